@@ -0,0 +1,112 @@
+/*
+    batch.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "runtime"
+import "sync"
+import "sync/atomic"
+
+// Batches with fewer messages are processed on the calling goroutine.
+const BATCH_PARALLEL = 16
+
+// Message is one entry of a batch. Data holds the plaintext for SealBatch and
+// ciphertext plus tag for OpenBatch. The result is written to Out, reusing
+// its capacity if sufficient, and the outcome of the entry to Err.
+type Message struct {
+    Nonce   []uint8
+    Header  []uint8
+    Data    []uint8
+    Trailer []uint8
+    Out     []uint8
+    Err     error
+}
+
+// SealBatch encrypts every message of the batch under the same key. The
+// messages are spread over one worker goroutine per CPU, so the fixed cost of
+// initialisation and finalisation of many small messages runs in parallel.
+// The returned error concerns the parameters and key, failures of single
+// messages are reported in their Err field.
+func SealBatch(p Params, key []uint8, batch []Message) error {
+    return norx_batch(p, key, batch, seal_message)
+}
+
+// OpenBatch decrypts every message of the batch under the same key, see
+// SealBatch. The Out field of a message failing authentication is nil.
+func OpenBatch(p Params, key []uint8, batch []Message) error {
+    return norx_batch(p, key, batch, open_message)
+}
+
+func seal_message(p *Params, key []uint8, x *Message) {
+
+    var clen uint64 = 0
+    var mlen = uint64(len(x.Data))
+    var out []uint8
+
+    x.Out, out = slice_for_append(x.Out[:0], len(x.Data) + p.TagSize())
+    x.Err = p.AEAD_encrypt(out, &clen, x.Header, uint64(len(x.Header)), x.Data, mlen, x.Trailer, uint64(len(x.Trailer)), x.Nonce, key)
+    if x.Err != nil {
+        x.Out = nil
+    }
+}
+
+func open_message(p *Params, key []uint8, x *Message) {
+
+    var mlen uint64 = 0
+    var clen = uint64(len(x.Data))
+    var out []uint8
+
+    if len(x.Data) < p.TagSize() {
+        x.Out, x.Err = nil, ErrShortCiphertext
+        return
+    }
+    x.Out, out = slice_for_append(x.Out[:0], len(x.Data) - p.TagSize())
+    x.Err = p.AEAD_decrypt(out, &mlen, x.Header, uint64(len(x.Header)), x.Data, clen, x.Trailer, uint64(len(x.Trailer)), x.Nonce, key)
+    if x.Err != nil {
+        x.Out = nil
+    }
+}
+
+func norx_batch(p Params, key []uint8, batch []Message, f func(*Params, []uint8, *Message)) error {
+
+    if err := p.Valid(); err != nil {
+        return err
+    }
+    if len(key) != p.KeySize() {
+        return ErrKeySize
+    }
+
+    if len(batch) < BATCH_PARALLEL {
+        for i := range batch {
+            f(&p, key, &batch[i])
+        }
+        return nil
+    }
+
+    var next int64 = -1
+    var workers = runtime.GOMAXPROCS(0)
+    var wg sync.WaitGroup
+
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for {
+                i := int(atomic.AddInt64(&next, 1))
+                if i >= len(batch) {
+                    return
+                }
+                f(&p, key, &batch[i])
+            }
+        }()
+    }
+    wg.Wait()
+    return nil
+}
@@ -0,0 +1,96 @@
+/*
+    bench_test.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead_test
+
+import norx "github.com/daeinar/norx-go/aead"
+
+import "fmt"
+import "testing"
+
+var bench_variants = []*norx.Params{&norx.NORX6441, &norx.NORX6441_V30, &norx.NORX3241_V30, &norx.NORX6444_V30}
+
+var bench_sizes = []int{16, 64, 1024, 1 << 20}
+
+// BenchmarkEncrypt measures AEAD_encrypt for short, medium and long messages.
+func BenchmarkEncrypt(b *testing.B) {
+
+    for _, p := range bench_variants {
+        for _, size := range bench_sizes {
+            p, size := p, size
+            b.Run(fmt.Sprintf("%s/%d", name(p), size), func(b *testing.B) {
+                k := make([]uint8, p.KeySize())
+                n := make([]uint8, p.NonceSize())
+                m := make([]uint8, size)
+                c := make([]uint8, size + p.TagSize())
+                var clen uint64 = 0
+                b.SetBytes(int64(size))
+                for i := 0; i < b.N; i++ {
+                    p.AEAD_encrypt(c, &clen, nil, 0, m, uint64(size), nil, 0, n, k)
+                }
+            })
+        }
+    }
+}
+
+// BenchmarkBackends compares the AVX2 lanes with the scalar lanes for the
+// variants with P != 1.
+func BenchmarkBackends(b *testing.B) {
+
+    defer norx.SetAVX2(norx.SetAVX2(true))
+
+    for _, p := range []*norx.Params{&norx.NORX6444_V30, &norx.NORX6440} {
+        for _, size := range bench_sizes[1:] {
+            for _, on := range []bool{false, true} {
+                p, size, on := p, size, on
+                b.Run(fmt.Sprintf("%s/%d/avx2=%t", name(p), size, on), func(b *testing.B) {
+                    if on && !norx.HasAVX2 {
+                        b.Skip("no AVX2")
+                    }
+                    norx.SetAVX2(on)
+                    k := make([]uint8, p.KeySize())
+                    n := make([]uint8, p.NonceSize())
+                    m := make([]uint8, size)
+                    c := make([]uint8, size + p.TagSize())
+                    var clen uint64 = 0
+                    b.SetBytes(int64(size))
+                    for i := 0; i < b.N; i++ {
+                        p.AEAD_encrypt(c, &clen, nil, 0, m, uint64(size), nil, 0, n, k)
+                    }
+                })
+            }
+        }
+    }
+}
+
+// BenchmarkContext measures packets of up to 1 KiB with a keyed Context,
+// which skips the key-dependent part of the initialisation.
+func BenchmarkContext(b *testing.B) {
+
+    for _, p := range bench_variants {
+        for _, size := range bench_sizes[:3] {
+            p, size := p, size
+            b.Run(fmt.Sprintf("%s/%d", name(p), size), func(b *testing.B) {
+                x, err := norx.NewContext(*p, make([]uint8, p.KeySize()))
+                if err != nil {
+                    b.Fatal(err)
+                }
+                n := make([]uint8, p.NonceSize())
+                m := make([]uint8, size)
+                c := make([]uint8, size + p.TagSize())
+                b.SetBytes(int64(size))
+                for i := 0; i < b.N; i++ {
+                    x.Reset(n)
+                    x.Encrypt(c, nil, m, nil)
+                }
+            })
+        }
+    }
+}
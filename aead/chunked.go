@@ -0,0 +1,216 @@
+/*
+    chunked.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "encoding/binary"
+import "io"
+
+// The chunked format follows the STREAM construction: the plaintext is cut
+// into chunks of a fixed size, and each chunk is sealed on its own with the
+// header and the nonce
+//
+//     prefix || counter (4 bytes, big endian) || last (1 byte)
+//
+// where last is 1 for the final chunk and 0 otherwise. The final chunk may be
+// shorter than the others or even empty. A reader can thus authenticate chunk
+// by chunk, and truncation, reordering and extension of the chunks make the
+// affected chunk fail to authenticate. The prefix holds NonceSize() - 5 bytes
+// and must be unique per key; for the 8-byte nonces of NORX32 v2.0 this leaves
+// only 3 bytes, so those variants need a fresh key per stream.
+const BYTES_CHUNK_NONCE = 5
+
+type norx_chunked_t struct {
+    p      Params
+    key    [BYTES_KEY]uint8
+    nonce  [4 * BYTES_WORD]uint8
+    header []uint8
+    size   int    // plaintext bytes per chunk
+    count  uint64 // index of the next chunk
+}
+
+func new_chunked(p Params, key []uint8, prefix []uint8, header []uint8, size int) (*norx_chunked_t, error) {
+
+    if err := p.Valid(); err != nil {
+        return nil, err
+    }
+    if len(key) != p.KeySize() {
+        return nil, ErrKeySize
+    }
+    if p.NonceSize() < BYTES_CHUNK_NONCE || len(prefix) != p.NonceSize() - BYTES_CHUNK_NONCE {
+        return nil, ErrNonceSize
+    }
+    if size <= 0 {
+        return nil, ErrChunkSize
+    }
+    x := &norx_chunked_t{p: p, header: header, size: size}
+    copy(x.key[:], key)
+    copy(x.nonce[:], prefix)
+    return x, nil
+}
+
+// next_nonce returns the nonce of the next chunk.
+func (x *norx_chunked_t) next_nonce(last bool) ([]uint8, error) {
+
+    if x.count > 0xFFFFFFFF {
+        return nil, ErrChunkCount
+    }
+    var n = x.p.NonceSize()
+    binary.BigEndian.PutUint32(x.nonce[n-5:n-1], uint32(x.count))
+    x.nonce[n-1] = 0
+    if last {
+        x.nonce[n-1] = 1
+    }
+    x.count++
+    return x.nonce[:n], nil
+}
+
+type norx_chunk_writer_t struct {
+    x   *norx_chunked_t
+    w   io.Writer
+    buf []uint8 // plaintext of the pending chunk, followed by room for the tag
+    n   int
+    out []uint8
+    err error
+}
+
+// NewChunkWriter returns an io.WriteCloser which writes the chunked format of
+// everything written to it to w. Close seals the final chunk, it does not
+// close w.
+func NewChunkWriter(w io.Writer, p Params, key []uint8, prefix []uint8, header []uint8, size int) (io.WriteCloser, error) {
+
+    x, err := new_chunked(p, key, prefix, header, size)
+    if err != nil {
+        return nil, err
+    }
+    var seg = size + p.TagSize()
+    return &norx_chunk_writer_t{x: x, w: w, buf: make([]uint8, size), out: make([]uint8, seg)}, nil
+}
+
+func (wr *norx_chunk_writer_t) Write(m []uint8) (int, error) {
+
+    var n = 0
+    for wr.err == nil && n < len(m) {
+        // A full chunk is only sealed once more data shows it is not the last.
+        if wr.n == len(wr.buf) {
+            wr.err = wr.seal(false)
+            continue
+        }
+        k := copy(wr.buf[wr.n:], m[n:])
+        wr.n += k
+        n += k
+    }
+    return n, wr.err
+}
+
+func (wr *norx_chunk_writer_t) Close() error {
+
+    if wr.err != nil {
+        return wr.err
+    }
+    if wr.err = wr.seal(true); wr.err == nil {
+        wr.err = ErrPhase
+        return nil
+    }
+    return wr.err
+}
+
+func (wr *norx_chunk_writer_t) seal(last bool) error {
+
+    nonce, err := wr.x.next_nonce(last)
+    if err != nil {
+        return err
+    }
+    var clen uint64 = 0
+    var h = wr.x.header
+    err = wr.x.p.AEAD_encrypt(wr.out, &clen, h, uint64(len(h)), wr.buf, uint64(wr.n), nil, 0, nonce, wr.x.key[:wr.x.p.KeySize()])
+    if err != nil {
+        return err
+    }
+    wr.n = 0
+    _, err = wr.w.Write(wr.out[:clen])
+    return err
+}
+
+type norx_chunk_reader_t struct {
+    x    *norx_chunked_t
+    r    io.Reader
+    buf  []uint8 // one segment plus one byte of look-ahead
+    n    int
+    m    []uint8 // authenticated plaintext not yet returned
+    last bool
+    err  error
+}
+
+// NewChunkReader returns an io.Reader which decrypts the chunked format read
+// from r. Only authenticated plaintext is returned; an error other than
+// io.EOF means that a chunk was forged, reordered, truncated or extended.
+func NewChunkReader(r io.Reader, p Params, key []uint8, prefix []uint8, header []uint8, size int) (io.Reader, error) {
+
+    x, err := new_chunked(p, key, prefix, header, size)
+    if err != nil {
+        return nil, err
+    }
+    var seg = size + p.TagSize()
+    return &norx_chunk_reader_t{x: x, r: r, buf: make([]uint8, seg + 1)}, nil
+}
+
+func (rd *norx_chunk_reader_t) Read(m []uint8) (int, error) {
+
+    for len(rd.m) == 0 {
+        if rd.err != nil {
+            return 0, rd.err
+        }
+        if rd.last {
+            rd.err = io.EOF
+            return 0, rd.err
+        }
+        rd.err = rd.open()
+    }
+    k := copy(m, rd.m)
+    rd.m = rd.m[k:]
+    return k, nil
+}
+
+// open reads and decrypts the next chunk. A chunk is the last one if no byte
+// follows it in r.
+func (rd *norx_chunk_reader_t) open() error {
+
+    k, err := io.ReadFull(rd.r, rd.buf[rd.n:])
+    rd.n += k
+    if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+        return err
+    }
+
+    var seg = len(rd.buf) - 1
+    var clen = rd.n
+    rd.last = rd.n <= seg
+    if !rd.last {
+        clen = seg
+    }
+    if clen < rd.x.p.TagSize() {
+        return ErrShortCiphertext
+    }
+
+    nonce, err := rd.x.next_nonce(rd.last)
+    if err != nil {
+        return err
+    }
+    var mlen uint64 = 0
+    var h = rd.x.header
+    var out = make([]uint8, clen - rd.x.p.TagSize())
+    err = rd.x.p.AEAD_decrypt(out, &mlen, h, uint64(len(h)), rd.buf, uint64(clen), nil, 0, nonce, rd.x.key[:rd.x.p.KeySize()])
+    if err != nil {
+        return err
+    }
+    rd.m = out
+    rd.n = copy(rd.buf, rd.buf[clen:rd.n])
+    return nil
+}
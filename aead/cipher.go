@@ -0,0 +1,137 @@
+/*
+    cipher.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "crypto/cipher"
+import "unsafe"
+
+// norx_crypt_f is the signature shared by AEAD_encrypt, AEAD_decrypt and their
+// SIV counterparts, with the output and its length first.
+type norx_crypt_f func(p *Params, out []uint8, outlen *uint64, a []uint8, alen uint64, in []uint8, inlen uint64, z []uint8, zlen uint64, nonce []uint8, key []uint8) error
+
+// norx_aead_t implements cipher.AEAD on top of a pair of encrypt and decrypt
+// functions, which New and NewSIV choose.
+type norx_aead_t struct {
+    p       Params
+    key     [BYTES_KEY]uint8
+    name    string // of the mode in panic messages
+    encrypt norx_crypt_f
+    decrypt norx_crypt_f
+}
+
+// NewNORX returns NORX6441 as a cipher.AEAD. The key has to be BYTES_KEY
+// bytes long. The trailer of the NORX scheme is not exposed and always empty.
+func NewNORX(key []uint8) (cipher.AEAD, error) {
+    return New(norx6441, key)
+}
+
+// New returns the NORX variant described by p as a cipher.AEAD. The key has
+// to be p.KeySize() bytes long.
+func New(p Params, key []uint8) (cipher.AEAD, error) {
+
+    if err := p.Valid(); err != nil {
+        return nil, err
+    }
+    if len(key) != p.KeySize() {
+        return nil, ErrKeySize
+    }
+    return new_aead(p, key, "NORX", (*Params).AEAD_encrypt, (*Params).AEAD_decrypt), nil
+}
+
+func new_aead(p Params, key []uint8, name string, encrypt norx_crypt_f, decrypt norx_crypt_f) *norx_aead_t {
+
+    x := &norx_aead_t{p: p, name: name, encrypt: encrypt, decrypt: decrypt}
+    copy(x.key[:], key)
+    return x
+}
+
+func (x *norx_aead_t) NonceSize() int {
+    return x.p.NonceSize()
+}
+
+func (x *norx_aead_t) Overhead() int {
+    return x.p.TagSize()
+}
+
+func (x *norx_aead_t) Seal(dst, nonce, plaintext, additionalData []uint8) []uint8 {
+
+    if len(nonce) != x.p.NonceSize() {
+        panic("norx: incorrect nonce length given to " + x.name)
+    }
+
+    var mlen = uint64(len(plaintext))
+    var alen = uint64(len(additionalData))
+    var clen uint64 = 0
+
+    ret, out := slice_for_append(dst, len(plaintext) + x.p.TagSize())
+    if inexact_overlap(out, plaintext) {
+        panic("norx: invalid buffer overlap")
+    }
+    x.encrypt(&x.p, out, &clen, additionalData, alen, plaintext, mlen, nil, 0, nonce, x.key[:x.p.KeySize()])
+    return ret
+}
+
+// Open reports a wrong nonce length or an invalid overlap as ErrNonceSize or
+// ErrOverlap, while Seal, which cannot return an error, panics.
+func (x *norx_aead_t) Open(dst, nonce, ciphertext, additionalData []uint8) ([]uint8, error) {
+
+    if len(nonce) != x.p.NonceSize() {
+        return nil, ErrNonceSize
+    }
+    if len(ciphertext) < x.p.TagSize() {
+        return nil, ErrShortCiphertext
+    }
+
+    var clen = uint64(len(ciphertext))
+    var alen = uint64(len(additionalData))
+    var mlen uint64 = 0
+
+    ret, out := slice_for_append(dst, len(ciphertext) - x.p.TagSize())
+    if inexact_overlap(out, ciphertext) {
+        return nil, ErrOverlap
+    }
+    if err := x.decrypt(&x.p, out, &mlen, additionalData, alen, ciphertext, clen, nil, 0, nonce, x.key[:x.p.KeySize()]); err != nil {
+        return nil, err
+    }
+    return ret, nil
+}
+
+// slice_for_append extends in by n bytes and returns the extended slice
+// together with a slice covering only the n new bytes.
+func slice_for_append(in []uint8, n int) (head, tail []uint8) {
+
+    if total := len(in) + n; cap(in) >= total {
+        head = in[:total]
+    } else {
+        head = make([]uint8, total)
+        copy(head, in)
+    }
+    tail = head[len(in):]
+    return
+}
+
+// any_overlap reports whether x and y share memory at any index.
+func any_overlap(x, y []uint8) bool {
+
+    return len(x) > 0 && len(y) > 0 &&
+        uintptr(unsafe.Pointer(&x[0])) <= uintptr(unsafe.Pointer(&y[len(y)-1])) &&
+        uintptr(unsafe.Pointer(&y[0])) <= uintptr(unsafe.Pointer(&x[len(x)-1]))
+}
+
+// inexact_overlap reports whether x and y share memory at any non-corresponding
+// index. Exact overlap is fine since all block functions process word by word.
+func inexact_overlap(x, y []uint8) bool {
+
+    if len(x) == 0 || len(y) == 0 || &x[0] == &y[0] {
+        return false
+    }
+    return any_overlap(x, y)
+}
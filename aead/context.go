@@ -0,0 +1,126 @@
+/*
+    context.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+// Context encrypts or decrypts one message per nonce under a fixed key and
+// performs no heap allocations after NewContext, unless a payload of at
+// least BYTES_PARALLEL bytes is spread over goroutines (P != 1). Every
+// message starts with Reset, so a context is never used twice under the same
+// initialisation by accident.
+//
+// The output may alias the input exactly, e.g. Encrypt(buf, a, buf[:n], z)
+// encrypts the first n bytes of buf in place and appends the tag. Any other
+// overlap of output and input, and any overlap of the output with the
+// trailer, fails with ErrOverlap.
+type Context struct {
+    p      Params
+    key    [BYTES_KEY]uint8
+    prefix norx_state_t // state after norx_init_key
+    state  norx_state_t
+    ready  bool // Reset was called since the last message
+}
+
+// NewContext returns a context for the variant p under key. The part of the
+// initialisation that only depends on the key is computed once, so Reset
+// costs a single permutation instead of two extra rounds on top.
+func NewContext(p Params, key []uint8) (*Context, error) {
+
+    if err := p.Valid(); err != nil {
+        return nil, err
+    }
+    if len(key) != p.KeySize() {
+        return nil, ErrKeySize
+    }
+    x := &Context{p: p}
+    copy(x.key[:], key)
+    norx_init_key(&x.prefix, &x.p, x.key[:p.KeySize()])
+    return x, nil
+}
+
+// Reset initialises the context for the next message under nonce.
+func (x *Context) Reset(nonce []uint8) error {
+
+    if len(nonce) != x.p.NonceSize() {
+        return ErrNonceSize
+    }
+    x.state = x.prefix
+    norx_init_nonce(&x.state, x.key[:x.p.KeySize()], nonce)
+    x.ready = true
+    return nil
+}
+
+func (x *Context) Overhead() int {
+    return x.p.TagSize()
+}
+
+// Encrypt writes the encryption of m followed by the tag to c, which has to
+// hold at least len(m) + Overhead() bytes.
+func (x *Context) Encrypt(c []uint8, a []uint8, m []uint8, z []uint8) error {
+
+    var mlen = uint64(len(m))
+    var taglen = uint64(x.p.TagSize())
+
+    if !x.ready {
+        return ErrPhase
+    }
+    if uint64(len(c)) < mlen + taglen {
+        return ErrBufferTooSmall
+    }
+    if inexact_overlap(c[:mlen + taglen], m) || any_overlap(c[:mlen + taglen], z) {
+        return ErrOverlap
+    }
+    x.ready = false
+
+    norx_absorb_data(&x.state, a, uint64(len(a)), HEADER_TAG)
+    norx_encrypt_payload(&x.state, c, m, mlen)
+    norx_absorb_data(&x.state, z, uint64(len(z)), TRAILER_TAG)
+    norx_output_tag(&x.state, c[mlen:mlen + taglen], x.key[:x.p.KeySize()])
+    burn64(x.state.s[:], WORDS_STATE)
+    return nil
+}
+
+// Decrypt writes the decryption of c, which ends with the tag, to m, which
+// has to hold at least len(c) - Overhead() bytes. If the tag does not verify,
+// m is wiped and ErrAuth returned.
+func (x *Context) Decrypt(m []uint8, a []uint8, c []uint8, z []uint8) error {
+
+    var clen = uint64(len(c))
+    var taglen = uint64(x.p.TagSize())
+
+    if !x.ready {
+        return ErrPhase
+    }
+    if clen < taglen {
+        return ErrShortCiphertext
+    }
+    if uint64(len(m)) < clen - taglen {
+        return ErrBufferTooSmall
+    }
+    if inexact_overlap(m[:clen - taglen], c) || any_overlap(m[:clen - taglen], z) {
+        return ErrOverlap
+    }
+    x.ready = false
+
+    var result int = -1
+    var tag [BYTES_TAG_MAX]uint8
+    norx_absorb_data(&x.state, a, uint64(len(a)), HEADER_TAG)
+    norx_decrypt_payload(&x.state, m, c, clen - taglen)
+    norx_absorb_data(&x.state, z, uint64(len(z)), TRAILER_TAG)
+    norx_output_tag(&x.state, tag[:], x.key[:x.p.KeySize()])
+    result = norx_verify_tag(c[clen - taglen:], tag[:], taglen)
+    burn64(x.state.s[:], WORDS_STATE)
+    burn8(tag[:], BYTES_TAG_MAX)
+    if result != 0 {
+        burn8(m[:], clen - taglen)
+        return ErrAuth
+    }
+    return nil
+}
@@ -0,0 +1,154 @@
+/*
+    drbg.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+const (
+    DRBG_MAX_REQUEST     = 1 << 16 // bytes per call of Generate
+    DRBG_RESEED_INTERVAL = 1 << 48 // calls of Generate between reseeds
+)
+
+// DRBG is a deterministic random bit generator on norx_permute. The state is
+// initialised like the hash function, with DRBG_TAG in place of HASH_TAG, and
+// seed material is absorbed with DRBG_TAG blocks, each input padded on its
+// own. Generate squeezes the rate after FINAL_TAG and ratchets the state
+// afterwards: one more permutation, wiping the rate and a final permutation,
+// so a compromised state does not reveal output of earlier requests.
+//
+// The output is fully determined by the seeds and additional inputs and must
+// therefore only be used as a cryptographic generator if the seeds are secret
+// and carry enough entropy.
+type DRBG struct {
+    p       Params
+    state   norx_state_t
+    counter uint64 // calls of Generate since the last (re)seed
+}
+
+// NewDRBG instantiates a generator on the permutation of p. The seed holds
+// the entropy input and nonce and needs at least KeySize() bytes, the
+// optional personalization string separates generators with the same seed.
+func NewDRBG(p Params, seed []uint8, personalization []uint8) (*DRBG, error) {
+
+    if err := p.Valid(); err != nil {
+        return nil, err
+    }
+    if len(seed) < p.KeySize() {
+        return nil, ErrSeedSize
+    }
+
+    d := &DRBG{p: p}
+    d.state.p = &d.p
+
+    var s = d.state.s[:]
+    for i := uint64(0); i < WORDS_STATE; i++ {
+        s[i] = i
+    }
+
+    norx_rounds(&d.state, 2)
+
+    s[12] ^= d.p.W
+    s[13] ^= d.p.L
+    s[14] ^= DRBG_TAG
+
+    norx_permute(&d.state)
+
+    d.absorb(seed)
+    d.absorb(personalization)
+    d.counter = 1
+    return d, nil
+}
+
+// Reseed mixes fresh entropy and optional additional input into the state.
+func (d *DRBG) Reseed(seed []uint8, additional []uint8) error {
+
+    if len(seed) < d.p.KeySize() {
+        return ErrSeedSize
+    }
+    d.absorb(seed)
+    d.absorb(additional)
+    d.counter = 1
+    return nil
+}
+
+// Generate fills out with at most DRBG_MAX_REQUEST pseudorandom bytes after
+// mixing in the optional additional input. It fails with ErrReseed once
+// DRBG_RESEED_INTERVAL requests have been served since the last (re)seed.
+func (d *DRBG) Generate(out []uint8, additional []uint8) error {
+
+    if len(out) > DRBG_MAX_REQUEST {
+        return ErrRequestSize
+    }
+    if d.counter > DRBG_RESEED_INTERVAL {
+        return ErrReseed
+    }
+
+    if len(additional) > 0 {
+        d.absorb(additional)
+    }
+    d.state.s[15] ^= FINAL_TAG
+
+    var block [BYTES_RATE]uint8
+    var r = d.p.bytes_rate()
+    var b = d.p.bytes_word()
+
+    for len(out) > 0 {
+        norx_permute(&d.state)
+        for i := uint64(0); i < WORDS_RATE; i++ {
+            store_word(&d.state, block[b*i:b*(i+1)], d.state.s[i])
+        }
+        out = out[copy(out, block[:r]):]
+    }
+    burn8(block[:], BYTES_RATE)
+
+    d.ratchet()
+    d.counter++
+    return nil
+}
+
+// Read implements io.Reader by splitting out into requests of at most
+// DRBG_MAX_REQUEST bytes, so the output differs from a single Generate call
+// for longer buffers.
+func (d *DRBG) Read(out []uint8) (int, error) {
+
+    var n = 0
+    for n < len(out) {
+        k := len(out) - n
+        if k > DRBG_MAX_REQUEST {
+            k = DRBG_MAX_REQUEST
+        }
+        if err := d.Generate(out[n:n+k], nil); err != nil {
+            return n, err
+        }
+        n += k
+    }
+    return n, nil
+}
+
+// absorb absorbs in with DRBG_TAG, padding the last block even if in is
+// empty so consecutive inputs cannot be shifted into each other.
+func (d *DRBG) absorb(in []uint8) {
+
+    var r = d.p.bytes_rate()
+
+    for uint64(len(in)) >= r {
+        norx_absorb_block(&d.state, in[:r], DRBG_TAG)
+        in = in[r:]
+    }
+    norx_absorb_lastblock(&d.state, in, uint64(len(in)), DRBG_TAG)
+}
+
+func (d *DRBG) ratchet() {
+
+    norx_permute(&d.state)
+    for i := 0; i < WORDS_RATE; i++ {
+        d.state.s[i] = 0
+    }
+    norx_permute(&d.state)
+}
@@ -0,0 +1,30 @@
+/*
+    errors.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "errors"
+
+var (
+    ErrAuth            = errors.New("norx: message authentication failed")
+    ErrShortCiphertext = errors.New("norx: ciphertext shorter than the tag")
+    ErrKeySize         = errors.New("norx: invalid key size")
+    ErrNonceSize       = errors.New("norx: invalid nonce size")
+    ErrBufferTooSmall  = errors.New("norx: buffer too small")
+    ErrParams          = errors.New("norx: unsupported parameters")
+    ErrPhase           = errors.New("norx: stream phases used out of order")
+    ErrChunkCount      = errors.New("norx: too many chunks")
+    ErrChunkSize       = errors.New("norx: invalid chunk size")
+    ErrSeedSize        = errors.New("norx: seed too short")
+    ErrRequestSize     = errors.New("norx: too many bytes requested")
+    ErrReseed          = errors.New("norx: reseed required")
+    ErrWrapSize        = errors.New("norx: invalid size of wrapped key")
+    ErrOverlap         = errors.New("norx: invalid buffer overlap")
+)
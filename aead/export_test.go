@@ -0,0 +1,33 @@
+/*
+    export_test.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+// HasAVX2 reports whether the AVX2 backend can run on this machine.
+var HasAVX2 = has_avx2
+
+// SetAVX2 switches the AVX2 backend on or off and returns the previous
+// setting, so that tests and benchmarks can compare both backends.
+func SetAVX2(on bool) bool {
+
+    old := use_avx2
+    use_avx2 = on && has_avx2
+    return old
+}
+
+// Permute4 applies r rounds to four interleaved states with the backend in use.
+func Permute4(s *[WORDS_STATE][4]uint64, r uint64) {
+    permute4((*norx_state4_t)(s), r)
+}
+
+// Permute4Generic applies r rounds to four interleaved states in Go.
+func Permute4Generic(s *[WORDS_STATE][4]uint64, r uint64) {
+    permute4_generic((*norx_state4_t)(s), r)
+}
@@ -0,0 +1,109 @@
+/*
+    fuzz_test.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead_test
+
+import norx "github.com/daeinar/norx-go/aead"
+import utils "github.com/daeinar/norx-go/utils"
+
+import "bytes"
+import "testing"
+
+// seed adds the KAT inputs of Check() for a few message lengths of every
+// variant to the corpus of f.
+func seed(f *testing.F, add func(v uint8, k, n, a, m, z []uint8)) {
+
+    for v, p := range utils.Variants() {
+        k, n, h, w := inputs(p)
+        for _, i := range []int{0, 1, 31, 32, 63, 64, 95, 96, 97, 255} {
+            add(uint8(v), k, n, h[:i], w[:i], nil)
+        }
+    }
+}
+
+// fit truncates or zero-pads x to size bytes.
+func fit(x []uint8, size int) []uint8 {
+
+    var y = make([]uint8, size)
+    copy(y, x)
+    return y
+}
+
+// FuzzRoundTrip encrypts arbitrary inputs under a variant chosen by v, checks
+// that decryption recovers the message and that flipping the bit selected by
+// flip anywhere in the ciphertext and tag is rejected.
+func FuzzRoundTrip(f *testing.F) {
+
+    seed(f, func(v uint8, k, n, a, m, z []uint8) {
+        f.Add(v, k, n, a, m, z, uint32(len(m)))
+    })
+
+    variants := utils.Variants()
+
+    f.Fuzz(func(t *testing.T, v uint8, k, n, a, m, z []uint8, flip uint32) {
+        p := variants[int(v) % len(variants)]
+        k, n = fit(k, p.KeySize()), fit(n, p.NonceSize())
+
+        var clen, mlen uint64
+        c := make([]uint8, len(m) + p.TagSize())
+        if err := p.AEAD_encrypt(c, &clen, a, uint64(len(a)), m, uint64(len(m)), z, uint64(len(z)), n, k); err != nil {
+            t.Fatalf("encrypt: %v", err)
+        }
+        o := make([]uint8, len(m))
+        if err := p.AEAD_decrypt(o, &mlen, a, uint64(len(a)), c, clen, z, uint64(len(z)), n, k); err != nil || !bytes.Equal(o, m) {
+            t.Fatalf("decrypt: %v", err)
+        }
+
+        x, err := norx.New(*p, k)
+        if err != nil {
+            t.Fatal(err)
+        }
+        s := x.Seal(nil, n, m, a)
+        if o, err := x.Open(nil, n, s, a); err != nil || !bytes.Equal(o, m) {
+            t.Fatalf("open: %v", err)
+        }
+
+        bit := int(flip) % (8 * len(c))
+        c[bit / 8] ^= 1 << uint(bit % 8)
+        if err := p.AEAD_decrypt(o, &mlen, a, uint64(len(a)), c, clen, z, uint64(len(z)), n, k); err != norx.ErrAuth {
+            t.Fatalf("flipped bit %d: got %v, want ErrAuth", bit, err)
+        }
+    })
+}
+
+// FuzzDecrypt feeds arbitrary ciphertexts, nonces and keys of any length to
+// the decryption entry points, which must neither panic nor authenticate.
+func FuzzDecrypt(f *testing.F) {
+
+    seed(f, func(v uint8, k, n, a, m, z []uint8) {
+        f.Add(v, k, n, a, m, z)
+    })
+
+    variants := utils.Variants()
+
+    f.Fuzz(func(t *testing.T, v uint8, k, n, a, c, z []uint8) {
+        p := variants[int(v) % len(variants)]
+
+        var mlen uint64
+        m := make([]uint8, len(c))
+        err := p.AEAD_decrypt(m, &mlen, a, uint64(len(a)), c, uint64(len(c)), z, uint64(len(z)), n, k)
+        if err == nil {
+            t.Fatalf("random ciphertext %x authenticated", c)
+        }
+
+        x, err := norx.New(*p, fit(k, p.KeySize()))
+        if err != nil {
+            t.Fatal(err)
+        }
+        if _, err := x.Open(nil, fit(n, p.NonceSize()), c, a); err == nil {
+            t.Fatalf("random ciphertext %x opened", c)
+        }
+    })
+}
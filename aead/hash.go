@@ -0,0 +1,221 @@
+/*
+    hash.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "hash"
+
+// The hash function is a sponge over norx_permute with the rate and padding
+// of the AEAD scheme. The state is initialised like in norx_init, but with
+// HASH_TAG in place of the parallelism degree and the digest size in bits in
+// place of the tag size (0 for the XOF), and without key and nonce. Every
+// block is absorbed with HASH_TAG, the last one padded by norx_pad, and the
+// output is squeezed from the rate after FINAL_TAG. Collision resistance is
+// bounded by half the capacity, i.e. 128 bits for NORX64 and 64 bits for
+// NORX32.
+type norx_sponge_t struct {
+    p      Params
+    size   int // digest size in bytes, 0 for the XOF
+    state  norx_state_t
+    buf    [BYTES_RATE]uint8
+    n      uint64 // bytes in buf
+    out    [BYTES_RATE]uint8
+    outpos uint64 // bytes of out already returned
+    final  bool
+}
+
+func new_sponge(p Params, size int) (*norx_sponge_t, error) {
+
+    if err := p.Valid(); err != nil {
+        return nil, err
+    }
+    x := &norx_sponge_t{p: p, size: size}
+    x.Reset()
+    return x, nil
+}
+
+// NewHash returns a hash.Hash computing size-byte digests on the permutation
+// of p. The parallelism degree and tag size of p are ignored. A size below 1
+// fails with ErrParams.
+func NewHash(p Params, size int) (hash.Hash, error) {
+
+    if size <= 0 {
+        return nil, ErrParams
+    }
+    return new_sponge(p, size)
+}
+
+// NewHash256 returns a hash.Hash computing 256-bit digests on NORX64-4.
+func NewHash256() hash.Hash {
+    x, _ := new_sponge(norx6441, 32)
+    return x
+}
+
+// NewHash512 returns a hash.Hash computing 512-bit digests on NORX64-4. Its
+// collision resistance is only 128 bits, bounded by the 256-bit capacity, so
+// unlike SHA-512 it is no stronger against collisions than NewHash256.
+func NewHash512() hash.Hash {
+    x, _ := new_sponge(norx6441, 64)
+    return x
+}
+
+// Sum256 returns the 256-bit digest of data on NORX64-4.
+func Sum256(data []uint8) [32]uint8 {
+    var d [32]uint8
+    h := NewHash256()
+    h.Write(data)
+    h.Sum(d[:0])
+    return d
+}
+
+// Sum512 returns the 512-bit digest of data on NORX64-4, see NewHash512.
+func Sum512(data []uint8) [64]uint8 {
+    var d [64]uint8
+    h := NewHash512()
+    h.Write(data)
+    h.Sum(d[:0])
+    return d
+}
+
+func (x *norx_sponge_t) Reset() {
+
+    x.state.p = &x.p
+
+    var s = x.state.s[:]
+    for i := uint64(0); i < WORDS_STATE; i++ {
+        s[i] = i
+    }
+
+    norx_rounds(&x.state, 2)
+
+    s[12] ^= x.p.W
+    s[13] ^= x.p.L
+    s[14] ^= HASH_TAG
+    s[15] ^= uint64(8 * x.size)
+
+    norx_permute(&x.state)
+
+    burn8(x.buf[:], BYTES_RATE)
+    burn8(x.out[:], BYTES_RATE)
+    x.n = 0
+    x.outpos = 0
+    x.final = false
+}
+
+func (x *norx_sponge_t) Size() int {
+    return x.size
+}
+
+func (x *norx_sponge_t) BlockSize() int {
+    return int(x.p.bytes_rate())
+}
+
+func (x *norx_sponge_t) Write(data []uint8) (int, error) {
+
+    if x.final {
+        return 0, ErrPhase
+    }
+
+    var r = x.p.bytes_rate()
+    var n = len(data)
+
+    for len(data) > 0 {
+        k := uint64(copy(x.buf[x.n:r], data))
+        x.n += k
+        data = data[k:]
+        // A full buffer is only absorbed once more data follows, the
+        // last block is always padded.
+        if x.n == r && len(data) > 0 {
+            norx_absorb_block(&x.state, x.buf[:r], HASH_TAG)
+            x.n = 0
+        }
+    }
+    return n, nil
+}
+
+// Sum appends the digest to b without changing the state of the hash.
+func (x *norx_sponge_t) Sum(b []uint8) []uint8 {
+
+    var y = *x
+    y.state.p = &y.p
+    ret, out := slice_for_append(b, y.size)
+    y.squeeze(out)
+    burn64(y.state.s[:], WORDS_STATE)
+    return ret
+}
+
+func (x *norx_sponge_t) finish() {
+
+    var r = x.p.bytes_rate()
+
+    if x.n == r {
+        norx_absorb_block(&x.state, x.buf[:r], HASH_TAG)
+        x.n = 0
+    }
+    norx_absorb_lastblock(&x.state, x.buf[:x.n], x.n, HASH_TAG)
+    x.state.s[15] ^= FINAL_TAG
+    x.final = true
+    x.outpos = r
+}
+
+func (x *norx_sponge_t) squeeze(out []uint8) {
+
+    if !x.final {
+        x.finish()
+    }
+
+    var r = x.p.bytes_rate()
+    var b = x.p.bytes_word()
+
+    for len(out) > 0 {
+        if x.outpos == r {
+            norx_permute(&x.state)
+            for i := uint64(0); i < WORDS_RATE; i++ {
+                store_word(&x.state, x.out[b*i:b*(i+1)], x.state.s[i])
+            }
+            x.outpos = 0
+        }
+        k := uint64(copy(out, x.out[x.outpos:r]))
+        x.outpos += k
+        out = out[k:]
+    }
+}
+
+// XOF is an extendable-output function: data is written to it and an output
+// stream of arbitrary length is read from it afterwards. Writing after the
+// first Read fails.
+type XOF struct {
+    x *norx_sponge_t
+}
+
+// NewXOF returns an XOF on the permutation of p.
+func NewXOF(p Params) (*XOF, error) {
+
+    x, err := new_sponge(p, 0)
+    if err != nil {
+        return nil, err
+    }
+    return &XOF{x}, nil
+}
+
+func (xof *XOF) Write(data []uint8) (int, error) {
+    return xof.x.Write(data)
+}
+
+// Read fills out with the next bytes of the output stream and never fails.
+func (xof *XOF) Read(out []uint8) (int, error) {
+    xof.x.squeeze(out)
+    return len(out), nil
+}
+
+// Reset returns the XOF to its initial state.
+func (xof *XOF) Reset() {
+    xof.x.Reset()
+}
@@ -0,0 +1,146 @@
+/*
+    io.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "io"
+
+// Size of the chunks in which the io wrappers process the payload.
+const BYTES_CHUNK = 1 << 14
+
+type norx_writer_t struct {
+    w       io.Writer
+    x       *Stream
+    trailer []uint8
+    buf     []uint8
+    err     error
+}
+
+// NewWriter returns an io.WriteCloser which encrypts everything written to it
+// and writes the ciphertext to w. Close absorbs the trailer and writes the
+// tag, it does not close w.
+func NewWriter(w io.Writer, p Params, key []uint8, nonce []uint8, header []uint8, trailer []uint8) (io.WriteCloser, error) {
+
+    x, err := NewStreamEncrypter(p, key, nonce)
+    if err != nil {
+        return nil, err
+    }
+    x.Header(header)
+    return &norx_writer_t{w: w, x: x, trailer: trailer, buf: make([]uint8, BYTES_CHUNK)}, nil
+}
+
+func (wr *norx_writer_t) Write(m []uint8) (int, error) {
+
+    var n = 0
+    for wr.err == nil && n < len(m) {
+        var k = len(m) - n
+        if k > len(wr.buf) {
+            k = len(wr.buf)
+        }
+        if wr.err = wr.x.Payload(wr.buf[:k], m[n:n+k]); wr.err != nil {
+            break
+        }
+        _, wr.err = wr.w.Write(wr.buf[:k])
+        n += k
+    }
+    return n, wr.err
+}
+
+func (wr *norx_writer_t) Close() error {
+
+    if wr.err != nil {
+        return wr.err
+    }
+    if wr.err = wr.x.Trailer(wr.trailer); wr.err != nil {
+        return wr.err
+    }
+    tag, err := wr.x.Finalize()
+    if err != nil {
+        wr.err = err
+        return err
+    }
+    _, wr.err = wr.w.Write(tag)
+    if wr.err == nil {
+        wr.err = ErrPhase
+        return nil
+    }
+    return wr.err
+}
+
+type norx_reader_t struct {
+    r       io.Reader
+    x       *Stream
+    trailer []uint8
+    buf     []uint8 // ciphertext read ahead, its last bytes may be the tag
+    n       int     // number of valid bytes in buf
+    eof     bool
+    err     error
+}
+
+// NewReader returns an io.Reader which decrypts the ciphertext and tag read
+// from r. The tag is verified once r is exhausted: Read then returns io.EOF
+// on success and an error otherwise. Plaintext is released before it is
+// authenticated, so nothing read may be acted upon before io.EOF.
+func NewReader(r io.Reader, p Params, key []uint8, nonce []uint8, header []uint8, trailer []uint8) (io.Reader, error) {
+
+    x, err := NewStreamDecrypter(p, key, nonce)
+    if err != nil {
+        return nil, err
+    }
+    x.Header(header)
+    return &norx_reader_t{r: r, x: x, trailer: trailer, buf: make([]uint8, BYTES_CHUNK + p.TagSize())}, nil
+}
+
+func (rd *norx_reader_t) Read(m []uint8) (int, error) {
+
+    if rd.err != nil {
+        return 0, rd.err
+    }
+    if len(m) == 0 {
+        return 0, nil
+    }
+
+    var taglen = rd.x.p.TagSize()
+
+    for rd.n <= taglen && !rd.eof {
+        k, err := rd.r.Read(rd.buf[rd.n:])
+        rd.n += k
+        if err == io.EOF {
+            rd.eof = true
+        } else if err != nil {
+            rd.err = err
+            return 0, err
+        }
+    }
+
+    if rd.n < taglen {
+        rd.err = ErrShortCiphertext
+        return 0, rd.err
+    }
+
+    if rd.n == taglen {
+        rd.x.Trailer(rd.trailer)
+        if err := rd.x.Verify(rd.buf[:rd.n]); err != nil {
+            rd.err = err
+        } else {
+            rd.err = io.EOF
+        }
+        return 0, rd.err
+    }
+
+    var k = rd.n - taglen
+    if k > len(m) {
+        k = len(m)
+    }
+    rd.x.Payload(m[:k], rd.buf[:k])
+    copy(rd.buf, rd.buf[k:rd.n])
+    rd.n -= k
+    return k, nil
+}
@@ -0,0 +1,117 @@
+/*
+    mac.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+// MAC authenticates data without encrypting it. The data is absorbed like a
+// NORX header under an all-zero nonce and the tag size is bound into the
+// initialisation, so tags of different lengths are unrelated. A MAC with the
+// full tag size of p equals the tag of AEAD_encrypt with the data as header,
+// an all-zero nonce and empty message and trailer.
+type MAC struct {
+    p     Params
+    key   [BYTES_KEY]uint8
+    state norx_state_t
+    buf   [BYTES_RATE]uint8
+    n     uint64 // bytes in buf
+    total uint64 // bytes written since the last Reset
+}
+
+// NewMAC returns a MAC with tags of size bytes, which lies between
+// BYTES_TAG_MIN and the rate (v2.0) or the capacity (v3.0) of p.
+func NewMAC(p Params, key []uint8, size int) (*MAC, error) {
+
+    if err := p.Valid(); err != nil {
+        return nil, err
+    }
+    if len(key) != p.KeySize() {
+        return nil, ErrKeySize
+    }
+    if size < BYTES_TAG_MIN || size > p.max_tag_size() {
+        return nil, ErrParams
+    }
+    x := &MAC{p: p}
+    x.p.T = uint64(8 * size)
+    copy(x.key[:], key)
+    x.Reset()
+    return x, nil
+}
+
+func (x *MAC) Reset() {
+
+    var nonce [4 * BYTES_WORD]uint8
+    norx_init(&x.state, &x.p, x.key[:x.p.KeySize()], nonce[:x.p.NonceSize()])
+    burn8(x.buf[:], BYTES_RATE)
+    x.n = 0
+    x.total = 0
+}
+
+func (x *MAC) Size() int {
+    return x.p.TagSize()
+}
+
+func (x *MAC) BlockSize() int {
+    return int(x.p.bytes_rate())
+}
+
+func (x *MAC) Write(data []uint8) (int, error) {
+
+    var r = x.p.bytes_rate()
+    var n = len(data)
+
+    x.total += uint64(n)
+    for len(data) > 0 {
+        // Full blocks are absorbed once more data follows, since the last
+        // block is always padded.
+        if x.n == r {
+            norx_absorb_block(&x.state, x.buf[:r], HEADER_TAG)
+            x.n = 0
+        }
+        k := uint64(copy(x.buf[x.n:r], data))
+        x.n += k
+        data = data[k:]
+    }
+    return n, nil
+}
+
+// Sum appends the tag to b without changing the state of the MAC.
+func (x *MAC) Sum(b []uint8) []uint8 {
+
+    var state = x.state
+    var r = x.p.bytes_rate()
+
+    if x.total > 0 {
+        if x.n == r {
+            norx_absorb_block(&state, x.buf[:r], HEADER_TAG)
+            norx_absorb_lastblock(&state, nil, 0, HEADER_TAG)
+        } else {
+            norx_absorb_lastblock(&state, x.buf[:x.n], x.n, HEADER_TAG)
+        }
+    }
+
+    ret, out := slice_for_append(b, x.p.TagSize())
+    norx_output_tag(&state, out, x.key[:x.p.KeySize()])
+    burn64(state.s[:], WORDS_STATE)
+    return ret
+}
+
+// Verify reports in constant time whether tag is the tag of the data written.
+func (x *MAC) Verify(tag []uint8) bool {
+
+    var t [BYTES_RATE]uint8
+    var taglen = uint64(x.p.TagSize())
+    x.Sum(t[:0])
+    var result = -1
+    if uint64(len(tag)) == taglen {
+        result = norx_verify_tag(tag, t[:], taglen)
+    }
+    burn8(t[:], BYTES_RATE)
+    return result == 0
+}
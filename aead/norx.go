@@ -4,7 +4,7 @@
 
     This file is part of the Go reference implementation of NORX.
 
-    :version: v2.0
+    :version: v2.0, v3.0
     :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
     :license: CC0, see LICENSE
 */
@@ -12,6 +12,10 @@ package aead
 
 import "encoding/binary"
 
+// NORX_W, NORX_L, NORX_P and NORX_T and the sizes derived from them describe
+// NORX6441 v2.0 only, the variant of NewNORX and AEAD_encrypt. BYTES_KEY and
+// BYTES_RATE are also the largest key and rate of all variants and size the
+// buffers. Other variants take their sizes from Params.
 const (
     NORX_W      = 64                       // wordsize
     NORX_L      = 4                        // number of rounds
@@ -22,17 +26,37 @@ const (
     BYTES_WORD  = NORX_W / 8               // number of bytes in a word
     BYTES_RATE  = WORDS_RATE * BYTES_WORD  // ... in the rate
     BYTES_TAG   = NORX_T / 8               // ... in the tag
+    BYTES_KEY   = 4 * BYTES_WORD           // ... in the key
+    BYTES_NONCE = 2 * BYTES_WORD           // ... in the nonce
+    BYTES_TAG_MIN = 8                      // ... in the shortest truncated tag
+    BYTES_TAG_MAX = BYTES_RATE             // ... in the longest tag
+    ROUNDS_MAX  = 16                       // largest number of rounds of a variant
+    LANES_MAX   = 16                       // ... of payload lanes, i.e. parallelism degree
     HEADER_TAG  = 0x01                     // domain separation constant for header
     PAYLOAD_TAG = 0x02                     // ... for payload
     TRAILER_TAG = 0x04                     // ... for trailer
     FINAL_TAG   = 0x08                     // ... for finalisation
     BRANCH_TAG  = 0x10                     // ... for branching
     MERGE_TAG   = 0x20                     // ... for merging
+    HASH_TAG    = 0x40                     // ... for hashing
+    DRBG_TAG    = 0x80                     // ... for random bit generation
     R0, R1, R2, R3 = 8, 19, 40, 63         // rotation offsets
+    R0_32, R1_32, R2_32, R3_32 = 8, 11, 16, 31 // ... for 32-bit words
 )
 
 type norx_state_t struct {
     s [WORDS_STATE]uint64
+    p *Params
+    phase Phase  // phase of the last traced block
+    block uint64 // number of traced blocks in phase
+}
+
+func load32(in []uint8) uint32 {
+    return binary.LittleEndian.Uint32(in)
+}
+
+func store32(out []uint8, in uint32) {
+    binary.LittleEndian.PutUint32(out, in)
 }
 
 func load64(in []uint8) uint64 {
@@ -43,6 +67,21 @@ func store64(out []uint8, in uint64) {
     binary.LittleEndian.PutUint64(out, in)
 }
 
+func load_word(state *norx_state_t, in []uint8) uint64 {
+    if state.p.W == 32 {
+        return uint64(load32(in))
+    }
+    return load64(in)
+}
+
+func store_word(state *norx_state_t, out []uint8, in uint64) {
+    if state.p.W == 32 {
+        store32(out, uint32(in))
+        return
+    }
+    store64(out, in)
+}
+
 func burn8(x []uint8, xlen uint64) {
     for i := uint64(0); i < xlen; i++ {
         x[i] = 0
@@ -88,37 +127,134 @@ func f(s []uint64) {
     s[ 3], s[ 4], s[ 9], s[14] = g(s[ 3], s[ 4], s[ 9], s[14])
 }
 
-func norx_permute(state *norx_state_t) {
+func rotr32(x,c uint32) uint32 {
+    return ((x >> c) | (x << (32 - c)))
+}
+
+func h32(x,y uint32) uint32 {
+    return (x ^ y) ^ ((x & y) << 1)
+}
+
+func g32(a,b,c,d uint32) (uint32,uint32,uint32,uint32) {
+    a = h32(a,b)
+    d = rotr32(a ^ d, R0_32)
+    c = h32(c,d)
+    b = rotr32(b ^ c, R1_32)
+    a = h32(a,b)
+    d = rotr32(a ^ d, R2_32)
+    c = h32(c,d)
+    b = rotr32(b ^ c, R3_32)
+    return a,b,c,d
+}
+
+func f32(s []uint32) {
+    // Column step
+    s[ 0], s[ 4], s[ 8], s[12] = g32(s[ 0], s[ 4], s[ 8], s[12])
+    s[ 1], s[ 5], s[ 9], s[13] = g32(s[ 1], s[ 5], s[ 9], s[13])
+    s[ 2], s[ 6], s[10], s[14] = g32(s[ 2], s[ 6], s[10], s[14])
+    s[ 3], s[ 7], s[11], s[15] = g32(s[ 3], s[ 7], s[11], s[15])
+    // Diagonal step
+    s[ 0], s[ 5], s[10], s[15] = g32(s[ 0], s[ 5], s[10], s[15])
+    s[ 1], s[ 6], s[11], s[12] = g32(s[ 1], s[ 6], s[11], s[12])
+    s[ 2], s[ 7], s[ 8], s[13] = g32(s[ 2], s[ 7], s[ 8], s[13])
+    s[ 3], s[ 4], s[ 9], s[14] = g32(s[ 3], s[ 4], s[ 9], s[14])
+}
+
+// norx_rounds applies r rounds of F to the state, using the 32-bit or 64-bit
+// round function depending on the word size of the state.
+func norx_rounds(state *norx_state_t, r uint64) {
+
+    if state.p.W == 32 {
+        var t [WORDS_STATE]uint32
+        for i := 0; i < WORDS_STATE; i++ {
+            t[i] = uint32(state.s[i])
+        }
+        for i := uint64(0); i < r; i++ {
+            f32(t[:])
+        }
+        for i := 0; i < WORDS_STATE; i++ {
+            state.s[i] = uint64(t[i])
+        }
+        return
+    }
+
     var s = state.s[:]
-    for i := uint64(0); i < NORX_L; i++ {
+    for i := uint64(0); i < r; i++ {
         f(s)
     }
 }
 
-func norx_init(state *norx_state_t, key []uint8, nonce []uint8) {
+func norx_permute(state *norx_state_t) {
+    norx_rounds(state, state.p.L)
+}
+
+func norx_init(state *norx_state_t, p *Params, key []uint8, nonce []uint8) {
+
+    norx_init_key(state, p, key)
+    norx_init_nonce(state, key, nonce)
+}
+
+// norx_init_key computes the part of the initialisation that does not depend
+// on the nonce, which only overwrites the first words afterwards. Contexts
+// cache the result to initialise messages with a single permutation.
+func norx_init_key(state *norx_state_t, p *Params, key []uint8) {
+
+    state.p = p
 
     var s = state.s[:]
     for i := uint64(0); i < WORDS_STATE; i++ {
         s[i] = i
     }
 
-    f(s)
-    f(s)
+    norx_rounds(state, 2)
+
+    var b = p.bytes_word()
+
+    s[ 4] = load_word(state, key[0*b:1*b])
+    s[ 5] = load_word(state, key[1*b:2*b])
+    s[ 6] = load_word(state, key[2*b:3*b])
+    s[ 7] = load_word(state, key[3*b:4*b])
 
-    s[ 0] = load64(nonce[ 0: 8])
-    s[ 1] = load64(nonce[ 8:16])
+    s[12] ^= p.W
+    s[13] ^= p.L
+    s[14] ^= p.P
+    s[15] ^= p.T
+}
+
+func norx_init_nonce(state *norx_state_t, key []uint8, nonce []uint8) {
 
-    s[ 4] = load64(key[ 0: 8])
-    s[ 5] = load64(key[ 8:16])
-    s[ 6] = load64(key[16:24])
-    s[ 7] = load64(key[24:32])
+    var p = state.p
+    var s = state.s[:]
+    var b = p.bytes_word()
 
-    s[12] ^= NORX_W
-    s[13] ^= NORX_L
-    s[14] ^= NORX_P
-    s[15] ^= NORX_T
+    s[ 0] = load_word(state, nonce[0*b:1*b])
+    s[ 1] = load_word(state, nonce[1*b:2*b])
+
+    if p.V == V30 {
+        s[ 2] = load_word(state, nonce[2*b:3*b])
+        s[ 3] = load_word(state, nonce[3*b:4*b])
+    }
 
     norx_permute(state)
+
+    if p.V == V30 {
+        norx_add_key(state, key)
+    }
+
+    state.phase, state.block = TRACE_INIT, 0
+    norx_trace(state, TRACE_INIT)
+}
+
+// norx_add_key adds the key to the capacity words of the state (v3.0).
+func norx_add_key(state *norx_state_t, key []uint8) {
+
+    var s = state.s[:]
+    var b = state.p.bytes_word()
+
+    s[12] ^= load_word(state, key[0*b:1*b])
+    s[13] ^= load_word(state, key[1*b:2*b])
+    s[14] ^= load_word(state, key[2*b:3*b])
+    s[15] ^= load_word(state, key[3*b:4*b])
 }
 
 func norx_absorb_data(state *norx_state_t, in []uint8, inlen uint64, tag uint64) {
@@ -126,7 +262,7 @@ func norx_absorb_data(state *norx_state_t, in []uint8, inlen uint64, tag uint64)
     if inlen > 0 {
 
         var i uint64 = 0
-        const n uint64 = BYTES_RATE
+        var n = state.p.bytes_rate()
 
         for i = 0; inlen >= n; inlen, i = inlen-n, i+1 {
             norx_absorb_block(state, in[n*i:n*(i+1)], tag)
@@ -140,7 +276,7 @@ func norx_encrypt_data(state *norx_state_t, out []uint8, in []uint8, inlen uint6
     if inlen > 0 {
 
         var i uint64 = 0
-        const n uint64 = BYTES_RATE
+        var n = state.p.bytes_rate()
 
         for i = 0; inlen >= n; inlen, i = inlen-n, i+1 {
             norx_encrypt_block(state, out[n*i:n*(i+1)], in[n*i:n*(i+1)])
@@ -154,7 +290,7 @@ func norx_decrypt_data(state *norx_state_t, out []uint8, in []uint8, inlen uint6
     if inlen > 0 {
 
         var i uint64 = 0
-        const n uint64 = BYTES_RATE
+        var n = state.p.bytes_rate()
 
         for i = 0; inlen >= n; inlen, i = inlen-n, i+1 {
             norx_decrypt_block(state, out[n*i:n*(i+1)], in[n*i:n*(i+1)])
@@ -163,38 +299,53 @@ func norx_decrypt_data(state *norx_state_t, out []uint8, in []uint8, inlen uint6
     }
 }
 
-func norx_output_tag(state *norx_state_t, tag []uint8) {
+// norx_output_tag finalises the state. In v2.0 the tag is taken from the
+// rate, in v3.0 the key is added around the second permutation and the tag is
+// taken from the capacity.
+func norx_output_tag(state *norx_state_t, tag []uint8, key []uint8) {
 
     state.s[15] ^= FINAL_TAG
     norx_permute(state)
-    norx_permute(state)
 
     var s = state.s[:]
     var lastblock [BYTES_RATE]uint8
-    const b uint64 = BYTES_WORD
+    var b = state.p.bytes_word()
 
-    for i := uint64(0); i < WORDS_RATE; i++ {
-        store64(lastblock[b*i:b*(i+1)], s[i])
+    if state.p.V == V30 {
+        norx_add_key(state, key)
+        norx_permute(state)
+        norx_add_key(state, key)
+
+        for i := uint64(0); i < 4; i++ {
+            store_word(state, lastblock[b*i:b*(i+1)], s[12+i])
+        }
+    } else {
+        norx_permute(state)
+
+        for i := uint64(0); i < WORDS_RATE; i++ {
+            store_word(state, lastblock[b*i:b*(i+1)], s[i])
+        }
     }
-    copy(tag[:], lastblock[:])
+    norx_trace(state, TRACE_FINAL)
+    copy(tag[:state.p.TagSize()], lastblock[:])
     burn8(lastblock[:], BYTES_RATE)
 }
 
-func norx_verify_tag(tag1 []uint8, tag2 []uint8) int {
+func norx_verify_tag(tag1 []uint8, tag2 []uint8, taglen uint64) int {
 
     var acc int = 0
 
-    for i := uint64(0); i < BYTES_TAG; i++ {
+    for i := uint64(0); i < taglen; i++ {
         acc |= int(tag1[i] ^ tag2[i])
     }
     return (((acc - 1) >> 8) & 1) - 1
 }
 
-func norx_pad(out []uint8, in []uint8, inlen uint64) {
+func norx_pad(out []uint8, in []uint8, inlen uint64, outlen uint64) {
 
     copy(out[:],in[:inlen])
     out[inlen] = 0x01
-    out[BYTES_RATE - 1] |= 0x80
+    out[outlen - 1] |= 0x80
 }
 
 func norx_absorb_block(state *norx_state_t, in []uint8, tag uint64) {
@@ -203,18 +354,20 @@ func norx_absorb_block(state *norx_state_t, in []uint8, tag uint64) {
     norx_permute(state)
 
     var s = state.s[:]
-    const b uint64 = BYTES_WORD
+    var b = state.p.bytes_word()
 
     for i := uint64(0); i < WORDS_RATE; i++ {
-        s[i] ^= load64(in[b*i:b*(i+1)])
+        s[i] ^= load_word(state, in[b*i:b*(i+1)])
     }
+    norx_trace(state, Phase(tag))
 }
 
 func norx_absorb_lastblock(state *norx_state_t, in []uint8, inlen uint64, tag uint64) {
 
     var lastblock [BYTES_RATE]uint8
-    norx_pad(lastblock[:], in[:], inlen)
-    norx_absorb_block(state, lastblock[:], tag)
+    var n = state.p.bytes_rate()
+    norx_pad(lastblock[:n], in[:], inlen, n)
+    norx_absorb_block(state, lastblock[:n], tag)
     burn8(lastblock[:], BYTES_RATE)
 }
 
@@ -224,20 +377,22 @@ func norx_encrypt_block(state *norx_state_t, out []uint8, in []uint8) {
     norx_permute(state)
 
     var s = state.s[:]
-    const b uint64 = BYTES_WORD
+    var b = state.p.bytes_word()
 
     for i := uint64(0); i < WORDS_RATE; i++ {
-        s[i] ^= load64(in[b*i:b*(i+1)])
-        store64(out[b*i:b*(i+1)], s[i])
+        s[i] ^= load_word(state, in[b*i:b*(i+1)])
+        store_word(state, out[b*i:b*(i+1)], s[i])
     }
+    norx_trace(state, TRACE_PAYLOAD)
 }
 
 func encrypt_lastblock(state *norx_state_t, out []uint8, in []uint8, inlen uint64) {
 
     var lastblock [BYTES_RATE]uint8
-    norx_pad(lastblock[:], in[:], inlen)
-    norx_encrypt_block(state, lastblock[:], lastblock[:])
-    copy(out[:], lastblock[:])
+    var n = state.p.bytes_rate()
+    norx_pad(lastblock[:n], in[:], inlen, n)
+    norx_encrypt_block(state, lastblock[:n], lastblock[:n])
+    copy(out[:], lastblock[:inlen])
     burn8(lastblock[:], BYTES_RATE)
 }
 
@@ -247,13 +402,14 @@ func norx_decrypt_block(state *norx_state_t, out []uint8, in []uint8) {
     norx_permute(state)
 
     var s = state.s[:]
-    const b uint64 = BYTES_WORD
+    var b = state.p.bytes_word()
 
     for i := uint64(0); i < WORDS_RATE; i++ {
-        c := load64(in[b*i:b*(i+1)])
-        store64(out[b*i:b*(i+1)], s[i] ^ c)
+        c := load_word(state, in[b*i:b*(i+1)])
+        store_word(state, out[b*i:b*(i+1)], s[i] ^ c)
         s[i] = c
     }
+    norx_trace(state, TRACE_PAYLOAD)
 }
 
 func norx_decrypt_lastblock(state *norx_state_t, out []uint8, in []uint8, inlen uint64) {
@@ -262,67 +418,140 @@ func norx_decrypt_lastblock(state *norx_state_t, out []uint8, in []uint8, inlen
     norx_permute(state)
 
     var s = state.s[:]
-    const n uint64 = BYTES_WORD
+    var b = state.p.bytes_word()
+    var n = state.p.bytes_rate()
     var lastblock [BYTES_RATE]uint8
 
     for i := uint64(0); i < WORDS_RATE; i++ {
-        store64(lastblock[n*i:n*(i+1)],s[i])
+        store_word(state, lastblock[b*i:b*(i+1)],s[i])
     }
     copy(lastblock[:],in[:inlen])
     lastblock[inlen] ^= 0x01
-    lastblock[BYTES_RATE - 1] ^= 0x80
+    lastblock[n - 1] ^= 0x80
 
     for i := uint64(0); i < WORDS_RATE; i++ {
-        c := load64(lastblock[n*i:n*(i+1)])
-        store64(lastblock[n*i:n*(i+1)], s[i] ^ c)
+        c := load_word(state, lastblock[b*i:b*(i+1)])
+        store_word(state, lastblock[b*i:b*(i+1)], s[i] ^ c)
         s[i] = c
     }
+    norx_trace(state, TRACE_PAYLOAD)
     copy(out[:inlen],lastblock[:])
     burn8(lastblock[:],BYTES_RATE)
 }
 
+// AEAD_encrypt encrypts with NORX6441, see Params.AEAD_encrypt.
 func AEAD_encrypt(
     c []uint8, clen *uint64,
     a []uint8, alen uint64,
     m []uint8, mlen uint64,
     z []uint8, zlen uint64,
     nonce []uint8,
-    key []uint8) {
+    key []uint8) error {
+
+    return norx6441.AEAD_encrypt(c, clen, a, alen, m, mlen, z, zlen, nonce, key)
+}
+
+// AEAD_decrypt decrypts with NORX6441, see Params.AEAD_decrypt.
+func AEAD_decrypt(
+    m []uint8, mlen *uint64,
+    a []uint8, alen uint64,
+    c []uint8, clen uint64,
+    z []uint8, zlen uint64,
+    nonce []uint8,
+    key []uint8) error {
+
+    return norx6441.AEAD_decrypt(m, mlen, a, alen, c, clen, z, zlen, nonce, key)
+}
+
+// norx_check_args validates the parameters, key and nonce as well as the
+// lengths of header and trailer against their buffers.
+func norx_check_args(p *Params, a []uint8, alen uint64, z []uint8, zlen uint64, nonce []uint8, key []uint8) error {
+
+    if err := p.Valid(); err != nil {
+        return err
+    }
+    if len(key) != p.KeySize() {
+        return ErrKeySize
+    }
+    if len(nonce) != p.NonceSize() {
+        return ErrNonceSize
+    }
+    if uint64(len(a)) < alen || uint64(len(z)) < zlen {
+        return ErrBufferTooSmall
+    }
+    return nil
+}
+
+// AEAD_encrypt writes the encryption of m followed by the tag to c, which has
+// to hold at least mlen + TagSize() bytes, and sets clen accordingly. c and m
+// may be the same buffer, any other overlap gives undefined results.
+func (p *Params) AEAD_encrypt(
+    c []uint8, clen *uint64,
+    a []uint8, alen uint64,
+    m []uint8, mlen uint64,
+    z []uint8, zlen uint64,
+    nonce []uint8,
+    key []uint8) error {
+
+    if err := norx_check_args(p, a, alen, z, zlen, nonce, key); err != nil {
+        return err
+    }
+    var taglen = uint64(p.TagSize())
+    if uint64(len(m)) < mlen || uint64(len(c)) < mlen + taglen {
+        return ErrBufferTooSmall
+    }
 
     var state = new(norx_state_t)
-    norx_init(state, key, nonce)
+    norx_init(state, p, key, nonce)
     norx_absorb_data(state, a, alen, HEADER_TAG)
-    norx_encrypt_data(state, c, m, mlen)
+    norx_encrypt_payload(state, c, m, mlen)
     norx_absorb_data(state, z, zlen, TRAILER_TAG)
-    norx_output_tag(state, c[mlen:])
-    *clen = mlen + BYTES_TAG
+    norx_output_tag(state, c[mlen:], key)
+    *clen = mlen + taglen
     burn64(state.s[:], WORDS_STATE)
+    return nil
 }
 
-func AEAD_decrypt(
+// AEAD_decrypt writes the decryption of c, which ends with the tag, to m,
+// which has to hold at least clen - TagSize() bytes, and sets mlen
+// accordingly. If the tag does not verify, m is wiped and ErrAuth returned.
+// m and c may start at the same address, see AEAD_encrypt.
+func (p *Params) AEAD_decrypt(
     m []uint8, mlen *uint64,
     a []uint8, alen uint64,
     c []uint8, clen uint64,
     z []uint8, zlen uint64,
     nonce []uint8,
-    key []uint8) int {
+    key []uint8) error {
 
-    if clen < BYTES_TAG {
-      return -1
+    if err := norx_check_args(p, a, alen, z, zlen, nonce, key); err != nil {
+        return err
+    }
+    var taglen = uint64(p.TagSize())
+    if uint64(len(c)) < clen {
+        return ErrBufferTooSmall
     }
+    if clen < taglen {
+        return ErrShortCiphertext
+    }
+    if uint64(len(m)) < clen - taglen {
+        return ErrBufferTooSmall
+    }
+
     var result int = -1
-    var tag [BYTES_TAG]uint8
+    var tag [BYTES_TAG_MAX]uint8
     var state = new(norx_state_t)
-    norx_init(state, key, nonce)
+    norx_init(state, p, key, nonce)
     norx_absorb_data(state, a, alen, HEADER_TAG)
-    norx_decrypt_data(state, m, c, clen - BYTES_TAG)
+    norx_decrypt_payload(state, m, c, clen - taglen)
     norx_absorb_data(state, z, zlen, TRAILER_TAG)
-    norx_output_tag(state, tag[:])
-    *mlen = clen - BYTES_TAG
-    result = norx_verify_tag(c[clen - BYTES_TAG:], tag[:])
+    norx_output_tag(state, tag[:], key)
+    *mlen = clen - taglen
+    result = norx_verify_tag(c[clen - taglen:], tag[:], taglen)
+    burn64(state.s[:], WORDS_STATE)
     if result != 0 {
-        burn8(m[:], clen - BYTES_TAG)
+        burn8(m[:], clen - taglen)
+        return ErrAuth
     }
-    burn64(state.s[:], WORDS_STATE)
-    return result
+    return nil
 }
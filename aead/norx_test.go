@@ -0,0 +1,359 @@
+/*
+    norx_test.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead_test
+
+import norx "github.com/daeinar/norx-go/aead"
+import utils "github.com/daeinar/norx-go/utils"
+
+import "bytes"
+import "fmt"
+import "sort"
+import "strings"
+import "testing"
+
+// inputs returns the key, nonce, header and message patterns of the KATs,
+// extended to cover messages of several blocks.
+func inputs(p *norx.Params) (k, n, h, w []uint8) {
+
+    h, w, _, k, n = utils.Inputs(p, 512)
+    return
+}
+
+func name(p *norx.Params) string {
+    return fmt.Sprintf("%s_%s", p, p.Version())
+}
+
+func TestKAT(t *testing.T) {
+
+    for _, p := range utils.Variants() {
+        p := p
+        t.Run(name(p), func(t *testing.T) {
+            if want := utils.Reference(p); want != nil {
+                k, n, a, m, z := utils.Example(p)
+                var clen uint64
+                c := make([]uint8, len(want))
+                if err := p.AEAD_encrypt(c, &clen, a, uint64(len(a)), m, uint64(len(m)), z, uint64(len(z)), n, k); err != nil || !bytes.Equal(c, want) {
+                    t.Fatalf("reference: got %x, want %x", c, want)
+                }
+            }
+
+            k, n, h, w := inputs(p)
+            getkat := utils.KAT(p)
+
+            var kat uint64 = 0
+            for i := uint64(0); i < 256; i++ {
+                var clen, mlen uint64
+                c := make([]uint8, i + uint64(p.TagSize()))
+                if err := p.AEAD_encrypt(c, &clen, h, i, w, i, nil, 0, n, k); err != nil {
+                    t.Fatalf("encrypt %d: %v", i, err)
+                }
+                if want := getkat(kat, kat + clen); !bytes.Equal(c, want) {
+                    t.Fatalf("KAT %d: got %x, want %x", i, c, want)
+                }
+                kat += clen
+
+                m := make([]uint8, i)
+                if err := p.AEAD_decrypt(m, &mlen, h, i, c, clen, nil, 0, n, k); err != nil || mlen != i || !bytes.Equal(m, w[:i]) {
+                    t.Fatalf("decrypt %d: %v", i, err)
+                }
+            }
+        })
+    }
+}
+
+func TestRoundTrip(t *testing.T) {
+
+    for _, p := range utils.Variants() {
+        p := p
+        t.Run(name(p), func(t *testing.T) {
+            k, n, h, w := inputs(p)
+
+            r := p.RateSize()
+            _, _, z, _, _ := utils.Inputs(p, 3 * r)
+
+            // Header, payload and trailer are padded and processed on their
+            // own and only meet in the state between the phases. So every
+            // length from 0 to 2r + 1 is swept in one of them while the
+            // others take the lengths around the block boundaries.
+            var all []int
+            for i := 0; i <= 2*r + 1; i++ {
+                all = append(all, i)
+            }
+            edges := []int{0, 1, r - 1, r, r + 1, 2*r + 3, 3 * r}
+
+            for _, sweep := range [][3][]int{{all, edges, edges}, {edges, all, edges}, {edges, edges, all}} {
+                for _, alen := range sweep[0] {
+                    for _, mlen := range sweep[1] {
+                        for _, zlen := range sweep[2] {
+                            var clen, olen uint64
+                            c := make([]uint8, mlen + p.TagSize())
+                            m := make([]uint8, mlen)
+                            if err := p.AEAD_encrypt(c, &clen, h, uint64(alen), w, uint64(mlen), z, uint64(zlen), n, k); err != nil {
+                                t.Fatalf("encrypt %d/%d/%d: %v", alen, mlen, zlen, err)
+                            }
+                            if err := p.AEAD_decrypt(m, &olen, h, uint64(alen), c, clen, z, uint64(zlen), n, k); err != nil {
+                                t.Fatalf("decrypt %d/%d/%d: %v", alen, mlen, zlen, err)
+                            }
+                            if !bytes.Equal(m, w[:mlen]) {
+                                t.Fatalf("round trip %d/%d/%d: got %x", alen, mlen, zlen, m)
+                            }
+                        }
+                    }
+                }
+            }
+        })
+    }
+}
+
+func TestForgery(t *testing.T) {
+
+    type input struct {
+        a, c, z, n, k []uint8
+        taglen        int
+    }
+
+    var flips = []struct {
+        name string
+        flip func(x *input)
+    }{
+        {"ciphertext", func(x *input) { x.c[0] ^= 0x01 }},
+        {"ciphertext last", func(x *input) { x.c[len(x.c) - x.taglen - 1] ^= 0x80 }},
+        {"tag", func(x *input) { x.c[len(x.c) - 1] ^= 0x01 }},
+        {"header", func(x *input) { x.a[len(x.a) / 2] ^= 0x01 }},
+        {"trailer", func(x *input) { x.z[len(x.z) - 1] ^= 0x01 }},
+        {"nonce", func(x *input) { x.n[len(x.n) - 1] ^= 0x01 }},
+        {"key", func(x *input) { x.k[0] ^= 0x01 }},
+    }
+
+    for _, p := range utils.Variants() {
+        for _, f := range flips {
+            p, f := p, f
+            t.Run(name(p) + "/" + f.name, func(t *testing.T) {
+                k, n, h, w := inputs(p)
+                var clen, mlen uint64
+
+                c := make([]uint8, len(w) + p.TagSize())
+                if err := p.AEAD_encrypt(c, &clen, h, 100, w, uint64(len(w)), h[100:], 50, n, k); err != nil {
+                    t.Fatal(err)
+                }
+
+                x := &input{
+                    a: append([]uint8{}, h[:100]...),
+                    c: c,
+                    z: append([]uint8{}, h[100:150]...),
+                    n: n,
+                    k: k,
+                    taglen: p.TagSize(),
+                }
+                f.flip(x)
+
+                m := make([]uint8, len(w))
+                for i := range m { m[i] = 0xff }
+                err := p.AEAD_decrypt(m, &mlen, x.a, uint64(len(x.a)), x.c, clen, x.z, uint64(len(x.z)), x.n, x.k)
+                if err != norx.ErrAuth {
+                    t.Fatalf("got %v, want ErrAuth", err)
+                }
+                if !bytes.Equal(m, make([]uint8, len(m))) {
+                    t.Fatal("plaintext not wiped")
+                }
+            })
+        }
+    }
+}
+
+func TestErrors(t *testing.T) {
+
+    p := &norx.NORX6441_V30
+    k, n, h, w := inputs(p)
+    c := make([]uint8, len(w) + p.TagSize())
+    m := make([]uint8, len(w))
+    var clen, mlen uint64
+
+    var cases = []struct {
+        name string
+        want error
+        err  error
+    }{
+        {"key size", norx.ErrKeySize, p.AEAD_encrypt(c, &clen, h, 0, w, 0, nil, 0, n, k[1:])},
+        {"nonce size", norx.ErrNonceSize, p.AEAD_encrypt(c, &clen, h, 0, w, 0, nil, 0, n[1:], k)},
+        {"short output", norx.ErrBufferTooSmall, p.AEAD_encrypt(c[:10], &clen, h, 0, w, 10, nil, 0, n, k)},
+        {"header length", norx.ErrBufferTooSmall, p.AEAD_encrypt(c, &clen, h, 1000, w, 0, nil, 0, n, k)},
+        {"short ciphertext", norx.ErrShortCiphertext, p.AEAD_decrypt(m, &mlen, h, 0, c, uint64(p.TagSize() - 1), nil, 0, n, k)},
+        {"short plaintext", norx.ErrBufferTooSmall, p.AEAD_decrypt(m[:1], &mlen, h, 0, c, uint64(len(c)), nil, 0, n, k)},
+        {"params", norx.ErrParams, (&norx.Params{W: 16, L: 4, P: 1, T: 64, V: norx.V30}).AEAD_encrypt(c, &clen, h, 0, w, 0, nil, 0, n, k)},
+        {"rounds", norx.ErrParams, (&norx.Params{W: 64, L: norx.ROUNDS_MAX + 1, P: 1, T: 256, V: norx.V30}).AEAD_encrypt(c, &clen, h, 0, w, 0, nil, 0, n, k)},
+        {"lanes", norx.ErrParams, (&norx.Params{W: 64, L: 4, P: 1 << 50, T: 256, V: norx.V30}).AEAD_encrypt(c, &clen, h, 0, w, uint64(len(w)), nil, 0, n, k)},
+    }
+
+    for _, x := range cases {
+        if x.err != x.want {
+            t.Errorf("%s: got %v, want %v", x.name, x.err, x.want)
+        }
+    }
+}
+
+// TestCheck runs the self-tests of the check command, which cover streams and
+// their io wrappers, the chunked format, batches, hash and XOF, MAC, DRBG,
+// SIV, key wrapping, contexts and the LWC format next to the KATs.
+func TestCheck(t *testing.T) {
+
+    if err := utils.Check(); err != nil {
+        t.Fatal(err)
+    }
+}
+
+// TestErrorCases runs the failure paths of the public entry points that the
+// check command covers.
+func TestErrorCases(t *testing.T) {
+
+    for _, x := range utils.ErrorCases() {
+        if err := x.Run(); err != x.Want {
+            t.Errorf("%s: got %v, want %v", x.Name, err, x.Want)
+        }
+    }
+}
+
+// TestAllocs checks that AEAD_encrypt and a Context, used in place, do not
+// allocate for payloads below BYTES_PARALLEL.
+func TestAllocs(t *testing.T) {
+
+    for _, p := range utils.Variants() {
+        p := p
+        t.Run(name(p), func(t *testing.T) {
+            k, n, h, w := inputs(p)
+            x, err := norx.NewContext(*p, k)
+            if err != nil {
+                t.Fatal(err)
+            }
+            var clen uint64
+            c := make([]uint8, len(w) + p.TagSize())
+            b := make([]uint8, len(w) + p.TagSize())
+
+            // AllocsPerRun runs the function once before measuring, so the
+            // decryption sees the output of the encryption.
+            allocs := testing.AllocsPerRun(10, func() {
+                x.Reset(n)
+                x.Encrypt(b, h, b[:len(w)], nil)
+                x.Reset(n)
+                x.Decrypt(b, h, b, nil)
+                p.AEAD_encrypt(c, &clen, h, uint64(len(h)), w, uint64(len(w)), nil, 0, n, k)
+            })
+            if allocs != 0 {
+                t.Fatalf("%v allocations", allocs)
+            }
+        })
+    }
+}
+
+// trace_t records every traced state as "phase block state".
+type trace_t []string
+
+func (t *trace_t) Trace(phase norx.Phase, block uint64, state [norx.WORDS_STATE]uint64) {
+    *t = append(*t, fmt.Sprintf("%s %d %x", phase, block, state))
+}
+
+// steps returns the phases and block indices of the trace.
+func (t trace_t) steps() []string {
+
+    var steps []string
+    for _, x := range t {
+        f := strings.Fields(x)
+        steps = append(steps, f[0] + " " + f[1])
+    }
+    return steps
+}
+
+func TestTrace(t *testing.T) {
+
+    p := &norx.NORX6441_V30
+    k, n, h, w := inputs(p)
+    r := p.RateSize()
+    var clen uint64
+    want := make([]uint8, r + p.TagSize())
+    p.AEAD_encrypt(want, &clen, h, uint64(r), w, uint64(r), nil, 0, n, k)
+
+    var trace trace_t
+    q := p.WithTracer(&trace)
+
+    c := make([]uint8, r + p.TagSize())
+    q.AEAD_encrypt(c, &clen, h, uint64(r), w, uint64(r), nil, 0, n, k)
+    if !bytes.Equal(c, want) {
+        t.Fatal("tracing changed the ciphertext")
+    }
+
+    // Operations on other parameters do not reach the tracer.
+    p.AEAD_encrypt(c, &clen, h, uint64(r), w, uint64(r), nil, 0, n, k)
+
+    // A full header and message block are each followed by a padding block.
+    steps := []string{"init 0", "header 0", "header 1", "payload 0", "payload 1", "final 0"}
+    if fmt.Sprint(trace.steps()) != fmt.Sprint(steps) {
+        t.Fatalf("got %v, want %v", trace.steps(), steps)
+    }
+}
+
+// TestTraceStream checks that the Stream API, fed in chunks that leave
+// blocks open, traces the same states as AEAD_encrypt. Lanes are traced in
+// a different order, so the traces are compared sorted.
+func TestTraceStream(t *testing.T) {
+
+    for _, p := range []*norx.Params{&norx.NORX6441, &norx.NORX6441_V30, &norx.NORX3241_V30, &norx.NORX6444, &norx.NORX6440} {
+        for _, chunk := range []int{1, 7, 100} {
+            k, n, h, w := inputs(p)
+            r := p.RateSize()
+            alen, mlen, zlen := r + 5, 2*r + 7, r - 1
+
+            var want, got trace_t
+            var clen uint64
+            c := make([]uint8, mlen + p.TagSize())
+            q := p.WithTracer(&want)
+            q.AEAD_encrypt(c, &clen, h, uint64(alen), w, uint64(mlen), h[alen:], uint64(zlen), n, k)
+
+            x, err := norx.NewStreamEncrypter(p.WithTracer(&got), k, n)
+            if err != nil {
+                t.Fatal(err)
+            }
+            feed := func(in []uint8, f func([]uint8)) {
+                for len(in) > chunk {
+                    f(in[:chunk])
+                    in = in[chunk:]
+                }
+                f(in)
+            }
+            s := make([]uint8, mlen)
+            feed(h[:alen], func(b []uint8) { x.Header(b) })
+            o := s
+            feed(w[:mlen], func(b []uint8) { x.Payload(o, b); o = o[len(b):] })
+            feed(h[alen:alen + zlen], func(b []uint8) { x.Trailer(b) })
+            x.Finalize()
+
+            sort.Strings(want)
+            sort.Strings(got)
+            if fmt.Sprint(want) != fmt.Sprint(got) {
+                t.Errorf("%s/%d: got %v, want %v", name(p), chunk, got.steps(), want.steps())
+            }
+        }
+    }
+}
+
+// TestDebug runs the debug command for every variant.
+func TestDebug(t *testing.T) {
+
+    for _, p := range utils.Variants() {
+        var out bytes.Buffer
+        utils.Debug(&out, p)
+        if !bytes.HasSuffix(out.Bytes(), []uint8("verify: ok\n")) {
+            t.Errorf("%s: %s", name(p), out.Bytes()[out.Len() - 64:])
+        }
+        if !bytes.Contains(out.Bytes(), []uint8("State after final:")) {
+            t.Errorf("%s: no trace", name(p))
+        }
+    }
+}
@@ -0,0 +1,321 @@
+/*
+    parallel.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "runtime"
+import "sync"
+
+// Payloads of at least BYTES_PARALLEL bytes have their lanes processed on
+// separate goroutines when NORX_P != 1.
+const BYTES_PARALLEL = 1 << 16
+
+// The lanes call the block functions directly, selected by a flag, since
+// calls through function values let the lane states escape to the heap. The
+// index i of the block in the payload is passed on to the tracer.
+func norx_payload_block(state *norx_state_t, i uint64, out []uint8, in []uint8, decrypt bool) {
+
+    state.phase, state.block = TRACE_PAYLOAD, i
+    if decrypt {
+        norx_decrypt_block(state, out, in)
+    } else {
+        norx_encrypt_block(state, out, in)
+    }
+}
+
+func norx_payload_lastblock(state *norx_state_t, i uint64, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    state.phase, state.block = TRACE_PAYLOAD, i
+    if decrypt {
+        norx_decrypt_lastblock(state, out, in, inlen)
+    } else {
+        encrypt_lastblock(state, out, in, inlen)
+    }
+}
+
+func norx_branch(state *norx_state_t, lane uint64) {
+
+    state.s[15] ^= BRANCH_TAG
+    norx_permute(state)
+
+    var s = state.s[:]
+
+    // Inject lane ID
+    for i := uint64(0); i < WORDS_RATE; i++ {
+        s[i] ^= lane
+    }
+}
+
+// norx_merge permutes state1 and adds it to state.
+func norx_merge(state *norx_state_t, state1 *norx_state_t) {
+
+    state1.s[15] ^= MERGE_TAG
+    norx_permute(state1)
+
+    for i := 0; i < WORDS_STATE; i++ {
+        state.s[i] ^= state1.s[i]
+    }
+}
+
+// norx_encrypt_payload processes the payload according to the parallelism
+// degree. As in the C reference, an empty payload is neither branched nor
+// padded nor merged, for any P.
+func norx_encrypt_payload(state *norx_state_t, out []uint8, in []uint8, inlen uint64) {
+
+    if inlen == 0 {
+        return
+    }
+    switch state.p.P {
+    case 1:
+        norx_encrypt_data(state, out, in, inlen)
+    case 0:
+        norx_payload_tree(state, out, in, inlen, false)
+    default:
+        norx_payload_lanes(state, out, in, inlen, false)
+    }
+}
+
+func norx_decrypt_payload(state *norx_state_t, out []uint8, in []uint8, inlen uint64) {
+
+    if inlen == 0 {
+        return
+    }
+    switch state.p.P {
+    case 1:
+        norx_decrypt_data(state, out, in, inlen)
+    case 0:
+        norx_payload_tree(state, out, in, inlen, true)
+    default:
+        norx_payload_lanes(state, out, in, inlen, true)
+    }
+}
+
+// norx_payload_lanes distributes the payload blocks round-robin over P lanes.
+// The last, padded block always goes to the lane following the last full block.
+// Short payloads are processed lane after lane on the calling goroutine
+// without heap allocations, since the merged lanes are simply XORed. With
+// AVX2, four lanes are processed at once, which beats a goroutine per lane
+// unless there are CPUs for all P lanes.
+func norx_payload_lanes(state *norx_state_t, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var p = state.p.P
+    var sum = norx_state_t{p: state.p}
+
+    if norx_use4(state) && (inlen < BYTES_PARALLEL || uint64(runtime.GOMAXPROCS(0)) < p) {
+        norx_payload_lanes4(state, &sum, out, in, inlen, decrypt)
+    } else if inlen < BYTES_PARALLEL {
+        var lane norx_state_t
+        for j := uint64(0); j < p; j++ {
+            lane = *state
+            norx_lane(&lane, j, out, in, inlen, decrypt)
+            norx_merge(&sum, &lane)
+        }
+        burn64(lane.s[:], WORDS_STATE)
+    } else {
+        var lanes = make([]norx_state_t, p)
+        var wg sync.WaitGroup
+        for j := uint64(0); j < p; j++ {
+            lanes[j] = *state
+            wg.Add(1)
+            go func(lane *norx_state_t, j uint64) {
+                defer wg.Done()
+                norx_lane(lane, j, out, in, inlen, decrypt)
+            }(&lanes[j], j)
+        }
+        wg.Wait()
+        for j := uint64(0); j < p; j++ {
+            norx_merge(&sum, &lanes[j])
+            burn64(lanes[j].s[:], WORDS_STATE)
+        }
+    }
+
+    state.s = sum.s
+    burn64(sum.s[:], WORDS_STATE)
+}
+
+// norx_lane branches lane j off the state held in lane and processes every
+// P-th block starting at j.
+func norx_lane(lane *norx_state_t, j uint64, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var p = lane.p.P
+    var n = lane.p.bytes_rate()
+    var blocks = inlen / n
+    var i uint64
+
+    norx_branch(lane, j)
+    for i = j; i < blocks; i += p {
+        norx_payload_block(lane, i, out[n*i:n*(i+1)], in[n*i:n*(i+1)], decrypt)
+    }
+    if i == blocks {
+        norx_payload_lastblock(lane, i, out[n*i:inlen], in[n*i:inlen], inlen - n*i, decrypt)
+    }
+}
+
+// norx_payload_lanes4 processes the lanes in groups of four through permute4,
+// on a goroutine per group for long payloads.
+func norx_payload_lanes4(state *norx_state_t, sum *norx_state_t, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var p = state.p.P
+
+    if inlen < BYTES_PARALLEL || p <= 4 {
+        for j := uint64(0); j < p; j += 4 {
+            norx_lanes4(state, sum, j, out, in, inlen, decrypt)
+        }
+        return
+    }
+
+    var groups = (p + 3) / 4
+    var sums = make([]norx_state_t, groups)
+    var root = new(norx_state_t)
+    var wg sync.WaitGroup
+    *root = *state
+    for k := uint64(0); k < groups; k++ {
+        sums[k].p = state.p
+        wg.Add(1)
+        go func(j uint64, sum *norx_state_t) {
+            defer wg.Done()
+            norx_lanes4(root, sum, j, out, in, inlen, decrypt)
+        }(4*k, &sums[k])
+    }
+    wg.Wait()
+    burn64(root.s[:], WORDS_STATE)
+    for k := uint64(0); k < groups; k++ {
+        for i := 0; i < WORDS_STATE; i++ {
+            sum.s[i] ^= sums[k].s[i]
+        }
+        burn64(sums[k].s[:], WORDS_STATE)
+    }
+}
+
+// norx_lanes4 processes the lanes j0 to j0 + 3, as far as they exist, in
+// lockstep and merges them into sum. A lane without a block in the last
+// round is left unchanged until the merge.
+func norx_lanes4(state *norx_state_t, sum *norx_state_t, j0 uint64, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var p = state.p.P
+    var blocks = inlen / state.p.bytes_rate()
+    var x norx_state4_t
+    var lane, i [4]uint64
+    var lanes, active [4]bool
+
+    for j := uint64(0); j < 4; j++ {
+        lane[j] = j0 + j
+        lanes[j] = lane[j] < p
+    }
+    norx_load4(&x, state)
+    norx_branch4(&x, state.p, &lane)
+    for i = lane; ; {
+        var more = false
+        for j := 0; j < 4; j++ {
+            active[j] = lanes[j] && i[j] <= blocks
+            more = more || active[j]
+        }
+        if !more {
+            break
+        }
+        norx_payload4(&x, state.p, &i, &active, out, in, inlen, decrypt)
+        for j := 0; j < 4; j++ {
+            i[j] += p
+        }
+    }
+    norx_merge4(sum, &x, &lanes)
+    burn_state4(&x)
+}
+
+// norx_payload_tree implements unbounded parallelism: every payload block,
+// including the last padded one, is processed on a lane of its own.
+func norx_payload_tree(state *norx_state_t, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var n = state.p.bytes_rate()
+    var blocks = inlen / n
+    var sum = norx_state_t{p: state.p}
+
+    if inlen < BYTES_PARALLEL {
+        norx_tree_range(state, &sum, 0, blocks + 1, out, in, inlen, decrypt)
+    } else {
+        var workers = uint64(runtime.GOMAXPROCS(0))
+        var chunk = (blocks + workers) / workers
+        var sums = make([]norx_state_t, workers)
+        var root = new(norx_state_t)
+        var wg sync.WaitGroup
+        *root = *state
+        for w := uint64(0); w < workers; w++ {
+            lo, hi := w * chunk, (w + 1) * chunk
+            if hi > blocks + 1 {
+                hi = blocks + 1
+            }
+            sums[w].p = state.p
+            wg.Add(1)
+            go func(lo uint64, hi uint64, sum *norx_state_t) {
+                defer wg.Done()
+                norx_tree_range(root, sum, lo, hi, out, in, inlen, decrypt)
+            }(lo, hi, &sums[w])
+        }
+        wg.Wait()
+        for w := uint64(0); w < workers; w++ {
+            for i := 0; i < WORDS_STATE; i++ {
+                sum.s[i] ^= sums[w].s[i]
+            }
+            burn64(sums[w].s[:], WORDS_STATE)
+        }
+        burn64(root.s[:], WORDS_STATE)
+    }
+
+    state.s = sum.s
+    burn64(sum.s[:], WORDS_STATE)
+}
+
+// norx_tree_range processes the blocks lo to hi - 1 on lanes branched off
+// state and merges them into sum.
+func norx_tree_range(state *norx_state_t, sum *norx_state_t, lo uint64, hi uint64, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var n = state.p.bytes_rate()
+    var blocks = inlen / n
+    var lane norx_state_t
+    var i = lo
+
+    if norx_use4(state) {
+        i = norx_tree_range4(state, sum, lo, hi, out, in, inlen, decrypt)
+    }
+    for ; i < hi; i++ {
+        lane = *state
+        norx_branch(&lane, i)
+        if i < blocks {
+            norx_payload_block(&lane, i, out[n*i:n*(i+1)], in[n*i:n*(i+1)], decrypt)
+        } else {
+            norx_payload_lastblock(&lane, i, out[n*i:inlen], in[n*i:inlen], inlen - n*i, decrypt)
+        }
+        norx_merge(sum, &lane)
+    }
+    burn64(lane.s[:], WORDS_STATE)
+}
+
+// norx_tree_range4 processes the blocks from lo on four at a time, as long as
+// at least two of them are left, and returns the first block left over.
+func norx_tree_range4(state *norx_state_t, sum *norx_state_t, lo uint64, hi uint64, out []uint8, in []uint8, inlen uint64, decrypt bool) uint64 {
+
+    var x norx_state4_t
+    var lane [4]uint64
+    var active [4]bool
+    var i = lo
+
+    for ; i + 1 < hi; i += 4 {
+        for j := uint64(0); j < 4; j++ {
+            lane[j] = i + j
+            active[j] = lane[j] < hi
+        }
+        norx_load4(&x, state)
+        norx_branch4(&x, state.p, &lane)
+        norx_payload4(&x, state.p, &lane, &active, out, in, inlen, decrypt)
+        norx_merge4(sum, &x, &active)
+    }
+    burn_state4(&x)
+    return i
+}
@@ -0,0 +1,132 @@
+/*
+    parallel_test.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead_test
+
+import norx "github.com/daeinar/norx-go/aead"
+import utils "github.com/daeinar/norx-go/utils"
+
+import "bytes"
+import "fmt"
+import "math/rand"
+import "runtime"
+import "testing"
+
+// TestParallel compares payloads long enough to be spread over goroutines
+// with the Stream API, which processes the lanes one block after another.
+func TestParallel(t *testing.T) {
+
+    defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+    lengths := []int{norx.BYTES_PARALLEL, norx.BYTES_PARALLEL + 95, 3 * norx.BYTES_PARALLEL + 1}
+
+    for _, p := range []*norx.Params{&norx.NORX6442, &norx.NORX6444, &norx.NORX6440, &norx.NORX6444_V30} {
+        for _, mlen := range lengths {
+            p, mlen := p, mlen
+            t.Run(fmt.Sprintf("%s/%d", name(p), mlen), func(t *testing.T) {
+                h, m, _, k, n := utils.Inputs(p, mlen)
+
+                var clen, olen uint64
+                c := make([]uint8, mlen + p.TagSize())
+                if err := p.AEAD_encrypt(c, &clen, h, 100, m, uint64(mlen), h[100:], 50, n, k); err != nil {
+                    t.Fatal(err)
+                }
+
+                x, err := norx.NewStreamEncrypter(*p, k, n)
+                if err != nil {
+                    t.Fatal(err)
+                }
+                s := make([]uint8, mlen)
+                x.Header(h[:100])
+                x.Payload(s, m)
+                x.Trailer(h[100:150])
+                tag, err := x.Finalize()
+                if err != nil {
+                    t.Fatal(err)
+                }
+                if !bytes.Equal(c, append(s, tag...)) {
+                    t.Fatal("parallel encryption differs from the stream")
+                }
+
+                o := make([]uint8, mlen)
+                if err := p.AEAD_decrypt(o, &olen, h, 100, c, clen, h[100:], 50, n, k); err != nil || !bytes.Equal(o, m) {
+                    t.Fatalf("decrypt: %v", err)
+                }
+            })
+        }
+    }
+}
+
+// TestPermute4 compares the AVX2 permutation of four interleaved states with
+// the generic one.
+func TestPermute4(t *testing.T) {
+
+    if !norx.HasAVX2 {
+        t.Skip("no AVX2")
+    }
+    r := rand.New(rand.NewSource(1))
+    for k := 0; k < 100; k++ {
+        var x, y [norx.WORDS_STATE][4]uint64
+        for i := range x {
+            for j := range x[i] {
+                x[i][j] = r.Uint64()
+            }
+        }
+        y = x
+        norx.Permute4(&x, uint64(k % 17))
+        norx.Permute4Generic(&y, uint64(k % 17))
+        if x != y {
+            t.Fatalf("%d rounds: AVX2 permutation differs from the generic one", k % 17)
+        }
+    }
+}
+
+// TestBackends compares the lanes processed four at a time with the scalar
+// lanes, for short and long payloads and lane counts that are no multiple of 4.
+func TestBackends(t *testing.T) {
+
+    if !norx.HasAVX2 {
+        t.Skip("no AVX2")
+    }
+    defer norx.SetAVX2(norx.SetAVX2(true))
+    defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(2))
+
+    variants := []norx.Params{norx.NORX6442, norx.NORX6444, norx.NORX6440, norx.NORX6444_V30,
+        {W: 64, L: 4, P: 3, T: 256, V: norx.V30}, {W: 64, L: 6, P: 7, T: 128, V: norx.V30}}
+    lengths := []int{0, 1, 95, 96, 97, 300, 767, 768, 1000, norx.BYTES_PARALLEL + 95}
+
+    for _, p := range variants {
+        for _, mlen := range lengths {
+            p, mlen := p, mlen
+            t.Run(fmt.Sprintf("%s/%d", name(&p), mlen), func(t *testing.T) {
+                _, m, _, k, n := utils.Inputs(&p, mlen)
+
+                var c [2][]uint8
+                for b, on := range []bool{false, true} {
+                    var clen uint64
+                    norx.SetAVX2(on)
+                    c[b] = make([]uint8, mlen + p.TagSize())
+                    if err := p.AEAD_encrypt(c[b], &clen, nil, 0, m, uint64(mlen), nil, 0, n, k); err != nil {
+                        t.Fatal(err)
+                    }
+                }
+                if !bytes.Equal(c[0], c[1]) {
+                    t.Fatal("AVX2 lanes differ from the scalar lanes")
+                }
+
+                var olen uint64
+                o := make([]uint8, mlen)
+                if err := p.AEAD_decrypt(o, &olen, nil, 0, c[1], uint64(len(c[1])), nil, 0, n, k); err != nil || !bytes.Equal(o, m) {
+                    t.Fatalf("decrypt: %v", err)
+                }
+            })
+        }
+    }
+}
@@ -0,0 +1,143 @@
+/*
+    params.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "fmt"
+
+const (
+    V20 = 20 // NORX v2.0
+    V30 = 30 // NORX v3.0, final CAESAR round
+)
+
+// Params selects a member of the NORX family at runtime. A variant is named
+// NORXWLP after its word size, number of rounds and parallelism degree, where
+// P = 0 stands for unbounded parallelism.
+type Params struct {
+    W uint64 // wordsize
+    L uint64 // number of rounds
+    P uint64 // parallelism degree
+    T uint64 // tag size in bits
+    V uint64 // specification version, V20 or V30
+
+    tracer *tracer_box // set by WithTracer
+}
+
+// norx6441 is the variant of NewNORX, AEAD_encrypt and the hash functions.
+// They use this copy so that changes to the exported NORX6441 do not reach
+// them.
+var norx6441 = Params{W: 64, L: 4, P: 1, T: 256, V: V20}
+
+// The predefined variants. They are variables so that their addresses can be
+// taken, but they must not be modified: all code naming them, including the
+// KAT tables of the utils package, shares them for the whole process. Derive
+// other instances from a copy, e.g. with WithTagSize.
+var (
+    NORX6441 = norx6441
+    NORX6461 = Params{W: 64, L: 6, P: 1, T: 256, V: V20}
+    NORX3241 = Params{W: 32, L: 4, P: 1, T: 128, V: V20}
+    NORX3261 = Params{W: 32, L: 6, P: 1, T: 128, V: V20}
+    NORX6442 = Params{W: 64, L: 4, P: 2, T: 256, V: V20}
+    NORX6444 = Params{W: 64, L: 4, P: 4, T: 256, V: V20}
+    NORX6440 = Params{W: 64, L: 4, P: 0, T: 256, V: V20} // unbounded parallelism
+
+    NORX6441_V30 = Params{W: 64, L: 4, P: 1, T: 256, V: V30}
+    NORX6461_V30 = Params{W: 64, L: 6, P: 1, T: 256, V: V30}
+    NORX3241_V30 = Params{W: 32, L: 4, P: 1, T: 128, V: V30}
+    NORX3261_V30 = Params{W: 32, L: 6, P: 1, T: 128, V: V30}
+    NORX6444_V30 = Params{W: 64, L: 4, P: 4, T: 256, V: V30}
+)
+
+// String returns the name of the variant, followed by the tag size in bits
+// if it differs from the default of 4 words, e.g. "NORX6441-T64".
+func (p *Params) String() string {
+
+    if p.T != 4 * p.W {
+        return fmt.Sprintf("NORX%d%d%d-T%d", p.W, p.L, p.P, p.T)
+    }
+    return fmt.Sprintf("NORX%d%d%d", p.W, p.L, p.P)
+}
+
+// Version returns the specification version as a string such as "v3.0".
+func (p *Params) Version() string {
+    return fmt.Sprintf("v%d.%d", p.V / 10, p.V % 10)
+}
+
+// Valid reports whether the parameters describe a supported instance. L is
+// limited to ROUNDS_MAX and P to LANES_MAX, so that parameters from untrusted
+// sources cannot request unbounded work or memory.
+func (p *Params) Valid() error {
+
+    if p.W != 32 && p.W != 64 {
+        return ErrParams
+    }
+    if p.V != V20 && p.V != V30 {
+        return ErrParams
+    }
+    if p.L < 1 || p.L > ROUNDS_MAX || p.P > LANES_MAX {
+        return ErrParams
+    }
+    if p.T % 8 != 0 || p.T < 8 * BYTES_TAG_MIN || p.T > 8 * uint64(p.max_tag_size()) {
+        return ErrParams
+    }
+    return nil
+}
+
+// WithTagSize returns a copy of p with tags of size bytes, which lies between
+// BYTES_TAG_MIN and the rate (v2.0) or the capacity (v3.0). The tag size is
+// part of the initialisation, so a truncated tag is not a prefix of the tag
+// of the same message under a longer tag size.
+func (p *Params) WithTagSize(size int) (Params, error) {
+
+    var q = *p
+    q.T = uint64(8 * size)
+    if err := q.Valid(); err != nil {
+        return Params{}, err
+    }
+    return q, nil
+}
+
+func (p *Params) KeySize() int {
+    return int(4 * p.bytes_word())
+}
+
+func (p *Params) NonceSize() int {
+    if p.V == V30 {
+        return int(4 * p.bytes_word())
+    }
+    return int(2 * p.bytes_word())
+}
+
+func (p *Params) TagSize() int {
+    return int(p.T / 8)
+}
+
+// RateSize returns the number of bytes absorbed or encrypted per block.
+func (p *Params) RateSize() int {
+    return int(p.bytes_rate())
+}
+
+// max_tag_size is the longest tag the finalisation can output: the rate in
+// v2.0 and the capacity in v3.0.
+func (p *Params) max_tag_size() int {
+
+    if p.V == V30 {
+        return int(4 * p.bytes_word())
+    }
+    return int(p.bytes_rate())
+}
+
+func (p *Params) bytes_word() uint64 {
+    return p.W / 8
+}
+
+func (p *Params) bytes_rate() uint64 {
+    return WORDS_RATE * p.bytes_word()
+}
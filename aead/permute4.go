@@ -0,0 +1,177 @@
+/*
+    permute4.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+// norx_state4_t holds four 64-bit states interleaved: word i of state j is
+// s[i][j], so that word i of all four fills one 256-bit vector. The payload
+// lanes of P != 1 are independent of each other and run through permute4 four
+// at a time.
+type norx_state4_t [WORDS_STATE][4]uint64
+
+// use_avx2 selects the AVX2 implementation of permute4. It is set where the
+// CPU and the operating system support AVX2, and only cleared by tests.
+var use_avx2 = has_avx2
+
+// Backend names the implementation of the permutation used for the payload
+// of p: "avx2" for the 64-bit variants with P != 1, whose lanes are permuted
+// four at a time, if the CPU supports it, and "generic" otherwise.
+func (p *Params) Backend() string {
+
+    if use_avx2 && p.W == 64 && p.P != 1 {
+        return "avx2"
+    }
+    return "generic"
+}
+
+// permute4_generic applies r rounds of F to each of the four states.
+func permute4_generic(s *norx_state4_t, r uint64) {
+
+    var t [WORDS_STATE]uint64
+
+    for j := 0; j < 4; j++ {
+        for i := 0; i < WORDS_STATE; i++ {
+            t[i] = s[i][j]
+        }
+        for k := uint64(0); k < r; k++ {
+            f(t[:])
+        }
+        for i := 0; i < WORDS_STATE; i++ {
+            s[i][j] = t[i]
+        }
+    }
+    burn64(t[:], WORDS_STATE)
+}
+
+// norx_use4 reports whether the lanes branched off state run through
+// permute4. Only the 64-bit variants are interleaved, and traced states keep
+// to the scalar lanes, which report every block to the tracer.
+func norx_use4(state *norx_state_t) bool {
+    return use_avx2 && state.p.W == 64 && state.p.tracer == nil
+}
+
+// norx_load4 copies state into each of the four states of x.
+func norx_load4(x *norx_state4_t, state *norx_state_t) {
+
+    for i := 0; i < WORDS_STATE; i++ {
+        x[i] = [4]uint64{state.s[i], state.s[i], state.s[i], state.s[i]}
+    }
+}
+
+// norx_branch4 branches the states of x to the lanes given by lane.
+func norx_branch4(x *norx_state4_t, p *Params, lane *[4]uint64) {
+
+    for j := 0; j < 4; j++ {
+        x[15][j] ^= BRANCH_TAG
+    }
+    permute4(x, p.L)
+
+    // Inject lane ID
+    for i := 0; i < WORDS_RATE; i++ {
+        for j := 0; j < 4; j++ {
+            x[i][j] ^= lane[j]
+        }
+    }
+}
+
+// norx_payload4 processes block i[j] on state j of x for every j set in
+// active. The states not set in active are left unchanged.
+func norx_payload4(x *norx_state4_t, p *Params, i *[4]uint64, active *[4]bool, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var n = p.bytes_rate()
+    var blocks = inlen / n
+    var saved norx_state4_t
+
+    for j := 0; j < 4; j++ {
+        if active[j] {
+            x[15][j] ^= PAYLOAD_TAG
+        } else {
+            for k := 0; k < WORDS_STATE; k++ {
+                saved[k][j] = x[k][j]
+            }
+        }
+    }
+    permute4(x, p.L)
+
+    for j := 0; j < 4; j++ {
+        if !active[j] {
+            for k := 0; k < WORDS_STATE; k++ {
+                x[k][j] = saved[k][j]
+            }
+            continue
+        }
+        if i[j] < blocks {
+            norx_crypt4_block(x, j, out[n*i[j]:n*(i[j]+1)], in[n*i[j]:n*(i[j]+1)], decrypt)
+        } else {
+            norx_crypt4_lastblock(x, j, n, out[n*i[j]:inlen], in[n*i[j]:inlen], inlen - n*i[j], decrypt)
+        }
+    }
+    burn_state4(&saved)
+}
+
+// norx_merge4 permutes the states of x and adds those set in active to sum.
+func norx_merge4(sum *norx_state_t, x *norx_state4_t, active *[4]bool) {
+
+    for j := 0; j < 4; j++ {
+        x[15][j] ^= MERGE_TAG
+    }
+    permute4(x, sum.p.L)
+
+    for j := 0; j < 4; j++ {
+        if active[j] {
+            for i := 0; i < WORDS_STATE; i++ {
+                sum.s[i] ^= x[i][j]
+            }
+        }
+    }
+}
+
+// norx_crypt4_block en- or decrypts a full block with the rate of state j of
+// x, as norx_encrypt_block and norx_decrypt_block do after the permutation.
+func norx_crypt4_block(x *norx_state4_t, j int, out []uint8, in []uint8, decrypt bool) {
+
+    for i := 0; i < WORDS_RATE; i++ {
+        c := load64(in[8*i:8*(i+1)])
+        if decrypt {
+            store64(out[8*i:8*(i+1)], x[i][j] ^ c)
+            x[i][j] = c
+        } else {
+            x[i][j] ^= c
+            store64(out[8*i:8*(i+1)], x[i][j])
+        }
+    }
+}
+
+// norx_crypt4_lastblock en- or decrypts the last, padded block of n bytes,
+// see encrypt_lastblock and norx_decrypt_lastblock.
+func norx_crypt4_lastblock(x *norx_state4_t, j int, n uint64, out []uint8, in []uint8, inlen uint64, decrypt bool) {
+
+    var lastblock [BYTES_RATE]uint8
+
+    if decrypt {
+        for i := 0; i < WORDS_RATE; i++ {
+            store64(lastblock[8*i:8*(i+1)], x[i][j])
+        }
+        copy(lastblock[:], in[:inlen])
+        lastblock[inlen] ^= 0x01
+        lastblock[n - 1] ^= 0x80
+    } else {
+        norx_pad(lastblock[:n], in[:], inlen, n)
+    }
+    norx_crypt4_block(x, j, lastblock[:n], lastblock[:n], decrypt)
+    copy(out[:], lastblock[:inlen])
+    burn8(lastblock[:], BYTES_RATE)
+}
+
+func burn_state4(x *norx_state4_t) {
+    for i := 0; i < WORDS_STATE; i++ {
+        x[i] = [4]uint64{}
+    }
+}
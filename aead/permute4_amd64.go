@@ -0,0 +1,30 @@
+/*
+    permute4_amd64.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+
+//go:build amd64 && !purego
+
+package aead
+
+var has_avx2 = cpuid_avx2()
+
+//go:noescape
+func permute4_avx2(s *norx_state4_t, r uint64)
+
+func cpuid_avx2() bool
+
+func permute4(s *norx_state4_t, r uint64) {
+
+    if use_avx2 {
+        permute4_avx2(s, r)
+        return
+    }
+    permute4_generic(s, r)
+}
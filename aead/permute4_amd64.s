@@ -0,0 +1,130 @@
+/*
+    permute4_amd64.s
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// Four 64-bit states are interleaved, so that word i of all of them fills one
+// 256-bit vector at offset 32*i. Two independent applications of G run side
+// by side on Y0 .. Y3 and Y5 .. Y8 with the temporaries Y4 and Y9. Rotations
+// by whole bytes are byte shuffles with the masks in Y14 and Y15.
+
+DATA rot8<>+0x00(SB)/8, $0x0007060504030201
+DATA rot8<>+0x08(SB)/8, $0x080f0e0d0c0b0a09
+DATA rot8<>+0x10(SB)/8, $0x0007060504030201
+DATA rot8<>+0x18(SB)/8, $0x080f0e0d0c0b0a09
+GLOBL rot8<>(SB), RODATA|NOPTR, $32
+
+DATA rot40<>+0x00(SB)/8, $0x0403020100070605
+DATA rot40<>+0x08(SB)/8, $0x0c0b0a09080f0e0d
+DATA rot40<>+0x10(SB)/8, $0x0403020100070605
+DATA rot40<>+0x18(SB)/8, $0x0c0b0a09080f0e0d
+GLOBL rot40<>(SB), RODATA|NOPTR, $32
+
+// x = (x ^ y) ^ ((x & y) << 1)
+#define H(x, y, t) \
+    VPAND  y, x, t; \
+    VPADDQ t, t, t; \
+    VPXOR  y, x, x; \
+    VPXOR  t, x, x
+
+#define ROTR19(x, t) \
+    VPSRLQ $19, x, t; \
+    VPSLLQ $45, x, x; \
+    VPOR   t, x, x
+
+#define ROTR63(x, t) \
+    VPSRLQ $63, x, t; \
+    VPADDQ x, x, x; \
+    VPOR   t, x, x
+
+#define G2(a0, b0, c0, d0, a1, b1, c1, d1) \
+    VMOVDQU a0(DI), Y0; VMOVDQU b0(DI), Y1; VMOVDQU c0(DI), Y2; VMOVDQU d0(DI), Y3; \
+    VMOVDQU a1(DI), Y5; VMOVDQU b1(DI), Y6; VMOVDQU c1(DI), Y7; VMOVDQU d1(DI), Y8; \
+    H(Y0, Y1, Y4); H(Y5, Y6, Y9); \
+    VPXOR Y0, Y3, Y3; VPXOR Y5, Y8, Y8; \
+    VPSHUFB Y14, Y3, Y3; VPSHUFB Y14, Y8, Y8; \
+    H(Y2, Y3, Y4); H(Y7, Y8, Y9); \
+    VPXOR Y2, Y1, Y1; VPXOR Y7, Y6, Y6; \
+    ROTR19(Y1, Y4); ROTR19(Y6, Y9); \
+    H(Y0, Y1, Y4); H(Y5, Y6, Y9); \
+    VPXOR Y0, Y3, Y3; VPXOR Y5, Y8, Y8; \
+    VPSHUFB Y15, Y3, Y3; VPSHUFB Y15, Y8, Y8; \
+    H(Y2, Y3, Y4); H(Y7, Y8, Y9); \
+    VPXOR Y2, Y1, Y1; VPXOR Y7, Y6, Y6; \
+    ROTR63(Y1, Y4); ROTR63(Y6, Y9); \
+    VMOVDQU Y0, a0(DI); VMOVDQU Y1, b0(DI); VMOVDQU Y2, c0(DI); VMOVDQU Y3, d0(DI); \
+    VMOVDQU Y5, a1(DI); VMOVDQU Y6, b1(DI); VMOVDQU Y7, c1(DI); VMOVDQU Y8, d1(DI)
+
+// func permute4_avx2(s *norx_state4_t, r uint64)
+TEXT ·permute4_avx2(SB), NOSPLIT, $0-16
+    MOVQ s+0(FP), DI
+    MOVQ r+8(FP), CX
+
+    VMOVDQU rot8<>(SB), Y14
+    VMOVDQU rot40<>(SB), Y15
+
+    TESTQ CX, CX
+    JZ    done
+
+loop:
+    // Column step
+    G2(0, 128, 256, 384, 32, 160, 288, 416)
+    G2(64, 192, 320, 448, 96, 224, 352, 480)
+
+    // Diagonal step
+    G2(0, 160, 320, 480, 32, 192, 352, 384)
+    G2(64, 224, 256, 416, 96, 128, 288, 448)
+
+    DECQ CX
+    JNZ  loop
+
+done:
+    VZEROUPPER
+    RET
+
+// func cpuid_avx2() bool
+TEXT ·cpuid_avx2(SB), NOSPLIT, $0-1
+    // Highest basic leaf has to include leaf 7
+    MOVL $0, AX
+    CPUID
+    CMPL AX, $7
+    JB   no
+
+    // OSXSAVE and AVX
+    MOVL $1, AX
+    MOVL $0, CX
+    CPUID
+    ANDL $0x18000000, CX
+    CMPL CX, $0x18000000
+    JNE  no
+
+    // The OS saves the XMM and YMM registers
+    MOVL   $0, CX
+    XGETBV
+    ANDL   $6, AX
+    CMPL   AX, $6
+    JNE    no
+
+    // AVX2
+    MOVL $7, AX
+    MOVL $0, CX
+    CPUID
+    ANDL $0x20, BX
+    JZ   no
+
+    MOVB $1, ret+0(FP)
+    RET
+
+no:
+    MOVB $0, ret+0(FP)
+    RET
@@ -0,0 +1,20 @@
+/*
+    permute4_generic.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+
+//go:build !amd64 || purego
+
+package aead
+
+const has_avx2 = false
+
+func permute4(s *norx_state4_t, r uint64) {
+    permute4_generic(s, r)
+}
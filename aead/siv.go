@@ -0,0 +1,175 @@
+/*
+    siv.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "crypto/cipher"
+
+// The SIV mode is a synthetic-IV construction in the spirit of RFC 5297. The
+// tag is the MAC of
+//
+//     nonce || a || m || z || le64(len(nonce)) || le64(alen) || le64(mlen) || le64(zlen)
+//
+// with the tag size of p, and its first NonceSize() bytes serve as the nonce
+// of norx_init for encrypting m with norx_encrypt_data. Decryption recovers m
+// under the IV from the tag and recomputes the tag over it. Truncated tags,
+// shorter than the default of 4 words, are rejected, so the tag always covers
+// the IV.
+//
+// Reusing a nonce only reveals whether two calls had the same nonce, header,
+// message and trailer: equal inputs give equal ciphertexts, any other change
+// gives an unrelated IV. The price is a second pass over the message, which
+// is therefore not processed in parallel lanes. The key must not be used with
+// other modes, since MAC and SIV share its initialisation.
+//
+// Keystream is reused only if two different inputs give the same IV. After q
+// messages under one key this happens with a probability of about
+// q^2 / 2^(8*NonceSize() + 1), so the IV has to be at least BYTES_SIV_IV
+// bytes long, which excludes the 32-bit variants of v2.0 with their 8-byte
+// nonces. Even then a key should protect far fewer than 2^64 messages.
+
+const BYTES_SIV_IV = 16 // shortest synthetic IV accepted by the SIV mode
+
+// NewSIV returns the SIV mode of the variant p as a cipher.AEAD. The trailer
+// of the NORX scheme is not exposed and always empty.
+func NewSIV(p Params, key []uint8) (cipher.AEAD, error) {
+
+    if err := p.siv_valid(); err != nil {
+        return nil, err
+    }
+    if len(key) != p.KeySize() {
+        return nil, ErrKeySize
+    }
+    return new_aead(p, key, "NORX-SIV", (*Params).SIV_encrypt, (*Params).SIV_decrypt), nil
+}
+
+// SIV_encrypt is AEAD_encrypt in SIV mode. The nonce may have any length,
+// including zero, since it is only absorbed by the MAC. Variants with IVs
+// shorter than BYTES_SIV_IV or truncated tags fail with ErrParams.
+func (p *Params) SIV_encrypt(
+    c []uint8, clen *uint64,
+    a []uint8, alen uint64,
+    m []uint8, mlen uint64,
+    z []uint8, zlen uint64,
+    nonce []uint8,
+    key []uint8) error {
+
+    if err := norx_check_siv_args(p, a, alen, z, zlen, key); err != nil {
+        return err
+    }
+    var taglen = uint64(p.TagSize())
+    if uint64(len(m)) < mlen || uint64(len(c)) < mlen + taglen {
+        return ErrBufferTooSmall
+    }
+
+    var state = new(norx_state_t)
+    var iv [4 * BYTES_WORD]uint8
+    norx_siv_tag(p, c[mlen:mlen + taglen], a[:alen], m[:mlen], z[:zlen], nonce, key)
+    copy(iv[:p.NonceSize()], c[mlen:mlen + taglen])
+    norx_init(state, p, key, iv[:p.NonceSize()])
+    norx_encrypt_data(state, c, m, mlen)
+    *clen = mlen + taglen
+    burn64(state.s[:], WORDS_STATE)
+    return nil
+}
+
+// SIV_decrypt is AEAD_decrypt in SIV mode, see SIV_encrypt.
+func (p *Params) SIV_decrypt(
+    m []uint8, mlen *uint64,
+    a []uint8, alen uint64,
+    c []uint8, clen uint64,
+    z []uint8, zlen uint64,
+    nonce []uint8,
+    key []uint8) error {
+
+    if err := norx_check_siv_args(p, a, alen, z, zlen, key); err != nil {
+        return err
+    }
+    var taglen = uint64(p.TagSize())
+    if uint64(len(c)) < clen {
+        return ErrBufferTooSmall
+    }
+    if clen < taglen {
+        return ErrShortCiphertext
+    }
+    if uint64(len(m)) < clen - taglen {
+        return ErrBufferTooSmall
+    }
+
+    var result int = -1
+    var tag [BYTES_TAG_MAX]uint8
+    var state = new(norx_state_t)
+    var iv [4 * BYTES_WORD]uint8
+    copy(iv[:p.NonceSize()], c[clen - taglen:clen])
+    norx_init(state, p, key, iv[:p.NonceSize()])
+    norx_decrypt_data(state, m, c, clen - taglen)
+    norx_siv_tag(p, tag[:taglen], a[:alen], m[:clen - taglen], z[:zlen], nonce, key)
+    *mlen = clen - taglen
+    result = norx_verify_tag(c[clen - taglen:], tag[:], taglen)
+    burn64(state.s[:], WORDS_STATE)
+    burn8(tag[:], BYTES_TAG_MAX)
+    if result != 0 {
+        burn8(m[:], clen - taglen)
+        return ErrAuth
+    }
+    return nil
+}
+
+// siv_valid reports whether p is valid, has an IV of at least BYTES_SIV_IV
+// bytes and tags that are not truncated.
+func (p *Params) siv_valid() error {
+
+    if err := p.Valid(); err != nil {
+        return err
+    }
+    if p.NonceSize() < BYTES_SIV_IV || p.T < 4 * p.W {
+        return ErrParams
+    }
+    return nil
+}
+
+func norx_check_siv_args(p *Params, a []uint8, alen uint64, z []uint8, zlen uint64, key []uint8) error {
+
+    if err := p.siv_valid(); err != nil {
+        return err
+    }
+    if len(key) != p.KeySize() {
+        return ErrKeySize
+    }
+    if uint64(len(a)) < alen || uint64(len(z)) < zlen {
+        return ErrBufferTooSmall
+    }
+    return nil
+}
+
+func norx_siv_tag(p *Params, tag []uint8, a []uint8, m []uint8, z []uint8, nonce []uint8, key []uint8) {
+
+    var lengths [32]uint8
+    var x = MAC{p: *p}
+
+    copy(x.key[:], key)
+    x.Reset()
+
+    store64(lengths[0:8], uint64(len(nonce)))
+    store64(lengths[8:16], uint64(len(a)))
+    store64(lengths[16:24], uint64(len(m)))
+    store64(lengths[24:32], uint64(len(z)))
+
+    x.Write(nonce)
+    x.Write(a)
+    x.Write(m)
+    x.Write(z)
+    x.Write(lengths[:])
+    x.Sum(tag[:0])
+
+    burn64(x.state.s[:], WORDS_STATE)
+    burn8(x.buf[:], BYTES_RATE)
+    burn8(x.key[:], BYTES_KEY)
+}
@@ -0,0 +1,353 @@
+/*
+    stream.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+const (
+    phase_header  = iota
+    phase_payload
+    phase_trailer
+    phase_done
+)
+
+// Stream is an incremental NORX encryption or decryption. Header, payload
+// and trailer are fed in this order, each in as many chunks of arbitrary
+// size as needed, and the result is byte-identical to Params.AEAD_encrypt.
+//
+// Partial blocks are kept open in the state: the permutation of a block runs
+// when its first byte arrives, so payload output is never delayed.
+type Stream struct {
+    p       Params
+    key     [BYTES_KEY]uint8
+    decrypt bool
+    phase   int
+
+    state   norx_state_t   // main state
+    lanes   []norx_state_t // payload lanes for NORX_P > 1
+    lane    norx_state_t   // lane of the open payload block for NORX_P = 0
+    sum     norx_state_t   // merged lanes for NORX_P = 0
+    cur     *norx_state_t  // state of the open block
+
+    open    bool   // whether a block is open in cur
+    pos     uint64 // bytes processed in the open block
+    total   uint64 // bytes fed into the current phase
+    block   uint64 // index of the current payload block
+}
+
+// NewStreamEncrypter starts an incremental encryption.
+func NewStreamEncrypter(p Params, key []uint8, nonce []uint8) (*Stream, error) {
+    return new_stream(p, key, nonce, false)
+}
+
+// NewStreamDecrypter starts an incremental decryption.
+func NewStreamDecrypter(p Params, key []uint8, nonce []uint8) (*Stream, error) {
+    return new_stream(p, key, nonce, true)
+}
+
+func new_stream(p Params, key []uint8, nonce []uint8, decrypt bool) (*Stream, error) {
+
+    if err := p.Valid(); err != nil {
+        return nil, err
+    }
+    if len(key) != p.KeySize() {
+        return nil, ErrKeySize
+    }
+    if len(nonce) != p.NonceSize() {
+        return nil, ErrNonceSize
+    }
+    x := new(Stream)
+    x.p = p
+    x.decrypt = decrypt
+    copy(x.key[:], key)
+    norx_init(&x.state, &x.p, key, nonce)
+    return x, nil
+}
+
+// Header absorbs the next chunk of the header.
+func (x *Stream) Header(a []uint8) error {
+
+    if x.phase > phase_header {
+        return ErrPhase
+    }
+    x.feed(nil, a, HEADER_TAG)
+    return nil
+}
+
+// Payload encrypts or decrypts the next chunk of the payload from src into
+// dst, which has to hold at least len(src) bytes. dst and src may overlap
+// entirely or not at all.
+func (x *Stream) Payload(dst []uint8, src []uint8) error {
+
+    if x.phase > phase_payload {
+        return ErrPhase
+    }
+    if len(dst) < len(src) {
+        return ErrBufferTooSmall
+    }
+    x.enter(phase_payload)
+    x.feed(dst, src, PAYLOAD_TAG)
+    return nil
+}
+
+// Trailer absorbs the next chunk of the trailer.
+func (x *Stream) Trailer(z []uint8) error {
+
+    if x.phase > phase_trailer {
+        return ErrPhase
+    }
+    x.enter(phase_trailer)
+    x.feed(nil, z, TRAILER_TAG)
+    return nil
+}
+
+// Finalize completes an encryption and returns the tag.
+func (x *Stream) Finalize() ([]uint8, error) {
+
+    if x.decrypt || x.phase == phase_done {
+        return nil, ErrPhase
+    }
+    var tag = make([]uint8, x.p.TagSize())
+    x.finish(tag)
+    return tag, nil
+}
+
+// Verify completes a decryption and checks the tag in constant time.
+func (x *Stream) Verify(tag []uint8) error {
+
+    if !x.decrypt || x.phase == phase_done {
+        return ErrPhase
+    }
+    var taglen = uint64(x.p.TagSize())
+    var t [BYTES_TAG_MAX]uint8
+    x.finish(t[:])
+    var result = -1
+    if uint64(len(tag)) == taglen {
+        result = norx_verify_tag(tag, t[:], taglen)
+    }
+    burn8(t[:], BYTES_TAG_MAX)
+    if result != 0 {
+        return ErrAuth
+    }
+    return nil
+}
+
+func (x *Stream) finish(tag []uint8) {
+
+    x.enter(phase_done)
+    norx_output_tag(&x.state, tag, x.key[:])
+    burn64(x.state.s[:], WORDS_STATE)
+    burn8(x.key[:], BYTES_KEY)
+}
+
+// enter closes phases until phase is reached.
+func (x *Stream) enter(phase int) {
+
+    for x.phase < phase {
+        switch x.phase {
+        case phase_header:
+            x.close_data(HEADER_TAG)
+            x.begin_payload()
+        case phase_payload:
+            x.close_payload()
+        case phase_trailer:
+            x.close_data(TRAILER_TAG)
+        }
+        x.phase++
+        x.total = 0
+        x.open = false
+        x.pos = 0
+    }
+}
+
+// close_data pads the last block of the header or trailer. As in
+// norx_absorb_data, empty data is not absorbed at all.
+func (x *Stream) close_data(tag uint64) {
+
+    if x.total == 0 {
+        return
+    }
+    if !x.open {
+        x.open_block(&x.state, tag)
+    }
+    x.pad(tag)
+}
+
+func (x *Stream) begin_payload() {
+
+    switch x.p.P {
+    case 1:
+    case 0:
+        x.sum = norx_state_t{p: &x.p}
+    default:
+        x.lanes = make([]norx_state_t, x.p.P)
+        for j := uint64(0); j < x.p.P; j++ {
+            x.lanes[j] = x.state
+            norx_branch(&x.lanes[j], j)
+        }
+    }
+}
+
+// close_payload pads the last payload block and merges the lanes. As in
+// norx_encrypt_payload, an empty payload is not processed at all.
+func (x *Stream) close_payload() {
+
+    if x.total == 0 {
+        for j := range x.lanes {
+            burn64(x.lanes[j].s[:], WORDS_STATE)
+        }
+        return
+    }
+    if !x.open {
+        x.open_block(x.payload_state(), PAYLOAD_TAG)
+    }
+    x.pad(PAYLOAD_TAG)
+    x.end_payload_block()
+
+    switch x.p.P {
+    case 1:
+    case 0:
+        x.state.s = x.sum.s
+        burn64(x.sum.s[:], WORDS_STATE)
+    default:
+        burn64(x.state.s[:], WORDS_STATE)
+        for j := uint64(0); j < x.p.P; j++ {
+            norx_merge(&x.state, &x.lanes[j])
+            burn64(x.lanes[j].s[:], WORDS_STATE)
+        }
+    }
+}
+
+// payload_state returns the state processing the current payload block,
+// which is traced under its index in the payload like in AEAD_encrypt.
+func (x *Stream) payload_state() *norx_state_t {
+
+    var state = &x.state
+    switch x.p.P {
+    case 1:
+    case 0:
+        x.lane = x.state
+        norx_branch(&x.lane, x.block)
+        state = &x.lane
+    default:
+        state = &x.lanes[x.block % x.p.P]
+    }
+    state.phase, state.block = TRACE_PAYLOAD, x.block
+    return state
+}
+
+// end_payload_block is called once a payload block is complete.
+func (x *Stream) end_payload_block() {
+
+    if x.p.P == 0 {
+        norx_merge(&x.sum, &x.lane)
+        burn64(x.lane.s[:], WORDS_STATE)
+    }
+    x.block++
+}
+
+func (x *Stream) open_block(state *norx_state_t, tag uint64) {
+
+    state.s[15] ^= tag
+    norx_permute(state)
+    x.cur = state
+    x.open = true
+    x.pos = 0
+}
+
+// pad applies the padding of norx_pad to the open block, which completes it.
+func (x *Stream) pad(tag uint64) {
+
+    var n = x.p.bytes_rate()
+    xor_byte(x.cur, x.pos, 0x01)
+    xor_byte(x.cur, n - 1, 0x80)
+    norx_trace(x.cur, Phase(tag))
+    x.open = false
+    x.pos = 0
+}
+
+func (x *Stream) feed(dst []uint8, src []uint8, tag uint64) {
+
+    var n = x.p.bytes_rate()
+    x.total += uint64(len(src))
+
+    for len(src) > 0 {
+
+        if !x.open && uint64(len(src)) >= n {
+            // Fast path for whole blocks
+            switch {
+            case tag != PAYLOAD_TAG:
+                norx_absorb_block(&x.state, src[:n], tag)
+            case x.decrypt:
+                norx_decrypt_block(x.payload_state(), dst[:n], src[:n])
+                x.end_payload_block()
+            default:
+                norx_encrypt_block(x.payload_state(), dst[:n], src[:n])
+                x.end_payload_block()
+            }
+            src = src[n:]
+            if dst != nil {
+                dst = dst[n:]
+            }
+            continue
+        }
+
+        if !x.open {
+            if tag == PAYLOAD_TAG {
+                x.open_block(x.payload_state(), tag)
+            } else {
+                x.open_block(&x.state, tag)
+            }
+        }
+
+        var k = n - x.pos
+        if k > uint64(len(src)) {
+            k = uint64(len(src))
+        }
+        for i := uint64(0); i < k; i++ {
+            switch {
+            case tag != PAYLOAD_TAG:
+                xor_byte(x.cur, x.pos + i, src[i])
+            case x.decrypt:
+                c := src[i]
+                dst[i] = get_byte(x.cur, x.pos + i) ^ c
+                xor_byte(x.cur, x.pos + i, dst[i])
+            default:
+                xor_byte(x.cur, x.pos + i, src[i])
+                dst[i] = get_byte(x.cur, x.pos + i)
+            }
+        }
+        x.pos += k
+        src = src[k:]
+        if dst != nil {
+            dst = dst[k:]
+        }
+
+        if x.pos == n {
+            norx_trace(x.cur, Phase(tag))
+            x.open = false
+            x.pos = 0
+            if tag == PAYLOAD_TAG {
+                x.end_payload_block()
+            }
+        }
+    }
+}
+
+// get_byte returns byte i of the rate of the state.
+func get_byte(state *norx_state_t, i uint64) uint8 {
+    var b = state.p.bytes_word()
+    return uint8(state.s[i / b] >> (8 * (i % b)))
+}
+
+// xor_byte adds v to byte i of the rate of the state.
+func xor_byte(state *norx_state_t, i uint64, v uint8) {
+    var b = state.p.bytes_word()
+    state.s[i / b] ^= uint64(v) << (8 * (i % b))
+}
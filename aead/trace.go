@@ -0,0 +1,91 @@
+/*
+    trace.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+import "fmt"
+
+// Phase identifies the step after which the state is traced. Apart from
+// TRACE_INIT the phases equal the domain separation constants of the blocks.
+type Phase uint64
+
+const (
+    TRACE_INIT    Phase = 0
+    TRACE_HEADER  Phase = HEADER_TAG
+    TRACE_PAYLOAD Phase = PAYLOAD_TAG
+    TRACE_TRAILER Phase = TRAILER_TAG
+    TRACE_FINAL   Phase = FINAL_TAG
+    TRACE_HASH    Phase = HASH_TAG
+    TRACE_DRBG    Phase = DRBG_TAG
+)
+
+func (phase Phase) String() string {
+
+    switch phase {
+    case TRACE_INIT:
+        return "init"
+    case TRACE_HEADER:
+        return "header"
+    case TRACE_PAYLOAD:
+        return "payload"
+    case TRACE_TRAILER:
+        return "trailer"
+    case TRACE_FINAL:
+        return "final"
+    case TRACE_HASH:
+        return "hash"
+    case TRACE_DRBG:
+        return "drbg"
+    }
+    return fmt.Sprintf("phase(%#x)", uint64(phase))
+}
+
+// Tracer receives the state after the initialisation, after every absorbed,
+// encrypted or decrypted block and after the finalisation, like the debug
+// mode of the C reference. Blocks are numbered from 0 within each phase,
+// payload blocks of P != 1 by their position in the payload, whichever lane
+// processes them. Words of 32-bit variants occupy the low half.
+type Tracer interface {
+    Trace(phase Phase, block uint64, state [WORDS_STATE]uint64)
+}
+
+// tracer_box keeps Params comparable whatever the dynamic type of the tracer.
+type tracer_box struct {
+    t Tracer
+}
+
+// WithTracer returns a copy of p whose operations pass their states to t, or
+// trace nothing if t is nil. Only operations created from the copy are
+// traced, so the states of other operations, which hold key material in
+// v3.0, never reach t. t has to be safe for concurrent use if the copy is
+// used concurrently or for payloads of BYTES_PARALLEL bytes with P != 1.
+func (p *Params) WithTracer(t Tracer) Params {
+
+    var q = *p
+    q.tracer = nil
+    if t != nil {
+        q.tracer = &tracer_box{t}
+    }
+    return q
+}
+
+// norx_trace passes the state to the tracer of its parameters, if any, and
+// counts the blocks of phase.
+func norx_trace(state *norx_state_t, phase Phase) {
+
+    if state.p.tracer == nil {
+        return
+    }
+    if phase != state.phase {
+        state.phase, state.block = phase, 0
+    }
+    state.p.tracer.t.Trace(phase, state.block, state.s)
+    state.block++
+}
@@ -0,0 +1,61 @@
+/*
+    wrap.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead
+
+const (
+    BYTES_WRAP_MIN = 16 // shortest key accepted by Wrap
+    BYTES_WRAP_MAX = 64 // longest ...
+)
+
+// Wrap encrypts key under the key-encryption key kek with SIV_encrypt, an
+// empty nonce, header and trailer. The result is deterministic, so no nonce
+// needs to be stored, and is len(key) + p.TagSize() bytes long. Equal keys
+// wrap to equal outputs under the same kek. Variants rejected by NewSIV fail
+// with ErrParams.
+func Wrap(p Params, kek []uint8, key []uint8) ([]uint8, error) {
+
+    if err := p.siv_valid(); err != nil {
+        return nil, err
+    }
+    if len(key) < BYTES_WRAP_MIN || len(key) > BYTES_WRAP_MAX {
+        return nil, ErrWrapSize
+    }
+
+    var clen uint64 = 0
+    var c = make([]uint8, len(key) + p.TagSize())
+
+    if err := p.SIV_encrypt(c, &clen, nil, 0, key, uint64(len(key)), nil, 0, nil, kek); err != nil {
+        return nil, err
+    }
+    return c, nil
+}
+
+// Unwrap reverses Wrap. It fails with ErrWrapSize if wrapped cannot hold a
+// key of BYTES_WRAP_MIN to BYTES_WRAP_MAX bytes and with ErrAuth if it was
+// not produced by Wrap under kek.
+func Unwrap(p Params, kek []uint8, wrapped []uint8) ([]uint8, error) {
+
+    if err := p.siv_valid(); err != nil {
+        return nil, err
+    }
+    var n = len(wrapped) - p.TagSize()
+    if n < BYTES_WRAP_MIN || n > BYTES_WRAP_MAX {
+        return nil, ErrWrapSize
+    }
+
+    var mlen uint64 = 0
+    var m = make([]uint8, n)
+
+    if err := p.SIV_decrypt(m, &mlen, nil, 0, wrapped, uint64(len(wrapped)), nil, 0, nil, kek); err != nil {
+        return nil, err
+    }
+    return m, nil
+}
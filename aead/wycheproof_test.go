@@ -0,0 +1,65 @@
+/*
+    wycheproof_test.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+package aead_test
+
+import utils "github.com/daeinar/norx-go/utils"
+
+import "fmt"
+import "os"
+import "path/filepath"
+import "testing"
+
+// TestWycheproof runs every Wycheproof vector file in testdata/wycheproof.
+// The files are regenerated with norx-go genkat --format wycheproof.
+func TestWycheproof(t *testing.T) {
+
+    files, err := filepath.Glob(filepath.Join("testdata", "wycheproof", "*_test.json"))
+    if err != nil || len(files) == 0 {
+        t.Fatalf("no vector files: %v", err)
+    }
+
+    for _, file := range files {
+        file := file
+        t.Run(filepath.Base(file), func(t *testing.T) {
+            f, err := os.Open(file)
+            if err != nil {
+                t.Fatal(err)
+            }
+            defer f.Close()
+
+            w, err := utils.ReadWycheproof(f)
+            if err != nil {
+                t.Fatal(err)
+            }
+
+            var count = 0
+            for i := range w.TestGroups {
+                g := &w.TestGroups[i]
+                p := g.Params()
+                if p == nil {
+                    t.Fatalf("unsupported group %s %s", g.Variant, g.Version)
+                }
+                for j := range g.Tests {
+                    tc := &g.Tests[j]
+                    t.Run(fmt.Sprintf("%s_%s/%d", p, p.Version(), tc.TcId), func(t *testing.T) {
+                        if err := tc.Run(p); err != nil {
+                            t.Errorf("%s: %v", tc.Comment, err)
+                        }
+                    })
+                    count++
+                }
+            }
+            if count != w.NumberOfTests {
+                t.Errorf("ran %d tests, file announces %d", count, w.NumberOfTests)
+            }
+        })
+    }
+}
@@ -4,34 +4,382 @@
 
     This file is part of the Go reference implementation of NORX.
 
-    :version: v2.0
+    :version: v2.0, v3.0
     :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
     :license: CC0, see LICENSE
 */
 
 package main
 
+import "encoding/json"
+import "errors"
+import "flag"
 import "fmt"
+import "io"
 import "os"
+import norx "github.com/daeinar/norx-go/aead"
 import utils "github.com/daeinar/norx-go/utils"
 
+const (
+    EXIT_OK    = 0 // command succeeded
+    EXIT_FAIL  = 1 // command failed, e.g. a KAT mismatch or a forged file
+    EXIT_USAGE = 2 // invalid command line
+)
+
+var errUsage = errors.New("invalid arguments")
+
+type options_t struct {
+    variant string
+    version string
+    tag     int
+    format  string
+    trailer bool
+    json    bool
+}
+
+type command_t struct {
+    name    string
+    args    string // synopsis of the positional arguments
+    help    string
+    nargs   int    // number of positional arguments
+    variant string // default variant, empty if the command takes none
+    version string // default version
+    format  string // default KAT format, empty if the command takes none
+    trailer bool   // whether the command supports --trailer
+    json    bool   // whether the command supports --json
+    run     func(o *options_t, args []string) (interface{}, error)
+}
+
+var commands = []*command_t{
+    {
+        name: "check",
+        help: "Verify all KATs and run the self-tests.",
+        json: true,
+        run:  run_check,
+    },
+    {
+        name:    "genkat",
+        help:    "Print the KATs of a variant as Go source, in the LWC_AEAD_KAT format or as Wycheproof vectors.",
+        variant: "NORX6441",
+        version: "v2.0",
+        format:  "go",
+        trailer: true,
+        run:     run_genkat,
+    },
+    {
+        name:    "verifykat",
+        args:    "file",
+        help:    "Verify a variant against a KAT file in the LWC_AEAD_KAT text or JSON format, or a Wycheproof file.",
+        nargs:   1,
+        variant: "NORX6441",
+        version: "v2.0",
+        format:  "lwc",
+        json:    true,
+        run:     run_verifykat,
+    },
+    {
+        name:    "debug",
+        help:    "Print a sample encryption and decryption with the intermediate states.",
+        variant: "NORX6441",
+        version: "v2.0",
+        run:     run_debug,
+    },
+    {
+        name:    "bench",
+        help:    "Measure the encryption throughput of a variant.",
+        variant: "NORX6441",
+        version: "v2.0",
+        json:    true,
+        run:     run_bench,
+    },
+    {
+        name:    "encrypt",
+        args:    "keyfile in out",
+        help:    "Encrypt the file in under a random nonce into the container out.",
+        nargs:   3,
+        variant: "NORX6441",
+        version: "v3.0",
+        json:    true,
+        run:     run_encrypt,
+    },
+    {
+        name:  "decrypt",
+        args:  "keyfile in out",
+        help:  "Decrypt the container in to out, which is not written if authentication fails.",
+        nargs: 3,
+        json:  true,
+        run:   run_decrypt,
+    },
+}
+
 func main() {
+    os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+
+    if len(args) < 1 {
+        fmt.Fprintln(stderr, "Error: Too few parameter.")
+        usage(stderr)
+        return EXIT_USAGE
+    }
 
-    args := os.Args
+    if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+        if len(args) > 1 {
+            if lookup(args[1]) == nil {
+                fmt.Fprintf(stderr, "Error: Unknown command %q.\n", args[1])
+                usage(stderr)
+                return EXIT_USAGE
+            }
+            lookup(args[1]).flags(new(options_t), stderr).Usage()
+            return EXIT_OK
+        }
+        usage(stdout)
+        return EXIT_OK
+    }
+
+    cmd := lookup(args[0])
+    if cmd == nil {
+        fmt.Fprintf(stderr, "Error: Unknown command %q.\n", args[0])
+        usage(stderr)
+        return EXIT_USAGE
+    }
+
+    o := new(options_t)
+    fs := cmd.flags(o, stderr)
+    if err := fs.Parse(args[1:]); err != nil {
+        if err == flag.ErrHelp {
+            return EXIT_OK
+        }
+        return EXIT_USAGE
+    }
+    if fs.NArg() != cmd.nargs {
+        fmt.Fprintf(stderr, "Error: %s expects %d arguments.\n", cmd.name, cmd.nargs)
+        fs.Usage()
+        return EXIT_USAGE
+    }
 
-    if len(args) != 2 {
-        fmt.Println("Error: Too few parameter.")
+    result, err := cmd.run(o, fs.Args())
+    if o.json {
+        report_json(stdout, cmd.name, result, err)
+    } else if err != nil {
+        fmt.Fprintf(stderr, "Error: %v\n", err)
     } else {
-        if args[1] == "check" {
-            utils.Check()
-        } else if args[1] == "genkat" {
-            utils.Genkat()
-        } else if args[1] == "debug" {
-            utils.Debug()
+        report_text(stdout, result)
+    }
+
+    if errors.Is(err, errUsage) {
+        return EXIT_USAGE
+    }
+    if err != nil {
+        return EXIT_FAIL
+    }
+    return EXIT_OK
+}
+
+func lookup(name string) *command_t {
+
+    for _, cmd := range commands {
+        if cmd.name == name {
+            return cmd
+        }
+    }
+    return nil
+}
+
+func (cmd *command_t) flags(o *options_t, stderr io.Writer) *flag.FlagSet {
+
+    fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+    fs.SetOutput(stderr)
+    if cmd.variant != "" {
+        fs.StringVar(&o.variant, "variant", cmd.variant, "NORX variant, e.g. NORX3241")
+        fs.StringVar(&o.version, "version", cmd.version, "specification version, v2.0 or v3.0")
+        fs.IntVar(&o.tag, "tag", 0, "tag size in bytes, 0 for the default of the variant")
+    }
+    if cmd.format != "" {
+        fs.StringVar(&o.format, "format", cmd.format, "KAT format, go (genkat only), lwc, json or wycheproof")
+    }
+    if cmd.trailer {
+        fs.BoolVar(&o.trailer, "trailer", false, "print the trailer KATs instead (go format only)")
+    }
+    if cmd.json {
+        fs.BoolVar(&o.json, "json", false, "print a machine-readable result")
+    }
+    fs.Usage = func() {
+        fmt.Fprintf(stderr, "usage: norx-go %s [flags] %s\n\n%s\n", cmd.name, cmd.args, cmd.help)
+        fs.PrintDefaults()
+    }
+    return fs
+}
+
+func usage(w io.Writer) {
+
+    var width = 0
+    for _, cmd := range commands {
+        if len(cmd.name) > width {
+            width = len(cmd.name)
+        }
+    }
+
+    fmt.Fprintln(w, "usage: norx-go <command> [flags] [arguments]\n\ncommands:")
+    for _, cmd := range commands {
+        fmt.Fprintf(w, "  %-*s %s\n", width, cmd.name, cmd.help)
+    }
+    fmt.Fprintln(w, "\nRun 'norx-go help <command>' for the flags of a command.")
+}
+
+func report_text(w io.Writer, result interface{}) {
+
+    switch r := result.(type) {
+    case string:
+        fmt.Fprintln(w, r)
+    case []utils.BenchResult:
+        for _, b := range r {
+            fmt.Fprintln(w, b)
+        }
+    }
+}
+
+func report_json(w io.Writer, name string, result interface{}, err error) {
+
+    out := struct {
+        Command string      `json:"command"`
+        OK      bool        `json:"ok"`
+        Error   string      `json:"error,omitempty"`
+        Result  interface{} `json:"result,omitempty"`
+    }{Command: name, OK: err == nil, Result: result}
+    if err != nil {
+        out.Error = err.Error()
+    }
+    enc := json.NewEncoder(w)
+    enc.Encode(out)
+}
+
+func (o *options_t) params() (*norx.Params, error) {
+
+    p := utils.Variant(o.variant, o.version)
+    if p == nil {
+        return nil, fmt.Errorf("%w: unknown variant %s %s", errUsage, o.variant, o.version)
+    }
+    if o.tag != 0 {
+        q, err := p.WithTagSize(o.tag)
+        if err != nil {
+            return nil, fmt.Errorf("%w: unsupported tag size %d", errUsage, o.tag)
+        }
+        p = &q
+    }
+    return p, nil
+}
+
+func run_check(o *options_t, args []string) (interface{}, error) {
+
+    if err := utils.Check(); err != nil {
+        return nil, err
+    }
+    return "ok", nil
+}
+
+func run_genkat(o *options_t, args []string) (interface{}, error) {
+
+    p, err := o.params()
+    if err != nil {
+        return nil, err
+    }
+    if o.trailer && o.format != "go" {
+        return nil, fmt.Errorf("%w: --trailer requires --format go", errUsage)
+    }
+    switch o.format {
+    case "go":
+        if o.trailer {
+            utils.GenkatTrailer(p)
         } else {
-            fmt.Println("Error: Unknown parameter.")
+            utils.Genkat(p)
         }
+        return nil, nil
+    case "lwc":
+        return nil, utils.WriteLWC(os.Stdout, utils.GenerateLWC(p))
+    case "json":
+        return nil, utils.WriteLWCJSON(os.Stdout, utils.GenerateLWC(p))
+    case "wycheproof":
+        return nil, utils.WriteWycheproof(os.Stdout, utils.GenerateWycheproof(p))
     }
+    return nil, fmt.Errorf("%w: unknown format %s", errUsage, o.format)
+}
 
+func run_verifykat(o *options_t, args []string) (interface{}, error) {
 
+    p, err := o.params()
+    if err != nil {
+        return nil, err
+    }
+    f, err := os.Open(args[0])
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+
+    var vs []utils.Vector
+    switch o.format {
+    case "wycheproof":
+        w, err := utils.ReadWycheproof(f)
+        if err != nil {
+            return nil, err
+        }
+        if err := utils.VerifyWycheproof(w); err != nil {
+            return nil, err
+        }
+        return fmt.Sprintf("ok, %d vectors", w.NumberOfTests), nil
+    case "lwc":
+        vs, err = utils.ReadLWC(f)
+    case "json":
+        vs, err = utils.ReadLWCJSON(f)
+    default:
+        return nil, fmt.Errorf("%w: unknown format %s", errUsage, o.format)
+    }
+    if err != nil {
+        return nil, err
+    }
+    if err := utils.VerifyLWC(p, vs); err != nil {
+        return nil, err
+    }
+    return fmt.Sprintf("ok, %d vectors", len(vs)), nil
+}
+
+func run_debug(o *options_t, args []string) (interface{}, error) {
+
+    p, err := o.params()
+    if err != nil {
+        return nil, err
+    }
+    utils.Debug(os.Stdout, p)
+    return nil, nil
+}
+
+func run_bench(o *options_t, args []string) (interface{}, error) {
+
+    p, err := o.params()
+    if err != nil {
+        return nil, err
+    }
+    return utils.Bench(p), nil
+}
+
+func run_encrypt(o *options_t, args []string) (interface{}, error) {
+
+    p, err := o.params()
+    if err != nil {
+        return nil, err
+    }
+    if err := utils.Encrypt(args[0], args[1], args[2], p); err != nil {
+        return nil, err
+    }
+    return map[string]string{"output": args[2]}, nil
+}
+
+func run_decrypt(o *options_t, args []string) (interface{}, error) {
+
+    if err := utils.Decrypt(args[0], args[1], args[2]); err != nil {
+        return nil, err
+    }
+    return map[string]string{"output": args[2]}, nil
 }
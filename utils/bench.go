@@ -0,0 +1,99 @@
+/*
+    bench.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+
+package utils
+
+import norx "github.com/daeinar/norx-go/aead"
+
+import "fmt"
+import "time"
+
+// BENCH_TIME is the minimum time measured per message size and path.
+const BENCH_TIME = 500 * time.Millisecond
+
+type BenchResult struct {
+    Variant string  `json:"variant"`
+    Version string  `json:"version"`
+    Backend string  `json:"backend"`
+    Path    string  `json:"path"`
+    Bytes   uint64  `json:"bytes"`
+    NsPerOp int64   `json:"ns_per_op"`
+    MBps    float64 `json:"mb_per_s"`
+}
+
+func (r BenchResult) String() string {
+    return fmt.Sprintf("%s %s %-7s %-7s %8d B: %10d ns/op %8.2f MB/s", r.Variant, r.Version, r.Backend, r.Path, r.Bytes, r.NsPerOp, r.MBps)
+}
+
+// Bench measures the encryption throughput of p for short, medium and long
+// messages with the backend p uses on this machine, see Params.Backend. Small
+// packets are measured both with AEAD_encrypt ("oneshot") and with a keyed
+// Context ("context"), which skips the key-dependent part of norx_init. The
+// benchmarks of the aead package give the same numbers with
+// go test -bench.
+func Bench(p *norx.Params) []BenchResult {
+
+    var results []BenchResult
+
+    k := make([]uint8, p.KeySize())
+    n := make([]uint8, p.NonceSize())
+    x, _ := norx.NewContext(*p, k)
+
+    for _, mlen := range []uint64{16, 64, 1024, 1 << 20} {
+
+        m := make([]uint8, mlen)
+        c := make([]uint8, mlen + uint64(p.TagSize()))
+
+        results = append(results, bench_result(p, "oneshot", mlen, func() {
+            var clen uint64 = 0
+            p.AEAD_encrypt(c, &clen, nil, 0, m, mlen, nil, 0, n, k)
+        }))
+
+        if mlen > 1024 {
+            continue
+        }
+        results = append(results, bench_result(p, "context", mlen, func() {
+            x.Reset(n)
+            x.Encrypt(c, nil, m, nil)
+        }))
+    }
+    return results
+}
+
+// bench_result runs f for at least BENCH_TIME, doubling the number of calls
+// until the time is reached.
+func bench_result(p *norx.Params, path string, mlen uint64, f func()) BenchResult {
+
+    var calls = 1
+    var elapsed time.Duration
+
+    for {
+        start := time.Now()
+        for i := 0; i < calls; i++ {
+            f()
+        }
+        elapsed = time.Since(start)
+        if elapsed >= BENCH_TIME {
+            break
+        }
+        calls *= 2
+    }
+
+    return BenchResult{
+        Variant: p.String(),
+        Version: p.Version(),
+        Backend: p.Backend(),
+        Path:    path,
+        Bytes:   mlen,
+        NsPerOp: elapsed.Nanoseconds() / int64(calls),
+        MBps:    float64(mlen) * float64(calls) / elapsed.Seconds() / 1e6,
+    }
+}
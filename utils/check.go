@@ -4,7 +4,7 @@
 
     This file is part of the Go reference implementation of NORX.
 
-    :version: v2.0
+    :version: v2.0, v3.0
     :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
     :license: CC0, see LICENSE
 */
@@ -13,66 +13,989 @@ package utils
 
 import norx "github.com/daeinar/norx-go/aead"
 
+import "bytes"
+import "encoding/hex"
 import "fmt"
+import "io"
+import "strings"
 
-func Check() int {
+type kat_t struct {
+    p      *norx.Params
+    getkat func(uint64, uint64) []uint8
+}
 
-    var wlen uint64 = 256
-    var hlen uint64 = 256
-    var tlen uint64 = 0
-    var klen uint64 = 32
-    var nlen uint64 = 16
+// The KATs of NORX6441 v2.0 are the vectors of the C reference this package
+// was ported from. All others are generated by genkat and are regression
+// vectors. A separate implementation following the C reference reproduces
+// all of them, including the NORX6441 vectors, but they have not been
+// compared with KATs published for the other variants.
+var kats = []kat_t{
+    {&norx.NORX6441, getkat6441},
+    {&norx.NORX6461, getkat6461},
+    {&norx.NORX3241, getkat3241},
+    {&norx.NORX3261, getkat3261},
+    {&norx.NORX6442, getkat6442},
+    {&norx.NORX6444, getkat6444},
+    {&norx.NORX6440, getkat6440},
+    {&norx.NORX6441_V30, getkat6441_v30},
+    {&norx.NORX6461_V30, getkat6461_v30},
+    {&norx.NORX3241_V30, getkat3241_v30},
+    {&norx.NORX3261_V30, getkat3261_v30},
+    {&norx.NORX6444_V30, getkat6444_v30},
+    {tagged(norx.NORX6441_V30, 8), getkat6441_v30_t64},
+    {tagged(norx.NORX6441_V30, 12), getkat6441_v30_t96},
+    {tagged(norx.NORX6441_V30, 16), getkat6441_v30_t128},
+    {tagged(norx.NORX3241_V30, 8), getkat3241_v30_t64},
+}
 
-    w := make([]uint8, wlen)
-    h := make([]uint8, hlen)
-    t := make([]uint8, tlen)
-    k := make([]uint8, klen)
-    n := make([]uint8, nlen)
+// The trailer KATs cover one variant per code path: both word sizes, the
+// parallel modes and both specification versions.
+var trailer_kats = []kat_t{
+    {&norx.NORX6441, getkat6441_trailer},
+    {&norx.NORX3241, getkat3241_trailer},
+    {&norx.NORX6444, getkat6444_trailer},
+    {&norx.NORX6440, getkat6440_trailer},
+    {&norx.NORX6441_V30, getkat6441_v30_trailer},
+    {&norx.NORX3241_V30, getkat3241_v30_trailer},
+    {&norx.NORX6444_V30, getkat6444_v30_trailer},
+}
 
-    var i uint64
+// tagged returns p with tags of size bytes for the KAT table.
+func tagged(p norx.Params, size int) *norx.Params {
+
+    q, err := p.WithTagSize(size)
+    if err != nil {
+        panic(err)
+    }
+    return &q
+}
+
+// Variants returns the parameters of all variants with KATs, the default tag
+// sizes first.
+func Variants() []*norx.Params {
+
+    var ps = make([]*norx.Params, len(kats))
+    for i, k := range kats {
+        ps[i] = k.p
+    }
+    return ps
+}
+
+// KAT returns the known-answer data of p, or nil if there is none. The KAT
+// of message length i starts where the one of length i - 1 ends and holds
+// the ciphertext and tag, see Genkat for the inputs.
+func KAT(p *norx.Params) func(uint64, uint64) []uint8 {
+
+    for _, k := range kats {
+        if *k.p == *p {
+            return k.getkat
+        }
+    }
+    return nil
+}
+
+// Variant returns the parameters of the NORX variant with the given name and
+// version (e.g. "NORX3241" and "v3.0"), or nil if there is no such variant.
+func Variant(name string, version string) *norx.Params {
+
+    for _, k := range kats {
+        if k.p.String() == name && k.p.Version() == version {
+            return k.p
+        }
+    }
+    return nil
+}
+
+// The ciphertexts and tags of the v3.0 example (see Example) for NORX6441,
+// NORX3241 and NORX6444. Unlike the KATs above they do not come from genkat
+// but from the separate implementation that reproduces the NORX6441 v2.0
+// vectors of the C reference. They have not been compared with the output of
+// the v3.0 C reference yet.
+var reference_kats = []struct {
+    p    *norx.Params
+    want string
+}{
+    {&norx.NORX6441_V30, "e3ebd5c05c9fb8d0e5032a3bbe08760b2055fef6aefbc62a2d0ee661c45787e9" +
+        "fc5247dfefd3ae81272e250f814b621d3ef264f406487574e9c067fdf2b7faee" +
+        "130f80b4ff509f017ff85725fcd8ef0cefde749daa933ad95197f0a3154de018" +
+        "8b4736d8d4c1fcc6646eee628820d980ef4f59853b670d333a1b84d8f3b055cd" +
+        "d7e0b4af46a6d4b1dc361b0459ff882c02016ae6543896e515cc3bf4335dbf38"},
+    {&norx.NORX3241_V30, "33b449d5ac92ad76e8f8ddc2da778d6148727ff0009f50fee149c058f02c73b9" +
+        "be77bc14b911b963a48a116b501c9452deda0df06b3d0ce696bee97a4f2e2eab" +
+        "d74c29f874404778576fc841201e84fb45fec2dcfed506a6164436e6d595f5bd" +
+        "f3fa30fd7c677e347ce816afc66d9302d5a9d4e4ec16f68cb5dfa9ef697cd3dd" +
+        "90635bffb487b616d3e07f9cbc352e05"},
+    {&norx.NORX6444_V30, "aab0936a1b64b31e3b908e3e87c7a07ff47e0a1e4b1a007e7aabe0dbd1f9f93d" +
+        "0789b63f79d734567980cfa3c514ae83de4e949fb68cd0cba929cc80fa1a763b" +
+        "740db618df39b850c4dda78740f5c49f916051cb473c0fe931e79c94ab9e8701" +
+        "7bfcb6ae87ebe37fa98c13df3dd2c85739253483bb8bc53ec4e8d4ebbfe9893a" +
+        "bca5a6e97784db40695d8500160708a196a63720fe785a8a3dea38cf83152104"},
+}
+
+// Reference returns the ciphertext and tag of the example of p, or nil if
+// there is none.
+func Reference(p *norx.Params) []uint8 {
+
+    for _, r := range reference_kats {
+        if *r.p == *p {
+            c, _ := hex.DecodeString(r.want)
+            return c
+        }
+    }
+    return nil
+}
+
+// Check runs all KATs and self-tests and returns the first failure.
+func Check() error {
+
+    if err := check_errors(); err != nil {
+        return err
+    }
+    if err := check_reference(); err != nil {
+        return err
+    }
+    if err := check_hash(); err != nil {
+        return err
+    }
+    if err := check_drbg(); err != nil {
+        return err
+    }
+    if err := check_wrap(); err != nil {
+        return err
+    }
+    if err := check_tags(); err != nil {
+        return err
+    }
+    if err := check_lwc(); err != nil {
+        return err
+    }
+    for _, k := range trailer_kats {
+        if err := check_kat(k.p, k.getkat, kat_trailer); err != nil {
+            return err
+        }
+    }
+    for _, k := range kats {
+        if err := check_kat(k.p, k.getkat, kat_default); err != nil {
+            return err
+        }
+        if err := check_stream(k.p); err != nil {
+            return err
+        }
+        if err := check_chunked(k.p); err != nil {
+            return err
+        }
+        if err := check_batch(k.p); err != nil {
+            return err
+        }
+        if err := check_mac(k.p); err != nil {
+            return err
+        }
+        if err := check_siv(k.p); err != nil {
+            return err
+        }
+        if err := check_context(k.p); err != nil {
+            return err
+        }
+    }
+    return nil
+}
 
-    for i = 0; i < wlen; i++ { w[i] = uint8(255 & (i*197 + 123)) }
-    for i = 0; i < hlen; i++ { h[i] = uint8(255 & (i*193 + 123)) }
-    for i = 0; i < klen; i++ { k[i] = uint8(255 & (i*191 + 123)) }
-    for i = 0; i < nlen; i++ { n[i] = uint8(255 & (i*181 + 123)) }
+// check_reference compares the v3.0 example with its fixed
+// ciphertexts and tags in both directions.
+func check_reference() error {
 
+    for _, r := range reference_kats {
+        p := r.p
+        k, n, a, m, z := Example(p)
+        want := Reference(p)
+
+        var clen, mlen uint64
+        c := make([]uint8, len(m) + p.TagSize())
+        p.AEAD_encrypt(c, &clen, a, uint64(len(a)), m, uint64(len(m)), z, uint64(len(z)), n, k)
+        if clen != uint64(len(want)) || 0 != cmp(c, want, clen) {
+            return fmt.Errorf("%s %s: fail at reference encryption", p, p.Version())
+        }
+
+        o := make([]uint8, len(m))
+        err := p.AEAD_decrypt(o, &mlen, a, uint64(len(a)), want, uint64(len(want)), z, uint64(len(z)), n, k)
+        if err != nil || 0 != cmp(o, m, uint64(len(m))) {
+            return fmt.Errorf("%s %s: fail at reference decryption", p, p.Version())
+        }
+    }
+    return nil
+}
+
+func check_kat(p *norx.Params, getkat func(uint64, uint64) []uint8, set kat_set_t) error {
+
+    var taglen = uint64(p.TagSize())
+    var h, w, t, k, n = Inputs(p, 256)
     var kat uint64 = 0
+    var i uint64
 
-    for i = 0; i < wlen; i++ {
+    for i = 0; i < 256; i++ {
 
-        m := make([]uint8, 256)
-        c := make([]uint8, 256 + 32)
-        copy(m,w[:i+1])
+        hlen, mlen, tlen := set.lengths(i)
+        c := make([]uint8, 256 + taglen)
 
         var clen uint64 = 0
-        var mlen uint64 = i
-        var hlen uint64 = i
 
-        norx.AEAD_encrypt(c, &clen, h, hlen, m, mlen, t, tlen, n, k)
-        if 0 != cmp(getkat(kat,kat+clen),c,clen) {
-            fmt.Printf("fail at encrypt check: %d\n", i)
-            return -1
+        err := p.AEAD_encrypt(c, &clen, h, hlen, w, mlen, t, tlen, n, k)
+        if err != nil || 0 != cmp(getkat(kat,kat+clen),c,clen) {
+            return fmt.Errorf("%s %s: fail at %sencrypt check: %d", p, p.Version(), set.name, i)
         }
 
-        m = make([]uint8, 256)
+        m := make([]uint8, 256)
         mlen = 0
 
-        if 0 != norx.AEAD_decrypt(m, &mlen, h, hlen, c, clen, t, tlen, n, k) {
-            fmt.Printf("fail at decrypt check: %d\n", i)
-            return -1
+        if nil != p.AEAD_decrypt(m, &mlen, h, hlen, c, clen, t, tlen, n, k) {
+            return fmt.Errorf("%s %s: fail at %sdecrypt check: %d", p, p.Version(), set.name, i)
         }
 
         if 0 != cmp(w,m,mlen) {
-            fmt.Printf("fail at msg check: %d\n", i)
-            return -1
+            return fmt.Errorf("%s %s: fail at %smsg check: %d", p, p.Version(), set.name, i)
+        }
+
+        if tlen == 0 {
+            x, err := norx.New(*p, k)
+            if err != nil {
+                return fmt.Errorf("%s %s: fail at aead setup: %d", p, p.Version(), i)
+            }
+
+            s := x.Seal(nil, n, w[:mlen], h[:hlen])
+            if 0 != cmp(getkat(kat,kat+clen),s,clen) {
+                return fmt.Errorf("%s %s: fail at %sseal check: %d", p, p.Version(), set.name, i)
+            }
+
+            o, err := x.Open(s[:0], n, s, h[:hlen])
+            if err != nil || 0 != cmp(w,o,mlen) {
+                return fmt.Errorf("%s %s: fail at %sopen check: %d", p, p.Version(), set.name, i)
+            }
         }
 
         kat += clen
     }
-    fmt.Println("ok")
-    return 0
+    return nil
 }
 
+// ErrorCase is a failure path of a public entry point together with the
+// sentinel error it has to return.
+type ErrorCase struct {
+    Name string
+    Want error
+    Run  func() error
+}
+
+// check_errors triggers every failure path of the public entry points and
+// compares the returned error with the expected sentinel.
+func check_errors() error {
+
+    for _, t := range ErrorCases() {
+        if err := t.Run(); err != t.Want {
+            return fmt.Errorf("fail at error check: %s: %v", t.Name, err)
+        }
+    }
+    return nil
+}
+
+// ErrorCases returns the failure paths of the public entry points covered by
+// check, so that the tests of the aead package run them as well.
+func ErrorCases() []ErrorCase {
+
+    var p = norx.NORX6441
+    var mlen, clen uint64 = 0, 0
+
+    k := make([]uint8, p.KeySize())
+    n := make([]uint8, p.NonceSize())
+    m := make([]uint8, 64)
+    c := make([]uint8, 64 + p.TagSize())
+    bad := norx.Params{W: 16, L: 4, P: 1, T: 64, V: norx.V20}
+
+    norx.AEAD_encrypt(c, &clen, nil, 0, m, 64, nil, 0, n, k)
+    forged := append([]uint8{}, c...)
+    forged[0] ^= 0x01
+
+    x, _ := norx.NewNORX(k)
+    y, _ := norx.NewSIV(p, k)
+
+    return []ErrorCase{
+        {"new params", norx.ErrParams, func() error { _, err := norx.New(bad, k); return err }},
+        {"new version", norx.ErrParams, func() error { _, err := norx.New(norx.Params{W: 64, L: 4, P: 1, T: 256}, k); return err }},
+        {"new key", norx.ErrKeySize, func() error { _, err := norx.NewNORX(k[1:]); return err }},
+        {"open short", norx.ErrShortCiphertext, func() error { _, err := x.Open(nil, n, c[:p.TagSize()-1], nil); return err }},
+        {"siv params", norx.ErrParams, func() error { _, err := norx.NewSIV(norx.NORX3241, k[:16]); return err }},
+        {"siv truncated tag", norx.ErrParams, func() error {
+            q, _ := norx.NORX6441_V30.WithTagSize(8)
+            _, err := norx.NewSIV(q, make([]uint8, q.KeySize()))
+            return err
+        }},
+        {"siv encrypt params", norx.ErrParams, func() error { return norx.NORX3241.SIV_encrypt(c, &clen, nil, 0, m, 64, nil, 0, n, k[:16]) }},
+        {"open forged", norx.ErrAuth, func() error { _, err := x.Open(nil, n, forged, nil); return err }},
+        {"open nonce", norx.ErrNonceSize, func() error { _, err := x.Open(nil, n[1:], c, nil); return err }},
+        {"open overlap", norx.ErrOverlap, func() error { _, err := x.Open(forged[1:1], n, forged, nil); return err }},
+        {"siv open nonce", norx.ErrNonceSize, func() error { _, err := y.Open(nil, n[1:], c, nil); return err }},
+        {"siv open overlap", norx.ErrOverlap, func() error { _, err := y.Open(forged[1:1], n, forged, nil); return err }},
+        {"encrypt params", norx.ErrParams, func() error { return bad.AEAD_encrypt(c, &clen, nil, 0, m, 64, nil, 0, n, k) }},
+        {"encrypt rounds", norx.ErrParams, func() error {
+            q := norx.Params{W: 64, L: norx.ROUNDS_MAX + 1, P: 1, T: 256, V: norx.V20}
+            return q.AEAD_encrypt(c, &clen, nil, 0, m, 64, nil, 0, n, k)
+        }},
+        {"encrypt lanes", norx.ErrParams, func() error {
+            q := norx.Params{W: 64, L: 4, P: norx.LANES_MAX + 1, T: 256, V: norx.V20}
+            return q.AEAD_encrypt(c, &clen, nil, 0, m, 64, nil, 0, n, k)
+        }},
+        {"encrypt key", norx.ErrKeySize, func() error { return norx.AEAD_encrypt(c, &clen, nil, 0, m, 64, nil, 0, n, k[1:]) }},
+        {"encrypt nonce", norx.ErrNonceSize, func() error { return norx.AEAD_encrypt(c, &clen, nil, 0, m, 64, nil, 0, n[1:], k) }},
+        {"encrypt output", norx.ErrBufferTooSmall, func() error { return norx.AEAD_encrypt(c[:64], &clen, nil, 0, m, 64, nil, 0, n, k) }},
+        {"encrypt message", norx.ErrBufferTooSmall, func() error { return norx.AEAD_encrypt(c, &clen, nil, 0, m[:63], 64, nil, 0, n, k) }},
+        {"encrypt header", norx.ErrBufferTooSmall, func() error { return norx.AEAD_encrypt(c, &clen, m, 65, m, 64, nil, 0, n, k) }},
+        {"encrypt trailer", norx.ErrBufferTooSmall, func() error { return norx.AEAD_encrypt(c, &clen, nil, 0, m, 64, m, 65, n, k) }},
+        {"decrypt key", norx.ErrKeySize, func() error { return norx.AEAD_decrypt(m, &mlen, nil, 0, c, clen, nil, 0, n, nil) }},
+        {"decrypt nonce", norx.ErrNonceSize, func() error { return norx.AEAD_decrypt(m, &mlen, nil, 0, c, clen, nil, 0, nil, k) }},
+        {"decrypt input", norx.ErrBufferTooSmall, func() error { return norx.AEAD_decrypt(m, &mlen, nil, 0, c[:clen-1], clen, nil, 0, n, k) }},
+        {"decrypt short", norx.ErrShortCiphertext, func() error { return norx.AEAD_decrypt(m, &mlen, nil, 0, c, 31, nil, 0, n, k) }},
+        {"decrypt output", norx.ErrBufferTooSmall, func() error { return norx.AEAD_decrypt(m[:63], &mlen, nil, 0, c, clen, nil, 0, n, k) }},
+        {"decrypt header", norx.ErrBufferTooSmall, func() error { return norx.AEAD_decrypt(m, &mlen, m, 65, c, clen, nil, 0, n, k) }},
+        {"decrypt forged", norx.ErrAuth, func() error {
+            err := norx.AEAD_decrypt(m, &mlen, nil, 0, forged, clen, nil, 0, n, k)
+            if 0 != cmp(m, make([]uint8, 64), 64) {
+                return fmt.Errorf("plaintext not wiped")
+            }
+            return err
+        }},
+        {"stream params", norx.ErrParams, func() error { _, err := norx.NewStreamEncrypter(bad, k, n); return err }},
+        {"stream key", norx.ErrKeySize, func() error { _, err := norx.NewStreamEncrypter(p, n, n); return err }},
+        {"stream nonce", norx.ErrNonceSize, func() error { _, err := norx.NewStreamDecrypter(p, k, k); return err }},
+        {"stream output", norx.ErrBufferTooSmall, func() error {
+            s, _ := norx.NewStreamEncrypter(p, k, n)
+            return s.Payload(c[:1], m)
+        }},
+        {"stream order", norx.ErrPhase, func() error {
+            s, _ := norx.NewStreamEncrypter(p, k, n)
+            s.Trailer(m)
+            return s.Header(m)
+        }},
+        {"stream finalize", norx.ErrPhase, func() error {
+            s, _ := norx.NewStreamDecrypter(p, k, n)
+            _, err := s.Finalize()
+            return err
+        }},
+        {"stream verify", norx.ErrAuth, func() error {
+            s, _ := norx.NewStreamDecrypter(p, k, n)
+            s.Payload(make([]uint8, 64), forged[:64])
+            return s.Verify(forged[64:])
+        }},
+        {"reader short", norx.ErrShortCiphertext, func() error {
+            rd, _ := norx.NewReader(bytes.NewReader(c[:5]), p, k, n, nil, nil)
+            _, err := io.ReadAll(rd)
+            return err
+        }},
+        {"hash size", norx.ErrParams, func() error { _, err := norx.NewHash(p, 0); return err }},
+        {"wrap params", norx.ErrParams, func() error {
+            _, err := norx.Wrap(norx.Params{W: 64, L: 4, P: 1, T: 1 << 62, V: norx.V30}, k, k)
+            return err
+        }},
+        {"unwrap params", norx.ErrParams, func() error {
+            _, err := norx.Unwrap(norx.Params{W: 64, L: 4, P: 1, T: 1 << 62, V: norx.V30}, k, c)
+            return err
+        }},
+        {"chunk size", norx.ErrChunkSize, func() error { _, err := norx.NewChunkWriter(nil, p, k, n[5:], nil, 0); return err }},
+        {"chunk prefix", norx.ErrNonceSize, func() error { _, err := norx.NewChunkReader(nil, p, k, n, nil, 64); return err }},
+    }
+}
+
+// check_hash compares the hash function and XOF with fixed digests and
+// checks that incremental input and output agree with one-shot processing.
+func check_hash() error {
+
+    var kats = []struct {
+        name string
+        out  func() []uint8
+        want string
+    }{
+        {"Sum256", func() []uint8 { d := norx.Sum256(nil); return d[:] },
+            "654a75eb2e2dfc4e8b390320e48b0cb8483d48c7dcdbf93cd6b3120eb7ff44e1"},
+        {"Sum512", func() []uint8 { d := norx.Sum512([]uint8("abc")); return d[:] },
+            "7dba24007801542f750fb411181850625fd1c0bd304ad50948bba34a1bc4ae12" +
+            "7d497256fd50e20160e9fac48ecd2cd88751c10929906f5a36c4f413a2ab52e2"},
+        {"XOF NORX3241", func() []uint8 {
+            x, _ := norx.NewXOF(norx.NORX3241)
+            x.Write([]uint8("abc"))
+            o := make([]uint8, 64)
+            x.Read(o)
+            return o
+        }, "ce4c548c237b6b7d96a2e2f1b6f4ac79b3396cc0a6368fe9e7a076b3adb8e3c2" +
+           "2881b860cc6db3adc9309acecad48037bf2013e0658d7018912321d9a13981d6"},
+        {"Hash NORX3261", func() []uint8 {
+            h, _ := norx.NewHash(norx.NORX3261, 32)
+            h.Write(make([]uint8, 200))
+            return h.Sum(nil)
+        }, "9567fd045674e8dd61701025f340cfe733ed4949aa9bad4f65c1406358a8cacd"},
+    }
+
+    for _, k := range kats {
+        if hex.EncodeToString(k.out()) != k.want {
+            return fmt.Errorf("fail at hash check: %s", k.name)
+        }
+    }
+
+    w := kat_pattern(300, 197)
+
+    for i := 0; i <= len(w); i += 7 {
+        d := norx.Sum512(w[:i])
+        h := norx.NewHash512()
+        feed(w[:i], 5, func(b []uint8) { h.Write(b) })
+        s := h.Sum(nil)
+        if !bytes.Equal(d[:], s) || !bytes.Equal(s, h.Sum(nil)) {
+            return fmt.Errorf("fail at incremental hash check: %d", i)
+        }
+
+        x, _ := norx.NewXOF(norx.NORX6441)
+        x.Write(w[:i])
+        o := make([]uint8, 250)
+        x.Read(o)
+        x.Reset()
+        x.Write(w[:i])
+        p := make([]uint8, 250)
+        feed(p, 11, func(b []uint8) { x.Read(b) })
+        if !bytes.Equal(o, p) || bytes.Equal(o[:64], d[:]) {
+            return fmt.Errorf("fail at xof check: %d", i)
+        }
+    }
+    return nil
+}
+
+// check_stream compares the incremental API and the io wrappers with the
+// one-shot functions for lengths around the block boundaries.
+func check_stream(p *norx.Params) error {
+
+    var r = uint64(p.RateSize())
+    var lens = []uint64{0, 1, r - 1, r, r + 1, 2*r + 5, 5*r}
+    var chunks = []uint64{1, 5, r, 1000}
+
+    _, w, _, k, n := Inputs(p, int(5*r))
+
+    for _, mlen := range lens {
+        for _, alen := range []uint64{0, r + 3} {
+            for _, chunk := range chunks {
+
+                var zlen = (mlen + alen) % (2*r)
+                a := w[:alen]
+                m := w[:mlen]
+                z := w[:zlen]
+
+                var clen uint64 = 0
+                c := make([]uint8, mlen + uint64(p.TagSize()))
+                p.AEAD_encrypt(c, &clen, a, alen, m, mlen, z, zlen, n, k)
+
+                x, err := norx.NewStreamEncrypter(*p, k, n)
+                if err != nil {
+                    return fmt.Errorf("%s %s: fail at stream setup: %d", p, p.Version(), mlen)
+                }
+                s := make([]uint8, mlen)
+                t := s
+                feed(a, chunk, func(b []uint8) { x.Header(b) })
+                feed(m, chunk, func(b []uint8) { x.Payload(t, b); t = t[len(b):] })
+                feed(z, chunk, func(b []uint8) { x.Trailer(b) })
+                tag, err := x.Finalize()
+                s = append(s[:mlen], tag...)
+                if err != nil || !bytes.Equal(c, s) {
+                    return fmt.Errorf("%s %s: fail at stream encrypt check: %d %d", p, p.Version(), mlen, chunk)
+                }
+
+                var buf bytes.Buffer
+                wr, _ := norx.NewWriter(&buf, *p, k, n, a, z)
+                feed(m, chunk, func(b []uint8) { wr.Write(b) })
+                if wr.Close() != nil || !bytes.Equal(c, buf.Bytes()) {
+                    return fmt.Errorf("%s %s: fail at writer check: %d %d", p, p.Version(), mlen, chunk)
+                }
+
+                rd, _ := norx.NewReader(bytes.NewReader(c), *p, k, n, a, z)
+                o, err := io.ReadAll(rd)
+                if err != nil || !bytes.Equal(m, o) {
+                    return fmt.Errorf("%s %s: fail at reader check: %d %d", p, p.Version(), mlen, chunk)
+                }
+
+                c[0] ^= 0x01
+                rd, _ = norx.NewReader(bytes.NewReader(c), *p, k, n, a, z)
+                if _, err = io.ReadAll(rd); err == nil {
+                    return fmt.Errorf("%s %s: fail at reader forgery check: %d %d", p, p.Version(), mlen, chunk)
+                }
+            }
+        }
+    }
+    return nil
+}
+
+// check_drbg compares the DRBG with fixed outputs for instantiation, reseeding
+// and additional input and checks its error paths.
+func check_drbg() error {
+
+    seed := kat_pattern(48, 191)
+
+    var kats = []struct {
+        name string
+        out  func() []uint8
+        want string
+    }{
+        {"NORX6441", func() []uint8 {
+            d, _ := norx.NewDRBG(norx.NORX6441, seed, nil)
+            o := make([]uint8, 64)
+            d.Generate(o, nil)
+            d.Generate(o, nil)
+            return o
+        }, "a00023d865f286e9f0a596c26dc40e1429b1a08eeec6ba1525d8922426627d6e" +
+           "da98d4d725001ea9ea32626461bd3ebdcd93c48d993e5f0bfe082027b644a80c"},
+        {"NORX6441 personalization", func() []uint8 {
+            d, _ := norx.NewDRBG(norx.NORX6441, seed, []uint8("norx-go"))
+            o := make([]uint8, 200)
+            d.Generate(o, []uint8("additional"))
+            return o[136:]
+        }, "2bee09619f723dd92e556289178b8c2f650f9cc6aaaaf7143ae0246c96a1a592" +
+           "0f2815fee6321762e31a9e3b34c0e7e8eeaad383c7952dadd9918d4ea09ca06d"},
+        {"NORX3241 reseed", func() []uint8 {
+            d, _ := norx.NewDRBG(norx.NORX3241, seed[:16], nil)
+            o := make([]uint8, 32)
+            d.Generate(o, nil)
+            d.Reseed(seed[16:], nil)
+            d.Generate(o, nil)
+            return o
+        }, "bfb6326e8df6e4d655830bf5459cd941f40c612eb67953ec22c924919dbd694f"},
+    }
+
+    for _, k := range kats {
+        if hex.EncodeToString(k.out()) != k.want {
+            return fmt.Errorf("fail at drbg check: %s", k.name)
+        }
+    }
+
+    x, _ := norx.NewDRBG(norx.NORX6441, seed, nil)
+    y, _ := norx.NewDRBG(norx.NORX6441, seed, nil)
+    o := make([]uint8, norx.DRBG_MAX_REQUEST + 100)
+    p := make([]uint8, len(o))
+    if n, err := x.Read(o); n != len(o) || err != nil {
+        return fmt.Errorf("fail at drbg read check")
+    }
+    y.Generate(p[:norx.DRBG_MAX_REQUEST], nil)
+    y.Generate(p[norx.DRBG_MAX_REQUEST:], nil)
+    if !bytes.Equal(o, p) {
+        return fmt.Errorf("fail at drbg read check")
+    }
+
+    if _, err := norx.NewDRBG(norx.NORX6441, seed[:31], nil); err != norx.ErrSeedSize {
+        return fmt.Errorf("fail at drbg seed size check")
+    }
+    if err := x.Reseed(seed[:31], nil); err != norx.ErrSeedSize {
+        return fmt.Errorf("fail at drbg reseed size check")
+    }
+    if err := x.Generate(o, nil); err != norx.ErrRequestSize {
+        return fmt.Errorf("fail at drbg request size check")
+    }
+    return nil
+}
+
+// check_wrap compares key wrapping with fixed vectors and checks the length
+// limits and forgeries.
+func check_wrap() error {
+
+    kek := kat_pattern(32, 191)
+    key := kat_pattern(norx.BYTES_WRAP_MAX + 1, 197)
+
+    var kats = []struct {
+        p    norx.Params
+        n    int
+        want string
+    }{
+        {norx.NORX6441_V30, 16, "948873c7d4f76900a68540cadf4b8765fd80cc7688af96a1d2923bc0645bc88e" +
+            "08e784191076b8171b05c95b8f63b8bd"},
+        {norx.NORX6441_V30, 32, "f5c16a3ac4044a83e8be3ce9d1ca6ec0f106026294d9911a782122976dc0092f" +
+            "42e9f68ed0c4c238a60d66b619d76878a6a2ea1870a4e9a0b710d53ed88472d4"},
+        {norx.NORX6441_V30, 64, "624700a2d57b403ddcf1c01c9ca57f21c39289fb5b0a337542fdb127e661aa1c" +
+            "9f0404172dadb8bc3363c72aa28f2637e3336ff3e4e4f05b8b114c8b6de5c551" +
+            "c61484054f19698b0902ae9e6e55c7f41b0e1a4982b6846ba5341c3181c0740b"},
+        {norx.NORX3241_V30, 16, "6e63faebc8f626e585064f5473aa03eab6d7127b97f6201a949b6c805b49cf17"},
+        {norx.NORX3241_V30, 24, "f862873cf63b4b764abaa66d80ded6ac0d9b6d9c8cddabf1edd74440c5d48d25" +
+            "b8bbf99068cfa21a"},
+    }
+
+    for _, t := range kats {
+        kk := kek[:t.p.KeySize()]
+        c, err := norx.Wrap(t.p, kk, key[:t.n])
+        if err != nil || hex.EncodeToString(c) != t.want {
+            return fmt.Errorf("fail at wrap check: %s %s %d", &t.p, t.p.Version(), t.n)
+        }
+        m, err := norx.Unwrap(t.p, kk, c)
+        if err != nil || !bytes.Equal(m, key[:t.n]) {
+            return fmt.Errorf("fail at unwrap check: %s %s %d", &t.p, t.p.Version(), t.n)
+        }
+        c[len(c)-1] ^= 0x01
+        if m, err := norx.Unwrap(t.p, kk, c); err != norx.ErrAuth || m != nil {
+            return fmt.Errorf("fail at unwrap forgery check: %s %s %d", &t.p, t.p.Version(), t.n)
+        }
+    }
+
+    p := norx.NORX6441_V30
+    for _, n := range []int{0, norx.BYTES_WRAP_MIN - 1, norx.BYTES_WRAP_MAX + 1} {
+        if _, err := norx.Wrap(p, kek, key[:n]); err != norx.ErrWrapSize {
+            return fmt.Errorf("fail at wrap size check: %d", n)
+        }
+        if _, err := norx.Unwrap(p, kek, make([]uint8, n + p.TagSize())); err != norx.ErrWrapSize {
+            return fmt.Errorf("fail at unwrap size check: %d", n)
+        }
+    }
+    if _, err := norx.Wrap(p, kek[:16], key[:16]); err != norx.ErrKeySize {
+        return fmt.Errorf("fail at wrap key size check")
+    }
+    return nil
+}
+
+// check_tags checks that tags of different sizes are bound into the
+// initialisation and reflected by Overhead.
+func check_tags() error {
+
+    k := kat_pattern(32, 191)
+    n := kat_pattern(32, 181)
+    w := kat_pattern(100, 197)
+
+    for _, p := range []norx.Params{norx.NORX6441, norx.NORX3241, norx.NORX6441_V30, norx.NORX3241_V30} {
+        full, _ := norx.New(p, k[:p.KeySize()])
+        f := full.Seal(nil, n[:p.NonceSize()], w, nil)
+
+        for size := norx.BYTES_TAG_MIN; size < p.TagSize(); size += 4 {
+            q, err := p.WithTagSize(size)
+            if err != nil {
+                return fmt.Errorf("%s %s: fail at tag size check: %d", &p, p.Version(), size)
+            }
+            x, _ := norx.New(q, k[:q.KeySize()])
+            c := x.Seal(nil, n[:q.NonceSize()], w, nil)
+            if x.Overhead() != size || len(c) != len(w) + size {
+                return fmt.Errorf("%s %s: fail at overhead check: %d", &p, p.Version(), size)
+            }
+            if bytes.Equal(c[:len(w)], f[:len(w)]) || bytes.Equal(c[len(w):], f[len(w):len(w)+size]) {
+                return fmt.Errorf("%s %s: fail at truncation check: %d", &p, p.Version(), size)
+            }
+            t := append(append([]uint8{}, f[:len(w)]...), f[len(w):len(w)+size]...)
+            if _, err := x.Open(nil, n[:q.NonceSize()], t, nil); err != norx.ErrAuth {
+                return fmt.Errorf("%s %s: fail at truncated forgery check: %d", &p, p.Version(), size)
+            }
+        }
+
+        for _, size := range []int{0, norx.BYTES_TAG_MIN - 1} {
+            if _, err := p.WithTagSize(size); err != norx.ErrParams {
+                return fmt.Errorf("%s %s: fail at tag size check: %d", &p, p.Version(), size)
+            }
+        }
+    }
+
+    if _, err := norx.NORX6441_V30.WithTagSize(33); err != norx.ErrParams {
+        return fmt.Errorf("fail at tag size check: v3.0 capacity")
+    }
+    if _, err := norx.NORX6441.WithTagSize(97); err != norx.ErrParams {
+        return fmt.Errorf("fail at tag size check: v2.0 rate")
+    }
+    return nil
+}
+
+// check_lwc writes the LWC_AEAD_KAT vectors of a variant in both formats,
+// reads them back and verifies them, including rejection of a modified file.
+func check_lwc() error {
+
+    p := &norx.NORX3241_V30
+    vs := GenerateLWC(p)
+    if len(vs) != (LWC_MAX_PT + 1) * (LWC_MAX_AD + 1) {
+        return fmt.Errorf("fail at lwc generate check")
+    }
+
+    var text, js bytes.Buffer
+    if err := WriteLWC(&text, vs); err != nil {
+        return err
+    }
+    if err := WriteLWCJSON(&js, vs); err != nil {
+        return err
+    }
+    ts := text.String()
+
+    vt, err := ReadLWC(&text)
+    if err != nil || VerifyLWC(p, vt) != nil || len(vt) != len(vs) {
+        return fmt.Errorf("fail at lwc text check: %v", err)
+    }
+    vj, err := ReadLWCJSON(&js)
+    if err != nil || VerifyLWC(p, vj) != nil || len(vj) != len(vs) {
+        return fmt.Errorf("fail at lwc json check: %v", err)
+    }
+    if VerifyLWC(&norx.NORX3241, vt) == nil {
+        return fmt.Errorf("fail at lwc variant check")
+    }
+
+    i := strings.LastIndex(ts, "CT = ") + 5
+    d := "0"
+    if ts[i] == '0' {
+        d = "1"
+    }
+    ts = ts[:i] + d + ts[i+1:]
+    vt, err = ReadLWC(strings.NewReader(ts))
+    if err != nil || VerifyLWC(p, vt) == nil {
+        return fmt.Errorf("fail at lwc forgery check")
+    }
+    return nil
+}
+
+// check_chunked runs the chunked format through round trips and checks that
+// truncated, reordered and extended chunk sequences are rejected.
+func check_chunked(p *norx.Params) error {
+
+    var size = 100
+    var seg = size + p.TagSize()
+
+    _, w, _, k, n := Inputs(p, 4*size)
+    n = n[:p.NonceSize() - norx.BYTES_CHUNK_NONCE]
+    h := []uint8("header")
+
+    open := func(c []uint8) ([]uint8, error) {
+        rd, _ := norx.NewChunkReader(bytes.NewReader(c), *p, k, n, h, size)
+        return io.ReadAll(rd)
+    }
+
+    for _, mlen := range []int{0, 1, size - 1, size, size + 1, 3*size, 4*size} {
+
+        var buf bytes.Buffer
+        wr, err := norx.NewChunkWriter(&buf, *p, k, n, h, size)
+        if err != nil {
+            return fmt.Errorf("%s %s: fail at chunk writer setup: %d", p, p.Version(), mlen)
+        }
+        feed(w[:mlen], 7, func(b []uint8) { wr.Write(b) })
+        wr.Close()
+        c := buf.Bytes()
+
+        if o, err := open(c); err != nil || !bytes.Equal(w[:mlen], o) {
+            return fmt.Errorf("%s %s: fail at chunk round trip check: %d", p, p.Version(), mlen)
+        }
+
+        if _, err := open(c[:len(c) - 1]); err == nil {
+            return fmt.Errorf("%s %s: fail at chunk truncation check: %d", p, p.Version(), mlen)
+        }
+
+        if len(c) > seg {
+            if _, err := open(c[:seg]); err == nil {
+                return fmt.Errorf("%s %s: fail at chunk truncation check: %d", p, p.Version(), mlen)
+            }
+            r := append(append([]uint8{}, c[seg:]...), c[:seg]...)
+            if _, err := open(r); err == nil {
+                return fmt.Errorf("%s %s: fail at chunk reorder check: %d", p, p.Version(), mlen)
+            }
+        }
+
+        e := append(append([]uint8{}, c...), c[len(c) - p.TagSize():]...)
+        if _, err := open(e); err == nil {
+            return fmt.Errorf("%s %s: fail at chunk extension check: %d", p, p.Version(), mlen)
+        }
+    }
+    return nil
+}
+
+// check_batch compares batch encryption with single AEAD_encrypt calls and
+// makes sure a forged entry fails on its own.
+func check_batch(p *norx.Params) error {
+
+    var count = 2 * norx.BATCH_PARALLEL
+
+    _, w, _, k, _ := Inputs(p, 256)
+
+    batch := make([]norx.Message, count)
+    for j := range batch {
+        n := make([]uint8, p.NonceSize())
+        n[0] = uint8(j)
+        batch[j] = norx.Message{Nonce: n, Header: w[:j], Data: w[:8*j], Trailer: w[:j/2]}
+    }
+
+    if err := norx.SealBatch(*p, k, batch); err != nil {
+        return fmt.Errorf("%s %s: fail at batch seal: %v", p, p.Version(), err)
+    }
+
+    for j := range batch {
+        var x = &batch[j]
+        var clen uint64 = 0
+        c := make([]uint8, len(x.Data) + p.TagSize())
+        p.AEAD_encrypt(c, &clen, x.Header, uint64(len(x.Header)), x.Data, uint64(len(x.Data)), x.Trailer, uint64(len(x.Trailer)), x.Nonce, k)
+        if x.Err != nil || !bytes.Equal(c, x.Out) {
+            return fmt.Errorf("%s %s: fail at batch seal check: %d", p, p.Version(), j)
+        }
+        x.Data, x.Out = x.Out, nil
+    }
+    batch[3].Data[0] ^= 0x01
+
+    if err := norx.OpenBatch(*p, k, batch); err != nil {
+        return fmt.Errorf("%s %s: fail at batch open: %v", p, p.Version(), err)
+    }
+
+    for j := range batch {
+        var x = &batch[j]
+        if j == 3 {
+            if x.Err != norx.ErrAuth || x.Out != nil {
+                return fmt.Errorf("%s %s: fail at batch forgery check: %d", p, p.Version(), j)
+            }
+        } else if x.Err != nil || !bytes.Equal(w[:8*j], x.Out) {
+            return fmt.Errorf("%s %s: fail at batch open check: %d", p, p.Version(), j)
+        }
+    }
+    return nil
+}
+
+// feed passes in to f in chunks of the given size, the last one may be shorter.
+func feed(in []uint8, chunk uint64, f func([]uint8)) {
+
+    for uint64(len(in)) > chunk {
+        f(in[:chunk])
+        in = in[chunk:]
+    }
+    if len(in) > 0 {
+        f(in)
+    }
+}
+
+// check_mac compares the MAC with the tag of an AEAD encryption of the data
+// as header and checks incremental writes, truncation and verification.
+func check_mac(p *norx.Params) error {
+
+    _, w, _, k, _ := Inputs(p, 300)
+    n := make([]uint8, p.NonceSize())
+
+    var i uint64
+
+    m, err := norx.NewMAC(*p, k, p.TagSize())
+    if err != nil {
+        return fmt.Errorf("%s %s: fail at mac init: %v", p, p.Version(), err)
+    }
+    short, err := norx.NewMAC(*p, k, norx.BYTES_TAG_MIN)
+    if err != nil {
+        return fmt.Errorf("%s %s: fail at mac init: %v", p, p.Version(), err)
+    }
+
+    for i = 0; i <= uint64(len(w)); i += 13 {
+        var clen uint64 = 0
+        c := make([]uint8, p.TagSize())
+        p.AEAD_encrypt(c, &clen, w[:i], i, nil, 0, nil, 0, n, k)
+
+        m.Reset()
+        feed(w[:i], 7, func(b []uint8) { m.Write(b) })
+        t := m.Sum(nil)
+        if !bytes.Equal(c, t) || !bytes.Equal(t, m.Sum(nil)) {
+            return fmt.Errorf("%s %s: fail at mac check: %d", p, p.Version(), i)
+        }
+        if !m.Verify(t) || m.Verify(t[:len(t)-1]) {
+            return fmt.Errorf("%s %s: fail at mac verify check: %d", p, p.Version(), i)
+        }
+        t[i % uint64(len(t))] ^= 0x01
+        if m.Verify(t) {
+            return fmt.Errorf("%s %s: fail at mac forgery check: %d", p, p.Version(), i)
+        }
+
+        short.Reset()
+        short.Write(w[:i])
+        s := short.Sum(nil)
+        if len(s) != norx.BYTES_TAG_MIN || (p.TagSize() != norx.BYTES_TAG_MIN && bytes.Equal(s, c[:norx.BYTES_TAG_MIN])) {
+            return fmt.Errorf("%s %s: fail at mac truncation check: %d", p, p.Version(), i)
+        }
+    }
+    return nil
+}
+
+// check_siv checks the SIV mode: round trips, in-place use, forgeries and
+// that a repeated nonce only reveals whether all inputs were equal.
+func check_siv(p *norx.Params) error {
+
+    h, w, _, k, n := Inputs(p, 300)
+    h = h[:64]
+
+    var i uint64
+
+    x, err := norx.NewSIV(*p, k)
+    if p.NonceSize() < norx.BYTES_SIV_IV || p.TagSize() < p.KeySize() {
+        if err != norx.ErrParams {
+            return fmt.Errorf("%s %s: fail at siv parameter check", p, p.Version())
+        }
+        return nil
+    }
+    if err != nil {
+        return fmt.Errorf("%s %s: fail at siv init: %v", p, p.Version(), err)
+    }
+
+    for i = 16; i <= uint64(len(w)); i += 23 {
+        c := x.Seal(nil, n, w[:i], h)
+        if !bytes.Equal(c, x.Seal(nil, n, w[:i], h)) {
+            return fmt.Errorf("%s %s: fail at siv determinism check: %d", p, p.Version(), i)
+        }
+
+        // Changing the last byte of the message or the header under the same
+        // nonce changes the whole ciphertext, not only the affected bytes.
+        m := append([]uint8{}, w[:i]...)
+        m[i-1] ^= 0x01
+        d := x.Seal(nil, n, m, h)
+        e := x.Seal(nil, n, w[:i], h[:len(h)-1])
+        if bytes.Equal(c[:i-1], d[:i-1]) || bytes.Equal(c[i:], d[i:]) || bytes.Equal(c[:i], e[:i]) {
+            return fmt.Errorf("%s %s: fail at siv misuse check: %d", p, p.Version(), i)
+        }
+
+        o, err := x.Open(nil, n, c, h)
+        if err != nil || !bytes.Equal(o, w[:i]) {
+            return fmt.Errorf("%s %s: fail at siv open check: %d", p, p.Version(), i)
+        }
+
+        copy(m, w[:i])
+        if !bytes.Equal(c, x.Seal(m[:0], n, m, h)) {
+            return fmt.Errorf("%s %s: fail at siv in-place check: %d", p, p.Version(), i)
+        }
+
+        c[i/2] ^= 0x01
+        o = make([]uint8, i)
+        if _, err := x.Open(o[:0], n, c, h); err != norx.ErrAuth || cmp(o, make([]uint8, i), i) != 0 {
+            return fmt.Errorf("%s %s: fail at siv forgery check: %d", p, p.Version(), i)
+        }
+    }
+    return nil
+}
+
+// check_context compares the reusable context with AEAD_encrypt, including
+// in-place use. TestAllocs in the aead package checks that neither allocates.
+func check_context(p *norx.Params) error {
+
+    h, w, _, k, n := Inputs(p, 300)
+    h = h[:64]
+
+    var i uint64
+
+    x, err := norx.NewContext(*p, k)
+    if err != nil {
+        return fmt.Errorf("%s %s: fail at context init: %v", p, p.Version(), err)
+    }
+
+    var taglen = uint64(p.TagSize())
+    var clen uint64 = 0
+    c := make([]uint8, uint64(len(w)) + taglen)
+    b := make([]uint8, uint64(len(w)) + taglen)
+
+    for i = 0; i <= uint64(len(w)); i += 37 {
+        p.AEAD_encrypt(c, &clen, h, 19, w, i, h, 7, n, k)
+
+        copy(b, w[:i])
+        x.Reset(n)
+        if err := x.Encrypt(b, h[:19], b[:i], h[:7]); err != nil || cmp(b, c, clen) != 0 {
+            return fmt.Errorf("%s %s: fail at context encrypt check: %d", p, p.Version(), i)
+        }
+        x.Reset(n)
+        if err := x.Decrypt(b, h[:19], b[:clen], h[:7]); err != nil || cmp(b, w, i) != 0 {
+            return fmt.Errorf("%s %s: fail at context decrypt check: %d", p, p.Version(), i)
+        }
+    }
+
+    if err := x.Encrypt(c, nil, w, nil); err != norx.ErrPhase {
+        return fmt.Errorf("%s %s: fail at context phase check", p, p.Version())
+    }
+    x.Reset(n)
+    if err := x.Encrypt(c[1:], nil, c[:len(w)-1], nil); err != norx.ErrOverlap {
+        return fmt.Errorf("%s %s: fail at context overlap check", p, p.Version())
+    }
+    return nil
+}
 
 func cmp(a []uint8, b []uint8, len uint64) int {
 
@@ -4,7 +4,7 @@
 
     This file is part of the Go reference implementation of NORX.
 
-    :version: v2.0
+    :version: v2.0, v3.0
     :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
     :license: CC0, see LICENSE
 */
@@ -14,62 +14,102 @@ package utils
 import norx "github.com/daeinar/norx-go/aead"
 
 import "fmt"
+import "io"
 
-func print_bytes(in []uint8) {
+func print_bytes(out io.Writer, in []uint8) {
 
     for i := 0; i < len(in); i++ {
-        fmt.Printf("%02X ", in[i])
+        fmt.Fprintf(out, "%02X ", in[i])
         if i % 16 == 15 {
-            fmt.Printf("\n")
+            fmt.Fprintf(out, "\n")
         }
     }
 }
 
-func Debug() {
+// debug_tracer_t prints the state as four rows of four words.
+type debug_tracer_t struct {
+    out io.Writer
+    w   uint64
+}
+
+func (t *debug_tracer_t) Trace(phase norx.Phase, block uint64, state [norx.WORDS_STATE]uint64) {
+
+    if phase == norx.TRACE_INIT || phase == norx.TRACE_FINAL {
+        fmt.Fprintf(t.out, "State after %s:\n", phase)
+    } else {
+        fmt.Fprintf(t.out, "State after %s block %d:\n", phase, block)
+    }
+    for i := 0; i < norx.WORDS_STATE; i++ {
+        fmt.Fprintf(t.out, "%0*X ", t.w / 4, state[i])
+        if i % 4 == 3 {
+            fmt.Fprintf(t.out, "\n")
+        }
+    }
+}
+
+// Example returns the inputs of the example in the debug mode of the C
+// reference: the key 00 01 02 ..., the nonce F0 E0 D0 ... and 128 bytes
+// 00 01 02 ... each as header, message and trailer.
+func Example(p *norx.Params) (k, n, a, m, z []uint8) {
+
+    k = make([]uint8, p.KeySize())
+    n = make([]uint8, p.NonceSize())
+    a = make([]uint8, 128)
+    m = make([]uint8, 128)
+    z = make([]uint8, 128)
+
+    for i := range k { k[i] = uint8(i & 255) }
+    for i := range n { n[i] = uint8(0xF0 - 0x10 * (i & 15)) }
+    for i := range a { a[i] = uint8(i & 255) }
+    for i := range m { m[i] = uint8(i & 255) }
+    for i := range z { z[i] = uint8(i & 255) }
+    return
+}
 
-    var alen uint64 = 128
-    var mlen uint64 = 128
+// Debug writes a sample encryption and decryption with p to out together
+// with the state after every step, like the debug mode of the C reference.
+func Debug(out io.Writer, p *norx.Params) {
+
+    k, n, a, m, z := Example(p)
+
+    var alen = uint64(len(a))
+    var mlen = uint64(len(m))
     var clen uint64 = 0
-    var zlen uint64 = 128
-
-    k := [32]uint8{0x00,0x01,0x02,0x03,0x04,0x05,0x06,0x07,0x08,0x09,0x0A,0x0B,0x0C,0x0D,0x0E,0x0F,
-                   0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1A,0x1B,0x1C,0x1D,0x1E,0x1F}
-    n := [16]uint8{0xF0,0xE0,0xD0,0xC0,0xB0,0xA0,0x90,0x80,0x70,0x60,0x50,0x40,0x30,0x20,0x10,0x00}
-    a := make([]uint8, alen)
-    m := make([]uint8, mlen)
-    c := make([]uint8, mlen + 32)
-    z := make([]uint8, zlen)
-
-    var i uint64
-
-    for i = 0; i < alen; i++ { a[i] = uint8(i & 255) }
-    for i = 0; i < mlen; i++ { m[i] = uint8(i & 255) }
-    for i = 0; i < zlen; i++ { z[i] = uint8(i & 255) }
-
-    fmt.Printf("========== SETUP ==========\n")
-    fmt.Printf("Key:\n")
-    print_bytes(k[:])
-    fmt.Printf("Nonce:\n")
-    print_bytes(n[:])
-    fmt.Printf("Header:\n")
-    print_bytes(a[:])
-    fmt.Printf("Message:\n")
-    print_bytes(m[:])
-    fmt.Printf("Trailer:\n")
-    print_bytes(z[:])
-
-    fmt.Printf("========== ENCRYPTION ==========\n")
-    norx.AEAD_encrypt(c, &clen, a, alen, m, mlen, z, zlen, n[:], k[:])
-    fmt.Printf("Ciphertext + tag:\n")
-    print_bytes(c[:])
+    var zlen = uint64(len(z))
+
+    c := make([]uint8, mlen + uint64(p.TagSize()))
+
+    q := p.WithTracer(&debug_tracer_t{out: out, w: p.W})
+
+    fmt.Fprintf(out, "========== SETUP ==========\n")
+    fmt.Fprintf(out, "Variant: %s %s\n", p, p.Version())
+    fmt.Fprintf(out, "Key:\n")
+    print_bytes(out, k)
+    fmt.Fprintf(out, "Nonce:\n")
+    print_bytes(out, n)
+    fmt.Fprintf(out, "Header:\n")
+    print_bytes(out, a[:])
+    fmt.Fprintf(out, "Message:\n")
+    print_bytes(out, m[:])
+    fmt.Fprintf(out, "Trailer:\n")
+    print_bytes(out, z[:])
+
+    fmt.Fprintf(out, "========== ENCRYPTION ==========\n")
+    q.AEAD_encrypt(c, &clen, a, alen, m, mlen, z, zlen, n, k)
+    fmt.Fprintf(out, "Ciphertext + tag:\n")
+    print_bytes(out, c[:])
 
     m = make([]uint8, mlen)
     mlen = 0
 
-    fmt.Printf("========== DECRYPTION ==========\n")
-    result := norx.AEAD_decrypt(m, &mlen, a, alen, c, clen, z, zlen, n[:], k[:])
-    fmt.Printf("Decrypted message:\n")
-    print_bytes(m[:])
+    fmt.Fprintf(out, "========== DECRYPTION ==========\n")
+    err := q.AEAD_decrypt(m, &mlen, a, alen, c, clen, z, zlen, n, k)
+    fmt.Fprintf(out, "Decrypted message:\n")
+    print_bytes(out, m[:])
 
-    fmt.Printf("verify: %d\n", result)
+    if err != nil {
+        fmt.Fprintf(out, "verify: %v\n", err)
+    } else {
+        fmt.Fprintf(out, "verify: ok\n")
+    }
 }
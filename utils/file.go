@@ -0,0 +1,178 @@
+/*
+    file.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+
+package utils
+
+import norx "github.com/daeinar/norx-go/aead"
+
+import "bufio"
+import "crypto/rand"
+import "encoding/binary"
+import "errors"
+import "io"
+import "os"
+import "path/filepath"
+
+// An encrypted file consists of the container header
+//
+//     magic   4 bytes  "NORX"
+//     format  1 byte   FILE_FORMAT
+//     W, L, P 1 byte each
+//     T       2 bytes, big endian
+//     V       1 byte
+//     nonce   NonceSize() bytes
+//
+// followed by the ciphertext and the tag. The container header is absorbed
+// as the NORX header, so the variant and nonce are authenticated as well.
+const (
+    FILE_MAGIC  = "NORX"
+    FILE_FORMAT = 1
+    FILE_FIXED  = 11 // bytes of the container header before the nonce
+)
+
+var ErrFileFormat = errors.New("norx: not a NORX file or unsupported format")
+
+// Encrypt encrypts the file in with the key read from keyfile under a random
+// nonce and writes the container to out.
+func Encrypt(keyfile string, in string, out string, p *norx.Params) error {
+
+    key, err := read_key(keyfile, p)
+    if err != nil {
+        return err
+    }
+
+    header := make([]uint8, FILE_FIXED + p.NonceSize())
+    copy(header, FILE_MAGIC)
+    header[4] = FILE_FORMAT
+    header[5] = uint8(p.W)
+    header[6] = uint8(p.L)
+    header[7] = uint8(p.P)
+    binary.BigEndian.PutUint16(header[8:10], uint16(p.T))
+    header[10] = uint8(p.V)
+    nonce := header[FILE_FIXED:]
+    if _, err := rand.Read(nonce); err != nil {
+        return err
+    }
+
+    src, err := os.Open(in)
+    if err != nil {
+        return err
+    }
+    defer src.Close()
+
+    return write_atomic(out, func(dst io.Writer) error {
+        if _, err := dst.Write(header); err != nil {
+            return err
+        }
+        wr, err := norx.NewWriter(dst, *p, key, nonce, header, nil)
+        if err != nil {
+            return err
+        }
+        if _, err := io.Copy(wr, bufio.NewReader(src)); err != nil {
+            return err
+        }
+        return wr.Close()
+    })
+}
+
+// Decrypt decrypts the container in with the key read from keyfile and writes
+// the plaintext to out. If authentication fails, out is not created. Since
+// the variant is read before anything is authenticated, only the variants
+// known to Variant are accepted, with any valid tag size, so that a crafted
+// file cannot choose expensive parameters.
+func Decrypt(keyfile string, in string, out string) error {
+
+    src, err := os.Open(in)
+    if err != nil {
+        return err
+    }
+    defer src.Close()
+
+    r := bufio.NewReader(src)
+    fixed := make([]uint8, FILE_FIXED)
+    if _, err := io.ReadFull(r, fixed); err != nil {
+        return ErrFileFormat
+    }
+    if string(fixed[:4]) != FILE_MAGIC || fixed[4] != FILE_FORMAT {
+        return ErrFileFormat
+    }
+    p := norx.Params{
+        W: uint64(fixed[5]),
+        L: uint64(fixed[6]),
+        P: uint64(fixed[7]),
+        T: uint64(binary.BigEndian.Uint16(fixed[8:10])),
+        V: uint64(fixed[10]),
+    }
+    if err := p.Valid(); err != nil {
+        return err
+    }
+    q := p
+    q.T = 4 * q.W
+    if Variant(q.String(), q.Version()) == nil {
+        return norx.ErrParams
+    }
+    nonce := make([]uint8, p.NonceSize())
+    if _, err := io.ReadFull(r, nonce); err != nil {
+        return ErrFileFormat
+    }
+    header := append(fixed, nonce...)
+
+    key, err := read_key(keyfile, &p)
+    if err != nil {
+        return err
+    }
+    rd, err := norx.NewReader(r, p, key, nonce, header, nil)
+    if err != nil {
+        return err
+    }
+
+    return write_atomic(out, func(dst io.Writer) error {
+        _, err := io.Copy(dst, rd)
+        return err
+    })
+}
+
+func read_key(keyfile string, p *norx.Params) ([]uint8, error) {
+
+    key, err := os.ReadFile(keyfile)
+    if err != nil {
+        return nil, err
+    }
+    if len(key) != p.KeySize() {
+        return nil, norx.ErrKeySize
+    }
+    return key, nil
+}
+
+// write_atomic writes to a temporary file next to name and renames it to name
+// only if write succeeds, so a failed decryption leaves no output behind.
+func write_atomic(name string, write func(io.Writer) error) error {
+
+    tmp, err := os.CreateTemp(filepath.Dir(name), "." + filepath.Base(name) + ".tmp")
+    if err != nil {
+        return err
+    }
+    defer os.Remove(tmp.Name())
+
+    w := bufio.NewWriter(tmp)
+    if err := write(w); err != nil {
+        tmp.Close()
+        return err
+    }
+    if err := w.Flush(); err != nil {
+        tmp.Close()
+        return err
+    }
+    if err := tmp.Close(); err != nil {
+        return err
+    }
+    return os.Rename(tmp.Name(), name)
+}
@@ -4,7 +4,7 @@
 
     This file is part of the Go reference implementation of NORX.
 
-    :version: v2.0
+    :version: v2.0, v3.0
     :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
     :license: CC0, see LICENSE
 */
@@ -15,41 +15,70 @@ import norx "github.com/daeinar/norx-go/aead"
 
 import "fmt"
 
-func Genkat() {
+// A KAT set is a sweep of 256 encryptions whose header, message and trailer
+// lengths are given by lengths for i = 0, ..., 255. The default set uses
+// equal header and message lengths and no trailer. The trailer set sweeps
+// the trailer length from 0 to 255 while the header shrinks and the message
+// wraps around at 101 bytes, so all three lengths differ.
+type kat_set_t struct {
+    name    string // prefix of the checks in error messages
+    suffix  string // of the KAT function names
+    lengths func(i uint64) (hlen uint64, mlen uint64, tlen uint64)
+}
 
-    var wlen uint64 = 256
-    var hlen uint64 = 256
-    var tlen uint64 = 0
-    var klen uint64 = 32
-    var nlen uint64 = 16
+var kat_default = kat_set_t{"", "", func(i uint64) (uint64, uint64, uint64) { return i, i, 0 }}
+var kat_trailer = kat_set_t{"trailer ", "_trailer", func(i uint64) (uint64, uint64, uint64) { return 255 - i, i % 101, i }}
 
-    var w = make([]uint8, wlen)
-    var h = make([]uint8, hlen)
-    var t = make([]uint8, tlen)
-    var k = make([]uint8, klen)
-    var n = make([]uint8, nlen)
+// Inputs returns size bytes each of the header, message and trailer patterns
+// the KATs are computed from, and the key and nonce patterns for p. Checks and
+// tests take their inputs from here, or prefixes thereof.
+func Inputs(p *norx.Params, size int) (h, w, t, k, n []uint8) {
 
-    var i,j uint64
+    h = kat_pattern(size, 193)
+    w = kat_pattern(size, 197)
+    t = kat_pattern(size, 179)
+    k = kat_pattern(p.KeySize(), 191)
+    n = kat_pattern(p.NonceSize(), 181)
+    return
+}
+
+// kat_pattern returns size bytes of the form i*mul + 123, the patterns of the
+// KATs for the multipliers used by Inputs.
+func kat_pattern(size int, mul int) []uint8 {
+
+    var x = make([]uint8, size)
+    for i := range x { x[i] = uint8(255 & (i*mul + 123)) }
+    return x
+}
+
+// Genkat prints the default KATs of p as Go source.
+func Genkat(p *norx.Params) {
+    genkat(p, kat_default)
+}
+
+// GenkatTrailer prints the trailer KATs of p as Go source.
+func GenkatTrailer(p *norx.Params) {
+    genkat(p, kat_trailer)
+}
+
+func genkat(p *norx.Params, set kat_set_t) {
 
-    for i = 0; i < wlen; i++ { w[i] = uint8(255 & (i*197 + 123)) }
-    for i = 0; i < hlen; i++ { h[i] = uint8(255 & (i*193 + 123)) }
-    for i = 0; i < klen; i++ { k[i] = uint8(255 & (i*191 + 123)) }
-    for i = 0; i < nlen; i++ { n[i] = uint8(255 & (i*181 + 123)) }
+    var taglen = uint64(p.TagSize())
+    var h, w, t, k, n = Inputs(p, 256)
+
+    var i,j uint64
 
     fmt.Println("package utils")
-    fmt.Println("func getkat(i uint64, j uint64) []uint8 {")
+    fmt.Printf("func getkat%d%d%d%s%s(i uint64, j uint64) []uint8 {\n", p.W, p.L, p.P, kat_suffix(p), set.suffix)
     fmt.Println("kat := []uint8{")
-    for i = 0; i < wlen; i++ {
+    for i = 0; i < 256; i++ {
 
-        m := make([]uint8, 256)
-        c := make([]uint8, 256 + 32)
-        copy(m,w[:i+1])
+        hlen, mlen, tlen := set.lengths(i)
+        c := make([]uint8, 256 + taglen)
 
         var clen uint64 = 0
-        var mlen uint64 = i
-        var hlen uint64 = i
 
-        norx.AEAD_encrypt(c, &clen, h, hlen, m, mlen, t, tlen, n, k)
+        p.AEAD_encrypt(c, &clen, h, hlen, w, mlen, t, tlen, n, k)
 
         for j = 0; j < clen; j++ {
             fmt.Printf("0x%02X, ", c[j])
@@ -59,9 +88,23 @@ func Genkat() {
         }
         fmt.Println()
 
-        if i == wlen - 1 {
+        if i == 255 {
             fmt.Println("}")
         }
     }
     fmt.Println("return kat[i:j]\n}")
 }
+
+// kat_suffix distinguishes the KATs of later specification versions and of
+// truncated tags, the v2.0 KATs with the default tag size carry no suffix.
+func kat_suffix(p *norx.Params) string {
+
+    var s = ""
+    if p.V != norx.V20 {
+        s += fmt.Sprintf("_v%d", p.V)
+    }
+    if p.T != 4 * p.W {
+        s += fmt.Sprintf("_t%d", p.T)
+    }
+    return s
+}
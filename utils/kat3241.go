@@ -0,0 +1,4966 @@
+package utils
+func getkat3241(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xAF, 0x7C, 0xEF, 0x63, 0x5D, 0xEE, 0x94, 0x74, 
+0x9B, 0xC2, 0x03, 0x9E, 0xC0, 0x49, 0xE0, 0x81, 
+
+0x69, 0x63, 0x57, 0x72, 0xEC, 0x0B, 0x66, 0xF2, 
+0xE4, 0xB0, 0x1D, 0x30, 0x34, 0x29, 0x84, 0xE9, 
+0xA8, 
+
+0x02, 0x46, 0x13, 0xCC, 0x6B, 0x19, 0x96, 0xB3, 
+0x7E, 0x1C, 0xA6, 0x79, 0x00, 0x28, 0x19, 0x09, 
+0x9F, 0x86, 
+
+0x99, 0xAC, 0xEA, 0x8C, 0x2B, 0x70, 0x02, 0xBA, 
+0x86, 0xE9, 0xEB, 0x22, 0xEC, 0x23, 0xAF, 0x9A, 
+0x95, 0xD0, 0xE5, 
+
+0xFE, 0xFB, 0xA0, 0xBA, 0x20, 0x28, 0x50, 0xDC, 
+0xE5, 0xD6, 0x70, 0x26, 0x7A, 0x9E, 0x2A, 0xC3, 
+0x3F, 0xCB, 0xD7, 0x0C, 
+
+0xB9, 0x67, 0x98, 0x4F, 0xDF, 0x1B, 0x19, 0x66, 
+0x9C, 0xDE, 0xAE, 0x96, 0x76, 0xDB, 0xDE, 0x55, 
+0x95, 0x50, 0xD9, 0xC2, 0xBA, 
+
+0x62, 0x28, 0x9A, 0x03, 0xF3, 0xF2, 0xFB, 0x0E, 
+0xCF, 0xD4, 0x11, 0x74, 0x1D, 0x8E, 0x8B, 0xCD, 
+0x92, 0x52, 0x96, 0x5B, 0x64, 0x99, 
+
+0x2F, 0xCE, 0xB4, 0x86, 0x4C, 0x0A, 0x47, 0xEB, 
+0x98, 0x2A, 0xD2, 0xA1, 0x59, 0x9A, 0x5A, 0xB9, 
+0x0C, 0xA7, 0x7D, 0xB0, 0xA6, 0xFF, 0x05, 
+
+0xE0, 0x22, 0x71, 0xE2, 0xCD, 0xF8, 0x3C, 0x12, 
+0x8B, 0x4E, 0x28, 0x14, 0x6F, 0x0E, 0x18, 0xAE, 
+0x23, 0xDF, 0xD0, 0x59, 0x70, 0x9E, 0x54, 0x49, 
+
+0xF6, 0xB6, 0x9E, 0x08, 0xB8, 0x27, 0x54, 0x94, 
+0xD1, 0x0B, 0x93, 0x25, 0xEF, 0xD5, 0xE2, 0x19, 
+0xEF, 0xEA, 0x0C, 0xFC, 0x01, 0x75, 0xC2, 0x90, 
+0x00, 
+
+0x54, 0xBE, 0x7D, 0xFB, 0xF5, 0xCC, 0x52, 0xC6, 
+0x9A, 0x0F, 0x65, 0xBD, 0xF1, 0x53, 0x5D, 0x01, 
+0x07, 0xCC, 0xAB, 0xB9, 0xA5, 0x53, 0xFD, 0xE7, 
+0xE4, 0x74, 
+
+0xD7, 0x6E, 0x5B, 0x87, 0xD0, 0xC1, 0x68, 0x92, 
+0xD0, 0x80, 0x7D, 0x36, 0x8D, 0xE7, 0x36, 0xC5, 
+0x97, 0xE7, 0x46, 0xDA, 0xC9, 0x19, 0xD9, 0x98, 
+0xD9, 0xBD, 0x7B, 
+
+0x7E, 0xEB, 0x69, 0xAB, 0x77, 0x97, 0xDA, 0xE8, 
+0x47, 0xA4, 0x6E, 0x4A, 0x1D, 0xC9, 0x00, 0xF3, 
+0x4B, 0x2E, 0xCD, 0x56, 0xDF, 0xE1, 0xAF, 0x9E, 
+0xE8, 0xAD, 0x94, 0x48, 
+
+0xDF, 0xAB, 0x2B, 0xE7, 0x84, 0x9C, 0xBB, 0x96, 
+0x3F, 0x02, 0x99, 0x65, 0x42, 0x1A, 0x0D, 0x33, 
+0x97, 0xA8, 0x23, 0x7C, 0x59, 0x4E, 0xCC, 0x64, 
+0x54, 0x11, 0x6F, 0x09, 0x80, 
+
+0xB5, 0x4B, 0x1A, 0x8C, 0x05, 0x62, 0x60, 0x7A, 
+0xCC, 0xA8, 0x63, 0x7F, 0xCC, 0xC6, 0x13, 0x70, 
+0xF8, 0xC6, 0x09, 0x5C, 0x45, 0x93, 0x3E, 0x7C, 
+0x9E, 0x27, 0x23, 0x42, 0xAE, 0x52, 
+
+0x56, 0x3E, 0x38, 0xC7, 0x66, 0xCE, 0xB5, 0x8F, 
+0x77, 0xA1, 0x20, 0xE2, 0x69, 0x08, 0x92, 0x1E, 
+0x32, 0x01, 0x75, 0xD2, 0x05, 0x92, 0x1B, 0x60, 
+0x60, 0x9F, 0xD2, 0xA3, 0x89, 0x49, 0x6E, 
+
+0x01, 0x91, 0x5D, 0xDC, 0xC7, 0xB1, 0x4F, 0x6D, 
+0x73, 0x4D, 0x3B, 0x68, 0xA0, 0xA5, 0x1D, 0xA5, 
+0x22, 0x3C, 0xFE, 0xDC, 0x86, 0xB1, 0x64, 0x54, 
+0x92, 0xE1, 0x0F, 0x32, 0xD5, 0xA6, 0x4B, 0xAC, 
+
+0x13, 0xF8, 0xFC, 0xDB, 0x41, 0x62, 0x9F, 0x6E, 
+0xE6, 0x9B, 0xAB, 0xE5, 0x27, 0x3B, 0x8C, 0x15, 
+0x8C, 0xC8, 0x03, 0x38, 0xE3, 0x7E, 0x83, 0xDA, 
+0xA2, 0x5B, 0xA8, 0x44, 0xE2, 0x41, 0x47, 0x53, 
+0x2A, 
+
+0xD2, 0x73, 0x87, 0xC2, 0xF0, 0x28, 0x2A, 0x0F, 
+0xE6, 0x37, 0xC9, 0xAF, 0xF3, 0x02, 0xAC, 0xEA, 
+0x37, 0xB6, 0x8C, 0xA6, 0x37, 0x5F, 0x79, 0x89, 
+0xCD, 0x90, 0x6B, 0xCC, 0xF6, 0x7C, 0xEC, 0xD9, 
+0x75, 0xE6, 
+
+0x0B, 0x76, 0x2F, 0xAC, 0x9F, 0x20, 0xA1, 0x56, 
+0x5F, 0xF9, 0x67, 0xB8, 0xDC, 0xAC, 0x48, 0xA0, 
+0x00, 0xD3, 0xFE, 0x87, 0xAE, 0x13, 0x07, 0x26, 
+0xD9, 0xC2, 0x6D, 0xF9, 0xEC, 0xB4, 0xA2, 0x41, 
+0x17, 0xB6, 0x4C, 
+
+0xA2, 0xE6, 0x67, 0xBC, 0x65, 0x03, 0x88, 0xDC, 
+0xAD, 0x8B, 0x3C, 0x28, 0x83, 0x5F, 0xE3, 0x47, 
+0x95, 0xDA, 0xF9, 0x6A, 0x09, 0xE9, 0xF9, 0xD5, 
+0x58, 0xE4, 0xC3, 0x64, 0x3E, 0xE2, 0x82, 0x22, 
+0xC3, 0xF7, 0x50, 0x40, 
+
+0x73, 0xA2, 0xA1, 0xB7, 0xC4, 0xCC, 0x5F, 0xA6, 
+0x86, 0x7A, 0xFB, 0xF9, 0x2D, 0xB0, 0x92, 0x90, 
+0xDB, 0xD1, 0xD0, 0xD4, 0x7E, 0x83, 0xD8, 0xF7, 
+0x39, 0x38, 0xFA, 0xD4, 0x24, 0x56, 0x96, 0x1D, 
+0xF5, 0xB9, 0x35, 0x7A, 0x7B, 
+
+0xCB, 0xA1, 0x1C, 0x70, 0x7E, 0x42, 0xF7, 0x32, 
+0x68, 0x56, 0x27, 0xC7, 0xFD, 0x13, 0xCE, 0xC2, 
+0xD3, 0xEE, 0x3E, 0x33, 0xF3, 0xC3, 0xB4, 0x87, 
+0x31, 0x6E, 0xDA, 0xA1, 0x09, 0x77, 0x9E, 0xBE, 
+0x43, 0x8D, 0x11, 0xF9, 0x0B, 0x5B, 
+
+0x31, 0xD7, 0x0C, 0xE3, 0xD3, 0x37, 0xCA, 0xF9, 
+0x9E, 0x7E, 0x57, 0xF1, 0x45, 0x02, 0x7D, 0xDC, 
+0xA9, 0xBF, 0x73, 0x6B, 0x04, 0x16, 0x44, 0x6D, 
+0x62, 0x65, 0x2E, 0x5D, 0xBE, 0x75, 0xD0, 0xA2, 
+0x88, 0xF2, 0x60, 0xFE, 0x7B, 0xED, 0x74, 
+
+0x42, 0xD4, 0xAD, 0xFF, 0xDB, 0x5D, 0xBF, 0x2F, 
+0xA9, 0xE1, 0x7B, 0x60, 0xB8, 0xE8, 0x4D, 0x6F, 
+0xF9, 0x70, 0xE4, 0x26, 0x40, 0xFA, 0x19, 0x36, 
+0x07, 0x5C, 0xEA, 0x0F, 0x47, 0x89, 0xE0, 0x91, 
+0xD2, 0xF7, 0x75, 0xB7, 0x27, 0x3A, 0xCC, 0xEB, 
+
+0xCB, 0x20, 0xDD, 0xCD, 0x30, 0x13, 0xD8, 0x84, 
+0xD8, 0x57, 0xAA, 0xFA, 0x74, 0xDA, 0x46, 0x49, 
+0x35, 0x60, 0x93, 0x20, 0xAB, 0x32, 0x19, 0x02, 
+0x3C, 0x8F, 0x9F, 0x1E, 0xAD, 0x4B, 0x8F, 0x5A, 
+0xE5, 0x1F, 0xEA, 0x8B, 0x5C, 0x53, 0x81, 0x68, 
+0x0D, 
+
+0xF9, 0x00, 0x75, 0x76, 0x0E, 0x8B, 0x33, 0xAF, 
+0x83, 0xD4, 0xB4, 0x0D, 0xF1, 0x48, 0xD5, 0x02, 
+0x08, 0x6F, 0xEC, 0x1B, 0xB0, 0x40, 0x82, 0xF2, 
+0xF4, 0x52, 0xCB, 0x43, 0xE6, 0x0F, 0x1F, 0xBE, 
+0x15, 0xCE, 0x9D, 0xB8, 0xED, 0x3F, 0x7B, 0xD0, 
+0x94, 0xFF, 
+
+0x6C, 0x78, 0x7D, 0x34, 0x07, 0xA5, 0xB2, 0xA9, 
+0xF8, 0x8F, 0xB6, 0x4E, 0x0D, 0x31, 0x23, 0xD2, 
+0x71, 0xB5, 0x7D, 0x10, 0xBA, 0xA7, 0x35, 0x1F, 
+0xDE, 0x10, 0xE7, 0x21, 0x56, 0x50, 0xB1, 0xAD, 
+0x76, 0x03, 0x7C, 0x8C, 0x88, 0x12, 0x09, 0x46, 
+0xF4, 0x5F, 0x57, 
+
+0xFC, 0xAC, 0x41, 0xB1, 0x66, 0xBD, 0xFE, 0x96, 
+0x4D, 0xDA, 0x18, 0x9C, 0xDA, 0xA8, 0xC7, 0x6A, 
+0x94, 0x5D, 0x2B, 0x44, 0xB0, 0xF6, 0x89, 0x58, 
+0x9C, 0x30, 0xD3, 0xC8, 0x48, 0x2F, 0xE8, 0x9F, 
+0xB5, 0x33, 0x4C, 0x2A, 0x89, 0x15, 0x71, 0xDF, 
+0xDB, 0xA0, 0x4B, 0x00, 
+
+0x1F, 0x85, 0x54, 0x96, 0x15, 0x04, 0x80, 0x09, 
+0xDB, 0x13, 0xA7, 0x52, 0x03, 0x5F, 0x8C, 0x6E, 
+0x66, 0x9D, 0x65, 0x64, 0xB0, 0x73, 0xC0, 0x61, 
+0xCD, 0xF1, 0xEF, 0xE6, 0x01, 0x5B, 0x3B, 0x85, 
+0xB8, 0x9E, 0x54, 0xC8, 0x47, 0x9C, 0x8A, 0x9C, 
+0x27, 0x43, 0x4D, 0xB3, 0x33, 
+
+0x96, 0xEA, 0xF6, 0x72, 0xED, 0x28, 0x75, 0x42, 
+0x06, 0x81, 0xEA, 0x29, 0xDF, 0xE4, 0x06, 0x51, 
+0xD2, 0x3F, 0x42, 0xF5, 0x9C, 0xDD, 0x4C, 0xCD, 
+0xA3, 0xEC, 0x46, 0x65, 0xD8, 0xA2, 0x5D, 0x05, 
+0x6D, 0x5B, 0x46, 0x18, 0x44, 0xB0, 0x34, 0x02, 
+0x7A, 0xEF, 0x24, 0x2A, 0x73, 0x69, 
+
+0x41, 0xC9, 0xA4, 0x8C, 0xE0, 0x92, 0xB4, 0xAB, 
+0xCB, 0xFA, 0x94, 0xDF, 0xF5, 0x29, 0xFA, 0x23, 
+0x43, 0x0F, 0xF0, 0x96, 0x01, 0x58, 0xAC, 0x6E, 
+0x16, 0x5B, 0x59, 0xAA, 0x46, 0x10, 0xA5, 0x27, 
+0xF3, 0xD0, 0x6D, 0x7B, 0xA8, 0x44, 0x2E, 0x9D, 
+0xF9, 0x62, 0x87, 0xA4, 0xA2, 0xDC, 0x69, 
+
+0xA6, 0xBB, 0x70, 0x33, 0x8E, 0x2E, 0xBD, 0xF7, 
+0x24, 0xAF, 0x04, 0xAF, 0x33, 0x3C, 0x58, 0x6A, 
+0xB0, 0x83, 0xC6, 0x4B, 0xE6, 0xB6, 0x90, 0xDA, 
+0x29, 0xFC, 0x34, 0xDE, 0x50, 0x4E, 0x4F, 0x77, 
+0xD6, 0x31, 0x41, 0x80, 0x52, 0x06, 0x39, 0x11, 
+0x83, 0xA9, 0x34, 0x69, 0x87, 0xAC, 0x54, 0x28, 
+
+0x5E, 0x8D, 0x7B, 0xAE, 0xCD, 0x3B, 0x76, 0xBC, 
+0xD6, 0x0A, 0x5D, 0x7A, 0x91, 0x4C, 0x51, 0x5D, 
+0x54, 0xA6, 0x9D, 0xB8, 0xAD, 0xE9, 0xA3, 0xCB, 
+0x80, 0xAD, 0xE8, 0x72, 0x99, 0xFE, 0x31, 0xFA, 
+0x9F, 0x5E, 0xB4, 0x7A, 0x8A, 0x33, 0x19, 0x42, 
+0xF4, 0x7E, 0xB1, 0x68, 0x28, 0x72, 0xFD, 0xCB, 
+0x39, 
+
+0x4E, 0x1D, 0xFC, 0x0C, 0x9C, 0x55, 0x80, 0x6A, 
+0x05, 0x1B, 0x55, 0x77, 0x9D, 0x42, 0x98, 0x4A, 
+0x70, 0x70, 0x1E, 0x06, 0x48, 0xD3, 0x76, 0x72, 
+0x6A, 0x14, 0x71, 0xA2, 0xF9, 0xB1, 0xB2, 0xE9, 
+0x17, 0x08, 0x95, 0x20, 0x2A, 0x00, 0xDF, 0x29, 
+0x3B, 0xFA, 0x3C, 0xD2, 0x63, 0xAF, 0x24, 0xF9, 
+0x08, 0x09, 
+
+0xCB, 0x1E, 0x1E, 0x95, 0xE6, 0x40, 0xDF, 0x3F, 
+0x0C, 0x7B, 0x5B, 0x76, 0xFD, 0x13, 0xC4, 0x95, 
+0xBE, 0xCC, 0x66, 0xD9, 0x1D, 0x4F, 0xBD, 0x29, 
+0xBB, 0x3F, 0x5C, 0x3B, 0xA4, 0x0E, 0x4E, 0x76, 
+0xD4, 0x42, 0x6F, 0x64, 0xCA, 0x26, 0xAB, 0x7B, 
+0xA6, 0x9C, 0x54, 0x7B, 0x2A, 0x71, 0xBA, 0xC2, 
+0xF0, 0xF2, 0x6F, 
+
+0x63, 0xF4, 0x8B, 0x62, 0x4F, 0xE7, 0x46, 0xCC, 
+0x4F, 0x60, 0x20, 0x8B, 0x00, 0x57, 0xD0, 0xA7, 
+0xDF, 0x6F, 0x08, 0x5C, 0x6F, 0x00, 0x78, 0xA8, 
+0x5A, 0x77, 0x71, 0x65, 0x6E, 0x01, 0x71, 0x8D, 
+0x2B, 0x15, 0x90, 0x7E, 0x59, 0xA4, 0xF6, 0x23, 
+0xF3, 0x12, 0xBC, 0x3C, 0xFD, 0x21, 0x7D, 0x2E, 
+0x7E, 0x4F, 0x83, 0xD7, 
+
+0x76, 0xC1, 0x46, 0xC5, 0x86, 0x11, 0xE9, 0x28, 
+0x6A, 0x97, 0x26, 0xC7, 0x89, 0xA7, 0xCD, 0x01, 
+0x40, 0x53, 0xD6, 0x42, 0xB3, 0xF1, 0x48, 0x51, 
+0x11, 0xC6, 0x9E, 0xDF, 0x91, 0x5C, 0xD9, 0x4C, 
+0xB9, 0x68, 0x63, 0x6D, 0xBC, 0xCE, 0x6F, 0x5E, 
+0xE3, 0xFC, 0xAB, 0xCE, 0x24, 0x94, 0x5C, 0x43, 
+0x51, 0xB6, 0xE7, 0x99, 0x45, 
+
+0xC9, 0xC7, 0xCD, 0x1F, 0x2E, 0x48, 0x25, 0xC3, 
+0x1C, 0xF8, 0x85, 0x32, 0x15, 0x56, 0x82, 0x17, 
+0xF4, 0xDC, 0x5F, 0xAE, 0xEF, 0x94, 0xDD, 0xFA, 
+0xEA, 0xEE, 0xFC, 0x9B, 0x8C, 0xE1, 0x2E, 0xCF, 
+0xE3, 0x40, 0x03, 0xF7, 0xBD, 0x7B, 0x12, 0x56, 
+0x42, 0xCA, 0x1A, 0x68, 0xEF, 0xC3, 0xEF, 0xAC, 
+0xEB, 0xA8, 0xD8, 0x91, 0x8C, 0x42, 
+
+0x78, 0xF9, 0x51, 0x9E, 0x84, 0x32, 0xFC, 0xBA, 
+0x46, 0xA0, 0xF1, 0x04, 0x77, 0xF6, 0x93, 0xAD, 
+0xB3, 0x95, 0xA3, 0x69, 0xA4, 0xAD, 0x45, 0x89, 
+0x39, 0xFC, 0x08, 0x35, 0x45, 0xA9, 0xDC, 0x26, 
+0x55, 0xA2, 0x8E, 0x3E, 0xF5, 0xE3, 0x86, 0xD1, 
+0x1A, 0x37, 0x4C, 0x96, 0x16, 0x68, 0x06, 0x01, 
+0xF0, 0x0D, 0x02, 0x4F, 0x17, 0x34, 0x9C, 
+
+0xD8, 0xFF, 0x53, 0x2C, 0xEE, 0xA5, 0xEB, 0x21, 
+0xD8, 0x4C, 0x89, 0xCF, 0x7F, 0x15, 0x59, 0x5D, 
+0xDD, 0x45, 0x01, 0x6A, 0x71, 0x0B, 0x47, 0x2C, 
+0x72, 0x08, 0xC4, 0x5D, 0x71, 0x6A, 0x59, 0xDB, 
+0x46, 0x0E, 0xDF, 0xD7, 0x2B, 0xDA, 0xE1, 0x69, 
+0x0A, 0xE7, 0xD7, 0x5F, 0x18, 0x49, 0xC6, 0xA2, 
+0xA7, 0x1A, 0x2E, 0x94, 0xCD, 0x45, 0xD9, 0x5A, 
+
+0x51, 0x54, 0x80, 0x8F, 0x07, 0xDA, 0xCC, 0x7B, 
+0xB8, 0xE1, 0xE0, 0xEE, 0xF2, 0x7D, 0x8D, 0x2D, 
+0xF0, 0x50, 0x5A, 0xB6, 0xF5, 0xBC, 0x62, 0xBC, 
+0xA6, 0x75, 0xB7, 0x80, 0xBA, 0x6E, 0x1D, 0x7C, 
+0xD5, 0xC7, 0x42, 0x31, 0xA3, 0x98, 0x08, 0xFE, 
+0x13, 0xA2, 0xA6, 0x74, 0x4D, 0xF3, 0x20, 0x9E, 
+0xEB, 0x83, 0x64, 0x27, 0xE4, 0x9C, 0x3C, 0x1F, 
+0xC2, 
+
+0xF1, 0x24, 0xBC, 0xD3, 0x95, 0x66, 0x65, 0x69, 
+0xA7, 0x6E, 0x61, 0x08, 0x9C, 0xBF, 0xC3, 0x9B, 
+0x9B, 0x09, 0x05, 0x0B, 0x94, 0xB4, 0x64, 0xB2, 
+0x0E, 0x0F, 0x19, 0x0C, 0x6D, 0x92, 0xF5, 0xC3, 
+0xB8, 0x9B, 0xBF, 0x59, 0x00, 0x6D, 0x8D, 0x48, 
+0xE0, 0xB1, 0x06, 0xC2, 0x3E, 0x33, 0x26, 0x24, 
+0x6F, 0x53, 0xBE, 0x86, 0xD7, 0xEA, 0xCD, 0x18, 
+0x18, 0x24, 
+
+0xE8, 0xE1, 0xC4, 0x50, 0xEF, 0xB6, 0xF0, 0xCA, 
+0x78, 0xEF, 0x9C, 0x51, 0x61, 0x58, 0x18, 0xB7, 
+0x90, 0x09, 0x48, 0x73, 0xA1, 0x13, 0x6D, 0x16, 
+0x8D, 0x97, 0x84, 0x92, 0x25, 0x44, 0xBF, 0xF5, 
+0x3C, 0x9D, 0xCE, 0x68, 0x7E, 0x4B, 0x0A, 0x49, 
+0xE1, 0xAE, 0xA7, 0xB3, 0xC8, 0xE0, 0x7B, 0x54, 
+0xF9, 0xAB, 0x22, 0x0A, 0x19, 0x4F, 0x86, 0x1F, 
+0xB7, 0x0F, 0x3A, 
+
+0x23, 0xD4, 0x95, 0xC7, 0xF9, 0xB7, 0x17, 0x4B, 
+0xE8, 0x10, 0xF9, 0xB4, 0xCA, 0x9F, 0xBA, 0xBE, 
+0x66, 0xAA, 0x83, 0x98, 0x7D, 0x2B, 0xF4, 0x84, 
+0x18, 0x13, 0xCE, 0xBE, 0x10, 0x7D, 0x1B, 0x76, 
+0x89, 0xBC, 0x9C, 0xF4, 0xE6, 0x71, 0x62, 0xA7, 
+0x39, 0x89, 0xEF, 0x0D, 0xE9, 0x63, 0xD9, 0xB1, 
+0x96, 0x63, 0xAC, 0xBC, 0xC7, 0x85, 0x77, 0xE3, 
+0xD4, 0x40, 0x41, 0x5C, 
+
+0x0F, 0x8F, 0x47, 0xF8, 0xEA, 0x17, 0x30, 0x7E, 
+0x67, 0xE2, 0x69, 0xF5, 0x20, 0xD9, 0xE0, 0x87, 
+0xAB, 0xF6, 0x70, 0xBE, 0xF1, 0x26, 0x25, 0x01, 
+0x14, 0x69, 0xE8, 0x8E, 0xA9, 0x8E, 0xB2, 0xAE, 
+0xA5, 0x2C, 0x5F, 0x9D, 0x0C, 0x50, 0xC6, 0x95, 
+0xC1, 0xDE, 0xEC, 0x7D, 0xEA, 0x87, 0xCA, 0x02, 
+0xD7, 0x3F, 0x5E, 0x8A, 0xB3, 0x3A, 0xDA, 0x6A, 
+0x33, 0xAD, 0x06, 0xD4, 0x6D, 
+
+0xAB, 0x89, 0xF3, 0x78, 0x67, 0x63, 0xD5, 0xFC, 
+0xF2, 0x19, 0xD5, 0x91, 0x5C, 0x10, 0x86, 0x9F, 
+0xD2, 0xDC, 0x46, 0xD9, 0xC7, 0xAA, 0x4E, 0x44, 
+0xFF, 0x79, 0x5B, 0x8A, 0xE3, 0x47, 0x92, 0x20, 
+0xA8, 0xA3, 0x5C, 0x95, 0xEF, 0xD2, 0x21, 0x49, 
+0x8C, 0x66, 0x0D, 0xCD, 0xD2, 0x1D, 0x11, 0x22, 
+0x31, 0x62, 0x47, 0xC9, 0xD4, 0xC1, 0xBC, 0xB3, 
+0x80, 0x84, 0xB4, 0x71, 0xC1, 0xAB, 
+
+0x85, 0x76, 0x7F, 0x0D, 0x73, 0xE2, 0x6C, 0xB5, 
+0x98, 0x1F, 0x7E, 0x09, 0xFC, 0x2D, 0xD6, 0xA4, 
+0xE7, 0x57, 0xDC, 0x14, 0xCB, 0xD9, 0x7D, 0x46, 
+0xC9, 0x6E, 0xE7, 0x61, 0x0A, 0x5F, 0x3D, 0xAB, 
+0x4D, 0x39, 0x77, 0x96, 0xF5, 0xB0, 0x0C, 0x53, 
+0x21, 0xBB, 0xA2, 0xAC, 0xA9, 0xC6, 0x91, 0xB9, 
+0xF8, 0x03, 0xE2, 0xDD, 0xEE, 0x3B, 0xB7, 0xEB, 
+0xD8, 0xB7, 0xCE, 0xAC, 0x02, 0xA1, 0xA8, 
+
+0xAA, 0xBE, 0xDD, 0x59, 0x61, 0xF8, 0xD1, 0x11, 
+0x7A, 0xC2, 0x81, 0x7E, 0x61, 0x58, 0xAF, 0xA2, 
+0x67, 0xC1, 0xA2, 0x8F, 0xE1, 0xC7, 0x0D, 0x27, 
+0x2B, 0x4A, 0x9A, 0x6A, 0x77, 0x68, 0xFD, 0x68, 
+0x18, 0xF1, 0xDB, 0x55, 0xBA, 0xEC, 0x2A, 0x37, 
+0x7F, 0xD2, 0x68, 0xA7, 0xEF, 0x31, 0x77, 0x97, 
+0xDE, 0x6B, 0x56, 0xB1, 0x5E, 0xBE, 0xBD, 0x51, 
+0x07, 0x3E, 0xD6, 0xD9, 0x01, 0x64, 0xB8, 0x65, 
+
+0x52, 0x67, 0x5D, 0x5E, 0x11, 0xDA, 0x06, 0xC2, 
+0xA6, 0x8E, 0x41, 0xE4, 0x30, 0x59, 0x2F, 0x73, 
+0x40, 0x03, 0x88, 0x85, 0xE6, 0x91, 0x29, 0xCB, 
+0xA6, 0x68, 0x56, 0xCF, 0x05, 0xE4, 0xD7, 0xA2, 
+0x46, 0xC6, 0xC1, 0x06, 0x50, 0x0E, 0x1F, 0xAA, 
+0xC2, 0xB2, 0x6E, 0x2F, 0xB9, 0x1A, 0x9F, 0xC3, 
+0xC0, 0xD8, 0xD7, 0xF6, 0xCD, 0xD8, 0x92, 0xFB, 
+0x6A, 0x4F, 0xFA, 0x2D, 0x0E, 0x13, 0x01, 0xD8, 
+0x9A, 
+
+0x6B, 0xA5, 0xF2, 0x8D, 0x73, 0x10, 0x6F, 0x06, 
+0x2B, 0x8B, 0x10, 0x56, 0xF6, 0x5F, 0xC0, 0x94, 
+0x1C, 0x70, 0xB3, 0xBE, 0xEE, 0x00, 0x4D, 0x50, 
+0x94, 0x88, 0xC5, 0x51, 0x01, 0x8B, 0xE8, 0xA5, 
+0xF8, 0x89, 0x0F, 0x97, 0x7F, 0x0C, 0x67, 0x6F, 
+0xC7, 0x1F, 0x91, 0x20, 0xD7, 0x32, 0x9C, 0x63, 
+0xFF, 0xE7, 0xB8, 0x63, 0x0F, 0xE1, 0xCE, 0xC7, 
+0xEA, 0x82, 0x1D, 0x57, 0xEC, 0xB9, 0xF9, 0x05, 
+0x8D, 0x94, 
+
+0xFE, 0x5B, 0xB2, 0x66, 0xD8, 0xDF, 0x19, 0x49, 
+0x59, 0x8E, 0xBC, 0xC5, 0xC7, 0xD1, 0x6F, 0x0A, 
+0x8A, 0x5B, 0x0C, 0xF4, 0xC2, 0x35, 0x62, 0x61, 
+0x89, 0x2E, 0x43, 0x70, 0x7D, 0x5A, 0xE7, 0xF1, 
+0x63, 0x92, 0xDD, 0xD4, 0xBD, 0x61, 0x93, 0x8C, 
+0x21, 0x13, 0x96, 0xBA, 0xDB, 0x7A, 0x1B, 0x81, 
+0x43, 0x6B, 0x3F, 0x2C, 0x2E, 0x6B, 0xB2, 0x49, 
+0x2E, 0x28, 0xB2, 0x79, 0x52, 0x66, 0x89, 0x48, 
+0xE7, 0x97, 0x44, 
+
+0x4C, 0xAD, 0x82, 0xE3, 0x09, 0xEA, 0x08, 0x73, 
+0xF4, 0x16, 0x4D, 0x67, 0x33, 0x17, 0xB0, 0x47, 
+0x16, 0xA2, 0xAF, 0x99, 0xFE, 0x5A, 0x45, 0x70, 
+0x5C, 0xB2, 0xF2, 0x7A, 0x64, 0xC9, 0x65, 0xE3, 
+0x07, 0xAD, 0x8D, 0x77, 0x3F, 0x10, 0x9E, 0xCE, 
+0xC5, 0x63, 0xA2, 0x67, 0xE3, 0xFE, 0x48, 0xAC, 
+0x9A, 0x8F, 0x9E, 0xB9, 0x63, 0xB2, 0x2B, 0x59, 
+0xF1, 0x53, 0x18, 0x95, 0xA8, 0x12, 0x28, 0xAF, 
+0xCF, 0x97, 0x9A, 0xC9, 
+
+0x5C, 0x23, 0xC9, 0xB0, 0x2F, 0x8E, 0x8B, 0x75, 
+0xBC, 0xD3, 0x76, 0x22, 0xD0, 0x83, 0x85, 0x0A, 
+0xA8, 0xC1, 0xF6, 0x06, 0xD5, 0x80, 0x59, 0xEB, 
+0xF5, 0xD2, 0xBF, 0x35, 0x76, 0x64, 0xF6, 0x40, 
+0x2B, 0xC4, 0x59, 0xA4, 0x8A, 0x21, 0x78, 0x77, 
+0xBD, 0x4C, 0x2D, 0x11, 0x24, 0x31, 0x33, 0xF4, 
+0x8D, 0x89, 0x61, 0xA4, 0xD9, 0x74, 0xEC, 0xEA, 
+0xC9, 0x90, 0x9C, 0x79, 0x5D, 0x4B, 0x9E, 0x92, 
+0xB5, 0x0D, 0x94, 0xE4, 0xCE, 
+
+0xAC, 0xE3, 0xD3, 0xD0, 0x7C, 0x51, 0x6F, 0xD7, 
+0x73, 0xC9, 0x82, 0x6B, 0x9A, 0x6C, 0x8D, 0x56, 
+0xB4, 0x17, 0xB7, 0xD8, 0xAD, 0x80, 0xA7, 0xFC, 
+0xCA, 0xD5, 0x5B, 0x8E, 0x3A, 0xE3, 0x4B, 0x6B, 
+0xFD, 0x75, 0x1B, 0xD1, 0xE5, 0xBA, 0x64, 0xD8, 
+0xC4, 0x29, 0x83, 0xF8, 0x18, 0x98, 0xA2, 0x96, 
+0x10, 0xB2, 0x3E, 0x60, 0x04, 0xE9, 0x9A, 0x68, 
+0x2D, 0xFD, 0x41, 0x6A, 0xB7, 0xB4, 0xB8, 0x81, 
+0x08, 0x54, 0x7A, 0xBF, 0xD1, 0x91, 
+
+0xA9, 0xB0, 0xF9, 0x25, 0x66, 0xC3, 0xF5, 0x4D, 
+0x9A, 0x9A, 0x18, 0x15, 0x8E, 0x06, 0x8E, 0xC3, 
+0x75, 0x7B, 0x99, 0xE2, 0x07, 0x49, 0x41, 0x19, 
+0xA1, 0x7E, 0x3E, 0x13, 0xED, 0x3E, 0xB9, 0x66, 
+0x89, 0x37, 0x37, 0xE3, 0xBC, 0xC4, 0xA7, 0xA5, 
+0xB4, 0x5A, 0x57, 0x1B, 0xF7, 0xAD, 0x54, 0x13, 
+0xF5, 0x4F, 0x80, 0x3F, 0xF9, 0x02, 0x89, 0xCA, 
+0xFE, 0x2F, 0xE3, 0xC5, 0x63, 0xB6, 0x4D, 0x72, 
+0x95, 0x25, 0x51, 0xFE, 0xD7, 0x4C, 0x7B, 
+
+0xB9, 0x14, 0xD1, 0xAD, 0x98, 0x4B, 0x37, 0x28, 
+0x4B, 0x73, 0xFE, 0x28, 0x3F, 0x0B, 0x83, 0xC4, 
+0xB6, 0xED, 0x8D, 0x4E, 0x25, 0x12, 0xB5, 0x41, 
+0xB3, 0xAC, 0x2C, 0x2A, 0x8B, 0x5C, 0x3D, 0xE6, 
+0x69, 0xE1, 0x99, 0x18, 0x31, 0x78, 0xCB, 0xAC, 
+0xA8, 0xE7, 0x7F, 0x44, 0xFF, 0x87, 0xBB, 0x92, 
+0xBD, 0x98, 0xEF, 0xD3, 0x86, 0x1B, 0xD3, 0xB5, 
+0xB2, 0x78, 0x56, 0x1D, 0x7A, 0x0C, 0x4D, 0xC7, 
+0x15, 0xA1, 0x68, 0x10, 0x85, 0x19, 0x39, 0x9A, 
+
+0x4B, 0x01, 0x86, 0x3D, 0x0D, 0xB2, 0x31, 0x1F, 
+0xA1, 0xCA, 0x12, 0x0C, 0x59, 0x69, 0xEC, 0x14, 
+0x62, 0xC9, 0x17, 0xC2, 0x61, 0xF1, 0x60, 0x2B, 
+0x8C, 0x46, 0x80, 0x62, 0x9E, 0x7E, 0xE4, 0x21, 
+0xC3, 0x3F, 0xF7, 0x4C, 0x31, 0xCE, 0x8B, 0x4C, 
+0x87, 0x48, 0xD9, 0x60, 0x39, 0xFD, 0xFC, 0x12, 
+0x15, 0x2F, 0x35, 0x74, 0x79, 0xEB, 0x87, 0x20, 
+0x72, 0x23, 0xE6, 0x66, 0x5A, 0xD4, 0xFB, 0x72, 
+0xA9, 0x6B, 0xDB, 0xB4, 0x30, 0xFD, 0x80, 0xF5, 
+0xBB, 
+
+0xBC, 0xD6, 0x2A, 0xC2, 0x2E, 0x81, 0x71, 0xF7, 
+0xF9, 0x29, 0x5B, 0xBB, 0x8C, 0x84, 0xB3, 0xB2, 
+0x47, 0xF4, 0xBB, 0x9C, 0x82, 0xC0, 0x35, 0xA2, 
+0xEA, 0xA7, 0x4E, 0xA5, 0x5E, 0xD5, 0x0D, 0xDF, 
+0x89, 0x3E, 0xC1, 0x3F, 0x8B, 0x82, 0xE5, 0xEB, 
+0x4D, 0x87, 0xD9, 0x73, 0xAC, 0x06, 0x8A, 0xB4, 
+0xF3, 0x2C, 0x83, 0xCB, 0x08, 0xB5, 0xB2, 0x74, 
+0x16, 0xD0, 0x97, 0x87, 0x53, 0x6C, 0x73, 0x7B, 
+0x20, 0x0D, 0x18, 0x7F, 0x3A, 0xD6, 0x3D, 0xF0, 
+0x9E, 0x00, 
+
+0xE9, 0x20, 0xCF, 0x62, 0x26, 0x4F, 0xA8, 0xA2, 
+0x8C, 0xCC, 0x32, 0xDE, 0x6B, 0xA5, 0x44, 0xB7, 
+0xD0, 0x25, 0x48, 0x4B, 0xB7, 0x10, 0x61, 0x3B, 
+0x93, 0xFB, 0xFB, 0x57, 0x02, 0xBC, 0x02, 0xD2, 
+0x8E, 0x33, 0x08, 0xBF, 0xA2, 0x02, 0xB4, 0xDF, 
+0x86, 0x33, 0xCD, 0xCD, 0x7C, 0x3B, 0x8D, 0xDB, 
+0x90, 0x3A, 0xF3, 0x55, 0x82, 0x73, 0x5F, 0x27, 
+0x8D, 0xED, 0x06, 0x08, 0x18, 0x27, 0xAF, 0xF8, 
+0x7B, 0x01, 0x4E, 0xB0, 0xF2, 0xE8, 0xD9, 0x73, 
+0x43, 0x59, 0xB0, 
+
+0x92, 0x47, 0x3D, 0x62, 0x6D, 0x82, 0xB4, 0x89, 
+0x35, 0xCB, 0x4A, 0x66, 0x24, 0xF1, 0x14, 0x40, 
+0xA6, 0xAD, 0xE1, 0xD4, 0xC8, 0xFB, 0x08, 0x90, 
+0xFC, 0x33, 0xA8, 0x2E, 0xCF, 0x38, 0x4C, 0x45, 
+0xF8, 0x8D, 0x4B, 0x11, 0xF8, 0x7F, 0xD8, 0x4F, 
+0x22, 0x2D, 0x7B, 0x38, 0x8C, 0x8F, 0xDF, 0x8B, 
+0xF1, 0x4B, 0xCF, 0x46, 0xE5, 0xCC, 0x8D, 0x0A, 
+0x7A, 0x0E, 0xF8, 0xDE, 0xCE, 0x10, 0xCA, 0xCD, 
+0xA8, 0x30, 0x0A, 0x63, 0x0E, 0xA0, 0x32, 0x54, 
+0x5C, 0x77, 0x49, 0xDC, 
+
+0xED, 0xA1, 0x20, 0xA9, 0x5E, 0x2A, 0xBC, 0xBC, 
+0x1A, 0x8D, 0x48, 0x71, 0x4E, 0x3A, 0xFA, 0xEA, 
+0x8F, 0x78, 0x3F, 0xA6, 0x61, 0x28, 0xC3, 0xC9, 
+0x01, 0xCF, 0xD4, 0x2E, 0x36, 0x40, 0x90, 0xA8, 
+0xCA, 0x45, 0x40, 0xFE, 0x9E, 0xB6, 0x40, 0x28, 
+0xFB, 0x28, 0x3E, 0x07, 0xFC, 0xA1, 0x1A, 0x8D, 
+0xA8, 0x5B, 0xB1, 0x1A, 0xE3, 0x18, 0xDC, 0xB3, 
+0x19, 0x14, 0xF9, 0xB9, 0x9C, 0xF2, 0x15, 0xE2, 
+0x70, 0xD5, 0x74, 0xFF, 0x1E, 0xED, 0x92, 0x0C, 
+0xC9, 0xE7, 0x57, 0x55, 0x8B, 
+
+0xD5, 0x15, 0x63, 0x0A, 0x41, 0xF9, 0x2B, 0x15, 
+0x55, 0x1E, 0x63, 0x96, 0xC3, 0xF0, 0x21, 0x90, 
+0xFB, 0xB9, 0xC7, 0xFE, 0xEE, 0x15, 0x2C, 0x28, 
+0xBB, 0x2C, 0x9D, 0x6F, 0x8E, 0xAB, 0x73, 0xD3, 
+0x03, 0x39, 0xE9, 0xB4, 0xEC, 0xED, 0x48, 0x1F, 
+0xDA, 0x87, 0x29, 0xA1, 0x4B, 0xD7, 0xC8, 0xCD, 
+0xDB, 0xF0, 0xCF, 0x82, 0x54, 0x27, 0xBF, 0x20, 
+0xF4, 0xD4, 0x52, 0xED, 0x64, 0xA2, 0x4A, 0x6D, 
+0xC8, 0x78, 0x1E, 0x83, 0x03, 0xBA, 0x22, 0x9C, 
+0xFC, 0xB1, 0xE0, 0x67, 0xC7, 0x23, 
+
+0x62, 0xB2, 0xA4, 0xD4, 0xCD, 0xAE, 0xD3, 0xF1, 
+0xFB, 0x71, 0x5C, 0xDD, 0xD6, 0x51, 0x91, 0x9D, 
+0x48, 0xBD, 0x3C, 0xF9, 0x0C, 0x05, 0xEB, 0xF8, 
+0x55, 0x48, 0x03, 0xE6, 0x9C, 0x13, 0x9E, 0xB8, 
+0x6B, 0xB5, 0x09, 0xB3, 0x89, 0x04, 0x96, 0xFD, 
+0x1C, 0xE8, 0x0F, 0xC9, 0x19, 0x85, 0xE4, 0xCC, 
+0xBB, 0xDB, 0xC3, 0x15, 0x69, 0xD9, 0xA1, 0x91, 
+0x9B, 0x16, 0xE0, 0xD0, 0x74, 0x3F, 0xF4, 0x2D, 
+0x33, 0x74, 0x02, 0x1D, 0xE3, 0xDB, 0xF8, 0xD3, 
+0x33, 0xD9, 0x2F, 0x77, 0x94, 0x6B, 0x20, 
+
+0x86, 0x99, 0xFC, 0xEF, 0x12, 0x8E, 0x6E, 0x84, 
+0x7A, 0x25, 0xD3, 0xB6, 0x37, 0xEE, 0x42, 0x0D, 
+0x88, 0xE2, 0x53, 0xD7, 0x59, 0x4A, 0x73, 0x67, 
+0xA6, 0x20, 0xA3, 0x91, 0x7C, 0x1F, 0xCA, 0x18, 
+0xC9, 0xEF, 0xD1, 0xD0, 0xE0, 0xC7, 0x23, 0x14, 
+0x03, 0x05, 0x7F, 0x84, 0xCD, 0xDB, 0xDB, 0x2D, 
+0x31, 0x33, 0xBE, 0xD5, 0x1A, 0xDC, 0xC2, 0xBE, 
+0x6C, 0x9B, 0x35, 0xFB, 0xAD, 0xC1, 0xDE, 0xDC, 
+0xDC, 0xB9, 0xDE, 0xF5, 0x38, 0xCF, 0x06, 0x95, 
+0x53, 0x24, 0x47, 0x9F, 0xB8, 0x41, 0x84, 0x9B, 
+
+0x13, 0x83, 0x54, 0x9F, 0x66, 0xE7, 0x40, 0x2D, 
+0xD9, 0xB5, 0xB9, 0xAC, 0xB0, 0x08, 0x06, 0x54, 
+0x50, 0x34, 0x07, 0x8A, 0x2E, 0x0B, 0xF2, 0x06, 
+0x85, 0xF2, 0x8C, 0xC1, 0x24, 0x2D, 0x9F, 0x66, 
+0x9A, 0x94, 0x93, 0x0E, 0xFA, 0x5E, 0xCC, 0x1D, 
+0x74, 0xAE, 0x8F, 0xAA, 0x51, 0x0E, 0x7F, 0xD4, 
+0x61, 0xA2, 0x29, 0xAB, 0xE9, 0x70, 0x8F, 0x99, 
+0xFF, 0x11, 0x38, 0x84, 0x63, 0x6D, 0x91, 0xB8, 
+0xF8, 0x08, 0x01, 0x08, 0x65, 0x39, 0xCF, 0x42, 
+0x34, 0x29, 0x36, 0xDE, 0x29, 0xE9, 0x44, 0xFE, 
+0x7E, 
+
+0x13, 0x26, 0xF8, 0xB2, 0xD9, 0x68, 0xE5, 0x81, 
+0x20, 0xE6, 0xA3, 0xC8, 0x34, 0x43, 0x4D, 0xCE, 
+0xA7, 0x87, 0x10, 0x98, 0x35, 0x91, 0x70, 0xF0, 
+0x1D, 0xA4, 0xFB, 0x73, 0x00, 0x8E, 0x15, 0x49, 
+0x14, 0xF8, 0xE8, 0x11, 0xA6, 0xBD, 0xEE, 0x7D, 
+0x51, 0x49, 0x52, 0x5F, 0x0D, 0x63, 0x8D, 0x52, 
+0x40, 0x4D, 0xF5, 0x40, 0x15, 0x04, 0x7C, 0xE2, 
+0x23, 0xCE, 0x1F, 0x37, 0x15, 0x2C, 0xDF, 0x92, 
+0xDC, 0xF7, 0xAB, 0xA5, 0x2B, 0xE8, 0x8C, 0xF9, 
+0xF1, 0xBC, 0x42, 0x79, 0xE0, 0x6B, 0xBF, 0xEA, 
+0x74, 0xBA, 
+
+0xB4, 0x5B, 0x74, 0xCE, 0x30, 0x5A, 0x8C, 0x7B, 
+0xCE, 0x65, 0x3E, 0x64, 0xEE, 0x0D, 0xC8, 0x91, 
+0x2E, 0x57, 0x6B, 0xE6, 0xD1, 0x95, 0xCC, 0x64, 
+0xB6, 0x0E, 0x67, 0xD2, 0xB3, 0x52, 0x78, 0x18, 
+0x1E, 0xE6, 0x49, 0x32, 0x61, 0x68, 0x94, 0x19, 
+0x98, 0x54, 0xDB, 0x92, 0x8B, 0x9B, 0x4E, 0x75, 
+0x93, 0x72, 0x59, 0x63, 0x8D, 0x3F, 0x2F, 0x9C, 
+0x4D, 0xC6, 0x94, 0x60, 0xDD, 0xD9, 0xB3, 0xB4, 
+0x04, 0xC0, 0xBC, 0x49, 0x94, 0x01, 0x61, 0x1B, 
+0xF0, 0xB6, 0x94, 0xCF, 0x21, 0xE6, 0x96, 0x77, 
+0xDF, 0xB9, 0x13, 
+
+0xB2, 0xEB, 0xE5, 0x13, 0x22, 0xCD, 0x5F, 0x6C, 
+0x28, 0xB3, 0xCD, 0x37, 0x6A, 0xCC, 0xC8, 0x59, 
+0xBC, 0x0A, 0x44, 0xC0, 0x53, 0xB7, 0xD9, 0x1F, 
+0x6A, 0xD2, 0x7D, 0x34, 0xB8, 0x9F, 0xBF, 0xA7, 
+0xF2, 0x87, 0x09, 0xF7, 0xE0, 0xDE, 0x43, 0x66, 
+0xD8, 0xAF, 0x04, 0x03, 0x2A, 0x48, 0xBC, 0x70, 
+0x49, 0x34, 0x05, 0x03, 0x85, 0xFC, 0x30, 0x78, 
+0xE5, 0x2A, 0x30, 0x65, 0x95, 0x62, 0xD6, 0x0B, 
+0x5C, 0xD6, 0x97, 0x4C, 0x65, 0x0A, 0x94, 0xB5, 
+0x2E, 0xF7, 0x86, 0x30, 0x63, 0x4F, 0xA8, 0x72, 
+0xAD, 0xF4, 0x19, 0xFB, 
+
+0x9B, 0x61, 0xE9, 0xE9, 0x8D, 0xB0, 0x02, 0x06, 
+0x4D, 0x1C, 0x28, 0x5C, 0x65, 0x7E, 0x89, 0x02, 
+0x47, 0x76, 0x1A, 0x1E, 0xEC, 0x64, 0xBA, 0x8D, 
+0x59, 0xF0, 0xC5, 0xCE, 0x89, 0xCF, 0x11, 0x5E, 
+0x3A, 0xBF, 0x8A, 0x32, 0x63, 0xFB, 0xD8, 0xC7, 
+0x56, 0xA8, 0x6F, 0xF3, 0x2B, 0xC8, 0x5B, 0x8F, 
+0xEC, 0x2C, 0x91, 0x35, 0x60, 0xE4, 0x84, 0x09, 
+0x2F, 0x36, 0x8E, 0x4F, 0xFB, 0x06, 0xBD, 0x53, 
+0xC4, 0x8A, 0x6B, 0x3B, 0xFA, 0x04, 0x50, 0x15, 
+0x17, 0x63, 0x46, 0x9C, 0x54, 0xF6, 0xB6, 0xA9, 
+0xEA, 0xE5, 0x60, 0x5E, 0x75, 
+
+0xC6, 0x4D, 0xA8, 0x7B, 0xC5, 0xC5, 0xDA, 0x2B, 
+0xBB, 0x19, 0xA2, 0x36, 0xBE, 0x76, 0xA7, 0x34, 
+0x92, 0xD4, 0xE2, 0xA5, 0xF3, 0xF1, 0xF8, 0xBE, 
+0xA0, 0xA3, 0xB8, 0xE2, 0x3C, 0x39, 0x34, 0x84, 
+0xE5, 0xF7, 0x48, 0xF3, 0xD1, 0x4F, 0x4E, 0xC2, 
+0x4E, 0xB3, 0xC2, 0xBB, 0xE0, 0x1E, 0xAA, 0xBF, 
+0x42, 0x62, 0x90, 0x4D, 0x62, 0xF5, 0x79, 0xE5, 
+0x50, 0x25, 0x03, 0x37, 0x90, 0xAA, 0xF7, 0xFC, 
+0xEB, 0xC0, 0x32, 0xDA, 0x1C, 0xD7, 0x1E, 0xA5, 
+0x1C, 0x49, 0xF4, 0xE4, 0x94, 0x2D, 0x88, 0xA8, 
+0x24, 0x7C, 0x0D, 0x1F, 0xB1, 0xA7, 
+
+0xE5, 0x1F, 0xCF, 0xE1, 0x7A, 0x7C, 0xF5, 0x66, 
+0x6E, 0x92, 0x41, 0x68, 0x89, 0x5C, 0xD7, 0xB4, 
+0x24, 0x06, 0xEE, 0x3D, 0x0D, 0xC7, 0xDF, 0xA9, 
+0x48, 0x39, 0xCB, 0x8E, 0x79, 0xE9, 0xA8, 0x3D, 
+0xB9, 0xFC, 0x97, 0xF1, 0x03, 0x06, 0xEB, 0xFB, 
+0x14, 0x97, 0x96, 0xA1, 0xFB, 0x25, 0x12, 0x6D, 
+0xA9, 0x2B, 0x0C, 0xC1, 0x27, 0x87, 0x4D, 0x17, 
+0xDE, 0xEA, 0xD3, 0xEE, 0x3A, 0xA9, 0xD5, 0x80, 
+0x52, 0x0E, 0xBB, 0x15, 0xA8, 0x16, 0x58, 0x25, 
+0x7A, 0x6C, 0x55, 0x30, 0x80, 0xCE, 0x9B, 0x1E, 
+0x97, 0x21, 0xD7, 0xEE, 0x5D, 0x4C, 0xE6, 
+
+0xEE, 0x3C, 0x03, 0x94, 0x28, 0xD8, 0xED, 0x96, 
+0x2E, 0x67, 0x08, 0xEE, 0xDB, 0x31, 0xD9, 0x42, 
+0x10, 0x51, 0x99, 0x56, 0x68, 0x60, 0xA6, 0x79, 
+0x19, 0x7A, 0x54, 0xC3, 0xBD, 0x2A, 0x2D, 0x69, 
+0xF1, 0xE2, 0xEF, 0xA7, 0x26, 0x3D, 0x9A, 0x6F, 
+0x26, 0x8D, 0xE3, 0x0E, 0x3B, 0xFB, 0xCC, 0xFF, 
+0x65, 0x09, 0xAD, 0x24, 0xEE, 0xA8, 0xD8, 0x55, 
+0x83, 0xF5, 0xEA, 0x61, 0x07, 0x6F, 0x90, 0x54, 
+0x3C, 0x1A, 0x8E, 0xB0, 0x89, 0x79, 0x8A, 0xA7, 
+0x35, 0x05, 0x7B, 0xAF, 0xF0, 0xBC, 0x94, 0xB1, 
+0x59, 0xF2, 0xB8, 0xAA, 0xC9, 0x92, 0x9B, 0x4A, 
+
+0xF6, 0xF8, 0x0F, 0x47, 0xEF, 0xEB, 0x09, 0xB2, 
+0xB3, 0xC6, 0x0E, 0xE1, 0xA5, 0xA2, 0x62, 0x6D, 
+0x44, 0x25, 0xEE, 0xEE, 0x35, 0xD0, 0x18, 0xBE, 
+0x07, 0xC3, 0xAD, 0x9E, 0x8E, 0x93, 0xBB, 0x78, 
+0xD7, 0x8F, 0x47, 0x6A, 0x27, 0x4C, 0x39, 0x18, 
+0xD1, 0xC5, 0xA9, 0x9F, 0x31, 0x7F, 0x47, 0x6A, 
+0x95, 0x35, 0x74, 0x6B, 0x1C, 0xD5, 0x33, 0x51, 
+0x68, 0x58, 0x67, 0x89, 0xA8, 0x43, 0x9B, 0xE5, 
+0xB4, 0x94, 0x5E, 0x62, 0xD5, 0x1C, 0xCB, 0x31, 
+0x1E, 0x5C, 0xA8, 0x10, 0xA4, 0xC0, 0x62, 0xDE, 
+0x19, 0xCC, 0x17, 0x09, 0x4A, 0x5B, 0x88, 0xC3, 
+0xE4, 
+
+0xFB, 0xD9, 0x1D, 0x95, 0x93, 0x0F, 0xBA, 0xDB, 
+0x70, 0x42, 0x47, 0x89, 0xB4, 0xF2, 0xCB, 0xF9, 
+0x65, 0x5F, 0x8D, 0xC7, 0x44, 0x51, 0x39, 0x7F, 
+0xCC, 0xD2, 0x8A, 0xA7, 0x14, 0xFE, 0xFC, 0x4A, 
+0x36, 0x50, 0x6E, 0x18, 0xF9, 0xAF, 0x0E, 0xB5, 
+0xEC, 0x1B, 0xCB, 0x66, 0xE0, 0x1A, 0x41, 0xC2, 
+0xA8, 0x5F, 0x62, 0x35, 0xE5, 0x9C, 0xCB, 0x35, 
+0x40, 0x51, 0x54, 0x25, 0xA6, 0xAB, 0x3D, 0x87, 
+0x13, 0x58, 0x6C, 0x39, 0xEA, 0x09, 0xDE, 0xF4, 
+0xD9, 0x8D, 0x55, 0xF3, 0xBF, 0x18, 0xDC, 0xD5, 
+0x9E, 0xD2, 0xCE, 0x9D, 0x38, 0x99, 0x16, 0xB3, 
+0x67, 0xAB, 
+
+0xFF, 0x15, 0x86, 0xD2, 0x9C, 0x6E, 0xBB, 0xC6, 
+0x3F, 0xB4, 0x57, 0x9D, 0x0C, 0x84, 0xCF, 0xE3, 
+0xAB, 0x55, 0xA6, 0x11, 0x6A, 0xBB, 0xB8, 0x3A, 
+0x4C, 0x18, 0x53, 0x53, 0x54, 0xAE, 0x1F, 0x48, 
+0x81, 0x98, 0x10, 0x13, 0x95, 0xE5, 0xF7, 0xC9, 
+0x7A, 0xE5, 0x08, 0x3D, 0xD2, 0xE3, 0xE0, 0x63, 
+0x08, 0x2C, 0x53, 0x35, 0x4A, 0xFA, 0xB1, 0x33, 
+0x8C, 0xE7, 0x4B, 0xE7, 0x56, 0x9C, 0x97, 0x26, 
+0xC8, 0xD7, 0x8F, 0x37, 0xF0, 0x8C, 0x69, 0x30, 
+0x6C, 0x6B, 0xE9, 0x50, 0x03, 0xDC, 0xF3, 0x06, 
+0x02, 0x6B, 0x73, 0x97, 0xF3, 0xC8, 0x51, 0x90, 
+0x56, 0xEE, 0x6B, 
+
+0xD1, 0x92, 0x49, 0xBE, 0x13, 0xB9, 0x0D, 0xC3, 
+0x94, 0x48, 0xF7, 0xAE, 0x8D, 0xB3, 0xCD, 0x40, 
+0xBB, 0x01, 0x2F, 0x5E, 0x65, 0xBF, 0x15, 0xE7, 
+0xC9, 0x23, 0xD8, 0x8A, 0x31, 0x92, 0xD4, 0x89, 
+0x03, 0x92, 0x64, 0x77, 0xC4, 0x02, 0xA1, 0xC8, 
+0x06, 0xA5, 0x46, 0xD8, 0x83, 0x19, 0x9B, 0x49, 
+0x2C, 0x77, 0x14, 0x1C, 0x37, 0xEC, 0xBC, 0x16, 
+0x16, 0x90, 0x38, 0x5D, 0x7B, 0x62, 0xC7, 0x5E, 
+0xB9, 0x90, 0x4C, 0x86, 0xB1, 0x04, 0x3F, 0x9B, 
+0x97, 0xFF, 0x96, 0x96, 0xF8, 0x8C, 0xED, 0x30, 
+0x29, 0x97, 0xCE, 0x39, 0x08, 0xEA, 0xCE, 0xEC, 
+0xE4, 0xD5, 0x97, 0x80, 
+
+0xED, 0x40, 0x76, 0x4D, 0x2A, 0x83, 0x9E, 0xB9, 
+0x0E, 0xA6, 0x9B, 0xFF, 0x43, 0xD3, 0x57, 0x22, 
+0xA1, 0xE5, 0x19, 0x44, 0x8A, 0x2C, 0x2B, 0xA5, 
+0xE0, 0x58, 0x00, 0xEA, 0xDE, 0xD7, 0x77, 0x95, 
+0x98, 0x02, 0x43, 0xC8, 0xAC, 0x9E, 0x10, 0x49, 
+0xDD, 0xFC, 0x0D, 0x6B, 0x69, 0x0C, 0x5F, 0xA6, 
+0xBC, 0x11, 0xCB, 0xB0, 0x40, 0x8C, 0xFD, 0xFD, 
+0x28, 0xEF, 0xEB, 0xAC, 0x73, 0xCD, 0x15, 0x74, 
+0x49, 0x08, 0x5F, 0xE5, 0xCA, 0x39, 0x3E, 0x07, 
+0xAB, 0xF0, 0xE8, 0xC3, 0x6F, 0xEC, 0x41, 0x50, 
+0x29, 0x5A, 0xF8, 0xD8, 0x95, 0x68, 0x77, 0xA9, 
+0x31, 0x9A, 0x5E, 0xB7, 0xFD, 
+
+0x4E, 0xB1, 0x03, 0x95, 0xE6, 0x22, 0x49, 0x89, 
+0xDE, 0x26, 0x73, 0x65, 0x0B, 0xE2, 0x85, 0xA1, 
+0xC5, 0x15, 0x5B, 0x5F, 0x8D, 0xC8, 0xB3, 0x15, 
+0xCB, 0x39, 0xD8, 0xD8, 0x12, 0xEF, 0x83, 0x89, 
+0x02, 0x81, 0x5A, 0x36, 0xB4, 0xAF, 0xE0, 0xA4, 
+0xE3, 0x1E, 0x72, 0xB6, 0x80, 0x1A, 0xCA, 0xB9, 
+0xF8, 0x64, 0x80, 0x62, 0x55, 0xA3, 0x50, 0x6F, 
+0xD0, 0x4C, 0x37, 0xFC, 0xFE, 0x8D, 0xC6, 0xFE, 
+0xD9, 0x6A, 0x6A, 0x1E, 0x44, 0x35, 0xCF, 0x6A, 
+0x1F, 0xDC, 0x86, 0x22, 0x74, 0x5D, 0xC9, 0x6A, 
+0xFA, 0x32, 0x12, 0xB3, 0x12, 0x8A, 0x9D, 0x29, 
+0x6A, 0xB0, 0x10, 0x73, 0xF3, 0xAF, 
+
+0xAF, 0x25, 0xCA, 0x2F, 0xCC, 0xAC, 0xEB, 0x64, 
+0x67, 0xCA, 0x4A, 0xC6, 0xE6, 0x6C, 0x98, 0xC8, 
+0xB9, 0x12, 0xCF, 0xCD, 0x56, 0xC1, 0x01, 0x32, 
+0x36, 0xEB, 0xE5, 0x58, 0xAC, 0xEC, 0x2D, 0xA6, 
+0xF9, 0x95, 0x84, 0xD9, 0x21, 0x13, 0x81, 0xE7, 
+0x8B, 0x46, 0x34, 0x3E, 0x29, 0xD7, 0x02, 0xAE, 
+0xC7, 0x45, 0x38, 0xC1, 0x69, 0x70, 0xE9, 0x38, 
+0x2C, 0x9D, 0x0F, 0xE1, 0x32, 0x48, 0x74, 0x65, 
+0x8A, 0x20, 0x50, 0x2A, 0x5C, 0xF9, 0x6F, 0xD7, 
+0xAC, 0x8A, 0xFB, 0x63, 0x24, 0x09, 0x6F, 0x9F, 
+0xC0, 0x19, 0xA9, 0x84, 0x53, 0x2E, 0x4F, 0x25, 
+0x22, 0x19, 0x99, 0x6D, 0x63, 0xD6, 0x2F, 
+
+0x06, 0x07, 0xEB, 0x05, 0xA8, 0x83, 0xC7, 0xFC, 
+0x5B, 0x09, 0xBF, 0x80, 0xC9, 0x86, 0x1C, 0x98, 
+0xDD, 0x25, 0xE9, 0xDB, 0x66, 0xC5, 0x49, 0x4F, 
+0xF0, 0x12, 0xD6, 0x73, 0x04, 0xD7, 0x1B, 0xC6, 
+0xFF, 0xEB, 0xB7, 0x34, 0x09, 0x74, 0x96, 0xB4, 
+0xDD, 0xB2, 0x52, 0x3E, 0xB2, 0xDC, 0x9D, 0x4D, 
+0x12, 0x77, 0x7C, 0x5B, 0xB1, 0x2D, 0x18, 0x35, 
+0xD0, 0xCF, 0x22, 0x40, 0x2B, 0xEF, 0x91, 0x28, 
+0xDC, 0x4A, 0xF8, 0xB3, 0xAE, 0x22, 0x7B, 0x9D, 
+0x1C, 0x92, 0x19, 0xD2, 0x3E, 0x7D, 0xAF, 0xD5, 
+0x31, 0x95, 0xB0, 0x9A, 0x52, 0xD2, 0xFE, 0x3F, 
+0x23, 0x37, 0x22, 0xF5, 0xC6, 0xC4, 0x41, 0xCC, 
+
+0x67, 0x3B, 0x8B, 0xB9, 0x68, 0x4C, 0x7A, 0x96, 
+0xA1, 0xB1, 0x89, 0x79, 0x49, 0x24, 0xC4, 0x12, 
+0x42, 0x1A, 0x78, 0x6F, 0xE2, 0x7F, 0xB4, 0x92, 
+0xCD, 0xC0, 0xAD, 0xF5, 0x2D, 0x87, 0x6D, 0x22, 
+0x18, 0xFC, 0xCE, 0x69, 0xAA, 0xD8, 0x01, 0x2D, 
+0x49, 0x64, 0x3C, 0x24, 0xEE, 0xFF, 0xCA, 0x32, 
+0x34, 0xF5, 0x09, 0x5E, 0x90, 0x50, 0x35, 0xEB, 
+0xB8, 0x2D, 0x16, 0x10, 0xAD, 0x38, 0x30, 0x3D, 
+0x97, 0x07, 0xD0, 0x99, 0xCF, 0xF0, 0xA8, 0xBB, 
+0x21, 0xB0, 0x54, 0xCB, 0x56, 0xC6, 0x72, 0x96, 
+0x22, 0x58, 0x9A, 0x10, 0xAE, 0xA5, 0xD5, 0x52, 
+0x0F, 0xB6, 0x3C, 0xF3, 0x16, 0x59, 0x3F, 0x46, 
+0x56, 
+
+0xC3, 0xFC, 0x2F, 0xDE, 0xBC, 0xC5, 0xF4, 0xB3, 
+0x17, 0x21, 0x88, 0xF7, 0xAB, 0x80, 0x06, 0x7A, 
+0x36, 0x20, 0x97, 0x8A, 0x71, 0x16, 0xB9, 0x38, 
+0xCF, 0x0E, 0x91, 0xC2, 0x4E, 0x38, 0x99, 0xC8, 
+0x5B, 0xAA, 0xC0, 0xFC, 0xD4, 0x48, 0x85, 0x3F, 
+0x41, 0xF0, 0x0A, 0x17, 0x2E, 0x32, 0xD3, 0xD4, 
+0xF4, 0x43, 0xDE, 0x00, 0x0D, 0xE0, 0xA5, 0x81, 
+0x2B, 0xB9, 0xF3, 0x9D, 0xE4, 0xA9, 0x29, 0xF1, 
+0x25, 0x1F, 0x44, 0x7C, 0xBC, 0x84, 0xEA, 0xA1, 
+0x39, 0xA3, 0x2F, 0x16, 0x7B, 0xEB, 0x47, 0xF6, 
+0x33, 0x53, 0xD4, 0x4D, 0xFE, 0x63, 0x72, 0x4E, 
+0x32, 0x72, 0x87, 0x1A, 0xA8, 0x94, 0x56, 0x7B, 
+0xC0, 0x6E, 
+
+0xB9, 0x69, 0x4D, 0x32, 0x87, 0xD6, 0xC6, 0x8B, 
+0xEC, 0xA1, 0xDF, 0xF8, 0x58, 0xB4, 0xCD, 0xCE, 
+0x1E, 0xC5, 0x37, 0x4E, 0x9B, 0x77, 0x8F, 0x8A, 
+0xEB, 0x89, 0x03, 0x4A, 0xA0, 0x0F, 0x51, 0x3C, 
+0x6C, 0x1B, 0x14, 0x7D, 0x0D, 0x1D, 0xBF, 0xA1, 
+0x8D, 0x59, 0x48, 0xA7, 0x95, 0x24, 0xED, 0x09, 
+0x8D, 0xDF, 0x89, 0xCA, 0x80, 0xB7, 0x2D, 0x0D, 
+0x71, 0xC7, 0x73, 0xFA, 0xB0, 0xFE, 0x70, 0xCE, 
+0xEA, 0xA2, 0x53, 0x23, 0x07, 0x41, 0x5B, 0x75, 
+0x44, 0x93, 0x0A, 0x36, 0xB3, 0x5A, 0x6C, 0xBD, 
+0xDA, 0x66, 0x43, 0x55, 0x3C, 0x87, 0xC2, 0x8E, 
+0x51, 0xA3, 0x28, 0xFB, 0xFB, 0x5F, 0x8E, 0x82, 
+0xA9, 0x60, 0x5D, 
+
+0x7E, 0x26, 0x4B, 0x2B, 0x10, 0x93, 0x82, 0xAC, 
+0x0B, 0x01, 0x88, 0x29, 0x90, 0x43, 0xA7, 0xAE, 
+0xC5, 0xDE, 0xC6, 0xAB, 0x67, 0x49, 0x45, 0x6B, 
+0x30, 0x5F, 0x87, 0x59, 0x4D, 0xB2, 0xE0, 0x8B, 
+0xEC, 0x9F, 0x9D, 0xB6, 0x5E, 0x1A, 0xA8, 0xE8, 
+0xB2, 0xF5, 0xC4, 0xDB, 0xC1, 0x39, 0xC6, 0xF4, 
+0x85, 0xE8, 0x59, 0x19, 0xE8, 0xE8, 0xAA, 0xE6, 
+0xA7, 0xE1, 0x66, 0x1B, 0x9A, 0xE3, 0x7C, 0xCE, 
+0x5A, 0x7A, 0x3B, 0x32, 0x38, 0x89, 0x60, 0x6B, 
+0xB8, 0xD9, 0xA1, 0xE2, 0x33, 0x62, 0xDA, 0xED, 
+0xC0, 0xAF, 0x10, 0x23, 0xCA, 0xD5, 0x18, 0x5F, 
+0x4F, 0xAC, 0x07, 0x02, 0x5D, 0x62, 0x4B, 0xCF, 
+0x89, 0x5D, 0xB6, 0x8F, 
+
+0x4F, 0xB9, 0xC8, 0xBB, 0x61, 0x38, 0x62, 0xC0, 
+0x7E, 0xDA, 0xBB, 0x97, 0x1F, 0x14, 0x93, 0x3D, 
+0x56, 0x14, 0x06, 0xA0, 0xDA, 0xA6, 0x2F, 0x8D, 
+0x61, 0xC6, 0xD3, 0x70, 0x2A, 0xF2, 0xEF, 0xD6, 
+0x97, 0x81, 0x1B, 0x18, 0xCB, 0x5D, 0x3F, 0xC0, 
+0xAB, 0xF1, 0xE8, 0x16, 0x59, 0xA3, 0x9E, 0xDC, 
+0x59, 0x0D, 0x5D, 0x1C, 0x51, 0x81, 0xF6, 0x1A, 
+0x12, 0x0C, 0xF7, 0xE3, 0xF0, 0x6E, 0x8D, 0x89, 
+0x8D, 0xED, 0x1F, 0xE7, 0x4E, 0xE9, 0xB0, 0x9A, 
+0x04, 0xB2, 0x9F, 0xB5, 0x05, 0xBB, 0xE2, 0x70, 
+0x4F, 0x9F, 0x86, 0x03, 0x86, 0x9C, 0xE9, 0x63, 
+0x8B, 0xA5, 0x8B, 0xFC, 0xD3, 0xF4, 0x24, 0xE1, 
+0xE2, 0x46, 0xEB, 0x77, 0x2C, 
+
+0xD3, 0xE2, 0xB1, 0xBF, 0xE6, 0x28, 0xA2, 0x19, 
+0x09, 0x7C, 0xE5, 0xDE, 0x6C, 0x07, 0x79, 0x55, 
+0x36, 0xD2, 0x39, 0x8B, 0x1C, 0x1E, 0xBB, 0x67, 
+0xF6, 0xE9, 0xA5, 0xD6, 0xCE, 0x83, 0x47, 0xFC, 
+0x2D, 0x5D, 0xF3, 0x9D, 0xA8, 0x98, 0x78, 0x2B, 
+0x2A, 0x4B, 0x1C, 0xC8, 0x7F, 0x45, 0x3F, 0x58, 
+0x01, 0x35, 0xE4, 0x5C, 0xF8, 0xEE, 0x27, 0xC6, 
+0x53, 0x88, 0x4B, 0x96, 0xDA, 0xBF, 0x57, 0x37, 
+0x45, 0x2F, 0x4B, 0xDC, 0x57, 0x74, 0xD9, 0x69, 
+0x15, 0x94, 0x60, 0x45, 0x72, 0xFA, 0x52, 0x05, 
+0x2B, 0xA5, 0xF2, 0x85, 0x6F, 0x3C, 0xF6, 0xF1, 
+0xF8, 0x4A, 0xAF, 0xF4, 0xC3, 0x0D, 0x6A, 0x8C, 
+0xF3, 0xBC, 0x6A, 0x07, 0x02, 0x15, 
+
+0xBE, 0x63, 0x59, 0xA9, 0x6D, 0x8D, 0x63, 0xB4, 
+0x80, 0xB1, 0xEA, 0xCD, 0x8B, 0xB3, 0x98, 0x8A, 
+0x63, 0xF3, 0x0C, 0xD6, 0x93, 0xC0, 0x9E, 0x88, 
+0x33, 0x19, 0x0F, 0xC0, 0xF0, 0xFE, 0xCC, 0x33, 
+0xC7, 0xC5, 0xF0, 0xD6, 0x73, 0x6D, 0x57, 0xA4, 
+0x4C, 0x49, 0x20, 0x10, 0x99, 0x68, 0x73, 0x99, 
+0x38, 0xE9, 0x25, 0x11, 0x8B, 0xE1, 0xC8, 0x90, 
+0x13, 0x6A, 0x96, 0x0F, 0x82, 0x23, 0xFD, 0x5E, 
+0x71, 0x8D, 0x6E, 0xA4, 0x37, 0x7C, 0x35, 0xC6, 
+0x37, 0xD3, 0xC8, 0xBE, 0x4B, 0x59, 0x94, 0xE2, 
+0x5C, 0x70, 0xEB, 0x62, 0xC5, 0xF1, 0x7E, 0x63, 
+0x02, 0xCE, 0xE4, 0x07, 0x38, 0x2E, 0x8F, 0x63, 
+0x8D, 0xAC, 0xBB, 0x93, 0xAB, 0x8D, 0x71, 
+
+0x52, 0xBD, 0xA1, 0x06, 0x3C, 0x94, 0x40, 0x14, 
+0x24, 0x66, 0x94, 0x84, 0x7F, 0x92, 0x07, 0x8C, 
+0x02, 0xB4, 0x9A, 0x9D, 0x77, 0x91, 0xDC, 0xEC, 
+0x69, 0xC6, 0x9F, 0xD5, 0x89, 0xDE, 0x3A, 0x55, 
+0xF1, 0x8C, 0x2D, 0xAD, 0xBE, 0x56, 0x1B, 0x88, 
+0x4D, 0xE1, 0x88, 0x8A, 0xEF, 0xFB, 0x2B, 0x1A, 
+0xE0, 0xEF, 0xF3, 0x79, 0xED, 0x25, 0x23, 0xF3, 
+0xAA, 0x1B, 0xE1, 0xF6, 0xB3, 0x0E, 0xC4, 0xA7, 
+0x02, 0xBB, 0xCC, 0x25, 0x08, 0x67, 0x75, 0x23, 
+0xE6, 0x0D, 0x5E, 0x4E, 0xE9, 0x00, 0xB3, 0xA8, 
+0x7F, 0xB3, 0x42, 0x08, 0x7B, 0x36, 0xC8, 0x4A, 
+0x67, 0xCA, 0xC3, 0x8E, 0xEC, 0x62, 0xB3, 0xA1, 
+0x2E, 0x8A, 0xB6, 0x60, 0xE0, 0xD6, 0x38, 0xA9, 
+
+0x9E, 0x06, 0x4A, 0xB5, 0x71, 0xD7, 0x33, 0x84, 
+0x95, 0x9C, 0x5F, 0xF8, 0x12, 0x0D, 0x46, 0xFA, 
+0xE5, 0x67, 0x31, 0xE2, 0x71, 0xDC, 0xB6, 0xB4, 
+0xE1, 0x3B, 0x16, 0x28, 0xE5, 0x04, 0x20, 0x90, 
+0xC1, 0x70, 0xB3, 0x1C, 0x93, 0xF6, 0x6E, 0xC8, 
+0x42, 0x8A, 0xAC, 0x85, 0x67, 0xB5, 0xAE, 0x74, 
+0x78, 0xFA, 0x32, 0x4B, 0x0D, 0x74, 0x3B, 0x38, 
+0xF9, 0x31, 0x01, 0xDA, 0x65, 0xE1, 0xBA, 0x5B, 
+0x47, 0xE5, 0xCE, 0x58, 0xC6, 0x16, 0x6D, 0xC2, 
+0x56, 0xB7, 0x9B, 0x43, 0x0B, 0x81, 0x65, 0xDD, 
+0xF5, 0xB9, 0x44, 0x93, 0x06, 0xD6, 0x07, 0x8B, 
+0xDF, 0xE7, 0x1F, 0x14, 0xD6, 0x04, 0x4F, 0xFD, 
+0x47, 0x67, 0xEB, 0xBF, 0x23, 0xEB, 0xA7, 0xEE, 
+0xB0, 
+
+0x31, 0xCF, 0x75, 0x5F, 0xFF, 0xBD, 0xB1, 0xE7, 
+0x04, 0xBF, 0x32, 0x3F, 0x80, 0x8C, 0x1A, 0xCF, 
+0x78, 0xF2, 0x95, 0x2B, 0x4B, 0xEF, 0x30, 0xDF, 
+0xE4, 0x49, 0x04, 0xF3, 0x82, 0x11, 0x30, 0x6B, 
+0x30, 0x3C, 0xAA, 0x9B, 0x1F, 0xDB, 0xFA, 0xDA, 
+0xC3, 0x79, 0x88, 0x1F, 0x4C, 0x06, 0x7E, 0xE5, 
+0x89, 0x04, 0x78, 0x7C, 0x0B, 0xA0, 0x2D, 0x50, 
+0x88, 0xD4, 0xA8, 0x3C, 0x4D, 0x9F, 0x85, 0x9E, 
+0x6A, 0x0C, 0xC6, 0x75, 0xE5, 0x02, 0x19, 0x64, 
+0xA6, 0x64, 0x21, 0x76, 0x2F, 0x2E, 0xBC, 0xDA, 
+0xF0, 0xDD, 0x19, 0xCE, 0xB1, 0xB6, 0x35, 0x3F, 
+0x6B, 0x3F, 0xCF, 0xFD, 0x71, 0x2E, 0x0C, 0x4B, 
+0x8B, 0x4D, 0x26, 0xDA, 0x06, 0x46, 0xE3, 0x68, 
+0xBA, 0x22, 
+
+0xA3, 0x90, 0x81, 0xE3, 0xBA, 0x9C, 0x12, 0x57, 
+0xE3, 0x53, 0x6E, 0x90, 0x2F, 0xD4, 0x6B, 0x23, 
+0x58, 0xCD, 0x1C, 0xBD, 0x6F, 0x8B, 0x16, 0x0C, 
+0x40, 0x41, 0x47, 0x86, 0xA4, 0xA2, 0x90, 0x6C, 
+0x81, 0x8B, 0x51, 0x53, 0xC8, 0x9A, 0xF4, 0x4F, 
+0x04, 0xB0, 0xF3, 0xE2, 0xDE, 0x73, 0x17, 0x3B, 
+0xB3, 0xDB, 0x61, 0xFB, 0xBD, 0xC8, 0xBB, 0x13, 
+0x8A, 0xE9, 0xB0, 0x5D, 0x38, 0x5F, 0x3F, 0x7A, 
+0xDC, 0xB2, 0x25, 0xB7, 0x0B, 0xBD, 0xC6, 0xD6, 
+0x8F, 0x15, 0xD5, 0x17, 0x2E, 0x84, 0xD0, 0xA6, 
+0xA8, 0x72, 0xEA, 0x2D, 0x2C, 0xE9, 0x31, 0x8D, 
+0x22, 0x6B, 0x0F, 0xD8, 0x36, 0x70, 0x3C, 0x11, 
+0xBE, 0x32, 0x2D, 0xB1, 0x14, 0xCD, 0x90, 0x18, 
+0x99, 0xCA, 0x01, 
+
+0xA9, 0x3C, 0xC6, 0x55, 0x5C, 0xA3, 0x2C, 0x02, 
+0x51, 0xB8, 0x98, 0xF0, 0xDE, 0xF3, 0x51, 0x7A, 
+0x1F, 0x6C, 0xA1, 0xCD, 0x15, 0xCB, 0x3B, 0x3A, 
+0x7E, 0xD5, 0x3E, 0xF0, 0xD5, 0xE4, 0x6F, 0x30, 
+0x3C, 0x1F, 0x92, 0x65, 0xEE, 0x40, 0xAC, 0x8F, 
+0x8A, 0xA5, 0x5D, 0x26, 0x38, 0xCE, 0x02, 0xDE, 
+0xA4, 0xF6, 0xC1, 0xB5, 0x53, 0x7B, 0x37, 0x40, 
+0x38, 0xC6, 0x31, 0x9B, 0x3F, 0x72, 0x0D, 0x91, 
+0x46, 0xD9, 0xE3, 0x17, 0xAD, 0x07, 0x1D, 0xDE, 
+0x69, 0xF9, 0xBD, 0x72, 0x1E, 0x4E, 0x68, 0x4E, 
+0x16, 0x48, 0xAD, 0xEA, 0x9C, 0x18, 0x70, 0xDF, 
+0x7E, 0x38, 0x4F, 0x68, 0xC5, 0xE2, 0xA1, 0x09, 
+0x5E, 0x5F, 0x42, 0x75, 0x66, 0x20, 0xE6, 0xE3, 
+0x1F, 0xAC, 0x76, 0x6D, 
+
+0xCE, 0x61, 0xD7, 0xE9, 0x85, 0xCF, 0xEF, 0xDC, 
+0x2D, 0x75, 0x04, 0x60, 0x76, 0xF1, 0x4E, 0xE9, 
+0x72, 0xD3, 0x2E, 0x6A, 0x5A, 0x81, 0xB0, 0xAF, 
+0x29, 0x05, 0xAF, 0x9C, 0xEF, 0xF1, 0xF3, 0x96, 
+0x97, 0x10, 0xCA, 0x15, 0xA9, 0x73, 0x44, 0xFC, 
+0x61, 0x0D, 0x2E, 0xE5, 0x1A, 0x3B, 0x24, 0x5E, 
+0x04, 0xAE, 0x5B, 0xCA, 0x40, 0xEC, 0x4B, 0x92, 
+0xD8, 0x7C, 0x3F, 0x0B, 0x69, 0x9B, 0x3C, 0xC4, 
+0x9E, 0x80, 0x3C, 0x2B, 0xB1, 0x39, 0xFB, 0xC6, 
+0x8A, 0x65, 0x98, 0x1A, 0x53, 0xE1, 0x31, 0x90, 
+0xE6, 0x26, 0x43, 0x73, 0x07, 0xAD, 0x80, 0xD9, 
+0x4C, 0xC0, 0x0E, 0x5F, 0xE7, 0x6D, 0x0B, 0xEB, 
+0xD1, 0x16, 0x0A, 0x57, 0xFB, 0x49, 0xF5, 0xA0, 
+0x96, 0x6E, 0x9A, 0xB0, 0x82, 
+
+0xC7, 0x84, 0x16, 0x76, 0x54, 0xAE, 0x96, 0x80, 
+0x66, 0x9E, 0xCF, 0xB2, 0x49, 0x57, 0x8B, 0x32, 
+0xDA, 0x1C, 0xCD, 0xD1, 0xA7, 0x44, 0x52, 0x19, 
+0x5A, 0x77, 0x8D, 0x9D, 0x89, 0xC4, 0x84, 0xD0, 
+0x2D, 0xFB, 0x8D, 0xB5, 0xCD, 0x63, 0x48, 0x6E, 
+0x18, 0x49, 0x1B, 0xFA, 0x48, 0xD5, 0x32, 0xBB, 
+0x85, 0x0A, 0x90, 0xBC, 0x6C, 0xAF, 0x5D, 0x6F, 
+0xAC, 0x34, 0xF9, 0xC6, 0x59, 0x85, 0xE8, 0xC2, 
+0xA2, 0xA4, 0x9F, 0x77, 0xE4, 0x00, 0xEE, 0x94, 
+0xD9, 0x57, 0xEC, 0x31, 0x7E, 0x0F, 0xB1, 0xD2, 
+0xD5, 0xA5, 0x1A, 0xBE, 0x0C, 0xC2, 0x85, 0x7B, 
+0x5E, 0xCD, 0xD0, 0xB6, 0x1E, 0x04, 0x86, 0xEF, 
+0x84, 0x74, 0x6B, 0x97, 0x5D, 0x6B, 0x5C, 0x63, 
+0x81, 0x50, 0x6F, 0x4F, 0x8E, 0x1B, 
+
+0xC7, 0x9C, 0x57, 0x9D, 0xE5, 0x46, 0x4F, 0xC1, 
+0x4A, 0x03, 0x63, 0x2E, 0x35, 0x1B, 0x35, 0x9D, 
+0xD3, 0xA7, 0xB5, 0xDC, 0xCD, 0x58, 0xEB, 0x25, 
+0x52, 0x9E, 0xC0, 0xB3, 0x19, 0x85, 0x11, 0x65, 
+0x14, 0x8A, 0x27, 0x0F, 0xDD, 0xB5, 0x06, 0xCE, 
+0x9A, 0x1A, 0xD8, 0xB5, 0x07, 0x63, 0x24, 0x5F, 
+0xD2, 0xBC, 0x79, 0xB8, 0x62, 0x45, 0x10, 0xBC, 
+0x65, 0x00, 0x22, 0x94, 0x83, 0x60, 0x62, 0xF3, 
+0x13, 0xE1, 0xD0, 0x5D, 0x47, 0xD8, 0xCB, 0x2C, 
+0x59, 0x60, 0x9D, 0x8F, 0x9A, 0x49, 0x7F, 0x60, 
+0x71, 0x85, 0xA5, 0x53, 0xCE, 0x55, 0x72, 0x30, 
+0x89, 0xD6, 0x74, 0x5B, 0xEE, 0xAF, 0x48, 0x60, 
+0x1C, 0x0A, 0xD0, 0x56, 0x54, 0xC5, 0x40, 0x1B, 
+0x1F, 0x94, 0xAE, 0x5E, 0x45, 0x60, 0x59, 
+
+0xD1, 0x73, 0xAA, 0xD6, 0x76, 0x3F, 0x35, 0xDE, 
+0x77, 0x82, 0x96, 0xFF, 0x1B, 0x34, 0xAD, 0x16, 
+0x77, 0x73, 0xD7, 0x8A, 0x96, 0x19, 0xA0, 0xBA, 
+0x22, 0x71, 0x36, 0x9D, 0xAC, 0x41, 0x14, 0xAB, 
+0xA8, 0x77, 0x34, 0x7A, 0x70, 0x2A, 0xF6, 0xF6, 
+0xEA, 0xFD, 0x6D, 0xDB, 0xAD, 0x65, 0x14, 0x3A, 
+0xEA, 0x0E, 0x2C, 0xC4, 0xC1, 0x13, 0x7D, 0x59, 
+0x8D, 0xE8, 0x61, 0x29, 0x50, 0x61, 0xC3, 0xC9, 
+0x76, 0x20, 0x7C, 0xEF, 0x09, 0xA8, 0xC3, 0x8E, 
+0x11, 0xD2, 0x3F, 0x32, 0x09, 0x61, 0x91, 0xF8, 
+0x60, 0xF6, 0x83, 0x93, 0x08, 0x94, 0xBF, 0x5D, 
+0x6E, 0xDE, 0xA1, 0x04, 0xEE, 0xB2, 0xE3, 0x10, 
+0x1D, 0x22, 0xF0, 0xF8, 0x4E, 0x5C, 0xF5, 0x9B, 
+0x86, 0x44, 0x57, 0x28, 0xB7, 0x8C, 0x81, 0x14, 
+
+0x31, 0xDF, 0xA5, 0xD5, 0xF6, 0x17, 0x19, 0x50, 
+0xA3, 0x0F, 0x26, 0x1C, 0xD4, 0x98, 0xCA, 0xB0, 
+0xDB, 0x0C, 0x2F, 0x8C, 0xCE, 0xCC, 0x56, 0x35, 
+0x57, 0xC2, 0x9D, 0xEF, 0x64, 0x82, 0xE4, 0x8A, 
+0xFA, 0xFE, 0x07, 0xF1, 0xB7, 0x10, 0xDF, 0x56, 
+0x7B, 0x64, 0x88, 0x9C, 0x25, 0xF1, 0x6D, 0x6C, 
+0x5F, 0xD3, 0xC8, 0xC4, 0x7E, 0xD5, 0x26, 0xB8, 
+0xC3, 0xDD, 0xAD, 0x85, 0x3E, 0xA0, 0x5C, 0x33, 
+0x25, 0x9A, 0xD9, 0x52, 0xB7, 0x8C, 0x22, 0x3F, 
+0x96, 0xD4, 0x51, 0x7C, 0x9F, 0xAD, 0x01, 0x81, 
+0xBE, 0x95, 0x90, 0x38, 0x90, 0xDD, 0x81, 0x43, 
+0xB0, 0x66, 0x0D, 0x4E, 0x9E, 0xF6, 0x0C, 0x13, 
+0x47, 0xA5, 0xA0, 0x1F, 0x94, 0xFB, 0x86, 0x42, 
+0x8B, 0x9B, 0x22, 0x25, 0xF2, 0xB8, 0x83, 0x3C, 
+0x87, 
+
+0x06, 0xFA, 0x95, 0x25, 0x62, 0x35, 0x4D, 0x06, 
+0x3F, 0xD2, 0xAE, 0x4E, 0x86, 0x32, 0x08, 0x54, 
+0x78, 0x35, 0xAD, 0x84, 0xE1, 0x6D, 0xB1, 0x5E, 
+0xD0, 0x91, 0x42, 0x02, 0x39, 0x1F, 0x0D, 0x4C, 
+0xE5, 0x4B, 0xDB, 0x9D, 0x1A, 0xE5, 0x05, 0x97, 
+0x90, 0x0F, 0xB4, 0xCD, 0xC5, 0xF7, 0xDA, 0x81, 
+0xC7, 0x22, 0x25, 0xB8, 0xAA, 0xC5, 0xA6, 0x6C, 
+0xCD, 0x21, 0xC3, 0xCA, 0xFA, 0xDB, 0x0C, 0xDC, 
+0x35, 0x7B, 0x49, 0x2E, 0xC9, 0xD4, 0xDC, 0x2C, 
+0xF5, 0x2A, 0x3E, 0xBA, 0x93, 0x52, 0x88, 0x73, 
+0x4B, 0x3A, 0x1E, 0x5B, 0x37, 0xE4, 0x54, 0x8E, 
+0xE7, 0x3C, 0x78, 0x37, 0xBA, 0x31, 0x09, 0x3A, 
+0xE0, 0x36, 0xFF, 0x6C, 0x7D, 0xA9, 0x68, 0x0F, 
+0x33, 0xCD, 0x1B, 0x7E, 0xD0, 0x12, 0x98, 0xC7, 
+0xC6, 0x9E, 
+
+0x59, 0xBC, 0xD3, 0x93, 0xD5, 0x73, 0x5C, 0x4F, 
+0xCD, 0x32, 0x8C, 0xA7, 0xEC, 0xB1, 0x92, 0x82, 
+0xFF, 0x08, 0x94, 0xED, 0xCE, 0x65, 0x7E, 0xDD, 
+0x28, 0x65, 0xEA, 0xDB, 0x3B, 0x77, 0x87, 0x41, 
+0x5A, 0xE7, 0xE4, 0x4F, 0x5D, 0xC6, 0x8E, 0xB7, 
+0xD5, 0x83, 0x5A, 0xA4, 0x1B, 0xE5, 0x40, 0x50, 
+0xA1, 0x0E, 0xB3, 0x8F, 0xE3, 0x31, 0x87, 0xD8, 
+0xBF, 0xD9, 0xBE, 0x07, 0x68, 0x9F, 0xAF, 0xD5, 
+0x5A, 0xB3, 0xEB, 0x4B, 0x59, 0x0E, 0xDA, 0x36, 
+0xE8, 0xCE, 0x29, 0x5A, 0x9D, 0x68, 0xF7, 0x40, 
+0xAD, 0xD4, 0x4C, 0xEC, 0xD3, 0xB1, 0xF8, 0x13, 
+0x5B, 0x4B, 0x9A, 0xE9, 0x7A, 0x19, 0x37, 0x21, 
+0x35, 0x2F, 0x01, 0xE3, 0xF0, 0x7E, 0xF4, 0x80, 
+0x3E, 0xBF, 0xB0, 0xDB, 0xB8, 0x5B, 0x46, 0xBF, 
+0x81, 0xB2, 0x6D, 
+
+0xE6, 0x27, 0x7B, 0xD7, 0x3A, 0x57, 0x4A, 0x57, 
+0x1F, 0x16, 0x60, 0x67, 0xE3, 0xCB, 0x95, 0x54, 
+0x52, 0x0F, 0xD3, 0xF8, 0x2E, 0x4E, 0xFE, 0xAC, 
+0x98, 0x99, 0x14, 0x6E, 0x24, 0x91, 0x5A, 0x2C, 
+0xA1, 0xDE, 0x40, 0xBB, 0x2E, 0x59, 0x23, 0x98, 
+0xCE, 0x15, 0x9A, 0xDC, 0x0D, 0x1B, 0xB2, 0xC4, 
+0x6C, 0xC3, 0x23, 0xBE, 0xCB, 0xF3, 0xF6, 0x67, 
+0xCF, 0x10, 0x5B, 0x90, 0xEE, 0xAC, 0x62, 0x54, 
+0xCA, 0x9C, 0xD4, 0xD4, 0x83, 0x41, 0x1B, 0x46, 
+0xD8, 0x42, 0x0B, 0x01, 0x7B, 0xC2, 0x1B, 0xD0, 
+0x6A, 0x55, 0x1D, 0x2F, 0x2D, 0xF3, 0x63, 0xCD, 
+0xAC, 0xCB, 0x30, 0xF0, 0x95, 0x7C, 0x9E, 0x37, 
+0xA8, 0x37, 0x42, 0xA5, 0xAC, 0xCC, 0xA2, 0xFD, 
+0x6E, 0x90, 0x0B, 0x64, 0x50, 0x38, 0x72, 0x7A, 
+0x0B, 0x7E, 0x50, 0xFF, 
+
+0xAF, 0xB6, 0xBB, 0x4A, 0x9E, 0x62, 0xFB, 0xD7, 
+0xDA, 0x69, 0xA1, 0x60, 0x20, 0x32, 0xE6, 0x56, 
+0xA1, 0x6F, 0x37, 0xCA, 0x2D, 0x23, 0xBC, 0x39, 
+0xED, 0xEF, 0xB7, 0xEE, 0x47, 0x4E, 0xCB, 0x46, 
+0xD3, 0xCA, 0x19, 0xF6, 0x58, 0x4C, 0x31, 0x2B, 
+0x65, 0xD6, 0xBC, 0x74, 0x21, 0x05, 0xD7, 0x99, 
+0x96, 0x8F, 0x47, 0xE4, 0xD8, 0x4B, 0xD1, 0x60, 
+0x27, 0x2E, 0x9B, 0xBF, 0x22, 0xC4, 0xAC, 0x37, 
+0xDB, 0x41, 0x61, 0xF6, 0xA7, 0x25, 0x4B, 0xC6, 
+0x75, 0x97, 0x0B, 0x17, 0xCB, 0x49, 0x60, 0x5A, 
+0x3E, 0xB6, 0xD7, 0x88, 0x8D, 0x02, 0x89, 0xB3, 
+0xB0, 0xCC, 0x03, 0xE4, 0x6F, 0x87, 0x44, 0xFF, 
+0x01, 0x6D, 0xC0, 0xC9, 0xC5, 0x78, 0x34, 0xD2, 
+0x33, 0x91, 0x0D, 0x51, 0x7A, 0xD9, 0x4D, 0x68, 
+0x91, 0x47, 0xAB, 0xF9, 0xA1, 
+
+0x7B, 0x15, 0x4A, 0xEF, 0x0F, 0xD6, 0x75, 0xB7, 
+0x1B, 0x32, 0x8B, 0x31, 0xC1, 0xCD, 0x3A, 0xF8, 
+0x7F, 0x2F, 0xA5, 0xBD, 0x7C, 0x5C, 0x43, 0x82, 
+0x4A, 0x01, 0x3B, 0x50, 0x59, 0x74, 0xB5, 0x61, 
+0x40, 0x3B, 0xC0, 0xF3, 0xA1, 0x3E, 0x75, 0x36, 
+0x85, 0x74, 0xC3, 0xB3, 0x55, 0xB6, 0xAB, 0xFC, 
+0xE9, 0x36, 0x9E, 0x01, 0x1E, 0xCB, 0xEA, 0x1B, 
+0xB2, 0x02, 0xD8, 0x42, 0x75, 0xD7, 0x36, 0x5E, 
+0x45, 0x17, 0x0E, 0xDF, 0x33, 0xEF, 0x97, 0xCE, 
+0xC5, 0x1D, 0x41, 0x4A, 0x06, 0xD3, 0xA1, 0x2F, 
+0x5C, 0xD6, 0x7F, 0xE1, 0x45, 0xF7, 0x69, 0xC5, 
+0xFA, 0xE6, 0x88, 0x3A, 0xCB, 0x96, 0xC0, 0xA4, 
+0xB7, 0x50, 0x9C, 0x67, 0xA2, 0x12, 0x85, 0xC3, 
+0x0D, 0xD3, 0x0A, 0xE2, 0x58, 0xD7, 0x94, 0x42, 
+0xFD, 0x3D, 0x54, 0xA7, 0x08, 0x85, 
+
+0x56, 0x28, 0xAF, 0x54, 0xEA, 0x3E, 0xF7, 0xCF, 
+0xB9, 0x44, 0xE1, 0xB6, 0x1D, 0x0D, 0xE5, 0x3F, 
+0xDF, 0x47, 0x9D, 0xE4, 0x94, 0xA0, 0xBF, 0x0E, 
+0xE1, 0xC1, 0x13, 0x73, 0x0F, 0x23, 0xB1, 0xBE, 
+0xC1, 0x19, 0xD5, 0x4E, 0x0D, 0x19, 0xA0, 0xD5, 
+0x20, 0xDD, 0xEA, 0xA9, 0x05, 0x27, 0xF7, 0x8C, 
+0x3C, 0xB3, 0x18, 0x07, 0xDC, 0x11, 0xE8, 0x60, 
+0xC2, 0x61, 0x67, 0xAD, 0x0B, 0x6C, 0xA9, 0xD9, 
+0x3D, 0x65, 0xE9, 0x31, 0x68, 0xFB, 0x3F, 0xDF, 
+0x4B, 0x0C, 0x3B, 0xC6, 0x51, 0xE4, 0x77, 0x73, 
+0x9D, 0xB5, 0x5C, 0x37, 0x3B, 0xDD, 0xAD, 0xF2, 
+0xA8, 0x22, 0x98, 0x4D, 0x5F, 0x4B, 0xE6, 0xE1, 
+0x0A, 0x46, 0x60, 0x0F, 0xDE, 0x02, 0xF9, 0xE6, 
+0xFC, 0x3E, 0x22, 0x5D, 0x42, 0x66, 0x50, 0x60, 
+0x67, 0x8C, 0x16, 0x28, 0xC4, 0x87, 0x02, 
+
+0x7E, 0xF5, 0xA3, 0x08, 0x91, 0x76, 0x3F, 0x65, 
+0x90, 0xBF, 0xA2, 0xD7, 0x38, 0x6B, 0x41, 0x4E, 
+0xC7, 0xD5, 0xBE, 0xEC, 0x60, 0xC5, 0x80, 0x3B, 
+0xD6, 0x7A, 0x45, 0x4A, 0x44, 0x0F, 0xA1, 0x28, 
+0xB5, 0x5C, 0x58, 0xB4, 0x2F, 0x56, 0xD3, 0xB9, 
+0x43, 0x49, 0x09, 0x5E, 0xA6, 0x50, 0xFE, 0xCF, 
+0x6A, 0x1D, 0x26, 0xC5, 0x4E, 0x96, 0x1A, 0xD0, 
+0xBA, 0x3F, 0x8D, 0x8D, 0xB1, 0x9E, 0x13, 0x66, 
+0xCB, 0x9C, 0xEC, 0x57, 0x34, 0x59, 0x7C, 0x8F, 
+0xE7, 0xE4, 0x8A, 0x52, 0x77, 0x31, 0x0A, 0x7C, 
+0xB2, 0xD4, 0x80, 0x89, 0xCA, 0x09, 0xAD, 0x93, 
+0xBC, 0x96, 0x60, 0xBE, 0xB7, 0x92, 0xD5, 0xDA, 
+0xBB, 0x2C, 0x3B, 0xF6, 0x80, 0xA1, 0xFA, 0xB7, 
+0x06, 0xA9, 0x5D, 0x74, 0x16, 0x5F, 0x4D, 0xBD, 
+0xDF, 0x2B, 0x45, 0xA4, 0x14, 0xBA, 0x65, 0xBF, 
+
+0xDB, 0xE7, 0xA9, 0x45, 0xFF, 0x17, 0xA2, 0xCE, 
+0xC3, 0x98, 0x34, 0xD0, 0x2F, 0xC2, 0xC1, 0xAE, 
+0x0A, 0xC0, 0x0B, 0xFF, 0x6A, 0x0D, 0xA1, 0xAB, 
+0xDA, 0x0B, 0x58, 0xA0, 0x85, 0xFC, 0xF1, 0xC7, 
+0x53, 0x09, 0x22, 0x4E, 0xBC, 0x0D, 0x32, 0x83, 
+0x2D, 0xF9, 0x06, 0x38, 0x18, 0x55, 0xC3, 0x6D, 
+0x79, 0x46, 0x9D, 0x10, 0x5F, 0x14, 0xFB, 0x02, 
+0x1D, 0xAD, 0x3A, 0xB2, 0x16, 0x48, 0xB5, 0xAD, 
+0xB7, 0x24, 0x59, 0x93, 0x09, 0xA3, 0xDE, 0x52, 
+0xE4, 0x4E, 0x63, 0xB8, 0xF4, 0x2E, 0x04, 0x90, 
+0x10, 0x70, 0xE6, 0x2D, 0xE9, 0x27, 0xEC, 0x20, 
+0x28, 0xF9, 0xBB, 0xF0, 0x1B, 0xF6, 0xB1, 0xA9, 
+0x74, 0x60, 0x93, 0xF9, 0x39, 0x5D, 0x45, 0x29, 
+0x43, 0x7B, 0x06, 0x50, 0x4B, 0x81, 0x79, 0xC1, 
+0xF4, 0xBB, 0x71, 0x60, 0xE6, 0x1F, 0x93, 0xB2, 
+0x33, 
+
+0xA8, 0xE6, 0xE9, 0xEB, 0x5A, 0xE1, 0xDE, 0x41, 
+0xE9, 0x3E, 0x93, 0x29, 0xA3, 0x8C, 0x26, 0x4C, 
+0x89, 0x01, 0x48, 0x46, 0x39, 0xB7, 0x49, 0x8D, 
+0xAD, 0xDB, 0x76, 0x22, 0x74, 0x1C, 0x49, 0x79, 
+0x61, 0x36, 0x56, 0x16, 0x10, 0x20, 0x68, 0x5E, 
+0xBF, 0x1A, 0x25, 0x87, 0x7F, 0xC6, 0xB8, 0x17, 
+0xB6, 0x4F, 0x89, 0xDD, 0x6A, 0x72, 0xD8, 0xF7, 
+0xDF, 0x27, 0x50, 0xBF, 0x93, 0x26, 0xFE, 0x7A, 
+0xD3, 0x55, 0x54, 0xC7, 0x23, 0x18, 0xFC, 0x88, 
+0x47, 0x1A, 0x58, 0x51, 0x49, 0xE7, 0xC0, 0xAB, 
+0xF8, 0xC8, 0x54, 0x38, 0xE0, 0x75, 0x6B, 0x47, 
+0x1D, 0x5F, 0xC2, 0x60, 0x59, 0x72, 0x92, 0x57, 
+0x5C, 0x2E, 0x0D, 0xAC, 0x94, 0x9A, 0x86, 0x74, 
+0xF0, 0x42, 0x98, 0x11, 0x41, 0xE8, 0x3A, 0x3F, 
+0xBA, 0xE4, 0xB4, 0xB6, 0x0E, 0x85, 0x11, 0xBC, 
+0xB3, 0x23, 
+
+0x3C, 0xD8, 0x11, 0xEC, 0xC7, 0xBB, 0xA6, 0x7C, 
+0x7F, 0x06, 0x4F, 0x7E, 0xBF, 0x3F, 0xA1, 0x96, 
+0x65, 0x75, 0xC4, 0xDC, 0x2C, 0x2D, 0x89, 0x6D, 
+0x75, 0xCB, 0xDD, 0xB2, 0xF9, 0x4F, 0x1B, 0x4C, 
+0xE7, 0xCA, 0x02, 0xA5, 0x64, 0x6A, 0xEE, 0xEF, 
+0x0B, 0xDF, 0xE8, 0x79, 0x2C, 0xBA, 0x0F, 0x46, 
+0x17, 0xA0, 0x45, 0x14, 0x0C, 0x99, 0x2E, 0x66, 
+0x09, 0xFC, 0x17, 0xD5, 0x03, 0xA3, 0x50, 0x98, 
+0x82, 0xD6, 0x1B, 0x53, 0xBE, 0x27, 0x4D, 0xB3, 
+0xBD, 0xC0, 0x43, 0x81, 0x75, 0x7D, 0xCF, 0x11, 
+0xC7, 0x70, 0x50, 0x01, 0x0C, 0x08, 0x2E, 0xE3, 
+0x81, 0xC7, 0x04, 0x43, 0xDE, 0x6F, 0x59, 0xB7, 
+0x9E, 0x82, 0x33, 0x74, 0x6D, 0xF9, 0x0A, 0xE5, 
+0x69, 0x99, 0xFC, 0x54, 0x73, 0xD8, 0xC8, 0x5A, 
+0x65, 0x43, 0x45, 0xE0, 0x1B, 0xF4, 0xFA, 0x80, 
+0xA0, 0x86, 0x7F, 
+
+0xB3, 0x36, 0xBC, 0x71, 0x8B, 0x9A, 0x93, 0x81, 
+0x95, 0xD8, 0x5E, 0xF8, 0xDA, 0x56, 0x9E, 0xDB, 
+0xF2, 0xBE, 0xFE, 0xB0, 0x57, 0xA6, 0x85, 0x48, 
+0x59, 0x72, 0xEF, 0xB4, 0xF2, 0xFD, 0x5E, 0x98, 
+0x9C, 0x74, 0x84, 0x52, 0xED, 0x88, 0xFA, 0x97, 
+0x5C, 0x91, 0x4E, 0x34, 0x92, 0xD3, 0xC4, 0x4C, 
+0x1B, 0x2F, 0x5F, 0xC8, 0x9B, 0xF1, 0xF6, 0x60, 
+0x8F, 0xA5, 0x3E, 0xEC, 0x15, 0x2F, 0xCA, 0xC6, 
+0xFF, 0xB9, 0xDD, 0x3E, 0x05, 0x6F, 0xAE, 0xE2, 
+0x67, 0xD7, 0xF5, 0xE6, 0x93, 0x74, 0x07, 0x54, 
+0x86, 0x62, 0xA3, 0x14, 0x4B, 0x8F, 0x00, 0xEA, 
+0x70, 0x29, 0x15, 0xA5, 0x05, 0x9A, 0xFE, 0xFE, 
+0xF5, 0x74, 0xE7, 0x29, 0xA1, 0x2C, 0x37, 0xEF, 
+0x08, 0x81, 0xE0, 0x63, 0x4C, 0x0B, 0x4C, 0xD8, 
+0xAF, 0x3A, 0x13, 0x11, 0x16, 0x03, 0x5C, 0x73, 
+0x74, 0x6D, 0xDB, 0xD2, 
+
+0x84, 0x6D, 0xEF, 0xF2, 0x61, 0x96, 0xD3, 0x49, 
+0x3A, 0x6E, 0x30, 0x46, 0xB9, 0x56, 0x8A, 0x81, 
+0xC0, 0xCB, 0xDF, 0x5B, 0xCD, 0x5A, 0xAE, 0xF1, 
+0xA1, 0x10, 0xE7, 0x03, 0x9A, 0xAE, 0x61, 0x16, 
+0x2F, 0xD0, 0x0C, 0x26, 0x7A, 0x96, 0x8E, 0x3A, 
+0x97, 0x8A, 0x0A, 0xC9, 0x90, 0x7F, 0x1E, 0x98, 
+0xF2, 0x17, 0x74, 0xE7, 0xD1, 0xB2, 0x7F, 0x0F, 
+0xE6, 0x19, 0x80, 0x2F, 0x83, 0x22, 0x63, 0xDD, 
+0x73, 0x01, 0xEB, 0xC7, 0x72, 0xC7, 0x87, 0xBA, 
+0x76, 0xF2, 0x53, 0xD2, 0xDD, 0x23, 0x91, 0x4F, 
+0x67, 0x6F, 0x69, 0x74, 0xB4, 0xD8, 0x24, 0x20, 
+0xD8, 0x29, 0x91, 0x78, 0xD6, 0xA2, 0xE0, 0x42, 
+0x4C, 0xAE, 0x3A, 0xFB, 0xAB, 0x01, 0x70, 0xCF, 
+0x57, 0xE7, 0xD0, 0x17, 0xF0, 0x4E, 0x9D, 0x52, 
+0x4C, 0x37, 0x25, 0xA3, 0x23, 0x16, 0x82, 0x89, 
+0x40, 0x64, 0xA4, 0x0C, 0x5A, 
+
+0x69, 0xB0, 0x3E, 0x4F, 0x95, 0x66, 0xFD, 0x32, 
+0x6D, 0x68, 0xF4, 0xDD, 0x78, 0x1D, 0x54, 0x43, 
+0xD1, 0xB2, 0x50, 0x71, 0x1C, 0x48, 0xDB, 0x8E, 
+0xE0, 0x58, 0x1A, 0x89, 0xE0, 0x37, 0x64, 0x76, 
+0x69, 0x85, 0xB9, 0x34, 0x82, 0xA5, 0x57, 0xAE, 
+0x2A, 0x03, 0x00, 0x9B, 0x97, 0xCF, 0xE1, 0x59, 
+0x94, 0x74, 0x07, 0x63, 0xD9, 0x6B, 0xBC, 0x1B, 
+0x03, 0xD9, 0xE4, 0x40, 0xA5, 0xCB, 0x63, 0xF4, 
+0xA1, 0xBA, 0x2F, 0xCE, 0x1A, 0x8F, 0xB7, 0x5A, 
+0xB9, 0xFE, 0xEF, 0x8C, 0xA6, 0x74, 0x97, 0xC0, 
+0xD6, 0x80, 0xB7, 0x2F, 0x03, 0xD8, 0x9B, 0x6E, 
+0x36, 0x5D, 0xCC, 0x92, 0x21, 0x6E, 0xB8, 0x02, 
+0xFA, 0xF9, 0xEA, 0x24, 0xA0, 0x3F, 0xDA, 0x2A, 
+0xD9, 0x43, 0x1C, 0x07, 0xF2, 0x0A, 0x2C, 0x75, 
+0x3D, 0x42, 0xDB, 0xEA, 0x33, 0xAB, 0x9D, 0x95, 
+0xBE, 0x53, 0xF6, 0xAC, 0x56, 0xEB, 
+
+0x80, 0x25, 0xA8, 0x28, 0x34, 0x43, 0x8F, 0xDB, 
+0xE4, 0xB8, 0x7A, 0x84, 0xE3, 0x12, 0x2E, 0xD3, 
+0x28, 0x14, 0xFE, 0x42, 0x90, 0x95, 0x26, 0x2B, 
+0xA1, 0x19, 0x69, 0x23, 0xCF, 0x9D, 0x91, 0xF4, 
+0xD4, 0xF3, 0x3A, 0xCF, 0xA0, 0x61, 0x3E, 0x91, 
+0xBF, 0xEE, 0x5B, 0xF8, 0x4E, 0xBE, 0xA0, 0x0A, 
+0xAB, 0xBC, 0xE4, 0x6A, 0x11, 0x45, 0x20, 0xEA, 
+0x3B, 0x29, 0xB7, 0xCA, 0x79, 0x75, 0x06, 0x48, 
+0x4B, 0x7E, 0x11, 0xC5, 0xA8, 0x6A, 0x51, 0x9B, 
+0x32, 0xBA, 0x3A, 0xA4, 0x06, 0x69, 0xC6, 0xD7, 
+0x8F, 0xDE, 0x67, 0x52, 0x63, 0xFC, 0x71, 0x99, 
+0x62, 0x10, 0x4B, 0x58, 0x77, 0xAB, 0x16, 0xBC, 
+0x5D, 0xDF, 0x25, 0x45, 0xB3, 0x37, 0x03, 0x12, 
+0x42, 0xAE, 0x77, 0xBD, 0xD5, 0x59, 0x37, 0x62, 
+0x28, 0x8C, 0x0F, 0x70, 0x3B, 0x0B, 0x08, 0x2B, 
+0x13, 0xAE, 0x21, 0x58, 0x20, 0x38, 0xDC, 
+
+0xCE, 0xFA, 0x4C, 0xBD, 0xCA, 0xBC, 0x3A, 0xC0, 
+0x4B, 0x91, 0x3F, 0x33, 0x19, 0x46, 0x05, 0x16, 
+0x80, 0xC0, 0xE2, 0x63, 0xB0, 0xE0, 0xD5, 0x2B, 
+0xCE, 0xBA, 0x01, 0x36, 0x5B, 0xCD, 0x68, 0x17, 
+0xCF, 0xEC, 0x0A, 0x31, 0x45, 0x36, 0x1B, 0x28, 
+0xB1, 0x85, 0x90, 0x55, 0xB5, 0x27, 0x90, 0xE1, 
+0x09, 0x4E, 0x8E, 0x27, 0x91, 0xD7, 0x15, 0x70, 
+0x85, 0x89, 0x20, 0x0D, 0xD3, 0xF1, 0x70, 0x74, 
+0xAA, 0x29, 0x0A, 0xA2, 0xA3, 0x96, 0x4B, 0x6E, 
+0xBB, 0x04, 0x99, 0x4D, 0xC9, 0xD8, 0xFB, 0xA3, 
+0xD0, 0xEF, 0x9D, 0x2A, 0x77, 0xC9, 0x0E, 0x85, 
+0x33, 0x68, 0xFC, 0x30, 0xA1, 0x48, 0x55, 0x75, 
+0x55, 0x21, 0xC8, 0x1F, 0x01, 0xF2, 0xA5, 0x2B, 
+0x7D, 0xD7, 0x5E, 0x8C, 0x76, 0x06, 0x18, 0xBD, 
+0x74, 0x4A, 0x5F, 0xE4, 0xE9, 0xD7, 0xCB, 0xEB, 
+0x37, 0x95, 0x4F, 0x06, 0x26, 0xD2, 0xF1, 0xD4, 
+
+0x37, 0x0D, 0xA6, 0xE9, 0x34, 0x06, 0x17, 0x74, 
+0x20, 0x52, 0x8E, 0x5B, 0xC2, 0x71, 0x8D, 0x6E, 
+0x3C, 0xAA, 0xAA, 0xF9, 0x7F, 0x82, 0x09, 0x74, 
+0x29, 0xCF, 0x79, 0xC1, 0xF7, 0x42, 0xB8, 0xA9, 
+0x36, 0xA5, 0xFF, 0x77, 0x15, 0x09, 0x0B, 0x04, 
+0x7E, 0xF4, 0xE1, 0xB1, 0x88, 0x89, 0x7A, 0x88, 
+0x98, 0xD3, 0xA7, 0x95, 0x23, 0x69, 0x88, 0xD3, 
+0x8F, 0x61, 0x1E, 0xBF, 0x99, 0xAD, 0xB4, 0x56, 
+0xDF, 0x37, 0x12, 0x78, 0xDA, 0xF7, 0xDA, 0x42, 
+0x8A, 0x43, 0xBD, 0x1A, 0xB2, 0x0A, 0xDA, 0x71, 
+0xD6, 0x16, 0xDB, 0x15, 0xCA, 0xEA, 0x74, 0xA2, 
+0x11, 0x0D, 0xAE, 0xE5, 0x2B, 0x64, 0x01, 0x61, 
+0x91, 0x38, 0x16, 0xF4, 0x6E, 0xB9, 0x01, 0xDF, 
+0xFD, 0xCD, 0x56, 0x79, 0xA7, 0x42, 0x7D, 0x8C, 
+0xF4, 0x10, 0x4B, 0x00, 0xBA, 0x05, 0x77, 0x35, 
+0x29, 0x74, 0x45, 0xDF, 0xCF, 0x2A, 0x6E, 0xBF, 
+0x60, 
+
+0x49, 0x06, 0x7D, 0xD6, 0xE2, 0xC8, 0x54, 0x45, 
+0x41, 0x97, 0x98, 0x29, 0xB5, 0x49, 0x3C, 0xE2, 
+0x29, 0xC1, 0x87, 0xAC, 0x4A, 0x88, 0x31, 0x54, 
+0x12, 0xDF, 0x6F, 0x7C, 0x88, 0xD4, 0x5A, 0x69, 
+0xEF, 0x89, 0xB7, 0x26, 0xBD, 0x5D, 0x14, 0x45, 
+0x5B, 0xAA, 0xC0, 0x31, 0x77, 0x20, 0x56, 0x22, 
+0x32, 0x43, 0x0B, 0x0F, 0x29, 0x9F, 0x2C, 0xA5, 
+0x42, 0xFC, 0x25, 0x1C, 0x9C, 0x1B, 0xE7, 0xBE, 
+0x65, 0x8F, 0xBD, 0xA8, 0x07, 0xCC, 0x54, 0xFF, 
+0xD2, 0x3B, 0xC0, 0x52, 0x9F, 0x21, 0xDE, 0xA4, 
+0xA0, 0x8F, 0xB9, 0x05, 0x51, 0x8B, 0x4D, 0x6C, 
+0x87, 0x4A, 0xD3, 0x82, 0xD4, 0x0D, 0xE2, 0x11, 
+0xAC, 0x48, 0x81, 0x6D, 0xF9, 0xFD, 0xEC, 0x8D, 
+0x15, 0x38, 0x8E, 0x6B, 0xE4, 0x5B, 0x04, 0x42, 
+0xD5, 0x34, 0xFB, 0xE1, 0xBC, 0x0D, 0xDB, 0xB7, 
+0xA9, 0xF7, 0xE6, 0x42, 0x5C, 0xBF, 0x89, 0x57, 
+0x63, 0xF6, 
+
+0x90, 0x0A, 0xF6, 0x05, 0x11, 0x92, 0x82, 0x59, 
+0xA1, 0x31, 0x54, 0xE8, 0x93, 0x6C, 0xE5, 0x4B, 
+0xE8, 0x6C, 0x14, 0x80, 0xFB, 0x5F, 0xB5, 0x52, 
+0xAA, 0x4A, 0x04, 0x64, 0xC5, 0xC5, 0x03, 0x2C, 
+0x2D, 0xB1, 0x6F, 0xDC, 0xA0, 0x74, 0xFF, 0xDE, 
+0xE5, 0x0D, 0x50, 0xE0, 0xBE, 0x35, 0xBA, 0x42, 
+0x5E, 0x56, 0x64, 0x4F, 0xA0, 0x19, 0xD0, 0xAC, 
+0xC6, 0x79, 0x09, 0x61, 0x1C, 0x72, 0xE2, 0x38, 
+0x66, 0x7E, 0x96, 0x61, 0xC7, 0x69, 0x41, 0xEF, 
+0x5A, 0x41, 0x89, 0xB2, 0xC6, 0xA9, 0xF3, 0xCB, 
+0x8C, 0xAE, 0x1E, 0xBE, 0xDE, 0xF2, 0x4B, 0x20, 
+0x67, 0xFF, 0x89, 0xAD, 0x95, 0x0A, 0x4D, 0x2C, 
+0xEC, 0x62, 0x45, 0x53, 0x5C, 0xD8, 0x8F, 0x41, 
+0x1B, 0x5E, 0xC6, 0x8E, 0xF6, 0x79, 0xB1, 0x3E, 
+0xD5, 0x95, 0x46, 0x4E, 0x85, 0xA2, 0x32, 0x79, 
+0x06, 0xE5, 0xBE, 0xD0, 0xEB, 0x77, 0xE0, 0x87, 
+0x87, 0x75, 0xDD, 
+
+0xAC, 0x14, 0x1B, 0x69, 0xEE, 0x01, 0x0E, 0xE8, 
+0x55, 0x78, 0x58, 0x91, 0xBE, 0xB4, 0x31, 0xCD, 
+0x3D, 0xBE, 0x2F, 0x30, 0x81, 0x9D, 0x5F, 0x3A, 
+0x65, 0x49, 0x1E, 0x24, 0x75, 0x38, 0xEA, 0x0F, 
+0x45, 0x88, 0x6F, 0x66, 0x98, 0x56, 0x84, 0x41, 
+0xB0, 0x7B, 0x08, 0x92, 0xE1, 0xAC, 0x8E, 0xFD, 
+0x51, 0x96, 0xAD, 0x8B, 0x2D, 0x8C, 0xB8, 0xFA, 
+0xB8, 0xA6, 0x63, 0x34, 0xF3, 0xC1, 0xCB, 0x51, 
+0xC2, 0xAB, 0x04, 0x9B, 0x53, 0x28, 0xF9, 0x13, 
+0xF0, 0x11, 0x54, 0xFF, 0xB0, 0x47, 0x18, 0x29, 
+0xC5, 0x97, 0x43, 0x5E, 0x7C, 0xAA, 0x6F, 0x38, 
+0x99, 0x04, 0x5C, 0x79, 0xF0, 0x08, 0xA2, 0x69, 
+0xD3, 0xFF, 0x62, 0xC6, 0xDB, 0xA8, 0x2B, 0xF5, 
+0xDB, 0xD2, 0x8A, 0xDD, 0x4F, 0xC3, 0xD2, 0x00, 
+0xE5, 0xDE, 0x75, 0xD7, 0xC4, 0x8B, 0x76, 0xC8, 
+0xDB, 0x55, 0xCB, 0xE6, 0x1B, 0xAB, 0xD2, 0x4E, 
+0x3B, 0x93, 0x08, 0x43, 
+
+0x10, 0x07, 0x49, 0x6C, 0x11, 0x44, 0xEE, 0xFA, 
+0x41, 0x19, 0xFA, 0xD1, 0x7D, 0xEC, 0xD2, 0x8F, 
+0xA2, 0xBC, 0xB5, 0x38, 0x85, 0xD5, 0x4B, 0x79, 
+0xFB, 0x77, 0x69, 0x42, 0x65, 0xEC, 0x29, 0x04, 
+0x24, 0xCF, 0x51, 0xE1, 0xC2, 0x23, 0x06, 0x88, 
+0x47, 0xAE, 0x7D, 0xA1, 0x08, 0x9A, 0x82, 0x2C, 
+0xD6, 0xB4, 0x4D, 0x84, 0x96, 0x19, 0x17, 0x5A, 
+0x0F, 0x22, 0x29, 0xDE, 0x0F, 0x8F, 0x71, 0xAE, 
+0x12, 0x65, 0x87, 0xC5, 0xB4, 0xAD, 0x58, 0xDB, 
+0xCA, 0x66, 0xE6, 0xB8, 0xE4, 0x3A, 0x80, 0x2B, 
+0x18, 0x88, 0xD6, 0x21, 0xDC, 0x2B, 0xC8, 0x52, 
+0x4B, 0x48, 0x90, 0x37, 0x72, 0x02, 0xCD, 0x01, 
+0xEC, 0xE8, 0x03, 0xD4, 0x28, 0x8D, 0xD1, 0x94, 
+0xBC, 0x1E, 0xAE, 0xA2, 0x79, 0x12, 0xB0, 0x5D, 
+0x34, 0x32, 0x53, 0x1B, 0xC9, 0xA8, 0x6A, 0x1D, 
+0x48, 0x30, 0x08, 0xB4, 0xC5, 0x54, 0x0A, 0x1E, 
+0x24, 0x9E, 0x8D, 0x58, 0xF2, 
+
+0x1C, 0x73, 0x21, 0xCA, 0xB9, 0x20, 0x75, 0xEF, 
+0xF4, 0x2B, 0xA5, 0xE5, 0x66, 0x62, 0xF3, 0x31, 
+0x61, 0x92, 0x8B, 0x34, 0x44, 0x67, 0xC5, 0xAD, 
+0xC1, 0x56, 0xC3, 0xB7, 0x23, 0xC6, 0x2B, 0xB1, 
+0x34, 0x5F, 0xEF, 0xF7, 0x70, 0x21, 0x5E, 0x35, 
+0x36, 0x28, 0xCC, 0xD2, 0x61, 0x58, 0xA3, 0xF6, 
+0xB0, 0xD7, 0xA2, 0xBC, 0xF3, 0x02, 0x4E, 0x76, 
+0x55, 0x1D, 0x48, 0x45, 0x90, 0x4C, 0xEF, 0x6C, 
+0xAC, 0x58, 0x7B, 0xF2, 0x7C, 0x3E, 0x3F, 0xD6, 
+0xFB, 0x57, 0x74, 0x3B, 0x91, 0xBE, 0xCE, 0x05, 
+0x58, 0x9E, 0x26, 0xE6, 0x8A, 0x96, 0x8B, 0x00, 
+0xC3, 0x28, 0x4A, 0xE8, 0x5C, 0xDA, 0x46, 0xF2, 
+0x55, 0x14, 0x7C, 0x86, 0x58, 0xE0, 0x78, 0x16, 
+0x0B, 0xA6, 0x45, 0xB2, 0xBD, 0x09, 0x83, 0x16, 
+0xFE, 0x8F, 0x0D, 0xE6, 0x46, 0xE4, 0x85, 0x80, 
+0x95, 0xE5, 0xC7, 0xEC, 0x5A, 0xE2, 0xE8, 0x1F, 
+0xCC, 0x33, 0x25, 0x2A, 0x71, 0xB4, 
+
+0xDF, 0x62, 0x81, 0xFF, 0x5B, 0x88, 0x34, 0xB3, 
+0xA9, 0x4A, 0xA6, 0xD9, 0x08, 0x48, 0x65, 0x7D, 
+0xFC, 0x07, 0x56, 0xD8, 0xF5, 0xBA, 0x69, 0xD2, 
+0xF8, 0x68, 0xAD, 0xDB, 0x14, 0x6F, 0x3F, 0x27, 
+0x0B, 0x1D, 0x14, 0x53, 0x2C, 0xC2, 0x9E, 0x7E, 
+0x1A, 0x97, 0xA2, 0xE3, 0x0B, 0x9E, 0x78, 0x3C, 
+0x79, 0x4B, 0xD9, 0xCC, 0xDD, 0x19, 0x0D, 0x26, 
+0x77, 0x65, 0xE6, 0x89, 0x19, 0xAB, 0x58, 0xA3, 
+0x91, 0x50, 0x82, 0xA0, 0xB1, 0xB3, 0x7E, 0xD7, 
+0x4B, 0xE2, 0xA2, 0xF7, 0x1E, 0x37, 0x33, 0x18, 
+0xF6, 0x09, 0x60, 0x6B, 0x00, 0x46, 0xEF, 0xB1, 
+0x06, 0x60, 0x1B, 0x0A, 0x4C, 0x0E, 0xE3, 0xFA, 
+0xEA, 0xD7, 0x9D, 0xDC, 0x6B, 0x4C, 0x9E, 0x9C, 
+0x61, 0x22, 0xAB, 0x19, 0x6E, 0x62, 0xA7, 0x25, 
+0x26, 0xC3, 0xDB, 0x9B, 0xE0, 0x6E, 0xD4, 0x2D, 
+0xDC, 0x71, 0x2A, 0x13, 0x7C, 0x77, 0xE8, 0xAA, 
+0x1D, 0xED, 0x37, 0xB5, 0x6A, 0xB8, 0x3E, 
+
+0x02, 0xDC, 0x62, 0xA2, 0x98, 0x84, 0x22, 0x1E, 
+0xB7, 0x25, 0x9A, 0xED, 0xDD, 0x60, 0x96, 0x44, 
+0xEB, 0x1B, 0xC6, 0x9A, 0xEE, 0x76, 0x1B, 0x67, 
+0xF4, 0x66, 0xE8, 0x63, 0x55, 0x3F, 0x6F, 0xB9, 
+0x35, 0xAE, 0xB4, 0x3D, 0xD4, 0xDC, 0x4B, 0xD9, 
+0x42, 0x1F, 0x7A, 0x36, 0x4D, 0x2C, 0x01, 0x2A, 
+0x74, 0x5B, 0x67, 0xF9, 0xE1, 0x66, 0x69, 0xEF, 
+0x89, 0x17, 0x96, 0xFF, 0x3F, 0xC6, 0x6C, 0xAB, 
+0xFA, 0xE8, 0x82, 0x6D, 0x65, 0x48, 0xD2, 0x8D, 
+0x0D, 0xCB, 0x0F, 0x5B, 0x2E, 0xCF, 0x17, 0xEA, 
+0x60, 0x96, 0x76, 0x64, 0x33, 0x9A, 0xDE, 0x11, 
+0xB4, 0x2A, 0xC4, 0x2D, 0x35, 0x2B, 0xF8, 0x36, 
+0x80, 0xC4, 0x4E, 0xFE, 0x14, 0xFD, 0xB2, 0x57, 
+0x0A, 0x46, 0x29, 0x37, 0x50, 0x7B, 0x26, 0x29, 
+0x2B, 0xAE, 0x3D, 0xB9, 0xF8, 0x38, 0x31, 0x4C, 
+0x13, 0x67, 0xD5, 0xF2, 0x40, 0x92, 0x09, 0xF1, 
+0x5C, 0xE1, 0xF3, 0xE7, 0xE3, 0x6B, 0x25, 0x88, 
+
+0x70, 0x64, 0x4F, 0x22, 0x92, 0xCC, 0xBA, 0x70, 
+0x10, 0xB5, 0xF9, 0x5D, 0xF6, 0xBD, 0x95, 0x7A, 
+0x9E, 0xA1, 0xC3, 0x00, 0x77, 0x6A, 0x12, 0x94, 
+0x43, 0xC9, 0x0C, 0xBB, 0xE2, 0xCD, 0xBE, 0xB7, 
+0xF4, 0x11, 0xB0, 0x86, 0x11, 0xBB, 0x98, 0x31, 
+0xBE, 0xC0, 0x82, 0xF9, 0x84, 0xF7, 0x99, 0xA6, 
+0x1A, 0x44, 0x66, 0x28, 0x93, 0x32, 0xD9, 0x91, 
+0x16, 0x8F, 0x53, 0x0D, 0x21, 0xF4, 0xFC, 0x69, 
+0x5F, 0xDD, 0xFE, 0xF5, 0x59, 0x9A, 0x96, 0x4F, 
+0x1C, 0x2A, 0xFD, 0xE4, 0x88, 0x66, 0x2A, 0x78, 
+0x33, 0x60, 0xF3, 0x10, 0x55, 0xED, 0x68, 0xE6, 
+0x34, 0x67, 0x62, 0x04, 0xBF, 0x39, 0x17, 0x3D, 
+0x1B, 0x22, 0x8A, 0x77, 0x45, 0x90, 0x7C, 0x5C, 
+0xCF, 0x4E, 0xE2, 0xB0, 0x88, 0xB8, 0x2B, 0x4A, 
+0x4F, 0xCC, 0x14, 0xDC, 0xD3, 0x6A, 0x77, 0xA7, 
+0xA7, 0x52, 0x0A, 0x4A, 0xCC, 0x3C, 0x30, 0xF0, 
+0x9B, 0x10, 0x1A, 0xAA, 0xC8, 0x5A, 0xFE, 0x6F, 
+0xA6, 
+
+0xC1, 0x62, 0xCF, 0x2C, 0xBA, 0xC0, 0xC0, 0x84, 
+0x67, 0x4E, 0x96, 0xA2, 0xFF, 0x81, 0xE6, 0x74, 
+0xFA, 0x22, 0xCE, 0x6B, 0xC4, 0x9C, 0x1D, 0x03, 
+0xC0, 0xB1, 0x8B, 0x10, 0x33, 0xC3, 0x1F, 0x61, 
+0x2D, 0x67, 0x09, 0x2F, 0x04, 0x51, 0x49, 0xCE, 
+0x9F, 0x94, 0x14, 0xAB, 0x0A, 0x7F, 0x39, 0xD9, 
+0xA5, 0x4F, 0x4E, 0x5A, 0x3C, 0xCB, 0xB8, 0x74, 
+0x51, 0x28, 0x3B, 0xBE, 0x1B, 0x5E, 0x94, 0xFC, 
+0xD1, 0xB2, 0x0A, 0x46, 0x20, 0xB3, 0x7E, 0x6E, 
+0xD3, 0x9F, 0x76, 0x3F, 0x4A, 0xFF, 0x0B, 0xB7, 
+0x52, 0xE2, 0xA4, 0xDF, 0x2E, 0x0C, 0x72, 0xE2, 
+0xC7, 0x91, 0x2D, 0x5F, 0xB3, 0x38, 0x4F, 0x03, 
+0x5C, 0x19, 0x2F, 0xBD, 0xDC, 0x84, 0xB5, 0xF9, 
+0xFF, 0x8C, 0x19, 0x6F, 0x57, 0x4A, 0xD7, 0xA6, 
+0xC5, 0x93, 0x2E, 0xEA, 0x35, 0xD7, 0xF8, 0xD4, 
+0x08, 0xCA, 0x21, 0x06, 0x21, 0x12, 0x9B, 0xE2, 
+0xF5, 0x38, 0x2C, 0xD4, 0x02, 0xD9, 0x56, 0xDE, 
+0x5D, 0xB5, 
+
+0x1F, 0x86, 0xAE, 0xE4, 0xE4, 0xB2, 0x42, 0x60, 
+0x9B, 0xBF, 0x1E, 0xA5, 0x16, 0x65, 0xA0, 0xDD, 
+0xB3, 0xC6, 0xCA, 0xD7, 0xB8, 0xAE, 0x14, 0xCD, 
+0xDF, 0xE9, 0xFF, 0x63, 0x38, 0x87, 0xE8, 0x2F, 
+0xAE, 0xC7, 0xC7, 0x43, 0x46, 0x24, 0x54, 0xC0, 
+0x41, 0xA6, 0x27, 0x73, 0x4E, 0x63, 0x0D, 0x0F, 
+0x2D, 0xAA, 0xD6, 0x62, 0xFE, 0x8E, 0xE4, 0x3F, 
+0xA2, 0xC3, 0x98, 0x5B, 0x93, 0x5B, 0x11, 0xEA, 
+0xC9, 0x6D, 0xED, 0x9E, 0x71, 0x9D, 0xD9, 0x5E, 
+0xA7, 0xB3, 0x4C, 0xB9, 0xBF, 0x2C, 0xE0, 0x90, 
+0x3C, 0x19, 0x82, 0xE5, 0x94, 0xB9, 0xD7, 0x67, 
+0x3F, 0xA7, 0x78, 0xAE, 0x10, 0x8B, 0x59, 0x69, 
+0xD3, 0x73, 0x33, 0x2C, 0xD5, 0x74, 0x76, 0x56, 
+0xA5, 0x16, 0xF0, 0x78, 0xB9, 0x4E, 0xFA, 0x0B, 
+0x71, 0x77, 0x99, 0xAD, 0xBC, 0xD5, 0x86, 0xE0, 
+0xFD, 0x36, 0xF6, 0x35, 0x97, 0x05, 0xEF, 0xB6, 
+0xFF, 0x83, 0xA7, 0x0F, 0x65, 0xE8, 0x98, 0x8F, 
+0x42, 0xB0, 0x8B, 
+
+0x90, 0x2A, 0x6C, 0xF6, 0x1D, 0xD4, 0x36, 0xDD, 
+0xC3, 0x87, 0x2D, 0xE1, 0x68, 0xED, 0x8F, 0x21, 
+0x0E, 0x53, 0xF5, 0x03, 0xE9, 0xA0, 0xD5, 0x3A, 
+0xF9, 0xEF, 0x15, 0xCC, 0x0E, 0xE3, 0xD6, 0x27, 
+0xB3, 0x30, 0x8B, 0x60, 0x0A, 0x43, 0x2F, 0xA4, 
+0x41, 0x0E, 0xE9, 0x8F, 0xFD, 0x6E, 0x7C, 0x8A, 
+0xEA, 0xCB, 0x7F, 0x1E, 0xEF, 0x15, 0x97, 0x59, 
+0x4A, 0x67, 0x25, 0xFD, 0x72, 0xB6, 0x0D, 0x3A, 
+0xC0, 0xCE, 0xD4, 0xA7, 0xF6, 0x61, 0x6B, 0x60, 
+0x8C, 0x55, 0xB3, 0xB7, 0xA9, 0xB0, 0x7E, 0xD8, 
+0x95, 0xBB, 0xAA, 0xFD, 0x3B, 0x72, 0x40, 0x72, 
+0x28, 0xA4, 0x73, 0x19, 0x75, 0x37, 0x39, 0x07, 
+0xBD, 0xB8, 0xB6, 0x51, 0x48, 0xC2, 0xB5, 0xFF, 
+0xE8, 0xBC, 0x0C, 0x65, 0xD0, 0x8E, 0xB2, 0x32, 
+0xA5, 0xCE, 0xE7, 0xB9, 0x3C, 0xC2, 0x56, 0xBF, 
+0x4D, 0x7B, 0x8E, 0xA4, 0xCE, 0x2D, 0x7B, 0x32, 
+0x48, 0xA9, 0xE3, 0x3A, 0x34, 0x18, 0xB0, 0x6A, 
+0x68, 0x88, 0xED, 0x55, 
+
+0xB6, 0xDF, 0x47, 0x88, 0x2F, 0x70, 0x18, 0x3C, 
+0xB9, 0x72, 0x04, 0x1E, 0x75, 0x5F, 0x45, 0xCA, 
+0xA4, 0xB0, 0xAC, 0x11, 0x1B, 0x65, 0x4B, 0xAE, 
+0xCA, 0x4D, 0xA1, 0xA9, 0x2C, 0xDF, 0x76, 0x14, 
+0x94, 0x2A, 0xFC, 0x04, 0xC2, 0xE8, 0xAE, 0x94, 
+0x15, 0x11, 0x07, 0x7A, 0x72, 0x25, 0xB6, 0x6D, 
+0x70, 0x03, 0xC9, 0xAC, 0xE0, 0xAB, 0xD0, 0xC1, 
+0x90, 0x4E, 0x08, 0xF4, 0x9F, 0x13, 0xB4, 0x7B, 
+0xB5, 0x56, 0x37, 0x20, 0x83, 0x0C, 0x71, 0xA6, 
+0x93, 0xF5, 0x00, 0x2F, 0xC9, 0xD5, 0x6A, 0x5C, 
+0xB7, 0xA7, 0x9E, 0xB2, 0x1B, 0x62, 0x49, 0x9B, 
+0xA0, 0x2A, 0x54, 0xC5, 0xE4, 0x5C, 0xCF, 0x5A, 
+0x63, 0x9D, 0xA2, 0x85, 0x8D, 0xFA, 0xEE, 0x2B, 
+0x1A, 0x41, 0x35, 0x97, 0x67, 0x36, 0x53, 0x25, 
+0x52, 0x57, 0x8C, 0x8E, 0x53, 0x27, 0x89, 0xBC, 
+0x2D, 0x3F, 0x91, 0xC4, 0x36, 0xB1, 0xEF, 0x54, 
+0x1A, 0xCB, 0xDA, 0xC9, 0x78, 0x54, 0x78, 0xBA, 
+0xDD, 0xA9, 0x23, 0x9D, 0xB4, 
+
+0xB0, 0xA8, 0x6B, 0x05, 0x72, 0x86, 0xDA, 0xD2, 
+0x21, 0xDC, 0xC6, 0x3C, 0x0B, 0x47, 0x2E, 0xAA, 
+0x4A, 0x4F, 0x19, 0x7B, 0xAC, 0xEF, 0x6F, 0xA6, 
+0xD4, 0x9D, 0x45, 0xEF, 0xB4, 0x14, 0x83, 0x49, 
+0xA6, 0x20, 0x55, 0x18, 0xA0, 0x64, 0x49, 0xD0, 
+0xBB, 0x1C, 0xA5, 0x49, 0x56, 0x13, 0x8E, 0xD6, 
+0x9A, 0x22, 0x3E, 0x1A, 0x28, 0x24, 0x6E, 0x55, 
+0xAF, 0xA3, 0xDD, 0x02, 0x1B, 0xBC, 0x1E, 0xF2, 
+0xA7, 0x8A, 0x5C, 0xBD, 0x6A, 0xE8, 0x26, 0x21, 
+0x81, 0x5D, 0x6C, 0xDF, 0x44, 0xF8, 0xB4, 0xA7, 
+0x42, 0x9D, 0x73, 0x9A, 0xC4, 0x7A, 0x4B, 0xF2, 
+0x98, 0xFC, 0xB7, 0xE8, 0xEA, 0x8C, 0x0A, 0xC2, 
+0xD5, 0xC3, 0x51, 0xCF, 0x3F, 0x71, 0xB5, 0xE4, 
+0xC3, 0x5B, 0x30, 0xA6, 0xE4, 0x80, 0xFA, 0x46, 
+0xB4, 0xBC, 0x13, 0x01, 0xA0, 0xA5, 0xF8, 0xEF, 
+0x6D, 0xA8, 0xD3, 0xCB, 0x92, 0xD7, 0xBF, 0x46, 
+0x73, 0xC4, 0xE0, 0x12, 0xC3, 0x57, 0xC1, 0x20, 
+0x5E, 0xF2, 0xF2, 0x7A, 0x18, 0x54, 
+
+0xD5, 0x8C, 0x8D, 0x9B, 0x33, 0xB8, 0x9B, 0xC3, 
+0x15, 0x50, 0xC9, 0xC7, 0x25, 0x8B, 0x36, 0xA6, 
+0x5C, 0x3E, 0x87, 0x59, 0x3F, 0x6B, 0x0A, 0xD8, 
+0x22, 0x39, 0x48, 0x8A, 0xE4, 0x50, 0x23, 0x8F, 
+0x3A, 0x6E, 0xE1, 0x60, 0xA3, 0xDE, 0xB3, 0xFA, 
+0xEA, 0xD4, 0x8B, 0x25, 0x17, 0xB1, 0x4E, 0xBA, 
+0x0E, 0x97, 0x22, 0x37, 0xEE, 0xFE, 0x5D, 0x42, 
+0xB5, 0xE5, 0xC1, 0x96, 0x34, 0xA6, 0x0E, 0x11, 
+0xAA, 0x41, 0xD4, 0xF5, 0xDA, 0x40, 0x22, 0x2B, 
+0xF9, 0x9E, 0x9C, 0x55, 0x6C, 0xB9, 0x73, 0xDF, 
+0x94, 0x71, 0x8B, 0x90, 0x91, 0x67, 0xF4, 0xFE, 
+0x01, 0x90, 0x64, 0xB8, 0xB8, 0x31, 0x85, 0xAE, 
+0x82, 0x1B, 0x65, 0xD4, 0xC8, 0x61, 0x9D, 0x1D, 
+0x9A, 0x94, 0x40, 0xD9, 0x7C, 0xE2, 0xE9, 0x09, 
+0xE9, 0x2B, 0xD0, 0xEB, 0xBE, 0x57, 0xAD, 0x29, 
+0xCB, 0xA5, 0xD4, 0x46, 0xBA, 0xB6, 0x86, 0xB8, 
+0xD1, 0x2F, 0x0B, 0xFC, 0xCA, 0x43, 0xBA, 0x60, 
+0xA2, 0x0F, 0xA6, 0xC6, 0x2F, 0x05, 0x0A, 
+
+0xA1, 0x11, 0x6A, 0x04, 0xA8, 0x55, 0xB1, 0x04, 
+0xA8, 0x6F, 0x55, 0x85, 0xE9, 0xF1, 0x0D, 0x6F, 
+0xDD, 0x04, 0x10, 0x3C, 0xB3, 0xAD, 0xD2, 0x9E, 
+0xE7, 0x1E, 0x08, 0xBF, 0x65, 0x0A, 0xB0, 0xB4, 
+0x95, 0x6D, 0xC6, 0x3E, 0xD8, 0x71, 0x46, 0x3E, 
+0x1B, 0x1E, 0x82, 0x8D, 0x16, 0xF8, 0x98, 0xF1, 
+0xEC, 0x0B, 0x0C, 0xFB, 0x73, 0xDB, 0x28, 0x1E, 
+0xC1, 0xD0, 0xC2, 0xB1, 0xB0, 0x48, 0x02, 0xD9, 
+0x69, 0xC5, 0x72, 0x6D, 0xB2, 0xD2, 0xCE, 0x15, 
+0x8C, 0x77, 0xAD, 0x48, 0x7C, 0x4D, 0xEA, 0x87, 
+0x5E, 0x13, 0x6D, 0x61, 0xFE, 0xFC, 0x75, 0xAA, 
+0x76, 0x55, 0x2E, 0x87, 0x26, 0x92, 0x9D, 0x43, 
+0x61, 0x7D, 0x1F, 0xFD, 0xE0, 0xCA, 0xC0, 0x87, 
+0xAF, 0xAF, 0xC4, 0x92, 0x37, 0xED, 0xBB, 0x4F, 
+0x38, 0x03, 0xE0, 0x1E, 0x1D, 0xB1, 0x4A, 0xBA, 
+0x9A, 0xA0, 0x14, 0x84, 0x90, 0x90, 0x70, 0x62, 
+0xA2, 0x55, 0x1D, 0x49, 0xD1, 0x51, 0xF1, 0x9B, 
+0x1E, 0xD6, 0x8C, 0x02, 0xA4, 0xE2, 0x2D, 0x0E, 
+
+0xB9, 0xED, 0x83, 0x89, 0xD5, 0x73, 0x97, 0x72, 
+0x3A, 0xF3, 0x32, 0x9A, 0xC4, 0xE0, 0x80, 0x5B, 
+0xA3, 0x2E, 0x18, 0x82, 0xD9, 0x6D, 0xDC, 0xA3, 
+0x4F, 0x00, 0x24, 0x67, 0x52, 0xDC, 0xD9, 0x07, 
+0x1E, 0x62, 0x1A, 0xC8, 0xA8, 0xC0, 0xEF, 0x68, 
+0x5E, 0xED, 0x2A, 0x23, 0xA2, 0x91, 0xC4, 0x49, 
+0x69, 0xF4, 0xC1, 0x84, 0x60, 0x84, 0xAB, 0x34, 
+0x91, 0x73, 0x76, 0x4A, 0x70, 0x98, 0xD5, 0x42, 
+0xA0, 0x8C, 0x69, 0x1A, 0x6B, 0xC5, 0x90, 0x86, 
+0x4E, 0xF3, 0x4C, 0xA0, 0xBA, 0x47, 0x4F, 0x41, 
+0x0E, 0x83, 0x30, 0xF7, 0x5B, 0x47, 0x4C, 0x2D, 
+0x78, 0xB9, 0x49, 0x51, 0x54, 0xF4, 0x01, 0x23, 
+0x77, 0x6D, 0x2D, 0x05, 0x1F, 0xA9, 0xA5, 0x65, 
+0x83, 0x92, 0xF3, 0xCE, 0x19, 0x2A, 0x9A, 0xF2, 
+0xC6, 0x9C, 0x08, 0x8D, 0xF9, 0x31, 0xCA, 0xD0, 
+0xF0, 0x56, 0xB9, 0xD2, 0xDE, 0x09, 0x6E, 0x7B, 
+0xF1, 0x33, 0x45, 0xAD, 0x5D, 0x20, 0xC9, 0x6B, 
+0x12, 0x91, 0xCC, 0xA0, 0x44, 0xF3, 0xB2, 0x9C, 
+0x29, 
+
+0x28, 0x3B, 0xFC, 0xF7, 0x0B, 0xE0, 0x62, 0x2F, 
+0xB3, 0x2E, 0x10, 0xD3, 0x78, 0x0C, 0x33, 0x6D, 
+0xD7, 0xA8, 0xB2, 0xF7, 0x1C, 0x6C, 0xBA, 0xD0, 
+0x67, 0x5F, 0xB5, 0xD3, 0x71, 0x09, 0xD7, 0x34, 
+0xCE, 0xFB, 0xF0, 0xEE, 0x7D, 0x5C, 0xCE, 0x03, 
+0x20, 0x85, 0xEA, 0xD6, 0x01, 0xF9, 0x4A, 0x3C, 
+0x83, 0xC8, 0x59, 0xA3, 0x42, 0x90, 0xB3, 0x14, 
+0x04, 0x86, 0xB1, 0x1A, 0xDB, 0x7C, 0x35, 0x56, 
+0x96, 0x15, 0xE4, 0x17, 0x27, 0xB0, 0x47, 0xF2, 
+0x75, 0x4E, 0xBD, 0x4D, 0x6D, 0x40, 0xDB, 0x03, 
+0xBE, 0x34, 0x23, 0x9C, 0x80, 0xF3, 0x34, 0xF2, 
+0x89, 0x43, 0xE2, 0x39, 0x4B, 0x9B, 0xD9, 0x1A, 
+0xAB, 0x48, 0x4A, 0x97, 0x66, 0x87, 0xF8, 0x37, 
+0x70, 0xCC, 0x05, 0x24, 0x48, 0x9F, 0xEF, 0x19, 
+0x9C, 0xB4, 0x5F, 0x76, 0x28, 0xED, 0x59, 0x12, 
+0x50, 0x5B, 0x69, 0x44, 0xA9, 0xBE, 0x40, 0xEE, 
+0x2C, 0x97, 0xA3, 0x4A, 0x05, 0xCC, 0x57, 0xB8, 
+0xC9, 0x26, 0x85, 0xE4, 0xB1, 0x58, 0xF2, 0x4D, 
+0x9B, 0xA9, 
+
+0x36, 0xEF, 0xE2, 0x4F, 0xAE, 0x4C, 0xB1, 0xB3, 
+0xB8, 0x22, 0x91, 0xB6, 0xC5, 0x31, 0x60, 0xFF, 
+0x7B, 0x00, 0x71, 0x12, 0xAA, 0x5A, 0xD2, 0x10, 
+0x5E, 0x0F, 0x4C, 0xB0, 0xDB, 0x09, 0x11, 0xC7, 
+0x28, 0x6C, 0x88, 0x96, 0x97, 0xC6, 0xB7, 0x56, 
+0x77, 0x20, 0xAB, 0xE4, 0x03, 0xFA, 0x7A, 0xCF, 
+0x8E, 0xB7, 0x73, 0xEB, 0xD6, 0x80, 0xD8, 0xAE, 
+0x6F, 0xCC, 0x22, 0x90, 0x49, 0x7B, 0x21, 0x57, 
+0xAF, 0x39, 0x8E, 0x25, 0x98, 0x54, 0x2C, 0x7A, 
+0xFC, 0xF1, 0xAE, 0xA0, 0xD7, 0x69, 0x95, 0x04, 
+0x6A, 0x93, 0xDA, 0x41, 0x8F, 0xE3, 0x53, 0x7D, 
+0x8C, 0xC4, 0xE6, 0x14, 0xD6, 0xF5, 0x23, 0x10, 
+0xFF, 0xF4, 0x3D, 0x87, 0x8E, 0x44, 0x7B, 0xBD, 
+0xDF, 0x5B, 0x81, 0x1E, 0x88, 0x9C, 0x8E, 0x3C, 
+0xB3, 0xA1, 0x8D, 0xC9, 0x1F, 0xE2, 0x51, 0xFB, 
+0x31, 0xBA, 0x07, 0x2D, 0xF8, 0xFA, 0x54, 0x88, 
+0x64, 0x00, 0xA0, 0x73, 0x2A, 0xD7, 0x9B, 0x85, 
+0xBB, 0xED, 0x0A, 0x2E, 0xA1, 0x98, 0x3D, 0x87, 
+0xFA, 0x0A, 0x43, 
+
+0xAE, 0x78, 0x23, 0x96, 0x97, 0xE1, 0x6D, 0x02, 
+0x31, 0xA9, 0x56, 0xBA, 0xDC, 0xA4, 0xDB, 0xD5, 
+0x5F, 0x37, 0x26, 0xF8, 0xED, 0x78, 0x79, 0xAA, 
+0x7B, 0xCC, 0xC3, 0x67, 0x38, 0x86, 0xB6, 0x58, 
+0x7D, 0x2A, 0x5E, 0x77, 0xF8, 0xDB, 0x3A, 0xB0, 
+0xB3, 0x83, 0x3A, 0xEF, 0xB5, 0xB0, 0x46, 0xA7, 
+0x85, 0x98, 0xA8, 0x71, 0x1C, 0x2C, 0xB6, 0x06, 
+0x3F, 0xEC, 0x59, 0x78, 0x15, 0xAD, 0x17, 0x65, 
+0x94, 0x37, 0xC1, 0x21, 0xC9, 0x1A, 0x16, 0xEA, 
+0x1A, 0xFE, 0x3D, 0x35, 0xF4, 0xBA, 0x5D, 0xA0, 
+0x21, 0x9C, 0x89, 0x39, 0x69, 0xAB, 0x8E, 0x4B, 
+0x8A, 0x9C, 0x38, 0xFD, 0x53, 0x37, 0xB4, 0x59, 
+0xBE, 0xB0, 0x56, 0xD7, 0x6C, 0xF8, 0xB8, 0xF1, 
+0x03, 0x26, 0xF5, 0x07, 0x98, 0xDB, 0xDF, 0xF3, 
+0x9D, 0x02, 0x65, 0x78, 0x7C, 0xD7, 0x71, 0x06, 
+0x93, 0xFF, 0xFA, 0xBE, 0xEF, 0x24, 0x24, 0x21, 
+0x32, 0xD8, 0xC2, 0xFD, 0xB9, 0x08, 0xBC, 0x06, 
+0xD6, 0xC1, 0xEE, 0x5D, 0xFE, 0xA1, 0x8D, 0x12, 
+0x9A, 0x36, 0x5B, 0xB7, 
+
+0x61, 0x61, 0x14, 0x68, 0xE4, 0x54, 0x04, 0x1F, 
+0xB4, 0x42, 0x87, 0x44, 0x7B, 0x01, 0xC6, 0xD6, 
+0xF9, 0xBC, 0xE5, 0x1D, 0x25, 0x6E, 0xFB, 0xC9, 
+0x2A, 0x50, 0x31, 0xB7, 0x8B, 0x11, 0x50, 0x39, 
+0x2C, 0xE9, 0x37, 0xD1, 0x43, 0xD5, 0xD2, 0x9D, 
+0x9D, 0x9E, 0x58, 0x1C, 0x14, 0x90, 0x8C, 0x0B, 
+0xF0, 0x2D, 0xBE, 0xEB, 0xF4, 0x24, 0xA9, 0xFF, 
+0x07, 0x5C, 0x8F, 0x49, 0x26, 0xEF, 0xC4, 0x74, 
+0x8E, 0x32, 0x03, 0xCA, 0xBF, 0xB9, 0x8D, 0x3C, 
+0x69, 0xE4, 0x3D, 0x35, 0x85, 0xC3, 0xAE, 0x35, 
+0x33, 0x95, 0xD4, 0xE8, 0xAC, 0xBE, 0xE2, 0x2E, 
+0xD2, 0x75, 0x3A, 0x42, 0x00, 0xBF, 0xC2, 0x61, 
+0xF1, 0x0C, 0x5D, 0xFB, 0xC9, 0x59, 0xB1, 0xAC, 
+0x37, 0x83, 0x7E, 0x52, 0xB0, 0x32, 0x38, 0x9E, 
+0xD5, 0xCD, 0xE4, 0x77, 0x47, 0x08, 0x9B, 0x41, 
+0x2A, 0x5D, 0xA7, 0x5E, 0xAA, 0xF2, 0xD3, 0x4F, 
+0x76, 0x20, 0xB3, 0xD5, 0x55, 0xEE, 0x41, 0x00, 
+0x8C, 0x7C, 0x38, 0x67, 0xF4, 0x38, 0x21, 0xB8, 
+0x3F, 0x1E, 0x57, 0x46, 0x45, 
+
+0x66, 0xF1, 0x58, 0xCA, 0xD3, 0x81, 0x9F, 0x85, 
+0x1D, 0x6F, 0xA2, 0xB5, 0xCB, 0x77, 0x12, 0x4C, 
+0x41, 0xF2, 0x93, 0xED, 0x56, 0x04, 0xC7, 0xB1, 
+0xA0, 0x9B, 0x6D, 0x51, 0x9C, 0xC8, 0xE9, 0xF3, 
+0x9C, 0xE1, 0xC2, 0x91, 0x6A, 0x31, 0x05, 0x2A, 
+0xB1, 0xEE, 0x19, 0xCD, 0x01, 0x30, 0x2F, 0x80, 
+0x37, 0x03, 0xCD, 0xB1, 0x7D, 0xB8, 0xC0, 0x06, 
+0x83, 0x00, 0xC7, 0x12, 0x85, 0x43, 0x8D, 0x67, 
+0x12, 0xD8, 0xC8, 0x15, 0x62, 0x80, 0xA1, 0xE1, 
+0x60, 0x42, 0x04, 0x74, 0x34, 0xBA, 0xBC, 0x67, 
+0xF0, 0x04, 0xA7, 0x54, 0x3C, 0x6C, 0x0B, 0x10, 
+0x2E, 0x02, 0x4D, 0x4F, 0x4A, 0x45, 0x97, 0x8E, 
+0xF6, 0x50, 0x65, 0x44, 0xD0, 0x8B, 0x72, 0x33, 
+0xCE, 0xD9, 0xDE, 0x7A, 0xE9, 0x98, 0x6F, 0x0C, 
+0xE7, 0x79, 0xA8, 0x11, 0x73, 0x83, 0xF2, 0x93, 
+0x5B, 0xE7, 0x3E, 0x4B, 0x81, 0xCE, 0xFB, 0x4E, 
+0x27, 0x73, 0x7E, 0x66, 0xFA, 0x55, 0x1F, 0xCE, 
+0xE8, 0xC3, 0x38, 0x7D, 0x04, 0xC6, 0xC3, 0x30, 
+0x47, 0x8B, 0x02, 0x9D, 0x81, 0x49, 
+
+0x0A, 0x2A, 0x5B, 0x2B, 0x5E, 0xA4, 0x2B, 0x40, 
+0x5E, 0x95, 0x70, 0x40, 0x23, 0x66, 0xD1, 0x1A, 
+0x27, 0x79, 0x3F, 0x3C, 0xA9, 0x86, 0xF2, 0x75, 
+0x2A, 0x52, 0x2E, 0xFE, 0x4E, 0x8E, 0x7E, 0x20, 
+0x79, 0x19, 0x98, 0xDD, 0x01, 0x3F, 0x19, 0x68, 
+0x23, 0x0A, 0xF0, 0x18, 0xA7, 0x13, 0x33, 0xF3, 
+0x9E, 0x04, 0xA7, 0xA9, 0x01, 0xFA, 0xAC, 0xF3, 
+0x81, 0x3D, 0xC6, 0x57, 0x35, 0x8C, 0xFE, 0x5D, 
+0xCB, 0xE6, 0xF3, 0xA6, 0xCF, 0x18, 0x96, 0x70, 
+0xCF, 0xAD, 0x05, 0xC6, 0x75, 0x05, 0x02, 0x50, 
+0xA6, 0xAA, 0x88, 0xBC, 0x57, 0xA9, 0x14, 0x1A, 
+0xF9, 0x1A, 0xD3, 0x40, 0xC7, 0x48, 0xFF, 0xE0, 
+0x2D, 0xC6, 0xA8, 0x94, 0xBA, 0x3F, 0xFA, 0x76, 
+0x44, 0xBA, 0xE8, 0x3E, 0xCC, 0x0D, 0x16, 0x8A, 
+0x72, 0x91, 0xD8, 0xB1, 0x29, 0xC7, 0x3C, 0x67, 
+0x1E, 0xA2, 0x3A, 0x9C, 0x9F, 0x5B, 0xCD, 0x41, 
+0x8C, 0xCB, 0xFF, 0xFE, 0x70, 0x6F, 0xDA, 0xDF, 
+0x10, 0x4B, 0xFF, 0x9A, 0xBF, 0x3E, 0xFF, 0x98, 
+0x4E, 0x62, 0x15, 0x96, 0x97, 0xE1, 0x4E, 
+
+0x18, 0xDD, 0xC6, 0xD7, 0x31, 0x4C, 0x44, 0xF5, 
+0x63, 0x56, 0x9C, 0xDF, 0xDC, 0x2D, 0x25, 0x5A, 
+0xBF, 0x0C, 0x40, 0x69, 0x86, 0xDA, 0xA8, 0x76, 
+0x86, 0x9C, 0x74, 0x9F, 0xDD, 0x04, 0x01, 0x9E, 
+0x0B, 0x70, 0xEF, 0xE1, 0xF6, 0x26, 0x58, 0x5F, 
+0x10, 0x2F, 0x9D, 0xC7, 0xAC, 0x4C, 0x19, 0x85, 
+0xC8, 0x3E, 0xE8, 0xFF, 0xC4, 0x6C, 0x17, 0xB0, 
+0xB9, 0x2E, 0x00, 0xD6, 0xEA, 0xF0, 0xFA, 0x40, 
+0x93, 0x8D, 0x6F, 0xFD, 0x0B, 0xF5, 0x59, 0xEC, 
+0xEA, 0x64, 0x26, 0x9D, 0x5F, 0xB2, 0x26, 0x1E, 
+0x4C, 0xC8, 0x65, 0xAD, 0xD4, 0xC1, 0xAD, 0x63, 
+0x29, 0x6C, 0x11, 0xCB, 0xE6, 0xDC, 0xF8, 0x2E, 
+0x18, 0xA7, 0x50, 0xF0, 0x8F, 0x0E, 0x9B, 0x95, 
+0xCC, 0x74, 0x3D, 0xB1, 0x9C, 0xD3, 0x50, 0x08, 
+0x18, 0x5E, 0x27, 0x15, 0xD3, 0xF3, 0x5A, 0xC0, 
+0x63, 0xEC, 0xB1, 0x4C, 0x77, 0x77, 0x9B, 0xE9, 
+0x7B, 0x57, 0x0C, 0x31, 0xAA, 0xB5, 0xCE, 0x6C, 
+0x4F, 0x46, 0x16, 0x0D, 0x55, 0xB9, 0x36, 0xF1, 
+0xFA, 0x36, 0xE8, 0x89, 0xB0, 0x7B, 0xBB, 0x84, 
+
+0xF3, 0x24, 0x87, 0xDA, 0x52, 0xDC, 0x22, 0x0A, 
+0xE1, 0x8E, 0x6A, 0x1A, 0xA6, 0x6C, 0x16, 0x48, 
+0xFD, 0x70, 0xF9, 0x3E, 0x45, 0xF7, 0xE3, 0x6A, 
+0xAC, 0x92, 0x5A, 0x82, 0xD1, 0xC3, 0x8C, 0x7E, 
+0x76, 0x69, 0x3E, 0x34, 0x66, 0x25, 0x46, 0xD0, 
+0x17, 0xEE, 0x25, 0xD2, 0xB2, 0x2A, 0xCE, 0xB8, 
+0x6A, 0x56, 0x2C, 0x37, 0xB0, 0x51, 0x62, 0x1A, 
+0x04, 0xB9, 0x68, 0xAA, 0x9B, 0x30, 0xEE, 0x17, 
+0x57, 0xFC, 0x98, 0xCF, 0x7E, 0x4B, 0xAB, 0x25, 
+0xA0, 0x91, 0x6E, 0x68, 0x23, 0x55, 0xF0, 0xC7, 
+0x20, 0xA7, 0x3B, 0xE1, 0x03, 0xB3, 0x94, 0x2C, 
+0x5D, 0xC1, 0x50, 0x32, 0x61, 0xB8, 0x63, 0x69, 
+0x61, 0x74, 0x61, 0xCA, 0xC1, 0x49, 0x25, 0x63, 
+0x9B, 0xA1, 0x5A, 0x09, 0x23, 0x44, 0x05, 0x97, 
+0xCA, 0x73, 0xA6, 0x57, 0x92, 0xA5, 0x22, 0x18, 
+0x82, 0x55, 0x0E, 0xFF, 0xE7, 0x53, 0xA9, 0x71, 
+0x25, 0xC6, 0x2D, 0x02, 0x07, 0xD4, 0x51, 0x12, 
+0x18, 0x03, 0x71, 0x4F, 0x10, 0x23, 0x17, 0xF0, 
+0x76, 0xB3, 0x3B, 0x57, 0x8D, 0x88, 0xDA, 0x6F, 
+0x5F, 
+
+0xCB, 0xF5, 0x44, 0xA5, 0x45, 0x49, 0x7D, 0x58, 
+0xA6, 0x10, 0x4B, 0x4E, 0x81, 0xF2, 0x15, 0x21, 
+0x03, 0xFB, 0xD2, 0x34, 0xE2, 0xF8, 0x99, 0x09, 
+0x5D, 0x59, 0x24, 0xFF, 0xB9, 0x0D, 0xBC, 0xF6, 
+0x2B, 0x11, 0x55, 0x4D, 0x50, 0x7C, 0xCD, 0x18, 
+0x94, 0x92, 0xF3, 0x5C, 0x95, 0x7A, 0x64, 0xF7, 
+0x35, 0x27, 0x56, 0x79, 0xD6, 0xD8, 0x81, 0xE0, 
+0xF3, 0x3F, 0x80, 0x8F, 0x97, 0x1B, 0xD8, 0x15, 
+0x70, 0x15, 0x2D, 0xC0, 0x2E, 0x7E, 0x79, 0xF8, 
+0x1F, 0x88, 0xB7, 0xB7, 0xFF, 0x53, 0x67, 0xE8, 
+0xC1, 0x59, 0x89, 0x66, 0xFD, 0x66, 0x4E, 0x88, 
+0xA0, 0xCD, 0xC6, 0xD0, 0x34, 0x0C, 0x31, 0xE3, 
+0xD4, 0x5F, 0x32, 0x32, 0xAC, 0xCC, 0xE1, 0x24, 
+0x8C, 0x4E, 0xC9, 0x39, 0xC3, 0x7A, 0x6B, 0xAB, 
+0xAE, 0x6C, 0x01, 0x79, 0x6D, 0xB4, 0xAF, 0x49, 
+0x12, 0x10, 0xB3, 0xC5, 0x20, 0xB9, 0x63, 0x99, 
+0x79, 0xC1, 0x22, 0xE4, 0x74, 0x15, 0x42, 0x61, 
+0x98, 0x4E, 0xDC, 0x13, 0xD0, 0xE1, 0xD8, 0x24, 
+0x0A, 0xC5, 0xB4, 0x54, 0xDC, 0x3C, 0x00, 0xDE, 
+0xFB, 0xEB, 
+
+0x01, 0x0B, 0xD7, 0x6A, 0x19, 0xF8, 0xD2, 0x4A, 
+0xD6, 0xC8, 0xFA, 0x05, 0x69, 0x4A, 0x18, 0x97, 
+0x35, 0x61, 0x8B, 0xB9, 0x8B, 0x7B, 0x0C, 0xD9, 
+0xF4, 0xF4, 0xC4, 0x2F, 0x1F, 0xA9, 0x98, 0x16, 
+0xB7, 0xD6, 0x1F, 0x4A, 0x59, 0x42, 0x37, 0x60, 
+0x37, 0x20, 0x37, 0x5C, 0x16, 0x6E, 0x63, 0x49, 
+0x25, 0x1A, 0x86, 0x42, 0x00, 0xC3, 0x4A, 0x33, 
+0xC7, 0x7D, 0x06, 0x14, 0xC9, 0x98, 0x19, 0x9A, 
+0xD3, 0x17, 0xB4, 0x61, 0x4F, 0x52, 0x09, 0x13, 
+0x54, 0xFE, 0xE4, 0x47, 0xA4, 0xFF, 0xA5, 0x20, 
+0x62, 0x52, 0xB1, 0xDD, 0x42, 0xF2, 0x1C, 0xE9, 
+0x0E, 0x2D, 0xEA, 0x19, 0x51, 0xF5, 0x3E, 0x7D, 
+0x27, 0xFF, 0x1D, 0x43, 0x96, 0x16, 0x9B, 0xBD, 
+0x9D, 0x29, 0x0E, 0x19, 0x4B, 0x6E, 0x98, 0xF4, 
+0xA3, 0x51, 0x3C, 0x14, 0x64, 0xBF, 0x93, 0xAA, 
+0x3C, 0x86, 0xF8, 0xB5, 0x3A, 0x2C, 0x12, 0x10, 
+0x52, 0xD6, 0xE9, 0xB6, 0x36, 0x91, 0x5B, 0x36, 
+0x22, 0x9B, 0xD1, 0x00, 0xEC, 0xC6, 0x13, 0x13, 
+0x1D, 0x83, 0xF4, 0xF9, 0xBE, 0xC0, 0x3E, 0x71, 
+0x0B, 0x64, 0xDF, 
+
+0xF2, 0xB0, 0xFC, 0xD2, 0x54, 0x85, 0x18, 0xD4, 
+0x31, 0x7A, 0x3E, 0xD5, 0xDD, 0x8D, 0x3C, 0xCC, 
+0x4E, 0x92, 0x14, 0xE4, 0x21, 0x39, 0xF3, 0x73, 
+0x66, 0x71, 0x77, 0x29, 0x37, 0x49, 0x6C, 0x72, 
+0xFB, 0x51, 0x17, 0x6C, 0x9E, 0x4A, 0x7C, 0x8C, 
+0xBF, 0x96, 0x13, 0x8F, 0xAE, 0x7E, 0x88, 0x15, 
+0xE9, 0x5E, 0x1A, 0xC4, 0xA7, 0x42, 0xFA, 0x60, 
+0xD5, 0xFB, 0xCF, 0x90, 0x56, 0x97, 0xBB, 0xAD, 
+0xF2, 0x2C, 0x6B, 0x07, 0xA5, 0xE6, 0x99, 0x92, 
+0x75, 0x55, 0x69, 0xE8, 0xA3, 0xD9, 0x05, 0xC4, 
+0x95, 0xED, 0xD0, 0x8E, 0x18, 0x60, 0x86, 0x86, 
+0xEB, 0xC3, 0x20, 0xA8, 0xB9, 0x2E, 0xB8, 0x38, 
+0xEB, 0xCE, 0x48, 0x2E, 0x4B, 0x42, 0xD6, 0xFB, 
+0xDF, 0x97, 0xC4, 0xCD, 0x8C, 0xFD, 0x98, 0x0E, 
+0xAC, 0xC2, 0xC4, 0xDA, 0x07, 0xFF, 0x8D, 0x72, 
+0xF3, 0x15, 0x6F, 0x69, 0x3A, 0x61, 0x5F, 0xE0, 
+0xAE, 0x1B, 0xCB, 0x16, 0x91, 0xEF, 0x54, 0x92, 
+0xFC, 0xCD, 0xAF, 0xF4, 0x45, 0x4F, 0x2A, 0xCD, 
+0xF8, 0xE4, 0x3F, 0x99, 0x2C, 0x61, 0xEC, 0x23, 
+0xE3, 0x8B, 0xAB, 0x8F, 
+
+0x7C, 0x3F, 0x27, 0xDE, 0xAF, 0xE9, 0x72, 0x9C, 
+0x04, 0xDA, 0xB4, 0xBB, 0xE6, 0x6F, 0x56, 0xF1, 
+0xE8, 0x7E, 0xB5, 0xDA, 0x14, 0x2D, 0xFA, 0x29, 
+0x80, 0x87, 0x53, 0x78, 0x65, 0xF7, 0x98, 0x64, 
+0xB7, 0x11, 0x1A, 0x08, 0x4F, 0x36, 0x7E, 0xF8, 
+0x4F, 0x0C, 0x70, 0xFE, 0xAF, 0xF1, 0x72, 0xC9, 
+0xB8, 0x39, 0x57, 0xCB, 0x10, 0x61, 0x95, 0x49, 
+0xEA, 0xB4, 0x2B, 0xA0, 0x77, 0x76, 0x30, 0x5D, 
+0x9A, 0xB5, 0xCB, 0xF4, 0x5F, 0xC7, 0xA6, 0xD8, 
+0x3A, 0x9E, 0xA8, 0xA1, 0x9F, 0xAB, 0xCC, 0x2C, 
+0x2D, 0x8C, 0xCF, 0x3D, 0x7C, 0x11, 0xC6, 0xE9, 
+0xD5, 0x15, 0xA2, 0xB8, 0xCE, 0x11, 0x45, 0x52, 
+0x94, 0x0D, 0xE3, 0xF4, 0x47, 0x4A, 0xC6, 0x2E, 
+0x7A, 0xBF, 0xBB, 0x4D, 0x2A, 0x89, 0xE5, 0x49, 
+0x51, 0xDE, 0x25, 0x36, 0x58, 0x52, 0x3F, 0xA3, 
+0xAD, 0xFE, 0xE3, 0xA3, 0x31, 0x90, 0xDA, 0xA3, 
+0x1D, 0x48, 0xD6, 0xE8, 0xF2, 0x9E, 0x4C, 0xEC, 
+0xCA, 0xC7, 0x46, 0x3C, 0xD5, 0x89, 0x01, 0xA7, 
+0x33, 0xD3, 0x50, 0x7E, 0x31, 0x1C, 0x8E, 0xFF, 
+0xF0, 0xB4, 0x42, 0x70, 0xA5, 
+
+0xDB, 0xFD, 0x65, 0xEB, 0x8C, 0xA7, 0x97, 0xE5, 
+0xC7, 0xF4, 0x2E, 0xD4, 0x19, 0xCD, 0xA6, 0x0F, 
+0x56, 0x39, 0xE3, 0x55, 0xF6, 0xFA, 0x43, 0xAC, 
+0x3C, 0x0A, 0x1E, 0x00, 0xBF, 0x36, 0x1B, 0x95, 
+0x71, 0x7E, 0x73, 0xBF, 0x93, 0x76, 0xF6, 0x2D, 
+0xCB, 0x35, 0xBE, 0x43, 0x33, 0x2B, 0x89, 0x2D, 
+0x85, 0x8A, 0xCE, 0x2F, 0xE7, 0x9C, 0x39, 0xD7, 
+0x01, 0x69, 0x2E, 0xB3, 0xE7, 0xED, 0xEF, 0x41, 
+0x06, 0xE7, 0xFC, 0x1F, 0x4B, 0x42, 0x71, 0xB2, 
+0x59, 0x55, 0xEB, 0xBD, 0x18, 0x12, 0x63, 0x7F, 
+0xEE, 0x10, 0x35, 0x84, 0x4E, 0x58, 0x9C, 0x75, 
+0x86, 0xAB, 0x7F, 0x54, 0x8C, 0x90, 0x79, 0xAD, 
+0xE7, 0xA6, 0x40, 0xCA, 0xB3, 0x6D, 0x8A, 0x14, 
+0x8A, 0xE5, 0x13, 0x8C, 0x6E, 0xE9, 0x99, 0x56, 
+0x11, 0xFC, 0xD4, 0x3B, 0xF5, 0xCB, 0x65, 0x33, 
+0xEF, 0x98, 0x63, 0x7F, 0x91, 0x22, 0xD1, 0x2E, 
+0xF4, 0xE0, 0xB3, 0xE8, 0xA6, 0x9E, 0xF9, 0x10, 
+0xC6, 0xF1, 0x21, 0x95, 0x8C, 0x34, 0x2D, 0x7C, 
+0x54, 0x92, 0x8D, 0x8F, 0xB6, 0x03, 0x34, 0xF7, 
+0xF7, 0xD8, 0x5A, 0xBA, 0x57, 0x50, 
+
+0x41, 0x62, 0x0B, 0xC6, 0xC6, 0x43, 0xE4, 0x2D, 
+0x36, 0x29, 0x87, 0x1D, 0x0D, 0xAC, 0xDB, 0xF0, 
+0x00, 0x89, 0xBD, 0xE6, 0x48, 0x04, 0x24, 0x0C, 
+0x28, 0x54, 0x88, 0x94, 0x3E, 0x1F, 0x2F, 0x5D, 
+0x1C, 0xC1, 0x18, 0x38, 0xA6, 0xA5, 0xCA, 0xC3, 
+0xAF, 0x5C, 0xDF, 0xAD, 0x8B, 0x4F, 0x53, 0x9E, 
+0x6B, 0x99, 0xAB, 0x09, 0x2F, 0xD8, 0x11, 0xF9, 
+0xC9, 0xAB, 0x73, 0x0A, 0xE6, 0x50, 0x6F, 0xBD, 
+0xA5, 0xD1, 0x16, 0xFE, 0xC3, 0x11, 0xDE, 0x11, 
+0x72, 0xC4, 0x71, 0x80, 0x40, 0x02, 0x14, 0x1F, 
+0xC1, 0x6C, 0xE3, 0x28, 0xF8, 0xD8, 0x99, 0x06, 
+0x1B, 0x90, 0x55, 0xFB, 0x67, 0x1D, 0x37, 0xA8, 
+0xF9, 0xC7, 0xB2, 0xAD, 0x0F, 0x6C, 0xF4, 0x0F, 
+0x1B, 0x42, 0xBD, 0x57, 0x51, 0x56, 0xA5, 0x83, 
+0x7A, 0x15, 0x1C, 0x1A, 0xCF, 0x8C, 0xA4, 0x1B, 
+0xF2, 0xFB, 0xDF, 0xC1, 0xCE, 0xD1, 0xF3, 0x29, 
+0x7B, 0xA6, 0xA7, 0xE8, 0xA8, 0xB4, 0xCF, 0xEC, 
+0x4E, 0xD7, 0x92, 0x82, 0x3A, 0x27, 0x05, 0xEF, 
+0x88, 0x1B, 0x9C, 0x04, 0xAD, 0xF9, 0x51, 0x94, 
+0xA6, 0xC1, 0xF8, 0x16, 0x3F, 0x5F, 0xF2, 
+
+0xFC, 0xF4, 0xBD, 0x0F, 0xE9, 0x09, 0xA4, 0xCE, 
+0x31, 0x2D, 0x53, 0xD4, 0x15, 0xE5, 0x62, 0xF6, 
+0x72, 0x63, 0x22, 0x53, 0x93, 0x2E, 0xCB, 0x30, 
+0x01, 0x5C, 0x1C, 0xBA, 0x04, 0xA6, 0x95, 0x46, 
+0x4E, 0x03, 0xEC, 0x36, 0xA2, 0xBE, 0x86, 0xD7, 
+0x6E, 0x92, 0x2C, 0xEF, 0x64, 0x45, 0x94, 0x22, 
+0xF8, 0x11, 0x43, 0x2D, 0x81, 0x04, 0xE6, 0x7B, 
+0xEC, 0xE3, 0xF9, 0x9A, 0x43, 0xEF, 0xA7, 0xC9, 
+0x5F, 0x39, 0x56, 0x6D, 0x3A, 0xBC, 0x26, 0xCD, 
+0x77, 0x6F, 0x38, 0x30, 0x0A, 0x78, 0xD3, 0x98, 
+0x71, 0xE1, 0x2C, 0xEA, 0xC2, 0xD8, 0x69, 0x18, 
+0x74, 0xFE, 0x91, 0x1C, 0xAF, 0x5D, 0x8D, 0xDB, 
+0x59, 0xDA, 0x52, 0xCD, 0xE4, 0x0D, 0x76, 0x41, 
+0x9E, 0x6C, 0xD3, 0x85, 0x96, 0x3C, 0x8E, 0x66, 
+0xDF, 0xED, 0x26, 0x03, 0x84, 0x88, 0x9D, 0x25, 
+0x39, 0x35, 0xAD, 0xB5, 0x8B, 0xBB, 0xC5, 0x64, 
+0xCE, 0xC5, 0xD7, 0x88, 0x5C, 0x2D, 0x46, 0x47, 
+0x80, 0x11, 0x43, 0x85, 0xF1, 0xEB, 0x90, 0x71, 
+0xA4, 0xB7, 0x5A, 0x3B, 0xB4, 0x51, 0x68, 0x76, 
+0x63, 0x4D, 0x8F, 0x9F, 0x65, 0x6F, 0x9D, 0x03, 
+
+0x80, 0x5F, 0x0F, 0xB1, 0x39, 0x5B, 0xF6, 0x33, 
+0xF7, 0x1C, 0xE6, 0x3C, 0x31, 0xC4, 0x31, 0x1A, 
+0x14, 0x67, 0x38, 0x6C, 0x79, 0x59, 0x05, 0xF2, 
+0x1F, 0xBA, 0xF2, 0xAE, 0x0C, 0xFF, 0xC5, 0x22, 
+0x89, 0xB9, 0x2C, 0x88, 0xD1, 0x10, 0x24, 0xD1, 
+0xBA, 0xD4, 0xD7, 0x41, 0x45, 0xAF, 0x57, 0xFC, 
+0xF6, 0x97, 0x81, 0x4D, 0xA5, 0xF9, 0xC2, 0x25, 
+0xFC, 0x3B, 0xBD, 0x0E, 0x96, 0xC2, 0x8B, 0xE3, 
+0x93, 0x67, 0x65, 0xC4, 0x1D, 0x2A, 0x86, 0xCC, 
+0x8A, 0x87, 0x4C, 0x35, 0xF7, 0x55, 0x51, 0xD6, 
+0x8A, 0x8A, 0xE8, 0xE8, 0x69, 0xEF, 0x1A, 0xED, 
+0xCB, 0x09, 0x4C, 0xE1, 0x4F, 0x36, 0xA6, 0xBD, 
+0x68, 0x6B, 0x87, 0xC1, 0x39, 0x5E, 0x63, 0x61, 
+0xA7, 0x19, 0x60, 0x3E, 0x1A, 0x2A, 0xF0, 0x43, 
+0xA4, 0xE5, 0xE3, 0xEF, 0x76, 0x20, 0xA1, 0x7D, 
+0x61, 0xFB, 0xC0, 0x8D, 0xED, 0xB0, 0x78, 0xDB, 
+0xEC, 0x53, 0x44, 0x4A, 0x94, 0x42, 0x39, 0x83, 
+0x41, 0x49, 0xBC, 0xA5, 0x17, 0x6C, 0xB4, 0x89, 
+0x8D, 0xAB, 0x32, 0x40, 0x05, 0x69, 0x44, 0xF8, 
+0x9A, 0x8B, 0x60, 0xD5, 0x47, 0x31, 0xD5, 0x17, 
+0x1A, 
+
+0x99, 0xC8, 0x1F, 0x50, 0x51, 0xE0, 0xCA, 0x64, 
+0x60, 0x38, 0x24, 0x34, 0x8B, 0x37, 0x13, 0x87, 
+0x05, 0xE5, 0x3D, 0x7E, 0x8F, 0x8D, 0xF7, 0xD5, 
+0x92, 0x06, 0xA4, 0x32, 0xD9, 0x64, 0xD8, 0xFC, 
+0x1B, 0x5E, 0x46, 0xE4, 0x97, 0x14, 0x80, 0x2D, 
+0xE2, 0x7B, 0x2E, 0xC4, 0xC2, 0x50, 0x0C, 0xDC, 
+0xC1, 0xE6, 0x6A, 0x5F, 0x37, 0x02, 0x36, 0x24, 
+0x98, 0x51, 0xB5, 0x92, 0x4E, 0x95, 0x80, 0x3E, 
+0x93, 0x21, 0x03, 0xFB, 0x41, 0x20, 0x85, 0x55, 
+0x39, 0xFC, 0xFC, 0x9E, 0xE6, 0x5B, 0x21, 0x29, 
+0xC6, 0xCD, 0x63, 0x6B, 0xB8, 0x66, 0xB3, 0xB2, 
+0x77, 0xE6, 0xA3, 0x3A, 0x40, 0x6C, 0xD0, 0x7C, 
+0xEA, 0x36, 0x5F, 0x3E, 0xA2, 0x47, 0x64, 0xD9, 
+0xF5, 0x94, 0x09, 0xE6, 0xC4, 0x90, 0x0E, 0xF6, 
+0x5E, 0xE5, 0x9E, 0x54, 0xFC, 0x32, 0x51, 0x0F, 
+0xED, 0x6A, 0xEE, 0x50, 0x29, 0x47, 0x0C, 0xEF, 
+0xCD, 0x3D, 0x83, 0x2F, 0xA4, 0x62, 0x93, 0x0A, 
+0x28, 0xEF, 0xD9, 0xAD, 0x6F, 0xAE, 0x79, 0x1B, 
+0x7E, 0xB8, 0xEC, 0x21, 0x53, 0x13, 0x21, 0xDF, 
+0x01, 0x81, 0x4C, 0x30, 0x07, 0x58, 0x6E, 0x80, 
+0x1A, 0xED, 
+
+0x78, 0x66, 0x40, 0x07, 0x32, 0x18, 0x83, 0x4D, 
+0x35, 0x65, 0x2D, 0xD2, 0x93, 0x09, 0xEF, 0xF7, 
+0x5F, 0xD6, 0xB0, 0x99, 0xD9, 0xD0, 0xC5, 0xD7, 
+0xF7, 0xC3, 0xDF, 0xD2, 0x01, 0xB2, 0x80, 0x7F, 
+0xC5, 0xC8, 0x9A, 0xB3, 0x1B, 0x1D, 0x4A, 0x3E, 
+0x05, 0xD4, 0x5B, 0xB5, 0xC5, 0x61, 0x7A, 0x16, 
+0x49, 0x0E, 0xB3, 0x17, 0x30, 0x81, 0x10, 0xA0, 
+0xC7, 0xE7, 0x3E, 0x1D, 0xAC, 0x2B, 0x36, 0x02, 
+0xB2, 0x82, 0x7D, 0xAD, 0xD4, 0x24, 0x34, 0xC6, 
+0x90, 0x19, 0x7E, 0xE0, 0xAD, 0xE2, 0xB2, 0xDE, 
+0x59, 0xB8, 0xB5, 0x98, 0xC5, 0xEE, 0xA4, 0x14, 
+0x8E, 0x4E, 0xEB, 0x9C, 0xA3, 0x27, 0x35, 0xF5, 
+0x03, 0x8F, 0x3F, 0x24, 0xED, 0x7D, 0xA1, 0x86, 
+0xFE, 0xD3, 0xD7, 0x45, 0xC8, 0x48, 0xDC, 0xA1, 
+0x2E, 0xF9, 0xDF, 0xB3, 0x15, 0xD9, 0x16, 0x8E, 
+0xEA, 0x52, 0xDE, 0x70, 0x71, 0xA9, 0x6A, 0x48, 
+0x75, 0xBD, 0x6C, 0x8C, 0xF4, 0x51, 0x93, 0x35, 
+0x7F, 0xD8, 0x59, 0xDB, 0xEE, 0x97, 0xB3, 0x15, 
+0xC2, 0x66, 0x95, 0xB9, 0x09, 0x7F, 0x22, 0x4A, 
+0x0D, 0xBF, 0xCE, 0x05, 0x11, 0x14, 0x72, 0x83, 
+0x89, 0x77, 0x48, 
+
+0x36, 0x9A, 0x46, 0xA7, 0xD8, 0x92, 0xA3, 0x4A, 
+0xBE, 0x55, 0xDA, 0xBE, 0xB4, 0x8D, 0x58, 0x09, 
+0x0F, 0xEB, 0x49, 0x0C, 0xFE, 0xDA, 0x5E, 0xB3, 
+0xCF, 0xB5, 0xDF, 0x65, 0x4B, 0x37, 0xF4, 0xFF, 
+0x0F, 0x68, 0x8C, 0xBE, 0x3C, 0x36, 0x62, 0x54, 
+0xF1, 0xC5, 0x76, 0x64, 0x6E, 0x7F, 0xCC, 0x91, 
+0x61, 0x77, 0xB5, 0xD1, 0x5F, 0x56, 0x35, 0xAA, 
+0xFB, 0x72, 0x8E, 0x21, 0xFF, 0x33, 0xD5, 0x36, 
+0xFE, 0xF6, 0x85, 0x63, 0x58, 0x02, 0x6A, 0x35, 
+0x0C, 0x3F, 0x42, 0xE2, 0xF7, 0xE7, 0x36, 0xC1, 
+0x11, 0x49, 0x75, 0x99, 0x6D, 0x39, 0x68, 0x7F, 
+0x17, 0x54, 0x29, 0x5A, 0x88, 0xAF, 0x48, 0xFC, 
+0xF7, 0xC9, 0xD1, 0x39, 0xA2, 0xBD, 0x1D, 0xBF, 
+0xAA, 0xA2, 0x37, 0x9D, 0x71, 0x7D, 0xEC, 0xF5, 
+0x7C, 0xEC, 0x1C, 0x21, 0xBC, 0x73, 0x4D, 0x69, 
+0xA5, 0x0C, 0xA1, 0x6E, 0xDA, 0x84, 0x52, 0xB9, 
+0xED, 0xE6, 0x3C, 0xAC, 0x0D, 0xEA, 0x78, 0x40, 
+0x4A, 0x24, 0x6C, 0xC5, 0xA9, 0xB9, 0x6C, 0x9A, 
+0x57, 0xC4, 0xA3, 0xC2, 0x66, 0x8F, 0x4D, 0x9E, 
+0x85, 0x14, 0x26, 0xB2, 0x9B, 0xB5, 0xEF, 0x22, 
+0xE1, 0xA1, 0x50, 0x41, 
+
+0x96, 0x16, 0x23, 0x5B, 0x30, 0x81, 0xD1, 0x9A, 
+0x66, 0x84, 0x92, 0xD1, 0xC4, 0x59, 0xE1, 0x24, 
+0xFF, 0x2D, 0xA8, 0x6B, 0x83, 0x72, 0x38, 0xCD, 
+0x7D, 0x4F, 0x5A, 0x85, 0x8A, 0x2E, 0xE9, 0x6B, 
+0x48, 0x02, 0x54, 0xE4, 0x3B, 0x9F, 0x76, 0x13, 
+0x9F, 0x9F, 0xF3, 0xE0, 0xF0, 0x48, 0x67, 0x9D, 
+0x9F, 0x86, 0xCF, 0x7E, 0x7C, 0x1C, 0x0F, 0x61, 
+0x64, 0xDA, 0x67, 0xBE, 0x68, 0x02, 0x08, 0x43, 
+0x02, 0xF8, 0x68, 0x4C, 0x73, 0x4B, 0xCF, 0xFB, 
+0xE8, 0xC7, 0x61, 0xCC, 0x62, 0x18, 0x39, 0xE4, 
+0x04, 0x44, 0x36, 0x1E, 0xB7, 0xFB, 0xCC, 0x0A, 
+0x05, 0xE7, 0x50, 0x28, 0x7F, 0xD0, 0x4F, 0xCF, 
+0x81, 0xC2, 0x61, 0x72, 0x1C, 0x21, 0x76, 0x46, 
+0x13, 0x05, 0x38, 0x5D, 0xEA, 0x2B, 0xF3, 0x5D, 
+0xD9, 0x02, 0x3D, 0xBA, 0x4D, 0x79, 0x19, 0xB5, 
+0xAC, 0xE3, 0xC7, 0x87, 0x0E, 0xA5, 0x40, 0xE5, 
+0x68, 0x7A, 0x4A, 0xA1, 0x54, 0x1B, 0x10, 0xA0, 
+0x9D, 0x00, 0x74, 0xB6, 0x86, 0x6D, 0x45, 0x77, 
+0xBB, 0x7B, 0x4C, 0x8C, 0xD1, 0xCA, 0xC0, 0xA8, 
+0xC1, 0x17, 0x17, 0x39, 0xD6, 0xC8, 0x8D, 0x75, 
+0x63, 0x03, 0xB7, 0xCC, 0xA8, 
+
+0x18, 0xB2, 0xD0, 0xF4, 0xB4, 0xCD, 0x4D, 0xB5, 
+0x3F, 0x45, 0x8E, 0x05, 0x57, 0x1A, 0x9E, 0xFB, 
+0xA7, 0x46, 0xB9, 0x97, 0xC6, 0x72, 0x46, 0x48, 
+0x84, 0xB2, 0xB3, 0x28, 0xBC, 0xF8, 0x3A, 0x70, 
+0x4A, 0x1A, 0x26, 0x9D, 0x71, 0x31, 0xEC, 0xB2, 
+0xA2, 0x26, 0x8A, 0x74, 0x03, 0x7D, 0x12, 0x07, 
+0x09, 0x7B, 0xD9, 0x89, 0x3B, 0x76, 0xD6, 0xC5, 
+0xB9, 0x25, 0x93, 0xFD, 0x15, 0xDA, 0x26, 0x9E, 
+0x0D, 0x74, 0xAE, 0xCE, 0x81, 0xC1, 0x3E, 0x1F, 
+0x4C, 0x86, 0x3C, 0x99, 0x0A, 0xC5, 0x28, 0x90, 
+0x74, 0x39, 0x03, 0x07, 0x1F, 0x6A, 0xB5, 0xF1, 
+0x64, 0xB4, 0xEC, 0xEE, 0x04, 0xEB, 0x46, 0xCA, 
+0xCC, 0x2B, 0x3C, 0x6A, 0x9B, 0x06, 0x0A, 0xD9, 
+0x76, 0xB6, 0xBB, 0x8B, 0xBD, 0x57, 0x7A, 0x31, 
+0x87, 0x1D, 0x37, 0x2B, 0xAC, 0x43, 0xA5, 0x0F, 
+0x0F, 0xC4, 0x25, 0x17, 0x65, 0xDF, 0xD3, 0x98, 
+0x7F, 0x31, 0x89, 0xAF, 0xE2, 0x1D, 0xED, 0x3B, 
+0x29, 0x5F, 0x35, 0x68, 0xEC, 0xC2, 0x77, 0x4A, 
+0x9C, 0x5A, 0xAE, 0x52, 0x13, 0x8C, 0x98, 0x47, 
+0x03, 0x1B, 0x20, 0x1A, 0x22, 0x4D, 0x87, 0x9D, 
+0x40, 0x86, 0x48, 0xF8, 0x60, 0xFB, 
+
+0x63, 0x82, 0x97, 0xF6, 0x37, 0x44, 0x0E, 0x4B, 
+0x54, 0x92, 0xA1, 0xFB, 0x06, 0x95, 0x89, 0x79, 
+0x8F, 0x50, 0x5F, 0xD3, 0x46, 0x7A, 0xEB, 0xEC, 
+0x98, 0x38, 0x40, 0x0E, 0xCC, 0x6C, 0x35, 0xBF, 
+0x50, 0xD1, 0x6A, 0x3E, 0x08, 0x2E, 0x50, 0x8E, 
+0xC2, 0xEB, 0xF3, 0xD8, 0x5A, 0xE8, 0xE3, 0xF4, 
+0xC0, 0x37, 0x88, 0x04, 0x32, 0xAF, 0x17, 0xFC, 
+0xEE, 0x42, 0x88, 0x84, 0xE7, 0xAD, 0x0F, 0xA7, 
+0x51, 0xAB, 0x6A, 0xD5, 0x4B, 0xCF, 0xDB, 0xC2, 
+0x09, 0xEF, 0x5D, 0x6B, 0x7F, 0x12, 0x79, 0x13, 
+0xB0, 0xE8, 0xDE, 0x80, 0x72, 0x71, 0xD8, 0xDB, 
+0x4E, 0x08, 0xF0, 0xA8, 0x7A, 0x68, 0xF8, 0x82, 
+0xD5, 0xFD, 0xD3, 0x4A, 0x58, 0x2A, 0x99, 0x65, 
+0xC2, 0x2C, 0x97, 0x59, 0x13, 0xA8, 0x96, 0x52, 
+0xC3, 0xA9, 0x17, 0x47, 0xBA, 0x9C, 0xD9, 0xBB, 
+0xBE, 0xAF, 0x91, 0x6F, 0xE0, 0xE3, 0x52, 0x9B, 
+0xA5, 0x49, 0x7B, 0xEF, 0x5F, 0x2B, 0x03, 0xD7, 
+0x4C, 0x46, 0xC0, 0xE8, 0x3F, 0xE5, 0x68, 0x7D, 
+0xC9, 0xCC, 0x63, 0xA8, 0x85, 0x78, 0xFD, 0x0F, 
+0x6A, 0x09, 0xBE, 0x1B, 0x1B, 0x62, 0x7A, 0x43, 
+0x57, 0xF3, 0x7B, 0xC7, 0xD4, 0x9F, 0xF0, 
+
+0x48, 0xA5, 0xCC, 0x95, 0x4E, 0xDD, 0xE7, 0xF9, 
+0x53, 0x3C, 0x37, 0xAC, 0xAA, 0x4D, 0xD7, 0x46, 
+0x53, 0xD8, 0xC5, 0x51, 0x27, 0xFE, 0x09, 0x24, 
+0x56, 0x0D, 0x9D, 0x57, 0x3B, 0x07, 0xB6, 0xA1, 
+0x0E, 0x75, 0xF3, 0xC4, 0x80, 0x06, 0x49, 0x0B, 
+0xFB, 0x36, 0x3A, 0x98, 0xB0, 0x98, 0xB8, 0xD1, 
+0x4A, 0x9F, 0x7A, 0x3D, 0x06, 0x32, 0xDE, 0x00, 
+0x8C, 0xF5, 0x5C, 0x65, 0xE8, 0xCB, 0x15, 0xF7, 
+0x77, 0xE6, 0x93, 0x1F, 0x56, 0x21, 0x48, 0x7B, 
+0xFF, 0xD1, 0x7E, 0xA2, 0xB1, 0xDB, 0x36, 0xC1, 
+0x3D, 0x23, 0x9F, 0x8B, 0x2B, 0x23, 0x90, 0x58, 
+0x9A, 0x60, 0xF2, 0x8D, 0x61, 0xAD, 0x69, 0x5C, 
+0xC4, 0xA5, 0xB3, 0x68, 0xF0, 0x98, 0xD1, 0xB2, 
+0xEA, 0x03, 0xE7, 0x02, 0x19, 0x81, 0x26, 0x5D, 
+0x20, 0x4E, 0x61, 0xF1, 0xEC, 0xE6, 0x6C, 0xEF, 
+0x0E, 0x55, 0x6F, 0x6D, 0x11, 0x84, 0x1A, 0x5B, 
+0x7E, 0x82, 0x66, 0x88, 0x44, 0x63, 0x99, 0xB1, 
+0x5B, 0xC5, 0xFB, 0x47, 0xFF, 0x9C, 0x39, 0xD6, 
+0xC2, 0x96, 0xEC, 0xE3, 0xA7, 0xAA, 0xF7, 0xC2, 
+0x93, 0xB2, 0x0D, 0x7B, 0x2A, 0xF8, 0xBA, 0x22, 
+0x2E, 0xA5, 0x7C, 0x88, 0x41, 0x7E, 0x8A, 0xFA, 
+
+0x0F, 0x63, 0x92, 0x0D, 0x42, 0x3E, 0x56, 0x78, 
+0x82, 0x58, 0xB7, 0x5A, 0xB4, 0xFF, 0x25, 0x5E, 
+0xCD, 0x05, 0x8A, 0x3B, 0x00, 0xE7, 0xF0, 0x70, 
+0xFE, 0x38, 0xC4, 0x17, 0x4D, 0x0A, 0x26, 0x71, 
+0x3F, 0x64, 0x1F, 0x85, 0xE2, 0xF6, 0xED, 0x07, 
+0x79, 0xDF, 0x7D, 0x03, 0x45, 0x0E, 0x26, 0xB1, 
+0xC8, 0xD7, 0x7C, 0xE0, 0xF6, 0x3E, 0x6C, 0xDC, 
+0xB2, 0x58, 0xFD, 0x56, 0x47, 0x69, 0xE1, 0x41, 
+0xEB, 0x88, 0xEB, 0xE9, 0x8E, 0x73, 0x68, 0x4A, 
+0xB4, 0xC3, 0x35, 0xA0, 0x2F, 0x8D, 0x07, 0x1E, 
+0xE9, 0x7E, 0x63, 0x4D, 0x73, 0xEE, 0x79, 0x6A, 
+0xA6, 0x84, 0x4A, 0x22, 0xE7, 0x88, 0xBC, 0x59, 
+0x83, 0x41, 0xCD, 0x16, 0x73, 0x7C, 0xE3, 0xC0, 
+0xEF, 0x23, 0x8C, 0x7A, 0x24, 0x16, 0x3F, 0x77, 
+0x72, 0x10, 0xCF, 0x32, 0x1F, 0x2E, 0x37, 0xED, 
+0x1B, 0x90, 0xD4, 0x4C, 0x71, 0x4D, 0x81, 0xBD, 
+0x36, 0x6B, 0x6A, 0x2E, 0x07, 0xAD, 0x32, 0x35, 
+0x76, 0x67, 0x66, 0xE1, 0x76, 0x95, 0x2F, 0x0A, 
+0xF3, 0xF7, 0xC3, 0x25, 0x3D, 0xDF, 0xA1, 0x89, 
+0xC2, 0xC1, 0x15, 0x7C, 0xD6, 0xA0, 0x07, 0x14, 
+0x7D, 0x81, 0x83, 0x3B, 0x96, 0xBA, 0x39, 0x57, 
+0x01, 
+
+0x41, 0xC2, 0x86, 0x92, 0x97, 0xA5, 0x85, 0x6B, 
+0xA6, 0xEC, 0x46, 0xB2, 0xA1, 0xF7, 0x9F, 0x4C, 
+0x95, 0xA8, 0xEE, 0xE0, 0xB8, 0x83, 0x46, 0x6A, 
+0xAD, 0x9C, 0xD1, 0x91, 0x42, 0xD8, 0x18, 0xC1, 
+0xA9, 0x54, 0x1A, 0xCA, 0xA6, 0x66, 0xF7, 0xE2, 
+0x9F, 0x82, 0x73, 0xE9, 0x17, 0x32, 0x23, 0x35, 
+0x3E, 0x18, 0x5C, 0xAE, 0x59, 0xB7, 0x6A, 0x04, 
+0x97, 0xD1, 0x1F, 0xB2, 0x58, 0xC9, 0x37, 0xFD, 
+0xE0, 0xC5, 0xA4, 0x6C, 0x9A, 0x00, 0x9B, 0x4C, 
+0x06, 0x32, 0xF4, 0xA3, 0x94, 0xBF, 0xA8, 0x49, 
+0xFE, 0xC2, 0x45, 0xA8, 0xB5, 0x32, 0x86, 0x5F, 
+0xBA, 0xFD, 0xD1, 0x60, 0x4D, 0xF1, 0x43, 0xE1, 
+0x55, 0x75, 0xD8, 0xD6, 0x1F, 0xD5, 0x7F, 0x35, 
+0x67, 0x39, 0x74, 0x9C, 0x3B, 0x50, 0xBE, 0xC0, 
+0x67, 0xA1, 0x90, 0x0F, 0x90, 0x27, 0xAD, 0xB9, 
+0x97, 0x0C, 0xBC, 0x2A, 0xEF, 0x4D, 0x8F, 0x69, 
+0x08, 0x50, 0xBD, 0x69, 0xE9, 0x2B, 0xB3, 0x37, 
+0xB5, 0x90, 0x81, 0x73, 0x63, 0x64, 0xE6, 0x6A, 
+0xF0, 0xEF, 0xB1, 0xEC, 0x9C, 0x8B, 0x23, 0xE3, 
+0xBA, 0xDD, 0x10, 0x1A, 0x0E, 0x6A, 0x76, 0x4C, 
+0x58, 0xAF, 0x8C, 0x10, 0xDD, 0xFE, 0xCB, 0x82, 
+0x53, 0x9A, 
+
+0xB5, 0x42, 0x48, 0x28, 0x65, 0x88, 0xE8, 0xB7, 
+0xB6, 0xCD, 0xAB, 0x9B, 0x31, 0xFE, 0xEC, 0xD7, 
+0xBF, 0x43, 0x1B, 0x30, 0x75, 0x57, 0x96, 0x3E, 
+0x34, 0x5C, 0xAA, 0x37, 0x83, 0x1C, 0xA3, 0x08, 
+0x88, 0x31, 0xA0, 0x96, 0x40, 0x50, 0x7D, 0x5C, 
+0x26, 0x66, 0x8D, 0x71, 0x95, 0xE0, 0xB8, 0x83, 
+0x90, 0x8D, 0x9B, 0xBF, 0x85, 0x67, 0x08, 0x88, 
+0x9F, 0x96, 0xC4, 0x31, 0xEC, 0xED, 0x6E, 0x9A, 
+0x7E, 0x62, 0x20, 0x9A, 0xD2, 0xC6, 0xEA, 0x6B, 
+0x65, 0xB2, 0xC5, 0xBB, 0xD3, 0x82, 0xC0, 0x0D, 
+0xF6, 0x37, 0x3F, 0xAB, 0x88, 0xFA, 0x7E, 0xFC, 
+0x4D, 0xE4, 0x83, 0x32, 0x8F, 0x3A, 0xFF, 0xAC, 
+0x12, 0x81, 0xFC, 0x49, 0x0E, 0xA6, 0x37, 0xA0, 
+0xFC, 0x40, 0x47, 0x4B, 0x14, 0x42, 0xC0, 0x0B, 
+0xE5, 0x2D, 0x26, 0x5C, 0x2B, 0x4D, 0x0C, 0xBE, 
+0xC1, 0x63, 0x67, 0x75, 0xB4, 0x1D, 0xB3, 0x9D, 
+0xAA, 0xD6, 0x7C, 0xC3, 0x53, 0xD5, 0x0F, 0xE7, 
+0x7F, 0xFA, 0xC0, 0xB5, 0x93, 0xBE, 0x18, 0x40, 
+0x3B, 0x71, 0x32, 0xF1, 0x0F, 0x78, 0x53, 0xA1, 
+0x01, 0xC6, 0xF6, 0x60, 0xEA, 0x50, 0x3A, 0x62, 
+0x30, 0xBD, 0x1E, 0xE1, 0x88, 0x7C, 0x30, 0x9F, 
+0xE4, 0x8E, 0x3F, 
+
+0x2A, 0x34, 0xC2, 0x7C, 0xAA, 0x2B, 0xA1, 0xC0, 
+0x1A, 0xF5, 0xA8, 0xBC, 0xB8, 0x1E, 0x17, 0x9D, 
+0xE3, 0x4E, 0x65, 0xB4, 0x4E, 0x9A, 0xB7, 0x6C, 
+0xDD, 0x1E, 0x86, 0xA0, 0x68, 0x4D, 0x83, 0xB7, 
+0x06, 0xD3, 0x5E, 0xAC, 0xB0, 0x9F, 0x00, 0x00, 
+0x62, 0xD2, 0xCD, 0x67, 0x0C, 0x2D, 0xA9, 0x25, 
+0xC1, 0x6E, 0xA8, 0xB0, 0x0E, 0xCF, 0xD9, 0x1D, 
+0x5D, 0x5A, 0x04, 0xD9, 0xEA, 0x3F, 0x57, 0xE1, 
+0x25, 0x19, 0xF4, 0x9D, 0x3C, 0x7D, 0xE0, 0x65, 
+0x98, 0xF2, 0xD1, 0x92, 0xCA, 0x8E, 0x97, 0x6B, 
+0x61, 0x58, 0x2B, 0xB4, 0x2A, 0xB4, 0x7A, 0x2A, 
+0xEC, 0x93, 0xC4, 0xCB, 0x54, 0x58, 0x96, 0xD1, 
+0x9E, 0x90, 0xA2, 0x2E, 0xD8, 0x62, 0x89, 0x97, 
+0x2B, 0x43, 0x29, 0x6C, 0xC8, 0x78, 0x5E, 0xB8, 
+0x25, 0x9E, 0x23, 0x92, 0x6F, 0x32, 0xAF, 0x4B, 
+0x13, 0x6E, 0x1F, 0xB5, 0x8B, 0x8F, 0x46, 0x80, 
+0x40, 0xD6, 0x34, 0xD4, 0x0D, 0x23, 0xA6, 0x46, 
+0x86, 0x22, 0x51, 0xCF, 0xF5, 0xB6, 0x7E, 0x0B, 
+0xD3, 0xE8, 0xD5, 0xAE, 0x47, 0x2C, 0xD9, 0xDA, 
+0x61, 0x81, 0x32, 0x0A, 0xAC, 0x32, 0x83, 0x0F, 
+0x5D, 0x06, 0x15, 0x19, 0x2D, 0x4E, 0x13, 0x7A, 
+0xEC, 0x91, 0xEC, 0xC8, 
+
+0xB0, 0x44, 0xEA, 0xAC, 0x35, 0x51, 0x2D, 0x29, 
+0x50, 0x73, 0x14, 0xC6, 0x5A, 0x7B, 0x9F, 0xD2, 
+0x75, 0xE5, 0xE0, 0xE7, 0x66, 0x8A, 0xCB, 0x54, 
+0x1D, 0xC4, 0x8A, 0x25, 0x0C, 0x7D, 0xA1, 0xA1, 
+0xBA, 0x4E, 0x24, 0xB6, 0xD1, 0xF8, 0x8A, 0x9E, 
+0x49, 0xD7, 0xCA, 0x7C, 0x7D, 0x83, 0xDE, 0x3E, 
+0xED, 0x6E, 0x26, 0x34, 0x57, 0x6F, 0x00, 0x55, 
+0xB9, 0x44, 0x54, 0x82, 0x9B, 0x9A, 0x32, 0xDA, 
+0xBB, 0x59, 0x48, 0x80, 0x08, 0x96, 0x2D, 0x34, 
+0x68, 0x40, 0x27, 0xB9, 0x00, 0x80, 0xC6, 0x6F, 
+0x42, 0x72, 0x3C, 0x9B, 0xD1, 0xA0, 0x01, 0xCC, 
+0xD2, 0x0F, 0x33, 0x00, 0x15, 0x6A, 0x65, 0x9B, 
+0xED, 0x91, 0xDF, 0x56, 0xE2, 0x81, 0x54, 0x06, 
+0xA0, 0xFE, 0x0E, 0xE6, 0x54, 0x16, 0xC5, 0xA6, 
+0x89, 0x54, 0x87, 0x7E, 0x49, 0x47, 0x6C, 0x29, 
+0x0F, 0x1C, 0x9E, 0xF7, 0x62, 0x23, 0xEB, 0x17, 
+0xC2, 0x7B, 0x0D, 0x8B, 0xA0, 0xEF, 0xCE, 0x03, 
+0xDE, 0x8D, 0x58, 0x0C, 0x7B, 0x6A, 0xD7, 0x66, 
+0x52, 0x6E, 0x93, 0x0C, 0x13, 0xDF, 0x65, 0x28, 
+0x66, 0x38, 0xA9, 0x67, 0xE9, 0x22, 0x96, 0x71, 
+0xD2, 0xB9, 0xA3, 0xE8, 0x61, 0x49, 0x20, 0x7C, 
+0xD5, 0x5B, 0x49, 0xCF, 0x57, 
+
+0x4B, 0xA0, 0x49, 0xB0, 0xEE, 0xB4, 0xED, 0x67, 
+0x08, 0x08, 0x9D, 0xBB, 0x9F, 0xD8, 0x79, 0xE8, 
+0x42, 0x71, 0x81, 0xDA, 0x5E, 0x8D, 0x90, 0x1B, 
+0x4C, 0x9E, 0x71, 0xCF, 0x46, 0xEB, 0xE7, 0x14, 
+0x96, 0x98, 0xA5, 0xDB, 0xFA, 0x35, 0x99, 0x4C, 
+0x40, 0x21, 0x5D, 0xF8, 0xB5, 0xC5, 0xAE, 0xE5, 
+0x29, 0x68, 0x5F, 0x4A, 0xE9, 0x1D, 0x15, 0x55, 
+0xF1, 0x4F, 0x74, 0xD4, 0x15, 0xB3, 0x7D, 0x9F, 
+0x37, 0xC8, 0xC8, 0xAF, 0xF1, 0xF0, 0x8D, 0xC4, 
+0xF8, 0x36, 0xF0, 0x7A, 0xB9, 0xE6, 0xB8, 0xA9, 
+0x66, 0x10, 0xDF, 0xFE, 0x16, 0x7C, 0x8E, 0xDE, 
+0x57, 0x0C, 0x75, 0xBF, 0x67, 0x8C, 0x39, 0x49, 
+0xF1, 0x66, 0x1D, 0xF2, 0x0D, 0x6D, 0xBB, 0xB4, 
+0xC3, 0xC7, 0xD7, 0xE1, 0xB3, 0xEB, 0xE2, 0x0C, 
+0x69, 0x4E, 0xC3, 0x60, 0x5B, 0x2B, 0xD5, 0x71, 
+0x1D, 0x33, 0xF6, 0x9D, 0xBC, 0xAE, 0x0C, 0x7E, 
+0x3B, 0x75, 0x16, 0x73, 0x1D, 0xD5, 0xFA, 0x71, 
+0x04, 0xA1, 0x76, 0xA8, 0xCF, 0xB2, 0xD1, 0x8D, 
+0x00, 0x83, 0x47, 0x14, 0x28, 0x13, 0x22, 0xF6, 
+0x2C, 0xF1, 0xCF, 0xC1, 0xB9, 0x8F, 0x18, 0x42, 
+0x88, 0x28, 0x9B, 0x0D, 0xFF, 0xEA, 0x2D, 0xD3, 
+0xF8, 0xC5, 0x26, 0x49, 0x67, 0xB4, 
+
+0x5A, 0xE7, 0x15, 0x4E, 0x9B, 0xC6, 0x57, 0xBD, 
+0x13, 0x39, 0xD7, 0xDA, 0x9A, 0xBA, 0x06, 0xDA, 
+0x73, 0xEC, 0x64, 0xBF, 0xF4, 0x29, 0xEB, 0x4D, 
+0x6B, 0x9A, 0xA2, 0x0F, 0x77, 0x6F, 0xBC, 0x2B, 
+0xC5, 0x3F, 0xE8, 0xF0, 0x5A, 0x46, 0x88, 0x3C, 
+0x4F, 0xC0, 0x44, 0xE7, 0xAC, 0x89, 0xED, 0xF6, 
+0xF3, 0xF2, 0xE1, 0xB7, 0x24, 0x75, 0xAB, 0x72, 
+0xC1, 0x06, 0x0E, 0x5D, 0x02, 0x5E, 0x57, 0xA6, 
+0xA3, 0x4E, 0x4A, 0xFE, 0x46, 0xF0, 0xB4, 0xDD, 
+0x2F, 0xAB, 0xD6, 0xED, 0x49, 0x68, 0x6B, 0xE3, 
+0x5D, 0xFF, 0x11, 0x67, 0xA0, 0x38, 0xD1, 0xB6, 
+0x6E, 0x98, 0x61, 0x88, 0xA5, 0x60, 0x3E, 0x74, 
+0xFB, 0xF6, 0x47, 0x5D, 0xB4, 0xB0, 0x1F, 0xB0, 
+0xD0, 0xE8, 0x7B, 0xB7, 0xD4, 0x7D, 0xA1, 0xAF, 
+0xD5, 0xD8, 0x5B, 0x83, 0xDF, 0x82, 0x29, 0xA8, 
+0xB1, 0x50, 0x2A, 0x06, 0xA6, 0xC0, 0x4C, 0xC0, 
+0x96, 0xA4, 0xDB, 0x95, 0x61, 0x19, 0x4E, 0x1E, 
+0xB8, 0x79, 0x45, 0x48, 0xCA, 0x22, 0xFD, 0x20, 
+0x9E, 0x0E, 0xE1, 0xD7, 0x28, 0x00, 0x16, 0x50, 
+0x42, 0xE4, 0x72, 0xB0, 0x61, 0x41, 0xA4, 0x93, 
+0x9D, 0xB9, 0x0D, 0x7F, 0x2F, 0xBB, 0xD3, 0x89, 
+0xA1, 0xBB, 0xCF, 0xE6, 0xE0, 0x09, 0x52, 
+
+0x0B, 0x8D, 0x85, 0x02, 0x46, 0xFB, 0x2D, 0x5A, 
+0x4A, 0xB7, 0xC1, 0x3A, 0x8C, 0x4D, 0xA0, 0xBD, 
+0x43, 0x52, 0xE1, 0x83, 0xA5, 0x6C, 0x78, 0x10, 
+0xC1, 0xF1, 0xDF, 0x77, 0x66, 0x2C, 0x30, 0x82, 
+0x12, 0x06, 0xBD, 0x79, 0x0A, 0xBD, 0x80, 0x92, 
+0xF2, 0xCF, 0xB3, 0x50, 0xF7, 0x3A, 0x80, 0xE2, 
+0xE5, 0xB6, 0xC8, 0x65, 0xFF, 0xB9, 0x75, 0x84, 
+0x89, 0xB3, 0x63, 0x75, 0xA8, 0xA1, 0x45, 0x3E, 
+0x46, 0x97, 0x4A, 0x09, 0xFF, 0xAC, 0x5B, 0xF9, 
+0x25, 0x79, 0x49, 0x70, 0xA8, 0x87, 0x95, 0x8D, 
+0x59, 0x2F, 0x27, 0x85, 0x02, 0x78, 0x30, 0x9F, 
+0x72, 0xDB, 0x11, 0x88, 0x85, 0x09, 0xD4, 0x4B, 
+0x71, 0x39, 0xF4, 0x68, 0xFB, 0x40, 0x63, 0x71, 
+0xAA, 0xFC, 0x6C, 0xE3, 0x2A, 0xF1, 0x45, 0xF4, 
+0xE8, 0x0E, 0x8E, 0xF4, 0xA3, 0xC9, 0x37, 0xA0, 
+0xEF, 0xDE, 0x9C, 0x92, 0x42, 0x36, 0x95, 0x30, 
+0x0A, 0x9A, 0x06, 0xB8, 0xEB, 0x5C, 0xE2, 0x85, 
+0x40, 0x7C, 0x2A, 0xBD, 0xC6, 0x63, 0xCE, 0xBB, 
+0x20, 0xC0, 0x1F, 0xE1, 0x5B, 0xDD, 0xD9, 0x62, 
+0x2D, 0x30, 0x79, 0xBB, 0x49, 0x65, 0x2A, 0x12, 
+0x82, 0x7A, 0x8D, 0x2F, 0xFC, 0xF7, 0xB4, 0x17, 
+0x1B, 0x69, 0x5B, 0x90, 0x6D, 0x07, 0x0D, 0x5B, 
+
+0x99, 0x09, 0x0A, 0xFD, 0xC2, 0x22, 0x9F, 0x2B, 
+0x4E, 0xD3, 0x2E, 0x38, 0x10, 0x2F, 0x9D, 0xD4, 
+0x5E, 0xFC, 0x93, 0x77, 0x7B, 0x8D, 0xD3, 0xB9, 
+0x5E, 0x1C, 0x17, 0x29, 0x4D, 0x0A, 0xA9, 0x5D, 
+0x3E, 0xA9, 0x59, 0xD9, 0xBA, 0xF9, 0x5B, 0x21, 
+0xF8, 0x59, 0xA8, 0xD5, 0x5C, 0x7E, 0x01, 0xDA, 
+0xE5, 0x4F, 0x81, 0x1C, 0xD7, 0x63, 0x16, 0xA4, 
+0x2D, 0xE6, 0xFA, 0xAA, 0x18, 0x8C, 0x87, 0xF7, 
+0xF2, 0x7C, 0x38, 0x0C, 0x08, 0xD6, 0xA4, 0x1E, 
+0x61, 0x19, 0xE7, 0xE9, 0x5B, 0x7C, 0x8F, 0x1A, 
+0xB6, 0x4B, 0x7E, 0x78, 0x12, 0x22, 0xF4, 0xCA, 
+0x59, 0xAE, 0x69, 0xC7, 0x22, 0x1D, 0xF6, 0xBB, 
+0xFC, 0xEF, 0x70, 0x47, 0x0E, 0x5F, 0x85, 0x75, 
+0x26, 0xA9, 0x07, 0xFA, 0x6C, 0x59, 0x67, 0x14, 
+0x0B, 0x86, 0xCD, 0xA4, 0x84, 0xD9, 0xB1, 0xCF, 
+0xC4, 0x26, 0x40, 0x55, 0x3E, 0xFA, 0x5F, 0x08, 
+0x0A, 0x89, 0x56, 0x6F, 0x3A, 0xFF, 0xE4, 0x46, 
+0x63, 0x93, 0xB9, 0xDE, 0x2C, 0x57, 0x26, 0x96, 
+0x38, 0x03, 0x01, 0x80, 0x02, 0x44, 0xBA, 0x96, 
+0x20, 0x7C, 0x7C, 0x76, 0x1C, 0x0C, 0x0D, 0x72, 
+0x56, 0x28, 0xFD, 0x9F, 0xCB, 0xD5, 0xEE, 0x92, 
+0x61, 0x5C, 0x6F, 0x0A, 0x70, 0xBA, 0xD3, 0xFB, 
+0xD5, 
+
+0x79, 0xFE, 0xFA, 0xEB, 0xF2, 0x35, 0xB1, 0x0C, 
+0x46, 0x53, 0x2E, 0x26, 0xCE, 0x1A, 0x6E, 0x36, 
+0xD0, 0xD1, 0x4C, 0x2B, 0x55, 0x02, 0x8C, 0x39, 
+0xA4, 0xC4, 0x14, 0x9B, 0x05, 0x83, 0x80, 0x8A, 
+0xE6, 0x05, 0x7E, 0x0A, 0xE9, 0x1A, 0xBC, 0xBD, 
+0xAE, 0xDE, 0xCF, 0x33, 0xC4, 0xAB, 0x10, 0x66, 
+0x52, 0x56, 0x4D, 0xEA, 0xBD, 0xE2, 0xDA, 0xC8, 
+0x77, 0x1D, 0x26, 0x8C, 0xD5, 0x9A, 0x01, 0xFD, 
+0xFB, 0x49, 0x89, 0xB8, 0xE1, 0xD0, 0x48, 0x49, 
+0x44, 0x23, 0xE2, 0xEF, 0x11, 0x74, 0x67, 0x5E, 
+0x14, 0x01, 0x15, 0xEC, 0x39, 0x9B, 0x0A, 0x69, 
+0x7C, 0x87, 0xC2, 0xE9, 0x58, 0x05, 0xDE, 0x15, 
+0xA2, 0x71, 0x50, 0x73, 0x12, 0x5E, 0x5B, 0x87, 
+0x6E, 0xAE, 0xD3, 0x8F, 0x95, 0xE9, 0x77, 0xEF, 
+0x2F, 0xD3, 0x63, 0x5B, 0x40, 0xBB, 0xDD, 0xF0, 
+0x01, 0x08, 0x7A, 0x5C, 0x92, 0xB6, 0x37, 0xC9, 
+0xEB, 0x84, 0x07, 0x65, 0x92, 0xFC, 0xC6, 0xB3, 
+0x68, 0x37, 0xED, 0x62, 0xD1, 0x70, 0xD4, 0xBD, 
+0x28, 0xD3, 0x46, 0x94, 0x63, 0xD9, 0x56, 0xBE, 
+0x93, 0xEC, 0x8A, 0x01, 0xB5, 0xBD, 0xC6, 0x9B, 
+0x75, 0x08, 0x06, 0x08, 0xF1, 0xFB, 0x3F, 0xB0, 
+0x3E, 0x18, 0x35, 0xF3, 0xFA, 0x85, 0x1A, 0xA7, 
+0x9A, 0x8E, 
+
+0x17, 0x73, 0x8A, 0x98, 0x3B, 0x5B, 0xAD, 0x53, 
+0x01, 0x84, 0x65, 0x88, 0xB4, 0x47, 0x4D, 0xC8, 
+0x8C, 0x13, 0x47, 0x75, 0xB5, 0x03, 0x43, 0x64, 
+0xE5, 0x8D, 0x56, 0xC1, 0xC0, 0x85, 0xE8, 0x25, 
+0xE7, 0x2F, 0xC1, 0x98, 0x58, 0xE7, 0x2D, 0xD7, 
+0x4D, 0x2B, 0xE0, 0x2D, 0x87, 0x67, 0x72, 0x7E, 
+0xA1, 0xAE, 0xF3, 0x86, 0x88, 0xE4, 0x69, 0x23, 
+0x30, 0x27, 0xA3, 0x48, 0xDC, 0xA0, 0x67, 0xCC, 
+0x54, 0x31, 0xB2, 0xB7, 0x24, 0x23, 0xB9, 0x4A, 
+0x5A, 0x07, 0x65, 0x4C, 0x2D, 0x5A, 0x9A, 0x4E, 
+0xB4, 0xA6, 0x70, 0xFF, 0x69, 0x3E, 0xD2, 0xC9, 
+0x55, 0x2E, 0x4F, 0xC4, 0x2F, 0x1F, 0x89, 0x98, 
+0x76, 0x45, 0x5F, 0xBD, 0x3A, 0x8C, 0xB6, 0x55, 
+0x93, 0xB0, 0xA7, 0x4E, 0x75, 0xBD, 0x7D, 0x25, 
+0x08, 0x8C, 0xAD, 0x13, 0x33, 0x24, 0x57, 0xA9, 
+0x1C, 0x44, 0x71, 0x86, 0x7E, 0xC4, 0xC4, 0x1C, 
+0x5B, 0x1E, 0x83, 0x37, 0x1B, 0x37, 0x98, 0x9A, 
+0xB4, 0xDC, 0xA1, 0xC2, 0x1A, 0x69, 0x89, 0xE1, 
+0xD9, 0x67, 0x41, 0x58, 0x72, 0x6A, 0xF8, 0x22, 
+0x69, 0xEA, 0x53, 0xF5, 0x3C, 0x5E, 0x53, 0x5D, 
+0x98, 0x9A, 0x2A, 0xDA, 0xF6, 0x30, 0xA0, 0xBA, 
+0xCE, 0xF8, 0xB7, 0x4D, 0xE2, 0xBF, 0x05, 0xAA, 
+0xF7, 0x44, 0x84, 
+
+0x97, 0xDD, 0xB6, 0xB9, 0xD3, 0xA3, 0x8A, 0x20, 
+0x05, 0x15, 0xD1, 0x14, 0x69, 0x33, 0x98, 0xFF, 
+0x77, 0xDA, 0x47, 0x11, 0xC3, 0x59, 0x19, 0xFB, 
+0x86, 0xEE, 0x3A, 0xB9, 0x4F, 0x61, 0x07, 0x6C, 
+0x7B, 0x01, 0x89, 0x87, 0x1E, 0xA0, 0xBB, 0x34, 
+0x60, 0x89, 0xAA, 0xE9, 0xE5, 0x03, 0xE4, 0xF7, 
+0xA7, 0x16, 0xD0, 0xA9, 0x08, 0x3F, 0xA1, 0x25, 
+0x57, 0xB4, 0xAD, 0x52, 0x80, 0x53, 0xB3, 0x3B, 
+0x58, 0x58, 0x96, 0x37, 0x25, 0xEB, 0x08, 0xD9, 
+0x63, 0xFA, 0x1F, 0xC8, 0x92, 0xF0, 0x50, 0x0D, 
+0xAE, 0xFB, 0x16, 0x2E, 0xA7, 0xB1, 0x1E, 0x71, 
+0x46, 0xCE, 0xE6, 0xA9, 0x4B, 0x67, 0xAC, 0xD8, 
+0xC7, 0x01, 0x18, 0xA0, 0x83, 0xD5, 0xBA, 0xA9, 
+0x94, 0xCD, 0x2C, 0x8C, 0x90, 0x86, 0xE9, 0x80, 
+0x3E, 0x94, 0x0B, 0x0C, 0xDD, 0xF0, 0xB8, 0xFE, 
+0x29, 0x9D, 0x9F, 0xAB, 0x16, 0xE6, 0x08, 0x12, 
+0x67, 0x29, 0x45, 0x57, 0x4D, 0xB9, 0x08, 0xF3, 
+0x44, 0x4E, 0x5C, 0x9C, 0x92, 0x69, 0xF6, 0x41, 
+0x90, 0x17, 0x92, 0xF5, 0x1B, 0x8B, 0xBB, 0x89, 
+0x2E, 0x3A, 0x7F, 0xAF, 0x82, 0x59, 0xA4, 0x94, 
+0x53, 0x40, 0xF5, 0x6A, 0x2A, 0xF5, 0xA8, 0x4F, 
+0x48, 0x2D, 0x01, 0x61, 0x6A, 0x98, 0xFD, 0xF8, 
+0xBC, 0x46, 0xFC, 0x6F, 
+
+0x2F, 0x20, 0x5D, 0x1C, 0xAC, 0x77, 0x49, 0x4E, 
+0x6A, 0x21, 0x43, 0x6D, 0x67, 0x9C, 0xF6, 0x87, 
+0xC9, 0xAF, 0x8B, 0x58, 0xF0, 0xCB, 0x8B, 0x9C, 
+0x41, 0x7D, 0x77, 0x66, 0xFF, 0x9D, 0x6D, 0xA5, 
+0x23, 0xAC, 0x7E, 0x35, 0x18, 0xC1, 0x8E, 0x96, 
+0x5F, 0x9B, 0xD8, 0xDF, 0xEA, 0xA1, 0x1A, 0x4E, 
+0xE5, 0x43, 0x6E, 0x05, 0xE7, 0xBF, 0x0B, 0x81, 
+0xD5, 0x73, 0x6C, 0x37, 0x43, 0x82, 0xF4, 0x40, 
+0x8F, 0x6E, 0xA2, 0x7B, 0xEC, 0x28, 0x7B, 0xD5, 
+0xA1, 0xCB, 0x38, 0xF1, 0x62, 0x5F, 0xCC, 0xCF, 
+0xB5, 0x87, 0x38, 0x03, 0x5D, 0x1E, 0xC9, 0x01, 
+0x4C, 0xE1, 0x97, 0xD7, 0xC5, 0x92, 0xE2, 0xEB, 
+0x5B, 0x49, 0xC3, 0xB9, 0xB9, 0x9D, 0x16, 0x8D, 
+0x54, 0x57, 0xB7, 0x08, 0xA9, 0xF2, 0x1E, 0x2B, 
+0x6B, 0x8C, 0x0C, 0x1E, 0xD6, 0x8D, 0x0E, 0xA6, 
+0x51, 0xB5, 0xC7, 0x02, 0xC1, 0x34, 0x92, 0x95, 
+0x8A, 0xEA, 0xF3, 0xCD, 0x37, 0x54, 0x09, 0x74, 
+0x47, 0x04, 0x93, 0x73, 0xD9, 0x91, 0x31, 0x33, 
+0x91, 0x4E, 0xF5, 0x08, 0x0A, 0x8A, 0x50, 0x15, 
+0x9F, 0x08, 0x36, 0xC5, 0x74, 0xEF, 0x2B, 0xD2, 
+0x1E, 0x05, 0xD1, 0xB7, 0x2E, 0x57, 0x3B, 0x25, 
+0x0F, 0x91, 0x65, 0x48, 0xEB, 0x2F, 0xF3, 0xDB, 
+0xF8, 0xE2, 0x7B, 0xAF, 0x93, 
+
+0xB0, 0xC5, 0x54, 0xAD, 0x36, 0x9B, 0x5C, 0x03, 
+0x86, 0x9C, 0x35, 0x73, 0xD5, 0xF9, 0x0F, 0x5F, 
+0xEF, 0x3A, 0xFF, 0x73, 0x9E, 0xC4, 0xDB, 0x64, 
+0x4F, 0x3E, 0xEF, 0xD2, 0x32, 0x5A, 0x7F, 0xA5, 
+0xBF, 0x2C, 0x81, 0x00, 0xBB, 0xFA, 0x5F, 0x98, 
+0x86, 0x71, 0x26, 0x39, 0x8F, 0xB7, 0x84, 0x38, 
+0x9D, 0xE4, 0xF7, 0x99, 0x21, 0xB9, 0x0D, 0xF7, 
+0x6F, 0x7F, 0xB8, 0x68, 0x8F, 0x7E, 0x7E, 0x4F, 
+0xA7, 0x15, 0x2A, 0x82, 0xFC, 0x60, 0xCF, 0x70, 
+0xE9, 0x86, 0xBD, 0x24, 0xA1, 0xBA, 0x30, 0xB2, 
+0x70, 0x66, 0xBC, 0x6F, 0x1C, 0x39, 0x94, 0xB5, 
+0x81, 0x3C, 0x54, 0x98, 0x3C, 0x82, 0xE3, 0xF8, 
+0x6D, 0x3C, 0x1B, 0x5A, 0x7A, 0x81, 0x7A, 0x29, 
+0xEF, 0x48, 0x3B, 0x35, 0x3F, 0x73, 0xF0, 0x05, 
+0x12, 0xB6, 0xC9, 0x2D, 0xDA, 0xCB, 0x81, 0xBD, 
+0xEE, 0x8F, 0x8E, 0xE7, 0x06, 0x8D, 0xD2, 0x76, 
+0x39, 0x60, 0xF2, 0x57, 0xD6, 0xE5, 0xFC, 0x81, 
+0x14, 0x49, 0xB4, 0x6B, 0x06, 0x6E, 0x33, 0xDC, 
+0x2C, 0x2F, 0x4E, 0x15, 0xC8, 0xC2, 0x41, 0x95, 
+0xA8, 0x65, 0x59, 0xCA, 0x97, 0xD8, 0xA0, 0x4A, 
+0x1F, 0xCA, 0x35, 0xC5, 0x21, 0xBD, 0x51, 0x29, 
+0x2B, 0x0A, 0x1B, 0x37, 0x4C, 0xF7, 0xAA, 0x88, 
+0xDF, 0x44, 0x88, 0x05, 0x2F, 0x4B, 
+
+0x50, 0xDC, 0xA7, 0x8B, 0xED, 0x47, 0x10, 0x06, 
+0x63, 0xCB, 0x7D, 0x5B, 0xE6, 0xB0, 0xED, 0x90, 
+0x55, 0xF0, 0x38, 0x3C, 0x80, 0x34, 0x2B, 0x62, 
+0x92, 0xC5, 0x61, 0x0D, 0xEC, 0x0E, 0xD3, 0xEA, 
+0xD6, 0x81, 0xB8, 0x4D, 0xC6, 0x3E, 0xC5, 0x19, 
+0x70, 0x31, 0x0F, 0x2C, 0x65, 0x07, 0x0F, 0x43, 
+0x2F, 0xA0, 0x63, 0xD7, 0xFB, 0x9B, 0xD1, 0xA9, 
+0x60, 0x3C, 0xFE, 0x4E, 0x09, 0xAF, 0xC7, 0x04, 
+0x4A, 0xE6, 0x75, 0x18, 0xF6, 0x53, 0x87, 0x91, 
+0xD6, 0x33, 0x15, 0x9C, 0x32, 0xA0, 0x52, 0x82, 
+0xC7, 0xF9, 0x17, 0x9C, 0x58, 0x2C, 0xBC, 0x75, 
+0x88, 0x1B, 0x7D, 0x71, 0xC3, 0xCC, 0xFE, 0x60, 
+0x08, 0xDD, 0xB0, 0x5F, 0x45, 0x49, 0x08, 0x91, 
+0xF6, 0x42, 0x97, 0x85, 0xBB, 0xF7, 0x04, 0xAB, 
+0xFF, 0xB6, 0xB6, 0x37, 0xA3, 0xA8, 0x1D, 0x41, 
+0x52, 0x9F, 0x47, 0xC2, 0xD4, 0xE1, 0x32, 0x22, 
+0x26, 0xF5, 0x26, 0x0A, 0x03, 0xD5, 0xDD, 0x37, 
+0x95, 0xBB, 0xC0, 0xEF, 0x4C, 0x61, 0xC9, 0x87, 
+0x43, 0x88, 0x22, 0xE9, 0x5F, 0x30, 0x0A, 0x64, 
+0x73, 0xF3, 0x2F, 0xD2, 0x4D, 0xE8, 0x91, 0x1C, 
+0x68, 0xBE, 0x35, 0xC3, 0xF4, 0xC4, 0xD3, 0xD2, 
+0x01, 0xB2, 0x19, 0x28, 0x30, 0x86, 0xD9, 0xFC, 
+0x16, 0x4A, 0x0E, 0xCC, 0xCA, 0xD6, 0xF6, 
+
+0x74, 0xAF, 0x14, 0xA0, 0x6E, 0x50, 0x25, 0x74, 
+0x8F, 0xC7, 0x16, 0x03, 0x56, 0x10, 0x01, 0x76, 
+0x5B, 0x63, 0xD0, 0xEF, 0x4E, 0xA7, 0x59, 0x27, 
+0x43, 0x0C, 0x3F, 0x8C, 0xFD, 0xB6, 0x09, 0x17, 
+0x81, 0xD7, 0xEC, 0x94, 0x50, 0x4B, 0xC8, 0x22, 
+0x3F, 0x82, 0x11, 0xA1, 0x58, 0x8C, 0x23, 0xBB, 
+0x8B, 0xFB, 0x4F, 0x34, 0x31, 0x98, 0x63, 0x1B, 
+0xC4, 0x8E, 0xCF, 0x12, 0x57, 0x68, 0x14, 0x2B, 
+0x23, 0xA9, 0x9D, 0x17, 0x84, 0x2A, 0x53, 0xE7, 
+0x67, 0x5A, 0xC1, 0x6E, 0x65, 0x25, 0x70, 0x25, 
+0x76, 0x70, 0x30, 0x24, 0x85, 0xF8, 0x1F, 0x9D, 
+0xFD, 0x8D, 0x84, 0xAD, 0x33, 0x07, 0xFD, 0x21, 
+0x01, 0x13, 0xC0, 0x14, 0x20, 0xF2, 0xDC, 0x81, 
+0x21, 0xEF, 0x3A, 0xCB, 0x32, 0x23, 0xB2, 0x76, 
+0x3B, 0xA4, 0x30, 0xB6, 0x8F, 0x90, 0xE3, 0x20, 
+0x4C, 0x81, 0x6A, 0xF4, 0x2C, 0xC3, 0x4C, 0x86, 
+0x08, 0x86, 0x1E, 0x31, 0x48, 0x55, 0x7E, 0xF2, 
+0xA7, 0xF5, 0xC6, 0x22, 0x19, 0x87, 0xE5, 0x6E, 
+0xA6, 0xC5, 0x31, 0xF6, 0x1D, 0xA4, 0xD8, 0x75, 
+0x90, 0x1C, 0x57, 0x01, 0xF5, 0xE8, 0x53, 0x3E, 
+0x0A, 0x16, 0xDD, 0x40, 0x58, 0x0C, 0xE0, 0x8F, 
+0xBF, 0x57, 0xFA, 0x1D, 0x37, 0x15, 0xC6, 0x63, 
+0xC1, 0x57, 0x0C, 0x6D, 0x83, 0x6C, 0xA4, 0x0E, 
+
+0x6A, 0x33, 0x55, 0x52, 0x04, 0x3E, 0x41, 0x83, 
+0x22, 0xED, 0x2A, 0xAC, 0x33, 0xEF, 0xA6, 0xA9, 
+0x8E, 0x21, 0x9D, 0x2B, 0xFB, 0x20, 0x80, 0x22, 
+0x0F, 0x7A, 0xB4, 0x67, 0xFF, 0x76, 0xFA, 0x1D, 
+0x1B, 0x26, 0xF9, 0xD4, 0x3A, 0x66, 0x99, 0x4C, 
+0xFD, 0x45, 0x61, 0x96, 0x02, 0x3F, 0xD3, 0x13, 
+0xE4, 0x44, 0x30, 0x30, 0x6B, 0x76, 0x77, 0xEA, 
+0x66, 0x69, 0x1B, 0x82, 0xE6, 0xA2, 0x50, 0x69, 
+0xFD, 0x5D, 0xAD, 0x59, 0x82, 0x94, 0xA1, 0xF3, 
+0x78, 0x5B, 0x00, 0x7B, 0x6E, 0xE9, 0xA2, 0xB9, 
+0xB4, 0x56, 0xF9, 0xD5, 0x5C, 0x42, 0x89, 0xA5, 
+0x7E, 0x86, 0xFE, 0x61, 0x6C, 0xF1, 0x0A, 0xFC, 
+0xC9, 0x7D, 0x9C, 0xF0, 0xE7, 0x7F, 0xFE, 0xCD, 
+0x85, 0xEC, 0x68, 0x68, 0xC5, 0x6F, 0x06, 0x35, 
+0x4B, 0xA6, 0x15, 0xF8, 0x38, 0xF5, 0x1B, 0x56, 
+0x20, 0x75, 0xCE, 0xA4, 0xCB, 0x5F, 0x60, 0x96, 
+0xE7, 0xEF, 0x71, 0x1B, 0x1D, 0xF5, 0xD1, 0x96, 
+0xA1, 0x9C, 0x64, 0xFA, 0x2F, 0x88, 0x9C, 0x87, 
+0x53, 0xC5, 0x08, 0xE5, 0x93, 0xD0, 0x6E, 0xDB, 
+0xA1, 0x84, 0x1C, 0x49, 0x79, 0x38, 0x48, 0xDD, 
+0x6B, 0xD0, 0x80, 0x52, 0x51, 0x4A, 0x96, 0x9C, 
+0x4A, 0x48, 0xB3, 0x9B, 0x86, 0x2F, 0xDC, 0x32, 
+0x34, 0x27, 0x04, 0xAB, 0x37, 0xB2, 0x50, 0x6C, 
+0xB8, 
+
+0x86, 0x42, 0xAF, 0x92, 0x97, 0x70, 0x4B, 0x6A, 
+0xC3, 0x85, 0x01, 0xE0, 0xFE, 0x10, 0x0A, 0x58, 
+0xD8, 0xF8, 0x1F, 0x0C, 0xA0, 0xF3, 0x41, 0x40, 
+0x1F, 0x90, 0x54, 0x07, 0x52, 0x34, 0x2C, 0xC8, 
+0x20, 0x3A, 0xA5, 0x1D, 0x12, 0x59, 0xC5, 0xCA, 
+0x3F, 0x60, 0x7E, 0x9F, 0xB0, 0xB3, 0x66, 0x99, 
+0x67, 0xD6, 0x0B, 0x05, 0x54, 0xB5, 0xE3, 0x7E, 
+0xC6, 0x97, 0xF3, 0xD5, 0xC0, 0x73, 0xE9, 0xE2, 
+0x70, 0x20, 0x78, 0x15, 0x74, 0x2F, 0x19, 0xCC, 
+0x0F, 0xA9, 0xCC, 0xE1, 0xAE, 0x60, 0x6A, 0x05, 
+0x97, 0xE4, 0x5A, 0x6F, 0xF9, 0xB6, 0x40, 0x31, 
+0xE1, 0x41, 0xF2, 0x72, 0x3E, 0xB2, 0xEB, 0x78, 
+0x33, 0x7E, 0x0A, 0xB4, 0x39, 0xE3, 0xEE, 0x99, 
+0x17, 0x6C, 0x2F, 0x2F, 0xA8, 0x3C, 0x2C, 0xFC, 
+0x70, 0x53, 0x53, 0x14, 0x82, 0x62, 0xCA, 0x55, 
+0x23, 0x20, 0xFF, 0x7F, 0xC5, 0xE9, 0x28, 0xB9, 
+0xA2, 0x15, 0x82, 0x6B, 0xED, 0x1C, 0xBB, 0xD6, 
+0x74, 0x8E, 0xE5, 0x2F, 0x54, 0x97, 0x05, 0xAB, 
+0x88, 0xF8, 0x6E, 0x5E, 0x45, 0x52, 0x61, 0x3B, 
+0xCD, 0x9F, 0x3E, 0xE0, 0x43, 0x30, 0x37, 0x73, 
+0x50, 0xE3, 0x21, 0x29, 0xAA, 0x48, 0x7B, 0xDE, 
+0x50, 0x2B, 0xBC, 0x34, 0xBF, 0xB3, 0x0F, 0xF7, 
+0xCB, 0x78, 0x4B, 0xDE, 0x7B, 0x1D, 0xAC, 0xFC, 
+0xEB, 0x03, 
+
+0x76, 0xD3, 0x4B, 0xE8, 0x19, 0x32, 0xEE, 0xA7, 
+0xD5, 0x21, 0xE4, 0xFF, 0x60, 0x32, 0xED, 0xE4, 
+0x6D, 0xC3, 0xE2, 0xA4, 0xD2, 0xEC, 0x0B, 0x41, 
+0x8E, 0xF2, 0xE3, 0x83, 0x84, 0x18, 0xE5, 0xF4, 
+0xBA, 0xE7, 0x17, 0xED, 0xF7, 0xF6, 0xC8, 0x6C, 
+0xA9, 0xC7, 0xF7, 0x23, 0x9E, 0x0F, 0xEC, 0x4C, 
+0x51, 0x20, 0xB8, 0xEF, 0x02, 0xCC, 0xFF, 0xBB, 
+0xDE, 0x1C, 0x47, 0x8A, 0xAB, 0x2D, 0xDF, 0xF6, 
+0x86, 0x0B, 0xC8, 0xFB, 0xDE, 0x24, 0x64, 0x1F, 
+0x99, 0x4F, 0x44, 0xC9, 0x78, 0x45, 0xD2, 0x2A, 
+0x7A, 0x3B, 0x1C, 0xC5, 0xD8, 0x87, 0x29, 0xEB, 
+0x5E, 0x02, 0x12, 0x93, 0xF7, 0xED, 0x21, 0xFF, 
+0x50, 0xDB, 0xD9, 0xD4, 0x0B, 0x1C, 0xC9, 0x1D, 
+0xF2, 0x1F, 0x9C, 0x93, 0x2F, 0xDA, 0x90, 0xB0, 
+0xF9, 0x25, 0x4F, 0x25, 0xE7, 0x64, 0xB4, 0xD6, 
+0x78, 0xB3, 0x68, 0xD8, 0x46, 0xCF, 0xE4, 0xC7, 
+0x15, 0x28, 0xC2, 0x34, 0x6E, 0xB2, 0x4F, 0x12, 
+0xD2, 0x89, 0xDE, 0x77, 0xDA, 0x58, 0x40, 0x62, 
+0x8B, 0x55, 0xF7, 0x80, 0x82, 0x3C, 0xC3, 0xAC, 
+0x7C, 0xF4, 0x2E, 0xDD, 0xC4, 0x70, 0x52, 0x63, 
+0x17, 0x7F, 0x44, 0xF7, 0x65, 0x59, 0x3D, 0x91, 
+0x95, 0x18, 0xB0, 0xA4, 0xA0, 0xAF, 0x52, 0x3A, 
+0xD3, 0xC0, 0xDD, 0x0C, 0x00, 0xC1, 0xD7, 0xA6, 
+0x1D, 0x5E, 0x35, 
+
+0xC5, 0x4F, 0x57, 0x22, 0xF0, 0xE2, 0x42, 0xEE, 
+0x03, 0x5C, 0xDE, 0x88, 0x0A, 0x6E, 0xEB, 0xA4, 
+0x41, 0xEF, 0x55, 0x9D, 0x37, 0x21, 0xAE, 0x2A, 
+0xA9, 0x9D, 0x92, 0xDC, 0xCF, 0x5F, 0xD3, 0x28, 
+0x23, 0x5D, 0x22, 0xDB, 0x89, 0x31, 0x4D, 0x60, 
+0x08, 0x92, 0x78, 0x3A, 0x5D, 0xAE, 0x91, 0x1A, 
+0x93, 0xCD, 0x4D, 0x62, 0x40, 0xA2, 0x18, 0x1A, 
+0x6B, 0xCE, 0x34, 0x0D, 0x3B, 0x09, 0xC6, 0xE6, 
+0x3F, 0x1B, 0x88, 0x19, 0x14, 0x29, 0xAD, 0x75, 
+0x06, 0x1B, 0xD9, 0xE2, 0xEC, 0xE7, 0x1B, 0xC9, 
+0x7A, 0xD3, 0x18, 0x86, 0xE7, 0x36, 0xAD, 0x21, 
+0x7D, 0xA2, 0xDC, 0x1C, 0x97, 0xBA, 0xB2, 0xCB, 
+0x59, 0x19, 0x7D, 0x20, 0xC5, 0x68, 0xB8, 0xBD, 
+0x51, 0x49, 0x5B, 0x45, 0xAB, 0x47, 0x33, 0x63, 
+0xA2, 0xEC, 0x4C, 0x2D, 0x69, 0x91, 0x27, 0x86, 
+0x72, 0x28, 0xDB, 0x96, 0x55, 0x5D, 0x1A, 0x2F, 
+0x7E, 0x5D, 0xC6, 0x0A, 0x69, 0xAB, 0x8C, 0x8B, 
+0x36, 0x87, 0xB4, 0x1A, 0x15, 0xE4, 0x68, 0x21, 
+0xDB, 0x65, 0x87, 0xBA, 0x24, 0xBD, 0x1F, 0xFA, 
+0x5C, 0x4B, 0x48, 0x21, 0x83, 0xD8, 0x04, 0x50, 
+0x31, 0x83, 0xF7, 0x21, 0xC5, 0xA8, 0xA9, 0x8A, 
+0x5E, 0xC6, 0x1C, 0x40, 0x8E, 0x29, 0xB3, 0xF2, 
+0xA8, 0x86, 0xED, 0x60, 0xCA, 0xF8, 0x24, 0x7F, 
+0x09, 0xAE, 0x36, 0x3F, 
+
+0x6D, 0x99, 0x39, 0x36, 0xA1, 0x3E, 0x29, 0x65, 
+0x55, 0xA1, 0x90, 0x10, 0xB5, 0x49, 0x64, 0xE3, 
+0x09, 0xB2, 0xDB, 0x65, 0xF1, 0x5E, 0x05, 0x1E, 
+0x0E, 0x6A, 0x8C, 0x38, 0xDF, 0xE1, 0xB8, 0x87, 
+0x15, 0x2C, 0xCB, 0x4D, 0xD7, 0x44, 0xDB, 0x3B, 
+0x80, 0xA2, 0xEA, 0x9A, 0x7A, 0xA8, 0x39, 0xE2, 
+0x33, 0xFC, 0x40, 0xCD, 0x92, 0xB8, 0x62, 0x1D, 
+0x9E, 0xC7, 0x65, 0x65, 0x3C, 0x24, 0x6A, 0x77, 
+0x11, 0xA5, 0xEE, 0x86, 0x19, 0x5E, 0xCF, 0x01, 
+0xF7, 0x74, 0x64, 0xAA, 0xDF, 0xD3, 0x0B, 0x54, 
+0xF8, 0xEB, 0x9F, 0xE5, 0x3B, 0x9B, 0x3A, 0xCB, 
+0x36, 0x65, 0xA6, 0xD3, 0x8B, 0x5D, 0xD3, 0x55, 
+0xC4, 0xD6, 0x26, 0x46, 0x5D, 0x66, 0x4E, 0xEB, 
+0x7F, 0xB3, 0x1F, 0x36, 0x12, 0x92, 0x50, 0xF4, 
+0xE3, 0x2B, 0x90, 0x32, 0xFF, 0x56, 0x90, 0x81, 
+0xC3, 0x52, 0x7A, 0x26, 0x67, 0xA5, 0x4E, 0xC1, 
+0x3B, 0xB9, 0x44, 0x4B, 0x6F, 0x96, 0xD7, 0xD4, 
+0xE9, 0x67, 0x10, 0x36, 0xD8, 0xAB, 0xC3, 0x0C, 
+0x17, 0xCF, 0xC1, 0x38, 0xC9, 0xD6, 0x7B, 0xB9, 
+0xFC, 0xF9, 0x1D, 0x49, 0x87, 0x84, 0xCF, 0x86, 
+0x72, 0xFD, 0x95, 0x15, 0xBF, 0x27, 0x1F, 0xAB, 
+0x64, 0x81, 0xCF, 0x99, 0x96, 0x2E, 0x15, 0x57, 
+0xA5, 0xD7, 0x7D, 0x0E, 0xB9, 0xDC, 0x6A, 0x44, 
+0xFC, 0x80, 0x80, 0x66, 0x2E, 
+
+0x9C, 0x95, 0xB5, 0x62, 0xE3, 0x90, 0x98, 0xF3, 
+0x02, 0x26, 0x65, 0x18, 0x99, 0x4B, 0xCC, 0x67, 
+0x42, 0xCF, 0x7B, 0x21, 0xC4, 0xB7, 0x97, 0x99, 
+0x50, 0xBD, 0x05, 0x49, 0xB4, 0xA3, 0x9E, 0xCA, 
+0x85, 0xD1, 0xD0, 0x65, 0xF3, 0x69, 0x8E, 0x21, 
+0x49, 0xCA, 0x23, 0x89, 0x8F, 0x9A, 0x03, 0x7B, 
+0xB0, 0x55, 0xE4, 0x21, 0x7D, 0x00, 0xC1, 0xFA, 
+0xBC, 0x24, 0x65, 0x5C, 0x83, 0x67, 0x5B, 0x97, 
+0x01, 0xCB, 0x00, 0x82, 0xF2, 0xA1, 0x3E, 0xE8, 
+0xCC, 0xCE, 0x00, 0x5A, 0x79, 0xB5, 0xF7, 0x13, 
+0xD8, 0x9D, 0x57, 0x8F, 0x98, 0x49, 0x80, 0x72, 
+0xC4, 0xA5, 0x7C, 0x03, 0x91, 0x77, 0x1F, 0x82, 
+0x07, 0x42, 0x88, 0x36, 0x00, 0x9B, 0x09, 0x87, 
+0x90, 0xE6, 0x6C, 0x53, 0xF6, 0x3A, 0x5F, 0x6E, 
+0x71, 0xA3, 0xDB, 0x58, 0x49, 0x69, 0xCC, 0x9B, 
+0x69, 0x85, 0x43, 0xFF, 0x1E, 0xEA, 0x53, 0x3C, 
+0xF5, 0xD9, 0x7C, 0x63, 0xF6, 0x85, 0xEB, 0xE0, 
+0xE9, 0x20, 0x6F, 0xB1, 0x5F, 0xFE, 0xEA, 0xF1, 
+0x30, 0x1F, 0x7E, 0x61, 0x10, 0x74, 0x29, 0x06, 
+0xB4, 0x9F, 0xAC, 0xA5, 0x3E, 0xC1, 0xAF, 0xF8, 
+0x6A, 0x3F, 0x7E, 0xDE, 0x75, 0x67, 0xB7, 0x79, 
+0xAB, 0x96, 0x82, 0xD9, 0x52, 0x08, 0xC9, 0xEA, 
+0x1F, 0x69, 0xBF, 0xF6, 0xEA, 0xE2, 0x6F, 0x7B, 
+0xB7, 0x2F, 0xED, 0xA8, 0x50, 0xD4, 
+
+0x5E, 0xAA, 0x52, 0xBD, 0xD4, 0x77, 0xAF, 0x8B, 
+0x18, 0x01, 0xE0, 0xF7, 0xC4, 0xE7, 0x4B, 0x86, 
+0xAB, 0x7D, 0x11, 0xE6, 0x70, 0x98, 0x34, 0x7C, 
+0x53, 0x63, 0x75, 0xFE, 0xE8, 0xB4, 0x7A, 0x95, 
+0x59, 0x0A, 0x4D, 0x78, 0x65, 0x7E, 0x93, 0xEE, 
+0xD0, 0x84, 0x3D, 0xE0, 0xB6, 0xA2, 0x2D, 0x8A, 
+0x36, 0x68, 0x04, 0xB4, 0x84, 0x38, 0x14, 0x1E, 
+0x7A, 0x61, 0x6B, 0x60, 0xA0, 0x4A, 0xE1, 0x7D, 
+0x4B, 0xB8, 0x8F, 0x31, 0x49, 0x66, 0xE2, 0x3E, 
+0x73, 0xE5, 0xE1, 0x73, 0xB9, 0x9A, 0x4D, 0xAD, 
+0xD5, 0xED, 0x42, 0x53, 0x41, 0x42, 0x21, 0x2E, 
+0xC3, 0x36, 0x8B, 0x0A, 0x40, 0xB5, 0xB4, 0x0C, 
+0xB3, 0xF0, 0x53, 0x34, 0xD7, 0xE5, 0xE0, 0x70, 
+0xB1, 0xEA, 0x86, 0x5E, 0x9A, 0x39, 0x30, 0x95, 
+0x84, 0xC7, 0x57, 0x93, 0xC9, 0x7A, 0xF6, 0x10, 
+0x3D, 0x3F, 0x68, 0x33, 0xC3, 0x4F, 0x45, 0x36, 
+0xB0, 0xA4, 0xDF, 0x58, 0xE8, 0xB7, 0x4A, 0x42, 
+0x8C, 0x6D, 0x04, 0xCF, 0x24, 0xA5, 0x69, 0x95, 
+0xDE, 0x04, 0xC3, 0xA8, 0xDD, 0xE3, 0x78, 0x44, 
+0xA0, 0x9F, 0x11, 0x9B, 0xB4, 0xA2, 0x27, 0x7A, 
+0x15, 0xA3, 0x9D, 0x27, 0x80, 0x35, 0xB4, 0x9F, 
+0x33, 0xBE, 0x27, 0x3A, 0xE1, 0x1F, 0x67, 0x97, 
+0x1D, 0x1A, 0x7D, 0x11, 0x59, 0xEF, 0x01, 0x5E, 
+0x8E, 0xD9, 0xA8, 0x16, 0x94, 0x54, 0xD9, 
+
+0x49, 0x52, 0x78, 0x3A, 0x42, 0x33, 0x66, 0xEF, 
+0x71, 0xB1, 0xF3, 0x8B, 0x98, 0xB2, 0x65, 0x8A, 
+0x8A, 0x5D, 0xE8, 0x6B, 0x9B, 0xFB, 0xEF, 0x15, 
+0x86, 0x3C, 0x68, 0x1D, 0x3A, 0x5C, 0xA7, 0xD8, 
+0x3B, 0x33, 0x95, 0xF0, 0x9C, 0xB8, 0xA6, 0x92, 
+0x11, 0x79, 0xB2, 0xD9, 0x3C, 0x18, 0x7F, 0xBB, 
+0xF9, 0xAA, 0x43, 0x01, 0xD1, 0x7D, 0xC7, 0xB7, 
+0x0D, 0x49, 0x44, 0x0D, 0x36, 0x0B, 0xD9, 0xC6, 
+0x54, 0x79, 0x0A, 0x63, 0x79, 0x86, 0xBD, 0x0B, 
+0xC4, 0x03, 0xF5, 0xFC, 0x1E, 0x37, 0xE5, 0x24, 
+0x30, 0x5A, 0x35, 0x1D, 0x13, 0xE8, 0x35, 0x9E, 
+0x42, 0x0F, 0xDA, 0xB1, 0x80, 0xD1, 0xE6, 0xE5, 
+0x7A, 0xD5, 0xFE, 0x66, 0x8B, 0x38, 0xD7, 0x5E, 
+0xD8, 0xE0, 0x67, 0xC0, 0xE0, 0x06, 0x10, 0xE6, 
+0x18, 0xC7, 0x7A, 0xF4, 0xCF, 0xFD, 0xB2, 0xB4, 
+0x1C, 0xF5, 0x46, 0x7D, 0xDF, 0x7C, 0x83, 0xA5, 
+0xAB, 0xC3, 0x7C, 0xE1, 0x3F, 0x0A, 0xA3, 0xAA, 
+0x37, 0x9F, 0x86, 0x8F, 0xAD, 0x26, 0xB8, 0x84, 
+0xF4, 0x91, 0xF7, 0x19, 0x0A, 0x7B, 0x1B, 0x46, 
+0x63, 0x43, 0x08, 0x41, 0x07, 0x0D, 0x19, 0xF1, 
+0xFF, 0x8D, 0x23, 0xF1, 0xE8, 0x42, 0x54, 0x4C, 
+0xBC, 0x89, 0x34, 0xA3, 0x90, 0xDC, 0x8A, 0x9F, 
+0xA0, 0xFD, 0xC9, 0x65, 0xAB, 0x6B, 0x29, 0x71, 
+0x6C, 0xA9, 0xF4, 0x77, 0x50, 0xA5, 0xEA, 0xBB, 
+
+0x9C, 0xB1, 0xAA, 0x0D, 0xDB, 0xC1, 0x1A, 0x9C, 
+0x5C, 0x98, 0x93, 0xD8, 0x01, 0xC7, 0xFE, 0x24, 
+0xBC, 0x60, 0x5F, 0x0D, 0xA4, 0x50, 0xC2, 0x9D, 
+0x11, 0x34, 0xDE, 0x31, 0xE9, 0xD2, 0x45, 0x49, 
+0x6F, 0xC2, 0x91, 0x8C, 0x75, 0x1D, 0xB8, 0xD8, 
+0x27, 0xA1, 0x5F, 0xF2, 0x66, 0xBA, 0xD2, 0x5C, 
+0xD6, 0xBB, 0x5B, 0x45, 0x57, 0x08, 0xF3, 0x0A, 
+0x5C, 0x58, 0xAA, 0x0B, 0x79, 0x12, 0x2F, 0x2D, 
+0x42, 0x37, 0xCD, 0x5C, 0xB3, 0xF1, 0x8F, 0x34, 
+0xE2, 0x61, 0xC3, 0x45, 0xFC, 0xFF, 0x96, 0x53, 
+0x0B, 0x1A, 0x71, 0xB5, 0x5F, 0xA2, 0x12, 0x26, 
+0x78, 0xCC, 0xBD, 0x5A, 0x84, 0x35, 0xAE, 0xF2, 
+0xA3, 0xC3, 0x42, 0x5F, 0x51, 0x22, 0x77, 0x91, 
+0x08, 0xDF, 0x1C, 0x68, 0xAE, 0x0F, 0x51, 0x79, 
+0x4E, 0x75, 0xED, 0x8D, 0x55, 0x2A, 0x54, 0x88, 
+0x97, 0xF8, 0x90, 0x24, 0x14, 0xE3, 0x07, 0x4D, 
+0xCD, 0x26, 0x06, 0xA7, 0x35, 0xCF, 0x6A, 0x38, 
+0xB7, 0x98, 0x2F, 0xA8, 0xAE, 0x6C, 0x3D, 0xB5, 
+0x1E, 0x37, 0xD1, 0xE9, 0xD4, 0x53, 0x67, 0x4B, 
+0xA4, 0xAE, 0xFA, 0x51, 0x01, 0x40, 0x8B, 0xD1, 
+0x09, 0x74, 0x4C, 0x7F, 0xB2, 0xFA, 0xF9, 0xA8, 
+0x27, 0xAF, 0xC8, 0x24, 0x2C, 0x31, 0xA1, 0xF6, 
+0xF8, 0x81, 0xAC, 0xB4, 0x02, 0x83, 0x6F, 0x49, 
+0xCF, 0xEC, 0xDF, 0x71, 0x5E, 0x2F, 0x33, 0x07, 
+0xBA, 
+
+0x55, 0xA8, 0x19, 0x38, 0x4C, 0x45, 0x5C, 0xF7, 
+0x03, 0x9A, 0x21, 0x1B, 0xFF, 0xA8, 0xF7, 0x76, 
+0x3E, 0xF2, 0x8E, 0xF1, 0xCD, 0x69, 0xDB, 0x0F, 
+0xF6, 0x1C, 0x6A, 0x92, 0x71, 0xEF, 0x4A, 0x3E, 
+0xCE, 0xF9, 0xEA, 0xAB, 0x76, 0x6B, 0x1E, 0xB5, 
+0x02, 0x61, 0xA7, 0x02, 0x76, 0xBA, 0x6B, 0x1D, 
+0xE5, 0xF0, 0x12, 0xDF, 0x1D, 0x61, 0x82, 0x90, 
+0x91, 0xDA, 0x96, 0x60, 0x89, 0x88, 0x83, 0xCD, 
+0xBF, 0xAC, 0xA8, 0x86, 0xC4, 0x7F, 0xA5, 0xA3, 
+0x3E, 0x50, 0xC4, 0x8B, 0x30, 0xD8, 0x98, 0x89, 
+0x0E, 0x10, 0xA3, 0x77, 0x64, 0xBF, 0x44, 0xE8, 
+0x7B, 0xF4, 0x56, 0xA2, 0x87, 0x06, 0xF6, 0xF0, 
+0x98, 0x77, 0x92, 0x5D, 0xED, 0x6A, 0x29, 0x33, 
+0x22, 0x31, 0xD5, 0x3A, 0xD4, 0xDB, 0x11, 0x34, 
+0x7D, 0xA0, 0xFC, 0x96, 0x54, 0x61, 0x16, 0x2C, 
+0x48, 0x6C, 0x55, 0x2B, 0xE0, 0x8C, 0xE3, 0x7F, 
+0x05, 0x32, 0xFB, 0xCE, 0xD4, 0x2F, 0x47, 0x54, 
+0xE5, 0x9D, 0x6B, 0x68, 0x85, 0xF0, 0xD6, 0xCD, 
+0xEA, 0xB6, 0x48, 0x59, 0xA8, 0xE6, 0x0B, 0x80, 
+0x21, 0x2A, 0x62, 0x74, 0x1E, 0xD9, 0x11, 0x9A, 
+0x16, 0x8A, 0xB0, 0xC5, 0x2C, 0xF2, 0x77, 0x80, 
+0x0D, 0x74, 0x24, 0xE9, 0x29, 0x65, 0x47, 0x1F, 
+0xEE, 0xF0, 0xA4, 0x26, 0xAF, 0xC5, 0x4B, 0x83, 
+0x67, 0x23, 0x7C, 0x2E, 0x54, 0x29, 0x88, 0xD0, 
+0x6A, 0xCB, 
+
+0x35, 0x07, 0x1D, 0x30, 0xAA, 0x5F, 0xC8, 0x44, 
+0xDA, 0x6D, 0xB6, 0xCB, 0x0B, 0xD6, 0x54, 0x54, 
+0x48, 0x98, 0x07, 0xE6, 0xB0, 0x33, 0x48, 0xCF, 
+0xB7, 0xAF, 0x58, 0xF6, 0x2A, 0x09, 0xD2, 0x7A, 
+0xB5, 0xB4, 0x93, 0x6C, 0xC3, 0x83, 0xFB, 0x17, 
+0xE5, 0xBF, 0xDD, 0xE3, 0x10, 0x37, 0x69, 0xF9, 
+0x90, 0xEE, 0x65, 0x1E, 0x8F, 0x82, 0x14, 0xC5, 
+0x38, 0xF3, 0x96, 0x22, 0x63, 0x81, 0xD4, 0x7A, 
+0x23, 0x2E, 0x9A, 0xFD, 0xD4, 0xBB, 0xE1, 0x56, 
+0xDD, 0xB6, 0xD0, 0x77, 0x0C, 0x88, 0x50, 0x18, 
+0xE3, 0x25, 0xBC, 0x33, 0x36, 0xB0, 0xD9, 0x65, 
+0xD2, 0xC2, 0xAA, 0x26, 0xB4, 0x52, 0xEF, 0x8B, 
+0x19, 0x74, 0x14, 0x69, 0xB9, 0x60, 0x0B, 0x59, 
+0x2C, 0xC7, 0x74, 0x0F, 0x6B, 0xF7, 0x5C, 0x4F, 
+0xC7, 0xC8, 0xED, 0xFF, 0x1F, 0x02, 0x46, 0x46, 
+0x93, 0x38, 0x6F, 0x34, 0x2A, 0x0C, 0xEC, 0x3E, 
+0x3F, 0xAA, 0xA0, 0xEB, 0x43, 0x24, 0x84, 0x55, 
+0xAF, 0x43, 0x08, 0x0B, 0xDD, 0x0D, 0x9D, 0x01, 
+0x5C, 0x47, 0x27, 0x47, 0x3A, 0x0D, 0xA8, 0x27, 
+0xAE, 0x04, 0x25, 0x27, 0x5D, 0xF6, 0x19, 0x6B, 
+0x2E, 0x5A, 0x1B, 0x70, 0xBD, 0xB3, 0x44, 0x23, 
+0x59, 0x4F, 0xE4, 0xA0, 0x02, 0x78, 0x22, 0xA6, 
+0x25, 0xA8, 0x55, 0x59, 0xB5, 0xA0, 0x60, 0x47, 
+0x57, 0x1F, 0x62, 0xC2, 0x16, 0x38, 0x7D, 0x86, 
+0xB9, 0x0F, 0x8A, 
+
+0xDD, 0x6E, 0xDE, 0x6B, 0x5B, 0x0B, 0xEA, 0xD1, 
+0xB1, 0xB9, 0xD8, 0xA3, 0xCE, 0xE3, 0x95, 0x79, 
+0x16, 0x68, 0x90, 0x54, 0x7A, 0xC4, 0x4E, 0x77, 
+0x95, 0xD6, 0xBE, 0xAF, 0x0E, 0xE0, 0x88, 0xDA, 
+0x28, 0xF2, 0xEA, 0x52, 0x77, 0xFE, 0x7F, 0xAA, 
+0x3D, 0xD4, 0xCA, 0x1B, 0xBC, 0xAC, 0xC5, 0x0D, 
+0xE2, 0x50, 0x70, 0x57, 0x12, 0x14, 0x54, 0x97, 
+0x22, 0x3E, 0x43, 0x82, 0x0F, 0xA1, 0xA8, 0x93, 
+0x4A, 0xD3, 0x7E, 0xE1, 0x1E, 0xBE, 0xD5, 0x3E, 
+0x36, 0x61, 0xB1, 0x0A, 0x85, 0x6F, 0x3B, 0xF4, 
+0x60, 0x39, 0xEE, 0xE5, 0x81, 0x88, 0xC2, 0x6E, 
+0x24, 0xAC, 0xBD, 0x0C, 0x25, 0xE7, 0xAB, 0xB0, 
+0xC8, 0xA1, 0x8F, 0x09, 0xE3, 0xCA, 0x77, 0xE3, 
+0x50, 0x80, 0x6A, 0x42, 0x94, 0x2C, 0x43, 0x85, 
+0x71, 0xB8, 0x4A, 0xCC, 0xBA, 0x0C, 0x5D, 0xBF, 
+0x2C, 0xF8, 0x36, 0x5D, 0x79, 0xFB, 0x3C, 0x11, 
+0x15, 0x7C, 0x47, 0xDD, 0xC7, 0x26, 0x71, 0x44, 
+0xAF, 0x17, 0xAE, 0x74, 0xF1, 0xC5, 0xE0, 0x00, 
+0x5C, 0xF0, 0x96, 0x38, 0x86, 0x1A, 0x7F, 0xA1, 
+0xBC, 0x0E, 0x32, 0xCA, 0x54, 0xBF, 0x5F, 0xEA, 
+0x76, 0x31, 0x1B, 0x1B, 0x70, 0xE5, 0xB9, 0xA8, 
+0x18, 0x58, 0xF4, 0x31, 0x92, 0xDD, 0x25, 0xB6, 
+0xF5, 0xC1, 0x6B, 0x0D, 0xFC, 0x97, 0x29, 0xD1, 
+0x6F, 0xE2, 0xCD, 0xC6, 0x41, 0x24, 0x28, 0x68, 
+0x42, 0x23, 0x34, 0xF5, 
+
+0xC7, 0xD2, 0x23, 0x12, 0x22, 0x4E, 0x47, 0x8E, 
+0xB9, 0x0E, 0x31, 0x80, 0xA3, 0xB3, 0x72, 0xE1, 
+0xB4, 0xE6, 0x43, 0x4C, 0x9C, 0xDE, 0xBD, 0x34, 
+0x79, 0xD8, 0x2D, 0x82, 0xA2, 0x79, 0x64, 0x54, 
+0x13, 0x9C, 0xFC, 0x21, 0x3A, 0x7C, 0xFC, 0x84, 
+0x87, 0xB6, 0x94, 0xD8, 0x8E, 0x6A, 0xBD, 0xA7, 
+0xC5, 0x2E, 0xC6, 0x88, 0x46, 0xF9, 0xA3, 0xFE, 
+0x66, 0x6D, 0x10, 0xB3, 0x07, 0x27, 0xDE, 0xDC, 
+0x84, 0xEC, 0xFB, 0x15, 0xC7, 0x6C, 0x9E, 0x3E, 
+0x03, 0x41, 0x29, 0x8F, 0x06, 0xDB, 0xF1, 0x5E, 
+0x21, 0xB7, 0x5E, 0x42, 0x9C, 0x7D, 0x21, 0xC8, 
+0x78, 0x5E, 0x6E, 0x19, 0x77, 0x21, 0x41, 0xF6, 
+0x5D, 0x2F, 0x6A, 0xC1, 0xCB, 0xB8, 0x62, 0x4B, 
+0xDD, 0xD4, 0xBE, 0x2E, 0xAD, 0x05, 0x6E, 0x4F, 
+0x96, 0x4C, 0x02, 0x5D, 0x75, 0xE7, 0x0E, 0x50, 
+0xA5, 0x45, 0xB3, 0x47, 0xAC, 0xAE, 0xED, 0x0C, 
+0x94, 0x6A, 0x5E, 0x4A, 0x66, 0xE9, 0x2A, 0x2A, 
+0x5B, 0x00, 0xA7, 0xEC, 0xDE, 0xE7, 0xE8, 0xAA, 
+0x01, 0x51, 0x4F, 0x2B, 0x67, 0x95, 0x2E, 0xA7, 
+0xE3, 0x05, 0x9D, 0x45, 0x40, 0x68, 0x7F, 0xBB, 
+0x98, 0xCB, 0x54, 0x8C, 0xEB, 0x29, 0xAD, 0xE2, 
+0xA6, 0x8C, 0xBB, 0x33, 0xCD, 0xB2, 0x79, 0x6E, 
+0x37, 0x01, 0xEB, 0xF6, 0x19, 0x70, 0x08, 0x08, 
+0x17, 0x4F, 0x8E, 0x45, 0x2A, 0x8A, 0x97, 0x91, 
+0x8D, 0x4F, 0x53, 0x4B, 0x3F, 
+
+0xCA, 0x0D, 0x28, 0x4B, 0x0A, 0x4D, 0xD4, 0x03, 
+0xDA, 0xAA, 0x7E, 0x33, 0x7D, 0x98, 0x9D, 0xE0, 
+0x5B, 0x29, 0x94, 0x19, 0xA4, 0xD8, 0xC7, 0xF8, 
+0x11, 0xF0, 0xD9, 0x18, 0x9A, 0x17, 0x1A, 0x14, 
+0xE1, 0x4E, 0x4F, 0x09, 0x45, 0x7D, 0x17, 0xE9, 
+0xB7, 0xA2, 0x6B, 0x0A, 0xE6, 0x17, 0x57, 0x05, 
+0x24, 0xDC, 0xC6, 0x75, 0xAE, 0xBC, 0xAE, 0x32, 
+0x1B, 0x60, 0x5A, 0x5D, 0x77, 0xB4, 0x42, 0x48, 
+0x99, 0xE7, 0xB5, 0xEB, 0xE5, 0x7A, 0x10, 0x93, 
+0x5D, 0x81, 0xCA, 0x01, 0x2F, 0x41, 0xD4, 0x0F, 
+0xDE, 0xBE, 0xC4, 0x59, 0xC3, 0xDB, 0xDE, 0xF3, 
+0x14, 0xFC, 0xC5, 0x8B, 0x22, 0xCC, 0x6A, 0x3F, 
+0x0C, 0xCC, 0x53, 0x3D, 0x39, 0x0C, 0x83, 0xED, 
+0x51, 0x54, 0x4A, 0xD9, 0x2D, 0x0C, 0x5E, 0x1D, 
+0x0B, 0x82, 0x73, 0x0C, 0x41, 0xCD, 0x8D, 0xD0, 
+0xD9, 0xD3, 0x7C, 0x8D, 0x3D, 0xFA, 0xA9, 0x51, 
+0xE5, 0x13, 0x8B, 0x81, 0x32, 0x62, 0xAB, 0xB6, 
+0xB1, 0xF2, 0x1C, 0x5C, 0xE6, 0x9A, 0xB0, 0x83, 
+0x40, 0x92, 0xB8, 0x85, 0x22, 0x7E, 0x53, 0x9A, 
+0x0F, 0x87, 0xDD, 0xBA, 0x20, 0x83, 0xE4, 0x93, 
+0xDE, 0x35, 0x8E, 0xBD, 0x2F, 0xA3, 0x04, 0xF2, 
+0x87, 0x62, 0x9E, 0xD9, 0x72, 0xBC, 0xC7, 0x0C, 
+0x8F, 0x6F, 0x48, 0x93, 0x0D, 0x48, 0x9B, 0x21, 
+0xA7, 0xD4, 0x12, 0x0C, 0x06, 0x61, 0x9D, 0xC2, 
+0x45, 0x51, 0x17, 0x6D, 0xD2, 0x95, 
+
+0x15, 0x94, 0x79, 0xF5, 0xEB, 0x7F, 0xDD, 0x18, 
+0x2E, 0x9C, 0x4B, 0xA0, 0xD2, 0x3D, 0xEF, 0x37, 
+0x82, 0xDB, 0xDC, 0xFB, 0x8D, 0x07, 0x87, 0x30, 
+0x89, 0x79, 0x31, 0xB1, 0x27, 0xAF, 0x6B, 0x9B, 
+0x0C, 0xAB, 0xA3, 0x72, 0xA4, 0xE3, 0x96, 0x7B, 
+0x61, 0x4F, 0xB4, 0x0D, 0xD1, 0x64, 0x9D, 0xD5, 
+0x3D, 0x0F, 0x24, 0x3C, 0xFF, 0x39, 0xA3, 0x08, 
+0x7E, 0xD0, 0x4A, 0x14, 0xF2, 0x16, 0x08, 0x12, 
+0x58, 0x6F, 0xE4, 0xC3, 0xEE, 0x77, 0xDA, 0xF0, 
+0x21, 0x0E, 0x8A, 0xFB, 0x19, 0x46, 0x08, 0xCF, 
+0x47, 0x93, 0x76, 0xE8, 0x15, 0x7F, 0x07, 0xC7, 
+0x44, 0x4A, 0x35, 0xA9, 0x65, 0x58, 0xF6, 0x21, 
+0xCA, 0x2E, 0xAD, 0xE1, 0x21, 0xE2, 0x25, 0x8F, 
+0x87, 0xA4, 0xFD, 0xE1, 0xEF, 0xE9, 0x25, 0x70, 
+0x7E, 0x41, 0x4D, 0x5A, 0x20, 0xC6, 0x0C, 0xD4, 
+0xE5, 0x49, 0xF3, 0xB9, 0xDB, 0xE5, 0xCE, 0x21, 
+0x1D, 0xE5, 0xA9, 0x73, 0x5E, 0x91, 0xFA, 0x86, 
+0x5A, 0x25, 0x52, 0x27, 0xF5, 0x4C, 0x92, 0xC1, 
+0x14, 0x52, 0x0E, 0x78, 0x6E, 0xCD, 0x8F, 0x0F, 
+0xD5, 0x40, 0x42, 0x01, 0xD1, 0x6A, 0xD2, 0xCE, 
+0xD8, 0xC0, 0x6A, 0x3E, 0x82, 0xF0, 0x41, 0x0D, 
+0x44, 0x24, 0xE2, 0x43, 0xA3, 0xE8, 0x68, 0x12, 
+0x46, 0x2E, 0x7A, 0x72, 0x04, 0xE0, 0xE6, 0x38, 
+0x4D, 0x62, 0x7F, 0x02, 0x59, 0x17, 0x8F, 0xB9, 
+0x75, 0x74, 0x25, 0x7F, 0x95, 0xBF, 0x9C, 
+
+0xC5, 0x38, 0x2D, 0xA3, 0x3D, 0xF8, 0x27, 0x28, 
+0x56, 0x3E, 0x35, 0x24, 0x8D, 0x8F, 0x56, 0xBD, 
+0xA9, 0x6D, 0x58, 0x99, 0x12, 0xC7, 0xAB, 0x4A, 
+0xE0, 0xF7, 0xDE, 0x1E, 0xF0, 0x40, 0x89, 0x0C, 
+0xE4, 0x7B, 0xF8, 0x7B, 0x8C, 0x1B, 0x6F, 0xC1, 
+0xD4, 0x6E, 0xEF, 0x45, 0x2E, 0x7E, 0x03, 0x0F, 
+0x3B, 0x0F, 0x48, 0x28, 0x8D, 0x8C, 0xAF, 0x9E, 
+0xB3, 0x55, 0x6B, 0xA7, 0xAA, 0x03, 0x94, 0x21, 
+0xED, 0x7A, 0x92, 0x81, 0x12, 0x37, 0xAF, 0x4B, 
+0xE1, 0x34, 0x62, 0xC6, 0x7D, 0x5E, 0xA8, 0xD6, 
+0x9A, 0xB8, 0x12, 0xF0, 0xB8, 0x7E, 0x92, 0x88, 
+0xDB, 0x67, 0x97, 0x56, 0x88, 0xA4, 0x22, 0x18, 
+0xFC, 0xC3, 0xCD, 0x1B, 0x12, 0x09, 0xC9, 0xD6, 
+0x71, 0xE1, 0x76, 0xED, 0x95, 0x61, 0x96, 0xF9, 
+0xB1, 0xFA, 0x16, 0x9F, 0xDC, 0x3A, 0x3C, 0x9A, 
+0xFB, 0x25, 0x0A, 0x0C, 0x8B, 0xFB, 0xD1, 0x7E, 
+0x13, 0x27, 0x5B, 0x24, 0x95, 0xC4, 0x6B, 0xAC, 
+0x32, 0xB5, 0x1D, 0xC9, 0x96, 0x67, 0x84, 0x43, 
+0x5C, 0xAD, 0xD2, 0xF2, 0x15, 0xDA, 0x83, 0x84, 
+0x71, 0xB2, 0x14, 0x04, 0x20, 0xDD, 0x04, 0x78, 
+0x6E, 0xCD, 0x7F, 0x73, 0xD1, 0xAD, 0xBE, 0xD4, 
+0x4F, 0xED, 0xF8, 0xE9, 0xEA, 0x07, 0xBD, 0xB7, 
+0xDD, 0x0F, 0xFB, 0xF5, 0x88, 0x6A, 0x3E, 0x8A, 
+0xCD, 0x79, 0xBA, 0xDE, 0x98, 0xB3, 0x74, 0x3D, 
+0x0F, 0x92, 0x40, 0x10, 0xE4, 0x26, 0x85, 0x2D, 
+
+0x0E, 0x46, 0xBD, 0x8B, 0xDC, 0xE8, 0x28, 0x6A, 
+0x31, 0x78, 0xE6, 0xDE, 0x37, 0xC0, 0x68, 0x2D, 
+0xE0, 0x38, 0xD0, 0x2F, 0x28, 0xBB, 0xA2, 0xF4, 
+0x8F, 0x82, 0x35, 0xDF, 0x0F, 0xB9, 0x31, 0x98, 
+0xF6, 0x03, 0x66, 0x80, 0xCF, 0xDA, 0xF0, 0x0B, 
+0x4C, 0x62, 0x2F, 0x6E, 0x7A, 0x1B, 0x80, 0x98, 
+0x7A, 0xDB, 0x1F, 0x2B, 0xC2, 0x2F, 0x96, 0x80, 
+0x58, 0x98, 0x21, 0xFC, 0xBB, 0x1A, 0x13, 0xAF, 
+0x56, 0xA5, 0x4A, 0x89, 0xDB, 0xF0, 0x28, 0x97, 
+0x43, 0xB6, 0x45, 0xBF, 0x58, 0x76, 0x78, 0x8A, 
+0x83, 0xF8, 0x6E, 0xC1, 0x90, 0x99, 0x59, 0x0A, 
+0xF2, 0x1E, 0x95, 0x8A, 0x13, 0x58, 0xC0, 0xC7, 
+0x8F, 0x27, 0xD9, 0x53, 0xEC, 0xD0, 0x24, 0xFC, 
+0x83, 0x60, 0x74, 0x24, 0x2D, 0xD5, 0xE5, 0x51, 
+0x27, 0x0E, 0x62, 0x17, 0xD4, 0xAA, 0xF1, 0x30, 
+0x6F, 0x35, 0x4C, 0xE0, 0x91, 0x2D, 0xDA, 0x11, 
+0x68, 0xDF, 0x6F, 0xEA, 0x29, 0xC0, 0xB4, 0x75, 
+0x04, 0x4E, 0xB7, 0x49, 0x47, 0x19, 0xA1, 0x7F, 
+0x99, 0x0B, 0x45, 0x95, 0x3F, 0x6A, 0x97, 0x8B, 
+0xA8, 0x27, 0x1C, 0xBF, 0x07, 0x7D, 0x30, 0xD9, 
+0x97, 0xD7, 0xA5, 0x2D, 0x22, 0x23, 0xF7, 0x1D, 
+0xFD, 0x4A, 0xE9, 0xA8, 0xFD, 0x10, 0xE4, 0xEF, 
+0x0F, 0xBB, 0x54, 0x2B, 0xFC, 0x74, 0xDE, 0x55, 
+0xF7, 0x51, 0xBF, 0x8B, 0x73, 0x38, 0xF8, 0x5E, 
+0xFF, 0x8C, 0x96, 0xFF, 0xFA, 0x82, 0x67, 0xC7, 
+0xA6, 
+
+0x78, 0xC3, 0xA1, 0xCB, 0x49, 0x80, 0x93, 0x83, 
+0x6D, 0x3D, 0x8C, 0xB6, 0x38, 0x79, 0x45, 0xCF, 
+0x65, 0x32, 0x71, 0xE6, 0x00, 0x24, 0x23, 0x45, 
+0x47, 0x37, 0x84, 0x54, 0x1B, 0x7E, 0x25, 0x5B, 
+0x86, 0xA5, 0x55, 0x07, 0xB4, 0x0B, 0xCD, 0x23, 
+0x6B, 0xF3, 0x33, 0xCB, 0xCA, 0x7C, 0xBC, 0x7F, 
+0x43, 0x50, 0x02, 0xA2, 0x45, 0x1C, 0xD3, 0x32, 
+0x7C, 0x28, 0x6D, 0xA1, 0x24, 0x2A, 0x58, 0x29, 
+0xC1, 0xB2, 0x6C, 0x71, 0x1A, 0xA4, 0xA9, 0xB3, 
+0x27, 0xAB, 0xBC, 0xC6, 0x9F, 0x9D, 0x92, 0xB6, 
+0x70, 0x99, 0xA6, 0x9B, 0xAD, 0x8A, 0x59, 0xC9, 
+0x08, 0xE0, 0xFB, 0x92, 0xB0, 0x0B, 0x03, 0x75, 
+0xBA, 0xC0, 0x88, 0x56, 0xF2, 0x8B, 0xCD, 0x22, 
+0xB8, 0x2B, 0xEA, 0xD8, 0x79, 0xD1, 0xA5, 0xF4, 
+0x98, 0xEF, 0x71, 0xE9, 0xFE, 0xBC, 0x0F, 0xEA, 
+0x5B, 0x8D, 0x96, 0xF6, 0xB7, 0x03, 0xEF, 0xA6, 
+0x61, 0x05, 0x8F, 0xB3, 0x0D, 0xB0, 0xCA, 0xC1, 
+0xCF, 0x25, 0xB2, 0x87, 0x98, 0x13, 0xB8, 0xEC, 
+0xA8, 0x54, 0xF6, 0xF5, 0x5F, 0xB2, 0x11, 0x52, 
+0x14, 0xD7, 0x9E, 0x36, 0x93, 0x62, 0x3B, 0x24, 
+0x92, 0x9C, 0x63, 0xB7, 0x2D, 0x18, 0xAA, 0x10, 
+0x89, 0x84, 0x29, 0x16, 0xF0, 0xDF, 0x7C, 0x31, 
+0x0C, 0x3E, 0x25, 0xB3, 0x72, 0x50, 0xE3, 0x51, 
+0xDC, 0x12, 0x58, 0xA7, 0xED, 0x38, 0x43, 0x92, 
+0x1B, 0xA5, 0x54, 0xE1, 0xC6, 0x56, 0x30, 0x65, 
+0x0A, 0xA5, 
+
+0x02, 0xF5, 0xEC, 0x03, 0x39, 0xF9, 0x74, 0xC8, 
+0xAC, 0xC7, 0xF7, 0xE5, 0x62, 0x30, 0xB3, 0x0E, 
+0xBA, 0x02, 0xD6, 0x48, 0x76, 0x84, 0xDC, 0x9F, 
+0xF0, 0x79, 0x39, 0x06, 0xA9, 0x82, 0x53, 0x00, 
+0xAB, 0x09, 0x0C, 0x16, 0xDE, 0xC1, 0x05, 0xC8, 
+0x2C, 0x92, 0x02, 0x61, 0xEA, 0x87, 0x8C, 0xFC, 
+0x8A, 0x0B, 0x9F, 0x04, 0x9F, 0x51, 0x5F, 0x7B, 
+0x4F, 0xAC, 0xF1, 0x4F, 0x9A, 0x29, 0x61, 0x42, 
+0x8C, 0xD7, 0x23, 0x49, 0x63, 0xEB, 0xFD, 0x2C, 
+0xDC, 0x76, 0xF1, 0xF0, 0xC8, 0xA2, 0x97, 0x5C, 
+0x20, 0x23, 0x7C, 0x9F, 0x5D, 0xDE, 0x59, 0x87, 
+0x3A, 0x33, 0xE6, 0x77, 0x83, 0xE8, 0xF7, 0xA8, 
+0x31, 0x7E, 0xFA, 0x02, 0x79, 0x96, 0x13, 0xCB, 
+0x08, 0xB3, 0x0E, 0x18, 0x2E, 0x2C, 0x48, 0x1B, 
+0x8D, 0xF7, 0x40, 0x0F, 0xD0, 0x95, 0x29, 0x99, 
+0xA9, 0x8C, 0xF5, 0xBA, 0xC3, 0xE7, 0x1E, 0x3F, 
+0x34, 0x13, 0x02, 0x24, 0xF0, 0x4E, 0xC6, 0xCD, 
+0xEE, 0xA1, 0xF0, 0x0F, 0xAE, 0xB8, 0x31, 0x0F, 
+0x5F, 0x34, 0x4F, 0xAC, 0x17, 0x52, 0x26, 0x84, 
+0xA0, 0x65, 0x88, 0x2E, 0xA2, 0x75, 0xC0, 0x18, 
+0x6A, 0x2C, 0xDD, 0x0F, 0xF9, 0x0E, 0xFA, 0x56, 
+0xBB, 0x71, 0xAD, 0x69, 0xCB, 0x96, 0x31, 0xCF, 
+0x66, 0x1C, 0x87, 0x71, 0x77, 0x11, 0x39, 0xB7, 
+0xE9, 0x25, 0x7A, 0x88, 0x8A, 0x0C, 0xE9, 0xCD, 
+0x0F, 0xB9, 0x82, 0x18, 0x66, 0x2A, 0xD1, 0x91, 
+0xD9, 0x4F, 0x8D, 
+
+0xE9, 0xF8, 0x7F, 0xAE, 0x1B, 0x66, 0x68, 0x95, 
+0x73, 0xE2, 0x74, 0x01, 0xE1, 0x7D, 0x2A, 0x45, 
+0xC0, 0x88, 0x27, 0xB0, 0x1A, 0x6F, 0xD0, 0x5E, 
+0xA6, 0x05, 0x9D, 0x18, 0xB8, 0xA2, 0x86, 0x0E, 
+0xB3, 0xF7, 0x79, 0xC3, 0x41, 0xBE, 0x1D, 0x34, 
+0x69, 0x19, 0x07, 0x2F, 0x81, 0x48, 0xFC, 0xC1, 
+0x35, 0xA4, 0x98, 0xFF, 0xAA, 0xA7, 0xF9, 0xC7, 
+0x32, 0x63, 0xBB, 0x56, 0xA7, 0xBE, 0x31, 0x88, 
+0xA1, 0x35, 0x57, 0x17, 0x1B, 0x00, 0x5A, 0x0C, 
+0xAF, 0x48, 0xE8, 0xA6, 0x63, 0x5F, 0x6B, 0xB9, 
+0x4D, 0x44, 0x0E, 0xCB, 0xA2, 0xDB, 0x7E, 0xBD, 
+0xC1, 0x99, 0x7A, 0x02, 0xAE, 0xDF, 0x01, 0xA6, 
+0x19, 0x8A, 0xA0, 0x91, 0xA8, 0x4E, 0x34, 0xF6, 
+0x4A, 0x53, 0xD3, 0x44, 0xF6, 0x3C, 0x5A, 0x2D, 
+0x16, 0xAD, 0x5F, 0x09, 0x0B, 0xE2, 0xE8, 0xD0, 
+0xFE, 0x09, 0x6F, 0x7A, 0xDB, 0x86, 0x57, 0xA9, 
+0x7B, 0x06, 0x7D, 0xC5, 0x99, 0x4C, 0xA5, 0xE2, 
+0xEA, 0x18, 0x0A, 0xFD, 0xB6, 0xF2, 0x03, 0xEA, 
+0xEC, 0xAF, 0x7B, 0xA1, 0x9C, 0x31, 0xDF, 0x17, 
+0x74, 0x6D, 0x01, 0x9E, 0xBF, 0x76, 0x63, 0x2D, 
+0x5B, 0xA0, 0xF6, 0xD1, 0xC2, 0x01, 0x40, 0xDF, 
+0xE1, 0x81, 0xD6, 0xCB, 0x67, 0xC7, 0x96, 0x6C, 
+0xE6, 0x34, 0x9E, 0xA5, 0x23, 0xCA, 0x9B, 0x06, 
+0x96, 0x45, 0xFA, 0xB8, 0x96, 0x3A, 0x66, 0x32, 
+0x5F, 0xF1, 0x92, 0xA1, 0x64, 0xF8, 0x2B, 0x90, 
+0x65, 0x5D, 0xD7, 0xF8, 
+
+0x8F, 0xEC, 0x60, 0x90, 0x57, 0x5B, 0x8B, 0x0F, 
+0xF5, 0xF9, 0xF1, 0xA6, 0x11, 0x9A, 0xE5, 0x47, 
+0x50, 0x3A, 0x7D, 0x92, 0x1C, 0x2D, 0xBE, 0x3F, 
+0x0B, 0x75, 0x18, 0x78, 0xD1, 0x29, 0xBA, 0x37, 
+0x3F, 0xD6, 0x89, 0xE6, 0x14, 0x54, 0x58, 0x70, 
+0x0F, 0x17, 0x62, 0x4E, 0xE3, 0x8E, 0xC0, 0x18, 
+0x7B, 0x5C, 0xCB, 0x31, 0x95, 0x16, 0x9E, 0xEC, 
+0x0B, 0x7B, 0xF4, 0x1D, 0xC6, 0x30, 0xB4, 0xBD, 
+0xAA, 0x29, 0x33, 0x94, 0xCC, 0x30, 0x98, 0x44, 
+0x20, 0x4E, 0xF3, 0x50, 0x9C, 0x75, 0x5C, 0xB0, 
+0xB9, 0x1F, 0x60, 0xDF, 0xFA, 0x57, 0xAF, 0xC1, 
+0x69, 0x50, 0xFD, 0x09, 0x9B, 0x62, 0xEB, 0xE5, 
+0x9C, 0x09, 0xAB, 0x83, 0x34, 0xDE, 0x9F, 0x48, 
+0xF7, 0x2B, 0x21, 0xB7, 0xC5, 0x90, 0x15, 0xFF, 
+0x9D, 0x5F, 0xD7, 0x5A, 0x4F, 0x6D, 0xB7, 0x8C, 
+0xD5, 0x74, 0x17, 0xEB, 0xD2, 0xF5, 0xE2, 0xCF, 
+0x6E, 0xE0, 0xE3, 0xCD, 0xD7, 0x11, 0xD7, 0xA6, 
+0x89, 0xF4, 0xDE, 0xEF, 0xEB, 0x77, 0xCD, 0xA3, 
+0x2C, 0x1F, 0xDF, 0xED, 0x93, 0xA6, 0x51, 0xC8, 
+0xD4, 0x45, 0x72, 0x11, 0x7F, 0xC8, 0x68, 0x86, 
+0x4B, 0x6E, 0x13, 0x92, 0x2B, 0xDA, 0x6F, 0xE4, 
+0x86, 0xBB, 0xF4, 0x71, 0x8E, 0xA4, 0x2A, 0x55, 
+0x3C, 0xC6, 0x37, 0x67, 0x96, 0xFF, 0x40, 0xF9, 
+0x1E, 0xDD, 0x95, 0x5A, 0x89, 0x0C, 0x07, 0x59, 
+0x90, 0x4F, 0xCF, 0x83, 0xE8, 0x0B, 0x93, 0x73, 
+0xAA, 0x8A, 0x15, 0x0A, 0x21, 
+
+0x8F, 0xB7, 0xC6, 0xB8, 0x22, 0xAE, 0x29, 0x71, 
+0x39, 0xA5, 0x99, 0xC3, 0x6F, 0xBD, 0x8D, 0xF2, 
+0x74, 0x24, 0x7E, 0xFF, 0x4B, 0x35, 0x63, 0xF4, 
+0xD2, 0xA8, 0x55, 0xCD, 0x1A, 0x1A, 0x40, 0x64, 
+0x45, 0x61, 0xD8, 0x26, 0xA0, 0x23, 0x3E, 0xDC, 
+0x57, 0x05, 0xB8, 0xC4, 0xC0, 0x0C, 0xF4, 0x2E, 
+0x3F, 0xBE, 0x36, 0xFB, 0x84, 0xDF, 0x5A, 0x19, 
+0x87, 0x78, 0x6B, 0x31, 0x2D, 0xB5, 0x03, 0x98, 
+0x92, 0x3C, 0x5F, 0xD7, 0x5F, 0x06, 0x2A, 0x00, 
+0x5C, 0x7C, 0xF1, 0x4F, 0x70, 0x36, 0xBB, 0xE0, 
+0xDC, 0x5B, 0x9A, 0x10, 0x87, 0x13, 0x05, 0x58, 
+0xB2, 0x9C, 0xEB, 0xFE, 0xCF, 0x82, 0x03, 0x18, 
+0x6A, 0x37, 0x8D, 0x11, 0xD9, 0x15, 0x1E, 0x27, 
+0x54, 0x8E, 0x7A, 0x30, 0x13, 0xD8, 0x1A, 0xBB, 
+0x6C, 0xAD, 0xC4, 0xFD, 0x61, 0x2E, 0x03, 0xEB, 
+0x2C, 0x2D, 0x01, 0x4A, 0x3A, 0xCA, 0xDF, 0x23, 
+0x8E, 0x39, 0x81, 0x6D, 0x62, 0xEE, 0x12, 0x89, 
+0xC6, 0xA3, 0x1D, 0x38, 0xA9, 0xDE, 0x5D, 0x1C, 
+0x3F, 0xAE, 0x79, 0xAC, 0x2A, 0x5B, 0xCD, 0x94, 
+0x9F, 0xE3, 0xB8, 0x93, 0x21, 0x4E, 0x0E, 0xB4, 
+0x78, 0xE6, 0xC2, 0x69, 0x7D, 0x58, 0x26, 0xDD, 
+0xE1, 0xC0, 0xAB, 0xF9, 0x9F, 0xDE, 0xB0, 0xB5, 
+0x24, 0x78, 0x4C, 0x5A, 0x42, 0x91, 0x8E, 0x03, 
+0x95, 0xAA, 0x08, 0xD4, 0x56, 0x6D, 0x64, 0x41, 
+0xC7, 0xC9, 0x2E, 0xEC, 0xE7, 0x1A, 0xEB, 0xE8, 
+0x24, 0x8D, 0xE3, 0x31, 0xA5, 0x11, 
+
+0x05, 0x2F, 0xC0, 0x7D, 0xD7, 0xC5, 0x12, 0xF3, 
+0x37, 0x94, 0x79, 0xEF, 0x44, 0xEE, 0xB0, 0xD0, 
+0xED, 0x39, 0xCB, 0x5E, 0x64, 0xE9, 0x83, 0xD2, 
+0x76, 0xF0, 0x65, 0x47, 0x25, 0x1D, 0x0D, 0x6B, 
+0x16, 0x23, 0x98, 0xE5, 0x1D, 0xF2, 0xB1, 0x68, 
+0x0D, 0xBA, 0xFE, 0x9D, 0x1A, 0x1D, 0xBA, 0x5F, 
+0x92, 0x6F, 0x17, 0x76, 0xF5, 0xB3, 0xBA, 0x5A, 
+0x71, 0x0C, 0x72, 0xFA, 0x32, 0xCC, 0x7B, 0x17, 
+0x52, 0xB7, 0xF9, 0x86, 0x70, 0xF7, 0x39, 0x22, 
+0xA1, 0xF6, 0xB3, 0xAA, 0xE9, 0xC5, 0x8B, 0x69, 
+0xE4, 0x48, 0xD2, 0xAA, 0x8E, 0xAF, 0x7C, 0xE5, 
+0x77, 0xE1, 0x33, 0xEC, 0x30, 0x20, 0xB8, 0xCD, 
+0x3E, 0xD6, 0xB6, 0xD0, 0x64, 0x86, 0x39, 0x01, 
+0x0D, 0x4F, 0xAB, 0xF7, 0x7B, 0xBE, 0x97, 0x21, 
+0xCD, 0x96, 0x69, 0x16, 0x73, 0x61, 0x7D, 0x84, 
+0x21, 0x3A, 0xA0, 0x07, 0xDE, 0xCA, 0x17, 0x0E, 
+0x82, 0xA4, 0x8B, 0x2D, 0xE4, 0x99, 0x11, 0x3C, 
+0x3C, 0x5B, 0x16, 0xB8, 0x00, 0xEE, 0xD1, 0xDF, 
+0x68, 0x30, 0x0E, 0x12, 0x5E, 0x12, 0x4F, 0x1B, 
+0xBE, 0xC2, 0x9B, 0x99, 0x64, 0xDB, 0xCE, 0x29, 
+0xE5, 0x8E, 0x66, 0x96, 0x65, 0x37, 0x6E, 0xE2, 
+0xE9, 0x05, 0x34, 0x56, 0x8D, 0xDF, 0x24, 0x34, 
+0x09, 0x77, 0xB0, 0x76, 0xDD, 0x1E, 0x76, 0x5D, 
+0x67, 0x7D, 0xCC, 0xCF, 0xB2, 0x7E, 0x15, 0x5E, 
+0xE0, 0x4E, 0x80, 0x4B, 0xE0, 0xD2, 0x5A, 0xD4, 
+0x1F, 0x5F, 0x6E, 0x65, 0x9C, 0x07, 0xBD, 
+
+0x08, 0x49, 0x9F, 0x83, 0x46, 0x0F, 0x0E, 0x04, 
+0x94, 0x6C, 0x3F, 0x75, 0x4D, 0x98, 0xE5, 0x5D, 
+0x12, 0xCB, 0xF6, 0x53, 0x0D, 0xFD, 0x30, 0x75, 
+0x92, 0x02, 0xA9, 0x69, 0xD3, 0x71, 0xBC, 0x28, 
+0xBC, 0x18, 0x88, 0x48, 0x27, 0x0B, 0x3B, 0x5C, 
+0x2C, 0xFF, 0x7C, 0xFA, 0xB1, 0x02, 0x00, 0x79, 
+0x6D, 0x8A, 0x39, 0x3F, 0xF2, 0x11, 0xA8, 0xA3, 
+0x95, 0x68, 0xCA, 0x97, 0x1E, 0xD9, 0x80, 0xD8, 
+0x9D, 0x4C, 0x62, 0x3E, 0xA5, 0xD0, 0x61, 0xE3, 
+0x0D, 0x3D, 0xBA, 0xE7, 0x0E, 0x7F, 0x23, 0xBF, 
+0x3D, 0x04, 0xB4, 0x1F, 0x20, 0x49, 0x5A, 0x40, 
+0x50, 0x59, 0x2C, 0x09, 0x05, 0x5B, 0x8D, 0x07, 
+0xA9, 0x79, 0x75, 0x13, 0xFF, 0xB5, 0x33, 0x58, 
+0x41, 0x5D, 0x1D, 0xF1, 0x39, 0x54, 0x3A, 0x41, 
+0x09, 0xDB, 0x5F, 0xC7, 0x5F, 0x7A, 0xE6, 0x27, 
+0xA4, 0xFF, 0x20, 0xE8, 0x5F, 0x25, 0x89, 0xCB, 
+0x77, 0x8D, 0xD7, 0x79, 0x16, 0x50, 0x68, 0xE0, 
+0x24, 0xEC, 0x87, 0x60, 0xC2, 0x75, 0x87, 0xB8, 
+0xAD, 0x66, 0x64, 0xF1, 0x6B, 0x01, 0x7E, 0xB0, 
+0xE3, 0x79, 0x7E, 0x84, 0x29, 0x51, 0x47, 0x24, 
+0xB9, 0x5D, 0xC6, 0x13, 0xEC, 0x0D, 0x5E, 0x8B, 
+0x3E, 0x68, 0x97, 0x74, 0xB5, 0x1C, 0xE6, 0x9E, 
+0x74, 0xD1, 0x9A, 0xE6, 0x1A, 0x6C, 0xFD, 0xBB, 
+0x27, 0xC1, 0x73, 0xB1, 0x4E, 0xAC, 0x97, 0x1B, 
+0x8B, 0xB2, 0x0A, 0x6A, 0x67, 0xA0, 0xA9, 0xDD, 
+0x88, 0xF0, 0x55, 0x58, 0x10, 0x79, 0x9C, 0xC5, 
+
+0xAF, 0x48, 0x98, 0xFF, 0xF1, 0xC9, 0x67, 0xB3, 
+0x74, 0xA9, 0xAC, 0xF5, 0x17, 0x7D, 0x95, 0x53, 
+0x6A, 0xDD, 0xD6, 0x44, 0x3B, 0x09, 0x32, 0x78, 
+0x2E, 0xEC, 0x19, 0x33, 0xD8, 0x4C, 0xFD, 0xE6, 
+0xCE, 0x4A, 0x08, 0x78, 0xB5, 0xE8, 0x90, 0x1B, 
+0x32, 0x04, 0x51, 0xC1, 0x4A, 0x1A, 0x69, 0xA2, 
+0x0A, 0x76, 0x86, 0x73, 0x4B, 0x4C, 0xF7, 0x62, 
+0xF7, 0xD0, 0x2E, 0x91, 0x8B, 0x7C, 0xFA, 0x8F, 
+0xC9, 0xBE, 0x49, 0x9B, 0x1C, 0x58, 0xF8, 0x14, 
+0xD1, 0x07, 0x4A, 0x4D, 0xE4, 0xB3, 0x83, 0x0A, 
+0xD3, 0xF9, 0xDD, 0xC1, 0x2F, 0x84, 0x15, 0x02, 
+0xA8, 0xB7, 0xB8, 0x45, 0x05, 0x99, 0x71, 0xEE, 
+0xC6, 0x3B, 0xC4, 0xC3, 0x6F, 0x10, 0x63, 0x17, 
+0x11, 0xD0, 0x1F, 0x1C, 0x63, 0xB9, 0x3F, 0x3D, 
+0x68, 0x0A, 0x39, 0x25, 0x95, 0xB9, 0x81, 0xA7, 
+0x04, 0x64, 0x6B, 0x2E, 0x52, 0xD0, 0x56, 0x04, 
+0x99, 0x4F, 0x1B, 0x8E, 0xB0, 0x29, 0xA5, 0x3C, 
+0x17, 0x46, 0xEC, 0x36, 0xC2, 0xD0, 0xAD, 0x92, 
+0xB7, 0x58, 0x70, 0x33, 0xA9, 0xA3, 0xD8, 0xB7, 
+0xF1, 0x04, 0xA7, 0xFA, 0x9D, 0x2C, 0x6B, 0x5A, 
+0x52, 0x89, 0xAC, 0xD0, 0x12, 0x44, 0x8F, 0x7E, 
+0x97, 0xA1, 0x82, 0x82, 0xB2, 0xEA, 0x47, 0x60, 
+0x9B, 0x22, 0x32, 0x74, 0x77, 0x62, 0xE3, 0x46, 
+0xF5, 0x55, 0x79, 0xEF, 0x9D, 0xB0, 0x2A, 0xC8, 
+0x10, 0x2E, 0x7B, 0x3F, 0x00, 0xE8, 0x04, 0xA0, 
+0x7E, 0x7F, 0x34, 0xB2, 0x35, 0x0E, 0x3A, 0x2F, 
+0x65, 
+
+0x0C, 0x94, 0x90, 0x76, 0x67, 0xAB, 0xE0, 0x58, 
+0x2E, 0x22, 0xF4, 0x48, 0xE7, 0x3E, 0x44, 0xB6, 
+0x6D, 0xD4, 0xBD, 0x46, 0xEB, 0x9E, 0x01, 0xF9, 
+0xB3, 0x06, 0x6F, 0xBA, 0xEE, 0x06, 0x1F, 0xB9, 
+0x22, 0xE3, 0x49, 0x27, 0x80, 0x86, 0xB5, 0x38, 
+0x47, 0x77, 0x14, 0x68, 0x0B, 0xF2, 0x6C, 0x9A, 
+0xF1, 0x1F, 0x20, 0xED, 0x86, 0xF9, 0xEE, 0x04, 
+0x92, 0xBC, 0xAB, 0xB8, 0x6B, 0x4A, 0x9D, 0x63, 
+0xEB, 0x1B, 0x69, 0x18, 0x3D, 0x81, 0x4D, 0x16, 
+0x68, 0x63, 0xF1, 0x96, 0x96, 0x0B, 0x51, 0x66, 
+0x93, 0xFE, 0x49, 0xEA, 0xF6, 0x55, 0x8A, 0x2A, 
+0xD7, 0x2E, 0xD0, 0x2D, 0xBF, 0x85, 0xD5, 0x97, 
+0x9D, 0x8D, 0x2D, 0xD3, 0xB3, 0x62, 0x61, 0xB6, 
+0x3B, 0x7B, 0xD1, 0x17, 0xB3, 0xB4, 0x94, 0x2D, 
+0xEC, 0x76, 0xD1, 0x39, 0x75, 0x39, 0xFA, 0xAA, 
+0x5B, 0x47, 0x0D, 0x9F, 0xBC, 0xEC, 0x12, 0x4F, 
+0x76, 0x7C, 0xCB, 0xAD, 0x19, 0x80, 0xCF, 0x65, 
+0x7F, 0x1E, 0x64, 0x2D, 0xE7, 0xF1, 0x4B, 0x22, 
+0x9D, 0xEE, 0x46, 0x48, 0xBA, 0x54, 0x32, 0x5D, 
+0x33, 0xE0, 0x81, 0xF6, 0x9C, 0x2F, 0x1C, 0xBC, 
+0x17, 0xCA, 0x34, 0x0C, 0x9A, 0xEF, 0x27, 0x8E, 
+0x19, 0xD8, 0x8C, 0xC4, 0xED, 0x0C, 0x88, 0xBA, 
+0x60, 0x8B, 0xCF, 0x84, 0xB8, 0xF0, 0x25, 0x8C, 
+0x9E, 0xEA, 0x00, 0xE0, 0xB6, 0xC1, 0xAA, 0x02, 
+0x51, 0xD9, 0xFF, 0xDA, 0x87, 0x9D, 0x62, 0xB1, 
+0xD1, 0x52, 0x7D, 0xE8, 0xB1, 0xC2, 0x45, 0x7D, 
+0x4D, 0xF8, 
+
+0x20, 0xC9, 0xAD, 0x20, 0xED, 0x26, 0x96, 0x8F, 
+0xF9, 0xEA, 0xD1, 0x3C, 0x54, 0xA2, 0x45, 0xAF, 
+0x13, 0x85, 0x90, 0x43, 0xC3, 0xFD, 0x90, 0xBA, 
+0x19, 0xAF, 0xB3, 0x6F, 0x3E, 0xD7, 0x01, 0xB3, 
+0xCD, 0x1F, 0xAC, 0x35, 0x48, 0x29, 0x7E, 0xE3, 
+0x7B, 0x5F, 0x2A, 0x48, 0x86, 0x27, 0x43, 0xAA, 
+0x9C, 0x30, 0x6A, 0x4B, 0x13, 0x77, 0x2D, 0xE5, 
+0xB4, 0xB2, 0x66, 0x82, 0xC0, 0x4E, 0xDE, 0x81, 
+0x18, 0x9E, 0x4A, 0xC4, 0x0A, 0x20, 0x2E, 0x70, 
+0xED, 0x7D, 0x48, 0x28, 0xF8, 0xE4, 0x68, 0x01, 
+0xEF, 0x54, 0x43, 0x42, 0x3A, 0xA8, 0x65, 0xC6, 
+0xF5, 0x94, 0x19, 0x8A, 0x23, 0x75, 0xBD, 0x08, 
+0xAE, 0xA4, 0x78, 0x42, 0xD1, 0xF4, 0xF0, 0xA3, 
+0x5A, 0x21, 0x74, 0x1B, 0x90, 0xD8, 0x05, 0x99, 
+0xA7, 0x22, 0x7C, 0x79, 0xE9, 0x7D, 0xFF, 0xB2, 
+0x1F, 0x04, 0x37, 0xAE, 0xFA, 0xD5, 0x93, 0x97, 
+0x32, 0x7E, 0xFD, 0xBB, 0xAC, 0x6E, 0x96, 0xA5, 
+0x4F, 0xB4, 0x89, 0x21, 0xB1, 0x3F, 0xB2, 0x3D, 
+0xFA, 0x08, 0xCE, 0xED, 0x70, 0x23, 0xAF, 0x75, 
+0x22, 0xDE, 0x2E, 0x12, 0x37, 0xE4, 0xB9, 0x92, 
+0xA2, 0xB0, 0x4D, 0x56, 0xDE, 0x5E, 0xD3, 0x7F, 
+0xBF, 0xBD, 0x5E, 0x6F, 0x4A, 0xBA, 0xC3, 0x27, 
+0x22, 0x19, 0xA3, 0x4E, 0x90, 0x2E, 0x72, 0x2E, 
+0xEB, 0x6B, 0x48, 0xD5, 0xC6, 0x00, 0x94, 0x56, 
+0x29, 0x74, 0x13, 0x0B, 0x85, 0xCF, 0xE5, 0x51, 
+0x83, 0xB5, 0x20, 0x49, 0xF0, 0xC6, 0x08, 0xEE, 
+0x18, 0x26, 0x19, 
+
+0x74, 0x41, 0x6D, 0x4E, 0x6C, 0x5E, 0x82, 0xB9, 
+0xA6, 0x6E, 0x53, 0x6C, 0xEF, 0x7E, 0x43, 0x36, 
+0x6A, 0xAA, 0x94, 0x99, 0xAA, 0x92, 0x44, 0xE6, 
+0x41, 0x1D, 0x09, 0xE6, 0x5D, 0xF5, 0x8D, 0xCF, 
+0x34, 0xE3, 0xAC, 0x10, 0x0B, 0x5D, 0xC1, 0x59, 
+0xB7, 0x60, 0x6C, 0xCB, 0x75, 0x67, 0x85, 0x40, 
+0xB8, 0x06, 0x54, 0xE0, 0xB7, 0xB9, 0x4E, 0x4A, 
+0x52, 0xD0, 0x39, 0x9F, 0x2C, 0x01, 0xFE, 0xB2, 
+0x09, 0x73, 0xA1, 0x6C, 0xD6, 0x0E, 0xB4, 0x8F, 
+0x40, 0xA2, 0x15, 0x12, 0x88, 0x3D, 0x65, 0x49, 
+0x0C, 0xE1, 0xF5, 0x95, 0xF4, 0xC0, 0xF2, 0x63, 
+0x5F, 0xF6, 0x1B, 0xF2, 0x30, 0xC8, 0x5B, 0xE9, 
+0xC0, 0x84, 0x0C, 0x6E, 0xDF, 0xC4, 0x19, 0xB9, 
+0xCE, 0xA6, 0x49, 0xA5, 0x64, 0xE9, 0x66, 0xC3, 
+0x6C, 0xE5, 0x93, 0x76, 0xAC, 0x59, 0x65, 0x0E, 
+0xE6, 0xF5, 0x80, 0xCE, 0x1C, 0x72, 0x9A, 0x53, 
+0xB0, 0xC7, 0xB6, 0x11, 0x65, 0xDE, 0xEC, 0x89, 
+0x18, 0xD8, 0x05, 0x6E, 0x41, 0xD2, 0x31, 0xBD, 
+0xDB, 0xE5, 0xEF, 0xF5, 0x76, 0x5C, 0xBD, 0x03, 
+0x6D, 0x04, 0x00, 0x16, 0x64, 0x19, 0xFE, 0x4B, 
+0x73, 0x9C, 0xDB, 0x31, 0x13, 0x50, 0xD6, 0xCB, 
+0xE4, 0xFC, 0x73, 0x89, 0x0F, 0x50, 0x31, 0xBF, 
+0x0E, 0xAB, 0xD8, 0xF2, 0x9C, 0xB8, 0x04, 0x88, 
+0xAD, 0xE3, 0x9F, 0xDA, 0x0D, 0xE3, 0x5B, 0x34, 
+0x94, 0xCB, 0x50, 0x61, 0xA4, 0x01, 0x03, 0xFA, 
+0x4D, 0x85, 0xE4, 0x29, 0xFC, 0x11, 0x77, 0x97, 
+0x75, 0x6D, 0xF3, 0xC1, 
+
+0x16, 0x34, 0x6D, 0x2E, 0x36, 0x03, 0xC4, 0x4E, 
+0xC5, 0xB4, 0xF8, 0xFA, 0x20, 0x49, 0x81, 0x68, 
+0x7E, 0xC8, 0xC2, 0x13, 0x18, 0x84, 0x0C, 0x84, 
+0xE8, 0xBF, 0xD9, 0x0D, 0x1B, 0x9F, 0xF0, 0x1F, 
+0x8D, 0x41, 0x56, 0x5F, 0xBB, 0x1A, 0x21, 0x5F, 
+0x0B, 0x7E, 0x79, 0xFC, 0x7C, 0x72, 0xD5, 0x80, 
+0x64, 0x8A, 0xFD, 0xC5, 0xF9, 0xDC, 0x61, 0x27, 
+0x75, 0x65, 0x0A, 0x2C, 0x2A, 0x4C, 0xE9, 0x7A, 
+0x3A, 0xFA, 0x1E, 0xE6, 0x2A, 0x31, 0xFD, 0xD1, 
+0xA0, 0xD8, 0xBF, 0xAB, 0x40, 0x61, 0x87, 0x9F, 
+0xA8, 0x65, 0x18, 0x7C, 0x87, 0xEE, 0xA2, 0x93, 
+0xC2, 0xE4, 0xED, 0xEF, 0x5C, 0x3D, 0x09, 0xC8, 
+0xD7, 0x75, 0x76, 0xF2, 0x1A, 0x9E, 0x19, 0xB8, 
+0x44, 0xE8, 0xBE, 0x4F, 0x71, 0xD2, 0xEF, 0x0A, 
+0x83, 0x1C, 0xFC, 0xA5, 0x6F, 0x43, 0x0A, 0x1D, 
+0xDA, 0xD7, 0x4D, 0x14, 0xE3, 0x5C, 0x32, 0x74, 
+0x45, 0x19, 0xC4, 0xF4, 0x3D, 0x5A, 0x6E, 0xEC, 
+0x5E, 0x54, 0xF1, 0xB3, 0xF1, 0x4B, 0x05, 0x52, 
+0x92, 0x41, 0x49, 0x27, 0x18, 0x40, 0x38, 0x4E, 
+0x0A, 0x7D, 0xD1, 0x31, 0x0A, 0xC8, 0x82, 0x60, 
+0x5A, 0xBE, 0x4A, 0x5A, 0xAE, 0xBE, 0x86, 0x7D, 
+0x2B, 0x27, 0x07, 0x2C, 0xFA, 0x65, 0xB0, 0x73, 
+0x50, 0x68, 0x58, 0x36, 0x50, 0x74, 0x61, 0x57, 
+0xAB, 0xF4, 0xE8, 0xCD, 0xEE, 0xBB, 0x29, 0x68, 
+0x0E, 0x6C, 0x38, 0x0E, 0x0C, 0xDC, 0x79, 0x12, 
+0xC7, 0xF4, 0x25, 0x74, 0x66, 0xD6, 0xC1, 0xF6, 
+0xF0, 0xF6, 0x1D, 0x27, 0xA1, 
+
+0xFA, 0x0C, 0xB5, 0xEE, 0x97, 0x04, 0x06, 0xF8, 
+0x04, 0xE8, 0xBF, 0x1C, 0x3D, 0x19, 0x5A, 0x1C, 
+0x04, 0xBC, 0x6F, 0x76, 0x50, 0x3D, 0x0C, 0xE7, 
+0xCE, 0xE5, 0xDE, 0x6F, 0xA1, 0xE6, 0xA3, 0xE4, 
+0x3C, 0xC6, 0x17, 0xBF, 0xDC, 0xDF, 0x56, 0xFC, 
+0x63, 0xE9, 0x9F, 0x57, 0x0C, 0xF0, 0xA8, 0x19, 
+0x1B, 0x8A, 0x33, 0xA3, 0xBF, 0xA8, 0xCD, 0xC4, 
+0x24, 0x71, 0xA2, 0x4D, 0x2D, 0xF4, 0x3E, 0xB7, 
+0xF9, 0xAD, 0x3B, 0xA9, 0xDF, 0xCF, 0x81, 0xBD, 
+0x9A, 0x5A, 0x45, 0xC0, 0x94, 0xA2, 0xAF, 0x9A, 
+0x86, 0xF6, 0xBF, 0x3B, 0x99, 0xDB, 0x1E, 0x41, 
+0xFD, 0xA5, 0x57, 0xB3, 0x2E, 0x7B, 0x73, 0x4F, 
+0x6A, 0xBF, 0xAD, 0x5F, 0x87, 0xFC, 0xA7, 0xEC, 
+0xC9, 0xD6, 0x70, 0xDA, 0xED, 0x75, 0x84, 0x50, 
+0x61, 0x7C, 0x73, 0x18, 0x8D, 0x7B, 0xF2, 0x47, 
+0x85, 0x75, 0x0A, 0x19, 0xBF, 0xFE, 0x3B, 0x81, 
+0x81, 0x15, 0x57, 0xD8, 0xE7, 0x13, 0xD6, 0xF6, 
+0x0D, 0x49, 0xB9, 0x71, 0x23, 0x51, 0x3F, 0x6A, 
+0x50, 0x70, 0xB6, 0x42, 0x18, 0xE9, 0xD9, 0xF5, 
+0xEF, 0x60, 0x15, 0xE3, 0x73, 0xCD, 0x49, 0x09, 
+0x4A, 0x87, 0x05, 0x5D, 0x45, 0xE1, 0x40, 0xAC, 
+0x6E, 0xA0, 0xD0, 0x1C, 0xD4, 0xFE, 0x76, 0xE4, 
+0xD8, 0x4E, 0x3E, 0x93, 0xC9, 0xC8, 0xC7, 0x4A, 
+0x49, 0x8E, 0x79, 0x46, 0x5D, 0xB0, 0xEB, 0xDC, 
+0x46, 0x88, 0x25, 0x5B, 0xE4, 0xE2, 0x0E, 0x0C, 
+0xC9, 0xD3, 0xA2, 0x79, 0x1A, 0xF2, 0x94, 0xF2, 
+0xC2, 0xE2, 0x98, 0x6E, 0x99, 0x87, 
+
+0xDE, 0xEE, 0x45, 0x68, 0x9D, 0xC8, 0x03, 0xC0, 
+0x02, 0x41, 0x3D, 0x9F, 0x33, 0x70, 0x73, 0x62, 
+0x96, 0x25, 0x02, 0xF4, 0x74, 0x5D, 0xE2, 0xF1, 
+0x67, 0xF2, 0xFD, 0xE1, 0x65, 0x47, 0x33, 0x6D, 
+0xF4, 0x86, 0x11, 0x7C, 0xD0, 0x48, 0x02, 0x7C, 
+0xA7, 0x26, 0x85, 0x27, 0xBE, 0x13, 0x96, 0x85, 
+0x40, 0x1F, 0x6A, 0xB0, 0x0D, 0x8E, 0xD2, 0x02, 
+0xD7, 0x03, 0xFD, 0x45, 0xC8, 0x08, 0xED, 0x3E, 
+0x32, 0x04, 0x30, 0x48, 0x63, 0xA3, 0x65, 0x8C, 
+0x11, 0x9D, 0x7B, 0x65, 0xE9, 0x02, 0x37, 0xF3, 
+0x9D, 0x0B, 0x5E, 0xAD, 0x9D, 0xFF, 0x7D, 0x56, 
+0x3D, 0x50, 0x74, 0x37, 0xAD, 0x39, 0x06, 0x85, 
+0x10, 0xC5, 0x69, 0x37, 0x57, 0x3C, 0x4F, 0xE8, 
+0x4A, 0xAC, 0x1D, 0x06, 0xDA, 0x62, 0xB3, 0x3E, 
+0x0B, 0xB0, 0xE4, 0x1F, 0x3F, 0x2F, 0x29, 0x88, 
+0x14, 0xC1, 0xAE, 0xD1, 0x62, 0x89, 0xB7, 0xC5, 
+0x51, 0x72, 0xFE, 0xE3, 0xDF, 0x56, 0x9E, 0xDB, 
+0x37, 0xE4, 0x64, 0xAC, 0x99, 0x08, 0xF7, 0x87, 
+0xFA, 0xCE, 0x5B, 0xF3, 0xCD, 0x21, 0xF6, 0x8A, 
+0xED, 0xA3, 0x7B, 0xEC, 0x3F, 0x30, 0x14, 0x3D, 
+0x00, 0xD4, 0x40, 0xBE, 0x35, 0x36, 0x89, 0xCF, 
+0x29, 0xDA, 0x13, 0xE8, 0x69, 0x0D, 0xDE, 0xE8, 
+0x05, 0x22, 0x0D, 0x02, 0x18, 0xDB, 0x12, 0xAC, 
+0x01, 0x12, 0xD0, 0xEF, 0xD2, 0x82, 0x21, 0xC9, 
+0xF7, 0x52, 0x94, 0x98, 0x0E, 0xE7, 0xCB, 0x9C, 
+0x3F, 0xD1, 0x0D, 0xA6, 0x0C, 0x53, 0x60, 0x8B, 
+0x13, 0x3F, 0x77, 0xD5, 0xDC, 0xC5, 0xE2, 
+
+0x7D, 0x3B, 0xAE, 0x67, 0x64, 0x19, 0xC8, 0x66, 
+0xF1, 0x0F, 0x31, 0xAD, 0x43, 0x35, 0xD4, 0x44, 
+0x2C, 0xA5, 0x5D, 0x76, 0x78, 0x70, 0x4C, 0x2F, 
+0x71, 0x11, 0xB9, 0xF3, 0x69, 0x16, 0xFA, 0x09, 
+0x42, 0xE7, 0x6C, 0x09, 0x2E, 0xDA, 0xEB, 0x9A, 
+0x53, 0x82, 0x1D, 0x31, 0x3E, 0x3E, 0xD8, 0x48, 
+0xEA, 0x86, 0xE8, 0x76, 0x8E, 0xC6, 0xA7, 0xEA, 
+0xBB, 0x58, 0x7D, 0xB7, 0x39, 0x76, 0xEC, 0xE6, 
+0x35, 0x19, 0xAA, 0xFA, 0x6E, 0x98, 0x2C, 0x38, 
+0xC2, 0xA2, 0x0E, 0x36, 0xA9, 0x4F, 0x88, 0xDA, 
+0x6E, 0x3D, 0x1C, 0xFB, 0x25, 0xF5, 0x96, 0x1E, 
+0x18, 0xBE, 0xEB, 0x0A, 0x48, 0xB8, 0x5A, 0x42, 
+0xD3, 0x77, 0xEB, 0xD1, 0x06, 0x93, 0x4D, 0x56, 
+0xB0, 0x60, 0xAA, 0x72, 0x8B, 0xC4, 0x29, 0x2E, 
+0xE5, 0x36, 0x53, 0x46, 0xA3, 0x4F, 0x27, 0xED, 
+0xED, 0x0A, 0x19, 0xCC, 0xF1, 0x1C, 0xBB, 0x0C, 
+0x43, 0x2C, 0x6E, 0x40, 0xCF, 0x0F, 0x58, 0x23, 
+0xC0, 0xE1, 0x5F, 0x2A, 0x63, 0x06, 0xD0, 0x91, 
+0x97, 0x9C, 0x16, 0x0E, 0x0A, 0xE6, 0xAF, 0x1D, 
+0x7C, 0x07, 0xD6, 0xC4, 0x64, 0x01, 0xB9, 0xE4, 
+0x6D, 0x75, 0x92, 0x4F, 0x35, 0x1B, 0x4C, 0x37, 
+0xCE, 0x05, 0x88, 0x06, 0x08, 0x1C, 0xD6, 0x75, 
+0xE2, 0x07, 0x2E, 0x54, 0x58, 0xA1, 0x00, 0x39, 
+0xA4, 0x80, 0xBF, 0xB2, 0x1F, 0xC4, 0x69, 0xC1, 
+0x3D, 0x4B, 0xFE, 0x7F, 0x20, 0x1F, 0x49, 0x8B, 
+0x08, 0xC6, 0x33, 0x86, 0xEB, 0x7B, 0x3E, 0x16, 
+0xDE, 0xE3, 0x34, 0xD8, 0x66, 0x04, 0x55, 0xBA, 
+
+0xBE, 0x42, 0xC4, 0xEA, 0xAB, 0x31, 0xF5, 0x2E, 
+0xF3, 0xF6, 0xBC, 0x86, 0xB6, 0xA4, 0x48, 0x74, 
+0x15, 0xE9, 0xA7, 0x65, 0xE6, 0xDA, 0xF0, 0x94, 
+0x5F, 0x1F, 0xC4, 0xCA, 0xD3, 0xFE, 0x66, 0x78, 
+0x03, 0xE3, 0xBE, 0x9D, 0xE8, 0xAC, 0x45, 0x29, 
+0xFA, 0x59, 0xE0, 0xBA, 0xD0, 0xAC, 0x62, 0x9A, 
+0xA6, 0x6F, 0x6C, 0x5B, 0x0E, 0x86, 0xC8, 0x3E, 
+0xD6, 0xE2, 0x84, 0x31, 0x79, 0xD1, 0xAF, 0xCB, 
+0x78, 0x1D, 0xF5, 0x1D, 0x76, 0x72, 0xCC, 0xAA, 
+0x7A, 0x22, 0x35, 0xBD, 0x3A, 0x26, 0x7B, 0x2B, 
+0x08, 0xD8, 0x27, 0x26, 0x07, 0xA2, 0xAA, 0x6E, 
+0x30, 0x8C, 0x38, 0x70, 0x40, 0x3C, 0x61, 0x9F, 
+0x5C, 0x57, 0x72, 0x9B, 0x5B, 0x4C, 0x20, 0xB0, 
+0x84, 0x1A, 0x85, 0x0C, 0xF3, 0x3E, 0x5B, 0x67, 
+0x3D, 0xF2, 0xB4, 0x4F, 0xB1, 0xE6, 0x3D, 0xDC, 
+0xF1, 0x3C, 0x49, 0x9A, 0x38, 0x95, 0x47, 0xF2, 
+0xCA, 0x43, 0xB7, 0x0A, 0x80, 0x06, 0x9F, 0x06, 
+0x4D, 0x02, 0x98, 0x44, 0xB0, 0x8B, 0x73, 0xC6, 
+0x26, 0x5B, 0x14, 0xA1, 0xF6, 0x64, 0x37, 0xDE, 
+0xF2, 0x22, 0x1A, 0x59, 0x85, 0xAE, 0xFD, 0x5A, 
+0xE5, 0x71, 0xC8, 0xCF, 0x6C, 0xFA, 0x64, 0x27, 
+0xFF, 0x55, 0x45, 0x88, 0xA4, 0x5A, 0x50, 0x82, 
+0x47, 0x61, 0x4C, 0xE5, 0x43, 0x9A, 0xA9, 0x5A, 
+0xCC, 0xA3, 0xCA, 0x1B, 0xA0, 0x28, 0x65, 0x6E, 
+0xA4, 0xCE, 0xE2, 0x17, 0x41, 0x07, 0x50, 0x31, 
+0x76, 0xCB, 0x61, 0x14, 0xE3, 0x50, 0x68, 0x2B, 
+0xF2, 0x96, 0x6B, 0xCF, 0xFE, 0xF7, 0x91, 0xD6, 
+0x6F, 
+
+0x55, 0x68, 0x17, 0x44, 0xA4, 0xAE, 0x0A, 0xBE, 
+0xB6, 0xF4, 0x72, 0xAA, 0x55, 0x65, 0x33, 0x22, 
+0xC5, 0x86, 0x02, 0xC5, 0xB6, 0x13, 0x9B, 0x68, 
+0x1E, 0xE8, 0xFD, 0x2D, 0xEB, 0x9E, 0xF2, 0x72, 
+0xA3, 0xA7, 0x38, 0xC1, 0xDB, 0xDB, 0x72, 0x03, 
+0xE9, 0x7E, 0x5B, 0x55, 0x12, 0x0A, 0x8B, 0x87, 
+0x89, 0xA5, 0xB7, 0x93, 0xFA, 0x8E, 0x80, 0xAA, 
+0xB5, 0xDC, 0xA4, 0x78, 0xD7, 0x76, 0x21, 0x7B, 
+0xF0, 0x1B, 0x41, 0x89, 0x9F, 0x9C, 0x41, 0xE6, 
+0xDE, 0x3A, 0xF6, 0x16, 0x66, 0xDF, 0xF1, 0xC9, 
+0x59, 0x75, 0x18, 0xBE, 0xC5, 0xE3, 0x55, 0xA7, 
+0x39, 0xF5, 0x04, 0xBA, 0x3A, 0x11, 0x53, 0x36, 
+0x69, 0x58, 0x49, 0xC3, 0xB1, 0x4E, 0x65, 0x88, 
+0xDA, 0x46, 0xB8, 0x79, 0x58, 0x32, 0x18, 0xBA, 
+0x3E, 0x3C, 0x5C, 0x02, 0x34, 0xFD, 0xD1, 0x2F, 
+0x32, 0xCA, 0x4D, 0x1A, 0x68, 0x3B, 0x73, 0xC6, 
+0xD0, 0xA8, 0x71, 0x24, 0xA8, 0xD5, 0x82, 0x83, 
+0x26, 0x99, 0xCA, 0x8E, 0xC9, 0x55, 0xF2, 0x92, 
+0xD1, 0x95, 0x4E, 0xA7, 0x5F, 0x16, 0x56, 0xD7, 
+0xEA, 0xCF, 0xD3, 0xC7, 0xD7, 0xEA, 0x79, 0xF4, 
+0x12, 0xC4, 0x7E, 0x5B, 0xB3, 0x1D, 0xAE, 0x0A, 
+0xFD, 0xE1, 0x9F, 0x27, 0xCC, 0x95, 0xC6, 0x03, 
+0xFD, 0x2F, 0xD3, 0xC5, 0x4E, 0xEF, 0x42, 0x10, 
+0xF4, 0x6B, 0x24, 0x78, 0x53, 0xC5, 0xE1, 0xB6, 
+0xB7, 0x80, 0xBD, 0xEB, 0x65, 0x5B, 0xA8, 0xE5, 
+0x8E, 0xE5, 0x7F, 0x1E, 0x5B, 0x93, 0xA4, 0xDD, 
+0xD5, 0xAB, 0xF2, 0x4A, 0x75, 0xE0, 0xAC, 0xC1, 
+0x0C, 0x2D, 
+
+0x3A, 0x63, 0xF3, 0x06, 0x48, 0xB8, 0x7A, 0x18, 
+0x11, 0x6F, 0x96, 0x89, 0x2C, 0x01, 0x1F, 0x47, 
+0x73, 0xF4, 0x9E, 0x28, 0x77, 0xE8, 0xCD, 0x57, 
+0x36, 0x53, 0xB4, 0x33, 0x62, 0x83, 0x51, 0x7A, 
+0x4A, 0x4D, 0xC3, 0xF7, 0x58, 0x19, 0x73, 0xB5, 
+0xFF, 0x36, 0x3E, 0x63, 0xB0, 0xD1, 0x12, 0x07, 
+0xB9, 0x17, 0xF1, 0xC6, 0x78, 0x79, 0xA1, 0x2D, 
+0x4F, 0x00, 0xAF, 0xCD, 0xA3, 0x73, 0x09, 0xDD, 
+0x9C, 0x3D, 0x8E, 0x6B, 0x2B, 0xA5, 0xF5, 0x91, 
+0x1C, 0x2B, 0xFE, 0xB8, 0xE5, 0x6E, 0xA5, 0x9D, 
+0x5D, 0x5C, 0x6A, 0xF2, 0xA1, 0x7E, 0x82, 0xB0, 
+0x47, 0xB5, 0x53, 0xE4, 0x49, 0x00, 0x5B, 0x60, 
+0x5C, 0x94, 0x6E, 0xD1, 0xDE, 0x27, 0x7C, 0xC2, 
+0xA2, 0xFF, 0xB4, 0xAF, 0x5F, 0x52, 0x0F, 0x5B, 
+0x3B, 0xA5, 0x48, 0x93, 0x90, 0x97, 0x30, 0x10, 
+0x30, 0x96, 0x84, 0x68, 0x8D, 0xB4, 0x47, 0x9E, 
+0x77, 0x23, 0x2A, 0x96, 0xA9, 0x29, 0x2E, 0xBC, 
+0x40, 0x59, 0x92, 0xE4, 0x55, 0xD7, 0x30, 0x2E, 
+0x62, 0x15, 0xA6, 0xEA, 0x77, 0x5D, 0x2C, 0x1C, 
+0x10, 0xF5, 0x8A, 0x43, 0x2B, 0x72, 0xC3, 0xD0, 
+0x9E, 0xB9, 0x80, 0x4C, 0x8D, 0xCD, 0x5B, 0xB5, 
+0xAD, 0xF6, 0x44, 0xDD, 0x9D, 0x37, 0x8E, 0x28, 
+0xF1, 0xB5, 0xCE, 0xF2, 0xDD, 0x35, 0x6F, 0x63, 
+0xB3, 0x32, 0xAC, 0x13, 0x4B, 0xC9, 0x67, 0x58, 
+0x63, 0x2F, 0xB4, 0x23, 0x0C, 0xA3, 0x4F, 0xF8, 
+0xB6, 0x52, 0xE7, 0xD1, 0xA7, 0x0D, 0x0D, 0x5B, 
+0x66, 0x1D, 0x77, 0x82, 0xCA, 0xE5, 0x11, 0x62, 
+0xF4, 0xA9, 0x95, 
+
+0xE7, 0x1E, 0xF9, 0x44, 0xCD, 0x9F, 0xB5, 0xC5, 
+0x80, 0xDC, 0x78, 0xA9, 0x4E, 0xAB, 0xD3, 0x1D, 
+0xA9, 0xC6, 0xCC, 0x67, 0x49, 0xC2, 0x9C, 0x99, 
+0x60, 0x86, 0xBA, 0x95, 0xDD, 0x10, 0x02, 0x37, 
+0xE8, 0x2B, 0xF9, 0x2B, 0xB3, 0x97, 0x44, 0xDA, 
+0xEF, 0x65, 0xB6, 0xB2, 0x0D, 0xA4, 0xAB, 0xFE, 
+0xD7, 0x19, 0x07, 0xBD, 0x5B, 0xCC, 0x56, 0x78, 
+0x90, 0xBA, 0x9C, 0x85, 0x7B, 0xD7, 0xDE, 0xD2, 
+0x07, 0x29, 0xB2, 0x82, 0xF1, 0x37, 0x7A, 0x87, 
+0xB9, 0x04, 0xF6, 0xE2, 0xC9, 0xF7, 0xDD, 0x1D, 
+0xA4, 0x25, 0xB0, 0x18, 0x49, 0x72, 0x6F, 0x02, 
+0xB1, 0x49, 0xB9, 0x15, 0xA6, 0x00, 0x87, 0x23, 
+0x88, 0xED, 0xB7, 0xD5, 0xA2, 0x12, 0xC2, 0xA6, 
+0xF3, 0x8A, 0x8C, 0x50, 0xE2, 0xC9, 0xBC, 0x62, 
+0x50, 0xF8, 0x2C, 0xB1, 0x11, 0x00, 0x36, 0x79, 
+0x13, 0x75, 0xFB, 0x87, 0xDA, 0x49, 0x59, 0x42, 
+0x57, 0xDD, 0x7C, 0xE4, 0xFA, 0xD1, 0x5B, 0xF9, 
+0xB5, 0xB8, 0x56, 0xB0, 0x98, 0xF9, 0x63, 0xB1, 
+0x1B, 0xEC, 0xF7, 0x05, 0x4C, 0xBC, 0x32, 0x1C, 
+0x59, 0xC8, 0x75, 0x7E, 0xBC, 0xAD, 0xCF, 0x32, 
+0x1A, 0x3D, 0xAE, 0x1F, 0x27, 0x01, 0x85, 0x60, 
+0xCB, 0xDE, 0xAB, 0x39, 0xFD, 0x1E, 0x45, 0x82, 
+0x36, 0xC1, 0xC3, 0x69, 0xA7, 0xE4, 0x5B, 0x16, 
+0x36, 0x5A, 0xD5, 0x20, 0x5E, 0x4D, 0x1C, 0xBA, 
+0x6E, 0x28, 0x30, 0x66, 0xDA, 0x6C, 0x66, 0x42, 
+0xD4, 0x9A, 0x98, 0x31, 0xF8, 0x11, 0x8A, 0xB5, 
+0xF9, 0x9E, 0x96, 0xE9, 0x42, 0x0D, 0xDF, 0x28, 
+0x2D, 0x03, 0xC6, 0xFF, 
+
+0x05, 0x1F, 0xCE, 0x41, 0xE4, 0x8C, 0x74, 0x81, 
+0x36, 0x78, 0x17, 0x9E, 0x28, 0xCC, 0xA0, 0xA8, 
+0x03, 0x13, 0xEB, 0x8D, 0xD9, 0xF5, 0x75, 0x8C, 
+0x58, 0x0B, 0xA5, 0x3F, 0xB5, 0x5D, 0x48, 0x94, 
+0xA9, 0x7D, 0x61, 0x34, 0x5F, 0x07, 0xA2, 0x84, 
+0x6A, 0xF9, 0x6C, 0xCB, 0x4C, 0x5D, 0x2D, 0xB2, 
+0x05, 0x0B, 0x03, 0x61, 0x4A, 0x68, 0xB3, 0xA2, 
+0x08, 0x33, 0x68, 0xB0, 0xD9, 0x95, 0x63, 0x98, 
+0xBA, 0xF9, 0x70, 0x81, 0xDC, 0x34, 0xD2, 0xA3, 
+0x5C, 0x5E, 0x85, 0x22, 0x8D, 0xEB, 0xEE, 0x81, 
+0xEC, 0xEE, 0x43, 0xAC, 0x70, 0x93, 0x2C, 0xBC, 
+0x66, 0x33, 0x37, 0xD9, 0xE1, 0x71, 0x2F, 0xD0, 
+0x5A, 0xB8, 0x32, 0x8C, 0xE7, 0x98, 0x13, 0x8C, 
+0x61, 0x94, 0x6C, 0xAA, 0x38, 0xCF, 0xAD, 0xF6, 
+0xBE, 0x40, 0xA9, 0x6F, 0x02, 0xFA, 0x16, 0x5C, 
+0x70, 0x2E, 0x55, 0xCC, 0x5F, 0x5A, 0xF7, 0x07, 
+0x95, 0xB0, 0x18, 0xB7, 0xF0, 0x4A, 0xFA, 0xCD, 
+0xB4, 0xA8, 0x92, 0x3F, 0x71, 0xED, 0x8F, 0x54, 
+0xC0, 0x35, 0x1F, 0x2E, 0xC7, 0xF2, 0xBE, 0x4B, 
+0x96, 0x4F, 0x05, 0xB7, 0x3E, 0x9E, 0x40, 0xA9, 
+0x2A, 0xC7, 0x1C, 0x2D, 0x91, 0x5A, 0xBB, 0x31, 
+0xB1, 0x4F, 0xE1, 0x3F, 0x34, 0x27, 0x4D, 0xAD, 
+0x19, 0x34, 0xC5, 0x4F, 0x2C, 0x32, 0x24, 0x3E, 
+0x9E, 0x50, 0x1F, 0xC2, 0x57, 0x63, 0xFF, 0x45, 
+0xBE, 0x73, 0x72, 0xED, 0xE6, 0x01, 0x35, 0x33, 
+0xCD, 0x14, 0xC2, 0xF4, 0xFB, 0xEF, 0x47, 0x55, 
+0x3F, 0x4B, 0xD0, 0x05, 0xF0, 0x38, 0x2A, 0x37, 
+0xAF, 0x02, 0xD9, 0x25, 0xDD, 
+
+0x20, 0xCE, 0x9F, 0xBC, 0x8E, 0x72, 0x30, 0xBB, 
+0xE1, 0xC8, 0x3C, 0x08, 0x89, 0x34, 0x73, 0x4C, 
+0x8D, 0x0C, 0x00, 0x1E, 0xA4, 0xD4, 0xDA, 0x55, 
+0x18, 0x8C, 0xF0, 0xA4, 0x2F, 0xE9, 0x06, 0x30, 
+0xDE, 0x73, 0xDA, 0x14, 0xA8, 0x82, 0xC3, 0x94, 
+0xAE, 0x12, 0xC5, 0x55, 0x5B, 0x15, 0x42, 0x75, 
+0x7A, 0xC3, 0x79, 0x05, 0x09, 0x63, 0x9A, 0xC4, 
+0xD4, 0xCA, 0x1D, 0x08, 0x75, 0x68, 0x78, 0x07, 
+0x7D, 0xD2, 0x37, 0xEB, 0x8B, 0x25, 0xAC, 0xF4, 
+0x90, 0x5F, 0x4A, 0x2D, 0xE4, 0x4F, 0x08, 0x3B, 
+0x3D, 0xF3, 0x9F, 0xA4, 0x8E, 0xEE, 0xE6, 0x47, 
+0x4F, 0x3A, 0x6D, 0xBC, 0xB7, 0xA9, 0x4C, 0x1D, 
+0x82, 0x4E, 0x7A, 0x29, 0xC9, 0x01, 0xFF, 0xC6, 
+0x6F, 0x63, 0x4C, 0xE3, 0x0A, 0x10, 0x3F, 0x07, 
+0xB4, 0x3F, 0x71, 0x55, 0x8D, 0xE3, 0xEF, 0xFC, 
+0x76, 0xF6, 0x0A, 0xC3, 0xE9, 0xA9, 0x28, 0x03, 
+0xB0, 0xDF, 0x15, 0x0E, 0xD0, 0x68, 0x17, 0x7D, 
+0xC7, 0xC2, 0x51, 0x1A, 0x0D, 0x24, 0x21, 0x21, 
+0x45, 0xFF, 0xEA, 0x17, 0xFB, 0xBF, 0x59, 0x63, 
+0x03, 0x4F, 0x62, 0xEF, 0x1C, 0xDA, 0xF5, 0x00, 
+0x8F, 0x9D, 0x2B, 0x81, 0x62, 0x9F, 0xED, 0xA6, 
+0x6D, 0xA0, 0x57, 0x16, 0x08, 0x9E, 0x07, 0xB9, 
+0xF3, 0x8B, 0x78, 0xA8, 0x6E, 0x15, 0x53, 0x26, 
+0xE5, 0x7A, 0xE4, 0xBA, 0x09, 0xC6, 0x25, 0x41, 
+0x1A, 0x24, 0x9B, 0xE0, 0x16, 0x62, 0xCF, 0x67, 
+0xB6, 0xA1, 0xEF, 0x8B, 0x4D, 0xCC, 0xC3, 0xB6, 
+0xB6, 0x89, 0x6C, 0x79, 0x02, 0x56, 0x79, 0x87, 
+0xF4, 0xA7, 0x4B, 0x1D, 0xBC, 0xC0, 
+
+0x34, 0xF6, 0x75, 0xEA, 0x0F, 0x48, 0x36, 0xB3, 
+0x59, 0x85, 0x62, 0x0F, 0xEF, 0x08, 0xA8, 0xEB, 
+0x6F, 0x83, 0x96, 0x3D, 0xDA, 0x81, 0x49, 0xF3, 
+0x9D, 0xBD, 0x99, 0x93, 0x3B, 0x86, 0xF3, 0x9F, 
+0xEF, 0x0F, 0x16, 0x0F, 0xBD, 0x58, 0x11, 0x93, 
+0x1E, 0xF2, 0xAB, 0x31, 0xB0, 0x2E, 0x8B, 0x3D, 
+0x57, 0x97, 0x6F, 0x54, 0xA2, 0x75, 0x04, 0xBE, 
+0x9E, 0xF2, 0x57, 0x23, 0xC7, 0x3C, 0x4A, 0x4B, 
+0xF0, 0xBC, 0x61, 0x53, 0x88, 0xC5, 0x91, 0x34, 
+0x58, 0xEE, 0x16, 0xA3, 0xB7, 0x68, 0xDE, 0x60, 
+0x99, 0x50, 0xF6, 0x3B, 0x14, 0x55, 0xC0, 0xE7, 
+0xEE, 0x34, 0xA4, 0x29, 0xED, 0xD5, 0x5E, 0x6A, 
+0xD2, 0xAD, 0x09, 0xCD, 0x21, 0x63, 0x7D, 0xAD, 
+0xCC, 0xB1, 0x15, 0xD4, 0x4A, 0x12, 0x7A, 0xD2, 
+0xB5, 0xE3, 0x79, 0x7A, 0x88, 0xFE, 0x57, 0x77, 
+0xB9, 0xE6, 0x08, 0xE4, 0xA4, 0x62, 0x7A, 0xED, 
+0xBF, 0x4F, 0xC2, 0xD8, 0x66, 0x4A, 0x3D, 0xA8, 
+0xEC, 0xC6, 0x1C, 0xE7, 0xAF, 0xC4, 0x2B, 0x3B, 
+0xB5, 0x5D, 0xF0, 0x33, 0xBA, 0xF2, 0x61, 0x99, 
+0x92, 0xCF, 0x34, 0x19, 0x99, 0x43, 0xF5, 0x9F, 
+0xF6, 0x64, 0x5A, 0x40, 0x87, 0x53, 0x2D, 0x16, 
+0x75, 0x68, 0x31, 0xAA, 0x61, 0xCF, 0x1F, 0xC3, 
+0x32, 0x5B, 0x78, 0xFB, 0x8D, 0x67, 0x1F, 0x16, 
+0x80, 0x3A, 0x7E, 0x05, 0x45, 0x7A, 0x5E, 0x43, 
+0x0A, 0x2E, 0xE1, 0x22, 0xA8, 0x96, 0x79, 0xD5, 
+0xC4, 0x2E, 0xE0, 0x1F, 0x43, 0x1B, 0x8D, 0xDC, 
+0xBA, 0xCF, 0x7D, 0xF2, 0xD1, 0x70, 0xF6, 0x65, 
+0x44, 0xCE, 0x2B, 0x08, 0x0D, 0x43, 0xC4, 
+
+0xF1, 0x1E, 0x0E, 0xFC, 0x7A, 0x10, 0x39, 0x3A, 
+0x1E, 0xAD, 0x9E, 0x81, 0xC9, 0x04, 0xDB, 0x02, 
+0x15, 0xC4, 0x9F, 0x6F, 0xD8, 0xF4, 0xCE, 0x77, 
+0x00, 0x46, 0xB9, 0x0C, 0xD1, 0x4B, 0x1D, 0x6C, 
+0x44, 0xFA, 0x19, 0x09, 0xB6, 0xEB, 0x34, 0x4A, 
+0xC2, 0xDF, 0xF3, 0xF2, 0xE7, 0x3A, 0xE3, 0x6C, 
+0xD4, 0xAF, 0x22, 0xFF, 0x40, 0xBC, 0x5C, 0x4A, 
+0xBA, 0x7E, 0x54, 0xB1, 0xDB, 0xDF, 0x02, 0x2D, 
+0xC2, 0xAD, 0x7B, 0x3E, 0x4C, 0x8E, 0x42, 0x97, 
+0xDA, 0x00, 0xAA, 0x9F, 0x6A, 0x33, 0xB1, 0xFF, 
+0xBB, 0x5E, 0x5F, 0x10, 0xE6, 0xA0, 0xF3, 0x25, 
+0x8B, 0xAC, 0x36, 0xCF, 0xB6, 0x4A, 0x4D, 0x12, 
+0x77, 0xDA, 0x17, 0xC5, 0xD5, 0xD2, 0xCB, 0xA1, 
+0x95, 0xBB, 0xF1, 0xC9, 0xD8, 0xD3, 0xC1, 0xCC, 
+0x67, 0xCE, 0xAB, 0x2B, 0x6A, 0x69, 0x41, 0x7C, 
+0x7A, 0x47, 0x25, 0xE8, 0x39, 0xDC, 0x29, 0x31, 
+0x36, 0x20, 0xA0, 0x58, 0x2A, 0x1A, 0xF0, 0x22, 
+0xB9, 0x04, 0x10, 0xDA, 0x42, 0x2A, 0x6E, 0x1F, 
+0x78, 0x01, 0x7C, 0x05, 0xE3, 0x1B, 0xA1, 0xC3, 
+0x1A, 0x26, 0xA6, 0xF6, 0xBF, 0x54, 0xE9, 0x45, 
+0x7F, 0x87, 0x08, 0xB8, 0xAF, 0xF0, 0xF0, 0xB4, 
+0x96, 0xA4, 0xE3, 0xC0, 0x6A, 0x0B, 0xF9, 0x49, 
+0x89, 0x1B, 0x9E, 0x69, 0x42, 0xD8, 0x21, 0x57, 
+0x20, 0xC3, 0x95, 0xE5, 0xC6, 0x98, 0x06, 0x5E, 
+0x69, 0x1F, 0x14, 0x8E, 0x9F, 0xF8, 0x58, 0x7C, 
+0xEE, 0x13, 0xC7, 0x96, 0xC1, 0xE7, 0x71, 0x88, 
+0x96, 0x54, 0xF9, 0xA7, 0xD6, 0x56, 0x7B, 0x8C, 
+0xA5, 0x44, 0x42, 0xD3, 0x6B, 0x2E, 0x2E, 0x03, 
+
+0x7D, 0x39, 0xA9, 0xFF, 0x6A, 0x3D, 0x14, 0x95, 
+0x1C, 0xB6, 0xD8, 0x13, 0x4E, 0x41, 0xF5, 0xD5, 
+0x3F, 0x0F, 0xEC, 0x55, 0x1E, 0xAC, 0x95, 0x79, 
+0x28, 0x5D, 0xBD, 0xBE, 0xC4, 0x7D, 0x31, 0x1F, 
+0x64, 0x11, 0x9D, 0x0B, 0x8F, 0x06, 0xAB, 0x23, 
+0x2D, 0x8D, 0xC4, 0x10, 0xE7, 0x20, 0x95, 0x60, 
+0x76, 0xFC, 0xFA, 0xE3, 0x64, 0xC3, 0x67, 0x32, 
+0xFA, 0x3D, 0x5D, 0x8F, 0xEA, 0xE3, 0x4F, 0x95, 
+0x0A, 0x37, 0x4A, 0x30, 0x98, 0x93, 0x76, 0x20, 
+0x7F, 0xB7, 0xA9, 0xE0, 0x6C, 0x42, 0xE7, 0xF8, 
+0x57, 0xC8, 0xBE, 0xD3, 0x7A, 0x1E, 0xBE, 0xF8, 
+0x30, 0x8C, 0x29, 0x95, 0x71, 0x29, 0xFD, 0x79, 
+0x0E, 0x1D, 0xDD, 0x4B, 0x41, 0xE9, 0x5F, 0x7E, 
+0xF5, 0x4C, 0x7F, 0xA2, 0x10, 0x06, 0x57, 0x86, 
+0xAB, 0x10, 0xBF, 0x5A, 0xFE, 0xA2, 0x5F, 0xE8, 
+0x70, 0x8F, 0xCC, 0x00, 0xD9, 0x86, 0x86, 0x2C, 
+0x31, 0x2E, 0xF9, 0xAD, 0x56, 0x0C, 0xE9, 0xB9, 
+0x42, 0x39, 0x3B, 0x0D, 0x47, 0x60, 0x6F, 0xF9, 
+0xFB, 0xE8, 0xBE, 0xFC, 0x7D, 0x0B, 0xA3, 0xEC, 
+0x09, 0x6E, 0x0E, 0x0B, 0x6D, 0xEC, 0x60, 0x17, 
+0xE9, 0x34, 0x65, 0x6F, 0xA4, 0x84, 0x61, 0x13, 
+0xB2, 0xA6, 0xD3, 0x56, 0x06, 0xD9, 0xAE, 0x9A, 
+0x57, 0x78, 0x93, 0xF2, 0x86, 0xA5, 0x39, 0x27, 
+0x20, 0x36, 0x7A, 0xE7, 0xDE, 0x63, 0x02, 0x90, 
+0x52, 0x3B, 0x7D, 0x32, 0x24, 0xFA, 0x77, 0x3A, 
+0xFF, 0x6D, 0x6D, 0x38, 0xB1, 0xDC, 0x78, 0x06, 
+0x5A, 0xCE, 0x72, 0xE9, 0x72, 0x22, 0x72, 0x60, 
+0x90, 0x25, 0x72, 0x00, 0x28, 0xA2, 0xC0, 0x4A, 
+0x31, 
+
+0xFE, 0xCE, 0x1F, 0x8E, 0xEF, 0xB9, 0x81, 0xD1, 
+0x1D, 0x7F, 0x88, 0x64, 0xF5, 0x96, 0xE5, 0xD0, 
+0xCD, 0x3E, 0xBE, 0x2D, 0x39, 0x22, 0x61, 0xBA, 
+0xC9, 0x91, 0xA1, 0xF6, 0x6E, 0x5F, 0x7E, 0x2A, 
+0x90, 0xD0, 0x26, 0xB7, 0x6D, 0x1B, 0xAE, 0xE2, 
+0x54, 0xDE, 0x79, 0xF8, 0x39, 0x1E, 0xA0, 0xFC, 
+0x65, 0xE1, 0x45, 0x22, 0x7A, 0x1A, 0x06, 0x0A, 
+0xBB, 0x59, 0x41, 0xF5, 0xCF, 0xC0, 0x4C, 0x76, 
+0xB7, 0x98, 0xF8, 0x2F, 0x32, 0xE6, 0x5B, 0xC3, 
+0x70, 0xF3, 0xF5, 0x18, 0xC5, 0x0B, 0xAF, 0x82, 
+0x4A, 0x17, 0xEF, 0xA5, 0x96, 0xE2, 0x20, 0xBA, 
+0xE5, 0x23, 0x55, 0xD2, 0x85, 0xA3, 0x1C, 0x1E, 
+0x46, 0xDA, 0xCF, 0xD3, 0xAC, 0x56, 0xF6, 0x94, 
+0xFE, 0x14, 0xBB, 0x15, 0xD6, 0xA0, 0xF3, 0x5B, 
+0x5C, 0x62, 0xA3, 0xEC, 0xF8, 0x23, 0x75, 0x62, 
+0x5D, 0xB9, 0x47, 0x46, 0x25, 0xF1, 0x9E, 0x88, 
+0xC0, 0xF7, 0x5A, 0xC2, 0xA7, 0x97, 0xEA, 0xB1, 
+0x35, 0x70, 0x02, 0x33, 0x29, 0xA9, 0xF9, 0x36, 
+0xC8, 0xF0, 0x70, 0xCB, 0xD0, 0xD4, 0x07, 0x26, 
+0xD6, 0x9C, 0x62, 0x37, 0xEB, 0xDD, 0xE1, 0x71, 
+0x10, 0x49, 0x52, 0x86, 0x71, 0xC1, 0xB7, 0x78, 
+0xE4, 0x85, 0xC2, 0x34, 0x3E, 0x83, 0xF2, 0xBE, 
+0x31, 0x51, 0x65, 0xF4, 0x42, 0xE4, 0x66, 0x85, 
+0xE8, 0xEF, 0xDD, 0xD9, 0xE0, 0x41, 0x3E, 0x25, 
+0xF2, 0x9D, 0xCA, 0x48, 0xF3, 0x55, 0x99, 0x83, 
+0xD6, 0xF3, 0x96, 0x37, 0xF5, 0x18, 0x90, 0x2E, 
+0xA4, 0xA7, 0xE1, 0x08, 0x89, 0xFA, 0x54, 0x22, 
+0xE8, 0x1D, 0x8E, 0xFE, 0x94, 0xED, 0x86, 0xF2, 
+0x21, 0xE4, 
+
+0xE1, 0x07, 0xBB, 0xC9, 0x51, 0x1C, 0xA7, 0x07, 
+0xE9, 0x61, 0x25, 0xB4, 0x69, 0x8D, 0x72, 0xF1, 
+0xF0, 0x12, 0xBB, 0x01, 0xD0, 0xCA, 0x4F, 0x29, 
+0x42, 0x6B, 0x97, 0x7E, 0xC1, 0x8B, 0x1D, 0x9B, 
+0x64, 0xD9, 0x21, 0x24, 0xF3, 0xE0, 0xEA, 0x72, 
+0xD3, 0x5E, 0xEB, 0x71, 0x27, 0xEE, 0xEF, 0x09, 
+0x3A, 0xDF, 0x73, 0x2E, 0xBE, 0x30, 0x57, 0xCD, 
+0xEB, 0x49, 0x28, 0x83, 0x58, 0xB6, 0xBB, 0x91, 
+0x90, 0xAC, 0x38, 0xBF, 0xC5, 0x6A, 0x6F, 0x6E, 
+0x2F, 0xFF, 0x98, 0xD2, 0xFF, 0xE1, 0xB6, 0x5C, 
+0x10, 0x3F, 0xBB, 0xA5, 0xDB, 0xC8, 0x56, 0x64, 
+0x0D, 0xD6, 0xBE, 0xD2, 0x76, 0xF0, 0x33, 0xA0, 
+0x37, 0xD1, 0xC6, 0xF1, 0x32, 0x96, 0x84, 0x17, 
+0xE9, 0x4D, 0x37, 0x1B, 0x76, 0x60, 0x5F, 0xAA, 
+0xD0, 0x70, 0xEF, 0xA7, 0x83, 0x48, 0xC6, 0xAE, 
+0xB0, 0xCF, 0x6B, 0x72, 0x2D, 0x43, 0x7A, 0x7C, 
+0x18, 0x25, 0x6B, 0xC7, 0x16, 0x60, 0x5C, 0x5D, 
+0xF9, 0x1E, 0xE4, 0x0B, 0xAD, 0x7B, 0x06, 0x23, 
+0xCA, 0xB8, 0x46, 0x05, 0xFC, 0x47, 0xBF, 0xCC, 
+0xF7, 0x78, 0x9A, 0xB6, 0xDE, 0x12, 0xA0, 0x5D, 
+0x1C, 0x1B, 0xF5, 0x36, 0x77, 0x9E, 0x8F, 0x95, 
+0x7E, 0x3F, 0x5F, 0x82, 0xE7, 0xF5, 0x3D, 0xF6, 
+0xEF, 0x13, 0x95, 0xB5, 0xEE, 0x3B, 0x1F, 0x0E, 
+0x20, 0x02, 0xEA, 0x85, 0xFB, 0x09, 0x84, 0xCE, 
+0x93, 0x8D, 0x48, 0x67, 0xCC, 0xA0, 0x98, 0x2E, 
+0xFF, 0xD4, 0x5D, 0x45, 0x81, 0x0D, 0x43, 0xA5, 
+0xD5, 0xC9, 0xD1, 0x4D, 0x5B, 0x3A, 0x9F, 0x4D, 
+0x85, 0x8E, 0x95, 0x6A, 0xD7, 0x98, 0x85, 0x95, 
+0x6F, 0x3E, 0x1E, 
+
+0x8F, 0x79, 0x64, 0x19, 0x20, 0xB6, 0x73, 0xAD, 
+0x05, 0xF9, 0x92, 0xB5, 0x64, 0xFD, 0x00, 0x8A, 
+0xD1, 0x35, 0x86, 0xF9, 0x1B, 0x1C, 0x6C, 0x48, 
+0x2A, 0x54, 0x07, 0xCD, 0x59, 0xD7, 0xC2, 0x1B, 
+0xA5, 0xF6, 0x25, 0x80, 0xF2, 0xC7, 0x4D, 0xE6, 
+0xC8, 0xB1, 0x6B, 0x02, 0x90, 0x19, 0x82, 0xCD, 
+0xEB, 0x35, 0xC5, 0xB9, 0x9C, 0xE6, 0x18, 0xBF, 
+0xD5, 0xFA, 0x16, 0x72, 0x5E, 0x41, 0x1B, 0x85, 
+0xB3, 0xA6, 0x96, 0xA8, 0x3C, 0x84, 0x7A, 0xB9, 
+0xCE, 0xA5, 0x18, 0xA8, 0xE5, 0x78, 0x9C, 0x1D, 
+0x2D, 0x5F, 0x8C, 0x7C, 0x09, 0xF9, 0xE5, 0xFB, 
+0xDD, 0x56, 0xD9, 0x95, 0x60, 0xB1, 0x12, 0xD6, 
+0x86, 0x6C, 0x3B, 0xE8, 0x2C, 0x22, 0xE3, 0xAB, 
+0xEF, 0xC5, 0x8E, 0x0A, 0x3F, 0x6E, 0x3A, 0x1B, 
+0x5D, 0x39, 0x96, 0x1B, 0xC3, 0x8B, 0x59, 0x1C, 
+0xC3, 0xE4, 0x10, 0xD7, 0x29, 0x43, 0x14, 0xEB, 
+0x63, 0x88, 0x53, 0x6E, 0x44, 0x8F, 0xAD, 0x5A, 
+0xDD, 0xF4, 0x8A, 0xD0, 0x20, 0x21, 0x02, 0x6B, 
+0x81, 0x05, 0xB1, 0xC4, 0xD2, 0xA1, 0x48, 0x70, 
+0xF7, 0x66, 0xE3, 0xBE, 0x15, 0xB5, 0xF5, 0x43, 
+0x66, 0x12, 0x94, 0x56, 0x88, 0x31, 0xE6, 0xAC, 
+0xC4, 0x54, 0x48, 0x9F, 0x51, 0x09, 0x3D, 0x95, 
+0xA4, 0x83, 0x62, 0xD8, 0x47, 0x3D, 0xEB, 0xC4, 
+0x5B, 0x6D, 0x38, 0x98, 0x93, 0x89, 0xF0, 0x1C, 
+0x40, 0x00, 0x02, 0xE6, 0xCA, 0xDB, 0x95, 0xE6, 
+0x8E, 0x44, 0xE0, 0x5F, 0xC9, 0x62, 0x55, 0x3F, 
+0xC8, 0x48, 0x5D, 0x12, 0xE7, 0x01, 0x87, 0x5C, 
+0x07, 0xF3, 0x0E, 0x0F, 0xD7, 0x89, 0x04, 0x3F, 
+0x2A, 0x10, 0x70, 0xCC, 
+
+0x3C, 0xB9, 0x3D, 0x28, 0x90, 0xEB, 0x21, 0x7D, 
+0x9E, 0xCC, 0x4D, 0xA5, 0x84, 0xF6, 0x96, 0x93, 
+0x5D, 0x12, 0x84, 0x9E, 0x71, 0x56, 0x5C, 0xD4, 
+0x71, 0xB9, 0x9F, 0xDF, 0x2B, 0x51, 0x43, 0xC5, 
+0x1E, 0x98, 0xBE, 0x8D, 0xB8, 0xFE, 0x3A, 0x9C, 
+0x22, 0x25, 0xF2, 0x0F, 0x7F, 0x52, 0xB3, 0x9A, 
+0xB6, 0xD8, 0x31, 0xE9, 0xC3, 0x9A, 0x86, 0x89, 
+0xB6, 0x1B, 0x63, 0x3F, 0xC0, 0x37, 0x7D, 0xFF, 
+0x80, 0xC3, 0x28, 0x0D, 0xF1, 0x09, 0xB1, 0xD1, 
+0x38, 0xA8, 0x99, 0xD9, 0x81, 0x32, 0x62, 0x16, 
+0xDE, 0x2D, 0x16, 0x07, 0x78, 0x5D, 0xCB, 0xB8, 
+0x3E, 0xB8, 0x01, 0x5A, 0xB5, 0x21, 0x6E, 0xA1, 
+0x7B, 0xD8, 0x64, 0x00, 0xFC, 0x6B, 0xDF, 0xA4, 
+0x4D, 0x07, 0x81, 0xEE, 0x76, 0x69, 0x43, 0x9F, 
+0x90, 0x09, 0x24, 0x26, 0x72, 0xC5, 0x2F, 0xF5, 
+0x50, 0x31, 0xC9, 0xB9, 0x3B, 0xAE, 0xDE, 0x1F, 
+0x6B, 0xCC, 0xFA, 0xC6, 0xCB, 0xBC, 0xEC, 0x75, 
+0x91, 0x3A, 0x0C, 0x5D, 0x13, 0xB9, 0x3E, 0x16, 
+0x3C, 0xE4, 0xAC, 0x56, 0x60, 0x0C, 0x92, 0x2D, 
+0x05, 0xD6, 0x1A, 0x01, 0xC4, 0x94, 0xAD, 0x19, 
+0xEE, 0x3C, 0x79, 0x5D, 0x5C, 0x49, 0x8A, 0x67, 
+0xAF, 0x19, 0x1B, 0xBA, 0x2C, 0x30, 0xA2, 0x47, 
+0xF2, 0x35, 0x00, 0x15, 0xF0, 0x7B, 0xC1, 0xA2, 
+0xB3, 0x9C, 0x49, 0x83, 0x81, 0x26, 0x71, 0x7E, 
+0xF0, 0x34, 0x01, 0x02, 0xC0, 0xDE, 0x44, 0xFA, 
+0xFA, 0x5F, 0x84, 0xBA, 0x23, 0x16, 0x39, 0x29, 
+0x77, 0xD7, 0x5D, 0x17, 0xBA, 0x9A, 0x39, 0x93, 
+0xBE, 0xBB, 0x01, 0x67, 0xD6, 0x23, 0x2B, 0x7F, 
+0x9E, 0x29, 0x61, 0xCC, 0x4D, 
+
+0xCE, 0x24, 0x29, 0x8D, 0x54, 0x03, 0x84, 0x07, 
+0xF8, 0x5A, 0x69, 0x9A, 0x3E, 0x01, 0xF5, 0x63, 
+0xFC, 0x16, 0xB6, 0xF0, 0x20, 0x39, 0x81, 0x10, 
+0xCD, 0x82, 0xD5, 0x5D, 0xAE, 0xED, 0xA6, 0x86, 
+0xA5, 0xFF, 0xF8, 0x85, 0x6E, 0x0A, 0x36, 0x2F, 
+0x6B, 0xF6, 0x62, 0xAB, 0x12, 0xE6, 0x41, 0x2B, 
+0x3D, 0x4D, 0xDB, 0x80, 0x26, 0xC9, 0xAD, 0xE4, 
+0x93, 0x05, 0x0C, 0x28, 0x23, 0xFF, 0x9B, 0xBF, 
+0x85, 0x43, 0x82, 0xCD, 0x6E, 0x5F, 0xD4, 0x08, 
+0x31, 0xFA, 0x52, 0x78, 0x99, 0x15, 0xD0, 0xD5, 
+0xBD, 0x7C, 0xD4, 0x91, 0xCB, 0x0F, 0x53, 0xE7, 
+0x5D, 0x53, 0xE8, 0xDA, 0xAB, 0x32, 0xDF, 0xD8, 
+0xCF, 0xF5, 0x95, 0xF1, 0x41, 0xCF, 0x37, 0x2B, 
+0xF0, 0x51, 0x93, 0x33, 0xAA, 0xFF, 0x36, 0x07, 
+0x3C, 0xF9, 0x40, 0x18, 0x05, 0xA6, 0x1B, 0xEF, 
+0x9E, 0x5E, 0x71, 0x77, 0x75, 0xD2, 0x23, 0xD6, 
+0x0F, 0x5A, 0x84, 0x73, 0x6C, 0x23, 0x17, 0x18, 
+0x78, 0x84, 0x41, 0xEC, 0x76, 0xD5, 0x66, 0xDE, 
+0xF3, 0x22, 0x6E, 0x03, 0xD0, 0xF0, 0xFE, 0x67, 
+0x8C, 0x4B, 0x4F, 0x43, 0xC8, 0x94, 0xBE, 0x8D, 
+0x3C, 0x34, 0xA9, 0x1E, 0xC2, 0x27, 0x64, 0x90, 
+0x13, 0x85, 0xA7, 0xC1, 0xC9, 0xF3, 0x6E, 0x50, 
+0x0B, 0xD5, 0xD1, 0x38, 0xB0, 0x53, 0x0D, 0xDC, 
+0x5A, 0xE8, 0x6B, 0x30, 0x91, 0xA2, 0x73, 0xDA, 
+0x5C, 0xB3, 0x77, 0x36, 0xBC, 0xE1, 0xBF, 0xE0, 
+0x85, 0x3B, 0xAC, 0x73, 0x13, 0xD1, 0x72, 0x0D, 
+0x36, 0x47, 0xAE, 0x77, 0xA9, 0x91, 0x3B, 0x65, 
+0x3F, 0x20, 0xED, 0x65, 0x02, 0x98, 0xAE, 0x1B, 
+0x6B, 0x22, 0x17, 0x9F, 0x10, 0x64, 
+
+0x43, 0xA6, 0xF3, 0x60, 0x88, 0x3A, 0x74, 0x66, 
+0xA5, 0x48, 0xB2, 0x8F, 0x82, 0x94, 0xCC, 0xFD, 
+0xCB, 0x15, 0x13, 0x60, 0x9B, 0xE4, 0x97, 0x96, 
+0x2E, 0x36, 0xF9, 0xB3, 0x7B, 0xEC, 0xF1, 0xC3, 
+0x54, 0xF0, 0xBB, 0xA0, 0xA8, 0x4A, 0x28, 0xB7, 
+0x3A, 0x2E, 0xEC, 0x36, 0x5D, 0x0F, 0x26, 0xDE, 
+0x3A, 0x24, 0x53, 0x13, 0x3F, 0xEA, 0x7C, 0x03, 
+0xEB, 0x83, 0x53, 0x76, 0x8E, 0x83, 0xBC, 0x15, 
+0x77, 0x04, 0xCC, 0xEF, 0x16, 0x7A, 0x40, 0xBA, 
+0x14, 0xAB, 0x79, 0xB3, 0x2C, 0x18, 0xCE, 0xEC, 
+0xC0, 0xF0, 0x66, 0x61, 0x99, 0xF7, 0x31, 0x3C, 
+0x69, 0x66, 0xB5, 0xC0, 0xF4, 0x22, 0x4E, 0xCC, 
+0x8A, 0xE9, 0xAC, 0x94, 0xC7, 0xC4, 0xC0, 0x88, 
+0xCB, 0xF3, 0x4C, 0x49, 0xD1, 0x87, 0x55, 0xB8, 
+0x15, 0x34, 0x47, 0x4B, 0xF0, 0x5B, 0xB3, 0xBB, 
+0x77, 0x15, 0xED, 0x3F, 0xF8, 0x4F, 0xD3, 0x9D, 
+0xFE, 0x8C, 0xD5, 0x4C, 0xE1, 0x2B, 0x74, 0x6F, 
+0xA3, 0xBF, 0x03, 0x8A, 0xDF, 0xC2, 0xBF, 0xC5, 
+0x27, 0x0F, 0xAF, 0x53, 0x42, 0x9A, 0x69, 0x39, 
+0x33, 0xC7, 0x73, 0x67, 0x2E, 0xCE, 0x88, 0xD6, 
+0xCF, 0xBB, 0x7C, 0xCB, 0x0D, 0x84, 0x0E, 0xB9, 
+0x54, 0xFD, 0x51, 0x9F, 0xE3, 0x62, 0x87, 0xC7, 
+0x5F, 0x08, 0xFE, 0x4C, 0x2E, 0xEA, 0x7F, 0xF2, 
+0xC6, 0xB8, 0xEB, 0xDC, 0x35, 0x02, 0x1A, 0x6C, 
+0xEB, 0xC0, 0x73, 0x82, 0x34, 0x52, 0xBE, 0x55, 
+0x14, 0xB9, 0x36, 0x3D, 0xC8, 0x88, 0x03, 0x51, 
+0x35, 0x7D, 0x89, 0x1B, 0x36, 0xA9, 0xD4, 0x03, 
+0xA0, 0x86, 0x66, 0x90, 0x10, 0xC0, 0xBD, 0x39, 
+0x5D, 0xB5, 0x4A, 0x67, 0x49, 0x2A, 0xBC, 
+
+0x05, 0x95, 0xB2, 0x93, 0x14, 0x73, 0xB3, 0x70, 
+0x89, 0xE4, 0xCC, 0x29, 0x8C, 0x60, 0x49, 0x46, 
+0xBD, 0xC5, 0x33, 0x19, 0x32, 0xEE, 0xC7, 0xFA, 
+0x6B, 0x93, 0x8B, 0x09, 0x75, 0x6D, 0xC6, 0x81, 
+0xFA, 0xE9, 0xB1, 0x91, 0xBE, 0x74, 0xDF, 0x09, 
+0xB6, 0xDB, 0x98, 0x67, 0x26, 0x7E, 0x8C, 0x0F, 
+0x27, 0x5D, 0x38, 0x22, 0xCE, 0x70, 0x60, 0x7A, 
+0xA1, 0xA5, 0x2F, 0xCC, 0x71, 0x18, 0x0C, 0x17, 
+0x3D, 0x2A, 0xDD, 0xEC, 0xFA, 0xCB, 0x5B, 0x0E, 
+0xD4, 0x4C, 0x3D, 0xED, 0x8A, 0xDE, 0xA5, 0x3D, 
+0x1E, 0x35, 0xE4, 0xAB, 0xAF, 0x14, 0x5F, 0xF0, 
+0x3F, 0xA0, 0x11, 0x5A, 0xC5, 0x64, 0x40, 0xEC, 
+0x11, 0x90, 0x70, 0xDE, 0x01, 0x7F, 0xAD, 0xAE, 
+0xE1, 0x30, 0x94, 0x6E, 0x1E, 0xE3, 0x22, 0xCD, 
+0xC3, 0x91, 0x25, 0x77, 0x25, 0x9A, 0x62, 0xFA, 
+0x43, 0xAC, 0x32, 0x8E, 0xE7, 0xB7, 0xF2, 0xD0, 
+0x54, 0xD4, 0xC4, 0x6C, 0xB3, 0x88, 0xDD, 0xDA, 
+0x82, 0xD6, 0xA4, 0x33, 0xC1, 0xAA, 0x42, 0xD1, 
+0x26, 0x0C, 0xE7, 0xEC, 0x81, 0x87, 0x50, 0x56, 
+0x00, 0xC0, 0xAC, 0xEE, 0x96, 0xBC, 0x67, 0xEB, 
+0x03, 0x58, 0x38, 0x67, 0x71, 0x1B, 0xD8, 0x59, 
+0xA2, 0xC5, 0x77, 0xA4, 0xD5, 0x39, 0x95, 0x13, 
+0x60, 0x3C, 0x4F, 0x28, 0xD4, 0xB2, 0x9E, 0x15, 
+0x3D, 0xB5, 0x24, 0x37, 0x32, 0xCC, 0x2C, 0x22, 
+0xD3, 0x02, 0xAF, 0x60, 0x7F, 0x8E, 0x56, 0x59, 
+0xC7, 0x52, 0x1A, 0xF7, 0x43, 0x70, 0x6E, 0xE6, 
+0xFF, 0x2C, 0x94, 0x22, 0x85, 0x28, 0xEF, 0x24, 
+0x83, 0x7F, 0x80, 0xAF, 0x4E, 0x7F, 0x37, 0x21, 
+0x78, 0x9B, 0x31, 0x2E, 0x3A, 0xC0, 0xCE, 0x49, 
+
+0x1C, 0x1A, 0x99, 0x58, 0xBA, 0x52, 0xC5, 0x41, 
+0xFB, 0x0A, 0x17, 0xAF, 0x23, 0x3B, 0xBF, 0x3D, 
+0x5B, 0x70, 0x45, 0xB9, 0xC2, 0xF4, 0xD5, 0xB2, 
+0xF9, 0x5E, 0x82, 0xC0, 0x19, 0x99, 0x3F, 0xCB, 
+0x22, 0xEC, 0x10, 0xF3, 0x26, 0xBA, 0xC7, 0xD4, 
+0x9B, 0x2D, 0xFF, 0x3C, 0x39, 0xE4, 0xFB, 0x4D, 
+0xC5, 0x5A, 0x01, 0x43, 0xBC, 0x83, 0x9F, 0x17, 
+0x4E, 0xDC, 0xFE, 0x4B, 0x2E, 0x3C, 0x67, 0xA9, 
+0x2A, 0xDB, 0xC4, 0x28, 0xB6, 0xD7, 0xB1, 0x24, 
+0xDB, 0x10, 0xE6, 0x27, 0xF9, 0x29, 0xFC, 0x63, 
+0x57, 0x72, 0xB6, 0xA0, 0x8C, 0x25, 0x7F, 0x67, 
+0x94, 0x70, 0xBB, 0xBF, 0x2F, 0x60, 0xF4, 0xF3, 
+0x98, 0x85, 0xBD, 0xE6, 0x50, 0xDC, 0xA6, 0x0E, 
+0x96, 0xE7, 0x9F, 0x3C, 0x4F, 0xAE, 0xC7, 0x95, 
+0xDB, 0x67, 0xEC, 0x20, 0x54, 0x2B, 0x34, 0xFA, 
+0x04, 0x98, 0x77, 0xEE, 0xAD, 0xE4, 0x65, 0x94, 
+0x94, 0xD6, 0x6D, 0xE0, 0x81, 0x15, 0x1F, 0x10, 
+0x0F, 0x34, 0xEE, 0x64, 0x47, 0xD4, 0x15, 0x07, 
+0xF4, 0x38, 0xC5, 0xEE, 0x06, 0x6F, 0x8A, 0xE6, 
+0x7A, 0x18, 0x2E, 0x2E, 0xAB, 0xE7, 0xD9, 0x5C, 
+0xD6, 0x58, 0xE4, 0x22, 0x21, 0xFB, 0xE0, 0x34, 
+0x46, 0xE1, 0xBE, 0x89, 0x17, 0xC7, 0x8A, 0xD7, 
+0x22, 0xE6, 0xE4, 0xDF, 0xA8, 0xCE, 0xFF, 0x2D, 
+0xC4, 0x63, 0xF8, 0x83, 0x70, 0x3B, 0x4F, 0xB6, 
+0x62, 0xA0, 0x50, 0x46, 0x30, 0xB1, 0x3E, 0xE8, 
+0x2E, 0xBE, 0xFC, 0xAA, 0x5C, 0xF5, 0x68, 0xB3, 
+0x47, 0xBF, 0x65, 0x3E, 0x64, 0x72, 0x6F, 0x90, 
+0x89, 0x88, 0x2F, 0x82, 0x35, 0xE9, 0xF6, 0xD1, 
+0xFA, 0xEE, 0x25, 0xDC, 0xA0, 0x04, 0xD7, 0x0B, 
+0x1E, 
+
+0xED, 0x32, 0x54, 0xE8, 0x2D, 0x03, 0x35, 0xD5, 
+0x55, 0x62, 0x1F, 0xD2, 0x2A, 0xE5, 0x69, 0x47, 
+0xD6, 0xD8, 0x92, 0xBC, 0x3D, 0xF1, 0xAB, 0x57, 
+0xFE, 0x03, 0x12, 0xCE, 0xAD, 0xF8, 0x1D, 0x37, 
+0xB2, 0x40, 0x72, 0xD0, 0xC8, 0x38, 0xE2, 0xD1, 
+0xA4, 0x0F, 0xA0, 0x7F, 0x19, 0x72, 0xAF, 0x84, 
+0x53, 0x8B, 0x71, 0x66, 0xBF, 0x56, 0x19, 0x3A, 
+0xEA, 0x27, 0xFD, 0xF5, 0xB6, 0x43, 0xD4, 0xB8, 
+0x8B, 0x27, 0xE8, 0x82, 0x0B, 0x24, 0x3C, 0xD7, 
+0xFF, 0x31, 0xF3, 0x6D, 0x15, 0x5D, 0xBD, 0x1D, 
+0x93, 0x0F, 0x98, 0x4E, 0x16, 0x00, 0x94, 0xEE, 
+0xE6, 0x27, 0xBA, 0x19, 0xC2, 0xCE, 0x73, 0x63, 
+0x0C, 0x71, 0xEC, 0x27, 0x70, 0x69, 0x2E, 0x96, 
+0xA3, 0x20, 0x98, 0xCF, 0x2B, 0xDF, 0x50, 0x07, 
+0xC8, 0x10, 0xCD, 0xA0, 0x6B, 0x9E, 0xBF, 0xEC, 
+0xD3, 0x6E, 0x94, 0x70, 0x6B, 0x3F, 0xE1, 0x73, 
+0x10, 0xDB, 0x9E, 0x98, 0x5B, 0x5B, 0x97, 0xB9, 
+0x07, 0xF4, 0xD6, 0x55, 0xEC, 0x52, 0x86, 0x0E, 
+0x14, 0xD5, 0xF9, 0x5F, 0xA9, 0xFB, 0xE1, 0x96, 
+0x6E, 0xB1, 0xA8, 0xB0, 0xD3, 0xB1, 0x44, 0x08, 
+0xF8, 0xBC, 0x97, 0xC8, 0xF7, 0xE8, 0xD5, 0xC9, 
+0xD7, 0x9F, 0x2C, 0xCA, 0xC6, 0x35, 0x26, 0x4F, 
+0x2F, 0x83, 0xC0, 0x88, 0x91, 0x8D, 0x9A, 0xD7, 
+0xFC, 0x8A, 0x2A, 0x9C, 0x06, 0x8C, 0xFA, 0xC3, 
+0xF3, 0xD0, 0x0A, 0x21, 0xE3, 0x4E, 0xDF, 0x6A, 
+0xB4, 0x00, 0x9F, 0xF4, 0xB6, 0x6E, 0x41, 0xF2, 
+0x1A, 0x5E, 0xC8, 0x8B, 0x36, 0xC2, 0x81, 0xDA, 
+0x0C, 0x74, 0x20, 0xC5, 0x41, 0x60, 0xD1, 0x2A, 
+0xEB, 0xAA, 0x51, 0xCB, 0x38, 0x10, 0x94, 0x8B, 
+0xA2, 0x89, 
+
+0x90, 0xBB, 0xE5, 0x2E, 0xDE, 0xBA, 0xB1, 0x0F, 
+0x5C, 0xB8, 0x39, 0x67, 0xF9, 0x10, 0x1A, 0x72, 
+0x33, 0xA4, 0x9B, 0x47, 0x5C, 0xC3, 0x97, 0x66, 
+0xA9, 0x31, 0xC6, 0xD6, 0xD1, 0x64, 0x51, 0x19, 
+0x3A, 0xAC, 0xBF, 0x99, 0x1B, 0x1C, 0xEC, 0x32, 
+0x4F, 0x69, 0xC7, 0x6D, 0xBD, 0x4A, 0xEE, 0x29, 
+0x75, 0x66, 0x42, 0xF7, 0x40, 0x58, 0xE5, 0x05, 
+0x84, 0x18, 0x8A, 0x82, 0xE8, 0x8E, 0x13, 0x19, 
+0x89, 0x6F, 0xC1, 0xDD, 0x43, 0x0C, 0xC5, 0x63, 
+0xE2, 0xC4, 0xCC, 0x33, 0x61, 0xF4, 0xE6, 0x6B, 
+0x9B, 0x39, 0x5E, 0xA1, 0x8E, 0x80, 0x8C, 0x13, 
+0x1B, 0xE5, 0xD4, 0x4D, 0x7A, 0xDD, 0xF4, 0x52, 
+0x51, 0x0C, 0x0B, 0x1F, 0x23, 0x52, 0xC8, 0x52, 
+0xF8, 0xD4, 0x88, 0x3E, 0xAE, 0xB8, 0x98, 0xF8, 
+0x76, 0xB3, 0xAD, 0x6B, 0x20, 0xD4, 0x83, 0x87, 
+0x68, 0x45, 0x5C, 0xA8, 0xB3, 0xA0, 0xA4, 0xB9, 
+0xE7, 0x16, 0x06, 0x48, 0xEF, 0x45, 0x33, 0x29, 
+0xAB, 0x75, 0xE6, 0x9D, 0x83, 0x74, 0xF9, 0xF7, 
+0x22, 0xA0, 0xC5, 0xFF, 0x9E, 0x35, 0x6A, 0x46, 
+0x7B, 0x80, 0x21, 0xE7, 0x47, 0xD8, 0x4C, 0x37, 
+0xB1, 0x96, 0x05, 0xBD, 0xE2, 0x1B, 0xDF, 0xE9, 
+0x34, 0x4B, 0xBF, 0x03, 0x72, 0xB8, 0x47, 0x6E, 
+0xE4, 0xB8, 0xDB, 0x63, 0xD6, 0xC2, 0x62, 0x8E, 
+0xA8, 0x28, 0x1F, 0xC3, 0x1C, 0x35, 0x1F, 0x2C, 
+0xCD, 0x24, 0xAF, 0x6A, 0x38, 0x1D, 0xA1, 0xC8, 
+0x07, 0xA7, 0xB7, 0x04, 0x5A, 0xC6, 0x08, 0x4E, 
+0x29, 0xBB, 0xA2, 0x19, 0xFE, 0x54, 0x2F, 0xD8, 
+0xF3, 0xB3, 0x3E, 0x60, 0xFF, 0x27, 0xB1, 0x88, 
+0x2F, 0x2C, 0xB7, 0x66, 0x09, 0x97, 0xE5, 0x09, 
+0x77, 0x5F, 0x53, 
+
+0xD3, 0xA5, 0x23, 0x44, 0x45, 0xCA, 0x7B, 0xF1, 
+0x84, 0xF2, 0x59, 0x2F, 0x75, 0xC6, 0xC3, 0x49, 
+0xEC, 0x4C, 0xA2, 0x4A, 0xF9, 0xD1, 0xBB, 0x1D, 
+0xBB, 0x30, 0x2E, 0x18, 0xDD, 0xFF, 0xC8, 0x77, 
+0x2D, 0x8F, 0x09, 0x6F, 0x65, 0x5A, 0x64, 0x6B, 
+0xEB, 0x8A, 0xDF, 0xA2, 0x50, 0xBA, 0x5A, 0x54, 
+0x79, 0xE5, 0x14, 0x69, 0x42, 0x34, 0xE8, 0xA0, 
+0x62, 0xA5, 0x3A, 0x7A, 0x49, 0xB1, 0x8B, 0xA3, 
+0xD8, 0x73, 0x57, 0x70, 0xE3, 0x93, 0x04, 0xE4, 
+0x59, 0x86, 0x8A, 0x7B, 0xBE, 0x65, 0x48, 0x16, 
+0xB5, 0xE6, 0xAF, 0xC3, 0xBD, 0xFF, 0x3C, 0xDF, 
+0xF6, 0xCB, 0x60, 0xE4, 0xBA, 0x77, 0xF4, 0x4B, 
+0x95, 0x79, 0xCE, 0xD6, 0x36, 0xBD, 0xBF, 0x36, 
+0x42, 0x53, 0x15, 0xA3, 0x77, 0xB3, 0x9F, 0x68, 
+0x98, 0x6E, 0xB5, 0x01, 0x16, 0xA2, 0x26, 0x79, 
+0xB0, 0x1A, 0x65, 0x45, 0x30, 0xBB, 0x00, 0x4F, 
+0xE4, 0x7A, 0xD0, 0x31, 0xE5, 0x77, 0xD4, 0xC6, 
+0x6A, 0x72, 0xC5, 0xF2, 0xC1, 0xFC, 0x60, 0x74, 
+0x99, 0xD5, 0x25, 0xF2, 0x7B, 0x6D, 0xFE, 0x32, 
+0x1C, 0x90, 0xD0, 0xF9, 0xF0, 0xAE, 0x93, 0x20, 
+0xB4, 0xA0, 0xF7, 0xA5, 0x75, 0xE4, 0x83, 0x94, 
+0x80, 0x8C, 0x1E, 0x18, 0xD7, 0x1C, 0xA4, 0x5F, 
+0x0A, 0x08, 0xD2, 0xB1, 0x27, 0xC4, 0x0D, 0xA4, 
+0xBE, 0xE0, 0x36, 0x69, 0x19, 0x14, 0x9A, 0x58, 
+0x9C, 0xD5, 0x36, 0xE5, 0x46, 0xE1, 0x94, 0x66, 
+0xC3, 0x77, 0x78, 0x52, 0xF9, 0x2B, 0xDA, 0x95, 
+0xDC, 0xED, 0x6A, 0x6E, 0x58, 0xE9, 0x16, 0x34, 
+0x2A, 0x6B, 0xD5, 0x4E, 0xF2, 0x7E, 0xB8, 0x96, 
+0xEB, 0x9B, 0x4D, 0xD3, 0xFD, 0xB4, 0x33, 0x0D, 
+0x13, 0x26, 0x42, 0x3E, 
+
+0xF6, 0x0F, 0x59, 0xEC, 0xB0, 0xE1, 0x3D, 0x20, 
+0xB8, 0xD7, 0x3D, 0xF9, 0x59, 0xCF, 0xEF, 0xA9, 
+0xD7, 0xB2, 0x47, 0xBC, 0x87, 0x54, 0xD3, 0x7E, 
+0xF9, 0x2C, 0xDF, 0xB6, 0x0F, 0x86, 0x13, 0x02, 
+0x1C, 0xFA, 0xC0, 0x26, 0xDB, 0x49, 0x3B, 0x36, 
+0x60, 0xA2, 0x64, 0xD8, 0x91, 0xB1, 0x7C, 0x35, 
+0xEA, 0x2F, 0x5E, 0xF6, 0x99, 0xE2, 0x0B, 0x74, 
+0x1F, 0x76, 0xF6, 0x8F, 0x7E, 0x58, 0x63, 0x3E, 
+0xB6, 0xA2, 0xF3, 0x0A, 0xD4, 0xA5, 0x19, 0xB7, 
+0x45, 0x08, 0xDB, 0x52, 0x17, 0x1D, 0xB1, 0x00, 
+0x23, 0x0B, 0xD4, 0xC0, 0x86, 0x52, 0x49, 0xA8, 
+0x33, 0xAB, 0x0A, 0xD1, 0x51, 0x9C, 0x5D, 0x4B, 
+0x23, 0xF1, 0x93, 0x17, 0x6A, 0x48, 0xA6, 0x2F, 
+0x9D, 0x0D, 0x9A, 0x09, 0xBD, 0x19, 0xA0, 0x39, 
+0x60, 0xDE, 0xD1, 0xE4, 0x42, 0xD8, 0x34, 0xC0, 
+0x1D, 0xD3, 0x88, 0x29, 0xA8, 0x48, 0x75, 0x5C, 
+0xDF, 0x1D, 0xD4, 0x09, 0x53, 0x5E, 0x5D, 0x0A, 
+0x9A, 0x2D, 0x95, 0x83, 0xF6, 0x85, 0x2F, 0xAD, 
+0x8C, 0xFF, 0x55, 0xD8, 0x19, 0x56, 0xEE, 0x38, 
+0xD2, 0x25, 0x30, 0x27, 0x21, 0xB5, 0x10, 0xED, 
+0x24, 0xFB, 0x0E, 0x22, 0x55, 0xA2, 0xC3, 0x31, 
+0xDC, 0x1E, 0xA0, 0xC7, 0x4B, 0x1B, 0xC0, 0x14, 
+0x95, 0x25, 0x8F, 0x37, 0x3C, 0x75, 0x61, 0x44, 
+0x0B, 0x09, 0x81, 0x8C, 0x32, 0xE5, 0x59, 0x3D, 
+0xE3, 0xD5, 0x45, 0xDF, 0x11, 0xFF, 0x61, 0x9E, 
+0x01, 0x10, 0x5E, 0x91, 0x70, 0x18, 0x1D, 0x76, 
+0xC9, 0x74, 0x3C, 0xDD, 0x30, 0xB2, 0x01, 0xF5, 
+0xCD, 0xCD, 0x9F, 0xFC, 0xE3, 0x7A, 0xB9, 0xD1, 
+0x7F, 0xA4, 0x3C, 0x2E, 0x2C, 0x28, 0x25, 0xCC, 
+0x6C, 0x56, 0x3A, 0x80, 0xF9, 
+
+0x7E, 0x13, 0xEF, 0xE6, 0x6B, 0x69, 0x25, 0xE0, 
+0x81, 0xE9, 0x64, 0x4E, 0xE9, 0xFC, 0x00, 0x0C, 
+0xDD, 0x81, 0x66, 0xB1, 0xC9, 0x12, 0xC4, 0x98, 
+0x5F, 0x71, 0x2F, 0xFC, 0xFB, 0x24, 0x9E, 0x49, 
+0x5E, 0xFB, 0x20, 0xB9, 0x56, 0x06, 0x23, 0xD3, 
+0xD5, 0x80, 0x51, 0x25, 0xE6, 0x33, 0x55, 0xC0, 
+0x48, 0x28, 0x32, 0xDB, 0xBB, 0x48, 0x6D, 0xF6, 
+0x30, 0xA7, 0x4D, 0xC6, 0x54, 0x7A, 0x63, 0x5E, 
+0x54, 0x7A, 0xE4, 0xEB, 0x71, 0x03, 0xA7, 0x65, 
+0x1F, 0x36, 0x25, 0x32, 0xD0, 0x3F, 0xA1, 0x07, 
+0xAB, 0xE0, 0x17, 0x57, 0x18, 0x06, 0xCB, 0x2B, 
+0x78, 0x9F, 0xC3, 0xBD, 0x57, 0x13, 0x4B, 0x0A, 
+0x52, 0xFC, 0x87, 0x39, 0xFF, 0xE6, 0xE8, 0x89, 
+0x1D, 0x65, 0x61, 0x36, 0x6B, 0x93, 0xB8, 0xA9, 
+0x71, 0x4B, 0xB3, 0xD4, 0x87, 0xBF, 0xAF, 0x89, 
+0xB7, 0x57, 0x8F, 0x11, 0x3D, 0x36, 0xF2, 0x13, 
+0x89, 0x92, 0x7C, 0xD6, 0xFE, 0x18, 0x9F, 0x4A, 
+0x7F, 0xBE, 0x34, 0x1F, 0xAF, 0xCB, 0xFB, 0xCA, 
+0x74, 0x7B, 0x4D, 0x93, 0x71, 0x45, 0xE4, 0xA0, 
+0x4E, 0xE7, 0x4B, 0x9E, 0xFE, 0x45, 0x13, 0xDB, 
+0xE9, 0x26, 0xD8, 0x75, 0xA5, 0xB6, 0x8A, 0x5E, 
+0xF7, 0x7A, 0xE1, 0xA6, 0x78, 0xEB, 0xBA, 0xBA, 
+0xC6, 0xB2, 0xC2, 0xCF, 0x04, 0x8A, 0x72, 0x6C, 
+0xA2, 0x53, 0xDE, 0xC8, 0x91, 0xDB, 0x05, 0x46, 
+0xA4, 0x86, 0x0C, 0xB8, 0xD0, 0x0F, 0x15, 0x10, 
+0xD4, 0xC3, 0xA1, 0x53, 0xEA, 0x9F, 0x9A, 0x20, 
+0x5A, 0xB3, 0xE8, 0x7A, 0x47, 0xC8, 0xCF, 0x87, 
+0xA3, 0x7D, 0x54, 0x5C, 0xF1, 0x84, 0xE2, 0x5E, 
+0x8A, 0x98, 0x8E, 0x7F, 0xAE, 0x18, 0x2F, 0x97, 
+0xB3, 0x5D, 0x84, 0xFF, 0xEC, 0xAB, 
+
+0x81, 0x65, 0x82, 0x11, 0xCB, 0x36, 0xCA, 0xE9, 
+0x04, 0x0E, 0x35, 0x85, 0xBE, 0xB3, 0x99, 0xDF, 
+0xCF, 0xD5, 0x77, 0x38, 0xD4, 0xDC, 0xFE, 0x0D, 
+0x01, 0xD3, 0x06, 0x2E, 0x83, 0x2E, 0x3A, 0x8C, 
+0x90, 0x84, 0x17, 0x74, 0x28, 0x79, 0xFB, 0x3C, 
+0x4B, 0x6D, 0x22, 0x43, 0xD6, 0x54, 0x6C, 0x6D, 
+0x04, 0x36, 0xD7, 0x75, 0x95, 0xA0, 0xA8, 0xD1, 
+0x42, 0xAC, 0x4B, 0x97, 0xAD, 0x69, 0x3F, 0xBA, 
+0x2C, 0x0B, 0xC2, 0xCD, 0x3D, 0x70, 0x31, 0xEE, 
+0xE6, 0x9E, 0x81, 0x28, 0x42, 0xAF, 0x67, 0x5D, 
+0x3C, 0xFE, 0xDA, 0xF8, 0x89, 0x2F, 0xF8, 0xA0, 
+0x19, 0x4E, 0x77, 0x87, 0x45, 0xBC, 0x0D, 0xB6, 
+0xC0, 0x16, 0xE3, 0x4D, 0x46, 0x03, 0x89, 0x70, 
+0xB0, 0xF3, 0xD3, 0x6F, 0x9A, 0x30, 0xD7, 0x51, 
+0x05, 0xAE, 0xCC, 0x3A, 0xC5, 0x68, 0x56, 0x93, 
+0x7B, 0x0F, 0x37, 0x96, 0x0E, 0x91, 0xD5, 0x7D, 
+0xF9, 0xA6, 0xEB, 0x2C, 0x4E, 0xF6, 0x19, 0x0A, 
+0xBA, 0x0F, 0x84, 0xA9, 0xD1, 0xFC, 0xD4, 0x72, 
+0x0B, 0xCD, 0x79, 0xDE, 0x05, 0x49, 0x62, 0x4A, 
+0x89, 0xEE, 0xB5, 0x6D, 0x5C, 0x28, 0xA7, 0xC7, 
+0x96, 0x99, 0xF1, 0x0D, 0x35, 0xE2, 0xB3, 0x0E, 
+0x20, 0xDB, 0x0A, 0xAD, 0x32, 0x72, 0x42, 0x40, 
+0x60, 0x68, 0x39, 0xE1, 0xD9, 0x2E, 0x74, 0x90, 
+0x59, 0xC7, 0xF1, 0xAE, 0x35, 0xC4, 0x0D, 0x8B, 
+0xCE, 0xB1, 0xDC, 0x60, 0xD7, 0x03, 0x5A, 0x03, 
+0xCD, 0x6F, 0xED, 0xC8, 0x8A, 0xF2, 0x5A, 0xE5, 
+0x88, 0xBB, 0x24, 0xA5, 0x6C, 0x8E, 0x95, 0x84, 
+0xF3, 0x02, 0x78, 0x5E, 0x7C, 0x8E, 0xDF, 0xCF, 
+0xB1, 0x48, 0x5F, 0x16, 0x73, 0x38, 0x46, 0x69, 
+0x3E, 0x41, 0x82, 0xBE, 0x29, 0xEC, 0xAF, 
+
+0x35, 0x6C, 0x92, 0x8B, 0x5B, 0xA9, 0xD1, 0x04, 
+0x67, 0x82, 0x3C, 0x7E, 0x26, 0xA3, 0x0B, 0x58, 
+0xD2, 0xC7, 0xC2, 0x3E, 0xC3, 0xDF, 0x79, 0x69, 
+0xA6, 0x73, 0x38, 0xDC, 0x01, 0x60, 0x17, 0x37, 
+0xD7, 0x46, 0xF4, 0xE6, 0x62, 0x72, 0xC7, 0x09, 
+0xDB, 0x6F, 0x45, 0xDE, 0x59, 0x85, 0x48, 0xA1, 
+0xA4, 0xF7, 0x6D, 0x83, 0x52, 0xC0, 0x64, 0xE3, 
+0x4B, 0xFB, 0xCD, 0xAB, 0x62, 0x10, 0xF7, 0x40, 
+0x07, 0xAA, 0x51, 0x83, 0xCF, 0xD8, 0xFD, 0xC1, 
+0x35, 0x27, 0x0A, 0xCE, 0x21, 0x5E, 0x85, 0xF8, 
+0x10, 0x0C, 0xAD, 0xCE, 0xD3, 0xB2, 0xF0, 0xCB, 
+0x03, 0x4A, 0xE1, 0x8E, 0x8E, 0xF1, 0x05, 0xE3, 
+0x1E, 0xBD, 0x35, 0xB3, 0x41, 0xEA, 0x39, 0x93, 
+0xAD, 0xE2, 0xBC, 0xFD, 0x68, 0xCF, 0xAF, 0xDE, 
+0x3F, 0xB3, 0xDD, 0xB3, 0x1A, 0x4C, 0x81, 0x6D, 
+0x3E, 0x7E, 0x76, 0xA3, 0xB8, 0x26, 0x9D, 0xA4, 
+0xD9, 0xAC, 0x88, 0x71, 0xEC, 0xE9, 0x4F, 0xA5, 
+0xBF, 0x53, 0xB6, 0xFF, 0x52, 0xDA, 0xCB, 0x9B, 
+0x2B, 0x67, 0xAF, 0x50, 0xEE, 0xCF, 0xA5, 0xD0, 
+0x35, 0xAA, 0xB5, 0xE9, 0x34, 0x8E, 0x62, 0xD6, 
+0x11, 0x94, 0xD0, 0xA7, 0xEF, 0xB9, 0xA2, 0x19, 
+0x22, 0x17, 0xE4, 0x20, 0x35, 0xB6, 0x60, 0x8F, 
+0x26, 0xAA, 0x3C, 0x41, 0xC8, 0xFC, 0xBC, 0xEE, 
+0x5E, 0x42, 0xF6, 0x9B, 0x79, 0xBA, 0xAA, 0x4D, 
+0x59, 0x18, 0xC9, 0x00, 0x9F, 0x28, 0x17, 0x3A, 
+0x1F, 0xFC, 0x3B, 0x4C, 0xD5, 0xBF, 0x1B, 0x72, 
+0x88, 0x5D, 0x22, 0x57, 0x7A, 0x47, 0x8A, 0x2C, 
+0x19, 0x97, 0x80, 0x9A, 0x1C, 0x8A, 0x70, 0xA7, 
+0xDA, 0xEB, 0xAE, 0x21, 0x81, 0x93, 0x63, 0xE9, 
+0xDE, 0x12, 0xB2, 0xA6, 0xC3, 0x57, 0xD0, 0xD7, 
+
+0x04, 0xCD, 0xA5, 0x8D, 0x82, 0x25, 0xCC, 0xAE, 
+0xDB, 0x8A, 0xBB, 0xCE, 0x96, 0x7D, 0x69, 0x62, 
+0x5F, 0x34, 0x39, 0x4C, 0x82, 0xD3, 0xC6, 0x1E, 
+0x27, 0x44, 0xDF, 0x66, 0xF0, 0x66, 0xF1, 0xA4, 
+0x3E, 0xC8, 0x72, 0x58, 0x7C, 0x78, 0x50, 0x1B, 
+0xAE, 0x6D, 0x22, 0x40, 0x27, 0x70, 0x6C, 0x12, 
+0x1D, 0x44, 0x70, 0x1B, 0xC6, 0xD5, 0x76, 0x76, 
+0xDD, 0xC4, 0x63, 0xE9, 0x17, 0xFF, 0xE4, 0x93, 
+0x67, 0x24, 0xE0, 0x2C, 0x51, 0x3E, 0xCA, 0x62, 
+0xC7, 0x0B, 0x47, 0x84, 0xDF, 0x98, 0x94, 0x85, 
+0x40, 0xF0, 0xAA, 0xFC, 0x74, 0xCC, 0x15, 0x7E, 
+0x9B, 0x14, 0x2D, 0x78, 0x38, 0x88, 0x12, 0x4D, 
+0xB3, 0xE4, 0x64, 0xEB, 0x38, 0x3C, 0x0F, 0xC7, 
+0x08, 0x26, 0x06, 0x9D, 0xD4, 0xCD, 0xCD, 0xCA, 
+0x10, 0xA1, 0x9B, 0xCD, 0x03, 0x92, 0x75, 0x32, 
+0xA5, 0x0F, 0xAA, 0xA4, 0xB0, 0xFE, 0x6B, 0xB3, 
+0x47, 0xFD, 0x58, 0x9F, 0x69, 0x6D, 0xD5, 0x93, 
+0xE7, 0x46, 0x92, 0xF5, 0x6B, 0xA7, 0x07, 0xBC, 
+0x5E, 0xE1, 0x9D, 0x7D, 0xF6, 0xB5, 0x01, 0x64, 
+0x6D, 0xDE, 0x24, 0x8F, 0xEF, 0x17, 0x4F, 0x74, 
+0x3A, 0x13, 0x2B, 0x67, 0x05, 0x3C, 0x3B, 0xE2, 
+0x17, 0x01, 0xD7, 0xAC, 0x2C, 0xD2, 0xEE, 0x89, 
+0xE7, 0x50, 0xAB, 0x0B, 0x3A, 0x03, 0x6A, 0x05, 
+0x2B, 0xAF, 0x7A, 0x71, 0x6D, 0x59, 0x13, 0x22, 
+0xD4, 0x7F, 0xE0, 0xD4, 0xD5, 0xE6, 0x37, 0x4E, 
+0x81, 0x04, 0xB1, 0x5C, 0xE4, 0x81, 0xE4, 0xE0, 
+0x1D, 0x11, 0xF6, 0x3E, 0xE7, 0xB8, 0x7E, 0xDB, 
+0xB3, 0x72, 0xE9, 0x63, 0x6B, 0xB7, 0xAA, 0x20, 
+0x94, 0x14, 0x8E, 0x1C, 0x6D, 0xD3, 0xF4, 0xE6, 
+0x3F, 0x14, 0xDD, 0x3F, 0xA3, 0x8A, 0x5B, 0xC3, 
+0x0A, 
+
+0x10, 0x5A, 0xA3, 0x65, 0x9B, 0x9C, 0x5D, 0xBD, 
+0x92, 0x5A, 0x84, 0x1B, 0x18, 0xA6, 0x4A, 0x43, 
+0x69, 0xA2, 0xD2, 0x67, 0x8C, 0xC5, 0x90, 0xD6, 
+0x33, 0xD7, 0xE5, 0x12, 0xEF, 0xCB, 0x68, 0x24, 
+0xB9, 0x66, 0xF6, 0x69, 0xA5, 0x2D, 0x43, 0xDD, 
+0xFC, 0x2C, 0x08, 0x3E, 0x71, 0xF3, 0x33, 0xF1, 
+0xED, 0xBA, 0x0E, 0x8B, 0xE6, 0xF3, 0xA7, 0x90, 
+0x61, 0xD9, 0x3B, 0xC6, 0xB6, 0x89, 0xEF, 0xE3, 
+0x9C, 0x55, 0xCC, 0x92, 0xE0, 0xB7, 0xA8, 0xA2, 
+0xBE, 0x6E, 0x3B, 0xF4, 0xC2, 0x47, 0x21, 0x0E, 
+0x1B, 0xD8, 0x76, 0x19, 0x99, 0xCE, 0x97, 0x02, 
+0x25, 0x37, 0x28, 0xC0, 0x8E, 0x13, 0x6E, 0xB7, 
+0x1B, 0x6B, 0x7C, 0x40, 0x3E, 0x78, 0xD1, 0xD1, 
+0x55, 0x84, 0xA7, 0x1E, 0x82, 0x5C, 0x5D, 0x8E, 
+0x14, 0x2E, 0xF4, 0x5B, 0x76, 0xC7, 0xCB, 0x82, 
+0x74, 0xB3, 0x6F, 0xF5, 0xFD, 0xDE, 0x74, 0xD5, 
+0xBD, 0xFB, 0x37, 0xA9, 0x54, 0x90, 0x97, 0x08, 
+0x5C, 0xA7, 0x13, 0x07, 0xC4, 0x8C, 0x0C, 0x51, 
+0x5D, 0x22, 0x71, 0xFE, 0x25, 0x77, 0x13, 0x23, 
+0x3C, 0x57, 0x04, 0xEC, 0xE5, 0xD4, 0x41, 0x4E, 
+0xE7, 0xFA, 0x1B, 0x4A, 0x31, 0xE1, 0xC6, 0x35, 
+0x99, 0x0D, 0x8B, 0xAC, 0x37, 0xAB, 0x38, 0x8C, 
+0xEC, 0x59, 0x44, 0x8A, 0x5A, 0xD9, 0x7A, 0x99, 
+0x95, 0x33, 0x7E, 0x65, 0xEF, 0x78, 0x63, 0xE2, 
+0x6D, 0xFE, 0x7D, 0xAC, 0xA5, 0x05, 0x13, 0xB7, 
+0x8E, 0xF2, 0x9B, 0x76, 0x7E, 0x6C, 0x54, 0x86, 
+0xA2, 0xAA, 0x2F, 0x3B, 0x38, 0x46, 0x1D, 0x4E, 
+0xDB, 0x36, 0x42, 0x88, 0x0D, 0x0C, 0x7B, 0xAF, 
+0xAC, 0x58, 0x0B, 0x24, 0x99, 0xCB, 0x5E, 0x9D, 
+0x6D, 0xDC, 0xE8, 0xE1, 0xDC, 0xD5, 0xC3, 0x31, 
+0xBE, 0xD5, 
+
+0xB0, 0xD1, 0xFC, 0x60, 0x3A, 0x61, 0x99, 0x7C, 
+0xDD, 0x25, 0x09, 0x27, 0x2F, 0x4F, 0xEA, 0x8D, 
+0x71, 0x0C, 0xE3, 0x47, 0x8A, 0xBC, 0xB6, 0xBD, 
+0x8B, 0x6E, 0xFC, 0x63, 0x4A, 0x49, 0x59, 0xA4, 
+0x4D, 0x2C, 0x8B, 0xDB, 0x9C, 0xFA, 0x23, 0xAE, 
+0xDF, 0xBA, 0x3A, 0x1A, 0x21, 0xED, 0x74, 0x3F, 
+0x20, 0xC1, 0x66, 0xCF, 0xBB, 0x21, 0x96, 0x57, 
+0xD4, 0x9A, 0xFF, 0xCD, 0xB6, 0x21, 0xE4, 0xA6, 
+0xC1, 0x38, 0xDA, 0x54, 0x20, 0x9B, 0x21, 0xC9, 
+0x22, 0x5D, 0x1E, 0xCD, 0x48, 0x8C, 0x85, 0xE2, 
+0xCA, 0x4D, 0xE3, 0xE3, 0x82, 0x9F, 0x3A, 0xB9, 
+0xC1, 0xB9, 0x01, 0x50, 0x1F, 0x13, 0xB0, 0xDF, 
+0x12, 0x2D, 0x09, 0x85, 0xA2, 0xD7, 0x0D, 0x9D, 
+0x01, 0x92, 0xC9, 0xEC, 0xE5, 0x50, 0x58, 0xF0, 
+0xF8, 0x58, 0x7F, 0xD1, 0xCF, 0xFD, 0x77, 0xBD, 
+0x73, 0x6B, 0x9B, 0x9D, 0x35, 0x25, 0x1D, 0x52, 
+0x6B, 0xF0, 0x8E, 0x78, 0xF2, 0xCF, 0x52, 0x11, 
+0x17, 0xF3, 0xB2, 0x20, 0x80, 0x22, 0x90, 0x7B, 
+0xBD, 0x1B, 0x32, 0x1D, 0x17, 0xF8, 0xB0, 0xB0, 
+0xBC, 0x82, 0xC1, 0x20, 0x8E, 0x79, 0x17, 0x18, 
+0x6B, 0x8D, 0xD3, 0x57, 0xBE, 0xB6, 0xB0, 0x48, 
+0x23, 0x5D, 0x70, 0x50, 0xA7, 0x2C, 0x55, 0xC4, 
+0x39, 0x88, 0x5C, 0x7C, 0xA5, 0x74, 0x43, 0xA4, 
+0x8E, 0x02, 0x4C, 0x8E, 0x7E, 0x05, 0x73, 0x6B, 
+0x89, 0x44, 0xAA, 0x84, 0xF4, 0xD4, 0x0F, 0xB9, 
+0xB1, 0x8B, 0x0D, 0xD5, 0x38, 0x32, 0xBD, 0x84, 
+0x49, 0xB6, 0x3E, 0xD9, 0x65, 0xAB, 0x96, 0x2A, 
+0x6A, 0x47, 0xD5, 0x0E, 0x21, 0x4F, 0xF7, 0xF9, 
+0xE4, 0xE9, 0x46, 0xA1, 0x09, 0x7A, 0x7B, 0x0E, 
+0x10, 0x3D, 0x3D, 0xE8, 0xB1, 0xBB, 0x07, 0x1A, 
+0x54, 0xBA, 0xC2, 
+
+0x3C, 0xDD, 0x72, 0x30, 0xB1, 0xD2, 0x79, 0x40, 
+0x8E, 0x03, 0x65, 0x7D, 0x82, 0x70, 0x77, 0xEB, 
+0x77, 0x9B, 0x6C, 0x46, 0x20, 0x27, 0x40, 0xCF, 
+0xAA, 0xF3, 0x2D, 0xBC, 0xF8, 0xE0, 0xA4, 0x04, 
+0xAE, 0x72, 0xE7, 0x95, 0x38, 0xA2, 0xAA, 0x45, 
+0x7F, 0xDB, 0x72, 0x58, 0x0C, 0x30, 0x70, 0x4E, 
+0x8A, 0xE2, 0xDD, 0x2D, 0xA8, 0x03, 0x9A, 0xEB, 
+0x99, 0x96, 0x8E, 0x9E, 0xC1, 0x65, 0x8C, 0xB9, 
+0xD1, 0xE1, 0xED, 0xEC, 0x3C, 0x3F, 0xA8, 0xAB, 
+0x54, 0x81, 0x2C, 0x16, 0x77, 0x3B, 0x6A, 0xB9, 
+0x09, 0x51, 0xF0, 0x98, 0x38, 0xF3, 0x69, 0xC6, 
+0x07, 0xB6, 0x98, 0x2C, 0x00, 0xDA, 0xFF, 0xD4, 
+0x4B, 0x51, 0x00, 0xF4, 0xF4, 0xC6, 0x59, 0xFF, 
+0x4D, 0x2F, 0xF0, 0x55, 0x91, 0x33, 0x58, 0xAC, 
+0x0B, 0xA7, 0x43, 0x4B, 0x11, 0xDA, 0x6F, 0x3A, 
+0x7A, 0xF1, 0xE5, 0xE4, 0x58, 0xB0, 0xA3, 0x5D, 
+0x89, 0xDD, 0x6B, 0x84, 0x28, 0x04, 0x1E, 0x0B, 
+0xD5, 0x3F, 0x5E, 0x87, 0x47, 0xE5, 0xCC, 0xCC, 
+0xF8, 0x46, 0xD0, 0x41, 0xD4, 0x89, 0xC6, 0x31, 
+0x4B, 0x9B, 0xF6, 0x64, 0xCB, 0xDE, 0x11, 0x36, 
+0x7F, 0x1C, 0xC4, 0x4A, 0x10, 0xA0, 0xF0, 0xE2, 
+0xA8, 0x9D, 0x80, 0x22, 0xAF, 0xFA, 0x1D, 0xCB, 
+0x99, 0x08, 0x56, 0x12, 0x0C, 0xE8, 0x23, 0xF9, 
+0x13, 0x68, 0x7C, 0x89, 0x5A, 0xAC, 0x00, 0x25, 
+0x0A, 0xEF, 0xDD, 0xF1, 0xF4, 0xDC, 0xC1, 0x92, 
+0x71, 0x12, 0xF2, 0xBF, 0x7F, 0xA9, 0x30, 0x5C, 
+0x75, 0x43, 0x4F, 0x19, 0x7C, 0xD6, 0x39, 0xF2, 
+0xDC, 0xAB, 0xE7, 0x36, 0x75, 0x26, 0x2C, 0xC5, 
+0x4D, 0xBF, 0xD0, 0x4A, 0x20, 0x26, 0x50, 0x9A, 
+0x31, 0x41, 0x29, 0xD0, 0x73, 0x53, 0x7A, 0x81, 
+0xF4, 0x5D, 0xDB, 0x8E, 
+
+0x21, 0x55, 0x95, 0x56, 0x2A, 0xFA, 0x88, 0x2E, 
+0xC9, 0x9B, 0x1E, 0xE9, 0xA4, 0x4C, 0x1A, 0xC7, 
+0xFB, 0xF6, 0xA5, 0xE8, 0x5A, 0xFB, 0xF6, 0x53, 
+0x4C, 0xCD, 0x0F, 0xE7, 0x0A, 0x97, 0x73, 0x95, 
+0x7C, 0x0E, 0x11, 0x82, 0x07, 0x8F, 0x77, 0x2B, 
+0xDE, 0x4D, 0x95, 0xDB, 0x27, 0x6D, 0x70, 0x89, 
+0xC8, 0x5C, 0x1B, 0xC8, 0xEE, 0xEE, 0x8E, 0x75, 
+0xDB, 0x1B, 0x5E, 0xF2, 0x1D, 0x6D, 0xD8, 0x8C, 
+0x81, 0x3B, 0x58, 0x71, 0xBA, 0x12, 0x70, 0x12, 
+0x6E, 0x8D, 0x13, 0x2E, 0x4B, 0x4A, 0x81, 0x93, 
+0xA9, 0xAD, 0xA6, 0xB2, 0xB2, 0x4D, 0xE2, 0x26, 
+0x2E, 0xC7, 0x31, 0xD1, 0xFB, 0x02, 0xAB, 0xE9, 
+0x76, 0xBF, 0x84, 0xEF, 0xE2, 0x02, 0xDA, 0xDE, 
+0x93, 0x08, 0x3F, 0x02, 0x37, 0x04, 0xC3, 0x37, 
+0xB0, 0x5B, 0x7F, 0x04, 0x37, 0xC0, 0xA3, 0x67, 
+0x3F, 0xFA, 0x31, 0x43, 0x10, 0x27, 0xA1, 0x36, 
+0x3D, 0x56, 0xF9, 0x70, 0xDF, 0x6B, 0x51, 0x61, 
+0x76, 0xD2, 0x22, 0xCC, 0x19, 0x66, 0x30, 0x63, 
+0xBC, 0x25, 0x7A, 0x7E, 0x8B, 0x7B, 0x9D, 0xD7, 
+0x49, 0x99, 0x67, 0x8C, 0x19, 0x2F, 0x51, 0x0F, 
+0x01, 0xD7, 0x35, 0xF0, 0xA2, 0x20, 0x38, 0x03, 
+0x9F, 0xCB, 0x42, 0x65, 0xC3, 0x6A, 0x0E, 0xA8, 
+0xF6, 0x4F, 0xBF, 0x73, 0x3A, 0x1A, 0xDF, 0x48, 
+0x03, 0x72, 0xF5, 0xB9, 0x94, 0x56, 0xD4, 0x46, 
+0x93, 0xDD, 0x6B, 0xB3, 0xF9, 0x6B, 0xDF, 0xEC, 
+0x88, 0x34, 0x6D, 0x39, 0x51, 0xEE, 0xFD, 0xA6, 
+0x4B, 0xC7, 0x2B, 0xAE, 0xC6, 0x2A, 0x14, 0x15, 
+0xDF, 0xB3, 0x7E, 0x02, 0x1C, 0x8D, 0xA8, 0xD4, 
+0xDB, 0x19, 0xCF, 0x19, 0xEA, 0x8A, 0x14, 0xBC, 
+0x67, 0xB1, 0x62, 0x35, 0x69, 0x84, 0x21, 0x08, 
+0xF8, 0x5D, 0x44, 0x10, 0xD9, 
+
+0x0E, 0xE7, 0x88, 0x5B, 0x12, 0x67, 0xA8, 0x08, 
+0x53, 0xD1, 0x65, 0x84, 0xE8, 0x9C, 0x12, 0xE3, 
+0xBA, 0x44, 0x83, 0xA1, 0x1E, 0x73, 0xDE, 0x63, 
+0x6C, 0xCC, 0xF7, 0xA4, 0x6B, 0xD0, 0x6B, 0xBF, 
+0x3D, 0xAE, 0x4D, 0xF2, 0xB7, 0xF7, 0xC4, 0x30, 
+0x2F, 0xB0, 0xEE, 0x09, 0x10, 0x20, 0xE1, 0x77, 
+0x7B, 0xD4, 0xD1, 0x6B, 0x7F, 0x88, 0xA6, 0xE6, 
+0x51, 0xD5, 0x4E, 0x9F, 0xFD, 0xFB, 0x1F, 0x4A, 
+0x7C, 0xA7, 0x99, 0x32, 0x3F, 0x7C, 0xEA, 0xD9, 
+0x36, 0xD4, 0x2C, 0xE1, 0xE0, 0x84, 0x2E, 0xFE, 
+0x2A, 0x57, 0xA5, 0x56, 0xBD, 0xD3, 0x16, 0x8F, 
+0x5A, 0x5A, 0x02, 0x20, 0xF1, 0x1B, 0xF0, 0x96, 
+0x22, 0xBF, 0x1B, 0x24, 0xA4, 0xD2, 0x5A, 0x35, 
+0x9E, 0xBA, 0x71, 0xB4, 0x5D, 0x13, 0x19, 0x8D, 
+0x64, 0x4C, 0x5B, 0x22, 0xAA, 0x0E, 0x87, 0x1E, 
+0xF6, 0x62, 0x8A, 0x94, 0xA6, 0x36, 0x03, 0xEA, 
+0x6F, 0xB4, 0xFC, 0xA5, 0xC9, 0x98, 0x73, 0x54, 
+0x38, 0x97, 0x6D, 0xC8, 0x08, 0x01, 0xCA, 0xD8, 
+0x88, 0xE7, 0xAA, 0x9E, 0xCE, 0x3B, 0xA7, 0xBA, 
+0x69, 0x50, 0xAB, 0x78, 0x9C, 0x83, 0x20, 0x23, 
+0x31, 0x1C, 0xCC, 0x01, 0x52, 0xDF, 0x6F, 0xC2, 
+0x56, 0x09, 0x57, 0xFF, 0xDE, 0x27, 0xD4, 0x17, 
+0x4E, 0x6B, 0xD5, 0x60, 0x3A, 0x9F, 0xC8, 0xA4, 
+0x1A, 0xAB, 0x82, 0x9A, 0x05, 0x15, 0xC8, 0x66, 
+0x89, 0x40, 0x2A, 0x90, 0xF8, 0xEB, 0xD1, 0x2F, 
+0x6E, 0x68, 0x13, 0xAD, 0x66, 0x39, 0x6C, 0xB1, 
+0x7C, 0xEE, 0x33, 0xB7, 0xA6, 0x88, 0x69, 0xE1, 
+0x32, 0x34, 0xA4, 0x87, 0xB1, 0x0C, 0xF3, 0x59, 
+0xBE, 0x88, 0x72, 0x77, 0x76, 0x61, 0x45, 0x6F, 
+0xF7, 0x75, 0x12, 0xB6, 0x03, 0x17, 0xE9, 0x2E, 
+0x15, 0x93, 0xDA, 0xA9, 0xAE, 0x12, 
+
+0x4E, 0xB8, 0x67, 0xB1, 0x3C, 0x12, 0xCD, 0x1E, 
+0x2D, 0xA3, 0x9C, 0xE5, 0xA6, 0x4A, 0x08, 0x0E, 
+0x0D, 0x1C, 0x9B, 0xE7, 0xE5, 0x6D, 0x0A, 0x75, 
+0xE0, 0xE4, 0x33, 0x3B, 0x43, 0x3D, 0x2C, 0x39, 
+0x96, 0x43, 0x8F, 0xF6, 0xA4, 0xAA, 0x5E, 0x32, 
+0xFE, 0x00, 0xCD, 0xD6, 0x9D, 0x75, 0x6F, 0x3A, 
+0x20, 0x4E, 0x05, 0xB5, 0x7C, 0xE8, 0xDA, 0xE1, 
+0x32, 0x10, 0xC0, 0x85, 0x8D, 0x34, 0x35, 0x12, 
+0x41, 0x50, 0xBF, 0x58, 0xB8, 0xFF, 0x4E, 0x6B, 
+0x04, 0x40, 0x76, 0xAD, 0xCC, 0x31, 0xDB, 0xF4, 
+0x12, 0x7F, 0x30, 0x4E, 0x28, 0x28, 0x73, 0x67, 
+0xED, 0x35, 0x38, 0x18, 0xD9, 0x9D, 0xEA, 0x68, 
+0xFA, 0x65, 0x28, 0x7B, 0x99, 0x3A, 0x89, 0x7D, 
+0x52, 0xE1, 0x59, 0x4C, 0x68, 0xC9, 0xDA, 0xFC, 
+0x3D, 0x39, 0xE0, 0x81, 0xDB, 0x22, 0xBD, 0xBF, 
+0xF3, 0xDA, 0x45, 0x1D, 0x3A, 0x8D, 0xF3, 0x97, 
+0x9E, 0xDA, 0x2A, 0xD5, 0x4E, 0x2C, 0x23, 0x74, 
+0x3A, 0xC9, 0x73, 0x4D, 0x24, 0x7E, 0x1A, 0x9B, 
+0xF9, 0xD7, 0x21, 0x4D, 0x9A, 0x28, 0x21, 0x44, 
+0xD4, 0xE8, 0x93, 0xC5, 0x4F, 0x9A, 0xF9, 0x66, 
+0x09, 0xA5, 0x46, 0x31, 0x59, 0xB3, 0xCC, 0xF3, 
+0xD4, 0x58, 0x9E, 0x8E, 0xB5, 0x35, 0x47, 0x5C, 
+0x23, 0xC9, 0xFC, 0xE8, 0x64, 0x5D, 0x47, 0x6C, 
+0x43, 0xAC, 0x47, 0x30, 0xAE, 0x40, 0x7A, 0x9E, 
+0x1F, 0xF9, 0x74, 0x55, 0x10, 0x6F, 0x2A, 0xEB, 
+0x50, 0x63, 0x51, 0xDD, 0x8E, 0x38, 0x6F, 0x27, 
+0x1F, 0x21, 0x3C, 0xDC, 0x65, 0x63, 0xE4, 0xE8, 
+0xD8, 0x63, 0x20, 0x67, 0x96, 0x22, 0xFD, 0xC3, 
+0xA0, 0xDB, 0x90, 0x64, 0x56, 0xE6, 0xB0, 0xFE, 
+0xB3, 0x7C, 0x74, 0x2C, 0x01, 0xD5, 0xCC, 0xD4, 
+0x3C, 0x1B, 0xBA, 0x79, 0x68, 0x33, 0x7C, 
+
+0x3E, 0x82, 0xD7, 0x53, 0xF6, 0x16, 0xE3, 0x10, 
+0x01, 0x36, 0xA8, 0xEF, 0x33, 0xCA, 0x26, 0xC9, 
+0x21, 0xBF, 0xCA, 0x3B, 0x7D, 0x89, 0xC2, 0x59, 
+0x1D, 0x57, 0x2B, 0x02, 0x05, 0xEA, 0x49, 0x99, 
+0x31, 0x95, 0xFF, 0x0A, 0x45, 0x56, 0x1D, 0xB9, 
+0xD3, 0x01, 0x44, 0xC4, 0x8C, 0x88, 0xAD, 0xC9, 
+0x83, 0x6A, 0x35, 0x83, 0x81, 0xBC, 0x59, 0x0A, 
+0xB0, 0x2A, 0x82, 0xE5, 0x89, 0x54, 0xA0, 0xAC, 
+0x69, 0x1A, 0x63, 0x5B, 0x2C, 0x8A, 0x61, 0xAE, 
+0x72, 0xD9, 0xDB, 0xF0, 0x6C, 0xAA, 0x8F, 0x61, 
+0x46, 0x0C, 0x0A, 0x5D, 0xE6, 0x15, 0x92, 0x65, 
+0x83, 0xCF, 0x80, 0xB6, 0x78, 0x9E, 0x87, 0x9B, 
+0x13, 0xD5, 0x94, 0xD2, 0x1E, 0x7A, 0x28, 0x8B, 
+0x14, 0x76, 0x0C, 0x7E, 0x41, 0xAE, 0x5B, 0x36, 
+0x40, 0xD9, 0x30, 0x31, 0xFD, 0x73, 0x81, 0xCC, 
+0x83, 0x22, 0x03, 0xFF, 0xB6, 0xCC, 0xFA, 0xD7, 
+0x3A, 0x1C, 0xB4, 0xA8, 0x48, 0x47, 0xD9, 0xA9, 
+0x54, 0xCE, 0x26, 0x92, 0x84, 0x19, 0x6D, 0x57, 
+0x1B, 0x24, 0x3B, 0x5F, 0xBD, 0xBB, 0x7F, 0x18, 
+0x97, 0xE2, 0x79, 0x81, 0x7B, 0x19, 0xB6, 0x9C, 
+0x4F, 0xE6, 0xD5, 0x01, 0x8B, 0x41, 0xE5, 0x21, 
+0x18, 0x76, 0x2A, 0x16, 0xC2, 0xEF, 0x20, 0xAC, 
+0xB0, 0x0E, 0x58, 0x8C, 0x6D, 0xE7, 0x02, 0x35, 
+0x15, 0x94, 0xDB, 0xC6, 0x58, 0xE3, 0xF0, 0x0F, 
+0xBF, 0x6A, 0x63, 0xE0, 0x4A, 0x51, 0x32, 0x90, 
+0x40, 0x84, 0xB6, 0x05, 0x92, 0xB1, 0x6B, 0xF8, 
+0xCD, 0xCD, 0x7F, 0xF1, 0x43, 0x72, 0xBB, 0x8B, 
+0x1E, 0xAF, 0xE5, 0x78, 0x75, 0x72, 0xE5, 0x72, 
+0x14, 0x2D, 0x5B, 0x83, 0xD2, 0x40, 0x55, 0xFC, 
+0x8C, 0x9B, 0xFB, 0xAE, 0xCC, 0x51, 0xBE, 0x56, 
+0x9D, 0x4C, 0xC1, 0xFC, 0xF1, 0xC3, 0xC3, 0xAD, 
+
+0xA9, 0x2F, 0xB7, 0xB1, 0x24, 0x2E, 0xD1, 0x6E, 
+0xAF, 0x5B, 0xC5, 0xF2, 0xF8, 0x5E, 0x43, 0x83, 
+0xB4, 0x3D, 0x57, 0xD6, 0x16, 0x8D, 0x56, 0x31, 
+0xE5, 0xEB, 0x44, 0x01, 0x9F, 0xCA, 0x6E, 0x77, 
+0xC2, 0xAC, 0xAD, 0x84, 0x1E, 0x4B, 0x38, 0xCC, 
+0x48, 0x36, 0xD6, 0x8E, 0xCA, 0xAD, 0x67, 0x3E, 
+0x20, 0xD2, 0x3A, 0x84, 0xA2, 0x79, 0x60, 0xBD, 
+0xA2, 0x1B, 0x7E, 0x41, 0x57, 0x2D, 0x52, 0x41, 
+0x41, 0x1C, 0x32, 0x5F, 0xE4, 0x1B, 0x21, 0x55, 
+0xA9, 0xAE, 0xFE, 0xB1, 0x80, 0xB2, 0x85, 0x0E, 
+0x5A, 0xA8, 0x06, 0x4C, 0x73, 0x96, 0xC7, 0xDF, 
+0x39, 0x6D, 0x5A, 0x69, 0x7F, 0x3C, 0xC1, 0x71, 
+0xEF, 0xEC, 0x84, 0x23, 0x5F, 0x92, 0xD9, 0x07, 
+0x2D, 0x73, 0x4A, 0xAB, 0x55, 0x46, 0xE8, 0xCC, 
+0x46, 0x2C, 0x09, 0x7B, 0x65, 0xD7, 0xCF, 0x1A, 
+0x3B, 0x27, 0xD3, 0x8C, 0x3B, 0x8A, 0xDC, 0xDB, 
+0x36, 0x95, 0x06, 0x8D, 0x5B, 0x44, 0xBA, 0xBF, 
+0x7E, 0x50, 0xAF, 0xC3, 0x63, 0x66, 0xB9, 0xD3, 
+0xB0, 0xC4, 0x2C, 0x03, 0xBF, 0x7E, 0x70, 0xCF, 
+0x93, 0xEB, 0x3D, 0xDC, 0x74, 0x16, 0x49, 0x0A, 
+0x02, 0x98, 0x4F, 0x81, 0x6C, 0x29, 0x53, 0x39, 
+0x25, 0xBA, 0xD4, 0x59, 0xF2, 0xB9, 0x92, 0xE6, 
+0x83, 0x41, 0xE2, 0x7B, 0xE1, 0x6E, 0xA0, 0x8F, 
+0xC4, 0xE8, 0x35, 0x76, 0x5A, 0x3A, 0xA4, 0x35, 
+0x83, 0x85, 0x77, 0xA9, 0x63, 0x00, 0xA1, 0x29, 
+0xD5, 0x43, 0x05, 0x59, 0xA3, 0x52, 0x3E, 0xE4, 
+0xDA, 0xC1, 0x72, 0x36, 0x8F, 0x5E, 0xA0, 0x8B, 
+0x88, 0x3D, 0xE9, 0x19, 0x11, 0x89, 0x3F, 0x0E, 
+0xF1, 0x04, 0xA9, 0x4C, 0x87, 0xFF, 0x08, 0x89, 
+0xF9, 0x13, 0xEB, 0xE7, 0x28, 0x41, 0x0B, 0x58, 
+0xA4, 0xA9, 0x19, 0xC7, 0x4F, 0x34, 0xFD, 0x54, 
+0x0A, 
+
+0x79, 0x14, 0x36, 0xA5, 0x6B, 0x3F, 0x22, 0xFA, 
+0x12, 0x4B, 0x4F, 0xC4, 0x97, 0xD7, 0xC7, 0x61, 
+0x94, 0x53, 0x53, 0xD3, 0xF5, 0x07, 0x64, 0x7D, 
+0xE0, 0x11, 0x63, 0xC5, 0xE7, 0x4A, 0x88, 0xEC, 
+0x6C, 0x0D, 0xA5, 0x05, 0x13, 0xE4, 0xC6, 0x94, 
+0x3D, 0xEE, 0x87, 0xB1, 0x77, 0xCE, 0xB6, 0x7E, 
+0xC9, 0x99, 0xB5, 0xAA, 0xB2, 0xC8, 0x0F, 0x5F, 
+0xE9, 0x92, 0x9F, 0x1E, 0x49, 0x6D, 0xC8, 0xD7, 
+0x6D, 0x1E, 0xD7, 0x63, 0x6D, 0xDD, 0x64, 0xCB, 
+0xFF, 0x45, 0xB0, 0xF6, 0x89, 0xC5, 0xF3, 0xFB, 
+0x82, 0xB9, 0x5B, 0xF1, 0x42, 0x4A, 0x8C, 0x9E, 
+0xCD, 0x9D, 0xEF, 0x9D, 0x36, 0x79, 0x04, 0x7D, 
+0x16, 0x6D, 0xC6, 0x66, 0xAA, 0xFE, 0x1D, 0xAB, 
+0x4D, 0xDF, 0xF8, 0xE8, 0xC3, 0x12, 0xB3, 0xAD, 
+0x49, 0xD1, 0x85, 0x7D, 0x2C, 0x76, 0xD9, 0x66, 
+0x85, 0xA0, 0x81, 0x29, 0x2B, 0x13, 0x47, 0x1E, 
+0x98, 0x77, 0x79, 0x3F, 0x23, 0xD2, 0xFC, 0xA5, 
+0xA5, 0x64, 0xDF, 0xBB, 0x3E, 0xA8, 0x85, 0x98, 
+0xB1, 0x34, 0x2B, 0xB7, 0xA0, 0xD1, 0x7A, 0x47, 
+0x42, 0xFE, 0x98, 0x80, 0xBB, 0x21, 0x19, 0x4F, 
+0xE0, 0x58, 0x2D, 0xD9, 0xE2, 0x0F, 0x46, 0x13, 
+0xC7, 0x4A, 0x11, 0xDD, 0x49, 0xEC, 0x32, 0x0C, 
+0x1C, 0x3C, 0x96, 0x75, 0x3C, 0xB4, 0x73, 0x07, 
+0x3E, 0xBC, 0xA4, 0xB8, 0x17, 0x05, 0x38, 0x92, 
+0x6B, 0x56, 0xF3, 0x28, 0xE3, 0x71, 0x73, 0x2A, 
+0x30, 0x4C, 0xF5, 0xCF, 0x2A, 0xC5, 0x1B, 0x24, 
+0x4E, 0xA0, 0x2C, 0x9A, 0xCA, 0x98, 0x8C, 0xCA, 
+0x16, 0xD3, 0xA6, 0x77, 0xC7, 0x83, 0x3C, 0x25, 
+0x96, 0x0D, 0x15, 0xBE, 0x33, 0x7E, 0xCA, 0x3A, 
+0xC0, 0x37, 0x7C, 0x91, 0x04, 0xF0, 0x4D, 0x8D, 
+0xAE, 0xBF, 0x6B, 0x99, 0x57, 0x63, 0x90, 0x10, 
+0x41, 0xF1, 
+
+0x83, 0xD6, 0xF5, 0x41, 0x9F, 0x3B, 0x2C, 0x9B, 
+0xAB, 0xAF, 0x1B, 0xAE, 0x69, 0xB4, 0xAA, 0x5B, 
+0xF9, 0x5F, 0x0F, 0xC7, 0xD4, 0x7F, 0x90, 0xAF, 
+0xB2, 0xBA, 0xAB, 0x86, 0x92, 0x8E, 0x66, 0x46, 
+0xD5, 0x73, 0x08, 0xB7, 0x3E, 0x46, 0x86, 0xC0, 
+0x1C, 0x72, 0xB1, 0xDF, 0x7A, 0x3A, 0xC4, 0xEF, 
+0x85, 0x36, 0x21, 0x3C, 0x29, 0xB9, 0x68, 0xFD, 
+0x48, 0xBD, 0x1A, 0x5C, 0x83, 0xA3, 0x02, 0xFD, 
+0x67, 0xBF, 0xC3, 0xD1, 0x68, 0xE1, 0xB8, 0x91, 
+0x57, 0xA5, 0x7B, 0xE8, 0x4B, 0x82, 0x7C, 0x76, 
+0xEB, 0x1C, 0x38, 0x4C, 0x1E, 0x54, 0xE8, 0x66, 
+0x47, 0x47, 0x8A, 0x41, 0xD7, 0x38, 0xCC, 0x8E, 
+0xD8, 0x58, 0x20, 0x26, 0x79, 0x7B, 0x5A, 0xCF, 
+0xD0, 0x0E, 0x6C, 0xE4, 0x91, 0x01, 0xE4, 0x95, 
+0xF8, 0x6D, 0x7F, 0xFD, 0x38, 0x04, 0x41, 0x23, 
+0x72, 0xC7, 0x32, 0xD9, 0x65, 0x4F, 0xCF, 0x3D, 
+0xE8, 0xA3, 0xA7, 0x9C, 0xEF, 0x05, 0xDE, 0x12, 
+0x70, 0x96, 0x04, 0x2C, 0x75, 0x06, 0xBE, 0xEC, 
+0xA0, 0x37, 0x43, 0xF7, 0x39, 0x7E, 0x90, 0x39, 
+0xAB, 0xFF, 0x97, 0x27, 0x05, 0xBC, 0x9D, 0xB9, 
+0xDB, 0x8C, 0xFB, 0x10, 0x55, 0xC1, 0x63, 0xAA, 
+0x59, 0xD3, 0x09, 0xC7, 0x49, 0xDB, 0x18, 0x2B, 
+0x4A, 0xAE, 0x2B, 0x0E, 0xE0, 0x45, 0x3E, 0x96, 
+0xF8, 0x82, 0x0F, 0xC4, 0xB7, 0x17, 0x3A, 0x0B, 
+0x1D, 0x43, 0x81, 0x33, 0xA5, 0xDD, 0x26, 0x12, 
+0xBF, 0xD9, 0x56, 0x13, 0xD8, 0xBB, 0xBE, 0xA8, 
+0xAE, 0xCE, 0xF0, 0x99, 0x95, 0x66, 0xA0, 0xB5, 
+0xD1, 0x94, 0xA3, 0xF8, 0x42, 0x3F, 0x3B, 0x0F, 
+0x56, 0x9A, 0xBF, 0xAE, 0xEE, 0xC1, 0xA8, 0x25, 
+0xFA, 0xF5, 0x0D, 0xAE, 0x4F, 0x40, 0x2B, 0xB2, 
+0xEF, 0xB1, 0x61, 0xCF, 0x41, 0x6D, 0x96, 0x4D, 
+0x8E, 0x0B, 0xFE, 
+
+0x24, 0xE7, 0xE5, 0x6C, 0xA2, 0x9D, 0xFB, 0xD1, 
+0x90, 0xAA, 0xF0, 0xE7, 0xA6, 0x08, 0x5C, 0x80, 
+0x69, 0xEE, 0x83, 0xE9, 0x8B, 0x70, 0x0B, 0x97, 
+0xFB, 0xF0, 0xE5, 0x43, 0x81, 0x6D, 0xDC, 0xE9, 
+0x4C, 0x14, 0xFA, 0xEA, 0x1F, 0x39, 0x31, 0x2A, 
+0x60, 0x87, 0x10, 0x95, 0x19, 0x3A, 0x97, 0x09, 
+0xF6, 0x7A, 0xDF, 0xF1, 0xAE, 0xB5, 0x43, 0x0D, 
+0x6C, 0x1E, 0x2C, 0xC9, 0x2A, 0xF3, 0x76, 0xDA, 
+0x6A, 0xEB, 0xE4, 0x92, 0x74, 0x82, 0xF1, 0x4C, 
+0x64, 0x28, 0x01, 0xB0, 0xE1, 0xDF, 0x9A, 0x6B, 
+0x89, 0x02, 0xE0, 0x43, 0xC6, 0x77, 0x18, 0xE8, 
+0xC4, 0xC9, 0x98, 0xF2, 0x8F, 0xE0, 0xB4, 0x38, 
+0x50, 0x81, 0xC7, 0xF4, 0x3F, 0xE2, 0xD9, 0xCB, 
+0x5E, 0xE9, 0x5B, 0xC5, 0x98, 0xD2, 0xEB, 0xC6, 
+0x13, 0x3E, 0xC1, 0x13, 0xFD, 0x54, 0x48, 0xA0, 
+0x09, 0x64, 0x71, 0xE5, 0xCA, 0xC0, 0x75, 0x2D, 
+0xF7, 0x89, 0x5B, 0x00, 0x05, 0xE1, 0x4B, 0xA8, 
+0xDA, 0x12, 0x6A, 0x4E, 0xCE, 0xAB, 0x3A, 0xDF, 
+0x4A, 0x90, 0xF4, 0xCA, 0x6C, 0x40, 0x00, 0xF9, 
+0x9F, 0x92, 0xF7, 0x94, 0x53, 0x77, 0x55, 0x7F, 
+0xA8, 0xA5, 0xBC, 0xC2, 0xC2, 0x45, 0xBB, 0x07, 
+0x13, 0x4D, 0x9E, 0x0D, 0xCF, 0x49, 0xF1, 0x5A, 
+0x69, 0xDF, 0x2D, 0x53, 0x54, 0xB7, 0x7A, 0xD4, 
+0x2F, 0x1B, 0x99, 0x78, 0x84, 0x81, 0x04, 0xC4, 
+0x5C, 0x26, 0x35, 0x01, 0xED, 0x75, 0xBC, 0x54, 
+0x71, 0xA3, 0x96, 0x9E, 0xD4, 0x41, 0xCB, 0x05, 
+0xB3, 0x08, 0x98, 0xAD, 0x93, 0x15, 0xF7, 0xC2, 
+0xF4, 0xCB, 0xE6, 0xB7, 0xEB, 0x3F, 0xF7, 0x62, 
+0x45, 0x40, 0xE3, 0x97, 0x58, 0xC4, 0x49, 0xED, 
+0xBA, 0x92, 0xA3, 0x94, 0x1F, 0xCD, 0x66, 0x9C, 
+0xA6, 0x85, 0x1F, 0xBF, 0xA1, 0xAA, 0x03, 0x1B, 
+0xB2, 0x70, 0x9D, 0xE3, 
+
+0x0B, 0x45, 0xA1, 0x98, 0x47, 0xD8, 0xB2, 0x5D, 
+0x41, 0xA7, 0x35, 0xE4, 0x41, 0x4B, 0x45, 0x6A, 
+0x9A, 0x91, 0xA5, 0x66, 0xDA, 0x63, 0xE7, 0xB8, 
+0x4E, 0x88, 0x0A, 0x80, 0x2E, 0xEC, 0x08, 0x74, 
+0x8E, 0xEB, 0xDA, 0xC4, 0x6D, 0xB5, 0xDA, 0x27, 
+0xEC, 0x67, 0xAC, 0x7D, 0x63, 0x7A, 0xF4, 0x9B, 
+0xB6, 0x43, 0xE5, 0x87, 0xA7, 0x52, 0x34, 0xFD, 
+0x15, 0x99, 0xC7, 0x13, 0x3E, 0xC6, 0x4F, 0x38, 
+0xA3, 0x8B, 0xD1, 0xB4, 0x5E, 0x0E, 0xC0, 0xA8, 
+0x9B, 0x34, 0x47, 0xC3, 0x61, 0x82, 0x0D, 0xBF, 
+0x78, 0x2F, 0x90, 0x7B, 0x07, 0x5A, 0x2B, 0xA2, 
+0xB6, 0x9D, 0xB0, 0x15, 0x0A, 0x32, 0xA1, 0x70, 
+0x4E, 0x8D, 0x98, 0xC4, 0xB7, 0xE0, 0xD0, 0xBE, 
+0x1D, 0xB4, 0xA3, 0xC9, 0x6A, 0xA4, 0xE6, 0x4F, 
+0x12, 0xC2, 0x35, 0xD7, 0xA6, 0x69, 0x67, 0x18, 
+0x2C, 0xB0, 0xCF, 0x39, 0x78, 0xDB, 0xB7, 0x66, 
+0x50, 0xFD, 0xB7, 0x3F, 0x77, 0x7F, 0x4E, 0x3C, 
+0xF8, 0xBD, 0xC5, 0xD2, 0xCA, 0xCB, 0xCB, 0x10, 
+0x94, 0x9F, 0x3E, 0xA0, 0x5B, 0xBA, 0x74, 0xB9, 
+0x84, 0xFE, 0xB1, 0x91, 0x93, 0x5D, 0x8B, 0x23, 
+0x86, 0x8F, 0xCB, 0xF0, 0x0E, 0x27, 0xA8, 0x6A, 
+0x57, 0xF3, 0x7A, 0xEE, 0x94, 0x5F, 0x29, 0x3F, 
+0xF8, 0xD3, 0xD1, 0x30, 0xCD, 0x8B, 0x31, 0xFC, 
+0x6E, 0xC6, 0x31, 0xB7, 0x76, 0x26, 0x12, 0x7A, 
+0xB9, 0xB0, 0x29, 0x4D, 0xAF, 0x10, 0xFC, 0xCC, 
+0xD1, 0xD6, 0x9C, 0x19, 0x81, 0x15, 0xC4, 0x19, 
+0xDD, 0x5D, 0x56, 0x65, 0x6C, 0xE0, 0x39, 0x25, 
+0x5C, 0xE5, 0xE2, 0x6E, 0x5D, 0x48, 0x44, 0xF8, 
+0x53, 0x4A, 0x36, 0x48, 0xDB, 0x0D, 0x89, 0xF7, 
+0x1B, 0xF5, 0xD0, 0x8E, 0xA2, 0xD8, 0xD7, 0x9E, 
+0xCE, 0x35, 0xA0, 0x10, 0xE5, 0xBC, 0x22, 0xF9, 
+0xC3, 0x35, 0xEC, 0x35, 0x6C, 
+
+0x59, 0x77, 0x61, 0xB9, 0xD2, 0xA0, 0x7C, 0xD6, 
+0x95, 0x93, 0x17, 0x1B, 0x25, 0x09, 0x47, 0xF1, 
+0x1B, 0x73, 0x28, 0xA8, 0xA1, 0xF6, 0x0F, 0x7F, 
+0x4B, 0x86, 0xED, 0xC5, 0xBD, 0x15, 0x92, 0x75, 
+0x23, 0x3E, 0x38, 0x30, 0xE0, 0x64, 0x80, 0x7B, 
+0x10, 0xD5, 0x54, 0x08, 0x6E, 0x3D, 0x78, 0xFE, 
+0x40, 0x0C, 0xFD, 0x86, 0xC1, 0xD1, 0xD4, 0xE7, 
+0xF0, 0xD2, 0xFF, 0x52, 0xA4, 0x8F, 0xA8, 0x0A, 
+0x9C, 0x6E, 0x49, 0x70, 0xC6, 0x7D, 0x7C, 0xDD, 
+0xCE, 0x75, 0x09, 0xE3, 0xBF, 0x5A, 0x4A, 0x30, 
+0xFD, 0xC0, 0x2B, 0x8F, 0x23, 0x39, 0x6B, 0x41, 
+0x17, 0x51, 0x1F, 0x16, 0xAC, 0x33, 0x69, 0x42, 
+0xD2, 0xE2, 0x32, 0x91, 0x81, 0xCB, 0x7C, 0x04, 
+0xC8, 0xD9, 0x77, 0x1A, 0x59, 0xC7, 0x37, 0x5C, 
+0x5B, 0xC3, 0x9F, 0x22, 0xE5, 0xDA, 0xD8, 0x59, 
+0x3C, 0x01, 0xE6, 0xCC, 0x46, 0x5C, 0x90, 0xE0, 
+0x3F, 0x4D, 0x77, 0xAD, 0x96, 0x2A, 0x5D, 0x37, 
+0x0F, 0x59, 0x10, 0xF0, 0x23, 0xF0, 0xA9, 0x75, 
+0xED, 0xDE, 0xFB, 0x74, 0x06, 0x2B, 0xBF, 0x20, 
+0xED, 0xEA, 0xCD, 0x49, 0x8A, 0x41, 0x09, 0x6C, 
+0x0F, 0x0E, 0x79, 0xF7, 0x61, 0x18, 0xBF, 0x77, 
+0x09, 0xB9, 0x05, 0xEF, 0x89, 0xEE, 0x79, 0xD9, 
+0x20, 0xAF, 0xE3, 0x3F, 0x0E, 0xCE, 0x3E, 0x94, 
+0x0C, 0x3B, 0xC1, 0xA0, 0x88, 0xA8, 0x8D, 0xDB, 
+0x4D, 0x70, 0xC6, 0x19, 0x2A, 0xCE, 0xE1, 0x9D, 
+0x9E, 0x60, 0xBD, 0x93, 0x2D, 0xF1, 0xC6, 0x50, 
+0x1B, 0xA7, 0x21, 0x7B, 0x11, 0x86, 0xCD, 0xF9, 
+0x17, 0xAE, 0x4B, 0x88, 0x7B, 0xAF, 0xD1, 0xCA, 
+0x80, 0xF7, 0xA1, 0x7F, 0x0A, 0x4D, 0xF8, 0x35, 
+0x6E, 0xB1, 0x61, 0xEC, 0xAB, 0xD7, 0x60, 0x2D, 
+0xB8, 0xB7, 0x59, 0x77, 0x90, 0xED, 0xC5, 0x24, 
+0xCA, 0x02, 0xF2, 0x35, 0xD8, 0xB4, 
+
+0xE5, 0x07, 0x55, 0x53, 0x6C, 0x5E, 0xD2, 0x9F, 
+0xE4, 0x23, 0xA5, 0x58, 0x45, 0x18, 0x2B, 0x0B, 
+0x17, 0x00, 0xE8, 0xD7, 0x89, 0x06, 0xE6, 0x75, 
+0x56, 0x25, 0xC0, 0x92, 0x79, 0xCD, 0xA2, 0xC0, 
+0xBD, 0x8E, 0x52, 0x0D, 0xF3, 0xA2, 0xA1, 0x66, 
+0x0F, 0x94, 0x23, 0xE7, 0x2D, 0x14, 0xB6, 0x82, 
+0xB0, 0x9A, 0xE1, 0xE8, 0xF3, 0x15, 0x92, 0xFB, 
+0xDE, 0x8D, 0xA2, 0x1A, 0xB3, 0xB2, 0xF7, 0xC0, 
+0xAB, 0xA9, 0x79, 0x70, 0xBE, 0xE8, 0x14, 0x65, 
+0x79, 0x10, 0x1C, 0x7C, 0x29, 0x2C, 0x16, 0x33, 
+0xBB, 0xEF, 0x91, 0x5A, 0xC0, 0xA5, 0x7D, 0x97, 
+0x79, 0x42, 0xB9, 0xA3, 0x7E, 0x9E, 0x87, 0xBB, 
+0xDC, 0x65, 0x12, 0xDC, 0xD9, 0x2D, 0x73, 0x8A, 
+0x73, 0x38, 0xF1, 0xA5, 0x60, 0x7E, 0xD1, 0xF3, 
+0x97, 0xAB, 0xCF, 0xFF, 0x5C, 0x5E, 0xE1, 0xAC, 
+0x1A, 0x41, 0xC2, 0x6D, 0xB1, 0x24, 0xAF, 0x19, 
+0x55, 0x61, 0x4D, 0xDA, 0xD4, 0xE7, 0x10, 0x78, 
+0x14, 0x2B, 0xA4, 0x88, 0x9D, 0x2F, 0x96, 0xF8, 
+0x03, 0xF6, 0xB5, 0x13, 0x2B, 0xEA, 0x52, 0x24, 
+0xB2, 0xA1, 0x46, 0xEC, 0x57, 0x59, 0x19, 0x17, 
+0x83, 0x05, 0x20, 0x44, 0x4C, 0xEC, 0xC7, 0xE0, 
+0xB1, 0x84, 0x00, 0x62, 0xE5, 0xE5, 0xAA, 0x0B, 
+0xE6, 0x0E, 0x44, 0x5D, 0x55, 0x1D, 0x15, 0xDC, 
+0xCE, 0x53, 0x2C, 0xF9, 0x7B, 0xDE, 0x95, 0x05, 
+0xAF, 0x88, 0x59, 0xF1, 0xC2, 0x59, 0x3A, 0xFC, 
+0x2E, 0x43, 0x37, 0x2F, 0xB8, 0x83, 0xD5, 0x42, 
+0xE3, 0xA2, 0xFC, 0xA1, 0x62, 0xC6, 0x99, 0x9C, 
+0x4C, 0x7A, 0x72, 0xD6, 0xF5, 0xF8, 0x3E, 0xE1, 
+0xD0, 0x21, 0xE1, 0x74, 0x69, 0xB4, 0xE5, 0xB5, 
+0x26, 0x2C, 0x37, 0x73, 0x90, 0xD3, 0x1D, 0x5B, 
+0x08, 0xF4, 0x64, 0xE4, 0x9F, 0xA1, 0x95, 0xAE, 
+0xCA, 0xB5, 0x6A, 0xD4, 0x50, 0x12, 0xDC, 
+
+0xED, 0xE8, 0x53, 0x43, 0xCA, 0x1B, 0xB7, 0x40, 
+0x82, 0x4A, 0x01, 0x55, 0xD6, 0x0F, 0x9F, 0xFE, 
+0x2F, 0x1B, 0x94, 0x9F, 0x49, 0xFA, 0x86, 0x45, 
+0x3C, 0x63, 0x14, 0x2E, 0xAF, 0x66, 0x78, 0x97, 
+0xA3, 0x40, 0x11, 0x32, 0x52, 0x3F, 0x22, 0x26, 
+0xCA, 0xCC, 0x61, 0x3F, 0x31, 0x5E, 0x67, 0x5D, 
+0x71, 0x7D, 0x5F, 0x69, 0xBF, 0xDC, 0x93, 0x1E, 
+0xA8, 0x5F, 0xA2, 0x56, 0x91, 0xEC, 0xCE, 0x61, 
+0x67, 0xF3, 0xDC, 0xE8, 0xF4, 0xF7, 0x46, 0xE9, 
+0xE3, 0xB8, 0x21, 0x2A, 0x6E, 0xB9, 0xDD, 0x69, 
+0x14, 0xFF, 0x1C, 0xC1, 0xF4, 0x27, 0x6B, 0x6E, 
+0x2D, 0xC0, 0x75, 0x56, 0xF9, 0x75, 0x21, 0x3D, 
+0x39, 0xE0, 0x67, 0x3C, 0xCC, 0x4D, 0x95, 0xDD, 
+0xFE, 0x99, 0x1C, 0x77, 0xA6, 0xF2, 0x62, 0x23, 
+0x79, 0x49, 0xAB, 0x65, 0x34, 0x2F, 0x71, 0x6F, 
+0xCD, 0xA3, 0xBF, 0xF3, 0xAE, 0x08, 0xF8, 0xF3, 
+0x18, 0x67, 0x51, 0x33, 0xD7, 0x3B, 0xF1, 0xD0, 
+0x37, 0xA9, 0x07, 0x52, 0x77, 0xF0, 0x75, 0x0C, 
+0xC6, 0x4A, 0xDC, 0x6B, 0x4C, 0xBF, 0x80, 0xEC, 
+0x42, 0x89, 0x19, 0x22, 0xE1, 0x90, 0x2F, 0xC9, 
+0x5E, 0x5D, 0xC8, 0xCF, 0xD3, 0x9F, 0x37, 0xAF, 
+0x60, 0xAE, 0x34, 0x5F, 0xD8, 0x3F, 0xA9, 0x55, 
+0x0E, 0x5C, 0xC9, 0xA8, 0xA2, 0x0E, 0xE3, 0xC2, 
+0xAC, 0x9E, 0x87, 0x38, 0xBB, 0x53, 0x86, 0x82, 
+0xDF, 0x05, 0xE4, 0x23, 0xF3, 0x77, 0xDA, 0x7A, 
+0x1E, 0x9C, 0x88, 0x53, 0xB9, 0x14, 0x56, 0x49, 
+0x2F, 0x6A, 0xE8, 0x3A, 0xF1, 0x5C, 0x72, 0xAF, 
+0x2A, 0x19, 0x0A, 0xBB, 0x2B, 0xFD, 0x91, 0x59, 
+0x43, 0x12, 0x9C, 0xC3, 0x0B, 0x7A, 0x7F, 0x85, 
+0x1C, 0x98, 0x34, 0xF6, 0xDE, 0x59, 0x3F, 0x7C, 
+0x49, 0x37, 0x75, 0x6B, 0xC1, 0xE1, 0xD7, 0x70, 
+0xB9, 0xFB, 0xCA, 0xD8, 0x41, 0x6B, 0x8C, 0x01, 
+
+0xB9, 0xC3, 0x0F, 0x35, 0xEB, 0x2F, 0x12, 0x4E, 
+0xE4, 0x78, 0x2D, 0x24, 0x77, 0xA4, 0x89, 0x1F, 
+0x64, 0xAD, 0xE7, 0x2B, 0x49, 0xE0, 0xDC, 0x05, 
+0x7D, 0x6C, 0xEC, 0x4E, 0x9E, 0xAE, 0x2E, 0xDF, 
+0xE6, 0xF2, 0x3F, 0xC6, 0xD5, 0xAA, 0xFC, 0x23, 
+0xAB, 0xCB, 0x96, 0x49, 0xC4, 0xEB, 0xAF, 0x18, 
+0x14, 0x27, 0xFB, 0xE7, 0x1C, 0x34, 0xF7, 0x22, 
+0x69, 0xA3, 0xD2, 0xFD, 0x9E, 0x9F, 0x29, 0xA9, 
+0x50, 0x34, 0x3C, 0x4F, 0xDD, 0xE5, 0xAB, 0xE6, 
+0xAC, 0x99, 0x80, 0x50, 0x7A, 0x4F, 0x79, 0xD2, 
+0xC2, 0xC6, 0x31, 0x8F, 0xF6, 0xD6, 0xAA, 0x57, 
+0x00, 0xA2, 0x4B, 0x61, 0xBA, 0xD6, 0x28, 0xD3, 
+0xFA, 0x93, 0xE4, 0x65, 0x53, 0x81, 0xD3, 0x01, 
+0xF1, 0xDA, 0x7A, 0x12, 0x85, 0x17, 0x5A, 0x7E, 
+0x35, 0x21, 0x6C, 0xF0, 0x18, 0x34, 0xA4, 0xF4, 
+0xDD, 0x24, 0xEB, 0x86, 0xDC, 0xD0, 0xF7, 0xAB, 
+0x41, 0xB5, 0x59, 0x3F, 0x22, 0x7B, 0xCF, 0xDB, 
+0x61, 0x4D, 0x61, 0x58, 0x55, 0xA5, 0x23, 0xCE, 
+0x69, 0x2D, 0x5C, 0x24, 0x73, 0xA2, 0x80, 0x3F, 
+0x67, 0xB7, 0x66, 0xD8, 0x30, 0x87, 0xFE, 0x74, 
+0x23, 0x35, 0xFD, 0xD8, 0xA7, 0xD4, 0xAC, 0x02, 
+0x40, 0xF6, 0xCA, 0x9B, 0xB3, 0xB4, 0x73, 0xEA, 
+0x67, 0x39, 0xB4, 0x1B, 0xB4, 0x45, 0xFB, 0xB2, 
+0xF9, 0xDB, 0x8E, 0x90, 0x29, 0x62, 0x9D, 0x9D, 
+0x73, 0x30, 0x6C, 0x51, 0x56, 0xA6, 0x9B, 0x9A, 
+0xE9, 0x33, 0xCA, 0xC8, 0xED, 0xE4, 0x58, 0x91, 
+0x82, 0x17, 0x10, 0x0F, 0x2D, 0xF7, 0x82, 0xCD, 
+0x25, 0x61, 0x45, 0xCD, 0xDD, 0x66, 0xF1, 0x8A, 
+0xDC, 0x08, 0xF8, 0x53, 0xF6, 0x07, 0x8E, 0x3A, 
+0x6F, 0x0B, 0xF0, 0x9D, 0x8F, 0xFE, 0xDD, 0xCF, 
+0x94, 0xDD, 0xB2, 0x1F, 0x1A, 0xFF, 0x08, 0x22, 
+0x3D, 0xEE, 0x45, 0x6B, 0xD2, 0x5A, 0xF3, 0x15, 
+0x93, 
+
+0xCC, 0x0E, 0xCA, 0xDF, 0xEF, 0xC6, 0xC6, 0x35, 
+0x66, 0x95, 0xFF, 0xCE, 0x3F, 0x13, 0x97, 0xEA, 
+0xEC, 0xB9, 0xEB, 0x1F, 0x82, 0x4E, 0x6A, 0xFF, 
+0xB0, 0x0A, 0x75, 0xAB, 0xBC, 0x6B, 0xF1, 0xC4, 
+0xEE, 0xB8, 0x8C, 0xAE, 0x52, 0xED, 0x7C, 0x9E, 
+0xF3, 0xE2, 0x74, 0xAD, 0x95, 0x65, 0x8F, 0x2D, 
+0x1A, 0x93, 0xF5, 0x35, 0x5D, 0x5E, 0x7F, 0x5A, 
+0x22, 0x9F, 0x48, 0x81, 0x25, 0xDE, 0xB1, 0xC3, 
+0x0A, 0xD6, 0x7E, 0x9E, 0x77, 0xF0, 0x1F, 0x77, 
+0xE0, 0x85, 0xBB, 0xFA, 0xC5, 0x87, 0xC7, 0x17, 
+0x0A, 0x7B, 0x3D, 0x85, 0xD4, 0xA9, 0xCA, 0x98, 
+0xCC, 0x08, 0xD5, 0x40, 0x58, 0x6A, 0x0D, 0xFE, 
+0xBC, 0x4D, 0xBE, 0x46, 0x9E, 0xC0, 0x84, 0xF4, 
+0x63, 0xE7, 0xEA, 0xFD, 0x7B, 0x73, 0x1D, 0xDB, 
+0xE2, 0xD7, 0x51, 0x6D, 0xB2, 0x4F, 0x79, 0x37, 
+0x9E, 0x37, 0x39, 0xE9, 0xD7, 0xCB, 0x7F, 0x56, 
+0x5E, 0x68, 0xCC, 0x73, 0x7B, 0xBA, 0x3D, 0x76, 
+0x0A, 0x41, 0x0B, 0x83, 0xC5, 0xAA, 0xCF, 0x7E, 
+0xDA, 0x40, 0xB6, 0xCB, 0xC1, 0x76, 0xE5, 0x21, 
+0xC3, 0xC8, 0xDB, 0x01, 0x2A, 0x87, 0xFF, 0x9E, 
+0xC7, 0x58, 0xD5, 0xC8, 0x22, 0x37, 0x3F, 0x1F, 
+0x49, 0xF0, 0x9F, 0x7C, 0x60, 0x91, 0x8C, 0xC5, 
+0x51, 0xE3, 0x5F, 0x7A, 0x98, 0x0B, 0x3E, 0xE1, 
+0xAA, 0xC8, 0xE4, 0x3C, 0xC5, 0x98, 0xC0, 0xE5, 
+0x00, 0x5C, 0xEB, 0x57, 0xAF, 0xF2, 0x67, 0x30, 
+0x11, 0xB0, 0xD8, 0x82, 0x8A, 0x5A, 0x97, 0xC8, 
+0xFC, 0x09, 0xD7, 0x74, 0x98, 0xC9, 0x8D, 0xB5, 
+0x1A, 0x96, 0x79, 0x47, 0xDE, 0x9B, 0x7C, 0xF3, 
+0x86, 0x80, 0x87, 0x2C, 0x2F, 0xC6, 0xA6, 0x17, 
+0x85, 0xF3, 0xD1, 0xEE, 0x33, 0x68, 0x7A, 0xCC, 
+0x54, 0x4A, 0x08, 0x18, 0x8F, 0x20, 0x08, 0x33, 
+0x43, 0x25, 0x05, 0x9B, 0x21, 0xB2, 0x49, 0xA5, 
+0x33, 0xC0, 
+
+0x62, 0xD2, 0x23, 0x2D, 0x6D, 0xC4, 0x02, 0x15, 
+0x9C, 0xC7, 0x37, 0x08, 0xEA, 0x73, 0x56, 0xB4, 
+0x77, 0xEE, 0x14, 0xEC, 0x2B, 0x86, 0x51, 0xA0, 
+0x63, 0x54, 0x00, 0xD4, 0xDE, 0x91, 0xE9, 0x94, 
+0x3F, 0x32, 0xE6, 0x44, 0x49, 0xF7, 0xBD, 0x4C, 
+0xFA, 0x07, 0xFB, 0xCB, 0x86, 0xFC, 0xBA, 0x1B, 
+0x66, 0x52, 0xE3, 0x2C, 0x52, 0x5C, 0x07, 0xD7, 
+0xFF, 0x08, 0x93, 0x73, 0x30, 0x4E, 0xB8, 0x97, 
+0x8E, 0xB5, 0xEB, 0x24, 0xF7, 0x26, 0xB5, 0x21, 
+0xEA, 0x1A, 0x62, 0x1B, 0xAC, 0x12, 0x83, 0xB1, 
+0xB2, 0xAB, 0x1C, 0x1C, 0x69, 0x7D, 0x5C, 0x9D, 
+0x4E, 0x20, 0x13, 0x52, 0x6B, 0x88, 0xB6, 0xBE, 
+0x0E, 0x8D, 0x23, 0xD4, 0x14, 0xF5, 0x99, 0xEB, 
+0x2E, 0xC8, 0x4D, 0x38, 0x57, 0x47, 0x70, 0x18, 
+0x65, 0xB5, 0xB8, 0xB4, 0x29, 0x99, 0xA2, 0x4B, 
+0xAA, 0x5A, 0xEC, 0x8B, 0xCA, 0x9F, 0xE9, 0x6D, 
+0x8B, 0x0D, 0xEC, 0x0A, 0xCB, 0xD8, 0xF7, 0x0C, 
+0x7B, 0x22, 0xB0, 0xE8, 0x3C, 0x4F, 0x0C, 0xEC, 
+0x7E, 0x2D, 0x8F, 0x93, 0x2C, 0xC0, 0xA8, 0x40, 
+0x48, 0xAA, 0xAF, 0x83, 0x08, 0xBF, 0xBF, 0x5C, 
+0xBE, 0xB8, 0xAD, 0xC0, 0xCC, 0xDC, 0x7D, 0x00, 
+0x2B, 0x2B, 0xC7, 0x74, 0xB0, 0x77, 0x95, 0x5F, 
+0xE9, 0xA1, 0x65, 0x45, 0xB0, 0xBB, 0xEF, 0xD8, 
+0xFA, 0x1D, 0x1F, 0x66, 0xEF, 0x3B, 0x68, 0x7B, 
+0x67, 0x9F, 0xFF, 0xA4, 0xB5, 0x24, 0x69, 0xAB, 
+0x84, 0xE3, 0x75, 0x69, 0x56, 0x72, 0x19, 0x0D, 
+0x47, 0xA3, 0xF8, 0xAE, 0xA1, 0x4E, 0xB0, 0x4C, 
+0xAA, 0x0F, 0xF9, 0x08, 0xEA, 0x8F, 0x69, 0xFC, 
+0x60, 0xF4, 0x91, 0xFF, 0x08, 0xD5, 0xD4, 0xB4, 
+0xD2, 0x4B, 0xBD, 0x4E, 0x3F, 0x53, 0x34, 0x06, 
+0x4A, 0x26, 0x57, 0x0F, 0xF2, 0xDE, 0x9D, 0x02, 
+0xA4, 0xE0, 0x0D, 0xD7, 0x2C, 0x5F, 0x5F, 0x05, 
+0x4F, 0x60, 0x38, 
+
+0x0D, 0xEC, 0xE4, 0x86, 0xF5, 0x6D, 0xC1, 0x52, 
+0x88, 0x55, 0xB9, 0x1D, 0x2D, 0xD7, 0x0A, 0xB4, 
+0x5C, 0xB2, 0xDE, 0xF6, 0xBD, 0x91, 0x5D, 0x8D, 
+0x0D, 0xB0, 0xD8, 0x54, 0x56, 0x62, 0xF1, 0x94, 
+0xB5, 0xEE, 0x73, 0x23, 0xF2, 0x25, 0xF5, 0x7E, 
+0x6A, 0xBF, 0x8A, 0xAA, 0x1C, 0x66, 0xCB, 0xA0, 
+0xD6, 0xB2, 0xFC, 0x94, 0x6E, 0x26, 0x49, 0xF8, 
+0xDE, 0xC0, 0xD8, 0x04, 0x93, 0x80, 0x16, 0x52, 
+0xA6, 0xDE, 0x3F, 0x87, 0x4E, 0x2F, 0x93, 0xBD, 
+0xAA, 0x8D, 0xA8, 0x86, 0x27, 0xAC, 0x6D, 0xA0, 
+0x13, 0x81, 0xF6, 0xE7, 0x7D, 0xBC, 0xF1, 0x09, 
+0x38, 0x09, 0x93, 0xC5, 0x94, 0x84, 0x0F, 0x4E, 
+0x28, 0xCA, 0x29, 0x4D, 0xED, 0xDC, 0x91, 0xDC, 
+0xEC, 0x16, 0xF7, 0x86, 0x4C, 0x0B, 0x2C, 0xDA, 
+0xCE, 0x2B, 0x1B, 0xB4, 0xFC, 0x6F, 0xA5, 0x7C, 
+0xE2, 0xC0, 0xAD, 0xC7, 0xA7, 0xE1, 0x28, 0x03, 
+0xD5, 0xB3, 0x30, 0x51, 0xA2, 0x24, 0x2F, 0x9E, 
+0x16, 0x48, 0x54, 0x6B, 0x36, 0x60, 0x22, 0x58, 
+0x00, 0xFF, 0x40, 0x59, 0x17, 0x36, 0x1C, 0x2C, 
+0x58, 0x0F, 0xC0, 0x5D, 0x41, 0x47, 0x7F, 0xE3, 
+0xB1, 0x5F, 0x0A, 0x88, 0xBC, 0xBA, 0x83, 0xBF, 
+0x3D, 0x0B, 0xDF, 0xFC, 0xD3, 0x55, 0xDF, 0x72, 
+0x0D, 0x8A, 0xAF, 0x4D, 0xF4, 0x0C, 0x0F, 0x8C, 
+0xE9, 0xEE, 0xA5, 0xA7, 0xFF, 0x87, 0x81, 0x2E, 
+0x38, 0x04, 0x76, 0xAE, 0xA2, 0xE6, 0x84, 0x73, 
+0xFF, 0xEC, 0xEE, 0xA3, 0x40, 0xA8, 0x67, 0x8C, 
+0xD6, 0x68, 0x70, 0xA0, 0x6B, 0x3F, 0x0D, 0x45, 
+0xE0, 0xA0, 0x29, 0x38, 0xF8, 0xD5, 0x58, 0x59, 
+0xBD, 0x9B, 0x2B, 0xD3, 0x8B, 0x45, 0x3D, 0xDB, 
+0x79, 0x0F, 0x25, 0xE7, 0xF9, 0xC7, 0x30, 0xC8, 
+0x92, 0xC1, 0x99, 0xBE, 0xEC, 0xAF, 0x68, 0xDF, 
+0x9F, 0xEC, 0x4C, 0x8C, 0xD8, 0x7A, 0xEF, 0x07, 
+0x6E, 0x14, 0xF4, 0x41, 
+
+0x93, 0x45, 0x33, 0x61, 0xF0, 0xF2, 0x27, 0x76, 
+0x4C, 0x53, 0x60, 0xAE, 0x83, 0xB0, 0x90, 0x70, 
+0xDB, 0xAE, 0x2F, 0x1A, 0xCF, 0xF2, 0xC6, 0x50, 
+0x36, 0x1B, 0x2E, 0x75, 0xF2, 0x4F, 0x1B, 0x9B, 
+0x81, 0x2F, 0x49, 0x96, 0xED, 0x05, 0x69, 0x07, 
+0x0C, 0x6B, 0x5A, 0x35, 0x11, 0x6B, 0x29, 0xC6, 
+0x07, 0xF5, 0x68, 0xAF, 0x26, 0xE1, 0xEB, 0x71, 
+0x53, 0x4A, 0xC0, 0x5A, 0x1B, 0xDD, 0x49, 0x28, 
+0x90, 0xE3, 0xDB, 0x1F, 0x86, 0xB1, 0xAB, 0x4A, 
+0x64, 0x39, 0x8F, 0x83, 0xA7, 0x37, 0x1B, 0xC9, 
+0xB6, 0xEA, 0x5E, 0xA8, 0x25, 0x87, 0x78, 0x52, 
+0xA0, 0xE5, 0xA3, 0x26, 0x74, 0x66, 0xCC, 0x0A, 
+0x92, 0x7D, 0x9B, 0x4B, 0xAD, 0x79, 0x7F, 0x87, 
+0x9E, 0x0A, 0xF9, 0xDE, 0xB0, 0x31, 0x2B, 0xDC, 
+0xC8, 0x29, 0xD0, 0xAC, 0x3C, 0xD8, 0x60, 0x19, 
+0xA5, 0xF6, 0x50, 0xC1, 0xBA, 0x0F, 0xE4, 0x4A, 
+0x07, 0xE8, 0xA5, 0xB7, 0x6C, 0x88, 0xF1, 0xBE, 
+0xAF, 0x2F, 0x40, 0x9B, 0xB3, 0x98, 0x28, 0x43, 
+0x25, 0xBB, 0x23, 0x1C, 0xF3, 0x98, 0x19, 0x9E, 
+0x9E, 0x3D, 0xC0, 0xFF, 0x05, 0xA8, 0x35, 0xCD, 
+0x47, 0xB7, 0xB3, 0xEF, 0xC9, 0x38, 0x36, 0xE7, 
+0xF9, 0x6F, 0x5B, 0x6E, 0x79, 0xCB, 0x08, 0x49, 
+0x6F, 0x4C, 0x17, 0xC2, 0x91, 0x7E, 0x8B, 0x86, 
+0x57, 0x30, 0x8F, 0x92, 0x94, 0x34, 0x10, 0x1B, 
+0xE5, 0x8F, 0xCC, 0xAF, 0xD0, 0x87, 0xDA, 0x9A, 
+0xF2, 0x09, 0x99, 0x6C, 0x8E, 0x50, 0x91, 0x7D, 
+0x7C, 0x4D, 0x3E, 0x24, 0xFF, 0xD4, 0xC1, 0xAA, 
+0xA7, 0x80, 0x89, 0xB5, 0x8E, 0x50, 0x7D, 0x49, 
+0xD4, 0xA0, 0xA1, 0xFD, 0x34, 0xCA, 0xB8, 0xCB, 
+0x64, 0x76, 0x07, 0x91, 0x39, 0x7D, 0xAE, 0xE2, 
+0xD9, 0x5A, 0xCB, 0xB7, 0xAD, 0x9E, 0x8C, 0xE8, 
+0x0A, 0xE8, 0xE9, 0xCC, 0x49, 0x97, 0xC3, 0xE9, 
+0x96, 0x1A, 0xCE, 0xFF, 0x16, 
+
+0x3D, 0x76, 0xF1, 0x1D, 0x88, 0x56, 0x8E, 0x23, 
+0x96, 0xE0, 0x55, 0xE9, 0xF3, 0x36, 0xCE, 0xEA, 
+0x3C, 0x72, 0x34, 0x12, 0xA4, 0x19, 0x29, 0x74, 
+0x26, 0xCA, 0xF9, 0xDB, 0xA4, 0x2F, 0x2E, 0xBD, 
+0xD1, 0x64, 0x97, 0xE2, 0x7E, 0x30, 0x59, 0xDB, 
+0xBB, 0x7D, 0x42, 0xD2, 0xC1, 0x84, 0x18, 0x1F, 
+0x67, 0x61, 0x5D, 0x40, 0xE5, 0xD8, 0x83, 0x36, 
+0x98, 0xAB, 0x2F, 0xE8, 0x24, 0x13, 0xC7, 0x61, 
+0x0A, 0x71, 0x59, 0x9E, 0x51, 0xE1, 0xBB, 0xCE, 
+0xEA, 0xE7, 0xFC, 0x7E, 0x92, 0xE0, 0x1F, 0xA5, 
+0xE3, 0x89, 0x80, 0xBC, 0xA8, 0xCB, 0xDB, 0xFD, 
+0x49, 0x8D, 0x7F, 0x01, 0x15, 0xA5, 0x35, 0x89, 
+0x46, 0xB5, 0xBC, 0x8E, 0x96, 0x80, 0x11, 0xCA, 
+0x6C, 0x56, 0x94, 0x1C, 0x0C, 0xEC, 0x56, 0x78, 
+0xC9, 0x7E, 0x4A, 0x44, 0xE7, 0xDF, 0x03, 0xA9, 
+0x32, 0xF5, 0xA2, 0x4C, 0x80, 0xBB, 0x31, 0x9E, 
+0x00, 0x5D, 0xCD, 0xB2, 0x33, 0x41, 0xCA, 0xA4, 
+0x8A, 0xB3, 0x52, 0x59, 0xD9, 0x19, 0xD4, 0x98, 
+0x78, 0xFE, 0xB1, 0x02, 0x6D, 0x52, 0x6F, 0x23, 
+0xC8, 0xF7, 0xA8, 0xC2, 0xB4, 0x90, 0x69, 0x68, 
+0x5A, 0xA5, 0xDB, 0x26, 0x93, 0xF0, 0x78, 0x25, 
+0xC3, 0x94, 0x1F, 0x0A, 0x0B, 0x0F, 0xA8, 0xC2, 
+0xCB, 0x7B, 0x0D, 0xC9, 0x53, 0x1D, 0x5A, 0xB4, 
+0x30, 0xF2, 0xDD, 0x15, 0x8D, 0xDE, 0x49, 0x82, 
+0x94, 0x9B, 0x6E, 0x2D, 0x2D, 0xD7, 0x21, 0x38, 
+0x98, 0x61, 0xEB, 0xF3, 0xDE, 0xB1, 0xCE, 0x54, 
+0xB9, 0x7F, 0x9C, 0x4B, 0xF0, 0x8B, 0xD1, 0xBF, 
+0x6A, 0xA4, 0x57, 0x28, 0x86, 0xE2, 0xB2, 0x74, 
+0x31, 0x51, 0x9E, 0x30, 0xF5, 0x98, 0x3E, 0x9D, 
+0x98, 0x21, 0x7C, 0xAD, 0x68, 0x44, 0x91, 0x5C, 
+0x11, 0x5A, 0x42, 0x0C, 0x84, 0x69, 0x62, 0xD0, 
+0x98, 0x3D, 0x89, 0x4D, 0xBC, 0x65, 0x38, 0x03, 
+0x44, 0x76, 0xFF, 0x20, 0x3D, 0x28, 
+
+0xD4, 0xAE, 0xDA, 0xA8, 0x24, 0x19, 0x06, 0xFB, 
+0xE9, 0xAD, 0x9F, 0x3C, 0x1D, 0xD6, 0x92, 0x5E, 
+0x83, 0x58, 0x54, 0xBB, 0xCB, 0x7D, 0x8E, 0x24, 
+0x0F, 0x0A, 0x43, 0xF5, 0x6D, 0x89, 0x99, 0x4A, 
+0xB7, 0xDF, 0x8A, 0x84, 0x00, 0x1A, 0xF5, 0x6E, 
+0x1C, 0xC2, 0xFA, 0x20, 0xE7, 0xC2, 0x41, 0x60, 
+0x7C, 0xCE, 0x90, 0x8E, 0x16, 0x64, 0x94, 0xDA, 
+0xE2, 0x3A, 0x8D, 0xDF, 0x21, 0x61, 0x17, 0x3C, 
+0x72, 0xE3, 0x66, 0x35, 0x0B, 0xF6, 0x30, 0x5C, 
+0x82, 0x5C, 0x48, 0xA1, 0xE8, 0x21, 0x9C, 0x92, 
+0xA1, 0x0F, 0xBF, 0x89, 0xC8, 0x7A, 0xEE, 0x18, 
+0x87, 0x5B, 0x51, 0xF3, 0xF7, 0x0A, 0xA3, 0xB4, 
+0x2E, 0xDA, 0x4E, 0xAA, 0xAD, 0x6E, 0x70, 0x04, 
+0xF8, 0x47, 0x1E, 0x5F, 0x43, 0x69, 0x0B, 0x26, 
+0x4C, 0xBC, 0xDB, 0x46, 0x77, 0x87, 0xA1, 0x9A, 
+0x04, 0xEF, 0x74, 0x99, 0xEA, 0x29, 0x71, 0x55, 
+0x9C, 0x2E, 0x47, 0xE3, 0x4A, 0x15, 0xA4, 0xF6, 
+0xED, 0x5D, 0xBE, 0x4F, 0x94, 0x3F, 0xFD, 0xD3, 
+0x6E, 0x0E, 0xD9, 0x26, 0x68, 0x9F, 0xC2, 0x8C, 
+0xA5, 0xA8, 0xF0, 0xFB, 0x53, 0x5F, 0x18, 0xCA, 
+0xDB, 0xBD, 0x98, 0x96, 0x28, 0xFC, 0xE9, 0x2E, 
+0x81, 0xE1, 0x0E, 0x3A, 0x77, 0x18, 0x25, 0x9D, 
+0xB7, 0x67, 0x8C, 0x35, 0x68, 0x4A, 0x88, 0x18, 
+0x45, 0x6C, 0xE5, 0xCC, 0x22, 0xD2, 0x77, 0x9A, 
+0x68, 0x0E, 0xC5, 0xA6, 0x1D, 0xAD, 0xDB, 0xB5, 
+0xFF, 0xEA, 0x7C, 0x7D, 0x19, 0x1B, 0x9D, 0xB9, 
+0xD0, 0xA7, 0xAB, 0x4D, 0x4A, 0x7D, 0x1A, 0xA5, 
+0x09, 0x54, 0x84, 0x5C, 0x14, 0x4E, 0xA6, 0x14, 
+0x6B, 0x22, 0x46, 0x7E, 0x5E, 0xB9, 0x33, 0x97, 
+0xB5, 0x63, 0x5C, 0xCE, 0xF4, 0xD0, 0xD8, 0x8C, 
+0xCC, 0xE7, 0x67, 0x01, 0x1B, 0x56, 0x68, 0x54, 
+0x56, 0x4C, 0x19, 0x1D, 0x05, 0xF8, 0x55, 0xD6, 
+0x45, 0xC0, 0xAD, 0xD6, 0xEE, 0xD9, 0x03, 
+
+0x7F, 0x07, 0xFA, 0x8C, 0x77, 0xF1, 0xDA, 0x4E, 
+0x4B, 0x65, 0x55, 0xA4, 0x12, 0xE8, 0x7F, 0x04, 
+0x0A, 0xEC, 0x57, 0xFF, 0x30, 0xD1, 0xE2, 0x08, 
+0x71, 0xEC, 0x5F, 0x72, 0x88, 0x37, 0xC1, 0x33, 
+0x79, 0xC8, 0x49, 0x47, 0x11, 0xC9, 0x8C, 0xD3, 
+0x3B, 0x4F, 0x2C, 0x5A, 0xDD, 0x8C, 0x00, 0x8B, 
+0xF6, 0xC4, 0x07, 0x7E, 0x2C, 0xFA, 0x3A, 0xBF, 
+0x84, 0xBA, 0x13, 0x31, 0x20, 0x69, 0xAF, 0xBD, 
+0x61, 0xB0, 0xFF, 0x28, 0x40, 0x1B, 0x5F, 0x1D, 
+0x87, 0x99, 0x23, 0x95, 0x77, 0x88, 0x3F, 0xBB, 
+0xFA, 0x55, 0xB5, 0xB2, 0xF7, 0xF3, 0xA6, 0x0B, 
+0x57, 0x71, 0xF5, 0x7A, 0xE7, 0x23, 0x61, 0x54, 
+0x13, 0xCB, 0x71, 0x97, 0xDE, 0xFF, 0x0E, 0x23, 
+0x5C, 0xD4, 0xCC, 0xB1, 0xB8, 0x2F, 0xA1, 0xA5, 
+0x15, 0xEB, 0xB4, 0x47, 0xA7, 0x7C, 0xEA, 0xD1, 
+0x23, 0xE7, 0xFE, 0x9D, 0x56, 0xC5, 0x73, 0x9D, 
+0xE8, 0x90, 0x4E, 0x00, 0xE5, 0x7F, 0x6E, 0x36, 
+0x6D, 0xEA, 0x8C, 0xBA, 0xC6, 0x9C, 0xA0, 0x27, 
+0x13, 0x90, 0x35, 0xE7, 0xD5, 0x27, 0x56, 0x50, 
+0x70, 0x64, 0x03, 0xD2, 0x43, 0xDC, 0x1F, 0x60, 
+0x4B, 0x5D, 0x48, 0xF1, 0x82, 0xE6, 0x9A, 0xDE, 
+0xC7, 0xDB, 0x2F, 0x0D, 0xD9, 0xF4, 0xAD, 0xD0, 
+0xB9, 0xDC, 0x01, 0x09, 0x74, 0x4F, 0x29, 0x71, 
+0x61, 0x6D, 0xE2, 0xAC, 0xE3, 0x60, 0x9C, 0x4D, 
+0x9A, 0x26, 0xC9, 0x3D, 0x11, 0x04, 0x83, 0x9F, 
+0x39, 0x39, 0x0E, 0x44, 0xB2, 0xD3, 0x7A, 0x0D, 
+0xD7, 0x0B, 0xDB, 0x14, 0x4A, 0x46, 0xA7, 0xB5, 
+0x34, 0x2C, 0x22, 0x98, 0xBA, 0x6A, 0xBA, 0x4A, 
+0xDA, 0x30, 0x8C, 0xE7, 0xDF, 0xD8, 0x73, 0x83, 
+0x8C, 0xB6, 0x7A, 0x73, 0x74, 0xE5, 0x04, 0x32, 
+0x66, 0x24, 0xC9, 0x45, 0xDA, 0x86, 0xE2, 0xCD, 
+0xF9, 0xDB, 0x2F, 0x1D, 0xBA, 0x2C, 0xC0, 0x91, 
+0x06, 0xF7, 0x44, 0xE8, 0x30, 0x34, 0x94, 0x91, 
+
+0xD5, 0x3B, 0xA6, 0x5A, 0x56, 0xB4, 0xDD, 0xBC, 
+0xD8, 0xE7, 0x85, 0x2B, 0x3E, 0xFC, 0xBC, 0xD1, 
+0xB9, 0x68, 0x09, 0xC3, 0xA5, 0xC1, 0x1D, 0x5C, 
+0x4C, 0x11, 0xAC, 0x4A, 0xA3, 0x96, 0xAB, 0xD2, 
+0xFD, 0x33, 0xD7, 0x69, 0xD1, 0x95, 0x50, 0xE6, 
+0x4D, 0x3A, 0xE9, 0x9D, 0x67, 0x68, 0x16, 0x01, 
+0xE7, 0x8A, 0xE3, 0x90, 0xC8, 0x4D, 0xD4, 0xDF, 
+0xB0, 0xA1, 0x90, 0x14, 0xC2, 0x32, 0x9A, 0xC2, 
+0x86, 0xA2, 0x53, 0x72, 0x1A, 0x9A, 0xFE, 0x8E, 
+0xB1, 0x4C, 0xCD, 0x9F, 0xFF, 0x3F, 0xA6, 0x6A, 
+0x8E, 0xEB, 0x5E, 0x7C, 0x9D, 0xF3, 0x4B, 0x3B, 
+0x09, 0xC7, 0x68, 0x71, 0xD5, 0xBA, 0x32, 0x2D, 
+0xBA, 0x15, 0xE3, 0x63, 0xD7, 0x5A, 0x87, 0x5E, 
+0x9E, 0xAF, 0xB0, 0x9C, 0xF9, 0x35, 0xA3, 0x67, 
+0x1B, 0xD5, 0xC7, 0x9C, 0xB5, 0x63, 0x0E, 0x16, 
+0x9B, 0x2D, 0x25, 0x1E, 0x22, 0x96, 0x0A, 0x96, 
+0xBE, 0xEF, 0x65, 0xAA, 0xFE, 0x28, 0xB9, 0x55, 
+0xD0, 0xDC, 0xB2, 0x5C, 0x21, 0x9B, 0x31, 0xF7, 
+0xDE, 0xD9, 0xA6, 0x86, 0x3D, 0xD8, 0x5F, 0xB4, 
+0xC5, 0x7A, 0x4D, 0xAB, 0xE5, 0xBC, 0x10, 0xB7, 
+0x26, 0x15, 0x08, 0x64, 0x58, 0xB0, 0x4F, 0x77, 
+0xCE, 0xDC, 0xBD, 0x1D, 0xD5, 0x21, 0x9A, 0x9A, 
+0x93, 0x72, 0x2D, 0x4D, 0x82, 0x36, 0x29, 0xCA, 
+0xAB, 0x80, 0xD2, 0x02, 0x1D, 0x6C, 0x49, 0x30, 
+0x56, 0x20, 0x8D, 0xFA, 0xA1, 0x58, 0x3F, 0xA6, 
+0xCB, 0xF0, 0x41, 0xFB, 0xDD, 0xD0, 0x6D, 0x80, 
+0x75, 0xE1, 0x36, 0xAC, 0xF8, 0xAE, 0xDB, 0x37, 
+0xB0, 0xDE, 0x4B, 0x89, 0xFB, 0xC1, 0x1B, 0x23, 
+0x05, 0xBC, 0x02, 0x87, 0x42, 0x9A, 0x77, 0x23, 
+0x99, 0xB4, 0x56, 0x7E, 0x7C, 0xD7, 0x61, 0x18, 
+0xD2, 0x4F, 0x19, 0xE6, 0x05, 0x4D, 0x72, 0xC8, 
+0xB7, 0xA9, 0x9F, 0xF9, 0x9F, 0xE9, 0x54, 0x34, 
+0x54, 0x45, 0x7C, 0xA4, 0xCC, 0x3B, 0xFF, 0x2B, 
+0x58, 
+
+0xB7, 0x93, 0x32, 0xE4, 0xCA, 0xD7, 0xB5, 0x4F, 
+0x11, 0xB0, 0x5B, 0x38, 0xB8, 0xC0, 0x90, 0xA8, 
+0x0E, 0x4B, 0x34, 0x6C, 0xCC, 0x81, 0x82, 0xC5, 
+0x41, 0x91, 0x0D, 0x1A, 0x47, 0x0E, 0xE6, 0x39, 
+0x7F, 0xDD, 0xF9, 0xBE, 0xF1, 0xB0, 0xDC, 0x76, 
+0xB8, 0x2C, 0x2B, 0xAD, 0xA9, 0xE5, 0xCA, 0x70, 
+0x8D, 0xF1, 0xCA, 0x60, 0x5C, 0x85, 0x2E, 0x73, 
+0xE7, 0xF8, 0x0A, 0x33, 0xB3, 0x32, 0xC0, 0xCD, 
+0x64, 0x4C, 0x9C, 0x65, 0x55, 0x2A, 0x60, 0x42, 
+0xF3, 0xBA, 0xF5, 0xC2, 0x28, 0xFC, 0xFE, 0x32, 
+0x96, 0xBF, 0x6F, 0x7E, 0x09, 0x82, 0xA5, 0xD0, 
+0xE4, 0x11, 0x66, 0x67, 0x66, 0xDB, 0x8A, 0x3D, 
+0x08, 0x40, 0xC4, 0x40, 0x66, 0xF8, 0x1F, 0x86, 
+0x39, 0xDB, 0xB6, 0x12, 0x11, 0x4E, 0xE4, 0x52, 
+0xA5, 0x18, 0x4A, 0x3F, 0x04, 0x01, 0x3D, 0x8D, 
+0x15, 0xEE, 0x28, 0xBD, 0xED, 0x32, 0x11, 0xB8, 
+0xE9, 0x5E, 0x2A, 0xB7, 0xD3, 0x3C, 0xDD, 0xC6, 
+0x42, 0xE0, 0x15, 0x20, 0x0B, 0x13, 0x7E, 0x21, 
+0xFE, 0x7B, 0x5F, 0x46, 0x70, 0xF6, 0x64, 0x73, 
+0x71, 0x1A, 0x29, 0xD9, 0x07, 0xE0, 0xA9, 0x65, 
+0x5B, 0x90, 0x17, 0x5E, 0xE6, 0xFC, 0x29, 0xA2, 
+0x5B, 0xB0, 0x00, 0x54, 0x6C, 0x15, 0xA1, 0x44, 
+0x66, 0x0F, 0x87, 0xCF, 0x66, 0x04, 0x6F, 0x3F, 
+0x04, 0xE5, 0xFA, 0x2D, 0x0C, 0x70, 0x43, 0xF0, 
+0x08, 0x01, 0x62, 0x21, 0x81, 0x65, 0x9F, 0x61, 
+0xC6, 0x5A, 0x80, 0x64, 0x9D, 0x8E, 0xD7, 0x52, 
+0xCF, 0xDC, 0x04, 0x50, 0x0A, 0x98, 0x5A, 0xF2, 
+0x9F, 0x6A, 0xAF, 0xDA, 0x96, 0x35, 0x34, 0xF9, 
+0xAB, 0x1E, 0x17, 0xC3, 0x18, 0x90, 0xFB, 0x07, 
+0x5E, 0xA0, 0x02, 0x4C, 0x0C, 0xE1, 0xE9, 0x06, 
+0x7F, 0x1F, 0xC1, 0xA2, 0x77, 0x63, 0xF0, 0x96, 
+0xEE, 0x87, 0xC8, 0x28, 0x79, 0x17, 0x33, 0x2D, 
+0x16, 0x43, 0x9A, 0x79, 0x32, 0xA9, 0x3D, 0x1F, 
+0x35, 0x3D, 
+
+0xD1, 0x6C, 0xFF, 0x29, 0x4F, 0xC3, 0x29, 0xC5, 
+0xC9, 0x5B, 0xDC, 0x81, 0xD1, 0xB3, 0x55, 0xA8, 
+0x5E, 0xE2, 0xC9, 0xE3, 0xFC, 0x16, 0x30, 0x02, 
+0xAB, 0x43, 0x26, 0x7E, 0xD1, 0xCD, 0x2B, 0x06, 
+0xE8, 0x4E, 0xDA, 0xA3, 0xD3, 0xD3, 0x87, 0x05, 
+0xB3, 0x05, 0x8C, 0xCF, 0xAE, 0xEF, 0x15, 0x33, 
+0xCE, 0x72, 0x64, 0x52, 0x88, 0x78, 0x20, 0x1B, 
+0xF1, 0x7E, 0xC9, 0x46, 0x13, 0xCF, 0xD9, 0xF2, 
+0x4B, 0x1C, 0xA6, 0x96, 0xAF, 0x02, 0xAD, 0x6F, 
+0xDB, 0xAD, 0x6F, 0x04, 0x55, 0xA2, 0x2C, 0x9C, 
+0x56, 0xB6, 0x07, 0x8A, 0x87, 0x3F, 0xD6, 0x06, 
+0x90, 0x4C, 0x6D, 0x5E, 0xE5, 0x16, 0x21, 0x46, 
+0x00, 0x88, 0x77, 0x58, 0x0E, 0x9E, 0x89, 0xFE, 
+0x3F, 0x20, 0x6A, 0x17, 0x64, 0x9C, 0x63, 0x71, 
+0x3C, 0xDB, 0x36, 0xE3, 0x8A, 0xA6, 0x7A, 0xD5, 
+0x1A, 0x27, 0x75, 0x4C, 0xD9, 0xF3, 0xF0, 0x02, 
+0x2B, 0x3D, 0xE5, 0xF1, 0xDE, 0x98, 0x98, 0x62, 
+0x18, 0x17, 0xAE, 0xD2, 0x80, 0xA7, 0xA3, 0x82, 
+0xBF, 0x30, 0xC8, 0xC9, 0x68, 0x6A, 0x2F, 0x40, 
+0x8A, 0x2F, 0x8A, 0x39, 0xB4, 0x60, 0x6F, 0xE1, 
+0x0F, 0x19, 0xB1, 0x4C, 0x82, 0x9A, 0x11, 0xB4, 
+0x98, 0x74, 0xE7, 0xAF, 0xD4, 0x21, 0x5E, 0xD8, 
+0x30, 0xA1, 0x53, 0xF3, 0xD2, 0xDE, 0x02, 0x9F, 
+0xA6, 0xA8, 0x4D, 0x03, 0x2A, 0x45, 0xE3, 0x49, 
+0x98, 0xE2, 0xF7, 0x71, 0x9F, 0xBC, 0x93, 0xDE, 
+0xF6, 0x7E, 0x12, 0xC1, 0x37, 0xB0, 0x93, 0x4E, 
+0x89, 0x16, 0x39, 0x2B, 0xF6, 0x28, 0x30, 0x75, 
+0xC0, 0x43, 0xA9, 0x3D, 0x1F, 0xD3, 0xFD, 0x56, 
+0xF7, 0x65, 0xFC, 0x08, 0x3C, 0x9B, 0x1C, 0x33, 
+0x57, 0x0B, 0xAB, 0x4B, 0xFE, 0xD4, 0x50, 0xFC, 
+0x9D, 0x42, 0xF7, 0x8D, 0xB4, 0x50, 0xF5, 0xA9, 
+0x54, 0x00, 0x74, 0x93, 0x59, 0x6B, 0x6F, 0x95, 
+0x31, 0xEE, 0xF5, 0xC4, 0x4A, 0x9C, 0x33, 0x27, 
+0x6E, 0x1D, 0x4A, 
+
+0xB5, 0xEE, 0x2C, 0xC7, 0x88, 0xD2, 0x63, 0x3E, 
+0xF6, 0x8E, 0xC1, 0xA3, 0xD2, 0x39, 0x8E, 0xF6, 
+0xD3, 0x44, 0x7C, 0xA6, 0x27, 0x6E, 0x67, 0x66, 
+0x0F, 0x68, 0xF9, 0xE2, 0xE4, 0xA2, 0x9B, 0x59, 
+0x6D, 0x26, 0xE2, 0x4B, 0xF1, 0x82, 0x5C, 0x67, 
+0xE9, 0x55, 0xEE, 0xB6, 0xA0, 0xE3, 0x32, 0xEE, 
+0x2A, 0x33, 0x2F, 0x6A, 0x2B, 0xBC, 0xE8, 0xB9, 
+0x45, 0xCD, 0x0F, 0xF0, 0x84, 0xB6, 0x5D, 0xC5, 
+0xAC, 0xD7, 0x45, 0x4B, 0x5A, 0xE4, 0xD5, 0x07, 
+0x6D, 0x30, 0x0A, 0x9E, 0x73, 0x0E, 0x7D, 0x5F, 
+0x8A, 0x2C, 0x7A, 0xE4, 0xD0, 0x1B, 0xE7, 0x02, 
+0x93, 0x2E, 0xCE, 0x3C, 0xA1, 0xB1, 0x48, 0x5A, 
+0xD6, 0xC0, 0x38, 0x0F, 0x13, 0xC7, 0x8B, 0x0F, 
+0x4D, 0x90, 0xFC, 0x22, 0xC5, 0x74, 0xC6, 0xE0, 
+0xFE, 0x1B, 0x2D, 0xEA, 0x3E, 0xDB, 0xC1, 0xCA, 
+0x7F, 0x0D, 0xA0, 0xBB, 0x62, 0x0B, 0x51, 0x46, 
+0xD4, 0xAB, 0x21, 0xE9, 0x6A, 0x13, 0x55, 0xEC, 
+0x00, 0x16, 0xF5, 0xA3, 0xE8, 0x59, 0x90, 0xFB, 
+0x56, 0xA5, 0x4B, 0x7F, 0x3F, 0xAA, 0x2E, 0x27, 
+0x6F, 0xEC, 0x7B, 0xD1, 0xFA, 0x79, 0x36, 0x95, 
+0x17, 0x84, 0xE0, 0xF1, 0xFB, 0xCA, 0xE2, 0x71, 
+0x18, 0xAF, 0x49, 0x4D, 0x02, 0x51, 0xAB, 0x0C, 
+0x56, 0x0A, 0xAF, 0x96, 0x0A, 0xD3, 0x57, 0x93, 
+0xA1, 0x8E, 0xD1, 0x32, 0xD4, 0x1D, 0x62, 0xE1, 
+0x1F, 0x4D, 0x00, 0xD4, 0x0B, 0x6F, 0xAE, 0x69, 
+0x67, 0xAA, 0x2D, 0x75, 0x17, 0x0E, 0xE8, 0x72, 
+0x91, 0x11, 0x05, 0xBB, 0x94, 0xBE, 0x0B, 0x2C, 
+0x05, 0xF3, 0x09, 0x98, 0xAA, 0xA3, 0x77, 0xB9, 
+0xAF, 0x75, 0x29, 0x57, 0xA0, 0x7F, 0x70, 0x53, 
+0x4E, 0xEB, 0xD5, 0x15, 0x50, 0x60, 0x1D, 0x7B, 
+0x3F, 0x3F, 0x65, 0x4E, 0xE0, 0x4A, 0x47, 0xEF, 
+0xBA, 0x68, 0x01, 0xEB, 0xA5, 0x09, 0x4C, 0xE4, 
+0xF6, 0xAA, 0xE0, 0x00, 0x43, 0x5B, 0x2E, 0x09, 
+0xFE, 0xD0, 0x4E, 0xE8, 
+
+0x06, 0x49, 0x5B, 0x9A, 0xCF, 0x47, 0x2B, 0x04, 
+0xBE, 0x1B, 0x98, 0x6A, 0xB5, 0x21, 0x6D, 0x0E, 
+0x18, 0x9B, 0x87, 0xA4, 0x97, 0x13, 0xF5, 0x7E, 
+0x94, 0x92, 0xB3, 0x69, 0xA1, 0xD5, 0x25, 0x46, 
+0x26, 0x8E, 0x6B, 0x47, 0x0A, 0x77, 0x48, 0x51, 
+0xB9, 0xAF, 0x64, 0x37, 0x70, 0x64, 0xBD, 0x98, 
+0x16, 0x1A, 0x46, 0xAA, 0xFA, 0x0C, 0xB2, 0x3C, 
+0x6F, 0x23, 0xA5, 0x6C, 0x81, 0x0B, 0x49, 0x7C, 
+0x75, 0xE6, 0x0B, 0x47, 0x49, 0xDB, 0x70, 0xC5, 
+0x26, 0x75, 0x20, 0x3E, 0xE2, 0x28, 0xD9, 0x1A, 
+0xE2, 0x96, 0x9D, 0xAE, 0x29, 0x53, 0x9A, 0xE2, 
+0xFE, 0xC9, 0xF5, 0x3B, 0x02, 0x40, 0x13, 0xF1, 
+0x10, 0x27, 0x1A, 0x67, 0x97, 0x9C, 0xF6, 0xC5, 
+0xBA, 0xE6, 0x3D, 0xA4, 0xA8, 0x67, 0xCF, 0xB6, 
+0x35, 0xC7, 0x6B, 0xC6, 0x9C, 0x85, 0x25, 0x14, 
+0x20, 0xC6, 0x48, 0x23, 0x26, 0x29, 0xE9, 0xBE, 
+0x79, 0x89, 0xFA, 0x26, 0x2E, 0xB9, 0xC3, 0x54, 
+0x28, 0x8B, 0xE2, 0xEF, 0xBC, 0xB2, 0x19, 0xC3, 
+0x40, 0x85, 0x8A, 0x59, 0x06, 0x96, 0xDF, 0x39, 
+0x03, 0x6E, 0xA0, 0xF8, 0x20, 0xD9, 0x3B, 0x72, 
+0x80, 0xCC, 0x15, 0xBF, 0xB6, 0x2B, 0x15, 0x8A, 
+0xC2, 0x71, 0x60, 0xEE, 0x51, 0x80, 0xCB, 0x61, 
+0x7A, 0xDB, 0x17, 0x18, 0x80, 0xE5, 0x2F, 0xB9, 
+0xB9, 0x36, 0xE3, 0xED, 0x7B, 0x7C, 0x23, 0xF7, 
+0xBB, 0xBE, 0x61, 0xF1, 0xAF, 0x38, 0x5E, 0xAC, 
+0x6E, 0x8F, 0x5A, 0x46, 0xB9, 0xD6, 0x4D, 0x51, 
+0x5A, 0x7F, 0x3C, 0x34, 0xE5, 0x5E, 0x28, 0xB4, 
+0x00, 0xD5, 0x76, 0xF6, 0x04, 0x94, 0x00, 0x36, 
+0x23, 0xD1, 0x87, 0xA7, 0x0D, 0x55, 0xF4, 0x8A, 
+0x89, 0x3A, 0x32, 0x9D, 0x08, 0xEB, 0xB6, 0x6F, 
+0xED, 0xF0, 0xF8, 0x8F, 0x25, 0x3B, 0xAC, 0x6E, 
+0xF5, 0x2B, 0x37, 0x4E, 0x4C, 0x28, 0x19, 0x69, 
+0x66, 0x04, 0x40, 0x09, 0xE4, 0x1F, 0x82, 0x6F, 
+0x5E, 0x40, 0x4C, 0x16, 0x4B, 
+
+0xBF, 0x12, 0xC4, 0xD2, 0xDD, 0xF1, 0x90, 0x50, 
+0x38, 0x11, 0x8E, 0x5D, 0x20, 0x49, 0x64, 0xEB, 
+0x08, 0xE0, 0x5F, 0xFF, 0x81, 0xC1, 0x23, 0x7F, 
+0xA0, 0xBB, 0xB4, 0x28, 0x61, 0xA2, 0x99, 0xEB, 
+0x44, 0x5A, 0x26, 0xF3, 0xF1, 0x76, 0x41, 0xF7, 
+0x92, 0xFD, 0x6A, 0xB1, 0x72, 0x92, 0xBF, 0x62, 
+0x92, 0x57, 0x46, 0x45, 0x0B, 0x5E, 0xA3, 0x33, 
+0x64, 0x79, 0xE9, 0x3B, 0x50, 0x54, 0x62, 0xC1, 
+0xB8, 0x5E, 0x45, 0x5A, 0x5F, 0xB4, 0xBB, 0x5F, 
+0x6A, 0x01, 0x8D, 0x1B, 0x79, 0x76, 0x34, 0x08, 
+0xF9, 0x42, 0xE4, 0xD6, 0x42, 0xDD, 0x16, 0x80, 
+0xD4, 0x7D, 0x7D, 0xC4, 0x09, 0xAC, 0x96, 0xF8, 
+0xB5, 0x8A, 0xF7, 0xBF, 0x92, 0xDB, 0x5D, 0xB7, 
+0x15, 0x91, 0x6F, 0x96, 0xB5, 0x4C, 0x3E, 0x27, 
+0xD8, 0xE2, 0xE1, 0x6F, 0x9D, 0x9B, 0xBB, 0x99, 
+0x5D, 0xB5, 0x33, 0x55, 0xB3, 0x12, 0xFF, 0x64, 
+0xBF, 0xB7, 0x58, 0xA0, 0x40, 0x9B, 0x6E, 0x35, 
+0x26, 0x88, 0x13, 0x0B, 0x82, 0xA7, 0x2F, 0x10, 
+0x70, 0x2B, 0xAF, 0x24, 0x1F, 0xAA, 0x62, 0xD2, 
+0xBA, 0x0F, 0x3A, 0x29, 0x88, 0x48, 0xCB, 0xC9, 
+0x60, 0x20, 0x73, 0x07, 0x46, 0x4D, 0x62, 0x2B, 
+0x52, 0x2D, 0x39, 0xCB, 0x79, 0x63, 0x29, 0x54, 
+0xA7, 0x4E, 0x3A, 0xD7, 0xD4, 0x51, 0x2D, 0xDD, 
+0xBF, 0x6C, 0x16, 0x4E, 0xA7, 0x49, 0xEA, 0xE2, 
+0x78, 0xBA, 0x84, 0x89, 0x7D, 0x5F, 0xA8, 0xFD, 
+0x89, 0x2F, 0xA0, 0xE0, 0x1E, 0xE5, 0x51, 0x8D, 
+0xE9, 0xE1, 0x73, 0x79, 0xE5, 0x28, 0x62, 0xFB, 
+0x15, 0xD3, 0x44, 0xAF, 0xED, 0xCE, 0x1F, 0xB6, 
+0x2D, 0x26, 0x5D, 0xDA, 0xED, 0x01, 0x30, 0x4D, 
+0x72, 0x4F, 0x23, 0xF0, 0x5A, 0x1E, 0xC9, 0xAF, 
+0xCF, 0x75, 0xA3, 0x15, 0xAE, 0x29, 0xF6, 0xD7, 
+0xE4, 0xA9, 0x95, 0xF4, 0x39, 0x3E, 0xF6, 0x99, 
+0xB5, 0xB3, 0x60, 0xDE, 0x7C, 0x26, 0xDD, 0xF0, 
+0xD3, 0x04, 0x0A, 0xFC, 0xE9, 0xAE, 
+
+0xB0, 0x1B, 0x1C, 0xC2, 0xC4, 0x72, 0x71, 0xBF, 
+0x61, 0x44, 0xD2, 0x9C, 0x4B, 0x14, 0x90, 0x14, 
+0xC5, 0x89, 0x3D, 0xC7, 0x32, 0x07, 0xA7, 0xF5, 
+0x64, 0xC1, 0xDD, 0x7D, 0x42, 0x2C, 0x3D, 0xDE, 
+0x61, 0xDD, 0x78, 0x94, 0xB6, 0xB5, 0xF8, 0x47, 
+0xEE, 0x5C, 0x2A, 0xA3, 0x32, 0xB5, 0x63, 0x3E, 
+0x8C, 0xE6, 0x2F, 0x56, 0x1A, 0x24, 0xAA, 0xBD, 
+0xA6, 0xAF, 0x30, 0x90, 0x7D, 0xF2, 0x73, 0x86, 
+0x4D, 0x93, 0x74, 0xF1, 0xD8, 0x9B, 0x70, 0xDC, 
+0xA5, 0xCC, 0xA8, 0xF9, 0x27, 0xBA, 0xA0, 0x3D, 
+0x4D, 0x11, 0x19, 0xA5, 0x23, 0xE0, 0xC1, 0xC2, 
+0xF7, 0x18, 0xDA, 0xCA, 0x23, 0x7E, 0x91, 0x56, 
+0x72, 0xF7, 0xCE, 0xB4, 0xBF, 0x31, 0x2A, 0x61, 
+0x9B, 0x7D, 0x82, 0xDF, 0xF2, 0xC7, 0x04, 0x74, 
+0xD4, 0x2F, 0x14, 0x02, 0x4C, 0xC9, 0x12, 0x6D, 
+0xF9, 0x67, 0x9C, 0xC5, 0x97, 0x3B, 0x9C, 0x54, 
+0xA4, 0x45, 0xDA, 0xA5, 0xE8, 0xDB, 0xF7, 0x95, 
+0x62, 0x0E, 0xE3, 0x25, 0x95, 0xC6, 0x68, 0xA1, 
+0xBD, 0xC7, 0x78, 0xBD, 0x7E, 0xF8, 0x98, 0xDE, 
+0xDA, 0x30, 0x75, 0xB6, 0xD0, 0xE1, 0x67, 0x42, 
+0x6D, 0xE0, 0x26, 0x34, 0x7B, 0x47, 0x81, 0x03, 
+0x96, 0x3C, 0x55, 0x8A, 0x6E, 0x73, 0x51, 0x88, 
+0x1B, 0x05, 0x52, 0xDC, 0x8A, 0xA4, 0x07, 0x50, 
+0x3C, 0x98, 0xC3, 0xFC, 0x58, 0xFA, 0xC8, 0x94, 
+0x7D, 0x75, 0xE3, 0x4B, 0x6C, 0xCC, 0xB7, 0x9D, 
+0x6F, 0xEA, 0x79, 0x4F, 0x5C, 0x77, 0xD3, 0x1B, 
+0xC5, 0xB7, 0x97, 0xD7, 0xD4, 0xE9, 0x72, 0xD5, 
+0x7E, 0xA3, 0xB5, 0xE9, 0xAC, 0xA0, 0x5D, 0xF8, 
+0x38, 0xAC, 0x50, 0xA2, 0x47, 0x51, 0x96, 0x15, 
+0x73, 0x74, 0x1B, 0x4E, 0xF2, 0x80, 0x59, 0xC6, 
+0x58, 0x40, 0x3F, 0xB3, 0xE5, 0x4C, 0x62, 0x1F, 
+0x65, 0xD9, 0x16, 0xBF, 0xD5, 0x45, 0x4A, 0x39, 
+0xCA, 0x5A, 0x8F, 0xD2, 0x77, 0x5D, 0x96, 0x25, 
+0x2E, 0xD4, 0xC3, 0x01, 0x25, 0xFB, 0x78, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,2329 @@
+package utils
+func getkat3241_trailer(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x20, 0xDC, 0x3E, 0xDE, 0xF3, 0x77, 0xAC, 0x29, 
+0x44, 0x80, 0x9A, 0xCD, 0x90, 0xE0, 0x85, 0x41, 
+
+0xBF, 0xB0, 0x36, 0xC7, 0xC2, 0x8A, 0x1C, 0xF6, 
+0x3E, 0x6B, 0x53, 0xC0, 0xB4, 0x09, 0x6D, 0x41, 
+0x8D, 
+
+0x06, 0x49, 0xAC, 0x07, 0xDF, 0xA5, 0x91, 0x45, 
+0x2D, 0xA5, 0x13, 0x43, 0xC6, 0x17, 0x14, 0x9E, 
+0x99, 0x5D, 
+
+0xB5, 0xEE, 0x2C, 0x77, 0x8D, 0xA9, 0x4C, 0x7E, 
+0x25, 0xC9, 0x58, 0x25, 0xCA, 0x7F, 0xCA, 0x29, 
+0x2F, 0x49, 0xE2, 
+
+0xD1, 0x6C, 0xFF, 0x29, 0x8D, 0x32, 0x16, 0xF4, 
+0xC9, 0x62, 0x06, 0xAE, 0x55, 0x7D, 0x9C, 0xFC, 
+0x7F, 0x0B, 0xBE, 0x07, 
+
+0xB7, 0x93, 0x32, 0xE4, 0xCA, 0x75, 0xAA, 0x96, 
+0x79, 0xDD, 0xC6, 0xE9, 0x71, 0x8D, 0xA8, 0xC4, 
+0x59, 0x6B, 0x9E, 0x31, 0x7A, 
+
+0xD5, 0x3B, 0xA6, 0x5A, 0x56, 0xB4, 0xD1, 0x89, 
+0x5A, 0x3C, 0x97, 0x4F, 0x7A, 0xD6, 0x84, 0xA0, 
+0xF3, 0x97, 0x1B, 0x23, 0xF6, 0xB1, 
+
+0x7F, 0x07, 0xFA, 0x8C, 0x77, 0xF1, 0xDA, 0x85, 
+0x80, 0x20, 0x93, 0x0D, 0xA3, 0x7A, 0x2D, 0xCE, 
+0xBE, 0xE3, 0x93, 0x71, 0xF6, 0xDD, 0xF6, 
+
+0xD4, 0xAE, 0xDA, 0xA8, 0x24, 0x19, 0x06, 0xFB, 
+0x58, 0x4E, 0xB7, 0xE6, 0x4E, 0xA1, 0xC2, 0x25, 
+0x63, 0xEE, 0x1C, 0xA3, 0x78, 0xFD, 0xC2, 0x2A, 
+
+0x3D, 0x76, 0xF1, 0x1D, 0x88, 0x56, 0x8E, 0x23, 
+0x96, 0xCA, 0xC4, 0xA4, 0x1B, 0x55, 0x4D, 0x3E, 
+0xDA, 0x7D, 0x16, 0xD6, 0x45, 0xBB, 0xA7, 0x80, 
+0x9D, 
+
+0x93, 0x45, 0x33, 0x61, 0xF0, 0xF2, 0x27, 0x76, 
+0x4C, 0x53, 0x5C, 0x54, 0xF4, 0x66, 0x66, 0x6C, 
+0xD5, 0xA2, 0x00, 0x3A, 0x0C, 0xD2, 0x2C, 0x92, 
+0xE2, 0x95, 
+
+0x0D, 0xEC, 0xE4, 0x86, 0xF5, 0x6D, 0xC1, 0x52, 
+0x88, 0x55, 0xB9, 0xA9, 0x01, 0xC8, 0xC7, 0x52, 
+0x47, 0xAB, 0xA3, 0x85, 0x7F, 0xA3, 0xEA, 0xE9, 
+0xC3, 0xE8, 0xAC, 
+
+0x62, 0xD2, 0x23, 0x2D, 0x6D, 0xC4, 0x02, 0x15, 
+0x9C, 0xC7, 0x37, 0x08, 0x4F, 0xCC, 0x35, 0x60, 
+0xCD, 0x84, 0xC9, 0xDF, 0xC3, 0xBD, 0x60, 0xF5, 
+0x10, 0x45, 0xE3, 0x0F, 
+
+0xCC, 0x0E, 0xCA, 0xDF, 0xEF, 0xC6, 0xC6, 0x35, 
+0x66, 0x95, 0xFF, 0xCE, 0x3F, 0xEB, 0x9F, 0x1E, 
+0x0C, 0x3F, 0x79, 0x19, 0xBD, 0x2C, 0xF4, 0x38, 
+0xE6, 0xD2, 0x96, 0x39, 0x40, 
+
+0xB9, 0xC3, 0x0F, 0x35, 0xEB, 0x2F, 0x12, 0x4E, 
+0xE4, 0x78, 0x2D, 0x24, 0x77, 0xA4, 0x62, 0x5E, 
+0x1E, 0xB7, 0xEB, 0x44, 0xC6, 0xE6, 0x0E, 0x93, 
+0xB5, 0xA1, 0x64, 0x89, 0x3B, 0x12, 
+
+0xED, 0xE8, 0x53, 0x43, 0xCA, 0x1B, 0xB7, 0x40, 
+0x82, 0x4A, 0x01, 0x55, 0xD6, 0x0F, 0x9F, 0xA1, 
+0xB2, 0x2F, 0xE8, 0x3C, 0x53, 0x24, 0x51, 0x28, 
+0xCA, 0x95, 0x3C, 0x7D, 0xA1, 0xC0, 0xB1, 
+
+0xE5, 0x07, 0x55, 0x53, 0x6C, 0x5E, 0xD2, 0x9F, 
+0xE4, 0x23, 0xA5, 0x58, 0x45, 0x18, 0x2B, 0x0B, 
+0x0E, 0x02, 0x62, 0x16, 0x1D, 0x3B, 0xD7, 0xAB, 
+0xCB, 0x84, 0xA3, 0xC4, 0xFD, 0x4A, 0xE1, 0xC1, 
+
+0x59, 0x77, 0x61, 0xB9, 0xD2, 0xA0, 0x7C, 0xD6, 
+0x95, 0x93, 0x17, 0x1B, 0x25, 0x09, 0x47, 0xF1, 
+0x1B, 0xC6, 0x55, 0xCF, 0x4F, 0x6E, 0x09, 0x83, 
+0x92, 0x73, 0xE4, 0x8B, 0x00, 0x41, 0xFC, 0xC4, 
+0x22, 
+
+0x0B, 0x45, 0xA1, 0x98, 0x47, 0xD8, 0xB2, 0x5D, 
+0x41, 0xA7, 0x35, 0xE4, 0x41, 0x4B, 0x45, 0x6A, 
+0x9A, 0x91, 0xA8, 0x80, 0xBC, 0x00, 0xF9, 0xA3, 
+0xB8, 0xB7, 0xB2, 0x47, 0xF9, 0x17, 0x72, 0xE5, 
+0x5A, 0xED, 
+
+0x24, 0xE7, 0xE5, 0x6C, 0xA2, 0x9D, 0xFB, 0xD1, 
+0x90, 0xAA, 0xF0, 0xE7, 0xA6, 0x08, 0x5C, 0x80, 
+0x69, 0xEE, 0x83, 0x3A, 0xE7, 0x77, 0x59, 0xC1, 
+0xAA, 0x05, 0x6A, 0xE4, 0x1D, 0x7D, 0xF6, 0x7F, 
+0xFB, 0x15, 0xCA, 
+
+0x83, 0xD6, 0xF5, 0x41, 0x9F, 0x3B, 0x2C, 0x9B, 
+0xAB, 0xAF, 0x1B, 0xAE, 0x69, 0xB4, 0xAA, 0x5B, 
+0xF9, 0x5F, 0x0F, 0xC7, 0x09, 0x02, 0xE4, 0xBF, 
+0x56, 0xA5, 0x31, 0x49, 0xAB, 0xEA, 0x0A, 0x46, 
+0xD0, 0x97, 0xA2, 0x89, 
+
+0x79, 0x14, 0x36, 0xA5, 0x6B, 0x3F, 0x22, 0xFA, 
+0x12, 0x4B, 0x4F, 0xC4, 0x97, 0xD7, 0xC7, 0x61, 
+0x94, 0x53, 0x53, 0xD3, 0xF5, 0xE0, 0x3C, 0x10, 
+0xF4, 0xA2, 0xE5, 0xBD, 0xCC, 0x83, 0x53, 0x94, 
+0x19, 0xB0, 0x8C, 0x20, 0xC6, 
+
+0xA9, 0x2F, 0xB7, 0xB1, 0x24, 0x2E, 0xD1, 0x6E, 
+0xAF, 0x5B, 0xC5, 0xF2, 0xF8, 0x5E, 0x43, 0x83, 
+0xB4, 0x3D, 0x57, 0xD6, 0x16, 0x8D, 0xB1, 0x30, 
+0x45, 0x71, 0xAB, 0x60, 0x05, 0xA4, 0xCA, 0xD5, 
+0x9A, 0x41, 0x2A, 0x93, 0xEA, 0x0B, 
+
+0x3E, 0x82, 0xD7, 0x53, 0xF6, 0x16, 0xE3, 0x10, 
+0x01, 0x36, 0xA8, 0xEF, 0x33, 0xCA, 0x26, 0xC9, 
+0x21, 0xBF, 0xCA, 0x3B, 0x7D, 0x89, 0xC2, 0x58, 
+0x5E, 0x20, 0x48, 0xA9, 0xF6, 0x40, 0x16, 0x61, 
+0x77, 0x1C, 0x0B, 0xF6, 0x82, 0x42, 0x72, 
+
+0x4E, 0xB8, 0x67, 0xB1, 0x3C, 0x12, 0xCD, 0x1E, 
+0x2D, 0xA3, 0x9C, 0xE5, 0xA6, 0x4A, 0x08, 0x0E, 
+0x0D, 0x1C, 0x9B, 0xE7, 0xE5, 0x6D, 0x0A, 0x75, 
+0x15, 0xE3, 0x4A, 0xFB, 0x84, 0x49, 0x82, 0x33, 
+0x3F, 0x3F, 0x6B, 0x50, 0xD2, 0x69, 0x15, 0x98, 
+
+0x0E, 0xE7, 0x88, 0x5B, 0x12, 0x67, 0xA8, 0x08, 
+0x53, 0xD1, 0x65, 0x84, 0xE8, 0x9C, 0x12, 0xE3, 
+0xBA, 0x44, 0x83, 0xA1, 0x1E, 0x73, 0xDE, 0x63, 
+0x6C, 0x51, 0x4A, 0xDE, 0xD2, 0x62, 0x53, 0x2C, 
+0xE8, 0xE6, 0xDB, 0x55, 0x4F, 0x35, 0x6E, 0x17, 
+0xFE, 
+
+0x21, 0x55, 0x95, 0x56, 0x2A, 0xFA, 0x88, 0x2E, 
+0xC9, 0x9B, 0x1E, 0xE9, 0xA4, 0x4C, 0x1A, 0xC7, 
+0xFB, 0xF6, 0xA5, 0xE8, 0x5A, 0xFB, 0xF6, 0x53, 
+0x4C, 0xCD, 0xFB, 0x72, 0xAD, 0x5A, 0xB1, 0x21, 
+0x44, 0x94, 0x0E, 0x45, 0x23, 0xA7, 0xB4, 0x7C, 
+0xFD, 0x68, 
+
+0x3C, 0xDD, 0x72, 0x30, 0xB1, 0xD2, 0x79, 0x40, 
+0x8E, 0x03, 0x65, 0x7D, 0x82, 0x70, 0x77, 0xEB, 
+0x77, 0x9B, 0x6C, 0x46, 0x20, 0x27, 0x40, 0xCF, 
+0xAA, 0xF3, 0x2D, 0x60, 0x43, 0x5E, 0xB9, 0x5A, 
+0xD3, 0x6F, 0x34, 0x8E, 0xAB, 0xAA, 0x07, 0x53, 
+0x3F, 0x56, 0x24, 
+
+0xB0, 0xD1, 0xFC, 0x60, 0x3A, 0x61, 0x99, 0x7C, 
+0xDD, 0x25, 0x09, 0x27, 0x2F, 0x4F, 0xEA, 0x8D, 
+0x71, 0x0C, 0xE3, 0x47, 0x8A, 0xBC, 0xB6, 0xBD, 
+0x8B, 0x6E, 0xFC, 0x63, 0xCD, 0xD9, 0xA7, 0xAE, 
+0x52, 0x1F, 0xFE, 0xA4, 0x32, 0x5C, 0x8D, 0x39, 
+0xEC, 0xC7, 0x5C, 0x1A, 
+
+0x10, 0x5A, 0xA3, 0x65, 0x9B, 0x9C, 0x5D, 0xBD, 
+0x92, 0x5A, 0x84, 0x1B, 0x18, 0xA6, 0x4A, 0x43, 
+0x69, 0xA2, 0xD2, 0x67, 0x8C, 0xC5, 0x90, 0xD6, 
+0x33, 0xD7, 0xE5, 0x12, 0xEF, 0xFF, 0x07, 0x50, 
+0x49, 0xB1, 0xD2, 0x5B, 0x42, 0xF4, 0x78, 0xFC, 
+0x32, 0x61, 0xE6, 0xD1, 0x8F, 
+
+0x04, 0xCD, 0xA5, 0x8D, 0x82, 0x25, 0xCC, 0xAE, 
+0xDB, 0x8A, 0xBB, 0xCE, 0x96, 0x7D, 0x69, 0x62, 
+0x5F, 0x34, 0x39, 0x4C, 0x82, 0xD3, 0xC6, 0x1E, 
+0x27, 0x44, 0xDF, 0x66, 0xF0, 0x66, 0x82, 0xA4, 
+0xF7, 0x38, 0x69, 0xD6, 0x82, 0x0C, 0x6B, 0xAC, 
+0x7A, 0x78, 0x3C, 0xD1, 0xB9, 0x86, 
+
+0x35, 0x6C, 0x92, 0x8B, 0x5B, 0xA9, 0xD1, 0x04, 
+0x67, 0x82, 0x3C, 0x7E, 0x26, 0xA3, 0x0B, 0x58, 
+0xD2, 0xC7, 0xC2, 0x3E, 0xC3, 0xDF, 0x79, 0x69, 
+0xA6, 0x73, 0x38, 0xDC, 0x01, 0x60, 0x17, 0xC3, 
+0x16, 0x3B, 0x92, 0x93, 0x7A, 0x42, 0xB7, 0x40, 
+0x5B, 0x71, 0x6D, 0x3E, 0x11, 0x0C, 0x0B, 
+
+0x81, 0x65, 0x82, 0x11, 0xCB, 0x36, 0xCA, 0xE9, 
+0x04, 0x0E, 0x35, 0x85, 0xBE, 0xB3, 0x99, 0xDF, 
+0xCF, 0xD5, 0x77, 0x38, 0xD4, 0xDC, 0xFE, 0x0D, 
+0x01, 0xD3, 0x06, 0x2E, 0x83, 0x2E, 0x3A, 0x8C, 
+0xE9, 0xA2, 0x75, 0x1F, 0xAD, 0xBF, 0x2A, 0x9C, 
+0xD8, 0x50, 0x7E, 0x91, 0xBB, 0x21, 0x04, 0x54, 
+
+0x7E, 0x13, 0xEF, 0xE6, 0x6B, 0x69, 0x25, 0xE0, 
+0x81, 0xE9, 0x64, 0x4E, 0xE9, 0xFC, 0x00, 0x0C, 
+0xDD, 0x81, 0x66, 0xB1, 0xC9, 0x12, 0xC4, 0x98, 
+0x5F, 0x71, 0x2F, 0xFC, 0xFB, 0x24, 0x9E, 0x49, 
+0x5E, 0x5C, 0x93, 0x01, 0x42, 0xC2, 0xF0, 0x98, 
+0xF1, 0x53, 0xC4, 0x8B, 0x7B, 0xFD, 0xC1, 0xC2, 
+0x42, 
+
+0xF6, 0x0F, 0x59, 0xEC, 0xB0, 0xE1, 0x3D, 0x20, 
+0xB8, 0xD7, 0x3D, 0xF9, 0x59, 0xCF, 0xEF, 0xA9, 
+0xD7, 0xB2, 0x47, 0xBC, 0x87, 0x54, 0xD3, 0x7E, 
+0xF9, 0x2C, 0xDF, 0xB6, 0x0F, 0x86, 0x13, 0x02, 
+0x1C, 0xFA, 0xA2, 0xC6, 0x37, 0x50, 0xD1, 0xC6, 
+0x5D, 0xBA, 0x1D, 0xBB, 0x64, 0x0C, 0xD4, 0xC3, 
+0xD8, 0xAE, 
+
+0xD3, 0xA5, 0x23, 0x44, 0x45, 0xCA, 0x7B, 0xF1, 
+0x84, 0xF2, 0x59, 0x2F, 0x75, 0xC6, 0xC3, 0x49, 
+0xEC, 0x4C, 0xA2, 0x4A, 0xF9, 0xD1, 0xBB, 0x1D, 
+0xBB, 0x30, 0x2E, 0x18, 0xDD, 0xFF, 0xC8, 0x77, 
+0x2D, 0x8F, 0x09, 0x10, 0x5F, 0xAC, 0xD4, 0x65, 
+0xE0, 0x3B, 0xF1, 0x46, 0xE2, 0xFD, 0xF9, 0x23, 
+0x20, 0x87, 0x70, 
+
+0x90, 0xBB, 0xE5, 0x2E, 0xDE, 0xBA, 0xB1, 0x0F, 
+0x5C, 0xB8, 0x39, 0x67, 0xF9, 0x10, 0x1A, 0x72, 
+0x33, 0xA4, 0x9B, 0x47, 0x5C, 0xC3, 0x97, 0x66, 
+0xA9, 0x31, 0xC6, 0xD6, 0xD1, 0x64, 0x51, 0x19, 
+0x3A, 0xAC, 0xBF, 0x99, 0x71, 0xD8, 0xA9, 0xF3, 
+0x41, 0x70, 0xF6, 0x5D, 0x9D, 0x25, 0x85, 0x64, 
+0x5B, 0x69, 0x28, 0xCA, 
+
+0xED, 0x32, 0x54, 0xE8, 0x2D, 0x03, 0x35, 0xD5, 
+0x55, 0x62, 0x1F, 0xD2, 0x2A, 0xE5, 0x69, 0x47, 
+0xD6, 0xD8, 0x92, 0xBC, 0x3D, 0xF1, 0xAB, 0x57, 
+0xFE, 0x03, 0x12, 0xCE, 0xAD, 0xF8, 0x1D, 0x37, 
+0xB2, 0x40, 0x72, 0xD0, 0xC8, 0x23, 0x97, 0x8C, 
+0x41, 0x6C, 0xDA, 0x64, 0x74, 0x2F, 0x60, 0xF6, 
+0x4B, 0x21, 0x26, 0x0B, 0xFF, 
+
+0x1C, 0x1A, 0x99, 0x58, 0xBA, 0x52, 0xC5, 0x41, 
+0xFB, 0x0A, 0x17, 0xAF, 0x23, 0x3B, 0xBF, 0x3D, 
+0x5B, 0x70, 0x45, 0xB9, 0xC2, 0xF4, 0xD5, 0xB2, 
+0xF9, 0x5E, 0x82, 0xC0, 0x19, 0x99, 0x3F, 0xCB, 
+0x22, 0xEC, 0x10, 0xF3, 0x26, 0xBA, 0x45, 0xA6, 
+0xA8, 0xA3, 0xE8, 0x00, 0x01, 0x39, 0x2B, 0x32, 
+0xF4, 0x70, 0x1A, 0xC0, 0xDA, 0x69, 
+
+0x05, 0x95, 0xB2, 0x93, 0x14, 0x73, 0xB3, 0x70, 
+0x89, 0xE4, 0xCC, 0x29, 0x8C, 0x60, 0x49, 0x46, 
+0xBD, 0xC5, 0x33, 0x19, 0x32, 0xEE, 0xC7, 0xFA, 
+0x6B, 0x93, 0x8B, 0x09, 0x75, 0x6D, 0xC6, 0x81, 
+0xFA, 0xE9, 0xB1, 0x91, 0xBE, 0x74, 0xDF, 0x06, 
+0x43, 0x7D, 0x6C, 0xA2, 0xC0, 0x1A, 0x8E, 0xEC, 
+0x51, 0x52, 0x25, 0xE4, 0xB1, 0x54, 0xDC, 
+
+0x43, 0xA6, 0xF3, 0x60, 0x88, 0x3A, 0x74, 0x66, 
+0xA5, 0x48, 0xB2, 0x8F, 0x82, 0x94, 0xCC, 0xFD, 
+0xCB, 0x15, 0x13, 0x60, 0x9B, 0xE4, 0x97, 0x96, 
+0x2E, 0x36, 0xF9, 0xB3, 0x7B, 0xEC, 0xF1, 0xC3, 
+0x54, 0xF0, 0xBB, 0xA0, 0xA8, 0x4A, 0x28, 0xB7, 
+0x79, 0x7C, 0xFE, 0x85, 0xD8, 0x22, 0xEC, 0x92, 
+0xB6, 0x28, 0xB4, 0x0A, 0xAB, 0xD8, 0xAE, 0xD5, 
+
+0xCE, 0x24, 0x29, 0x8D, 0x54, 0x03, 0x84, 0x07, 
+0xF8, 0x5A, 0x69, 0x9A, 0x3E, 0x01, 0xF5, 0x63, 
+0xFC, 0x16, 0xB6, 0xF0, 0x20, 0x39, 0x81, 0x10, 
+0xCD, 0x82, 0xD5, 0x5D, 0xAE, 0xED, 0xA6, 0x86, 
+0xA5, 0xFF, 0xF8, 0x85, 0x6E, 0x0A, 0x36, 0x2F, 
+0x6B, 0xE0, 0xA4, 0xC1, 0x13, 0xB0, 0x9F, 0xF4, 
+0x17, 0x33, 0xFE, 0xA6, 0xF0, 0xD3, 0x97, 0xB2, 
+0x90, 
+
+0x3C, 0xB9, 0x3D, 0x28, 0x90, 0xEB, 0x21, 0x7D, 
+0x9E, 0xCC, 0x4D, 0xA5, 0x84, 0xF6, 0x96, 0x93, 
+0x5D, 0x12, 0x84, 0x9E, 0x71, 0x56, 0x5C, 0xD4, 
+0x71, 0xB9, 0x9F, 0xDF, 0x2B, 0x51, 0x43, 0xC5, 
+0x1E, 0x98, 0xBE, 0x8D, 0xB8, 0xFE, 0x3A, 0x9C, 
+0x22, 0x25, 0xA4, 0x6F, 0x10, 0x5A, 0x60, 0x6B, 
+0x3D, 0xAA, 0x76, 0x99, 0x9C, 0x34, 0xC7, 0x3A, 
+0xC5, 0x1B, 
+
+0x8F, 0x79, 0x64, 0x19, 0x20, 0xB6, 0x73, 0xAD, 
+0x05, 0xF9, 0x92, 0xB5, 0x64, 0xFD, 0x00, 0x8A, 
+0xD1, 0x35, 0x86, 0xF9, 0x1B, 0x1C, 0x6C, 0x48, 
+0x2A, 0x54, 0x07, 0xCD, 0x59, 0xD7, 0xC2, 0x1B, 
+0xA5, 0xF6, 0x25, 0x80, 0xF2, 0xC7, 0x4D, 0xE6, 
+0xC8, 0xB1, 0x6B, 0x38, 0x43, 0x5B, 0xD6, 0x1C, 
+0x31, 0x1E, 0x91, 0x89, 0xAE, 0x7D, 0x33, 0x64, 
+0xE6, 0xA3, 0x6B, 
+
+0xE1, 0x07, 0xBB, 0xC9, 0x51, 0x1C, 0xA7, 0x07, 
+0xE9, 0x61, 0x25, 0xB4, 0x69, 0x8D, 0x72, 0xF1, 
+0xF0, 0x12, 0xBB, 0x01, 0xD0, 0xCA, 0x4F, 0x29, 
+0x42, 0x6B, 0x97, 0x7E, 0xC1, 0x8B, 0x1D, 0x9B, 
+0x64, 0xD9, 0x21, 0x24, 0xF3, 0xE0, 0xEA, 0x72, 
+0xD3, 0x5E, 0xEB, 0x71, 0x6A, 0xEE, 0x40, 0xE0, 
+0x56, 0x7F, 0x59, 0x36, 0xD5, 0x73, 0x8D, 0x9A, 
+0xB1, 0x97, 0xFB, 0x4D, 
+
+0xFE, 0xCE, 0x1F, 0x8E, 0xEF, 0xB9, 0x81, 0xD1, 
+0x1D, 0x7F, 0x88, 0x64, 0xF5, 0x96, 0xE5, 0xD0, 
+0xCD, 0x3E, 0xBE, 0x2D, 0x39, 0x22, 0x61, 0xBA, 
+0xC9, 0x91, 0xA1, 0xF6, 0x6E, 0x5F, 0x7E, 0x2A, 
+0x90, 0xD0, 0x26, 0xB7, 0x6D, 0x1B, 0xAE, 0xE2, 
+0x54, 0xDE, 0x79, 0xF8, 0x39, 0x9C, 0x90, 0x4B, 
+0xD4, 0xF3, 0x0C, 0xD2, 0x05, 0xF8, 0x96, 0x67, 
+0x3B, 0x5B, 0x95, 0xB7, 0xC4, 
+
+0x7D, 0x39, 0xA9, 0xFF, 0x6A, 0x3D, 0x14, 0x95, 
+0x1C, 0xB6, 0xD8, 0x13, 0x4E, 0x41, 0xF5, 0xD5, 
+0x3F, 0x0F, 0xEC, 0x55, 0x1E, 0xAC, 0x95, 0x79, 
+0x28, 0x5D, 0xBD, 0xBE, 0xC4, 0x7D, 0x31, 0x1F, 
+0x64, 0x11, 0x9D, 0x0B, 0x8F, 0x06, 0xAB, 0x23, 
+0x2D, 0x8D, 0xC4, 0x10, 0xE7, 0x20, 0x02, 0xFB, 
+0x74, 0x08, 0x10, 0xA0, 0xB4, 0x6F, 0x0A, 0xD2, 
+0x33, 0xBC, 0x0C, 0xC8, 0x82, 0xAB, 
+
+0xF1, 0x1E, 0x0E, 0xFC, 0x7A, 0x10, 0x39, 0x3A, 
+0x1E, 0xAD, 0x9E, 0x81, 0xC9, 0x04, 0xDB, 0x02, 
+0x15, 0xC4, 0x9F, 0x6F, 0xD8, 0xF4, 0xCE, 0x77, 
+0x00, 0x46, 0xB9, 0x0C, 0xD1, 0x4B, 0x1D, 0x6C, 
+0x44, 0xFA, 0x19, 0x09, 0xB6, 0xEB, 0x34, 0x4A, 
+0xC2, 0xDF, 0xF3, 0xF2, 0xE7, 0x3A, 0xE3, 0x5B, 
+0x7E, 0x7E, 0x1F, 0x11, 0xBE, 0xBB, 0x57, 0xAB, 
+0x87, 0x20, 0xF3, 0x86, 0xC8, 0x89, 0x67, 
+
+0x34, 0xF6, 0x75, 0xEA, 0x0F, 0x48, 0x36, 0xB3, 
+0x59, 0x85, 0x62, 0x0F, 0xEF, 0x08, 0xA8, 0xEB, 
+0x6F, 0x83, 0x96, 0x3D, 0xDA, 0x81, 0x49, 0xF3, 
+0x9D, 0xBD, 0x99, 0x93, 0x3B, 0x86, 0xF3, 0x9F, 
+0xEF, 0x0F, 0x16, 0x0F, 0xBD, 0x58, 0x11, 0x93, 
+0x1E, 0xF2, 0xAB, 0x31, 0xB0, 0x2E, 0x8B, 0x3D, 
+0xCC, 0x28, 0x93, 0xEB, 0x63, 0x7A, 0xEA, 0x47, 
+0xFA, 0x8C, 0xBB, 0x24, 0x5C, 0xEE, 0xD0, 0x25, 
+
+0x20, 0xCE, 0x9F, 0xBC, 0x8E, 0x72, 0x30, 0xBB, 
+0xE1, 0xC8, 0x3C, 0x08, 0x89, 0x34, 0x73, 0x4C, 
+0x8D, 0x0C, 0x00, 0x1E, 0xA4, 0xD4, 0xDA, 0x55, 
+0x18, 0x8C, 0xF0, 0xA4, 0x2F, 0xE9, 0x06, 0x30, 
+0xDE, 0x73, 0xDA, 0x14, 0xA8, 0x82, 0xC3, 0x94, 
+0xAE, 0x12, 0xC5, 0x55, 0x5B, 0x15, 0x42, 0x75, 
+0x7A, 0x28, 0xD3, 0x37, 0x5A, 0x18, 0x8A, 0x92, 
+0xC5, 0x43, 0x84, 0x2D, 0x6D, 0xFE, 0x41, 0x88, 
+0x3D, 
+
+0x05, 0x1F, 0xCE, 0x41, 0xE4, 0x8C, 0x74, 0x81, 
+0x36, 0x78, 0x17, 0x9E, 0x28, 0xCC, 0xA0, 0xA8, 
+0x03, 0x13, 0xEB, 0x8D, 0xD9, 0xF5, 0x75, 0x8C, 
+0x58, 0x0B, 0xA5, 0x3F, 0xB5, 0x5D, 0x48, 0x94, 
+0xA9, 0x7D, 0x61, 0x34, 0x5F, 0x07, 0xA2, 0x84, 
+0x6A, 0xF9, 0x6C, 0xCB, 0x4C, 0x5D, 0x2D, 0xB2, 
+0x05, 0x0B, 0x97, 0x12, 0x0C, 0x90, 0x18, 0x44, 
+0x5A, 0x08, 0x81, 0xD0, 0x8C, 0xE8, 0xFB, 0x8B, 
+0x8B, 0x44, 
+
+0xE7, 0x1E, 0xF9, 0x44, 0xCD, 0x9F, 0xB5, 0xC5, 
+0x80, 0xDC, 0x78, 0xA9, 0x4E, 0xAB, 0xD3, 0x1D, 
+0xA9, 0xC6, 0xCC, 0x67, 0x49, 0xC2, 0x9C, 0x99, 
+0x60, 0x86, 0xBA, 0x95, 0xDD, 0x10, 0x02, 0x37, 
+0xE8, 0x2B, 0xF9, 0x2B, 0xB3, 0x97, 0x44, 0xDA, 
+0xEF, 0x65, 0xB6, 0xB2, 0x0D, 0xA4, 0xAB, 0xFE, 
+0xD7, 0x19, 0x07, 0x65, 0x4E, 0xD6, 0x4F, 0xFB, 
+0xD6, 0xE8, 0x01, 0xDA, 0xD0, 0xF6, 0xA0, 0x34, 
+0xB3, 0x9C, 0x4F, 
+
+0x3A, 0x63, 0xF3, 0x06, 0x48, 0xB8, 0x7A, 0x18, 
+0x11, 0x6F, 0x96, 0x89, 0x2C, 0x01, 0x1F, 0x47, 
+0x73, 0xF4, 0x9E, 0x28, 0x77, 0xE8, 0xCD, 0x57, 
+0x36, 0x53, 0xB4, 0x33, 0x62, 0x83, 0x51, 0x7A, 
+0x4A, 0x4D, 0xC3, 0xF7, 0x58, 0x19, 0x73, 0xB5, 
+0xFF, 0x36, 0x3E, 0x63, 0xB0, 0xD1, 0x12, 0x07, 
+0xB9, 0x17, 0xF1, 0xC6, 0x21, 0x4D, 0x44, 0xB7, 
+0xCF, 0xFD, 0x28, 0xAD, 0x11, 0xDA, 0x26, 0x79, 
+0x27, 0x4E, 0xF8, 0x25, 
+
+0x55, 0x68, 0x17, 0x44, 0xA4, 0xAE, 0x0A, 0xBE, 
+0xB6, 0xF4, 0x72, 0xAA, 0x55, 0x65, 0x33, 0x22, 
+0xC5, 0x86, 0x02, 0xC5, 0xB6, 0x13, 0x9B, 0x68, 
+0x1E, 0xE8, 0xFD, 0x2D, 0xEB, 0x9E, 0xF2, 0x72, 
+0xA3, 0xA7, 0x38, 0xC1, 0xDB, 0xDB, 0x72, 0x03, 
+0xE9, 0x7E, 0x5B, 0x55, 0x12, 0x0A, 0x8B, 0x87, 
+0x89, 0xA5, 0xB7, 0x93, 0xFA, 0xE7, 0x04, 0x5F, 
+0xDE, 0x0A, 0xB3, 0x74, 0x86, 0x54, 0x87, 0x26, 
+0x12, 0x4E, 0x14, 0xD4, 0x52, 
+
+0xBE, 0x42, 0xC4, 0xEA, 0xAB, 0x31, 0xF5, 0x2E, 
+0xF3, 0xF6, 0xBC, 0x86, 0xB6, 0xA4, 0x48, 0x74, 
+0x15, 0xE9, 0xA7, 0x65, 0xE6, 0xDA, 0xF0, 0x94, 
+0x5F, 0x1F, 0xC4, 0xCA, 0xD3, 0xFE, 0x66, 0x78, 
+0x03, 0xE3, 0xBE, 0x9D, 0xE8, 0xAC, 0x45, 0x29, 
+0xFA, 0x59, 0xE0, 0xBA, 0xD0, 0xAC, 0x62, 0x9A, 
+0xA6, 0x6F, 0x6C, 0x5B, 0x0E, 0x86, 0x38, 0xF6, 
+0x96, 0x8A, 0x2A, 0x98, 0xDF, 0x93, 0x03, 0x32, 
+0x2D, 0xA7, 0x5C, 0x03, 0x71, 0x42, 
+
+0x7D, 0x3B, 0xAE, 0x67, 0x64, 0x19, 0xC8, 0x66, 
+0xF1, 0x0F, 0x31, 0xAD, 0x43, 0x35, 0xD4, 0x44, 
+0x2C, 0xA5, 0x5D, 0x76, 0x78, 0x70, 0x4C, 0x2F, 
+0x71, 0x11, 0xB9, 0xF3, 0x69, 0x16, 0xFA, 0x09, 
+0x42, 0xE7, 0x6C, 0x09, 0x2E, 0xDA, 0xEB, 0x9A, 
+0x53, 0x82, 0x1D, 0x31, 0x3E, 0x3E, 0xD8, 0x48, 
+0xEA, 0x86, 0xE8, 0x76, 0x8E, 0xC6, 0xA7, 0x04, 
+0x00, 0xEA, 0x17, 0xAB, 0x3F, 0x3A, 0xDE, 0xDD, 
+0x8B, 0xBE, 0x6E, 0x70, 0x64, 0x1D, 0x58, 
+
+0xDE, 0xEE, 0x45, 0x68, 0x9D, 0xC8, 0x03, 0xC0, 
+0x02, 0x41, 0x3D, 0x9F, 0x33, 0x70, 0x73, 0x62, 
+0x96, 0x25, 0x02, 0xF4, 0x74, 0x5D, 0xE2, 0xF1, 
+0x67, 0xF2, 0xFD, 0xE1, 0x65, 0x47, 0x33, 0x6D, 
+0xF4, 0x86, 0x11, 0x7C, 0xD0, 0x48, 0x02, 0x7C, 
+0xA7, 0x26, 0x85, 0x27, 0xBE, 0x13, 0x96, 0x85, 
+0x40, 0x1F, 0x6A, 0xB0, 0x0D, 0x8E, 0xD2, 0x02, 
+0x14, 0x70, 0xEB, 0x5B, 0x08, 0x15, 0x0B, 0x02, 
+0xE6, 0xF7, 0x98, 0xB9, 0xBB, 0x7E, 0x3E, 0x95, 
+
+0xFA, 0x0C, 0xB5, 0xEE, 0x97, 0x04, 0x06, 0xF8, 
+0x04, 0xE8, 0xBF, 0x1C, 0x3D, 0x19, 0x5A, 0x1C, 
+0x04, 0xBC, 0x6F, 0x76, 0x50, 0x3D, 0x0C, 0xE7, 
+0xCE, 0xE5, 0xDE, 0x6F, 0xA1, 0xE6, 0xA3, 0xE4, 
+0x3C, 0xC6, 0x17, 0xBF, 0xDC, 0xDF, 0x56, 0xFC, 
+0x63, 0xE9, 0x9F, 0x57, 0x0C, 0xF0, 0xA8, 0x19, 
+0x1B, 0x8A, 0x33, 0xA3, 0xBF, 0xA8, 0xCD, 0xC4, 
+0x24, 0xFF, 0x0D, 0x86, 0x54, 0x95, 0x2F, 0x93, 
+0x5E, 0xB1, 0x4C, 0x46, 0x68, 0x25, 0x44, 0xEF, 
+0x01, 
+
+0x16, 0x34, 0x6D, 0x2E, 0x36, 0x03, 0xC4, 0x4E, 
+0xC5, 0xB4, 0xF8, 0xFA, 0x20, 0x49, 0x81, 0x68, 
+0x7E, 0xC8, 0xC2, 0x13, 0x18, 0x84, 0x0C, 0x84, 
+0xE8, 0xBF, 0xD9, 0x0D, 0x1B, 0x9F, 0xF0, 0x1F, 
+0x8D, 0x41, 0x56, 0x5F, 0xBB, 0x1A, 0x21, 0x5F, 
+0x0B, 0x7E, 0x79, 0xFC, 0x7C, 0x72, 0xD5, 0x80, 
+0x64, 0x8A, 0xFD, 0xC5, 0xF9, 0xDC, 0x61, 0x27, 
+0x75, 0x65, 0x5F, 0x73, 0xC5, 0x45, 0xD4, 0x7B, 
+0x5F, 0x44, 0xC0, 0x2D, 0x9B, 0x93, 0xED, 0xB3, 
+0xC7, 0xEB, 
+
+0x74, 0x41, 0x6D, 0x4E, 0x6C, 0x5E, 0x82, 0xB9, 
+0xA6, 0x6E, 0x53, 0x6C, 0xEF, 0x7E, 0x43, 0x36, 
+0x6A, 0xAA, 0x94, 0x99, 0xAA, 0x92, 0x44, 0xE6, 
+0x41, 0x1D, 0x09, 0xE6, 0x5D, 0xF5, 0x8D, 0xCF, 
+0x34, 0xE3, 0xAC, 0x10, 0x0B, 0x5D, 0xC1, 0x59, 
+0xB7, 0x60, 0x6C, 0xCB, 0x75, 0x67, 0x85, 0x40, 
+0xB8, 0x06, 0x54, 0xE0, 0xB7, 0xB9, 0x4E, 0x4A, 
+0x52, 0xD0, 0x39, 0x44, 0xD2, 0x52, 0x8A, 0x2E, 
+0x07, 0x10, 0xCB, 0xC9, 0x79, 0xB9, 0x00, 0x7D, 
+0x70, 0x17, 0xD1, 
+
+0x20, 0xC9, 0xAD, 0x20, 0xED, 0x26, 0x96, 0x8F, 
+0xF9, 0xEA, 0xD1, 0x3C, 0x54, 0xA2, 0x45, 0xAF, 
+0x13, 0x85, 0x90, 0x43, 0xC3, 0xFD, 0x90, 0xBA, 
+0x19, 0xAF, 0xB3, 0x6F, 0x3E, 0xD7, 0x01, 0xB3, 
+0xCD, 0x1F, 0xAC, 0x35, 0x48, 0x29, 0x7E, 0xE3, 
+0x7B, 0x5F, 0x2A, 0x48, 0x86, 0x27, 0x43, 0xAA, 
+0x9C, 0x30, 0x6A, 0x4B, 0x13, 0x77, 0x2D, 0xE5, 
+0xB4, 0xB2, 0x66, 0x82, 0x83, 0xA4, 0x88, 0x20, 
+0x11, 0x98, 0xD3, 0x23, 0x99, 0x62, 0xB7, 0x99, 
+0x20, 0x77, 0xCB, 0x26, 
+
+0x0C, 0x94, 0x90, 0x76, 0x67, 0xAB, 0xE0, 0x58, 
+0x2E, 0x22, 0xF4, 0x48, 0xE7, 0x3E, 0x44, 0xB6, 
+0x6D, 0xD4, 0xBD, 0x46, 0xEB, 0x9E, 0x01, 0xF9, 
+0xB3, 0x06, 0x6F, 0xBA, 0xEE, 0x06, 0x1F, 0xB9, 
+0x22, 0xE3, 0x49, 0x27, 0x80, 0x86, 0xB5, 0x38, 
+0x47, 0x77, 0x14, 0x68, 0x0B, 0xF2, 0x6C, 0x9A, 
+0xF1, 0x1F, 0x20, 0xED, 0x86, 0xF9, 0xEE, 0x04, 
+0x92, 0xBC, 0xAB, 0xB8, 0x6B, 0x58, 0xD4, 0xAD, 
+0x4C, 0x80, 0xFB, 0x0F, 0xCD, 0x6A, 0xB0, 0x67, 
+0xE3, 0x0D, 0x37, 0x81, 0x38, 
+
+0xAF, 0x48, 0x98, 0xFF, 0xF1, 0xC9, 0x67, 0xB3, 
+0x74, 0xA9, 0xAC, 0xF5, 0x17, 0x7D, 0x95, 0x53, 
+0x6A, 0xDD, 0xD6, 0x44, 0x3B, 0x09, 0x32, 0x78, 
+0x2E, 0xEC, 0x19, 0x33, 0xD8, 0x4C, 0xFD, 0xE6, 
+0xCE, 0x4A, 0x08, 0x78, 0xB5, 0xE8, 0x90, 0x1B, 
+0x32, 0x04, 0x51, 0xC1, 0x4A, 0x1A, 0x69, 0xA2, 
+0x0A, 0x76, 0x86, 0x73, 0x4B, 0x4C, 0xF7, 0x62, 
+0xF7, 0xD0, 0x2E, 0x91, 0x8B, 0x7C, 0x69, 0x6D, 
+0x56, 0x31, 0x50, 0x09, 0x99, 0xD1, 0x4B, 0x9C, 
+0x80, 0x90, 0x62, 0x69, 0x4A, 0x7F, 
+
+0x08, 0x49, 0x9F, 0x83, 0x46, 0x0F, 0x0E, 0x04, 
+0x94, 0x6C, 0x3F, 0x75, 0x4D, 0x98, 0xE5, 0x5D, 
+0x12, 0xCB, 0xF6, 0x53, 0x0D, 0xFD, 0x30, 0x75, 
+0x92, 0x02, 0xA9, 0x69, 0xD3, 0x71, 0xBC, 0x28, 
+0xBC, 0x18, 0x88, 0x48, 0x27, 0x0B, 0x3B, 0x5C, 
+0x2C, 0xFF, 0x7C, 0xFA, 0xB1, 0x02, 0x00, 0x79, 
+0x6D, 0x8A, 0x39, 0x3F, 0xF2, 0x11, 0xA8, 0xA3, 
+0x95, 0x68, 0xCA, 0x97, 0x1E, 0xD9, 0x80, 0xB3, 
+0x5E, 0x52, 0x8E, 0x40, 0xCD, 0x6E, 0x39, 0x4E, 
+0xD7, 0x98, 0xEC, 0x28, 0xD6, 0x25, 0xB9, 
+
+0x05, 0x2F, 0xC0, 0x7D, 0xD7, 0xC5, 0x12, 0xF3, 
+0x37, 0x94, 0x79, 0xEF, 0x44, 0xEE, 0xB0, 0xD0, 
+0xED, 0x39, 0xCB, 0x5E, 0x64, 0xE9, 0x83, 0xD2, 
+0x76, 0xF0, 0x65, 0x47, 0x25, 0x1D, 0x0D, 0x6B, 
+0x16, 0x23, 0x98, 0xE5, 0x1D, 0xF2, 0xB1, 0x68, 
+0x0D, 0xBA, 0xFE, 0x9D, 0x1A, 0x1D, 0xBA, 0x5F, 
+0x92, 0x6F, 0x17, 0x76, 0xF5, 0xB3, 0xBA, 0x5A, 
+0x71, 0x0C, 0x72, 0xFA, 0x32, 0xCC, 0x7B, 0x17, 
+0x8D, 0x42, 0xAD, 0xA2, 0xB9, 0x6A, 0xEC, 0xD3, 
+0xF8, 0x41, 0x01, 0x7B, 0x8B, 0x2F, 0x4F, 0xC2, 
+
+0x8F, 0xB7, 0xC6, 0xB8, 0x22, 0xAE, 0x29, 0x71, 
+0x39, 0xA5, 0x99, 0xC3, 0x6F, 0xBD, 0x8D, 0xF2, 
+0x74, 0x24, 0x7E, 0xFF, 0x4B, 0x35, 0x63, 0xF4, 
+0xD2, 0xA8, 0x55, 0xCD, 0x1A, 0x1A, 0x40, 0x64, 
+0x45, 0x61, 0xD8, 0x26, 0xA0, 0x23, 0x3E, 0xDC, 
+0x57, 0x05, 0xB8, 0xC4, 0xC0, 0x0C, 0xF4, 0x2E, 
+0x3F, 0xBE, 0x36, 0xFB, 0x84, 0xDF, 0x5A, 0x19, 
+0x87, 0x78, 0x6B, 0x31, 0x2D, 0xB5, 0x03, 0x98, 
+0x92, 0x35, 0x41, 0xA3, 0x61, 0xDA, 0xC5, 0x82, 
+0x0B, 0x59, 0x38, 0x40, 0x2A, 0x6B, 0x58, 0x0C, 
+0x62, 
+
+0x8F, 0xEC, 0x60, 0x90, 0x57, 0x5B, 0x8B, 0x0F, 
+0xF5, 0xF9, 0xF1, 0xA6, 0x11, 0x9A, 0xE5, 0x47, 
+0x50, 0x3A, 0x7D, 0x92, 0x1C, 0x2D, 0xBE, 0x3F, 
+0x0B, 0x75, 0x18, 0x78, 0xD1, 0x29, 0xBA, 0x37, 
+0x3F, 0xD6, 0x89, 0xE6, 0x14, 0x54, 0x58, 0x70, 
+0x0F, 0x17, 0x62, 0x4E, 0xE3, 0x8E, 0xC0, 0x18, 
+0x7B, 0x5C, 0xCB, 0x31, 0x95, 0x16, 0x9E, 0xEC, 
+0x0B, 0x7B, 0xF4, 0x1D, 0xC6, 0x30, 0xB4, 0xBD, 
+0xAA, 0x29, 0xCC, 0xBF, 0x09, 0xF1, 0xBB, 0x50, 
+0x67, 0xAF, 0xF0, 0x16, 0x0E, 0xB0, 0x4D, 0xD1, 
+0x7B, 0xBC, 
+
+0xE9, 0xF8, 0x7F, 0xAE, 0x1B, 0x66, 0x68, 0x95, 
+0x73, 0xE2, 0x74, 0x01, 0xE1, 0x7D, 0x2A, 0x45, 
+0xC0, 0x88, 0x27, 0xB0, 0x1A, 0x6F, 0xD0, 0x5E, 
+0xA6, 0x05, 0x9D, 0x18, 0xB8, 0xA2, 0x86, 0x0E, 
+0xB3, 0xF7, 0x79, 0xC3, 0x41, 0xBE, 0x1D, 0x34, 
+0x69, 0x19, 0x07, 0x2F, 0x81, 0x48, 0xFC, 0xC1, 
+0x35, 0xA4, 0x98, 0xFF, 0xAA, 0xA7, 0xF9, 0xC7, 
+0x32, 0x63, 0xBB, 0x56, 0xA7, 0xBE, 0x31, 0x88, 
+0xA1, 0x35, 0x57, 0xE0, 0x4E, 0x5C, 0x96, 0x2C, 
+0xE7, 0xE3, 0x1A, 0x04, 0xDA, 0x95, 0x3A, 0x56, 
+0x71, 0x5D, 0xC7, 
+
+0x02, 0xF5, 0xEC, 0x03, 0x39, 0xF9, 0x74, 0xC8, 
+0xAC, 0xC7, 0xF7, 0xE5, 0x62, 0x30, 0xB3, 0x0E, 
+0xBA, 0x02, 0xD6, 0x48, 0x76, 0x84, 0xDC, 0x9F, 
+0xF0, 0x79, 0x39, 0x06, 0xA9, 0x82, 0x53, 0x00, 
+0xAB, 0x09, 0x0C, 0x16, 0xDE, 0xC1, 0x05, 0xC8, 
+0x2C, 0x92, 0x02, 0x61, 0xEA, 0x87, 0x8C, 0xFC, 
+0x8A, 0x0B, 0x9F, 0x04, 0x9F, 0x51, 0x5F, 0x7B, 
+0x4F, 0xAC, 0xF1, 0x4F, 0x9A, 0x29, 0x61, 0x42, 
+0x8C, 0xD7, 0x23, 0x49, 0x67, 0xA1, 0x87, 0x2B, 
+0xCE, 0xE9, 0xC6, 0xF7, 0x27, 0x02, 0xE2, 0x7F, 
+0xD8, 0x68, 0x1E, 0xB2, 
+
+0x78, 0xC3, 0xA1, 0xCB, 0x49, 0x80, 0x93, 0x83, 
+0x6D, 0x3D, 0x8C, 0xB6, 0x38, 0x79, 0x45, 0xCF, 
+0x65, 0x32, 0x71, 0xE6, 0x00, 0x24, 0x23, 0x45, 
+0x47, 0x37, 0x84, 0x54, 0x1B, 0x7E, 0x25, 0x5B, 
+0x86, 0xA5, 0x55, 0x07, 0xB4, 0x0B, 0xCD, 0x23, 
+0x6B, 0xF3, 0x33, 0xCB, 0xCA, 0x7C, 0xBC, 0x7F, 
+0x43, 0x50, 0x02, 0xA2, 0x45, 0x1C, 0xD3, 0x32, 
+0x7C, 0x28, 0x6D, 0xA1, 0x24, 0x2A, 0x58, 0x29, 
+0xC1, 0xB2, 0x6C, 0x71, 0x1A, 0x45, 0xB0, 0xF7, 
+0x0B, 0x30, 0xB4, 0x18, 0x08, 0x39, 0xDB, 0x23, 
+0xF9, 0x33, 0x86, 0x81, 0xE6, 
+
+0x0E, 0x46, 0xBD, 0x8B, 0xDC, 0xE8, 0x28, 0x6A, 
+0x31, 0x78, 0xE6, 0xDE, 0x37, 0xC0, 0x68, 0x2D, 
+0xE0, 0x38, 0xD0, 0x2F, 0x28, 0xBB, 0xA2, 0xF4, 
+0x8F, 0x82, 0x35, 0xDF, 0x0F, 0xB9, 0x31, 0x98, 
+0xF6, 0x03, 0x66, 0x80, 0xCF, 0xDA, 0xF0, 0x0B, 
+0x4C, 0x62, 0x2F, 0x6E, 0x7A, 0x1B, 0x80, 0x98, 
+0x7A, 0xDB, 0x1F, 0x2B, 0xC2, 0x2F, 0x96, 0x80, 
+0x58, 0x98, 0x21, 0xFC, 0xBB, 0x1A, 0x13, 0xAF, 
+0x56, 0xA5, 0x4A, 0x89, 0xDB, 0xF0, 0x23, 0x83, 
+0xBA, 0xD3, 0x1F, 0x3A, 0xCC, 0xE0, 0xFB, 0x78, 
+0xF0, 0xA7, 0x01, 0xB5, 0xF7, 0x0C, 
+
+0xC5, 0x38, 0x2D, 0xA3, 0x3D, 0xF8, 0x27, 0x28, 
+0x56, 0x3E, 0x35, 0x24, 0x8D, 0x8F, 0x56, 0xBD, 
+0xA9, 0x6D, 0x58, 0x99, 0x12, 0xC7, 0xAB, 0x4A, 
+0xE0, 0xF7, 0xDE, 0x1E, 0xF0, 0x40, 0x89, 0x0C, 
+0xE4, 0x7B, 0xF8, 0x7B, 0x8C, 0x1B, 0x6F, 0xC1, 
+0xD4, 0x6E, 0xEF, 0x45, 0x2E, 0x7E, 0x03, 0x0F, 
+0x3B, 0x0F, 0x48, 0x28, 0x8D, 0x8C, 0xAF, 0x9E, 
+0xB3, 0x55, 0x6B, 0xA7, 0xAA, 0x03, 0x94, 0x21, 
+0xED, 0x7A, 0x92, 0x81, 0x12, 0x37, 0xAF, 0x1F, 
+0x22, 0x81, 0x0D, 0xA3, 0x4B, 0x8D, 0xF9, 0x7C, 
+0x37, 0x00, 0x5D, 0xA9, 0xEC, 0x11, 0xE9, 
+
+0x15, 0x94, 0x79, 0xF5, 0xEB, 0x7F, 0xDD, 0x18, 
+0x2E, 0x9C, 0x4B, 0xA0, 0xD2, 0x3D, 0xEF, 0x37, 
+0x82, 0xDB, 0xDC, 0xFB, 0x8D, 0x07, 0x87, 0x30, 
+0x89, 0x79, 0x31, 0xB1, 0x27, 0xAF, 0x6B, 0x9B, 
+0x0C, 0xAB, 0xA3, 0x72, 0xA4, 0xE3, 0x96, 0x7B, 
+0x61, 0x4F, 0xB4, 0x0D, 0xD1, 0x64, 0x9D, 0xD5, 
+0x3D, 0x0F, 0x24, 0x3C, 0xFF, 0x39, 0xA3, 0x08, 
+0x7E, 0xD0, 0x4A, 0x14, 0xF2, 0x16, 0x08, 0x12, 
+0x58, 0x6F, 0xE4, 0xC3, 0xEE, 0x77, 0xDA, 0xF0, 
+0x2F, 0x83, 0x6E, 0x1A, 0xE4, 0x55, 0x89, 0x19, 
+0xDA, 0x40, 0x58, 0x2E, 0x61, 0x30, 0x1E, 0x68, 
+
+0xCA, 0x0D, 0x28, 0x4B, 0x0A, 0x4D, 0xD4, 0x03, 
+0xDA, 0xAA, 0x7E, 0x33, 0x7D, 0x98, 0x9D, 0xE0, 
+0x5B, 0x29, 0x94, 0x19, 0xA4, 0xD8, 0xC7, 0xF8, 
+0x11, 0xF0, 0xD9, 0x18, 0x9A, 0x17, 0x1A, 0x14, 
+0xE1, 0x4E, 0x4F, 0x09, 0x45, 0x7D, 0x17, 0xE9, 
+0xB7, 0xA2, 0x6B, 0x0A, 0xE6, 0x17, 0x57, 0x05, 
+0x24, 0xDC, 0xC6, 0x75, 0xAE, 0xBC, 0xAE, 0x32, 
+0x1B, 0x60, 0x5A, 0x5D, 0x77, 0xB4, 0x42, 0x48, 
+0x99, 0xE7, 0xB5, 0xEB, 0xE5, 0x7A, 0x10, 0x93, 
+0x5D, 0x34, 0x14, 0xA0, 0xF7, 0xBA, 0x51, 0xCB, 
+0x90, 0x35, 0x05, 0xC6, 0x27, 0xFF, 0x83, 0xFE, 
+0x0B, 
+
+0xC7, 0xD2, 0x23, 0x12, 0x22, 0x4E, 0x47, 0x8E, 
+0xB9, 0x0E, 0x31, 0x80, 0xA3, 0xB3, 0x72, 0xE1, 
+0xB4, 0xE6, 0x43, 0x4C, 0x9C, 0xDE, 0xBD, 0x34, 
+0x79, 0xD8, 0x2D, 0x82, 0xA2, 0x79, 0x64, 0x54, 
+0x13, 0x9C, 0xFC, 0x21, 0x3A, 0x7C, 0xFC, 0x84, 
+0x87, 0xB6, 0x94, 0xD8, 0x8E, 0x6A, 0xBD, 0xA7, 
+0xC5, 0x2E, 0xC6, 0x88, 0x46, 0xF9, 0xA3, 0xFE, 
+0x66, 0x6D, 0x10, 0xB3, 0x07, 0x27, 0xDE, 0xDC, 
+0x84, 0xEC, 0xFB, 0x15, 0xC7, 0x6C, 0x9E, 0x3E, 
+0x03, 0x41, 0x6E, 0x67, 0x59, 0x88, 0x65, 0x1C, 
+0x3E, 0xA0, 0x10, 0x30, 0xDA, 0xC0, 0xE6, 0x73, 
+0xC1, 0x15, 
+
+0xDD, 0x6E, 0xDE, 0x6B, 0x5B, 0x0B, 0xEA, 0xD1, 
+0xB1, 0xB9, 0xD8, 0xA3, 0xCE, 0xE3, 0x95, 0x79, 
+0x16, 0x68, 0x90, 0x54, 0x7A, 0xC4, 0x4E, 0x77, 
+0x95, 0xD6, 0xBE, 0xAF, 0x0E, 0xE0, 0x88, 0xDA, 
+0x28, 0xF2, 0xEA, 0x52, 0x77, 0xFE, 0x7F, 0xAA, 
+0x3D, 0xD4, 0xCA, 0x1B, 0xBC, 0xAC, 0xC5, 0x0D, 
+0xE2, 0x50, 0x70, 0x57, 0x12, 0x14, 0x54, 0x97, 
+0x22, 0x3E, 0x43, 0x82, 0x0F, 0xA1, 0xA8, 0x93, 
+0x4A, 0xD3, 0x7E, 0xE1, 0x1E, 0xBE, 0xD5, 0x3E, 
+0x36, 0x61, 0xB1, 0x39, 0x97, 0x1A, 0x7D, 0xB6, 
+0x1B, 0x59, 0x49, 0x2F, 0xF8, 0x23, 0xA1, 0x69, 
+0x9F, 0x9A, 0x80, 
+
+0x35, 0x07, 0x1D, 0x30, 0xAA, 0x5F, 0xC8, 0x44, 
+0xDA, 0x6D, 0xB6, 0xCB, 0x0B, 0xD6, 0x54, 0x54, 
+0x48, 0x98, 0x07, 0xE6, 0xB0, 0x33, 0x48, 0xCF, 
+0xB7, 0xAF, 0x58, 0xF6, 0x2A, 0x09, 0xD2, 0x7A, 
+0xB5, 0xB4, 0x93, 0x6C, 0xC3, 0x83, 0xFB, 0x17, 
+0xE5, 0xBF, 0xDD, 0xE3, 0x10, 0x37, 0x69, 0xF9, 
+0x90, 0xEE, 0x65, 0x1E, 0x8F, 0x82, 0x14, 0xC5, 
+0x38, 0xF3, 0x96, 0x22, 0x63, 0x81, 0xD4, 0x7A, 
+0x23, 0x2E, 0x9A, 0xFD, 0xD4, 0xBB, 0xE1, 0x56, 
+0xDD, 0xB6, 0xD0, 0x77, 0xA7, 0x47, 0x2F, 0x2F, 
+0x3B, 0xC0, 0x35, 0x73, 0x1E, 0x20, 0xB3, 0x1C, 
+0x26, 0x0C, 0x6B, 0x25, 
+
+0x55, 0xA8, 0x19, 0x38, 0x4C, 0x45, 0x5C, 0xF7, 
+0x03, 0x9A, 0x21, 0x1B, 0xFF, 0xA8, 0xF7, 0x76, 
+0x3E, 0xF2, 0x8E, 0xF1, 0xCD, 0x69, 0xDB, 0x0F, 
+0xF6, 0x1C, 0x6A, 0x92, 0x71, 0xEF, 0x4A, 0x3E, 
+0xCE, 0xF9, 0xEA, 0xAB, 0x76, 0x6B, 0x1E, 0xB5, 
+0x02, 0x61, 0xA7, 0x02, 0x76, 0xBA, 0x6B, 0x1D, 
+0xE5, 0xF0, 0x12, 0xDF, 0x1D, 0x61, 0x82, 0x90, 
+0x91, 0xDA, 0x96, 0x60, 0x89, 0x88, 0x83, 0xCD, 
+0xBF, 0xAC, 0xA8, 0x86, 0xC4, 0x7F, 0xA5, 0xA3, 
+0x3E, 0x50, 0xC4, 0x8B, 0x30, 0x9A, 0xAA, 0x30, 
+0x63, 0x9A, 0xA7, 0xEB, 0x1E, 0xF3, 0x4A, 0x47, 
+0xF3, 0xDC, 0x23, 0xF2, 0x4B, 
+
+0x9C, 0xB1, 0xAA, 0x0D, 0xDB, 0xC1, 0x1A, 0x9C, 
+0x5C, 0x98, 0x93, 0xD8, 0x01, 0xC7, 0xFE, 0x24, 
+0xBC, 0x60, 0x5F, 0x0D, 0xA4, 0x50, 0xC2, 0x9D, 
+0x11, 0x34, 0xDE, 0x31, 0xE9, 0xD2, 0x45, 0x49, 
+0x6F, 0xC2, 0x91, 0x8C, 0x75, 0x1D, 0xB8, 0xD8, 
+0x27, 0xA1, 0x5F, 0xF2, 0x66, 0xBA, 0xD2, 0x5C, 
+0xD6, 0xBB, 0x5B, 0x45, 0x57, 0x08, 0xF3, 0x0A, 
+0x5C, 0x58, 0xAA, 0x0B, 0x79, 0x12, 0x2F, 0x2D, 
+0x42, 0x37, 0xCD, 0x5C, 0xB3, 0xF1, 0x8F, 0x34, 
+0xE2, 0x61, 0xC3, 0x45, 0xFC, 0xFF, 0xCF, 0x70, 
+0xC5, 0xCD, 0xD9, 0xE9, 0x69, 0x90, 0xD3, 0xAB, 
+0x7B, 0xB2, 0x05, 0x4B, 0xD7, 0x49, 
+
+0x49, 0x52, 0x78, 0x3A, 0x42, 0x33, 0x66, 0xEF, 
+0x71, 0xB1, 0xF3, 0x8B, 0x98, 0xB2, 0x65, 0x8A, 
+0x8A, 0x5D, 0xE8, 0x6B, 0x9B, 0xFB, 0xEF, 0x15, 
+0x86, 0x3C, 0x68, 0x1D, 0x3A, 0x5C, 0xA7, 0xD8, 
+0x3B, 0x33, 0x95, 0xF0, 0x9C, 0xB8, 0xA6, 0x92, 
+0x11, 0x79, 0xB2, 0xD9, 0x3C, 0x18, 0x7F, 0xBB, 
+0xF9, 0xAA, 0x43, 0x01, 0xD1, 0x7D, 0xC7, 0xB7, 
+0x0D, 0x49, 0x44, 0x0D, 0x36, 0x0B, 0xD9, 0xC6, 
+0x54, 0x79, 0x0A, 0x63, 0x79, 0x86, 0xBD, 0x0B, 
+0xC4, 0x03, 0xF5, 0xFC, 0x1E, 0x37, 0xE5, 0x08, 
+0x59, 0xE4, 0x43, 0xF9, 0x11, 0x00, 0xFF, 0x44, 
+0x0C, 0x88, 0x2D, 0x80, 0xCF, 0xC2, 0xC1, 
+
+0x5E, 0xAA, 0x52, 0xBD, 0xD4, 0x77, 0xAF, 0x8B, 
+0x18, 0x01, 0xE0, 0xF7, 0xC4, 0xE7, 0x4B, 0x86, 
+0xAB, 0x7D, 0x11, 0xE6, 0x70, 0x98, 0x34, 0x7C, 
+0x53, 0x63, 0x75, 0xFE, 0xE8, 0xB4, 0x7A, 0x95, 
+0x59, 0x0A, 0x4D, 0x78, 0x65, 0x7E, 0x93, 0xEE, 
+0xD0, 0x84, 0x3D, 0xE0, 0xB6, 0xA2, 0x2D, 0x8A, 
+0x36, 0x68, 0x04, 0xB4, 0x84, 0x38, 0x14, 0x1E, 
+0x7A, 0x61, 0x6B, 0x60, 0xA0, 0x4A, 0xE1, 0x7D, 
+0x4B, 0xB8, 0x8F, 0x31, 0x49, 0x66, 0xE2, 0x3E, 
+0x73, 0xE5, 0xE1, 0x73, 0xB9, 0x9A, 0x4D, 0xAD, 
+0x34, 0xD8, 0x8A, 0xC2, 0xD7, 0x47, 0xEA, 0x51, 
+0x81, 0xA0, 0xD1, 0x6A, 0xB3, 0x31, 0xE5, 0xAF, 
+
+0x9C, 0x95, 0xB5, 0x62, 0xE3, 0x90, 0x98, 0xF3, 
+0x02, 0x26, 0x65, 0x18, 0x99, 0x4B, 0xCC, 0x67, 
+0x42, 0xCF, 0x7B, 0x21, 0xC4, 0xB7, 0x97, 0x99, 
+0x50, 0xBD, 0x05, 0x49, 0xB4, 0xA3, 0x9E, 0xCA, 
+0x85, 0xD1, 0xD0, 0x65, 0xF3, 0x69, 0x8E, 0x21, 
+0x49, 0xCA, 0x23, 0x89, 0x8F, 0x9A, 0x03, 0x7B, 
+0xB0, 0x55, 0xE4, 0x21, 0x7D, 0x00, 0xC1, 0xFA, 
+0xBC, 0x24, 0x65, 0x5C, 0x83, 0x67, 0x5B, 0x97, 
+0x01, 0xCB, 0x00, 0x82, 0xF2, 0xA1, 0x3E, 0xE8, 
+0xCC, 0xCE, 0x00, 0x5A, 0x79, 0xB5, 0xF7, 0x13, 
+0xD8, 0x5F, 0x4A, 0x2F, 0x22, 0x3C, 0x53, 0xA8, 
+0x5A, 0x59, 0x39, 0xE5, 0x85, 0xE8, 0xCE, 0x28, 
+0xB3, 
+
+0x6D, 0x99, 0x39, 0x36, 0xA1, 0x3E, 0x29, 0x65, 
+0x55, 0xA1, 0x90, 0x10, 0xB5, 0x49, 0x64, 0xE3, 
+0x09, 0xB2, 0xDB, 0x65, 0xF1, 0x5E, 0x05, 0x1E, 
+0x0E, 0x6A, 0x8C, 0x38, 0xDF, 0xE1, 0xB8, 0x87, 
+0x15, 0x2C, 0xCB, 0x4D, 0xD7, 0x44, 0xDB, 0x3B, 
+0x80, 0xA2, 0xEA, 0x9A, 0x7A, 0xA8, 0x39, 0xE2, 
+0x33, 0xFC, 0x40, 0xCD, 0x92, 0xB8, 0x62, 0x1D, 
+0x9E, 0xC7, 0x65, 0x65, 0x3C, 0x24, 0x6A, 0x77, 
+0x11, 0xA5, 0xEE, 0x86, 0x19, 0x5E, 0xCF, 0x01, 
+0xF7, 0x74, 0x64, 0xAA, 0xDF, 0xD3, 0x0B, 0x54, 
+0xF8, 0xEB, 0xE0, 0x5F, 0x39, 0xBA, 0xFF, 0xBC, 
+0x13, 0x7C, 0x14, 0xD5, 0xB7, 0x94, 0x46, 0x5B, 
+0x39, 0x5F, 
+
+0xC5, 0x4F, 0x57, 0x22, 0xF0, 0xE2, 0x42, 0xEE, 
+0x03, 0x5C, 0xDE, 0x88, 0x0A, 0x6E, 0xEB, 0xA4, 
+0x41, 0xEF, 0x55, 0x9D, 0x37, 0x21, 0xAE, 0x2A, 
+0xA9, 0x9D, 0x92, 0xDC, 0xCF, 0x5F, 0xD3, 0x28, 
+0x23, 0x5D, 0x22, 0xDB, 0x89, 0x31, 0x4D, 0x60, 
+0x08, 0x92, 0x78, 0x3A, 0x5D, 0xAE, 0x91, 0x1A, 
+0x93, 0xCD, 0x4D, 0x62, 0x40, 0xA2, 0x18, 0x1A, 
+0x6B, 0xCE, 0x34, 0x0D, 0x3B, 0x09, 0xC6, 0xE6, 
+0x3F, 0x1B, 0x88, 0x19, 0x14, 0x29, 0xAD, 0x75, 
+0x06, 0x1B, 0xD9, 0xE2, 0xEC, 0xE7, 0x1B, 0xC9, 
+0x7A, 0xD3, 0x18, 0x49, 0xD4, 0x16, 0xFF, 0xCE, 
+0xC3, 0x2C, 0x2C, 0x3F, 0xEB, 0xEF, 0x2B, 0x4B, 
+0xAB, 0xB3, 0x53, 
+
+0x76, 0xD3, 0x4B, 0xE8, 0x19, 0x32, 0xEE, 0xA7, 
+0xD5, 0x21, 0xE4, 0xFF, 0x60, 0x32, 0xED, 0xE4, 
+0x6D, 0xC3, 0xE2, 0xA4, 0xD2, 0xEC, 0x0B, 0x41, 
+0x8E, 0xF2, 0xE3, 0x83, 0x84, 0x18, 0xE5, 0xF4, 
+0xBA, 0xE7, 0x17, 0xED, 0xF7, 0xF6, 0xC8, 0x6C, 
+0xA9, 0xC7, 0xF7, 0x23, 0x9E, 0x0F, 0xEC, 0x4C, 
+0x51, 0x20, 0xB8, 0xEF, 0x02, 0xCC, 0xFF, 0xBB, 
+0xDE, 0x1C, 0x47, 0x8A, 0xAB, 0x2D, 0xDF, 0xF6, 
+0x86, 0x0B, 0xC8, 0xFB, 0xDE, 0x24, 0x64, 0x1F, 
+0x99, 0x4F, 0x44, 0xC9, 0x78, 0x45, 0xD2, 0x2A, 
+0x7A, 0x3B, 0x1C, 0xC5, 0xFB, 0xCA, 0x67, 0x3A, 
+0x30, 0xE0, 0x32, 0x18, 0x67, 0x38, 0x5D, 0x3B, 
+0x44, 0x1D, 0x32, 0xD6, 
+
+0x86, 0x42, 0xAF, 0x92, 0x97, 0x70, 0x4B, 0x6A, 
+0xC3, 0x85, 0x01, 0xE0, 0xFE, 0x10, 0x0A, 0x58, 
+0xD8, 0xF8, 0x1F, 0x0C, 0xA0, 0xF3, 0x41, 0x40, 
+0x1F, 0x90, 0x54, 0x07, 0x52, 0x34, 0x2C, 0xC8, 
+0x20, 0x3A, 0xA5, 0x1D, 0x12, 0x59, 0xC5, 0xCA, 
+0x3F, 0x60, 0x7E, 0x9F, 0xB0, 0xB3, 0x66, 0x99, 
+0x67, 0xD6, 0x0B, 0x05, 0x54, 0xB5, 0xE3, 0x7E, 
+0xC6, 0x97, 0xF3, 0xD5, 0xC0, 0x73, 0xE9, 0xE2, 
+0x70, 0x20, 0x78, 0x15, 0x74, 0x2F, 0x19, 0xCC, 
+0x0F, 0xA9, 0xCC, 0xE1, 0xAE, 0x60, 0x6A, 0x05, 
+0x97, 0xE4, 0x5A, 0x6F, 0xF9, 0xAB, 0x2A, 0xFF, 
+0xB5, 0xFC, 0x9F, 0x3E, 0x8B, 0x6D, 0xCE, 0x28, 
+0x46, 0x1D, 0x8E, 0x61, 0xEF, 
+
+0x6A, 0x33, 0x55, 0x52, 0x04, 0x3E, 0x41, 0x83, 
+0x22, 0xED, 0x2A, 0xAC, 0x33, 0xEF, 0xA6, 0xA9, 
+0x8E, 0x21, 0x9D, 0x2B, 0xFB, 0x20, 0x80, 0x22, 
+0x0F, 0x7A, 0xB4, 0x67, 0xFF, 0x76, 0xFA, 0x1D, 
+0x1B, 0x26, 0xF9, 0xD4, 0x3A, 0x66, 0x99, 0x4C, 
+0xFD, 0x45, 0x61, 0x96, 0x02, 0x3F, 0xD3, 0x13, 
+0xE4, 0x44, 0x30, 0x30, 0x6B, 0x76, 0x77, 0xEA, 
+0x66, 0x69, 0x1B, 0x82, 0xE6, 0xA2, 0x50, 0x69, 
+0xFD, 0x5D, 0xAD, 0x59, 0x82, 0x94, 0xA1, 0xF3, 
+0x78, 0x5B, 0x00, 0x7B, 0x6E, 0xE9, 0xA2, 0xB9, 
+0xB4, 0x56, 0xF9, 0xD5, 0x5C, 0x42, 0x42, 0xD5, 
+0x6E, 0x88, 0x06, 0xAD, 0x21, 0xD8, 0xE9, 0xDE, 
+0x0C, 0x2B, 0xF5, 0x55, 0xE9, 0x3A, 
+
+0x74, 0xAF, 0x14, 0xA0, 0x6E, 0x50, 0x25, 0x74, 
+0x8F, 0xC7, 0x16, 0x03, 0x56, 0x10, 0x01, 0x76, 
+0x5B, 0x63, 0xD0, 0xEF, 0x4E, 0xA7, 0x59, 0x27, 
+0x43, 0x0C, 0x3F, 0x8C, 0xFD, 0xB6, 0x09, 0x17, 
+0x81, 0xD7, 0xEC, 0x94, 0x50, 0x4B, 0xC8, 0x22, 
+0x3F, 0x82, 0x11, 0xA1, 0x58, 0x8C, 0x23, 0xBB, 
+0x8B, 0xFB, 0x4F, 0x34, 0x31, 0x98, 0x63, 0x1B, 
+0xC4, 0x8E, 0xCF, 0x12, 0x57, 0x68, 0x14, 0x2B, 
+0x23, 0xA9, 0x9D, 0x17, 0x84, 0x2A, 0x53, 0xE7, 
+0x67, 0x5A, 0xC1, 0x6E, 0x65, 0x25, 0x70, 0x25, 
+0x76, 0x70, 0x30, 0x24, 0x85, 0xF8, 0x1F, 0xDA, 
+0x3D, 0x52, 0x60, 0x1A, 0x65, 0xD8, 0xE6, 0x3A, 
+0x23, 0x7D, 0x56, 0xE2, 0xCB, 0x05, 0xA9, 
+
+0x50, 0xDC, 0xA7, 0x8B, 0xED, 0x47, 0x10, 0x06, 
+0x63, 0xCB, 0x7D, 0x5B, 0xE6, 0xB0, 0xED, 0x90, 
+0x55, 0xF0, 0x38, 0x3C, 0x80, 0x34, 0x2B, 0x62, 
+0x92, 0xC5, 0x61, 0x0D, 0xEC, 0x0E, 0xD3, 0xEA, 
+0xD6, 0x81, 0xB8, 0x4D, 0xC6, 0x3E, 0xC5, 0x19, 
+0x70, 0x31, 0x0F, 0x2C, 0x65, 0x07, 0x0F, 0x43, 
+0x2F, 0xA0, 0x63, 0xD7, 0xFB, 0x9B, 0xD1, 0xA9, 
+0x60, 0x3C, 0xFE, 0x4E, 0x09, 0xAF, 0xC7, 0x04, 
+0x4A, 0xE6, 0x75, 0x18, 0xF6, 0x53, 0x87, 0x91, 
+0xD6, 0x33, 0x15, 0x9C, 0x32, 0xA0, 0x52, 0x82, 
+0xC7, 0xF9, 0x17, 0x9C, 0x58, 0x2C, 0xBC, 0x75, 
+0xAC, 0xFB, 0x09, 0xAD, 0x80, 0x27, 0x86, 0xFA, 
+0x40, 0x75, 0x40, 0xC1, 0xFE, 0x20, 0xBC, 0x23, 
+
+0xB0, 0xC5, 0x54, 0xAD, 0x36, 0x9B, 0x5C, 0x03, 
+0x86, 0x9C, 0x35, 0x73, 0xD5, 0xF9, 0x0F, 0x5F, 
+0xEF, 0x3A, 0xFF, 0x73, 0x9E, 0xC4, 0xDB, 0x64, 
+0x4F, 0x3E, 0xEF, 0xD2, 0x32, 0x5A, 0x7F, 0xA5, 
+0xBF, 0x2C, 0x81, 0x00, 0xBB, 0xFA, 0x5F, 0x98, 
+0x86, 0x71, 0x26, 0x39, 0x8F, 0xB7, 0x84, 0x38, 
+0x9D, 0xE4, 0xF7, 0x99, 0x21, 0xB9, 0x0D, 0xF7, 
+0x6F, 0x7F, 0xB8, 0x68, 0x8F, 0x7E, 0x7E, 0x4F, 
+0xA7, 0x15, 0x2A, 0x82, 0xFC, 0x60, 0xCF, 0x70, 
+0xE9, 0x86, 0xBD, 0x24, 0xA1, 0xBA, 0x30, 0xB2, 
+0x70, 0x66, 0xBC, 0x6F, 0x1C, 0x39, 0x94, 0xB5, 
+0x81, 0xE6, 0xC2, 0x7F, 0x71, 0x86, 0x22, 0x6F, 
+0xDD, 0xDE, 0x32, 0xE9, 0xCD, 0x19, 0x57, 0x50, 
+0xA7, 
+
+0x2F, 0x20, 0x5D, 0x1C, 0xAC, 0x77, 0x49, 0x4E, 
+0x6A, 0x21, 0x43, 0x6D, 0x67, 0x9C, 0xF6, 0x87, 
+0xC9, 0xAF, 0x8B, 0x58, 0xF0, 0xCB, 0x8B, 0x9C, 
+0x41, 0x7D, 0x77, 0x66, 0xFF, 0x9D, 0x6D, 0xA5, 
+0x23, 0xAC, 0x7E, 0x35, 0x18, 0xC1, 0x8E, 0x96, 
+0x5F, 0x9B, 0xD8, 0xDF, 0xEA, 0xA1, 0x1A, 0x4E, 
+0xE5, 0x43, 0x6E, 0x05, 0xE7, 0xBF, 0x0B, 0x81, 
+0xD5, 0x73, 0x6C, 0x37, 0x43, 0x82, 0xF4, 0x40, 
+0x8F, 0x6E, 0xA2, 0x7B, 0xEC, 0x28, 0x7B, 0xD5, 
+0xA1, 0xCB, 0x38, 0xF1, 0x62, 0x5F, 0xCC, 0xCF, 
+0xB5, 0x87, 0x38, 0x03, 0x5D, 0x1E, 0xC9, 0x01, 
+0x4C, 0xE1, 0xE4, 0xCD, 0x0F, 0x40, 0xE5, 0xC1, 
+0xF6, 0xD5, 0xFF, 0x42, 0xEC, 0x51, 0xF2, 0x64, 
+0x45, 0xE9, 
+
+0x97, 0xDD, 0xB6, 0xB9, 0xD3, 0xA3, 0x8A, 0x20, 
+0x05, 0x15, 0xD1, 0x14, 0x69, 0x33, 0x98, 0xFF, 
+0x77, 0xDA, 0x47, 0x11, 0xC3, 0x59, 0x19, 0xFB, 
+0x86, 0xEE, 0x3A, 0xB9, 0x4F, 0x61, 0x07, 0x6C, 
+0x7B, 0x01, 0x89, 0x87, 0x1E, 0xA0, 0xBB, 0x34, 
+0x60, 0x89, 0xAA, 0xE9, 0xE5, 0x03, 0xE4, 0xF7, 
+0xA7, 0x16, 0xD0, 0xA9, 0x08, 0x3F, 0xA1, 0x25, 
+0x57, 0xB4, 0xAD, 0x52, 0x80, 0x53, 0xB3, 0x3B, 
+0x58, 0x58, 0x96, 0x37, 0x25, 0xEB, 0x08, 0xD9, 
+0x63, 0xFA, 0x1F, 0xC8, 0x92, 0xF0, 0x50, 0x0D, 
+0xAE, 0xFB, 0x16, 0x2E, 0xA7, 0xB1, 0x1E, 0x71, 
+0x46, 0xCE, 0xE6, 0xB6, 0x69, 0xC6, 0x6D, 0xC2, 
+0x7A, 0x14, 0x1A, 0x2A, 0x72, 0xDB, 0x7A, 0xC4, 
+0x6A, 0x95, 0xA8, 
+
+0x17, 0x73, 0x8A, 0x98, 0x3B, 0x5B, 0xAD, 0x53, 
+0x01, 0x84, 0x65, 0x88, 0xB4, 0x47, 0x4D, 0xC8, 
+0x8C, 0x13, 0x47, 0x75, 0xB5, 0x03, 0x43, 0x64, 
+0xE5, 0x8D, 0x56, 0xC1, 0xC0, 0x85, 0xE8, 0x25, 
+0xE7, 0x2F, 0xC1, 0x98, 0x58, 0xE7, 0x2D, 0xD7, 
+0x4D, 0x2B, 0xE0, 0x2D, 0x87, 0x67, 0x72, 0x7E, 
+0xA1, 0xAE, 0xF3, 0x86, 0x88, 0xE4, 0x69, 0x23, 
+0x30, 0x27, 0xA3, 0x48, 0xDC, 0xA0, 0x67, 0xCC, 
+0x54, 0x31, 0xB2, 0xB7, 0x24, 0x23, 0xB9, 0x4A, 
+0x5A, 0x07, 0x65, 0x4C, 0x2D, 0x5A, 0x9A, 0x4E, 
+0xB4, 0xA6, 0x70, 0xFF, 0x69, 0x3E, 0xD2, 0xC9, 
+0x55, 0x2E, 0x4F, 0xC4, 0xA4, 0xE7, 0x67, 0xE0, 
+0xD7, 0x7D, 0x69, 0xDF, 0x86, 0x7A, 0xB3, 0xBA, 
+0x10, 0x0C, 0xD4, 0x51, 
+
+0x79, 0xFE, 0xFA, 0xEB, 0xF2, 0x35, 0xB1, 0x0C, 
+0x46, 0x53, 0x2E, 0x26, 0xCE, 0x1A, 0x6E, 0x36, 
+0xD0, 0xD1, 0x4C, 0x2B, 0x55, 0x02, 0x8C, 0x39, 
+0xA4, 0xC4, 0x14, 0x9B, 0x05, 0x83, 0x80, 0x8A, 
+0xE6, 0x05, 0x7E, 0x0A, 0xE9, 0x1A, 0xBC, 0xBD, 
+0xAE, 0xDE, 0xCF, 0x33, 0xC4, 0xAB, 0x10, 0x66, 
+0x52, 0x56, 0x4D, 0xEA, 0xBD, 0xE2, 0xDA, 0xC8, 
+0x77, 0x1D, 0x26, 0x8C, 0xD5, 0x9A, 0x01, 0xFD, 
+0xFB, 0x49, 0x89, 0xB8, 0xE1, 0xD0, 0x48, 0x49, 
+0x44, 0x23, 0xE2, 0xEF, 0x11, 0x74, 0x67, 0x5E, 
+0x14, 0x01, 0x15, 0xEC, 0x39, 0x9B, 0x0A, 0x69, 
+0x7C, 0x87, 0xC2, 0xE9, 0x58, 0x7F, 0x97, 0x83, 
+0xB1, 0xD3, 0xEF, 0xC6, 0x93, 0x36, 0x1D, 0x92, 
+0x7F, 0x0A, 0xF7, 0xC5, 0x2D, 
+
+0x99, 0x09, 0x0A, 0xFD, 0xC2, 0x22, 0x9F, 0x2B, 
+0x4E, 0xD3, 0x2E, 0x38, 0x10, 0x2F, 0x9D, 0xD4, 
+0x5E, 0xFC, 0x93, 0x77, 0x7B, 0x8D, 0xD3, 0xB9, 
+0x5E, 0x1C, 0x17, 0x29, 0x4D, 0x0A, 0xA9, 0x5D, 
+0x3E, 0xA9, 0x59, 0xD9, 0xBA, 0xF9, 0x5B, 0x21, 
+0xF8, 0x59, 0xA8, 0xD5, 0x5C, 0x7E, 0x01, 0xDA, 
+0xE5, 0x4F, 0x81, 0x1C, 0xD7, 0x63, 0x16, 0xA4, 
+0x2D, 0xE6, 0xFA, 0xAA, 0x18, 0x8C, 0x87, 0xF7, 
+0xF2, 0x7C, 0x38, 0x0C, 0x08, 0xD6, 0xA4, 0x1E, 
+0x61, 0x19, 0xE7, 0xE9, 0x5B, 0x7C, 0x8F, 0x1A, 
+0xB6, 0x4B, 0x7E, 0x78, 0x12, 0x22, 0xF4, 0xCA, 
+0x59, 0xAE, 0x69, 0xC7, 0x22, 0x1D, 0x9C, 0x73, 
+0x1E, 0x31, 0x8F, 0xB9, 0x55, 0xEF, 0x79, 0xD0, 
+0xB8, 0x70, 0xDF, 0xA0, 0x92, 0x48, 
+
+0x0B, 0x8D, 0x85, 0x02, 0x46, 0xFB, 0x2D, 0x5A, 
+0x4A, 0xB7, 0xC1, 0x3A, 0x8C, 0x4D, 0xA0, 0xBD, 
+0x43, 0x52, 0xE1, 0x83, 0xA5, 0x6C, 0x78, 0x10, 
+0xC1, 0xF1, 0xDF, 0x77, 0x66, 0x2C, 0x30, 0x82, 
+0x12, 0x06, 0xBD, 0x79, 0x0A, 0xBD, 0x80, 0x92, 
+0xF2, 0xCF, 0xB3, 0x50, 0xF7, 0x3A, 0x80, 0xE2, 
+0xE5, 0xB6, 0xC8, 0x65, 0xFF, 0xB9, 0x75, 0x84, 
+0x89, 0xB3, 0x63, 0x75, 0xA8, 0xA1, 0x45, 0x3E, 
+0x46, 0x97, 0x4A, 0x09, 0xFF, 0xAC, 0x5B, 0xF9, 
+0x25, 0x79, 0x49, 0x70, 0xA8, 0x87, 0x95, 0x8D, 
+0x59, 0x2F, 0x27, 0x85, 0x02, 0x78, 0x30, 0x9F, 
+0x72, 0xDB, 0x11, 0x88, 0x85, 0x09, 0xD4, 0x5B, 
+0x14, 0xCC, 0x41, 0x98, 0x2F, 0x74, 0xE3, 0xC8, 
+0x59, 0x28, 0x62, 0xB2, 0x2D, 0xCE, 0x03, 
+
+0x5A, 0xE7, 0x15, 0x4E, 0x9B, 0xC6, 0x57, 0xBD, 
+0x13, 0x39, 0xD7, 0xDA, 0x9A, 0xBA, 0x06, 0xDA, 
+0x73, 0xEC, 0x64, 0xBF, 0xF4, 0x29, 0xEB, 0x4D, 
+0x6B, 0x9A, 0xA2, 0x0F, 0x77, 0x6F, 0xBC, 0x2B, 
+0xC5, 0x3F, 0xE8, 0xF0, 0x5A, 0x46, 0x88, 0x3C, 
+0x4F, 0xC0, 0x44, 0xE7, 0xAC, 0x89, 0xED, 0xF6, 
+0xF3, 0xF2, 0xE1, 0xB7, 0x24, 0x75, 0xAB, 0x72, 
+0xC1, 0x06, 0x0E, 0x5D, 0x02, 0x5E, 0x57, 0xA6, 
+0xA3, 0x4E, 0x4A, 0xFE, 0x46, 0xF0, 0xB4, 0xDD, 
+0x2F, 0xAB, 0xD6, 0xED, 0x49, 0x68, 0x6B, 0xE3, 
+0x5D, 0xFF, 0x11, 0x67, 0xA0, 0x38, 0xD1, 0xB6, 
+0x6E, 0x98, 0x61, 0x88, 0xA5, 0x60, 0x3E, 0x74, 
+0x3C, 0xE7, 0x4C, 0x5E, 0xE3, 0x1B, 0x8D, 0x3D, 
+0x17, 0x36, 0xE8, 0x65, 0xCA, 0x87, 0xE4, 0x0D, 
+
+0x4B, 0xA0, 0x49, 0xB0, 0xEE, 0xB4, 0xED, 0x67, 
+0x08, 0x08, 0x9D, 0xBB, 0x9F, 0xD8, 0x79, 0xE8, 
+0x42, 0x71, 0x81, 0xDA, 0x5E, 0x8D, 0x90, 0x1B, 
+0x4C, 0x9E, 0x71, 0xCF, 0x46, 0xEB, 0xE7, 0x14, 
+0x96, 0x98, 0xA5, 0xDB, 0xFA, 0x35, 0x99, 0x4C, 
+0x40, 0x21, 0x5D, 0xF8, 0xB5, 0xC5, 0xAE, 0xE5, 
+0x29, 0x68, 0x5F, 0x4A, 0xE9, 0x1D, 0x15, 0x55, 
+0xF1, 0x4F, 0x74, 0xD4, 0x15, 0xB3, 0x7D, 0x9F, 
+0x37, 0xC8, 0xC8, 0xAF, 0xF1, 0xF0, 0x8D, 0xC4, 
+0xF8, 0x36, 0xF0, 0x7A, 0xB9, 0xE6, 0xB8, 0xA9, 
+0x66, 0x10, 0xDF, 0xFE, 0x16, 0x7C, 0x8E, 0xDE, 
+0x57, 0x0C, 0x75, 0xBF, 0x67, 0x8C, 0x39, 0x49, 
+0xF1, 0xB7, 0x8A, 0x6D, 0x77, 0xCE, 0x7A, 0x02, 
+0x6D, 0xB3, 0x24, 0x2C, 0xBF, 0x29, 0x9B, 0xEE, 
+0x4E, 
+
+0xB0, 0x44, 0xEA, 0xAC, 0x35, 0x51, 0x2D, 0x29, 
+0x50, 0x73, 0x14, 0xC6, 0x5A, 0x7B, 0x9F, 0xD2, 
+0x75, 0xE5, 0xE0, 0xE7, 0x66, 0x8A, 0xCB, 0x54, 
+0x1D, 0xC4, 0x8A, 0x25, 0x0C, 0x7D, 0xA1, 0xA1, 
+0xBA, 0x4E, 0x24, 0xB6, 0xD1, 0xF8, 0x8A, 0x9E, 
+0x49, 0xD7, 0xCA, 0x7C, 0x7D, 0x83, 0xDE, 0x3E, 
+0xED, 0x6E, 0x26, 0x34, 0x57, 0x6F, 0x00, 0x55, 
+0xB9, 0x44, 0x54, 0x82, 0x9B, 0x9A, 0x32, 0xDA, 
+0xBB, 0x59, 0x48, 0x80, 0x08, 0x96, 0x2D, 0x34, 
+0x68, 0x40, 0x27, 0xB9, 0x00, 0x80, 0xC6, 0x6F, 
+0x42, 0x72, 0x3C, 0x9B, 0xD1, 0xA0, 0x01, 0xCC, 
+0xD2, 0x0F, 0x33, 0x00, 0x15, 0x6A, 0x65, 0x9B, 
+0xED, 0x91, 0x91, 0xAF, 0xE1, 0x10, 0x7F, 0x9F, 
+0xF1, 0x61, 0xAB, 0x51, 0x7A, 0x1A, 0x51, 0xB3, 
+0xF2, 0x20, 
+
+0x2A, 0x34, 0xC2, 0x7C, 0xAA, 0x2B, 0xA1, 0xC0, 
+0x1A, 0xF5, 0xA8, 0xBC, 0xB8, 0x1E, 0x17, 0x9D, 
+0xE3, 0x4E, 0x65, 0xB4, 0x4E, 0x9A, 0xB7, 0x6C, 
+0xDD, 0x1E, 0x86, 0xA0, 0x68, 0x4D, 0x83, 0xB7, 
+0x06, 0xD3, 0x5E, 0xAC, 0xB0, 0x9F, 0x00, 0x00, 
+0x62, 0xD2, 0xCD, 0x67, 0x0C, 0x2D, 0xA9, 0x25, 
+0xC1, 0x6E, 0xA8, 0xB0, 0x0E, 0xCF, 0xD9, 0x1D, 
+0x5D, 0x5A, 0x04, 0xD9, 0xEA, 0x3F, 0x57, 0xE1, 
+0x25, 0x19, 0xF4, 0x9D, 0x3C, 0x7D, 0xE0, 0x65, 
+0x98, 0xF2, 0xD1, 0x92, 0xCA, 0x8E, 0x97, 0x6B, 
+0x61, 0x58, 0x2B, 0xB4, 0x2A, 0xB4, 0x7A, 0x2A, 
+0xEC, 0x93, 0xC4, 0xCB, 0x54, 0x58, 0x96, 0xD1, 
+0x9E, 0x90, 0xA2, 0x39, 0x43, 0x1F, 0xE7, 0x72, 
+0x8A, 0x8D, 0xB3, 0x10, 0xB7, 0xCB, 0xAF, 0x15, 
+0xB0, 0xB8, 0xEC, 
+
+0xB5, 0x42, 0x48, 0x28, 0x65, 0x88, 0xE8, 0xB7, 
+0xB6, 0xCD, 0xAB, 0x9B, 0x31, 0xFE, 0xEC, 0xD7, 
+0xBF, 0x43, 0x1B, 0x30, 0x75, 0x57, 0x96, 0x3E, 
+0x34, 0x5C, 0xAA, 0x37, 0x83, 0x1C, 0xA3, 0x08, 
+0x88, 0x31, 0xA0, 0x96, 0x40, 0x50, 0x7D, 0x5C, 
+0x26, 0x66, 0x8D, 0x71, 0x95, 0xE0, 0xB8, 0x83, 
+0x90, 0x8D, 0x9B, 0xBF, 0x85, 0x67, 0x08, 0x88, 
+0x9F, 0x96, 0xC4, 0x31, 0xEC, 0xED, 0x6E, 0x9A, 
+0x7E, 0x62, 0x20, 0x9A, 0xD2, 0xC6, 0xEA, 0x6B, 
+0x65, 0xB2, 0xC5, 0xBB, 0xD3, 0x82, 0xC0, 0x0D, 
+0xF6, 0x37, 0x3F, 0xAB, 0x88, 0xFA, 0x7E, 0xFC, 
+0x4D, 0xE4, 0x83, 0x32, 0x8F, 0x3A, 0xFF, 0xAC, 
+0x12, 0x81, 0xFC, 0x49, 0xE8, 0xF5, 0xFE, 0xDB, 
+0x4F, 0x4A, 0x12, 0x1C, 0x10, 0x2C, 0x22, 0x9A, 
+0x86, 0x06, 0x5C, 0xF6, 
+
+0x5A, 0xD9, 0x35, 0xE0, 0x3E, 0x20, 0xB9, 0x83, 
+0x04, 0x20, 0xA8, 0xF2, 0x87, 0xCF, 0x8D, 0x4F, 
+
+0x0F, 0x53, 0xE4, 0xDC, 0xBD, 0xF0, 0xB8, 0x52, 
+0x09, 0x15, 0x17, 0x0C, 0xA6, 0x21, 0x0B, 0x39, 
+0xE2, 
+
+0x48, 0xA5, 0x91, 0x68, 0x5E, 0x7C, 0x7B, 0xBC, 
+0xBE, 0xBD, 0x18, 0x24, 0x4B, 0x2F, 0x35, 0x9C, 
+0xF0, 0xE5, 
+
+0x63, 0x82, 0x97, 0xBB, 0x93, 0x3B, 0x2C, 0xB2, 
+0xE6, 0x10, 0x40, 0x12, 0xA8, 0xF0, 0xD4, 0x81, 
+0x02, 0xE0, 0x65, 
+
+0x18, 0xB2, 0xD0, 0xF4, 0x97, 0x80, 0x99, 0x0D, 
+0x65, 0xE4, 0xB2, 0x23, 0x43, 0x6B, 0x9A, 0x72, 
+0x57, 0xAD, 0xEE, 0xA1, 
+
+0x96, 0x16, 0x23, 0x5B, 0x30, 0xA2, 0x67, 0xC9, 
+0x85, 0xC3, 0x32, 0xD3, 0x30, 0x47, 0x0C, 0x26, 
+0x08, 0xAF, 0xB8, 0xAF, 0x1F, 
+
+0x36, 0x9A, 0x46, 0xA7, 0xD8, 0x92, 0xE0, 0x73, 
+0x08, 0xBA, 0xC6, 0xAE, 0xBC, 0xFD, 0x4B, 0x4C, 
+0x44, 0x7A, 0xBC, 0x79, 0xD3, 0xA4, 
+
+0x78, 0x66, 0x40, 0x07, 0x32, 0x18, 0x83, 0x30, 
+0x44, 0x05, 0x4A, 0xD3, 0x67, 0x00, 0x7A, 0x00, 
+0xD8, 0xF1, 0xD2, 0x13, 0x6C, 0xAF, 0x3F, 
+
+0x99, 0xC8, 0x1F, 0x50, 0x51, 0xE0, 0xCA, 0x64, 
+0xC2, 0x9C, 0x06, 0x84, 0x11, 0xEB, 0x4F, 0xEE, 
+0xEE, 0x5C, 0x79, 0x9B, 0x3B, 0xB6, 0x09, 0x94, 
+
+0x80, 0x5F, 0x0F, 0xB1, 0x39, 0x5B, 0xF6, 0x33, 
+0xF7, 0x9F, 0xBD, 0xFA, 0x21, 0xC9, 0x30, 0xB0, 
+0xF3, 0x20, 0x3F, 0x26, 0x77, 0x0E, 0xAB, 0xD9, 
+0x18, 
+
+0xFC, 0xF4, 0xBD, 0x0F, 0xE9, 0x09, 0xA4, 0xCE, 
+0x31, 0x2D, 0x57, 0x01, 0xA3, 0xE1, 0x26, 0x73, 
+0xEC, 0xC2, 0x26, 0xD9, 0xE2, 0x2C, 0xF1, 0x1E, 
+0xA9, 0x08, 
+
+0x41, 0x62, 0x0B, 0xC6, 0xC6, 0x43, 0xE4, 0x2D, 
+0x36, 0x29, 0x87, 0xD7, 0xAC, 0x84, 0xD4, 0x54, 
+0xB2, 0x84, 0x52, 0xE9, 0x13, 0xE9, 0x6F, 0x27, 
+0x97, 0xE7, 0x24, 
+
+0xDB, 0xFD, 0x65, 0xEB, 0x8C, 0xA7, 0x97, 0xE5, 
+0xC7, 0xF4, 0x2E, 0xD4, 0xB2, 0xCD, 0xA2, 0x6D, 
+0x2F, 0x85, 0xF8, 0xF6, 0xD4, 0x36, 0xE8, 0xA9, 
+0xA9, 0x4A, 0xAB, 0x14, 
+
+0x7C, 0x3F, 0x27, 0xDE, 0xAF, 0xE9, 0x72, 0x9C, 
+0x04, 0xDA, 0xB4, 0xBB, 0xE6, 0xD1, 0xFA, 0xE8, 
+0xFF, 0xBC, 0x9D, 0x1E, 0x34, 0x2C, 0xF4, 0x67, 
+0xCA, 0x04, 0x67, 0x50, 0x9F, 
+
+0xF2, 0xB0, 0xFC, 0xD2, 0x54, 0x85, 0x18, 0xD4, 
+0x31, 0x7A, 0x3E, 0xD5, 0xDD, 0x8D, 0xB6, 0x5A, 
+0xAC, 0xE6, 0x59, 0x74, 0x44, 0x94, 0xE4, 0x3D, 
+0x49, 0x77, 0x62, 0x28, 0x75, 0xF7, 
+
+0x01, 0x0B, 0xD7, 0x6A, 0x19, 0xF8, 0xD2, 0x4A, 
+0xD6, 0xC8, 0xFA, 0x05, 0x69, 0x4A, 0x18, 0x24, 
+0xB0, 0x3C, 0x32, 0x33, 0x21, 0x72, 0xD0, 0x71, 
+0x52, 0xAC, 0xDA, 0xA0, 0x87, 0x39, 0x2D, 
+
+0xCB, 0xF5, 0x44, 0xA5, 0x45, 0x49, 0x7D, 0x58, 
+0xA6, 0x10, 0x4B, 0x4E, 0x81, 0xF2, 0x15, 0x21, 
+0x20, 0xCC, 0x84, 0x8A, 0x97, 0x69, 0xD8, 0x08, 
+0x9B, 0x0D, 0x87, 0xC5, 0x4E, 0xB7, 0x73, 0xA3, 
+
+0xF3, 0x24, 0x87, 0xDA, 0x52, 0xDC, 0x22, 0x0A, 
+0xE1, 0x8E, 0x6A, 0x1A, 0xA6, 0x6C, 0x16, 0x48, 
+0xFD, 0x97, 0x69, 0x3C, 0x3E, 0x76, 0xCD, 0x5F, 
+0x68, 0x62, 0x1C, 0x17, 0x50, 0x7A, 0x37, 0x67, 
+0x42, 
+
+0x18, 0xDD, 0xC6, 0xD7, 0x31, 0x4C, 0x44, 0xF5, 
+0x63, 0x56, 0x9C, 0xDF, 0xDC, 0x2D, 0x25, 0x5A, 
+0xBF, 0x0C, 0x03, 0x25, 0x95, 0xE4, 0x7B, 0xF3, 
+0xFB, 0xEB, 0x7D, 0xEF, 0x6C, 0xA1, 0x87, 0x5A, 
+0x38, 0xC0, 
+
+0x0A, 0x2A, 0x5B, 0x2B, 0x5E, 0xA4, 0x2B, 0x40, 
+0x5E, 0x95, 0x70, 0x40, 0x23, 0x66, 0xD1, 0x1A, 
+0x27, 0x79, 0x3F, 0x3F, 0xC9, 0x23, 0xB1, 0xCE, 
+0x0F, 0xDF, 0x35, 0xA5, 0xB0, 0x8A, 0x77, 0xD6, 
+0x32, 0x04, 0x87, 
+
+0x66, 0xF1, 0x58, 0xCA, 0xD3, 0x81, 0x9F, 0x85, 
+0x1D, 0x6F, 0xA2, 0xB5, 0xCB, 0x77, 0x12, 0x4C, 
+0x41, 0xF2, 0x93, 0xED, 0xA2, 0xC9, 0xB3, 0x34, 
+0xE1, 0xBC, 0x67, 0x96, 0x84, 0x9A, 0x2A, 0xB8, 
+0x0D, 0x91, 0x0D, 0xB9, 
+
+0x61, 0x61, 0x14, 0x68, 0xE4, 0x54, 0x04, 0x1F, 
+0xB4, 0x42, 0x87, 0x44, 0x7B, 0x01, 0xC6, 0xD6, 
+0xF9, 0xBC, 0xE5, 0x1D, 0x25, 0x43, 0xE6, 0x2E, 
+0x86, 0x52, 0x06, 0x58, 0x82, 0x57, 0x30, 0xA9, 
+0x75, 0x7D, 0xD6, 0xAB, 0x39, 
+
+0xAE, 0x78, 0x23, 0x96, 0x97, 0xE1, 0x6D, 0x02, 
+0x31, 0xA9, 0x56, 0xBA, 0xDC, 0xA4, 0xDB, 0xD5, 
+0x5F, 0x37, 0x26, 0xF8, 0xED, 0x78, 0xF4, 0x8F, 
+0x9A, 0x07, 0xB2, 0x19, 0xC9, 0x03, 0xB1, 0x39, 
+0xA8, 0x61, 0x60, 0x96, 0x83, 0x2F, 
+
+0x36, 0xEF, 0xE2, 0x4F, 0xAE, 0x4C, 0xB1, 0xB3, 
+0xB8, 0x22, 0x91, 0xB6, 0xC5, 0x31, 0x60, 0xFF, 
+0x7B, 0x00, 0x71, 0x12, 0xAA, 0x5A, 0xD2, 0x9A, 
+0xE7, 0xB8, 0x57, 0x95, 0x26, 0x02, 0xEF, 0x10, 
+0xE9, 0x76, 0x33, 0x72, 0x72, 0x8E, 0x67, 
+
+0x28, 0x3B, 0xFC, 0xF7, 0x0B, 0xE0, 0x62, 0x2F, 
+0xB3, 0x2E, 0x10, 0xD3, 0x78, 0x0C, 0x33, 0x6D, 
+0xD7, 0xA8, 0xB2, 0xF7, 0x1C, 0x6C, 0xBA, 0xD0, 
+0xB0, 0x29, 0x86, 0x52, 0xAF, 0x1F, 0x18, 0xA5, 
+0x3D, 0x52, 0xFF, 0x1C, 0xB9, 0xEF, 0xE6, 0xD4, 
+
+0xB9, 0xED, 0x83, 0x89, 0xD5, 0x73, 0x97, 0x72, 
+0x3A, 0xF3, 0x32, 0x9A, 0xC4, 0xE0, 0x80, 0x5B, 
+0xA3, 0x2E, 0x18, 0x82, 0xD9, 0x6D, 0xDC, 0xA3, 
+0x4F, 0x01, 0x8A, 0x1C, 0x73, 0x8F, 0x45, 0x1F, 
+0x62, 0xA7, 0x8A, 0xCA, 0x85, 0x87, 0xD0, 0xAD, 
+0x7D, 
+
+0xA1, 0x11, 0x6A, 0x04, 0xA8, 0x55, 0xB1, 0x04, 
+0xA8, 0x6F, 0x55, 0x85, 0xE9, 0xF1, 0x0D, 0x6F, 
+0xDD, 0x04, 0x10, 0x3C, 0xB3, 0xAD, 0xD2, 0x9E, 
+0xE7, 0x1E, 0x9A, 0x63, 0x08, 0x6A, 0x05, 0xC4, 
+0xF2, 0xB8, 0x1E, 0xB0, 0x72, 0xA6, 0x23, 0x02, 
+0xE2, 0x21, 
+
+0xD5, 0x8C, 0x8D, 0x9B, 0x33, 0xB8, 0x9B, 0xC3, 
+0x15, 0x50, 0xC9, 0xC7, 0x25, 0x8B, 0x36, 0xA6, 
+0x5C, 0x3E, 0x87, 0x59, 0x3F, 0x6B, 0x0A, 0xD8, 
+0x22, 0x39, 0x48, 0xD4, 0xE7, 0x0F, 0x55, 0x0D, 
+0x35, 0x25, 0xFB, 0x2C, 0xFA, 0x0C, 0x73, 0x4E, 
+0x49, 0x76, 0x73, 
+
+0xB0, 0xA8, 0x6B, 0x05, 0x72, 0x86, 0xDA, 0xD2, 
+0x21, 0xDC, 0xC6, 0x3C, 0x0B, 0x47, 0x2E, 0xAA, 
+0x4A, 0x4F, 0x19, 0x7B, 0xAC, 0xEF, 0x6F, 0xA6, 
+0xD4, 0x9D, 0x45, 0xEF, 0x07, 0xB9, 0x8B, 0xAF, 
+0xC5, 0xA2, 0x7D, 0x8A, 0x92, 0xE1, 0xC1, 0xF9, 
+0x49, 0xED, 0x7D, 0x2E, 
+
+0xB6, 0xDF, 0x47, 0x88, 0x2F, 0x70, 0x18, 0x3C, 
+0xB9, 0x72, 0x04, 0x1E, 0x75, 0x5F, 0x45, 0xCA, 
+0xA4, 0xB0, 0xAC, 0x11, 0x1B, 0x65, 0x4B, 0xAE, 
+0xCA, 0x4D, 0xA1, 0xA9, 0x2C, 0xF6, 0x64, 0xC1, 
+0xB0, 0x6F, 0xAF, 0xF7, 0x6E, 0xB0, 0xA3, 0xDF, 
+0x3C, 0x15, 0xDC, 0xAA, 0xA1, 
+
+0x90, 0x2A, 0x6C, 0xF6, 0x1D, 0xD4, 0x36, 0xDD, 
+0xC3, 0x87, 0x2D, 0xE1, 0x68, 0xED, 0x8F, 0x21, 
+0x0E, 0x53, 0xF5, 0x03, 0xE9, 0xA0, 0xD5, 0x3A, 
+0xF9, 0xEF, 0x15, 0xCC, 0x0E, 0xE3, 0x40, 0x7D, 
+0x3D, 0x8C, 0xA9, 0x40, 0x1E, 0x6D, 0x50, 0x5E, 
+0xF5, 0xFE, 0xAF, 0xE3, 0x45, 0xA5, 
+
+0x1F, 0x86, 0xAE, 0xE4, 0xE4, 0xB2, 0x42, 0x60, 
+0x9B, 0xBF, 0x1E, 0xA5, 0x16, 0x65, 0xA0, 0xDD, 
+0xB3, 0xC6, 0xCA, 0xD7, 0xB8, 0xAE, 0x14, 0xCD, 
+0xDF, 0xE9, 0xFF, 0x63, 0x38, 0x87, 0xE8, 0x84, 
+0xE7, 0x79, 0x08, 0x2B, 0x9C, 0x5B, 0x94, 0x23, 
+0x73, 0x3F, 0x5D, 0x4F, 0x34, 0x41, 0x07, 
+
+0xC1, 0x62, 0xCF, 0x2C, 0xBA, 0xC0, 0xC0, 0x84, 
+0x67, 0x4E, 0x96, 0xA2, 0xFF, 0x81, 0xE6, 0x74, 
+0xFA, 0x22, 0xCE, 0x6B, 0xC4, 0x9C, 0x1D, 0x03, 
+0xC0, 0xB1, 0x8B, 0x10, 0x33, 0xC3, 0x1F, 0x61, 
+0x5D, 0x36, 0x65, 0x57, 0x4D, 0x3C, 0x1E, 0x2F, 
+0xA6, 0x6A, 0x98, 0x91, 0xE4, 0x46, 0x8B, 0xD5, 
+
+0x70, 0x64, 0x4F, 0x22, 0x92, 0xCC, 0xBA, 0x70, 
+0x10, 0xB5, 0xF9, 0x5D, 0xF6, 0xBD, 0x95, 0x7A, 
+0x9E, 0xA1, 0xC3, 0x00, 0x77, 0x6A, 0x12, 0x94, 
+0x43, 0xC9, 0x0C, 0xBB, 0xE2, 0xCD, 0xBE, 0xB7, 
+0xF4, 0x48, 0xAE, 0x10, 0x8D, 0x71, 0x80, 0xCF, 
+0x2A, 0x31, 0xDB, 0x01, 0xE7, 0xAA, 0x71, 0x23, 
+0x31, 
+
+0x02, 0xDC, 0x62, 0xA2, 0x98, 0x84, 0x22, 0x1E, 
+0xB7, 0x25, 0x9A, 0xED, 0xDD, 0x60, 0x96, 0x44, 
+0xEB, 0x1B, 0xC6, 0x9A, 0xEE, 0x76, 0x1B, 0x67, 
+0xF4, 0x66, 0xE8, 0x63, 0x55, 0x3F, 0x6F, 0xB9, 
+0x35, 0xAE, 0xE3, 0x3E, 0xF3, 0x85, 0x47, 0x2C, 
+0x80, 0xA4, 0xFA, 0x1C, 0x2A, 0xCF, 0x5E, 0x7F, 
+0xC8, 0x93, 
+
+0xDF, 0x62, 0x81, 0xFF, 0x5B, 0x88, 0x34, 0xB3, 
+0xA9, 0x4A, 0xA6, 0xD9, 0x08, 0x48, 0x65, 0x7D, 
+0xFC, 0x07, 0x56, 0xD8, 0xF5, 0xBA, 0x69, 0xD2, 
+0xF8, 0x68, 0xAD, 0xDB, 0x14, 0x6F, 0x3F, 0x27, 
+0x0B, 0x1D, 0x14, 0x6B, 0x5D, 0x13, 0xCB, 0x70, 
+0x05, 0xD6, 0x38, 0x9F, 0x79, 0x69, 0xFE, 0xCD, 
+0x6D, 0xAA, 0x2B, 
+
+0x1C, 0x73, 0x21, 0xCA, 0xB9, 0x20, 0x75, 0xEF, 
+0xF4, 0x2B, 0xA5, 0xE5, 0x66, 0x62, 0xF3, 0x31, 
+0x61, 0x92, 0x8B, 0x34, 0x44, 0x67, 0xC5, 0xAD, 
+0xC1, 0x56, 0xC3, 0xB7, 0x23, 0xC6, 0x2B, 0xB1, 
+0x34, 0x5F, 0xEF, 0xF7, 0xF1, 0x1D, 0x6A, 0x0A, 
+0x4D, 0xA6, 0x3D, 0x56, 0x0A, 0x73, 0xC8, 0xC6, 
+0xB8, 0x55, 0x50, 0xBD, 
+
+0x10, 0x07, 0x49, 0x6C, 0x11, 0x44, 0xEE, 0xFA, 
+0x41, 0x19, 0xFA, 0xD1, 0x7D, 0xEC, 0xD2, 0x8F, 
+0xA2, 0xBC, 0xB5, 0x38, 0x85, 0xD5, 0x4B, 0x79, 
+0xFB, 0x77, 0x69, 0x42, 0x65, 0xEC, 0x29, 0x04, 
+0x24, 0xCF, 0x51, 0xE1, 0xC2, 0x00, 0x09, 0x61, 
+0x2A, 0x5D, 0xF6, 0xBF, 0x5B, 0xCC, 0x90, 0xDF, 
+0xF4, 0xCF, 0xE8, 0xA4, 0x13, 
+
+0xAC, 0x14, 0x1B, 0x69, 0xEE, 0x01, 0x0E, 0xE8, 
+0x55, 0x78, 0x58, 0x91, 0xBE, 0xB4, 0x31, 0xCD, 
+0x3D, 0xBE, 0x2F, 0x30, 0x81, 0x9D, 0x5F, 0x3A, 
+0x65, 0x49, 0x1E, 0x24, 0x75, 0x38, 0xEA, 0x0F, 
+0x45, 0x88, 0x6F, 0x66, 0x98, 0x56, 0xE0, 0xCF, 
+0xB3, 0xD3, 0x47, 0x72, 0x15, 0x5C, 0xDC, 0x99, 
+0x16, 0x7D, 0x7F, 0x2A, 0xBA, 0xC8, 
+
+0x90, 0x0A, 0xF6, 0x05, 0x11, 0x92, 0x82, 0x59, 
+0xA1, 0x31, 0x54, 0xE8, 0x93, 0x6C, 0xE5, 0x4B, 
+0xE8, 0x6C, 0x14, 0x80, 0xFB, 0x5F, 0xB5, 0x52, 
+0xAA, 0x4A, 0x04, 0x64, 0xC5, 0xC5, 0x03, 0x2C, 
+0x2D, 0xB1, 0x6F, 0xDC, 0xA0, 0x74, 0xFF, 0xC5, 
+0x12, 0xE5, 0xA3, 0x35, 0x84, 0x3C, 0xC8, 0x6D, 
+0xC4, 0x07, 0x92, 0x51, 0x12, 0xE7, 0x6F, 
+
+0x49, 0x06, 0x7D, 0xD6, 0xE2, 0xC8, 0x54, 0x45, 
+0x41, 0x97, 0x98, 0x29, 0xB5, 0x49, 0x3C, 0xE2, 
+0x29, 0xC1, 0x87, 0xAC, 0x4A, 0x88, 0x31, 0x54, 
+0x12, 0xDF, 0x6F, 0x7C, 0x88, 0xD4, 0x5A, 0x69, 
+0xEF, 0x89, 0xB7, 0x26, 0xBD, 0x5D, 0x14, 0x45, 
+0xC3, 0xC5, 0x39, 0xF2, 0x5C, 0xB2, 0x72, 0x0F, 
+0xEC, 0xE5, 0xEB, 0xD7, 0x6F, 0xC8, 0x87, 0xA2, 
+
+0x37, 0x0D, 0xA6, 0xE9, 0x34, 0x06, 0x17, 0x74, 
+0x20, 0x52, 0x8E, 0x5B, 0xC2, 0x71, 0x8D, 0x6E, 
+0x3C, 0xAA, 0xAA, 0xF9, 0x7F, 0x82, 0x09, 0x74, 
+0x29, 0xCF, 0x79, 0xC1, 0xF7, 0x42, 0xB8, 0xA9, 
+0x36, 0xA5, 0xFF, 0x77, 0x15, 0x09, 0x0B, 0x04, 
+0x7E, 0x2A, 0xE6, 0xD1, 0xBB, 0xAE, 0x3B, 0x07, 
+0xEB, 0xEA, 0x0B, 0x92, 0xA2, 0x9C, 0x27, 0x71, 
+0x39, 
+
+0xCE, 0xFA, 0x4C, 0xBD, 0xCA, 0xBC, 0x3A, 0xC0, 
+0x4B, 0x91, 0x3F, 0x33, 0x19, 0x46, 0x05, 0x16, 
+0x80, 0xC0, 0xE2, 0x63, 0xB0, 0xE0, 0xD5, 0x2B, 
+0xCE, 0xBA, 0x01, 0x36, 0x5B, 0xCD, 0x68, 0x17, 
+0xCF, 0xEC, 0x0A, 0x31, 0x45, 0x36, 0x1B, 0x28, 
+0xB1, 0x85, 0x73, 0x20, 0xF4, 0x0F, 0xC6, 0xCB, 
+0xA1, 0xFE, 0x09, 0xA4, 0x0B, 0xB4, 0x6E, 0xD2, 
+0x86, 0xA3, 
+
+0x80, 0x25, 0xA8, 0x28, 0x34, 0x43, 0x8F, 0xDB, 
+0xE4, 0xB8, 0x7A, 0x84, 0xE3, 0x12, 0x2E, 0xD3, 
+0x28, 0x14, 0xFE, 0x42, 0x90, 0x95, 0x26, 0x2B, 
+0xA1, 0x19, 0x69, 0x23, 0xCF, 0x9D, 0x91, 0xF4, 
+0xD4, 0xF3, 0x3A, 0xCF, 0xA0, 0x61, 0x3E, 0x91, 
+0xBF, 0xEE, 0x5B, 0x14, 0xB2, 0xB1, 0x52, 0x79, 
+0x4E, 0x71, 0x6A, 0x0A, 0x21, 0x3A, 0xA0, 0xC7, 
+0x96, 0x59, 0xC0, 
+
+0x69, 0xB0, 0x3E, 0x4F, 0x95, 0x66, 0xFD, 0x32, 
+0x6D, 0x68, 0xF4, 0xDD, 0x78, 0x1D, 0x54, 0x43, 
+0xD1, 0xB2, 0x50, 0x71, 0x1C, 0x48, 0xDB, 0x8E, 
+0xE0, 0x58, 0x1A, 0x89, 0xE0, 0x37, 0x64, 0x76, 
+0x69, 0x85, 0xB9, 0x34, 0x82, 0xA5, 0x57, 0xAE, 
+0x2A, 0x03, 0x00, 0x9B, 0xF2, 0xE9, 0xA1, 0x2B, 
+0xE8, 0x1F, 0xD1, 0xC3, 0xEB, 0x9B, 0xDC, 0x25, 
+0x6D, 0x88, 0x96, 0x6D, 
+
+0x84, 0x6D, 0xEF, 0xF2, 0x61, 0x96, 0xD3, 0x49, 
+0x3A, 0x6E, 0x30, 0x46, 0xB9, 0x56, 0x8A, 0x81, 
+0xC0, 0xCB, 0xDF, 0x5B, 0xCD, 0x5A, 0xAE, 0xF1, 
+0xA1, 0x10, 0xE7, 0x03, 0x9A, 0xAE, 0x61, 0x16, 
+0x2F, 0xD0, 0x0C, 0x26, 0x7A, 0x96, 0x8E, 0x3A, 
+0x97, 0x8A, 0x0A, 0xC9, 0x90, 0x0B, 0x51, 0x8F, 
+0x72, 0xF0, 0x4E, 0xA9, 0xCF, 0x6E, 0x90, 0x14, 
+0x23, 0xE4, 0x2B, 0xBD, 0x75, 
+
+0xB3, 0x36, 0xBC, 0x71, 0x8B, 0x9A, 0x93, 0x81, 
+0x95, 0xD8, 0x5E, 0xF8, 0xDA, 0x56, 0x9E, 0xDB, 
+0xF2, 0xBE, 0xFE, 0xB0, 0x57, 0xA6, 0x85, 0x48, 
+0x59, 0x72, 0xEF, 0xB4, 0xF2, 0xFD, 0x5E, 0x98, 
+0x9C, 0x74, 0x84, 0x52, 0xED, 0x88, 0xFA, 0x97, 
+0x5C, 0x91, 0x4E, 0x34, 0x92, 0xD3, 0x8E, 0xF9, 
+0xDE, 0xDB, 0x12, 0xA1, 0x78, 0x96, 0xBD, 0x9C, 
+0x96, 0xFF, 0xFE, 0xA9, 0x03, 0x51, 
+
+0x3C, 0xD8, 0x11, 0xEC, 0xC7, 0xBB, 0xA6, 0x7C, 
+0x7F, 0x06, 0x4F, 0x7E, 0xBF, 0x3F, 0xA1, 0x96, 
+0x65, 0x75, 0xC4, 0xDC, 0x2C, 0x2D, 0x89, 0x6D, 
+0x75, 0xCB, 0xDD, 0xB2, 0xF9, 0x4F, 0x1B, 0x4C, 
+0xE7, 0xCA, 0x02, 0xA5, 0x64, 0x6A, 0xEE, 0xEF, 
+0x0B, 0xDF, 0xE8, 0x79, 0x2C, 0xBA, 0x0F, 0x14, 
+0xF8, 0x3F, 0xD3, 0x9D, 0x15, 0x27, 0x3C, 0x84, 
+0x29, 0x17, 0xFA, 0xBC, 0xCD, 0x46, 0x5B, 
+
+0xA8, 0xE6, 0xE9, 0xEB, 0x5A, 0xE1, 0xDE, 0x41, 
+0xE9, 0x3E, 0x93, 0x29, 0xA3, 0x8C, 0x26, 0x4C, 
+0x89, 0x01, 0x48, 0x46, 0x39, 0xB7, 0x49, 0x8D, 
+0xAD, 0xDB, 0x76, 0x22, 0x74, 0x1C, 0x49, 0x79, 
+0x61, 0x36, 0x56, 0x16, 0x10, 0x20, 0x68, 0x5E, 
+0xBF, 0x1A, 0x25, 0x87, 0x7F, 0xC6, 0xB8, 0x17, 
+0xBC, 0x5F, 0xF6, 0x09, 0x56, 0x0B, 0x6F, 0x24, 
+0x94, 0x30, 0x65, 0xD8, 0x59, 0x36, 0xB3, 0x5D, 
+
+0xDB, 0xE7, 0xA9, 0x45, 0xFF, 0x17, 0xA2, 0xCE, 
+0xC3, 0x98, 0x34, 0xD0, 0x2F, 0xC2, 0xC1, 0xAE, 
+0x0A, 0xC0, 0x0B, 0xFF, 0x6A, 0x0D, 0xA1, 0xAB, 
+0xDA, 0x0B, 0x58, 0xA0, 0x85, 0xFC, 0xF1, 0xC7, 
+0x53, 0x09, 0x22, 0x4E, 0xBC, 0x0D, 0x32, 0x83, 
+0x2D, 0xF9, 0x06, 0x38, 0x18, 0x55, 0xC3, 0x6D, 
+0x79, 0x9B, 0x1D, 0xF3, 0x29, 0xD3, 0xA5, 0x16, 
+0xAE, 0x42, 0x77, 0x79, 0x55, 0xFA, 0xC4, 0xF9, 
+0x32, 
+
+0x7E, 0xF5, 0xA3, 0x08, 0x91, 0x76, 0x3F, 0x65, 
+0x90, 0xBF, 0xA2, 0xD7, 0x38, 0x6B, 0x41, 0x4E, 
+0xC7, 0xD5, 0xBE, 0xEC, 0x60, 0xC5, 0x80, 0x3B, 
+0xD6, 0x7A, 0x45, 0x4A, 0x44, 0x0F, 0xA1, 0x28, 
+0xB5, 0x5C, 0x58, 0xB4, 0x2F, 0x56, 0xD3, 0xB9, 
+0x43, 0x49, 0x09, 0x5E, 0xA6, 0x50, 0xFE, 0xCF, 
+0x6A, 0x1D, 0x99, 0x58, 0xBE, 0x3F, 0x9E, 0x5C, 
+0x4F, 0xD2, 0x29, 0xDF, 0x54, 0x05, 0x38, 0x8F, 
+0x49, 0xC0, 
+
+0x56, 0x28, 0xAF, 0x54, 0xEA, 0x3E, 0xF7, 0xCF, 
+0xB9, 0x44, 0xE1, 0xB6, 0x1D, 0x0D, 0xE5, 0x3F, 
+0xDF, 0x47, 0x9D, 0xE4, 0x94, 0xA0, 0xBF, 0x0E, 
+0xE1, 0xC1, 0x13, 0x73, 0x0F, 0x23, 0xB1, 0xBE, 
+0xC1, 0x19, 0xD5, 0x4E, 0x0D, 0x19, 0xA0, 0xD5, 
+0x20, 0xDD, 0xEA, 0xA9, 0x05, 0x27, 0xF7, 0x8C, 
+0x3C, 0xB3, 0x18, 0x15, 0xCF, 0xE3, 0xDE, 0xA4, 
+0xB4, 0xFD, 0xC5, 0xF4, 0xE6, 0xBB, 0x0B, 0x4D, 
+0x37, 0xEF, 0xD0, 
+
+0x7B, 0x15, 0x4A, 0xEF, 0x0F, 0xD6, 0x75, 0xB7, 
+0x1B, 0x32, 0x8B, 0x31, 0xC1, 0xCD, 0x3A, 0xF8, 
+0x7F, 0x2F, 0xA5, 0xBD, 0x7C, 0x5C, 0x43, 0x82, 
+0x4A, 0x01, 0x3B, 0x50, 0x59, 0x74, 0xB5, 0x61, 
+0x40, 0x3B, 0xC0, 0xF3, 0xA1, 0x3E, 0x75, 0x36, 
+0x85, 0x74, 0xC3, 0xB3, 0x55, 0xB6, 0xAB, 0xFC, 
+0xE9, 0x36, 0x9E, 0x01, 0x54, 0x8E, 0xF4, 0x7F, 
+0xD1, 0xFF, 0x51, 0x21, 0x28, 0x0F, 0x4E, 0x7E, 
+0x5A, 0xFD, 0xEE, 0xA6, 
+
+0xAF, 0xB6, 0xBB, 0x4A, 0x9E, 0x62, 0xFB, 0xD7, 
+0xDA, 0x69, 0xA1, 0x60, 0x20, 0x32, 0xE6, 0x56, 
+0xA1, 0x6F, 0x37, 0xCA, 0x2D, 0x23, 0xBC, 0x39, 
+0xED, 0xEF, 0xB7, 0xEE, 0x47, 0x4E, 0xCB, 0x46, 
+0xD3, 0xCA, 0x19, 0xF6, 0x58, 0x4C, 0x31, 0x2B, 
+0x65, 0xD6, 0xBC, 0x74, 0x21, 0x05, 0xD7, 0x99, 
+0x96, 0x8F, 0x47, 0xE4, 0xD8, 0xF6, 0x17, 0x4C, 
+0xA2, 0xEE, 0xD1, 0xFB, 0x8E, 0x53, 0xA0, 0x9A, 
+0xED, 0x6C, 0x3F, 0x99, 0x56, 
+
+0xE6, 0x27, 0x7B, 0xD7, 0x3A, 0x57, 0x4A, 0x57, 
+0x1F, 0x16, 0x60, 0x67, 0xE3, 0xCB, 0x95, 0x54, 
+0x52, 0x0F, 0xD3, 0xF8, 0x2E, 0x4E, 0xFE, 0xAC, 
+0x98, 0x99, 0x14, 0x6E, 0x24, 0x91, 0x5A, 0x2C, 
+0xA1, 0xDE, 0x40, 0xBB, 0x2E, 0x59, 0x23, 0x98, 
+0xCE, 0x15, 0x9A, 0xDC, 0x0D, 0x1B, 0xB2, 0xC4, 
+0x6C, 0xC3, 0x23, 0xBE, 0xCB, 0xF3, 0x15, 0x14, 
+0x57, 0x4C, 0xC5, 0x2E, 0x61, 0x8C, 0x3F, 0xC5, 
+0xA8, 0x21, 0x3F, 0xCD, 0x23, 0x9E, 
+
+0x59, 0xBC, 0xD3, 0x93, 0xD5, 0x73, 0x5C, 0x4F, 
+0xCD, 0x32, 0x8C, 0xA7, 0xEC, 0xB1, 0x92, 0x82, 
+0xFF, 0x08, 0x94, 0xED, 0xCE, 0x65, 0x7E, 0xDD, 
+0x28, 0x65, 0xEA, 0xDB, 0x3B, 0x77, 0x87, 0x41, 
+0x5A, 0xE7, 0xE4, 0x4F, 0x5D, 0xC6, 0x8E, 0xB7, 
+0xD5, 0x83, 0x5A, 0xA4, 0x1B, 0xE5, 0x40, 0x50, 
+0xA1, 0x0E, 0xB3, 0x8F, 0xE3, 0x31, 0x87, 0xE7, 
+0x13, 0xF9, 0xD1, 0xA9, 0xBE, 0x13, 0xBF, 0x98, 
+0x7A, 0x36, 0x10, 0x56, 0xFD, 0x71, 0x58, 
+
+0x06, 0xFA, 0x95, 0x25, 0x62, 0x35, 0x4D, 0x06, 
+0x3F, 0xD2, 0xAE, 0x4E, 0x86, 0x32, 0x08, 0x54, 
+0x78, 0x35, 0xAD, 0x84, 0xE1, 0x6D, 0xB1, 0x5E, 
+0xD0, 0x91, 0x42, 0x02, 0x39, 0x1F, 0x0D, 0x4C, 
+0xE5, 0x4B, 0xDB, 0x9D, 0x1A, 0xE5, 0x05, 0x97, 
+0x90, 0x0F, 0xB4, 0xCD, 0xC5, 0xF7, 0xDA, 0x81, 
+0xC7, 0x22, 0x25, 0xB8, 0xAA, 0xC5, 0xA6, 0x6C, 
+0x8A, 0x6C, 0xDB, 0x25, 0xE6, 0xBD, 0x6E, 0x73, 
+0x1E, 0xE3, 0x8B, 0xB1, 0xFB, 0x66, 0x00, 0xE1, 
+
+0x31, 0xDF, 0xA5, 0xD5, 0xF6, 0x17, 0x19, 0x50, 
+0xA3, 0x0F, 0x26, 0x1C, 0xD4, 0x98, 0xCA, 0xB0, 
+0xDB, 0x0C, 0x2F, 0x8C, 0xCE, 0xCC, 0x56, 0x35, 
+0x57, 0xC2, 0x9D, 0xEF, 0x64, 0x82, 0xE4, 0x8A, 
+0xFA, 0xFE, 0x07, 0xF1, 0xB7, 0x10, 0xDF, 0x56, 
+0x7B, 0x64, 0x88, 0x9C, 0x25, 0xF1, 0x6D, 0x6C, 
+0x5F, 0xD3, 0xC8, 0xC4, 0x7E, 0xD5, 0x26, 0xB8, 
+0xC3, 0x63, 0x4A, 0x9E, 0x1D, 0x7A, 0xE9, 0xB7, 
+0xDD, 0x24, 0x86, 0x30, 0x9B, 0xB8, 0xF5, 0xB4, 
+0x43, 
+
+0xD1, 0x73, 0xAA, 0xD6, 0x76, 0x3F, 0x35, 0xDE, 
+0x77, 0x82, 0x96, 0xFF, 0x1B, 0x34, 0xAD, 0x16, 
+0x77, 0x73, 0xD7, 0x8A, 0x96, 0x19, 0xA0, 0xBA, 
+0x22, 0x71, 0x36, 0x9D, 0xAC, 0x41, 0x14, 0xAB, 
+0xA8, 0x77, 0x34, 0x7A, 0x70, 0x2A, 0xF6, 0xF6, 
+0xEA, 0xFD, 0x6D, 0xDB, 0xAD, 0x65, 0x14, 0x3A, 
+0xEA, 0x0E, 0x2C, 0xC4, 0xC1, 0x13, 0x7D, 0x59, 
+0x8D, 0xE8, 0x58, 0x0A, 0xE6, 0x5B, 0xEB, 0xEE, 
+0x90, 0x03, 0x95, 0x23, 0xC7, 0xCC, 0x62, 0x25, 
+0xC1, 0x20, 
+
+0xC7, 0x9C, 0x57, 0x9D, 0xE5, 0x46, 0x4F, 0xC1, 
+0x4A, 0x03, 0x63, 0x2E, 0x35, 0x1B, 0x35, 0x9D, 
+0xD3, 0xA7, 0xB5, 0xDC, 0xCD, 0x58, 0xEB, 0x25, 
+0x52, 0x9E, 0xC0, 0xB3, 0x19, 0x85, 0x11, 0x65, 
+0x14, 0x8A, 0x27, 0x0F, 0xDD, 0xB5, 0x06, 0xCE, 
+0x9A, 0x1A, 0xD8, 0xB5, 0x07, 0x63, 0x24, 0x5F, 
+0xD2, 0xBC, 0x79, 0xB8, 0x62, 0x45, 0x10, 0xBC, 
+0x65, 0x00, 0x22, 0x99, 0x8B, 0x2A, 0xCC, 0x60, 
+0x93, 0xB8, 0x9B, 0xF7, 0x74, 0x0C, 0x04, 0xA1, 
+0x35, 0x65, 0x42, 
+
+0xC7, 0x84, 0x16, 0x76, 0x54, 0xAE, 0x96, 0x80, 
+0x66, 0x9E, 0xCF, 0xB2, 0x49, 0x57, 0x8B, 0x32, 
+0xDA, 0x1C, 0xCD, 0xD1, 0xA7, 0x44, 0x52, 0x19, 
+0x5A, 0x77, 0x8D, 0x9D, 0x89, 0xC4, 0x84, 0xD0, 
+0x2D, 0xFB, 0x8D, 0xB5, 0xCD, 0x63, 0x48, 0x6E, 
+0x18, 0x49, 0x1B, 0xFA, 0x48, 0xD5, 0x32, 0xBB, 
+0x85, 0x0A, 0x90, 0xBC, 0x6C, 0xAF, 0x5D, 0x6F, 
+0xAC, 0x34, 0xF9, 0xC6, 0x9A, 0x8D, 0xD7, 0x52, 
+0xD3, 0xC7, 0x57, 0x3C, 0x6C, 0xE3, 0xFA, 0x48, 
+0x9C, 0x31, 0xB7, 0x8D, 
+
+0xCE, 0x61, 0xD7, 0xE9, 0x85, 0xCF, 0xEF, 0xDC, 
+0x2D, 0x75, 0x04, 0x60, 0x76, 0xF1, 0x4E, 0xE9, 
+0x72, 0xD3, 0x2E, 0x6A, 0x5A, 0x81, 0xB0, 0xAF, 
+0x29, 0x05, 0xAF, 0x9C, 0xEF, 0xF1, 0xF3, 0x96, 
+0x97, 0x10, 0xCA, 0x15, 0xA9, 0x73, 0x44, 0xFC, 
+0x61, 0x0D, 0x2E, 0xE5, 0x1A, 0x3B, 0x24, 0x5E, 
+0x04, 0xAE, 0x5B, 0xCA, 0x40, 0xEC, 0x4B, 0x92, 
+0xD8, 0x7C, 0x3F, 0x0B, 0x69, 0x07, 0xC1, 0x4F, 
+0xD2, 0xBA, 0x39, 0x09, 0x9D, 0xA2, 0x43, 0x0D, 
+0x82, 0x2F, 0xDD, 0x46, 0x2D, 
+
+0xA9, 0x3C, 0xC6, 0x55, 0x5C, 0xA3, 0x2C, 0x02, 
+0x51, 0xB8, 0x98, 0xF0, 0xDE, 0xF3, 0x51, 0x7A, 
+0x1F, 0x6C, 0xA1, 0xCD, 0x15, 0xCB, 0x3B, 0x3A, 
+0x7E, 0xD5, 0x3E, 0xF0, 0xD5, 0xE4, 0x6F, 0x30, 
+0x3C, 0x1F, 0x92, 0x65, 0xEE, 0x40, 0xAC, 0x8F, 
+0x8A, 0xA5, 0x5D, 0x26, 0x38, 0xCE, 0x02, 0xDE, 
+0xA4, 0xF6, 0xC1, 0xB5, 0x53, 0x7B, 0x37, 0x40, 
+0x38, 0xC6, 0x31, 0x9B, 0x3F, 0x72, 0xBB, 0x67, 
+0x9D, 0xC5, 0xE7, 0xFF, 0x84, 0x6E, 0xA5, 0x79, 
+0x24, 0x25, 0x39, 0xD9, 0xE3, 0x33, 
+
+0xA3, 0x90, 0x81, 0xE3, 0xBA, 0x9C, 0x12, 0x57, 
+0xE3, 0x53, 0x6E, 0x90, 0x2F, 0xD4, 0x6B, 0x23, 
+0x58, 0xCD, 0x1C, 0xBD, 0x6F, 0x8B, 0x16, 0x0C, 
+0x40, 0x41, 0x47, 0x86, 0xA4, 0xA2, 0x90, 0x6C, 
+0x81, 0x8B, 0x51, 0x53, 0xC8, 0x9A, 0xF4, 0x4F, 
+0x04, 0xB0, 0xF3, 0xE2, 0xDE, 0x73, 0x17, 0x3B, 
+0xB3, 0xDB, 0x61, 0xFB, 0xBD, 0xC8, 0xBB, 0x13, 
+0x8A, 0xE9, 0xB0, 0x5D, 0x38, 0x5F, 0x3F, 0x4F, 
+0x0E, 0xDF, 0xA9, 0xFB, 0xA9, 0x70, 0x99, 0x35, 
+0xB4, 0x51, 0x5E, 0xD8, 0xEE, 0x1D, 0x19, 
+
+0x31, 0xCF, 0x75, 0x5F, 0xFF, 0xBD, 0xB1, 0xE7, 
+0x04, 0xBF, 0x32, 0x3F, 0x80, 0x8C, 0x1A, 0xCF, 
+0x78, 0xF2, 0x95, 0x2B, 0x4B, 0xEF, 0x30, 0xDF, 
+0xE4, 0x49, 0x04, 0xF3, 0x82, 0x11, 0x30, 0x6B, 
+0x30, 0x3C, 0xAA, 0x9B, 0x1F, 0xDB, 0xFA, 0xDA, 
+0xC3, 0x79, 0x88, 0x1F, 0x4C, 0x06, 0x7E, 0xE5, 
+0x89, 0x04, 0x78, 0x7C, 0x0B, 0xA0, 0x2D, 0x50, 
+0x88, 0xD4, 0xA8, 0x3C, 0x4D, 0x9F, 0x85, 0x9E, 
+0x2C, 0x5B, 0x44, 0xBC, 0xD4, 0x2D, 0xF9, 0xC5, 
+0x12, 0x6E, 0x91, 0xCC, 0xDD, 0x3F, 0x89, 0x19, 
+
+0x9E, 0x06, 0x4A, 0xB5, 0x71, 0xD7, 0x33, 0x84, 
+0x95, 0x9C, 0x5F, 0xF8, 0x12, 0x0D, 0x46, 0xFA, 
+0xE5, 0x67, 0x31, 0xE2, 0x71, 0xDC, 0xB6, 0xB4, 
+0xE1, 0x3B, 0x16, 0x28, 0xE5, 0x04, 0x20, 0x90, 
+0xC1, 0x70, 0xB3, 0x1C, 0x93, 0xF6, 0x6E, 0xC8, 
+0x42, 0x8A, 0xAC, 0x85, 0x67, 0xB5, 0xAE, 0x74, 
+0x78, 0xFA, 0x32, 0x4B, 0x0D, 0x74, 0x3B, 0x38, 
+0xF9, 0x31, 0x01, 0xDA, 0x65, 0xE1, 0xBA, 0x5B, 
+0x47, 0x36, 0x26, 0x27, 0xD2, 0x41, 0xBD, 0xCE, 
+0x63, 0xA4, 0xA8, 0x7A, 0xF2, 0x4D, 0x85, 0x3A, 
+0x86, 
+
+0x52, 0xBD, 0xA1, 0x06, 0x3C, 0x94, 0x40, 0x14, 
+0x24, 0x66, 0x94, 0x84, 0x7F, 0x92, 0x07, 0x8C, 
+0x02, 0xB4, 0x9A, 0x9D, 0x77, 0x91, 0xDC, 0xEC, 
+0x69, 0xC6, 0x9F, 0xD5, 0x89, 0xDE, 0x3A, 0x55, 
+0xF1, 0x8C, 0x2D, 0xAD, 0xBE, 0x56, 0x1B, 0x88, 
+0x4D, 0xE1, 0x88, 0x8A, 0xEF, 0xFB, 0x2B, 0x1A, 
+0xE0, 0xEF, 0xF3, 0x79, 0xED, 0x25, 0x23, 0xF3, 
+0xAA, 0x1B, 0xE1, 0xF6, 0xB3, 0x0E, 0xC4, 0xA7, 
+0x02, 0xBB, 0xFF, 0x67, 0x18, 0x6C, 0xE5, 0x2F, 
+0x00, 0xA0, 0x52, 0x13, 0xEF, 0xDF, 0x1C, 0xD8, 
+0xAD, 0xB3, 
+
+0xBE, 0x63, 0x59, 0xA9, 0x6D, 0x8D, 0x63, 0xB4, 
+0x80, 0xB1, 0xEA, 0xCD, 0x8B, 0xB3, 0x98, 0x8A, 
+0x63, 0xF3, 0x0C, 0xD6, 0x93, 0xC0, 0x9E, 0x88, 
+0x33, 0x19, 0x0F, 0xC0, 0xF0, 0xFE, 0xCC, 0x33, 
+0xC7, 0xC5, 0xF0, 0xD6, 0x73, 0x6D, 0x57, 0xA4, 
+0x4C, 0x49, 0x20, 0x10, 0x99, 0x68, 0x73, 0x99, 
+0x38, 0xE9, 0x25, 0x11, 0x8B, 0xE1, 0xC8, 0x90, 
+0x13, 0x6A, 0x96, 0x0F, 0x82, 0x23, 0xFD, 0x5E, 
+0x71, 0x8D, 0x6E, 0xC7, 0xAB, 0x4F, 0x4B, 0x68, 
+0xFD, 0xC2, 0xFB, 0xA3, 0x80, 0xFA, 0xA3, 0xFB, 
+0x1D, 0xA4, 0x8E, 
+
+0xD3, 0xE2, 0xB1, 0xBF, 0xE6, 0x28, 0xA2, 0x19, 
+0x09, 0x7C, 0xE5, 0xDE, 0x6C, 0x07, 0x79, 0x55, 
+0x36, 0xD2, 0x39, 0x8B, 0x1C, 0x1E, 0xBB, 0x67, 
+0xF6, 0xE9, 0xA5, 0xD6, 0xCE, 0x83, 0x47, 0xFC, 
+0x2D, 0x5D, 0xF3, 0x9D, 0xA8, 0x98, 0x78, 0x2B, 
+0x2A, 0x4B, 0x1C, 0xC8, 0x7F, 0x45, 0x3F, 0x58, 
+0x01, 0x35, 0xE4, 0x5C, 0xF8, 0xEE, 0x27, 0xC6, 
+0x53, 0x88, 0x4B, 0x96, 0xDA, 0xBF, 0x57, 0x37, 
+0x45, 0x2F, 0x4B, 0xDC, 0x9B, 0x88, 0x8F, 0x98, 
+0xF4, 0x10, 0xEE, 0xC9, 0x25, 0x81, 0x31, 0x77, 
+0x5A, 0xF2, 0x5E, 0xEF, 
+
+0x4F, 0xB9, 0xC8, 0xBB, 0x61, 0x38, 0x62, 0xC0, 
+0x7E, 0xDA, 0xBB, 0x97, 0x1F, 0x14, 0x93, 0x3D, 
+0x56, 0x14, 0x06, 0xA0, 0xDA, 0xA6, 0x2F, 0x8D, 
+0x61, 0xC6, 0xD3, 0x70, 0x2A, 0xF2, 0xEF, 0xD6, 
+0x97, 0x81, 0x1B, 0x18, 0xCB, 0x5D, 0x3F, 0xC0, 
+0xAB, 0xF1, 0xE8, 0x16, 0x59, 0xA3, 0x9E, 0xDC, 
+0x59, 0x0D, 0x5D, 0x1C, 0x51, 0x81, 0xF6, 0x1A, 
+0x12, 0x0C, 0xF7, 0xE3, 0xF0, 0x6E, 0x8D, 0x89, 
+0x8D, 0xED, 0x1F, 0xE7, 0x4E, 0xAF, 0x30, 0x2F, 
+0xD2, 0x4B, 0xF3, 0x6D, 0x5B, 0xB3, 0x72, 0x8B, 
+0x2F, 0x1D, 0x01, 0x1B, 0xC6, 
+
+0x7E, 0x26, 0x4B, 0x2B, 0x10, 0x93, 0x82, 0xAC, 
+0x0B, 0x01, 0x88, 0x29, 0x90, 0x43, 0xA7, 0xAE, 
+0xC5, 0xDE, 0xC6, 0xAB, 0x67, 0x49, 0x45, 0x6B, 
+0x30, 0x5F, 0x87, 0x59, 0x4D, 0xB2, 0xE0, 0x8B, 
+0xEC, 0x9F, 0x9D, 0xB6, 0x5E, 0x1A, 0xA8, 0xE8, 
+0xB2, 0xF5, 0xC4, 0xDB, 0xC1, 0x39, 0xC6, 0xF4, 
+0x85, 0xE8, 0x59, 0x19, 0xE8, 0xE8, 0xAA, 0xE6, 
+0xA7, 0xE1, 0x66, 0x1B, 0x9A, 0xE3, 0x7C, 0xCE, 
+0x5A, 0x7A, 0x3B, 0x32, 0x38, 0x89, 0xED, 0x77, 
+0x99, 0x9C, 0x45, 0x90, 0xC2, 0xC8, 0xD1, 0xDE, 
+0xA3, 0x44, 0x71, 0xFE, 0x1B, 0x21, 
+
+0xB9, 0x69, 0x4D, 0x32, 0x87, 0xD6, 0xC6, 0x8B, 
+0xEC, 0xA1, 0xDF, 0xF8, 0x58, 0xB4, 0xCD, 0xCE, 
+0x1E, 0xC5, 0x37, 0x4E, 0x9B, 0x77, 0x8F, 0x8A, 
+0xEB, 0x89, 0x03, 0x4A, 0xA0, 0x0F, 0x51, 0x3C, 
+0x6C, 0x1B, 0x14, 0x7D, 0x0D, 0x1D, 0xBF, 0xA1, 
+0x8D, 0x59, 0x48, 0xA7, 0x95, 0x24, 0xED, 0x09, 
+0x8D, 0xDF, 0x89, 0xCA, 0x80, 0xB7, 0x2D, 0x0D, 
+0x71, 0xC7, 0x73, 0xFA, 0xB0, 0xFE, 0x70, 0xCE, 
+0xEA, 0xA2, 0x53, 0x23, 0x07, 0x41, 0x5B, 0xC8, 
+0xD9, 0xBE, 0x7C, 0xF7, 0x27, 0x17, 0x46, 0xA8, 
+0x25, 0xC4, 0x96, 0xFC, 0x58, 0x52, 0xF1, 
+
+0xC3, 0xFC, 0x2F, 0xDE, 0xBC, 0xC5, 0xF4, 0xB3, 
+0x17, 0x21, 0x88, 0xF7, 0xAB, 0x80, 0x06, 0x7A, 
+0x36, 0x20, 0x97, 0x8A, 0x71, 0x16, 0xB9, 0x38, 
+0xCF, 0x0E, 0x91, 0xC2, 0x4E, 0x38, 0x99, 0xC8, 
+0x5B, 0xAA, 0xC0, 0xFC, 0xD4, 0x48, 0x85, 0x3F, 
+0x41, 0xF0, 0x0A, 0x17, 0x2E, 0x32, 0xD3, 0xD4, 
+0xF4, 0x43, 0xDE, 0x00, 0x0D, 0xE0, 0xA5, 0x81, 
+0x2B, 0xB9, 0xF3, 0x9D, 0xE4, 0xA9, 0x29, 0xF1, 
+0x25, 0x1F, 0x44, 0x7C, 0xBC, 0x84, 0xEA, 0xA1, 
+0x9E, 0xD4, 0xD7, 0xAD, 0x1E, 0xC9, 0x0F, 0x9E, 
+0x23, 0x34, 0x15, 0xEC, 0xEF, 0x11, 0x47, 0x29, 
+
+0x67, 0x3B, 0x8B, 0xB9, 0x68, 0x4C, 0x7A, 0x96, 
+0xA1, 0xB1, 0x89, 0x79, 0x49, 0x24, 0xC4, 0x12, 
+0x42, 0x1A, 0x78, 0x6F, 0xE2, 0x7F, 0xB4, 0x92, 
+0xCD, 0xC0, 0xAD, 0xF5, 0x2D, 0x87, 0x6D, 0x22, 
+0x18, 0xFC, 0xCE, 0x69, 0xAA, 0xD8, 0x01, 0x2D, 
+0x49, 0x64, 0x3C, 0x24, 0xEE, 0xFF, 0xCA, 0x32, 
+0x34, 0xF5, 0x09, 0x5E, 0x90, 0x50, 0x35, 0xEB, 
+0xB8, 0x2D, 0x16, 0x10, 0xAD, 0x38, 0x30, 0x3D, 
+0x97, 0x07, 0xD0, 0x99, 0xCF, 0xF0, 0xA8, 0xBB, 
+0x21, 0x4E, 0x41, 0x1A, 0x12, 0x40, 0x11, 0x66, 
+0x66, 0x0F, 0x3C, 0xEA, 0x8A, 0x9E, 0x88, 0x8A, 
+0x46, 
+
+0x06, 0x07, 0xEB, 0x05, 0xA8, 0x83, 0xC7, 0xFC, 
+0x5B, 0x09, 0xBF, 0x80, 0xC9, 0x86, 0x1C, 0x98, 
+0xDD, 0x25, 0xE9, 0xDB, 0x66, 0xC5, 0x49, 0x4F, 
+0xF0, 0x12, 0xD6, 0x73, 0x04, 0xD7, 0x1B, 0xC6, 
+0xFF, 0xEB, 0xB7, 0x34, 0x09, 0x74, 0x96, 0xB4, 
+0xDD, 0xB2, 0x52, 0x3E, 0xB2, 0xDC, 0x9D, 0x4D, 
+0x12, 0x77, 0x7C, 0x5B, 0xB1, 0x2D, 0x18, 0x35, 
+0xD0, 0xCF, 0x22, 0x40, 0x2B, 0xEF, 0x91, 0x28, 
+0xDC, 0x4A, 0xF8, 0xB3, 0xAE, 0x22, 0x7B, 0x9D, 
+0x1C, 0x92, 0x4E, 0x07, 0xFF, 0xDA, 0x30, 0xD8, 
+0xB6, 0x47, 0xB8, 0x59, 0xD6, 0x6B, 0x27, 0x62, 
+0x50, 0xFE, 
+
+0xAF, 0x25, 0xCA, 0x2F, 0xCC, 0xAC, 0xEB, 0x64, 
+0x67, 0xCA, 0x4A, 0xC6, 0xE6, 0x6C, 0x98, 0xC8, 
+0xB9, 0x12, 0xCF, 0xCD, 0x56, 0xC1, 0x01, 0x32, 
+0x36, 0xEB, 0xE5, 0x58, 0xAC, 0xEC, 0x2D, 0xA6, 
+0xF9, 0x95, 0x84, 0xD9, 0x21, 0x13, 0x81, 0xE7, 
+0x8B, 0x46, 0x34, 0x3E, 0x29, 0xD7, 0x02, 0xAE, 
+0xC7, 0x45, 0x38, 0xC1, 0x69, 0x70, 0xE9, 0x38, 
+0x2C, 0x9D, 0x0F, 0xE1, 0x32, 0x48, 0x74, 0x65, 
+0x8A, 0x20, 0x50, 0x2A, 0x5C, 0xF9, 0x6F, 0xD7, 
+0xAC, 0x8A, 0xFB, 0x36, 0x3D, 0xBB, 0xEA, 0xA8, 
+0xA3, 0x9F, 0xC4, 0xAF, 0x45, 0x55, 0xF6, 0x1D, 
+0x93, 0xAA, 0x16, 
+
+0x4E, 0xB1, 0x03, 0x95, 0xE6, 0x22, 0x49, 0x89, 
+0xDE, 0x26, 0x73, 0x65, 0x0B, 0xE2, 0x85, 0xA1, 
+0xC5, 0x15, 0x5B, 0x5F, 0x8D, 0xC8, 0xB3, 0x15, 
+0xCB, 0x39, 0xD8, 0xD8, 0x12, 0xEF, 0x83, 0x89, 
+0x02, 0x81, 0x5A, 0x36, 0xB4, 0xAF, 0xE0, 0xA4, 
+0xE3, 0x1E, 0x72, 0xB6, 0x80, 0x1A, 0xCA, 0xB9, 
+0xF8, 0x64, 0x80, 0x62, 0x55, 0xA3, 0x50, 0x6F, 
+0xD0, 0x4C, 0x37, 0xFC, 0xFE, 0x8D, 0xC6, 0xFE, 
+0xD9, 0x6A, 0x6A, 0x1E, 0x44, 0x35, 0xCF, 0x6A, 
+0x1F, 0xDC, 0x86, 0x22, 0x98, 0x8E, 0x9B, 0x28, 
+0x85, 0x26, 0xAC, 0x20, 0xCF, 0x7E, 0xF4, 0xA3, 
+0x55, 0x02, 0x2F, 0x12, 
+
+0xED, 0x40, 0x76, 0x4D, 0x2A, 0x83, 0x9E, 0xB9, 
+0x0E, 0xA6, 0x9B, 0xFF, 0x43, 0xD3, 0x57, 0x22, 
+0xA1, 0xE5, 0x19, 0x44, 0x8A, 0x2C, 0x2B, 0xA5, 
+0xE0, 0x58, 0x00, 0xEA, 0xDE, 0xD7, 0x77, 0x95, 
+0x98, 0x02, 0x43, 0xC8, 0xAC, 0x9E, 0x10, 0x49, 
+0xDD, 0xFC, 0x0D, 0x6B, 0x69, 0x0C, 0x5F, 0xA6, 
+0xBC, 0x11, 0xCB, 0xB0, 0x40, 0x8C, 0xFD, 0xFD, 
+0x28, 0xEF, 0xEB, 0xAC, 0x73, 0xCD, 0x15, 0x74, 
+0x49, 0x08, 0x5F, 0xE5, 0xCA, 0x39, 0x3E, 0x07, 
+0xAB, 0xF0, 0xE8, 0xC3, 0x6F, 0x87, 0xAC, 0x34, 
+0xBB, 0x52, 0xA9, 0x5C, 0xF9, 0xE1, 0x64, 0x65, 
+0x43, 0x60, 0x00, 0x2B, 0xC5, 
+
+0xD1, 0x92, 0x49, 0xBE, 0x13, 0xB9, 0x0D, 0xC3, 
+0x94, 0x48, 0xF7, 0xAE, 0x8D, 0xB3, 0xCD, 0x40, 
+0xBB, 0x01, 0x2F, 0x5E, 0x65, 0xBF, 0x15, 0xE7, 
+0xC9, 0x23, 0xD8, 0x8A, 0x31, 0x92, 0xD4, 0x89, 
+0x03, 0x92, 0x64, 0x77, 0xC4, 0x02, 0xA1, 0xC8, 
+0x06, 0xA5, 0x46, 0xD8, 0x83, 0x19, 0x9B, 0x49, 
+0x2C, 0x77, 0x14, 0x1C, 0x37, 0xEC, 0xBC, 0x16, 
+0x16, 0x90, 0x38, 0x5D, 0x7B, 0x62, 0xC7, 0x5E, 
+0xB9, 0x90, 0x4C, 0x86, 0xB1, 0x04, 0x3F, 0x9B, 
+0x97, 0xFF, 0x96, 0x96, 0x87, 0x76, 0xCE, 0x54, 
+0xB9, 0xA1, 0x92, 0x44, 0x1E, 0xFF, 0x66, 0x19, 
+0xC5, 0x34, 0x9F, 0x3F, 0x91, 0x2A, 
+
+0xFF, 0x15, 0x86, 0xD2, 0x9C, 0x6E, 0xBB, 0xC6, 
+0x3F, 0xB4, 0x57, 0x9D, 0x0C, 0x84, 0xCF, 0xE3, 
+0xAB, 0x55, 0xA6, 0x11, 0x6A, 0xBB, 0xB8, 0x3A, 
+0x4C, 0x18, 0x53, 0x53, 0x54, 0xAE, 0x1F, 0x48, 
+0x81, 0x98, 0x10, 0x13, 0x95, 0xE5, 0xF7, 0xC9, 
+0x7A, 0xE5, 0x08, 0x3D, 0xD2, 0xE3, 0xE0, 0x63, 
+0x08, 0x2C, 0x53, 0x35, 0x4A, 0xFA, 0xB1, 0x33, 
+0x8C, 0xE7, 0x4B, 0xE7, 0x56, 0x9C, 0x97, 0x26, 
+0xC8, 0xD7, 0x8F, 0x37, 0xF0, 0x8C, 0x69, 0x30, 
+0x6C, 0x6B, 0xE9, 0x1D, 0x91, 0xBB, 0x14, 0xE9, 
+0x1A, 0xD8, 0x86, 0x72, 0x25, 0x31, 0x26, 0x91, 
+0xA1, 0x0F, 0x21, 0x67, 0xDC, 0x94, 0xF1, 
+
+0xFB, 0xD9, 0x1D, 0x95, 0x93, 0x0F, 0xBA, 0xDB, 
+0x70, 0x42, 0x47, 0x89, 0xB4, 0xF2, 0xCB, 0xF9, 
+0x65, 0x5F, 0x8D, 0xC7, 0x44, 0x51, 0x39, 0x7F, 
+0xCC, 0xD2, 0x8A, 0xA7, 0x14, 0xFE, 0xFC, 0x4A, 
+0x36, 0x50, 0x6E, 0x18, 0xF9, 0xAF, 0x0E, 0xB5, 
+0xEC, 0x1B, 0xCB, 0x66, 0xE0, 0x1A, 0x41, 0xC2, 
+0xA8, 0x5F, 0x62, 0x35, 0xE5, 0x9C, 0xCB, 0x35, 
+0x40, 0x51, 0x54, 0x25, 0xA6, 0xAB, 0x3D, 0x87, 
+0x13, 0x58, 0x6C, 0x39, 0xEA, 0x09, 0xDE, 0xF4, 
+0xD9, 0x8D, 0xCF, 0x4C, 0x68, 0x93, 0x69, 0x75, 
+0xAB, 0x03, 0xB0, 0x54, 0x8F, 0x17, 0xF4, 0xE2, 
+0x57, 0x81, 0xFB, 0xFA, 0x8C, 0x3B, 0xC0, 0x3A, 
+
+0xF6, 0xF8, 0x0F, 0x47, 0xEF, 0xEB, 0x09, 0xB2, 
+0xB3, 0xC6, 0x0E, 0xE1, 0xA5, 0xA2, 0x62, 0x6D, 
+0x44, 0x25, 0xEE, 0xEE, 0x35, 0xD0, 0x18, 0xBE, 
+0x07, 0xC3, 0xAD, 0x9E, 0x8E, 0x93, 0xBB, 0x78, 
+0xD7, 0x8F, 0x47, 0x6A, 0x27, 0x4C, 0x39, 0x18, 
+0xD1, 0xC5, 0xA9, 0x9F, 0x31, 0x7F, 0x47, 0x6A, 
+0x95, 0x35, 0x74, 0x6B, 0x1C, 0xD5, 0x33, 0x51, 
+0x68, 0x58, 0x67, 0x89, 0xA8, 0x43, 0x9B, 0xE5, 
+0xB4, 0x94, 0x5E, 0x62, 0xD5, 0x1C, 0xCB, 0x31, 
+0x1E, 0x1D, 0x25, 0x68, 0x6F, 0x44, 0xD0, 0x06, 
+0x4A, 0x14, 0x64, 0x32, 0x43, 0x5F, 0x4F, 0x9B, 
+0x56, 0x22, 0xA1, 0x02, 0x78, 0x54, 0x26, 0xCE, 
+0x12, 
+
+0xEE, 0x3C, 0x03, 0x94, 0x28, 0xD8, 0xED, 0x96, 
+0x2E, 0x67, 0x08, 0xEE, 0xDB, 0x31, 0xD9, 0x42, 
+0x10, 0x51, 0x99, 0x56, 0x68, 0x60, 0xA6, 0x79, 
+0x19, 0x7A, 0x54, 0xC3, 0xBD, 0x2A, 0x2D, 0x69, 
+0xF1, 0xE2, 0xEF, 0xA7, 0x26, 0x3D, 0x9A, 0x6F, 
+0x26, 0x8D, 0xE3, 0x0E, 0x3B, 0xFB, 0xCC, 0xFF, 
+0x65, 0x09, 0xAD, 0x24, 0xEE, 0xA8, 0xD8, 0x55, 
+0x83, 0xF5, 0xEA, 0x61, 0x07, 0x6F, 0x90, 0x54, 
+0x3C, 0x1A, 0x8E, 0xB0, 0x89, 0x79, 0x8A, 0xA7, 
+0x9B, 0x01, 0x53, 0x48, 0xBC, 0xB3, 0x9D, 0x9C, 
+0xF9, 0x8E, 0xC2, 0xF5, 0xF2, 0x9D, 0xF6, 0x1E, 
+0x28, 0x27, 0x1B, 0x43, 0x1C, 0xBE, 0xD5, 0x12, 
+0xE2, 0x9C, 
+
+0xE5, 0x1F, 0xCF, 0xE1, 0x7A, 0x7C, 0xF5, 0x66, 
+0x6E, 0x92, 0x41, 0x68, 0x89, 0x5C, 0xD7, 0xB4, 
+0x24, 0x06, 0xEE, 0x3D, 0x0D, 0xC7, 0xDF, 0xA9, 
+0x48, 0x39, 0xCB, 0x8E, 0x79, 0xE9, 0xA8, 0x3D, 
+0xB9, 0xFC, 0x97, 0xF1, 0x03, 0x06, 0xEB, 0xFB, 
+0x14, 0x97, 0x96, 0xA1, 0xFB, 0x25, 0x12, 0x6D, 
+0xA9, 0x2B, 0x0C, 0xC1, 0x27, 0x87, 0x4D, 0x17, 
+0xDE, 0xEA, 0xD3, 0xEE, 0x3A, 0xA9, 0xD5, 0x80, 
+0x52, 0x0E, 0xBB, 0x15, 0xA8, 0x16, 0x58, 0x01, 
+0x7A, 0x2D, 0x2F, 0xDF, 0x41, 0x23, 0x27, 0x5E, 
+0x54, 0xDC, 0x55, 0xB0, 0x75, 0xFE, 0xEE, 0xC1, 
+0xCD, 0x24, 0x9A, 0x8A, 0xC6, 0x07, 0xCB, 0x48, 
+0xF1, 0xA4, 0x55, 
+
+0xC6, 0x4D, 0xA8, 0x7B, 0xC5, 0xC5, 0xDA, 0x2B, 
+0xBB, 0x19, 0xA2, 0x36, 0xBE, 0x76, 0xA7, 0x34, 
+0x92, 0xD4, 0xE2, 0xA5, 0xF3, 0xF1, 0xF8, 0xBE, 
+0xA0, 0xA3, 0xB8, 0xE2, 0x3C, 0x39, 0x34, 0x84, 
+0xE5, 0xF7, 0x48, 0xF3, 0xD1, 0x4F, 0x4E, 0xC2, 
+0x4E, 0xB3, 0xC2, 0xBB, 0xE0, 0x1E, 0xAA, 0xBF, 
+0x42, 0x62, 0x90, 0x4D, 0x62, 0xF5, 0x79, 0xE5, 
+0x50, 0x25, 0x03, 0x37, 0x90, 0xAA, 0xF7, 0xFC, 
+0xEB, 0xC0, 0x32, 0xDA, 0x1C, 0xD7, 0x4F, 0x93, 
+0x17, 0xD0, 0xA2, 0x03, 0x09, 0x4C, 0x38, 0x3C, 
+0x8D, 0xBD, 0x1C, 0xE5, 0x05, 0xF6, 0x37, 0x96, 
+0xB3, 0x2A, 0xB6, 0x07, 0xE8, 0xA0, 0xBC, 0x77, 
+0x6F, 0x32, 0xCC, 0x24, 
+
+0x9B, 0x61, 0xE9, 0xE9, 0x8D, 0xB0, 0x02, 0x06, 
+0x4D, 0x1C, 0x28, 0x5C, 0x65, 0x7E, 0x89, 0x02, 
+0x47, 0x76, 0x1A, 0x1E, 0xEC, 0x64, 0xBA, 0x8D, 
+0x59, 0xF0, 0xC5, 0xCE, 0x89, 0xCF, 0x11, 0x5E, 
+0x3A, 0xBF, 0x8A, 0x32, 0x63, 0xFB, 0xD8, 0xC7, 
+0x56, 0xA8, 0x6F, 0xF3, 0x2B, 0xC8, 0x5B, 0x8F, 
+0xEC, 0x2C, 0x91, 0x35, 0x60, 0xE4, 0x84, 0x09, 
+0x2F, 0x36, 0x8E, 0x4F, 0xFB, 0x06, 0xBD, 0x53, 
+0xC4, 0x8A, 0x6B, 0x3B, 0xFA, 0xAA, 0x76, 0xFB, 
+0xFD, 0xD0, 0xF6, 0xD3, 0x75, 0xF6, 0x2B, 0xBF, 
+0x1F, 0x1C, 0x0F, 0xCA, 0xE1, 0xE6, 0x07, 0xC1, 
+0x33, 0x7A, 0x27, 0x14, 0xCF, 0xCC, 0x3A, 0xE2, 
+0x21, 0xFA, 0xF0, 0x8E, 0xEF, 
+
+0xB2, 0xEB, 0xE5, 0x13, 0x22, 0xCD, 0x5F, 0x6C, 
+0x28, 0xB3, 0xCD, 0x37, 0x6A, 0xCC, 0xC8, 0x59, 
+0xBC, 0x0A, 0x44, 0xC0, 0x53, 0xB7, 0xD9, 0x1F, 
+0x6A, 0xD2, 0x7D, 0x34, 0xB8, 0x9F, 0xBF, 0xA7, 
+0xF2, 0x87, 0x09, 0xF7, 0xE0, 0xDE, 0x43, 0x66, 
+0xD8, 0xAF, 0x04, 0x03, 0x2A, 0x48, 0xBC, 0x70, 
+0x49, 0x34, 0x05, 0x03, 0x85, 0xFC, 0x30, 0x78, 
+0xE5, 0x2A, 0x30, 0x65, 0x95, 0x62, 0xD6, 0x0B, 
+0x5C, 0xD6, 0x97, 0x4C, 0x4C, 0x70, 0xE7, 0xF4, 
+0xDA, 0x7E, 0xE2, 0x78, 0xFC, 0x2D, 0x4B, 0x09, 
+0x6D, 0x0A, 0xC1, 0x5E, 0xCA, 0x65, 0x60, 0xCD, 
+0x78, 0x5C, 0x24, 0x5D, 0xB5, 0x6C, 0x41, 0x86, 
+0xBB, 0x0A, 0xFE, 0xC8, 0xDB, 0x13, 
+
+0xB4, 0x5B, 0x74, 0xCE, 0x30, 0x5A, 0x8C, 0x7B, 
+0xCE, 0x65, 0x3E, 0x64, 0xEE, 0x0D, 0xC8, 0x91, 
+0x2E, 0x57, 0x6B, 0xE6, 0xD1, 0x95, 0xCC, 0x64, 
+0xB6, 0x0E, 0x67, 0xD2, 0xB3, 0x52, 0x78, 0x18, 
+0x1E, 0xE6, 0x49, 0x32, 0x61, 0x68, 0x94, 0x19, 
+0x98, 0x54, 0xDB, 0x92, 0x8B, 0x9B, 0x4E, 0x75, 
+0x93, 0x72, 0x59, 0x63, 0x8D, 0x3F, 0x2F, 0x9C, 
+0x4D, 0xC6, 0x94, 0x60, 0xDD, 0xD9, 0xB3, 0xB4, 
+0x04, 0xC0, 0xBC, 0x2E, 0xF4, 0xF5, 0x89, 0x67, 
+0xE3, 0x0E, 0x69, 0x2C, 0xE6, 0x8A, 0x74, 0xAE, 
+0xE6, 0xA4, 0xCC, 0x47, 0x20, 0x39, 0xE4, 0xEE, 
+0x87, 0x33, 0x28, 0x20, 0x8A, 0x39, 0x48, 0x44, 
+0x78, 0x10, 0x18, 0x0E, 0xDD, 0x41, 0xF7, 
+
+0x13, 0x26, 0xF8, 0xB2, 0xD9, 0x68, 0xE5, 0x81, 
+0x20, 0xE6, 0xA3, 0xC8, 0x34, 0x43, 0x4D, 0xCE, 
+0xA7, 0x87, 0x10, 0x98, 0x35, 0x91, 0x70, 0xF0, 
+0x1D, 0xA4, 0xFB, 0x73, 0x00, 0x8E, 0x15, 0x49, 
+0x14, 0xF8, 0xE8, 0x11, 0xA6, 0xBD, 0xEE, 0x7D, 
+0x51, 0x49, 0x52, 0x5F, 0x0D, 0x63, 0x8D, 0x52, 
+0x40, 0x4D, 0xF5, 0x40, 0x15, 0x04, 0x7C, 0xE2, 
+0x23, 0xCE, 0x1F, 0x37, 0x15, 0x2C, 0xDF, 0x92, 
+0xDC, 0xF7, 0xE4, 0x0B, 0x32, 0x72, 0x9E, 0x1C, 
+0x78, 0x61, 0x81, 0x1E, 0x27, 0xC7, 0xD7, 0xDF, 
+0x77, 0x59, 0xE3, 0x65, 0x8E, 0x33, 0x51, 0x72, 
+0x91, 0x2F, 0xF9, 0xC4, 0x68, 0xF8, 0x5D, 0x97, 
+0x86, 0xA6, 0x55, 0xAB, 0x20, 0x11, 0x2C, 0xE7, 
+
+0x13, 0x83, 0x54, 0x9F, 0x66, 0xE7, 0x40, 0x2D, 
+0xD9, 0xB5, 0xB9, 0xAC, 0xB0, 0x08, 0x06, 0x54, 
+0x50, 0x34, 0x07, 0x8A, 0x2E, 0x0B, 0xF2, 0x06, 
+0x85, 0xF2, 0x8C, 0xC1, 0x24, 0x2D, 0x9F, 0x66, 
+0x9A, 0x94, 0x93, 0x0E, 0xFA, 0x5E, 0xCC, 0x1D, 
+0x74, 0xAE, 0x8F, 0xAA, 0x51, 0x0E, 0x7F, 0xD4, 
+0x61, 0xA2, 0x29, 0xAB, 0xE9, 0x70, 0x8F, 0x99, 
+0xFF, 0x11, 0x38, 0x84, 0x63, 0x6D, 0x91, 0xB8, 
+0xF8, 0x18, 0x00, 0x61, 0x50, 0xBA, 0xBA, 0xAC, 
+0x07, 0x8D, 0x27, 0x53, 0xE8, 0xAD, 0x6D, 0x8B, 
+0x79, 0x2F, 0xE4, 0xCD, 0xC0, 0x87, 0x47, 0x37, 
+0x96, 0xB4, 0x07, 0x0E, 0x07, 0x71, 0x24, 0xCA, 
+0x3F, 0xB7, 0x40, 0x0E, 0x72, 0xBA, 0x61, 0x4F, 
+0x05, 
+
+0x86, 0x99, 0xFC, 0xEF, 0x12, 0x8E, 0x6E, 0x84, 
+0x7A, 0x25, 0xD3, 0xB6, 0x37, 0xEE, 0x42, 0x0D, 
+0x88, 0xE2, 0x53, 0xD7, 0x59, 0x4A, 0x73, 0x67, 
+0xA6, 0x20, 0xA3, 0x91, 0x7C, 0x1F, 0xCA, 0x18, 
+0xC9, 0xEF, 0xD1, 0xD0, 0xE0, 0xC7, 0x23, 0x14, 
+0x03, 0x05, 0x7F, 0x84, 0xCD, 0xDB, 0xDB, 0x2D, 
+0x31, 0x33, 0xBE, 0xD5, 0x1A, 0xDC, 0xC2, 0xBE, 
+0x6C, 0x9B, 0x35, 0xFB, 0xAD, 0xC1, 0xDE, 0xDC, 
+0x11, 0x32, 0xA9, 0xCB, 0x0E, 0x48, 0x19, 0x20, 
+0x37, 0xC5, 0x08, 0xE0, 0xBB, 0x6A, 0x69, 0x46, 
+0x3A, 0x31, 0xB8, 0x35, 0x88, 0x1D, 0x5E, 0xFC, 
+0x18, 0xB1, 0xA0, 0x90, 0x71, 0x94, 0x0D, 0x1E, 
+0xA7, 0x78, 0x9B, 0x8E, 0xEC, 0x2B, 0xC8, 0x55, 
+0x3E, 0x21, 
+
+0x62, 0xB2, 0xA4, 0xD4, 0xCD, 0xAE, 0xD3, 0xF1, 
+0xFB, 0x71, 0x5C, 0xDD, 0xD6, 0x51, 0x91, 0x9D, 
+0x48, 0xBD, 0x3C, 0xF9, 0x0C, 0x05, 0xEB, 0xF8, 
+0x55, 0x48, 0x03, 0xE6, 0x9C, 0x13, 0x9E, 0xB8, 
+0x6B, 0xB5, 0x09, 0xB3, 0x89, 0x04, 0x96, 0xFD, 
+0x1C, 0xE8, 0x0F, 0xC9, 0x19, 0x85, 0xE4, 0xCC, 
+0xBB, 0xDB, 0xC3, 0x15, 0x69, 0xD9, 0xA1, 0x91, 
+0x9B, 0x16, 0xE0, 0xD0, 0x74, 0x3F, 0xF4, 0xFB, 
+0x4B, 0xAD, 0x4E, 0x2B, 0xBF, 0xD0, 0xD9, 0xB1, 
+0xC6, 0x72, 0xBB, 0xC8, 0x20, 0x90, 0x56, 0x38, 
+0x15, 0x42, 0x1F, 0x26, 0xF0, 0xB7, 0x0C, 0x08, 
+0xA5, 0xB0, 0xF8, 0x3E, 0x0A, 0xD4, 0x84, 0xB0, 
+0x70, 0x10, 0x62, 0xCF, 0x9A, 0xF0, 0x96, 0xDE, 
+0x2D, 0x33, 0x01, 
+
+0xD5, 0x15, 0x63, 0x0A, 0x41, 0xF9, 0x2B, 0x15, 
+0x55, 0x1E, 0x63, 0x96, 0xC3, 0xF0, 0x21, 0x90, 
+0xFB, 0xB9, 0xC7, 0xFE, 0xEE, 0x15, 0x2C, 0x28, 
+0xBB, 0x2C, 0x9D, 0x6F, 0x8E, 0xAB, 0x73, 0xD3, 
+0x03, 0x39, 0xE9, 0xB4, 0xEC, 0xED, 0x48, 0x1F, 
+0xDA, 0x87, 0x29, 0xA1, 0x4B, 0xD7, 0xC8, 0xCD, 
+0xDB, 0xF0, 0xCF, 0x82, 0x54, 0x27, 0xBF, 0x20, 
+0xF4, 0xD4, 0x52, 0xED, 0x64, 0xA2, 0x56, 0x06, 
+0x32, 0x0A, 0xE5, 0x91, 0xE6, 0xC0, 0x6E, 0x0A, 
+0x02, 0xC0, 0x26, 0x3E, 0xFB, 0x0B, 0x2F, 0x76, 
+0x37, 0x4E, 0x2D, 0x77, 0xAA, 0x7E, 0x1E, 0xB1, 
+0xC1, 0xEB, 0x11, 0x9E, 0x25, 0x05, 0x97, 0x12, 
+0x44, 0x22, 0xDE, 0x2C, 0x83, 0x97, 0x03, 0x8C, 
+0x5A, 0x93, 0xAE, 0xD0, 
+
+0xED, 0xA1, 0x20, 0xA9, 0x5E, 0x2A, 0xBC, 0xBC, 
+0x1A, 0x8D, 0x48, 0x71, 0x4E, 0x3A, 0xFA, 0xEA, 
+0x8F, 0x78, 0x3F, 0xA6, 0x61, 0x28, 0xC3, 0xC9, 
+0x01, 0xCF, 0xD4, 0x2E, 0x36, 0x40, 0x90, 0xA8, 
+0xCA, 0x45, 0x40, 0xFE, 0x9E, 0xB6, 0x40, 0x28, 
+0xFB, 0x28, 0x3E, 0x07, 0xFC, 0xA1, 0x1A, 0x8D, 
+0xA8, 0x5B, 0xB1, 0x1A, 0xE3, 0x18, 0xDC, 0xB3, 
+0x19, 0x14, 0xF9, 0xB9, 0x9C, 0x18, 0xAE, 0x7A, 
+0xE9, 0x27, 0xCA, 0x63, 0x6A, 0x95, 0xAA, 0xD2, 
+0x79, 0x93, 0xF0, 0x74, 0x9C, 0xCD, 0x55, 0x47, 
+0xF1, 0x88, 0x7E, 0xAC, 0x15, 0xA4, 0x4B, 0x1B, 
+0x9F, 0x2A, 0xD4, 0x88, 0xF7, 0x6F, 0x79, 0x3D, 
+0xCC, 0x48, 0x31, 0x64, 0xE2, 0x09, 0x00, 0x26, 
+0x57, 0x01, 0x02, 0x7E, 0x6C, 
+
+0x92, 0x47, 0x3D, 0x62, 0x6D, 0x82, 0xB4, 0x89, 
+0x35, 0xCB, 0x4A, 0x66, 0x24, 0xF1, 0x14, 0x40, 
+0xA6, 0xAD, 0xE1, 0xD4, 0xC8, 0xFB, 0x08, 0x90, 
+0xFC, 0x33, 0xA8, 0x2E, 0xCF, 0x38, 0x4C, 0x45, 
+0xF8, 0x8D, 0x4B, 0x11, 0xF8, 0x7F, 0xD8, 0x4F, 
+0x22, 0x2D, 0x7B, 0x38, 0x8C, 0x8F, 0xDF, 0x8B, 
+0xF1, 0x4B, 0xCF, 0x46, 0xE5, 0xCC, 0x8D, 0x0A, 
+0x7A, 0x0E, 0xF8, 0xDE, 0xB5, 0x6D, 0xB8, 0x1F, 
+0xC0, 0xD7, 0x2A, 0x65, 0x95, 0xED, 0x88, 0x0B, 
+0x7C, 0xAB, 0xFC, 0xDB, 0xEF, 0xF0, 0x68, 0xFA, 
+0x0E, 0xD6, 0xBD, 0xC1, 0xD4, 0xFD, 0x72, 0x9E, 
+0x20, 0xB5, 0xA8, 0x9F, 0x8E, 0x68, 0x9F, 0x1E, 
+0xF5, 0x10, 0x99, 0x79, 0x59, 0x18, 0xB4, 0x99, 
+0x11, 0x60, 0x18, 0x02, 0x0A, 0x53, 
+
+0xE9, 0x20, 0xCF, 0x62, 0x26, 0x4F, 0xA8, 0xA2, 
+0x8C, 0xCC, 0x32, 0xDE, 0x6B, 0xA5, 0x44, 0xB7, 
+0xD0, 0x25, 0x48, 0x4B, 0xB7, 0x10, 0x61, 0x3B, 
+0x93, 0xFB, 0xFB, 0x57, 0x02, 0xBC, 0x02, 0xD2, 
+0x8E, 0x33, 0x08, 0xBF, 0xA2, 0x02, 0xB4, 0xDF, 
+0x86, 0x33, 0xCD, 0xCD, 0x7C, 0x3B, 0x8D, 0xDB, 
+0x90, 0x3A, 0xF3, 0x55, 0x82, 0x73, 0x5F, 0x27, 
+0x8D, 0xED, 0x06, 0xE0, 0x76, 0x5A, 0x7A, 0xB6, 
+0xD4, 0xE5, 0xE0, 0xDC, 0x26, 0xB8, 0xF1, 0x4C, 
+0x79, 0xBD, 0xDD, 0xE5, 0xC1, 0x72, 0x78, 0xB2, 
+0x93, 0x39, 0x04, 0xAC, 0x52, 0x57, 0xA6, 0x1A, 
+0x1E, 0x30, 0x66, 0xF9, 0xDF, 0x9B, 0x9B, 0x41, 
+0x1B, 0x15, 0x05, 0xED, 0x47, 0x9F, 0x8D, 0xF6, 
+0xDF, 0x1A, 0x05, 0xA7, 0x93, 0xBD, 0x20, 
+
+0xBC, 0xD6, 0x2A, 0xC2, 0x2E, 0x81, 0x71, 0xF7, 
+0xF9, 0x29, 0x5B, 0xBB, 0x8C, 0x84, 0xB3, 0xB2, 
+0x47, 0xF4, 0xBB, 0x9C, 0x82, 0xC0, 0x35, 0xA2, 
+0xEA, 0xA7, 0x4E, 0xA5, 0x5E, 0xD5, 0x0D, 0xDF, 
+0x89, 0x3E, 0xC1, 0x3F, 0x8B, 0x82, 0xE5, 0xEB, 
+0x4D, 0x87, 0xD9, 0x73, 0xAC, 0x06, 0x8A, 0xB4, 
+0xF3, 0x2C, 0x83, 0xCB, 0x08, 0xB5, 0xB2, 0x74, 
+0x16, 0xD0, 0xB5, 0x7D, 0xB7, 0x20, 0x1D, 0xE9, 
+0x85, 0x89, 0xEC, 0xFF, 0x99, 0xE6, 0x4F, 0x7F, 
+0x62, 0x2D, 0x9E, 0x16, 0xAF, 0x89, 0x91, 0x86, 
+0x95, 0x24, 0xDE, 0x7D, 0x0F, 0x54, 0xA3, 0xD7, 
+0x40, 0x30, 0x71, 0xF1, 0xAA, 0x69, 0xD0, 0x88, 
+0x17, 0xA6, 0xEA, 0x38, 0x8C, 0x0C, 0x1A, 0x15, 
+0xE7, 0xD5, 0x77, 0x0B, 0x81, 0xC7, 0x43, 0xBB, 
+
+0x4B, 0x01, 0x86, 0x3D, 0x0D, 0xB2, 0x31, 0x1F, 
+0xA1, 0xCA, 0x12, 0x0C, 0x59, 0x69, 0xEC, 0x14, 
+0x62, 0xC9, 0x17, 0xC2, 0x61, 0xF1, 0x60, 0x2B, 
+0x8C, 0x46, 0x80, 0x62, 0x9E, 0x7E, 0xE4, 0x21, 
+0xC3, 0x3F, 0xF7, 0x4C, 0x31, 0xCE, 0x8B, 0x4C, 
+0x87, 0x48, 0xD9, 0x60, 0x39, 0xFD, 0xFC, 0x12, 
+0x15, 0x2F, 0x35, 0x74, 0x79, 0xEB, 0x87, 0x20, 
+0x72, 0xE4, 0x36, 0x06, 0xEE, 0xB0, 0xEB, 0x46, 
+0xA9, 0xB7, 0x43, 0x99, 0x51, 0x62, 0x22, 0x8E, 
+0x7D, 0x6A, 0x98, 0xA2, 0x17, 0xCA, 0x43, 0xF7, 
+0xC7, 0x6B, 0xB8, 0xB9, 0xA5, 0xEA, 0xED, 0xEE, 
+0x68, 0x47, 0x93, 0x6F, 0xCE, 0x87, 0x72, 0x2D, 
+0x23, 0xD9, 0xCD, 0x45, 0x90, 0x63, 0x61, 0x92, 
+0x89, 0xC8, 0x29, 0x71, 0x86, 0xF5, 0x8F, 0x7B, 
+0x41, 
+
+0xB9, 0x14, 0xD1, 0xAD, 0x98, 0x4B, 0x37, 0x28, 
+0x4B, 0x73, 0xFE, 0x28, 0x3F, 0x0B, 0x83, 0xC4, 
+0xB6, 0xED, 0x8D, 0x4E, 0x25, 0x12, 0xB5, 0x41, 
+0xB3, 0xAC, 0x2C, 0x2A, 0x8B, 0x5C, 0x3D, 0xE6, 
+0x69, 0xE1, 0x99, 0x18, 0x31, 0x78, 0xCB, 0xAC, 
+0xA8, 0xE7, 0x7F, 0x44, 0xFF, 0x87, 0xBB, 0x92, 
+0xBD, 0x98, 0xEF, 0xD3, 0x86, 0x1B, 0xD3, 0xB5, 
+0xB1, 0x50, 0x3A, 0x0D, 0x54, 0x79, 0x8F, 0xB9, 
+0x9D, 0x3D, 0xF9, 0x6A, 0x9B, 0x0B, 0xC1, 0xE5, 
+0x62, 0x04, 0xC5, 0x2D, 0xEF, 0x9E, 0xAA, 0x79, 
+0xA9, 0x82, 0x9D, 0xEA, 0x16, 0x93, 0xA4, 0x69, 
+0xB5, 0x54, 0xCD, 0x4A, 0x0E, 0xEC, 0xB7, 0x06, 
+0x07, 0x97, 0x95, 0xF4, 0xB0, 0x09, 0x53, 0x04, 
+0xBB, 0xC0, 0x65, 0x27, 0xA9, 0xAF, 0x11, 0x48, 
+0x92, 0x7E, 
+
+0xA9, 0xB0, 0xF9, 0x25, 0x66, 0xC3, 0xF5, 0x4D, 
+0x9A, 0x9A, 0x18, 0x15, 0x8E, 0x06, 0x8E, 0xC3, 
+0x75, 0x7B, 0x99, 0xE2, 0x07, 0x49, 0x41, 0x19, 
+0xA1, 0x7E, 0x3E, 0x13, 0xED, 0x3E, 0xB9, 0x66, 
+0x89, 0x37, 0x37, 0xE3, 0xBC, 0xC4, 0xA7, 0xA5, 
+0xB4, 0x5A, 0x57, 0x1B, 0xF7, 0xAD, 0x54, 0x13, 
+0xF5, 0x4F, 0x80, 0x3F, 0xF9, 0x02, 0x89, 0x42, 
+0x13, 0x78, 0x41, 0x72, 0x52, 0x9E, 0xBA, 0x35, 
+0x12, 0xDC, 0x60, 0xDA, 0xEB, 0xF8, 0x81, 0x77, 
+0x88, 0xB2, 0xF7, 0xA5, 0x39, 0xB1, 0x50, 0xDC, 
+0x9C, 0x7A, 0xF3, 0xB6, 0x81, 0xE5, 0x1D, 0xCE, 
+0xBD, 0x16, 0x2E, 0x4A, 0xD4, 0xE5, 0x7B, 0x2D, 
+0xC3, 0x29, 0x5F, 0xFB, 0x63, 0x21, 0x4D, 0x35, 
+0x3C, 0xC4, 0xDB, 0x4F, 0xA9, 0x15, 0x68, 0xDE, 
+0x6D, 0x5D, 0x49, 
+
+0xAC, 0xE3, 0xD3, 0xD0, 0x7C, 0x51, 0x6F, 0xD7, 
+0x73, 0xC9, 0x82, 0x6B, 0x9A, 0x6C, 0x8D, 0x56, 
+0xB4, 0x17, 0xB7, 0xD8, 0xAD, 0x80, 0xA7, 0xFC, 
+0xCA, 0xD5, 0x5B, 0x8E, 0x3A, 0xE3, 0x4B, 0x6B, 
+0xFD, 0x75, 0x1B, 0xD1, 0xE5, 0xBA, 0x64, 0xD8, 
+0xC4, 0x29, 0x83, 0xF8, 0x18, 0x98, 0xA2, 0x96, 
+0x10, 0xB2, 0x3E, 0x60, 0x04, 0xE9, 0x7F, 0xBF, 
+0x50, 0xFE, 0x1A, 0x25, 0x6F, 0xA7, 0xD9, 0xAE, 
+0x74, 0xCC, 0xF0, 0x07, 0x22, 0xA6, 0xB1, 0x94, 
+0xD7, 0xFE, 0x0A, 0x00, 0x36, 0x98, 0x4D, 0xC7, 
+0x2D, 0xDE, 0xB6, 0xD8, 0x9D, 0xEA, 0x34, 0xEE, 
+0x45, 0x5D, 0xF8, 0xE2, 0xF2, 0x1C, 0x15, 0x70, 
+0x16, 0x89, 0x23, 0xA5, 0x28, 0xFD, 0xCC, 0xB7, 
+0xF6, 0x69, 0x7E, 0x92, 0xCE, 0xD4, 0xF1, 0x4F, 
+0x51, 0xA6, 0xAA, 0x4F, 
+
+0x5D, 0xCC, 0x88, 0xC5, 0x86, 0xF9, 0x69, 0xF2, 
+0xB0, 0x4D, 0x03, 0x23, 0xFE, 0x17, 0xFA, 0x40, 
+
+0x4C, 0x5A, 0xA2, 0xCF, 0x99, 0x32, 0x9B, 0x96, 
+0x87, 0x4D, 0x65, 0xC4, 0x2A, 0xA0, 0x09, 0xCC, 
+0xDC, 
+
+0xFE, 0x5B, 0xD6, 0xBA, 0xED, 0x90, 0xE3, 0xC1, 
+0xDE, 0x46, 0xA3, 0xD2, 0xC8, 0x35, 0xD5, 0xE2, 
+0x9F, 0x6D, 
+
+0x6B, 0xA5, 0xF2, 0xDE, 0x65, 0xEB, 0x9A, 0xAE, 
+0xF2, 0x5B, 0xF6, 0xC9, 0x7A, 0x4C, 0x20, 0x59, 
+0xF8, 0x48, 0xED, 
+
+0x52, 0x67, 0x5D, 0x5E, 0xAE, 0x79, 0x92, 0x62, 
+0x4B, 0x7C, 0xB3, 0x9C, 0xD8, 0x32, 0xDF, 0x60, 
+0x3D, 0x07, 0xA0, 0x06, 
+
+0xAA, 0xBE, 0xDD, 0x59, 0x61, 0x63, 0x14, 0x58, 
+0xE8, 0xDE, 0x12, 0xD1, 0x0B, 0xDC, 0xFD, 0x84, 
+0xFC, 0x35, 0x7A, 0xF7, 0x60, 
+
+0x85, 0x76, 0x7F, 0x0D, 0x73, 0xE2, 0x4D, 0x13, 
+0x6E, 0x98, 0x20, 0x22, 0x42, 0x42, 0xC9, 0xCD, 
+0x21, 0xB3, 0x00, 0xA7, 0xEF, 0x78, 
+
+0xAB, 0x89, 0xF3, 0x78, 0x67, 0x63, 0xD5, 0x62, 
+0x45, 0x38, 0x84, 0xFA, 0x1A, 0x98, 0xF6, 0xCF, 
+0x3D, 0xE8, 0xF7, 0x63, 0x6A, 0xD9, 0x5A, 
+
+0x0F, 0x8F, 0x47, 0xF8, 0xEA, 0x17, 0x30, 0x7E, 
+0xD4, 0x60, 0x58, 0xB4, 0xA6, 0xDE, 0xC0, 0x44, 
+0x2A, 0x54, 0x8B, 0x8C, 0xC3, 0x97, 0x89, 0xDD, 
+
+0x23, 0xD4, 0x95, 0xC7, 0xF9, 0xB7, 0x17, 0x4B, 
+0xE8, 0x05, 0xED, 0xE7, 0x5A, 0x20, 0xEA, 0xFB, 
+0xBF, 0x5D, 0x75, 0x36, 0x03, 0x70, 0x47, 0x43, 
+0x06, 
+
+0xE8, 0xE1, 0xC4, 0x50, 0xEF, 0xB6, 0xF0, 0xCA, 
+0x78, 0xEF, 0x4E, 0x64, 0xE4, 0x77, 0x6E, 0xCD, 
+0x90, 0xBD, 0x0A, 0x18, 0x19, 0x67, 0xB7, 0x8B, 
+0x48, 0xCE, 
+
+0xF1, 0x24, 0xBC, 0xD3, 0x95, 0x66, 0x65, 0x69, 
+0xA7, 0x6E, 0x61, 0x3C, 0xCE, 0xC7, 0xC2, 0x5C, 
+0x18, 0x31, 0x3C, 0x81, 0x19, 0x7B, 0xC5, 0xF5, 
+0x2C, 0x61, 0x06, 
+
+0x51, 0x54, 0x80, 0x8F, 0x07, 0xDA, 0xCC, 0x7B, 
+0xB8, 0xE1, 0xE0, 0xEE, 0x7D, 0x02, 0x52, 0x6F, 
+0xDD, 0x5E, 0xD1, 0xAD, 0x82, 0x7D, 0x47, 0xC2, 
+0x4D, 0xCB, 0x5A, 0x0D, 
+
+0xD8, 0xFF, 0x53, 0x2C, 0xEE, 0xA5, 0xEB, 0x21, 
+0xD8, 0x4C, 0x89, 0xCF, 0x7F, 0x79, 0x50, 0xCE, 
+0xE9, 0x13, 0x24, 0xA0, 0xCC, 0xF8, 0x62, 0xEA, 
+0x96, 0xCA, 0xC7, 0x19, 0x90, 
+
+0x78, 0xF9, 0x51, 0x9E, 0x84, 0x32, 0xFC, 0xBA, 
+0x46, 0xA0, 0xF1, 0x04, 0x77, 0xF6, 0x38, 0xB3, 
+0xBE, 0x6B, 0x15, 0xDD, 0x55, 0xC3, 0x21, 0x12, 
+0xAE, 0x28, 0x9F, 0x9E, 0x7B, 0x11, 
+
+0xC9, 0xC7, 0xCD, 0x1F, 0x2E, 0x48, 0x25, 0xC3, 
+0x1C, 0xF8, 0x85, 0x32, 0x15, 0x56, 0x82, 0x30, 
+0x31, 0xC9, 0xBB, 0xB1, 0x99, 0x1E, 0x2E, 0x47, 
+0x80, 0x50, 0xA0, 0x4A, 0x9F, 0x24, 0xA0, 
+
+0x76, 0xC1, 0x46, 0xC5, 0x86, 0x11, 0xE9, 0x28, 
+0x6A, 0x97, 0x26, 0xC7, 0x89, 0xA7, 0xCD, 0x01, 
+0x8C, 0xB7, 0x99, 0x94, 0x0C, 0x83, 0x89, 0xB1, 
+0x34, 0x10, 0x1D, 0xE1, 0xE4, 0x16, 0x21, 0xA5, 
+
+0x63, 0xF4, 0x8B, 0x62, 0x4F, 0xE7, 0x46, 0xCC, 
+0x4F, 0x60, 0x20, 0x8B, 0x00, 0x57, 0xD0, 0xA7, 
+0xDF, 0xE1, 0xAC, 0x3A, 0x62, 0xDE, 0x16, 0xE0, 
+0x45, 0x75, 0xFF, 0xE0, 0x4C, 0xBB, 0xB7, 0x8C, 
+0xD7, 
+
+0xCB, 0x1E, 0x1E, 0x95, 0xE6, 0x40, 0xDF, 0x3F, 
+0x0C, 0x7B, 0x5B, 0x76, 0xFD, 0x13, 0xC4, 0x95, 
+0xBE, 0xCC, 0x94, 0x12, 0x54, 0xDD, 0x30, 0x38, 
+0x60, 0x43, 0x29, 0xEE, 0x2D, 0x56, 0x0A, 0x06, 
+0xB5, 0x9A, 
+
+0x4E, 0x1D, 0xFC, 0x0C, 0x9C, 0x55, 0x80, 0x6A, 
+0x05, 0x1B, 0x55, 0x77, 0x9D, 0x42, 0x98, 0x4A, 
+0x70, 0x70, 0x1E, 0xCB, 0xC4, 0x46, 0xDE, 0xE1, 
+0xFC, 0xE7, 0xCE, 0xA4, 0xD3, 0xA3, 0x3A, 0x6D, 
+0x53, 0xCA, 0x20, 
+
+0x5E, 0x8D, 0x7B, 0xAE, 0xCD, 0x3B, 0x76, 0xBC, 
+0xD6, 0x0A, 0x5D, 0x7A, 0x91, 0x4C, 0x51, 0x5D, 
+0x54, 0xA6, 0x9D, 0xB8, 0x36, 0xD4, 0x88, 0x55, 
+0x46, 0xF8, 0xE4, 0x48, 0x8F, 0x94, 0x42, 0x70, 
+0xDC, 0x8B, 0x83, 0x9A, 
+
+0xA6, 0xBB, 0x70, 0x33, 0x8E, 0x2E, 0xBD, 0xF7, 
+0x24, 0xAF, 0x04, 0xAF, 0x33, 0x3C, 0x58, 0x6A, 
+0xB0, 0x83, 0xC6, 0x4B, 0xE6, 0xAD, 0x00, 0x11, 
+0xCD, 0x0C, 0x11, 0x06, 0x44, 0x12, 0x8B, 0xF4, 
+0x5E, 0xAF, 0xEE, 0x5D, 0x79, 
+
+0x41, 0xC9, 0xA4, 0x8C, 0xE0, 0x92, 0xB4, 0xAB, 
+0xCB, 0xFA, 0x94, 0xDF, 0xF5, 0x29, 0xFA, 0x23, 
+0x43, 0x0F, 0xF0, 0x96, 0x01, 0x58, 0xDA, 0x5D, 
+0x24, 0xF2, 0x5A, 0x70, 0x3D, 0x27, 0x6E, 0xD2, 
+0xE2, 0xCF, 0xC5, 0x70, 0xD9, 0x6D, 
+
+0x96, 0xEA, 0xF6, 0x72, 0xED, 0x28, 0x75, 0x42, 
+0x06, 0x81, 0xEA, 0x29, 0xDF, 0xE4, 0x06, 0x51, 
+0xD2, 0x3F, 0x42, 0xF5, 0x9C, 0xDD, 0x4C, 0x9A, 
+0x05, 0x2C, 0x12, 0x1D, 0x86, 0x9E, 0x3B, 0x3C, 
+0x8C, 0xC6, 0x9E, 0x66, 0x0C, 0x2E, 0xFE, 
+
+0x1F, 0x85, 0x54, 0x96, 0x15, 0x04, 0x80, 0x09, 
+0xDB, 0x13, 0xA7, 0x52, 0x03, 0x5F, 0x8C, 0x6E, 
+0x66, 0x9D, 0x65, 0x64, 0xB0, 0x73, 0xC0, 0x61, 
+0x5B, 0xE2, 0x67, 0xAE, 0x5E, 0xAC, 0x4A, 0x8D, 
+0x95, 0x04, 0xEA, 0x62, 0x24, 0x14, 0xCB, 0x0E, 
+
+0xFC, 0xAC, 0x41, 0xB1, 0x66, 0xBD, 0xFE, 0x96, 
+0x4D, 0xDA, 0x18, 0x9C, 0xDA, 0xA8, 0xC7, 0x6A, 
+0x94, 0x5D, 0x2B, 0x44, 0xB0, 0xF6, 0x89, 0x58, 
+0x9C, 0xEB, 0xFB, 0xA2, 0x8F, 0xEC, 0x7A, 0x0A, 
+0x7F, 0xFB, 0x76, 0x5B, 0xF8, 0x44, 0x6F, 0x72, 
+0xF8, 
+
+0x6C, 0x78, 0x7D, 0x34, 0x07, 0xA5, 0xB2, 0xA9, 
+0xF8, 0x8F, 0xB6, 0x4E, 0x0D, 0x31, 0x23, 0xD2, 
+0x71, 0xB5, 0x7D, 0x10, 0xBA, 0xA7, 0x35, 0x1F, 
+0xDE, 0x10, 0x4B, 0x7B, 0x9C, 0x30, 0xB4, 0x5C, 
+0xEA, 0xCA, 0xA3, 0x10, 0x01, 0x7D, 0x83, 0x9A, 
+0xBB, 0xCE, 
+
+0xF9, 0x00, 0x75, 0x76, 0x0E, 0x8B, 0x33, 0xAF, 
+0x83, 0xD4, 0xB4, 0x0D, 0xF1, 0x48, 0xD5, 0x02, 
+0x08, 0x6F, 0xEC, 0x1B, 0xB0, 0x40, 0x82, 0xF2, 
+0xF4, 0x52, 0xF2, 0x9C, 0xB9, 0x2E, 0x40, 0x31, 
+0x39, 0xBE, 0x35, 0x49, 0x46, 0x67, 0xBD, 0xCB, 
+0x70, 0x69, 0x87, 
+
+0xCB, 0x20, 0xDD, 0xCD, 0x30, 0x13, 0xD8, 0x84, 
+0xD8, 0x57, 0xAA, 0xFA, 0x74, 0xDA, 0x46, 0x49, 
+0x35, 0x60, 0x93, 0x20, 0xAB, 0x32, 0x19, 0x02, 
+0x3C, 0x28, 0x85, 0x33, 0x7F, 0xFB, 0xCF, 0xA8, 
+0xF1, 0x19, 0xDA, 0x1F, 0xDE, 0x19, 0x12, 0x9E, 
+0x37, 0x2D, 0xCE, 0x26, 
+
+0x42, 0xD4, 0xAD, 0xFF, 0xDB, 0x5D, 0xBF, 0x2F, 
+0xA9, 0xE1, 0x7B, 0x60, 0xB8, 0xE8, 0x4D, 0x6F, 
+0xF9, 0x70, 0xE4, 0x26, 0x40, 0xFA, 0x19, 0x36, 
+0x68, 0x79, 0x46, 0x3E, 0xB7, 0x6A, 0x01, 0x46, 
+0xA6, 0x64, 0xDB, 0xC3, 0x10, 0x81, 0x9E, 0x39, 
+0x5E, 0x88, 0xFC, 0x58, 0x42, 
+
+0x31, 0xD7, 0x0C, 0xE3, 0xD3, 0x37, 0xCA, 0xF9, 
+0x9E, 0x7E, 0x57, 0xF1, 0x45, 0x02, 0x7D, 0xDC, 
+0xA9, 0xBF, 0x73, 0x6B, 0x04, 0x16, 0x44, 0x2E, 
+0xE1, 0xCE, 0xFC, 0x54, 0xD3, 0x5C, 0x41, 0xDE, 
+0x1A, 0x6C, 0xD0, 0x25, 0xC8, 0x63, 0xC2, 0xA4, 
+0x03, 0x7D, 0x91, 0xC6, 0x7D, 0xBD, 
+
+0xCB, 0xA1, 0x1C, 0x70, 0x7E, 0x42, 0xF7, 0x32, 
+0x68, 0x56, 0x27, 0xC7, 0xFD, 0x13, 0xCE, 0xC2, 
+0xD3, 0xEE, 0x3E, 0x33, 0xF3, 0xC3, 0xBD, 0x44, 
+0x18, 0x5C, 0x29, 0xDA, 0x13, 0xA7, 0x03, 0x0E, 
+0x80, 0x4E, 0xCA, 0x50, 0x45, 0x59, 0xF1, 0x75, 
+0xE8, 0x97, 0xF8, 0x3F, 0xE3, 0x4E, 0x7A, 
+
+0x73, 0xA2, 0xA1, 0xB7, 0xC4, 0xCC, 0x5F, 0xA6, 
+0x86, 0x7A, 0xFB, 0xF9, 0x2D, 0xB0, 0x92, 0x90, 
+0xDB, 0xD1, 0xD0, 0xD4, 0x7E, 0x38, 0x41, 0x53, 
+0xFA, 0xB2, 0x02, 0xAC, 0xD2, 0x5F, 0x2B, 0xA2, 
+0x0F, 0x25, 0x93, 0xAF, 0x5B, 0xBA, 0x20, 0x87, 
+0xA5, 0xDB, 0xCA, 0xA8, 0x87, 0x63, 0xAC, 0x08, 
+
+0xA2, 0xE6, 0x67, 0xBC, 0x65, 0x03, 0x88, 0xDC, 
+0xAD, 0x8B, 0x3C, 0x28, 0x83, 0x5F, 0xE3, 0x47, 
+0x95, 0xDA, 0xF9, 0x6A, 0x01, 0x93, 0xE4, 0x67, 
+0xE4, 0x4C, 0x6D, 0x82, 0xBB, 0xBE, 0x72, 0x02, 
+0x74, 0x0E, 0x3D, 0x46, 0xB0, 0x4B, 0x3A, 0xF4, 
+0xA6, 0x16, 0x9E, 0xBA, 0x33, 0x00, 0xE2, 0x69, 
+0xE0, 
+
+0x0B, 0x76, 0x2F, 0xAC, 0x9F, 0x20, 0xA1, 0x56, 
+0x5F, 0xF9, 0x67, 0xB8, 0xDC, 0xAC, 0x48, 0xA0, 
+0x00, 0xD3, 0xFE, 0x7B, 0x82, 0xB5, 0xC0, 0xE5, 
+0xEE, 0x12, 0x9A, 0xFF, 0x58, 0x55, 0xB0, 0xD2, 
+0xA5, 0x5C, 0x4F, 0x0A, 0xFF, 0x76, 0x72, 0x3F, 
+0x22, 0x79, 0x69, 0x7C, 0x48, 0x4C, 0x5B, 0x5B, 
+0x1A, 0xC4, 
+
+0xD2, 0x73, 0x87, 0xC2, 0xF0, 0x28, 0x2A, 0x0F, 
+0xE6, 0x37, 0xC9, 0xAF, 0xF3, 0x02, 0xAC, 0xEA, 
+0x37, 0xB6, 0x83, 0xAC, 0x3B, 0x92, 0x9D, 0x2D, 
+0x52, 0x85, 0xC8, 0x53, 0xF7, 0xD7, 0x1E, 0x37, 
+0x54, 0x63, 0x7C, 0xB6, 0x57, 0x77, 0x8A, 0x23, 
+0x78, 0xA0, 0xA9, 0x1B, 0xA0, 0x4E, 0x0C, 0x22, 
+0xC8, 0x53, 0x03, 
+
+0x13, 0xF8, 0xFC, 0xDB, 0x41, 0x62, 0x9F, 0x6E, 
+0xE6, 0x9B, 0xAB, 0xE5, 0x27, 0x3B, 0x8C, 0x15, 
+0x8C, 0xBA, 0x46, 0x58, 0x30, 0x14, 0x03, 0x9F, 
+0xF2, 0xE5, 0x1D, 0x2C, 0x49, 0x91, 0x9B, 0xB1, 
+0x0A, 0xA5, 0x5A, 0x7C, 0x1D, 0xFF, 0xDD, 0xC9, 
+0xFE, 0xB7, 0x08, 0x7F, 0x71, 0x91, 0x56, 0x87, 
+0xA2, 0x06, 0x14, 0xDA, 
+
+0x01, 0x91, 0x5D, 0xDC, 0xC7, 0xB1, 0x4F, 0x6D, 
+0x73, 0x4D, 0x3B, 0x68, 0xA0, 0xA5, 0x1D, 0xA5, 
+0xA2, 0x04, 0x2F, 0xF3, 0x8F, 0xCE, 0x49, 0x92, 
+0xC3, 0x77, 0xD2, 0x4A, 0x2D, 0xA2, 0x13, 0x8A, 
+0x00, 0xB0, 0x4F, 0x38, 0xAC, 0xF7, 0x07, 0xB8, 
+0xC4, 0x9A, 0x9E, 0x0E, 0x32, 0x27, 0x83, 0x82, 
+0x15, 0xA6, 0x42, 0xEB, 0xA6, 
+
+0x56, 0x3E, 0x38, 0xC7, 0x66, 0xCE, 0xB5, 0x8F, 
+0x77, 0xA1, 0x20, 0xE2, 0x69, 0x08, 0x92, 0x23, 
+0xCC, 0x8B, 0x81, 0x3F, 0xF9, 0x8E, 0x98, 0x3B, 
+0x5A, 0xA3, 0x61, 0x5E, 0xB2, 0xF2, 0xBF, 0xA4, 
+0xAF, 0x01, 0x67, 0x2F, 0xBC, 0xFF, 0xED, 0x16, 
+0xE2, 0x0B, 0x8A, 0x2D, 0xFD, 0xA5, 0xCF, 0x17, 
+0xDC, 0x7B, 0xDD, 0xBA, 0x82, 0x71, 
+
+0xB5, 0x4B, 0x1A, 0x8C, 0x05, 0x62, 0x60, 0x7A, 
+0xCC, 0xA8, 0x63, 0x7F, 0xCC, 0xC6, 0xF2, 0x89, 
+0xDE, 0x70, 0x22, 0xF1, 0xFD, 0xD3, 0x44, 0x65, 
+0x6D, 0x55, 0xFB, 0xFA, 0x0C, 0x6B, 0x61, 0x1E, 
+0x64, 0xAE, 0x43, 0x70, 0x28, 0x89, 0x51, 0x35, 
+0xFC, 0xE8, 0xD4, 0xD7, 0xD2, 0x3E, 0x45, 0x8B, 
+0x7C, 0xBB, 0x94, 0x20, 0xBC, 0x38, 0x82, 
+
+0xDF, 0xAB, 0x2B, 0xE7, 0x84, 0x9C, 0xBB, 0x96, 
+0x3F, 0x02, 0x99, 0x65, 0x42, 0xBA, 0x95, 0x1D, 
+0x21, 0x41, 0xAC, 0xE1, 0xC5, 0x64, 0x11, 0xA3, 
+0x9A, 0x10, 0x4F, 0xE4, 0xFD, 0x87, 0x42, 0x00, 
+0xB7, 0x55, 0xB1, 0x5D, 0xBD, 0xD0, 0xDD, 0x80, 
+0x62, 0x5A, 0x2A, 0x2C, 0x1F, 0x64, 0x3B, 0x00, 
+0x91, 0x0C, 0x9B, 0xD9, 0x06, 0x2A, 0x3B, 0xFC, 
+
+0x7E, 0xEB, 0x69, 0xAB, 0x77, 0x97, 0xDA, 0xE8, 
+0x47, 0xA4, 0x6E, 0x4A, 0x1E, 0x2C, 0x23, 0x51, 
+0xA6, 0x9D, 0xC0, 0x74, 0x0D, 0xA1, 0xDD, 0x2C, 
+0x89, 0xD7, 0xE8, 0x4C, 0xB7, 0xCC, 0x06, 0x85, 
+0xD0, 0x8B, 0xE3, 0x25, 0x8D, 0xE0, 0x82, 0x00, 
+0x03, 0x4B, 0x05, 0x68, 0x34, 0xCD, 0x37, 0xC4, 
+0x28, 0x80, 0x43, 0xA0, 0xC6, 0x5B, 0xA0, 0xD6, 
+0xEA, 
+
+0xD7, 0x6E, 0x5B, 0x87, 0xD0, 0xC1, 0x68, 0x92, 
+0xD0, 0x80, 0x7D, 0x8A, 0x56, 0x83, 0x1A, 0x22, 
+0x5F, 0x0E, 0x8A, 0x09, 0x0D, 0x37, 0x43, 0x6C, 
+0xD8, 0x6F, 0x6A, 0x1C, 0x65, 0x83, 0x1A, 0x79, 
+0x9C, 0xE5, 0x56, 0xF4, 0xB9, 0x25, 0x12, 0x0B, 
+0x4B, 0xAB, 0x44, 0x3E, 0x50, 0x59, 0x26, 0xDB, 
+0x35, 0xB5, 0x3F, 0xA7, 0x66, 0xF1, 0xD1, 0xDD, 
+0x88, 0xCB, 
+
+0x54, 0xBE, 0x7D, 0xFB, 0xF5, 0xCC, 0x52, 0xC6, 
+0x9A, 0x0F, 0x25, 0x86, 0x7E, 0xCB, 0xE7, 0x52, 
+0x03, 0x8A, 0x7E, 0x94, 0x07, 0xAD, 0xD9, 0x56, 
+0xE5, 0x73, 0x8D, 0x91, 0x38, 0xB2, 0xAC, 0xA4, 
+0xD9, 0x9B, 0x8C, 0xB6, 0xED, 0x30, 0x17, 0xD1, 
+0x38, 0xDF, 0xD2, 0xFC, 0x09, 0xAF, 0xAD, 0x21, 
+0x87, 0x30, 0xD7, 0x13, 0x70, 0xA2, 0xE2, 0x29, 
+0xD9, 0x2B, 0x3B, 
+
+0xF6, 0xB6, 0x9E, 0x08, 0xB8, 0x27, 0x54, 0x94, 
+0xD1, 0xC0, 0x2D, 0x18, 0xD8, 0x1B, 0x53, 0x7B, 
+0x9A, 0x50, 0x0F, 0x9A, 0x4C, 0xAE, 0xCD, 0x17, 
+0x18, 0xFF, 0x4D, 0xE5, 0x8A, 0x0E, 0x8A, 0x98, 
+0x2F, 0x58, 0xFE, 0xEE, 0x1B, 0xA9, 0xC7, 0x1D, 
+0xEB, 0x31, 0x66, 0xB1, 0x59, 0x73, 0x25, 0x5F, 
+0x3B, 0x49, 0xDA, 0x9F, 0x26, 0x01, 0x27, 0x74, 
+0xEC, 0x5D, 0x2D, 0xB7, 
+
+0xE0, 0x22, 0x71, 0xE2, 0xCD, 0xF8, 0x3C, 0x12, 
+0xE9, 0xB3, 0xCB, 0x8B, 0x0D, 0xC1, 0x83, 0x2B, 
+0xC9, 0x30, 0x02, 0x8D, 0x14, 0x6C, 0xB0, 0xAF, 
+0x0D, 0xC3, 0x81, 0xC5, 0xF0, 0x25, 0x17, 0x9C, 
+0xB6, 0xA0, 0x25, 0x66, 0x19, 0x46, 0xDB, 0x75, 
+0x0B, 0x00, 0x75, 0xE9, 0xBE, 0x7E, 0xA7, 0x8E, 
+0x97, 0x9E, 0xA2, 0x35, 0x6C, 0x3B, 0xB3, 0xE7, 
+0x15, 0xA1, 0xA4, 0x8F, 0x9B, 
+
+0x2F, 0xCE, 0xB4, 0x86, 0x4C, 0x0A, 0x47, 0x96, 
+0xCA, 0xF2, 0xE5, 0xA4, 0x72, 0xD2, 0xBA, 0xBE, 
+0x1E, 0x1C, 0x4D, 0x96, 0x96, 0x02, 0x4E, 0x6B, 
+0xED, 0x7C, 0xE0, 0x7C, 0xAF, 0x53, 0xDA, 0x78, 
+0xA3, 0xA3, 0xEA, 0x22, 0xAC, 0x98, 0x72, 0x9C, 
+0xBC, 0xFE, 0xEE, 0x83, 0x19, 0xA9, 0x76, 0x5B, 
+0xBA, 0x0E, 0x01, 0xBE, 0xD8, 0x64, 0x8E, 0x96, 
+0xF1, 0x64, 0xAE, 0xD9, 0xF6, 0xE7, 
+
+0x62, 0x28, 0x9A, 0x03, 0xF3, 0xF2, 0x4E, 0x69, 
+0x5C, 0x13, 0xE6, 0x7F, 0x3A, 0x0F, 0xFA, 0x37, 
+0x46, 0xF4, 0x67, 0x2C, 0x3B, 0xB7, 0xAE, 0x61, 
+0x2D, 0xC6, 0x16, 0x43, 0x6B, 0x98, 0xD2, 0x3A, 
+0x64, 0xF2, 0xAC, 0xEB, 0x34, 0xFF, 0x1B, 0x92, 
+0xC1, 0xFB, 0x76, 0x92, 0x93, 0xAC, 0x99, 0x67, 
+0xF0, 0x15, 0x31, 0x9A, 0xE7, 0x6A, 0x54, 0xDF, 
+0xF1, 0xAA, 0x54, 0x04, 0xDF, 0xF4, 0x8B, 
+
+0xB9, 0x67, 0x98, 0x4F, 0xDF, 0x81, 0xBB, 0x16, 
+0xB2, 0x75, 0x0E, 0x07, 0xB5, 0xB7, 0x32, 0x05, 
+0x79, 0xDD, 0x16, 0xA0, 0xD1, 0x51, 0xCA, 0x82, 
+0x33, 0x85, 0xC5, 0x90, 0x3A, 0x36, 0x00, 0xA3, 
+0x29, 0x7C, 0xCD, 0xCB, 0xE0, 0xB0, 0xB9, 0xAC, 
+0xE1, 0x09, 0xF3, 0x83, 0xFF, 0x38, 0xF8, 0xAC, 
+0x39, 0x10, 0x71, 0x80, 0x39, 0xF6, 0x28, 0x00, 
+0xEE, 0xF5, 0xD6, 0x8A, 0xF0, 0x0C, 0x62, 0xA1, 
+
+0xFE, 0xFB, 0xA0, 0xBA, 0x29, 0x48, 0xAD, 0xB3, 
+0xE4, 0x6E, 0x7B, 0x82, 0x79, 0x0B, 0x41, 0xD4, 
+0x2F, 0xD3, 0x5B, 0xC3, 0x22, 0x98, 0x67, 0xAD, 
+0x1A, 0xAA, 0x61, 0x28, 0x8F, 0x06, 0x44, 0x6A, 
+0x4F, 0x9A, 0xAE, 0x3F, 0xDC, 0x0D, 0xA9, 0x62, 
+0x7A, 0x3F, 0xF5, 0x1F, 0x6E, 0xF3, 0x1B, 0xC2, 
+0x0E, 0x6D, 0x74, 0x57, 0x2B, 0xC8, 0xBE, 0xE6, 
+0x6E, 0xB8, 0x7F, 0x87, 0x97, 0xB4, 0x7D, 0xCE, 
+0x7F, 
+
+0x99, 0xAC, 0xEA, 0x76, 0x93, 0x12, 0xB3, 0xBA, 
+0xB4, 0x9C, 0xE3, 0x74, 0x78, 0xA5, 0x56, 0xCC, 
+0x6E, 0x75, 0x91, 0x81, 0x9A, 0x73, 0x52, 0xD2, 
+0xA0, 0xFF, 0xDC, 0x4B, 0x1C, 0x90, 0x03, 0x5B, 
+0x02, 0xE4, 0x1C, 0x4D, 0x54, 0xD9, 0x75, 0xB9, 
+0x4F, 0xCF, 0x79, 0xCD, 0x88, 0x9A, 0xE5, 0x5F, 
+0x40, 0x1A, 0x33, 0x8E, 0x0D, 0xED, 0xCB, 0x6A, 
+0x2E, 0x91, 0x26, 0x5B, 0xB3, 0xE9, 0xDB, 0x39, 
+0x22, 0x07, 
+
+0x02, 0x46, 0x4B, 0xFB, 0xD1, 0x35, 0x53, 0x2A, 
+0xDD, 0xF0, 0x8A, 0x8C, 0xA1, 0xF0, 0x6C, 0x23, 
+0xAE, 0x1C, 0x8B, 0xBD, 0xC1, 0x1A, 0x22, 0x39, 
+0xA6, 0x29, 0xFA, 0x01, 0xDF, 0xFC, 0x3A, 0x1C, 
+0xEF, 0x8C, 0x9D, 0x95, 0xAC, 0xBB, 0xED, 0x87, 
+0xD6, 0x61, 0xD2, 0x86, 0x3C, 0x26, 0xAC, 0x95, 
+0xB9, 0x32, 0xDD, 0xC8, 0x08, 0x94, 0x3E, 0x44, 
+0x08, 0x52, 0x3A, 0xD8, 0xB2, 0x93, 0x2D, 0x90, 
+0xDE, 0x46, 0xF6, 
+
+0x69, 0xDB, 0x35, 0xF4, 0xF8, 0xA5, 0xCE, 0x4C, 
+0x38, 0x51, 0x65, 0xEB, 0xEA, 0xA5, 0xAB, 0x15, 
+0xF8, 0x62, 0xDC, 0xB2, 0x56, 0x99, 0x14, 0x88, 
+0xF0, 0x4F, 0xEB, 0xA4, 0x61, 0xFC, 0x24, 0x98, 
+0xF8, 0x77, 0x84, 0xF7, 0xAC, 0xFA, 0x2B, 0xE6, 
+0x1B, 0x21, 0x99, 0x39, 0x8A, 0xE4, 0xD8, 0x13, 
+0x88, 0x18, 0x9F, 0x67, 0xA1, 0x19, 0x23, 0x34, 
+0x48, 0xFA, 0xB9, 0xA0, 0xE2, 0xC5, 0xD9, 0xC2, 
+0x99, 0x48, 0x5E, 0xF7, 
+
+0xBF, 0xC0, 0x55, 0x83, 0xE5, 0xE7, 0x01, 0xCC, 
+0xCD, 0x8C, 0x92, 0x0A, 0x8D, 0x17, 0x11, 0x4F, 
+0x14, 0xA1, 0x05, 0x1D, 0x69, 0x98, 0x91, 0x8B, 
+0x33, 0x26, 0x24, 0x79, 0xE9, 0x19, 0x4C, 0x90, 
+0x8F, 0xBE, 0x35, 0x3F, 0x56, 0x3F, 0xFD, 0xD3, 
+0xBA, 0x47, 0x77, 0x3C, 0x43, 0xF4, 0x86, 0x78, 
+0x2A, 0x3C, 0x65, 0x24, 0xBC, 0xA7, 0x69, 0x6A, 
+0xE0, 0x50, 0xB4, 0x24, 0xCF, 0x86, 0x9A, 0xD9, 
+0x05, 0x00, 0xBE, 0x14, 0xE2, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,4966 @@
+package utils
+func getkat3241_v30(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x43, 0x62, 0xCE, 0x67, 0x45, 0x6B, 0x07, 0x3C, 
+0xBF, 0xC8, 0xD5, 0x85, 0x2B, 0x59, 0x82, 0x00, 
+
+0xF2, 0x0B, 0x78, 0x4A, 0x39, 0x13, 0xAC, 0xB4, 
+0xB7, 0xE2, 0xA9, 0x47, 0x0E, 0xE1, 0x2A, 0x5E, 
+0x83, 
+
+0x94, 0x51, 0x15, 0x60, 0xFD, 0x45, 0x19, 0xB4, 
+0x30, 0xF9, 0xDA, 0x65, 0xCB, 0x93, 0x86, 0x96, 
+0x31, 0x88, 
+
+0x04, 0xD4, 0x09, 0x5C, 0x0B, 0x45, 0x0E, 0x2C, 
+0x78, 0x01, 0x52, 0x6F, 0x22, 0xB9, 0x12, 0x9C, 
+0x20, 0x3D, 0xBD, 
+
+0xDF, 0xB0, 0x11, 0x26, 0x37, 0xF5, 0x74, 0x09, 
+0xB4, 0x8A, 0x59, 0xFE, 0xD9, 0x04, 0x16, 0x37, 
+0xA8, 0x98, 0x66, 0xF2, 
+
+0x67, 0x2D, 0xFF, 0x0C, 0x50, 0x7F, 0x78, 0x3B, 
+0x5B, 0x24, 0x36, 0xBB, 0xC1, 0x7E, 0xA1, 0x4A, 
+0xD2, 0xE1, 0x6E, 0xCA, 0x67, 
+
+0xC9, 0x02, 0xA8, 0x5D, 0x57, 0x0C, 0xCB, 0xF7, 
+0x36, 0x5D, 0x78, 0x22, 0xCE, 0x1C, 0x81, 0x62, 
+0x86, 0x85, 0x98, 0xD7, 0xC6, 0x88, 
+
+0xBF, 0x1F, 0x6B, 0x54, 0x33, 0xBA, 0xB0, 0x98, 
+0x83, 0x5A, 0x95, 0x5B, 0xDA, 0x15, 0xCC, 0x44, 
+0x8F, 0x2D, 0x5F, 0x33, 0x1B, 0xF7, 0xC7, 
+
+0x56, 0xA4, 0x2A, 0xAC, 0xA0, 0xA0, 0x01, 0x81, 
+0x2F, 0xB2, 0xAA, 0xC3, 0xA6, 0x91, 0x77, 0xB3, 
+0x4B, 0x76, 0xC5, 0x3B, 0x37, 0x58, 0xEA, 0x7F, 
+
+0xF9, 0xCD, 0xFE, 0x92, 0xC2, 0xFB, 0x9F, 0xF2, 
+0x4A, 0x98, 0xF3, 0x2A, 0xB7, 0xEE, 0x03, 0xCC, 
+0x55, 0x80, 0x1E, 0x39, 0xF1, 0x88, 0xCF, 0xA6, 
+0x0D, 
+
+0x89, 0x64, 0x5E, 0x80, 0x61, 0x72, 0x91, 0x39, 
+0xB8, 0x49, 0x8F, 0x3D, 0xF4, 0x8E, 0x47, 0x2E, 
+0x9E, 0x54, 0x42, 0x88, 0xAC, 0x74, 0x67, 0xCF, 
+0xE3, 0xF0, 
+
+0x1E, 0xDB, 0x00, 0x85, 0x53, 0x3F, 0xDF, 0x32, 
+0xE6, 0xD1, 0x52, 0x8E, 0x11, 0xAF, 0x0F, 0xC1, 
+0x00, 0x46, 0x14, 0xDF, 0x54, 0x52, 0x06, 0x9C, 
+0x8E, 0xB6, 0xAE, 
+
+0x90, 0xA4, 0xFA, 0x96, 0x8C, 0x7A, 0xA5, 0x87, 
+0x53, 0x36, 0x9A, 0x66, 0xC6, 0xD8, 0x2E, 0x56, 
+0xC0, 0x35, 0x8C, 0xA8, 0x66, 0x11, 0x5F, 0x8A, 
+0x05, 0x64, 0x48, 0x15, 
+
+0x92, 0x43, 0x31, 0x4C, 0xF7, 0x73, 0xA5, 0xDC, 
+0x80, 0x27, 0x00, 0x1A, 0x52, 0x3C, 0x5E, 0x30, 
+0xA8, 0xC1, 0xA1, 0x46, 0x82, 0xF7, 0x0E, 0x8D, 
+0xAC, 0x0C, 0x98, 0x1B, 0xDF, 
+
+0x41, 0xFC, 0x5A, 0xB5, 0xC6, 0xB9, 0x3F, 0x7B, 
+0x11, 0x60, 0xBA, 0x0E, 0x13, 0x73, 0x0B, 0x84, 
+0xDF, 0x37, 0x7D, 0x3D, 0xA0, 0x4A, 0x47, 0x6A, 
+0xBA, 0x9C, 0xC9, 0x09, 0xD8, 0x92, 
+
+0x6E, 0x5B, 0xD9, 0xB6, 0x41, 0xE8, 0x7F, 0x39, 
+0xC5, 0xE6, 0xF2, 0x89, 0x3A, 0x78, 0x91, 0x5F, 
+0xF8, 0x0B, 0x1D, 0xE5, 0xCA, 0x44, 0x28, 0xE0, 
+0x25, 0xEC, 0x4F, 0xB1, 0x4F, 0x88, 0x72, 
+
+0x95, 0x22, 0x19, 0x3F, 0x57, 0xA5, 0x97, 0xC8, 
+0x54, 0xC9, 0x7C, 0x7E, 0x72, 0x63, 0xC5, 0xCF, 
+0xC2, 0x82, 0x6E, 0x39, 0xD3, 0x9E, 0x35, 0x4B, 
+0x27, 0x26, 0xC6, 0x0D, 0xAE, 0x2F, 0xF7, 0x3B, 
+
+0x75, 0x8C, 0x2C, 0x5C, 0xD3, 0xB7, 0x5E, 0xC0, 
+0xCA, 0x14, 0x47, 0x7D, 0x1E, 0x84, 0xE5, 0x33, 
+0xAB, 0x0B, 0x64, 0xA7, 0xB0, 0x35, 0xA5, 0x57, 
+0x6C, 0x26, 0xF6, 0xF3, 0x85, 0x89, 0x8C, 0x47, 
+0xFE, 
+
+0x8D, 0x1A, 0xCA, 0xC3, 0x5E, 0x79, 0xF9, 0x9E, 
+0xB0, 0x13, 0x6A, 0x10, 0xAF, 0xDF, 0x8B, 0x16, 
+0x5B, 0x4E, 0xC5, 0x38, 0xFE, 0x09, 0x8E, 0x00, 
+0x23, 0xF9, 0x2F, 0x31, 0x86, 0xA1, 0x1F, 0xA0, 
+0x82, 0x90, 
+
+0x1D, 0x78, 0xC7, 0xFF, 0x61, 0x51, 0x6D, 0xEA, 
+0xA2, 0x1F, 0x12, 0x0D, 0x79, 0xA7, 0x57, 0xDF, 
+0x0C, 0x6B, 0xDE, 0x90, 0xFA, 0x4F, 0x7C, 0xC7, 
+0xC9, 0x5B, 0xF6, 0xB8, 0xB4, 0xF6, 0x60, 0xC6, 
+0x3C, 0x8F, 0xA0, 
+
+0x2F, 0xF3, 0x66, 0x1B, 0x9E, 0x19, 0xE7, 0x34, 
+0x1C, 0x35, 0x63, 0x9D, 0xB3, 0x4A, 0xE8, 0x70, 
+0x41, 0x76, 0xA7, 0x28, 0xC8, 0x73, 0xE7, 0x77, 
+0x31, 0x57, 0x96, 0x7C, 0x8A, 0xCA, 0xD7, 0xAF, 
+0x30, 0x4B, 0x77, 0x3A, 
+
+0x6B, 0x7E, 0xB5, 0xE6, 0x22, 0x1A, 0x76, 0x20, 
+0x3C, 0x2E, 0x83, 0x0F, 0xCF, 0x24, 0x39, 0x27, 
+0x55, 0x95, 0xF5, 0xAE, 0x0D, 0x7E, 0xCE, 0x78, 
+0xF2, 0x2A, 0x8A, 0x06, 0x92, 0x2C, 0x1E, 0x17, 
+0x85, 0xD7, 0x51, 0x36, 0x64, 
+
+0x3C, 0xC6, 0xE2, 0xDF, 0x7C, 0x78, 0x67, 0x00, 
+0x63, 0xFB, 0x91, 0x60, 0x31, 0x43, 0x0A, 0x60, 
+0x3A, 0x64, 0x61, 0x81, 0xFB, 0xB3, 0xD6, 0x0B, 
+0x30, 0x65, 0x6D, 0x90, 0xC3, 0xD7, 0x6A, 0x23, 
+0x49, 0x22, 0x71, 0x44, 0x90, 0x9A, 
+
+0x96, 0xBA, 0x45, 0x04, 0x1F, 0x79, 0x84, 0xD5, 
+0x12, 0x39, 0x72, 0x51, 0x67, 0x96, 0xDC, 0x0A, 
+0x42, 0xFD, 0x07, 0x48, 0xE5, 0x9A, 0x06, 0xDD, 
+0xB1, 0x71, 0x0E, 0x70, 0xAE, 0x1E, 0x7C, 0x09, 
+0x82, 0x7F, 0xAD, 0x84, 0x46, 0x8A, 0x79, 
+
+0xB3, 0xEB, 0x9B, 0x18, 0xF0, 0xB4, 0x09, 0xAD, 
+0xDC, 0x5D, 0x03, 0xA3, 0x61, 0xF2, 0x86, 0x9F, 
+0x4D, 0x3B, 0x6A, 0x53, 0xA5, 0xF2, 0x31, 0xA5, 
+0xBF, 0xE3, 0x86, 0x70, 0xB9, 0x19, 0xE0, 0x96, 
+0x62, 0x53, 0xFB, 0xB4, 0x05, 0xCD, 0xDD, 0x64, 
+
+0xB2, 0xED, 0x5C, 0xBD, 0x05, 0xD3, 0xEB, 0xA6, 
+0xEB, 0x2B, 0xE0, 0xE8, 0x0C, 0x40, 0x6F, 0x57, 
+0x58, 0xD8, 0x76, 0xB4, 0x9D, 0x69, 0x58, 0xB6, 
+0x1B, 0x84, 0x16, 0xCF, 0xC6, 0x90, 0x27, 0x14, 
+0x2B, 0xA7, 0xB6, 0x83, 0xB0, 0xE4, 0x49, 0x6F, 
+0x0A, 
+
+0x23, 0xD4, 0xE5, 0x60, 0xF1, 0xAE, 0x3A, 0x5C, 
+0x23, 0xB3, 0xAF, 0x28, 0xF8, 0x93, 0xB6, 0x26, 
+0x28, 0x2A, 0x6B, 0x4E, 0x1D, 0x6B, 0xCC, 0xDE, 
+0xAE, 0x9B, 0x90, 0x2B, 0x24, 0xE8, 0xA4, 0x17, 
+0x1A, 0x73, 0x75, 0x0D, 0x80, 0x52, 0x55, 0x76, 
+0xE7, 0x7F, 
+
+0x2D, 0x27, 0x3E, 0x93, 0xEB, 0x92, 0x31, 0xF2, 
+0x8E, 0x6B, 0xC3, 0xD9, 0xAD, 0x2C, 0x5C, 0xFA, 
+0x9A, 0x47, 0xCE, 0x45, 0x05, 0x08, 0x16, 0xF8, 
+0x47, 0x05, 0xBD, 0xCC, 0x1D, 0xC3, 0x7B, 0xF4, 
+0x54, 0x48, 0x36, 0x39, 0xE7, 0xFF, 0xFA, 0xFA, 
+0xA8, 0xC2, 0x32, 
+
+0xEA, 0x6C, 0x63, 0x3F, 0x42, 0xF2, 0x64, 0x0F, 
+0x57, 0x7B, 0x21, 0xB1, 0xFB, 0x3E, 0x5B, 0x27, 
+0x09, 0xBE, 0x4F, 0x92, 0x92, 0xD0, 0x97, 0xEA, 
+0xBF, 0xAF, 0x6D, 0x3D, 0xB0, 0x8A, 0x0D, 0x81, 
+0x3E, 0x3F, 0xDC, 0x9C, 0xD4, 0x20, 0xBD, 0x60, 
+0xEA, 0xC4, 0x55, 0x16, 
+
+0xCC, 0xA3, 0xA2, 0xBB, 0x68, 0x65, 0xA9, 0x97, 
+0x2E, 0x6B, 0x52, 0x9F, 0xE4, 0xB6, 0x23, 0xB3, 
+0x33, 0x6B, 0xC1, 0xCB, 0xBB, 0x44, 0x32, 0x40, 
+0x41, 0x06, 0xF5, 0x18, 0x29, 0x34, 0x95, 0x33, 
+0x1E, 0x17, 0x1F, 0xE2, 0x92, 0x6C, 0x3F, 0x74, 
+0x01, 0x17, 0x6F, 0x81, 0x8F, 
+
+0x0D, 0x98, 0xF2, 0xA6, 0x0D, 0x08, 0xBA, 0xEC, 
+0xB0, 0x7D, 0xC0, 0xE4, 0xFC, 0x8C, 0xFD, 0x14, 
+0x60, 0x4C, 0xA3, 0x93, 0x6D, 0x12, 0x9F, 0xC3, 
+0x44, 0xC1, 0xBB, 0x6D, 0x0F, 0xD3, 0x78, 0x51, 
+0x30, 0xDA, 0x05, 0x59, 0x4E, 0xF8, 0x52, 0xE1, 
+0x40, 0x25, 0x5E, 0x9C, 0x95, 0xB1, 
+
+0x30, 0x2D, 0x16, 0xBA, 0x74, 0x54, 0xF6, 0xB0, 
+0x6D, 0xED, 0x82, 0xDA, 0x80, 0xED, 0x95, 0xB0, 
+0x28, 0x32, 0xBF, 0xA1, 0xC5, 0x57, 0x10, 0x1F, 
+0xC6, 0x2D, 0x5C, 0xE5, 0x64, 0x83, 0xA9, 0xEE, 
+0x7A, 0x33, 0xCE, 0x34, 0x7B, 0x7B, 0x91, 0xD9, 
+0x32, 0x8F, 0x48, 0xE6, 0x61, 0x9F, 0x19, 
+
+0xC6, 0xEC, 0x5F, 0xC9, 0x08, 0x8F, 0x0A, 0x96, 
+0xD8, 0xB6, 0x75, 0x1C, 0xAB, 0x87, 0x23, 0xD7, 
+0x57, 0xE5, 0x1A, 0xFB, 0x35, 0xEB, 0xF8, 0xC5, 
+0x96, 0x09, 0x68, 0x07, 0x56, 0x65, 0x57, 0xBE, 
+0xB8, 0xE9, 0x05, 0xD5, 0xFB, 0x27, 0x79, 0x7D, 
+0xFA, 0x07, 0xD3, 0x1C, 0xBB, 0x94, 0x2D, 0x06, 
+
+0x93, 0xBD, 0x40, 0x8B, 0x07, 0xC8, 0x8C, 0x94, 
+0x36, 0x9B, 0x26, 0x8D, 0x65, 0x80, 0x4E, 0x89, 
+0xFF, 0x18, 0x25, 0x62, 0xDE, 0x3E, 0x86, 0x2F, 
+0x86, 0x6E, 0xB0, 0x77, 0xA6, 0x0C, 0x83, 0x19, 
+0xE5, 0xFA, 0x39, 0xD4, 0xE0, 0x18, 0x3F, 0xE7, 
+0x55, 0x14, 0xA7, 0xC7, 0xE3, 0xE8, 0x3C, 0xE1, 
+0x18, 
+
+0x19, 0xE7, 0x5B, 0x5A, 0xC9, 0xDC, 0x3A, 0x40, 
+0x61, 0x61, 0x1A, 0xED, 0x67, 0x25, 0x86, 0x4C, 
+0x6C, 0xAA, 0x67, 0xB3, 0xC5, 0x8D, 0xC6, 0xFD, 
+0xCB, 0xA9, 0x4A, 0x36, 0xF2, 0x9D, 0x21, 0x32, 
+0xDB, 0x17, 0x87, 0xF4, 0x59, 0x2B, 0xE5, 0x5D, 
+0xDE, 0x44, 0xE5, 0x89, 0x34, 0x30, 0xCB, 0xF0, 
+0x39, 0x01, 
+
+0x7F, 0xBE, 0xDA, 0x4B, 0xCA, 0x3A, 0x16, 0x7F, 
+0x82, 0x28, 0xA3, 0xEE, 0x4F, 0x5C, 0xF8, 0xB4, 
+0xE9, 0xFF, 0xE4, 0x33, 0x5C, 0x77, 0x48, 0x7F, 
+0x19, 0xB3, 0xC6, 0x4C, 0x12, 0x75, 0x9E, 0xA6, 
+0x92, 0x0D, 0x31, 0xA1, 0x93, 0x51, 0x9E, 0x88, 
+0xEB, 0xF9, 0xFB, 0xB7, 0x32, 0x22, 0x91, 0xAA, 
+0x07, 0x48, 0x6D, 
+
+0x04, 0xF2, 0xDF, 0x6D, 0x3F, 0x25, 0x69, 0x37, 
+0x26, 0xCC, 0x0A, 0x57, 0x01, 0x4D, 0xAE, 0xC2, 
+0xB8, 0xFA, 0x84, 0xF0, 0xEE, 0xF6, 0x78, 0x9E, 
+0x59, 0xF8, 0xEB, 0x21, 0x52, 0x45, 0xA4, 0x4E, 
+0x7C, 0x61, 0x04, 0x72, 0xF1, 0xA2, 0xF0, 0x2C, 
+0x10, 0x61, 0xC2, 0x6B, 0x5D, 0x29, 0xC0, 0x43, 
+0x4A, 0x00, 0xE2, 0x44, 
+
+0xB9, 0x42, 0x5C, 0x25, 0xB8, 0x5D, 0xB6, 0xAF, 
+0x3B, 0x02, 0xDA, 0x62, 0x51, 0x3E, 0x8C, 0x30, 
+0x09, 0x98, 0x59, 0x79, 0xF1, 0xCA, 0x6F, 0xD0, 
+0x27, 0xAD, 0xEF, 0x04, 0xC5, 0xE1, 0xC3, 0x21, 
+0xED, 0x42, 0x26, 0x77, 0x7B, 0xA2, 0xB9, 0x55, 
+0x68, 0x2A, 0x3E, 0xC4, 0x55, 0xA0, 0x65, 0x9B, 
+0x63, 0x4A, 0x2C, 0x6D, 0xAB, 
+
+0xE7, 0xA0, 0x64, 0x3D, 0x1F, 0xBF, 0x1C, 0x69, 
+0x05, 0x7C, 0xF3, 0x46, 0xCC, 0xB4, 0x0E, 0xEE, 
+0xD1, 0x99, 0x3A, 0x06, 0xFF, 0x6D, 0x0E, 0x84, 
+0xE9, 0x84, 0xCC, 0xCA, 0xAE, 0xCB, 0x0B, 0xD9, 
+0x30, 0x88, 0x2B, 0x79, 0x1F, 0xD7, 0x5C, 0x89, 
+0xC5, 0x91, 0x9D, 0x9D, 0xD7, 0xF0, 0x5E, 0x78, 
+0xB0, 0xF2, 0x23, 0xB8, 0xB9, 0x92, 
+
+0x89, 0xCD, 0x21, 0x0A, 0xD7, 0xA7, 0xC5, 0x6A, 
+0xDD, 0x84, 0xA4, 0xC7, 0x2C, 0x24, 0xF4, 0x37, 
+0xB6, 0x72, 0x32, 0x5F, 0x13, 0x90, 0x99, 0x27, 
+0xCC, 0xDD, 0xE9, 0xED, 0x65, 0x4F, 0xF0, 0x9D, 
+0x39, 0xC1, 0xF6, 0x3B, 0xB3, 0x16, 0x5D, 0x0E, 
+0xCF, 0xA4, 0x79, 0x50, 0x57, 0x2B, 0xDC, 0x9B, 
+0x24, 0x4E, 0xCF, 0xF4, 0x04, 0x30, 0xE5, 
+
+0x14, 0x96, 0x82, 0x0F, 0x4C, 0x25, 0xE7, 0xA4, 
+0xA9, 0x11, 0x84, 0xB1, 0xA4, 0x7E, 0xAD, 0x2F, 
+0xFB, 0x6A, 0xE8, 0xB9, 0xA0, 0xA9, 0x6E, 0x48, 
+0xD3, 0xDB, 0xFA, 0xFC, 0x1B, 0xCE, 0x1C, 0x78, 
+0xB2, 0x4C, 0xCC, 0x61, 0x42, 0x19, 0xA3, 0xF5, 
+0x1F, 0x06, 0xA7, 0x12, 0x3C, 0x17, 0x22, 0x0B, 
+0x93, 0xFE, 0x9A, 0xF3, 0x22, 0xA5, 0xF9, 0xCF, 
+
+0x31, 0x0D, 0xD4, 0x87, 0x8B, 0x43, 0x46, 0x8C, 
+0xBD, 0xD1, 0x41, 0x65, 0x79, 0x28, 0x66, 0x56, 
+0x56, 0x1C, 0xBA, 0x38, 0xDB, 0x1A, 0x6B, 0x2D, 
+0xB9, 0x90, 0x23, 0x47, 0xAB, 0xE4, 0x0C, 0x9B, 
+0xDC, 0xA0, 0x28, 0x7F, 0xE4, 0x14, 0x7B, 0x4F, 
+0x4B, 0x13, 0xC4, 0x7D, 0x21, 0x62, 0x2A, 0x18, 
+0x1E, 0xA0, 0xA0, 0xB7, 0xA1, 0xDE, 0xC4, 0x52, 
+0x9F, 
+
+0x9F, 0x4D, 0xF5, 0xDF, 0x58, 0x5D, 0xF1, 0xB1, 
+0x05, 0xCB, 0x18, 0xA5, 0xD5, 0xFC, 0xA5, 0xE0, 
+0x27, 0x0E, 0x98, 0x2E, 0x92, 0xFF, 0x22, 0x7C, 
+0x17, 0x19, 0xA4, 0x3C, 0xF0, 0xDA, 0xA2, 0xA3, 
+0x14, 0x85, 0xD5, 0xCB, 0x65, 0xBD, 0xA9, 0x86, 
+0x9F, 0xB0, 0x6C, 0x6B, 0x7A, 0x09, 0x4C, 0x66, 
+0x11, 0x4F, 0x57, 0x49, 0xD9, 0x5E, 0x5C, 0xE0, 
+0xD5, 0x63, 
+
+0x33, 0xE7, 0x91, 0xD9, 0x7A, 0x71, 0xE5, 0xC5, 
+0xCC, 0xF8, 0xAC, 0xA8, 0xCE, 0x51, 0xBB, 0x37, 
+0x7C, 0x69, 0xC8, 0x03, 0xF2, 0xAA, 0x2B, 0xE8, 
+0xEE, 0x0C, 0xF2, 0x80, 0xA3, 0x81, 0x82, 0x4A, 
+0xE0, 0xFE, 0xA2, 0xCB, 0xE3, 0x92, 0xF5, 0xFB, 
+0xAF, 0x93, 0x48, 0xC4, 0x51, 0xCF, 0x3E, 0x32, 
+0x48, 0x9B, 0x46, 0x97, 0x13, 0x7A, 0x7C, 0x92, 
+0x6A, 0x98, 0x78, 
+
+0xCD, 0xB9, 0x47, 0x51, 0xBC, 0x14, 0x00, 0x50, 
+0x64, 0xF7, 0x2B, 0xA9, 0x00, 0xF8, 0x3D, 0x2C, 
+0x41, 0xFE, 0x2E, 0x53, 0x60, 0xA7, 0x1B, 0xB1, 
+0x39, 0x16, 0x0B, 0xDE, 0xDC, 0xF4, 0x3A, 0x90, 
+0x99, 0x93, 0x61, 0x59, 0x52, 0xF5, 0xDF, 0x6C, 
+0xDB, 0xCF, 0xCE, 0x4A, 0x5C, 0x49, 0x6B, 0xED, 
+0x3A, 0xD9, 0x56, 0xE3, 0x66, 0xE1, 0xFF, 0x78, 
+0xEB, 0x34, 0x3F, 0x9F, 
+
+0xAA, 0xEB, 0x8D, 0x8E, 0xB8, 0xFF, 0x0F, 0x39, 
+0x6C, 0x9A, 0x3E, 0x33, 0xDD, 0x94, 0x26, 0x41, 
+0x57, 0x3B, 0x94, 0xAE, 0x42, 0x79, 0xAE, 0xB8, 
+0x2C, 0xB8, 0xD3, 0x21, 0x3A, 0xA0, 0x43, 0xF2, 
+0xC7, 0x7A, 0x9B, 0x6E, 0x72, 0x86, 0x52, 0xB1, 
+0x70, 0xBF, 0xFB, 0x9E, 0x87, 0x8B, 0xCA, 0x6E, 
+0x45, 0x3D, 0xED, 0xCC, 0x19, 0x95, 0x74, 0xCE, 
+0x4A, 0x4D, 0xC6, 0x35, 0x30, 
+
+0xB3, 0xBF, 0x6E, 0x63, 0x19, 0x55, 0x03, 0x3A, 
+0xD7, 0x6D, 0xB4, 0x3A, 0xC6, 0x43, 0x6E, 0xAB, 
+0x97, 0x30, 0xE7, 0xAD, 0xD5, 0xA1, 0x7B, 0x61, 
+0x16, 0x03, 0x25, 0x3B, 0xE6, 0xDC, 0x71, 0x88, 
+0x69, 0xF4, 0x9F, 0x99, 0xD0, 0x80, 0x26, 0x2A, 
+0xBE, 0x5F, 0x00, 0x7C, 0xE0, 0x0D, 0xFF, 0xC4, 
+0x3B, 0x62, 0x20, 0xA2, 0x0E, 0x3A, 0x28, 0x22, 
+0x64, 0xA5, 0xB8, 0xF9, 0x2B, 0x78, 
+
+0x85, 0x68, 0xD0, 0x1B, 0xB7, 0xBE, 0x95, 0xC9, 
+0x50, 0x1D, 0x7F, 0xE4, 0x71, 0xA6, 0x00, 0xD1, 
+0xE8, 0xC0, 0x4F, 0xCF, 0xD9, 0xBE, 0xE2, 0x99, 
+0x11, 0x07, 0x3A, 0xD6, 0x13, 0x07, 0x7D, 0xAD, 
+0xC1, 0x04, 0xCD, 0x31, 0x49, 0x70, 0x14, 0xC0, 
+0x19, 0xBF, 0x03, 0x7C, 0xC6, 0xF1, 0x06, 0xAC, 
+0x29, 0x97, 0x31, 0xAF, 0xD7, 0x75, 0x1A, 0xBD, 
+0xB6, 0x73, 0xE4, 0x7E, 0x62, 0x0A, 0x28, 
+
+0x10, 0xD7, 0xAA, 0x2B, 0x27, 0xF9, 0xD3, 0x2F, 
+0x33, 0xC7, 0x02, 0x1E, 0x9D, 0x66, 0xB2, 0xE4, 
+0x25, 0xF2, 0x27, 0x19, 0xBB, 0x8E, 0xFB, 0x62, 
+0x8E, 0xFE, 0x21, 0x23, 0x5D, 0x3D, 0xA0, 0x95, 
+0xD5, 0xCD, 0x07, 0xDA, 0xC4, 0xE4, 0x37, 0x4E, 
+0x1A, 0xBC, 0x41, 0x77, 0x1C, 0x00, 0x64, 0xA3, 
+0x88, 0x71, 0x78, 0x1B, 0x8B, 0x26, 0x23, 0x80, 
+0xFB, 0x67, 0x1A, 0x96, 0x3A, 0xC6, 0xE5, 0x79, 
+
+0x19, 0xAC, 0x72, 0x2E, 0x3F, 0xE1, 0x94, 0x71, 
+0x37, 0x04, 0x7A, 0x81, 0x72, 0x72, 0x5D, 0x31, 
+0x08, 0x01, 0x9C, 0xE0, 0x0C, 0x99, 0x6B, 0x79, 
+0xA9, 0x5B, 0x78, 0x31, 0x51, 0xB6, 0xB5, 0x98, 
+0xA5, 0x87, 0x32, 0x49, 0xF1, 0x20, 0x40, 0xDF, 
+0xCB, 0x47, 0xEA, 0xC1, 0xE4, 0x08, 0xCA, 0xC8, 
+0x3B, 0x88, 0x08, 0x67, 0x9A, 0x95, 0x61, 0xBA, 
+0x1C, 0x2C, 0x5D, 0x33, 0x0B, 0xC5, 0x18, 0xF1, 
+0x50, 
+
+0xD8, 0xEB, 0x64, 0x7A, 0xFE, 0x9C, 0x6F, 0x98, 
+0x8B, 0xB1, 0xDF, 0x5A, 0x59, 0x8B, 0x8C, 0x0F, 
+0x4A, 0x74, 0x45, 0xE6, 0x06, 0x56, 0x56, 0x3F, 
+0xCC, 0x0D, 0xC4, 0x96, 0xF2, 0xF9, 0x9C, 0xE4, 
+0xBC, 0x11, 0x0D, 0x9E, 0x48, 0x8D, 0x0B, 0xEE, 
+0xC2, 0x4B, 0x2D, 0xC1, 0xAF, 0xC7, 0xD1, 0xCA, 
+0x3D, 0x2D, 0xEB, 0x89, 0x61, 0xC9, 0x23, 0x20, 
+0xBC, 0x87, 0x6B, 0x82, 0x08, 0x28, 0x19, 0x15, 
+0x4A, 0xBC, 
+
+0x37, 0xDE, 0x0C, 0x3D, 0x30, 0x1C, 0x68, 0xF1, 
+0x53, 0x66, 0x98, 0xBB, 0x10, 0xC9, 0x5C, 0x81, 
+0x3F, 0x24, 0x14, 0x2F, 0xB0, 0x25, 0xDC, 0x01, 
+0x31, 0x1B, 0x23, 0x7F, 0xA5, 0x80, 0x2A, 0x4B, 
+0x84, 0x80, 0x3A, 0x72, 0x50, 0x85, 0xC7, 0x0D, 
+0x90, 0x49, 0xAB, 0x3A, 0xE7, 0x75, 0xCF, 0x0D, 
+0xCE, 0xB5, 0x5B, 0x77, 0xEE, 0x69, 0x59, 0x19, 
+0xCC, 0x20, 0x3F, 0x63, 0xF5, 0xA5, 0x9F, 0xDF, 
+0x44, 0x7B, 0x02, 
+
+0x9C, 0xD1, 0xE7, 0x28, 0xF5, 0x67, 0x91, 0xF1, 
+0x72, 0x1E, 0x33, 0x4A, 0xDC, 0xE1, 0x6A, 0x8E, 
+0x2F, 0xB7, 0xBD, 0xE3, 0xA2, 0x8B, 0xB8, 0xFC, 
+0x74, 0xAC, 0x04, 0x2E, 0x4D, 0xDE, 0x38, 0xDA, 
+0xC0, 0x16, 0xB1, 0x09, 0x59, 0xCF, 0xCC, 0xE4, 
+0x07, 0xF6, 0x4F, 0x4A, 0x63, 0xDB, 0x38, 0x0A, 
+0xE8, 0x86, 0x6A, 0x46, 0x84, 0xD3, 0x6B, 0x11, 
+0xE7, 0x2C, 0x64, 0x07, 0x75, 0xA8, 0x97, 0x4E, 
+0x1B, 0x6A, 0x3C, 0x6E, 
+
+0x5A, 0x65, 0x5A, 0xB5, 0x31, 0xB9, 0x4D, 0x76, 
+0x53, 0x06, 0x28, 0xE9, 0x3E, 0x19, 0x3D, 0x7A, 
+0x58, 0xB4, 0x01, 0x93, 0x9E, 0xD8, 0x17, 0x1F, 
+0x26, 0x2C, 0xFB, 0x43, 0xE3, 0x5E, 0x54, 0x72, 
+0x72, 0x46, 0x1E, 0x2F, 0x63, 0xFA, 0xD5, 0x4B, 
+0xFE, 0x69, 0x6A, 0xCF, 0x30, 0x97, 0xF2, 0x4B, 
+0x73, 0x52, 0xDE, 0xFB, 0x21, 0x19, 0xC6, 0x35, 
+0x99, 0xAD, 0x53, 0x22, 0x56, 0xCE, 0x44, 0xFE, 
+0xDA, 0x41, 0x08, 0x3A, 0x67, 
+
+0x82, 0xAB, 0x78, 0x01, 0x5B, 0xA3, 0x36, 0xD7, 
+0xF1, 0x7A, 0x51, 0xB2, 0xDA, 0xF9, 0x19, 0x61, 
+0x42, 0x5C, 0xB0, 0xEE, 0x25, 0x87, 0x06, 0x13, 
+0xC4, 0x1F, 0x0D, 0x4F, 0x64, 0x28, 0xAD, 0x16, 
+0x6C, 0xA4, 0x13, 0x6D, 0x2E, 0x15, 0x76, 0xAE, 
+0x90, 0x9E, 0x17, 0xB6, 0x37, 0xF2, 0xCE, 0xD4, 
+0x85, 0x14, 0xB9, 0x74, 0x46, 0x24, 0x0E, 0x8C, 
+0xB0, 0x54, 0x46, 0x44, 0xA3, 0x13, 0x8F, 0x47, 
+0x10, 0xA2, 0x39, 0x76, 0x6E, 0x84, 
+
+0xBE, 0xFC, 0x84, 0x3B, 0xB5, 0x59, 0x41, 0xA2, 
+0xCA, 0xF9, 0x4D, 0x1D, 0xC7, 0x32, 0xFC, 0x9C, 
+0x33, 0x81, 0xA5, 0xF1, 0xF9, 0x06, 0xD7, 0x92, 
+0xDD, 0xDF, 0xE3, 0xB0, 0x1E, 0x92, 0x25, 0xF0, 
+0xEC, 0x94, 0x06, 0x43, 0xDC, 0xC3, 0xB7, 0x2E, 
+0x4D, 0x00, 0xBC, 0xED, 0x90, 0xAC, 0x4C, 0x99, 
+0x0E, 0x1B, 0x74, 0x2E, 0x34, 0xE3, 0x44, 0xF7, 
+0x0C, 0xB2, 0x4D, 0x6F, 0xCB, 0x59, 0x5C, 0x82, 
+0x99, 0xF7, 0xC6, 0x4F, 0xD0, 0x56, 0x69, 
+
+0xA9, 0x18, 0x4E, 0x98, 0x4E, 0x8F, 0x7D, 0x12, 
+0xEA, 0x9E, 0xBD, 0xF9, 0x83, 0xC1, 0xD0, 0xCA, 
+0x8F, 0xF2, 0xB0, 0x04, 0xF4, 0x08, 0x12, 0x69, 
+0x9F, 0x0C, 0xBD, 0xA5, 0xEB, 0x0A, 0x82, 0x7D, 
+0x7E, 0x6D, 0xFC, 0x43, 0xF1, 0x2A, 0x2E, 0x83, 
+0x39, 0x3E, 0x44, 0xD9, 0xC1, 0xDE, 0xD4, 0x33, 
+0xA8, 0x89, 0x04, 0x58, 0x58, 0x74, 0x1A, 0xD2, 
+0x9B, 0x6F, 0x9B, 0x5B, 0xBC, 0x5B, 0xDD, 0x9D, 
+0x9F, 0x47, 0xFC, 0xAF, 0xBF, 0x27, 0xF6, 0x03, 
+
+0x65, 0xAE, 0x1F, 0x54, 0x00, 0x97, 0xEB, 0x36, 
+0xF8, 0xD1, 0xD4, 0xE3, 0x91, 0x5C, 0x36, 0x8B, 
+0xA1, 0x25, 0xBF, 0xAD, 0x04, 0xF6, 0x4C, 0xD4, 
+0x87, 0x14, 0x96, 0x55, 0xAF, 0xB1, 0x80, 0x7F, 
+0x09, 0x7C, 0x4A, 0xC2, 0x10, 0x65, 0x36, 0xB1, 
+0x85, 0xC1, 0x41, 0x00, 0x18, 0x12, 0xEB, 0xFF, 
+0xB5, 0xC0, 0xF3, 0x10, 0xAE, 0x3C, 0x47, 0x33, 
+0x7A, 0x1C, 0x4B, 0x77, 0x81, 0x7E, 0xF3, 0x54, 
+0xE0, 0x5C, 0x27, 0x66, 0xE7, 0xA1, 0x85, 0x48, 
+0x28, 
+
+0xBE, 0x2F, 0x4D, 0xDA, 0x45, 0xD2, 0x4D, 0x7F, 
+0x94, 0x37, 0xE1, 0xD6, 0x52, 0x48, 0xC9, 0xE8, 
+0x54, 0xB4, 0x08, 0xFA, 0x5F, 0xB5, 0x0D, 0xDB, 
+0xA2, 0x9A, 0xAA, 0x09, 0xE2, 0xA1, 0xFF, 0x9E, 
+0x8E, 0xFA, 0x3E, 0x95, 0x82, 0x58, 0xB1, 0x83, 
+0xF5, 0x26, 0x4B, 0xE6, 0x36, 0xA6, 0xC2, 0xF4, 
+0xBF, 0xE0, 0xBB, 0x49, 0x42, 0xAF, 0xDC, 0xBC, 
+0xFC, 0xE4, 0x67, 0xB7, 0xA9, 0xD9, 0xB5, 0xF2, 
+0x30, 0x48, 0x2E, 0x37, 0x17, 0xAF, 0x5A, 0x16, 
+0xD0, 0x5D, 
+
+0x59, 0x13, 0xAB, 0x22, 0x1F, 0x7E, 0x6C, 0xA3, 
+0x0C, 0x1D, 0xBB, 0x4B, 0x39, 0x28, 0xBE, 0x0C, 
+0x5D, 0x7C, 0xFD, 0xFE, 0x36, 0xC7, 0x77, 0x63, 
+0x94, 0x8A, 0x0C, 0x65, 0x07, 0x38, 0x4A, 0xBB, 
+0x8B, 0x09, 0x8F, 0x6F, 0xFC, 0xB7, 0x6E, 0x93, 
+0x2B, 0x0A, 0x4D, 0x97, 0x73, 0x5F, 0x04, 0x15, 
+0x12, 0x1A, 0x33, 0x22, 0x02, 0x48, 0xE6, 0x90, 
+0x7A, 0xFB, 0x4F, 0xB5, 0x0C, 0x85, 0x84, 0x77, 
+0x28, 0xE1, 0xD9, 0xF6, 0xE7, 0xB0, 0x28, 0x7B, 
+0xD1, 0x0F, 0x00, 
+
+0x70, 0xC6, 0xDA, 0xFA, 0xD1, 0x0D, 0x88, 0x62, 
+0x3F, 0x56, 0x08, 0x27, 0x93, 0xD5, 0x1B, 0xCB, 
+0x00, 0x59, 0x73, 0x15, 0xDF, 0xD9, 0xE1, 0x47, 
+0x4C, 0x2A, 0x48, 0x45, 0xCE, 0x70, 0x33, 0x32, 
+0xD9, 0x53, 0x10, 0x85, 0xC5, 0x4E, 0xD6, 0x85, 
+0x19, 0x92, 0xCE, 0x45, 0x49, 0x11, 0x39, 0x28, 
+0xE7, 0xBD, 0xA1, 0x30, 0xF4, 0xB5, 0x97, 0x37, 
+0x25, 0xCA, 0x48, 0x09, 0xAA, 0x93, 0x5C, 0xCA, 
+0xC6, 0x69, 0x1E, 0xFE, 0x46, 0xB7, 0x28, 0x8C, 
+0x19, 0x86, 0xCF, 0xF6, 
+
+0x21, 0xAA, 0xFD, 0x3D, 0x8B, 0x6D, 0x82, 0x1D, 
+0xC3, 0x15, 0xED, 0x69, 0x90, 0x48, 0x61, 0x57, 
+0xB5, 0x4C, 0xB2, 0xAF, 0x63, 0x9F, 0xF5, 0x02, 
+0x64, 0x90, 0x2B, 0xA6, 0x77, 0x17, 0xA5, 0x50, 
+0xA5, 0xC9, 0x9B, 0x18, 0x00, 0xD3, 0x2D, 0xC0, 
+0x47, 0x98, 0xCE, 0x85, 0x19, 0xA8, 0xDC, 0x3C, 
+0xC2, 0x3D, 0x16, 0xC3, 0x84, 0x5E, 0xF1, 0x27, 
+0xC4, 0xBB, 0x5B, 0xD8, 0x9F, 0x37, 0x53, 0x8B, 
+0x27, 0x78, 0xAC, 0x27, 0x06, 0x4C, 0x14, 0x84, 
+0xCE, 0x79, 0x14, 0xAC, 0xD3, 
+
+0x85, 0x20, 0xE5, 0x16, 0x0E, 0x65, 0x8C, 0x74, 
+0x8B, 0xDA, 0x34, 0x6F, 0xA2, 0xBF, 0x34, 0xFA, 
+0xBD, 0xA8, 0xC6, 0xA7, 0x0B, 0x30, 0x09, 0xF5, 
+0x48, 0x22, 0xD9, 0xB9, 0x47, 0x44, 0xBD, 0xE0, 
+0x3E, 0x24, 0x3D, 0x7A, 0xB6, 0x3B, 0xBE, 0x5C, 
+0x2C, 0x33, 0x62, 0xDA, 0x91, 0x38, 0x95, 0x67, 
+0x03, 0x50, 0xD3, 0x1E, 0xA2, 0x9C, 0xAE, 0xFF, 
+0xE0, 0xB6, 0x7A, 0x25, 0x1C, 0x4D, 0x12, 0x63, 
+0x2D, 0x7C, 0x22, 0xE4, 0x1C, 0x9A, 0x70, 0x58, 
+0xC3, 0x45, 0xE7, 0x15, 0x07, 0x8F, 
+
+0xAB, 0x08, 0xF9, 0x5B, 0x37, 0xF2, 0x09, 0xBC, 
+0x27, 0x13, 0xB6, 0xEC, 0x44, 0xD7, 0x80, 0x14, 
+0x6A, 0x6D, 0x8A, 0xCD, 0xB4, 0xE8, 0x05, 0xAE, 
+0xA3, 0xE5, 0x2E, 0x4C, 0x5C, 0x4E, 0x9E, 0x4A, 
+0xFE, 0xA7, 0xDD, 0xF4, 0xD5, 0xDE, 0x50, 0xDA, 
+0xEC, 0x45, 0x28, 0xD7, 0x14, 0x1F, 0xDE, 0x91, 
+0x30, 0x7D, 0x3B, 0xD0, 0x4D, 0x84, 0x4E, 0x6B, 
+0xDB, 0x4B, 0x63, 0x60, 0x2A, 0xB7, 0x24, 0xCB, 
+0x5F, 0x92, 0xF4, 0x78, 0x36, 0x7F, 0xA4, 0xF7, 
+0x62, 0xCB, 0x3C, 0x52, 0x18, 0x1A, 0xA3, 
+
+0x1D, 0xF9, 0xB7, 0x6A, 0xB4, 0x97, 0xA4, 0x2E, 
+0xF7, 0xA6, 0x3C, 0x4C, 0x3B, 0x74, 0x77, 0x2B, 
+0x4E, 0xFA, 0xA6, 0xFD, 0xE8, 0x14, 0xEF, 0x08, 
+0xA7, 0x2A, 0x54, 0x5F, 0x46, 0xA3, 0x82, 0x2C, 
+0x04, 0xF8, 0x30, 0x48, 0x54, 0xA8, 0x77, 0xB6, 
+0x3A, 0x5C, 0x23, 0x3D, 0xA2, 0x55, 0xFE, 0xFD, 
+0x2A, 0x7D, 0x8F, 0xA7, 0x91, 0x54, 0x5D, 0x82, 
+0xF1, 0x20, 0x8A, 0x3F, 0x2F, 0xC7, 0x6E, 0x4F, 
+0xC8, 0x14, 0x6C, 0x1B, 0xFD, 0xF3, 0xB9, 0x6B, 
+0x1B, 0xDE, 0x3F, 0x1A, 0xB2, 0x00, 0x52, 0x8F, 
+
+0xB4, 0x22, 0x46, 0xDB, 0x68, 0x04, 0x53, 0x50, 
+0x17, 0xF4, 0x54, 0x3D, 0x6E, 0x52, 0x9A, 0xC4, 
+0x25, 0x8B, 0xD7, 0x44, 0xFA, 0x3C, 0x44, 0x8F, 
+0xEB, 0xAC, 0xE3, 0xE8, 0xB7, 0xFE, 0x43, 0xB2, 
+0xBA, 0xA4, 0xD0, 0x31, 0xFE, 0x92, 0x58, 0x04, 
+0x8B, 0x0C, 0xF7, 0x2C, 0x38, 0x34, 0xF2, 0x0F, 
+0xAE, 0xBC, 0x51, 0x1E, 0x27, 0x86, 0x76, 0x05, 
+0x09, 0x79, 0x70, 0x5E, 0xBA, 0xF8, 0x83, 0x4E, 
+0x16, 0x84, 0x33, 0x65, 0x61, 0x94, 0xA9, 0x68, 
+0xDF, 0xE3, 0xBF, 0x55, 0xD0, 0x36, 0xF1, 0x29, 
+0x2C, 
+
+0xF6, 0x76, 0x35, 0x6D, 0xD0, 0x88, 0x1D, 0xE9, 
+0xF0, 0xA1, 0x76, 0x88, 0xAB, 0xE2, 0xBF, 0x7B, 
+0x2D, 0x22, 0xD9, 0x9F, 0xCE, 0xE1, 0x1F, 0xB9, 
+0x88, 0xCB, 0x91, 0x1C, 0x53, 0xAD, 0x65, 0x9F, 
+0x0D, 0x98, 0x57, 0xDC, 0x6A, 0x59, 0xC4, 0x98, 
+0x93, 0xFE, 0x8C, 0xCA, 0x93, 0x74, 0xE5, 0x02, 
+0x30, 0xFC, 0x05, 0x6F, 0xA9, 0x57, 0xFB, 0x04, 
+0x68, 0x9E, 0xE4, 0x18, 0xA6, 0x58, 0xAF, 0x48, 
+0x8D, 0x8A, 0xDB, 0x96, 0xED, 0x3D, 0xDA, 0xE4, 
+0x7C, 0x01, 0x3A, 0x6F, 0x0E, 0x59, 0x1D, 0x4A, 
+0x80, 0x9E, 
+
+0x4A, 0xE8, 0xD0, 0xF1, 0x38, 0x45, 0x1C, 0x57, 
+0x41, 0xDD, 0xBA, 0xFC, 0xDB, 0x49, 0xE0, 0x4F, 
+0x64, 0xEF, 0x53, 0x03, 0x44, 0x43, 0x3B, 0x4B, 
+0xE4, 0x1A, 0x59, 0xE3, 0x7B, 0xD9, 0xE1, 0x2D, 
+0x44, 0x44, 0xBA, 0x58, 0x40, 0x4D, 0xC8, 0x07, 
+0xC7, 0x69, 0xAC, 0x1B, 0x97, 0x58, 0x58, 0xBA, 
+0xAD, 0x70, 0xAB, 0x82, 0xCA, 0xFB, 0x5C, 0x0D, 
+0x90, 0x18, 0x35, 0x77, 0xDB, 0x03, 0x5D, 0x7E, 
+0x10, 0xC9, 0x46, 0x37, 0xE4, 0xE9, 0x23, 0x54, 
+0x9A, 0x65, 0xD6, 0x99, 0xDB, 0xA4, 0x7D, 0x1C, 
+0x5F, 0xEF, 0x1E, 
+
+0x2F, 0xB0, 0xB7, 0x50, 0x10, 0x69, 0xD9, 0xE6, 
+0xC5, 0xB2, 0xCC, 0x63, 0xF1, 0xAB, 0x2E, 0x5C, 
+0xE3, 0xC3, 0x69, 0xD7, 0xE2, 0x3B, 0xE4, 0xBC, 
+0x6C, 0x60, 0xCB, 0xAF, 0x12, 0x76, 0x69, 0xF4, 
+0x92, 0x1F, 0x89, 0x84, 0x07, 0x95, 0x22, 0x91, 
+0x4C, 0x97, 0xF1, 0xF4, 0x35, 0x7E, 0x8B, 0xB2, 
+0x9D, 0xBF, 0x51, 0xFB, 0x51, 0xCB, 0xFF, 0x18, 
+0x4B, 0xC0, 0xB9, 0x55, 0xB0, 0x32, 0x7A, 0xA3, 
+0x6C, 0xFE, 0xA3, 0xC7, 0x0B, 0x87, 0xCF, 0xE4, 
+0xCF, 0x5D, 0x82, 0x71, 0x36, 0x78, 0x52, 0x9E, 
+0xB2, 0x71, 0x75, 0x27, 
+
+0x38, 0xEC, 0xB9, 0xC6, 0x23, 0xB5, 0x14, 0xFC, 
+0x47, 0x21, 0x38, 0x87, 0xC8, 0x7A, 0x3A, 0x72, 
+0xB5, 0x64, 0x6F, 0x0B, 0x96, 0x2D, 0x8B, 0xE1, 
+0x78, 0x4D, 0x80, 0xE4, 0x3F, 0x38, 0xF4, 0xC2, 
+0xEB, 0x76, 0xBC, 0xEF, 0x68, 0xF7, 0x34, 0xE5, 
+0xAB, 0xDF, 0x61, 0x1E, 0x7A, 0x83, 0xDC, 0xD8, 
+0xA8, 0xA6, 0xA8, 0xA2, 0xA6, 0xC2, 0x36, 0x95, 
+0x7E, 0x01, 0xB7, 0xF4, 0x34, 0x24, 0x9F, 0x54, 
+0x3A, 0xC4, 0xCB, 0xB5, 0xBB, 0xF1, 0x5F, 0xDE, 
+0xE2, 0x22, 0x67, 0x37, 0xE1, 0xA1, 0x65, 0x8C, 
+0x6D, 0x80, 0x0E, 0x31, 0xBE, 
+
+0x74, 0xF5, 0x71, 0x32, 0x8C, 0x3D, 0xD2, 0x19, 
+0x7E, 0xFB, 0xA4, 0xA5, 0x13, 0x67, 0xC7, 0xD6, 
+0xDA, 0x0C, 0x03, 0x4F, 0x83, 0xA6, 0x6D, 0xEB, 
+0x09, 0x87, 0x50, 0x1E, 0xBB, 0x31, 0xF5, 0x26, 
+0x18, 0x73, 0x32, 0x4E, 0x82, 0x64, 0xDD, 0xBF, 
+0x3D, 0x3B, 0xEB, 0xB4, 0x26, 0x92, 0x0A, 0x3A, 
+0x1D, 0x72, 0x54, 0x85, 0x31, 0x22, 0x19, 0x55, 
+0xAC, 0x26, 0x20, 0x09, 0x33, 0x1F, 0xA3, 0x55, 
+0xBE, 0xA0, 0xE8, 0x37, 0x66, 0xE7, 0x23, 0xBF, 
+0x3D, 0x2D, 0xAE, 0x7C, 0x82, 0xA0, 0xA9, 0x0A, 
+0xEE, 0x89, 0x40, 0xBC, 0x35, 0x9A, 
+
+0xB6, 0x9E, 0x6D, 0xE9, 0x6E, 0xAD, 0xBB, 0xDC, 
+0x17, 0xA5, 0x97, 0x2D, 0xB2, 0xEE, 0xC7, 0xA8, 
+0x12, 0xEB, 0x05, 0xDC, 0xCF, 0x3A, 0xB5, 0x72, 
+0x7E, 0x28, 0x81, 0xBB, 0xAB, 0x36, 0xDE, 0x07, 
+0x45, 0xD7, 0x86, 0x3C, 0x43, 0x34, 0x75, 0x7B, 
+0xA6, 0xC1, 0x0D, 0x79, 0xC2, 0x61, 0x2E, 0x18, 
+0xFA, 0x92, 0x39, 0xC3, 0x9C, 0xF4, 0x7E, 0xA2, 
+0x70, 0x30, 0x4C, 0x85, 0xA0, 0xE0, 0x89, 0x23, 
+0x4C, 0xBB, 0x1D, 0x3E, 0xB3, 0xBA, 0x64, 0xB8, 
+0x21, 0xDF, 0xC9, 0xBE, 0xA0, 0x56, 0x86, 0xC3, 
+0xD5, 0xA2, 0xC6, 0x43, 0x23, 0x73, 0xD4, 
+
+0x83, 0x2C, 0xFD, 0x99, 0xAB, 0x97, 0xA7, 0x86, 
+0xB6, 0xB2, 0x60, 0x45, 0xD5, 0xE4, 0xE2, 0x9F, 
+0x5B, 0x66, 0x87, 0xBD, 0x35, 0x6F, 0xD1, 0x12, 
+0xF9, 0xF8, 0x86, 0xA7, 0x56, 0xC1, 0x1E, 0xCE, 
+0x43, 0x30, 0x7A, 0xA0, 0xE9, 0x49, 0xE4, 0xC1, 
+0x36, 0x32, 0x90, 0x7A, 0xA5, 0x3E, 0x3D, 0x85, 
+0x62, 0x89, 0xD8, 0xD0, 0x55, 0xB4, 0x41, 0x53, 
+0xA7, 0x2C, 0x45, 0x08, 0xDA, 0x19, 0xCF, 0xC4, 
+0x55, 0x2E, 0x37, 0xB3, 0x25, 0xFE, 0xB0, 0xFC, 
+0x5F, 0xE5, 0x5E, 0x5D, 0xCE, 0x79, 0x86, 0x46, 
+0x44, 0xBE, 0x4E, 0x63, 0x7F, 0x2F, 0xB9, 0xB8, 
+
+0x41, 0x81, 0x6E, 0x7F, 0xD1, 0x30, 0xEA, 0xA5, 
+0x76, 0xE8, 0xD3, 0x06, 0xD4, 0xE8, 0x8E, 0x61, 
+0x6A, 0x66, 0xA6, 0x7C, 0x58, 0x36, 0x90, 0x95, 
+0x14, 0x54, 0x47, 0x12, 0x58, 0xA4, 0x99, 0xA5, 
+0x44, 0xD6, 0xDA, 0x22, 0x18, 0x79, 0xB7, 0xA6, 
+0x14, 0xC7, 0x2D, 0xE8, 0xBF, 0x07, 0x19, 0xF6, 
+0x7D, 0xA3, 0xBF, 0xB4, 0xE8, 0xF5, 0xEB, 0x18, 
+0x8B, 0x36, 0xC0, 0x36, 0x9B, 0x11, 0x24, 0x2A, 
+0x2E, 0xB1, 0x44, 0x75, 0x77, 0x39, 0xDB, 0x28, 
+0xB8, 0x41, 0x2F, 0xD1, 0x18, 0xE4, 0x13, 0x20, 
+0x11, 0x8F, 0xE8, 0xDB, 0x37, 0xEB, 0xBB, 0x07, 
+0x0D, 
+
+0x1C, 0xE7, 0xBF, 0xAE, 0x5E, 0x53, 0x04, 0xAE, 
+0x99, 0x41, 0x11, 0x22, 0x30, 0x96, 0xE2, 0x20, 
+0x19, 0x97, 0xA9, 0x0F, 0xFF, 0xB0, 0xB3, 0xFA, 
+0x77, 0x8A, 0xD4, 0xA0, 0x86, 0x9A, 0xFF, 0xCC, 
+0xC9, 0x54, 0xAB, 0x43, 0x99, 0xAC, 0xED, 0x39, 
+0x1C, 0x66, 0xC7, 0xC5, 0xB6, 0x3E, 0xE3, 0xD9, 
+0x76, 0x67, 0x8B, 0x48, 0x28, 0x98, 0x9A, 0x20, 
+0xF4, 0x8A, 0x66, 0x21, 0x73, 0xDC, 0x7B, 0xA5, 
+0xB1, 0x2E, 0xFE, 0x34, 0xA4, 0x52, 0x70, 0x66, 
+0x8F, 0xE2, 0xB8, 0x9D, 0xA4, 0xB3, 0xD0, 0xAD, 
+0xA2, 0x44, 0xEF, 0x1A, 0x37, 0x72, 0xA9, 0x30, 
+0x40, 0xA4, 
+
+0xAD, 0xAC, 0xDA, 0x21, 0xFF, 0x53, 0xF8, 0x1B, 
+0x85, 0x8F, 0x17, 0xCD, 0xE9, 0x8B, 0xCF, 0x03, 
+0xE1, 0xF0, 0xB0, 0x55, 0xE0, 0xA4, 0x24, 0x2A, 
+0xD2, 0x8F, 0xAB, 0x6F, 0xE4, 0x09, 0x77, 0x78, 
+0xB1, 0xF1, 0xB6, 0xE4, 0x7F, 0x36, 0xF3, 0xDF, 
+0xD5, 0x7C, 0xDB, 0x48, 0xC2, 0xD8, 0x7F, 0x69, 
+0x6D, 0x05, 0x72, 0x58, 0x59, 0x6E, 0xBF, 0x79, 
+0xA1, 0x6A, 0xA0, 0x9F, 0x2D, 0xEF, 0x9F, 0x20, 
+0x97, 0xD6, 0x17, 0x27, 0x80, 0x43, 0xBD, 0x1A, 
+0x85, 0xC6, 0x59, 0xB0, 0x2F, 0xC7, 0xE1, 0x21, 
+0xFB, 0xBA, 0x01, 0xB6, 0xCA, 0xBD, 0xA5, 0x97, 
+0x29, 0xC1, 0x69, 
+
+0x58, 0x1F, 0x00, 0xF4, 0x84, 0x61, 0x88, 0x0D, 
+0x58, 0x39, 0x1A, 0x47, 0x76, 0x0B, 0x44, 0x17, 
+0x9F, 0x8C, 0x4B, 0xA4, 0x87, 0x08, 0x2F, 0xED, 
+0xC3, 0x55, 0xEB, 0x0A, 0x6E, 0x73, 0x2F, 0xD6, 
+0x17, 0x6D, 0x00, 0x9C, 0xDE, 0x26, 0x42, 0x19, 
+0xDA, 0x88, 0xF4, 0x3B, 0x59, 0x7D, 0x9D, 0x96, 
+0xEB, 0x80, 0xC9, 0x46, 0xA3, 0x31, 0x6A, 0x10, 
+0x1B, 0xEB, 0xDD, 0x6E, 0x98, 0x62, 0x50, 0x4D, 
+0x2F, 0x1B, 0xBA, 0xB9, 0x8A, 0xB3, 0x92, 0xD4, 
+0xD9, 0x65, 0x57, 0xA3, 0x41, 0xC8, 0xE6, 0x59, 
+0xE1, 0x5F, 0x8D, 0xA4, 0xF5, 0xC5, 0x69, 0xA4, 
+0x3A, 0x28, 0xBB, 0x1D, 
+
+0xD7, 0xDA, 0x1F, 0xE8, 0x2A, 0x9D, 0x03, 0x3A, 
+0x17, 0xB9, 0x07, 0xBF, 0xC1, 0x12, 0xB5, 0xB0, 
+0x2C, 0x17, 0x14, 0x36, 0x3A, 0x9C, 0xE9, 0x50, 
+0x86, 0x3F, 0xEF, 0xE8, 0xB0, 0xAE, 0x7E, 0xBC, 
+0x1F, 0x4D, 0xAD, 0xED, 0x87, 0xA8, 0xD9, 0x66, 
+0x4D, 0x00, 0x97, 0x94, 0xDE, 0xB3, 0xAC, 0x31, 
+0x07, 0x98, 0x25, 0xF0, 0xBC, 0xA9, 0x1D, 0x14, 
+0xCE, 0x8F, 0x36, 0xD0, 0xE7, 0x57, 0x45, 0xB9, 
+0xE1, 0x04, 0xB4, 0x94, 0x96, 0xF6, 0xC9, 0xE7, 
+0xB8, 0x82, 0xBE, 0x43, 0x5F, 0x0E, 0x8E, 0x91, 
+0x2F, 0xC5, 0x3D, 0x37, 0x9E, 0x9A, 0x35, 0x09, 
+0x4A, 0x89, 0x1D, 0x0E, 0x34, 
+
+0x39, 0x2B, 0x8A, 0xE9, 0x3C, 0xBF, 0xE6, 0xAC, 
+0x92, 0x0B, 0x62, 0x76, 0x65, 0x54, 0x33, 0x84, 
+0x98, 0x93, 0x2D, 0x70, 0xA4, 0x8F, 0x2D, 0x8D, 
+0x8E, 0xD9, 0xCB, 0x27, 0xA5, 0xDE, 0xF2, 0x11, 
+0xE4, 0xAC, 0x20, 0x9F, 0x5F, 0xD0, 0x49, 0x1E, 
+0x6B, 0x3B, 0xEB, 0x90, 0x46, 0xB2, 0xAF, 0x36, 
+0x11, 0x36, 0x87, 0x27, 0x7B, 0x22, 0x9A, 0x34, 
+0x35, 0x49, 0x38, 0xE8, 0xF7, 0x2C, 0x35, 0x21, 
+0x4F, 0x40, 0xDC, 0x7D, 0xFD, 0x23, 0xFC, 0xC3, 
+0xAA, 0x20, 0x49, 0xDB, 0x4A, 0x9D, 0xA8, 0x10, 
+0x28, 0x5A, 0xCE, 0xF6, 0xF3, 0x27, 0x32, 0x3C, 
+0x63, 0x53, 0x3F, 0x9E, 0xA3, 0x07, 
+
+0x85, 0x7F, 0x04, 0xE6, 0x72, 0xF9, 0x8B, 0xEB, 
+0x8D, 0x74, 0x28, 0x9B, 0xB9, 0xA8, 0xC7, 0xE5, 
+0x36, 0xDC, 0xEA, 0xFE, 0xE3, 0x50, 0x59, 0xAF, 
+0xAE, 0x41, 0x9C, 0xE2, 0x63, 0x37, 0x68, 0x9A, 
+0xDB, 0x7B, 0xEC, 0x6C, 0x62, 0xD9, 0x56, 0x25, 
+0x35, 0x0E, 0xF5, 0x18, 0xB5, 0xD1, 0x54, 0x1A, 
+0xE3, 0xA3, 0x87, 0x23, 0x49, 0x95, 0xFC, 0xAE, 
+0x43, 0xF1, 0xA0, 0x57, 0x3D, 0xA4, 0xE5, 0xEB, 
+0xB6, 0xED, 0xB8, 0x3F, 0x83, 0xD1, 0x2C, 0x99, 
+0xE1, 0x3F, 0x3A, 0xBF, 0x09, 0xD2, 0xBB, 0xED, 
+0x87, 0xF7, 0x82, 0xA5, 0xF1, 0xBC, 0xCE, 0xBC, 
+0x67, 0x96, 0xB0, 0x2C, 0x8B, 0xBD, 0x8E, 
+
+0x1B, 0xC9, 0x8D, 0xF8, 0x2F, 0xE7, 0x65, 0xE1, 
+0x5B, 0x43, 0x1C, 0xD1, 0x31, 0xE2, 0xBB, 0x0E, 
+0x2D, 0x96, 0x77, 0x76, 0x36, 0xA4, 0x56, 0xD7, 
+0x9E, 0x38, 0xB6, 0x21, 0x17, 0x1C, 0x4E, 0xD0, 
+0x8D, 0x4E, 0xFB, 0xB2, 0xC2, 0x4B, 0x62, 0xC6, 
+0xCA, 0xE3, 0x1C, 0xAE, 0x21, 0xB6, 0x5C, 0xC2, 
+0x9C, 0x8A, 0x2A, 0x2D, 0xEA, 0xC7, 0x23, 0x9B, 
+0x81, 0xF2, 0x27, 0x96, 0x2D, 0xA3, 0x59, 0xC9, 
+0xC7, 0x08, 0xB3, 0xF4, 0x44, 0x24, 0xDC, 0xF1, 
+0x5D, 0xC3, 0xB6, 0xD4, 0xA8, 0x99, 0xD7, 0x93, 
+0x9B, 0x0A, 0xC7, 0x03, 0x29, 0xD8, 0x01, 0xE3, 
+0xC4, 0x05, 0x9B, 0x0C, 0x26, 0x78, 0x62, 0xAB, 
+
+0x2E, 0xC9, 0x6C, 0xCF, 0xAC, 0xB4, 0xF4, 0x2D, 
+0x67, 0x38, 0xB2, 0x44, 0x03, 0xBB, 0x4B, 0xCE, 
+0x3E, 0xAB, 0x94, 0xA5, 0x0A, 0xD8, 0xC3, 0x47, 
+0xE7, 0xBD, 0x9A, 0x49, 0x74, 0xDF, 0x60, 0x70, 
+0xBA, 0x40, 0x4B, 0x8F, 0xFB, 0xF4, 0x84, 0x38, 
+0xDA, 0x48, 0x1F, 0x0F, 0x8A, 0xA9, 0x81, 0x42, 
+0xFA, 0xFF, 0xA1, 0xEB, 0x39, 0x4E, 0xBE, 0x14, 
+0xD5, 0xF0, 0x53, 0xD4, 0x37, 0x9C, 0xE5, 0xF0, 
+0x76, 0x9E, 0x8B, 0x68, 0xAB, 0x61, 0xD6, 0x3D, 
+0x53, 0x17, 0x67, 0x33, 0xF2, 0x16, 0xC0, 0x11, 
+0x41, 0x1B, 0xB8, 0x63, 0x3B, 0xC0, 0x45, 0xF4, 
+0x9D, 0x07, 0x84, 0x2E, 0x54, 0x14, 0x70, 0x7C, 
+0x3B, 
+
+0x96, 0x19, 0xA6, 0x8A, 0x1E, 0x95, 0x82, 0x0F, 
+0x26, 0xCD, 0xEF, 0xDF, 0x14, 0x65, 0x26, 0xBC, 
+0xE0, 0x07, 0x02, 0x5F, 0xB7, 0xFF, 0xEA, 0xB5, 
+0x0B, 0xBF, 0xE7, 0xD4, 0xE8, 0xDB, 0xE7, 0xE2, 
+0xBB, 0x64, 0xD6, 0xB1, 0x54, 0xBA, 0x5C, 0x3D, 
+0x7A, 0x6F, 0xA1, 0xDC, 0x06, 0xB6, 0xFB, 0x88, 
+0xD6, 0xD9, 0x28, 0xBF, 0x9A, 0xC7, 0x67, 0x73, 
+0xBB, 0xE0, 0x38, 0x4B, 0xE5, 0x0B, 0xDF, 0x13, 
+0xBE, 0xDB, 0x78, 0x7D, 0xB4, 0x28, 0x48, 0x52, 
+0xA0, 0x91, 0xB3, 0xF9, 0xD6, 0xE5, 0x99, 0x9D, 
+0x0C, 0xBB, 0xFE, 0x17, 0x5C, 0x98, 0xEE, 0x1A, 
+0x38, 0xF9, 0x07, 0x46, 0x62, 0xFB, 0x72, 0xB8, 
+0x74, 0xC2, 
+
+0x67, 0x95, 0x9C, 0xD7, 0xD9, 0x46, 0xFD, 0xEE, 
+0x79, 0xA9, 0x66, 0x44, 0x4A, 0x4D, 0x91, 0x1D, 
+0x74, 0xE2, 0xB1, 0xB3, 0x70, 0x19, 0xFC, 0x45, 
+0x35, 0xED, 0x84, 0xDE, 0xDE, 0x8A, 0x4C, 0xF0, 
+0x99, 0x23, 0xE5, 0xB6, 0xB4, 0x90, 0xEF, 0xBC, 
+0xFA, 0x98, 0xCD, 0x4A, 0x7B, 0xCF, 0x43, 0x50, 
+0x60, 0x79, 0x7F, 0x13, 0x43, 0x9B, 0xFE, 0xB1, 
+0xB5, 0xE4, 0x08, 0xFF, 0xC6, 0x5D, 0x33, 0x0E, 
+0x1B, 0x61, 0xC1, 0xDA, 0xA2, 0xB8, 0x89, 0x30, 
+0xD5, 0xB6, 0x67, 0x5A, 0xD2, 0x33, 0x85, 0xFC, 
+0xAD, 0x36, 0xD2, 0xEF, 0xA6, 0xD0, 0xEF, 0x3D, 
+0xF3, 0xBE, 0xF6, 0x5D, 0x3F, 0xCD, 0x48, 0xB3, 
+0xBA, 0xF6, 0xC3, 
+
+0xC1, 0x4B, 0xDE, 0x90, 0xC3, 0xA3, 0x1B, 0x72, 
+0x7A, 0x3B, 0x89, 0x49, 0xAA, 0x15, 0xEE, 0x32, 
+0x8B, 0x76, 0x74, 0xA7, 0x59, 0x0B, 0x1F, 0xD0, 
+0x65, 0xD8, 0x81, 0x2D, 0x2D, 0xD3, 0x1D, 0x3B, 
+0x51, 0x4B, 0xE3, 0x57, 0xA8, 0x84, 0x42, 0x56, 
+0x7A, 0xF2, 0x41, 0x00, 0xC4, 0x11, 0xF2, 0xBD, 
+0x84, 0x15, 0xE0, 0x58, 0x35, 0xB6, 0x51, 0xE6, 
+0x10, 0x5F, 0x87, 0x62, 0x91, 0x90, 0x48, 0x5F, 
+0xCE, 0x26, 0xB8, 0x0C, 0x87, 0xA4, 0xB6, 0xB0, 
+0x73, 0xAC, 0xC5, 0xC0, 0xA8, 0x00, 0xF5, 0x9E, 
+0x04, 0x23, 0xE6, 0xB8, 0x34, 0xF5, 0x0D, 0xB4, 
+0x5A, 0x3C, 0x93, 0xE5, 0xAF, 0x95, 0xD6, 0x3E, 
+0xEB, 0x2D, 0xD1, 0xA4, 
+
+0x04, 0xFC, 0x1C, 0x60, 0x44, 0x16, 0x33, 0xB8, 
+0xCB, 0xAC, 0x9F, 0xC8, 0xE0, 0x78, 0xE1, 0xF2, 
+0x99, 0xCD, 0x59, 0x4C, 0x6F, 0x0F, 0xA8, 0xFD, 
+0x56, 0x2D, 0xE1, 0x50, 0x9E, 0x93, 0x01, 0xF1, 
+0x71, 0xF2, 0xC3, 0xE7, 0x37, 0x29, 0x8A, 0x65, 
+0x78, 0x6F, 0x47, 0x23, 0xE8, 0x3C, 0x64, 0x68, 
+0x38, 0x3C, 0x42, 0x29, 0x7E, 0x45, 0x4C, 0xCD, 
+0x35, 0xF5, 0x82, 0x1F, 0xD0, 0xCA, 0x43, 0x19, 
+0x71, 0x19, 0xA9, 0xB9, 0x78, 0x80, 0xD5, 0xEA, 
+0x2F, 0x09, 0x73, 0x52, 0x92, 0x05, 0xBD, 0xA6, 
+0x8C, 0x46, 0xA9, 0x80, 0x40, 0xB2, 0x09, 0x87, 
+0x41, 0x7F, 0x62, 0xF0, 0xEE, 0x90, 0xBA, 0x47, 
+0xD9, 0x38, 0x89, 0xD2, 0x34, 
+
+0xFA, 0x3E, 0x75, 0x7A, 0x23, 0x74, 0x90, 0x08, 
+0xAB, 0x3A, 0xEE, 0xAC, 0x2F, 0xA4, 0x61, 0x13, 
+0x6A, 0x83, 0xF1, 0x5B, 0x73, 0x4F, 0x2F, 0xD4, 
+0x80, 0xDF, 0x5A, 0x45, 0x26, 0x4D, 0x83, 0x5B, 
+0x80, 0x3A, 0xD6, 0x04, 0x12, 0xCD, 0xCF, 0xD0, 
+0xFF, 0x8C, 0xA4, 0xCB, 0x93, 0xCB, 0xBB, 0x6B, 
+0x82, 0xB4, 0x43, 0xB2, 0x5A, 0xB9, 0x53, 0xEB, 
+0x1E, 0x81, 0x5E, 0x20, 0xB3, 0x64, 0x7D, 0xDC, 
+0x17, 0xD6, 0xC6, 0x1A, 0xBB, 0x2A, 0xC5, 0x76, 
+0xE5, 0x6B, 0xB7, 0xF1, 0xF2, 0xA0, 0xA0, 0x54, 
+0x9D, 0x42, 0xF8, 0x46, 0xCB, 0x34, 0x95, 0xF9, 
+0x9B, 0xF6, 0x29, 0xD1, 0xA4, 0x63, 0x05, 0x70, 
+0x4F, 0x63, 0x7C, 0x6C, 0xCF, 0xFF, 
+
+0xFA, 0xB9, 0xB9, 0x80, 0x2C, 0x94, 0x4D, 0xE5, 
+0x2C, 0x92, 0xB9, 0xBB, 0x7B, 0x6A, 0xF1, 0x42, 
+0x20, 0x3B, 0xE5, 0x48, 0xF5, 0xE6, 0x88, 0x04, 
+0x1C, 0x70, 0x81, 0x04, 0x98, 0xC9, 0x23, 0x8C, 
+0x24, 0xD9, 0xF6, 0x9A, 0xD5, 0xEF, 0xC9, 0x16, 
+0x42, 0xE7, 0x40, 0xCF, 0x46, 0xA9, 0x6B, 0xCC, 
+0x1A, 0x08, 0x80, 0xDE, 0x7E, 0xDE, 0x1C, 0xD2, 
+0xDA, 0xE5, 0xDC, 0x1B, 0x3F, 0xDD, 0x43, 0xFC, 
+0x64, 0xCF, 0x2A, 0xA0, 0x04, 0x22, 0x9C, 0x34, 
+0xD0, 0xD7, 0xD5, 0xDE, 0xA8, 0xDB, 0x3A, 0x9E, 
+0xF7, 0xED, 0xBB, 0xE8, 0xEF, 0x73, 0x88, 0x63, 
+0xAF, 0xA1, 0x2F, 0x19, 0xB2, 0x4B, 0x63, 0xD6, 
+0x2C, 0x29, 0x88, 0x09, 0x0D, 0x29, 0xA0, 
+
+0x7B, 0xB1, 0x5D, 0x43, 0xCC, 0x5A, 0x7E, 0xB4, 
+0x9C, 0x2C, 0x97, 0xA2, 0xF5, 0x48, 0x05, 0x07, 
+0x35, 0xBB, 0xA6, 0xE0, 0xFF, 0x82, 0x26, 0x5A, 
+0x7D, 0x6A, 0xFA, 0xAE, 0x63, 0x52, 0x95, 0x1E, 
+0x85, 0x4B, 0x14, 0x21, 0xC8, 0xCB, 0xB8, 0xB8, 
+0x3C, 0x4F, 0x08, 0x01, 0xE4, 0x13, 0x4E, 0x9A, 
+0x2A, 0x55, 0x29, 0x56, 0xB3, 0x59, 0x6F, 0x72, 
+0x03, 0xAC, 0x1B, 0x9B, 0xDD, 0xAA, 0x8A, 0x44, 
+0x4C, 0xF4, 0x66, 0x1C, 0x47, 0x22, 0x92, 0x59, 
+0xC4, 0xA5, 0xA0, 0xC2, 0xCD, 0xC4, 0xA2, 0x1C, 
+0x4A, 0x97, 0x40, 0x9B, 0xC3, 0x3C, 0x66, 0xAE, 
+0x5D, 0x2D, 0xAF, 0x36, 0xF6, 0x5D, 0xE7, 0xB8, 
+0x01, 0x08, 0xFB, 0xFC, 0xDB, 0x51, 0x43, 0x86, 
+
+0x77, 0x9A, 0xBB, 0x84, 0x77, 0xAB, 0x11, 0xE9, 
+0x95, 0xFE, 0xBE, 0x92, 0x2D, 0x67, 0x2C, 0x95, 
+0xFD, 0xD5, 0x7E, 0x34, 0x4D, 0xED, 0x95, 0x6B, 
+0xF5, 0x69, 0xE6, 0x5E, 0xCF, 0xF0, 0x12, 0x37, 
+0x29, 0x91, 0x2A, 0xFF, 0x9E, 0xDF, 0xA6, 0x1F, 
+0xE7, 0xA7, 0x47, 0x01, 0x34, 0x47, 0x63, 0x31, 
+0xE2, 0xDF, 0x28, 0xA5, 0xAA, 0xF8, 0x22, 0x9D, 
+0x68, 0xD2, 0x27, 0x1A, 0xED, 0x3A, 0x0E, 0xAF, 
+0x9C, 0xB4, 0x42, 0xA8, 0x84, 0x30, 0xEE, 0xFD, 
+0xDE, 0xA3, 0x2C, 0x15, 0x32, 0xD0, 0xE1, 0xBA, 
+0x8C, 0x5E, 0x75, 0x9E, 0xB6, 0xCA, 0x5B, 0x93, 
+0xBA, 0xB4, 0x0F, 0x92, 0x4D, 0x01, 0x18, 0x91, 
+0x49, 0xA2, 0x1D, 0xEC, 0x67, 0xDD, 0x9A, 0xB0, 
+0xA7, 
+
+0xF1, 0x77, 0xC5, 0xD7, 0x53, 0xB9, 0x20, 0xF7, 
+0x7B, 0x8B, 0x8A, 0x95, 0xF6, 0xBF, 0x58, 0xCB, 
+0xEF, 0x87, 0x73, 0xD9, 0xAF, 0xF0, 0x13, 0xC2, 
+0xDD, 0x6C, 0x27, 0xD9, 0x7D, 0x18, 0x13, 0x59, 
+0x25, 0xD6, 0x2C, 0x60, 0x3E, 0x3F, 0x6E, 0x51, 
+0xFC, 0xE6, 0x14, 0xC0, 0x05, 0x3B, 0xE0, 0x39, 
+0x98, 0x83, 0xD3, 0x0D, 0x61, 0x2B, 0xAC, 0x0F, 
+0x09, 0x7D, 0x12, 0x43, 0x7F, 0xC0, 0x8E, 0x72, 
+0x51, 0xC2, 0x1F, 0xF1, 0xAA, 0xEC, 0xA7, 0xB3, 
+0x80, 0x1B, 0xF3, 0xB3, 0xEF, 0xB0, 0x7B, 0x73, 
+0x5C, 0xC1, 0x16, 0x70, 0xB6, 0x78, 0xE0, 0x62, 
+0x6F, 0x04, 0x42, 0x1F, 0xAA, 0x0E, 0x59, 0x0C, 
+0x52, 0xE2, 0xC2, 0xD1, 0x55, 0xA7, 0xF8, 0xC4, 
+0x0F, 0xD6, 
+
+0x91, 0x3F, 0xB0, 0xF8, 0xF1, 0x65, 0x27, 0xD2, 
+0xB3, 0x95, 0xCE, 0x5F, 0xB0, 0x3C, 0x92, 0x81, 
+0x80, 0x4B, 0x4E, 0x40, 0x23, 0x3E, 0xE1, 0xE9, 
+0x07, 0xB1, 0x3E, 0x41, 0xB7, 0xCF, 0xCC, 0xB3, 
+0x60, 0x79, 0x92, 0xD2, 0x28, 0xC1, 0x34, 0x05, 
+0x77, 0x42, 0x72, 0x27, 0xDD, 0x5B, 0x79, 0x97, 
+0xD8, 0xA4, 0xA2, 0x44, 0x8E, 0x56, 0xD2, 0xD6, 
+0x13, 0xEA, 0xA3, 0x3B, 0xDE, 0xA6, 0x82, 0xA3, 
+0x00, 0xC0, 0x4F, 0x0B, 0xA1, 0x6E, 0xB5, 0xE2, 
+0xA4, 0x22, 0x95, 0xAD, 0x32, 0x15, 0xB6, 0xB4, 
+0x2E, 0xC4, 0xAC, 0x37, 0x86, 0x74, 0xC8, 0x06, 
+0xE4, 0xC1, 0xB1, 0xAC, 0xD6, 0x32, 0x86, 0x0D, 
+0xFA, 0x31, 0xFA, 0x17, 0xB8, 0x06, 0x97, 0x09, 
+0x09, 0xC9, 0x43, 
+
+0xDE, 0xCC, 0xCD, 0x5E, 0x59, 0xC1, 0xF9, 0x9E, 
+0xFB, 0xE5, 0xEC, 0x71, 0x79, 0x3F, 0x98, 0x17, 
+0xF1, 0x0A, 0xED, 0xF1, 0x07, 0x2C, 0x0C, 0x19, 
+0xBE, 0x92, 0x30, 0x66, 0x74, 0xFF, 0xE4, 0x9D, 
+0x16, 0xD7, 0xF1, 0x6D, 0x55, 0xF2, 0x77, 0x1E, 
+0x75, 0x91, 0x55, 0xBB, 0x36, 0xE8, 0x75, 0x97, 
+0x35, 0x1E, 0xAC, 0x74, 0x57, 0x1E, 0x2E, 0xC6, 
+0xA2, 0xC3, 0xEF, 0x29, 0x09, 0x92, 0x12, 0xCE, 
+0x8E, 0x00, 0x01, 0xC1, 0x50, 0x26, 0x27, 0xBA, 
+0x4A, 0x11, 0xD6, 0xAD, 0xA1, 0x47, 0x7F, 0x31, 
+0x80, 0x34, 0xCE, 0x15, 0x66, 0x55, 0x61, 0x1F, 
+0xD0, 0x58, 0x10, 0x22, 0xDE, 0xDB, 0x37, 0x43, 
+0xC4, 0xCB, 0x18, 0xC5, 0x0B, 0x03, 0x07, 0xF5, 
+0x97, 0xCA, 0x19, 0x0F, 
+
+0x76, 0x4D, 0xAE, 0xDA, 0x03, 0x85, 0xB6, 0x8E, 
+0x1D, 0x29, 0xA2, 0xE0, 0x7F, 0x17, 0x18, 0xF3, 
+0xE9, 0x13, 0x02, 0xE0, 0x51, 0x8C, 0x5E, 0x55, 
+0x17, 0xBB, 0x53, 0x54, 0x37, 0xAE, 0x3A, 0x01, 
+0xB9, 0x70, 0xC1, 0x67, 0xC3, 0xA6, 0x14, 0x57, 
+0x08, 0x49, 0x14, 0xB1, 0x18, 0xC0, 0x10, 0x3F, 
+0x7A, 0x91, 0x61, 0xEC, 0x4B, 0x52, 0x7A, 0xB6, 
+0x81, 0xBA, 0x76, 0xDC, 0x1F, 0xA4, 0x0E, 0x32, 
+0xCC, 0xA4, 0x83, 0x80, 0xA1, 0xAB, 0x58, 0x4C, 
+0xFD, 0xD8, 0x2D, 0x57, 0x7B, 0xB7, 0x9C, 0xA0, 
+0xCE, 0x3E, 0xC7, 0x4A, 0x4B, 0xB5, 0xEB, 0x46, 
+0x9A, 0x63, 0xD5, 0xA4, 0x37, 0x2B, 0x6D, 0x35, 
+0x01, 0x70, 0xD7, 0x89, 0x2F, 0x63, 0x5B, 0x90, 
+0x66, 0x5B, 0xC4, 0xDC, 0x41, 
+
+0xF1, 0x91, 0x5B, 0x64, 0x88, 0x3A, 0x1F, 0x44, 
+0xA8, 0xE1, 0x81, 0xD1, 0x40, 0x29, 0x05, 0xB2, 
+0x41, 0x02, 0xFA, 0xC6, 0x45, 0x22, 0x05, 0x00, 
+0x87, 0x3E, 0xC5, 0xF5, 0x1C, 0xCC, 0x3D, 0x38, 
+0xD5, 0x55, 0x09, 0x90, 0x02, 0xC6, 0x1A, 0xFE, 
+0xE0, 0x86, 0x0E, 0xA8, 0xB9, 0x68, 0xC8, 0x77, 
+0x9B, 0xC5, 0x43, 0x82, 0x95, 0x7E, 0x53, 0x40, 
+0x25, 0x0D, 0x12, 0xBD, 0xFF, 0x1A, 0xFD, 0xD4, 
+0x6D, 0x1E, 0x08, 0x31, 0xC0, 0x21, 0xBA, 0x07, 
+0x6F, 0xB6, 0x6F, 0x53, 0xE3, 0x03, 0x2A, 0xD6, 
+0x96, 0xE3, 0x5B, 0x5B, 0xA9, 0x80, 0x6B, 0xAE, 
+0x3D, 0xA2, 0xF8, 0x90, 0x40, 0x7C, 0xC4, 0xEA, 
+0x46, 0xA2, 0x4C, 0x36, 0x11, 0x25, 0xA7, 0x71, 
+0xF2, 0x37, 0xA7, 0xB5, 0x6F, 0x52, 
+
+0x16, 0x1B, 0xC9, 0x06, 0x07, 0x28, 0x68, 0xA7, 
+0xDA, 0xE3, 0xE7, 0xBB, 0x24, 0x8A, 0x1E, 0xD0, 
+0xDD, 0x85, 0x9A, 0x31, 0x44, 0x86, 0x77, 0x36, 
+0x4D, 0xE8, 0x48, 0x59, 0x01, 0x0C, 0x0A, 0xE4, 
+0x0E, 0x1A, 0x0F, 0xAB, 0x5D, 0x74, 0x93, 0x92, 
+0x64, 0x4A, 0xAB, 0xC5, 0xE3, 0xEA, 0x1F, 0xC3, 
+0xB7, 0xD1, 0x45, 0x09, 0x7B, 0x45, 0xDB, 0x94, 
+0xA8, 0x39, 0x70, 0x56, 0xA8, 0xF4, 0xE1, 0x52, 
+0x64, 0xF8, 0x91, 0x13, 0x60, 0xC6, 0x8E, 0xCA, 
+0x31, 0xB9, 0x3F, 0x88, 0xC5, 0x2B, 0x20, 0xCD, 
+0x17, 0xBE, 0x1B, 0xAD, 0x24, 0xDA, 0x6D, 0x8E, 
+0x26, 0x6B, 0xF0, 0xFA, 0x4A, 0x56, 0x6E, 0x20, 
+0x87, 0x27, 0x87, 0x7A, 0x36, 0xBD, 0x07, 0x14, 
+0xF3, 0xE8, 0x62, 0x73, 0xF4, 0xA8, 0x6A, 
+
+0xDE, 0x2D, 0x31, 0x30, 0x49, 0x8C, 0xDF, 0x14, 
+0xF7, 0xED, 0x22, 0x4A, 0xBE, 0xC9, 0xFE, 0x2D, 
+0xD9, 0xA0, 0xF9, 0xE1, 0x4D, 0x67, 0x09, 0x67, 
+0xC3, 0x64, 0xA7, 0x5A, 0x5C, 0xBE, 0x00, 0x4D, 
+0xAA, 0x40, 0x1A, 0x4A, 0x2B, 0x7E, 0xF3, 0x8E, 
+0xE6, 0x15, 0x0A, 0x17, 0x6D, 0x5C, 0x76, 0x26, 
+0xD1, 0xD1, 0xF1, 0x8F, 0xC3, 0x1D, 0x37, 0xF3, 
+0xA4, 0xB4, 0x32, 0x61, 0xD6, 0x15, 0x91, 0x08, 
+0xFB, 0x62, 0xB5, 0x0D, 0x2B, 0x7B, 0x83, 0x3E, 
+0xE7, 0xF6, 0xC5, 0x55, 0x1C, 0x48, 0x89, 0xA2, 
+0xC8, 0xEF, 0x10, 0x91, 0x16, 0x75, 0x33, 0x12, 
+0xE5, 0xFE, 0x1C, 0xEA, 0xC0, 0x69, 0xF4, 0x0B, 
+0xBF, 0xCE, 0x06, 0x45, 0x3A, 0x84, 0x78, 0x0C, 
+0x7F, 0x02, 0x94, 0x9B, 0xE5, 0x0A, 0x75, 0x67, 
+
+0x6A, 0xA0, 0xA2, 0xA0, 0x36, 0x87, 0x81, 0x3C, 
+0xBA, 0x45, 0xCE, 0xE5, 0xDD, 0x9B, 0xA6, 0xA0, 
+0xED, 0x85, 0x7D, 0x6E, 0xFC, 0x7A, 0x69, 0xB7, 
+0xAF, 0xE2, 0x7C, 0x61, 0xCE, 0xE8, 0x4E, 0xBA, 
+0xFC, 0x59, 0x4D, 0x53, 0x41, 0x3F, 0x01, 0x69, 
+0x88, 0xE7, 0x69, 0xFB, 0x22, 0x7E, 0x9D, 0xF2, 
+0xFD, 0x13, 0xC5, 0x45, 0x73, 0x24, 0x0B, 0x30, 
+0x1E, 0x1D, 0x28, 0xBC, 0xAE, 0xDF, 0xDD, 0xA4, 
+0x66, 0x04, 0x92, 0x6F, 0x8F, 0x77, 0x4D, 0x93, 
+0x09, 0x00, 0xC4, 0x01, 0xB0, 0x9F, 0xFB, 0x70, 
+0x87, 0x21, 0x68, 0x5C, 0x51, 0x39, 0x85, 0x97, 
+0x68, 0x82, 0x43, 0xC0, 0xB1, 0x5C, 0x28, 0x4F, 
+0xF7, 0x51, 0x0E, 0x71, 0x4C, 0xBE, 0x63, 0x06, 
+0x34, 0xE6, 0x10, 0xD5, 0x67, 0x1D, 0xD8, 0xB5, 
+0xC2, 
+
+0x31, 0x17, 0xFF, 0x40, 0xB4, 0xC1, 0x42, 0x64, 
+0x6E, 0xED, 0xDE, 0xE2, 0xF1, 0x72, 0xAB, 0xD3, 
+0x68, 0x55, 0x75, 0x70, 0x4C, 0x31, 0x02, 0x7E, 
+0x78, 0x09, 0xEA, 0xD2, 0xDC, 0xAB, 0x13, 0x7D, 
+0xAC, 0x07, 0x3D, 0x5A, 0x64, 0xE6, 0x7A, 0x75, 
+0x2D, 0x6B, 0xB3, 0x0D, 0xF4, 0x05, 0x68, 0x39, 
+0x56, 0xD7, 0xFB, 0xE2, 0x7F, 0x71, 0xDB, 0xD0, 
+0xD4, 0xAE, 0x7A, 0xFC, 0xEC, 0x74, 0xD4, 0x5B, 
+0x65, 0xC3, 0x57, 0x77, 0x37, 0x78, 0xE0, 0xE1, 
+0x1C, 0x2C, 0x1C, 0x9D, 0xD7, 0x69, 0x14, 0x20, 
+0x35, 0x5C, 0x9F, 0x6E, 0x6F, 0xD0, 0xCB, 0xC8, 
+0xAD, 0xD0, 0x98, 0xCB, 0x6C, 0xAA, 0xAC, 0xB0, 
+0xEF, 0xC2, 0xA2, 0xA9, 0xD0, 0x76, 0x3A, 0xE5, 
+0x15, 0x01, 0xAE, 0x79, 0x1F, 0xAD, 0xD0, 0x03, 
+0xFB, 0xB2, 
+
+0xF8, 0x8F, 0x16, 0xAC, 0x3A, 0x8A, 0xD0, 0x8F, 
+0x97, 0xD3, 0x36, 0x94, 0xCA, 0xD8, 0x97, 0xF7, 
+0x8D, 0x97, 0x14, 0xA2, 0x56, 0x5C, 0x85, 0x3B, 
+0x1C, 0xC5, 0xF3, 0x05, 0x16, 0x1D, 0xFF, 0x0E, 
+0x0A, 0x2B, 0x0F, 0xB3, 0x82, 0x79, 0x9B, 0x83, 
+0x9A, 0xE0, 0x65, 0xF2, 0x32, 0x81, 0x57, 0x4D, 
+0xE3, 0xA0, 0x9E, 0x1F, 0x31, 0x38, 0xD3, 0xB8, 
+0xA4, 0x02, 0x4D, 0x62, 0x15, 0x49, 0x1F, 0x5D, 
+0x9B, 0x98, 0xC2, 0x02, 0x05, 0xEF, 0x63, 0xC4, 
+0x10, 0xBB, 0x21, 0x1C, 0x2D, 0x2C, 0x92, 0x9C, 
+0x6F, 0x7C, 0x3D, 0x73, 0x8E, 0x7A, 0x3F, 0xBD, 
+0x57, 0x96, 0x01, 0x07, 0x37, 0xB6, 0x14, 0xE9, 
+0xE3, 0xC7, 0xE3, 0xCB, 0x6E, 0xB8, 0xEA, 0x22, 
+0xE5, 0xFC, 0x72, 0x0A, 0xDB, 0x7D, 0x5D, 0x6F, 
+0xD9, 0xDB, 0xAF, 
+
+0xEF, 0x24, 0xAA, 0x59, 0xBB, 0xFE, 0xED, 0x64, 
+0xFF, 0xC7, 0x4E, 0x31, 0xDA, 0xC0, 0x2C, 0xA1, 
+0xE4, 0xAA, 0x24, 0x6A, 0x14, 0xB9, 0x66, 0x1C, 
+0x5A, 0xB5, 0xD9, 0x0B, 0xFF, 0xC0, 0x04, 0x16, 
+0x2A, 0xA3, 0xC1, 0xD9, 0x85, 0xCB, 0x5C, 0x79, 
+0xEF, 0x45, 0xF8, 0x61, 0x4B, 0xA3, 0x41, 0xDB, 
+0xC2, 0x21, 0x70, 0xAF, 0xEE, 0x6E, 0x1E, 0x2D, 
+0x3D, 0x2F, 0x79, 0xC9, 0x2B, 0xCD, 0x44, 0xE5, 
+0x2D, 0x25, 0x75, 0x38, 0x0C, 0x74, 0xAC, 0x6C, 
+0x38, 0x2B, 0xC9, 0xE1, 0xF7, 0x47, 0x2E, 0x9C, 
+0x4C, 0x31, 0xBF, 0x86, 0xC4, 0x6C, 0x6E, 0x05, 
+0x06, 0x95, 0xBD, 0xE9, 0x2A, 0xC3, 0xC2, 0x8B, 
+0xDB, 0x44, 0x30, 0x15, 0x73, 0xB5, 0x68, 0x3A, 
+0xCE, 0x33, 0xB3, 0x8B, 0x9F, 0x33, 0x35, 0x75, 
+0xDD, 0x35, 0x80, 0xD6, 
+
+0x00, 0x62, 0xE6, 0x3D, 0x93, 0x8F, 0x09, 0x0E, 
+0x76, 0x93, 0x43, 0xE1, 0x5B, 0x72, 0xA0, 0xB7, 
+0x17, 0x70, 0xE3, 0x99, 0x0D, 0x60, 0x35, 0x27, 
+0xC0, 0x28, 0xBE, 0x3A, 0xBB, 0xC7, 0xA4, 0x75, 
+0x30, 0x9D, 0xA3, 0x1D, 0x25, 0x5C, 0x41, 0xEC, 
+0x5A, 0x32, 0x3A, 0x11, 0x28, 0x04, 0x54, 0x2B, 
+0xB0, 0xCD, 0xEB, 0xC4, 0x76, 0xE1, 0x33, 0x39, 
+0x2A, 0x0D, 0xE2, 0xC8, 0xB7, 0x01, 0x6D, 0x52, 
+0xA3, 0xA4, 0xCB, 0xD8, 0x46, 0x6F, 0x9C, 0xF5, 
+0x03, 0x6F, 0x71, 0xA7, 0x51, 0x9C, 0x26, 0xA5, 
+0x54, 0x65, 0x53, 0x15, 0x3D, 0x33, 0x1F, 0x06, 
+0x0C, 0xA7, 0xFA, 0xBE, 0x71, 0x22, 0xBB, 0x2A, 
+0x37, 0x16, 0x37, 0x19, 0x4C, 0xD1, 0xF3, 0xD7, 
+0xDA, 0x9C, 0xB4, 0x04, 0xC7, 0x7F, 0xDC, 0x2C, 
+0x25, 0xF2, 0xC5, 0x35, 0x7E, 
+
+0x3C, 0x2A, 0xC4, 0x3D, 0x83, 0xFB, 0x11, 0x11, 
+0x1E, 0xC3, 0x0A, 0x8C, 0x51, 0x9C, 0xA6, 0x36, 
+0x36, 0x36, 0x41, 0x68, 0x87, 0x7E, 0x15, 0x07, 
+0x8F, 0xF2, 0x56, 0x05, 0x7F, 0x24, 0x26, 0x03, 
+0xF9, 0x30, 0x88, 0x58, 0x81, 0x46, 0xF8, 0x50, 
+0x55, 0x3F, 0x22, 0x6B, 0x98, 0x2A, 0xBC, 0x18, 
+0xD0, 0xC9, 0x18, 0xFC, 0x2E, 0x80, 0x5A, 0x3F, 
+0x58, 0x6D, 0x6C, 0x18, 0x14, 0x19, 0x69, 0xC9, 
+0x42, 0xDE, 0x1A, 0xD3, 0x71, 0xBD, 0x12, 0x6B, 
+0x3C, 0x61, 0x0C, 0x0F, 0x50, 0xC4, 0xB3, 0x5F, 
+0x34, 0x2B, 0xF8, 0x92, 0x22, 0xCC, 0x70, 0x2D, 
+0xB4, 0xB7, 0x4A, 0x81, 0x0C, 0x67, 0xF7, 0x29, 
+0xE3, 0xED, 0x57, 0xB3, 0x1D, 0x91, 0xC2, 0xEC, 
+0xF8, 0xA0, 0x9D, 0x37, 0x4B, 0x96, 0x18, 0x25, 
+0x32, 0xA2, 0xCD, 0x65, 0xD4, 0xB6, 
+
+0x2D, 0x08, 0xAE, 0x77, 0x71, 0x49, 0x56, 0xA5, 
+0x1E, 0x8E, 0xE1, 0x7F, 0x6F, 0xDC, 0x98, 0x69, 
+0x88, 0xF6, 0xBE, 0x07, 0xCF, 0x61, 0xEE, 0xBC, 
+0xA3, 0x0A, 0xE8, 0xB6, 0x38, 0x81, 0x83, 0xDE, 
+0x31, 0xF3, 0xA1, 0xE2, 0x3F, 0x58, 0x98, 0x0A, 
+0x02, 0xA3, 0x79, 0xAD, 0x96, 0xD8, 0xC7, 0xE8, 
+0xEF, 0xA8, 0x83, 0x84, 0x4C, 0xF0, 0x0C, 0x6E, 
+0x69, 0x19, 0x16, 0x89, 0xE0, 0x3D, 0xE2, 0x71, 
+0x42, 0x1D, 0xCC, 0x13, 0xCA, 0x75, 0xBE, 0xD5, 
+0xC7, 0xEF, 0xA0, 0xDE, 0xF7, 0x80, 0x07, 0x9C, 
+0xE2, 0xA9, 0x6E, 0x64, 0x3B, 0xEB, 0x5B, 0xE4, 
+0xEC, 0xC4, 0x3D, 0x9C, 0x8B, 0x25, 0xCC, 0xBE, 
+0xD6, 0xD0, 0x51, 0x8A, 0x98, 0xC2, 0x9B, 0x39, 
+0x39, 0xFA, 0xDC, 0x16, 0x42, 0x7B, 0x1A, 0x0E, 
+0x54, 0x3F, 0xAA, 0x6C, 0x81, 0x67, 0xAE, 
+
+0xA7, 0xCD, 0xA1, 0xCA, 0x68, 0xF8, 0xDF, 0xC9, 
+0x5A, 0x67, 0x67, 0x9A, 0x44, 0xAE, 0xB0, 0x53, 
+0x8B, 0x14, 0xD0, 0x71, 0x88, 0xB2, 0x21, 0xCA, 
+0x4C, 0xE3, 0x31, 0x30, 0xD4, 0x1D, 0x6A, 0x68, 
+0xD2, 0x21, 0xB6, 0xF0, 0x05, 0x9F, 0x73, 0x1D, 
+0x94, 0x29, 0xAC, 0x3D, 0xED, 0x93, 0xE0, 0x55, 
+0xE0, 0xC1, 0x99, 0xC6, 0x1D, 0xDB, 0x36, 0xA3, 
+0x05, 0xD9, 0xF5, 0x77, 0xE1, 0x84, 0xEC, 0x2F, 
+0xE4, 0x33, 0x8E, 0x5E, 0x0E, 0x11, 0x85, 0x0B, 
+0xDE, 0x97, 0x29, 0x17, 0x16, 0xF2, 0xEC, 0x0F, 
+0x1A, 0x5E, 0x4D, 0xA8, 0x34, 0xB2, 0x93, 0xA4, 
+0x37, 0x1E, 0x9F, 0xDD, 0x13, 0x84, 0x79, 0x59, 
+0x5D, 0x1D, 0xD9, 0x21, 0x6C, 0xA2, 0x14, 0x56, 
+0x87, 0xEF, 0xDA, 0x25, 0x51, 0x98, 0x03, 0x8E, 
+0xB4, 0x32, 0x65, 0xD4, 0x5B, 0xB4, 0x6A, 0x71, 
+
+0xB0, 0xB9, 0xDB, 0x13, 0xD1, 0xEC, 0x75, 0xC4, 
+0x17, 0xA1, 0x10, 0x96, 0xD1, 0x7F, 0x85, 0xB5, 
+0xD4, 0x1B, 0x79, 0x48, 0x80, 0x00, 0x78, 0x6D, 
+0xFA, 0xAB, 0xA9, 0x2B, 0xD2, 0xFC, 0xF5, 0xF2, 
+0x6D, 0xEF, 0xAF, 0x5A, 0x7E, 0xD6, 0x40, 0xA1, 
+0xFA, 0x99, 0xD4, 0x3F, 0xCA, 0x42, 0xEC, 0x2E, 
+0xF4, 0xAF, 0x90, 0x94, 0x17, 0x74, 0xB2, 0x73, 
+0x7A, 0x9C, 0x21, 0x73, 0x90, 0x67, 0x27, 0x4C, 
+0x6A, 0x0A, 0x17, 0x8A, 0x80, 0xD9, 0x19, 0x14, 
+0x7D, 0x21, 0x54, 0x57, 0xA8, 0xFA, 0x9B, 0xC4, 
+0x2A, 0xCC, 0x4D, 0x03, 0xAB, 0x54, 0x67, 0xA7, 
+0xDD, 0x8E, 0x3D, 0x88, 0x98, 0xF7, 0x13, 0x7B, 
+0xC7, 0x88, 0x08, 0x55, 0x20, 0x5D, 0x84, 0xED, 
+0xCC, 0x6D, 0x17, 0x14, 0x5C, 0xC3, 0x2F, 0x85, 
+0xC1, 0x35, 0xA2, 0x7E, 0xEB, 0x52, 0x9E, 0x5C, 
+0x84, 
+
+0xB1, 0xF5, 0x84, 0x31, 0x5B, 0xFE, 0x9D, 0x7F, 
+0x72, 0x08, 0xD9, 0xE5, 0x4B, 0xB6, 0xD0, 0x7A, 
+0x2E, 0x86, 0x50, 0xDD, 0x49, 0x06, 0x6C, 0x4D, 
+0xF1, 0x14, 0x6F, 0x43, 0x97, 0x3B, 0x93, 0xB3, 
+0x46, 0x1F, 0x71, 0x3E, 0x45, 0x55, 0x7C, 0xC7, 
+0xBC, 0x7F, 0x68, 0x97, 0xF7, 0x56, 0x13, 0xD8, 
+0x32, 0x4E, 0xF8, 0x24, 0x58, 0xE8, 0x5E, 0xAE, 
+0x25, 0xE0, 0x09, 0x72, 0x0A, 0xFA, 0xAA, 0x2C, 
+0x9E, 0xB4, 0xC1, 0xEC, 0xCF, 0x02, 0x75, 0x80, 
+0x11, 0xFB, 0xEB, 0x5A, 0x7F, 0x64, 0x4C, 0x44, 
+0x93, 0xEE, 0x7A, 0x85, 0x97, 0xAE, 0x24, 0x22, 
+0x8C, 0x8E, 0xE7, 0x30, 0x1B, 0xC9, 0xE9, 0x9E, 
+0x34, 0x05, 0x8F, 0xA2, 0x77, 0xD4, 0xEB, 0x95, 
+0xBA, 0x91, 0xF4, 0x61, 0xE1, 0xBC, 0x17, 0x99, 
+0xB7, 0xE4, 0xDC, 0x0F, 0x0F, 0x4C, 0x06, 0x39, 
+0xDA, 0x90, 
+
+0xD8, 0x59, 0x8C, 0x71, 0x2D, 0x70, 0x13, 0x98, 
+0xC9, 0x84, 0x4F, 0x5D, 0x01, 0x27, 0x39, 0xC1, 
+0x09, 0x75, 0x61, 0x78, 0xDB, 0xDD, 0x47, 0x0A, 
+0x63, 0xDB, 0xAA, 0x7D, 0x83, 0xA2, 0xC1, 0x80, 
+0x09, 0xAC, 0x32, 0x34, 0xC3, 0x9D, 0x13, 0x02, 
+0xC7, 0xEC, 0x9A, 0x6E, 0x2E, 0x2D, 0x70, 0x61, 
+0x87, 0xE6, 0xE7, 0x22, 0x6E, 0x7E, 0x48, 0xB1, 
+0xFB, 0x8B, 0xE9, 0x6E, 0x88, 0xD3, 0x6F, 0x9D, 
+0x78, 0xA0, 0xAC, 0xF8, 0x2A, 0xA1, 0xB8, 0x7F, 
+0xAF, 0xA0, 0x16, 0xF4, 0x9B, 0x4B, 0x1A, 0x3C, 
+0x34, 0xF3, 0xBF, 0xB9, 0xE0, 0x41, 0xC8, 0xF1, 
+0x7E, 0x1C, 0xAC, 0xAB, 0x04, 0xD6, 0x84, 0xD5, 
+0x26, 0xB2, 0x7A, 0xCA, 0xE8, 0xF3, 0x16, 0x0D, 
+0xCC, 0x18, 0xAE, 0x9D, 0x33, 0xC4, 0x31, 0xE5, 
+0xEB, 0x33, 0x3E, 0xAB, 0x1B, 0xC5, 0x09, 0xAA, 
+0xFA, 0x5B, 0x5E, 
+
+0x2C, 0xC4, 0xDD, 0xBA, 0x59, 0x73, 0x33, 0xE7, 
+0xBB, 0x3A, 0x4F, 0x59, 0x74, 0x11, 0xAB, 0x78, 
+0x26, 0xA5, 0x2F, 0x55, 0xF7, 0x21, 0x66, 0xE9, 
+0x5C, 0x7A, 0x5C, 0x01, 0xDD, 0x23, 0xBD, 0xE3, 
+0xA1, 0xFF, 0x0B, 0x04, 0x79, 0x5B, 0xC0, 0x95, 
+0x12, 0x99, 0xA0, 0xB4, 0x70, 0xAD, 0x5C, 0x77, 
+0x33, 0xC0, 0x62, 0x23, 0x2F, 0xF1, 0xAA, 0x60, 
+0xD5, 0xB6, 0x47, 0x55, 0x2C, 0x5C, 0x6C, 0x3F, 
+0x4B, 0x87, 0xA2, 0x40, 0xD8, 0x08, 0x38, 0xE4, 
+0x14, 0x15, 0x90, 0xCF, 0xC8, 0x9D, 0xB5, 0x93, 
+0x12, 0xC9, 0x3F, 0x4A, 0x99, 0xA7, 0x95, 0x1C, 
+0xC3, 0xC2, 0x3C, 0x7D, 0x41, 0xDA, 0xFC, 0x00, 
+0x89, 0x79, 0x0C, 0xBB, 0x73, 0xB2, 0xB4, 0xFA, 
+0xED, 0x80, 0x7B, 0xE0, 0x44, 0xB6, 0x78, 0x76, 
+0xF3, 0xBA, 0x3A, 0x27, 0x02, 0xD2, 0xED, 0x46, 
+0x1D, 0x75, 0xA3, 0xFE, 
+
+0x6E, 0x2D, 0xEB, 0xD7, 0xA9, 0x1B, 0xE3, 0xDD, 
+0x1A, 0xBA, 0x8F, 0xAD, 0x4A, 0xE7, 0x69, 0x54, 
+0x57, 0x3A, 0xA3, 0x9C, 0x0C, 0xD4, 0x61, 0x60, 
+0x2E, 0x55, 0x1F, 0x44, 0x5E, 0x25, 0x34, 0x94, 
+0xA9, 0xA6, 0x5D, 0xA9, 0x5C, 0x67, 0x02, 0x39, 
+0xA3, 0x5B, 0x85, 0x22, 0xA4, 0x6A, 0x71, 0xCB, 
+0xD9, 0xE9, 0x47, 0x9F, 0x14, 0x55, 0xFD, 0x57, 
+0x6D, 0x1B, 0xED, 0x86, 0x48, 0xF0, 0x8B, 0x77, 
+0x7E, 0x84, 0xA5, 0xC7, 0xA1, 0x33, 0x3C, 0x2B, 
+0xE3, 0xF3, 0xAF, 0xB4, 0xB8, 0x2D, 0xE5, 0x8A, 
+0x11, 0x8F, 0x5A, 0x9C, 0x94, 0x8C, 0x15, 0xA0, 
+0x97, 0xE0, 0x91, 0x98, 0xFA, 0x74, 0xAF, 0xFC, 
+0xBB, 0x90, 0x2C, 0xFA, 0xF7, 0x4B, 0x3F, 0xD2, 
+0xE2, 0xF9, 0x6E, 0x3F, 0x87, 0xDF, 0x18, 0x8E, 
+0xA0, 0xA5, 0x37, 0xD6, 0xE2, 0x20, 0x74, 0x1F, 
+0xF7, 0x68, 0x5A, 0x84, 0x3E, 
+
+0x7F, 0x3F, 0x78, 0x00, 0x6E, 0x67, 0xD9, 0x91, 
+0x46, 0x1D, 0x5C, 0xC9, 0x5B, 0xF5, 0xF1, 0xF0, 
+0x8C, 0x85, 0x60, 0x1B, 0x8B, 0x30, 0xFE, 0xFC, 
+0x6E, 0x90, 0xBA, 0xBC, 0xF0, 0x66, 0xE8, 0x5C, 
+0x87, 0x86, 0x2B, 0xC1, 0xE2, 0x3D, 0x8C, 0x91, 
+0x47, 0xC2, 0xA0, 0xDC, 0x93, 0xFA, 0x86, 0xC9, 
+0xFA, 0x8F, 0x49, 0x87, 0x17, 0x7B, 0x69, 0x66, 
+0x02, 0x91, 0x92, 0xFF, 0xB4, 0xA6, 0x62, 0x97, 
+0xA3, 0xA2, 0xBD, 0x7C, 0x63, 0x99, 0x1A, 0x0D, 
+0x66, 0xD4, 0x49, 0x79, 0xBB, 0x31, 0x49, 0xC8, 
+0x5E, 0x58, 0x3D, 0x70, 0xAC, 0x2E, 0xD3, 0xED, 
+0x61, 0x11, 0xCC, 0x28, 0xDE, 0x5E, 0xA3, 0xA5, 
+0xC6, 0x31, 0x4D, 0x1E, 0x92, 0x5D, 0x22, 0xDC, 
+0x6B, 0x58, 0x79, 0x85, 0x24, 0xE4, 0x5A, 0x23, 
+0xDF, 0xA0, 0xB2, 0xB7, 0x4B, 0x95, 0x84, 0x58, 
+0x6F, 0x9B, 0xB5, 0x80, 0xD0, 0x29, 
+
+0xDD, 0x65, 0x77, 0x29, 0xAF, 0x2E, 0x14, 0xD7, 
+0x9B, 0xDA, 0xB3, 0x4D, 0x34, 0xA6, 0x42, 0x63, 
+0x4E, 0x86, 0xD3, 0x02, 0xCF, 0x29, 0x87, 0x95, 
+0xEC, 0x4F, 0x2C, 0xF7, 0x08, 0xC0, 0x78, 0xE3, 
+0x22, 0x96, 0xF2, 0xBE, 0x9D, 0x33, 0x70, 0x0F, 
+0xB9, 0x80, 0x0B, 0x5E, 0x2A, 0xAA, 0x59, 0xD5, 
+0x73, 0x69, 0x99, 0x92, 0xA4, 0x9E, 0x50, 0x88, 
+0xF7, 0x91, 0xA7, 0x4F, 0x64, 0x34, 0x47, 0x3D, 
+0xA6, 0x72, 0xE4, 0xB2, 0x2B, 0xD5, 0xE2, 0x14, 
+0x20, 0x71, 0x23, 0xF0, 0x91, 0x29, 0x98, 0x8B, 
+0x63, 0x31, 0x5E, 0xB2, 0xAA, 0x2C, 0xDF, 0x36, 
+0xA5, 0x5D, 0x61, 0xFA, 0xCF, 0x0F, 0x40, 0xA8, 
+0x1E, 0x43, 0xEB, 0x52, 0xA5, 0x87, 0x9D, 0x19, 
+0x33, 0xD0, 0xBA, 0x50, 0xAE, 0xED, 0x29, 0x5A, 
+0xF9, 0x2F, 0x93, 0x75, 0xAB, 0xF7, 0x07, 0x55, 
+0xB0, 0x53, 0x24, 0x09, 0x8B, 0xE1, 0x2B, 
+
+0xA4, 0x98, 0xB7, 0x2D, 0x65, 0x11, 0x3B, 0x75, 
+0xC7, 0x67, 0x16, 0xDF, 0x34, 0x2D, 0x03, 0x9F, 
+0x8D, 0x8B, 0xE8, 0x17, 0x13, 0x9F, 0xAA, 0x8F, 
+0x08, 0x87, 0xA2, 0xB8, 0x57, 0xBA, 0x60, 0x41, 
+0x29, 0x1C, 0x06, 0x40, 0x36, 0x5E, 0xC3, 0x4C, 
+0x01, 0x0D, 0x3A, 0xE8, 0x76, 0xD0, 0x67, 0x09, 
+0x68, 0x7D, 0x63, 0xC1, 0x3D, 0x60, 0x84, 0x1D, 
+0xA2, 0x62, 0x7A, 0xD8, 0x9F, 0x0E, 0xCD, 0x7A, 
+0x4B, 0xC5, 0x53, 0xC9, 0xDE, 0xA6, 0x44, 0x72, 
+0x3F, 0x5B, 0x1F, 0xCA, 0xCA, 0x5C, 0x9F, 0xF3, 
+0xDF, 0xA3, 0x76, 0x0D, 0x2E, 0x9B, 0x38, 0xEB, 
+0x59, 0xBC, 0x07, 0x2E, 0xD1, 0xD7, 0x08, 0x28, 
+0x5B, 0xC7, 0x00, 0xDD, 0xF6, 0xDF, 0xA4, 0x9B, 
+0xEC, 0xC4, 0x63, 0x47, 0x51, 0x52, 0xC0, 0xC2, 
+0xFE, 0x9A, 0xC2, 0xB8, 0xDB, 0x3A, 0x56, 0x0F, 
+0xAD, 0x1D, 0xEB, 0x16, 0x95, 0xBD, 0x5C, 0xA6, 
+
+0x1A, 0xCD, 0xA9, 0x16, 0xEE, 0xA4, 0x53, 0x3C, 
+0xF8, 0x76, 0x66, 0x4B, 0xD3, 0x97, 0x4D, 0xD2, 
+0x41, 0x65, 0x37, 0x04, 0xEA, 0x8E, 0x2F, 0x7F, 
+0x72, 0x26, 0xE5, 0xA6, 0x65, 0x13, 0x69, 0x48, 
+0xCB, 0xA0, 0x9E, 0x25, 0xC8, 0x57, 0xA6, 0x0E, 
+0xB3, 0xC5, 0x25, 0x73, 0x4F, 0x84, 0x2E, 0xFF, 
+0x81, 0x8A, 0xFB, 0x6C, 0x80, 0x5C, 0x35, 0xBA, 
+0x59, 0x3E, 0xE5, 0xFC, 0x67, 0xFC, 0x5D, 0x5B, 
+0x9A, 0xDE, 0xAF, 0x0F, 0x39, 0xD0, 0x12, 0x0C, 
+0xD2, 0xCA, 0xD1, 0xCA, 0xAB, 0xD1, 0x7D, 0xE3, 
+0xA8, 0xC0, 0x68, 0x0D, 0x15, 0xAD, 0x3C, 0x00, 
+0x72, 0x87, 0xF8, 0x9D, 0x86, 0x1F, 0xDD, 0x05, 
+0x19, 0x48, 0x0C, 0xEF, 0xB6, 0xE3, 0x86, 0x6E, 
+0x74, 0xAA, 0x7F, 0xEB, 0x5E, 0xC5, 0xAD, 0xCB, 
+0x7E, 0x7C, 0x88, 0x8D, 0x73, 0x73, 0x42, 0x95, 
+0x43, 0x1D, 0x14, 0x87, 0x17, 0x2F, 0xEB, 0x93, 
+0x07, 
+
+0x3B, 0x72, 0x07, 0xAD, 0x75, 0x10, 0xBD, 0x39, 
+0x4A, 0xDA, 0x21, 0xEE, 0xF1, 0xF6, 0xD4, 0xF5, 
+0x20, 0xC3, 0x37, 0xE3, 0x83, 0xE2, 0xF9, 0xAE, 
+0x16, 0x88, 0xD5, 0xD6, 0x80, 0x31, 0x4F, 0x88, 
+0x48, 0xAF, 0xA6, 0xCE, 0xE4, 0x67, 0xFA, 0x58, 
+0x3D, 0x4F, 0xB9, 0x73, 0x33, 0x80, 0x64, 0x7F, 
+0x57, 0xC0, 0x24, 0xF7, 0xD4, 0x6F, 0x4C, 0x17, 
+0xD0, 0x85, 0x83, 0x20, 0x4A, 0xF1, 0x98, 0x4F, 
+0x5F, 0x25, 0xC3, 0xE6, 0x29, 0xDF, 0x3B, 0x98, 
+0xFB, 0x5E, 0x91, 0x93, 0xDB, 0x93, 0x02, 0xFE, 
+0x80, 0x57, 0x84, 0xA1, 0x32, 0x28, 0xF0, 0xDC, 
+0xB0, 0x07, 0x93, 0x0F, 0x01, 0xAA, 0x2F, 0x85, 
+0xB5, 0x5D, 0xA8, 0x5F, 0x46, 0x2E, 0x1F, 0xF8, 
+0x46, 0x59, 0x26, 0x35, 0x24, 0x4E, 0xFB, 0xDD, 
+0x6E, 0x3E, 0x64, 0x9F, 0xC3, 0x43, 0x3B, 0x9C, 
+0xA4, 0xC7, 0x1C, 0x09, 0xAB, 0xBF, 0x6E, 0x27, 
+0x5E, 0xB8, 
+
+0xAB, 0xF3, 0xCE, 0xEF, 0x9C, 0x94, 0xA1, 0xBF, 
+0x30, 0xD5, 0xBA, 0x9E, 0xF0, 0xBD, 0x85, 0xD8, 
+0x62, 0x1D, 0x15, 0x77, 0xBF, 0xE5, 0xED, 0x28, 
+0xD5, 0x6B, 0xBE, 0xC5, 0x5E, 0xF3, 0x78, 0xB7, 
+0x54, 0x55, 0xF8, 0xC2, 0x06, 0xC6, 0x17, 0x91, 
+0x92, 0x28, 0xBA, 0xC6, 0x65, 0xD9, 0x33, 0xD1, 
+0xB1, 0xBB, 0x0A, 0xF1, 0x31, 0xD4, 0x78, 0x2C, 
+0x59, 0xA6, 0xCA, 0xDB, 0x1B, 0xCD, 0xEB, 0x76, 
+0x96, 0x39, 0xB3, 0xE4, 0xA8, 0xE0, 0x83, 0x7D, 
+0xB3, 0xC4, 0x06, 0x26, 0x2E, 0x77, 0xF2, 0x96, 
+0x84, 0xD8, 0xF3, 0x4A, 0xC0, 0x49, 0x5A, 0x73, 
+0xFE, 0x11, 0x73, 0x28, 0x90, 0x47, 0x5E, 0x9F, 
+0x3F, 0xD9, 0x23, 0x84, 0x2F, 0x6C, 0xE8, 0x90, 
+0xF4, 0x9E, 0xFB, 0xFB, 0x43, 0xFE, 0xFA, 0xC5, 
+0x4C, 0x0D, 0xA6, 0xA5, 0x73, 0x27, 0x79, 0xE5, 
+0x4B, 0x6F, 0x6B, 0xAE, 0x72, 0xCF, 0x96, 0xD0, 
+0x73, 0x09, 0xA4, 
+
+0x13, 0x93, 0xBF, 0x38, 0x6D, 0xFB, 0x67, 0xB7, 
+0x0D, 0x43, 0x69, 0xC7, 0x9E, 0xFE, 0xA4, 0x57, 
+0x52, 0x19, 0x15, 0xF9, 0xA7, 0x39, 0xD1, 0x49, 
+0x2A, 0x3B, 0xC3, 0xC1, 0xC5, 0xC5, 0xD6, 0x65, 
+0xCC, 0x38, 0xFC, 0xC5, 0x4C, 0xD0, 0x3E, 0xE9, 
+0x00, 0x1A, 0x55, 0x5F, 0x13, 0x1F, 0xD9, 0xA5, 
+0x3A, 0x6C, 0xAF, 0x40, 0x45, 0xC9, 0x92, 0x71, 
+0xD9, 0x7A, 0xEF, 0x70, 0xFC, 0xC0, 0x3D, 0xEF, 
+0x1E, 0xC6, 0xF1, 0xFA, 0xF4, 0x19, 0x7C, 0xF9, 
+0xEB, 0x4C, 0x78, 0xD3, 0xED, 0x86, 0xCC, 0x8C, 
+0x9C, 0xCE, 0x6C, 0x49, 0x7D, 0xD6, 0x63, 0x68, 
+0xF0, 0x90, 0x8A, 0x0A, 0xCD, 0xC2, 0x07, 0xF9, 
+0x55, 0x20, 0xB1, 0x2B, 0xAD, 0xA3, 0x06, 0xF7, 
+0x9B, 0x6F, 0x68, 0x3F, 0x0A, 0xAB, 0x60, 0x9B, 
+0xD6, 0xF6, 0x60, 0xB8, 0xD8, 0x7F, 0x22, 0x19, 
+0x44, 0x2E, 0x2D, 0x4E, 0x13, 0x0B, 0xC2, 0x1F, 
+0x33, 0x2E, 0x93, 0xEF, 
+
+0x8B, 0x7F, 0xB5, 0x47, 0x31, 0x9D, 0x45, 0x6A, 
+0xC3, 0x11, 0x67, 0xC7, 0xF3, 0x4B, 0x43, 0x5A, 
+0x10, 0x7C, 0x21, 0x79, 0xDE, 0x82, 0xB9, 0x38, 
+0x9E, 0x91, 0x00, 0x30, 0xDF, 0xE9, 0xF4, 0x97, 
+0x98, 0x32, 0x62, 0x1E, 0xDE, 0x01, 0x71, 0xCE, 
+0x95, 0x32, 0x81, 0xEF, 0x6C, 0x61, 0xD7, 0x92, 
+0x1B, 0x94, 0x5B, 0x25, 0xAF, 0x09, 0xFC, 0xD0, 
+0x22, 0xFA, 0x92, 0xCF, 0xC4, 0x50, 0x9E, 0x25, 
+0x8B, 0x00, 0x34, 0x3F, 0x8F, 0xAD, 0xF3, 0xB8, 
+0x62, 0xE7, 0x25, 0xDC, 0xD0, 0x34, 0x67, 0x79, 
+0xE6, 0x3A, 0x51, 0x4C, 0xFB, 0xB8, 0x23, 0xEE, 
+0x4B, 0xAE, 0x46, 0xBE, 0x6E, 0xA3, 0x9C, 0x0B, 
+0x3C, 0xF4, 0x1F, 0xF8, 0x4A, 0x80, 0x7A, 0x15, 
+0x1B, 0x3C, 0x63, 0xED, 0x82, 0xF7, 0x7A, 0x8C, 
+0xCD, 0xBA, 0x8E, 0x37, 0x7C, 0x87, 0xCD, 0xD6, 
+0x60, 0x57, 0x29, 0x19, 0xB9, 0xD8, 0xA2, 0x2F, 
+0x79, 0x1F, 0x1B, 0x14, 0x6A, 
+
+0xC8, 0xB1, 0xC9, 0x45, 0x33, 0x1D, 0xEF, 0xCA, 
+0x00, 0x7A, 0xA3, 0x95, 0x6D, 0xDE, 0xB3, 0x9D, 
+0x57, 0xA4, 0x6C, 0x72, 0x91, 0xCD, 0x35, 0xEA, 
+0xEE, 0xF1, 0xC9, 0xEE, 0xB7, 0x71, 0xEE, 0x6A, 
+0x6A, 0xC8, 0xD9, 0x32, 0xE9, 0xA9, 0xA0, 0xC8, 
+0xB4, 0x60, 0x91, 0xE8, 0x6D, 0x4B, 0x8B, 0x70, 
+0x0F, 0x33, 0xB4, 0x03, 0x70, 0x4A, 0x09, 0x67, 
+0x1B, 0x10, 0xA3, 0x55, 0xE6, 0xF4, 0x23, 0xA9, 
+0x5F, 0x0A, 0x3D, 0x9F, 0xA6, 0x00, 0xCB, 0xF1, 
+0x4C, 0xD6, 0xC4, 0x47, 0xE8, 0x29, 0x6D, 0xAA, 
+0x74, 0x1F, 0x6C, 0xC8, 0x07, 0x71, 0xD4, 0x4D, 
+0x90, 0x76, 0xA7, 0x83, 0xCE, 0x11, 0x71, 0xE3, 
+0x84, 0xEA, 0x78, 0x56, 0xFD, 0xE2, 0xBB, 0xDA, 
+0x46, 0xF6, 0x88, 0x78, 0xB1, 0x73, 0xC1, 0x67, 
+0x35, 0x80, 0xA2, 0x1C, 0xA6, 0x84, 0x53, 0xD3, 
+0x3D, 0xC9, 0xB7, 0x4A, 0xD6, 0xB6, 0x3D, 0x81, 
+0x0F, 0x4C, 0x36, 0x6B, 0xCD, 0x48, 
+
+0xB8, 0x29, 0x11, 0xC3, 0x2A, 0x80, 0x13, 0x70, 
+0xB8, 0x46, 0xD6, 0x4C, 0x0E, 0x15, 0x75, 0x9D, 
+0x84, 0x2C, 0x3F, 0x4E, 0xE1, 0x09, 0x6A, 0x9B, 
+0x73, 0xFF, 0x31, 0xE7, 0x7E, 0x03, 0x7A, 0x82, 
+0x6D, 0xD3, 0xC2, 0xA4, 0x87, 0x9B, 0x10, 0x40, 
+0x6C, 0x1E, 0x4E, 0xF1, 0xE3, 0xDA, 0xA9, 0xC1, 
+0xAA, 0x23, 0x23, 0x68, 0x58, 0x1C, 0x99, 0x46, 
+0xFC, 0xC5, 0x54, 0x57, 0x71, 0x6D, 0x22, 0xE9, 
+0x6F, 0xED, 0xC6, 0x56, 0x7E, 0x2E, 0x95, 0x11, 
+0x24, 0x8C, 0x04, 0x4F, 0x34, 0x08, 0x7E, 0x02, 
+0x71, 0x55, 0x98, 0x56, 0xB3, 0xA8, 0x0B, 0x14, 
+0x39, 0x33, 0x8B, 0x76, 0xFD, 0xF2, 0x61, 0x43, 
+0xE5, 0xB5, 0x02, 0x5E, 0x4F, 0x86, 0x84, 0xE2, 
+0x55, 0x43, 0xAA, 0xD5, 0x2D, 0x6D, 0xDB, 0xDF, 
+0x00, 0x6B, 0x75, 0x37, 0x46, 0xE9, 0xB5, 0x10, 
+0xDB, 0x3F, 0x5A, 0xC5, 0xE3, 0xA0, 0x81, 0x47, 
+0xB2, 0x01, 0xA6, 0x77, 0xE3, 0xD8, 0xCC, 
+
+0x99, 0x56, 0x1A, 0x26, 0x97, 0x9F, 0x17, 0xF9, 
+0x31, 0x8E, 0x94, 0x97, 0x41, 0xF9, 0x94, 0xA0, 
+0x8B, 0x3E, 0x4D, 0x49, 0xC9, 0x26, 0xD9, 0xDC, 
+0xAD, 0x13, 0x60, 0xDD, 0x9C, 0x73, 0xA5, 0xE5, 
+0x51, 0x93, 0x7C, 0xE0, 0xE8, 0xD7, 0x44, 0xD6, 
+0x04, 0x02, 0x3F, 0xD4, 0x05, 0xE3, 0x60, 0xFE, 
+0x65, 0x2A, 0xEB, 0x96, 0x33, 0x16, 0xE3, 0x04, 
+0xA4, 0xFE, 0x8F, 0xCF, 0x25, 0xBC, 0xA4, 0x4D, 
+0xA7, 0x69, 0x63, 0x19, 0x2B, 0x5F, 0x3E, 0xAB, 
+0x45, 0xD2, 0xE2, 0xD0, 0xC5, 0x80, 0xEB, 0x7D, 
+0x53, 0x65, 0x4E, 0x48, 0x2D, 0x3E, 0x66, 0x08, 
+0xEA, 0xEC, 0x9D, 0x6A, 0xB6, 0x04, 0x69, 0xE4, 
+0xB4, 0x27, 0x60, 0xFF, 0x3C, 0x0D, 0x57, 0x0B, 
+0x9F, 0x39, 0xDC, 0x10, 0x15, 0x4F, 0xCA, 0xD2, 
+0xC0, 0xFB, 0x0B, 0x67, 0x90, 0x63, 0x01, 0xAA, 
+0x40, 0x43, 0xF3, 0xBD, 0x6A, 0xA5, 0xA7, 0xA0, 
+0x75, 0x4C, 0x51, 0x3B, 0x5D, 0x0B, 0x24, 0x84, 
+
+0xFF, 0xCC, 0x18, 0xB1, 0x20, 0xC8, 0xC0, 0x71, 
+0x06, 0x1B, 0x3C, 0x42, 0x0C, 0xE3, 0xD0, 0x9F, 
+0x64, 0x2F, 0x5A, 0xA7, 0xF8, 0x9C, 0xF2, 0x93, 
+0x7E, 0x73, 0xCD, 0x0F, 0xB2, 0x8E, 0x87, 0x00, 
+0x59, 0x01, 0xED, 0x9C, 0xCF, 0xCD, 0x2E, 0x6C, 
+0x3E, 0xAB, 0x61, 0xD3, 0x07, 0x6E, 0xD9, 0x03, 
+0xA8, 0x90, 0xB7, 0xAC, 0x28, 0xFF, 0xA8, 0x64, 
+0x70, 0xF6, 0x61, 0x4E, 0xF6, 0xA0, 0x07, 0xFF, 
+0x59, 0xDA, 0xB3, 0x08, 0x37, 0xF7, 0x8D, 0x63, 
+0x03, 0xAB, 0xC5, 0xB6, 0xD7, 0x58, 0xFD, 0x25, 
+0x0B, 0x66, 0xB3, 0x2E, 0x50, 0xA9, 0x14, 0xE5, 
+0x69, 0x3D, 0x04, 0x6C, 0x3E, 0xDE, 0x12, 0x49, 
+0x9B, 0x65, 0xD8, 0xA8, 0x7E, 0xB2, 0x9B, 0x7D, 
+0xAC, 0x2D, 0x0A, 0x53, 0xF5, 0xB1, 0xF1, 0x7D, 
+0xE5, 0x92, 0x4E, 0x79, 0x00, 0x8F, 0x54, 0xA2, 
+0xF3, 0xBE, 0x4D, 0x9C, 0x95, 0xFC, 0xD6, 0xAA, 
+0x5E, 0xF2, 0x66, 0xAA, 0x38, 0x19, 0xFC, 0x92, 
+0x7B, 
+
+0x6A, 0x16, 0x38, 0x35, 0xF0, 0x63, 0x10, 0x97, 
+0x64, 0x6E, 0x2F, 0xA8, 0x52, 0xB9, 0xE2, 0x97, 
+0xEF, 0xA1, 0xC5, 0xA0, 0x42, 0x04, 0x46, 0x09, 
+0xE8, 0xFA, 0xBB, 0x35, 0xD7, 0xDF, 0x63, 0x87, 
+0x3D, 0x51, 0x21, 0x74, 0x47, 0x9B, 0x10, 0xEC, 
+0xA6, 0x25, 0xF5, 0xFD, 0x66, 0x49, 0x07, 0xB6, 
+0xC0, 0x26, 0x72, 0x53, 0xAA, 0x8A, 0xEB, 0x69, 
+0xFB, 0x9B, 0x57, 0x1E, 0x50, 0x50, 0x59, 0xE2, 
+0xDB, 0xDC, 0xBD, 0x5B, 0x4D, 0xC5, 0x3B, 0xA9, 
+0x42, 0xF9, 0xCF, 0x46, 0x29, 0x4F, 0x34, 0x41, 
+0xDC, 0x4E, 0x67, 0x18, 0xDF, 0x33, 0xE5, 0x08, 
+0xA1, 0x31, 0xC0, 0x50, 0xD9, 0x1A, 0x3D, 0x15, 
+0xC4, 0xB7, 0x1F, 0x49, 0x8B, 0x07, 0x49, 0x9E, 
+0x3E, 0xE1, 0xCB, 0x14, 0x1E, 0x91, 0x5E, 0x7D, 
+0xC8, 0x0D, 0x3E, 0x64, 0x23, 0x51, 0xF9, 0x98, 
+0x79, 0x2B, 0x2A, 0x5A, 0x16, 0xCA, 0x78, 0xAE, 
+0xD8, 0x8A, 0xD0, 0xA9, 0x3B, 0xDF, 0xB5, 0x57, 
+0x56, 0xFA, 
+
+0x63, 0x3D, 0x54, 0x9C, 0x33, 0xD5, 0x2B, 0xE4, 
+0xD9, 0x9E, 0xB2, 0xA3, 0x50, 0x8C, 0x71, 0x29, 
+0xF8, 0x2C, 0xD4, 0xD2, 0x46, 0x58, 0x57, 0x07, 
+0xFD, 0xE3, 0x3A, 0x05, 0xD0, 0x0A, 0x56, 0x2C, 
+0xAE, 0xC7, 0x95, 0xE2, 0x1A, 0x93, 0xF5, 0xA8, 
+0xFE, 0x14, 0x42, 0xB2, 0xAF, 0x5F, 0xA2, 0x11, 
+0x81, 0xE7, 0xB7, 0xE7, 0xBD, 0x5F, 0xDE, 0xBB, 
+0xD5, 0x41, 0x18, 0x56, 0xC9, 0x4D, 0x6D, 0x5C, 
+0x74, 0x69, 0x75, 0x32, 0x1F, 0x44, 0x44, 0x3F, 
+0xDD, 0x2B, 0x6E, 0xEF, 0xAE, 0x2F, 0xFD, 0xAA, 
+0xD2, 0x00, 0x25, 0x36, 0xA4, 0x39, 0x11, 0xCF, 
+0xA8, 0xBB, 0x92, 0x9D, 0xF7, 0x07, 0x93, 0x7E, 
+0x67, 0x71, 0x5B, 0x23, 0xE1, 0xD1, 0xFF, 0x66, 
+0xAF, 0xA1, 0x4B, 0x3E, 0xD7, 0x94, 0xC1, 0xAB, 
+0xE3, 0x38, 0x8C, 0x98, 0xC5, 0xE6, 0x2F, 0xF4, 
+0x47, 0xD0, 0x37, 0x4F, 0x6D, 0x03, 0xB8, 0x52, 
+0x5D, 0x1F, 0x7A, 0x25, 0x91, 0xB9, 0x9F, 0x38, 
+0x72, 0x96, 0xE4, 
+
+0x30, 0x44, 0x42, 0xF3, 0x50, 0xBA, 0xEA, 0x55, 
+0x00, 0x29, 0x86, 0xFC, 0x37, 0xAF, 0xB1, 0x5B, 
+0x0A, 0xF9, 0x16, 0x3A, 0xDE, 0xBC, 0x44, 0x13, 
+0xDB, 0x50, 0xA1, 0xEB, 0x8D, 0x74, 0xA7, 0x79, 
+0xD9, 0x92, 0xA1, 0xA0, 0xF9, 0xCC, 0xB4, 0xD0, 
+0xF3, 0x09, 0x6E, 0x8B, 0x76, 0x51, 0x9E, 0x21, 
+0x18, 0x05, 0xF9, 0xD7, 0x53, 0x64, 0x94, 0xCF, 
+0x42, 0xE3, 0x2B, 0x33, 0x7D, 0x4C, 0xE7, 0x46, 
+0xF0, 0xD5, 0xFE, 0xEC, 0xEE, 0xC2, 0xCF, 0x35, 
+0x1B, 0x09, 0xA7, 0xD4, 0xF7, 0xB9, 0x3E, 0x97, 
+0xC9, 0x5C, 0x62, 0x31, 0xDE, 0xD8, 0x47, 0x68, 
+0xA9, 0x76, 0x16, 0xE6, 0x8A, 0x78, 0xDB, 0x84, 
+0x89, 0x73, 0xBC, 0x2E, 0x70, 0x74, 0x6F, 0x4D, 
+0x59, 0x0A, 0x82, 0x86, 0xDF, 0x85, 0x34, 0x89, 
+0x3B, 0x3A, 0x15, 0xE0, 0xAC, 0x3C, 0x9D, 0x98, 
+0x83, 0xB5, 0x0A, 0xE9, 0xE1, 0x71, 0x84, 0xF1, 
+0x37, 0x01, 0x7C, 0xD9, 0xA0, 0x87, 0x6E, 0x16, 
+0xAC, 0x3A, 0x4F, 0x22, 
+
+0x13, 0xA5, 0x58, 0xA8, 0x0E, 0x32, 0x31, 0xFE, 
+0xFD, 0xC0, 0xC1, 0x25, 0x34, 0xF9, 0x41, 0x4E, 
+0x64, 0x4D, 0x1E, 0x9E, 0xB9, 0xDE, 0x90, 0xDD, 
+0x0E, 0x06, 0xBF, 0x96, 0xEA, 0xB0, 0x27, 0x6C, 
+0xEB, 0x53, 0x3B, 0x57, 0x3D, 0xD5, 0x1F, 0x7D, 
+0xA9, 0xE7, 0x7B, 0x23, 0x98, 0x37, 0xFE, 0x58, 
+0x89, 0x50, 0xE8, 0x38, 0xB3, 0xFD, 0x97, 0xF0, 
+0xCF, 0xEC, 0xCF, 0xA3, 0x98, 0xA2, 0xD4, 0x3A, 
+0xD2, 0xBF, 0x1D, 0x20, 0x99, 0x1B, 0x28, 0xBC, 
+0xB4, 0xD2, 0xC9, 0x58, 0xFD, 0x74, 0x34, 0x8F, 
+0xE7, 0xDA, 0x3A, 0xEE, 0x41, 0x22, 0xC3, 0x55, 
+0x75, 0xF9, 0x8D, 0x91, 0x25, 0xC8, 0xCA, 0x84, 
+0x9C, 0x00, 0x28, 0x0B, 0x69, 0xCF, 0x52, 0x83, 
+0x4D, 0x08, 0x61, 0x67, 0xEB, 0xD8, 0xA6, 0x84, 
+0x15, 0x58, 0x76, 0x4C, 0xB9, 0x1F, 0xF9, 0x6E, 
+0x0C, 0x6E, 0x15, 0xE0, 0xB6, 0x2D, 0x85, 0xF6, 
+0x3F, 0x65, 0x6F, 0x56, 0x1C, 0x4F, 0xDD, 0x4C, 
+0x5C, 0x78, 0x3C, 0x44, 0x62, 
+
+0xFB, 0xF6, 0xD9, 0x39, 0xA7, 0xA4, 0x83, 0xA5, 
+0xDD, 0xF4, 0xA1, 0xBD, 0x13, 0xDE, 0xB2, 0x18, 
+0xD2, 0x4E, 0xE6, 0xF1, 0x3B, 0xCF, 0xCA, 0xC7, 
+0xF2, 0xC5, 0x73, 0x1F, 0x3B, 0x27, 0xD2, 0xA9, 
+0x43, 0xD1, 0xBD, 0xB5, 0xCC, 0x6C, 0xF3, 0xE9, 
+0x00, 0x91, 0xCF, 0x38, 0xA7, 0xF2, 0x58, 0xDC, 
+0x0D, 0x68, 0xF3, 0x42, 0x20, 0x66, 0x2F, 0xCA, 
+0x40, 0x6E, 0x88, 0xDE, 0x42, 0xF1, 0x48, 0x02, 
+0xFC, 0xCC, 0xF7, 0x9C, 0x8D, 0x2C, 0x5B, 0xF9, 
+0xA0, 0x8B, 0x69, 0x34, 0x90, 0x06, 0x27, 0x1E, 
+0x3A, 0xB9, 0x02, 0x54, 0xEE, 0x7E, 0x7E, 0x64, 
+0xDE, 0x0D, 0xDC, 0xB6, 0x4F, 0x1C, 0xE4, 0xC2, 
+0xEF, 0xBB, 0x35, 0x86, 0xAE, 0x5F, 0x71, 0xF0, 
+0x40, 0x33, 0xE4, 0x6A, 0x79, 0xE7, 0xEA, 0x8A, 
+0x60, 0xE7, 0x46, 0x90, 0x92, 0x1F, 0xB3, 0x80, 
+0xFE, 0x6C, 0x2F, 0x50, 0x32, 0x7E, 0xBB, 0x52, 
+0x4B, 0x60, 0x1A, 0x69, 0x35, 0x62, 0x24, 0xF1, 
+0xA9, 0xA8, 0xA4, 0xAA, 0xC2, 0x76, 
+
+0x78, 0xF6, 0xA5, 0xA2, 0xEA, 0x62, 0x5C, 0xDA, 
+0xDE, 0xE2, 0xE3, 0x5E, 0x44, 0x75, 0xBD, 0x00, 
+0xA5, 0x31, 0x9B, 0x81, 0x02, 0xC9, 0xF4, 0x3E, 
+0x86, 0x2D, 0xAF, 0x56, 0xEE, 0xC7, 0x19, 0x76, 
+0x6D, 0x98, 0x1A, 0x44, 0x27, 0x1F, 0xAA, 0x80, 
+0x15, 0xA1, 0xDE, 0xB6, 0x72, 0x3E, 0xD3, 0xC5, 
+0xF4, 0x63, 0xA9, 0x38, 0x8C, 0x22, 0x50, 0xF9, 
+0xF3, 0x01, 0x4C, 0x44, 0xF9, 0x96, 0xB9, 0x57, 
+0xDF, 0x73, 0x85, 0x83, 0xEF, 0x88, 0x13, 0x2F, 
+0x10, 0xDD, 0x01, 0x8D, 0x43, 0x5F, 0xF9, 0xB4, 
+0x0B, 0xC5, 0xF5, 0x83, 0x1D, 0x1A, 0xB8, 0xD1, 
+0xD8, 0xF3, 0x35, 0xF8, 0x67, 0x77, 0x03, 0x8E, 
+0x63, 0xA1, 0x04, 0x4F, 0x9F, 0x0F, 0x1D, 0xD6, 
+0x8D, 0x69, 0x27, 0x17, 0x59, 0xA3, 0xAC, 0x73, 
+0xCD, 0xCE, 0x0D, 0x3A, 0xD2, 0x45, 0x89, 0xF1, 
+0x8E, 0x21, 0x61, 0xB2, 0xD8, 0x6B, 0xB9, 0x9F, 
+0xCD, 0x49, 0x71, 0x1F, 0x33, 0x3C, 0x1E, 0xC3, 
+0x27, 0x96, 0x0E, 0x20, 0xAA, 0xBC, 0x33, 
+
+0x73, 0x3F, 0x44, 0x64, 0x02, 0x88, 0x1F, 0x2F, 
+0xD1, 0x78, 0x42, 0x24, 0x12, 0x25, 0x42, 0x3F, 
+0xC7, 0x17, 0xCB, 0x07, 0x44, 0x06, 0xE5, 0x01, 
+0xEA, 0x2F, 0xC1, 0x66, 0x13, 0x53, 0xDB, 0xE9, 
+0x9A, 0xA9, 0x08, 0xA1, 0x34, 0xC3, 0x83, 0x55, 
+0x5D, 0x40, 0xD8, 0x15, 0xE2, 0x86, 0x1E, 0xBD, 
+0x37, 0x43, 0x68, 0xE1, 0xD7, 0x73, 0x47, 0xA7, 
+0x3F, 0xDA, 0xB2, 0x06, 0xB0, 0xA5, 0x32, 0xBD, 
+0x50, 0x22, 0xC9, 0xA5, 0x6E, 0x2B, 0xA8, 0x9C, 
+0x95, 0x69, 0xF5, 0x35, 0x24, 0xCC, 0x76, 0x93, 
+0xB7, 0xD6, 0xC0, 0xD7, 0x41, 0xDC, 0xF6, 0x61, 
+0x4A, 0xA6, 0x69, 0x8F, 0xFE, 0xA1, 0x44, 0x0F, 
+0xE5, 0x04, 0x63, 0x63, 0x3A, 0x6A, 0xC9, 0x41, 
+0x64, 0xAB, 0x3A, 0x74, 0x25, 0xCE, 0x21, 0xFE, 
+0x10, 0x1C, 0x23, 0x25, 0x84, 0x5B, 0x96, 0x3E, 
+0xF3, 0x0D, 0x35, 0x28, 0x3B, 0x77, 0xA3, 0x1B, 
+0x46, 0xE0, 0x1F, 0xEA, 0xBF, 0xAB, 0x6F, 0x55, 
+0xB5, 0x46, 0xDD, 0xD0, 0xD6, 0xC3, 0x9F, 0xBF, 
+
+0x60, 0x43, 0xC3, 0x2A, 0x65, 0x88, 0xEC, 0x5F, 
+0x67, 0x20, 0xC1, 0x5D, 0x0D, 0xC1, 0xF3, 0xA9, 
+0x91, 0x2A, 0xCE, 0x10, 0xB1, 0xF7, 0x05, 0xE4, 
+0x8C, 0xB7, 0x3A, 0xFD, 0x81, 0x6A, 0x58, 0x60, 
+0x4F, 0xC2, 0x58, 0x5D, 0x81, 0x1A, 0xBD, 0xC8, 
+0x2B, 0xE2, 0xE8, 0xB9, 0xEC, 0x62, 0x4B, 0x17, 
+0xC4, 0xB0, 0x3F, 0xA5, 0x47, 0x5C, 0x7A, 0xE8, 
+0xC7, 0xC4, 0xBC, 0x19, 0x5D, 0x44, 0x67, 0x08, 
+0xA4, 0x00, 0x17, 0xCF, 0x99, 0xFD, 0x79, 0xBC, 
+0x0B, 0x2D, 0x5D, 0x12, 0x38, 0xDC, 0x47, 0x39, 
+0xF8, 0x5E, 0x0D, 0xB4, 0xE4, 0xD9, 0x3C, 0x65, 
+0x23, 0xFD, 0x7A, 0x1F, 0x79, 0x27, 0xE8, 0x9F, 
+0x20, 0x5F, 0x41, 0xF7, 0xA6, 0x59, 0xC5, 0x45, 
+0xD3, 0x65, 0x19, 0xC2, 0xFD, 0x63, 0xD2, 0xAF, 
+0xB1, 0x8C, 0xBC, 0x8A, 0x40, 0xBD, 0x16, 0xC1, 
+0xBF, 0x94, 0x84, 0x70, 0x20, 0x08, 0x59, 0x4F, 
+0x0C, 0xE0, 0xCC, 0xA3, 0x13, 0x66, 0x03, 0x3E, 
+0xB2, 0xC2, 0xF4, 0x9A, 0x26, 0xD4, 0x3C, 0x16, 
+0xD8, 
+
+0xBE, 0x5B, 0x57, 0xAE, 0xA6, 0x23, 0x65, 0xAF, 
+0x56, 0xD2, 0x92, 0x92, 0x23, 0x46, 0xC0, 0x59, 
+0x1F, 0x66, 0x9D, 0x01, 0x9C, 0x23, 0x04, 0xFB, 
+0xED, 0x19, 0xFC, 0xA5, 0x6D, 0xF2, 0x6C, 0x98, 
+0xE1, 0x24, 0xCB, 0x0F, 0x4D, 0x4C, 0x0F, 0x35, 
+0xEC, 0xDE, 0x3F, 0x9B, 0x47, 0x1F, 0x58, 0x05, 
+0x22, 0xB1, 0x0B, 0x24, 0x15, 0x73, 0xBB, 0x0B, 
+0x0F, 0x69, 0x47, 0x15, 0x7C, 0x0E, 0x3E, 0xC8, 
+0x97, 0x23, 0x6E, 0xC0, 0xEE, 0xB7, 0x3C, 0x96, 
+0xF0, 0x12, 0x77, 0xC5, 0xA3, 0xEA, 0x9B, 0xF8, 
+0x90, 0x7A, 0xDB, 0xCB, 0x45, 0x79, 0x51, 0x21, 
+0x82, 0x84, 0x7E, 0x79, 0x61, 0x2E, 0xDE, 0xA6, 
+0xCE, 0x80, 0x28, 0x85, 0xCB, 0x75, 0x42, 0x2D, 
+0x89, 0xD8, 0x6F, 0x68, 0xE5, 0x6E, 0x09, 0x20, 
+0xC0, 0xF5, 0x81, 0xB5, 0x61, 0xFE, 0x76, 0x14, 
+0x96, 0xD2, 0x3F, 0xFD, 0x73, 0xFD, 0x12, 0x28, 
+0x18, 0x67, 0x98, 0xA2, 0x5E, 0xE0, 0xC1, 0x77, 
+0x0D, 0x0E, 0xD8, 0xEB, 0x74, 0x50, 0x8D, 0xE9, 
+0xC3, 0x24, 
+
+0xCE, 0xC0, 0x1B, 0xA7, 0x62, 0x8A, 0x2E, 0x3E, 
+0x35, 0x89, 0x22, 0x0E, 0xD2, 0x9E, 0x8F, 0x7B, 
+0x22, 0x36, 0xA0, 0x56, 0x7F, 0x8A, 0xDE, 0xA6, 
+0x20, 0xFC, 0x6F, 0xE8, 0x66, 0x7C, 0xF1, 0xE4, 
+0x20, 0x79, 0x7F, 0x25, 0x04, 0x12, 0x48, 0xF1, 
+0x94, 0xB9, 0x2D, 0x16, 0x30, 0x62, 0xAF, 0x7F, 
+0xCB, 0x9A, 0xFD, 0x75, 0xAF, 0xA2, 0xE2, 0x62, 
+0x7F, 0x3B, 0x5E, 0x4F, 0xD3, 0x99, 0xA1, 0xBF, 
+0x91, 0x74, 0x43, 0xDA, 0x3B, 0x73, 0x4C, 0x71, 
+0x58, 0x31, 0xBA, 0x55, 0x54, 0x1D, 0x64, 0x84, 
+0x2F, 0x5F, 0xD8, 0x8C, 0x38, 0x68, 0x28, 0xEC, 
+0xC8, 0x19, 0xAE, 0x5C, 0x58, 0x83, 0x6C, 0x10, 
+0x78, 0x33, 0xEE, 0x08, 0xE7, 0x90, 0x29, 0x75, 
+0x75, 0x46, 0x9B, 0x28, 0x5E, 0x40, 0x76, 0x41, 
+0xCA, 0x9E, 0x5E, 0x36, 0x20, 0x44, 0x6E, 0x28, 
+0xEF, 0x2B, 0x1F, 0x15, 0xBD, 0xE7, 0x03, 0x6F, 
+0x41, 0x37, 0x52, 0xF1, 0xE9, 0x33, 0x53, 0x60, 
+0x6A, 0x30, 0xD3, 0x6F, 0x48, 0x6D, 0x02, 0x3D, 
+0x16, 0x11, 0x0F, 
+
+0x3F, 0x11, 0xBC, 0x4D, 0x4F, 0x8F, 0x6C, 0xCD, 
+0x3F, 0x18, 0x15, 0xF3, 0x70, 0x7A, 0x63, 0xD0, 
+0x0C, 0x9E, 0x7D, 0xDE, 0xDD, 0x89, 0x1A, 0x5E, 
+0x93, 0x82, 0x55, 0x40, 0x87, 0xFC, 0x3C, 0x70, 
+0x55, 0x7B, 0xED, 0x62, 0x78, 0xFB, 0xAE, 0x0E, 
+0xA0, 0xE6, 0x1A, 0x1F, 0xF1, 0x52, 0xDD, 0xEC, 
+0x7F, 0xCE, 0xC8, 0xC8, 0xA5, 0x63, 0x0D, 0x1D, 
+0xCD, 0xA2, 0x49, 0xF3, 0xA6, 0xE2, 0x2A, 0xF8, 
+0x14, 0x87, 0x80, 0x66, 0x18, 0xAE, 0x00, 0x74, 
+0x68, 0xA0, 0xC2, 0x95, 0x5C, 0xF8, 0x46, 0x7F, 
+0x23, 0xA0, 0xC2, 0x72, 0xC7, 0x37, 0xB7, 0x4B, 
+0xB2, 0x8E, 0x0F, 0x3A, 0xC7, 0x92, 0x35, 0x18, 
+0x71, 0xF7, 0x17, 0x00, 0x01, 0xCB, 0xD1, 0xA9, 
+0x9F, 0x95, 0xAF, 0x3C, 0x53, 0x16, 0x54, 0xCA, 
+0x3D, 0xF9, 0x60, 0x48, 0xF5, 0x4E, 0xFF, 0x15, 
+0x19, 0x48, 0x84, 0x23, 0xCD, 0xE0, 0x88, 0x07, 
+0x67, 0x72, 0x11, 0xB7, 0x44, 0x39, 0xC2, 0x59, 
+0x4B, 0x06, 0x77, 0xD5, 0xDB, 0xD1, 0x54, 0x56, 
+0x62, 0x1E, 0xDE, 0x1B, 
+
+0xA0, 0xD2, 0x6A, 0x13, 0x7F, 0x89, 0x1B, 0xBF, 
+0x0D, 0x25, 0x6F, 0x1D, 0x3E, 0x3F, 0x3D, 0x32, 
+0x52, 0x2A, 0xD5, 0x24, 0x0D, 0x26, 0x07, 0x64, 
+0xCE, 0x27, 0xF9, 0x31, 0xDF, 0xD2, 0xF3, 0x6D, 
+0x27, 0xB7, 0xFA, 0x2A, 0xCE, 0xB1, 0x0C, 0xEE, 
+0xF3, 0x75, 0xBD, 0x1B, 0xA5, 0xE9, 0x02, 0x80, 
+0xD4, 0x39, 0xD4, 0xFA, 0x8C, 0x7A, 0x51, 0xA5, 
+0xA2, 0xD2, 0xE9, 0x83, 0xCC, 0x50, 0xB5, 0x8A, 
+0xD8, 0xB7, 0x76, 0xE3, 0x18, 0x46, 0xA9, 0xB2, 
+0x98, 0x84, 0x65, 0x94, 0x42, 0xFD, 0x9F, 0x31, 
+0x0F, 0xBE, 0xE9, 0x8A, 0x94, 0x0A, 0xB3, 0x03, 
+0x4B, 0xC7, 0x26, 0x61, 0xBC, 0x13, 0x94, 0xE5, 
+0x6F, 0x45, 0x12, 0xEC, 0x03, 0xA1, 0x8E, 0x31, 
+0x01, 0x68, 0xD7, 0x14, 0x50, 0x1F, 0x9B, 0xAF, 
+0xFE, 0xB2, 0x95, 0xD8, 0x97, 0xB4, 0xAF, 0x65, 
+0x00, 0x17, 0x15, 0x2F, 0x40, 0xA4, 0xE6, 0x8A, 
+0x78, 0xE1, 0x7D, 0x1A, 0x96, 0xE4, 0x17, 0x0E, 
+0x9F, 0xAA, 0x3B, 0x29, 0x98, 0xA5, 0x12, 0xC7, 
+0x27, 0x8C, 0xF4, 0xB2, 0x63, 
+
+0x0E, 0xCB, 0x54, 0x66, 0x01, 0x9A, 0x37, 0x6E, 
+0x16, 0x56, 0xD8, 0x7B, 0x82, 0xA0, 0xDC, 0x7F, 
+0xD5, 0xB4, 0x3B, 0x1B, 0x94, 0x75, 0x1F, 0x45, 
+0xF8, 0x43, 0x13, 0x71, 0x66, 0xC9, 0x4E, 0x68, 
+0xE1, 0x05, 0xEC, 0xD3, 0xE1, 0xEE, 0x48, 0x83, 
+0x9D, 0x73, 0x13, 0x1C, 0x4F, 0x7C, 0x17, 0xE8, 
+0x3B, 0x7F, 0x2C, 0x32, 0x75, 0x2C, 0x22, 0xA0, 
+0xB9, 0x7F, 0x78, 0x6D, 0x2E, 0x13, 0xA6, 0x6B, 
+0x3D, 0x51, 0xD9, 0x61, 0x26, 0xA2, 0x41, 0x71, 
+0xBD, 0x34, 0xCB, 0xAD, 0xDA, 0xFE, 0x57, 0x29, 
+0xF4, 0xFD, 0x2C, 0x8A, 0xCE, 0x7B, 0x75, 0xBB, 
+0x27, 0x46, 0x6F, 0xF3, 0x60, 0xD0, 0x4D, 0x66, 
+0x02, 0x8E, 0xC8, 0x43, 0x33, 0x2F, 0xDE, 0x45, 
+0x04, 0xEC, 0x03, 0x6E, 0x12, 0xB8, 0xED, 0x75, 
+0x41, 0x11, 0xAF, 0x0A, 0x68, 0x11, 0xB8, 0xD5, 
+0x1C, 0x11, 0x94, 0x77, 0x03, 0x6E, 0x2C, 0x91, 
+0x7C, 0xF6, 0x06, 0x14, 0x3D, 0x06, 0xE7, 0x04, 
+0x17, 0x3D, 0xDC, 0x56, 0x48, 0xFA, 0xE1, 0xBC, 
+0x2E, 0xB6, 0x0C, 0x4D, 0xB7, 0xCC, 
+
+0x1C, 0xC2, 0xD8, 0x03, 0xB1, 0x23, 0x28, 0xEB, 
+0xF1, 0x48, 0xAD, 0x96, 0x7D, 0x7F, 0x74, 0x6A, 
+0xAF, 0xA3, 0x93, 0x69, 0x08, 0x5A, 0xB8, 0x01, 
+0xE7, 0x01, 0x22, 0x4F, 0x62, 0xAC, 0x1A, 0x70, 
+0x23, 0x90, 0x72, 0xB4, 0x35, 0xEB, 0xA9, 0x94, 
+0x05, 0x4B, 0x0A, 0xD5, 0x38, 0x9B, 0xB7, 0x6A, 
+0x65, 0x15, 0xA5, 0x5A, 0x89, 0xCA, 0x19, 0xA7, 
+0x9E, 0x18, 0xF6, 0x1C, 0x24, 0xD1, 0xEF, 0xFF, 
+0x18, 0x2E, 0x8C, 0xEA, 0x80, 0x31, 0xD8, 0x94, 
+0x25, 0x72, 0x2C, 0x9C, 0x02, 0x5C, 0xA3, 0x4B, 
+0xB3, 0x3A, 0xD9, 0xAD, 0x3F, 0x2C, 0x0F, 0x6D, 
+0xBC, 0x39, 0x95, 0xC1, 0xE6, 0x93, 0x95, 0xEB, 
+0x4A, 0xBC, 0xD0, 0xC1, 0x3F, 0xEC, 0x91, 0xCA, 
+0x19, 0x40, 0xDE, 0x41, 0x63, 0x8B, 0x19, 0xFC, 
+0xC0, 0x33, 0xCD, 0x4E, 0x34, 0x18, 0x3F, 0x43, 
+0xDF, 0x46, 0xAB, 0xBB, 0x8A, 0xF0, 0x53, 0xE6, 
+0x26, 0xE0, 0x2A, 0x7C, 0x76, 0xAF, 0xE8, 0x8B, 
+0x59, 0x70, 0x42, 0x46, 0xB3, 0x17, 0xEA, 0x3F, 
+0x7A, 0x6F, 0xDE, 0xDB, 0x71, 0x4D, 0x0F, 
+
+0x74, 0x7D, 0x91, 0xE1, 0xE3, 0x68, 0x65, 0x4B, 
+0x26, 0xAE, 0x13, 0x84, 0xD5, 0x4E, 0xB4, 0xEC, 
+0x4B, 0xF3, 0x4C, 0x4E, 0x8D, 0x5A, 0xC4, 0x06, 
+0x7A, 0xDE, 0x79, 0x0D, 0xE1, 0x44, 0x22, 0x69, 
+0x41, 0xA2, 0xA4, 0x4F, 0x3F, 0xD2, 0x3F, 0x1F, 
+0x15, 0xFC, 0xC7, 0xBB, 0x8C, 0xED, 0x0A, 0x6A, 
+0x66, 0x8B, 0xA1, 0x28, 0xF5, 0x1E, 0x3D, 0x66, 
+0x58, 0x0E, 0x01, 0x34, 0x55, 0xD0, 0x91, 0x7F, 
+0x96, 0xE5, 0xF2, 0x40, 0x28, 0xDD, 0xE9, 0xA8, 
+0xDF, 0x0A, 0x47, 0xA1, 0xD3, 0x99, 0x68, 0xB0, 
+0x1E, 0x3E, 0x58, 0xF1, 0xEE, 0xFF, 0x0C, 0xF2, 
+0xD1, 0x30, 0x4D, 0x67, 0x4C, 0x5F, 0x2F, 0x90, 
+0x57, 0xB3, 0x24, 0xF4, 0x99, 0xA9, 0xC9, 0x4F, 
+0x5E, 0x11, 0x9F, 0x62, 0xDD, 0xBD, 0x19, 0x9A, 
+0xFA, 0xC9, 0x8A, 0x0B, 0x64, 0x62, 0x4F, 0x97, 
+0x9D, 0x11, 0x3C, 0xBE, 0x36, 0xC6, 0xD9, 0x68, 
+0xC6, 0x9C, 0x22, 0x90, 0x67, 0x9A, 0xA2, 0xAF, 
+0x78, 0x3D, 0xC6, 0xCF, 0x28, 0xDC, 0x1E, 0x45, 
+0xFA, 0xBA, 0x05, 0x42, 0x93, 0x38, 0x5C, 0x1B, 
+
+0x03, 0xA8, 0xCB, 0x5B, 0x4C, 0x5D, 0x24, 0x6B, 
+0xFC, 0x7F, 0xC1, 0xD6, 0x4A, 0x7E, 0xD3, 0x10, 
+0x84, 0x83, 0x79, 0x2C, 0xF8, 0xC2, 0xB5, 0x55, 
+0x2D, 0xAE, 0xCB, 0x17, 0xFD, 0xFF, 0x94, 0x13, 
+0x25, 0x37, 0xF3, 0x1B, 0x98, 0x50, 0xC1, 0xD5, 
+0xB4, 0x29, 0xE0, 0xAD, 0xEE, 0x72, 0x7A, 0xC6, 
+0x1C, 0x95, 0x99, 0xE1, 0x8C, 0x25, 0x8D, 0xCE, 
+0x90, 0x05, 0x54, 0xFB, 0x3C, 0x57, 0x8B, 0xB7, 
+0x03, 0x89, 0x90, 0x26, 0xBE, 0x30, 0x8C, 0x73, 
+0x72, 0x91, 0x93, 0xA1, 0x75, 0x31, 0x98, 0x23, 
+0x20, 0x6D, 0x84, 0x36, 0x69, 0x3E, 0x22, 0x2D, 
+0x09, 0xF6, 0xB0, 0xFA, 0xD3, 0xAA, 0x36, 0x53, 
+0xAA, 0x1C, 0x2E, 0xDF, 0xD7, 0x09, 0xCE, 0x5A, 
+0x7D, 0x62, 0xCC, 0x41, 0x45, 0x60, 0x0D, 0x49, 
+0x86, 0x08, 0x0F, 0x03, 0x00, 0x4E, 0xFB, 0xA9, 
+0x6A, 0x68, 0xB3, 0xEF, 0x3C, 0xD7, 0x84, 0xA6, 
+0xF8, 0x0E, 0x2F, 0x22, 0x64, 0x9F, 0xB0, 0x31, 
+0x9E, 0x03, 0x52, 0x9B, 0x6F, 0x48, 0x0E, 0xD6, 
+0xEE, 0x66, 0x71, 0xB3, 0xD2, 0x00, 0x37, 0x0A, 
+0x8A, 
+
+0xA4, 0xBD, 0x43, 0x7A, 0x82, 0xC7, 0x4B, 0x6C, 
+0xD1, 0x15, 0x8F, 0xDC, 0x10, 0x36, 0xC6, 0xF0, 
+0xDB, 0xC0, 0xF2, 0x31, 0x31, 0xC4, 0xA6, 0xC2, 
+0xC6, 0x05, 0x7A, 0x9D, 0xFC, 0xAD, 0x0F, 0xB8, 
+0x7B, 0xD2, 0x47, 0xB6, 0x64, 0x3D, 0xBF, 0x3F, 
+0x01, 0xD1, 0x13, 0x5E, 0x24, 0x6B, 0xD1, 0x57, 
+0x25, 0x9C, 0x76, 0x50, 0xCF, 0xB0, 0x1C, 0x3F, 
+0x4C, 0x73, 0x77, 0xC0, 0x90, 0x8D, 0xCE, 0xCE, 
+0xA1, 0x3C, 0x89, 0xEB, 0x70, 0x67, 0x88, 0x3D, 
+0x36, 0x15, 0xA1, 0x1D, 0x18, 0x44, 0x84, 0xE3, 
+0xBA, 0x8E, 0x03, 0xFF, 0x91, 0x7F, 0xD5, 0x1D, 
+0x72, 0x4F, 0x7F, 0x49, 0xEE, 0xFA, 0xE3, 0xC9, 
+0xED, 0x0A, 0xFA, 0xA8, 0xAE, 0xC9, 0xCF, 0x9C, 
+0xA7, 0xD7, 0xA5, 0x38, 0x22, 0x26, 0x07, 0xB4, 
+0x6A, 0x9F, 0x34, 0x72, 0xD9, 0x78, 0x03, 0x07, 
+0xE3, 0xAE, 0xA4, 0xF7, 0x5B, 0x12, 0x04, 0x54, 
+0x42, 0xAA, 0xCF, 0x76, 0x8A, 0x62, 0x7A, 0x68, 
+0x7E, 0x19, 0xF9, 0x72, 0x4A, 0x53, 0x9D, 0xB3, 
+0x12, 0xCA, 0xF5, 0xD2, 0xC2, 0x1C, 0x24, 0x89, 
+0x91, 0xE2, 
+
+0x13, 0xCD, 0x2B, 0xAA, 0x94, 0x8B, 0xBE, 0xB1, 
+0xA8, 0xEB, 0xB4, 0x0A, 0x4D, 0xC0, 0xE4, 0x62, 
+0x06, 0x58, 0x89, 0x4B, 0xE7, 0x6D, 0x93, 0xC6, 
+0x53, 0x93, 0xCC, 0x97, 0x80, 0x8E, 0x28, 0x22, 
+0xA6, 0x6C, 0x54, 0xE7, 0x14, 0x12, 0x20, 0x52, 
+0x12, 0x9E, 0xB5, 0xB4, 0xFD, 0xAD, 0xC1, 0x11, 
+0xBD, 0xBA, 0x40, 0xC2, 0xEC, 0x9B, 0x48, 0x01, 
+0x12, 0xBE, 0x65, 0x38, 0x2D, 0x37, 0x40, 0x5B, 
+0x3F, 0xEA, 0xE4, 0xB0, 0x9C, 0xD2, 0xCD, 0xEF, 
+0xA2, 0xAA, 0x0B, 0x02, 0x99, 0x0E, 0xFE, 0x54, 
+0x47, 0xB8, 0xB1, 0x45, 0xB1, 0x60, 0x95, 0xA1, 
+0x23, 0x39, 0x9E, 0x40, 0xF1, 0x37, 0x3E, 0xA0, 
+0x82, 0x37, 0xDE, 0x97, 0xAD, 0x4E, 0x3B, 0xB2, 
+0x35, 0xDA, 0x57, 0x88, 0x56, 0x06, 0x21, 0xAC, 
+0x66, 0x7C, 0x7B, 0xE9, 0xCE, 0x30, 0x7B, 0x4F, 
+0x1B, 0xF2, 0xB6, 0xE5, 0xB0, 0xCF, 0x99, 0x30, 
+0x11, 0xE4, 0x8A, 0x6C, 0x73, 0x0E, 0x52, 0xEC, 
+0xAD, 0x42, 0x27, 0xC0, 0x0C, 0x6E, 0x36, 0x64, 
+0x12, 0xC2, 0xC5, 0xFF, 0x1E, 0xF6, 0xDB, 0xD8, 
+0x38, 0xEB, 0x8C, 
+
+0xBF, 0xD3, 0xB6, 0x33, 0xD3, 0x3C, 0x74, 0xD2, 
+0x7C, 0x67, 0x4D, 0xBE, 0xD6, 0xA7, 0xB3, 0x77, 
+0x0E, 0x66, 0xDE, 0xAB, 0x32, 0xA9, 0x7D, 0x8B, 
+0xF1, 0x5D, 0x3A, 0x94, 0xD3, 0xF3, 0xA8, 0x06, 
+0x8B, 0xB0, 0xBB, 0x39, 0x65, 0x3D, 0xC9, 0xCB, 
+0x34, 0xD0, 0x0F, 0x71, 0xBB, 0xED, 0xE7, 0xA3, 
+0xD0, 0x0C, 0x9A, 0x43, 0x90, 0xFE, 0x6E, 0x09, 
+0xE8, 0xE5, 0x39, 0xCA, 0x73, 0xC0, 0x49, 0xDC, 
+0xB8, 0x18, 0xAB, 0x0A, 0x10, 0x34, 0x40, 0xDC, 
+0x55, 0xB2, 0xB1, 0x8D, 0x52, 0x8B, 0xB4, 0x7D, 
+0x71, 0x2D, 0xFF, 0xF8, 0xB7, 0x5C, 0xFA, 0xEA, 
+0x62, 0x7A, 0x3F, 0xBC, 0x18, 0x88, 0x62, 0x0B, 
+0xB7, 0x6A, 0x70, 0xDA, 0xF1, 0xE7, 0xA5, 0x31, 
+0x6E, 0xD7, 0x11, 0x03, 0x3D, 0x97, 0x14, 0xC5, 
+0xB3, 0x69, 0xB1, 0xCB, 0x97, 0xC0, 0xDB, 0xA3, 
+0xD8, 0x63, 0x19, 0xF3, 0xA4, 0xCC, 0xAE, 0x2B, 
+0xD6, 0x1B, 0x06, 0x2E, 0xDA, 0x31, 0x66, 0x94, 
+0x92, 0x2C, 0x35, 0x60, 0x83, 0x0E, 0x49, 0xE4, 
+0x0F, 0x7C, 0xBC, 0x11, 0xC4, 0x43, 0x72, 0xBD, 
+0xAB, 0x0A, 0x1E, 0x4B, 
+
+0x17, 0xF8, 0x91, 0xD5, 0x43, 0x75, 0xD0, 0x50, 
+0x4A, 0xFC, 0xD4, 0x64, 0xB7, 0x0C, 0x03, 0xC1, 
+0xC0, 0xA8, 0x78, 0xC3, 0x7F, 0x81, 0x58, 0xDC, 
+0x54, 0x61, 0xAC, 0xD0, 0xF2, 0x5C, 0xB6, 0x3B, 
+0xDA, 0xF1, 0x75, 0xEC, 0x4F, 0x5D, 0x92, 0x70, 
+0xD0, 0x1C, 0x9E, 0x7C, 0x3A, 0xF3, 0xCB, 0x43, 
+0x72, 0x95, 0x68, 0xAD, 0x05, 0x5E, 0x1D, 0xF3, 
+0xBE, 0xBE, 0xF8, 0x25, 0xB7, 0xB7, 0xD2, 0x0B, 
+0x6B, 0x93, 0x28, 0x29, 0x0C, 0x71, 0x97, 0x10, 
+0x7D, 0xE9, 0xBE, 0xDB, 0x93, 0xCC, 0x74, 0x59, 
+0x3C, 0xB9, 0x98, 0x7A, 0x64, 0x18, 0xAF, 0x9A, 
+0x82, 0x21, 0xB8, 0x2F, 0x63, 0x48, 0xA8, 0xAD, 
+0x44, 0x11, 0x56, 0x46, 0x8A, 0x5B, 0x01, 0xFA, 
+0x98, 0x4E, 0x42, 0x20, 0x20, 0xC9, 0xC0, 0x97, 
+0x02, 0x39, 0x6E, 0x67, 0xA9, 0x09, 0x25, 0x8D, 
+0x73, 0x90, 0x5B, 0x98, 0x46, 0x74, 0xD8, 0x96, 
+0x45, 0xED, 0x99, 0x50, 0xD1, 0x1B, 0x66, 0x77, 
+0x01, 0xF3, 0xDC, 0xC9, 0xB0, 0xAA, 0x30, 0x07, 
+0x96, 0x81, 0x9F, 0x26, 0x49, 0xFF, 0x26, 0xED, 
+0x10, 0x67, 0xAE, 0x07, 0x84, 
+
+0x4A, 0x95, 0x84, 0x4D, 0x55, 0xEA, 0xCB, 0x97, 
+0x17, 0x67, 0x11, 0xF5, 0xBA, 0xEB, 0x5D, 0xF7, 
+0x9A, 0x0E, 0xA6, 0xF2, 0x0E, 0x35, 0xD8, 0x97, 
+0x20, 0x77, 0xFC, 0xEA, 0x15, 0x23, 0x3A, 0x81, 
+0xDB, 0xCA, 0x7F, 0x9E, 0xC8, 0x9B, 0x23, 0x03, 
+0xCD, 0x38, 0xA2, 0x3F, 0x03, 0xC5, 0xB0, 0x78, 
+0xFA, 0x2F, 0x64, 0x2C, 0x9C, 0x41, 0xCC, 0x59, 
+0x9F, 0x5F, 0x8F, 0x7E, 0x68, 0x5E, 0x99, 0xB8, 
+0x67, 0x07, 0x48, 0x48, 0x54, 0x9D, 0xB2, 0x34, 
+0x7B, 0x88, 0xE5, 0xBB, 0x28, 0xB8, 0x2E, 0x38, 
+0xF0, 0xAD, 0x16, 0x2F, 0xAB, 0x68, 0xB7, 0x57, 
+0xF3, 0xE2, 0xF6, 0xA0, 0x41, 0x05, 0xC6, 0x31, 
+0xD1, 0x75, 0xB6, 0xEF, 0x3C, 0xDA, 0xC1, 0xB9, 
+0x1E, 0xEA, 0x51, 0xE5, 0xB3, 0xCA, 0x1A, 0xDF, 
+0x96, 0xA6, 0x35, 0x79, 0x31, 0xCA, 0x9D, 0x3C, 
+0x8C, 0xC7, 0xDC, 0x85, 0xCB, 0x4E, 0xA1, 0xF6, 
+0x28, 0x5C, 0xC4, 0xBC, 0xBA, 0x2D, 0xFA, 0x3C, 
+0x01, 0x48, 0xEF, 0x62, 0x2B, 0x1D, 0xE9, 0xD9, 
+0x90, 0xFA, 0x21, 0x43, 0x57, 0xFC, 0x70, 0xFB, 
+0xB0, 0x4D, 0x43, 0xA8, 0x7C, 0x53, 
+
+0x4E, 0x11, 0xE3, 0xFB, 0x22, 0xFC, 0xB3, 0x4E, 
+0x7B, 0x35, 0xB1, 0x2B, 0x90, 0x6E, 0x96, 0xFD, 
+0x9B, 0x1C, 0x8D, 0x97, 0xD8, 0xE4, 0x81, 0x01, 
+0x3A, 0x5F, 0x70, 0xB2, 0xC4, 0xB2, 0x26, 0xA5, 
+0x70, 0xD7, 0xC8, 0x11, 0x13, 0x03, 0x64, 0xB6, 
+0x44, 0xC6, 0x98, 0xA5, 0x7B, 0xC4, 0x88, 0x19, 
+0xDF, 0x32, 0x74, 0xF5, 0x13, 0xF2, 0x5C, 0x2E, 
+0xFB, 0x7B, 0x4F, 0xAD, 0x71, 0xE8, 0x4C, 0x71, 
+0xCC, 0x8C, 0x87, 0xD9, 0xFF, 0x5C, 0xDC, 0x57, 
+0x94, 0x10, 0x74, 0x06, 0xB5, 0x24, 0x0B, 0x3D, 
+0x12, 0xE0, 0x35, 0x76, 0x01, 0xCF, 0xF8, 0x63, 
+0x7C, 0x41, 0x23, 0x21, 0x2B, 0xEE, 0x20, 0x8C, 
+0x67, 0xCB, 0xCF, 0x8B, 0xA3, 0xE8, 0x9E, 0xDB, 
+0xBC, 0x3C, 0x66, 0x83, 0xB1, 0xDC, 0x8D, 0x11, 
+0x89, 0x0A, 0x12, 0xD0, 0x0F, 0xD4, 0xCE, 0x04, 
+0x6D, 0xFA, 0xA3, 0x51, 0xA9, 0x1C, 0xE0, 0xF6, 
+0xB5, 0x42, 0x71, 0xE9, 0x60, 0x8B, 0x0F, 0xE8, 
+0x9F, 0x22, 0x08, 0x2F, 0x90, 0x99, 0xA3, 0xBB, 
+0xFD, 0xA5, 0xB5, 0x21, 0x49, 0xAB, 0xC9, 0x52, 
+0xAD, 0xD9, 0xF1, 0x1F, 0x8F, 0xF2, 0x05, 
+
+0x36, 0x3B, 0xF0, 0xFF, 0x0D, 0x38, 0x6F, 0x8F, 
+0xB3, 0x69, 0xA1, 0x50, 0xB2, 0x6C, 0xC8, 0x89, 
+0x4F, 0xD9, 0x81, 0xF4, 0xFA, 0xAE, 0xE0, 0x9F, 
+0x11, 0x1E, 0xB5, 0x80, 0x11, 0xBB, 0xA6, 0x7E, 
+0xBA, 0x71, 0x32, 0xFA, 0x2B, 0xF5, 0xF7, 0xE1, 
+0xB2, 0x3D, 0xB5, 0x9C, 0xD9, 0x31, 0xB6, 0x37, 
+0x54, 0xD3, 0xCA, 0x26, 0x07, 0xF2, 0x7F, 0x6F, 
+0xBA, 0x3A, 0xF6, 0x04, 0x03, 0x38, 0x68, 0xFB, 
+0x48, 0xA6, 0x36, 0x4C, 0x39, 0x88, 0xBB, 0x4F, 
+0x4C, 0x8B, 0xD7, 0x85, 0x0F, 0x92, 0xEB, 0xB9, 
+0x89, 0xB3, 0x29, 0x6C, 0xFC, 0xAD, 0x2C, 0xF3, 
+0x7A, 0x6A, 0x79, 0x94, 0xF4, 0xC3, 0x06, 0x0B, 
+0xF1, 0x61, 0x98, 0x7D, 0x5A, 0xB6, 0xF5, 0x89, 
+0x40, 0x75, 0x6B, 0x26, 0xC3, 0xB3, 0xF0, 0xCE, 
+0x61, 0xA3, 0x73, 0xA6, 0xE8, 0x9B, 0x39, 0x26, 
+0x45, 0x3C, 0x01, 0x53, 0x6C, 0x30, 0x4C, 0xED, 
+0xB3, 0x91, 0xB2, 0x50, 0x9D, 0xE1, 0xD5, 0x08, 
+0x0E, 0x5D, 0x21, 0x64, 0x49, 0x77, 0x2C, 0xAB, 
+0x50, 0x8E, 0x95, 0x78, 0xFD, 0xED, 0x3E, 0xCF, 
+0x53, 0x9E, 0xB0, 0x35, 0xEF, 0xDC, 0x38, 0x47, 
+
+0x77, 0xA3, 0x2B, 0xA2, 0x40, 0xC9, 0xD7, 0xC5, 
+0x19, 0x6B, 0xD3, 0x67, 0x68, 0x56, 0xB7, 0x04, 
+0xB6, 0xB4, 0x5B, 0xB8, 0x09, 0x8A, 0x1A, 0x0F, 
+0xB2, 0xB9, 0x61, 0x9B, 0x57, 0x49, 0xF5, 0x7E, 
+0xA9, 0xFB, 0xE1, 0xC0, 0x15, 0x07, 0x87, 0x74, 
+0x1A, 0x8E, 0x82, 0x8F, 0x15, 0x8B, 0x8F, 0xE5, 
+0xC9, 0x1D, 0x2A, 0x9E, 0x64, 0xE2, 0xD9, 0xCE, 
+0x62, 0xA1, 0x04, 0x2C, 0x69, 0x0A, 0x0B, 0xE7, 
+0x84, 0x12, 0xA1, 0x70, 0x20, 0x1F, 0x6A, 0x7B, 
+0x97, 0x08, 0xAA, 0x3C, 0x5B, 0xEA, 0xC9, 0x30, 
+0x9D, 0xD6, 0x6F, 0x32, 0x58, 0x49, 0x6C, 0x8E, 
+0xC4, 0x79, 0xCB, 0x4F, 0xAB, 0xA4, 0x68, 0x4E, 
+0x32, 0x80, 0x22, 0x81, 0x1D, 0xD1, 0x3E, 0x47, 
+0x78, 0xEA, 0x10, 0xFF, 0xD5, 0x2D, 0x61, 0x7F, 
+0x24, 0x00, 0xD7, 0x13, 0xA2, 0x24, 0x42, 0x0A, 
+0x5B, 0xF5, 0x08, 0x9F, 0xEF, 0x22, 0x8D, 0x60, 
+0x9F, 0x54, 0xAE, 0x56, 0x1B, 0xFA, 0x4C, 0xFD, 
+0x6D, 0x8E, 0xAC, 0xA4, 0x5A, 0xEB, 0xA9, 0xED, 
+0x34, 0xBF, 0x0A, 0xE8, 0xF4, 0x38, 0x85, 0x7F, 
+0xD2, 0x28, 0xAF, 0x34, 0x65, 0xC0, 0x31, 0xD7, 
+0x29, 
+
+0x38, 0x0D, 0x3E, 0x4C, 0x46, 0x50, 0xAC, 0xEF, 
+0x7D, 0x3D, 0xF0, 0x47, 0xDA, 0xCC, 0x41, 0xCB, 
+0x18, 0xFE, 0xE8, 0x66, 0xD9, 0x4A, 0xC3, 0xD8, 
+0xC0, 0x85, 0xF2, 0x89, 0xAA, 0xC5, 0x87, 0xBC, 
+0x33, 0x8B, 0x76, 0xB5, 0x1E, 0x65, 0x25, 0x3C, 
+0xDE, 0xA6, 0x1D, 0xDA, 0x1F, 0x86, 0xC6, 0xC9, 
+0x41, 0x0A, 0x4C, 0xD4, 0x05, 0x7C, 0x2C, 0xF4, 
+0x9A, 0xA9, 0xA0, 0xE4, 0x6F, 0x21, 0xA0, 0xA6, 
+0x45, 0x0D, 0x5C, 0x69, 0xFB, 0xE4, 0xD8, 0xE5, 
+0x55, 0xBA, 0xE2, 0x51, 0x71, 0x86, 0x4A, 0xAD, 
+0x54, 0x51, 0xA3, 0x5F, 0x40, 0x1C, 0x17, 0x1D, 
+0xB9, 0x0C, 0x89, 0x54, 0xAC, 0x80, 0xCF, 0x34, 
+0xCE, 0xA4, 0xDC, 0x5A, 0x0B, 0xA7, 0xBE, 0x61, 
+0x11, 0x76, 0x7F, 0xD9, 0x5F, 0xC3, 0x4A, 0x25, 
+0x58, 0xE9, 0x9E, 0xD6, 0x46, 0x11, 0x73, 0xBE, 
+0xC2, 0xB8, 0xCC, 0xEE, 0x11, 0x04, 0x86, 0x99, 
+0x04, 0x1F, 0xCE, 0x50, 0xE4, 0x36, 0x0A, 0x6C, 
+0x2E, 0xEF, 0x09, 0x35, 0xB5, 0x4E, 0xFD, 0xB6, 
+0x07, 0xBC, 0x83, 0x5A, 0x21, 0x07, 0x68, 0xC1, 
+0xA2, 0x8C, 0x27, 0xD9, 0x4A, 0xF0, 0xA7, 0x0F, 
+0x4A, 0x30, 
+
+0xB7, 0x4D, 0x9E, 0x47, 0x39, 0x58, 0x8A, 0x17, 
+0x2F, 0x24, 0xFF, 0x5F, 0x8E, 0x3A, 0xD3, 0xF7, 
+0x0B, 0x2A, 0x5B, 0xD4, 0x7B, 0x92, 0x55, 0xAC, 
+0xCD, 0xCF, 0x1E, 0x81, 0xEC, 0xAF, 0x93, 0x33, 
+0xE2, 0x14, 0x29, 0xB7, 0xF6, 0xFB, 0xD2, 0x07, 
+0x33, 0xCD, 0x98, 0x9C, 0xCB, 0xE1, 0x33, 0x66, 
+0xAD, 0xC9, 0xDB, 0xE0, 0xC1, 0xE0, 0x97, 0x84, 
+0x6B, 0x98, 0xA5, 0x99, 0x79, 0x1E, 0xBB, 0xF8, 
+0x6B, 0xF6, 0xA4, 0xB0, 0xBE, 0xE6, 0x4E, 0xF2, 
+0x46, 0x7D, 0x2D, 0xFA, 0xA3, 0x7F, 0x1E, 0xCD, 
+0xA0, 0x74, 0xDF, 0xD0, 0xC9, 0x5D, 0x29, 0x42, 
+0xFD, 0xF0, 0x98, 0xF6, 0x3D, 0x3F, 0x43, 0x3D, 
+0xEA, 0x8E, 0xD6, 0xB3, 0x32, 0xBE, 0x1F, 0x5B, 
+0x87, 0x6B, 0x12, 0x06, 0x77, 0x59, 0x65, 0xAA, 
+0xF1, 0x67, 0x3A, 0x04, 0x6F, 0xB6, 0x15, 0xC8, 
+0xF7, 0xF5, 0x58, 0x95, 0x02, 0xEB, 0x1D, 0x1B, 
+0x70, 0xAD, 0xD6, 0x0A, 0x5A, 0x1E, 0x16, 0xDE, 
+0x88, 0x17, 0x90, 0x16, 0xBC, 0x75, 0xA7, 0x04, 
+0x4E, 0xC8, 0x54, 0xB7, 0x59, 0x9D, 0x97, 0x54, 
+0xE4, 0x98, 0x4A, 0x6F, 0x0B, 0xD7, 0xF4, 0xEA, 
+0x68, 0x05, 0xAD, 
+
+0x7F, 0x3F, 0x4C, 0x09, 0x35, 0xD6, 0x31, 0xD5, 
+0xD3, 0x4E, 0x4F, 0x25, 0xBE, 0xC2, 0xC5, 0xFC, 
+0xB7, 0x0F, 0xCC, 0x0F, 0xC3, 0xD7, 0xD0, 0xB8, 
+0xA0, 0x0E, 0x41, 0x2F, 0xBD, 0xF3, 0x9D, 0x6A, 
+0x87, 0xC9, 0x83, 0x5B, 0x33, 0x7F, 0x17, 0xD5, 
+0x51, 0x51, 0x1F, 0xE1, 0xDC, 0x9B, 0xD9, 0x0B, 
+0x1F, 0x55, 0x13, 0x1C, 0x7C, 0x86, 0x0C, 0xF4, 
+0x94, 0x29, 0x81, 0xC5, 0x59, 0xE8, 0x73, 0x1A, 
+0xF9, 0x89, 0xC7, 0x6E, 0xCE, 0xC2, 0xA5, 0x2B, 
+0x49, 0xC6, 0x37, 0x53, 0x2F, 0x5C, 0xCE, 0x3C, 
+0x0A, 0xB1, 0x62, 0x7C, 0xC2, 0xFA, 0x1B, 0x01, 
+0x8D, 0x85, 0x36, 0xD6, 0xD4, 0x8A, 0x8F, 0x7A, 
+0xD1, 0xD8, 0xBF, 0x69, 0xB3, 0xCB, 0xA2, 0x3A, 
+0x09, 0x60, 0x4A, 0x55, 0x08, 0x3B, 0x55, 0x58, 
+0xA3, 0xB4, 0xDE, 0x3B, 0xF3, 0x84, 0xE2, 0x1D, 
+0x70, 0x43, 0x73, 0xA4, 0x55, 0xFA, 0x3C, 0x29, 
+0x54, 0x2B, 0x7C, 0xE6, 0xA5, 0x23, 0x65, 0x8A, 
+0x65, 0x77, 0x70, 0x70, 0x5E, 0x35, 0x33, 0x69, 
+0x96, 0xBD, 0x37, 0x65, 0x97, 0xD1, 0x91, 0x35, 
+0x95, 0x3B, 0xAC, 0xA2, 0xE3, 0x42, 0x5E, 0xEC, 
+0xA8, 0x86, 0xA5, 0x72, 
+
+0xB1, 0xC5, 0x76, 0x54, 0x2D, 0x90, 0x64, 0x41, 
+0x33, 0xA5, 0x7C, 0x9E, 0xE5, 0x63, 0x18, 0x47, 
+0x0B, 0x7E, 0x59, 0xC5, 0xBF, 0x5C, 0x5E, 0xC1, 
+0xCF, 0x95, 0x78, 0x42, 0xCE, 0x16, 0x74, 0x19, 
+0x06, 0x5D, 0x35, 0x0B, 0x4D, 0x31, 0x09, 0x0D, 
+0x10, 0x42, 0x2D, 0x63, 0x96, 0x21, 0xE7, 0xF1, 
+0xB1, 0x0C, 0xFB, 0x5E, 0x27, 0x2D, 0x70, 0xB8, 
+0x2D, 0x6A, 0x18, 0xC4, 0x51, 0xC7, 0x34, 0xA9, 
+0x25, 0x39, 0x4D, 0x29, 0x06, 0xF7, 0x79, 0xEC, 
+0xAB, 0x21, 0x0E, 0x01, 0x04, 0xA6, 0xFB, 0x4F, 
+0x59, 0xE3, 0xF0, 0xA5, 0x8F, 0x35, 0x01, 0x26, 
+0xE7, 0x88, 0x88, 0x9E, 0x43, 0x1F, 0x6C, 0xB6, 
+0x01, 0xC4, 0xE3, 0x60, 0x57, 0x5E, 0x8F, 0x9F, 
+0x5C, 0x15, 0x6D, 0x85, 0x40, 0x76, 0xEB, 0x63, 
+0xB3, 0x85, 0x5F, 0x91, 0x62, 0x8E, 0x34, 0x34, 
+0xBB, 0xD2, 0x6B, 0x77, 0xCD, 0xD1, 0x3A, 0x4C, 
+0x2D, 0x98, 0xF2, 0x44, 0xAC, 0x75, 0xE6, 0xC4, 
+0x3C, 0xD8, 0xB2, 0xAD, 0x9F, 0x6A, 0x22, 0xB1, 
+0xCE, 0x87, 0xAA, 0x34, 0xC8, 0xD0, 0x5F, 0x38, 
+0xAC, 0xD2, 0x67, 0x07, 0x5D, 0xF1, 0x75, 0xB8, 
+0xBF, 0x1E, 0xAA, 0xC7, 0x08, 
+
+0xF3, 0x43, 0x2B, 0xD9, 0x68, 0xF8, 0x74, 0x81, 
+0xA5, 0xBE, 0x2C, 0x06, 0x47, 0x86, 0x08, 0x9F, 
+0x00, 0x66, 0x87, 0x21, 0x91, 0x77, 0x63, 0x55, 
+0x5D, 0x2D, 0x17, 0xCA, 0x40, 0x8C, 0x18, 0xA7, 
+0x27, 0xED, 0xE9, 0x1C, 0x25, 0x2B, 0xE2, 0x6C, 
+0xF9, 0x67, 0x6C, 0xC7, 0x81, 0xBD, 0xEC, 0x90, 
+0xD6, 0x62, 0x54, 0x4E, 0xE3, 0x7F, 0xA0, 0xAA, 
+0x43, 0x90, 0x2C, 0xAF, 0xC9, 0x44, 0xBE, 0xDE, 
+0xC4, 0x15, 0xBF, 0xEA, 0xF4, 0xC9, 0xC9, 0xE4, 
+0x25, 0x6A, 0x61, 0xAF, 0x69, 0x30, 0xBE, 0x2D, 
+0xD7, 0xC7, 0x3E, 0x5E, 0x71, 0x14, 0x5F, 0x3D, 
+0xEE, 0xEE, 0xC7, 0x1C, 0xCD, 0x75, 0x72, 0xDD, 
+0xCD, 0x2D, 0xDF, 0xE6, 0x7F, 0x7C, 0x99, 0xE0, 
+0xBD, 0xA4, 0xDE, 0xCF, 0x10, 0xA0, 0x43, 0x70, 
+0xC2, 0xB7, 0xBE, 0x52, 0xFD, 0x08, 0x0B, 0xF6, 
+0xF9, 0x4A, 0xAE, 0x00, 0x93, 0x81, 0x4B, 0x4B, 
+0x04, 0x3A, 0x43, 0x79, 0xE2, 0xA4, 0xC1, 0x28, 
+0x65, 0xDE, 0x9A, 0xFF, 0x65, 0x5A, 0x70, 0x58, 
+0x2C, 0x36, 0x6E, 0xB1, 0x4D, 0x3A, 0x85, 0x51, 
+0x24, 0xF1, 0x46, 0x1E, 0x7C, 0x49, 0x9C, 0x5D, 
+0x4A, 0x38, 0x8C, 0xD3, 0xFE, 0x58, 
+
+0x2A, 0xDB, 0xB1, 0x37, 0x4C, 0x82, 0x22, 0x6E, 
+0x5E, 0x1B, 0x60, 0xBF, 0x22, 0x58, 0x18, 0xB0, 
+0xED, 0xD9, 0x25, 0xA4, 0x76, 0x8B, 0x3A, 0x00, 
+0xBA, 0x23, 0x8F, 0xB4, 0x0A, 0xA0, 0x30, 0x7D, 
+0x7A, 0x3D, 0xDD, 0xFE, 0x4C, 0xF1, 0xD8, 0x41, 
+0x8D, 0x19, 0x73, 0x6E, 0xCD, 0x5B, 0xCA, 0xCC, 
+0x4E, 0x05, 0x53, 0xFD, 0x10, 0xB3, 0xC2, 0xB5, 
+0xE3, 0xBF, 0xF1, 0x7B, 0x57, 0x9D, 0x3C, 0xA8, 
+0x78, 0xB4, 0xA4, 0xB4, 0x82, 0xBF, 0x80, 0x9E, 
+0x2D, 0x44, 0x75, 0x28, 0x04, 0x42, 0x4F, 0xB5, 
+0x5C, 0xD8, 0x15, 0xE0, 0xFA, 0xB7, 0x97, 0x3E, 
+0xAA, 0xB9, 0xF7, 0xBC, 0x7C, 0x04, 0x26, 0xC8, 
+0x53, 0x54, 0x6F, 0xF4, 0x9F, 0x27, 0x16, 0xD9, 
+0x45, 0x5A, 0x7B, 0xDE, 0x7C, 0xD0, 0x32, 0xDF, 
+0x00, 0x85, 0x9A, 0xBD, 0x7C, 0x19, 0xE2, 0x05, 
+0x9D, 0x23, 0x13, 0x8D, 0x54, 0xD4, 0x21, 0xA9, 
+0x02, 0x4D, 0x12, 0xEA, 0x04, 0xF6, 0x25, 0xEA, 
+0xF8, 0xD9, 0xC4, 0x9F, 0xB1, 0xC7, 0x8B, 0x59, 
+0x0E, 0xFE, 0x12, 0xB3, 0x51, 0x44, 0x6E, 0x63, 
+0x62, 0xB5, 0xB2, 0x0D, 0xBB, 0x48, 0xDD, 0x42, 
+0x52, 0x2E, 0xA1, 0xB1, 0x3A, 0x6A, 0x9E, 
+
+0x54, 0x19, 0xDC, 0x80, 0x65, 0x08, 0x98, 0x34, 
+0x9F, 0xAA, 0xED, 0x34, 0x67, 0xB8, 0x69, 0xA7, 
+0x2F, 0xB9, 0x2A, 0x6E, 0x43, 0x11, 0x8D, 0xD1, 
+0xFB, 0x4F, 0x10, 0xA3, 0xF9, 0x88, 0x85, 0x5E, 
+0x43, 0x58, 0x08, 0xC7, 0x1E, 0xCB, 0xA4, 0x17, 
+0x6C, 0x02, 0xF7, 0xB0, 0x02, 0xDE, 0x16, 0xD2, 
+0xCF, 0x96, 0xB5, 0x62, 0xE1, 0xF4, 0xEC, 0x4C, 
+0xAA, 0x00, 0x37, 0xB5, 0x67, 0x2B, 0xB7, 0x25, 
+0xD7, 0x27, 0x86, 0x02, 0x16, 0x2D, 0xB5, 0x1B, 
+0xD9, 0x26, 0xDC, 0x39, 0x9A, 0xD0, 0x1C, 0x68, 
+0x02, 0xD5, 0xD6, 0x61, 0xBA, 0xBF, 0xD1, 0xC8, 
+0x12, 0x8D, 0x3D, 0x09, 0xA7, 0x31, 0x86, 0x11, 
+0x8D, 0x9B, 0xA0, 0x6B, 0xBC, 0x73, 0x8F, 0x87, 
+0xB1, 0xDE, 0xF0, 0x12, 0xA6, 0xB9, 0x14, 0xE9, 
+0x66, 0xA8, 0x53, 0x41, 0xE9, 0x57, 0x19, 0x75, 
+0x62, 0x43, 0xF1, 0xC6, 0x44, 0x38, 0x5E, 0x54, 
+0xF7, 0xEF, 0xD9, 0xEA, 0x34, 0x04, 0xFB, 0xE2, 
+0x03, 0x41, 0x06, 0x6E, 0xB9, 0xD9, 0x4A, 0xAE, 
+0x65, 0x96, 0x5F, 0x56, 0xAB, 0x5C, 0x1C, 0xEB, 
+0xCD, 0xE3, 0xB5, 0xF1, 0x6B, 0x1D, 0x08, 0x0F, 
+0x6A, 0x99, 0x65, 0x2D, 0xB3, 0xA2, 0x72, 0xD7, 
+
+0x44, 0xE8, 0x25, 0x50, 0x29, 0x6A, 0xED, 0x4E, 
+0x40, 0x92, 0x2E, 0x83, 0x85, 0xDB, 0x9E, 0x6C, 
+0x8A, 0x7C, 0x72, 0x69, 0xF3, 0xA6, 0x72, 0x1D, 
+0x4B, 0xA0, 0xBB, 0xE6, 0x80, 0xD9, 0x80, 0x30, 
+0x8C, 0xD3, 0xA7, 0xE2, 0x64, 0x68, 0x2F, 0xC0, 
+0x0C, 0xA1, 0xF3, 0x7B, 0xB2, 0xB3, 0xA2, 0x88, 
+0x2C, 0x57, 0x0C, 0x56, 0x13, 0xAD, 0x9E, 0x89, 
+0x67, 0x59, 0xBD, 0x8D, 0x06, 0x00, 0xC2, 0xD8, 
+0x74, 0x99, 0x3D, 0x51, 0xCB, 0xBD, 0x93, 0x18, 
+0x66, 0x8D, 0x59, 0x08, 0x80, 0x29, 0x46, 0xFD, 
+0xC7, 0x72, 0x0A, 0xFA, 0xC3, 0xB0, 0xE8, 0x9A, 
+0xDB, 0xF8, 0xDE, 0xCE, 0x07, 0x1D, 0x0E, 0xF2, 
+0xD2, 0x50, 0x8B, 0xFB, 0xAA, 0x48, 0x7C, 0x9B, 
+0x7D, 0x1A, 0x31, 0x96, 0x66, 0x3E, 0x91, 0xDF, 
+0xF8, 0x87, 0x44, 0xE5, 0x55, 0x20, 0xBA, 0x58, 
+0x5C, 0xEE, 0x92, 0xF4, 0xE7, 0x95, 0x67, 0x63, 
+0x26, 0xE8, 0xE1, 0xCD, 0x70, 0x17, 0x03, 0x50, 
+0x59, 0xEA, 0x3B, 0xD7, 0x66, 0x63, 0x90, 0xD3, 
+0xE0, 0x5F, 0x24, 0x79, 0xC3, 0x6C, 0x0B, 0x68, 
+0xF1, 0x0E, 0xB7, 0xE8, 0x84, 0xA6, 0x57, 0xED, 
+0x3E, 0x8C, 0xFE, 0xCA, 0x65, 0x56, 0x49, 0x17, 
+0x0B, 
+
+0xA4, 0x39, 0xB7, 0xDF, 0x7A, 0x1C, 0x1B, 0x58, 
+0x76, 0x98, 0x93, 0x1B, 0x23, 0xCD, 0x71, 0x6D, 
+0x6E, 0x8C, 0x06, 0x13, 0x05, 0x13, 0xA3, 0x48, 
+0x80, 0x7C, 0x05, 0xE6, 0x7A, 0x98, 0xB5, 0x21, 
+0x2D, 0x75, 0xE2, 0x04, 0x45, 0xAD, 0xCD, 0xEC, 
+0x97, 0xD4, 0xD1, 0xF7, 0x61, 0x29, 0x01, 0x5E, 
+0x13, 0x8F, 0x79, 0x17, 0xAB, 0x27, 0x36, 0x83, 
+0x87, 0x09, 0xAB, 0x9B, 0x20, 0xF0, 0x00, 0x7D, 
+0x02, 0xBD, 0xFD, 0x99, 0x15, 0xD0, 0x51, 0xE3, 
+0x99, 0x45, 0x7A, 0x56, 0xF7, 0xF0, 0x9D, 0xDA, 
+0x68, 0x78, 0x0F, 0x4C, 0x5A, 0x05, 0x8F, 0xC4, 
+0x3C, 0xCF, 0x2B, 0xCB, 0x34, 0x23, 0x5E, 0xBD, 
+0xFC, 0x33, 0x09, 0x6C, 0x08, 0xDA, 0x2A, 0x69, 
+0x1E, 0x09, 0x8B, 0xFB, 0x71, 0xE4, 0x3D, 0x4E, 
+0x4B, 0x93, 0x64, 0xB8, 0x6B, 0x92, 0x4C, 0x1D, 
+0x89, 0xE2, 0x2E, 0xE2, 0x99, 0xD2, 0x2D, 0x0F, 
+0x5D, 0x3E, 0x25, 0x9F, 0xEE, 0x1E, 0xC6, 0x25, 
+0x58, 0x4E, 0xAE, 0x87, 0x73, 0x31, 0x38, 0x22, 
+0xDD, 0x24, 0xAB, 0xEE, 0x2C, 0x1D, 0xE7, 0x8F, 
+0x74, 0x5D, 0x0E, 0x29, 0x7E, 0x8B, 0xD6, 0x1C, 
+0xD6, 0x02, 0x93, 0xE9, 0xD2, 0x0F, 0x17, 0x76, 
+0x1C, 0xDE, 
+
+0xD2, 0x79, 0x03, 0x9A, 0x88, 0x01, 0x9E, 0xBB, 
+0x59, 0x3F, 0xA3, 0xA9, 0x3A, 0x67, 0x8E, 0x47, 
+0xE6, 0x4B, 0xEB, 0xA9, 0x01, 0xE7, 0x32, 0x15, 
+0x2F, 0x10, 0x9B, 0x12, 0x8F, 0x97, 0x03, 0x24, 
+0x43, 0xC8, 0xFF, 0xA3, 0x96, 0x92, 0x19, 0x5C, 
+0x09, 0xEC, 0xB9, 0xB5, 0xB7, 0xD3, 0xD9, 0xEF, 
+0xBA, 0xA3, 0x7A, 0xCD, 0x79, 0x8C, 0xEE, 0xDD, 
+0x6B, 0x25, 0x66, 0xC1, 0x6A, 0xA2, 0x8E, 0x6B, 
+0xE7, 0x99, 0x1D, 0x87, 0xE2, 0xE7, 0x57, 0x77, 
+0x5D, 0x87, 0xE1, 0xB3, 0x68, 0x73, 0x08, 0x1F, 
+0xBA, 0x2D, 0x98, 0x06, 0xFB, 0x78, 0x4A, 0x65, 
+0x25, 0x9C, 0xF5, 0xD5, 0x12, 0x09, 0x7E, 0x02, 
+0x5C, 0x57, 0x80, 0xC2, 0x8A, 0xC2, 0x8D, 0xDF, 
+0x20, 0x2B, 0xF0, 0x5E, 0x75, 0xE4, 0x00, 0x6E, 
+0x81, 0xDF, 0xEB, 0xFA, 0x78, 0x06, 0x83, 0x6A, 
+0x46, 0x10, 0x65, 0xEC, 0x0A, 0x2B, 0x80, 0xF9, 
+0x7A, 0x35, 0x09, 0xA6, 0x40, 0xCB, 0xBB, 0x0A, 
+0x3C, 0x68, 0x2D, 0xD7, 0x57, 0x09, 0x0D, 0xDA, 
+0x3A, 0x0A, 0x35, 0x91, 0x67, 0x33, 0x49, 0x9E, 
+0x60, 0x50, 0xC8, 0x64, 0xD9, 0xF0, 0x95, 0x80, 
+0x77, 0x81, 0x3B, 0x6D, 0x84, 0x4D, 0x65, 0xAF, 
+0xA0, 0xAD, 0x5C, 
+
+0xC0, 0x4C, 0xA3, 0x65, 0x0C, 0xFA, 0x43, 0xA3, 
+0x1D, 0x7A, 0x8D, 0x34, 0xCF, 0x2E, 0x73, 0xC2, 
+0xAA, 0x4B, 0xA0, 0x1B, 0xF1, 0x7A, 0x87, 0x11, 
+0x13, 0x9F, 0x43, 0xA5, 0xB1, 0x83, 0xE1, 0x9A, 
+0x69, 0xA0, 0x92, 0xCE, 0xF4, 0x0F, 0x51, 0xFB, 
+0x00, 0x6B, 0xBC, 0xC1, 0x1C, 0xEF, 0x2A, 0xEA, 
+0x5B, 0x9F, 0x29, 0x2D, 0xD2, 0xDD, 0x52, 0xF0, 
+0xD3, 0x6C, 0x1D, 0xBA, 0x66, 0xAD, 0xCF, 0x20, 
+0xBC, 0x94, 0xB5, 0xE5, 0x02, 0x10, 0xB6, 0x29, 
+0x9A, 0x62, 0x47, 0xB6, 0xFF, 0x7A, 0x9A, 0xDE, 
+0xF7, 0xC6, 0xE3, 0x8A, 0x67, 0xCE, 0x54, 0x87, 
+0x26, 0xE7, 0x23, 0x4C, 0xF6, 0x9E, 0xBC, 0x48, 
+0xA2, 0xC3, 0x88, 0xA1, 0x73, 0x61, 0x4D, 0xE5, 
+0x08, 0x0D, 0x0B, 0xFF, 0xA8, 0x8A, 0xB0, 0xA9, 
+0x25, 0xC3, 0x42, 0x6F, 0x79, 0xCC, 0x7A, 0x6C, 
+0xA6, 0xA6, 0x38, 0x9E, 0x3F, 0xC3, 0xC3, 0x74, 
+0x05, 0xE2, 0x95, 0x00, 0xE7, 0xB2, 0x20, 0x87, 
+0x5E, 0x99, 0x6E, 0xB0, 0x23, 0x3F, 0x51, 0x14, 
+0x66, 0x54, 0xE5, 0xAD, 0x08, 0x98, 0x15, 0x6D, 
+0xF8, 0x5B, 0x53, 0x72, 0x35, 0x0D, 0x97, 0x64, 
+0xA4, 0xEB, 0xA3, 0x57, 0x98, 0xE4, 0x4F, 0x64, 
+0x73, 0x5F, 0x6F, 0x1C, 
+
+0xAA, 0x90, 0xC8, 0x76, 0x7C, 0x95, 0xE7, 0x1C, 
+0x99, 0x89, 0xFB, 0xB8, 0x9C, 0x59, 0xED, 0x93, 
+0xC6, 0xF7, 0x4C, 0x81, 0xAB, 0x04, 0xF2, 0xE0, 
+0x4B, 0x92, 0x85, 0xAA, 0x8C, 0x12, 0xA8, 0xEE, 
+0xE8, 0xC3, 0x92, 0xC0, 0x4A, 0xB8, 0x44, 0xC4, 
+0x74, 0x12, 0x15, 0x96, 0x64, 0x3C, 0x7D, 0x9D, 
+0x88, 0x31, 0x0F, 0x2C, 0xF3, 0x2C, 0xDA, 0x47, 
+0x13, 0x05, 0x5C, 0x35, 0x7A, 0x31, 0xD3, 0x68, 
+0xC2, 0xE3, 0x2D, 0x67, 0xA2, 0x33, 0xE1, 0x5F, 
+0x58, 0xE4, 0x4F, 0x91, 0xA6, 0x03, 0xB5, 0x41, 
+0xC8, 0xDC, 0xC0, 0xFF, 0xC6, 0x27, 0xB9, 0xC0, 
+0x40, 0x00, 0x2A, 0xC3, 0xE0, 0xDF, 0xF3, 0xF2, 
+0x21, 0xA7, 0x6E, 0x86, 0xF8, 0xE9, 0x60, 0xD0, 
+0x83, 0x1B, 0x56, 0x79, 0xE7, 0xF2, 0x74, 0x23, 
+0x75, 0xAA, 0x55, 0x06, 0x03, 0x3C, 0x4B, 0x2E, 
+0x84, 0xA2, 0xAF, 0x67, 0xB8, 0xE4, 0xBE, 0xFD, 
+0x63, 0xA5, 0xE2, 0x37, 0xB6, 0x8A, 0x72, 0xA6, 
+0x1D, 0x3C, 0x0C, 0x84, 0x65, 0xAB, 0x9E, 0x6A, 
+0x1E, 0x2B, 0xF8, 0xC8, 0x72, 0x2D, 0xDF, 0xE1, 
+0xE3, 0x93, 0x48, 0x68, 0xA9, 0xDF, 0xF4, 0xD0, 
+0xCB, 0x52, 0x36, 0xB8, 0x6F, 0x56, 0x12, 0xB9, 
+0xFB, 0x27, 0xFE, 0x43, 0xD7, 
+
+0x02, 0x4B, 0xC6, 0xA3, 0xA7, 0x2B, 0xF4, 0x04, 
+0xD1, 0xA8, 0x9C, 0x50, 0x39, 0x02, 0xAF, 0x61, 
+0xFC, 0x48, 0x3F, 0xDC, 0x32, 0x39, 0x7F, 0x03, 
+0x1F, 0x51, 0x56, 0x29, 0x48, 0x94, 0x9F, 0xA8, 
+0x75, 0xD9, 0x77, 0x94, 0x85, 0x2B, 0xAC, 0x42, 
+0xF5, 0x14, 0xC9, 0xD9, 0x91, 0xA6, 0x9F, 0xF5, 
+0xB5, 0x37, 0x17, 0x0F, 0xD1, 0x71, 0x9D, 0x82, 
+0x79, 0x24, 0x7F, 0xDE, 0x6D, 0x49, 0xA1, 0xE8, 
+0x5F, 0x5E, 0xDA, 0x16, 0x9F, 0x72, 0x1E, 0x39, 
+0x25, 0xA5, 0xF2, 0x8C, 0x7F, 0xC6, 0x82, 0x21, 
+0xCF, 0xB5, 0xE8, 0xE0, 0x1D, 0x43, 0x14, 0xF4, 
+0x77, 0x70, 0xA4, 0xC5, 0x47, 0xC3, 0xC5, 0xB1, 
+0x01, 0x2B, 0xD5, 0xAB, 0xAE, 0xBA, 0x54, 0x13, 
+0x23, 0x91, 0xF2, 0x13, 0x21, 0xC5, 0x6E, 0x1C, 
+0x4F, 0xE6, 0x5E, 0x48, 0x5F, 0xDE, 0x5D, 0xB6, 
+0x6B, 0xEE, 0x6B, 0xBF, 0x5E, 0x39, 0xFD, 0xD4, 
+0xCA, 0xB1, 0x9D, 0x76, 0x9E, 0xB7, 0x0D, 0x4E, 
+0xC1, 0xFC, 0xC8, 0x95, 0x77, 0x09, 0x9C, 0xC3, 
+0xB7, 0x41, 0xDD, 0x0C, 0xA7, 0xD5, 0x2E, 0x50, 
+0x4F, 0x03, 0xA3, 0xBD, 0xF2, 0x7D, 0xDF, 0xAD, 
+0xB3, 0x0A, 0x8E, 0x4E, 0xA3, 0xDA, 0x03, 0x87, 
+0x09, 0x6B, 0x53, 0xB7, 0x38, 0xC8, 
+
+0x32, 0xFC, 0xDC, 0x34, 0x80, 0x0A, 0x0D, 0x69, 
+0x03, 0xC4, 0x6D, 0x0E, 0xBA, 0x3D, 0xB0, 0x0B, 
+0x44, 0x60, 0x23, 0x7F, 0x34, 0x63, 0xD1, 0x9A, 
+0x3B, 0xFD, 0x64, 0x5D, 0x42, 0xF6, 0x48, 0xAF, 
+0x73, 0x41, 0xA9, 0x63, 0x98, 0x46, 0xC9, 0x4E, 
+0xFA, 0x39, 0xEA, 0x67, 0xA8, 0xF7, 0x85, 0xE4, 
+0xFE, 0x4D, 0x94, 0x33, 0x77, 0xA7, 0x0D, 0x2A, 
+0x36, 0x65, 0x59, 0xBC, 0xBD, 0xE5, 0x57, 0xD1, 
+0x48, 0xE9, 0x03, 0x12, 0x84, 0xBC, 0x43, 0xE1, 
+0xF0, 0x10, 0x7D, 0x9F, 0xB3, 0xCC, 0xAA, 0x92, 
+0xAD, 0xCC, 0x38, 0xF5, 0x64, 0x3F, 0x81, 0x60, 
+0xE6, 0x00, 0xD7, 0xE4, 0x30, 0x74, 0xB7, 0xB1, 
+0x37, 0x32, 0xA5, 0x51, 0xF0, 0xE0, 0x87, 0xF3, 
+0x7F, 0x84, 0x73, 0xA0, 0x67, 0x0A, 0x10, 0x32, 
+0xD4, 0x3B, 0x59, 0x08, 0xC4, 0x18, 0x50, 0xA1, 
+0xBF, 0x6B, 0xB0, 0x7F, 0xB2, 0x1D, 0x37, 0xBD, 
+0x2D, 0xDD, 0x9C, 0x3B, 0xFD, 0xDD, 0xCA, 0x4C, 
+0x4A, 0x15, 0x77, 0xD5, 0x76, 0xD8, 0xFF, 0x2B, 
+0x4C, 0x62, 0x1B, 0x7A, 0xDF, 0x04, 0xEA, 0x98, 
+0xD2, 0x39, 0x73, 0x6A, 0x35, 0xE5, 0x26, 0x8D, 
+0x8D, 0x74, 0xD9, 0xFF, 0x2E, 0x4E, 0x77, 0x4C, 
+0xEA, 0x26, 0x24, 0x14, 0x49, 0xD0, 0x4F, 
+
+0x15, 0x42, 0x0A, 0xAD, 0xDC, 0x7D, 0x78, 0x87, 
+0xEE, 0x77, 0xF0, 0xBB, 0xC7, 0x0E, 0xDC, 0xCB, 
+0x94, 0x06, 0x17, 0x3F, 0x37, 0x4B, 0x6D, 0x19, 
+0x05, 0xE8, 0x73, 0xEE, 0xFD, 0x65, 0x84, 0x05, 
+0x9C, 0x31, 0x3F, 0xA7, 0x62, 0x38, 0xF2, 0x1B, 
+0xEB, 0xCA, 0x15, 0x32, 0x15, 0x99, 0x12, 0xEF, 
+0x9E, 0x20, 0xC7, 0x15, 0xF7, 0xC3, 0x53, 0x9A, 
+0xC8, 0xC0, 0xC1, 0xC2, 0x6C, 0x70, 0xF7, 0xC0, 
+0x99, 0x6E, 0x45, 0xE1, 0x1D, 0x2C, 0x63, 0x7C, 
+0x08, 0xA7, 0x38, 0x60, 0x3B, 0x4F, 0x43, 0x7F, 
+0x21, 0x4F, 0x54, 0x3B, 0x0A, 0xAD, 0x8B, 0x1B, 
+0x02, 0xD3, 0x9E, 0x5B, 0x7C, 0x51, 0x79, 0xEC, 
+0xE8, 0x45, 0x43, 0x05, 0x2A, 0xA2, 0x30, 0xAF, 
+0x2E, 0x79, 0x53, 0x9B, 0xB6, 0x5F, 0x22, 0xC9, 
+0xF1, 0x7F, 0x56, 0x9B, 0x08, 0xA6, 0x1E, 0xFC, 
+0xB4, 0x27, 0xE4, 0x8C, 0x8A, 0x1B, 0x3F, 0x37, 
+0x65, 0xFF, 0x1E, 0x59, 0x42, 0xB6, 0xA8, 0x64, 
+0xAB, 0xB0, 0x3E, 0xFE, 0xCB, 0xA2, 0xA5, 0xFC, 
+0x0A, 0xFB, 0xE8, 0x44, 0xAE, 0x03, 0x42, 0x9C, 
+0x5B, 0x6E, 0x1E, 0x4C, 0xC0, 0x04, 0x11, 0xA8, 
+0x19, 0x03, 0x25, 0x1A, 0xD3, 0xAC, 0xBD, 0xD7, 
+0x43, 0x6B, 0xBE, 0xEB, 0xF7, 0x34, 0x1C, 0xE4, 
+
+0xB0, 0xDD, 0x81, 0x31, 0xBF, 0x8B, 0x47, 0xA2, 
+0x28, 0xDA, 0xDF, 0xB7, 0xD7, 0xD9, 0xDC, 0x29, 
+0x4D, 0x22, 0x9B, 0x07, 0x4A, 0xCB, 0xAC, 0x2F, 
+0xE4, 0x98, 0x9F, 0x66, 0x8D, 0xFC, 0x18, 0xCC, 
+0x4A, 0x37, 0x46, 0x7C, 0x63, 0xC7, 0x3F, 0x35, 
+0x0F, 0xF2, 0x5C, 0x36, 0xF8, 0x31, 0x2A, 0xE1, 
+0x8F, 0xDA, 0x51, 0x40, 0x57, 0xDF, 0xB6, 0xED, 
+0xDB, 0x1D, 0xA1, 0xED, 0x8B, 0xAA, 0x82, 0x59, 
+0x13, 0xD4, 0x6F, 0x32, 0x04, 0x85, 0x59, 0x5C, 
+0x8D, 0x84, 0x1C, 0xA0, 0x9D, 0x85, 0x3D, 0xFB, 
+0x24, 0x8F, 0x07, 0x78, 0x5F, 0xED, 0x8B, 0xBB, 
+0x21, 0x79, 0x0A, 0x62, 0x78, 0x93, 0x73, 0x4F, 
+0x84, 0x77, 0x04, 0x1F, 0xD5, 0x9E, 0x21, 0x46, 
+0xE0, 0x00, 0x99, 0x15, 0x85, 0xDE, 0xFB, 0xB3, 
+0xCA, 0x9B, 0x29, 0x13, 0x16, 0x44, 0x49, 0x57, 
+0xF5, 0x3B, 0xA1, 0x3A, 0xD9, 0x60, 0xB6, 0x84, 
+0x95, 0xF8, 0x9F, 0x57, 0x26, 0xFD, 0x06, 0x77, 
+0x56, 0x6F, 0xC3, 0x4A, 0x36, 0x56, 0x3B, 0xC0, 
+0xFB, 0x04, 0x6E, 0x80, 0x7C, 0x9F, 0xFF, 0xB6, 
+0xAB, 0xDD, 0x75, 0x20, 0x32, 0x3E, 0x72, 0x1C, 
+0x6B, 0x20, 0x99, 0x19, 0x69, 0xB6, 0xF2, 0xF4, 
+0x81, 0xAD, 0xD6, 0x47, 0x12, 0x7A, 0x83, 0xCF, 
+0x55, 
+
+0xCC, 0x61, 0xE7, 0x5E, 0x3E, 0xD4, 0x26, 0xB3, 
+0x57, 0x1F, 0xD1, 0x9A, 0x48, 0xB6, 0x71, 0xF7, 
+0x3F, 0x28, 0x52, 0x14, 0x56, 0xDA, 0xA8, 0xF9, 
+0x10, 0xCF, 0xF8, 0x86, 0x09, 0x08, 0xFA, 0xD1, 
+0x2A, 0x9A, 0xAE, 0x55, 0xCA, 0x96, 0xA2, 0xB9, 
+0x9D, 0x38, 0x8A, 0x7A, 0x26, 0xB0, 0xCB, 0xD8, 
+0x15, 0x60, 0x26, 0xFC, 0xA1, 0x84, 0xCF, 0xC4, 
+0xD5, 0xE9, 0xA8, 0x56, 0x4D, 0x52, 0xC9, 0xB8, 
+0xC8, 0x6C, 0x0D, 0xAE, 0x83, 0xA2, 0xBD, 0xA2, 
+0xAB, 0x80, 0x82, 0xC0, 0xE2, 0x3C, 0x18, 0x0A, 
+0xFE, 0xE3, 0xAB, 0x0A, 0x24, 0xBB, 0xED, 0xE8, 
+0xB7, 0x08, 0x8D, 0x7C, 0x4D, 0x34, 0x0D, 0xEC, 
+0x45, 0xD5, 0x7F, 0xA3, 0xE6, 0x69, 0x78, 0xA8, 
+0xD0, 0x96, 0xB6, 0x6E, 0xA2, 0x6F, 0x5A, 0xB1, 
+0xBF, 0x20, 0x03, 0x64, 0xE0, 0xE0, 0x9E, 0x7D, 
+0xE5, 0xAE, 0xFE, 0x4F, 0xBC, 0x22, 0x6B, 0x8F, 
+0x83, 0xD4, 0xFE, 0xF8, 0x02, 0x86, 0x26, 0x1B, 
+0x24, 0xE1, 0x33, 0xB4, 0x77, 0x15, 0x08, 0x2A, 
+0x5D, 0xE9, 0xC4, 0x8F, 0xB2, 0x51, 0xBC, 0xC8, 
+0xB3, 0xDE, 0xBC, 0xD7, 0x5C, 0x64, 0x65, 0xCC, 
+0xB3, 0x5B, 0x9F, 0x55, 0x0A, 0x9B, 0x4E, 0x0B, 
+0x72, 0x0D, 0x2D, 0x0E, 0x69, 0xC7, 0xBC, 0x32, 
+0xB5, 0x47, 
+
+0x9C, 0xEA, 0x7A, 0xC8, 0xCB, 0x19, 0xD9, 0x7A, 
+0x6C, 0x12, 0xBF, 0x0B, 0xEB, 0xE1, 0x39, 0xF4, 
+0x5C, 0x5F, 0xD8, 0xE8, 0x14, 0x1D, 0x11, 0xFB, 
+0x47, 0x92, 0xB7, 0x64, 0xC2, 0x3D, 0x31, 0x72, 
+0x93, 0x50, 0x99, 0x75, 0xCC, 0xB3, 0x00, 0x70, 
+0x22, 0xA7, 0x57, 0xE6, 0x89, 0xAC, 0xDF, 0x6E, 
+0x77, 0x7D, 0x5D, 0xD0, 0x66, 0x2E, 0x55, 0x95, 
+0x9B, 0xC4, 0x73, 0x63, 0x4D, 0xCE, 0x74, 0xE6, 
+0xAD, 0xC8, 0x3F, 0x3F, 0x5F, 0x4C, 0x96, 0x30, 
+0x38, 0x31, 0x85, 0x81, 0xF7, 0xEF, 0x22, 0xCC, 
+0x5F, 0x7D, 0x0C, 0x26, 0xDD, 0x28, 0xE4, 0x52, 
+0xB3, 0xBA, 0x97, 0xAF, 0x1A, 0x11, 0x13, 0xCD, 
+0xC5, 0x87, 0x34, 0x38, 0xD6, 0x66, 0xC5, 0x8D, 
+0x05, 0x7C, 0xC3, 0xBC, 0x4E, 0x1C, 0xAF, 0x37, 
+0xD8, 0xBC, 0x9B, 0x05, 0x89, 0x26, 0x47, 0x7A, 
+0xBF, 0x11, 0xCB, 0x60, 0xAB, 0x58, 0xD3, 0x62, 
+0x65, 0x9F, 0x32, 0x47, 0xD2, 0x9B, 0x53, 0xEA, 
+0x99, 0x99, 0x27, 0xCF, 0x7E, 0xC9, 0x30, 0xA8, 
+0xD6, 0xFD, 0xDA, 0xAA, 0x2B, 0x33, 0x37, 0x66, 
+0x1B, 0x35, 0xEF, 0xC0, 0x60, 0x1A, 0xD4, 0x31, 
+0x5D, 0x0C, 0x95, 0xDF, 0x74, 0xDC, 0xF6, 0xE1, 
+0x77, 0xD1, 0x19, 0x3F, 0x65, 0x25, 0xD1, 0x35, 
+0x9D, 0xBA, 0xBC, 
+
+0x8F, 0xBD, 0x43, 0xF4, 0x99, 0x6B, 0x93, 0x14, 
+0x5F, 0x25, 0xC6, 0xFE, 0x1F, 0x83, 0x7C, 0x8F, 
+0x9E, 0x7F, 0xC5, 0xB8, 0xFA, 0x75, 0x4C, 0x36, 
+0x2C, 0x6C, 0xD1, 0x5F, 0x42, 0xEC, 0xEA, 0x41, 
+0x05, 0xC9, 0xFB, 0xA2, 0x4B, 0x96, 0xAB, 0x3C, 
+0xA1, 0xB4, 0xA7, 0xEF, 0x9C, 0x84, 0x5E, 0xD3, 
+0xB0, 0xA2, 0xF8, 0x1F, 0xC0, 0xC8, 0x10, 0xCA, 
+0x17, 0xAB, 0xCD, 0x98, 0xA2, 0xE4, 0xC7, 0xC8, 
+0xEA, 0x7B, 0x5F, 0x0C, 0x04, 0xAD, 0x8E, 0x7C, 
+0x16, 0x9D, 0xE1, 0xC4, 0xE6, 0x95, 0x39, 0xB0, 
+0x67, 0x9C, 0xC9, 0x0F, 0x4B, 0xEC, 0x7B, 0x5A, 
+0xAB, 0xF3, 0xD2, 0xFB, 0x46, 0xB5, 0xDE, 0x8B, 
+0x0B, 0x95, 0xF8, 0x42, 0xA0, 0xF4, 0x9C, 0x5B, 
+0x48, 0x78, 0x41, 0x19, 0x95, 0x63, 0x60, 0xE1, 
+0xBA, 0xFF, 0xC6, 0xF9, 0xE6, 0x2F, 0xEB, 0x66, 
+0xCB, 0x8C, 0x36, 0x48, 0x7B, 0x27, 0x72, 0x1D, 
+0x1D, 0xED, 0xB6, 0x54, 0xEE, 0xDE, 0xCB, 0x17, 
+0xD5, 0x2A, 0x1C, 0xBC, 0x1D, 0x2F, 0x88, 0x6A, 
+0xB2, 0x3C, 0xB6, 0xFA, 0x34, 0x91, 0x70, 0xD5, 
+0xA7, 0xCB, 0x5B, 0xC8, 0xFA, 0xAE, 0x39, 0x1C, 
+0xF3, 0xB8, 0x39, 0x9C, 0x08, 0x8D, 0xD3, 0x8F, 
+0x52, 0x28, 0x89, 0x00, 0x49, 0x5C, 0x4B, 0x12, 
+0x94, 0x0C, 0x59, 0x42, 
+
+0x61, 0xA4, 0x54, 0x03, 0xCF, 0xDE, 0xC0, 0xB2, 
+0xC5, 0x03, 0x2F, 0xA1, 0xC7, 0x26, 0x4D, 0x8A, 
+0x6C, 0xBF, 0x86, 0x76, 0xCC, 0x2E, 0x15, 0x6B, 
+0xE1, 0x74, 0x13, 0x9C, 0xDE, 0x5A, 0xDD, 0x69, 
+0x43, 0x54, 0xF0, 0xC6, 0xC0, 0xA0, 0x74, 0xBA, 
+0x62, 0xCB, 0xC9, 0xF3, 0x3A, 0x6D, 0x49, 0xA8, 
+0xCB, 0x28, 0x55, 0x64, 0x4B, 0xEA, 0x9F, 0xB0, 
+0xE8, 0xAE, 0x2B, 0xC7, 0xC1, 0x91, 0x27, 0x3D, 
+0x88, 0x8A, 0x86, 0x85, 0x12, 0x9C, 0x7E, 0xAA, 
+0xA6, 0xEE, 0xAA, 0x42, 0x93, 0xE8, 0xFE, 0xFE, 
+0xF0, 0x88, 0xE3, 0x00, 0xEC, 0xA1, 0x2B, 0x6F, 
+0x07, 0xF0, 0xED, 0x4C, 0x41, 0x4B, 0x1D, 0x99, 
+0x9E, 0xE0, 0xB8, 0x2C, 0xE0, 0x5B, 0x33, 0x8D, 
+0xA6, 0xB7, 0x71, 0xF9, 0x55, 0x25, 0x57, 0x9A, 
+0x1A, 0x4F, 0x0F, 0xDD, 0x53, 0x2A, 0xBD, 0xBD, 
+0x5A, 0xA6, 0x89, 0x4F, 0x1B, 0xFF, 0x3C, 0x23, 
+0x05, 0x2D, 0xD3, 0x2A, 0xDB, 0xA1, 0x7D, 0x45, 
+0x52, 0x7C, 0xD8, 0xFC, 0xC1, 0x9F, 0x7C, 0x8B, 
+0xF1, 0xF1, 0x94, 0x21, 0x40, 0x41, 0x66, 0x0F, 
+0xD4, 0x94, 0x54, 0x6F, 0x88, 0x82, 0xFB, 0x51, 
+0xAE, 0x6D, 0x7C, 0xCE, 0x63, 0x11, 0x99, 0x05, 
+0x78, 0xFE, 0x39, 0xA7, 0x17, 0xF7, 0x38, 0x6B, 
+0xED, 0x3C, 0xDA, 0xDF, 0x47, 
+
+0xE5, 0x45, 0x91, 0x8A, 0x4F, 0x19, 0xFA, 0x73, 
+0x34, 0x60, 0xA6, 0x57, 0xA8, 0x2E, 0xEE, 0x14, 
+0xB6, 0x1B, 0xD1, 0x7D, 0xE5, 0x69, 0x46, 0x4E, 
+0xCD, 0x7C, 0x14, 0xF0, 0x7D, 0xC7, 0x92, 0x17, 
+0x8F, 0x7F, 0xFD, 0xAB, 0x55, 0xEC, 0xBB, 0x9B, 
+0x26, 0x74, 0x5F, 0x29, 0x24, 0xA5, 0x1D, 0x56, 
+0xBA, 0x9C, 0xA1, 0xE6, 0xA5, 0xED, 0xDB, 0x65, 
+0x3B, 0xA1, 0x17, 0x90, 0x19, 0xDB, 0xC1, 0xC4, 
+0x59, 0xF2, 0xAD, 0xFD, 0x27, 0x51, 0xA5, 0xF0, 
+0x40, 0x37, 0x86, 0x9A, 0x33, 0x97, 0x63, 0x72, 
+0x75, 0x5B, 0x57, 0xB4, 0xAB, 0x8E, 0x9B, 0x6B, 
+0xFA, 0xDA, 0x95, 0x4D, 0x80, 0xAD, 0x8F, 0xCC, 
+0x62, 0x77, 0x6A, 0x5A, 0xFB, 0xAE, 0x12, 0x0C, 
+0xA5, 0x7A, 0x3E, 0x92, 0x7A, 0xDB, 0x18, 0x79, 
+0xD1, 0xB0, 0x1D, 0xA3, 0xF7, 0x7B, 0xCA, 0x48, 
+0xBE, 0x0F, 0x68, 0x31, 0x04, 0x65, 0xBA, 0xA7, 
+0x48, 0x05, 0x0D, 0x90, 0xB4, 0x0D, 0xB9, 0x32, 
+0x91, 0x69, 0x69, 0x1F, 0x70, 0x6A, 0x53, 0xFF, 
+0xF3, 0x2A, 0x85, 0x2E, 0x60, 0xD1, 0x9C, 0xEB, 
+0x34, 0x5E, 0x68, 0x9A, 0x96, 0x41, 0xC5, 0x61, 
+0x0C, 0x3C, 0xDE, 0x43, 0xBD, 0x86, 0x45, 0x18, 
+0xF7, 0x75, 0x3C, 0x78, 0x65, 0xC6, 0x86, 0x14, 
+0x94, 0x14, 0xCF, 0xB7, 0xCC, 0xF0, 
+
+0xFA, 0xD3, 0x14, 0x5A, 0x45, 0x71, 0x84, 0x25, 
+0xF4, 0xEF, 0x52, 0xE2, 0xFA, 0x27, 0x7C, 0xEB, 
+0x0C, 0x7A, 0xF0, 0x69, 0xBF, 0xE5, 0xD1, 0x22, 
+0xE2, 0x65, 0x2B, 0x87, 0x1E, 0xE1, 0xF9, 0x15, 
+0x18, 0xD9, 0xCB, 0xCA, 0x8C, 0x05, 0x38, 0xA5, 
+0x6A, 0x5A, 0x4D, 0x21, 0xFB, 0xD9, 0x8C, 0x9F, 
+0xCD, 0xE6, 0x9B, 0x96, 0xAE, 0x97, 0x6F, 0x7A, 
+0xDB, 0xF1, 0x83, 0x9C, 0xCA, 0x64, 0x3A, 0x69, 
+0x59, 0xA0, 0x16, 0x3D, 0x90, 0x41, 0x81, 0xD2, 
+0x87, 0x0A, 0xB3, 0x28, 0x28, 0x1B, 0x72, 0x9C, 
+0x8C, 0x0C, 0x10, 0x47, 0x15, 0x48, 0x23, 0xBD, 
+0x93, 0x79, 0xE3, 0x23, 0x79, 0x43, 0x22, 0x4D, 
+0x99, 0xB2, 0xAB, 0xAB, 0x41, 0xA1, 0xA3, 0x7D, 
+0x15, 0x92, 0xC5, 0x9D, 0xE9, 0xED, 0x89, 0xDB, 
+0x52, 0xD6, 0x69, 0xFE, 0xE8, 0x7C, 0xEA, 0x99, 
+0x03, 0x1F, 0x34, 0xBD, 0xD8, 0x94, 0xF3, 0x9C, 
+0x8F, 0xF8, 0x83, 0x76, 0x04, 0x83, 0x3E, 0x7E, 
+0x0F, 0xB6, 0xC4, 0xA4, 0x94, 0xA8, 0xAC, 0x6B, 
+0x07, 0xA7, 0x46, 0x52, 0x2D, 0x7C, 0xF0, 0x1C, 
+0xF6, 0xD5, 0xAB, 0x1F, 0x20, 0xB0, 0x5C, 0x89, 
+0x09, 0x5F, 0x8F, 0x54, 0x48, 0x42, 0x62, 0xE4, 
+0x21, 0x64, 0x58, 0x9F, 0xBE, 0xF8, 0x75, 0x1B, 
+0x64, 0xD8, 0x2C, 0xDE, 0x8F, 0x8E, 0x8E, 
+
+0x37, 0x5A, 0x27, 0x4A, 0xB4, 0xE8, 0x18, 0x78, 
+0xC1, 0xEC, 0x18, 0x79, 0xF3, 0x4A, 0x39, 0xD1, 
+0xCE, 0x07, 0xFF, 0x4E, 0xF9, 0xA8, 0xB8, 0xBE, 
+0x2C, 0x79, 0x75, 0xB8, 0x1C, 0x83, 0xE1, 0x56, 
+0x03, 0x15, 0xB7, 0x51, 0x17, 0xD0, 0xB2, 0x2F, 
+0xA1, 0x66, 0x5D, 0x8A, 0x93, 0xD3, 0xD0, 0xF6, 
+0x10, 0x44, 0x28, 0x5C, 0x9B, 0x4F, 0x07, 0x04, 
+0x18, 0xF5, 0x2C, 0x76, 0x99, 0x2E, 0x01, 0x02, 
+0x28, 0x4D, 0xCE, 0x4F, 0xEF, 0x4D, 0x2C, 0x01, 
+0xE5, 0xB0, 0x86, 0xC3, 0x8B, 0x2F, 0xA2, 0x0E, 
+0x53, 0x62, 0x3D, 0x7E, 0x3F, 0x7D, 0xBF, 0x14, 
+0xC9, 0xF3, 0xF6, 0xE8, 0xF9, 0x69, 0x51, 0x56, 
+0x92, 0xD0, 0xDF, 0x99, 0x21, 0xF9, 0x9F, 0xD8, 
+0x2F, 0x19, 0x86, 0x3E, 0x29, 0xAA, 0x78, 0x1D, 
+0x18, 0x20, 0x9F, 0x44, 0x4E, 0x98, 0x5D, 0xF5, 
+0x42, 0xCC, 0xED, 0xCE, 0x01, 0xF8, 0x43, 0xAC, 
+0x97, 0xB0, 0xBD, 0x7D, 0xD7, 0xAD, 0xD4, 0x87, 
+0xFE, 0x8F, 0x43, 0x8E, 0xAC, 0x43, 0x50, 0x1A, 
+0xAF, 0x0B, 0x4B, 0x86, 0x18, 0x13, 0x58, 0x86, 
+0x8A, 0x82, 0xAC, 0xF1, 0x35, 0x04, 0xDE, 0xC5, 
+0x6B, 0x11, 0x9A, 0xC7, 0x32, 0xE9, 0x9C, 0xC5, 
+0x53, 0x09, 0xC1, 0xD4, 0x4D, 0x14, 0xF7, 0x33, 
+0x9D, 0x50, 0x3E, 0xD5, 0xFF, 0x7A, 0xDC, 0x17, 
+
+0xA7, 0x3A, 0x75, 0xB0, 0xFA, 0x82, 0xBE, 0x0D, 
+0x4D, 0xCB, 0xD5, 0xF9, 0xC4, 0xB7, 0x22, 0xF8, 
+0x42, 0x86, 0xFB, 0x42, 0xE8, 0xDD, 0x3E, 0x5C, 
+0x5A, 0xD3, 0x64, 0x6B, 0x65, 0x84, 0x4A, 0xEE, 
+0xC6, 0x20, 0x19, 0x53, 0x71, 0x98, 0x6B, 0xFB, 
+0xDC, 0xB3, 0x93, 0xF4, 0x5E, 0xEE, 0xED, 0xA8, 
+0xB4, 0x82, 0xCD, 0xB4, 0xBE, 0xDC, 0x7A, 0xC5, 
+0x4E, 0x1E, 0x3C, 0xC9, 0xE4, 0xBE, 0x7D, 0xFA, 
+0x0C, 0x82, 0x6F, 0x73, 0x85, 0xA0, 0x1C, 0xF9, 
+0x18, 0xA2, 0x22, 0x3B, 0xF1, 0x42, 0x52, 0x04, 
+0xC3, 0x3F, 0x07, 0xBF, 0xEE, 0x48, 0x78, 0x0A, 
+0xCE, 0x7E, 0x65, 0xB5, 0x56, 0x72, 0xC7, 0x06, 
+0xE1, 0xAF, 0x21, 0x43, 0x1A, 0xC5, 0x8F, 0xEF, 
+0x13, 0x9D, 0xDF, 0x12, 0xB8, 0xD1, 0x68, 0xAA, 
+0x01, 0xEE, 0x38, 0xF0, 0xEA, 0x7F, 0x9E, 0xC0, 
+0xC5, 0x95, 0xF0, 0x79, 0x40, 0x2D, 0x94, 0x83, 
+0x95, 0x29, 0xC1, 0x0C, 0x66, 0xC4, 0x00, 0x90, 
+0xB3, 0x11, 0xAE, 0x29, 0xA0, 0x96, 0xA7, 0xD7, 
+0x52, 0x54, 0x83, 0xFE, 0xEF, 0x6B, 0xEF, 0x17, 
+0xB8, 0x60, 0x19, 0x96, 0x18, 0xCE, 0x1C, 0xDA, 
+0x44, 0xB9, 0x4A, 0xA9, 0xF3, 0xC3, 0x58, 0x66, 
+0xB3, 0xF0, 0xC4, 0xE1, 0x0B, 0x47, 0x16, 0x96, 
+0x5D, 0xD3, 0xFA, 0xBD, 0x68, 0xEC, 0x45, 0x5A, 
+0x80, 
+
+0xBD, 0xD0, 0xDA, 0x08, 0x00, 0x36, 0x3C, 0x6D, 
+0xE8, 0x10, 0x16, 0xA3, 0x77, 0xAA, 0x0D, 0x74, 
+0x16, 0x01, 0xEC, 0x40, 0x24, 0xC6, 0x6C, 0x19, 
+0xD1, 0x38, 0x12, 0x85, 0x60, 0xC1, 0xBD, 0x9D, 
+0xF4, 0xDB, 0xC8, 0x0D, 0x95, 0xA0, 0x70, 0x9B, 
+0x25, 0xFD, 0x8A, 0x42, 0x1E, 0xCB, 0x6E, 0x03, 
+0x2E, 0x7A, 0xDF, 0x97, 0x6B, 0x6D, 0x4C, 0xD7, 
+0x0A, 0xB0, 0x02, 0x68, 0x49, 0x81, 0xF2, 0x81, 
+0x3D, 0x61, 0x9F, 0xAE, 0x23, 0xBE, 0x6E, 0xB5, 
+0x35, 0x69, 0xB7, 0x00, 0x53, 0x00, 0x48, 0x4B, 
+0xF4, 0xB2, 0x23, 0x08, 0xCD, 0x53, 0x6B, 0x2E, 
+0xC4, 0xC2, 0x9E, 0x7C, 0x74, 0x6C, 0x1C, 0x60, 
+0xA4, 0xB8, 0x4D, 0x9F, 0xC9, 0xF2, 0x1B, 0x82, 
+0xD7, 0x24, 0xE7, 0xFC, 0x27, 0xAB, 0x86, 0x6C, 
+0x3F, 0xAB, 0x6C, 0x8E, 0x7B, 0x53, 0xF2, 0x82, 
+0x72, 0x82, 0x1C, 0x3B, 0x8C, 0xBC, 0x6B, 0xD3, 
+0x73, 0xCD, 0x08, 0xE9, 0xEA, 0xE3, 0x96, 0x8D, 
+0xF1, 0x71, 0xEC, 0x04, 0x9A, 0xF0, 0x1A, 0x32, 
+0x78, 0xE0, 0xD4, 0x48, 0x83, 0x26, 0x29, 0x04, 
+0xAE, 0x99, 0xD8, 0xE8, 0x63, 0x54, 0xAD, 0x57, 
+0x77, 0x08, 0xAF, 0xA5, 0xB9, 0xF4, 0xAD, 0xA5, 
+0x5A, 0xF8, 0xE5, 0x80, 0xA1, 0x2A, 0xE0, 0x6B, 
+0x51, 0x50, 0x31, 0x3C, 0x51, 0xFD, 0xDF, 0xDB, 
+0xA0, 0xAB, 
+
+0x19, 0xF3, 0x9D, 0xF6, 0xCB, 0x35, 0x08, 0xAB, 
+0xFE, 0xD2, 0xB4, 0x85, 0xEC, 0xFB, 0xD2, 0x32, 
+0x18, 0x13, 0x9C, 0x78, 0x6B, 0x0C, 0xF1, 0x06, 
+0xA8, 0x73, 0xC9, 0xEF, 0xB3, 0xC4, 0x89, 0x30, 
+0x21, 0xA0, 0xA7, 0xDC, 0x35, 0xA6, 0x94, 0xF8, 
+0x1B, 0x2A, 0xF0, 0xB7, 0xFC, 0xF3, 0x00, 0x70, 
+0x3C, 0xF9, 0x16, 0x88, 0x98, 0xE0, 0x1F, 0xC1, 
+0xFD, 0x09, 0x76, 0xD9, 0x42, 0x82, 0x25, 0xD5, 
+0xA9, 0x40, 0x5B, 0xF8, 0x92, 0x93, 0x38, 0xD1, 
+0x0B, 0xD9, 0x4C, 0x0C, 0x98, 0x69, 0x86, 0x78, 
+0x36, 0x4D, 0x33, 0x4E, 0x92, 0x14, 0xF9, 0xE8, 
+0x66, 0x43, 0x0B, 0xB5, 0x2E, 0x21, 0xD6, 0x31, 
+0xC1, 0xC4, 0x9C, 0x7F, 0x7B, 0xDD, 0xE3, 0x80, 
+0x68, 0x15, 0x3B, 0x54, 0xEC, 0xDD, 0x9D, 0xA9, 
+0x93, 0x81, 0x35, 0x8F, 0x72, 0xA4, 0xB6, 0x09, 
+0xB2, 0xCE, 0xB1, 0xC2, 0xFE, 0xC5, 0xF1, 0x37, 
+0x0D, 0x9F, 0x44, 0x88, 0xBE, 0x65, 0x52, 0xFF, 
+0xEF, 0xCA, 0x54, 0xCC, 0x78, 0xA9, 0x03, 0x15, 
+0xCA, 0x6F, 0x2E, 0xAD, 0x96, 0xB7, 0xEA, 0xC7, 
+0x50, 0x55, 0x64, 0x0B, 0x58, 0x26, 0x99, 0xAC, 
+0xC8, 0x32, 0x44, 0x0D, 0xA4, 0x53, 0xA0, 0xA3, 
+0x75, 0x76, 0xD4, 0x2D, 0x55, 0xEB, 0x41, 0xC5, 
+0xB3, 0x68, 0x64, 0xDF, 0x5C, 0xE6, 0xF8, 0x14, 
+0x8E, 0xC8, 0x31, 
+
+0xBE, 0x95, 0x6A, 0xF1, 0x9E, 0x62, 0x54, 0x37, 
+0x8A, 0x9D, 0xC7, 0x1B, 0xC4, 0x5C, 0xA7, 0xCD, 
+0xAA, 0x1E, 0xFC, 0xA3, 0xAF, 0x2F, 0x0A, 0xE5, 
+0x97, 0xCD, 0x3E, 0x95, 0xA3, 0x80, 0x87, 0x7D, 
+0xE5, 0xFF, 0x6A, 0x6C, 0x63, 0xBB, 0x06, 0xBE, 
+0x43, 0x9F, 0xCE, 0x4B, 0x62, 0x10, 0x47, 0xFA, 
+0x6E, 0xE4, 0x3E, 0x9A, 0x61, 0x6D, 0x05, 0x23, 
+0x98, 0x94, 0x1F, 0xE2, 0xE5, 0xB4, 0x15, 0x0F, 
+0xB4, 0x3A, 0xC4, 0x43, 0xEC, 0x2C, 0xAE, 0xB2, 
+0xD0, 0x79, 0xCE, 0xC6, 0x53, 0xE1, 0x51, 0xFB, 
+0xF9, 0x22, 0xA7, 0x67, 0x75, 0x17, 0x6E, 0x06, 
+0x84, 0xD3, 0xB3, 0x5C, 0xEC, 0x44, 0x4D, 0x9B, 
+0x03, 0xCE, 0x01, 0x0D, 0x24, 0x4E, 0x0D, 0x26, 
+0x6B, 0x9E, 0x4D, 0x30, 0x22, 0x8E, 0x2D, 0xAC, 
+0x9F, 0x8B, 0x3B, 0x23, 0xE9, 0xBD, 0x31, 0x9F, 
+0x33, 0x43, 0x08, 0x9D, 0x95, 0xEB, 0xAB, 0xF7, 
+0xB7, 0xE4, 0x74, 0x44, 0x19, 0x73, 0x32, 0xB5, 
+0x1A, 0x10, 0x7D, 0x54, 0x7A, 0xFD, 0xC5, 0x90, 
+0xE1, 0x06, 0x0A, 0x71, 0x02, 0xE8, 0xC6, 0x28, 
+0x7D, 0x37, 0x6C, 0x21, 0x6A, 0x9C, 0x41, 0xF4, 
+0xC7, 0x1E, 0x36, 0xB6, 0x69, 0x18, 0xF4, 0x0A, 
+0x8A, 0xFC, 0x14, 0x37, 0x7E, 0x29, 0x10, 0x62, 
+0x38, 0xF5, 0xC8, 0x3C, 0x6D, 0xA0, 0xA9, 0x50, 
+0x92, 0xE0, 0x37, 0xA4, 
+
+0x4E, 0x15, 0xE4, 0xFD, 0xEF, 0xC0, 0x4B, 0x5E, 
+0xEA, 0xAB, 0x6C, 0x2E, 0xC5, 0x8B, 0x1E, 0xC3, 
+0x1F, 0x40, 0x0A, 0x76, 0x59, 0xCF, 0xCF, 0xB3, 
+0x7E, 0xA6, 0xBD, 0x2A, 0x1E, 0xCF, 0xF0, 0x60, 
+0x15, 0xB9, 0x8C, 0x72, 0x73, 0xC5, 0xA1, 0xE2, 
+0x4C, 0x7C, 0xB9, 0x7D, 0xA1, 0xF1, 0xB7, 0xE7, 
+0xD7, 0x9F, 0xBF, 0x07, 0xF4, 0x65, 0xD0, 0x23, 
+0xD4, 0x07, 0xD7, 0xBB, 0xBE, 0x7B, 0xE9, 0x44, 
+0x9C, 0x44, 0xCD, 0x8E, 0x98, 0x00, 0xE2, 0x84, 
+0xE2, 0x8A, 0xC6, 0x51, 0xEB, 0xAA, 0x62, 0xB1, 
+0x1B, 0x67, 0xDB, 0xFD, 0xE0, 0x7A, 0xD0, 0xB8, 
+0x98, 0xA4, 0x98, 0xF6, 0x6F, 0x0B, 0xCA, 0x8C, 
+0xE7, 0x61, 0xB2, 0xF6, 0x4A, 0xD9, 0xAC, 0x58, 
+0x58, 0x02, 0x3C, 0x36, 0x78, 0xDE, 0x5D, 0x9C, 
+0x48, 0xEC, 0x00, 0x2A, 0x9E, 0x84, 0x81, 0xC7, 
+0xBE, 0x3A, 0x89, 0x4C, 0x21, 0x30, 0xDF, 0x98, 
+0x7B, 0x1C, 0x8F, 0x63, 0x9E, 0x1B, 0x3B, 0x94, 
+0xB1, 0x33, 0x69, 0x26, 0x63, 0x07, 0x84, 0x0E, 
+0xDA, 0xDA, 0x89, 0xD0, 0x6A, 0x0E, 0x7C, 0xC0, 
+0x56, 0xC5, 0x4F, 0x95, 0x63, 0x2D, 0xA0, 0x4B, 
+0x31, 0xA7, 0xB7, 0x0D, 0xB0, 0x57, 0x94, 0xC0, 
+0xA1, 0x90, 0x16, 0xDA, 0xF6, 0xB1, 0x73, 0x4C, 
+0xDD, 0xF9, 0x82, 0xB4, 0x02, 0x58, 0xEC, 0x88, 
+0x9E, 0x2B, 0xAA, 0x63, 0x67, 
+
+0xF6, 0x64, 0x76, 0x66, 0x5B, 0xD7, 0xEE, 0xE4, 
+0x88, 0x16, 0xAC, 0x1E, 0x7D, 0x1A, 0x52, 0x81, 
+0x18, 0x9E, 0xCE, 0x06, 0x3E, 0x71, 0x73, 0x09, 
+0x34, 0x7A, 0x37, 0xF5, 0x21, 0x66, 0x66, 0x7F, 
+0xA8, 0x79, 0x40, 0x60, 0xFB, 0x56, 0xAE, 0xA7, 
+0x72, 0x50, 0xC2, 0x70, 0x3A, 0x27, 0x8F, 0x88, 
+0xEA, 0x2B, 0x39, 0x8A, 0x1D, 0xFE, 0x6E, 0xA4, 
+0xE4, 0xE1, 0xF9, 0x76, 0x6B, 0x8B, 0x55, 0x3E, 
+0x97, 0xCC, 0xD4, 0x01, 0x50, 0x5C, 0x82, 0x5A, 
+0xD0, 0x8C, 0x1D, 0xEA, 0xA3, 0xC0, 0xFD, 0x12, 
+0x57, 0xED, 0xD9, 0x0F, 0x78, 0xB5, 0x23, 0x75, 
+0x0E, 0x98, 0x53, 0x0A, 0x45, 0xC8, 0x5A, 0x2B, 
+0x78, 0x75, 0x80, 0x0D, 0x85, 0x52, 0xD0, 0xC4, 
+0x47, 0xA5, 0x6D, 0x9C, 0x36, 0x0E, 0x0A, 0x3F, 
+0xDF, 0xB3, 0x53, 0x11, 0x39, 0x0A, 0xB1, 0xC2, 
+0xC0, 0xB6, 0x6E, 0x0F, 0x59, 0x5B, 0x2E, 0x04, 
+0x8A, 0x21, 0x66, 0x15, 0x87, 0xE1, 0xE8, 0xAF, 
+0x7A, 0xBC, 0xB5, 0x26, 0xB3, 0x37, 0x85, 0xC9, 
+0xE8, 0x45, 0x09, 0x26, 0x05, 0x39, 0x8E, 0x9E, 
+0x9E, 0xFF, 0xB3, 0x64, 0x87, 0xED, 0x22, 0x52, 
+0xD2, 0xC3, 0x0F, 0x86, 0x8C, 0xD7, 0xE2, 0x1A, 
+0x3A, 0x08, 0x42, 0x5E, 0x63, 0x74, 0xA0, 0x09, 
+0x55, 0x79, 0x32, 0xF8, 0xE0, 0x4E, 0x16, 0xE1, 
+0xBA, 0xF3, 0x6C, 0x1B, 0x84, 0x35, 
+
+0xD1, 0xF3, 0x64, 0x05, 0xE5, 0x87, 0xBC, 0x80, 
+0x9B, 0xF9, 0x02, 0xBA, 0x84, 0x34, 0x32, 0x91, 
+0xC0, 0x72, 0x94, 0x07, 0x61, 0x2E, 0xC4, 0xC3, 
+0xBF, 0x55, 0xA7, 0xE4, 0x30, 0x96, 0x1F, 0xD3, 
+0x35, 0xD7, 0xF5, 0x6E, 0x96, 0x3E, 0xC1, 0xC5, 
+0xEF, 0x5F, 0xE9, 0x35, 0x4E, 0x97, 0x52, 0xED, 
+0x06, 0x7A, 0x91, 0x8B, 0xBC, 0x98, 0xA4, 0x19, 
+0x02, 0x8E, 0x60, 0x26, 0x28, 0x01, 0x0C, 0x67, 
+0x65, 0xE6, 0xAB, 0x30, 0x5F, 0xF2, 0x68, 0x41, 
+0x86, 0xF8, 0xA7, 0xCC, 0x05, 0x17, 0x1D, 0x54, 
+0x91, 0xA4, 0x5A, 0xB2, 0x12, 0xA3, 0x27, 0xE5, 
+0xA4, 0x78, 0xEE, 0x0D, 0xE4, 0x3E, 0x19, 0xBE, 
+0x21, 0xB9, 0x69, 0x50, 0x0B, 0xF3, 0x76, 0xE0, 
+0x7E, 0xAD, 0x3A, 0xE9, 0x28, 0xDB, 0x24, 0xE5, 
+0xA6, 0x91, 0x43, 0x0C, 0x8C, 0x6E, 0x6D, 0xB8, 
+0x2C, 0xCE, 0x20, 0x88, 0x41, 0x54, 0xD7, 0x8F, 
+0x5D, 0x64, 0x8B, 0xD6, 0xCD, 0xAF, 0x29, 0xB3, 
+0x2E, 0xE3, 0x00, 0x33, 0xD8, 0xA7, 0x9D, 0x9E, 
+0x3E, 0x72, 0x2C, 0xF5, 0x9A, 0xBC, 0x8D, 0xF4, 
+0x80, 0x27, 0x52, 0x71, 0x41, 0xA0, 0x1E, 0x06, 
+0xFA, 0xF7, 0xC0, 0xA0, 0x1F, 0x05, 0x8A, 0x29, 
+0x40, 0xEB, 0xD1, 0xAD, 0xB8, 0x0A, 0x3F, 0x45, 
+0x71, 0x2F, 0xE2, 0xA8, 0x4E, 0x59, 0x7F, 0xB7, 
+0x5F, 0x9C, 0xEE, 0xCB, 0xF3, 0xC4, 0xD9, 
+
+0xDE, 0x36, 0x10, 0x19, 0x1F, 0x76, 0xC1, 0x18, 
+0xE0, 0xCD, 0x63, 0x7D, 0xE2, 0x71, 0x27, 0xEA, 
+0x97, 0x8D, 0x84, 0x70, 0x82, 0xC0, 0x87, 0xD5, 
+0x75, 0xE8, 0xD7, 0xFA, 0x2D, 0x0D, 0x42, 0x6E, 
+0x23, 0x58, 0x77, 0x41, 0x0F, 0x1B, 0x0A, 0x1A, 
+0x7F, 0xFF, 0xA5, 0xA5, 0x9B, 0x9B, 0xB9, 0x21, 
+0xBB, 0x06, 0xC8, 0x7C, 0x43, 0x13, 0x82, 0x97, 
+0xC6, 0x3F, 0x16, 0x07, 0xA9, 0xFF, 0x29, 0x3D, 
+0xA9, 0xB5, 0xB0, 0x1D, 0xDB, 0xAC, 0x6E, 0x6D, 
+0x03, 0xF6, 0x9D, 0xB7, 0x59, 0xC2, 0x75, 0x8C, 
+0x54, 0x44, 0x75, 0xA4, 0x35, 0xF1, 0x77, 0x15, 
+0xB6, 0x4B, 0xE4, 0xAD, 0x69, 0xD2, 0xE9, 0xC2, 
+0x7D, 0xA6, 0x70, 0x6A, 0xA6, 0xB4, 0x24, 0x6E, 
+0xF9, 0xAE, 0x25, 0xA1, 0x6A, 0x52, 0x63, 0x50, 
+0xAA, 0x3A, 0xF7, 0xB5, 0x21, 0xFB, 0x97, 0x79, 
+0xB3, 0xD1, 0xA4, 0xE6, 0xDA, 0xDE, 0x3F, 0xAC, 
+0xA0, 0xB8, 0x37, 0x18, 0x4C, 0x80, 0x54, 0x94, 
+0xC2, 0x42, 0x33, 0xFC, 0x71, 0x8F, 0xFB, 0x2C, 
+0x14, 0xE4, 0x4E, 0xF2, 0x57, 0x20, 0xA0, 0x30, 
+0xBE, 0x58, 0x33, 0x11, 0x1D, 0x3A, 0xF4, 0xC5, 
+0xF6, 0x1F, 0x2B, 0x28, 0xD4, 0xB0, 0x6B, 0xEA, 
+0x1E, 0xA5, 0xBA, 0xE3, 0xBB, 0x83, 0x06, 0x3B, 
+0xE4, 0x34, 0x28, 0xAA, 0x4E, 0xEE, 0xB3, 0x6F, 
+0x08, 0xC6, 0x60, 0x00, 0x3B, 0xCC, 0x6D, 0x96, 
+
+0x79, 0x15, 0x70, 0x2A, 0x23, 0x6D, 0x2C, 0xF8, 
+0x3E, 0x39, 0x70, 0x08, 0xF3, 0xA6, 0xBD, 0x02, 
+0x78, 0x3C, 0xE4, 0x0A, 0xC5, 0x5C, 0xAA, 0xBC, 
+0x61, 0x87, 0xA0, 0x3C, 0x59, 0x79, 0xA7, 0x3A, 
+0x06, 0x85, 0xA8, 0xDF, 0x61, 0xAD, 0x8F, 0x77, 
+0x52, 0x16, 0x5F, 0xD8, 0x09, 0x65, 0xE3, 0x98, 
+0x12, 0x4D, 0x90, 0x92, 0x7E, 0x00, 0x1F, 0xA0, 
+0x1C, 0x82, 0xED, 0xD7, 0x47, 0x01, 0x33, 0x48, 
+0x4D, 0x72, 0x15, 0x08, 0xFD, 0xF7, 0xE4, 0x29, 
+0x6A, 0xE2, 0x34, 0x8D, 0x1A, 0xD8, 0xCB, 0xCB, 
+0x82, 0xA2, 0x92, 0xC9, 0x75, 0x35, 0xF4, 0x5E, 
+0xFE, 0x97, 0xCD, 0x48, 0x03, 0xEB, 0x26, 0x98, 
+0xEE, 0x8E, 0xDF, 0x76, 0xD1, 0xE0, 0x58, 0x92, 
+0x48, 0xEB, 0x63, 0x31, 0x23, 0xC4, 0x65, 0x32, 
+0xD6, 0x03, 0x08, 0x86, 0x78, 0x21, 0xFE, 0x76, 
+0x68, 0x64, 0xB9, 0x3B, 0xD1, 0x42, 0x92, 0x36, 
+0x1E, 0xD2, 0x86, 0x5F, 0x5A, 0x24, 0xEF, 0xAC, 
+0x6D, 0x81, 0x87, 0xD5, 0x3E, 0xAD, 0xF7, 0xE2, 
+0xB3, 0xA2, 0x15, 0x4C, 0x22, 0x34, 0xD8, 0x8A, 
+0xB9, 0x9D, 0xBD, 0x5B, 0x71, 0x97, 0xA8, 0xCB, 
+0x3D, 0x79, 0xF3, 0xFF, 0x45, 0xD8, 0xAC, 0xCE, 
+0x26, 0x43, 0xAD, 0xEB, 0xBE, 0xBD, 0x93, 0x95, 
+0x58, 0x9A, 0x26, 0x32, 0xE5, 0x2E, 0x68, 0x75, 
+0x0F, 0x03, 0x32, 0x26, 0xB7, 0xD0, 0xE1, 0x24, 
+0x26, 
+
+0xFE, 0x65, 0x97, 0xF3, 0xF5, 0xBD, 0xC4, 0xC5, 
+0x0B, 0x60, 0x01, 0x79, 0x52, 0x4A, 0x7F, 0x60, 
+0x39, 0xCE, 0xC0, 0x9F, 0x82, 0x8D, 0x3D, 0xF6, 
+0x7B, 0x61, 0x1A, 0x5F, 0x9D, 0x49, 0x99, 0x6A, 
+0xCB, 0xD4, 0x19, 0x05, 0xB5, 0xCB, 0x10, 0x99, 
+0xB2, 0x1C, 0x43, 0x4A, 0xC6, 0xC9, 0x22, 0xF0, 
+0x91, 0x46, 0xC4, 0xFD, 0x6D, 0xC0, 0xB9, 0x4E, 
+0xC5, 0x4C, 0xB5, 0x97, 0xC4, 0xDD, 0x3E, 0x9D, 
+0xA2, 0x84, 0x19, 0x5A, 0x67, 0x79, 0x3E, 0xAA, 
+0xC8, 0xAD, 0x7A, 0xCC, 0xFC, 0x1E, 0x5C, 0xAB, 
+0xB5, 0xB0, 0x18, 0x82, 0x06, 0x40, 0xF8, 0xC5, 
+0x53, 0x7E, 0xBA, 0xD2, 0xA7, 0xA0, 0xDF, 0x0B, 
+0x63, 0x8E, 0xFE, 0xDC, 0xCC, 0x3B, 0x0A, 0xF3, 
+0x9D, 0xA5, 0x4C, 0xF5, 0xD4, 0xE9, 0x63, 0x62, 
+0xFF, 0x1E, 0xA0, 0xD5, 0x53, 0xE9, 0x96, 0x19, 
+0x6C, 0x23, 0xE0, 0xAD, 0x64, 0x6C, 0xBD, 0x35, 
+0xA4, 0x98, 0x8F, 0x3C, 0x29, 0x85, 0xDB, 0x90, 
+0x8D, 0x73, 0x10, 0x24, 0x1E, 0x6A, 0x91, 0x0A, 
+0x8E, 0x65, 0x8B, 0xFB, 0x33, 0x1E, 0x04, 0x2B, 
+0x83, 0x2F, 0xEF, 0x28, 0x92, 0xC9, 0x18, 0x09, 
+0x3B, 0x73, 0xEF, 0x27, 0xD8, 0x13, 0xF6, 0x29, 
+0x07, 0xCD, 0xAA, 0xCA, 0x6E, 0x7F, 0xE8, 0xB6, 
+0xDD, 0x80, 0xB3, 0xF7, 0x18, 0x7E, 0xB9, 0xE6, 
+0x11, 0x49, 0xDE, 0x2B, 0x04, 0xF1, 0x12, 0x17, 
+0x1C, 0x3A, 
+
+0xA8, 0x2A, 0xE9, 0xC5, 0x02, 0x00, 0xF4, 0x61, 
+0xC7, 0x1A, 0x78, 0x72, 0xCC, 0x22, 0xF9, 0xE6, 
+0x7F, 0x9C, 0x8F, 0xFB, 0xEF, 0x3A, 0x02, 0x42, 
+0x9B, 0xA9, 0x82, 0x25, 0xA8, 0xEF, 0xC3, 0x56, 
+0x95, 0x24, 0xD7, 0xC4, 0x88, 0x6A, 0xC1, 0xD5, 
+0x27, 0xB9, 0xE6, 0x8B, 0x65, 0xD4, 0x82, 0xE5, 
+0xF6, 0xB1, 0x82, 0xCF, 0x3F, 0xA4, 0xA4, 0x90, 
+0x4B, 0x8A, 0x63, 0xEE, 0x3D, 0x82, 0x85, 0x39, 
+0xFA, 0x19, 0x78, 0xB7, 0xCA, 0xCD, 0x32, 0xDB, 
+0x25, 0xCD, 0x65, 0x27, 0x7A, 0x50, 0xD2, 0x2F, 
+0xE0, 0xEB, 0xFE, 0xA2, 0x6B, 0x8F, 0xF3, 0x2B, 
+0x65, 0x2C, 0x4D, 0xC4, 0x88, 0xD9, 0x94, 0xA5, 
+0xDB, 0x61, 0x75, 0x2B, 0x9C, 0xF2, 0x94, 0x87, 
+0xAC, 0xF3, 0x9F, 0xB9, 0x87, 0x54, 0xC6, 0xD6, 
+0xC9, 0x27, 0x0C, 0xEA, 0x20, 0x83, 0xC5, 0xB3, 
+0x72, 0xF8, 0xA7, 0x17, 0xF3, 0x72, 0xDB, 0xA0, 
+0xBA, 0x9D, 0xFD, 0x35, 0x34, 0x96, 0xE7, 0xC0, 
+0x98, 0xCF, 0x6B, 0x95, 0xE2, 0xE1, 0x90, 0x00, 
+0xE8, 0xCC, 0x4F, 0x68, 0x96, 0xC1, 0x5B, 0x1B, 
+0xA4, 0xFB, 0x61, 0x9D, 0x1C, 0x4F, 0xA2, 0xDF, 
+0x53, 0xAA, 0x4F, 0x53, 0x94, 0x01, 0x96, 0x7A, 
+0x89, 0x4F, 0xAC, 0xA2, 0xA3, 0x41, 0x65, 0x9F, 
+0x45, 0x97, 0x4E, 0x19, 0x9E, 0x5B, 0x3C, 0x96, 
+0x27, 0xE6, 0xD5, 0xAC, 0xD7, 0x3E, 0x72, 0x80, 
+0xAA, 0x41, 0x18, 
+
+0x46, 0xC4, 0xA9, 0x44, 0xBE, 0xAE, 0xCE, 0xF8, 
+0x3C, 0x1F, 0xC0, 0x28, 0xC1, 0xF3, 0xB6, 0xC0, 
+0x94, 0x83, 0x20, 0x81, 0xEC, 0xBC, 0x8C, 0xE8, 
+0x63, 0x02, 0x18, 0xF2, 0x57, 0xE2, 0xE0, 0x1D, 
+0x5B, 0x61, 0x9A, 0x65, 0xFB, 0xCC, 0xC1, 0x43, 
+0x57, 0x31, 0xAA, 0x18, 0x70, 0x08, 0xAB, 0xC8, 
+0xB6, 0x21, 0xF7, 0x10, 0x3E, 0x79, 0x32, 0x96, 
+0xC5, 0x2D, 0xD8, 0xE5, 0xC4, 0xD3, 0xD6, 0x5F, 
+0xDD, 0x26, 0x15, 0x3E, 0x92, 0x76, 0x77, 0xF1, 
+0xEB, 0x5E, 0xE1, 0x3C, 0x00, 0xCE, 0x18, 0x89, 
+0x6F, 0x54, 0xC4, 0xE4, 0x70, 0x49, 0x7B, 0xA0, 
+0x47, 0x49, 0xEF, 0x14, 0x5C, 0x31, 0xEB, 0xD4, 
+0x8F, 0x5F, 0x09, 0x7F, 0x0A, 0xCA, 0x4C, 0xCB, 
+0x4E, 0x56, 0x57, 0x58, 0x69, 0xC6, 0x6D, 0x2C, 
+0x22, 0x1A, 0x84, 0xD4, 0x8B, 0xF5, 0x4C, 0xB0, 
+0x91, 0xF2, 0xA9, 0xD9, 0x53, 0x98, 0xF3, 0x84, 
+0x03, 0xD7, 0x1E, 0x89, 0x37, 0xB0, 0x68, 0x84, 
+0x8E, 0x41, 0x85, 0x19, 0x56, 0x5B, 0x7C, 0x8A, 
+0x50, 0x43, 0x6F, 0xB7, 0x64, 0x0E, 0xA1, 0x3B, 
+0xA9, 0x54, 0x3F, 0x1B, 0x36, 0x4D, 0xA1, 0xD1, 
+0xB7, 0x6C, 0x06, 0xFB, 0x3B, 0x88, 0xD0, 0x8D, 
+0x99, 0x7F, 0x6A, 0x42, 0x21, 0x25, 0x11, 0xF3, 
+0xF1, 0xFB, 0x58, 0xC8, 0x74, 0xEA, 0x8D, 0x76, 
+0x7D, 0x57, 0x41, 0x56, 0x6B, 0xEF, 0x0A, 0x67, 
+0x16, 0xF3, 0x53, 0xBE, 
+
+0x40, 0xE9, 0xB9, 0x0A, 0x0C, 0xF4, 0xA2, 0x2D, 
+0xBA, 0x15, 0xB9, 0x6F, 0xE8, 0x98, 0x69, 0xDC, 
+0xB7, 0x43, 0x00, 0xE7, 0x25, 0x56, 0xC8, 0x14, 
+0xB0, 0x13, 0xC7, 0x8F, 0xEE, 0x96, 0xD9, 0x8D, 
+0x66, 0x02, 0x9C, 0x6C, 0x74, 0x4E, 0x1F, 0x8F, 
+0x93, 0xCF, 0x32, 0x31, 0x51, 0x89, 0xCB, 0x16, 
+0x9F, 0x2C, 0x23, 0x26, 0x4E, 0x26, 0xEE, 0x08, 
+0x71, 0x1D, 0x84, 0xB2, 0x4C, 0xB1, 0x70, 0x86, 
+0x06, 0xD0, 0xBE, 0xAE, 0x63, 0x02, 0xE4, 0x15, 
+0x38, 0x35, 0xF9, 0xFD, 0x8E, 0xD5, 0x3E, 0x6A, 
+0x0B, 0x34, 0x74, 0xC8, 0x9A, 0x51, 0x27, 0x4E, 
+0x50, 0x6A, 0xCE, 0xFA, 0x27, 0xC8, 0x10, 0x3F, 
+0xCC, 0x58, 0x96, 0xC8, 0x87, 0x34, 0x90, 0xA5, 
+0xB9, 0x18, 0xB1, 0x9B, 0xE8, 0x50, 0x2B, 0x57, 
+0x5D, 0xDD, 0xDA, 0xFA, 0xCB, 0x7C, 0xDB, 0xDE, 
+0x98, 0x5B, 0x9B, 0xCD, 0xB4, 0x69, 0xD3, 0x0A, 
+0xDC, 0x7A, 0x53, 0xD4, 0x57, 0xE0, 0xA2, 0xB7, 
+0x24, 0xDC, 0x93, 0xF5, 0x73, 0xBF, 0x04, 0xDA, 
+0xE6, 0x4B, 0x89, 0xD3, 0x46, 0x83, 0x92, 0x74, 
+0x56, 0xE0, 0x97, 0x95, 0x8D, 0xAD, 0xC2, 0xCF, 
+0x27, 0xA1, 0x28, 0x4E, 0x8F, 0x71, 0xF4, 0x03, 
+0x72, 0x5E, 0x5E, 0xF5, 0x5E, 0xB9, 0xAF, 0xBA, 
+0xAE, 0xB7, 0x60, 0x6D, 0x31, 0x30, 0x45, 0x0F, 
+0x65, 0x7C, 0xFB, 0xC6, 0x4D, 0x27, 0x68, 0x6D, 
+0x9A, 0x61, 0x23, 0x11, 0x1A, 
+
+0xAF, 0x22, 0xCD, 0x98, 0x12, 0x64, 0xEF, 0x47, 
+0x84, 0x46, 0xF7, 0x4C, 0x61, 0xED, 0xBF, 0x15, 
+0xC5, 0xE1, 0x33, 0x4D, 0x61, 0x81, 0x34, 0x85, 
+0x18, 0x24, 0x67, 0x8B, 0x73, 0xB4, 0xF7, 0xC1, 
+0xA3, 0x51, 0x67, 0x4D, 0x4D, 0x7B, 0xEA, 0x18, 
+0x91, 0xD4, 0xF3, 0x35, 0xD1, 0xF2, 0x9F, 0x27, 
+0x23, 0xAF, 0x31, 0x52, 0xC8, 0xFC, 0x69, 0xCB, 
+0xE6, 0x4A, 0x32, 0x01, 0xA1, 0x97, 0x74, 0x9F, 
+0x9B, 0x1E, 0x73, 0xDB, 0x8C, 0x46, 0x02, 0x1B, 
+0xEC, 0x65, 0x28, 0x7D, 0x87, 0x1A, 0x0C, 0x21, 
+0xE0, 0x40, 0x68, 0x02, 0x1C, 0xE3, 0x37, 0xEF, 
+0xF3, 0x3A, 0x62, 0x0C, 0x73, 0xEF, 0x31, 0x11, 
+0x38, 0x8D, 0x79, 0xBD, 0xA2, 0x11, 0x8B, 0xC8, 
+0x25, 0x8B, 0x12, 0x25, 0x7A, 0x89, 0x00, 0xC9, 
+0xF2, 0xCC, 0xB0, 0x03, 0x00, 0x7B, 0xB6, 0x92, 
+0xD7, 0xAA, 0xC8, 0x9C, 0x8E, 0xBA, 0x43, 0xBE, 
+0xD9, 0x65, 0xE0, 0x3B, 0x93, 0xE0, 0x3E, 0xC2, 
+0x7E, 0x68, 0xED, 0xD3, 0xAF, 0x26, 0x48, 0x9D, 
+0x6B, 0x34, 0x42, 0x92, 0x0A, 0xE0, 0x09, 0x80, 
+0x55, 0xD0, 0x48, 0x5E, 0xB7, 0xDE, 0x40, 0xEC, 
+0xB5, 0x17, 0xDD, 0x90, 0xC2, 0x1C, 0xB7, 0xCC, 
+0x42, 0xA9, 0x74, 0xF5, 0x14, 0x55, 0xCB, 0xBA, 
+0x46, 0xD2, 0x6B, 0xAC, 0x9D, 0x72, 0x96, 0x94, 
+0x43, 0xFA, 0xC2, 0x6B, 0x1B, 0x55, 0xDC, 0x35, 
+0x82, 0x28, 0x02, 0x96, 0xBD, 0x56, 
+
+0x14, 0xEF, 0xF3, 0xAC, 0xD7, 0xDA, 0x07, 0x36, 
+0x11, 0x45, 0x0B, 0xF7, 0x05, 0x3B, 0x6E, 0x95, 
+0x32, 0x0B, 0x76, 0xC3, 0x58, 0x8D, 0x8B, 0x2E, 
+0x66, 0xD2, 0x77, 0x10, 0x12, 0x07, 0xFC, 0x99, 
+0xB6, 0x01, 0x00, 0x03, 0xC4, 0xAD, 0x01, 0x21, 
+0x02, 0x40, 0x3B, 0xC5, 0xB8, 0xC9, 0x53, 0x10, 
+0x32, 0xBA, 0x96, 0x1C, 0x8B, 0x91, 0x24, 0xF6, 
+0x89, 0xB4, 0xAF, 0xF0, 0xBD, 0x46, 0xCE, 0xA7, 
+0x57, 0xBB, 0x62, 0x36, 0xC8, 0x8C, 0x1C, 0x08, 
+0x77, 0x16, 0x99, 0x20, 0x7C, 0xFD, 0xD4, 0x30, 
+0x15, 0x85, 0xA8, 0x2B, 0x58, 0x68, 0xF4, 0x5B, 
+0x38, 0xAC, 0x51, 0x46, 0xB5, 0x1C, 0x4E, 0x26, 
+0xB9, 0x38, 0xD6, 0x2E, 0x52, 0xD5, 0xE8, 0x83, 
+0xA9, 0x64, 0xB3, 0x9E, 0xC0, 0x40, 0x30, 0x4F, 
+0xC4, 0xB6, 0xED, 0x81, 0xD1, 0x30, 0x83, 0x79, 
+0xF0, 0x6D, 0x38, 0xA0, 0x84, 0x38, 0xFA, 0xBB, 
+0x9E, 0xBE, 0x26, 0x65, 0x0F, 0x79, 0x8B, 0x68, 
+0xC5, 0x19, 0x26, 0xD6, 0x6E, 0xA0, 0x93, 0x75, 
+0xBC, 0xE1, 0x10, 0x54, 0x2A, 0x2D, 0x19, 0x5F, 
+0x07, 0x9E, 0x55, 0x57, 0x79, 0x38, 0xB2, 0x54, 
+0xB3, 0xC5, 0x3C, 0xA9, 0x3C, 0xBF, 0xAF, 0x7B, 
+0x7A, 0x8D, 0x5D, 0x56, 0x03, 0x8B, 0x3C, 0x11, 
+0x69, 0x8D, 0x6C, 0x0E, 0xFD, 0x7B, 0xFD, 0x2A, 
+0x23, 0xA4, 0xF0, 0xDD, 0xE4, 0xB2, 0x4D, 0xA1, 
+0x2A, 0x5E, 0xC7, 0x9F, 0x27, 0x0E, 0x12, 
+
+0xCC, 0x63, 0x58, 0x8C, 0xA9, 0xDE, 0xB8, 0x96, 
+0x2A, 0x5C, 0x18, 0x74, 0xF5, 0xB6, 0x81, 0x9A, 
+0xD3, 0x9A, 0xA4, 0x86, 0x5E, 0x13, 0x8C, 0xDF, 
+0x81, 0xD8, 0x54, 0x01, 0xC6, 0x17, 0x43, 0x03, 
+0xAE, 0xCE, 0x79, 0xD2, 0xD5, 0x62, 0xC3, 0x95, 
+0xAE, 0xF8, 0x87, 0x36, 0xEF, 0x74, 0xF4, 0x70, 
+0x41, 0x09, 0x78, 0xE0, 0x3A, 0x34, 0x77, 0xCC, 
+0xAC, 0x92, 0x93, 0x97, 0x23, 0xCE, 0x3C, 0x02, 
+0xB6, 0xA7, 0xEC, 0xBB, 0x33, 0x59, 0xCD, 0x9C, 
+0xF8, 0xD3, 0xA8, 0xF4, 0x12, 0x44, 0xC4, 0x74, 
+0xCF, 0x5D, 0x11, 0xC8, 0xA7, 0x03, 0x18, 0x2E, 
+0xB1, 0x9C, 0x55, 0x19, 0x92, 0x94, 0xDC, 0x39, 
+0x27, 0xB9, 0xED, 0x87, 0x9B, 0x74, 0xC0, 0x89, 
+0x9A, 0x63, 0x57, 0x94, 0xB4, 0xC1, 0xDE, 0x0E, 
+0x27, 0xAC, 0xF2, 0x5E, 0x3B, 0x4E, 0xC5, 0x79, 
+0x65, 0x82, 0x7F, 0x8D, 0x04, 0xB8, 0xC5, 0x67, 
+0x09, 0xC0, 0x27, 0xE1, 0x9A, 0x6B, 0xB4, 0x5E, 
+0x11, 0xA3, 0x97, 0x63, 0xF8, 0xE5, 0x03, 0x99, 
+0x6A, 0x9D, 0xCB, 0x66, 0x5C, 0x79, 0x08, 0xC0, 
+0x06, 0x05, 0x04, 0x4F, 0x15, 0x34, 0xE7, 0x71, 
+0x60, 0x39, 0x35, 0xD2, 0xA1, 0x5A, 0xA9, 0x9D, 
+0x48, 0x22, 0xBE, 0x9E, 0x72, 0xE4, 0xEC, 0x08, 
+0x31, 0xA6, 0x6B, 0x86, 0xC7, 0x69, 0xDD, 0x41, 
+0x8B, 0x28, 0x86, 0x91, 0x48, 0xB1, 0x9B, 0xFB, 
+0xA3, 0x78, 0x2D, 0xDC, 0x0F, 0xA5, 0x22, 0x69, 
+
+0x76, 0xF0, 0x32, 0x23, 0x68, 0x08, 0xFA, 0xB6, 
+0xA5, 0xB9, 0x93, 0xF3, 0xA1, 0x93, 0xF7, 0xA2, 
+0x38, 0x49, 0xE9, 0x20, 0x9C, 0xF8, 0x8F, 0x0C, 
+0x07, 0x91, 0xAD, 0x97, 0xE9, 0xA4, 0x82, 0x13, 
+0x08, 0x51, 0xF9, 0x88, 0xBA, 0xF9, 0xF2, 0x5C, 
+0x5D, 0x74, 0x37, 0x5B, 0xA6, 0x83, 0x70, 0xE9, 
+0x94, 0x44, 0x35, 0x30, 0x49, 0x81, 0x80, 0x7F, 
+0xF8, 0x40, 0x6B, 0x58, 0x68, 0xD2, 0xEF, 0x25, 
+0x28, 0x93, 0xDF, 0x49, 0xE5, 0x8D, 0xD5, 0xC8, 
+0xD5, 0x2D, 0xA4, 0x0F, 0x08, 0xB1, 0xF8, 0xC4, 
+0x35, 0x15, 0xE5, 0x4C, 0x34, 0x26, 0x55, 0x86, 
+0xAA, 0xCD, 0x50, 0x42, 0x79, 0x12, 0x41, 0xB6, 
+0x88, 0xD3, 0x94, 0x07, 0x8A, 0xE0, 0x06, 0xC0, 
+0x80, 0xC1, 0x14, 0x13, 0xB2, 0x05, 0x4E, 0x0F, 
+0x26, 0x27, 0xFB, 0xEC, 0x8D, 0x40, 0x87, 0x52, 
+0xCE, 0x29, 0x42, 0xD8, 0x69, 0xE9, 0x59, 0xFB, 
+0xA4, 0xBD, 0x57, 0x8F, 0x84, 0x1F, 0x0E, 0x08, 
+0xAC, 0x12, 0x02, 0xB4, 0x6E, 0x60, 0xA8, 0xA7, 
+0x5A, 0x54, 0x7D, 0xA0, 0x19, 0x0B, 0x24, 0xED, 
+0x79, 0x3D, 0x3C, 0xF3, 0x73, 0xB4, 0x5D, 0x9C, 
+0x11, 0x9F, 0x03, 0x84, 0xDD, 0x86, 0xAB, 0x7E, 
+0x90, 0x09, 0x74, 0x04, 0x1F, 0x94, 0xDA, 0x9A, 
+0x88, 0x04, 0xAA, 0x1B, 0xA6, 0x5B, 0x6A, 0x5C, 
+0x55, 0xDF, 0xCE, 0x34, 0x1C, 0x16, 0x7C, 0x6F, 
+0x95, 0x04, 0x01, 0xEB, 0xD9, 0xFA, 0x79, 0x62, 
+0x40, 
+
+0x34, 0x53, 0x5B, 0x5B, 0x16, 0x67, 0xC1, 0xF3, 
+0x93, 0x0C, 0xAF, 0x04, 0x86, 0xB6, 0xF0, 0xCE, 
+0x07, 0x8E, 0x9A, 0x09, 0xB7, 0x1D, 0xAB, 0x6C, 
+0xCF, 0x0E, 0xB0, 0xC9, 0x2E, 0xE7, 0xF2, 0xE9, 
+0xAC, 0x2E, 0x41, 0x84, 0xA2, 0xFC, 0x09, 0x26, 
+0xFD, 0xC9, 0x3C, 0x84, 0x8E, 0x1C, 0x7F, 0xB8, 
+0xE8, 0x68, 0x51, 0xDF, 0x22, 0x97, 0x61, 0x12, 
+0xFA, 0xB9, 0xFC, 0x03, 0xC6, 0x62, 0xBC, 0xB8, 
+0x35, 0xC6, 0xE3, 0xBE, 0x7B, 0x8D, 0x68, 0x0F, 
+0x1A, 0xF4, 0x20, 0x30, 0xEF, 0xD4, 0x6B, 0xF9, 
+0x72, 0xB9, 0x8E, 0x1D, 0xFE, 0x4D, 0x1E, 0x64, 
+0xB8, 0x9E, 0xEE, 0xCD, 0x38, 0xD8, 0xB9, 0x1E, 
+0x47, 0xC8, 0x1F, 0xD4, 0x54, 0x02, 0xBE, 0x7E, 
+0xBD, 0xED, 0xF2, 0x23, 0xFB, 0xE8, 0x4E, 0xCD, 
+0x50, 0x78, 0x4B, 0x13, 0x51, 0xC6, 0x4B, 0xE5, 
+0x2F, 0x32, 0x99, 0x5C, 0xAE, 0x2A, 0x9D, 0xB6, 
+0x94, 0x53, 0x3A, 0x5E, 0x8D, 0x7F, 0xD0, 0x35, 
+0x39, 0x9D, 0xB0, 0x04, 0x4C, 0x27, 0xC6, 0x9C, 
+0xC6, 0x58, 0xEA, 0x89, 0x79, 0xC2, 0x2F, 0x0B, 
+0xF1, 0xF4, 0x25, 0x62, 0xFC, 0x53, 0x38, 0xBA, 
+0x69, 0xCB, 0x66, 0x5F, 0x4E, 0xFC, 0xC9, 0x22, 
+0x7D, 0xC9, 0x3C, 0x6B, 0xAE, 0x24, 0x1A, 0x24, 
+0xBE, 0xB9, 0xD9, 0x6A, 0x38, 0x13, 0x84, 0x2A, 
+0x4C, 0x12, 0x67, 0xAE, 0xB9, 0xC1, 0x38, 0x72, 
+0x9C, 0x7E, 0xC2, 0xC0, 0xDC, 0xE2, 0x06, 0xD4, 
+0x1F, 0x2C, 
+
+0x69, 0x7B, 0x65, 0x82, 0x77, 0xB6, 0x41, 0xC8, 
+0x89, 0xC0, 0x16, 0x93, 0x55, 0xC6, 0x1F, 0x0F, 
+0xC0, 0x79, 0x13, 0x5E, 0x7E, 0x29, 0x19, 0x35, 
+0xAA, 0x40, 0x91, 0x26, 0xCE, 0x1F, 0x7E, 0xF6, 
+0xC3, 0xF7, 0x84, 0x5B, 0x5F, 0x66, 0x92, 0xE3, 
+0x85, 0x86, 0xE9, 0x45, 0x59, 0xA5, 0xA7, 0x1A, 
+0x42, 0x25, 0xE8, 0x50, 0x2F, 0x62, 0x27, 0x68, 
+0x9D, 0x1D, 0x05, 0x34, 0x6E, 0x2A, 0x9D, 0x3E, 
+0xB8, 0x8A, 0x9D, 0x8D, 0x59, 0xBC, 0xC3, 0xAA, 
+0x87, 0x24, 0xD0, 0x26, 0x53, 0xAE, 0xC7, 0xAB, 
+0x5E, 0xA9, 0xE8, 0xB1, 0xC2, 0xAE, 0x4C, 0x8E, 
+0x71, 0xD3, 0xFF, 0x78, 0xFD, 0x85, 0xDD, 0xA1, 
+0x16, 0x2D, 0x7D, 0x7A, 0xDD, 0x2D, 0x63, 0xC8, 
+0x68, 0x0F, 0x3F, 0x35, 0xE4, 0xC4, 0x13, 0x07, 
+0xDB, 0x3C, 0x48, 0x35, 0x16, 0xD1, 0xE4, 0xDC, 
+0x12, 0x93, 0x63, 0xC3, 0x5E, 0x61, 0x32, 0xC3, 
+0xF3, 0xDF, 0xBC, 0xB8, 0x0B, 0x92, 0x8A, 0x57, 
+0x0A, 0x60, 0x42, 0x45, 0xD7, 0x2A, 0xCD, 0x84, 
+0xBC, 0x62, 0xA0, 0x1B, 0xCF, 0xCA, 0x27, 0xFF, 
+0x9C, 0x9E, 0x6B, 0xE6, 0x32, 0x97, 0xF7, 0xD4, 
+0x94, 0x46, 0x4C, 0x94, 0xB5, 0x5A, 0x57, 0x44, 
+0x7C, 0x31, 0x6A, 0x65, 0xC0, 0xD0, 0xCF, 0x83, 
+0x56, 0xA3, 0x5F, 0x93, 0x44, 0x38, 0x51, 0x66, 
+0x5E, 0x03, 0x0D, 0x5F, 0x0D, 0x84, 0x5D, 0x6C, 
+0x90, 0xB8, 0xC4, 0x64, 0x89, 0x78, 0x15, 0xEC, 
+0x9B, 0xBE, 0x75, 
+
+0x61, 0xB6, 0x87, 0x2C, 0xDD, 0x7F, 0x47, 0xB0, 
+0x8F, 0x56, 0x51, 0xB5, 0x2B, 0x8B, 0xBE, 0x6E, 
+0xA1, 0xF6, 0xAA, 0x72, 0x93, 0x2B, 0x90, 0x91, 
+0xDE, 0xE8, 0xC0, 0x9E, 0xC1, 0x21, 0x5D, 0x75, 
+0x6E, 0xFC, 0xA0, 0xC3, 0x9F, 0xA7, 0xE1, 0x06, 
+0x1C, 0xA8, 0x05, 0x90, 0x1F, 0x00, 0xBE, 0x71, 
+0xEA, 0x1C, 0xA4, 0xCA, 0x46, 0xD5, 0xDC, 0xBD, 
+0x01, 0xC0, 0xCE, 0xDB, 0xBF, 0x6C, 0x05, 0xF2, 
+0xCF, 0xE0, 0x2B, 0xD0, 0x66, 0xCC, 0x5E, 0xFB, 
+0xC8, 0x4B, 0x93, 0x0B, 0xFA, 0xDF, 0xCD, 0x30, 
+0xE8, 0xC0, 0x82, 0x18, 0x31, 0x28, 0xFC, 0x19, 
+0x15, 0x51, 0xEE, 0x55, 0x76, 0x82, 0x38, 0x2A, 
+0x19, 0x3D, 0xDF, 0xC8, 0x51, 0x46, 0xA8, 0xE8, 
+0xEC, 0xE1, 0x78, 0x8C, 0x97, 0x37, 0xB8, 0x0A, 
+0x03, 0xAB, 0x53, 0x26, 0x4F, 0x1C, 0xFE, 0x43, 
+0xC2, 0x6C, 0xC2, 0x73, 0x27, 0x25, 0xCC, 0x20, 
+0xCB, 0xAA, 0x9D, 0xED, 0x51, 0xC5, 0x00, 0xDD, 
+0x1D, 0x38, 0x71, 0x94, 0xC0, 0x3C, 0x6E, 0x0D, 
+0x3E, 0x74, 0x7E, 0xB7, 0x7A, 0x19, 0x84, 0x22, 
+0x04, 0x3F, 0xFA, 0xBC, 0x3D, 0x99, 0x1F, 0x2E, 
+0xB4, 0xDF, 0x9D, 0xE4, 0x93, 0x5A, 0x1D, 0x41, 
+0x1B, 0xD3, 0x1D, 0x98, 0xBD, 0x62, 0x3E, 0x46, 
+0xE1, 0xAC, 0x33, 0x1A, 0xA6, 0x0A, 0xCB, 0x82, 
+0xCA, 0x53, 0xFA, 0x45, 0x17, 0x27, 0x1C, 0x31, 
+0xF0, 0x5B, 0xCF, 0xE7, 0x54, 0x2A, 0x75, 0xCD, 
+0xE2, 0x8E, 0x5F, 0x95, 
+
+0x03, 0x95, 0xEF, 0x61, 0x82, 0x3E, 0xCF, 0x43, 
+0x33, 0x85, 0x57, 0x10, 0xFB, 0xD7, 0x5F, 0xC6, 
+0x34, 0x46, 0xBD, 0x59, 0xC7, 0xCC, 0x50, 0x42, 
+0x86, 0x3F, 0x0F, 0x3D, 0xEB, 0x25, 0x56, 0x88, 
+0xA9, 0x8E, 0x4D, 0xEB, 0x6A, 0xB0, 0xBD, 0x64, 
+0x4F, 0x2A, 0x6E, 0xF6, 0xEA, 0xA1, 0xBC, 0x37, 
+0x2D, 0x4F, 0xBB, 0x22, 0xD9, 0x8C, 0x60, 0x76, 
+0x3F, 0xE4, 0xF9, 0x89, 0xE9, 0xAE, 0xA9, 0xDB, 
+0xAA, 0x16, 0x79, 0x12, 0xFD, 0xBB, 0x22, 0x35, 
+0xE0, 0xC3, 0x92, 0xA4, 0x84, 0x30, 0xC3, 0x2F, 
+0x41, 0x19, 0x42, 0xA8, 0xCE, 0x7D, 0x4A, 0xAF, 
+0x32, 0xEA, 0x37, 0x9D, 0xDD, 0xEC, 0x0D, 0x04, 
+0xD7, 0xF2, 0xAB, 0x16, 0xDC, 0xF6, 0x6F, 0xAC, 
+0x71, 0x26, 0x4D, 0x8E, 0xA9, 0x07, 0x62, 0x3F, 
+0x6B, 0xC3, 0x8F, 0x08, 0xBD, 0xDF, 0x9B, 0x2E, 
+0x44, 0x9D, 0x72, 0xEF, 0x27, 0x26, 0x77, 0x7A, 
+0xB5, 0xB1, 0x20, 0xC9, 0x1D, 0xA8, 0x66, 0x98, 
+0xB6, 0x11, 0xD9, 0xB3, 0x75, 0xBC, 0x1A, 0xBB, 
+0x1A, 0x16, 0x19, 0x47, 0x79, 0x3F, 0x9A, 0x27, 
+0x0E, 0xB7, 0xFE, 0x76, 0x40, 0x90, 0x6B, 0x49, 
+0xF9, 0xF7, 0xB6, 0x1E, 0x83, 0x8F, 0xFF, 0x0B, 
+0x9E, 0xE0, 0x81, 0x6D, 0xE3, 0x27, 0x2F, 0x68, 
+0xD1, 0x6E, 0xA1, 0x46, 0x4F, 0x38, 0xB5, 0x75, 
+0xD1, 0xC7, 0xD0, 0x51, 0x96, 0x00, 0x4F, 0x13, 
+0x10, 0x6B, 0xE3, 0xFA, 0xD8, 0x9B, 0xE1, 0xA7, 
+0x02, 0x59, 0xB0, 0x2B, 0xA6, 
+
+0xFE, 0x6A, 0x6F, 0x35, 0xE9, 0x5F, 0x9D, 0x7C, 
+0x7F, 0x3E, 0x7A, 0x0B, 0xA4, 0x99, 0xB0, 0xE8, 
+0xBE, 0xF8, 0x58, 0x69, 0x14, 0xD7, 0xCD, 0xCA, 
+0xEF, 0x1F, 0xD1, 0xF3, 0x67, 0xBB, 0xD1, 0xB3, 
+0x25, 0x35, 0x18, 0x28, 0xF0, 0x60, 0x0B, 0x72, 
+0x45, 0xB4, 0x04, 0x0D, 0xDC, 0x52, 0xF3, 0xC7, 
+0x21, 0xD4, 0x15, 0x2C, 0x46, 0xC2, 0xDF, 0x10, 
+0x85, 0xF6, 0xE5, 0xB1, 0xA4, 0x34, 0xF4, 0x76, 
+0x77, 0x52, 0xA3, 0x00, 0x19, 0x2B, 0xEF, 0x26, 
+0x38, 0x9C, 0xB7, 0x10, 0x14, 0xF5, 0xC1, 0x46, 
+0xE3, 0xBF, 0x0A, 0xB4, 0x5E, 0x2B, 0xEB, 0xBB, 
+0x72, 0xB4, 0xB7, 0x49, 0xA4, 0xCD, 0x8B, 0x12, 
+0x2E, 0xE1, 0xF1, 0x89, 0x1D, 0x63, 0x34, 0x04, 
+0xE7, 0x1B, 0x25, 0xE3, 0x26, 0xEA, 0xC8, 0x63, 
+0xE0, 0x0D, 0x5A, 0x06, 0x81, 0x4A, 0xB3, 0x49, 
+0x51, 0xFA, 0xA5, 0xE1, 0x1B, 0x8F, 0xC8, 0x18, 
+0xF6, 0x49, 0xA6, 0x76, 0x23, 0xA2, 0x6F, 0xA6, 
+0x80, 0x1C, 0xE1, 0x20, 0x95, 0xD7, 0x8F, 0x37, 
+0x3A, 0x88, 0x83, 0x2F, 0x31, 0x1C, 0xC7, 0xD6, 
+0xB9, 0x2C, 0x7D, 0x7A, 0xA0, 0x42, 0x98, 0x4F, 
+0x47, 0xF1, 0xBF, 0x62, 0xA9, 0x4D, 0x3C, 0x09, 
+0x19, 0xD2, 0x3E, 0x41, 0xBE, 0xC6, 0x7B, 0x39, 
+0xC5, 0xA6, 0x04, 0x5F, 0xEA, 0x7B, 0x97, 0x87, 
+0xAF, 0xE7, 0xE5, 0xA3, 0xEA, 0xAD, 0xBA, 0x4D, 
+0x96, 0x3A, 0xC1, 0x55, 0xBC, 0x6F, 0xDC, 0xAA, 
+0x42, 0xBB, 0x7F, 0xBD, 0xD4, 0xC9, 
+
+0x76, 0x80, 0xBB, 0xF0, 0x5F, 0xBD, 0x83, 0x1D, 
+0xCA, 0xD8, 0x1B, 0x71, 0x0B, 0xA4, 0x4E, 0x15, 
+0x42, 0xC7, 0x66, 0x58, 0xAA, 0xFB, 0x0E, 0x87, 
+0x41, 0x70, 0x13, 0xCE, 0x6F, 0xC4, 0xAA, 0x15, 
+0x29, 0x0A, 0x4C, 0xBD, 0x8A, 0xE5, 0xF0, 0x61, 
+0xED, 0x13, 0x50, 0x10, 0x0E, 0x43, 0x7E, 0x31, 
+0x0F, 0xE9, 0x56, 0x72, 0x97, 0xB6, 0xB4, 0x24, 
+0xF4, 0xAB, 0xEF, 0x5E, 0x52, 0xDA, 0xFA, 0xBD, 
+0x85, 0xC1, 0xDE, 0x97, 0x42, 0x94, 0x4D, 0x1F, 
+0x90, 0xE6, 0xE2, 0x20, 0x02, 0xDA, 0xC0, 0x3C, 
+0x42, 0xAD, 0x39, 0x65, 0xE3, 0x71, 0xA7, 0xAD, 
+0x58, 0x08, 0x47, 0xA7, 0xBC, 0x43, 0x23, 0xB6, 
+0xE1, 0x61, 0x89, 0xD7, 0xCB, 0xEE, 0x43, 0x01, 
+0x44, 0xD4, 0x7C, 0x01, 0xD0, 0x8A, 0x0B, 0xD3, 
+0x8F, 0xD2, 0x9B, 0x1F, 0x16, 0xBF, 0x64, 0x73, 
+0xCE, 0xF0, 0x0A, 0xBD, 0xFD, 0x8D, 0x57, 0xFE, 
+0xDE, 0x2F, 0xC9, 0x59, 0xAF, 0x93, 0x14, 0x1F, 
+0xAC, 0x42, 0x4A, 0xAC, 0xD4, 0x76, 0x14, 0x42, 
+0x8E, 0x96, 0x7F, 0x27, 0xEA, 0x72, 0x9D, 0x81, 
+0x2A, 0x85, 0xC0, 0x4B, 0xB8, 0xEB, 0x23, 0x08, 
+0x4B, 0x49, 0xA0, 0x3B, 0x83, 0x45, 0xEE, 0x6C, 
+0x18, 0x5C, 0x1D, 0x8F, 0xBD, 0x54, 0xE0, 0x49, 
+0xF9, 0xFB, 0x41, 0xF5, 0x15, 0xB4, 0xFB, 0x9A, 
+0xAF, 0x4D, 0x83, 0x50, 0x4F, 0xC9, 0x96, 0xAC, 
+0x53, 0x29, 0x64, 0x3C, 0xEF, 0xFA, 0xFC, 0xAD, 
+0xD7, 0x37, 0xCE, 0xF9, 0x01, 0x6E, 0x04, 
+
+0x71, 0xE7, 0x07, 0x82, 0x19, 0x3A, 0x33, 0xFB, 
+0x5D, 0xB4, 0x9F, 0x59, 0x43, 0x83, 0x8A, 0xDC, 
+0xD5, 0xD7, 0x43, 0x4D, 0x88, 0xD2, 0x79, 0x06, 
+0x7A, 0x0E, 0x40, 0xD5, 0x31, 0x4A, 0x08, 0xDC, 
+0xE2, 0xE4, 0x42, 0xF0, 0x17, 0x13, 0x9B, 0x9C, 
+0x1A, 0x36, 0x62, 0x9A, 0xEA, 0xEC, 0xB8, 0x08, 
+0x2B, 0x2A, 0xED, 0x2F, 0x1D, 0x8D, 0xA1, 0x20, 
+0x20, 0x24, 0x65, 0x5C, 0x32, 0xAE, 0x9E, 0x45, 
+0x69, 0x3D, 0x6E, 0x7C, 0x4A, 0x91, 0xBF, 0x05, 
+0x58, 0xC1, 0xA0, 0x3B, 0x4D, 0x9B, 0xB0, 0x94, 
+0x78, 0x50, 0xA6, 0x30, 0xD5, 0x56, 0xF3, 0x0A, 
+0x8C, 0xB1, 0x88, 0x0F, 0xD2, 0x1B, 0xD0, 0x68, 
+0x26, 0x8D, 0xBA, 0x29, 0xA4, 0x87, 0x95, 0xCC, 
+0xEA, 0x6D, 0xAA, 0x12, 0xF3, 0x77, 0xB5, 0xE8, 
+0x12, 0x3B, 0x6B, 0x0A, 0xE4, 0x62, 0x3A, 0xFC, 
+0xCB, 0xCB, 0xA3, 0x83, 0x82, 0x43, 0xA8, 0xAC, 
+0x54, 0x88, 0xBA, 0xEF, 0x69, 0xA6, 0x36, 0x86, 
+0xF8, 0x4A, 0xD2, 0x05, 0xA0, 0xE0, 0x6D, 0x27, 
+0xA5, 0x22, 0x5A, 0x3C, 0x5F, 0xA5, 0x62, 0x88, 
+0x4D, 0x0A, 0x20, 0xFA, 0x04, 0x3C, 0xF0, 0x99, 
+0xE0, 0xD4, 0xAC, 0xD7, 0xC1, 0xE2, 0x5D, 0xAF, 
+0x50, 0x0B, 0x20, 0x15, 0xC8, 0x9D, 0xAA, 0x1E, 
+0x57, 0x3F, 0xD8, 0x1C, 0x0A, 0xC3, 0x9D, 0x64, 
+0xBB, 0xA1, 0x95, 0x5C, 0x53, 0x74, 0x42, 0x34, 
+0x54, 0xC8, 0x1B, 0x99, 0x14, 0x17, 0x04, 0xC2, 
+0x33, 0xBC, 0x12, 0x04, 0xCB, 0xC2, 0xCD, 0x93, 
+
+0xCA, 0x3F, 0x93, 0x8D, 0xE7, 0x1E, 0x43, 0xC1, 
+0x84, 0xA4, 0xB8, 0x0D, 0xEF, 0x34, 0xFA, 0xF4, 
+0x97, 0xE2, 0x84, 0x7D, 0xA0, 0x3D, 0x68, 0xD4, 
+0xD8, 0x49, 0x47, 0x61, 0x77, 0x26, 0xA4, 0x3D, 
+0x7B, 0x4E, 0xF2, 0x95, 0xD7, 0x57, 0x40, 0xB5, 
+0x85, 0xC4, 0xB7, 0x97, 0x71, 0x75, 0x1F, 0x50, 
+0x56, 0xBC, 0xF7, 0x8D, 0x95, 0x26, 0x1B, 0x95, 
+0x56, 0x6B, 0x48, 0x23, 0xC3, 0xC9, 0x73, 0xF6, 
+0xFD, 0xCA, 0x36, 0x19, 0xAD, 0x47, 0x4A, 0x4B, 
+0x52, 0x8D, 0xCD, 0xA4, 0x17, 0xE0, 0xB0, 0x72, 
+0x9E, 0xC5, 0x5E, 0x35, 0xA1, 0x99, 0x67, 0xCA, 
+0x7A, 0x1A, 0xEB, 0x68, 0x19, 0x49, 0xD1, 0x0B, 
+0xE5, 0x8F, 0x5E, 0x0C, 0xB8, 0x23, 0xC5, 0x8C, 
+0x47, 0x79, 0xF7, 0xCB, 0xB1, 0x35, 0x02, 0x28, 
+0x18, 0xE9, 0x39, 0x89, 0xE0, 0x36, 0xDC, 0xF1, 
+0x87, 0x4E, 0x63, 0x43, 0xF2, 0x53, 0x8F, 0x4E, 
+0x4A, 0xE6, 0x33, 0x45, 0xE7, 0x54, 0x23, 0xE5, 
+0xA8, 0x1F, 0x30, 0xC7, 0x40, 0x3A, 0xA8, 0x54, 
+0x2C, 0xC5, 0x91, 0xA1, 0x65, 0x58, 0xE2, 0x12, 
+0x0C, 0x5D, 0x1A, 0x7E, 0x6B, 0xD0, 0x9F, 0xB9, 
+0x2E, 0xF7, 0x1F, 0x10, 0xE6, 0x05, 0x5E, 0xA6, 
+0x06, 0x99, 0x0D, 0x8F, 0xDD, 0x7E, 0xA4, 0xBB, 
+0xBB, 0x61, 0xDF, 0xBD, 0x62, 0xF0, 0xB9, 0xD4, 
+0x3B, 0x87, 0x32, 0x64, 0x50, 0xCA, 0x17, 0x97, 
+0x79, 0x11, 0x03, 0x86, 0xF9, 0x20, 0x67, 0xA0, 
+0xA3, 0x93, 0xB4, 0xDC, 0x69, 0x58, 0x6F, 0xF8, 
+0x76, 
+
+0x1F, 0x40, 0x58, 0x7F, 0x62, 0xE9, 0xFA, 0x8F, 
+0xCA, 0xD3, 0x04, 0x4A, 0x2B, 0x1D, 0x64, 0xDE, 
+0x1D, 0x2E, 0x4F, 0xCE, 0xEE, 0x0D, 0x46, 0x99, 
+0x79, 0x58, 0x73, 0x5A, 0x4C, 0x0F, 0x2B, 0x97, 
+0x90, 0x82, 0x1A, 0xA6, 0x60, 0x3C, 0xB8, 0xCD, 
+0xCE, 0x63, 0xF2, 0x7D, 0xB1, 0x35, 0xCB, 0x74, 
+0xA6, 0xB5, 0x8E, 0x49, 0xFD, 0xE3, 0x80, 0xEB, 
+0xC8, 0xB5, 0x42, 0x72, 0xF6, 0xD0, 0x6E, 0x91, 
+0xCA, 0xC1, 0x37, 0xC3, 0xA6, 0x64, 0x8F, 0xB8, 
+0xF9, 0x47, 0x20, 0xFD, 0x8C, 0x22, 0x27, 0xB3, 
+0x61, 0xFA, 0xFD, 0xAA, 0xBA, 0xA1, 0xFE, 0x9F, 
+0xC4, 0xE4, 0x38, 0x63, 0x28, 0x1F, 0xFF, 0x4A, 
+0x15, 0x06, 0xD8, 0xA3, 0xE5, 0x51, 0x04, 0xBF, 
+0x5D, 0xB3, 0xDF, 0x61, 0xB8, 0x28, 0x85, 0x84, 
+0x04, 0x45, 0xDB, 0xDD, 0x07, 0x4D, 0x0B, 0xC1, 
+0xF9, 0x9B, 0x1F, 0xB4, 0xFE, 0x8E, 0xAB, 0x27, 
+0x82, 0x71, 0xC7, 0x1B, 0xA9, 0x33, 0x27, 0xD7, 
+0x84, 0xEC, 0x99, 0x58, 0x78, 0x77, 0x94, 0x3A, 
+0xEE, 0xD0, 0x21, 0x9B, 0x5F, 0x90, 0x68, 0x5E, 
+0x03, 0xBF, 0xAB, 0xD1, 0x06, 0x4D, 0x77, 0xF6, 
+0xF4, 0x1C, 0x7C, 0x47, 0xAD, 0x77, 0x74, 0x0C, 
+0x62, 0x37, 0x2C, 0xD8, 0x47, 0xE8, 0x7C, 0x96, 
+0xED, 0xC9, 0x63, 0xCF, 0x83, 0xCC, 0x9B, 0x8C, 
+0xA3, 0xEF, 0x6A, 0xDB, 0x6A, 0x38, 0x2D, 0x76, 
+0x76, 0xB5, 0x1A, 0x25, 0xAA, 0x75, 0x15, 0x15, 
+0x0E, 0xED, 0xF2, 0x9B, 0xEC, 0x78, 0xED, 0x2A, 
+0xB9, 0x0C, 
+
+0xBA, 0x27, 0xEC, 0xE5, 0x68, 0xD7, 0x8F, 0xA5, 
+0x45, 0xA4, 0x7B, 0x8E, 0x3E, 0x50, 0x5F, 0x38, 
+0x0E, 0x48, 0xF9, 0x44, 0x27, 0x4D, 0xC2, 0xA4, 
+0x8D, 0x64, 0xC7, 0x61, 0x85, 0xAD, 0x1F, 0xC4, 
+0x85, 0xFD, 0xB6, 0xB5, 0x12, 0xE1, 0x98, 0x78, 
+0x80, 0x56, 0x58, 0x43, 0x16, 0x87, 0xBA, 0x2A, 
+0xD5, 0x56, 0x8B, 0x98, 0xA1, 0x81, 0x82, 0x03, 
+0x95, 0x72, 0x4F, 0x73, 0x98, 0x00, 0x73, 0x57, 
+0xB4, 0x05, 0x8A, 0xFB, 0xFC, 0x14, 0xCC, 0xB4, 
+0x74, 0x8B, 0x15, 0xE1, 0x28, 0x6D, 0x6D, 0x86, 
+0xAD, 0x6E, 0x16, 0x1C, 0x38, 0x56, 0x84, 0x3C, 
+0x59, 0x2E, 0x57, 0xFE, 0xD9, 0xA2, 0x65, 0xCC, 
+0xF3, 0xBE, 0xB5, 0xB7, 0xD3, 0x96, 0xB9, 0x12, 
+0x37, 0x98, 0xBF, 0x6D, 0x7E, 0xC4, 0xE9, 0x55, 
+0x51, 0x57, 0xA7, 0x83, 0xD8, 0x5E, 0xBA, 0x67, 
+0x29, 0xE2, 0x9C, 0x6B, 0xAD, 0xC1, 0xCA, 0x9E, 
+0x21, 0x5E, 0x7F, 0x98, 0x12, 0xDD, 0x79, 0xBE, 
+0x79, 0xA2, 0x87, 0x71, 0x07, 0x40, 0xE0, 0x2C, 
+0x10, 0x36, 0x57, 0x8A, 0xF9, 0x1D, 0xC5, 0x7C, 
+0x38, 0xB0, 0xFD, 0x94, 0x53, 0x3A, 0xFD, 0x5D, 
+0x66, 0x03, 0xDC, 0x2B, 0xFF, 0xB2, 0x10, 0xD6, 
+0xC2, 0x17, 0x5D, 0x65, 0xB2, 0xEB, 0xF2, 0x40, 
+0x69, 0xC6, 0x91, 0xA1, 0xD4, 0xE4, 0xA7, 0x00, 
+0xF7, 0x8A, 0xD2, 0x78, 0xF6, 0xCF, 0xCA, 0xD1, 
+0x23, 0xAB, 0x91, 0x7E, 0xF9, 0xBD, 0x53, 0x55, 
+0xD3, 0x3D, 0xBB, 0x35, 0x2E, 0x6A, 0xCB, 0xB9, 
+0xF8, 0x97, 0x9D, 
+
+0xD6, 0x9A, 0xC3, 0x3A, 0x82, 0x00, 0xFD, 0x1D, 
+0x29, 0x7A, 0x17, 0x57, 0xC7, 0x5C, 0xDA, 0x87, 
+0x50, 0xA9, 0x40, 0x0D, 0x2C, 0x9A, 0x2E, 0x32, 
+0x1F, 0x3F, 0x1B, 0x0C, 0xF7, 0x4A, 0x68, 0x4F, 
+0xC4, 0x34, 0xB0, 0x81, 0x3A, 0x2E, 0xB2, 0x6D, 
+0x08, 0x57, 0xD7, 0xAF, 0x29, 0xC4, 0xCF, 0x5A, 
+0xF8, 0xCE, 0xB1, 0x60, 0xA3, 0x86, 0x7A, 0x4E, 
+0x64, 0x67, 0x18, 0x0A, 0x5C, 0x20, 0xF1, 0x37, 
+0x70, 0x13, 0x87, 0xA4, 0xA8, 0xE4, 0xA7, 0xB2, 
+0x11, 0x4C, 0xA8, 0xE1, 0xF5, 0x6F, 0xB5, 0x5D, 
+0xDD, 0x43, 0xAB, 0x13, 0x19, 0xCF, 0xF5, 0xA4, 
+0xF8, 0xF6, 0x24, 0x90, 0x36, 0x8E, 0x14, 0x8A, 
+0xB8, 0x69, 0x5D, 0x21, 0xFE, 0xEA, 0x19, 0x47, 
+0xEC, 0x43, 0xDD, 0x26, 0x8E, 0x79, 0x3B, 0xBA, 
+0x44, 0xE0, 0xB7, 0x20, 0x3C, 0xC2, 0x19, 0x65, 
+0x6D, 0xC0, 0xA3, 0xA8, 0xB1, 0x74, 0x42, 0x3B, 
+0xF8, 0xB2, 0xFB, 0xA4, 0x60, 0xB5, 0xC2, 0x57, 
+0xE0, 0xE9, 0x0D, 0x4F, 0xB9, 0xF6, 0x00, 0xDD, 
+0x30, 0x33, 0x05, 0x6C, 0xF2, 0x54, 0x06, 0x55, 
+0xBE, 0xD9, 0x1B, 0x35, 0xE4, 0xC9, 0x7E, 0x0A, 
+0x5A, 0x96, 0x1B, 0x52, 0x49, 0x92, 0x02, 0x78, 
+0xB7, 0x11, 0xEE, 0x6F, 0xD5, 0xC5, 0x29, 0x55, 
+0x62, 0xB1, 0xC5, 0x4B, 0x97, 0x32, 0x30, 0x40, 
+0x09, 0x90, 0xBB, 0x8A, 0x84, 0x07, 0x5B, 0x19, 
+0x3B, 0x65, 0xF7, 0xF9, 0xF6, 0x01, 0x91, 0xA4, 
+0xC9, 0x4B, 0x31, 0x83, 0x5D, 0x8B, 0x10, 0x0C, 
+0xA9, 0x97, 0x80, 0x8C, 
+
+0x03, 0xAF, 0x2F, 0x43, 0xBD, 0x4B, 0x4E, 0x2D, 
+0xC2, 0xDB, 0x2F, 0xD6, 0x57, 0xC2, 0x55, 0x8B, 
+0xE4, 0x54, 0x13, 0xD8, 0x7D, 0xB5, 0x95, 0x1B, 
+0xD3, 0x88, 0x04, 0xF0, 0xD1, 0xFC, 0x84, 0xE3, 
+0xF2, 0x17, 0xB4, 0xFB, 0x11, 0x89, 0x0B, 0xA0, 
+0xD2, 0x5D, 0x9F, 0x73, 0x56, 0x35, 0x94, 0x64, 
+0x24, 0xFC, 0x59, 0x4D, 0xCE, 0xC3, 0x03, 0x57, 
+0xA6, 0x5A, 0x16, 0xDD, 0x2E, 0x0D, 0xBE, 0xD6, 
+0xFA, 0xEE, 0x8F, 0xFC, 0xC7, 0x47, 0xB4, 0x1C, 
+0x63, 0x2A, 0xAA, 0x40, 0xC7, 0x98, 0xDF, 0x10, 
+0xD5, 0x17, 0x7F, 0x5B, 0xE3, 0x01, 0x61, 0x67, 
+0x03, 0x2D, 0xAD, 0x7E, 0x26, 0x76, 0xA1, 0x48, 
+0x30, 0x9D, 0xF3, 0x89, 0x7B, 0x30, 0x74, 0x25, 
+0x4B, 0x19, 0xA5, 0x80, 0x4A, 0xFB, 0xE3, 0xCA, 
+0xD6, 0x4E, 0xC2, 0x0C, 0xA5, 0xFC, 0x8A, 0xC1, 
+0x2A, 0x41, 0xBF, 0x06, 0xD9, 0x91, 0xF4, 0xCF, 
+0xE1, 0x28, 0x74, 0x95, 0x81, 0x90, 0x0B, 0xDC, 
+0xF3, 0x17, 0xE9, 0xA8, 0xFF, 0xB7, 0x86, 0x4C, 
+0x2A, 0xE9, 0x4A, 0x2B, 0x39, 0xD2, 0xB2, 0x76, 
+0xDB, 0x58, 0x5B, 0x60, 0x6D, 0xB9, 0x4F, 0xA9, 
+0x21, 0xAB, 0x02, 0xBC, 0x08, 0x3F, 0x81, 0x00, 
+0xAC, 0x5C, 0x58, 0xF8, 0xAF, 0x19, 0xF9, 0x60, 
+0x2D, 0xCD, 0x74, 0xAE, 0xB2, 0xAC, 0x7B, 0xC8, 
+0xFC, 0x9A, 0xF0, 0x1A, 0x69, 0x2F, 0x44, 0x1A, 
+0xEF, 0x4B, 0x71, 0xC0, 0x79, 0xD7, 0xC8, 0xB2, 
+0x5D, 0xCA, 0x81, 0x6C, 0x2B, 0x62, 0x73, 0x76, 
+0xD0, 0xD8, 0x05, 0xBC, 0x5D, 
+
+0x98, 0xFC, 0x75, 0x2E, 0x6F, 0xDF, 0x98, 0x48, 
+0xC6, 0xE6, 0xD1, 0x8E, 0xC5, 0x3D, 0x68, 0xB5, 
+0x3B, 0xC3, 0x0C, 0x21, 0xA1, 0xE4, 0x8B, 0x7C, 
+0xF3, 0x1E, 0xEC, 0x12, 0x59, 0x7A, 0x2D, 0x59, 
+0xD7, 0xB3, 0x04, 0x25, 0xA4, 0xE5, 0x7D, 0xB5, 
+0xB1, 0x41, 0xD6, 0x47, 0x3F, 0x1F, 0xD0, 0x0E, 
+0x40, 0xF3, 0x3E, 0x18, 0x96, 0xAC, 0x07, 0x1E, 
+0xCF, 0x71, 0x13, 0xA0, 0x11, 0x7B, 0x09, 0xFE, 
+0xB0, 0x74, 0x19, 0x4C, 0x31, 0x22, 0x27, 0x96, 
+0xA2, 0x1D, 0x94, 0x3D, 0x88, 0x17, 0x11, 0xEF, 
+0xC1, 0x58, 0xCB, 0xAC, 0x63, 0x88, 0xB8, 0x02, 
+0xB5, 0x2B, 0x1D, 0x13, 0x66, 0x89, 0x7C, 0x88, 
+0x39, 0xE8, 0x43, 0x7B, 0xB7, 0x82, 0x44, 0xE5, 
+0xE1, 0xDE, 0x7D, 0x89, 0xC2, 0x48, 0xBD, 0x05, 
+0x78, 0x15, 0x29, 0xAF, 0x68, 0x46, 0x28, 0xAC, 
+0x1A, 0xFB, 0xC1, 0xFC, 0x50, 0x6E, 0xB7, 0x22, 
+0xC4, 0x4C, 0x42, 0xF4, 0xB8, 0xFF, 0xBE, 0x33, 
+0x59, 0x91, 0x0F, 0x41, 0x7C, 0x66, 0x49, 0x32, 
+0x62, 0x80, 0x71, 0xA5, 0x7F, 0x3F, 0xA2, 0x67, 
+0x0E, 0x45, 0xA5, 0xD0, 0xFF, 0xA2, 0x72, 0xAE, 
+0x2C, 0xE6, 0xA8, 0x25, 0x5D, 0xDE, 0x99, 0x58, 
+0xB3, 0x83, 0xC4, 0x59, 0xE9, 0x19, 0xBE, 0x71, 
+0x31, 0x7E, 0x07, 0x5C, 0x08, 0x64, 0xC3, 0xC2, 
+0x38, 0x07, 0xB1, 0x36, 0x9E, 0x86, 0x25, 0x02, 
+0xE5, 0x92, 0x01, 0xE5, 0x77, 0x05, 0x64, 0x45, 
+0x9C, 0xDC, 0x3F, 0x34, 0x44, 0xD8, 0x9F, 0x4A, 
+0x1F, 0x5D, 0xFE, 0xE1, 0x71, 0xBC, 
+
+0xA7, 0xE2, 0x4A, 0xD2, 0x38, 0xB1, 0x34, 0x40, 
+0x86, 0xB0, 0x07, 0xF4, 0xE7, 0xA9, 0x65, 0xC8, 
+0x79, 0xA0, 0xA0, 0x24, 0xEE, 0x1D, 0x9D, 0x8E, 
+0x8D, 0x9C, 0x55, 0x61, 0x98, 0xA4, 0x96, 0x90, 
+0xF1, 0x9B, 0xF6, 0x42, 0xE1, 0x4A, 0xA5, 0xA4, 
+0x16, 0xF8, 0xA9, 0x77, 0x3B, 0x0F, 0x40, 0x0F, 
+0xC7, 0x23, 0x94, 0xF1, 0x3B, 0x1F, 0x8E, 0x1F, 
+0x01, 0x78, 0x82, 0x2C, 0x95, 0xAF, 0x3A, 0x83, 
+0xF7, 0x37, 0xCD, 0x55, 0x96, 0x62, 0x58, 0x1F, 
+0x53, 0xC8, 0x79, 0xED, 0x9F, 0xBC, 0x8B, 0x32, 
+0x9D, 0xC3, 0xE7, 0x4C, 0xC3, 0x30, 0x79, 0x19, 
+0x7B, 0xA1, 0xFB, 0x8E, 0x52, 0x87, 0x1C, 0x2D, 
+0x8C, 0x76, 0xA1, 0xA0, 0x3E, 0xC8, 0x4A, 0x7C, 
+0x4E, 0xFD, 0x46, 0x4F, 0x88, 0x9E, 0x94, 0x67, 
+0x5F, 0xFA, 0x80, 0x33, 0x23, 0xB4, 0x49, 0xE4, 
+0x5F, 0xF4, 0xBA, 0x20, 0x90, 0xC1, 0x45, 0x98, 
+0x57, 0x18, 0x09, 0x93, 0x93, 0x03, 0x5A, 0x87, 
+0xC6, 0xEB, 0x95, 0xB4, 0x25, 0x92, 0xF5, 0xF5, 
+0x6A, 0x04, 0x71, 0xAC, 0xCF, 0x15, 0x2B, 0xEC, 
+0x21, 0xB7, 0x75, 0x9E, 0x9F, 0x3F, 0xB4, 0x8A, 
+0x1C, 0xB9, 0xCA, 0xED, 0xBC, 0xCB, 0x2D, 0xDF, 
+0xDA, 0xF7, 0x59, 0x32, 0x4A, 0x8A, 0x2C, 0xFC, 
+0x9A, 0x14, 0x66, 0xE7, 0x2A, 0x3B, 0x07, 0x24, 
+0x1B, 0x5B, 0xA3, 0x1B, 0xD7, 0xA2, 0x3E, 0x71, 
+0xE0, 0xE4, 0x95, 0xD2, 0x73, 0xB5, 0x1F, 0xC3, 
+0x4A, 0x08, 0x45, 0x9E, 0x06, 0xCC, 0x2B, 0xB9, 
+0x42, 0xD2, 0xE4, 0x99, 0x9D, 0xC5, 0x3E, 
+
+0x1E, 0x39, 0x5A, 0x6D, 0x3F, 0x75, 0x7C, 0xAD, 
+0x86, 0xBF, 0xBD, 0x50, 0x71, 0x8D, 0x00, 0x21, 
+0x9D, 0x71, 0x65, 0x51, 0x99, 0x8C, 0x40, 0xC6, 
+0xAF, 0xA9, 0x82, 0xDF, 0xB7, 0xA2, 0xF3, 0x01, 
+0xA2, 0xA3, 0xF4, 0x93, 0x87, 0x46, 0x0B, 0xE5, 
+0x47, 0x56, 0xEA, 0x64, 0xAA, 0x94, 0xDC, 0x85, 
+0x51, 0xA5, 0xC7, 0x15, 0x15, 0x71, 0x12, 0xE5, 
+0x8A, 0x20, 0xBE, 0x21, 0x0A, 0x3A, 0x0C, 0xCA, 
+0x16, 0x21, 0x0F, 0x16, 0xC3, 0xBC, 0x3E, 0xE1, 
+0x30, 0x8D, 0x93, 0x50, 0xCF, 0xDE, 0xED, 0xB9, 
+0xFB, 0xB6, 0x83, 0xED, 0x6C, 0xC0, 0x7F, 0x0B, 
+0x4C, 0xDB, 0x6B, 0xFF, 0x5A, 0x9B, 0x39, 0xB9, 
+0x15, 0x12, 0x2F, 0xF1, 0x60, 0xA6, 0xEE, 0xF2, 
+0x22, 0x68, 0xC3, 0x1F, 0x32, 0xC8, 0x04, 0x93, 
+0xBD, 0xD6, 0x1B, 0xCB, 0x48, 0x16, 0x6E, 0xC7, 
+0x47, 0xD1, 0x3D, 0xD9, 0xEE, 0x86, 0xD0, 0xF7, 
+0x03, 0x1F, 0x28, 0xF0, 0xA1, 0xB9, 0x5A, 0x5A, 
+0x87, 0x39, 0xF9, 0x4F, 0x7A, 0x73, 0x61, 0xCC, 
+0xAA, 0x29, 0x6C, 0x29, 0x07, 0xB5, 0x18, 0x2A, 
+0xF5, 0x5B, 0x7E, 0x7A, 0x0B, 0xDA, 0xCF, 0xAD, 
+0x1E, 0x11, 0xC7, 0xA3, 0xD4, 0xC0, 0x8D, 0x6D, 
+0x11, 0x72, 0x37, 0x08, 0xF4, 0x97, 0xEA, 0x5B, 
+0x5C, 0xC2, 0xEB, 0x5E, 0x44, 0x5B, 0x9E, 0xAF, 
+0x18, 0x67, 0x85, 0x3C, 0x73, 0xDF, 0x82, 0x7C, 
+0x21, 0xC4, 0x39, 0xFA, 0x0B, 0x3A, 0x32, 0x5A, 
+0xD5, 0x04, 0xE5, 0xED, 0x8F, 0x92, 0xA8, 0xB3, 
+0x40, 0x93, 0xE6, 0x49, 0xF7, 0xD0, 0xCD, 0x74, 
+
+0x6E, 0x89, 0x7C, 0x50, 0xAC, 0x42, 0xB8, 0x20, 
+0xBF, 0x94, 0x99, 0x12, 0x15, 0xF6, 0x07, 0xCA, 
+0xAC, 0x9C, 0x43, 0x0C, 0x53, 0x83, 0x3C, 0x8A, 
+0xB1, 0xC7, 0xBE, 0xB1, 0xD4, 0x51, 0x66, 0xEA, 
+0x98, 0x55, 0x51, 0xBD, 0x64, 0x95, 0x21, 0x16, 
+0xF2, 0x2D, 0xF3, 0x78, 0x7F, 0x57, 0x36, 0x1E, 
+0x8D, 0xA2, 0x2D, 0x02, 0x09, 0x42, 0x97, 0x3A, 
+0x38, 0xDF, 0x6F, 0x49, 0x0A, 0x00, 0x30, 0xCF, 
+0xEE, 0x29, 0xB1, 0x16, 0x89, 0x53, 0x07, 0x4A, 
+0x93, 0x09, 0x06, 0xE6, 0xF9, 0x99, 0x09, 0xB7, 
+0xD0, 0x47, 0x76, 0xB3, 0xB2, 0x18, 0xF3, 0x18, 
+0x29, 0xD6, 0x12, 0xEB, 0x4F, 0xE7, 0xCB, 0x8D, 
+0x6C, 0x54, 0xB3, 0x3A, 0xFA, 0x4A, 0xE5, 0x4B, 
+0x95, 0x41, 0x8A, 0x6C, 0x64, 0x5F, 0x5D, 0x01, 
+0xF3, 0xBA, 0xAA, 0x38, 0x3C, 0x52, 0x95, 0xFE, 
+0x8E, 0x5B, 0x5B, 0xA3, 0x4D, 0x7B, 0xE8, 0xAE, 
+0x78, 0xF8, 0xCF, 0x84, 0xD2, 0x27, 0x46, 0xF8, 
+0x7F, 0x6A, 0x32, 0x9B, 0x3E, 0x8C, 0x87, 0x7B, 
+0xF1, 0xDE, 0x00, 0x75, 0x77, 0x3C, 0xEE, 0xB0, 
+0xFE, 0xFC, 0x83, 0x6C, 0x98, 0x49, 0x8B, 0x38, 
+0x8A, 0xCE, 0x74, 0xEC, 0x66, 0x0F, 0x13, 0xD4, 
+0x68, 0x3C, 0x58, 0x40, 0x84, 0x70, 0x80, 0x22, 
+0x5C, 0xBA, 0x93, 0x47, 0x41, 0x4E, 0xE2, 0x07, 
+0xEB, 0x60, 0x00, 0x62, 0xA6, 0x30, 0x95, 0xDF, 
+0xBB, 0x68, 0x43, 0x50, 0x30, 0x9B, 0x48, 0x05, 
+0x8B, 0x4C, 0xD1, 0xE8, 0xCA, 0x28, 0x8D, 0x02, 
+0x11, 0xC8, 0x0F, 0xE7, 0x47, 0xC7, 0xE9, 0x7F, 
+0x7D, 
+
+0x6D, 0xB5, 0x27, 0xC0, 0x67, 0xA5, 0x0A, 0x69, 
+0x41, 0xEC, 0x43, 0x42, 0xF1, 0x9B, 0x7F, 0x68, 
+0x1F, 0x86, 0x99, 0xDB, 0x52, 0xBF, 0x72, 0xC9, 
+0x7A, 0xCC, 0xA4, 0xD8, 0x40, 0xF8, 0xF8, 0xEB, 
+0xA9, 0x51, 0x2B, 0x82, 0x90, 0x78, 0x52, 0x05, 
+0x26, 0x38, 0x87, 0x92, 0x10, 0x6E, 0x4F, 0x57, 
+0x99, 0xE3, 0xBF, 0xC6, 0x63, 0xF1, 0x30, 0x05, 
+0xEE, 0xD1, 0x86, 0xFB, 0xDA, 0xAD, 0x47, 0xD2, 
+0xF0, 0x59, 0xF5, 0xFA, 0x0E, 0x0E, 0x67, 0x5A, 
+0xF0, 0x2F, 0x22, 0xBD, 0xB8, 0x22, 0x0C, 0x9C, 
+0x6F, 0x67, 0xB1, 0x8A, 0xC5, 0xC8, 0x9D, 0x7D, 
+0x24, 0x3C, 0x78, 0xE4, 0x47, 0x95, 0x3B, 0xD5, 
+0x0B, 0xA4, 0xD7, 0xE1, 0xD5, 0x64, 0x36, 0x93, 
+0x90, 0x37, 0x04, 0x2D, 0xD8, 0x06, 0xA2, 0xE0, 
+0xCD, 0x84, 0x32, 0x28, 0x9D, 0xEF, 0xE4, 0xBC, 
+0xB9, 0xD0, 0xCD, 0x44, 0xB5, 0xCD, 0x85, 0xED, 
+0x8F, 0x80, 0x06, 0xF5, 0x6D, 0xC4, 0x9B, 0xD3, 
+0xD9, 0x94, 0xBE, 0xF1, 0xDD, 0x8F, 0x9F, 0x9A, 
+0xEA, 0x2D, 0x51, 0x60, 0x78, 0xE8, 0x9E, 0x58, 
+0x82, 0x54, 0x0E, 0x61, 0xD9, 0x9F, 0x0E, 0x54, 
+0x67, 0xF3, 0x08, 0x23, 0xBC, 0xCF, 0x47, 0xB8, 
+0x75, 0xB9, 0xD0, 0x12, 0xAB, 0x42, 0x14, 0x5B, 
+0xC9, 0x87, 0xBF, 0x6E, 0x89, 0xC4, 0xBA, 0x2B, 
+0x15, 0x20, 0xA5, 0xEC, 0xA8, 0x23, 0x57, 0xD6, 
+0xB9, 0x3F, 0x81, 0x85, 0xA7, 0x6A, 0xCF, 0x7C, 
+0x80, 0x16, 0xE6, 0xA9, 0xAB, 0xA5, 0x52, 0xC6, 
+0x71, 0x21, 0x77, 0x8F, 0xCC, 0x9E, 0xDF, 0x70, 
+0xE1, 0x7D, 
+
+0xC2, 0x42, 0x31, 0x29, 0x90, 0xA9, 0xF1, 0xFC, 
+0xFE, 0xA4, 0x2F, 0xC8, 0xE2, 0xBA, 0xA3, 0x74, 
+0x42, 0x2E, 0xBE, 0x28, 0xA7, 0x91, 0x92, 0xC2, 
+0x19, 0xCE, 0x53, 0x32, 0x0C, 0x0C, 0xA6, 0x7B, 
+0x79, 0x7A, 0x8A, 0x5C, 0xEC, 0x64, 0xEA, 0x13, 
+0xDB, 0x71, 0xC0, 0x0A, 0xD2, 0xD3, 0x73, 0x2F, 
+0xB7, 0x88, 0x17, 0xBC, 0xA9, 0xDF, 0xCE, 0xA7, 
+0x6F, 0x18, 0x89, 0xEF, 0x5E, 0xD9, 0xB0, 0xBD, 
+0x6F, 0x2E, 0xBD, 0x0E, 0x0E, 0xA9, 0xB1, 0xF2, 
+0xB7, 0x64, 0xA2, 0xCB, 0x07, 0xAE, 0x2A, 0xE9, 
+0x89, 0x9B, 0xD1, 0xAF, 0x26, 0xE6, 0xAA, 0x11, 
+0xE1, 0x00, 0xD5, 0xB1, 0x99, 0xAA, 0x4F, 0xA7, 
+0x64, 0xDE, 0x92, 0xE7, 0x6B, 0xAC, 0x81, 0x70, 
+0xDA, 0x53, 0x0B, 0x6E, 0x21, 0x22, 0x1F, 0xB8, 
+0xE1, 0xAA, 0x4F, 0x0F, 0xBF, 0x8B, 0x7D, 0x12, 
+0x8A, 0x51, 0x8B, 0xFD, 0xFC, 0x95, 0xCC, 0xA3, 
+0xE3, 0x40, 0x78, 0x2A, 0x29, 0x73, 0xEC, 0x97, 
+0xB5, 0xC2, 0x8E, 0x1C, 0xBF, 0xCB, 0x5D, 0x5F, 
+0xF3, 0x24, 0x70, 0x1F, 0x64, 0x84, 0xFB, 0xD8, 
+0xC7, 0x5C, 0x6D, 0xA4, 0xF7, 0xA4, 0xB7, 0xED, 
+0xD8, 0xDA, 0x6B, 0x34, 0x08, 0x83, 0x39, 0xFA, 
+0x14, 0xBF, 0xD2, 0xF9, 0xE4, 0x7B, 0x2C, 0x11, 
+0xBE, 0x5C, 0x70, 0x4D, 0x63, 0x4C, 0xB9, 0x93, 
+0x32, 0x4E, 0x27, 0x8B, 0x47, 0xEE, 0x8F, 0x6F, 
+0x96, 0xAD, 0x35, 0xDC, 0xEF, 0xCE, 0x72, 0x4D, 
+0xE8, 0x8F, 0xC7, 0xD6, 0x57, 0xF3, 0xEE, 0x11, 
+0xB9, 0xB5, 0x39, 0xA9, 0x97, 0x2B, 0xF4, 0x83, 
+0x9D, 0x59, 0xD6, 
+
+0x8C, 0x38, 0x16, 0x9D, 0x0B, 0x4A, 0x18, 0xF5, 
+0x08, 0xE1, 0xB6, 0x5B, 0xF0, 0x04, 0x9B, 0xC7, 
+0x5F, 0x18, 0xB2, 0xCC, 0xCA, 0xB1, 0xA3, 0x79, 
+0x56, 0x98, 0x5B, 0xCC, 0x8E, 0x91, 0x4B, 0x2C, 
+0x84, 0x2F, 0x51, 0x8E, 0x1B, 0x93, 0x1C, 0xD9, 
+0xEC, 0x6B, 0xA7, 0x45, 0xE0, 0x2C, 0xCD, 0x69, 
+0xF9, 0x03, 0x48, 0x7F, 0x08, 0xED, 0xE3, 0xAF, 
+0xFA, 0x00, 0xFF, 0x6F, 0x64, 0x25, 0x5B, 0xA6, 
+0xE8, 0xCD, 0x4D, 0x2B, 0x45, 0xEC, 0x4A, 0x6F, 
+0xD5, 0x88, 0xA6, 0x31, 0xD1, 0x95, 0x9B, 0x78, 
+0xF8, 0xB6, 0xDC, 0x45, 0xAD, 0x33, 0xA6, 0x98, 
+0xE4, 0xA2, 0xFC, 0xB7, 0xF0, 0xD6, 0x3A, 0xB3, 
+0x98, 0x47, 0xB3, 0x1A, 0x3F, 0x8B, 0xA2, 0x03, 
+0xAA, 0x87, 0xB8, 0xD5, 0xC5, 0x11, 0x95, 0xD9, 
+0x2B, 0xC7, 0x0D, 0xDB, 0xF8, 0x86, 0xF6, 0x7A, 
+0x70, 0x15, 0xC9, 0x96, 0xF1, 0x46, 0x36, 0xEE, 
+0x53, 0x0C, 0xED, 0xA2, 0x6B, 0xF2, 0x52, 0x11, 
+0x75, 0x39, 0x06, 0xBB, 0xFC, 0x53, 0x0E, 0xAE, 
+0xD4, 0x91, 0x63, 0x69, 0xBD, 0x2C, 0x93, 0xB4, 
+0xE8, 0xDD, 0x0E, 0x50, 0xC3, 0x1E, 0x1B, 0x3A, 
+0xC5, 0xCB, 0xF9, 0xE2, 0xC3, 0x81, 0xDC, 0xC0, 
+0xE1, 0x6D, 0x95, 0xC3, 0xFA, 0xDB, 0x9C, 0x21, 
+0xBD, 0x43, 0x27, 0xAB, 0x05, 0x6A, 0xD1, 0x4E, 
+0x17, 0x85, 0xAE, 0x60, 0x2E, 0xF3, 0x82, 0x87, 
+0x2A, 0x95, 0x03, 0x9C, 0x2B, 0x9D, 0x14, 0xFB, 
+0x30, 0xD2, 0x72, 0x2E, 0x66, 0x8E, 0x8D, 0x9C, 
+0xF4, 0xAA, 0x4A, 0xD6, 0x79, 0xF7, 0x9A, 0xF3, 
+0xFD, 0x6F, 0x83, 0xAF, 
+
+0x31, 0x8C, 0x44, 0x3E, 0xD3, 0xFD, 0x18, 0xE2, 
+0x06, 0x82, 0x23, 0x66, 0x7D, 0xC7, 0x73, 0x1B, 
+0x93, 0x8E, 0x15, 0xFE, 0xF7, 0x93, 0x19, 0xC0, 
+0xA3, 0x78, 0xAC, 0xA6, 0xE5, 0x7F, 0x7E, 0x3B, 
+0xF6, 0x75, 0x02, 0xC7, 0x9A, 0x4D, 0x98, 0xCC, 
+0x1B, 0x36, 0x37, 0x4F, 0xE3, 0x32, 0xF9, 0xA4, 
+0x85, 0xA8, 0x4F, 0xCE, 0x73, 0xBD, 0xB1, 0x9C, 
+0x3C, 0xC9, 0xA7, 0xCF, 0x7D, 0xAA, 0xEA, 0x7F, 
+0x7B, 0x0A, 0xC1, 0x17, 0x43, 0x65, 0xB5, 0x40, 
+0x02, 0x64, 0x04, 0x43, 0x26, 0xF6, 0xC2, 0xCA, 
+0x1E, 0x44, 0x3C, 0x90, 0x23, 0xA0, 0x1A, 0x34, 
+0xF3, 0x7F, 0x49, 0x07, 0x77, 0x12, 0x67, 0x0D, 
+0x31, 0xDE, 0x69, 0x59, 0xC3, 0x52, 0xF4, 0x3D, 
+0x22, 0x1C, 0x1D, 0x74, 0x3C, 0x73, 0x5F, 0xDC, 
+0x48, 0x95, 0x6D, 0xF8, 0xB3, 0xB2, 0xF4, 0xA1, 
+0x06, 0x30, 0xB9, 0xC9, 0x9F, 0xB9, 0xD2, 0x62, 
+0xDD, 0x68, 0x86, 0x9D, 0x86, 0x8B, 0x3F, 0x73, 
+0xD5, 0x18, 0x1A, 0x55, 0xC5, 0x2E, 0x98, 0x2D, 
+0x4A, 0x0A, 0x31, 0x58, 0x1A, 0xE2, 0x74, 0xA9, 
+0x6A, 0xCB, 0xB6, 0x3B, 0xB7, 0xC7, 0xDF, 0x3A, 
+0xA1, 0x01, 0x4A, 0xF9, 0x58, 0x59, 0xFE, 0xF9, 
+0xA4, 0x55, 0x23, 0xA9, 0xC6, 0xF9, 0x60, 0x6F, 
+0xE8, 0x2A, 0x85, 0x9A, 0x65, 0x7A, 0x9C, 0xD9, 
+0x8E, 0x49, 0x86, 0xEF, 0x09, 0xCA, 0x8D, 0xED, 
+0x3C, 0x1B, 0x12, 0x17, 0xDB, 0xDC, 0x26, 0x79, 
+0xC7, 0xD8, 0x25, 0x6D, 0xBE, 0x7E, 0xEA, 0xA9, 
+0x08, 0x47, 0x97, 0x09, 0x34, 0x0D, 0xAF, 0xCE, 
+0x20, 0x48, 0x3E, 0x7E, 0x91, 
+
+0xB0, 0x69, 0x3A, 0xCF, 0xA1, 0xB2, 0x00, 0x49, 
+0x7C, 0x67, 0x79, 0x78, 0xC8, 0xDC, 0x54, 0xD0, 
+0xEF, 0xA9, 0x89, 0x37, 0x73, 0xE2, 0x9C, 0x6A, 
+0x89, 0x23, 0x1E, 0x71, 0x73, 0x18, 0x33, 0x9D, 
+0xC0, 0x78, 0x10, 0x21, 0x88, 0x47, 0xBF, 0x40, 
+0x23, 0x12, 0x18, 0xCE, 0x1F, 0xDE, 0x26, 0xE8, 
+0xD0, 0x6B, 0x0F, 0xBF, 0x96, 0x30, 0xDF, 0x56, 
+0x42, 0x64, 0x37, 0xC7, 0x44, 0xF6, 0x7E, 0x7D, 
+0x91, 0x9D, 0xD0, 0x28, 0x9E, 0xD2, 0x4B, 0xBB, 
+0x62, 0xF9, 0xEA, 0x20, 0x11, 0x12, 0x43, 0xF4, 
+0x99, 0xBA, 0xD7, 0x0B, 0x4E, 0x30, 0xB4, 0x4A, 
+0x39, 0x25, 0xE1, 0x59, 0x85, 0xD9, 0x19, 0x72, 
+0x52, 0xB6, 0x64, 0x21, 0x17, 0xC2, 0x3B, 0x63, 
+0x7D, 0xD0, 0x5D, 0x14, 0xDC, 0x95, 0x3E, 0x97, 
+0x97, 0x8A, 0x80, 0x70, 0xFD, 0x7E, 0xB9, 0xA0, 
+0xA2, 0x41, 0xC2, 0x1D, 0x6A, 0x51, 0x2B, 0x84, 
+0x02, 0x03, 0xD2, 0xEC, 0x83, 0x26, 0x53, 0xDF, 
+0x7D, 0xD0, 0x2D, 0x26, 0x0C, 0x7D, 0x23, 0x1B, 
+0x85, 0x52, 0x29, 0x97, 0xC5, 0x7A, 0xD3, 0xF5, 
+0x8B, 0x26, 0x01, 0x67, 0x8A, 0xC3, 0x5A, 0x19, 
+0x9C, 0xE7, 0x5A, 0xD1, 0x9E, 0x02, 0xD2, 0xAA, 
+0xE6, 0xB3, 0xDF, 0x97, 0x0E, 0xCF, 0xDE, 0xD9, 
+0x72, 0xDC, 0x4E, 0x6A, 0xEB, 0xF2, 0xF7, 0xA3, 
+0xED, 0x59, 0x59, 0x63, 0x1E, 0xA3, 0xD5, 0x48, 
+0x5A, 0xC8, 0xDF, 0x52, 0x08, 0x33, 0x1D, 0xC9, 
+0x69, 0xD3, 0x88, 0x91, 0x9D, 0x25, 0xCC, 0x1C, 
+0x6D, 0xA0, 0xAD, 0x45, 0x5F, 0x65, 0x7E, 0x32, 
+0x68, 0x15, 0x27, 0x71, 0x95, 0x73, 
+
+0xBC, 0x0E, 0xAD, 0xC8, 0x8F, 0x27, 0x1E, 0x40, 
+0xD0, 0xB0, 0x77, 0x95, 0xDE, 0x95, 0x34, 0x86, 
+0x9F, 0xA5, 0x59, 0x17, 0x5F, 0x61, 0x61, 0x43, 
+0x64, 0x51, 0x9D, 0xEF, 0xA2, 0xBE, 0x85, 0xB4, 
+0x79, 0x36, 0x5F, 0xE4, 0x9A, 0x15, 0xC7, 0xF7, 
+0xFE, 0x61, 0xA9, 0x7C, 0x11, 0x04, 0x7C, 0xFF, 
+0x29, 0x8B, 0x0F, 0x98, 0x30, 0x0D, 0x2D, 0xDD, 
+0x70, 0x52, 0x86, 0x60, 0x80, 0x06, 0x81, 0xB0, 
+0x50, 0xD6, 0xFA, 0x2A, 0xA5, 0xEF, 0xB4, 0xE1, 
+0x78, 0xD8, 0x6B, 0x74, 0xFD, 0xB6, 0x62, 0x4D, 
+0x6C, 0x0D, 0x28, 0xBC, 0x16, 0xB2, 0x33, 0x85, 
+0xAE, 0x93, 0xDF, 0xDC, 0xF8, 0xFD, 0xC0, 0x43, 
+0xA3, 0xC0, 0xDC, 0x78, 0x81, 0x7E, 0xEA, 0x40, 
+0x76, 0x64, 0xA8, 0x61, 0x0A, 0x2D, 0x54, 0x15, 
+0xCC, 0x91, 0x60, 0x7F, 0x73, 0xEF, 0x31, 0xB9, 
+0xE7, 0x1E, 0x92, 0xA3, 0x01, 0xC5, 0xF4, 0xC1, 
+0x20, 0xF9, 0x26, 0x38, 0xCE, 0x37, 0x37, 0x8A, 
+0xF2, 0x73, 0x2E, 0x8F, 0xB7, 0x1D, 0xBE, 0x18, 
+0x30, 0x89, 0xF5, 0x6E, 0xE4, 0xB1, 0x78, 0xD9, 
+0x55, 0x99, 0x29, 0x13, 0x71, 0xE5, 0x3A, 0x4F, 
+0xA5, 0x18, 0xCE, 0x30, 0x13, 0x46, 0x45, 0xA4, 
+0x94, 0x64, 0xEB, 0x54, 0x01, 0x8C, 0xAB, 0x76, 
+0xA3, 0x22, 0xE8, 0xBB, 0x40, 0x72, 0x4F, 0x80, 
+0x66, 0x65, 0x71, 0x97, 0x66, 0x90, 0xD7, 0x8E, 
+0xB0, 0x3E, 0xD5, 0x78, 0x05, 0x37, 0x72, 0xB9, 
+0x74, 0xD8, 0x31, 0x9B, 0xA2, 0x5E, 0x72, 0x8D, 
+0x3F, 0x12, 0xF9, 0xF0, 0x05, 0x23, 0x4D, 0xC5, 
+0x73, 0x03, 0xAD, 0xCE, 0xE0, 0x1D, 0xEC, 
+
+0xA4, 0xC1, 0xA8, 0x17, 0x62, 0x0A, 0x8A, 0x8A, 
+0xDD, 0x4C, 0xA7, 0x48, 0x55, 0xE0, 0x67, 0x2F, 
+0x7E, 0x8E, 0x87, 0xE5, 0xFD, 0xC5, 0x20, 0x86, 
+0xBB, 0xD0, 0x96, 0xD8, 0x86, 0x0E, 0x11, 0xC1, 
+0x00, 0xF4, 0x02, 0x11, 0x46, 0xFB, 0x06, 0xDB, 
+0x64, 0x16, 0x41, 0x5B, 0x41, 0x0D, 0xFE, 0x1B, 
+0x14, 0x07, 0x4B, 0x07, 0x47, 0x23, 0x03, 0xF6, 
+0x63, 0x61, 0xA3, 0x99, 0x43, 0x1F, 0x44, 0x44, 
+0x05, 0xAF, 0x53, 0x5F, 0x62, 0x8B, 0x6F, 0x64, 
+0x65, 0xD3, 0x48, 0xF2, 0x7B, 0xC6, 0xA8, 0xF3, 
+0xD8, 0xF8, 0x48, 0x54, 0x7C, 0xEB, 0xED, 0x69, 
+0x74, 0xD4, 0x92, 0xCE, 0xA1, 0x8A, 0xF7, 0xD5, 
+0xF2, 0xE1, 0xC4, 0x0D, 0xA2, 0x57, 0x86, 0x5A, 
+0x95, 0xC1, 0x80, 0x15, 0x00, 0xA9, 0x0D, 0xA9, 
+0x26, 0x53, 0x3D, 0xE1, 0x26, 0xE2, 0x68, 0x41, 
+0xA3, 0x93, 0xD9, 0x35, 0x63, 0xF3, 0xA4, 0xBB, 
+0x3E, 0x27, 0x8C, 0x97, 0x57, 0x11, 0xF3, 0x74, 
+0x29, 0x09, 0x04, 0x3E, 0x86, 0xFD, 0x7D, 0x8C, 
+0xC0, 0x17, 0xDC, 0x54, 0xD7, 0x77, 0xAD, 0x5E, 
+0x64, 0xA4, 0x97, 0x56, 0xC0, 0xF7, 0xFF, 0x0E, 
+0x84, 0x41, 0xF1, 0x6D, 0xDB, 0x00, 0xBB, 0x64, 
+0x46, 0x92, 0xC0, 0x1F, 0xBF, 0xD1, 0xA5, 0x3C, 
+0x27, 0x2B, 0x14, 0xF5, 0x54, 0x89, 0x74, 0x46, 
+0x93, 0x2F, 0x1D, 0x44, 0xA2, 0xB2, 0xC4, 0xE6, 
+0x00, 0x6D, 0x49, 0x01, 0x9E, 0x86, 0x91, 0x12, 
+0x78, 0xAB, 0x79, 0xB0, 0x85, 0x63, 0x31, 0x6E, 
+0x50, 0xC6, 0x11, 0x03, 0x3D, 0xA7, 0x5E, 0x14, 
+0x35, 0x04, 0x90, 0xCF, 0xEC, 0xF2, 0x10, 0xA8, 
+
+0x9E, 0xFE, 0xF9, 0xE0, 0x32, 0x40, 0x16, 0x1B, 
+0x95, 0xD4, 0x5C, 0xC1, 0xCC, 0xFA, 0xC8, 0x68, 
+0xE2, 0x2A, 0xD2, 0xFC, 0x37, 0x22, 0x50, 0x7B, 
+0xC4, 0xA5, 0x07, 0xC0, 0x02, 0x5B, 0x07, 0x0A, 
+0x6D, 0xD5, 0x64, 0x15, 0x83, 0xD9, 0xA2, 0x75, 
+0x91, 0x06, 0x27, 0x66, 0xC6, 0x78, 0x0E, 0xDF, 
+0x8D, 0x25, 0x6F, 0xDB, 0xAC, 0x60, 0xD7, 0xFC, 
+0x13, 0x50, 0x6F, 0x4C, 0xDA, 0x60, 0xAD, 0x63, 
+0xAB, 0x84, 0x09, 0x0D, 0x2B, 0xB3, 0xDE, 0xAF, 
+0x5A, 0x1E, 0xD6, 0xE5, 0x79, 0x1D, 0x3D, 0x35, 
+0x39, 0xE4, 0xC4, 0x07, 0x5E, 0x12, 0xBA, 0xDB, 
+0xC4, 0x48, 0x2B, 0x00, 0x12, 0xAD, 0xAE, 0xFF, 
+0xF2, 0xA2, 0xB3, 0x67, 0x0A, 0xAF, 0x49, 0xAA, 
+0xBA, 0xEB, 0xF8, 0x4E, 0x7B, 0xFB, 0x88, 0xD5, 
+0x22, 0xE4, 0x52, 0xCF, 0xA2, 0xE7, 0xC0, 0xA9, 
+0x50, 0x4F, 0x28, 0xAD, 0x14, 0x26, 0xB1, 0x17, 
+0x2D, 0x6E, 0x8C, 0x41, 0x42, 0x72, 0x5F, 0xBA, 
+0xB3, 0x2D, 0x68, 0x4C, 0x45, 0x6C, 0xEA, 0xF7, 
+0x08, 0x13, 0xB5, 0xF8, 0x07, 0x4D, 0x3F, 0x8F, 
+0xD8, 0x7E, 0x45, 0xD7, 0x5E, 0x88, 0xCC, 0xCF, 
+0xA5, 0x14, 0x95, 0x10, 0x18, 0x11, 0xB4, 0x43, 
+0xF6, 0xD3, 0x20, 0xCB, 0x1C, 0xED, 0xCC, 0xAE, 
+0x30, 0x23, 0x99, 0x75, 0x11, 0xF4, 0x7E, 0x2A, 
+0xDC, 0xDF, 0xEB, 0x6E, 0x60, 0xC2, 0xB9, 0x62, 
+0xF1, 0x96, 0x47, 0x2B, 0x4B, 0x32, 0xAF, 0xAC, 
+0x77, 0x70, 0x4A, 0x61, 0xD7, 0x93, 0xD2, 0xC8, 
+0x2D, 0x6F, 0x50, 0x78, 0x2B, 0xA3, 0xE8, 0x89, 
+0x4D, 0x8F, 0x1B, 0x55, 0x06, 0x22, 0xFB, 0xEE, 
+0xA5, 
+
+0xD5, 0x14, 0x54, 0xA2, 0x41, 0x16, 0xD3, 0xF7, 
+0x8A, 0x45, 0xB5, 0x86, 0xB3, 0x0F, 0x62, 0x31, 
+0xA2, 0x38, 0xB1, 0xDB, 0x62, 0xE4, 0x8E, 0x97, 
+0xFD, 0xED, 0x28, 0x8C, 0xA4, 0x01, 0x5B, 0x7F, 
+0xA1, 0x82, 0x90, 0xFE, 0x65, 0x1C, 0xC0, 0xAC, 
+0xC4, 0xA2, 0x18, 0x9D, 0x99, 0x28, 0xE7, 0xDE, 
+0x52, 0xAE, 0xFA, 0x40, 0xC0, 0xFE, 0x5C, 0x83, 
+0xB8, 0xD3, 0xFB, 0x89, 0x43, 0x79, 0x79, 0x66, 
+0x27, 0x22, 0xBF, 0x03, 0xA8, 0x4B, 0xE7, 0xC1, 
+0x30, 0x4C, 0xD0, 0x2B, 0x23, 0x6E, 0x56, 0xAB, 
+0xB0, 0xB6, 0xF5, 0x49, 0xA8, 0x8D, 0xC4, 0x88, 
+0x38, 0xE1, 0xF5, 0x76, 0xCD, 0xA7, 0x7A, 0x6E, 
+0x35, 0x4D, 0x69, 0xBE, 0x97, 0xA1, 0xA7, 0xE4, 
+0x2E, 0x69, 0xA6, 0xC7, 0x8D, 0xE2, 0x86, 0xD6, 
+0x4A, 0x22, 0x25, 0x9E, 0xE8, 0xBD, 0x35, 0x1B, 
+0x80, 0x34, 0x09, 0xF3, 0xF2, 0x13, 0x54, 0xEE, 
+0xD9, 0x1A, 0x04, 0x7E, 0xC9, 0x01, 0x9C, 0x5C, 
+0xC5, 0x30, 0xD0, 0x4E, 0xF4, 0xA8, 0xA4, 0xE3, 
+0x07, 0x73, 0x46, 0xE0, 0x2E, 0xA2, 0xBC, 0xC7, 
+0xA0, 0x4A, 0x58, 0xF9, 0x47, 0xD6, 0xD5, 0x5D, 
+0xE2, 0xB9, 0x56, 0xFA, 0x27, 0x35, 0xC5, 0x75, 
+0x12, 0x7F, 0x0A, 0x40, 0x01, 0x0E, 0x61, 0x55, 
+0x65, 0x4F, 0x7F, 0x9A, 0xC5, 0x47, 0x21, 0xDA, 
+0x62, 0xDF, 0x08, 0x03, 0x69, 0xEE, 0xEC, 0xB9, 
+0xF4, 0x16, 0x71, 0x76, 0x76, 0x33, 0x6B, 0xDE, 
+0x56, 0x3D, 0x74, 0x92, 0xDE, 0x00, 0xFE, 0x40, 
+0x5A, 0x9E, 0x8C, 0xD4, 0x2B, 0x86, 0xAE, 0x25, 
+0xB4, 0xA4, 0x21, 0xC6, 0xFE, 0x6E, 0xFD, 0x13, 
+0x4B, 0x1E, 
+
+0x3A, 0xDD, 0x6B, 0x68, 0xB0, 0xCB, 0xE4, 0x76, 
+0x28, 0xD1, 0x98, 0x12, 0xEB, 0xF7, 0xD9, 0x57, 
+0x50, 0xF6, 0xFB, 0x52, 0x19, 0x63, 0xFA, 0x58, 
+0x15, 0x48, 0xB8, 0xFB, 0x96, 0xB0, 0x36, 0x8C, 
+0x5C, 0xC5, 0xC8, 0x53, 0x77, 0x35, 0xC0, 0xF0, 
+0xF8, 0x0C, 0x5D, 0xE7, 0x93, 0x76, 0x17, 0x04, 
+0xAF, 0xDF, 0x0F, 0xFC, 0xD1, 0x9C, 0xE3, 0x09, 
+0xCE, 0x3E, 0x51, 0x5B, 0xE3, 0x1D, 0x33, 0x9A, 
+0xD9, 0xDB, 0xF9, 0x4E, 0x06, 0xA5, 0x41, 0x64, 
+0x5B, 0x2E, 0xD9, 0x03, 0x7C, 0xBC, 0x5D, 0x38, 
+0x4F, 0x29, 0x12, 0xFD, 0xCF, 0x9C, 0x07, 0x2B, 
+0x3D, 0xCD, 0x63, 0x65, 0xE5, 0x19, 0x4E, 0x6E, 
+0x7E, 0xC6, 0x6A, 0xF7, 0xC3, 0xFA, 0x22, 0x07, 
+0xE7, 0xEF, 0xA6, 0x22, 0x48, 0xAD, 0x06, 0x28, 
+0x2F, 0x61, 0xB8, 0x3C, 0x93, 0xB2, 0x3A, 0x5A, 
+0xA5, 0xB2, 0xE2, 0x10, 0xAB, 0x36, 0xD1, 0xF1, 
+0x7D, 0xA6, 0x26, 0xD6, 0x8F, 0x0C, 0xB4, 0x2F, 
+0xC8, 0xB2, 0x59, 0x5E, 0x88, 0x88, 0xDA, 0x43, 
+0xFA, 0x50, 0x25, 0x82, 0xD5, 0x81, 0x92, 0x0B, 
+0x26, 0x9A, 0x3C, 0x8C, 0x8C, 0x0A, 0x78, 0xB1, 
+0xBA, 0xF0, 0x22, 0xA6, 0x22, 0xE3, 0xCE, 0x18, 
+0xA4, 0xD4, 0x96, 0x2F, 0xEE, 0x8A, 0xC4, 0xB6, 
+0x46, 0x3B, 0x8D, 0xC2, 0x13, 0xD1, 0x34, 0x9A, 
+0x32, 0x26, 0xF3, 0x4C, 0x2C, 0x76, 0xDD, 0x2B, 
+0xAC, 0xCB, 0xE8, 0x39, 0x53, 0x70, 0xB0, 0x94, 
+0x2C, 0xDD, 0x50, 0x1A, 0x91, 0x2E, 0xC9, 0x59, 
+0xC5, 0x2F, 0x38, 0xBF, 0x9B, 0x49, 0x36, 0x9B, 
+0x3F, 0x73, 0x27, 0xF6, 0xE6, 0x2B, 0xF4, 0x9E, 
+0x4B, 0x04, 0xE1, 
+
+0x0A, 0x19, 0xC3, 0xDB, 0xAA, 0xB2, 0x9D, 0x17, 
+0x77, 0x3B, 0xF6, 0x3D, 0x16, 0x43, 0x42, 0x5A, 
+0x67, 0xF4, 0x95, 0x7D, 0x8A, 0x9F, 0x44, 0xFB, 
+0xC0, 0x80, 0xCD, 0x09, 0x37, 0xB7, 0x40, 0x2C, 
+0x8A, 0x04, 0x66, 0x04, 0x52, 0x88, 0x18, 0x95, 
+0xDC, 0x55, 0x86, 0x4B, 0xF5, 0xE0, 0xCD, 0xCE, 
+0x74, 0x30, 0x91, 0xA8, 0x07, 0x43, 0x79, 0xA6, 
+0xD1, 0x34, 0xCE, 0xD3, 0x10, 0xFC, 0x51, 0xFC, 
+0x94, 0x44, 0x60, 0x56, 0x4B, 0x13, 0xC3, 0xA9, 
+0x61, 0x9D, 0x64, 0x20, 0xAE, 0xDA, 0x0F, 0x98, 
+0x7E, 0x76, 0x69, 0xBA, 0xEA, 0x35, 0x9E, 0x16, 
+0x30, 0x4B, 0xC8, 0x4C, 0xCF, 0x8D, 0x4A, 0x75, 
+0x47, 0x31, 0x9B, 0x2B, 0x7E, 0xF5, 0x1D, 0x21, 
+0x06, 0xCB, 0xC9, 0x2F, 0xCB, 0x2E, 0x80, 0x12, 
+0xEF, 0x5D, 0x21, 0xD5, 0x78, 0x88, 0xA7, 0xB0, 
+0xD1, 0x2E, 0x25, 0xF6, 0xA9, 0xAA, 0x9B, 0xCC, 
+0x5C, 0xF0, 0xA7, 0x4F, 0xF1, 0x92, 0xF7, 0xD2, 
+0x53, 0xD9, 0x0D, 0x48, 0x7C, 0x71, 0xC2, 0xD3, 
+0xFA, 0xEC, 0xA0, 0x9D, 0x6E, 0xF3, 0x51, 0x1C, 
+0xCA, 0x76, 0xA0, 0xDA, 0x3F, 0xA9, 0x54, 0x99, 
+0xB1, 0x2F, 0xE4, 0x8C, 0x05, 0x00, 0xF3, 0xAA, 
+0x69, 0xBD, 0x05, 0x17, 0x52, 0x19, 0x75, 0x39, 
+0x52, 0xFA, 0x6A, 0x36, 0xD5, 0xE1, 0x0D, 0xF3, 
+0xF6, 0x91, 0x21, 0xDA, 0x8B, 0xD0, 0xA9, 0x26, 
+0x03, 0x41, 0xD2, 0x19, 0x37, 0xE6, 0x62, 0x27, 
+0xD9, 0xF6, 0x56, 0xD0, 0x28, 0x2A, 0x9D, 0xA9, 
+0xA8, 0x50, 0x47, 0xE2, 0x03, 0x2A, 0xFB, 0xFC, 
+0x6A, 0x1F, 0x67, 0x08, 0x89, 0x36, 0x93, 0x45, 
+0x9F, 0x5C, 0x83, 0x65, 
+
+0x71, 0xE2, 0x61, 0x6D, 0xCD, 0xF0, 0xC7, 0x8C, 
+0x62, 0x50, 0xD9, 0xB1, 0xA9, 0xD3, 0xB5, 0x81, 
+0xC4, 0xEE, 0xDA, 0x73, 0xB5, 0xCD, 0x2C, 0x85, 
+0x3F, 0x37, 0xDB, 0x82, 0x01, 0xD2, 0xC4, 0xEE, 
+0xC0, 0x49, 0xDA, 0x0A, 0x92, 0xC5, 0x7E, 0x33, 
+0x61, 0x48, 0xA7, 0x94, 0xD4, 0x36, 0xC2, 0x88, 
+0x4E, 0xB7, 0xF1, 0xEC, 0xAE, 0xB7, 0x3D, 0x0C, 
+0x57, 0x0E, 0x2D, 0x1C, 0x40, 0x24, 0xF4, 0xF5, 
+0x67, 0x38, 0x1B, 0x2D, 0x77, 0xF7, 0x32, 0xAB, 
+0xE8, 0x9B, 0x2F, 0xDA, 0x50, 0xD8, 0x0C, 0xCF, 
+0xAC, 0xF9, 0xE7, 0x8A, 0x40, 0xFB, 0xE0, 0xAD, 
+0x7C, 0x93, 0x87, 0x60, 0x3D, 0x66, 0x3B, 0x02, 
+0x0D, 0xF8, 0x70, 0x8E, 0xF2, 0x1A, 0xF5, 0x82, 
+0x9C, 0x9B, 0x92, 0x7F, 0x2F, 0x9F, 0x14, 0x9B, 
+0x48, 0x0E, 0xB2, 0x4A, 0x08, 0x9A, 0x66, 0x44, 
+0xC1, 0x81, 0x3A, 0x1F, 0x76, 0x87, 0x5E, 0x0A, 
+0x2B, 0xF4, 0xA7, 0x52, 0xAE, 0x0B, 0x6B, 0xE6, 
+0x6A, 0x12, 0x6A, 0xE2, 0xF6, 0x26, 0xD0, 0x1F, 
+0x68, 0x6C, 0x9E, 0x07, 0xE5, 0x0E, 0x48, 0x09, 
+0xBF, 0xB7, 0xAB, 0xA2, 0xE2, 0x55, 0xFD, 0x2A, 
+0x4D, 0xD5, 0xA1, 0x22, 0x93, 0xFC, 0x3A, 0xFF, 
+0x21, 0x25, 0x6D, 0x5C, 0xD3, 0x3F, 0xB6, 0x7B, 
+0xF8, 0x77, 0x36, 0xD7, 0xFC, 0x74, 0xDA, 0xC0, 
+0xCC, 0xAF, 0x45, 0x45, 0xC0, 0x34, 0x21, 0x37, 
+0x0D, 0xEB, 0x71, 0xBC, 0xD7, 0xCC, 0x08, 0x63, 
+0x68, 0x0B, 0x50, 0x4C, 0xBE, 0xAC, 0x8F, 0x71, 
+0x91, 0x45, 0xEE, 0x08, 0x7E, 0xD8, 0xDC, 0xB5, 
+0xDA, 0x33, 0x21, 0x3C, 0x9D, 0x9B, 0x11, 0x85, 
+0x57, 0xC3, 0xB3, 0xDB, 0x99, 
+
+0xB3, 0x64, 0xBB, 0xDF, 0xB7, 0x70, 0x44, 0xAF, 
+0x59, 0xC9, 0x14, 0x4B, 0x1A, 0xDF, 0xA3, 0x6F, 
+0xA4, 0x87, 0x2F, 0x09, 0x6B, 0x07, 0xAB, 0x76, 
+0x8E, 0x96, 0x90, 0xDC, 0x5B, 0x81, 0x4B, 0x0A, 
+0x48, 0xFD, 0x9C, 0x81, 0x1A, 0x84, 0xE2, 0x61, 
+0xC6, 0x51, 0x8C, 0x9F, 0xCB, 0x49, 0x4C, 0xCD, 
+0xCF, 0x7B, 0x21, 0xFF, 0xE5, 0x22, 0x9C, 0xF7, 
+0x3B, 0x6B, 0x25, 0xC0, 0xC2, 0xB3, 0xB2, 0xBB, 
+0xE7, 0x67, 0x63, 0x43, 0xB0, 0x32, 0x29, 0xD8, 
+0xF5, 0xE0, 0x23, 0xEE, 0x41, 0x1A, 0x2D, 0x11, 
+0xA0, 0x76, 0x9A, 0x0F, 0x2D, 0x5A, 0xA5, 0x16, 
+0x16, 0x3B, 0x0B, 0x70, 0xC4, 0x92, 0x03, 0x3F, 
+0x92, 0x5B, 0xB4, 0x98, 0x92, 0x41, 0x8B, 0x0B, 
+0xD5, 0x8F, 0xB1, 0x3E, 0xDA, 0x53, 0x6D, 0x66, 
+0x9F, 0x08, 0x02, 0xF8, 0xE3, 0x93, 0x5C, 0x6F, 
+0x9A, 0xE5, 0x9A, 0x90, 0xC0, 0x5D, 0x9F, 0x77, 
+0xD0, 0x1F, 0x22, 0xE8, 0x23, 0x57, 0x2F, 0x9B, 
+0x0A, 0x6A, 0xB8, 0x1C, 0x55, 0x9E, 0xB3, 0x63, 
+0x86, 0x1E, 0x32, 0x94, 0x0A, 0x33, 0xC9, 0xF1, 
+0xBA, 0xFB, 0xB0, 0x03, 0x7D, 0x93, 0x84, 0xDD, 
+0xDD, 0xA9, 0x9D, 0xC8, 0x76, 0x78, 0x2A, 0x18, 
+0x5A, 0x8D, 0x26, 0x07, 0x18, 0x3B, 0xE2, 0x42, 
+0x28, 0x46, 0x01, 0xC0, 0x9F, 0xF3, 0xD8, 0x56, 
+0x51, 0x09, 0x91, 0x7D, 0x53, 0x36, 0xB5, 0xED, 
+0x8F, 0x80, 0xF5, 0x79, 0xDA, 0xD2, 0xC6, 0x02, 
+0xDC, 0x2A, 0xC0, 0x79, 0xA4, 0x3D, 0x9E, 0xA6, 
+0xDB, 0xB0, 0xBC, 0xCB, 0xB0, 0xF5, 0xB4, 0x0F, 
+0xA0, 0xB7, 0xDD, 0x67, 0x35, 0x36, 0x78, 0x54, 
+0x38, 0x7D, 0xCB, 0xA6, 0xA6, 0x1C, 
+
+0x01, 0xF2, 0xBF, 0x61, 0x94, 0x02, 0x21, 0x8C, 
+0xA2, 0x3E, 0xF8, 0x5F, 0x86, 0x25, 0x9D, 0x06, 
+0x28, 0x3F, 0x9C, 0x5F, 0xB3, 0x3D, 0x3B, 0xAD, 
+0xA8, 0xE5, 0x68, 0x1A, 0x3E, 0xCB, 0x5A, 0x6F, 
+0xD8, 0xFC, 0xDC, 0x47, 0x76, 0xFE, 0x18, 0x6D, 
+0x04, 0xCA, 0xF3, 0x12, 0xC5, 0xB6, 0xC0, 0xEE, 
+0x0C, 0x4A, 0x97, 0x79, 0x9F, 0x88, 0xC6, 0xA3, 
+0x3C, 0x27, 0x13, 0xD5, 0x8B, 0x6C, 0xDD, 0x89, 
+0x3F, 0xE5, 0xCC, 0xA5, 0xAB, 0x29, 0x5B, 0x1D, 
+0xC8, 0x57, 0x37, 0xD3, 0x98, 0x43, 0x0F, 0x7A, 
+0xCC, 0xA7, 0xC6, 0x4B, 0x2C, 0x0F, 0xD2, 0x6E, 
+0xB2, 0x1B, 0xE9, 0x96, 0xD5, 0x89, 0x4E, 0xD7, 
+0x66, 0xEF, 0x3B, 0xFC, 0x62, 0xA9, 0x33, 0xB5, 
+0x90, 0xCE, 0x9D, 0x58, 0xBD, 0x5C, 0xCA, 0x86, 
+0xFD, 0xD7, 0x8F, 0x0B, 0xFD, 0xC0, 0xEF, 0x95, 
+0xEE, 0xDE, 0x63, 0xC5, 0x8A, 0x22, 0xB6, 0x90, 
+0x4D, 0x9D, 0x32, 0xF7, 0x60, 0xE5, 0x0F, 0xE5, 
+0xC8, 0x89, 0x6B, 0x91, 0xA7, 0x84, 0xED, 0xE6, 
+0x19, 0xCF, 0x07, 0x32, 0x42, 0x38, 0x8D, 0x6D, 
+0xFC, 0x73, 0xC8, 0xDB, 0x9E, 0xD9, 0xCD, 0x84, 
+0x45, 0xCC, 0x47, 0xA1, 0xCB, 0xEE, 0xAF, 0xDA, 
+0x6E, 0x12, 0xDB, 0x63, 0x67, 0x62, 0xC7, 0xB2, 
+0xCE, 0x5D, 0x3B, 0x83, 0x64, 0xD6, 0x67, 0x06, 
+0xF7, 0xD0, 0x45, 0xDF, 0x75, 0x14, 0x04, 0x9A, 
+0x65, 0x94, 0xA0, 0xFF, 0x94, 0xB8, 0x25, 0xD2, 
+0x25, 0x21, 0x2E, 0xFF, 0xD7, 0x89, 0x7E, 0xA3, 
+0x84, 0x0C, 0x91, 0xA3, 0xB3, 0xBE, 0x87, 0x8A, 
+0x88, 0x44, 0x71, 0x4F, 0x9C, 0x24, 0x0A, 0x53, 
+0xDB, 0x43, 0xF4, 0xE0, 0x42, 0x42, 0xDF, 
+
+0x64, 0x49, 0xC0, 0x95, 0x98, 0x78, 0x40, 0x86, 
+0x69, 0x54, 0x0E, 0x6A, 0xD9, 0xF9, 0x86, 0x3A, 
+0x8B, 0xD9, 0x82, 0x32, 0x8F, 0x84, 0xBD, 0x82, 
+0x9D, 0x1D, 0x7B, 0x60, 0x07, 0xD7, 0xBD, 0x3E, 
+0xB3, 0x68, 0xB1, 0x57, 0x3E, 0xB4, 0x63, 0xF6, 
+0xDE, 0x7E, 0xD4, 0x00, 0x59, 0x1D, 0xD3, 0xAA, 
+0xAA, 0xF5, 0x09, 0xB4, 0x1A, 0xF1, 0x03, 0x61, 
+0x88, 0xBD, 0x2E, 0x59, 0x6B, 0x03, 0x33, 0xBC, 
+0x9B, 0xED, 0xD7, 0x69, 0x1A, 0x73, 0xE9, 0xF0, 
+0xDF, 0x98, 0x2E, 0x89, 0x02, 0x3C, 0x33, 0xC7, 
+0x8D, 0x73, 0x21, 0x6C, 0x55, 0xDC, 0x8A, 0x50, 
+0xE4, 0x9A, 0x2E, 0x2F, 0x31, 0x35, 0x28, 0xCF, 
+0xFE, 0xB0, 0x07, 0x6E, 0x59, 0x1C, 0x73, 0x01, 
+0x05, 0x18, 0xAE, 0x3E, 0x51, 0x04, 0xA0, 0x5E, 
+0x4A, 0xCD, 0x58, 0x6B, 0xC8, 0xDB, 0x6C, 0xE0, 
+0x0F, 0x66, 0xD7, 0x09, 0x91, 0x58, 0x7B, 0x07, 
+0xFF, 0xAE, 0x46, 0x90, 0x73, 0x23, 0xD1, 0xE0, 
+0x21, 0x59, 0x26, 0x60, 0x83, 0x4B, 0xF2, 0x9D, 
+0x4C, 0xD2, 0x5D, 0x49, 0x06, 0x5B, 0xC8, 0xF9, 
+0xAA, 0x9D, 0xC7, 0xAB, 0x1C, 0x80, 0x3E, 0xA6, 
+0x85, 0xB4, 0x49, 0x88, 0x6D, 0x4C, 0x3C, 0x1A, 
+0x8F, 0x6F, 0x19, 0xBE, 0xC5, 0xDD, 0x22, 0x77, 
+0x0C, 0x19, 0xF5, 0xEC, 0x70, 0xD3, 0xBE, 0x77, 
+0x14, 0xFD, 0xDD, 0xA5, 0x87, 0x5F, 0x80, 0xDA, 
+0xF3, 0x0F, 0xFF, 0x9E, 0x1D, 0xC7, 0x19, 0x8C, 
+0xFA, 0x3A, 0xB0, 0x42, 0xC8, 0x17, 0x92, 0x40, 
+0x6B, 0x5D, 0x7C, 0x4D, 0xE8, 0x62, 0xD7, 0x64, 
+0xB5, 0x18, 0xC3, 0x23, 0x0C, 0xA5, 0xB5, 0x7C, 
+0x19, 0x27, 0xF8, 0x9E, 0xE7, 0x9D, 0x81, 0xF4, 
+
+0x80, 0xE9, 0xDE, 0x18, 0x7D, 0x2B, 0x56, 0x4B, 
+0x40, 0x29, 0x30, 0x1D, 0x23, 0xB9, 0xFC, 0x1A, 
+0xB7, 0xDF, 0x78, 0x1E, 0xDA, 0x8A, 0x01, 0xC9, 
+0xB5, 0x63, 0x39, 0xF7, 0xF4, 0x3A, 0xF5, 0x45, 
+0x51, 0x25, 0xEF, 0xAA, 0x90, 0x83, 0xD8, 0x30, 
+0xDA, 0x89, 0xB1, 0xCE, 0xD5, 0xE8, 0xE9, 0xE4, 
+0x0C, 0x76, 0x56, 0xA3, 0x8F, 0xA8, 0xAA, 0xA4, 
+0x26, 0x7A, 0x20, 0x11, 0xDE, 0xFE, 0xB3, 0xF9, 
+0x92, 0x0D, 0x33, 0x0A, 0x89, 0x17, 0xF2, 0x88, 
+0xEE, 0x32, 0x1D, 0x6D, 0xB4, 0xF7, 0x6D, 0x7C, 
+0x9A, 0x2F, 0x77, 0xF8, 0x21, 0xD5, 0x02, 0xB9, 
+0x61, 0x38, 0x1C, 0xE4, 0x6A, 0xED, 0xCC, 0x34, 
+0x70, 0x0E, 0x4B, 0x0C, 0xD0, 0xA6, 0x55, 0xBC, 
+0xDC, 0x5A, 0xE2, 0x86, 0xEA, 0x4C, 0xE2, 0x5E, 
+0xD4, 0x83, 0x67, 0x3E, 0xF6, 0xFE, 0x8D, 0xE7, 
+0x88, 0x7C, 0x91, 0xF4, 0xC7, 0xD8, 0x4E, 0x7F, 
+0x4F, 0x44, 0x80, 0x5D, 0x41, 0xB6, 0x2D, 0xF4, 
+0xD6, 0xF3, 0x8D, 0x4B, 0x13, 0x03, 0x39, 0x92, 
+0xCE, 0x57, 0x11, 0xBB, 0xA5, 0x7C, 0x64, 0x78, 
+0xD9, 0x7A, 0xBD, 0x2A, 0x7A, 0x6A, 0x1A, 0x7E, 
+0xA1, 0x09, 0x4C, 0xD5, 0x04, 0xD2, 0x38, 0xA4, 
+0xA6, 0xDE, 0xC0, 0xD2, 0x40, 0xC3, 0x67, 0x49, 
+0x9D, 0x4B, 0x02, 0x6E, 0x43, 0x39, 0x00, 0xA4, 
+0x05, 0xD9, 0x34, 0xD1, 0x05, 0x37, 0x29, 0xF8, 
+0x06, 0x8D, 0xD5, 0xD5, 0x64, 0xA5, 0x8F, 0x40, 
+0x50, 0xC1, 0x38, 0x8A, 0x45, 0x2D, 0xDC, 0x30, 
+0xD2, 0xC1, 0x55, 0x82, 0x02, 0x80, 0xFE, 0xE0, 
+0x46, 0x7F, 0x81, 0x2E, 0xCB, 0x16, 0xCA, 0xB9, 
+0x71, 0x5C, 0xC4, 0x86, 0xC7, 0x81, 0x7C, 0xBB, 
+0xD3, 
+
+0xA3, 0xC5, 0x78, 0xBB, 0xAC, 0xC9, 0x9B, 0x4E, 
+0x1A, 0xF1, 0x91, 0x05, 0xAB, 0xBB, 0xD1, 0xFD, 
+0xD3, 0xF7, 0x8F, 0x9A, 0xC5, 0xEE, 0xD0, 0xE2, 
+0xE8, 0x17, 0xD5, 0x20, 0x15, 0x17, 0x73, 0x83, 
+0x64, 0x71, 0xF4, 0xC6, 0x70, 0x8D, 0x74, 0xED, 
+0xF5, 0x3F, 0xDB, 0xF3, 0x68, 0x5F, 0x52, 0xA5, 
+0x54, 0xD4, 0x1B, 0xCE, 0x52, 0x96, 0x60, 0x3E, 
+0x7F, 0x8E, 0xC2, 0xC9, 0x57, 0x93, 0xFF, 0x8D, 
+0xDC, 0xF9, 0xE4, 0x39, 0xE6, 0x87, 0xED, 0xB1, 
+0x3C, 0xEC, 0x71, 0xD3, 0x3A, 0xF4, 0x0A, 0x80, 
+0x73, 0x9D, 0xD5, 0xC0, 0x1C, 0xF3, 0x2C, 0x1E, 
+0x81, 0x63, 0x15, 0xF5, 0xF1, 0x97, 0x8A, 0x56, 
+0x15, 0x31, 0x26, 0xE9, 0xE7, 0x6C, 0x9D, 0x2A, 
+0x30, 0x20, 0xF8, 0xFC, 0x81, 0xDE, 0x74, 0x5D, 
+0xBC, 0x40, 0x31, 0xC3, 0x6D, 0xC6, 0x7C, 0x92, 
+0xF0, 0x2D, 0x7A, 0xF0, 0x87, 0x1E, 0xB5, 0xB8, 
+0xE1, 0x52, 0x8F, 0xC3, 0x85, 0x38, 0xC7, 0x46, 
+0xEB, 0xBC, 0x0A, 0x87, 0x1C, 0x19, 0x29, 0xEA, 
+0xE3, 0x6A, 0x30, 0xDD, 0x26, 0x88, 0x36, 0x89, 
+0x48, 0xB7, 0xEF, 0xC2, 0x71, 0x99, 0x4D, 0xEA, 
+0xD8, 0x25, 0xBE, 0x16, 0xE5, 0xFA, 0x18, 0x74, 
+0x83, 0x5C, 0x5E, 0x42, 0x5F, 0xA5, 0xE4, 0xDF, 
+0xF0, 0x55, 0xFF, 0xC6, 0x20, 0xF4, 0x51, 0x08, 
+0xF1, 0x34, 0x16, 0x69, 0x0E, 0x6F, 0x85, 0x92, 
+0x32, 0x40, 0x6E, 0xF7, 0xD0, 0x73, 0x79, 0x66, 
+0xD1, 0x47, 0xC4, 0x5B, 0xFE, 0xCF, 0xDC, 0x65, 
+0x97, 0xE6, 0x96, 0x2C, 0x1C, 0x54, 0x31, 0xBE, 
+0xA8, 0xD2, 0x21, 0x2F, 0x4E, 0xA6, 0x12, 0x84, 
+0x39, 0x53, 0x1B, 0x91, 0x02, 0xBE, 0x49, 0x22, 
+0xDA, 0x5B, 
+
+0xCB, 0x6C, 0x11, 0x69, 0xE0, 0x57, 0x25, 0x64, 
+0x4E, 0xF3, 0x2F, 0x71, 0x7E, 0x1A, 0x17, 0x28, 
+0xD3, 0xFC, 0x1B, 0xA7, 0xEC, 0x9E, 0x84, 0xA2, 
+0xB5, 0x5F, 0x61, 0x7F, 0xE7, 0x6B, 0xBB, 0x1A, 
+0xAB, 0x58, 0xF1, 0x2D, 0x4D, 0x61, 0x8C, 0xD2, 
+0xC3, 0x16, 0xCF, 0x67, 0x6E, 0xBC, 0x8D, 0x19, 
+0x49, 0xF0, 0xDF, 0x05, 0x67, 0x2B, 0xCE, 0x96, 
+0x92, 0x92, 0x47, 0xF6, 0x0F, 0x83, 0x3D, 0x2D, 
+0xB8, 0xE5, 0x7C, 0x6F, 0xCD, 0x6A, 0x46, 0x30, 
+0xD9, 0x77, 0x0E, 0x37, 0xCD, 0x20, 0xE7, 0x79, 
+0xC2, 0xBA, 0xEF, 0xA8, 0x39, 0x80, 0xAD, 0xA6, 
+0xE4, 0x76, 0x21, 0x9B, 0x20, 0xF9, 0x01, 0xCE, 
+0x6A, 0x7A, 0xDF, 0x36, 0xC5, 0x4A, 0x12, 0x70, 
+0x84, 0x87, 0x7E, 0x43, 0x57, 0xF2, 0x95, 0x92, 
+0xEC, 0x16, 0x65, 0x5F, 0x03, 0x96, 0x27, 0x74, 
+0xB3, 0x54, 0x3E, 0x83, 0x66, 0x9C, 0x66, 0x50, 
+0xD1, 0xBC, 0x20, 0x25, 0x57, 0xE9, 0x01, 0x1E, 
+0x17, 0x5D, 0x8E, 0xC8, 0x86, 0x92, 0x78, 0x89, 
+0x5B, 0x0F, 0xE8, 0xA9, 0xB8, 0x49, 0x3C, 0x6C, 
+0x07, 0xA4, 0xBC, 0xCC, 0x67, 0x48, 0xAB, 0x17, 
+0x39, 0xF7, 0x0F, 0xB9, 0xAB, 0x91, 0x30, 0x18, 
+0x49, 0x11, 0x11, 0x42, 0x8A, 0x0F, 0xF7, 0xD5, 
+0x16, 0xBC, 0x05, 0x2E, 0x29, 0xC0, 0x60, 0x00, 
+0x44, 0x1F, 0x3D, 0x7B, 0xE1, 0xB0, 0x78, 0x8B, 
+0x0C, 0x8E, 0x8F, 0x1E, 0x78, 0xC1, 0x04, 0x6B, 
+0x1F, 0xA1, 0xF8, 0xDE, 0x69, 0xA6, 0x40, 0x1A, 
+0xF1, 0x77, 0x2E, 0xB0, 0x12, 0xBA, 0x64, 0x91, 
+0x1B, 0x88, 0x51, 0x0F, 0x56, 0x88, 0x06, 0x09, 
+0x6C, 0x47, 0xA5, 0x5C, 0x4B, 0x70, 0x2C, 0x5F, 
+0x43, 0x37, 0x97, 
+
+0x10, 0xB6, 0x9C, 0xE4, 0x4C, 0x5D, 0xD9, 0xDD, 
+0xD3, 0x7F, 0x9D, 0x3B, 0xD8, 0xF1, 0x86, 0xDA, 
+0xDE, 0xCB, 0xC6, 0x19, 0xAC, 0x72, 0x7B, 0x15, 
+0x99, 0xF1, 0x9E, 0x17, 0xCE, 0x57, 0x25, 0x23, 
+0x07, 0x85, 0x68, 0xF4, 0x6D, 0x92, 0xE2, 0xFD, 
+0x97, 0x5F, 0xE8, 0x65, 0x68, 0x76, 0x98, 0xBE, 
+0x16, 0xCF, 0x8A, 0xAF, 0xF8, 0x20, 0x94, 0xF1, 
+0xA3, 0xD2, 0x83, 0xAD, 0x13, 0xA9, 0x07, 0xB7, 
+0x84, 0x64, 0x89, 0xEE, 0x91, 0xA2, 0xBC, 0x11, 
+0x65, 0xE4, 0xE1, 0x2B, 0x97, 0x7D, 0x82, 0xD4, 
+0x2A, 0xB7, 0x48, 0x10, 0x99, 0x0F, 0x07, 0xF2, 
+0xF0, 0xD5, 0xE5, 0x44, 0xC9, 0xD7, 0x6E, 0x40, 
+0x16, 0x89, 0x03, 0x15, 0x82, 0x60, 0xAA, 0xA1, 
+0x1C, 0x99, 0x29, 0xF2, 0x17, 0x13, 0x4C, 0xCD, 
+0x1F, 0x43, 0x17, 0x96, 0xEF, 0x4E, 0x6C, 0x26, 
+0xED, 0x58, 0x0E, 0xA2, 0x1A, 0xBA, 0xA1, 0x3A, 
+0x6D, 0x12, 0x96, 0xE6, 0x5B, 0x10, 0x06, 0x76, 
+0xE2, 0x05, 0xBF, 0x10, 0x1B, 0xED, 0x0F, 0xF8, 
+0xD3, 0xE1, 0x6A, 0x7D, 0xF7, 0x59, 0xE2, 0x58, 
+0xAC, 0x4F, 0xD3, 0x19, 0x87, 0x8D, 0x0D, 0x80, 
+0x4E, 0x33, 0x26, 0x11, 0x86, 0xBF, 0xB8, 0x9E, 
+0x51, 0x55, 0xF2, 0x73, 0x72, 0x88, 0xDF, 0x80, 
+0x8D, 0x23, 0x44, 0xAD, 0x82, 0x65, 0x08, 0x09, 
+0xA3, 0x4C, 0x33, 0x74, 0x59, 0x3C, 0x22, 0x6A, 
+0x0E, 0x70, 0x45, 0xBC, 0xEC, 0xEE, 0x9C, 0x96, 
+0x61, 0x47, 0xC9, 0x9E, 0x9B, 0x9D, 0x85, 0x5D, 
+0xA8, 0xA8, 0x1B, 0x11, 0x72, 0x7D, 0xCC, 0x62, 
+0x13, 0x3A, 0x68, 0xB7, 0x94, 0x25, 0x53, 0x07, 
+0xDD, 0x56, 0x25, 0xCA, 0xBD, 0x00, 0xE8, 0x8B, 
+0xE2, 0x87, 0x19, 0x16, 
+
+0x3F, 0xFB, 0x14, 0xE9, 0x37, 0x67, 0xCD, 0x92, 
+0x59, 0xA4, 0x93, 0x17, 0x7B, 0x23, 0xAE, 0xDC, 
+0x7A, 0x73, 0x2C, 0xD7, 0x71, 0x71, 0x3C, 0x7B, 
+0x66, 0xD5, 0xF4, 0x8C, 0x54, 0x6E, 0x06, 0xC7, 
+0x9C, 0xBD, 0x77, 0x41, 0x21, 0x9A, 0x15, 0x34, 
+0xB9, 0xAA, 0x00, 0x52, 0x5F, 0x6D, 0x32, 0x02, 
+0x41, 0xAF, 0x69, 0x67, 0x89, 0x2C, 0xFE, 0x49, 
+0x18, 0x91, 0x00, 0x7A, 0x35, 0xAE, 0x02, 0x1A, 
+0x26, 0xF0, 0x03, 0xB1, 0x30, 0x87, 0x12, 0x7F, 
+0x15, 0xEC, 0xE0, 0xAE, 0x98, 0x91, 0xAD, 0x3D, 
+0x50, 0xA7, 0x78, 0xBE, 0xCA, 0xA7, 0x03, 0xD6, 
+0x72, 0x3B, 0x2E, 0xB6, 0x1C, 0x2C, 0x3D, 0x63, 
+0x9E, 0x6A, 0x11, 0xFF, 0xAB, 0x8C, 0x59, 0x74, 
+0xF5, 0x33, 0x8B, 0x8B, 0x8D, 0x93, 0x46, 0x95, 
+0xF8, 0xFF, 0xD4, 0x17, 0xAE, 0x5C, 0x07, 0xCE, 
+0x1A, 0xD6, 0x73, 0xF3, 0xEC, 0x5E, 0xD4, 0x55, 
+0x32, 0x9D, 0x20, 0x15, 0x9E, 0xC5, 0x38, 0x36, 
+0x73, 0x11, 0x94, 0x33, 0x02, 0xCE, 0xD3, 0xBC, 
+0x92, 0xF8, 0xF5, 0xCE, 0xA4, 0xC3, 0x16, 0x51, 
+0x48, 0xD1, 0x8F, 0x52, 0x40, 0x31, 0x4D, 0x45, 
+0xFA, 0xBD, 0xA2, 0x4B, 0x4A, 0x5D, 0xC0, 0xEB, 
+0x7B, 0x39, 0xEC, 0x5F, 0x2E, 0x78, 0xA3, 0x7E, 
+0x1A, 0x64, 0xA2, 0x3E, 0x67, 0x94, 0x94, 0x7E, 
+0xFF, 0xD1, 0x52, 0x70, 0x0A, 0x16, 0x74, 0xEA, 
+0x4A, 0x20, 0xA0, 0x68, 0xDE, 0xF5, 0x5C, 0x06, 
+0x3D, 0x5B, 0x69, 0x57, 0xAE, 0x5E, 0x5B, 0xAE, 
+0xB0, 0x5B, 0x1A, 0x39, 0x39, 0x8E, 0x68, 0x87, 
+0x9B, 0x0D, 0x60, 0x0A, 0xEF, 0x87, 0x46, 0xA4, 
+0x34, 0xD8, 0xB3, 0xFA, 0x2D, 0x41, 0xFF, 0xD1, 
+0x6C, 0xA4, 0x1C, 0x3C, 0xC7, 
+
+0xFD, 0xD7, 0xBB, 0x5B, 0x5C, 0xE5, 0x4E, 0xF1, 
+0xAE, 0x21, 0x8A, 0x34, 0xD7, 0x5C, 0x28, 0x9A, 
+0x11, 0x98, 0x10, 0x07, 0xA9, 0x6B, 0xFD, 0x65, 
+0x69, 0xAB, 0xD1, 0x40, 0x77, 0xB5, 0x16, 0x08, 
+0x4D, 0xB0, 0x26, 0x43, 0x11, 0x90, 0xFB, 0x32, 
+0x13, 0x0D, 0xDE, 0xD5, 0x93, 0x92, 0xDD, 0x15, 
+0x27, 0xB5, 0x4C, 0x72, 0x62, 0xB2, 0x1E, 0x10, 
+0x3C, 0x6D, 0x99, 0x48, 0xF0, 0x0C, 0x4B, 0x6A, 
+0xD1, 0x9E, 0x22, 0x84, 0x05, 0x17, 0xFE, 0xE5, 
+0xDA, 0x9E, 0xEA, 0x50, 0x70, 0xB9, 0x99, 0x10, 
+0x7D, 0x3C, 0x99, 0x71, 0x73, 0xA6, 0x81, 0xA3, 
+0x3A, 0x5F, 0xF2, 0xC2, 0x28, 0x68, 0x48, 0x4F, 
+0x1D, 0x6B, 0xAC, 0xFB, 0x1E, 0x3F, 0x11, 0xD5, 
+0x7C, 0x29, 0x4C, 0x85, 0x81, 0x3D, 0x80, 0xF2, 
+0x11, 0x89, 0x20, 0x85, 0x20, 0x10, 0x7A, 0x0C, 
+0x5B, 0x73, 0xAD, 0xEA, 0x38, 0x1A, 0x4D, 0xA8, 
+0xF2, 0xAA, 0x8E, 0xD0, 0xE8, 0x3D, 0x77, 0x5D, 
+0xE2, 0xFF, 0xB8, 0xF4, 0xB7, 0x39, 0xB4, 0x48, 
+0x82, 0x1E, 0xD6, 0x6A, 0x4F, 0x0E, 0xAE, 0xCA, 
+0x29, 0x76, 0xE9, 0x38, 0xC0, 0x51, 0xD7, 0xF1, 
+0xAA, 0x14, 0x19, 0x51, 0xBF, 0x91, 0xC5, 0xD4, 
+0xAD, 0x2F, 0x88, 0x68, 0xD3, 0xD1, 0x85, 0x9E, 
+0xAD, 0x56, 0x5A, 0xF3, 0x8A, 0xBD, 0xA5, 0xF6, 
+0x33, 0x78, 0xFA, 0x68, 0xC4, 0x0F, 0x3B, 0xA2, 
+0xA8, 0x8D, 0x59, 0xB2, 0x26, 0x05, 0xCB, 0x66, 
+0x9C, 0xB3, 0xAB, 0x48, 0x53, 0x92, 0xC8, 0x30, 
+0x85, 0xAE, 0xA8, 0xC4, 0xA6, 0x76, 0x98, 0x3B, 
+0x6D, 0x41, 0xC7, 0x85, 0x0D, 0xD4, 0xF8, 0x74, 
+0x86, 0xBC, 0x26, 0x6E, 0x53, 0x96, 0x43, 0x21, 
+0xB1, 0x8D, 0xD2, 0x4A, 0x15, 0xC8, 
+
+0x63, 0xA2, 0x3D, 0xEC, 0x0B, 0x88, 0x08, 0x01, 
+0x86, 0xB9, 0x92, 0x0E, 0x02, 0x7F, 0x8A, 0x27, 
+0x55, 0x5E, 0x3F, 0x7D, 0x9C, 0x80, 0x99, 0x3E, 
+0xEF, 0x30, 0x91, 0xD1, 0x83, 0x70, 0x7B, 0x18, 
+0x6C, 0x77, 0x04, 0xC4, 0xB4, 0x3E, 0x12, 0x8A, 
+0x06, 0x7F, 0xB1, 0x43, 0x02, 0xFD, 0xD9, 0xCC, 
+0x2C, 0xFC, 0xEF, 0x0D, 0x6B, 0x2D, 0xA8, 0x92, 
+0xCE, 0xD6, 0x8D, 0x2D, 0xE4, 0xA6, 0xDC, 0xA5, 
+0xC1, 0x5E, 0x3F, 0x38, 0x76, 0xDA, 0x50, 0x16, 
+0x42, 0x4F, 0x75, 0x8E, 0x5F, 0x92, 0x6D, 0x73, 
+0xEE, 0x12, 0xDF, 0x1F, 0x4B, 0x66, 0xF5, 0x77, 
+0x32, 0x71, 0x00, 0xBD, 0xDD, 0x1A, 0x4F, 0xE2, 
+0x25, 0x2F, 0x1D, 0xE9, 0x36, 0xE2, 0x1A, 0x68, 
+0x9A, 0xCF, 0xC3, 0xCF, 0x83, 0xD2, 0x54, 0x7A, 
+0xA2, 0xA0, 0x75, 0x64, 0xBE, 0xEC, 0x06, 0xE3, 
+0x75, 0x8B, 0xF1, 0xE5, 0xEB, 0x1E, 0xC8, 0x45, 
+0xEC, 0x3A, 0xF7, 0x52, 0x46, 0x64, 0x1A, 0x57, 
+0x61, 0xE2, 0x46, 0x9B, 0x65, 0x0C, 0x0E, 0x99, 
+0x30, 0x11, 0xC1, 0x85, 0xFF, 0x43, 0x88, 0xD2, 
+0x32, 0x7F, 0x2E, 0x93, 0x32, 0x41, 0x64, 0x1D, 
+0x5A, 0x34, 0x67, 0xD3, 0x98, 0x8C, 0xA0, 0x27, 
+0x6B, 0xC5, 0xDC, 0x7C, 0x07, 0x41, 0x80, 0x03, 
+0x75, 0x01, 0xAB, 0x35, 0x8F, 0x3B, 0x0C, 0xF4, 
+0xCD, 0xC4, 0xC0, 0xF9, 0xFD, 0x01, 0x24, 0xC8, 
+0x81, 0xC7, 0xF0, 0x9E, 0xA6, 0x09, 0x0B, 0x39, 
+0xA6, 0xCF, 0xDA, 0x79, 0x8A, 0x47, 0x54, 0xED, 
+0xA3, 0x30, 0x4F, 0xE3, 0xF6, 0x69, 0x2F, 0xB6, 
+0xDE, 0x18, 0xD9, 0xCB, 0x97, 0x0B, 0x06, 0x39, 
+0x23, 0x9D, 0xCC, 0x10, 0xF0, 0x16, 0x43, 0x31, 
+0x5F, 0xC5, 0xD9, 0x5A, 0x37, 0x78, 0x1A, 
+
+0x93, 0x94, 0xB1, 0x20, 0x44, 0xAD, 0xF3, 0x97, 
+0xA7, 0x4B, 0x3B, 0xB3, 0x9D, 0x00, 0xDF, 0x61, 
+0x25, 0x01, 0x0A, 0x08, 0xC7, 0x90, 0xAC, 0x9D, 
+0xD2, 0x1A, 0x0C, 0x4A, 0x95, 0x92, 0x76, 0xF7, 
+0xF3, 0x05, 0xAF, 0x8A, 0x2D, 0x7B, 0x13, 0x45, 
+0x54, 0x32, 0x9F, 0xEA, 0x82, 0x48, 0xD5, 0x14, 
+0x3A, 0xD2, 0xA7, 0x21, 0xFC, 0x70, 0x8D, 0x7C, 
+0x3C, 0x52, 0x52, 0xB5, 0x12, 0xDB, 0x11, 0x07, 
+0x7A, 0x85, 0x59, 0x0F, 0x97, 0x4C, 0x92, 0x1A, 
+0xB6, 0xFE, 0xFB, 0x08, 0x25, 0x4B, 0x93, 0x9C, 
+0xD3, 0x4D, 0x66, 0x10, 0xE3, 0x56, 0x98, 0x78, 
+0xF7, 0x73, 0xC8, 0x01, 0xCB, 0x2D, 0xC7, 0xC3, 
+0xA0, 0x5B, 0xBD, 0xAB, 0x48, 0x32, 0xFA, 0x6B, 
+0x71, 0xCF, 0x4F, 0x32, 0x50, 0xD0, 0x95, 0xCC, 
+0x9E, 0x9E, 0x38, 0x14, 0x08, 0x7F, 0x47, 0xAC, 
+0x79, 0x04, 0xB0, 0x37, 0x38, 0xEF, 0xB8, 0x6F, 
+0x7A, 0xA1, 0xC7, 0xF1, 0x7E, 0x55, 0x06, 0xC5, 
+0xCE, 0xB9, 0x57, 0x1D, 0xC5, 0xEA, 0x0D, 0xE0, 
+0xF2, 0x99, 0xE9, 0xD2, 0x04, 0xE1, 0xAF, 0x41, 
+0xC2, 0x97, 0x16, 0xFF, 0x21, 0x79, 0x03, 0x31, 
+0x6F, 0x03, 0x3A, 0x44, 0x26, 0xD8, 0xFA, 0x3A, 
+0x8A, 0x53, 0x4A, 0x02, 0xE3, 0x28, 0xC8, 0xBD, 
+0xB2, 0x0E, 0xDF, 0x7B, 0x8C, 0x2B, 0x24, 0xE0, 
+0x91, 0xA3, 0x24, 0xAE, 0x15, 0xB8, 0xC2, 0x34, 
+0xCD, 0x6C, 0xC9, 0x17, 0xC0, 0x5E, 0xF0, 0xA8, 
+0x87, 0x00, 0x9B, 0x38, 0x22, 0x81, 0x93, 0xD6, 
+0xC5, 0x04, 0x89, 0x0B, 0xAF, 0x22, 0x96, 0xA0, 
+0xB1, 0xFD, 0xDC, 0x9B, 0x88, 0x10, 0x07, 0x7F, 
+0xE6, 0x27, 0x14, 0xA6, 0xE0, 0xDC, 0x33, 0x31, 
+0x7B, 0xA9, 0x0B, 0x94, 0x0D, 0xA4, 0xB0, 0x07, 
+
+0x54, 0xF9, 0x6C, 0xCE, 0x09, 0x02, 0xE7, 0xA4, 
+0x5E, 0x00, 0x18, 0x57, 0xCD, 0xB7, 0x30, 0xAF, 
+0xB6, 0x0D, 0xF2, 0xB8, 0x91, 0x45, 0x8A, 0x9F, 
+0xE5, 0xB9, 0xFA, 0x97, 0xE7, 0xA2, 0x3A, 0x28, 
+0x00, 0x8B, 0x57, 0x30, 0x95, 0x9A, 0x70, 0xC8, 
+0x2B, 0x92, 0xD4, 0x5F, 0x7C, 0xFE, 0x18, 0x55, 
+0xB3, 0x36, 0x2B, 0xBF, 0x0C, 0x45, 0x55, 0x62, 
+0x0C, 0x1E, 0xBB, 0x84, 0x85, 0xE8, 0x03, 0xE1, 
+0x96, 0x67, 0x75, 0x16, 0x30, 0xB3, 0x07, 0xEC, 
+0x59, 0x8D, 0x4A, 0x01, 0x8E, 0x23, 0x88, 0xDC, 
+0x6A, 0x8C, 0xB0, 0x23, 0xD1, 0xEE, 0x3F, 0xA7, 
+0x67, 0x30, 0x89, 0xF4, 0x43, 0xCC, 0xDD, 0xB3, 
+0x3F, 0xDA, 0xB3, 0x83, 0xE4, 0x75, 0x87, 0xB8, 
+0x86, 0xFB, 0x9A, 0xB7, 0xCE, 0x30, 0xB3, 0xFE, 
+0xE4, 0x73, 0x7A, 0xF6, 0x02, 0x8B, 0x9A, 0xD2, 
+0x18, 0xBE, 0x7F, 0x74, 0x69, 0x54, 0x2B, 0x4D, 
+0xD9, 0x29, 0xB7, 0xED, 0xDD, 0x40, 0x7F, 0x0A, 
+0x80, 0xC1, 0x8D, 0x93, 0x75, 0x39, 0xFF, 0x74, 
+0x34, 0xD5, 0x54, 0x8D, 0x13, 0x14, 0x2D, 0x11, 
+0x4F, 0x8F, 0xFC, 0x3A, 0xFF, 0x2E, 0x0E, 0x02, 
+0x56, 0xCD, 0xBA, 0x6A, 0x9F, 0x39, 0x23, 0x5A, 
+0xD6, 0xC1, 0x0F, 0x93, 0xA6, 0xDD, 0x31, 0xA9, 
+0xC4, 0x6E, 0x22, 0x7E, 0x06, 0x8E, 0xA1, 0x02, 
+0xD6, 0x0C, 0x32, 0x14, 0x4D, 0xEC, 0x6A, 0x0A, 
+0x4E, 0x18, 0x05, 0xC6, 0xCA, 0x33, 0xCD, 0x2D, 
+0x99, 0x7C, 0xE7, 0xDC, 0xC8, 0x5E, 0xFC, 0xFC, 
+0x1F, 0xC5, 0xEB, 0x1C, 0x1E, 0x0B, 0x34, 0x7E, 
+0xA6, 0x68, 0x4B, 0x7B, 0xAE, 0xDC, 0x12, 0xB0, 
+0x1E, 0xB2, 0xAE, 0xEE, 0xB2, 0xB6, 0x4B, 0xA0, 
+0x9E, 0xB9, 0x8D, 0x47, 0xA2, 0x84, 0xCA, 0x3C, 
+0x84, 
+
+0x71, 0x55, 0xF6, 0xFD, 0x9A, 0x71, 0x29, 0x23, 
+0xC5, 0x35, 0xC1, 0x8E, 0x71, 0x26, 0x9C, 0x80, 
+0x04, 0x51, 0xEA, 0x48, 0x52, 0x80, 0x5A, 0xB7, 
+0x8A, 0xA4, 0x08, 0xB0, 0xD8, 0x4D, 0x49, 0x51, 
+0xC8, 0x21, 0x33, 0x75, 0xB5, 0x3A, 0x5D, 0x88, 
+0xD0, 0xFA, 0xB4, 0x72, 0x91, 0xD7, 0x38, 0x20, 
+0xC8, 0xCB, 0x36, 0xF4, 0x0C, 0xA7, 0x70, 0xD5, 
+0x13, 0x25, 0x24, 0xC1, 0x88, 0x25, 0x74, 0x1D, 
+0x85, 0x5B, 0xD0, 0x84, 0x47, 0x6C, 0x75, 0x0A, 
+0xF8, 0xA0, 0x77, 0x4B, 0x32, 0x37, 0x9E, 0x20, 
+0x94, 0x47, 0xFB, 0x5C, 0x39, 0x97, 0x03, 0xE9, 
+0xF8, 0xFC, 0x8C, 0x36, 0x5C, 0xEB, 0x9A, 0x6E, 
+0x3B, 0x09, 0x82, 0x4D, 0x8B, 0x67, 0x4D, 0x11, 
+0x39, 0x99, 0x56, 0x64, 0x06, 0x5F, 0xDD, 0xAD, 
+0xE0, 0x20, 0x64, 0xB4, 0x84, 0x6A, 0x4B, 0xB5, 
+0x7D, 0xD7, 0x1D, 0xD9, 0x6C, 0xC4, 0x74, 0x90, 
+0x5B, 0x6C, 0x5E, 0x1B, 0xC4, 0x6E, 0xEF, 0x6C, 
+0x81, 0x0D, 0x6B, 0x3E, 0xA3, 0xDB, 0x17, 0xBA, 
+0x52, 0x4D, 0x7F, 0x90, 0x29, 0x33, 0x17, 0x82, 
+0x6B, 0xB7, 0x0D, 0xE1, 0xC0, 0xF1, 0x36, 0xB9, 
+0x07, 0xCB, 0x7D, 0xE1, 0x96, 0x3A, 0x19, 0xA3, 
+0xFC, 0x76, 0xEB, 0x10, 0x72, 0x0E, 0x95, 0xFF, 
+0x2E, 0x16, 0xDE, 0xDA, 0x69, 0x3D, 0x00, 0x66, 
+0x7B, 0xFE, 0x82, 0xC8, 0xA2, 0xF6, 0x4C, 0xF5, 
+0xE0, 0x3D, 0x2D, 0x5A, 0x24, 0xBD, 0x03, 0x84, 
+0xFF, 0x18, 0xFA, 0xED, 0x1A, 0xDE, 0x46, 0xED, 
+0xA5, 0x1D, 0x86, 0x24, 0x0C, 0x9B, 0x00, 0x6D, 
+0x18, 0x4B, 0xBB, 0xEF, 0x8A, 0x3D, 0x42, 0xFA, 
+0x5D, 0x68, 0x1E, 0x3F, 0x59, 0x90, 0xBA, 0xBA, 
+0xAE, 0x3F, 0x0E, 0x00, 0xDC, 0x3F, 0xD5, 0x13, 
+0x05, 0x6D, 
+
+0x83, 0x1D, 0xF2, 0xA9, 0x2C, 0xDC, 0xDB, 0x63, 
+0x07, 0xEB, 0x8A, 0x52, 0x9A, 0x40, 0x94, 0xBD, 
+0x85, 0xD3, 0x06, 0x7D, 0xD8, 0xF3, 0xE1, 0x9E, 
+0xA2, 0xE0, 0xD9, 0x9A, 0xF7, 0x3D, 0xD0, 0x02, 
+0xF7, 0x68, 0x16, 0x1D, 0x6A, 0x68, 0x44, 0xD5, 
+0xCC, 0x48, 0x84, 0xF2, 0x00, 0x46, 0x66, 0x9C, 
+0x73, 0xBC, 0x3D, 0x20, 0xC7, 0x99, 0xA2, 0x8D, 
+0xF7, 0x34, 0x5B, 0x98, 0x25, 0x88, 0x64, 0x57, 
+0x52, 0x49, 0x0A, 0xDA, 0x98, 0xBC, 0x51, 0x12, 
+0xEE, 0x90, 0xEF, 0x7F, 0xB2, 0x27, 0xFA, 0x26, 
+0x8E, 0xD5, 0xFA, 0xAE, 0x4B, 0x1E, 0x03, 0x2A, 
+0x4F, 0xED, 0x7F, 0xA3, 0xC6, 0x0C, 0x7E, 0x3B, 
+0x81, 0x2D, 0x35, 0x9F, 0x75, 0x3D, 0x48, 0x35, 
+0xA2, 0xF1, 0x63, 0x0E, 0x2B, 0x16, 0xC6, 0x23, 
+0x49, 0x09, 0x2A, 0x9C, 0xC4, 0x52, 0x8C, 0x5A, 
+0xA6, 0x04, 0x76, 0x03, 0xCF, 0xE8, 0xF1, 0xCD, 
+0xA0, 0xB6, 0x5B, 0xD2, 0x5D, 0xEB, 0xBF, 0x6E, 
+0x4A, 0xDD, 0xF0, 0x7D, 0xD7, 0xC0, 0x00, 0x83, 
+0x5F, 0x3D, 0x03, 0xBE, 0xDC, 0x00, 0x21, 0x87, 
+0x73, 0x26, 0xF0, 0x66, 0x19, 0x02, 0x4C, 0xC5, 
+0xEA, 0x0D, 0xD9, 0x2D, 0xF5, 0x9F, 0x83, 0x9D, 
+0x3A, 0x27, 0xE9, 0xAE, 0x9B, 0xC1, 0xBB, 0x26, 
+0x96, 0x15, 0x73, 0x75, 0x57, 0xF0, 0xDD, 0x88, 
+0xB4, 0x26, 0x16, 0x27, 0xD1, 0x01, 0x4A, 0xC3, 
+0xE8, 0x28, 0x9A, 0xA2, 0xFE, 0xA0, 0xE3, 0x39, 
+0x31, 0xB7, 0x14, 0x58, 0x39, 0x93, 0xB4, 0x50, 
+0xE0, 0x94, 0x29, 0x69, 0xE9, 0x95, 0xAF, 0xF7, 
+0x4C, 0x04, 0x5C, 0x70, 0x1B, 0xF9, 0xEF, 0xCB, 
+0xB2, 0xB4, 0x4E, 0x43, 0x30, 0xCB, 0x2A, 0xB1, 
+0xCC, 0x3B, 0x4A, 0xCE, 0x01, 0xC3, 0x31, 0xC7, 
+0x0B, 0x92, 0x25, 
+
+0x29, 0xDC, 0xDE, 0xE4, 0xDF, 0xBE, 0x27, 0xDF, 
+0x34, 0xD4, 0xE5, 0x6C, 0x95, 0x7D, 0xD6, 0x8E, 
+0x95, 0x0C, 0x1A, 0x5D, 0xB4, 0x4E, 0x84, 0xD7, 
+0xFC, 0x71, 0xAF, 0xA9, 0xCD, 0x5E, 0x06, 0xDA, 
+0xC6, 0x5E, 0xB3, 0xF2, 0x3D, 0x90, 0x77, 0x48, 
+0x09, 0x7B, 0x84, 0x4E, 0xAC, 0xCC, 0xE8, 0xA5, 
+0xF2, 0x84, 0x3A, 0x3D, 0xB1, 0x72, 0x01, 0xBB, 
+0xC5, 0xAD, 0x50, 0xC8, 0x2C, 0x36, 0x39, 0x5E, 
+0x1C, 0xC2, 0xA7, 0xF5, 0x72, 0x5A, 0xD9, 0xAB, 
+0xEC, 0x7D, 0x2C, 0xDC, 0x5A, 0x70, 0xA6, 0x26, 
+0x81, 0xFA, 0x29, 0x6E, 0xCE, 0xA0, 0x50, 0x89, 
+0x6F, 0x85, 0x69, 0x95, 0x49, 0xC2, 0x6F, 0xC1, 
+0x9F, 0x02, 0xFC, 0xB9, 0xEC, 0x2D, 0xD6, 0x4D, 
+0x9A, 0xB4, 0xEB, 0x8C, 0x19, 0x5A, 0xC0, 0x0D, 
+0xBF, 0x8B, 0x69, 0x3E, 0x8F, 0xFB, 0x5E, 0xB2, 
+0xAB, 0x6B, 0xE2, 0x69, 0xDA, 0x63, 0xAD, 0xB6, 
+0x7F, 0xAE, 0xD2, 0xA3, 0x0A, 0x78, 0xD5, 0x62, 
+0xC3, 0x53, 0x4A, 0x52, 0x94, 0xF3, 0xC4, 0x30, 
+0x2A, 0x59, 0x14, 0x07, 0x0A, 0x54, 0xCC, 0xED, 
+0x15, 0xA6, 0x3C, 0x1F, 0x76, 0xC2, 0x34, 0xA5, 
+0xE5, 0xF2, 0x05, 0xDE, 0x71, 0x27, 0xE7, 0x99, 
+0x04, 0xE4, 0xC3, 0x09, 0x7E, 0x7E, 0xDC, 0x79, 
+0x84, 0xDD, 0x50, 0x51, 0x00, 0x87, 0x4C, 0xC5, 
+0xCC, 0xDA, 0x16, 0x06, 0x61, 0x1B, 0x24, 0x38, 
+0xAB, 0x1A, 0x52, 0xF7, 0xCF, 0x66, 0x76, 0x67, 
+0xE5, 0x79, 0x73, 0x9D, 0x1F, 0x76, 0xB7, 0x34, 
+0x2E, 0xC8, 0x9E, 0xD2, 0x97, 0xE2, 0x5E, 0x17, 
+0x47, 0xFE, 0xFE, 0x02, 0xAF, 0xDF, 0xE4, 0x87, 
+0x53, 0x92, 0x66, 0xAE, 0x54, 0xFE, 0xA8, 0xE0, 
+0x22, 0x16, 0x79, 0x0F, 0xFB, 0x63, 0xCB, 0x26, 
+0x0E, 0x49, 0x82, 0xCB, 
+
+0x54, 0x55, 0x54, 0x19, 0xAB, 0x79, 0x25, 0x58, 
+0xB9, 0xFF, 0x16, 0xDD, 0xA3, 0x64, 0xFC, 0xC4, 
+0x44, 0x64, 0x4A, 0xEA, 0x95, 0xF8, 0xDA, 0x6D, 
+0xD8, 0x47, 0xD7, 0x55, 0xE2, 0x12, 0x93, 0x30, 
+0x44, 0xC1, 0xEF, 0x15, 0x98, 0x0C, 0x6D, 0x57, 
+0x1D, 0x83, 0xFD, 0x2F, 0x2E, 0x6B, 0x53, 0x64, 
+0x21, 0x21, 0x60, 0x3A, 0x1D, 0x99, 0xAD, 0x70, 
+0xCD, 0x15, 0x32, 0x4C, 0xCD, 0x54, 0xCA, 0x54, 
+0x58, 0xB1, 0xC8, 0xD2, 0x51, 0x8F, 0x3A, 0x40, 
+0x63, 0xBA, 0xDC, 0x10, 0x21, 0x65, 0x4C, 0x0B, 
+0xB8, 0x37, 0x46, 0xD4, 0xC8, 0xAA, 0x24, 0xFE, 
+0x3A, 0xC4, 0x19, 0x02, 0x47, 0xFA, 0x21, 0x6A, 
+0x01, 0xCA, 0x28, 0xAF, 0x1D, 0x65, 0xAD, 0x13, 
+0x58, 0xCE, 0x04, 0x31, 0xBD, 0xB1, 0x29, 0x76, 
+0x9E, 0xDC, 0xBC, 0xB8, 0x3E, 0xE6, 0x19, 0xAE, 
+0x32, 0xDA, 0x91, 0x5E, 0xD2, 0xBF, 0x3C, 0x4D, 
+0x7B, 0x10, 0x1A, 0xC6, 0x41, 0xC6, 0x45, 0x51, 
+0x83, 0x16, 0x11, 0x0F, 0xCF, 0xB5, 0x5E, 0xEF, 
+0xEE, 0x29, 0x7F, 0x95, 0x33, 0x26, 0xBA, 0xC6, 
+0x9B, 0xCD, 0xC4, 0x2D, 0xCE, 0x25, 0xAC, 0xFC, 
+0x70, 0x10, 0x20, 0x99, 0xEE, 0x3E, 0xE6, 0x52, 
+0x9E, 0xB7, 0xAA, 0x99, 0x71, 0xAF, 0xAA, 0x35, 
+0x28, 0x9D, 0x98, 0x69, 0xD4, 0x33, 0x24, 0xC5, 
+0x05, 0xCF, 0x08, 0x32, 0x8A, 0x2A, 0xD0, 0xCF, 
+0xF6, 0x25, 0xB5, 0xF3, 0xCD, 0x30, 0x0A, 0x28, 
+0xDB, 0x6F, 0x8E, 0x5D, 0xD5, 0x42, 0xA6, 0x36, 
+0xAD, 0xFA, 0x32, 0x46, 0xFE, 0x08, 0xE5, 0x6F, 
+0x94, 0x72, 0x64, 0x48, 0x85, 0x79, 0x24, 0x1C, 
+0x3E, 0xDE, 0x74, 0x7D, 0x5A, 0xCD, 0x38, 0x60, 
+0x7C, 0xC5, 0x3D, 0xFF, 0x06, 0x9F, 0x76, 0x3A, 
+0xC3, 0x45, 0xBE, 0xCD, 0x83, 
+
+0xBF, 0xDE, 0x8D, 0x95, 0x8C, 0x16, 0x6A, 0x0E, 
+0xE1, 0x0B, 0xC3, 0xC7, 0x3B, 0x74, 0x18, 0x5F, 
+0xEC, 0xB9, 0x99, 0x32, 0x3E, 0x5E, 0x16, 0x7C, 
+0x45, 0x4A, 0x5A, 0xFD, 0xA6, 0x65, 0x8F, 0xFF, 
+0x77, 0x45, 0xF0, 0x43, 0xDD, 0xAC, 0x02, 0x2E, 
+0xD2, 0x80, 0x1C, 0x6E, 0xEB, 0x4B, 0x49, 0x4D, 
+0x5C, 0x13, 0xF5, 0x8C, 0x88, 0xE1, 0xE6, 0x35, 
+0xB1, 0xA7, 0x4B, 0xC9, 0xB3, 0x13, 0x43, 0x7D, 
+0xC7, 0xDC, 0x73, 0x91, 0xBE, 0xBC, 0xAD, 0xED, 
+0xD0, 0x9A, 0x53, 0xC1, 0x61, 0x07, 0x43, 0x94, 
+0xEC, 0x26, 0x1E, 0xFE, 0x2E, 0x24, 0x5F, 0xB9, 
+0x26, 0x45, 0x33, 0xE4, 0xB1, 0x92, 0x38, 0x9E, 
+0x2D, 0x65, 0x75, 0xE8, 0x23, 0x14, 0x50, 0x0D, 
+0x31, 0x9C, 0x3B, 0xAA, 0x11, 0x61, 0xC2, 0x2A, 
+0x77, 0x39, 0x61, 0xEB, 0xDF, 0x0F, 0x59, 0x79, 
+0x80, 0x8E, 0xD1, 0x73, 0x6F, 0xB5, 0xF6, 0xF4, 
+0xB5, 0x32, 0xB3, 0x23, 0x29, 0xD5, 0x53, 0xBF, 
+0xE5, 0xA7, 0x5F, 0x52, 0x7E, 0x42, 0x2C, 0xB8, 
+0xB7, 0x54, 0x67, 0x92, 0xDF, 0x37, 0xF9, 0xBF, 
+0xC3, 0x84, 0x4E, 0x69, 0x52, 0x38, 0xCC, 0xDB, 
+0xDD, 0x8F, 0x14, 0x91, 0xD4, 0xCB, 0xF1, 0x9B, 
+0xD6, 0x14, 0x8E, 0xE1, 0x27, 0xB0, 0x94, 0x4F, 
+0x02, 0x38, 0x40, 0xF5, 0xDE, 0xE6, 0x35, 0x65, 
+0x4F, 0xAC, 0x9C, 0xFE, 0xBD, 0x32, 0x0A, 0x4D, 
+0xCA, 0x4D, 0x77, 0x67, 0x17, 0x1B, 0x0B, 0x2D, 
+0x5A, 0x9D, 0x0E, 0xDF, 0x33, 0xFC, 0xC1, 0x81, 
+0xBB, 0x7D, 0x65, 0x72, 0x3D, 0x64, 0xFB, 0xC7, 
+0xD6, 0xB8, 0xFB, 0xCB, 0x21, 0x79, 0x52, 0xCD, 
+0x1E, 0xE0, 0xE9, 0x22, 0x2E, 0xE9, 0xD3, 0x9F, 
+0x3B, 0xE3, 0x59, 0xBA, 0x9A, 0x0B, 0xB9, 0x61, 
+0x16, 0x71, 0xBD, 0xD7, 0x1A, 0x92, 
+
+0x63, 0xA1, 0x66, 0x25, 0x2B, 0x5A, 0x84, 0xE7, 
+0xA2, 0x37, 0xB3, 0xFA, 0x15, 0xAB, 0x13, 0x6D, 
+0x27, 0x84, 0xD3, 0x85, 0xCA, 0x98, 0x5D, 0x92, 
+0x08, 0x59, 0x3A, 0x50, 0x14, 0xAE, 0x71, 0x78, 
+0x34, 0x90, 0x90, 0x2E, 0xAF, 0x7A, 0xBC, 0xF8, 
+0x4A, 0xD6, 0x6C, 0xED, 0x69, 0x00, 0x0C, 0x75, 
+0x71, 0x07, 0x67, 0x57, 0x3D, 0xE7, 0x81, 0x46, 
+0xFC, 0x68, 0xA6, 0x74, 0x5E, 0x06, 0xDD, 0xC1, 
+0x4B, 0x3F, 0x11, 0xD7, 0x75, 0x0C, 0x13, 0x39, 
+0xA0, 0x6E, 0x5C, 0x2E, 0x90, 0xC8, 0x5E, 0x9F, 
+0xCF, 0x3B, 0x50, 0x97, 0xF3, 0x34, 0xB3, 0x3F, 
+0xEA, 0x07, 0xC9, 0x25, 0x1A, 0x1E, 0x15, 0x94, 
+0x8C, 0xC7, 0x07, 0x44, 0x8C, 0xA9, 0xE5, 0x68, 
+0x5B, 0x0D, 0x22, 0x78, 0x64, 0x69, 0x38, 0x5E, 
+0x25, 0xF4, 0x7D, 0x02, 0xE9, 0x3A, 0xD9, 0x13, 
+0x4D, 0xDF, 0xA2, 0x77, 0x29, 0xB0, 0x50, 0x9B, 
+0x46, 0x01, 0x74, 0xF2, 0xDA, 0x1A, 0xA8, 0x98, 
+0xEE, 0xAD, 0xA5, 0xB8, 0x02, 0x46, 0xAA, 0x74, 
+0x06, 0x4C, 0x23, 0x58, 0x80, 0x96, 0x9A, 0xDF, 
+0x67, 0x5D, 0x0F, 0x2E, 0x24, 0x6E, 0x6E, 0xF3, 
+0x56, 0xD7, 0x8B, 0xE3, 0x4C, 0x60, 0x0B, 0xA4, 
+0xFE, 0xFF, 0x8C, 0xD1, 0x27, 0xC5, 0x52, 0x04, 
+0x3D, 0x45, 0xA8, 0x9C, 0x17, 0x43, 0x31, 0x11, 
+0x7D, 0xBD, 0x5E, 0xA2, 0x71, 0xAD, 0x78, 0xBB, 
+0x3E, 0x4E, 0xB5, 0xD2, 0xB2, 0x70, 0x16, 0x7E, 
+0xA6, 0xF4, 0x05, 0xB6, 0x6D, 0x33, 0xD4, 0xD0, 
+0x92, 0xB5, 0x67, 0x3A, 0xEC, 0x70, 0x52, 0x45, 
+0x98, 0x34, 0x2C, 0xFE, 0x4A, 0x6C, 0x57, 0xEE, 
+0x1F, 0xC9, 0xA1, 0xBE, 0x8B, 0x24, 0xFF, 0xE2, 
+0xE4, 0x24, 0xF0, 0xBA, 0x9B, 0xA4, 0x8D, 0x10, 
+0x0E, 0x68, 0xA3, 0xD6, 0x30, 0xB6, 0x80, 
+
+0x29, 0x3D, 0x66, 0x9B, 0x2F, 0x49, 0x92, 0xE1, 
+0x61, 0xDC, 0x25, 0xC3, 0x62, 0x48, 0x7C, 0x07, 
+0xA8, 0x6E, 0x9D, 0x11, 0xBF, 0x4D, 0xBB, 0x33, 
+0x3C, 0xD6, 0x2F, 0x0B, 0x88, 0xDE, 0xB5, 0xEC, 
+0x30, 0x13, 0xA2, 0xCA, 0x32, 0xB0, 0xCE, 0x97, 
+0xB2, 0x60, 0x19, 0xD3, 0x25, 0x5A, 0x0C, 0x7C, 
+0x56, 0xFF, 0x66, 0x8F, 0x11, 0xA4, 0x88, 0x19, 
+0x74, 0xA6, 0xD0, 0x0E, 0x13, 0x9E, 0x04, 0x43, 
+0x45, 0xAF, 0xE2, 0xCE, 0x5B, 0xD6, 0xF2, 0x8E, 
+0x00, 0x9F, 0xD2, 0xCC, 0x02, 0x5D, 0x44, 0xEA, 
+0xBD, 0xE4, 0x89, 0xA9, 0x8A, 0xB9, 0x76, 0xD1, 
+0x4A, 0x87, 0xC5, 0x32, 0x4A, 0x0C, 0xDC, 0x58, 
+0x69, 0xC8, 0x2E, 0xAF, 0x47, 0x47, 0x39, 0x26, 
+0xD7, 0x4E, 0x64, 0x35, 0xF5, 0xB0, 0x76, 0x4E, 
+0xEA, 0x36, 0x27, 0x50, 0x3C, 0xAE, 0xDF, 0x79, 
+0x4D, 0x71, 0xF6, 0xE3, 0x35, 0xA0, 0x28, 0xC7, 
+0x82, 0xB4, 0x49, 0x28, 0x1A, 0x93, 0x39, 0x53, 
+0x99, 0x08, 0xBE, 0xD9, 0x34, 0x5E, 0xE6, 0xAD, 
+0xE6, 0x8D, 0x77, 0x9C, 0x3F, 0x58, 0x9A, 0x1F, 
+0x9A, 0xEA, 0xE7, 0xC2, 0x93, 0x3D, 0x16, 0x23, 
+0xBC, 0x5B, 0x20, 0x39, 0xCA, 0x24, 0x12, 0xFB, 
+0x09, 0xEC, 0x12, 0xC0, 0x91, 0xFC, 0xB0, 0xB5, 
+0x8B, 0x02, 0xE2, 0x1C, 0xC1, 0xE4, 0xCF, 0xBE, 
+0xD5, 0x61, 0xC1, 0xF7, 0x4A, 0xEC, 0x37, 0x69, 
+0xBA, 0xCE, 0xDB, 0x15, 0x94, 0xD2, 0x6B, 0x1D, 
+0x85, 0xFF, 0x03, 0x6A, 0x73, 0xAC, 0xB4, 0x92, 
+0xFC, 0x4A, 0xE3, 0x54, 0x58, 0x34, 0xFC, 0x1C, 
+0xF9, 0x00, 0x09, 0x92, 0xDF, 0x6D, 0xF3, 0xAE, 
+0x2F, 0xA7, 0x1D, 0xA6, 0x0B, 0x8D, 0x81, 0x9D, 
+0x25, 0x15, 0xE9, 0xB3, 0x88, 0x20, 0xEA, 0x79, 
+0x95, 0x93, 0xFC, 0xF1, 0xC7, 0x3C, 0xA8, 0xA7, 
+
+0x3B, 0x29, 0xAB, 0xB8, 0xC4, 0x89, 0x77, 0x20, 
+0xCC, 0x74, 0x28, 0xA8, 0x8D, 0x8B, 0xE6, 0x3B, 
+0x7C, 0x3F, 0x41, 0xA3, 0x4C, 0x39, 0x6F, 0xA6, 
+0x44, 0x6D, 0xDF, 0xAB, 0x6C, 0xB4, 0x12, 0xD8, 
+0x3A, 0x79, 0xCF, 0x77, 0x7A, 0xCD, 0x84, 0xD6, 
+0x7E, 0x5D, 0xFC, 0x4A, 0x97, 0x6D, 0x60, 0x7A, 
+0x77, 0x81, 0xA3, 0x57, 0xF1, 0x24, 0x84, 0x01, 
+0x43, 0xC6, 0x1A, 0xD6, 0x51, 0x64, 0x8F, 0x5B, 
+0xD2, 0x80, 0x4B, 0xF4, 0x35, 0xB2, 0xD3, 0x98, 
+0x0D, 0x87, 0x28, 0x63, 0x83, 0x5B, 0x28, 0x39, 
+0x62, 0x81, 0x00, 0x6B, 0xBE, 0x15, 0x93, 0x95, 
+0x20, 0x57, 0x5D, 0xB6, 0x58, 0xD4, 0xFC, 0x5A, 
+0x45, 0xC6, 0x53, 0x01, 0x3B, 0x9E, 0xA4, 0x0D, 
+0x3F, 0x71, 0x16, 0x9E, 0xAB, 0x98, 0xB8, 0x13, 
+0x6B, 0x51, 0x5C, 0x77, 0xED, 0x95, 0x45, 0xD0, 
+0xCE, 0xE0, 0x4C, 0xDD, 0x44, 0xF2, 0x6C, 0x26, 
+0x35, 0xEF, 0xF0, 0xE1, 0x1C, 0xB0, 0x73, 0x00, 
+0x46, 0x22, 0x8B, 0xAE, 0x03, 0x34, 0x70, 0x64, 
+0xDB, 0xB4, 0xAD, 0x32, 0xAD, 0x5B, 0xF1, 0x6F, 
+0xA8, 0x57, 0x33, 0x4B, 0xD9, 0x27, 0x65, 0xCC, 
+0xDF, 0x28, 0x67, 0x48, 0xD8, 0x0B, 0xAE, 0x6E, 
+0x5E, 0xD6, 0xB9, 0x68, 0x40, 0x69, 0x7C, 0xA7, 
+0x0C, 0xB1, 0xB7, 0xAB, 0x47, 0xEA, 0xAC, 0xDD, 
+0xA7, 0xD0, 0x51, 0x41, 0x0B, 0x5A, 0x27, 0x82, 
+0x25, 0xCC, 0x83, 0xCE, 0x0C, 0xA6, 0xB0, 0xEA, 
+0xAC, 0x91, 0xF7, 0x27, 0xE1, 0x9C, 0xE9, 0x93, 
+0x79, 0x4F, 0xE4, 0x2E, 0xA7, 0xA9, 0x93, 0x8B, 
+0x4F, 0x46, 0x17, 0x30, 0xC6, 0x38, 0x52, 0x10, 
+0x20, 0xC9, 0x79, 0xAF, 0xC0, 0xBC, 0xD3, 0xE2, 
+0x40, 0x85, 0x9C, 0x39, 0x7A, 0x74, 0xB8, 0xAB, 
+0x5C, 0x16, 0x66, 0xAA, 0x40, 0x3E, 0xEF, 0x40, 
+0x26, 
+
+0xFE, 0xE3, 0x49, 0xEA, 0x05, 0xF2, 0x20, 0x3F, 
+0x9B, 0x7A, 0x41, 0x16, 0x25, 0xF8, 0xBD, 0x58, 
+0x0E, 0x97, 0xA8, 0x37, 0xB8, 0x62, 0x15, 0xDD, 
+0x02, 0x59, 0x46, 0xFB, 0xF5, 0x2E, 0x70, 0x96, 
+0xF9, 0xFC, 0x8B, 0x5C, 0xB1, 0x30, 0xDC, 0x33, 
+0x1C, 0x44, 0x9D, 0x45, 0xC7, 0x9F, 0xB6, 0x8C, 
+0x7D, 0xF1, 0x15, 0x9F, 0x37, 0xBF, 0xC6, 0x58, 
+0x73, 0x7F, 0x4B, 0x14, 0x6C, 0xD3, 0x5C, 0xE8, 
+0xEE, 0x45, 0xE9, 0x41, 0x2F, 0x90, 0x17, 0xC0, 
+0xA0, 0xBF, 0xD1, 0x6B, 0xCF, 0xB5, 0xE0, 0x63, 
+0x63, 0xE4, 0x57, 0x57, 0xD8, 0x37, 0xAE, 0x66, 
+0x7C, 0x7E, 0x7C, 0xC8, 0xC9, 0x3D, 0x1E, 0x5C, 
+0x88, 0x38, 0x2E, 0xD4, 0xE5, 0x05, 0xFB, 0x0A, 
+0xF4, 0x1E, 0x40, 0x7A, 0x45, 0xFB, 0x74, 0x3F, 
+0xCD, 0x69, 0x91, 0xF5, 0x62, 0xAF, 0x75, 0x7E, 
+0x44, 0xAB, 0x48, 0x1E, 0x2E, 0x78, 0xE7, 0xAC, 
+0xF3, 0xD1, 0x08, 0x9B, 0x82, 0x8B, 0x43, 0xEF, 
+0xF8, 0x73, 0x40, 0xA7, 0x8F, 0x3F, 0xBD, 0x62, 
+0x37, 0xAE, 0x63, 0xC5, 0x04, 0x6A, 0x8F, 0x2D, 
+0x8A, 0xD2, 0x0E, 0xBA, 0x49, 0x61, 0xFE, 0xA3, 
+0xA2, 0x52, 0xF5, 0x7E, 0xE6, 0x0D, 0x76, 0x2D, 
+0x5D, 0x49, 0x20, 0x42, 0xFE, 0x4B, 0xCC, 0x14, 
+0x45, 0x6C, 0x9F, 0x99, 0xFB, 0x37, 0xC9, 0xEF, 
+0x1F, 0xBB, 0x87, 0xCE, 0xA4, 0x69, 0x31, 0xBA, 
+0x19, 0x52, 0x18, 0xE0, 0x2D, 0x12, 0x29, 0x58, 
+0xAC, 0xB6, 0xBD, 0xA1, 0xC8, 0x9D, 0xE4, 0x20, 
+0xE6, 0xE0, 0x41, 0x32, 0xA6, 0x41, 0xFF, 0xE2, 
+0xA1, 0x98, 0xA5, 0x21, 0x27, 0xB8, 0xD9, 0x5D, 
+0x5F, 0xE6, 0x2E, 0xC8, 0x61, 0xC7, 0xF3, 0x42, 
+0xAB, 0xF2, 0x48, 0xF7, 0x7E, 0x89, 0x92, 0x93, 
+0x0C, 0x3C, 0x04, 0xD7, 0x60, 0x3B, 0xCE, 0x51, 
+0x11, 0x92, 
+
+0x1C, 0xAD, 0xFD, 0x12, 0x26, 0x9F, 0x4F, 0x73, 
+0xB6, 0xC2, 0x83, 0x92, 0xE2, 0xDF, 0xA4, 0xDA, 
+0xE0, 0xA2, 0x71, 0x62, 0x56, 0x80, 0xEF, 0x1B, 
+0x8E, 0x67, 0x09, 0xAE, 0x18, 0x4B, 0x70, 0xDA, 
+0x94, 0xCA, 0x70, 0xF4, 0x89, 0x1F, 0x9A, 0xAD, 
+0x72, 0x85, 0x28, 0xC0, 0x0E, 0xB6, 0x4B, 0x23, 
+0x76, 0x8B, 0x14, 0xD4, 0x6C, 0xDE, 0x6B, 0xBE, 
+0xAC, 0x1C, 0xDE, 0x44, 0x30, 0x5D, 0xC0, 0x66, 
+0x2C, 0xF7, 0x21, 0xD8, 0xF4, 0x4F, 0x23, 0x1D, 
+0x21, 0xFC, 0xE5, 0xC9, 0xE2, 0xBA, 0x5B, 0xD6, 
+0xF5, 0xD0, 0x77, 0x58, 0x93, 0xC7, 0x62, 0x62, 
+0xE8, 0x94, 0xB1, 0xC0, 0xA4, 0xCA, 0x4D, 0x15, 
+0x7D, 0xED, 0x15, 0xC5, 0xB1, 0xF1, 0x38, 0xD0, 
+0xA1, 0x91, 0xE3, 0x61, 0x72, 0x4C, 0x1A, 0x30, 
+0xF7, 0xAF, 0xE7, 0x41, 0xED, 0xC3, 0x14, 0x90, 
+0x94, 0xEE, 0x3E, 0x9B, 0x87, 0x49, 0x73, 0x3D, 
+0x9F, 0x20, 0xFD, 0x19, 0x61, 0xFA, 0xAC, 0x17, 
+0xCC, 0xEE, 0x98, 0x1F, 0x9B, 0x7C, 0x5B, 0x0E, 
+0x83, 0x00, 0xFE, 0x6B, 0x5A, 0xD9, 0x87, 0x69, 
+0x5B, 0x4C, 0x07, 0x28, 0xC8, 0xE7, 0xD3, 0xD4, 
+0xD1, 0x08, 0x7A, 0x26, 0x5A, 0xD2, 0x74, 0x64, 
+0x65, 0x91, 0x0B, 0xF6, 0x14, 0x1A, 0x25, 0xE9, 
+0x44, 0x77, 0xDF, 0x65, 0x40, 0xE3, 0x79, 0x78, 
+0x57, 0x02, 0x4B, 0x09, 0x5D, 0x36, 0x65, 0x25, 
+0x53, 0x30, 0x09, 0xBF, 0xD2, 0x33, 0x86, 0x90, 
+0x84, 0x8E, 0x49, 0x13, 0x2A, 0x26, 0x53, 0xC6, 
+0x29, 0x61, 0x18, 0x56, 0xD8, 0x58, 0xA4, 0xC4, 
+0x95, 0xAC, 0xFE, 0x11, 0x1F, 0x04, 0x3F, 0x98, 
+0xA8, 0x7D, 0x1F, 0x03, 0x52, 0x66, 0x22, 0x1B, 
+0x63, 0x4B, 0xF8, 0x9B, 0x85, 0xD9, 0xCE, 0x20, 
+0x75, 0xA1, 0xE9, 0x66, 0xBE, 0x82, 0x79, 0x32, 
+0xC1, 0x1E, 0x92, 
+
+0xA4, 0x07, 0x92, 0x8E, 0xA0, 0x22, 0x35, 0x3B, 
+0xB3, 0x80, 0x38, 0x16, 0xDD, 0x41, 0x2F, 0x1C, 
+0x15, 0xE1, 0x05, 0xCD, 0x03, 0xA5, 0x17, 0x79, 
+0xBF, 0x0C, 0xB1, 0xBD, 0x28, 0x3E, 0xAF, 0x02, 
+0xC9, 0x9B, 0x34, 0xD3, 0xE0, 0x7E, 0x26, 0x76, 
+0xA2, 0x23, 0xBB, 0x44, 0xC4, 0x64, 0xCA, 0x9B, 
+0x02, 0x21, 0x47, 0xC7, 0x88, 0x0A, 0x81, 0xF2, 
+0x67, 0xE9, 0x0E, 0x08, 0x0B, 0x8D, 0x2D, 0x5F, 
+0x11, 0xCD, 0xBF, 0x13, 0xD3, 0x2C, 0x4D, 0x13, 
+0xC4, 0xC0, 0xE0, 0x48, 0xA8, 0xB2, 0x91, 0x00, 
+0x2D, 0xC9, 0x52, 0x7C, 0xFB, 0xF3, 0x84, 0x99, 
+0x56, 0x25, 0xC4, 0x3D, 0xAD, 0x38, 0xC7, 0x5D, 
+0xC9, 0xC0, 0x29, 0xDB, 0x9B, 0x88, 0x0C, 0xC9, 
+0x82, 0x0A, 0xCE, 0x72, 0x73, 0xDC, 0x11, 0x5F, 
+0x10, 0x72, 0x4C, 0x16, 0x72, 0xD9, 0x7B, 0x0D, 
+0xCD, 0x64, 0x20, 0x52, 0xA0, 0x31, 0x26, 0x88, 
+0x0A, 0xAE, 0x0F, 0x22, 0x85, 0x1D, 0x31, 0x85, 
+0x74, 0x7C, 0x5E, 0xC3, 0xE1, 0xD1, 0x01, 0x29, 
+0x93, 0x10, 0xEB, 0x10, 0xD8, 0x54, 0xFD, 0xD5, 
+0xBA, 0xEB, 0x54, 0xF0, 0xA7, 0x80, 0x02, 0x1E, 
+0x16, 0xA4, 0xD7, 0x75, 0xEB, 0x4D, 0x17, 0x24, 
+0x62, 0xEF, 0x36, 0xD8, 0x21, 0xB9, 0x7C, 0xA7, 
+0x87, 0x70, 0xAE, 0x3A, 0xD4, 0xDC, 0xF8, 0xB8, 
+0x44, 0x3C, 0x9D, 0xF4, 0x9C, 0x07, 0x8D, 0x14, 
+0xFE, 0xB8, 0x8F, 0x9A, 0x51, 0x2C, 0x60, 0xA9, 
+0xBB, 0x9D, 0xC4, 0x92, 0x2D, 0x1E, 0x8F, 0xAA, 
+0x3E, 0x13, 0xC7, 0xD9, 0x5C, 0x63, 0x3A, 0x1C, 
+0x86, 0xC1, 0x8D, 0x10, 0x08, 0x9C, 0x11, 0x6D, 
+0xA1, 0x7F, 0x85, 0x80, 0x9C, 0x6C, 0x63, 0xCD, 
+0x0F, 0x83, 0x71, 0x85, 0x78, 0x90, 0x45, 0x8F, 
+0x9F, 0x60, 0x32, 0x2F, 0x8B, 0xB6, 0x4A, 0x31, 
+0xD7, 0x3A, 0xB6, 0x6C, 
+
+0x70, 0xB7, 0x43, 0xE9, 0xB2, 0xBA, 0x3E, 0xE1, 
+0x39, 0xEF, 0xC7, 0xA8, 0x3B, 0x5B, 0xC5, 0x34, 
+0x3A, 0xB1, 0xBC, 0x53, 0x18, 0xD0, 0x68, 0xD2, 
+0x2E, 0x4D, 0xCA, 0x44, 0x1C, 0x30, 0xB0, 0xCC, 
+0x3D, 0x67, 0x7F, 0x1C, 0x46, 0xB4, 0xC8, 0xB8, 
+0xA3, 0x4E, 0x86, 0xBE, 0x6D, 0x40, 0xD2, 0xE6, 
+0x7A, 0x73, 0x26, 0xF9, 0x9D, 0x1B, 0x91, 0xEB, 
+0xEC, 0x5E, 0xE4, 0xB7, 0xEF, 0xD8, 0x49, 0xBB, 
+0x04, 0xCA, 0x65, 0xF2, 0x2D, 0xEA, 0x07, 0xB6, 
+0x91, 0x9F, 0x84, 0xFE, 0x19, 0xB8, 0x25, 0x22, 
+0x38, 0x94, 0x74, 0xC3, 0x97, 0x4D, 0xC7, 0x76, 
+0xA8, 0xC1, 0x60, 0x4B, 0x3E, 0x2C, 0x1D, 0x71, 
+0x2B, 0x02, 0x0C, 0x9E, 0xF6, 0xA0, 0x12, 0xC8, 
+0xC3, 0xD7, 0xD5, 0x65, 0x9E, 0xD9, 0x89, 0xB3, 
+0xE2, 0x15, 0x08, 0xD5, 0xD8, 0xC7, 0x97, 0x7B, 
+0x59, 0x0F, 0xC5, 0x18, 0x89, 0x32, 0x14, 0xB5, 
+0x32, 0x22, 0xDA, 0x44, 0x65, 0x62, 0x27, 0x4F, 
+0xB7, 0x6C, 0xC9, 0x59, 0x4C, 0x40, 0x6A, 0xA5, 
+0x8F, 0xF0, 0xB5, 0x37, 0xAE, 0xB4, 0xC5, 0xDF, 
+0xE5, 0x2F, 0x8D, 0x92, 0x04, 0x09, 0x13, 0x72, 
+0x08, 0x1A, 0x7F, 0x78, 0x23, 0x58, 0x92, 0x7C, 
+0x11, 0x64, 0x2D, 0x80, 0x5B, 0xA2, 0x05, 0x55, 
+0xCF, 0x84, 0x1C, 0xE2, 0x8A, 0x90, 0xC2, 0xF3, 
+0x2A, 0xD9, 0x09, 0xA2, 0xFB, 0xF0, 0x05, 0x3E, 
+0x93, 0xD0, 0xC8, 0xBF, 0x2C, 0x86, 0x7A, 0xD9, 
+0x9B, 0x4A, 0xA2, 0x96, 0x3D, 0xB8, 0xC9, 0x81, 
+0xA5, 0x20, 0x3D, 0x00, 0xA1, 0x5C, 0x9D, 0x96, 
+0xDB, 0xA1, 0xD9, 0xFC, 0x70, 0xF4, 0x72, 0x80, 
+0xCC, 0x50, 0xC5, 0x6F, 0xCD, 0xAB, 0xF4, 0x34, 
+0x26, 0xAD, 0xDE, 0x94, 0x54, 0xEC, 0x61, 0x93, 
+0x01, 0x07, 0x9D, 0x36, 0x45, 0xA0, 0x6F, 0x2E, 
+0x1C, 0xD3, 0x91, 0x3E, 0xD9, 
+
+0xBE, 0x3A, 0x8C, 0xD1, 0xED, 0x3C, 0x63, 0xA3, 
+0x8B, 0xE6, 0xEC, 0x3D, 0xDF, 0x90, 0x41, 0xAD, 
+0x45, 0xB4, 0x6F, 0x61, 0xBB, 0x8D, 0xA6, 0x2B, 
+0x2C, 0x36, 0x2D, 0xC9, 0x60, 0xAE, 0xF7, 0x1C, 
+0x22, 0x98, 0x7E, 0x95, 0x82, 0x26, 0x3B, 0x08, 
+0x5D, 0x4D, 0x9E, 0x64, 0xE2, 0x98, 0x2F, 0xB9, 
+0x57, 0xD7, 0x2F, 0x2C, 0x1D, 0x6D, 0x82, 0x3D, 
+0xB7, 0x7D, 0x72, 0x1A, 0xF9, 0xB4, 0x2F, 0x69, 
+0x89, 0xC3, 0x11, 0x9E, 0xA8, 0x72, 0xF0, 0xFE, 
+0x92, 0xD2, 0x11, 0x54, 0x24, 0xDC, 0x01, 0x0B, 
+0x28, 0xDE, 0x45, 0x89, 0x4A, 0xB0, 0x9D, 0x11, 
+0x55, 0x9F, 0x57, 0x58, 0xC6, 0x92, 0x23, 0x0E, 
+0xC5, 0x68, 0x2E, 0x11, 0x02, 0x74, 0xE6, 0x30, 
+0x2C, 0x71, 0xB6, 0x42, 0x89, 0x07, 0x9E, 0x7F, 
+0xC1, 0xB9, 0x6E, 0x56, 0x98, 0xAA, 0x89, 0xB5, 
+0x01, 0xB3, 0xB0, 0x1C, 0x18, 0xD4, 0x4E, 0xD2, 
+0x5C, 0xB2, 0xC2, 0xA3, 0x73, 0x3E, 0x0D, 0x9D, 
+0xF5, 0x7C, 0xAA, 0x56, 0x4E, 0xD6, 0x9D, 0xF6, 
+0xD2, 0x18, 0x38, 0x43, 0xBD, 0xF9, 0x88, 0x23, 
+0x75, 0x1E, 0xB2, 0xC1, 0x92, 0xAD, 0x04, 0xA1, 
+0x3D, 0xB1, 0x5C, 0x3C, 0x47, 0x98, 0xD8, 0x6E, 
+0xF9, 0x40, 0x1B, 0xE4, 0xAB, 0xA1, 0x13, 0x2A, 
+0x02, 0x1F, 0xEA, 0x23, 0x7B, 0xD5, 0x05, 0xB5, 
+0x36, 0x81, 0xC2, 0x3F, 0xE1, 0xB7, 0xB5, 0x87, 
+0x6D, 0x37, 0x9F, 0x22, 0xC9, 0x20, 0x94, 0xC1, 
+0x0F, 0xB3, 0x38, 0x32, 0x28, 0x59, 0x47, 0xE8, 
+0x04, 0x9E, 0x5A, 0x50, 0xE1, 0x76, 0x01, 0x0F, 
+0x79, 0x33, 0x49, 0x3D, 0x77, 0xC3, 0x6A, 0x63, 
+0xE5, 0x8F, 0x4C, 0x6E, 0x57, 0x31, 0x18, 0x61, 
+0x33, 0x46, 0xB3, 0x67, 0x47, 0xFC, 0x98, 0xE0, 
+0xDF, 0x2E, 0xCF, 0x84, 0xC7, 0xA0, 0x02, 0xE1, 
+0xD6, 0x4D, 0xA7, 0xF2, 0x7B, 0xDE, 
+
+0x79, 0xD8, 0xF7, 0x6A, 0x34, 0x3E, 0x98, 0xB4, 
+0x05, 0x6F, 0x49, 0x33, 0x59, 0xD1, 0x7D, 0x71, 
+0xC6, 0x81, 0xE2, 0xB8, 0xB3, 0xA2, 0x2D, 0x04, 
+0xCC, 0xDC, 0x22, 0xCA, 0xF9, 0x9A, 0xE1, 0xAD, 
+0xE8, 0xB5, 0xB6, 0x15, 0xFC, 0x4E, 0x19, 0xA7, 
+0x05, 0xA3, 0xD3, 0xDD, 0x5C, 0x1A, 0xB6, 0xDF, 
+0xFE, 0xD4, 0x18, 0x52, 0xB1, 0xC9, 0x1F, 0x77, 
+0xBA, 0xDF, 0xB5, 0x4E, 0x41, 0xBC, 0xED, 0xEB, 
+0x3D, 0x93, 0xA8, 0xB8, 0x60, 0x62, 0x00, 0x12, 
+0x8A, 0xE5, 0x23, 0x59, 0xFD, 0x02, 0x76, 0x49, 
+0xC3, 0xA6, 0x7B, 0x58, 0x29, 0xFC, 0x44, 0x71, 
+0x51, 0x38, 0xAC, 0x52, 0x8B, 0x01, 0xB6, 0x0F, 
+0x44, 0xD3, 0xDE, 0x80, 0x08, 0xA5, 0xD9, 0x8E, 
+0x6F, 0x20, 0x24, 0x03, 0xEB, 0x66, 0xCB, 0x1C, 
+0x5F, 0xD9, 0x98, 0x36, 0x9F, 0xE5, 0x76, 0x13, 
+0x8B, 0x34, 0xDE, 0x0F, 0xDB, 0xA3, 0x26, 0xF1, 
+0xEC, 0xCC, 0x57, 0x08, 0xE8, 0xA4, 0x57, 0x73, 
+0xC2, 0xDC, 0xCB, 0x32, 0xFE, 0xD5, 0x0D, 0x2A, 
+0x2A, 0xD7, 0xA5, 0x21, 0xF5, 0xF9, 0xE7, 0x0C, 
+0x63, 0x49, 0xF4, 0x64, 0x82, 0xE7, 0x5D, 0x5F, 
+0x04, 0xC8, 0x99, 0x9F, 0xDC, 0x34, 0x84, 0xFE, 
+0x41, 0x23, 0x87, 0x43, 0xD3, 0x7C, 0x7A, 0xDF, 
+0x46, 0xC8, 0x55, 0x4A, 0x94, 0x05, 0x71, 0x61, 
+0x61, 0x4C, 0xD6, 0x57, 0x1F, 0x0D, 0x65, 0x2C, 
+0x9C, 0xE3, 0xC4, 0x5A, 0x68, 0x58, 0x2C, 0xBC, 
+0x65, 0x0D, 0x5E, 0x55, 0x13, 0xD0, 0x3C, 0x75, 
+0xA6, 0xF6, 0x43, 0x1E, 0x65, 0x00, 0x85, 0x88, 
+0x9A, 0x7F, 0xA3, 0x4E, 0x03, 0xA8, 0x23, 0x0E, 
+0x5B, 0xE1, 0x60, 0xE9, 0xE6, 0xB3, 0x5A, 0xAE, 
+0x64, 0xC1, 0xFC, 0x41, 0x4F, 0xC2, 0xB9, 0xFF, 
+0x18, 0x88, 0x38, 0x08, 0x1F, 0xDB, 0x55, 0xF0, 
+0x40, 0xBE, 0xC0, 0x98, 0xF6, 0x48, 0x7A, 
+
+0x93, 0x3B, 0x26, 0xBF, 0x89, 0x81, 0x3B, 0xD6, 
+0x07, 0xFB, 0x0F, 0x45, 0x03, 0x1C, 0x12, 0xA2, 
+0xA3, 0xCA, 0x96, 0xA7, 0x67, 0xEB, 0x50, 0xED, 
+0xCB, 0x3A, 0xC6, 0x29, 0xF0, 0xB1, 0x56, 0x2C, 
+0xEE, 0xB5, 0x53, 0xA6, 0x0B, 0xAB, 0x51, 0x9E, 
+0x54, 0xB3, 0x71, 0x8F, 0x86, 0x79, 0xC2, 0x92, 
+0x12, 0xE9, 0xA1, 0x0A, 0xEB, 0xDE, 0xA5, 0x8C, 
+0x1F, 0x6D, 0x0B, 0x80, 0xF1, 0x86, 0x91, 0x46, 
+0xBE, 0x37, 0x32, 0xB1, 0x78, 0xED, 0x24, 0xB0, 
+0xFB, 0xB9, 0x19, 0xD1, 0x4F, 0x83, 0x7D, 0x12, 
+0x88, 0x1D, 0x23, 0xDF, 0x0B, 0xB3, 0xAF, 0xE3, 
+0xF7, 0x03, 0x63, 0x38, 0x8A, 0x92, 0xDB, 0xB4, 
+0x4A, 0x7C, 0xB1, 0x3D, 0xAE, 0xB7, 0x46, 0xB0, 
+0xEC, 0x95, 0xC4, 0xC1, 0xDA, 0xA1, 0x43, 0x93, 
+0x98, 0x01, 0x07, 0x29, 0xFA, 0xDB, 0xBA, 0xC2, 
+0x75, 0x99, 0xB2, 0xA5, 0xB5, 0xEC, 0x06, 0x48, 
+0x09, 0x6C, 0xD8, 0xDC, 0x4D, 0x3F, 0xE1, 0x70, 
+0x82, 0x89, 0x73, 0xDF, 0xD4, 0x2A, 0xBD, 0xBA, 
+0x4E, 0xE9, 0x2E, 0xE8, 0x71, 0xB1, 0xA7, 0xF8, 
+0x08, 0x77, 0xA7, 0x15, 0x0C, 0x7E, 0x29, 0x38, 
+0x18, 0x1D, 0xFB, 0xA3, 0xD3, 0x91, 0xB6, 0x6D, 
+0xA3, 0x5F, 0x54, 0xC9, 0x78, 0xD1, 0x6A, 0x00, 
+0xEC, 0xD9, 0x9E, 0xAF, 0xF2, 0x3C, 0x49, 0xE0, 
+0x31, 0x5C, 0xAF, 0x71, 0x56, 0x88, 0xD3, 0xF5, 
+0x97, 0x95, 0x3E, 0x40, 0x48, 0x01, 0x72, 0xE6, 
+0x2C, 0xEE, 0x8A, 0x67, 0x20, 0x80, 0x50, 0x2A, 
+0x5B, 0xD4, 0x9B, 0xE3, 0x12, 0xDB, 0xC2, 0x07, 
+0x5A, 0x71, 0x5A, 0x30, 0xF1, 0xF1, 0x16, 0x75, 
+0x76, 0xA9, 0x87, 0xE4, 0x2E, 0x5A, 0x56, 0x8D, 
+0x2A, 0xD0, 0x47, 0xD7, 0x11, 0xAA, 0x7D, 0x1A, 
+0xA4, 0x79, 0x00, 0xF0, 0x5A, 0x9F, 0xD1, 0xA6, 
+0x44, 0xAB, 0xBD, 0x31, 0xF5, 0x41, 0x39, 0x7C, 
+
+0x11, 0xFE, 0x3D, 0xD5, 0x7C, 0x9D, 0xE1, 0xEF, 
+0x4B, 0x86, 0x1A, 0xFB, 0x2B, 0xD2, 0x05, 0xA9, 
+0x6C, 0x79, 0xA5, 0x68, 0xCF, 0x52, 0xBA, 0x57, 
+0x62, 0x67, 0x4F, 0xEF, 0xC8, 0x48, 0x6A, 0xA8, 
+0xCC, 0xCA, 0x54, 0x3D, 0xB3, 0xD8, 0x06, 0x90, 
+0x2D, 0x69, 0x52, 0xD5, 0x38, 0x5A, 0x69, 0x3B, 
+0x35, 0xDC, 0xFE, 0x46, 0xB8, 0x17, 0x34, 0xDB, 
+0x02, 0x5D, 0x88, 0x01, 0x01, 0x2C, 0xFF, 0x32, 
+0x28, 0x45, 0xC9, 0xBE, 0x4B, 0x33, 0xC4, 0xAE, 
+0xE1, 0x04, 0x88, 0x4C, 0xCE, 0x4E, 0xA1, 0xE7, 
+0xE4, 0x15, 0xE2, 0x28, 0xD0, 0xEA, 0xEB, 0x0D, 
+0xB0, 0x19, 0x55, 0x4C, 0x9B, 0x50, 0x4F, 0x3E, 
+0x5D, 0x68, 0x0A, 0xC4, 0x7D, 0x20, 0x75, 0xD3, 
+0xE7, 0x6B, 0x5B, 0x14, 0x99, 0xAF, 0x06, 0xF0, 
+0xF8, 0xFF, 0x26, 0xB1, 0xFB, 0xE7, 0xA9, 0x98, 
+0x3E, 0xF7, 0x63, 0xF1, 0x4A, 0xB3, 0xA8, 0xC3, 
+0x48, 0xD6, 0x97, 0x07, 0x44, 0x04, 0xA6, 0x76, 
+0x80, 0x47, 0xE1, 0xFB, 0xAF, 0xAC, 0x1D, 0x9E, 
+0x6F, 0x3C, 0x46, 0xB6, 0x68, 0xBD, 0x1F, 0xD9, 
+0xE9, 0x85, 0xBA, 0x73, 0x97, 0x81, 0xD5, 0x26, 
+0x96, 0x26, 0xF6, 0xFE, 0x2E, 0x7A, 0x13, 0x1A, 
+0x8F, 0xDC, 0xF2, 0x56, 0x5F, 0x93, 0x2E, 0xF5, 
+0xC3, 0xB0, 0x52, 0x8E, 0x9E, 0x58, 0x45, 0x0A, 
+0xC0, 0x04, 0xBB, 0x0C, 0xCB, 0x98, 0xF8, 0xE2, 
+0x1A, 0x79, 0x60, 0xF8, 0x8C, 0xE2, 0xD8, 0xFF, 
+0x72, 0x85, 0x69, 0x4C, 0x50, 0x04, 0x26, 0x40, 
+0x3D, 0x00, 0xC5, 0x93, 0x2F, 0x3B, 0xA0, 0xBF, 
+0x82, 0x6A, 0x67, 0xF1, 0xBF, 0x67, 0x56, 0x51, 
+0x99, 0x97, 0x71, 0x48, 0x98, 0x3E, 0xD0, 0x0F, 
+0x5C, 0x6F, 0x27, 0x12, 0xAB, 0xB2, 0xB1, 0x58, 
+0x2A, 0xBF, 0x4C, 0xAF, 0x23, 0x04, 0x07, 0x10, 
+0xD6, 0x1D, 0x11, 0x38, 0xD7, 0x3B, 0xB7, 0xFC, 
+0x58, 
+
+0x5A, 0xFF, 0x6D, 0xD7, 0x57, 0x34, 0x70, 0xFF, 
+0xEE, 0x0E, 0xE2, 0x43, 0xBA, 0x05, 0xAC, 0xED, 
+0x0A, 0x67, 0xD8, 0xCC, 0xAC, 0x4F, 0xE0, 0xAA, 
+0x7B, 0x13, 0xFC, 0x29, 0xB9, 0xF1, 0x0E, 0xF9, 
+0x57, 0xCA, 0x57, 0x82, 0x1F, 0x6C, 0x0B, 0x1E, 
+0xC2, 0x57, 0x46, 0xFE, 0xBD, 0xDD, 0xEE, 0x60, 
+0x62, 0x53, 0xCB, 0xB1, 0xC9, 0x4B, 0x6E, 0xF6, 
+0xCF, 0xCD, 0x16, 0xD3, 0x1A, 0x2E, 0x8B, 0xD3, 
+0x4D, 0xA2, 0x81, 0x8D, 0xCA, 0x4A, 0x9E, 0xFB, 
+0xF0, 0x76, 0x6B, 0xEB, 0x7E, 0xFE, 0xF3, 0xDE, 
+0xF6, 0xCD, 0x89, 0x44, 0xB9, 0xB2, 0xE3, 0x84, 
+0x4D, 0x60, 0x88, 0x9D, 0x8F, 0x73, 0x05, 0x2D, 
+0x28, 0x5F, 0xB6, 0xE9, 0x1E, 0x12, 0x9C, 0xAA, 
+0x55, 0xC1, 0x4A, 0x81, 0x74, 0x0B, 0x24, 0x13, 
+0x8D, 0x37, 0xE2, 0x3F, 0x57, 0x30, 0x99, 0x72, 
+0x55, 0xE9, 0x85, 0xB8, 0xF3, 0x91, 0x74, 0xC0, 
+0xCC, 0x40, 0xDA, 0x40, 0xAE, 0xA0, 0x3F, 0x4D, 
+0x83, 0x0A, 0xC6, 0xC1, 0xF6, 0xE7, 0x15, 0x4D, 
+0xB3, 0x62, 0xA8, 0xB1, 0x7A, 0x7D, 0xE9, 0x25, 
+0x16, 0x21, 0x73, 0x6B, 0x9D, 0x63, 0xEC, 0xD4, 
+0xB2, 0x52, 0x21, 0xB3, 0xAC, 0x0A, 0x69, 0x06, 
+0x9D, 0x5F, 0xAA, 0xC4, 0xD9, 0x09, 0x0B, 0x5F, 
+0x2F, 0xF9, 0x8D, 0x4D, 0xAB, 0xEF, 0x53, 0xDB, 
+0x66, 0xB0, 0x83, 0x81, 0xC8, 0x67, 0x00, 0xC9, 
+0x88, 0x7C, 0xD3, 0x01, 0x0E, 0x55, 0x81, 0x82, 
+0x77, 0x54, 0xCD, 0x80, 0xAE, 0x41, 0xB0, 0xC8, 
+0x38, 0xFC, 0xDE, 0x20, 0x92, 0x7B, 0x38, 0x4A, 
+0xDF, 0xDE, 0xCF, 0x8A, 0x73, 0xF9, 0x06, 0xB7, 
+0x02, 0x86, 0xF5, 0xD0, 0x66, 0xE1, 0x7C, 0x18, 
+0xD9, 0xEC, 0xEC, 0x0B, 0x63, 0x9C, 0x75, 0xB3, 
+0x82, 0xAF, 0xC3, 0xBB, 0xD0, 0x53, 0x26, 0xBE, 
+0xC2, 0x23, 0x07, 0xE4, 0xE2, 0xC2, 0xF5, 0x32, 
+0x2C, 0xE9, 
+
+0x6F, 0x1B, 0x9B, 0x01, 0x35, 0x21, 0xD5, 0xF9, 
+0x94, 0xCF, 0xFE, 0xD8, 0x47, 0x7E, 0x2A, 0x4E, 
+0x5B, 0x97, 0x2A, 0xA8, 0x9E, 0xBB, 0x4E, 0x70, 
+0x25, 0xD6, 0xBF, 0xAC, 0xCD, 0x1B, 0x34, 0xFB, 
+0x12, 0xD9, 0x32, 0x97, 0x6E, 0x13, 0xCD, 0xCE, 
+0xE9, 0x06, 0x55, 0x43, 0x05, 0xC1, 0x67, 0xF0, 
+0xA7, 0x1E, 0xC8, 0x5F, 0xEB, 0xC2, 0x93, 0x56, 
+0x89, 0x21, 0x37, 0xFD, 0xB9, 0xEB, 0x16, 0xA1, 
+0x3B, 0x42, 0x77, 0xFF, 0x2F, 0x0B, 0x5A, 0x27, 
+0xF1, 0x02, 0xC1, 0x9A, 0x5A, 0x2D, 0xAE, 0xAB, 
+0x6E, 0x4E, 0xF4, 0x6E, 0xF4, 0x67, 0xED, 0x79, 
+0x81, 0xC2, 0xB4, 0x17, 0x12, 0x44, 0x77, 0x2A, 
+0x9C, 0xF0, 0x5F, 0x66, 0x51, 0x1A, 0x9B, 0xF7, 
+0xC8, 0xE4, 0xE2, 0x28, 0xDA, 0x99, 0x85, 0xAF, 
+0xC4, 0x8D, 0x88, 0x9B, 0x26, 0xBF, 0xF8, 0x29, 
+0x5F, 0xA8, 0xF4, 0xC4, 0xD7, 0xDD, 0xAB, 0x26, 
+0x27, 0xC4, 0x25, 0x1C, 0x82, 0xD6, 0x5D, 0x00, 
+0xBD, 0x5F, 0x14, 0x76, 0x6D, 0xCB, 0xC9, 0xA4, 
+0x94, 0xA2, 0x04, 0x7B, 0x8F, 0x94, 0x5A, 0x95, 
+0x5F, 0xC4, 0xC0, 0x14, 0x54, 0xA3, 0x7E, 0xC8, 
+0x3A, 0xD2, 0x26, 0x11, 0x27, 0x26, 0xC9, 0x95, 
+0xB3, 0x8A, 0x98, 0x17, 0xD2, 0x0F, 0x63, 0x11, 
+0xD2, 0xA7, 0x74, 0x68, 0xFA, 0x12, 0x0D, 0x4B, 
+0x10, 0x10, 0x6F, 0x1C, 0xF6, 0xFD, 0x85, 0x9B, 
+0xAF, 0x5E, 0xDE, 0x77, 0x56, 0x68, 0xB6, 0xEF, 
+0x13, 0xBC, 0x09, 0xE3, 0x00, 0x92, 0x21, 0xB9, 
+0xA0, 0x4E, 0x0C, 0xBE, 0xC5, 0x56, 0xA9, 0x76, 
+0xB8, 0x55, 0xA8, 0xFA, 0x00, 0x05, 0xC0, 0xB5, 
+0x96, 0x69, 0x88, 0x51, 0x89, 0x1D, 0xD4, 0x1A, 
+0xC4, 0x31, 0xE3, 0x38, 0x74, 0x46, 0xA7, 0x9A, 
+0x7B, 0xF4, 0x34, 0x9B, 0x6A, 0x54, 0x14, 0x9B, 
+0x0E, 0x0F, 0x5D, 0x4D, 0xE9, 0x88, 0xDE, 0xF3, 
+0x5F, 0xAB, 0xAB, 
+
+0x74, 0x89, 0x9B, 0x4C, 0xE0, 0x22, 0x5F, 0xB6, 
+0x5A, 0x9C, 0x2D, 0x0E, 0xF1, 0x39, 0x93, 0xBB, 
+0x97, 0xDB, 0x5D, 0x2F, 0x78, 0xC3, 0xCA, 0x63, 
+0xB7, 0xA3, 0xC2, 0x8F, 0x04, 0x4C, 0xBD, 0x70, 
+0x12, 0x35, 0xEF, 0x7F, 0x17, 0x94, 0x28, 0x73, 
+0xCA, 0xB3, 0x1F, 0x7B, 0xB3, 0x4F, 0x38, 0x3F, 
+0xA7, 0x6F, 0x31, 0xB5, 0xD6, 0x12, 0xED, 0x08, 
+0x02, 0xC6, 0x80, 0x69, 0x41, 0xBF, 0xD0, 0x1D, 
+0x74, 0x5F, 0xA0, 0xD9, 0x1E, 0xB9, 0xEA, 0xFC, 
+0x2C, 0x24, 0xD9, 0x5E, 0x91, 0x06, 0xD1, 0x00, 
+0x2A, 0x71, 0x55, 0x89, 0xB7, 0x31, 0x1D, 0x14, 
+0xDD, 0x3A, 0xFF, 0x14, 0x96, 0x75, 0x6D, 0x0E, 
+0x27, 0xED, 0xCA, 0x5B, 0x4A, 0x30, 0x2E, 0x4A, 
+0x88, 0x76, 0xE9, 0x9C, 0x11, 0xCF, 0x61, 0x42, 
+0x83, 0x26, 0xEE, 0xAB, 0x85, 0x10, 0x75, 0xE0, 
+0xC8, 0x63, 0x12, 0xDE, 0x23, 0xB9, 0x37, 0x09, 
+0xCC, 0xA9, 0x5B, 0x06, 0xBF, 0x93, 0x6B, 0xC9, 
+0x6D, 0x03, 0x52, 0x66, 0x88, 0x00, 0xC1, 0x56, 
+0xAF, 0xF2, 0x8A, 0xC3, 0xC3, 0x70, 0x33, 0x81, 
+0x71, 0x13, 0x0E, 0x6E, 0x41, 0xC3, 0x17, 0xC4, 
+0x67, 0x30, 0x5E, 0x28, 0x6A, 0xC8, 0x4F, 0xDD, 
+0x2C, 0x94, 0x43, 0xCF, 0x13, 0x72, 0x94, 0x59, 
+0x7A, 0xF7, 0x0B, 0xA4, 0xEB, 0x27, 0x43, 0xC6, 
+0x80, 0xD5, 0x04, 0x7F, 0xF0, 0x91, 0x03, 0xCB, 
+0xC2, 0xE8, 0xE9, 0xF5, 0x31, 0xF8, 0x72, 0x9A, 
+0x4B, 0xDB, 0x58, 0x0C, 0x2E, 0x38, 0x8C, 0x44, 
+0x24, 0x44, 0x7A, 0x2D, 0x70, 0xD7, 0x19, 0x3E, 
+0xA6, 0xDE, 0xED, 0xBC, 0x41, 0x6A, 0xDA, 0xD7, 
+0x9B, 0xBD, 0x5F, 0x35, 0xB3, 0x52, 0xD9, 0xAF, 
+0xE9, 0x9B, 0x73, 0xFE, 0xBC, 0x4C, 0xA9, 0xE0, 
+0x91, 0x39, 0xE0, 0xB9, 0x27, 0x7B, 0xA2, 0xBA, 
+0x69, 0x30, 0x12, 0xF2, 0x89, 0xBB, 0xAF, 0xAC, 
+0x28, 0x59, 0x4F, 0xDE, 
+
+0x25, 0x8F, 0x0A, 0xD7, 0xB0, 0x6B, 0xA8, 0x26, 
+0x34, 0x15, 0x2E, 0xE2, 0x61, 0x89, 0x37, 0xBB, 
+0xE1, 0x38, 0x5F, 0xF0, 0xC9, 0xA5, 0xB1, 0xBC, 
+0xD3, 0x59, 0xD6, 0x9E, 0x92, 0x73, 0x45, 0x12, 
+0xA3, 0x2B, 0x0B, 0x8F, 0xC5, 0xFD, 0x67, 0xAA, 
+0x4F, 0x66, 0xCA, 0x5F, 0x17, 0x15, 0x67, 0xAF, 
+0x6D, 0xF9, 0x58, 0x5D, 0x70, 0x12, 0x9E, 0x6C, 
+0xB8, 0xC3, 0x7B, 0x9D, 0x88, 0x93, 0xF1, 0x76, 
+0xBC, 0x2D, 0x1D, 0x3D, 0xB1, 0xC8, 0x5B, 0xE8, 
+0xDD, 0x2B, 0x68, 0xD3, 0x39, 0xF5, 0x7E, 0x29, 
+0x77, 0x8E, 0x3D, 0xD7, 0x70, 0x0F, 0x83, 0x3B, 
+0x25, 0x5C, 0x0E, 0x03, 0xB0, 0x9C, 0x8E, 0x76, 
+0xBC, 0x64, 0xF3, 0xC1, 0x13, 0x04, 0x06, 0xF2, 
+0x37, 0x85, 0x22, 0xB3, 0xE6, 0x97, 0xB3, 0x4C, 
+0x33, 0xED, 0x40, 0x94, 0x95, 0x41, 0x66, 0xDB, 
+0xB4, 0x04, 0x09, 0xBA, 0xE5, 0x1E, 0x51, 0xAA, 
+0x6A, 0x4B, 0x64, 0x57, 0x29, 0xF8, 0xDC, 0x8E, 
+0x5B, 0x4A, 0x7E, 0xFC, 0xD7, 0x48, 0xF4, 0xD8, 
+0x11, 0x1D, 0x99, 0x3E, 0x29, 0x2C, 0x7D, 0xC0, 
+0x97, 0xA4, 0x51, 0x08, 0x68, 0xD6, 0xEB, 0xBA, 
+0x2B, 0xE2, 0x84, 0xD9, 0xFE, 0xD9, 0x82, 0x50, 
+0x42, 0x95, 0x12, 0x1F, 0xE4, 0xA0, 0xBD, 0x74, 
+0x5A, 0x50, 0x08, 0x59, 0x2A, 0x23, 0xDC, 0x44, 
+0x38, 0x77, 0x46, 0xBC, 0x94, 0xEB, 0xE1, 0xC9, 
+0x75, 0x11, 0x88, 0x90, 0x92, 0xE6, 0x27, 0xAB, 
+0x92, 0x8F, 0xC2, 0x20, 0xBD, 0xF7, 0x88, 0xF5, 
+0xB1, 0x26, 0x76, 0xE8, 0xB2, 0xA5, 0x28, 0xFF, 
+0xEC, 0x23, 0x84, 0xF8, 0x50, 0x65, 0x74, 0xCD, 
+0x38, 0x8D, 0x9F, 0x84, 0xA0, 0x29, 0x84, 0x63, 
+0x5E, 0xFF, 0xFF, 0x49, 0xF1, 0x3E, 0xA3, 0xC4, 
+0xA7, 0x13, 0x14, 0x72, 0x6C, 0xE4, 0xF3, 0xA7, 
+0xCF, 0x1B, 0xBF, 0xD6, 0x6D, 0x2D, 0x8B, 0x4C, 
+0x79, 0x81, 0x53, 0xF0, 0x44, 
+
+0xED, 0xF3, 0x38, 0xAB, 0xDB, 0xDD, 0x4B, 0xC0, 
+0x04, 0x5F, 0xD9, 0xBA, 0x34, 0xFB, 0xEF, 0x9C, 
+0x37, 0xA9, 0x46, 0xF8, 0xCA, 0xDD, 0xB9, 0x22, 
+0x33, 0xD9, 0xCF, 0xC8, 0xB3, 0xAC, 0xCC, 0x56, 
+0x5E, 0x57, 0x0E, 0x52, 0xB2, 0xE4, 0x70, 0x5A, 
+0xF4, 0xA2, 0x9B, 0x5D, 0xE5, 0xA7, 0x02, 0x0B, 
+0xEE, 0x64, 0xEC, 0xB7, 0xA2, 0xF6, 0x9A, 0x61, 
+0xAC, 0xBA, 0x67, 0xC6, 0x06, 0x1D, 0xD8, 0x07, 
+0x1E, 0x7B, 0x28, 0xEC, 0x92, 0x9C, 0xFA, 0x14, 
+0x08, 0xD6, 0xB5, 0xAD, 0xA4, 0x80, 0x10, 0x7B, 
+0x45, 0xE4, 0x12, 0x67, 0xA8, 0xE0, 0x54, 0x3E, 
+0xA0, 0x96, 0x97, 0x41, 0xF0, 0xFC, 0x6D, 0x89, 
+0x4C, 0xA1, 0x6F, 0x9D, 0x1C, 0x6A, 0x26, 0xB7, 
+0xE5, 0xC8, 0xA3, 0x5B, 0x2C, 0xFE, 0x76, 0x77, 
+0x45, 0xD5, 0x7E, 0xE6, 0x66, 0x48, 0x49, 0x76, 
+0xCA, 0x73, 0x30, 0x18, 0xE9, 0xD3, 0x19, 0x8D, 
+0xD1, 0x1E, 0xE2, 0xF4, 0x56, 0x5D, 0x94, 0xA4, 
+0xC7, 0x48, 0xFD, 0xF8, 0xDC, 0x6B, 0x6A, 0x62, 
+0xD7, 0xDB, 0x11, 0x6E, 0x91, 0x8B, 0x10, 0x48, 
+0x80, 0xFB, 0xA7, 0x89, 0x6B, 0xD7, 0x73, 0x03, 
+0x25, 0x1E, 0x65, 0x04, 0xE7, 0x75, 0xB6, 0xD3, 
+0x9A, 0x08, 0x9F, 0x32, 0xF5, 0x60, 0xD9, 0x84, 
+0x44, 0xD9, 0xD9, 0x43, 0xF4, 0x45, 0x76, 0x53, 
+0xC9, 0x44, 0x59, 0x28, 0x25, 0x1D, 0x0F, 0x51, 
+0x91, 0x0F, 0x85, 0x50, 0x97, 0xEE, 0xFA, 0x18, 
+0xB4, 0xB5, 0x89, 0x8E, 0xA6, 0x72, 0x77, 0xB1, 
+0x1C, 0x2E, 0x27, 0x1A, 0x71, 0xA9, 0x39, 0x56, 
+0x99, 0x34, 0xEC, 0xB3, 0xDF, 0xDD, 0xD5, 0x4E, 
+0xC3, 0x61, 0x6C, 0x43, 0x60, 0x34, 0x49, 0x7D, 
+0x2F, 0x75, 0x77, 0xAE, 0xD3, 0x01, 0xE2, 0xC6, 
+0xA9, 0x09, 0x6A, 0x8E, 0x49, 0x67, 0xCF, 0xF8, 
+0x75, 0x10, 0x0E, 0x21, 0x5C, 0x92, 0x69, 0xC9, 
+0x03, 0x29, 0x3F, 0xEB, 0xBF, 0x53, 
+
+0x14, 0x6B, 0x37, 0x5D, 0x9F, 0x33, 0xB9, 0x9A, 
+0xCE, 0x5C, 0x8A, 0x21, 0x34, 0xC5, 0x20, 0x63, 
+0x31, 0xFF, 0x1E, 0x02, 0x44, 0x68, 0xE5, 0xB4, 
+0x6C, 0x25, 0x77, 0x7D, 0xC9, 0x56, 0xA1, 0xF9, 
+0x1B, 0xDD, 0x3B, 0x77, 0x9B, 0x81, 0x49, 0x28, 
+0xFC, 0x58, 0x24, 0x9C, 0xBA, 0x25, 0x5B, 0x45, 
+0xD8, 0x1A, 0x8B, 0x15, 0xA1, 0xA1, 0xDE, 0x39, 
+0x35, 0xC7, 0x64, 0xE4, 0x08, 0xC5, 0x52, 0xBA, 
+0xB4, 0x0E, 0xFC, 0xD8, 0x0A, 0x7A, 0x1B, 0x1C, 
+0xCF, 0x2A, 0xEA, 0x24, 0xCE, 0xF6, 0x43, 0x0B, 
+0xC3, 0xB9, 0xFC, 0xA2, 0x1D, 0xF1, 0x6C, 0xD1, 
+0x1A, 0x63, 0x62, 0x36, 0xF7, 0x31, 0x77, 0x6C, 
+0xEF, 0x5F, 0x74, 0xFF, 0x72, 0xA9, 0xA1, 0x0D, 
+0x0F, 0xBF, 0x06, 0xD7, 0xCD, 0x12, 0xB0, 0x7F, 
+0x07, 0x1B, 0x9C, 0xD2, 0xA3, 0x09, 0x6E, 0x69, 
+0x21, 0x42, 0x44, 0x71, 0xEE, 0x95, 0x40, 0xCC, 
+0x06, 0x0E, 0x09, 0x62, 0xF6, 0x79, 0xC8, 0x54, 
+0x0B, 0x57, 0xF7, 0x07, 0x14, 0x59, 0x45, 0x76, 
+0x1B, 0xDD, 0xC8, 0x45, 0x0C, 0x57, 0x49, 0xD6, 
+0xAD, 0x05, 0x51, 0x5D, 0x24, 0x96, 0x7E, 0x09, 
+0xB6, 0x4D, 0xD7, 0x47, 0x0E, 0x60, 0x8B, 0x45, 
+0x17, 0x3F, 0x56, 0x15, 0x8C, 0xD0, 0x30, 0x46, 
+0xA8, 0x33, 0x1D, 0x4F, 0x0A, 0x3F, 0xAE, 0x1D, 
+0x47, 0x4B, 0x60, 0xDA, 0xAD, 0xD6, 0x78, 0xE2, 
+0x1D, 0xFD, 0x59, 0x1A, 0xE0, 0x76, 0xD3, 0xAE, 
+0x4F, 0x1F, 0x39, 0x40, 0x89, 0xBF, 0x7D, 0xE5, 
+0x93, 0x67, 0x0A, 0x32, 0xAF, 0xE8, 0x80, 0x1F, 
+0xDA, 0xA6, 0x38, 0x7E, 0x9C, 0x56, 0x34, 0x70, 
+0x83, 0xFB, 0xD3, 0x06, 0xBD, 0xA0, 0xD8, 0x17, 
+0xCE, 0x06, 0xBC, 0x65, 0x5D, 0xB1, 0x66, 0xBD, 
+0x15, 0x27, 0x04, 0xC2, 0x97, 0x9D, 0x40, 0x40, 
+0x86, 0xCF, 0xC4, 0x22, 0xA2, 0x62, 0x4F, 0x14, 
+0x0C, 0xAF, 0x81, 0x3D, 0x17, 0x6D, 0x89, 
+
+0x5F, 0xDB, 0xE8, 0x1F, 0xFF, 0xAA, 0xA2, 0x09, 
+0xFE, 0x56, 0x29, 0x43, 0x5A, 0x96, 0x76, 0x41, 
+0xD0, 0xE6, 0xF1, 0x50, 0x1B, 0xB4, 0xFC, 0x8C, 
+0x15, 0x68, 0x7B, 0xA9, 0xDC, 0xF7, 0xEF, 0x62, 
+0x30, 0xEB, 0x93, 0x8E, 0xFA, 0x34, 0xAD, 0xEC, 
+0xFB, 0x72, 0xFD, 0x6A, 0x29, 0x04, 0x33, 0x4E, 
+0xC6, 0x10, 0xBA, 0x3F, 0xB0, 0x65, 0xF8, 0x2D, 
+0x65, 0x2E, 0x3F, 0x71, 0xD6, 0x9E, 0x74, 0x87, 
+0xDD, 0x19, 0xC5, 0xFF, 0xFB, 0x28, 0x94, 0xF0, 
+0x74, 0xC3, 0x45, 0xCB, 0x8B, 0x12, 0x79, 0x11, 
+0x28, 0xD4, 0xF8, 0x93, 0xAF, 0x46, 0x6C, 0xDC, 
+0xFF, 0xB2, 0xC6, 0x4F, 0xC1, 0x0E, 0x01, 0xC9, 
+0x9E, 0x9A, 0x12, 0x7A, 0x51, 0x06, 0x78, 0xDF, 
+0x98, 0x0A, 0xA4, 0xD4, 0x26, 0x2A, 0x73, 0xF6, 
+0xF0, 0x3F, 0xBC, 0xEB, 0x20, 0x1B, 0xE9, 0x12, 
+0x57, 0x65, 0xDC, 0xDE, 0x5F, 0xFA, 0xD9, 0x82, 
+0xE5, 0x7E, 0x12, 0x10, 0x38, 0xB9, 0xB6, 0x62, 
+0xD5, 0x0D, 0xAA, 0x15, 0xE6, 0x5A, 0x70, 0xF4, 
+0x1D, 0x17, 0xC2, 0x63, 0xC0, 0xF9, 0x4E, 0x02, 
+0x0B, 0x30, 0x00, 0x71, 0x85, 0xD1, 0x76, 0x97, 
+0xCE, 0x1C, 0x5D, 0x13, 0x5E, 0x00, 0x3F, 0x93, 
+0x5F, 0xB6, 0xC4, 0x2A, 0xC0, 0xCC, 0x15, 0x6F, 
+0xB7, 0xFF, 0xB0, 0x75, 0x2F, 0xA6, 0x42, 0x46, 
+0x60, 0x46, 0x26, 0xB6, 0x93, 0xC8, 0x61, 0x0B, 
+0xF8, 0xD9, 0x94, 0xA9, 0x86, 0x9C, 0xDA, 0x11, 
+0xD8, 0x14, 0x51, 0x5E, 0xC2, 0x37, 0x45, 0xA0, 
+0x1D, 0xB1, 0x00, 0x4F, 0x7A, 0xA6, 0xEE, 0xD0, 
+0x40, 0x89, 0x7F, 0x78, 0xA4, 0x0B, 0x97, 0x80, 
+0xF7, 0x77, 0xA6, 0xF3, 0x5D, 0xE6, 0x95, 0xEF, 
+0xFC, 0xCD, 0x2F, 0x5B, 0x64, 0x40, 0x86, 0x8B, 
+0x3C, 0x8C, 0x8F, 0x0F, 0x02, 0x37, 0xBC, 0x47, 
+0xB6, 0x9B, 0x7C, 0xA7, 0x11, 0x5E, 0x44, 0x27, 
+0x40, 0xFB, 0x73, 0xE2, 0xE4, 0xF7, 0x12, 0xB1, 
+
+0x67, 0x0F, 0x39, 0x7C, 0x6F, 0xC4, 0x6F, 0x8E, 
+0xC8, 0xC3, 0xC3, 0xFA, 0x5C, 0xBB, 0x01, 0x62, 
+0x86, 0x7F, 0xA5, 0x51, 0x08, 0x5D, 0x40, 0x9D, 
+0xC9, 0x13, 0x47, 0xEB, 0xA4, 0xAD, 0x03, 0x3C, 
+0xEE, 0xFB, 0x46, 0x64, 0x5C, 0x55, 0x8E, 0x62, 
+0xE9, 0xB7, 0xEF, 0xF9, 0x7A, 0xD1, 0xA0, 0xAF, 
+0xE0, 0x8B, 0x13, 0xE5, 0x01, 0x7D, 0xCC, 0xCF, 
+0x87, 0x1E, 0x70, 0xEA, 0xB2, 0xBC, 0x4D, 0x52, 
+0xC0, 0xB3, 0x6C, 0xDB, 0xD8, 0x63, 0x94, 0x6F, 
+0x95, 0x6D, 0xBC, 0x43, 0x56, 0x1F, 0x52, 0x32, 
+0xAA, 0xBB, 0x2B, 0xBF, 0xDF, 0x77, 0x8B, 0x35, 
+0xBE, 0x07, 0x0A, 0xCD, 0x8C, 0x09, 0xAE, 0xFD, 
+0x57, 0x6D, 0xA6, 0x1F, 0xBC, 0x9D, 0xCE, 0x1C, 
+0xE1, 0xB9, 0x6D, 0x95, 0x44, 0xC6, 0xB8, 0xFB, 
+0xE3, 0x06, 0x1A, 0xDA, 0xF9, 0x2F, 0xF4, 0x4A, 
+0x7E, 0x23, 0x63, 0xA7, 0x5D, 0x02, 0x8D, 0xE5, 
+0xCC, 0x48, 0x87, 0xBE, 0x2D, 0x8E, 0xCB, 0x2F, 
+0x0B, 0xED, 0xFA, 0xB8, 0x42, 0xC6, 0x1D, 0x02, 
+0xC9, 0xC4, 0x5A, 0xD9, 0x0D, 0x04, 0xFC, 0x60, 
+0x85, 0x98, 0xC4, 0x64, 0x85, 0xC9, 0xFF, 0x2D, 
+0xBF, 0xA3, 0x96, 0x46, 0x74, 0xBE, 0xC3, 0x8C, 
+0xF8, 0x3C, 0xDD, 0x5E, 0xF5, 0x8E, 0x42, 0x88, 
+0x7C, 0x5A, 0x7E, 0x0D, 0x51, 0x7F, 0xEC, 0x09, 
+0x75, 0x00, 0xA9, 0xA1, 0x4C, 0x86, 0x6D, 0x7C, 
+0xF1, 0xE7, 0xA8, 0x6C, 0x30, 0x51, 0xD4, 0x83, 
+0x72, 0xA2, 0xFF, 0x92, 0x4F, 0xE7, 0xB4, 0x77, 
+0x25, 0xF7, 0x80, 0x57, 0x6A, 0x02, 0xD9, 0x10, 
+0xF2, 0x6A, 0x7D, 0xB3, 0x32, 0x4A, 0x71, 0x6E, 
+0xDB, 0x22, 0x6A, 0xE7, 0x2A, 0x0D, 0x58, 0x99, 
+0x67, 0x71, 0x12, 0xC9, 0x2F, 0xD5, 0x10, 0xB4, 
+0xC7, 0xF5, 0xDE, 0x34, 0xCA, 0x6A, 0xEA, 0x6A, 
+0xE4, 0x24, 0xBD, 0x98, 0x9D, 0x3C, 0x1C, 0xDE, 
+0x97, 0xB7, 0x99, 0xDD, 0x9F, 0x9A, 0xDB, 0xCF, 
+0x9B, 
+
+0x4A, 0xE8, 0xE8, 0x67, 0xD1, 0xDE, 0x10, 0x72, 
+0xB4, 0x4E, 0x06, 0x4D, 0xD8, 0xED, 0xCF, 0xDF, 
+0x22, 0xE9, 0x35, 0x9E, 0xB4, 0x9D, 0x13, 0x9D, 
+0x9D, 0x7B, 0xDD, 0x85, 0xBE, 0x57, 0x41, 0x93, 
+0xB9, 0x01, 0xD2, 0x4F, 0x0A, 0x4D, 0x4A, 0xCA, 
+0x15, 0xAC, 0x0B, 0x26, 0x39, 0x19, 0xA4, 0x83, 
+0xF2, 0x24, 0xDF, 0x36, 0x7A, 0x00, 0x1D, 0x0E, 
+0x64, 0xE0, 0x34, 0xC4, 0xBB, 0x04, 0xF4, 0x4B, 
+0x0C, 0x96, 0x8C, 0x65, 0x4B, 0x33, 0x6D, 0xD3, 
+0xCA, 0xFD, 0xDB, 0x3F, 0xC5, 0x17, 0xD0, 0xC7, 
+0x72, 0x41, 0x20, 0x0B, 0x31, 0x8F, 0x0D, 0x46, 
+0xBB, 0x66, 0x50, 0x44, 0xB9, 0x61, 0x1A, 0x15, 
+0x14, 0x7B, 0xC1, 0x58, 0xE7, 0x8F, 0xCA, 0x9A, 
+0x3E, 0x8D, 0xA8, 0xE7, 0x21, 0xB8, 0xC3, 0x05, 
+0x52, 0x54, 0x8B, 0x7C, 0x5C, 0x79, 0x4C, 0x5F, 
+0x3A, 0xE3, 0x0C, 0x6D, 0xE6, 0x92, 0xC7, 0x7B, 
+0xD4, 0xD6, 0x7B, 0x67, 0xDA, 0x16, 0x44, 0x41, 
+0xB7, 0x1E, 0x0A, 0x8D, 0x12, 0xE5, 0x78, 0x15, 
+0xBB, 0x7F, 0x6F, 0xE5, 0x63, 0x4F, 0xD7, 0xB1, 
+0xDD, 0x4E, 0xCA, 0xEC, 0x6C, 0x25, 0x18, 0xD4, 
+0x7F, 0x1F, 0x2E, 0x33, 0xAB, 0x49, 0xCE, 0x33, 
+0x34, 0x04, 0xDD, 0xC5, 0x85, 0x76, 0x03, 0xF8, 
+0xD4, 0xAD, 0x50, 0x91, 0xCC, 0xF4, 0x26, 0x37, 
+0xD6, 0x41, 0x0C, 0xC1, 0xBA, 0x8E, 0x08, 0xA3, 
+0x9B, 0x54, 0xCA, 0xB8, 0xB6, 0x96, 0x7A, 0xA9, 
+0x2A, 0x5E, 0x7A, 0xA6, 0x6F, 0x4C, 0xB1, 0xFB, 
+0xFE, 0x81, 0x1A, 0xD7, 0xD8, 0xC2, 0xA2, 0x08, 
+0xAD, 0x36, 0xC6, 0xB2, 0x49, 0x02, 0xBB, 0xAA, 
+0xB9, 0x9F, 0xAF, 0xF1, 0x19, 0x45, 0xB0, 0xC2, 
+0x21, 0xB9, 0xAE, 0xF2, 0xC1, 0x97, 0x84, 0x49, 
+0xC0, 0x8C, 0xEC, 0xE8, 0xB4, 0x5F, 0x76, 0xB7, 
+0x4A, 0x3E, 0x0E, 0x78, 0xE4, 0x47, 0xCD, 0xE0, 
+0x61, 0x89, 0x83, 0xF6, 0x4A, 0x40, 0x5D, 0xCB, 
+0xCD, 0x05, 
+
+0xD8, 0xDA, 0xEC, 0xEB, 0x5D, 0xE4, 0x83, 0xE6, 
+0xF0, 0x50, 0xAA, 0xE4, 0xE1, 0xBA, 0x6D, 0xF4, 
+0x85, 0x7B, 0x25, 0x3C, 0xA0, 0xEA, 0x1C, 0x40, 
+0x53, 0x9C, 0xF2, 0x8B, 0x92, 0xDC, 0xC4, 0xD3, 
+0x34, 0x98, 0xE7, 0x2C, 0x44, 0x52, 0x91, 0xE6, 
+0xC7, 0x9F, 0x93, 0x30, 0x5D, 0x6F, 0x7F, 0x07, 
+0x05, 0xB3, 0xCF, 0xB2, 0x50, 0x0B, 0x26, 0x38, 
+0x5D, 0xD7, 0x36, 0x80, 0xCE, 0xD7, 0x5C, 0xAF, 
+0x17, 0xA7, 0x28, 0x76, 0x7B, 0xF9, 0x97, 0x59, 
+0x22, 0xB9, 0xA6, 0x14, 0xA5, 0x98, 0x50, 0x60, 
+0x02, 0x0C, 0xA8, 0x91, 0xD1, 0x32, 0x40, 0xDD, 
+0x19, 0x1C, 0x8A, 0x7B, 0xC9, 0xB3, 0x66, 0x86, 
+0xD7, 0x96, 0xD8, 0xF1, 0x63, 0xEE, 0x20, 0xC0, 
+0x8E, 0x43, 0x9E, 0xB5, 0x1F, 0xA7, 0x3E, 0x56, 
+0x6D, 0x67, 0xD0, 0x4C, 0x95, 0xBA, 0xE9, 0xA1, 
+0x23, 0x07, 0x73, 0x10, 0xF5, 0x8A, 0x0A, 0x28, 
+0x36, 0x4C, 0xA8, 0x20, 0xBB, 0x2B, 0xB8, 0x42, 
+0xAD, 0xC5, 0x04, 0x98, 0x7B, 0x87, 0xE8, 0x36, 
+0xC1, 0xF3, 0xA6, 0xDC, 0x1F, 0xBF, 0x7A, 0xB7, 
+0xBB, 0x07, 0xBA, 0x4B, 0x7B, 0x9B, 0x85, 0x9B, 
+0xB3, 0x09, 0x62, 0x54, 0x87, 0x11, 0x34, 0x18, 
+0x23, 0xED, 0xC2, 0x15, 0xFD, 0x14, 0x91, 0x33, 
+0x3D, 0xDA, 0x4C, 0x68, 0xEF, 0x3A, 0x5F, 0xA9, 
+0xD0, 0xCA, 0x4C, 0x46, 0xAA, 0x7F, 0x3A, 0x27, 
+0x74, 0x72, 0x22, 0xF3, 0xF4, 0x42, 0x30, 0x39, 
+0x97, 0x0C, 0xAB, 0xEE, 0x58, 0x46, 0x02, 0x19, 
+0xD3, 0x9D, 0x51, 0xB7, 0x66, 0xF5, 0x9C, 0xDB, 
+0x89, 0x36, 0x36, 0xD1, 0x85, 0x9F, 0x5F, 0x2B, 
+0xFA, 0xC6, 0x5F, 0x2C, 0xF7, 0x1E, 0x52, 0xF2, 
+0x2A, 0xFB, 0xC5, 0x54, 0xB5, 0x29, 0x29, 0x87, 
+0x83, 0xEB, 0xB8, 0xB9, 0xAA, 0x04, 0xB2, 0x66, 
+0xEB, 0xA2, 0xC4, 0xDC, 0x50, 0x7D, 0x32, 0xEC, 
+0xD5, 0x2E, 0x4B, 0xE8, 0x85, 0x54, 0xA5, 0x56, 
+0x32, 0x4D, 0x7C, 
+
+0xD7, 0x31, 0xC7, 0xBF, 0x4A, 0x36, 0x02, 0x29, 
+0x8B, 0x38, 0xCF, 0x58, 0x85, 0x38, 0x50, 0x24, 
+0xC3, 0x1F, 0xD5, 0xEF, 0x35, 0x8C, 0xF4, 0x02, 
+0xFF, 0x10, 0x07, 0xAB, 0xC8, 0x17, 0x12, 0x4B, 
+0xB2, 0x34, 0xE6, 0xC3, 0x93, 0xC1, 0x26, 0x85, 
+0xFB, 0xB0, 0x71, 0x72, 0x4B, 0xB5, 0x32, 0x93, 
+0x49, 0x45, 0x95, 0x23, 0x52, 0x5B, 0x0F, 0x5A, 
+0x22, 0x83, 0x63, 0x63, 0x98, 0x7E, 0xC6, 0xD6, 
+0xE7, 0x1F, 0x35, 0x95, 0x74, 0x30, 0xE5, 0x8C, 
+0xCB, 0xFD, 0xDC, 0x8A, 0x62, 0xAF, 0x3A, 0x4B, 
+0xA3, 0x3E, 0xBC, 0x1A, 0x0F, 0x28, 0xE8, 0x87, 
+0xC0, 0xB1, 0x12, 0x59, 0xEA, 0x7C, 0x27, 0x54, 
+0x54, 0x29, 0x1D, 0xC4, 0xF0, 0xC8, 0x72, 0xB1, 
+0xF8, 0x4A, 0xF6, 0xEE, 0xCB, 0x85, 0x79, 0x6C, 
+0xFD, 0x77, 0xD7, 0x2B, 0x79, 0xCE, 0xE0, 0x25, 
+0x22, 0x92, 0x21, 0x47, 0xFB, 0xA5, 0x66, 0x4F, 
+0xC9, 0xF3, 0x70, 0x47, 0xE4, 0x6F, 0x56, 0x18, 
+0x76, 0x6D, 0xF0, 0xA6, 0x63, 0x41, 0x92, 0xB2, 
+0x60, 0x5A, 0xA3, 0x34, 0xB9, 0x09, 0x9F, 0xCF, 
+0x96, 0xB1, 0xD5, 0x9D, 0x86, 0x8C, 0x25, 0x09, 
+0x91, 0x98, 0x1B, 0xCF, 0xF3, 0xA5, 0x9D, 0xA9, 
+0x97, 0x00, 0x86, 0x2E, 0xA3, 0x45, 0x32, 0xC2, 
+0xEB, 0xEA, 0x87, 0xDE, 0x30, 0xC9, 0x1B, 0xF3, 
+0xB0, 0x0D, 0x0A, 0xAE, 0x1D, 0xB1, 0x10, 0xB8, 
+0xE7, 0xA2, 0x25, 0xE2, 0xFB, 0xE3, 0x46, 0x55, 
+0x8A, 0x50, 0xB1, 0xF5, 0x89, 0x21, 0xA6, 0xE1, 
+0x5F, 0x46, 0x93, 0x2B, 0x64, 0xBE, 0xDF, 0x4B, 
+0xC7, 0x6D, 0xE0, 0x39, 0x45, 0xBA, 0x2F, 0x5C, 
+0x19, 0x65, 0xB6, 0xA9, 0xCA, 0xFF, 0xF0, 0xD7, 
+0xDB, 0xC2, 0x67, 0x06, 0x91, 0xF9, 0xB2, 0x42, 
+0xA7, 0x28, 0x37, 0x7E, 0x50, 0x74, 0x85, 0xCF, 
+0xCF, 0x1E, 0x99, 0x7B, 0xBA, 0x50, 0xF7, 0x4E, 
+0x4B, 0x9E, 0x27, 0x1D, 0xDA, 0xE2, 0xD0, 0x9F, 
+0x67, 0x20, 0xBB, 0x32, 
+
+0x6C, 0xA5, 0xF1, 0xDE, 0x94, 0x2C, 0x8F, 0xE2, 
+0xF9, 0x0C, 0xF2, 0xBD, 0x8A, 0x7A, 0x3D, 0xB1, 
+0x90, 0x8E, 0x84, 0x74, 0xF7, 0x03, 0x72, 0x08, 
+0x44, 0x2A, 0x9C, 0xDD, 0x5C, 0x12, 0x5E, 0xBA, 
+0x70, 0x52, 0x2F, 0xBB, 0x25, 0x1D, 0xF9, 0x98, 
+0xE3, 0x4A, 0x3B, 0xEF, 0x6C, 0x00, 0xC6, 0x50, 
+0x92, 0x99, 0xCD, 0x2A, 0x81, 0xE1, 0x61, 0x1E, 
+0x54, 0x84, 0x14, 0x8D, 0x13, 0xB9, 0x09, 0x2A, 
+0xC8, 0x37, 0x9D, 0x47, 0x9C, 0x51, 0xFE, 0x1C, 
+0x07, 0xF1, 0x3D, 0x73, 0x36, 0x86, 0x00, 0x2E, 
+0xFF, 0xA8, 0xE0, 0x75, 0x22, 0xB2, 0x1D, 0xD6, 
+0xFE, 0x18, 0x25, 0x72, 0x22, 0xE4, 0xC1, 0xF1, 
+0x32, 0x28, 0xED, 0x99, 0xA7, 0xDE, 0xCE, 0x22, 
+0x02, 0x92, 0xB0, 0xFF, 0x71, 0x06, 0x7D, 0xE5, 
+0x50, 0xDB, 0x8F, 0x38, 0x4F, 0x1D, 0x7E, 0xDF, 
+0xF2, 0xD8, 0x72, 0x04, 0x67, 0x15, 0xB1, 0xFC, 
+0xD2, 0x04, 0x4A, 0x32, 0x4A, 0x1D, 0x8A, 0x50, 
+0x14, 0x9F, 0xBA, 0x22, 0x1C, 0x16, 0x8F, 0xD7, 
+0xE1, 0x77, 0x03, 0x64, 0x73, 0x41, 0x32, 0xCD, 
+0x8B, 0x22, 0x2E, 0x2F, 0x69, 0xE9, 0x26, 0xAD, 
+0xB2, 0xE3, 0x77, 0xAC, 0x69, 0xC4, 0x97, 0xC7, 
+0x57, 0x7E, 0x34, 0xE7, 0x83, 0xFA, 0x6A, 0xD4, 
+0x94, 0xC2, 0xCC, 0x99, 0x12, 0x44, 0xB4, 0x83, 
+0x5C, 0x4A, 0x07, 0x1B, 0x28, 0xC0, 0x19, 0x46, 
+0xE3, 0x87, 0x10, 0x23, 0x80, 0x37, 0x80, 0x0E, 
+0xEB, 0xCF, 0x5E, 0xA6, 0x02, 0xC1, 0x83, 0x43, 
+0x70, 0x0A, 0x83, 0x49, 0x2E, 0x62, 0x2B, 0xCE, 
+0x16, 0x57, 0xE4, 0x91, 0x97, 0x77, 0xE0, 0xE8, 
+0x21, 0x21, 0x74, 0x51, 0x49, 0x29, 0x58, 0xBD, 
+0xFD, 0x83, 0xB3, 0xDF, 0xCA, 0xCC, 0xEB, 0x30, 
+0x14, 0xD2, 0x5B, 0x73, 0x98, 0xA9, 0xBF, 0x5D, 
+0x7B, 0x71, 0x35, 0xA8, 0xA9, 0x90, 0x05, 0x70, 
+0xCD, 0xF4, 0xD5, 0xF3, 0x0E, 0x0C, 0xC9, 0xAC, 
+0x56, 0x98, 0x7C, 0x11, 0x8D, 
+
+0xFD, 0x0A, 0x0A, 0xC0, 0x78, 0x3F, 0xAB, 0x38, 
+0x00, 0x1D, 0x11, 0x20, 0x34, 0xD7, 0xFF, 0xFF, 
+0xA7, 0x57, 0x4E, 0x4C, 0xBC, 0x56, 0x23, 0x42, 
+0xCE, 0x34, 0xE5, 0x89, 0xB8, 0x96, 0x92, 0x5A, 
+0xEF, 0x07, 0xBC, 0x55, 0x36, 0x2D, 0xC4, 0x6D, 
+0xB9, 0x66, 0x8F, 0x58, 0x7D, 0xFF, 0x07, 0xA0, 
+0x28, 0x27, 0xE9, 0xD4, 0xA7, 0x7E, 0xCA, 0x6B, 
+0xB5, 0xA4, 0xB2, 0x3A, 0x5C, 0x51, 0x6A, 0x6E, 
+0x8C, 0xDA, 0x4E, 0x82, 0x22, 0x3A, 0x44, 0x89, 
+0x7F, 0xD6, 0xC0, 0x62, 0x53, 0x20, 0x99, 0xF3, 
+0xF7, 0x31, 0xE8, 0x16, 0x7B, 0xF3, 0x08, 0xDB, 
+0x57, 0x32, 0x02, 0x06, 0xE1, 0x59, 0xF2, 0xE8, 
+0x64, 0x8C, 0xBA, 0xDE, 0x41, 0x2C, 0x9C, 0xD8, 
+0x22, 0x9C, 0x62, 0xA3, 0xA9, 0x1D, 0x4F, 0x5A, 
+0x61, 0x31, 0xBA, 0xD0, 0x20, 0xE3, 0xAD, 0xE7, 
+0xBA, 0x99, 0x51, 0x26, 0xD7, 0xD3, 0x85, 0xBD, 
+0x3E, 0x26, 0x89, 0x60, 0x70, 0x7A, 0x0C, 0x13, 
+0x18, 0x77, 0x21, 0x2A, 0x2F, 0x4F, 0x38, 0x39, 
+0xF7, 0xD9, 0x2E, 0x37, 0x22, 0xC6, 0xF7, 0x83, 
+0x43, 0x9F, 0xFB, 0x98, 0x4D, 0x12, 0x2F, 0x0A, 
+0x48, 0x9D, 0x33, 0x32, 0x60, 0x4B, 0x07, 0xD8, 
+0xBC, 0xDA, 0xA4, 0x11, 0x2F, 0x28, 0x0C, 0x85, 
+0x39, 0x92, 0xC0, 0x2D, 0xF1, 0x10, 0xE2, 0x34, 
+0x91, 0xCB, 0xEA, 0xE2, 0xA1, 0x79, 0x47, 0xE0, 
+0x76, 0x50, 0x61, 0xA3, 0xE7, 0xEB, 0x1F, 0x79, 
+0x81, 0xFA, 0x39, 0x5A, 0x26, 0xA0, 0xD8, 0xAC, 
+0x34, 0xB6, 0xFF, 0xF3, 0xA6, 0x54, 0xBC, 0x4C, 
+0xB8, 0x7B, 0x34, 0xB1, 0x4C, 0xCD, 0xF6, 0x87, 
+0x70, 0x6B, 0x8D, 0x90, 0x2F, 0x1E, 0x9A, 0x94, 
+0x13, 0x26, 0xAC, 0x7F, 0xCF, 0x62, 0x5C, 0x77, 
+0x9C, 0xEF, 0x2B, 0x46, 0xC8, 0x76, 0x75, 0x89, 
+0x00, 0xB0, 0xA6, 0xDA, 0x96, 0x03, 0x4F, 0x5C, 
+0xF8, 0x81, 0xCC, 0x89, 0x71, 0x17, 0x9D, 0x5C, 
+0xC6, 0x88, 0xA6, 0xDB, 0xC1, 0x8D, 
+
+0xAE, 0x67, 0x2A, 0xD3, 0xBD, 0x00, 0xEC, 0xD9, 
+0x65, 0x53, 0xDC, 0x14, 0x73, 0x9F, 0x40, 0xA7, 
+0x57, 0xEE, 0xD2, 0x6B, 0x6E, 0x95, 0xDB, 0x62, 
+0x3C, 0xA5, 0x69, 0x4A, 0xE4, 0x7F, 0xD1, 0xBD, 
+0x13, 0x5D, 0x65, 0x51, 0x7C, 0x3C, 0xA4, 0x75, 
+0x77, 0xFE, 0xC5, 0x1F, 0x2C, 0xD6, 0xAF, 0x58, 
+0xF6, 0x09, 0x10, 0x4F, 0x24, 0xAD, 0x02, 0x2F, 
+0x1F, 0xC8, 0x24, 0x09, 0xAE, 0xF7, 0xE2, 0xA8, 
+0xBF, 0x8B, 0xB2, 0x38, 0x78, 0xED, 0x07, 0xAA, 
+0x78, 0x9E, 0x3E, 0xE1, 0xD5, 0xC1, 0xA7, 0xC8, 
+0x5E, 0x08, 0x3E, 0x81, 0x26, 0x69, 0x68, 0xC8, 
+0x1E, 0x6F, 0x19, 0x1F, 0x0A, 0xEF, 0x06, 0x91, 
+0x65, 0xFA, 0xE4, 0x92, 0x19, 0xF2, 0xF1, 0x12, 
+0xFA, 0x3E, 0x67, 0x95, 0x58, 0xC3, 0x4D, 0xAB, 
+0xE0, 0x62, 0xF1, 0x4D, 0xF4, 0x56, 0xD1, 0xCB, 
+0x41, 0x71, 0x04, 0x4B, 0x0C, 0x9B, 0xFF, 0x62, 
+0xBA, 0xB0, 0xFE, 0x92, 0xE8, 0xEF, 0xC6, 0xFD, 
+0xA6, 0xA6, 0xDE, 0xA7, 0x91, 0x34, 0x16, 0x2B, 
+0x46, 0xB5, 0xD4, 0xF3, 0xE1, 0xD9, 0x0B, 0x25, 
+0x3C, 0xB8, 0x3D, 0xA4, 0x66, 0x61, 0x6F, 0x2D, 
+0x6A, 0x0B, 0x1D, 0xBF, 0x73, 0xD4, 0xD4, 0x7B, 
+0x30, 0x4C, 0xE9, 0x42, 0xC6, 0xE5, 0x7E, 0xAF, 
+0x73, 0x94, 0x58, 0x62, 0xE1, 0xEB, 0x00, 0x92, 
+0x00, 0x10, 0x5A, 0x11, 0x09, 0xD8, 0x0C, 0x24, 
+0xDA, 0xE1, 0x89, 0x38, 0x1C, 0xEA, 0x3A, 0xFE, 
+0xBD, 0x7D, 0x5D, 0x24, 0x3E, 0x06, 0xF5, 0xCA, 
+0x6D, 0xA0, 0x2D, 0x26, 0xA8, 0x97, 0xF2, 0xBE, 
+0x6C, 0x16, 0xAE, 0x35, 0x5D, 0xEB, 0xCE, 0xAF, 
+0xAB, 0x45, 0xF5, 0x0E, 0xA3, 0x59, 0x45, 0x0A, 
+0x3F, 0x3C, 0xE4, 0xA8, 0x69, 0x93, 0x96, 0x7F, 
+0x13, 0xE5, 0x3C, 0xA2, 0x03, 0x14, 0xE1, 0x86, 
+0xF5, 0x65, 0x90, 0x52, 0xE4, 0xA0, 0xF0, 0x5B, 
+0x8E, 0x28, 0xDA, 0x25, 0x0D, 0x70, 0xCB, 0x15, 
+0x3E, 0x33, 0xFC, 0x92, 0xB3, 0xF9, 0xA9, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,4710 @@
+package utils
+func getkat3241_v30_t64(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xEE, 0x1A, 0x9A, 0xCD, 0x9A, 0xBC, 0x33, 0xE2, 
+
+0x01, 0x20, 0x3E, 0xAF, 0xD0, 0x8B, 0x28, 0xB3, 
+0x18, 
+
+0xA7, 0x6E, 0x62, 0x92, 0xC9, 0x6C, 0xBD, 0xB8, 
+0x5C, 0xF5, 
+
+0xA4, 0x13, 0xAF, 0xA8, 0x4B, 0x35, 0xF9, 0x99, 
+0x24, 0xF5, 0x43, 
+
+0x1F, 0x1B, 0x06, 0x69, 0x22, 0x10, 0xBA, 0x72, 
+0x8D, 0xED, 0x5D, 0x8D, 
+
+0x61, 0xFD, 0xA3, 0x15, 0x2F, 0x33, 0x17, 0x4E, 
+0x5C, 0x59, 0x46, 0x6E, 0x17, 
+
+0x82, 0xC2, 0x09, 0x93, 0xD1, 0xA7, 0xC4, 0xD6, 
+0xB2, 0x02, 0x77, 0x58, 0xA3, 0x26, 
+
+0x54, 0x45, 0x88, 0xBA, 0xAD, 0x35, 0x15, 0xD8, 
+0xF5, 0x15, 0x6A, 0xA4, 0x62, 0xE8, 0x41, 
+
+0x3F, 0x90, 0xED, 0xA2, 0xF3, 0x66, 0xD8, 0x7E, 
+0xAC, 0x54, 0xF6, 0xDD, 0xC5, 0x73, 0x7E, 0x9A, 
+
+0xBB, 0x3B, 0x40, 0x60, 0xB2, 0x95, 0xFD, 0x5B, 
+0x68, 0xF0, 0x02, 0xA4, 0x32, 0x53, 0xFE, 0x43, 
+0x92, 
+
+0x4C, 0xF6, 0x11, 0xCA, 0xA4, 0xE6, 0xF8, 0x01, 
+0x62, 0x29, 0x43, 0x1C, 0x7E, 0xB5, 0x5E, 0x17, 
+0x1A, 0x0B, 
+
+0x34, 0x1B, 0x34, 0xBD, 0xE0, 0xC5, 0x2B, 0xC4, 
+0xD1, 0x76, 0x93, 0xEE, 0x94, 0x0C, 0x66, 0xB7, 
+0x1E, 0xFC, 0xAF, 
+
+0x65, 0x65, 0x16, 0xAD, 0x81, 0x2E, 0xFB, 0xFE, 
+0x57, 0x91, 0x7B, 0x8D, 0x50, 0x2E, 0xDD, 0x25, 
+0xA0, 0x8E, 0x6F, 0xC7, 
+
+0xA8, 0x61, 0x18, 0xFC, 0x7E, 0xB8, 0xB3, 0x66, 
+0x47, 0x54, 0x22, 0xD1, 0xD4, 0xCD, 0x9B, 0xD0, 
+0x42, 0xCA, 0xC6, 0x09, 0x2D, 
+
+0xEA, 0x9B, 0x9D, 0xF0, 0x13, 0x19, 0x9A, 0x4F, 
+0xB0, 0xA1, 0x7B, 0x97, 0xD7, 0x88, 0xAA, 0xD5, 
+0x30, 0x22, 0x7B, 0x81, 0x57, 0xC3, 
+
+0x41, 0x02, 0x6E, 0xAC, 0x9F, 0x85, 0xF4, 0x0E, 
+0xF0, 0x4D, 0xA5, 0xA8, 0x61, 0x50, 0x9A, 0x62, 
+0x76, 0xB4, 0xD5, 0xEC, 0xC6, 0x2C, 0x77, 
+
+0x96, 0x6E, 0x38, 0x15, 0x47, 0x69, 0x64, 0xF1, 
+0xE4, 0x7A, 0x60, 0xAF, 0xCD, 0x77, 0x23, 0xD9, 
+0xBB, 0xA6, 0x80, 0xD0, 0xBA, 0xCD, 0x41, 0x70, 
+
+0x19, 0x8B, 0x08, 0x81, 0x1E, 0xA1, 0x22, 0xC5, 
+0x81, 0x03, 0xC2, 0xBB, 0xB9, 0x83, 0x88, 0xD7, 
+0x71, 0x2E, 0x5D, 0x1E, 0x4C, 0x2A, 0x81, 0xDA, 
+0x64, 
+
+0xAE, 0x3F, 0x8B, 0x2C, 0x14, 0xE6, 0xF4, 0xCD, 
+0x06, 0x68, 0xB1, 0xCE, 0xA7, 0x06, 0x74, 0x09, 
+0xFE, 0x1F, 0xF8, 0x33, 0xF5, 0x87, 0x34, 0x26, 
+0xEA, 0x16, 
+
+0x38, 0x82, 0x47, 0x1A, 0xED, 0x1B, 0x83, 0x4B, 
+0xC5, 0xBA, 0x4C, 0x6B, 0x47, 0x53, 0x89, 0x0E, 
+0x04, 0x60, 0x05, 0xC2, 0x20, 0x69, 0x9B, 0xDF, 
+0x15, 0xDE, 0x98, 
+
+0xD8, 0x38, 0x58, 0xB2, 0xEB, 0x09, 0x26, 0x3E, 
+0xBD, 0x24, 0xF1, 0x4F, 0xDD, 0x64, 0x22, 0xCD, 
+0xE3, 0xA0, 0x13, 0x37, 0xC3, 0x12, 0x91, 0x71, 
+0x0B, 0x3C, 0xC5, 0x29, 
+
+0xA0, 0x73, 0x2E, 0x3D, 0x39, 0x4D, 0xE8, 0x39, 
+0x01, 0xE5, 0xDD, 0xCA, 0x7D, 0x84, 0x92, 0x34, 
+0x5B, 0xC3, 0x9C, 0xCA, 0xB1, 0xD8, 0xD3, 0x36, 
+0xCF, 0xDC, 0x8A, 0xE0, 0xB7, 
+
+0x2B, 0xAB, 0x2B, 0x43, 0xEB, 0xB5, 0xBC, 0x80, 
+0x00, 0xDA, 0x73, 0x87, 0xE4, 0x90, 0x84, 0x0F, 
+0xBD, 0x5A, 0x8C, 0xAD, 0xF3, 0x65, 0x26, 0x79, 
+0xEA, 0x3A, 0xEF, 0x13, 0xB7, 0x92, 
+
+0xE2, 0x69, 0x0E, 0xC1, 0xBD, 0x0A, 0x7D, 0x25, 
+0x83, 0xC2, 0xB8, 0x03, 0x64, 0x43, 0x52, 0xBE, 
+0x3E, 0x99, 0x55, 0xF9, 0xE2, 0x8C, 0x39, 0x5E, 
+0xA2, 0xE7, 0x7D, 0x90, 0x76, 0xEA, 0x30, 
+
+0x42, 0x1D, 0xF0, 0xF3, 0x3E, 0x48, 0x56, 0x42, 
+0xB7, 0xF2, 0x49, 0xF7, 0x4F, 0x5F, 0x95, 0xE0, 
+0xEB, 0x5B, 0x78, 0x09, 0xD0, 0x5F, 0x30, 0xCC, 
+0xC1, 0xBC, 0xFD, 0x77, 0x90, 0x90, 0xBE, 0x10, 
+
+0x21, 0x60, 0xA1, 0x2D, 0xB0, 0x11, 0xF0, 0xA7, 
+0xC5, 0xD6, 0xB9, 0xFF, 0xE8, 0x4A, 0x0D, 0xF0, 
+0xCC, 0xEC, 0x80, 0x5D, 0x0F, 0x48, 0xEB, 0x37, 
+0x23, 0x71, 0x08, 0x60, 0x3D, 0xD0, 0x24, 0xA7, 
+0x6B, 
+
+0x7C, 0xE5, 0xA2, 0x0D, 0x45, 0xF9, 0x04, 0x19, 
+0x3E, 0xF7, 0x0F, 0x52, 0x44, 0xA6, 0xC8, 0x3B, 
+0xC2, 0x4E, 0x9F, 0xF1, 0x1D, 0x5F, 0x24, 0x39, 
+0x75, 0x26, 0x68, 0x97, 0xDB, 0x7A, 0x1C, 0xDE, 
+0x0B, 0x2A, 
+
+0x2B, 0xC8, 0xB0, 0xD1, 0xE2, 0xCF, 0xAA, 0x31, 
+0x2C, 0x1E, 0x85, 0x20, 0xDD, 0x76, 0xE3, 0x66, 
+0x25, 0x17, 0x01, 0x72, 0x93, 0xCD, 0x57, 0x67, 
+0x25, 0x77, 0x66, 0xDE, 0x9E, 0xE3, 0xFA, 0xFA, 
+0xBE, 0x71, 0xBC, 
+
+0x4B, 0x4E, 0xE7, 0x53, 0x95, 0x39, 0x6B, 0x09, 
+0x5D, 0x50, 0x73, 0x16, 0xDB, 0x4B, 0xA0, 0x23, 
+0x30, 0x7D, 0x82, 0xBC, 0x3A, 0x5D, 0xFA, 0x5F, 
+0x35, 0x6B, 0x0B, 0xD9, 0xE6, 0xF1, 0xC8, 0xD7, 
+0x52, 0xAC, 0xF5, 0xF4, 
+
+0x60, 0x27, 0x9B, 0xB8, 0x20, 0x75, 0x7D, 0x64, 
+0xF8, 0x59, 0x36, 0x54, 0x9D, 0xCC, 0x58, 0x78, 
+0x80, 0xFF, 0x1D, 0x9A, 0x36, 0xCB, 0x66, 0xE7, 
+0xAE, 0x0A, 0xBF, 0x85, 0x4A, 0x18, 0x4A, 0xDF, 
+0x2C, 0xB6, 0xE7, 0x4A, 0xB6, 
+
+0x5B, 0x20, 0x25, 0xA3, 0x19, 0x2B, 0x25, 0x11, 
+0xB2, 0xF7, 0xA4, 0x31, 0x6B, 0x50, 0xC9, 0xB2, 
+0xE1, 0x36, 0x41, 0xAB, 0x78, 0x3C, 0x5C, 0x1F, 
+0xE5, 0xBD, 0xE6, 0xBE, 0xC8, 0xE9, 0x61, 0x31, 
+0x7D, 0x6E, 0x10, 0x88, 0xEE, 0xBD, 
+
+0xB0, 0xBB, 0x08, 0xA3, 0xF3, 0x61, 0x26, 0x1C, 
+0xFC, 0xC7, 0xD3, 0xED, 0xD6, 0x9B, 0x56, 0xD1, 
+0x22, 0x44, 0x64, 0x8B, 0xCE, 0x70, 0x31, 0xF0, 
+0x44, 0xC9, 0x31, 0xF0, 0xAD, 0x7F, 0xB7, 0x7D, 
+0x56, 0xEE, 0x83, 0x9D, 0xC2, 0x88, 0xFE, 
+
+0x81, 0x6E, 0xF3, 0x35, 0x8E, 0xF7, 0xEB, 0xC9, 
+0x48, 0xC0, 0xC1, 0x47, 0x76, 0x90, 0x87, 0xAB, 
+0x91, 0x78, 0x26, 0x4D, 0x2C, 0x5A, 0x3C, 0xC3, 
+0x13, 0x5F, 0x0B, 0x40, 0x8D, 0xE8, 0xB3, 0x32, 
+0x9F, 0xBD, 0x5B, 0xE0, 0xD2, 0x50, 0x6C, 0x1D, 
+
+0x10, 0xEF, 0xAE, 0xAC, 0xDD, 0x2B, 0xEB, 0x2B, 
+0xF7, 0x18, 0x85, 0x00, 0x65, 0x89, 0xE0, 0xD1, 
+0xA5, 0xD0, 0x6E, 0xC7, 0x15, 0xF4, 0xA2, 0x1C, 
+0xA6, 0xA6, 0x1D, 0xDE, 0x98, 0xAF, 0x49, 0xD1, 
+0x46, 0x77, 0x70, 0x50, 0x26, 0xDF, 0xE8, 0x37, 
+0x16, 
+
+0x7E, 0x5B, 0xD4, 0x8D, 0x37, 0x37, 0xB8, 0x45, 
+0x2C, 0x72, 0x3D, 0x46, 0xCC, 0xB0, 0xD7, 0xC1, 
+0xBA, 0x4B, 0x8F, 0x0C, 0xD4, 0x79, 0xBA, 0x82, 
+0x7A, 0x94, 0x11, 0x4D, 0x05, 0x4C, 0xEC, 0x97, 
+0xAF, 0xD9, 0x87, 0x14, 0xE3, 0x48, 0xD2, 0x69, 
+0xB5, 0xCC, 
+
+0x39, 0x58, 0x5D, 0xEE, 0x29, 0x2A, 0xFE, 0xED, 
+0xB4, 0x35, 0x61, 0x6D, 0x82, 0xE9, 0x55, 0x17, 
+0x86, 0x8A, 0x9D, 0x0E, 0x53, 0x68, 0xC0, 0x47, 
+0x77, 0x8C, 0x85, 0x1E, 0x03, 0x00, 0x27, 0xE2, 
+0x99, 0x8E, 0x5F, 0x34, 0xBD, 0xD6, 0x69, 0xB6, 
+0x8C, 0x1C, 0x48, 
+
+0xFA, 0x2D, 0xAA, 0xD2, 0x99, 0x71, 0x1E, 0x65, 
+0xE1, 0x55, 0x22, 0x73, 0xB3, 0x64, 0x9E, 0xA8, 
+0x8A, 0xAA, 0x10, 0xAF, 0xD0, 0xEE, 0xEC, 0x1C, 
+0xC4, 0xFD, 0xF7, 0xD2, 0xF6, 0x69, 0x0B, 0x44, 
+0x5A, 0x11, 0xB9, 0xBD, 0x6C, 0xB0, 0x2A, 0x9B, 
+0x08, 0x87, 0x0F, 0x19, 
+
+0x7B, 0x66, 0xF7, 0xE5, 0x76, 0xE2, 0x36, 0x8B, 
+0x85, 0x4F, 0xA2, 0x23, 0xEB, 0xFE, 0x22, 0xAB, 
+0x7A, 0x65, 0xA1, 0x71, 0xC0, 0x6F, 0x07, 0xFA, 
+0x64, 0xF8, 0xE6, 0x1E, 0x70, 0x2F, 0x53, 0x1D, 
+0x35, 0x98, 0x07, 0x59, 0x84, 0x96, 0x1A, 0x74, 
+0xCE, 0x6D, 0xDF, 0x4D, 0xAC, 
+
+0xBA, 0xD8, 0x21, 0x9B, 0xF0, 0x8D, 0xC8, 0xD3, 
+0x3C, 0x4C, 0x4D, 0x38, 0xDB, 0xF3, 0x63, 0x49, 
+0x7A, 0x9F, 0xD4, 0x53, 0xCA, 0x8D, 0xDC, 0x3D, 
+0xFD, 0x5A, 0xA3, 0x04, 0x01, 0x60, 0x09, 0x37, 
+0x42, 0x30, 0x5B, 0xAA, 0x5B, 0x64, 0x45, 0x44, 
+0xFE, 0x18, 0xE3, 0xD0, 0xEF, 0xAB, 
+
+0x48, 0x7D, 0x22, 0x8C, 0x34, 0xB4, 0xB6, 0x41, 
+0xA8, 0xCE, 0x54, 0xF3, 0x58, 0x35, 0x79, 0xDE, 
+0x26, 0x49, 0x39, 0xE3, 0xA6, 0x0A, 0xD6, 0x5D, 
+0x4B, 0x2A, 0xC6, 0x88, 0x89, 0xFB, 0x53, 0x3F, 
+0xDE, 0x8A, 0x2C, 0x69, 0x9A, 0x4A, 0x4B, 0xC6, 
+0xB5, 0x4E, 0x21, 0x8A, 0x09, 0x71, 0xA8, 
+
+0xEF, 0x83, 0x9D, 0xD8, 0x43, 0xD1, 0x8A, 0x53, 
+0x97, 0x98, 0x32, 0x8F, 0xB2, 0x6E, 0xB8, 0x82, 
+0x53, 0x69, 0xE5, 0xAA, 0xA2, 0x2B, 0x5B, 0x14, 
+0x3F, 0xF5, 0x04, 0xF3, 0x13, 0xE4, 0x77, 0xF1, 
+0xB7, 0x6D, 0x9F, 0x4A, 0xD4, 0x8F, 0x2C, 0xE2, 
+0x85, 0x99, 0x51, 0x23, 0xDC, 0x7A, 0xE8, 0x95, 
+
+0xDD, 0xF8, 0xA1, 0x04, 0xDF, 0xE4, 0x95, 0x0F, 
+0x0A, 0x04, 0x64, 0xF5, 0x42, 0x0E, 0xEA, 0x0B, 
+0x7F, 0x43, 0x39, 0x2C, 0x59, 0xBB, 0x4D, 0xBE, 
+0xDF, 0xEF, 0xF6, 0x19, 0x1A, 0x79, 0xA8, 0x8A, 
+0xF0, 0x81, 0x2D, 0x2C, 0x09, 0x6D, 0x30, 0x4C, 
+0xED, 0x8C, 0xCD, 0x78, 0x8A, 0xE4, 0xB9, 0xA6, 
+0x97, 
+
+0x76, 0xDD, 0x56, 0xB7, 0x59, 0xCC, 0x98, 0x3C, 
+0x43, 0xEF, 0xD9, 0x83, 0xCD, 0x34, 0xC9, 0x2A, 
+0xF1, 0x82, 0x47, 0x31, 0x59, 0xC5, 0x94, 0xB2, 
+0xB5, 0xB0, 0xCA, 0x34, 0x5A, 0xFA, 0xBB, 0xFC, 
+0x82, 0x1E, 0x12, 0xFF, 0xA5, 0xF2, 0xD2, 0x9B, 
+0xE5, 0x3B, 0xC4, 0xB3, 0xBB, 0x2C, 0xF0, 0x18, 
+0x9D, 0x74, 
+
+0x75, 0x3E, 0xDF, 0x07, 0x02, 0xFA, 0x7F, 0x72, 
+0x29, 0x12, 0x89, 0x4B, 0x55, 0x5F, 0xE1, 0x10, 
+0x0D, 0x66, 0xF3, 0x73, 0x48, 0xE4, 0xE2, 0xE9, 
+0x45, 0xD5, 0xB6, 0x80, 0xA2, 0xC5, 0x7B, 0xE9, 
+0x67, 0x29, 0xB9, 0x97, 0xE7, 0x2F, 0x6E, 0x2B, 
+0x13, 0x08, 0x82, 0x60, 0x1C, 0x2C, 0x02, 0x5D, 
+0xB1, 0x3F, 0x86, 
+
+0x5D, 0xD4, 0xF9, 0xC5, 0xB9, 0x77, 0x52, 0xF2, 
+0x84, 0x51, 0xAA, 0x6B, 0x80, 0x32, 0x72, 0x6C, 
+0xA2, 0xCA, 0x9B, 0x0C, 0xB5, 0x8B, 0x3F, 0xD5, 
+0x8D, 0x75, 0x50, 0x48, 0x5C, 0x7F, 0xA2, 0x03, 
+0xEF, 0x1B, 0x99, 0x61, 0x50, 0xA2, 0xBD, 0x1B, 
+0xC1, 0x37, 0x8B, 0x69, 0x0D, 0x69, 0x63, 0x16, 
+0x62, 0x8A, 0x0D, 0xB2, 
+
+0x11, 0x23, 0x36, 0x6A, 0xD9, 0xAE, 0x08, 0x0A, 
+0x00, 0xE9, 0x47, 0x4F, 0xA9, 0xD4, 0x7B, 0x91, 
+0x90, 0xC3, 0x7D, 0x3B, 0x21, 0xA7, 0xFE, 0xD8, 
+0x04, 0x9C, 0x6B, 0xE1, 0x05, 0x8D, 0x83, 0xF4, 
+0x9B, 0xA9, 0x81, 0xE4, 0xD0, 0x30, 0xAA, 0xD4, 
+0xB2, 0xDC, 0x11, 0xC2, 0x07, 0x10, 0xF8, 0x7E, 
+0x87, 0xAB, 0x3D, 0xA2, 0xA5, 
+
+0xA4, 0x2B, 0x88, 0xA9, 0xB1, 0x71, 0xB9, 0x4E, 
+0x97, 0x1F, 0x06, 0xC6, 0xB5, 0xDC, 0x68, 0x23, 
+0x43, 0x95, 0x30, 0xCF, 0x7C, 0x91, 0xCF, 0x2E, 
+0xE0, 0x6D, 0xD1, 0xC7, 0x0D, 0x4F, 0x8A, 0x59, 
+0x44, 0x6B, 0x94, 0x96, 0x0D, 0x13, 0x81, 0x59, 
+0x1E, 0xFE, 0x34, 0xE1, 0xE7, 0xD1, 0xCA, 0x71, 
+0xF4, 0x25, 0xD9, 0xC3, 0x84, 0x1D, 
+
+0x7A, 0x23, 0x77, 0xBF, 0x83, 0x95, 0x24, 0xA0, 
+0x33, 0xFE, 0x8D, 0xA4, 0x84, 0xDB, 0xF8, 0x42, 
+0x47, 0x34, 0xBC, 0x98, 0x7C, 0x27, 0x55, 0x56, 
+0xED, 0x02, 0xDF, 0x72, 0x50, 0x44, 0x72, 0x9F, 
+0xDF, 0xF6, 0x79, 0xD3, 0xED, 0x80, 0xA9, 0x21, 
+0x66, 0x20, 0x72, 0x96, 0x78, 0x1B, 0x58, 0x66, 
+0xD4, 0x27, 0x06, 0xFC, 0x84, 0xFA, 0x21, 
+
+0x65, 0x3C, 0xAA, 0x6B, 0x24, 0x66, 0x92, 0x8F, 
+0x0D, 0x03, 0x98, 0xB0, 0x29, 0x92, 0x8C, 0xFF, 
+0x53, 0x48, 0x68, 0x58, 0x9D, 0xC0, 0x27, 0x2F, 
+0xB3, 0x93, 0x85, 0xAB, 0x5F, 0xD6, 0x58, 0x80, 
+0x94, 0xF4, 0x81, 0xB6, 0x65, 0x84, 0xB4, 0x19, 
+0x27, 0x09, 0xE6, 0x5D, 0xDD, 0x1F, 0x7C, 0x08, 
+0xA9, 0x1F, 0x5E, 0x4F, 0x8D, 0x87, 0x72, 0x13, 
+
+0xCE, 0xA5, 0x53, 0xCD, 0x10, 0x3F, 0x5D, 0xC9, 
+0x27, 0xA8, 0xF6, 0x0D, 0x8C, 0x67, 0x77, 0x0F, 
+0x2B, 0x4D, 0xB7, 0x8C, 0xD8, 0xF7, 0x02, 0x03, 
+0x67, 0x5C, 0x56, 0x6C, 0x55, 0x83, 0x1C, 0x34, 
+0x0F, 0xA9, 0x23, 0x92, 0xC9, 0xA5, 0xFD, 0xDF, 
+0x80, 0x75, 0xA7, 0x04, 0xDA, 0x28, 0x9F, 0x24, 
+0xB0, 0xAF, 0xAA, 0x78, 0xBD, 0x52, 0xD5, 0x19, 
+0x90, 
+
+0x3D, 0x99, 0xA0, 0x65, 0xE5, 0x32, 0x6F, 0x8E, 
+0x2D, 0x6D, 0x71, 0x0A, 0xF1, 0x64, 0x8A, 0x04, 
+0xD9, 0xB5, 0x73, 0x4F, 0x00, 0x68, 0x66, 0x59, 
+0x60, 0xE6, 0x63, 0x83, 0x9A, 0x4B, 0xB4, 0x6C, 
+0x2D, 0x3B, 0x48, 0x4D, 0xCB, 0x3D, 0xED, 0x40, 
+0x11, 0xCB, 0x52, 0x55, 0x47, 0x95, 0xD6, 0x79, 
+0xCD, 0xF6, 0x5D, 0xFC, 0x0C, 0xBE, 0x3B, 0xAF, 
+0x83, 0x79, 
+
+0x09, 0x0D, 0xD0, 0xAB, 0xC5, 0x29, 0x37, 0xC1, 
+0x41, 0xE6, 0x33, 0x22, 0x14, 0x4F, 0x43, 0x21, 
+0x0C, 0xB8, 0x68, 0xC6, 0x8C, 0x9B, 0x90, 0xBC, 
+0x93, 0x0B, 0xC9, 0x05, 0xA3, 0x6F, 0xD5, 0x03, 
+0x7A, 0x68, 0xF5, 0x61, 0xEB, 0x0B, 0xEA, 0x7B, 
+0x1C, 0xFD, 0x9C, 0x0F, 0x5B, 0xB5, 0xA6, 0xBC, 
+0x68, 0x11, 0x2F, 0x87, 0xE5, 0x71, 0xFE, 0x65, 
+0x33, 0x4A, 0xB0, 
+
+0x3C, 0x94, 0xC4, 0x4A, 0xF4, 0x40, 0x36, 0xFE, 
+0xBB, 0x8E, 0xB8, 0x53, 0x95, 0xEC, 0x04, 0xC3, 
+0x95, 0x1B, 0xF1, 0xA1, 0x82, 0xF8, 0xF5, 0xD2, 
+0x5B, 0x3F, 0x88, 0xB0, 0xA5, 0xEF, 0x4D, 0x19, 
+0xFC, 0xC2, 0x46, 0x9A, 0x8D, 0x3B, 0x57, 0x6C, 
+0x4C, 0x55, 0x27, 0x6A, 0xD3, 0xA5, 0xBD, 0x7D, 
+0xE7, 0xF2, 0xF2, 0xE0, 0x93, 0x5E, 0xED, 0xC9, 
+0xAB, 0x9D, 0x33, 0x8E, 
+
+0x06, 0x5B, 0x25, 0x07, 0x33, 0x21, 0x56, 0x00, 
+0x1E, 0xC9, 0x1D, 0x92, 0xED, 0x6F, 0xF2, 0x16, 
+0x71, 0x42, 0xB3, 0x58, 0xA3, 0xE0, 0xB4, 0x6D, 
+0xCA, 0xAD, 0xDC, 0xA9, 0x7B, 0x35, 0x29, 0x5F, 
+0x67, 0xAD, 0xFD, 0x40, 0x97, 0x8F, 0xD8, 0x03, 
+0xED, 0x74, 0x1D, 0x78, 0xF9, 0x32, 0x9D, 0xBA, 
+0x2F, 0xC7, 0x4E, 0xFC, 0x38, 0x16, 0x71, 0x48, 
+0xFA, 0x4C, 0x86, 0xFC, 0x92, 
+
+0xB6, 0xAB, 0xAE, 0xAE, 0xB2, 0x20, 0xFE, 0xDD, 
+0xC7, 0xEA, 0x62, 0xCE, 0xED, 0x33, 0xC0, 0x11, 
+0x6A, 0xE8, 0x50, 0xA5, 0xD1, 0x08, 0x08, 0x09, 
+0xD5, 0xEF, 0xE5, 0x98, 0x59, 0xCF, 0xB7, 0xC3, 
+0xE8, 0xEA, 0x59, 0x0D, 0x26, 0xCB, 0x55, 0xF5, 
+0xFF, 0x6C, 0x84, 0x1D, 0x07, 0xC5, 0x0F, 0xA5, 
+0x62, 0x7B, 0x19, 0xA9, 0x42, 0xF3, 0x92, 0xDD, 
+0x46, 0x1A, 0xEF, 0x84, 0xF8, 0xB3, 
+
+0x42, 0x72, 0xCE, 0x1E, 0x5E, 0xCA, 0x6C, 0x93, 
+0xC8, 0xD0, 0x30, 0xD6, 0xFC, 0xFD, 0xED, 0x4C, 
+0xF4, 0xDF, 0xCE, 0xE0, 0x29, 0x7E, 0xAB, 0x4E, 
+0x3A, 0x27, 0xFE, 0x3E, 0x31, 0xBD, 0x03, 0x32, 
+0xAC, 0x2A, 0xA2, 0xD9, 0x76, 0xB3, 0x58, 0x24, 
+0x94, 0x32, 0x1C, 0xD3, 0x27, 0xBA, 0x21, 0x4E, 
+0xE8, 0x79, 0x10, 0xD4, 0x1C, 0x62, 0x1E, 0xE4, 
+0x08, 0x56, 0x5C, 0xA4, 0x20, 0xE8, 0x7C, 
+
+0xCC, 0xED, 0x66, 0x80, 0xE2, 0x72, 0x43, 0xBF, 
+0x86, 0xB9, 0xB8, 0x0D, 0xA2, 0xEB, 0x92, 0x1B, 
+0x47, 0xD8, 0x55, 0x30, 0x2A, 0x39, 0x00, 0xF2, 
+0xFF, 0xAC, 0xC8, 0x19, 0x16, 0xA7, 0x68, 0x56, 
+0x33, 0x3B, 0x1E, 0xCA, 0x23, 0x8A, 0x90, 0x7A, 
+0x65, 0x9D, 0xC1, 0x8B, 0x37, 0x4E, 0x45, 0xB1, 
+0xC3, 0x26, 0xBB, 0xEF, 0xB7, 0x24, 0x98, 0x95, 
+0x01, 0x42, 0xB8, 0xAC, 0x44, 0xD5, 0x27, 0xD9, 
+
+0x1C, 0x83, 0x9B, 0x8D, 0x89, 0x48, 0x47, 0x7F, 
+0x73, 0x6F, 0xF2, 0x61, 0xDB, 0xE3, 0xE8, 0xA2, 
+0xC4, 0xD6, 0xDD, 0xFF, 0x59, 0xF8, 0xF6, 0x9D, 
+0x84, 0xC0, 0x64, 0xF7, 0x89, 0xEB, 0xA2, 0x17, 
+0x93, 0x48, 0x27, 0xE2, 0x67, 0x9B, 0x7E, 0x02, 
+0x76, 0x5B, 0x4C, 0xAF, 0xC0, 0xD0, 0x05, 0xD2, 
+0xF5, 0x30, 0xBD, 0x8B, 0x2C, 0x0F, 0x2D, 0x9D, 
+0x70, 0xB7, 0xDF, 0x98, 0x4C, 0x4E, 0xC2, 0xE3, 
+0x1F, 
+
+0x94, 0x95, 0xBD, 0x07, 0x3F, 0x5F, 0x9C, 0x5B, 
+0x5E, 0xCB, 0xCB, 0xEF, 0x08, 0x7F, 0x1A, 0x59, 
+0x2C, 0x63, 0x86, 0xF2, 0x82, 0xAB, 0x24, 0xFE, 
+0x15, 0xD8, 0xE8, 0x24, 0xC6, 0xFE, 0xA2, 0x6A, 
+0xD0, 0xF6, 0xA3, 0x69, 0x3A, 0x67, 0xFD, 0x44, 
+0x03, 0xDC, 0xC8, 0xC3, 0x02, 0x96, 0x3E, 0x33, 
+0x7F, 0xEE, 0xCF, 0xCB, 0x59, 0x41, 0x9A, 0x75, 
+0x4F, 0x58, 0x59, 0x7D, 0xD0, 0x04, 0x30, 0x74, 
+0x09, 0x06, 
+
+0xFA, 0x18, 0x68, 0x41, 0x14, 0x2D, 0x4B, 0x00, 
+0xF2, 0x72, 0xAE, 0x8C, 0x0D, 0x93, 0xB7, 0xBF, 
+0xB8, 0x65, 0x1A, 0xA4, 0xA7, 0x1A, 0x90, 0x4A, 
+0xBA, 0xE1, 0x6E, 0x1B, 0x9C, 0x0D, 0xC5, 0xCD, 
+0x15, 0x1A, 0x7E, 0x80, 0x18, 0x6E, 0xFA, 0xB3, 
+0x41, 0xA1, 0xAC, 0x79, 0xCE, 0xB4, 0x09, 0x2E, 
+0x8D, 0x9A, 0xDC, 0x1E, 0x4D, 0x8B, 0xEB, 0xA6, 
+0xDE, 0x22, 0xEA, 0xC7, 0x39, 0x56, 0x7D, 0x73, 
+0xEC, 0x9B, 0xBA, 
+
+0x52, 0x43, 0xBA, 0xE8, 0x16, 0x77, 0x22, 0xD9, 
+0xF7, 0xEF, 0xEC, 0xBA, 0x56, 0x0E, 0xE1, 0xBD, 
+0x2E, 0x20, 0xFA, 0xE8, 0x35, 0xA3, 0x72, 0x9E, 
+0x36, 0x0E, 0x6D, 0x89, 0xD0, 0x12, 0x9C, 0xF4, 
+0xD2, 0x79, 0x6E, 0xFA, 0xCC, 0x1C, 0x5C, 0x69, 
+0xD8, 0x8C, 0x3A, 0xD1, 0x66, 0x76, 0x6D, 0xF0, 
+0xEA, 0xCF, 0xAC, 0x7A, 0x64, 0x07, 0xEC, 0xA5, 
+0x09, 0xCA, 0xF4, 0x76, 0x82, 0xBC, 0x29, 0x6F, 
+0x8A, 0x21, 0xDC, 0xDA, 
+
+0xEA, 0x3D, 0x1F, 0xCE, 0xD4, 0x11, 0x93, 0x95, 
+0xF5, 0x6F, 0xC1, 0xA4, 0x8D, 0x47, 0xC9, 0xFA, 
+0x0F, 0xEB, 0x15, 0x05, 0x39, 0x6B, 0x85, 0x6D, 
+0x90, 0x93, 0xDE, 0xA5, 0x9D, 0x5F, 0x4F, 0xF1, 
+0xD1, 0xD6, 0xEB, 0x04, 0xE2, 0x64, 0x0E, 0x3F, 
+0x4D, 0x52, 0x64, 0x1B, 0x35, 0x37, 0xC6, 0x96, 
+0x1B, 0x8E, 0xCB, 0x6D, 0x71, 0x5B, 0x54, 0x33, 
+0x4D, 0x46, 0xBB, 0x20, 0x7C, 0x9B, 0x71, 0x17, 
+0x5E, 0x47, 0x22, 0x4B, 0xC3, 
+
+0xD4, 0xF8, 0x77, 0x07, 0x7C, 0xC5, 0xED, 0xB1, 
+0xB2, 0x08, 0x39, 0x3D, 0x48, 0x37, 0x6F, 0xC2, 
+0xD3, 0xC4, 0xA1, 0x7E, 0x91, 0xD2, 0x98, 0xB3, 
+0xE1, 0x61, 0x67, 0x01, 0x24, 0xF4, 0x49, 0x20, 
+0xBE, 0x98, 0x92, 0x40, 0xEB, 0xA6, 0x1A, 0xD8, 
+0x59, 0x5F, 0x2F, 0x6C, 0xDF, 0xCA, 0xF2, 0x6F, 
+0xD4, 0x79, 0x91, 0x14, 0xD7, 0x91, 0x82, 0x7D, 
+0x54, 0x03, 0xEA, 0x49, 0xC3, 0xAF, 0x04, 0xDE, 
+0xED, 0x03, 0xF5, 0x25, 0x2C, 0x33, 
+
+0x2E, 0x3F, 0x60, 0x77, 0x62, 0x2E, 0x17, 0xAD, 
+0x13, 0xC6, 0x92, 0xBB, 0xC7, 0x42, 0x4E, 0xE8, 
+0x1A, 0xE1, 0xD5, 0x8E, 0x22, 0x13, 0x2A, 0xDC, 
+0xE6, 0xD3, 0x5C, 0xED, 0xF4, 0x7D, 0xFE, 0xB2, 
+0x06, 0xE5, 0x8D, 0x01, 0xB1, 0x46, 0xE3, 0xD1, 
+0x65, 0xC9, 0x48, 0xDB, 0x39, 0x7C, 0xF9, 0xF1, 
+0xDE, 0x05, 0xF1, 0x74, 0xFB, 0x33, 0xE6, 0x8C, 
+0x5D, 0xA2, 0x65, 0xEF, 0x05, 0x90, 0x5A, 0x8E, 
+0x9C, 0xAE, 0x9F, 0xEA, 0xFB, 0xCB, 0x4F, 
+
+0xC2, 0x27, 0x9D, 0xEE, 0x69, 0x88, 0x77, 0x89, 
+0xAE, 0x7D, 0x84, 0xCF, 0xB5, 0x24, 0x73, 0xE1, 
+0xD9, 0xA7, 0x39, 0x92, 0x34, 0xA0, 0x93, 0xA0, 
+0x54, 0x2D, 0x70, 0xF7, 0x33, 0x30, 0x35, 0xEB, 
+0x42, 0xF6, 0x53, 0xAD, 0x83, 0x40, 0xF0, 0xDE, 
+0xCD, 0xFC, 0x13, 0xB6, 0x66, 0xED, 0x74, 0x85, 
+0x5D, 0xB6, 0xE0, 0x6F, 0x41, 0xED, 0xC9, 0x7E, 
+0x5A, 0x47, 0x17, 0xD5, 0xA3, 0xAA, 0xEA, 0xE2, 
+0xB3, 0xF4, 0x21, 0x2A, 0xAE, 0x40, 0x34, 0x50, 
+
+0xB2, 0xEA, 0x93, 0xDA, 0x36, 0x5F, 0xFE, 0xEC, 
+0x7F, 0x2A, 0x6D, 0x95, 0x91, 0x8D, 0xAF, 0x95, 
+0x13, 0x34, 0x50, 0x13, 0x75, 0x60, 0x78, 0xA9, 
+0xF5, 0x5A, 0x7F, 0x2E, 0xB1, 0x16, 0xFA, 0x99, 
+0x91, 0x8D, 0xAE, 0xF8, 0x4A, 0x15, 0x8C, 0x99, 
+0x48, 0x68, 0x6F, 0x01, 0x05, 0x72, 0xFC, 0xC7, 
+0xC0, 0x66, 0x8E, 0xE8, 0x3F, 0xE5, 0xD3, 0x54, 
+0x2F, 0x06, 0xEE, 0x02, 0xAB, 0xF3, 0xAD, 0x73, 
+0x71, 0xCE, 0x07, 0x80, 0x39, 0xBF, 0xBC, 0x32, 
+0xC7, 
+
+0x0C, 0xD7, 0x7C, 0x15, 0x6F, 0x3C, 0xD0, 0x74, 
+0x36, 0xC5, 0x62, 0x13, 0x6F, 0x3F, 0xCD, 0xBD, 
+0xA9, 0xB7, 0x9F, 0x02, 0x43, 0xAF, 0xEC, 0x04, 
+0x89, 0x2A, 0x14, 0xCC, 0x10, 0x87, 0x79, 0xF7, 
+0x4D, 0x2C, 0x5C, 0x94, 0x96, 0x54, 0x87, 0x08, 
+0x1D, 0x0A, 0x67, 0xD7, 0xA8, 0x0A, 0x39, 0xFB, 
+0x89, 0xAA, 0x2C, 0xAE, 0x1E, 0x72, 0xE4, 0x25, 
+0x49, 0x5F, 0x31, 0xAF, 0xF7, 0xAE, 0xFB, 0xE9, 
+0x23, 0x87, 0xC6, 0xB2, 0x2C, 0xD4, 0xC1, 0x47, 
+0x57, 0x52, 
+
+0x14, 0xA2, 0x1D, 0x81, 0x89, 0x00, 0x93, 0x53, 
+0xAF, 0x30, 0xD1, 0x9E, 0x46, 0x93, 0x89, 0x00, 
+0xEA, 0x6F, 0x5F, 0x5A, 0x16, 0x97, 0x4A, 0xBD, 
+0xD3, 0xC1, 0xA6, 0x4E, 0x0E, 0xE0, 0xD7, 0x3A, 
+0x7D, 0x46, 0x1F, 0xC9, 0x29, 0x2E, 0x97, 0xAA, 
+0x2D, 0xA5, 0x52, 0x28, 0xFA, 0xFC, 0x2C, 0xD0, 
+0x21, 0xC1, 0x9F, 0xE3, 0xBC, 0x38, 0x44, 0x8D, 
+0xA2, 0x9E, 0x61, 0x24, 0x10, 0x69, 0xBA, 0x87, 
+0x42, 0x46, 0x50, 0x69, 0xE1, 0x3E, 0x0D, 0x8B, 
+0xFD, 0x62, 0xCF, 
+
+0x22, 0x4F, 0xAA, 0x98, 0x70, 0xFC, 0x6D, 0x10, 
+0x3F, 0xA4, 0x58, 0x81, 0xED, 0x70, 0x6F, 0x5D, 
+0xC7, 0x11, 0x4E, 0x0F, 0xBF, 0x8B, 0x6D, 0x13, 
+0xED, 0x83, 0x72, 0x84, 0xE7, 0x36, 0x19, 0xEC, 
+0xFD, 0x02, 0x7D, 0x37, 0x0A, 0xE0, 0xD9, 0x6B, 
+0xE7, 0x18, 0x4A, 0xA7, 0x93, 0x1C, 0x95, 0x0B, 
+0x18, 0x6A, 0x82, 0x4A, 0xB9, 0x83, 0xDC, 0x14, 
+0xA4, 0xC2, 0x0A, 0x05, 0x20, 0x13, 0xF6, 0xAA, 
+0x72, 0xC0, 0x3E, 0x43, 0xEE, 0x04, 0xFB, 0xDB, 
+0x9F, 0xBA, 0x7C, 0x4C, 
+
+0x54, 0x43, 0x98, 0x5E, 0x90, 0xEC, 0xA3, 0x25, 
+0x64, 0x99, 0xB8, 0x15, 0x48, 0x70, 0xD6, 0x81, 
+0x8D, 0x05, 0x94, 0xB3, 0xFB, 0x27, 0xB2, 0xF8, 
+0xF4, 0x27, 0x68, 0x66, 0x06, 0x5B, 0x13, 0x6A, 
+0x4D, 0x7B, 0x02, 0xAD, 0xE7, 0x5B, 0x5B, 0x7A, 
+0xAC, 0xD3, 0x80, 0x0D, 0x16, 0x53, 0x58, 0xCD, 
+0x3E, 0xDF, 0xD7, 0x1B, 0x0B, 0x6F, 0x0B, 0xC8, 
+0x82, 0xB9, 0x39, 0x8B, 0x2F, 0xD5, 0x92, 0x97, 
+0x8B, 0x61, 0x7D, 0x7A, 0xC2, 0xB8, 0xA4, 0x0F, 
+0x13, 0x2E, 0x6E, 0x56, 0x6F, 
+
+0x9A, 0x89, 0x62, 0xEF, 0x29, 0x45, 0x59, 0x78, 
+0xE5, 0x63, 0x25, 0x48, 0xBD, 0x80, 0x24, 0x92, 
+0x3A, 0x5E, 0x63, 0x03, 0xBA, 0x82, 0x8D, 0x98, 
+0xAC, 0x50, 0x74, 0x8F, 0x11, 0xA7, 0xE5, 0xF4, 
+0x71, 0x3A, 0xBC, 0x98, 0x62, 0x7E, 0x20, 0x8A, 
+0x3E, 0xD0, 0x11, 0x0F, 0xF4, 0x26, 0xCE, 0x85, 
+0xC9, 0x76, 0x28, 0x37, 0xA8, 0x2B, 0x70, 0xE2, 
+0xF8, 0x04, 0x2A, 0x27, 0x1F, 0x96, 0x26, 0x11, 
+0xD7, 0xB2, 0x03, 0x0A, 0xA6, 0xD9, 0x73, 0x19, 
+0x3B, 0x97, 0x45, 0xFD, 0xFA, 0xA8, 
+
+0xAE, 0xC6, 0x6A, 0x27, 0x5D, 0x7F, 0xDA, 0x2A, 
+0xC7, 0xA6, 0xDB, 0x07, 0xBC, 0xC7, 0x6D, 0xEC, 
+0xD4, 0x11, 0x6E, 0x0A, 0xEB, 0x58, 0xFD, 0x93, 
+0x02, 0xB3, 0x7A, 0x9B, 0xE4, 0x25, 0xF8, 0x66, 
+0x48, 0xA0, 0x4F, 0xD1, 0x7F, 0x1D, 0xE0, 0xB4, 
+0x6E, 0xF4, 0xE3, 0xD2, 0x39, 0x48, 0xE5, 0x36, 
+0x62, 0x21, 0x48, 0xA9, 0x60, 0x9A, 0x5B, 0x29, 
+0xF2, 0xA0, 0x80, 0x38, 0xC5, 0x0D, 0x48, 0x27, 
+0x02, 0x0C, 0x1C, 0x37, 0xAD, 0x12, 0x55, 0xDB, 
+0xA3, 0x44, 0x8E, 0xE0, 0x09, 0x19, 0x10, 
+
+0xD5, 0xF2, 0xCD, 0xBB, 0xA1, 0x62, 0x87, 0x04, 
+0x59, 0x01, 0x44, 0xA5, 0x5A, 0x05, 0x6D, 0x74, 
+0x94, 0x83, 0x99, 0x7A, 0x43, 0xDF, 0x2D, 0x50, 
+0x18, 0x77, 0x61, 0x5A, 0x0E, 0xA7, 0xD9, 0xA6, 
+0x11, 0xB7, 0xFF, 0x0F, 0x89, 0xC8, 0xCF, 0x53, 
+0x7B, 0xB8, 0x9A, 0xAF, 0xE7, 0xFB, 0x07, 0x48, 
+0x3B, 0xEA, 0xF7, 0xB0, 0xF1, 0xDC, 0xA8, 0xA5, 
+0xAF, 0xC6, 0xA9, 0xE7, 0x6B, 0x07, 0xF8, 0x49, 
+0x67, 0xC7, 0x42, 0xAF, 0xD2, 0xF7, 0xF1, 0xCB, 
+0xF3, 0x3C, 0x94, 0x48, 0xA2, 0x24, 0x17, 0x9D, 
+
+0x2B, 0x5F, 0x7B, 0x69, 0x54, 0x48, 0xBD, 0x90, 
+0x68, 0x27, 0x3E, 0x34, 0x0C, 0x4D, 0xA0, 0x70, 
+0x13, 0xFC, 0xC7, 0xE4, 0x88, 0x78, 0x96, 0x3A, 
+0xBC, 0x31, 0x11, 0xFE, 0x2B, 0x00, 0xD2, 0x6B, 
+0xA5, 0x23, 0xDE, 0xF1, 0x0B, 0xB3, 0x12, 0x88, 
+0x7F, 0x22, 0x60, 0x95, 0x04, 0x47, 0xF3, 0xD3, 
+0x83, 0x12, 0x5B, 0x4A, 0x47, 0xB3, 0x43, 0x79, 
+0x66, 0x76, 0x04, 0x5E, 0xA3, 0x4A, 0x90, 0xCD, 
+0x41, 0xDE, 0xD0, 0xD0, 0x73, 0x2B, 0xEC, 0x11, 
+0x7A, 0xED, 0xB0, 0xCC, 0x09, 0x2F, 0xFE, 0x2F, 
+0xA7, 
+
+0xFF, 0x38, 0x8B, 0xD2, 0xA9, 0x20, 0x66, 0x0D, 
+0xA0, 0x86, 0x95, 0x9D, 0xD7, 0x91, 0x7A, 0x77, 
+0xDF, 0x9C, 0xAF, 0xC0, 0x07, 0x83, 0x37, 0x2B, 
+0x48, 0x60, 0xFB, 0xB5, 0x54, 0x03, 0xD5, 0x05, 
+0xE3, 0xF7, 0xFA, 0xA5, 0x39, 0x29, 0xD3, 0xA8, 
+0xEA, 0xEA, 0x22, 0xCB, 0xBB, 0x33, 0x0D, 0x8F, 
+0xB9, 0xC3, 0xAC, 0x6A, 0x28, 0xE7, 0xD6, 0xDB, 
+0x1A, 0x6D, 0x98, 0x85, 0x18, 0x9B, 0x95, 0x00, 
+0x1F, 0xF5, 0xA0, 0xB2, 0xAB, 0xCD, 0x9B, 0x2F, 
+0xD7, 0x49, 0x8E, 0xF6, 0xDF, 0x7A, 0x77, 0x60, 
+0xA8, 0x67, 
+
+0xBB, 0xC8, 0xEA, 0xC5, 0x48, 0x56, 0x8E, 0x3F, 
+0xFC, 0x49, 0xD3, 0xD4, 0x0E, 0x6C, 0x42, 0xC2, 
+0x4F, 0x2C, 0x4C, 0x7F, 0x55, 0x10, 0x3A, 0x77, 
+0x6D, 0x72, 0x42, 0xD9, 0xEE, 0x82, 0x9C, 0xBF, 
+0x2E, 0x15, 0x0B, 0x98, 0xDB, 0x93, 0x0A, 0x65, 
+0xDB, 0x55, 0x94, 0xAF, 0x0F, 0x43, 0xEB, 0x83, 
+0x16, 0xFE, 0xD3, 0x6B, 0x43, 0xD6, 0x76, 0xC2, 
+0x77, 0x05, 0x1A, 0x8A, 0x4A, 0x64, 0x8B, 0xB3, 
+0x56, 0xF0, 0x8D, 0x8C, 0x32, 0x15, 0x2D, 0x99, 
+0x27, 0x5F, 0x81, 0xA5, 0xEC, 0x0D, 0x53, 0xC1, 
+0x27, 0xE4, 0xFD, 
+
+0xB6, 0x91, 0x88, 0x6B, 0xF8, 0x65, 0xF0, 0x29, 
+0x1E, 0x06, 0x5D, 0xC3, 0xC3, 0x58, 0x2E, 0x7B, 
+0xE4, 0xA9, 0x77, 0xF9, 0x32, 0x5C, 0x71, 0xB1, 
+0x51, 0x45, 0x67, 0xF6, 0x2D, 0x18, 0x40, 0xBD, 
+0xF4, 0x1E, 0xB8, 0xB0, 0xE6, 0x5B, 0x83, 0xA7, 
+0x5F, 0x23, 0xEB, 0xA4, 0x79, 0x8C, 0x97, 0xC7, 
+0x58, 0x02, 0x84, 0x8D, 0xB4, 0x39, 0x43, 0x5E, 
+0x26, 0xF1, 0x04, 0x45, 0xB9, 0x08, 0xB9, 0xED, 
+0xC4, 0x3F, 0x8F, 0xE2, 0xF7, 0xA7, 0x98, 0xF0, 
+0xFC, 0x28, 0x80, 0xD1, 0x26, 0x5A, 0x95, 0x18, 
+0x27, 0x42, 0x84, 0x56, 
+
+0x3F, 0x8C, 0x11, 0xC3, 0x53, 0x23, 0xC1, 0xF6, 
+0xED, 0xD3, 0x53, 0x6A, 0x9F, 0x6A, 0xFE, 0x0D, 
+0xA9, 0xE9, 0x28, 0xE4, 0x28, 0x3B, 0xFC, 0xB1, 
+0x1E, 0x14, 0xA5, 0xE2, 0xF5, 0x22, 0xEB, 0x5C, 
+0x56, 0x63, 0xE7, 0x01, 0xB2, 0x5E, 0x63, 0xA7, 
+0x8D, 0x7F, 0x36, 0x17, 0xD0, 0x22, 0x42, 0x30, 
+0xC3, 0xBC, 0x74, 0xB2, 0xB8, 0x0F, 0x2F, 0x0A, 
+0x4A, 0x99, 0x88, 0x91, 0xA0, 0x4F, 0x72, 0xFB, 
+0x63, 0xCC, 0x1D, 0x14, 0x0E, 0x31, 0x2A, 0xB9, 
+0x56, 0x46, 0xCB, 0x40, 0xC7, 0x33, 0xCD, 0xF4, 
+0x9B, 0x7C, 0x31, 0x63, 0x58, 
+
+0x1B, 0xFF, 0x63, 0xEB, 0x26, 0x51, 0x3A, 0x0F, 
+0x0D, 0x0E, 0x3A, 0x3C, 0x27, 0x19, 0x93, 0xE3, 
+0x25, 0xCD, 0xAA, 0xF6, 0xE6, 0x60, 0x72, 0x64, 
+0xC5, 0x96, 0x8F, 0xC2, 0x6D, 0xA4, 0xFA, 0x91, 
+0x62, 0xD5, 0xC9, 0xA2, 0xAB, 0x6A, 0x4D, 0x80, 
+0xE8, 0x5E, 0x3B, 0xA9, 0x82, 0x57, 0x56, 0xFF, 
+0x24, 0xA4, 0xBD, 0x7F, 0x8F, 0x4D, 0xCD, 0x15, 
+0x97, 0xBF, 0xDF, 0xE8, 0xF2, 0xA1, 0x20, 0x02, 
+0x75, 0x53, 0xEA, 0x60, 0xAB, 0x98, 0xD3, 0x61, 
+0x8B, 0x9E, 0xAF, 0x46, 0xFC, 0xA0, 0xB2, 0xE2, 
+0x55, 0xFF, 0x54, 0xE4, 0xBE, 0x30, 
+
+0x04, 0x44, 0x62, 0xFA, 0x6B, 0x2F, 0xFA, 0xB1, 
+0x45, 0x35, 0x6B, 0x64, 0xD4, 0x60, 0x4F, 0x79, 
+0x95, 0x2A, 0x60, 0x9D, 0xE6, 0x29, 0xD4, 0x6D, 
+0x1C, 0xA4, 0xF1, 0xE0, 0x06, 0x2C, 0xA1, 0xB4, 
+0x7F, 0x1D, 0x63, 0x9C, 0x6B, 0x8D, 0xE7, 0x4B, 
+0x86, 0x1E, 0x01, 0x90, 0xDE, 0x61, 0x26, 0x87, 
+0x28, 0x97, 0x4C, 0x53, 0x71, 0x56, 0x0E, 0xC0, 
+0x94, 0x8B, 0xBB, 0xCA, 0x39, 0xC2, 0x27, 0x36, 
+0x8F, 0xBD, 0x05, 0xC9, 0x46, 0x1D, 0xAE, 0xCF, 
+0x52, 0x03, 0x2A, 0xF6, 0x47, 0x88, 0x2E, 0x8C, 
+0x8A, 0xB7, 0x43, 0x3D, 0x75, 0xBB, 0x88, 
+
+0xC1, 0xD3, 0x82, 0xDB, 0x16, 0x3C, 0xC8, 0x8D, 
+0x95, 0x3B, 0xBB, 0x79, 0x54, 0xA6, 0x47, 0x4D, 
+0x45, 0xCF, 0x27, 0xF3, 0xE3, 0xFD, 0xA6, 0x1B, 
+0x40, 0xA8, 0x58, 0x54, 0x05, 0xFA, 0x25, 0xCB, 
+0xA3, 0x5E, 0xDD, 0xE8, 0x39, 0x6D, 0xDF, 0xF3, 
+0xE4, 0x25, 0x35, 0x2C, 0x79, 0xD8, 0xFB, 0x34, 
+0xEF, 0x5C, 0x4E, 0x24, 0xE4, 0xEA, 0xD0, 0xC6, 
+0x69, 0xAE, 0xA3, 0x44, 0x3A, 0x41, 0xE9, 0xCF, 
+0x8F, 0x93, 0x82, 0x54, 0x32, 0xFE, 0x26, 0x92, 
+0xFA, 0xA6, 0xCD, 0x71, 0xED, 0x53, 0x46, 0xED, 
+0xDE, 0x08, 0xDC, 0x1F, 0x8D, 0x02, 0x23, 0x37, 
+
+0x71, 0x50, 0xEF, 0x84, 0x83, 0xEC, 0x72, 0xB5, 
+0x3D, 0xDA, 0x39, 0xE9, 0xCE, 0x46, 0xF0, 0x0A, 
+0x6F, 0x92, 0x53, 0x5A, 0x35, 0x93, 0xF4, 0x0B, 
+0xA4, 0xE4, 0x81, 0x82, 0x34, 0x80, 0x04, 0xC1, 
+0xA7, 0x81, 0x02, 0xC7, 0x26, 0x58, 0xD3, 0x76, 
+0xC0, 0xCD, 0x0D, 0x5D, 0x4C, 0x46, 0x5F, 0x02, 
+0xB3, 0x40, 0x21, 0xE3, 0x45, 0xDD, 0x20, 0x29, 
+0x09, 0xC5, 0x53, 0x80, 0x40, 0x41, 0xD9, 0x60, 
+0xEC, 0xD3, 0xAA, 0xF8, 0x33, 0x97, 0x43, 0xC7, 
+0x52, 0x45, 0x73, 0xDA, 0xC6, 0x1D, 0x6D, 0xD5, 
+0x28, 0xDC, 0x15, 0x7B, 0x83, 0xC3, 0x91, 0x30, 
+0x58, 
+
+0x52, 0x1D, 0xC5, 0xB7, 0x68, 0x25, 0xA1, 0x7A, 
+0x0A, 0xB1, 0xAD, 0xE6, 0xF2, 0x64, 0x03, 0x80, 
+0x97, 0xEC, 0xC7, 0xE3, 0xB0, 0xEE, 0x8C, 0x04, 
+0x56, 0x1F, 0xF1, 0xC9, 0x75, 0xA7, 0xCD, 0xAF, 
+0x2E, 0x52, 0x60, 0x9D, 0xC7, 0xE5, 0x3C, 0x4F, 
+0x59, 0x38, 0x3D, 0xF9, 0xB1, 0x0B, 0xD5, 0x3D, 
+0x73, 0x37, 0xF0, 0x1B, 0x90, 0xF6, 0xD9, 0x69, 
+0x56, 0xA3, 0x54, 0x14, 0xEF, 0x87, 0xA1, 0xC8, 
+0x8D, 0xDA, 0x01, 0x0B, 0xAC, 0x18, 0xC6, 0x4F, 
+0x84, 0xE9, 0x3A, 0x0B, 0x64, 0xA0, 0x85, 0xB3, 
+0x72, 0xE0, 0x33, 0x4B, 0xC0, 0x07, 0x1C, 0xF5, 
+0x7E, 0xD6, 
+
+0x30, 0xD2, 0x7D, 0xE8, 0x42, 0xED, 0xC1, 0xB1, 
+0x3B, 0x0C, 0x23, 0x4A, 0x73, 0xD4, 0xBC, 0xDD, 
+0x04, 0xEE, 0xB4, 0x58, 0x9B, 0x80, 0xE2, 0x34, 
+0x3C, 0x3C, 0x28, 0x07, 0xC9, 0x8D, 0x2D, 0xF1, 
+0x52, 0xC6, 0xC9, 0x96, 0x68, 0xB8, 0x36, 0xC7, 
+0x61, 0xC1, 0x72, 0xC1, 0xF5, 0xE8, 0x28, 0x9F, 
+0x9C, 0x25, 0x8E, 0x66, 0xDF, 0xF7, 0xEE, 0xA9, 
+0x5A, 0x5C, 0x65, 0xD6, 0x33, 0xED, 0xD6, 0x5F, 
+0xF8, 0xE8, 0x90, 0x88, 0x90, 0x9F, 0x63, 0x7D, 
+0x25, 0xA1, 0xC2, 0x28, 0x5C, 0xBC, 0x39, 0x90, 
+0xF1, 0x23, 0xD7, 0xCA, 0xFE, 0x73, 0x0D, 0x4B, 
+0xDD, 0xC0, 0x6A, 
+
+0x52, 0x7D, 0xB2, 0x1D, 0xA1, 0x88, 0x9F, 0x10, 
+0xCA, 0x45, 0x0A, 0xFD, 0x9E, 0x2C, 0x0C, 0x0D, 
+0x1B, 0x4C, 0xA5, 0x84, 0x06, 0xC9, 0x60, 0x56, 
+0x14, 0x20, 0x56, 0xE1, 0x5A, 0x94, 0x9F, 0xD3, 
+0xCE, 0x95, 0x12, 0x91, 0x5B, 0x0C, 0x67, 0x81, 
+0xC4, 0x73, 0xFE, 0xB5, 0x00, 0x59, 0x9E, 0x93, 
+0xC6, 0x28, 0x92, 0x83, 0xE9, 0x4D, 0x27, 0xCE, 
+0x11, 0x0D, 0x4D, 0x5B, 0x02, 0x58, 0xC5, 0xAB, 
+0x1D, 0x99, 0x54, 0xA1, 0x79, 0xC9, 0xE6, 0x77, 
+0xFE, 0x42, 0x1A, 0xDC, 0x09, 0x0A, 0x1E, 0x57, 
+0x16, 0x56, 0xE5, 0xD2, 0xF3, 0x1E, 0xA0, 0x23, 
+0x82, 0xB1, 0x25, 0xE9, 
+
+0xEE, 0x13, 0xC6, 0xE5, 0x6C, 0x25, 0x94, 0xEA, 
+0x2D, 0x9D, 0xC0, 0x35, 0x33, 0x3D, 0xCB, 0x02, 
+0x3E, 0x2F, 0x8B, 0x12, 0x7B, 0x37, 0x8A, 0x3A, 
+0x89, 0xA9, 0x2C, 0x62, 0x1A, 0x48, 0x14, 0xCB, 
+0xC0, 0xB9, 0x0C, 0x97, 0x9B, 0x7B, 0x4E, 0xD8, 
+0xF4, 0xAB, 0x5C, 0x6F, 0x59, 0x5C, 0xE1, 0xEC, 
+0x19, 0xDC, 0x96, 0xB4, 0x84, 0x4B, 0xA8, 0xBE, 
+0x8B, 0xB6, 0x47, 0x0D, 0xBA, 0x38, 0x08, 0x70, 
+0x1C, 0xD9, 0xEB, 0xC0, 0x62, 0x32, 0xC2, 0xB7, 
+0x56, 0x69, 0xF0, 0xF9, 0x62, 0x0E, 0x8E, 0x4E, 
+0x15, 0xF1, 0xFC, 0x87, 0xFF, 0x33, 0xFA, 0x48, 
+0xA2, 0xEE, 0xEB, 0x2F, 0x82, 
+
+0xB1, 0xCC, 0xEF, 0x2D, 0x6C, 0xDC, 0xA5, 0x20, 
+0x14, 0xCE, 0x96, 0x36, 0xA0, 0xBB, 0x91, 0x7A, 
+0x18, 0x11, 0x1A, 0x22, 0xDB, 0xF6, 0x6A, 0x8F, 
+0x29, 0x1A, 0xB7, 0x7B, 0x9F, 0xF6, 0xED, 0xAB, 
+0xC1, 0x14, 0xAF, 0x08, 0x30, 0xD8, 0x26, 0x35, 
+0xDF, 0xDB, 0xA6, 0x76, 0xC9, 0xD3, 0x81, 0xA0, 
+0xB2, 0x09, 0x56, 0x7B, 0xB6, 0xA3, 0xED, 0xDC, 
+0x5F, 0x0B, 0x57, 0x0E, 0x87, 0x06, 0x6C, 0x8A, 
+0xA1, 0x99, 0x18, 0xD6, 0xFE, 0x74, 0xCA, 0xA6, 
+0x62, 0xF6, 0x1E, 0xA6, 0xAE, 0x50, 0x9C, 0xD5, 
+0x65, 0xC5, 0xF4, 0xFD, 0x8E, 0x45, 0x89, 0x23, 
+0x8C, 0xE9, 0x9C, 0xB9, 0x4C, 0x3D, 
+
+0xF3, 0xC1, 0x31, 0xCD, 0x5D, 0xAC, 0x6A, 0x9D, 
+0xC1, 0xF8, 0xB2, 0x9D, 0x09, 0xFD, 0xFC, 0x72, 
+0x46, 0x27, 0x87, 0x1F, 0xF3, 0xB9, 0x8A, 0x17, 
+0xCA, 0x1F, 0x50, 0x1C, 0xC5, 0xAD, 0xE2, 0x5F, 
+0x52, 0xE8, 0x03, 0x88, 0xFA, 0xCF, 0x92, 0xAB, 
+0xC5, 0x1C, 0xE3, 0xA7, 0x79, 0xB0, 0xF1, 0x92, 
+0x35, 0x5F, 0x0E, 0x4E, 0x41, 0x4C, 0xCD, 0x61, 
+0xDC, 0x7E, 0xBB, 0x2D, 0x1D, 0xA4, 0x2A, 0xF0, 
+0x55, 0x61, 0x66, 0x59, 0xA0, 0x25, 0xCE, 0x87, 
+0x04, 0x90, 0x2D, 0x4A, 0x2D, 0xB9, 0xC0, 0xC6, 
+0xE2, 0xEF, 0x4C, 0x8F, 0xB9, 0xDB, 0x92, 0x10, 
+0xC6, 0xD6, 0x3A, 0x35, 0x92, 0x0E, 0xDD, 
+
+0x68, 0x04, 0x09, 0x0A, 0xD7, 0x0B, 0x7C, 0x35, 
+0x15, 0x53, 0x68, 0x5F, 0x08, 0xC5, 0x40, 0xA0, 
+0xEF, 0x4B, 0x57, 0x1B, 0x1F, 0xBB, 0xBA, 0x92, 
+0xC4, 0xEA, 0x8E, 0xC0, 0x63, 0x7D, 0x8A, 0x29, 
+0x6C, 0x19, 0x98, 0x61, 0x47, 0x70, 0x69, 0xCE, 
+0xAE, 0xFA, 0xBC, 0xE7, 0x9B, 0x05, 0x5E, 0xB6, 
+0x95, 0x59, 0xF7, 0xA8, 0x7C, 0x37, 0xA7, 0x13, 
+0x4A, 0xFD, 0xDF, 0xED, 0xD3, 0x75, 0xAF, 0x2A, 
+0x43, 0x28, 0x7D, 0x53, 0x6C, 0x46, 0xCC, 0x60, 
+0x6F, 0x91, 0x0D, 0xB9, 0x84, 0x8D, 0xEC, 0x4A, 
+0x67, 0x0F, 0x88, 0x21, 0xE5, 0x5A, 0xD7, 0xBB, 
+0x9F, 0x1F, 0x05, 0x8D, 0x94, 0xCD, 0xBB, 0x57, 
+
+0x6A, 0xA4, 0x11, 0xAB, 0xD5, 0x85, 0x56, 0xD1, 
+0x82, 0x3B, 0x8E, 0x20, 0xD5, 0x22, 0x86, 0x53, 
+0xDF, 0xEF, 0xF0, 0xF1, 0xE8, 0x1D, 0x62, 0xAA, 
+0x22, 0xDD, 0x31, 0xA6, 0x92, 0xBF, 0x45, 0x9A, 
+0x4A, 0x7B, 0x30, 0x9F, 0x13, 0xA9, 0xA3, 0x46, 
+0x7D, 0x49, 0x23, 0x80, 0x42, 0xCF, 0xB3, 0x81, 
+0x83, 0x35, 0x97, 0xAB, 0x63, 0x62, 0xA5, 0x4F, 
+0x15, 0xCF, 0xE9, 0xB2, 0xBF, 0x3E, 0x60, 0x8B, 
+0x23, 0xE2, 0x68, 0x90, 0xAD, 0x84, 0x44, 0x5D, 
+0xE5, 0x1D, 0x2B, 0x33, 0x2E, 0x96, 0x4D, 0x94, 
+0x70, 0x0C, 0x64, 0x38, 0x08, 0x17, 0xB6, 0x59, 
+0x57, 0xCE, 0x5F, 0x62, 0xAC, 0xE0, 0xBF, 0xE9, 
+0x0E, 
+
+0x41, 0x07, 0x9D, 0x7D, 0x67, 0x6E, 0x7C, 0x4E, 
+0x81, 0x7B, 0x0C, 0xB9, 0xE0, 0xF3, 0x84, 0xDD, 
+0x11, 0x08, 0xD4, 0x72, 0x7E, 0xC5, 0x33, 0x72, 
+0x68, 0xA5, 0x9B, 0x00, 0x3A, 0xAC, 0x58, 0x4C, 
+0x0A, 0xBD, 0x6E, 0x2C, 0x1D, 0x57, 0x00, 0x84, 
+0x26, 0x69, 0x68, 0x60, 0xF8, 0x6D, 0x93, 0x4B, 
+0x11, 0x1F, 0xF8, 0x1B, 0x83, 0x56, 0xB9, 0x17, 
+0x0D, 0x86, 0x3D, 0xF3, 0xBA, 0x13, 0x20, 0xA6, 
+0xC3, 0x7A, 0x13, 0xD3, 0x88, 0x46, 0xAF, 0x6F, 
+0x1C, 0x1E, 0xCD, 0xA4, 0xE0, 0x61, 0x96, 0xEE, 
+0x94, 0xA4, 0xF7, 0xCE, 0x28, 0x4A, 0xFC, 0x73, 
+0xC1, 0x30, 0x8E, 0xBA, 0x4F, 0x7D, 0xC8, 0x88, 
+0x58, 0x66, 
+
+0x62, 0x9A, 0x86, 0xBA, 0x43, 0x55, 0xDF, 0xBA, 
+0x38, 0x3A, 0x60, 0x62, 0xB5, 0xBE, 0x2F, 0x56, 
+0x7C, 0xA6, 0xB2, 0x19, 0x78, 0xB9, 0x94, 0xE0, 
+0xEE, 0xAC, 0x46, 0xF2, 0x00, 0x5B, 0x64, 0x48, 
+0x64, 0xA1, 0x33, 0x37, 0x1B, 0x15, 0x04, 0x7C, 
+0x4E, 0xB3, 0x4F, 0x41, 0xD6, 0x35, 0x27, 0x0A, 
+0x72, 0xF4, 0xF9, 0x4E, 0xCA, 0xFE, 0x8C, 0x4B, 
+0x9F, 0xC3, 0xE2, 0x78, 0x28, 0x99, 0xAD, 0x80, 
+0xEE, 0x54, 0x0F, 0x9B, 0x52, 0x59, 0xAF, 0xE1, 
+0x20, 0xDF, 0xBA, 0xE8, 0xF6, 0x04, 0x4D, 0x29, 
+0x61, 0x6B, 0x2A, 0xCF, 0xC6, 0xF1, 0x54, 0xF6, 
+0xCD, 0x41, 0xCE, 0x6C, 0x01, 0x21, 0x51, 0xCE, 
+0xF8, 0x17, 0xE1, 
+
+0x63, 0xA8, 0x6F, 0x8B, 0xED, 0xC3, 0x14, 0x4E, 
+0x07, 0x45, 0x38, 0xE3, 0x7C, 0x3E, 0x46, 0x8E, 
+0x6B, 0x8D, 0xC0, 0x89, 0xEB, 0x2E, 0x39, 0x51, 
+0xE5, 0xBA, 0x5F, 0xDB, 0x39, 0xF9, 0xD4, 0x76, 
+0xC8, 0x06, 0xD6, 0x40, 0xCC, 0x07, 0xD7, 0x61, 
+0xD5, 0xC5, 0x06, 0x9B, 0xB4, 0x0B, 0xCC, 0xD2, 
+0xA8, 0x74, 0x40, 0xC2, 0xA2, 0x27, 0xF3, 0xD7, 
+0x3D, 0x3D, 0x8B, 0x5B, 0xA1, 0x13, 0xB1, 0x40, 
+0x5B, 0xBC, 0x15, 0x5E, 0x79, 0xB5, 0x67, 0x2B, 
+0xC1, 0xC2, 0x1E, 0xBF, 0x99, 0x5A, 0x75, 0x80, 
+0x06, 0xC7, 0x9F, 0x10, 0xEB, 0xF2, 0xE1, 0x7E, 
+0x93, 0xBF, 0x6D, 0x66, 0x1A, 0xBA, 0x36, 0x01, 
+0x29, 0xA0, 0x16, 0x32, 
+
+0x7D, 0x56, 0x74, 0x41, 0x56, 0xA8, 0xA9, 0xFB, 
+0xF1, 0x40, 0xA5, 0x52, 0x7D, 0xF5, 0x03, 0x9D, 
+0xC3, 0x5C, 0xB4, 0xA9, 0x80, 0xAB, 0x60, 0x28, 
+0xBB, 0x19, 0x49, 0x0B, 0x5F, 0x36, 0xC5, 0x55, 
+0xA0, 0x5A, 0x41, 0xEE, 0x0E, 0xAB, 0x2F, 0xF4, 
+0xB0, 0xBE, 0x9B, 0xF6, 0xE8, 0xC5, 0xDF, 0x58, 
+0x74, 0x74, 0x81, 0x7B, 0x16, 0x9A, 0xAB, 0x6A, 
+0x8B, 0xA1, 0xBF, 0xD6, 0x68, 0x33, 0x18, 0xC7, 
+0xF9, 0x57, 0xE5, 0x7A, 0xE3, 0x07, 0x6E, 0xF6, 
+0x86, 0xC2, 0x18, 0xE1, 0x55, 0x19, 0xD9, 0xB4, 
+0xE2, 0x51, 0xB8, 0x69, 0x3F, 0x03, 0x5D, 0x21, 
+0xFC, 0xE5, 0xC9, 0xEE, 0xBC, 0xC9, 0xB7, 0x99, 
+0xFB, 0xA9, 0x9D, 0x0E, 0x7D, 
+
+0x68, 0xFF, 0xA2, 0x2B, 0xA4, 0xEC, 0xDD, 0x9A, 
+0x51, 0xAA, 0x69, 0xBE, 0xD9, 0xCD, 0xAF, 0xE2, 
+0x00, 0x50, 0x74, 0xFD, 0x11, 0x00, 0xD5, 0xA4, 
+0x4B, 0x33, 0xF0, 0xE2, 0x0B, 0x26, 0xAC, 0xD3, 
+0xE0, 0xFA, 0x4A, 0x99, 0xB0, 0xF5, 0x3C, 0x2A, 
+0xF6, 0x8D, 0x7F, 0xFC, 0xF5, 0xE4, 0x8B, 0xF5, 
+0xDA, 0xA0, 0xC3, 0xF3, 0xE3, 0x07, 0x7B, 0xE1, 
+0x7A, 0x31, 0xC5, 0x3C, 0x4D, 0x07, 0x1B, 0x14, 
+0x7C, 0xE9, 0xE4, 0x53, 0x90, 0x34, 0x34, 0xDC, 
+0xAA, 0x5E, 0x0B, 0xB5, 0x08, 0x31, 0x33, 0x34, 
+0x1B, 0x2F, 0x8A, 0xA0, 0xDB, 0x34, 0x3A, 0xB7, 
+0xE4, 0xC2, 0xED, 0x00, 0x17, 0xEE, 0x5C, 0x29, 
+0xD9, 0x11, 0x44, 0x87, 0x98, 0x12, 
+
+0x89, 0x9A, 0xC5, 0x66, 0x84, 0xC5, 0xE3, 0xCC, 
+0xC3, 0xB0, 0xB5, 0x82, 0xA3, 0x62, 0x92, 0x2C, 
+0x33, 0xF6, 0xBD, 0x0F, 0x96, 0xEC, 0xB2, 0x68, 
+0x7E, 0xB5, 0xD6, 0xA7, 0x7B, 0x20, 0x87, 0xA3, 
+0xB0, 0x07, 0xFA, 0x56, 0xEF, 0x98, 0x38, 0x7E, 
+0x0A, 0x93, 0xEF, 0x2C, 0x91, 0xC7, 0xC5, 0x5D, 
+0x10, 0xC1, 0xBC, 0xC3, 0x85, 0x9C, 0x60, 0xD1, 
+0x30, 0xF6, 0x74, 0x7E, 0xDF, 0x32, 0xB0, 0x97, 
+0xAD, 0x9C, 0x0D, 0xC6, 0xF6, 0x77, 0x50, 0xFD, 
+0x12, 0x06, 0x07, 0xF1, 0x8E, 0x4D, 0x4E, 0xF4, 
+0x6A, 0xDC, 0x73, 0x7E, 0xBF, 0xAE, 0x47, 0xE0, 
+0xE2, 0xEC, 0xCB, 0x04, 0xC7, 0xD0, 0x4A, 0x32, 
+0x6E, 0xE3, 0xA8, 0x9A, 0xFD, 0xA9, 0x39, 
+
+0xEC, 0x57, 0xD7, 0x8A, 0xAE, 0x71, 0xEC, 0x4D, 
+0x29, 0xB6, 0xCC, 0xD7, 0x86, 0x10, 0xE8, 0xB8, 
+0x91, 0xC3, 0x37, 0x5F, 0x87, 0x14, 0x05, 0xEC, 
+0xB0, 0xC1, 0x89, 0x26, 0x7C, 0xAF, 0xA5, 0xD1, 
+0xCB, 0x81, 0x08, 0x40, 0x97, 0x4B, 0x8D, 0x8C, 
+0x15, 0x01, 0x49, 0xD2, 0xB9, 0x2F, 0x81, 0x38, 
+0xE8, 0x6D, 0x43, 0xAF, 0xA8, 0xF8, 0x82, 0xFE, 
+0x6B, 0xBA, 0xFF, 0x4A, 0x63, 0x0D, 0x93, 0xE5, 
+0xE5, 0xE5, 0x76, 0x04, 0x32, 0x84, 0x02, 0xC1, 
+0x26, 0x38, 0x33, 0x5E, 0x22, 0x8B, 0x8B, 0xBF, 
+0x1F, 0x84, 0x7B, 0xA9, 0x1C, 0x19, 0x13, 0x22, 
+0xA9, 0x7B, 0x2F, 0x6E, 0x59, 0x0B, 0xB7, 0xE6, 
+0x49, 0x37, 0x07, 0xCF, 0x79, 0xFC, 0xA4, 0x0F, 
+
+0x90, 0x59, 0x5E, 0xB9, 0xBD, 0x7D, 0x03, 0x5A, 
+0xFB, 0x89, 0xAF, 0x5C, 0xF9, 0x92, 0x26, 0xD6, 
+0x69, 0x0D, 0xE4, 0xD0, 0xA8, 0x23, 0xDF, 0x81, 
+0x96, 0x5A, 0xB1, 0xD1, 0x9A, 0xCD, 0x67, 0x35, 
+0xF6, 0xFB, 0xE7, 0x5C, 0x79, 0x0D, 0x3D, 0x22, 
+0xC5, 0xBD, 0x36, 0x23, 0x54, 0x10, 0xED, 0x22, 
+0x11, 0xDC, 0xFF, 0x54, 0x50, 0xE1, 0x01, 0x5A, 
+0x50, 0xC1, 0xDE, 0x55, 0x98, 0xCB, 0x72, 0xCE, 
+0x35, 0x45, 0x93, 0xC1, 0x1B, 0x16, 0x55, 0xAC, 
+0x82, 0xC8, 0xA0, 0xF3, 0x64, 0x11, 0x0B, 0x6B, 
+0xCA, 0xF8, 0x94, 0xCB, 0xC4, 0x18, 0x74, 0xDD, 
+0xD3, 0xB1, 0x7B, 0xB9, 0x1B, 0x2B, 0xC2, 0xE0, 
+0xFF, 0xE9, 0xEF, 0x70, 0x44, 0x10, 0x5B, 0x11, 
+0x4C, 
+
+0x57, 0x82, 0xA5, 0x37, 0x2F, 0x1A, 0xEF, 0x26, 
+0xD7, 0x16, 0x5E, 0x8F, 0x04, 0xB2, 0x8F, 0x87, 
+0xED, 0xB1, 0x08, 0xE3, 0x91, 0x49, 0x3B, 0x7C, 
+0xEC, 0x7F, 0x9F, 0xDA, 0xA2, 0x33, 0xC0, 0x36, 
+0x7D, 0x38, 0xAA, 0xB7, 0xD3, 0x84, 0x6B, 0x29, 
+0x82, 0xC8, 0x14, 0xD3, 0x48, 0x0A, 0xB6, 0x60, 
+0xAD, 0xAC, 0x67, 0xDB, 0x04, 0xA6, 0xD8, 0x00, 
+0x1C, 0x42, 0x79, 0x4B, 0x7E, 0x0D, 0x3D, 0x43, 
+0x46, 0x0B, 0xDA, 0xFB, 0x00, 0xC6, 0x9C, 0xA4, 
+0xA5, 0xE6, 0xB6, 0xD0, 0xD0, 0x7D, 0xD0, 0x3F, 
+0x86, 0x36, 0x7E, 0x9E, 0x26, 0xA3, 0xDD, 0x26, 
+0xB3, 0x37, 0xBD, 0x76, 0x9F, 0xE9, 0xF4, 0xD8, 
+0x87, 0xFB, 0xB2, 0x7A, 0x60, 0x59, 0xB8, 0xB9, 
+0x61, 0xB1, 
+
+0xD4, 0x72, 0x03, 0x9E, 0x11, 0x78, 0xDE, 0x86, 
+0xA4, 0x7D, 0xCA, 0x35, 0x07, 0x23, 0x67, 0xFB, 
+0x98, 0xAB, 0x3C, 0x73, 0xA7, 0x83, 0x21, 0xE5, 
+0xA1, 0x3C, 0xA9, 0xC6, 0x4A, 0x91, 0x31, 0xD3, 
+0x9D, 0x40, 0x71, 0x4D, 0x37, 0xF6, 0x27, 0x8D, 
+0x82, 0x94, 0x25, 0xC7, 0x60, 0xAD, 0xD5, 0x99, 
+0x72, 0x90, 0xDC, 0xC7, 0x3D, 0xDF, 0x93, 0x88, 
+0x06, 0xD0, 0x38, 0xB7, 0x52, 0x6B, 0xF0, 0xBF, 
+0x84, 0xD8, 0x51, 0x8A, 0x56, 0x51, 0xF6, 0xC7, 
+0x15, 0x8B, 0xA0, 0xC5, 0x29, 0x49, 0x91, 0x52, 
+0xA3, 0xBA, 0x59, 0x7C, 0x8F, 0x7C, 0x0F, 0x9F, 
+0x46, 0xCC, 0x9F, 0xBF, 0x96, 0x32, 0x2F, 0xCF, 
+0x75, 0x58, 0x12, 0xEE, 0xC7, 0x76, 0xB4, 0x9C, 
+0x3F, 0x3F, 0x60, 
+
+0x68, 0xA5, 0x38, 0xD1, 0xB7, 0xCC, 0x55, 0xF2, 
+0x60, 0xC7, 0xD9, 0x30, 0x8B, 0x78, 0x51, 0xB2, 
+0xEA, 0x1D, 0xCF, 0x08, 0x5A, 0x7E, 0x96, 0x96, 
+0xE9, 0xE1, 0x1E, 0x4F, 0xD5, 0xB6, 0xCD, 0xB9, 
+0x4E, 0x4A, 0xE3, 0x4E, 0xA7, 0xC8, 0x7B, 0x43, 
+0x32, 0xB0, 0x3C, 0x64, 0xB0, 0xE4, 0x89, 0x8F, 
+0xDE, 0x26, 0x08, 0x7F, 0x45, 0xD4, 0xDA, 0xB2, 
+0xF2, 0x99, 0x04, 0xF0, 0x0C, 0xAC, 0x7A, 0xD0, 
+0x10, 0xD9, 0x5D, 0xF0, 0x6F, 0x90, 0xB6, 0x86, 
+0x02, 0xD9, 0x05, 0x4E, 0x62, 0x75, 0xBD, 0x0C, 
+0x4D, 0x48, 0xB8, 0x28, 0xDD, 0x5E, 0x25, 0xF8, 
+0x09, 0x9F, 0x40, 0xEB, 0xC0, 0xB1, 0x46, 0x31, 
+0x01, 0x1F, 0x78, 0xB3, 0x36, 0x2D, 0x74, 0x09, 
+0x8C, 0x3D, 0x2E, 0x27, 
+
+0xD3, 0xD1, 0xF6, 0x07, 0x96, 0x9A, 0xA9, 0x4B, 
+0x7F, 0x5B, 0xB7, 0x78, 0x27, 0x0A, 0x6D, 0xB0, 
+0x80, 0x1A, 0x6F, 0x2C, 0xEA, 0xB6, 0xEB, 0xE1, 
+0x21, 0x4E, 0xE9, 0xE6, 0xF1, 0x3B, 0x1B, 0xF8, 
+0x85, 0xBE, 0xE3, 0x2B, 0xEC, 0xDC, 0x22, 0xF2, 
+0x5F, 0x86, 0x8D, 0x2D, 0x9D, 0xCD, 0xA4, 0xB6, 
+0x5B, 0x58, 0xB4, 0x40, 0xA0, 0xED, 0x84, 0xA3, 
+0x3D, 0x10, 0x08, 0x41, 0xA8, 0x57, 0xA3, 0x22, 
+0xF3, 0xD9, 0xB1, 0xA3, 0x51, 0x2C, 0x87, 0x6D, 
+0xC1, 0xD3, 0xA1, 0xAC, 0x62, 0x55, 0x58, 0xAD, 
+0xCE, 0x32, 0xDC, 0xA3, 0xB6, 0xD8, 0x0E, 0x97, 
+0x4A, 0xB8, 0x39, 0x57, 0xB8, 0x90, 0x1B, 0x15, 
+0x9F, 0xCF, 0xAC, 0x74, 0x0B, 0xE9, 0x75, 0x61, 
+0x97, 0xDC, 0x5B, 0x11, 0x16, 
+
+0x11, 0x02, 0x41, 0xE5, 0x83, 0x08, 0xDC, 0xA1, 
+0x0B, 0x78, 0xB0, 0xF7, 0xC2, 0xEF, 0x7C, 0x8D, 
+0x15, 0x92, 0x15, 0x9D, 0xE1, 0xCD, 0x1F, 0x8B, 
+0x47, 0x7A, 0xD9, 0x91, 0xA0, 0x60, 0x86, 0x5C, 
+0xAA, 0x38, 0x7F, 0x95, 0xEA, 0x35, 0x80, 0xA1, 
+0xDA, 0xB6, 0x9E, 0x0B, 0xC8, 0x08, 0xA0, 0x60, 
+0x77, 0x53, 0x17, 0x8C, 0xB1, 0x97, 0x71, 0x09, 
+0x7E, 0x9C, 0x15, 0xE8, 0xD3, 0x73, 0x18, 0xE1, 
+0xB9, 0x71, 0x77, 0x9C, 0x6B, 0x96, 0xCC, 0xFB, 
+0x89, 0x9A, 0x47, 0x87, 0x6C, 0x59, 0xA5, 0x02, 
+0xDC, 0x5B, 0x8F, 0x18, 0xC7, 0xF5, 0x37, 0x00, 
+0x0A, 0xE2, 0xB6, 0x86, 0x5D, 0xAE, 0x1A, 0x0B, 
+0x69, 0x25, 0x4C, 0xA6, 0xA5, 0x01, 0x8A, 0xBA, 
+0x3B, 0x2D, 0xE0, 0x3D, 0x2D, 0x3C, 
+
+0xDA, 0xD2, 0xF4, 0x72, 0x42, 0x05, 0x7A, 0x76, 
+0xB2, 0xB9, 0x1D, 0x35, 0xB5, 0xFD, 0x57, 0xCE, 
+0x81, 0xB3, 0x35, 0x47, 0x9E, 0xFB, 0x35, 0xEC, 
+0x55, 0x10, 0x84, 0x49, 0x85, 0x3A, 0x3F, 0xAD, 
+0x44, 0x76, 0x05, 0x76, 0xCF, 0x52, 0xD9, 0xA9, 
+0xD4, 0x87, 0x24, 0xC1, 0x86, 0x66, 0x9B, 0x6C, 
+0x64, 0x64, 0x09, 0xF3, 0xA1, 0xBA, 0x57, 0xF4, 
+0xE0, 0x95, 0x7C, 0x0F, 0x33, 0x7B, 0xCB, 0xB0, 
+0xC1, 0x8C, 0xC0, 0xE3, 0xCD, 0x1C, 0xED, 0x75, 
+0x98, 0x5E, 0x82, 0x3F, 0x26, 0xC4, 0x99, 0xED, 
+0x0E, 0x14, 0x11, 0xC9, 0x06, 0x0E, 0x95, 0x17, 
+0x16, 0x4A, 0x6F, 0x6C, 0x60, 0xC6, 0xAD, 0xFC, 
+0xF0, 0xE5, 0xDD, 0x7B, 0xE0, 0x04, 0x24, 0x23, 
+0x5A, 0xDD, 0xDD, 0x6C, 0x11, 0xB2, 0x06, 
+
+0x80, 0x07, 0xDE, 0xDA, 0x61, 0x5F, 0x18, 0x84, 
+0xB5, 0x0C, 0xE3, 0xD3, 0xDD, 0xB0, 0x24, 0x59, 
+0x4C, 0xA1, 0x50, 0x4F, 0x2C, 0x13, 0xD0, 0x46, 
+0xFF, 0x8D, 0x35, 0xA5, 0xC6, 0x03, 0x8F, 0x9E, 
+0xED, 0x0C, 0x24, 0xAA, 0x44, 0xFA, 0x82, 0xA0, 
+0x41, 0xEA, 0xDD, 0xB9, 0xF5, 0x97, 0x32, 0xEB, 
+0x50, 0x0D, 0x8E, 0x53, 0xA7, 0xE5, 0x77, 0x0C, 
+0x67, 0xE5, 0xD3, 0xDB, 0xB3, 0x3E, 0xD5, 0x07, 
+0x67, 0x88, 0x7C, 0xCD, 0x2C, 0x3D, 0x82, 0xF9, 
+0x98, 0x07, 0x06, 0xDD, 0x97, 0xD2, 0xFE, 0x4B, 
+0xD2, 0xA8, 0x68, 0x41, 0xB7, 0x77, 0x9C, 0xA7, 
+0xD0, 0xE6, 0x33, 0x5A, 0x85, 0xFD, 0xE9, 0xD0, 
+0xB5, 0xC7, 0x0B, 0x8D, 0xA9, 0x87, 0xD3, 0x54, 
+0x82, 0x72, 0x5E, 0xDD, 0xDB, 0x50, 0xEF, 0x81, 
+
+0x6C, 0x42, 0xAA, 0xCA, 0xA6, 0x32, 0x85, 0xBF, 
+0xAD, 0x95, 0x1C, 0x8B, 0x10, 0x96, 0x10, 0xA8, 
+0x76, 0x70, 0x2D, 0x8A, 0xCB, 0x5D, 0xF2, 0xAF, 
+0x4E, 0x6D, 0x06, 0xF9, 0xDD, 0x1F, 0xC8, 0x84, 
+0x8D, 0x36, 0xA2, 0x87, 0xDC, 0xE1, 0xF5, 0xC9, 
+0x8B, 0xF9, 0xA4, 0xF6, 0xBB, 0x6F, 0x58, 0x56, 
+0x21, 0xD2, 0x85, 0x55, 0xDE, 0xF8, 0x28, 0x44, 
+0xE5, 0x37, 0x3A, 0xA4, 0xEC, 0x99, 0x4A, 0x4C, 
+0x4B, 0x92, 0xBE, 0x72, 0xDB, 0x4D, 0xFD, 0x9C, 
+0x13, 0x0B, 0x6D, 0xC7, 0x04, 0xBC, 0xA6, 0x8F, 
+0xED, 0xAB, 0x2B, 0xB9, 0xC7, 0x2A, 0x79, 0x33, 
+0x9E, 0x85, 0x34, 0x03, 0x14, 0xD3, 0x54, 0x68, 
+0x57, 0xFE, 0x06, 0x41, 0xAF, 0xE4, 0x7A, 0xEB, 
+0x5B, 0x35, 0x37, 0xE7, 0x21, 0x33, 0xAF, 0x91, 
+0x50, 
+
+0x99, 0x4D, 0x1D, 0xB1, 0x91, 0xE9, 0x61, 0x56, 
+0x26, 0xDD, 0xEE, 0x11, 0x51, 0xC3, 0xC6, 0xDD, 
+0x13, 0xDE, 0x85, 0xE2, 0x22, 0x4C, 0xDD, 0xEC, 
+0x8C, 0x07, 0xA5, 0x80, 0xBE, 0xC5, 0x43, 0x6F, 
+0x7D, 0x7E, 0xFA, 0x29, 0x17, 0x71, 0x89, 0xD5, 
+0x38, 0x02, 0x22, 0xF4, 0xF9, 0xC7, 0x22, 0xBB, 
+0x21, 0x74, 0x94, 0x62, 0xF3, 0x82, 0xBD, 0x7B, 
+0x2B, 0x72, 0x93, 0xA6, 0xEE, 0xEE, 0xC3, 0x51, 
+0xD9, 0x93, 0x29, 0xCF, 0x08, 0x37, 0x0D, 0x03, 
+0x93, 0x34, 0x50, 0x6A, 0xE6, 0x26, 0x3B, 0x89, 
+0xD9, 0x05, 0xA2, 0xB6, 0xE0, 0x49, 0x5C, 0x17, 
+0x59, 0x90, 0xA9, 0x5E, 0xB1, 0xCE, 0x17, 0xBE, 
+0x48, 0x07, 0x42, 0x48, 0x2F, 0x9C, 0x8D, 0x69, 
+0xE1, 0x9F, 0xEB, 0xF8, 0x73, 0x6C, 0x40, 0x92, 
+0xD9, 0x74, 
+
+0x24, 0x6E, 0xF9, 0xD3, 0x16, 0x06, 0x09, 0x32, 
+0x16, 0x43, 0xA7, 0x0C, 0x5E, 0x27, 0x06, 0x4A, 
+0xC7, 0xEF, 0x36, 0x27, 0xF4, 0xDC, 0x6F, 0x16, 
+0x79, 0x65, 0x90, 0x03, 0x0A, 0x7F, 0x39, 0xC8, 
+0x1F, 0x4E, 0xED, 0xB4, 0xB2, 0x69, 0xE1, 0x13, 
+0xD2, 0x23, 0x84, 0x0E, 0x2D, 0x70, 0xEB, 0x65, 
+0x97, 0x43, 0xD6, 0x2C, 0x46, 0xD4, 0xEF, 0xF8, 
+0x42, 0xCE, 0x61, 0xCF, 0xBC, 0x32, 0xE1, 0x5F, 
+0xC0, 0xA1, 0x8E, 0x87, 0xBC, 0xDD, 0x41, 0x0D, 
+0x57, 0x36, 0x6A, 0xAF, 0xF0, 0xEC, 0xB4, 0x75, 
+0x37, 0x8B, 0xA7, 0xAB, 0x71, 0x0F, 0x2D, 0x9F, 
+0x46, 0xC5, 0xAA, 0x0F, 0xF1, 0x0F, 0x53, 0xBB, 
+0xAC, 0x29, 0x34, 0xC8, 0x97, 0x5B, 0x97, 0xC5, 
+0xA6, 0x5A, 0x95, 0x09, 0x90, 0x15, 0x95, 0x07, 
+0xB5, 0x2F, 0x41, 
+
+0x4D, 0xD4, 0x42, 0x7F, 0x8B, 0x20, 0x26, 0xDC, 
+0x82, 0x95, 0xFA, 0x04, 0xA8, 0x34, 0x69, 0xF8, 
+0xA8, 0x36, 0xCE, 0x3C, 0x25, 0xCF, 0x26, 0xC1, 
+0x0D, 0x7C, 0x68, 0x7E, 0x85, 0x57, 0x62, 0x37, 
+0xB4, 0x78, 0x1F, 0x77, 0xB1, 0xCC, 0x76, 0xF4, 
+0x61, 0x13, 0x63, 0x53, 0xAD, 0x41, 0x57, 0xA7, 
+0x38, 0xE4, 0x66, 0xF5, 0x31, 0x15, 0x7D, 0xC6, 
+0x28, 0x0D, 0x6B, 0xD3, 0xD0, 0x12, 0x51, 0x41, 
+0x8D, 0xF9, 0x2B, 0x14, 0x86, 0x43, 0xFA, 0xFE, 
+0x2E, 0xC3, 0x97, 0xD9, 0x10, 0x1C, 0xB8, 0x93, 
+0x57, 0x0C, 0x58, 0x9F, 0x2D, 0xAC, 0xEF, 0x9F, 
+0x48, 0x2F, 0xBE, 0x26, 0xAA, 0xFE, 0xCD, 0xE7, 
+0x27, 0x20, 0x62, 0x6F, 0x93, 0x21, 0x53, 0xCD, 
+0x41, 0x21, 0xD7, 0x99, 0x41, 0x00, 0xF8, 0x73, 
+0x2E, 0x8F, 0x7A, 0x52, 
+
+0x87, 0x82, 0xB3, 0xFB, 0x3A, 0x1A, 0x65, 0x9C, 
+0x99, 0x95, 0x8C, 0x1B, 0xF9, 0x2F, 0x9D, 0x7C, 
+0xE6, 0x06, 0xC0, 0x18, 0x79, 0x2B, 0x62, 0x0D, 
+0xFB, 0xBB, 0xC5, 0x5A, 0xC8, 0x54, 0xB1, 0xFE, 
+0x67, 0x4F, 0xB8, 0xAC, 0x60, 0x28, 0x41, 0x43, 
+0x93, 0x7D, 0xA1, 0xDF, 0xE8, 0xC8, 0xFB, 0x76, 
+0x1D, 0x7D, 0x01, 0xEF, 0x9A, 0xDD, 0xA5, 0xAA, 
+0x1C, 0xC1, 0x09, 0xBD, 0xCF, 0x61, 0x2D, 0x6A, 
+0x3D, 0xE5, 0x0B, 0xD5, 0xBB, 0xEC, 0x17, 0x6D, 
+0x56, 0x3A, 0xF5, 0x0B, 0xF2, 0x0E, 0xB4, 0x41, 
+0x39, 0x9E, 0x12, 0xC3, 0xC8, 0x3B, 0x35, 0xA8, 
+0x13, 0x5E, 0xBD, 0xD2, 0x44, 0x3B, 0xAB, 0x6B, 
+0x64, 0xE6, 0xA1, 0xEA, 0x53, 0x24, 0xBD, 0x77, 
+0x9A, 0xB1, 0xB0, 0xE0, 0x64, 0x47, 0xCD, 0x23, 
+0xFE, 0x3B, 0xDB, 0x60, 0x35, 
+
+0x73, 0xFE, 0xEF, 0xD1, 0x4B, 0xCC, 0x4C, 0x47, 
+0x0B, 0x76, 0xF5, 0xD0, 0xA3, 0x12, 0x9A, 0x24, 
+0x6C, 0x23, 0xAC, 0x08, 0x85, 0x96, 0x1E, 0x14, 
+0x5A, 0x5E, 0x4C, 0x27, 0x85, 0x85, 0x39, 0xAF, 
+0x7D, 0x9A, 0x08, 0x40, 0x96, 0x8B, 0x63, 0x38, 
+0xCD, 0x2D, 0x2B, 0x09, 0x31, 0x8C, 0x2A, 0x99, 
+0x68, 0xBE, 0xAF, 0x7B, 0xDE, 0x47, 0x3D, 0x28, 
+0x86, 0xE5, 0x5B, 0x7C, 0x89, 0xEE, 0xEF, 0xCC, 
+0xA8, 0x16, 0x6C, 0xEF, 0xFD, 0x6A, 0x3D, 0xD8, 
+0x83, 0x77, 0x16, 0xAE, 0xF4, 0x35, 0x43, 0x5A, 
+0x3F, 0x45, 0x70, 0xE2, 0x59, 0xA4, 0x39, 0xF9, 
+0x4A, 0x53, 0x98, 0xCA, 0xCD, 0xC8, 0x69, 0xA4, 
+0xC1, 0x1F, 0x09, 0xD0, 0x43, 0x67, 0x19, 0x3D, 
+0x8B, 0xFA, 0x5A, 0xC1, 0xEC, 0x8C, 0x6E, 0x3C, 
+0x0A, 0x01, 0x0D, 0x71, 0x08, 0x63, 
+
+0xBA, 0x7B, 0x8D, 0x0A, 0x88, 0x6D, 0x1B, 0x45, 
+0x08, 0x87, 0xC4, 0x71, 0x0F, 0x0E, 0x30, 0xF6, 
+0xC3, 0xB4, 0x78, 0xD3, 0x5B, 0xAC, 0x60, 0x93, 
+0x98, 0xA0, 0x64, 0xD3, 0x7A, 0x50, 0x35, 0xFD, 
+0xBB, 0xAC, 0xDD, 0x61, 0xC5, 0x9E, 0xB2, 0x3F, 
+0x4B, 0x53, 0x37, 0xED, 0x65, 0x5F, 0xE6, 0x6A, 
+0x42, 0x7E, 0x36, 0x8F, 0x42, 0x02, 0x37, 0x22, 
+0x05, 0x47, 0xBC, 0x42, 0xB3, 0x5E, 0x93, 0x48, 
+0x30, 0x25, 0x52, 0x3A, 0x06, 0x33, 0x17, 0x57, 
+0x6C, 0xCE, 0xA4, 0xF0, 0x66, 0x77, 0x82, 0x21, 
+0xCB, 0xF5, 0x8E, 0xA4, 0x5D, 0x74, 0xE7, 0x65, 
+0x7F, 0xA0, 0xF8, 0x56, 0xCF, 0x69, 0x9E, 0x83, 
+0xD2, 0x46, 0xD0, 0xD7, 0x9A, 0x01, 0x4F, 0x77, 
+0x2D, 0x67, 0x47, 0xAF, 0x88, 0xAF, 0xCE, 0x99, 
+0x59, 0xDE, 0x42, 0xD1, 0xBC, 0x83, 0x51, 
+
+0xF6, 0xAA, 0x4F, 0xB2, 0x08, 0x43, 0x25, 0x55, 
+0x75, 0xBF, 0x94, 0x31, 0x49, 0x12, 0x68, 0xEF, 
+0x0C, 0xE2, 0xBD, 0x97, 0x44, 0x55, 0x65, 0x47, 
+0xD4, 0x78, 0x1B, 0xDA, 0x7B, 0x85, 0x1B, 0x8F, 
+0xDF, 0x77, 0x1D, 0x64, 0xAD, 0x68, 0x4E, 0x4F, 
+0x58, 0xF6, 0x8F, 0xA5, 0x66, 0x22, 0x52, 0xA5, 
+0x31, 0x63, 0x8F, 0x3A, 0x83, 0x25, 0x0F, 0x0B, 
+0x24, 0xF2, 0x0B, 0x90, 0x8B, 0xE4, 0x17, 0x8E, 
+0xF8, 0x89, 0xF6, 0x02, 0xE1, 0x5C, 0x35, 0x84, 
+0xFA, 0x24, 0x7F, 0x46, 0x8D, 0x3B, 0x8E, 0x2C, 
+0x89, 0xCB, 0xC1, 0xC3, 0xA2, 0xF4, 0x35, 0xAB, 
+0x91, 0x14, 0x89, 0x8C, 0xA1, 0x7D, 0x64, 0x15, 
+0x00, 0x9F, 0xC4, 0xD4, 0x69, 0xD5, 0xEB, 0xD8, 
+0x58, 0x9B, 0xDC, 0x48, 0xC8, 0x5D, 0x64, 0x99, 
+0x17, 0xB6, 0xA4, 0x65, 0xE7, 0x3C, 0x31, 0x77, 
+
+0x3C, 0xCE, 0x1B, 0xFA, 0x31, 0x15, 0xA0, 0x04, 
+0x9F, 0xAF, 0xE5, 0x3C, 0xE1, 0x9A, 0xFD, 0xBB, 
+0x2F, 0x74, 0xFA, 0x04, 0x8A, 0x24, 0xB0, 0x16, 
+0xF0, 0xC5, 0x0F, 0x20, 0x49, 0x7F, 0xAC, 0x41, 
+0x56, 0xED, 0xCC, 0xE1, 0x62, 0x80, 0x44, 0x2C, 
+0x58, 0x9B, 0x50, 0xFD, 0xE9, 0x0F, 0xFC, 0xB5, 
+0x09, 0xAB, 0xC9, 0x4A, 0x7F, 0xC4, 0xA6, 0xC5, 
+0x14, 0xA2, 0x5D, 0xF9, 0x2E, 0x9C, 0xEA, 0x63, 
+0x31, 0xB6, 0x1E, 0x31, 0xC1, 0x9A, 0x22, 0x77, 
+0x23, 0x59, 0x04, 0x50, 0xFC, 0x4D, 0x6F, 0x63, 
+0xD4, 0xAC, 0xA0, 0xF2, 0xF0, 0x76, 0x0C, 0x85, 
+0x33, 0x00, 0x20, 0x57, 0x1C, 0x24, 0x4A, 0xC3, 
+0xA1, 0x21, 0x7A, 0xCE, 0x6E, 0x33, 0xB3, 0x8E, 
+0xF9, 0x75, 0x3F, 0x11, 0x7B, 0x4F, 0x11, 0x96, 
+0xD9, 0x9F, 0x09, 0xF3, 0x75, 0x1B, 0xBB, 0xDF, 
+0x53, 
+
+0x21, 0x72, 0x73, 0x92, 0x37, 0x38, 0xC2, 0x62, 
+0x9A, 0x2B, 0x19, 0xEA, 0xFB, 0x12, 0x18, 0x16, 
+0xBE, 0x50, 0x25, 0xE6, 0xE0, 0xAA, 0xEE, 0x5F, 
+0x34, 0x5E, 0x5C, 0x37, 0xF7, 0x46, 0xCF, 0x96, 
+0x39, 0xC5, 0xD8, 0x35, 0xB1, 0x2F, 0x6C, 0xE1, 
+0x33, 0x95, 0xED, 0x21, 0x59, 0x96, 0xAD, 0x24, 
+0xD9, 0x2F, 0x83, 0x10, 0x58, 0x16, 0xCC, 0xF9, 
+0x1E, 0xD3, 0x53, 0x53, 0x2E, 0x75, 0x95, 0xE6, 
+0xD0, 0x4D, 0x78, 0x9B, 0xB5, 0xCD, 0x02, 0x9D, 
+0x13, 0x2C, 0x7B, 0x97, 0x82, 0x97, 0x16, 0x4C, 
+0x7E, 0x33, 0x96, 0x03, 0x05, 0xE4, 0x9D, 0x86, 
+0xB9, 0xC1, 0xEF, 0x0A, 0x78, 0xC2, 0xAA, 0x89, 
+0x87, 0xEE, 0xC4, 0x93, 0xA7, 0x5F, 0x2A, 0x65, 
+0x96, 0x3A, 0xAC, 0x59, 0x98, 0x85, 0x06, 0x67, 
+0xAA, 0x25, 0x5C, 0xEF, 0xC2, 0xFE, 0x51, 0xD9, 
+0x32, 0xED, 
+
+0x97, 0xCA, 0xE6, 0xA0, 0xF7, 0xBB, 0x3B, 0xE3, 
+0x42, 0xEF, 0x0E, 0x9B, 0x3D, 0x81, 0xEC, 0xBF, 
+0x1A, 0xE6, 0x2F, 0x37, 0xEB, 0x0E, 0x62, 0x8A, 
+0x94, 0xB9, 0x75, 0x39, 0x02, 0x11, 0x1D, 0x3F, 
+0x7F, 0x98, 0x9B, 0xA2, 0xA4, 0x45, 0x21, 0xBE, 
+0x07, 0xBE, 0x00, 0xF3, 0x39, 0x9B, 0x83, 0x4A, 
+0xB2, 0x03, 0x34, 0x71, 0xF3, 0xA3, 0xD3, 0x2E, 
+0xE5, 0xD2, 0x15, 0x57, 0xAB, 0x29, 0xC4, 0x0F, 
+0x83, 0x22, 0x81, 0x5C, 0x30, 0x5B, 0xEC, 0x37, 
+0x36, 0xBD, 0x4A, 0xBB, 0x98, 0x76, 0x3D, 0x04, 
+0xC6, 0xD9, 0xA8, 0x72, 0x51, 0xDA, 0xAA, 0x73, 
+0x25, 0x51, 0xCA, 0x47, 0x6D, 0x73, 0xB0, 0x1B, 
+0x7F, 0xCE, 0xD5, 0xED, 0x17, 0xB6, 0x96, 0x24, 
+0xC1, 0x3B, 0x24, 0x2C, 0xD4, 0x5C, 0x4B, 0x71, 
+0xD6, 0x07, 0xB2, 0x64, 0xC1, 0x91, 0xC7, 0x86, 
+0x50, 0x84, 0x80, 
+
+0x63, 0xF5, 0x1C, 0xDD, 0x41, 0x0E, 0x12, 0x7F, 
+0x5D, 0x53, 0x03, 0x14, 0x4D, 0x66, 0x20, 0x43, 
+0x78, 0xF9, 0x84, 0x12, 0xC8, 0xCD, 0x90, 0x5A, 
+0x69, 0x37, 0xFA, 0x46, 0x89, 0xB7, 0x3C, 0x6F, 
+0x1D, 0xEC, 0xD1, 0x99, 0x9E, 0xF9, 0xF4, 0x07, 
+0x93, 0xB2, 0xA0, 0x93, 0x5B, 0x25, 0x80, 0x34, 
+0x9D, 0x5A, 0x42, 0xAD, 0x69, 0x52, 0xED, 0x51, 
+0xAD, 0xB1, 0x4B, 0xF8, 0xAD, 0xF3, 0x79, 0xA5, 
+0x6A, 0xDC, 0x48, 0x20, 0x78, 0x56, 0xC0, 0x01, 
+0x24, 0x9B, 0x03, 0x20, 0x36, 0x3C, 0x89, 0x9F, 
+0x45, 0x7A, 0x51, 0xD5, 0xE0, 0x00, 0x68, 0x60, 
+0x6C, 0x6E, 0x16, 0xF9, 0xA8, 0xBE, 0x96, 0x5E, 
+0xEB, 0x99, 0x41, 0x86, 0x65, 0x7A, 0xC5, 0x19, 
+0xD1, 0x15, 0xBD, 0x42, 0x3F, 0x5E, 0x15, 0x3B, 
+0x65, 0x76, 0x4E, 0x99, 0x9C, 0x7D, 0x8A, 0x7F, 
+0xBC, 0xFA, 0x2F, 0x1D, 
+
+0x9D, 0xAA, 0xED, 0xF8, 0x73, 0x0B, 0x03, 0x26, 
+0x80, 0x92, 0x23, 0x67, 0x2C, 0xD0, 0xF9, 0xCD, 
+0xF8, 0x09, 0x88, 0x6D, 0xFE, 0x82, 0xA5, 0xE1, 
+0xF8, 0x66, 0xF5, 0xA8, 0xAA, 0x61, 0xBC, 0x35, 
+0x6A, 0xDA, 0x1A, 0x11, 0x26, 0x26, 0x5F, 0x97, 
+0xA0, 0xD1, 0x47, 0x9B, 0xF5, 0xFF, 0x59, 0x01, 
+0xC0, 0x01, 0x2C, 0xC8, 0x82, 0x59, 0xBD, 0x1A, 
+0xCE, 0x23, 0xB9, 0x14, 0x96, 0xEC, 0xDD, 0x98, 
+0x42, 0x5E, 0x2C, 0x5E, 0xEC, 0x78, 0xE1, 0x39, 
+0x33, 0x3A, 0xBA, 0x39, 0xFB, 0xE2, 0x34, 0x1E, 
+0xC2, 0x29, 0xF8, 0xEA, 0xC4, 0xAE, 0x8C, 0xDC, 
+0xEB, 0x80, 0xB8, 0xB8, 0x90, 0x3B, 0xE8, 0x2B, 
+0xCB, 0x0D, 0x5D, 0xCB, 0xC5, 0x25, 0x71, 0x67, 
+0x49, 0xB0, 0xD6, 0x30, 0xA7, 0x32, 0x07, 0xBD, 
+0xFC, 0xF2, 0x57, 0x82, 0x52, 0x96, 0x0E, 0x3C, 
+0x61, 0xAC, 0x73, 0x2A, 0x4E, 
+
+0x92, 0x62, 0xF6, 0xBF, 0x62, 0xD4, 0xBE, 0x0D, 
+0xE1, 0x9E, 0xCE, 0xED, 0x70, 0x04, 0xB8, 0x36, 
+0x4D, 0x13, 0x02, 0x85, 0x01, 0x60, 0x66, 0xA9, 
+0x00, 0x1B, 0x71, 0x3D, 0x5D, 0x7F, 0xBF, 0x64, 
+0xB8, 0x91, 0xE0, 0x28, 0x12, 0xBA, 0x8B, 0xA3, 
+0x20, 0x10, 0x23, 0x65, 0x53, 0xDD, 0x27, 0x2A, 
+0x44, 0x72, 0x3C, 0x90, 0x8A, 0x17, 0xF5, 0xF2, 
+0x13, 0x2D, 0xDD, 0xB2, 0xD1, 0x0B, 0x6D, 0x4C, 
+0xC5, 0x19, 0x56, 0xB0, 0x61, 0xC7, 0xD1, 0x1A, 
+0x7D, 0x36, 0xA0, 0xB4, 0x68, 0xB0, 0xBF, 0x5F, 
+0xC5, 0xF5, 0x74, 0xD2, 0x86, 0x13, 0xF5, 0x7E, 
+0x83, 0x93, 0x65, 0x4A, 0xE0, 0x81, 0xB2, 0x3D, 
+0x49, 0xB4, 0xC8, 0x02, 0x20, 0x7C, 0x44, 0x36, 
+0x89, 0xF9, 0x41, 0x1E, 0xBB, 0x37, 0x18, 0x76, 
+0x2E, 0x00, 0xD8, 0x1C, 0xD1, 0x58, 0x76, 0x18, 
+0x45, 0xC1, 0x8D, 0x24, 0x2F, 0x35, 
+
+0x6D, 0x06, 0xDB, 0xB3, 0xB1, 0x52, 0xB0, 0x66, 
+0xEA, 0xA1, 0xFC, 0x6D, 0xF7, 0x18, 0x39, 0xE3, 
+0xAC, 0x52, 0x04, 0x7B, 0x27, 0x9D, 0xBC, 0x01, 
+0x19, 0xF3, 0xB7, 0xB7, 0x64, 0x6D, 0xF7, 0x7C, 
+0x79, 0x4A, 0xF8, 0xE0, 0xB7, 0xC9, 0x21, 0x3F, 
+0xB1, 0x98, 0x66, 0x6A, 0x58, 0x59, 0xD3, 0xA9, 
+0xC2, 0xAE, 0x41, 0x70, 0x37, 0xFB, 0xD8, 0x91, 
+0x65, 0x4C, 0x47, 0xB3, 0x7E, 0xF6, 0xBD, 0x40, 
+0x8D, 0x3F, 0xB1, 0xA6, 0x19, 0x0B, 0x53, 0x46, 
+0xFB, 0x5B, 0x78, 0x19, 0xE3, 0x22, 0x2B, 0x3C, 
+0x69, 0xDA, 0xCB, 0x89, 0x00, 0xEB, 0xAA, 0xB1, 
+0x2D, 0x73, 0x1B, 0x83, 0xA1, 0x69, 0x7A, 0x79, 
+0x0D, 0xE4, 0xCC, 0x78, 0x7F, 0x80, 0x63, 0xE5, 
+0x63, 0xFF, 0x97, 0x84, 0x62, 0x08, 0x9A, 0xDC, 
+0xC3, 0x2D, 0x28, 0xE8, 0x84, 0xDC, 0xBE, 0x8B, 
+0xC3, 0x78, 0xB2, 0x46, 0xEF, 0x3A, 0x74, 
+
+0x39, 0xA4, 0xB5, 0x77, 0x33, 0x5A, 0x68, 0x7D, 
+0x79, 0x6B, 0x04, 0x86, 0xCD, 0xBF, 0xA6, 0x23, 
+0x80, 0x52, 0x15, 0x05, 0xCF, 0xC5, 0x9F, 0xE1, 
+0xCB, 0xCE, 0x7B, 0x34, 0x02, 0x2D, 0x13, 0x50, 
+0xF3, 0xD5, 0x46, 0xFE, 0x5F, 0xAF, 0xB3, 0xB9, 
+0xFD, 0xC4, 0x6E, 0xD2, 0x24, 0xF4, 0x94, 0xD5, 
+0xAC, 0xDB, 0x73, 0x88, 0xC2, 0x59, 0x4B, 0x25, 
+0x78, 0xBB, 0x2D, 0xC9, 0x09, 0xDB, 0x25, 0xCC, 
+0x78, 0xA8, 0x15, 0x57, 0x1A, 0xD2, 0x51, 0xC0, 
+0xF8, 0x28, 0x2C, 0x49, 0x73, 0xCB, 0xC3, 0x83, 
+0xD3, 0xDA, 0x99, 0x96, 0xFF, 0x23, 0x4B, 0x64, 
+0x0B, 0x57, 0xF4, 0x1A, 0x5E, 0x11, 0x3F, 0x25, 
+0x30, 0x30, 0x78, 0x18, 0xE5, 0x3E, 0xBD, 0xE3, 
+0xD1, 0x22, 0x47, 0xC5, 0xFB, 0x32, 0x87, 0x4F, 
+0x08, 0x4A, 0x45, 0xA6, 0x87, 0x9D, 0x48, 0xF6, 
+0x17, 0x28, 0x99, 0x72, 0xD9, 0x49, 0x64, 0x04, 
+
+0xB5, 0xA2, 0xF4, 0x34, 0xE6, 0x55, 0x44, 0x12, 
+0x5D, 0xD9, 0xB6, 0xE3, 0xC3, 0x50, 0x5B, 0x05, 
+0xCD, 0x5B, 0x92, 0xF5, 0x50, 0x15, 0x51, 0x1D, 
+0x69, 0xB1, 0x96, 0xD1, 0xC6, 0xF8, 0x81, 0x1B, 
+0xC6, 0x58, 0x5B, 0xDB, 0xA4, 0xA1, 0x24, 0x85, 
+0x9B, 0x34, 0xFD, 0x0C, 0xF7, 0xF0, 0x38, 0xBF, 
+0xBD, 0xDA, 0x92, 0xB1, 0xF7, 0x58, 0x66, 0xE0, 
+0xAE, 0x1E, 0x10, 0xD1, 0x17, 0x48, 0x1E, 0x5E, 
+0xAB, 0x6F, 0x67, 0x52, 0x13, 0x51, 0x78, 0xF1, 
+0xA7, 0x24, 0x34, 0x43, 0xCC, 0xDB, 0x78, 0x7A, 
+0x5B, 0xC4, 0xEA, 0x0D, 0x52, 0xF0, 0x16, 0xE1, 
+0xE9, 0xF8, 0xAD, 0xAA, 0x4F, 0x1B, 0xF2, 0x14, 
+0x14, 0x82, 0x0B, 0x3F, 0x15, 0xEA, 0xC7, 0x02, 
+0xD9, 0x0D, 0x1C, 0xAF, 0x11, 0x99, 0x43, 0x91, 
+0xDC, 0x6B, 0x74, 0x13, 0xD2, 0x3D, 0x0A, 0x7E, 
+0x2C, 0xAF, 0x16, 0x9C, 0xAD, 0xC1, 0xFE, 0x3B, 
+0xA8, 
+
+0x61, 0x23, 0xB8, 0x0A, 0x85, 0xD6, 0x39, 0x78, 
+0x42, 0xB6, 0x8D, 0x74, 0x7C, 0x26, 0xF6, 0xCE, 
+0x08, 0x60, 0x9B, 0x85, 0xC7, 0x1F, 0xC3, 0x7D, 
+0xD0, 0xCB, 0x62, 0x07, 0x54, 0x31, 0x4E, 0x97, 
+0x7A, 0x30, 0xE8, 0x6B, 0x6D, 0xB6, 0xD5, 0x42, 
+0xF7, 0x32, 0x0D, 0x58, 0x6F, 0x1E, 0xC6, 0x9A, 
+0x83, 0x13, 0xC6, 0xEC, 0x25, 0x5A, 0x63, 0x9E, 
+0xFA, 0xFD, 0x16, 0x92, 0x78, 0xD7, 0xA2, 0x63, 
+0x07, 0x88, 0xB3, 0x24, 0xB1, 0x13, 0xD0, 0x92, 
+0x4D, 0xD3, 0xFF, 0x0E, 0x5C, 0xF0, 0x92, 0x03, 
+0x44, 0x08, 0xF5, 0x77, 0x6F, 0x7A, 0x51, 0xEA, 
+0xA5, 0x41, 0x25, 0x12, 0x5A, 0x94, 0xBC, 0x28, 
+0x66, 0x08, 0xE0, 0x59, 0x0E, 0x3E, 0xFB, 0xA6, 
+0x59, 0x2D, 0xB2, 0xCA, 0xAB, 0xB0, 0xB8, 0x1F, 
+0xB4, 0x1C, 0xBA, 0x2A, 0x65, 0x21, 0x2A, 0xE4, 
+0x58, 0x31, 0x9A, 0x44, 0x47, 0x35, 0xFF, 0x0C, 
+0x47, 0x1E, 
+
+0x93, 0x07, 0x8D, 0x63, 0x8F, 0xA2, 0x59, 0xCC, 
+0xB5, 0x5A, 0x69, 0xC9, 0x41, 0xB3, 0x58, 0x34, 
+0x88, 0x78, 0x0C, 0x7D, 0x61, 0x50, 0xDB, 0xC1, 
+0xD8, 0x63, 0x2F, 0x53, 0xE0, 0x84, 0x55, 0xD6, 
+0xDB, 0xED, 0x91, 0x84, 0x42, 0x4C, 0xDE, 0xFB, 
+0x66, 0x5C, 0xC3, 0x8F, 0x63, 0x83, 0x13, 0xDE, 
+0x17, 0xA8, 0x73, 0xF9, 0x2E, 0xD6, 0xF9, 0x1D, 
+0xF3, 0x93, 0x2B, 0x1D, 0xCB, 0xA2, 0xE8, 0xD0, 
+0x52, 0x68, 0xDE, 0x70, 0xC3, 0x07, 0x00, 0x5F, 
+0x1A, 0xE6, 0xD3, 0x2D, 0x6E, 0x2B, 0xD0, 0x91, 
+0x83, 0x78, 0x89, 0xE7, 0x9E, 0xDE, 0x55, 0x98, 
+0xAE, 0x7C, 0xBD, 0x19, 0xC3, 0x8A, 0x8C, 0x4F, 
+0x20, 0xD4, 0x8E, 0x16, 0xA3, 0xA0, 0x57, 0x7A, 
+0x41, 0x6D, 0xAD, 0x5F, 0x96, 0x53, 0xFE, 0xB0, 
+0x09, 0x0C, 0x45, 0x33, 0x49, 0xD3, 0x3E, 0x30, 
+0xE2, 0x06, 0xBA, 0xAF, 0x6D, 0x82, 0xB5, 0x30, 
+0xF6, 0x8A, 0x1B, 
+
+0xBD, 0xD0, 0x9C, 0xA6, 0xAD, 0x79, 0x31, 0x5A, 
+0xB0, 0x23, 0x27, 0x1D, 0x04, 0x4B, 0xD3, 0xC3, 
+0xAB, 0x0E, 0xC6, 0x8B, 0xEB, 0x65, 0x35, 0x72, 
+0x57, 0xDE, 0x04, 0xB7, 0x93, 0xC2, 0x54, 0x86, 
+0x9A, 0xFB, 0xB2, 0xA8, 0x5C, 0xB4, 0x80, 0xAD, 
+0x88, 0x88, 0x76, 0x3D, 0xE2, 0xF7, 0x75, 0xB9, 
+0x85, 0x91, 0x78, 0x5E, 0x78, 0xFE, 0xDD, 0x3E, 
+0x48, 0x6C, 0x5C, 0xEE, 0x1E, 0xAC, 0x2D, 0xB1, 
+0x38, 0x11, 0xF4, 0xF7, 0x85, 0x9A, 0x26, 0xFF, 
+0x9F, 0x30, 0xF5, 0x84, 0x8D, 0x01, 0xC8, 0x0B, 
+0x89, 0xC1, 0x58, 0x72, 0xD8, 0xC4, 0x23, 0x7A, 
+0xFA, 0x29, 0xC8, 0xB0, 0x06, 0xFE, 0x28, 0x77, 
+0x09, 0x46, 0x33, 0x3F, 0xA4, 0xCF, 0xD9, 0x01, 
+0x5E, 0x37, 0xDC, 0xAD, 0x1E, 0xB9, 0x38, 0x4D, 
+0x99, 0x87, 0x48, 0xE5, 0xB8, 0x2E, 0x7B, 0x7E, 
+0xF3, 0xC3, 0xE9, 0x61, 0x7A, 0x4F, 0x83, 0x32, 
+0xF2, 0x27, 0x39, 0x96, 
+
+0xDE, 0xD6, 0x33, 0x1E, 0xF1, 0x8F, 0x0C, 0xB9, 
+0x6D, 0x49, 0x39, 0x88, 0xDE, 0x42, 0x83, 0xD4, 
+0x30, 0x28, 0x61, 0x7F, 0x97, 0xCF, 0x7A, 0xA0, 
+0x0E, 0xDF, 0xF9, 0xA9, 0xDB, 0x52, 0xB1, 0xBD, 
+0xCA, 0x9B, 0x7A, 0xAD, 0xB3, 0x22, 0x7E, 0x6E, 
+0x32, 0xCA, 0xDF, 0x1F, 0x26, 0x85, 0x4F, 0xC3, 
+0xCC, 0xB8, 0x95, 0x8E, 0x04, 0xD2, 0x4D, 0xB3, 
+0x5B, 0xDD, 0xED, 0x7F, 0x90, 0xA3, 0x9D, 0x88, 
+0xE6, 0x4A, 0xCF, 0xB7, 0x17, 0x3C, 0x3C, 0x5C, 
+0xB9, 0x7F, 0xA9, 0x1E, 0xCE, 0xD7, 0x21, 0x33, 
+0x5F, 0xB4, 0xD1, 0x8E, 0xDF, 0x28, 0x1A, 0xDF, 
+0xD3, 0xF0, 0x58, 0xE8, 0x1F, 0x3F, 0xAD, 0x57, 
+0xCD, 0x30, 0x3E, 0xD9, 0xD7, 0xC2, 0xBC, 0xB7, 
+0xC7, 0x71, 0x3E, 0x63, 0xAA, 0x9C, 0xD9, 0x29, 
+0x20, 0xF0, 0x64, 0x3A, 0xE2, 0xBC, 0xA0, 0x44, 
+0x71, 0x07, 0xD1, 0x7B, 0x2C, 0xD6, 0xC3, 0x68, 
+0x69, 0x27, 0x65, 0xA4, 0x15, 
+
+0xB2, 0xEF, 0x54, 0xB2, 0xE7, 0x93, 0x91, 0x5F, 
+0xF3, 0xE1, 0x3B, 0x59, 0xED, 0xB7, 0x1F, 0xA2, 
+0x4C, 0x26, 0x8F, 0xE2, 0x42, 0x0C, 0x71, 0x1B, 
+0x24, 0x5D, 0x52, 0xE4, 0xEC, 0xC6, 0x95, 0x0D, 
+0xD3, 0x19, 0xFD, 0xB8, 0x27, 0xBA, 0x71, 0x8D, 
+0x5D, 0x96, 0x63, 0x89, 0x6F, 0x4E, 0x66, 0x4A, 
+0xD0, 0xE5, 0x34, 0xEA, 0x4C, 0xF2, 0xA8, 0xF4, 
+0x91, 0x37, 0x17, 0x5A, 0x03, 0x95, 0x10, 0xCD, 
+0xFB, 0x1D, 0x1A, 0xDB, 0x1C, 0xF2, 0xD8, 0x2D, 
+0x4F, 0x5A, 0x33, 0xA1, 0x7A, 0x4A, 0xE4, 0xEB, 
+0xBE, 0x15, 0x59, 0x86, 0xAB, 0xD5, 0x54, 0xF1, 
+0x63, 0xDF, 0x97, 0xCA, 0x2A, 0x53, 0xD6, 0x13, 
+0x80, 0x22, 0x21, 0x86, 0xB1, 0x63, 0x7F, 0x84, 
+0x31, 0x33, 0x8F, 0x8E, 0xA6, 0x39, 0x9C, 0xA7, 
+0xA0, 0x2B, 0xBA, 0x12, 0x3F, 0xBC, 0xEA, 0xE6, 
+0x42, 0xC7, 0x79, 0x7B, 0x91, 0xF7, 0x73, 0xCB, 
+0x63, 0x8D, 0xD3, 0x67, 0x42, 0xA5, 
+
+0x64, 0xCC, 0xCC, 0x07, 0xD5, 0xB3, 0x23, 0x86, 
+0xF3, 0x91, 0x38, 0x88, 0xEA, 0x24, 0x4E, 0x11, 
+0x46, 0xD8, 0xC9, 0xD7, 0x81, 0x16, 0xEB, 0x6D, 
+0xD5, 0xE1, 0x21, 0xAB, 0x17, 0x3C, 0xD7, 0xDA, 
+0x11, 0x87, 0x23, 0xD3, 0xCE, 0xBA, 0x62, 0xCB, 
+0xEE, 0x82, 0xD8, 0x15, 0x15, 0xF1, 0x51, 0x6E, 
+0x29, 0x6F, 0xDB, 0xB3, 0x4F, 0x7F, 0x9B, 0x99, 
+0xAC, 0x02, 0x8A, 0x37, 0x67, 0x7F, 0x74, 0xFD, 
+0xD5, 0x0C, 0x89, 0x0D, 0xED, 0x78, 0xD8, 0xA7, 
+0x72, 0xC4, 0x1C, 0x85, 0x05, 0xC9, 0x52, 0x5F, 
+0x44, 0xD8, 0xDB, 0xA1, 0xAD, 0x44, 0x87, 0xB5, 
+0xBE, 0xF8, 0xA5, 0x15, 0xE9, 0x73, 0x1C, 0x6A, 
+0xFB, 0x3B, 0xF7, 0x40, 0xA9, 0x14, 0x3E, 0x18, 
+0x41, 0x9C, 0x90, 0xEF, 0x1E, 0xAA, 0x59, 0xF5, 
+0x5A, 0xB1, 0xFC, 0x0A, 0x27, 0x7A, 0xC9, 0x1D, 
+0x45, 0x59, 0x9A, 0x07, 0xDF, 0x95, 0x86, 0xB5, 
+0xC0, 0x4F, 0xF0, 0xAA, 0xBE, 0xB1, 0xBA, 
+
+0x1C, 0xA1, 0x9C, 0x20, 0xFC, 0xF7, 0x27, 0xCA, 
+0x81, 0x70, 0x11, 0xDA, 0x17, 0x0B, 0x29, 0x16, 
+0x81, 0x96, 0x13, 0x2D, 0xC1, 0xCD, 0xE5, 0xDD, 
+0xB2, 0x5D, 0x7C, 0x0D, 0x47, 0xA6, 0x75, 0xE2, 
+0x32, 0x2B, 0x21, 0x56, 0x91, 0x70, 0x71, 0x11, 
+0xE0, 0x5B, 0x94, 0xE0, 0x20, 0xF5, 0x58, 0x42, 
+0xBF, 0x37, 0x8A, 0x05, 0x0B, 0x0B, 0x2F, 0x34, 
+0xF8, 0xE3, 0xDC, 0xE8, 0xB3, 0xD3, 0x39, 0x71, 
+0x5D, 0x2D, 0xCE, 0xB9, 0x9B, 0xD6, 0xD9, 0xA4, 
+0x4B, 0xD0, 0x14, 0x84, 0xEE, 0x82, 0x3D, 0xFE, 
+0xA1, 0x4F, 0x22, 0x54, 0xFB, 0xF9, 0x87, 0xE3, 
+0x04, 0x5E, 0x2E, 0x7E, 0xF7, 0xB2, 0x21, 0x51, 
+0x9A, 0xAC, 0x6E, 0x25, 0x0C, 0x56, 0xEB, 0x70, 
+0x67, 0xC4, 0xA4, 0xFF, 0x01, 0xA9, 0x35, 0xBC, 
+0x3F, 0x62, 0x7C, 0x94, 0xE1, 0xE2, 0xD0, 0x9C, 
+0xC7, 0x86, 0x63, 0x34, 0x99, 0xA0, 0xEC, 0x4D, 
+0x2B, 0x47, 0x69, 0x1F, 0xFE, 0x59, 0xF6, 0x55, 
+
+0x32, 0xE0, 0xFC, 0xD4, 0x05, 0x42, 0xB1, 0x1D, 
+0xC3, 0x99, 0x15, 0x58, 0x47, 0x98, 0xEC, 0xB9, 
+0xE5, 0x9C, 0xE0, 0xF0, 0x12, 0x9C, 0x57, 0xAF, 
+0xD1, 0xB6, 0xF6, 0x02, 0x22, 0x21, 0x25, 0x92, 
+0xE5, 0x9F, 0x00, 0xB1, 0xEB, 0xAC, 0x26, 0x09, 
+0x15, 0xB5, 0x53, 0xD4, 0x51, 0xD3, 0x4F, 0x83, 
+0x92, 0x8F, 0x58, 0xE5, 0x1C, 0x01, 0x30, 0x6D, 
+0xBF, 0xF8, 0x96, 0xBC, 0x1E, 0x1B, 0x8F, 0xFC, 
+0xE3, 0x5D, 0xA6, 0x0F, 0x3A, 0xB6, 0x7A, 0x8D, 
+0x78, 0x8B, 0x7A, 0x97, 0x3A, 0xAE, 0x16, 0x03, 
+0x0F, 0x57, 0x62, 0xD0, 0xCB, 0x9D, 0xBD, 0x68, 
+0xBC, 0xDD, 0x8C, 0x89, 0xC0, 0x28, 0x88, 0x39, 
+0x98, 0xE2, 0xC9, 0xE9, 0x5B, 0x9E, 0xC6, 0xCE, 
+0x29, 0x00, 0xCB, 0x0F, 0x57, 0x7D, 0xDB, 0x0F, 
+0x35, 0x7C, 0xAE, 0x70, 0x3A, 0x0C, 0x29, 0x71, 
+0x79, 0x98, 0xF2, 0x5F, 0xD6, 0x6F, 0xD5, 0xA2, 
+0xE6, 0xE6, 0x20, 0x91, 0x36, 0x49, 0xED, 0xC6, 
+0xAE, 
+
+0xA7, 0x69, 0xD5, 0xA3, 0xDE, 0xB5, 0x88, 0xC4, 
+0x93, 0x8E, 0xCA, 0x3D, 0x50, 0x84, 0x61, 0x5E, 
+0xB9, 0x52, 0x6C, 0x43, 0x43, 0x82, 0xC9, 0xC9, 
+0xC1, 0xC5, 0xAA, 0x2C, 0xFF, 0x8E, 0x69, 0xF6, 
+0xD9, 0x84, 0x95, 0xFD, 0x63, 0x51, 0x12, 0xD6, 
+0xF6, 0xCA, 0xED, 0x5E, 0x2C, 0xC7, 0x0B, 0xA6, 
+0x31, 0xA4, 0xB8, 0xD4, 0xB5, 0x57, 0xA9, 0x3B, 
+0x41, 0x8E, 0xD4, 0x24, 0xBD, 0xC0, 0x02, 0x80, 
+0x3C, 0xC9, 0x81, 0xD0, 0xAC, 0x22, 0x27, 0x2D, 
+0x3C, 0x96, 0xCC, 0xCB, 0x4B, 0x9B, 0x7A, 0xBB, 
+0xA3, 0x2B, 0xC4, 0x6B, 0xF8, 0x61, 0x96, 0x7D, 
+0xB8, 0xA7, 0x3B, 0xD1, 0x10, 0x89, 0xCD, 0xD3, 
+0xA6, 0x6B, 0xE4, 0x4E, 0xDA, 0x05, 0xFC, 0xD5, 
+0x5C, 0xCA, 0xB9, 0xB9, 0x22, 0x84, 0x12, 0xA9, 
+0x65, 0xAB, 0xED, 0xDE, 0x2B, 0xBC, 0x17, 0x92, 
+0x32, 0x36, 0xD2, 0x4B, 0x23, 0xEE, 0x72, 0x03, 
+0xD7, 0x86, 0x32, 0x26, 0x27, 0xDA, 0xF1, 0x59, 
+0x79, 0x84, 
+
+0x28, 0x73, 0xE9, 0x74, 0x2A, 0x9A, 0xFB, 0x9C, 
+0x9D, 0x88, 0xC0, 0xBC, 0xEE, 0xFB, 0xED, 0xA3, 
+0x43, 0x03, 0x59, 0xE9, 0xE7, 0x46, 0xE1, 0x2B, 
+0x66, 0x65, 0x96, 0xFE, 0xED, 0x7F, 0xC4, 0xCB, 
+0x5A, 0x78, 0x98, 0x41, 0xA7, 0x94, 0xBB, 0x69, 
+0xAD, 0xDA, 0xCA, 0x23, 0xAE, 0xBA, 0xCC, 0xF9, 
+0xDE, 0x7C, 0xC2, 0x56, 0xB3, 0x5B, 0x44, 0xB5, 
+0xE6, 0xC4, 0x40, 0x8A, 0x59, 0x36, 0x70, 0xC2, 
+0x3B, 0xC1, 0xB7, 0xDC, 0xD7, 0x2C, 0x0A, 0xAC, 
+0x36, 0x69, 0xF5, 0xA8, 0x88, 0x8C, 0x05, 0xA6, 
+0xB1, 0x6C, 0x42, 0x0D, 0xC7, 0xCC, 0xE2, 0x08, 
+0x04, 0x00, 0x17, 0x7B, 0xAE, 0xF0, 0x6B, 0xB6, 
+0xC9, 0x2B, 0x39, 0xBC, 0x13, 0x59, 0x87, 0xAD, 
+0xB1, 0xC6, 0xCB, 0x22, 0x07, 0x40, 0x94, 0xBF, 
+0xF8, 0xF2, 0xF4, 0x12, 0x16, 0x66, 0x33, 0x9F, 
+0x21, 0xB2, 0x52, 0x66, 0xD5, 0x80, 0x6C, 0x48, 
+0x93, 0xFF, 0x87, 0x02, 0x6E, 0x35, 0xBB, 0xA4, 
+0x82, 0xC4, 0x0C, 
+
+0xD7, 0x9F, 0x5C, 0x2F, 0x67, 0x44, 0x69, 0xF0, 
+0x7C, 0xB6, 0xFE, 0x6E, 0x87, 0x89, 0x57, 0xD6, 
+0x75, 0x08, 0xB6, 0x07, 0x08, 0x2E, 0x18, 0x4D, 
+0xD4, 0xD7, 0x85, 0x8C, 0xFD, 0x91, 0xA5, 0x13, 
+0xE2, 0xC3, 0x8A, 0xB4, 0xE2, 0xB9, 0xA9, 0xE7, 
+0xA0, 0x3C, 0xF7, 0x83, 0xE2, 0x40, 0x78, 0x87, 
+0xBA, 0xB3, 0x68, 0x0E, 0x8E, 0xCE, 0x32, 0xB3, 
+0x29, 0x97, 0x33, 0xA0, 0x5D, 0x1D, 0x17, 0xF3, 
+0xF7, 0xDE, 0x0E, 0xE3, 0x81, 0x82, 0xFF, 0x38, 
+0x65, 0xA3, 0x87, 0x69, 0x8C, 0x93, 0x61, 0x90, 
+0xE7, 0x74, 0x09, 0x83, 0xC7, 0xAF, 0xA7, 0xEC, 
+0x72, 0xE2, 0x39, 0xE1, 0x39, 0xAE, 0x26, 0x17, 
+0x0F, 0xE7, 0xB0, 0xC2, 0x61, 0x32, 0xA9, 0x81, 
+0x86, 0x81, 0xA4, 0xFA, 0x61, 0x2D, 0x27, 0x9E, 
+0x73, 0xD2, 0x5D, 0x72, 0x95, 0xD3, 0x2A, 0xA1, 
+0xD2, 0x52, 0x00, 0x18, 0xA9, 0xE3, 0x1B, 0x7F, 
+0xF0, 0x16, 0x8C, 0x88, 0xA4, 0x4C, 0x06, 0x9B, 
+0xA8, 0x2E, 0x86, 0x96, 
+
+0x6A, 0xC0, 0x03, 0xC8, 0x82, 0xB5, 0x1D, 0xAE, 
+0x3F, 0xE7, 0x12, 0x30, 0x07, 0xC1, 0x80, 0x20, 
+0x82, 0x66, 0x12, 0xCB, 0x6B, 0x69, 0x06, 0x53, 
+0xF9, 0x6A, 0x4F, 0x83, 0x7D, 0xDC, 0x20, 0xC3, 
+0x72, 0xA3, 0x72, 0xEA, 0xE9, 0xA3, 0x91, 0x1B, 
+0x52, 0x6C, 0x99, 0x92, 0x2C, 0x6E, 0x84, 0xA3, 
+0x95, 0xE8, 0x15, 0xC9, 0x6A, 0x91, 0x40, 0xBF, 
+0x38, 0x73, 0xDC, 0x3B, 0x45, 0xCE, 0x6D, 0x8C, 
+0x9F, 0x41, 0x4A, 0x21, 0x81, 0x09, 0xEA, 0xD3, 
+0xF4, 0xD3, 0x73, 0x11, 0x99, 0x22, 0x27, 0x91, 
+0xA4, 0xF1, 0x6E, 0x90, 0xC4, 0x32, 0x87, 0x7E, 
+0x01, 0x68, 0x22, 0xD0, 0xA3, 0xB7, 0x95, 0xC7, 
+0x7F, 0xA4, 0xB1, 0x1B, 0x49, 0x55, 0xAD, 0x67, 
+0xCF, 0xD2, 0xF6, 0x2E, 0x5F, 0x47, 0x47, 0x49, 
+0x0F, 0x78, 0x5E, 0xB2, 0x28, 0xD1, 0xCF, 0x09, 
+0xE0, 0x75, 0x3D, 0x6F, 0xFA, 0x4C, 0x63, 0x58, 
+0xCF, 0x5A, 0x19, 0xEE, 0xAD, 0x78, 0x6D, 0x7B, 
+0xD9, 0xAF, 0xE1, 0xE1, 0x82, 
+
+0xE7, 0xE8, 0x9C, 0x37, 0x2A, 0xA3, 0x60, 0xEB, 
+0x93, 0x96, 0x15, 0xA4, 0xB0, 0xEB, 0x42, 0xA3, 
+0x81, 0x85, 0xE4, 0x72, 0x4C, 0x18, 0xC8, 0x24, 
+0xA5, 0x4C, 0xB3, 0x30, 0xB9, 0x40, 0x41, 0xC8, 
+0xA1, 0x77, 0xA7, 0x88, 0x44, 0x15, 0xAD, 0x39, 
+0x3F, 0x6C, 0xDB, 0x1B, 0x75, 0xFF, 0xD3, 0x66, 
+0xAE, 0x69, 0x94, 0xA6, 0x72, 0x51, 0x71, 0xF0, 
+0x79, 0xE6, 0xAA, 0xAC, 0xBB, 0x79, 0xAA, 0x4A, 
+0x17, 0xFA, 0x03, 0x02, 0x8A, 0x36, 0xE9, 0x9D, 
+0xCF, 0x22, 0x45, 0x69, 0x68, 0x3E, 0xF1, 0x27, 
+0x13, 0x4E, 0xC8, 0xF8, 0xDB, 0xA7, 0x1E, 0x93, 
+0xA0, 0xB0, 0xE8, 0x0E, 0x51, 0x63, 0x46, 0x1F, 
+0xB7, 0x29, 0x44, 0xAB, 0x31, 0xD6, 0x02, 0xC8, 
+0x70, 0x57, 0xD7, 0xC7, 0x15, 0x81, 0xA2, 0x3B, 
+0xF1, 0x3E, 0x5C, 0xD3, 0xB5, 0xAF, 0x1B, 0xF5, 
+0xDB, 0xD5, 0xBC, 0x1D, 0xA8, 0xCE, 0xF6, 0x16, 
+0xCB, 0x61, 0x2A, 0xF8, 0x9E, 0xCF, 0xBC, 0xE1, 
+0x79, 0xAE, 0x8C, 0x8F, 0x3D, 0x46, 
+
+0xF4, 0xBF, 0xD3, 0x8E, 0xAE, 0xA5, 0xF0, 0x1D, 
+0x0A, 0xE9, 0x77, 0x35, 0xC7, 0x6C, 0x80, 0x39, 
+0x8C, 0x3C, 0xCB, 0xCD, 0x6D, 0x5F, 0x07, 0x98, 
+0x32, 0x97, 0x66, 0xCB, 0xA9, 0x16, 0x87, 0x85, 
+0x6C, 0x80, 0x42, 0xBB, 0x47, 0xA2, 0x08, 0x7F, 
+0xD2, 0xF0, 0x6E, 0xFF, 0xCA, 0x3B, 0x71, 0x33, 
+0x9E, 0x1A, 0x89, 0x3E, 0x58, 0x75, 0x26, 0xE1, 
+0xA7, 0x73, 0xEC, 0x33, 0xB8, 0xA4, 0x0D, 0xDE, 
+0xE2, 0x00, 0x74, 0x3E, 0xB4, 0x1B, 0x00, 0x8C, 
+0xED, 0xFF, 0xF3, 0x48, 0xA1, 0xDA, 0xCE, 0x6E, 
+0x42, 0x89, 0x42, 0x30, 0x41, 0x1D, 0x6A, 0x25, 
+0x70, 0x55, 0x79, 0x55, 0xF5, 0x2B, 0xDE, 0x7D, 
+0x97, 0x66, 0xE6, 0x7E, 0x97, 0xBD, 0x4D, 0x26, 
+0x29, 0x32, 0x1E, 0x05, 0xAD, 0x2F, 0x8C, 0x9E, 
+0x1F, 0x41, 0xB6, 0xCA, 0x5D, 0x5F, 0xC4, 0xF2, 
+0xF8, 0xCF, 0x8F, 0x7E, 0xE8, 0xE9, 0x2F, 0xA8, 
+0x60, 0x28, 0xAF, 0xD3, 0x54, 0x4E, 0x66, 0x10, 
+0xC9, 0x8A, 0x7D, 0xC7, 0xEE, 0x50, 0x6B, 
+
+0x51, 0xFC, 0xF7, 0xDB, 0x4F, 0x91, 0x87, 0xB9, 
+0x36, 0xB3, 0xBD, 0x01, 0x01, 0xED, 0xB5, 0xF4, 
+0x8F, 0xD9, 0xE0, 0xCD, 0x89, 0xCF, 0xB3, 0x82, 
+0x33, 0x30, 0xE3, 0xEC, 0x90, 0xC4, 0x60, 0x1B, 
+0x11, 0x5D, 0x70, 0x5F, 0x84, 0x5F, 0xC5, 0xA5, 
+0xD9, 0xDC, 0xA7, 0x11, 0xEC, 0xE1, 0x41, 0xCE, 
+0xF8, 0xBE, 0x31, 0xFA, 0xEB, 0xFA, 0x56, 0x66, 
+0x01, 0xAB, 0x26, 0x28, 0xD8, 0x6B, 0x30, 0x6C, 
+0x3F, 0xE2, 0xA3, 0xCD, 0x04, 0x45, 0x3F, 0xA2, 
+0xAE, 0x92, 0xCA, 0xAE, 0x73, 0x8F, 0x47, 0x0E, 
+0xA2, 0x5F, 0x92, 0x26, 0xBA, 0x8D, 0xD3, 0x21, 
+0xDB, 0x61, 0xBD, 0x9F, 0x29, 0x15, 0xF3, 0x42, 
+0x52, 0x1D, 0x94, 0xB8, 0xD3, 0xD3, 0xB4, 0x60, 
+0xA9, 0x18, 0xDB, 0x34, 0x51, 0x05, 0xFF, 0xB4, 
+0xAA, 0xE8, 0x0A, 0xA6, 0x4E, 0xD0, 0x21, 0x82, 
+0xB6, 0xE2, 0x2D, 0x2C, 0x77, 0x7E, 0x03, 0x0E, 
+0x11, 0xE0, 0x2B, 0x8C, 0x7A, 0x78, 0x5C, 0xB3, 
+0x72, 0x13, 0x6D, 0xA0, 0x80, 0xBA, 0xDD, 0x30, 
+
+0x68, 0x26, 0x3A, 0x81, 0x8E, 0x7B, 0x1F, 0x5A, 
+0x80, 0xED, 0x33, 0xE6, 0x05, 0x85, 0x5E, 0xF1, 
+0xE1, 0x6F, 0x06, 0xCE, 0x85, 0x15, 0x79, 0x35, 
+0x3A, 0x2F, 0x57, 0x2F, 0xE2, 0xC9, 0x0D, 0x0F, 
+0x6E, 0xF2, 0x4A, 0xF8, 0xB8, 0x61, 0xB3, 0xAC, 
+0x94, 0x71, 0xAD, 0x21, 0xCC, 0x66, 0x59, 0x9B, 
+0x68, 0x89, 0xFB, 0x66, 0x9E, 0x1C, 0xC7, 0xAF, 
+0x0F, 0xC2, 0x23, 0xC0, 0x5B, 0xAC, 0xCD, 0xBE, 
+0x63, 0x88, 0x4B, 0xA5, 0xAC, 0x9A, 0xBD, 0x19, 
+0xC3, 0x96, 0xB1, 0x26, 0xC5, 0xD9, 0x32, 0xFA, 
+0x1C, 0x01, 0xB8, 0x60, 0x58, 0xBF, 0x7A, 0x22, 
+0xCB, 0x14, 0xE7, 0x99, 0x36, 0x29, 0x8A, 0xE9, 
+0x68, 0x2C, 0x44, 0x7A, 0x72, 0xA6, 0x65, 0x95, 
+0xED, 0x7D, 0x6A, 0x50, 0x29, 0x2F, 0x07, 0xD1, 
+0x67, 0x50, 0x57, 0x93, 0xE2, 0xD7, 0x02, 0x0E, 
+0x46, 0xD5, 0x20, 0x8B, 0xA5, 0x4E, 0x0D, 0x85, 
+0x4E, 0xBE, 0xB1, 0xBA, 0x47, 0x8E, 0xD0, 0xD7, 
+0x97, 0xEE, 0xC2, 0x95, 0x35, 0x58, 0xF9, 0xDA, 
+0xD1, 
+
+0x97, 0x11, 0x2A, 0x2E, 0x91, 0x5C, 0x16, 0x09, 
+0x8F, 0x20, 0x24, 0x41, 0xAC, 0x06, 0x76, 0xDB, 
+0x0E, 0x5E, 0x34, 0x4E, 0x9E, 0x73, 0xAE, 0xD5, 
+0xB6, 0x75, 0x6B, 0x20, 0x2F, 0x01, 0xBC, 0x3C, 
+0xF7, 0xFB, 0x74, 0x5A, 0xE4, 0x0F, 0x0D, 0xD9, 
+0x43, 0x14, 0x74, 0xF7, 0xAC, 0x52, 0xAA, 0x7A, 
+0x98, 0x6D, 0x86, 0x14, 0xDA, 0x3A, 0xB2, 0x98, 
+0x02, 0xC2, 0x8E, 0x19, 0x1C, 0xF1, 0x7A, 0x81, 
+0xD4, 0xAD, 0x59, 0xC6, 0xF1, 0xDB, 0x8C, 0x08, 
+0xE2, 0x73, 0xAF, 0x42, 0xBF, 0xB6, 0x87, 0xA2, 
+0x12, 0x7E, 0x04, 0x0F, 0xBF, 0x9B, 0x52, 0x41, 
+0x28, 0x68, 0x06, 0x86, 0x16, 0x26, 0x7E, 0x4D, 
+0xFA, 0x58, 0xFD, 0xB0, 0x43, 0x39, 0x6C, 0xCD, 
+0xD1, 0x1E, 0x81, 0x03, 0x05, 0xA3, 0xF8, 0x8A, 
+0x95, 0x11, 0x37, 0xDE, 0xCF, 0x74, 0x18, 0x3F, 
+0x35, 0xAE, 0xB9, 0x06, 0x31, 0x3F, 0xA6, 0xC2, 
+0xF9, 0x3B, 0x71, 0x3E, 0xC8, 0xFA, 0xBE, 0x9F, 
+0x02, 0x3E, 0xDA, 0x6A, 0xBC, 0xD9, 0x90, 0xB0, 
+0xBF, 0x2A, 
+
+0xD7, 0x3F, 0xF7, 0x64, 0x96, 0xF5, 0xFC, 0x10, 
+0xC9, 0x00, 0xE9, 0x4D, 0xA0, 0x64, 0x2D, 0x38, 
+0x0C, 0x57, 0xFE, 0x64, 0x0B, 0x6B, 0x2A, 0xDF, 
+0x35, 0xD8, 0x8D, 0x2C, 0x97, 0xF8, 0xE8, 0x0E, 
+0x3A, 0x54, 0xC5, 0x57, 0x8C, 0x77, 0xEF, 0x50, 
+0x9C, 0x51, 0x35, 0xDE, 0x20, 0x75, 0x0B, 0x79, 
+0x85, 0x52, 0x36, 0x7C, 0xA1, 0x9E, 0x9F, 0x87, 
+0xAF, 0xC3, 0xEE, 0x12, 0x0C, 0x3F, 0x1B, 0xE7, 
+0xC2, 0xE0, 0x44, 0x01, 0x4C, 0xC8, 0x38, 0xED, 
+0x93, 0xE4, 0x7A, 0x8E, 0x6B, 0x65, 0x7D, 0x52, 
+0xD7, 0x6D, 0x76, 0x25, 0xBB, 0x6C, 0x64, 0xB0, 
+0x9C, 0x11, 0xD4, 0x76, 0xEF, 0xD5, 0xD4, 0xEB, 
+0xE1, 0x87, 0xE2, 0xB3, 0xA0, 0xC9, 0xB9, 0xEC, 
+0xAD, 0x71, 0x01, 0x0E, 0x68, 0xF0, 0xB1, 0xCB, 
+0x49, 0xCF, 0xF2, 0x6D, 0x60, 0x4D, 0xD2, 0x2A, 
+0xD1, 0xC4, 0xA4, 0x39, 0x29, 0x12, 0x49, 0x36, 
+0xAE, 0xDB, 0x5D, 0x22, 0x63, 0x6C, 0xC9, 0xC3, 
+0x36, 0x3A, 0x2F, 0xC1, 0x6B, 0x17, 0x4B, 0x4A, 
+0x3E, 0xDF, 0x27, 
+
+0xDF, 0x54, 0xFD, 0x28, 0x32, 0x67, 0x02, 0x1B, 
+0x7A, 0x13, 0x29, 0x6F, 0x60, 0x7C, 0x79, 0x1A, 
+0xF1, 0xD9, 0x00, 0xE5, 0xC0, 0x48, 0x81, 0xFD, 
+0x26, 0x32, 0x4E, 0x43, 0xCE, 0xAC, 0xE1, 0xCE, 
+0x5A, 0xF8, 0xA9, 0xEA, 0x93, 0xE7, 0xDB, 0x50, 
+0x4A, 0xED, 0xA5, 0x31, 0x03, 0x1A, 0x73, 0x87, 
+0x7C, 0x30, 0xB3, 0x92, 0x0F, 0x13, 0xEF, 0xA1, 
+0xF5, 0xC8, 0x75, 0x9E, 0x3A, 0x59, 0xA4, 0x2D, 
+0x8D, 0x82, 0x9B, 0x17, 0xEF, 0xE5, 0x19, 0x72, 
+0x83, 0xE5, 0x1B, 0xBA, 0x37, 0xBE, 0x41, 0x81, 
+0x0F, 0xB3, 0x79, 0x86, 0x4E, 0xE9, 0xEC, 0x50, 
+0x22, 0x18, 0xDD, 0x71, 0x74, 0xF1, 0x6A, 0x37, 
+0x19, 0x36, 0x89, 0x6D, 0xF1, 0x82, 0x67, 0xF0, 
+0xB2, 0xCE, 0xA5, 0x6A, 0x29, 0x5E, 0x33, 0x70, 
+0x90, 0x14, 0xD1, 0x04, 0xF3, 0x0B, 0xC4, 0xD2, 
+0xAC, 0x03, 0x60, 0xA5, 0x04, 0x02, 0xC5, 0xD5, 
+0xD1, 0x9D, 0x20, 0xD4, 0xDC, 0xD7, 0x6A, 0x30, 
+0xE0, 0xC7, 0x19, 0xEF, 0x1C, 0x8D, 0x35, 0x56, 
+0x92, 0x0E, 0x93, 0x89, 
+
+0x50, 0x83, 0x5B, 0x3C, 0x82, 0xFF, 0xEC, 0x25, 
+0xCB, 0x10, 0x1E, 0xB9, 0x0C, 0xD7, 0x78, 0x54, 
+0xD0, 0x2A, 0x27, 0x90, 0xAC, 0x3F, 0x17, 0x99, 
+0x25, 0x7B, 0x3A, 0x6F, 0xCD, 0xC7, 0x43, 0x58, 
+0x6D, 0x80, 0xB2, 0xD5, 0xF8, 0x97, 0x73, 0x16, 
+0x44, 0x36, 0xB1, 0xE3, 0xD8, 0x97, 0x0C, 0x46, 
+0xFC, 0xEC, 0xBF, 0x52, 0x51, 0x8A, 0x75, 0xFC, 
+0xB1, 0xA9, 0x47, 0x48, 0x05, 0xAB, 0xE0, 0xFB, 
+0x43, 0x08, 0x96, 0xFC, 0xD5, 0xF3, 0xD9, 0x99, 
+0x3C, 0xE7, 0x9E, 0x6F, 0xB1, 0x5A, 0x47, 0x00, 
+0x9C, 0x6F, 0x67, 0x41, 0xDB, 0x94, 0xEF, 0xBA, 
+0x4F, 0x60, 0xAE, 0xCE, 0xF4, 0x62, 0x11, 0xFB, 
+0x0A, 0x80, 0x2C, 0xB6, 0xD0, 0xB2, 0x06, 0xF0, 
+0x54, 0xDB, 0x53, 0xF0, 0x6D, 0x47, 0x3C, 0xCF, 
+0x1A, 0x1F, 0x09, 0x6F, 0x44, 0xA6, 0xF3, 0x44, 
+0xF1, 0x45, 0x0D, 0xF9, 0x07, 0xA9, 0x96, 0xD8, 
+0xAE, 0xF0, 0x0A, 0xDC, 0xCB, 0x99, 0xB1, 0x16, 
+0x8D, 0x6C, 0x37, 0x44, 0xD2, 0x4E, 0xD4, 0xBD, 
+0x1B, 0xA9, 0x6E, 0x3C, 0x47, 
+
+0xF0, 0x03, 0x7C, 0xA0, 0x42, 0x93, 0x85, 0xF5, 
+0xB6, 0x2C, 0x0F, 0x83, 0xF1, 0x0C, 0x83, 0x36, 
+0x64, 0xBD, 0x49, 0x76, 0x5E, 0x8C, 0x0E, 0x82, 
+0xC5, 0x42, 0x40, 0x83, 0xFA, 0xE1, 0xF4, 0x90, 
+0x75, 0x59, 0xAB, 0x59, 0xEF, 0x54, 0x95, 0x96, 
+0x2F, 0x1B, 0x5B, 0x5D, 0xAF, 0xF5, 0xA0, 0xC2, 
+0x87, 0x8F, 0x01, 0xE9, 0xA5, 0xBD, 0x80, 0x4C, 
+0xF8, 0xD1, 0x35, 0x71, 0x21, 0x11, 0x02, 0x17, 
+0x6A, 0x42, 0x9B, 0x54, 0xE0, 0x72, 0x9E, 0xF8, 
+0xAF, 0xAD, 0xEA, 0x49, 0xB3, 0xFC, 0x75, 0xE1, 
+0xEA, 0xBF, 0x0A, 0x3C, 0x77, 0x92, 0xFA, 0x37, 
+0x01, 0xB2, 0xE2, 0xC5, 0x33, 0x2D, 0xA1, 0x88, 
+0xEF, 0x3C, 0x26, 0x7A, 0x04, 0x8F, 0xC9, 0xE9, 
+0xFF, 0xF4, 0x5D, 0x52, 0x9E, 0x33, 0xAF, 0x6A, 
+0xE6, 0x64, 0x62, 0x0C, 0x96, 0x71, 0x82, 0xB2, 
+0x20, 0x19, 0x0C, 0x6B, 0x6E, 0xF9, 0xF9, 0xBD, 
+0x33, 0xAB, 0xA6, 0xC5, 0x59, 0xF6, 0x08, 0x9B, 
+0x55, 0x5E, 0xE9, 0xF9, 0x6D, 0x3D, 0xC9, 0x97, 
+0xF1, 0xCC, 0x4A, 0x85, 0x19, 0xA1, 
+
+0xD8, 0x4E, 0xA4, 0xEE, 0xAD, 0x64, 0xF1, 0xB7, 
+0x9D, 0x36, 0xC7, 0xDE, 0x6D, 0xBF, 0xB8, 0x3A, 
+0xA3, 0xDC, 0x78, 0x43, 0x2E, 0x0E, 0x42, 0x82, 
+0xC1, 0x9A, 0x41, 0x04, 0x2E, 0xCE, 0x2F, 0xE3, 
+0xDC, 0xCF, 0xCD, 0x0D, 0xFA, 0x30, 0x4F, 0x48, 
+0xD3, 0xF9, 0x2C, 0x00, 0xC6, 0x5B, 0x3E, 0xB4, 
+0xD6, 0xF9, 0x61, 0x8B, 0xA4, 0x00, 0xCE, 0xCE, 
+0x43, 0xDF, 0x30, 0xCE, 0x58, 0x14, 0xED, 0x1D, 
+0xBC, 0x94, 0x12, 0x48, 0xF8, 0x9B, 0x06, 0x39, 
+0x55, 0xE1, 0x16, 0x0F, 0x4D, 0xBA, 0x33, 0xC7, 
+0x36, 0xAD, 0xB3, 0xA3, 0x5A, 0x3F, 0x88, 0xCA, 
+0xA1, 0x8F, 0x05, 0x96, 0xF2, 0x07, 0x96, 0x85, 
+0x5C, 0xBC, 0xFD, 0x74, 0x3C, 0x1B, 0xE7, 0xAF, 
+0xDA, 0xE2, 0xE8, 0xE9, 0xE1, 0x6F, 0x4B, 0x12, 
+0xAF, 0xA9, 0xEB, 0xF3, 0x66, 0x7C, 0x45, 0x3C, 
+0x42, 0xFD, 0x50, 0x29, 0xB2, 0x58, 0xDD, 0x51, 
+0x90, 0xD4, 0x17, 0x06, 0x02, 0xE7, 0x28, 0x97, 
+0x66, 0xDC, 0xD2, 0x02, 0xEE, 0xEA, 0xC6, 0xEE, 
+0xFD, 0x4E, 0x28, 0xFE, 0x7F, 0x6E, 0x08, 
+
+0x5B, 0x16, 0x64, 0xBE, 0xB3, 0x7E, 0x16, 0x7C, 
+0x28, 0x4D, 0x7D, 0x9B, 0xED, 0xE5, 0xE4, 0xC7, 
+0xA2, 0xDB, 0x87, 0xCA, 0x95, 0xB4, 0xB0, 0x46, 
+0x5B, 0x37, 0xE1, 0xD7, 0x4E, 0x08, 0xA4, 0xB0, 
+0x2B, 0xB7, 0x62, 0x1B, 0x0F, 0x3C, 0x88, 0xAB, 
+0x8D, 0xD2, 0x26, 0x46, 0xB1, 0x7E, 0xC3, 0xA8, 
+0xB3, 0xEE, 0x40, 0x41, 0xA4, 0xD6, 0x12, 0x12, 
+0x8E, 0xA1, 0x64, 0x1F, 0x9A, 0xBA, 0x00, 0xB5, 
+0xFC, 0xE1, 0x2B, 0x2D, 0x4D, 0x2A, 0xD7, 0xC6, 
+0xF1, 0x67, 0x97, 0xE5, 0x34, 0xB0, 0x4C, 0x3D, 
+0x63, 0x04, 0x01, 0x9B, 0x6B, 0xCD, 0x27, 0xE3, 
+0x3F, 0xAE, 0x93, 0xA6, 0x7F, 0x71, 0x62, 0x54, 
+0x06, 0x91, 0xA4, 0xCA, 0x46, 0x88, 0xFD, 0xCA, 
+0x4F, 0x4F, 0x70, 0x91, 0xDD, 0xD9, 0x23, 0xA9, 
+0xBB, 0xDC, 0x19, 0x52, 0x82, 0xC8, 0xFD, 0xB7, 
+0x6A, 0x9A, 0xAB, 0xAF, 0x37, 0x4E, 0xE3, 0xB7, 
+0x66, 0xE0, 0xE9, 0x7A, 0x75, 0x20, 0x09, 0x38, 
+0xFB, 0xA9, 0x16, 0xC6, 0x7E, 0x15, 0x9F, 0xE1, 
+0xA0, 0x87, 0x25, 0x06, 0xDD, 0x65, 0x5F, 0xCD, 
+
+0xBA, 0x73, 0x7C, 0x22, 0x6C, 0x20, 0x6D, 0x10, 
+0x54, 0x1D, 0xE2, 0xFE, 0x1E, 0x18, 0x03, 0x0C, 
+0x51, 0x18, 0x03, 0x55, 0xBF, 0xBC, 0x08, 0x72, 
+0x8B, 0x2B, 0x8A, 0x5E, 0xC2, 0x1C, 0x71, 0x5A, 
+0xB4, 0x7F, 0xA9, 0xBA, 0xD4, 0x44, 0xF3, 0x66, 
+0x69, 0x42, 0x02, 0xCE, 0xCB, 0xCD, 0x80, 0xEC, 
+0xD1, 0x72, 0x00, 0xFA, 0x85, 0xD9, 0xC2, 0x4D, 
+0x8B, 0xC8, 0x9F, 0x42, 0x8E, 0x2B, 0x6B, 0x0C, 
+0xD1, 0x82, 0xAA, 0x55, 0xC3, 0x38, 0x53, 0x51, 
+0x6C, 0x09, 0x84, 0x67, 0xFD, 0x84, 0x41, 0x5E, 
+0xCA, 0x6D, 0x50, 0xC2, 0x34, 0xF7, 0x18, 0xA8, 
+0xD0, 0xDC, 0x8A, 0x56, 0x2D, 0x69, 0x57, 0xA3, 
+0xFA, 0x5F, 0x4C, 0x9D, 0xEB, 0xF4, 0x53, 0x4F, 
+0xA0, 0x7B, 0x44, 0xA6, 0x08, 0x76, 0x8E, 0x84, 
+0x4E, 0x29, 0x4A, 0x4D, 0xB5, 0x0E, 0x9E, 0xAC, 
+0xC6, 0x8C, 0x96, 0x61, 0x4D, 0xD1, 0x4A, 0xE0, 
+0xB7, 0x36, 0xCF, 0xC9, 0xB1, 0xEC, 0x4D, 0x0D, 
+0x10, 0x50, 0x81, 0xA5, 0x3D, 0x4E, 0x02, 0x93, 
+0xC5, 0x24, 0xA8, 0x89, 0xF2, 0x7C, 0xC0, 0x59, 
+0x36, 
+
+0x77, 0x3C, 0xE6, 0x36, 0xDE, 0x5C, 0x32, 0xB6, 
+0xD1, 0x68, 0xD1, 0xA4, 0xC9, 0x11, 0x31, 0xBE, 
+0xDF, 0x7C, 0x34, 0x01, 0x11, 0x38, 0x25, 0x91, 
+0x8B, 0xA0, 0x92, 0x42, 0xD8, 0x85, 0xA8, 0x69, 
+0x54, 0xF3, 0xFF, 0x35, 0x96, 0x86, 0xC2, 0xEE, 
+0x04, 0xA4, 0x0A, 0x2C, 0x0A, 0x7D, 0xEF, 0x12, 
+0x21, 0xDC, 0xD3, 0x75, 0x0B, 0xF2, 0x0F, 0xCF, 
+0x5D, 0xF2, 0x9B, 0x1B, 0x10, 0x60, 0xB0, 0x12, 
+0x29, 0x3E, 0x1B, 0x9F, 0x71, 0x11, 0xFE, 0xB5, 
+0x16, 0xEB, 0x05, 0xBC, 0x76, 0x8B, 0x5D, 0x0A, 
+0x91, 0x52, 0xF0, 0x30, 0xC8, 0x60, 0x88, 0xB0, 
+0x25, 0x94, 0x42, 0x62, 0xB1, 0x72, 0x81, 0x87, 
+0x96, 0xBD, 0xB6, 0xDB, 0x73, 0x81, 0x7E, 0xED, 
+0x51, 0xD8, 0x2A, 0xBF, 0x93, 0xE4, 0xFF, 0xF9, 
+0x1B, 0x87, 0xC7, 0x4E, 0x96, 0xCF, 0xD6, 0x84, 
+0x0F, 0x09, 0x62, 0xD3, 0x45, 0x92, 0xE8, 0x86, 
+0xD2, 0xFA, 0x8C, 0x47, 0xD6, 0x56, 0x29, 0xA6, 
+0x0A, 0x55, 0x43, 0x11, 0x63, 0xFD, 0xC9, 0x05, 
+0x2D, 0x41, 0x10, 0x23, 0xB5, 0xF9, 0xD4, 0xA7, 
+0xC9, 0x73, 
+
+0x40, 0x90, 0x14, 0x55, 0x99, 0xEB, 0xEA, 0x79, 
+0xFB, 0xBA, 0x98, 0x0E, 0x05, 0x49, 0x45, 0x95, 
+0x9A, 0xB2, 0x8D, 0xF7, 0x26, 0x0C, 0x6E, 0x26, 
+0x50, 0x09, 0x3C, 0x89, 0xEC, 0x5D, 0x34, 0xA9, 
+0xD1, 0x99, 0xA1, 0xFA, 0x55, 0xE8, 0xEF, 0x02, 
+0x6B, 0x1E, 0xAA, 0x3A, 0x45, 0xC1, 0x0D, 0xD7, 
+0xD6, 0x7F, 0x6A, 0xF4, 0xF2, 0x13, 0xD6, 0xA9, 
+0x5E, 0x45, 0xF6, 0x9A, 0x62, 0x54, 0xF1, 0x30, 
+0x63, 0x3F, 0x0B, 0x5E, 0x9B, 0xF5, 0x4D, 0xD7, 
+0x4E, 0xCE, 0x45, 0xAC, 0xDB, 0x75, 0x0D, 0x34, 
+0x32, 0xA2, 0xEC, 0x54, 0xC2, 0x87, 0xCD, 0xAB, 
+0x2B, 0x9F, 0xE5, 0x3C, 0x10, 0x1A, 0xBA, 0x38, 
+0x02, 0xEC, 0x57, 0x73, 0xAF, 0x24, 0x0B, 0xEB, 
+0x74, 0x7C, 0xCE, 0x65, 0x4A, 0x68, 0x9C, 0x46, 
+0x9D, 0x49, 0x37, 0x67, 0x1F, 0x81, 0xD1, 0x33, 
+0xC6, 0xFB, 0x34, 0x48, 0x70, 0xDB, 0xBF, 0xE4, 
+0xCC, 0xEF, 0xF1, 0x9F, 0x8C, 0x82, 0x3E, 0xE7, 
+0x36, 0x2A, 0xAA, 0xD2, 0x21, 0x4D, 0x18, 0xD9, 
+0x6B, 0x92, 0x6D, 0x8F, 0x89, 0x43, 0xCF, 0x3F, 
+0x03, 0xF2, 0x28, 
+
+0x7B, 0x79, 0xBC, 0x0B, 0x04, 0xCD, 0x0E, 0xBF, 
+0x95, 0xB2, 0x03, 0xA4, 0x01, 0x64, 0xDD, 0xC8, 
+0xD4, 0x3E, 0x70, 0xD4, 0xE0, 0xA3, 0x6C, 0xFC, 
+0xC6, 0x52, 0x72, 0x69, 0x31, 0x55, 0x0C, 0x92, 
+0x35, 0x10, 0xCC, 0xFF, 0xAD, 0xC2, 0x80, 0x7A, 
+0xFE, 0x79, 0x93, 0x9E, 0xC2, 0x86, 0x9C, 0x60, 
+0x07, 0x04, 0x2A, 0x88, 0xCB, 0x16, 0x20, 0x8B, 
+0xE5, 0x71, 0x7C, 0x79, 0x73, 0x37, 0x33, 0x74, 
+0x24, 0xDE, 0x16, 0xAD, 0x75, 0x4C, 0x2B, 0x3C, 
+0x5A, 0xC0, 0x27, 0x81, 0x59, 0x82, 0x79, 0xD7, 
+0xC5, 0x06, 0x3E, 0x68, 0x69, 0x89, 0xA9, 0xE6, 
+0xCC, 0x47, 0x9C, 0x85, 0x6B, 0x36, 0x2A, 0xEE, 
+0x55, 0x01, 0xB1, 0x68, 0x21, 0x00, 0x85, 0x9A, 
+0x27, 0x1A, 0x5B, 0xDA, 0xCE, 0x23, 0x1F, 0xD5, 
+0xAC, 0x99, 0x68, 0x80, 0xE2, 0x4F, 0x31, 0xE1, 
+0xD9, 0xAF, 0xE1, 0x06, 0xE6, 0x4A, 0x98, 0x44, 
+0x6B, 0xAA, 0x97, 0xC9, 0x38, 0xD7, 0x80, 0x89, 
+0x3D, 0x96, 0x9F, 0x50, 0x5A, 0xE4, 0xFB, 0x55, 
+0x07, 0x07, 0x50, 0x31, 0xBD, 0x86, 0x6A, 0x33, 
+0x38, 0xB4, 0x21, 0x40, 
+
+0xB0, 0x7D, 0x74, 0x89, 0x25, 0xA1, 0xCB, 0x9B, 
+0xD7, 0x01, 0x28, 0xB2, 0x6A, 0xD2, 0x1F, 0x01, 
+0xC0, 0x8F, 0x85, 0x82, 0xE9, 0xED, 0xA4, 0x2B, 
+0xDF, 0xDF, 0xF9, 0x25, 0x77, 0xEE, 0x08, 0x47, 
+0x1A, 0x21, 0x4E, 0x5F, 0xB2, 0xD3, 0x27, 0xA2, 
+0x5A, 0xEE, 0x33, 0x94, 0x8B, 0x31, 0x9A, 0x97, 
+0x2F, 0x3C, 0xFD, 0x3A, 0xCC, 0xA2, 0x6C, 0x51, 
+0xFF, 0x78, 0x78, 0x87, 0x9C, 0x8F, 0x9F, 0xFD, 
+0x17, 0xB4, 0xA5, 0x92, 0x96, 0x9E, 0xBD, 0x68, 
+0x77, 0x1F, 0x25, 0xC5, 0x08, 0xF1, 0xE5, 0x47, 
+0x72, 0x72, 0x53, 0x58, 0x2D, 0x32, 0x9B, 0x8A, 
+0xEC, 0xE4, 0xE1, 0xC8, 0xFA, 0x1D, 0xCC, 0x9B, 
+0xC8, 0x3F, 0x37, 0xB7, 0xD5, 0x07, 0xC3, 0xF8, 
+0xC7, 0xE2, 0x40, 0x9A, 0xA0, 0xD1, 0x95, 0x14, 
+0x42, 0x04, 0xF7, 0x11, 0x0B, 0x8E, 0x78, 0x1D, 
+0x37, 0xDE, 0xED, 0x69, 0xCB, 0xC8, 0x49, 0x53, 
+0x64, 0x1A, 0x5D, 0x10, 0x6F, 0xF6, 0x7F, 0xB4, 
+0x31, 0xC0, 0x6C, 0xCF, 0x9F, 0xDE, 0xB0, 0x0F, 
+0x99, 0xAA, 0xA0, 0x02, 0x44, 0x11, 0x49, 0xB4, 
+0x97, 0x20, 0x12, 0x74, 0x84, 
+
+0x57, 0x92, 0x17, 0x30, 0xA8, 0x12, 0x3E, 0xA8, 
+0xF7, 0x6F, 0x95, 0x87, 0xD1, 0xA0, 0x78, 0x59, 
+0xCD, 0xEB, 0x47, 0x3B, 0xD3, 0xC6, 0xCD, 0xEC, 
+0x52, 0xDA, 0xE3, 0xB7, 0xBB, 0xBD, 0x61, 0xA7, 
+0x05, 0x7F, 0xB0, 0x4A, 0xF9, 0xCF, 0x4E, 0x6C, 
+0xAA, 0xDB, 0x48, 0xA1, 0x2E, 0xD1, 0x09, 0x24, 
+0xB8, 0xC7, 0xE2, 0x53, 0x73, 0xE5, 0x1C, 0x67, 
+0x6D, 0x6A, 0xD5, 0x87, 0x16, 0x50, 0xE9, 0xE9, 
+0x1A, 0xE4, 0xCD, 0xA2, 0x28, 0xE0, 0xF1, 0xD4, 
+0xFE, 0xCF, 0x33, 0x58, 0xAF, 0x34, 0x5F, 0x22, 
+0x3A, 0xA6, 0xE3, 0x8C, 0x1B, 0x62, 0x72, 0x82, 
+0xBA, 0x9B, 0xEE, 0x9D, 0x8B, 0x88, 0xEA, 0xC3, 
+0x1C, 0xFF, 0xE3, 0x78, 0x04, 0xCD, 0xD2, 0xC5, 
+0xC1, 0x2A, 0xCE, 0x60, 0xE1, 0x05, 0x73, 0xDE, 
+0x00, 0x2C, 0xE5, 0x79, 0x5C, 0x37, 0xD7, 0x3C, 
+0xF5, 0x75, 0xA7, 0x7B, 0x9F, 0xF0, 0x46, 0x59, 
+0x72, 0x08, 0x0A, 0x1E, 0x44, 0xC3, 0x9E, 0x0F, 
+0xB4, 0x07, 0x65, 0x04, 0xB9, 0x94, 0x26, 0x61, 
+0x22, 0x9B, 0x67, 0xE6, 0xF4, 0xCB, 0x2B, 0xD4, 
+0x53, 0xA6, 0xA7, 0xC7, 0x41, 0xFB, 
+
+0x20, 0xF5, 0xEC, 0xEA, 0x87, 0xDE, 0x95, 0x2D, 
+0x02, 0x52, 0x63, 0x6B, 0xB5, 0xDE, 0x4C, 0xC7, 
+0x66, 0xB2, 0xE3, 0x78, 0xD3, 0x22, 0xB1, 0x69, 
+0x4E, 0xA8, 0x79, 0x93, 0xCF, 0xD1, 0x23, 0x4D, 
+0x71, 0x24, 0x2B, 0x08, 0x56, 0x30, 0xD3, 0xC3, 
+0x6E, 0xDB, 0xDC, 0xD4, 0x28, 0xD1, 0x70, 0xB9, 
+0xE5, 0x8E, 0x6C, 0xDD, 0xF3, 0x1C, 0x79, 0xA9, 
+0x77, 0xEF, 0x65, 0x42, 0xEE, 0xCF, 0xB2, 0xC9, 
+0x0B, 0x10, 0xF5, 0x65, 0x36, 0x5B, 0x71, 0xF5, 
+0x1C, 0xD1, 0x42, 0xD7, 0x28, 0xDE, 0x47, 0x10, 
+0x71, 0x9A, 0x31, 0xB2, 0xAA, 0x29, 0xBF, 0xB1, 
+0x1E, 0x7A, 0x70, 0x14, 0x35, 0x10, 0xC3, 0x94, 
+0x55, 0x8A, 0xE9, 0x8A, 0xEF, 0x02, 0x81, 0x27, 
+0xAB, 0x4D, 0x66, 0xE7, 0x7F, 0x48, 0x9F, 0xEF, 
+0xE6, 0x18, 0xBD, 0xC6, 0xCF, 0x01, 0x78, 0xC1, 
+0xF5, 0xDC, 0xAE, 0x61, 0xED, 0xA1, 0xC3, 0x2F, 
+0xD5, 0xCE, 0x9C, 0xA4, 0xE6, 0xFA, 0x6E, 0x7A, 
+0x2B, 0x86, 0xA4, 0x9B, 0x63, 0x59, 0xDA, 0x86, 
+0x4A, 0x39, 0x65, 0xCF, 0xA7, 0xAD, 0x10, 0xA6, 
+0xA6, 0x8A, 0x60, 0x4A, 0xC5, 0x44, 0x56, 
+
+0xC8, 0x32, 0x51, 0x67, 0x1C, 0xA9, 0x6D, 0xE5, 
+0x7E, 0xE2, 0x16, 0x3F, 0x01, 0x1A, 0x25, 0xC5, 
+0x8D, 0x15, 0xC1, 0xCE, 0x1F, 0x8E, 0x12, 0xB6, 
+0x75, 0x07, 0x8E, 0xBD, 0x7D, 0xB2, 0xC8, 0x15, 
+0x0C, 0x9E, 0x81, 0xE8, 0xD4, 0xC0, 0x3E, 0x86, 
+0xBA, 0xE5, 0x21, 0x6F, 0xAA, 0xF1, 0xC9, 0x52, 
+0xC6, 0xDC, 0x95, 0x53, 0x9E, 0x0E, 0xD6, 0xC4, 
+0xF4, 0x38, 0xEE, 0x72, 0x92, 0x02, 0x83, 0xB3, 
+0xA1, 0xCA, 0x95, 0x64, 0xF7, 0xA4, 0x54, 0xB2, 
+0xCC, 0x5D, 0x16, 0xFA, 0x49, 0x38, 0x49, 0xF0, 
+0x3A, 0x17, 0x50, 0x23, 0x81, 0xAF, 0x5B, 0x7B, 
+0x60, 0x03, 0xAA, 0x79, 0xF6, 0xB2, 0x10, 0x53, 
+0x11, 0x83, 0x19, 0xEC, 0xA6, 0x43, 0xFF, 0xEF, 
+0x04, 0x96, 0x40, 0x57, 0x1A, 0xE6, 0xFE, 0xE2, 
+0xAD, 0xCC, 0x1B, 0xE5, 0xD5, 0x98, 0xE3, 0x35, 
+0x82, 0xCB, 0x08, 0x1A, 0xAC, 0xB1, 0x26, 0xEF, 
+0x55, 0x9A, 0xD4, 0xE0, 0x8C, 0x35, 0xA5, 0xD5, 
+0xBE, 0x63, 0xE1, 0x05, 0x3E, 0xA3, 0x18, 0x1A, 
+0x0E, 0x68, 0x29, 0x1B, 0x1D, 0x74, 0x4C, 0x22, 
+0x14, 0x89, 0xF2, 0x7F, 0xC0, 0x16, 0xB7, 0x02, 
+
+0x85, 0xEE, 0xA1, 0x23, 0x30, 0xC5, 0x97, 0x8B, 
+0xF9, 0xA0, 0xCE, 0x17, 0x4C, 0x62, 0x84, 0x98, 
+0x78, 0x41, 0x92, 0xAF, 0x3C, 0x7E, 0x10, 0x53, 
+0x42, 0xEB, 0x95, 0xEA, 0xB6, 0x48, 0x39, 0xA5, 
+0xAD, 0xD7, 0x65, 0xF2, 0x31, 0xED, 0xFE, 0xBA, 
+0x71, 0x56, 0x9E, 0x3D, 0xEB, 0x86, 0x3F, 0x55, 
+0xAB, 0xF3, 0x26, 0x52, 0x1F, 0x6A, 0x56, 0xEB, 
+0x91, 0xAC, 0xD8, 0x51, 0x16, 0x53, 0xD1, 0x5D, 
+0x99, 0x45, 0x5A, 0xBE, 0x66, 0x0B, 0x20, 0xA5, 
+0xDE, 0x1E, 0x78, 0x90, 0x86, 0xE1, 0x23, 0xB3, 
+0x44, 0x95, 0x7A, 0x44, 0x8A, 0x06, 0xAB, 0x4D, 
+0xA3, 0xE1, 0x07, 0xA2, 0x1E, 0x85, 0x70, 0x96, 
+0x5C, 0x5B, 0xA9, 0x77, 0x57, 0xE6, 0xBA, 0xBB, 
+0xE3, 0x6C, 0xA9, 0x2E, 0xFC, 0xC4, 0x98, 0xA1, 
+0xB8, 0x29, 0xCF, 0xF0, 0x0B, 0xB5, 0x94, 0xEF, 
+0xC3, 0x08, 0x67, 0xE4, 0xA4, 0xEE, 0x2B, 0xB8, 
+0xB0, 0x02, 0x2F, 0x46, 0xBD, 0x16, 0x57, 0x86, 
+0xE8, 0x05, 0x8F, 0xB0, 0x48, 0xBB, 0x21, 0xC5, 
+0x4B, 0x04, 0x7F, 0xC7, 0x8D, 0x79, 0x86, 0xE7, 
+0xD1, 0x80, 0x72, 0x5C, 0xEA, 0x4A, 0xA9, 0x88, 
+0xE0, 
+
+0x4E, 0xFC, 0x71, 0x1F, 0xF9, 0x5A, 0xD6, 0xCB, 
+0x42, 0x16, 0x60, 0x78, 0xFD, 0xB8, 0x20, 0xC0, 
+0xE8, 0x89, 0xFD, 0x05, 0x21, 0x48, 0xD0, 0x6E, 
+0x82, 0xDE, 0xAF, 0x56, 0x34, 0xB2, 0xF3, 0xC2, 
+0x68, 0x36, 0x01, 0xF0, 0x03, 0x86, 0x50, 0x45, 
+0xCA, 0x74, 0xC9, 0x53, 0x8C, 0x4F, 0xE6, 0xAC, 
+0xD2, 0x05, 0x8B, 0x3F, 0x34, 0x22, 0x17, 0xB7, 
+0xF9, 0x0E, 0x6B, 0xED, 0xC6, 0xDE, 0x26, 0x70, 
+0xDA, 0x56, 0x84, 0x34, 0x18, 0x54, 0x70, 0x18, 
+0x43, 0x4B, 0x83, 0x90, 0xF1, 0xDF, 0x7F, 0x2A, 
+0xE9, 0x8D, 0xC1, 0x11, 0x6A, 0xA1, 0xE6, 0xEF, 
+0xF3, 0x93, 0x7F, 0x02, 0xFC, 0x71, 0x61, 0x45, 
+0xC7, 0xFC, 0xDE, 0x1E, 0x47, 0x8C, 0x85, 0x1D, 
+0xE7, 0x9C, 0x85, 0x8C, 0x55, 0x83, 0x0E, 0x7B, 
+0x24, 0xF4, 0x32, 0x50, 0x82, 0x8F, 0xDF, 0xE7, 
+0x40, 0x57, 0x25, 0xE7, 0xB5, 0xE2, 0xB0, 0x89, 
+0x18, 0x87, 0x10, 0x17, 0xC6, 0xC4, 0x4D, 0x36, 
+0xBC, 0xFE, 0xE7, 0xDF, 0x01, 0x4B, 0xDF, 0x1E, 
+0xE9, 0x96, 0x14, 0x40, 0xAF, 0xAA, 0x02, 0xDF, 
+0x40, 0x48, 0x50, 0x73, 0xB2, 0xAF, 0x1F, 0x16, 
+0x84, 0x75, 
+
+0x47, 0x6E, 0x40, 0x73, 0xD3, 0xB9, 0x28, 0xA9, 
+0xFA, 0x3B, 0xAD, 0x60, 0x69, 0xFA, 0x06, 0xCB, 
+0xC0, 0x67, 0x06, 0xD8, 0xF3, 0xAA, 0x17, 0x4B, 
+0x30, 0x05, 0xBA, 0x00, 0x56, 0xBE, 0x1E, 0xD2, 
+0xBB, 0xE1, 0x07, 0xD1, 0x74, 0xD5, 0x41, 0x62, 
+0x9C, 0x24, 0x16, 0x5F, 0x1F, 0x0D, 0xAE, 0xDD, 
+0x18, 0x97, 0xC0, 0xA4, 0xFD, 0xAE, 0x6F, 0x55, 
+0x20, 0xEB, 0x2D, 0xB4, 0x62, 0x9A, 0xF3, 0x33, 
+0x52, 0x84, 0xA0, 0xDB, 0x13, 0x8D, 0xD2, 0x3E, 
+0x6F, 0x31, 0xC4, 0xB0, 0xF1, 0x85, 0x9A, 0x55, 
+0xE3, 0x46, 0x02, 0xD3, 0xFF, 0x5F, 0x8E, 0xBC, 
+0xC3, 0xCA, 0xA8, 0xDE, 0xCC, 0x05, 0xF3, 0x0A, 
+0xB5, 0x1E, 0x18, 0x41, 0xD2, 0xE2, 0x78, 0x88, 
+0xFA, 0x19, 0x92, 0xE2, 0xED, 0x15, 0xE2, 0xE7, 
+0x8B, 0x9B, 0x3D, 0x11, 0xF3, 0xDE, 0xEA, 0xE4, 
+0x1A, 0xEA, 0xA5, 0x5D, 0x82, 0x86, 0x6E, 0xE7, 
+0xBF, 0x90, 0x73, 0x86, 0x22, 0xEE, 0xC9, 0xCA, 
+0x29, 0xAC, 0xAB, 0xB1, 0x97, 0xA9, 0xAE, 0xF4, 
+0x3F, 0xA7, 0xC9, 0x9E, 0xA4, 0xF7, 0xD0, 0x67, 
+0x55, 0xA9, 0x5D, 0x14, 0xB4, 0x6A, 0x4E, 0x34, 
+0xD5, 0x71, 0x8A, 
+
+0xE6, 0x39, 0x43, 0x85, 0x2C, 0xD3, 0xA3, 0xBA, 
+0xC6, 0x00, 0x7C, 0xC4, 0x88, 0x6D, 0x00, 0x71, 
+0x7E, 0x44, 0xF7, 0x0E, 0x4A, 0x5D, 0x3B, 0xAC, 
+0xA1, 0x34, 0x10, 0x6E, 0xD1, 0x45, 0x29, 0x90, 
+0xE7, 0xFF, 0x52, 0x6A, 0x44, 0xFC, 0x80, 0x3B, 
+0xEE, 0xFF, 0x44, 0x19, 0x02, 0x63, 0xE6, 0xF0, 
+0xA6, 0xFB, 0x2C, 0xAB, 0xF2, 0xF7, 0x32, 0x0C, 
+0xEE, 0xD4, 0xCC, 0x2F, 0x32, 0xF2, 0x02, 0x6E, 
+0xB7, 0x32, 0xD2, 0x0D, 0xA6, 0xCB, 0x80, 0xC1, 
+0xF6, 0xDB, 0x03, 0x09, 0x1B, 0x5E, 0x2C, 0x9C, 
+0x5E, 0x7B, 0xD9, 0x85, 0x47, 0x45, 0x93, 0xB6, 
+0xD2, 0x84, 0x50, 0x57, 0x52, 0x92, 0x33, 0x2C, 
+0xD8, 0x3E, 0x25, 0xA8, 0x9C, 0x43, 0x31, 0xB8, 
+0x3C, 0x1B, 0xE8, 0x9E, 0xD1, 0x85, 0x27, 0xEE, 
+0xBA, 0x4D, 0x2E, 0x3B, 0x72, 0x03, 0x90, 0x47, 
+0xDA, 0x66, 0x56, 0xFE, 0xC5, 0x2E, 0xB4, 0x91, 
+0xD9, 0xF5, 0xA3, 0xFE, 0x50, 0x7E, 0x01, 0x5E, 
+0x5D, 0x52, 0x18, 0xE1, 0x1C, 0x36, 0x1B, 0x98, 
+0x3E, 0x10, 0x95, 0x29, 0xAF, 0x2D, 0x8F, 0xCE, 
+0x3C, 0xD5, 0xF9, 0x02, 0xE9, 0x4D, 0x7B, 0xF6, 
+0x09, 0xF9, 0x17, 0xBE, 
+
+0xB3, 0xB5, 0xC5, 0xB0, 0x16, 0x6E, 0x34, 0xAE, 
+0xD8, 0x5A, 0x1A, 0x48, 0x15, 0xEF, 0x42, 0xDE, 
+0x74, 0xC7, 0xEB, 0xB2, 0xB7, 0x7D, 0xE3, 0x46, 
+0x14, 0x50, 0x75, 0x03, 0xA3, 0x50, 0x6F, 0xBF, 
+0xAB, 0x94, 0xC0, 0xB4, 0x7F, 0x8C, 0x8D, 0x4E, 
+0x8C, 0xC8, 0xF0, 0x9E, 0xA0, 0xAE, 0x06, 0xAA, 
+0x37, 0x65, 0x10, 0x62, 0xE7, 0xC7, 0xC7, 0xD8, 
+0x2B, 0x13, 0x4A, 0xDF, 0x7D, 0xC3, 0xE1, 0x81, 
+0x7F, 0xA4, 0x78, 0x21, 0x00, 0xCE, 0x7B, 0x18, 
+0x4E, 0xEA, 0x9A, 0x62, 0x20, 0xB4, 0x83, 0xBD, 
+0x81, 0x6E, 0xF0, 0x00, 0xF8, 0xDE, 0x19, 0x1E, 
+0x30, 0xB7, 0x81, 0xB9, 0xF0, 0x92, 0xF6, 0xB2, 
+0xD3, 0xB6, 0x69, 0xE7, 0xD0, 0x54, 0xA7, 0x72, 
+0x83, 0x4A, 0x98, 0x02, 0xCD, 0x92, 0xA8, 0x90, 
+0x76, 0x12, 0x68, 0x04, 0x73, 0xE5, 0xF5, 0x26, 
+0xA6, 0xE5, 0xE9, 0x53, 0x87, 0xD3, 0x6A, 0x77, 
+0xCC, 0x15, 0x16, 0x04, 0x05, 0x38, 0x7C, 0x5E, 
+0x5E, 0xDC, 0x8B, 0x3F, 0x69, 0x0C, 0xB6, 0xF7, 
+0x5F, 0x1D, 0xF5, 0xBC, 0xAA, 0x93, 0x2C, 0xE5, 
+0x58, 0xBC, 0x6A, 0x2E, 0x3E, 0xF4, 0x8F, 0x33, 
+0xA1, 0x84, 0xCC, 0x32, 0xBE, 
+
+0xF6, 0xB8, 0xA7, 0xDC, 0x51, 0x3D, 0x1B, 0x03, 
+0x81, 0x59, 0xA5, 0xE1, 0x2C, 0x15, 0xDD, 0x3F, 
+0xAA, 0xCB, 0x1B, 0x8E, 0xFB, 0x2B, 0x90, 0x03, 
+0xF8, 0xAE, 0x22, 0xA8, 0x6E, 0xB0, 0x8C, 0xB4, 
+0x14, 0x9A, 0xEB, 0x71, 0x74, 0xC1, 0x4B, 0x03, 
+0x04, 0xAC, 0xC9, 0xDE, 0xBA, 0xC5, 0x9F, 0x65, 
+0x41, 0x22, 0xD3, 0x07, 0x05, 0x36, 0x19, 0xB3, 
+0x23, 0x46, 0xFE, 0x32, 0x60, 0x4A, 0x0D, 0x4A, 
+0x10, 0xB6, 0x2D, 0x67, 0x45, 0xA0, 0xC6, 0x92, 
+0xDF, 0xDA, 0x67, 0x51, 0xE8, 0xC2, 0xAA, 0x09, 
+0xB5, 0x3B, 0x41, 0x97, 0x0B, 0x2D, 0x0D, 0x58, 
+0x1F, 0x53, 0xE4, 0x38, 0xC2, 0x70, 0x12, 0x3A, 
+0xDA, 0xF2, 0x94, 0xC3, 0xF9, 0x41, 0x91, 0x60, 
+0xE2, 0x45, 0x96, 0x9B, 0x80, 0x02, 0xF0, 0x12, 
+0x11, 0x9B, 0x5F, 0x0D, 0x77, 0x94, 0xD7, 0x47, 
+0x26, 0x90, 0x25, 0x72, 0x68, 0x52, 0x44, 0x19, 
+0x36, 0xA4, 0xBB, 0x49, 0x69, 0x49, 0xA2, 0x4A, 
+0x27, 0x9B, 0x82, 0x54, 0x5E, 0xBF, 0x4F, 0x93, 
+0xC1, 0x2A, 0xBD, 0xBA, 0x88, 0xAD, 0x88, 0x4A, 
+0xC8, 0xB5, 0xA1, 0x3F, 0xEC, 0xF0, 0x46, 0x50, 
+0xB7, 0xC3, 0x18, 0xC7, 0xD1, 0xB9, 
+
+0xD1, 0xE2, 0x09, 0x93, 0x77, 0x88, 0x6A, 0x67, 
+0xAD, 0x4B, 0x0E, 0x5C, 0xD1, 0xE2, 0xAE, 0xCA, 
+0x35, 0x5C, 0x4D, 0x33, 0xE9, 0xF2, 0xC1, 0x77, 
+0xDE, 0xA2, 0x5B, 0x2C, 0x7C, 0xC2, 0x18, 0xC7, 
+0xAF, 0xE5, 0xED, 0xB2, 0x6E, 0x63, 0x56, 0x8F, 
+0x1A, 0x20, 0xDB, 0xB8, 0x92, 0x16, 0xC4, 0x88, 
+0x85, 0xC3, 0xAC, 0x53, 0x99, 0xCF, 0xF9, 0x73, 
+0x24, 0x60, 0xBE, 0xE2, 0x21, 0xFC, 0x70, 0x8E, 
+0x91, 0xFF, 0x56, 0x5A, 0x3A, 0xDA, 0x1A, 0x6F, 
+0x5C, 0xEF, 0x42, 0x3F, 0xDE, 0xC3, 0xF2, 0x7B, 
+0xD4, 0x4E, 0x62, 0x30, 0x72, 0xFA, 0x7F, 0xF4, 
+0x87, 0x41, 0x16, 0x9E, 0x69, 0x21, 0x50, 0x2C, 
+0xBE, 0x2D, 0x51, 0x32, 0xC6, 0x2E, 0x7D, 0x7B, 
+0x94, 0x03, 0x0D, 0x6F, 0xFD, 0xC4, 0x6E, 0x6F, 
+0xA3, 0xC3, 0x07, 0xCE, 0xC4, 0x09, 0x9C, 0xE4, 
+0x98, 0x71, 0x49, 0x0C, 0x73, 0x15, 0xB4, 0xD5, 
+0xA6, 0x5C, 0xEB, 0x35, 0x45, 0x5F, 0x09, 0xA9, 
+0x9C, 0x30, 0x6E, 0xA8, 0xE8, 0x13, 0xD2, 0x7E, 
+0x90, 0x37, 0xEF, 0xA5, 0xD1, 0xF9, 0xC8, 0x85, 
+0x3B, 0xC1, 0x4C, 0x3A, 0x67, 0x3A, 0x38, 0x91, 
+0x51, 0xF0, 0x05, 0xDF, 0xFD, 0x3B, 0xD5, 
+
+0x7E, 0x30, 0xF7, 0x7B, 0x0B, 0xD2, 0x76, 0xB5, 
+0xBC, 0x62, 0xAD, 0x54, 0xF3, 0xAE, 0xA0, 0x8A, 
+0xA5, 0x64, 0x54, 0xEE, 0x3F, 0x12, 0x81, 0x43, 
+0xEA, 0xD7, 0xCF, 0x1A, 0x91, 0x4D, 0x2E, 0x4D, 
+0x93, 0x29, 0x64, 0xDC, 0x4C, 0x07, 0x82, 0xFF, 
+0xB2, 0x7A, 0xF0, 0x55, 0xBC, 0xCE, 0x37, 0x24, 
+0x87, 0x46, 0x20, 0xC7, 0xB8, 0x3A, 0x58, 0x08, 
+0x2B, 0x8B, 0xC8, 0xF4, 0x36, 0xDA, 0xE6, 0xB6, 
+0x8F, 0x81, 0x2D, 0xB3, 0xC8, 0x38, 0x79, 0x34, 
+0x4F, 0xAD, 0x47, 0x21, 0xF9, 0x8B, 0xBF, 0xBB, 
+0xD6, 0x8E, 0x5A, 0x1C, 0x07, 0xC8, 0xBB, 0xB9, 
+0x64, 0x0A, 0x95, 0x2D, 0xAD, 0x5B, 0xB9, 0xF7, 
+0xD3, 0x21, 0x14, 0x43, 0x28, 0xEE, 0x02, 0xED, 
+0x4A, 0xF3, 0x1F, 0x60, 0x6F, 0xBF, 0x23, 0xAD, 
+0xFE, 0x26, 0x4B, 0xA2, 0x7A, 0x87, 0x35, 0x39, 
+0x22, 0x09, 0x1F, 0x2F, 0xD8, 0x79, 0xC8, 0x9E, 
+0x7E, 0xA4, 0xD9, 0x6F, 0x0B, 0x56, 0x43, 0x23, 
+0x98, 0x4C, 0xAD, 0x06, 0x2C, 0xDA, 0x22, 0xDC, 
+0xA1, 0x95, 0xCA, 0xB4, 0xA2, 0x07, 0xE6, 0xD5, 
+0x01, 0x7B, 0xE8, 0xA6, 0x46, 0x06, 0x81, 0xC3, 
+0x08, 0x62, 0x17, 0x27, 0x70, 0xDC, 0x12, 0x8E, 
+
+0x47, 0x8C, 0xAC, 0xAD, 0xBF, 0xF7, 0x04, 0x07, 
+0xC1, 0x6E, 0x4E, 0x36, 0xBD, 0xB1, 0x76, 0x1A, 
+0xCC, 0x5E, 0xB7, 0x03, 0x7B, 0x36, 0x33, 0x29, 
+0x15, 0x2C, 0xAF, 0x28, 0x0F, 0x23, 0xB0, 0x50, 
+0x6F, 0x07, 0x15, 0x54, 0xD1, 0xD7, 0x28, 0x4C, 
+0xEC, 0x9D, 0x59, 0x2F, 0xFC, 0x07, 0x08, 0xE4, 
+0x1D, 0x06, 0x4F, 0x48, 0xD9, 0xDC, 0x36, 0x06, 
+0xD3, 0x78, 0x48, 0x99, 0x8C, 0x33, 0x74, 0x93, 
+0x15, 0xEE, 0xE9, 0xFD, 0xF1, 0x06, 0x30, 0xAD, 
+0xE6, 0xB6, 0x78, 0x0C, 0x69, 0xEF, 0x6C, 0xC9, 
+0xD3, 0x6C, 0xB6, 0xC5, 0x12, 0xCA, 0xEB, 0x61, 
+0xD9, 0x58, 0x50, 0x18, 0x83, 0xD5, 0x5D, 0x42, 
+0xE0, 0x6E, 0xA0, 0xB7, 0x03, 0x1A, 0x8D, 0xDE, 
+0x30, 0xDA, 0xCC, 0xA7, 0x19, 0x51, 0xCD, 0xF9, 
+0x0B, 0x15, 0x6A, 0xB0, 0x03, 0xEF, 0xEE, 0x70, 
+0x42, 0x2F, 0x05, 0x8D, 0x30, 0xBC, 0xBB, 0xDD, 
+0xE2, 0xC0, 0x63, 0x94, 0x44, 0x10, 0x84, 0x2F, 
+0x2B, 0xD3, 0xFF, 0xE3, 0x74, 0x9B, 0x5E, 0x0D, 
+0xDD, 0xB4, 0xB8, 0xE6, 0xAD, 0xBE, 0xE5, 0x2C, 
+0xBC, 0x3F, 0x75, 0xC7, 0xDF, 0xA7, 0xDD, 0x8D, 
+0x6D, 0x83, 0x61, 0x10, 0xB0, 0x74, 0x63, 0xAF, 
+0x63, 
+
+0xD9, 0x8E, 0x8C, 0x7D, 0x9D, 0x46, 0xA0, 0x22, 
+0x7F, 0x93, 0x95, 0x36, 0x53, 0x5A, 0xAF, 0x98, 
+0x65, 0x8B, 0xCA, 0xC8, 0x59, 0x9C, 0x03, 0xC1, 
+0x6E, 0x71, 0xEE, 0x93, 0xDB, 0x18, 0xDD, 0x40, 
+0x67, 0xA4, 0xA0, 0x20, 0x8A, 0x75, 0x64, 0x7A, 
+0x86, 0x91, 0xD3, 0xF7, 0x74, 0x3B, 0x97, 0x9C, 
+0x2A, 0x9E, 0x4C, 0xB8, 0x55, 0xD5, 0xC6, 0x28, 
+0xEF, 0xE5, 0xB3, 0x91, 0xAA, 0xBB, 0x3B, 0x15, 
+0xBA, 0x29, 0x01, 0x2B, 0x41, 0xE0, 0xF4, 0x04, 
+0xE0, 0x28, 0xC2, 0xAC, 0x02, 0xF0, 0x4D, 0xBC, 
+0x3E, 0x43, 0x59, 0x10, 0xF0, 0x1A, 0xC0, 0x38, 
+0x95, 0xAE, 0x50, 0x48, 0x04, 0xB6, 0xEA, 0xDF, 
+0x76, 0x5C, 0x0F, 0xFF, 0xC8, 0x53, 0xB8, 0x4E, 
+0x90, 0xB0, 0xD9, 0x53, 0xAF, 0x0B, 0x2F, 0x66, 
+0x19, 0x79, 0x22, 0x37, 0x06, 0x32, 0xAF, 0xF8, 
+0x8D, 0x3F, 0x26, 0x84, 0x1C, 0x25, 0xE2, 0x0D, 
+0xD7, 0x87, 0x2F, 0xF6, 0xF3, 0x98, 0x5D, 0xDF, 
+0x6E, 0x27, 0x1B, 0xD3, 0xD8, 0x78, 0x59, 0x86, 
+0x65, 0x07, 0x95, 0x1D, 0x98, 0x35, 0x5E, 0xE3, 
+0x77, 0x19, 0x4C, 0xFF, 0x4B, 0x2E, 0xBA, 0x5D, 
+0x4B, 0x43, 0xFB, 0xB8, 0x72, 0x7F, 0x4E, 0x2C, 
+0x13, 0x95, 
+
+0xBE, 0x4F, 0x12, 0x3E, 0x63, 0xDE, 0x9C, 0xA7, 
+0x0B, 0x81, 0xE7, 0x83, 0x4A, 0x69, 0x1A, 0xAD, 
+0xCE, 0x6A, 0x3B, 0x37, 0x6C, 0xC7, 0x4C, 0x7E, 
+0x54, 0x86, 0x64, 0x5A, 0x3A, 0x2F, 0xAC, 0x01, 
+0xFE, 0xB8, 0xF2, 0x74, 0xA4, 0xA1, 0x98, 0xF0, 
+0x58, 0x67, 0x46, 0xCB, 0x70, 0x9B, 0xD4, 0x5E, 
+0xCB, 0xA5, 0x50, 0x85, 0xB3, 0x5D, 0xE7, 0x4E, 
+0xB9, 0xDD, 0x9C, 0xB7, 0xE2, 0x92, 0xB8, 0x07, 
+0x4D, 0x79, 0xD4, 0x7B, 0x88, 0x7F, 0xE9, 0x92, 
+0x15, 0xAA, 0x9D, 0xF2, 0x97, 0x7F, 0x14, 0x29, 
+0x9E, 0x37, 0x12, 0x51, 0x06, 0x58, 0x10, 0x1D, 
+0x77, 0x23, 0x39, 0x36, 0x46, 0xEE, 0x47, 0x1F, 
+0x84, 0x1F, 0xCF, 0x7B, 0x82, 0xCD, 0x61, 0x50, 
+0x2E, 0x9A, 0x1D, 0xD0, 0xB9, 0x69, 0xD7, 0xA8, 
+0xCA, 0x87, 0x61, 0x13, 0xFA, 0xFB, 0xAF, 0xC0, 
+0xEF, 0xCA, 0xE9, 0xDA, 0x04, 0xE5, 0x43, 0xB8, 
+0xAF, 0xB4, 0xF8, 0x0B, 0x64, 0xC3, 0x81, 0xAA, 
+0x33, 0x09, 0x76, 0xA4, 0xF0, 0xA3, 0xA7, 0x6D, 
+0xBD, 0x31, 0x81, 0x8C, 0xB7, 0x64, 0x2D, 0xC8, 
+0x8D, 0xB8, 0xEC, 0x57, 0xCB, 0x5F, 0x0C, 0xD0, 
+0x97, 0x82, 0x85, 0x7E, 0x2D, 0xF2, 0x3C, 0x24, 
+0xED, 0x10, 0x54, 
+
+0x4E, 0x6A, 0xAC, 0x19, 0xAC, 0x5E, 0xC1, 0xE4, 
+0x63, 0x96, 0x56, 0xFE, 0xD7, 0x58, 0x8A, 0xAD, 
+0x3B, 0x50, 0xC9, 0x62, 0xB3, 0xDC, 0x72, 0x73, 
+0xFE, 0x77, 0xC6, 0x7C, 0x79, 0x3E, 0xAF, 0x98, 
+0xBD, 0xAA, 0x95, 0x21, 0xC3, 0x23, 0x94, 0x0B, 
+0xAC, 0xD5, 0x11, 0xE2, 0x8C, 0x0A, 0xE8, 0x2C, 
+0x1C, 0x75, 0xCD, 0x21, 0x85, 0x31, 0xCD, 0xEB, 
+0xD9, 0xB6, 0xDF, 0xB7, 0x45, 0xAD, 0xFC, 0x2A, 
+0x9D, 0x40, 0x26, 0x73, 0xE7, 0x3C, 0x65, 0xE0, 
+0x59, 0xD8, 0xAE, 0xA3, 0x01, 0x6F, 0x64, 0xF8, 
+0x4F, 0xDB, 0x19, 0xBE, 0x26, 0xA9, 0xE0, 0x18, 
+0x60, 0xF7, 0xB6, 0x2D, 0x14, 0x02, 0xC9, 0x9B, 
+0xA9, 0x9E, 0x0C, 0x05, 0xE1, 0x16, 0xDE, 0x5A, 
+0x1C, 0x0C, 0x9F, 0x9F, 0xA2, 0x1E, 0x11, 0x8E, 
+0xC9, 0x6E, 0x66, 0xA5, 0x77, 0xFC, 0x60, 0xD1, 
+0xD1, 0x03, 0x03, 0xF0, 0x70, 0x00, 0xDA, 0x22, 
+0xB1, 0x27, 0xFD, 0x2A, 0xB1, 0xF8, 0x82, 0xA5, 
+0x0E, 0x13, 0x4D, 0x19, 0xAE, 0x01, 0xD0, 0x33, 
+0x13, 0x93, 0x5E, 0x10, 0x3A, 0xDE, 0x70, 0xAD, 
+0x25, 0x6F, 0xEE, 0xEA, 0x48, 0x2B, 0xD6, 0x2E, 
+0x5B, 0x39, 0xB7, 0xCD, 0x2C, 0x16, 0x81, 0xC4, 
+0x56, 0xD6, 0xE5, 0xF4, 
+
+0xD6, 0x0F, 0x3A, 0x61, 0x21, 0x9A, 0x43, 0xC0, 
+0x8E, 0xC4, 0x6F, 0xD1, 0x72, 0x22, 0x13, 0xBE, 
+0xAB, 0xE5, 0x68, 0x47, 0x86, 0xF7, 0x56, 0xDC, 
+0x93, 0x2C, 0xE7, 0xA2, 0x61, 0x47, 0x31, 0x33, 
+0xA2, 0x55, 0x2F, 0x97, 0x17, 0x87, 0x37, 0xEE, 
+0xBF, 0xDB, 0x95, 0x93, 0x26, 0x8E, 0x9E, 0x75, 
+0x98, 0x46, 0x47, 0x57, 0x51, 0x3C, 0xF7, 0x74, 
+0x81, 0x68, 0x1C, 0x8C, 0x81, 0xFC, 0x67, 0x6C, 
+0x84, 0x31, 0xCC, 0xE0, 0x89, 0x22, 0x95, 0xDA, 
+0xDC, 0x07, 0x8F, 0x57, 0xC2, 0xA7, 0xDC, 0x9E, 
+0xA6, 0xDC, 0x5E, 0x11, 0x6D, 0x21, 0x77, 0xE5, 
+0xA9, 0x04, 0xB5, 0x2F, 0xD1, 0x03, 0xB6, 0x5F, 
+0x05, 0xA2, 0x40, 0x40, 0x74, 0xBB, 0x80, 0xDB, 
+0x27, 0xFE, 0xE4, 0x85, 0x83, 0x09, 0x69, 0x08, 
+0x04, 0x57, 0xC5, 0xBC, 0x81, 0x44, 0x52, 0xDF, 
+0x46, 0xF5, 0xF7, 0x42, 0x30, 0x66, 0xBA, 0x87, 
+0x95, 0xE3, 0x64, 0x4B, 0x2E, 0xF3, 0xAB, 0x08, 
+0x0B, 0xAD, 0xE3, 0xDD, 0xF5, 0xB3, 0x02, 0x10, 
+0xB0, 0x61, 0xB2, 0xA5, 0xFC, 0x29, 0x5E, 0x88, 
+0xC5, 0x1C, 0x30, 0xF1, 0xE3, 0x7D, 0x24, 0x70, 
+0x1B, 0x82, 0xB5, 0x33, 0xD7, 0x18, 0x95, 0xB9, 
+0x68, 0xE9, 0xA8, 0xE9, 0x50, 
+
+0xD4, 0x99, 0xE2, 0xB0, 0xAE, 0x95, 0x58, 0xA6, 
+0x0C, 0x73, 0xAA, 0x26, 0x3D, 0xE7, 0xB6, 0x6B, 
+0x97, 0x76, 0x6E, 0xC7, 0x72, 0x5E, 0xC7, 0xD7, 
+0x8E, 0x59, 0xE5, 0x72, 0x8D, 0xDF, 0x6C, 0xD0, 
+0x81, 0x00, 0x53, 0xA0, 0xF4, 0x9B, 0xE4, 0x88, 
+0xB6, 0x08, 0xCF, 0x28, 0x04, 0x78, 0x73, 0x91, 
+0x9C, 0x8F, 0xC9, 0xE1, 0x21, 0x00, 0xCF, 0x52, 
+0xE0, 0x57, 0x61, 0x7C, 0xC0, 0x28, 0xD0, 0xEF, 
+0x62, 0x6F, 0x69, 0xD8, 0x38, 0xAD, 0x31, 0x80, 
+0xA6, 0x85, 0xCE, 0x29, 0x68, 0x07, 0x58, 0x65, 
+0x8D, 0x26, 0x28, 0xBE, 0x36, 0xB3, 0xD1, 0xD5, 
+0xC5, 0x4C, 0xA8, 0x34, 0x33, 0x0F, 0xB8, 0xC7, 
+0xB9, 0xA4, 0x71, 0x58, 0x51, 0xC2, 0x84, 0x6C, 
+0x39, 0x2A, 0x0B, 0xBE, 0x28, 0x6A, 0x25, 0x82, 
+0xA7, 0xAB, 0x6B, 0x28, 0x9F, 0xA7, 0xBE, 0x54, 
+0xFE, 0xAE, 0xD4, 0x5B, 0x82, 0xA0, 0x6F, 0xD5, 
+0xDC, 0xBF, 0x2B, 0x04, 0x42, 0x30, 0xB8, 0x41, 
+0x25, 0x5D, 0xD6, 0xAE, 0x16, 0x26, 0xCB, 0x7F, 
+0xBB, 0x9C, 0xD7, 0xFF, 0xC5, 0xA7, 0x8F, 0xFE, 
+0x1B, 0xA4, 0x30, 0x51, 0x1E, 0x24, 0x8C, 0x1A, 
+0xF1, 0xDD, 0x50, 0xDE, 0x3F, 0xBF, 0xA7, 0xD4, 
+0xF7, 0x0A, 0xBF, 0xB1, 0xAC, 0xA5, 
+
+0xBE, 0x95, 0x7F, 0x53, 0x74, 0xDF, 0x9B, 0x19, 
+0x8D, 0xAB, 0x3F, 0x4A, 0x7A, 0x2F, 0x72, 0x91, 
+0x12, 0xF3, 0x35, 0x15, 0x16, 0xA8, 0x2F, 0x9B, 
+0x3B, 0xF2, 0x4C, 0xBE, 0x3A, 0x34, 0xD5, 0x8B, 
+0xA2, 0x7D, 0x92, 0x9B, 0x66, 0x3A, 0x4E, 0x05, 
+0xCF, 0x7C, 0x0C, 0xCF, 0x82, 0xAD, 0x56, 0xE2, 
+0x47, 0x80, 0x38, 0xBD, 0x6B, 0x85, 0x96, 0xEE, 
+0x4F, 0xD0, 0xC2, 0x0A, 0x04, 0x0C, 0x97, 0x07, 
+0xFF, 0x5D, 0xEE, 0xBB, 0x13, 0x74, 0xC7, 0xEF, 
+0x2F, 0x57, 0x78, 0x25, 0xEB, 0x22, 0x5C, 0x3B, 
+0xA4, 0x77, 0x7D, 0x52, 0x03, 0x14, 0xD1, 0xB8, 
+0x82, 0xD1, 0x08, 0x4A, 0x7E, 0xD6, 0xB9, 0x54, 
+0x20, 0xED, 0xEA, 0x5D, 0x15, 0x4F, 0x61, 0x88, 
+0xA8, 0x93, 0x7C, 0xF2, 0xB6, 0x64, 0x79, 0x91, 
+0x83, 0x08, 0xD2, 0x2F, 0xFF, 0xA0, 0x8B, 0x91, 
+0x7B, 0x00, 0x86, 0x46, 0x52, 0xE5, 0xD8, 0x43, 
+0xDA, 0x13, 0xA4, 0xE5, 0xB5, 0xD7, 0xF5, 0xA2, 
+0xE8, 0x0A, 0x8B, 0x13, 0x23, 0x38, 0x4D, 0xF5, 
+0x3B, 0xC7, 0x11, 0x11, 0xFB, 0x2B, 0xF7, 0x30, 
+0x9C, 0x6C, 0xDB, 0xAC, 0x0F, 0xDC, 0xEE, 0xB7, 
+0x2D, 0xAC, 0x9C, 0xB5, 0xAB, 0x74, 0x23, 0x1D, 
+0xC7, 0xE3, 0xCB, 0xA6, 0xA2, 0xE8, 0x48, 
+
+0x40, 0x28, 0x97, 0xB2, 0x07, 0x1B, 0xD9, 0x5F, 
+0x09, 0x3D, 0x54, 0xBE, 0x6B, 0xEA, 0x38, 0x97, 
+0x6C, 0xE7, 0xF1, 0xED, 0xE4, 0xD2, 0x67, 0xF4, 
+0x62, 0x6A, 0x39, 0x58, 0xCF, 0x63, 0x36, 0x55, 
+0x3C, 0xFF, 0x26, 0x30, 0x7E, 0x6E, 0x31, 0xFD, 
+0xD7, 0x2A, 0x96, 0xBD, 0x7B, 0x6F, 0x4E, 0x14, 
+0xB4, 0xCC, 0x0C, 0x6B, 0x9F, 0x0F, 0xD0, 0x2E, 
+0x58, 0xE6, 0xCD, 0xF7, 0x3C, 0x38, 0xFA, 0x76, 
+0x89, 0xF7, 0x46, 0x04, 0xA4, 0x81, 0x83, 0x21, 
+0x22, 0xC1, 0x8B, 0x6A, 0x21, 0xE2, 0x08, 0xEC, 
+0xC6, 0xAD, 0xDB, 0x3B, 0xBC, 0xDB, 0x63, 0xA9, 
+0xE5, 0xF8, 0xA6, 0x88, 0x9E, 0x92, 0xA2, 0x7E, 
+0x3D, 0x25, 0x48, 0xE5, 0x73, 0xD3, 0xD6, 0x29, 
+0x19, 0x7E, 0x3A, 0x22, 0x5B, 0x0F, 0xEF, 0x16, 
+0x05, 0x2D, 0x45, 0x34, 0x45, 0x50, 0x24, 0x8F, 
+0x6A, 0xDC, 0xA5, 0x46, 0xAC, 0x2D, 0x3F, 0x7E, 
+0x86, 0xC3, 0x3D, 0x3F, 0x26, 0x19, 0x06, 0xD1, 
+0x7D, 0x66, 0x43, 0x38, 0x4A, 0xEA, 0xCF, 0x8A, 
+0x1D, 0x59, 0x51, 0xB7, 0x01, 0x0A, 0xA5, 0xFD, 
+0x23, 0x41, 0x8E, 0x12, 0x31, 0x72, 0xE0, 0x88, 
+0x97, 0x88, 0xBE, 0x75, 0xB4, 0xAB, 0x2A, 0x52, 
+0xBB, 0x2B, 0xDA, 0x51, 0xE5, 0xB0, 0x4C, 0xDE, 
+
+0xBF, 0x78, 0xCC, 0x38, 0xC1, 0x4F, 0x0D, 0xF5, 
+0xE0, 0x41, 0x6A, 0xE9, 0xD6, 0xF8, 0x5B, 0x3C, 
+0xB7, 0x78, 0x08, 0x15, 0x88, 0x73, 0x4F, 0x5C, 
+0x1F, 0xC8, 0x0A, 0xA2, 0x1D, 0xAC, 0x43, 0x2C, 
+0x7D, 0x16, 0xAD, 0x30, 0xF0, 0xAF, 0xBF, 0x12, 
+0xE6, 0x98, 0xC0, 0xE9, 0xDA, 0x5D, 0xE8, 0x71, 
+0x8A, 0xC7, 0x65, 0x7F, 0x14, 0x5D, 0x8A, 0x64, 
+0xFE, 0xCB, 0x4A, 0x15, 0x55, 0xB4, 0x4C, 0x91, 
+0xE7, 0x4B, 0x76, 0xAB, 0x54, 0x73, 0x92, 0xD3, 
+0xB5, 0x6A, 0x79, 0x39, 0xAF, 0xD6, 0x4B, 0x30, 
+0xBC, 0x9D, 0x56, 0xE6, 0x2B, 0x95, 0x2A, 0x40, 
+0xA8, 0x92, 0x72, 0x4B, 0x95, 0xA2, 0xBE, 0x19, 
+0xC1, 0x9B, 0x02, 0x54, 0x42, 0x6E, 0x3F, 0xBD, 
+0x5D, 0x07, 0xDD, 0xF6, 0xA4, 0x18, 0x3D, 0x3A, 
+0xBC, 0xA5, 0x4C, 0x32, 0x91, 0xAB, 0x63, 0x7C, 
+0x3E, 0x10, 0x7B, 0x96, 0x0C, 0xAA, 0x6E, 0x29, 
+0x38, 0x9C, 0xA4, 0x8C, 0x26, 0xD8, 0x0C, 0x39, 
+0xFA, 0xBC, 0x30, 0xB9, 0xFC, 0xA5, 0x73, 0x92, 
+0x5F, 0xE1, 0x80, 0x49, 0x77, 0x70, 0x9B, 0x18, 
+0x1E, 0x88, 0xE6, 0xC7, 0xD4, 0xB8, 0x42, 0x6F, 
+0xC6, 0xC4, 0xB7, 0xF8, 0x19, 0xB9, 0xF1, 0x1A, 
+0x4E, 0x29, 0xAE, 0x29, 0x38, 0xA8, 0x31, 0x06, 
+0x12, 
+
+0x29, 0x63, 0x01, 0x1D, 0x8F, 0xA0, 0x90, 0x1B, 
+0x27, 0x5A, 0x33, 0x44, 0x27, 0x54, 0x97, 0xA6, 
+0x60, 0xC9, 0x80, 0xDF, 0xDF, 0x38, 0x90, 0x3C, 
+0x06, 0xCF, 0x00, 0xDF, 0x08, 0xE1, 0xAF, 0x0A, 
+0xB6, 0x08, 0xA9, 0xC9, 0x59, 0x97, 0x8D, 0xE0, 
+0x00, 0x28, 0x01, 0x6F, 0x66, 0x57, 0xD0, 0xE0, 
+0xB9, 0x28, 0xC7, 0xDB, 0xB9, 0xEE, 0x35, 0xE1, 
+0xD6, 0xFA, 0xEF, 0xFD, 0x72, 0x54, 0x9C, 0x2B, 
+0x0B, 0x22, 0x78, 0x69, 0x2A, 0xDE, 0x4D, 0xAA, 
+0xC7, 0xDB, 0xD2, 0x44, 0x9A, 0xF0, 0x26, 0x49, 
+0xFD, 0xF4, 0x04, 0x1E, 0x30, 0x0E, 0xD6, 0x55, 
+0xBE, 0x50, 0x44, 0xBA, 0x2B, 0xDE, 0x5F, 0xDB, 
+0x98, 0xBD, 0xE6, 0x05, 0x89, 0x87, 0xB9, 0x29, 
+0x8E, 0x59, 0xE1, 0x56, 0xA9, 0xF0, 0x14, 0xEB, 
+0x96, 0x8D, 0xE3, 0x4B, 0xE3, 0x07, 0xEA, 0x07, 
+0x5B, 0x38, 0xA9, 0xD0, 0x02, 0x3B, 0x68, 0x88, 
+0xD8, 0x5C, 0x22, 0x2A, 0x66, 0xE4, 0xEC, 0x69, 
+0xBA, 0x4F, 0x10, 0x1B, 0xEA, 0xAA, 0xC4, 0x03, 
+0x9F, 0x25, 0x18, 0x1A, 0x35, 0x06, 0xE1, 0x4C, 
+0x92, 0x1A, 0x5B, 0xE5, 0xCA, 0x45, 0x06, 0x92, 
+0x14, 0x20, 0x33, 0x94, 0x9F, 0xB5, 0xC5, 0x18, 
+0x5D, 0x23, 0xAF, 0x30, 0x92, 0xD8, 0x77, 0x90, 
+0x96, 0xFB, 
+
+0x21, 0x5C, 0xD9, 0xD0, 0x80, 0x47, 0x8F, 0x7D, 
+0x27, 0x6E, 0x1B, 0xF8, 0x27, 0x2B, 0x8F, 0xD5, 
+0x3E, 0x43, 0x76, 0xB0, 0x1A, 0xD9, 0x4A, 0xBA, 
+0x1D, 0x86, 0xF4, 0x78, 0x2F, 0x96, 0xEE, 0xBE, 
+0xEC, 0x6A, 0xDD, 0x0F, 0x5E, 0xC5, 0xD7, 0x51, 
+0xD2, 0xC5, 0x04, 0x96, 0xE8, 0xCB, 0x6A, 0x6F, 
+0x5D, 0x2B, 0x3F, 0xB0, 0xC5, 0xEF, 0x07, 0x56, 
+0x28, 0xD5, 0x17, 0xD7, 0x31, 0xF5, 0x2C, 0x41, 
+0xF3, 0x1C, 0xC6, 0x29, 0xB2, 0x41, 0xDF, 0x26, 
+0x29, 0xFC, 0x82, 0xF1, 0xF3, 0xE7, 0x2C, 0xAC, 
+0x0D, 0xCC, 0xBD, 0x7B, 0x11, 0xFB, 0x91, 0x0A, 
+0xD7, 0xE3, 0x67, 0x40, 0x4B, 0xEA, 0x2E, 0x3C, 
+0x75, 0x3A, 0x5C, 0x05, 0xA3, 0x0E, 0x8C, 0xD7, 
+0x5B, 0x10, 0xEC, 0xE4, 0xE0, 0x40, 0x6B, 0x14, 
+0x89, 0x58, 0x54, 0x0F, 0x9D, 0x1C, 0xF7, 0xF3, 
+0x22, 0xBD, 0x95, 0x06, 0xB4, 0x44, 0x96, 0x8F, 
+0x3F, 0x8B, 0x05, 0xA6, 0xAE, 0x40, 0x9F, 0xD2, 
+0xE6, 0x4F, 0xCF, 0xD4, 0xE4, 0xAE, 0x0B, 0x7F, 
+0x18, 0xA5, 0x81, 0x77, 0xF0, 0x9B, 0xEA, 0xE0, 
+0x02, 0xEF, 0x61, 0x05, 0xED, 0xE0, 0x07, 0xC5, 
+0xC7, 0x8F, 0x7C, 0x2E, 0xD0, 0xE4, 0x72, 0xB7, 
+0xFC, 0x74, 0xE8, 0xC0, 0xA0, 0xA2, 0x81, 0xA9, 
+0xDC, 0xBB, 0x76, 
+
+0xC7, 0xD5, 0xBE, 0x71, 0x2B, 0x4B, 0xEB, 0xFD, 
+0xFB, 0x80, 0x9E, 0x70, 0x98, 0x39, 0x0F, 0x18, 
+0x50, 0xD1, 0x43, 0x5B, 0xA8, 0x06, 0x52, 0x96, 
+0xA0, 0x1B, 0x9D, 0x1D, 0x7A, 0x65, 0x8A, 0x14, 
+0x89, 0xC9, 0xD5, 0xFC, 0xD6, 0xFB, 0xDA, 0x49, 
+0xC7, 0x81, 0x83, 0x93, 0x1E, 0xAA, 0xC9, 0xE5, 
+0xE3, 0x7F, 0x14, 0x6A, 0xB0, 0xEB, 0xC1, 0xE1, 
+0x70, 0x42, 0x7C, 0xE1, 0x6D, 0x63, 0x77, 0x9D, 
+0x67, 0x45, 0x7C, 0x66, 0xD3, 0x6E, 0xEF, 0xC1, 
+0x2B, 0xD5, 0xB2, 0x80, 0x84, 0x83, 0x49, 0xD9, 
+0x63, 0x0F, 0x11, 0xB4, 0xEE, 0xC4, 0xC9, 0x29, 
+0x75, 0x16, 0xAF, 0x4D, 0xAD, 0x47, 0xD4, 0x35, 
+0x4C, 0x45, 0x2F, 0x0F, 0x03, 0x93, 0x99, 0x61, 
+0x8B, 0x0A, 0xF2, 0xB3, 0x1E, 0xE8, 0xC7, 0x78, 
+0x92, 0x22, 0xDD, 0x6E, 0xA7, 0x21, 0xAF, 0xFA, 
+0xEF, 0xE6, 0x7F, 0xCD, 0x50, 0xFD, 0x51, 0xE9, 
+0x14, 0xDF, 0x72, 0xE3, 0x9C, 0xC1, 0x1E, 0xFD, 
+0x07, 0x22, 0x16, 0x37, 0x5E, 0xE3, 0x1B, 0x57, 
+0xA4, 0xDB, 0x4D, 0x74, 0xB1, 0xFD, 0xBB, 0xD2, 
+0xC6, 0x0D, 0xA8, 0xAD, 0x76, 0xA8, 0x6D, 0x21, 
+0xDB, 0xA3, 0xA4, 0x75, 0x4C, 0x22, 0x91, 0x23, 
+0x62, 0xD0, 0xE5, 0x4B, 0x86, 0xE3, 0x74, 0xAC, 
+0xC6, 0xD4, 0xB1, 0x4F, 
+
+0xB4, 0xF4, 0x75, 0x1C, 0x75, 0xB2, 0x70, 0xCE, 
+0x66, 0x70, 0xC6, 0xFB, 0x62, 0xD3, 0x58, 0x71, 
+0x51, 0x91, 0x7D, 0x7E, 0x6F, 0x67, 0xFC, 0xB0, 
+0x01, 0x5C, 0xA1, 0x4A, 0x4E, 0x26, 0x15, 0x8E, 
+0x20, 0xF2, 0xC5, 0x0E, 0x1A, 0xEE, 0x32, 0xE6, 
+0xE9, 0x1C, 0xFF, 0x98, 0x40, 0x6E, 0xFB, 0xA7, 
+0x97, 0x7F, 0xC1, 0xE9, 0x8F, 0xA9, 0xF3, 0x10, 
+0x0A, 0x89, 0x5C, 0xC7, 0x5B, 0xF0, 0x0B, 0x3B, 
+0x71, 0x33, 0x6F, 0x46, 0x7B, 0x9C, 0xD3, 0x18, 
+0x06, 0x1A, 0xC9, 0xFA, 0xF0, 0x4F, 0xDD, 0xA4, 
+0xD2, 0x2C, 0x8A, 0x5D, 0x38, 0x4A, 0xBF, 0x99, 
+0x4A, 0xC5, 0x49, 0xE0, 0x73, 0x18, 0x33, 0x5D, 
+0x34, 0xB3, 0xA6, 0xED, 0x0E, 0xB5, 0x61, 0x71, 
+0xAA, 0xCF, 0x4B, 0x05, 0x8D, 0x26, 0x33, 0xB0, 
+0x65, 0x5C, 0x48, 0xBD, 0x5F, 0x68, 0xAF, 0xBA, 
+0x10, 0x4D, 0xFD, 0xB3, 0xB5, 0xC3, 0x0D, 0x1C, 
+0xE8, 0x02, 0xF5, 0xE7, 0x20, 0x5F, 0x4C, 0xB3, 
+0xCE, 0x60, 0x77, 0x1A, 0x15, 0x68, 0x2E, 0x09, 
+0x1A, 0x32, 0x21, 0xE2, 0xB5, 0x70, 0x38, 0x7D, 
+0x0E, 0xC4, 0xA2, 0xED, 0x16, 0xEE, 0x28, 0xE8, 
+0xAA, 0x69, 0x4D, 0x57, 0x21, 0xCA, 0x8A, 0x3F, 
+0x00, 0x9F, 0x99, 0xBE, 0xDF, 0x98, 0xA3, 0xCC, 
+0x42, 0xAE, 0x9A, 0x8D, 0xD3, 
+
+0x01, 0xA0, 0x09, 0x1B, 0xA7, 0x31, 0x58, 0x85, 
+0x43, 0x31, 0x75, 0x48, 0xAF, 0x6A, 0x8A, 0xCA, 
+0xA9, 0x02, 0xF6, 0x0B, 0x80, 0x89, 0x47, 0x24, 
+0x63, 0x2C, 0xB5, 0x84, 0xB7, 0x72, 0x81, 0x05, 
+0xA5, 0x39, 0x96, 0x8A, 0xA0, 0xC5, 0x65, 0xA2, 
+0x07, 0xC6, 0x90, 0x97, 0xC2, 0x81, 0xEB, 0x12, 
+0x19, 0x92, 0x68, 0xC6, 0x25, 0xFA, 0x30, 0x56, 
+0x1D, 0x03, 0xFA, 0x39, 0x49, 0xF5, 0xE2, 0x81, 
+0x6E, 0x98, 0xE8, 0xB5, 0x96, 0xD7, 0xC9, 0x1E, 
+0xAE, 0xE7, 0x6A, 0x0C, 0x56, 0xBD, 0xA0, 0xC1, 
+0xF5, 0x04, 0x6A, 0x2D, 0xC3, 0xB9, 0xA7, 0xBF, 
+0xFD, 0x01, 0xAE, 0xAA, 0x3F, 0xB2, 0xE8, 0x11, 
+0x62, 0x7C, 0xFB, 0x2F, 0xDF, 0xE9, 0x1D, 0x00, 
+0xF9, 0x6D, 0x9A, 0x5B, 0x44, 0x0B, 0x3F, 0xE9, 
+0x6A, 0xA4, 0x17, 0x84, 0x5F, 0x55, 0x75, 0x4E, 
+0x48, 0xED, 0xEE, 0x91, 0x23, 0x70, 0x35, 0xC3, 
+0x52, 0xB8, 0xDC, 0xF6, 0x11, 0x9B, 0xD9, 0x70, 
+0xAD, 0xB6, 0x31, 0x24, 0x9E, 0xD0, 0x8F, 0x10, 
+0xEB, 0x86, 0x9B, 0x3E, 0x94, 0x11, 0x5B, 0x47, 
+0x45, 0xEB, 0x0E, 0xD3, 0x02, 0x6E, 0x38, 0x3E, 
+0x1C, 0x13, 0x35, 0x5D, 0x5C, 0x7A, 0x25, 0xF9, 
+0x62, 0x4C, 0x3C, 0x84, 0x93, 0xF7, 0xB5, 0x67, 
+0x19, 0xFD, 0xA0, 0x5E, 0x19, 0x72, 
+
+0x25, 0xDE, 0x03, 0x20, 0xD0, 0x65, 0xF3, 0xD1, 
+0x3E, 0x25, 0xDF, 0xA6, 0x97, 0x7B, 0x05, 0x24, 
+0x4D, 0xE3, 0xF8, 0x00, 0x43, 0xAD, 0xAE, 0xA9, 
+0xE8, 0x15, 0xC4, 0xE9, 0x9A, 0xDD, 0xE2, 0xBE, 
+0x60, 0x04, 0x94, 0x82, 0x9C, 0xE6, 0xF5, 0xBA, 
+0x09, 0xAD, 0x29, 0xC9, 0x74, 0xE6, 0x83, 0xB8, 
+0xA7, 0x74, 0xAA, 0xD7, 0xD8, 0x23, 0xC2, 0xAE, 
+0x4D, 0x31, 0x4A, 0xDA, 0x93, 0x0A, 0x1D, 0xDA, 
+0xBB, 0x5E, 0xA6, 0x6F, 0x14, 0xC4, 0x30, 0xFC, 
+0x56, 0xC6, 0xC9, 0x09, 0x3F, 0xB8, 0xCF, 0x0F, 
+0xDC, 0x4E, 0x6E, 0xE9, 0xE6, 0xC8, 0xDE, 0x41, 
+0xDC, 0x4A, 0xC1, 0x53, 0x7C, 0x2B, 0x28, 0x05, 
+0x6C, 0xA6, 0x3F, 0x2E, 0x79, 0xE3, 0x7F, 0xEE, 
+0xAD, 0xF4, 0x1F, 0x9A, 0x72, 0x85, 0x68, 0x77, 
+0x23, 0xAD, 0x52, 0x2A, 0xB8, 0xFC, 0xF5, 0x61, 
+0x77, 0x0B, 0xA8, 0xD9, 0x65, 0xFC, 0xFC, 0xE1, 
+0x85, 0x06, 0xF9, 0xF9, 0x8E, 0x9F, 0x0F, 0x75, 
+0x5D, 0x89, 0xCA, 0xDA, 0x3B, 0x53, 0xB8, 0xBA, 
+0x40, 0xC9, 0x80, 0xB6, 0xD8, 0xE6, 0xE6, 0x0E, 
+0xC4, 0x53, 0x2D, 0xD8, 0xA1, 0xD1, 0x99, 0x11, 
+0xB5, 0x51, 0x0A, 0x77, 0xB1, 0x0B, 0x5E, 0xAB, 
+0xA4, 0xD8, 0xCC, 0x96, 0x38, 0xE8, 0x6E, 0xE8, 
+0xC3, 0x88, 0xD8, 0x15, 0xB3, 0x23, 0x7A, 
+
+0xDA, 0xBC, 0x95, 0x72, 0xFD, 0xC4, 0xA3, 0xC8, 
+0xFA, 0xF5, 0x91, 0x50, 0x42, 0x4B, 0xC9, 0xA2, 
+0x9D, 0x53, 0x9B, 0x74, 0x3C, 0x1E, 0x36, 0x65, 
+0x01, 0x05, 0x55, 0xF5, 0x00, 0xAD, 0x75, 0x01, 
+0x92, 0x7E, 0x60, 0xA6, 0xE2, 0x16, 0x2F, 0xBC, 
+0x0A, 0x76, 0x39, 0x3D, 0xEA, 0xC1, 0xE9, 0x5A, 
+0x5E, 0x6C, 0x72, 0xE5, 0x1A, 0xBD, 0x48, 0x5F, 
+0xA1, 0x88, 0x19, 0xF9, 0x8C, 0xE2, 0x2E, 0x72, 
+0x05, 0x43, 0x96, 0x5B, 0x68, 0x6E, 0x2C, 0xD0, 
+0xB1, 0xEC, 0xB9, 0x3E, 0x3A, 0x2E, 0xBA, 0xA1, 
+0x4A, 0x6A, 0x9F, 0xD0, 0x64, 0x18, 0xE5, 0x1A, 
+0x5A, 0x92, 0x8B, 0x7F, 0xBC, 0x6D, 0x5A, 0x9B, 
+0x79, 0xDF, 0x12, 0x0A, 0x70, 0xB7, 0xC7, 0xB0, 
+0x7D, 0x6D, 0xEC, 0xE0, 0xED, 0x9F, 0x01, 0x4C, 
+0x0F, 0x80, 0x6F, 0x0B, 0x5F, 0x0C, 0xA4, 0x5E, 
+0x63, 0xE8, 0x7C, 0xBE, 0x11, 0xDC, 0x70, 0xC0, 
+0xA6, 0xA7, 0x53, 0xE3, 0xEF, 0xAD, 0xD0, 0x4D, 
+0x20, 0x9D, 0xDF, 0x21, 0xF1, 0x81, 0x03, 0xAE, 
+0xE5, 0xB3, 0x6B, 0x60, 0x01, 0x7B, 0x49, 0x54, 
+0xFF, 0xFB, 0x0C, 0x47, 0x85, 0x71, 0x9B, 0xA7, 
+0x2D, 0x37, 0x3B, 0x6C, 0x36, 0x0D, 0xD5, 0x5C, 
+0x92, 0xF3, 0xDE, 0x98, 0x9D, 0x52, 0x7A, 0xA9, 
+0xC5, 0xC9, 0xC1, 0x55, 0x79, 0xEF, 0xF9, 0x97, 
+
+0x8D, 0x30, 0xFD, 0xE5, 0x84, 0x05, 0x14, 0x59, 
+0xA5, 0x82, 0x3E, 0x8F, 0x27, 0x6B, 0xB8, 0x93, 
+0x2F, 0x53, 0x20, 0x7A, 0x45, 0xB5, 0x5E, 0xAF, 
+0xD5, 0xA6, 0x37, 0xB3, 0xC7, 0xC9, 0xEC, 0x47, 
+0x98, 0x1E, 0x09, 0xD9, 0xD4, 0x4F, 0x43, 0x0D, 
+0xE0, 0x1D, 0xEE, 0x96, 0xB6, 0x21, 0xEE, 0xDD, 
+0xE3, 0x95, 0x8F, 0x8A, 0x7C, 0x7A, 0x5E, 0x4B, 
+0x4A, 0x27, 0x59, 0xE4, 0x3F, 0x58, 0xD9, 0x20, 
+0x05, 0x62, 0x3A, 0xC6, 0x87, 0xDC, 0x1D, 0x40, 
+0xFC, 0x8D, 0x12, 0x3C, 0x92, 0x47, 0xDA, 0xFC, 
+0x26, 0x20, 0xF2, 0x42, 0xAF, 0xFE, 0x01, 0xFE, 
+0x21, 0xC0, 0x70, 0xAF, 0xB7, 0x33, 0x4F, 0xCB, 
+0xC0, 0x8C, 0x2C, 0x12, 0xBC, 0x01, 0xCD, 0xA9, 
+0x67, 0x0E, 0x40, 0x06, 0xBD, 0x61, 0xD0, 0xC1, 
+0xB2, 0xA2, 0xC7, 0x1A, 0x27, 0x97, 0x48, 0x3F, 
+0xD7, 0x2B, 0x6C, 0x93, 0xEF, 0x79, 0xD3, 0xAA, 
+0x58, 0xA2, 0xE2, 0x4D, 0xF2, 0x64, 0x93, 0x3A, 
+0xE0, 0x55, 0x70, 0x60, 0xCC, 0x00, 0x54, 0xBC, 
+0xC8, 0xB5, 0x57, 0x61, 0x06, 0x54, 0x33, 0x5A, 
+0xA1, 0x5B, 0x73, 0xFF, 0x65, 0xE1, 0x9B, 0xF9, 
+0x52, 0x1A, 0xEC, 0xB5, 0x86, 0x94, 0x6D, 0x3C, 
+0x4E, 0x60, 0x46, 0x06, 0x6F, 0xD3, 0x99, 0xC8, 
+0x3F, 0x5C, 0xAB, 0x2B, 0xC2, 0x9E, 0xB6, 0xEE, 
+0xCC, 
+
+0x84, 0x68, 0x0C, 0x03, 0x35, 0x2E, 0x25, 0xD8, 
+0xFE, 0x13, 0x31, 0xFD, 0x62, 0xBE, 0xD2, 0xA3, 
+0x82, 0xB4, 0xB8, 0x8B, 0x33, 0x22, 0xA9, 0x69, 
+0xAD, 0x59, 0xB9, 0x05, 0x70, 0xBF, 0xB9, 0xE6, 
+0x8D, 0xE7, 0x1C, 0x3B, 0xC8, 0xA4, 0xBB, 0xE7, 
+0xA3, 0x33, 0x5A, 0xA3, 0xAF, 0xE2, 0x40, 0xFE, 
+0xF5, 0x8D, 0x69, 0x25, 0x30, 0x5E, 0xEA, 0xEB, 
+0x9E, 0xB9, 0x86, 0xF3, 0x40, 0x31, 0x6C, 0x2A, 
+0xCE, 0xE5, 0x3E, 0x6C, 0xE3, 0x4C, 0x6A, 0x32, 
+0xD5, 0xCC, 0xB3, 0xCE, 0x1D, 0x8D, 0xF8, 0x06, 
+0x7A, 0x7D, 0xE9, 0x12, 0x70, 0x69, 0xD2, 0xF6, 
+0x2C, 0xA8, 0xB2, 0x32, 0x32, 0x85, 0x5D, 0xFD, 
+0x17, 0x68, 0xF0, 0x52, 0x25, 0xAD, 0x77, 0x17, 
+0x30, 0x74, 0x23, 0x1C, 0x41, 0x52, 0x51, 0xCE, 
+0x9A, 0x37, 0x31, 0xC4, 0x82, 0xC9, 0x9F, 0xBF, 
+0x85, 0x77, 0x0E, 0x8B, 0x66, 0x80, 0x29, 0x6B, 
+0xC1, 0xD1, 0x67, 0x1F, 0xE8, 0x1F, 0xDC, 0x3C, 
+0x63, 0xE3, 0x48, 0x8B, 0x41, 0xA0, 0x05, 0x14, 
+0x85, 0x4E, 0x6D, 0xFF, 0x5D, 0x19, 0x27, 0xED, 
+0x96, 0x72, 0x1D, 0x52, 0xB3, 0xAF, 0xD3, 0x56, 
+0x5D, 0xF4, 0x92, 0xBC, 0xD6, 0x78, 0x17, 0xAA, 
+0x10, 0x4D, 0xC6, 0x7C, 0xC4, 0x2C, 0x85, 0x25, 
+0x03, 0x27, 0x87, 0x7B, 0xDF, 0x47, 0x23, 0x99, 
+0xA1, 0xF7, 
+
+0x8E, 0xA5, 0xB9, 0x3A, 0xB0, 0xA7, 0xEF, 0x7D, 
+0xA1, 0x76, 0xD8, 0x12, 0x5E, 0x0C, 0xB3, 0x2E, 
+0xDA, 0x06, 0x27, 0xFC, 0xD9, 0x73, 0x3E, 0x86, 
+0x31, 0xD6, 0x70, 0xFD, 0xB1, 0xFD, 0xA8, 0xC7, 
+0xF9, 0x22, 0x87, 0xE6, 0xE7, 0xE0, 0x91, 0x86, 
+0x6A, 0xCA, 0x8B, 0xE1, 0x13, 0x91, 0xF2, 0xA9, 
+0xB2, 0x5A, 0x60, 0xE0, 0x43, 0x3E, 0x03, 0xB8, 
+0xB4, 0x7E, 0x18, 0x5C, 0x02, 0xB8, 0xE2, 0x46, 
+0xE0, 0xEF, 0x17, 0x30, 0x18, 0x68, 0x62, 0x9A, 
+0x56, 0x68, 0x59, 0xD8, 0x8F, 0x4B, 0x84, 0xB3, 
+0xD0, 0xAC, 0x7F, 0xDB, 0x84, 0x6B, 0x3B, 0xEC, 
+0xDC, 0x63, 0x76, 0x30, 0x93, 0xFE, 0xAE, 0x0F, 
+0x3B, 0xAA, 0x5C, 0xB8, 0x5F, 0xE2, 0x2A, 0x8D, 
+0x91, 0x86, 0xD1, 0x56, 0xE4, 0x19, 0x50, 0x2D, 
+0xEA, 0x4F, 0x20, 0x70, 0x7A, 0x4F, 0xA9, 0x96, 
+0xB0, 0x41, 0xCF, 0x25, 0xE5, 0xD3, 0x2F, 0x46, 
+0xA5, 0xAE, 0x05, 0x59, 0x28, 0x60, 0xE8, 0x52, 
+0x1A, 0x76, 0x79, 0x1F, 0x59, 0x11, 0x2C, 0x9B, 
+0x57, 0x60, 0x4F, 0x51, 0xA0, 0x66, 0x37, 0x3C, 
+0xDB, 0x82, 0xB2, 0xD5, 0x06, 0x7E, 0x18, 0xDA, 
+0xC0, 0x3D, 0xE6, 0xEE, 0x63, 0x48, 0x1C, 0x8F, 
+0xF3, 0x40, 0x75, 0xAB, 0xA5, 0x00, 0xD5, 0x86, 
+0xA5, 0xAB, 0x8B, 0x4D, 0xC4, 0x35, 0xE3, 0x19, 
+0x44, 0x53, 0x47, 
+
+0xD8, 0x0B, 0xEE, 0xCE, 0x8D, 0xF9, 0x33, 0x14, 
+0x50, 0xC4, 0x3F, 0xD0, 0x77, 0x34, 0xEA, 0xCC, 
+0x8E, 0x56, 0x5A, 0x6A, 0xE0, 0xD2, 0xFD, 0xC7, 
+0x5F, 0xC3, 0xAB, 0x24, 0x03, 0xC1, 0x9E, 0x27, 
+0x91, 0x81, 0x69, 0xE9, 0xE0, 0xAB, 0x1D, 0x9F, 
+0x7F, 0x8F, 0x35, 0x02, 0xA3, 0x45, 0x39, 0x00, 
+0x7B, 0x90, 0x40, 0x49, 0xA4, 0xA1, 0xCF, 0x1B, 
+0xC8, 0xA8, 0xA8, 0xB1, 0x7B, 0x69, 0x49, 0x33, 
+0x9D, 0xBA, 0x6F, 0x9D, 0xF3, 0x50, 0x60, 0x5D, 
+0xD1, 0x2C, 0xE4, 0x3E, 0x0B, 0x85, 0xC4, 0xC7, 
+0xBE, 0x51, 0xF5, 0xC8, 0x9D, 0x53, 0x46, 0xF8, 
+0x2D, 0x32, 0x88, 0x08, 0xD7, 0x80, 0xE3, 0x41, 
+0x50, 0x6E, 0xBE, 0x1C, 0x5A, 0x6A, 0x7B, 0xD7, 
+0x14, 0xF8, 0x89, 0x96, 0xC2, 0xFE, 0x9D, 0x23, 
+0xFF, 0x4C, 0x4C, 0x3E, 0x04, 0x8E, 0xF6, 0xDD, 
+0x56, 0x98, 0x17, 0xAD, 0x45, 0x64, 0xDB, 0xE4, 
+0xCE, 0x90, 0x60, 0x77, 0x46, 0x6F, 0x3E, 0x31, 
+0x28, 0xA7, 0xB0, 0x1B, 0x58, 0x55, 0xBC, 0xC7, 
+0x77, 0x9A, 0x06, 0xC9, 0xD0, 0x75, 0x99, 0x5F, 
+0x41, 0xE0, 0x1D, 0x1B, 0xAE, 0x97, 0x52, 0x05, 
+0x9D, 0x37, 0x69, 0x78, 0x23, 0x77, 0x32, 0x15, 
+0xF6, 0xD3, 0xD1, 0xBA, 0x90, 0xAC, 0x47, 0xB7, 
+0xCF, 0x9E, 0x28, 0xFE, 0xA3, 0x10, 0x8F, 0x39, 
+0x0E, 0x03, 0x06, 0x77, 
+
+0xE2, 0x7C, 0x88, 0x88, 0x42, 0x18, 0x14, 0xF7, 
+0xA8, 0x44, 0x6E, 0x29, 0x81, 0xEB, 0x9B, 0x65, 
+0x62, 0x58, 0x89, 0x53, 0x4C, 0xCA, 0x26, 0x66, 
+0x82, 0xEA, 0x9B, 0x99, 0x1B, 0x0A, 0xB9, 0xC5, 
+0x93, 0x83, 0x97, 0x60, 0x19, 0x3D, 0x2C, 0x60, 
+0x89, 0x2D, 0xD3, 0xDE, 0x0F, 0xF4, 0xF5, 0xA0, 
+0xB3, 0xE4, 0x4D, 0xB4, 0x29, 0x13, 0xBC, 0x72, 
+0x26, 0xD2, 0x20, 0xE5, 0x66, 0x5F, 0x5A, 0x41, 
+0xAD, 0x76, 0xE5, 0xE9, 0x45, 0x39, 0xC7, 0x2E, 
+0x57, 0xB4, 0x2E, 0x2E, 0x95, 0x55, 0x30, 0xF8, 
+0xA6, 0xBE, 0x97, 0x84, 0x6A, 0x2E, 0x05, 0xD3, 
+0x3B, 0x90, 0xBD, 0xD6, 0xD7, 0x8C, 0x55, 0x70, 
+0x09, 0x20, 0xB2, 0x05, 0xC1, 0xB2, 0x85, 0x71, 
+0x1D, 0x13, 0xC9, 0x27, 0xD6, 0x2F, 0x43, 0xE6, 
+0x25, 0xB3, 0x1B, 0xBA, 0x25, 0xDF, 0xF5, 0xB7, 
+0xBC, 0x27, 0x64, 0xD2, 0x2B, 0x4B, 0x00, 0xA2, 
+0x39, 0xD1, 0x24, 0x70, 0xF4, 0x14, 0x9B, 0xA4, 
+0x81, 0xED, 0xE2, 0x7A, 0xDE, 0xF5, 0x51, 0xA9, 
+0x89, 0x2B, 0xEE, 0x0D, 0x9D, 0xAE, 0xF1, 0x10, 
+0xC5, 0x2D, 0x53, 0x58, 0x4D, 0x67, 0x14, 0x9B, 
+0x6A, 0x25, 0x37, 0x7E, 0x74, 0xAA, 0xB9, 0xE3, 
+0x98, 0x20, 0xF3, 0x56, 0x01, 0x0D, 0x54, 0x5E, 
+0x39, 0xE4, 0x3B, 0x80, 0x02, 0x90, 0xF6, 0x4C, 
+0xC9, 0x4B, 0x1D, 0xB0, 0x22, 
+
+0x2A, 0x35, 0xB8, 0xA7, 0xDF, 0x07, 0x47, 0x30, 
+0xB7, 0xE9, 0x34, 0x04, 0x47, 0x24, 0x30, 0xE3, 
+0x3E, 0x4F, 0x97, 0xEB, 0x9E, 0x42, 0xA5, 0xB7, 
+0xD8, 0x04, 0x9F, 0xC0, 0x75, 0xBF, 0x81, 0x7A, 
+0x14, 0x96, 0x71, 0xA6, 0x4C, 0x63, 0x38, 0xD5, 
+0x74, 0x61, 0x82, 0xE2, 0x2E, 0xA4, 0x34, 0x89, 
+0x47, 0xDF, 0x83, 0x35, 0x09, 0x6C, 0x72, 0x51, 
+0x59, 0x7F, 0x64, 0xC2, 0xA1, 0xB7, 0x2B, 0xB7, 
+0xEC, 0xEC, 0xC3, 0xFB, 0x25, 0x10, 0x4E, 0xD1, 
+0x91, 0xDF, 0x93, 0x68, 0xF0, 0x2F, 0x6D, 0x3E, 
+0x46, 0xBF, 0x82, 0xD1, 0xD8, 0x22, 0x48, 0x26, 
+0xBD, 0x71, 0xE5, 0x47, 0xB9, 0x2D, 0x93, 0xE1, 
+0x5D, 0x0D, 0x9C, 0xB1, 0x3C, 0x25, 0xAF, 0x5D, 
+0x8A, 0x52, 0x68, 0x46, 0xB1, 0x25, 0xD8, 0x3C, 
+0x39, 0x87, 0x4A, 0xAC, 0x57, 0x20, 0x47, 0x02, 
+0x9F, 0xE4, 0xDC, 0x03, 0xBE, 0xB0, 0xFE, 0xC9, 
+0xFF, 0x94, 0x49, 0xE7, 0xB3, 0x08, 0x63, 0x90, 
+0x73, 0x0D, 0x7B, 0xDA, 0x9F, 0xBC, 0x41, 0x94, 
+0xCC, 0xAC, 0x86, 0x93, 0x23, 0x7F, 0xA0, 0xC6, 
+0x9A, 0x5E, 0x95, 0x20, 0x48, 0x0F, 0xC4, 0xF6, 
+0xB5, 0x3F, 0x53, 0x80, 0x24, 0x16, 0xFD, 0x16, 
+0x13, 0x9E, 0x9D, 0xEB, 0x26, 0x17, 0xFA, 0xB0, 
+0x9C, 0x51, 0xDD, 0xD7, 0xCC, 0xB0, 0x0E, 0xA2, 
+0x5D, 0xF6, 0x29, 0x1B, 0x5D, 0x03, 
+
+0x66, 0x61, 0xF5, 0x18, 0xDC, 0x80, 0xC5, 0x11, 
+0xA0, 0x90, 0x66, 0xD3, 0x2B, 0xD8, 0x20, 0x5D, 
+0x0F, 0xCC, 0xB2, 0xD1, 0x59, 0xDB, 0x28, 0xB1, 
+0x25, 0x62, 0x54, 0xA3, 0x93, 0x4F, 0xA0, 0x32, 
+0xDA, 0x74, 0x79, 0xA7, 0xF4, 0x22, 0xDB, 0xC6, 
+0x1D, 0xA0, 0x1F, 0xDB, 0x24, 0xDE, 0x95, 0xF8, 
+0x8F, 0xBC, 0xEE, 0x4E, 0xD8, 0xFA, 0x09, 0x5C, 
+0xBB, 0x48, 0xD6, 0x4F, 0x4B, 0x3F, 0x30, 0x55, 
+0x4C, 0x4C, 0x51, 0xC6, 0xBE, 0xFD, 0xDC, 0x81, 
+0x33, 0x3F, 0xA7, 0xD3, 0x7C, 0xE5, 0xA2, 0x26, 
+0xB2, 0x3C, 0x32, 0x57, 0x82, 0x10, 0xF8, 0xA2, 
+0x30, 0xAB, 0xF9, 0xDB, 0x1B, 0xB4, 0x08, 0x76, 
+0x6E, 0xE3, 0x0F, 0x0C, 0x40, 0x18, 0x31, 0x67, 
+0x7E, 0xB8, 0xBB, 0xD9, 0xD1, 0xCE, 0x5E, 0x80, 
+0x51, 0xD8, 0x36, 0x5B, 0xB6, 0x95, 0x27, 0x93, 
+0xCF, 0x5F, 0xC2, 0xAE, 0x69, 0xB5, 0x31, 0xFF, 
+0x3A, 0x8B, 0x26, 0x3C, 0x05, 0x78, 0xF1, 0xC2, 
+0xFE, 0xBC, 0x0A, 0x52, 0x6D, 0xFF, 0x80, 0xFF, 
+0x21, 0xE0, 0x80, 0xF1, 0xAC, 0xE9, 0x22, 0xBA, 
+0x7B, 0x9B, 0x93, 0x8A, 0x8C, 0xFC, 0x9D, 0x1C, 
+0x22, 0x5B, 0x1A, 0x80, 0x2F, 0x29, 0xF4, 0x46, 
+0x0F, 0x88, 0x65, 0xCD, 0x61, 0x8E, 0x91, 0x28, 
+0xC1, 0xA9, 0xD0, 0xC9, 0x44, 0x36, 0xA3, 0xDF, 
+0x2A, 0x45, 0x3A, 0x52, 0x94, 0xED, 0xA9, 
+
+0xA7, 0x42, 0x88, 0x87, 0x2A, 0x02, 0x7A, 0x8B, 
+0xA2, 0x4F, 0x1F, 0x30, 0xEF, 0xAA, 0x99, 0x81, 
+0xA4, 0x62, 0x8B, 0x5E, 0x83, 0x4C, 0xA2, 0xFD, 
+0x52, 0x5B, 0x92, 0xF0, 0x36, 0x83, 0x98, 0x30, 
+0x08, 0x56, 0x06, 0xA1, 0x92, 0xF7, 0xF5, 0x76, 
+0xB3, 0x82, 0x07, 0x98, 0xCE, 0xF2, 0x88, 0x5A, 
+0x9F, 0x7E, 0xA3, 0x79, 0x2C, 0x94, 0x81, 0xB4, 
+0xE3, 0xFD, 0xA3, 0x7F, 0x21, 0x89, 0xCA, 0x0D, 
+0xFB, 0xDF, 0xE1, 0x35, 0xB5, 0xE3, 0x63, 0x13, 
+0xED, 0x4F, 0x8D, 0x60, 0xDD, 0x8B, 0xF9, 0x48, 
+0x07, 0x2A, 0x3A, 0xE2, 0xD0, 0x15, 0x40, 0x49, 
+0xB4, 0xA0, 0x99, 0xE2, 0x5A, 0xA4, 0x54, 0xDA, 
+0x5E, 0xC1, 0xCF, 0x78, 0x5B, 0x3C, 0xE5, 0xC6, 
+0x68, 0x2E, 0x91, 0x38, 0xFF, 0x13, 0xB1, 0xAC, 
+0x32, 0xB0, 0x98, 0xFA, 0x77, 0x3C, 0x22, 0xC9, 
+0x24, 0x4B, 0x58, 0xF1, 0x95, 0xA3, 0xF0, 0xAC, 
+0xFB, 0x4D, 0x43, 0xD4, 0xC1, 0xA0, 0x2B, 0x90, 
+0x4D, 0x05, 0xB3, 0x57, 0x86, 0xB6, 0x3B, 0x05, 
+0x6E, 0xC2, 0xE6, 0xC3, 0xED, 0xE2, 0x20, 0xC2, 
+0x34, 0xF0, 0x75, 0x41, 0xA2, 0x24, 0x9B, 0x73, 
+0x94, 0x94, 0xC5, 0xA9, 0x43, 0xAD, 0xA3, 0xF4, 
+0x93, 0x8D, 0x06, 0x9C, 0x06, 0x9E, 0xC5, 0xAE, 
+0xA6, 0x23, 0x4D, 0xC6, 0x4D, 0xA0, 0xE4, 0xE9, 
+0xD8, 0xF5, 0xFC, 0xF2, 0xB7, 0xB1, 0xDD, 0x19, 
+
+0xC5, 0x10, 0x0B, 0x54, 0xAD, 0x44, 0xC8, 0x0C, 
+0xC8, 0xEF, 0x22, 0x57, 0x4C, 0xA8, 0xFA, 0x25, 
+0x66, 0xF3, 0xAB, 0x03, 0x94, 0x46, 0xD1, 0x0E, 
+0xFE, 0xE4, 0xDF, 0x58, 0x49, 0x30, 0xF4, 0x30, 
+0xC9, 0xEE, 0x95, 0x3F, 0x89, 0x33, 0x7C, 0x1C, 
+0xC4, 0x9B, 0xA8, 0x43, 0xC7, 0x08, 0xD3, 0x22, 
+0x5D, 0xF2, 0xC8, 0xC5, 0x46, 0x08, 0x68, 0x24, 
+0xC5, 0x8A, 0x75, 0x35, 0x33, 0xD4, 0x5A, 0xAA, 
+0xE7, 0x26, 0x62, 0x77, 0x5A, 0x19, 0x2C, 0x67, 
+0xA2, 0xFE, 0x3B, 0x02, 0x04, 0x46, 0xBE, 0x8F, 
+0x74, 0x69, 0x6D, 0x3D, 0xED, 0x72, 0x50, 0x13, 
+0x87, 0xA0, 0x3E, 0x5F, 0x06, 0xED, 0x1A, 0x9B, 
+0x97, 0xAB, 0xDD, 0x8E, 0x42, 0x20, 0xBE, 0x91, 
+0xB4, 0xA4, 0x9D, 0xFD, 0x66, 0xAC, 0x93, 0x76, 
+0xBD, 0xF7, 0x20, 0x0D, 0x11, 0x81, 0x58, 0xA2, 
+0x80, 0x5D, 0xB1, 0xAC, 0x77, 0xF9, 0x2F, 0x09, 
+0xE4, 0x1D, 0x02, 0x9E, 0x7B, 0xFE, 0xA7, 0x79, 
+0x50, 0x2F, 0x5B, 0x2C, 0x12, 0x2D, 0xFB, 0x7A, 
+0x40, 0xE4, 0x40, 0xA9, 0xD9, 0xF3, 0x4E, 0x9A, 
+0x89, 0x78, 0xD4, 0x08, 0x65, 0x58, 0xBD, 0x8E, 
+0x0A, 0x9A, 0x80, 0x23, 0x18, 0xC7, 0x96, 0x1F, 
+0x25, 0xCA, 0x72, 0xCA, 0x81, 0x2F, 0x88, 0x1C, 
+0xE8, 0xFD, 0xEF, 0xFB, 0x6F, 0xD5, 0xBE, 0xAD, 
+0xBB, 0xA4, 0x94, 0x82, 0xB3, 0x8E, 0x31, 0x02, 
+0xBC, 
+
+0xFB, 0xD7, 0x9A, 0xA7, 0xF1, 0xF3, 0xA8, 0x5F, 
+0xE3, 0x4A, 0x83, 0xD2, 0x37, 0x0E, 0x2B, 0x99, 
+0x55, 0xE8, 0x3E, 0x57, 0x85, 0xC1, 0x8F, 0x46, 
+0x97, 0x8C, 0x93, 0x9C, 0xD1, 0x5D, 0xEF, 0x1B, 
+0x2E, 0x7E, 0xBD, 0x87, 0xA0, 0xE6, 0x1A, 0x0D, 
+0xBB, 0x85, 0x78, 0xB2, 0xD2, 0x15, 0xF5, 0xAF, 
+0x88, 0x2D, 0xDD, 0xC9, 0x92, 0x3D, 0x3B, 0x6C, 
+0xC9, 0x4E, 0x14, 0xC0, 0x94, 0x9E, 0x9B, 0x91, 
+0x7E, 0x19, 0xF4, 0xCF, 0x11, 0x14, 0x3B, 0x4B, 
+0x6D, 0xE5, 0xB5, 0x3B, 0x72, 0x08, 0x8F, 0xF3, 
+0x90, 0xB9, 0xE4, 0x2B, 0x75, 0x18, 0x5E, 0x35, 
+0xAC, 0xAC, 0x71, 0x4C, 0x9B, 0xFB, 0x0E, 0xF9, 
+0xF6, 0xE0, 0xA3, 0x1D, 0xD5, 0xDF, 0x92, 0x06, 
+0x5D, 0x33, 0xF0, 0xF9, 0x8A, 0x89, 0xFE, 0x2F, 
+0x16, 0x59, 0x47, 0x97, 0x9D, 0x0F, 0x9E, 0x37, 
+0xE7, 0x2E, 0xAF, 0xED, 0xF1, 0x6D, 0x7D, 0x94, 
+0xB6, 0x98, 0x1E, 0x51, 0xDF, 0x10, 0x58, 0xB1, 
+0x62, 0x4C, 0x81, 0x94, 0x43, 0x39, 0x19, 0x56, 
+0xC2, 0x26, 0x71, 0xD5, 0x47, 0x07, 0x96, 0x5D, 
+0x4B, 0x27, 0x91, 0xEB, 0x85, 0x54, 0x0C, 0x06, 
+0xB4, 0x9D, 0x96, 0xCE, 0x07, 0x81, 0x2A, 0x56, 
+0x1B, 0x30, 0x41, 0x52, 0xF5, 0xA6, 0xFD, 0x31, 
+0x94, 0x3D, 0xE2, 0x7A, 0xC7, 0x86, 0x10, 0xDE, 
+0x53, 0x41, 0xC0, 0xF6, 0xC7, 0xAD, 0x45, 0x63, 
+0x0A, 0xF0, 
+
+0x87, 0xAB, 0x71, 0xDD, 0x16, 0x49, 0xE1, 0xC7, 
+0xD0, 0x11, 0x38, 0xCC, 0xD7, 0x90, 0xE3, 0xD3, 
+0xB2, 0x4B, 0x87, 0xAE, 0xD3, 0x83, 0xDD, 0x1C, 
+0x7B, 0x9F, 0xE3, 0x01, 0x4E, 0xD4, 0xF3, 0x0D, 
+0x04, 0xDA, 0x33, 0x14, 0x33, 0xA0, 0x84, 0xE6, 
+0xEC, 0x2F, 0x8F, 0x6C, 0x5B, 0x94, 0x73, 0x9C, 
+0x9D, 0x16, 0xDE, 0x50, 0x63, 0xD1, 0x03, 0xC9, 
+0x27, 0x04, 0x1F, 0x3F, 0x5F, 0xC5, 0xB2, 0xEB, 
+0x40, 0xE8, 0x7C, 0x7B, 0xF6, 0x1E, 0xFE, 0x86, 
+0x57, 0x80, 0x63, 0x99, 0x2B, 0x36, 0x07, 0xED, 
+0x0D, 0x16, 0xD3, 0x81, 0x78, 0xCD, 0x76, 0xA3, 
+0x16, 0xA7, 0xCD, 0xDB, 0xE7, 0xE8, 0xAC, 0x55, 
+0x9A, 0xC0, 0x4D, 0xAC, 0x62, 0x5E, 0x46, 0x9D, 
+0xCA, 0x1D, 0xBA, 0x10, 0xD9, 0xE1, 0xA8, 0x49, 
+0x1A, 0x00, 0x9A, 0xE9, 0xF5, 0xD5, 0x9C, 0x67, 
+0x9C, 0xF4, 0xA4, 0xFF, 0x4A, 0x0B, 0x63, 0x44, 
+0x7B, 0x84, 0x2F, 0x4E, 0xDA, 0xE1, 0xBD, 0x59, 
+0x2E, 0xC7, 0x79, 0x5E, 0xA7, 0x66, 0x83, 0xA6, 
+0xC0, 0xA3, 0xEC, 0xA6, 0x0E, 0x91, 0x12, 0x02, 
+0xCC, 0xF2, 0x86, 0xC5, 0x84, 0x97, 0x8B, 0x0E, 
+0x0C, 0x78, 0x14, 0x9B, 0x80, 0xC7, 0xCE, 0x50, 
+0xA5, 0x39, 0x30, 0xEA, 0x82, 0x25, 0xD6, 0x48, 
+0xA6, 0xAA, 0xC1, 0xDA, 0x51, 0xE8, 0x2E, 0x39, 
+0x0F, 0xE7, 0xF7, 0x0A, 0xA4, 0xA9, 0x80, 0xEF, 
+0x9E, 0x9B, 0x0B, 
+
+0x5B, 0x85, 0x45, 0xF7, 0xEC, 0x80, 0x62, 0xFE, 
+0xF3, 0x15, 0x05, 0x05, 0x8A, 0xB0, 0xBC, 0x3F, 
+0xB2, 0xB9, 0xDB, 0xC8, 0xDA, 0x35, 0x5F, 0xBA, 
+0x3F, 0x0A, 0x08, 0xFC, 0x8E, 0xDB, 0x47, 0xB5, 
+0x48, 0x60, 0x69, 0xB0, 0x6B, 0x87, 0xC5, 0x25, 
+0x04, 0x96, 0x5B, 0x3E, 0xCB, 0x36, 0x88, 0x0A, 
+0x5C, 0xEB, 0xD2, 0x57, 0xC1, 0x4A, 0x47, 0xBF, 
+0x50, 0xE6, 0xBD, 0xA9, 0xC6, 0xC9, 0x9D, 0xDE, 
+0x86, 0xE5, 0x86, 0x95, 0x77, 0xF5, 0xBF, 0xBD, 
+0x00, 0x02, 0x2D, 0xA3, 0xAD, 0x3F, 0xC7, 0xFB, 
+0x4E, 0x78, 0xF1, 0xE7, 0x2E, 0xDC, 0x9A, 0x8D, 
+0x80, 0xB8, 0xED, 0x3E, 0x0F, 0x1D, 0x80, 0x37, 
+0xA6, 0x16, 0x79, 0x97, 0xBC, 0x4D, 0xE0, 0x1C, 
+0x12, 0x4F, 0x2F, 0x37, 0x50, 0x5B, 0xA7, 0xBD, 
+0xD7, 0xCF, 0x42, 0xCE, 0xF3, 0x04, 0x7D, 0x69, 
+0x56, 0x3C, 0xA9, 0x05, 0xC5, 0x69, 0xC7, 0x80, 
+0xF7, 0xCA, 0x01, 0xFD, 0x99, 0x91, 0x5C, 0x5E, 
+0x11, 0x62, 0xD0, 0x28, 0x83, 0x6C, 0xB3, 0xFD, 
+0x19, 0xA1, 0xB2, 0xB5, 0x46, 0x80, 0x71, 0x5E, 
+0x8B, 0xC7, 0x30, 0x88, 0xE4, 0x41, 0x9A, 0x08, 
+0x21, 0x68, 0x5A, 0xDE, 0x7A, 0x6C, 0x34, 0xB9, 
+0xDF, 0x45, 0x06, 0x57, 0x0D, 0x13, 0xB5, 0x95, 
+0x86, 0x3C, 0xF1, 0x0D, 0x6C, 0xCF, 0x25, 0x2E, 
+0xA8, 0xC0, 0xA5, 0x3B, 0x58, 0x39, 0x57, 0x7C, 
+0x6E, 0x54, 0x4C, 0xDA, 
+
+0x18, 0x89, 0xA2, 0xB4, 0x30, 0x17, 0x26, 0xF1, 
+0x1C, 0xAC, 0xAF, 0x53, 0x7C, 0x14, 0xAE, 0x25, 
+0x70, 0x3A, 0x4A, 0x30, 0x70, 0x71, 0x81, 0xB9, 
+0x78, 0x4D, 0xE6, 0x8F, 0x57, 0xE5, 0xF6, 0x7B, 
+0x5B, 0x3D, 0x03, 0xC5, 0x59, 0xA3, 0xF7, 0x96, 
+0xAC, 0x4C, 0x5D, 0x0C, 0xDD, 0x7D, 0xE9, 0x06, 
+0x5C, 0xA3, 0x6A, 0x64, 0x29, 0xC6, 0xF0, 0xA7, 
+0xC3, 0x7D, 0x0E, 0xCD, 0x3C, 0xFC, 0xEA, 0x97, 
+0xF0, 0x11, 0x8E, 0x5F, 0x78, 0xEE, 0x52, 0x49, 
+0xD6, 0x69, 0x8D, 0xE4, 0x18, 0x9C, 0xD9, 0x75, 
+0x85, 0xE0, 0x94, 0x81, 0xC4, 0xF2, 0x8D, 0x3A, 
+0x74, 0x03, 0xC8, 0x88, 0xE1, 0x94, 0xAA, 0x2E, 
+0x5A, 0x97, 0xF0, 0xE6, 0x84, 0x34, 0xA3, 0x6F, 
+0xE0, 0xF9, 0x0D, 0x9D, 0x75, 0x10, 0xBC, 0xB2, 
+0x58, 0xEE, 0x78, 0x62, 0x64, 0x58, 0xA7, 0x8F, 
+0xD1, 0x2F, 0x8A, 0x6B, 0xA3, 0xA9, 0x00, 0x69, 
+0xF8, 0x90, 0x48, 0x3B, 0xD0, 0xC0, 0x4C, 0x96, 
+0x62, 0x88, 0xA6, 0x7A, 0x09, 0x1C, 0xF7, 0x1E, 
+0xC4, 0x14, 0x5D, 0x97, 0xF2, 0x5E, 0xA6, 0x1A, 
+0xC5, 0x67, 0x3F, 0x59, 0x0E, 0x1E, 0xEB, 0x8A, 
+0xAD, 0x2B, 0x77, 0xDE, 0xDC, 0xA2, 0xF4, 0x60, 
+0x0A, 0x81, 0x05, 0x32, 0x29, 0xFB, 0xCE, 0x85, 
+0x7B, 0x12, 0x49, 0xD8, 0xFE, 0xB9, 0xDF, 0x35, 
+0x0D, 0x34, 0x70, 0xF6, 0xD4, 0x2B, 0x29, 0x92, 
+0xAD, 0xB5, 0x50, 0xC3, 0x9B, 
+
+0xC4, 0x0C, 0xF2, 0xA1, 0xF4, 0xED, 0xC3, 0x9B, 
+0x2A, 0x71, 0x33, 0x73, 0x03, 0xA2, 0x35, 0xD8, 
+0xB4, 0xB7, 0x23, 0x49, 0xAC, 0x6B, 0x1E, 0x17, 
+0x93, 0x03, 0x6C, 0x96, 0x97, 0xD5, 0x69, 0x39, 
+0x2F, 0x56, 0x8C, 0x00, 0xE8, 0xE7, 0x3F, 0x2B, 
+0xF1, 0x7A, 0x9F, 0x0D, 0x5B, 0xA0, 0x5A, 0x84, 
+0x7A, 0x31, 0x66, 0x7B, 0x84, 0x38, 0xAA, 0x40, 
+0xB3, 0x55, 0xB7, 0xD4, 0xA0, 0x23, 0x3C, 0x4B, 
+0xDA, 0x87, 0x85, 0xC1, 0x70, 0x58, 0xF8, 0x64, 
+0x92, 0x43, 0x7F, 0xD8, 0x94, 0x2A, 0xB6, 0x91, 
+0xAB, 0xDE, 0xE3, 0xAF, 0x09, 0x4C, 0xEB, 0xBC, 
+0x74, 0xD8, 0x7D, 0x8B, 0x3A, 0x15, 0xF7, 0xC4, 
+0x3E, 0xA7, 0x57, 0xF1, 0x31, 0x21, 0xC5, 0xF5, 
+0xE2, 0x73, 0x22, 0x65, 0x13, 0xC0, 0xED, 0x6C, 
+0x0C, 0xC0, 0x6C, 0xA5, 0xDB, 0x2A, 0xD7, 0x17, 
+0x3A, 0x23, 0xAF, 0xA7, 0xF5, 0x1B, 0xC2, 0x59, 
+0x24, 0x8D, 0xBC, 0x75, 0x4C, 0xF8, 0x2B, 0xFB, 
+0x28, 0xE5, 0x00, 0xE7, 0xAB, 0xCE, 0xAF, 0x29, 
+0x3F, 0xB7, 0xC4, 0x0F, 0xCD, 0x58, 0x54, 0xD8, 
+0x97, 0x99, 0x04, 0xA1, 0x4D, 0x95, 0x26, 0x45, 
+0x11, 0x42, 0xDB, 0x2E, 0x6B, 0xE1, 0x19, 0xD5, 
+0xAF, 0x59, 0xC6, 0x70, 0x07, 0x4F, 0x80, 0x3F, 
+0xB3, 0x5D, 0x2B, 0x45, 0xC2, 0x72, 0x44, 0x63, 
+0xB4, 0x4E, 0x48, 0xAD, 0x59, 0x3A, 0x6E, 0x0B, 
+0xA6, 0x38, 0xCA, 0xA4, 0xBF, 0x80, 
+
+0xA6, 0x72, 0x43, 0x51, 0x28, 0x7D, 0x51, 0xFF, 
+0xB2, 0xE1, 0xD6, 0x2A, 0x74, 0x0C, 0xC6, 0x5B, 
+0x7C, 0x30, 0xB5, 0x6C, 0x02, 0xCC, 0xEF, 0x5E, 
+0x9B, 0xA7, 0x23, 0xF1, 0xB3, 0xDD, 0x80, 0x41, 
+0xF8, 0xEE, 0x24, 0x43, 0x42, 0xBC, 0x7F, 0xCB, 
+0x4D, 0xFD, 0xFF, 0x66, 0x6C, 0x0D, 0x88, 0x4D, 
+0xD9, 0xDD, 0x7E, 0x59, 0xF0, 0xC6, 0xE9, 0x3F, 
+0x63, 0x05, 0x09, 0x4F, 0x59, 0xEC, 0x39, 0xD1, 
+0xBD, 0x51, 0x72, 0x41, 0xFA, 0xC2, 0xD4, 0x3D, 
+0x35, 0x72, 0xAA, 0xCE, 0xED, 0x9D, 0x67, 0x2C, 
+0xCA, 0x33, 0xAC, 0x91, 0xE9, 0x52, 0xF9, 0xB9, 
+0x72, 0xD7, 0x4E, 0x29, 0xC6, 0xB8, 0x61, 0xEB, 
+0x5A, 0xA9, 0x76, 0xBC, 0x2F, 0xC7, 0x2B, 0x2A, 
+0x85, 0xE3, 0x6F, 0x1D, 0xFD, 0x2B, 0xC5, 0xE8, 
+0x61, 0xBE, 0xD4, 0x4E, 0x55, 0xBB, 0x98, 0xEC, 
+0xF2, 0x11, 0x2B, 0x11, 0x54, 0x75, 0x2F, 0x00, 
+0x6D, 0x63, 0x4B, 0x9E, 0x3D, 0x07, 0x76, 0xAD, 
+0xB6, 0xC6, 0xA5, 0x31, 0x9C, 0xDC, 0x33, 0xD0, 
+0x6F, 0x64, 0x39, 0x4F, 0x81, 0x56, 0x6B, 0xA7, 
+0xE7, 0xF1, 0x6D, 0xEA, 0xBB, 0xFD, 0xA8, 0xD6, 
+0x29, 0x9B, 0x1E, 0xA4, 0x2C, 0x7E, 0x3F, 0xC2, 
+0x80, 0x47, 0x6F, 0x5C, 0x69, 0xC3, 0xEE, 0x22, 
+0xB1, 0xC8, 0xDA, 0x58, 0x07, 0x9A, 0x09, 0xC3, 
+0x95, 0x73, 0x8D, 0xA2, 0x74, 0x80, 0x58, 0x13, 
+0x52, 0x62, 0x98, 0xB7, 0x0F, 0x31, 0xCA, 
+
+0xAB, 0x00, 0x07, 0x94, 0x86, 0x99, 0xAC, 0x1C, 
+0x78, 0xC9, 0xCB, 0x7C, 0x77, 0xC9, 0xB2, 0x8F, 
+0xCB, 0xA5, 0xBB, 0xFF, 0xB3, 0x56, 0xDC, 0x85, 
+0x45, 0xC5, 0xD7, 0x96, 0xD7, 0x54, 0x43, 0x4C, 
+0xF6, 0x77, 0x63, 0x07, 0xE8, 0x5D, 0xFA, 0x10, 
+0x12, 0x96, 0x37, 0x2F, 0x5F, 0xA4, 0x4D, 0x5A, 
+0x37, 0x58, 0x70, 0x32, 0x4D, 0x8E, 0x46, 0xF1, 
+0x2B, 0x6D, 0xD2, 0x6C, 0x5D, 0x04, 0xF9, 0x6A, 
+0x6A, 0x42, 0xD8, 0xED, 0xCE, 0xB0, 0xA1, 0x08, 
+0x0C, 0x49, 0x13, 0xC0, 0xDD, 0x56, 0x03, 0xF4, 
+0x76, 0x69, 0xB3, 0x29, 0xCF, 0xAA, 0xE8, 0xBF, 
+0x31, 0xA1, 0x93, 0x40, 0x5B, 0xA3, 0x3D, 0x3F, 
+0x97, 0x03, 0x9D, 0xF6, 0x0E, 0x42, 0x9C, 0xFF, 
+0x68, 0x26, 0xDF, 0xF0, 0x6C, 0x3C, 0x2D, 0x2E, 
+0xC2, 0xE5, 0xA9, 0xA5, 0xAC, 0xFD, 0x18, 0x66, 
+0xC3, 0x96, 0xDC, 0x73, 0x41, 0x3E, 0x4C, 0x79, 
+0xC6, 0x47, 0x4C, 0x16, 0xA3, 0xD8, 0x53, 0xE7, 
+0xE6, 0x46, 0x3D, 0x4A, 0x64, 0xD6, 0x31, 0x1A, 
+0x00, 0x3A, 0x24, 0xC1, 0xF4, 0xC2, 0xF8, 0xE0, 
+0x63, 0xC5, 0x94, 0x0F, 0xD8, 0x43, 0x16, 0xA3, 
+0xA9, 0xAA, 0x1C, 0xA3, 0xC8, 0x0A, 0xEA, 0xB3, 
+0xFD, 0xDC, 0xC6, 0x36, 0x9E, 0x3A, 0xDF, 0x2A, 
+0x10, 0x02, 0x2D, 0xA8, 0x24, 0xDE, 0xCF, 0x81, 
+0x06, 0xBD, 0xC9, 0xC2, 0xA1, 0xAC, 0x5E, 0x84, 
+0xE2, 0xAC, 0x41, 0xFD, 0xD7, 0x28, 0x0A, 0x97, 
+
+0xBB, 0xB3, 0x76, 0xD5, 0xF3, 0x14, 0x27, 0x9B, 
+0xDA, 0x19, 0x53, 0x06, 0x57, 0x0F, 0x66, 0xAF, 
+0x57, 0xB7, 0xAE, 0x69, 0x82, 0x73, 0x40, 0x2B, 
+0x4E, 0x54, 0x8D, 0xAD, 0xBA, 0xEC, 0x40, 0x7F, 
+0xD7, 0x51, 0x09, 0x69, 0x84, 0x12, 0x4E, 0x69, 
+0xAF, 0xD6, 0x09, 0x03, 0x23, 0x32, 0x40, 0xC9, 
+0x14, 0x36, 0x80, 0x48, 0x6E, 0xB2, 0xA8, 0x11, 
+0x49, 0xB0, 0x23, 0xE6, 0x4B, 0x43, 0x8C, 0x47, 
+0xF8, 0xC7, 0x0B, 0x95, 0x25, 0x10, 0x38, 0x96, 
+0x35, 0x2B, 0xE7, 0xC5, 0x8E, 0xCF, 0x42, 0x9E, 
+0xF0, 0x21, 0xA4, 0x55, 0x56, 0x62, 0xE0, 0x68, 
+0x97, 0x01, 0xC0, 0x5D, 0x0C, 0x01, 0xD4, 0x6D, 
+0x66, 0xD1, 0x30, 0x3C, 0x3B, 0x0A, 0x67, 0x43, 
+0x41, 0x8F, 0x27, 0xEB, 0x4A, 0xCA, 0x60, 0xFC, 
+0xB5, 0x18, 0x33, 0x69, 0x2F, 0xFC, 0x6E, 0x6C, 
+0xA2, 0xF0, 0x29, 0x87, 0xBA, 0xFC, 0x9B, 0x43, 
+0xF1, 0xA8, 0xB1, 0x05, 0x02, 0x76, 0x7C, 0xD8, 
+0x4D, 0x30, 0x14, 0x3B, 0x38, 0x95, 0xD6, 0xDF, 
+0x2E, 0xB4, 0x5A, 0xBC, 0x85, 0xB7, 0x97, 0x57, 
+0xAE, 0x46, 0x08, 0x8A, 0xAD, 0xF8, 0x51, 0x11, 
+0x02, 0x39, 0x3D, 0xAB, 0xD1, 0x6D, 0xC0, 0x2E, 
+0x76, 0x20, 0x6A, 0x0C, 0xA0, 0x30, 0x66, 0x16, 
+0x96, 0x72, 0xD9, 0x64, 0x23, 0xA9, 0x3C, 0xB2, 
+0x02, 0x9D, 0x7A, 0x6F, 0xD5, 0x86, 0x5C, 0xDF, 
+0x81, 0x7D, 0x3E, 0x34, 0x09, 0x48, 0x16, 0xE4, 
+0xC5, 
+
+0x78, 0x08, 0x25, 0x59, 0xDE, 0x61, 0x7C, 0x4B, 
+0xD8, 0x16, 0x9E, 0x5A, 0x13, 0x99, 0x28, 0x38, 
+0x1C, 0x60, 0x46, 0x49, 0x5F, 0x24, 0x6A, 0x11, 
+0x64, 0xA4, 0xDA, 0xB1, 0xB4, 0x6E, 0xBC, 0x2A, 
+0xA9, 0xAA, 0xC0, 0x38, 0xD6, 0x5C, 0x48, 0xA7, 
+0x9A, 0x37, 0xE3, 0xAC, 0x6E, 0x68, 0x47, 0x36, 
+0x64, 0x0C, 0x3E, 0xA9, 0x9F, 0x7F, 0x3D, 0xD1, 
+0x14, 0xED, 0x3A, 0x88, 0x08, 0x1B, 0x90, 0xD5, 
+0x8C, 0x2F, 0xDB, 0x4A, 0xC0, 0xA7, 0x41, 0x72, 
+0x6B, 0xC9, 0xC3, 0xBB, 0x72, 0xFF, 0x87, 0xD7, 
+0xD2, 0x13, 0x70, 0xC7, 0x64, 0xBE, 0x3B, 0x11, 
+0xA4, 0xFF, 0x05, 0x25, 0xE5, 0x25, 0x52, 0x74, 
+0x68, 0xA4, 0xAD, 0x57, 0xDA, 0x03, 0xC6, 0xEB, 
+0x21, 0x92, 0xB3, 0x62, 0x31, 0x6B, 0xFC, 0xC1, 
+0x1B, 0x10, 0xE9, 0x6B, 0xC7, 0x4A, 0x2E, 0x7A, 
+0xD7, 0x51, 0x70, 0x88, 0xD0, 0x02, 0x0F, 0x34, 
+0x43, 0xA0, 0x8F, 0x15, 0xDF, 0xE6, 0xED, 0x71, 
+0x44, 0x9B, 0xA2, 0xCA, 0x54, 0x75, 0xBB, 0x51, 
+0x96, 0x33, 0xD0, 0xCF, 0xFD, 0xBF, 0x63, 0x45, 
+0x23, 0x30, 0xD7, 0x60, 0x2C, 0x01, 0xC5, 0x98, 
+0x77, 0xFF, 0x72, 0x86, 0x8C, 0x65, 0x11, 0xE7, 
+0xAB, 0xC6, 0x6D, 0xF7, 0x38, 0x33, 0x36, 0xFA, 
+0x4E, 0x16, 0x9F, 0x68, 0x22, 0x36, 0xE7, 0x1C, 
+0x0C, 0xCA, 0x0F, 0x09, 0x50, 0xD8, 0x2E, 0x65, 
+0x2A, 0x58, 0xFE, 0xC9, 0x22, 0x96, 0x78, 0x8B, 
+0xFF, 0x94, 
+
+0x1D, 0xF9, 0xB8, 0xD0, 0xF5, 0xDD, 0x68, 0xD2, 
+0xBC, 0xEF, 0x80, 0x07, 0x83, 0x4C, 0x5C, 0xAE, 
+0xEA, 0x7D, 0x0F, 0xF6, 0xFF, 0xF9, 0x31, 0xBC, 
+0xD2, 0x6D, 0x05, 0x06, 0x44, 0xA1, 0xBA, 0x7F, 
+0x8B, 0x02, 0x43, 0x69, 0x40, 0x2D, 0x80, 0x8E, 
+0x6C, 0x39, 0xF4, 0xBE, 0xB7, 0xE1, 0x48, 0x12, 
+0xE8, 0xEF, 0x99, 0x64, 0xD8, 0x20, 0xAE, 0x86, 
+0x53, 0xC7, 0xB4, 0xC0, 0x01, 0xB6, 0x4E, 0x8C, 
+0x86, 0x74, 0x0C, 0x23, 0x62, 0x09, 0xDE, 0x11, 
+0x2F, 0x74, 0xCC, 0x4F, 0x6C, 0xAE, 0x9A, 0xD1, 
+0x16, 0x0E, 0x4F, 0x9B, 0x78, 0xC7, 0x27, 0xBC, 
+0x74, 0x90, 0x41, 0x54, 0xEB, 0x09, 0xC9, 0x1F, 
+0xD1, 0x24, 0xD1, 0x7A, 0x11, 0x37, 0x39, 0xDE, 
+0x30, 0xF3, 0xE5, 0x17, 0x08, 0x8E, 0x55, 0x7B, 
+0x4C, 0xCE, 0x9A, 0xD4, 0x4A, 0xC5, 0xD7, 0x59, 
+0xF4, 0x5E, 0x00, 0x15, 0x55, 0xC9, 0x5B, 0xC7, 
+0xD9, 0x99, 0x38, 0xB7, 0xF0, 0x6B, 0x54, 0x82, 
+0x28, 0xDF, 0xF7, 0xA1, 0x4C, 0xE9, 0xB2, 0x92, 
+0x1C, 0x70, 0xD6, 0xBF, 0x14, 0x2F, 0x20, 0xBD, 
+0x3B, 0x7E, 0x56, 0xE9, 0x34, 0xE0, 0xCD, 0xEC, 
+0x05, 0x51, 0x08, 0x41, 0x25, 0xBA, 0x54, 0x4F, 
+0x56, 0x4B, 0x63, 0x29, 0xC6, 0xDD, 0x04, 0xE9, 
+0x7D, 0x5A, 0x8B, 0xD6, 0xFF, 0xB5, 0x1D, 0xF3, 
+0x64, 0x29, 0x59, 0x94, 0x47, 0x56, 0xC2, 0xE8, 
+0xAA, 0x0D, 0x29, 0xD3, 0x9D, 0x8D, 0x5F, 0x93, 
+0x56, 0xDA, 0x44, 
+
+0x51, 0xEA, 0x08, 0x64, 0x05, 0x0D, 0x27, 0x73, 
+0x78, 0xCF, 0xBF, 0x72, 0x67, 0xDB, 0xB2, 0x2E, 
+0x1E, 0xA8, 0x11, 0xFD, 0x42, 0x0F, 0xBE, 0x36, 
+0x40, 0x97, 0x07, 0x62, 0x80, 0xFB, 0xAD, 0xC4, 
+0xD4, 0xB8, 0xED, 0x96, 0xB5, 0xD8, 0x5F, 0xFD, 
+0xA6, 0xF8, 0xDB, 0x93, 0x1D, 0x59, 0x2F, 0xE7, 
+0x2C, 0x35, 0x9F, 0xCC, 0x33, 0xA1, 0xEC, 0xBC, 
+0x83, 0x26, 0x92, 0xF5, 0x55, 0xA8, 0x02, 0xE1, 
+0x7A, 0xC3, 0x94, 0x2D, 0x30, 0xD5, 0x6F, 0x85, 
+0xE5, 0xD6, 0xB8, 0xFF, 0x9F, 0xBD, 0xDF, 0x6A, 
+0x92, 0xE0, 0x15, 0x08, 0x36, 0x01, 0x41, 0xB4, 
+0xA8, 0x84, 0xAF, 0x41, 0x88, 0xF0, 0x96, 0x70, 
+0xE5, 0x57, 0x72, 0x6E, 0x11, 0x46, 0x43, 0x46, 
+0x93, 0x95, 0xF9, 0x78, 0x53, 0xB3, 0x84, 0x3B, 
+0x47, 0x8D, 0xE6, 0x28, 0x9D, 0x2F, 0x49, 0x42, 
+0xE3, 0x09, 0x75, 0x2D, 0x37, 0x65, 0x37, 0x79, 
+0x47, 0x4C, 0xDA, 0x20, 0x5A, 0x6E, 0x9F, 0xDE, 
+0xD9, 0x2E, 0x82, 0xAC, 0x20, 0xD5, 0x85, 0x42, 
+0x01, 0xDA, 0xFD, 0x61, 0x7E, 0x97, 0xA9, 0xBA, 
+0x44, 0xFE, 0xAD, 0x76, 0x79, 0xAB, 0x54, 0x1A, 
+0xAC, 0xDF, 0x28, 0x98, 0x1B, 0x2A, 0xCB, 0x9F, 
+0xD0, 0x50, 0x6F, 0xC4, 0x3C, 0xD4, 0x3F, 0x06, 
+0x8B, 0xC4, 0x49, 0x24, 0xC8, 0x44, 0xE0, 0x39, 
+0xB1, 0x71, 0x31, 0xED, 0x43, 0x0A, 0xCF, 0x23, 
+0xA0, 0x84, 0x56, 0x38, 0xAA, 0x84, 0x20, 0xCF, 
+0x5F, 0xEE, 0xEC, 0x7D, 
+
+0x06, 0x6C, 0x60, 0xC3, 0x05, 0x67, 0xA7, 0xCC, 
+0xAE, 0xEB, 0x64, 0x6E, 0x10, 0x5D, 0x6E, 0x79, 
+0x92, 0x77, 0x23, 0x37, 0x2A, 0x67, 0x5A, 0x48, 
+0x98, 0xDD, 0x42, 0xF0, 0x56, 0xDF, 0xE2, 0x27, 
+0x22, 0x8D, 0xE4, 0x25, 0xC2, 0x35, 0xEF, 0x4F, 
+0x43, 0x08, 0x07, 0xE7, 0x9B, 0x7C, 0xE0, 0xBD, 
+0xB6, 0xFB, 0xC7, 0x58, 0xD4, 0x63, 0x67, 0x49, 
+0x17, 0x58, 0xE1, 0x6A, 0x53, 0x9D, 0x28, 0x4F, 
+0x7B, 0x3B, 0xEF, 0x6E, 0xBA, 0xB6, 0xA4, 0x02, 
+0x81, 0xF4, 0x9B, 0xDB, 0xF4, 0x6A, 0xC3, 0xC9, 
+0xE6, 0xA3, 0x1D, 0x98, 0x62, 0x1C, 0x4B, 0xE1, 
+0x1A, 0x46, 0x4B, 0x50, 0x43, 0xCD, 0x09, 0xBB, 
+0x8C, 0xE5, 0xBB, 0x2D, 0x1F, 0x92, 0x97, 0x43, 
+0x91, 0x5D, 0xDD, 0x01, 0x93, 0xD4, 0x89, 0x63, 
+0x41, 0xE4, 0xF6, 0xCE, 0xA5, 0x3B, 0x13, 0xD9, 
+0x1C, 0x67, 0x6A, 0x0A, 0xBB, 0x0C, 0x74, 0x4A, 
+0x52, 0x63, 0x65, 0x5E, 0x6D, 0xD7, 0xD3, 0xF5, 
+0x4B, 0xCC, 0x7C, 0x8F, 0x6A, 0xF3, 0x8B, 0x53, 
+0xA3, 0x89, 0x23, 0xB0, 0x86, 0xA1, 0xE0, 0x8C, 
+0xF4, 0x23, 0xFF, 0x7F, 0xEF, 0x35, 0xF6, 0xF2, 
+0x85, 0x57, 0x9F, 0xDA, 0xD9, 0x02, 0x60, 0xB6, 
+0xC1, 0x55, 0x68, 0xFC, 0x35, 0xBC, 0x0E, 0xAB, 
+0x2C, 0x23, 0x4C, 0xB7, 0x59, 0xCB, 0xEF, 0xAC, 
+0x94, 0x19, 0xF2, 0xE3, 0x25, 0x3B, 0x9E, 0x81, 
+0x0E, 0xAE, 0x3F, 0x45, 0x9D, 0x10, 0x17, 0x2A, 
+0x1C, 0xAB, 0x31, 0x4D, 0xE8, 
+
+0x09, 0xE2, 0x5A, 0x04, 0x6E, 0xBA, 0x19, 0x48, 
+0x5C, 0x4D, 0x37, 0x7E, 0x9F, 0xAA, 0xCC, 0xA4, 
+0x21, 0xD0, 0x3C, 0xBE, 0xE4, 0x7A, 0x40, 0x18, 
+0x10, 0x86, 0x3B, 0xC2, 0xDA, 0xA3, 0x90, 0x40, 
+0x12, 0x1E, 0x27, 0x27, 0xB9, 0x7F, 0xAF, 0xD4, 
+0x5D, 0xC0, 0xF8, 0x75, 0x02, 0x30, 0xBD, 0x55, 
+0xB8, 0x37, 0xBD, 0x53, 0x3A, 0x5A, 0x2C, 0x71, 
+0xB7, 0xBF, 0x66, 0xD9, 0x82, 0xC3, 0x1A, 0xBE, 
+0x82, 0xE9, 0xEE, 0x79, 0x6F, 0x36, 0xEF, 0xEF, 
+0x77, 0x88, 0x03, 0xF5, 0x79, 0x7A, 0x72, 0x8D, 
+0x4D, 0x42, 0x65, 0x40, 0xEC, 0x7C, 0x09, 0x8E, 
+0x8D, 0xF7, 0x46, 0x95, 0x48, 0x2E, 0xFF, 0x4C, 
+0x19, 0x78, 0xA9, 0x8D, 0x3E, 0xA2, 0x7B, 0x74, 
+0x66, 0xE1, 0x15, 0xC1, 0xD6, 0x0C, 0xAE, 0xE1, 
+0x52, 0xA8, 0x52, 0xAF, 0x2E, 0x98, 0x04, 0x79, 
+0x50, 0x8A, 0x7C, 0xE0, 0x4C, 0x08, 0xAC, 0x2B, 
+0xF5, 0x3A, 0x99, 0x8D, 0xD0, 0x28, 0xE8, 0x0C, 
+0x90, 0x6A, 0x1A, 0x52, 0xD1, 0xDA, 0x34, 0xFB, 
+0x57, 0x6C, 0x3F, 0x07, 0x79, 0x07, 0x39, 0x11, 
+0xAA, 0x27, 0xB6, 0x7D, 0x81, 0xF3, 0x6D, 0x7C, 
+0xF3, 0xFF, 0x14, 0xC9, 0x27, 0x4A, 0x8E, 0xE1, 
+0x41, 0x86, 0xA0, 0x9D, 0xC1, 0xCF, 0x99, 0x92, 
+0x7D, 0x82, 0xC7, 0xF3, 0x06, 0xBB, 0x77, 0x91, 
+0xC2, 0xE6, 0xA4, 0x4D, 0x1D, 0xB1, 0xDE, 0xAA, 
+0xD1, 0x01, 0x6A, 0x25, 0xB5, 0x88, 0xDE, 0x85, 
+0x26, 0x00, 0x48, 0xB1, 0x68, 0x43, 
+
+0x61, 0xA6, 0x53, 0x78, 0xCE, 0x44, 0xFA, 0x05, 
+0x83, 0xE8, 0xD5, 0xC3, 0xFB, 0x5C, 0x4B, 0xE7, 
+0x2E, 0x56, 0xCC, 0xC2, 0xF8, 0x8D, 0x80, 0xFC, 
+0xD2, 0xF4, 0xFD, 0x26, 0x3E, 0x69, 0x4D, 0x10, 
+0xE5, 0x24, 0x5A, 0x6C, 0x62, 0xD0, 0xCC, 0xC2, 
+0xB3, 0x83, 0x2C, 0xE5, 0x1F, 0x62, 0x7F, 0x42, 
+0x29, 0x0B, 0xE2, 0x89, 0x07, 0x40, 0xD1, 0x2E, 
+0xAE, 0x34, 0x6F, 0x4F, 0x58, 0x23, 0x97, 0x28, 
+0xC1, 0x4E, 0xBF, 0xD1, 0xB2, 0x7A, 0x25, 0xC5, 
+0x89, 0x68, 0x35, 0xB1, 0xC4, 0xAE, 0x87, 0x27, 
+0x30, 0xDC, 0x64, 0x1C, 0xF2, 0x77, 0x89, 0xD2, 
+0x09, 0x53, 0xEA, 0x4B, 0x94, 0xBA, 0x90, 0x6D, 
+0x97, 0xDB, 0xDB, 0x64, 0x26, 0xA0, 0x9F, 0xCE, 
+0x76, 0xFD, 0xA0, 0xDF, 0x27, 0xB6, 0x1E, 0x8D, 
+0xB1, 0x68, 0x49, 0x85, 0x3F, 0x70, 0x14, 0x42, 
+0xAC, 0xFF, 0x18, 0x86, 0x92, 0x99, 0x53, 0xD4, 
+0xF0, 0x12, 0x3A, 0x0F, 0x42, 0xEC, 0x26, 0x16, 
+0x13, 0x5C, 0x23, 0x1A, 0x9A, 0x22, 0x7A, 0x8C, 
+0xEB, 0xF9, 0x5A, 0xF6, 0x85, 0xCC, 0x7B, 0x06, 
+0xDF, 0x8A, 0x53, 0x0E, 0xF7, 0x2F, 0x8A, 0x24, 
+0x7E, 0xD0, 0x1F, 0xEC, 0xE6, 0x17, 0x9E, 0x14, 
+0x4C, 0x80, 0xB0, 0x08, 0xE3, 0x33, 0x95, 0x2C, 
+0x85, 0x31, 0x80, 0xB3, 0xE7, 0x01, 0xF6, 0xC5, 
+0x7E, 0x01, 0x1C, 0x70, 0x3C, 0xC0, 0x91, 0xF7, 
+0x67, 0xA0, 0x7A, 0x36, 0x64, 0x40, 0xEA, 0xF4, 
+0x79, 0x83, 0x46, 0xFA, 0x8D, 0xE5, 0xE8, 
+
+0xB3, 0x57, 0x83, 0x6E, 0xA4, 0xA8, 0x34, 0x1C, 
+0x98, 0x42, 0x9A, 0xF5, 0x09, 0xF1, 0x65, 0xD8, 
+0x88, 0x87, 0x42, 0x3C, 0xD7, 0xBE, 0x8E, 0xCD, 
+0xCD, 0x58, 0x45, 0x38, 0x36, 0x7F, 0xF6, 0x7E, 
+0x84, 0x60, 0x00, 0xBF, 0x05, 0x6E, 0x68, 0x6B, 
+0xCC, 0x3A, 0x92, 0xEC, 0x80, 0x38, 0xDF, 0x64, 
+0x67, 0x2E, 0x0A, 0xB9, 0xF0, 0x3E, 0x18, 0x5D, 
+0x09, 0x0D, 0xE8, 0x07, 0x86, 0x0E, 0x1B, 0x8D, 
+0xAB, 0xA4, 0xE9, 0x1A, 0x08, 0x76, 0x3A, 0xA5, 
+0xD5, 0x63, 0x29, 0xB7, 0xD5, 0x6F, 0xFB, 0xC1, 
+0x2B, 0x7A, 0xE5, 0x97, 0x22, 0x4F, 0xE3, 0x7C, 
+0xCE, 0xE4, 0x85, 0x36, 0xBF, 0x4B, 0x28, 0x60, 
+0xC3, 0x94, 0xF2, 0xD9, 0x00, 0xF6, 0xC9, 0x52, 
+0xD9, 0xA5, 0x46, 0xAA, 0x5E, 0x21, 0x64, 0xD9, 
+0xE0, 0xD2, 0x25, 0x0D, 0x8D, 0xDE, 0x06, 0xE6, 
+0x63, 0x63, 0x89, 0xC5, 0x7A, 0x43, 0x3C, 0x92, 
+0x45, 0xB0, 0x60, 0xAF, 0x67, 0xBC, 0xB0, 0x4E, 
+0xA7, 0x14, 0x79, 0x50, 0x70, 0x3B, 0xA2, 0x70, 
+0xFC, 0x42, 0x87, 0x57, 0xA6, 0x64, 0xC6, 0x5F, 
+0x92, 0x96, 0x05, 0xAD, 0x64, 0xFD, 0x31, 0x56, 
+0xFF, 0x14, 0xB6, 0x78, 0xEC, 0x15, 0xB9, 0xAE, 
+0x60, 0x62, 0xD6, 0x55, 0x58, 0x53, 0x2D, 0xFD, 
+0xCE, 0xBC, 0xA7, 0xC8, 0xDF, 0x19, 0x9F, 0xA0, 
+0x3C, 0x33, 0xC6, 0x99, 0x59, 0xC1, 0x47, 0x6B, 
+0xCB, 0x61, 0x5B, 0xEF, 0x31, 0x17, 0xAA, 0x8E, 
+0xAB, 0x56, 0xE2, 0x93, 0x26, 0xF6, 0xC2, 0x87, 
+
+0x4A, 0x13, 0x26, 0x58, 0xEA, 0x2F, 0x07, 0x9E, 
+0x68, 0x97, 0xD1, 0xFB, 0xA8, 0xED, 0x23, 0x0F, 
+0x79, 0xD7, 0x43, 0x1C, 0x6F, 0x8F, 0x89, 0x3C, 
+0x57, 0xD7, 0x19, 0x23, 0xA5, 0xA2, 0x65, 0xBA, 
+0x9F, 0xFA, 0x55, 0x4D, 0x74, 0x33, 0x40, 0xEA, 
+0x60, 0x9C, 0x54, 0xBC, 0x73, 0x2D, 0x9A, 0xAC, 
+0x93, 0x1B, 0x5E, 0xEB, 0x99, 0xD1, 0x22, 0xAC, 
+0x31, 0xBB, 0xA2, 0xBD, 0xE4, 0x14, 0xED, 0xE0, 
+0x44, 0xF0, 0xEC, 0x31, 0x30, 0xFC, 0xD7, 0xC2, 
+0x6C, 0x6B, 0xF1, 0x63, 0x75, 0xB4, 0x30, 0x30, 
+0x62, 0x5F, 0x3D, 0x1B, 0xEA, 0x1D, 0xB9, 0xDB, 
+0x6F, 0x79, 0x69, 0x05, 0xB1, 0x1B, 0xB7, 0xC0, 
+0x43, 0x92, 0x3E, 0xAE, 0x9A, 0x74, 0xED, 0x6E, 
+0x71, 0xCB, 0xDA, 0x2D, 0x16, 0xB7, 0xB9, 0x44, 
+0xB7, 0xA0, 0x0D, 0x5D, 0xF4, 0xFE, 0x02, 0x4C, 
+0xAC, 0xAA, 0x25, 0x99, 0xCD, 0x15, 0xEF, 0xB9, 
+0xA1, 0xB8, 0x40, 0xD5, 0x5E, 0x8A, 0x21, 0xB8, 
+0x8E, 0x1E, 0x72, 0xF9, 0x6E, 0x99, 0x4E, 0x3E, 
+0xEF, 0xAD, 0x64, 0x59, 0x5C, 0x13, 0x69, 0xC2, 
+0x6F, 0x17, 0xC8, 0xAE, 0x88, 0xB7, 0x22, 0x96, 
+0xE5, 0x4C, 0x8F, 0x39, 0x3F, 0x72, 0x1C, 0x55, 
+0x71, 0x10, 0x6C, 0x03, 0xFD, 0xF3, 0x56, 0xE7, 
+0xF7, 0xFE, 0x99, 0xCA, 0x5B, 0x97, 0x3B, 0x31, 
+0x32, 0xBB, 0xC3, 0x40, 0x87, 0x71, 0xAF, 0x7E, 
+0x7A, 0xEA, 0x3C, 0x65, 0xD6, 0x73, 0x09, 0x2B, 
+0xD9, 0xF1, 0x38, 0x39, 0xD6, 0x81, 0xE2, 0x50, 
+0x51, 
+
+0x97, 0x37, 0xD9, 0xCD, 0xF7, 0xDF, 0xE2, 0xA0, 
+0xE6, 0x10, 0xB0, 0x6C, 0xF9, 0xBC, 0x40, 0xD8, 
+0x3B, 0x44, 0x18, 0xA2, 0x01, 0x12, 0xB4, 0xD5, 
+0x45, 0xF7, 0xEC, 0xB3, 0x3F, 0x36, 0xA8, 0xE0, 
+0xEF, 0xE9, 0xC3, 0x21, 0x7D, 0xCD, 0x4A, 0x6E, 
+0x5E, 0x96, 0x40, 0x15, 0xD4, 0x6A, 0x52, 0x4D, 
+0x9D, 0x00, 0x48, 0x11, 0xA0, 0x0D, 0x47, 0x3B, 
+0xEA, 0x03, 0xD8, 0xDA, 0x46, 0xFF, 0x77, 0x7C, 
+0xFA, 0xF2, 0xCD, 0x3F, 0x05, 0xB1, 0x1A, 0xB6, 
+0xFB, 0xEB, 0x97, 0x39, 0x43, 0xDC, 0x85, 0x1A, 
+0x4E, 0x74, 0x20, 0xCB, 0xF1, 0x91, 0x67, 0x88, 
+0xA4, 0xB5, 0x9E, 0xE5, 0xD6, 0xE4, 0xAD, 0x46, 
+0x97, 0x84, 0x3D, 0xA1, 0xDA, 0x6E, 0xD7, 0x5A, 
+0x5B, 0x1D, 0x19, 0x98, 0x96, 0x1C, 0xB0, 0xD5, 
+0xAE, 0x85, 0x80, 0x41, 0x3C, 0x91, 0xEA, 0x74, 
+0xCB, 0x4A, 0x12, 0x2D, 0xED, 0xCC, 0xAF, 0x20, 
+0xA7, 0x8F, 0x38, 0x71, 0x50, 0x19, 0x51, 0xE7, 
+0xCA, 0xB6, 0x5B, 0x70, 0x6B, 0x00, 0xB5, 0xA6, 
+0xF4, 0x86, 0xE8, 0xA3, 0xC7, 0x45, 0x38, 0xC9, 
+0x82, 0x6F, 0x31, 0xB0, 0xC0, 0x2B, 0xE6, 0x6D, 
+0x94, 0x00, 0xAC, 0xB3, 0x29, 0xC8, 0x58, 0xED, 
+0xE1, 0xCF, 0x28, 0xF8, 0xB6, 0x02, 0xA0, 0xCA, 
+0x54, 0xFF, 0x19, 0x65, 0x48, 0xDB, 0xD8, 0x85, 
+0x78, 0x25, 0x09, 0xFA, 0x90, 0x39, 0x1E, 0x74, 
+0xC1, 0xA8, 0x51, 0x5B, 0xC2, 0xA0, 0x7E, 0x42, 
+0x91, 0xC5, 0x79, 0x30, 0xEC, 0x14, 0x9F, 0x28, 
+0x46, 0x1E, 
+
+0x02, 0x86, 0x64, 0x7C, 0x96, 0xE0, 0x9D, 0x8D, 
+0x28, 0xF8, 0x8C, 0x1A, 0x7F, 0x53, 0x89, 0x56, 
+0x47, 0x55, 0xF6, 0x34, 0x03, 0xAC, 0xE2, 0xAB, 
+0xA4, 0xC0, 0xE9, 0xBA, 0x9B, 0x63, 0x17, 0x16, 
+0x8F, 0xFF, 0xE3, 0xB4, 0x3D, 0x2E, 0xA7, 0x65, 
+0x7C, 0x7A, 0xDB, 0x67, 0x81, 0x6B, 0x0D, 0xF1, 
+0x93, 0x12, 0xA3, 0x18, 0xCE, 0x90, 0xA2, 0xBD, 
+0x34, 0x52, 0x08, 0xC2, 0xC1, 0x8A, 0xC6, 0x3E, 
+0x64, 0x08, 0xD4, 0x11, 0x3F, 0x27, 0x39, 0x0E, 
+0xA1, 0xAC, 0x49, 0xB1, 0x51, 0x52, 0xD3, 0x20, 
+0xE7, 0x5C, 0x12, 0x71, 0x41, 0x5B, 0x2F, 0xBB, 
+0xA5, 0xF3, 0x34, 0xCD, 0x4E, 0x58, 0x64, 0xFC, 
+0xE7, 0x5D, 0x59, 0x2D, 0x16, 0xE9, 0xE9, 0xC4, 
+0xB4, 0x8E, 0x10, 0x9D, 0x84, 0xD0, 0x7A, 0xAD, 
+0xEF, 0x5B, 0xB8, 0xFA, 0xC0, 0xB9, 0x4E, 0xF6, 
+0x63, 0x79, 0x9F, 0x3A, 0x39, 0xF0, 0xAC, 0x8D, 
+0x5A, 0x89, 0xD2, 0x22, 0x29, 0x46, 0x89, 0x5F, 
+0xA4, 0xB3, 0x8E, 0x72, 0x2A, 0xB9, 0x0E, 0xC8, 
+0xD2, 0x89, 0xB8, 0x24, 0x2E, 0x05, 0x3C, 0xC6, 
+0xC0, 0xCF, 0xE0, 0x00, 0x17, 0xDD, 0xB9, 0x22, 
+0xA5, 0x45, 0x43, 0x9C, 0x7F, 0x3D, 0x27, 0x46, 
+0x45, 0xCC, 0x93, 0x3F, 0x05, 0xA5, 0xEE, 0xB6, 
+0xC7, 0x7C, 0xC4, 0x17, 0xBC, 0x35, 0x33, 0x90, 
+0x2B, 0x21, 0xCD, 0xF9, 0x67, 0x2D, 0x78, 0xA4, 
+0x20, 0x4B, 0x73, 0x7E, 0x14, 0x3F, 0xF5, 0xE4, 
+0x0D, 0xA8, 0xFC, 0x2E, 0x66, 0xF3, 0x7D, 0xE4, 
+0x4E, 0x3A, 0x24, 
+
+0xAC, 0x2C, 0x24, 0x28, 0x08, 0x1E, 0xC6, 0xAF, 
+0xA1, 0x60, 0x33, 0x02, 0xB8, 0x8D, 0x20, 0x0E, 
+0x14, 0xF1, 0x6C, 0x47, 0x80, 0x48, 0x7E, 0x0A, 
+0xE6, 0xF9, 0x43, 0x4C, 0xC3, 0xF4, 0x60, 0x6C, 
+0x00, 0x49, 0xB5, 0xFF, 0x03, 0xA0, 0x19, 0x3C, 
+0x5D, 0x9E, 0xC3, 0x4F, 0x27, 0x9F, 0x00, 0x03, 
+0x42, 0x9B, 0x60, 0x92, 0x88, 0x33, 0x94, 0xA5, 
+0x94, 0xAB, 0xA0, 0xDC, 0x58, 0xC3, 0x41, 0x09, 
+0xCB, 0xEC, 0xF8, 0x96, 0x6D, 0xB6, 0xF6, 0x43, 
+0x33, 0x79, 0x54, 0x2F, 0x2F, 0xF4, 0x20, 0xFC, 
+0x5F, 0x6B, 0xCF, 0x3D, 0x86, 0x8A, 0xE4, 0x7B, 
+0x5F, 0x75, 0x35, 0x1A, 0x9F, 0xB6, 0x9B, 0xDA, 
+0x1F, 0xDE, 0x45, 0xEA, 0xD3, 0x69, 0xD4, 0xB7, 
+0x48, 0xE9, 0x49, 0x1F, 0x9F, 0x8F, 0x4E, 0x0A, 
+0xC5, 0x7A, 0xF3, 0xA3, 0x1A, 0x4F, 0x32, 0x56, 
+0x9A, 0xF2, 0x11, 0x30, 0xFD, 0xAD, 0x67, 0xE7, 
+0x70, 0x0F, 0xD3, 0x92, 0x37, 0x6F, 0x84, 0x27, 
+0xB5, 0x37, 0xB2, 0xDB, 0xE3, 0xF8, 0x2E, 0x0D, 
+0xCC, 0xD6, 0x17, 0xB0, 0xFF, 0x37, 0xDC, 0xF4, 
+0x5E, 0xF2, 0x19, 0xC5, 0x0F, 0x89, 0xC8, 0xF4, 
+0x17, 0x5F, 0x7D, 0x7A, 0x35, 0x8D, 0x0F, 0x96, 
+0xBF, 0x26, 0x71, 0x2C, 0xE3, 0xCA, 0xEB, 0xB8, 
+0x63, 0x25, 0xD6, 0xC2, 0xA8, 0xC5, 0xFD, 0xA0, 
+0xB0, 0x38, 0x5E, 0x30, 0x5E, 0x56, 0xB7, 0xB8, 
+0x3D, 0x6E, 0xDC, 0xC9, 0x29, 0x77, 0x81, 0x74, 
+0x18, 0x0D, 0xD1, 0x40, 0x4C, 0x77, 0x28, 0x46, 
+0xA9, 0x92, 0xEE, 0x1E, 
+
+0xE1, 0xC6, 0xEA, 0xFC, 0xE5, 0x41, 0x48, 0xC2, 
+0x12, 0x39, 0xC5, 0x2B, 0x97, 0x24, 0x9B, 0x7A, 
+0x0A, 0x4C, 0x5A, 0xA7, 0x90, 0x3D, 0xB5, 0xD2, 
+0x3C, 0xE8, 0xF2, 0xF8, 0x4C, 0xAC, 0x30, 0xD0, 
+0x26, 0xED, 0x8B, 0xCA, 0x9E, 0x33, 0xC2, 0x6F, 
+0xAB, 0xF7, 0x49, 0xE8, 0xFB, 0x88, 0xAF, 0xB9, 
+0xDF, 0x74, 0x71, 0x9E, 0xB1, 0x1C, 0x0F, 0x13, 
+0x2D, 0x8F, 0x69, 0x83, 0xBD, 0xD3, 0x55, 0x56, 
+0xFD, 0xFC, 0x8E, 0x9A, 0xF5, 0x68, 0xD5, 0xD1, 
+0xAC, 0xBC, 0x4D, 0x80, 0xB5, 0x71, 0xA1, 0x04, 
+0xBE, 0x98, 0x1C, 0x17, 0xDE, 0xE8, 0xE0, 0x1A, 
+0xE3, 0x96, 0x74, 0x2D, 0xB8, 0x19, 0x9A, 0xBA, 
+0xDE, 0x6C, 0x0A, 0x0D, 0x72, 0x8B, 0x94, 0x66, 
+0x15, 0x20, 0xC7, 0x43, 0xFD, 0xE0, 0x5B, 0xE1, 
+0x81, 0x43, 0x9E, 0x32, 0x5C, 0x0E, 0x2D, 0x43, 
+0x07, 0x6B, 0x55, 0xB6, 0xDA, 0x2D, 0xB7, 0x5F, 
+0xB4, 0xB1, 0x16, 0x8B, 0xAA, 0xF5, 0x49, 0xE5, 
+0xF0, 0xF3, 0xFF, 0x06, 0x21, 0x84, 0xC0, 0x04, 
+0xCC, 0x91, 0x85, 0xB9, 0x34, 0x2A, 0xD0, 0x2E, 
+0xB6, 0x48, 0xB6, 0x1E, 0x8C, 0x54, 0x28, 0x5A, 
+0x12, 0x72, 0x52, 0xB8, 0x89, 0x69, 0x6A, 0x91, 
+0xB1, 0xBD, 0xCE, 0x58, 0xA9, 0x25, 0x14, 0x48, 
+0x55, 0xCA, 0xD2, 0xF3, 0xFF, 0xE7, 0x5C, 0xC7, 
+0x8B, 0xE9, 0xAD, 0x08, 0x2C, 0xCB, 0x71, 0x84, 
+0xEB, 0x5F, 0xEB, 0x4C, 0xBC, 0x8C, 0x23, 0x0F, 
+0xF9, 0xEB, 0xD5, 0x35, 0x2D, 0x20, 0xB3, 0x66, 
+0xC3, 0xAE, 0x58, 0x11, 0xB4, 
+
+0xD8, 0xA3, 0x4E, 0xB6, 0x72, 0x84, 0x9D, 0x75, 
+0xAB, 0x36, 0x96, 0x79, 0x24, 0x50, 0xAF, 0xEA, 
+0x61, 0x48, 0xDE, 0x90, 0x90, 0xB2, 0x14, 0x8E, 
+0x65, 0xE4, 0xD8, 0xDC, 0x18, 0xF5, 0x78, 0x61, 
+0xEC, 0xDA, 0x7A, 0x67, 0x2C, 0x30, 0x3A, 0x3F, 
+0x37, 0xF9, 0xF0, 0x8A, 0x43, 0xC0, 0xF1, 0x3B, 
+0x30, 0x50, 0x62, 0xD7, 0xC5, 0x35, 0x0A, 0xF9, 
+0x6E, 0x7C, 0x91, 0x23, 0x24, 0x84, 0xD9, 0xE0, 
+0xCA, 0xAF, 0x7F, 0xA3, 0xE3, 0x40, 0xB5, 0x9F, 
+0x8F, 0xA8, 0x61, 0x38, 0x76, 0x6E, 0xDF, 0xAA, 
+0x04, 0x94, 0x36, 0xF9, 0xFE, 0xAE, 0x82, 0x30, 
+0xCD, 0xA5, 0xDF, 0x19, 0x4E, 0xAB, 0xD5, 0xF0, 
+0x50, 0xCB, 0x49, 0x7F, 0x78, 0x94, 0x58, 0xB1, 
+0x3A, 0x80, 0xBA, 0x64, 0x23, 0x96, 0xF6, 0xA2, 
+0x9C, 0xA8, 0xD4, 0x73, 0xBF, 0xBF, 0x01, 0xC7, 
+0x75, 0x4A, 0x2D, 0x67, 0x10, 0xD5, 0xDD, 0x5D, 
+0xA5, 0x5C, 0x6D, 0x90, 0xA9, 0xB8, 0xE3, 0xD4, 
+0x99, 0xA6, 0xCF, 0x0E, 0xE0, 0xA4, 0xCA, 0xC1, 
+0x5A, 0xD8, 0xC6, 0x10, 0x76, 0x54, 0xFF, 0xF7, 
+0xB3, 0xB8, 0x80, 0x88, 0x5E, 0xB3, 0x13, 0x1E, 
+0x64, 0x91, 0x57, 0x35, 0xB7, 0xFA, 0x10, 0x6B, 
+0x33, 0xB4, 0xE5, 0x09, 0x28, 0x0E, 0x6E, 0xEF, 
+0x05, 0x46, 0xFB, 0xCA, 0xAE, 0x2F, 0x99, 0x93, 
+0x2C, 0xA2, 0x4E, 0x7C, 0x7B, 0x2F, 0xB7, 0x2F, 
+0x4C, 0x57, 0x62, 0xE9, 0x94, 0x17, 0x4E, 0x3E, 
+0xAB, 0xA5, 0x62, 0x59, 0xDB, 0x0D, 0x25, 0xED, 
+0x8C, 0x57, 0x6B, 0x04, 0x8A, 0x3B, 
+
+0x6F, 0xEC, 0xC3, 0x68, 0xCC, 0xB6, 0x68, 0x51, 
+0xDB, 0x3D, 0x1D, 0x0A, 0x6D, 0xDB, 0xAE, 0x63, 
+0x60, 0x87, 0x4A, 0x2E, 0x22, 0x7C, 0xA8, 0x1D, 
+0xDF, 0xF3, 0xCB, 0x24, 0x05, 0x1E, 0x3B, 0x4D, 
+0x7B, 0x32, 0x84, 0x2C, 0x1B, 0x6E, 0x9C, 0xF2, 
+0x5D, 0xEA, 0x99, 0xDA, 0x01, 0x1F, 0x19, 0x79, 
+0x3F, 0xBC, 0xE3, 0x95, 0x0B, 0xA3, 0x20, 0x38, 
+0x88, 0x2A, 0x08, 0x26, 0x1D, 0x77, 0x48, 0x8A, 
+0x6F, 0x0A, 0xEC, 0xE4, 0x17, 0xC6, 0xC9, 0xFA, 
+0xE9, 0x3E, 0xA9, 0xDC, 0x1E, 0x14, 0xF6, 0xAF, 
+0xB2, 0x7A, 0x51, 0x34, 0xD3, 0xDE, 0x0D, 0x55, 
+0x68, 0x11, 0x78, 0xEC, 0x6C, 0xE0, 0x3C, 0x7C, 
+0x4A, 0xD4, 0xF4, 0x02, 0x2F, 0x10, 0x88, 0xB9, 
+0x0F, 0x56, 0xE5, 0x5E, 0xB3, 0x51, 0x2A, 0x86, 
+0x4A, 0x84, 0x36, 0xBC, 0xAE, 0x96, 0x62, 0x75, 
+0xB4, 0x91, 0xAD, 0xF4, 0xCA, 0xD0, 0x47, 0xAD, 
+0xF6, 0xFE, 0x6A, 0x7E, 0x31, 0x4F, 0x33, 0x52, 
+0xEB, 0x52, 0x89, 0x15, 0xBB, 0x58, 0x4B, 0xDB, 
+0x37, 0x34, 0x47, 0xF0, 0x34, 0x4A, 0x1F, 0xD3, 
+0xBF, 0x2B, 0x6E, 0x2C, 0x84, 0x99, 0xBC, 0x6B, 
+0x8A, 0x4D, 0x82, 0x31, 0x62, 0x8B, 0x09, 0x90, 
+0x9A, 0xF4, 0xE0, 0x19, 0x85, 0xB8, 0x8E, 0x44, 
+0xBB, 0x6F, 0xAF, 0x97, 0x6D, 0x67, 0xD2, 0x5C, 
+0xCF, 0xE3, 0xE1, 0xEE, 0xD8, 0x6A, 0x7A, 0xCA, 
+0x40, 0x81, 0x5B, 0x35, 0xD9, 0xD4, 0x2C, 0xE4, 
+0xD0, 0x7C, 0xA7, 0x0F, 0x39, 0x77, 0x32, 0xB6, 
+0xFA, 0xEC, 0x6A, 0x32, 0x63, 0x59, 0xD9, 
+
+0xFE, 0xE8, 0xD1, 0x34, 0x80, 0x93, 0xE5, 0x1A, 
+0xA1, 0xBC, 0xA2, 0x24, 0x89, 0xF3, 0x76, 0x32, 
+0x56, 0x45, 0x1D, 0xA8, 0xB9, 0x76, 0x8E, 0xD7, 
+0xF6, 0x79, 0x93, 0xA7, 0x7E, 0x98, 0x9F, 0xE8, 
+0x55, 0x1A, 0xFA, 0x75, 0xF2, 0x95, 0x2A, 0x91, 
+0x89, 0xD6, 0x65, 0xFB, 0xD6, 0xA4, 0x55, 0x72, 
+0xC1, 0xAD, 0xA6, 0x78, 0xC1, 0xC7, 0x17, 0x1C, 
+0x1D, 0x1B, 0xA3, 0x09, 0xE6, 0x43, 0x84, 0x8A, 
+0x2F, 0x62, 0x6A, 0xDC, 0xB3, 0x08, 0x18, 0xBF, 
+0xEB, 0xE8, 0x8C, 0x67, 0xFE, 0x33, 0xD5, 0x77, 
+0x91, 0x11, 0x16, 0x3A, 0x2E, 0x49, 0xB0, 0x19, 
+0xEA, 0x14, 0xA0, 0xFC, 0xBD, 0xDF, 0x12, 0x30, 
+0x42, 0x13, 0x6F, 0xE4, 0x4E, 0xE8, 0xC3, 0x44, 
+0x33, 0xFB, 0xF5, 0xA6, 0x02, 0xEE, 0xAB, 0xF9, 
+0x52, 0x37, 0x5B, 0x61, 0x72, 0x28, 0x27, 0x75, 
+0x22, 0xC3, 0xE5, 0x9B, 0x51, 0x0F, 0xA9, 0x69, 
+0x22, 0x3A, 0x54, 0x8E, 0x35, 0x5A, 0x5A, 0x57, 
+0xD2, 0xC7, 0x52, 0xC4, 0x94, 0xE1, 0xA2, 0x26, 
+0xDB, 0x27, 0x63, 0x70, 0x3E, 0xF0, 0x21, 0x12, 
+0x2A, 0x3F, 0x5F, 0xED, 0xFE, 0xF1, 0xA6, 0xA2, 
+0xBD, 0xA5, 0xED, 0x7A, 0x08, 0x24, 0x64, 0xC7, 
+0x32, 0xA6, 0x3F, 0x4F, 0xBF, 0x71, 0x52, 0xCA, 
+0x21, 0x57, 0x73, 0x8A, 0xA7, 0x84, 0x0C, 0xCD, 
+0x44, 0x51, 0xF9, 0xC5, 0x14, 0x0B, 0x3E, 0x15, 
+0xB6, 0x46, 0xAA, 0x5A, 0x14, 0x64, 0x81, 0x25, 
+0xF5, 0x32, 0x81, 0x97, 0x05, 0x90, 0xC8, 0xCC, 
+0x0C, 0x85, 0x1C, 0x26, 0xE3, 0x7E, 0x06, 0xCB, 
+
+0x9C, 0xDE, 0x45, 0xFF, 0xB6, 0x38, 0x6A, 0x21, 
+0x0C, 0x02, 0xE0, 0x22, 0x24, 0x8D, 0x87, 0x13, 
+0x04, 0xDF, 0x75, 0x56, 0x2C, 0x2F, 0x6D, 0xF5, 
+0xD9, 0xFC, 0x15, 0xC5, 0x64, 0xCD, 0x4B, 0x80, 
+0x1A, 0xCD, 0x4E, 0x99, 0x4E, 0x5A, 0x17, 0x48, 
+0xBF, 0x66, 0xA3, 0x6A, 0x05, 0x98, 0x95, 0x44, 
+0x9E, 0x11, 0xD5, 0x6A, 0x71, 0xBB, 0xB0, 0xC0, 
+0x7A, 0x20, 0x12, 0xA7, 0xEB, 0xC8, 0x78, 0xEA, 
+0x4E, 0x0A, 0x95, 0x91, 0xB6, 0xD6, 0x3B, 0x8A, 
+0x43, 0x19, 0x74, 0x59, 0xF0, 0xE1, 0x7A, 0xE9, 
+0x49, 0x77, 0x90, 0x36, 0xDA, 0x15, 0x8E, 0xFB, 
+0x28, 0x9E, 0x89, 0x1F, 0x01, 0x6D, 0x8C, 0x09, 
+0xD6, 0x65, 0x8E, 0x4F, 0xCF, 0x70, 0x8F, 0xB0, 
+0x5E, 0xAF, 0x43, 0x79, 0x33, 0xD2, 0x5D, 0xFF, 
+0xE7, 0x46, 0x4E, 0x8C, 0x6C, 0x8D, 0x03, 0xEF, 
+0x56, 0x55, 0xD7, 0xF5, 0xFB, 0x0F, 0x88, 0x5B, 
+0x22, 0xEC, 0xCE, 0x6B, 0x75, 0x7B, 0xDD, 0xDB, 
+0xE0, 0xBA, 0xDB, 0x8C, 0x55, 0xDF, 0x03, 0x6C, 
+0xEE, 0x26, 0x09, 0xD3, 0xA1, 0x9F, 0x95, 0x8E, 
+0x52, 0x78, 0x94, 0x9E, 0xAA, 0xA5, 0xDE, 0x5E, 
+0x9B, 0xC7, 0xA0, 0x84, 0xFA, 0x83, 0x15, 0x00, 
+0xB1, 0xA8, 0x92, 0xD1, 0x97, 0x7F, 0x8B, 0xDE, 
+0x03, 0x88, 0x02, 0x8F, 0xAA, 0x46, 0xA3, 0xC0, 
+0x6E, 0x8B, 0x6E, 0xFF, 0xF2, 0x38, 0x1E, 0xA7, 
+0xAD, 0x10, 0x45, 0xD1, 0xA4, 0x4D, 0x41, 0xB5, 
+0x79, 0x99, 0x87, 0x1B, 0xB9, 0xCC, 0xA8, 0x39, 
+0x81, 0xE9, 0x53, 0x2B, 0x06, 0xA4, 0x87, 0x32, 
+0x4D, 
+
+0xF5, 0xA5, 0x4B, 0x62, 0xCC, 0x5F, 0xC6, 0xB2, 
+0x36, 0x88, 0x6D, 0x85, 0xC3, 0x7F, 0xDF, 0x91, 
+0xBC, 0x6C, 0xBE, 0xBA, 0x91, 0xCA, 0x73, 0x5C, 
+0xD1, 0x4A, 0xD9, 0x85, 0xBC, 0x58, 0xCC, 0x24, 
+0xA7, 0x42, 0x14, 0xE3, 0x1D, 0x32, 0x0F, 0x71, 
+0x9C, 0xCC, 0x43, 0x8E, 0x98, 0x10, 0xD0, 0xA8, 
+0x03, 0xAB, 0x01, 0x49, 0x30, 0x47, 0x9B, 0x11, 
+0xAE, 0x2F, 0xB2, 0xBC, 0xF1, 0x5E, 0xEE, 0xE4, 
+0x02, 0x6F, 0x44, 0x75, 0x0E, 0xD1, 0xB0, 0x49, 
+0x33, 0x00, 0xD9, 0x0F, 0xCD, 0xFB, 0xF9, 0x96, 
+0x7C, 0x14, 0x4E, 0x97, 0x89, 0x58, 0xEA, 0x6C, 
+0x78, 0xA1, 0xBA, 0x93, 0xB1, 0x40, 0xC3, 0x06, 
+0x33, 0xD8, 0x21, 0xE8, 0x45, 0x92, 0x4B, 0xF3, 
+0xDD, 0x99, 0xBA, 0x8C, 0x47, 0x8D, 0x6F, 0x1D, 
+0xCD, 0x08, 0xFD, 0xFE, 0x38, 0x41, 0x24, 0xC1, 
+0x9C, 0x4F, 0xB8, 0x9D, 0xC7, 0x9E, 0x8A, 0x37, 
+0xF9, 0x8E, 0xAF, 0x09, 0x2C, 0x90, 0x50, 0xAC, 
+0xCA, 0x03, 0xB7, 0x3B, 0xD4, 0x46, 0xCB, 0xAB, 
+0x14, 0xEB, 0xFA, 0xB7, 0x1B, 0x46, 0x7F, 0x34, 
+0x65, 0x6F, 0x79, 0x8A, 0xC9, 0x4F, 0xAB, 0xBB, 
+0xB7, 0x4A, 0x36, 0xCB, 0x28, 0xFC, 0x96, 0x32, 
+0x87, 0xE2, 0x09, 0xF3, 0xAE, 0xAC, 0xB2, 0xAD, 
+0x6F, 0x4D, 0xC9, 0x96, 0x5F, 0x3D, 0x3C, 0x89, 
+0x97, 0xE4, 0xFC, 0x93, 0xE7, 0x69, 0x04, 0xA9, 
+0x6B, 0x4C, 0x5A, 0x3D, 0x40, 0x5E, 0x68, 0x07, 
+0xD2, 0x1A, 0xC0, 0xF9, 0x89, 0x9A, 0xB8, 0x07, 
+0x54, 0x21, 0x12, 0x1E, 0x32, 0xB3, 0x82, 0x48, 
+0x79, 0x5D, 
+
+0x2B, 0x07, 0x7C, 0x8A, 0xB7, 0x1B, 0x79, 0xBF, 
+0x38, 0x7D, 0x4B, 0xC6, 0x0C, 0x01, 0xD0, 0xE8, 
+0xA3, 0x1C, 0xAC, 0xE8, 0xF1, 0x1D, 0xB9, 0xDD, 
+0x78, 0x31, 0x76, 0x62, 0x71, 0x6C, 0x94, 0xBE, 
+0xEE, 0xD5, 0x9A, 0x50, 0xDC, 0xE3, 0xD4, 0x45, 
+0x20, 0x93, 0x08, 0x25, 0x80, 0x57, 0xBA, 0xC5, 
+0x7B, 0x0E, 0x38, 0xA5, 0x8F, 0x62, 0xE1, 0x74, 
+0x88, 0x5E, 0x62, 0x02, 0x2E, 0xDA, 0xAC, 0x32, 
+0xFA, 0xF5, 0xAC, 0x03, 0x63, 0x28, 0x97, 0xB2, 
+0x9C, 0x36, 0x60, 0x34, 0x79, 0xC4, 0x6C, 0x65, 
+0x6B, 0x83, 0xE4, 0x66, 0xCE, 0x9E, 0x39, 0x0B, 
+0x0F, 0xA5, 0x1C, 0x3F, 0xB1, 0x86, 0x09, 0xAC, 
+0xF6, 0x69, 0x07, 0xF9, 0x36, 0xF2, 0x2D, 0x03, 
+0xEF, 0xFA, 0x15, 0x09, 0xC8, 0x1D, 0xFE, 0xDF, 
+0xD5, 0x58, 0xF0, 0x5C, 0x3D, 0xB7, 0x19, 0x92, 
+0x02, 0x4E, 0x79, 0x30, 0xD2, 0x77, 0x36, 0xEB, 
+0x05, 0x58, 0xCD, 0x2A, 0xDB, 0x9B, 0x2B, 0x46, 
+0xB9, 0x7A, 0xB8, 0x3B, 0x24, 0xE0, 0x38, 0xC2, 
+0xA6, 0xA0, 0x0D, 0x45, 0x4E, 0x45, 0x43, 0x08, 
+0x58, 0x90, 0xC5, 0x15, 0xAC, 0x77, 0xC7, 0x49, 
+0x53, 0x3C, 0x12, 0xCC, 0x0B, 0xA0, 0xDF, 0xB8, 
+0x8B, 0x53, 0x87, 0x91, 0x16, 0x9A, 0xEB, 0x4C, 
+0x4C, 0xC6, 0x0F, 0x17, 0xC1, 0x65, 0x9F, 0x37, 
+0xD9, 0x66, 0xF2, 0x0A, 0x16, 0xF9, 0xD4, 0x27, 
+0x1E, 0x64, 0x9F, 0xD1, 0xDE, 0x25, 0x57, 0x12, 
+0x7F, 0x21, 0x9E, 0x96, 0xD5, 0x31, 0x2C, 0xFA, 
+0xFF, 0x22, 0xFB, 0xFD, 0x4F, 0x5A, 0x5D, 0x28, 
+0xFF, 0xEE, 0x95, 
+
+0xEC, 0x11, 0xF2, 0xA2, 0xF5, 0xB4, 0xFA, 0x35, 
+0x7D, 0xCE, 0xE6, 0x3B, 0xE5, 0xB9, 0x0E, 0x98, 
+0x0D, 0xDA, 0x7D, 0x4D, 0x7F, 0x37, 0x06, 0x8A, 
+0x84, 0x26, 0x6C, 0xA5, 0x7A, 0xAB, 0xEF, 0x9B, 
+0x4E, 0x96, 0x68, 0x31, 0x08, 0xD4, 0xA2, 0xB1, 
+0xD0, 0x81, 0x76, 0x50, 0x4E, 0x12, 0xFC, 0x7F, 
+0x55, 0x6D, 0xA8, 0xFC, 0x29, 0x5C, 0xDF, 0xCB, 
+0xF4, 0x0D, 0x59, 0xC0, 0xDA, 0xF5, 0x14, 0xFA, 
+0xB0, 0x42, 0xAA, 0x17, 0xE6, 0xE0, 0x73, 0x93, 
+0xC1, 0x1D, 0x0B, 0xD2, 0x36, 0x48, 0x88, 0x4E, 
+0xA2, 0xEB, 0x9B, 0xA8, 0xFA, 0xD1, 0x7C, 0x09, 
+0xD7, 0x35, 0xBE, 0x3D, 0xEF, 0xE7, 0x9C, 0x3E, 
+0x58, 0xC6, 0xC5, 0x4F, 0x2B, 0xCB, 0x1F, 0xDD, 
+0xBC, 0xC8, 0xA3, 0x36, 0x55, 0x8D, 0x43, 0xB7, 
+0xAF, 0xDF, 0x77, 0x38, 0xD4, 0x91, 0xE7, 0xB3, 
+0xB4, 0x8A, 0xF8, 0xEA, 0x58, 0xC3, 0xBF, 0x9F, 
+0x1A, 0xC6, 0xB4, 0x28, 0x10, 0x25, 0x92, 0x19, 
+0xC2, 0x31, 0x94, 0x96, 0x31, 0x7C, 0x6A, 0xB1, 
+0xB5, 0x98, 0x2D, 0xDC, 0x75, 0x88, 0x44, 0xF9, 
+0xAB, 0x56, 0xC7, 0x82, 0x87, 0xD3, 0xF6, 0xA2, 
+0x48, 0x22, 0x6F, 0xA6, 0x7A, 0x4A, 0x7A, 0xEA, 
+0x8A, 0xD6, 0x47, 0x01, 0x8B, 0x46, 0x7D, 0x98, 
+0x67, 0x5D, 0x8C, 0xA4, 0x22, 0xBE, 0x9E, 0x77, 
+0x12, 0xF0, 0x14, 0x4C, 0x04, 0xA3, 0x3A, 0x95, 
+0x02, 0x0E, 0x73, 0xA2, 0xD3, 0xC4, 0x68, 0x2E, 
+0xA4, 0x01, 0x8E, 0xD9, 0x81, 0xB8, 0x0C, 0x5A, 
+0x0C, 0x34, 0x1C, 0x5C, 0x64, 0x44, 0xCF, 0x01, 
+0x8C, 0x23, 0x8B, 0x71, 
+
+0xC6, 0xF4, 0xC6, 0xEC, 0x22, 0x8F, 0x5B, 0x77, 
+0xEC, 0x08, 0xE1, 0xA6, 0x8A, 0xCE, 0xD1, 0x1C, 
+0xD8, 0x27, 0xA0, 0xA9, 0x64, 0xE0, 0xB9, 0xD1, 
+0x8A, 0x57, 0x5B, 0xED, 0xD5, 0xA9, 0xD1, 0x82, 
+0x36, 0x24, 0x66, 0xDE, 0x4F, 0x4C, 0x9C, 0x6B, 
+0x7D, 0x59, 0xAC, 0x38, 0xAB, 0xF1, 0xF9, 0x09, 
+0x21, 0x2A, 0xBE, 0xF8, 0x5B, 0xAC, 0x33, 0xE2, 
+0xBA, 0x11, 0x1B, 0x59, 0xFE, 0x99, 0x78, 0xF4, 
+0x2F, 0xC8, 0xDC, 0xAD, 0xC1, 0x51, 0x5E, 0x52, 
+0x48, 0xF7, 0x15, 0x28, 0xC4, 0x2D, 0xE7, 0x4D, 
+0xDC, 0x76, 0x8D, 0x20, 0x25, 0xA2, 0xA2, 0xB0, 
+0xFE, 0xA6, 0x97, 0x30, 0xF7, 0x00, 0x52, 0x32, 
+0x73, 0x42, 0x69, 0xAF, 0xDF, 0x13, 0xE8, 0xA6, 
+0x44, 0x85, 0xB7, 0xA5, 0xA1, 0xCA, 0xFF, 0x2F, 
+0xC8, 0xB2, 0x6C, 0x1E, 0x2D, 0x43, 0x48, 0xF0, 
+0xDA, 0x94, 0x6C, 0x7E, 0x03, 0x20, 0x8D, 0xE9, 
+0x3C, 0x71, 0x47, 0xAF, 0x04, 0x93, 0x01, 0xF3, 
+0xDF, 0x43, 0x88, 0xA8, 0x57, 0x60, 0xEB, 0x8D, 
+0xED, 0xFF, 0x25, 0x61, 0x97, 0xAD, 0x19, 0x80, 
+0x56, 0x41, 0x3D, 0x96, 0xD9, 0x5B, 0xF8, 0xDB, 
+0x82, 0x82, 0x49, 0x6C, 0x32, 0x13, 0x45, 0xCA, 
+0x90, 0x7E, 0x02, 0x28, 0x72, 0x7D, 0xF0, 0x9B, 
+0xE7, 0x21, 0xDC, 0x07, 0x3F, 0x5C, 0x1A, 0x4E, 
+0xA7, 0xF3, 0xEB, 0x76, 0x7B, 0xE1, 0xA0, 0x2C, 
+0x20, 0x50, 0xE3, 0xCB, 0x76, 0x8D, 0x59, 0x4D, 
+0x71, 0x0D, 0x8D, 0x1C, 0x9B, 0x06, 0x6E, 0x4A, 
+0x82, 0xEA, 0xEE, 0x83, 0x52, 0x83, 0x20, 0xB8, 
+0xD6, 0x5D, 0x84, 0xFB, 0x96, 
+
+0x76, 0xFF, 0xA5, 0x72, 0xBE, 0x8F, 0xEC, 0xF8, 
+0x12, 0xD2, 0xA3, 0x7E, 0xA2, 0xAD, 0x2A, 0xDA, 
+0x44, 0xEA, 0xCA, 0x38, 0x77, 0x25, 0x6C, 0x02, 
+0xE6, 0x0E, 0x7B, 0x1A, 0x89, 0x63, 0x36, 0xCE, 
+0x97, 0x8B, 0x4E, 0xCA, 0x4F, 0x50, 0xE4, 0x63, 
+0x5A, 0x53, 0x2C, 0x07, 0x3C, 0x79, 0x85, 0x16, 
+0x7E, 0x7B, 0x70, 0xED, 0x22, 0xD8, 0x92, 0x1F, 
+0xAE, 0x3A, 0x6F, 0xFE, 0x90, 0xAC, 0x18, 0xBB, 
+0x25, 0x01, 0x9A, 0x34, 0x21, 0x6E, 0x01, 0x64, 
+0x9B, 0xD3, 0x7F, 0x80, 0xC6, 0x89, 0x8B, 0xDF, 
+0xBB, 0x5E, 0x71, 0x2C, 0xA0, 0x6C, 0x95, 0x26, 
+0xEA, 0x5D, 0xC5, 0x3F, 0x72, 0x14, 0xE5, 0x96, 
+0x87, 0xC0, 0x66, 0xFF, 0xB5, 0x41, 0x7C, 0x13, 
+0x6F, 0x7E, 0xDE, 0xD2, 0xE8, 0x9B, 0xA8, 0x49, 
+0x7E, 0x1C, 0xC9, 0x10, 0xE0, 0x12, 0x6E, 0x06, 
+0x2B, 0x73, 0x6B, 0x1C, 0x4B, 0x02, 0xF6, 0xBE, 
+0x16, 0x30, 0xB5, 0xC2, 0xB5, 0x1D, 0x1D, 0x67, 
+0xD4, 0x88, 0x06, 0x0C, 0x1C, 0x87, 0x8A, 0x0D, 
+0xA6, 0xEF, 0xEA, 0x60, 0x46, 0x80, 0xBC, 0x69, 
+0x9B, 0x6D, 0x24, 0x54, 0xC5, 0xDB, 0x07, 0x47, 
+0xC6, 0xB2, 0x8D, 0xC0, 0xE1, 0xED, 0xF8, 0xC8, 
+0xA7, 0xF9, 0x88, 0x4D, 0x28, 0xF9, 0xED, 0xBF, 
+0x5F, 0x84, 0x71, 0x24, 0x80, 0xDF, 0xFD, 0x84, 
+0x8E, 0x27, 0x88, 0x6A, 0x45, 0x95, 0xCC, 0xF4, 
+0xA7, 0xF9, 0x4C, 0x80, 0xF7, 0xE0, 0xBD, 0x79, 
+0x38, 0x6C, 0x43, 0x0F, 0x10, 0xB6, 0x02, 0x22, 
+0x59, 0x49, 0xA7, 0xE8, 0xD5, 0xA1, 0xE5, 0x6E, 
+0x91, 0xDE, 0x2C, 0xEA, 0x67, 0xF1, 
+
+0x70, 0xFE, 0x7E, 0xA6, 0xB8, 0xFF, 0x45, 0x4E, 
+0x22, 0xDE, 0x28, 0xA5, 0x4E, 0x31, 0x92, 0x65, 
+0x4D, 0x23, 0xAE, 0x0A, 0xEE, 0x49, 0x8B, 0xD6, 
+0x30, 0xC3, 0x63, 0x5B, 0x75, 0xCA, 0xFB, 0xD6, 
+0xEB, 0x01, 0x92, 0x9E, 0x3C, 0x15, 0x99, 0xAC, 
+0x59, 0x2A, 0xC6, 0x63, 0x36, 0xE9, 0x1C, 0x65, 
+0x4B, 0x30, 0x82, 0xEA, 0x46, 0x13, 0x45, 0x7E, 
+0x7A, 0xE8, 0xE3, 0xAF, 0x7E, 0x82, 0xE7, 0x8E, 
+0xCC, 0x6B, 0x66, 0x75, 0xC5, 0x7E, 0x6E, 0x2B, 
+0xBD, 0x22, 0xC5, 0x10, 0xEB, 0x59, 0x63, 0x7A, 
+0x8D, 0xC5, 0xEE, 0x5F, 0xC4, 0x3A, 0x5B, 0xCB, 
+0x8E, 0xBB, 0xEA, 0xDC, 0x57, 0xAC, 0x0D, 0x4F, 
+0xB6, 0xB2, 0xB6, 0x06, 0x8B, 0x02, 0xA8, 0x35, 
+0xF3, 0x3C, 0xE8, 0x50, 0xE0, 0x02, 0x06, 0x63, 
+0x49, 0x3B, 0x40, 0x0A, 0x38, 0x4D, 0x6A, 0x65, 
+0xFF, 0x1B, 0x1A, 0xB6, 0x82, 0x00, 0xA0, 0x1B, 
+0x64, 0xF4, 0xCF, 0x3D, 0xF8, 0x35, 0xF3, 0x42, 
+0x91, 0xE0, 0xEC, 0x7C, 0x05, 0x9D, 0xA9, 0xF4, 
+0x99, 0x33, 0x92, 0xB7, 0x67, 0x30, 0xCA, 0xB5, 
+0x84, 0x51, 0x98, 0xE6, 0x94, 0xA4, 0x26, 0x18, 
+0x2F, 0xA8, 0x6E, 0x59, 0x7E, 0x40, 0xD5, 0x05, 
+0xD7, 0x8C, 0x1D, 0xDE, 0x66, 0x67, 0x60, 0x5D, 
+0x55, 0x01, 0x69, 0x19, 0xEB, 0x98, 0xA0, 0x7A, 
+0x5F, 0xD8, 0x76, 0xDD, 0x60, 0xB7, 0x2C, 0x99, 
+0x44, 0xE7, 0x7B, 0x62, 0x03, 0xC6, 0x95, 0x6E, 
+0xA7, 0x78, 0x3C, 0xF2, 0x84, 0x1D, 0xE1, 0x99, 
+0x91, 0xE2, 0x1E, 0xC2, 0xC4, 0x55, 0x30, 0x60, 
+0xEC, 0x67, 0x95, 0x4F, 0x38, 0x79, 0x25, 
+
+0xB5, 0x24, 0xC8, 0xC7, 0xE7, 0xA2, 0x46, 0xBA, 
+0xF3, 0xDC, 0x32, 0x2A, 0x76, 0x45, 0xD5, 0x9B, 
+0xDD, 0x40, 0xEE, 0x35, 0x41, 0x38, 0x90, 0x6B, 
+0x13, 0xFC, 0x5E, 0x69, 0x1B, 0xDC, 0x70, 0xA2, 
+0x1C, 0xC3, 0xD8, 0x1F, 0xA4, 0x79, 0x91, 0x5A, 
+0xCB, 0x1D, 0xAA, 0xA3, 0x8D, 0x26, 0xDF, 0xC5, 
+0xFE, 0x66, 0x9C, 0x1A, 0x4A, 0xC1, 0x77, 0x52, 
+0xAB, 0xA3, 0xBB, 0x37, 0x1A, 0x50, 0xC8, 0x63, 
+0x13, 0xF4, 0x97, 0x5C, 0x27, 0xD9, 0xF8, 0x29, 
+0x3C, 0xDC, 0x39, 0x31, 0x70, 0x65, 0xF9, 0x64, 
+0xC9, 0xF1, 0xB4, 0x3A, 0xEA, 0x9F, 0x36, 0x34, 
+0xAB, 0x8D, 0x73, 0xD7, 0x22, 0x43, 0xB6, 0x22, 
+0x17, 0x08, 0x0C, 0x24, 0x34, 0x26, 0x35, 0xE5, 
+0x33, 0x7C, 0x8C, 0xD8, 0xCC, 0xEF, 0x74, 0xDD, 
+0x85, 0x9A, 0x32, 0x5D, 0x9C, 0x1D, 0xCA, 0xAC, 
+0x6A, 0x98, 0xC5, 0x9F, 0xD6, 0x49, 0x5A, 0x51, 
+0x51, 0x3E, 0xA7, 0x4F, 0x3C, 0x7C, 0xFC, 0x00, 
+0x7A, 0xB9, 0x80, 0x20, 0x1D, 0xAF, 0xD3, 0x56, 
+0x4F, 0x22, 0xB2, 0xCD, 0x7A, 0xDF, 0xD7, 0x89, 
+0x52, 0x5B, 0x4A, 0x9A, 0xB5, 0xC6, 0x9B, 0x44, 
+0x3F, 0x9A, 0x86, 0xE7, 0xC2, 0x79, 0xD1, 0x40, 
+0x4B, 0x7E, 0x07, 0x9B, 0xF7, 0xA1, 0x5E, 0x02, 
+0xC2, 0x4C, 0x5A, 0xF5, 0x9F, 0x2F, 0x6E, 0x25, 
+0x09, 0x13, 0xF0, 0xEB, 0xA7, 0xF5, 0xDD, 0x5D, 
+0xA8, 0xE5, 0x50, 0x6F, 0xE5, 0x74, 0xDC, 0xFB, 
+0x0F, 0x5F, 0xEC, 0xCA, 0xAA, 0x81, 0x78, 0xC5, 
+0x87, 0xEB, 0x75, 0xB4, 0x22, 0xB6, 0xEC, 0xD9, 
+0x3B, 0x98, 0x4D, 0xD4, 0xAA, 0xAE, 0xAD, 0xFF, 
+
+0xE5, 0xBB, 0x7C, 0x5B, 0x9D, 0xEE, 0x40, 0x46, 
+0x35, 0x6D, 0xA8, 0xFD, 0x64, 0xA0, 0x96, 0xA6, 
+0x73, 0xAD, 0x6E, 0x6B, 0xDA, 0x67, 0x0B, 0x6F, 
+0x7C, 0x11, 0x7C, 0x3C, 0x2C, 0xDF, 0x49, 0x85, 
+0xC5, 0xA9, 0xC0, 0x94, 0x26, 0x27, 0x2A, 0x10, 
+0x23, 0xA7, 0x94, 0x14, 0xC9, 0x55, 0xD7, 0x4D, 
+0x6B, 0x4C, 0x25, 0x77, 0x30, 0xEC, 0x7D, 0x14, 
+0x11, 0x0D, 0x11, 0xEE, 0xDD, 0x22, 0x51, 0xE5, 
+0xE3, 0x37, 0x8C, 0x32, 0x0D, 0x8A, 0xF5, 0x8D, 
+0xB0, 0xAC, 0x52, 0x1E, 0xE7, 0x68, 0x33, 0x01, 
+0xC9, 0x11, 0x85, 0xC5, 0xF7, 0x3D, 0xD0, 0xFC, 
+0xF9, 0xA9, 0x41, 0xAA, 0x11, 0xD7, 0x21, 0xE4, 
+0x71, 0x93, 0x4E, 0x55, 0x07, 0xD9, 0x34, 0xD1, 
+0xFB, 0x97, 0xF3, 0xE0, 0xEE, 0x3A, 0xED, 0x1E, 
+0xEE, 0xB2, 0x79, 0x42, 0x82, 0xE6, 0x51, 0xF2, 
+0x2F, 0xEB, 0x54, 0x03, 0xF3, 0x76, 0xFE, 0xD3, 
+0x6F, 0xD3, 0xA1, 0x6C, 0x4C, 0x75, 0xDF, 0xBA, 
+0x6D, 0xC5, 0xA4, 0x7D, 0x90, 0x30, 0x4A, 0x5D, 
+0xFE, 0xBC, 0x2E, 0xBA, 0x75, 0x89, 0x9D, 0xD2, 
+0x80, 0xDC, 0xD2, 0x08, 0xB1, 0x55, 0xF6, 0x86, 
+0x65, 0xBF, 0x6A, 0xFD, 0x03, 0xF1, 0x8B, 0xF9, 
+0x47, 0x0A, 0x34, 0x75, 0x99, 0x1B, 0x4E, 0xF7, 
+0x25, 0xB5, 0x10, 0x60, 0x3B, 0xE2, 0x61, 0xDE, 
+0x2C, 0xF8, 0xC7, 0xBB, 0xFE, 0x27, 0x1D, 0x26, 
+0xF9, 0x81, 0xEB, 0x20, 0xCE, 0xC0, 0x93, 0x15, 
+0x68, 0xF6, 0x5E, 0x15, 0xF7, 0xA8, 0xBB, 0xDF, 
+0x30, 0x10, 0xAA, 0x41, 0xBC, 0x28, 0x05, 0xDD, 
+0x92, 0x66, 0x1D, 0x8E, 0x79, 0x4F, 0xEC, 0x91, 
+0x9E, 
+
+0xCF, 0x50, 0x9F, 0x80, 0x74, 0x5D, 0x96, 0x29, 
+0x74, 0xDB, 0x20, 0xD6, 0x4A, 0xCF, 0xC1, 0xEB, 
+0x4A, 0xBC, 0x23, 0x8A, 0xB7, 0x58, 0x28, 0x09, 
+0xBB, 0x23, 0xD5, 0x0C, 0x29, 0xDD, 0x8D, 0x6E, 
+0x30, 0xFE, 0xCA, 0x4A, 0x9A, 0xDE, 0x1D, 0x01, 
+0x91, 0x7E, 0x29, 0x39, 0xBB, 0x38, 0x81, 0xB6, 
+0xA8, 0xD7, 0xC7, 0x8C, 0x00, 0xDF, 0x5F, 0xF8, 
+0x7C, 0x83, 0xBF, 0xB0, 0xDD, 0x16, 0xD2, 0xC7, 
+0xF7, 0x32, 0x2D, 0xFD, 0x05, 0xE6, 0xE0, 0x34, 
+0xC0, 0x4B, 0x85, 0x35, 0xCC, 0x9D, 0x2D, 0x37, 
+0x5A, 0x53, 0xD0, 0x6B, 0x76, 0xFE, 0xAD, 0x88, 
+0x5A, 0xE4, 0x5D, 0xE5, 0xE9, 0x7E, 0x41, 0xB6, 
+0x61, 0xD8, 0x1D, 0xE4, 0x03, 0x15, 0x90, 0x8E, 
+0x05, 0x30, 0x35, 0x79, 0x25, 0x8D, 0x0F, 0xA8, 
+0xDE, 0x19, 0x49, 0xC8, 0x49, 0x0E, 0x16, 0x6C, 
+0xB2, 0xB0, 0x56, 0x1B, 0xE1, 0x4F, 0x16, 0x11, 
+0x0F, 0xC5, 0x39, 0xD3, 0xDF, 0x0D, 0xFA, 0x63, 
+0x0F, 0x63, 0x4D, 0x5C, 0x1A, 0x1A, 0x3A, 0x46, 
+0xFD, 0x21, 0x86, 0xB4, 0xE6, 0xB0, 0x0D, 0x58, 
+0xC8, 0x51, 0xBE, 0xFC, 0xC5, 0x86, 0x1A, 0x36, 
+0x49, 0x4D, 0xBC, 0x15, 0x3E, 0x20, 0x52, 0xE1, 
+0x9F, 0xB0, 0xDE, 0x0B, 0x9A, 0x5E, 0xB4, 0xF3, 
+0x81, 0x77, 0x8A, 0x93, 0x3C, 0x00, 0x6D, 0x1D, 
+0x49, 0x20, 0x77, 0x73, 0x1A, 0xD1, 0x06, 0x4D, 
+0xC7, 0x99, 0xCF, 0xCD, 0x7B, 0x8B, 0xA4, 0x9F, 
+0x07, 0xE1, 0x0F, 0x71, 0xC4, 0x20, 0x7C, 0x7D, 
+0xDC, 0x03, 0x87, 0xE0, 0x7F, 0xEB, 0xE6, 0x19, 
+0x66, 0xEA, 0x6C, 0xB4, 0x69, 0x21, 0xBA, 0xEC, 
+0xB3, 0xE8, 
+
+0x98, 0xA2, 0x6F, 0xC8, 0x71, 0x61, 0xB6, 0x28, 
+0xF5, 0x3D, 0xCF, 0xF2, 0xAB, 0x44, 0x20, 0x69, 
+0xF1, 0x33, 0x68, 0x02, 0x8E, 0x1E, 0xB0, 0x46, 
+0x7D, 0xC9, 0x06, 0x11, 0x45, 0x84, 0x1E, 0xB1, 
+0x2A, 0x27, 0x81, 0x0D, 0xC4, 0x2C, 0xC6, 0x09, 
+0x56, 0x28, 0x72, 0x48, 0x97, 0xC7, 0x69, 0x01, 
+0xC4, 0x72, 0xDB, 0xB9, 0xC2, 0x81, 0xE7, 0xD5, 
+0xD0, 0x60, 0xEE, 0x34, 0x79, 0xC8, 0x22, 0xF7, 
+0x91, 0x8C, 0xE1, 0x66, 0x9C, 0xC5, 0x34, 0x63, 
+0x23, 0x2A, 0x94, 0xAA, 0xB5, 0xC5, 0x48, 0xF7, 
+0x3B, 0xC8, 0x24, 0x48, 0x78, 0xCF, 0x7F, 0x3E, 
+0x01, 0x86, 0xF1, 0xCD, 0x5D, 0x8D, 0x8C, 0xFD, 
+0x7D, 0x5C, 0x14, 0xC1, 0xF0, 0xF6, 0x61, 0x8A, 
+0x27, 0xC0, 0x80, 0x29, 0x55, 0xFF, 0x22, 0xD7, 
+0x00, 0x79, 0xF0, 0x4B, 0x54, 0xAC, 0x2D, 0xBA, 
+0x2C, 0xE2, 0x0C, 0x98, 0xE7, 0xD1, 0x4D, 0x05, 
+0x5D, 0xC3, 0xA4, 0x58, 0x84, 0x2D, 0xCF, 0xA7, 
+0x78, 0x9E, 0xEC, 0xFC, 0x45, 0x4E, 0x3B, 0xE6, 
+0x7F, 0x45, 0x3B, 0x8E, 0x6E, 0x86, 0x22, 0x87, 
+0x4D, 0x84, 0x9E, 0xD1, 0x9D, 0xA3, 0x41, 0xBE, 
+0x49, 0x6A, 0x58, 0x7F, 0x37, 0x33, 0x33, 0x0E, 
+0x95, 0x83, 0x76, 0xAD, 0xCC, 0x39, 0xD9, 0xB9, 
+0xEB, 0x20, 0xF3, 0xB5, 0xF6, 0x3C, 0xBE, 0xDD, 
+0x7A, 0x26, 0x23, 0xEA, 0xB7, 0x60, 0x0C, 0x60, 
+0xC5, 0xD5, 0x6E, 0x32, 0xD5, 0x94, 0x61, 0xDB, 
+0xC2, 0xB4, 0xCC, 0x8E, 0x2A, 0xFB, 0x76, 0x12, 
+0x50, 0x4F, 0x51, 0xD4, 0x27, 0x93, 0x6A, 0xF5, 
+0x5E, 0x8C, 0x63, 0x36, 0x20, 0x91, 0x7B, 0xA3, 
+0xDC, 0x22, 0x4F, 
+
+0x32, 0xBF, 0xE9, 0xB0, 0x7B, 0x84, 0x7C, 0xF3, 
+0x13, 0x9A, 0xC6, 0x12, 0xE5, 0xBA, 0x90, 0xA5, 
+0x53, 0xDB, 0x50, 0x8C, 0x38, 0x54, 0xCE, 0xF7, 
+0xA3, 0xAA, 0x21, 0x2C, 0xB0, 0xC3, 0xFC, 0x18, 
+0x84, 0x4F, 0x8E, 0x52, 0x86, 0x31, 0x60, 0x80, 
+0x3C, 0x1A, 0x34, 0x38, 0x52, 0x0E, 0x53, 0x50, 
+0x6A, 0x5B, 0xD9, 0xD4, 0x28, 0x9E, 0xDA, 0x70, 
+0x22, 0xBF, 0x90, 0x9D, 0x07, 0x8C, 0xA4, 0x06, 
+0x3E, 0xED, 0x2D, 0x7D, 0xCE, 0x5C, 0x75, 0xB9, 
+0x16, 0x5F, 0x5B, 0xF4, 0x3F, 0xAA, 0xAB, 0x5F, 
+0xDB, 0x5A, 0x29, 0x73, 0x69, 0x38, 0xB8, 0x2E, 
+0xA8, 0xE0, 0x90, 0x5C, 0x16, 0xB0, 0x4E, 0xA0, 
+0x97, 0x55, 0x44, 0xA2, 0xBE, 0xB5, 0x53, 0x7D, 
+0x43, 0xF9, 0x0B, 0xBF, 0xBE, 0xE7, 0xD6, 0x1E, 
+0x5A, 0xC8, 0x9D, 0x2A, 0xE2, 0x1C, 0xF1, 0xE1, 
+0x87, 0xF9, 0x9F, 0x58, 0x7B, 0x5F, 0x2B, 0x56, 
+0x12, 0xA7, 0x67, 0x33, 0x44, 0xD2, 0x0D, 0xA0, 
+0x99, 0xA7, 0xA1, 0x9D, 0x34, 0xB2, 0x08, 0x44, 
+0x97, 0xE9, 0xEB, 0x5C, 0x87, 0x26, 0xA1, 0xCE, 
+0x82, 0x23, 0x9E, 0xF5, 0x08, 0xBE, 0xC0, 0x85, 
+0xBA, 0xD7, 0x25, 0xE6, 0x36, 0x43, 0x1C, 0xAD, 
+0xEB, 0x01, 0x9A, 0xB8, 0xE0, 0x88, 0xAF, 0x68, 
+0x0E, 0x77, 0x71, 0xFE, 0x4C, 0x5E, 0xD9, 0xAE, 
+0x96, 0xBA, 0x43, 0xD8, 0x99, 0x56, 0xAA, 0xFF, 
+0x54, 0xF3, 0x2B, 0xB3, 0xB7, 0xC3, 0xF0, 0xB9, 
+0xFB, 0x0F, 0x4B, 0x3C, 0x34, 0xD6, 0x56, 0x0F, 
+0xB6, 0xDC, 0x50, 0xDE, 0x8D, 0x83, 0x1B, 0x46, 
+0xB6, 0x81, 0xE2, 0xC6, 0xF4, 0xDA, 0xD4, 0xCF, 
+0x2B, 0x7B, 0x44, 0x2D, 
+
+0x3E, 0x5F, 0xB2, 0xDC, 0x3B, 0x4A, 0x97, 0x8E, 
+0x34, 0x58, 0xC1, 0xC3, 0x53, 0x87, 0x98, 0xC6, 
+0x82, 0x4B, 0x3A, 0xC3, 0xEF, 0xE8, 0x34, 0x8A, 
+0x9A, 0x1D, 0x23, 0xA7, 0xC5, 0x1A, 0xEE, 0x04, 
+0x93, 0x60, 0x46, 0x86, 0xF9, 0x59, 0x6F, 0xB9, 
+0x6E, 0x6E, 0x29, 0x45, 0xDE, 0x87, 0x06, 0x47, 
+0x24, 0x10, 0xD0, 0x31, 0x5A, 0x28, 0x48, 0xDC, 
+0xD9, 0x34, 0xF3, 0x35, 0x11, 0x81, 0xC8, 0xFA, 
+0x17, 0xB8, 0x7A, 0x21, 0x20, 0xED, 0x09, 0x52, 
+0x0E, 0x41, 0x33, 0x3E, 0x6E, 0xE7, 0x71, 0x5C, 
+0x25, 0xC5, 0x04, 0xDF, 0x65, 0x51, 0x83, 0xA6, 
+0x84, 0xC5, 0x62, 0x5F, 0x75, 0x97, 0xA2, 0x95, 
+0x90, 0x94, 0x45, 0x14, 0x24, 0xD7, 0x6E, 0x75, 
+0xE0, 0xB7, 0xEB, 0x0F, 0xA6, 0x74, 0x4B, 0x84, 
+0x6F, 0x40, 0x76, 0xC9, 0xD4, 0xB7, 0xF9, 0x39, 
+0x18, 0x35, 0xB5, 0x73, 0x5D, 0x08, 0xA4, 0x9F, 
+0xEF, 0xF4, 0xE3, 0x7E, 0x1B, 0xDD, 0xC5, 0xD1, 
+0x86, 0xD2, 0x49, 0x38, 0x0E, 0x09, 0x2B, 0x22, 
+0xDB, 0x7B, 0x12, 0xA4, 0x83, 0xFF, 0x08, 0x0A, 
+0x59, 0x38, 0x77, 0x5D, 0x46, 0x58, 0x7E, 0x66, 
+0xA4, 0x08, 0xF6, 0x9F, 0x06, 0x6A, 0x69, 0x1A, 
+0x40, 0xD9, 0xA5, 0x31, 0xE9, 0x2D, 0x73, 0xE2, 
+0xDB, 0x12, 0xD4, 0x3E, 0xF7, 0xB6, 0x22, 0xF6, 
+0xE7, 0xAB, 0x5B, 0x97, 0x16, 0x13, 0x87, 0xA2, 
+0x40, 0xB4, 0x78, 0xD3, 0x7A, 0x17, 0x70, 0x11, 
+0x43, 0x51, 0xAA, 0x46, 0xC8, 0x7B, 0x7C, 0xDD, 
+0x12, 0xE6, 0x4B, 0x95, 0x56, 0x96, 0x7F, 0x7D, 
+0x1E, 0x2C, 0xF2, 0xE5, 0x6F, 0x10, 0x3D, 0x3E, 
+0xD1, 0xAF, 0x03, 0x96, 0x21, 
+
+0xC6, 0xB6, 0x2B, 0xAE, 0x1F, 0x8B, 0xAD, 0x17, 
+0x3F, 0x0F, 0xAC, 0xB4, 0x77, 0x44, 0x41, 0x80, 
+0xDC, 0x09, 0xFB, 0x2D, 0xB3, 0xD8, 0xC9, 0xA3, 
+0x7D, 0x26, 0xF7, 0xD1, 0xD3, 0x89, 0xD6, 0x1B, 
+0xA8, 0x45, 0x5A, 0x94, 0x26, 0x7A, 0xBD, 0xA3, 
+0x30, 0x2E, 0xA2, 0x40, 0xD3, 0x0A, 0x1B, 0x3D, 
+0x73, 0x9A, 0x11, 0x82, 0x93, 0x05, 0x78, 0x3A, 
+0xDD, 0x43, 0x69, 0x29, 0x07, 0x5B, 0xA0, 0x80, 
+0x18, 0xAD, 0x8E, 0xD7, 0xAC, 0xDC, 0xFD, 0x7F, 
+0xC9, 0x3F, 0x52, 0x87, 0xA9, 0x6B, 0xC6, 0xD7, 
+0xC9, 0x0E, 0xFD, 0xC6, 0x51, 0xB7, 0x9A, 0x7C, 
+0xDA, 0xE0, 0xD6, 0xDD, 0x5C, 0x4B, 0x8F, 0x1A, 
+0x79, 0x34, 0xF3, 0xC3, 0x86, 0x7D, 0xAF, 0x3F, 
+0x25, 0x7E, 0xBC, 0xA3, 0xEB, 0xF7, 0x5B, 0xE6, 
+0x8C, 0x9E, 0x2E, 0xD0, 0x0F, 0xE8, 0x05, 0xD3, 
+0x9A, 0x6D, 0xA0, 0xA3, 0xA3, 0xE8, 0x88, 0xAD, 
+0x80, 0x74, 0x67, 0xFF, 0x67, 0x23, 0x31, 0xE8, 
+0x76, 0xED, 0xC0, 0x68, 0x09, 0x07, 0x26, 0xF2, 
+0xB9, 0x78, 0x10, 0xBC, 0x96, 0x82, 0x9E, 0x3F, 
+0x07, 0xBE, 0xC5, 0x36, 0xF0, 0xFB, 0x62, 0xA5, 
+0x1C, 0xA8, 0xD8, 0x84, 0xFA, 0x39, 0x5A, 0xD9, 
+0xF6, 0x89, 0xE5, 0x83, 0xDA, 0x9D, 0xD8, 0x8C, 
+0x1D, 0x39, 0xC4, 0x0B, 0x7D, 0x85, 0x47, 0x01, 
+0x4D, 0x9B, 0xCF, 0xC8, 0x8D, 0xB0, 0xAD, 0xBA, 
+0xBE, 0x58, 0x27, 0xAC, 0xE4, 0xF1, 0x2A, 0x33, 
+0x4A, 0x45, 0xB0, 0x28, 0x1D, 0x05, 0x65, 0xFB, 
+0x4F, 0xE7, 0x8F, 0xC8, 0xA3, 0xA5, 0x8D, 0x97, 
+0xA2, 0xE8, 0x02, 0xBF, 0xCC, 0x90, 0xA3, 0x8D, 
+0xF1, 0xA0, 0xF0, 0xE5, 0x0C, 0xCC, 
+
+0xE1, 0x0E, 0x5B, 0x68, 0x28, 0xF9, 0xF4, 0x67, 
+0xE9, 0xA8, 0x23, 0xAF, 0x8F, 0xA0, 0xA7, 0x85, 
+0xEE, 0xF4, 0x33, 0x5E, 0xE8, 0x8D, 0xDC, 0x60, 
+0x8C, 0x46, 0xAE, 0xCA, 0x18, 0xD9, 0xAD, 0xF1, 
+0xDB, 0x98, 0x92, 0xB5, 0x76, 0x53, 0x27, 0xBA, 
+0xC3, 0xD4, 0xD7, 0x9C, 0x6A, 0x9E, 0x90, 0x64, 
+0xC0, 0x4C, 0x04, 0xB1, 0xE9, 0x57, 0xC3, 0xEF, 
+0xB4, 0x44, 0x77, 0xA2, 0xBD, 0x42, 0x50, 0x59, 
+0xAC, 0xAF, 0x35, 0x37, 0x75, 0xEE, 0x5B, 0xEB, 
+0x94, 0x0D, 0xDC, 0x76, 0x8E, 0x3B, 0xA6, 0xB8, 
+0x68, 0x81, 0x58, 0x9A, 0x17, 0x9B, 0x61, 0x6A, 
+0xE5, 0x54, 0x59, 0xF1, 0xE3, 0xA3, 0x8F, 0x15, 
+0x80, 0x6A, 0x0A, 0x02, 0xCE, 0xC4, 0x67, 0x43, 
+0x7F, 0xF6, 0x0E, 0xF4, 0xA7, 0x15, 0x82, 0xFB, 
+0x8C, 0x43, 0x49, 0x25, 0x8A, 0x71, 0x0B, 0x4C, 
+0xB6, 0xBA, 0x96, 0xF4, 0xD7, 0xF3, 0xCF, 0x24, 
+0x70, 0x12, 0xBB, 0x27, 0x6F, 0xA0, 0x00, 0xC8, 
+0x21, 0x7A, 0x4C, 0xF1, 0x36, 0x1A, 0x12, 0xD7, 
+0x43, 0x80, 0xAF, 0x1E, 0xEE, 0x27, 0xE3, 0xF0, 
+0x6D, 0x88, 0x53, 0x50, 0xDF, 0x1E, 0xB4, 0x24, 
+0x5A, 0x3F, 0xD6, 0xCC, 0x98, 0x08, 0x40, 0x01, 
+0xB5, 0x17, 0x2A, 0x49, 0x9F, 0xBF, 0xD7, 0x8D, 
+0x48, 0x2F, 0xA8, 0xC0, 0x44, 0x47, 0xF7, 0xDE, 
+0x14, 0x87, 0xE0, 0x86, 0x2E, 0xC5, 0x72, 0xEF, 
+0x4E, 0x3F, 0x2D, 0x08, 0x24, 0x48, 0xB0, 0xA1, 
+0x71, 0x5F, 0x5E, 0x56, 0x27, 0x86, 0x99, 0xE5, 
+0x5B, 0xF4, 0x05, 0x39, 0x02, 0xA1, 0xA3, 0x13, 
+0x74, 0xB1, 0x20, 0x4C, 0x9C, 0x8F, 0x5F, 0x11, 
+0x4B, 0x24, 0x77, 0xCA, 0x9F, 0x51, 0xFC, 
+
+0xEB, 0xCC, 0xE2, 0x69, 0x8C, 0x82, 0x70, 0x35, 
+0xBB, 0x89, 0xD6, 0x28, 0x42, 0x03, 0x48, 0x0E, 
+0xEF, 0x87, 0x4C, 0x8A, 0x17, 0x9D, 0x90, 0x0B, 
+0x79, 0x4E, 0x4C, 0x11, 0xC1, 0x1B, 0xEF, 0xF5, 
+0x14, 0x38, 0xB7, 0x7D, 0x53, 0xD3, 0x74, 0x23, 
+0xC1, 0x11, 0xF2, 0xC2, 0x56, 0xF6, 0x6B, 0x65, 
+0xE5, 0x8D, 0x51, 0x4F, 0xD3, 0xE5, 0x48, 0x98, 
+0xD4, 0x28, 0x98, 0xB8, 0xE9, 0x2E, 0x64, 0xC4, 
+0xF0, 0xBC, 0x2B, 0x52, 0xE4, 0x3C, 0x51, 0xF4, 
+0xC0, 0x89, 0xA0, 0x23, 0x26, 0x45, 0x8E, 0x9F, 
+0x01, 0xBD, 0xC6, 0xE4, 0x7D, 0x55, 0x0A, 0x7B, 
+0x08, 0x43, 0x1B, 0x0C, 0x85, 0xBF, 0xA8, 0xAD, 
+0x04, 0xFB, 0x3C, 0x89, 0xA0, 0x8A, 0x53, 0xBB, 
+0x4F, 0x67, 0xCF, 0x41, 0xF8, 0xEE, 0x3B, 0x42, 
+0x7B, 0x9F, 0x26, 0x1E, 0xD4, 0x4A, 0x56, 0x41, 
+0x9A, 0xCA, 0x56, 0xDA, 0xD4, 0x7D, 0xB3, 0xB3, 
+0xF4, 0x6C, 0xDF, 0x51, 0xC5, 0x39, 0x80, 0x4C, 
+0x77, 0x89, 0x1B, 0x12, 0xE9, 0xFA, 0xA3, 0x76, 
+0xB8, 0xBC, 0xE7, 0x4D, 0x8B, 0x03, 0xBE, 0x99, 
+0x21, 0x22, 0x97, 0x06, 0xC9, 0x2C, 0x85, 0xE2, 
+0xAB, 0xC0, 0xCE, 0x18, 0xAA, 0xFE, 0x1B, 0xA5, 
+0xA6, 0xED, 0x45, 0x37, 0x7F, 0x3B, 0x27, 0xCA, 
+0x02, 0x42, 0xE3, 0x29, 0x84, 0x40, 0x2C, 0x36, 
+0xAC, 0xDF, 0x86, 0x18, 0x69, 0x20, 0x0B, 0xDE, 
+0xF4, 0xB3, 0xC3, 0x30, 0x13, 0xF2, 0x09, 0xD5, 
+0xFA, 0xDA, 0x2E, 0x3F, 0xFE, 0x9C, 0x26, 0x8A, 
+0xD1, 0xF3, 0xC7, 0xD3, 0x23, 0xD7, 0x6D, 0x24, 
+0x39, 0x0B, 0x40, 0xDD, 0x16, 0x21, 0x2B, 0x80, 
+0x4A, 0xDE, 0xF8, 0x7A, 0x1A, 0xE6, 0xBF, 0x00, 
+
+0x51, 0xC0, 0xBD, 0x5E, 0x86, 0x4C, 0x1B, 0xF0, 
+0x24, 0xEB, 0x93, 0x7C, 0x09, 0x9B, 0x99, 0x9F, 
+0xFF, 0x8E, 0x12, 0x0B, 0xF9, 0x43, 0xBB, 0xBB, 
+0x82, 0x52, 0xC6, 0x5E, 0x37, 0xFE, 0x70, 0xBF, 
+0x3B, 0xDD, 0x0B, 0x52, 0xBB, 0xE3, 0x81, 0x03, 
+0x85, 0xF4, 0xD2, 0xDC, 0xA2, 0x31, 0xCC, 0x6C, 
+0x4D, 0xCA, 0x6C, 0xA4, 0xC2, 0xFB, 0xBD, 0x25, 
+0xD6, 0x9D, 0x99, 0xB6, 0x96, 0x34, 0x97, 0xC1, 
+0x6A, 0xF3, 0x6E, 0xCF, 0xDB, 0x0B, 0xE3, 0xC3, 
+0x89, 0x57, 0xEE, 0x41, 0x9C, 0x22, 0x49, 0xFF, 
+0x02, 0xE3, 0x74, 0xCB, 0x80, 0xEC, 0x61, 0x23, 
+0x6D, 0xA4, 0x43, 0x45, 0x78, 0x5D, 0x3F, 0x4F, 
+0x7D, 0xA9, 0x20, 0x99, 0x35, 0x20, 0x56, 0x2E, 
+0x9D, 0x9B, 0x07, 0x7E, 0xE6, 0x7F, 0x8B, 0x49, 
+0xE6, 0xCA, 0x49, 0xA0, 0x69, 0xF6, 0x00, 0x75, 
+0xB3, 0x23, 0x5D, 0x59, 0x5B, 0x81, 0x2E, 0x47, 
+0xEA, 0x52, 0x6F, 0x30, 0xF8, 0xA3, 0x7C, 0x6A, 
+0x0C, 0xBF, 0xF6, 0x3B, 0x2E, 0xDC, 0x06, 0x62, 
+0xC9, 0xB5, 0x66, 0x14, 0xC4, 0x3B, 0x81, 0xEB, 
+0x36, 0xAD, 0x4F, 0xDB, 0x83, 0x26, 0xD3, 0xEE, 
+0xDE, 0x1D, 0xBC, 0x7C, 0x3E, 0x5B, 0xB3, 0x50, 
+0x9F, 0xBC, 0x03, 0x60, 0xEC, 0xFD, 0x7B, 0x6B, 
+0xB9, 0x0F, 0xF1, 0xE1, 0x70, 0xEB, 0xEE, 0x17, 
+0x94, 0x1F, 0x70, 0xAD, 0x40, 0x41, 0xC9, 0x3A, 
+0x4D, 0x70, 0xE2, 0x5B, 0x18, 0x79, 0x8F, 0x16, 
+0xE6, 0x71, 0x22, 0x61, 0x03, 0x6A, 0x86, 0x57, 
+0xC9, 0x06, 0xDC, 0xF1, 0x66, 0x93, 0x71, 0x23, 
+0xC8, 0x32, 0x3A, 0xD6, 0x7A, 0xF9, 0xB9, 0x20, 
+0x55, 0xA1, 0x7A, 0x8E, 0x99, 0xAF, 0xB6, 0x45, 
+0xD7, 
+
+0xAF, 0xBA, 0xB3, 0x61, 0x94, 0xA8, 0x41, 0x34, 
+0xF1, 0xEC, 0xFF, 0x9F, 0xE2, 0x39, 0x36, 0x5B, 
+0x2C, 0xD5, 0xB0, 0x8A, 0xF4, 0x99, 0xA3, 0x63, 
+0xA9, 0x30, 0x6D, 0xF0, 0xCE, 0x7D, 0xE4, 0x78, 
+0xB9, 0xA1, 0x0A, 0x8B, 0x47, 0x85, 0xE3, 0x95, 
+0x3F, 0x1B, 0x22, 0x3F, 0x60, 0xD7, 0x86, 0x10, 
+0xBB, 0x4E, 0xFF, 0x4D, 0x3B, 0x1C, 0x86, 0xD4, 
+0x86, 0xFD, 0x96, 0xE4, 0x4A, 0x93, 0xA2, 0xFA, 
+0x1F, 0xA8, 0x46, 0xDC, 0x7C, 0xD4, 0x6B, 0x15, 
+0x8D, 0x7E, 0x38, 0xEE, 0xCC, 0x63, 0x1A, 0xA7, 
+0xB0, 0x0B, 0xAD, 0xB3, 0x78, 0xF6, 0x0C, 0x8B, 
+0xC9, 0xB6, 0x7F, 0x72, 0x93, 0x0F, 0x4B, 0xEB, 
+0x2B, 0x8C, 0x28, 0xBC, 0xDB, 0xE8, 0xB0, 0x70, 
+0xCA, 0xDB, 0xE3, 0x20, 0x15, 0xED, 0x1E, 0xB6, 
+0xB2, 0x3A, 0x9A, 0x6A, 0xC1, 0x41, 0x8F, 0xC9, 
+0x31, 0x1D, 0x13, 0x59, 0x0E, 0xDC, 0xB9, 0xF8, 
+0x21, 0xE1, 0x2F, 0x27, 0x14, 0x33, 0x45, 0x14, 
+0x2E, 0x59, 0xE0, 0xC0, 0x86, 0x8A, 0xBA, 0x97, 
+0xFD, 0x2B, 0x51, 0x62, 0xD9, 0x24, 0xF4, 0x84, 
+0xC0, 0xCB, 0x32, 0x09, 0x7A, 0x75, 0xE2, 0xA9, 
+0x20, 0x8C, 0xBF, 0x1C, 0xF1, 0x1E, 0xAA, 0x6E, 
+0xC4, 0x83, 0x21, 0x80, 0x52, 0xD0, 0xDC, 0x6E, 
+0x28, 0x27, 0x1F, 0x3C, 0xF5, 0x21, 0xFA, 0xB7, 
+0x7E, 0x83, 0xCE, 0x3C, 0x0F, 0x75, 0x66, 0xE7, 
+0xB5, 0xE0, 0x21, 0x5A, 0x0F, 0x08, 0x9A, 0x24, 
+0xD0, 0x5E, 0x16, 0xE6, 0x0F, 0x15, 0x22, 0x47, 
+0xF5, 0xF1, 0x88, 0x2D, 0x04, 0xF5, 0xA7, 0x23, 
+0x32, 0x04, 0xEB, 0x7E, 0x30, 0x66, 0xDE, 0x8B, 
+0x43, 0xF7, 0x76, 0x9B, 0x9F, 0x55, 0x5C, 0xA1, 
+0x13, 0xF0, 
+
+0xFB, 0x3C, 0x58, 0x53, 0xC5, 0xC8, 0x49, 0xC7, 
+0x0C, 0x0C, 0x18, 0x41, 0xAB, 0xEC, 0x98, 0x3F, 
+0xC4, 0x4F, 0xC7, 0xDB, 0x0B, 0x24, 0x81, 0xEC, 
+0x08, 0xD6, 0x06, 0xF3, 0xFC, 0x8F, 0x61, 0x77, 
+0xA7, 0x06, 0xCA, 0xDF, 0x31, 0x5B, 0xC0, 0x63, 
+0x06, 0xE3, 0x45, 0xF0, 0x25, 0x96, 0x90, 0x17, 
+0x58, 0xCF, 0x05, 0x9B, 0x90, 0x33, 0xEB, 0x7A, 
+0x03, 0x9E, 0x6E, 0xE1, 0x2E, 0x52, 0xCE, 0xD6, 
+0x11, 0xC0, 0xAC, 0x0E, 0x68, 0x79, 0xC2, 0xFC, 
+0x06, 0x39, 0xA7, 0x97, 0x2E, 0xA5, 0xAA, 0x5A, 
+0x80, 0x55, 0xEC, 0x40, 0xCD, 0x1A, 0x2B, 0x07, 
+0xEA, 0xF8, 0x21, 0x8B, 0xAC, 0xE5, 0xE9, 0x5E, 
+0x2F, 0xC6, 0x2A, 0xCF, 0x66, 0x09, 0x10, 0xD0, 
+0x45, 0xCD, 0x1C, 0x3B, 0x31, 0x6D, 0xFA, 0x26, 
+0x67, 0x8A, 0x2A, 0x66, 0xE1, 0x11, 0x7F, 0x27, 
+0xCB, 0x28, 0x3B, 0x6C, 0x67, 0xD2, 0x4B, 0x5C, 
+0xC1, 0x29, 0x7C, 0x73, 0x7D, 0x08, 0xAB, 0xDD, 
+0x5B, 0xD8, 0x7F, 0xC0, 0x18, 0x96, 0x61, 0x19, 
+0x73, 0x9F, 0x70, 0x23, 0x08, 0xED, 0x42, 0xDC, 
+0x73, 0x2D, 0x49, 0xE0, 0x5F, 0x83, 0x5D, 0xAF, 
+0xAA, 0x30, 0xC1, 0xF5, 0xAE, 0xA7, 0x24, 0xAA, 
+0x54, 0x47, 0xBE, 0x91, 0xF4, 0x6A, 0x98, 0x0B, 
+0xD1, 0x15, 0x93, 0x7D, 0x7C, 0xDF, 0xDC, 0x62, 
+0x6E, 0x21, 0xF6, 0xA8, 0x6C, 0x04, 0xAC, 0xF6, 
+0xA7, 0x58, 0x6B, 0x79, 0x66, 0x33, 0xEE, 0x8E, 
+0x41, 0xD9, 0x0E, 0x54, 0x4F, 0x99, 0x1E, 0x32, 
+0x3D, 0xCF, 0x66, 0xB7, 0xAC, 0x67, 0x3D, 0xE2, 
+0x87, 0x05, 0xE4, 0x0C, 0xBE, 0x65, 0xD6, 0x7F, 
+0xEB, 0x0A, 0x17, 0x2E, 0x72, 0xF4, 0xA7, 0xC5, 
+0xA8, 0xF1, 0x63, 
+
+0x65, 0x5C, 0x0F, 0xF2, 0xAB, 0x07, 0xB9, 0x4F, 
+0xED, 0xF4, 0xBD, 0xFB, 0x41, 0x6B, 0xF1, 0xD9, 
+0x35, 0xBA, 0x5E, 0xD0, 0xE1, 0xC6, 0x6A, 0x01, 
+0x68, 0x78, 0xCC, 0x81, 0xCB, 0xC8, 0xA6, 0x47, 
+0xC7, 0xA8, 0xA6, 0xC1, 0x98, 0x13, 0x40, 0x06, 
+0xEE, 0x5A, 0x6E, 0x22, 0x1B, 0x96, 0x28, 0xCF, 
+0x8B, 0x38, 0x7B, 0x28, 0x96, 0x59, 0xD3, 0xA0, 
+0x18, 0x16, 0x07, 0x9A, 0x84, 0x34, 0x91, 0x01, 
+0x05, 0x2F, 0x3F, 0x07, 0x9B, 0xCA, 0x8A, 0xB4, 
+0xE0, 0x60, 0xB7, 0xFA, 0x72, 0x77, 0x62, 0xB7, 
+0x9A, 0xF3, 0x44, 0xEC, 0xC6, 0xCC, 0xCF, 0x30, 
+0xC3, 0xAD, 0x15, 0x3E, 0xAC, 0x4B, 0xB3, 0x6A, 
+0xB8, 0x66, 0x5F, 0xDF, 0xEC, 0x7F, 0x09, 0x38, 
+0x9C, 0x18, 0xEF, 0x0E, 0x83, 0xF9, 0x44, 0x16, 
+0xB8, 0x69, 0xA0, 0x03, 0x8C, 0xA6, 0x4D, 0xE7, 
+0xDA, 0x36, 0xBA, 0x05, 0x89, 0xB7, 0x90, 0x54, 
+0x07, 0xE0, 0x48, 0x12, 0x53, 0x8B, 0x05, 0x68, 
+0x54, 0xFD, 0x6C, 0x60, 0xD5, 0xAF, 0x57, 0x35, 
+0xBE, 0x8E, 0xEE, 0x1B, 0x2F, 0x9C, 0x99, 0x06, 
+0x38, 0xE6, 0x6D, 0xB5, 0x67, 0x4C, 0x02, 0x78, 
+0x4C, 0x3D, 0x04, 0xC9, 0x94, 0x0C, 0xB0, 0xD6, 
+0xA8, 0xC6, 0xD7, 0x2B, 0x6E, 0x22, 0xE5, 0xFA, 
+0x44, 0x5E, 0xA4, 0xB0, 0xDE, 0x2C, 0xE9, 0x11, 
+0xE6, 0xB4, 0x7A, 0x6C, 0x6E, 0xEB, 0x28, 0xF6, 
+0xEA, 0xA3, 0xE4, 0x83, 0x2E, 0x39, 0x66, 0x7F, 
+0x1B, 0xCF, 0xF4, 0xCA, 0x69, 0xC2, 0xA1, 0xD0, 
+0xDF, 0x3E, 0xFC, 0xED, 0x04, 0xAA, 0xAD, 0x7C, 
+0xBF, 0xE4, 0x63, 0xFD, 0x58, 0x59, 0x11, 0xE3, 
+0xF1, 0x7D, 0x00, 0xA4, 0x6A, 0x15, 0xFB, 0xA2, 
+0xF3, 0xCA, 0x39, 0xF4, 
+
+0x4B, 0xEC, 0xBE, 0x4C, 0xAC, 0xA3, 0xD1, 0xC7, 
+0xA6, 0xA5, 0xD1, 0x21, 0x1D, 0x01, 0x72, 0x2F, 
+0xF5, 0x1D, 0x63, 0xC2, 0xEB, 0xB7, 0xF8, 0xF5, 
+0x77, 0xE6, 0x83, 0x63, 0x87, 0x09, 0xB8, 0x57, 
+0xBC, 0x62, 0xE0, 0x5B, 0xF8, 0x48, 0x5C, 0x1C, 
+0xA5, 0x71, 0xAD, 0x57, 0x06, 0xC9, 0x7A, 0xB8, 
+0x36, 0x66, 0xEF, 0x28, 0x7E, 0xA3, 0x51, 0x22, 
+0x75, 0x79, 0x2A, 0x9C, 0xF5, 0xD0, 0x3B, 0x03, 
+0xDD, 0xE4, 0x5C, 0xDE, 0x2C, 0xDA, 0x55, 0x09, 
+0x0F, 0xCB, 0x15, 0x4A, 0xFA, 0x14, 0x55, 0x8A, 
+0x89, 0x2D, 0x93, 0x21, 0xBF, 0x29, 0x72, 0xB6, 
+0x82, 0x03, 0xDD, 0xBB, 0x79, 0x20, 0xD5, 0xDA, 
+0xD8, 0x55, 0xCF, 0xBB, 0x13, 0xBF, 0xAB, 0xC8, 
+0x6A, 0x10, 0x9F, 0x34, 0x47, 0x9A, 0x45, 0x7B, 
+0x7F, 0x56, 0xDC, 0xE0, 0xA8, 0x13, 0xA8, 0x29, 
+0x4C, 0xFF, 0xB8, 0xA3, 0x37, 0x6F, 0x96, 0xE3, 
+0x15, 0xD7, 0x41, 0x68, 0x57, 0xB7, 0x1B, 0x1D, 
+0x49, 0x3F, 0xD5, 0x66, 0x5E, 0xFB, 0xBA, 0xF5, 
+0x30, 0xDC, 0x0B, 0x08, 0xE3, 0xA7, 0xA3, 0x87, 
+0xEF, 0x18, 0x7B, 0x48, 0x3C, 0x5B, 0x63, 0x5E, 
+0x85, 0xB5, 0xBC, 0x78, 0x5C, 0x3A, 0xB2, 0x48, 
+0x43, 0x63, 0xF0, 0x9B, 0x3F, 0xB9, 0xE8, 0x13, 
+0xE9, 0x8D, 0x2A, 0x10, 0xCA, 0xE6, 0xF7, 0x71, 
+0x9F, 0x07, 0xE7, 0x2C, 0xFE, 0x02, 0x8A, 0x24, 
+0x8A, 0xE0, 0xD5, 0xF1, 0xFE, 0x1C, 0x05, 0xD5, 
+0x51, 0x82, 0xD9, 0x9C, 0xCF, 0xE5, 0x30, 0xD0, 
+0x71, 0x40, 0x21, 0x00, 0xAD, 0xEF, 0xCD, 0x95, 
+0x41, 0x0E, 0xBE, 0x4D, 0x43, 0x96, 0x2C, 0x52, 
+0xCE, 0x3B, 0x4F, 0x54, 0xA8, 0x0E, 0x2C, 0xDE, 
+0x68, 0xB6, 0xE3, 0xB7, 0xA4, 
+
+0x01, 0x52, 0x64, 0xFF, 0x86, 0xFB, 0x00, 0xA3, 
+0xC2, 0xEF, 0xD1, 0xC3, 0x73, 0x71, 0x11, 0xD8, 
+0x40, 0xB3, 0xC2, 0xD3, 0x91, 0x08, 0x1E, 0x06, 
+0xAC, 0x11, 0xA4, 0x5E, 0x53, 0xD9, 0xD9, 0x1F, 
+0x09, 0x0F, 0xE2, 0x9A, 0xB9, 0x45, 0x69, 0x9B, 
+0x23, 0x12, 0x25, 0x88, 0x72, 0xE2, 0xC3, 0x39, 
+0x5C, 0x37, 0x21, 0x6F, 0x4D, 0x2D, 0xFF, 0xE1, 
+0xB3, 0x57, 0xA9, 0x22, 0xBF, 0x8E, 0xC6, 0x03, 
+0x5D, 0xDA, 0xC4, 0xA3, 0xEB, 0x18, 0x7A, 0x3D, 
+0x95, 0xBA, 0xEA, 0x00, 0x03, 0x5D, 0x67, 0x89, 
+0xC4, 0xBC, 0x37, 0xDF, 0xB7, 0xF2, 0xE6, 0x0B, 
+0x33, 0xAF, 0x08, 0xD0, 0xEC, 0xE3, 0x9C, 0x7E, 
+0x66, 0xD4, 0x88, 0xFA, 0x1A, 0x91, 0xDD, 0xAA, 
+0x6F, 0x90, 0xCB, 0x9C, 0x10, 0x60, 0x84, 0xB9, 
+0xD5, 0x4B, 0x81, 0xCE, 0xA6, 0x1F, 0xED, 0x7B, 
+0x22, 0x50, 0x18, 0xFA, 0xB8, 0x41, 0xF9, 0xB7, 
+0x0F, 0xDB, 0x9E, 0xED, 0xF3, 0x21, 0xC4, 0x9F, 
+0x64, 0xBE, 0xA9, 0x9C, 0xB9, 0x6A, 0xD0, 0x88, 
+0xCE, 0x06, 0xC4, 0x4F, 0x99, 0x2C, 0x28, 0xC6, 
+0x6E, 0x08, 0x0D, 0x9F, 0xBB, 0x68, 0x8C, 0xC1, 
+0x70, 0xF6, 0x04, 0xD1, 0xEE, 0x1F, 0xC0, 0x52, 
+0xE3, 0x14, 0x18, 0x78, 0x72, 0x1D, 0xFC, 0x2F, 
+0x78, 0xFE, 0xCC, 0x0D, 0xA0, 0x6F, 0xA0, 0xFF, 
+0x66, 0x9C, 0x7B, 0x3C, 0x61, 0xEA, 0x4E, 0x80, 
+0xD0, 0xC2, 0xDE, 0x2F, 0x2D, 0xD3, 0x52, 0x18, 
+0x5D, 0xAA, 0x93, 0xCB, 0xE6, 0x1F, 0xDE, 0x6B, 
+0xF3, 0x6B, 0x87, 0xE8, 0xE0, 0x9F, 0x96, 0xB7, 
+0x1C, 0x62, 0xF9, 0xC4, 0xAF, 0x4F, 0xFD, 0xEF, 
+0xD4, 0x3C, 0x68, 0xD1, 0x5B, 0xF8, 0xD4, 0xFE, 
+0xA8, 0x2B, 0x72, 0x4A, 0x60, 0x0F, 
+
+0x7C, 0x9F, 0x7F, 0x0D, 0x49, 0x2E, 0xB6, 0xE3, 
+0xE2, 0x07, 0x85, 0xEE, 0x10, 0x8C, 0x29, 0x66, 
+0x16, 0x92, 0x46, 0x6C, 0x67, 0xDC, 0x8D, 0xBB, 
+0xCB, 0xF1, 0x4F, 0xAE, 0x97, 0xEC, 0x9D, 0xF9, 
+0x4D, 0x65, 0x9A, 0xF4, 0xA9, 0x4E, 0xEA, 0x0C, 
+0x2C, 0x73, 0xE6, 0x25, 0x42, 0x3C, 0xE7, 0x97, 
+0xEB, 0x7C, 0xB6, 0xC7, 0x99, 0x4B, 0x80, 0xCD, 
+0x3A, 0x9F, 0x4A, 0xA8, 0xBB, 0x43, 0x1F, 0x71, 
+0xAB, 0x99, 0x9F, 0xE7, 0xEB, 0x95, 0x6C, 0xC2, 
+0x1C, 0x4A, 0x54, 0x25, 0x4B, 0x1C, 0xBE, 0xB7, 
+0xBA, 0x78, 0x10, 0x97, 0xC2, 0xD7, 0xB3, 0x21, 
+0x87, 0xC3, 0x07, 0xFA, 0x41, 0x07, 0x05, 0xA2, 
+0xBB, 0x37, 0x9B, 0xA1, 0xB1, 0x23, 0xD6, 0x5B, 
+0xA8, 0xF6, 0xF8, 0xCC, 0xDB, 0x30, 0x71, 0xCC, 
+0x60, 0x8A, 0x34, 0x3F, 0x64, 0x83, 0xD8, 0x5A, 
+0x08, 0xB6, 0x14, 0x5D, 0x2E, 0xA8, 0x6A, 0x89, 
+0x79, 0x94, 0x5A, 0x7E, 0xCC, 0xAA, 0x83, 0x7D, 
+0x7C, 0x6E, 0xB3, 0x7C, 0x9B, 0xE0, 0xD8, 0x76, 
+0xCA, 0xC2, 0x83, 0xEE, 0x35, 0x80, 0x41, 0x6B, 
+0x4B, 0xA6, 0xB8, 0x41, 0x3F, 0xFB, 0x6C, 0xD3, 
+0xF3, 0x33, 0xF2, 0x17, 0xBC, 0xDF, 0xAA, 0xE0, 
+0x66, 0x0D, 0x86, 0x83, 0xC1, 0x72, 0x74, 0x74, 
+0xD4, 0xD3, 0xFA, 0x3F, 0xB7, 0x38, 0xC2, 0x60, 
+0xC8, 0x75, 0x8B, 0x92, 0x84, 0x0A, 0x5E, 0x7B, 
+0xD9, 0xBE, 0xF2, 0x57, 0x66, 0xDF, 0x80, 0x0A, 
+0xEA, 0x14, 0xFE, 0x0C, 0x25, 0x5A, 0xBF, 0x8C, 
+0x6E, 0xB3, 0x42, 0xEB, 0x2B, 0x71, 0xD3, 0x04, 
+0xEC, 0xD6, 0xCD, 0x78, 0x36, 0x4C, 0xB2, 0x3D, 
+0x50, 0xC7, 0x80, 0x5D, 0x7E, 0xAC, 0x30, 0x62, 
+0x6D, 0xC6, 0x07, 0xB3, 0x95, 0x52, 0xDB, 
+
+0x9F, 0x6C, 0x39, 0x0E, 0x89, 0x5A, 0xF1, 0xF9, 
+0xDB, 0xD0, 0xE1, 0xF0, 0x6D, 0x16, 0xA9, 0xF8, 
+0x04, 0x91, 0x2C, 0x6D, 0xAE, 0x28, 0x0A, 0x1E, 
+0x91, 0xC2, 0xD6, 0x8C, 0x5E, 0x49, 0x9F, 0xDB, 
+0x7F, 0x23, 0xFA, 0x5C, 0x63, 0x06, 0xFD, 0xAF, 
+0x1B, 0xB4, 0xAD, 0xE2, 0x9A, 0x2F, 0x02, 0x48, 
+0x00, 0xEF, 0xF4, 0x6D, 0x74, 0xB7, 0x5B, 0x5D, 
+0xCF, 0x56, 0x43, 0x3C, 0x4D, 0x56, 0x29, 0x01, 
+0xAB, 0x85, 0xF1, 0xFC, 0x43, 0x06, 0x83, 0xAD, 
+0x11, 0xD7, 0xCB, 0xC4, 0x5E, 0x49, 0x6C, 0x52, 
+0xDD, 0x96, 0x99, 0xB5, 0xE3, 0x04, 0x72, 0x12, 
+0x8C, 0xC7, 0x86, 0xD7, 0x96, 0x81, 0x07, 0xDB, 
+0xC3, 0x61, 0x21, 0x59, 0x16, 0x69, 0x5B, 0xCE, 
+0xA5, 0x5C, 0xCD, 0x17, 0xC8, 0x67, 0xC7, 0x37, 
+0xBA, 0xF2, 0x61, 0xFC, 0x92, 0xF7, 0x9F, 0x46, 
+0x5D, 0x66, 0xA4, 0x0B, 0x9F, 0x28, 0xD7, 0x77, 
+0x10, 0x04, 0xC2, 0xE5, 0xCA, 0xA6, 0xBC, 0xEA, 
+0x1C, 0xBA, 0x4D, 0x0D, 0x9B, 0xDA, 0x5A, 0x5E, 
+0xE8, 0x08, 0x3F, 0xDE, 0x97, 0xFC, 0xAE, 0x88, 
+0x43, 0xE2, 0x86, 0x9F, 0x22, 0xCD, 0x50, 0xBE, 
+0xB2, 0xB6, 0xB3, 0x89, 0xF7, 0xD4, 0x41, 0x84, 
+0x3A, 0x58, 0x94, 0x06, 0xC9, 0x67, 0xB6, 0xBE, 
+0x0B, 0xC5, 0x9C, 0x1E, 0xE1, 0x9F, 0xF9, 0x04, 
+0x95, 0xA0, 0x2B, 0x2A, 0x7C, 0xFE, 0x0D, 0xA0, 
+0xD8, 0xA3, 0x28, 0xDA, 0x10, 0x8A, 0xA3, 0x92, 
+0x33, 0xE3, 0x78, 0x52, 0xDC, 0xBD, 0x15, 0xDD, 
+0x1B, 0xD7, 0xC6, 0x05, 0xC6, 0x86, 0x08, 0x11, 
+0xF1, 0x67, 0xA4, 0x95, 0x3D, 0x03, 0xEF, 0x02, 
+0x79, 0x40, 0xA6, 0x7F, 0xB2, 0xFC, 0x68, 0xBD, 
+0x1B, 0x7C, 0x47, 0xD5, 0xBF, 0x50, 0x05, 0xA4, 
+
+0xC6, 0x10, 0xB0, 0x6D, 0x19, 0x35, 0x73, 0xAC, 
+0xD2, 0x84, 0x60, 0xDC, 0x7B, 0xCE, 0xC2, 0x70, 
+0x03, 0xCE, 0x52, 0x6D, 0xE2, 0x2C, 0x8F, 0xAE, 
+0x01, 0xF7, 0xAB, 0xA4, 0x74, 0x20, 0x55, 0xFC, 
+0x26, 0xBC, 0x3C, 0x95, 0xA9, 0xC2, 0x82, 0xBD, 
+0x2C, 0x00, 0x72, 0xBD, 0x8B, 0x92, 0x34, 0x49, 
+0x1A, 0x60, 0x4F, 0xB8, 0x9F, 0x3A, 0x5A, 0xCE, 
+0xB5, 0xAD, 0x97, 0x67, 0x27, 0x58, 0xA4, 0xAE, 
+0xAB, 0x97, 0xFF, 0x17, 0xAA, 0x3A, 0xB9, 0x56, 
+0xD5, 0x40, 0xA8, 0xFE, 0x56, 0xC4, 0x18, 0x09, 
+0x75, 0x35, 0x35, 0xC8, 0x65, 0x9D, 0x44, 0x14, 
+0x4A, 0x44, 0x5B, 0xD6, 0xE8, 0x04, 0x87, 0x11, 
+0x1F, 0x14, 0x6D, 0x61, 0x84, 0x5F, 0xED, 0xEF, 
+0x07, 0x95, 0x16, 0xE7, 0xBE, 0xCE, 0x78, 0x35, 
+0x10, 0x54, 0x5F, 0x55, 0x00, 0x41, 0xC2, 0x80, 
+0xA9, 0x84, 0xBF, 0x8B, 0x95, 0x80, 0x07, 0xB5, 
+0xAF, 0x39, 0x0B, 0xD9, 0xD5, 0x86, 0x89, 0x94, 
+0x2B, 0x07, 0xAF, 0xD3, 0x9F, 0xA0, 0xF1, 0x28, 
+0xC1, 0xB4, 0xFB, 0xE5, 0x1E, 0xA9, 0xF2, 0x3F, 
+0x9E, 0x30, 0x92, 0x49, 0x8E, 0x11, 0xBD, 0x22, 
+0xE6, 0x32, 0xF9, 0x9D, 0x2F, 0x22, 0x04, 0x91, 
+0x8B, 0x62, 0xFC, 0xE1, 0x37, 0x08, 0x72, 0x9B, 
+0x05, 0x28, 0xB6, 0x51, 0xB1, 0xBC, 0x4A, 0x95, 
+0x8E, 0x3C, 0x91, 0x4F, 0xFC, 0x75, 0x7F, 0x34, 
+0x24, 0x9C, 0x56, 0x77, 0x84, 0xD5, 0x73, 0x70, 
+0x66, 0xCE, 0x9B, 0x2B, 0xD3, 0x91, 0x9F, 0xB3, 
+0xDF, 0x6A, 0xEA, 0xE8, 0xCD, 0x2A, 0x87, 0x47, 
+0x49, 0xBF, 0xC5, 0x6A, 0x5B, 0xD4, 0x0C, 0x7C, 
+0xA1, 0xC5, 0x10, 0x4D, 0x12, 0x19, 0x52, 0xB0, 
+0x36, 0x72, 0x0B, 0x46, 0x13, 0x0E, 0xD2, 0xFA, 
+0x58, 
+
+0x40, 0x91, 0x6C, 0xF2, 0x8B, 0x51, 0xCF, 0xB7, 
+0x8B, 0x68, 0x8A, 0x42, 0xD8, 0x12, 0x2D, 0x97, 
+0x69, 0x49, 0xA9, 0xE3, 0xB8, 0x3F, 0xE9, 0xAC, 
+0x4E, 0x77, 0xF5, 0x15, 0x7A, 0x02, 0xB0, 0x58, 
+0x6B, 0xFE, 0x66, 0x45, 0x29, 0x33, 0xE8, 0x23, 
+0xA7, 0x30, 0x99, 0x33, 0x21, 0xA7, 0xFB, 0x9E, 
+0x30, 0xE8, 0xE8, 0x80, 0x2B, 0x3F, 0x83, 0xC5, 
+0x64, 0xB3, 0x56, 0x2A, 0x00, 0xD3, 0x17, 0x69, 
+0x8D, 0x8A, 0xA5, 0x3C, 0xC8, 0x3B, 0x4C, 0x44, 
+0x4F, 0xCC, 0x7A, 0x07, 0x1B, 0x96, 0x8E, 0xE9, 
+0xFC, 0x9B, 0x09, 0xC3, 0xCE, 0x20, 0x31, 0x24, 
+0x5D, 0x2F, 0x14, 0xB7, 0x28, 0xC8, 0x0A, 0xD4, 
+0xB9, 0xE3, 0xB5, 0x45, 0x06, 0x8E, 0x31, 0xFA, 
+0xAE, 0xFD, 0x4D, 0x97, 0x62, 0x9C, 0x82, 0x3B, 
+0xDE, 0xD6, 0x08, 0xF9, 0x5E, 0x77, 0x8F, 0x3E, 
+0xF9, 0xE2, 0x61, 0xFC, 0x92, 0x68, 0xD6, 0x40, 
+0x1B, 0x52, 0x7A, 0x20, 0x0F, 0x8F, 0x29, 0x9D, 
+0xC4, 0x77, 0x1C, 0x2F, 0xBB, 0x79, 0xEF, 0xFA, 
+0x2E, 0x3E, 0x7D, 0xD0, 0x36, 0x7B, 0x23, 0x62, 
+0xA3, 0x02, 0x3B, 0xF0, 0xC9, 0x34, 0x34, 0x57, 
+0xD0, 0x8D, 0xE0, 0x1E, 0xCA, 0xA8, 0x4B, 0x34, 
+0xF1, 0x85, 0x63, 0xEC, 0x8A, 0xA8, 0x8E, 0x2E, 
+0xE9, 0x3F, 0x71, 0x84, 0xB3, 0xA7, 0x86, 0x50, 
+0xA7, 0x8F, 0xF7, 0xD8, 0x99, 0xFB, 0xD1, 0xB2, 
+0xC7, 0xD0, 0x97, 0x57, 0x8C, 0x77, 0xBD, 0xAA, 
+0x59, 0x4F, 0xFD, 0x2F, 0xD5, 0x8F, 0x64, 0x33, 
+0x25, 0x24, 0x52, 0xFF, 0xDF, 0xB1, 0xC8, 0xFC, 
+0x7D, 0x06, 0xF1, 0x25, 0x6C, 0x5D, 0xE9, 0xE6, 
+0x73, 0xCB, 0x30, 0x95, 0xE4, 0xD5, 0x0D, 0x70, 
+0x53, 0x81, 0x82, 0x9C, 0xEB, 0x8F, 0xC1, 0xD9, 
+0xFB, 0xA9, 
+
+0x05, 0xA5, 0x04, 0x3E, 0x57, 0x92, 0xC6, 0xC3, 
+0xBF, 0xD5, 0xBC, 0xFE, 0x1D, 0x58, 0x4A, 0x4E, 
+0xAA, 0xEC, 0x03, 0x25, 0x2B, 0x8E, 0x55, 0x2A, 
+0x3E, 0x03, 0x00, 0x63, 0x4A, 0x54, 0x5A, 0xAE, 
+0xAB, 0x8E, 0xC5, 0x61, 0x4E, 0xE5, 0x26, 0x92, 
+0xC9, 0x2D, 0xB5, 0x93, 0xF8, 0x7E, 0xB7, 0x28, 
+0x8B, 0xBA, 0x3E, 0x7D, 0xE3, 0x7B, 0x54, 0x74, 
+0x45, 0x92, 0xCA, 0xDA, 0xD0, 0x93, 0x0A, 0x15, 
+0xBE, 0x3C, 0x9F, 0x52, 0x26, 0x2A, 0xCF, 0x79, 
+0x0B, 0xC0, 0x89, 0x30, 0x51, 0xD4, 0xD9, 0x62, 
+0x23, 0xA8, 0xDC, 0xAC, 0xD0, 0x9A, 0x04, 0xB2, 
+0x6B, 0x75, 0x51, 0xAB, 0x0F, 0x6B, 0xED, 0xCF, 
+0x2E, 0xF7, 0x63, 0x75, 0x64, 0x06, 0x3C, 0x37, 
+0x0C, 0x6B, 0x8B, 0x24, 0xAE, 0x88, 0x99, 0x11, 
+0x17, 0xF7, 0xFB, 0xB3, 0xF3, 0x7A, 0x36, 0xBA, 
+0xF2, 0xD3, 0x52, 0xE2, 0xC4, 0x8E, 0x62, 0xE9, 
+0xB4, 0x96, 0x41, 0x23, 0x98, 0x87, 0x24, 0xED, 
+0x0B, 0x10, 0xE8, 0xAE, 0x62, 0xFF, 0xAF, 0x27, 
+0x9E, 0xDC, 0x55, 0x45, 0x0D, 0x69, 0x98, 0x67, 
+0x79, 0xB2, 0xD1, 0x2B, 0x13, 0x2E, 0xD5, 0x26, 
+0x80, 0xD1, 0x61, 0xE2, 0x5B, 0xD5, 0xB2, 0xC4, 
+0xC2, 0x57, 0x6F, 0x0F, 0xDD, 0xB4, 0x9C, 0x39, 
+0x4C, 0xB3, 0x8B, 0x7A, 0x16, 0xBB, 0x8B, 0x4D, 
+0xED, 0x6E, 0x91, 0x59, 0x68, 0xA5, 0x9D, 0xE1, 
+0x3B, 0xFF, 0x79, 0x55, 0x30, 0xCF, 0xDC, 0x44, 
+0xB4, 0x1B, 0x00, 0x64, 0xCE, 0xE7, 0x7A, 0xC2, 
+0x57, 0x7B, 0x3A, 0xFB, 0x53, 0x15, 0xE5, 0x28, 
+0x56, 0x35, 0x2A, 0x7A, 0x90, 0xC5, 0x94, 0xAD, 
+0xA6, 0xA2, 0x72, 0x91, 0x29, 0x90, 0x4B, 0x2F, 
+0x8B, 0x86, 0xF8, 0xA6, 0x85, 0x88, 0xEF, 0xCD, 
+0x67, 0xF3, 0xEB, 
+
+0x8B, 0x00, 0x36, 0x38, 0x41, 0x98, 0xA5, 0xFF, 
+0xF4, 0x1B, 0x8B, 0xB5, 0xBC, 0xD0, 0x47, 0xA4, 
+0xD8, 0xDE, 0x79, 0x57, 0xB3, 0x7E, 0x82, 0x94, 
+0x1D, 0x49, 0x69, 0x1B, 0x7B, 0x21, 0x44, 0x6F, 
+0xFB, 0x19, 0xF6, 0x60, 0x94, 0xB0, 0xB9, 0x47, 
+0x6F, 0x6F, 0x54, 0x83, 0xAE, 0xC7, 0xC6, 0xF5, 
+0x8F, 0xCF, 0xCA, 0x12, 0x9A, 0x61, 0x2B, 0x2F, 
+0xE6, 0x32, 0x23, 0x91, 0xB4, 0xCB, 0x0B, 0xEB, 
+0x55, 0xA6, 0x18, 0xE7, 0xDD, 0xD7, 0xC4, 0x35, 
+0x5E, 0xEB, 0xE3, 0xD9, 0x57, 0x41, 0xD3, 0x74, 
+0xF3, 0xB4, 0x0E, 0x1F, 0xE0, 0xE1, 0x04, 0x0A, 
+0x62, 0x07, 0x3E, 0xD0, 0x99, 0x3B, 0x62, 0xFE, 
+0xB7, 0x5A, 0x0B, 0xEC, 0x08, 0x1E, 0x91, 0x58, 
+0x01, 0xDF, 0xFD, 0xC1, 0x68, 0x41, 0x02, 0xA8, 
+0x51, 0x8B, 0xA4, 0xB5, 0x16, 0xD4, 0x38, 0x61, 
+0x7D, 0xF3, 0xF0, 0xA4, 0x9F, 0x89, 0xB3, 0x30, 
+0x04, 0x0F, 0x89, 0x06, 0xDF, 0x81, 0xC6, 0x44, 
+0x29, 0x7D, 0xA1, 0xA0, 0xEF, 0x46, 0x21, 0x2A, 
+0x20, 0xE3, 0xEE, 0x8A, 0x56, 0x13, 0x8E, 0xC1, 
+0x44, 0x68, 0x77, 0x74, 0xB5, 0xB9, 0xA7, 0x19, 
+0x8D, 0xA2, 0x9F, 0xA1, 0x5D, 0xC2, 0x2D, 0x73, 
+0x46, 0x55, 0x93, 0x53, 0x77, 0xE9, 0xD2, 0xD6, 
+0x12, 0x26, 0xBE, 0x11, 0xE3, 0xC8, 0x4E, 0x79, 
+0x3C, 0x81, 0x9F, 0xF5, 0xA9, 0x1C, 0xFB, 0xE2, 
+0xE1, 0xDC, 0xE4, 0x21, 0x7B, 0x0D, 0xD8, 0x70, 
+0xBC, 0x76, 0x1E, 0x8D, 0x84, 0x0D, 0x21, 0xAB, 
+0x19, 0xC2, 0x7C, 0x8E, 0x68, 0x5F, 0x34, 0x6A, 
+0x49, 0x33, 0x5B, 0x96, 0x1D, 0x9F, 0x00, 0x7C, 
+0x02, 0x34, 0xC2, 0x51, 0x8E, 0xF8, 0x17, 0x94, 
+0x06, 0x95, 0x63, 0xA5, 0x41, 0x59, 0x32, 0xBC, 
+0xFA, 0xD4, 0x35, 0x75, 
+
+0xB5, 0x35, 0xB4, 0xCE, 0xF3, 0x6E, 0x35, 0xBE, 
+0x3C, 0x2D, 0x99, 0x5B, 0xC7, 0x2B, 0x70, 0x91, 
+0x8D, 0xFA, 0xAF, 0x06, 0xCC, 0x3E, 0xD5, 0x04, 
+0x61, 0xAB, 0x2F, 0x5B, 0x3B, 0x28, 0x0C, 0xA1, 
+0x63, 0xBA, 0x0E, 0xD7, 0xE7, 0x09, 0xDA, 0x38, 
+0xF5, 0x4C, 0xD3, 0xF2, 0xBC, 0x6F, 0x89, 0x5D, 
+0x89, 0x6D, 0x04, 0xF3, 0x79, 0x8E, 0xBA, 0xB6, 
+0x58, 0x4E, 0x79, 0xB1, 0x0A, 0x86, 0xEE, 0x0E, 
+0xFA, 0x86, 0xA9, 0xC8, 0xD0, 0x2E, 0x35, 0x42, 
+0x81, 0xEC, 0xF1, 0x10, 0x34, 0xA6, 0xD3, 0x65, 
+0xFD, 0x39, 0xCE, 0x2A, 0x1E, 0x71, 0x51, 0xBC, 
+0xA2, 0x68, 0xB7, 0xE8, 0x42, 0x13, 0x27, 0x53, 
+0x46, 0xCC, 0x0E, 0x65, 0xFD, 0x1B, 0x83, 0x7F, 
+0xA2, 0x1B, 0x26, 0xCF, 0xC5, 0xEE, 0x30, 0xB7, 
+0x8F, 0xB0, 0x92, 0x14, 0x60, 0x9D, 0x7E, 0x10, 
+0x82, 0xEF, 0x98, 0xC9, 0x88, 0xA1, 0x80, 0x4D, 
+0x5C, 0x88, 0x52, 0x37, 0x3D, 0x90, 0x59, 0xD6, 
+0x56, 0x0E, 0x1F, 0x50, 0x61, 0x0E, 0xF9, 0x1B, 
+0x13, 0x44, 0x0F, 0xE1, 0x31, 0xBE, 0x3A, 0x5E, 
+0x04, 0xB3, 0xD3, 0xFE, 0xCD, 0xF0, 0xD2, 0x9A, 
+0xB7, 0x64, 0x45, 0xB1, 0xF9, 0x88, 0x34, 0x88, 
+0x00, 0xE1, 0x0D, 0x16, 0x5A, 0x44, 0xE7, 0x9E, 
+0x62, 0xCE, 0x79, 0x2C, 0x27, 0x30, 0x10, 0x94, 
+0x0F, 0xEC, 0x33, 0x72, 0xF0, 0x42, 0x74, 0xEE, 
+0x7D, 0xA0, 0xD3, 0xFE, 0x98, 0x9C, 0x26, 0x3B, 
+0x1E, 0x9A, 0xF2, 0xED, 0xCB, 0x46, 0x70, 0x40, 
+0xA5, 0x12, 0xE8, 0x14, 0xAF, 0x7C, 0x36, 0x30, 
+0x94, 0xAF, 0x42, 0x02, 0x19, 0x5A, 0x7F, 0x95, 
+0x9D, 0x68, 0xEB, 0x46, 0xF2, 0x23, 0xB5, 0xCD, 
+0x5E, 0x2A, 0xD2, 0x17, 0x50, 0xF6, 0x6D, 0xFC, 
+0x00, 0xAE, 0x81, 0x59, 0xED, 
+
+0x6D, 0x28, 0xC2, 0x8C, 0x2A, 0x31, 0x76, 0x59, 
+0x08, 0x3A, 0xEE, 0xAE, 0x3E, 0xE6, 0x85, 0x45, 
+0x18, 0x20, 0xB8, 0x70, 0x63, 0xD1, 0xDA, 0x95, 
+0x46, 0x2F, 0x4E, 0x91, 0xD0, 0x60, 0x46, 0x95, 
+0x48, 0x1B, 0x7B, 0xB0, 0x86, 0x5B, 0x05, 0x28, 
+0xA1, 0xDC, 0x0F, 0xA3, 0x50, 0xF9, 0x66, 0x97, 
+0x74, 0xB4, 0xC4, 0x68, 0x16, 0x89, 0x71, 0x7B, 
+0x30, 0xEC, 0x18, 0xC4, 0x78, 0x2C, 0x93, 0x09, 
+0x7B, 0xE7, 0xD7, 0xF8, 0x94, 0xD7, 0x54, 0xF2, 
+0xB1, 0xBB, 0x40, 0x95, 0xFB, 0x8A, 0x14, 0x5C, 
+0xB7, 0xBF, 0x6C, 0x13, 0x54, 0x30, 0x20, 0xF1, 
+0xE1, 0x8C, 0xF1, 0x7E, 0xFD, 0x93, 0xCA, 0x10, 
+0xD1, 0x21, 0x0F, 0xE6, 0xB8, 0x2D, 0x6C, 0x40, 
+0xD6, 0x2D, 0x94, 0x43, 0x17, 0x86, 0x30, 0x79, 
+0xF2, 0xE1, 0x15, 0xF5, 0x8E, 0x3C, 0xE4, 0xFD, 
+0x0F, 0x89, 0x03, 0x35, 0xEF, 0xAC, 0x9D, 0xF6, 
+0x8B, 0x41, 0x28, 0xCD, 0x4F, 0x4D, 0xB6, 0xD2, 
+0x42, 0x3E, 0x68, 0x2A, 0xE0, 0x83, 0x09, 0xD6, 
+0x3B, 0xD3, 0x16, 0xA6, 0x97, 0x86, 0xAA, 0x03, 
+0x35, 0x39, 0x94, 0x01, 0xD6, 0x60, 0x13, 0x66, 
+0xE8, 0x4F, 0xCB, 0x08, 0x8D, 0x48, 0x5D, 0x3C, 
+0x1A, 0x48, 0x56, 0xBF, 0x5F, 0x2C, 0x7C, 0x70, 
+0x19, 0xC2, 0xCC, 0xDB, 0x8E, 0x8F, 0x64, 0x8C, 
+0x93, 0xC2, 0x6E, 0x34, 0xE7, 0x59, 0x52, 0xB0, 
+0x0F, 0xDA, 0xC1, 0x6A, 0x34, 0xE2, 0x17, 0x58, 
+0x75, 0x47, 0xA0, 0x4F, 0xAA, 0x47, 0xDC, 0x39, 
+0x77, 0x57, 0x64, 0x05, 0x01, 0x38, 0x5B, 0x6C, 
+0xBC, 0x73, 0xF7, 0xE1, 0x4A, 0x3D, 0x93, 0xED, 
+0x4B, 0x2C, 0x18, 0x2B, 0x81, 0x1D, 0xCF, 0xD4, 
+0x93, 0x9D, 0x94, 0x4E, 0x7B, 0x2A, 0x01, 0xAA, 
+0xBC, 0xC1, 0x8F, 0x6F, 0xBB, 0x70, 
+
+0xF1, 0x6C, 0x14, 0x99, 0x1D, 0x4F, 0x6C, 0x52, 
+0x85, 0x03, 0xC2, 0xAC, 0x4B, 0x2B, 0x99, 0xBB, 
+0xF0, 0x68, 0x81, 0xE4, 0x59, 0x0A, 0x23, 0xA3, 
+0xFC, 0x4A, 0x31, 0x07, 0xBB, 0x6F, 0xAE, 0xCD, 
+0x9E, 0x83, 0xC9, 0x01, 0x6D, 0x49, 0x21, 0xDF, 
+0x3A, 0x4A, 0xB5, 0xA1, 0x0E, 0xD3, 0xC7, 0xA8, 
+0x20, 0x6E, 0xD0, 0x81, 0x96, 0x5A, 0x85, 0x71, 
+0xD0, 0xB3, 0x30, 0x01, 0x1D, 0x9C, 0x4A, 0x44, 
+0x36, 0xAC, 0x3B, 0xAA, 0x0A, 0x2F, 0x98, 0x65, 
+0xCF, 0xFE, 0xF2, 0x46, 0xD7, 0xC3, 0xCC, 0x1B, 
+0xB3, 0x95, 0x53, 0x44, 0x8C, 0x2B, 0x28, 0x66, 
+0x9C, 0x33, 0x88, 0x19, 0xF3, 0xCD, 0x1E, 0xD9, 
+0x84, 0xF5, 0x27, 0x4A, 0x47, 0x27, 0x19, 0xE3, 
+0xE5, 0xFE, 0xC5, 0x8E, 0x10, 0xA0, 0x69, 0x09, 
+0x2E, 0xBD, 0x09, 0x57, 0x02, 0x9E, 0xB2, 0x44, 
+0xE1, 0xE9, 0x4D, 0xB4, 0xD6, 0x2E, 0x6B, 0x7B, 
+0x33, 0xD9, 0x55, 0x5E, 0x3A, 0x59, 0x03, 0x58, 
+0x2D, 0xEF, 0x88, 0xC0, 0x1F, 0xAF, 0x29, 0xC2, 
+0x34, 0xA0, 0x99, 0xDB, 0xD1, 0x2B, 0xAA, 0x31, 
+0xBF, 0x7E, 0x92, 0x19, 0xBF, 0x6B, 0xDA, 0x6B, 
+0xDF, 0x60, 0xB7, 0x77, 0xAB, 0xAE, 0xB4, 0xA0, 
+0x12, 0xA6, 0xAC, 0x1C, 0x24, 0xC1, 0x7B, 0xDA, 
+0xC0, 0x49, 0x23, 0xCE, 0xE5, 0x98, 0xC2, 0x46, 
+0x61, 0x9B, 0x17, 0x83, 0x4F, 0x8A, 0x71, 0x8D, 
+0x9D, 0x1D, 0xFC, 0x5B, 0xF0, 0x17, 0x25, 0x57, 
+0xA1, 0xAA, 0x3C, 0x81, 0x48, 0xBD, 0x20, 0x4D, 
+0x62, 0x02, 0xF7, 0x16, 0xE5, 0xCF, 0x12, 0x54, 
+0xC3, 0xC2, 0x87, 0xFE, 0x44, 0xC8, 0x84, 0x10, 
+0x10, 0x8B, 0xFC, 0x47, 0x96, 0xFB, 0x8C, 0xCA, 
+0xE5, 0x02, 0xF9, 0xAA, 0x0C, 0xCB, 0x1B, 0x99, 
+0xCD, 0x4D, 0xA4, 0xB2, 0xD4, 0xCF, 0x17, 
+
+0x05, 0x74, 0x85, 0x08, 0x12, 0x84, 0xFC, 0x30, 
+0x17, 0xCD, 0xB8, 0xCA, 0x70, 0x41, 0x20, 0x51, 
+0xDE, 0x45, 0xCA, 0xA2, 0xF4, 0x26, 0xB0, 0x9F, 
+0x1A, 0x20, 0x8A, 0xD6, 0x36, 0xBF, 0xC5, 0x8F, 
+0xAA, 0xE6, 0xF6, 0x1F, 0x4A, 0x39, 0xBE, 0x0C, 
+0xF4, 0x7C, 0xEB, 0x88, 0xB7, 0x4F, 0x09, 0xFD, 
+0xCB, 0x2E, 0x35, 0xED, 0x5F, 0xA9, 0xF9, 0x81, 
+0x66, 0x2D, 0xF2, 0x08, 0x41, 0x18, 0xF9, 0xD9, 
+0xF5, 0x42, 0x69, 0x04, 0x94, 0x29, 0xBF, 0xAF, 
+0x99, 0xE9, 0xA5, 0x79, 0xED, 0x19, 0x0B, 0x9A, 
+0xA9, 0x4D, 0xDC, 0xA1, 0x36, 0xE0, 0xA0, 0xD3, 
+0xFB, 0xBF, 0x90, 0x44, 0x42, 0x97, 0x87, 0xD4, 
+0x26, 0x65, 0x32, 0x02, 0xC2, 0x22, 0x23, 0x70, 
+0xC3, 0x6E, 0x50, 0x5A, 0x96, 0x76, 0xE9, 0x84, 
+0xC7, 0x6B, 0xF9, 0x9A, 0xC0, 0x19, 0x93, 0x4C, 
+0x9D, 0x39, 0x8E, 0xB3, 0x99, 0x88, 0x52, 0xA1, 
+0x03, 0x0E, 0x84, 0xF3, 0x44, 0x26, 0x5E, 0x38, 
+0x67, 0xF6, 0xDB, 0x2A, 0x0F, 0x82, 0x03, 0x10, 
+0xC0, 0x9E, 0xFC, 0x63, 0x75, 0x97, 0x45, 0x6C, 
+0xA5, 0xE0, 0xD0, 0x59, 0xBD, 0x53, 0x66, 0x0F, 
+0xA6, 0x77, 0x16, 0xD4, 0xF2, 0x89, 0xF5, 0xD9, 
+0x61, 0x57, 0xF1, 0xF5, 0x85, 0x74, 0xDB, 0xD7, 
+0x79, 0x47, 0xD4, 0x85, 0x1D, 0xC3, 0xD5, 0x25, 
+0x22, 0x37, 0x50, 0x97, 0xB9, 0xB5, 0x25, 0xF2, 
+0x8A, 0x38, 0xD3, 0xAF, 0x26, 0xA9, 0x7F, 0x04, 
+0x95, 0xBB, 0x1B, 0xBA, 0x72, 0x13, 0x18, 0x11, 
+0xD4, 0x9B, 0x77, 0x42, 0xB9, 0x5F, 0x53, 0x17, 
+0x1B, 0xCC, 0x30, 0xD3, 0x8A, 0xB6, 0x78, 0x6C, 
+0xA6, 0x89, 0x9D, 0xAE, 0xBC, 0x91, 0x3F, 0x92, 
+0x8B, 0xF1, 0xA4, 0x95, 0xA4, 0x6E, 0xD9, 0xA2, 
+0xEC, 0x11, 0xA5, 0x74, 0xE6, 0x29, 0x1E, 0x16, 
+
+0xAA, 0x12, 0x53, 0xD6, 0x5E, 0x72, 0xDB, 0xE8, 
+0xD1, 0xAF, 0x5D, 0x7E, 0xAD, 0x20, 0x13, 0xBC, 
+0x91, 0x4D, 0x27, 0x52, 0xCF, 0x73, 0x29, 0x7F, 
+0x57, 0x2C, 0x0B, 0x45, 0x06, 0xFF, 0xE0, 0xF4, 
+0x52, 0xFF, 0xB8, 0x25, 0xEC, 0xB6, 0xCF, 0x41, 
+0x02, 0x62, 0x1E, 0x54, 0xA4, 0xC5, 0xAC, 0x48, 
+0x36, 0xEA, 0x91, 0x2C, 0x6F, 0xDE, 0x50, 0x57, 
+0x9D, 0xFE, 0x46, 0x1B, 0xDC, 0x11, 0x68, 0xE8, 
+0x0E, 0xFB, 0x3D, 0xC1, 0x1C, 0x0C, 0x93, 0x21, 
+0xA3, 0xD3, 0x23, 0x33, 0xB4, 0x21, 0xD8, 0xA5, 
+0x3A, 0x40, 0x20, 0x62, 0xE7, 0xC7, 0xBC, 0xBE, 
+0xBA, 0x37, 0xAA, 0x92, 0xDD, 0x71, 0xB5, 0x4A, 
+0x2E, 0xC8, 0x64, 0xD8, 0xCD, 0x18, 0x01, 0xE8, 
+0xDB, 0x75, 0x30, 0xF5, 0x07, 0x1C, 0x3D, 0x2E, 
+0x5B, 0x68, 0x1E, 0x12, 0x5C, 0x98, 0x43, 0xD2, 
+0xD6, 0x43, 0x4D, 0xAE, 0xCD, 0x9A, 0xC8, 0x34, 
+0x6E, 0x74, 0xB4, 0x64, 0x84, 0xC5, 0x02, 0xBA, 
+0x2B, 0x9A, 0xAC, 0x14, 0x6B, 0x9F, 0x7D, 0x4F, 
+0xAC, 0xD5, 0xEE, 0xF7, 0x4D, 0xA4, 0x75, 0x1A, 
+0xD8, 0x0C, 0x76, 0x85, 0x84, 0x6B, 0x7C, 0xBA, 
+0x0A, 0xAA, 0xF2, 0x22, 0xA4, 0x7E, 0x5E, 0x57, 
+0x81, 0x87, 0xCE, 0xAF, 0x52, 0x35, 0x53, 0x1A, 
+0x25, 0xD0, 0xF0, 0x15, 0x18, 0xB4, 0x99, 0x4D, 
+0xFB, 0x74, 0xEA, 0xE6, 0xDA, 0xA0, 0xB4, 0x71, 
+0x8F, 0xBD, 0x1E, 0x36, 0x13, 0x4E, 0xA1, 0x3E, 
+0x06, 0xA1, 0x64, 0x84, 0x54, 0x85, 0x4E, 0x7B, 
+0x28, 0x49, 0x77, 0xBA, 0x55, 0xAC, 0xDA, 0x81, 
+0x4C, 0x0A, 0x57, 0x35, 0xB9, 0xD5, 0xE4, 0x51, 
+0xBA, 0xA7, 0x2E, 0xA1, 0x57, 0x49, 0xD9, 0x8C, 
+0x0E, 0xAE, 0xCC, 0x19, 0xDE, 0x5B, 0x13, 0x00, 
+0xC6, 0x9E, 0x68, 0x73, 0xC3, 0x9C, 0x0A, 0xAA, 
+0x57, 
+
+0x41, 0x3A, 0xC8, 0xF8, 0x00, 0x43, 0xCD, 0xB4, 
+0x09, 0xCF, 0x98, 0x4C, 0x80, 0x27, 0x6D, 0x89, 
+0x2F, 0x25, 0x74, 0xED, 0x0D, 0xE0, 0xC7, 0x03, 
+0xD5, 0xFC, 0x26, 0x2F, 0xCB, 0xB9, 0x4E, 0xA3, 
+0xD5, 0xC7, 0xCA, 0x4A, 0xBB, 0x42, 0xEC, 0x92, 
+0x0A, 0xAA, 0x94, 0x2B, 0xCF, 0xC2, 0x08, 0x1C, 
+0xA9, 0xE0, 0x9D, 0x7A, 0xF7, 0x46, 0x27, 0x41, 
+0xA1, 0xC5, 0xC7, 0x6B, 0x24, 0xC3, 0xE4, 0x57, 
+0x6E, 0xF6, 0xAF, 0x8D, 0xC4, 0xEE, 0x24, 0xD3, 
+0x25, 0x1A, 0xCC, 0x26, 0x65, 0xDE, 0xBF, 0x5D, 
+0x43, 0x3F, 0x74, 0x01, 0xBF, 0x30, 0x00, 0x35, 
+0x8C, 0x84, 0xAA, 0x3F, 0xB0, 0x99, 0x56, 0x2A, 
+0x41, 0xA3, 0xA4, 0x61, 0x60, 0x15, 0x2D, 0xAF, 
+0xBE, 0xFB, 0xEA, 0xBB, 0x52, 0x1B, 0x18, 0xCD, 
+0x0D, 0x5E, 0x84, 0xFF, 0xB5, 0x11, 0x88, 0x36, 
+0x34, 0xD9, 0x5E, 0x8C, 0xD9, 0x71, 0x5E, 0x4F, 
+0x0D, 0x33, 0xA7, 0xD9, 0x60, 0x3E, 0xF4, 0x97, 
+0x96, 0xE2, 0x5A, 0xBF, 0x4A, 0xC3, 0x88, 0x57, 
+0x70, 0x5F, 0x0F, 0x8B, 0x65, 0x8B, 0x49, 0x9A, 
+0x41, 0x98, 0xEB, 0x24, 0x78, 0xBA, 0x61, 0xCE, 
+0xBF, 0x37, 0x92, 0x75, 0x4D, 0x74, 0x61, 0xCA, 
+0xE6, 0x0E, 0x3E, 0x75, 0xA4, 0xC9, 0xF6, 0x03, 
+0xB5, 0xFC, 0x24, 0xC1, 0x35, 0xBF, 0x2F, 0x1C, 
+0xC9, 0x27, 0x9C, 0x7D, 0x56, 0xF2, 0x27, 0xB0, 
+0xB2, 0x64, 0x6E, 0xB9, 0xF6, 0x6F, 0xBF, 0x78, 
+0xBE, 0x94, 0x0D, 0xCE, 0x5C, 0x47, 0xCC, 0x7F, 
+0xEF, 0xB8, 0x81, 0xA0, 0x5D, 0x1E, 0xEC, 0xC8, 
+0x6F, 0x8E, 0xC1, 0x82, 0xA4, 0xB1, 0x41, 0xF2, 
+0xC0, 0x95, 0x16, 0x6A, 0x3A, 0x37, 0x65, 0x8B, 
+0xDB, 0x55, 0xE2, 0xD3, 0xF0, 0x30, 0x8E, 0xA3, 
+0x2E, 0x45, 0xB8, 0xB7, 0x4E, 0x4B, 0xB7, 0x9D, 
+0x3A, 0xEF, 
+
+0x6D, 0xBA, 0x38, 0xC4, 0xF9, 0xAB, 0xD8, 0x80, 
+0x4B, 0x17, 0xBC, 0x05, 0x43, 0x53, 0x5E, 0x2A, 
+0x97, 0x9D, 0x5D, 0xB9, 0x98, 0xEA, 0x4B, 0xAD, 
+0x36, 0x49, 0xAD, 0xF0, 0xDA, 0x3E, 0x09, 0x4B, 
+0xBF, 0x78, 0x07, 0x4B, 0xE8, 0xBD, 0x98, 0x14, 
+0x48, 0x6D, 0xF4, 0x1C, 0xDA, 0xAF, 0x29, 0x29, 
+0xBF, 0xA6, 0xF5, 0x98, 0x35, 0x25, 0x9A, 0x56, 
+0xE5, 0x0D, 0x82, 0x8B, 0xCD, 0x9D, 0x4D, 0xE9, 
+0x4A, 0x41, 0x4B, 0x53, 0x7C, 0x66, 0x9B, 0x32, 
+0x08, 0x4A, 0xD6, 0xED, 0x49, 0xED, 0xEE, 0x6C, 
+0xCE, 0x89, 0xBA, 0x70, 0xC1, 0x4D, 0x24, 0xE3, 
+0x91, 0x90, 0x8C, 0x95, 0x33, 0x65, 0xAC, 0x6A, 
+0x93, 0xEE, 0x79, 0x6C, 0x3E, 0x22, 0xB5, 0xAC, 
+0x4C, 0x62, 0x17, 0xD3, 0xC7, 0x62, 0x3E, 0xF8, 
+0x46, 0x21, 0x89, 0xE5, 0x43, 0x08, 0x9E, 0x58, 
+0x87, 0x09, 0x3C, 0xE0, 0xDE, 0x43, 0x1F, 0x55, 
+0x35, 0x64, 0x5A, 0x70, 0xFB, 0x0B, 0x05, 0xE0, 
+0x76, 0x9E, 0xC9, 0x2B, 0x16, 0xE6, 0x33, 0x3C, 
+0xCC, 0xB3, 0xA7, 0x66, 0x7C, 0x5A, 0x93, 0x79, 
+0x8A, 0xF6, 0x1A, 0x22, 0xC9, 0x91, 0x1F, 0xFD, 
+0x2C, 0xD7, 0xCB, 0x48, 0x76, 0x7F, 0x38, 0xE9, 
+0xDD, 0x8C, 0x19, 0x03, 0x92, 0x90, 0xB7, 0x5A, 
+0x9B, 0x01, 0xE2, 0xC3, 0x07, 0xDE, 0xFE, 0x8B, 
+0xEF, 0xF6, 0x81, 0xCF, 0x2A, 0x02, 0x94, 0xB3, 
+0x26, 0x1B, 0x40, 0x96, 0x07, 0x7B, 0x4F, 0xE4, 
+0x4A, 0x0F, 0x6C, 0xFD, 0xA4, 0x6C, 0x23, 0xF2, 
+0xA0, 0xA7, 0x73, 0xD8, 0xEC, 0x02, 0x8B, 0x55, 
+0x61, 0xED, 0x92, 0x53, 0xE0, 0x4A, 0x51, 0x84, 
+0xAF, 0x4B, 0x4D, 0x19, 0xF3, 0xB5, 0xDC, 0xB0, 
+0xB0, 0x5C, 0xC5, 0x3B, 0xAD, 0xFD, 0x99, 0x91, 
+0xE3, 0xC7, 0x02, 0xFD, 0x0D, 0xC6, 0xFB, 0xD4, 
+0xD7, 0xC8, 0x31, 
+
+0xA6, 0x88, 0xCF, 0xC4, 0x6F, 0xE4, 0x4B, 0x6C, 
+0x9A, 0xEC, 0xFB, 0xB8, 0x66, 0x0D, 0xA0, 0xC1, 
+0x81, 0x57, 0x15, 0x57, 0xD1, 0x60, 0x8A, 0xF0, 
+0x69, 0x97, 0x7F, 0x24, 0x58, 0x13, 0xE3, 0x6B, 
+0xEF, 0xC0, 0xF9, 0xA4, 0xA4, 0xB7, 0x0C, 0x3A, 
+0x95, 0x84, 0x5C, 0xE1, 0x7A, 0x04, 0x00, 0x64, 
+0x7C, 0x33, 0x14, 0x5F, 0x38, 0x4E, 0xB9, 0x66, 
+0x38, 0x85, 0x06, 0x22, 0xB3, 0x02, 0x30, 0xA0, 
+0xE6, 0xA9, 0x76, 0xD6, 0x47, 0x07, 0x35, 0x80, 
+0xA9, 0x5E, 0x77, 0x23, 0x94, 0x4C, 0x10, 0x44, 
+0xC2, 0x7C, 0xCE, 0xB5, 0x0B, 0xAC, 0x9D, 0x21, 
+0xBB, 0xCD, 0x09, 0x54, 0x2B, 0x14, 0x03, 0xD8, 
+0x26, 0x9F, 0xF5, 0xC2, 0xE4, 0x0E, 0x55, 0xA9, 
+0x77, 0xDF, 0x06, 0x3C, 0x69, 0x70, 0xC7, 0xB0, 
+0xB9, 0xAF, 0xA3, 0x40, 0x93, 0xD3, 0xBF, 0x97, 
+0xC7, 0x08, 0xCF, 0x4B, 0x3B, 0x82, 0xBA, 0x2C, 
+0x96, 0x6F, 0x00, 0xA4, 0x48, 0xD8, 0xBE, 0x91, 
+0xBB, 0xC1, 0x07, 0x91, 0x9D, 0xAD, 0x27, 0xBF, 
+0x85, 0xC3, 0x3A, 0x4F, 0xF4, 0xC9, 0xE9, 0x38, 
+0xA5, 0x41, 0xFE, 0xC4, 0xA8, 0x3D, 0x6E, 0xBE, 
+0x69, 0xB9, 0xBE, 0x11, 0xE8, 0x21, 0x63, 0xF8, 
+0x5A, 0x65, 0x24, 0xEC, 0x81, 0x16, 0x87, 0x5D, 
+0x9F, 0xA0, 0x35, 0x67, 0x74, 0x39, 0x74, 0xE2, 
+0x99, 0x01, 0xBE, 0xCA, 0x9A, 0xDC, 0x92, 0x38, 
+0x9B, 0x00, 0xD0, 0x72, 0x1B, 0xD4, 0xE7, 0x43, 
+0x2D, 0x1D, 0x84, 0xB6, 0xBC, 0xE4, 0x6F, 0xD2, 
+0x26, 0x7C, 0x1D, 0xDE, 0xDB, 0x5E, 0xCF, 0x0F, 
+0x42, 0x34, 0xCB, 0xCF, 0x5F, 0xC1, 0x10, 0x0C, 
+0x57, 0x1B, 0x18, 0xE1, 0x8F, 0x4D, 0x52, 0xDD, 
+0x4E, 0xA6, 0x57, 0x49, 0x7D, 0xDB, 0x4E, 0x7F, 
+0x60, 0x01, 0xFE, 0x43, 0xD3, 0xBB, 0x68, 0x85, 
+0x3B, 0x3D, 0x01, 0xDD, 
+
+0x24, 0x21, 0xF4, 0xEF, 0x9C, 0x1A, 0x79, 0xD3, 
+0x53, 0x1F, 0x12, 0x48, 0xEA, 0xD1, 0x9A, 0x28, 
+0x8E, 0xEE, 0x74, 0xD9, 0xF0, 0x47, 0x5C, 0x95, 
+0xCA, 0xFE, 0x3C, 0x1F, 0xFF, 0x73, 0x07, 0x1A, 
+0x4C, 0xE4, 0x74, 0xC2, 0x93, 0xA7, 0x05, 0x8C, 
+0x29, 0x50, 0xFF, 0x60, 0xAF, 0x7A, 0x05, 0x48, 
+0x88, 0x4A, 0xBF, 0xD1, 0x8E, 0x29, 0xB1, 0xC1, 
+0x56, 0x18, 0xBB, 0x51, 0x0D, 0x4C, 0xD1, 0x1D, 
+0xF8, 0x07, 0x7E, 0x37, 0x4B, 0x31, 0xAD, 0xA4, 
+0xDF, 0x17, 0x52, 0x50, 0x02, 0x02, 0x45, 0x57, 
+0xD7, 0x42, 0x13, 0xB7, 0x2F, 0xD2, 0x06, 0xF6, 
+0x48, 0xBC, 0x33, 0xC4, 0x11, 0x51, 0x00, 0x1A, 
+0x88, 0x8F, 0x8D, 0x8A, 0xED, 0x06, 0x01, 0x54, 
+0x02, 0x13, 0x77, 0xD5, 0x90, 0x7D, 0x6C, 0xAD, 
+0x57, 0x75, 0x3A, 0x06, 0xEE, 0x87, 0x4A, 0x55, 
+0xAF, 0x9F, 0x30, 0x09, 0x3D, 0xA7, 0x1B, 0x7E, 
+0x1A, 0x36, 0x6F, 0x0B, 0x6C, 0xDE, 0x22, 0xFA, 
+0xA2, 0x79, 0xCE, 0x09, 0xC9, 0x91, 0xA0, 0xB6, 
+0x24, 0x50, 0x88, 0xCA, 0x88, 0xDC, 0x9F, 0x4E, 
+0x9C, 0x80, 0x14, 0x46, 0x21, 0xD3, 0x51, 0xBD, 
+0xA0, 0x53, 0xF0, 0xB8, 0x60, 0x17, 0x85, 0x2F, 
+0x78, 0x1F, 0xB4, 0x87, 0xB8, 0x58, 0x64, 0x79, 
+0x2C, 0x22, 0xA7, 0x5B, 0x63, 0x75, 0x34, 0xE8, 
+0x6E, 0xEF, 0x5A, 0xE8, 0xCC, 0xC4, 0x78, 0x1D, 
+0x97, 0xDB, 0x9A, 0x85, 0x24, 0x7A, 0x57, 0xE0, 
+0x83, 0x43, 0x11, 0x6C, 0x73, 0xA7, 0xFA, 0x55, 
+0x0D, 0x38, 0xD0, 0x3C, 0x87, 0x62, 0x95, 0x56, 
+0xF4, 0xB8, 0x67, 0x6E, 0xB6, 0xD5, 0x33, 0x16, 
+0x0D, 0x7E, 0xD5, 0x29, 0xB1, 0x60, 0x28, 0xF2, 
+0x40, 0xB6, 0x2B, 0xAC, 0x0E, 0xAC, 0x4A, 0xB5, 
+0x7B, 0xC9, 0xDD, 0xCC, 0x6F, 0x6A, 0xDD, 0x97, 
+0x17, 0x73, 0xB1, 0x88, 0x67, 
+
+0x30, 0xCA, 0x69, 0xCA, 0x74, 0xAE, 0x45, 0x6D, 
+0x69, 0xB3, 0xA5, 0xF4, 0xEA, 0x31, 0x54, 0xAF, 
+0x70, 0x80, 0xA6, 0xE5, 0x7E, 0x44, 0xF5, 0xA3, 
+0xEA, 0x2F, 0xB8, 0xA9, 0x1A, 0x99, 0x58, 0xCE, 
+0x54, 0xD0, 0x89, 0x51, 0x58, 0xC2, 0x12, 0x59, 
+0x2E, 0x56, 0x86, 0x03, 0xE7, 0x27, 0x02, 0x64, 
+0x35, 0x4A, 0x3C, 0x0F, 0x1E, 0x19, 0x37, 0x86, 
+0x5C, 0xD3, 0x51, 0xCD, 0xFB, 0x88, 0xDB, 0xA1, 
+0x87, 0xD8, 0x65, 0xA0, 0x2C, 0xE0, 0xC5, 0x37, 
+0x4B, 0x5A, 0x3D, 0xF9, 0xDE, 0x5D, 0x1F, 0x6B, 
+0x38, 0xA3, 0x8F, 0x78, 0x6C, 0x5E, 0x6B, 0x80, 
+0x02, 0x69, 0x87, 0xCF, 0x12, 0x95, 0x18, 0x4B, 
+0xFC, 0x95, 0x2F, 0xC6, 0x0D, 0xEE, 0x58, 0x08, 
+0x86, 0xE9, 0x67, 0x52, 0xCA, 0xF3, 0x1D, 0xA1, 
+0x3A, 0xD6, 0x8C, 0x82, 0x3A, 0x9E, 0x27, 0xEF, 
+0xF4, 0x25, 0x78, 0x5C, 0x2E, 0x66, 0xCE, 0x52, 
+0xA0, 0x1A, 0x96, 0x8D, 0x08, 0xEC, 0x34, 0x9C, 
+0xBF, 0x74, 0xE3, 0xAA, 0x5B, 0xB5, 0x13, 0xAE, 
+0x5C, 0x60, 0xE9, 0x83, 0x13, 0x6D, 0x9D, 0x5E, 
+0x14, 0x95, 0x06, 0x1F, 0x46, 0x05, 0x13, 0xE1, 
+0xE9, 0xA7, 0xC5, 0x93, 0xEE, 0xCD, 0xB0, 0x1C, 
+0x1F, 0x06, 0xBC, 0x78, 0x3E, 0x86, 0x8B, 0xCF, 
+0x39, 0xB1, 0xC1, 0x2E, 0x6A, 0x56, 0x50, 0x90, 
+0xC9, 0x97, 0x3C, 0x6E, 0x24, 0xF4, 0xDC, 0xA0, 
+0xA6, 0xDE, 0x2D, 0x2D, 0x1F, 0x74, 0x63, 0x54, 
+0x3F, 0x3F, 0x7D, 0xB6, 0x60, 0x06, 0x49, 0x2C, 
+0x62, 0x0A, 0x04, 0xDB, 0x96, 0xC9, 0x65, 0x61, 
+0x05, 0xDA, 0x46, 0x10, 0x3A, 0x0F, 0x51, 0x93, 
+0x2B, 0x80, 0xB8, 0x48, 0x23, 0xE9, 0x6F, 0x33, 
+0x54, 0x2A, 0xAA, 0x37, 0xBE, 0xD2, 0x18, 0xB6, 
+0x59, 0x32, 0xAD, 0xAE, 0x16, 0x59, 0x37, 0x69, 
+0x71, 0x31, 0x84, 0x2A, 0x7F, 0x78, 
+
+0xD2, 0x1F, 0x2F, 0x78, 0x79, 0x72, 0xE4, 0xB6, 
+0xBA, 0xC4, 0x7C, 0x38, 0x4F, 0x10, 0x81, 0x6C, 
+0xAC, 0x1D, 0xE4, 0x5E, 0x56, 0x96, 0x01, 0x39, 
+0x77, 0xA9, 0x81, 0xD0, 0xDB, 0x01, 0xF8, 0xD1, 
+0x71, 0x0C, 0x35, 0x91, 0xD5, 0x28, 0xAC, 0xAF, 
+0x38, 0xDB, 0xC6, 0x29, 0x4A, 0x9D, 0x95, 0x04, 
+0xBC, 0x1E, 0x2A, 0x40, 0x2B, 0x86, 0x46, 0x85, 
+0x61, 0xBD, 0xCC, 0xA9, 0x63, 0x09, 0x59, 0x98, 
+0x91, 0x66, 0xE6, 0x85, 0xB3, 0x94, 0x47, 0xDC, 
+0x35, 0x2B, 0x32, 0xE0, 0x1A, 0x89, 0xE7, 0xE3, 
+0x29, 0x4F, 0xB6, 0x54, 0x6C, 0x73, 0x5F, 0xC8, 
+0xC1, 0x95, 0x92, 0xAF, 0x03, 0x3B, 0xFC, 0x43, 
+0xFA, 0x2F, 0x87, 0x18, 0x46, 0x4D, 0x23, 0x57, 
+0x84, 0x1C, 0x14, 0xDA, 0x0B, 0x31, 0x77, 0xC8, 
+0x50, 0x9C, 0xF9, 0x97, 0x17, 0x4C, 0x9E, 0x68, 
+0x75, 0x62, 0x5F, 0x72, 0x93, 0x61, 0xCE, 0x43, 
+0x14, 0x7E, 0x2F, 0x14, 0x31, 0x6C, 0x7E, 0x34, 
+0x78, 0xC4, 0xF2, 0xA7, 0x73, 0xD2, 0x87, 0x8B, 
+0xD9, 0xF6, 0x5B, 0xB3, 0x0D, 0x14, 0x4D, 0x7B, 
+0xB1, 0xD1, 0x69, 0xF5, 0x7D, 0x69, 0x89, 0x06, 
+0x65, 0xA4, 0x4B, 0xD0, 0x37, 0xEE, 0xE2, 0x26, 
+0x72, 0xAE, 0xD0, 0xE8, 0xBB, 0x0F, 0xE2, 0x34, 
+0x47, 0x0A, 0x47, 0xBD, 0x97, 0x63, 0x2E, 0xB2, 
+0x11, 0x9A, 0x33, 0x37, 0x23, 0xEC, 0x3C, 0x02, 
+0x62, 0x70, 0x7E, 0xD0, 0x84, 0x5E, 0x2A, 0x8A, 
+0xC0, 0xB6, 0x1F, 0x1A, 0x0F, 0x38, 0x8E, 0xBD, 
+0xDD, 0xF7, 0xC9, 0x51, 0xFB, 0x84, 0x63, 0x3A, 
+0x54, 0x1E, 0xB4, 0x3C, 0x17, 0x24, 0xE9, 0x28, 
+0x43, 0xC0, 0x5C, 0xEA, 0x33, 0xB6, 0x7D, 0x7C, 
+0x9C, 0x8C, 0x90, 0x39, 0x9A, 0x22, 0x4B, 0xC8, 
+0x31, 0x45, 0x76, 0xC5, 0x68, 0x1A, 0x43, 0xCF, 
+0x57, 0x42, 0x28, 0x57, 0xC6, 0x82, 0x3C, 
+
+0xFA, 0x60, 0xB8, 0x28, 0xD9, 0x7D, 0x96, 0x8E, 
+0x7D, 0x50, 0x26, 0x22, 0xDB, 0xD5, 0xA3, 0xD5, 
+0x6C, 0x35, 0x4A, 0xEB, 0x22, 0xE4, 0x08, 0x5C, 
+0x5B, 0x5F, 0xEF, 0xBE, 0x0C, 0xA7, 0xB2, 0x09, 
+0xFE, 0xAD, 0x48, 0x3F, 0x59, 0x95, 0xF9, 0x2B, 
+0xCA, 0xDC, 0xDD, 0xD4, 0x98, 0x76, 0x36, 0x7B, 
+0xF5, 0x57, 0x59, 0x8D, 0xC8, 0x45, 0x42, 0x3B, 
+0x00, 0x2C, 0xDE, 0xB5, 0x9C, 0x0E, 0xFA, 0x17, 
+0x8E, 0x3D, 0x56, 0xFF, 0x29, 0x7B, 0x6E, 0x6D, 
+0xE0, 0x9A, 0x89, 0xC7, 0xEC, 0x96, 0x86, 0x74, 
+0x64, 0x26, 0xE9, 0x49, 0x1F, 0xD9, 0xDD, 0x9F, 
+0x6B, 0xF6, 0x06, 0x81, 0xB8, 0x6D, 0xA9, 0x5D, 
+0xA9, 0x63, 0xCD, 0x36, 0xBC, 0xDF, 0x23, 0x81, 
+0x97, 0x98, 0x14, 0x93, 0xBE, 0x83, 0xBB, 0xA8, 
+0x08, 0xCE, 0x4D, 0x44, 0xA0, 0x0B, 0x07, 0xDC, 
+0x83, 0x91, 0x84, 0x53, 0x16, 0x98, 0x19, 0x22, 
+0x81, 0x38, 0xC8, 0xC0, 0x03, 0x6E, 0x15, 0x96, 
+0x7D, 0xAF, 0x98, 0x60, 0xAC, 0x40, 0x39, 0xCA, 
+0x9B, 0xF2, 0x68, 0x31, 0x6E, 0xD9, 0x14, 0x1A, 
+0x0E, 0x5E, 0xB1, 0xFF, 0x49, 0x95, 0x6D, 0x64, 
+0xC7, 0x8C, 0x62, 0x84, 0x9E, 0x50, 0xCD, 0x4B, 
+0x60, 0x32, 0x39, 0x20, 0xC9, 0x4B, 0x68, 0x91, 
+0xB9, 0x1D, 0xBE, 0x7C, 0x74, 0x42, 0x7C, 0xFA, 
+0x5A, 0x93, 0xA9, 0x93, 0x41, 0x6A, 0x8F, 0xE3, 
+0x92, 0x9A, 0x46, 0x71, 0x3F, 0x4F, 0xD1, 0x8A, 
+0x30, 0x5A, 0x42, 0x38, 0x2C, 0xE4, 0x2F, 0x33, 
+0x06, 0x85, 0x07, 0x28, 0x76, 0xC1, 0x37, 0xB1, 
+0x74, 0x6E, 0x50, 0xE2, 0x16, 0x79, 0x9A, 0x79, 
+0xE6, 0xE7, 0x48, 0x7C, 0x89, 0x32, 0xC8, 0xAA, 
+0x43, 0x4E, 0x58, 0x1D, 0x52, 0x27, 0x4F, 0xEF, 
+0x38, 0xAB, 0x7A, 0x73, 0xEC, 0x83, 0x6E, 0x52, 
+0xCD, 0x14, 0xAF, 0x6D, 0xFE, 0x47, 0xEA, 0xA7, 
+
+0x42, 0x37, 0xF1, 0xE0, 0x12, 0xA4, 0x6F, 0x73, 
+0x59, 0xDB, 0x91, 0x31, 0x88, 0x6E, 0x0F, 0x61, 
+0x46, 0x16, 0x9A, 0x3C, 0xB6, 0x02, 0x8F, 0x13, 
+0xF7, 0x56, 0xD9, 0x45, 0xD9, 0x3B, 0x73, 0xB3, 
+0x42, 0x04, 0x00, 0x57, 0x93, 0xEE, 0xF0, 0x2D, 
+0x0A, 0xBA, 0x68, 0xD9, 0x57, 0x26, 0x1F, 0x2C, 
+0xE1, 0xF5, 0xD3, 0xC2, 0xCC, 0x02, 0xCD, 0x4E, 
+0x91, 0xA3, 0xC5, 0x79, 0xCC, 0xA0, 0x26, 0xA4, 
+0x55, 0xC5, 0x71, 0xD4, 0xBE, 0xDC, 0xE9, 0x9E, 
+0xF9, 0xC8, 0xE5, 0x6B, 0x09, 0xFA, 0xE4, 0xC7, 
+0x76, 0x5B, 0x2A, 0xD9, 0xDF, 0xC8, 0x7A, 0xA6, 
+0xEB, 0x38, 0x84, 0x31, 0xA7, 0xE6, 0xC7, 0xFE, 
+0x97, 0x6B, 0xDE, 0xEB, 0x01, 0x4E, 0x39, 0xDE, 
+0xC0, 0x89, 0xC3, 0xD9, 0x02, 0x8B, 0xB2, 0x4E, 
+0x7A, 0xCC, 0x26, 0x6B, 0xE7, 0x67, 0x02, 0x7B, 
+0xF4, 0xDF, 0xAF, 0xE9, 0x83, 0x19, 0x23, 0x04, 
+0x80, 0x43, 0x52, 0x9A, 0x96, 0x15, 0xC5, 0xF7, 
+0x51, 0x99, 0xCC, 0xE6, 0x69, 0xAA, 0xB4, 0x7A, 
+0x1C, 0xB6, 0x35, 0xD2, 0xF6, 0xDA, 0xEC, 0xBD, 
+0x9A, 0x51, 0xBC, 0xCB, 0x1F, 0xDF, 0xDE, 0x13, 
+0xE0, 0xC3, 0x1E, 0x64, 0x3F, 0xC1, 0x1B, 0x5F, 
+0x5F, 0x5A, 0x76, 0x65, 0x52, 0xC0, 0xE7, 0xB8, 
+0x0F, 0xE6, 0x95, 0x3B, 0x1B, 0x4C, 0x0C, 0xBE, 
+0xC3, 0x52, 0x03, 0x96, 0x97, 0xB9, 0x86, 0x8A, 
+0xFA, 0x0C, 0xB0, 0x51, 0x3A, 0x0B, 0x2C, 0x73, 
+0xC6, 0x53, 0x59, 0xE7, 0x2B, 0x0D, 0xA1, 0xF8, 
+0x85, 0xC0, 0x31, 0x5D, 0x89, 0xB5, 0xF6, 0x62, 
+0xFA, 0x62, 0xD0, 0xF1, 0x4C, 0xCF, 0x32, 0x41, 
+0xB7, 0x66, 0xDF, 0x55, 0xAB, 0x1D, 0xF0, 0xE6, 
+0x3D, 0xAE, 0x77, 0xF1, 0x04, 0x74, 0x1D, 0xCA, 
+0x9C, 0xFD, 0xE3, 0x4C, 0x83, 0x71, 0x9A, 0x63, 
+0xFD, 0xDB, 0x38, 0x6C, 0xDA, 0x31, 0x9F, 0x11, 
+0x80, 
+
+0x4F, 0x38, 0x9A, 0x12, 0x95, 0xE7, 0x07, 0x26, 
+0xF1, 0x89, 0xDD, 0x8A, 0x05, 0xA8, 0x62, 0xD6, 
+0x67, 0x33, 0xB5, 0x5E, 0xAB, 0x6F, 0xB0, 0xD5, 
+0xC6, 0xDE, 0x85, 0x0E, 0x73, 0xCD, 0xE8, 0x37, 
+0xF8, 0x7F, 0xAD, 0x78, 0x44, 0xF3, 0xC6, 0x36, 
+0xD6, 0x74, 0x34, 0x05, 0x46, 0xD9, 0x8F, 0x11, 
+0xD4, 0x79, 0xCF, 0xF9, 0x0C, 0xEA, 0x9F, 0x66, 
+0xA6, 0x6D, 0x7C, 0x7A, 0xF4, 0x36, 0xC0, 0x35, 
+0x78, 0xB9, 0x69, 0x64, 0xEE, 0x8A, 0x02, 0x47, 
+0x32, 0x7F, 0x60, 0x31, 0x28, 0x82, 0xF1, 0xA5, 
+0xBD, 0x16, 0x51, 0x2A, 0x20, 0xA7, 0x8F, 0x00, 
+0xEE, 0x49, 0x2A, 0x35, 0xD0, 0xBD, 0x68, 0xF0, 
+0x4C, 0x32, 0xCE, 0xD6, 0x7D, 0x48, 0x8D, 0x5F, 
+0xF7, 0x06, 0xF2, 0x4C, 0x23, 0xA7, 0x00, 0xF1, 
+0x1D, 0x89, 0xCB, 0x33, 0xF7, 0xD0, 0x7A, 0xB9, 
+0x9D, 0xE4, 0xF0, 0xA8, 0x64, 0x9D, 0xD7, 0x98, 
+0xA4, 0x68, 0xC8, 0x57, 0x7F, 0xEA, 0xE9, 0x80, 
+0xE6, 0xFB, 0x2E, 0xA9, 0x52, 0xDA, 0x80, 0x25, 
+0x01, 0x26, 0x28, 0x1A, 0x2D, 0x53, 0x2D, 0x64, 
+0xB0, 0xF3, 0x60, 0xDF, 0x06, 0x93, 0x57, 0x4E, 
+0x02, 0xC2, 0xBE, 0x87, 0x7A, 0x41, 0x43, 0xFC, 
+0x9F, 0x96, 0xCE, 0xF2, 0x14, 0x41, 0x2F, 0x93, 
+0xB4, 0x1B, 0xDB, 0xD5, 0xA6, 0x99, 0x83, 0x16, 
+0x6D, 0x56, 0xDE, 0x5D, 0xDE, 0x08, 0x1F, 0x95, 
+0xD2, 0xF6, 0xDB, 0x83, 0x1A, 0x43, 0x76, 0x07, 
+0x0A, 0xB9, 0x6C, 0x23, 0x8D, 0xDC, 0x1A, 0x73, 
+0xD3, 0x84, 0xF6, 0x8F, 0x61, 0xA1, 0xB1, 0xBB, 
+0x74, 0x10, 0x89, 0xB3, 0x95, 0x50, 0x97, 0xB3, 
+0x1F, 0xC0, 0x34, 0xE4, 0x03, 0x38, 0x5C, 0xF9, 
+0xCA, 0xAE, 0x5B, 0x20, 0x1C, 0x64, 0xE6, 0x2C, 
+0x60, 0x13, 0x47, 0xC8, 0x0A, 0x6E, 0xF1, 0x0F, 
+0x3D, 0xB2, 0xAD, 0xC6, 0xE5, 0x7C, 0x92, 0xAE, 
+0x14, 0xE4, 
+
+0xE9, 0xC2, 0x41, 0x30, 0x52, 0x6C, 0xFA, 0x86, 
+0xF8, 0xB1, 0x84, 0xFA, 0x65, 0x4E, 0x5B, 0x3A, 
+0x37, 0x61, 0xEA, 0xD4, 0xCA, 0xAA, 0x09, 0x62, 
+0xFB, 0x41, 0x68, 0x3E, 0xE9, 0xCE, 0xCC, 0x5C, 
+0xA6, 0xFA, 0x38, 0xFF, 0x19, 0x41, 0x40, 0x5F, 
+0xAA, 0xF8, 0xDA, 0x9F, 0xF6, 0x67, 0xE3, 0x1D, 
+0x72, 0xA5, 0x53, 0xB9, 0xEC, 0xC3, 0x11, 0x5D, 
+0x48, 0x19, 0x67, 0xE9, 0x97, 0x17, 0x5B, 0xE0, 
+0x20, 0x7C, 0x6E, 0x9A, 0x6B, 0x59, 0x4C, 0x05, 
+0x4F, 0x2E, 0xE0, 0x7D, 0x15, 0x9F, 0xFA, 0xCB, 
+0x02, 0x15, 0x8A, 0xD2, 0x4C, 0x3C, 0x0C, 0xE2, 
+0x72, 0x03, 0x28, 0xDF, 0x8A, 0x8E, 0x44, 0x47, 
+0x10, 0xCD, 0xE7, 0xDF, 0xBE, 0x71, 0xEB, 0xDD, 
+0xD4, 0x10, 0xE0, 0x34, 0xE4, 0x2D, 0x69, 0xDB, 
+0x03, 0x9A, 0xD8, 0x2E, 0xC0, 0x12, 0xE1, 0x1B, 
+0x25, 0x38, 0x33, 0xAD, 0x14, 0x14, 0x4A, 0xCF, 
+0xA0, 0xD3, 0xA2, 0x99, 0x3A, 0x94, 0xE8, 0xA2, 
+0xE9, 0xF0, 0xB1, 0x86, 0xC1, 0x37, 0x47, 0x4B, 
+0x88, 0x4C, 0xE0, 0xEC, 0x77, 0x24, 0xF6, 0xDD, 
+0x7F, 0x31, 0x45, 0x30, 0xAF, 0xD8, 0x2D, 0x5D, 
+0xEB, 0xAC, 0xA1, 0xE6, 0x44, 0xF3, 0x5D, 0xEB, 
+0xE6, 0x41, 0x99, 0x9D, 0x9A, 0x35, 0x2C, 0x15, 
+0xB3, 0xFE, 0x0E, 0x5C, 0x82, 0x30, 0x86, 0xEF, 
+0x47, 0xF3, 0xAC, 0xA7, 0xE1, 0x37, 0x70, 0x2C, 
+0x24, 0x5E, 0xC0, 0x36, 0x86, 0x4A, 0xFA, 0x63, 
+0x83, 0xD4, 0xA3, 0x90, 0x63, 0x89, 0x13, 0x6F, 
+0x19, 0x36, 0x79, 0x90, 0x24, 0x9F, 0xFE, 0x8D, 
+0x18, 0xEB, 0xAC, 0xC3, 0x2F, 0xFA, 0x07, 0x44, 
+0x7E, 0xAE, 0x4D, 0x7D, 0x45, 0x0B, 0x35, 0xC6, 
+0xC6, 0x6C, 0xF8, 0x3A, 0xAE, 0xC9, 0x11, 0x3C, 
+0x04, 0xF8, 0xFD, 0xBF, 0x1C, 0x9B, 0x90, 0x3C, 
+0x49, 0xE1, 0x69, 0xCD, 0xBC, 0xB4, 0x71, 0xC1, 
+0xA3, 0xBF, 0xA5, 
+
+0xB8, 0xAE, 0x5C, 0x62, 0xC1, 0x30, 0x98, 0x59, 
+0x3A, 0x7C, 0x20, 0x0C, 0x44, 0xA2, 0x67, 0x40, 
+0xA7, 0xF3, 0x79, 0x68, 0x26, 0xEB, 0x86, 0x69, 
+0x7C, 0xD8, 0x8A, 0x3A, 0x41, 0x3B, 0x40, 0x0B, 
+0xA3, 0x76, 0xBF, 0x69, 0x3B, 0x94, 0xBA, 0xA1, 
+0xA4, 0x9C, 0x68, 0x94, 0x29, 0x14, 0xA8, 0x4A, 
+0xB9, 0x5E, 0xCD, 0x1D, 0x26, 0x4E, 0x08, 0x5C, 
+0x03, 0x36, 0xD5, 0x32, 0xBD, 0x54, 0x72, 0xDF, 
+0x27, 0x31, 0x88, 0x18, 0xA4, 0xB2, 0x50, 0xE7, 
+0x96, 0xBE, 0x56, 0xC4, 0xA5, 0xA0, 0xED, 0xBB, 
+0xF0, 0xA0, 0x3D, 0xA2, 0x42, 0x5B, 0x7B, 0x84, 
+0xBE, 0xA2, 0xA1, 0x95, 0xE9, 0xC3, 0x77, 0x4A, 
+0x18, 0xEE, 0xF4, 0xBC, 0x5C, 0x84, 0xEB, 0x23, 
+0x44, 0x78, 0x88, 0x9E, 0x5F, 0x13, 0xD2, 0x2C, 
+0x43, 0x2C, 0x3E, 0x57, 0x3D, 0x16, 0xEC, 0x87, 
+0x62, 0xC5, 0xBC, 0x04, 0xD2, 0x34, 0x74, 0xA1, 
+0xBE, 0xB8, 0x5A, 0xAF, 0xC3, 0xB4, 0x5D, 0x8A, 
+0x8F, 0xC7, 0x5D, 0xFD, 0xF3, 0x60, 0xCE, 0xCE, 
+0x47, 0x12, 0x98, 0x0F, 0x57, 0xCD, 0xBF, 0xAE, 
+0x32, 0xA7, 0xF1, 0xD8, 0x5C, 0x6B, 0x1C, 0x2B, 
+0x58, 0x55, 0xA1, 0x47, 0x66, 0xAF, 0xC9, 0xAC, 
+0xCF, 0x17, 0xBC, 0x11, 0x32, 0x46, 0x6A, 0x9A, 
+0x12, 0xFC, 0x3D, 0x6B, 0x2A, 0xC3, 0x7B, 0x1A, 
+0x9D, 0xA2, 0x01, 0x82, 0x4A, 0x19, 0x3D, 0x32, 
+0x56, 0x3D, 0x69, 0xA6, 0xF2, 0x54, 0x29, 0xD5, 
+0x61, 0xD3, 0x90, 0x8A, 0x67, 0xA1, 0x1F, 0xF5, 
+0xC6, 0x5D, 0xCE, 0x30, 0xCE, 0xF1, 0x56, 0x2C, 
+0xFF, 0x53, 0x48, 0x9A, 0xFB, 0xE4, 0xC2, 0x55, 
+0x48, 0x38, 0x93, 0x82, 0xF9, 0x5D, 0x89, 0xA6, 
+0xA7, 0x52, 0xD4, 0xF1, 0x2C, 0xE4, 0xF6, 0x25, 
+0x9B, 0x18, 0xC5, 0xED, 0x56, 0x55, 0xD7, 0x24, 
+0x32, 0xF0, 0x96, 0x79, 0x8E, 0x3A, 0x13, 0xD2, 
+0x8C, 0x86, 0xE7, 0xD3, 
+
+0xF9, 0xCD, 0xCA, 0x8F, 0x2B, 0xDD, 0xE3, 0x1B, 
+0xEE, 0x5B, 0x44, 0xDC, 0x61, 0x58, 0xE2, 0xB3, 
+0x26, 0xE3, 0x8F, 0x30, 0x3A, 0x37, 0xB2, 0x4A, 
+0xB8, 0x8C, 0x16, 0x71, 0x2E, 0xA3, 0x0F, 0x84, 
+0x4B, 0x3E, 0x98, 0x60, 0xF4, 0x19, 0xEB, 0x52, 
+0x61, 0xE7, 0x37, 0x81, 0x20, 0x88, 0x00, 0x9D, 
+0x98, 0xAF, 0x5A, 0x47, 0x90, 0x22, 0x04, 0xE7, 
+0x59, 0x9E, 0x1F, 0xCB, 0x64, 0xC8, 0xE8, 0xFC, 
+0xFE, 0xFF, 0xE5, 0xDB, 0xD3, 0x2E, 0x07, 0x6D, 
+0x55, 0xF1, 0xB9, 0x82, 0xFB, 0xEA, 0xEC, 0x61, 
+0x7C, 0xAC, 0x2C, 0x8F, 0x05, 0xDE, 0x75, 0x16, 
+0x86, 0x7F, 0xE4, 0x25, 0xBF, 0x8E, 0xD9, 0x6E, 
+0x21, 0x8E, 0xF0, 0x9D, 0xCF, 0xF6, 0x6F, 0xE9, 
+0xD9, 0x97, 0x7C, 0x00, 0x55, 0xB2, 0x72, 0x6F, 
+0x9E, 0x89, 0x00, 0x15, 0x00, 0xF0, 0xC1, 0xC7, 
+0x0C, 0xB2, 0x78, 0x89, 0x55, 0x38, 0x8D, 0xAA, 
+0x59, 0xEB, 0x5E, 0x2D, 0xC3, 0x49, 0xE9, 0x64, 
+0x95, 0xDE, 0x09, 0x47, 0xCA, 0xA6, 0x29, 0x1A, 
+0x9D, 0xDC, 0x8A, 0x47, 0x7A, 0x7C, 0xD8, 0x37, 
+0x29, 0x7D, 0x3C, 0xDC, 0xF0, 0x19, 0x11, 0x2F, 
+0x23, 0xB4, 0x4F, 0x99, 0x75, 0xAE, 0xE4, 0x33, 
+0x71, 0xE0, 0x8A, 0x6E, 0x28, 0x67, 0xAB, 0x75, 
+0x7A, 0xDE, 0xDE, 0xD2, 0x07, 0x38, 0x2A, 0xE0, 
+0x81, 0x4B, 0x13, 0x4A, 0x4E, 0x4E, 0x88, 0xA2, 
+0xAA, 0xF8, 0x34, 0x70, 0x0A, 0xB1, 0x8E, 0x85, 
+0x2C, 0xE1, 0x31, 0xE3, 0x5B, 0x44, 0x7A, 0xAD, 
+0x34, 0x38, 0x0F, 0x30, 0x55, 0xC9, 0xB8, 0x09, 
+0x5C, 0xBC, 0x3C, 0xD8, 0x0A, 0xF0, 0xFF, 0x02, 
+0x0E, 0x8A, 0x35, 0x25, 0x86, 0x96, 0x9C, 0x08, 
+0xBB, 0x9D, 0x17, 0xF2, 0x7D, 0x79, 0x9D, 0x2C, 
+0x45, 0x18, 0x73, 0xF2, 0xE3, 0xE6, 0xBD, 0x6C, 
+0x6D, 0xD6, 0x52, 0x31, 0xD7, 0x73, 0x65, 0xBB, 
+0x5E, 0x01, 0x65, 0x29, 0x13, 
+
+0x71, 0xF9, 0x1C, 0x49, 0x98, 0x56, 0x64, 0x7A, 
+0x58, 0x50, 0x67, 0x48, 0x43, 0x38, 0x4D, 0x52, 
+0x77, 0xEE, 0xC2, 0x3E, 0x21, 0xCE, 0x3D, 0x2D, 
+0x9C, 0x7C, 0x81, 0x85, 0xBA, 0xAF, 0x81, 0x09, 
+0x31, 0x1E, 0xCA, 0x48, 0x22, 0xCF, 0xFE, 0x26, 
+0x5D, 0x0C, 0x12, 0x2F, 0xC4, 0xD9, 0x07, 0x46, 
+0x18, 0x7A, 0xF9, 0x41, 0xD7, 0x86, 0xE1, 0x7E, 
+0x3B, 0x86, 0x0A, 0xED, 0x83, 0xBD, 0xA0, 0x8E, 
+0xC7, 0xAD, 0xDA, 0x1C, 0x74, 0x59, 0x4F, 0x6F, 
+0x05, 0x31, 0xB0, 0x1F, 0xA7, 0x48, 0x68, 0x08, 
+0xB7, 0xC3, 0xFC, 0x70, 0xE4, 0x64, 0x15, 0xCA, 
+0xCB, 0xFB, 0x38, 0x9D, 0x27, 0x5D, 0xC8, 0x71, 
+0x95, 0x92, 0xEA, 0x5F, 0x4A, 0x99, 0x5A, 0xC1, 
+0x0C, 0xCF, 0x5F, 0xCB, 0x75, 0x54, 0xF7, 0x01, 
+0xCE, 0x9C, 0x32, 0xA0, 0xDF, 0x40, 0x83, 0xD3, 
+0x95, 0xAF, 0x06, 0x15, 0xF9, 0x3F, 0xA2, 0x0E, 
+0x49, 0x5A, 0xAD, 0x17, 0xA9, 0x83, 0xFA, 0x63, 
+0xF2, 0x2F, 0x9C, 0xD9, 0xDF, 0xC6, 0x95, 0x7A, 
+0xA0, 0xD8, 0xF4, 0xB3, 0xEE, 0x5D, 0x97, 0xD8, 
+0x42, 0xE8, 0x98, 0xC2, 0xC9, 0xA3, 0x42, 0x1D, 
+0xFC, 0x66, 0xD9, 0x81, 0x49, 0x7D, 0xBA, 0x54, 
+0xB0, 0x2E, 0x78, 0x39, 0x8C, 0xFC, 0x79, 0x6E, 
+0x0B, 0x7A, 0x6D, 0x99, 0xDB, 0x02, 0xD2, 0xED, 
+0x86, 0x4E, 0xA2, 0xC0, 0x58, 0x15, 0xDF, 0xFC, 
+0xC3, 0xFC, 0xEC, 0x03, 0x59, 0x3D, 0x29, 0x13, 
+0x15, 0x76, 0xAC, 0x31, 0xEC, 0x6B, 0x05, 0x70, 
+0xC2, 0xAE, 0x5C, 0x3F, 0x60, 0x7D, 0x26, 0x7E, 
+0xA8, 0xA4, 0x7F, 0x36, 0xBC, 0x82, 0xDB, 0x0C, 
+0x34, 0xC8, 0xA1, 0xC7, 0x8F, 0x37, 0x0B, 0xB0, 
+0x25, 0xE8, 0xF8, 0xE7, 0x22, 0x37, 0x76, 0x5E, 
+0x9C, 0x03, 0xF8, 0x6F, 0x57, 0xD1, 0x9B, 0xFE, 
+0x5E, 0xE1, 0x0B, 0x9F, 0x68, 0xD2, 0x9D, 0x5A, 
+0xE4, 0xC9, 0x06, 0x02, 0x6A, 0xCA, 
+
+0x68, 0x7C, 0xE6, 0x92, 0xF7, 0x54, 0x37, 0xF7, 
+0x31, 0x91, 0xA0, 0xD5, 0xCC, 0x78, 0x31, 0x55, 
+0x98, 0x5F, 0x11, 0x94, 0x5A, 0xAF, 0xDC, 0xCA, 
+0x01, 0x34, 0xEF, 0x92, 0x2D, 0x98, 0x4D, 0x55, 
+0xE6, 0x5E, 0x0A, 0x5D, 0xF9, 0xA8, 0x23, 0xDD, 
+0x5C, 0xD8, 0xA1, 0x0F, 0xC4, 0x21, 0xCD, 0x7F, 
+0x9D, 0x74, 0xA2, 0x30, 0xDA, 0x99, 0xC6, 0xCA, 
+0xC8, 0x7B, 0xCA, 0x36, 0x91, 0x87, 0xCB, 0xAC, 
+0x26, 0x8B, 0x5E, 0x72, 0xFD, 0xB8, 0x3A, 0x71, 
+0xCF, 0x7D, 0x6D, 0x66, 0x85, 0x68, 0xC7, 0x1E, 
+0xA2, 0xBA, 0xB8, 0x21, 0x4F, 0xDC, 0x73, 0x36, 
+0xF6, 0x90, 0xD8, 0x85, 0xE4, 0x56, 0xDF, 0x65, 
+0x69, 0x4A, 0x62, 0xBA, 0xEA, 0xBF, 0x94, 0xA7, 
+0x88, 0xA0, 0xD1, 0x8F, 0xBC, 0x07, 0x7D, 0x2C, 
+0xAE, 0xBC, 0x73, 0x6B, 0x4C, 0xF2, 0x03, 0x53, 
+0x42, 0xEC, 0x4F, 0x80, 0x37, 0x00, 0x6A, 0xAD, 
+0xD4, 0xB5, 0x70, 0x50, 0x34, 0x7F, 0x09, 0x56, 
+0xE0, 0x37, 0x2C, 0x1A, 0xE6, 0x34, 0x2A, 0x73, 
+0x45, 0x27, 0xF7, 0x56, 0x5C, 0xAE, 0xF4, 0x87, 
+0xF7, 0x97, 0x67, 0x63, 0xD2, 0xF5, 0xB4, 0xD0, 
+0x46, 0xDB, 0x8C, 0x08, 0x87, 0x7A, 0xD3, 0xA5, 
+0x69, 0x1D, 0x87, 0x84, 0xEE, 0xE7, 0x6C, 0xE2, 
+0x36, 0x6D, 0xAD, 0x41, 0xCC, 0x7D, 0xF1, 0x94, 
+0x28, 0x8B, 0x87, 0x3D, 0x75, 0x80, 0x99, 0x22, 
+0xC5, 0x97, 0xD5, 0xBF, 0x19, 0xA7, 0xD2, 0x59, 
+0xB5, 0xF3, 0x0F, 0xC6, 0x67, 0x3F, 0x56, 0xE5, 
+0x96, 0x94, 0xF4, 0x41, 0x7C, 0xF6, 0x83, 0x90, 
+0x6A, 0x23, 0xF7, 0xDE, 0x02, 0x97, 0x59, 0x1F, 
+0xD5, 0x3D, 0x04, 0x09, 0x16, 0x63, 0xBB, 0x5E, 
+0xAC, 0x09, 0x4B, 0xEF, 0xBC, 0x8A, 0x8F, 0xF7, 
+0x73, 0xC2, 0xBF, 0x3C, 0xDD, 0xF5, 0x48, 0x80, 
+0x51, 0x27, 0xEE, 0x59, 0x69, 0x46, 0xE8, 0x33, 
+0xE9, 0xEA, 0xE0, 0xCB, 0xB5, 0xA8, 0xC3, 
+
+}
+return kat[i:j]
+}
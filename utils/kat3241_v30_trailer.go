@@ -0,0 +1,2329 @@
+package utils
+func getkat3241_v30_trailer(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xBA, 0xB3, 0x79, 0x99, 0x44, 0x8C, 0x80, 0xC5, 
+0x2B, 0xC1, 0xE6, 0xCB, 0x4C, 0xB6, 0x5D, 0xFA, 
+
+0xFD, 0x8C, 0xE4, 0xCA, 0x3A, 0xCB, 0x22, 0x63, 
+0x2C, 0xD7, 0xD4, 0x0B, 0x91, 0x47, 0xF1, 0x75, 
+0xF2, 
+
+0x6C, 0xA5, 0x3D, 0xDF, 0x65, 0x6A, 0xAA, 0x71, 
+0xD7, 0x8D, 0x93, 0x46, 0x23, 0xFF, 0x83, 0xC4, 
+0x8A, 0xA8, 
+
+0xD7, 0x31, 0xC7, 0xD9, 0xA8, 0x37, 0x9D, 0xA5, 
+0x0F, 0x37, 0xBC, 0x4D, 0x9D, 0x7D, 0x6A, 0x19, 
+0x20, 0xB2, 0x7F, 
+
+0xD8, 0xDA, 0xEC, 0xEB, 0x61, 0xF5, 0x63, 0xEA, 
+0x6F, 0xD5, 0xD2, 0x6E, 0x78, 0x35, 0xEF, 0xDE, 
+0xD8, 0xC7, 0x0A, 0xE4, 
+
+0x4A, 0xE8, 0xE8, 0x67, 0xD1, 0x33, 0x3F, 0x12, 
+0x07, 0x33, 0x85, 0xF0, 0xE0, 0xCB, 0x37, 0xFA, 
+0x3B, 0x4A, 0xF0, 0x07, 0xBC, 
+
+0x67, 0x0F, 0x39, 0x7C, 0x6F, 0xC4, 0x82, 0x01, 
+0xD9, 0xFE, 0x1E, 0x33, 0xBC, 0xF2, 0xD8, 0x76, 
+0x82, 0x8E, 0x72, 0x85, 0xF4, 0xBD, 
+
+0x5F, 0xDB, 0xE8, 0x1F, 0xFF, 0xAA, 0xA2, 0x82, 
+0x95, 0xB8, 0xCB, 0x19, 0x57, 0x00, 0x52, 0x9A, 
+0x18, 0xE4, 0xF2, 0xAA, 0x76, 0x20, 0xFC, 
+
+0x14, 0x6B, 0x37, 0x5D, 0x9F, 0x33, 0xB9, 0x9A, 
+0x10, 0xDB, 0xB3, 0x2B, 0xFC, 0xC1, 0x67, 0x5C, 
+0x57, 0x3E, 0x3A, 0x0A, 0x21, 0xCD, 0xF6, 0x93, 
+
+0xED, 0xF3, 0x38, 0xAB, 0xDB, 0xDD, 0x4B, 0xC0, 
+0x04, 0xF5, 0xA9, 0x9F, 0xDF, 0x50, 0x59, 0x83, 
+0xB4, 0x29, 0xDD, 0x88, 0x89, 0x6D, 0x55, 0xA3, 
+0x53, 
+
+0x25, 0x8F, 0x0A, 0xD7, 0xB0, 0x6B, 0xA8, 0x26, 
+0x34, 0x15, 0x5B, 0x50, 0x35, 0x1C, 0xBF, 0x9F, 
+0x9B, 0x59, 0x7B, 0x0D, 0x3F, 0xC5, 0xEE, 0x78, 
+0x7D, 0xF8, 
+
+0x74, 0x89, 0x9B, 0x4C, 0xE0, 0x22, 0x5F, 0xB6, 
+0x5A, 0x9C, 0x2D, 0xC6, 0x3F, 0x8B, 0x12, 0x02, 
+0xA9, 0xA5, 0x44, 0x17, 0x58, 0xC4, 0xB4, 0xF0, 
+0xA7, 0x55, 0xCF, 
+
+0x6F, 0x1B, 0x9B, 0x01, 0x35, 0x21, 0xD5, 0xF9, 
+0x94, 0xCF, 0xFE, 0xD8, 0x29, 0x13, 0x12, 0xA0, 
+0x0F, 0xD1, 0xA8, 0x52, 0x0A, 0x13, 0xC6, 0xA5, 
+0xE5, 0x24, 0xF1, 0xB2, 
+
+0x5A, 0xFF, 0x6D, 0xD7, 0x57, 0x34, 0x70, 0xFF, 
+0xEE, 0x0E, 0xE2, 0x43, 0xBA, 0x5B, 0x01, 0xA8, 
+0xD2, 0x53, 0xEF, 0x46, 0x87, 0xB3, 0xB3, 0xF3, 
+0x30, 0x65, 0x0F, 0x08, 0x1E, 
+
+0x11, 0xFE, 0x3D, 0xD5, 0x7C, 0x9D, 0xE1, 0xEF, 
+0x4B, 0x86, 0x1A, 0xFB, 0x2B, 0xD2, 0x68, 0xDF, 
+0x38, 0x82, 0xDE, 0x89, 0x4D, 0xBC, 0x82, 0xD8, 
+0x62, 0x3B, 0xBB, 0x49, 0x25, 0x28, 
+
+0x93, 0x3B, 0x26, 0xBF, 0x89, 0x81, 0x3B, 0xD6, 
+0x07, 0xFB, 0x0F, 0x45, 0x03, 0x1C, 0x12, 0x2A, 
+0xA0, 0x89, 0xAB, 0x55, 0x0D, 0x4A, 0x76, 0x28, 
+0xE4, 0x5E, 0x95, 0x76, 0xC1, 0x85, 0xAD, 
+
+0x79, 0xD8, 0xF7, 0x6A, 0x34, 0x3E, 0x98, 0xB4, 
+0x05, 0x6F, 0x49, 0x33, 0x59, 0xD1, 0x7D, 0x71, 
+0xC9, 0xE5, 0xDB, 0x6B, 0xEB, 0xC1, 0x99, 0xC9, 
+0xD7, 0x2A, 0x8E, 0x70, 0xF8, 0x74, 0x30, 0x3A, 
+
+0xBE, 0x3A, 0x8C, 0xD1, 0xED, 0x3C, 0x63, 0xA3, 
+0x8B, 0xE6, 0xEC, 0x3D, 0xDF, 0x90, 0x41, 0xAD, 
+0x45, 0xFB, 0xF7, 0xB4, 0x3C, 0xF5, 0x8B, 0x53, 
+0x68, 0xE1, 0xC5, 0x7A, 0xB4, 0x93, 0x3E, 0x21, 
+0x2C, 
+
+0x70, 0xB7, 0x43, 0xE9, 0xB2, 0xBA, 0x3E, 0xE1, 
+0x39, 0xEF, 0xC7, 0xA8, 0x3B, 0x5B, 0xC5, 0x34, 
+0x3A, 0xB1, 0x59, 0xA8, 0x99, 0xD6, 0xE8, 0x3E, 
+0x31, 0x41, 0xD7, 0xAF, 0x54, 0xDF, 0xA0, 0x04, 
+0x64, 0x7A, 
+
+0xA4, 0x07, 0x92, 0x8E, 0xA0, 0x22, 0x35, 0x3B, 
+0xB3, 0x80, 0x38, 0x16, 0xDD, 0x41, 0x2F, 0x1C, 
+0x15, 0xE1, 0x05, 0x68, 0xA2, 0x80, 0x11, 0xBE, 
+0x6D, 0x5B, 0x87, 0xB4, 0x23, 0x69, 0x8C, 0x93, 
+0x25, 0x9E, 0x6A, 
+
+0x1C, 0xAD, 0xFD, 0x12, 0x26, 0x9F, 0x4F, 0x73, 
+0xB6, 0xC2, 0x83, 0x92, 0xE2, 0xDF, 0xA4, 0xDA, 
+0xE0, 0xA2, 0x71, 0x62, 0xFA, 0xE5, 0x82, 0x6D, 
+0x7F, 0x1E, 0x79, 0xD8, 0x73, 0x1F, 0x2B, 0x2F, 
+0xE4, 0x02, 0xF4, 0xD9, 
+
+0xFE, 0xE3, 0x49, 0xEA, 0x05, 0xF2, 0x20, 0x3F, 
+0x9B, 0x7A, 0x41, 0x16, 0x25, 0xF8, 0xBD, 0x58, 
+0x0E, 0x97, 0xA8, 0x37, 0xB8, 0xD1, 0x14, 0xEC, 
+0x25, 0xEF, 0x8D, 0xC8, 0xC1, 0xA2, 0xA1, 0xBD, 
+0xD5, 0x8C, 0x9A, 0x1E, 0x59, 
+
+0x3B, 0x29, 0xAB, 0xB8, 0xC4, 0x89, 0x77, 0x20, 
+0xCC, 0x74, 0x28, 0xA8, 0x8D, 0x8B, 0xE6, 0x3B, 
+0x7C, 0x3F, 0x41, 0xA3, 0x4C, 0x39, 0xC3, 0x6D, 
+0x58, 0xD9, 0xB2, 0xF4, 0x8F, 0xB9, 0x52, 0xA2, 
+0x96, 0x04, 0x1B, 0x30, 0x34, 0x00, 
+
+0x29, 0x3D, 0x66, 0x9B, 0x2F, 0x49, 0x92, 0xE1, 
+0x61, 0xDC, 0x25, 0xC3, 0x62, 0x48, 0x7C, 0x07, 
+0xA8, 0x6E, 0x9D, 0x11, 0xBF, 0x4D, 0xBB, 0xAE, 
+0xED, 0x8D, 0xE2, 0x1B, 0x36, 0x2F, 0xCE, 0xB6, 
+0x13, 0x4A, 0xC3, 0x47, 0x98, 0x5B, 0x85, 
+
+0x63, 0xA1, 0x66, 0x25, 0x2B, 0x5A, 0x84, 0xE7, 
+0xA2, 0x37, 0xB3, 0xFA, 0x15, 0xAB, 0x13, 0x6D, 
+0x27, 0x84, 0xD3, 0x85, 0xCA, 0x98, 0x5D, 0x92, 
+0x7E, 0x23, 0x74, 0x7E, 0x2B, 0x55, 0xF9, 0x3B, 
+0x33, 0x7F, 0xCE, 0x5B, 0x61, 0x12, 0x4C, 0x12, 
+
+0xBF, 0xDE, 0x8D, 0x95, 0x8C, 0x16, 0x6A, 0x0E, 
+0xE1, 0x0B, 0xC3, 0xC7, 0x3B, 0x74, 0x18, 0x5F, 
+0xEC, 0xB9, 0x99, 0x32, 0x3E, 0x5E, 0x16, 0x7C, 
+0x45, 0x59, 0x0D, 0x84, 0x33, 0xB7, 0x25, 0xD2, 
+0x08, 0x63, 0xE6, 0xFF, 0xCE, 0x1E, 0xE7, 0x73, 
+0x94, 
+
+0x54, 0x55, 0x54, 0x19, 0xAB, 0x79, 0x25, 0x58, 
+0xB9, 0xFF, 0x16, 0xDD, 0xA3, 0x64, 0xFC, 0xC4, 
+0x44, 0x64, 0x4A, 0xEA, 0x95, 0xF8, 0xDA, 0x6D, 
+0xD8, 0x47, 0xA7, 0x7B, 0x92, 0xD8, 0x41, 0xAF, 
+0x9E, 0xD9, 0x7F, 0xDC, 0x1B, 0xFA, 0xC1, 0x33, 
+0x0B, 0xFA, 
+
+0x29, 0xDC, 0xDE, 0xE4, 0xDF, 0xBE, 0x27, 0xDF, 
+0x34, 0xD4, 0xE5, 0x6C, 0x95, 0x7D, 0xD6, 0x8E, 
+0x95, 0x0C, 0x1A, 0x5D, 0xB4, 0x4E, 0x84, 0xD7, 
+0xFC, 0x71, 0xAF, 0x54, 0xA4, 0xED, 0xC4, 0x8C, 
+0x9A, 0xC8, 0x68, 0x74, 0xB6, 0x10, 0x7B, 0x2E, 
+0x29, 0x2A, 0x72, 
+
+0x83, 0x1D, 0xF2, 0xA9, 0x2C, 0xDC, 0xDB, 0x63, 
+0x07, 0xEB, 0x8A, 0x52, 0x9A, 0x40, 0x94, 0xBD, 
+0x85, 0xD3, 0x06, 0x7D, 0xD8, 0xF3, 0xE1, 0x9E, 
+0xA2, 0xE0, 0xD9, 0x9A, 0x27, 0xCF, 0x3F, 0x30, 
+0x5E, 0x44, 0xA1, 0xD8, 0xED, 0xE3, 0x3B, 0x9E, 
+0x6F, 0x96, 0x8F, 0xA5, 
+
+0x71, 0x55, 0xF6, 0xFD, 0x9A, 0x71, 0x29, 0x23, 
+0xC5, 0x35, 0xC1, 0x8E, 0x71, 0x26, 0x9C, 0x80, 
+0x04, 0x51, 0xEA, 0x48, 0x52, 0x80, 0x5A, 0xB7, 
+0x8A, 0xA4, 0x08, 0xB0, 0xD8, 0xA8, 0x1D, 0x28, 
+0xE2, 0xB5, 0x0F, 0x45, 0xCF, 0x87, 0x94, 0xD0, 
+0x3F, 0xDB, 0x91, 0x06, 0x5F, 
+
+0x54, 0xF9, 0x6C, 0xCE, 0x09, 0x02, 0xE7, 0xA4, 
+0x5E, 0x00, 0x18, 0x57, 0xCD, 0xB7, 0x30, 0xAF, 
+0xB6, 0x0D, 0xF2, 0xB8, 0x91, 0x45, 0x8A, 0x9F, 
+0xE5, 0xB9, 0xFA, 0x97, 0xE7, 0xA2, 0x3D, 0x07, 
+0xAB, 0x90, 0xB6, 0x42, 0x8E, 0x58, 0xD5, 0x4C, 
+0x29, 0x86, 0xA9, 0xA2, 0x39, 0x2C, 
+
+0x93, 0x94, 0xB1, 0x20, 0x44, 0xAD, 0xF3, 0x97, 
+0xA7, 0x4B, 0x3B, 0xB3, 0x9D, 0x00, 0xDF, 0x61, 
+0x25, 0x01, 0x0A, 0x08, 0xC7, 0x90, 0xAC, 0x9D, 
+0xD2, 0x1A, 0x0C, 0x4A, 0x95, 0x92, 0x76, 0x53, 
+0xBB, 0x7F, 0xCB, 0x93, 0x7A, 0xC0, 0xE2, 0x15, 
+0x66, 0x79, 0xD7, 0xE2, 0x43, 0xBB, 0xAC, 
+
+0x63, 0xA2, 0x3D, 0xEC, 0x0B, 0x88, 0x08, 0x01, 
+0x86, 0xB9, 0x92, 0x0E, 0x02, 0x7F, 0x8A, 0x27, 
+0x55, 0x5E, 0x3F, 0x7D, 0x9C, 0x80, 0x99, 0x3E, 
+0xEF, 0x30, 0x91, 0xD1, 0x83, 0x70, 0x7B, 0x18, 
+0x24, 0x28, 0x21, 0xE4, 0x56, 0x51, 0x34, 0xBF, 
+0x15, 0x5C, 0x90, 0x5E, 0x19, 0x00, 0xF0, 0xFA, 
+
+0xFD, 0xD7, 0xBB, 0x5B, 0x5C, 0xE5, 0x4E, 0xF1, 
+0xAE, 0x21, 0x8A, 0x34, 0xD7, 0x5C, 0x28, 0x9A, 
+0x11, 0x98, 0x10, 0x07, 0xA9, 0x6B, 0xFD, 0x65, 
+0x69, 0xAB, 0xD1, 0x40, 0x77, 0xB5, 0x16, 0x08, 
+0x4D, 0x3D, 0xBE, 0x7B, 0x6D, 0xD5, 0xF4, 0x86, 
+0x61, 0x66, 0x76, 0xAA, 0xFA, 0xD7, 0x4E, 0x79, 
+0xD7, 
+
+0x3F, 0xFB, 0x14, 0xE9, 0x37, 0x67, 0xCD, 0x92, 
+0x59, 0xA4, 0x93, 0x17, 0x7B, 0x23, 0xAE, 0xDC, 
+0x7A, 0x73, 0x2C, 0xD7, 0x71, 0x71, 0x3C, 0x7B, 
+0x66, 0xD5, 0xF4, 0x8C, 0x54, 0x6E, 0x06, 0xC7, 
+0x9C, 0xBD, 0x59, 0x78, 0xC5, 0x07, 0xD8, 0x5C, 
+0x07, 0xBF, 0x2C, 0x5B, 0x22, 0xD4, 0x46, 0x94, 
+0x3E, 0xD0, 
+
+0x10, 0xB6, 0x9C, 0xE4, 0x4C, 0x5D, 0xD9, 0xDD, 
+0xD3, 0x7F, 0x9D, 0x3B, 0xD8, 0xF1, 0x86, 0xDA, 
+0xDE, 0xCB, 0xC6, 0x19, 0xAC, 0x72, 0x7B, 0x15, 
+0x99, 0xF1, 0x9E, 0x17, 0xCE, 0x57, 0x25, 0x23, 
+0x07, 0x85, 0x68, 0xAA, 0xAB, 0xE8, 0x93, 0x81, 
+0xFA, 0x75, 0xBF, 0x84, 0xAF, 0x03, 0x9E, 0x6F, 
+0xE6, 0xD5, 0x73, 
+
+0xCB, 0x6C, 0x11, 0x69, 0xE0, 0x57, 0x25, 0x64, 
+0x4E, 0xF3, 0x2F, 0x71, 0x7E, 0x1A, 0x17, 0x28, 
+0xD3, 0xFC, 0x1B, 0xA7, 0xEC, 0x9E, 0x84, 0xA2, 
+0xB5, 0x5F, 0x61, 0x7F, 0xE7, 0x6B, 0xBB, 0x1A, 
+0xAB, 0x58, 0xF1, 0x2D, 0xAE, 0x74, 0xC8, 0xC4, 
+0xCB, 0xC1, 0xA9, 0x58, 0x3B, 0xF8, 0xFA, 0x10, 
+0x95, 0xA8, 0xFF, 0x19, 
+
+0xA3, 0xC5, 0x78, 0xBB, 0xAC, 0xC9, 0x9B, 0x4E, 
+0x1A, 0xF1, 0x91, 0x05, 0xAB, 0xBB, 0xD1, 0xFD, 
+0xD3, 0xF7, 0x8F, 0x9A, 0xC5, 0xEE, 0xD0, 0xE2, 
+0xE8, 0x17, 0xD5, 0x20, 0x15, 0x17, 0x73, 0x83, 
+0x64, 0x71, 0xF4, 0xC6, 0x70, 0x70, 0x8A, 0x68, 
+0xEF, 0x9C, 0x89, 0x03, 0xC5, 0x74, 0xEA, 0xF6, 
+0x03, 0x19, 0x03, 0x75, 0x65, 
+
+0x80, 0xE9, 0xDE, 0x18, 0x7D, 0x2B, 0x56, 0x4B, 
+0x40, 0x29, 0x30, 0x1D, 0x23, 0xB9, 0xFC, 0x1A, 
+0xB7, 0xDF, 0x78, 0x1E, 0xDA, 0x8A, 0x01, 0xC9, 
+0xB5, 0x63, 0x39, 0xF7, 0xF4, 0x3A, 0xF5, 0x45, 
+0x51, 0x25, 0xEF, 0xAA, 0x90, 0x83, 0x49, 0x45, 
+0x01, 0xB7, 0x5E, 0x6C, 0x72, 0x10, 0xE2, 0x0C, 
+0x23, 0x1F, 0x84, 0x3F, 0xD4, 0xA8, 
+
+0x64, 0x49, 0xC0, 0x95, 0x98, 0x78, 0x40, 0x86, 
+0x69, 0x54, 0x0E, 0x6A, 0xD9, 0xF9, 0x86, 0x3A, 
+0x8B, 0xD9, 0x82, 0x32, 0x8F, 0x84, 0xBD, 0x82, 
+0x9D, 0x1D, 0x7B, 0x60, 0x07, 0xD7, 0xBD, 0x3E, 
+0xB3, 0x68, 0xB1, 0x57, 0x3E, 0xB4, 0x63, 0x7C, 
+0x2C, 0xD8, 0x15, 0x4E, 0x9C, 0x3B, 0x3D, 0xC8, 
+0x5B, 0x96, 0x5F, 0x2E, 0x90, 0x0D, 0xB9, 
+
+0x01, 0xF2, 0xBF, 0x61, 0x94, 0x02, 0x21, 0x8C, 
+0xA2, 0x3E, 0xF8, 0x5F, 0x86, 0x25, 0x9D, 0x06, 
+0x28, 0x3F, 0x9C, 0x5F, 0xB3, 0x3D, 0x3B, 0xAD, 
+0xA8, 0xE5, 0x68, 0x1A, 0x3E, 0xCB, 0x5A, 0x6F, 
+0xD8, 0xFC, 0xDC, 0x47, 0x76, 0xFE, 0x18, 0x6D, 
+0xFD, 0xD4, 0x73, 0xAA, 0x5F, 0xC5, 0x2D, 0x62, 
+0x91, 0x13, 0x1D, 0xC4, 0x1B, 0x76, 0x51, 0xB0, 
+
+0xB3, 0x64, 0xBB, 0xDF, 0xB7, 0x70, 0x44, 0xAF, 
+0x59, 0xC9, 0x14, 0x4B, 0x1A, 0xDF, 0xA3, 0x6F, 
+0xA4, 0x87, 0x2F, 0x09, 0x6B, 0x07, 0xAB, 0x76, 
+0x8E, 0x96, 0x90, 0xDC, 0x5B, 0x81, 0x4B, 0x0A, 
+0x48, 0xFD, 0x9C, 0x81, 0x1A, 0x84, 0xE2, 0x61, 
+0xC6, 0x58, 0x39, 0xDA, 0xD3, 0xFA, 0xE1, 0xB8, 
+0x12, 0xE3, 0x9B, 0xF3, 0x27, 0xF0, 0x8F, 0x2A, 
+0x18, 
+
+0x71, 0xE2, 0x61, 0x6D, 0xCD, 0xF0, 0xC7, 0x8C, 
+0x62, 0x50, 0xD9, 0xB1, 0xA9, 0xD3, 0xB5, 0x81, 
+0xC4, 0xEE, 0xDA, 0x73, 0xB5, 0xCD, 0x2C, 0x85, 
+0x3F, 0x37, 0xDB, 0x82, 0x01, 0xD2, 0xC4, 0xEE, 
+0xC0, 0x49, 0xDA, 0x0A, 0x92, 0xC5, 0x7E, 0x33, 
+0x61, 0x48, 0xDC, 0x95, 0xED, 0xCB, 0xE5, 0x96, 
+0x31, 0x23, 0x57, 0xBF, 0x45, 0x13, 0xC3, 0xC9, 
+0xEA, 0xBD, 
+
+0x0A, 0x19, 0xC3, 0xDB, 0xAA, 0xB2, 0x9D, 0x17, 
+0x77, 0x3B, 0xF6, 0x3D, 0x16, 0x43, 0x42, 0x5A, 
+0x67, 0xF4, 0x95, 0x7D, 0x8A, 0x9F, 0x44, 0xFB, 
+0xC0, 0x80, 0xCD, 0x09, 0x37, 0xB7, 0x40, 0x2C, 
+0x8A, 0x04, 0x66, 0x04, 0x52, 0x88, 0x18, 0x95, 
+0xDC, 0x55, 0x86, 0x7A, 0x86, 0xA1, 0x6C, 0xE4, 
+0x05, 0x33, 0xD2, 0xC4, 0xF4, 0xAD, 0x0A, 0xEE, 
+0xCA, 0x2E, 0xAE, 
+
+0x3A, 0xDD, 0x6B, 0x68, 0xB0, 0xCB, 0xE4, 0x76, 
+0x28, 0xD1, 0x98, 0x12, 0xEB, 0xF7, 0xD9, 0x57, 
+0x50, 0xF6, 0xFB, 0x52, 0x19, 0x63, 0xFA, 0x58, 
+0x15, 0x48, 0xB8, 0xFB, 0x96, 0xB0, 0x36, 0x8C, 
+0x5C, 0xC5, 0xC8, 0x53, 0x77, 0x35, 0xC0, 0xF0, 
+0xF8, 0x0C, 0x5D, 0xE7, 0x8B, 0x07, 0x55, 0xAF, 
+0xC9, 0xDF, 0xB9, 0x28, 0xC7, 0x99, 0xFC, 0x95, 
+0x6B, 0x6F, 0xC2, 0x31, 
+
+0xD5, 0x14, 0x54, 0xA2, 0x41, 0x16, 0xD3, 0xF7, 
+0x8A, 0x45, 0xB5, 0x86, 0xB3, 0x0F, 0x62, 0x31, 
+0xA2, 0x38, 0xB1, 0xDB, 0x62, 0xE4, 0x8E, 0x97, 
+0xFD, 0xED, 0x28, 0x8C, 0xA4, 0x01, 0x5B, 0x7F, 
+0xA1, 0x82, 0x90, 0xFE, 0x65, 0x1C, 0xC0, 0xAC, 
+0xC4, 0xA2, 0x18, 0x9D, 0x99, 0x85, 0x2B, 0x61, 
+0xCF, 0x9B, 0x67, 0x33, 0x0E, 0x00, 0xED, 0x7A, 
+0xBD, 0x5F, 0x8D, 0x97, 0xB7, 
+
+0x9E, 0xFE, 0xF9, 0xE0, 0x32, 0x40, 0x16, 0x1B, 
+0x95, 0xD4, 0x5C, 0xC1, 0xCC, 0xFA, 0xC8, 0x68, 
+0xE2, 0x2A, 0xD2, 0xFC, 0x37, 0x22, 0x50, 0x7B, 
+0xC4, 0xA5, 0x07, 0xC0, 0x02, 0x5B, 0x07, 0x0A, 
+0x6D, 0xD5, 0x64, 0x15, 0x83, 0xD9, 0xA2, 0x75, 
+0x91, 0x06, 0x27, 0x66, 0xC6, 0x78, 0x36, 0x39, 
+0x0A, 0x32, 0x79, 0x22, 0x1F, 0xA7, 0xF4, 0x2D, 
+0x2D, 0x0A, 0x8A, 0xC3, 0x9D, 0x97, 
+
+0xA4, 0xC1, 0xA8, 0x17, 0x62, 0x0A, 0x8A, 0x8A, 
+0xDD, 0x4C, 0xA7, 0x48, 0x55, 0xE0, 0x67, 0x2F, 
+0x7E, 0x8E, 0x87, 0xE5, 0xFD, 0xC5, 0x20, 0x86, 
+0xBB, 0xD0, 0x96, 0xD8, 0x86, 0x0E, 0x11, 0xC1, 
+0x00, 0xF4, 0x02, 0x11, 0x46, 0xFB, 0x06, 0xDB, 
+0x64, 0x16, 0x41, 0x5B, 0x41, 0x0D, 0xFE, 0xEE, 
+0xC2, 0x85, 0x4D, 0x24, 0xB5, 0xF1, 0x6C, 0xC4, 
+0x31, 0xD2, 0x68, 0x87, 0x80, 0x26, 0x6D, 
+
+0xBC, 0x0E, 0xAD, 0xC8, 0x8F, 0x27, 0x1E, 0x40, 
+0xD0, 0xB0, 0x77, 0x95, 0xDE, 0x95, 0x34, 0x86, 
+0x9F, 0xA5, 0x59, 0x17, 0x5F, 0x61, 0x61, 0x43, 
+0x64, 0x51, 0x9D, 0xEF, 0xA2, 0xBE, 0x85, 0xB4, 
+0x79, 0x36, 0x5F, 0xE4, 0x9A, 0x15, 0xC7, 0xF7, 
+0xFE, 0x61, 0xA9, 0x7C, 0x11, 0x04, 0x7C, 0xFF, 
+0x99, 0xF4, 0xF7, 0xD4, 0xBF, 0x09, 0x92, 0x14, 
+0x90, 0xC0, 0xED, 0xBB, 0x8E, 0x29, 0x27, 0xFF, 
+
+0xB0, 0x69, 0x3A, 0xCF, 0xA1, 0xB2, 0x00, 0x49, 
+0x7C, 0x67, 0x79, 0x78, 0xC8, 0xDC, 0x54, 0xD0, 
+0xEF, 0xA9, 0x89, 0x37, 0x73, 0xE2, 0x9C, 0x6A, 
+0x89, 0x23, 0x1E, 0x71, 0x73, 0x18, 0x33, 0x9D, 
+0xC0, 0x78, 0x10, 0x21, 0x88, 0x47, 0xBF, 0x40, 
+0x23, 0x12, 0x18, 0xCE, 0x1F, 0xDE, 0x26, 0xE8, 
+0xD0, 0x07, 0xD3, 0xA4, 0x9E, 0xFF, 0x45, 0x6D, 
+0x31, 0x16, 0x88, 0x5D, 0x15, 0xA6, 0xEE, 0xC7, 
+0xCF, 
+
+0x31, 0x8C, 0x44, 0x3E, 0xD3, 0xFD, 0x18, 0xE2, 
+0x06, 0x82, 0x23, 0x66, 0x7D, 0xC7, 0x73, 0x1B, 
+0x93, 0x8E, 0x15, 0xFE, 0xF7, 0x93, 0x19, 0xC0, 
+0xA3, 0x78, 0xAC, 0xA6, 0xE5, 0x7F, 0x7E, 0x3B, 
+0xF6, 0x75, 0x02, 0xC7, 0x9A, 0x4D, 0x98, 0xCC, 
+0x1B, 0x36, 0x37, 0x4F, 0xE3, 0x32, 0xF9, 0xA4, 
+0x85, 0xA8, 0xE5, 0x10, 0x68, 0x32, 0x40, 0x45, 
+0xAB, 0x32, 0x26, 0x73, 0x6B, 0x31, 0xD8, 0x71, 
+0x88, 0x0B, 
+
+0x8C, 0x38, 0x16, 0x9D, 0x0B, 0x4A, 0x18, 0xF5, 
+0x08, 0xE1, 0xB6, 0x5B, 0xF0, 0x04, 0x9B, 0xC7, 
+0x5F, 0x18, 0xB2, 0xCC, 0xCA, 0xB1, 0xA3, 0x79, 
+0x56, 0x98, 0x5B, 0xCC, 0x8E, 0x91, 0x4B, 0x2C, 
+0x84, 0x2F, 0x51, 0x8E, 0x1B, 0x93, 0x1C, 0xD9, 
+0xEC, 0x6B, 0xA7, 0x45, 0xE0, 0x2C, 0xCD, 0x69, 
+0xF9, 0x03, 0x48, 0xA7, 0xB1, 0xC1, 0xE3, 0xB3, 
+0x18, 0xB1, 0x60, 0x4B, 0x10, 0x8A, 0x24, 0xFC, 
+0x18, 0x57, 0xE5, 
+
+0xC2, 0x42, 0x31, 0x29, 0x90, 0xA9, 0xF1, 0xFC, 
+0xFE, 0xA4, 0x2F, 0xC8, 0xE2, 0xBA, 0xA3, 0x74, 
+0x42, 0x2E, 0xBE, 0x28, 0xA7, 0x91, 0x92, 0xC2, 
+0x19, 0xCE, 0x53, 0x32, 0x0C, 0x0C, 0xA6, 0x7B, 
+0x79, 0x7A, 0x8A, 0x5C, 0xEC, 0x64, 0xEA, 0x13, 
+0xDB, 0x71, 0xC0, 0x0A, 0xD2, 0xD3, 0x73, 0x2F, 
+0xB7, 0x88, 0x17, 0xBC, 0xB2, 0xB9, 0xB7, 0xCF, 
+0x46, 0xEC, 0x8E, 0xC5, 0x37, 0x25, 0x98, 0x03, 
+0x55, 0xD0, 0xAD, 0x4B, 
+
+0x6D, 0xB5, 0x27, 0xC0, 0x67, 0xA5, 0x0A, 0x69, 
+0x41, 0xEC, 0x43, 0x42, 0xF1, 0x9B, 0x7F, 0x68, 
+0x1F, 0x86, 0x99, 0xDB, 0x52, 0xBF, 0x72, 0xC9, 
+0x7A, 0xCC, 0xA4, 0xD8, 0x40, 0xF8, 0xF8, 0xEB, 
+0xA9, 0x51, 0x2B, 0x82, 0x90, 0x78, 0x52, 0x05, 
+0x26, 0x38, 0x87, 0x92, 0x10, 0x6E, 0x4F, 0x57, 
+0x99, 0xE3, 0xBF, 0xC6, 0x63, 0x7F, 0x48, 0x16, 
+0xCB, 0xA5, 0x09, 0x69, 0x9F, 0xC5, 0x35, 0x3B, 
+0xA5, 0x32, 0x0F, 0x41, 0x6C, 
+
+0x6E, 0x89, 0x7C, 0x50, 0xAC, 0x42, 0xB8, 0x20, 
+0xBF, 0x94, 0x99, 0x12, 0x15, 0xF6, 0x07, 0xCA, 
+0xAC, 0x9C, 0x43, 0x0C, 0x53, 0x83, 0x3C, 0x8A, 
+0xB1, 0xC7, 0xBE, 0xB1, 0xD4, 0x51, 0x66, 0xEA, 
+0x98, 0x55, 0x51, 0xBD, 0x64, 0x95, 0x21, 0x16, 
+0xF2, 0x2D, 0xF3, 0x78, 0x7F, 0x57, 0x36, 0x1E, 
+0x8D, 0xA2, 0x2D, 0x02, 0x09, 0x42, 0x8E, 0xC3, 
+0x34, 0x7B, 0xB8, 0xFE, 0x09, 0x24, 0x83, 0xC3, 
+0xA2, 0x17, 0xC6, 0x0E, 0x7C, 0xD0, 
+
+0x1E, 0x39, 0x5A, 0x6D, 0x3F, 0x75, 0x7C, 0xAD, 
+0x86, 0xBF, 0xBD, 0x50, 0x71, 0x8D, 0x00, 0x21, 
+0x9D, 0x71, 0x65, 0x51, 0x99, 0x8C, 0x40, 0xC6, 
+0xAF, 0xA9, 0x82, 0xDF, 0xB7, 0xA2, 0xF3, 0x01, 
+0xA2, 0xA3, 0xF4, 0x93, 0x87, 0x46, 0x0B, 0xE5, 
+0x47, 0x56, 0xEA, 0x64, 0xAA, 0x94, 0xDC, 0x85, 
+0x51, 0xA5, 0xC7, 0x15, 0x15, 0x71, 0x12, 0x3E, 
+0x2A, 0x80, 0xD9, 0xCF, 0x61, 0x32, 0x83, 0x67, 
+0xF3, 0x2D, 0x96, 0x51, 0xDF, 0x95, 0x90, 
+
+0xA7, 0xE2, 0x4A, 0xD2, 0x38, 0xB1, 0x34, 0x40, 
+0x86, 0xB0, 0x07, 0xF4, 0xE7, 0xA9, 0x65, 0xC8, 
+0x79, 0xA0, 0xA0, 0x24, 0xEE, 0x1D, 0x9D, 0x8E, 
+0x8D, 0x9C, 0x55, 0x61, 0x98, 0xA4, 0x96, 0x90, 
+0xF1, 0x9B, 0xF6, 0x42, 0xE1, 0x4A, 0xA5, 0xA4, 
+0x16, 0xF8, 0xA9, 0x77, 0x3B, 0x0F, 0x40, 0x0F, 
+0xC7, 0x23, 0x94, 0xF1, 0x3B, 0x1F, 0x8E, 0x1F, 
+0x54, 0xD8, 0xD3, 0xCE, 0x79, 0x20, 0xC6, 0x60, 
+0x22, 0xE7, 0x89, 0x46, 0x04, 0x32, 0x7F, 0xD5, 
+
+0x98, 0xFC, 0x75, 0x2E, 0x6F, 0xDF, 0x98, 0x48, 
+0xC6, 0xE6, 0xD1, 0x8E, 0xC5, 0x3D, 0x68, 0xB5, 
+0x3B, 0xC3, 0x0C, 0x21, 0xA1, 0xE4, 0x8B, 0x7C, 
+0xF3, 0x1E, 0xEC, 0x12, 0x59, 0x7A, 0x2D, 0x59, 
+0xD7, 0xB3, 0x04, 0x25, 0xA4, 0xE5, 0x7D, 0xB5, 
+0xB1, 0x41, 0xD6, 0x47, 0x3F, 0x1F, 0xD0, 0x0E, 
+0x40, 0xF3, 0x3E, 0x18, 0x96, 0xAC, 0x07, 0x1E, 
+0xCF, 0x31, 0xD4, 0xA9, 0xD5, 0xCC, 0xB0, 0xBA, 
+0xCE, 0x15, 0x2F, 0xFD, 0xF2, 0xCF, 0xC4, 0x3C, 
+0x2D, 
+
+0x03, 0xAF, 0x2F, 0x43, 0xBD, 0x4B, 0x4E, 0x2D, 
+0xC2, 0xDB, 0x2F, 0xD6, 0x57, 0xC2, 0x55, 0x8B, 
+0xE4, 0x54, 0x13, 0xD8, 0x7D, 0xB5, 0x95, 0x1B, 
+0xD3, 0x88, 0x04, 0xF0, 0xD1, 0xFC, 0x84, 0xE3, 
+0xF2, 0x17, 0xB4, 0xFB, 0x11, 0x89, 0x0B, 0xA0, 
+0xD2, 0x5D, 0x9F, 0x73, 0x56, 0x35, 0x94, 0x64, 
+0x24, 0xFC, 0x59, 0x4D, 0xCE, 0xC3, 0x03, 0x57, 
+0xA6, 0x5A, 0x64, 0x9C, 0x00, 0x57, 0xFD, 0xE8, 
+0xA1, 0x6A, 0x2B, 0x2F, 0xB4, 0x48, 0x96, 0x81, 
+0x7D, 0x62, 
+
+0xD6, 0x9A, 0xC3, 0x3A, 0x82, 0x00, 0xFD, 0x1D, 
+0x29, 0x7A, 0x17, 0x57, 0xC7, 0x5C, 0xDA, 0x87, 
+0x50, 0xA9, 0x40, 0x0D, 0x2C, 0x9A, 0x2E, 0x32, 
+0x1F, 0x3F, 0x1B, 0x0C, 0xF7, 0x4A, 0x68, 0x4F, 
+0xC4, 0x34, 0xB0, 0x81, 0x3A, 0x2E, 0xB2, 0x6D, 
+0x08, 0x57, 0xD7, 0xAF, 0x29, 0xC4, 0xCF, 0x5A, 
+0xF8, 0xCE, 0xB1, 0x60, 0xA3, 0x86, 0x7A, 0x4E, 
+0x64, 0x67, 0x18, 0xF1, 0xEA, 0x93, 0x7E, 0x5E, 
+0x0A, 0xF0, 0xBC, 0x05, 0xA9, 0xE7, 0xE5, 0xD3, 
+0xB0, 0x74, 0x4A, 
+
+0xBA, 0x27, 0xEC, 0xE5, 0x68, 0xD7, 0x8F, 0xA5, 
+0x45, 0xA4, 0x7B, 0x8E, 0x3E, 0x50, 0x5F, 0x38, 
+0x0E, 0x48, 0xF9, 0x44, 0x27, 0x4D, 0xC2, 0xA4, 
+0x8D, 0x64, 0xC7, 0x61, 0x85, 0xAD, 0x1F, 0xC4, 
+0x85, 0xFD, 0xB6, 0xB5, 0x12, 0xE1, 0x98, 0x78, 
+0x80, 0x56, 0x58, 0x43, 0x16, 0x87, 0xBA, 0x2A, 
+0xD5, 0x56, 0x8B, 0x98, 0xA1, 0x81, 0x82, 0x03, 
+0x95, 0x72, 0x4F, 0x73, 0x05, 0xB7, 0x81, 0xCD, 
+0x55, 0x2B, 0xA9, 0x05, 0x5A, 0x8D, 0xFC, 0xB4, 
+0x60, 0x96, 0x69, 0x30, 
+
+0x1F, 0x40, 0x58, 0x7F, 0x62, 0xE9, 0xFA, 0x8F, 
+0xCA, 0xD3, 0x04, 0x4A, 0x2B, 0x1D, 0x64, 0xDE, 
+0x1D, 0x2E, 0x4F, 0xCE, 0xEE, 0x0D, 0x46, 0x99, 
+0x79, 0x58, 0x73, 0x5A, 0x4C, 0x0F, 0x2B, 0x97, 
+0x90, 0x82, 0x1A, 0xA6, 0x60, 0x3C, 0xB8, 0xCD, 
+0xCE, 0x63, 0xF2, 0x7D, 0xB1, 0x35, 0xCB, 0x74, 
+0xA6, 0xB5, 0x8E, 0x49, 0xFD, 0xE3, 0x80, 0xEB, 
+0xC8, 0xB5, 0x42, 0x72, 0xF6, 0x12, 0x3D, 0xBC, 
+0x5A, 0x7D, 0xC9, 0x08, 0xA0, 0x20, 0x53, 0xD4, 
+0x50, 0x34, 0xDA, 0x13, 0x99, 
+
+0xCA, 0x3F, 0x93, 0x8D, 0xE7, 0x1E, 0x43, 0xC1, 
+0x84, 0xA4, 0xB8, 0x0D, 0xEF, 0x34, 0xFA, 0xF4, 
+0x97, 0xE2, 0x84, 0x7D, 0xA0, 0x3D, 0x68, 0xD4, 
+0xD8, 0x49, 0x47, 0x61, 0x77, 0x26, 0xA4, 0x3D, 
+0x7B, 0x4E, 0xF2, 0x95, 0xD7, 0x57, 0x40, 0xB5, 
+0x85, 0xC4, 0xB7, 0x97, 0x71, 0x75, 0x1F, 0x50, 
+0x56, 0xBC, 0xF7, 0x8D, 0x95, 0x26, 0x1B, 0x95, 
+0x56, 0x6B, 0x48, 0x23, 0xC3, 0xC9, 0xD3, 0x85, 
+0x37, 0x7F, 0xE9, 0x77, 0x64, 0x2D, 0xE4, 0x83, 
+0xD2, 0xB0, 0xBA, 0x94, 0xE2, 0x86, 
+
+0x71, 0xE7, 0x07, 0x82, 0x19, 0x3A, 0x33, 0xFB, 
+0x5D, 0xB4, 0x9F, 0x59, 0x43, 0x83, 0x8A, 0xDC, 
+0xD5, 0xD7, 0x43, 0x4D, 0x88, 0xD2, 0x79, 0x06, 
+0x7A, 0x0E, 0x40, 0xD5, 0x31, 0x4A, 0x08, 0xDC, 
+0xE2, 0xE4, 0x42, 0xF0, 0x17, 0x13, 0x9B, 0x9C, 
+0x1A, 0x36, 0x62, 0x9A, 0xEA, 0xEC, 0xB8, 0x08, 
+0x2B, 0x2A, 0xED, 0x2F, 0x1D, 0x8D, 0xA1, 0x20, 
+0x20, 0x24, 0x65, 0x5C, 0x32, 0xAE, 0x9E, 0x81, 
+0xED, 0x2E, 0x56, 0x2D, 0xCE, 0x7E, 0xCB, 0x3E, 
+0xBB, 0xA4, 0xAB, 0x34, 0x2F, 0x3E, 0x57, 
+
+0x76, 0x80, 0xBB, 0xF0, 0x5F, 0xBD, 0x83, 0x1D, 
+0xCA, 0xD8, 0x1B, 0x71, 0x0B, 0xA4, 0x4E, 0x15, 
+0x42, 0xC7, 0x66, 0x58, 0xAA, 0xFB, 0x0E, 0x87, 
+0x41, 0x70, 0x13, 0xCE, 0x6F, 0xC4, 0xAA, 0x15, 
+0x29, 0x0A, 0x4C, 0xBD, 0x8A, 0xE5, 0xF0, 0x61, 
+0xED, 0x13, 0x50, 0x10, 0x0E, 0x43, 0x7E, 0x31, 
+0x0F, 0xE9, 0x56, 0x72, 0x97, 0xB6, 0xB4, 0x24, 
+0xF4, 0xAB, 0xEF, 0x5E, 0x52, 0xDA, 0xFA, 0xBD, 
+0x85, 0x4C, 0xAE, 0x3E, 0x32, 0x5F, 0x07, 0x0B, 
+0x3B, 0xAA, 0xF1, 0x46, 0x6E, 0x2C, 0xD2, 0x69, 
+
+0xFE, 0x6A, 0x6F, 0x35, 0xE9, 0x5F, 0x9D, 0x7C, 
+0x7F, 0x3E, 0x7A, 0x0B, 0xA4, 0x99, 0xB0, 0xE8, 
+0xBE, 0xF8, 0x58, 0x69, 0x14, 0xD7, 0xCD, 0xCA, 
+0xEF, 0x1F, 0xD1, 0xF3, 0x67, 0xBB, 0xD1, 0xB3, 
+0x25, 0x35, 0x18, 0x28, 0xF0, 0x60, 0x0B, 0x72, 
+0x45, 0xB4, 0x04, 0x0D, 0xDC, 0x52, 0xF3, 0xC7, 
+0x21, 0xD4, 0x15, 0x2C, 0x46, 0xC2, 0xDF, 0x10, 
+0x85, 0xF6, 0xE5, 0xB1, 0xA4, 0x34, 0xF4, 0x76, 
+0x77, 0x99, 0x65, 0xDB, 0xE8, 0xA4, 0xAC, 0x80, 
+0x6A, 0x93, 0x99, 0xA4, 0x92, 0xDE, 0xBE, 0x7B, 
+0x42, 
+
+0x03, 0x95, 0xEF, 0x61, 0x82, 0x3E, 0xCF, 0x43, 
+0x33, 0x85, 0x57, 0x10, 0xFB, 0xD7, 0x5F, 0xC6, 
+0x34, 0x46, 0xBD, 0x59, 0xC7, 0xCC, 0x50, 0x42, 
+0x86, 0x3F, 0x0F, 0x3D, 0xEB, 0x25, 0x56, 0x88, 
+0xA9, 0x8E, 0x4D, 0xEB, 0x6A, 0xB0, 0xBD, 0x64, 
+0x4F, 0x2A, 0x6E, 0xF6, 0xEA, 0xA1, 0xBC, 0x37, 
+0x2D, 0x4F, 0xBB, 0x22, 0xD9, 0x8C, 0x60, 0x76, 
+0x3F, 0xE4, 0xF9, 0x89, 0xE9, 0xAE, 0xA9, 0xDB, 
+0xAA, 0x16, 0x75, 0x4D, 0x9D, 0x55, 0xE7, 0x5D, 
+0xCD, 0x94, 0x74, 0x33, 0x48, 0x43, 0x0E, 0xCB, 
+0x07, 0xE9, 
+
+0x61, 0xB6, 0x87, 0x2C, 0xDD, 0x7F, 0x47, 0xB0, 
+0x8F, 0x56, 0x51, 0xB5, 0x2B, 0x8B, 0xBE, 0x6E, 
+0xA1, 0xF6, 0xAA, 0x72, 0x93, 0x2B, 0x90, 0x91, 
+0xDE, 0xE8, 0xC0, 0x9E, 0xC1, 0x21, 0x5D, 0x75, 
+0x6E, 0xFC, 0xA0, 0xC3, 0x9F, 0xA7, 0xE1, 0x06, 
+0x1C, 0xA8, 0x05, 0x90, 0x1F, 0x00, 0xBE, 0x71, 
+0xEA, 0x1C, 0xA4, 0xCA, 0x46, 0xD5, 0xDC, 0xBD, 
+0x01, 0xC0, 0xCE, 0xDB, 0xBF, 0x6C, 0x05, 0xF2, 
+0xCF, 0xE0, 0x2B, 0xD2, 0x7E, 0x94, 0xF5, 0xDF, 
+0xE2, 0x60, 0x5E, 0xD4, 0xDC, 0xE4, 0x42, 0x7E, 
+0xE1, 0x97, 0x84, 
+
+0x69, 0x7B, 0x65, 0x82, 0x77, 0xB6, 0x41, 0xC8, 
+0x89, 0xC0, 0x16, 0x93, 0x55, 0xC6, 0x1F, 0x0F, 
+0xC0, 0x79, 0x13, 0x5E, 0x7E, 0x29, 0x19, 0x35, 
+0xAA, 0x40, 0x91, 0x26, 0xCE, 0x1F, 0x7E, 0xF6, 
+0xC3, 0xF7, 0x84, 0x5B, 0x5F, 0x66, 0x92, 0xE3, 
+0x85, 0x86, 0xE9, 0x45, 0x59, 0xA5, 0xA7, 0x1A, 
+0x42, 0x25, 0xE8, 0x50, 0x2F, 0x62, 0x27, 0x68, 
+0x9D, 0x1D, 0x05, 0x34, 0x6E, 0x2A, 0x9D, 0x3E, 
+0xB8, 0x8A, 0x9D, 0x8D, 0x03, 0x68, 0xD0, 0xA3, 
+0x69, 0x9F, 0x3A, 0xAA, 0x68, 0x9C, 0xA2, 0x80, 
+0x9A, 0x3D, 0xF9, 0x72, 
+
+0x34, 0x53, 0x5B, 0x5B, 0x16, 0x67, 0xC1, 0xF3, 
+0x93, 0x0C, 0xAF, 0x04, 0x86, 0xB6, 0xF0, 0xCE, 
+0x07, 0x8E, 0x9A, 0x09, 0xB7, 0x1D, 0xAB, 0x6C, 
+0xCF, 0x0E, 0xB0, 0xC9, 0x2E, 0xE7, 0xF2, 0xE9, 
+0xAC, 0x2E, 0x41, 0x84, 0xA2, 0xFC, 0x09, 0x26, 
+0xFD, 0xC9, 0x3C, 0x84, 0x8E, 0x1C, 0x7F, 0xB8, 
+0xE8, 0x68, 0x51, 0xDF, 0x22, 0x97, 0x61, 0x12, 
+0xFA, 0xB9, 0xFC, 0x03, 0xC6, 0x62, 0xBC, 0xB8, 
+0x35, 0xC6, 0xE3, 0xBE, 0x7B, 0x40, 0x26, 0x32, 
+0xC2, 0xD9, 0x04, 0x0B, 0x62, 0x12, 0x89, 0xF7, 
+0x73, 0xC2, 0xF6, 0xCB, 0x59, 
+
+0x76, 0xF0, 0x32, 0x23, 0x68, 0x08, 0xFA, 0xB6, 
+0xA5, 0xB9, 0x93, 0xF3, 0xA1, 0x93, 0xF7, 0xA2, 
+0x38, 0x49, 0xE9, 0x20, 0x9C, 0xF8, 0x8F, 0x0C, 
+0x07, 0x91, 0xAD, 0x97, 0xE9, 0xA4, 0x82, 0x13, 
+0x08, 0x51, 0xF9, 0x88, 0xBA, 0xF9, 0xF2, 0x5C, 
+0x5D, 0x74, 0x37, 0x5B, 0xA6, 0x83, 0x70, 0xE9, 
+0x94, 0x44, 0x35, 0x30, 0x49, 0x81, 0x80, 0x7F, 
+0xF8, 0x40, 0x6B, 0x58, 0x68, 0xD2, 0xEF, 0x25, 
+0x28, 0x93, 0xDF, 0x49, 0xE5, 0x8D, 0x2A, 0x97, 
+0x40, 0x80, 0xC2, 0xBD, 0x6E, 0x88, 0xDA, 0x00, 
+0x00, 0xBD, 0x92, 0x86, 0x35, 0x79, 
+
+0xCC, 0x63, 0x58, 0x8C, 0xA9, 0xDE, 0xB8, 0x96, 
+0x2A, 0x5C, 0x18, 0x74, 0xF5, 0xB6, 0x81, 0x9A, 
+0xD3, 0x9A, 0xA4, 0x86, 0x5E, 0x13, 0x8C, 0xDF, 
+0x81, 0xD8, 0x54, 0x01, 0xC6, 0x17, 0x43, 0x03, 
+0xAE, 0xCE, 0x79, 0xD2, 0xD5, 0x62, 0xC3, 0x95, 
+0xAE, 0xF8, 0x87, 0x36, 0xEF, 0x74, 0xF4, 0x70, 
+0x41, 0x09, 0x78, 0xE0, 0x3A, 0x34, 0x77, 0xCC, 
+0xAC, 0x92, 0x93, 0x97, 0x23, 0xCE, 0x3C, 0x02, 
+0xB6, 0xA7, 0xEC, 0xBB, 0x33, 0x59, 0xCD, 0xBA, 
+0x8A, 0x15, 0x92, 0x20, 0xD8, 0xD6, 0x40, 0x7D, 
+0x30, 0x46, 0xBB, 0x7B, 0x19, 0xD2, 0xC6, 
+
+0x14, 0xEF, 0xF3, 0xAC, 0xD7, 0xDA, 0x07, 0x36, 
+0x11, 0x45, 0x0B, 0xF7, 0x05, 0x3B, 0x6E, 0x95, 
+0x32, 0x0B, 0x76, 0xC3, 0x58, 0x8D, 0x8B, 0x2E, 
+0x66, 0xD2, 0x77, 0x10, 0x12, 0x07, 0xFC, 0x99, 
+0xB6, 0x01, 0x00, 0x03, 0xC4, 0xAD, 0x01, 0x21, 
+0x02, 0x40, 0x3B, 0xC5, 0xB8, 0xC9, 0x53, 0x10, 
+0x32, 0xBA, 0x96, 0x1C, 0x8B, 0x91, 0x24, 0xF6, 
+0x89, 0xB4, 0xAF, 0xF0, 0xBD, 0x46, 0xCE, 0xA7, 
+0x57, 0xBB, 0x62, 0x36, 0xC8, 0x8C, 0x1C, 0x08, 
+0x7B, 0xD4, 0x32, 0xC2, 0x49, 0xD1, 0xB7, 0x76, 
+0x96, 0xCF, 0xE3, 0x80, 0x7B, 0x90, 0x90, 0x2A, 
+
+0xAF, 0x22, 0xCD, 0x98, 0x12, 0x64, 0xEF, 0x47, 
+0x84, 0x46, 0xF7, 0x4C, 0x61, 0xED, 0xBF, 0x15, 
+0xC5, 0xE1, 0x33, 0x4D, 0x61, 0x81, 0x34, 0x85, 
+0x18, 0x24, 0x67, 0x8B, 0x73, 0xB4, 0xF7, 0xC1, 
+0xA3, 0x51, 0x67, 0x4D, 0x4D, 0x7B, 0xEA, 0x18, 
+0x91, 0xD4, 0xF3, 0x35, 0xD1, 0xF2, 0x9F, 0x27, 
+0x23, 0xAF, 0x31, 0x52, 0xC8, 0xFC, 0x69, 0xCB, 
+0xE6, 0x4A, 0x32, 0x01, 0xA1, 0x97, 0x74, 0x9F, 
+0x9B, 0x1E, 0x73, 0xDB, 0x8C, 0x46, 0x02, 0x1B, 
+0xEC, 0xBE, 0x58, 0x70, 0x4E, 0xD6, 0xD4, 0x12, 
+0x4A, 0xB9, 0x64, 0xF7, 0xBF, 0x9A, 0x68, 0x11, 
+0x54, 
+
+0x40, 0xE9, 0xB9, 0x0A, 0x0C, 0xF4, 0xA2, 0x2D, 
+0xBA, 0x15, 0xB9, 0x6F, 0xE8, 0x98, 0x69, 0xDC, 
+0xB7, 0x43, 0x00, 0xE7, 0x25, 0x56, 0xC8, 0x14, 
+0xB0, 0x13, 0xC7, 0x8F, 0xEE, 0x96, 0xD9, 0x8D, 
+0x66, 0x02, 0x9C, 0x6C, 0x74, 0x4E, 0x1F, 0x8F, 
+0x93, 0xCF, 0x32, 0x31, 0x51, 0x89, 0xCB, 0x16, 
+0x9F, 0x2C, 0x23, 0x26, 0x4E, 0x26, 0xEE, 0x08, 
+0x71, 0x1D, 0x84, 0xB2, 0x4C, 0xB1, 0x70, 0x86, 
+0x06, 0xD0, 0xBE, 0xAE, 0x63, 0x02, 0xE4, 0x15, 
+0x38, 0x35, 0x80, 0xC1, 0xF3, 0x1B, 0xB6, 0xD8, 
+0x43, 0x95, 0xF9, 0x82, 0xA6, 0x97, 0xF2, 0xAC, 
+0xDC, 0x44, 
+
+0x46, 0xC4, 0xA9, 0x44, 0xBE, 0xAE, 0xCE, 0xF8, 
+0x3C, 0x1F, 0xC0, 0x28, 0xC1, 0xF3, 0xB6, 0xC0, 
+0x94, 0x83, 0x20, 0x81, 0xEC, 0xBC, 0x8C, 0xE8, 
+0x63, 0x02, 0x18, 0xF2, 0x57, 0xE2, 0xE0, 0x1D, 
+0x5B, 0x61, 0x9A, 0x65, 0xFB, 0xCC, 0xC1, 0x43, 
+0x57, 0x31, 0xAA, 0x18, 0x70, 0x08, 0xAB, 0xC8, 
+0xB6, 0x21, 0xF7, 0x10, 0x3E, 0x79, 0x32, 0x96, 
+0xC5, 0x2D, 0xD8, 0xE5, 0xC4, 0xD3, 0xD6, 0x5F, 
+0xDD, 0x26, 0x15, 0x3E, 0x92, 0x76, 0x77, 0xF1, 
+0xEB, 0x5E, 0xE1, 0x54, 0xA3, 0x03, 0x69, 0xF7, 
+0xBA, 0x4B, 0x9E, 0x51, 0x26, 0xB5, 0x8D, 0x69, 
+0x3A, 0x7D, 0xC9, 
+
+0xA8, 0x2A, 0xE9, 0xC5, 0x02, 0x00, 0xF4, 0x61, 
+0xC7, 0x1A, 0x78, 0x72, 0xCC, 0x22, 0xF9, 0xE6, 
+0x7F, 0x9C, 0x8F, 0xFB, 0xEF, 0x3A, 0x02, 0x42, 
+0x9B, 0xA9, 0x82, 0x25, 0xA8, 0xEF, 0xC3, 0x56, 
+0x95, 0x24, 0xD7, 0xC4, 0x88, 0x6A, 0xC1, 0xD5, 
+0x27, 0xB9, 0xE6, 0x8B, 0x65, 0xD4, 0x82, 0xE5, 
+0xF6, 0xB1, 0x82, 0xCF, 0x3F, 0xA4, 0xA4, 0x90, 
+0x4B, 0x8A, 0x63, 0xEE, 0x3D, 0x82, 0x85, 0x39, 
+0xFA, 0x19, 0x78, 0xB7, 0xCA, 0xCD, 0x32, 0xDB, 
+0x25, 0xCD, 0x65, 0x27, 0xCF, 0x2C, 0x9F, 0x65, 
+0x43, 0xCE, 0x07, 0xB4, 0x9D, 0x7E, 0x89, 0xC6, 
+0x4A, 0x8C, 0x20, 0x2D, 
+
+0xFE, 0x65, 0x97, 0xF3, 0xF5, 0xBD, 0xC4, 0xC5, 
+0x0B, 0x60, 0x01, 0x79, 0x52, 0x4A, 0x7F, 0x60, 
+0x39, 0xCE, 0xC0, 0x9F, 0x82, 0x8D, 0x3D, 0xF6, 
+0x7B, 0x61, 0x1A, 0x5F, 0x9D, 0x49, 0x99, 0x6A, 
+0xCB, 0xD4, 0x19, 0x05, 0xB5, 0xCB, 0x10, 0x99, 
+0xB2, 0x1C, 0x43, 0x4A, 0xC6, 0xC9, 0x22, 0xF0, 
+0x91, 0x46, 0xC4, 0xFD, 0x6D, 0xC0, 0xB9, 0x4E, 
+0xC5, 0x4C, 0xB5, 0x97, 0xC4, 0xDD, 0x3E, 0x9D, 
+0xA2, 0x84, 0x19, 0x5A, 0x67, 0x79, 0x3E, 0xAA, 
+0xC8, 0xAD, 0x7A, 0xCC, 0xFC, 0x06, 0xE5, 0x2D, 
+0x23, 0xCF, 0x49, 0x2D, 0x42, 0x7E, 0x5D, 0x68, 
+0xFA, 0xD1, 0x63, 0xCC, 0xAC, 
+
+0x79, 0x15, 0x70, 0x2A, 0x23, 0x6D, 0x2C, 0xF8, 
+0x3E, 0x39, 0x70, 0x08, 0xF3, 0xA6, 0xBD, 0x02, 
+0x78, 0x3C, 0xE4, 0x0A, 0xC5, 0x5C, 0xAA, 0xBC, 
+0x61, 0x87, 0xA0, 0x3C, 0x59, 0x79, 0xA7, 0x3A, 
+0x06, 0x85, 0xA8, 0xDF, 0x61, 0xAD, 0x8F, 0x77, 
+0x52, 0x16, 0x5F, 0xD8, 0x09, 0x65, 0xE3, 0x98, 
+0x12, 0x4D, 0x90, 0x92, 0x7E, 0x00, 0x1F, 0xA0, 
+0x1C, 0x82, 0xED, 0xD7, 0x47, 0x01, 0x33, 0x48, 
+0x4D, 0x72, 0x15, 0x08, 0xFD, 0xF7, 0xE4, 0x29, 
+0x6A, 0xE2, 0x34, 0x8D, 0x1A, 0xD8, 0xFC, 0xE3, 
+0x11, 0x95, 0x72, 0x4C, 0x29, 0xCB, 0xF8, 0xA0, 
+0x79, 0x16, 0x0D, 0x28, 0xEB, 0x6C, 
+
+0xDE, 0x36, 0x10, 0x19, 0x1F, 0x76, 0xC1, 0x18, 
+0xE0, 0xCD, 0x63, 0x7D, 0xE2, 0x71, 0x27, 0xEA, 
+0x97, 0x8D, 0x84, 0x70, 0x82, 0xC0, 0x87, 0xD5, 
+0x75, 0xE8, 0xD7, 0xFA, 0x2D, 0x0D, 0x42, 0x6E, 
+0x23, 0x58, 0x77, 0x41, 0x0F, 0x1B, 0x0A, 0x1A, 
+0x7F, 0xFF, 0xA5, 0xA5, 0x9B, 0x9B, 0xB9, 0x21, 
+0xBB, 0x06, 0xC8, 0x7C, 0x43, 0x13, 0x82, 0x97, 
+0xC6, 0x3F, 0x16, 0x07, 0xA9, 0xFF, 0x29, 0x3D, 
+0xA9, 0xB5, 0xB0, 0x1D, 0xDB, 0xAC, 0x6E, 0x6D, 
+0x03, 0xF6, 0x9D, 0xB7, 0x59, 0xC2, 0x75, 0x9E, 
+0x54, 0x66, 0x1B, 0x5F, 0x5D, 0x03, 0xB0, 0x21, 
+0x86, 0x5E, 0x14, 0x26, 0x05, 0xDA, 0x8C, 
+
+0xD1, 0xF3, 0x64, 0x05, 0xE5, 0x87, 0xBC, 0x80, 
+0x9B, 0xF9, 0x02, 0xBA, 0x84, 0x34, 0x32, 0x91, 
+0xC0, 0x72, 0x94, 0x07, 0x61, 0x2E, 0xC4, 0xC3, 
+0xBF, 0x55, 0xA7, 0xE4, 0x30, 0x96, 0x1F, 0xD3, 
+0x35, 0xD7, 0xF5, 0x6E, 0x96, 0x3E, 0xC1, 0xC5, 
+0xEF, 0x5F, 0xE9, 0x35, 0x4E, 0x97, 0x52, 0xED, 
+0x06, 0x7A, 0x91, 0x8B, 0xBC, 0x98, 0xA4, 0x19, 
+0x02, 0x8E, 0x60, 0x26, 0x28, 0x01, 0x0C, 0x67, 
+0x65, 0xE6, 0xAB, 0x30, 0x5F, 0xF2, 0x68, 0x41, 
+0x86, 0xF8, 0xA7, 0xCC, 0x05, 0x17, 0x1D, 0x54, 
+0x0E, 0x42, 0xC6, 0xC2, 0x02, 0x8E, 0xE5, 0xD6, 
+0x24, 0x4A, 0x6A, 0xF5, 0xD3, 0x76, 0xFB, 0x60, 
+
+0xF6, 0x64, 0x76, 0x66, 0x5B, 0xD7, 0xEE, 0xE4, 
+0x88, 0x16, 0xAC, 0x1E, 0x7D, 0x1A, 0x52, 0x81, 
+0x18, 0x9E, 0xCE, 0x06, 0x3E, 0x71, 0x73, 0x09, 
+0x34, 0x7A, 0x37, 0xF5, 0x21, 0x66, 0x66, 0x7F, 
+0xA8, 0x79, 0x40, 0x60, 0xFB, 0x56, 0xAE, 0xA7, 
+0x72, 0x50, 0xC2, 0x70, 0x3A, 0x27, 0x8F, 0x88, 
+0xEA, 0x2B, 0x39, 0x8A, 0x1D, 0xFE, 0x6E, 0xA4, 
+0xE4, 0xE1, 0xF9, 0x76, 0x6B, 0x8B, 0x55, 0x3E, 
+0x97, 0xCC, 0xD4, 0x01, 0x50, 0x5C, 0x82, 0x5A, 
+0xD0, 0x8C, 0x1D, 0xEA, 0xA3, 0xC0, 0xFD, 0x12, 
+0x57, 0x48, 0x66, 0x25, 0x0E, 0x6D, 0x45, 0xC6, 
+0xDD, 0x56, 0x4A, 0x31, 0xFD, 0xFA, 0xB7, 0x94, 
+0x23, 
+
+0x4E, 0x15, 0xE4, 0xFD, 0xEF, 0xC0, 0x4B, 0x5E, 
+0xEA, 0xAB, 0x6C, 0x2E, 0xC5, 0x8B, 0x1E, 0xC3, 
+0x1F, 0x40, 0x0A, 0x76, 0x59, 0xCF, 0xCF, 0xB3, 
+0x7E, 0xA6, 0xBD, 0x2A, 0x1E, 0xCF, 0xF0, 0x60, 
+0x15, 0xB9, 0x8C, 0x72, 0x73, 0xC5, 0xA1, 0xE2, 
+0x4C, 0x7C, 0xB9, 0x7D, 0xA1, 0xF1, 0xB7, 0xE7, 
+0xD7, 0x9F, 0xBF, 0x07, 0xF4, 0x65, 0xD0, 0x23, 
+0xD4, 0x07, 0xD7, 0xBB, 0xBE, 0x7B, 0xE9, 0x44, 
+0x9C, 0x44, 0xCD, 0x8E, 0x98, 0x00, 0xE2, 0x84, 
+0xE2, 0x8A, 0xC6, 0x51, 0xEB, 0xAA, 0x62, 0xB1, 
+0x1B, 0x67, 0xCD, 0x65, 0x57, 0x20, 0x05, 0xE3, 
+0x3D, 0x0B, 0xCB, 0x24, 0x18, 0x04, 0x11, 0x46, 
+0xAB, 0xD2, 
+
+0xBE, 0x95, 0x6A, 0xF1, 0x9E, 0x62, 0x54, 0x37, 
+0x8A, 0x9D, 0xC7, 0x1B, 0xC4, 0x5C, 0xA7, 0xCD, 
+0xAA, 0x1E, 0xFC, 0xA3, 0xAF, 0x2F, 0x0A, 0xE5, 
+0x97, 0xCD, 0x3E, 0x95, 0xA3, 0x80, 0x87, 0x7D, 
+0xE5, 0xFF, 0x6A, 0x6C, 0x63, 0xBB, 0x06, 0xBE, 
+0x43, 0x9F, 0xCE, 0x4B, 0x62, 0x10, 0x47, 0xFA, 
+0x6E, 0xE4, 0x3E, 0x9A, 0x61, 0x6D, 0x05, 0x23, 
+0x98, 0x94, 0x1F, 0xE2, 0xE5, 0xB4, 0x15, 0x0F, 
+0xB4, 0x3A, 0xC4, 0x43, 0xEC, 0x2C, 0xAE, 0xB2, 
+0xD0, 0x79, 0xCE, 0xC6, 0x53, 0xE1, 0x51, 0xFB, 
+0xF9, 0x22, 0xA7, 0x7C, 0x55, 0x9C, 0xCC, 0xC0, 
+0x35, 0x52, 0x01, 0x40, 0xFC, 0xCD, 0x6D, 0xE8, 
+0x40, 0x9B, 0x06, 
+
+0x19, 0xF3, 0x9D, 0xF6, 0xCB, 0x35, 0x08, 0xAB, 
+0xFE, 0xD2, 0xB4, 0x85, 0xEC, 0xFB, 0xD2, 0x32, 
+0x18, 0x13, 0x9C, 0x78, 0x6B, 0x0C, 0xF1, 0x06, 
+0xA8, 0x73, 0xC9, 0xEF, 0xB3, 0xC4, 0x89, 0x30, 
+0x21, 0xA0, 0xA7, 0xDC, 0x35, 0xA6, 0x94, 0xF8, 
+0x1B, 0x2A, 0xF0, 0xB7, 0xFC, 0xF3, 0x00, 0x70, 
+0x3C, 0xF9, 0x16, 0x88, 0x98, 0xE0, 0x1F, 0xC1, 
+0xFD, 0x09, 0x76, 0xD9, 0x42, 0x82, 0x25, 0xD5, 
+0xA9, 0x40, 0x5B, 0xF8, 0x92, 0x93, 0x38, 0xD1, 
+0x0B, 0xD9, 0x4C, 0x0C, 0x98, 0x69, 0x86, 0x78, 
+0x36, 0x4D, 0x33, 0x4E, 0xAA, 0xDD, 0x90, 0x54, 
+0x13, 0xFA, 0x5C, 0x73, 0xBD, 0xA0, 0xBA, 0x24, 
+0xC7, 0x2F, 0x40, 0x50, 
+
+0xBD, 0xD0, 0xDA, 0x08, 0x00, 0x36, 0x3C, 0x6D, 
+0xE8, 0x10, 0x16, 0xA3, 0x77, 0xAA, 0x0D, 0x74, 
+0x16, 0x01, 0xEC, 0x40, 0x24, 0xC6, 0x6C, 0x19, 
+0xD1, 0x38, 0x12, 0x85, 0x60, 0xC1, 0xBD, 0x9D, 
+0xF4, 0xDB, 0xC8, 0x0D, 0x95, 0xA0, 0x70, 0x9B, 
+0x25, 0xFD, 0x8A, 0x42, 0x1E, 0xCB, 0x6E, 0x03, 
+0x2E, 0x7A, 0xDF, 0x97, 0x6B, 0x6D, 0x4C, 0xD7, 
+0x0A, 0xB0, 0x02, 0x68, 0x49, 0x81, 0xF2, 0x81, 
+0x3D, 0x61, 0x9F, 0xAE, 0x23, 0xBE, 0x6E, 0xB5, 
+0x35, 0x69, 0xB7, 0x00, 0x53, 0x00, 0x48, 0x4B, 
+0xF4, 0xB2, 0x23, 0x08, 0xCD, 0x93, 0x68, 0x5A, 
+0xA7, 0x66, 0xC7, 0x2E, 0xB7, 0x2D, 0xC8, 0x5F, 
+0x23, 0xC5, 0x02, 0x90, 0x28, 
+
+0xA7, 0x3A, 0x75, 0xB0, 0xFA, 0x82, 0xBE, 0x0D, 
+0x4D, 0xCB, 0xD5, 0xF9, 0xC4, 0xB7, 0x22, 0xF8, 
+0x42, 0x86, 0xFB, 0x42, 0xE8, 0xDD, 0x3E, 0x5C, 
+0x5A, 0xD3, 0x64, 0x6B, 0x65, 0x84, 0x4A, 0xEE, 
+0xC6, 0x20, 0x19, 0x53, 0x71, 0x98, 0x6B, 0xFB, 
+0xDC, 0xB3, 0x93, 0xF4, 0x5E, 0xEE, 0xED, 0xA8, 
+0xB4, 0x82, 0xCD, 0xB4, 0xBE, 0xDC, 0x7A, 0xC5, 
+0x4E, 0x1E, 0x3C, 0xC9, 0xE4, 0xBE, 0x7D, 0xFA, 
+0x0C, 0x82, 0x6F, 0x73, 0x85, 0xA0, 0x1C, 0xF9, 
+0x18, 0xA2, 0x22, 0x3B, 0xF1, 0x42, 0x52, 0x04, 
+0xC3, 0x3F, 0x07, 0xBF, 0xEE, 0x48, 0x97, 0x4D, 
+0x17, 0x6F, 0x96, 0x97, 0x82, 0xCE, 0x6E, 0x8A, 
+0x2C, 0x99, 0x82, 0x30, 0x01, 0xE4, 
+
+0x37, 0x5A, 0x27, 0x4A, 0xB4, 0xE8, 0x18, 0x78, 
+0xC1, 0xEC, 0x18, 0x79, 0xF3, 0x4A, 0x39, 0xD1, 
+0xCE, 0x07, 0xFF, 0x4E, 0xF9, 0xA8, 0xB8, 0xBE, 
+0x2C, 0x79, 0x75, 0xB8, 0x1C, 0x83, 0xE1, 0x56, 
+0x03, 0x15, 0xB7, 0x51, 0x17, 0xD0, 0xB2, 0x2F, 
+0xA1, 0x66, 0x5D, 0x8A, 0x93, 0xD3, 0xD0, 0xF6, 
+0x10, 0x44, 0x28, 0x5C, 0x9B, 0x4F, 0x07, 0x04, 
+0x18, 0xF5, 0x2C, 0x76, 0x99, 0x2E, 0x01, 0x02, 
+0x28, 0x4D, 0xCE, 0x4F, 0xEF, 0x4D, 0x2C, 0x01, 
+0xE5, 0xB0, 0x86, 0xC3, 0x8B, 0x2F, 0xA2, 0x0E, 
+0x53, 0x62, 0x3D, 0x7E, 0x3F, 0x7D, 0xBF, 0xD1, 
+0x07, 0xFA, 0xBE, 0x4E, 0x36, 0x4E, 0xB9, 0x50, 
+0x84, 0x06, 0x1F, 0x87, 0xAA, 0xD0, 0xB5, 
+
+0xFA, 0xD3, 0x14, 0x5A, 0x45, 0x71, 0x84, 0x25, 
+0xF4, 0xEF, 0x52, 0xE2, 0xFA, 0x27, 0x7C, 0xEB, 
+0x0C, 0x7A, 0xF0, 0x69, 0xBF, 0xE5, 0xD1, 0x22, 
+0xE2, 0x65, 0x2B, 0x87, 0x1E, 0xE1, 0xF9, 0x15, 
+0x18, 0xD9, 0xCB, 0xCA, 0x8C, 0x05, 0x38, 0xA5, 
+0x6A, 0x5A, 0x4D, 0x21, 0xFB, 0xD9, 0x8C, 0x9F, 
+0xCD, 0xE6, 0x9B, 0x96, 0xAE, 0x97, 0x6F, 0x7A, 
+0xDB, 0xF1, 0x83, 0x9C, 0xCA, 0x64, 0x3A, 0x69, 
+0x59, 0xA0, 0x16, 0x3D, 0x90, 0x41, 0x81, 0xD2, 
+0x87, 0x0A, 0xB3, 0x28, 0x28, 0x1B, 0x72, 0x9C, 
+0x8C, 0x0C, 0x10, 0x47, 0x15, 0x48, 0x23, 0xBD, 
+0x33, 0x33, 0xB2, 0xAF, 0xA3, 0x61, 0x12, 0xF7, 
+0x6B, 0x7B, 0xE9, 0xBB, 0x08, 0xAE, 0xAB, 0x2C, 
+
+0xE5, 0x45, 0x91, 0x8A, 0x4F, 0x19, 0xFA, 0x73, 
+0x34, 0x60, 0xA6, 0x57, 0xA8, 0x2E, 0xEE, 0x14, 
+0xB6, 0x1B, 0xD1, 0x7D, 0xE5, 0x69, 0x46, 0x4E, 
+0xCD, 0x7C, 0x14, 0xF0, 0x7D, 0xC7, 0x92, 0x17, 
+0x8F, 0x7F, 0xFD, 0xAB, 0x55, 0xEC, 0xBB, 0x9B, 
+0x26, 0x74, 0x5F, 0x29, 0x24, 0xA5, 0x1D, 0x56, 
+0xBA, 0x9C, 0xA1, 0xE6, 0xA5, 0xED, 0xDB, 0x65, 
+0x3B, 0xA1, 0x17, 0x90, 0x19, 0xDB, 0xC1, 0xC4, 
+0x59, 0xF2, 0xAD, 0xFD, 0x27, 0x51, 0xA5, 0xF0, 
+0x40, 0x37, 0x86, 0x9A, 0x33, 0x97, 0x63, 0x72, 
+0x75, 0x5B, 0x57, 0xB4, 0xAB, 0x8E, 0x9B, 0x6B, 
+0xFA, 0x9D, 0x4A, 0xBD, 0xC9, 0x27, 0x17, 0x94, 
+0xA8, 0x16, 0x71, 0xA9, 0xE2, 0x3B, 0xAC, 0x39, 
+0xD6, 
+
+0x61, 0xA4, 0x54, 0x03, 0xCF, 0xDE, 0xC0, 0xB2, 
+0xC5, 0x03, 0x2F, 0xA1, 0xC7, 0x26, 0x4D, 0x8A, 
+0x6C, 0xBF, 0x86, 0x76, 0xCC, 0x2E, 0x15, 0x6B, 
+0xE1, 0x74, 0x13, 0x9C, 0xDE, 0x5A, 0xDD, 0x69, 
+0x43, 0x54, 0xF0, 0xC6, 0xC0, 0xA0, 0x74, 0xBA, 
+0x62, 0xCB, 0xC9, 0xF3, 0x3A, 0x6D, 0x49, 0xA8, 
+0xCB, 0x28, 0x55, 0x64, 0x4B, 0xEA, 0x9F, 0xB0, 
+0xE8, 0xAE, 0x2B, 0xC7, 0xC1, 0x91, 0x27, 0x3D, 
+0x88, 0x8A, 0x86, 0x85, 0x12, 0x9C, 0x7E, 0xAA, 
+0xA6, 0xEE, 0xAA, 0x42, 0x93, 0xE8, 0xFE, 0xFE, 
+0xF0, 0x88, 0xE3, 0x00, 0xEC, 0xA1, 0x2B, 0x6F, 
+0x07, 0xF0, 0x0D, 0xA9, 0x2A, 0x9E, 0xAC, 0xBB, 
+0x5D, 0x4C, 0xA3, 0x29, 0x3E, 0xBE, 0x81, 0xED, 
+0x20, 0xAE, 
+
+0x8F, 0xBD, 0x43, 0xF4, 0x99, 0x6B, 0x93, 0x14, 
+0x5F, 0x25, 0xC6, 0xFE, 0x1F, 0x83, 0x7C, 0x8F, 
+0x9E, 0x7F, 0xC5, 0xB8, 0xFA, 0x75, 0x4C, 0x36, 
+0x2C, 0x6C, 0xD1, 0x5F, 0x42, 0xEC, 0xEA, 0x41, 
+0x05, 0xC9, 0xFB, 0xA2, 0x4B, 0x96, 0xAB, 0x3C, 
+0xA1, 0xB4, 0xA7, 0xEF, 0x9C, 0x84, 0x5E, 0xD3, 
+0xB0, 0xA2, 0xF8, 0x1F, 0xC0, 0xC8, 0x10, 0xCA, 
+0x17, 0xAB, 0xCD, 0x98, 0xA2, 0xE4, 0xC7, 0xC8, 
+0xEA, 0x7B, 0x5F, 0x0C, 0x04, 0xAD, 0x8E, 0x7C, 
+0x16, 0x9D, 0xE1, 0xC4, 0xE6, 0x95, 0x39, 0xB0, 
+0x67, 0x9C, 0xC9, 0x0F, 0x4B, 0xEC, 0x7B, 0x5A, 
+0xAB, 0xF3, 0xD2, 0xEE, 0x49, 0x8B, 0xDD, 0xFC, 
+0xDC, 0xCB, 0x9B, 0x7D, 0xDD, 0x58, 0x17, 0xD7, 
+0x03, 0xFC, 0xED, 
+
+0x9C, 0xEA, 0x7A, 0xC8, 0xCB, 0x19, 0xD9, 0x7A, 
+0x6C, 0x12, 0xBF, 0x0B, 0xEB, 0xE1, 0x39, 0xF4, 
+0x5C, 0x5F, 0xD8, 0xE8, 0x14, 0x1D, 0x11, 0xFB, 
+0x47, 0x92, 0xB7, 0x64, 0xC2, 0x3D, 0x31, 0x72, 
+0x93, 0x50, 0x99, 0x75, 0xCC, 0xB3, 0x00, 0x70, 
+0x22, 0xA7, 0x57, 0xE6, 0x89, 0xAC, 0xDF, 0x6E, 
+0x77, 0x7D, 0x5D, 0xD0, 0x66, 0x2E, 0x55, 0x95, 
+0x9B, 0xC4, 0x73, 0x63, 0x4D, 0xCE, 0x74, 0xE6, 
+0xAD, 0xC8, 0x3F, 0x3F, 0x5F, 0x4C, 0x96, 0x30, 
+0x38, 0x31, 0x85, 0x81, 0xF7, 0xEF, 0x22, 0xCC, 
+0x5F, 0x7D, 0x0C, 0x26, 0xDD, 0x28, 0xE4, 0x52, 
+0xB3, 0xBA, 0x97, 0xAF, 0x08, 0x8B, 0x31, 0x84, 
+0x81, 0x00, 0xC4, 0xB3, 0x25, 0xE7, 0x2D, 0x97, 
+0xE5, 0xFA, 0xA3, 0xBF, 
+
+0xCC, 0x61, 0xE7, 0x5E, 0x3E, 0xD4, 0x26, 0xB3, 
+0x57, 0x1F, 0xD1, 0x9A, 0x48, 0xB6, 0x71, 0xF7, 
+0x3F, 0x28, 0x52, 0x14, 0x56, 0xDA, 0xA8, 0xF9, 
+0x10, 0xCF, 0xF8, 0x86, 0x09, 0x08, 0xFA, 0xD1, 
+0x2A, 0x9A, 0xAE, 0x55, 0xCA, 0x96, 0xA2, 0xB9, 
+0x9D, 0x38, 0x8A, 0x7A, 0x26, 0xB0, 0xCB, 0xD8, 
+0x15, 0x60, 0x26, 0xFC, 0xA1, 0x84, 0xCF, 0xC4, 
+0xD5, 0xE9, 0xA8, 0x56, 0x4D, 0x52, 0xC9, 0xB8, 
+0xC8, 0x6C, 0x0D, 0xAE, 0x83, 0xA2, 0xBD, 0xA2, 
+0xAB, 0x80, 0x82, 0xC0, 0xE2, 0x3C, 0x18, 0x0A, 
+0xFE, 0xE3, 0xAB, 0x0A, 0x24, 0xBB, 0xED, 0xE8, 
+0xB7, 0x08, 0x8D, 0x7C, 0x4D, 0xE7, 0x72, 0x2F, 
+0x8C, 0x15, 0x6F, 0x66, 0x71, 0xA4, 0x89, 0x8C, 
+0x44, 0x11, 0xEC, 0x24, 0x61, 
+
+0xB0, 0xDD, 0x81, 0x31, 0xBF, 0x8B, 0x47, 0xA2, 
+0x28, 0xDA, 0xDF, 0xB7, 0xD7, 0xD9, 0xDC, 0x29, 
+0x4D, 0x22, 0x9B, 0x07, 0x4A, 0xCB, 0xAC, 0x2F, 
+0xE4, 0x98, 0x9F, 0x66, 0x8D, 0xFC, 0x18, 0xCC, 
+0x4A, 0x37, 0x46, 0x7C, 0x63, 0xC7, 0x3F, 0x35, 
+0x0F, 0xF2, 0x5C, 0x36, 0xF8, 0x31, 0x2A, 0xE1, 
+0x8F, 0xDA, 0x51, 0x40, 0x57, 0xDF, 0xB6, 0xED, 
+0xDB, 0x1D, 0xA1, 0xED, 0x8B, 0xAA, 0x82, 0x59, 
+0x13, 0xD4, 0x6F, 0x32, 0x04, 0x85, 0x59, 0x5C, 
+0x8D, 0x84, 0x1C, 0xA0, 0x9D, 0x85, 0x3D, 0xFB, 
+0x24, 0x8F, 0x07, 0x78, 0x5F, 0xED, 0x8B, 0xBB, 
+0x21, 0x79, 0x0A, 0x62, 0x78, 0x93, 0xA5, 0xC9, 
+0x51, 0x42, 0x6A, 0xB0, 0x7C, 0x02, 0x84, 0x1A, 
+0x3E, 0x12, 0xA2, 0x4B, 0x5D, 0xB4, 
+
+0x15, 0x42, 0x0A, 0xAD, 0xDC, 0x7D, 0x78, 0x87, 
+0xEE, 0x77, 0xF0, 0xBB, 0xC7, 0x0E, 0xDC, 0xCB, 
+0x94, 0x06, 0x17, 0x3F, 0x37, 0x4B, 0x6D, 0x19, 
+0x05, 0xE8, 0x73, 0xEE, 0xFD, 0x65, 0x84, 0x05, 
+0x9C, 0x31, 0x3F, 0xA7, 0x62, 0x38, 0xF2, 0x1B, 
+0xEB, 0xCA, 0x15, 0x32, 0x15, 0x99, 0x12, 0xEF, 
+0x9E, 0x20, 0xC7, 0x15, 0xF7, 0xC3, 0x53, 0x9A, 
+0xC8, 0xC0, 0xC1, 0xC2, 0x6C, 0x70, 0xF7, 0xC0, 
+0x99, 0x6E, 0x45, 0xE1, 0x1D, 0x2C, 0x63, 0x7C, 
+0x08, 0xA7, 0x38, 0x60, 0x3B, 0x4F, 0x43, 0x7F, 
+0x21, 0x4F, 0x54, 0x3B, 0x0A, 0xAD, 0x8B, 0x1B, 
+0x02, 0xD3, 0x9E, 0x5B, 0x7C, 0x51, 0x79, 0x8A, 
+0x30, 0x1E, 0xDC, 0xE3, 0xBD, 0xCC, 0x47, 0x33, 
+0xDB, 0x6E, 0xB4, 0x50, 0x8C, 0x82, 0xDF, 
+
+0x32, 0xFC, 0xDC, 0x34, 0x80, 0x0A, 0x0D, 0x69, 
+0x03, 0xC4, 0x6D, 0x0E, 0xBA, 0x3D, 0xB0, 0x0B, 
+0x44, 0x60, 0x23, 0x7F, 0x34, 0x63, 0xD1, 0x9A, 
+0x3B, 0xFD, 0x64, 0x5D, 0x42, 0xF6, 0x48, 0xAF, 
+0x73, 0x41, 0xA9, 0x63, 0x98, 0x46, 0xC9, 0x4E, 
+0xFA, 0x39, 0xEA, 0x67, 0xA8, 0xF7, 0x85, 0xE4, 
+0xFE, 0x4D, 0x94, 0x33, 0x77, 0xA7, 0x0D, 0x2A, 
+0x36, 0x65, 0x59, 0xBC, 0xBD, 0xE5, 0x57, 0xD1, 
+0x48, 0xE9, 0x03, 0x12, 0x84, 0xBC, 0x43, 0xE1, 
+0xF0, 0x10, 0x7D, 0x9F, 0xB3, 0xCC, 0xAA, 0x92, 
+0xAD, 0xCC, 0x38, 0xF5, 0x64, 0x3F, 0x81, 0x60, 
+0xE6, 0x00, 0xD7, 0xE4, 0x30, 0x74, 0xB7, 0xB1, 
+0x11, 0x74, 0x37, 0x09, 0xEF, 0xDC, 0x69, 0x58, 
+0x02, 0x75, 0xE3, 0xFE, 0x44, 0xF0, 0xB8, 0xDB, 
+
+0x02, 0x4B, 0xC6, 0xA3, 0xA7, 0x2B, 0xF4, 0x04, 
+0xD1, 0xA8, 0x9C, 0x50, 0x39, 0x02, 0xAF, 0x61, 
+0xFC, 0x48, 0x3F, 0xDC, 0x32, 0x39, 0x7F, 0x03, 
+0x1F, 0x51, 0x56, 0x29, 0x48, 0x94, 0x9F, 0xA8, 
+0x75, 0xD9, 0x77, 0x94, 0x85, 0x2B, 0xAC, 0x42, 
+0xF5, 0x14, 0xC9, 0xD9, 0x91, 0xA6, 0x9F, 0xF5, 
+0xB5, 0x37, 0x17, 0x0F, 0xD1, 0x71, 0x9D, 0x82, 
+0x79, 0x24, 0x7F, 0xDE, 0x6D, 0x49, 0xA1, 0xE8, 
+0x5F, 0x5E, 0xDA, 0x16, 0x9F, 0x72, 0x1E, 0x39, 
+0x25, 0xA5, 0xF2, 0x8C, 0x7F, 0xC6, 0x82, 0x21, 
+0xCF, 0xB5, 0xE8, 0xE0, 0x1D, 0x43, 0x14, 0xF4, 
+0x77, 0x70, 0xA4, 0xC5, 0x47, 0xC3, 0xC5, 0xB1, 
+0x01, 0x8F, 0x9B, 0x22, 0x9E, 0x5C, 0xB0, 0x2C, 
+0xC0, 0x6C, 0xC0, 0x63, 0x2A, 0x41, 0xD7, 0x84, 
+0x13, 
+
+0xAA, 0x90, 0xC8, 0x76, 0x7C, 0x95, 0xE7, 0x1C, 
+0x99, 0x89, 0xFB, 0xB8, 0x9C, 0x59, 0xED, 0x93, 
+0xC6, 0xF7, 0x4C, 0x81, 0xAB, 0x04, 0xF2, 0xE0, 
+0x4B, 0x92, 0x85, 0xAA, 0x8C, 0x12, 0xA8, 0xEE, 
+0xE8, 0xC3, 0x92, 0xC0, 0x4A, 0xB8, 0x44, 0xC4, 
+0x74, 0x12, 0x15, 0x96, 0x64, 0x3C, 0x7D, 0x9D, 
+0x88, 0x31, 0x0F, 0x2C, 0xF3, 0x2C, 0xDA, 0x47, 
+0x13, 0x05, 0x5C, 0x35, 0x7A, 0x31, 0xD3, 0x68, 
+0xC2, 0xE3, 0x2D, 0x67, 0xA2, 0x33, 0xE1, 0x5F, 
+0x58, 0xE4, 0x4F, 0x91, 0xA6, 0x03, 0xB5, 0x41, 
+0xC8, 0xDC, 0xC0, 0xFF, 0xC6, 0x27, 0xB9, 0xC0, 
+0x40, 0x00, 0x2A, 0xC3, 0xE0, 0xDF, 0xF3, 0xF2, 
+0x21, 0xA7, 0x77, 0x70, 0xEB, 0x01, 0xF6, 0x70, 
+0x03, 0x01, 0x6E, 0xC4, 0x86, 0xB7, 0xBE, 0x17, 
+0xD3, 0x52, 
+
+0xC0, 0x4C, 0xA3, 0x65, 0x0C, 0xFA, 0x43, 0xA3, 
+0x1D, 0x7A, 0x8D, 0x34, 0xCF, 0x2E, 0x73, 0xC2, 
+0xAA, 0x4B, 0xA0, 0x1B, 0xF1, 0x7A, 0x87, 0x11, 
+0x13, 0x9F, 0x43, 0xA5, 0xB1, 0x83, 0xE1, 0x9A, 
+0x69, 0xA0, 0x92, 0xCE, 0xF4, 0x0F, 0x51, 0xFB, 
+0x00, 0x6B, 0xBC, 0xC1, 0x1C, 0xEF, 0x2A, 0xEA, 
+0x5B, 0x9F, 0x29, 0x2D, 0xD2, 0xDD, 0x52, 0xF0, 
+0xD3, 0x6C, 0x1D, 0xBA, 0x66, 0xAD, 0xCF, 0x20, 
+0xBC, 0x94, 0xB5, 0xE5, 0x02, 0x10, 0xB6, 0x29, 
+0x9A, 0x62, 0x47, 0xB6, 0xFF, 0x7A, 0x9A, 0xDE, 
+0xF7, 0xC6, 0xE3, 0x8A, 0x67, 0xCE, 0x54, 0x87, 
+0x26, 0xE7, 0x23, 0x4C, 0xF6, 0x9E, 0xBC, 0x48, 
+0xA2, 0xC3, 0x88, 0xF4, 0x39, 0x85, 0xB3, 0x0F, 
+0xB6, 0x43, 0x6D, 0x2C, 0xC3, 0xAB, 0xF2, 0x54, 
+0xE7, 0x17, 0xF0, 
+
+0xD2, 0x79, 0x03, 0x9A, 0x88, 0x01, 0x9E, 0xBB, 
+0x59, 0x3F, 0xA3, 0xA9, 0x3A, 0x67, 0x8E, 0x47, 
+0xE6, 0x4B, 0xEB, 0xA9, 0x01, 0xE7, 0x32, 0x15, 
+0x2F, 0x10, 0x9B, 0x12, 0x8F, 0x97, 0x03, 0x24, 
+0x43, 0xC8, 0xFF, 0xA3, 0x96, 0x92, 0x19, 0x5C, 
+0x09, 0xEC, 0xB9, 0xB5, 0xB7, 0xD3, 0xD9, 0xEF, 
+0xBA, 0xA3, 0x7A, 0xCD, 0x79, 0x8C, 0xEE, 0xDD, 
+0x6B, 0x25, 0x66, 0xC1, 0x6A, 0xA2, 0x8E, 0x6B, 
+0xE7, 0x99, 0x1D, 0x87, 0xE2, 0xE7, 0x57, 0x77, 
+0x5D, 0x87, 0xE1, 0xB3, 0x68, 0x73, 0x08, 0x1F, 
+0xBA, 0x2D, 0x98, 0x06, 0xFB, 0x78, 0x4A, 0x65, 
+0x25, 0x9C, 0xF5, 0xD5, 0x12, 0x09, 0x7E, 0x02, 
+0x5C, 0x57, 0x80, 0xC2, 0x92, 0xD4, 0xB2, 0x7C, 
+0xC1, 0xD5, 0x16, 0x95, 0x4E, 0x90, 0xB3, 0x39, 
+0x26, 0xB5, 0x81, 0xE1, 
+
+0x17, 0xB9, 0x3B, 0x12, 0x69, 0x56, 0x88, 0x5B, 
+0xE8, 0x9B, 0x9E, 0x58, 0xB5, 0x6E, 0x21, 0x6D, 
+
+0x44, 0xE6, 0xA7, 0x66, 0x76, 0xB0, 0xF7, 0x59, 
+0x6A, 0xA3, 0x55, 0xD0, 0xA3, 0x41, 0xFA, 0xAB, 
+0x4C, 
+
+0x54, 0x19, 0x8D, 0x1B, 0xD0, 0xFA, 0x5F, 0x77, 
+0x28, 0x7B, 0x9F, 0x5B, 0xE1, 0x77, 0xA3, 0xE4, 
+0xFB, 0x34, 
+
+0x2A, 0xDB, 0xB1, 0x13, 0x92, 0xE8, 0xDF, 0x3F, 
+0xA9, 0x59, 0x7A, 0x1D, 0x9F, 0xD0, 0x6A, 0x0B, 
+0x29, 0x23, 0x06, 
+
+0xF3, 0x43, 0x2B, 0xD9, 0xCA, 0x15, 0xB7, 0x23, 
+0x29, 0xAD, 0x3C, 0x24, 0xD1, 0x43, 0xA6, 0x26, 
+0x16, 0x60, 0x00, 0x3D, 
+
+0xB1, 0xC5, 0x76, 0x54, 0x2D, 0x28, 0x6B, 0xC1, 
+0x97, 0xD8, 0x26, 0x30, 0xCE, 0xFC, 0x3E, 0x11, 
+0x7A, 0x29, 0xD8, 0x00, 0x6E, 
+
+0x7F, 0x3F, 0x4C, 0x09, 0x35, 0xD6, 0x31, 0x4A, 
+0xC7, 0x29, 0x86, 0xBE, 0x7B, 0x69, 0xD4, 0xB6, 
+0x4B, 0x4E, 0xBB, 0xC5, 0xD1, 0x1E, 
+
+0xB7, 0x4D, 0x9E, 0x47, 0x39, 0x58, 0x8A, 0xA4, 
+0x52, 0x7C, 0x8D, 0x27, 0xF9, 0x70, 0xA7, 0x56, 
+0x74, 0x5B, 0xDC, 0xD4, 0xE9, 0xC4, 0x97, 
+
+0x38, 0x0D, 0x3E, 0x4C, 0x46, 0x50, 0xAC, 0xEF, 
+0x01, 0xCE, 0x94, 0xC9, 0xEF, 0x52, 0x2C, 0x3D, 
+0x57, 0x18, 0xE2, 0xF8, 0x3E, 0xCF, 0x97, 0x81, 
+
+0x77, 0xA3, 0x2B, 0xA2, 0x40, 0xC9, 0xD7, 0xC5, 
+0x19, 0x3A, 0x08, 0x53, 0x10, 0x65, 0x9E, 0x52, 
+0x13, 0xFE, 0xB5, 0x74, 0x10, 0x2C, 0x97, 0xEC, 
+0x7C, 
+
+0x36, 0x3B, 0xF0, 0xFF, 0x0D, 0x38, 0x6F, 0x8F, 
+0xB3, 0x69, 0x10, 0x2F, 0xF2, 0xFE, 0xBF, 0x72, 
+0x6F, 0x7D, 0xA2, 0x05, 0xC2, 0xB8, 0xB5, 0x8D, 
+0x77, 0x5E, 
+
+0x4E, 0x11, 0xE3, 0xFB, 0x22, 0xFC, 0xB3, 0x4E, 
+0x7B, 0x35, 0xB1, 0xB1, 0x1B, 0x17, 0x5D, 0xDD, 
+0x3F, 0xAC, 0x32, 0xF4, 0x27, 0xFD, 0xD2, 0x14, 
+0xAF, 0xDC, 0x09, 
+
+0x4A, 0x95, 0x84, 0x4D, 0x55, 0xEA, 0xCB, 0x97, 
+0x17, 0x67, 0x11, 0xF5, 0x80, 0x31, 0xD6, 0x9B, 
+0x99, 0x2A, 0x89, 0x90, 0x33, 0x29, 0x74, 0x12, 
+0xF2, 0x63, 0xFE, 0x46, 
+
+0x17, 0xF8, 0x91, 0xD5, 0x43, 0x75, 0xD0, 0x50, 
+0x4A, 0xFC, 0xD4, 0x64, 0xB7, 0xB2, 0x46, 0x9B, 
+0x37, 0x6B, 0xA0, 0x48, 0x10, 0x49, 0x97, 0x46, 
+0x64, 0xF6, 0xFA, 0x97, 0x41, 
+
+0xBF, 0xD3, 0xB6, 0x33, 0xD3, 0x3C, 0x74, 0xD2, 
+0x7C, 0x67, 0x4D, 0xBE, 0xD6, 0xA7, 0x5A, 0xBF, 
+0x0E, 0x8E, 0x4B, 0x7E, 0x10, 0xC9, 0xBB, 0x7B, 
+0x6E, 0x8A, 0x0B, 0x6D, 0xB0, 0x65, 
+
+0x13, 0xCD, 0x2B, 0xAA, 0x94, 0x8B, 0xBE, 0xB1, 
+0xA8, 0xEB, 0xB4, 0x0A, 0x4D, 0xC0, 0xE4, 0x30, 
+0xD8, 0x2C, 0x36, 0x85, 0x4B, 0x91, 0x77, 0x93, 
+0xBD, 0x44, 0xA2, 0x5F, 0x11, 0x79, 0x2B, 
+
+0xA4, 0xBD, 0x43, 0x7A, 0x82, 0xC7, 0x4B, 0x6C, 
+0xD1, 0x15, 0x8F, 0xDC, 0x10, 0x36, 0xC6, 0xF0, 
+0x9D, 0x60, 0x47, 0x46, 0xBD, 0x3A, 0x87, 0x45, 
+0xAC, 0xDF, 0xF0, 0xAA, 0x93, 0x89, 0x45, 0x0A, 
+
+0x03, 0xA8, 0xCB, 0x5B, 0x4C, 0x5D, 0x24, 0x6B, 
+0xFC, 0x7F, 0xC1, 0xD6, 0x4A, 0x7E, 0xD3, 0x10, 
+0x84, 0x01, 0x5E, 0x63, 0xE6, 0x43, 0x9C, 0x84, 
+0xD1, 0xD9, 0x2B, 0x00, 0x50, 0x8E, 0xC7, 0xAF, 
+0x90, 
+
+0x74, 0x7D, 0x91, 0xE1, 0xE3, 0x68, 0x65, 0x4B, 
+0x26, 0xAE, 0x13, 0x84, 0xD5, 0x4E, 0xB4, 0xEC, 
+0x4B, 0xF3, 0x9A, 0x24, 0xC7, 0xB4, 0x21, 0xF6, 
+0x5A, 0xE2, 0x2B, 0x4C, 0x7D, 0x79, 0x03, 0xB7, 
+0x63, 0xFF, 
+
+0x1C, 0xC2, 0xD8, 0x03, 0xB1, 0x23, 0x28, 0xEB, 
+0xF1, 0x48, 0xAD, 0x96, 0x7D, 0x7F, 0x74, 0x6A, 
+0xAF, 0xA3, 0x93, 0xB9, 0xC4, 0x17, 0x77, 0x6E, 
+0x39, 0xF2, 0x38, 0xA1, 0x4A, 0xF8, 0xF4, 0xBD, 
+0x36, 0x68, 0xD0, 
+
+0x0E, 0xCB, 0x54, 0x66, 0x01, 0x9A, 0x37, 0x6E, 
+0x16, 0x56, 0xD8, 0x7B, 0x82, 0xA0, 0xDC, 0x7F, 
+0xD5, 0xB4, 0x3B, 0x1B, 0x5E, 0x59, 0xA6, 0xAD, 
+0x13, 0xB0, 0x08, 0x0B, 0xA3, 0x1E, 0x78, 0x2B, 
+0x27, 0xEF, 0xC2, 0xAB, 
+
+0xA0, 0xD2, 0x6A, 0x13, 0x7F, 0x89, 0x1B, 0xBF, 
+0x0D, 0x25, 0x6F, 0x1D, 0x3E, 0x3F, 0x3D, 0x32, 
+0x52, 0x2A, 0xD5, 0x24, 0x0D, 0x32, 0x88, 0x3F, 
+0x19, 0x58, 0xDD, 0x9D, 0x59, 0x5B, 0x04, 0x19, 
+0x45, 0xBB, 0x3C, 0xB6, 0x74, 
+
+0x3F, 0x11, 0xBC, 0x4D, 0x4F, 0x8F, 0x6C, 0xCD, 
+0x3F, 0x18, 0x15, 0xF3, 0x70, 0x7A, 0x63, 0xD0, 
+0x0C, 0x9E, 0x7D, 0xDE, 0xDD, 0x89, 0xB9, 0xB9, 
+0x5E, 0xC2, 0x0B, 0x4F, 0xE8, 0xC1, 0x75, 0x77, 
+0xBC, 0x7D, 0xF5, 0xBA, 0x5F, 0x47, 
+
+0xCE, 0xC0, 0x1B, 0xA7, 0x62, 0x8A, 0x2E, 0x3E, 
+0x35, 0x89, 0x22, 0x0E, 0xD2, 0x9E, 0x8F, 0x7B, 
+0x22, 0x36, 0xA0, 0x56, 0x7F, 0x8A, 0xDE, 0x05, 
+0xC7, 0x09, 0x32, 0xB1, 0xCC, 0x48, 0x58, 0x0F, 
+0xCA, 0x97, 0x83, 0x40, 0x34, 0xCE, 0x03, 
+
+0xBE, 0x5B, 0x57, 0xAE, 0xA6, 0x23, 0x65, 0xAF, 
+0x56, 0xD2, 0x92, 0x92, 0x23, 0x46, 0xC0, 0x59, 
+0x1F, 0x66, 0x9D, 0x01, 0x9C, 0x23, 0x04, 0xFB, 
+0x38, 0xBB, 0x4D, 0x34, 0x6E, 0x02, 0xBA, 0x10, 
+0x18, 0x26, 0xB3, 0xD9, 0x01, 0x34, 0x05, 0x6D, 
+
+0x60, 0x43, 0xC3, 0x2A, 0x65, 0x88, 0xEC, 0x5F, 
+0x67, 0x20, 0xC1, 0x5D, 0x0D, 0xC1, 0xF3, 0xA9, 
+0x91, 0x2A, 0xCE, 0x10, 0xB1, 0xF7, 0x05, 0xE4, 
+0x8C, 0xB1, 0xFC, 0xA8, 0x42, 0x7B, 0xD8, 0x17, 
+0x74, 0xF9, 0xDA, 0xEA, 0x4A, 0xA2, 0x18, 0xA5, 
+0xBB, 
+
+0x73, 0x3F, 0x44, 0x64, 0x02, 0x88, 0x1F, 0x2F, 
+0xD1, 0x78, 0x42, 0x24, 0x12, 0x25, 0x42, 0x3F, 
+0xC7, 0x17, 0xCB, 0x07, 0x44, 0x06, 0xE5, 0x01, 
+0xEA, 0x2F, 0xAA, 0x76, 0xDC, 0x48, 0x7B, 0x59, 
+0x3C, 0x16, 0x87, 0xFA, 0x2B, 0x15, 0xD5, 0xFD, 
+0x24, 0x2D, 
+
+0x78, 0xF6, 0xA5, 0xA2, 0xEA, 0x62, 0x5C, 0xDA, 
+0xDE, 0xE2, 0xE3, 0x5E, 0x44, 0x75, 0xBD, 0x00, 
+0xA5, 0x31, 0x9B, 0x81, 0x02, 0xC9, 0xF4, 0x3E, 
+0x86, 0x2D, 0xAF, 0xF6, 0x8F, 0x2D, 0x11, 0xEA, 
+0x23, 0xF2, 0x22, 0xE9, 0x95, 0x3E, 0xF1, 0x44, 
+0xC7, 0xEC, 0x58, 
+
+0xFB, 0xF6, 0xD9, 0x39, 0xA7, 0xA4, 0x83, 0xA5, 
+0xDD, 0xF4, 0xA1, 0xBD, 0x13, 0xDE, 0xB2, 0x18, 
+0xD2, 0x4E, 0xE6, 0xF1, 0x3B, 0xCF, 0xCA, 0xC7, 
+0xF2, 0xC5, 0x73, 0x1F, 0x46, 0x74, 0x61, 0xF4, 
+0x3A, 0xC8, 0xFE, 0xFC, 0x63, 0x19, 0xAD, 0x77, 
+0x3D, 0x80, 0x1A, 0x6B, 
+
+0x13, 0xA5, 0x58, 0xA8, 0x0E, 0x32, 0x31, 0xFE, 
+0xFD, 0xC0, 0xC1, 0x25, 0x34, 0xF9, 0x41, 0x4E, 
+0x64, 0x4D, 0x1E, 0x9E, 0xB9, 0xDE, 0x90, 0xDD, 
+0x0E, 0x06, 0xBF, 0x96, 0xEA, 0x90, 0xF0, 0xAB, 
+0xF3, 0xCD, 0x67, 0x94, 0xB2, 0xB6, 0x19, 0x69, 
+0xAB, 0x90, 0xE1, 0x81, 0xD6, 
+
+0x30, 0x44, 0x42, 0xF3, 0x50, 0xBA, 0xEA, 0x55, 
+0x00, 0x29, 0x86, 0xFC, 0x37, 0xAF, 0xB1, 0x5B, 
+0x0A, 0xF9, 0x16, 0x3A, 0xDE, 0xBC, 0x44, 0x13, 
+0xDB, 0x50, 0xA1, 0xEB, 0x8D, 0x74, 0x87, 0xDB, 
+0xB7, 0xC0, 0x53, 0xE6, 0x8D, 0x7D, 0x28, 0x70, 
+0x5F, 0x0C, 0x7D, 0x28, 0x77, 0x74, 
+
+0x63, 0x3D, 0x54, 0x9C, 0x33, 0xD5, 0x2B, 0xE4, 
+0xD9, 0x9E, 0xB2, 0xA3, 0x50, 0x8C, 0x71, 0x29, 
+0xF8, 0x2C, 0xD4, 0xD2, 0x46, 0x58, 0x57, 0x07, 
+0xFD, 0xE3, 0x3A, 0x05, 0xD0, 0x0A, 0x56, 0x13, 
+0xBA, 0x10, 0x7C, 0xA4, 0xDC, 0x9C, 0x5F, 0x85, 
+0x95, 0xE8, 0x37, 0x45, 0xE9, 0x81, 0xC0, 
+
+0x6A, 0x16, 0x38, 0x35, 0xF0, 0x63, 0x10, 0x97, 
+0x64, 0x6E, 0x2F, 0xA8, 0x52, 0xB9, 0xE2, 0x97, 
+0xEF, 0xA1, 0xC5, 0xA0, 0x42, 0x04, 0x46, 0x09, 
+0xE8, 0xFA, 0xBB, 0x35, 0xD7, 0xDF, 0x63, 0x87, 
+0x01, 0xAA, 0x08, 0xAC, 0xFB, 0xE6, 0x1E, 0x6B, 
+0x7A, 0x88, 0x38, 0x78, 0x14, 0xF9, 0x5D, 0x20, 
+
+0xFF, 0xCC, 0x18, 0xB1, 0x20, 0xC8, 0xC0, 0x71, 
+0x06, 0x1B, 0x3C, 0x42, 0x0C, 0xE3, 0xD0, 0x9F, 
+0x64, 0x2F, 0x5A, 0xA7, 0xF8, 0x9C, 0xF2, 0x93, 
+0x7E, 0x73, 0xCD, 0x0F, 0xB2, 0x8E, 0x87, 0x00, 
+0x59, 0x26, 0x58, 0x8E, 0x58, 0xA6, 0x67, 0x23, 
+0xC3, 0xA3, 0x58, 0x83, 0x39, 0xCE, 0x9C, 0x48, 
+0x0F, 
+
+0x99, 0x56, 0x1A, 0x26, 0x97, 0x9F, 0x17, 0xF9, 
+0x31, 0x8E, 0x94, 0x97, 0x41, 0xF9, 0x94, 0xA0, 
+0x8B, 0x3E, 0x4D, 0x49, 0xC9, 0x26, 0xD9, 0xDC, 
+0xAD, 0x13, 0x60, 0xDD, 0x9C, 0x73, 0xA5, 0xE5, 
+0x51, 0x93, 0x56, 0x66, 0x9F, 0xA2, 0x57, 0x93, 
+0x27, 0x61, 0x81, 0x1E, 0x10, 0x56, 0xD6, 0xCE, 
+0x19, 0x64, 
+
+0xB8, 0x29, 0x11, 0xC3, 0x2A, 0x80, 0x13, 0x70, 
+0xB8, 0x46, 0xD6, 0x4C, 0x0E, 0x15, 0x75, 0x9D, 
+0x84, 0x2C, 0x3F, 0x4E, 0xE1, 0x09, 0x6A, 0x9B, 
+0x73, 0xFF, 0x31, 0xE7, 0x7E, 0x03, 0x7A, 0x82, 
+0x6D, 0xD3, 0xC2, 0x98, 0x8A, 0xCB, 0xFE, 0xAF, 
+0xA1, 0xB5, 0x15, 0xF5, 0xC7, 0x23, 0x81, 0x46, 
+0x49, 0x8B, 0xF5, 
+
+0xC8, 0xB1, 0xC9, 0x45, 0x33, 0x1D, 0xEF, 0xCA, 
+0x00, 0x7A, 0xA3, 0x95, 0x6D, 0xDE, 0xB3, 0x9D, 
+0x57, 0xA4, 0x6C, 0x72, 0x91, 0xCD, 0x35, 0xEA, 
+0xEE, 0xF1, 0xC9, 0xEE, 0xB7, 0x71, 0xEE, 0x6A, 
+0x6A, 0xC8, 0xD9, 0x32, 0xAC, 0xC9, 0xD1, 0xE2, 
+0x04, 0xCF, 0xCD, 0x49, 0x7A, 0x9D, 0x1B, 0x61, 
+0xEA, 0x64, 0x3B, 0xB4, 
+
+0x8B, 0x7F, 0xB5, 0x47, 0x31, 0x9D, 0x45, 0x6A, 
+0xC3, 0x11, 0x67, 0xC7, 0xF3, 0x4B, 0x43, 0x5A, 
+0x10, 0x7C, 0x21, 0x79, 0xDE, 0x82, 0xB9, 0x38, 
+0x9E, 0x91, 0x00, 0x30, 0xDF, 0xE9, 0xF4, 0x97, 
+0x98, 0x32, 0x62, 0x1E, 0xDE, 0x44, 0xD4, 0x2B, 
+0xE9, 0xC2, 0xED, 0x40, 0xD8, 0xAA, 0x45, 0x0D, 
+0x31, 0xC1, 0x23, 0x30, 0x86, 
+
+0x13, 0x93, 0xBF, 0x38, 0x6D, 0xFB, 0x67, 0xB7, 
+0x0D, 0x43, 0x69, 0xC7, 0x9E, 0xFE, 0xA4, 0x57, 
+0x52, 0x19, 0x15, 0xF9, 0xA7, 0x39, 0xD1, 0x49, 
+0x2A, 0x3B, 0xC3, 0xC1, 0xC5, 0xC5, 0xD6, 0x65, 
+0xCC, 0x38, 0xFC, 0xC5, 0x4C, 0xD0, 0x60, 0xF0, 
+0x24, 0x6B, 0xBF, 0x16, 0x39, 0xE5, 0x0B, 0xF9, 
+0xFA, 0x97, 0x2F, 0xBA, 0x9D, 0x8D, 
+
+0xAB, 0xF3, 0xCE, 0xEF, 0x9C, 0x94, 0xA1, 0xBF, 
+0x30, 0xD5, 0xBA, 0x9E, 0xF0, 0xBD, 0x85, 0xD8, 
+0x62, 0x1D, 0x15, 0x77, 0xBF, 0xE5, 0xED, 0x28, 
+0xD5, 0x6B, 0xBE, 0xC5, 0x5E, 0xF3, 0x78, 0xB7, 
+0x54, 0x55, 0xF8, 0xC2, 0x06, 0xC6, 0x17, 0x1F, 
+0xEB, 0x0B, 0x1C, 0xAA, 0x0A, 0x4A, 0xA8, 0x73, 
+0x8D, 0x21, 0x8E, 0x08, 0xB3, 0x34, 0xE2, 
+
+0x3B, 0x72, 0x07, 0xAD, 0x75, 0x10, 0xBD, 0x39, 
+0x4A, 0xDA, 0x21, 0xEE, 0xF1, 0xF6, 0xD4, 0xF5, 
+0x20, 0xC3, 0x37, 0xE3, 0x83, 0xE2, 0xF9, 0xAE, 
+0x16, 0x88, 0xD5, 0xD6, 0x80, 0x31, 0x4F, 0x88, 
+0x48, 0xAF, 0xA6, 0xCE, 0xE4, 0x67, 0xFA, 0x58, 
+0xC9, 0x46, 0x42, 0x28, 0x89, 0xE1, 0x97, 0x6C, 
+0xB8, 0x10, 0xE7, 0x30, 0x04, 0xFC, 0x08, 0xF5, 
+
+0x1A, 0xCD, 0xA9, 0x16, 0xEE, 0xA4, 0x53, 0x3C, 
+0xF8, 0x76, 0x66, 0x4B, 0xD3, 0x97, 0x4D, 0xD2, 
+0x41, 0x65, 0x37, 0x04, 0xEA, 0x8E, 0x2F, 0x7F, 
+0x72, 0x26, 0xE5, 0xA6, 0x65, 0x13, 0x69, 0x48, 
+0xCB, 0xA0, 0x9E, 0x25, 0xC8, 0x57, 0xA6, 0x0E, 
+0xB3, 0x5C, 0x52, 0x14, 0x30, 0x86, 0xA7, 0x67, 
+0x3C, 0x85, 0xD7, 0x89, 0x46, 0x2C, 0xE6, 0xB2, 
+0x43, 
+
+0xA4, 0x98, 0xB7, 0x2D, 0x65, 0x11, 0x3B, 0x75, 
+0xC7, 0x67, 0x16, 0xDF, 0x34, 0x2D, 0x03, 0x9F, 
+0x8D, 0x8B, 0xE8, 0x17, 0x13, 0x9F, 0xAA, 0x8F, 
+0x08, 0x87, 0xA2, 0xB8, 0x57, 0xBA, 0x60, 0x41, 
+0x29, 0x1C, 0x06, 0x40, 0x36, 0x5E, 0xC3, 0x4C, 
+0x01, 0x0D, 0xC6, 0x23, 0x32, 0x3D, 0xED, 0x49, 
+0x8D, 0xED, 0xDF, 0x70, 0xA2, 0x73, 0x3B, 0xEA, 
+0x83, 0x16, 
+
+0xDD, 0x65, 0x77, 0x29, 0xAF, 0x2E, 0x14, 0xD7, 
+0x9B, 0xDA, 0xB3, 0x4D, 0x34, 0xA6, 0x42, 0x63, 
+0x4E, 0x86, 0xD3, 0x02, 0xCF, 0x29, 0x87, 0x95, 
+0xEC, 0x4F, 0x2C, 0xF7, 0x08, 0xC0, 0x78, 0xE3, 
+0x22, 0x96, 0xF2, 0xBE, 0x9D, 0x33, 0x70, 0x0F, 
+0xB9, 0x80, 0x0B, 0x55, 0x10, 0xAF, 0x27, 0x5D, 
+0x88, 0x1F, 0x52, 0xD6, 0x80, 0x85, 0xB2, 0xBC, 
+0x52, 0xCA, 0x59, 
+
+0x7F, 0x3F, 0x78, 0x00, 0x6E, 0x67, 0xD9, 0x91, 
+0x46, 0x1D, 0x5C, 0xC9, 0x5B, 0xF5, 0xF1, 0xF0, 
+0x8C, 0x85, 0x60, 0x1B, 0x8B, 0x30, 0xFE, 0xFC, 
+0x6E, 0x90, 0xBA, 0xBC, 0xF0, 0x66, 0xE8, 0x5C, 
+0x87, 0x86, 0x2B, 0xC1, 0xE2, 0x3D, 0x8C, 0x91, 
+0x47, 0xC2, 0xA0, 0xDC, 0xB9, 0xA6, 0x1B, 0x70, 
+0x8A, 0x1E, 0xB7, 0x7E, 0x73, 0x12, 0xB1, 0xDF, 
+0xE3, 0xA4, 0xCB, 0x58, 
+
+0x6E, 0x2D, 0xEB, 0xD7, 0xA9, 0x1B, 0xE3, 0xDD, 
+0x1A, 0xBA, 0x8F, 0xAD, 0x4A, 0xE7, 0x69, 0x54, 
+0x57, 0x3A, 0xA3, 0x9C, 0x0C, 0xD4, 0x61, 0x60, 
+0x2E, 0x55, 0x1F, 0x44, 0x5E, 0x25, 0x34, 0x94, 
+0xA9, 0xA6, 0x5D, 0xA9, 0x5C, 0x67, 0x02, 0x39, 
+0xA3, 0x5B, 0x85, 0x22, 0xA4, 0x07, 0x23, 0x37, 
+0x58, 0x67, 0x8D, 0x71, 0xCF, 0x84, 0xEF, 0xE5, 
+0x14, 0x6B, 0x48, 0x3A, 0x9A, 
+
+0x2C, 0xC4, 0xDD, 0xBA, 0x59, 0x73, 0x33, 0xE7, 
+0xBB, 0x3A, 0x4F, 0x59, 0x74, 0x11, 0xAB, 0x78, 
+0x26, 0xA5, 0x2F, 0x55, 0xF7, 0x21, 0x66, 0xE9, 
+0x5C, 0x7A, 0x5C, 0x01, 0xDD, 0x23, 0xBD, 0xE3, 
+0xA1, 0xFF, 0x0B, 0x04, 0x79, 0x5B, 0xC0, 0x95, 
+0x12, 0x99, 0xA0, 0xB4, 0x70, 0xAD, 0x49, 0xB4, 
+0xB5, 0x07, 0x2F, 0xCE, 0xB4, 0x9D, 0x02, 0xCA, 
+0x65, 0x96, 0x75, 0x88, 0xB6, 0x69, 
+
+0xD8, 0x59, 0x8C, 0x71, 0x2D, 0x70, 0x13, 0x98, 
+0xC9, 0x84, 0x4F, 0x5D, 0x01, 0x27, 0x39, 0xC1, 
+0x09, 0x75, 0x61, 0x78, 0xDB, 0xDD, 0x47, 0x0A, 
+0x63, 0xDB, 0xAA, 0x7D, 0x83, 0xA2, 0xC1, 0x80, 
+0x09, 0xAC, 0x32, 0x34, 0xC3, 0x9D, 0x13, 0x02, 
+0xC7, 0xEC, 0x9A, 0x6E, 0x2E, 0x2D, 0x70, 0xE1, 
+0xFC, 0x17, 0x62, 0x16, 0x9E, 0xCE, 0xA6, 0x9D, 
+0xEA, 0xAA, 0x1B, 0xCD, 0x20, 0xC7, 0xB4, 
+
+0xB1, 0xF5, 0x84, 0x31, 0x5B, 0xFE, 0x9D, 0x7F, 
+0x72, 0x08, 0xD9, 0xE5, 0x4B, 0xB6, 0xD0, 0x7A, 
+0x2E, 0x86, 0x50, 0xDD, 0x49, 0x06, 0x6C, 0x4D, 
+0xF1, 0x14, 0x6F, 0x43, 0x97, 0x3B, 0x93, 0xB3, 
+0x46, 0x1F, 0x71, 0x3E, 0x45, 0x55, 0x7C, 0xC7, 
+0xBC, 0x7F, 0x68, 0x97, 0xF7, 0x56, 0x13, 0xD8, 
+0x51, 0xA1, 0xF9, 0xDA, 0xED, 0x4A, 0xA3, 0x72, 
+0xEF, 0x43, 0x31, 0x43, 0xEB, 0xEB, 0x70, 0x28, 
+
+0xB0, 0xB9, 0xDB, 0x13, 0xD1, 0xEC, 0x75, 0xC4, 
+0x17, 0xA1, 0x10, 0x96, 0xD1, 0x7F, 0x85, 0xB5, 
+0xD4, 0x1B, 0x79, 0x48, 0x80, 0x00, 0x78, 0x6D, 
+0xFA, 0xAB, 0xA9, 0x2B, 0xD2, 0xFC, 0xF5, 0xF2, 
+0x6D, 0xEF, 0xAF, 0x5A, 0x7E, 0xD6, 0x40, 0xA1, 
+0xFA, 0x99, 0xD4, 0x3F, 0xCA, 0x42, 0xEC, 0x2E, 
+0xF4, 0x11, 0x7B, 0xF4, 0x7B, 0x4B, 0x80, 0x8C, 
+0xFE, 0x8A, 0xF3, 0xBC, 0xFE, 0xDC, 0x61, 0x6D, 
+0x63, 
+
+0xA7, 0xCD, 0xA1, 0xCA, 0x68, 0xF8, 0xDF, 0xC9, 
+0x5A, 0x67, 0x67, 0x9A, 0x44, 0xAE, 0xB0, 0x53, 
+0x8B, 0x14, 0xD0, 0x71, 0x88, 0xB2, 0x21, 0xCA, 
+0x4C, 0xE3, 0x31, 0x30, 0xD4, 0x1D, 0x6A, 0x68, 
+0xD2, 0x21, 0xB6, 0xF0, 0x05, 0x9F, 0x73, 0x1D, 
+0x94, 0x29, 0xAC, 0x3D, 0xED, 0x93, 0xE0, 0x55, 
+0xE0, 0xC1, 0x2B, 0x39, 0x68, 0x71, 0xBF, 0x49, 
+0xCF, 0x7E, 0x5B, 0x5B, 0xE7, 0x3F, 0xA3, 0xCA, 
+0xA1, 0xE8, 
+
+0x2D, 0x08, 0xAE, 0x77, 0x71, 0x49, 0x56, 0xA5, 
+0x1E, 0x8E, 0xE1, 0x7F, 0x6F, 0xDC, 0x98, 0x69, 
+0x88, 0xF6, 0xBE, 0x07, 0xCF, 0x61, 0xEE, 0xBC, 
+0xA3, 0x0A, 0xE8, 0xB6, 0x38, 0x81, 0x83, 0xDE, 
+0x31, 0xF3, 0xA1, 0xE2, 0x3F, 0x58, 0x98, 0x0A, 
+0x02, 0xA3, 0x79, 0xAD, 0x96, 0xD8, 0xC7, 0xE8, 
+0xEF, 0xA8, 0x83, 0x27, 0xFE, 0x1F, 0xD7, 0xBC, 
+0x6F, 0xAE, 0x6D, 0x9B, 0x76, 0xD0, 0xBA, 0xB4, 
+0xBC, 0x4A, 0xA3, 
+
+0x3C, 0x2A, 0xC4, 0x3D, 0x83, 0xFB, 0x11, 0x11, 
+0x1E, 0xC3, 0x0A, 0x8C, 0x51, 0x9C, 0xA6, 0x36, 
+0x36, 0x36, 0x41, 0x68, 0x87, 0x7E, 0x15, 0x07, 
+0x8F, 0xF2, 0x56, 0x05, 0x7F, 0x24, 0x26, 0x03, 
+0xF9, 0x30, 0x88, 0x58, 0x81, 0x46, 0xF8, 0x50, 
+0x55, 0x3F, 0x22, 0x6B, 0x98, 0x2A, 0xBC, 0x18, 
+0xD0, 0xC9, 0x18, 0xFC, 0x82, 0x6A, 0xB4, 0x10, 
+0xE5, 0xC1, 0xD6, 0x4D, 0xCF, 0x5E, 0xCB, 0x54, 
+0xDE, 0xED, 0x31, 0x92, 
+
+0x00, 0x62, 0xE6, 0x3D, 0x93, 0x8F, 0x09, 0x0E, 
+0x76, 0x93, 0x43, 0xE1, 0x5B, 0x72, 0xA0, 0xB7, 
+0x17, 0x70, 0xE3, 0x99, 0x0D, 0x60, 0x35, 0x27, 
+0xC0, 0x28, 0xBE, 0x3A, 0xBB, 0xC7, 0xA4, 0x75, 
+0x30, 0x9D, 0xA3, 0x1D, 0x25, 0x5C, 0x41, 0xEC, 
+0x5A, 0x32, 0x3A, 0x11, 0x28, 0x04, 0x54, 0x2B, 
+0xB0, 0xCD, 0xEB, 0xC4, 0x76, 0xA5, 0x8F, 0x7A, 
+0x03, 0x1A, 0x9C, 0xB5, 0xE0, 0xDF, 0x02, 0x81, 
+0x9F, 0xE3, 0xAB, 0x66, 0x39, 
+
+0xEF, 0x24, 0xAA, 0x59, 0xBB, 0xFE, 0xED, 0x64, 
+0xFF, 0xC7, 0x4E, 0x31, 0xDA, 0xC0, 0x2C, 0xA1, 
+0xE4, 0xAA, 0x24, 0x6A, 0x14, 0xB9, 0x66, 0x1C, 
+0x5A, 0xB5, 0xD9, 0x0B, 0xFF, 0xC0, 0x04, 0x16, 
+0x2A, 0xA3, 0xC1, 0xD9, 0x85, 0xCB, 0x5C, 0x79, 
+0xEF, 0x45, 0xF8, 0x61, 0x4B, 0xA3, 0x41, 0xDB, 
+0xC2, 0x21, 0x70, 0xAF, 0xEE, 0x6E, 0x19, 0x59, 
+0x6F, 0x97, 0xA0, 0xC4, 0xE7, 0x2F, 0x40, 0xC3, 
+0x00, 0xD4, 0xF8, 0x64, 0x59, 0x8D, 
+
+0xF8, 0x8F, 0x16, 0xAC, 0x3A, 0x8A, 0xD0, 0x8F, 
+0x97, 0xD3, 0x36, 0x94, 0xCA, 0xD8, 0x97, 0xF7, 
+0x8D, 0x97, 0x14, 0xA2, 0x56, 0x5C, 0x85, 0x3B, 
+0x1C, 0xC5, 0xF3, 0x05, 0x16, 0x1D, 0xFF, 0x0E, 
+0x0A, 0x2B, 0x0F, 0xB3, 0x82, 0x79, 0x9B, 0x83, 
+0x9A, 0xE0, 0x65, 0xF2, 0x32, 0x81, 0x57, 0x4D, 
+0xE3, 0xA0, 0x9E, 0x1F, 0x31, 0x38, 0xD3, 0x1B, 
+0xA9, 0x6E, 0x2B, 0xAE, 0xA6, 0x2B, 0x51, 0x45, 
+0x52, 0xEB, 0xDA, 0x39, 0x68, 0xAD, 0x8C, 
+
+0x31, 0x17, 0xFF, 0x40, 0xB4, 0xC1, 0x42, 0x64, 
+0x6E, 0xED, 0xDE, 0xE2, 0xF1, 0x72, 0xAB, 0xD3, 
+0x68, 0x55, 0x75, 0x70, 0x4C, 0x31, 0x02, 0x7E, 
+0x78, 0x09, 0xEA, 0xD2, 0xDC, 0xAB, 0x13, 0x7D, 
+0xAC, 0x07, 0x3D, 0x5A, 0x64, 0xE6, 0x7A, 0x75, 
+0x2D, 0x6B, 0xB3, 0x0D, 0xF4, 0x05, 0x68, 0x39, 
+0x56, 0xD7, 0xFB, 0xE2, 0x7F, 0x71, 0xDB, 0xD0, 
+0x5B, 0x0E, 0x88, 0x74, 0x13, 0x26, 0x34, 0xB2, 
+0xE6, 0x96, 0x0C, 0x7C, 0xAB, 0x46, 0xA8, 0x3B, 
+
+0x6A, 0xA0, 0xA2, 0xA0, 0x36, 0x87, 0x81, 0x3C, 
+0xBA, 0x45, 0xCE, 0xE5, 0xDD, 0x9B, 0xA6, 0xA0, 
+0xED, 0x85, 0x7D, 0x6E, 0xFC, 0x7A, 0x69, 0xB7, 
+0xAF, 0xE2, 0x7C, 0x61, 0xCE, 0xE8, 0x4E, 0xBA, 
+0xFC, 0x59, 0x4D, 0x53, 0x41, 0x3F, 0x01, 0x69, 
+0x88, 0xE7, 0x69, 0xFB, 0x22, 0x7E, 0x9D, 0xF2, 
+0xFD, 0x13, 0xC5, 0x45, 0x73, 0x24, 0x0B, 0x30, 
+0x1E, 0xEA, 0xC6, 0xD3, 0x77, 0x86, 0x00, 0x18, 
+0x2F, 0xB6, 0x56, 0x7A, 0xAD, 0x46, 0x7D, 0xE0, 
+0x45, 
+
+0xDE, 0x2D, 0x31, 0x30, 0x49, 0x8C, 0xDF, 0x14, 
+0xF7, 0xED, 0x22, 0x4A, 0xBE, 0xC9, 0xFE, 0x2D, 
+0xD9, 0xA0, 0xF9, 0xE1, 0x4D, 0x67, 0x09, 0x67, 
+0xC3, 0x64, 0xA7, 0x5A, 0x5C, 0xBE, 0x00, 0x4D, 
+0xAA, 0x40, 0x1A, 0x4A, 0x2B, 0x7E, 0xF3, 0x8E, 
+0xE6, 0x15, 0x0A, 0x17, 0x6D, 0x5C, 0x76, 0x26, 
+0xD1, 0xD1, 0xF1, 0x8F, 0xC3, 0x1D, 0x37, 0xF3, 
+0xA4, 0xB4, 0x23, 0xE8, 0x8B, 0x66, 0xAE, 0x50, 
+0x56, 0xA2, 0xB7, 0x6C, 0x7D, 0x01, 0xBC, 0xCB, 
+0x4D, 0xA8, 
+
+0x16, 0x1B, 0xC9, 0x06, 0x07, 0x28, 0x68, 0xA7, 
+0xDA, 0xE3, 0xE7, 0xBB, 0x24, 0x8A, 0x1E, 0xD0, 
+0xDD, 0x85, 0x9A, 0x31, 0x44, 0x86, 0x77, 0x36, 
+0x4D, 0xE8, 0x48, 0x59, 0x01, 0x0C, 0x0A, 0xE4, 
+0x0E, 0x1A, 0x0F, 0xAB, 0x5D, 0x74, 0x93, 0x92, 
+0x64, 0x4A, 0xAB, 0xC5, 0xE3, 0xEA, 0x1F, 0xC3, 
+0xB7, 0xD1, 0x45, 0x09, 0x7B, 0x45, 0xDB, 0x94, 
+0xA8, 0x39, 0x70, 0xA2, 0x4A, 0x44, 0x98, 0x37, 
+0xA1, 0x71, 0x10, 0x52, 0xFF, 0x37, 0x35, 0x62, 
+0x39, 0x14, 0x72, 
+
+0xF1, 0x91, 0x5B, 0x64, 0x88, 0x3A, 0x1F, 0x44, 
+0xA8, 0xE1, 0x81, 0xD1, 0x40, 0x29, 0x05, 0xB2, 
+0x41, 0x02, 0xFA, 0xC6, 0x45, 0x22, 0x05, 0x00, 
+0x87, 0x3E, 0xC5, 0xF5, 0x1C, 0xCC, 0x3D, 0x38, 
+0xD5, 0x55, 0x09, 0x90, 0x02, 0xC6, 0x1A, 0xFE, 
+0xE0, 0x86, 0x0E, 0xA8, 0xB9, 0x68, 0xC8, 0x77, 
+0x9B, 0xC5, 0x43, 0x82, 0x95, 0x7E, 0x53, 0x40, 
+0x25, 0x0D, 0x12, 0xBD, 0x3F, 0x82, 0x90, 0x64, 
+0x3D, 0x74, 0x7C, 0x52, 0x0E, 0x70, 0x69, 0xB5, 
+0x1A, 0x33, 0xB1, 0x61, 
+
+0x76, 0x4D, 0xAE, 0xDA, 0x03, 0x85, 0xB6, 0x8E, 
+0x1D, 0x29, 0xA2, 0xE0, 0x7F, 0x17, 0x18, 0xF3, 
+0xE9, 0x13, 0x02, 0xE0, 0x51, 0x8C, 0x5E, 0x55, 
+0x17, 0xBB, 0x53, 0x54, 0x37, 0xAE, 0x3A, 0x01, 
+0xB9, 0x70, 0xC1, 0x67, 0xC3, 0xA6, 0x14, 0x57, 
+0x08, 0x49, 0x14, 0xB1, 0x18, 0xC0, 0x10, 0x3F, 
+0x7A, 0x91, 0x61, 0xEC, 0x4B, 0x52, 0x7A, 0xB6, 
+0x81, 0xBA, 0x76, 0xDC, 0x1F, 0x8B, 0x54, 0x72, 
+0x3F, 0x12, 0x98, 0xB3, 0x73, 0x6F, 0xD4, 0x46, 
+0x82, 0x4E, 0x85, 0xD4, 0x98, 
+
+0xDE, 0xCC, 0xCD, 0x5E, 0x59, 0xC1, 0xF9, 0x9E, 
+0xFB, 0xE5, 0xEC, 0x71, 0x79, 0x3F, 0x98, 0x17, 
+0xF1, 0x0A, 0xED, 0xF1, 0x07, 0x2C, 0x0C, 0x19, 
+0xBE, 0x92, 0x30, 0x66, 0x74, 0xFF, 0xE4, 0x9D, 
+0x16, 0xD7, 0xF1, 0x6D, 0x55, 0xF2, 0x77, 0x1E, 
+0x75, 0x91, 0x55, 0xBB, 0x36, 0xE8, 0x75, 0x97, 
+0x35, 0x1E, 0xAC, 0x74, 0x57, 0x1E, 0x2E, 0xC6, 
+0xA2, 0xC3, 0xEF, 0x29, 0x09, 0x92, 0x14, 0x77, 
+0x2B, 0x1C, 0x9A, 0x76, 0xBC, 0xE3, 0xE9, 0xF5, 
+0x18, 0xB7, 0x95, 0xEB, 0x62, 0x25, 
+
+0x91, 0x3F, 0xB0, 0xF8, 0xF1, 0x65, 0x27, 0xD2, 
+0xB3, 0x95, 0xCE, 0x5F, 0xB0, 0x3C, 0x92, 0x81, 
+0x80, 0x4B, 0x4E, 0x40, 0x23, 0x3E, 0xE1, 0xE9, 
+0x07, 0xB1, 0x3E, 0x41, 0xB7, 0xCF, 0xCC, 0xB3, 
+0x60, 0x79, 0x92, 0xD2, 0x28, 0xC1, 0x34, 0x05, 
+0x77, 0x42, 0x72, 0x27, 0xDD, 0x5B, 0x79, 0x97, 
+0xD8, 0xA4, 0xA2, 0x44, 0x8E, 0x56, 0xD2, 0xD6, 
+0x13, 0xEA, 0xA3, 0x3B, 0xDE, 0xA6, 0x82, 0x2E, 
+0xBF, 0x9B, 0x6B, 0x71, 0x77, 0x74, 0xD3, 0xEC, 
+0xC3, 0xC7, 0x8D, 0x2C, 0x27, 0x2B, 0x7D, 
+
+0xF1, 0x77, 0xC5, 0xD7, 0x53, 0xB9, 0x20, 0xF7, 
+0x7B, 0x8B, 0x8A, 0x95, 0xF6, 0xBF, 0x58, 0xCB, 
+0xEF, 0x87, 0x73, 0xD9, 0xAF, 0xF0, 0x13, 0xC2, 
+0xDD, 0x6C, 0x27, 0xD9, 0x7D, 0x18, 0x13, 0x59, 
+0x25, 0xD6, 0x2C, 0x60, 0x3E, 0x3F, 0x6E, 0x51, 
+0xFC, 0xE6, 0x14, 0xC0, 0x05, 0x3B, 0xE0, 0x39, 
+0x98, 0x83, 0xD3, 0x0D, 0x61, 0x2B, 0xAC, 0x0F, 
+0x09, 0x7D, 0x12, 0x43, 0x7F, 0xC0, 0x8E, 0x72, 
+0xEB, 0x86, 0x98, 0x9F, 0xFE, 0xBD, 0x93, 0x5B, 
+0xB0, 0xB7, 0xD3, 0x15, 0x92, 0xFF, 0x4A, 0x9E, 
+
+0x77, 0x9A, 0xBB, 0x84, 0x77, 0xAB, 0x11, 0xE9, 
+0x95, 0xFE, 0xBE, 0x92, 0x2D, 0x67, 0x2C, 0x95, 
+0xFD, 0xD5, 0x7E, 0x34, 0x4D, 0xED, 0x95, 0x6B, 
+0xF5, 0x69, 0xE6, 0x5E, 0xCF, 0xF0, 0x12, 0x37, 
+0x29, 0x91, 0x2A, 0xFF, 0x9E, 0xDF, 0xA6, 0x1F, 
+0xE7, 0xA7, 0x47, 0x01, 0x34, 0x47, 0x63, 0x31, 
+0xE2, 0xDF, 0x28, 0xA5, 0xAA, 0xF8, 0x22, 0x9D, 
+0x68, 0xD2, 0x27, 0x1A, 0xED, 0x3A, 0x0E, 0xAF, 
+0x9C, 0x05, 0x4B, 0x31, 0x9F, 0xFD, 0xC5, 0x00, 
+0x44, 0xA5, 0x44, 0x13, 0xA7, 0x34, 0x2D, 0xFA, 
+0x3A, 
+
+0x7B, 0xB1, 0x5D, 0x43, 0xCC, 0x5A, 0x7E, 0xB4, 
+0x9C, 0x2C, 0x97, 0xA2, 0xF5, 0x48, 0x05, 0x07, 
+0x35, 0xBB, 0xA6, 0xE0, 0xFF, 0x82, 0x26, 0x5A, 
+0x7D, 0x6A, 0xFA, 0xAE, 0x63, 0x52, 0x95, 0x1E, 
+0x85, 0x4B, 0x14, 0x21, 0xC8, 0xCB, 0xB8, 0xB8, 
+0x3C, 0x4F, 0x08, 0x01, 0xE4, 0x13, 0x4E, 0x9A, 
+0x2A, 0x55, 0x29, 0x56, 0xB3, 0x59, 0x6F, 0x72, 
+0x03, 0xAC, 0x1B, 0x9B, 0xDD, 0xAA, 0x8A, 0x44, 
+0x4C, 0xF4, 0x13, 0x4B, 0x61, 0x4C, 0x04, 0x00, 
+0x05, 0xA5, 0x2B, 0x16, 0xF8, 0x77, 0x1D, 0x7C, 
+0x92, 0x1D, 
+
+0xFA, 0xB9, 0xB9, 0x80, 0x2C, 0x94, 0x4D, 0xE5, 
+0x2C, 0x92, 0xB9, 0xBB, 0x7B, 0x6A, 0xF1, 0x42, 
+0x20, 0x3B, 0xE5, 0x48, 0xF5, 0xE6, 0x88, 0x04, 
+0x1C, 0x70, 0x81, 0x04, 0x98, 0xC9, 0x23, 0x8C, 
+0x24, 0xD9, 0xF6, 0x9A, 0xD5, 0xEF, 0xC9, 0x16, 
+0x42, 0xE7, 0x40, 0xCF, 0x46, 0xA9, 0x6B, 0xCC, 
+0x1A, 0x08, 0x80, 0xDE, 0x7E, 0xDE, 0x1C, 0xD2, 
+0xDA, 0xE5, 0xDC, 0x1B, 0x3F, 0xDD, 0x43, 0xFC, 
+0x64, 0xCF, 0x2A, 0x1C, 0xBC, 0x96, 0xF2, 0xD2, 
+0x1E, 0xBB, 0x85, 0x36, 0x6E, 0xD5, 0x50, 0x7E, 
+0xBF, 0x3D, 0x13, 
+
+0xFA, 0x3E, 0x75, 0x7A, 0x23, 0x74, 0x90, 0x08, 
+0xAB, 0x3A, 0xEE, 0xAC, 0x2F, 0xA4, 0x61, 0x13, 
+0x6A, 0x83, 0xF1, 0x5B, 0x73, 0x4F, 0x2F, 0xD4, 
+0x80, 0xDF, 0x5A, 0x45, 0x26, 0x4D, 0x83, 0x5B, 
+0x80, 0x3A, 0xD6, 0x04, 0x12, 0xCD, 0xCF, 0xD0, 
+0xFF, 0x8C, 0xA4, 0xCB, 0x93, 0xCB, 0xBB, 0x6B, 
+0x82, 0xB4, 0x43, 0xB2, 0x5A, 0xB9, 0x53, 0xEB, 
+0x1E, 0x81, 0x5E, 0x20, 0xB3, 0x64, 0x7D, 0xDC, 
+0x17, 0xD6, 0xC6, 0x1A, 0x6D, 0x7C, 0x4A, 0x35, 
+0x9C, 0xCC, 0xB8, 0xAC, 0x93, 0xD2, 0xAF, 0xA8, 
+0x9F, 0xB6, 0x35, 0xCD, 
+
+0x04, 0xFC, 0x1C, 0x60, 0x44, 0x16, 0x33, 0xB8, 
+0xCB, 0xAC, 0x9F, 0xC8, 0xE0, 0x78, 0xE1, 0xF2, 
+0x99, 0xCD, 0x59, 0x4C, 0x6F, 0x0F, 0xA8, 0xFD, 
+0x56, 0x2D, 0xE1, 0x50, 0x9E, 0x93, 0x01, 0xF1, 
+0x71, 0xF2, 0xC3, 0xE7, 0x37, 0x29, 0x8A, 0x65, 
+0x78, 0x6F, 0x47, 0x23, 0xE8, 0x3C, 0x64, 0x68, 
+0x38, 0x3C, 0x42, 0x29, 0x7E, 0x45, 0x4C, 0xCD, 
+0x35, 0xF5, 0x82, 0x1F, 0xD0, 0xCA, 0x43, 0x19, 
+0x71, 0x19, 0xA9, 0xB9, 0x78, 0x5D, 0xF5, 0x01, 
+0xF7, 0xDB, 0x7C, 0x5B, 0xB6, 0x78, 0xB6, 0x87, 
+0x53, 0x1B, 0xCD, 0x4C, 0x5B, 
+
+0xC1, 0x4B, 0xDE, 0x90, 0xC3, 0xA3, 0x1B, 0x72, 
+0x7A, 0x3B, 0x89, 0x49, 0xAA, 0x15, 0xEE, 0x32, 
+0x8B, 0x76, 0x74, 0xA7, 0x59, 0x0B, 0x1F, 0xD0, 
+0x65, 0xD8, 0x81, 0x2D, 0x2D, 0xD3, 0x1D, 0x3B, 
+0x51, 0x4B, 0xE3, 0x57, 0xA8, 0x84, 0x42, 0x56, 
+0x7A, 0xF2, 0x41, 0x00, 0xC4, 0x11, 0xF2, 0xBD, 
+0x84, 0x15, 0xE0, 0x58, 0x35, 0xB6, 0x51, 0xE6, 
+0x10, 0x5F, 0x87, 0x62, 0x91, 0x90, 0x48, 0x5F, 
+0xCE, 0x26, 0xB8, 0x0C, 0x87, 0xA4, 0xCF, 0xD0, 
+0x43, 0xC1, 0x10, 0x3D, 0xDF, 0x95, 0xF3, 0xCC, 
+0x9C, 0x32, 0x0F, 0xAC, 0x67, 0x9A, 
+
+0x67, 0x95, 0x9C, 0xD7, 0xD9, 0x46, 0xFD, 0xEE, 
+0x79, 0xA9, 0x66, 0x44, 0x4A, 0x4D, 0x91, 0x1D, 
+0x74, 0xE2, 0xB1, 0xB3, 0x70, 0x19, 0xFC, 0x45, 
+0x35, 0xED, 0x84, 0xDE, 0xDE, 0x8A, 0x4C, 0xF0, 
+0x99, 0x23, 0xE5, 0xB6, 0xB4, 0x90, 0xEF, 0xBC, 
+0xFA, 0x98, 0xCD, 0x4A, 0x7B, 0xCF, 0x43, 0x50, 
+0x60, 0x79, 0x7F, 0x13, 0x43, 0x9B, 0xFE, 0xB1, 
+0xB5, 0xE4, 0x08, 0xFF, 0xC6, 0x5D, 0x33, 0x0E, 
+0x1B, 0x61, 0xC1, 0xDA, 0xA2, 0xB8, 0x89, 0xF3, 
+0x3A, 0x79, 0xFC, 0xCE, 0xE5, 0x65, 0x5E, 0x24, 
+0x4E, 0x7B, 0xE7, 0x60, 0x3B, 0xBC, 0xF5, 
+
+0x96, 0x19, 0xA6, 0x8A, 0x1E, 0x95, 0x82, 0x0F, 
+0x26, 0xCD, 0xEF, 0xDF, 0x14, 0x65, 0x26, 0xBC, 
+0xE0, 0x07, 0x02, 0x5F, 0xB7, 0xFF, 0xEA, 0xB5, 
+0x0B, 0xBF, 0xE7, 0xD4, 0xE8, 0xDB, 0xE7, 0xE2, 
+0xBB, 0x64, 0xD6, 0xB1, 0x54, 0xBA, 0x5C, 0x3D, 
+0x7A, 0x6F, 0xA1, 0xDC, 0x06, 0xB6, 0xFB, 0x88, 
+0xD6, 0xD9, 0x28, 0xBF, 0x9A, 0xC7, 0x67, 0x73, 
+0xBB, 0xE0, 0x38, 0x4B, 0xE5, 0x0B, 0xDF, 0x13, 
+0xBE, 0xDB, 0x78, 0x7D, 0xB4, 0x28, 0x48, 0x52, 
+0xF8, 0xFA, 0x6E, 0xCE, 0x8E, 0x2E, 0xF6, 0xA3, 
+0x92, 0x5A, 0xA1, 0x34, 0xB2, 0x17, 0xD9, 0x26, 
+
+0x2E, 0xC9, 0x6C, 0xCF, 0xAC, 0xB4, 0xF4, 0x2D, 
+0x67, 0x38, 0xB2, 0x44, 0x03, 0xBB, 0x4B, 0xCE, 
+0x3E, 0xAB, 0x94, 0xA5, 0x0A, 0xD8, 0xC3, 0x47, 
+0xE7, 0xBD, 0x9A, 0x49, 0x74, 0xDF, 0x60, 0x70, 
+0xBA, 0x40, 0x4B, 0x8F, 0xFB, 0xF4, 0x84, 0x38, 
+0xDA, 0x48, 0x1F, 0x0F, 0x8A, 0xA9, 0x81, 0x42, 
+0xFA, 0xFF, 0xA1, 0xEB, 0x39, 0x4E, 0xBE, 0x14, 
+0xD5, 0xF0, 0x53, 0xD4, 0x37, 0x9C, 0xE5, 0xF0, 
+0x76, 0x9E, 0x8B, 0x68, 0xAB, 0x61, 0xD6, 0x3D, 
+0x53, 0xE4, 0xDE, 0x23, 0xB3, 0xF2, 0xC1, 0x7B, 
+0xF7, 0x34, 0x41, 0xBD, 0xCC, 0xED, 0x79, 0x94, 
+0xF0, 
+
+0x1B, 0xC9, 0x8D, 0xF8, 0x2F, 0xE7, 0x65, 0xE1, 
+0x5B, 0x43, 0x1C, 0xD1, 0x31, 0xE2, 0xBB, 0x0E, 
+0x2D, 0x96, 0x77, 0x76, 0x36, 0xA4, 0x56, 0xD7, 
+0x9E, 0x38, 0xB6, 0x21, 0x17, 0x1C, 0x4E, 0xD0, 
+0x8D, 0x4E, 0xFB, 0xB2, 0xC2, 0x4B, 0x62, 0xC6, 
+0xCA, 0xE3, 0x1C, 0xAE, 0x21, 0xB6, 0x5C, 0xC2, 
+0x9C, 0x8A, 0x2A, 0x2D, 0xEA, 0xC7, 0x23, 0x9B, 
+0x81, 0xF2, 0x27, 0x96, 0x2D, 0xA3, 0x59, 0xC9, 
+0xC7, 0x08, 0xB3, 0xF4, 0x44, 0x24, 0xDC, 0xF1, 
+0x5D, 0xC3, 0x30, 0x77, 0x49, 0x5A, 0x78, 0xD2, 
+0x87, 0x51, 0x6C, 0xA3, 0xDD, 0x08, 0x9D, 0x03, 
+0xCA, 0x8D, 
+
+0x85, 0x7F, 0x04, 0xE6, 0x72, 0xF9, 0x8B, 0xEB, 
+0x8D, 0x74, 0x28, 0x9B, 0xB9, 0xA8, 0xC7, 0xE5, 
+0x36, 0xDC, 0xEA, 0xFE, 0xE3, 0x50, 0x59, 0xAF, 
+0xAE, 0x41, 0x9C, 0xE2, 0x63, 0x37, 0x68, 0x9A, 
+0xDB, 0x7B, 0xEC, 0x6C, 0x62, 0xD9, 0x56, 0x25, 
+0x35, 0x0E, 0xF5, 0x18, 0xB5, 0xD1, 0x54, 0x1A, 
+0xE3, 0xA3, 0x87, 0x23, 0x49, 0x95, 0xFC, 0xAE, 
+0x43, 0xF1, 0xA0, 0x57, 0x3D, 0xA4, 0xE5, 0xEB, 
+0xB6, 0xED, 0xB8, 0x3F, 0x83, 0xD1, 0x2C, 0x99, 
+0xE1, 0x3F, 0x3A, 0x4A, 0x5D, 0x6C, 0xB3, 0x2D, 
+0x8D, 0x59, 0x76, 0xD4, 0x41, 0x9E, 0xE1, 0xD4, 
+0x79, 0x02, 0x9B, 
+
+0x39, 0x2B, 0x8A, 0xE9, 0x3C, 0xBF, 0xE6, 0xAC, 
+0x92, 0x0B, 0x62, 0x76, 0x65, 0x54, 0x33, 0x84, 
+0x98, 0x93, 0x2D, 0x70, 0xA4, 0x8F, 0x2D, 0x8D, 
+0x8E, 0xD9, 0xCB, 0x27, 0xA5, 0xDE, 0xF2, 0x11, 
+0xE4, 0xAC, 0x20, 0x9F, 0x5F, 0xD0, 0x49, 0x1E, 
+0x6B, 0x3B, 0xEB, 0x90, 0x46, 0xB2, 0xAF, 0x36, 
+0x11, 0x36, 0x87, 0x27, 0x7B, 0x22, 0x9A, 0x34, 
+0x35, 0x49, 0x38, 0xE8, 0xF7, 0x2C, 0x35, 0x21, 
+0x4F, 0x40, 0xDC, 0x7D, 0xFD, 0x23, 0xFC, 0xC3, 
+0xAA, 0x20, 0x49, 0xDB, 0x47, 0x39, 0x4A, 0xD3, 
+0x10, 0x64, 0xC1, 0xFB, 0x11, 0x5F, 0x09, 0x18, 
+0xBB, 0xA0, 0x80, 0x6F, 
+
+0xD7, 0xDA, 0x1F, 0xE8, 0x2A, 0x9D, 0x03, 0x3A, 
+0x17, 0xB9, 0x07, 0xBF, 0xC1, 0x12, 0xB5, 0xB0, 
+0x2C, 0x17, 0x14, 0x36, 0x3A, 0x9C, 0xE9, 0x50, 
+0x86, 0x3F, 0xEF, 0xE8, 0xB0, 0xAE, 0x7E, 0xBC, 
+0x1F, 0x4D, 0xAD, 0xED, 0x87, 0xA8, 0xD9, 0x66, 
+0x4D, 0x00, 0x97, 0x94, 0xDE, 0xB3, 0xAC, 0x31, 
+0x07, 0x98, 0x25, 0xF0, 0xBC, 0xA9, 0x1D, 0x14, 
+0xCE, 0x8F, 0x36, 0xD0, 0xE7, 0x57, 0x45, 0xB9, 
+0xE1, 0x04, 0xB4, 0x94, 0x96, 0xF6, 0xC9, 0xE7, 
+0xB8, 0x82, 0xBE, 0x43, 0x5F, 0x68, 0xE5, 0x7F, 
+0x69, 0x3D, 0xE5, 0x33, 0x33, 0x79, 0x8C, 0xF7, 
+0x8B, 0x2F, 0x24, 0x0E, 0x39, 
+
+0x58, 0x1F, 0x00, 0xF4, 0x84, 0x61, 0x88, 0x0D, 
+0x58, 0x39, 0x1A, 0x47, 0x76, 0x0B, 0x44, 0x17, 
+0x9F, 0x8C, 0x4B, 0xA4, 0x87, 0x08, 0x2F, 0xED, 
+0xC3, 0x55, 0xEB, 0x0A, 0x6E, 0x73, 0x2F, 0xD6, 
+0x17, 0x6D, 0x00, 0x9C, 0xDE, 0x26, 0x42, 0x19, 
+0xDA, 0x88, 0xF4, 0x3B, 0x59, 0x7D, 0x9D, 0x96, 
+0xEB, 0x80, 0xC9, 0x46, 0xA3, 0x31, 0x6A, 0x10, 
+0x1B, 0xEB, 0xDD, 0x6E, 0x98, 0x62, 0x50, 0x4D, 
+0x2F, 0x1B, 0xBA, 0xB9, 0x8A, 0xB3, 0x92, 0xD4, 
+0xD9, 0x65, 0x57, 0xA3, 0x9B, 0xE8, 0x1B, 0x24, 
+0x28, 0x1B, 0xF3, 0xBF, 0x65, 0x39, 0xBA, 0xD2, 
+0x6F, 0xED, 0xF9, 0x1E, 0x01, 0xE9, 
+
+0xAD, 0xAC, 0xDA, 0x21, 0xFF, 0x53, 0xF8, 0x1B, 
+0x85, 0x8F, 0x17, 0xCD, 0xE9, 0x8B, 0xCF, 0x03, 
+0xE1, 0xF0, 0xB0, 0x55, 0xE0, 0xA4, 0x24, 0x2A, 
+0xD2, 0x8F, 0xAB, 0x6F, 0xE4, 0x09, 0x77, 0x78, 
+0xB1, 0xF1, 0xB6, 0xE4, 0x7F, 0x36, 0xF3, 0xDF, 
+0xD5, 0x7C, 0xDB, 0x48, 0xC2, 0xD8, 0x7F, 0x69, 
+0x6D, 0x05, 0x72, 0x58, 0x59, 0x6E, 0xBF, 0x79, 
+0xA1, 0x6A, 0xA0, 0x9F, 0x2D, 0xEF, 0x9F, 0x20, 
+0x97, 0xD6, 0x17, 0x27, 0x80, 0x43, 0xBD, 0x1A, 
+0x85, 0xC6, 0x59, 0x2B, 0x69, 0x32, 0xBC, 0xAD, 
+0xF6, 0xCF, 0xE7, 0x9B, 0x6D, 0xD4, 0x96, 0x91, 
+0x44, 0x79, 0xA2, 0xDA, 0x5B, 0x4F, 0x80, 
+
+0x1C, 0xE7, 0xBF, 0xAE, 0x5E, 0x53, 0x04, 0xAE, 
+0x99, 0x41, 0x11, 0x22, 0x30, 0x96, 0xE2, 0x20, 
+0x19, 0x97, 0xA9, 0x0F, 0xFF, 0xB0, 0xB3, 0xFA, 
+0x77, 0x8A, 0xD4, 0xA0, 0x86, 0x9A, 0xFF, 0xCC, 
+0xC9, 0x54, 0xAB, 0x43, 0x99, 0xAC, 0xED, 0x39, 
+0x1C, 0x66, 0xC7, 0xC5, 0xB6, 0x3E, 0xE3, 0xD9, 
+0x76, 0x67, 0x8B, 0x48, 0x28, 0x98, 0x9A, 0x20, 
+0xF4, 0x8A, 0x66, 0x21, 0x73, 0xDC, 0x7B, 0xA5, 
+0xB1, 0x2E, 0xFE, 0x34, 0xA4, 0x52, 0x70, 0x66, 
+0x8F, 0xE2, 0xDE, 0x00, 0x43, 0x58, 0x3C, 0xB6, 
+0x87, 0x04, 0xEC, 0xDD, 0x96, 0xFB, 0x58, 0x94, 
+0x09, 0x3F, 0x75, 0x31, 0xE2, 0x69, 0xEA, 0x98, 
+
+0x41, 0x81, 0x6E, 0x7F, 0xD1, 0x30, 0xEA, 0xA5, 
+0x76, 0xE8, 0xD3, 0x06, 0xD4, 0xE8, 0x8E, 0x61, 
+0x6A, 0x66, 0xA6, 0x7C, 0x58, 0x36, 0x90, 0x95, 
+0x14, 0x54, 0x47, 0x12, 0x58, 0xA4, 0x99, 0xA5, 
+0x44, 0xD6, 0xDA, 0x22, 0x18, 0x79, 0xB7, 0xA6, 
+0x14, 0xC7, 0x2D, 0xE8, 0xBF, 0x07, 0x19, 0xF6, 
+0x7D, 0xA3, 0xBF, 0xB4, 0xE8, 0xF5, 0xEB, 0x18, 
+0x8B, 0x36, 0xC0, 0x36, 0x9B, 0x11, 0x24, 0x2A, 
+0x2E, 0xB1, 0x44, 0x75, 0x77, 0x39, 0xDB, 0x28, 
+0xB8, 0x71, 0x0A, 0x34, 0x64, 0x1B, 0x1C, 0xCD, 
+0x2B, 0x96, 0x05, 0x82, 0x3B, 0x80, 0xCC, 0x26, 
+0x9E, 0xE0, 0x11, 0xC5, 0x72, 0x1D, 0x51, 0xA3, 
+0xF0, 
+
+0x83, 0x2C, 0xFD, 0x99, 0xAB, 0x97, 0xA7, 0x86, 
+0xB6, 0xB2, 0x60, 0x45, 0xD5, 0xE4, 0xE2, 0x9F, 
+0x5B, 0x66, 0x87, 0xBD, 0x35, 0x6F, 0xD1, 0x12, 
+0xF9, 0xF8, 0x86, 0xA7, 0x56, 0xC1, 0x1E, 0xCE, 
+0x43, 0x30, 0x7A, 0xA0, 0xE9, 0x49, 0xE4, 0xC1, 
+0x36, 0x32, 0x90, 0x7A, 0xA5, 0x3E, 0x3D, 0x85, 
+0x62, 0x89, 0xD8, 0xD0, 0x55, 0xB4, 0x41, 0x53, 
+0xA7, 0x2C, 0x45, 0x08, 0xDA, 0x19, 0xCF, 0xC4, 
+0x55, 0x2E, 0x37, 0xB3, 0x25, 0xFE, 0xB0, 0xFC, 
+0xD3, 0x61, 0x84, 0x1F, 0xC8, 0xCA, 0x96, 0x7A, 
+0x7F, 0x3A, 0x5B, 0x71, 0x8F, 0xD9, 0xD5, 0x4A, 
+0xF6, 0x68, 0xED, 0x91, 0xE8, 0x5F, 0x96, 0xEF, 
+0xEB, 0xBE, 
+
+0xB6, 0x9E, 0x6D, 0xE9, 0x6E, 0xAD, 0xBB, 0xDC, 
+0x17, 0xA5, 0x97, 0x2D, 0xB2, 0xEE, 0xC7, 0xA8, 
+0x12, 0xEB, 0x05, 0xDC, 0xCF, 0x3A, 0xB5, 0x72, 
+0x7E, 0x28, 0x81, 0xBB, 0xAB, 0x36, 0xDE, 0x07, 
+0x45, 0xD7, 0x86, 0x3C, 0x43, 0x34, 0x75, 0x7B, 
+0xA6, 0xC1, 0x0D, 0x79, 0xC2, 0x61, 0x2E, 0x18, 
+0xFA, 0x92, 0x39, 0xC3, 0x9C, 0xF4, 0x7E, 0xA2, 
+0x70, 0x30, 0x4C, 0x85, 0xA0, 0xE0, 0x89, 0x23, 
+0x4C, 0xBB, 0x1D, 0x3E, 0xB3, 0xBA, 0x64, 0x7D, 
+0x76, 0xFD, 0xEE, 0x88, 0x9C, 0xCE, 0x42, 0x55, 
+0xC2, 0xD4, 0x88, 0x67, 0xEA, 0x31, 0xAA, 0xBD, 
+0x30, 0x03, 0xF5, 0x17, 0x7F, 0xA9, 0x69, 0x0D, 
+0x6E, 0xE4, 0x3F, 
+
+0x74, 0xF5, 0x71, 0x32, 0x8C, 0x3D, 0xD2, 0x19, 
+0x7E, 0xFB, 0xA4, 0xA5, 0x13, 0x67, 0xC7, 0xD6, 
+0xDA, 0x0C, 0x03, 0x4F, 0x83, 0xA6, 0x6D, 0xEB, 
+0x09, 0x87, 0x50, 0x1E, 0xBB, 0x31, 0xF5, 0x26, 
+0x18, 0x73, 0x32, 0x4E, 0x82, 0x64, 0xDD, 0xBF, 
+0x3D, 0x3B, 0xEB, 0xB4, 0x26, 0x92, 0x0A, 0x3A, 
+0x1D, 0x72, 0x54, 0x85, 0x31, 0x22, 0x19, 0x55, 
+0xAC, 0x26, 0x20, 0x09, 0x33, 0x1F, 0xA3, 0x55, 
+0xBE, 0xA0, 0xE8, 0x37, 0x66, 0xE7, 0x3B, 0xAD, 
+0x1F, 0x6C, 0xDE, 0x52, 0x0C, 0xF4, 0x65, 0xE2, 
+0xD0, 0xE0, 0xB2, 0xC5, 0xB2, 0x3E, 0x52, 0x3D, 
+0x2F, 0xBE, 0x79, 0xA2, 0x2E, 0xB0, 0x70, 0x95, 
+0x8A, 0xA4, 0x21, 0xA5, 
+
+0x38, 0xEC, 0xB9, 0xC6, 0x23, 0xB5, 0x14, 0xFC, 
+0x47, 0x21, 0x38, 0x87, 0xC8, 0x7A, 0x3A, 0x72, 
+0xB5, 0x64, 0x6F, 0x0B, 0x96, 0x2D, 0x8B, 0xE1, 
+0x78, 0x4D, 0x80, 0xE4, 0x3F, 0x38, 0xF4, 0xC2, 
+0xEB, 0x76, 0xBC, 0xEF, 0x68, 0xF7, 0x34, 0xE5, 
+0xAB, 0xDF, 0x61, 0x1E, 0x7A, 0x83, 0xDC, 0xD8, 
+0xA8, 0xA6, 0xA8, 0xA2, 0xA6, 0xC2, 0x36, 0x95, 
+0x7E, 0x01, 0xB7, 0xF4, 0x34, 0x24, 0x9F, 0x54, 
+0x3A, 0xC4, 0xCB, 0xB5, 0xBB, 0x2D, 0x87, 0xE8, 
+0xCC, 0x9D, 0x41, 0xFA, 0xFC, 0x39, 0x1D, 0x74, 
+0x2B, 0xCE, 0xDD, 0x82, 0xE1, 0xBE, 0x87, 0xEC, 
+0xF1, 0xE4, 0xEF, 0x57, 0x25, 0x0B, 0x08, 0xC9, 
+0x40, 0x73, 0xC4, 0x7E, 0xDD, 
+
+0x2F, 0xB0, 0xB7, 0x50, 0x10, 0x69, 0xD9, 0xE6, 
+0xC5, 0xB2, 0xCC, 0x63, 0xF1, 0xAB, 0x2E, 0x5C, 
+0xE3, 0xC3, 0x69, 0xD7, 0xE2, 0x3B, 0xE4, 0xBC, 
+0x6C, 0x60, 0xCB, 0xAF, 0x12, 0x76, 0x69, 0xF4, 
+0x92, 0x1F, 0x89, 0x84, 0x07, 0x95, 0x22, 0x91, 
+0x4C, 0x97, 0xF1, 0xF4, 0x35, 0x7E, 0x8B, 0xB2, 
+0x9D, 0xBF, 0x51, 0xFB, 0x51, 0xCB, 0xFF, 0x18, 
+0x4B, 0xC0, 0xB9, 0x55, 0xB0, 0x32, 0x7A, 0xA3, 
+0x6C, 0xFE, 0xA3, 0xC7, 0x97, 0x29, 0xCC, 0xD4, 
+0xAA, 0x0B, 0xAE, 0x5E, 0x03, 0xA6, 0x2B, 0xA5, 
+0x53, 0x06, 0xC2, 0xF5, 0xD7, 0x60, 0xA6, 0xE3, 
+0x7E, 0xD4, 0x93, 0xAD, 0x7E, 0xAD, 0x43, 0x39, 
+0x8E, 0x5B, 0x82, 0x43, 0xD9, 0x16, 
+
+0x4A, 0xE8, 0xD0, 0xF1, 0x38, 0x45, 0x1C, 0x57, 
+0x41, 0xDD, 0xBA, 0xFC, 0xDB, 0x49, 0xE0, 0x4F, 
+0x64, 0xEF, 0x53, 0x03, 0x44, 0x43, 0x3B, 0x4B, 
+0xE4, 0x1A, 0x59, 0xE3, 0x7B, 0xD9, 0xE1, 0x2D, 
+0x44, 0x44, 0xBA, 0x58, 0x40, 0x4D, 0xC8, 0x07, 
+0xC7, 0x69, 0xAC, 0x1B, 0x97, 0x58, 0x58, 0xBA, 
+0xAD, 0x70, 0xAB, 0x82, 0xCA, 0xFB, 0x5C, 0x0D, 
+0x90, 0x18, 0x35, 0x77, 0xDB, 0x03, 0x5D, 0x7E, 
+0x10, 0xC9, 0x46, 0x49, 0x50, 0xA7, 0xD6, 0xEC, 
+0x30, 0xE9, 0x52, 0x2E, 0x34, 0x6E, 0x43, 0x97, 
+0x3C, 0xB2, 0x35, 0xB4, 0xFC, 0x0A, 0x06, 0xFF, 
+0x2F, 0xE2, 0x48, 0xC9, 0xD3, 0x05, 0x37, 0x6C, 
+0xDD, 0x6A, 0x47, 0x83, 0xA4, 0xC9, 0x7F, 
+
+0xF6, 0x76, 0x35, 0x6D, 0xD0, 0x88, 0x1D, 0xE9, 
+0xF0, 0xA1, 0x76, 0x88, 0xAB, 0xE2, 0xBF, 0x7B, 
+0x2D, 0x22, 0xD9, 0x9F, 0xCE, 0xE1, 0x1F, 0xB9, 
+0x88, 0xCB, 0x91, 0x1C, 0x53, 0xAD, 0x65, 0x9F, 
+0x0D, 0x98, 0x57, 0xDC, 0x6A, 0x59, 0xC4, 0x98, 
+0x93, 0xFE, 0x8C, 0xCA, 0x93, 0x74, 0xE5, 0x02, 
+0x30, 0xFC, 0x05, 0x6F, 0xA9, 0x57, 0xFB, 0x04, 
+0x68, 0x9E, 0xE4, 0x18, 0xA6, 0x58, 0xAF, 0x48, 
+0x8D, 0x8A, 0xC8, 0x23, 0xBE, 0xD4, 0x90, 0x7D, 
+0x32, 0x38, 0xE4, 0x2A, 0x03, 0x00, 0x77, 0x4A, 
+0x38, 0x5A, 0xB2, 0x02, 0xA3, 0x0D, 0x5E, 0x7A, 
+0xF8, 0x71, 0xCD, 0xB9, 0x89, 0xB6, 0x45, 0xEB, 
+0x16, 0x7C, 0x25, 0xDE, 0xD6, 0xE8, 0xFD, 0x09, 
+
+0xB4, 0x22, 0x46, 0xDB, 0x68, 0x04, 0x53, 0x50, 
+0x17, 0xF4, 0x54, 0x3D, 0x6E, 0x52, 0x9A, 0xC4, 
+0x25, 0x8B, 0xD7, 0x44, 0xFA, 0x3C, 0x44, 0x8F, 
+0xEB, 0xAC, 0xE3, 0xE8, 0xB7, 0xFE, 0x43, 0xB2, 
+0xBA, 0xA4, 0xD0, 0x31, 0xFE, 0x92, 0x58, 0x04, 
+0x8B, 0x0C, 0xF7, 0x2C, 0x38, 0x34, 0xF2, 0x0F, 
+0xAE, 0xBC, 0x51, 0x1E, 0x27, 0x86, 0x76, 0x05, 
+0x09, 0x79, 0x70, 0x5E, 0xBA, 0xF8, 0x83, 0x4E, 
+0x16, 0x37, 0x95, 0x81, 0x29, 0xCB, 0x25, 0x73, 
+0xED, 0x9A, 0xAA, 0xFB, 0x8B, 0x8C, 0xB9, 0x2B, 
+0x8A, 0xE8, 0xDE, 0x9D, 0x65, 0xB3, 0xEF, 0x21, 
+0xA9, 0xBD, 0x0C, 0x06, 0x54, 0x27, 0x2C, 0x30, 
+0x95, 0x4E, 0x44, 0x97, 0xB4, 0x5A, 0xBD, 0x50, 
+0x8B, 
+
+0x1D, 0xF9, 0xB7, 0x6A, 0xB4, 0x97, 0xA4, 0x2E, 
+0xF7, 0xA6, 0x3C, 0x4C, 0x3B, 0x74, 0x77, 0x2B, 
+0x4E, 0xFA, 0xA6, 0xFD, 0xE8, 0x14, 0xEF, 0x08, 
+0xA7, 0x2A, 0x54, 0x5F, 0x46, 0xA3, 0x82, 0x2C, 
+0x04, 0xF8, 0x30, 0x48, 0x54, 0xA8, 0x77, 0xB6, 
+0x3A, 0x5C, 0x23, 0x3D, 0xA2, 0x55, 0xFE, 0xFD, 
+0x2A, 0x7D, 0x8F, 0xA7, 0x91, 0x54, 0x5D, 0x82, 
+0xF1, 0x20, 0x8A, 0x3F, 0x2F, 0xC7, 0x6E, 0x4F, 
+0x4D, 0xE1, 0x41, 0x81, 0x56, 0x75, 0x6A, 0x9E, 
+0xB5, 0x42, 0x03, 0xC9, 0x0A, 0x7D, 0x9C, 0x6D, 
+0x10, 0x73, 0x57, 0x18, 0x56, 0x50, 0x90, 0x59, 
+0xE0, 0x3B, 0x17, 0xE9, 0x40, 0xF0, 0x62, 0x75, 
+0xEE, 0x8E, 0x5D, 0x5B, 0xC8, 0x54, 0x2C, 0xFD, 
+0x2C, 0x06, 
+
+0xAB, 0x08, 0xF9, 0x5B, 0x37, 0xF2, 0x09, 0xBC, 
+0x27, 0x13, 0xB6, 0xEC, 0x44, 0xD7, 0x80, 0x14, 
+0x6A, 0x6D, 0x8A, 0xCD, 0xB4, 0xE8, 0x05, 0xAE, 
+0xA3, 0xE5, 0x2E, 0x4C, 0x5C, 0x4E, 0x9E, 0x4A, 
+0xFE, 0xA7, 0xDD, 0xF4, 0xD5, 0xDE, 0x50, 0xDA, 
+0xEC, 0x45, 0x28, 0xD7, 0x14, 0x1F, 0xDE, 0x91, 
+0x30, 0x7D, 0x3B, 0xD0, 0x4D, 0x84, 0x4E, 0x6B, 
+0xDB, 0x4B, 0x63, 0x60, 0x2A, 0xB7, 0x24, 0xF7, 
+0xEF, 0x90, 0x4C, 0xBA, 0x6F, 0x60, 0x3A, 0x66, 
+0x55, 0x05, 0x0D, 0x96, 0xDC, 0x43, 0x9E, 0xB2, 
+0x0E, 0x02, 0x17, 0x54, 0x0B, 0x8F, 0x5B, 0x65, 
+0x00, 0xDE, 0xA5, 0x13, 0x45, 0x31, 0x47, 0x8E, 
+0x03, 0x88, 0x85, 0x27, 0xFA, 0xFA, 0xC4, 0x58, 
+0x1E, 0xE9, 0x58, 
+
+0x85, 0x20, 0xE5, 0x16, 0x0E, 0x65, 0x8C, 0x74, 
+0x8B, 0xDA, 0x34, 0x6F, 0xA2, 0xBF, 0x34, 0xFA, 
+0xBD, 0xA8, 0xC6, 0xA7, 0x0B, 0x30, 0x09, 0xF5, 
+0x48, 0x22, 0xD9, 0xB9, 0x47, 0x44, 0xBD, 0xE0, 
+0x3E, 0x24, 0x3D, 0x7A, 0xB6, 0x3B, 0xBE, 0x5C, 
+0x2C, 0x33, 0x62, 0xDA, 0x91, 0x38, 0x95, 0x67, 
+0x03, 0x50, 0xD3, 0x1E, 0xA2, 0x9C, 0xAE, 0xFF, 
+0xE0, 0xB6, 0x7A, 0x25, 0x1C, 0x4D, 0x63, 0xF8, 
+0x60, 0x7B, 0xFE, 0x7B, 0xD8, 0x22, 0x8B, 0xD9, 
+0x82, 0x4B, 0x79, 0x66, 0xE2, 0xA8, 0x53, 0x6A, 
+0xDC, 0x31, 0x48, 0x84, 0x7D, 0x25, 0x72, 0x53, 
+0xD0, 0xC8, 0x30, 0xB3, 0x6F, 0x8E, 0x01, 0xE0, 
+0x86, 0x2B, 0xF3, 0xB3, 0x4F, 0x53, 0xA9, 0xA6, 
+0x06, 0x15, 0x35, 0xDB, 
+
+0x21, 0xAA, 0xFD, 0x3D, 0x8B, 0x6D, 0x82, 0x1D, 
+0xC3, 0x15, 0xED, 0x69, 0x90, 0x48, 0x61, 0x57, 
+0xB5, 0x4C, 0xB2, 0xAF, 0x63, 0x9F, 0xF5, 0x02, 
+0x64, 0x90, 0x2B, 0xA6, 0x77, 0x17, 0xA5, 0x50, 
+0xA5, 0xC9, 0x9B, 0x18, 0x00, 0xD3, 0x2D, 0xC0, 
+0x47, 0x98, 0xCE, 0x85, 0x19, 0xA8, 0xDC, 0x3C, 
+0xC2, 0x3D, 0x16, 0xC3, 0x84, 0x5E, 0xF1, 0x27, 
+0xC4, 0xBB, 0x5B, 0xD8, 0x9F, 0x3F, 0x5C, 0xC2, 
+0xDD, 0x40, 0xBD, 0x23, 0xC4, 0xF5, 0x82, 0xB5, 
+0x78, 0xAB, 0xD3, 0xEA, 0x98, 0xDF, 0xB3, 0xA4, 
+0xEF, 0x84, 0x6F, 0x65, 0x97, 0x7E, 0x23, 0x6D, 
+0x24, 0x4F, 0x2A, 0x72, 0xFE, 0x39, 0x7A, 0x21, 
+0x6A, 0xCF, 0xC8, 0xE3, 0xDD, 0x52, 0xF4, 0x7B, 
+0x5E, 0x04, 0x7F, 0xF7, 0x4F, 
+
+0x70, 0xC6, 0xDA, 0xFA, 0xD1, 0x0D, 0x88, 0x62, 
+0x3F, 0x56, 0x08, 0x27, 0x93, 0xD5, 0x1B, 0xCB, 
+0x00, 0x59, 0x73, 0x15, 0xDF, 0xD9, 0xE1, 0x47, 
+0x4C, 0x2A, 0x48, 0x45, 0xCE, 0x70, 0x33, 0x32, 
+0xD9, 0x53, 0x10, 0x85, 0xC5, 0x4E, 0xD6, 0x85, 
+0x19, 0x92, 0xCE, 0x45, 0x49, 0x11, 0x39, 0x28, 
+0xE7, 0xBD, 0xA1, 0x30, 0xF4, 0xB5, 0x97, 0x37, 
+0x25, 0xCA, 0x48, 0x09, 0x62, 0xAB, 0xBC, 0x9D, 
+0x5C, 0x2E, 0xA9, 0xC0, 0xE6, 0xF6, 0x3D, 0xF1, 
+0x0A, 0xB3, 0xA8, 0x87, 0x85, 0x02, 0x33, 0x08, 
+0x11, 0x0D, 0xBC, 0x29, 0xE1, 0xE5, 0xB7, 0x91, 
+0x6F, 0xC8, 0x3D, 0xF2, 0x70, 0x2E, 0xB8, 0xE4, 
+0x62, 0x4A, 0xE5, 0x1E, 0x8D, 0x73, 0x9A, 0x4D, 
+0x90, 0xDA, 0xDE, 0xD2, 0x21, 0xB3, 
+
+0x59, 0x13, 0xAB, 0x22, 0x1F, 0x7E, 0x6C, 0xA3, 
+0x0C, 0x1D, 0xBB, 0x4B, 0x39, 0x28, 0xBE, 0x0C, 
+0x5D, 0x7C, 0xFD, 0xFE, 0x36, 0xC7, 0x77, 0x63, 
+0x94, 0x8A, 0x0C, 0x65, 0x07, 0x38, 0x4A, 0xBB, 
+0x8B, 0x09, 0x8F, 0x6F, 0xFC, 0xB7, 0x6E, 0x93, 
+0x2B, 0x0A, 0x4D, 0x97, 0x73, 0x5F, 0x04, 0x15, 
+0x12, 0x1A, 0x33, 0x22, 0x02, 0x48, 0xE6, 0x90, 
+0x7A, 0xFB, 0x4F, 0x22, 0xB0, 0x6F, 0xC3, 0x94, 
+0xF6, 0x2D, 0xF7, 0x56, 0xEE, 0x7F, 0xE7, 0x7E, 
+0xF9, 0xB2, 0xE8, 0x60, 0xB2, 0xA6, 0x77, 0x4A, 
+0xE8, 0x80, 0x07, 0xC9, 0xC5, 0xE1, 0xD2, 0x89, 
+0xFC, 0x5F, 0x8D, 0xC8, 0xFF, 0xD2, 0xFD, 0xA4, 
+0x50, 0xF5, 0x5D, 0x57, 0xAF, 0xB1, 0xBC, 0xAD, 
+0x77, 0xA6, 0xF5, 0x29, 0xED, 0x9B, 0x50, 
+
+0xBE, 0x2F, 0x4D, 0xDA, 0x45, 0xD2, 0x4D, 0x7F, 
+0x94, 0x37, 0xE1, 0xD6, 0x52, 0x48, 0xC9, 0xE8, 
+0x54, 0xB4, 0x08, 0xFA, 0x5F, 0xB5, 0x0D, 0xDB, 
+0xA2, 0x9A, 0xAA, 0x09, 0xE2, 0xA1, 0xFF, 0x9E, 
+0x8E, 0xFA, 0x3E, 0x95, 0x82, 0x58, 0xB1, 0x83, 
+0xF5, 0x26, 0x4B, 0xE6, 0x36, 0xA6, 0xC2, 0xF4, 
+0xBF, 0xE0, 0xBB, 0x49, 0x42, 0xAF, 0xDC, 0xBC, 
+0xFC, 0xE4, 0x42, 0xEB, 0xCF, 0xA4, 0x92, 0x1C, 
+0xA2, 0x19, 0xF7, 0xBE, 0x05, 0xD2, 0x98, 0x27, 
+0x22, 0x9D, 0x0C, 0xFA, 0x6D, 0x43, 0xE6, 0x13, 
+0xD0, 0x63, 0x1B, 0x00, 0xF2, 0xA7, 0x29, 0xE0, 
+0x98, 0x2A, 0xF3, 0xE9, 0x1F, 0x52, 0x04, 0x73, 
+0xFF, 0x3C, 0x48, 0x8A, 0x49, 0x7B, 0xFD, 0xBF, 
+0xDF, 0xDE, 0x07, 0xF9, 0x70, 0x3A, 0xEF, 0xA5, 
+
+0x65, 0xAE, 0x1F, 0x54, 0x00, 0x97, 0xEB, 0x36, 
+0xF8, 0xD1, 0xD4, 0xE3, 0x91, 0x5C, 0x36, 0x8B, 
+0xA1, 0x25, 0xBF, 0xAD, 0x04, 0xF6, 0x4C, 0xD4, 
+0x87, 0x14, 0x96, 0x55, 0xAF, 0xB1, 0x80, 0x7F, 
+0x09, 0x7C, 0x4A, 0xC2, 0x10, 0x65, 0x36, 0xB1, 
+0x85, 0xC1, 0x41, 0x00, 0x18, 0x12, 0xEB, 0xFF, 
+0xB5, 0xC0, 0xF3, 0x10, 0xAE, 0x3C, 0x47, 0x33, 
+0x7A, 0x5A, 0x74, 0xCE, 0x38, 0xBF, 0x1F, 0xEE, 
+0x79, 0x0A, 0x1C, 0x92, 0x95, 0x6B, 0x59, 0xE7, 
+0xB9, 0x96, 0x87, 0x6D, 0x31, 0xE4, 0xB7, 0x3A, 
+0xD4, 0xD8, 0xF1, 0x8C, 0x56, 0xD2, 0xE8, 0x60, 
+0xCF, 0x82, 0xA0, 0x0A, 0x96, 0x62, 0x8F, 0x47, 
+0x6E, 0xEF, 0x3F, 0x79, 0x75, 0x8B, 0x02, 0x9B, 
+0x61, 0x65, 0xC5, 0xF8, 0x96, 0xF0, 0x89, 0xE0, 
+0x6B, 
+
+0xA9, 0x18, 0x4E, 0x98, 0x4E, 0x8F, 0x7D, 0x12, 
+0xEA, 0x9E, 0xBD, 0xF9, 0x83, 0xC1, 0xD0, 0xCA, 
+0x8F, 0xF2, 0xB0, 0x04, 0xF4, 0x08, 0x12, 0x69, 
+0x9F, 0x0C, 0xBD, 0xA5, 0xEB, 0x0A, 0x82, 0x7D, 
+0x7E, 0x6D, 0xFC, 0x43, 0xF1, 0x2A, 0x2E, 0x83, 
+0x39, 0x3E, 0x44, 0xD9, 0xC1, 0xDE, 0xD4, 0x33, 
+0xA8, 0x89, 0x04, 0x58, 0x58, 0x74, 0x1A, 0xD2, 
+0x81, 0x05, 0xD6, 0x97, 0x1F, 0xA9, 0xFA, 0x4B, 
+0xD7, 0xA8, 0xB0, 0x56, 0x05, 0x51, 0xEA, 0xEF, 
+0x9E, 0xF5, 0x04, 0x04, 0xA6, 0x40, 0x50, 0x80, 
+0x38, 0x5F, 0x01, 0x86, 0xE9, 0xFC, 0x68, 0x5B, 
+0x89, 0x05, 0x88, 0x34, 0x18, 0x1D, 0xE3, 0x28, 
+0x65, 0x83, 0x0C, 0xE6, 0x9E, 0xBC, 0xB3, 0x69, 
+0xDF, 0xAC, 0xDA, 0xFB, 0x50, 0xDF, 0x4B, 0x95, 
+0x19, 0x77, 
+
+0xBE, 0xFC, 0x84, 0x3B, 0xB5, 0x59, 0x41, 0xA2, 
+0xCA, 0xF9, 0x4D, 0x1D, 0xC7, 0x32, 0xFC, 0x9C, 
+0x33, 0x81, 0xA5, 0xF1, 0xF9, 0x06, 0xD7, 0x92, 
+0xDD, 0xDF, 0xE3, 0xB0, 0x1E, 0x92, 0x25, 0xF0, 
+0xEC, 0x94, 0x06, 0x43, 0xDC, 0xC3, 0xB7, 0x2E, 
+0x4D, 0x00, 0xBC, 0xED, 0x90, 0xAC, 0x4C, 0x99, 
+0x0E, 0x1B, 0x74, 0x2E, 0x34, 0xE3, 0x44, 0x4B, 
+0x7E, 0xD5, 0xA8, 0x7F, 0x92, 0x59, 0x8E, 0x8E, 
+0x39, 0x95, 0xF8, 0x32, 0xAA, 0x43, 0xD0, 0x9B, 
+0xCE, 0x3D, 0x73, 0xC8, 0x23, 0x13, 0x52, 0xBA, 
+0x06, 0x7A, 0xA3, 0xE8, 0x92, 0xBD, 0x21, 0x44, 
+0x18, 0xB8, 0xA3, 0x20, 0xA3, 0xC0, 0x57, 0x75, 
+0xE0, 0x29, 0x66, 0x7B, 0x71, 0x7F, 0x2B, 0xF5, 
+0x7C, 0x0B, 0xCB, 0x5D, 0x85, 0x27, 0xE0, 0x72, 
+0x43, 0x9C, 0x24, 
+
+0x82, 0xAB, 0x78, 0x01, 0x5B, 0xA3, 0x36, 0xD7, 
+0xF1, 0x7A, 0x51, 0xB2, 0xDA, 0xF9, 0x19, 0x61, 
+0x42, 0x5C, 0xB0, 0xEE, 0x25, 0x87, 0x06, 0x13, 
+0xC4, 0x1F, 0x0D, 0x4F, 0x64, 0x28, 0xAD, 0x16, 
+0x6C, 0xA4, 0x13, 0x6D, 0x2E, 0x15, 0x76, 0xAE, 
+0x90, 0x9E, 0x17, 0xB6, 0x37, 0xF2, 0xCE, 0xD4, 
+0x85, 0x14, 0xB9, 0x74, 0x46, 0x24, 0x57, 0x2C, 
+0x53, 0xF9, 0xE5, 0xC6, 0x68, 0x68, 0xB2, 0x06, 
+0xEB, 0x5F, 0x25, 0xF1, 0x7A, 0xA3, 0x3F, 0x5D, 
+0x81, 0x08, 0xB8, 0x55, 0x40, 0xBC, 0xB0, 0xFF, 
+0x55, 0xD5, 0x78, 0xE0, 0x4C, 0x58, 0x4E, 0x70, 
+0x39, 0x0C, 0x3E, 0x57, 0x11, 0x7F, 0x43, 0x68, 
+0x62, 0x12, 0xA7, 0x03, 0x81, 0xBF, 0xEA, 0xA9, 
+0xF3, 0x4B, 0x12, 0x64, 0x18, 0xD8, 0xDC, 0xE5, 
+0x6C, 0x38, 0x3E, 0x5E, 
+
+0xFE, 0x46, 0x89, 0x7A, 0x2A, 0x7B, 0xD7, 0x41, 
+0x76, 0xE8, 0x58, 0xE5, 0x07, 0x43, 0x4C, 0x73, 
+
+0x9C, 0xCC, 0x67, 0x9E, 0xA2, 0x61, 0xAE, 0x82, 
+0x03, 0x7C, 0x00, 0x8F, 0xFB, 0xCA, 0x8E, 0x2C, 
+0xE2, 
+
+0x37, 0xDE, 0xF5, 0x6E, 0x55, 0x3A, 0x30, 0x2D, 
+0x23, 0x91, 0x97, 0xC3, 0xDC, 0x32, 0xB7, 0xAD, 
+0xF2, 0x58, 
+
+0xD8, 0xEB, 0x64, 0xB2, 0xB2, 0xCF, 0x43, 0xCD, 
+0xBC, 0x94, 0x22, 0xFC, 0xA3, 0x93, 0xFE, 0x5F, 
+0x69, 0x1F, 0xC6, 
+
+0x19, 0xAC, 0x72, 0x2E, 0xBD, 0xA5, 0xB7, 0x6C, 
+0x5B, 0xA4, 0x98, 0x52, 0x8B, 0xAA, 0x1F, 0xBC, 
+0xFD, 0x44, 0x32, 0xF4, 
+
+0x10, 0xD7, 0xAA, 0x2B, 0x27, 0xD6, 0x9F, 0x93, 
+0xEC, 0xF8, 0x8A, 0x5B, 0xC5, 0x22, 0x95, 0x18, 
+0x94, 0xE1, 0xF6, 0x85, 0x9B, 
+
+0x85, 0x68, 0xD0, 0x1B, 0xB7, 0xBE, 0x63, 0x9F, 
+0x04, 0x75, 0xD7, 0x54, 0xB2, 0x54, 0xB3, 0xCF, 
+0xD5, 0x47, 0xEE, 0x0C, 0xA9, 0xB1, 
+
+0xB3, 0xBF, 0x6E, 0x63, 0x19, 0x55, 0x03, 0xF6, 
+0xF6, 0x1A, 0x20, 0x9A, 0xB5, 0xC2, 0x87, 0x93, 
+0x38, 0xBB, 0x45, 0xB3, 0x8D, 0x1C, 0x6C, 
+
+0xAA, 0xEB, 0x8D, 0x8E, 0xB8, 0xFF, 0x0F, 0x39, 
+0xA2, 0x15, 0x33, 0x8F, 0xEF, 0xDF, 0xA9, 0x81, 
+0x11, 0xEE, 0xD2, 0x09, 0x2D, 0x02, 0x9F, 0x29, 
+
+0xCD, 0xB9, 0x47, 0x51, 0xBC, 0x14, 0x00, 0x50, 
+0x64, 0xC6, 0xCC, 0x40, 0x56, 0x36, 0xF1, 0x24, 
+0x27, 0x67, 0xC2, 0x37, 0x4D, 0x6C, 0x02, 0x48, 
+0xDC, 
+
+0x33, 0xE7, 0x91, 0xD9, 0x7A, 0x71, 0xE5, 0xC5, 
+0xCC, 0xF8, 0x11, 0xD8, 0x73, 0xF7, 0x7D, 0xAA, 
+0xCC, 0xAB, 0x35, 0x3B, 0xA8, 0x4B, 0x60, 0x54, 
+0xB8, 0x71, 
+
+0x9F, 0x4D, 0xF5, 0xDF, 0x58, 0x5D, 0xF1, 0xB1, 
+0x05, 0xCB, 0x18, 0x14, 0x78, 0xA2, 0x21, 0x1A, 
+0x0A, 0xB1, 0xEA, 0x39, 0x31, 0xE3, 0x0D, 0xA4, 
+0xD4, 0x1E, 0x2A, 
+
+0x31, 0x0D, 0xD4, 0x87, 0x8B, 0x43, 0x46, 0x8C, 
+0xBD, 0xD1, 0x41, 0x65, 0x35, 0x58, 0x43, 0x9E, 
+0xCE, 0x8D, 0xE6, 0xC8, 0x6F, 0x32, 0xC4, 0xFA, 
+0x99, 0x20, 0x27, 0x5D, 
+
+0x14, 0x96, 0x82, 0x0F, 0x4C, 0x25, 0xE7, 0xA4, 
+0xA9, 0x11, 0x84, 0xB1, 0xA4, 0x3C, 0x68, 0x5A, 
+0xC0, 0xB9, 0x15, 0xD9, 0xDD, 0x45, 0x4E, 0x4E, 
+0xA7, 0xE0, 0x08, 0xB9, 0xEA, 
+
+0x89, 0xCD, 0x21, 0x0A, 0xD7, 0xA7, 0xC5, 0x6A, 
+0xDD, 0x84, 0xA4, 0xC7, 0x2C, 0x24, 0x2C, 0xDD, 
+0x39, 0xF1, 0x34, 0xD2, 0x97, 0xD5, 0x53, 0xA5, 
+0x00, 0x3F, 0xFD, 0xBB, 0x8C, 0x6A, 
+
+0xE7, 0xA0, 0x64, 0x3D, 0x1F, 0xBF, 0x1C, 0x69, 
+0x05, 0x7C, 0xF3, 0x46, 0xCC, 0xB4, 0x0E, 0xDE, 
+0x29, 0x26, 0x03, 0x83, 0xFB, 0xD1, 0xE6, 0xD1, 
+0x93, 0x1E, 0x63, 0x56, 0x1E, 0x42, 0xA1, 
+
+0xB9, 0x42, 0x5C, 0x25, 0xB8, 0x5D, 0xB6, 0xAF, 
+0x3B, 0x02, 0xDA, 0x62, 0x51, 0x3E, 0x8C, 0x30, 
+0x24, 0x06, 0x26, 0x18, 0xDD, 0xA2, 0x39, 0xA4, 
+0x20, 0x0A, 0x36, 0x0F, 0xF8, 0xD0, 0xD7, 0x58, 
+
+0x04, 0xF2, 0xDF, 0x6D, 0x3F, 0x25, 0x69, 0x37, 
+0x26, 0xCC, 0x0A, 0x57, 0x01, 0x4D, 0xAE, 0xC2, 
+0xB8, 0x95, 0x2E, 0xB6, 0xD9, 0x66, 0x6F, 0xB1, 
+0xE8, 0xEC, 0x25, 0x13, 0x47, 0x6A, 0xF3, 0x81, 
+0x38, 
+
+0x7F, 0xBE, 0xDA, 0x4B, 0xCA, 0x3A, 0x16, 0x7F, 
+0x82, 0x28, 0xA3, 0xEE, 0x4F, 0x5C, 0xF8, 0xB4, 
+0xE9, 0xFF, 0xF5, 0x81, 0x27, 0xF2, 0x9D, 0x4C, 
+0xF4, 0x7D, 0xCA, 0x2F, 0xFC, 0x62, 0x16, 0x64, 
+0x13, 0x9B, 
+
+0x19, 0xE7, 0x5B, 0x5A, 0xC9, 0xDC, 0x3A, 0x40, 
+0x61, 0x61, 0x1A, 0xED, 0x67, 0x25, 0x86, 0x4C, 
+0x6C, 0xAA, 0x67, 0xCC, 0x9A, 0x79, 0xDA, 0x90, 
+0x4C, 0xCD, 0x38, 0x5A, 0x81, 0x33, 0xA5, 0x13, 
+0x11, 0xBD, 0x18, 
+
+0x93, 0xBD, 0x40, 0x8B, 0x07, 0xC8, 0x8C, 0x94, 
+0x36, 0x9B, 0x26, 0x8D, 0x65, 0x80, 0x4E, 0x89, 
+0xFF, 0x18, 0x25, 0x62, 0x6B, 0x67, 0xFE, 0x57, 
+0xFD, 0xA0, 0xCA, 0x82, 0xCE, 0x39, 0x26, 0x68, 
+0x11, 0x31, 0xB9, 0x45, 
+
+0xC6, 0xEC, 0x5F, 0xC9, 0x08, 0x8F, 0x0A, 0x96, 
+0xD8, 0xB6, 0x75, 0x1C, 0xAB, 0x87, 0x23, 0xD7, 
+0x57, 0xE5, 0x1A, 0xFB, 0x35, 0xCF, 0xB7, 0xD3, 
+0x15, 0x1C, 0xD5, 0x71, 0xA6, 0x12, 0xB7, 0x4C, 
+0x01, 0x9F, 0x39, 0x42, 0x73, 
+
+0x30, 0x2D, 0x16, 0xBA, 0x74, 0x54, 0xF6, 0xB0, 
+0x6D, 0xED, 0x82, 0xDA, 0x80, 0xED, 0x95, 0xB0, 
+0x28, 0x32, 0xBF, 0xA1, 0xC5, 0x57, 0xBD, 0x38, 
+0x5E, 0xFC, 0x2B, 0x08, 0x9C, 0x6F, 0xEE, 0x0D, 
+0x57, 0xBE, 0x27, 0x4D, 0x3C, 0xF9, 
+
+0x0D, 0x98, 0xF2, 0xA6, 0x0D, 0x08, 0xBA, 0xEC, 
+0xB0, 0x7D, 0xC0, 0xE4, 0xFC, 0x8C, 0xFD, 0x14, 
+0x60, 0x4C, 0xA3, 0x93, 0x6D, 0x12, 0x9F, 0x68, 
+0x28, 0x6E, 0x50, 0x5A, 0x55, 0x32, 0xB0, 0x5B, 
+0x82, 0x13, 0xBE, 0xBE, 0xC5, 0xC1, 0x06, 
+
+0xCC, 0xA3, 0xA2, 0xBB, 0x68, 0x65, 0xA9, 0x97, 
+0x2E, 0x6B, 0x52, 0x9F, 0xE4, 0xB6, 0x23, 0xB3, 
+0x33, 0x6B, 0xC1, 0xCB, 0xBB, 0x44, 0x32, 0x40, 
+0xC8, 0xE0, 0x98, 0x93, 0x42, 0x8A, 0xCB, 0xBD, 
+0x05, 0x4A, 0xFE, 0x23, 0x5B, 0x3F, 0x9C, 0x7E, 
+
+0xEA, 0x6C, 0x63, 0x3F, 0x42, 0xF2, 0x64, 0x0F, 
+0x57, 0x7B, 0x21, 0xB1, 0xFB, 0x3E, 0x5B, 0x27, 
+0x09, 0xBE, 0x4F, 0x92, 0x92, 0xD0, 0x97, 0xEA, 
+0xBF, 0x5A, 0x4E, 0x0E, 0x1D, 0x8D, 0x73, 0xB0, 
+0x5E, 0xDA, 0x6C, 0x19, 0xA9, 0xC4, 0xFB, 0x2A, 
+0x71, 
+
+0x2D, 0x27, 0x3E, 0x93, 0xEB, 0x92, 0x31, 0xF2, 
+0x8E, 0x6B, 0xC3, 0xD9, 0xAD, 0x2C, 0x5C, 0xFA, 
+0x9A, 0x47, 0xCE, 0x45, 0x05, 0x08, 0x16, 0xF8, 
+0x47, 0x05, 0xBC, 0x01, 0x2E, 0x36, 0x96, 0x5C, 
+0xE3, 0x4B, 0x0B, 0xAB, 0x4C, 0x9D, 0x51, 0x58, 
+0x25, 0xD4, 
+
+0x23, 0xD4, 0xE5, 0x60, 0xF1, 0xAE, 0x3A, 0x5C, 
+0x23, 0xB3, 0xAF, 0x28, 0xF8, 0x93, 0xB6, 0x26, 
+0x28, 0x2A, 0x6B, 0x4E, 0x1D, 0x6B, 0xCC, 0xDE, 
+0xAE, 0x9B, 0x6E, 0x97, 0x12, 0xCA, 0x3F, 0xB9, 
+0xCF, 0x0F, 0x27, 0x4C, 0xA5, 0x22, 0xC1, 0x63, 
+0xFA, 0x70, 0x58, 
+
+0xB2, 0xED, 0x5C, 0xBD, 0x05, 0xD3, 0xEB, 0xA6, 
+0xEB, 0x2B, 0xE0, 0xE8, 0x0C, 0x40, 0x6F, 0x57, 
+0x58, 0xD8, 0x76, 0xB4, 0x9D, 0x69, 0x58, 0xB6, 
+0x1B, 0x52, 0xB3, 0x73, 0xEE, 0xE4, 0x96, 0xB0, 
+0xF1, 0xEC, 0x2C, 0x90, 0x9B, 0xD1, 0xE6, 0xF2, 
+0x16, 0x0C, 0x42, 0xB3, 
+
+0xB3, 0xEB, 0x9B, 0x18, 0xF0, 0xB4, 0x09, 0xAD, 
+0xDC, 0x5D, 0x03, 0xA3, 0x61, 0xF2, 0x86, 0x9F, 
+0x4D, 0x3B, 0x6A, 0x53, 0xA5, 0xF2, 0x31, 0xA5, 
+0xF3, 0xA4, 0x81, 0xB7, 0xCD, 0xD7, 0x11, 0x54, 
+0x06, 0x85, 0x7B, 0x7B, 0x5B, 0x49, 0xB1, 0xAD, 
+0x1C, 0x5D, 0xDA, 0xC2, 0x15, 
+
+0x96, 0xBA, 0x45, 0x04, 0x1F, 0x79, 0x84, 0xD5, 
+0x12, 0x39, 0x72, 0x51, 0x67, 0x96, 0xDC, 0x0A, 
+0x42, 0xFD, 0x07, 0x48, 0xE5, 0x9A, 0x06, 0x78, 
+0x5D, 0x25, 0xA3, 0xFF, 0xA2, 0x9B, 0xA6, 0xA0, 
+0x4F, 0x4C, 0xFD, 0xDF, 0x1D, 0x4C, 0x1F, 0xDE, 
+0x6B, 0xBF, 0x3A, 0x1F, 0x8C, 0x91, 
+
+0x3C, 0xC6, 0xE2, 0xDF, 0x7C, 0x78, 0x67, 0x00, 
+0x63, 0xFB, 0x91, 0x60, 0x31, 0x43, 0x0A, 0x60, 
+0x3A, 0x64, 0x61, 0x81, 0xFB, 0xB3, 0x97, 0x7B, 
+0x42, 0x48, 0x70, 0x0C, 0xE0, 0x0F, 0x08, 0xBC, 
+0x21, 0xB0, 0x90, 0x86, 0xB9, 0x41, 0xF7, 0xAB, 
+0xD0, 0x3B, 0x70, 0xAC, 0xC1, 0xD8, 0xDA, 
+
+0x6B, 0x7E, 0xB5, 0xE6, 0x22, 0x1A, 0x76, 0x20, 
+0x3C, 0x2E, 0x83, 0x0F, 0xCF, 0x24, 0x39, 0x27, 
+0x55, 0x95, 0xF5, 0xAE, 0x0D, 0xAF, 0xC4, 0x17, 
+0xF7, 0xB3, 0xF3, 0x05, 0x77, 0xCD, 0x88, 0x1C, 
+0x2C, 0x03, 0x13, 0x73, 0xC1, 0x17, 0x6C, 0x2E, 
+0x05, 0x27, 0xB8, 0xC3, 0xB3, 0x9E, 0x97, 0xF5, 
+
+0x2F, 0xF3, 0x66, 0x1B, 0x9E, 0x19, 0xE7, 0x34, 
+0x1C, 0x35, 0x63, 0x9D, 0xB3, 0x4A, 0xE8, 0x70, 
+0x41, 0x76, 0xA7, 0x28, 0xC5, 0x1F, 0x76, 0x8F, 
+0x3E, 0x74, 0x5A, 0xDD, 0x8D, 0x69, 0xAE, 0x74, 
+0x43, 0x11, 0xFF, 0xAF, 0x77, 0x97, 0x10, 0xC9, 
+0xDD, 0x74, 0x76, 0x29, 0x91, 0xCC, 0xAC, 0x3E, 
+0xD8, 
+
+0x1D, 0x78, 0xC7, 0xFF, 0x61, 0x51, 0x6D, 0xEA, 
+0xA2, 0x1F, 0x12, 0x0D, 0x79, 0xA7, 0x57, 0xDF, 
+0x0C, 0x6B, 0xDE, 0xD9, 0x3D, 0x64, 0xDC, 0xC1, 
+0xB8, 0xD8, 0x7A, 0x97, 0x33, 0xC2, 0x3A, 0x65, 
+0x26, 0xDB, 0x11, 0x84, 0x3C, 0x3F, 0xE6, 0x41, 
+0x0F, 0x67, 0x2E, 0x60, 0xCE, 0xFD, 0xBE, 0x6C, 
+0x42, 0xE8, 
+
+0x8D, 0x1A, 0xCA, 0xC3, 0x5E, 0x79, 0xF9, 0x9E, 
+0xB0, 0x13, 0x6A, 0x10, 0xAF, 0xDF, 0x8B, 0x16, 
+0x5B, 0x4E, 0x9C, 0xC4, 0x3F, 0x14, 0x8D, 0x0E, 
+0x20, 0x7D, 0x38, 0xF0, 0xB2, 0x2C, 0x84, 0x73, 
+0x87, 0xEC, 0x2D, 0xD4, 0xBF, 0xD9, 0xCF, 0x57, 
+0xD4, 0xF9, 0xE7, 0xF8, 0x38, 0x60, 0x83, 0x29, 
+0x73, 0x7E, 0x8D, 
+
+0x75, 0x8C, 0x2C, 0x5C, 0xD3, 0xB7, 0x5E, 0xC0, 
+0xCA, 0x14, 0x47, 0x7D, 0x1E, 0x84, 0xE5, 0x33, 
+0xAB, 0x8C, 0xD2, 0x99, 0x8C, 0xCB, 0x71, 0x80, 
+0xEC, 0xCA, 0x3A, 0x0B, 0x68, 0x87, 0xD7, 0x5B, 
+0x3D, 0xB7, 0x53, 0x89, 0x58, 0xC4, 0x5A, 0x17, 
+0xBB, 0xFA, 0xBE, 0xAD, 0x88, 0x03, 0x9A, 0x38, 
+0x9F, 0xB3, 0x41, 0xBB, 
+
+0x95, 0x22, 0x19, 0x3F, 0x57, 0xA5, 0x97, 0xC8, 
+0x54, 0xC9, 0x7C, 0x7E, 0x72, 0x63, 0xC5, 0xCF, 
+0x80, 0xC0, 0xB6, 0xED, 0x90, 0xB0, 0x0E, 0x5C, 
+0x03, 0x12, 0xF8, 0x2A, 0x29, 0x57, 0x42, 0x99, 
+0x12, 0xEA, 0xB5, 0x2E, 0x6E, 0x51, 0x03, 0x91, 
+0x57, 0xED, 0x22, 0x26, 0xA5, 0x73, 0x23, 0x03, 
+0x49, 0x27, 0x5A, 0xBF, 0x78, 
+
+0x6E, 0x5B, 0xD9, 0xB6, 0x41, 0xE8, 0x7F, 0x39, 
+0xC5, 0xE6, 0xF2, 0x89, 0x3A, 0x78, 0x91, 0xE1, 
+0x34, 0x57, 0xDF, 0xE0, 0x9B, 0x33, 0x96, 0xDE, 
+0xA6, 0x2E, 0xD3, 0xE7, 0xB8, 0xBE, 0xE8, 0xAF, 
+0x20, 0x9D, 0x0A, 0xD2, 0xE8, 0x12, 0xC1, 0x19, 
+0xC6, 0xE5, 0x70, 0xD0, 0xC0, 0xF0, 0xA9, 0x93, 
+0xB0, 0x89, 0xD3, 0xA8, 0xB4, 0x49, 
+
+0x41, 0xFC, 0x5A, 0xB5, 0xC6, 0xB9, 0x3F, 0x7B, 
+0x11, 0x60, 0xBA, 0x0E, 0x13, 0x73, 0x8B, 0xBE, 
+0xC3, 0xFC, 0x5B, 0x47, 0x60, 0x36, 0xA9, 0xFC, 
+0xDD, 0x14, 0x72, 0xEF, 0x32, 0xA0, 0x10, 0xEB, 
+0x9B, 0x93, 0xE9, 0x16, 0x8F, 0x64, 0xB3, 0x74, 
+0x2E, 0x30, 0xA9, 0x31, 0x93, 0x4C, 0xFA, 0x95, 
+0xBC, 0x49, 0x1E, 0x2D, 0xB3, 0xFA, 0x58, 
+
+0x92, 0x43, 0x31, 0x4C, 0xF7, 0x73, 0xA5, 0xDC, 
+0x80, 0x27, 0x00, 0x1A, 0x52, 0x57, 0x69, 0x5C, 
+0x8B, 0x42, 0xE3, 0x71, 0xA8, 0x34, 0xA0, 0x0F, 
+0x32, 0xAE, 0x78, 0xB0, 0xE3, 0xA7, 0x35, 0x05, 
+0xEF, 0xCE, 0xCE, 0xA7, 0xD0, 0xBD, 0x88, 0xF9, 
+0x78, 0x56, 0xE9, 0x88, 0xEF, 0x77, 0x29, 0x53, 
+0x2E, 0x9C, 0x66, 0x6C, 0x58, 0x99, 0x8F, 0x69, 
+
+0x90, 0xA4, 0xFA, 0x96, 0x8C, 0x7A, 0xA5, 0x87, 
+0x53, 0x36, 0x9A, 0x66, 0x2B, 0x90, 0x9C, 0x86, 
+0xB8, 0x6E, 0x11, 0x55, 0x58, 0x66, 0x83, 0x16, 
+0x04, 0xCA, 0x26, 0xE1, 0x7A, 0x4C, 0x65, 0xA1, 
+0x0B, 0x49, 0x26, 0xF6, 0xF5, 0x94, 0xE0, 0x10, 
+0xC4, 0xD6, 0xE0, 0xB4, 0x58, 0x0E, 0x3C, 0xFC, 
+0x48, 0x51, 0x04, 0xCF, 0xFE, 0xB8, 0x58, 0xA6, 
+0xE2, 
+
+0x1E, 0xDB, 0x00, 0x85, 0x53, 0x3F, 0xDF, 0x32, 
+0xE6, 0xD1, 0x52, 0x1E, 0xCD, 0x78, 0xCC, 0x66, 
+0xE5, 0xA2, 0x1C, 0x27, 0x4F, 0xC2, 0x9A, 0xDD, 
+0x3A, 0x2B, 0x57, 0x3D, 0x06, 0x63, 0x5A, 0x43, 
+0x83, 0x77, 0xF0, 0x98, 0x8D, 0x0E, 0xBA, 0x0E, 
+0x58, 0x3E, 0x83, 0x4D, 0x99, 0xF2, 0x9A, 0x6A, 
+0xE0, 0xD7, 0xA4, 0x02, 0xC9, 0x44, 0xB8, 0x33, 
+0x8F, 0x7B, 
+
+0x89, 0x64, 0x5E, 0x80, 0x61, 0x72, 0x91, 0x39, 
+0xB8, 0x49, 0xF9, 0x1F, 0xBB, 0x04, 0x79, 0xC5, 
+0x5F, 0xD3, 0x51, 0xC8, 0xF7, 0xFD, 0x20, 0x5C, 
+0x09, 0xEA, 0xF0, 0x0E, 0x19, 0xFF, 0x8A, 0x04, 
+0x26, 0x70, 0xAA, 0x2B, 0x22, 0x49, 0x80, 0x76, 
+0xF7, 0xC9, 0x00, 0x6F, 0xC5, 0xAA, 0xB8, 0xF9, 
+0x24, 0x59, 0x8C, 0x1E, 0x9C, 0xBE, 0x92, 0x1A, 
+0xC8, 0x21, 0x0E, 
+
+0xF9, 0xCD, 0xFE, 0x92, 0xC2, 0xFB, 0x9F, 0xF2, 
+0x4A, 0xF3, 0x50, 0x70, 0x77, 0x29, 0xCB, 0x74, 
+0xBE, 0x45, 0xC8, 0x5C, 0xCF, 0xD4, 0x4C, 0x21, 
+0x05, 0xB7, 0xB0, 0xB4, 0xBC, 0x8F, 0xA3, 0x09, 
+0x00, 0x0B, 0x0D, 0x19, 0x98, 0x37, 0x11, 0xE9, 
+0x2A, 0x3D, 0xAC, 0xDD, 0xE2, 0x02, 0x5A, 0xFB, 
+0xC9, 0x24, 0x12, 0x35, 0x1E, 0x26, 0xAB, 0x94, 
+0xC3, 0xDA, 0x80, 0x10, 
+
+0x56, 0xA4, 0x2A, 0xAC, 0xA0, 0xA0, 0x01, 0x81, 
+0x2B, 0xE3, 0x06, 0xD3, 0xA6, 0xD6, 0xB5, 0x32, 
+0xD8, 0x08, 0x1A, 0xF0, 0xB3, 0xF6, 0xE2, 0x7F, 
+0x44, 0xFD, 0xED, 0x13, 0x94, 0x02, 0xAC, 0x91, 
+0x3B, 0x37, 0xD4, 0x0B, 0x81, 0xE1, 0xF2, 0xD8, 
+0x0F, 0x34, 0x3A, 0xC8, 0x31, 0xB9, 0x3E, 0xB6, 
+0x47, 0xA6, 0x27, 0xAB, 0x66, 0x7D, 0x32, 0xF8, 
+0xCB, 0x44, 0x4D, 0x6F, 0x24, 
+
+0xBF, 0x1F, 0x6B, 0x54, 0x33, 0xBA, 0xB0, 0x44, 
+0xBA, 0x93, 0xA7, 0x25, 0xED, 0xB1, 0xF6, 0x45, 
+0x7D, 0x80, 0xCD, 0x2E, 0x05, 0x58, 0x14, 0xA2, 
+0xCA, 0x4F, 0xB7, 0xC1, 0x99, 0x2F, 0x9A, 0x72, 
+0xA4, 0xE1, 0xCC, 0x76, 0xA0, 0xFC, 0x49, 0x5B, 
+0x4E, 0x01, 0x86, 0xB4, 0xAA, 0x3C, 0x97, 0x56, 
+0x0F, 0x18, 0x77, 0x78, 0xFE, 0xEA, 0x15, 0x2A, 
+0x70, 0x01, 0xAF, 0xAF, 0xA3, 0xED, 
+
+0xC9, 0x02, 0xA8, 0x5D, 0x57, 0x0C, 0xB3, 0xFC, 
+0x19, 0x06, 0x08, 0xC7, 0x1E, 0x51, 0x2F, 0x4F, 
+0xDC, 0x38, 0x5F, 0x28, 0x7D, 0x0D, 0x2A, 0x95, 
+0xE5, 0xCB, 0x5F, 0x0B, 0xC7, 0x81, 0x5D, 0x25, 
+0x91, 0x89, 0x31, 0x76, 0x77, 0x35, 0x99, 0x31, 
+0x90, 0x84, 0x63, 0xBC, 0x09, 0x8B, 0xAB, 0x17, 
+0x03, 0x51, 0xCE, 0x8A, 0x09, 0x41, 0x06, 0x35, 
+0xD9, 0x8F, 0xF9, 0xB0, 0x5E, 0xAE, 0xBC, 
+
+0x67, 0x2D, 0xFF, 0x0C, 0x50, 0x03, 0xBF, 0x1B, 
+0x61, 0x3B, 0x75, 0xF5, 0xC0, 0xB7, 0x45, 0x04, 
+0x9C, 0xF9, 0x39, 0x0D, 0xFD, 0x95, 0xE3, 0xC3, 
+0x16, 0x0B, 0xF2, 0xCD, 0x15, 0x5A, 0xE9, 0xDB, 
+0x84, 0x86, 0x6E, 0x33, 0xFB, 0x80, 0x3F, 0xDA, 
+0xF3, 0xA5, 0xF8, 0xEA, 0x2F, 0xB7, 0x4A, 0x42, 
+0x4D, 0xBB, 0xC2, 0x0D, 0x9C, 0x59, 0x2C, 0xFD, 
+0x38, 0xB9, 0x0F, 0xAE, 0xFF, 0x23, 0xB3, 0xDA, 
+
+0xDF, 0xB0, 0x11, 0x26, 0xAF, 0x3A, 0x5C, 0xA7, 
+0x28, 0xC6, 0x14, 0x0A, 0xE4, 0xA6, 0x70, 0x16, 
+0x10, 0x3C, 0x58, 0x0E, 0x8D, 0x37, 0xCD, 0x5D, 
+0x25, 0x5E, 0xBC, 0xCB, 0x68, 0x70, 0x5F, 0x24, 
+0xB9, 0xF8, 0x48, 0x35, 0xE7, 0x35, 0xA1, 0x76, 
+0x99, 0xF4, 0x48, 0x3F, 0xAD, 0x18, 0xAC, 0x02, 
+0x66, 0xC0, 0xBE, 0x1D, 0xA8, 0xEE, 0x4E, 0x73, 
+0xC8, 0x5C, 0xC3, 0x11, 0x8E, 0x6E, 0xDF, 0x71, 
+0x57, 
+
+0x04, 0xD4, 0x09, 0x74, 0x37, 0x6C, 0x54, 0xD4, 
+0x1C, 0x8C, 0xC0, 0x8D, 0xDC, 0x29, 0x3B, 0x62, 
+0x6D, 0xFB, 0x24, 0x65, 0xB0, 0xE1, 0xCE, 0x41, 
+0x7E, 0x6C, 0x41, 0x08, 0x8A, 0x82, 0x03, 0x30, 
+0x83, 0xF1, 0x85, 0xF8, 0x53, 0x9D, 0xC4, 0x0F, 
+0x29, 0x89, 0xCF, 0x41, 0x4F, 0x7B, 0xDC, 0x86, 
+0x3F, 0xA0, 0xB0, 0x45, 0x34, 0xDA, 0xB0, 0x54, 
+0x0C, 0x24, 0x65, 0x78, 0x52, 0x1C, 0xEE, 0xE7, 
+0x97, 0x7C, 
+
+0x94, 0x51, 0x03, 0x6A, 0xF2, 0xCC, 0x96, 0xF3, 
+0x7E, 0x56, 0xD6, 0x17, 0xDD, 0x5B, 0x51, 0x2A, 
+0x9D, 0x1D, 0x09, 0xDF, 0x49, 0x0D, 0xA9, 0x67, 
+0x90, 0x4D, 0xD9, 0x0F, 0x89, 0x19, 0x75, 0xF6, 
+0xB7, 0x82, 0x73, 0xF1, 0x93, 0x98, 0xAB, 0xA8, 
+0x4F, 0x78, 0xC4, 0x27, 0x74, 0x08, 0xF6, 0xEA, 
+0xB4, 0xA3, 0x55, 0x1F, 0x07, 0xB8, 0xDC, 0x8D, 
+0x32, 0xE8, 0xBD, 0xC8, 0x56, 0x45, 0x41, 0x34, 
+0x30, 0xC7, 0x81, 
+
+0xF2, 0x8E, 0x0F, 0x9B, 0x5C, 0x60, 0x2B, 0x53, 
+0xAE, 0x99, 0x83, 0xC4, 0x5C, 0xA1, 0x09, 0xF6, 
+0x22, 0x7A, 0xC6, 0x8A, 0x20, 0xC0, 0x93, 0x73, 
+0xE9, 0xF4, 0x7B, 0x81, 0x41, 0x23, 0xF8, 0xF3, 
+0x8B, 0xEC, 0x19, 0xA7, 0x94, 0x08, 0x0B, 0x80, 
+0x34, 0xBD, 0xB6, 0x65, 0xA9, 0xE3, 0xC5, 0x39, 
+0xF1, 0xAF, 0xD8, 0x5B, 0x70, 0x33, 0x8F, 0xBB, 
+0x3B, 0xAE, 0x57, 0x1A, 0x17, 0x67, 0xCF, 0xD2, 
+0x6D, 0x25, 0xE3, 0x5B, 
+
+0xAF, 0x8C, 0x5C, 0x07, 0x98, 0x7C, 0x90, 0x92, 
+0x9A, 0x79, 0x52, 0x0F, 0xAF, 0x16, 0x5B, 0xCB, 
+0x76, 0xCB, 0xEA, 0xBF, 0x77, 0x48, 0x3B, 0x6A, 
+0x15, 0xF8, 0x08, 0xAB, 0x4E, 0x07, 0x41, 0x39, 
+0xC1, 0x57, 0xF0, 0x61, 0x6A, 0x31, 0x84, 0xFB, 
+0x39, 0xCB, 0xD2, 0x87, 0x25, 0x6D, 0xEA, 0x46, 
+0x47, 0xA6, 0xEB, 0xA3, 0xD9, 0xEF, 0x03, 0xEE, 
+0xF6, 0xE3, 0xFC, 0xD7, 0x4A, 0x6F, 0xCB, 0x65, 
+0x69, 0xF6, 0x13, 0x3B, 0xBB, 
+
+}
+return kat[i:j]
+}
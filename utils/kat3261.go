@@ -0,0 +1,4966 @@
+package utils
+func getkat3261(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x75, 0x05, 0x5C, 0x30, 0x3D, 0xB9, 0x39, 0xEB, 
+0x8F, 0x7E, 0x77, 0xA2, 0xEF, 0x94, 0x14, 0x51, 
+
+0xE1, 0xD5, 0xCC, 0xF3, 0x13, 0x30, 0xB6, 0xF1, 
+0xC4, 0xC6, 0xD6, 0xB1, 0x1E, 0xA9, 0x5A, 0xFB, 
+0x3B, 
+
+0xC9, 0xC3, 0x2B, 0x07, 0x65, 0x33, 0x04, 0xD0, 
+0xC5, 0xDF, 0x68, 0x46, 0xE7, 0x3C, 0x35, 0xF7, 
+0x23, 0x9E, 
+
+0xA6, 0x8D, 0xAD, 0x62, 0x63, 0xED, 0x90, 0x7B, 
+0xB1, 0xB7, 0x13, 0xC6, 0xB2, 0x0A, 0xF9, 0x79, 
+0x5A, 0x45, 0xAF, 
+
+0xA2, 0xC7, 0xB8, 0x8B, 0x0C, 0xD4, 0xE5, 0x54, 
+0x74, 0x2B, 0x88, 0xCB, 0x0C, 0xB3, 0x39, 0x5A, 
+0xBD, 0x01, 0xB8, 0x17, 
+
+0x63, 0xAE, 0xC2, 0x0D, 0x81, 0x35, 0x25, 0x0E, 
+0x97, 0x9C, 0xF6, 0xA3, 0xE9, 0xC6, 0x00, 0x42, 
+0x7A, 0x99, 0x0A, 0xC2, 0x2E, 
+
+0xBE, 0x77, 0xC1, 0xAA, 0xB3, 0xA3, 0x97, 0xB6, 
+0xDF, 0x3A, 0x14, 0x34, 0x74, 0x44, 0x33, 0x9C, 
+0x16, 0x07, 0x75, 0xF8, 0xFF, 0x03, 
+
+0x1F, 0x08, 0x3C, 0x9C, 0xE4, 0x4E, 0xC7, 0x81, 
+0x88, 0x4C, 0xC6, 0x3F, 0xDE, 0x83, 0x6B, 0xAE, 
+0x66, 0x6A, 0x47, 0x62, 0xA7, 0xC9, 0xB9, 
+
+0xA3, 0x7D, 0xE1, 0x4C, 0xE4, 0xB0, 0xB9, 0x43, 
+0x0E, 0xA3, 0x27, 0xC2, 0xE3, 0x63, 0x78, 0xD9, 
+0x7F, 0xA0, 0x16, 0x07, 0xC6, 0xBA, 0xB8, 0xAC, 
+
+0x13, 0x78, 0x90, 0xDC, 0x0F, 0xE1, 0xA9, 0x39, 
+0x03, 0xBF, 0x55, 0x91, 0x34, 0x31, 0x83, 0xA6, 
+0x52, 0xDA, 0x55, 0xCF, 0x19, 0x5E, 0xE8, 0xC6, 
+0x6A, 
+
+0x31, 0x0C, 0xA1, 0x94, 0x21, 0x39, 0x9C, 0xEF, 
+0x17, 0x8B, 0x19, 0x07, 0x16, 0x41, 0x8A, 0x05, 
+0x01, 0x02, 0x13, 0x68, 0xEA, 0x84, 0xC6, 0xEE, 
+0x4D, 0x46, 
+
+0x14, 0x79, 0xD1, 0xA8, 0x02, 0x46, 0xA2, 0xFC, 
+0x2D, 0xE7, 0xAE, 0xC4, 0x25, 0xE4, 0xB4, 0x9D, 
+0x15, 0xBA, 0x3C, 0x14, 0x60, 0xDE, 0x78, 0x68, 
+0xCF, 0x5D, 0x64, 
+
+0x0D, 0x1F, 0xA0, 0xB4, 0x3A, 0xBF, 0xCC, 0xBA, 
+0x80, 0x40, 0xC2, 0x77, 0xA7, 0xEB, 0xD9, 0x6E, 
+0x5B, 0x82, 0x8E, 0xBB, 0xFF, 0xAF, 0xD8, 0x63, 
+0x9E, 0xB3, 0xDA, 0x06, 
+
+0xF1, 0xA0, 0x1D, 0x3B, 0xCC, 0xDC, 0x12, 0x2D, 
+0x7A, 0xF7, 0xF5, 0x34, 0x30, 0xA2, 0xB8, 0x46, 
+0x78, 0x80, 0x62, 0x68, 0x17, 0x37, 0x1C, 0xCC, 
+0xF5, 0x30, 0x7A, 0x9E, 0x1A, 
+
+0xCB, 0x0E, 0xBE, 0x64, 0x26, 0xAD, 0xC2, 0x5A, 
+0x6F, 0x2B, 0xD6, 0x5D, 0xCF, 0xE1, 0x27, 0xBF, 
+0xCA, 0x39, 0xCC, 0x45, 0xB1, 0x95, 0x39, 0x32, 
+0x2F, 0xFB, 0x94, 0xAF, 0x04, 0x87, 
+
+0x5A, 0x18, 0x84, 0x29, 0x90, 0x13, 0x5C, 0xD0, 
+0x7F, 0x5D, 0x4B, 0xBE, 0x46, 0x5F, 0x26, 0x54, 
+0x8A, 0x8F, 0x6F, 0x69, 0x09, 0x8C, 0x22, 0xE9, 
+0x7D, 0xDE, 0x7E, 0xD5, 0xD9, 0x6D, 0x49, 
+
+0xD5, 0xBF, 0x4D, 0x7A, 0x1C, 0x80, 0xAA, 0x06, 
+0x87, 0xB3, 0xA4, 0x3D, 0x7D, 0x9B, 0x56, 0x00, 
+0x53, 0x33, 0xD8, 0x75, 0x26, 0x85, 0x28, 0x1C, 
+0x32, 0x03, 0x17, 0x54, 0xA9, 0x78, 0xAD, 0x28, 
+
+0xFB, 0xD0, 0x82, 0x64, 0x9B, 0xEE, 0xFD, 0x6C, 
+0x66, 0x94, 0x9D, 0x7A, 0xC2, 0xE7, 0xD8, 0x97, 
+0x3B, 0x17, 0xF2, 0x0D, 0xD1, 0x7C, 0x90, 0xBD, 
+0xBC, 0x4D, 0x6F, 0x96, 0x33, 0xDA, 0x5D, 0xBC, 
+0xFE, 
+
+0x27, 0xDB, 0x95, 0xAB, 0xC6, 0x16, 0xA7, 0xD3, 
+0x20, 0xBF, 0xEB, 0x04, 0x73, 0x20, 0x85, 0x5A, 
+0xC2, 0xC3, 0xC9, 0x6D, 0xF4, 0x77, 0xBA, 0xEA, 
+0x03, 0x1A, 0x05, 0x01, 0xED, 0xA1, 0xB9, 0x4E, 
+0x4D, 0xCA, 
+
+0x88, 0x00, 0x7B, 0xB4, 0x75, 0xDE, 0x35, 0x3C, 
+0x9B, 0x5B, 0x04, 0xCE, 0x34, 0xC1, 0xF1, 0xE6, 
+0x04, 0x8B, 0x3E, 0x40, 0x59, 0x50, 0xE7, 0x25, 
+0xCA, 0xC2, 0xC9, 0x0E, 0xEE, 0xCE, 0xA9, 0x5F, 
+0x29, 0x88, 0xD0, 
+
+0x7B, 0x01, 0x66, 0x5D, 0x1A, 0x1D, 0xEA, 0x3C, 
+0x42, 0x35, 0x14, 0x5B, 0x8C, 0xA8, 0x71, 0x96, 
+0xDB, 0xBF, 0x43, 0x67, 0xFB, 0x56, 0x3B, 0x4F, 
+0xD3, 0x6D, 0xF7, 0xD0, 0x8D, 0x09, 0xC7, 0x60, 
+0x85, 0xAD, 0x42, 0xD5, 
+
+0x5F, 0x3F, 0x1B, 0x71, 0x0B, 0xB0, 0xA3, 0x25, 
+0x21, 0x03, 0xCC, 0x22, 0x20, 0xC8, 0x27, 0x5F, 
+0x36, 0x50, 0x90, 0xA5, 0x68, 0x72, 0xC8, 0x1B, 
+0xC5, 0xCB, 0x69, 0xC4, 0xFD, 0xF8, 0x06, 0xC3, 
+0xF3, 0xD9, 0xC4, 0x36, 0x0A, 
+
+0x39, 0x4C, 0x0C, 0x33, 0xCB, 0xDC, 0xC4, 0x2F, 
+0x52, 0x03, 0xE9, 0x9B, 0xE0, 0xE3, 0xDF, 0x80, 
+0x76, 0x04, 0x3B, 0x5D, 0x40, 0xDF, 0xCC, 0xAC, 
+0x69, 0x60, 0x60, 0x67, 0xB5, 0x3F, 0x31, 0xCD, 
+0xA9, 0x7A, 0x05, 0x2B, 0xFF, 0x79, 
+
+0xE0, 0x38, 0xAE, 0x98, 0xAD, 0x31, 0xC3, 0x12, 
+0xFE, 0xC4, 0xF4, 0xE5, 0x68, 0x0D, 0xA4, 0x2E, 
+0x93, 0x04, 0x6D, 0xAD, 0x6C, 0x10, 0xE2, 0xEF, 
+0x9B, 0x0C, 0x4D, 0x8F, 0xCE, 0xC0, 0x16, 0x22, 
+0xE4, 0xC3, 0x3E, 0xEC, 0xAA, 0x5B, 0xF6, 
+
+0x30, 0x6C, 0xD6, 0xB2, 0x8F, 0x85, 0x20, 0x1B, 
+0x95, 0x83, 0x9B, 0x0B, 0xB2, 0x80, 0x31, 0xAB, 
+0xD6, 0x59, 0x3E, 0x3D, 0x48, 0x19, 0xF1, 0x97, 
+0xD2, 0x2B, 0x9C, 0xEE, 0x6A, 0x37, 0x42, 0xEC, 
+0xF9, 0xF8, 0xC3, 0x1F, 0xDC, 0xDC, 0x79, 0x38, 
+
+0x7D, 0xD7, 0xF5, 0x92, 0x00, 0x41, 0xD9, 0xF4, 
+0x00, 0x1F, 0x7F, 0x8F, 0x4D, 0x24, 0x59, 0x47, 
+0x17, 0x98, 0xA7, 0x6B, 0x2A, 0x28, 0x28, 0xAF, 
+0x81, 0x3D, 0xD6, 0x7D, 0xE4, 0x8A, 0x09, 0x7B, 
+0x95, 0xE7, 0xD4, 0xFB, 0x92, 0x06, 0xDF, 0xD3, 
+0x59, 
+
+0xA5, 0xE2, 0xA9, 0x12, 0xE3, 0x66, 0x50, 0x66, 
+0x01, 0x95, 0x61, 0xEB, 0x0F, 0x95, 0x62, 0x0C, 
+0x92, 0x9F, 0xF5, 0xB3, 0x31, 0x3A, 0x7E, 0x3C, 
+0xD2, 0x5E, 0x1C, 0x98, 0xEA, 0x52, 0xD1, 0xB6, 
+0x91, 0x58, 0x76, 0x9A, 0x38, 0xCB, 0xE3, 0xFF, 
+0xA1, 0x92, 
+
+0x93, 0xA7, 0x5B, 0x30, 0x28, 0x5E, 0x5C, 0x20, 
+0x72, 0x9B, 0xA9, 0x74, 0x8C, 0xD9, 0x05, 0x9D, 
+0xE9, 0xD1, 0x3A, 0xCF, 0xCE, 0xB2, 0x6C, 0x84, 
+0xDA, 0xA8, 0xC3, 0xF3, 0x7E, 0xF9, 0x54, 0x05, 
+0x1E, 0xC1, 0x01, 0x78, 0xD3, 0xAE, 0x5D, 0x7F, 
+0xD2, 0x3B, 0x3E, 
+
+0xAF, 0xF1, 0x40, 0xEA, 0x22, 0x7A, 0x86, 0xD1, 
+0x2D, 0x8B, 0x56, 0x01, 0x2A, 0xED, 0x75, 0xA9, 
+0x19, 0x8B, 0x8D, 0x69, 0x45, 0x11, 0x51, 0x59, 
+0xEA, 0x0E, 0x36, 0x50, 0x53, 0x02, 0xFE, 0x43, 
+0x59, 0x0D, 0x1B, 0x24, 0x63, 0x74, 0xEA, 0x1E, 
+0xB4, 0x9C, 0xD6, 0x70, 
+
+0xDA, 0x2D, 0x65, 0xF1, 0xBA, 0x2A, 0x01, 0x91, 
+0xA7, 0x6F, 0xF2, 0xC8, 0x04, 0x5D, 0x79, 0x9C, 
+0xD3, 0x98, 0x80, 0x53, 0x8D, 0x9E, 0xFB, 0x55, 
+0x58, 0x98, 0x22, 0x35, 0xD8, 0x9E, 0x71, 0xDF, 
+0xA0, 0xBC, 0x09, 0x37, 0xE3, 0x80, 0xC8, 0xC7, 
+0x74, 0xF2, 0x34, 0x1A, 0xE6, 
+
+0xDC, 0x1F, 0xE0, 0xF9, 0x2C, 0x14, 0x31, 0xCE, 
+0x12, 0x25, 0xDB, 0x2B, 0x6A, 0xC6, 0xE0, 0xF2, 
+0x8D, 0x4B, 0x37, 0xC0, 0x82, 0x2C, 0x19, 0x0D, 
+0xD1, 0xF0, 0x16, 0xF8, 0xE3, 0x1D, 0x1D, 0x54, 
+0x9A, 0x05, 0x03, 0x2E, 0x75, 0x82, 0x6C, 0xCB, 
+0xD7, 0xB3, 0xB2, 0x17, 0x3A, 0x4F, 
+
+0xEF, 0xD4, 0x31, 0x55, 0xBD, 0x62, 0x01, 0x6B, 
+0x65, 0x7F, 0x0A, 0xE9, 0x37, 0xCA, 0xF2, 0x9B, 
+0xC5, 0xC8, 0xE2, 0x3C, 0xD3, 0xEF, 0xA6, 0x3B, 
+0xDF, 0xAA, 0x37, 0x75, 0x01, 0x5A, 0xCA, 0xA1, 
+0x60, 0x88, 0xAB, 0x04, 0x43, 0x66, 0xE1, 0x30, 
+0x1D, 0xAF, 0x89, 0xBF, 0xFC, 0x28, 0x22, 
+
+0x88, 0x30, 0x56, 0xFE, 0x12, 0x40, 0xF1, 0x8D, 
+0x7A, 0xFA, 0x78, 0xC8, 0xFE, 0x3B, 0xF0, 0xCC, 
+0xAC, 0x6C, 0xB6, 0x02, 0x8D, 0x90, 0xB1, 0x81, 
+0x26, 0x0B, 0x2A, 0x6D, 0x2F, 0x04, 0xD1, 0xB7, 
+0xC5, 0x88, 0xB8, 0xD6, 0xA5, 0xED, 0xFE, 0xD8, 
+0x62, 0xFC, 0xE7, 0x08, 0xA4, 0xFC, 0xE9, 0x83, 
+
+0x30, 0xF2, 0xCA, 0x38, 0xDF, 0xDC, 0x22, 0x8F, 
+0x6D, 0xDD, 0x9A, 0xE5, 0xC4, 0xDA, 0x7D, 0x27, 
+0x77, 0xBB, 0xD6, 0x7D, 0xCC, 0x9A, 0x70, 0x1C, 
+0xDA, 0x70, 0xAF, 0x58, 0x2E, 0x77, 0x96, 0x3C, 
+0x80, 0x08, 0x4A, 0x3F, 0x53, 0x74, 0xFB, 0xF1, 
+0x91, 0x7E, 0x86, 0xF0, 0xE6, 0x78, 0x72, 0x5E, 
+0x9B, 
+
+0xB5, 0x1D, 0x88, 0x66, 0x3A, 0x57, 0x8C, 0x7B, 
+0x14, 0xFD, 0x72, 0xE1, 0x17, 0xF6, 0x8A, 0xC1, 
+0x49, 0xB4, 0x3A, 0xD5, 0x4F, 0x08, 0x64, 0x87, 
+0x04, 0xD9, 0x10, 0x84, 0x95, 0x3D, 0xC2, 0xF1, 
+0x73, 0x25, 0x25, 0xD9, 0x0B, 0xFB, 0x36, 0x82, 
+0x70, 0xEF, 0x06, 0x6D, 0xBA, 0xAB, 0x0F, 0xCE, 
+0x05, 0x92, 
+
+0x86, 0x58, 0x42, 0xD8, 0xEA, 0x81, 0x8C, 0x14, 
+0x09, 0x3E, 0xAC, 0xFE, 0x54, 0x08, 0xD8, 0x60, 
+0x00, 0x22, 0x67, 0xC1, 0xC2, 0x87, 0xD2, 0x8C, 
+0xD9, 0x47, 0xEF, 0xE9, 0xF7, 0x6E, 0xC6, 0x4E, 
+0x04, 0x9E, 0xFF, 0xD5, 0xF5, 0x62, 0xD7, 0xC2, 
+0xA0, 0x18, 0x2B, 0xA1, 0xEA, 0xFC, 0x05, 0x3B, 
+0x79, 0x0E, 0x32, 
+
+0xA6, 0xE4, 0x76, 0xB2, 0x20, 0x45, 0xF9, 0x86, 
+0x38, 0x29, 0x35, 0xFE, 0xE1, 0x3D, 0x18, 0x17, 
+0x08, 0xFE, 0xFF, 0x4E, 0x46, 0xDF, 0x45, 0x51, 
+0x93, 0x8C, 0x86, 0xDD, 0x18, 0xD4, 0xF4, 0x1A, 
+0x7E, 0x19, 0x24, 0x17, 0x7D, 0x33, 0xE9, 0x0B, 
+0xA6, 0x3C, 0xD6, 0x7C, 0x5C, 0x53, 0xF8, 0x84, 
+0x7D, 0xE7, 0xAD, 0x95, 
+
+0xA0, 0xF5, 0x40, 0xD2, 0x05, 0x62, 0x06, 0x8F, 
+0x3D, 0x90, 0xE2, 0x09, 0xA8, 0x3F, 0x6D, 0xE0, 
+0x6A, 0x09, 0x49, 0xD0, 0x9A, 0x21, 0x55, 0x48, 
+0xED, 0xE6, 0x09, 0x25, 0x49, 0x2D, 0x27, 0xC7, 
+0x28, 0x0D, 0xCE, 0xFF, 0x28, 0xF7, 0x97, 0x38, 
+0xCB, 0x33, 0xA9, 0xAD, 0xFA, 0x58, 0xF1, 0xE2, 
+0x14, 0x49, 0xD1, 0xA0, 0xAB, 
+
+0xA7, 0x83, 0xE2, 0xC4, 0xA5, 0x2B, 0x76, 0x2D, 
+0x73, 0xE2, 0x50, 0x15, 0x01, 0x6B, 0x3B, 0x42, 
+0x5A, 0x35, 0xDB, 0x50, 0x34, 0x09, 0xAE, 0x2D, 
+0x9D, 0x7D, 0x23, 0xB7, 0x2B, 0x03, 0xC3, 0x21, 
+0x66, 0xF6, 0xCE, 0xCB, 0xFA, 0x85, 0xAE, 0xCE, 
+0xDF, 0xBE, 0x6C, 0x41, 0x27, 0x00, 0x84, 0x71, 
+0x99, 0x57, 0x17, 0xEE, 0x36, 0xD0, 
+
+0xE7, 0x93, 0x7A, 0x41, 0xF3, 0x3C, 0xA8, 0xC3, 
+0x9E, 0x8A, 0x5A, 0x21, 0x5C, 0xF1, 0x39, 0xA1, 
+0x92, 0x51, 0xC0, 0x56, 0x6D, 0x01, 0x4C, 0x45, 
+0x06, 0x8B, 0xE7, 0x9D, 0x4C, 0x6F, 0x2B, 0x2D, 
+0x8B, 0x44, 0x84, 0xF4, 0x04, 0x30, 0x21, 0xAB, 
+0x34, 0x93, 0x11, 0x62, 0xA9, 0x97, 0xCC, 0x32, 
+0x8B, 0x75, 0xBD, 0xAC, 0x1B, 0xB3, 0x51, 
+
+0x82, 0x38, 0x53, 0x00, 0x6C, 0xBD, 0x1F, 0xAD, 
+0x78, 0xE9, 0x06, 0x4C, 0xD1, 0xB3, 0xE4, 0xFA, 
+0xE5, 0xBF, 0x61, 0xB8, 0xFD, 0x42, 0x95, 0x8E, 
+0x84, 0x9C, 0xD1, 0x3D, 0xF9, 0x1F, 0x78, 0x57, 
+0xB0, 0xF1, 0x35, 0x45, 0xFE, 0xF3, 0xF6, 0x1B, 
+0xA9, 0xF2, 0xB3, 0xE5, 0x53, 0x51, 0xC0, 0xD4, 
+0x7F, 0x25, 0x5F, 0xB7, 0xCA, 0xB4, 0x38, 0xDF, 
+
+0xD1, 0x8B, 0x0F, 0xF9, 0xE2, 0x23, 0x79, 0x7A, 
+0x60, 0x49, 0xCF, 0x48, 0xB3, 0x4C, 0x2F, 0xDD, 
+0x71, 0x5B, 0xC5, 0x1D, 0xA5, 0x2C, 0x8C, 0xF7, 
+0xCC, 0xF6, 0xF8, 0x4D, 0x3B, 0x30, 0x55, 0x67, 
+0xA8, 0x43, 0xD9, 0x1C, 0x32, 0x38, 0x70, 0x7B, 
+0x77, 0x42, 0xBC, 0xA1, 0x11, 0xCA, 0xA1, 0x41, 
+0xED, 0xD2, 0xFF, 0xF9, 0x3C, 0xC7, 0xCF, 0x1B, 
+0x66, 
+
+0x29, 0xF1, 0x11, 0x52, 0x69, 0xC6, 0x28, 0x14, 
+0x3A, 0xA2, 0x1E, 0xCA, 0x65, 0x3F, 0x53, 0x42, 
+0x40, 0x60, 0xD7, 0x2D, 0x9A, 0xB7, 0x72, 0x4E, 
+0x20, 0xB6, 0xA9, 0xE9, 0x6F, 0xBB, 0x9D, 0x0C, 
+0xC7, 0xB4, 0xB3, 0x48, 0xB1, 0x4A, 0x6B, 0xB8, 
+0x85, 0x16, 0x84, 0x9D, 0x80, 0xD7, 0x02, 0x91, 
+0xC6, 0xBC, 0x76, 0x96, 0xEB, 0x57, 0x77, 0x66, 
+0x92, 0xDF, 
+
+0xD8, 0xDF, 0x4A, 0x44, 0xB4, 0x43, 0xD0, 0x84, 
+0x6E, 0x35, 0x73, 0xD0, 0xBF, 0x55, 0x77, 0x66, 
+0xDF, 0xFA, 0xC0, 0x5C, 0x42, 0x8F, 0x1F, 0xB6, 
+0x22, 0x2D, 0x68, 0x00, 0x75, 0x42, 0x12, 0x4A, 
+0xB7, 0x38, 0xDD, 0x32, 0xB7, 0x80, 0x61, 0x9B, 
+0xBA, 0x5F, 0x2D, 0xFB, 0x2D, 0x68, 0x87, 0xE8, 
+0x9C, 0xF3, 0x7B, 0x1E, 0x47, 0xD8, 0xC0, 0x9B, 
+0x71, 0x94, 0xAA, 
+
+0x7C, 0x7B, 0x78, 0x9A, 0xB6, 0xCC, 0xA9, 0xEA, 
+0x6B, 0x85, 0xA8, 0x84, 0xF9, 0xB4, 0x5E, 0x58, 
+0x4D, 0x69, 0x27, 0xA4, 0xB3, 0x0F, 0xA7, 0x78, 
+0xE7, 0x6C, 0x22, 0xCA, 0x45, 0xAC, 0xCF, 0xEC, 
+0x0D, 0xB0, 0xC7, 0x90, 0x1C, 0xC2, 0x6B, 0x76, 
+0x45, 0xDF, 0x9B, 0x50, 0xBA, 0x97, 0x24, 0xA0, 
+0xBB, 0x40, 0x31, 0xF4, 0x5B, 0xB1, 0xB7, 0xC9, 
+0xC4, 0xA7, 0x3A, 0x74, 
+
+0xE2, 0xFC, 0x9C, 0x28, 0xF5, 0x5F, 0x53, 0xFD, 
+0xB0, 0x1E, 0x77, 0x0E, 0xB9, 0x6D, 0x7C, 0xF6, 
+0xB5, 0x70, 0x49, 0x06, 0x92, 0x5E, 0x06, 0xE5, 
+0x29, 0x18, 0xAD, 0x4B, 0x2E, 0x25, 0xC2, 0xE2, 
+0xE6, 0x8C, 0xE5, 0xBB, 0x25, 0xDE, 0x98, 0x59, 
+0x5F, 0xAA, 0x32, 0x39, 0xB0, 0xBD, 0x48, 0x6E, 
+0xD2, 0x3E, 0x54, 0x44, 0x3F, 0xA4, 0x7C, 0x2B, 
+0x5A, 0x01, 0xD4, 0x6A, 0x05, 
+
+0xC5, 0x92, 0x1D, 0x0F, 0xEC, 0x6A, 0x37, 0x45, 
+0x77, 0x1D, 0x8E, 0xDF, 0x2E, 0x54, 0xE7, 0x9F, 
+0x86, 0xA3, 0xE0, 0x0D, 0x27, 0xAE, 0xB4, 0x4C, 
+0x29, 0xA0, 0x09, 0x19, 0x53, 0xE2, 0x19, 0xDD, 
+0xF7, 0x93, 0x54, 0xA0, 0x7F, 0xC4, 0xB2, 0x4C, 
+0x46, 0xF0, 0x8E, 0x40, 0x6A, 0x89, 0xE6, 0xEC, 
+0x95, 0xA1, 0xC7, 0x0F, 0x53, 0xA0, 0xF8, 0x12, 
+0xFA, 0xC9, 0xB4, 0x7D, 0xD7, 0x86, 
+
+0x7E, 0xCB, 0x6B, 0x25, 0xC1, 0xA2, 0x3F, 0xB1, 
+0xC2, 0xB0, 0xC0, 0xA4, 0xE1, 0xF8, 0x4D, 0x3A, 
+0x9E, 0xC3, 0x3F, 0x14, 0xA2, 0xFE, 0x01, 0xAF, 
+0xE8, 0x40, 0x07, 0x91, 0x0B, 0xFF, 0x0E, 0xA0, 
+0xCF, 0x39, 0xF1, 0x05, 0x1C, 0xF6, 0x7A, 0xC4, 
+0xB5, 0x9B, 0xEA, 0xB9, 0x7C, 0xFB, 0xCE, 0x58, 
+0xF6, 0x7B, 0x46, 0x5D, 0xC0, 0xF3, 0xE6, 0xCA, 
+0x1A, 0x83, 0x00, 0x85, 0xA9, 0x5F, 0x3F, 
+
+0x92, 0x77, 0x04, 0x35, 0x28, 0xD5, 0xA4, 0x39, 
+0x0F, 0x1C, 0x04, 0x2E, 0x42, 0xA6, 0x57, 0x55, 
+0x7A, 0xE2, 0x35, 0x34, 0x54, 0x2D, 0x19, 0xE9, 
+0x87, 0xCF, 0xD4, 0x14, 0x67, 0x97, 0x3B, 0x5D, 
+0xF7, 0xA3, 0x04, 0x8F, 0xFB, 0x1B, 0xB0, 0x2F, 
+0x3A, 0x79, 0x1C, 0x6C, 0xEC, 0x41, 0x8E, 0xD5, 
+0x0C, 0xCA, 0x88, 0xD3, 0xF4, 0xDE, 0x17, 0xD9, 
+0xD5, 0x06, 0x20, 0x93, 0xDB, 0x73, 0x17, 0x28, 
+
+0x42, 0x83, 0x8D, 0x8F, 0x08, 0x64, 0x7D, 0x48, 
+0x5A, 0x5A, 0xFC, 0x65, 0x89, 0xC0, 0x8A, 0xEA, 
+0xBB, 0xCD, 0xB6, 0x6E, 0x8D, 0x27, 0xB6, 0xF9, 
+0xE2, 0x2B, 0xBE, 0x91, 0xF9, 0x7C, 0xAF, 0xA8, 
+0x5A, 0xC8, 0x33, 0x68, 0xD5, 0xEE, 0x21, 0x71, 
+0xB1, 0xAE, 0x02, 0x06, 0xAE, 0xD4, 0xF1, 0x97, 
+0x61, 0xB9, 0x81, 0x44, 0x47, 0x49, 0xBE, 0x9E, 
+0x4B, 0x31, 0x2B, 0x58, 0x24, 0xD3, 0x81, 0x78, 
+0x30, 
+
+0x74, 0x9D, 0x59, 0x07, 0x25, 0xB2, 0x79, 0xAD, 
+0x4E, 0x9D, 0x5E, 0x25, 0x21, 0x5C, 0x0C, 0xF3, 
+0xA4, 0xF2, 0x77, 0x13, 0x93, 0x41, 0xDB, 0x34, 
+0xF5, 0x25, 0xE5, 0x2F, 0x99, 0xD4, 0x02, 0xFC, 
+0x0A, 0x8F, 0xF1, 0xC7, 0xE3, 0x3E, 0xC9, 0x6D, 
+0xBC, 0x37, 0x7E, 0xE5, 0x25, 0x3D, 0x39, 0xA8, 
+0x75, 0x5A, 0x55, 0x16, 0xF5, 0x51, 0x74, 0x7A, 
+0xA2, 0xB3, 0xE5, 0x9B, 0xD2, 0xCA, 0x83, 0xFD, 
+0x67, 0x08, 
+
+0x34, 0x4D, 0xDA, 0x24, 0x4A, 0x5E, 0xE7, 0x28, 
+0x73, 0x51, 0xA7, 0x33, 0xD0, 0x73, 0x7F, 0x2A, 
+0x1A, 0x4A, 0xCA, 0x02, 0xCE, 0xAF, 0x82, 0x5D, 
+0xE0, 0x82, 0x21, 0xDE, 0x28, 0x8E, 0x76, 0x5B, 
+0x89, 0xE1, 0xB6, 0x75, 0x55, 0x22, 0x76, 0x33, 
+0xB4, 0x4B, 0x26, 0x7F, 0x8C, 0x83, 0x7B, 0x48, 
+0x35, 0x0D, 0x59, 0x6C, 0xB1, 0xC9, 0x44, 0x97, 
+0xD1, 0xE8, 0x37, 0x21, 0xA7, 0x1D, 0x9E, 0x5B, 
+0x05, 0x82, 0x4F, 
+
+0x31, 0x6A, 0x45, 0x86, 0x07, 0x5C, 0x02, 0x97, 
+0x7E, 0x92, 0x3C, 0x0A, 0xC0, 0x59, 0xB5, 0xEB, 
+0x28, 0xB8, 0x31, 0xA4, 0x21, 0x22, 0x54, 0x8E, 
+0xE3, 0x89, 0xBB, 0x35, 0xA0, 0x6C, 0xB5, 0x32, 
+0x3F, 0x28, 0x5A, 0xDB, 0x95, 0x64, 0x42, 0x55, 
+0x46, 0x3D, 0xAA, 0x0D, 0x5F, 0x76, 0x86, 0xE4, 
+0x08, 0x29, 0x9D, 0x66, 0x7F, 0xDA, 0x06, 0x0C, 
+0x02, 0x6C, 0x3C, 0x7C, 0x65, 0x44, 0x63, 0x82, 
+0xCE, 0x40, 0x34, 0x6D, 
+
+0xC8, 0x28, 0xB4, 0x40, 0x77, 0x96, 0x8B, 0xFC, 
+0x20, 0x93, 0xBF, 0x2D, 0xB7, 0x79, 0x84, 0x88, 
+0x4D, 0x0F, 0xAA, 0x78, 0x90, 0x44, 0x4A, 0xED, 
+0x15, 0xAC, 0x08, 0xC8, 0x8D, 0x3C, 0x50, 0xD6, 
+0x6F, 0x21, 0xAB, 0xFB, 0x40, 0x73, 0x0A, 0x13, 
+0x35, 0xE0, 0xED, 0x9C, 0x99, 0x7A, 0x81, 0xA4, 
+0xE3, 0x52, 0x77, 0x25, 0xCA, 0xB5, 0xD6, 0xC8, 
+0x96, 0x61, 0x7B, 0xC3, 0x14, 0xAE, 0xEA, 0xAE, 
+0xC2, 0x17, 0xDC, 0xDE, 0xF4, 
+
+0x96, 0x58, 0x97, 0x47, 0x20, 0xB4, 0x8F, 0xDF, 
+0x31, 0xD6, 0x0E, 0x5C, 0x97, 0x32, 0x0E, 0xE9, 
+0xA3, 0xE5, 0x09, 0xD3, 0x09, 0xA6, 0xF7, 0x5B, 
+0xCA, 0xD0, 0x9C, 0xEF, 0x75, 0xB8, 0x9C, 0xE8, 
+0x4F, 0x70, 0x99, 0x29, 0x44, 0xC6, 0x4E, 0x0E, 
+0xC6, 0x28, 0x7B, 0x6A, 0x92, 0x6B, 0xEA, 0xDD, 
+0xB7, 0x43, 0x46, 0x83, 0x37, 0xAF, 0x3D, 0x92, 
+0x09, 0xF6, 0xF8, 0xFD, 0xD8, 0x18, 0xC9, 0x9B, 
+0x81, 0xC5, 0x5F, 0xE9, 0x7C, 0x19, 
+
+0x89, 0x7E, 0xFB, 0xAF, 0xF3, 0x8E, 0xC8, 0x71, 
+0x25, 0x01, 0x9E, 0x07, 0xB7, 0x76, 0x84, 0xF7, 
+0x19, 0x57, 0x36, 0xE4, 0x3A, 0xCA, 0xCE, 0xEA, 
+0x3F, 0xCF, 0x28, 0x01, 0xDB, 0xD3, 0xCC, 0x7F, 
+0xE3, 0xB3, 0x31, 0x33, 0x86, 0x8C, 0x83, 0x4F, 
+0xB8, 0xD4, 0x91, 0xA6, 0x9A, 0xD1, 0x4F, 0xDA, 
+0xCA, 0x08, 0x79, 0xA0, 0x34, 0x7F, 0x69, 0x80, 
+0x48, 0x9B, 0x2E, 0x38, 0x05, 0x95, 0x1D, 0xC9, 
+0xBB, 0x45, 0xFA, 0xC7, 0xFD, 0x36, 0xFF, 
+
+0xE5, 0xB5, 0x0F, 0xF9, 0xC7, 0x4A, 0x8B, 0xC5, 
+0x52, 0x9C, 0xF3, 0xA0, 0x63, 0x07, 0xB4, 0x75, 
+0xB8, 0x62, 0xDC, 0x4E, 0x66, 0xE8, 0x35, 0x74, 
+0x10, 0x2A, 0xC6, 0x29, 0x51, 0x8D, 0x97, 0xBB, 
+0x24, 0x06, 0xFB, 0xCF, 0x54, 0xC1, 0x05, 0xBC, 
+0x54, 0xD7, 0x1E, 0x51, 0x3D, 0xB6, 0xE5, 0x5C, 
+0xF7, 0xC3, 0x3F, 0x67, 0x2E, 0x57, 0xCF, 0x0E, 
+0xEF, 0x1A, 0x01, 0xDA, 0x27, 0xA2, 0x31, 0xCA, 
+0xBB, 0x31, 0xE0, 0x2D, 0x52, 0xA8, 0xC3, 0x2D, 
+
+0x1C, 0x46, 0x31, 0xCC, 0xF2, 0x26, 0xBB, 0x7A, 
+0x81, 0xD8, 0x1D, 0x34, 0xCE, 0x4A, 0x77, 0xDB, 
+0x41, 0x44, 0x97, 0x37, 0x98, 0xFD, 0x3A, 0x6C, 
+0x2D, 0xEB, 0x6C, 0xE7, 0xDD, 0x8D, 0x10, 0xA1, 
+0x57, 0x3A, 0x6A, 0x6E, 0x3E, 0x08, 0xF0, 0x0E, 
+0xD6, 0x52, 0x16, 0x8D, 0x93, 0x73, 0x80, 0xAB, 
+0x3F, 0x5D, 0xCC, 0x64, 0xD9, 0xB4, 0x10, 0xFD, 
+0xB2, 0x81, 0xFD, 0xD7, 0xB6, 0x6B, 0x59, 0x09, 
+0xBF, 0xD3, 0x08, 0xB6, 0x9C, 0x72, 0x29, 0x2E, 
+0x29, 
+
+0xE4, 0x27, 0x36, 0x57, 0x60, 0xE8, 0x67, 0xC9, 
+0x70, 0xD6, 0x41, 0x7E, 0x90, 0xD0, 0xCF, 0x2F, 
+0x2F, 0xCE, 0x85, 0x74, 0x36, 0x64, 0xB3, 0xC1, 
+0x19, 0xBB, 0x65, 0xCA, 0xC0, 0x20, 0xC6, 0x02, 
+0x20, 0x7F, 0xF3, 0x08, 0xCF, 0x45, 0x9D, 0xF7, 
+0xD5, 0xE0, 0x71, 0xC9, 0x37, 0x90, 0xBB, 0x46, 
+0xE0, 0x31, 0xF7, 0x04, 0xD5, 0x93, 0x2E, 0x74, 
+0x89, 0xC3, 0x00, 0x24, 0x4D, 0x06, 0xA3, 0x18, 
+0x2F, 0xA5, 0xF5, 0x5F, 0xAC, 0x10, 0x74, 0xE5, 
+0x69, 0xC8, 
+
+0xC7, 0xC5, 0x54, 0xCE, 0x65, 0x9F, 0x41, 0x51, 
+0xC6, 0xBA, 0xFC, 0xE2, 0xF7, 0x5E, 0x46, 0xED, 
+0x6E, 0x07, 0x28, 0xA4, 0x51, 0xDC, 0x10, 0x70, 
+0xBE, 0xDF, 0xD2, 0xA1, 0x01, 0x41, 0xDF, 0xAD, 
+0x0D, 0xB9, 0xBF, 0x37, 0x57, 0xC2, 0xAE, 0x12, 
+0x27, 0x53, 0x33, 0x7E, 0x98, 0x0E, 0x30, 0x3E, 
+0xA9, 0x37, 0x7D, 0x0F, 0x8C, 0xB9, 0x7C, 0x7C, 
+0x0F, 0x89, 0xFB, 0x3A, 0xF5, 0x04, 0x72, 0xCD, 
+0xB1, 0xE1, 0x51, 0x16, 0xF3, 0xD2, 0x26, 0x63, 
+0xD2, 0xE0, 0xAB, 
+
+0x82, 0x2B, 0x96, 0x54, 0x1D, 0x10, 0x1B, 0xB6, 
+0x00, 0x17, 0xCA, 0x7A, 0x0D, 0x73, 0xB8, 0x3B, 
+0xA8, 0x81, 0xD1, 0x88, 0x14, 0x11, 0xF0, 0x46, 
+0xE2, 0x25, 0x68, 0x94, 0xB2, 0x2B, 0xBC, 0xB6, 
+0x1A, 0xFF, 0x4E, 0xB5, 0x07, 0x20, 0x5C, 0x86, 
+0xD2, 0x31, 0x3C, 0x75, 0xC3, 0x93, 0x49, 0x0C, 
+0xAC, 0x11, 0xF0, 0xB8, 0x2B, 0xAD, 0x6E, 0x57, 
+0xC3, 0xB2, 0x85, 0x18, 0xA1, 0x5C, 0x85, 0x90, 
+0xEF, 0x37, 0xF8, 0xC8, 0x13, 0x20, 0x5B, 0x7B, 
+0x4A, 0x8C, 0x8C, 0x76, 
+
+0xC5, 0x98, 0x2B, 0x05, 0x46, 0xBE, 0x0F, 0x2C, 
+0x6F, 0x51, 0x0B, 0x81, 0xFA, 0x96, 0xC4, 0x70, 
+0x96, 0x89, 0x16, 0x03, 0xA5, 0x64, 0xE5, 0xA5, 
+0xA5, 0x1E, 0x02, 0x4D, 0xF1, 0x1B, 0xC2, 0xCC, 
+0x1F, 0xF0, 0x13, 0x4A, 0xE9, 0xF1, 0x9C, 0x31, 
+0xEC, 0xC6, 0x2C, 0xB9, 0xD2, 0x90, 0xD6, 0xD4, 
+0x20, 0x21, 0xFD, 0xF9, 0x34, 0xEF, 0x72, 0x55, 
+0x49, 0xE9, 0x8C, 0xAE, 0xDA, 0xDE, 0x92, 0xAE, 
+0x7B, 0x7F, 0xEE, 0x51, 0xA2, 0xB6, 0xD9, 0x93, 
+0x9E, 0x18, 0xA4, 0x97, 0x89, 
+
+0x6C, 0x0B, 0xC9, 0x35, 0xBB, 0x06, 0x90, 0xA8, 
+0xEF, 0x5E, 0x2A, 0x4E, 0x7A, 0xB4, 0x7C, 0x76, 
+0x64, 0x53, 0x16, 0x8E, 0x97, 0x1A, 0xE5, 0x3F, 
+0x7E, 0xED, 0x7D, 0x39, 0x2E, 0xC7, 0x12, 0x80, 
+0x07, 0x3C, 0xE7, 0x37, 0x1B, 0xDE, 0x81, 0x3A, 
+0x32, 0xFE, 0x99, 0x61, 0x9B, 0xF2, 0x0F, 0x21, 
+0xD2, 0x87, 0x03, 0xF0, 0x49, 0xCA, 0xEA, 0xE0, 
+0x0F, 0x52, 0xE1, 0xF5, 0xE8, 0x04, 0xFE, 0xD8, 
+0x64, 0x8D, 0xFC, 0x20, 0x69, 0xEC, 0x93, 0x9B, 
+0xC6, 0xA1, 0x0F, 0x47, 0x21, 0x92, 
+
+0x30, 0x5E, 0x96, 0xD7, 0xCB, 0x85, 0xB7, 0x0E, 
+0x80, 0xFD, 0x8C, 0x8F, 0xAF, 0x5B, 0xA1, 0x45, 
+0x7D, 0x2F, 0x04, 0x5B, 0x3D, 0xE0, 0x46, 0xF2, 
+0x73, 0xFD, 0x36, 0x4D, 0xC3, 0xB8, 0x19, 0x11, 
+0x49, 0xB4, 0xD7, 0x33, 0xBD, 0x7A, 0xE0, 0x26, 
+0x92, 0x65, 0xAB, 0xAB, 0x5A, 0x3B, 0x63, 0x59, 
+0x58, 0x73, 0x08, 0xB2, 0xC4, 0x8C, 0x29, 0x96, 
+0xB4, 0x4A, 0x79, 0xF6, 0x2C, 0xE7, 0x1F, 0x0E, 
+0x97, 0x3B, 0xD3, 0x4C, 0x27, 0x5C, 0x16, 0x0C, 
+0x95, 0x3C, 0xA8, 0x80, 0x44, 0x19, 0x0C, 
+
+0xAE, 0xE2, 0xA9, 0xB3, 0x12, 0x2A, 0xA8, 0x2B, 
+0x2E, 0x44, 0x36, 0x58, 0xDC, 0x81, 0x10, 0xDC, 
+0x32, 0x4C, 0xF4, 0xB1, 0xAA, 0x34, 0x63, 0x6B, 
+0x4C, 0x42, 0xB3, 0x0F, 0x2E, 0x9C, 0x34, 0x3B, 
+0xC6, 0xC1, 0xD4, 0x99, 0x27, 0x14, 0xC6, 0xDF, 
+0x28, 0x29, 0x7C, 0xA2, 0xB4, 0x58, 0x99, 0x3A, 
+0x8F, 0x2E, 0xA5, 0x3C, 0xCD, 0xA2, 0x4E, 0x40, 
+0x64, 0xCC, 0x62, 0xC3, 0xF9, 0x4F, 0x44, 0x22, 
+0x09, 0x6A, 0x04, 0x52, 0xC0, 0x21, 0x80, 0x70, 
+0xC2, 0x1D, 0xC1, 0x71, 0x32, 0x7E, 0x4A, 0xC2, 
+
+0xD5, 0x51, 0x1B, 0xB9, 0x08, 0x38, 0x28, 0xB1, 
+0xB0, 0x20, 0xF1, 0xA5, 0x0C, 0x2E, 0x29, 0x7C, 
+0x4E, 0x1A, 0x6A, 0xDC, 0x93, 0x64, 0x33, 0xF6, 
+0x6C, 0xA8, 0xDF, 0xBE, 0xF4, 0xD5, 0x00, 0x39, 
+0x8B, 0x26, 0x26, 0xC3, 0x5C, 0x23, 0xD0, 0x49, 
+0x80, 0x05, 0x71, 0xE8, 0x46, 0x1C, 0x4C, 0xE8, 
+0x90, 0x39, 0x1A, 0x5B, 0x16, 0x65, 0x48, 0xA3, 
+0x7C, 0x8E, 0x31, 0xFD, 0x69, 0xA2, 0x88, 0x55, 
+0x22, 0x60, 0x5C, 0xDB, 0x75, 0xDB, 0xDA, 0x12, 
+0x12, 0xC4, 0x3D, 0x08, 0x44, 0xA1, 0x79, 0x3D, 
+0x6C, 
+
+0x46, 0xF1, 0xBD, 0x06, 0xC8, 0x40, 0xE0, 0x52, 
+0x8A, 0xB8, 0x16, 0x3D, 0xBE, 0x3D, 0xF5, 0x84, 
+0xF6, 0xD1, 0xFD, 0x2F, 0xBF, 0x48, 0xB8, 0x6F, 
+0x12, 0xD9, 0x5B, 0xC1, 0x1F, 0x49, 0xB6, 0x9F, 
+0x6A, 0x2E, 0x51, 0xDB, 0xD8, 0x39, 0xCE, 0x22, 
+0x69, 0x79, 0x6A, 0xA7, 0xE1, 0x14, 0x05, 0x4D, 
+0xDA, 0x03, 0xB0, 0xB4, 0xB5, 0xA4, 0x12, 0xC1, 
+0x2E, 0xA3, 0xDF, 0x71, 0x95, 0x74, 0x33, 0xBF, 
+0x98, 0x74, 0x48, 0x6F, 0x8C, 0xA6, 0x92, 0x66, 
+0x60, 0xA5, 0xED, 0x51, 0xEF, 0x99, 0xB9, 0x21, 
+0xE9, 0x9E, 
+
+0xA7, 0x81, 0x67, 0x01, 0x4E, 0x5D, 0xDC, 0x82, 
+0xFE, 0xB4, 0xB2, 0x81, 0xDE, 0x38, 0xF3, 0x21, 
+0x60, 0xF8, 0x50, 0xA9, 0xCD, 0x40, 0xC3, 0x50, 
+0xE2, 0xFA, 0xF1, 0x98, 0x8D, 0x9E, 0xB1, 0xEE, 
+0x4A, 0x46, 0x9A, 0x44, 0x3E, 0x09, 0x37, 0xDA, 
+0x17, 0xBE, 0x27, 0xF8, 0x3C, 0x66, 0x87, 0x27, 
+0xB2, 0xDE, 0x66, 0xCA, 0x10, 0x2C, 0x82, 0x7C, 
+0xF1, 0x65, 0x65, 0xC8, 0x10, 0x71, 0x98, 0x75, 
+0x40, 0x8B, 0xEB, 0x2A, 0x2F, 0xE5, 0xF9, 0x1D, 
+0xBB, 0x28, 0x4D, 0xBC, 0x9B, 0xA9, 0x57, 0x07, 
+0xA0, 0x61, 0xB1, 
+
+0x4B, 0xA1, 0x03, 0x50, 0x29, 0x01, 0x7E, 0x3F, 
+0x6E, 0x3B, 0x2B, 0x23, 0x8E, 0xF9, 0x01, 0x13, 
+0x88, 0xDE, 0x99, 0x02, 0xC8, 0x04, 0x94, 0xBD, 
+0xC6, 0x82, 0x3C, 0x14, 0xE3, 0x34, 0x73, 0x68, 
+0xEC, 0x2C, 0x63, 0x40, 0x8C, 0x7B, 0x29, 0x61, 
+0xC6, 0x40, 0x43, 0x87, 0xBE, 0xC8, 0x88, 0xC9, 
+0x1C, 0x97, 0x72, 0x0C, 0x0E, 0x5D, 0x69, 0xB2, 
+0x2B, 0x55, 0x5D, 0x35, 0x20, 0x08, 0x06, 0x02, 
+0x65, 0xB6, 0xD7, 0xE1, 0x47, 0x7A, 0x6C, 0xBD, 
+0xCF, 0x88, 0xA7, 0x44, 0x68, 0x8F, 0xCF, 0x51, 
+0x8B, 0x08, 0xC0, 0x55, 
+
+0x3A, 0x20, 0x97, 0x50, 0x7D, 0x05, 0x88, 0x61, 
+0xE0, 0xE6, 0xD8, 0xDC, 0x75, 0x5E, 0xAD, 0x46, 
+0x95, 0x7E, 0xD5, 0xA5, 0xAF, 0x92, 0x93, 0xDA, 
+0x4D, 0xD8, 0x29, 0x91, 0xAF, 0x96, 0x40, 0x5E, 
+0x7A, 0xD6, 0xE6, 0xB1, 0x65, 0xAB, 0xB0, 0x7D, 
+0x4D, 0xF7, 0xE8, 0x9B, 0x97, 0xB1, 0x61, 0x97, 
+0x21, 0x04, 0x60, 0x12, 0xCF, 0xA4, 0xA4, 0xDA, 
+0x67, 0x1B, 0x9A, 0x7E, 0x55, 0x34, 0x85, 0xCC, 
+0x22, 0x2F, 0x9D, 0xEC, 0x75, 0x9D, 0x50, 0xCB, 
+0xE1, 0x3A, 0x55, 0x4D, 0x43, 0xDB, 0xC1, 0x28, 
+0x48, 0x03, 0xC6, 0xA1, 0xDF, 
+
+0x21, 0x6D, 0x82, 0x29, 0xA3, 0xB1, 0xBF, 0xDC, 
+0x6F, 0x02, 0xBF, 0xE7, 0xE2, 0xF4, 0xC6, 0x0B, 
+0x59, 0x42, 0x7F, 0x67, 0xF9, 0x4A, 0x79, 0x7A, 
+0x58, 0x7E, 0xA3, 0x2D, 0x9E, 0x30, 0x34, 0x14, 
+0xCD, 0xB8, 0x88, 0xDB, 0x65, 0x94, 0x0B, 0xE6, 
+0x6B, 0x0C, 0xC1, 0xD0, 0x02, 0xAE, 0x72, 0x45, 
+0x4A, 0x26, 0x98, 0xFD, 0xAF, 0xD9, 0x44, 0x8E, 
+0x24, 0x02, 0xD0, 0xC9, 0x4F, 0xDC, 0xB2, 0xEA, 
+0xE6, 0xF9, 0xEE, 0x9D, 0x54, 0x4E, 0xE1, 0x8A, 
+0xED, 0x54, 0xF7, 0x3D, 0xB7, 0x68, 0xF4, 0x4E, 
+0xA1, 0x53, 0xBD, 0xBB, 0x57, 0x8A, 
+
+0x1E, 0xE1, 0xDB, 0x5F, 0x59, 0xEF, 0x5A, 0x91, 
+0x6D, 0x97, 0xF0, 0x6F, 0x01, 0x8E, 0x84, 0x90, 
+0x57, 0x10, 0x3D, 0x7D, 0xD5, 0x4D, 0xE1, 0x6D, 
+0xED, 0x36, 0x43, 0xDA, 0x04, 0x02, 0xF7, 0x71, 
+0xB7, 0xA9, 0x08, 0x06, 0xCF, 0x99, 0x8F, 0xE7, 
+0x96, 0x8E, 0xC5, 0xEF, 0xBF, 0xC9, 0x07, 0x50, 
+0x68, 0xE3, 0x50, 0xED, 0xC9, 0xCE, 0x64, 0xAA, 
+0xC9, 0x6C, 0x20, 0x60, 0x3B, 0x2C, 0xC8, 0x54, 
+0xB1, 0x64, 0xFF, 0x3F, 0x43, 0xBA, 0x0A, 0x57, 
+0xE3, 0xF2, 0x86, 0x76, 0x50, 0xAD, 0x9B, 0xE0, 
+0xED, 0xF4, 0x1D, 0x5E, 0xB4, 0xE3, 0x17, 
+
+0x9E, 0x05, 0x51, 0xE2, 0x03, 0x9B, 0xE9, 0x47, 
+0x29, 0x5B, 0x1F, 0x43, 0x8F, 0xB8, 0x36, 0x93, 
+0xFA, 0x79, 0x3C, 0x21, 0xC3, 0x32, 0x41, 0xC5, 
+0xEB, 0x14, 0x41, 0x94, 0x7B, 0x99, 0x8D, 0x3C, 
+0x4B, 0xA5, 0xA2, 0xE7, 0x50, 0x4F, 0xC2, 0xFD, 
+0x8F, 0x65, 0x53, 0x1F, 0xAA, 0xB7, 0xAA, 0xAA, 
+0x31, 0xF4, 0xA7, 0xA0, 0x57, 0xE3, 0xA3, 0x50, 
+0x5C, 0xDF, 0x45, 0x06, 0x24, 0x8D, 0x23, 0x94, 
+0x46, 0xF2, 0xD1, 0xEF, 0xA2, 0xFB, 0x54, 0xAF, 
+0x25, 0x88, 0x40, 0xEC, 0x22, 0x76, 0x0D, 0x0D, 
+0xB3, 0x30, 0x66, 0x46, 0x78, 0x60, 0xBA, 0x0C, 
+
+0xDE, 0xC1, 0x20, 0x38, 0xE1, 0x41, 0xC6, 0x9C, 
+0xF4, 0xD6, 0x97, 0xF9, 0x48, 0xB5, 0xA1, 0x76, 
+0x32, 0x00, 0x99, 0x88, 0x8E, 0xA4, 0x04, 0x14, 
+0xD9, 0x78, 0xF3, 0xA9, 0xF3, 0xBB, 0xD8, 0xC0, 
+0x33, 0x0A, 0x98, 0xEA, 0x75, 0xD1, 0x25, 0xB9, 
+0x3E, 0x83, 0x56, 0x2D, 0x4A, 0x16, 0x92, 0xBC, 
+0xAF, 0xC8, 0x46, 0xFC, 0x5B, 0x6E, 0x16, 0x33, 
+0x21, 0x4F, 0x2D, 0xD9, 0x7F, 0xD1, 0x95, 0x5C, 
+0xB7, 0x41, 0xB7, 0xE0, 0x1F, 0x42, 0x8F, 0x20, 
+0x8B, 0xD3, 0xB9, 0x1A, 0x4E, 0x6E, 0x73, 0x89, 
+0xE7, 0x78, 0x3B, 0x25, 0x2B, 0xC5, 0x99, 0x2C, 
+0x8E, 
+
+0x7B, 0xBD, 0xEC, 0xA3, 0xEA, 0x04, 0x1A, 0x27, 
+0x93, 0xE8, 0x5B, 0x4F, 0x0B, 0xE9, 0x59, 0x03, 
+0x6C, 0xE4, 0xB7, 0x70, 0xA2, 0xEF, 0x4E, 0x52, 
+0x4C, 0xFD, 0x75, 0xC9, 0x72, 0xF9, 0xB3, 0x4F, 
+0x30, 0x86, 0x7D, 0xE0, 0xFC, 0xB0, 0xA2, 0xC3, 
+0xC8, 0x79, 0x63, 0xB7, 0x37, 0x27, 0xB2, 0x96, 
+0x0B, 0x58, 0xFD, 0x3D, 0x05, 0x86, 0x95, 0xA9, 
+0x5F, 0xD5, 0x82, 0xFA, 0x65, 0x6B, 0x96, 0xB4, 
+0x62, 0x4E, 0x55, 0x43, 0xF6, 0x32, 0xA3, 0xC7, 
+0xDA, 0x66, 0x13, 0x91, 0xF7, 0x6A, 0x5F, 0xF0, 
+0xC5, 0x79, 0x5B, 0x6A, 0xC8, 0xFC, 0x83, 0xD5, 
+0xD8, 0x9A, 
+
+0xC1, 0x76, 0xAA, 0x39, 0xDA, 0xC0, 0x87, 0x56, 
+0x65, 0x30, 0x5F, 0x7D, 0xE0, 0x39, 0xCB, 0xD0, 
+0x51, 0xBE, 0x26, 0x86, 0x71, 0xCB, 0x3D, 0x1B, 
+0x18, 0xFB, 0x29, 0x77, 0x36, 0x45, 0x62, 0x92, 
+0x6E, 0xEC, 0x45, 0xDC, 0x54, 0x01, 0x96, 0x6B, 
+0x90, 0x60, 0xB3, 0x6B, 0x60, 0xAB, 0x49, 0x40, 
+0x56, 0x39, 0x11, 0x95, 0xF8, 0xBC, 0xE3, 0x9E, 
+0xD8, 0x7B, 0x82, 0x38, 0x69, 0xA6, 0x3A, 0xBD, 
+0xAF, 0xAF, 0x63, 0x6A, 0x0D, 0x2F, 0x03, 0x02, 
+0x9B, 0x20, 0x42, 0x36, 0x16, 0xD9, 0x2E, 0xDD, 
+0x56, 0x0E, 0x0E, 0xDA, 0x76, 0x2D, 0x14, 0x51, 
+0xA4, 0x5E, 0x22, 
+
+0x95, 0xD7, 0x86, 0x12, 0x6D, 0xFB, 0xCF, 0xAC, 
+0xA1, 0xF7, 0x59, 0xD7, 0x21, 0xBB, 0xCE, 0x56, 
+0x8F, 0x57, 0x1E, 0x22, 0x2C, 0xE9, 0xBF, 0x71, 
+0x2C, 0x75, 0x3F, 0xAA, 0x26, 0xC5, 0xB8, 0x5C, 
+0xED, 0x0A, 0xDA, 0xB9, 0x23, 0x98, 0xBE, 0xF2, 
+0x91, 0xEF, 0x01, 0xDC, 0x70, 0x59, 0x15, 0xB5, 
+0xF4, 0x41, 0x54, 0x44, 0x4E, 0x08, 0x35, 0xE7, 
+0xB7, 0x3E, 0xE9, 0x76, 0x90, 0x92, 0xC5, 0xCA, 
+0x9E, 0x92, 0x0D, 0x46, 0x2D, 0xEB, 0xCA, 0x8C, 
+0x5D, 0x59, 0x3F, 0x82, 0x68, 0x82, 0x82, 0xCC, 
+0x18, 0x2C, 0x5E, 0x86, 0x79, 0xE0, 0xC3, 0x1D, 
+0x16, 0x8A, 0xF5, 0xB8, 
+
+0x76, 0xE4, 0xB2, 0x3A, 0x7A, 0x07, 0xF9, 0xF1, 
+0xDC, 0xDD, 0x5F, 0xD1, 0x2D, 0x93, 0x5A, 0xD9, 
+0x18, 0x26, 0xE9, 0x91, 0x39, 0x38, 0xDA, 0xF7, 
+0x00, 0x09, 0x44, 0xD9, 0xC5, 0x7D, 0xEC, 0x1F, 
+0xAA, 0xC9, 0xD5, 0xF9, 0x5F, 0x14, 0x98, 0xE1, 
+0xC1, 0x39, 0xB3, 0x72, 0xFF, 0x7C, 0x08, 0xAE, 
+0x72, 0xE7, 0x28, 0xC8, 0x8C, 0x8C, 0xAA, 0x49, 
+0x7C, 0xC2, 0xC9, 0x88, 0x11, 0xEF, 0x28, 0xB7, 
+0x16, 0x84, 0xDE, 0x02, 0xC9, 0x89, 0x3B, 0xB3, 
+0x60, 0xEB, 0xEA, 0x2E, 0x24, 0x2F, 0x0D, 0x1E, 
+0x3B, 0x33, 0x17, 0xF6, 0x82, 0x6C, 0x83, 0xCF, 
+0x4A, 0x62, 0xD5, 0x46, 0x5D, 
+
+0xEE, 0x66, 0xEC, 0xFD, 0x84, 0xEA, 0x80, 0xC4, 
+0x55, 0x08, 0xE0, 0x97, 0x19, 0xB1, 0x10, 0x2D, 
+0x57, 0x06, 0x39, 0x91, 0x10, 0xD8, 0x9D, 0x83, 
+0x8F, 0xEB, 0xB7, 0xB2, 0xE7, 0xA5, 0xF8, 0x3F, 
+0x80, 0xF3, 0xA8, 0x1B, 0x8A, 0x07, 0xBA, 0xCF, 
+0xFB, 0x6E, 0x01, 0xF7, 0xCC, 0xF8, 0xA8, 0x6C, 
+0x00, 0x9A, 0x44, 0x42, 0x2A, 0x29, 0xDF, 0xB6, 
+0x8A, 0x2A, 0x07, 0xF7, 0xD3, 0x60, 0x8E, 0x04, 
+0xE1, 0xE5, 0x1C, 0xDA, 0xCB, 0xE2, 0xA1, 0xA5, 
+0xA8, 0x7E, 0xC2, 0x42, 0x4A, 0x2C, 0x6E, 0x4B, 
+0x03, 0x79, 0x64, 0x6D, 0xE8, 0xC8, 0x0E, 0x67, 
+0xD4, 0x0A, 0x20, 0x52, 0x98, 0xB2, 
+
+0x7A, 0x7B, 0x32, 0x63, 0x02, 0xE6, 0xD1, 0xB1, 
+0x68, 0x2A, 0x73, 0xFA, 0x22, 0x32, 0xF5, 0xDC, 
+0x73, 0x6B, 0x02, 0x2E, 0xDF, 0xFB, 0xCA, 0x2F, 
+0xFC, 0x3C, 0xFB, 0x6E, 0x11, 0xA6, 0xBA, 0x68, 
+0x9C, 0xAA, 0xEE, 0x53, 0x2A, 0xB5, 0xEC, 0x84, 
+0xE4, 0x25, 0xAE, 0xE7, 0xD7, 0x17, 0x25, 0x37, 
+0xB3, 0x82, 0x9F, 0x2F, 0xD2, 0x70, 0x2E, 0x12, 
+0x64, 0x9A, 0x2C, 0xFE, 0x22, 0xE7, 0x22, 0x7F, 
+0x32, 0x89, 0xCF, 0xAB, 0x6D, 0x73, 0xCB, 0xF2, 
+0x62, 0x02, 0x6E, 0xE7, 0xB3, 0x86, 0x29, 0x3F, 
+0x1B, 0xD4, 0x0E, 0x05, 0x83, 0x84, 0x19, 0x6B, 
+0x82, 0x70, 0x92, 0xBF, 0x0F, 0x91, 0xD4, 
+
+0x94, 0x77, 0xDA, 0x06, 0xE3, 0xC0, 0x8D, 0x7D, 
+0x62, 0x08, 0x8F, 0x83, 0x3F, 0xB3, 0xDB, 0x0A, 
+0xC9, 0x61, 0x27, 0x97, 0x44, 0xC9, 0xCC, 0x9E, 
+0xFB, 0x99, 0xA4, 0x68, 0x67, 0xA1, 0x8E, 0xED, 
+0xB9, 0x8F, 0xDD, 0xCF, 0xCF, 0x56, 0x26, 0x00, 
+0xAC, 0x6E, 0x68, 0xB2, 0x41, 0x72, 0xF8, 0xA8, 
+0xA7, 0x2E, 0xED, 0x66, 0xF9, 0xF3, 0xAA, 0x9B, 
+0x45, 0x16, 0x90, 0x81, 0x2D, 0x4A, 0x2D, 0xB1, 
+0xBC, 0xF1, 0x59, 0x8B, 0xCE, 0x95, 0x29, 0x73, 
+0xDE, 0x99, 0x0E, 0xDF, 0xF2, 0x62, 0x70, 0x9B, 
+0xA4, 0x5B, 0x50, 0x93, 0x5C, 0x44, 0x4D, 0xD7, 
+0x54, 0xEF, 0x31, 0x52, 0x11, 0xB4, 0x99, 0x92, 
+
+0x21, 0x5C, 0x0F, 0x1F, 0xC4, 0xC1, 0xC1, 0x6E, 
+0x2D, 0xB5, 0xB6, 0x54, 0xA5, 0x27, 0x87, 0x16, 
+0x3F, 0x73, 0x6B, 0xF5, 0xDB, 0xDB, 0x41, 0x75, 
+0x9D, 0x78, 0x13, 0x9F, 0x29, 0x20, 0x99, 0x7C, 
+0x75, 0xBF, 0xEC, 0x96, 0x8A, 0x48, 0xF7, 0x3C, 
+0x24, 0xD1, 0x75, 0xAE, 0xEB, 0xFB, 0x02, 0xB1, 
+0xE4, 0x23, 0x6D, 0xFD, 0x75, 0xB9, 0x76, 0xB3, 
+0xBF, 0xE2, 0x79, 0xD1, 0xDE, 0xDC, 0x35, 0x98, 
+0xF7, 0x45, 0x63, 0xA8, 0x5A, 0xA7, 0x58, 0x1E, 
+0x9C, 0x0D, 0x82, 0x87, 0x7C, 0xBF, 0x18, 0x4E, 
+0xBD, 0x91, 0x89, 0x65, 0xB4, 0x31, 0x4D, 0xF4, 
+0x77, 0x90, 0x74, 0x2C, 0x71, 0x1B, 0xBF, 0x96, 
+0xA1, 
+
+0xD1, 0x26, 0xDC, 0xD9, 0xDD, 0xFF, 0xD9, 0x1A, 
+0x25, 0xDD, 0x7C, 0x7B, 0x68, 0x33, 0x10, 0x7C, 
+0xF8, 0xFE, 0xEC, 0x22, 0x81, 0xBD, 0x01, 0x83, 
+0x00, 0xFC, 0x6D, 0x2B, 0x54, 0x13, 0x73, 0x6F, 
+0x76, 0xF0, 0x86, 0x59, 0xB7, 0x2B, 0xCF, 0x3A, 
+0x88, 0x87, 0x74, 0x80, 0xBE, 0xB5, 0x80, 0x0B, 
+0x0E, 0x68, 0xE0, 0x89, 0xE2, 0x43, 0xD9, 0xE1, 
+0x4D, 0x0D, 0x78, 0x17, 0x0C, 0x94, 0xF4, 0x78, 
+0xEF, 0xA0, 0x91, 0x7D, 0x7E, 0x85, 0x15, 0x89, 
+0x50, 0x2D, 0xF1, 0x0C, 0x1A, 0x38, 0x58, 0x51, 
+0x66, 0xF6, 0x19, 0x47, 0xD4, 0x4B, 0x71, 0xB5, 
+0x6C, 0xDD, 0x20, 0xF6, 0xB9, 0x61, 0xD9, 0x45, 
+0xAF, 0x37, 
+
+0x63, 0xBF, 0xD1, 0x3D, 0x39, 0x16, 0x61, 0x19, 
+0x80, 0xF3, 0x72, 0xFE, 0xFB, 0x1E, 0x6D, 0x44, 
+0xB0, 0xC0, 0x93, 0xB4, 0xFF, 0x15, 0x15, 0xB2, 
+0xBC, 0xF0, 0xD6, 0x33, 0x1D, 0x31, 0x81, 0x24, 
+0x2A, 0x64, 0x7A, 0xB3, 0x2C, 0x59, 0xC9, 0xD0, 
+0x27, 0x86, 0x91, 0x89, 0xF5, 0x37, 0x63, 0x37, 
+0x87, 0x02, 0xFA, 0x2D, 0xC2, 0x6E, 0xBA, 0xA8, 
+0x50, 0x75, 0xF1, 0x03, 0x3D, 0x41, 0x53, 0xA4, 
+0xB8, 0x5D, 0xCC, 0xFA, 0xAB, 0x6A, 0xB1, 0x95, 
+0xA5, 0x44, 0xC2, 0x62, 0xF1, 0x99, 0x92, 0xE0, 
+0x08, 0xFE, 0x28, 0xC7, 0xE3, 0x56, 0x36, 0xA6, 
+0x23, 0x56, 0x1D, 0x79, 0xD6, 0x1C, 0x59, 0xA9, 
+0x01, 0x17, 0xE8, 
+
+0xA3, 0xB8, 0x5E, 0x1F, 0xAD, 0xB9, 0xF4, 0x73, 
+0xB1, 0xBD, 0xE5, 0x1E, 0x7E, 0x8F, 0x5A, 0xEA, 
+0x9C, 0x55, 0x5C, 0x65, 0xF9, 0x28, 0xFA, 0x7F, 
+0x98, 0x72, 0x99, 0x30, 0x1D, 0x4C, 0x11, 0x1D, 
+0xCA, 0x99, 0xFC, 0xCF, 0x72, 0xA3, 0x37, 0x60, 
+0x08, 0x63, 0xBB, 0xAE, 0xA9, 0x4F, 0x79, 0xA9, 
+0xCB, 0x3D, 0x25, 0x6C, 0x7C, 0xAE, 0xEB, 0xF9, 
+0x74, 0x25, 0xB7, 0x42, 0xE1, 0x52, 0xF7, 0x0E, 
+0x2C, 0xC2, 0xE6, 0xA5, 0x7A, 0x65, 0xE3, 0x34, 
+0xD9, 0xFE, 0x24, 0x63, 0x24, 0x5C, 0x13, 0xF9, 
+0xCF, 0x7D, 0x61, 0x36, 0x35, 0x8A, 0x51, 0x1C, 
+0x09, 0x0C, 0xD2, 0xF6, 0x5D, 0xE2, 0x37, 0xF4, 
+0x94, 0x54, 0x69, 0xB7, 
+
+0x38, 0xFB, 0x23, 0xED, 0x4D, 0x74, 0x5A, 0xF3, 
+0x46, 0xE0, 0xE2, 0x0E, 0x4A, 0x2E, 0xB1, 0x76, 
+0x1B, 0x2B, 0xBE, 0x09, 0x43, 0x53, 0x53, 0xF1, 
+0xBB, 0x32, 0xF4, 0x5C, 0xE6, 0x93, 0x26, 0x3E, 
+0x15, 0x8F, 0x7D, 0xE5, 0xB6, 0x24, 0x40, 0xDE, 
+0xBF, 0x8B, 0xE6, 0x2D, 0x3A, 0xC9, 0x1A, 0x60, 
+0x5A, 0xC2, 0x2D, 0xCF, 0x15, 0xC3, 0x4E, 0xC5, 
+0xEA, 0x07, 0x61, 0xB7, 0x05, 0xB0, 0xD2, 0xF8, 
+0x15, 0x4D, 0x07, 0x46, 0x4E, 0xB7, 0xDF, 0x3F, 
+0xDA, 0x68, 0xA9, 0x17, 0xFD, 0x3B, 0x56, 0xAB, 
+0xC3, 0x60, 0x20, 0xF9, 0x83, 0xFC, 0xE2, 0x93, 
+0x97, 0xAD, 0x1C, 0xFD, 0xEA, 0x60, 0x4B, 0x28, 
+0xEE, 0xE5, 0x43, 0x63, 0x53, 
+
+0x0D, 0xB1, 0xDA, 0xF1, 0x20, 0x1D, 0x85, 0x0F, 
+0x15, 0x0A, 0x49, 0x37, 0x60, 0xE9, 0xA0, 0xE6, 
+0x95, 0x84, 0x98, 0xD5, 0xDF, 0x70, 0x26, 0x9A, 
+0x1D, 0x8B, 0x0D, 0x13, 0x8C, 0x1B, 0x01, 0xE8, 
+0x54, 0x80, 0x00, 0x31, 0xC3, 0x49, 0xA0, 0xD9, 
+0x15, 0x23, 0x97, 0xDC, 0xA6, 0xE8, 0xEB, 0x25, 
+0x78, 0x82, 0x5B, 0x9C, 0xFB, 0x94, 0x72, 0x47, 
+0x90, 0xE7, 0xDE, 0xD1, 0x89, 0xE9, 0x54, 0x97, 
+0x38, 0x02, 0xCE, 0x00, 0x34, 0xF2, 0xFC, 0x89, 
+0x6B, 0xCB, 0xF4, 0x7B, 0x81, 0x25, 0xB3, 0xC7, 
+0xF7, 0x2E, 0x16, 0x8A, 0x0A, 0xDF, 0x08, 0x14, 
+0xAC, 0x19, 0x7F, 0x17, 0xDD, 0x09, 0x59, 0xA5, 
+0x71, 0x3E, 0xBC, 0x9E, 0x58, 0xAE, 
+
+0xEC, 0x6E, 0x03, 0x5D, 0x66, 0x2C, 0xC2, 0xB6, 
+0x6B, 0x40, 0x8A, 0x0E, 0x2C, 0xA4, 0x72, 0x01, 
+0xDA, 0x47, 0xC8, 0xA8, 0x74, 0x74, 0x7E, 0xD1, 
+0xC4, 0x5B, 0xB5, 0xC6, 0x3E, 0xF4, 0xFA, 0x73, 
+0xB9, 0x8C, 0x30, 0xED, 0xB6, 0x0B, 0x98, 0x80, 
+0xDA, 0x41, 0xED, 0x99, 0x2B, 0x35, 0xCA, 0xA1, 
+0x66, 0xE1, 0x88, 0xA2, 0x38, 0x44, 0x91, 0x14, 
+0xE3, 0xF8, 0xA6, 0xDD, 0xE4, 0xA5, 0xF1, 0x60, 
+0x9E, 0x46, 0x15, 0xE7, 0xB1, 0x8C, 0x5F, 0x3B, 
+0x5B, 0x52, 0xA8, 0x16, 0x92, 0x1C, 0xA8, 0xC1, 
+0xCF, 0xE2, 0xC6, 0x53, 0x55, 0x76, 0x9F, 0xA0, 
+0x52, 0x9F, 0xB6, 0x61, 0x4E, 0x2F, 0xEC, 0x2A, 
+0xE3, 0x9A, 0x2E, 0x7C, 0xB7, 0x16, 0xEA, 
+
+0xDA, 0xD3, 0xB5, 0x81, 0xF8, 0x72, 0xB7, 0xD7, 
+0x83, 0x4F, 0xFF, 0x19, 0x6C, 0x37, 0x13, 0xAD, 
+0x28, 0x35, 0x1B, 0x10, 0x4B, 0x64, 0xE9, 0x03, 
+0x63, 0x63, 0x57, 0xA1, 0x36, 0x4F, 0x69, 0x36, 
+0x53, 0x92, 0xC0, 0xB2, 0x38, 0xEA, 0x52, 0x48, 
+0xBA, 0x4C, 0x15, 0xE0, 0x1A, 0x39, 0xB4, 0x4B, 
+0x99, 0x01, 0x7A, 0x8D, 0x46, 0x2F, 0x52, 0x9B, 
+0x15, 0xF5, 0x49, 0x98, 0x21, 0x65, 0x0D, 0xD0, 
+0xAC, 0xC0, 0x85, 0xB2, 0x00, 0xD5, 0x1C, 0x9B, 
+0xBE, 0x26, 0xDD, 0x84, 0xFE, 0x2B, 0x1C, 0x3F, 
+0x3B, 0x2D, 0x6E, 0xD4, 0x44, 0xFC, 0x56, 0xC5, 
+0x89, 0x4E, 0xA9, 0x28, 0xD2, 0xC0, 0x5A, 0x46, 
+0x63, 0x0B, 0x86, 0x82, 0x2F, 0x4A, 0x3F, 0x74, 
+
+0xE7, 0x0D, 0xC8, 0xA6, 0x45, 0x06, 0xCB, 0xBF, 
+0x68, 0x6B, 0x00, 0x77, 0xFD, 0x65, 0xBD, 0xD2, 
+0x27, 0xFF, 0x9D, 0x52, 0x0D, 0xA1, 0xC1, 0x98, 
+0x47, 0xA9, 0x33, 0x76, 0x91, 0xFA, 0x2D, 0x09, 
+0x3D, 0x8B, 0x77, 0xA1, 0x2A, 0x5A, 0xD9, 0x99, 
+0xA4, 0x16, 0xE2, 0x6C, 0x8B, 0x8D, 0xF8, 0x17, 
+0xD0, 0x5F, 0x33, 0x2D, 0xC9, 0x9A, 0xE2, 0x79, 
+0x2F, 0xA0, 0xEC, 0xF2, 0xC6, 0x2D, 0x56, 0x1E, 
+0x6D, 0x22, 0x2E, 0x1D, 0x16, 0x31, 0x96, 0xD4, 
+0xCD, 0x49, 0xE5, 0xFE, 0x18, 0x49, 0x3E, 0x9B, 
+0x86, 0x03, 0x68, 0xC4, 0x20, 0xC0, 0x4F, 0xBE, 
+0x34, 0x49, 0xA8, 0xAD, 0x57, 0x08, 0x0F, 0xD3, 
+0xB4, 0x2C, 0x53, 0xCE, 0x6C, 0xF9, 0x10, 0x89, 
+0x7D, 
+
+0x93, 0x46, 0x6F, 0xFA, 0x00, 0x7C, 0x46, 0x4C, 
+0x24, 0xF7, 0x11, 0xE4, 0xC3, 0x77, 0x47, 0xF8, 
+0xF9, 0x0C, 0x22, 0xEA, 0xBA, 0xD8, 0xFB, 0xFC, 
+0x8E, 0xE9, 0x99, 0x6A, 0x01, 0xD7, 0x38, 0x00, 
+0x29, 0x85, 0x61, 0xD7, 0x23, 0x93, 0xFB, 0x45, 
+0x3B, 0x6D, 0xDD, 0x43, 0x88, 0x27, 0x45, 0x67, 
+0x6D, 0xB2, 0x30, 0xD1, 0x39, 0x7F, 0x3B, 0xC7, 
+0xDC, 0x17, 0x69, 0xFD, 0xEA, 0xF2, 0x8C, 0xFA, 
+0xE3, 0x11, 0x7F, 0x64, 0xA0, 0xA5, 0x65, 0xC6, 
+0x41, 0xA7, 0x65, 0x53, 0xA8, 0x91, 0xFB, 0x94, 
+0x3A, 0x6F, 0x90, 0xF4, 0x9C, 0xB6, 0xE5, 0xE4, 
+0xBC, 0xFD, 0xA6, 0x5F, 0x59, 0xAB, 0xDE, 0x54, 
+0xF4, 0x0B, 0xCE, 0xF8, 0x55, 0x97, 0x35, 0xD1, 
+0x20, 0x19, 
+
+0x18, 0xBA, 0x63, 0xB0, 0x49, 0xB4, 0xCB, 0xBD, 
+0x99, 0xA9, 0x9C, 0xCF, 0xCE, 0x89, 0xD8, 0xFB, 
+0xFF, 0x86, 0x0A, 0xA4, 0x45, 0xC0, 0xAD, 0xA6, 
+0x36, 0xF3, 0x44, 0x7E, 0xC4, 0x36, 0xF5, 0xB9, 
+0x8A, 0x92, 0x59, 0x04, 0x06, 0xA9, 0x3C, 0xD6, 
+0x8F, 0x28, 0xB4, 0x5A, 0x71, 0xAB, 0x52, 0x06, 
+0xD9, 0x87, 0xF5, 0x7F, 0x31, 0x43, 0x82, 0x65, 
+0xF7, 0x4C, 0x33, 0x4C, 0x3C, 0xFA, 0xC1, 0xC4, 
+0xC5, 0x63, 0xCD, 0x53, 0xD1, 0x5D, 0xEE, 0xD7, 
+0xBD, 0x69, 0x2D, 0xE9, 0x46, 0x6B, 0x34, 0x01, 
+0x8E, 0xF6, 0x74, 0xCB, 0xE4, 0xC0, 0x8E, 0xD1, 
+0xBE, 0x1D, 0xB0, 0xD2, 0xA1, 0x35, 0x29, 0x19, 
+0xE3, 0xC7, 0xB2, 0x57, 0xBC, 0x0F, 0x58, 0xE0, 
+0xEE, 0x15, 0x7E, 
+
+0x8A, 0x0B, 0x52, 0xB9, 0xDF, 0x23, 0x72, 0x80, 
+0x73, 0xA9, 0xA4, 0x56, 0xB9, 0x1D, 0x4D, 0x3A, 
+0xD7, 0x00, 0xBF, 0x21, 0x07, 0xCE, 0x41, 0x9F, 
+0x5F, 0x24, 0xAF, 0xDB, 0x55, 0x60, 0xA7, 0x38, 
+0x62, 0x0C, 0xEB, 0xF6, 0x19, 0xF7, 0x73, 0x5A, 
+0x3C, 0x83, 0xA0, 0xF2, 0x9F, 0xC8, 0x9E, 0x3F, 
+0xEC, 0x4B, 0x91, 0x7F, 0x1A, 0x7D, 0xE6, 0x60, 
+0xD0, 0x3D, 0xF1, 0x24, 0x4B, 0xD0, 0x45, 0xCC, 
+0xCF, 0x15, 0xD4, 0x44, 0x22, 0x6C, 0x4A, 0xAE, 
+0xAC, 0x85, 0x1F, 0xC2, 0xED, 0xB7, 0xD5, 0x0B, 
+0xFB, 0x05, 0x07, 0xBB, 0xE7, 0xD3, 0xA9, 0xCA, 
+0xFB, 0x70, 0xFF, 0x59, 0xEE, 0x8F, 0x2B, 0x6A, 
+0xA2, 0x80, 0xA2, 0x0B, 0x8C, 0x9F, 0xED, 0x91, 
+0x7E, 0x50, 0xBA, 0xEA, 
+
+0x18, 0xC1, 0x18, 0x44, 0xDF, 0xD3, 0x85, 0xE8, 
+0xF9, 0x0A, 0x58, 0x87, 0x74, 0x59, 0x2D, 0x32, 
+0x6D, 0x41, 0x13, 0x38, 0x90, 0x50, 0xCA, 0x88, 
+0x0B, 0x69, 0xD7, 0xF8, 0x26, 0x8B, 0x7C, 0x0B, 
+0x08, 0x99, 0x86, 0x76, 0x9A, 0x97, 0x3F, 0xE2, 
+0x72, 0x86, 0xC7, 0x06, 0x6D, 0xB3, 0xBC, 0xEB, 
+0x09, 0x79, 0x1C, 0xFC, 0x06, 0xAC, 0xCA, 0x3B, 
+0x1C, 0x59, 0xFA, 0x31, 0xB7, 0xB6, 0xA3, 0x07, 
+0xFB, 0xC1, 0x1E, 0xDE, 0xA9, 0x02, 0xD6, 0xE9, 
+0x48, 0x00, 0x67, 0x82, 0x0A, 0x27, 0xFC, 0x48, 
+0x1D, 0x60, 0x45, 0x76, 0x87, 0xBD, 0x16, 0x35, 
+0x0B, 0xBA, 0xF8, 0x4C, 0x40, 0xC5, 0x7F, 0xCE, 
+0x82, 0x25, 0x32, 0x37, 0x29, 0x44, 0xED, 0xBD, 
+0x9C, 0xDE, 0x04, 0xC4, 0xF9, 
+
+0xE3, 0x7E, 0xDF, 0xC4, 0xC0, 0xE8, 0x71, 0x0A, 
+0x06, 0x27, 0x4E, 0x98, 0x4F, 0x0C, 0x90, 0xA7, 
+0x3C, 0x74, 0x4A, 0x04, 0xD8, 0x28, 0x8D, 0xE0, 
+0xD3, 0x4F, 0xE6, 0x6A, 0x43, 0xD6, 0xA0, 0x6D, 
+0x95, 0xB0, 0x90, 0x54, 0x80, 0x57, 0xCD, 0x5A, 
+0x04, 0x8D, 0xBC, 0x6B, 0xFF, 0x55, 0x21, 0x7D, 
+0xA7, 0x0F, 0xC6, 0xE5, 0x37, 0xE8, 0xA7, 0x94, 
+0xB3, 0x05, 0xF4, 0x31, 0xA9, 0x28, 0xDC, 0x2A, 
+0x5C, 0xF0, 0x3B, 0x57, 0x44, 0xDC, 0xD6, 0x40, 
+0xAE, 0x82, 0xA1, 0x44, 0xE5, 0xC1, 0xDF, 0x7A, 
+0x25, 0x05, 0x2F, 0xF9, 0x11, 0x63, 0xB1, 0x9C, 
+0x65, 0x9F, 0x31, 0x71, 0xCF, 0x80, 0x68, 0x92, 
+0x83, 0x25, 0x8C, 0xC6, 0x38, 0x8B, 0x48, 0x8E, 
+0xDD, 0x1B, 0x13, 0xF4, 0x58, 0x36, 
+
+0x66, 0x25, 0xA9, 0xF0, 0x73, 0x03, 0x47, 0xA8, 
+0xE5, 0x3E, 0x75, 0xAE, 0x68, 0x77, 0x89, 0xE2, 
+0x40, 0x8F, 0xBF, 0x70, 0xF7, 0xCE, 0x6F, 0x0F, 
+0x70, 0x0A, 0x4F, 0xEF, 0xC3, 0xA0, 0x1A, 0x4E, 
+0xB9, 0x0E, 0xEF, 0xC5, 0xA1, 0x78, 0xEE, 0xB6, 
+0x2B, 0x86, 0x6E, 0x4B, 0x7D, 0x9C, 0x54, 0x74, 
+0x06, 0x0B, 0x45, 0x77, 0xA7, 0x49, 0xDA, 0x65, 
+0x8F, 0xE9, 0x56, 0x90, 0x11, 0xC0, 0x3B, 0xDA, 
+0x55, 0x59, 0x22, 0xED, 0x16, 0xDC, 0x56, 0x17, 
+0xAA, 0xDD, 0xDF, 0xFA, 0xB8, 0x15, 0x78, 0x3F, 
+0xF8, 0x78, 0x79, 0x80, 0x24, 0x55, 0x21, 0x39, 
+0xBD, 0xC1, 0x0E, 0x87, 0x9C, 0xB4, 0xC0, 0x9A, 
+0x6C, 0x25, 0xC0, 0x90, 0x3B, 0xA5, 0x4D, 0x6E, 
+0xD4, 0x21, 0x22, 0x5C, 0x02, 0xF4, 0xD3, 
+
+0x21, 0xC8, 0x89, 0xA7, 0xB4, 0xF6, 0xBF, 0x61, 
+0x35, 0x1D, 0x85, 0xA4, 0x4A, 0x0E, 0x64, 0xC0, 
+0x0E, 0x37, 0xEE, 0x8B, 0x6A, 0xC5, 0x9E, 0xFE, 
+0xD3, 0x6A, 0x00, 0x75, 0xCC, 0xFA, 0x30, 0x32, 
+0x88, 0x82, 0x48, 0xBA, 0x56, 0x9C, 0x3F, 0xAE, 
+0x96, 0xD0, 0x99, 0x32, 0x33, 0xA4, 0x4C, 0x2E, 
+0x46, 0x5C, 0x1D, 0x59, 0xEA, 0x93, 0x03, 0x8D, 
+0x7C, 0xC2, 0x1A, 0x84, 0x22, 0x08, 0xF3, 0x37, 
+0xA1, 0xAF, 0xF0, 0x69, 0xC5, 0x8C, 0x7D, 0x71, 
+0x2D, 0x0B, 0xC7, 0x77, 0x73, 0x8D, 0x62, 0xD1, 
+0xBD, 0x4B, 0x99, 0xF0, 0x7F, 0x57, 0xEB, 0xC1, 
+0x12, 0x4F, 0x31, 0x0B, 0x05, 0xFE, 0x7F, 0xC8, 
+0xD4, 0xA4, 0xAD, 0x9A, 0x3E, 0x5A, 0x41, 0x0A, 
+0xE2, 0x7D, 0x7A, 0xCC, 0x66, 0x3A, 0x15, 0xD6, 
+
+0x10, 0xC0, 0x71, 0x62, 0x2D, 0xF7, 0x89, 0xBF, 
+0xF7, 0x50, 0x2D, 0x6D, 0xC4, 0x5D, 0x2B, 0x2F, 
+0xC9, 0x06, 0xA1, 0x70, 0x11, 0x48, 0x9A, 0x4E, 
+0x9A, 0x23, 0x13, 0x34, 0x09, 0xCB, 0xA3, 0x64, 
+0x72, 0x86, 0x19, 0x8B, 0xC9, 0x74, 0xD6, 0x8C, 
+0xB3, 0xC7, 0xFB, 0xF2, 0x1D, 0xE7, 0x04, 0x98, 
+0x5B, 0x3F, 0xB5, 0x32, 0x8F, 0xDF, 0xED, 0x96, 
+0xB0, 0xE4, 0x67, 0xBE, 0x22, 0x3B, 0xEF, 0xAE, 
+0xB8, 0x00, 0x11, 0xE2, 0xF1, 0xB3, 0xE5, 0x28, 
+0xCA, 0x2D, 0x8B, 0xD4, 0xFB, 0xA6, 0x57, 0xEB, 
+0xEA, 0xCA, 0x6B, 0x96, 0xC3, 0x31, 0x17, 0x96, 
+0x09, 0xE9, 0x04, 0x42, 0xF0, 0xD1, 0xE6, 0x8F, 
+0xAC, 0x22, 0x9B, 0x85, 0xDF, 0x70, 0xB1, 0xB2, 
+0x14, 0x7A, 0xD3, 0x7B, 0x24, 0x40, 0x6F, 0x62, 
+0x14, 
+
+0xE6, 0x0F, 0x0E, 0x56, 0x3E, 0xCF, 0xBF, 0x40, 
+0x29, 0x91, 0xD0, 0xD6, 0x48, 0xB9, 0x01, 0xBE, 
+0xA4, 0x6E, 0x4F, 0xE1, 0x7D, 0xD4, 0xF6, 0x39, 
+0x74, 0xD2, 0xD5, 0x1A, 0xDC, 0xD7, 0xAC, 0x59, 
+0xEE, 0xD6, 0xC0, 0xD3, 0x28, 0x84, 0x8F, 0xFE, 
+0x06, 0x5F, 0xE4, 0x92, 0x2F, 0xA0, 0x68, 0x86, 
+0xF9, 0x5D, 0x3B, 0x90, 0xED, 0xCA, 0x35, 0x31, 
+0x7E, 0xA8, 0x75, 0x8F, 0x36, 0x37, 0x58, 0x29, 
+0x8A, 0x1E, 0xBE, 0x76, 0x1C, 0x72, 0x32, 0x73, 
+0x55, 0x80, 0xB8, 0xBE, 0x26, 0x24, 0xAB, 0x46, 
+0x3F, 0xF6, 0xBB, 0x3D, 0x17, 0x4C, 0x7A, 0x5D, 
+0x52, 0x7B, 0x47, 0xA0, 0x4C, 0xBD, 0xDE, 0x11, 
+0xA8, 0x37, 0x22, 0x2D, 0x12, 0xED, 0xF8, 0x28, 
+0x93, 0xFD, 0xF7, 0x16, 0x4D, 0x95, 0x3D, 0x26, 
+0xDE, 0x8D, 
+
+0x1D, 0xBE, 0x0A, 0x2B, 0xC8, 0x8C, 0xD6, 0x7E, 
+0x04, 0x82, 0x95, 0xB3, 0x7E, 0x90, 0x98, 0xB2, 
+0x9F, 0xD1, 0x7E, 0x87, 0xE9, 0x47, 0xF1, 0xA8, 
+0x61, 0x9E, 0x75, 0xDB, 0x5A, 0xEA, 0x86, 0x33, 
+0x36, 0x99, 0x94, 0x97, 0x46, 0x6D, 0x56, 0x91, 
+0xF6, 0x64, 0x84, 0x60, 0xC1, 0x7B, 0x2A, 0x2F, 
+0x6F, 0xA2, 0x8D, 0xB4, 0x8F, 0xCF, 0x1C, 0x05, 
+0xC5, 0x49, 0x06, 0xD0, 0xFA, 0xBF, 0x90, 0x34, 
+0xE9, 0x92, 0xF1, 0xCE, 0xDF, 0x14, 0x25, 0x3C, 
+0xE8, 0xEB, 0x50, 0xEC, 0xBF, 0x61, 0xE0, 0x1C, 
+0xD9, 0xFA, 0xC9, 0x72, 0xB0, 0x49, 0x8A, 0xAF, 
+0x92, 0xE2, 0xEF, 0x3B, 0xD4, 0xD5, 0x41, 0x67, 
+0xBB, 0xF5, 0xC6, 0x2F, 0x6C, 0x46, 0x4E, 0xE4, 
+0x4B, 0xA0, 0x9E, 0xD0, 0xEF, 0xB8, 0xF8, 0xBE, 
+0xF1, 0x9D, 0xFA, 
+
+0x1F, 0x43, 0x70, 0x81, 0xE2, 0x67, 0xE4, 0x1C, 
+0x29, 0x23, 0x8F, 0xFF, 0x8E, 0xD9, 0x59, 0x69, 
+0x9F, 0x3B, 0x08, 0xB8, 0x45, 0x07, 0xEF, 0x47, 
+0xCB, 0xCA, 0xE8, 0xAB, 0x96, 0x37, 0x14, 0xBA, 
+0xDD, 0xB2, 0xAF, 0xB0, 0x6D, 0xA6, 0x51, 0x36, 
+0x38, 0x5A, 0xCB, 0x2F, 0x81, 0x57, 0x89, 0x9C, 
+0x3C, 0x46, 0xA7, 0x55, 0x9D, 0xE6, 0x97, 0x5E, 
+0xCE, 0xD4, 0x28, 0x66, 0x0D, 0x36, 0xCF, 0x3A, 
+0xBD, 0x5F, 0x70, 0x96, 0x4B, 0x94, 0xF2, 0x25, 
+0xE1, 0xC4, 0x8B, 0xB7, 0x85, 0x89, 0xC9, 0xCB, 
+0xBD, 0xDB, 0xF1, 0x05, 0x53, 0xC7, 0xFE, 0x51, 
+0x52, 0x5C, 0xFC, 0xF9, 0x0A, 0xA4, 0x05, 0x6C, 
+0x6A, 0xF7, 0x70, 0x25, 0x22, 0x79, 0x4C, 0x72, 
+0xD4, 0x4F, 0x69, 0x27, 0x08, 0x6D, 0x1D, 0xDE, 
+0x88, 0x9D, 0xA8, 0x85, 
+
+0xAB, 0x84, 0xBF, 0x92, 0x5D, 0xBF, 0x69, 0xAB, 
+0x00, 0x47, 0x5C, 0xA6, 0x3F, 0x8B, 0xD4, 0x4C, 
+0x70, 0x45, 0xCE, 0x80, 0xC4, 0x32, 0x0A, 0xFA, 
+0x1F, 0x4B, 0x64, 0x56, 0x18, 0x53, 0xF4, 0x4C, 
+0x25, 0xB5, 0x3A, 0xC9, 0xFF, 0x54, 0x43, 0xFC, 
+0x60, 0xF6, 0x20, 0x49, 0xFE, 0x31, 0x79, 0xC4, 
+0xF7, 0x42, 0x49, 0xFD, 0xFB, 0x8A, 0x50, 0x2D, 
+0xB8, 0xAB, 0x05, 0xCB, 0x15, 0xE4, 0x09, 0xAA, 
+0x55, 0x79, 0x30, 0xAC, 0x37, 0xA1, 0x4F, 0x47, 
+0xD5, 0xC0, 0x5E, 0x19, 0x07, 0xB1, 0xC4, 0xE4, 
+0xAB, 0x2B, 0xC1, 0xF9, 0xDB, 0xBA, 0xA7, 0x59, 
+0x69, 0xAE, 0x12, 0x6B, 0xD8, 0x42, 0xF7, 0xDD, 
+0xC2, 0xBE, 0x79, 0x74, 0x04, 0x6F, 0x39, 0x1A, 
+0x37, 0x57, 0x4E, 0x3B, 0x09, 0xD3, 0x11, 0x8A, 
+0x11, 0x2B, 0xBE, 0x7D, 0x25, 
+
+0x40, 0x58, 0xA9, 0x92, 0xA5, 0x80, 0x6D, 0xBE, 
+0x5A, 0xDA, 0x1C, 0xA3, 0x04, 0xF8, 0xF4, 0x00, 
+0x28, 0x5A, 0x60, 0x6A, 0xEC, 0xFF, 0x4E, 0x1E, 
+0x1B, 0x95, 0x76, 0xAA, 0x1D, 0x3C, 0xC5, 0xDF, 
+0xEF, 0x48, 0x1C, 0x51, 0xB7, 0xBF, 0xE7, 0x91, 
+0x29, 0x17, 0x5C, 0xC9, 0x6B, 0x32, 0x3E, 0xFD, 
+0x33, 0x4B, 0x5F, 0x27, 0x87, 0xEB, 0x14, 0xB0, 
+0x34, 0x4E, 0x01, 0x11, 0x37, 0x40, 0x8F, 0x0A, 
+0xEB, 0xB9, 0xF2, 0x2C, 0xFD, 0x90, 0xEE, 0x8E, 
+0xA7, 0x94, 0xDC, 0x5E, 0x67, 0xBA, 0xC2, 0x6C, 
+0xAA, 0x08, 0xD6, 0x24, 0xB2, 0x45, 0x4D, 0x81, 
+0x12, 0x5E, 0xF5, 0xDA, 0xA4, 0xA3, 0x31, 0x37, 
+0x34, 0x3C, 0xC5, 0x65, 0x8E, 0x94, 0xDE, 0x30, 
+0xFD, 0xCB, 0xD5, 0x7E, 0x8E, 0xB8, 0x3A, 0x72, 
+0x3E, 0xFF, 0x0D, 0x8F, 0x4B, 0x14, 
+
+0xCB, 0xD8, 0xEB, 0x76, 0x58, 0x54, 0x1D, 0xC7, 
+0x7A, 0x13, 0xD7, 0x7B, 0xFE, 0xC4, 0x32, 0x04, 
+0x65, 0x70, 0x5B, 0x0F, 0x8A, 0xA6, 0x83, 0x5F, 
+0x43, 0x15, 0xD8, 0x89, 0x47, 0x3C, 0xFB, 0x82, 
+0x94, 0x64, 0x4F, 0xE9, 0xA6, 0x37, 0x96, 0xC7, 
+0x50, 0x1B, 0x80, 0x62, 0x28, 0x1D, 0x98, 0xB0, 
+0x45, 0x22, 0xC2, 0x42, 0xE9, 0x59, 0x24, 0xC3, 
+0x1A, 0x5A, 0x3D, 0x72, 0xB6, 0xDC, 0x49, 0x47, 
+0xDC, 0x23, 0xB2, 0x23, 0xE2, 0x75, 0xB1, 0xF6, 
+0x84, 0x2C, 0x29, 0xE4, 0x2C, 0xB1, 0x62, 0x78, 
+0x95, 0x34, 0xFB, 0x34, 0x02, 0x45, 0xF6, 0x88, 
+0xF6, 0xAF, 0x85, 0x2E, 0x33, 0x5C, 0xAE, 0x8A, 
+0x6C, 0x39, 0x5A, 0x90, 0x2A, 0x7F, 0x70, 0x0D, 
+0x68, 0x2A, 0xB4, 0x64, 0x37, 0x30, 0xF3, 0xD4, 
+0x09, 0xDF, 0x6B, 0xC0, 0x32, 0x33, 0x2F, 
+
+0xCC, 0x8E, 0xB0, 0x47, 0x40, 0x88, 0xFF, 0x72, 
+0x93, 0x56, 0x4C, 0xE8, 0xB8, 0x9E, 0x93, 0xA4, 
+0xF9, 0x5C, 0x09, 0xA9, 0xF2, 0x57, 0xCA, 0x57, 
+0x7B, 0x22, 0x3B, 0x99, 0x18, 0x7C, 0x8A, 0x7F, 
+0xCB, 0x11, 0x76, 0xCC, 0x66, 0xCF, 0xD3, 0xC2, 
+0xAE, 0xEB, 0x61, 0x1F, 0xBA, 0x50, 0x9D, 0xE0, 
+0x64, 0x10, 0x88, 0x1A, 0x10, 0xC6, 0xC1, 0x15, 
+0x00, 0x5F, 0xE9, 0x9C, 0x37, 0x2C, 0x10, 0xB1, 
+0x93, 0x9F, 0xE9, 0xAD, 0xBE, 0xA5, 0x3C, 0xAB, 
+0x95, 0xD5, 0xFC, 0x8D, 0xF0, 0x2E, 0xAD, 0xE3, 
+0x03, 0xE8, 0xE1, 0x2C, 0x41, 0xC7, 0x10, 0x32, 
+0x5C, 0xAE, 0xF0, 0xF1, 0x26, 0xC5, 0xCE, 0xAD, 
+0xF3, 0x77, 0x9D, 0x31, 0x7B, 0x1A, 0x81, 0xE9, 
+0xB8, 0x6D, 0xE2, 0x4F, 0x4B, 0x83, 0x26, 0xAE, 
+0xEB, 0xA7, 0xB0, 0xC3, 0x43, 0x9B, 0xCB, 0x56, 
+
+0x81, 0x93, 0x4D, 0x31, 0xAE, 0x98, 0x7C, 0xB0, 
+0x87, 0x98, 0x0A, 0x91, 0xF9, 0xEA, 0x0B, 0xC5, 
+0x81, 0x35, 0x34, 0xFF, 0xD1, 0x08, 0x23, 0x7D, 
+0x27, 0x33, 0x70, 0xD1, 0x45, 0x2C, 0xE7, 0xF0, 
+0x4F, 0x4F, 0x4B, 0xD6, 0x4E, 0xEF, 0x8C, 0x77, 
+0x19, 0xFC, 0x0A, 0xAF, 0x74, 0x66, 0x90, 0x4F, 
+0x5C, 0x41, 0x2B, 0xDA, 0xBC, 0x2B, 0xCD, 0x3B, 
+0xD1, 0xC0, 0xD3, 0x49, 0xF8, 0xAC, 0x5F, 0xDD, 
+0x26, 0x41, 0xE5, 0x6E, 0x9D, 0x48, 0x53, 0xE2, 
+0xED, 0xA4, 0x90, 0x36, 0x65, 0xF4, 0x23, 0xBE, 
+0xD3, 0x81, 0xE6, 0x5D, 0x27, 0xF9, 0x4F, 0x4F, 
+0x62, 0x91, 0x73, 0xC3, 0x49, 0xF5, 0x89, 0xCD, 
+0xEB, 0xA2, 0x79, 0x67, 0xEB, 0xB5, 0xD8, 0x7A, 
+0xDA, 0x01, 0xAC, 0x41, 0xDA, 0x2B, 0x19, 0xA4, 
+0x98, 0x29, 0x0D, 0xC3, 0x14, 0x02, 0x5E, 0x66, 
+0x70, 
+
+0x05, 0x62, 0x10, 0xC7, 0x65, 0x40, 0x88, 0x59, 
+0x59, 0x78, 0x18, 0x31, 0x71, 0xF7, 0xA4, 0x9A, 
+0x4D, 0x2D, 0x19, 0xA8, 0x01, 0xAD, 0x3E, 0x0E, 
+0x35, 0x37, 0xE0, 0x26, 0x2F, 0x22, 0x89, 0x7F, 
+0xDD, 0x31, 0xBD, 0xE2, 0x89, 0x99, 0x35, 0xEC, 
+0x30, 0xC9, 0x6E, 0x7A, 0x7B, 0xE1, 0x23, 0x5D, 
+0x1E, 0xD2, 0x43, 0x85, 0xEE, 0x60, 0x00, 0xF8, 
+0x96, 0xEC, 0x17, 0x57, 0x78, 0x78, 0xCC, 0x7D, 
+0x38, 0x67, 0x65, 0xFF, 0x0B, 0x3A, 0xF4, 0x35, 
+0x9E, 0x79, 0xAB, 0xB2, 0xE0, 0x5A, 0xCB, 0xD3, 
+0x5C, 0xA8, 0x56, 0x77, 0x41, 0xD1, 0xE6, 0xD7, 
+0x3B, 0x58, 0xF5, 0xA7, 0x55, 0x0C, 0x53, 0xFA, 
+0x2D, 0x6E, 0xA8, 0x0C, 0xA0, 0x25, 0x9C, 0x75, 
+0x2F, 0x36, 0x9F, 0x48, 0x6C, 0xC7, 0x4C, 0x01, 
+0x27, 0xFC, 0x2B, 0xB9, 0xB4, 0x1D, 0xEB, 0xC9, 
+0x78, 0xB4, 
+
+0x40, 0x9F, 0x91, 0xE8, 0xAC, 0xBA, 0x4A, 0x75, 
+0x10, 0x3A, 0xFE, 0x0B, 0xBF, 0x51, 0x52, 0x27, 
+0xBE, 0x4C, 0x4C, 0xBD, 0x50, 0xC1, 0xA5, 0x69, 
+0xA6, 0x30, 0xAD, 0x4F, 0x5D, 0x82, 0x1E, 0x1F, 
+0x81, 0x6A, 0x9D, 0x17, 0x94, 0x16, 0x54, 0xBD, 
+0xB7, 0x94, 0x9A, 0x2D, 0x94, 0xFF, 0x51, 0xFD, 
+0x41, 0x3A, 0xAA, 0x17, 0x95, 0x76, 0x62, 0x9F, 
+0x18, 0xA5, 0x3B, 0xA8, 0x56, 0x12, 0xB2, 0x5B, 
+0xD3, 0x05, 0x25, 0xF4, 0xB7, 0x3B, 0x2E, 0xC7, 
+0xA2, 0x26, 0x8E, 0x19, 0xA1, 0xEE, 0xDB, 0xF3, 
+0xFA, 0xFD, 0xCE, 0x57, 0x6B, 0x1F, 0xF9, 0xA5, 
+0x39, 0x82, 0x86, 0xFC, 0x52, 0x57, 0xB8, 0xDC, 
+0xF7, 0xBE, 0xD1, 0x75, 0x71, 0x1D, 0xAF, 0x29, 
+0xC6, 0x25, 0xE1, 0xDD, 0x8E, 0xC1, 0x31, 0xCA, 
+0x59, 0xA6, 0x0E, 0xB2, 0x69, 0xDA, 0x2B, 0x99, 
+0x69, 0x56, 0x6E, 
+
+0x16, 0xBC, 0x89, 0xBB, 0x22, 0x03, 0xED, 0x8A, 
+0x56, 0x9E, 0xF5, 0xF9, 0x9D, 0xCD, 0x63, 0x15, 
+0x78, 0xD8, 0x3A, 0x0F, 0x17, 0xDA, 0x0F, 0x39, 
+0x31, 0x0E, 0x8B, 0x2F, 0xCC, 0x27, 0x0D, 0xD1, 
+0x01, 0x2C, 0x86, 0xC2, 0x57, 0xB3, 0x38, 0xC3, 
+0xBA, 0xCE, 0xEA, 0x2F, 0x52, 0x3A, 0x7B, 0x00, 
+0xEB, 0xBD, 0x24, 0xBD, 0x67, 0x43, 0x9D, 0xD6, 
+0xAB, 0xC3, 0x15, 0x7A, 0xAA, 0xB9, 0x5C, 0x6F, 
+0x67, 0x64, 0xE4, 0x3A, 0x0A, 0x42, 0xAB, 0xA7, 
+0x61, 0xDB, 0x51, 0xAC, 0xB8, 0x64, 0x09, 0xEC, 
+0xDF, 0x39, 0xD5, 0x94, 0x01, 0xF9, 0x2E, 0xCC, 
+0x19, 0x25, 0x86, 0xE4, 0xFE, 0xE2, 0x5C, 0x20, 
+0x93, 0x5C, 0x08, 0x47, 0xB9, 0x5C, 0xE4, 0x1F, 
+0x11, 0x4E, 0xFA, 0xB7, 0x26, 0xC0, 0x05, 0x02, 
+0x37, 0x59, 0x46, 0xF4, 0x52, 0xEF, 0x1C, 0xE6, 
+0xD9, 0x2E, 0x23, 0x8E, 
+
+0xF2, 0x73, 0xEE, 0xBA, 0x59, 0xFE, 0xDE, 0x6E, 
+0x1A, 0x9A, 0x07, 0xB8, 0xE3, 0x49, 0xED, 0xA0, 
+0x57, 0x37, 0xE9, 0xD5, 0x3D, 0xEE, 0xBA, 0x48, 
+0x4F, 0xBF, 0x24, 0xA2, 0x46, 0xA3, 0xFF, 0x81, 
+0x6D, 0x9E, 0x33, 0x3F, 0x13, 0xCA, 0x67, 0x15, 
+0x72, 0xBE, 0xD8, 0x0E, 0x2C, 0xCB, 0xB5, 0xB4, 
+0xA9, 0x3D, 0x40, 0xF7, 0xF3, 0x93, 0xC3, 0x5D, 
+0x74, 0xDC, 0xF3, 0x1F, 0x39, 0x37, 0x7E, 0x46, 
+0x68, 0x0A, 0xDC, 0x1C, 0xE7, 0x91, 0x1F, 0x7E, 
+0x85, 0x89, 0x2B, 0x3C, 0x8B, 0x82, 0x34, 0xEE, 
+0xDA, 0x81, 0x46, 0xD3, 0x2A, 0xAF, 0x7F, 0x40, 
+0x21, 0x3F, 0x30, 0x77, 0x28, 0xD8, 0x6A, 0x96, 
+0x76, 0xBD, 0xF7, 0x2A, 0xD0, 0x03, 0x0A, 0x44, 
+0xD4, 0xAC, 0x9A, 0x16, 0xA5, 0x09, 0xE8, 0xB9, 
+0x5F, 0x69, 0xA0, 0xA4, 0x79, 0xE9, 0x1F, 0x2F, 
+0xD7, 0xC9, 0x61, 0x36, 0x28, 
+
+0x54, 0x7A, 0x1C, 0x7B, 0xC3, 0xDE, 0xEC, 0x79, 
+0xFE, 0xA0, 0x1D, 0x87, 0x81, 0xA3, 0xB5, 0x5F, 
+0x37, 0x17, 0x29, 0xB6, 0x59, 0x0F, 0xAE, 0x5A, 
+0xF2, 0x82, 0x63, 0xD1, 0x41, 0xD0, 0xB5, 0xD0, 
+0x85, 0xA1, 0xA6, 0xA1, 0xBD, 0xED, 0xDA, 0xAD, 
+0x35, 0x6A, 0x5B, 0x18, 0xED, 0x51, 0x5E, 0xCB, 
+0x82, 0xB5, 0x3F, 0xAE, 0x24, 0x7E, 0x82, 0x9D, 
+0x25, 0x76, 0x43, 0xB8, 0x05, 0xC1, 0x3E, 0x75, 
+0x00, 0x55, 0xC9, 0x41, 0xBA, 0x3F, 0xBF, 0x35, 
+0xD8, 0x7F, 0xE9, 0xB8, 0x1F, 0xD6, 0x2A, 0x0C, 
+0x5E, 0x4E, 0xD1, 0x8B, 0xB5, 0x9E, 0x75, 0xD8, 
+0x4E, 0xC7, 0x9D, 0xC6, 0x49, 0x4B, 0xF9, 0x53, 
+0x52, 0xF7, 0x9C, 0xD1, 0xF9, 0x5E, 0x11, 0x5B, 
+0x0A, 0xC1, 0x00, 0x98, 0x05, 0x6A, 0x97, 0x9F, 
+0x4C, 0x72, 0x30, 0x3B, 0x9C, 0x0A, 0x17, 0xD4, 
+0xE0, 0x00, 0xA9, 0x7B, 0xD0, 0x06, 
+
+0xC4, 0xFF, 0xDF, 0x78, 0x66, 0xDE, 0xCD, 0xBA, 
+0x34, 0x5F, 0xE8, 0xC4, 0x69, 0x09, 0xEE, 0x90, 
+0x24, 0x67, 0xDA, 0x31, 0x86, 0xC7, 0x6F, 0x91, 
+0xAD, 0xC0, 0xBB, 0x0E, 0xD0, 0x2F, 0xB2, 0xB7, 
+0xEB, 0xA4, 0x75, 0x3D, 0x23, 0xE4, 0x70, 0xB7, 
+0xE5, 0xE3, 0x09, 0x77, 0x54, 0x17, 0x51, 0x2E, 
+0x57, 0x31, 0xDE, 0xF9, 0xA1, 0x3E, 0xCB, 0xEA, 
+0xFC, 0x49, 0x45, 0xED, 0x18, 0x10, 0x8E, 0xEA, 
+0x2D, 0x1E, 0x5D, 0x11, 0xCF, 0xD5, 0xD8, 0xD9, 
+0x1E, 0xE3, 0x99, 0xD0, 0x66, 0x90, 0x0E, 0x5E, 
+0x83, 0x70, 0xFB, 0xCF, 0xFA, 0x87, 0x5C, 0x69, 
+0x4A, 0x24, 0x9D, 0xD4, 0x13, 0xE5, 0xFA, 0xF9, 
+0x56, 0xE0, 0xA9, 0xAC, 0xD6, 0x46, 0xB2, 0x08, 
+0x0C, 0x72, 0xC9, 0x23, 0xE2, 0x4A, 0x68, 0xB8, 
+0x22, 0x6A, 0x1D, 0x3A, 0xB6, 0xC9, 0x1F, 0x3B, 
+0x4D, 0x85, 0x0B, 0x09, 0x26, 0xB0, 0xF1, 
+
+0xED, 0x9D, 0x2F, 0xA4, 0x59, 0xE2, 0xCA, 0x58, 
+0xBC, 0xEA, 0x60, 0x3D, 0xBB, 0xFF, 0x1F, 0x70, 
+0x96, 0x28, 0x54, 0xA9, 0x99, 0x51, 0x40, 0xF7, 
+0xB3, 0x2B, 0xDC, 0x16, 0xE6, 0x8F, 0x4A, 0xAA, 
+0x98, 0x3F, 0x2C, 0xFD, 0x60, 0xBA, 0xC9, 0x2F, 
+0xAE, 0xF3, 0x87, 0x79, 0x87, 0xA9, 0x59, 0x1D, 
+0x8C, 0x15, 0x11, 0xC8, 0xA3, 0x4F, 0x6D, 0xC6, 
+0xCF, 0x4C, 0xCC, 0x1B, 0x69, 0xCE, 0xAE, 0x23, 
+0x7D, 0xBD, 0x74, 0xFB, 0xBB, 0xA3, 0x12, 0xC0, 
+0x90, 0xF6, 0xD1, 0xDC, 0xB1, 0x70, 0x01, 0x2F, 
+0x23, 0xFA, 0x0F, 0xC5, 0x2A, 0x83, 0x39, 0x90, 
+0x73, 0xBE, 0xD0, 0x96, 0xDD, 0xAE, 0x45, 0x3F, 
+0xEA, 0x85, 0x61, 0x7B, 0xF6, 0xE4, 0xF6, 0x52, 
+0xA9, 0x78, 0x0A, 0x01, 0x93, 0xBD, 0x81, 0x85, 
+0xD7, 0xD8, 0x2C, 0x38, 0x91, 0xCC, 0xD0, 0x8F, 
+0xEC, 0x77, 0xE2, 0xDB, 0x72, 0x12, 0x3E, 0xA6, 
+
+0x0E, 0xEF, 0x2B, 0xA1, 0x03, 0xB4, 0x66, 0x3B, 
+0x3C, 0x51, 0x74, 0x4C, 0x53, 0x15, 0xA2, 0x75, 
+0x18, 0x41, 0x11, 0xA6, 0x47, 0xE9, 0xFF, 0x4B, 
+0xE6, 0x77, 0x08, 0xE4, 0x2A, 0x3E, 0xE7, 0xD4, 
+0x6D, 0xD7, 0xFB, 0xF7, 0xC6, 0x9B, 0x39, 0xAA, 
+0x18, 0x10, 0xD2, 0x0F, 0xF8, 0x5E, 0xAA, 0x87, 
+0xE1, 0xC4, 0x25, 0x43, 0xEE, 0x69, 0x15, 0x03, 
+0x6E, 0x89, 0x71, 0xDD, 0xD3, 0xC8, 0x9F, 0x76, 
+0xAD, 0xB1, 0x80, 0x72, 0xFA, 0xA4, 0xF0, 0xC7, 
+0xCF, 0x07, 0x3D, 0xB2, 0x5E, 0x81, 0x5C, 0xF7, 
+0xAA, 0xCE, 0xD9, 0x83, 0x3F, 0x88, 0x63, 0xCD, 
+0x5F, 0xBD, 0xA6, 0xE2, 0x46, 0x1C, 0x7F, 0xCF, 
+0xF0, 0xD8, 0x08, 0xFD, 0xCF, 0x47, 0xAD, 0xBB, 
+0xC7, 0x89, 0xC8, 0xFB, 0x53, 0x9D, 0x54, 0xD5, 
+0x6C, 0x6E, 0x64, 0xFC, 0x75, 0x6C, 0x64, 0xA1, 
+0x58, 0x11, 0xD6, 0xAE, 0xE3, 0xD6, 0x08, 0x6B, 
+0x9E, 
+
+0x04, 0xFE, 0x5E, 0xD2, 0x6B, 0x5B, 0x55, 0x23, 
+0x10, 0x85, 0x6D, 0xF0, 0x3E, 0xE9, 0x2C, 0x97, 
+0x03, 0x76, 0x4C, 0x5B, 0x95, 0x56, 0xB1, 0xC6, 
+0xB4, 0x85, 0x56, 0x4F, 0x5F, 0x55, 0x7A, 0xD9, 
+0x9A, 0xDA, 0x11, 0x94, 0x2E, 0xE6, 0xF9, 0x82, 
+0x00, 0x19, 0xF4, 0xD1, 0x02, 0xE4, 0x0D, 0xED, 
+0xC8, 0x76, 0x66, 0xEC, 0x8C, 0x15, 0xE6, 0x90, 
+0x7E, 0x52, 0x47, 0x89, 0x58, 0x64, 0x2A, 0x2D, 
+0xDB, 0x86, 0x94, 0xA2, 0x06, 0x24, 0x86, 0xD7, 
+0x07, 0x63, 0x59, 0xD8, 0xAE, 0xB9, 0xA5, 0x8D, 
+0xF7, 0x04, 0x5A, 0x8D, 0xF7, 0xBF, 0x44, 0x4D, 
+0x54, 0xD8, 0xEB, 0x20, 0x05, 0x03, 0xFC, 0x2C, 
+0x2E, 0x64, 0x7E, 0x5B, 0xB4, 0xC9, 0x25, 0xD4, 
+0x31, 0x07, 0xCA, 0x1D, 0x85, 0xC7, 0x37, 0x0A, 
+0x3D, 0xA8, 0xCD, 0xAD, 0xC2, 0x32, 0x15, 0x96, 
+0x30, 0x43, 0xDC, 0xEC, 0xB5, 0x36, 0xDB, 0x9B, 
+0xC3, 0x33, 
+
+0xA8, 0xA6, 0x23, 0x71, 0xD8, 0x90, 0x5B, 0xE1, 
+0x3B, 0x98, 0x9F, 0xDC, 0x09, 0x46, 0x69, 0x15, 
+0x52, 0x7C, 0xAD, 0x55, 0x27, 0xBB, 0xB5, 0xD5, 
+0xC8, 0x8A, 0x0D, 0x91, 0x10, 0x6A, 0xF4, 0x00, 
+0xF9, 0xE8, 0xDB, 0x2A, 0xBD, 0x37, 0xAB, 0x2B, 
+0x57, 0xD0, 0xDA, 0xB0, 0x4C, 0x2D, 0x9A, 0x3D, 
+0x91, 0x91, 0xD5, 0xBE, 0x62, 0x9E, 0x89, 0xF0, 
+0x0B, 0x42, 0x20, 0x20, 0x46, 0xA5, 0x32, 0xB3, 
+0x14, 0x59, 0x92, 0x68, 0xF8, 0x93, 0x9B, 0x13, 
+0x9A, 0x70, 0x7C, 0x08, 0x74, 0x32, 0x71, 0xDA, 
+0xDE, 0xF7, 0xA5, 0x30, 0xB2, 0xD0, 0x48, 0x58, 
+0x6C, 0xDF, 0x52, 0x50, 0x66, 0x0A, 0x10, 0x10, 
+0x7A, 0x75, 0x51, 0xF2, 0x30, 0x5F, 0xB6, 0xB8, 
+0xF1, 0xFA, 0x50, 0x58, 0x72, 0xD2, 0xF5, 0xB7, 
+0x52, 0xE7, 0x56, 0x75, 0x3A, 0x9A, 0x11, 0x50, 
+0x69, 0xB1, 0xCB, 0x87, 0xB9, 0xAC, 0x33, 0x13, 
+0x2E, 0x82, 0xFA, 
+
+0x20, 0xC8, 0xAE, 0x69, 0x09, 0x9F, 0x20, 0x8E, 
+0xFF, 0x28, 0x14, 0x35, 0xAF, 0x38, 0x5A, 0x77, 
+0x56, 0x80, 0x73, 0xBB, 0x0C, 0x70, 0x37, 0xC3, 
+0x87, 0x38, 0x20, 0x17, 0x58, 0x2E, 0xBA, 0x80, 
+0x4B, 0xF8, 0x21, 0x7F, 0x93, 0xBD, 0x11, 0x29, 
+0x14, 0x31, 0xE6, 0x3B, 0xDE, 0xD4, 0xF5, 0xF2, 
+0x34, 0x49, 0x33, 0x67, 0x80, 0xFC, 0xDE, 0x85, 
+0x5C, 0x0E, 0xF3, 0x60, 0x2F, 0xC5, 0x34, 0x82, 
+0x5A, 0x09, 0x66, 0x69, 0xAF, 0x5A, 0xE4, 0x25, 
+0x24, 0x00, 0xF0, 0xFA, 0xBE, 0xEA, 0xBC, 0x06, 
+0xFA, 0xB5, 0x17, 0xD2, 0xD9, 0x71, 0x0A, 0xB6, 
+0x02, 0x82, 0x15, 0x58, 0xE3, 0xC1, 0x0E, 0xE5, 
+0xCE, 0x6B, 0x3F, 0x43, 0x3E, 0x3E, 0x7D, 0xC4, 
+0x12, 0x1A, 0xB6, 0x8D, 0x36, 0x15, 0x42, 0xCE, 
+0x5E, 0xA5, 0xD3, 0x1B, 0x87, 0x3D, 0x96, 0x66, 
+0xA5, 0x34, 0x2A, 0x2B, 0xF5, 0x8A, 0x3F, 0x73, 
+0xE7, 0x77, 0x8E, 0x20, 
+
+0xD5, 0x2C, 0x5D, 0x4F, 0x2E, 0x54, 0x6B, 0x0F, 
+0xF5, 0x5F, 0x9E, 0x64, 0x42, 0x51, 0x3B, 0x74, 
+0xCD, 0x02, 0x41, 0x46, 0x0E, 0xAC, 0x50, 0xC5, 
+0xC8, 0xFD, 0xCC, 0xE7, 0x3D, 0x97, 0x94, 0x66, 
+0x0E, 0x71, 0x15, 0xBE, 0x8C, 0x77, 0xB8, 0x05, 
+0xCC, 0xAC, 0x40, 0x73, 0x0C, 0x24, 0x95, 0x2C, 
+0xF5, 0xA9, 0xA3, 0xE4, 0x41, 0x60, 0x1D, 0xCB, 
+0x62, 0xCC, 0x8E, 0xC5, 0x47, 0xF2, 0xF1, 0x14, 
+0x87, 0xB6, 0xEE, 0xC4, 0x50, 0xCC, 0x63, 0x13, 
+0x06, 0x24, 0xF1, 0x3D, 0xD9, 0x14, 0x6D, 0xC5, 
+0x92, 0x43, 0x68, 0xE5, 0xEF, 0x49, 0x5E, 0xB6, 
+0xC2, 0x67, 0x8F, 0xB8, 0xF9, 0xDB, 0x3E, 0x35, 
+0x71, 0x8F, 0x18, 0xF1, 0xDE, 0x94, 0x01, 0x90, 
+0x57, 0x00, 0x6B, 0x1B, 0xA3, 0x07, 0x9C, 0x9E, 
+0x88, 0xAA, 0x8A, 0xE3, 0x49, 0x43, 0x67, 0x7C, 
+0x30, 0xDD, 0xC5, 0x0C, 0x67, 0x0C, 0x35, 0x11, 
+0x92, 0x7A, 0x80, 0xF7, 0xFC, 
+
+0x60, 0xF4, 0xA0, 0xDE, 0x48, 0x39, 0x47, 0x5D, 
+0x58, 0x21, 0x6C, 0x02, 0xF3, 0xFE, 0x4C, 0x49, 
+0x16, 0x71, 0x21, 0x01, 0x0D, 0x84, 0xDF, 0xEC, 
+0xA5, 0x51, 0x45, 0x0B, 0x3B, 0x6B, 0x03, 0x64, 
+0x2C, 0x7B, 0xBD, 0xE2, 0xF7, 0x91, 0xDB, 0xEC, 
+0x11, 0x7F, 0x15, 0x3E, 0x50, 0x47, 0x49, 0x3E, 
+0x04, 0xD5, 0x6F, 0xCD, 0x5A, 0x4A, 0x43, 0x77, 
+0x0A, 0x67, 0x55, 0xA8, 0x45, 0xF8, 0x57, 0xBA, 
+0xE9, 0xB1, 0x5B, 0x9C, 0xC5, 0x55, 0x2C, 0x04, 
+0x60, 0xCB, 0x14, 0xD6, 0x5C, 0x43, 0x19, 0x2F, 
+0x50, 0xAC, 0xE0, 0x17, 0x7E, 0x9E, 0x8F, 0xA6, 
+0x0E, 0x67, 0xE6, 0x4A, 0x61, 0x17, 0xCE, 0x77, 
+0x1C, 0xF3, 0xC5, 0x15, 0xDB, 0x8A, 0x8D, 0xAB, 
+0x87, 0x43, 0xE0, 0x2A, 0xEF, 0xFE, 0xF5, 0xF3, 
+0x04, 0xEC, 0xA4, 0xF9, 0x40, 0x6F, 0xBD, 0x94, 
+0x1D, 0x47, 0x66, 0xBA, 0x87, 0x50, 0x04, 0x69, 
+0xEF, 0x84, 0xB4, 0x80, 0xAD, 0x3F, 
+
+0x5C, 0x7D, 0x87, 0x9C, 0xCD, 0xD7, 0x1D, 0x7D, 
+0x95, 0xCF, 0x48, 0xA6, 0xA6, 0x09, 0xF3, 0x88, 
+0x1A, 0xB1, 0xBD, 0x22, 0xE8, 0x60, 0xE3, 0x6B, 
+0xB9, 0xC7, 0x0B, 0xD8, 0xA9, 0xE1, 0x00, 0x67, 
+0x59, 0xA8, 0x56, 0xC9, 0xD6, 0x95, 0x23, 0x34, 
+0x5A, 0xEF, 0xA5, 0x2B, 0x8D, 0x9C, 0xCD, 0x54, 
+0xED, 0x8D, 0xC1, 0x91, 0x99, 0x18, 0x61, 0xE2, 
+0x67, 0x33, 0xEF, 0xD8, 0x66, 0x25, 0x81, 0x73, 
+0x65, 0x12, 0xF0, 0x3E, 0xC2, 0x60, 0xC8, 0xA8, 
+0xA0, 0x20, 0x42, 0x7F, 0x86, 0x51, 0x49, 0xCB, 
+0xA8, 0x3C, 0xD4, 0xD9, 0x88, 0x4C, 0x5A, 0xF8, 
+0xC0, 0x92, 0xDA, 0xD3, 0xC0, 0x6F, 0x39, 0x32, 
+0x40, 0x16, 0xBF, 0xBE, 0xD1, 0x0D, 0xD3, 0xCF, 
+0x2B, 0xF1, 0x70, 0x8C, 0x8A, 0x67, 0xB4, 0x80, 
+0xBB, 0x29, 0x44, 0xF3, 0x08, 0x74, 0x9B, 0xB2, 
+0xC8, 0xC6, 0xE6, 0x68, 0x76, 0x44, 0xA2, 0xA3, 
+0x12, 0xE8, 0xEE, 0xF9, 0x31, 0x29, 0xB3, 
+
+0x38, 0xAF, 0x3A, 0xCC, 0x11, 0x91, 0x24, 0xBC, 
+0xE0, 0x45, 0x39, 0xD7, 0x06, 0xA2, 0xA3, 0x34, 
+0xDE, 0xD7, 0xD6, 0xEF, 0x59, 0xA2, 0x22, 0x8A, 
+0x67, 0xAE, 0xE2, 0x6B, 0xC3, 0x6F, 0xBD, 0xE0, 
+0x43, 0xDF, 0x88, 0xE7, 0x58, 0x7E, 0x25, 0x7D, 
+0x16, 0xC9, 0x89, 0x24, 0xC4, 0x78, 0x4F, 0x82, 
+0xE7, 0x60, 0xDF, 0x71, 0x45, 0xF2, 0x52, 0xBF, 
+0x10, 0xF3, 0xC0, 0x93, 0xC2, 0xA4, 0x59, 0xAC, 
+0x35, 0x12, 0xF9, 0xFE, 0x3E, 0xB3, 0xF7, 0x78, 
+0xE9, 0xB5, 0x4B, 0x66, 0x60, 0x66, 0x1E, 0x55, 
+0x2D, 0x95, 0xEB, 0x28, 0x0F, 0x3F, 0x30, 0xF7, 
+0xA2, 0xE8, 0xF6, 0x47, 0x0C, 0xAE, 0xE4, 0xEF, 
+0x56, 0xF0, 0xDB, 0xE8, 0xAD, 0xF4, 0x7A, 0x99, 
+0xCB, 0x7C, 0x9D, 0x0A, 0x06, 0x8D, 0x9B, 0x4C, 
+0x3C, 0xDD, 0xF9, 0x10, 0x94, 0x77, 0x6B, 0x76, 
+0x3B, 0x3F, 0x49, 0x4D, 0xFD, 0x31, 0xF8, 0x21, 
+0xA6, 0x21, 0xD4, 0x37, 0x6C, 0xA7, 0xDE, 0x28, 
+
+0x35, 0xF4, 0x8D, 0x1F, 0x36, 0x2C, 0x74, 0x86, 
+0x05, 0x89, 0x58, 0x37, 0xB9, 0x35, 0xE6, 0x84, 
+0x3B, 0xEE, 0xD8, 0xFD, 0xAF, 0xEC, 0xC7, 0xF7, 
+0xBF, 0x85, 0x30, 0x6E, 0x9A, 0x9C, 0x41, 0x5C, 
+0x8D, 0x3D, 0x13, 0xB9, 0x68, 0x43, 0x40, 0xE3, 
+0xFE, 0x9B, 0xF2, 0x8A, 0x5D, 0x94, 0x21, 0xB1, 
+0x82, 0xFB, 0x80, 0xF1, 0xA9, 0x28, 0x12, 0x12, 
+0x74, 0xD9, 0x10, 0x2B, 0xF9, 0x30, 0x63, 0x1A, 
+0xD4, 0x32, 0xF3, 0xA0, 0xAD, 0x76, 0x2A, 0x30, 
+0x52, 0x84, 0x23, 0x3F, 0xA1, 0x8E, 0x1E, 0x46, 
+0x87, 0x92, 0xA5, 0xEE, 0x99, 0xBA, 0x32, 0x24, 
+0x29, 0xB0, 0xFB, 0xC5, 0x9B, 0xBC, 0x71, 0xCF, 
+0x00, 0x23, 0xC8, 0x6A, 0x5B, 0xDA, 0x7E, 0x7B, 
+0x13, 0xD7, 0x86, 0x5C, 0x38, 0xF1, 0xF0, 0x87, 
+0x6D, 0xBE, 0xEF, 0xDC, 0x50, 0x09, 0x76, 0x2A, 
+0x08, 0xBB, 0x04, 0x9C, 0xB1, 0xF7, 0xF2, 0xF6, 
+0x99, 0xBF, 0x3B, 0x3F, 0x0E, 0x4D, 0xC4, 0x4E, 
+0x57, 
+
+0x0F, 0xB9, 0xE7, 0xAF, 0x33, 0xF7, 0x1A, 0x90, 
+0x4B, 0x0E, 0x3D, 0x5E, 0x0B, 0x0D, 0x20, 0x7F, 
+0x29, 0xF4, 0x0F, 0xA9, 0x13, 0x1C, 0xAF, 0xCC, 
+0x51, 0x5B, 0x11, 0xEE, 0x20, 0xAC, 0x1D, 0xF2, 
+0xEF, 0x13, 0x32, 0x1E, 0xEF, 0xD8, 0x41, 0x38, 
+0xFA, 0xC0, 0x28, 0xF8, 0xC8, 0x55, 0x04, 0xB2, 
+0xEA, 0x62, 0x93, 0x50, 0x55, 0x10, 0x58, 0x8C, 
+0xFE, 0x00, 0x5A, 0x3D, 0x00, 0x6D, 0xF3, 0x67, 
+0x40, 0x6C, 0xC0, 0x0A, 0xA7, 0x92, 0x29, 0x8A, 
+0xB2, 0x94, 0xD5, 0x2F, 0xFA, 0x57, 0x09, 0xF1, 
+0x8B, 0x4F, 0xDF, 0xE9, 0x65, 0xE1, 0xA2, 0x9B, 
+0xD8, 0x5A, 0x0F, 0x97, 0x9A, 0x77, 0x41, 0x5D, 
+0xF2, 0xC7, 0x9C, 0x21, 0x33, 0xC7, 0x37, 0x85, 
+0xCE, 0x11, 0xE4, 0x4D, 0x06, 0xC3, 0x53, 0xF4, 
+0xD7, 0x49, 0x2F, 0xED, 0xA6, 0x9B, 0xEB, 0xD9, 
+0xBE, 0x94, 0xCA, 0x3D, 0x85, 0xE2, 0x1C, 0x26, 
+0xEF, 0x8E, 0x8A, 0xB6, 0x6D, 0xA0, 0x26, 0x4E, 
+0xAD, 0x21, 
+
+0x6D, 0x1F, 0xD8, 0xDE, 0xA0, 0xFB, 0xEA, 0x80, 
+0xD6, 0x38, 0x7E, 0x21, 0x2D, 0xFA, 0xBB, 0x86, 
+0xB5, 0xA3, 0xC8, 0xC7, 0x46, 0x04, 0xC9, 0x84, 
+0xB7, 0xB1, 0xBF, 0x00, 0x83, 0x7E, 0xD2, 0x08, 
+0x17, 0x40, 0x1F, 0xE1, 0xB4, 0x47, 0xCB, 0x80, 
+0xB7, 0xBC, 0xCC, 0xC0, 0x8E, 0xA1, 0x0A, 0x8D, 
+0x45, 0x02, 0xF7, 0xDE, 0xF9, 0x2B, 0xC5, 0xE5, 
+0x4F, 0x5C, 0x19, 0x77, 0xF3, 0x1A, 0x9B, 0x41, 
+0x66, 0x03, 0x20, 0x1C, 0x19, 0x6C, 0xB6, 0xC5, 
+0x70, 0xF4, 0xC3, 0x1C, 0xA0, 0xA9, 0x3C, 0xF5, 
+0xBE, 0x92, 0x03, 0xFC, 0x07, 0x85, 0x0D, 0x7B, 
+0x83, 0xB6, 0xE7, 0xD1, 0x5C, 0xB3, 0x85, 0xF4, 
+0xEC, 0x40, 0xFF, 0x48, 0x1D, 0x13, 0x63, 0x3F, 
+0xE8, 0xB2, 0x10, 0x55, 0x0F, 0x03, 0x80, 0x98, 
+0x80, 0x70, 0x47, 0x7E, 0x5F, 0xA3, 0xB8, 0xB1, 
+0xE9, 0xF5, 0x8C, 0xC1, 0x59, 0x51, 0xCB, 0x25, 
+0x0E, 0x30, 0x67, 0x04, 0x16, 0xAC, 0xFB, 0x2B, 
+0x97, 0xA7, 0x9E, 
+
+0x0D, 0xA7, 0x5E, 0xD9, 0x68, 0x31, 0xE8, 0xEE, 
+0xC9, 0x4C, 0xD0, 0x32, 0xA3, 0xA9, 0x95, 0x66, 
+0x5D, 0x10, 0x3F, 0xAC, 0x07, 0xA5, 0xD6, 0x6E, 
+0x68, 0x5D, 0x58, 0x7B, 0x73, 0xD4, 0xA6, 0x7C, 
+0x4A, 0x0A, 0x4C, 0x7D, 0xBC, 0xB6, 0xC0, 0x99, 
+0xCA, 0xC0, 0xED, 0x74, 0x8E, 0xB1, 0xE7, 0x56, 
+0x38, 0x17, 0x89, 0x25, 0x35, 0x6F, 0x41, 0x74, 
+0xF8, 0xDD, 0xB5, 0xE2, 0xDE, 0x11, 0x0C, 0x5B, 
+0x85, 0xE5, 0x33, 0xD9, 0x4A, 0xC7, 0x57, 0xC6, 
+0x42, 0xEE, 0x20, 0x53, 0xA0, 0xD6, 0x9A, 0x65, 
+0x8E, 0xCA, 0xE8, 0x4F, 0xD8, 0x4C, 0x93, 0x9E, 
+0x03, 0x3C, 0x95, 0x91, 0x74, 0x1F, 0x7A, 0x9B, 
+0xB7, 0x90, 0x82, 0xAB, 0x10, 0x4C, 0x1A, 0x80, 
+0xA6, 0x7E, 0x38, 0x9D, 0xFE, 0x35, 0x23, 0xC6, 
+0xDF, 0x2C, 0x83, 0xF3, 0x20, 0x55, 0x5B, 0x82, 
+0x8F, 0x9C, 0x30, 0x57, 0x26, 0x54, 0xA7, 0xC3, 
+0x97, 0x9D, 0xAA, 0x1C, 0xF3, 0xB3, 0xFD, 0x39, 
+0x6D, 0xB6, 0xC4, 0x77, 
+
+0x00, 0x6C, 0x01, 0x4C, 0xF9, 0xDB, 0x66, 0xBE, 
+0xE9, 0x65, 0xCA, 0x19, 0xC9, 0x8E, 0xEF, 0x83, 
+0x26, 0xBD, 0x38, 0x4B, 0x9A, 0x99, 0x30, 0xFE, 
+0xB3, 0xEB, 0x50, 0xA8, 0xA9, 0x09, 0x42, 0x06, 
+0x31, 0x2E, 0x32, 0x29, 0x49, 0x7D, 0x4D, 0x2B, 
+0x86, 0x5F, 0xAC, 0xE4, 0x1A, 0x62, 0x7D, 0x58, 
+0xCD, 0x3D, 0x80, 0xE8, 0x33, 0x07, 0x03, 0x85, 
+0x6F, 0x14, 0x1A, 0x39, 0x50, 0x63, 0xCE, 0x1A, 
+0xEF, 0xA7, 0x66, 0xF7, 0xC2, 0xE8, 0x95, 0x33, 
+0xD1, 0x1A, 0x91, 0x50, 0x93, 0xB2, 0x24, 0xE0, 
+0xA4, 0x33, 0xBD, 0x9B, 0xFC, 0xA3, 0x03, 0xFF, 
+0x78, 0x9C, 0xBE, 0xB6, 0xAD, 0x5C, 0xD8, 0xFB, 
+0xE5, 0xB7, 0xB3, 0x14, 0x37, 0x70, 0x74, 0x2B, 
+0xF2, 0x75, 0xE7, 0x78, 0x13, 0x50, 0x93, 0xD1, 
+0x91, 0x5F, 0x73, 0xAC, 0x3F, 0x0F, 0x9E, 0x45, 
+0x3C, 0x40, 0xD5, 0xA3, 0xA9, 0x58, 0x7E, 0xAB, 
+0xF5, 0x2A, 0xEF, 0x01, 0x3E, 0x6B, 0xC7, 0x69, 
+0x17, 0x42, 0xA5, 0xE9, 0x54, 
+
+0x2C, 0xD7, 0x5A, 0x30, 0xCE, 0x44, 0x94, 0x30, 
+0x4E, 0x71, 0xBE, 0xB9, 0x76, 0x33, 0x76, 0x8A, 
+0x4A, 0xBC, 0x68, 0x67, 0xCE, 0x1D, 0x89, 0x84, 
+0x25, 0x32, 0xEF, 0xB8, 0x07, 0x74, 0x1B, 0x6C, 
+0x60, 0x5F, 0x15, 0xAA, 0xC4, 0x38, 0x8D, 0x6C, 
+0x27, 0xDB, 0xA5, 0x96, 0x62, 0xA5, 0xC4, 0x61, 
+0x40, 0x68, 0x51, 0x6A, 0xD2, 0xC7, 0x17, 0xDD, 
+0xC6, 0x48, 0x84, 0x45, 0xFF, 0x38, 0x38, 0x17, 
+0x5B, 0x60, 0xFE, 0xA9, 0x68, 0xA2, 0x9D, 0x88, 
+0x6B, 0x6B, 0x03, 0x22, 0xDA, 0xF0, 0x24, 0x6E, 
+0x44, 0x7A, 0x64, 0xF1, 0x99, 0xF9, 0x8F, 0x19, 
+0xAE, 0x7B, 0x29, 0x43, 0x80, 0x03, 0x84, 0x63, 
+0xCB, 0x9E, 0x80, 0xEE, 0xE6, 0xCF, 0x85, 0xCD, 
+0xBC, 0xF3, 0xE3, 0x1F, 0x9B, 0x99, 0x8A, 0x1E, 
+0xAA, 0x70, 0x36, 0x4D, 0xBF, 0x2A, 0xCD, 0xDB, 
+0x40, 0xCB, 0x43, 0x86, 0xFB, 0xE3, 0x7F, 0x8D, 
+0x34, 0x43, 0xF5, 0xF9, 0x18, 0x8E, 0x76, 0x83, 
+0x39, 0x67, 0xBF, 0xBB, 0x8F, 0x30, 
+
+0xD4, 0x9F, 0xB4, 0xC4, 0x9D, 0x09, 0x6B, 0xEA, 
+0x1F, 0x19, 0xD8, 0x26, 0x7D, 0x12, 0x27, 0xF8, 
+0x47, 0xEC, 0x1C, 0x8D, 0xB1, 0x35, 0x68, 0xEF, 
+0x2F, 0x88, 0xE1, 0x38, 0x8B, 0x76, 0xEF, 0x40, 
+0x19, 0x6A, 0x22, 0x5C, 0xDF, 0xB3, 0xE3, 0xCF, 
+0x4A, 0x3F, 0x75, 0x65, 0x8F, 0xD4, 0xEE, 0xFB, 
+0x3D, 0xE8, 0xFE, 0xC3, 0x9A, 0x3D, 0xEE, 0xDA, 
+0x46, 0x75, 0x9E, 0x59, 0xC6, 0x4F, 0xE7, 0x30, 
+0xB6, 0x47, 0x4D, 0x60, 0x51, 0xCC, 0xFA, 0x86, 
+0x9F, 0xF0, 0x2E, 0x8D, 0x10, 0x90, 0x72, 0x99, 
+0x07, 0x65, 0x02, 0x77, 0xDF, 0x97, 0x8E, 0x42, 
+0x28, 0x16, 0xE9, 0x76, 0x4C, 0xBF, 0xE3, 0x0C, 
+0x16, 0x5D, 0x31, 0x4E, 0x03, 0xB1, 0xDB, 0x9D, 
+0xA1, 0x48, 0x54, 0x95, 0x83, 0xA7, 0xB7, 0x39, 
+0x32, 0x95, 0x39, 0xA4, 0xF6, 0xE1, 0xAA, 0x75, 
+0xE5, 0xDE, 0xAE, 0xDB, 0xE8, 0x7B, 0x51, 0xB8, 
+0xCA, 0x4A, 0x37, 0xF6, 0xFD, 0x17, 0xB5, 0x9A, 
+0xF4, 0xFD, 0x12, 0xF5, 0x19, 0x56, 0x2B, 
+
+0x70, 0x6B, 0x41, 0xF6, 0xBE, 0x3E, 0xB9, 0x2F, 
+0xF7, 0xC0, 0x6E, 0xB3, 0xD8, 0x06, 0xFD, 0x4A, 
+0xB7, 0x6D, 0xF9, 0x5B, 0x4D, 0xED, 0x42, 0x05, 
+0x22, 0x2A, 0x3D, 0x35, 0xFB, 0x12, 0x5E, 0x83, 
+0x7F, 0x35, 0xC0, 0x7D, 0x0C, 0x4C, 0xC7, 0x17, 
+0x6F, 0x90, 0xF8, 0x22, 0x83, 0x04, 0x8A, 0x32, 
+0x1B, 0xD3, 0x61, 0x8D, 0x6A, 0x0C, 0x41, 0x1E, 
+0xF8, 0xFC, 0xAC, 0x80, 0xC7, 0xF5, 0x1A, 0x32, 
+0xED, 0x75, 0x6D, 0x91, 0x90, 0x71, 0xE7, 0x3C, 
+0xD2, 0xC9, 0xF2, 0x41, 0xED, 0xDB, 0xD7, 0xAE, 
+0x4B, 0xA0, 0x7E, 0x7A, 0x10, 0x08, 0xEF, 0xC5, 
+0xCD, 0x98, 0x8A, 0x18, 0xC8, 0x25, 0x65, 0x92, 
+0x72, 0x2F, 0x9A, 0x3F, 0x2B, 0xFB, 0x1E, 0x52, 
+0x21, 0xC9, 0x18, 0x29, 0xC7, 0xFD, 0x6D, 0x4A, 
+0x7A, 0x41, 0x47, 0x7D, 0x7A, 0x20, 0x3A, 0xB6, 
+0xB7, 0x74, 0xDD, 0x1F, 0x73, 0xC3, 0xF1, 0xAE, 
+0xE6, 0xF1, 0x80, 0xEC, 0xD6, 0xB4, 0x0C, 0x9A, 
+0x91, 0xB9, 0xD9, 0x04, 0x02, 0xB0, 0xFE, 0xBD, 
+
+0xD4, 0x75, 0x92, 0xCE, 0x17, 0x38, 0xBB, 0xC1, 
+0x7B, 0xF6, 0xB2, 0x6F, 0x2A, 0x3A, 0x6C, 0x8B, 
+0x26, 0x5F, 0x9F, 0x44, 0xF1, 0x37, 0xD0, 0x36, 
+0x38, 0x5F, 0xB4, 0x05, 0x6F, 0xF1, 0x0A, 0xF2, 
+0x03, 0xE7, 0xBB, 0x54, 0xB8, 0x9A, 0x2D, 0x48, 
+0x78, 0xCB, 0x4F, 0x2A, 0x20, 0x66, 0x6B, 0x8C, 
+0x62, 0xB2, 0xE0, 0xF3, 0x93, 0x17, 0x40, 0x08, 
+0x05, 0x35, 0xF5, 0x9F, 0x28, 0x9C, 0xDF, 0x4C, 
+0x8F, 0x10, 0x8F, 0xB2, 0xDA, 0x3B, 0x6C, 0xBE, 
+0x0B, 0xF9, 0xE4, 0x23, 0xD7, 0x20, 0x01, 0x29, 
+0xDF, 0x04, 0x14, 0x24, 0x0C, 0x41, 0x00, 0x15, 
+0x43, 0x1C, 0x3B, 0x92, 0x64, 0x88, 0x02, 0x27, 
+0x67, 0x91, 0x5B, 0x4F, 0x44, 0xF9, 0xE9, 0x9F, 
+0xC6, 0xA5, 0x54, 0x2D, 0xFB, 0x10, 0x06, 0x1C, 
+0xE6, 0xAA, 0x1D, 0xE3, 0xDA, 0x47, 0x15, 0xF7, 
+0x65, 0x19, 0x92, 0xF4, 0x61, 0x52, 0xF3, 0x84, 
+0x4A, 0xBA, 0xF0, 0xEA, 0x5E, 0xF7, 0x2C, 0x87, 
+0xA8, 0xD3, 0x9E, 0x2C, 0x12, 0xEF, 0x53, 0x52, 
+0x50, 
+
+0xA0, 0xE8, 0xA9, 0xA5, 0x80, 0x9E, 0x99, 0x8B, 
+0xB4, 0x0C, 0x40, 0xCF, 0xE4, 0x65, 0x30, 0x93, 
+0x9A, 0x31, 0xD6, 0xE2, 0x95, 0xB3, 0x1A, 0xEC, 
+0x76, 0x9D, 0xE3, 0x42, 0x07, 0x14, 0xD5, 0x3B, 
+0xD3, 0xB2, 0xDA, 0x06, 0x73, 0x28, 0xBF, 0xF9, 
+0x24, 0x58, 0x5F, 0xC1, 0xFC, 0x7F, 0x93, 0xE1, 
+0xC6, 0xEF, 0x7D, 0x38, 0x75, 0x37, 0x47, 0xAF, 
+0x96, 0xB7, 0xF8, 0x31, 0x6C, 0x84, 0xC2, 0x84, 
+0x9E, 0x6E, 0x93, 0x21, 0xC0, 0xEE, 0x59, 0xE9, 
+0xD4, 0x8A, 0x28, 0xA8, 0x40, 0x8D, 0x72, 0xDA, 
+0x40, 0x9D, 0xA3, 0x09, 0x36, 0x5C, 0x5C, 0x9A, 
+0x11, 0x5D, 0x28, 0x99, 0x0A, 0x7B, 0xE7, 0x38, 
+0xEA, 0x97, 0xC8, 0x68, 0xD4, 0x08, 0xD9, 0xD2, 
+0xEC, 0x22, 0x0A, 0x7F, 0xEE, 0x55, 0x0D, 0xF2, 
+0x3A, 0xDF, 0xBF, 0xF6, 0x2D, 0x90, 0x10, 0xF1, 
+0xD3, 0xD5, 0xC7, 0x03, 0x5D, 0x93, 0xE4, 0x7E, 
+0xC5, 0x11, 0x07, 0xDC, 0x50, 0x79, 0xC6, 0xEE, 
+0xA6, 0x25, 0x2E, 0x33, 0x07, 0xE7, 0x6D, 0x81, 
+0x2E, 0x68, 
+
+0xE0, 0xA9, 0x7A, 0x25, 0x16, 0x15, 0x30, 0x66, 
+0xE2, 0x19, 0x5B, 0x39, 0x84, 0x58, 0x30, 0x66, 
+0x82, 0xA4, 0x6A, 0x4C, 0xD0, 0x2F, 0xEB, 0x12, 
+0x8A, 0x16, 0x8A, 0xF7, 0x40, 0x45, 0xE3, 0xC6, 
+0x44, 0x66, 0x91, 0x38, 0x64, 0x81, 0xB5, 0xEA, 
+0x8C, 0xBE, 0x51, 0xD2, 0x0D, 0x8E, 0x69, 0xA8, 
+0x64, 0xFE, 0xB6, 0x27, 0x78, 0xC9, 0x4A, 0x84, 
+0xE3, 0x71, 0x9F, 0xD4, 0xC0, 0x2D, 0x7C, 0xC5, 
+0x97, 0x8A, 0x40, 0xF7, 0xFB, 0x2D, 0x39, 0xD4, 
+0xE7, 0x67, 0x9C, 0x82, 0x08, 0xF3, 0xEC, 0x71, 
+0x08, 0x64, 0x2D, 0xCB, 0x04, 0x97, 0x04, 0x0C, 
+0x98, 0x3C, 0x3A, 0x41, 0xAD, 0xD1, 0xF0, 0x61, 
+0x8B, 0x52, 0x66, 0xFB, 0x5E, 0x5F, 0x99, 0xE2, 
+0x2C, 0xC4, 0x08, 0x91, 0x68, 0x87, 0xE3, 0xF2, 
+0xC6, 0x94, 0x43, 0x94, 0x08, 0xB0, 0x8B, 0xBD, 
+0xA6, 0x9B, 0x2E, 0x10, 0xAB, 0x35, 0x43, 0x3D, 
+0x43, 0x28, 0x51, 0xC5, 0x33, 0x73, 0x77, 0x93, 
+0x10, 0xC8, 0x1E, 0xA5, 0x3A, 0x32, 0x6C, 0xD5, 
+0xA1, 0x3A, 0xA1, 
+
+0x5C, 0xF9, 0x4D, 0x5A, 0x59, 0xCD, 0xE7, 0x6E, 
+0xCE, 0x52, 0xA1, 0x9B, 0x80, 0xB3, 0x7B, 0xC4, 
+0x83, 0x2F, 0x81, 0xA0, 0x38, 0xFE, 0xDF, 0xCC, 
+0x42, 0x21, 0x16, 0x63, 0x55, 0x10, 0xF1, 0x9E, 
+0x2B, 0x5C, 0x66, 0x22, 0x29, 0x23, 0x64, 0x05, 
+0x37, 0xE3, 0x14, 0x4C, 0xE9, 0x3E, 0x80, 0x59, 
+0x61, 0xA5, 0x7D, 0x67, 0x4F, 0xCD, 0x9C, 0x63, 
+0xB6, 0x20, 0x77, 0xD0, 0xA1, 0x8A, 0xCA, 0xE4, 
+0x90, 0x41, 0x4D, 0x3B, 0x92, 0xAD, 0xFB, 0xE0, 
+0xE1, 0x2A, 0x94, 0xF0, 0x45, 0x19, 0x99, 0xF7, 
+0xAD, 0xEB, 0x6B, 0x66, 0xB5, 0x7C, 0x77, 0x4F, 
+0xF1, 0x9B, 0xF3, 0x58, 0x0F, 0x40, 0x56, 0xB8, 
+0xD6, 0x18, 0x26, 0x77, 0x40, 0x41, 0x61, 0x2D, 
+0x48, 0x27, 0xFB, 0x4B, 0xB4, 0x05, 0x98, 0xB7, 
+0x97, 0x81, 0x2D, 0x00, 0x9E, 0xC0, 0x61, 0x1A, 
+0xD8, 0x07, 0xF3, 0x2E, 0x33, 0x60, 0x6C, 0xDD, 
+0xE9, 0x3C, 0x8A, 0xEF, 0x15, 0x48, 0xED, 0xE4, 
+0xED, 0x83, 0x41, 0x4E, 0x3B, 0x40, 0xFE, 0xCD, 
+0x59, 0xBF, 0x79, 0xEB, 
+
+0x2B, 0xAD, 0xC7, 0xC4, 0x3B, 0x04, 0xAD, 0x02, 
+0x7C, 0xF1, 0xB2, 0x71, 0xD2, 0x39, 0x03, 0x3B, 
+0x72, 0xF3, 0x3E, 0x44, 0xDA, 0x24, 0x95, 0xC2, 
+0xB0, 0x70, 0xFF, 0x86, 0xE4, 0x91, 0x57, 0x81, 
+0xED, 0xE0, 0x77, 0x50, 0xAD, 0xEB, 0x48, 0x1C, 
+0x25, 0x10, 0xEA, 0xD7, 0x5A, 0x16, 0x12, 0x7C, 
+0x0E, 0x6C, 0x83, 0x67, 0x27, 0xCC, 0x40, 0xA3, 
+0x48, 0x2F, 0x6E, 0xEE, 0xAE, 0x76, 0x76, 0x7C, 
+0x57, 0x37, 0xDC, 0x27, 0xBE, 0xB5, 0x35, 0x69, 
+0xD1, 0x92, 0xF9, 0x12, 0xBF, 0xAC, 0x4F, 0x54, 
+0x60, 0x60, 0x63, 0x8D, 0x59, 0x2C, 0x56, 0xBD, 
+0xB7, 0xBB, 0xCB, 0xB8, 0x8C, 0xAA, 0xBE, 0x96, 
+0xF5, 0x5A, 0xF2, 0x19, 0xBB, 0xEF, 0x6E, 0xBD, 
+0x47, 0x8F, 0xE2, 0x27, 0x1D, 0x17, 0x99, 0x79, 
+0x44, 0x8D, 0xE5, 0x58, 0xE4, 0xB9, 0x8B, 0xDD, 
+0x01, 0x3C, 0x1A, 0x5D, 0xAC, 0x2B, 0x71, 0x7A, 
+0x97, 0x34, 0xA8, 0x00, 0xDC, 0x8F, 0x6C, 0xF0, 
+0x41, 0x71, 0xFF, 0x45, 0x74, 0x7F, 0x0B, 0x39, 
+0xE2, 0xA1, 0x23, 0xF7, 0xD7, 
+
+0x8B, 0x99, 0xB0, 0xBA, 0xB1, 0xE6, 0x52, 0x7B, 
+0xDB, 0x1A, 0xE4, 0x99, 0xE5, 0x63, 0xC5, 0xD8, 
+0xD4, 0x75, 0x3C, 0xCB, 0x3B, 0xF7, 0x88, 0x84, 
+0xE3, 0x3C, 0x17, 0x5B, 0x32, 0x52, 0x1A, 0xD3, 
+0x45, 0x6D, 0xAE, 0x04, 0x88, 0xC2, 0xC4, 0x6B, 
+0x1C, 0x2E, 0xCD, 0x1D, 0x29, 0xE2, 0xDF, 0xE9, 
+0x51, 0x1A, 0x77, 0x7C, 0x24, 0x22, 0xDC, 0x83, 
+0xFE, 0x65, 0x16, 0xD9, 0xE9, 0xF5, 0x04, 0x61, 
+0xB4, 0x4E, 0xC7, 0xFE, 0xFA, 0x84, 0xAA, 0x65, 
+0xBD, 0x83, 0x47, 0xEF, 0x2A, 0xFA, 0x45, 0x03, 
+0x9F, 0xAD, 0x2C, 0x81, 0x03, 0x18, 0x72, 0x66, 
+0x0C, 0x7A, 0x90, 0x9A, 0x78, 0xFD, 0xD3, 0x1F, 
+0x7B, 0x78, 0xC0, 0x32, 0x7D, 0xE3, 0x05, 0xC1, 
+0x88, 0x63, 0x25, 0xA3, 0x19, 0xBD, 0xCB, 0xE8, 
+0xB2, 0xE7, 0xF9, 0x6B, 0xA4, 0xF0, 0xD6, 0x42, 
+0x50, 0xD8, 0x17, 0xE3, 0x32, 0xCC, 0x90, 0x48, 
+0x1D, 0xFB, 0xD1, 0x59, 0x25, 0xE2, 0x72, 0xE7, 
+0x1E, 0x24, 0xE2, 0x5A, 0x91, 0x46, 0xD8, 0x96, 
+0x69, 0xC7, 0x24, 0x1D, 0x10, 0x8B, 
+
+0x75, 0x85, 0xA7, 0x67, 0xDD, 0x20, 0x96, 0x55, 
+0x77, 0xBC, 0xD3, 0x9D, 0x5F, 0xE8, 0x57, 0xCA, 
+0x4C, 0x28, 0x44, 0x10, 0x1C, 0xBB, 0xA7, 0xD5, 
+0x3A, 0xCD, 0x8F, 0x22, 0x86, 0x40, 0x0D, 0x02, 
+0x56, 0xA4, 0xEE, 0x8C, 0xEF, 0x76, 0x8D, 0x03, 
+0xB7, 0xCB, 0xE1, 0x75, 0x61, 0xB1, 0xBE, 0xB0, 
+0x02, 0x31, 0xF1, 0x6F, 0xB2, 0x33, 0x86, 0x81, 
+0x13, 0x9F, 0x6F, 0xC9, 0xA9, 0xC0, 0x87, 0x00, 
+0x8D, 0xA7, 0x6E, 0xCF, 0x36, 0x17, 0x97, 0x32, 
+0xF0, 0x15, 0xC1, 0xCF, 0x0F, 0x14, 0xAD, 0xEC, 
+0xDE, 0xEC, 0x6A, 0xAB, 0x93, 0xCE, 0x42, 0xF1, 
+0xE2, 0xCF, 0xDA, 0xAE, 0xCF, 0xD1, 0x50, 0xCD, 
+0x09, 0xA0, 0x66, 0x34, 0x3C, 0xC2, 0x5E, 0xDC, 
+0xF4, 0xE2, 0x12, 0x66, 0x45, 0x22, 0x93, 0xEC, 
+0x92, 0x3E, 0x4C, 0x26, 0x39, 0x54, 0xD3, 0x7C, 
+0x19, 0x18, 0x08, 0x21, 0x10, 0xCE, 0x3A, 0xA2, 
+0x42, 0x07, 0x92, 0x1B, 0x11, 0xE3, 0x34, 0xAB, 
+0x1A, 0xBC, 0xA6, 0x8A, 0x37, 0xD5, 0xBB, 0x92, 
+0x03, 0x78, 0x3F, 0xD7, 0xE9, 0xA1, 0x0A, 
+
+0xC3, 0xED, 0x3C, 0xB8, 0x87, 0x5B, 0x38, 0xE8, 
+0xAE, 0xD5, 0x20, 0x88, 0x57, 0x70, 0x27, 0x6D, 
+0x17, 0x3B, 0x86, 0x64, 0xB7, 0x79, 0x27, 0x43, 
+0x91, 0x40, 0x7D, 0x5A, 0x02, 0x22, 0x96, 0xAC, 
+0x8C, 0xDC, 0x3B, 0x3B, 0x22, 0xA4, 0xE0, 0x1E, 
+0xAD, 0x63, 0xAE, 0xED, 0xB6, 0x4C, 0x3B, 0xAB, 
+0xC3, 0x54, 0x15, 0xF2, 0xF2, 0x01, 0x65, 0x4D, 
+0x5B, 0x65, 0x3A, 0x71, 0xF7, 0xF0, 0x53, 0x3F, 
+0xD7, 0x83, 0x15, 0xB5, 0xEA, 0xC5, 0x0A, 0xF9, 
+0xD1, 0xAE, 0x2A, 0x71, 0xA7, 0x30, 0x42, 0x3F, 
+0x7D, 0xDC, 0x2F, 0xA7, 0xC7, 0x15, 0x61, 0x56, 
+0x6C, 0xB5, 0xB7, 0x47, 0xE3, 0x7F, 0xE2, 0xD5, 
+0xF8, 0x4B, 0x8A, 0x98, 0xA9, 0x80, 0x60, 0x73, 
+0x74, 0x04, 0x75, 0xC0, 0x63, 0xA3, 0x9F, 0x1E, 
+0xA8, 0xDB, 0x22, 0xB3, 0x44, 0xC0, 0xE9, 0xB7, 
+0x6B, 0x02, 0x86, 0xB5, 0x7E, 0x65, 0xC0, 0x9D, 
+0x6D, 0x86, 0x19, 0x54, 0x93, 0xBD, 0xCE, 0xFB, 
+0xB7, 0xA7, 0x58, 0x45, 0xC2, 0xA4, 0xFB, 0x9D, 
+0xD9, 0x9C, 0xF1, 0x24, 0xD1, 0x6F, 0x07, 0xE4, 
+
+0xF4, 0x20, 0xA7, 0xFF, 0x79, 0x04, 0x2F, 0xB8, 
+0xCF, 0xFD, 0xB0, 0x78, 0xF4, 0xD7, 0x6D, 0x26, 
+0x7F, 0x02, 0x05, 0x43, 0x60, 0x70, 0xF8, 0x2A, 
+0xF4, 0x2E, 0x9A, 0xC2, 0xB9, 0x4C, 0x26, 0xDF, 
+0xEA, 0x8C, 0x60, 0xC9, 0x56, 0xB5, 0x4B, 0xCE, 
+0xD0, 0x69, 0xAE, 0xA4, 0x44, 0xE2, 0x0F, 0xC2, 
+0x44, 0xE5, 0x6F, 0x2F, 0xC4, 0xA5, 0xBF, 0xDE, 
+0x8C, 0x44, 0xDD, 0xF8, 0xB7, 0x14, 0x15, 0xAD, 
+0x24, 0x92, 0xF1, 0x2B, 0x33, 0x87, 0x14, 0xD5, 
+0x69, 0x30, 0xBF, 0x49, 0xD5, 0xE5, 0x88, 0xBA, 
+0x66, 0x61, 0x55, 0x44, 0x00, 0xE7, 0x00, 0x61, 
+0xA8, 0xF1, 0x52, 0xB0, 0x2A, 0xBC, 0x48, 0x87, 
+0xCD, 0x72, 0xAE, 0xAF, 0x4F, 0x87, 0x19, 0x22, 
+0xA1, 0x92, 0x25, 0x37, 0xE6, 0x92, 0x90, 0xBC, 
+0xDC, 0x8E, 0x78, 0x7A, 0x92, 0x35, 0xAF, 0xF9, 
+0x50, 0xBE, 0x37, 0xAD, 0x79, 0x56, 0x88, 0x02, 
+0xD9, 0xB0, 0xC3, 0xF0, 0x1A, 0x8C, 0xDA, 0xCD, 
+0xD7, 0x7F, 0xDC, 0x18, 0x30, 0x53, 0x4F, 0x83, 
+0x65, 0x26, 0x20, 0x2A, 0xA1, 0xB1, 0x99, 0x5A, 
+0x5F, 
+
+0xEB, 0x83, 0xA7, 0x3F, 0x1C, 0xE0, 0x7B, 0x07, 
+0x33, 0xE5, 0xE1, 0xD3, 0xF1, 0x81, 0x0F, 0x4E, 
+0x68, 0xDB, 0x65, 0x14, 0xBE, 0x6F, 0xD4, 0x4E, 
+0x52, 0x2D, 0xFB, 0x06, 0x28, 0xEE, 0x70, 0x5B, 
+0x0E, 0xEF, 0xB7, 0xAF, 0x07, 0xF6, 0x91, 0x28, 
+0xF7, 0xDD, 0x4E, 0x54, 0xA7, 0x5D, 0xC9, 0xB1, 
+0x36, 0x41, 0xAD, 0xEC, 0xE7, 0x31, 0xD0, 0x88, 
+0xFC, 0x38, 0x51, 0xFE, 0xDE, 0xE9, 0xC3, 0x0A, 
+0xD6, 0x56, 0x0C, 0xFF, 0x95, 0xBC, 0x20, 0xAB, 
+0x9B, 0x52, 0x71, 0x61, 0x4D, 0xFE, 0x78, 0x89, 
+0x9F, 0x19, 0xF4, 0xA9, 0x88, 0xC2, 0xE8, 0xEC, 
+0xBC, 0xB5, 0xB2, 0x2E, 0xE3, 0x9E, 0xCC, 0xFA, 
+0xBF, 0xD6, 0xA5, 0xDA, 0x32, 0xE7, 0x5D, 0x93, 
+0x95, 0xFA, 0x4C, 0x2A, 0x20, 0x06, 0x5D, 0xCC, 
+0x3A, 0x4E, 0x02, 0x19, 0x61, 0x40, 0x04, 0x47, 
+0x24, 0x9A, 0x17, 0x7A, 0x51, 0x15, 0xE3, 0x88, 
+0xB3, 0x0A, 0x8A, 0x2E, 0x29, 0x08, 0xBA, 0x47, 
+0xA7, 0x9D, 0x85, 0x77, 0x49, 0x42, 0x78, 0x9F, 
+0x5A, 0x47, 0xB4, 0x21, 0xCD, 0x8A, 0x34, 0xB6, 
+0x36, 0xAE, 
+
+0xDA, 0xDA, 0xC6, 0xDB, 0x66, 0xC6, 0x52, 0x11, 
+0xC9, 0x64, 0xF9, 0xFB, 0x06, 0xC6, 0xFA, 0xC6, 
+0xD0, 0x7B, 0xBE, 0x91, 0x7E, 0x3C, 0xFD, 0x1C, 
+0xCF, 0x51, 0xBD, 0x35, 0xFE, 0x25, 0x20, 0x07, 
+0x1B, 0x9A, 0x5B, 0xBB, 0xA5, 0x9C, 0x5A, 0xAC, 
+0xC2, 0xF0, 0x19, 0x1B, 0xCF, 0x4B, 0x0F, 0x6D, 
+0xD7, 0xBE, 0x9C, 0x65, 0x94, 0x3A, 0xAA, 0xEB, 
+0x0B, 0xE6, 0xFE, 0x22, 0x73, 0x44, 0x98, 0x08, 
+0xAB, 0x01, 0xC3, 0x52, 0x34, 0x9F, 0xF6, 0x3E, 
+0xE5, 0x5D, 0x6B, 0xC1, 0x54, 0x3E, 0x6F, 0xCA, 
+0xBF, 0x4A, 0x32, 0xD2, 0xB9, 0x24, 0xF9, 0x3B, 
+0x7C, 0x9C, 0xFD, 0xA3, 0x0A, 0x34, 0x33, 0x74, 
+0xCB, 0xDC, 0x85, 0x2A, 0x87, 0x91, 0xAE, 0xF8, 
+0xD7, 0xAF, 0xFB, 0x0E, 0x31, 0x40, 0x0D, 0x60, 
+0xDE, 0x35, 0x93, 0xD0, 0x6A, 0x74, 0xFE, 0x05, 
+0xC0, 0x79, 0x71, 0x00, 0x2F, 0x4E, 0xD4, 0xDF, 
+0xF7, 0x1E, 0x1C, 0xC2, 0xB7, 0xDF, 0x70, 0xFC, 
+0x38, 0x1B, 0x33, 0x16, 0x41, 0x4D, 0xC6, 0x07, 
+0xF9, 0x07, 0x57, 0xA2, 0x65, 0xED, 0x4F, 0xEE, 
+0x3C, 0x16, 0x90, 
+
+0xA5, 0x5A, 0xC1, 0x64, 0xCE, 0x42, 0x80, 0xA9, 
+0x74, 0x35, 0xC6, 0x3A, 0x0A, 0x53, 0xCA, 0x6E, 
+0x5A, 0x1B, 0xF9, 0xD4, 0xEC, 0x2A, 0xB9, 0x47, 
+0xCA, 0xFD, 0x53, 0x36, 0x2F, 0x5C, 0xF6, 0x8B, 
+0x0B, 0xED, 0xAC, 0x4B, 0x1E, 0x1D, 0x65, 0x31, 
+0x07, 0x02, 0xC5, 0xC8, 0x7A, 0x6C, 0xF6, 0xBE, 
+0x8E, 0xBB, 0x76, 0x1F, 0x25, 0xFD, 0xB8, 0xEF, 
+0xD0, 0xA0, 0x3B, 0x0A, 0x4E, 0x54, 0x44, 0x1B, 
+0x2A, 0x72, 0xAD, 0x57, 0x7C, 0x81, 0x83, 0xD8, 
+0x12, 0x34, 0xB2, 0x95, 0x56, 0xA4, 0xA6, 0xF4, 
+0x64, 0xC9, 0xBF, 0xEA, 0x31, 0xC4, 0x59, 0xDB, 
+0x46, 0x73, 0x3A, 0xCA, 0xB8, 0xA2, 0x3B, 0xFA, 
+0x0F, 0x5C, 0xDC, 0xD7, 0x24, 0x6A, 0xAD, 0x39, 
+0xC4, 0xBC, 0x0E, 0x55, 0x3A, 0xD0, 0x3B, 0x56, 
+0xD8, 0xFC, 0x6C, 0x29, 0x0F, 0xF4, 0x08, 0x37, 
+0x91, 0xDF, 0x4F, 0x56, 0xEB, 0x67, 0x01, 0x5D, 
+0xB4, 0x9D, 0xA1, 0x91, 0x69, 0xA0, 0xD8, 0x55, 
+0x13, 0xE3, 0xBD, 0x50, 0x7B, 0x95, 0xDB, 0x11, 
+0x91, 0x4A, 0xDE, 0x60, 0x3A, 0x59, 0x44, 0x1E, 
+0xF3, 0x47, 0x43, 0xD0, 
+
+0x8F, 0xBF, 0x94, 0xE5, 0x1F, 0x4E, 0x74, 0x6A, 
+0x57, 0x16, 0x68, 0x45, 0x91, 0x24, 0x4A, 0x7E, 
+0x83, 0xD6, 0x09, 0x04, 0xF7, 0xD3, 0x93, 0xC4, 
+0x1F, 0x01, 0x4C, 0xDD, 0xD7, 0x65, 0x3C, 0xFB, 
+0xF7, 0x47, 0x7A, 0xB2, 0x9F, 0xD8, 0xC8, 0x29, 
+0x4B, 0x77, 0xFF, 0x39, 0xC7, 0xFE, 0xD1, 0xD5, 
+0x1C, 0x21, 0x23, 0xED, 0x8E, 0x02, 0xB9, 0x91, 
+0xA9, 0x4F, 0xA8, 0x1B, 0x64, 0xD4, 0x6D, 0xF0, 
+0xDA, 0x57, 0x75, 0xCC, 0x95, 0x04, 0x11, 0x71, 
+0xA5, 0x5D, 0xFC, 0x71, 0xC3, 0x28, 0x2A, 0xF0, 
+0x1C, 0x1C, 0x60, 0xAD, 0x74, 0x67, 0x39, 0x7E, 
+0x2E, 0xBB, 0x83, 0xFF, 0xF8, 0x02, 0x7F, 0xAB, 
+0x19, 0x37, 0x2D, 0xCF, 0x6D, 0xC8, 0x9E, 0x56, 
+0xA0, 0x61, 0x00, 0x7E, 0x5E, 0xA8, 0xB2, 0x02, 
+0xFF, 0xCE, 0x9D, 0xBF, 0x9B, 0x5C, 0xF2, 0x8F, 
+0xCE, 0x09, 0x9D, 0xD7, 0x85, 0x76, 0xC9, 0x5E, 
+0x00, 0x97, 0x13, 0x89, 0x08, 0xF8, 0xD3, 0xAE, 
+0x0F, 0xEE, 0x09, 0xD5, 0x41, 0xB0, 0xD4, 0x3F, 
+0x36, 0x6B, 0xD1, 0x50, 0x7A, 0xE8, 0x24, 0xA0, 
+0x07, 0x24, 0x2E, 0x3D, 0x14, 
+
+0x12, 0x64, 0x25, 0x40, 0xBB, 0xF1, 0x28, 0x60, 
+0x95, 0xED, 0x6F, 0xE9, 0x01, 0xA3, 0x9C, 0x07, 
+0xD5, 0x3A, 0x8E, 0xAB, 0xE0, 0x43, 0xEB, 0xF2, 
+0x8C, 0x36, 0x43, 0xB6, 0x11, 0x95, 0x55, 0x79, 
+0x87, 0x69, 0x26, 0x76, 0x3A, 0xA3, 0x47, 0xDB, 
+0x37, 0x23, 0x96, 0xAB, 0x0E, 0x3B, 0x3D, 0x81, 
+0x14, 0x3D, 0x74, 0x96, 0xF2, 0x4B, 0xE2, 0x96, 
+0x8F, 0xB9, 0x74, 0x8C, 0x83, 0x16, 0x00, 0x34, 
+0xAD, 0x1E, 0xFB, 0x63, 0xA9, 0x93, 0x92, 0xCF, 
+0xD6, 0xDE, 0x29, 0x2C, 0xD7, 0x3F, 0x8A, 0x93, 
+0x0F, 0xA5, 0x63, 0x94, 0x7F, 0xA3, 0x6C, 0x68, 
+0x20, 0x3D, 0x6D, 0xC2, 0xEC, 0x42, 0x15, 0xA3, 
+0x6C, 0x59, 0xDD, 0x47, 0xE6, 0x51, 0xE6, 0xE3, 
+0xFD, 0x6F, 0x1E, 0x0F, 0xFC, 0x98, 0x75, 0xD2, 
+0x82, 0xC6, 0x02, 0xF8, 0xAA, 0x8D, 0x36, 0x33, 
+0xFF, 0xF5, 0x3A, 0x9F, 0xCC, 0x8B, 0x1F, 0x47, 
+0x47, 0x9E, 0x2E, 0x96, 0xF2, 0xD4, 0x66, 0xB2, 
+0x05, 0xCB, 0xF0, 0x20, 0x63, 0x45, 0x53, 0x60, 
+0x60, 0xFD, 0x76, 0x09, 0xF2, 0x00, 0x45, 0xB4, 
+0x68, 0xD4, 0x7C, 0xBD, 0x0B, 0x25, 
+
+0x80, 0x96, 0x2E, 0xAA, 0x7C, 0xF7, 0x1F, 0x50, 
+0x77, 0x3F, 0x41, 0xF5, 0xD8, 0xF0, 0x4C, 0xCA, 
+0xDF, 0x48, 0xFB, 0xE1, 0x03, 0xBD, 0x10, 0xFB, 
+0x35, 0x58, 0x50, 0x38, 0x30, 0x4C, 0x1D, 0x85, 
+0x41, 0x59, 0xEF, 0x6F, 0x8C, 0xF1, 0xC5, 0xAF, 
+0xDA, 0x48, 0xD9, 0x58, 0xB8, 0x43, 0xCC, 0x01, 
+0x3D, 0xC8, 0xA8, 0xA7, 0xFD, 0x1D, 0xFA, 0xE7, 
+0x61, 0x45, 0x5C, 0xD3, 0x64, 0x5E, 0x76, 0xDF, 
+0xAE, 0x4A, 0xC2, 0x15, 0x28, 0xB1, 0xCB, 0x06, 
+0x58, 0x00, 0xFC, 0x3B, 0x56, 0x5F, 0xD4, 0xEA, 
+0x55, 0xFA, 0xBA, 0x33, 0x9D, 0x67, 0x9C, 0xF3, 
+0x02, 0xEA, 0x08, 0xBD, 0x91, 0x13, 0x57, 0x5A, 
+0x36, 0xC7, 0x72, 0xD7, 0xC0, 0x8E, 0x99, 0xDD, 
+0x6B, 0x3A, 0xEB, 0x42, 0xC2, 0xDA, 0x09, 0xAE, 
+0x1B, 0x98, 0xA7, 0xDF, 0x3D, 0xBF, 0xAE, 0x3E, 
+0x9C, 0xFE, 0x17, 0xDC, 0xED, 0x2E, 0xC2, 0x78, 
+0xD0, 0x22, 0xAD, 0x76, 0x29, 0x43, 0xBC, 0xAB, 
+0x1D, 0x91, 0x07, 0xD8, 0x6F, 0x85, 0x71, 0x56, 
+0xBF, 0x2A, 0xC2, 0x38, 0xB0, 0x9E, 0xB5, 0x2C, 
+0xB3, 0x54, 0x9A, 0x89, 0xBC, 0x68, 0x2A, 
+
+0x2C, 0x5A, 0x00, 0x13, 0xE3, 0x71, 0x9D, 0x9D, 
+0x73, 0xDD, 0xA7, 0xA3, 0x38, 0x5E, 0xE9, 0x74, 
+0x86, 0x04, 0xA8, 0xBB, 0x59, 0x76, 0x7B, 0xA7, 
+0xEE, 0x00, 0x28, 0x20, 0xBB, 0x17, 0x38, 0xEE, 
+0xC7, 0x85, 0xBF, 0xEA, 0xE3, 0x03, 0x1F, 0x48, 
+0x5E, 0xB3, 0x85, 0xA0, 0xE1, 0xF6, 0x4C, 0xA1, 
+0x9D, 0xC7, 0x0C, 0x20, 0xF9, 0x6E, 0x7E, 0xD8, 
+0x70, 0x4C, 0xDB, 0x92, 0x86, 0x0F, 0xBB, 0xC3, 
+0x62, 0x05, 0x45, 0x57, 0x04, 0xA4, 0x17, 0xDC, 
+0x72, 0x18, 0xDA, 0x77, 0xD5, 0x44, 0x78, 0xD0, 
+0x34, 0xB2, 0x2C, 0xA8, 0xD2, 0xB0, 0x65, 0x29, 
+0x24, 0x3E, 0x40, 0xCD, 0x31, 0x3F, 0xC4, 0x12, 
+0x59, 0x40, 0x17, 0xBE, 0xCD, 0x86, 0x18, 0xC7, 
+0x68, 0x25, 0xC6, 0x60, 0x7C, 0x7F, 0x51, 0x3B, 
+0xCE, 0x70, 0xEE, 0x5E, 0xC1, 0xD0, 0x8D, 0x7E, 
+0x55, 0x73, 0x34, 0x0C, 0x75, 0xAD, 0x4D, 0x66, 
+0x7D, 0x0A, 0x3B, 0x8E, 0x2C, 0x26, 0x4E, 0x44, 
+0x71, 0x8F, 0xEC, 0xCE, 0x90, 0x32, 0x78, 0x8F, 
+0xE6, 0xC5, 0xC9, 0xF2, 0x9B, 0x35, 0x97, 0x6D, 
+0x65, 0xA9, 0x2D, 0xE5, 0x13, 0x89, 0xFC, 0x2E, 
+
+0x74, 0x39, 0xBC, 0x3C, 0x91, 0x5E, 0x7A, 0xE5, 
+0x70, 0xF9, 0x12, 0xB3, 0x43, 0x19, 0x2F, 0x3B, 
+0xFC, 0x67, 0x45, 0x0F, 0x30, 0xE3, 0xE8, 0x34, 
+0x37, 0x57, 0x91, 0x82, 0x42, 0x81, 0x4E, 0xBF, 
+0x56, 0x3A, 0xB5, 0xC6, 0xDC, 0x74, 0x78, 0x01, 
+0xB2, 0xAC, 0x7E, 0x7B, 0x46, 0xA4, 0xE9, 0x53, 
+0x49, 0x0F, 0x9B, 0xFE, 0x1D, 0x12, 0x61, 0x54, 
+0x89, 0x46, 0xAB, 0x82, 0x9C, 0x3B, 0x94, 0x4B, 
+0x80, 0xC8, 0x93, 0xA8, 0xEC, 0x3F, 0x63, 0xB6, 
+0xB0, 0xB0, 0x02, 0xB6, 0x68, 0x7D, 0xE8, 0x05, 
+0xBD, 0xF6, 0x0A, 0x4A, 0x42, 0xF5, 0x41, 0x31, 
+0x89, 0x47, 0xAE, 0x51, 0xA4, 0x3B, 0x76, 0xFE, 
+0x0B, 0xDA, 0x5B, 0x37, 0x51, 0xD6, 0x5A, 0x89, 
+0xA8, 0x4A, 0x66, 0x6C, 0x2F, 0xAC, 0xCD, 0xB1, 
+0x9A, 0x2E, 0xD6, 0x0C, 0x7B, 0xE0, 0x3A, 0x9A, 
+0x45, 0xBB, 0xF0, 0x9B, 0x81, 0x18, 0xFE, 0x2F, 
+0x1B, 0xA2, 0x3B, 0x1B, 0xDA, 0xCA, 0x99, 0xB8, 
+0xDD, 0x76, 0x49, 0x1A, 0x2D, 0x0A, 0xEA, 0x6C, 
+0xE7, 0x4A, 0x8D, 0xE1, 0xA8, 0x44, 0x15, 0xE3, 
+0x11, 0x6A, 0x3C, 0xF1, 0xD6, 0x3B, 0x6A, 0xC4, 
+0x52, 
+
+0xCA, 0x22, 0xB6, 0xE2, 0x07, 0xC9, 0x5C, 0xEA, 
+0x1C, 0x50, 0xC5, 0x5B, 0x25, 0x52, 0x22, 0xA6, 
+0x0F, 0xA3, 0x0F, 0x2D, 0x0E, 0x4B, 0xA8, 0x1A, 
+0x3A, 0xF4, 0x61, 0xF7, 0x8E, 0x7E, 0x78, 0x47, 
+0xC3, 0x8C, 0x39, 0x64, 0x17, 0xBB, 0xC3, 0x2A, 
+0x10, 0x38, 0x72, 0xC8, 0x3A, 0xE5, 0xE1, 0x4F, 
+0x31, 0x75, 0xC7, 0x94, 0xB3, 0x1B, 0xE3, 0x86, 
+0x0A, 0x95, 0xEB, 0x9F, 0x69, 0x2B, 0xD8, 0x1E, 
+0x97, 0x94, 0x9B, 0x41, 0xD2, 0x02, 0x4D, 0xDF, 
+0x24, 0x6A, 0x5D, 0xCB, 0xBB, 0xE0, 0xAB, 0x20, 
+0x37, 0xCC, 0xDF, 0xCA, 0x04, 0x89, 0x8C, 0x0D, 
+0x59, 0xDE, 0xC6, 0xCD, 0xB0, 0xDE, 0x6C, 0x98, 
+0x0D, 0x46, 0x06, 0x35, 0xBB, 0xDF, 0xEF, 0x95, 
+0x07, 0x2A, 0x96, 0xE5, 0x8B, 0xBB, 0xF9, 0xB6, 
+0x4A, 0xF6, 0x93, 0xDB, 0xC3, 0xC1, 0xA8, 0xE7, 
+0x7F, 0xA1, 0xCA, 0xD3, 0x55, 0x4A, 0xB1, 0x54, 
+0x17, 0x63, 0x67, 0x0F, 0xA3, 0x79, 0xBE, 0xDA, 
+0x91, 0x16, 0x8C, 0xB4, 0x90, 0x1C, 0x0B, 0x3B, 
+0x40, 0xB4, 0x07, 0x6C, 0x86, 0xB6, 0xCE, 0x82, 
+0x54, 0xB6, 0x28, 0xEB, 0xEF, 0x79, 0xDB, 0xEE, 
+0x48, 0xF8, 
+
+0xDC, 0xB1, 0x15, 0x49, 0x3A, 0xA3, 0x1E, 0xFD, 
+0x97, 0x83, 0x76, 0x0B, 0x52, 0xA8, 0xFD, 0x31, 
+0xA2, 0x3F, 0xAE, 0x0C, 0x40, 0xA4, 0x84, 0x43, 
+0x6F, 0xBB, 0x6E, 0x7B, 0x64, 0x89, 0x4D, 0x36, 
+0xF9, 0x07, 0xBC, 0xDD, 0xCE, 0x5A, 0x9D, 0xA6, 
+0xB7, 0x55, 0x6F, 0xC0, 0x0B, 0xD9, 0xB9, 0xE2, 
+0xB1, 0x41, 0xC4, 0xE6, 0xD5, 0x61, 0x09, 0xD4, 
+0x94, 0xFE, 0x7E, 0x99, 0x2F, 0x56, 0x16, 0x21, 
+0x61, 0x2F, 0x49, 0xAF, 0xEB, 0xFA, 0x88, 0xBA, 
+0x39, 0xD3, 0xB9, 0x79, 0xBA, 0x0C, 0xD1, 0x4D, 
+0x65, 0x43, 0x7A, 0xBC, 0xF2, 0x6C, 0x8B, 0x6C, 
+0xFC, 0xAA, 0xCA, 0x1B, 0x3D, 0x31, 0x93, 0x01, 
+0xF0, 0x6C, 0x29, 0x03, 0x8B, 0xAF, 0x3F, 0xAF, 
+0x71, 0x16, 0x1D, 0xF8, 0x2C, 0x06, 0x2B, 0x1F, 
+0xAB, 0xBF, 0xC0, 0xF6, 0x3C, 0x76, 0x9A, 0xF3, 
+0x70, 0x4F, 0xCC, 0x08, 0x7B, 0x06, 0xEB, 0xAC, 
+0x96, 0x34, 0x90, 0x8A, 0x76, 0xE9, 0x2B, 0xC6, 
+0x89, 0x8F, 0x23, 0x1F, 0xC3, 0xE7, 0x47, 0x83, 
+0xC6, 0x57, 0x0E, 0x0E, 0x61, 0x8F, 0xC4, 0xE0, 
+0xEA, 0x8A, 0xA5, 0x6F, 0xE9, 0xBC, 0x68, 0xE1, 
+0x7C, 0xBD, 0x94, 
+
+0x3A, 0x7F, 0x0C, 0xA5, 0x36, 0x39, 0xFD, 0x51, 
+0x50, 0x88, 0xE6, 0x5D, 0xC2, 0x7F, 0x22, 0x73, 
+0x0F, 0xE4, 0x41, 0x1A, 0xAA, 0xBD, 0x62, 0x84, 
+0x6B, 0x9B, 0x86, 0xE1, 0x75, 0x78, 0x34, 0x7E, 
+0xF3, 0x81, 0xF8, 0x82, 0x80, 0xFD, 0xDE, 0xDE, 
+0x51, 0xA4, 0x6A, 0x7D, 0x85, 0xD8, 0xD8, 0x70, 
+0x61, 0x3F, 0x9C, 0x44, 0x4A, 0x52, 0xE8, 0x1E, 
+0xB2, 0x05, 0x1A, 0x20, 0x6E, 0x02, 0x5F, 0xDE, 
+0x95, 0x65, 0x92, 0xF7, 0x92, 0x58, 0x06, 0x9E, 
+0x1C, 0xBD, 0xB2, 0x2C, 0x50, 0xDC, 0x6A, 0x67, 
+0xF1, 0xDB, 0x6D, 0x4A, 0x0A, 0x40, 0x8C, 0xD9, 
+0x50, 0xFB, 0x9F, 0xDE, 0x2E, 0x42, 0x56, 0xC2, 
+0xC6, 0xA2, 0x7C, 0xCA, 0x7C, 0xE6, 0x5B, 0x11, 
+0x2B, 0x5B, 0x42, 0xB4, 0xD1, 0x15, 0xCC, 0xD6, 
+0x76, 0xC4, 0x8A, 0xC9, 0xB6, 0x0F, 0x65, 0x86, 
+0xF8, 0xF5, 0x1D, 0x89, 0x04, 0xDC, 0xFF, 0xDF, 
+0xA4, 0x88, 0x5B, 0x25, 0xE8, 0x71, 0x39, 0x62, 
+0xBF, 0x1E, 0xA8, 0xD7, 0xD7, 0x63, 0x36, 0x1B, 
+0xB9, 0x1D, 0x78, 0x6A, 0x86, 0x58, 0x20, 0x7A, 
+0x2E, 0x83, 0x31, 0xCB, 0xA3, 0x27, 0x96, 0x4C, 
+0xD4, 0x03, 0x36, 0x92, 
+
+0xC2, 0xAE, 0x8F, 0x75, 0x9B, 0xEF, 0xC1, 0xFE, 
+0x70, 0xFA, 0x60, 0xC8, 0x8A, 0x8B, 0x2E, 0x88, 
+0xC6, 0x1E, 0x46, 0x1C, 0xA3, 0x53, 0xB0, 0x16, 
+0xB3, 0xBC, 0x17, 0x2D, 0x55, 0xB1, 0x0E, 0x8F, 
+0xB5, 0x04, 0xA5, 0x4B, 0x74, 0x8B, 0x29, 0x33, 
+0x42, 0x11, 0x8B, 0x3E, 0x8E, 0xD5, 0xD2, 0xF4, 
+0xAA, 0x50, 0x1E, 0x7E, 0x7D, 0x50, 0xFF, 0x32, 
+0xF9, 0x26, 0xDA, 0x31, 0xD3, 0x45, 0x2F, 0x03, 
+0x5D, 0x67, 0x5C, 0x4A, 0x1D, 0x85, 0xD3, 0x54, 
+0x4B, 0xF4, 0xBD, 0x90, 0x37, 0xAD, 0x42, 0x8E, 
+0xC9, 0x71, 0xBD, 0xA6, 0x9F, 0x9A, 0x9C, 0x4B, 
+0xB1, 0x08, 0x04, 0xFB, 0x57, 0xA8, 0xCC, 0x04, 
+0x6D, 0xDC, 0x96, 0xCB, 0xC5, 0xA5, 0x82, 0x04, 
+0xFE, 0xA0, 0xB1, 0x0B, 0x62, 0x88, 0x81, 0xDF, 
+0x3F, 0x9C, 0xA7, 0x0A, 0x22, 0xE1, 0x58, 0x73, 
+0x6F, 0x36, 0x1B, 0x84, 0x48, 0x0C, 0xBE, 0x6F, 
+0x41, 0x78, 0xAC, 0xF9, 0xDA, 0x93, 0xB5, 0x6F, 
+0x94, 0xAE, 0x66, 0x41, 0x4B, 0x21, 0xCE, 0x79, 
+0x49, 0x2E, 0xF5, 0xDE, 0xD0, 0x7C, 0x7F, 0x9B, 
+0xBF, 0xEE, 0x98, 0xA0, 0x05, 0x37, 0xC9, 0xB5, 
+0xCD, 0x74, 0x83, 0xBF, 0x63, 
+
+0xEE, 0x1C, 0xBE, 0x48, 0x71, 0x60, 0xA6, 0x87, 
+0x0F, 0xB9, 0x40, 0xC0, 0x1B, 0xA6, 0x58, 0x11, 
+0xBA, 0x91, 0xB7, 0x76, 0x15, 0x8D, 0xFD, 0x52, 
+0x02, 0x33, 0x92, 0xDF, 0x50, 0x0E, 0x84, 0x06, 
+0x31, 0xE5, 0xCE, 0xB4, 0x60, 0x5B, 0xE2, 0xF6, 
+0x4A, 0xC5, 0x4E, 0x04, 0x08, 0xE6, 0x1D, 0x31, 
+0xE2, 0x0F, 0x7B, 0x06, 0x6D, 0x11, 0x3F, 0x5B, 
+0x69, 0xCA, 0xD7, 0xDE, 0x6B, 0x2C, 0x47, 0x92, 
+0xC1, 0xAB, 0x8F, 0xAB, 0xB4, 0xA2, 0xEA, 0x7E, 
+0x68, 0xA8, 0xC7, 0x49, 0xD4, 0x4F, 0x5B, 0x8A, 
+0xBF, 0x62, 0xE2, 0xB2, 0x0B, 0xA2, 0xBC, 0xE9, 
+0xE9, 0xD9, 0x6A, 0xC5, 0x2C, 0xAB, 0xA2, 0x30, 
+0xEE, 0xD2, 0x3F, 0x55, 0x32, 0x6D, 0x42, 0x06, 
+0xA0, 0xDA, 0xF6, 0xD6, 0xB0, 0xDB, 0xF4, 0x3F, 
+0x15, 0x07, 0xCF, 0x09, 0xF3, 0x60, 0x65, 0x41, 
+0x7F, 0x19, 0xF9, 0x1A, 0x9E, 0x82, 0xCA, 0xFA, 
+0x27, 0xD0, 0x3D, 0x15, 0x92, 0xE4, 0xC9, 0x6E, 
+0xA1, 0xC4, 0xEF, 0x65, 0xD1, 0x9D, 0x21, 0xA1, 
+0x0E, 0x6F, 0xF9, 0xD9, 0xC4, 0xBB, 0x9F, 0x1A, 
+0xBA, 0x65, 0xB4, 0xD1, 0x58, 0x7D, 0x0A, 0xAF, 
+0x93, 0xE2, 0x48, 0xF8, 0xBA, 0x76, 
+
+0x1B, 0xA0, 0x37, 0xD3, 0x8D, 0x37, 0x6F, 0x8E, 
+0x43, 0xD0, 0xA8, 0x98, 0x94, 0x2F, 0x75, 0x65, 
+0xE6, 0xEA, 0x75, 0xCD, 0xDF, 0xB4, 0x85, 0xD3, 
+0x85, 0x6E, 0xF3, 0x65, 0xB0, 0xB7, 0x46, 0x70, 
+0x3B, 0x71, 0xEE, 0xAD, 0x80, 0xB8, 0xE2, 0x7D, 
+0x63, 0xF0, 0x27, 0x0C, 0xB8, 0xCF, 0xAF, 0x24, 
+0xA5, 0xF4, 0x1B, 0x7D, 0x75, 0x00, 0x16, 0xCB, 
+0x23, 0xCB, 0x44, 0xB3, 0xC7, 0x1D, 0xA6, 0x06, 
+0x60, 0xA8, 0xD7, 0x87, 0x2F, 0xB3, 0xCC, 0xFA, 
+0x70, 0xC1, 0x81, 0x21, 0x3D, 0x45, 0x37, 0x35, 
+0xF7, 0x9D, 0x13, 0x01, 0x01, 0x50, 0x81, 0x3B, 
+0x31, 0x67, 0x94, 0xAD, 0x78, 0xC4, 0xCE, 0xB8, 
+0xE2, 0xC0, 0xDA, 0x07, 0xE9, 0x51, 0x3F, 0x5E, 
+0x41, 0xD0, 0x2D, 0x62, 0x72, 0xF9, 0x1A, 0xFD, 
+0xC3, 0x1F, 0x1C, 0xB0, 0xBE, 0x74, 0x71, 0x45, 
+0x0D, 0x04, 0x75, 0xD8, 0x4B, 0x52, 0x77, 0x68, 
+0x24, 0x0C, 0x12, 0x58, 0x1C, 0xBA, 0x87, 0xB9, 
+0xAC, 0xBC, 0x60, 0x20, 0x1C, 0x2B, 0xDE, 0xF9, 
+0x86, 0x5C, 0x22, 0x88, 0x60, 0x9A, 0x90, 0x1B, 
+0xD5, 0xB3, 0x8D, 0xD4, 0x38, 0x01, 0xCB, 0xD6, 
+0x79, 0x1A, 0xFF, 0xC9, 0xBD, 0x6E, 0xB0, 
+
+0xB3, 0x9F, 0xC4, 0xCA, 0xC9, 0x31, 0x6E, 0x5D, 
+0x05, 0x68, 0x5E, 0xE2, 0x3E, 0x90, 0x97, 0x0C, 
+0xB8, 0xB2, 0x9C, 0xDB, 0x6A, 0x67, 0xEF, 0xFD, 
+0xB4, 0x67, 0x12, 0x85, 0x4E, 0xA0, 0x5D, 0xC6, 
+0xE1, 0x92, 0xB3, 0xDE, 0xFF, 0xAE, 0xCE, 0xD2, 
+0xD4, 0xDB, 0xFE, 0xE0, 0x57, 0x41, 0x28, 0x75, 
+0x66, 0x4F, 0x72, 0xD6, 0x13, 0x76, 0xB2, 0xD6, 
+0xAD, 0xA3, 0x76, 0x95, 0x89, 0x35, 0x8A, 0x6C, 
+0x69, 0x7E, 0xBE, 0x4E, 0xDA, 0xA8, 0x37, 0xE2, 
+0xC5, 0x31, 0x18, 0xF1, 0x7C, 0x62, 0xBD, 0x44, 
+0x99, 0x44, 0x22, 0xB3, 0x37, 0x7A, 0x4D, 0xF1, 
+0xDF, 0x39, 0x64, 0x4B, 0x8A, 0xE9, 0x3E, 0xAE, 
+0x22, 0xCC, 0x2B, 0x2D, 0xDA, 0x13, 0x1B, 0xC1, 
+0x89, 0x21, 0xD0, 0x24, 0x52, 0x0D, 0xC8, 0x04, 
+0x57, 0x39, 0x6E, 0x3D, 0x36, 0x94, 0x06, 0xB2, 
+0xC9, 0xED, 0xC8, 0x9A, 0x08, 0xCC, 0x81, 0xCE, 
+0x11, 0x70, 0xAE, 0xAE, 0xE7, 0x49, 0xD4, 0x33, 
+0x16, 0x0F, 0xC7, 0x7F, 0xD4, 0xA1, 0x7E, 0x4C, 
+0x10, 0x64, 0x1B, 0x24, 0x36, 0xA1, 0x3D, 0x01, 
+0x13, 0xCD, 0x10, 0xE3, 0x67, 0x07, 0x48, 0xF3, 
+0x87, 0x49, 0x1B, 0x20, 0xFF, 0x6D, 0xB6, 0xD8, 
+
+0x54, 0xB3, 0x39, 0x92, 0xBD, 0xA9, 0x3A, 0xEC, 
+0x75, 0xFF, 0xA7, 0xA5, 0xB5, 0x9B, 0xF0, 0x8E, 
+0x8B, 0x3E, 0xF0, 0xB7, 0xBD, 0x32, 0x7B, 0x53, 
+0x78, 0xDA, 0x7B, 0x37, 0xA2, 0xB8, 0xB2, 0x31, 
+0xA0, 0x89, 0x31, 0x77, 0xDA, 0x93, 0x4C, 0xC7, 
+0xF0, 0xC5, 0x60, 0xC5, 0xAF, 0x3D, 0x8B, 0xDA, 
+0x19, 0xB8, 0x7B, 0x11, 0x48, 0x6A, 0xE3, 0xD4, 
+0x99, 0x0F, 0x25, 0x46, 0x9C, 0xA0, 0xA8, 0x9D, 
+0xCD, 0x6F, 0x57, 0x1E, 0x43, 0x28, 0xCD, 0xD9, 
+0x07, 0x9F, 0x07, 0xD5, 0x01, 0x34, 0x16, 0x4B, 
+0x90, 0xEF, 0x5C, 0x07, 0xF4, 0x85, 0xDD, 0xAB, 
+0xF2, 0xB7, 0xE0, 0x3D, 0x54, 0xA1, 0x02, 0x9A, 
+0x27, 0xF9, 0xC1, 0x53, 0x13, 0xBB, 0xDF, 0xD3, 
+0x7C, 0xFD, 0x14, 0x72, 0xD9, 0xAF, 0x1B, 0xCE, 
+0x32, 0x0A, 0xD1, 0x0A, 0x58, 0xB0, 0x66, 0xD7, 
+0x83, 0x1A, 0x80, 0x4E, 0x00, 0x1D, 0x10, 0xEB, 
+0xDA, 0x4D, 0x4E, 0x0E, 0x1E, 0x26, 0xBB, 0x37, 
+0x96, 0x01, 0xE0, 0x9E, 0x87, 0xA6, 0xC4, 0xAD, 
+0xEB, 0xAC, 0x0B, 0xCF, 0x11, 0x67, 0xF2, 0xA6, 
+0x55, 0x17, 0xD8, 0xF8, 0xA3, 0xB9, 0xD5, 0x82, 
+0xEB, 0xC9, 0xD9, 0xA5, 0x1F, 0x97, 0x23, 0x48, 
+0xAF, 
+
+0xEB, 0xF0, 0x60, 0xB8, 0x73, 0x3C, 0xF4, 0x22, 
+0xE3, 0x91, 0x0B, 0x25, 0x05, 0xFA, 0x07, 0xA7, 
+0xDB, 0xBD, 0x9A, 0x4B, 0x95, 0x4D, 0x04, 0xF1, 
+0xAB, 0x2D, 0xBF, 0x70, 0xD3, 0x83, 0xEA, 0x07, 
+0x60, 0x43, 0x0F, 0x4D, 0x3F, 0xCC, 0x12, 0xC2, 
+0x5D, 0x3E, 0x3D, 0x39, 0xD6, 0xFA, 0xD2, 0xDD, 
+0x93, 0x4C, 0x9A, 0xBE, 0x4E, 0x33, 0x28, 0x17, 
+0xAB, 0x33, 0x10, 0x0F, 0x1C, 0xAA, 0x4F, 0x1E, 
+0xD7, 0xB7, 0x74, 0xDB, 0xD0, 0x0E, 0x23, 0xDF, 
+0x01, 0xCA, 0x93, 0xE5, 0x38, 0x24, 0xF8, 0x73, 
+0xCD, 0xF0, 0x27, 0x50, 0x7A, 0xFB, 0x3A, 0xE3, 
+0x08, 0x8D, 0xF4, 0xC8, 0x15, 0x44, 0x23, 0x36, 
+0x0E, 0x6A, 0x13, 0xE7, 0x0F, 0x16, 0x14, 0xBD, 
+0x43, 0x7D, 0xA5, 0x1B, 0xC3, 0x66, 0xC8, 0x5D, 
+0xA5, 0x66, 0x5C, 0xCF, 0x25, 0x0F, 0xB6, 0x33, 
+0x5E, 0xC2, 0xA4, 0x28, 0xFB, 0x27, 0xCD, 0x3C, 
+0xAE, 0x4B, 0x96, 0xAF, 0xF6, 0xCA, 0x8F, 0x7A, 
+0xCE, 0x7E, 0x04, 0x15, 0xCB, 0x72, 0x94, 0x77, 
+0x3F, 0x1C, 0x39, 0x1B, 0x25, 0x19, 0x58, 0xF3, 
+0x13, 0xD2, 0x76, 0x99, 0xF4, 0x52, 0xD2, 0xE9, 
+0x29, 0xFB, 0x2E, 0x99, 0x6C, 0xE8, 0xD2, 0xCE, 
+0xB6, 0x4F, 
+
+0x65, 0x34, 0x87, 0xF9, 0x01, 0x9B, 0x18, 0x6A, 
+0x2C, 0xE9, 0x87, 0xD5, 0x1E, 0x52, 0xBE, 0xB6, 
+0xF8, 0xFB, 0x3F, 0x8F, 0x34, 0x67, 0x5E, 0x38, 
+0x60, 0x79, 0xAC, 0xE1, 0xEA, 0x95, 0x69, 0xD4, 
+0xA2, 0x24, 0xDA, 0x7E, 0x91, 0x9E, 0x5E, 0x49, 
+0xA3, 0x25, 0x35, 0x3A, 0xA0, 0x21, 0x3E, 0x9A, 
+0xCA, 0x42, 0x73, 0xE1, 0x83, 0xCD, 0x25, 0x23, 
+0x0A, 0x03, 0x54, 0xFB, 0x4E, 0x66, 0x4A, 0x0C, 
+0xCA, 0x34, 0xF8, 0xDA, 0x10, 0x8E, 0x3A, 0x58, 
+0xBF, 0x04, 0x28, 0x23, 0xE3, 0x04, 0x84, 0x6E, 
+0x06, 0xE1, 0xFE, 0xB3, 0x54, 0xB5, 0x7F, 0x9C, 
+0x94, 0xFF, 0xD5, 0x80, 0x78, 0xAC, 0xB8, 0xC7, 
+0x72, 0xA0, 0xF3, 0x19, 0xE4, 0xBA, 0x3D, 0x5C, 
+0x33, 0xA4, 0x26, 0xA5, 0x30, 0x05, 0x3B, 0xFE, 
+0x70, 0x2D, 0xFD, 0x43, 0xC2, 0xF3, 0x20, 0xE1, 
+0x4C, 0x75, 0xAF, 0x88, 0xA0, 0x5A, 0x91, 0xD9, 
+0xCB, 0xE3, 0x9D, 0xF8, 0xA8, 0x84, 0xFE, 0x16, 
+0xFF, 0x9F, 0xBA, 0x3E, 0x13, 0x57, 0x3F, 0x58, 
+0x93, 0xC8, 0xE4, 0x9B, 0x1C, 0x30, 0x77, 0x9A, 
+0x6E, 0xB4, 0x06, 0x79, 0xA2, 0x77, 0x20, 0x6A, 
+0x1F, 0x3F, 0xFE, 0x8A, 0xC7, 0x13, 0x57, 0x0D, 
+0x11, 0x23, 0xB6, 
+
+0x1C, 0xAE, 0x2F, 0x9E, 0x07, 0x24, 0xE1, 0x24, 
+0xC5, 0x2D, 0xEF, 0x0E, 0x2F, 0x6A, 0xC7, 0xC8, 
+0xBB, 0x8B, 0x32, 0x0F, 0xEB, 0x8A, 0x1D, 0x31, 
+0xAB, 0x7C, 0x80, 0x16, 0x55, 0x1E, 0x28, 0xB6, 
+0x3C, 0x77, 0x13, 0x60, 0x1B, 0x3B, 0x21, 0x72, 
+0x89, 0x0F, 0x8B, 0x36, 0xCE, 0x00, 0x4F, 0x29, 
+0x6E, 0x65, 0xE2, 0x1E, 0x1C, 0x34, 0xCC, 0x8B, 
+0xDB, 0x75, 0x16, 0x21, 0x6A, 0x61, 0x2D, 0xEF, 
+0x40, 0x0F, 0x2B, 0x4B, 0xA2, 0xFF, 0xC4, 0xAA, 
+0x98, 0xB5, 0xDC, 0xE9, 0x29, 0xF9, 0x34, 0xBA, 
+0x57, 0xDF, 0x32, 0x06, 0xCD, 0xF3, 0xDB, 0x53, 
+0x56, 0x11, 0x25, 0xF0, 0x63, 0x23, 0x54, 0x82, 
+0x0F, 0x24, 0xF3, 0xA5, 0x36, 0xA3, 0xFE, 0x84, 
+0xCB, 0x28, 0x7E, 0x94, 0xF6, 0xF3, 0xA5, 0xA3, 
+0x3F, 0xB0, 0xE5, 0xB0, 0xA9, 0x2F, 0x75, 0xCB, 
+0xAF, 0xC7, 0x6E, 0x62, 0x11, 0x58, 0x95, 0x6C, 
+0x2A, 0xF5, 0x2D, 0xBA, 0xCD, 0x03, 0xCA, 0x68, 
+0x01, 0xDA, 0x39, 0xEF, 0x55, 0x38, 0x6B, 0xCE, 
+0x64, 0x40, 0x22, 0x97, 0xD1, 0x22, 0x6E, 0xDD, 
+0xE0, 0x8B, 0x17, 0xEF, 0x4E, 0xA2, 0x48, 0x1B, 
+0x8A, 0xE3, 0x20, 0x9D, 0x85, 0x24, 0xC9, 0x1D, 
+0xE4, 0x45, 0x57, 0xCE, 
+
+0x99, 0x4E, 0x08, 0xFB, 0x99, 0x1F, 0x72, 0x2E, 
+0x72, 0x4E, 0x2E, 0x27, 0xA3, 0x2D, 0xCE, 0x61, 
+0xC7, 0x49, 0x62, 0x64, 0x15, 0x1A, 0xF9, 0xE5, 
+0xAC, 0x43, 0xFE, 0xB3, 0x56, 0x51, 0x36, 0x86, 
+0x8C, 0xF2, 0x33, 0x70, 0x5D, 0x7D, 0xF3, 0xCD, 
+0x23, 0xFA, 0xDD, 0xA7, 0xC3, 0x4B, 0x26, 0xD0, 
+0x29, 0x4E, 0xF3, 0xFC, 0x62, 0x43, 0xB2, 0x33, 
+0x2C, 0x1F, 0x25, 0x5C, 0x4C, 0x08, 0x3E, 0x23, 
+0x8B, 0xC8, 0x75, 0xEB, 0xAB, 0x36, 0x60, 0x77, 
+0xE5, 0x90, 0xE5, 0x36, 0xCF, 0x59, 0x2A, 0x0A, 
+0xFE, 0x8B, 0xA7, 0x24, 0x9D, 0xF1, 0x1A, 0xFD, 
+0x8B, 0xCF, 0x2A, 0xE2, 0x75, 0x4D, 0xA9, 0x60, 
+0x14, 0x64, 0xBD, 0x68, 0x2D, 0x70, 0x5F, 0x29, 
+0xCB, 0x6C, 0x82, 0x90, 0xD1, 0x8E, 0x28, 0x0C, 
+0xD8, 0xC2, 0x10, 0x7D, 0x62, 0xA7, 0xD7, 0x9B, 
+0xB6, 0xE7, 0x47, 0xC1, 0xDF, 0x2E, 0x67, 0x20, 
+0xE0, 0x86, 0x01, 0xE2, 0x60, 0x03, 0xB1, 0xF2, 
+0xA8, 0x99, 0x61, 0x5C, 0x99, 0x18, 0xCB, 0xA6, 
+0xF4, 0xD1, 0x00, 0x46, 0x02, 0x4A, 0xCE, 0x23, 
+0x8B, 0xE4, 0xD9, 0xF8, 0x31, 0xC9, 0x05, 0xF1, 
+0x71, 0x17, 0xDC, 0x3B, 0x59, 0x90, 0xD5, 0xA2, 
+0xB4, 0x19, 0xCD, 0xEB, 0x27, 
+
+0x28, 0x9A, 0x49, 0x0F, 0xEF, 0x43, 0x19, 0x13, 
+0xF2, 0xC6, 0x01, 0xF9, 0xB4, 0x19, 0x9E, 0x95, 
+0x36, 0xAE, 0x7A, 0xA7, 0xB4, 0xBB, 0x47, 0x6E, 
+0xA5, 0xCA, 0x6C, 0x68, 0xDA, 0x60, 0x9E, 0xEE, 
+0xB4, 0xEC, 0xC0, 0xD0, 0x6F, 0xBB, 0x50, 0x36, 
+0x7B, 0x29, 0xC0, 0xD3, 0xB0, 0xC5, 0xE7, 0x68, 
+0x62, 0x39, 0xD7, 0x1D, 0x5B, 0xB4, 0x25, 0x66, 
+0xAC, 0x6B, 0xFE, 0x38, 0xA6, 0x74, 0x8F, 0x52, 
+0xF9, 0x78, 0x6B, 0x0E, 0x7D, 0x8D, 0x38, 0x0F, 
+0x26, 0xFB, 0xD7, 0x35, 0x7D, 0x88, 0x2B, 0xC4, 
+0xF1, 0xEA, 0xB7, 0xEB, 0xC8, 0x43, 0x98, 0x9F, 
+0xD8, 0xA1, 0x58, 0xB5, 0xB5, 0x10, 0xD7, 0x85, 
+0xA0, 0x9D, 0xC3, 0x19, 0x6C, 0x86, 0xBE, 0xB5, 
+0x06, 0xBC, 0x3B, 0x1C, 0xD0, 0xBF, 0x6E, 0xA1, 
+0x64, 0xF8, 0x39, 0x9F, 0x39, 0xBE, 0xE3, 0xE8, 
+0xC1, 0x94, 0x87, 0xF7, 0xF7, 0xAC, 0xAD, 0x07, 
+0xFF, 0x94, 0x44, 0xBA, 0x27, 0x06, 0x6A, 0x58, 
+0x50, 0x45, 0x22, 0x83, 0x36, 0xD7, 0x14, 0xF5, 
+0x2A, 0xE3, 0x89, 0x0E, 0xAD, 0x93, 0xF6, 0x60, 
+0x6D, 0xA1, 0xBA, 0x44, 0xD5, 0x0E, 0xFA, 0xD1, 
+0xFE, 0x19, 0xA9, 0xA8, 0x5C, 0xCA, 0x68, 0x4D, 
+0x2E, 0xE5, 0x3D, 0x36, 0x25, 0x40, 
+
+0x9A, 0x67, 0x6C, 0x9B, 0xD1, 0x8F, 0x58, 0x91, 
+0xE6, 0xD0, 0xB5, 0x08, 0xD1, 0x67, 0xDD, 0xA5, 
+0xF6, 0x18, 0x25, 0x4B, 0x19, 0xB3, 0xD4, 0x0E, 
+0xC9, 0x54, 0x92, 0xDE, 0x64, 0xE5, 0x8E, 0x58, 
+0x52, 0xCC, 0xEB, 0x06, 0x48, 0xE5, 0x39, 0x86, 
+0x58, 0xC3, 0x6D, 0x16, 0x8C, 0x57, 0x88, 0xFB, 
+0x16, 0xF2, 0x55, 0x17, 0xB7, 0x04, 0xF2, 0xBC, 
+0xA4, 0x4C, 0x91, 0x05, 0x8A, 0x43, 0x89, 0xF6, 
+0x8B, 0xE2, 0x3E, 0x15, 0xB8, 0x51, 0x2B, 0xF7, 
+0x88, 0xC2, 0xDB, 0xCA, 0xFA, 0x99, 0x4A, 0x7E, 
+0xB4, 0x2A, 0xF6, 0x45, 0x93, 0x7E, 0x00, 0x17, 
+0xB2, 0x51, 0xD7, 0x8D, 0x35, 0x5C, 0x6B, 0x0A, 
+0x99, 0x63, 0x57, 0x5B, 0xC0, 0x6B, 0x18, 0x25, 
+0xE8, 0xAC, 0x59, 0x7A, 0xE3, 0xA7, 0xB6, 0xEE, 
+0xBA, 0xEC, 0xFA, 0xBD, 0x95, 0x41, 0xC2, 0xB3, 
+0xA8, 0x8E, 0x14, 0xF5, 0x50, 0x87, 0x36, 0xF1, 
+0xAD, 0xA8, 0x9F, 0xCF, 0xB3, 0xBB, 0xDE, 0x5F, 
+0xB1, 0x4F, 0x4D, 0x1D, 0x15, 0x54, 0x97, 0x42, 
+0x0F, 0xA3, 0x1F, 0x52, 0x30, 0x7A, 0xD7, 0x7B, 
+0x4F, 0x8A, 0x69, 0xAE, 0x22, 0x33, 0x68, 0xF3, 
+0xE2, 0x38, 0xE8, 0xDB, 0x71, 0xEC, 0x4B, 0x11, 
+0xE4, 0xC8, 0x61, 0x6F, 0xEB, 0xA9, 0xC5, 
+
+0xD3, 0xD5, 0x4B, 0x7F, 0xFF, 0x7E, 0x25, 0x31, 
+0x87, 0xF7, 0xF4, 0x85, 0x77, 0xA5, 0x55, 0xC5, 
+0x9D, 0x20, 0x2B, 0x13, 0xE5, 0xF5, 0x7B, 0x32, 
+0x1B, 0x7D, 0x4E, 0x3B, 0x97, 0xAE, 0xF9, 0x18, 
+0x73, 0x18, 0x5B, 0x2F, 0xE5, 0x01, 0xBF, 0xEE, 
+0x6A, 0xE5, 0x74, 0x77, 0x47, 0xED, 0x01, 0xA9, 
+0xA3, 0x01, 0x2C, 0x2B, 0x78, 0x36, 0x00, 0x2C, 
+0xB6, 0x3C, 0xFE, 0x53, 0xAE, 0xA8, 0x6A, 0x3F, 
+0x5E, 0xAA, 0x56, 0x97, 0x8A, 0x08, 0x72, 0x63, 
+0x21, 0xFD, 0xD5, 0x10, 0x6B, 0x51, 0xEB, 0xE8, 
+0x9D, 0x17, 0xE1, 0x79, 0x93, 0x16, 0x28, 0x8B, 
+0xF8, 0x4E, 0x66, 0xBD, 0xBE, 0x59, 0x6F, 0xD0, 
+0xD0, 0xF8, 0x3A, 0xE6, 0x52, 0xBA, 0xD7, 0xF0, 
+0x9F, 0xAA, 0xC4, 0xEE, 0x46, 0x5F, 0xA5, 0xE0, 
+0x83, 0x2A, 0xCE, 0x17, 0xC8, 0xCD, 0x48, 0xB1, 
+0xC9, 0xEB, 0x25, 0xAB, 0x58, 0x16, 0xBC, 0x1E, 
+0x12, 0xF9, 0x74, 0xC5, 0x93, 0xDE, 0x44, 0x2E, 
+0x48, 0x37, 0xC9, 0x3F, 0xED, 0xBE, 0x92, 0xEC, 
+0x63, 0xD5, 0x44, 0x07, 0xEF, 0x14, 0x86, 0xDD, 
+0xB3, 0xE6, 0x1F, 0x1E, 0x7E, 0x58, 0x30, 0x96, 
+0xCD, 0xFE, 0xBA, 0x48, 0x6A, 0xF0, 0xA7, 0xB8, 
+0x0C, 0xEC, 0x42, 0x50, 0x8C, 0xB2, 0x58, 0xD2, 
+
+0x18, 0x5E, 0xDF, 0x9D, 0x76, 0x46, 0x2E, 0x49, 
+0x05, 0xF6, 0x26, 0x21, 0xC6, 0xCC, 0x3E, 0x4E, 
+0xC3, 0x15, 0x40, 0xE0, 0x34, 0xD9, 0xDD, 0x0A, 
+0x5E, 0xB6, 0xE1, 0x3F, 0x9E, 0x76, 0x63, 0x02, 
+0xEF, 0xEE, 0xE4, 0xB1, 0xA0, 0x98, 0xF0, 0x45, 
+0x2C, 0x09, 0xE2, 0x2B, 0x12, 0xD0, 0xAE, 0x7E, 
+0x24, 0xA7, 0xDA, 0x88, 0xB1, 0x88, 0x4E, 0x9A, 
+0x8C, 0xE0, 0x25, 0x75, 0x1B, 0xB5, 0xC3, 0x7E, 
+0x8B, 0xA5, 0x14, 0x33, 0xB6, 0xEA, 0xD2, 0xE9, 
+0x22, 0x9E, 0xE4, 0x2E, 0x1A, 0x7A, 0x2D, 0x90, 
+0xF8, 0x9E, 0x13, 0xE6, 0x16, 0x1C, 0xE8, 0x25, 
+0x60, 0xB8, 0xDA, 0x39, 0xB8, 0xC8, 0x47, 0x99, 
+0x0A, 0x04, 0xBD, 0xAC, 0x96, 0x68, 0x01, 0x14, 
+0xBF, 0x11, 0x85, 0x8E, 0xB6, 0x5B, 0x17, 0x08, 
+0x1D, 0x5C, 0x6B, 0x6A, 0x4F, 0x1D, 0xC2, 0x3E, 
+0x61, 0x13, 0x7B, 0xF1, 0x25, 0xB1, 0x1C, 0x39, 
+0xE9, 0x19, 0xDE, 0x00, 0xC8, 0x34, 0xCC, 0xDD, 
+0x96, 0xC5, 0x41, 0x78, 0x44, 0x1C, 0x17, 0xA2, 
+0x43, 0x02, 0x1B, 0xED, 0xCA, 0x73, 0xE6, 0xD7, 
+0xEE, 0x57, 0x5B, 0x4C, 0x65, 0x75, 0x4D, 0xE2, 
+0x2A, 0x2E, 0x8E, 0x7C, 0x10, 0xF3, 0x4E, 0xE1, 
+0x4E, 0x78, 0x3F, 0xA1, 0x9B, 0x3B, 0x0A, 0xAE, 
+0xD2, 
+
+0x81, 0xB9, 0xA4, 0x62, 0xAF, 0xF4, 0x86, 0xAE, 
+0x03, 0x3D, 0x96, 0xE2, 0xBA, 0x8E, 0xBF, 0xCF, 
+0xBB, 0x71, 0x13, 0x9F, 0x01, 0xFD, 0xF2, 0x6D, 
+0xBD, 0x32, 0x1A, 0x52, 0x93, 0xED, 0xC6, 0x97, 
+0x37, 0xE4, 0xDD, 0xF7, 0x27, 0x8E, 0x92, 0xB5, 
+0x8D, 0x3D, 0x57, 0xB8, 0x8F, 0xA8, 0xE2, 0xA7, 
+0x8D, 0x49, 0xE0, 0x2D, 0xEB, 0x83, 0xB0, 0x0C, 
+0x74, 0x2F, 0x53, 0x9C, 0x61, 0x60, 0x64, 0xFA, 
+0x65, 0xDB, 0xB2, 0xF0, 0xFD, 0x47, 0xFA, 0x75, 
+0x70, 0x41, 0x71, 0x72, 0x1D, 0x2D, 0x94, 0x2F, 
+0xAF, 0x96, 0x28, 0x23, 0x92, 0x7C, 0xD1, 0x07, 
+0x37, 0x5C, 0x5B, 0xAF, 0x21, 0x08, 0xAC, 0x80, 
+0xAF, 0x4A, 0x26, 0x1A, 0x3F, 0x74, 0xE3, 0x12, 
+0x8E, 0x35, 0xF0, 0xF0, 0x15, 0x97, 0x61, 0x66, 
+0x98, 0x22, 0xEE, 0x3E, 0x44, 0x43, 0xAC, 0xD7, 
+0xD9, 0x07, 0xDE, 0x36, 0xEC, 0xCD, 0x38, 0xE5, 
+0xC6, 0x9C, 0xA6, 0x42, 0xA1, 0x9F, 0x74, 0x23, 
+0x3A, 0x36, 0xE7, 0xE8, 0x3E, 0x7B, 0xD8, 0xB4, 
+0x25, 0xAA, 0xA4, 0x6A, 0xB0, 0x9A, 0x72, 0xB3, 
+0x9C, 0x00, 0xA6, 0xF5, 0x1D, 0xDD, 0xA0, 0x5C, 
+0xCD, 0x88, 0x87, 0x96, 0x4F, 0x4A, 0xE7, 0x2B, 
+0x35, 0x73, 0x55, 0x1F, 0xC0, 0xCD, 0x0F, 0xAC, 
+0x1D, 0x11, 
+
+0x07, 0xDC, 0x9E, 0x95, 0xA7, 0x3E, 0x33, 0x0C, 
+0x64, 0x76, 0x2D, 0x98, 0xB6, 0xC6, 0x47, 0x50, 
+0x14, 0x1F, 0x44, 0x70, 0xA3, 0x96, 0x25, 0x08, 
+0x91, 0x78, 0x3B, 0x19, 0xDE, 0x7A, 0x7D, 0xFB, 
+0xDD, 0x5F, 0x60, 0xA6, 0x16, 0xBE, 0x91, 0x64, 
+0x0E, 0x9B, 0xB7, 0x73, 0x1C, 0x39, 0x2B, 0x88, 
+0x47, 0x9C, 0x3D, 0x17, 0xF0, 0xD6, 0xEF, 0x5F, 
+0x3E, 0xA5, 0x5A, 0x1D, 0x32, 0x7A, 0x89, 0xD7, 
+0x4B, 0x9D, 0xAE, 0x1D, 0x94, 0xAF, 0x41, 0x29, 
+0xF9, 0x0D, 0x87, 0xAD, 0xD8, 0x55, 0x6F, 0xF4, 
+0x7F, 0xAE, 0x60, 0x5A, 0x0C, 0xDC, 0x6A, 0xA6, 
+0x91, 0x4B, 0x03, 0xFE, 0x1E, 0xF0, 0x4B, 0x85, 
+0x05, 0xDE, 0xAA, 0xBB, 0x47, 0x15, 0x1C, 0xC3, 
+0xE6, 0x93, 0xF7, 0x92, 0xA0, 0xF5, 0x7D, 0x67, 
+0x86, 0x27, 0x66, 0xD1, 0x9E, 0xAE, 0x58, 0xA2, 
+0x81, 0x13, 0xE0, 0x1C, 0x3B, 0x1E, 0x13, 0xB2, 
+0x79, 0xC9, 0xB0, 0x60, 0x31, 0x21, 0x6C, 0x7A, 
+0x22, 0xFD, 0xA6, 0xC4, 0x6E, 0xE1, 0x3E, 0x52, 
+0x83, 0x1F, 0x68, 0x75, 0x25, 0x1A, 0x9D, 0x3B, 
+0x36, 0x00, 0x8A, 0x01, 0xB8, 0x59, 0x40, 0x51, 
+0x0D, 0x2E, 0x8D, 0x45, 0x4A, 0x42, 0xEC, 0x16, 
+0xED, 0x64, 0xFC, 0x48, 0xD6, 0x4B, 0xEA, 0x88, 
+0xEF, 0x22, 0x73, 
+
+0x69, 0x56, 0x8E, 0x34, 0xBE, 0xCE, 0x40, 0x79, 
+0x91, 0x85, 0x4F, 0x8F, 0x33, 0xE8, 0x96, 0xA7, 
+0x0B, 0x24, 0xE0, 0x5F, 0x14, 0xA4, 0x51, 0x11, 
+0x62, 0x1E, 0xBE, 0x1C, 0x8B, 0xAE, 0x1A, 0xED, 
+0x1B, 0x40, 0x80, 0x04, 0xF0, 0x12, 0x3C, 0x76, 
+0x7E, 0x77, 0xE7, 0xE2, 0xA6, 0x3F, 0x9B, 0xCD, 
+0xA2, 0x5B, 0x62, 0xE0, 0x44, 0x8E, 0x6F, 0x68, 
+0x59, 0x5D, 0x77, 0x0C, 0x4A, 0x1C, 0x74, 0x39, 
+0x40, 0xE2, 0x31, 0x09, 0x40, 0xDD, 0x88, 0x77, 
+0x16, 0x58, 0xD2, 0x7C, 0xD5, 0x4C, 0xE6, 0xE0, 
+0xBB, 0xA9, 0xFD, 0x56, 0xE0, 0x14, 0xAD, 0x46, 
+0xD9, 0x54, 0x64, 0x61, 0x33, 0x95, 0xAC, 0xD5, 
+0x2D, 0x95, 0xA6, 0xD2, 0x84, 0x0E, 0x43, 0x7A, 
+0x10, 0x86, 0x4C, 0x8A, 0x50, 0x8C, 0x11, 0x2D, 
+0x0D, 0xEE, 0xC8, 0x73, 0x15, 0xBC, 0x49, 0x56, 
+0xB9, 0x0D, 0x19, 0x29, 0xFA, 0x9C, 0x3F, 0x33, 
+0x32, 0x63, 0x35, 0xB6, 0xD0, 0xEC, 0xB8, 0xF5, 
+0x85, 0xB0, 0x2D, 0xEA, 0xC4, 0xAF, 0x1C, 0xFB, 
+0x07, 0xD8, 0xC9, 0x62, 0x02, 0x3A, 0xC8, 0xE9, 
+0xD8, 0x01, 0xD5, 0xA4, 0x28, 0xBE, 0x85, 0x5E, 
+0xF1, 0x0B, 0x66, 0x3F, 0x56, 0xF9, 0x0D, 0x88, 
+0x4D, 0x68, 0x7F, 0x0B, 0xD7, 0x0B, 0xF9, 0x55, 
+0x79, 0x77, 0xB9, 0x70, 
+
+0xC4, 0xAC, 0xC5, 0xB6, 0xBF, 0x1B, 0x63, 0x5E, 
+0x1D, 0x3F, 0x3F, 0xF7, 0xE7, 0xEC, 0xAD, 0xA7, 
+0x36, 0x4B, 0x0C, 0xBD, 0xB2, 0x14, 0x46, 0x32, 
+0xB3, 0x8B, 0x98, 0x08, 0xAB, 0x74, 0x26, 0x4A, 
+0xBB, 0x32, 0x4C, 0xAD, 0x4B, 0x40, 0xF8, 0xF6, 
+0x05, 0xC3, 0xF8, 0x9B, 0x20, 0x39, 0x80, 0x53, 
+0x22, 0x8F, 0x56, 0xE4, 0x9D, 0x2B, 0x18, 0x6F, 
+0x38, 0x19, 0xB9, 0x4F, 0xE2, 0x97, 0x43, 0xDB, 
+0x88, 0x90, 0xE5, 0x42, 0xBE, 0x68, 0x3C, 0x0B, 
+0x55, 0x86, 0xD9, 0x72, 0xD7, 0x10, 0x45, 0x7F, 
+0xB7, 0xD9, 0xDB, 0xE2, 0x15, 0xA2, 0x01, 0xB2, 
+0x92, 0xDE, 0x24, 0xE5, 0xD2, 0x08, 0x97, 0x9E, 
+0xA1, 0x9F, 0x05, 0x4C, 0x2E, 0x11, 0x63, 0x0B, 
+0x6A, 0x5B, 0x79, 0x85, 0x53, 0x0C, 0x8E, 0x2F, 
+0x95, 0xFF, 0xF0, 0x71, 0x93, 0x37, 0x76, 0x29, 
+0xC2, 0x9C, 0x84, 0xCB, 0xA3, 0x10, 0xB9, 0xEC, 
+0xEF, 0x93, 0x1E, 0xA9, 0xD3, 0x4B, 0xA5, 0x05, 
+0x13, 0xB7, 0x1C, 0x4B, 0x72, 0x04, 0x73, 0xEC, 
+0x0A, 0x0A, 0x8A, 0x01, 0x4D, 0x0A, 0xF3, 0x58, 
+0xC2, 0x3D, 0x0F, 0x4F, 0x53, 0x6A, 0x41, 0x0E, 
+0xDC, 0x54, 0xBD, 0xC5, 0xED, 0x2A, 0x08, 0x6B, 
+0xD6, 0xC5, 0x0D, 0x73, 0x72, 0x63, 0xE4, 0x83, 
+0xD0, 0x34, 0xDE, 0x0D, 0xA3, 
+
+0x76, 0x32, 0x3D, 0x27, 0xCB, 0xCE, 0x20, 0x9C, 
+0xC7, 0xD4, 0xFC, 0x5D, 0x38, 0xC0, 0xC9, 0xFB, 
+0xAE, 0x86, 0x00, 0xC7, 0x53, 0xD4, 0x24, 0x6A, 
+0x6D, 0xBB, 0x77, 0xFD, 0x97, 0xD3, 0x81, 0xCC, 
+0x61, 0xC0, 0x01, 0xEB, 0x62, 0x8C, 0x21, 0xD0, 
+0x43, 0x22, 0xEA, 0x9D, 0x24, 0xFA, 0x4B, 0xEF, 
+0xDF, 0x8F, 0x48, 0x0F, 0x12, 0xB8, 0x0D, 0x27, 
+0xD8, 0x4E, 0xCD, 0x5E, 0x49, 0x17, 0xBB, 0xFE, 
+0xAC, 0x46, 0x9C, 0x54, 0xE4, 0x9E, 0x07, 0x8E, 
+0xB7, 0xED, 0xF3, 0xE8, 0xEA, 0xA8, 0xE4, 0x05, 
+0xDE, 0xB2, 0x4B, 0x28, 0x62, 0x2C, 0x61, 0xE2, 
+0x17, 0xBE, 0x9A, 0xB2, 0x0C, 0x0E, 0x35, 0xA7, 
+0xB6, 0xDE, 0xE0, 0xD9, 0x68, 0x18, 0x4F, 0x12, 
+0x5D, 0xBE, 0x87, 0xDA, 0x5E, 0x70, 0x0B, 0x69, 
+0x4B, 0x18, 0x4A, 0x5D, 0x70, 0xFD, 0x25, 0x5D, 
+0x43, 0x0D, 0xED, 0xD5, 0x20, 0xFB, 0x87, 0x36, 
+0xFA, 0x95, 0x9C, 0x10, 0xF9, 0x26, 0xD3, 0xB3, 
+0xF4, 0x1B, 0xBA, 0xD8, 0xE2, 0x4B, 0x56, 0x05, 
+0xF6, 0xF1, 0x3C, 0xA1, 0xA5, 0xD9, 0x83, 0x81, 
+0xA6, 0xEB, 0x4C, 0x98, 0xB2, 0xC6, 0xFE, 0x60, 
+0x9C, 0x8F, 0x8B, 0xDE, 0xD4, 0x65, 0x81, 0xE7, 
+0x6C, 0xCA, 0x0B, 0x9A, 0xD7, 0xB3, 0x36, 0x44, 
+0x12, 0x9A, 0xB9, 0x84, 0x74, 0x21, 
+
+0x15, 0xCA, 0x57, 0x8E, 0x20, 0x6F, 0x7A, 0xF8, 
+0x5E, 0xC0, 0x6D, 0x41, 0x76, 0x07, 0xE6, 0x8A, 
+0x56, 0x04, 0xD9, 0xB0, 0x66, 0x47, 0x08, 0xED, 
+0x7C, 0xE9, 0x16, 0x28, 0xB4, 0xE5, 0x2B, 0xF6, 
+0x16, 0xAD, 0xE9, 0x5F, 0x27, 0xF4, 0xEE, 0xE9, 
+0x3D, 0x73, 0x7C, 0xB4, 0xA1, 0xEA, 0x49, 0xC4, 
+0xA2, 0xA8, 0xD1, 0x53, 0xAF, 0x52, 0x27, 0xCF, 
+0x81, 0xBF, 0xB2, 0x12, 0xB5, 0xBF, 0x19, 0x6F, 
+0x84, 0x7A, 0x89, 0x86, 0xBC, 0xAC, 0xCA, 0x4E, 
+0x36, 0xDA, 0xC3, 0x74, 0xD2, 0x33, 0x2A, 0xB2, 
+0x03, 0x99, 0xC5, 0xE3, 0x35, 0x4D, 0xEA, 0xE9, 
+0x9D, 0xED, 0x71, 0xEA, 0x9D, 0xEE, 0x8B, 0x3A, 
+0xA6, 0x71, 0xEF, 0x09, 0x77, 0x6C, 0x66, 0x57, 
+0x30, 0xF8, 0xD5, 0xEF, 0xC7, 0x45, 0xEE, 0xC1, 
+0x29, 0x84, 0xC5, 0x57, 0xD4, 0xE2, 0x04, 0x31, 
+0xAB, 0xF9, 0x38, 0xEB, 0xC5, 0x32, 0x99, 0xC3, 
+0x72, 0x30, 0x54, 0x27, 0x2C, 0xD7, 0xD3, 0x00, 
+0x1B, 0x89, 0x45, 0xA7, 0x52, 0x2D, 0x88, 0x67, 
+0x5B, 0xA3, 0x01, 0x3C, 0x1B, 0x37, 0x8F, 0xB6, 
+0xDB, 0xFB, 0x3C, 0xBC, 0xFD, 0x18, 0x22, 0x5C, 
+0x24, 0x88, 0x6A, 0xC1, 0x25, 0x2E, 0xAE, 0xA6, 
+0x6A, 0xD1, 0x0B, 0xBC, 0xFC, 0x5B, 0xCB, 0x53, 
+0xA5, 0xCB, 0x60, 0x3B, 0x59, 0x34, 0x22, 
+
+0x85, 0x8D, 0x48, 0x23, 0xA8, 0xC6, 0x30, 0xA9, 
+0xDF, 0xC3, 0xB8, 0x06, 0x16, 0x1E, 0xB5, 0x2F, 
+0xCD, 0x53, 0x0B, 0x30, 0xE4, 0xE4, 0xE0, 0x5B, 
+0x01, 0x18, 0x62, 0x74, 0x84, 0xAF, 0x49, 0x3F, 
+0xA0, 0x64, 0x60, 0x1D, 0x98, 0xD7, 0xE7, 0xDA, 
+0xED, 0x0C, 0xD7, 0x7F, 0x17, 0xDF, 0x40, 0xF0, 
+0x49, 0xDD, 0xAC, 0x03, 0x21, 0x9F, 0x11, 0x2C, 
+0xE2, 0x94, 0x06, 0x73, 0xDF, 0x09, 0x3C, 0x9D, 
+0x45, 0x25, 0x60, 0x1D, 0x81, 0x30, 0xA5, 0xA0, 
+0xCD, 0xBC, 0xA5, 0xE1, 0xC5, 0xF4, 0x0A, 0xF2, 
+0x5B, 0xE1, 0xFF, 0xBC, 0xAB, 0x70, 0x75, 0x11, 
+0x7A, 0x2B, 0xB4, 0xB4, 0xAE, 0xA1, 0xA9, 0x7F, 
+0x74, 0x17, 0xCB, 0x28, 0xA4, 0x0F, 0x38, 0x63, 
+0x32, 0xD5, 0xFF, 0xBE, 0xDE, 0x2D, 0xFB, 0x3C, 
+0x6C, 0xD2, 0xE3, 0x99, 0x07, 0xFC, 0x46, 0xEA, 
+0x29, 0xD1, 0x13, 0x51, 0xDD, 0xC3, 0x4B, 0x88, 
+0x58, 0x28, 0xF6, 0x58, 0x0A, 0x6E, 0x02, 0x46, 
+0xD5, 0xE5, 0x9B, 0x99, 0x83, 0xC1, 0xED, 0xBA, 
+0xED, 0x81, 0x7F, 0x52, 0x7C, 0xA1, 0xB9, 0x70, 
+0x23, 0x64, 0x8F, 0x1D, 0x14, 0x52, 0x2E, 0xAE, 
+0x82, 0x50, 0x13, 0x4C, 0x0B, 0x93, 0x9A, 0xE3, 
+0x4D, 0x75, 0x6C, 0x8A, 0x06, 0xF4, 0x0E, 0x96, 
+0x12, 0x0D, 0xD5, 0x58, 0xC7, 0x76, 0x66, 0x93, 
+
+0x10, 0x99, 0x54, 0xFE, 0x38, 0x70, 0xCD, 0x6F, 
+0xF3, 0x84, 0x85, 0x81, 0xD2, 0x2D, 0xA9, 0x87, 
+0x19, 0xB0, 0xF3, 0x71, 0xBB, 0xF6, 0x44, 0x58, 
+0x76, 0xBE, 0x72, 0xC7, 0xF3, 0x36, 0x93, 0x28, 
+0x8B, 0x81, 0xD4, 0xB6, 0x70, 0x32, 0x4E, 0xD8, 
+0x18, 0x48, 0x10, 0x68, 0x24, 0xB7, 0x4B, 0x6A, 
+0x0C, 0xA4, 0x83, 0xCB, 0x73, 0x77, 0x7C, 0xE5, 
+0x9D, 0x0E, 0x4B, 0x8D, 0x8F, 0xFB, 0x87, 0x07, 
+0x39, 0xCD, 0x86, 0x8F, 0x89, 0x1D, 0xC5, 0x2D, 
+0x7C, 0xEA, 0xDB, 0x33, 0x90, 0x47, 0xF7, 0xD6, 
+0x4B, 0x56, 0x01, 0xBE, 0x68, 0x00, 0xD6, 0x4B, 
+0x97, 0xB4, 0x0B, 0x35, 0x0B, 0xFF, 0xD0, 0xA2, 
+0x94, 0x52, 0x84, 0x10, 0x68, 0x28, 0xE1, 0xC1, 
+0xF0, 0xC4, 0xBB, 0xE9, 0x95, 0x93, 0xC9, 0x7F, 
+0x94, 0xA6, 0xE9, 0xEB, 0xC0, 0xD6, 0x05, 0xF6, 
+0x79, 0x38, 0x2A, 0x9C, 0x2F, 0x96, 0x52, 0xEC, 
+0x86, 0x25, 0xD0, 0x53, 0x2B, 0xAD, 0x55, 0xC5, 
+0x8F, 0x8C, 0x73, 0x97, 0x97, 0x94, 0xCA, 0x72, 
+0x13, 0x8C, 0x12, 0x49, 0xD1, 0x13, 0xF9, 0x04, 
+0x8F, 0x81, 0x19, 0xB1, 0x76, 0x1B, 0xC3, 0xD8, 
+0x63, 0x85, 0x17, 0x54, 0x42, 0x79, 0x3E, 0x29, 
+0xD7, 0x47, 0x14, 0x5E, 0x05, 0xD8, 0x31, 0xBF, 
+0x3A, 0x5E, 0x30, 0xC4, 0x8D, 0x67, 0xBA, 0x19, 
+0x35, 
+
+0xDB, 0xD3, 0x5D, 0xE3, 0xE6, 0x98, 0x45, 0xDB, 
+0xC1, 0x52, 0xC0, 0x6E, 0x88, 0x47, 0xA6, 0xE3, 
+0x45, 0xAD, 0x67, 0x8F, 0x19, 0xDB, 0xB7, 0xAA, 
+0xDB, 0xC7, 0x9B, 0x85, 0x33, 0x24, 0xA3, 0x90, 
+0x2A, 0xF7, 0x56, 0x6C, 0xBE, 0xE6, 0x5C, 0xE5, 
+0x49, 0x89, 0x04, 0xC3, 0x36, 0x6F, 0xFA, 0xCB, 
+0x32, 0x7A, 0x77, 0xE4, 0x22, 0x42, 0x8D, 0xAB, 
+0x55, 0x20, 0x0F, 0x26, 0xFA, 0xAA, 0xD6, 0x1B, 
+0xC3, 0xBD, 0x24, 0xFA, 0xBC, 0x01, 0xA3, 0x0F, 
+0x1C, 0xD2, 0x08, 0x7A, 0xCD, 0xE2, 0x45, 0xE6, 
+0xB9, 0x6C, 0xDB, 0xF3, 0x40, 0x9F, 0xE0, 0xDE, 
+0x7D, 0xA3, 0xAC, 0xA6, 0xE4, 0xF7, 0x33, 0xBE, 
+0x43, 0xF2, 0xB9, 0x5E, 0x76, 0x86, 0xAD, 0x12, 
+0x48, 0xA1, 0x10, 0x6B, 0x66, 0x1B, 0x1E, 0xBA, 
+0xEA, 0xF3, 0xAE, 0xF0, 0x2F, 0x4D, 0x77, 0x1B, 
+0xD3, 0x44, 0x6E, 0x39, 0xF4, 0x61, 0x15, 0x64, 
+0xFD, 0x1C, 0xDD, 0xBD, 0xD0, 0xC8, 0x42, 0xF2, 
+0xC8, 0x21, 0x94, 0xBF, 0x55, 0x94, 0x6F, 0x65, 
+0xD0, 0xEC, 0xAB, 0x29, 0x6B, 0xC4, 0xB4, 0xAE, 
+0x26, 0x16, 0x50, 0x9D, 0x86, 0xE4, 0x96, 0x87, 
+0x31, 0x86, 0xDF, 0x8E, 0xC7, 0x01, 0x27, 0x76, 
+0x5B, 0x9D, 0x6F, 0x89, 0x7A, 0x51, 0x38, 0xD9, 
+0xDB, 0x2A, 0xC8, 0xB8, 0x98, 0x5B, 0xEA, 0x8F, 
+0x81, 0x5E, 
+
+0x80, 0x0C, 0x5D, 0xFF, 0xC1, 0x9C, 0x4E, 0xE9, 
+0x4F, 0x4B, 0x82, 0xC7, 0x4D, 0xCD, 0xA8, 0xDA, 
+0xF2, 0x0C, 0x12, 0xAB, 0x4D, 0x63, 0x50, 0x6D, 
+0x4E, 0x4B, 0xC1, 0xBC, 0x3E, 0xAC, 0xF2, 0xAD, 
+0x19, 0xBD, 0x09, 0xCD, 0x87, 0xF1, 0x97, 0x86, 
+0xE6, 0xF3, 0x25, 0xC9, 0xF1, 0x2E, 0x82, 0xB1, 
+0x74, 0xF7, 0x91, 0x92, 0x2B, 0xE8, 0xC2, 0x10, 
+0x12, 0xA0, 0x03, 0x54, 0x5F, 0x11, 0x60, 0x41, 
+0x1E, 0xA1, 0xF1, 0x29, 0x01, 0x4C, 0xC0, 0xE0, 
+0x57, 0xE9, 0xD5, 0x53, 0xF3, 0x08, 0x56, 0xD3, 
+0xE7, 0xE7, 0x8F, 0x5D, 0x30, 0x0A, 0x73, 0x8A, 
+0xA7, 0xAE, 0x45, 0x81, 0x08, 0xD4, 0xF3, 0x06, 
+0x21, 0x83, 0x67, 0xF0, 0x1A, 0xB8, 0x35, 0xD2, 
+0x19, 0xAE, 0x3D, 0x37, 0x06, 0x79, 0xE7, 0xE4, 
+0x0A, 0xAD, 0x88, 0x67, 0x12, 0xDC, 0xFD, 0x4E, 
+0xA8, 0xDC, 0x47, 0xAA, 0xE9, 0xAA, 0xAB, 0x6F, 
+0x97, 0xAF, 0x1D, 0xCA, 0x24, 0x8C, 0xBB, 0x96, 
+0x88, 0x1A, 0x9B, 0xF4, 0x6B, 0xDF, 0xFC, 0xFD, 
+0x2B, 0xE7, 0x49, 0xA0, 0x9A, 0x0C, 0xB6, 0x85, 
+0x79, 0xA5, 0x1B, 0xC6, 0xD7, 0x4C, 0x9E, 0x1E, 
+0x61, 0x0B, 0x11, 0x68, 0x3D, 0x4C, 0x59, 0xA2, 
+0x56, 0xCF, 0x66, 0x76, 0xF1, 0x0F, 0x91, 0x61, 
+0x61, 0x6D, 0xD3, 0xA0, 0x4F, 0x7F, 0x9D, 0xAB, 
+0x20, 0x6E, 0x42, 
+
+0x8C, 0x66, 0x27, 0xA8, 0x75, 0x60, 0xF5, 0x94, 
+0x16, 0x6E, 0xB4, 0xA4, 0x78, 0x47, 0xDD, 0x74, 
+0x0A, 0x13, 0x60, 0x1A, 0xB2, 0xEA, 0x25, 0x76, 
+0x25, 0x36, 0x91, 0x8C, 0x3B, 0xAF, 0x39, 0xC2, 
+0x9F, 0x7B, 0x45, 0x84, 0xE0, 0x80, 0x55, 0xC4, 
+0x10, 0x50, 0x9A, 0x47, 0x92, 0x11, 0x8C, 0x1B, 
+0x86, 0xF8, 0x73, 0x11, 0x52, 0x91, 0xA4, 0x81, 
+0x36, 0x56, 0xE4, 0x07, 0x59, 0xAF, 0x12, 0x5A, 
+0x26, 0xA1, 0x5C, 0xB2, 0xFC, 0x21, 0xF0, 0x2B, 
+0x5C, 0xF4, 0xA1, 0x27, 0xC1, 0x57, 0xA9, 0x4E, 
+0xFE, 0xE9, 0x7B, 0x42, 0xDF, 0xAC, 0xD0, 0xD1, 
+0x69, 0x9D, 0xEE, 0xDC, 0xFD, 0xF2, 0x9D, 0xFE, 
+0x6A, 0x22, 0xFD, 0xFA, 0x87, 0x8F, 0x28, 0xF3, 
+0x90, 0xB1, 0x56, 0x0B, 0x7A, 0xC4, 0x52, 0x70, 
+0x1A, 0x5B, 0xA8, 0x81, 0xC2, 0xEA, 0x62, 0xA0, 
+0x85, 0x4B, 0xE5, 0xD2, 0x7E, 0x87, 0x3D, 0x2D, 
+0x79, 0x51, 0x60, 0x03, 0xFA, 0x3B, 0xAA, 0x0F, 
+0x4E, 0xA6, 0xC6, 0xA7, 0x5A, 0xEF, 0x8D, 0x2E, 
+0x46, 0x08, 0xD0, 0x52, 0xC7, 0xF7, 0xF6, 0x40, 
+0xD2, 0x84, 0x3F, 0x2E, 0xDC, 0x2E, 0x1C, 0x5B, 
+0x26, 0xFF, 0x67, 0x1B, 0x88, 0xAA, 0x10, 0x95, 
+0x00, 0x2F, 0xD0, 0xB2, 0x1C, 0xE6, 0x3B, 0x47, 
+0x21, 0xAB, 0x9F, 0xD9, 0x51, 0xB5, 0xA1, 0x75, 
+0xB8, 0x2D, 0x01, 0x36, 
+
+0x8E, 0x09, 0x0B, 0x0C, 0x78, 0xDA, 0x19, 0x99, 
+0x66, 0x59, 0x19, 0xAD, 0x0F, 0xBB, 0x77, 0x57, 
+0xEF, 0x53, 0xB8, 0xF8, 0x1C, 0xA1, 0x39, 0x0A, 
+0x72, 0x88, 0x7E, 0x62, 0xDC, 0x90, 0xB1, 0x5B, 
+0x69, 0x23, 0x5F, 0xA5, 0x8B, 0x52, 0x12, 0x82, 
+0xEE, 0x6E, 0xD9, 0xD9, 0x36, 0xFC, 0xDB, 0x5B, 
+0x50, 0x3A, 0xA8, 0xEC, 0x58, 0x76, 0x0A, 0x05, 
+0x50, 0x46, 0x33, 0x9E, 0xDE, 0x46, 0x9D, 0x1C, 
+0xA2, 0x4E, 0xC0, 0x05, 0xCE, 0xA1, 0x18, 0x7F, 
+0x4E, 0xFC, 0x3C, 0xEE, 0x71, 0x1C, 0x51, 0x0D, 
+0xBC, 0x8C, 0xC3, 0xDA, 0x74, 0xAE, 0xE6, 0x71, 
+0x83, 0xAE, 0x7F, 0x6C, 0xCF, 0x06, 0x42, 0x16, 
+0x76, 0x92, 0xDD, 0x5E, 0x3D, 0x1B, 0x4B, 0x36, 
+0x65, 0xAD, 0x0C, 0x4F, 0xB1, 0x10, 0x66, 0x78, 
+0x8F, 0x7D, 0xDC, 0x21, 0xFF, 0xEC, 0xA5, 0x1A, 
+0x34, 0x66, 0xA5, 0xE9, 0x72, 0xC7, 0x6C, 0x56, 
+0x51, 0x5B, 0x3E, 0xEF, 0x1B, 0xB4, 0x29, 0x28, 
+0x54, 0xCF, 0x14, 0xC9, 0xFE, 0x60, 0x44, 0x5D, 
+0x63, 0xBD, 0x86, 0x6A, 0x36, 0x6F, 0xD8, 0x17, 
+0xFC, 0xCE, 0x9D, 0x4A, 0x65, 0x62, 0x2C, 0x2B, 
+0x42, 0x15, 0xA8, 0x4D, 0x69, 0x35, 0x69, 0x09, 
+0xB1, 0xB1, 0x98, 0x7F, 0x6D, 0xDC, 0xDE, 0xA7, 
+0xBB, 0x96, 0x46, 0xFF, 0x70, 0xFA, 0x0D, 0x69, 
+0x0E, 0x90, 0x79, 0xF6, 0x83, 
+
+0x9B, 0xD4, 0xFF, 0x58, 0xFF, 0x62, 0x66, 0x1E, 
+0xFF, 0x85, 0x3E, 0xAB, 0x0E, 0xB4, 0x71, 0x73, 
+0x34, 0xF9, 0xCD, 0x33, 0x6C, 0xFD, 0x00, 0x4A, 
+0xC5, 0xDC, 0x20, 0xC5, 0xC2, 0xF1, 0xDC, 0xFF, 
+0x18, 0xB0, 0xA1, 0x45, 0xBF, 0xF2, 0xDD, 0x09, 
+0xD7, 0x02, 0x67, 0xC0, 0x16, 0xEB, 0x43, 0xB7, 
+0xF1, 0xD3, 0xA9, 0xD5, 0x3A, 0x85, 0xB7, 0x19, 
+0x23, 0x07, 0x2E, 0xC3, 0xB9, 0x37, 0xB7, 0xE0, 
+0x5F, 0x1B, 0x60, 0x32, 0x39, 0xF9, 0x3E, 0xE8, 
+0x78, 0xBA, 0x27, 0x43, 0x31, 0x8A, 0x72, 0x90, 
+0x2A, 0x48, 0xCA, 0x1E, 0x71, 0x78, 0xFF, 0xC9, 
+0x7F, 0xDE, 0x49, 0x15, 0xB2, 0xB9, 0x04, 0x08, 
+0x37, 0x52, 0x9E, 0x8F, 0x51, 0x56, 0x51, 0x36, 
+0xBC, 0x52, 0x20, 0xB8, 0xE4, 0xFA, 0x58, 0xA3, 
+0x34, 0x35, 0x31, 0x72, 0x68, 0xF1, 0xEA, 0xAF, 
+0x1A, 0xBC, 0x52, 0xCA, 0xE9, 0xCE, 0xFA, 0x65, 
+0xCE, 0x75, 0x8A, 0xD6, 0xCA, 0x1A, 0xCA, 0xD2, 
+0x67, 0x49, 0xF6, 0x20, 0xB9, 0x9F, 0x3D, 0xED, 
+0xEF, 0x85, 0xC3, 0xF1, 0xE4, 0x94, 0x9C, 0x96, 
+0xD2, 0xCF, 0xC0, 0x03, 0xA6, 0xA0, 0xDC, 0x2C, 
+0x6E, 0xF3, 0xCF, 0xD9, 0x40, 0xB4, 0x0E, 0xC7, 
+0xA4, 0x97, 0x68, 0xD3, 0xD8, 0xC4, 0x39, 0x56, 
+0x5C, 0x69, 0x55, 0xA4, 0x7F, 0x90, 0x11, 0x02, 
+0xFB, 0xBF, 0x8D, 0x92, 0x69, 0x50, 
+
+0xC6, 0x4A, 0x36, 0xF7, 0xC7, 0xF8, 0x79, 0x5D, 
+0x06, 0x7C, 0x28, 0xD6, 0x91, 0xA5, 0x0A, 0x8C, 
+0x49, 0xF0, 0x64, 0xA0, 0xA0, 0x3D, 0x80, 0xDA, 
+0x33, 0xA3, 0x69, 0x3D, 0x80, 0x72, 0x6A, 0x0C, 
+0x2A, 0xDF, 0xD9, 0x5E, 0x04, 0xAC, 0xA3, 0xB6, 
+0x37, 0x7A, 0x4F, 0x7E, 0xA8, 0x4B, 0xD1, 0x89, 
+0x0D, 0x2C, 0x64, 0x98, 0x57, 0xA0, 0xC5, 0xCD, 
+0x04, 0x6F, 0xC2, 0x00, 0xB5, 0x50, 0x74, 0xF5, 
+0xE6, 0xA8, 0x71, 0x75, 0x3E, 0xAA, 0x27, 0x0E, 
+0xBA, 0xE9, 0x47, 0xD1, 0xCC, 0xBE, 0x8B, 0x42, 
+0x2C, 0x2B, 0xFA, 0x95, 0x82, 0xA9, 0x34, 0x80, 
+0x72, 0x9A, 0x5C, 0x1D, 0x15, 0x27, 0x2B, 0x18, 
+0xDB, 0x4A, 0xB7, 0x00, 0x2D, 0x10, 0xD7, 0xE0, 
+0xA8, 0x36, 0x6F, 0x55, 0xD5, 0xAD, 0xCE, 0xF8, 
+0x9E, 0x1F, 0x9F, 0x68, 0xC6, 0x27, 0x3D, 0xBC, 
+0xC5, 0x05, 0x28, 0x84, 0x7E, 0xD0, 0x18, 0x52, 
+0x88, 0xAE, 0x57, 0xF0, 0xC2, 0x48, 0x79, 0xF4, 
+0x72, 0xA4, 0x29, 0x60, 0x6B, 0xBA, 0x51, 0xFC, 
+0x1D, 0x5F, 0x6F, 0x7A, 0xF6, 0x7A, 0x3F, 0x11, 
+0xF2, 0x3A, 0x6D, 0x4E, 0x7C, 0x8E, 0xE6, 0xCB, 
+0xA1, 0x9D, 0x56, 0x2A, 0x3B, 0x57, 0x07, 0xE2, 
+0x08, 0xAE, 0x95, 0x96, 0xE0, 0xFE, 0x63, 0x61, 
+0x4F, 0x07, 0x42, 0x20, 0x4C, 0x6E, 0x3C, 0xEE, 
+0xF6, 0x0A, 0x08, 0xA2, 0x56, 0x53, 0x7B, 
+
+0xC1, 0x8F, 0xC5, 0x70, 0x08, 0xE3, 0x58, 0x96, 
+0x66, 0xFE, 0xEE, 0x16, 0x97, 0x8D, 0xC9, 0x70, 
+0xF9, 0xA4, 0xCF, 0x60, 0xF3, 0x65, 0xEF, 0x3C, 
+0x8F, 0x6A, 0x03, 0xD4, 0x3E, 0xB9, 0x04, 0xAB, 
+0x55, 0x68, 0xE5, 0x4D, 0x77, 0x38, 0xF8, 0xC2, 
+0x0F, 0x08, 0x13, 0xD8, 0xAF, 0x29, 0x39, 0x4B, 
+0x99, 0x8E, 0xBA, 0xC5, 0xDE, 0x1C, 0xDB, 0xC1, 
+0x35, 0xE3, 0x06, 0x1C, 0xB2, 0x9D, 0x36, 0x70, 
+0x57, 0x25, 0x97, 0xFA, 0x68, 0xD5, 0x6F, 0x40, 
+0x38, 0xA7, 0xB0, 0x85, 0x44, 0x2E, 0x53, 0x9D, 
+0x2D, 0x70, 0xD7, 0xE8, 0x5B, 0xA5, 0x1E, 0xAB, 
+0x50, 0x61, 0x5E, 0x99, 0x6A, 0x01, 0x14, 0x3A, 
+0xDB, 0x68, 0x36, 0xD7, 0x47, 0xE7, 0xBC, 0xAD, 
+0x4A, 0x3F, 0x95, 0x0E, 0x9C, 0x9B, 0x57, 0x26, 
+0x6D, 0x6E, 0xD4, 0x5B, 0xD3, 0xD0, 0x79, 0x69, 
+0xAB, 0xFC, 0x22, 0xCD, 0xCC, 0xF8, 0x2E, 0x5D, 
+0xCD, 0x85, 0x08, 0xC7, 0x1E, 0xAC, 0x04, 0x3B, 
+0xCE, 0x8A, 0x66, 0x27, 0x65, 0x24, 0xA9, 0xF4, 
+0x72, 0x9B, 0xC8, 0xAE, 0x7C, 0x43, 0xE8, 0xE7, 
+0xC8, 0x57, 0x50, 0x4C, 0x6B, 0xBF, 0x69, 0xF7, 
+0x22, 0x01, 0xBF, 0x64, 0x53, 0xD2, 0xED, 0xFC, 
+0x3B, 0x26, 0xF8, 0x61, 0x49, 0xB1, 0xF2, 0x38, 
+0x88, 0x2E, 0x44, 0x58, 0x77, 0xF6, 0x09, 0x49, 
+0x93, 0xC1, 0x43, 0xCC, 0x2E, 0x41, 0xB3, 0xB5, 
+
+0x87, 0x05, 0x59, 0xC6, 0x7B, 0x02, 0x1D, 0xB2, 
+0x38, 0x52, 0xFC, 0x2F, 0x7F, 0xDA, 0xAA, 0x59, 
+0xC4, 0xF9, 0x4D, 0xED, 0xF5, 0x77, 0x29, 0x5B, 
+0xF8, 0x3B, 0x45, 0x4C, 0x10, 0xDA, 0x19, 0x27, 
+0x13, 0xF3, 0xA3, 0xFC, 0x62, 0x85, 0x5C, 0x04, 
+0x31, 0xE7, 0x32, 0xC9, 0x7E, 0x93, 0x8D, 0x55, 
+0x11, 0xA9, 0x12, 0x95, 0xF2, 0x55, 0x31, 0x3D, 
+0x7B, 0x52, 0x33, 0x50, 0x54, 0xE9, 0x84, 0xBE, 
+0x47, 0x2C, 0xA5, 0xFC, 0x4C, 0xC4, 0x02, 0x26, 
+0x50, 0xD7, 0x10, 0xD6, 0xFD, 0x04, 0x35, 0x49, 
+0x25, 0xCB, 0x1A, 0x5C, 0x6C, 0xBC, 0xBA, 0x01, 
+0xD5, 0x9B, 0xEA, 0x10, 0x0E, 0x1D, 0x5E, 0x5F, 
+0x84, 0x63, 0xA3, 0xFA, 0x1E, 0xCF, 0x72, 0x96, 
+0x07, 0xB3, 0xC7, 0x78, 0xF7, 0xAC, 0xDE, 0x41, 
+0x7F, 0x58, 0x8E, 0x11, 0xB8, 0x46, 0xED, 0x78, 
+0x65, 0xF2, 0xBC, 0x1A, 0xFF, 0xD8, 0x77, 0x45, 
+0x17, 0x00, 0x8E, 0xE0, 0xF5, 0x51, 0xD0, 0x94, 
+0x58, 0x16, 0xCE, 0x8B, 0x9A, 0x56, 0xBC, 0xAD, 
+0x77, 0x4F, 0xAE, 0xB6, 0x96, 0xF5, 0x72, 0x51, 
+0x11, 0x1D, 0x0B, 0x3D, 0x32, 0xB0, 0xA4, 0x36, 
+0x77, 0xE8, 0xD7, 0x0C, 0x04, 0xF3, 0x7C, 0x81, 
+0x8A, 0x17, 0x28, 0x61, 0x31, 0xBB, 0xC3, 0xBD, 
+0xC9, 0xFC, 0xA3, 0xD1, 0xB5, 0xEC, 0x8D, 0x1D, 
+0x0C, 0x13, 0x78, 0xD6, 0xB7, 0x3F, 0x00, 0xA8, 
+0x56, 
+
+0x7E, 0x3C, 0xB9, 0xA3, 0x80, 0x34, 0x0A, 0xE4, 
+0x3E, 0x5B, 0xE7, 0x4E, 0xD8, 0x83, 0x4E, 0xE5, 
+0x9D, 0x86, 0xAD, 0x97, 0x4F, 0xC5, 0x11, 0x03, 
+0x26, 0x42, 0xE2, 0xA3, 0xA3, 0x3A, 0x9E, 0x61, 
+0x47, 0x9D, 0x23, 0xE8, 0xD5, 0x44, 0x3D, 0x2A, 
+0xB2, 0xDC, 0x71, 0x12, 0x3A, 0xC7, 0x81, 0xF0, 
+0x39, 0x51, 0xE2, 0x3F, 0x4D, 0xB7, 0xB1, 0x5C, 
+0x61, 0xE0, 0x05, 0xE5, 0x07, 0x78, 0xA2, 0x07, 
+0xCA, 0x1C, 0xCD, 0x59, 0xF3, 0xE9, 0xE0, 0x6F, 
+0xBC, 0x08, 0x38, 0x12, 0x2A, 0xF8, 0x67, 0x78, 
+0x01, 0xC9, 0xF4, 0xD1, 0xD7, 0x4A, 0x5C, 0x7D, 
+0x02, 0x38, 0x86, 0x4A, 0x57, 0xC0, 0x13, 0x7B, 
+0x1C, 0x34, 0x7A, 0x6B, 0x5D, 0x74, 0x4C, 0x11, 
+0x69, 0x3C, 0x15, 0x36, 0x85, 0x6C, 0x1B, 0xB7, 
+0xC2, 0x31, 0xF6, 0x03, 0x32, 0x71, 0x5A, 0x27, 
+0x4D, 0x1B, 0x48, 0x3D, 0xD1, 0x98, 0xF0, 0xEB, 
+0x21, 0xAF, 0x9C, 0x22, 0x81, 0x8F, 0x9F, 0x78, 
+0xA1, 0x13, 0xAD, 0x21, 0x62, 0xC3, 0xB2, 0xCB, 
+0x9E, 0x4D, 0xC9, 0x41, 0xA2, 0xDD, 0xDA, 0x74, 
+0x68, 0x5D, 0xDD, 0x81, 0x45, 0xDF, 0x12, 0xF7, 
+0xE1, 0xFC, 0x62, 0xB8, 0x26, 0x5F, 0x9D, 0xF4, 
+0xBA, 0xB3, 0x2F, 0x43, 0x0C, 0x68, 0x89, 0xA6, 
+0x84, 0xB2, 0x3D, 0x91, 0xF8, 0x44, 0x3F, 0x41, 
+0x22, 0xAF, 0x19, 0xCD, 0x6E, 0x60, 0xCD, 0x72, 
+0x13, 0x8B, 
+
+0x39, 0xF6, 0xF4, 0xEA, 0xA7, 0x93, 0xC2, 0x86, 
+0x6D, 0x9F, 0x22, 0x9A, 0xF6, 0x70, 0x1F, 0x92, 
+0x93, 0xDF, 0x11, 0xC4, 0x75, 0x49, 0x1F, 0xD8, 
+0x25, 0x87, 0xF2, 0x2B, 0xEB, 0x26, 0x2C, 0xDB, 
+0x57, 0xE9, 0x47, 0x39, 0x61, 0xA1, 0x6E, 0x9A, 
+0x4E, 0x31, 0xCD, 0x82, 0xC0, 0x18, 0xB6, 0x8A, 
+0x2B, 0x52, 0xAA, 0xE7, 0x70, 0xB4, 0x00, 0x51, 
+0x1F, 0x76, 0x47, 0x38, 0xBB, 0xEF, 0x8F, 0x77, 
+0xEC, 0xCD, 0x87, 0x1A, 0x76, 0xB2, 0x16, 0x36, 
+0xB1, 0xBA, 0xBE, 0x6C, 0xB2, 0x83, 0xBB, 0xE2, 
+0x61, 0x11, 0xF6, 0x8C, 0x96, 0x02, 0xD5, 0x70, 
+0xF3, 0x94, 0xD7, 0xBA, 0x00, 0x4F, 0xA1, 0x0B, 
+0x43, 0x50, 0x63, 0x25, 0xB8, 0x86, 0xC2, 0xE7, 
+0xB0, 0x68, 0x20, 0xCA, 0xC1, 0xD3, 0x07, 0x66, 
+0x9B, 0x67, 0xF6, 0x79, 0x6D, 0x62, 0x36, 0x07, 
+0xEE, 0x90, 0x5E, 0x6B, 0xCE, 0x5C, 0x93, 0x27, 
+0x08, 0xF1, 0x61, 0xD0, 0x07, 0x8F, 0xB7, 0x30, 
+0xDA, 0x8D, 0xF6, 0xC7, 0x67, 0x82, 0x2E, 0xE5, 
+0xBF, 0x0B, 0x46, 0x82, 0xF2, 0x5D, 0x85, 0x2D, 
+0xCA, 0x8A, 0x4E, 0xE5, 0xAF, 0x45, 0x71, 0xA1, 
+0xC4, 0xC9, 0xE1, 0xB1, 0x6C, 0xB7, 0x76, 0x67, 
+0xD1, 0x1F, 0x86, 0x66, 0x80, 0x11, 0xBE, 0xD1, 
+0x78, 0x50, 0xDA, 0x55, 0x2B, 0xF9, 0x8D, 0x51, 
+0xA7, 0x68, 0x5B, 0x76, 0x8E, 0x1B, 0x9C, 0xE8, 
+0xFE, 0xC3, 0x6B, 
+
+0x00, 0x62, 0xC1, 0x47, 0x36, 0x01, 0x39, 0xEB, 
+0x86, 0x06, 0xB1, 0x36, 0x5D, 0xB3, 0x66, 0x09, 
+0x9C, 0x4A, 0x89, 0x32, 0x71, 0xD3, 0x4F, 0x32, 
+0xA2, 0x61, 0x7E, 0x82, 0x2F, 0xBC, 0x87, 0x83, 
+0xAB, 0xE2, 0x0C, 0xD9, 0x45, 0x44, 0x87, 0x90, 
+0x95, 0x82, 0x10, 0x47, 0xA7, 0x88, 0x09, 0xF1, 
+0x8D, 0x52, 0x9A, 0x57, 0xBB, 0x12, 0xAD, 0xCD, 
+0x2B, 0xAF, 0xF2, 0x0B, 0x84, 0xC4, 0x3E, 0x74, 
+0x5F, 0x7E, 0xE8, 0xC4, 0x02, 0xF8, 0x5F, 0x3D, 
+0x49, 0xE5, 0xF5, 0xBE, 0xB9, 0x17, 0xD1, 0x0B, 
+0xBD, 0x35, 0xA3, 0xE9, 0xA3, 0xFF, 0xB7, 0xE7, 
+0xAB, 0xD4, 0x1D, 0x06, 0x90, 0xD1, 0x67, 0x2D, 
+0xEC, 0xBB, 0x4D, 0xBF, 0x78, 0x58, 0xA1, 0xD2, 
+0x98, 0x7D, 0x28, 0x9A, 0x63, 0xB1, 0x87, 0x99, 
+0x93, 0x7F, 0xBC, 0x52, 0xBF, 0xBC, 0x34, 0xA9, 
+0xC6, 0x0E, 0x0D, 0x36, 0x52, 0x59, 0xBA, 0xEB, 
+0x85, 0x24, 0x90, 0xE9, 0xDF, 0xDD, 0xE4, 0xFE, 
+0x80, 0xBB, 0x13, 0x25, 0x51, 0xB1, 0x08, 0x08, 
+0xD5, 0x3A, 0xC6, 0x5A, 0xD7, 0x27, 0xE8, 0x5B, 
+0x0D, 0x39, 0x50, 0xB3, 0x2B, 0x89, 0x9D, 0x2C, 
+0xD3, 0x96, 0xF0, 0x98, 0xDA, 0xAF, 0xEA, 0xFC, 
+0x66, 0xEF, 0x33, 0xCF, 0x31, 0xF5, 0xBC, 0x90, 
+0x92, 0x5C, 0x5B, 0xFA, 0xD6, 0x4D, 0xF2, 0xF0, 
+0xD3, 0x1C, 0x28, 0x2F, 0x35, 0x0C, 0x2D, 0x8A, 
+0x54, 0x1C, 0x3B, 0x1A, 
+
+0xB5, 0x3E, 0xC8, 0x44, 0x0D, 0x34, 0xEA, 0x68, 
+0x2B, 0x47, 0xB3, 0x3F, 0x69, 0xC2, 0x39, 0xEE, 
+0xBA, 0xDE, 0x50, 0x19, 0x11, 0x38, 0x92, 0x51, 
+0x31, 0xD8, 0x57, 0x04, 0x11, 0x0C, 0xA3, 0x9B, 
+0xD1, 0x8F, 0xA3, 0xB5, 0xB8, 0x80, 0x32, 0x6D, 
+0x61, 0x1E, 0x87, 0xF6, 0x01, 0x80, 0x6A, 0x7B, 
+0x96, 0xF7, 0x5E, 0x2B, 0x0F, 0xE4, 0x4F, 0xF6, 
+0xC9, 0x03, 0x19, 0x77, 0xA0, 0xCD, 0x42, 0x43, 
+0xFB, 0x48, 0x45, 0x58, 0x5F, 0x70, 0x74, 0x8B, 
+0x5A, 0x69, 0x57, 0x63, 0xB3, 0x4A, 0x90, 0xCF, 
+0xA9, 0x78, 0x55, 0xDB, 0x8B, 0x0C, 0x33, 0xAC, 
+0xF2, 0x29, 0x56, 0x6D, 0x9F, 0x8F, 0x67, 0x15, 
+0xFD, 0xD0, 0x35, 0x65, 0x29, 0x35, 0xF5, 0x3E, 
+0xAD, 0xF7, 0xEE, 0x26, 0xA0, 0x73, 0x2B, 0x56, 
+0x19, 0xD6, 0x25, 0xA3, 0x82, 0x76, 0x59, 0xF7, 
+0x75, 0xAC, 0xB4, 0xC1, 0x4F, 0x32, 0x5A, 0x6D, 
+0xFA, 0xD8, 0x67, 0x79, 0x54, 0x21, 0x59, 0xB8, 
+0xE8, 0x43, 0xCB, 0xC8, 0x6D, 0xB6, 0x59, 0xF4, 
+0x12, 0xCC, 0xB5, 0x2A, 0xE1, 0xE0, 0xD4, 0x60, 
+0x58, 0x27, 0x18, 0xDE, 0x4E, 0xD4, 0x25, 0x3D, 
+0xBA, 0xC5, 0x7A, 0xFB, 0xFB, 0x16, 0x06, 0x20, 
+0x46, 0xBD, 0x26, 0x00, 0xD0, 0xE0, 0x1D, 0x39, 
+0x40, 0x40, 0x4F, 0x62, 0xC7, 0x51, 0xF4, 0x9A, 
+0xCC, 0x19, 0x8B, 0x2F, 0x0A, 0x5D, 0xFC, 0x2C, 
+0xCA, 0x36, 0x9D, 0x74, 0x13, 
+
+0x99, 0xA3, 0x3C, 0x5B, 0x8B, 0x0D, 0x45, 0x0B, 
+0x03, 0xB7, 0x19, 0x5E, 0xC6, 0x72, 0xD7, 0xBE, 
+0xC6, 0xBD, 0xD9, 0x40, 0xE5, 0x2B, 0x4A, 0x04, 
+0xC6, 0xE6, 0xD4, 0x95, 0x98, 0xB2, 0xF3, 0xEE, 
+0x42, 0xA1, 0x9B, 0xAD, 0x52, 0x33, 0xB8, 0xA7, 
+0x30, 0xBD, 0x0F, 0x6C, 0xC6, 0x8D, 0x5E, 0x6C, 
+0xC4, 0x6A, 0xD2, 0xA5, 0xFE, 0x5D, 0x8D, 0xAE, 
+0x57, 0x76, 0x11, 0xBD, 0xDC, 0xBD, 0x9F, 0xCD, 
+0x57, 0x34, 0xB5, 0xD6, 0xD8, 0xF7, 0x15, 0x2F, 
+0xB7, 0x22, 0xC6, 0x07, 0x63, 0xCC, 0x01, 0x63, 
+0x4B, 0x4A, 0xB8, 0xB5, 0xED, 0x76, 0x93, 0x17, 
+0x17, 0x43, 0x86, 0x8B, 0x91, 0x36, 0xE9, 0x5B, 
+0xDC, 0xDD, 0x85, 0x7D, 0x7D, 0x41, 0x2A, 0xB5, 
+0x31, 0x7A, 0xE2, 0x74, 0x2B, 0x0D, 0xF8, 0xD8, 
+0x60, 0x7C, 0x38, 0x60, 0xCD, 0x87, 0x83, 0xC4, 
+0x3A, 0xBB, 0x98, 0xA0, 0x17, 0xFD, 0x38, 0x70, 
+0xE9, 0x4F, 0x84, 0x8C, 0x93, 0xAD, 0xBA, 0xF9, 
+0x00, 0x6A, 0x06, 0x4E, 0xEB, 0xAB, 0x47, 0x00, 
+0x7C, 0xA1, 0x62, 0x57, 0x08, 0x61, 0xF4, 0xE4, 
+0xB4, 0x61, 0xA2, 0xC1, 0x8E, 0x43, 0x2E, 0xC8, 
+0xDE, 0x59, 0xE1, 0xEA, 0xBA, 0x72, 0x57, 0x25, 
+0x7C, 0x2E, 0xA6, 0x6F, 0x02, 0x58, 0xA0, 0xE0, 
+0x5B, 0xB5, 0x6D, 0xF8, 0xBD, 0xD7, 0x71, 0x2F, 
+0x3D, 0x73, 0xEA, 0x36, 0xEB, 0xAC, 0xA1, 0x7C, 
+0x2D, 0x52, 0x1B, 0xC1, 0x0D, 0xB1, 
+
+0x3B, 0x3E, 0xFB, 0x0E, 0x72, 0xDC, 0x42, 0xDC, 
+0x92, 0x59, 0x95, 0xE3, 0x68, 0x6E, 0x30, 0x82, 
+0x1C, 0x3F, 0x7F, 0x40, 0x92, 0xCA, 0x0B, 0xB4, 
+0x0C, 0xA5, 0x5B, 0xA6, 0x8B, 0x99, 0xBF, 0x88, 
+0x65, 0x84, 0x90, 0xAA, 0x8B, 0xE3, 0x62, 0x4B, 
+0xFD, 0x18, 0x08, 0x66, 0x1F, 0xF1, 0xD5, 0xB7, 
+0xD3, 0x67, 0x9B, 0x30, 0x68, 0x9D, 0x20, 0x5A, 
+0xC0, 0xB1, 0xD3, 0xBE, 0xBF, 0x38, 0xD7, 0xA8, 
+0xFD, 0x2F, 0x8E, 0x8D, 0xD1, 0x85, 0x17, 0xB4, 
+0x99, 0xFB, 0x1A, 0x01, 0x8F, 0xB3, 0x3A, 0xF9, 
+0x5E, 0x38, 0x63, 0xB5, 0x5E, 0x94, 0x0C, 0xCF, 
+0x23, 0xAC, 0xF8, 0xC0, 0x44, 0xD7, 0x47, 0x8C, 
+0x71, 0x53, 0x78, 0x4A, 0x2B, 0xA5, 0x5B, 0x8B, 
+0x28, 0x2C, 0x60, 0x7F, 0x84, 0x07, 0x1D, 0xC3, 
+0x82, 0xBD, 0x60, 0xD9, 0x7F, 0x9F, 0x56, 0x67, 
+0x26, 0x6C, 0x27, 0x58, 0x6B, 0xF0, 0xE4, 0x30, 
+0xC9, 0x75, 0xE9, 0x79, 0x96, 0xAF, 0xA0, 0x8A, 
+0xDF, 0x3D, 0xA6, 0x3F, 0x83, 0xC1, 0x0C, 0xAA, 
+0x84, 0x0C, 0x6C, 0x3F, 0x43, 0x60, 0xC5, 0xA0, 
+0x98, 0x21, 0xC6, 0xD2, 0xFE, 0xA7, 0x67, 0xA6, 
+0xE3, 0xCD, 0x66, 0x37, 0xC3, 0xF8, 0x1F, 0xDA, 
+0x08, 0x87, 0xFA, 0x20, 0xAB, 0xC4, 0x98, 0x87, 
+0xF2, 0xBF, 0xA0, 0x5C, 0x51, 0x24, 0x90, 0x5A, 
+0x8F, 0xF9, 0x64, 0xA9, 0x89, 0xC8, 0x23, 0x6C, 
+0xA0, 0xAA, 0x18, 0xF0, 0xDE, 0xA8, 0xE4, 
+
+0x93, 0x6C, 0x4B, 0x68, 0xAC, 0x5F, 0x01, 0x26, 
+0x3C, 0x27, 0xB9, 0x55, 0xCF, 0xA6, 0x03, 0xA7, 
+0x75, 0x3A, 0xE0, 0x3B, 0xCF, 0x47, 0xD2, 0xBB, 
+0xCA, 0x44, 0x03, 0x3A, 0x32, 0xBA, 0x80, 0x46, 
+0x11, 0x3E, 0x03, 0x63, 0xD8, 0x87, 0x72, 0xF8, 
+0x30, 0xEA, 0x24, 0x90, 0x5D, 0xF4, 0x67, 0x58, 
+0x17, 0xD5, 0xF8, 0xCD, 0x60, 0xE0, 0xFF, 0xCA, 
+0xB8, 0x8B, 0x72, 0xFE, 0x07, 0xCA, 0x95, 0xA3, 
+0xF6, 0x82, 0x97, 0x60, 0xBF, 0x58, 0xB4, 0x64, 
+0xAD, 0x87, 0x9B, 0x2C, 0x6C, 0x26, 0xDE, 0xAD, 
+0xDC, 0xE5, 0xF6, 0xC1, 0x67, 0xE3, 0x97, 0x5D, 
+0xA3, 0xFD, 0xD1, 0x7D, 0x07, 0x69, 0x70, 0xC4, 
+0x52, 0x8D, 0xD2, 0xFA, 0x9B, 0xA8, 0xBA, 0xD3, 
+0x01, 0x79, 0x40, 0xA2, 0x3A, 0xA2, 0x41, 0x56, 
+0x11, 0x24, 0xD6, 0x2A, 0x5E, 0xEA, 0x66, 0x0E, 
+0xBC, 0xF5, 0x1B, 0x50, 0x6D, 0x82, 0x0C, 0x5E, 
+0x2E, 0x54, 0x72, 0x15, 0xD6, 0x59, 0x5B, 0xD8, 
+0xEB, 0xCB, 0x8A, 0x06, 0xC5, 0x2B, 0x8E, 0x00, 
+0xE3, 0x3D, 0xE7, 0x37, 0x4E, 0x50, 0x63, 0x99, 
+0x7E, 0x32, 0xB5, 0x26, 0xC1, 0xC0, 0xDD, 0xC4, 
+0xBE, 0xEA, 0xCF, 0x5B, 0x15, 0xCB, 0x4B, 0x62, 
+0x73, 0x1B, 0x58, 0x49, 0xF3, 0x26, 0x7E, 0xDE, 
+0x84, 0x80, 0x29, 0x9E, 0x98, 0xB7, 0x15, 0x10, 
+0x39, 0xBB, 0x71, 0xFD, 0x0A, 0x00, 0x18, 0xFC, 
+0xF8, 0x3D, 0x91, 0x37, 0x50, 0xE8, 0x55, 0x84, 
+
+0xC6, 0xDF, 0xD1, 0xAE, 0xD9, 0xD8, 0xC9, 0x52, 
+0x04, 0x2E, 0x59, 0x0F, 0xF6, 0x7E, 0x80, 0x02, 
+0xB6, 0xB0, 0x0E, 0x0B, 0xD9, 0xC8, 0x16, 0xA3, 
+0x87, 0xFD, 0x80, 0x83, 0x19, 0xA6, 0xD2, 0xA5, 
+0xE4, 0x35, 0x45, 0x20, 0x88, 0xD3, 0xC1, 0x9E, 
+0x08, 0xDC, 0x9A, 0x85, 0x5B, 0x09, 0xC3, 0xD1, 
+0xF1, 0x44, 0x2F, 0x19, 0x24, 0xA0, 0x20, 0x9C, 
+0xE3, 0x85, 0x1B, 0x85, 0x9A, 0xE9, 0x6C, 0xA6, 
+0x34, 0x32, 0x22, 0xD9, 0x87, 0xCF, 0x52, 0xAA, 
+0xE6, 0x84, 0xF0, 0x8E, 0x9C, 0xC6, 0x42, 0xE6, 
+0x27, 0x08, 0x41, 0xD6, 0x7B, 0x6E, 0x86, 0xE9, 
+0xD1, 0xA8, 0x2F, 0xA0, 0x67, 0x08, 0xB4, 0xD5, 
+0xA8, 0xCB, 0x56, 0x1E, 0x5D, 0x1D, 0x74, 0x98, 
+0x81, 0xC4, 0xB4, 0x84, 0x5C, 0xCC, 0xCE, 0x00, 
+0x4F, 0xA5, 0x2C, 0xDA, 0x36, 0x47, 0x2C, 0x1D, 
+0x4D, 0x24, 0xCD, 0x0A, 0xF3, 0x75, 0xF0, 0x03, 
+0x54, 0xD2, 0x1E, 0xEB, 0xB5, 0xA3, 0xF7, 0x24, 
+0x70, 0xC5, 0x6B, 0x85, 0x06, 0xDE, 0x90, 0xCC, 
+0x70, 0x52, 0x38, 0x2A, 0xD4, 0x7B, 0xF5, 0x3E, 
+0xC3, 0x30, 0x33, 0x84, 0xDF, 0x14, 0x68, 0x34, 
+0xC5, 0xEE, 0x6B, 0xBF, 0xEB, 0xEA, 0xB1, 0xE5, 
+0x60, 0x23, 0xCE, 0x5E, 0xF4, 0xD2, 0xD4, 0xD9, 
+0x3E, 0x15, 0x1D, 0xF4, 0xB7, 0x90, 0x96, 0xA6, 
+0x78, 0x5B, 0x4B, 0xF2, 0x1E, 0x88, 0x72, 0x68, 
+0xB1, 0x15, 0x2E, 0x9A, 0x0E, 0xC7, 0x62, 0x06, 
+0x1C, 
+
+0x86, 0xD3, 0x17, 0xA4, 0x23, 0x3D, 0x48, 0xAE, 
+0xDE, 0x8F, 0x72, 0xB9, 0x59, 0xBE, 0xC0, 0x61, 
+0x3D, 0xEE, 0x0A, 0xF5, 0xDC, 0xC2, 0x4C, 0xC4, 
+0xDA, 0xE7, 0x53, 0xA5, 0x1E, 0x7D, 0x67, 0x55, 
+0xB3, 0x8D, 0xA1, 0x60, 0xC8, 0xF5, 0xE2, 0x49, 
+0x1A, 0x93, 0xEB, 0x77, 0xA0, 0xBD, 0xAB, 0x1A, 
+0x66, 0xAF, 0x68, 0xE1, 0x90, 0x6A, 0x2F, 0x2F, 
+0x54, 0x4E, 0xDF, 0x5D, 0xF6, 0x99, 0x0D, 0xC3, 
+0x51, 0xF1, 0x68, 0x89, 0x7E, 0xE8, 0xB8, 0xBF, 
+0x15, 0x48, 0x0A, 0x6B, 0x3C, 0x91, 0x2A, 0x21, 
+0x06, 0xF8, 0xF2, 0x97, 0x4D, 0xAA, 0xA2, 0x72, 
+0x67, 0xC2, 0x71, 0xB2, 0x13, 0xD4, 0x57, 0xC7, 
+0x45, 0x5B, 0xCC, 0x09, 0x78, 0x75, 0x73, 0x8A, 
+0xD8, 0x17, 0x35, 0x78, 0x50, 0x92, 0x17, 0xE9, 
+0xA0, 0xF0, 0x83, 0x0D, 0x15, 0x4B, 0xD1, 0x75, 
+0x48, 0x4B, 0x1E, 0x9E, 0xD6, 0xCC, 0x9E, 0x2F, 
+0x02, 0x8C, 0x0C, 0x22, 0x31, 0x29, 0x17, 0x50, 
+0x05, 0x46, 0x73, 0x20, 0x5E, 0xB8, 0x11, 0x99, 
+0xFF, 0x26, 0x17, 0x99, 0x3E, 0x24, 0xA8, 0x49, 
+0xD9, 0xEB, 0xF2, 0xA1, 0x6A, 0x12, 0xF1, 0x9E, 
+0xE0, 0x63, 0x04, 0x16, 0x7A, 0xA9, 0x76, 0x57, 
+0x81, 0x65, 0xA2, 0x7A, 0xDF, 0x2B, 0x39, 0x71, 
+0x3A, 0x15, 0x77, 0x9A, 0x07, 0x46, 0xC1, 0x03, 
+0xE9, 0x27, 0xDA, 0x55, 0xEA, 0xDA, 0x99, 0x08, 
+0x35, 0x22, 0x23, 0xFB, 0x67, 0x12, 0x36, 0x38, 
+0x04, 0x58, 
+
+0xC2, 0x9F, 0x22, 0xA6, 0x71, 0x30, 0xDE, 0x5A, 
+0xC6, 0x4F, 0xC1, 0x48, 0x69, 0x4D, 0x90, 0xC2, 
+0xBE, 0xFA, 0xD4, 0x58, 0x29, 0xD1, 0xB7, 0xD8, 
+0x8B, 0x7F, 0x9A, 0x59, 0xFC, 0x45, 0x9C, 0x85, 
+0x0D, 0xCC, 0x01, 0x6E, 0x7D, 0xB7, 0xFD, 0x0D, 
+0xDA, 0x50, 0x16, 0x70, 0xA6, 0x9D, 0xCB, 0x53, 
+0x7B, 0x51, 0x97, 0x39, 0x9D, 0x56, 0xD3, 0xA2, 
+0x39, 0xCF, 0x55, 0xB3, 0xCF, 0x43, 0xD7, 0x60, 
+0x7F, 0x30, 0x64, 0x00, 0xDC, 0x43, 0x24, 0x1D, 
+0x7C, 0x7F, 0x0E, 0x41, 0x52, 0xA8, 0x83, 0xC2, 
+0x82, 0xF4, 0xD2, 0x1A, 0x56, 0xCE, 0x41, 0x1E, 
+0x51, 0x60, 0xE9, 0x0D, 0x06, 0x4C, 0xA5, 0x88, 
+0xF2, 0x16, 0xF3, 0xCF, 0x67, 0x00, 0x96, 0xB1, 
+0x1C, 0x0A, 0x49, 0x14, 0x5A, 0xEC, 0x9C, 0xA7, 
+0xB3, 0xE6, 0x92, 0x55, 0xE5, 0x04, 0xAC, 0xBA, 
+0xBB, 0xCF, 0x97, 0x7A, 0xE0, 0xC3, 0x45, 0x24, 
+0xF7, 0x10, 0x82, 0x9D, 0xFB, 0x3B, 0x61, 0xA2, 
+0xB9, 0x4B, 0x6A, 0x59, 0xCE, 0x4F, 0xDE, 0xA0, 
+0xFE, 0x4C, 0x02, 0x43, 0x88, 0x95, 0xA6, 0x71, 
+0x84, 0x40, 0x1A, 0x60, 0xEF, 0x7B, 0x8D, 0xFC, 
+0xBD, 0xDE, 0x06, 0x8A, 0xB8, 0x7D, 0x7E, 0x68, 
+0xEA, 0xC4, 0x8D, 0x7D, 0xF3, 0xA1, 0xFE, 0x5A, 
+0x44, 0xB0, 0x70, 0xC8, 0x04, 0x5C, 0xA8, 0x46, 
+0xF3, 0x33, 0x52, 0xB4, 0xA7, 0xC0, 0x00, 0x24, 
+0xF0, 0x1C, 0x22, 0x14, 0xF4, 0x80, 0x38, 0x25, 
+0x5A, 0x3B, 0x71, 
+
+0x7A, 0x80, 0xA4, 0x53, 0x54, 0x6D, 0x95, 0xAB, 
+0x9C, 0x63, 0x6A, 0x32, 0x11, 0x2E, 0xE0, 0x1A, 
+0xF7, 0x0D, 0x22, 0x1C, 0xE5, 0xAE, 0xCE, 0xDA, 
+0x54, 0x1D, 0xCC, 0x85, 0x2F, 0x7A, 0xA2, 0x53, 
+0x1D, 0x29, 0xA9, 0x48, 0xB6, 0xA3, 0xB7, 0xD4, 
+0x8F, 0x4C, 0x50, 0x40, 0x52, 0x4A, 0xE6, 0x0B, 
+0xF4, 0xE7, 0x70, 0xEE, 0x51, 0xC2, 0x6A, 0xD0, 
+0x56, 0x23, 0x51, 0xF2, 0x72, 0x7C, 0xEE, 0xBA, 
+0x76, 0x26, 0x07, 0xDE, 0xC0, 0xBD, 0x6A, 0xD3, 
+0x9B, 0x6A, 0x73, 0xC4, 0x97, 0xF6, 0x16, 0xE8, 
+0x03, 0x63, 0x75, 0xEF, 0x57, 0xB7, 0xE2, 0xEA, 
+0x61, 0xC8, 0x42, 0x62, 0x0B, 0x3E, 0x0A, 0xB6, 
+0x78, 0xA3, 0x5C, 0xE7, 0x3A, 0xF6, 0x7A, 0x75, 
+0x5E, 0x88, 0x9E, 0xC5, 0xA1, 0x86, 0x47, 0x0E, 
+0x40, 0xAE, 0xF6, 0xD0, 0x0B, 0xAC, 0x1E, 0xA8, 
+0x52, 0x03, 0xD5, 0xD5, 0x6F, 0x04, 0x41, 0x4B, 
+0x2D, 0x0D, 0x26, 0x2E, 0xCB, 0xCA, 0x0A, 0xAD, 
+0x2E, 0xB0, 0x7D, 0x87, 0xE5, 0x57, 0x46, 0x7C, 
+0x45, 0xE5, 0xAF, 0x97, 0x72, 0x89, 0x3B, 0x99, 
+0x24, 0xAF, 0x71, 0x87, 0x21, 0x52, 0xAF, 0xE7, 
+0xF4, 0x2F, 0xA8, 0x9C, 0x92, 0x11, 0x07, 0x1F, 
+0xD6, 0x24, 0x95, 0x8F, 0xD8, 0x0B, 0x97, 0xF5, 
+0xFE, 0xB6, 0x45, 0x4B, 0xF7, 0xC9, 0xA9, 0xFB, 
+0x77, 0xAA, 0xC3, 0xB7, 0xB3, 0x38, 0x55, 0xEB, 
+0x4A, 0xD0, 0x84, 0xD6, 0x5B, 0x78, 0xCE, 0x95, 
+0xE4, 0xA2, 0x5C, 0xAC, 
+
+0x3C, 0x8E, 0x38, 0xF0, 0x5B, 0x62, 0x1D, 0x97, 
+0xE9, 0xF3, 0xF5, 0x7D, 0xB4, 0x0D, 0x45, 0x31, 
+0x6A, 0x35, 0x89, 0xE5, 0x2A, 0x33, 0x27, 0xAA, 
+0x0A, 0x40, 0x82, 0x57, 0x95, 0x10, 0x89, 0xD9, 
+0xF6, 0xAD, 0x58, 0x18, 0x56, 0x04, 0xD7, 0x73, 
+0x57, 0x42, 0x1D, 0xF4, 0x27, 0x33, 0xB8, 0x62, 
+0xC8, 0xD3, 0x12, 0x89, 0x88, 0x28, 0xCB, 0x28, 
+0x45, 0xED, 0x55, 0x63, 0xCF, 0x12, 0x1F, 0x78, 
+0x4B, 0xBC, 0xF4, 0x1E, 0xC0, 0x2F, 0x92, 0x8E, 
+0x3A, 0xAB, 0xC2, 0x51, 0x98, 0x00, 0x17, 0x55, 
+0x55, 0x18, 0xFF, 0x5B, 0x4E, 0x03, 0x7A, 0xB5, 
+0xC6, 0xE4, 0x04, 0x37, 0x0A, 0xFB, 0xAB, 0x76, 
+0x65, 0xAB, 0xA4, 0xDE, 0xFD, 0xC8, 0xEE, 0x48, 
+0x72, 0xB3, 0x27, 0x19, 0xE5, 0x78, 0xF3, 0x09, 
+0xB6, 0xD9, 0x23, 0x3B, 0x3C, 0xA4, 0x64, 0x72, 
+0x1F, 0xF6, 0x66, 0x39, 0x23, 0xA3, 0x45, 0xB9, 
+0x95, 0xFC, 0x97, 0x50, 0x60, 0x8E, 0x19, 0x80, 
+0x25, 0xFD, 0xC8, 0x77, 0x7E, 0x7C, 0xD8, 0xFA, 
+0x3B, 0xA9, 0xC1, 0x8F, 0x7D, 0x9A, 0x7E, 0x9F, 
+0x46, 0x21, 0xB2, 0x59, 0x1C, 0x39, 0xFC, 0x1E, 
+0x15, 0xB7, 0x25, 0x74, 0xBC, 0x41, 0x7A, 0x23, 
+0xE8, 0xD0, 0x49, 0x86, 0x2C, 0x63, 0xA2, 0x9F, 
+0xBB, 0x0F, 0x0C, 0x5A, 0xA3, 0xD5, 0x44, 0xEA, 
+0x00, 0x07, 0x25, 0x9F, 0xC8, 0xF3, 0x89, 0x2D, 
+0x94, 0x74, 0xBC, 0x7A, 0xF3, 0xF2, 0x78, 0xB3, 
+0xC3, 0xC4, 0x3A, 0x62, 0x74, 
+
+0x1E, 0xB0, 0x44, 0x40, 0xE0, 0xAB, 0xA0, 0xCD, 
+0x00, 0xF4, 0xCE, 0x93, 0x44, 0x9D, 0x7F, 0x6D, 
+0xB5, 0x05, 0x09, 0x9B, 0x0F, 0xE1, 0xD0, 0xED, 
+0xB7, 0x52, 0xDC, 0xCA, 0x39, 0xA5, 0x9B, 0xCC, 
+0xEB, 0xB5, 0xA8, 0xAB, 0x2C, 0xFA, 0xE9, 0x6D, 
+0xEA, 0x57, 0xB9, 0x8E, 0x2B, 0x21, 0x7B, 0x09, 
+0x1E, 0x30, 0x7E, 0x4B, 0x23, 0x6C, 0x55, 0x13, 
+0x5B, 0x4A, 0x19, 0x9A, 0x8A, 0x6E, 0x64, 0x02, 
+0x0F, 0xF6, 0x09, 0x31, 0xB2, 0xC8, 0xDF, 0x54, 
+0x03, 0xC2, 0x39, 0xB7, 0x9D, 0x59, 0x52, 0x01, 
+0x87, 0x95, 0xF0, 0x9C, 0x35, 0xF3, 0xE4, 0xBD, 
+0x4B, 0xE7, 0x4C, 0x40, 0x10, 0xB6, 0x41, 0x16, 
+0x86, 0x07, 0x97, 0xCE, 0xE9, 0xB1, 0xB3, 0xBA, 
+0xF1, 0xCC, 0x46, 0xF3, 0x7F, 0x30, 0xB2, 0x14, 
+0x56, 0xFA, 0x39, 0x67, 0xF1, 0xFA, 0x93, 0xDB, 
+0x7A, 0x99, 0x54, 0x8D, 0xF6, 0x32, 0xF2, 0x6D, 
+0x96, 0xB2, 0x3D, 0x89, 0x14, 0xA7, 0x94, 0xF8, 
+0x1A, 0xE7, 0x84, 0x5F, 0x7B, 0xC4, 0xA2, 0x41, 
+0x0C, 0x85, 0x79, 0xAC, 0x8E, 0xB0, 0x1B, 0xDD, 
+0x9E, 0xB3, 0xBC, 0x62, 0xB5, 0xAC, 0x6A, 0xBC, 
+0x6C, 0x96, 0x1E, 0x87, 0x45, 0x46, 0x0F, 0x8D, 
+0xE7, 0x50, 0x95, 0x18, 0xC0, 0xC3, 0x47, 0xAC, 
+0x5E, 0xF1, 0x53, 0xE9, 0xCE, 0xCB, 0x3D, 0xFB, 
+0x98, 0x7D, 0x1B, 0x2B, 0x3B, 0x82, 0xE6, 0xFC, 
+0x36, 0x00, 0x62, 0x16, 0x21, 0x1E, 0xCB, 0xBC, 
+0xDE, 0x8C, 0x2D, 0x51, 0xFD, 0x80, 
+
+0x21, 0xE1, 0xB7, 0xF0, 0x85, 0x3D, 0xEF, 0xD6, 
+0x15, 0xD5, 0xC8, 0x47, 0x49, 0x51, 0xB0, 0x18, 
+0xBB, 0xDC, 0x26, 0x46, 0x5A, 0xFC, 0x7A, 0x10, 
+0x19, 0xD9, 0x95, 0xDF, 0xB6, 0x73, 0x28, 0x2A, 
+0xAB, 0xF9, 0x47, 0x98, 0xA0, 0xAF, 0x4F, 0x47, 
+0x32, 0x44, 0x2C, 0x6A, 0x23, 0xE7, 0xD1, 0x61, 
+0xDB, 0xBD, 0x59, 0xEA, 0x74, 0xA6, 0x76, 0x7C, 
+0x4A, 0xAD, 0x8F, 0x9E, 0x78, 0xC1, 0x13, 0x9F, 
+0x94, 0xC7, 0x2A, 0x22, 0xC5, 0x53, 0x83, 0x47, 
+0x39, 0xD0, 0xED, 0x48, 0xF8, 0xF7, 0x81, 0xC4, 
+0x67, 0x7B, 0xBA, 0x6E, 0x1A, 0xA3, 0xD8, 0x61, 
+0x33, 0x3F, 0xB1, 0x55, 0xC9, 0x05, 0x4E, 0xFB, 
+0x17, 0xA3, 0x04, 0x14, 0x25, 0x7F, 0x2D, 0x01, 
+0x91, 0xE7, 0xF3, 0xE9, 0xB0, 0x3F, 0x7E, 0x82, 
+0x61, 0xF7, 0x3F, 0x94, 0xA6, 0x74, 0x1C, 0xC1, 
+0x6B, 0xA2, 0xE7, 0xEF, 0xFB, 0x22, 0x47, 0x34, 
+0x91, 0x1A, 0x33, 0x8D, 0x52, 0x87, 0x8D, 0x12, 
+0xD8, 0xFB, 0x72, 0xF2, 0x15, 0x31, 0xD3, 0x51, 
+0x58, 0xD3, 0xDE, 0xD5, 0x09, 0x56, 0x26, 0xC8, 
+0x8A, 0xBA, 0xEE, 0x5B, 0xBB, 0xF5, 0xD3, 0x2D, 
+0xE5, 0xA4, 0x9E, 0x08, 0x63, 0x71, 0x55, 0x90, 
+0x7B, 0xE8, 0x70, 0x25, 0x14, 0xDB, 0x06, 0x50, 
+0x79, 0x5D, 0x9C, 0xF2, 0xB5, 0x48, 0x3A, 0xE9, 
+0x87, 0xB7, 0x45, 0x0E, 0xA0, 0x55, 0x38, 0x1E, 
+0x66, 0x91, 0xC3, 0x72, 0xED, 0x51, 0x1F, 0xA5, 
+0x1E, 0x4A, 0x8C, 0xAC, 0x3F, 0xF1, 0xA1, 
+
+0x9B, 0x7E, 0xD8, 0xA0, 0x97, 0x19, 0x45, 0x0C, 
+0x7E, 0xA2, 0x0F, 0x5D, 0xC1, 0x12, 0x34, 0xCB, 
+0x5F, 0xC6, 0xEB, 0x81, 0x95, 0x2A, 0xDD, 0x7A, 
+0x65, 0x02, 0x99, 0xD8, 0x74, 0xA7, 0xFA, 0x17, 
+0x2D, 0x0E, 0xA5, 0x79, 0x28, 0x6A, 0x0B, 0x02, 
+0x03, 0x0B, 0xDC, 0x60, 0x6C, 0x15, 0xB8, 0x5B, 
+0x8A, 0x40, 0x59, 0xB6, 0x4A, 0x89, 0x38, 0x12, 
+0xF0, 0x9D, 0xCE, 0x4E, 0x8A, 0xC8, 0x92, 0xAF, 
+0xAD, 0x2C, 0x16, 0xF9, 0x61, 0x88, 0xF6, 0x04, 
+0x71, 0xE3, 0xB5, 0x77, 0xB3, 0xBC, 0xC9, 0x28, 
+0x53, 0x20, 0x41, 0x71, 0x26, 0x6A, 0xC1, 0xBB, 
+0x43, 0x12, 0x0C, 0x58, 0x27, 0xB2, 0x13, 0xE4, 
+0x0D, 0x79, 0x36, 0x0E, 0x24, 0x81, 0xD3, 0x49, 
+0x07, 0x99, 0xC0, 0xE4, 0x77, 0xF2, 0x4C, 0x56, 
+0xF9, 0xF0, 0x08, 0x1A, 0x99, 0x32, 0x99, 0xDF, 
+0x28, 0x18, 0x35, 0x54, 0x1C, 0x53, 0xF7, 0x21, 
+0xC8, 0xA9, 0xD2, 0x92, 0x40, 0x7D, 0x62, 0x5F, 
+0x40, 0xA0, 0x4C, 0x65, 0xD8, 0x42, 0xEA, 0xB5, 
+0x7E, 0x79, 0x3D, 0x8F, 0x20, 0x90, 0x22, 0x58, 
+0x4E, 0xAC, 0x9D, 0xB6, 0x92, 0x16, 0x87, 0xCA, 
+0xCC, 0x88, 0x59, 0xB1, 0xD1, 0xD9, 0xBE, 0xBE, 
+0xD2, 0x62, 0xE6, 0xCD, 0x71, 0xA3, 0x27, 0x8A, 
+0x86, 0x95, 0x72, 0x54, 0xCE, 0xDF, 0x4D, 0x55, 
+0x70, 0xEF, 0x04, 0xD4, 0xC3, 0xCC, 0xE5, 0x8F, 
+0xB7, 0xAC, 0xB0, 0x72, 0x65, 0x53, 0x91, 0x68, 
+0x36, 0xDC, 0x4F, 0x71, 0x49, 0xBC, 0xDA, 0xF4, 
+
+0xB0, 0x94, 0x41, 0x72, 0x47, 0x64, 0x0D, 0xCC, 
+0x37, 0x15, 0xC2, 0x20, 0xB7, 0x19, 0xC8, 0x16, 
+0x9C, 0x00, 0x08, 0xA5, 0xB1, 0xCB, 0x92, 0xF8, 
+0x22, 0xFC, 0x61, 0x74, 0xF4, 0xE3, 0x36, 0x4C, 
+0x44, 0xB3, 0x59, 0x30, 0xD5, 0xB2, 0xFF, 0x4B, 
+0xCE, 0x7B, 0x1C, 0xF7, 0xE2, 0x76, 0xCD, 0x7A, 
+0x16, 0x4D, 0x5C, 0xA3, 0x2B, 0xF0, 0x9D, 0x3A, 
+0xC4, 0x6A, 0xC8, 0xED, 0x30, 0xA4, 0x84, 0xDF, 
+0x0D, 0x96, 0xFD, 0x77, 0x0F, 0xCA, 0xF3, 0x58, 
+0xE0, 0x37, 0x93, 0x46, 0xF7, 0x7F, 0xB2, 0x49, 
+0x82, 0xFA, 0x28, 0x6C, 0x32, 0x76, 0x73, 0xAF, 
+0x8E, 0x17, 0x28, 0x2A, 0x76, 0x35, 0xF3, 0xD0, 
+0x24, 0xEB, 0x7B, 0x4A, 0x41, 0xA6, 0x7C, 0xFB, 
+0x1B, 0x2D, 0x1F, 0xAB, 0x09, 0x0D, 0x32, 0x6B, 
+0x75, 0x49, 0x87, 0xBA, 0x19, 0xC8, 0xAC, 0xFA, 
+0xCB, 0x76, 0x14, 0x18, 0x6F, 0xD7, 0x72, 0x9F, 
+0x4F, 0x2D, 0x83, 0x4C, 0x66, 0xAA, 0xC4, 0x40, 
+0x23, 0x18, 0x10, 0x23, 0xCB, 0x71, 0x6D, 0xD7, 
+0x85, 0x1A, 0xFA, 0x1A, 0x5F, 0x7A, 0x7B, 0xCD, 
+0xF1, 0xA1, 0x06, 0x1C, 0xC3, 0x4C, 0x27, 0xCB, 
+0x79, 0x0F, 0x8B, 0x87, 0x85, 0x5D, 0x59, 0xA2, 
+0x4A, 0x85, 0x77, 0x79, 0x09, 0x4A, 0xAA, 0x79, 
+0xB0, 0x0B, 0xB1, 0xA6, 0x12, 0x14, 0xC8, 0xCB, 
+0x36, 0x82, 0x59, 0x82, 0xBB, 0xFB, 0x08, 0xCE, 
+0x68, 0xF3, 0x84, 0x34, 0x66, 0x9A, 0xEB, 0x97, 
+0x72, 0xFC, 0xDF, 0xCB, 0x1C, 0x8F, 0x63, 0x84, 
+0x15, 
+
+0xC6, 0x4E, 0x8E, 0x64, 0xBD, 0x00, 0xEB, 0x62, 
+0x4B, 0x2C, 0x55, 0x4B, 0xC1, 0xE8, 0xA9, 0x49, 
+0xF2, 0x4E, 0x0C, 0x9A, 0x71, 0x1E, 0xA4, 0xC6, 
+0xF4, 0xB4, 0xF5, 0x96, 0x94, 0x9C, 0x96, 0x67, 
+0x9A, 0x18, 0x32, 0xED, 0x10, 0x96, 0xDA, 0xAD, 
+0xAA, 0x14, 0x0A, 0x98, 0x90, 0x70, 0x92, 0x9B, 
+0x2F, 0x12, 0xFF, 0x85, 0x93, 0xA2, 0x92, 0x3E, 
+0xC0, 0x7C, 0x48, 0x3D, 0x1A, 0x6E, 0xCD, 0x90, 
+0x57, 0xE4, 0x9E, 0xC4, 0x06, 0xC5, 0x2F, 0xAD, 
+0x75, 0x51, 0xE9, 0x53, 0x62, 0x49, 0x1A, 0x0C, 
+0xA1, 0x8B, 0x23, 0x81, 0xBE, 0xCE, 0x31, 0xC6, 
+0x90, 0xA1, 0x39, 0x0B, 0x53, 0xE8, 0xA3, 0x5E, 
+0x09, 0xFE, 0xD3, 0x68, 0xA2, 0x10, 0x3E, 0xC2, 
+0x24, 0x02, 0x16, 0xC3, 0x11, 0x86, 0xDB, 0x47, 
+0xF2, 0xF1, 0x12, 0x25, 0x07, 0xD9, 0x90, 0xE4, 
+0x09, 0xB5, 0xC4, 0xC0, 0xAA, 0x6C, 0xFE, 0x39, 
+0xF1, 0xB4, 0xD3, 0xB5, 0x29, 0x64, 0xF8, 0x48, 
+0xE5, 0xA0, 0x6C, 0x81, 0x95, 0xD0, 0x0B, 0x29, 
+0x9F, 0xE1, 0x89, 0xA4, 0xAD, 0xAB, 0xA9, 0x79, 
+0x90, 0x0B, 0x5B, 0x28, 0xD2, 0x67, 0x10, 0xCC, 
+0xF4, 0xFD, 0x70, 0x96, 0x63, 0xD5, 0xD1, 0xE0, 
+0xD2, 0xE1, 0x3F, 0xAA, 0x0C, 0x9C, 0x21, 0x8E, 
+0x01, 0x1E, 0x34, 0x2E, 0xD2, 0xF5, 0x7C, 0xAF, 
+0xD2, 0xF4, 0x25, 0x14, 0x9B, 0x82, 0x97, 0xCF, 
+0x0F, 0x13, 0xF2, 0xB3, 0x99, 0xEA, 0x46, 0x6C, 
+0x1A, 0x02, 0xE9, 0x00, 0xF0, 0x7A, 0x88, 0x78, 
+0x2A, 0x6B, 
+
+0x2C, 0xA4, 0x18, 0x0B, 0x5B, 0x2A, 0x33, 0x1E, 
+0x9B, 0x2F, 0xC3, 0x0F, 0x1C, 0x30, 0x9F, 0xA0, 
+0xCD, 0x59, 0xAE, 0xCD, 0x09, 0xA3, 0x8E, 0xB1, 
+0x8C, 0x0E, 0x53, 0x66, 0xF6, 0xAE, 0x0B, 0x3D, 
+0x74, 0xC5, 0x39, 0xE6, 0x35, 0xC4, 0x56, 0x18, 
+0x16, 0xC8, 0x80, 0x23, 0x9C, 0x7B, 0xB2, 0x5B, 
+0xB9, 0x80, 0xD4, 0x3E, 0x66, 0x4E, 0x31, 0x6D, 
+0x04, 0x32, 0xCC, 0xB5, 0xDA, 0x77, 0xA6, 0x39, 
+0x02, 0x11, 0x57, 0x33, 0x49, 0x1A, 0x08, 0x98, 
+0x92, 0x0D, 0x1B, 0x9B, 0xD4, 0x84, 0x0D, 0xEF, 
+0xE5, 0xB5, 0x21, 0x65, 0x14, 0x1A, 0x49, 0x09, 
+0xE8, 0x15, 0x8D, 0x82, 0x0D, 0xDD, 0xCF, 0xA7, 
+0x2D, 0xC6, 0x10, 0x78, 0x52, 0x85, 0xBA, 0x62, 
+0x93, 0x12, 0xA2, 0xE2, 0xEA, 0x66, 0x67, 0xFA, 
+0x10, 0xBC, 0x61, 0x28, 0xAF, 0xAF, 0xC2, 0xA3, 
+0x14, 0x60, 0x00, 0xA4, 0xB4, 0x74, 0x93, 0xF4, 
+0xC1, 0x93, 0x78, 0xA8, 0xE4, 0x79, 0x7B, 0x21, 
+0xB1, 0x0B, 0x9E, 0x31, 0x3A, 0x3D, 0x3B, 0x10, 
+0xD7, 0x6D, 0xA6, 0x86, 0x31, 0xB9, 0x6D, 0x38, 
+0xC9, 0xCC, 0x8A, 0x7C, 0xF4, 0xFB, 0xB7, 0xC0, 
+0x55, 0xEF, 0x34, 0x93, 0x43, 0x82, 0xDD, 0x98, 
+0xA3, 0xF3, 0x13, 0x5A, 0x0B, 0x05, 0xED, 0xAF, 
+0x36, 0x6D, 0x8F, 0x3E, 0x4A, 0xAB, 0x0E, 0x60, 
+0x9D, 0xA1, 0x17, 0x1B, 0x22, 0xE9, 0x8A, 0xD2, 
+0x49, 0xFE, 0x07, 0xF2, 0xAF, 0x2B, 0x55, 0xA1, 
+0x15, 0xE0, 0x4A, 0x32, 0xD6, 0x66, 0xF4, 0x28, 
+0xAD, 0xCC, 0x83, 
+
+0x7B, 0x37, 0xB3, 0x30, 0xB3, 0xE4, 0xE8, 0xA3, 
+0xFF, 0xD7, 0xFC, 0x84, 0xE2, 0x07, 0x7C, 0xF5, 
+0x33, 0x53, 0x58, 0x03, 0xEC, 0xC4, 0xFC, 0x79, 
+0x1A, 0x29, 0x40, 0x21, 0x8A, 0x25, 0x23, 0xB8, 
+0xB5, 0x2E, 0xF4, 0x2A, 0x88, 0x97, 0xC0, 0x37, 
+0xA5, 0x15, 0xA1, 0xD4, 0x27, 0x93, 0xBA, 0xCD, 
+0x32, 0x04, 0xFC, 0x14, 0x66, 0x6C, 0x7C, 0xA8, 
+0xF1, 0xA3, 0xDF, 0x34, 0xCD, 0xAD, 0x2E, 0x50, 
+0x83, 0x36, 0x92, 0x88, 0x29, 0x8F, 0x24, 0x78, 
+0xE2, 0x8B, 0xB8, 0x9E, 0x9B, 0x40, 0x76, 0x53, 
+0x68, 0xFD, 0x49, 0x56, 0xF9, 0xB5, 0xE1, 0xCF, 
+0x63, 0x60, 0xC9, 0xB7, 0x03, 0x28, 0xCB, 0xB2, 
+0x2E, 0xC3, 0x64, 0xB1, 0x60, 0x80, 0x84, 0xDC, 
+0xB1, 0xB8, 0x94, 0x61, 0xCD, 0x88, 0x33, 0xA6, 
+0x43, 0x9B, 0x2F, 0xC3, 0x4E, 0x0B, 0xD9, 0xBB, 
+0xDF, 0xDE, 0x1B, 0x96, 0xA6, 0x2E, 0xC2, 0x3A, 
+0xC2, 0xA2, 0x53, 0x9B, 0x96, 0x79, 0x69, 0x81, 
+0xE9, 0x8F, 0x92, 0x49, 0xF9, 0x13, 0xEB, 0xCD, 
+0xF6, 0xC3, 0x59, 0x56, 0xFA, 0xE1, 0xD2, 0x6C, 
+0x65, 0xD6, 0x4E, 0x11, 0x05, 0x9A, 0x50, 0x25, 
+0x2A, 0x09, 0xE0, 0xF5, 0x46, 0xFC, 0xF8, 0xF6, 
+0xEF, 0x05, 0x49, 0x33, 0xB1, 0xF1, 0x59, 0xB3, 
+0x14, 0x1C, 0x68, 0xB8, 0xFB, 0xD8, 0xB6, 0x80, 
+0xE7, 0x37, 0xAE, 0xDC, 0x88, 0xA5, 0x64, 0x47, 
+0x78, 0xCA, 0x6B, 0x90, 0x9F, 0x21, 0x62, 0xFB, 
+0x52, 0x51, 0x0A, 0xD9, 0x9A, 0x8A, 0x22, 0xC1, 
+0x35, 0x05, 0xEA, 0xEE, 
+
+0x2C, 0xAE, 0xD2, 0x4D, 0x80, 0x2A, 0xB9, 0x43, 
+0x2F, 0x13, 0xE4, 0x1F, 0x2E, 0xEE, 0x92, 0xEC, 
+0x33, 0xE4, 0x30, 0x7A, 0xA9, 0x3D, 0x3D, 0xB9, 
+0x39, 0xEA, 0x4D, 0x4B, 0xDD, 0x1C, 0x23, 0xAF, 
+0xFE, 0x26, 0xFC, 0x20, 0x8F, 0x4C, 0x3B, 0x1B, 
+0x8B, 0xFD, 0x19, 0xB5, 0x81, 0x2D, 0x73, 0x93, 
+0x0D, 0xF8, 0xDE, 0x0C, 0x9F, 0x88, 0x34, 0x0A, 
+0xE4, 0x34, 0xCB, 0x48, 0xF2, 0xD4, 0x65, 0x0A, 
+0x8C, 0x92, 0x5F, 0x3B, 0xC8, 0x57, 0x74, 0x30, 
+0xA8, 0x23, 0x62, 0x7B, 0xE8, 0xF4, 0xBB, 0x30, 
+0xF1, 0x13, 0xD8, 0xFB, 0x2F, 0xFD, 0x1D, 0x90, 
+0x6C, 0x7F, 0xD8, 0x31, 0x44, 0xC7, 0xE6, 0xF2, 
+0xDC, 0x4F, 0x3B, 0x6D, 0xA3, 0x33, 0x1E, 0x65, 
+0xE7, 0x9D, 0x5F, 0x14, 0x6E, 0x92, 0x3E, 0xF5, 
+0x82, 0xCF, 0xCE, 0xD4, 0xD2, 0xAF, 0x8D, 0xEB, 
+0xF5, 0x94, 0xC2, 0xFF, 0x05, 0xBB, 0x84, 0x0C, 
+0x95, 0x02, 0x54, 0x10, 0x12, 0x96, 0x62, 0x17, 
+0x70, 0xD7, 0xA8, 0xFE, 0xB2, 0x7C, 0x42, 0x65, 
+0x18, 0x0F, 0x6A, 0x79, 0xF9, 0xE5, 0x3D, 0xBE, 
+0xA4, 0x03, 0x82, 0x69, 0x9E, 0x21, 0x17, 0x12, 
+0xC3, 0x0E, 0x53, 0x97, 0x2D, 0x23, 0xD8, 0x82, 
+0xD2, 0x16, 0xDF, 0x1F, 0x1B, 0x1D, 0x1B, 0x6C, 
+0x7B, 0xF1, 0xFC, 0xE0, 0x56, 0xC4, 0xD8, 0xD1, 
+0x74, 0xCF, 0xD0, 0xA1, 0x1A, 0x36, 0xBB, 0xFA, 
+0xDD, 0x13, 0x7A, 0xBB, 0x54, 0x61, 0x7D, 0x6A, 
+0x75, 0x97, 0x67, 0x30, 0xE6, 0x3E, 0x03, 0x4E, 
+0xAB, 0x60, 0x9C, 0xE2, 0x31, 
+
+0xDE, 0x6D, 0xDB, 0x02, 0xA3, 0x58, 0xE9, 0x0C, 
+0x59, 0x78, 0xF1, 0x6E, 0x94, 0x40, 0xAC, 0x24, 
+0xB3, 0x94, 0x21, 0x7F, 0x73, 0x81, 0x57, 0xC2, 
+0x10, 0x1F, 0xC4, 0xBC, 0x7E, 0x9D, 0x33, 0xB1, 
+0x82, 0x9A, 0x77, 0x6E, 0xAF, 0x59, 0xCF, 0x74, 
+0x4D, 0x7A, 0x5C, 0xB4, 0x19, 0x7A, 0x8E, 0x61, 
+0xDD, 0xD2, 0x2E, 0x3C, 0x0C, 0xCF, 0xEB, 0x49, 
+0x80, 0x45, 0xA8, 0x54, 0xCB, 0xBB, 0x0A, 0x92, 
+0x2E, 0xBE, 0x3B, 0xDD, 0x09, 0x41, 0x4D, 0x24, 
+0xF6, 0x96, 0x21, 0x1D, 0xF1, 0x19, 0x60, 0x67, 
+0x8A, 0xED, 0xB8, 0xBA, 0x6A, 0xC6, 0x8B, 0xF7, 
+0x6B, 0xD8, 0x94, 0x06, 0x84, 0xDB, 0xF7, 0xBF, 
+0xA4, 0x00, 0x6D, 0x2B, 0x23, 0xC0, 0xBD, 0x7D, 
+0x08, 0xAB, 0xF8, 0x8D, 0x86, 0x05, 0x2E, 0xAC, 
+0xA7, 0x45, 0xCA, 0x48, 0xB1, 0xFA, 0x0B, 0x36, 
+0xDC, 0x86, 0xC9, 0xE2, 0xCA, 0x54, 0xE7, 0xE1, 
+0xB0, 0xD1, 0x56, 0x30, 0xF4, 0xE0, 0xAD, 0x88, 
+0xB7, 0xEC, 0x59, 0x68, 0xD7, 0x6E, 0x7D, 0x5B, 
+0x6D, 0xA9, 0xAC, 0x07, 0xD6, 0x90, 0x72, 0x24, 
+0xFB, 0x1D, 0xC2, 0x91, 0x1F, 0x8C, 0xD7, 0xF9, 
+0xB5, 0xD2, 0x1F, 0x2A, 0x39, 0x7A, 0xC4, 0x98, 
+0x9D, 0x37, 0x8A, 0xF1, 0x9A, 0x5C, 0xD1, 0x4C, 
+0x05, 0x23, 0xA2, 0xFC, 0x74, 0x90, 0xE3, 0x0F, 
+0x9D, 0xDF, 0x51, 0x5E, 0xE8, 0x0A, 0xF4, 0x4F, 
+0xEA, 0x1B, 0x5A, 0x1E, 0x6E, 0xEB, 0xAC, 0x6B, 
+0x42, 0x2A, 0x41, 0xB1, 0x7F, 0xB1, 0x51, 0x0D, 
+0x40, 0xAA, 0xAE, 0x30, 0x8F, 0xFA, 
+
+0x5F, 0x9F, 0xCD, 0x1F, 0x53, 0xA0, 0xC3, 0x6F, 
+0x20, 0xC9, 0xFC, 0x08, 0xF4, 0xE5, 0x01, 0x7D, 
+0x67, 0xA1, 0x72, 0x4A, 0xDA, 0x09, 0x55, 0xCC, 
+0xB1, 0x54, 0x31, 0x71, 0x82, 0x3E, 0x4C, 0xE5, 
+0x20, 0xEE, 0x63, 0x1B, 0x5E, 0x5F, 0xC3, 0x39, 
+0x84, 0xD4, 0xDC, 0x86, 0xFE, 0xD5, 0xB0, 0x51, 
+0x58, 0x5A, 0x5B, 0x8A, 0x62, 0x89, 0x7B, 0x21, 
+0xF7, 0x13, 0x82, 0x86, 0x85, 0x5E, 0xF9, 0x41, 
+0x6E, 0xD2, 0x31, 0x11, 0xF7, 0x71, 0x95, 0x1B, 
+0x76, 0xA2, 0x92, 0x08, 0x29, 0x19, 0x05, 0x7B, 
+0x38, 0x04, 0xB4, 0x99, 0x86, 0xF3, 0xF0, 0xB2, 
+0x59, 0x98, 0x1D, 0xB2, 0xE0, 0x87, 0x42, 0xF4, 
+0xEA, 0x09, 0xEA, 0xAE, 0xBB, 0x5C, 0xFA, 0xA3, 
+0x82, 0x4E, 0x33, 0x1D, 0xBC, 0x11, 0x42, 0x77, 
+0x88, 0x9F, 0x03, 0x05, 0xF0, 0x50, 0x3A, 0xCD, 
+0x27, 0x28, 0x29, 0xAA, 0x8C, 0x98, 0x78, 0xEA, 
+0x33, 0xA4, 0xA9, 0x85, 0x3F, 0x95, 0x70, 0xFA, 
+0x5F, 0x47, 0x67, 0x06, 0x2B, 0x2D, 0x7F, 0xE3, 
+0x9F, 0xC6, 0xFD, 0x24, 0xC1, 0x01, 0xF0, 0x1D, 
+0x2B, 0x0F, 0x34, 0x20, 0xB5, 0xA9, 0x03, 0x22, 
+0xA1, 0x54, 0x29, 0xF8, 0x01, 0xC9, 0x36, 0x54, 
+0x10, 0x32, 0x7F, 0x14, 0xB8, 0x3B, 0xC8, 0x44, 
+0x28, 0x09, 0x56, 0xD2, 0x43, 0xDB, 0x7F, 0x3D, 
+0x4B, 0x2E, 0x6A, 0x7A, 0x2E, 0x6E, 0xF0, 0x49, 
+0x9B, 0x82, 0xC2, 0x35, 0xD4, 0xE5, 0x4A, 0xE2, 
+0xE4, 0x32, 0x06, 0xEF, 0xD3, 0x91, 0x1C, 0xB4, 
+0xFD, 0x77, 0x9B, 0x2A, 0x55, 0x8F, 0xCB, 
+
+0xCF, 0xFC, 0x73, 0xFB, 0xCD, 0x95, 0xAD, 0xB4, 
+0x83, 0x25, 0x6D, 0xAC, 0xB4, 0x0E, 0x23, 0xB8, 
+0x7A, 0x70, 0xA6, 0x40, 0x20, 0x28, 0xFE, 0xE4, 
+0x39, 0xCE, 0x83, 0x85, 0xC4, 0xAD, 0x0D, 0x4B, 
+0x29, 0x07, 0x5B, 0xC6, 0xF9, 0xA8, 0xF8, 0xF3, 
+0xA5, 0xD1, 0x86, 0x48, 0x38, 0xE8, 0x15, 0xE1, 
+0x97, 0x08, 0x88, 0x6D, 0x80, 0x0F, 0x6C, 0xEF, 
+0xA9, 0xAD, 0xCA, 0x47, 0x2F, 0x53, 0x9E, 0x6C, 
+0x88, 0x49, 0xD8, 0x6B, 0x5B, 0x10, 0xD2, 0xB8, 
+0x2C, 0x9C, 0xFB, 0xB5, 0x46, 0xF6, 0xFB, 0xEF, 
+0x92, 0x2B, 0x14, 0x09, 0xDA, 0x59, 0x52, 0x6A, 
+0x9F, 0xFF, 0x85, 0xEF, 0xC0, 0x52, 0xA3, 0x6F, 
+0xD1, 0x2C, 0xB7, 0x9C, 0xD7, 0xB8, 0x29, 0x77, 
+0xDB, 0x53, 0x15, 0x0D, 0x6A, 0x37, 0x37, 0xEF, 
+0xC5, 0xFB, 0x25, 0xD7, 0xAD, 0x37, 0xF4, 0xBC, 
+0xB9, 0x1C, 0x9C, 0xAF, 0x3B, 0x6B, 0xDE, 0x92, 
+0x42, 0x09, 0x7C, 0x84, 0x2C, 0x84, 0x34, 0x49, 
+0xE4, 0x7F, 0xC2, 0xEF, 0xFD, 0x3F, 0x9B, 0xA2, 
+0x0C, 0x8F, 0xC3, 0x81, 0xAB, 0xEA, 0x32, 0x12, 
+0xF6, 0xB9, 0xAA, 0x54, 0x31, 0x5B, 0xCE, 0xE6, 
+0xFE, 0x35, 0x7D, 0xB1, 0x6A, 0xF3, 0xF6, 0x65, 
+0xCD, 0x2B, 0xCA, 0xEF, 0xDA, 0xE5, 0x7D, 0x43, 
+0x52, 0xE5, 0x9F, 0x53, 0x14, 0xDD, 0x71, 0x32, 
+0xD8, 0x6F, 0x7D, 0x0C, 0x2D, 0xCF, 0x1E, 0xAA, 
+0xE5, 0x70, 0xB6, 0x8B, 0xE4, 0xBD, 0xF5, 0x83, 
+0x75, 0x58, 0x9E, 0xE1, 0xC9, 0x8B, 0x59, 0x2E, 
+0x09, 0x15, 0xC0, 0x78, 0xF2, 0x45, 0xA4, 0x6C, 
+
+0x9B, 0xCC, 0xE0, 0xDC, 0x9F, 0x66, 0xBD, 0x74, 
+0x8C, 0x69, 0xD8, 0xB9, 0xDB, 0x62, 0x2C, 0x88, 
+0x5B, 0x4C, 0xDF, 0xAE, 0xAA, 0xEF, 0xF3, 0x8A, 
+0x03, 0x6E, 0xEF, 0x68, 0xF1, 0xC1, 0x27, 0xC7, 
+0x63, 0xFE, 0x97, 0x97, 0x15, 0x63, 0x39, 0xE6, 
+0x6B, 0x6E, 0x4A, 0xD8, 0x7D, 0xAC, 0x54, 0xD6, 
+0x0D, 0x3F, 0x2E, 0x50, 0x3F, 0x06, 0xD4, 0xF8, 
+0xA0, 0xB9, 0xF7, 0x5B, 0xE9, 0x50, 0x33, 0xAA, 
+0x5B, 0x1B, 0x11, 0x2A, 0x77, 0x6F, 0x1E, 0x59, 
+0x4A, 0xEB, 0x65, 0x8E, 0x13, 0x92, 0x80, 0x47, 
+0x0C, 0xB8, 0x71, 0x8B, 0x2D, 0x68, 0xC2, 0xBA, 
+0xBA, 0xD4, 0xA1, 0x5C, 0x0A, 0x52, 0xE7, 0x95, 
+0x04, 0x55, 0xD4, 0x26, 0x04, 0x0A, 0x3F, 0xF1, 
+0xD9, 0xE3, 0xEA, 0xFD, 0x76, 0x43, 0x64, 0x8A, 
+0x1B, 0x04, 0x8A, 0x47, 0x01, 0x0A, 0xB5, 0xB9, 
+0x9C, 0x17, 0x4A, 0xAE, 0xF8, 0x4C, 0xA8, 0x47, 
+0x6B, 0x9D, 0x82, 0x56, 0x55, 0x3D, 0x1D, 0xCC, 
+0xD6, 0xCB, 0xCE, 0x9E, 0xF7, 0x0E, 0x4A, 0xD3, 
+0x18, 0x32, 0xEB, 0x19, 0x4B, 0x32, 0xA5, 0xF7, 
+0xEC, 0x61, 0xD7, 0xA1, 0x29, 0x15, 0x93, 0x6C, 
+0x07, 0x9D, 0x62, 0x3C, 0x1E, 0xA0, 0xFC, 0x1D, 
+0xA5, 0xD2, 0xD6, 0x83, 0x7A, 0x33, 0x21, 0xD5, 
+0xEC, 0x7B, 0x1D, 0x02, 0x59, 0x17, 0x07, 0x7D, 
+0x64, 0x4C, 0xDA, 0x9A, 0x9A, 0x09, 0xE2, 0x44, 
+0x76, 0x7C, 0x08, 0xDF, 0xDA, 0xC7, 0xFF, 0xEA, 
+0xA6, 0xF3, 0x52, 0x83, 0xAA, 0xD5, 0x3A, 0x2C, 
+0x36, 0xD9, 0xF4, 0x44, 0x29, 0x54, 0x2C, 0x2D, 
+0x00, 
+
+0x55, 0x6A, 0xD2, 0x41, 0xFD, 0x13, 0x15, 0xCD, 
+0x4C, 0x6A, 0x14, 0x54, 0xE9, 0x4B, 0xC4, 0xD2, 
+0x4C, 0xF2, 0x96, 0x72, 0xE4, 0x5A, 0x2E, 0xE6, 
+0x52, 0xEB, 0x9A, 0xDB, 0xD4, 0x2C, 0xA4, 0x0C, 
+0x69, 0x3D, 0x45, 0xBB, 0xD3, 0xD2, 0xB3, 0x92, 
+0x54, 0x04, 0x2B, 0x23, 0x1A, 0x05, 0xF2, 0x16, 
+0x61, 0xFC, 0x4D, 0x4D, 0x4B, 0x4D, 0x19, 0x59, 
+0xA8, 0xA4, 0x2D, 0xD6, 0x13, 0x69, 0x41, 0xE9, 
+0xA3, 0x93, 0x68, 0x2E, 0xA1, 0xAF, 0x7D, 0x8B, 
+0xDB, 0xBA, 0xB7, 0x3D, 0x8D, 0x00, 0xFE, 0x73, 
+0x9E, 0x92, 0x5B, 0xF7, 0x43, 0x06, 0xB4, 0x66, 
+0x60, 0x8F, 0x5A, 0x4C, 0x1F, 0x6F, 0xE2, 0xD6, 
+0x76, 0x73, 0x76, 0xDA, 0xE4, 0xA3, 0x5D, 0x82, 
+0x84, 0x50, 0x64, 0xD4, 0xC3, 0xF4, 0xCA, 0xBE, 
+0x2E, 0xE4, 0xA5, 0xC5, 0x3A, 0x00, 0x00, 0xAF, 
+0x1A, 0xD7, 0xF2, 0x0B, 0xD1, 0x2D, 0x86, 0x63, 
+0x7D, 0x8B, 0x2C, 0xF5, 0xAE, 0xA7, 0x82, 0x5C, 
+0x73, 0xBE, 0x41, 0xB7, 0x7E, 0xCB, 0xBB, 0x65, 
+0xE0, 0x9E, 0x49, 0x0A, 0x53, 0xA5, 0x5A, 0x5D, 
+0x85, 0xDB, 0xB8, 0x87, 0x03, 0x4D, 0x97, 0xE5, 
+0x8C, 0xBA, 0x16, 0xAB, 0x6A, 0xE3, 0xD6, 0x02, 
+0xC5, 0xF3, 0x66, 0xEE, 0xC3, 0x37, 0x33, 0xB6, 
+0x51, 0x96, 0x53, 0x31, 0x51, 0x42, 0x12, 0x3D, 
+0xA8, 0x70, 0x63, 0x1F, 0x4A, 0x0C, 0xEA, 0x38, 
+0x5C, 0x58, 0x7B, 0x76, 0x68, 0x24, 0x4B, 0x7E, 
+0xB8, 0x16, 0xB2, 0x6C, 0xFF, 0xA0, 0x27, 0x58, 
+0x73, 0x93, 0xEA, 0xD8, 0xEF, 0x9D, 0x0D, 0x93, 
+0xCE, 0x72, 
+
+0xAF, 0x07, 0x71, 0xB3, 0x2A, 0x87, 0x2F, 0xF7, 
+0x6E, 0x4A, 0x64, 0x19, 0xEE, 0x8D, 0xD2, 0x67, 
+0x8E, 0x5A, 0x84, 0x33, 0x0A, 0x0A, 0x66, 0xFD, 
+0xF2, 0x24, 0xA3, 0x98, 0xC5, 0x8D, 0x17, 0xA3, 
+0x80, 0xEB, 0x83, 0xB8, 0xD4, 0xB4, 0xBE, 0xCF, 
+0x18, 0xE9, 0x0A, 0x0F, 0x12, 0xD6, 0x3A, 0x61, 
+0xEE, 0x50, 0x1C, 0x9C, 0x3C, 0x31, 0xAA, 0x7D, 
+0x71, 0x31, 0x0D, 0xA3, 0xC2, 0x73, 0x88, 0x07, 
+0xFB, 0xC3, 0x24, 0x42, 0x95, 0xDB, 0x44, 0x13, 
+0x0A, 0x86, 0xED, 0xB8, 0x95, 0xDE, 0xE0, 0xE7, 
+0x1D, 0x8D, 0x67, 0xBF, 0x93, 0x8A, 0x95, 0x39, 
+0x85, 0xBA, 0x74, 0x29, 0xB9, 0x51, 0x2B, 0xF5, 
+0xBF, 0x6A, 0xCD, 0xA7, 0x0D, 0x25, 0xB7, 0x9E, 
+0x95, 0xF8, 0xA1, 0xF8, 0xBA, 0x91, 0x82, 0xD9, 
+0xAD, 0xF8, 0x82, 0xF1, 0xE9, 0x97, 0xB9, 0x2B, 
+0x60, 0xE0, 0x4C, 0xB8, 0xA7, 0xEF, 0x7C, 0xCD, 
+0xFB, 0x69, 0xD7, 0x7C, 0x94, 0x87, 0x48, 0x9F, 
+0xEB, 0x1B, 0x4D, 0xD0, 0x5A, 0x78, 0x41, 0xE2, 
+0x29, 0x4D, 0xF9, 0x4C, 0x83, 0x60, 0x66, 0x83, 
+0x72, 0x5F, 0x88, 0xA8, 0x2A, 0x09, 0x6B, 0xFA, 
+0x6B, 0x53, 0x08, 0xEE, 0x71, 0x53, 0xF8, 0x0E, 
+0x16, 0x7A, 0xCB, 0x7B, 0x0E, 0x1A, 0x3F, 0xDD, 
+0xE7, 0x2F, 0x70, 0x8A, 0xA1, 0xD8, 0x6E, 0x36, 
+0xA8, 0xCC, 0xB6, 0x1B, 0x7A, 0x86, 0x8D, 0xD3, 
+0x1E, 0x4F, 0x3F, 0x17, 0x16, 0x6D, 0x18, 0xA5, 
+0x57, 0xB3, 0xB5, 0x84, 0x00, 0x17, 0xBF, 0x62, 
+0x30, 0x15, 0x79, 0x51, 0x64, 0x90, 0x39, 0xBB, 
+0x9C, 0xA5, 0xC1, 
+
+0xD7, 0x44, 0xC4, 0x85, 0x0B, 0xE5, 0xC2, 0xAA, 
+0xF5, 0x8E, 0x82, 0x10, 0xBF, 0xF3, 0x83, 0x99, 
+0x8B, 0x96, 0x16, 0x43, 0x9D, 0x1C, 0x41, 0xCF, 
+0xD8, 0x57, 0x52, 0x3C, 0xD0, 0x95, 0xF3, 0x98, 
+0x63, 0x43, 0x5D, 0xA9, 0x6E, 0xB2, 0xBC, 0x34, 
+0x9A, 0x96, 0x24, 0x63, 0x86, 0xB9, 0x00, 0x68, 
+0xAB, 0x7D, 0x32, 0x3A, 0xDB, 0xC8, 0x77, 0x3B, 
+0xC2, 0x76, 0xF5, 0xA7, 0xCB, 0x1C, 0x63, 0x3D, 
+0x5D, 0xFB, 0x93, 0x05, 0xA2, 0x40, 0xF7, 0x36, 
+0x77, 0x5B, 0x07, 0x4D, 0x37, 0xB6, 0x47, 0xD0, 
+0x31, 0xE1, 0xD0, 0x5C, 0xBA, 0xAB, 0xF6, 0x71, 
+0x90, 0x46, 0x57, 0x92, 0x3C, 0xBC, 0x3C, 0xAE, 
+0xDC, 0xAE, 0xA9, 0x13, 0xFD, 0x4F, 0x1D, 0xE8, 
+0xED, 0x58, 0x2E, 0x96, 0x3F, 0x88, 0xCD, 0x1B, 
+0x94, 0x2E, 0x4B, 0x0D, 0xA1, 0xFC, 0x7C, 0xE3, 
+0xB2, 0x37, 0x41, 0x1F, 0xFC, 0x27, 0x2F, 0xE2, 
+0xD5, 0x3E, 0xE6, 0x32, 0x66, 0x4E, 0x20, 0x3F, 
+0xB4, 0xDC, 0x62, 0x05, 0x6E, 0x1B, 0x01, 0x5E, 
+0x1A, 0x28, 0x93, 0x11, 0x3A, 0xDC, 0x56, 0x67, 
+0xB7, 0xD1, 0x34, 0x9E, 0xD0, 0x9D, 0x56, 0xEF, 
+0x84, 0x88, 0xFD, 0xC5, 0x3B, 0x04, 0x9A, 0x0E, 
+0x94, 0x56, 0x83, 0x69, 0x7E, 0x4A, 0x64, 0x19, 
+0xD3, 0x06, 0x06, 0x7B, 0x2F, 0x51, 0xEA, 0x87, 
+0x9D, 0x76, 0xCD, 0xD1, 0x66, 0x1E, 0x31, 0x63, 
+0xB2, 0xCE, 0xAB, 0xBA, 0x15, 0xC8, 0x7F, 0x75, 
+0xE7, 0x9C, 0x04, 0xC4, 0x9C, 0x6B, 0x5D, 0x7C, 
+0x0E, 0x21, 0x5D, 0x6C, 0x70, 0x28, 0xB0, 0x7A, 
+0x83, 0x60, 0xAA, 0x30, 
+
+0x7E, 0x02, 0xA1, 0x9F, 0x9C, 0x22, 0xF4, 0x4B, 
+0xDC, 0x55, 0x55, 0x20, 0xD3, 0xE0, 0xAD, 0x84, 
+0xFA, 0x14, 0x44, 0x69, 0x8D, 0x3E, 0xDA, 0x9C, 
+0xBA, 0x24, 0xF9, 0x4E, 0xA9, 0x15, 0x8A, 0x51, 
+0x10, 0xD6, 0x17, 0x33, 0xD5, 0x28, 0x05, 0x2A, 
+0x79, 0xBD, 0x44, 0x9A, 0xA1, 0xD9, 0x58, 0x0E, 
+0x4D, 0x06, 0x0B, 0x46, 0xAF, 0xAA, 0x39, 0x1A, 
+0xFA, 0x22, 0xE3, 0x63, 0x4D, 0xD5, 0x2E, 0x34, 
+0xD3, 0xD3, 0x17, 0x00, 0x2E, 0xF8, 0x27, 0xDE, 
+0x09, 0xBF, 0x6C, 0x3D, 0xC5, 0xFF, 0x3E, 0xE2, 
+0x12, 0x44, 0xDC, 0x81, 0x84, 0xDD, 0xBD, 0x34, 
+0xC0, 0x18, 0x5A, 0x6C, 0x82, 0xD5, 0xD2, 0xB2, 
+0xB3, 0x27, 0x90, 0xCA, 0xC8, 0xF4, 0x84, 0x01, 
+0x99, 0x94, 0xB5, 0xF2, 0x11, 0x01, 0x32, 0xE2, 
+0xC3, 0xC5, 0x20, 0x84, 0xC7, 0x46, 0x6B, 0x4A, 
+0x4D, 0x5F, 0xCE, 0xB6, 0xE2, 0x71, 0x5F, 0xC2, 
+0x19, 0x42, 0x08, 0x34, 0x6D, 0x0E, 0x0C, 0x19, 
+0xF2, 0x31, 0x25, 0x6F, 0x40, 0x4E, 0x60, 0x6B, 
+0xF8, 0xAB, 0x28, 0x97, 0xF8, 0x02, 0x79, 0xC3, 
+0x89, 0xBC, 0x4A, 0xDA, 0x1C, 0xD2, 0x70, 0x80, 
+0xA4, 0x65, 0xC7, 0xE7, 0x8C, 0xCF, 0xB4, 0xB9, 
+0xE4, 0xF1, 0x61, 0x87, 0x3B, 0x5D, 0x8B, 0xC6, 
+0xD2, 0x53, 0xB0, 0x0B, 0x38, 0x87, 0x11, 0x3C, 
+0x4E, 0x0C, 0x97, 0x06, 0xD2, 0x74, 0x13, 0xC4, 
+0xC4, 0x5A, 0xEB, 0x4E, 0x97, 0x59, 0x75, 0x3F, 
+0xF8, 0x17, 0x3E, 0x50, 0x35, 0xF7, 0x02, 0x9E, 
+0xD8, 0x83, 0xB1, 0x82, 0x01, 0x30, 0xA2, 0x64, 
+0xF5, 0xAE, 0xA4, 0x49, 0x09, 
+
+0x10, 0xC7, 0xCB, 0x84, 0x05, 0xBC, 0x71, 0x9B, 
+0x35, 0x0E, 0x0A, 0x4E, 0x52, 0x21, 0xBE, 0x93, 
+0x01, 0x1D, 0x94, 0x4D, 0x92, 0x24, 0xE6, 0x63, 
+0x4D, 0x7C, 0x65, 0xE9, 0x04, 0xD7, 0xBE, 0xC3, 
+0x04, 0x3A, 0xD9, 0x34, 0x80, 0x7D, 0x85, 0x93, 
+0x7F, 0xE6, 0xAF, 0xC6, 0x26, 0xA2, 0xF3, 0x54, 
+0x15, 0xE3, 0xDD, 0x3E, 0xE1, 0xB2, 0x8B, 0x3E, 
+0x91, 0x01, 0x2E, 0x1B, 0xBE, 0x64, 0xE9, 0x30, 
+0xDC, 0xE9, 0x99, 0x80, 0x09, 0x17, 0xBD, 0xF3, 
+0x21, 0xFF, 0x3F, 0x4A, 0xBF, 0x2E, 0x1A, 0x1B, 
+0x03, 0x09, 0x62, 0xA1, 0xAE, 0xE3, 0x6A, 0x42, 
+0xD2, 0xC3, 0xD0, 0x9D, 0x4B, 0x39, 0x59, 0x53, 
+0xB4, 0x22, 0xF4, 0x2D, 0xB7, 0x05, 0x98, 0xFE, 
+0x62, 0xBA, 0x0E, 0xC9, 0x89, 0xC7, 0x8E, 0x37, 
+0x6E, 0xFA, 0xCA, 0x5C, 0xA5, 0x53, 0x46, 0x66, 
+0xFD, 0x21, 0xA8, 0xF6, 0x08, 0x9C, 0xC0, 0x81, 
+0xF2, 0x83, 0xDD, 0xAF, 0xB4, 0x82, 0x98, 0xF1, 
+0xD7, 0x40, 0xCE, 0x8D, 0x30, 0x7D, 0x06, 0x96, 
+0x86, 0x6D, 0x02, 0xCC, 0xA4, 0xF1, 0xDA, 0xF8, 
+0x0C, 0xEA, 0xB6, 0xC2, 0x05, 0xCD, 0x35, 0x2C, 
+0x1C, 0x45, 0x3B, 0x45, 0x8B, 0xE8, 0x15, 0x88, 
+0x51, 0xFC, 0x72, 0x3F, 0xBA, 0x47, 0xB4, 0x3E, 
+0xB4, 0x51, 0xE4, 0x48, 0xF9, 0x93, 0xF4, 0x69, 
+0x7B, 0x67, 0xAC, 0xCE, 0xBB, 0x39, 0xD8, 0x8E, 
+0x67, 0x01, 0xE0, 0xB7, 0x74, 0xB9, 0xA6, 0xE6, 
+0x25, 0x9F, 0xD3, 0x13, 0x8B, 0x48, 0x80, 0xC1, 
+0xBB, 0x9B, 0xED, 0xB6, 0x15, 0x04, 0x17, 0x66, 
+0x93, 0x5C, 0xFF, 0xC9, 0x68, 0x5D, 
+
+0x7B, 0x0F, 0xBB, 0xD1, 0xE4, 0x40, 0xF9, 0xF4, 
+0x6B, 0xE7, 0xCA, 0x9C, 0xAC, 0x65, 0xC7, 0x4C, 
+0x3F, 0xB2, 0xFB, 0x34, 0xEE, 0xB4, 0x61, 0x25, 
+0x6C, 0x72, 0x56, 0x32, 0x1C, 0xC7, 0x40, 0x7C, 
+0x78, 0x30, 0x11, 0x3A, 0x51, 0x91, 0x92, 0x3C, 
+0x90, 0xF2, 0x77, 0x9B, 0xE2, 0xF3, 0x40, 0x5A, 
+0x09, 0x08, 0x97, 0x18, 0x0B, 0x2A, 0x24, 0xB5, 
+0xED, 0x43, 0xE9, 0x66, 0xCA, 0xE6, 0x9A, 0xF8, 
+0xBA, 0x52, 0x48, 0xC2, 0xDB, 0x52, 0x9F, 0x4B, 
+0x73, 0x11, 0xDC, 0x67, 0xF3, 0x75, 0xAA, 0x68, 
+0x50, 0x53, 0x0C, 0x16, 0xD5, 0xA1, 0x28, 0x10, 
+0xF3, 0xBE, 0x57, 0x5C, 0x65, 0x1A, 0x39, 0x75, 
+0x8D, 0x88, 0x9F, 0xB7, 0x5F, 0x11, 0x57, 0x9D, 
+0x27, 0x6B, 0xFB, 0xC0, 0x80, 0x03, 0x9D, 0xD3, 
+0x21, 0x56, 0x51, 0x0A, 0xED, 0x31, 0x4D, 0x58, 
+0x36, 0x64, 0x7C, 0xF9, 0xDA, 0x79, 0x18, 0x7A, 
+0x29, 0xE7, 0x3E, 0xD4, 0x5F, 0xE6, 0x5A, 0x68, 
+0xE1, 0x63, 0x31, 0xD5, 0xBF, 0x64, 0xF9, 0x4A, 
+0x2B, 0x01, 0xB6, 0xAF, 0xF3, 0x17, 0xE6, 0xCC, 
+0x44, 0x80, 0xCB, 0x9C, 0x1D, 0x89, 0x3C, 0x6A, 
+0x5E, 0x60, 0xF0, 0x85, 0xDB, 0x28, 0x61, 0x8B, 
+0x96, 0xF3, 0x6F, 0x42, 0x45, 0x4B, 0x1D, 0x75, 
+0x1C, 0x5E, 0x16, 0x0B, 0xC5, 0xFB, 0x58, 0x91, 
+0x69, 0x2B, 0x09, 0x57, 0x53, 0xE6, 0x85, 0xB7, 
+0xDC, 0x75, 0xFA, 0x5A, 0x8B, 0xD4, 0x74, 0x52, 
+0x3A, 0x3D, 0x35, 0xFD, 0x7A, 0x8B, 0x1E, 0xB6, 
+0xE8, 0xDF, 0x0D, 0x41, 0x30, 0x0A, 0x5D, 0x52, 
+0x2F, 0x64, 0x3F, 0xD0, 0x30, 0x48, 0x5B, 
+
+0x1D, 0x0E, 0xC2, 0xDD, 0x21, 0xF6, 0xC3, 0xE9, 
+0x07, 0xA3, 0x5E, 0x74, 0x31, 0xED, 0x0B, 0x8D, 
+0x8D, 0x66, 0xBA, 0x4F, 0x37, 0x64, 0x8D, 0x53, 
+0xD8, 0xDB, 0x78, 0x95, 0x87, 0x35, 0x7D, 0x3B, 
+0xE5, 0xC8, 0x8A, 0x94, 0x25, 0xFB, 0x77, 0x71, 
+0x93, 0xBE, 0x38, 0xF9, 0x6D, 0x0E, 0xED, 0x13, 
+0xF2, 0x83, 0xD8, 0x00, 0xD8, 0x26, 0xA3, 0xEA, 
+0x65, 0x0F, 0xEE, 0x16, 0xB3, 0x30, 0xC4, 0x70, 
+0x9F, 0x41, 0x0D, 0x5A, 0x25, 0x8B, 0xB4, 0x26, 
+0x58, 0x79, 0xBD, 0xAC, 0x8C, 0xA3, 0xC5, 0x68, 
+0x38, 0x98, 0xFC, 0xC8, 0xE7, 0xD9, 0xE3, 0x8F, 
+0xBF, 0x5B, 0x00, 0x78, 0x32, 0x85, 0x71, 0xB8, 
+0x51, 0x0A, 0x71, 0x7B, 0x89, 0xB6, 0x43, 0x8A, 
+0xD8, 0x18, 0xC3, 0xCC, 0x92, 0xF9, 0x23, 0x33, 
+0x65, 0x48, 0x4B, 0xB0, 0x5F, 0x88, 0x8F, 0xA0, 
+0xD3, 0x8F, 0xC8, 0x55, 0x32, 0x4D, 0x90, 0x1D, 
+0xAF, 0x24, 0x13, 0xF9, 0xD4, 0xB4, 0xE4, 0xCE, 
+0x79, 0x69, 0xF0, 0x77, 0xA5, 0x99, 0x23, 0x71, 
+0x71, 0xA6, 0xFD, 0xE7, 0x9D, 0xB9, 0x6C, 0xCA, 
+0x23, 0x31, 0xF8, 0x8C, 0xB7, 0xBF, 0xA6, 0x34, 
+0x56, 0xB6, 0xFE, 0x70, 0xD8, 0x5C, 0x41, 0x4F, 
+0x6E, 0x41, 0x04, 0x7D, 0x76, 0xDF, 0x28, 0x2B, 
+0x9F, 0xEB, 0x04, 0x1A, 0x9A, 0x77, 0xAB, 0x4E, 
+0xBD, 0x68, 0x84, 0x76, 0x2B, 0xB7, 0xF3, 0x98, 
+0xE3, 0x90, 0x24, 0x6E, 0x6C, 0x45, 0xA2, 0x53, 
+0xFF, 0x0E, 0xAE, 0xD6, 0x8E, 0xF3, 0xCB, 0x86, 
+0xB8, 0x91, 0x0E, 0x2A, 0xF5, 0xEC, 0xB3, 0x2E, 
+0x75, 0x80, 0xC6, 0x7D, 0xFE, 0xCA, 0xB9, 0x1A, 
+
+0x1C, 0x9F, 0xBB, 0xCF, 0xF9, 0xD9, 0xB5, 0x78, 
+0xA3, 0x1B, 0x23, 0x63, 0x19, 0x2C, 0x94, 0x7C, 
+0xB9, 0x16, 0x0F, 0x21, 0x3B, 0xC6, 0x1E, 0xE2, 
+0xDD, 0x4D, 0x6B, 0x78, 0x79, 0x37, 0x8B, 0x18, 
+0x06, 0xAC, 0xC8, 0x35, 0x7C, 0xA1, 0xC1, 0xFB, 
+0x4B, 0x72, 0xD8, 0xAD, 0x5E, 0xAD, 0x2C, 0x07, 
+0xA3, 0x4F, 0x36, 0xA3, 0xCD, 0xFA, 0x26, 0xB0, 
+0xA4, 0xE4, 0xA7, 0xE4, 0xF5, 0x46, 0xEE, 0x5C, 
+0x22, 0x8A, 0x83, 0x9B, 0xB7, 0x7F, 0x9D, 0x7D, 
+0x92, 0xED, 0xBB, 0x74, 0x21, 0x1F, 0xB9, 0xF3, 
+0x21, 0x2E, 0xD7, 0x14, 0x9F, 0xA4, 0xEC, 0xA8, 
+0xF2, 0xFA, 0x68, 0xF1, 0x64, 0x78, 0xB1, 0x04, 
+0x3B, 0x09, 0x34, 0x29, 0x2A, 0x49, 0xFB, 0x2C, 
+0x8D, 0x73, 0x8D, 0x4A, 0xCD, 0x59, 0xD1, 0x56, 
+0x39, 0x01, 0x6B, 0x57, 0xCE, 0xD7, 0x00, 0xF4, 
+0xA5, 0x5F, 0xAC, 0xA8, 0xBA, 0x21, 0x2E, 0x5C, 
+0x0B, 0xD4, 0xCC, 0x74, 0x4D, 0x72, 0x9F, 0xD2, 
+0x23, 0x9B, 0xC7, 0x8F, 0x93, 0x65, 0x9B, 0x17, 
+0xCE, 0x4D, 0xC6, 0xCC, 0xAE, 0xC4, 0x87, 0x40, 
+0xF0, 0x3C, 0xDE, 0x0F, 0x1D, 0x0E, 0x36, 0xF2, 
+0xE8, 0xA8, 0xEE, 0x7A, 0x97, 0x77, 0x01, 0x21, 
+0x85, 0x7D, 0x22, 0x9B, 0x53, 0x2F, 0x52, 0x99, 
+0xD5, 0xB7, 0x1A, 0xA3, 0x5B, 0x29, 0x28, 0x9C, 
+0x8C, 0xAF, 0x90, 0x47, 0x4F, 0xD9, 0x02, 0x8D, 
+0x65, 0x96, 0x62, 0x08, 0xBC, 0x7C, 0x59, 0x14, 
+0xB7, 0x0D, 0x0A, 0xB7, 0x5C, 0xAE, 0xFA, 0x7E, 
+0x2B, 0x54, 0x95, 0xC9, 0x16, 0x16, 0x97, 0x99, 
+0x3C, 0x81, 0xA1, 0x59, 0x6B, 0x62, 0xDA, 0xCF, 
+0x67, 
+
+0x31, 0x49, 0x81, 0xA5, 0x99, 0x50, 0x04, 0x90, 
+0xB8, 0x8D, 0x2C, 0x1E, 0x39, 0x45, 0x9A, 0xCA, 
+0x1C, 0xCF, 0xD0, 0xE4, 0xF3, 0x93, 0x2A, 0xFB, 
+0xA1, 0xF6, 0x6D, 0x30, 0xB2, 0x74, 0xCA, 0x0A, 
+0x32, 0x0B, 0xA6, 0xC2, 0x56, 0xC0, 0xA3, 0xD8, 
+0x32, 0xD3, 0xFC, 0x8F, 0x8C, 0xA9, 0xC4, 0x59, 
+0x7E, 0xFD, 0x13, 0x23, 0x85, 0x7D, 0xF7, 0xC6, 
+0x16, 0x73, 0x89, 0x17, 0x82, 0x18, 0x9E, 0xCC, 
+0x2E, 0xF6, 0x7D, 0x36, 0x13, 0x30, 0x54, 0xED, 
+0x24, 0x48, 0x40, 0x4D, 0x30, 0xB5, 0x3C, 0x71, 
+0x3D, 0xB1, 0x30, 0xAE, 0x5B, 0xC9, 0xE5, 0xAB, 
+0x4B, 0x85, 0x55, 0x06, 0xB7, 0xE2, 0xB0, 0x8D, 
+0x1C, 0x1A, 0xC1, 0xF6, 0xF4, 0x0B, 0x86, 0xFB, 
+0x49, 0x7C, 0xF6, 0x18, 0xBD, 0x6A, 0x5F, 0xAA, 
+0xF2, 0x38, 0x7C, 0x1D, 0xA0, 0x23, 0x91, 0xC7, 
+0x21, 0xD3, 0x9A, 0x9E, 0x04, 0xE5, 0x8A, 0xA1, 
+0xD7, 0x1D, 0xE6, 0xC4, 0x99, 0x02, 0xA5, 0x23, 
+0xC7, 0x76, 0xEB, 0x92, 0x6F, 0x46, 0x11, 0xCE, 
+0xD3, 0xBB, 0x9A, 0xA4, 0x4E, 0xDF, 0xB3, 0x2B, 
+0xD6, 0x6B, 0x40, 0x10, 0x47, 0xDA, 0x27, 0xFA, 
+0x27, 0x5C, 0x83, 0xBB, 0xB7, 0x69, 0x1E, 0x7A, 
+0x9C, 0xEC, 0xA8, 0x27, 0x94, 0x2A, 0xD9, 0xA6, 
+0xC2, 0xF5, 0x8C, 0x3D, 0xA2, 0xCE, 0x4D, 0x01, 
+0xD6, 0x1D, 0xF1, 0x6A, 0x06, 0x62, 0x49, 0x2F, 
+0x31, 0xA5, 0xE6, 0x11, 0xC1, 0x19, 0x51, 0xE9, 
+0x3C, 0x01, 0x8E, 0x0F, 0x8C, 0xFB, 0xBF, 0x3E, 
+0x54, 0x16, 0x36, 0xA6, 0x19, 0xB9, 0xDC, 0xE2, 
+0xE0, 0x0B, 0x28, 0x4E, 0xD0, 0x30, 0xD6, 0xF8, 
+0x15, 0xFA, 
+
+0x3B, 0x48, 0xF9, 0xB5, 0x6E, 0xCE, 0xD8, 0xF8, 
+0xC7, 0xC2, 0xD0, 0xEA, 0xFB, 0x22, 0x37, 0xF2, 
+0xBE, 0x33, 0xC8, 0x44, 0x36, 0xD9, 0xEA, 0x9A, 
+0xBD, 0x49, 0xE5, 0x75, 0xB7, 0xD3, 0x40, 0x5E, 
+0xC4, 0xA9, 0x18, 0x5C, 0xB7, 0x0A, 0x17, 0xF3, 
+0x18, 0xC4, 0xA9, 0xE8, 0xC8, 0x83, 0xFB, 0x36, 
+0xBB, 0xD9, 0x83, 0xA8, 0x90, 0xCB, 0xE5, 0x2B, 
+0x2E, 0xFA, 0x6D, 0xE0, 0x30, 0x4C, 0xB2, 0x42, 
+0x13, 0xE0, 0x41, 0xCB, 0xEA, 0x50, 0xAB, 0xAB, 
+0x00, 0x0A, 0x10, 0x65, 0x07, 0x32, 0x0F, 0x2A, 
+0x74, 0x23, 0x0C, 0x31, 0x7C, 0x6F, 0xA8, 0x4B, 
+0x27, 0x3E, 0x8B, 0x9C, 0x12, 0xFE, 0x5E, 0x27, 
+0x22, 0x24, 0xF9, 0xAF, 0xF6, 0x64, 0x5B, 0x0B, 
+0x9B, 0xED, 0xD8, 0x1F, 0x40, 0xBB, 0x81, 0x2D, 
+0x56, 0x5B, 0x5A, 0x34, 0xA9, 0x7A, 0x8C, 0xE5, 
+0x96, 0x89, 0x8B, 0x12, 0x24, 0xC1, 0xE4, 0x6A, 
+0xA0, 0xC9, 0xEB, 0xE7, 0x3F, 0xE0, 0xE9, 0xAC, 
+0xC0, 0x74, 0xED, 0xA6, 0xE1, 0xB1, 0xA1, 0xF9, 
+0xEB, 0x8A, 0xF5, 0xB8, 0x4E, 0x65, 0xAB, 0xBB, 
+0x2D, 0x78, 0xBB, 0xB2, 0x7D, 0x86, 0x7C, 0x6F, 
+0x6B, 0x3D, 0x18, 0x25, 0x9E, 0xB9, 0xDC, 0xAC, 
+0x59, 0x49, 0x6B, 0x2A, 0x07, 0x48, 0x0E, 0xE5, 
+0xA8, 0x27, 0xB7, 0xFF, 0x5B, 0x03, 0x03, 0x73, 
+0x5F, 0x00, 0x88, 0x43, 0xE8, 0xE2, 0x9F, 0xD6, 
+0x10, 0xAC, 0x81, 0xAE, 0x11, 0x5C, 0xAD, 0x1B, 
+0x71, 0x2D, 0x0E, 0xBF, 0xE7, 0x1E, 0xA1, 0x23, 
+0xA1, 0xA9, 0x97, 0x57, 0x60, 0x7C, 0x4B, 0x6F, 
+0x72, 0x9F, 0x56, 0x72, 0x2C, 0x24, 0xE7, 0xD4, 
+0xE4, 0x8F, 0xE5, 
+
+0xDA, 0xB6, 0x62, 0x6A, 0x6E, 0x1F, 0xDE, 0x34, 
+0x48, 0xB8, 0x45, 0xC0, 0xE0, 0x1E, 0xE2, 0xC9, 
+0x95, 0xC9, 0x6F, 0x56, 0x65, 0x8D, 0xF6, 0x5F, 
+0x88, 0xC3, 0xFD, 0x72, 0x7D, 0xF2, 0x9A, 0x62, 
+0x99, 0xBD, 0xB0, 0xF7, 0x49, 0xD2, 0xE9, 0xCF, 
+0x69, 0x53, 0x77, 0xAB, 0xD3, 0x1C, 0x33, 0xC8, 
+0x63, 0x35, 0xEB, 0xCD, 0xA9, 0xDB, 0x15, 0x2B, 
+0xDD, 0x6F, 0x05, 0x08, 0xA0, 0x15, 0xA1, 0xC9, 
+0x58, 0x5B, 0xA9, 0x24, 0xDA, 0xFB, 0xC5, 0x1A, 
+0x90, 0x44, 0x28, 0x69, 0x51, 0xDE, 0x76, 0x20, 
+0x25, 0x8D, 0x4A, 0xA8, 0x7B, 0x50, 0xAC, 0x6D, 
+0xEA, 0xE5, 0x79, 0xBE, 0x40, 0x72, 0x13, 0xF8, 
+0x43, 0xCE, 0xBF, 0xB4, 0xAC, 0x37, 0x35, 0xD7, 
+0x3E, 0xFF, 0x8F, 0xEB, 0x8C, 0x04, 0x20, 0x4E, 
+0x35, 0x58, 0x14, 0xCB, 0x98, 0x29, 0x1E, 0x22, 
+0xF4, 0xA1, 0xE5, 0x39, 0x11, 0x39, 0x14, 0xDE, 
+0x35, 0x07, 0x00, 0xF0, 0x5D, 0x3F, 0x47, 0xE7, 
+0x61, 0x9E, 0x0E, 0xA0, 0x33, 0xAB, 0x11, 0xD2, 
+0x7D, 0x66, 0xAA, 0x77, 0xF0, 0x44, 0x2D, 0x71, 
+0x4A, 0x8F, 0x0B, 0x3B, 0x8C, 0x6D, 0x33, 0x95, 
+0x70, 0x56, 0xFD, 0x10, 0x0E, 0x19, 0xB8, 0x06, 
+0xA2, 0x15, 0xA5, 0x0F, 0xFD, 0x9E, 0xC9, 0xA5, 
+0xAD, 0xF4, 0x56, 0xF6, 0x4A, 0xA1, 0xA5, 0x0F, 
+0x63, 0xF1, 0x49, 0xF3, 0x42, 0xDD, 0x8F, 0x25, 
+0x06, 0x67, 0x43, 0xA2, 0x3E, 0x63, 0x1D, 0xC1, 
+0x62, 0xBB, 0xB0, 0xEE, 0x2A, 0xAE, 0xA3, 0x43, 
+0x40, 0xEF, 0x2E, 0x86, 0x1C, 0x48, 0x00, 0xB7, 
+0x79, 0x5A, 0x74, 0xDC, 0x16, 0xF3, 0x0A, 0x2C, 
+0x16, 0x79, 0x67, 0x83, 
+
+0xA7, 0x99, 0xD5, 0xBD, 0xA6, 0x51, 0x1C, 0xD1, 
+0x79, 0xF2, 0x0C, 0x24, 0xDE, 0xEE, 0x7B, 0xFD, 
+0x32, 0x1D, 0x4D, 0x02, 0x82, 0x91, 0xF0, 0x18, 
+0x8F, 0x1F, 0xC9, 0xD3, 0x72, 0x06, 0x5F, 0xF0, 
+0x50, 0x4C, 0xEB, 0xD5, 0x7C, 0x29, 0xFB, 0x56, 
+0xA3, 0x62, 0x8E, 0xFE, 0x7D, 0xEE, 0x07, 0x73, 
+0xCC, 0x80, 0x84, 0xD3, 0xFA, 0x69, 0xE4, 0x6D, 
+0x90, 0x07, 0xAC, 0x55, 0xAA, 0xA5, 0x4D, 0xB4, 
+0x34, 0xAF, 0xA4, 0xCC, 0x57, 0x2E, 0xF1, 0x5D, 
+0x75, 0xF0, 0x55, 0x37, 0xD9, 0x32, 0x07, 0x77, 
+0x83, 0xB3, 0x24, 0x57, 0x8C, 0xC9, 0x7C, 0xE9, 
+0x7B, 0xCA, 0x69, 0xA7, 0x37, 0x57, 0x1C, 0x62, 
+0x22, 0x44, 0xAE, 0xA9, 0x5A, 0xBF, 0xA8, 0xB6, 
+0x8B, 0xDF, 0xBB, 0xC2, 0x59, 0x40, 0x03, 0x5E, 
+0xAB, 0x68, 0x38, 0xB4, 0xCD, 0x11, 0xF4, 0x1F, 
+0x6E, 0xA2, 0x79, 0xEF, 0xBE, 0xE0, 0x27, 0x33, 
+0x2E, 0xD6, 0xB1, 0x0D, 0x0A, 0x9F, 0x27, 0x96, 
+0x71, 0x2A, 0xB5, 0x39, 0xC3, 0x17, 0xD4, 0x72, 
+0x7B, 0x15, 0x9B, 0x67, 0x85, 0x98, 0x83, 0x45, 
+0xF9, 0x41, 0xAF, 0x18, 0x50, 0xA8, 0x97, 0x9F, 
+0x21, 0xC6, 0xC7, 0xCC, 0x58, 0x0B, 0xC2, 0x7A, 
+0x48, 0xD7, 0x76, 0x07, 0x58, 0xC6, 0x49, 0xBA, 
+0x04, 0xCA, 0x6D, 0xC2, 0x7D, 0xC0, 0xC4, 0x45, 
+0xD8, 0x8E, 0x95, 0x67, 0x8D, 0xA4, 0x35, 0xB7, 
+0x87, 0xA6, 0xE0, 0x8E, 0x22, 0x69, 0xF0, 0x85, 
+0xF7, 0xEC, 0x09, 0x04, 0xB6, 0x49, 0x0B, 0x9B, 
+0xC4, 0x09, 0x09, 0x7F, 0x35, 0x12, 0x31, 0xBA, 
+0x92, 0x7C, 0x7E, 0x20, 0xE3, 0x88, 0xFE, 0xD0, 
+0xF7, 0x2D, 0x27, 0x42, 0x16, 
+
+0x6F, 0x04, 0x60, 0x46, 0x02, 0x52, 0x33, 0x17, 
+0x93, 0x5F, 0xD7, 0x93, 0xFA, 0xF3, 0x76, 0x43, 
+0x42, 0xAB, 0x89, 0x42, 0xFC, 0xBB, 0x11, 0x0E, 
+0x7B, 0x00, 0xCF, 0x7D, 0x27, 0x10, 0x3A, 0x2E, 
+0x1D, 0x46, 0x6A, 0xE0, 0xC5, 0xD0, 0x70, 0x51, 
+0xA3, 0x05, 0x2D, 0x6A, 0xC7, 0x80, 0x05, 0x46, 
+0x8A, 0x65, 0x10, 0x91, 0x24, 0xBB, 0x15, 0xEC, 
+0xFE, 0x7D, 0xC9, 0x47, 0x55, 0x1A, 0x6B, 0x95, 
+0x83, 0x65, 0xE6, 0xFD, 0x56, 0xEB, 0x13, 0xC6, 
+0x2F, 0xEE, 0x95, 0x68, 0xBD, 0x83, 0x76, 0x66, 
+0xC7, 0x71, 0x8C, 0xE8, 0x0D, 0xBF, 0xBC, 0x7C, 
+0x8A, 0x47, 0x98, 0x05, 0x0D, 0xE8, 0x0D, 0xD5, 
+0x0F, 0x7D, 0x55, 0x3C, 0xB8, 0x58, 0x54, 0x26, 
+0xB5, 0x2D, 0x7D, 0x7C, 0x6C, 0x76, 0x05, 0x29, 
+0x4B, 0xAF, 0x9D, 0x64, 0xA2, 0x3D, 0xCF, 0x86, 
+0x6B, 0xCA, 0xE2, 0xE1, 0xD0, 0x3E, 0x53, 0x01, 
+0x89, 0xF9, 0x4E, 0xAC, 0x5F, 0x74, 0x3B, 0xB7, 
+0xCE, 0xB8, 0xB4, 0x99, 0xA4, 0x1A, 0x20, 0x56, 
+0xA5, 0x55, 0x5F, 0x0F, 0x02, 0x6D, 0x52, 0x15, 
+0x8A, 0xE4, 0x2C, 0xE5, 0x40, 0x0D, 0x5F, 0x9B, 
+0x4E, 0x6B, 0x75, 0xA4, 0x96, 0xDD, 0xCF, 0xCD, 
+0x22, 0x5E, 0x45, 0x4B, 0x08, 0xAB, 0xA4, 0x0C, 
+0x19, 0xE4, 0xB2, 0x90, 0x32, 0x56, 0x6E, 0xCF, 
+0x19, 0xA5, 0xE3, 0xC3, 0x23, 0xEF, 0x82, 0xE8, 
+0xCB, 0x3C, 0x08, 0x08, 0xFB, 0x35, 0x35, 0x27, 
+0x82, 0x1C, 0x77, 0xAC, 0x34, 0x0E, 0x8F, 0x69, 
+0xBA, 0x75, 0xE2, 0xD2, 0x2D, 0x20, 0x2D, 0x5B, 
+0xE9, 0x58, 0xEB, 0x79, 0x8E, 0x23, 0x89, 0xA2, 
+0xCD, 0x1B, 0x03, 0x1B, 0x2C, 0x0D, 
+
+0x1A, 0xF7, 0x7B, 0x86, 0x79, 0x39, 0x57, 0x9E, 
+0x4A, 0x32, 0x73, 0x4F, 0x3E, 0x8A, 0x13, 0x3F, 
+0xFF, 0x90, 0xF0, 0x46, 0xE6, 0x25, 0x3F, 0x40, 
+0x4B, 0xCD, 0xBD, 0x84, 0x9A, 0x5B, 0xCB, 0x02, 
+0xF1, 0xFD, 0x8F, 0x65, 0x89, 0xE8, 0xAE, 0x5C, 
+0xD1, 0x1B, 0x0A, 0xF4, 0xF1, 0x99, 0x2A, 0x9C, 
+0x60, 0x27, 0x57, 0x0C, 0xA4, 0x8C, 0x6B, 0xA0, 
+0x6E, 0xD0, 0xBC, 0xB7, 0x74, 0x28, 0x65, 0x5C, 
+0xA9, 0x24, 0x3B, 0xA5, 0xAB, 0x01, 0x31, 0x12, 
+0x4B, 0xAE, 0xAC, 0xA6, 0x17, 0x76, 0x56, 0xD0, 
+0x04, 0x94, 0xFC, 0x44, 0x69, 0xB5, 0x84, 0xF1, 
+0xAF, 0xB9, 0xF9, 0x1C, 0xE9, 0x6C, 0x68, 0xCD, 
+0x5A, 0x0B, 0x97, 0x97, 0xD8, 0xF4, 0x84, 0x6D, 
+0xF1, 0xBF, 0x69, 0xC6, 0xDC, 0x53, 0x18, 0xA0, 
+0x14, 0x7F, 0x42, 0x4C, 0x5D, 0xA9, 0x36, 0x75, 
+0xB7, 0xE6, 0xB9, 0x85, 0x19, 0x3A, 0x7A, 0x9C, 
+0x0D, 0x47, 0xED, 0x41, 0x50, 0x89, 0x01, 0x29, 
+0x50, 0xC0, 0x0B, 0xD5, 0xB7, 0x73, 0x28, 0xC7, 
+0x0A, 0x77, 0x89, 0xFD, 0x0B, 0xA7, 0xEA, 0x37, 
+0x7D, 0x12, 0x9A, 0x49, 0x7C, 0xE4, 0x1F, 0x71, 
+0xF2, 0xBB, 0xA8, 0x80, 0x95, 0x94, 0xC2, 0xF8, 
+0xF2, 0x21, 0x7B, 0x16, 0x68, 0x8B, 0x4F, 0x37, 
+0x8D, 0xC4, 0xC6, 0x06, 0xB0, 0x19, 0x4A, 0xA2, 
+0x6F, 0x39, 0x46, 0xB0, 0xE7, 0xD2, 0xBE, 0x48, 
+0x50, 0xEB, 0x49, 0xB9, 0x51, 0xA2, 0xFF, 0x63, 
+0xD5, 0x58, 0x90, 0xE9, 0x67, 0x46, 0x74, 0x1A, 
+0x90, 0xE0, 0xAD, 0x57, 0x7F, 0xEE, 0x29, 0x3B, 
+0x2E, 0x9C, 0x88, 0x3F, 0x60, 0x6B, 0xE9, 0x4E, 
+0x51, 0xA3, 0x7E, 0xAA, 0xB5, 0x37, 0x35, 
+
+0x72, 0x98, 0xAA, 0x09, 0xF7, 0x07, 0x0E, 0x25, 
+0xC1, 0x0C, 0xEA, 0x2C, 0x7D, 0x26, 0xA9, 0xBE, 
+0x72, 0xF1, 0x25, 0x44, 0xBA, 0xD5, 0x14, 0xC9, 
+0x9B, 0x3C, 0xB2, 0xC6, 0x2E, 0xBC, 0x8B, 0xEB, 
+0x76, 0xF6, 0xE7, 0x04, 0x65, 0xD5, 0x8E, 0x35, 
+0xB9, 0xC7, 0x57, 0xF4, 0xF6, 0xAD, 0xB3, 0x28, 
+0x28, 0x59, 0xA1, 0xC9, 0x10, 0x47, 0x41, 0x80, 
+0xEA, 0x00, 0xD7, 0xA5, 0x47, 0x34, 0xBC, 0x67, 
+0xAC, 0xF6, 0x3A, 0xF0, 0x56, 0x32, 0xED, 0xBA, 
+0x2D, 0xB9, 0x62, 0x44, 0x8B, 0xEF, 0x38, 0xD4, 
+0x41, 0x62, 0x9E, 0xC5, 0x4A, 0x40, 0x2F, 0x18, 
+0x1D, 0x87, 0x91, 0x7D, 0x49, 0x91, 0x91, 0x7C, 
+0x48, 0x9B, 0x9D, 0x5D, 0x1D, 0x37, 0xA8, 0x84, 
+0xA5, 0x30, 0x44, 0x47, 0x2E, 0xBB, 0xEF, 0x79, 
+0x3C, 0x97, 0xA9, 0x87, 0x44, 0x1B, 0xDE, 0x15, 
+0x7D, 0x43, 0xDD, 0x9F, 0x6D, 0x8B, 0x96, 0xCE, 
+0xAD, 0x2F, 0x5B, 0x16, 0xCC, 0x99, 0xB7, 0x97, 
+0x31, 0x9C, 0x9F, 0x52, 0x58, 0x7E, 0xB5, 0xDC, 
+0xB1, 0x0A, 0x7B, 0xA2, 0x57, 0x62, 0xB8, 0xBF, 
+0xD5, 0x99, 0xFA, 0x37, 0x1F, 0xC4, 0x55, 0xFE, 
+0x6A, 0x14, 0x3D, 0x4D, 0x16, 0x8B, 0x68, 0x60, 
+0x9E, 0xA9, 0x23, 0xF0, 0xA7, 0xBD, 0x37, 0xE3, 
+0xFD, 0x26, 0x7B, 0xBC, 0x60, 0x96, 0xB8, 0x21, 
+0xA3, 0xDA, 0x5C, 0x98, 0x64, 0x31, 0xBF, 0xC0, 
+0x93, 0x69, 0xCE, 0x80, 0x4A, 0xBC, 0x61, 0xCC, 
+0xD2, 0x35, 0x0B, 0x52, 0x70, 0x33, 0x9A, 0x81, 
+0xB1, 0xC5, 0x4F, 0x93, 0x3F, 0xE8, 0x97, 0xF9, 
+0xA1, 0xFC, 0xB1, 0x72, 0x60, 0x95, 0xFE, 0x27, 
+0x78, 0x82, 0x83, 0xB8, 0x23, 0x8D, 0x1A, 0x6F, 
+
+0x31, 0x2B, 0xC5, 0xA3, 0xA9, 0xA9, 0xFE, 0xF1, 
+0x6A, 0xC9, 0xE4, 0x97, 0x89, 0xDE, 0x33, 0x33, 
+0xBC, 0x3F, 0x31, 0xB4, 0xB0, 0x91, 0x4D, 0x20, 
+0x57, 0x0B, 0xC7, 0x14, 0xA9, 0x92, 0xE0, 0xE0, 
+0xA1, 0xDD, 0x5B, 0xB7, 0x40, 0xC0, 0x72, 0x4E, 
+0x66, 0xC6, 0x3C, 0x45, 0xC4, 0x7A, 0x00, 0xC9, 
+0xC9, 0x25, 0xA2, 0xC2, 0xDF, 0x0D, 0x33, 0x9C, 
+0xAC, 0xEF, 0xCB, 0x82, 0x0E, 0x25, 0xB0, 0xA3, 
+0xBE, 0xB3, 0x80, 0x20, 0x55, 0x26, 0x45, 0xD6, 
+0x3E, 0x31, 0x8D, 0x00, 0x0B, 0xDD, 0xC5, 0xFB, 
+0xC4, 0x79, 0xB9, 0x8F, 0x5E, 0x72, 0x81, 0x7F, 
+0x38, 0x72, 0x98, 0xF7, 0x0D, 0xAC, 0x0F, 0xD6, 
+0x45, 0xAA, 0xF3, 0x22, 0x8A, 0x36, 0xCA, 0x06, 
+0x8E, 0xF8, 0xE5, 0x40, 0x63, 0xB9, 0xCD, 0xF2, 
+0x61, 0x87, 0x56, 0x10, 0x3A, 0x92, 0xC3, 0xCE, 
+0x2D, 0x50, 0xC1, 0x89, 0xD4, 0xD7, 0x43, 0x30, 
+0xC7, 0x69, 0x05, 0xB4, 0x0B, 0x7B, 0x68, 0x39, 
+0xC6, 0x98, 0x29, 0x43, 0x54, 0xFB, 0x6E, 0xC3, 
+0x2C, 0x3A, 0x97, 0x3C, 0xD8, 0xAC, 0x86, 0x4C, 
+0x14, 0xC2, 0x8F, 0x0B, 0x95, 0x6F, 0x47, 0xC1, 
+0xC5, 0xEC, 0xD3, 0x1B, 0xB0, 0x06, 0x1C, 0x28, 
+0x38, 0xBE, 0xC4, 0x86, 0xEB, 0x4A, 0x23, 0x5E, 
+0x24, 0xEC, 0x52, 0xE2, 0xDE, 0xF7, 0x87, 0x6E, 
+0xB8, 0x69, 0xEA, 0xF0, 0x57, 0x54, 0x41, 0x1A, 
+0x7F, 0x4D, 0xE3, 0x92, 0x19, 0x97, 0x0D, 0xCC, 
+0x42, 0x0E, 0x83, 0xC2, 0xEF, 0xF9, 0x43, 0x34, 
+0xED, 0xED, 0x8C, 0x67, 0x38, 0x87, 0x9B, 0x29, 
+0x3D, 0xDD, 0x9B, 0xA5, 0xED, 0xCB, 0xDB, 0x42, 
+0x3F, 0x2E, 0xE9, 0xC2, 0x32, 0xBE, 0x42, 0x20, 
+0xA5, 
+
+0xAF, 0x88, 0x68, 0xC7, 0xB0, 0xF1, 0xD8, 0xD9, 
+0x79, 0x25, 0xF8, 0x5E, 0xCC, 0x2A, 0xF9, 0x3C, 
+0x72, 0xC0, 0xAF, 0x07, 0x60, 0x85, 0x8E, 0x7A, 
+0xF4, 0xD7, 0x62, 0xAA, 0x34, 0x3A, 0x79, 0xF2, 
+0x26, 0x27, 0xB4, 0xE2, 0x9A, 0x0D, 0xB5, 0xD1, 
+0xC5, 0x2A, 0x9D, 0x2D, 0x38, 0xAD, 0xF2, 0xAB, 
+0xFC, 0xC9, 0xA5, 0x0D, 0x8F, 0x7E, 0x3E, 0x62, 
+0xBE, 0xBA, 0xF5, 0x2E, 0xCC, 0x39, 0x0E, 0x52, 
+0x4E, 0x67, 0x26, 0x6F, 0xC7, 0x39, 0xC5, 0x17, 
+0x88, 0xC1, 0x42, 0x9B, 0xF6, 0xA0, 0xEA, 0xCC, 
+0x79, 0x62, 0xA8, 0x7E, 0x61, 0x49, 0x9B, 0x3F, 
+0x86, 0xA6, 0x0E, 0x48, 0xA8, 0xE7, 0x19, 0x9C, 
+0x21, 0xBB, 0x7F, 0xC5, 0x9F, 0x57, 0xF4, 0xE3, 
+0x1C, 0x56, 0x26, 0x93, 0x5D, 0x26, 0xEF, 0x63, 
+0x95, 0xF6, 0x32, 0x61, 0x2B, 0x09, 0xE9, 0xB2, 
+0x04, 0x6D, 0x57, 0xE4, 0x03, 0x34, 0xF9, 0x35, 
+0x27, 0xE7, 0x89, 0x1A, 0x5A, 0xED, 0xCB, 0xEE, 
+0x8A, 0xB1, 0xD7, 0xCB, 0x66, 0x2D, 0x53, 0x75, 
+0x2F, 0xF0, 0xA6, 0x5E, 0x20, 0xAC, 0x60, 0x28, 
+0xD9, 0x5D, 0xF2, 0x06, 0xBD, 0xCA, 0x52, 0x1B, 
+0x0A, 0x91, 0xF2, 0x26, 0xF5, 0x0D, 0xB0, 0x69, 
+0xE9, 0x2C, 0xB4, 0x3B, 0xE6, 0xF6, 0xD7, 0x57, 
+0xD0, 0x49, 0xC2, 0xFD, 0x83, 0x28, 0x74, 0xC7, 
+0x75, 0x4D, 0x0A, 0xF8, 0x96, 0xDD, 0x34, 0x18, 
+0x07, 0x49, 0x79, 0x2E, 0x2F, 0x88, 0xE6, 0x94, 
+0xFA, 0x0F, 0x46, 0x74, 0x4C, 0xA8, 0xF2, 0x6D, 
+0x3F, 0x0D, 0xA7, 0xC1, 0xD3, 0xAE, 0x8D, 0x9E, 
+0x76, 0xFF, 0xC7, 0x1D, 0xC1, 0x66, 0x98, 0xF1, 
+0x1E, 0x23, 0xCA, 0x0C, 0x5F, 0x36, 0xA9, 0xA7, 
+0xD7, 0xF7, 
+
+0x1B, 0xF8, 0x25, 0x4F, 0xF2, 0xDB, 0xCC, 0xCD, 
+0x35, 0xA0, 0x6B, 0x35, 0x78, 0x8C, 0xA1, 0x96, 
+0xCC, 0x21, 0x56, 0xA0, 0xB5, 0x84, 0x9D, 0xB7, 
+0x72, 0x6B, 0x27, 0xCD, 0xCD, 0x62, 0xBC, 0xFA, 
+0x3C, 0x62, 0xF6, 0x5B, 0x35, 0xD7, 0x74, 0x1E, 
+0x06, 0x5B, 0x5A, 0xEE, 0x42, 0x72, 0x4A, 0x86, 
+0x1A, 0xEB, 0xEF, 0x1D, 0x07, 0xD3, 0xBB, 0x04, 
+0x36, 0xCF, 0xE4, 0x3D, 0xD2, 0x03, 0x6C, 0x59, 
+0xDC, 0x37, 0x5B, 0x0C, 0x06, 0xDE, 0xFD, 0xF1, 
+0x54, 0xA5, 0x84, 0xDC, 0xF2, 0x33, 0xE5, 0xC7, 
+0xB1, 0xD4, 0x10, 0x8E, 0x40, 0xE6, 0x01, 0xDA, 
+0x64, 0x3E, 0x64, 0x89, 0x61, 0x43, 0x7C, 0x3B, 
+0x4E, 0xD3, 0xB9, 0xBA, 0x3F, 0x62, 0xC4, 0xD8, 
+0xD2, 0xE8, 0x16, 0xEE, 0x5A, 0x31, 0x66, 0xAC, 
+0x6B, 0xEE, 0x8C, 0xDB, 0x49, 0x76, 0x80, 0xA9, 
+0x33, 0x99, 0x5B, 0x68, 0x36, 0xF3, 0x85, 0x8A, 
+0x31, 0xA6, 0xE3, 0xEB, 0x4F, 0xCA, 0xFF, 0x89, 
+0x94, 0xBA, 0xAE, 0x02, 0xA6, 0xC8, 0x6B, 0xF6, 
+0x6F, 0x4C, 0x45, 0x0C, 0x30, 0x56, 0xF9, 0xEE, 
+0x3C, 0xAA, 0xBC, 0x5C, 0xD7, 0xCE, 0xC6, 0x0C, 
+0x58, 0x6A, 0xC3, 0x43, 0x57, 0xB9, 0xA3, 0x7B, 
+0x42, 0x7A, 0xF1, 0x2A, 0x60, 0x18, 0xDD, 0x4B, 
+0x11, 0x93, 0x6F, 0x7B, 0x8F, 0x72, 0xE0, 0x69, 
+0x48, 0xA8, 0x61, 0x67, 0x95, 0x63, 0x53, 0x16, 
+0x5F, 0x82, 0x42, 0xCA, 0x6C, 0xE3, 0xFB, 0xA7, 
+0x1B, 0x3C, 0x01, 0xCB, 0x50, 0x1B, 0xA7, 0x7A, 
+0x3C, 0xB1, 0xF5, 0x2B, 0x88, 0x84, 0x3B, 0x12, 
+0xCD, 0x4D, 0x0F, 0xB2, 0x8C, 0x2D, 0x67, 0x8C, 
+0xD7, 0xB7, 0xDC, 0x70, 0xC1, 0xEF, 0x40, 0x59, 
+0xFE, 0x74, 0xC3, 
+
+0x61, 0x31, 0x00, 0x8A, 0xE3, 0xEE, 0xB2, 0xFA, 
+0x6B, 0x62, 0xA3, 0x6C, 0x26, 0xB4, 0x96, 0x7F, 
+0xCC, 0x33, 0x75, 0xBD, 0x6E, 0x6E, 0x8E, 0x8A, 
+0x85, 0xA2, 0xBD, 0xDC, 0xC3, 0x96, 0xB4, 0x44, 
+0x74, 0x14, 0x19, 0xC9, 0x20, 0x49, 0xEC, 0x3B, 
+0x01, 0x26, 0x0A, 0xCD, 0x2B, 0x20, 0xCA, 0x1C, 
+0x26, 0x0D, 0x0F, 0xD8, 0x0C, 0x77, 0xC4, 0x1B, 
+0x93, 0x60, 0x24, 0x4C, 0xB6, 0x50, 0xA4, 0x3A, 
+0x9D, 0x77, 0x19, 0xCC, 0xC9, 0x4E, 0x00, 0xEB, 
+0xAA, 0xEF, 0x3A, 0xE4, 0x30, 0x9E, 0xBF, 0x94, 
+0x05, 0x37, 0x4A, 0xEE, 0x66, 0x77, 0xF6, 0x8B, 
+0xAA, 0x8A, 0xA8, 0x86, 0xB7, 0xE0, 0x00, 0xB9, 
+0x13, 0x6A, 0x87, 0x3F, 0xC5, 0x16, 0x83, 0x73, 
+0x80, 0xBB, 0x82, 0x9B, 0x4A, 0xEE, 0x30, 0x2E, 
+0x3F, 0x9E, 0xBC, 0xFF, 0x85, 0x44, 0x70, 0x86, 
+0x80, 0xC2, 0x99, 0x5A, 0xCD, 0xF8, 0xCE, 0x0E, 
+0xC1, 0xD1, 0xC7, 0x2D, 0x04, 0x0C, 0x3C, 0xFA, 
+0x81, 0xF7, 0xC6, 0xB0, 0x9D, 0x15, 0xCB, 0x75, 
+0x2B, 0xF5, 0x8A, 0x3E, 0xE7, 0x5D, 0x30, 0x0B, 
+0x0E, 0xD6, 0x16, 0x5C, 0x96, 0xBE, 0x64, 0xFE, 
+0xF7, 0x8D, 0xEC, 0xFB, 0x1E, 0x14, 0xFE, 0x82, 
+0xB2, 0xAE, 0xE5, 0x0C, 0xC1, 0x15, 0xE9, 0x29, 
+0x9D, 0xD3, 0xA2, 0xC0, 0x2F, 0x1D, 0x95, 0x37, 
+0xF9, 0xB1, 0xCB, 0x70, 0xBD, 0x34, 0xD8, 0xAC, 
+0xAB, 0x3B, 0xFC, 0x4E, 0x43, 0xB9, 0x32, 0x9D, 
+0x2E, 0x22, 0x15, 0x37, 0x28, 0x6A, 0x54, 0xEA, 
+0x21, 0x82, 0xCC, 0x13, 0xEF, 0x8F, 0xF7, 0x06, 
+0xDF, 0xF4, 0x3B, 0x90, 0x83, 0x6B, 0x12, 0xA2, 
+0xA8, 0x42, 0x03, 0xFC, 0xEF, 0xA2, 0xDE, 0x0E, 
+0x66, 0x6B, 0x69, 0x15, 
+
+0x71, 0x3D, 0xCF, 0x6A, 0x27, 0x2B, 0x60, 0xB6, 
+0x67, 0x86, 0x37, 0x8A, 0xFD, 0xD7, 0xB2, 0x0F, 
+0x68, 0xDA, 0x38, 0x88, 0x45, 0xDE, 0x20, 0x91, 
+0x30, 0xD0, 0x56, 0x7A, 0x39, 0xDA, 0x41, 0x37, 
+0xDC, 0x30, 0xD5, 0x60, 0xE7, 0x12, 0x3A, 0x8D, 
+0x8B, 0x04, 0x3C, 0x25, 0xC6, 0xCC, 0x05, 0x84, 
+0x98, 0x0D, 0x5C, 0x91, 0x5C, 0xA9, 0x59, 0x0F, 
+0x20, 0x5B, 0x68, 0x58, 0xED, 0xF1, 0x2D, 0xFF, 
+0xB6, 0x09, 0x4B, 0x04, 0xC8, 0xD0, 0xD8, 0x20, 
+0xBD, 0xB8, 0xAD, 0x09, 0xC4, 0x21, 0x84, 0xA5, 
+0xA4, 0xD7, 0xA0, 0x42, 0xDA, 0x79, 0x2C, 0x46, 
+0x45, 0xD5, 0xA1, 0x43, 0x97, 0x09, 0x76, 0xFA, 
+0x8D, 0x88, 0xE1, 0x15, 0x39, 0x08, 0x31, 0x06, 
+0xA0, 0x5C, 0xB6, 0xBE, 0x8A, 0x74, 0x8C, 0x42, 
+0x21, 0x8B, 0x8A, 0xAB, 0xDE, 0x43, 0xCB, 0xBD, 
+0x5A, 0xD5, 0x14, 0x60, 0xB1, 0x15, 0xA6, 0x50, 
+0x9B, 0xE2, 0xFE, 0xC8, 0xC3, 0x2A, 0xE3, 0x03, 
+0xBB, 0xFE, 0x8E, 0xB8, 0x97, 0xEA, 0x96, 0xD1, 
+0x07, 0x27, 0x59, 0x8D, 0x8D, 0x6F, 0x60, 0xD5, 
+0x96, 0x83, 0x85, 0x54, 0x7D, 0xFE, 0x17, 0xEE, 
+0x5C, 0x5F, 0x53, 0xBB, 0x38, 0xBE, 0x76, 0x3C, 
+0xBB, 0x3C, 0xA3, 0x8B, 0x66, 0x28, 0xA0, 0xF2, 
+0xA9, 0x45, 0x2F, 0x3E, 0xE0, 0x53, 0x6F, 0xE4, 
+0x88, 0x77, 0xA9, 0x84, 0x91, 0x01, 0x40, 0xDD, 
+0x15, 0x20, 0xD8, 0x11, 0x7B, 0xA8, 0x79, 0xC7, 
+0x48, 0x25, 0x09, 0x05, 0x99, 0x37, 0x99, 0x13, 
+0x0E, 0xCA, 0xCE, 0x03, 0xBC, 0x0F, 0xA1, 0x11, 
+0x21, 0xCE, 0x2C, 0x50, 0xB8, 0x73, 0xED, 0x71, 
+0x2D, 0x48, 0x61, 0x9C, 0xC9, 0x05, 0x8F, 0xC6, 
+0xF7, 0x30, 0x61, 0x66, 0x04, 
+
+0xE3, 0x68, 0x08, 0x79, 0x0A, 0x73, 0x9C, 0xD3, 
+0x3A, 0x09, 0x66, 0xC5, 0x00, 0x58, 0xB8, 0xB8, 
+0x4D, 0x1D, 0xB6, 0x41, 0x6A, 0x76, 0x52, 0xD5, 
+0xC1, 0x37, 0xC5, 0x9B, 0x38, 0x76, 0xE3, 0x4D, 
+0x86, 0x71, 0x77, 0xD8, 0xC9, 0x77, 0x85, 0x92, 
+0xCB, 0x4E, 0xC4, 0x9B, 0x98, 0xEB, 0x34, 0x21, 
+0x6E, 0xA0, 0xC6, 0xC0, 0xE0, 0x0D, 0x78, 0x2D, 
+0x02, 0x59, 0x0B, 0x29, 0xB9, 0xBA, 0xA0, 0xA8, 
+0x22, 0xF3, 0x7C, 0xB3, 0xD5, 0x56, 0xE8, 0x94, 
+0xFA, 0x05, 0xD2, 0xBE, 0xFD, 0x0C, 0x71, 0x4C, 
+0x16, 0xF6, 0x58, 0xB4, 0xE7, 0x89, 0xCB, 0xCA, 
+0x5D, 0xD4, 0xC7, 0x3A, 0x0E, 0x53, 0xBD, 0xD5, 
+0xA4, 0x1C, 0xAF, 0x77, 0x27, 0xB4, 0x25, 0x21, 
+0x53, 0xE8, 0x00, 0x87, 0x6C, 0xB6, 0x4F, 0x80, 
+0x67, 0x8D, 0xA4, 0x14, 0xD7, 0x24, 0x7B, 0x96, 
+0x32, 0x0F, 0xB3, 0x07, 0x84, 0x39, 0x47, 0xC2, 
+0xDC, 0x40, 0xFD, 0x7F, 0x94, 0x03, 0xD2, 0xC9, 
+0x3F, 0x5C, 0x7A, 0xB5, 0xED, 0x84, 0x9D, 0x48, 
+0x8E, 0x52, 0x28, 0xB7, 0x7B, 0x41, 0x0B, 0xA8, 
+0x56, 0x24, 0xE0, 0xA4, 0x70, 0x80, 0x4E, 0xFA, 
+0x37, 0xFF, 0xB5, 0xEE, 0x45, 0xE1, 0xD1, 0x46, 
+0x6D, 0x3D, 0xCC, 0x15, 0x93, 0x6B, 0xD3, 0x26, 
+0x2D, 0x77, 0x32, 0x10, 0x89, 0x57, 0xF3, 0xBE, 
+0xB2, 0xC0, 0xE0, 0x47, 0xC3, 0x84, 0x37, 0x11, 
+0xD1, 0xC4, 0x40, 0x65, 0x94, 0x5C, 0xA9, 0x24, 
+0xA8, 0xE6, 0x72, 0x51, 0x43, 0x1D, 0xD3, 0x4F, 
+0xD2, 0x79, 0x3A, 0x7A, 0x08, 0x96, 0x49, 0x0D, 
+0x96, 0x5B, 0x7A, 0x07, 0x83, 0x8A, 0xDE, 0x00, 
+0x9D, 0xC9, 0x6E, 0xDD, 0x1A, 0x20, 0x60, 0xA5, 
+0x6E, 0x57, 0x1C, 0xA6, 0xAB, 0xFD, 
+
+0xEE, 0x32, 0xB0, 0x0C, 0xE4, 0x3F, 0x73, 0xCB, 
+0xC2, 0xC0, 0xBE, 0x30, 0xA2, 0x49, 0x94, 0x3D, 
+0xE8, 0x6B, 0xB7, 0xF0, 0x71, 0xD2, 0x34, 0xC9, 
+0x0A, 0xEE, 0xEB, 0x35, 0x1B, 0x44, 0xAC, 0xD2, 
+0xB9, 0x48, 0x0D, 0x1D, 0xAE, 0x39, 0xB5, 0x54, 
+0x37, 0x37, 0xEE, 0x7A, 0xD1, 0x9D, 0xA9, 0xF6, 
+0x9B, 0xD1, 0x3D, 0xE8, 0x1F, 0xB9, 0x8A, 0xEF, 
+0x46, 0xE2, 0x9E, 0x72, 0x96, 0xC7, 0xC0, 0x38, 
+0x0A, 0xFC, 0xE7, 0x10, 0xCF, 0x9D, 0x3A, 0x86, 
+0x8D, 0xE5, 0x16, 0x7D, 0xD4, 0x7C, 0xBA, 0x0B, 
+0x37, 0x73, 0x69, 0x72, 0x6C, 0x3C, 0xD1, 0x70, 
+0x1B, 0x01, 0xEE, 0xF3, 0x82, 0x10, 0x68, 0x21, 
+0xBC, 0x69, 0xBC, 0x9D, 0x46, 0xF5, 0x56, 0x11, 
+0xDA, 0xDC, 0x95, 0xE7, 0x05, 0x92, 0xF2, 0xD4, 
+0xA0, 0x1C, 0x4E, 0x1E, 0x9D, 0xF2, 0x30, 0x8A, 
+0x20, 0x7C, 0xD8, 0x3A, 0x1B, 0x31, 0xE3, 0x8F, 
+0xC3, 0x86, 0xB0, 0x0C, 0x12, 0x24, 0xAE, 0x96, 
+0x08, 0x5A, 0xA3, 0x52, 0x86, 0xA1, 0xE9, 0x2F, 
+0xD8, 0x25, 0x76, 0x14, 0x28, 0x18, 0xF3, 0x71, 
+0xC4, 0xBC, 0xE2, 0x98, 0x36, 0x3B, 0x48, 0xC6, 
+0xC8, 0xC7, 0x2D, 0xAB, 0xF6, 0x16, 0x4A, 0x49, 
+0xCF, 0xD3, 0xF5, 0x66, 0xEF, 0xD8, 0x5A, 0x30, 
+0x37, 0x49, 0xA1, 0xF3, 0xC9, 0x97, 0x46, 0x3A, 
+0x80, 0x25, 0x2B, 0x7D, 0x4C, 0xE9, 0x99, 0x1E, 
+0xAC, 0xB6, 0x81, 0x9C, 0xF2, 0x41, 0xF4, 0x99, 
+0xC0, 0x00, 0xD1, 0x19, 0x15, 0x5C, 0xDA, 0x87, 
+0x7F, 0x1E, 0xEC, 0x42, 0xDF, 0x1B, 0x58, 0x4C, 
+0x7C, 0x16, 0x02, 0x58, 0x0C, 0xB5, 0x64, 0xF1, 
+0x67, 0x45, 0x9D, 0xA5, 0x37, 0xB0, 0xED, 0x97, 
+0xD5, 0x22, 0x7B, 0x8D, 0x6E, 0x98, 0xDE, 
+
+0x3C, 0x0A, 0xD0, 0xD4, 0x5C, 0x68, 0x6C, 0xF1, 
+0xF5, 0xD3, 0x3C, 0xD3, 0x42, 0x4D, 0x28, 0x84, 
+0xE5, 0xDA, 0x8D, 0x35, 0xB6, 0x11, 0x0A, 0x06, 
+0xA9, 0xC2, 0x29, 0xED, 0x12, 0x1E, 0x77, 0xE7, 
+0xD6, 0xAD, 0x3D, 0x77, 0x66, 0x0B, 0x34, 0xA9, 
+0xBE, 0xB5, 0x10, 0x5E, 0x01, 0xBD, 0x61, 0x10, 
+0x3A, 0x6E, 0x07, 0xB8, 0x83, 0x3B, 0x6A, 0x03, 
+0xCF, 0x4D, 0x6F, 0xA0, 0x50, 0x9D, 0x48, 0x2E, 
+0x71, 0xA6, 0x42, 0x36, 0x3F, 0x99, 0xD6, 0xFF, 
+0xBE, 0x66, 0xFC, 0xFA, 0x61, 0x70, 0x58, 0x00, 
+0x91, 0xD2, 0x0A, 0x73, 0x31, 0xD2, 0xE5, 0x3A, 
+0xC6, 0xD9, 0xE2, 0xAB, 0xEB, 0x77, 0xFB, 0x6A, 
+0x7D, 0xB5, 0x98, 0x8C, 0xB2, 0x41, 0x18, 0xA9, 
+0x43, 0x84, 0x8E, 0xB7, 0xB2, 0x75, 0x7E, 0x18, 
+0x6B, 0xF8, 0x53, 0xE2, 0xE7, 0x13, 0x69, 0x8C, 
+0xEA, 0xAD, 0x81, 0x1C, 0x62, 0xF3, 0xE5, 0xF0, 
+0xF3, 0x0A, 0x01, 0xBC, 0x3F, 0x8D, 0x59, 0x93, 
+0x85, 0xCB, 0xB6, 0x10, 0xE0, 0x21, 0x85, 0xBD, 
+0x75, 0x96, 0xD6, 0xB7, 0xED, 0x13, 0xBB, 0xFF, 
+0x50, 0xFA, 0x87, 0xF2, 0x86, 0xCE, 0x27, 0x80, 
+0x83, 0xD4, 0xA3, 0x24, 0x90, 0xFD, 0x96, 0x21, 
+0x54, 0xE9, 0xE7, 0xEF, 0xBD, 0x7E, 0xC9, 0x86, 
+0x3B, 0x2A, 0xAC, 0xE2, 0x3D, 0x5D, 0xA7, 0x34, 
+0x42, 0xDC, 0xB6, 0x70, 0x4B, 0x61, 0x29, 0xC7, 
+0x73, 0xFC, 0xB0, 0x49, 0x2B, 0x4D, 0x5F, 0xE7, 
+0x83, 0x2C, 0x7A, 0x4B, 0x43, 0xD3, 0x19, 0x62, 
+0x9A, 0x75, 0xE1, 0xBD, 0x69, 0x50, 0x25, 0x1C, 
+0x65, 0xA3, 0x25, 0xC4, 0x04, 0x55, 0x7C, 0xCB, 
+0x71, 0xB6, 0x0C, 0xD0, 0xEF, 0x18, 0x51, 0xCE, 
+0x47, 0x2F, 0x26, 0xD7, 0xCF, 0xE3, 0x13, 0x72, 
+
+0xD0, 0xAF, 0x7D, 0xF7, 0xE2, 0x55, 0xED, 0xA4, 
+0xA6, 0x7B, 0xF9, 0x0F, 0xF0, 0x64, 0x77, 0xE7, 
+0x58, 0x03, 0x6C, 0xAC, 0xEE, 0x69, 0xFA, 0xFC, 
+0x8A, 0xC9, 0x44, 0x13, 0x92, 0x75, 0xF3, 0x91, 
+0xD8, 0x2F, 0xBB, 0xEA, 0xC8, 0xA6, 0xE4, 0x6D, 
+0x78, 0xD8, 0x72, 0xA0, 0x66, 0x64, 0x40, 0xB4, 
+0xD7, 0x5A, 0xA4, 0x41, 0xFB, 0xBD, 0x08, 0xB4, 
+0x99, 0x48, 0x56, 0x61, 0x21, 0x0F, 0x20, 0x44, 
+0x6F, 0x7B, 0xE3, 0x00, 0xEA, 0x0D, 0x87, 0xE1, 
+0xD1, 0xF3, 0x17, 0x1A, 0xF1, 0x9D, 0xC8, 0xFF, 
+0xEE, 0xFC, 0x0B, 0x42, 0x97, 0xF8, 0xDC, 0xA2, 
+0x0F, 0xD0, 0xD5, 0x31, 0xFB, 0xFF, 0x8D, 0xA4, 
+0xB8, 0x83, 0x01, 0x1E, 0xF8, 0x40, 0xD4, 0x6C, 
+0x78, 0xE7, 0x65, 0xE2, 0x3A, 0xF5, 0x35, 0xF0, 
+0xCC, 0x8E, 0x0A, 0x17, 0xDC, 0x03, 0x2B, 0x75, 
+0xF4, 0x24, 0x90, 0xA4, 0x49, 0x05, 0x8C, 0xC9, 
+0x46, 0xC9, 0x2B, 0xEC, 0x47, 0xFE, 0x48, 0xF5, 
+0xC3, 0x9A, 0x02, 0x97, 0xF2, 0x47, 0x30, 0x2B, 
+0x1B, 0xD2, 0xB1, 0x3B, 0xF0, 0x7F, 0x76, 0x7F, 
+0x31, 0x80, 0x3B, 0x7E, 0x11, 0x3D, 0xFD, 0xAD, 
+0xF6, 0xC8, 0x72, 0x08, 0x0C, 0xFF, 0x4F, 0x65, 
+0xE1, 0xDF, 0x25, 0x45, 0x6A, 0x2D, 0x2F, 0xC9, 
+0x6E, 0xAE, 0x15, 0x91, 0x6F, 0x05, 0xF5, 0xAD, 
+0x59, 0xF9, 0x77, 0x05, 0xDB, 0x80, 0x78, 0xB0, 
+0xCA, 0xFE, 0x41, 0x2D, 0x5E, 0xD9, 0x2A, 0x6B, 
+0xAE, 0xC0, 0xF1, 0xA4, 0x7F, 0xA2, 0x10, 0x23, 
+0x88, 0x66, 0xA3, 0xE9, 0x61, 0x76, 0x1C, 0xB1, 
+0x9C, 0x75, 0xED, 0xBE, 0x25, 0x5F, 0xEF, 0xC6, 
+0x28, 0x30, 0xFC, 0xC5, 0x93, 0x4F, 0xB4, 0x0D, 
+0x93, 0xCA, 0xCE, 0x13, 0xC0, 0xB8, 0x96, 0x4B, 
+0x13, 
+
+0x94, 0xC1, 0x1B, 0x24, 0xA4, 0x15, 0xAC, 0xC3, 
+0x14, 0x88, 0x3D, 0x9C, 0x0F, 0x74, 0x8C, 0x65, 
+0x7B, 0x85, 0x96, 0x0D, 0x03, 0x32, 0x4D, 0xA4, 
+0x78, 0x47, 0x86, 0xE0, 0x6A, 0xEF, 0x86, 0x89, 
+0xB6, 0x54, 0x42, 0x80, 0x95, 0xC3, 0x02, 0x57, 
+0x26, 0x7A, 0x8C, 0x4E, 0x96, 0xC1, 0xAD, 0x0C, 
+0x6A, 0xDB, 0x0B, 0x06, 0x7D, 0x9B, 0x37, 0x64, 
+0xF6, 0xE9, 0x37, 0xF7, 0xF5, 0x0E, 0xAD, 0xAE, 
+0xE3, 0x0B, 0x96, 0xA3, 0x3E, 0x62, 0xD7, 0x4D, 
+0x09, 0x9F, 0xEC, 0xE2, 0xD0, 0x35, 0x59, 0x8F, 
+0x34, 0x88, 0xCD, 0x9B, 0x8E, 0x44, 0x85, 0x81, 
+0xCD, 0x43, 0x98, 0xFA, 0xCB, 0x18, 0xA5, 0x69, 
+0x3F, 0x0B, 0x9F, 0xDA, 0x23, 0xF3, 0x74, 0x9E, 
+0x49, 0x0D, 0x51, 0x7D, 0xF2, 0xAA, 0x5A, 0xF4, 
+0xF0, 0xA8, 0x6F, 0xC6, 0x0A, 0x7E, 0x78, 0xCC, 
+0x38, 0x61, 0x30, 0x3D, 0x74, 0xA7, 0xD3, 0xC6, 
+0x40, 0xDD, 0x14, 0x04, 0x15, 0xC8, 0xC1, 0x32, 
+0x25, 0xC4, 0xF6, 0xF6, 0x8F, 0xD7, 0x7D, 0x2A, 
+0xDB, 0x5A, 0x93, 0xEC, 0x58, 0xB8, 0x9E, 0xE8, 
+0xD4, 0x7D, 0x55, 0x7B, 0x24, 0x7C, 0x6D, 0x4B, 
+0x07, 0x74, 0xE0, 0xC2, 0xB6, 0x30, 0xB4, 0x01, 
+0x50, 0x5E, 0x29, 0x08, 0x35, 0xCD, 0x38, 0xCD, 
+0x88, 0x27, 0xA3, 0x8F, 0xE3, 0x59, 0x79, 0x7B, 
+0xF2, 0x95, 0xDD, 0x98, 0x26, 0x14, 0x11, 0x6E, 
+0x56, 0x9C, 0x1B, 0x99, 0xC6, 0x89, 0x4F, 0x71, 
+0xA9, 0x75, 0xF5, 0x30, 0xB1, 0x79, 0xB0, 0xC4, 
+0x8F, 0x9B, 0xAE, 0x80, 0xEF, 0x5C, 0xE1, 0x85, 
+0xAB, 0x2D, 0x63, 0x83, 0x32, 0x1C, 0x24, 0x13, 
+0x17, 0xF9, 0x75, 0xD1, 0xB8, 0x23, 0xB5, 0x85, 
+0xCD, 0x1C, 0x76, 0x99, 0xC6, 0xBC, 0xAB, 0x73, 
+0xC9, 0x48, 
+
+0x01, 0x20, 0xCE, 0xAF, 0x2F, 0x55, 0x74, 0x3C, 
+0x53, 0x60, 0xCA, 0x85, 0x14, 0x95, 0xA7, 0x2E, 
+0xA6, 0xE6, 0x45, 0x2F, 0x26, 0x5A, 0xF2, 0x55, 
+0xE5, 0xF8, 0x8B, 0x56, 0x28, 0xCC, 0x76, 0x96, 
+0x00, 0x70, 0xCE, 0xAD, 0x56, 0x20, 0x58, 0x18, 
+0xAB, 0xA2, 0x71, 0x19, 0xB3, 0xDC, 0x85, 0x84, 
+0xB7, 0x0A, 0x68, 0x3A, 0x4E, 0x3F, 0xFC, 0x38, 
+0xFA, 0x90, 0xAE, 0x37, 0xA9, 0x92, 0x94, 0x65, 
+0x4C, 0x0E, 0x4C, 0xFE, 0x30, 0x0A, 0xAB, 0xEF, 
+0x77, 0x52, 0xE1, 0xE4, 0xB4, 0x93, 0xA6, 0x27, 
+0x40, 0x96, 0x41, 0x0A, 0xC3, 0x1C, 0x75, 0x01, 
+0x98, 0x09, 0x86, 0x19, 0xDA, 0x93, 0xC9, 0xA8, 
+0x91, 0x50, 0xAF, 0x31, 0x83, 0x1F, 0x69, 0x62, 
+0xB8, 0x95, 0x18, 0xDF, 0x12, 0xEA, 0x4E, 0x0A, 
+0x09, 0x17, 0x18, 0x4A, 0xCE, 0x0D, 0x52, 0x52, 
+0x23, 0xF7, 0x6F, 0x2A, 0x29, 0x70, 0x3C, 0xDD, 
+0x1E, 0xC1, 0x86, 0x06, 0x4F, 0x09, 0xF9, 0x4C, 
+0x7B, 0x62, 0x27, 0x99, 0xDC, 0xFB, 0x82, 0x8E, 
+0x2D, 0xD7, 0xE0, 0x8B, 0xE3, 0x8B, 0x42, 0x53, 
+0xF0, 0x8A, 0x99, 0xDA, 0xFD, 0x73, 0xA2, 0xE2, 
+0x74, 0x02, 0x4C, 0xF0, 0x06, 0x35, 0x78, 0xE4, 
+0xC0, 0x9B, 0xE0, 0xB7, 0x31, 0x79, 0xAC, 0x93, 
+0xC1, 0x5D, 0x69, 0x88, 0x46, 0x7A, 0xE0, 0x8A, 
+0x6C, 0x0F, 0x72, 0xE2, 0x70, 0x55, 0x63, 0x0D, 
+0xDD, 0x7F, 0x16, 0x3E, 0x9E, 0xBD, 0xC8, 0x45, 
+0x25, 0x02, 0x87, 0x7D, 0x83, 0x0B, 0x48, 0xB9, 
+0x14, 0xFB, 0x47, 0x72, 0x91, 0x58, 0x1A, 0x1B, 
+0xD8, 0x61, 0x28, 0xB7, 0x62, 0xA8, 0x4E, 0xAF, 
+0xB5, 0xFE, 0x3B, 0xB2, 0x99, 0x7E, 0xC0, 0x89, 
+0xC1, 0xDD, 0x1F, 0xF4, 0x56, 0x5B, 0xDB, 0x9F, 
+0xBB, 0x26, 0x5F, 
+
+0xF0, 0x9C, 0x24, 0xC2, 0x5A, 0xA9, 0x23, 0xED, 
+0x41, 0x96, 0x51, 0x0F, 0x9E, 0xFD, 0xB0, 0x3B, 
+0x1B, 0xA8, 0x27, 0xE7, 0xB1, 0xA2, 0x56, 0x62, 
+0x7C, 0x56, 0xEE, 0x56, 0xC7, 0xF7, 0xEE, 0xB4, 
+0x22, 0x73, 0x1D, 0x19, 0x45, 0x55, 0xFC, 0xED, 
+0x80, 0x57, 0x8A, 0xF2, 0x99, 0x92, 0x58, 0xD8, 
+0x9C, 0x7A, 0x45, 0xB6, 0xA1, 0xD1, 0x7F, 0xEB, 
+0xD1, 0x9E, 0x8C, 0x68, 0xF1, 0xAB, 0x5F, 0x89, 
+0x1F, 0xB9, 0xFD, 0x8D, 0x52, 0x16, 0xAF, 0x84, 
+0x6C, 0x1C, 0x0C, 0xFA, 0xA8, 0x56, 0x76, 0x3A, 
+0x33, 0x5B, 0x46, 0x6E, 0x16, 0x65, 0xE6, 0xC7, 
+0x6C, 0xBB, 0x0E, 0xE3, 0x33, 0xFC, 0x4E, 0xCC, 
+0x65, 0x5B, 0x4A, 0x4A, 0x51, 0x5A, 0xC6, 0xD9, 
+0x75, 0x66, 0x49, 0x33, 0x35, 0x1A, 0x9D, 0x10, 
+0x37, 0x91, 0x26, 0xC5, 0x8C, 0xA1, 0xC5, 0x1D, 
+0x74, 0x9E, 0x23, 0x79, 0x56, 0x69, 0x4C, 0x2E, 
+0xAE, 0x84, 0xCB, 0x75, 0xC1, 0x35, 0x29, 0x1C, 
+0xEB, 0x4D, 0x80, 0x04, 0x8A, 0x54, 0xD4, 0xAD, 
+0xF2, 0x66, 0x9F, 0xED, 0x37, 0x6B, 0x7A, 0x20, 
+0xAF, 0x28, 0xE9, 0x12, 0x07, 0x22, 0xD6, 0xF7, 
+0xDD, 0x4F, 0xDD, 0x23, 0xE1, 0xFF, 0x65, 0x00, 
+0x97, 0x9C, 0xB4, 0x13, 0x49, 0x9B, 0xA4, 0x81, 
+0x0B, 0xEB, 0x24, 0xB3, 0x38, 0x3C, 0x76, 0x3B, 
+0xC4, 0x55, 0x4C, 0x97, 0x8A, 0x3C, 0x03, 0x7C, 
+0x88, 0x71, 0x4F, 0xBE, 0xC6, 0x3C, 0x42, 0xD6, 
+0xAF, 0xA8, 0xC9, 0xFC, 0x5D, 0xA4, 0xF9, 0xC4, 
+0x0B, 0x5F, 0x24, 0x45, 0x99, 0x40, 0x82, 0x6F, 
+0x3C, 0xD3, 0x3E, 0xD0, 0xDA, 0x11, 0xD9, 0x65, 
+0x3E, 0x02, 0xE8, 0xFA, 0x90, 0x49, 0x95, 0x04, 
+0x1C, 0xC5, 0xF0, 0x30, 0x59, 0x40, 0xC5, 0x78, 
+0xED, 0xAF, 0xAE, 0x4B, 
+
+0xA3, 0x95, 0x43, 0xCB, 0xB3, 0xCF, 0x0F, 0x2F, 
+0x87, 0x10, 0x06, 0x89, 0x6B, 0xAB, 0x42, 0x5E, 
+0x67, 0xC7, 0x2D, 0xC6, 0x12, 0x78, 0x52, 0x6B, 
+0x80, 0x22, 0xC0, 0xEE, 0x81, 0x74, 0x28, 0xB2, 
+0x7F, 0xC6, 0x2E, 0x56, 0x76, 0x3C, 0x88, 0xD5, 
+0x90, 0xF4, 0x34, 0x63, 0xF0, 0x0F, 0x5F, 0xAA, 
+0x33, 0xAB, 0x7B, 0x17, 0xD8, 0x49, 0x48, 0x08, 
+0xFF, 0x0B, 0xA7, 0xE1, 0x8E, 0x7D, 0x8C, 0x05, 
+0xC4, 0x56, 0x8A, 0x12, 0xAB, 0xDC, 0xF3, 0x62, 
+0xEF, 0x0F, 0xC0, 0x6A, 0x81, 0x56, 0x4E, 0xF8, 
+0x37, 0xB2, 0x53, 0xBB, 0x4E, 0x31, 0xCC, 0x3D, 
+0xA5, 0x3D, 0x8A, 0x91, 0xE9, 0xE4, 0xEF, 0xDB, 
+0xE2, 0x10, 0x96, 0x62, 0xA9, 0x0D, 0x7B, 0x6D, 
+0x0D, 0x19, 0xCC, 0x1B, 0xAE, 0x1B, 0xCC, 0xE7, 
+0x04, 0x03, 0x45, 0x50, 0x56, 0xB2, 0x1A, 0xA8, 
+0xF6, 0x24, 0xBC, 0x5C, 0x5E, 0xC7, 0xFC, 0x94, 
+0xF0, 0x49, 0xFC, 0xF6, 0x77, 0x8D, 0x41, 0x33, 
+0xAC, 0x08, 0x97, 0x92, 0x40, 0x07, 0x8D, 0x05, 
+0x0C, 0xA1, 0xE5, 0x82, 0x6C, 0xCF, 0xC9, 0x28, 
+0x00, 0x71, 0xBA, 0x75, 0x3E, 0x7C, 0xB2, 0x78, 
+0xC0, 0x55, 0x1A, 0x6F, 0x3C, 0xC6, 0x91, 0x28, 
+0x80, 0x04, 0x99, 0xE5, 0x32, 0xC1, 0x1F, 0x2B, 
+0xAC, 0x47, 0x0C, 0xB5, 0x31, 0xCA, 0x8E, 0xB8, 
+0xDC, 0x02, 0x6A, 0x19, 0x58, 0x57, 0x26, 0x6B, 
+0x2C, 0xE7, 0xAB, 0xA4, 0xFC, 0x2C, 0x50, 0xC5, 
+0x3D, 0xD6, 0x7B, 0x87, 0x1B, 0xD0, 0x8A, 0xAC, 
+0x87, 0xB5, 0xA6, 0x88, 0x33, 0x5F, 0x28, 0x43, 
+0x88, 0xAB, 0x56, 0x87, 0x30, 0x14, 0xD6, 0x2E, 
+0x0D, 0x6D, 0xA6, 0x10, 0x68, 0x3A, 0xF9, 0x91, 
+0x5A, 0x20, 0x10, 0x68, 0xE9, 0xA2, 0x05, 0x35, 
+0xDA, 0xF7, 0x52, 0x6A, 0x88, 
+
+0x78, 0xC4, 0xC1, 0x71, 0x17, 0xF4, 0xF9, 0x68, 
+0x9F, 0x3F, 0x55, 0x75, 0xE2, 0xA9, 0x39, 0xBC, 
+0x48, 0x0F, 0x5D, 0xFD, 0x7E, 0x05, 0xA4, 0xC3, 
+0x51, 0x63, 0x67, 0xDE, 0x61, 0x8F, 0x31, 0x44, 
+0x4C, 0x67, 0x9E, 0xC4, 0x1E, 0xB1, 0xEA, 0xAB, 
+0x37, 0x8F, 0x54, 0x6E, 0xC7, 0x18, 0xE5, 0x7B, 
+0x7E, 0xEA, 0xEC, 0x05, 0xDA, 0x6D, 0xC5, 0x87, 
+0x00, 0x51, 0x2A, 0x9E, 0xEB, 0xFC, 0x49, 0x44, 
+0xB4, 0x09, 0x71, 0xFC, 0x58, 0x8F, 0x0B, 0xDD, 
+0x5C, 0x08, 0x7E, 0x65, 0xE3, 0x65, 0xEA, 0x1A, 
+0x82, 0x66, 0x89, 0x42, 0xDD, 0x74, 0x9C, 0xD5, 
+0x14, 0xEA, 0x5C, 0xEC, 0xA0, 0x56, 0xFF, 0x79, 
+0x6D, 0x38, 0x10, 0xA8, 0x92, 0x38, 0x59, 0xA6, 
+0x38, 0xB9, 0x14, 0xA2, 0x12, 0xB2, 0x7E, 0xFA, 
+0x50, 0x2A, 0xDA, 0xDB, 0x6A, 0x5F, 0x15, 0xA1, 
+0x80, 0x09, 0x2F, 0x3F, 0xFE, 0xF6, 0x89, 0xD6, 
+0x4C, 0x5B, 0x64, 0xBB, 0xA0, 0x4B, 0xAA, 0x68, 
+0x23, 0x9F, 0x97, 0xB9, 0xFE, 0x67, 0x21, 0x62, 
+0x5C, 0xAA, 0xB0, 0xEF, 0x49, 0x7E, 0x13, 0x82, 
+0x31, 0xC1, 0x7C, 0xB6, 0x77, 0x7E, 0x1F, 0x88, 
+0x11, 0xEA, 0x02, 0xE6, 0xEC, 0x58, 0xE3, 0x44, 
+0xBF, 0x40, 0x74, 0xD5, 0xE6, 0x95, 0xAE, 0x34, 
+0x5D, 0x3F, 0x14, 0x50, 0xC7, 0x03, 0xF6, 0xF9, 
+0xDD, 0x43, 0xDF, 0x92, 0xB0, 0x3D, 0xF6, 0x56, 
+0x8F, 0xFA, 0x1D, 0x5B, 0x72, 0x26, 0xB4, 0x0E, 
+0x33, 0xA2, 0xFE, 0x45, 0x6F, 0x02, 0xD3, 0x57, 
+0xA0, 0xFE, 0x47, 0xEA, 0xC8, 0x80, 0x36, 0x26, 
+0x74, 0x6B, 0x71, 0x91, 0xFC, 0x17, 0x44, 0x82, 
+0x59, 0xA6, 0xB7, 0xA5, 0xB5, 0x9A, 0xEF, 0xC2, 
+0x8A, 0x40, 0x68, 0x48, 0x44, 0xDE, 0x77, 0x5C, 
+0x61, 0xAC, 0x6B, 0x8F, 0x6C, 0x79, 
+
+0xCB, 0x63, 0x58, 0xBC, 0xD0, 0xCE, 0x5A, 0x6F, 
+0xF3, 0x4A, 0x66, 0xEA, 0xBC, 0xB2, 0x12, 0x8E, 
+0xD4, 0x04, 0xA3, 0x07, 0x6E, 0x9A, 0x41, 0x66, 
+0x08, 0x19, 0x6A, 0xA9, 0x24, 0x8D, 0x0B, 0xDF, 
+0xB4, 0xD9, 0xE7, 0xFD, 0x1F, 0x30, 0xFA, 0x31, 
+0xEF, 0x5A, 0x8F, 0xE3, 0x99, 0xC7, 0x73, 0x0F, 
+0xC4, 0x21, 0x58, 0x98, 0x7F, 0x75, 0x12, 0x98, 
+0xDA, 0x31, 0x99, 0xC3, 0x44, 0xD7, 0xFA, 0x42, 
+0x75, 0x93, 0x22, 0x92, 0x68, 0xE4, 0xC4, 0x62, 
+0x69, 0x3A, 0xE2, 0x51, 0x4A, 0xA1, 0x30, 0xAB, 
+0xBD, 0x4B, 0xFA, 0x40, 0x1D, 0xD5, 0xBF, 0xA0, 
+0x5D, 0xBF, 0x57, 0xB3, 0xED, 0x6F, 0x9C, 0x2B, 
+0xBA, 0xEA, 0x01, 0x28, 0x29, 0x89, 0x67, 0xB5, 
+0x76, 0xFD, 0x0F, 0xEE, 0xB0, 0x94, 0x17, 0x90, 
+0xED, 0xB4, 0xB0, 0x27, 0xF4, 0x8E, 0x60, 0x61, 
+0x00, 0xD8, 0x6E, 0xAA, 0x89, 0xD5, 0xFC, 0x89, 
+0x1F, 0xC8, 0x05, 0x49, 0x83, 0x17, 0x74, 0xDD, 
+0xC2, 0xDA, 0xCD, 0x6D, 0xA5, 0x92, 0x94, 0x5B, 
+0x60, 0x96, 0xCB, 0x1E, 0x85, 0x8E, 0x48, 0xD2, 
+0x65, 0xA4, 0x4A, 0xE4, 0x30, 0x12, 0xBC, 0x84, 
+0x63, 0xA0, 0xBA, 0xEB, 0x5B, 0xBE, 0x7D, 0x85, 
+0x13, 0x59, 0xB4, 0x90, 0x0B, 0x6A, 0xEB, 0x50, 
+0x7A, 0x02, 0x06, 0xF5, 0x1C, 0xA0, 0x45, 0xC0, 
+0xE4, 0x26, 0x96, 0xAD, 0xC8, 0x1A, 0x2D, 0x22, 
+0xA5, 0xCA, 0xFF, 0x44, 0x6D, 0xB8, 0x65, 0x62, 
+0x7E, 0x03, 0x16, 0x33, 0xB9, 0x8A, 0x16, 0xDE, 
+0x39, 0xE2, 0x1C, 0x5B, 0x24, 0xCD, 0x63, 0xB6, 
+0x5A, 0x62, 0xAB, 0xE7, 0xF0, 0xA6, 0xBE, 0x9D, 
+0xAC, 0x45, 0x5E, 0x20, 0x23, 0x83, 0x33, 0x7A, 
+0x16, 0x95, 0xF2, 0xE9, 0xD2, 0x20, 0x98, 0xB2, 
+0xE5, 0x2F, 0x02, 0xED, 0x2C, 0xB4, 0x96, 
+
+0xD4, 0x26, 0x9C, 0x0F, 0x09, 0xAA, 0xDD, 0x1F, 
+0x0A, 0xAA, 0xEE, 0xAD, 0x67, 0xFA, 0x54, 0x5A, 
+0xD0, 0x68, 0x93, 0x69, 0xF4, 0x6C, 0xCC, 0xC2, 
+0xD6, 0xE8, 0x93, 0x35, 0x71, 0xA0, 0x2B, 0x9A, 
+0x29, 0x99, 0x83, 0x1B, 0xCF, 0x37, 0x7B, 0x6D, 
+0x5A, 0xDD, 0x4F, 0x34, 0xAA, 0x1D, 0xAD, 0x70, 
+0x33, 0xA3, 0xFB, 0xEC, 0xA2, 0xEA, 0xFF, 0xE4, 
+0xFE, 0x99, 0x86, 0x94, 0xD2, 0x97, 0x15, 0xFE, 
+0xD3, 0x3C, 0xE6, 0x7D, 0xF9, 0x32, 0x8F, 0xEC, 
+0x4D, 0x45, 0x9A, 0xF2, 0xA3, 0xCF, 0xE9, 0xE6, 
+0x9F, 0xD5, 0xB6, 0x48, 0xBA, 0x82, 0x34, 0x17, 
+0x15, 0x49, 0x76, 0xEC, 0x1A, 0x84, 0x53, 0xD4, 
+0x1C, 0xE1, 0xDB, 0x9C, 0x67, 0x66, 0x98, 0x81, 
+0x06, 0x64, 0x6F, 0xAE, 0xD3, 0x28, 0x66, 0x0F, 
+0x33, 0x00, 0x76, 0xAF, 0xA5, 0xAE, 0x74, 0xA2, 
+0xBB, 0xF2, 0x70, 0x92, 0x92, 0x35, 0x19, 0xC8, 
+0x01, 0x40, 0x53, 0x82, 0xD8, 0xE9, 0x32, 0x3B, 
+0xC1, 0x32, 0x7C, 0x8A, 0xF6, 0x3C, 0xE5, 0x74, 
+0x4B, 0xFD, 0x38, 0x06, 0xF2, 0x82, 0xBB, 0x31, 
+0x73, 0x5D, 0x1D, 0x95, 0x33, 0x31, 0x04, 0x29, 
+0xD2, 0x88, 0x8A, 0x89, 0x14, 0xB7, 0x16, 0x3E, 
+0x25, 0xF7, 0x7A, 0x9F, 0x09, 0x0D, 0xDD, 0x12, 
+0x54, 0x50, 0x5D, 0x5E, 0xE4, 0x81, 0x5A, 0x28, 
+0xC7, 0x9D, 0xBF, 0x78, 0xEF, 0xE3, 0x3A, 0x7B, 
+0x41, 0x19, 0x14, 0x6A, 0xAC, 0xB6, 0xB5, 0x54, 
+0x5D, 0x53, 0x14, 0x09, 0xBB, 0x0A, 0xB7, 0x6B, 
+0xFA, 0xEC, 0x2E, 0xB2, 0x14, 0x76, 0x2C, 0xB9, 
+0xF3, 0xFC, 0xBE, 0xED, 0x58, 0xE6, 0xB4, 0x7A, 
+0xB1, 0x05, 0x56, 0x26, 0xF3, 0x63, 0x26, 0x23, 
+0xA4, 0x9F, 0xB9, 0x06, 0xA7, 0xBA, 0x59, 0x0C, 
+0x25, 0x91, 0x2A, 0x58, 0x42, 0x7B, 0x85, 0x3B, 
+
+0x34, 0xB5, 0x93, 0xA2, 0x6F, 0xBC, 0x7D, 0x5B, 
+0x36, 0x67, 0x7A, 0xCE, 0x77, 0x3D, 0x9D, 0x82, 
+0x6F, 0xC0, 0x12, 0x1C, 0xF3, 0xC5, 0x86, 0x13, 
+0xAB, 0xD1, 0x33, 0xE2, 0x0C, 0x2C, 0xC6, 0xBC, 
+0x4D, 0x86, 0x66, 0xB1, 0xC6, 0x5E, 0x82, 0x78, 
+0xD9, 0x1B, 0x58, 0x0A, 0x32, 0x5C, 0x83, 0xD5, 
+0xC0, 0xBD, 0xE5, 0x91, 0x2C, 0xB3, 0xF2, 0x94, 
+0x0D, 0x9B, 0x5A, 0x7F, 0xF8, 0x2A, 0xEC, 0x09, 
+0x80, 0x27, 0x0A, 0x7D, 0x0E, 0x44, 0x27, 0x58, 
+0x7B, 0xE5, 0x64, 0xC2, 0x48, 0xA9, 0x04, 0xEA, 
+0x91, 0x9D, 0x40, 0xCA, 0x53, 0xB7, 0x66, 0x7D, 
+0x10, 0x8E, 0xFE, 0x8C, 0x44, 0x70, 0x9A, 0x35, 
+0xB2, 0xC8, 0x22, 0x8F, 0x4C, 0xD1, 0x51, 0x26, 
+0x76, 0x18, 0xC6, 0x6A, 0x30, 0xA9, 0x0C, 0x41, 
+0xB0, 0x8E, 0xB1, 0x5B, 0x5D, 0xA7, 0x08, 0x7A, 
+0x5F, 0x7B, 0x3A, 0x54, 0x9F, 0x31, 0xE6, 0x37, 
+0xFD, 0x73, 0xBD, 0x68, 0x4B, 0x2D, 0x9E, 0xA0, 
+0xDA, 0xC2, 0xFD, 0xEB, 0xFA, 0x35, 0x2E, 0x7F, 
+0x9D, 0x7C, 0xFE, 0x76, 0x95, 0x00, 0x10, 0x4C, 
+0x95, 0x33, 0xEE, 0x8B, 0x09, 0x5E, 0x44, 0x59, 
+0x4B, 0x16, 0x02, 0x4F, 0x25, 0x37, 0xCC, 0x9F, 
+0xD0, 0x95, 0xD9, 0x9C, 0x7D, 0x6D, 0xD2, 0xDD, 
+0x12, 0x41, 0x92, 0x6E, 0x91, 0x67, 0x41, 0xC0, 
+0xAD, 0xAC, 0xDE, 0x41, 0x81, 0x9F, 0x38, 0xEE, 
+0xF2, 0x12, 0xAE, 0xFE, 0xDE, 0xF8, 0xE1, 0x6C, 
+0x26, 0x06, 0x8F, 0x29, 0xB0, 0xB5, 0x2D, 0x97, 
+0xDA, 0x74, 0xCD, 0x75, 0x06, 0xF3, 0x33, 0xE0, 
+0x46, 0x32, 0x41, 0x5A, 0xB7, 0xA7, 0xD5, 0xA8, 
+0x95, 0xFE, 0xC7, 0xBF, 0xB2, 0x0E, 0x13, 0xC0, 
+0xC2, 0x20, 0x47, 0x49, 0x88, 0xA6, 0x42, 0x56, 
+0x52, 0xBA, 0xA7, 0xCB, 0xF8, 0xBE, 0x8A, 0x39, 
+0x75, 
+
+0x46, 0x95, 0xBB, 0xDE, 0x93, 0xF7, 0xC1, 0x83, 
+0x4D, 0x5F, 0x31, 0x79, 0xBB, 0x13, 0x07, 0x57, 
+0x81, 0x4A, 0xBA, 0xDE, 0xA2, 0x9F, 0x6E, 0x60, 
+0x5F, 0xA9, 0x76, 0xCC, 0x2F, 0x7F, 0x62, 0x2D, 
+0x87, 0x29, 0x19, 0x84, 0xF2, 0xEF, 0x19, 0x93, 
+0x0D, 0xF4, 0x7F, 0xDD, 0xD6, 0x80, 0x8F, 0xE2, 
+0x3A, 0x88, 0xAE, 0x1F, 0x58, 0x8E, 0x82, 0x28, 
+0x43, 0xA9, 0x7A, 0x00, 0x74, 0xB0, 0x20, 0x27, 
+0xC3, 0x91, 0x09, 0x85, 0xC8, 0x6D, 0xBA, 0xEC, 
+0xF5, 0x16, 0xE9, 0xF8, 0x29, 0x98, 0x26, 0xE4, 
+0x48, 0x9D, 0xF5, 0x51, 0xF9, 0x9D, 0xBF, 0x95, 
+0x1A, 0x08, 0x90, 0xD8, 0xAD, 0xE6, 0x04, 0x03, 
+0xAA, 0x80, 0xAF, 0x24, 0x20, 0x70, 0x32, 0x41, 
+0x45, 0x52, 0xDD, 0xB4, 0x1A, 0xE2, 0x27, 0xBE, 
+0xEA, 0xE6, 0xCE, 0x74, 0x53, 0xBC, 0xB6, 0x3E, 
+0x36, 0x21, 0x3B, 0x07, 0x81, 0x7E, 0xAF, 0xAE, 
+0xDB, 0x61, 0x1C, 0xF5, 0x89, 0xAA, 0x5A, 0xB0, 
+0xE9, 0x79, 0x0D, 0x64, 0x53, 0xE4, 0xFF, 0x5F, 
+0x94, 0x68, 0xD4, 0xDB, 0x7C, 0xD1, 0x51, 0x9C, 
+0x47, 0xEA, 0x9E, 0xAB, 0x16, 0x9B, 0xF1, 0x36, 
+0xAD, 0x3E, 0x1E, 0x75, 0x6D, 0x32, 0x24, 0x80, 
+0xCB, 0x44, 0x62, 0x5A, 0xA0, 0xDC, 0x0D, 0x95, 
+0xB7, 0xF9, 0x29, 0x93, 0xF7, 0xFD, 0xE7, 0x9C, 
+0xA1, 0x01, 0xAA, 0xCF, 0xB6, 0x06, 0x4B, 0x5C, 
+0x14, 0x2D, 0x66, 0x03, 0x07, 0x31, 0xC8, 0xB4, 
+0xD3, 0x4C, 0x2A, 0x89, 0x80, 0x15, 0xD0, 0x31, 
+0xC4, 0xCA, 0x12, 0x55, 0x13, 0x97, 0x23, 0xDD, 
+0x70, 0x1A, 0xA0, 0xE4, 0x86, 0x4B, 0x68, 0x2C, 
+0x2B, 0x3F, 0x4F, 0x84, 0xF3, 0x82, 0x4D, 0xF5, 
+0xF6, 0x54, 0x5A, 0x17, 0xDB, 0xC2, 0x4F, 0x47, 
+0x48, 0xBF, 0x76, 0x75, 0x4F, 0xE2, 0xBF, 0x8B, 
+0x3F, 0x08, 
+
+0xF8, 0x13, 0x3F, 0x65, 0x76, 0x4C, 0x38, 0xB0, 
+0xB1, 0x81, 0x49, 0x66, 0xBE, 0x05, 0xAC, 0xBD, 
+0xEE, 0xE2, 0xCF, 0x70, 0xDB, 0x97, 0x09, 0x55, 
+0xAC, 0x93, 0xB1, 0x3E, 0x1A, 0x45, 0x21, 0xDC, 
+0x65, 0x0E, 0xDA, 0x44, 0xFB, 0x9A, 0xF1, 0x66, 
+0xDD, 0x87, 0xC4, 0x85, 0x8D, 0xDE, 0x4E, 0x1E, 
+0xBE, 0xEB, 0xD6, 0x0F, 0x07, 0x53, 0x3F, 0x4A, 
+0x2F, 0x5C, 0xCF, 0xFE, 0x50, 0xAC, 0x35, 0x22, 
+0xD7, 0xCD, 0x1B, 0xFE, 0x49, 0x2E, 0x99, 0x45, 
+0x03, 0x47, 0xBC, 0xDF, 0x2C, 0xB2, 0x39, 0x4B, 
+0xF1, 0x94, 0xCA, 0x1F, 0x24, 0x8E, 0xBD, 0x9E, 
+0x91, 0xCE, 0x43, 0x25, 0xD9, 0x61, 0x4F, 0x90, 
+0xAA, 0xBC, 0x34, 0x26, 0x66, 0x11, 0x4A, 0xD6, 
+0xDA, 0x28, 0xCE, 0x40, 0x81, 0x10, 0x60, 0x6F, 
+0xB6, 0x3F, 0xD8, 0xB2, 0x7C, 0xED, 0x6B, 0x01, 
+0x00, 0xC4, 0x56, 0x26, 0x84, 0x0A, 0x0C, 0xE6, 
+0x75, 0xA4, 0xB9, 0xFD, 0xB3, 0x09, 0x3C, 0x5A, 
+0x22, 0x48, 0xCD, 0xD6, 0x82, 0xEC, 0xC3, 0x69, 
+0xDF, 0xAD, 0xB5, 0x78, 0xD5, 0x37, 0x05, 0x3D, 
+0xED, 0x0A, 0x4C, 0x02, 0xEA, 0x92, 0x09, 0x0D, 
+0x9C, 0xFC, 0xD7, 0xBC, 0x20, 0x21, 0x33, 0x34, 
+0x23, 0x7F, 0xA4, 0x44, 0x3E, 0x59, 0x19, 0x0D, 
+0xAA, 0xCE, 0xE8, 0x27, 0x9D, 0x98, 0xA4, 0xA2, 
+0xA3, 0xD6, 0x9C, 0x38, 0x56, 0xD3, 0x29, 0xD6, 
+0xDC, 0xE5, 0x5B, 0x40, 0x02, 0xE4, 0xD9, 0x7C, 
+0x73, 0x67, 0x08, 0xFB, 0xC7, 0x99, 0xC2, 0x76, 
+0x4B, 0x7A, 0x2B, 0x83, 0xE6, 0x0C, 0xB8, 0xCC, 
+0xA5, 0x83, 0x8B, 0xDB, 0x15, 0xB1, 0xC4, 0xD7, 
+0x57, 0xC3, 0xC9, 0x52, 0xC2, 0x92, 0x88, 0x64, 
+0xED, 0xD7, 0x19, 0xB0, 0x51, 0x07, 0x45, 0x27, 
+0x51, 0xA4, 0xE8, 0x10, 0xB9, 0x1A, 0x1A, 0xE8, 
+0x5E, 0x08, 0x18, 
+
+0x0F, 0xE0, 0x3F, 0xB3, 0x85, 0x6B, 0x0D, 0x00, 
+0xDA, 0xE1, 0x96, 0x8E, 0x0F, 0xFA, 0x1A, 0xBC, 
+0xA5, 0x1B, 0x11, 0x4A, 0xF9, 0x4C, 0xFC, 0x03, 
+0x2F, 0xD6, 0x5A, 0x5F, 0xEF, 0xAD, 0x52, 0x85, 
+0xDB, 0xDE, 0x96, 0x2E, 0x60, 0x0A, 0x67, 0x29, 
+0x7F, 0xF3, 0xF8, 0xA4, 0xF4, 0x67, 0x61, 0xAB, 
+0x09, 0x99, 0x02, 0xD4, 0x5D, 0x4B, 0xB1, 0x08, 
+0x59, 0x6A, 0x6F, 0x9E, 0x45, 0x92, 0x40, 0x85, 
+0xFB, 0xA6, 0x66, 0x18, 0xCF, 0x2D, 0x6D, 0x97, 
+0xF4, 0x74, 0xB9, 0x74, 0x03, 0xD9, 0x81, 0x8F, 
+0x3E, 0x9C, 0x93, 0x58, 0x4D, 0x48, 0x3B, 0x10, 
+0xD8, 0xFB, 0xDD, 0x16, 0x38, 0x0E, 0x46, 0xA4, 
+0x50, 0xF2, 0x01, 0x77, 0xB3, 0x44, 0x7A, 0x42, 
+0x0C, 0x21, 0xB3, 0x08, 0x1B, 0x85, 0x0B, 0x5F, 
+0x29, 0xB0, 0x71, 0xE0, 0xCB, 0xD2, 0xE4, 0x4A, 
+0xBE, 0x6F, 0xB6, 0x24, 0x59, 0x44, 0xFA, 0x17, 
+0xDC, 0x7F, 0x7C, 0xE3, 0x6D, 0xC2, 0xB8, 0x12, 
+0xDC, 0xC2, 0xA8, 0x2F, 0xFD, 0xA0, 0x00, 0x3A, 
+0xD4, 0x12, 0x04, 0x82, 0xC7, 0x3E, 0x63, 0x9F, 
+0x14, 0x9E, 0x8E, 0x4A, 0x35, 0xE8, 0x99, 0x77, 
+0x80, 0x52, 0xDB, 0xCD, 0x65, 0xAC, 0x39, 0x08, 
+0xAD, 0x23, 0x7C, 0x09, 0xB1, 0x2B, 0xBA, 0x1F, 
+0x21, 0x9B, 0x13, 0x5B, 0x4F, 0xDB, 0xFC, 0x54, 
+0x6F, 0xC8, 0x6F, 0xFE, 0x8A, 0x69, 0xF3, 0x0C, 
+0x96, 0x40, 0xB5, 0xDE, 0x50, 0x16, 0x13, 0x63, 
+0x22, 0x82, 0x46, 0x13, 0x12, 0x47, 0x7F, 0xE6, 
+0x55, 0x6B, 0x8B, 0x02, 0x03, 0x7A, 0x54, 0xB3, 
+0xB0, 0x29, 0xF5, 0x29, 0x32, 0x21, 0x4F, 0x5D, 
+0x38, 0x33, 0xD1, 0xE5, 0x2C, 0x01, 0x7D, 0x2E, 
+0xB4, 0x47, 0x77, 0xAE, 0x37, 0x3D, 0xB3, 0x50, 
+0xD7, 0xB2, 0x22, 0x97, 0x7C, 0x33, 0xE1, 0x94, 
+0x40, 0xC8, 0x8F, 0xB0, 
+
+0xD9, 0x08, 0xF2, 0x77, 0xC9, 0x28, 0x50, 0x0A, 
+0xB4, 0x7A, 0x23, 0x82, 0xB1, 0xCF, 0x93, 0x06, 
+0x05, 0x7E, 0x3D, 0xD2, 0x6A, 0xBF, 0x9D, 0x21, 
+0x45, 0x8C, 0x90, 0x94, 0x57, 0x23, 0x75, 0x89, 
+0xB5, 0x1C, 0x2C, 0x85, 0x58, 0x96, 0xB8, 0xF4, 
+0x39, 0x9E, 0x7A, 0xB5, 0x90, 0xAA, 0x65, 0xD5, 
+0x83, 0xA9, 0x97, 0xFA, 0x2E, 0xB6, 0x21, 0x24, 
+0x69, 0x6C, 0x26, 0x91, 0x54, 0x27, 0x7F, 0x04, 
+0xB4, 0xD3, 0x4C, 0x5A, 0xAC, 0xF8, 0xB4, 0xA5, 
+0xDC, 0x28, 0x11, 0x38, 0x47, 0x69, 0xED, 0x11, 
+0x4F, 0x27, 0x93, 0x1B, 0x62, 0xCC, 0xD5, 0xBB, 
+0xC0, 0x01, 0xF9, 0x1E, 0x3C, 0x60, 0x43, 0x82, 
+0x64, 0x76, 0x3B, 0xA1, 0xCC, 0x8A, 0x47, 0x32, 
+0x25, 0x3A, 0xDD, 0x97, 0x23, 0x93, 0xA0, 0x0B, 
+0x40, 0xA4, 0x8C, 0xE8, 0xC8, 0x75, 0x47, 0x5D, 
+0x25, 0x7D, 0x2D, 0x40, 0x43, 0x60, 0x2A, 0xF8, 
+0x50, 0xC9, 0x93, 0x04, 0xD3, 0xFC, 0xD1, 0xFA, 
+0x78, 0x3E, 0x58, 0x86, 0x62, 0x11, 0xF6, 0x85, 
+0xCA, 0xAA, 0x79, 0x35, 0xE0, 0xDB, 0xD6, 0xC8, 
+0xA7, 0x08, 0x21, 0x2B, 0xA7, 0x3F, 0x0E, 0xB2, 
+0xB0, 0x71, 0xF4, 0x74, 0x02, 0x83, 0x98, 0x2D, 
+0x8A, 0x2B, 0x4A, 0x4E, 0x29, 0xFD, 0xBD, 0x19, 
+0x10, 0x0B, 0xE9, 0xF7, 0xC5, 0x01, 0xB8, 0x44, 
+0x29, 0xDA, 0x8E, 0x3C, 0x07, 0x87, 0xE3, 0xF6, 
+0x6B, 0x2C, 0x6A, 0x53, 0x4B, 0xA7, 0x70, 0xED, 
+0x92, 0xA1, 0x30, 0x05, 0x34, 0x66, 0xF7, 0x3D, 
+0xC5, 0x01, 0x6C, 0x68, 0xCB, 0xC8, 0x89, 0x15, 
+0x17, 0x24, 0x58, 0x82, 0xDF, 0x9D, 0x8C, 0x20, 
+0x9E, 0x61, 0x65, 0xFA, 0xE1, 0xDD, 0x1B, 0xC5, 
+0xA8, 0xE8, 0xFD, 0x4F, 0x3A, 0xA6, 0xD9, 0x9E, 
+0xF4, 0xCB, 0x1D, 0x14, 0x4F, 0xB9, 0x8A, 0x7F, 
+0xF6, 0x4A, 0x8F, 0xED, 0x87, 
+
+0x1B, 0x7F, 0x5F, 0xE5, 0xBC, 0x7E, 0xF4, 0xF1, 
+0xF1, 0x97, 0x06, 0x7C, 0x01, 0x78, 0x28, 0x0E, 
+0x93, 0x5B, 0xEC, 0x9F, 0xA8, 0xC9, 0x0D, 0xBE, 
+0xA6, 0xA5, 0x83, 0xF7, 0xF9, 0x7D, 0xD1, 0xFB, 
+0xB7, 0xBE, 0xFC, 0x72, 0xC2, 0xDE, 0xC3, 0x08, 
+0x76, 0x9F, 0x28, 0x82, 0x42, 0x94, 0x0E, 0xD6, 
+0xFA, 0x2C, 0xD1, 0x7A, 0xB4, 0x13, 0x0B, 0x48, 
+0x6D, 0x78, 0x42, 0x30, 0xD5, 0xB6, 0x96, 0xB5, 
+0x46, 0x88, 0x4B, 0xD7, 0x4F, 0x9D, 0xC0, 0x14, 
+0xC3, 0x95, 0x02, 0x55, 0x59, 0x5A, 0xC5, 0x51, 
+0xB3, 0x84, 0xF4, 0x37, 0xF7, 0x72, 0x9A, 0x75, 
+0x0F, 0x13, 0x69, 0xAC, 0x36, 0xE2, 0x65, 0x96, 
+0x17, 0xB5, 0x3A, 0x00, 0xB8, 0x4C, 0x19, 0x58, 
+0x9E, 0x5B, 0xE4, 0x2E, 0x39, 0x2E, 0x15, 0xBB, 
+0xEE, 0x3A, 0x35, 0xD0, 0x40, 0x10, 0x4A, 0xD9, 
+0xBF, 0xA4, 0x05, 0xFC, 0x67, 0x32, 0x40, 0xFE, 
+0x34, 0x93, 0xA5, 0xB1, 0x30, 0x47, 0x5A, 0x90, 
+0xFF, 0x9A, 0x22, 0x0D, 0xC2, 0x77, 0xA0, 0x82, 
+0xFA, 0x17, 0x36, 0x0D, 0xB0, 0xF4, 0xB3, 0x6C, 
+0xD7, 0xFF, 0x94, 0x08, 0xF5, 0x50, 0x6C, 0x97, 
+0xC0, 0xFA, 0xFA, 0xBC, 0xAC, 0x63, 0x27, 0x72, 
+0x02, 0x46, 0x2B, 0xDF, 0x84, 0x8C, 0x3F, 0x23, 
+0xD8, 0x31, 0x10, 0xFF, 0x1A, 0x27, 0xE0, 0xE0, 
+0x21, 0xFE, 0x0D, 0x3D, 0xC6, 0x38, 0x22, 0x9C, 
+0x21, 0xFC, 0x3C, 0xAD, 0x6F, 0x9F, 0xF9, 0x33, 
+0xBD, 0x14, 0x60, 0x3E, 0xA7, 0xAB, 0x71, 0x8F, 
+0xC4, 0x89, 0xA2, 0xF4, 0xE2, 0xD6, 0xA3, 0x65, 
+0x3D, 0x51, 0xCF, 0x28, 0x18, 0x18, 0x8A, 0x3F, 
+0xFE, 0x42, 0x72, 0x03, 0x50, 0x09, 0x30, 0x27, 
+0x85, 0xE9, 0x67, 0xC1, 0x23, 0x84, 0x64, 0xAF, 
+0xF4, 0x8D, 0xF4, 0x51, 0x15, 0x0E, 0xEB, 0xC9, 
+0x56, 0xAE, 0xA8, 0xBA, 0xC5, 0xA7, 
+
+0xD7, 0x2F, 0xEA, 0x52, 0x53, 0xB0, 0xA9, 0xEE, 
+0xF6, 0xE5, 0x3E, 0x53, 0xEF, 0x9B, 0xBF, 0xD7, 
+0x04, 0x5A, 0x1B, 0x7F, 0xE2, 0x27, 0xBA, 0xDF, 
+0xE1, 0x28, 0x2D, 0xDC, 0xAB, 0xB0, 0x4D, 0x32, 
+0xE0, 0x92, 0xAD, 0xEB, 0xBF, 0x3A, 0xC8, 0xA8, 
+0x8E, 0x39, 0x09, 0xF3, 0xDD, 0x24, 0xF5, 0xB2, 
+0xEB, 0xE2, 0x60, 0x27, 0x16, 0xF1, 0x54, 0x2F, 
+0xC9, 0xC3, 0x31, 0x41, 0x43, 0x41, 0x45, 0x55, 
+0x10, 0xFE, 0xA3, 0x28, 0x7F, 0x74, 0x97, 0xFB, 
+0x72, 0x75, 0xD2, 0x1E, 0x00, 0x39, 0x97, 0x66, 
+0xF2, 0x00, 0x9F, 0xD8, 0x9C, 0xBB, 0x2C, 0x96, 
+0x15, 0x39, 0xC5, 0xF6, 0xD1, 0xD8, 0x33, 0xDD, 
+0xC2, 0xB1, 0x4D, 0x67, 0x34, 0x61, 0x22, 0x46, 
+0xE9, 0x7B, 0xCF, 0x8B, 0x40, 0xD0, 0x31, 0x75, 
+0x74, 0x3C, 0x0D, 0x42, 0x4C, 0x4F, 0x32, 0xFC, 
+0x92, 0xCA, 0xE4, 0xB0, 0x38, 0x68, 0xCE, 0x0D, 
+0xC9, 0xD6, 0x1E, 0x3D, 0x89, 0x91, 0x0F, 0x66, 
+0xFD, 0x1E, 0x22, 0xE6, 0x73, 0xC6, 0x96, 0xE3, 
+0x9C, 0x1F, 0x9D, 0xC4, 0xF2, 0x54, 0x54, 0xFD, 
+0x87, 0x6A, 0x3B, 0x9B, 0xF2, 0x5F, 0xF0, 0xE8, 
+0x3C, 0x65, 0x1F, 0x0D, 0x55, 0xAB, 0x78, 0x39, 
+0x6E, 0x41, 0xE2, 0x20, 0xA8, 0xEC, 0x9B, 0xEA, 
+0xD8, 0xAA, 0xB6, 0x34, 0x32, 0x45, 0xA4, 0xA2, 
+0x84, 0x0D, 0x9E, 0xA0, 0x9E, 0x20, 0x43, 0xDD, 
+0xF0, 0x44, 0x1F, 0xE5, 0x03, 0xA3, 0x7B, 0x84, 
+0x28, 0x2E, 0x93, 0x5A, 0xF5, 0x59, 0xDE, 0x69, 
+0xB6, 0xE9, 0xD3, 0xD4, 0x37, 0xAF, 0x13, 0xEE, 
+0xA2, 0xBF, 0x76, 0x50, 0x0F, 0xC5, 0x15, 0x35, 
+0xD9, 0xE0, 0xF3, 0x90, 0xC1, 0xE2, 0x42, 0xE0, 
+0x3B, 0x9E, 0x88, 0x0B, 0xA1, 0x67, 0x22, 0x44, 
+0x31, 0x53, 0x3C, 0xAA, 0x06, 0x6E, 0x73, 0x6A, 
+0x37, 0x97, 0x7C, 0xF6, 0xEC, 0x01, 0xC6, 
+
+0x1F, 0x51, 0xEB, 0x72, 0x3A, 0x0D, 0x02, 0x8F, 
+0xA7, 0xE4, 0x89, 0xE4, 0x44, 0xB5, 0xDF, 0x6D, 
+0xAF, 0xCB, 0x13, 0x1B, 0x99, 0x15, 0x06, 0xC7, 
+0xEF, 0x25, 0xA6, 0xDD, 0x06, 0xE0, 0x71, 0xA3, 
+0x7E, 0x4F, 0x06, 0xFA, 0xF5, 0x54, 0xAA, 0x25, 
+0x0A, 0x7F, 0x67, 0x68, 0x1A, 0x6A, 0x36, 0xF9, 
+0xF0, 0x8E, 0xB3, 0x83, 0x3C, 0x80, 0x67, 0x49, 
+0x1A, 0x0F, 0xE0, 0x71, 0xDE, 0xE0, 0xA9, 0x14, 
+0x43, 0x13, 0x39, 0xED, 0x13, 0x84, 0x9D, 0x34, 
+0x66, 0x0E, 0xBB, 0x19, 0x0C, 0x75, 0x71, 0x3E, 
+0x0C, 0xB1, 0x45, 0x19, 0x6E, 0x8B, 0x27, 0x51, 
+0x38, 0x67, 0x75, 0xF8, 0xED, 0x58, 0x7F, 0x4D, 
+0xE8, 0x82, 0x38, 0x14, 0x01, 0xE0, 0xE2, 0x8A, 
+0xCD, 0xBB, 0x52, 0x43, 0xB5, 0x09, 0x7A, 0x89, 
+0x90, 0xA8, 0x0A, 0x16, 0x7B, 0x02, 0xC6, 0x6B, 
+0xEA, 0xED, 0xD0, 0xE6, 0xB7, 0x55, 0x65, 0xB8, 
+0xDF, 0x4E, 0x91, 0x3A, 0x79, 0x45, 0xA0, 0x60, 
+0x5B, 0x1F, 0xAF, 0x78, 0x59, 0x36, 0x7E, 0xFF, 
+0x2F, 0x92, 0xD6, 0x28, 0x1D, 0x83, 0x95, 0xAE, 
+0xC4, 0xE5, 0xB2, 0x8B, 0x7A, 0x6E, 0x4C, 0x38, 
+0x08, 0xBE, 0x3D, 0x44, 0xBD, 0x34, 0x20, 0xFC, 
+0x40, 0x67, 0xC8, 0x58, 0x68, 0x4C, 0x2D, 0xB6, 
+0xD7, 0xFE, 0x35, 0x17, 0xEB, 0x1A, 0xF7, 0xCA, 
+0x0F, 0x8F, 0x72, 0x9B, 0x68, 0xAC, 0xFC, 0x84, 
+0x27, 0x4A, 0x2D, 0xC1, 0x49, 0x60, 0x4E, 0x28, 
+0x44, 0xBD, 0xD7, 0x40, 0xB2, 0xC2, 0x06, 0xF8, 
+0x6B, 0xF0, 0x3D, 0x31, 0x88, 0x24, 0x22, 0x3B, 
+0x5F, 0x3D, 0x58, 0xF3, 0xE7, 0x3C, 0xB3, 0xFB, 
+0x57, 0x30, 0xC5, 0xD5, 0xB9, 0x96, 0xF8, 0xB6, 
+0x6D, 0xAD, 0xC1, 0xFC, 0xCB, 0x17, 0x54, 0x7D, 
+0xFB, 0xEC, 0x82, 0xFA, 0x27, 0x68, 0x25, 0x35, 
+0x7E, 0xA9, 0xFF, 0x3E, 0x7E, 0x5F, 0x12, 0x47, 
+
+0x4C, 0xA7, 0x55, 0x54, 0x69, 0x5E, 0x81, 0xED, 
+0x24, 0xCB, 0x24, 0x76, 0xA2, 0x57, 0x77, 0xF0, 
+0x12, 0x3E, 0x9F, 0xF8, 0xCE, 0x08, 0x99, 0x14, 
+0xA1, 0xA0, 0x63, 0x7F, 0x42, 0x27, 0x2F, 0x09, 
+0xF6, 0xCF, 0x9E, 0xB0, 0xBE, 0x11, 0xF1, 0x58, 
+0x28, 0xE3, 0x88, 0xAB, 0x99, 0x53, 0xB5, 0x44, 
+0x61, 0xAE, 0x43, 0x73, 0x34, 0xE7, 0xA3, 0x19, 
+0x3F, 0x47, 0x98, 0xFD, 0x64, 0x83, 0x9F, 0xD3, 
+0x6C, 0x80, 0xA7, 0x96, 0x2C, 0x1D, 0x2C, 0x40, 
+0x7A, 0xBE, 0x8C, 0x32, 0xDE, 0x06, 0x7B, 0x82, 
+0x12, 0xE7, 0xC4, 0x7B, 0x26, 0x1D, 0x6B, 0xB0, 
+0xB8, 0x61, 0x15, 0x8B, 0x4B, 0x38, 0x32, 0x26, 
+0x24, 0x77, 0x52, 0xD1, 0x95, 0xD7, 0x1A, 0x5C, 
+0x4D, 0xA1, 0xD8, 0x91, 0x9D, 0x8C, 0xF3, 0x06, 
+0x02, 0x51, 0xD2, 0x04, 0xB7, 0x6B, 0xB3, 0x74, 
+0xF7, 0x6E, 0xC5, 0x59, 0x17, 0xC1, 0x3E, 0x3D, 
+0xA2, 0x20, 0xF1, 0x6A, 0x4C, 0xE2, 0x23, 0xC6, 
+0xB7, 0x6C, 0xF4, 0xA8, 0x82, 0xF8, 0x7C, 0xFE, 
+0x86, 0x58, 0x32, 0xAE, 0xC5, 0x3A, 0xE2, 0x08, 
+0x8D, 0xA9, 0x0B, 0x7D, 0xC5, 0x46, 0xC0, 0xE8, 
+0x9F, 0x7B, 0xE1, 0xDB, 0x9D, 0x07, 0xE5, 0xF9, 
+0x64, 0xC3, 0x31, 0xB8, 0xBB, 0x56, 0xAC, 0x73, 
+0xDD, 0x77, 0x9A, 0x34, 0x6D, 0xC0, 0xC9, 0xD0, 
+0x70, 0x04, 0x96, 0x55, 0xAA, 0xBE, 0xCE, 0x5E, 
+0xAE, 0x62, 0x08, 0x26, 0x69, 0x73, 0xB1, 0x86, 
+0x94, 0xE5, 0x7C, 0x07, 0x1A, 0x55, 0xF1, 0xBC, 
+0xA6, 0xEB, 0x5D, 0xD1, 0x9C, 0x6E, 0xDB, 0xEF, 
+0x4E, 0x45, 0x1D, 0x99, 0x10, 0xBA, 0x79, 0x26, 
+0xD0, 0x8C, 0x82, 0x56, 0xF6, 0x5E, 0x55, 0x9A, 
+0x1E, 0xA6, 0x8F, 0x42, 0xC2, 0x2A, 0x9D, 0x60, 
+0x63, 0xCD, 0x58, 0x80, 0xB5, 0xD3, 0x07, 0xDD, 
+0x74, 0x33, 0x3B, 0x33, 0x70, 0x98, 0x1C, 0x38, 
+0xB4, 
+
+0xF2, 0x1C, 0x80, 0xED, 0x3E, 0x53, 0x37, 0x44, 
+0x93, 0xF1, 0x15, 0x77, 0x7A, 0x0F, 0xD9, 0x84, 
+0x61, 0xC9, 0xAD, 0x4A, 0x14, 0x8E, 0x4E, 0xC0, 
+0xBC, 0x93, 0x2B, 0xDB, 0x1C, 0xBA, 0x96, 0x0A, 
+0x5B, 0xD0, 0xE3, 0x87, 0x20, 0xFC, 0xE0, 0xA6, 
+0xD8, 0x29, 0x48, 0x57, 0xBF, 0xD5, 0xF4, 0x17, 
+0xB9, 0xF8, 0xAE, 0x0E, 0x85, 0x67, 0xC8, 0x83, 
+0x84, 0x56, 0x30, 0xD4, 0xCB, 0xFC, 0xAB, 0x7B, 
+0x9B, 0x47, 0x79, 0x9C, 0x68, 0xE7, 0xC4, 0x30, 
+0x75, 0x8F, 0x1C, 0x82, 0xB1, 0xFA, 0xC6, 0x5A, 
+0x4D, 0x56, 0xD3, 0x8D, 0x4B, 0xD7, 0x4D, 0x37, 
+0xD0, 0xF5, 0xC7, 0x9A, 0xE1, 0x88, 0xE3, 0x04, 
+0x82, 0x05, 0x23, 0x3B, 0x13, 0x1A, 0x5F, 0xA1, 
+0x3A, 0xEE, 0xDB, 0x11, 0x59, 0x28, 0x10, 0xE2, 
+0xAE, 0x93, 0xC5, 0x29, 0x84, 0x6D, 0x46, 0x24, 
+0x4B, 0x8D, 0x82, 0x19, 0x40, 0x4A, 0xD8, 0x2F, 
+0xB6, 0xA0, 0x5D, 0x66, 0x2D, 0x55, 0xD2, 0x81, 
+0x29, 0x87, 0xB2, 0xEC, 0x01, 0xBD, 0x01, 0x2A, 
+0x51, 0x08, 0x54, 0xB0, 0x8E, 0xCB, 0xFF, 0x3B, 
+0x10, 0x45, 0x56, 0xE0, 0xFC, 0x36, 0xD3, 0xF3, 
+0x2F, 0x5D, 0x05, 0xF3, 0x99, 0x18, 0x87, 0xD9, 
+0xD5, 0xF3, 0x24, 0xF1, 0x63, 0x0E, 0x81, 0x70, 
+0x3B, 0x44, 0x01, 0x5C, 0xD1, 0xF1, 0xB2, 0xD5, 
+0x1A, 0xCA, 0x21, 0xD2, 0x32, 0x5E, 0xC0, 0x83, 
+0xF4, 0xDC, 0x69, 0xB1, 0xF1, 0x34, 0x95, 0x9C, 
+0xD0, 0x8D, 0xB5, 0x5E, 0x39, 0x19, 0xC7, 0x8D, 
+0x4F, 0xB0, 0x41, 0xA1, 0x7C, 0x9C, 0xC5, 0xC2, 
+0x82, 0x8B, 0xAA, 0xCD, 0x5C, 0x02, 0x53, 0xD9, 
+0xCA, 0x70, 0x6B, 0x54, 0x47, 0xF2, 0xE7, 0xCA, 
+0x56, 0x40, 0xD2, 0x10, 0xC8, 0x08, 0xFB, 0xB1, 
+0xA9, 0x27, 0xB6, 0x7D, 0xE4, 0x19, 0xFA, 0x0A, 
+0x3B, 0xFB, 0x66, 0xA5, 0x89, 0x0D, 0x82, 0x65, 
+0xA6, 0x68, 
+
+0x38, 0x21, 0xFB, 0x7B, 0xD0, 0x6B, 0xEC, 0xBF, 
+0x06, 0xBA, 0x34, 0x17, 0x48, 0x5D, 0x87, 0x0E, 
+0x96, 0x1D, 0x58, 0x73, 0xE3, 0x64, 0x58, 0xE0, 
+0x70, 0xB1, 0xDA, 0xA7, 0xA4, 0x76, 0x23, 0x00, 
+0xAE, 0x0B, 0x9E, 0xA0, 0xEC, 0xAE, 0x66, 0x5B, 
+0x8C, 0x66, 0x30, 0x9C, 0xCD, 0xFC, 0x3A, 0x4D, 
+0xF1, 0xB0, 0x8F, 0xED, 0x8E, 0x82, 0x11, 0x9C, 
+0x16, 0x9A, 0x96, 0x9F, 0xA9, 0x2F, 0x3E, 0xCE, 
+0xA0, 0xDB, 0x6A, 0x4D, 0xCB, 0x25, 0xEA, 0xC9, 
+0xA2, 0xD9, 0x13, 0x24, 0x6B, 0xE1, 0x90, 0x88, 
+0x3D, 0x71, 0x29, 0xDA, 0x49, 0x43, 0xB3, 0x63, 
+0x4D, 0xBB, 0xEE, 0xCE, 0xDF, 0xFB, 0x23, 0xF2, 
+0xB2, 0xFC, 0x52, 0x0F, 0x40, 0x14, 0x26, 0x22, 
+0x24, 0x70, 0xF5, 0x54, 0x2D, 0x1F, 0xF5, 0x30, 
+0xFD, 0x7A, 0x16, 0x73, 0xBB, 0xF3, 0xBE, 0x36, 
+0xA0, 0xA6, 0x85, 0x76, 0x86, 0x52, 0xFA, 0x53, 
+0xA8, 0xAA, 0xC0, 0x8A, 0x7D, 0xDF, 0x22, 0x47, 
+0x9A, 0x5F, 0xD9, 0x85, 0xB9, 0x9B, 0xCC, 0xC0, 
+0xD2, 0x9E, 0xB3, 0xA6, 0xBC, 0xF4, 0x2C, 0x3F, 
+0x83, 0x0F, 0x9C, 0x55, 0x54, 0xF6, 0xC7, 0xB7, 
+0xF5, 0xBC, 0x42, 0x5D, 0x3A, 0xF9, 0x15, 0xFF, 
+0xB2, 0xF5, 0x26, 0x2B, 0xD8, 0xF7, 0x42, 0x06, 
+0x8A, 0xA4, 0xDC, 0xEA, 0xC3, 0x5A, 0x50, 0x08, 
+0xAA, 0x4A, 0xA3, 0x72, 0xAA, 0xEE, 0xD7, 0xFA, 
+0xC4, 0x48, 0x9B, 0xAB, 0x83, 0x72, 0x67, 0xBF, 
+0xF2, 0x01, 0x7E, 0xCD, 0xFD, 0x8A, 0x09, 0xBA, 
+0x93, 0xA1, 0xC4, 0xE7, 0x21, 0x7C, 0x6D, 0x4D, 
+0x8A, 0x77, 0x36, 0xE9, 0xAF, 0x30, 0xC2, 0x23, 
+0x51, 0x8B, 0x68, 0xD6, 0x0E, 0xD3, 0xA9, 0x47, 
+0x9B, 0xB4, 0x5B, 0x1D, 0xD3, 0x8D, 0xFA, 0xAB, 
+0x99, 0xE0, 0xB2, 0x48, 0x0F, 0xAE, 0x44, 0x1B, 
+0xD3, 0x05, 0x68, 0x69, 0x58, 0xBC, 0x83, 0x54, 
+0xF8, 0x4D, 0xF6, 
+
+0x3A, 0x60, 0xC2, 0xF5, 0x7A, 0x72, 0x53, 0xFD, 
+0x5B, 0x5B, 0x29, 0x27, 0xD1, 0xE4, 0x9D, 0xF0, 
+0x3A, 0x1C, 0x69, 0x5C, 0x3A, 0x5D, 0xDA, 0xC8, 
+0x91, 0x29, 0xC2, 0xB0, 0xE4, 0xE1, 0x09, 0x8E, 
+0x9B, 0x2B, 0x11, 0xCC, 0x73, 0xDC, 0xB8, 0xF9, 
+0x9D, 0xAA, 0xBE, 0x4E, 0x3A, 0x9E, 0x33, 0x2D, 
+0x99, 0x2A, 0xDE, 0xBF, 0xD8, 0xC8, 0x48, 0xB1, 
+0x99, 0xF4, 0xED, 0x4B, 0x9A, 0x2E, 0x04, 0xE7, 
+0x7A, 0xFD, 0x46, 0x24, 0xF7, 0x67, 0xB3, 0x39, 
+0x06, 0x84, 0x89, 0xEF, 0xB5, 0x79, 0x4F, 0xFD, 
+0xAC, 0xF8, 0x2B, 0xD2, 0x3A, 0x71, 0x60, 0xBD, 
+0x5F, 0x86, 0x3B, 0xD2, 0x90, 0xA3, 0xFF, 0x05, 
+0xAB, 0x0C, 0x0F, 0x3B, 0x02, 0xC8, 0xA5, 0x7F, 
+0x1E, 0x09, 0xF2, 0xFB, 0x0C, 0x42, 0xA1, 0x66, 
+0x3E, 0x58, 0x5C, 0xB0, 0x17, 0xB6, 0x33, 0x61, 
+0x44, 0x66, 0x80, 0xCB, 0x8F, 0x1D, 0xC3, 0x9F, 
+0x49, 0xC0, 0x83, 0xDB, 0xC4, 0xCF, 0xFF, 0x6C, 
+0xBF, 0xD8, 0x77, 0xFC, 0x5E, 0x35, 0xA4, 0xE7, 
+0x0A, 0x36, 0x95, 0x21, 0xF3, 0x01, 0x86, 0xB1, 
+0x7D, 0x96, 0xA5, 0xE5, 0x23, 0xB8, 0x75, 0x5A, 
+0x05, 0x02, 0x71, 0x86, 0x8E, 0x98, 0xA7, 0x17, 
+0xF9, 0xD2, 0xCF, 0xC1, 0xC2, 0xD6, 0xBB, 0x46, 
+0x7C, 0x2C, 0x30, 0x29, 0x5E, 0x37, 0xC0, 0x7A, 
+0x52, 0xC1, 0x76, 0x39, 0xFA, 0x04, 0xC9, 0x96, 
+0x49, 0x50, 0xE7, 0x30, 0x0A, 0x9B, 0xFD, 0x80, 
+0x0C, 0x35, 0xF6, 0x5B, 0x26, 0x7D, 0xBD, 0xBA, 
+0xC1, 0xF0, 0x8B, 0x9A, 0x3B, 0x6B, 0x20, 0x8D, 
+0x58, 0xBE, 0xE9, 0x22, 0x1D, 0x24, 0x38, 0x7A, 
+0xCA, 0xB0, 0x91, 0x63, 0xD6, 0x53, 0x22, 0x7D, 
+0x89, 0x33, 0xA4, 0x37, 0x71, 0xA9, 0x5D, 0xB6, 
+0xD8, 0x8F, 0x0E, 0xA2, 0x24, 0xA9, 0xA7, 0x34, 
+0x98, 0xF2, 0xDD, 0xC6, 0x5A, 0x56, 0x52, 0x04, 
+0x39, 0xD2, 0x11, 0xB3, 
+
+0x81, 0x5C, 0x9B, 0x77, 0x29, 0x33, 0xF4, 0xB0, 
+0x29, 0x32, 0xA2, 0x37, 0x7D, 0xAD, 0x98, 0x00, 
+0x5A, 0x75, 0xC7, 0x17, 0xBD, 0xA6, 0xC4, 0xA2, 
+0xC0, 0xAF, 0x6E, 0x48, 0xA9, 0xF6, 0x47, 0xBB, 
+0x7B, 0x6D, 0x84, 0x06, 0x2D, 0x71, 0xDF, 0x64, 
+0x09, 0xC6, 0x74, 0x98, 0xF3, 0x74, 0x8A, 0x3A, 
+0x25, 0xB2, 0xB5, 0xCE, 0xDA, 0x39, 0x15, 0x20, 
+0x8F, 0x39, 0xAF, 0xED, 0x18, 0x50, 0x7A, 0x86, 
+0x9A, 0x4E, 0x18, 0x37, 0xF5, 0x17, 0xB8, 0xB9, 
+0xF3, 0x8A, 0x6A, 0x1D, 0x59, 0x0F, 0xE6, 0xE5, 
+0x9F, 0x6C, 0xEC, 0x6C, 0xB5, 0xE0, 0x5C, 0x16, 
+0xD2, 0x12, 0xDE, 0xB6, 0xF9, 0x8E, 0xFC, 0x97, 
+0xA8, 0x8F, 0x19, 0xD7, 0x01, 0x06, 0x2E, 0xB3, 
+0x10, 0x37, 0xF2, 0x1E, 0xC0, 0x1C, 0x5D, 0xBB, 
+0xC7, 0x68, 0xEB, 0xBF, 0xC1, 0xA8, 0xBF, 0x5D, 
+0xAD, 0x8F, 0x76, 0xC2, 0xDC, 0xC6, 0xCF, 0x56, 
+0x09, 0x8E, 0xD0, 0xEE, 0xE7, 0xFF, 0xF1, 0x61, 
+0xA8, 0xA7, 0x6D, 0xFB, 0x56, 0xA8, 0x88, 0x12, 
+0xB5, 0x1D, 0xED, 0x88, 0xB2, 0x91, 0x43, 0x32, 
+0x15, 0x2B, 0x31, 0xCD, 0xAC, 0xE8, 0xFD, 0xA4, 
+0xAB, 0x81, 0x64, 0x24, 0x56, 0xFE, 0x65, 0xDC, 
+0x31, 0xC2, 0x61, 0xC6, 0xB8, 0x41, 0x6C, 0x66, 
+0x48, 0x37, 0x2E, 0x42, 0x4C, 0x3C, 0x8B, 0x0F, 
+0xE5, 0xB4, 0x60, 0xCC, 0xCB, 0xB5, 0xC2, 0x16, 
+0x17, 0x38, 0xB0, 0xCE, 0x78, 0x33, 0x94, 0x78, 
+0x3C, 0xD3, 0x55, 0x29, 0xAB, 0xEC, 0xAB, 0xEB, 
+0x97, 0xF2, 0x07, 0x03, 0xFE, 0x54, 0xB7, 0xA3, 
+0xC1, 0xD7, 0x40, 0x45, 0xEE, 0x03, 0x30, 0x86, 
+0x0A, 0x30, 0xE0, 0xAB, 0xDF, 0x5A, 0xB2, 0x23, 
+0xC1, 0xB7, 0x0D, 0x85, 0xF2, 0x4D, 0x4B, 0xC1, 
+0xED, 0x51, 0x89, 0x20, 0xB0, 0xC7, 0x3C, 0xBE, 
+0x3F, 0x75, 0x82, 0xD9, 0xE1, 0xE2, 0x25, 0x68, 
+0x1E, 0xDF, 0x9C, 0x1E, 0xE7, 
+
+0x91, 0xE0, 0xEB, 0xA7, 0xA5, 0xD6, 0x8D, 0xAB, 
+0x22, 0xE1, 0x75, 0x57, 0x70, 0xA2, 0xB8, 0x79, 
+0x08, 0xAF, 0x71, 0x58, 0x7E, 0x85, 0xC7, 0x38, 
+0x91, 0x58, 0x38, 0xDB, 0xCF, 0xEA, 0xD3, 0xDC, 
+0x84, 0xD6, 0x98, 0x6C, 0x4E, 0x98, 0xD5, 0x97, 
+0xB1, 0x80, 0xCB, 0xBB, 0xBD, 0x16, 0x88, 0xCE, 
+0xCD, 0x45, 0x98, 0x2F, 0x90, 0xCD, 0xA9, 0x11, 
+0x37, 0x98, 0xB5, 0xCD, 0xC6, 0xA6, 0x26, 0x40, 
+0x94, 0xF6, 0x10, 0x7D, 0xAD, 0xEE, 0x68, 0xFC, 
+0xA6, 0x8B, 0xE9, 0x9D, 0xF0, 0x5C, 0x7D, 0x0A, 
+0x1A, 0x4B, 0x98, 0x45, 0x15, 0xA1, 0x21, 0xAC, 
+0xE5, 0x1F, 0xC0, 0xAB, 0x4D, 0xD0, 0x19, 0x9B, 
+0x85, 0x91, 0xE2, 0xD9, 0x0A, 0x37, 0xFB, 0x24, 
+0xA7, 0x99, 0x53, 0x1D, 0x7D, 0x63, 0x64, 0x07, 
+0xC4, 0xCC, 0xDE, 0x35, 0x20, 0x7E, 0x5B, 0xCC, 
+0xE2, 0xDA, 0x1D, 0x8D, 0x1F, 0xC0, 0xF9, 0x4D, 
+0x4B, 0xE1, 0x65, 0x8D, 0xB9, 0x29, 0x1B, 0x13, 
+0x7D, 0x07, 0x2C, 0x31, 0xD3, 0x72, 0x5B, 0x47, 
+0xBB, 0x81, 0x7A, 0xBB, 0x76, 0x0E, 0x94, 0x96, 
+0xA3, 0xCC, 0xDC, 0x51, 0x14, 0xD3, 0x1A, 0x98, 
+0x4D, 0xFE, 0xBD, 0x3B, 0x47, 0xB9, 0x4E, 0x14, 
+0xB0, 0x65, 0xD1, 0x4C, 0x37, 0x27, 0xB3, 0x78, 
+0xD5, 0xF5, 0x9A, 0x0A, 0x19, 0xA0, 0x61, 0x26, 
+0x55, 0x61, 0x8A, 0x41, 0x0E, 0x76, 0x8E, 0x10, 
+0x38, 0x85, 0xE4, 0xEB, 0x80, 0x36, 0x93, 0x87, 
+0x03, 0x72, 0x68, 0xF0, 0x12, 0xA0, 0xAB, 0x36, 
+0x95, 0xD6, 0x9F, 0x0F, 0x44, 0x8F, 0xB2, 0x4F, 
+0x98, 0xB7, 0x3E, 0xD2, 0x14, 0x4C, 0x81, 0x26, 
+0xE9, 0x62, 0x92, 0x4E, 0x7A, 0x6C, 0x40, 0x0E, 
+0xA3, 0x4B, 0x1D, 0x90, 0xE5, 0x2E, 0xA2, 0x43, 
+0xE3, 0x3B, 0x46, 0xAE, 0x10, 0x6E, 0x0D, 0xF1, 
+0x95, 0xD5, 0xB7, 0xE6, 0x96, 0x14, 0x8D, 0x14, 
+0xED, 0xF9, 0x63, 0x53, 0xD3, 0xE8, 
+
+0xAB, 0x68, 0xB6, 0xDF, 0x5C, 0x21, 0x7C, 0x35, 
+0x51, 0xAA, 0x75, 0xB2, 0x47, 0xE5, 0xEF, 0xC1, 
+0xC8, 0x08, 0x9C, 0x96, 0xCD, 0xAD, 0x73, 0x8A, 
+0x2E, 0xFA, 0xFB, 0x32, 0x96, 0x7F, 0xDA, 0x8E, 
+0x46, 0x11, 0x4B, 0x41, 0xE7, 0x3F, 0x7C, 0xE2, 
+0x51, 0x06, 0x26, 0x69, 0x98, 0x79, 0x89, 0xB9, 
+0x61, 0x8B, 0x1D, 0x29, 0x69, 0x93, 0xD8, 0x0A, 
+0xDC, 0x98, 0x07, 0x64, 0x6F, 0x8A, 0xF9, 0x4E, 
+0x3F, 0x70, 0x8D, 0xFE, 0x3C, 0x69, 0x74, 0xB3, 
+0x37, 0x56, 0x61, 0x80, 0xDE, 0xC4, 0xBF, 0xE2, 
+0xE2, 0x21, 0xE4, 0x96, 0x2A, 0x75, 0x71, 0xBE, 
+0xC8, 0x70, 0x24, 0x8E, 0x32, 0x8F, 0x2F, 0x70, 
+0x55, 0x31, 0x8B, 0x41, 0x4F, 0xE1, 0x53, 0x73, 
+0x00, 0x95, 0x15, 0xC9, 0x0B, 0x09, 0x40, 0x9F, 
+0x12, 0xAB, 0xEE, 0xC1, 0x40, 0x7F, 0x40, 0x1C, 
+0xFA, 0x98, 0xE4, 0xA2, 0x0F, 0x6A, 0xA9, 0x14, 
+0x64, 0x4D, 0x95, 0xE5, 0xC0, 0xEB, 0xCA, 0x78, 
+0xBB, 0xB6, 0xE6, 0xD8, 0x1D, 0x5E, 0x1F, 0x78, 
+0xC1, 0xD2, 0x0B, 0x78, 0x6B, 0xC0, 0x76, 0x17, 
+0xD9, 0x5E, 0x1C, 0xDD, 0x91, 0xCC, 0x6B, 0x04, 
+0x7F, 0x5A, 0x0C, 0xF0, 0xA4, 0x44, 0x67, 0xDD, 
+0x68, 0xB3, 0x50, 0x50, 0xDA, 0x49, 0x60, 0x32, 
+0x58, 0x1B, 0x67, 0x69, 0x66, 0xC6, 0xCC, 0x2E, 
+0xF4, 0xD3, 0xF8, 0x1D, 0xAA, 0x17, 0xA3, 0x1F, 
+0xB2, 0x5B, 0x0A, 0x8C, 0xAC, 0x40, 0xE3, 0xD5, 
+0xB8, 0x98, 0x18, 0x8D, 0xE8, 0x2B, 0xD2, 0x2C, 
+0x72, 0xE0, 0x42, 0xA7, 0x4D, 0x89, 0x25, 0xD7, 
+0x4E, 0x39, 0x8D, 0x6A, 0xFF, 0xB5, 0xDC, 0xC1, 
+0x20, 0x16, 0x53, 0x16, 0xA8, 0x50, 0x91, 0xB5, 
+0x2A, 0x62, 0x91, 0x30, 0xB7, 0xC4, 0xA3, 0x65, 
+0x38, 0xEC, 0xF0, 0xE5, 0xFA, 0xA6, 0x37, 0xD0, 
+0x7D, 0xF4, 0x50, 0x99, 0x51, 0x07, 0x4B, 0x20, 
+0x32, 0x81, 0x78, 0x16, 0x92, 0xE7, 0x8C, 
+
+0xE5, 0x0F, 0xAF, 0xFE, 0x8F, 0x12, 0x3C, 0x8E, 
+0x6A, 0x7D, 0x1D, 0x26, 0xAD, 0x3F, 0xBC, 0xE7, 
+0x83, 0x3E, 0x07, 0xA5, 0x82, 0xA6, 0x39, 0x57, 
+0xFD, 0x1A, 0x82, 0xA8, 0x56, 0x8E, 0xAF, 0xE5, 
+0x8A, 0x1F, 0x3A, 0x14, 0xFF, 0xA1, 0x9C, 0x7D, 
+0xEA, 0xC0, 0x77, 0xE9, 0xEB, 0x44, 0xE4, 0xB9, 
+0xB9, 0xED, 0x9A, 0x08, 0x65, 0x8D, 0x32, 0x28, 
+0xF7, 0x23, 0xD8, 0x65, 0x10, 0x5E, 0xCD, 0xFB, 
+0xCD, 0xC1, 0x03, 0x7D, 0x01, 0x95, 0x8F, 0x9E, 
+0xC0, 0xAD, 0x5C, 0xF9, 0xD1, 0xAA, 0xBC, 0x65, 
+0x42, 0xEC, 0x7E, 0x3A, 0x1D, 0x8D, 0x8E, 0x86, 
+0x98, 0xDB, 0x8C, 0x0A, 0x4B, 0x9F, 0xE5, 0xF3, 
+0x15, 0xE6, 0xC2, 0x76, 0x98, 0xF2, 0xE5, 0xF5, 
+0x25, 0x11, 0xB1, 0x84, 0xA4, 0x6E, 0x20, 0x57, 
+0x43, 0x61, 0x40, 0x2A, 0x0D, 0x6E, 0x83, 0xE1, 
+0xF0, 0x46, 0xF7, 0xB8, 0x38, 0x43, 0x2D, 0x15, 
+0x87, 0x1F, 0x64, 0x3C, 0xF7, 0xA7, 0x28, 0x3F, 
+0x7A, 0xF1, 0x7A, 0x36, 0x56, 0x30, 0x88, 0x5B, 
+0x06, 0xB3, 0x16, 0xDB, 0x91, 0xE9, 0xE2, 0x67, 
+0x4B, 0xEC, 0x9A, 0xDC, 0x16, 0x3E, 0x44, 0x81, 
+0xA0, 0x82, 0x16, 0xDA, 0xB2, 0xB7, 0x9B, 0x40, 
+0xA5, 0x86, 0x80, 0xD6, 0x15, 0x7A, 0x80, 0x11, 
+0x74, 0x51, 0x3C, 0xE9, 0x24, 0xE8, 0xBC, 0x4C, 
+0x22, 0xD6, 0xC2, 0xE4, 0x58, 0x30, 0xA4, 0x74, 
+0x6B, 0x9E, 0xA0, 0xFE, 0x4A, 0xAB, 0x30, 0xD2, 
+0x81, 0x5F, 0xCE, 0x54, 0x42, 0x88, 0xCC, 0x02, 
+0x6C, 0xDF, 0x69, 0x17, 0xC5, 0xB3, 0x73, 0xC9, 
+0x49, 0x14, 0xBE, 0x19, 0xE0, 0x03, 0x95, 0x20, 
+0x90, 0x1D, 0xC1, 0x7E, 0x84, 0xFB, 0x2C, 0x8F, 
+0xEF, 0x8E, 0x8C, 0xA2, 0x07, 0x4F, 0x34, 0x9B, 
+0x26, 0xA8, 0xC4, 0xF9, 0xC9, 0x06, 0x63, 0xC0, 
+0xFF, 0x38, 0x8D, 0xAF, 0x8A, 0x66, 0x82, 0xB4, 
+0xB6, 0x2C, 0x2E, 0xFB, 0xAC, 0x03, 0x30, 0x04, 
+
+0x8A, 0x65, 0x8F, 0x73, 0x76, 0xF4, 0xB9, 0x49, 
+0xB1, 0xB5, 0xF5, 0xF4, 0xC8, 0x88, 0x73, 0x14, 
+0x55, 0x08, 0x8E, 0x64, 0xE5, 0xA4, 0x37, 0x23, 
+0x2B, 0xE4, 0xD7, 0xF8, 0xE5, 0x31, 0xD2, 0xEA, 
+0xAD, 0xAD, 0xCD, 0x6D, 0x7C, 0xED, 0x27, 0x1F, 
+0x2F, 0x44, 0x6B, 0xED, 0xD4, 0xB2, 0x42, 0x28, 
+0x91, 0x61, 0x58, 0xC5, 0x70, 0x93, 0x97, 0x3C, 
+0x73, 0xE6, 0xD5, 0xE7, 0x8F, 0x91, 0xD6, 0x00, 
+0x64, 0x59, 0x07, 0x60, 0x00, 0xB7, 0x6C, 0x39, 
+0x60, 0xD6, 0xFC, 0x34, 0x69, 0xB8, 0x5B, 0xCB, 
+0x4E, 0xD8, 0xDE, 0xAD, 0xC7, 0xFB, 0xF9, 0xB8, 
+0x95, 0x00, 0x36, 0xF6, 0xE3, 0x8B, 0x3F, 0x0A, 
+0x43, 0xB1, 0x37, 0x55, 0x82, 0x36, 0xE7, 0xB1, 
+0x06, 0x3D, 0x8A, 0xBF, 0x47, 0x1A, 0x79, 0xE5, 
+0x0D, 0x4E, 0x5B, 0xCA, 0x95, 0xCA, 0xC1, 0x57, 
+0x38, 0xC2, 0xF5, 0xA5, 0x73, 0xA2, 0x31, 0x1E, 
+0xA6, 0xFA, 0x66, 0x5C, 0x23, 0xD0, 0x47, 0x47, 
+0x22, 0xE7, 0xD7, 0x46, 0x58, 0x52, 0x49, 0x9D, 
+0xC1, 0x2F, 0xAF, 0x44, 0xAC, 0x20, 0x5C, 0x42, 
+0xD4, 0xB5, 0x80, 0x49, 0x7A, 0xDC, 0xBB, 0x6B, 
+0xDE, 0x74, 0x44, 0x40, 0xF4, 0x8D, 0xAD, 0x98, 
+0x7A, 0xBF, 0xA6, 0xDA, 0x98, 0x0E, 0x22, 0x63, 
+0xA3, 0xD8, 0x87, 0x2B, 0x2B, 0x11, 0xD1, 0x64, 
+0x21, 0x0B, 0xDE, 0x91, 0xBD, 0x4A, 0x90, 0x7F, 
+0x6D, 0x26, 0xC9, 0x48, 0xC3, 0xBA, 0x68, 0xA8, 
+0x49, 0xEA, 0xE0, 0xB7, 0xD3, 0x75, 0xE5, 0x3D, 
+0xAE, 0x6A, 0xFE, 0x0B, 0xD8, 0x64, 0xF2, 0x3C, 
+0xF3, 0x9D, 0x95, 0xA2, 0x4D, 0x9F, 0x05, 0x9B, 
+0xDE, 0xB6, 0xBC, 0xB7, 0xC0, 0xEC, 0xFC, 0x10, 
+0x00, 0xAB, 0x03, 0x74, 0x62, 0x02, 0x47, 0xA2, 
+0x8B, 0xB5, 0xBF, 0x89, 0x12, 0x3F, 0x5E, 0x9C, 
+0xDB, 0x0D, 0xC6, 0xBA, 0xAE, 0xBA, 0x17, 0x16, 
+0x19, 0x2C, 0x0D, 0xDD, 0x4B, 0x32, 0x28, 0x50, 
+0x26, 
+
+0x8C, 0xBB, 0x56, 0x57, 0x8C, 0xF0, 0x3D, 0x65, 
+0x66, 0x9B, 0xA2, 0x18, 0x04, 0xBD, 0xA9, 0x01, 
+0xCB, 0xBF, 0xC3, 0xF5, 0x5C, 0x1C, 0x82, 0x84, 
+0x3F, 0xD7, 0xB9, 0x39, 0x62, 0x44, 0x85, 0xF2, 
+0xD8, 0x05, 0x96, 0x44, 0x6A, 0xB1, 0x70, 0xF0, 
+0x13, 0xF2, 0x0C, 0x23, 0x15, 0x54, 0x24, 0x78, 
+0x3D, 0x3A, 0xEC, 0x1A, 0x8B, 0x9F, 0x50, 0xCF, 
+0xB8, 0x83, 0x73, 0x92, 0xDA, 0x77, 0xAC, 0x55, 
+0xE5, 0x97, 0x0B, 0xAB, 0x60, 0xCF, 0x70, 0x03, 
+0x9E, 0x5A, 0xAA, 0x6F, 0xC0, 0x1A, 0x7D, 0x9D, 
+0x7F, 0xD9, 0x26, 0x1D, 0x89, 0x7F, 0x94, 0xE2, 
+0x74, 0x6C, 0x62, 0x37, 0x77, 0xBA, 0x96, 0x71, 
+0x2B, 0x0E, 0x7A, 0xFB, 0x81, 0x75, 0x56, 0xC3, 
+0x10, 0xF8, 0x8D, 0xA5, 0x7E, 0x85, 0x25, 0x52, 
+0x85, 0x9A, 0x32, 0xEA, 0xB9, 0xAC, 0x2C, 0xE2, 
+0x98, 0x09, 0xF6, 0x17, 0xF1, 0x53, 0xD3, 0x5F, 
+0xCB, 0x02, 0x09, 0x80, 0xC4, 0x5F, 0xCF, 0x06, 
+0x4C, 0xE7, 0xDF, 0xB3, 0x56, 0x2C, 0xCE, 0x42, 
+0x96, 0x59, 0xBB, 0x6A, 0x43, 0x13, 0xBC, 0xA7, 
+0x2F, 0xAE, 0x73, 0xE4, 0x8C, 0x09, 0x0A, 0xA7, 
+0x88, 0x5F, 0x32, 0xA5, 0x50, 0x81, 0xE5, 0xCC, 
+0x01, 0xB0, 0x8A, 0xBA, 0x97, 0x2D, 0x66, 0x59, 
+0xB9, 0x74, 0x42, 0x75, 0x81, 0xDD, 0x4C, 0x72, 
+0xE3, 0x72, 0x29, 0xC0, 0x2D, 0x29, 0xDD, 0xD7, 
+0x73, 0x2D, 0x70, 0x0B, 0xE1, 0x9C, 0xB6, 0xD5, 
+0x9D, 0xD2, 0x81, 0xA1, 0xE6, 0x91, 0xB3, 0xEB, 
+0x87, 0x42, 0x41, 0x54, 0xA9, 0xD4, 0xFA, 0x2E, 
+0x3C, 0x7F, 0x90, 0xF3, 0x4F, 0xA7, 0xCD, 0x04, 
+0x8E, 0x17, 0x38, 0x0D, 0xE9, 0xB9, 0xAE, 0x73, 
+0xA4, 0x3E, 0x1A, 0x78, 0xD7, 0xDC, 0x1A, 0x69, 
+0xB7, 0xC9, 0x20, 0xA1, 0x95, 0x8A, 0xFE, 0x24, 
+0xA4, 0x01, 0x11, 0xEA, 0xF4, 0xA6, 0x2D, 0xBC, 
+0xA5, 0x04, 0x0F, 0xA2, 0x4D, 0x04, 0x73, 0x09, 
+0x71, 0x8A, 
+
+0x3A, 0x84, 0x01, 0xB4, 0x09, 0x0F, 0x70, 0xC3, 
+0x5B, 0x02, 0x83, 0xB5, 0xF2, 0x47, 0x57, 0x05, 
+0x97, 0x3E, 0x64, 0x18, 0xAF, 0x80, 0xDD, 0x70, 
+0xC6, 0xDE, 0xF7, 0xF5, 0x7E, 0x36, 0xC1, 0x92, 
+0x3B, 0xEE, 0xAF, 0xB9, 0x0C, 0xEA, 0xEE, 0xE5, 
+0x01, 0x33, 0x1A, 0x11, 0xCA, 0x44, 0x73, 0x3B, 
+0x80, 0xA9, 0x50, 0x50, 0x0A, 0xEB, 0x90, 0xAE, 
+0x39, 0xFF, 0x38, 0x2E, 0xD7, 0x1E, 0xEB, 0xED, 
+0xDB, 0xAD, 0xA9, 0x53, 0xED, 0x28, 0xA5, 0x0E, 
+0x92, 0x89, 0x66, 0x7C, 0x29, 0x05, 0x96, 0xA6, 
+0x8A, 0x64, 0x55, 0xF9, 0xEE, 0x3A, 0xBF, 0x01, 
+0x76, 0xEB, 0x70, 0x8E, 0xA0, 0x5B, 0x60, 0x33, 
+0x36, 0x63, 0x55, 0xE9, 0x1B, 0x41, 0xDD, 0x63, 
+0xA2, 0xD0, 0x47, 0xFD, 0x33, 0x46, 0xFF, 0xF3, 
+0xCE, 0x15, 0xC3, 0x11, 0x95, 0xC3, 0x03, 0x06, 
+0x97, 0xB4, 0xFA, 0x61, 0x4D, 0xE8, 0x17, 0x32, 
+0x5D, 0x5C, 0xAB, 0x85, 0x99, 0xF9, 0x48, 0x10, 
+0xED, 0x9C, 0xE8, 0xE4, 0xB1, 0x6F, 0xB0, 0x16, 
+0x70, 0x5E, 0x7B, 0x32, 0xCC, 0x6C, 0x6A, 0x55, 
+0xB1, 0xF5, 0xA0, 0x8E, 0x9C, 0x5D, 0x99, 0x5D, 
+0x12, 0x8B, 0xD8, 0x2D, 0x3C, 0xFD, 0xC2, 0x16, 
+0xEF, 0x51, 0x9A, 0x43, 0xDF, 0xC4, 0x76, 0x36, 
+0x18, 0xAF, 0x1C, 0x5E, 0xB2, 0x94, 0x78, 0xFC, 
+0x8C, 0x58, 0x23, 0x83, 0x6D, 0x8A, 0x2A, 0x45, 
+0xEB, 0xAD, 0xA4, 0x7E, 0x86, 0x7A, 0x1C, 0xAE, 
+0x42, 0xFD, 0xE3, 0x7D, 0xB6, 0xE7, 0xFA, 0x34, 
+0x8F, 0x91, 0x77, 0xBC, 0x8B, 0xD7, 0x10, 0x8A, 
+0x5A, 0x94, 0x96, 0xCE, 0x43, 0x88, 0x72, 0x0B, 
+0x13, 0x85, 0x21, 0x83, 0x5C, 0x64, 0x6C, 0xE6, 
+0xF6, 0x6D, 0x80, 0x92, 0xD9, 0xF7, 0xC5, 0xE0, 
+0xEF, 0x22, 0x61, 0x73, 0x4A, 0xB8, 0xC5, 0x7B, 
+0xEA, 0x2D, 0x0D, 0x58, 0x4E, 0x39, 0xCF, 0xCB, 
+0x0F, 0xFB, 0xB7, 0x58, 0xA9, 0x17, 0x3A, 0x69, 
+0xEA, 0x9A, 0x93, 
+
+0x71, 0x24, 0x73, 0x17, 0xA7, 0xFB, 0xBF, 0x32, 
+0xCE, 0x31, 0x17, 0xE3, 0x7B, 0x87, 0x0C, 0xDB, 
+0xA9, 0x48, 0x55, 0x65, 0x4E, 0xFB, 0xB7, 0x57, 
+0xA1, 0x6B, 0x64, 0x17, 0xF0, 0x2A, 0xEB, 0xD3, 
+0x7A, 0x1E, 0xD7, 0x88, 0xBA, 0x47, 0x2B, 0x5E, 
+0xAE, 0x1F, 0xA9, 0xF2, 0xBD, 0xCC, 0x1C, 0x13, 
+0x31, 0x57, 0x61, 0x6B, 0xD2, 0x33, 0x1A, 0x2B, 
+0xE9, 0xF4, 0xFF, 0xE3, 0x10, 0x25, 0x55, 0x8F, 
+0xC8, 0xEA, 0x53, 0x0D, 0xB1, 0x1E, 0x24, 0x2B, 
+0x10, 0x77, 0xD8, 0x45, 0x6F, 0xF9, 0x29, 0x95, 
+0x90, 0x9C, 0xD6, 0x6A, 0xD4, 0xB6, 0x82, 0x96, 
+0xE0, 0x25, 0x23, 0x44, 0x33, 0xEE, 0x29, 0xCC, 
+0xC6, 0x45, 0x05, 0x9E, 0x6A, 0x89, 0xF7, 0x7C, 
+0x4B, 0x34, 0xFB, 0xDF, 0xF8, 0xAB, 0xFC, 0x98, 
+0x44, 0x89, 0xE9, 0x9C, 0x92, 0x31, 0x44, 0x9D, 
+0x90, 0x5F, 0xA9, 0x4F, 0x42, 0x08, 0x10, 0x4B, 
+0x65, 0xC2, 0x12, 0x0A, 0xAA, 0x7E, 0xD9, 0x40, 
+0xFE, 0xE5, 0xC6, 0x9F, 0xBB, 0xB8, 0x88, 0xA2, 
+0x95, 0x9D, 0x46, 0x12, 0xF7, 0x9C, 0x41, 0x29, 
+0x7F, 0x91, 0xA1, 0x64, 0x85, 0x09, 0x12, 0x40, 
+0x2D, 0x04, 0x53, 0x82, 0x40, 0x2D, 0x76, 0x93, 
+0xF8, 0xC8, 0x06, 0xFA, 0x78, 0xC2, 0x7B, 0xE6, 
+0xBC, 0x9B, 0x36, 0x3F, 0xCB, 0x5D, 0xAD, 0xB6, 
+0x07, 0x52, 0x7F, 0x22, 0xD1, 0xD4, 0x9C, 0x89, 
+0x4F, 0x1D, 0x37, 0xC5, 0xE9, 0xED, 0x54, 0x9D, 
+0x69, 0xFF, 0x0B, 0x04, 0x07, 0x19, 0xF7, 0xC7, 
+0x1B, 0x99, 0xAD, 0xEB, 0x00, 0xB9, 0x21, 0x9F, 
+0x36, 0xFB, 0x96, 0xA5, 0x9D, 0x6E, 0x3D, 0x7E, 
+0xB3, 0x53, 0x0A, 0xEE, 0x78, 0x61, 0xA7, 0x24, 
+0x36, 0x23, 0x26, 0x8B, 0xDB, 0xFF, 0x50, 0x55, 
+0xB9, 0xA6, 0xB3, 0x32, 0xF2, 0xD0, 0xCB, 0x7D, 
+0xC8, 0x2B, 0x2C, 0xF0, 0x5A, 0x60, 0x3B, 0x04, 
+0xFA, 0xF2, 0xA5, 0x9D, 0x98, 0x3B, 0xE3, 0x9E, 
+0xFB, 0x8C, 0xF4, 0x34, 
+
+0x2B, 0x18, 0x4A, 0x32, 0x84, 0x9B, 0x32, 0x78, 
+0x0E, 0x9B, 0x23, 0xF4, 0x26, 0xEB, 0x8E, 0xFB, 
+0xB5, 0x43, 0x32, 0xB8, 0xB7, 0x75, 0x55, 0x3E, 
+0xB4, 0x10, 0x18, 0x14, 0x75, 0xB6, 0x39, 0x45, 
+0x76, 0x38, 0x51, 0xBF, 0x6D, 0x87, 0xE0, 0xE1, 
+0xC6, 0xFB, 0x5C, 0x39, 0xE7, 0x9D, 0xCB, 0x00, 
+0x26, 0x10, 0x08, 0x4F, 0x9C, 0x8D, 0xED, 0xB0, 
+0x43, 0xE6, 0x9D, 0xB3, 0xDD, 0xC1, 0x24, 0xE8, 
+0x81, 0x0C, 0xD9, 0x8D, 0xEF, 0x2E, 0xDE, 0x55, 
+0x0D, 0x21, 0xD5, 0xA4, 0xEC, 0x9B, 0xF5, 0x5A, 
+0x3A, 0xDD, 0xA2, 0xDD, 0xBB, 0x32, 0x7D, 0xBD, 
+0x1A, 0x25, 0x2A, 0x67, 0xFF, 0xD5, 0x45, 0xC5, 
+0x54, 0x68, 0x1B, 0xDD, 0xD5, 0xA4, 0xCE, 0xF5, 
+0x06, 0x15, 0xF5, 0x7D, 0x6C, 0x25, 0x7C, 0xB5, 
+0x4C, 0x90, 0x5F, 0x97, 0xB0, 0x98, 0x81, 0x61, 
+0xEB, 0x93, 0x33, 0xAB, 0x93, 0x71, 0xD0, 0x68, 
+0xED, 0x58, 0x0D, 0x0F, 0xA4, 0xDD, 0xC8, 0xA5, 
+0x20, 0xDD, 0x9A, 0xAC, 0x3F, 0xBC, 0x85, 0x81, 
+0x5A, 0xBF, 0x88, 0x9C, 0xAF, 0x7A, 0x2C, 0x27, 
+0x72, 0x24, 0xBB, 0x5E, 0xB2, 0xEF, 0xD4, 0xDD, 
+0xFB, 0xBC, 0xDE, 0x33, 0xA5, 0xA4, 0x37, 0xA9, 
+0x36, 0x7F, 0x07, 0x84, 0xF2, 0x1C, 0x9C, 0xD3, 
+0x8E, 0x9A, 0xFE, 0xCB, 0x77, 0x9B, 0xEF, 0x5F, 
+0xF9, 0x89, 0xD6, 0xB7, 0xD3, 0xEE, 0x40, 0xBE, 
+0x48, 0xF4, 0x79, 0x8E, 0xB3, 0xA0, 0x1C, 0x63, 
+0xD0, 0x03, 0x26, 0x83, 0xB5, 0x66, 0x53, 0x1A, 
+0x09, 0xF4, 0x0D, 0x5A, 0x68, 0x3D, 0x96, 0x62, 
+0x94, 0xA2, 0x28, 0xAE, 0xFC, 0xAE, 0xE5, 0xC9, 
+0xA0, 0xB6, 0xBF, 0x16, 0x4D, 0x5D, 0xC6, 0xA4, 
+0xBB, 0x1D, 0x4D, 0x29, 0x21, 0x4B, 0xCE, 0xE1, 
+0xA4, 0xE4, 0x8B, 0xA5, 0x2B, 0x91, 0x7C, 0x2D, 
+0x74, 0xFE, 0xAB, 0x9B, 0xC0, 0x29, 0xAA, 0xE3, 
+0xA5, 0xB2, 0xF7, 0xBD, 0x07, 0xAA, 0x98, 0x2B, 
+0x70, 0x31, 0xA1, 0x28, 0xB6, 
+
+0x76, 0x63, 0x50, 0xF9, 0xDD, 0x42, 0x5E, 0x52, 
+0xF7, 0x11, 0x83, 0xF6, 0x23, 0x87, 0x99, 0x08, 
+0xB5, 0x65, 0x93, 0xA7, 0xFA, 0x5D, 0xDA, 0x5D, 
+0xD6, 0x05, 0xE5, 0xE1, 0x0A, 0xA1, 0xAA, 0x63, 
+0xA6, 0x81, 0xC7, 0xB1, 0xED, 0xB1, 0x29, 0x61, 
+0x35, 0x1D, 0xC8, 0x44, 0xE3, 0xDA, 0x1C, 0xE3, 
+0x9E, 0xAE, 0x71, 0xB0, 0xF8, 0x19, 0x72, 0x4E, 
+0xB6, 0x48, 0xB7, 0xEF, 0x05, 0x52, 0x6B, 0x64, 
+0x9F, 0x98, 0x52, 0x05, 0x8E, 0xB2, 0xCC, 0x77, 
+0x74, 0xFC, 0xE4, 0xB7, 0x4B, 0x9F, 0x85, 0xBD, 
+0x55, 0xA6, 0x2D, 0x97, 0x2E, 0x06, 0x0B, 0x0D, 
+0xCB, 0x1C, 0xD6, 0xF0, 0x30, 0xAC, 0xBF, 0xEC, 
+0xF6, 0x21, 0x49, 0x4A, 0x40, 0x8A, 0x2B, 0x59, 
+0x04, 0x1E, 0xB7, 0x71, 0x30, 0xAC, 0x81, 0xD8, 
+0x7D, 0xBC, 0x7E, 0xEF, 0x85, 0xC3, 0xCA, 0xA8, 
+0xE3, 0x29, 0x87, 0x57, 0x51, 0xFA, 0xBF, 0xBD, 
+0x8E, 0xAB, 0x1E, 0xE8, 0x85, 0x9F, 0xEC, 0xA7, 
+0xD1, 0x21, 0x57, 0x3D, 0xE4, 0xFD, 0xAC, 0xE8, 
+0x44, 0xCD, 0x53, 0x1C, 0xF2, 0x11, 0x9A, 0xA0, 
+0x34, 0x9D, 0x7D, 0x59, 0x3D, 0xCB, 0xC9, 0xD7, 
+0xC4, 0x5B, 0xA5, 0xD7, 0x3B, 0x8D, 0x2A, 0x1C, 
+0xBF, 0xB0, 0xCB, 0x44, 0x4B, 0x75, 0x6B, 0xF6, 
+0xEB, 0x37, 0x93, 0x18, 0x4A, 0xE8, 0x18, 0xE0, 
+0x53, 0x82, 0x75, 0x33, 0xB5, 0xF6, 0x0A, 0xBE, 
+0x69, 0x23, 0x2A, 0xF6, 0x0F, 0xA7, 0x87, 0xEA, 
+0x17, 0x49, 0x88, 0xA1, 0x96, 0x95, 0xC0, 0x93, 
+0x76, 0x20, 0x95, 0x19, 0xE7, 0x18, 0xF4, 0xD7, 
+0x68, 0x54, 0x9B, 0xE0, 0xBB, 0xC8, 0xDF, 0x5B, 
+0x40, 0x3A, 0x44, 0xA2, 0x29, 0x4F, 0xB1, 0x4E, 
+0x42, 0xC1, 0xC4, 0x6F, 0x92, 0xA1, 0x19, 0x63, 
+0xD3, 0x1A, 0xB0, 0xF1, 0xD2, 0x72, 0xDF, 0x80, 
+0xB2, 0xFD, 0xE1, 0x43, 0x32, 0x72, 0xA0, 0x25, 
+0x3C, 0x1C, 0x58, 0x7B, 0x1A, 0x2F, 0x87, 0x50, 
+0x41, 0x0B, 0x80, 0xBB, 0x85, 0xD2, 
+
+0x7C, 0x81, 0x46, 0x66, 0xE3, 0x92, 0x38, 0x72, 
+0xBC, 0xC2, 0x06, 0xC1, 0x91, 0x73, 0x12, 0xBD, 
+0x85, 0xFD, 0xEF, 0x3E, 0x2B, 0x08, 0x98, 0xAD, 
+0xED, 0x05, 0xAB, 0xD6, 0x71, 0x84, 0x65, 0x83, 
+0xFF, 0x19, 0x2B, 0x54, 0x77, 0xE7, 0x1E, 0xF8, 
+0xCB, 0x52, 0x81, 0x24, 0x66, 0x43, 0x56, 0xBD, 
+0xF8, 0x1D, 0x6A, 0x1E, 0x05, 0x29, 0x40, 0x49, 
+0x8E, 0x41, 0x4E, 0x39, 0x79, 0x7B, 0xA4, 0xFE, 
+0xB7, 0xE1, 0xCE, 0x93, 0xFC, 0x75, 0xA4, 0xC7, 
+0x50, 0x1A, 0x87, 0x23, 0xF4, 0xCB, 0x96, 0x34, 
+0x2F, 0x57, 0xF4, 0x03, 0x67, 0x0F, 0xC1, 0xA0, 
+0x23, 0x6C, 0x96, 0x77, 0x58, 0xB2, 0x53, 0xCB, 
+0x05, 0x77, 0x82, 0x0D, 0x43, 0xDE, 0x26, 0x15, 
+0xEC, 0x36, 0x7C, 0x22, 0x76, 0xBB, 0x26, 0x18, 
+0x49, 0x53, 0x3A, 0x07, 0x55, 0x6F, 0x14, 0xE9, 
+0xCC, 0xB6, 0xA2, 0xB0, 0x05, 0x08, 0x25, 0x91, 
+0x0F, 0x99, 0x71, 0xD9, 0xF8, 0xDA, 0xE2, 0xB1, 
+0x6A, 0x82, 0x98, 0x4C, 0x2A, 0xE3, 0x7A, 0xDF, 
+0x35, 0x0E, 0x64, 0xAD, 0xE4, 0x9C, 0xE1, 0x50, 
+0x7F, 0x71, 0x1D, 0xB7, 0x92, 0x80, 0x87, 0x76, 
+0xCB, 0x38, 0xEE, 0x4D, 0x3B, 0xFD, 0x77, 0xC2, 
+0x84, 0xCF, 0x5D, 0x76, 0x64, 0x44, 0x1B, 0x12, 
+0x18, 0x82, 0x7E, 0x48, 0x41, 0x55, 0xCC, 0xF5, 
+0x1F, 0x89, 0x6B, 0x52, 0xD9, 0x29, 0x90, 0x0D, 
+0x06, 0x95, 0x0E, 0xF5, 0xF1, 0xAD, 0x33, 0xFA, 
+0x28, 0x1D, 0x8F, 0x4B, 0x4F, 0xFA, 0x85, 0x19, 
+0x62, 0xD8, 0x24, 0xF2, 0xEE, 0x27, 0x4B, 0xF6, 
+0x7F, 0xE1, 0xB2, 0xC0, 0x98, 0xC0, 0x09, 0xE4, 
+0xFF, 0x33, 0x2E, 0x7A, 0xAE, 0x1F, 0x3D, 0xED, 
+0xBB, 0xA0, 0x58, 0x36, 0xEF, 0x50, 0x16, 0x1E, 
+0xEE, 0xE4, 0x76, 0x02, 0x1E, 0x87, 0x47, 0x2B, 
+0x44, 0x17, 0x6C, 0x58, 0x14, 0x5E, 0x55, 0xF9, 
+0xC0, 0x64, 0x3A, 0xD8, 0xB0, 0xF2, 0x83, 0x81, 
+0xC4, 0x68, 0x9A, 0x90, 0xE9, 0x97, 0x79, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,4966 @@
+package utils
+func getkat3261_v30(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x16, 0xD9, 0xF9, 0xE9, 0x7A, 0xD1, 0xB5, 0xFC, 
+0xCD, 0x92, 0x17, 0x7E, 0x50, 0x41, 0xD6, 0x85, 
+
+0x4C, 0xC2, 0xD9, 0xE1, 0x11, 0xE0, 0x95, 0x21, 
+0x32, 0xDD, 0x1E, 0xE9, 0xB0, 0x52, 0x36, 0x72, 
+0x5E, 
+
+0x57, 0x52, 0x0B, 0x3E, 0x39, 0x22, 0xC2, 0xCC, 
+0x6C, 0x4C, 0xF0, 0x5B, 0x32, 0xDE, 0xDC, 0x3E, 
+0x2B, 0x78, 
+
+0x5C, 0xAB, 0x88, 0x7E, 0x3F, 0x4E, 0x9B, 0x0D, 
+0x13, 0xC3, 0xB1, 0x3D, 0x3D, 0x5E, 0x85, 0x31, 
+0x18, 0x3F, 0xDA, 
+
+0x47, 0x25, 0x12, 0x1B, 0xA1, 0x15, 0x55, 0x87, 
+0xD1, 0x2C, 0x15, 0x60, 0xEB, 0x1C, 0x91, 0x9D, 
+0xA6, 0x77, 0xBD, 0x20, 
+
+0x88, 0x92, 0x46, 0x55, 0xE8, 0x57, 0xD4, 0x50, 
+0xD5, 0x49, 0xD5, 0xA8, 0x9B, 0x2F, 0xCF, 0xBA, 
+0xA1, 0x99, 0xFC, 0xCD, 0xE0, 
+
+0x47, 0xCF, 0x5C, 0xE6, 0xD3, 0x60, 0xE6, 0xF7, 
+0x43, 0xDA, 0xE3, 0x66, 0x9E, 0xDE, 0xCC, 0x37, 
+0xBC, 0xF1, 0x3F, 0x8F, 0x22, 0xE7, 
+
+0xC4, 0x8F, 0x22, 0x4C, 0xB8, 0x20, 0xEA, 0x57, 
+0x41, 0xF7, 0x44, 0xC0, 0x27, 0xAC, 0x09, 0xF7, 
+0x33, 0xE2, 0x1C, 0xE4, 0x93, 0x9B, 0xE4, 
+
+0xF1, 0xF2, 0x2A, 0x1B, 0x46, 0x81, 0xF2, 0x70, 
+0xBB, 0x22, 0xA8, 0x2B, 0xDE, 0xDD, 0x63, 0x8F, 
+0x52, 0x8F, 0x15, 0x5D, 0x1C, 0x0F, 0x8A, 0x45, 
+
+0xA7, 0xD5, 0xFB, 0x17, 0x9F, 0xB6, 0xAB, 0x66, 
+0x93, 0x1B, 0xFB, 0xB7, 0xEF, 0xC9, 0x49, 0x57, 
+0xBE, 0xC1, 0x27, 0xB5, 0x5B, 0x21, 0x3E, 0xDF, 
+0xFA, 
+
+0x19, 0xC7, 0xD0, 0x65, 0xDC, 0x64, 0xBB, 0x51, 
+0x3F, 0xC8, 0xA8, 0x72, 0x97, 0x01, 0x37, 0xAB, 
+0x48, 0xD4, 0x47, 0x4E, 0xA4, 0x70, 0x05, 0x73, 
+0x27, 0x17, 
+
+0x10, 0x2E, 0x8B, 0x0A, 0xEB, 0xEF, 0x89, 0xD8, 
+0xE8, 0x18, 0xA5, 0xF9, 0xB4, 0x19, 0xF0, 0x26, 
+0xEB, 0x19, 0xCF, 0x0C, 0x28, 0xAE, 0xF0, 0x52, 
+0x05, 0xA1, 0xC6, 
+
+0xF2, 0xF4, 0xAE, 0x28, 0x91, 0x15, 0x30, 0xA3, 
+0xB7, 0x44, 0x10, 0xD9, 0xD9, 0x7E, 0xC7, 0xCA, 
+0x02, 0xB8, 0x9C, 0xD5, 0x0F, 0x16, 0x36, 0x6B, 
+0x54, 0x08, 0x60, 0x7A, 
+
+0xE5, 0x56, 0x2F, 0xFC, 0x32, 0xFE, 0x91, 0x95, 
+0x5A, 0x41, 0xDF, 0x42, 0x69, 0x48, 0x2C, 0x18, 
+0xF1, 0x06, 0xBC, 0x1B, 0x8C, 0xEF, 0x42, 0xE0, 
+0x5A, 0x0A, 0x3A, 0x25, 0xE7, 
+
+0x30, 0x6D, 0x52, 0xD2, 0x53, 0x87, 0x8C, 0x33, 
+0x34, 0x47, 0xDA, 0x75, 0x01, 0xF2, 0xCF, 0xA2, 
+0x2F, 0x3A, 0x55, 0xD9, 0xAE, 0xE9, 0x13, 0xA9, 
+0x90, 0x63, 0x39, 0x1E, 0x5E, 0xA7, 
+
+0xDC, 0x3E, 0xD8, 0x15, 0x64, 0x60, 0xC2, 0x41, 
+0xEB, 0x87, 0x83, 0x54, 0x98, 0x33, 0xBF, 0x6A, 
+0x4E, 0x1F, 0x70, 0x70, 0xC5, 0x66, 0x81, 0xE7, 
+0xDF, 0x01, 0x6F, 0xB8, 0xE3, 0x48, 0x21, 
+
+0xBE, 0xCD, 0x2A, 0x7F, 0x2E, 0xE5, 0x3A, 0x5E, 
+0x77, 0x50, 0x89, 0x0F, 0x89, 0xB6, 0xF7, 0x6B, 
+0x54, 0xE2, 0xAE, 0xC3, 0x6F, 0x98, 0xD0, 0x76, 
+0xB7, 0xF4, 0xD8, 0x1C, 0xC5, 0xC3, 0xCB, 0x53, 
+
+0xB3, 0xB4, 0xA7, 0x77, 0x86, 0xF3, 0xC3, 0xA1, 
+0x8F, 0xD1, 0x64, 0xF1, 0x62, 0x77, 0xA3, 0x47, 
+0x19, 0x2D, 0xDB, 0x49, 0x1E, 0x0D, 0xBB, 0xAF, 
+0x6B, 0x7C, 0x98, 0x69, 0xCA, 0x79, 0x82, 0x90, 
+0x77, 
+
+0x92, 0xC8, 0xD4, 0x42, 0x9D, 0x41, 0xD2, 0x8F, 
+0x9C, 0xE7, 0xAE, 0x14, 0xF6, 0xC2, 0xA1, 0x96, 
+0x04, 0x9D, 0x96, 0x79, 0xD1, 0x8C, 0x12, 0x0D, 
+0xAC, 0xA5, 0x8B, 0x05, 0x0E, 0x9A, 0x28, 0x1F, 
+0xC4, 0x12, 
+
+0xE4, 0x14, 0x66, 0x3C, 0xE5, 0x4F, 0x40, 0x20, 
+0x4C, 0x1C, 0xBF, 0xA1, 0x40, 0xA1, 0x40, 0xD5, 
+0x7B, 0xE6, 0xF3, 0x9E, 0xE5, 0x42, 0x45, 0x71, 
+0x3F, 0x9E, 0x86, 0x21, 0x85, 0xB4, 0x7B, 0x7A, 
+0xEB, 0x60, 0x39, 
+
+0x39, 0xD5, 0xC4, 0x6E, 0x96, 0xB4, 0x2F, 0x86, 
+0x2C, 0xF6, 0x10, 0xFC, 0x8A, 0xC1, 0x2A, 0x0A, 
+0xCA, 0x20, 0xDB, 0xE9, 0xF4, 0xBE, 0xCF, 0xA5, 
+0x03, 0xD8, 0x72, 0x7E, 0xD0, 0x33, 0xF5, 0x02, 
+0xB5, 0x5B, 0x7D, 0x3E, 
+
+0x1C, 0x4B, 0x2C, 0xC9, 0x42, 0x59, 0xD5, 0x5A, 
+0xFE, 0xA3, 0x0B, 0xAC, 0xA1, 0xE8, 0xAB, 0x17, 
+0x9B, 0x71, 0xBA, 0xD8, 0x98, 0x21, 0xBA, 0x4A, 
+0x13, 0xCE, 0xEB, 0x73, 0xD1, 0x10, 0x47, 0x5F, 
+0x51, 0x68, 0xA8, 0xC6, 0xCC, 
+
+0xD2, 0x19, 0x11, 0xED, 0x8B, 0x48, 0x8F, 0x75, 
+0x9E, 0xE9, 0x76, 0x8A, 0x06, 0x4B, 0x76, 0xA3, 
+0x68, 0x02, 0xAF, 0xCE, 0xF7, 0x7C, 0x04, 0xA7, 
+0xC4, 0x5D, 0x30, 0x18, 0xA4, 0x88, 0x11, 0x00, 
+0x60, 0x4A, 0xEF, 0xA4, 0x61, 0xB9, 
+
+0xCC, 0x42, 0xE5, 0xD0, 0x90, 0x4D, 0xE6, 0x2A, 
+0xF8, 0xF1, 0xDA, 0x82, 0x3A, 0x9C, 0x9F, 0x97, 
+0xFC, 0xA0, 0xAC, 0xE4, 0xFF, 0x5C, 0xC6, 0x12, 
+0xD1, 0x95, 0xF8, 0x2C, 0xA3, 0x5A, 0xA5, 0xC8, 
+0xA1, 0x95, 0x37, 0x30, 0xE3, 0x5E, 0x5E, 
+
+0xE0, 0xC3, 0x30, 0x79, 0xDF, 0x0D, 0x69, 0xC8, 
+0x56, 0xFC, 0x95, 0x48, 0x60, 0xF5, 0x48, 0x16, 
+0xB3, 0x94, 0x7C, 0xB6, 0xAA, 0xEB, 0x51, 0x02, 
+0xC3, 0x54, 0x37, 0x37, 0xDD, 0x47, 0x44, 0xEB, 
+0x57, 0x8F, 0x65, 0xF3, 0xA7, 0x65, 0x95, 0x75, 
+
+0xB7, 0x87, 0xA4, 0x85, 0x40, 0x29, 0x6E, 0x8A, 
+0xC9, 0x5E, 0x05, 0x8C, 0xF7, 0xB1, 0x53, 0xA9, 
+0xD0, 0xE2, 0x98, 0x53, 0x57, 0x73, 0x6F, 0xFC, 
+0x9A, 0xEE, 0x64, 0x42, 0xDB, 0xB8, 0x9D, 0xCA, 
+0xC3, 0x2A, 0xA0, 0x04, 0xF2, 0xE9, 0xAD, 0xFE, 
+0x45, 
+
+0x3B, 0xCA, 0x31, 0x1A, 0x2E, 0x10, 0x36, 0x25, 
+0x88, 0xDF, 0x56, 0x1E, 0xF0, 0xD4, 0x5E, 0x5E, 
+0x77, 0x2B, 0x0A, 0x10, 0x86, 0xD0, 0xF5, 0xA6, 
+0x06, 0xB0, 0x52, 0x20, 0xD9, 0x5A, 0x06, 0xDF, 
+0x9B, 0xAF, 0x4A, 0x5F, 0x3C, 0x35, 0x62, 0x5D, 
+0x4F, 0xE7, 
+
+0x89, 0xA0, 0x01, 0x58, 0xD5, 0xD3, 0x52, 0x9C, 
+0x2D, 0xFB, 0x16, 0xFF, 0x83, 0xC4, 0xD8, 0x46, 
+0x6E, 0x39, 0x16, 0xA8, 0xE2, 0x36, 0x11, 0xBA, 
+0xC6, 0x19, 0x46, 0xE5, 0xFE, 0xD2, 0xF5, 0xDD, 
+0xA3, 0x70, 0x82, 0x87, 0xB3, 0x7C, 0x52, 0xCA, 
+0x3C, 0x6E, 0xB7, 
+
+0xFF, 0x63, 0x8F, 0x49, 0x32, 0x31, 0xA0, 0xAA, 
+0xF2, 0xFA, 0x1F, 0xA5, 0x92, 0x22, 0xF4, 0x3F, 
+0x92, 0xF2, 0xE1, 0xF3, 0x96, 0x30, 0xB1, 0xE0, 
+0x0A, 0x7A, 0x50, 0x63, 0x69, 0xD2, 0x2E, 0xE7, 
+0x4F, 0xD0, 0x73, 0x81, 0x1E, 0xA2, 0x72, 0x06, 
+0x6F, 0xFD, 0xB9, 0x6F, 
+
+0xB5, 0xA1, 0x4F, 0x8C, 0x0F, 0x6C, 0x65, 0xD9, 
+0x67, 0x43, 0xF1, 0x24, 0x99, 0x16, 0x19, 0x54, 
+0xFE, 0x30, 0x1E, 0x31, 0x40, 0xA3, 0xD8, 0x9A, 
+0x05, 0xE4, 0x52, 0x63, 0x51, 0xBC, 0x15, 0xF6, 
+0x6F, 0x9C, 0xF0, 0xCD, 0xBA, 0x5E, 0x2C, 0x90, 
+0x32, 0xC1, 0x3E, 0xF5, 0x7F, 
+
+0x30, 0xBF, 0xD7, 0xCF, 0xA3, 0x1D, 0x1D, 0x64, 
+0xC3, 0xD4, 0xF6, 0x64, 0xC9, 0x4E, 0x54, 0x4A, 
+0xC9, 0xE3, 0x95, 0xE6, 0xE4, 0x68, 0x78, 0xB7, 
+0x32, 0xC8, 0x5E, 0x7F, 0x56, 0xDC, 0x43, 0x74, 
+0x5B, 0x3F, 0x62, 0x73, 0x76, 0x52, 0xEF, 0x9E, 
+0x1B, 0x85, 0xA8, 0x62, 0x83, 0x4F, 
+
+0xDF, 0x41, 0xD0, 0x9C, 0xCB, 0xF7, 0x13, 0x56, 
+0x50, 0x6C, 0x76, 0x1D, 0x55, 0xD7, 0xFD, 0xDA, 
+0xF1, 0x3A, 0x35, 0xE4, 0x14, 0xFF, 0x25, 0xC1, 
+0x86, 0x9B, 0xC4, 0xDE, 0x97, 0x20, 0x93, 0x7A, 
+0x4C, 0x1B, 0xF3, 0x63, 0x52, 0x6F, 0xB1, 0xAB, 
+0xDF, 0x9C, 0x92, 0x57, 0xB7, 0x32, 0xD4, 
+
+0x78, 0xE3, 0x0B, 0x11, 0xA2, 0xFD, 0x73, 0x51, 
+0xEA, 0x48, 0x08, 0xDA, 0xCE, 0x98, 0x3F, 0xCA, 
+0x6C, 0xD6, 0x26, 0xAF, 0x28, 0x82, 0xEE, 0x50, 
+0x43, 0x4B, 0x3E, 0x3B, 0xEC, 0x8B, 0xE9, 0xFC, 
+0xE5, 0xE6, 0x09, 0x52, 0xF1, 0x79, 0xEB, 0xA5, 
+0xC0, 0x8F, 0x7A, 0x7C, 0x13, 0x39, 0xF6, 0x0C, 
+
+0xF2, 0xF2, 0xEE, 0xEB, 0x54, 0x44, 0x5E, 0x4F, 
+0xCF, 0xB1, 0x51, 0xBF, 0x65, 0x60, 0xCD, 0x8F, 
+0x35, 0x6D, 0xDF, 0x5B, 0x81, 0x9F, 0x59, 0x71, 
+0xC2, 0x46, 0x05, 0x94, 0x83, 0x71, 0x0E, 0xDE, 
+0x84, 0xA3, 0x5E, 0xFB, 0x07, 0xF3, 0x78, 0xD9, 
+0x8C, 0xB1, 0x89, 0x5F, 0xA5, 0x42, 0xA2, 0x2C, 
+0x4C, 
+
+0x1C, 0x16, 0x1F, 0xCF, 0x61, 0x27, 0xDC, 0xC3, 
+0xB9, 0xE0, 0x86, 0x7C, 0xF8, 0x0D, 0x71, 0x8C, 
+0x2E, 0x39, 0xB6, 0x74, 0x5A, 0x9C, 0x53, 0x07, 
+0x79, 0x24, 0xBC, 0x9E, 0xCF, 0x6A, 0x65, 0x8E, 
+0x48, 0xCF, 0xAD, 0x43, 0xAB, 0x24, 0x05, 0x8E, 
+0xB8, 0xA7, 0xC6, 0x64, 0x53, 0xB2, 0x56, 0x49, 
+0xE5, 0x6B, 
+
+0x8F, 0x42, 0xB7, 0x2A, 0x6F, 0x46, 0x09, 0x9C, 
+0x93, 0x3E, 0x74, 0x07, 0x4D, 0x67, 0x03, 0x7A, 
+0xCD, 0xAE, 0xFC, 0x14, 0x8A, 0xC9, 0xF7, 0xBC, 
+0xFB, 0x90, 0xF3, 0xF5, 0xCA, 0x11, 0xF3, 0xF4, 
+0x6B, 0xDB, 0xC7, 0x4F, 0x28, 0x6D, 0xAC, 0xC1, 
+0xB8, 0x5D, 0xD5, 0x04, 0x40, 0x79, 0x66, 0x9C, 
+0x40, 0x65, 0x02, 
+
+0xF9, 0x32, 0xEA, 0xC7, 0xAD, 0x8C, 0x01, 0x95, 
+0xA7, 0x92, 0xEF, 0x97, 0x47, 0x76, 0xA9, 0x28, 
+0x07, 0xC6, 0xE2, 0x14, 0xAD, 0xF8, 0x6C, 0xA6, 
+0xF5, 0x70, 0x19, 0x49, 0xA2, 0x37, 0x12, 0xC1, 
+0x9C, 0xA6, 0xF9, 0x8B, 0x6B, 0x8B, 0xC6, 0x8D, 
+0x51, 0x7F, 0x48, 0x9E, 0x99, 0x7B, 0xEE, 0xF9, 
+0x4D, 0xF1, 0x1B, 0x25, 
+
+0x49, 0x6B, 0xD3, 0xFD, 0xC6, 0x83, 0x90, 0xDE, 
+0x32, 0x51, 0x1C, 0xAA, 0xFB, 0x05, 0xF2, 0xDF, 
+0xA0, 0x2F, 0x68, 0x73, 0xD5, 0x62, 0xA0, 0x68, 
+0x71, 0xE3, 0xF6, 0x3C, 0x58, 0xED, 0xBB, 0xBC, 
+0x85, 0xE1, 0xF1, 0xD9, 0x3A, 0x2E, 0xEC, 0x6E, 
+0x82, 0x92, 0x80, 0xE1, 0xD5, 0x5A, 0x53, 0x32, 
+0x11, 0xF4, 0x10, 0xB5, 0x15, 
+
+0x70, 0x60, 0x74, 0xF9, 0x30, 0xA7, 0xD2, 0x61, 
+0xFF, 0x1C, 0x2B, 0x80, 0x0B, 0x91, 0xAD, 0xD3, 
+0x1D, 0x08, 0xB6, 0xE0, 0x70, 0x02, 0x26, 0x62, 
+0xCD, 0x02, 0x11, 0x2D, 0x35, 0x3B, 0x54, 0x60, 
+0x2F, 0xE8, 0xFD, 0xC8, 0x1A, 0x5F, 0xAB, 0xF0, 
+0x0F, 0x90, 0xE4, 0xC2, 0x4D, 0xBE, 0x1B, 0x9B, 
+0xC9, 0x6B, 0x9F, 0xA4, 0x80, 0x04, 
+
+0x75, 0x64, 0x44, 0x63, 0x80, 0x31, 0xE4, 0x00, 
+0x59, 0xA0, 0x09, 0x11, 0x6B, 0x13, 0xAE, 0x73, 
+0x87, 0x0F, 0xCD, 0xE2, 0xAB, 0x11, 0x8D, 0xF0, 
+0x43, 0xCC, 0xD7, 0x85, 0x05, 0xDE, 0xF4, 0x2A, 
+0x7A, 0x11, 0xC8, 0x98, 0xD5, 0x1C, 0x79, 0xDD, 
+0x33, 0x70, 0xF0, 0x0A, 0x85, 0x46, 0x38, 0x90, 
+0x2B, 0x1D, 0x45, 0x37, 0x6B, 0x4F, 0xA1, 
+
+0x01, 0xAF, 0x04, 0xB5, 0xBF, 0x73, 0x0C, 0xFA, 
+0x2F, 0x24, 0x23, 0xF9, 0xA5, 0xE6, 0x55, 0x4B, 
+0x85, 0xD9, 0xF4, 0x65, 0x23, 0x0C, 0x8C, 0x9D, 
+0xCC, 0xBD, 0x0E, 0xB3, 0xFE, 0x10, 0x84, 0x5A, 
+0xAD, 0x5B, 0xE5, 0x17, 0x6B, 0x6C, 0x72, 0x5C, 
+0x26, 0xC3, 0x32, 0x3D, 0xA9, 0xC1, 0xBF, 0x5C, 
+0xC4, 0x5B, 0x65, 0xB3, 0xC8, 0x71, 0x71, 0x63, 
+
+0x6F, 0x81, 0x14, 0xDD, 0xFF, 0xDE, 0x05, 0xFF, 
+0xF2, 0x59, 0xA2, 0x7C, 0x80, 0xDB, 0x2B, 0x1C, 
+0x55, 0x30, 0xD8, 0x3E, 0xC6, 0xA5, 0x24, 0x3F, 
+0x13, 0xB5, 0x25, 0x38, 0x99, 0xB1, 0x14, 0x86, 
+0x15, 0x5D, 0x07, 0xE2, 0xDF, 0x61, 0x3E, 0xD2, 
+0x90, 0x8C, 0x7E, 0x06, 0x08, 0x81, 0x5F, 0x51, 
+0x5F, 0x77, 0x45, 0xC9, 0x71, 0xB4, 0x50, 0x3F, 
+0x65, 
+
+0x2D, 0x69, 0x32, 0xD2, 0x44, 0xA6, 0xE7, 0xAC, 
+0xC4, 0x0B, 0x6F, 0x03, 0xAA, 0x78, 0x51, 0x75, 
+0x56, 0xC0, 0xA2, 0xC9, 0x96, 0x39, 0x16, 0x3F, 
+0x70, 0xB5, 0x5B, 0xBE, 0xC1, 0x8A, 0x74, 0xEB, 
+0x68, 0xD8, 0x70, 0x8D, 0xCC, 0x99, 0x56, 0xE5, 
+0x38, 0x71, 0xD6, 0xB8, 0x07, 0x3D, 0x0B, 0x54, 
+0xF2, 0xBA, 0x9B, 0x8E, 0x8C, 0xA7, 0xE8, 0x49, 
+0xFB, 0xA6, 
+
+0x19, 0x8C, 0x32, 0x14, 0x66, 0xBD, 0xAB, 0x01, 
+0xAE, 0x0F, 0xAE, 0xBE, 0x61, 0x4B, 0xE5, 0x59, 
+0xD3, 0x21, 0x8A, 0x7E, 0xA5, 0xBF, 0x28, 0x3D, 
+0x9F, 0x5B, 0xE7, 0x61, 0x87, 0x93, 0x99, 0xEA, 
+0xF5, 0x8D, 0x30, 0x51, 0x31, 0x83, 0x86, 0x5E, 
+0xF5, 0x6E, 0xFB, 0x30, 0x0D, 0x50, 0x46, 0x5E, 
+0x4E, 0xA0, 0xA1, 0x97, 0x8F, 0x5A, 0x42, 0x19, 
+0x21, 0xBD, 0x0F, 
+
+0x56, 0x5C, 0xFA, 0x25, 0x3F, 0x84, 0xDD, 0x2A, 
+0xA8, 0xDF, 0x26, 0x43, 0x13, 0xE7, 0x07, 0xDA, 
+0xB9, 0x97, 0x5A, 0x39, 0x57, 0x41, 0xAE, 0x27, 
+0x7D, 0x10, 0x14, 0x16, 0x0F, 0x10, 0xC8, 0x77, 
+0x31, 0x55, 0x40, 0x0F, 0xC5, 0xFB, 0xCE, 0x2A, 
+0x3D, 0xA8, 0xA0, 0x63, 0xF6, 0x48, 0x0F, 0x25, 
+0x43, 0xB1, 0x45, 0x55, 0x86, 0x97, 0xC2, 0x3B, 
+0x62, 0x40, 0x49, 0x2F, 
+
+0x6E, 0x8A, 0x45, 0xA3, 0x19, 0x39, 0xB8, 0x88, 
+0x4A, 0xCD, 0x70, 0x26, 0x79, 0xBF, 0x7A, 0x62, 
+0xB1, 0xBF, 0x2A, 0x81, 0x5D, 0xA7, 0x5F, 0xB5, 
+0xB0, 0x34, 0xEB, 0x0D, 0xCD, 0x44, 0x3F, 0xB6, 
+0xCB, 0xEF, 0x5D, 0x3F, 0x51, 0x99, 0x06, 0x12, 
+0xF9, 0x4B, 0xAE, 0x6D, 0x65, 0xED, 0x0E, 0x26, 
+0xCD, 0x40, 0xFA, 0x4F, 0x2F, 0x86, 0x47, 0xB9, 
+0x9D, 0x0A, 0x36, 0xC7, 0xD3, 
+
+0x45, 0xBE, 0x14, 0x1E, 0xCD, 0xCC, 0x5E, 0x75, 
+0x00, 0x2D, 0xC2, 0x98, 0x05, 0x39, 0xDB, 0x81, 
+0xE5, 0x34, 0x3F, 0xBB, 0x9D, 0x46, 0x35, 0x13, 
+0xB2, 0x5D, 0x4C, 0x9B, 0x0D, 0x2C, 0x3D, 0x27, 
+0xEF, 0x9B, 0x1B, 0x63, 0x37, 0x66, 0x43, 0x73, 
+0xAC, 0x5E, 0x93, 0xD6, 0x2E, 0x66, 0xB8, 0x82, 
+0x3B, 0x59, 0xDE, 0x0D, 0x3B, 0x23, 0x48, 0x3A, 
+0xB1, 0x49, 0x7A, 0xA6, 0xB0, 0xC8, 
+
+0x37, 0x48, 0x50, 0x02, 0xDC, 0x14, 0xF0, 0xCD, 
+0xC6, 0x21, 0x63, 0x5E, 0x8E, 0x4C, 0xAE, 0x8E, 
+0x27, 0x8D, 0x11, 0x99, 0xD3, 0x55, 0x55, 0x33, 
+0xCF, 0xCE, 0xFE, 0x9A, 0x88, 0x08, 0x06, 0x57, 
+0x68, 0x97, 0x46, 0xD8, 0xE5, 0x2B, 0x13, 0x95, 
+0x2C, 0xEE, 0x9F, 0x5F, 0xD1, 0x9E, 0x08, 0xBE, 
+0x3F, 0xA1, 0xDC, 0x33, 0x1E, 0x5F, 0xEA, 0xAE, 
+0x5F, 0xD6, 0xFA, 0xF0, 0x87, 0xA0, 0x54, 
+
+0x96, 0xAB, 0x88, 0x6A, 0xD8, 0xBA, 0x56, 0x22, 
+0x07, 0x2C, 0x5A, 0x88, 0xAE, 0x8E, 0xF9, 0x4E, 
+0x7C, 0x0A, 0xE6, 0x45, 0x54, 0x3B, 0x9E, 0xFB, 
+0xD2, 0xE6, 0xA2, 0x2B, 0xD6, 0x5B, 0x56, 0x74, 
+0xBE, 0xDA, 0xC8, 0x91, 0x8B, 0x6E, 0x66, 0x7A, 
+0x83, 0x90, 0x23, 0xD0, 0xC9, 0x6D, 0x7D, 0xBC, 
+0x60, 0xF3, 0x8C, 0x50, 0xA1, 0xE0, 0x32, 0xFD, 
+0xD2, 0xD6, 0x51, 0xEC, 0x36, 0x0D, 0xE5, 0x6C, 
+
+0xFB, 0xB1, 0x29, 0x34, 0x23, 0xC6, 0x78, 0x02, 
+0xD8, 0x21, 0xDB, 0x46, 0xCC, 0x04, 0x78, 0x52, 
+0xB1, 0x1B, 0xA9, 0x87, 0x75, 0x8F, 0x0B, 0x65, 
+0xCB, 0xC9, 0x18, 0xD3, 0xCC, 0x5B, 0xD4, 0xBD, 
+0xE8, 0x1D, 0x5B, 0x30, 0xEA, 0x9E, 0xDD, 0xE1, 
+0xD9, 0xE8, 0x26, 0x18, 0x19, 0x53, 0x3A, 0x04, 
+0x27, 0x29, 0x7B, 0xB6, 0x78, 0x58, 0x70, 0x25, 
+0xB7, 0x22, 0x05, 0xAC, 0x7C, 0xE7, 0xB3, 0x8A, 
+0xD0, 
+
+0xF9, 0xA8, 0x9C, 0x48, 0xA3, 0xD5, 0xE9, 0x3D, 
+0xD2, 0xD2, 0xA8, 0x3A, 0xC8, 0x94, 0xDD, 0xAF, 
+0xA3, 0x55, 0x97, 0x0C, 0xFB, 0x9E, 0xA8, 0x08, 
+0xF4, 0x33, 0x75, 0x87, 0x94, 0x28, 0xDD, 0xB3, 
+0xEE, 0x82, 0x75, 0x87, 0x73, 0x7C, 0xBA, 0xA2, 
+0x3D, 0x0A, 0x3C, 0x27, 0xFC, 0xCD, 0xDC, 0xB1, 
+0xB1, 0xE3, 0x4F, 0xFF, 0xFC, 0xA7, 0x4E, 0xED, 
+0xF2, 0xF8, 0x61, 0x29, 0x63, 0xD3, 0x81, 0xC4, 
+0xE1, 0x0B, 
+
+0x83, 0xBD, 0x60, 0x06, 0x2B, 0x50, 0x7B, 0x39, 
+0x5F, 0xC8, 0x2B, 0x13, 0x95, 0x04, 0x51, 0x2A, 
+0x33, 0xCD, 0x20, 0x09, 0xC0, 0x50, 0xAD, 0x97, 
+0xF8, 0x44, 0x61, 0x64, 0x9C, 0xCD, 0xB4, 0xCF, 
+0x3E, 0xEF, 0x33, 0x6B, 0x4D, 0xE3, 0x12, 0x4D, 
+0x23, 0xAA, 0xAA, 0xE6, 0x90, 0xF5, 0xA4, 0x8B, 
+0x0E, 0x2B, 0x14, 0xC9, 0x49, 0x58, 0x35, 0x3C, 
+0x33, 0x8B, 0x68, 0xB4, 0x46, 0xF5, 0x66, 0x68, 
+0x78, 0xA3, 0xF4, 
+
+0x42, 0xE0, 0x8B, 0x46, 0xE7, 0x10, 0x2F, 0x43, 
+0x7F, 0xCB, 0xAC, 0xAE, 0xCA, 0x2D, 0x49, 0x2F, 
+0x5F, 0x58, 0x9F, 0x49, 0xC3, 0xF7, 0x9F, 0x83, 
+0x6D, 0xD9, 0x9D, 0x0B, 0xB6, 0x52, 0x1C, 0xE3, 
+0x74, 0xC2, 0x2E, 0xCF, 0x9D, 0x79, 0x23, 0x80, 
+0x70, 0x2B, 0x67, 0x0C, 0x45, 0x76, 0xF5, 0x9D, 
+0x2F, 0xB7, 0xA8, 0xAC, 0x50, 0x88, 0x75, 0x3A, 
+0xD1, 0x93, 0x8B, 0x83, 0x38, 0xDC, 0x7B, 0x61, 
+0x83, 0x47, 0xEA, 0x2F, 
+
+0x5B, 0x1C, 0x82, 0x21, 0x11, 0xC8, 0x63, 0x67, 
+0x7B, 0x0C, 0x2C, 0x65, 0x86, 0x1D, 0x89, 0x03, 
+0x3A, 0x1C, 0x8A, 0x72, 0x4E, 0x66, 0x62, 0xC0, 
+0xCF, 0xDB, 0x91, 0x87, 0x4A, 0x4E, 0xCA, 0xCA, 
+0x3C, 0x0A, 0x93, 0xE9, 0x8C, 0xFD, 0x45, 0x6E, 
+0x96, 0xEB, 0xE3, 0xA8, 0x04, 0x93, 0x74, 0xEC, 
+0x74, 0x70, 0x18, 0x24, 0xD6, 0x2B, 0xFB, 0x64, 
+0xF6, 0x2D, 0xC5, 0x26, 0xE6, 0x3F, 0xE4, 0xD7, 
+0x13, 0x85, 0x98, 0x2E, 0x22, 
+
+0xEB, 0x20, 0x3B, 0xF5, 0x9A, 0x5C, 0xAE, 0x26, 
+0x2F, 0x22, 0xE3, 0xCD, 0x8C, 0xB4, 0xB0, 0xEB, 
+0x47, 0xFD, 0xA1, 0x1B, 0xA1, 0xB0, 0x4F, 0xFC, 
+0x2E, 0xBD, 0x8C, 0x2D, 0x30, 0xB5, 0x44, 0x23, 
+0xB4, 0x62, 0x5B, 0x39, 0xCD, 0x62, 0x3D, 0x7D, 
+0x8B, 0xFC, 0x93, 0x1D, 0xDD, 0x42, 0x4E, 0x4D, 
+0x76, 0x77, 0x7E, 0xEB, 0xDB, 0xFF, 0xDF, 0x5A, 
+0x4E, 0x12, 0x29, 0xC7, 0x20, 0xC1, 0x69, 0xE6, 
+0xFA, 0xE7, 0x6A, 0x31, 0x79, 0x70, 
+
+0x00, 0x0F, 0xC7, 0xC7, 0x39, 0xF5, 0x9B, 0x17, 
+0xB4, 0xA9, 0x5E, 0x84, 0x31, 0xFA, 0xAE, 0xBC, 
+0xC4, 0x45, 0xD2, 0xD9, 0xF4, 0x13, 0x88, 0x11, 
+0x8E, 0xD3, 0x1F, 0x59, 0x56, 0x85, 0x72, 0xAB, 
+0x62, 0x21, 0xD4, 0x4B, 0x96, 0x48, 0xA7, 0x3E, 
+0x05, 0x5A, 0x0F, 0x1E, 0x0C, 0x56, 0x0D, 0xA3, 
+0x2B, 0xBD, 0x3D, 0x0C, 0xB8, 0xA4, 0xF6, 0x75, 
+0x4C, 0xE0, 0xF7, 0x51, 0x1F, 0x20, 0x60, 0xB3, 
+0x7B, 0xD0, 0x18, 0xCB, 0x42, 0xCB, 0x77, 
+
+0x76, 0xBD, 0xDD, 0xAD, 0xC4, 0xBF, 0x02, 0x2F, 
+0xEA, 0x30, 0xD9, 0xF6, 0x2D, 0x23, 0xB8, 0x45, 
+0x44, 0x1E, 0xAA, 0xCC, 0xE7, 0x73, 0xF0, 0x96, 
+0x1D, 0x10, 0x4A, 0x7B, 0xF4, 0x55, 0x1C, 0x8A, 
+0x7C, 0xEF, 0xFC, 0xEB, 0xD3, 0xDD, 0x58, 0x17, 
+0x82, 0xCA, 0x46, 0x1B, 0x7A, 0xAB, 0xCB, 0x46, 
+0xB5, 0xF8, 0xDA, 0xE7, 0xFB, 0x6B, 0x3D, 0xA8, 
+0x63, 0x59, 0x6A, 0x19, 0x41, 0xD5, 0x7A, 0x3A, 
+0xD4, 0x56, 0xFE, 0x2B, 0xAB, 0x36, 0x11, 0xFA, 
+
+0x9B, 0xA1, 0x89, 0x3C, 0x5C, 0x56, 0xBD, 0x07, 
+0xCC, 0x99, 0xE8, 0x0E, 0x9B, 0x16, 0x45, 0xFA, 
+0x69, 0xBF, 0x6B, 0xED, 0x3B, 0xBD, 0xF0, 0x2C, 
+0x84, 0x4A, 0x62, 0x39, 0x0F, 0x37, 0xBF, 0xF1, 
+0x8D, 0xC0, 0x09, 0x7F, 0x5D, 0x92, 0xC5, 0x3B, 
+0x5C, 0xF7, 0xE5, 0xE0, 0x4E, 0x17, 0x01, 0xA9, 
+0xF6, 0x8A, 0x95, 0x69, 0x8E, 0xCD, 0x71, 0x73, 
+0xD4, 0x71, 0x7B, 0x7A, 0x4C, 0xBF, 0x38, 0x61, 
+0x11, 0x36, 0x08, 0x68, 0x59, 0x07, 0x0C, 0x91, 
+0xC7, 
+
+0x43, 0x81, 0x01, 0x67, 0xA8, 0xF4, 0x61, 0x4B, 
+0xAF, 0x2F, 0xFD, 0x29, 0xC4, 0xE6, 0x28, 0x1B, 
+0xEE, 0x29, 0xB2, 0x5D, 0x60, 0x25, 0x9E, 0x1E, 
+0x7D, 0x1D, 0xD2, 0x43, 0x59, 0x4E, 0x64, 0x34, 
+0x95, 0xA1, 0x7E, 0x19, 0x1C, 0x73, 0xB8, 0xE5, 
+0x42, 0xEC, 0xC2, 0x9E, 0x0A, 0x18, 0x99, 0xEE, 
+0x44, 0x16, 0x92, 0x59, 0x93, 0x02, 0x2B, 0x02, 
+0xFE, 0xC8, 0x47, 0xE2, 0x89, 0xC5, 0xB2, 0x5C, 
+0x95, 0xBC, 0xD5, 0xB7, 0x47, 0x12, 0xBE, 0xBA, 
+0xFC, 0x8C, 
+
+0xE1, 0x90, 0xEB, 0xE6, 0x41, 0x7A, 0x8C, 0xEF, 
+0x12, 0x5A, 0xF1, 0xD9, 0x33, 0x39, 0xD2, 0x1A, 
+0x9D, 0xE4, 0xF4, 0x53, 0x9F, 0x15, 0x89, 0xA2, 
+0x4B, 0xF7, 0x4E, 0x7E, 0x5A, 0xE5, 0xCC, 0x7E, 
+0x4B, 0x2A, 0x7E, 0x88, 0x71, 0x06, 0xA5, 0xB5, 
+0x63, 0x8B, 0xEC, 0x76, 0x94, 0x82, 0x96, 0xC4, 
+0x1D, 0x73, 0x47, 0xDC, 0x33, 0xE4, 0x6C, 0xCD, 
+0xC2, 0x66, 0x89, 0xE1, 0x7C, 0xA6, 0x44, 0x8F, 
+0x15, 0x32, 0x2D, 0x2A, 0xBA, 0x3B, 0x2F, 0x49, 
+0xC3, 0x98, 0x02, 
+
+0x9B, 0xE4, 0xCE, 0x1A, 0x07, 0xAA, 0xB2, 0xB5, 
+0x13, 0x6F, 0xC5, 0x58, 0x50, 0x90, 0x15, 0xF7, 
+0xA2, 0xDE, 0xD6, 0x6C, 0x4C, 0xF2, 0x51, 0x98, 
+0xDE, 0xE2, 0x99, 0x68, 0x5C, 0xA3, 0xF4, 0xCC, 
+0x8E, 0xE1, 0xBA, 0x87, 0xC4, 0x89, 0xCD, 0x6B, 
+0x93, 0xA0, 0x0C, 0xA7, 0x28, 0x0D, 0x45, 0x01, 
+0x6D, 0x32, 0x81, 0x8E, 0x4B, 0xB8, 0x84, 0xE4, 
+0x8E, 0x04, 0x01, 0x9F, 0xFB, 0xFE, 0x96, 0x61, 
+0xD2, 0x3E, 0xCF, 0x1B, 0xA6, 0xE2, 0x55, 0x76, 
+0x6C, 0x8C, 0x05, 0x42, 
+
+0x99, 0xBB, 0x86, 0xFA, 0x48, 0x0F, 0x96, 0xAA, 
+0x40, 0xA7, 0xD4, 0xCA, 0x0D, 0xD4, 0x21, 0x09, 
+0x85, 0x19, 0xF8, 0x61, 0xD7, 0x3E, 0x75, 0x62, 
+0xF1, 0x48, 0x83, 0x69, 0x5B, 0x7E, 0x87, 0x62, 
+0xF3, 0x3B, 0xED, 0xD7, 0x9E, 0xC1, 0xBB, 0x2C, 
+0x2B, 0x33, 0xF4, 0xE3, 0xDA, 0x05, 0xA5, 0x47, 
+0xB4, 0xEB, 0x6A, 0x55, 0x66, 0xF3, 0xFA, 0x87, 
+0x70, 0xDD, 0xF1, 0x09, 0x48, 0x58, 0x68, 0x6B, 
+0x88, 0x83, 0x19, 0xB9, 0x3A, 0x3B, 0x8F, 0x07, 
+0xC7, 0xE3, 0xD2, 0x83, 0xD2, 
+
+0xE2, 0x9D, 0x91, 0x8D, 0xBF, 0x92, 0x0B, 0x58, 
+0x9B, 0x1A, 0x7D, 0x28, 0x11, 0xAE, 0xF9, 0x7E, 
+0x33, 0xBB, 0x5A, 0x64, 0xFB, 0x1F, 0x01, 0x5B, 
+0x86, 0x0F, 0x78, 0xA1, 0xB8, 0x05, 0x21, 0x88, 
+0x9E, 0x4F, 0xA4, 0x25, 0xB0, 0xEF, 0x9E, 0x7E, 
+0x63, 0xAC, 0xDB, 0x6D, 0x3B, 0x0F, 0x72, 0x78, 
+0xF3, 0xC2, 0x6C, 0xF7, 0x60, 0xF0, 0x4B, 0x13, 
+0x8A, 0x82, 0x4D, 0x87, 0x40, 0xBD, 0x1B, 0x18, 
+0xE2, 0x83, 0xC5, 0x61, 0xB6, 0x9D, 0x7D, 0x8F, 
+0x3C, 0xDC, 0xCF, 0x95, 0xCE, 0x6E, 
+
+0x3F, 0x1A, 0x62, 0xA1, 0x2E, 0x68, 0x22, 0x7E, 
+0xE1, 0x75, 0x5E, 0x7F, 0x56, 0x8B, 0xE1, 0x9B, 
+0x52, 0xC6, 0xE8, 0xF9, 0x51, 0xDF, 0xEC, 0x04, 
+0x84, 0x59, 0xA3, 0xEB, 0x8C, 0x7A, 0x68, 0x39, 
+0x7A, 0xA3, 0x58, 0xDE, 0xD8, 0xFD, 0xE1, 0x98, 
+0x6D, 0xCF, 0xF5, 0x72, 0xF6, 0xEC, 0x32, 0xB6, 
+0xA9, 0x86, 0xC5, 0x92, 0xCE, 0xDE, 0x1F, 0x4B, 
+0x9D, 0xCD, 0xDF, 0x9F, 0xB0, 0x9C, 0x20, 0xC5, 
+0xBD, 0x19, 0xE0, 0x6C, 0x32, 0x19, 0x38, 0xE6, 
+0x4B, 0x68, 0x16, 0xC5, 0x9F, 0x2C, 0x2E, 
+
+0xC8, 0xFA, 0x57, 0x39, 0x19, 0x05, 0xD6, 0x54, 
+0x0E, 0x69, 0xF1, 0xA8, 0x34, 0x97, 0xCB, 0xD8, 
+0x17, 0x6F, 0x89, 0x79, 0x0B, 0x3D, 0x70, 0x16, 
+0xDD, 0x9C, 0xF3, 0xAD, 0x22, 0x6F, 0x7E, 0xE8, 
+0xA0, 0xF7, 0xE6, 0x50, 0x92, 0xFF, 0x32, 0x98, 
+0x8C, 0x00, 0xE2, 0xE9, 0x96, 0x0C, 0x7D, 0x07, 
+0x65, 0x0C, 0x31, 0xB7, 0x40, 0x7E, 0xC2, 0xA3, 
+0xBD, 0xFE, 0xCC, 0x41, 0x22, 0x4E, 0xF9, 0x54, 
+0x9F, 0x50, 0xFC, 0x53, 0xBF, 0xEF, 0x54, 0x9E, 
+0x90, 0x0D, 0x11, 0x80, 0xF2, 0x29, 0xFB, 0x82, 
+
+0x6C, 0x54, 0x99, 0x61, 0x23, 0x1B, 0x98, 0x03, 
+0x5F, 0x11, 0xD7, 0x77, 0x1A, 0x19, 0x6F, 0x7C, 
+0x4A, 0x33, 0x3D, 0xD4, 0x14, 0xE6, 0x12, 0x97, 
+0x79, 0xFF, 0xC3, 0xA4, 0xDF, 0x1B, 0x6D, 0x3B, 
+0x59, 0xFB, 0x5F, 0xAF, 0x50, 0xB4, 0x11, 0xD7, 
+0x9A, 0x36, 0xC8, 0x4C, 0xCB, 0xA8, 0x1A, 0x34, 
+0x3A, 0x49, 0x6A, 0x51, 0x0A, 0x56, 0x6D, 0x29, 
+0xCC, 0xD4, 0xBC, 0x9A, 0xA8, 0x9D, 0x38, 0x6C, 
+0xCF, 0x40, 0x28, 0xE5, 0xC5, 0x62, 0x5C, 0xAE, 
+0xB8, 0x68, 0xB0, 0x21, 0x09, 0xAC, 0x5C, 0xC0, 
+0x44, 
+
+0xC3, 0x4F, 0x0F, 0x37, 0xFA, 0x9F, 0x50, 0x03, 
+0x99, 0xD7, 0x4F, 0x60, 0x53, 0xE5, 0x35, 0x60, 
+0x03, 0xD4, 0xE5, 0xD3, 0xD2, 0x04, 0xC8, 0xAE, 
+0xF1, 0xB4, 0xEF, 0x79, 0x86, 0x22, 0xBF, 0x62, 
+0xC4, 0xEF, 0x5D, 0x2C, 0xAF, 0x78, 0xC7, 0x3B, 
+0x8C, 0xCD, 0xE8, 0x09, 0xBE, 0xD3, 0xDC, 0x58, 
+0x26, 0x38, 0x83, 0x4E, 0xC8, 0xC9, 0x5A, 0xF0, 
+0x7D, 0x03, 0x7C, 0x85, 0x09, 0x0C, 0xBF, 0x7F, 
+0x48, 0x4F, 0xC3, 0x9B, 0x8B, 0x78, 0xE1, 0x3A, 
+0xBB, 0x91, 0xE7, 0x5A, 0xA0, 0xC2, 0xFB, 0xC7, 
+0x6C, 0xBB, 
+
+0xEC, 0x2D, 0x03, 0xE0, 0x2D, 0x65, 0xD6, 0xC3, 
+0x9F, 0x4D, 0x87, 0x07, 0x18, 0x00, 0xC2, 0x6C, 
+0x00, 0x3B, 0x4E, 0x1A, 0x53, 0x8D, 0x1D, 0x46, 
+0xBC, 0x13, 0xF8, 0x97, 0x67, 0x12, 0xD8, 0xCA, 
+0x79, 0x39, 0xDC, 0x55, 0x3F, 0x71, 0x7F, 0x92, 
+0x89, 0x53, 0x55, 0x26, 0xF6, 0x83, 0x69, 0x52, 
+0xF0, 0x5F, 0x2A, 0xBC, 0xAD, 0x96, 0x37, 0xF6, 
+0x3F, 0x63, 0xF3, 0x80, 0x7D, 0x76, 0xCF, 0xD7, 
+0xF1, 0x11, 0x58, 0x0F, 0xB5, 0xDD, 0x23, 0xD3, 
+0xA3, 0xE2, 0x4E, 0x66, 0x8D, 0x41, 0x50, 0x38, 
+0xDC, 0xE0, 0x43, 
+
+0x04, 0x0C, 0x5A, 0x41, 0x7E, 0xFF, 0xF8, 0xC1, 
+0xE3, 0x06, 0xB4, 0x02, 0x68, 0x3B, 0xC2, 0x84, 
+0x42, 0x0B, 0x3C, 0x95, 0xB5, 0x59, 0x23, 0xBB, 
+0xE1, 0xA1, 0x59, 0x73, 0x93, 0x83, 0x77, 0xE2, 
+0x95, 0x41, 0x2D, 0x2F, 0xDC, 0xF2, 0xCE, 0xA0, 
+0xD7, 0x0C, 0x03, 0x13, 0x2F, 0xB8, 0xD2, 0x1C, 
+0x8D, 0x23, 0xF4, 0x0A, 0xE1, 0x6D, 0xE3, 0x40, 
+0x7D, 0x71, 0xF6, 0x00, 0xB2, 0x18, 0x72, 0x55, 
+0xA3, 0x6A, 0x54, 0xB4, 0xDF, 0x89, 0x5E, 0xB1, 
+0x5A, 0xD3, 0x86, 0x3B, 0x7B, 0x2E, 0xDE, 0x1F, 
+0x50, 0x95, 0x27, 0x9E, 
+
+0x4C, 0x6B, 0x54, 0x8C, 0xA9, 0x29, 0x13, 0xD1, 
+0xF4, 0xD6, 0x8A, 0x77, 0x01, 0x43, 0xF6, 0x00, 
+0x74, 0x12, 0x97, 0x3F, 0xC4, 0xE3, 0x2C, 0xEF, 
+0x1F, 0x42, 0x80, 0x52, 0x16, 0xD2, 0x22, 0x42, 
+0x9C, 0x95, 0xDC, 0xA1, 0xD1, 0x5C, 0xA3, 0xA3, 
+0x64, 0x38, 0x89, 0x4B, 0x56, 0x73, 0x35, 0x64, 
+0x5C, 0x49, 0x1E, 0x5A, 0xEB, 0xC3, 0xC0, 0xE5, 
+0x27, 0xCB, 0x45, 0x42, 0x13, 0x5E, 0xA1, 0xB8, 
+0x50, 0x8D, 0x07, 0xC9, 0xA2, 0x6A, 0x11, 0xE4, 
+0x50, 0x84, 0x3E, 0x8F, 0x38, 0x3D, 0x8D, 0x03, 
+0xDA, 0x6B, 0xAB, 0xE8, 0x24, 
+
+0x48, 0xCF, 0x53, 0x81, 0x3C, 0xBB, 0xAF, 0x0A, 
+0xA4, 0xAA, 0x1A, 0x26, 0x5A, 0x8B, 0x34, 0x54, 
+0xEF, 0xF6, 0x5F, 0x6E, 0x1F, 0xA3, 0x3B, 0x59, 
+0x72, 0x92, 0x47, 0xBE, 0x89, 0xAB, 0xA3, 0x04, 
+0x06, 0x38, 0xE2, 0x47, 0xC4, 0xE5, 0x8B, 0x08, 
+0xC3, 0x11, 0x8A, 0x5D, 0xB1, 0x72, 0xF5, 0xF8, 
+0x88, 0x34, 0xBF, 0x0E, 0xC0, 0xFE, 0x53, 0x89, 
+0x8F, 0xB0, 0x05, 0xA0, 0x53, 0xFA, 0xE1, 0xCB, 
+0x5F, 0x71, 0xC4, 0xC4, 0xDF, 0x19, 0xD8, 0x68, 
+0xC4, 0x6D, 0x65, 0x4A, 0xC4, 0x39, 0x52, 0x92, 
+0xC3, 0x53, 0xBE, 0x7E, 0xB9, 0x42, 
+
+0x30, 0xCB, 0xCC, 0x4A, 0x9F, 0x09, 0x49, 0xC0, 
+0xF7, 0x13, 0xA2, 0x31, 0xBE, 0x51, 0x2E, 0xD6, 
+0x26, 0xE5, 0xC7, 0x51, 0xBF, 0x76, 0xB8, 0xE5, 
+0x18, 0x6D, 0x88, 0xB9, 0xCA, 0x0E, 0x56, 0x6B, 
+0x65, 0x9F, 0xAD, 0x0D, 0x46, 0xD1, 0xB2, 0xF6, 
+0x9E, 0x5E, 0x29, 0xE1, 0xEC, 0xD9, 0x1E, 0x78, 
+0x88, 0x2E, 0xFE, 0x7C, 0x4A, 0x9C, 0xC7, 0x74, 
+0xF6, 0x09, 0xEE, 0x4F, 0x28, 0x58, 0x9B, 0x63, 
+0x00, 0x48, 0x86, 0x5D, 0xDB, 0x98, 0x39, 0x45, 
+0xE3, 0x35, 0x05, 0x5A, 0x3F, 0xCE, 0x96, 0x7A, 
+0x98, 0x6F, 0x00, 0xDC, 0xC2, 0x55, 0x4B, 
+
+0xD2, 0xD6, 0xB6, 0x0A, 0x41, 0x8A, 0x6D, 0x44, 
+0x4F, 0x1A, 0x91, 0x1E, 0xED, 0xBF, 0x1C, 0x34, 
+0x7B, 0x7F, 0xCF, 0xBE, 0x9E, 0x89, 0x3F, 0xE1, 
+0x15, 0xE7, 0x15, 0xFD, 0x5A, 0x8B, 0x39, 0x7A, 
+0x7F, 0xBA, 0x5A, 0x06, 0x70, 0x55, 0xD4, 0xAE, 
+0x96, 0x65, 0x54, 0xCE, 0x0E, 0xF1, 0x85, 0xBD, 
+0xBE, 0x40, 0xFD, 0xDF, 0x55, 0xFE, 0xDE, 0x61, 
+0xF6, 0xD1, 0x06, 0xD9, 0x3F, 0xB6, 0xA4, 0x42, 
+0x00, 0xB7, 0x2C, 0x89, 0x67, 0x7E, 0x96, 0x40, 
+0x2C, 0x10, 0x50, 0xA8, 0x12, 0x29, 0x74, 0xF9, 
+0xF8, 0xCC, 0x3B, 0xCF, 0x52, 0xF6, 0x7C, 0x21, 
+
+0xDA, 0xCB, 0xA9, 0xAB, 0xBA, 0x79, 0x95, 0xA3, 
+0x00, 0x8F, 0x7D, 0xD7, 0x74, 0x96, 0xB6, 0x53, 
+0xB5, 0x93, 0xC7, 0xC6, 0xB9, 0xC7, 0xB9, 0xEA, 
+0x35, 0xF8, 0xB3, 0x69, 0xEB, 0x58, 0x8A, 0x77, 
+0x29, 0xEA, 0xF0, 0xD8, 0xAA, 0x30, 0xD9, 0x7D, 
+0x9D, 0x9D, 0xBC, 0x15, 0xA2, 0xB4, 0x08, 0x5C, 
+0xAD, 0x59, 0x81, 0x85, 0x9C, 0x1E, 0x38, 0x0E, 
+0x9D, 0xB9, 0x9B, 0x2C, 0xAB, 0x58, 0x00, 0x1B, 
+0xFD, 0x87, 0x20, 0x8D, 0xCF, 0x6C, 0x21, 0xA0, 
+0x2D, 0xCC, 0x80, 0x55, 0x0E, 0x08, 0xD3, 0x5B, 
+0xDD, 0x9D, 0xFF, 0x41, 0x8D, 0x22, 0x02, 0xE0, 
+0xB8, 
+
+0x5D, 0x18, 0x11, 0x13, 0xEF, 0x63, 0x9A, 0x5A, 
+0xF7, 0x97, 0xCD, 0x89, 0x5C, 0x90, 0xEB, 0xB8, 
+0x71, 0x36, 0xE3, 0x34, 0xC8, 0x16, 0xE3, 0xD3, 
+0x6B, 0xC4, 0x2E, 0x37, 0xDF, 0x14, 0xD1, 0xA0, 
+0x0C, 0x8B, 0xAD, 0x12, 0xDD, 0x9E, 0x35, 0x68, 
+0x97, 0xF8, 0x23, 0x80, 0x67, 0x50, 0x50, 0x1C, 
+0xA7, 0x7F, 0x4E, 0x4F, 0x33, 0x21, 0x35, 0x41, 
+0xAF, 0xAF, 0xF3, 0xE0, 0x87, 0xC0, 0xE8, 0xDD, 
+0xE0, 0xF0, 0xAB, 0xF8, 0x4F, 0x4A, 0x54, 0xCA, 
+0x17, 0x48, 0x0A, 0x6A, 0x6C, 0x8D, 0x14, 0xCF, 
+0xB9, 0x41, 0x37, 0xBF, 0x3E, 0x90, 0xE8, 0xAB, 
+0x32, 0x12, 
+
+0xE9, 0x52, 0x62, 0x47, 0x2B, 0x97, 0x36, 0x71, 
+0xA8, 0xD2, 0xD4, 0x47, 0xAF, 0xBF, 0x2A, 0x91, 
+0xCB, 0xA4, 0x12, 0xA1, 0xB4, 0xE6, 0xF9, 0x77, 
+0x87, 0x37, 0x36, 0xED, 0x06, 0x06, 0xE6, 0xB5, 
+0x8A, 0x61, 0x25, 0x75, 0x9A, 0x93, 0x4A, 0xAA, 
+0x6D, 0x39, 0x88, 0xBC, 0xD4, 0xEC, 0x96, 0x75, 
+0x88, 0x62, 0x84, 0x66, 0xCF, 0x66, 0xDC, 0x8D, 
+0x7C, 0x66, 0x3B, 0xCA, 0x64, 0xF7, 0x75, 0x57, 
+0xD6, 0xD9, 0x09, 0xB3, 0x2C, 0x29, 0xA0, 0xA9, 
+0x1F, 0x3F, 0x22, 0x39, 0xD0, 0x9E, 0x05, 0xE6, 
+0x74, 0x0E, 0x0A, 0x67, 0xDC, 0x94, 0xE6, 0xFA, 
+0x07, 0x6C, 0x6A, 
+
+0xE2, 0x07, 0x17, 0x04, 0x86, 0x2A, 0xBE, 0x10, 
+0xC6, 0x40, 0x12, 0x43, 0xFF, 0x41, 0xB0, 0xD0, 
+0x43, 0x45, 0x91, 0x12, 0x3D, 0xC9, 0x62, 0xE6, 
+0x3E, 0x3E, 0xED, 0xAD, 0x67, 0x13, 0x43, 0x58, 
+0xBD, 0x1D, 0x89, 0x56, 0x6F, 0x64, 0xEB, 0xA2, 
+0x56, 0xA3, 0xA8, 0x5D, 0xDF, 0x6B, 0xED, 0x6C, 
+0xE9, 0x1C, 0x93, 0xD4, 0x22, 0xF0, 0x53, 0xE8, 
+0x83, 0x2A, 0x6B, 0xEA, 0xD0, 0xC1, 0xD5, 0xE8, 
+0x78, 0x8D, 0xF6, 0xA4, 0x2C, 0xF7, 0x8F, 0x37, 
+0x00, 0xB1, 0x27, 0x38, 0x91, 0x84, 0x0E, 0x8D, 
+0x9B, 0x06, 0x32, 0xE6, 0x79, 0x72, 0xCF, 0x47, 
+0xC4, 0xD5, 0x2F, 0x2B, 
+
+0xA2, 0xF6, 0xB2, 0xB6, 0xD8, 0x7A, 0x54, 0xA1, 
+0x2F, 0x40, 0xA2, 0x2B, 0xD9, 0x3A, 0xF2, 0x37, 
+0x20, 0xB6, 0x88, 0x70, 0x78, 0x84, 0xA3, 0x49, 
+0xF7, 0x45, 0x78, 0xE7, 0xED, 0x0F, 0xEB, 0x94, 
+0xC6, 0xD1, 0xC5, 0x23, 0xC6, 0x77, 0x5C, 0x70, 
+0x91, 0x5F, 0xB2, 0xA6, 0x8D, 0x07, 0xB5, 0x45, 
+0xC5, 0xFE, 0xCA, 0x3D, 0x97, 0xC6, 0x1B, 0x7A, 
+0x24, 0x4E, 0xA2, 0xDE, 0x8D, 0xE5, 0x6C, 0xF7, 
+0xA5, 0xA4, 0xCF, 0x37, 0xB3, 0x4D, 0x8D, 0x5C, 
+0xF2, 0x7F, 0x0A, 0xF0, 0x6A, 0x21, 0xDE, 0x91, 
+0xFC, 0x22, 0xBE, 0x23, 0xB0, 0xFD, 0x23, 0xB4, 
+0xF1, 0x6E, 0x7B, 0x9B, 0xBF, 
+
+0x3D, 0x14, 0xF2, 0x74, 0xB0, 0x09, 0xB3, 0x24, 
+0xEC, 0x27, 0xDE, 0xEF, 0x59, 0x62, 0x4D, 0xAB, 
+0x59, 0x39, 0xC1, 0xED, 0x58, 0xF9, 0xEF, 0xB1, 
+0x53, 0x0A, 0xCF, 0x4C, 0x4E, 0x48, 0x90, 0x04, 
+0x95, 0x37, 0xFE, 0x35, 0xA9, 0x47, 0x92, 0x73, 
+0x08, 0x3E, 0xA0, 0x40, 0x69, 0xE0, 0x45, 0x8D, 
+0x58, 0x00, 0x2B, 0xFD, 0xA9, 0x56, 0x75, 0x90, 
+0xF9, 0xD3, 0x63, 0x23, 0xB9, 0xAE, 0x79, 0xFC, 
+0x24, 0x72, 0x29, 0x0B, 0x69, 0x40, 0x61, 0x6A, 
+0x80, 0x80, 0x1B, 0x75, 0xB2, 0x66, 0x94, 0x22, 
+0xF0, 0xD1, 0x43, 0x5F, 0x46, 0x22, 0x94, 0xF1, 
+0xAF, 0xEC, 0xEA, 0xB8, 0xA8, 0xEA, 
+
+0x04, 0xD6, 0x70, 0xB5, 0xB7, 0xE6, 0x17, 0xE6, 
+0x07, 0x49, 0xED, 0x79, 0xB1, 0xA1, 0x15, 0xEB, 
+0xE5, 0xAB, 0xF4, 0x41, 0x52, 0x6E, 0x20, 0xF1, 
+0x48, 0xAB, 0x19, 0x9F, 0xFC, 0x09, 0xE3, 0xA1, 
+0x88, 0xFD, 0x6F, 0x56, 0x02, 0x34, 0x70, 0x17, 
+0x2C, 0xB8, 0x60, 0xD9, 0xBA, 0xD6, 0x56, 0x00, 
+0x2C, 0xC4, 0xB4, 0x11, 0xA6, 0x1B, 0x4E, 0x70, 
+0x40, 0xDA, 0x59, 0x7D, 0x4F, 0x6E, 0xC3, 0x49, 
+0xBE, 0xB7, 0xAA, 0xA2, 0xCC, 0xEE, 0xAB, 0xDF, 
+0x5C, 0x05, 0xE9, 0x63, 0xC7, 0x1B, 0xB2, 0xAF, 
+0xCA, 0xD1, 0x23, 0x6F, 0x61, 0xB7, 0x49, 0x75, 
+0x60, 0xCE, 0x8C, 0x93, 0xD8, 0x0E, 0x59, 
+
+0x1F, 0xD8, 0x4B, 0x1F, 0x46, 0x39, 0x55, 0xEE, 
+0x4C, 0xBE, 0xBA, 0x17, 0x7C, 0x8D, 0xF3, 0x85, 
+0x77, 0x11, 0x8E, 0x2E, 0xFF, 0xD2, 0x6B, 0x15, 
+0xC4, 0x96, 0x4A, 0x8F, 0xCB, 0xA4, 0x86, 0xAF, 
+0x10, 0xC0, 0xF0, 0x10, 0x88, 0x87, 0xEF, 0x1F, 
+0x59, 0xDD, 0x06, 0xB9, 0x13, 0x75, 0x7B, 0x01, 
+0x89, 0xE0, 0x88, 0x0B, 0xE9, 0x90, 0x0C, 0x1F, 
+0x36, 0xAF, 0x13, 0x65, 0x1F, 0x5F, 0x1E, 0xEC, 
+0xF8, 0x18, 0x2A, 0xEE, 0xDF, 0x5D, 0x4A, 0xCA, 
+0x3A, 0xCD, 0x4E, 0x49, 0xA9, 0x9B, 0x7E, 0xE0, 
+0xCF, 0x5E, 0x20, 0x42, 0x38, 0xC5, 0x79, 0xCD, 
+0x5D, 0x53, 0x85, 0x09, 0x0A, 0x41, 0x99, 0x9C, 
+
+0x90, 0xDE, 0x3E, 0x59, 0x74, 0xB0, 0x1F, 0x18, 
+0x90, 0xB0, 0x4E, 0x49, 0x43, 0xFE, 0xE2, 0xB4, 
+0xFC, 0x49, 0xF3, 0x0D, 0xBC, 0xEF, 0x4E, 0x75, 
+0x8E, 0x3F, 0xBE, 0x70, 0x4B, 0x68, 0xEC, 0x66, 
+0x5E, 0x21, 0x3B, 0x2F, 0xE8, 0x94, 0xA6, 0x6C, 
+0x31, 0xF6, 0xB4, 0xBD, 0x1F, 0xD4, 0x93, 0x5D, 
+0x5B, 0x21, 0xA9, 0x8A, 0xE8, 0x4B, 0x6F, 0x34, 
+0xE8, 0x46, 0x5E, 0x9E, 0x96, 0xF9, 0xB8, 0x28, 
+0xB6, 0xFB, 0x8F, 0x74, 0x8F, 0xDC, 0x9F, 0xB5, 
+0xE6, 0xD2, 0x43, 0xCA, 0x5B, 0xD8, 0x8F, 0xE3, 
+0xFB, 0xFB, 0xAF, 0xDE, 0xD4, 0x8C, 0x63, 0x81, 
+0x8B, 0xFF, 0x8E, 0xED, 0xD5, 0x3F, 0x9A, 0xD6, 
+0xD1, 
+
+0x17, 0x4D, 0x10, 0x87, 0x2D, 0x78, 0x48, 0x17, 
+0xFB, 0xD1, 0x5C, 0x69, 0xCD, 0x39, 0xCB, 0x96, 
+0x28, 0x4E, 0x37, 0x41, 0xFB, 0xE2, 0x78, 0x41, 
+0x67, 0xEE, 0x7E, 0xA2, 0x98, 0x92, 0x6B, 0x84, 
+0x59, 0x07, 0xF6, 0xB1, 0x9F, 0xA6, 0xC2, 0xB0, 
+0xE2, 0x48, 0x44, 0x46, 0xE2, 0x09, 0x8D, 0xCB, 
+0x56, 0xC3, 0x2C, 0xAB, 0xC5, 0xE7, 0xE9, 0xD7, 
+0x48, 0x0B, 0x4C, 0x73, 0x71, 0x80, 0x54, 0x32, 
+0x12, 0x54, 0x62, 0x39, 0x98, 0x87, 0x72, 0xC2, 
+0xA3, 0x87, 0xBE, 0x49, 0xD2, 0x1A, 0xFF, 0x14, 
+0xF2, 0xA1, 0x08, 0xA2, 0x0D, 0x69, 0x77, 0xB8, 
+0x07, 0x9F, 0x30, 0x99, 0xF2, 0x18, 0x3C, 0x83, 
+0x02, 0xA0, 
+
+0x69, 0x63, 0x51, 0x14, 0x86, 0x28, 0x2A, 0x8B, 
+0x13, 0x66, 0x05, 0x8E, 0xBD, 0x46, 0x54, 0x4E, 
+0xC4, 0xD4, 0x22, 0x3A, 0x45, 0x09, 0x8C, 0x23, 
+0x97, 0x0A, 0x28, 0x9E, 0x42, 0x95, 0x61, 0xCE, 
+0x2B, 0x83, 0x56, 0x1F, 0x7A, 0x85, 0xC7, 0x84, 
+0xEB, 0xB4, 0xE7, 0xDD, 0xFA, 0xBB, 0x84, 0x76, 
+0xC9, 0x05, 0xED, 0xB3, 0xBB, 0xE0, 0x8F, 0x05, 
+0xD2, 0xBF, 0x67, 0x28, 0x22, 0xB3, 0x22, 0x29, 
+0x81, 0xCD, 0x9A, 0x5A, 0xAF, 0xBC, 0xD9, 0xF3, 
+0xEA, 0x77, 0x7E, 0x5E, 0x5B, 0xB6, 0x8F, 0x44, 
+0x59, 0x58, 0x73, 0x60, 0xE8, 0x3B, 0xCD, 0xE1, 
+0xC2, 0x76, 0x61, 0xD5, 0x8A, 0x83, 0xCB, 0x19, 
+0x66, 0x8D, 0x60, 
+
+0x45, 0x0A, 0x96, 0xAD, 0xB0, 0x4D, 0x1E, 0xFA, 
+0x09, 0x2B, 0x96, 0xAF, 0x76, 0x89, 0xFF, 0xC4, 
+0xC6, 0xDB, 0x1F, 0x17, 0x3C, 0x99, 0x0B, 0x1B, 
+0xA2, 0x28, 0x48, 0xF9, 0x56, 0x0B, 0xDA, 0x3A, 
+0x75, 0x20, 0x70, 0xC6, 0xAF, 0x28, 0x5E, 0xAA, 
+0x16, 0x4F, 0xC7, 0xD3, 0x77, 0x88, 0x4B, 0x72, 
+0xF0, 0xE2, 0xAD, 0xDB, 0xA7, 0xDB, 0xF2, 0x15, 
+0x04, 0x0B, 0x84, 0xF9, 0x99, 0x1A, 0x67, 0x17, 
+0x45, 0x62, 0x67, 0x0D, 0x80, 0xE0, 0x70, 0xD2, 
+0x6A, 0xBF, 0xA3, 0xE2, 0x29, 0xD0, 0x4E, 0x8F, 
+0x3A, 0xB8, 0x33, 0x1E, 0x84, 0xA8, 0x0C, 0x61, 
+0x0E, 0x5E, 0x47, 0xDA, 0x30, 0x88, 0x34, 0xD8, 
+0xE1, 0x1A, 0x6F, 0x97, 
+
+0xCD, 0x59, 0x6F, 0xB5, 0x15, 0xD0, 0xB5, 0xE1, 
+0x3B, 0x9F, 0x83, 0xCA, 0x51, 0x09, 0x6F, 0x05, 
+0x2F, 0xBC, 0xC8, 0xEE, 0x32, 0x5B, 0x96, 0x17, 
+0xEF, 0x04, 0x1D, 0x17, 0x91, 0x12, 0x58, 0x5E, 
+0x6B, 0x50, 0x5E, 0xC2, 0xF6, 0x3E, 0x6A, 0x1D, 
+0xF6, 0x57, 0x4C, 0x49, 0xF4, 0xB6, 0x2B, 0xFC, 
+0xA7, 0xA1, 0xD0, 0xAD, 0x48, 0x9A, 0xCC, 0x4E, 
+0x10, 0xCA, 0xE2, 0x34, 0x90, 0xD5, 0x51, 0xB3, 
+0x2A, 0xEF, 0x31, 0x0E, 0x29, 0x7C, 0xBB, 0xC7, 
+0xA0, 0x23, 0x53, 0xB3, 0x48, 0x2C, 0x10, 0x8D, 
+0xFF, 0xC3, 0xE1, 0xD9, 0x64, 0x00, 0xFA, 0x5F, 
+0x4A, 0x0A, 0x44, 0x51, 0x1F, 0x6F, 0xD9, 0xC2, 
+0xBB, 0xE6, 0xAC, 0x88, 0x79, 
+
+0x1F, 0x81, 0x14, 0x5F, 0x43, 0xB5, 0x57, 0xD9, 
+0x1B, 0x78, 0x85, 0x9F, 0x77, 0x08, 0x67, 0xAD, 
+0xB4, 0x88, 0x3A, 0xFD, 0x18, 0x3C, 0x14, 0x72, 
+0xAA, 0x8C, 0xC3, 0xD4, 0x9E, 0x9A, 0xBA, 0x75, 
+0xC1, 0x8A, 0x64, 0xD1, 0x53, 0x76, 0x8C, 0xD1, 
+0x1C, 0x39, 0x40, 0x64, 0xE7, 0x8B, 0x3F, 0xA4, 
+0xCC, 0x6D, 0x98, 0x48, 0x22, 0x71, 0x42, 0x85, 
+0x51, 0x1E, 0x03, 0x51, 0xFB, 0x4A, 0x47, 0x7B, 
+0x88, 0x5F, 0x4A, 0xD0, 0xD3, 0x5C, 0x09, 0xD0, 
+0x77, 0x23, 0x1E, 0xE6, 0x0C, 0x7F, 0x20, 0x59, 
+0xFF, 0x1C, 0xDF, 0xB3, 0x13, 0x7D, 0xF0, 0x59, 
+0x80, 0xE5, 0x46, 0xF7, 0x80, 0x29, 0x0A, 0x1F, 
+0x8C, 0x4A, 0xE1, 0x27, 0x13, 0x12, 
+
+0xE5, 0xCA, 0x6A, 0x84, 0x13, 0x21, 0x1D, 0x84, 
+0xC4, 0x36, 0x6E, 0x92, 0x5A, 0xD7, 0xD2, 0x62, 
+0x51, 0x26, 0x63, 0xC6, 0xDF, 0xAB, 0x6E, 0xDE, 
+0x2E, 0x10, 0x1F, 0xAB, 0xAC, 0x5D, 0x88, 0x50, 
+0x46, 0x66, 0xF3, 0x8C, 0x50, 0x99, 0x8B, 0x6A, 
+0x24, 0x32, 0x94, 0xA9, 0x0E, 0xE8, 0x80, 0x4C, 
+0x86, 0xEB, 0x2F, 0xA3, 0x03, 0x02, 0x0D, 0x40, 
+0x5E, 0x10, 0x6E, 0x3A, 0x64, 0xA5, 0xCF, 0x26, 
+0xCE, 0xE2, 0x16, 0xC4, 0x6A, 0x61, 0x2F, 0x9D, 
+0xF1, 0x78, 0x86, 0x58, 0xFE, 0x2D, 0x9B, 0xC6, 
+0xC4, 0xF1, 0x21, 0x33, 0x36, 0x9E, 0x36, 0xF4, 
+0x6A, 0xB2, 0x4A, 0x1A, 0x3D, 0xCB, 0x00, 0x16, 
+0x4A, 0xA8, 0x07, 0x53, 0xBB, 0xAC, 0x69, 
+
+0x39, 0xA9, 0xA4, 0x67, 0xA3, 0x86, 0x5B, 0xC6, 
+0xF6, 0x46, 0xCD, 0xF7, 0xA6, 0x92, 0xEA, 0x76, 
+0xC5, 0xA7, 0x04, 0xBB, 0x64, 0x8C, 0xD0, 0x7C, 
+0x04, 0x35, 0x6C, 0x5E, 0x62, 0xA9, 0x63, 0x74, 
+0xB5, 0xC1, 0x7E, 0xC7, 0x5C, 0xA6, 0x26, 0xE1, 
+0x53, 0xAF, 0x34, 0xAC, 0xC2, 0x9D, 0xA4, 0x48, 
+0xE4, 0x03, 0xBE, 0x60, 0x1D, 0xD9, 0x54, 0x7B, 
+0x5E, 0x97, 0x36, 0xC2, 0xD2, 0xE8, 0xF6, 0xF4, 
+0x39, 0xF9, 0xEB, 0xB2, 0x52, 0x99, 0xFB, 0xF9, 
+0x29, 0x43, 0x53, 0x18, 0x68, 0xF5, 0xF4, 0x74, 
+0x0A, 0x52, 0xC8, 0x02, 0xA6, 0x84, 0x1A, 0x5D, 
+0xA2, 0xBA, 0xE7, 0xD1, 0x49, 0x6E, 0x68, 0xD6, 
+0xAF, 0x56, 0x43, 0xFA, 0x92, 0x90, 0x84, 0xCB, 
+
+0x49, 0x6D, 0xE5, 0x96, 0x1D, 0x15, 0x5B, 0x17, 
+0x3D, 0x5B, 0x33, 0xDC, 0x38, 0x26, 0xE5, 0x9F, 
+0x32, 0x91, 0x47, 0x6B, 0x0C, 0xAF, 0xD3, 0x0E, 
+0x06, 0xE3, 0xD5, 0x5E, 0xA5, 0x84, 0x7E, 0x7E, 
+0x16, 0x6B, 0xD3, 0x50, 0xA6, 0xE0, 0xB3, 0x49, 
+0xF9, 0x29, 0x82, 0xE0, 0x31, 0x59, 0x2C, 0x60, 
+0xFF, 0xB6, 0x5F, 0x0C, 0x75, 0xE8, 0xA6, 0x6F, 
+0x58, 0x54, 0x56, 0x5C, 0xF8, 0xC0, 0x80, 0x65, 
+0xC0, 0x68, 0x43, 0x5C, 0x6F, 0x7B, 0x4F, 0x1B, 
+0xF1, 0x35, 0x23, 0x90, 0x2E, 0x2C, 0xF0, 0xFA, 
+0xE4, 0x57, 0x56, 0x3E, 0x96, 0xC2, 0xBF, 0xD5, 
+0x65, 0x13, 0x3A, 0xC0, 0x82, 0xFE, 0xFC, 0xEE, 
+0x25, 0x49, 0x8A, 0x76, 0x37, 0x21, 0xD3, 0x7A, 
+0xE8, 
+
+0x26, 0x5F, 0xB9, 0xD5, 0x00, 0x24, 0xA7, 0xA5, 
+0x81, 0x1F, 0x5B, 0x28, 0xF6, 0x73, 0x16, 0x1B, 
+0xE8, 0x2B, 0x1F, 0xA1, 0xE5, 0x11, 0x33, 0x6B, 
+0x7A, 0xCA, 0xE5, 0x20, 0xC0, 0xF4, 0x7C, 0xF6, 
+0xB3, 0x75, 0xF2, 0x91, 0x77, 0xA2, 0xC6, 0x1A, 
+0x3D, 0x38, 0xFD, 0x9C, 0xC0, 0x5A, 0x42, 0xB7, 
+0xEB, 0x07, 0xCE, 0x0E, 0xC9, 0x53, 0x0A, 0x61, 
+0x1D, 0xA9, 0x76, 0xC4, 0x8E, 0xA1, 0x5A, 0x27, 
+0x4B, 0x3C, 0xE8, 0x14, 0x48, 0xAC, 0x7E, 0x4C, 
+0xEF, 0x47, 0x13, 0xA2, 0x2B, 0x1B, 0x6B, 0x4A, 
+0x60, 0xF5, 0x27, 0x45, 0xB2, 0xF3, 0x10, 0x9D, 
+0x01, 0x3F, 0x5C, 0xD7, 0xEA, 0x60, 0xB8, 0x2C, 
+0xBF, 0xE9, 0x80, 0x7E, 0xF5, 0x8C, 0xA5, 0xF1, 
+0x5C, 0xEB, 
+
+0x2F, 0xCC, 0x92, 0x6B, 0xC4, 0x20, 0x97, 0xC4, 
+0xA6, 0xF5, 0x09, 0xF9, 0x66, 0x71, 0xD5, 0x26, 
+0xB6, 0xCC, 0x2D, 0x4F, 0xA7, 0x03, 0x61, 0x39, 
+0xE5, 0xFD, 0x7B, 0x3D, 0x6F, 0x83, 0x9D, 0x98, 
+0x7B, 0x59, 0x95, 0xBD, 0xCB, 0xBC, 0xF1, 0x9A, 
+0xB7, 0x42, 0x63, 0x99, 0x11, 0x68, 0x8E, 0x90, 
+0x82, 0x27, 0xAB, 0x2E, 0x2A, 0xF0, 0xC1, 0x83, 
+0x72, 0x21, 0xBE, 0x61, 0xDE, 0xE0, 0x65, 0x62, 
+0x82, 0x6D, 0x84, 0x9C, 0x8C, 0x05, 0x4B, 0xF5, 
+0xC2, 0x31, 0xB7, 0x1D, 0x1E, 0x43, 0xD5, 0x17, 
+0x90, 0x76, 0xB9, 0xB5, 0xA1, 0x88, 0x69, 0xF1, 
+0x2E, 0x77, 0xA4, 0xA4, 0x48, 0x9B, 0x88, 0xB3, 
+0x80, 0x40, 0x06, 0x77, 0xE7, 0xF4, 0x02, 0x64, 
+0x3A, 0xA4, 0x6A, 
+
+0x59, 0xDF, 0xC8, 0x5A, 0x03, 0xA3, 0xDB, 0x65, 
+0x16, 0x9F, 0x16, 0x63, 0xCC, 0x19, 0xE1, 0x05, 
+0xA8, 0xDA, 0xB2, 0x8A, 0x60, 0x15, 0x9D, 0x26, 
+0xDE, 0x57, 0x23, 0x94, 0xCF, 0x07, 0x3E, 0x82, 
+0x4B, 0x22, 0xC3, 0x3E, 0x13, 0x9C, 0x93, 0x03, 
+0xD5, 0xD6, 0xFB, 0xF8, 0x1D, 0xDA, 0x31, 0x51, 
+0xF8, 0x6C, 0x26, 0x6F, 0xBF, 0xDD, 0xA1, 0xA1, 
+0x78, 0x1E, 0x30, 0x99, 0x23, 0xDB, 0xAC, 0x63, 
+0x0C, 0x97, 0x91, 0xE8, 0xE8, 0x9D, 0xEC, 0x59, 
+0x97, 0x71, 0x57, 0xEE, 0x42, 0xEB, 0x75, 0xE2, 
+0x89, 0x69, 0x98, 0xE0, 0x16, 0x09, 0x52, 0x69, 
+0x6A, 0x0A, 0x6B, 0x8A, 0x6C, 0xA6, 0x1B, 0x86, 
+0x27, 0xF7, 0x7F, 0x40, 0x90, 0x4A, 0x48, 0x90, 
+0x82, 0xDF, 0xFC, 0x94, 
+
+0x01, 0xF8, 0xBE, 0x93, 0x2E, 0x85, 0x51, 0xD0, 
+0xA6, 0xDD, 0x3B, 0xF0, 0x1F, 0xED, 0xDE, 0x6E, 
+0xE3, 0x66, 0xBC, 0x91, 0xC4, 0xCE, 0x97, 0xCA, 
+0x2F, 0x52, 0x87, 0x0E, 0xF8, 0xB1, 0xD1, 0xB2, 
+0x2A, 0xF7, 0xDD, 0xE8, 0x30, 0x22, 0x70, 0xC0, 
+0x27, 0xDA, 0x1C, 0xD6, 0xE7, 0x4F, 0x50, 0x64, 
+0x95, 0x64, 0x99, 0x9D, 0x42, 0x87, 0x36, 0xDA, 
+0xEB, 0xFF, 0x6A, 0x0E, 0xBB, 0x2F, 0xA2, 0xBF, 
+0xFC, 0xDF, 0x31, 0x1F, 0x45, 0x41, 0xD1, 0x8D, 
+0xE6, 0x70, 0x00, 0x2C, 0xDF, 0x4D, 0xD2, 0x4C, 
+0x84, 0x35, 0xB3, 0x64, 0x8D, 0x51, 0x00, 0xBF, 
+0xF2, 0xBD, 0x13, 0x09, 0xE5, 0x34, 0xB9, 0x89, 
+0xAD, 0xBC, 0x8A, 0xB5, 0x04, 0x9D, 0x2F, 0xEE, 
+0x24, 0x54, 0xE5, 0xF1, 0x8B, 
+
+0xB9, 0xC9, 0xF6, 0x56, 0xD6, 0x12, 0x77, 0x3D, 
+0xF0, 0x37, 0xDA, 0x75, 0x0E, 0x56, 0x09, 0x57, 
+0x8C, 0x7F, 0x2D, 0x79, 0xB7, 0xBC, 0x3F, 0xCF, 
+0xB0, 0xB7, 0xD3, 0xCA, 0x5F, 0x5C, 0x55, 0x8E, 
+0xBD, 0x49, 0x00, 0x6A, 0x6C, 0x74, 0x7C, 0x6F, 
+0x57, 0xB9, 0x02, 0xFB, 0x6D, 0x79, 0x2A, 0x75, 
+0x70, 0x3A, 0xA6, 0xC6, 0xDB, 0x13, 0xE8, 0xB9, 
+0xA1, 0xE5, 0x47, 0xBA, 0x88, 0x04, 0x7E, 0x54, 
+0xAA, 0x01, 0xC2, 0xC9, 0xB5, 0xB2, 0x00, 0xC5, 
+0x83, 0x71, 0x2A, 0xD5, 0x3D, 0xBF, 0x43, 0x50, 
+0xF8, 0x8A, 0x57, 0x23, 0xA7, 0xDD, 0x79, 0x84, 
+0x8A, 0x39, 0xBF, 0x17, 0xE3, 0xE0, 0x71, 0x34, 
+0x4A, 0xBA, 0x1D, 0x1C, 0xD7, 0xD8, 0xB2, 0x58, 
+0x0D, 0x9A, 0x81, 0x69, 0x42, 0x92, 
+
+0x5A, 0x75, 0xEA, 0xFA, 0xF9, 0x5B, 0x57, 0x54, 
+0xAF, 0x06, 0xE2, 0xAC, 0x5F, 0x49, 0x6E, 0xF8, 
+0x22, 0x50, 0x17, 0x15, 0x90, 0x04, 0x80, 0x8A, 
+0x69, 0x4E, 0xE4, 0xD9, 0xF3, 0xD4, 0xB9, 0xB3, 
+0xFC, 0x01, 0xA9, 0x11, 0xA1, 0xDD, 0x64, 0x1D, 
+0x4B, 0x61, 0x54, 0x6E, 0x84, 0x12, 0x08, 0x8C, 
+0xF4, 0xBE, 0xC9, 0x3F, 0xBD, 0x8B, 0xB2, 0x69, 
+0x3A, 0x7F, 0xAE, 0xB5, 0x12, 0x3E, 0x81, 0xB1, 
+0xF3, 0xB3, 0xA0, 0x4C, 0x35, 0xEA, 0x3F, 0xC6, 
+0x5C, 0x9C, 0x5B, 0x12, 0x02, 0xFC, 0x59, 0xBD, 
+0x0C, 0x8F, 0x99, 0x11, 0xD4, 0x6E, 0xFD, 0x7C, 
+0x09, 0x0C, 0xEA, 0x5C, 0x3A, 0x16, 0xAB, 0x8A, 
+0x24, 0x0D, 0xDB, 0xA6, 0xEE, 0x80, 0xD4, 0xC3, 
+0x20, 0x70, 0x01, 0x5A, 0x2B, 0xCA, 0x3D, 
+
+0xA7, 0x22, 0xD2, 0x48, 0x14, 0x93, 0xA8, 0x0B, 
+0x5C, 0xFC, 0x91, 0x07, 0xA7, 0x65, 0x3E, 0x98, 
+0xEC, 0xDD, 0xDC, 0x55, 0x6A, 0xFA, 0x8E, 0x74, 
+0x20, 0xCE, 0xFE, 0xFE, 0x70, 0x3A, 0x91, 0xB6, 
+0xF1, 0x43, 0x92, 0x1C, 0x57, 0xBF, 0xCE, 0x01, 
+0xC3, 0x3C, 0x39, 0x04, 0x6C, 0xD3, 0xD9, 0x5E, 
+0x8B, 0xC3, 0x3C, 0xF3, 0x2D, 0x27, 0x67, 0x15, 
+0x34, 0x9D, 0x86, 0x11, 0x64, 0x9D, 0x64, 0xB0, 
+0x9D, 0xFF, 0x4F, 0x8A, 0x73, 0x08, 0xF0, 0xAE, 
+0x4F, 0xC4, 0x60, 0xB5, 0x13, 0x4C, 0xE1, 0x59, 
+0xAD, 0xCB, 0xF9, 0x9A, 0x10, 0x85, 0x75, 0x56, 
+0xE2, 0x9F, 0x1A, 0x41, 0x14, 0x13, 0xC1, 0xB0, 
+0x6A, 0xCF, 0x2E, 0x34, 0xB6, 0xF8, 0x61, 0x28, 
+0xFF, 0xA1, 0xB7, 0x37, 0x78, 0xD6, 0xC0, 0x73, 
+
+0x4A, 0x4A, 0xDC, 0xFD, 0x83, 0x68, 0xF7, 0xC2, 
+0xDD, 0x3F, 0xCD, 0xBC, 0x50, 0x69, 0x1F, 0xB1, 
+0xF7, 0xDB, 0x38, 0xAA, 0xFB, 0x80, 0xAD, 0x13, 
+0x5B, 0xC3, 0x8F, 0x87, 0x6D, 0xCF, 0x7A, 0x20, 
+0x7F, 0xEB, 0xFD, 0xB6, 0xCF, 0x49, 0xF4, 0xC6, 
+0xEC, 0x69, 0xF5, 0x50, 0x98, 0xD9, 0x77, 0x22, 
+0x9C, 0xC4, 0xB1, 0xAB, 0xA6, 0xEE, 0x42, 0x14, 
+0xF1, 0xAE, 0xD2, 0x62, 0x13, 0x83, 0x57, 0x00, 
+0xF9, 0xAD, 0xED, 0xBD, 0x36, 0x74, 0x2A, 0x68, 
+0xDE, 0x43, 0x80, 0xF4, 0xF2, 0xD7, 0x00, 0xED, 
+0x7A, 0x15, 0x9D, 0x18, 0xD2, 0x8C, 0x2B, 0x45, 
+0xD6, 0xD5, 0x82, 0xAF, 0x61, 0xA5, 0xD4, 0x26, 
+0xFF, 0x75, 0x53, 0xCA, 0x6A, 0x32, 0xC7, 0x9A, 
+0x54, 0xB1, 0x4F, 0xDD, 0x29, 0xDE, 0xA9, 0xF2, 
+0x3E, 
+
+0x13, 0x21, 0xB3, 0x22, 0x92, 0x6F, 0x0A, 0x76, 
+0xE4, 0xAE, 0xD1, 0x6B, 0xE7, 0x5E, 0x4A, 0xA0, 
+0x5D, 0xAB, 0xAE, 0xBB, 0x88, 0x64, 0x75, 0xB7, 
+0x57, 0x24, 0xC1, 0xDB, 0x3B, 0x12, 0x41, 0x02, 
+0xE6, 0x91, 0x8E, 0x6D, 0x08, 0x1C, 0x70, 0xCF, 
+0xC7, 0x71, 0x57, 0x63, 0x1D, 0xB5, 0x41, 0x71, 
+0x32, 0x51, 0x3E, 0x43, 0x65, 0xA5, 0x5A, 0xFF, 
+0x80, 0xFD, 0x87, 0x83, 0x08, 0x06, 0xCC, 0x41, 
+0x5A, 0x69, 0xE0, 0x04, 0x7D, 0xC5, 0x8B, 0x37, 
+0x2D, 0xE3, 0x0C, 0xB6, 0xB9, 0x78, 0xC9, 0x04, 
+0x23, 0x5A, 0xCD, 0xDC, 0xDB, 0xCD, 0xE2, 0x9A, 
+0x75, 0xBC, 0x00, 0x7D, 0xF6, 0x5E, 0xC5, 0x79, 
+0x7D, 0x80, 0x21, 0x3C, 0xE1, 0xCF, 0x12, 0x8D, 
+0x0E, 0xD0, 0xA6, 0x9D, 0xD6, 0x2E, 0x02, 0xF2, 
+0xC3, 0x1D, 
+
+0x5E, 0x6A, 0x12, 0x29, 0x23, 0x7D, 0x20, 0xA8, 
+0xE8, 0x37, 0x2F, 0xD1, 0xC7, 0xB2, 0x1A, 0xA0, 
+0x1E, 0x98, 0x71, 0xDC, 0x49, 0xEF, 0x5E, 0xA9, 
+0xEC, 0x53, 0xA9, 0x76, 0xD1, 0xD7, 0xFC, 0xA5, 
+0x92, 0x43, 0x70, 0xD8, 0xA1, 0x66, 0x0C, 0xD6, 
+0xBC, 0xC4, 0x9F, 0x1C, 0x67, 0xEF, 0xB4, 0xF5, 
+0x1A, 0x72, 0x57, 0xB1, 0xFD, 0x27, 0xDE, 0xB1, 
+0x9A, 0xEE, 0x04, 0xB2, 0x2B, 0x24, 0x35, 0x2B, 
+0x3B, 0x19, 0x21, 0xE5, 0x2C, 0x46, 0xAC, 0x31, 
+0x71, 0xEE, 0x88, 0x94, 0xFE, 0xA1, 0x1B, 0x74, 
+0x0D, 0xC5, 0xC9, 0xCB, 0xA8, 0x28, 0xE2, 0x51, 
+0x7D, 0x73, 0xFE, 0xE5, 0x89, 0x10, 0x74, 0xF6, 
+0xFA, 0x73, 0xB5, 0x9E, 0x30, 0xCA, 0x45, 0xA1, 
+0x02, 0x71, 0x28, 0x97, 0x1A, 0x8D, 0x15, 0x05, 
+0x71, 0x4A, 0xDA, 
+
+0xD2, 0xDF, 0x4D, 0xEF, 0xFF, 0xB6, 0x42, 0x63, 
+0xB9, 0xC8, 0x2A, 0x59, 0xDD, 0x21, 0x8F, 0x0D, 
+0x7A, 0x7E, 0x93, 0x16, 0x03, 0xC1, 0x7F, 0xA0, 
+0xC8, 0x8B, 0x62, 0x17, 0xAD, 0x44, 0x72, 0xBC, 
+0xAA, 0xD2, 0xF5, 0x14, 0x23, 0xA8, 0xAC, 0x11, 
+0x24, 0xCE, 0x22, 0xFD, 0x49, 0x32, 0x33, 0xC5, 
+0xC7, 0xD6, 0xB0, 0x50, 0x1E, 0x42, 0x26, 0xD0, 
+0x83, 0x06, 0xE6, 0xE7, 0x42, 0x54, 0xD1, 0x62, 
+0x56, 0xAD, 0x2F, 0x39, 0xD5, 0x7B, 0x04, 0x09, 
+0x25, 0xBA, 0xF7, 0x0E, 0x69, 0x07, 0xE1, 0xDF, 
+0x60, 0xDF, 0xA5, 0xBB, 0xBA, 0xE3, 0x19, 0xC4, 
+0xF3, 0xA1, 0x04, 0x6E, 0x06, 0x62, 0x6B, 0xC0, 
+0x2F, 0x23, 0xB9, 0x10, 0x24, 0x21, 0xF5, 0x30, 
+0x4B, 0x8D, 0x26, 0x36, 0xD8, 0xFA, 0x4D, 0x7C, 
+0x48, 0x10, 0xE9, 0x54, 
+
+0x3B, 0x38, 0x12, 0x36, 0xBB, 0xF3, 0x5B, 0x4C, 
+0x12, 0xE9, 0xF6, 0xDA, 0x01, 0x0A, 0xF3, 0x36, 
+0xE0, 0x11, 0x6C, 0x47, 0xC1, 0x51, 0x4A, 0x0E, 
+0x07, 0x54, 0x57, 0xF1, 0xB8, 0x7C, 0xDD, 0x00, 
+0x91, 0x6B, 0x4B, 0x45, 0x47, 0x7B, 0x09, 0x0B, 
+0x24, 0xB0, 0xCC, 0x5F, 0xC3, 0xF8, 0x98, 0xB1, 
+0xE6, 0xD5, 0x63, 0xFE, 0x73, 0x36, 0x1E, 0x9C, 
+0x27, 0x1A, 0x82, 0x94, 0x55, 0x1E, 0xC6, 0x1D, 
+0x89, 0x93, 0x3C, 0x33, 0x91, 0x79, 0x42, 0x81, 
+0xEA, 0xFC, 0xFE, 0xA5, 0xBC, 0xF7, 0x43, 0xDC, 
+0xC6, 0xD0, 0x8E, 0x95, 0x92, 0x97, 0x12, 0xE9, 
+0x8D, 0xCD, 0xF5, 0x79, 0x49, 0x82, 0x97, 0xA4, 
+0x83, 0x8C, 0x62, 0x67, 0x10, 0x92, 0xDD, 0x8E, 
+0x7E, 0xB4, 0x6B, 0x15, 0xF7, 0x39, 0xAE, 0x27, 
+0xF3, 0x19, 0x68, 0xA3, 0xDC, 
+
+0x71, 0x87, 0x19, 0x99, 0x34, 0x4A, 0x39, 0x77, 
+0xC9, 0x8E, 0xF7, 0x27, 0x00, 0x99, 0x9E, 0x39, 
+0x2B, 0x9C, 0xE4, 0x3B, 0x29, 0x97, 0xDF, 0xEB, 
+0xB9, 0xD4, 0xAF, 0x2D, 0xA0, 0xC5, 0xC4, 0xDC, 
+0x84, 0xC1, 0x6D, 0xE4, 0x28, 0xD8, 0x21, 0x06, 
+0x07, 0xD4, 0xA6, 0xD0, 0xD1, 0xDE, 0xC1, 0x10, 
+0xA0, 0x00, 0x1D, 0xA9, 0x94, 0x17, 0xA1, 0x19, 
+0xCD, 0xF2, 0xCE, 0x04, 0x6F, 0x2E, 0xF0, 0xAC, 
+0xF2, 0x50, 0xFC, 0x12, 0x10, 0x79, 0x8D, 0x0C, 
+0x38, 0xDB, 0xBD, 0x1E, 0xEA, 0xD3, 0x40, 0xDF, 
+0x43, 0x8E, 0xDD, 0x82, 0xD2, 0x94, 0x00, 0x9A, 
+0x61, 0x31, 0x1E, 0x9E, 0xD6, 0x47, 0x40, 0x24, 
+0x86, 0xDF, 0xF9, 0x2C, 0x55, 0x56, 0x1D, 0xA8, 
+0xEE, 0x4F, 0x31, 0x1F, 0x6C, 0x91, 0x9B, 0xA4, 
+0xF3, 0x30, 0x68, 0xD0, 0xE3, 0x84, 
+
+0xD3, 0x5F, 0x37, 0xDD, 0x37, 0x34, 0xB0, 0x87, 
+0x33, 0x7D, 0xC6, 0xED, 0x55, 0x6C, 0x0D, 0x17, 
+0x95, 0x26, 0xFB, 0xE2, 0x56, 0x84, 0xEB, 0xC6, 
+0xF6, 0x02, 0x6D, 0xA1, 0xBF, 0xD9, 0x02, 0x7F, 
+0xAB, 0xA5, 0xEB, 0x5B, 0xCE, 0xE6, 0x67, 0x7A, 
+0xCA, 0xA2, 0x99, 0x18, 0x53, 0x97, 0x08, 0x2E, 
+0x99, 0x7A, 0x55, 0xB9, 0x2C, 0x08, 0xFE, 0x90, 
+0x10, 0xDC, 0x93, 0xA1, 0xEC, 0x92, 0x6A, 0xC3, 
+0xA1, 0xEC, 0x34, 0x5C, 0x8A, 0x20, 0x92, 0xC0, 
+0xEF, 0xF5, 0xE2, 0x48, 0x6E, 0x87, 0x3F, 0x59, 
+0x73, 0xEB, 0xFA, 0x89, 0x9E, 0xB5, 0xE8, 0xDD, 
+0x0E, 0xF8, 0x67, 0xFA, 0xAF, 0x0A, 0x45, 0xEF, 
+0x38, 0xA3, 0xE0, 0x54, 0x51, 0x34, 0xEB, 0x4E, 
+0x16, 0x11, 0x3D, 0xAF, 0x52, 0x88, 0x31, 0xEF, 
+0x50, 0xE9, 0xD9, 0xF0, 0xC5, 0xDA, 0xD1, 
+
+0x8B, 0x14, 0x14, 0x70, 0x03, 0x1E, 0xAC, 0x83, 
+0x2C, 0x48, 0x7B, 0x5B, 0x8E, 0x86, 0x5B, 0xBC, 
+0xF5, 0x17, 0x7E, 0x23, 0x12, 0x2A, 0xB1, 0x2D, 
+0x4B, 0x9E, 0x0D, 0x6B, 0x79, 0xBF, 0xCF, 0x21, 
+0xC5, 0x3E, 0x5E, 0x39, 0x72, 0xC4, 0xD0, 0x93, 
+0x79, 0x0F, 0xEC, 0xFE, 0x04, 0xDD, 0xBB, 0x89, 
+0xD9, 0x2D, 0xD8, 0x31, 0x77, 0x87, 0x64, 0x07, 
+0x5C, 0x31, 0x6D, 0xCC, 0xCC, 0x59, 0xF7, 0xE3, 
+0x9A, 0x8B, 0x50, 0xB4, 0xDD, 0x31, 0xF4, 0x0E, 
+0x16, 0xB5, 0xB1, 0x6A, 0xFF, 0xC1, 0x18, 0x71, 
+0xA2, 0xEB, 0xCB, 0x61, 0x84, 0x76, 0x0A, 0xB2, 
+0x0F, 0xF7, 0x97, 0x9B, 0xA6, 0x8B, 0xAD, 0x8C, 
+0x33, 0xBD, 0x53, 0x1E, 0xF3, 0x62, 0x5C, 0x92, 
+0xD8, 0x9A, 0x21, 0x43, 0x59, 0x94, 0x9F, 0x9F, 
+0xC9, 0xD5, 0xEF, 0xD4, 0x2B, 0x28, 0xB6, 0x47, 
+
+0x37, 0xDC, 0xDE, 0xE9, 0xCA, 0xB9, 0x16, 0x38, 
+0x3C, 0xB6, 0x18, 0x3B, 0x16, 0x7A, 0xCA, 0xA5, 
+0x09, 0x5E, 0x01, 0x8D, 0x91, 0x3A, 0x64, 0x0A, 
+0xD9, 0x10, 0x6A, 0x81, 0x4F, 0x2E, 0x03, 0x39, 
+0xD8, 0x19, 0x25, 0x3E, 0x2D, 0xEC, 0xC4, 0x0F, 
+0x45, 0x36, 0x92, 0x5E, 0x7F, 0x37, 0xB6, 0x3F, 
+0xF9, 0x1A, 0xCB, 0x03, 0x65, 0x3B, 0x3D, 0x26, 
+0x45, 0xB8, 0x88, 0x24, 0xD5, 0xAC, 0x82, 0x3A, 
+0x67, 0x7D, 0xD5, 0x20, 0x7B, 0xDE, 0xC6, 0x6D, 
+0x79, 0x14, 0x09, 0x08, 0x58, 0xEA, 0x8E, 0xA0, 
+0xAC, 0xFC, 0x11, 0xFB, 0x8D, 0xAA, 0x0D, 0xEB, 
+0x2F, 0x75, 0x66, 0xEC, 0x52, 0xCC, 0xDB, 0x15, 
+0x2F, 0xE4, 0xBA, 0xAE, 0x33, 0xE2, 0x72, 0xA2, 
+0x03, 0xE1, 0xE3, 0x6C, 0x79, 0xBB, 0xBD, 0x9A, 
+0x1C, 0x00, 0xAA, 0x93, 0x04, 0xB5, 0x66, 0x67, 
+0x83, 
+
+0xBB, 0x13, 0xF1, 0xB0, 0x72, 0x34, 0xD8, 0x85, 
+0xB5, 0xAF, 0x1B, 0xBC, 0xC2, 0xB8, 0x46, 0xC4, 
+0x58, 0x4B, 0xC6, 0x87, 0xD9, 0x74, 0x00, 0x5D, 
+0x2B, 0x26, 0x68, 0x18, 0x74, 0xBF, 0x4A, 0x5C, 
+0x9D, 0x23, 0x23, 0x3D, 0xE2, 0x92, 0x07, 0x59, 
+0x55, 0x76, 0x08, 0xDC, 0x70, 0x26, 0x9E, 0x29, 
+0x5E, 0x55, 0x3C, 0xE0, 0x5B, 0xAE, 0x3B, 0x18, 
+0x86, 0xB4, 0xBA, 0x48, 0xFC, 0x45, 0x0F, 0x56, 
+0x6D, 0x1C, 0xDE, 0xD4, 0xC7, 0xB3, 0x95, 0x1B, 
+0x65, 0x99, 0xFF, 0x30, 0x19, 0xFB, 0x74, 0xFC, 
+0xD3, 0xE9, 0xF6, 0xE9, 0x26, 0x07, 0x2A, 0x48, 
+0x3F, 0xE0, 0x2D, 0xA5, 0x9A, 0x93, 0x20, 0xCC, 
+0xEC, 0x38, 0x10, 0xA0, 0xEA, 0x52, 0xE2, 0x3D, 
+0x9D, 0x82, 0x99, 0x8C, 0x53, 0x71, 0x1F, 0x79, 
+0xDC, 0xA9, 0x55, 0x00, 0x18, 0x24, 0xA8, 0x62, 
+0x37, 0x22, 
+
+0x0E, 0xF4, 0x6F, 0x48, 0xDD, 0xD8, 0x0E, 0x9E, 
+0x02, 0xD5, 0x3D, 0xB6, 0xD3, 0xF2, 0x84, 0xA0, 
+0x82, 0xA7, 0x38, 0x11, 0x43, 0x1F, 0x2E, 0x7D, 
+0xE3, 0xC6, 0x84, 0xDB, 0xB4, 0x0A, 0x78, 0x99, 
+0xFB, 0x3B, 0x49, 0x93, 0x29, 0x9C, 0x5D, 0xD9, 
+0xAC, 0xDD, 0x31, 0x14, 0x1B, 0xEC, 0xEF, 0xD7, 
+0xB1, 0x06, 0x60, 0x21, 0x29, 0xD9, 0x15, 0x69, 
+0xB5, 0xF1, 0x01, 0x32, 0x30, 0x77, 0x49, 0x6C, 
+0x25, 0xFB, 0xCF, 0x93, 0xB5, 0xC5, 0x9D, 0x03, 
+0x73, 0x6A, 0x9A, 0x3D, 0xD3, 0x13, 0x12, 0x74, 
+0xF3, 0x6A, 0xC3, 0x45, 0xDC, 0xA7, 0xE7, 0x8F, 
+0xE3, 0x53, 0x9E, 0xED, 0x0D, 0xC7, 0x73, 0x07, 
+0xDE, 0x2A, 0xAB, 0x4D, 0xA3, 0x08, 0xDF, 0x44, 
+0xA2, 0x1D, 0x4C, 0x48, 0x30, 0xD9, 0x61, 0x99, 
+0xD6, 0x53, 0x70, 0xF8, 0xCD, 0x75, 0xEB, 0x22, 
+0x71, 0xB1, 0x32, 
+
+0x4F, 0xE5, 0x56, 0x1E, 0x0D, 0x19, 0x02, 0x19, 
+0xC1, 0xAC, 0x72, 0xB7, 0x2A, 0xB8, 0x2C, 0xB0, 
+0xA1, 0x4F, 0x6A, 0x1A, 0x8D, 0x45, 0x76, 0x62, 
+0x7A, 0xC2, 0x97, 0x97, 0x65, 0x6A, 0x72, 0x8D, 
+0x32, 0x15, 0x39, 0xBD, 0x4B, 0x22, 0x53, 0x22, 
+0x52, 0xBC, 0x8E, 0x58, 0xAA, 0xFB, 0x47, 0xFD, 
+0xAD, 0x85, 0x02, 0x07, 0x60, 0xA5, 0xC7, 0x63, 
+0x49, 0x9B, 0xD0, 0xB8, 0x14, 0x54, 0x02, 0x36, 
+0x3E, 0x6E, 0x30, 0x24, 0x2C, 0xF5, 0x51, 0xA2, 
+0x5E, 0x53, 0xCD, 0xDF, 0xAD, 0x71, 0xAB, 0x9B, 
+0x25, 0xD9, 0xC2, 0xC3, 0x3D, 0x25, 0x3F, 0xDE, 
+0x36, 0xF4, 0x2E, 0xBD, 0x62, 0x64, 0xBD, 0xF9, 
+0x07, 0xF2, 0x32, 0x31, 0x6D, 0xB6, 0x0A, 0xA9, 
+0x4A, 0xDC, 0x35, 0x0D, 0x27, 0xD1, 0x3C, 0xF7, 
+0xE4, 0x99, 0x7A, 0xBD, 0x77, 0xDD, 0x19, 0xC5, 
+0x42, 0x1D, 0x88, 0x53, 
+
+0xC7, 0xDC, 0xBD, 0x4A, 0xA9, 0xE7, 0xA0, 0x46, 
+0xAA, 0xFD, 0x5C, 0xDE, 0x22, 0xC6, 0x14, 0x23, 
+0xC6, 0x48, 0x88, 0xB5, 0x4C, 0x3C, 0x60, 0x42, 
+0xEF, 0x0D, 0xD9, 0x90, 0xCC, 0x92, 0xA9, 0xD4, 
+0xE0, 0x67, 0x1A, 0xE6, 0xEC, 0xD9, 0x1A, 0x03, 
+0xE7, 0xA7, 0x49, 0xCE, 0x3E, 0x13, 0x3A, 0x48, 
+0x1E, 0x91, 0xB4, 0xE4, 0xB5, 0xF2, 0xF1, 0x1D, 
+0x57, 0x30, 0x89, 0x40, 0xCA, 0x63, 0x60, 0x31, 
+0x3E, 0x0C, 0xD8, 0x35, 0x03, 0xEE, 0xEF, 0x74, 
+0xD5, 0x00, 0xB1, 0xC6, 0x9E, 0xF0, 0x8A, 0x75, 
+0x80, 0x78, 0x8F, 0x78, 0x7C, 0x14, 0x83, 0x0D, 
+0x7E, 0x7B, 0x44, 0xA7, 0x02, 0x36, 0x53, 0xBF, 
+0xFC, 0x7D, 0x03, 0xC7, 0xD2, 0x7D, 0x93, 0x1A, 
+0x38, 0xFA, 0x44, 0xE0, 0xFC, 0xCB, 0x6B, 0xA2, 
+0x8A, 0x40, 0xC4, 0xF9, 0x45, 0x12, 0xA6, 0xCA, 
+0x72, 0xCC, 0xC0, 0xCA, 0x71, 
+
+0x5E, 0x0F, 0x5F, 0x3A, 0x7F, 0xEE, 0xD3, 0x8E, 
+0xE0, 0xF6, 0xD7, 0xC5, 0x80, 0x74, 0xF0, 0xA8, 
+0x59, 0xCE, 0xFA, 0xB7, 0xD0, 0xAE, 0x4A, 0x2B, 
+0x1A, 0xAC, 0xB9, 0x21, 0x39, 0x6D, 0x73, 0x78, 
+0x49, 0x6D, 0xF2, 0x79, 0xDB, 0x5D, 0x16, 0x82, 
+0x89, 0xC7, 0x87, 0xC0, 0x0B, 0x68, 0xC2, 0xA9, 
+0x05, 0xA7, 0xA7, 0x3F, 0xEA, 0xCC, 0x1E, 0xF3, 
+0xCF, 0x1A, 0xAE, 0x01, 0xA8, 0x65, 0xFA, 0xEA, 
+0x5D, 0x89, 0xF9, 0xDA, 0xA7, 0x3D, 0xA4, 0x61, 
+0xF7, 0x81, 0x42, 0x22, 0x37, 0x03, 0x00, 0xD7, 
+0x2F, 0x83, 0x0C, 0x2B, 0xD6, 0xAF, 0x4D, 0x92, 
+0xE9, 0x7E, 0xB2, 0x69, 0xBD, 0xF5, 0xDB, 0x5F, 
+0x94, 0xA2, 0x93, 0x4A, 0x7A, 0x97, 0xDF, 0xD0, 
+0x89, 0xE7, 0xD0, 0x21, 0xAE, 0x22, 0x99, 0x2F, 
+0xEE, 0xD5, 0x58, 0x84, 0xB0, 0x37, 0xCC, 0x31, 
+0xCE, 0x83, 0x30, 0xFB, 0x1D, 0x9A, 
+
+0xD8, 0xE0, 0xC2, 0x71, 0x6A, 0x95, 0x25, 0xC2, 
+0x6E, 0xC6, 0xB7, 0x11, 0x46, 0xF5, 0x61, 0xA6, 
+0xF5, 0x39, 0xCF, 0x7D, 0xA1, 0x67, 0xE6, 0xF4, 
+0x35, 0xB0, 0x85, 0x76, 0x85, 0xB7, 0x75, 0x7F, 
+0xA7, 0x05, 0xA2, 0x2F, 0x34, 0x43, 0x99, 0x40, 
+0x59, 0xF8, 0x95, 0x78, 0x15, 0xB1, 0x05, 0xF3, 
+0x96, 0x8C, 0x48, 0xEB, 0x16, 0x24, 0x0D, 0x57, 
+0x32, 0x0A, 0x89, 0x86, 0x6F, 0x8D, 0xC3, 0xFF, 
+0x8D, 0x3B, 0x17, 0x3B, 0xBB, 0xAA, 0x18, 0x49, 
+0x24, 0x48, 0x0C, 0xCA, 0xA7, 0xE2, 0xB2, 0x76, 
+0x7B, 0xDE, 0xC0, 0xBE, 0x6B, 0x20, 0x97, 0x84, 
+0x3E, 0x3B, 0xF8, 0x51, 0x6E, 0x62, 0x33, 0x81, 
+0x00, 0xA9, 0x20, 0x29, 0xEE, 0xB3, 0x98, 0x3F, 
+0xDF, 0x40, 0x85, 0xA1, 0xC3, 0xC1, 0xD6, 0xCE, 
+0x23, 0xC8, 0x4D, 0x6A, 0xE8, 0x85, 0x78, 0xE3, 
+0x74, 0x2B, 0x69, 0x65, 0x78, 0x4A, 0xC2, 
+
+0x1D, 0x38, 0x40, 0x86, 0xF1, 0x07, 0x9E, 0x70, 
+0xD2, 0x18, 0x3D, 0x57, 0x1C, 0xF2, 0x08, 0x74, 
+0x80, 0x2F, 0xEE, 0x52, 0x97, 0xDD, 0xD0, 0x5A, 
+0x39, 0x37, 0x96, 0x88, 0x62, 0xDC, 0x07, 0x79, 
+0x71, 0x1D, 0x6F, 0x50, 0xDC, 0xE2, 0x44, 0x48, 
+0xD3, 0x04, 0xC6, 0x78, 0x4F, 0xD3, 0xE0, 0x7C, 
+0x7F, 0x8C, 0x2A, 0x9D, 0xB2, 0x1E, 0xA8, 0x3F, 
+0x38, 0x32, 0x8B, 0x50, 0x27, 0x2F, 0x5C, 0x68, 
+0x8F, 0x24, 0x2B, 0x35, 0x39, 0x45, 0xDC, 0x40, 
+0xE8, 0xD3, 0xEB, 0x13, 0x63, 0xE0, 0x83, 0x64, 
+0xA4, 0xAE, 0xF3, 0x1E, 0x55, 0xF6, 0xE3, 0x5C, 
+0xA0, 0xDA, 0x71, 0x7E, 0xF7, 0xC0, 0xC2, 0x64, 
+0xEB, 0x3A, 0xFB, 0xDA, 0x8D, 0x70, 0xB1, 0x2A, 
+0xCD, 0x18, 0xB3, 0xBF, 0x19, 0xF4, 0x9E, 0x64, 
+0x61, 0x7E, 0xCA, 0x68, 0x10, 0xFE, 0xA8, 0x5C, 
+0x2C, 0xBB, 0x05, 0x5F, 0x2E, 0x3A, 0xEF, 0x37, 
+
+0xF6, 0xF9, 0xAF, 0x85, 0x05, 0x8F, 0x2E, 0xB5, 
+0x3D, 0xFF, 0x64, 0x70, 0x01, 0xB7, 0x04, 0x03, 
+0x22, 0x3F, 0x1D, 0xC4, 0x0E, 0x6E, 0x32, 0x4F, 
+0xDF, 0xB8, 0xF4, 0x33, 0xDF, 0x93, 0x60, 0xC9, 
+0xE0, 0xE9, 0xC9, 0x79, 0x27, 0x59, 0xED, 0xFA, 
+0xF9, 0xB4, 0x37, 0x12, 0x37, 0x24, 0x3F, 0x48, 
+0x4B, 0xC7, 0xAD, 0x08, 0x9B, 0x51, 0x1A, 0x26, 
+0x52, 0x23, 0x31, 0x3D, 0xA7, 0x55, 0x86, 0x70, 
+0x1A, 0xBA, 0x5E, 0xA6, 0x0C, 0x79, 0x2C, 0x9D, 
+0x6F, 0xAC, 0xE1, 0x5C, 0x1E, 0x9B, 0x0E, 0x4D, 
+0x2E, 0x06, 0x24, 0x77, 0x6F, 0x06, 0x6D, 0x25, 
+0x7D, 0x4B, 0xC8, 0x9C, 0xFE, 0xB5, 0x14, 0x26, 
+0xD7, 0xCC, 0x1F, 0x38, 0xBB, 0x19, 0x8D, 0xB0, 
+0x70, 0x34, 0xE7, 0x85, 0x9E, 0x08, 0x19, 0x52, 
+0xAC, 0xF8, 0xBD, 0x21, 0xB0, 0x47, 0x69, 0x93, 
+0x01, 0x69, 0x8C, 0x14, 0xC3, 0x87, 0xBE, 0xA8, 
+0x80, 
+
+0x78, 0x4A, 0xA8, 0xB0, 0x37, 0x5C, 0x2D, 0xE2, 
+0xD3, 0x7B, 0x41, 0x9F, 0xF1, 0x8C, 0x56, 0x19, 
+0x3C, 0x21, 0x6E, 0xFE, 0x32, 0x7C, 0x2B, 0x30, 
+0x47, 0x00, 0xBA, 0xE8, 0x62, 0x08, 0x6F, 0x85, 
+0x76, 0x34, 0x3B, 0x65, 0xE4, 0x6B, 0xE8, 0x27, 
+0x3F, 0x9B, 0x91, 0x4C, 0x05, 0x1B, 0xAA, 0x84, 
+0x78, 0x72, 0xE0, 0x2E, 0xCC, 0xA8, 0xE8, 0xF0, 
+0x06, 0x4C, 0x0B, 0xC8, 0xAD, 0xEB, 0x59, 0xC2, 
+0xF4, 0xE8, 0xEE, 0xFA, 0x48, 0xBA, 0x37, 0x06, 
+0x9A, 0xC1, 0x76, 0xED, 0xDB, 0xCB, 0xCE, 0x9B, 
+0x81, 0x99, 0xFE, 0x4D, 0xEB, 0x74, 0x74, 0xC4, 
+0xC6, 0x9A, 0x66, 0x23, 0x66, 0x4F, 0x0E, 0xA6, 
+0x4F, 0x2C, 0xAE, 0x85, 0xD1, 0x6E, 0x58, 0x89, 
+0xEF, 0x46, 0x24, 0xB0, 0x6E, 0x15, 0xC8, 0x33, 
+0xA0, 0xE6, 0x0B, 0xEE, 0xD6, 0x65, 0xC0, 0xBF, 
+0x0D, 0xD4, 0x40, 0xDA, 0x40, 0x86, 0xF6, 0x9F, 
+0xAF, 0xD2, 
+
+0x31, 0x88, 0x7E, 0x75, 0xE5, 0x97, 0x5A, 0x05, 
+0xE4, 0x3B, 0xE4, 0x1F, 0xDA, 0x0D, 0x4F, 0xED, 
+0x68, 0x20, 0xE1, 0xE3, 0x2E, 0x0C, 0xF0, 0x18, 
+0x73, 0x24, 0x2F, 0xC1, 0xB0, 0x88, 0xB1, 0xD8, 
+0xF4, 0xB0, 0x79, 0x60, 0xBD, 0x7E, 0x4A, 0xFC, 
+0x6C, 0xC4, 0x67, 0x0D, 0x30, 0xE5, 0xED, 0x4D, 
+0x66, 0x2B, 0x86, 0x09, 0xD0, 0xB9, 0x7A, 0xAF, 
+0x07, 0xDC, 0x9A, 0xF4, 0xEB, 0xC9, 0x33, 0x86, 
+0x1F, 0x34, 0x70, 0x44, 0xE0, 0x4A, 0x3A, 0xD1, 
+0x27, 0xC8, 0xF2, 0x3C, 0x92, 0xF8, 0x37, 0x75, 
+0xC6, 0x95, 0x5C, 0x08, 0x69, 0x55, 0xD5, 0x5D, 
+0x9C, 0xB2, 0xC1, 0x09, 0xEF, 0x62, 0xF8, 0x67, 
+0x66, 0xEC, 0x70, 0xF8, 0x76, 0xDC, 0xE0, 0x21, 
+0xBF, 0xD9, 0x10, 0x20, 0x5D, 0x54, 0x7A, 0xB3, 
+0x84, 0x1E, 0x7C, 0xBE, 0x09, 0x4B, 0x5D, 0x8E, 
+0xEF, 0xDB, 0xD4, 0x8A, 0x37, 0x76, 0x21, 0x50, 
+0x7A, 0x68, 0x20, 
+
+0xD1, 0x44, 0xDE, 0x5F, 0x96, 0x70, 0xB6, 0xEC, 
+0xC6, 0xB1, 0x30, 0x92, 0xA0, 0x81, 0x2B, 0xFC, 
+0x06, 0xFC, 0x5F, 0x28, 0x02, 0x8B, 0x9C, 0x86, 
+0x63, 0xEC, 0xB1, 0xB1, 0xA7, 0x98, 0x6A, 0x46, 
+0x02, 0x78, 0xB6, 0xB5, 0xFF, 0x6E, 0xE1, 0xEF, 
+0x33, 0xCB, 0x61, 0x5C, 0x1E, 0xDF, 0xB9, 0x6C, 
+0x5B, 0x77, 0xF5, 0x03, 0x77, 0x88, 0x55, 0x74, 
+0xD0, 0x4A, 0x79, 0x1F, 0x0F, 0x45, 0xE2, 0xB5, 
+0x12, 0xB0, 0x72, 0x19, 0x1A, 0x42, 0x4B, 0xEF, 
+0x3F, 0x59, 0x08, 0xB2, 0xB4, 0x55, 0x97, 0x11, 
+0xE9, 0xA7, 0xEA, 0x8F, 0xEF, 0x67, 0x10, 0x1E, 
+0xA5, 0xA8, 0xC2, 0xF3, 0xE9, 0x30, 0xE4, 0xFA, 
+0x98, 0xD9, 0x86, 0x38, 0x4A, 0xE0, 0x21, 0xAE, 
+0x42, 0x02, 0x9F, 0xB4, 0x08, 0x96, 0x6E, 0x0D, 
+0x08, 0xFF, 0x5D, 0x0B, 0x77, 0xBC, 0x5B, 0x2F, 
+0xAE, 0x17, 0x26, 0x02, 0x57, 0x5C, 0xF5, 0x2D, 
+0x20, 0x9E, 0x6D, 0x8C, 
+
+0x2F, 0x7D, 0xB1, 0x95, 0x78, 0xE4, 0x67, 0xC4, 
+0xC8, 0x13, 0xE3, 0x17, 0xCC, 0xEE, 0x37, 0x6C, 
+0x7B, 0xFB, 0xC2, 0x41, 0x63, 0x34, 0x90, 0xDB, 
+0x6F, 0x24, 0x82, 0x44, 0x4A, 0x48, 0x44, 0xAC, 
+0x6F, 0x41, 0x05, 0xE2, 0x68, 0x51, 0xC8, 0x3E, 
+0x53, 0x19, 0x74, 0x72, 0x40, 0x65, 0xB8, 0xC8, 
+0xC7, 0x24, 0xCE, 0x9C, 0x47, 0x95, 0x39, 0x67, 
+0xE0, 0x26, 0x4C, 0x64, 0xF6, 0x84, 0xE9, 0xBD, 
+0xFB, 0x17, 0x68, 0xDD, 0x17, 0x04, 0x2B, 0x32, 
+0x37, 0x7E, 0x6C, 0x4F, 0x09, 0xDC, 0xD6, 0xA4, 
+0x69, 0xC2, 0x2A, 0xE8, 0xB0, 0x1B, 0x63, 0x2C, 
+0x84, 0x3C, 0xE5, 0x66, 0x5F, 0x2B, 0xF0, 0xF6, 
+0xB2, 0x98, 0xAA, 0x0A, 0x33, 0x62, 0x1E, 0xE3, 
+0x2F, 0xD2, 0x0C, 0xC6, 0xDE, 0xDC, 0xF7, 0x3B, 
+0x79, 0xE6, 0x3A, 0xF4, 0x3D, 0xBC, 0x8F, 0x40, 
+0x1D, 0xD3, 0xEF, 0x7B, 0x1F, 0x99, 0xB6, 0xEA, 
+0x86, 0xBD, 0x09, 0x28, 0x3F, 
+
+0x3E, 0xA5, 0x58, 0x52, 0x7D, 0xC3, 0xC9, 0x84, 
+0x93, 0x91, 0x3D, 0x77, 0x49, 0x53, 0x44, 0x12, 
+0xD5, 0xD4, 0x9D, 0x1D, 0x90, 0xA8, 0x01, 0xF1, 
+0x83, 0x13, 0x8A, 0xC3, 0x1E, 0x09, 0x8C, 0x6F, 
+0x65, 0x72, 0x51, 0x6A, 0xB7, 0x40, 0x4E, 0xBF, 
+0x3E, 0x8B, 0xC6, 0x0E, 0xBD, 0x09, 0x4F, 0x83, 
+0x3E, 0x4B, 0xCD, 0xD9, 0x5F, 0x38, 0x3F, 0xAF, 
+0xC5, 0xF6, 0xE2, 0x58, 0x31, 0x8D, 0xC5, 0x13, 
+0x23, 0x3A, 0xE7, 0x10, 0x24, 0xC9, 0x42, 0x48, 
+0x92, 0x17, 0x1F, 0x65, 0x7C, 0x55, 0x30, 0x37, 
+0x75, 0x48, 0xF1, 0x86, 0xD6, 0x32, 0x6D, 0xE2, 
+0x6F, 0x5A, 0x18, 0x4A, 0xD2, 0x3B, 0xC8, 0xB6, 
+0x1B, 0x67, 0x73, 0xA6, 0x52, 0xA1, 0x14, 0xC3, 
+0xD7, 0xEB, 0xBC, 0x73, 0xA5, 0xA3, 0xE7, 0x12, 
+0x67, 0xAC, 0x7A, 0xD7, 0x61, 0xD6, 0xF7, 0x3F, 
+0xC9, 0xDF, 0xD7, 0xF8, 0xCE, 0xB5, 0x22, 0x42, 
+0x4D, 0xF2, 0xCF, 0x59, 0x96, 0x77, 
+
+0xB5, 0xA0, 0xCF, 0xC7, 0x67, 0x1D, 0x8C, 0x5A, 
+0x51, 0x0A, 0x02, 0xD0, 0x5E, 0x55, 0x16, 0x57, 
+0x17, 0x6A, 0x26, 0x3E, 0x95, 0x55, 0x7C, 0xED, 
+0x90, 0x29, 0x6C, 0xB4, 0x5B, 0x7C, 0x6D, 0xC1, 
+0x17, 0xE2, 0x36, 0xE9, 0xF0, 0x2F, 0xF2, 0xDC, 
+0xBE, 0xCE, 0x4A, 0xC7, 0xD1, 0x7E, 0x4F, 0x69, 
+0x7D, 0xA7, 0x8C, 0x2D, 0xA9, 0x4F, 0x64, 0x3C, 
+0xB4, 0xD7, 0x93, 0x2A, 0x4C, 0xA9, 0xB7, 0x06, 
+0x28, 0x87, 0x48, 0xDF, 0x66, 0x39, 0xE4, 0xA3, 
+0xB1, 0x52, 0x57, 0x44, 0x9D, 0x0A, 0x5A, 0x14, 
+0xC2, 0x8A, 0x42, 0x04, 0xA8, 0xE0, 0x0B, 0xDA, 
+0xEE, 0xE6, 0x7D, 0xC0, 0x11, 0x9A, 0x78, 0x48, 
+0x0A, 0xEA, 0x32, 0xF8, 0x31, 0x9D, 0xDB, 0x48, 
+0x99, 0x89, 0x89, 0x94, 0xBE, 0xD1, 0x9D, 0x11, 
+0x2D, 0x13, 0x61, 0x2D, 0x27, 0x95, 0x9C, 0x97, 
+0x70, 0xCD, 0xD1, 0x1F, 0x74, 0x44, 0x5F, 0x6E, 
+0x5D, 0x83, 0xAD, 0x8C, 0xF1, 0x1A, 0x21, 
+
+0xF4, 0xC9, 0x36, 0xC9, 0x00, 0x08, 0xB7, 0x90, 
+0x04, 0x93, 0x4E, 0xA9, 0x94, 0x22, 0x58, 0x00, 
+0xCF, 0xB8, 0xC5, 0xB8, 0xAC, 0x8C, 0x09, 0xA1, 
+0x48, 0xF3, 0x2C, 0x1E, 0x8D, 0x66, 0x92, 0x47, 
+0x6B, 0xC2, 0x4A, 0xCD, 0x45, 0x4A, 0xE9, 0xA1, 
+0x5B, 0x25, 0xFB, 0x39, 0xDA, 0xB2, 0x9D, 0x19, 
+0x63, 0x86, 0x31, 0x78, 0x18, 0xA2, 0x22, 0x18, 
+0xC1, 0x14, 0x56, 0xF7, 0x5D, 0x7A, 0x00, 0x43, 
+0x26, 0xEA, 0x32, 0x1F, 0x09, 0x1B, 0x38, 0x38, 
+0xC3, 0x77, 0xCE, 0x25, 0x70, 0x8C, 0xB0, 0xF4, 
+0x94, 0xB4, 0x72, 0x23, 0x9C, 0x07, 0xF1, 0xC0, 
+0x20, 0xF0, 0x5A, 0x3A, 0xAF, 0xDF, 0xAF, 0xF6, 
+0xED, 0x1A, 0xC7, 0x89, 0x7E, 0x65, 0x1F, 0x9D, 
+0xF4, 0x6A, 0xE8, 0x78, 0x43, 0xA6, 0x79, 0x35, 
+0xA7, 0xCF, 0x1E, 0xDF, 0x0F, 0x34, 0xED, 0xCF, 
+0xA3, 0x10, 0x3A, 0xD5, 0xFC, 0x98, 0x05, 0x2F, 
+0x57, 0x76, 0x39, 0x26, 0x0D, 0x87, 0x8F, 0x26, 
+
+0x23, 0xA2, 0xCB, 0xE8, 0x76, 0xCB, 0x29, 0x91, 
+0xEF, 0xF4, 0xDC, 0xB9, 0xBF, 0x6B, 0x82, 0x49, 
+0x31, 0x4F, 0x8F, 0xF2, 0x04, 0x06, 0xBD, 0xFC, 
+0x68, 0x69, 0x00, 0x42, 0xE5, 0x8C, 0x9B, 0xA9, 
+0xDA, 0xD9, 0x68, 0x04, 0x07, 0x93, 0x17, 0x7E, 
+0xB3, 0x52, 0x34, 0xC6, 0x01, 0xD4, 0xCE, 0xD0, 
+0x49, 0x22, 0x39, 0x69, 0x5A, 0xA7, 0xB6, 0x0B, 
+0x46, 0x6E, 0xEF, 0x8E, 0x39, 0xF6, 0x20, 0xF7, 
+0x8F, 0xF4, 0x16, 0x98, 0x1E, 0x8A, 0xA6, 0x96, 
+0x95, 0xF7, 0x60, 0x9A, 0x76, 0xAA, 0x01, 0x1B, 
+0x4D, 0xE3, 0x41, 0xC7, 0xEB, 0x4B, 0x73, 0x17, 
+0xFF, 0xD7, 0xAB, 0xF1, 0x8A, 0x77, 0x6E, 0xB2, 
+0x11, 0xA6, 0x56, 0x8F, 0x9C, 0x5D, 0x64, 0xDD, 
+0x8C, 0xE7, 0x4B, 0x51, 0x54, 0xFD, 0x09, 0x78, 
+0x05, 0xCC, 0x5A, 0xF4, 0xCE, 0x95, 0x36, 0xD3, 
+0xCF, 0x17, 0xEE, 0x28, 0x45, 0xC3, 0xDE, 0x97, 
+0xB6, 0xA0, 0xFD, 0x7B, 0x19, 0x84, 0x78, 0xF2, 
+0x15, 
+
+0x93, 0x44, 0xB1, 0x99, 0x2D, 0x94, 0x49, 0x99, 
+0xE1, 0xBE, 0x3D, 0xB9, 0xD6, 0xCE, 0xF6, 0x66, 
+0x45, 0x52, 0xC8, 0x09, 0x57, 0xF8, 0x7B, 0xE3, 
+0x16, 0xEE, 0xA6, 0xAE, 0x5A, 0xB3, 0xAB, 0x91, 
+0xC0, 0xDB, 0xCA, 0x80, 0xE4, 0x55, 0x29, 0x79, 
+0x6E, 0x6F, 0x7D, 0x58, 0x31, 0xC9, 0x48, 0x39, 
+0x75, 0xC4, 0x65, 0x7D, 0x17, 0x51, 0xB6, 0x1A, 
+0x0E, 0x32, 0x85, 0x64, 0xD8, 0x75, 0xEB, 0xF4, 
+0xD2, 0xC9, 0x4E, 0xD0, 0xFD, 0x15, 0xC8, 0xE7, 
+0x54, 0x11, 0x5B, 0x37, 0x46, 0x2A, 0x08, 0x48, 
+0x03, 0xD4, 0xE1, 0x88, 0x64, 0x93, 0xC0, 0x70, 
+0x3F, 0x18, 0x07, 0x6C, 0xF8, 0x22, 0x3A, 0x50, 
+0x9A, 0x72, 0x8A, 0xB6, 0x00, 0xD0, 0xE5, 0x0C, 
+0x28, 0xB0, 0x6B, 0x76, 0xE5, 0x26, 0x8E, 0x3D, 
+0x9C, 0x8E, 0xD5, 0x33, 0xEB, 0x9D, 0xC5, 0xC2, 
+0x70, 0xD5, 0x37, 0x84, 0xCE, 0x9D, 0xBA, 0xC4, 
+0x5B, 0x49, 0x9A, 0x1E, 0xAF, 0xB4, 0x4C, 0x38, 
+0xB5, 0x06, 
+
+0x7B, 0x91, 0x8D, 0x3E, 0xCF, 0xB0, 0xA6, 0x99, 
+0xF8, 0x14, 0x17, 0xE5, 0x0B, 0xD8, 0x71, 0xD6, 
+0xB3, 0x00, 0xD4, 0xC4, 0xDF, 0x2C, 0x49, 0x3A, 
+0xE7, 0x45, 0x5B, 0x51, 0xDA, 0x89, 0x27, 0x8F, 
+0x2E, 0x6A, 0xFA, 0xD5, 0x50, 0x86, 0xAA, 0x0D, 
+0x7F, 0x19, 0xE2, 0x67, 0x26, 0x30, 0xCE, 0x56, 
+0xF4, 0xDC, 0xE2, 0x70, 0x8B, 0x6E, 0x46, 0x3C, 
+0x46, 0xD3, 0xCD, 0x2C, 0xA7, 0x7E, 0x3D, 0x59, 
+0x0F, 0x6C, 0x82, 0xBC, 0x47, 0x7F, 0x97, 0x34, 
+0x53, 0xD4, 0x52, 0x78, 0xDA, 0x25, 0x60, 0x12, 
+0x7B, 0x0F, 0xC1, 0x47, 0x34, 0x76, 0x4E, 0xA3, 
+0x9E, 0x93, 0xA0, 0x59, 0xAE, 0x8C, 0x70, 0xB7, 
+0xE9, 0x27, 0x74, 0x9C, 0xC5, 0x52, 0xCF, 0x99, 
+0x4C, 0xCE, 0x35, 0x9E, 0x8A, 0x73, 0x43, 0x8A, 
+0xA4, 0xC8, 0xB7, 0x1A, 0xF9, 0xED, 0x52, 0x5E, 
+0x33, 0x5C, 0x0A, 0x43, 0xDE, 0x7E, 0xA2, 0x5E, 
+0xB6, 0xB4, 0xEA, 0x9D, 0xE7, 0x9F, 0xDE, 0x1E, 
+0xEF, 0xA0, 0x25, 
+
+0x00, 0x5D, 0x53, 0xAC, 0x0D, 0xBB, 0xC2, 0x78, 
+0xCC, 0x4E, 0x5E, 0x86, 0xA8, 0xEB, 0x4B, 0x5F, 
+0x13, 0xB9, 0x2E, 0xCC, 0x06, 0xC1, 0x78, 0x1A, 
+0xA8, 0xD0, 0x29, 0x4F, 0xE7, 0xD5, 0x6E, 0xBB, 
+0xAD, 0x35, 0x40, 0x6A, 0x4B, 0x63, 0x43, 0xB4, 
+0x62, 0x5A, 0x3C, 0xFB, 0x6A, 0xD5, 0xDC, 0x1F, 
+0x87, 0xFF, 0xFE, 0xAE, 0x2E, 0xC9, 0x23, 0xC5, 
+0x5F, 0xE7, 0x6D, 0x4C, 0xAB, 0x7C, 0x1E, 0xCA, 
+0x94, 0xD3, 0x8F, 0xEC, 0x28, 0x6C, 0xB3, 0xB5, 
+0x40, 0x35, 0x83, 0x63, 0x4A, 0x25, 0x26, 0x08, 
+0x34, 0x83, 0x70, 0x0F, 0x50, 0xF6, 0xDC, 0xA0, 
+0xB5, 0xD9, 0xE2, 0xE3, 0x61, 0x52, 0x58, 0xD0, 
+0xBE, 0x40, 0xF9, 0x17, 0x43, 0xD4, 0x3C, 0xC8, 
+0xC2, 0x6C, 0x2B, 0x14, 0x1A, 0xC1, 0x66, 0xB8, 
+0x10, 0x06, 0x9B, 0x8F, 0xE4, 0xED, 0x9E, 0xE3, 
+0xFB, 0x83, 0x5C, 0xB6, 0xB4, 0xB2, 0xB4, 0x69, 
+0x86, 0x84, 0x5B, 0xA1, 0x1D, 0x55, 0xFB, 0x96, 
+0x93, 0x9F, 0x0D, 0xD6, 
+
+0xE8, 0x1A, 0x5C, 0x45, 0xE4, 0x9D, 0xA4, 0x16, 
+0xAB, 0x75, 0x0A, 0xBA, 0x03, 0x15, 0x06, 0x3C, 
+0xA8, 0xAF, 0xD0, 0x7C, 0x77, 0x72, 0xA1, 0x55, 
+0xB6, 0x8E, 0x65, 0xEE, 0xE2, 0xF8, 0x9A, 0xD6, 
+0x7B, 0xC0, 0x2F, 0x51, 0x98, 0xCC, 0xC2, 0x08, 
+0x9C, 0xF9, 0xCE, 0x7F, 0x90, 0x11, 0x32, 0x8D, 
+0x16, 0xE4, 0x16, 0x5E, 0x97, 0x71, 0x1B, 0x97, 
+0xCB, 0x8F, 0xA1, 0xF9, 0x18, 0xCB, 0xFD, 0x13, 
+0x20, 0x15, 0x00, 0xC2, 0x04, 0xAE, 0xAE, 0xB0, 
+0x35, 0xC8, 0xEE, 0x1C, 0x7F, 0x7C, 0x45, 0x49, 
+0x08, 0x8C, 0x21, 0xB0, 0xB3, 0xB1, 0x60, 0xFA, 
+0xED, 0x33, 0x3A, 0x3E, 0xFD, 0xF0, 0x79, 0x25, 
+0xC1, 0x44, 0xEA, 0xA6, 0x73, 0x7A, 0xB9, 0x57, 
+0x61, 0x16, 0xC8, 0x11, 0x0C, 0x16, 0x11, 0x47, 
+0xBB, 0xA4, 0x20, 0xA3, 0x00, 0xE7, 0x2F, 0xE4, 
+0x9D, 0x15, 0x0F, 0x2C, 0xDF, 0x1C, 0x1D, 0x42, 
+0xF1, 0xDF, 0x8C, 0xDB, 0xF7, 0xC0, 0x8F, 0xC3, 
+0x6A, 0x40, 0x77, 0x4E, 0xE3, 
+
+0x7F, 0x8E, 0x53, 0xE6, 0x66, 0xE5, 0x10, 0xC8, 
+0x6B, 0xA2, 0x31, 0xD1, 0x7F, 0xA1, 0x69, 0x16, 
+0x40, 0xB4, 0xBE, 0xA2, 0x08, 0x8D, 0x42, 0x59, 
+0xAB, 0x77, 0x06, 0x22, 0x7C, 0x05, 0x6D, 0x1C, 
+0x11, 0xBD, 0x5B, 0xF2, 0xA8, 0x9C, 0x0F, 0x40, 
+0x7C, 0xB1, 0x01, 0x5A, 0xD9, 0x43, 0x63, 0x78, 
+0xF6, 0x3F, 0x83, 0x1B, 0x85, 0xA3, 0x06, 0x24, 
+0xC7, 0x41, 0x2C, 0xA1, 0xC1, 0xF8, 0x9B, 0x24, 
+0xB1, 0x86, 0x1A, 0xCF, 0xF8, 0x9C, 0x5D, 0x63, 
+0x70, 0xC2, 0xF3, 0x58, 0x93, 0x7D, 0x6C, 0x77, 
+0x8B, 0x2B, 0xC3, 0x85, 0xC9, 0xB0, 0x1E, 0x45, 
+0x86, 0x8C, 0xDA, 0x31, 0x7A, 0x2A, 0xEE, 0x2F, 
+0x94, 0x05, 0x96, 0xF2, 0x6E, 0xEF, 0x60, 0x21, 
+0xDB, 0x05, 0x60, 0x93, 0x39, 0xF6, 0xE4, 0x46, 
+0xAB, 0x11, 0x6A, 0x95, 0x98, 0x0D, 0x18, 0x6C, 
+0x9C, 0x0C, 0xEF, 0xEA, 0xAA, 0x1C, 0x2D, 0x25, 
+0x28, 0x98, 0x64, 0x64, 0x4D, 0xB0, 0x08, 0x5C, 
+0x80, 0xE4, 0xEC, 0x02, 0x50, 0x4E, 
+
+0x6B, 0x38, 0xF4, 0xC9, 0x20, 0xA9, 0x3D, 0x34, 
+0x17, 0xE7, 0xC9, 0x7B, 0x79, 0x9B, 0x4B, 0x25, 
+0xE7, 0x5B, 0xC1, 0x04, 0x80, 0x64, 0xCB, 0x63, 
+0x32, 0x85, 0xF2, 0xE1, 0x2F, 0x4E, 0x75, 0xE6, 
+0xC6, 0xDE, 0x41, 0x59, 0xDB, 0xD7, 0x14, 0x4B, 
+0x99, 0x30, 0x3F, 0x01, 0xA6, 0x86, 0xD4, 0x39, 
+0xF8, 0x34, 0x8D, 0x6A, 0xB6, 0x2D, 0xC3, 0xF3, 
+0x95, 0x03, 0xDF, 0x2F, 0x43, 0xB3, 0xC9, 0x36, 
+0x69, 0x5B, 0xD3, 0x6B, 0x75, 0x29, 0xBA, 0x6A, 
+0xB1, 0x5E, 0x1B, 0xCF, 0x34, 0x86, 0x21, 0x65, 
+0xB0, 0x8C, 0x58, 0xA6, 0xAC, 0xF2, 0xC0, 0x0A, 
+0xC5, 0xF9, 0x21, 0xEC, 0x62, 0xBF, 0x58, 0xAD, 
+0x0B, 0x15, 0xF6, 0x41, 0xA2, 0xB7, 0x8C, 0x5B, 
+0xFF, 0x6B, 0x51, 0xE9, 0xB1, 0x37, 0x95, 0xBE, 
+0xF5, 0xBD, 0xAF, 0xB9, 0xC4, 0xCE, 0xD2, 0x2E, 
+0xBC, 0x86, 0x09, 0xB4, 0xB1, 0x11, 0x63, 0x93, 
+0x49, 0x8C, 0x17, 0x6E, 0x50, 0x7D, 0xE7, 0x04, 
+0x0B, 0x52, 0x34, 0x16, 0x15, 0x77, 0x40, 
+
+0x77, 0x79, 0xFF, 0x99, 0x04, 0x94, 0x3D, 0xC1, 
+0x64, 0x5D, 0x9B, 0x31, 0xAB, 0x94, 0x4A, 0xFD, 
+0xD7, 0xF3, 0x9F, 0xA8, 0xBB, 0xAE, 0x23, 0x56, 
+0x07, 0x46, 0x1C, 0x1F, 0x72, 0xD5, 0x1E, 0x42, 
+0xDC, 0x8B, 0x5F, 0xBE, 0xA4, 0xAA, 0xED, 0x4C, 
+0x82, 0xA9, 0x5C, 0xC0, 0xFB, 0x8E, 0x0D, 0x93, 
+0xE6, 0x16, 0xF6, 0xF1, 0xE6, 0xF1, 0xDB, 0x7B, 
+0x7A, 0xD3, 0x30, 0x5D, 0x0F, 0x7A, 0xAB, 0xBE, 
+0x18, 0xF1, 0xD8, 0x8C, 0x28, 0x25, 0xD9, 0x91, 
+0x36, 0x73, 0x49, 0x84, 0xC8, 0x18, 0x67, 0x17, 
+0x67, 0x8E, 0x34, 0xBC, 0x29, 0x02, 0xDA, 0xC3, 
+0xDE, 0x9F, 0x61, 0xDF, 0x48, 0xBD, 0x5E, 0xFB, 
+0xA8, 0xDA, 0x11, 0x28, 0x89, 0xDB, 0x15, 0xD8, 
+0x45, 0x7D, 0x70, 0x4D, 0xA0, 0x0C, 0x58, 0x79, 
+0x70, 0xB8, 0x69, 0x77, 0x45, 0x28, 0xCA, 0xB8, 
+0x68, 0x88, 0x86, 0x83, 0xAC, 0x5B, 0xF7, 0xA7, 
+0xE4, 0x41, 0x65, 0xA8, 0x33, 0x48, 0x7D, 0x65, 
+0xA7, 0x0A, 0xAC, 0xF7, 0xD3, 0xD7, 0x04, 0xBD, 
+
+0xFA, 0x01, 0x1D, 0xCE, 0xC2, 0xD7, 0x04, 0xEE, 
+0x28, 0xB4, 0x91, 0x36, 0xA9, 0xCB, 0x8E, 0x87, 
+0x66, 0x02, 0xFB, 0xDB, 0xE9, 0x8F, 0x62, 0xF4, 
+0xC9, 0x27, 0x70, 0x8F, 0x37, 0xA2, 0x0E, 0xEB, 
+0x8F, 0x4E, 0xE3, 0x29, 0xF6, 0x5D, 0xF7, 0x69, 
+0xCE, 0x6C, 0xE4, 0xC2, 0xE4, 0x40, 0x69, 0x5A, 
+0x9F, 0xA0, 0x8F, 0xC1, 0xCC, 0xAF, 0xC3, 0x69, 
+0xD1, 0x3F, 0x02, 0x9D, 0x90, 0x4D, 0x79, 0xD7, 
+0x96, 0xE3, 0x64, 0x8F, 0xC0, 0xD0, 0x81, 0xCB, 
+0x10, 0x57, 0xB2, 0xDA, 0xD5, 0xAF, 0x6C, 0xFD, 
+0xDD, 0x42, 0x97, 0xB1, 0xD0, 0x3D, 0x8A, 0xE7, 
+0x60, 0x6A, 0xD3, 0xA0, 0x90, 0x25, 0xB7, 0x8F, 
+0xFE, 0x26, 0x23, 0x08, 0x1A, 0x65, 0xB5, 0xC7, 
+0x9D, 0x2B, 0x24, 0x4D, 0x3D, 0x05, 0xE3, 0xC9, 
+0x8D, 0x88, 0x93, 0x66, 0xAA, 0x4E, 0xE4, 0xB2, 
+0x04, 0x77, 0x53, 0x87, 0x53, 0x7B, 0x0D, 0x0E, 
+0x3F, 0xC4, 0x88, 0x2F, 0x08, 0x47, 0x16, 0x6D, 
+0x5B, 0x9A, 0x09, 0x69, 0x2E, 0x05, 0x48, 0xD0, 
+0xB9, 
+
+0x82, 0xB2, 0xE6, 0x12, 0x1F, 0xE7, 0xF4, 0x19, 
+0x44, 0x4C, 0x7A, 0xF0, 0xC5, 0xA4, 0xF5, 0x79, 
+0x86, 0x62, 0x86, 0x5A, 0x8B, 0xA5, 0xF5, 0x7B, 
+0x30, 0x81, 0x60, 0x33, 0x2E, 0x44, 0x36, 0x77, 
+0x45, 0x00, 0x0D, 0x43, 0x2B, 0x61, 0x4C, 0x00, 
+0x09, 0xEB, 0x76, 0x4C, 0x10, 0x5A, 0x44, 0x5E, 
+0x05, 0xFF, 0x99, 0x33, 0x91, 0x32, 0x54, 0xC6, 
+0xC2, 0x82, 0x7E, 0x8C, 0x03, 0x34, 0x76, 0xAA, 
+0xEB, 0x5D, 0x36, 0x33, 0x6F, 0x20, 0xB8, 0x9A, 
+0xBF, 0x2A, 0x92, 0x57, 0x29, 0xA4, 0xEA, 0xB4, 
+0xE6, 0x61, 0x64, 0x6A, 0xE1, 0x48, 0x1E, 0x6F, 
+0xF1, 0x38, 0x70, 0x55, 0x3F, 0x37, 0x47, 0x07, 
+0xB1, 0xFC, 0x7B, 0x32, 0x18, 0x53, 0x26, 0xBA, 
+0xD8, 0x5A, 0xC5, 0x56, 0x2D, 0xC0, 0xAC, 0x7D, 
+0x64, 0x94, 0x4F, 0x4D, 0x2E, 0xA5, 0x13, 0x6A, 
+0x57, 0x96, 0x42, 0x4F, 0x6F, 0x6A, 0x18, 0x04, 
+0xDB, 0xC0, 0x4F, 0xA3, 0x30, 0x40, 0x74, 0x6A, 
+0x12, 0x9C, 0x8A, 0x7B, 0x1B, 0x1B, 0x94, 0xF5, 
+0x57, 0x29, 
+
+0xAE, 0x14, 0x6F, 0x73, 0x5F, 0xDA, 0xD0, 0x7F, 
+0x3A, 0xE8, 0x50, 0x02, 0xC8, 0x59, 0x94, 0x93, 
+0x43, 0xCE, 0xB3, 0xE1, 0xED, 0xA9, 0x6D, 0xAA, 
+0x0D, 0xDF, 0x5F, 0x7C, 0x16, 0x8C, 0x05, 0xD8, 
+0x4C, 0x83, 0x5F, 0xE5, 0x27, 0x38, 0x15, 0x58, 
+0xAC, 0x1E, 0x10, 0x53, 0xD0, 0x5A, 0x87, 0x45, 
+0x9E, 0x89, 0x5C, 0xEB, 0x99, 0xA9, 0x47, 0x7C, 
+0xC8, 0xAE, 0x86, 0x88, 0x9C, 0x3C, 0xA5, 0xA6, 
+0x79, 0xB7, 0x47, 0x78, 0x40, 0x1A, 0xD4, 0x34, 
+0x23, 0x19, 0x64, 0x46, 0xD9, 0x3B, 0x96, 0xD7, 
+0xA7, 0x98, 0x12, 0x5D, 0xBB, 0x15, 0x36, 0xAC, 
+0x35, 0xFD, 0x6D, 0x26, 0x5F, 0x07, 0xF8, 0xF8, 
+0xF6, 0xCB, 0xE0, 0x71, 0xA8, 0x80, 0x0A, 0x63, 
+0x4C, 0x1B, 0x4B, 0x79, 0xFD, 0xA1, 0x16, 0x12, 
+0xD9, 0x39, 0xB5, 0x42, 0x10, 0xD4, 0xC7, 0x7F, 
+0x60, 0xE5, 0xDF, 0x0C, 0x4E, 0x49, 0x0D, 0xC2, 
+0xBB, 0x9C, 0xEA, 0xC2, 0x0C, 0xBA, 0xAB, 0xAF, 
+0xDC, 0x5A, 0xFD, 0xBF, 0x6E, 0x08, 0x33, 0x90, 
+0x46, 0xE8, 0x37, 
+
+0x26, 0xA2, 0x17, 0xEC, 0x09, 0x3E, 0x76, 0xF4, 
+0x95, 0xCF, 0x6E, 0x13, 0x57, 0xBF, 0x8C, 0x90, 
+0xC6, 0x59, 0xEF, 0x27, 0x70, 0xAD, 0xCA, 0x30, 
+0x34, 0xA3, 0x04, 0xD3, 0x4F, 0x48, 0x60, 0x22, 
+0x41, 0x1B, 0x8C, 0x79, 0x25, 0x52, 0xF4, 0xCC, 
+0x2D, 0xAE, 0x0D, 0x65, 0xD7, 0x0E, 0x6B, 0xDD, 
+0xBA, 0xEB, 0xBB, 0xC4, 0xEF, 0x87, 0xBA, 0xFE, 
+0x68, 0x98, 0x3C, 0x50, 0x69, 0xC7, 0x1A, 0x40, 
+0x3E, 0x61, 0x19, 0x58, 0x00, 0xAD, 0x44, 0x7B, 
+0x92, 0x9C, 0xD4, 0xB6, 0x51, 0x49, 0xCB, 0x5C, 
+0x2B, 0xD9, 0xAF, 0xBA, 0x95, 0x3F, 0x0B, 0x4B, 
+0x7F, 0x7B, 0x40, 0x78, 0x60, 0xB3, 0x41, 0xB6, 
+0xC5, 0xC2, 0xAE, 0x6B, 0xDA, 0xF0, 0xF8, 0x20, 
+0xAA, 0x18, 0x6C, 0x94, 0xF6, 0x60, 0xD3, 0x44, 
+0x1A, 0x59, 0x74, 0x39, 0x9F, 0x1A, 0x1E, 0x5D, 
+0x9C, 0x37, 0x0B, 0x05, 0x0D, 0x23, 0xA0, 0x9B, 
+0xD7, 0x73, 0x35, 0x46, 0x7C, 0x8B, 0xCD, 0x1B, 
+0xC4, 0x5C, 0x8B, 0x62, 0x4F, 0xA0, 0x5D, 0xDC, 
+0x8E, 0xE7, 0x73, 0xF8, 
+
+0x87, 0x35, 0x4B, 0x5D, 0xCA, 0x70, 0x29, 0x05, 
+0x40, 0xF4, 0x31, 0xB9, 0x87, 0x0C, 0xE3, 0xE3, 
+0x40, 0x34, 0x82, 0x2A, 0xA5, 0xCB, 0x20, 0xC8, 
+0xAB, 0x0B, 0xF8, 0x6E, 0x5F, 0x0A, 0x9B, 0xF1, 
+0x39, 0x3E, 0x1E, 0xCF, 0x8E, 0x0A, 0xCB, 0x2F, 
+0x46, 0x2F, 0xE2, 0x96, 0x47, 0x91, 0x09, 0x14, 
+0x68, 0x42, 0x88, 0xB1, 0x35, 0x75, 0x83, 0x2F, 
+0x4D, 0xBC, 0xC4, 0x12, 0x0D, 0x78, 0xB2, 0x7A, 
+0x77, 0x68, 0x52, 0x31, 0xE4, 0x63, 0x24, 0x8A, 
+0x47, 0x6F, 0x7A, 0x8A, 0xD0, 0x5A, 0x4A, 0x1B, 
+0x19, 0x84, 0xCF, 0x44, 0xE7, 0xA3, 0x80, 0xBD, 
+0x69, 0x56, 0x07, 0x39, 0xBD, 0x4A, 0x6E, 0xFE, 
+0x6A, 0x5D, 0xF4, 0xBA, 0x96, 0xE1, 0x40, 0x28, 
+0xF6, 0x30, 0x28, 0x07, 0x0A, 0x5A, 0x88, 0x52, 
+0x21, 0x39, 0x28, 0xEF, 0xEC, 0xAD, 0x39, 0x07, 
+0x58, 0x84, 0xD9, 0x33, 0xF6, 0x21, 0x98, 0xA2, 
+0xB1, 0xB4, 0xCC, 0x0C, 0x43, 0xB0, 0x56, 0xD9, 
+0xDB, 0x73, 0x3F, 0xA8, 0xCD, 0xD1, 0x93, 0xB8, 
+0x06, 0x2E, 0x67, 0x67, 0x72, 
+
+0xF1, 0x68, 0x89, 0xCA, 0x8B, 0x71, 0x26, 0xD6, 
+0x98, 0x1E, 0x44, 0x0A, 0xB4, 0xC0, 0x62, 0x40, 
+0xBB, 0xCB, 0x60, 0xF4, 0x84, 0xD0, 0x45, 0xFE, 
+0xA0, 0xAF, 0xE0, 0xCD, 0x9E, 0xA0, 0x83, 0x40, 
+0xB9, 0x03, 0x5F, 0x17, 0xC9, 0xD0, 0x74, 0x09, 
+0x69, 0xD1, 0xBB, 0x3E, 0x7C, 0xEB, 0x38, 0xB8, 
+0xC6, 0xB4, 0x63, 0x9C, 0xAD, 0xBC, 0xC7, 0x16, 
+0xB2, 0x07, 0x56, 0xF4, 0x37, 0xEC, 0xC6, 0x3A, 
+0x55, 0x89, 0x4C, 0xB5, 0x8A, 0x7D, 0x80, 0x70, 
+0x4B, 0x6C, 0x14, 0xF2, 0xCD, 0xED, 0x31, 0xF3, 
+0x23, 0xD5, 0x96, 0xC4, 0x20, 0xC0, 0xD4, 0xE3, 
+0x1A, 0x20, 0xC8, 0x75, 0x00, 0xA9, 0x23, 0x29, 
+0x3C, 0x59, 0xE0, 0x91, 0xF5, 0xA2, 0xE5, 0x3C, 
+0x98, 0x2D, 0x26, 0xF6, 0xCF, 0xA6, 0xE2, 0xDD, 
+0xD5, 0x3D, 0x24, 0x60, 0x89, 0xFC, 0x0E, 0x5A, 
+0xCA, 0xD4, 0x4A, 0x58, 0x1B, 0xE5, 0xBA, 0x22, 
+0xF4, 0x52, 0x32, 0xD1, 0x13, 0x5D, 0x9D, 0x15, 
+0xF7, 0x50, 0x5B, 0x4C, 0x9C, 0x18, 0xD8, 0x60, 
+0x9D, 0x12, 0x1C, 0xEF, 0xE6, 0x84, 
+
+0x96, 0xC0, 0xAC, 0x6B, 0x44, 0x8F, 0x93, 0x9C, 
+0xC6, 0x7F, 0xB0, 0x56, 0xD9, 0x33, 0xB9, 0x36, 
+0xFF, 0x00, 0xFC, 0x17, 0x3D, 0x23, 0x45, 0x36, 
+0x6D, 0xCF, 0x16, 0x9C, 0x3C, 0xB3, 0xA5, 0x60, 
+0xD3, 0xA2, 0x0F, 0xFD, 0xE1, 0x1A, 0xD6, 0x36, 
+0x44, 0x04, 0xA8, 0xB7, 0x4E, 0xF0, 0x76, 0x63, 
+0x15, 0x77, 0xD7, 0x2D, 0x87, 0xA4, 0xBC, 0xED, 
+0xE3, 0x34, 0xA0, 0x32, 0xEF, 0x31, 0xF2, 0x7A, 
+0x7E, 0xE4, 0x73, 0x28, 0xD8, 0xCB, 0xAE, 0x38, 
+0x82, 0x60, 0x0E, 0xAA, 0xE9, 0xFA, 0x50, 0xE7, 
+0x24, 0xDD, 0xE4, 0x0A, 0xB6, 0x23, 0xF4, 0x93, 
+0xC0, 0xB0, 0xE5, 0x32, 0x06, 0x37, 0x0E, 0x55, 
+0x4C, 0x19, 0x64, 0x42, 0x4E, 0xF3, 0x7B, 0x7B, 
+0xBC, 0x32, 0x9C, 0xF9, 0xD7, 0x1C, 0x09, 0xBC, 
+0x03, 0x97, 0x0A, 0x10, 0x99, 0x46, 0x3D, 0x72, 
+0x06, 0x03, 0xB5, 0x1C, 0xEE, 0x5C, 0x3B, 0xFF, 
+0x9A, 0x0D, 0x3C, 0x20, 0x24, 0xF0, 0x11, 0xB7, 
+0x4F, 0x05, 0x6D, 0x5E, 0x20, 0x38, 0x73, 0x80, 
+0x05, 0x47, 0x0D, 0xE2, 0xA3, 0xA3, 0x0D, 
+
+0x5C, 0xF1, 0x47, 0xB3, 0x30, 0x6D, 0x48, 0x8B, 
+0x77, 0x54, 0xE3, 0xA5, 0x31, 0xF3, 0xFC, 0x19, 
+0x56, 0xE6, 0x7F, 0x8B, 0x5E, 0x69, 0x3B, 0xC6, 
+0x59, 0xD3, 0x88, 0x75, 0x50, 0x13, 0xAB, 0xB6, 
+0x46, 0x09, 0x9E, 0x9D, 0x16, 0x7B, 0x34, 0x8D, 
+0xA2, 0x66, 0xBE, 0xF6, 0x90, 0xAA, 0x89, 0x20, 
+0xF3, 0xB7, 0xA9, 0xD2, 0x8B, 0x23, 0xB6, 0x9E, 
+0xD4, 0xA5, 0xA7, 0x05, 0xF2, 0xEB, 0xF8, 0xD0, 
+0x6E, 0xBF, 0xB0, 0x6A, 0xC9, 0x4A, 0x4D, 0x98, 
+0x23, 0xFF, 0x5C, 0xF4, 0xF4, 0xBC, 0x52, 0x10, 
+0x2C, 0xD2, 0xE3, 0xFF, 0x21, 0x49, 0x45, 0xCA, 
+0x5A, 0x43, 0x0E, 0xC5, 0x7D, 0x98, 0x33, 0x44, 
+0xA6, 0x59, 0x2F, 0x0E, 0x52, 0xAA, 0x8B, 0xD6, 
+0x7A, 0x6C, 0x7F, 0x61, 0x10, 0x0E, 0x18, 0xE2, 
+0x6C, 0xCD, 0x21, 0x64, 0x61, 0xC9, 0x07, 0x3C, 
+0x72, 0xA9, 0x31, 0x5A, 0xA3, 0x46, 0x0F, 0xE1, 
+0x59, 0x5C, 0x84, 0x56, 0xBE, 0xD2, 0x1B, 0x1B, 
+0xCD, 0x39, 0x73, 0xE7, 0x5D, 0x88, 0x96, 0x1A, 
+0x89, 0x81, 0x4B, 0x0E, 0x2A, 0xFA, 0x14, 0x5C, 
+
+0x2C, 0x90, 0xFB, 0xB7, 0xE9, 0xE3, 0xF4, 0x16, 
+0x28, 0xC3, 0x45, 0xE9, 0x75, 0x3B, 0x06, 0x56, 
+0xE7, 0xD2, 0xD6, 0xC1, 0xF0, 0xCB, 0x0B, 0xBE, 
+0xA1, 0xC3, 0xD5, 0x7C, 0x6B, 0xF1, 0x57, 0x05, 
+0x60, 0xC8, 0xAA, 0xF4, 0xA6, 0x42, 0xF9, 0xA7, 
+0x8A, 0x39, 0x58, 0xCB, 0x6A, 0xFC, 0xFD, 0xF3, 
+0xC3, 0x72, 0x24, 0x81, 0x7C, 0x8A, 0xAC, 0xA4, 
+0x0C, 0x84, 0x7C, 0x79, 0x97, 0x41, 0x50, 0x3A, 
+0x42, 0x60, 0xEA, 0x4B, 0x9B, 0xA1, 0x16, 0xC1, 
+0x30, 0x50, 0xB0, 0x88, 0x1D, 0xB7, 0xB8, 0xA4, 
+0x92, 0xDB, 0x86, 0x45, 0xBC, 0xB6, 0x5C, 0x55, 
+0x12, 0x7D, 0x09, 0xF1, 0x30, 0xBE, 0xE0, 0x3C, 
+0x4D, 0xC8, 0x45, 0xD8, 0xCF, 0x34, 0x30, 0x55, 
+0xD5, 0xE2, 0x2E, 0x79, 0xDC, 0x8C, 0x64, 0x33, 
+0x7B, 0x29, 0xF6, 0x01, 0xAD, 0xA4, 0xA5, 0xC8, 
+0xE9, 0x0C, 0x01, 0x8C, 0xAC, 0xE9, 0xD9, 0xBB, 
+0xBE, 0x1C, 0x66, 0x6F, 0x75, 0x90, 0x69, 0x2D, 
+0x41, 0xD6, 0x9C, 0xAF, 0xA5, 0xD5, 0x1C, 0x15, 
+0xAA, 0x9E, 0x73, 0x5B, 0x78, 0x9E, 0x1D, 0xE8, 
+0xEE, 
+
+0x0C, 0x2C, 0xEF, 0x20, 0x50, 0x54, 0x9F, 0xAF, 
+0xC1, 0xA0, 0xAC, 0x95, 0x5D, 0xC5, 0x04, 0x4B, 
+0x08, 0x13, 0x10, 0xCA, 0x8F, 0x8C, 0xFC, 0x57, 
+0xB6, 0x3C, 0xFA, 0xFE, 0x8F, 0x52, 0x27, 0x5A, 
+0xED, 0xED, 0xB4, 0xEE, 0x36, 0xCB, 0x87, 0x68, 
+0xD2, 0x61, 0xD2, 0x67, 0x3F, 0x64, 0x6E, 0x7C, 
+0xE7, 0x69, 0x58, 0x7F, 0x7D, 0x45, 0x51, 0x5B, 
+0xB6, 0x72, 0x69, 0x1B, 0xD9, 0x39, 0xFC, 0xBF, 
+0x9B, 0x6B, 0x0D, 0xD9, 0xB1, 0x91, 0x29, 0xC1, 
+0x4F, 0x66, 0xD7, 0x93, 0xD8, 0xF0, 0x46, 0xF0, 
+0xE9, 0xC8, 0x46, 0x1D, 0xE1, 0x84, 0x0D, 0x83, 
+0x88, 0xF3, 0x76, 0x8D, 0xA8, 0xD4, 0xD2, 0xDD, 
+0x9F, 0x71, 0x8D, 0x7E, 0x8F, 0xC2, 0xA1, 0x99, 
+0x9F, 0x35, 0x50, 0xCD, 0xBA, 0xD1, 0x4F, 0xFF, 
+0xF6, 0x9A, 0x5D, 0x68, 0xCF, 0x22, 0xC8, 0x2C, 
+0xA6, 0x20, 0xAF, 0xB1, 0x7A, 0x20, 0xA2, 0x30, 
+0xE9, 0x9B, 0x5D, 0x8F, 0x56, 0xDD, 0x7E, 0xD6, 
+0x6B, 0x25, 0x25, 0x7D, 0xB4, 0x9D, 0x85, 0x22, 
+0x43, 0xFE, 0xEF, 0xCC, 0x7F, 0x77, 0x7C, 0x11, 
+0x6B, 0xAD, 
+
+0xC8, 0xC2, 0x3E, 0x18, 0xEC, 0x69, 0x40, 0xE9, 
+0xE6, 0x4B, 0x5C, 0xD6, 0xF9, 0xF7, 0x59, 0x48, 
+0x71, 0x5A, 0xBE, 0x0C, 0xE5, 0x59, 0x54, 0x44, 
+0xD5, 0x1B, 0x72, 0xF1, 0x10, 0x8E, 0x04, 0x08, 
+0x2C, 0x94, 0x98, 0x90, 0x8F, 0x92, 0x94, 0x30, 
+0xFD, 0x1D, 0x1B, 0xB9, 0xD7, 0xCC, 0xEA, 0x01, 
+0x8F, 0x0F, 0x5F, 0x8F, 0xD6, 0xAE, 0x62, 0x15, 
+0x30, 0x1F, 0x7F, 0xA3, 0xFA, 0xBE, 0x5B, 0xE0, 
+0xC9, 0x08, 0x77, 0xA0, 0xF5, 0xA4, 0x95, 0xE0, 
+0xDC, 0x11, 0xBC, 0xCF, 0x17, 0xCC, 0xCD, 0x19, 
+0x5D, 0xF2, 0x73, 0x83, 0x63, 0xC6, 0x9F, 0xE6, 
+0xBE, 0xF6, 0xD6, 0xEA, 0x9A, 0x3C, 0x28, 0xFA, 
+0x5E, 0x0B, 0x04, 0x38, 0x73, 0xA8, 0xC9, 0x4C, 
+0x41, 0xE6, 0x37, 0x0F, 0x97, 0x92, 0xA3, 0xF5, 
+0x76, 0xC6, 0xC0, 0x45, 0x38, 0xA7, 0xA7, 0x00, 
+0x93, 0xEB, 0xC6, 0x24, 0x5B, 0x3A, 0x2D, 0x74, 
+0x9A, 0x44, 0xAD, 0xBC, 0x03, 0xC4, 0x89, 0x7B, 
+0x44, 0x38, 0xB5, 0x1A, 0x88, 0x12, 0x68, 0x35, 
+0x82, 0xE1, 0x23, 0xEB, 0x0E, 0x89, 0x5B, 0xC2, 
+0x54, 0xC9, 0xF9, 
+
+0x7F, 0x68, 0xA6, 0xA3, 0xE0, 0x45, 0x0F, 0xC4, 
+0x7F, 0xCE, 0x99, 0x99, 0x04, 0xD1, 0x1D, 0x07, 
+0x93, 0x5D, 0x6F, 0x95, 0x23, 0x7A, 0x32, 0xDB, 
+0x69, 0xEC, 0x96, 0xA1, 0x73, 0xE1, 0x0D, 0x8E, 
+0xE8, 0x54, 0x96, 0x38, 0xBB, 0x04, 0xE4, 0xE2, 
+0x7F, 0x66, 0x70, 0x47, 0x97, 0xF7, 0x35, 0x06, 
+0x4E, 0x0A, 0x4B, 0x32, 0x98, 0xFD, 0x35, 0xC8, 
+0x73, 0x8B, 0x9E, 0x01, 0xBC, 0x2E, 0x74, 0x23, 
+0x66, 0x4B, 0x21, 0x66, 0xF4, 0x5D, 0x18, 0x6D, 
+0xE1, 0x3D, 0x08, 0x60, 0x93, 0xC6, 0xE6, 0x24, 
+0x4B, 0xBF, 0x9B, 0xD8, 0x6E, 0x4D, 0x4B, 0x0A, 
+0xF0, 0x25, 0x75, 0xE6, 0xCD, 0x71, 0x06, 0xE3, 
+0xCF, 0x8F, 0x04, 0xE6, 0x54, 0x23, 0xC6, 0x1D, 
+0xC0, 0x54, 0x20, 0x53, 0xC2, 0x2A, 0x75, 0x9F, 
+0x96, 0x6E, 0x4D, 0x99, 0x45, 0x66, 0x7D, 0x52, 
+0xB4, 0x0F, 0xCA, 0x82, 0x4C, 0x9C, 0x1C, 0xA5, 
+0x47, 0xB5, 0x05, 0xEC, 0x71, 0x50, 0x42, 0x7A, 
+0x5C, 0x68, 0x94, 0x05, 0x42, 0x3F, 0x4C, 0x18, 
+0xA8, 0x3D, 0x64, 0x8E, 0x97, 0x75, 0x97, 0x7C, 
+0x69, 0xC1, 0x45, 0x10, 
+
+0x6D, 0x44, 0xAC, 0x9B, 0x33, 0xBE, 0x7C, 0x77, 
+0xE5, 0x32, 0xBF, 0x55, 0xBE, 0xCF, 0xC8, 0x08, 
+0x55, 0x68, 0x45, 0x65, 0xA7, 0x78, 0xFB, 0xA5, 
+0x78, 0xD2, 0x46, 0x79, 0x25, 0x2D, 0x39, 0xF0, 
+0x96, 0x41, 0xE1, 0x85, 0x4A, 0x3F, 0xAB, 0x1A, 
+0x67, 0x4D, 0x77, 0x7F, 0x72, 0x4A, 0xD2, 0x79, 
+0xF9, 0x5B, 0xB6, 0xD9, 0x02, 0x02, 0xFF, 0xCA, 
+0x4E, 0xFD, 0xE5, 0x6D, 0x9C, 0x7D, 0x2C, 0x41, 
+0x8D, 0x8A, 0x50, 0x40, 0xF4, 0x5F, 0x4D, 0xA5, 
+0xC9, 0xFA, 0x7F, 0x0A, 0x2A, 0xDE, 0xFD, 0x88, 
+0xA7, 0x14, 0x2D, 0xDF, 0x92, 0xCA, 0x77, 0x24, 
+0x13, 0xCA, 0xCA, 0xEB, 0x26, 0x7F, 0x02, 0x29, 
+0x4E, 0x24, 0xCE, 0x0C, 0x7D, 0xD7, 0x2F, 0x19, 
+0x03, 0xEA, 0x8F, 0x54, 0x03, 0x5C, 0x5F, 0x43, 
+0x86, 0xBF, 0x67, 0x88, 0x39, 0xDE, 0xFF, 0x6E, 
+0x4C, 0xDC, 0x2A, 0x54, 0xB8, 0x6F, 0x1D, 0x6B, 
+0xC4, 0x5A, 0x6E, 0x49, 0x67, 0xC3, 0x6D, 0xF5, 
+0x8C, 0x92, 0xCC, 0x08, 0xEF, 0xCF, 0x63, 0xE7, 
+0x17, 0x3F, 0xD1, 0x1E, 0x21, 0xF0, 0x37, 0x15, 
+0x21, 0xCA, 0x8D, 0xA9, 0xE6, 
+
+0x46, 0xAC, 0x0B, 0x35, 0x2D, 0x3D, 0xA1, 0x20, 
+0x86, 0xF5, 0x2D, 0xEC, 0x92, 0x41, 0x8F, 0xFC, 
+0xE0, 0x65, 0x32, 0x67, 0xB6, 0xA4, 0x65, 0x6B, 
+0x68, 0x74, 0x6A, 0x71, 0x33, 0xFD, 0xA9, 0xE8, 
+0xE0, 0x1A, 0xB9, 0x02, 0x47, 0x4E, 0x74, 0x3D, 
+0xF6, 0x08, 0x1B, 0x4F, 0x5E, 0x2B, 0x77, 0x04, 
+0x81, 0x78, 0x78, 0x13, 0x80, 0x49, 0x41, 0x00, 
+0x40, 0x70, 0xFC, 0x6C, 0xA2, 0x3C, 0xEC, 0x79, 
+0x22, 0x65, 0xA4, 0xD1, 0xF3, 0x1D, 0x6A, 0x42, 
+0x0D, 0x17, 0x72, 0xDA, 0x35, 0xBF, 0xD2, 0x30, 
+0x8F, 0x29, 0xE9, 0xA8, 0x9B, 0x2B, 0x18, 0xE0, 
+0x0F, 0xCC, 0x0B, 0x1C, 0xAA, 0x3B, 0x89, 0x8A, 
+0xC3, 0x39, 0x13, 0xEE, 0x7C, 0xFE, 0x0B, 0xCC, 
+0x9D, 0xB8, 0x01, 0x3C, 0x9D, 0xCC, 0x8C, 0x1A, 
+0xC9, 0x1C, 0xD1, 0x3C, 0x0C, 0x57, 0xE6, 0x90, 
+0xDF, 0x19, 0x97, 0x7F, 0x32, 0x9A, 0xE2, 0x45, 
+0xDA, 0x7F, 0x97, 0x9E, 0xFD, 0x5B, 0x81, 0x7F, 
+0x82, 0x2E, 0x20, 0xB4, 0x6E, 0x84, 0xB3, 0xBE, 
+0xD9, 0x6C, 0x1F, 0x26, 0xB1, 0xDD, 0xAE, 0x5C, 
+0x40, 0x85, 0x9A, 0x63, 0xA2, 0x0D, 
+
+0x76, 0x4F, 0x48, 0x52, 0xC3, 0x4B, 0xBB, 0x8C, 
+0x84, 0xE5, 0x38, 0x43, 0x90, 0x7C, 0xFE, 0xF2, 
+0x01, 0x25, 0xE6, 0x3A, 0x12, 0x83, 0xC5, 0xC7, 
+0xF4, 0xD6, 0xA2, 0x3F, 0x5B, 0xE6, 0x1A, 0x0B, 
+0x3E, 0x18, 0x51, 0x5C, 0xC1, 0xE9, 0x12, 0xE1, 
+0x23, 0x3B, 0x87, 0xD3, 0xEC, 0x8A, 0x50, 0xE9, 
+0xA6, 0xF9, 0xCE, 0x0D, 0x8B, 0x06, 0x06, 0xEE, 
+0x86, 0x9B, 0xC2, 0xBA, 0x8D, 0x73, 0xCF, 0xFF, 
+0x19, 0x9C, 0x37, 0x00, 0x14, 0x3A, 0x78, 0xBD, 
+0x73, 0x72, 0xB3, 0xA2, 0x29, 0xB3, 0x05, 0x4A, 
+0x0D, 0x82, 0xB8, 0xAE, 0x78, 0x3A, 0xB4, 0xAF, 
+0xAC, 0x64, 0x0C, 0xE1, 0xD1, 0x5A, 0x76, 0x39, 
+0x5F, 0xC4, 0x9C, 0x00, 0xD0, 0x62, 0xFA, 0x28, 
+0xBA, 0x1A, 0x77, 0x0B, 0x7C, 0xA2, 0xC1, 0xFA, 
+0x3A, 0x6B, 0xC7, 0xB8, 0x0C, 0xB0, 0xC1, 0x25, 
+0xA1, 0xC2, 0xE2, 0xBA, 0xB1, 0xA4, 0xE2, 0xDE, 
+0xDF, 0xDE, 0xE6, 0x37, 0xF6, 0x31, 0x29, 0xD7, 
+0x71, 0x4D, 0x63, 0x2B, 0x40, 0xFF, 0x08, 0x46, 
+0xE1, 0xBB, 0x95, 0x67, 0x51, 0xAF, 0xAE, 0x34, 
+0x87, 0xE8, 0xDC, 0x2E, 0x16, 0x20, 0xB8, 
+
+0xB2, 0xE9, 0x5F, 0x5D, 0x44, 0xED, 0x93, 0xF3, 
+0x21, 0x25, 0x1C, 0x07, 0x21, 0xE7, 0x7D, 0xA8, 
+0xCE, 0xF9, 0x59, 0x52, 0x2E, 0xF8, 0x80, 0x2D, 
+0x23, 0x4A, 0x0B, 0xC3, 0x20, 0x3B, 0x85, 0x1A, 
+0xE6, 0xE6, 0x96, 0xFD, 0x63, 0xD7, 0xF0, 0x22, 
+0xFB, 0x81, 0xD2, 0xCD, 0x36, 0xBA, 0x8B, 0x0A, 
+0xA6, 0x4D, 0x69, 0x00, 0xBB, 0xA9, 0x51, 0xD5, 
+0xD1, 0x1D, 0x5E, 0x3E, 0x49, 0x36, 0xF7, 0x11, 
+0x54, 0x4A, 0xC8, 0xC0, 0x5A, 0x82, 0x75, 0x31, 
+0x10, 0x65, 0xEC, 0xFB, 0xF6, 0x2A, 0xFA, 0xEC, 
+0x13, 0x78, 0xEA, 0x82, 0x16, 0x36, 0x86, 0xE0, 
+0xBE, 0x21, 0x3F, 0xD0, 0x8C, 0x03, 0x0C, 0xAF, 
+0x9F, 0x5B, 0xCB, 0x08, 0x23, 0xA2, 0x14, 0xE0, 
+0x9C, 0x15, 0x33, 0x69, 0x98, 0x24, 0x1A, 0x78, 
+0x54, 0x05, 0xA3, 0xE0, 0x67, 0xDF, 0x7F, 0x7A, 
+0xB9, 0x43, 0x0E, 0xD7, 0x3A, 0x67, 0x9E, 0x01, 
+0x9C, 0x02, 0x81, 0x01, 0x2A, 0xE9, 0xB4, 0xB2, 
+0x6B, 0x62, 0xE8, 0x41, 0x17, 0x76, 0x0D, 0x24, 
+0x19, 0x0C, 0xEE, 0x55, 0xEE, 0x24, 0x54, 0x07, 
+0x38, 0x21, 0xBA, 0x80, 0xC3, 0x92, 0x3B, 0x00, 
+
+0x0A, 0xA6, 0xD4, 0x24, 0x9E, 0xC3, 0x1B, 0x09, 
+0xEF, 0x02, 0xC0, 0xCC, 0x81, 0x37, 0x15, 0x70, 
+0xBC, 0x4C, 0xA1, 0x31, 0x10, 0x06, 0x7F, 0xF5, 
+0x7A, 0x22, 0x93, 0xDC, 0xDF, 0x61, 0x8A, 0x2B, 
+0x14, 0xFC, 0xC2, 0x0E, 0xEA, 0x24, 0xAB, 0xA4, 
+0x3B, 0x35, 0xB4, 0xD6, 0x74, 0xB3, 0x6F, 0x86, 
+0xFA, 0x8B, 0xD0, 0x57, 0x4B, 0xAF, 0x34, 0x96, 
+0x17, 0xD0, 0x42, 0x9E, 0xDC, 0xE1, 0x43, 0xC3, 
+0xE7, 0x69, 0xC6, 0x38, 0x50, 0x0C, 0x16, 0xBD, 
+0x1A, 0xE9, 0xD0, 0xBA, 0x88, 0x77, 0xE9, 0xE2, 
+0xCB, 0x08, 0xF8, 0x74, 0xB0, 0x37, 0x26, 0xB8, 
+0x62, 0x01, 0x6F, 0xA1, 0x15, 0xE4, 0x6C, 0x41, 
+0xE7, 0x1F, 0xEE, 0xD7, 0x1A, 0x0C, 0xA7, 0xD3, 
+0x02, 0x3E, 0x29, 0x25, 0xB6, 0x71, 0x6C, 0x65, 
+0x7F, 0x53, 0x59, 0xC4, 0x52, 0x56, 0xF1, 0x9E, 
+0x48, 0xC7, 0xC0, 0x11, 0xB8, 0x08, 0x84, 0x49, 
+0xCC, 0x8E, 0xC8, 0xE2, 0x15, 0xCC, 0xC0, 0x5E, 
+0xDD, 0xD8, 0xB2, 0x47, 0x56, 0x6F, 0xD1, 0xE0, 
+0xB8, 0x73, 0x1D, 0x55, 0x24, 0x65, 0xC6, 0xEE, 
+0x14, 0xDC, 0x94, 0x64, 0xBA, 0xEE, 0xC8, 0x86, 
+0xF1, 
+
+0xA6, 0x49, 0x9B, 0xFB, 0xCB, 0xF6, 0xD7, 0xD4, 
+0x12, 0x54, 0xAE, 0xCF, 0xF1, 0x29, 0x57, 0x68, 
+0x9B, 0xEF, 0x2C, 0x71, 0x6A, 0xF2, 0x80, 0x0E, 
+0x92, 0x34, 0xE6, 0xFA, 0x16, 0x39, 0x1A, 0x99, 
+0x56, 0xBC, 0x5E, 0xA1, 0x5B, 0x7E, 0xD8, 0x9C, 
+0x1B, 0xDD, 0xBD, 0x6E, 0xEB, 0x97, 0x06, 0x20, 
+0xEE, 0xE8, 0x12, 0x6A, 0xD1, 0x93, 0x96, 0xB9, 
+0xB7, 0x62, 0x75, 0x7C, 0xD3, 0xC2, 0x24, 0x64, 
+0x6E, 0x1D, 0x59, 0x7C, 0xC1, 0x6E, 0x9B, 0x4F, 
+0x8D, 0xA7, 0x37, 0x8B, 0x75, 0x22, 0xF3, 0x18, 
+0xA6, 0x84, 0x8B, 0x9B, 0x75, 0x74, 0x51, 0x6A, 
+0x1A, 0xC8, 0xE9, 0x0C, 0x94, 0xD8, 0xEA, 0xEA, 
+0x5A, 0x56, 0xE8, 0x2F, 0x9C, 0x27, 0xA9, 0x1E, 
+0x53, 0x52, 0x35, 0x3A, 0xB4, 0x68, 0xA8, 0x3A, 
+0x31, 0x68, 0x71, 0x88, 0x05, 0x98, 0xFC, 0x06, 
+0x97, 0x68, 0xF1, 0x3B, 0xB5, 0xA1, 0x06, 0x0D, 
+0x32, 0x6D, 0x62, 0xA4, 0x84, 0x64, 0x5D, 0x34, 
+0xA7, 0x2C, 0x16, 0xF5, 0xB6, 0x9C, 0x52, 0x9C, 
+0xF8, 0x6C, 0x1F, 0x6B, 0x7B, 0x8E, 0x39, 0xA6, 
+0x77, 0x39, 0xEE, 0x66, 0x6F, 0xBA, 0xD2, 0x7C, 
+0x2A, 0x42, 
+
+0xF1, 0xF1, 0x6D, 0xFA, 0xFD, 0xD3, 0x74, 0xCC, 
+0x9C, 0xFF, 0xB1, 0x2A, 0x51, 0xEE, 0xF5, 0x82, 
+0xC2, 0xAC, 0xE9, 0x76, 0x14, 0x5F, 0x9C, 0x77, 
+0xF0, 0xA9, 0x3E, 0xE1, 0x02, 0xC7, 0xDA, 0xA9, 
+0x8D, 0xFE, 0x41, 0x67, 0x15, 0x60, 0x00, 0x29, 
+0xB1, 0x9B, 0x63, 0x47, 0x58, 0x83, 0xA6, 0xBF, 
+0xC6, 0x33, 0x5A, 0xC9, 0x50, 0x00, 0x3E, 0x3C, 
+0xF3, 0xB4, 0xC1, 0xD5, 0x21, 0x5A, 0x6D, 0x60, 
+0x86, 0xB7, 0x8E, 0x64, 0x14, 0x0C, 0x30, 0x22, 
+0xF9, 0xC0, 0x08, 0x75, 0xF2, 0xCC, 0x82, 0x2F, 
+0xF6, 0x1A, 0x2F, 0x69, 0x58, 0x0C, 0x8A, 0x60, 
+0x16, 0x54, 0xEC, 0x7D, 0x2E, 0x43, 0xAE, 0x03, 
+0x59, 0x6F, 0xE6, 0xB4, 0x30, 0x3C, 0xC2, 0x80, 
+0x7A, 0x62, 0x03, 0x85, 0x6E, 0xEA, 0xE6, 0xE9, 
+0xEA, 0x7F, 0xE1, 0x66, 0x7B, 0xF9, 0xEA, 0x5E, 
+0xD9, 0x1D, 0x75, 0x6E, 0x77, 0xF2, 0x88, 0xA1, 
+0x8A, 0x5C, 0xC4, 0x51, 0x23, 0x39, 0x68, 0x23, 
+0x46, 0xF1, 0xC1, 0x1C, 0x1E, 0x5B, 0xE0, 0x59, 
+0x01, 0xE2, 0x2C, 0xF7, 0xB9, 0x61, 0x72, 0x78, 
+0x5A, 0x42, 0xAF, 0x7A, 0x3F, 0x67, 0x5C, 0x9B, 
+0x14, 0x43, 0xF8, 
+
+0xB1, 0xAD, 0x85, 0xCC, 0x07, 0x1B, 0x43, 0xA6, 
+0xAD, 0x72, 0xDC, 0xFE, 0x81, 0x6A, 0x04, 0xCD, 
+0xCA, 0x06, 0x30, 0xC3, 0x25, 0xC2, 0x59, 0x0E, 
+0x1A, 0x90, 0xBD, 0x7B, 0x8E, 0x70, 0x71, 0xF7, 
+0x46, 0xD9, 0xF7, 0x3E, 0x97, 0x09, 0x15, 0x7B, 
+0xA2, 0x54, 0xCA, 0x84, 0x71, 0x27, 0x0F, 0x2A, 
+0xF5, 0x1F, 0xF4, 0xF4, 0x5A, 0x21, 0xE7, 0xAF, 
+0x8E, 0x1A, 0xC7, 0x88, 0x49, 0x36, 0xAD, 0x89, 
+0xDA, 0x27, 0x0D, 0x53, 0xA9, 0x48, 0x22, 0xB8, 
+0xE8, 0x9A, 0x32, 0x29, 0x69, 0x12, 0x7C, 0xE3, 
+0xA6, 0x6A, 0xE2, 0xC3, 0x15, 0x81, 0x9F, 0xBE, 
+0x11, 0x8D, 0xA6, 0xF1, 0xA9, 0xA5, 0x1B, 0xA2, 
+0x75, 0x61, 0xC0, 0xC0, 0xA4, 0x48, 0xBC, 0x00, 
+0x08, 0xB1, 0x11, 0xBA, 0x73, 0x11, 0x50, 0xB6, 
+0xD9, 0xAA, 0xC9, 0x45, 0xBA, 0x35, 0xE3, 0x49, 
+0xF9, 0x10, 0x74, 0x1F, 0x83, 0x7D, 0x7E, 0x0F, 
+0x4F, 0x8F, 0x80, 0x71, 0x8D, 0x59, 0x1D, 0x33, 
+0x2A, 0x83, 0x7E, 0x48, 0x6A, 0x2A, 0x91, 0x1C, 
+0x05, 0x93, 0xE2, 0xF1, 0xB1, 0xA6, 0x5E, 0x5E, 
+0x8B, 0x15, 0x42, 0x12, 0x32, 0x4D, 0x4D, 0x60, 
+0x4D, 0x10, 0x2A, 0xF8, 
+
+0x83, 0xE9, 0x3D, 0xF1, 0xDD, 0xF8, 0x66, 0xD8, 
+0xBF, 0x54, 0xF8, 0xB7, 0x28, 0x17, 0x72, 0x66, 
+0x05, 0x3F, 0x9B, 0x2B, 0x36, 0x3D, 0x7A, 0x08, 
+0x98, 0x27, 0x86, 0xD7, 0x8C, 0xD4, 0x47, 0x85, 
+0x02, 0x19, 0x9C, 0x75, 0x47, 0xE1, 0x11, 0x0D, 
+0xAB, 0x4C, 0xDD, 0x04, 0xA9, 0x0B, 0xE5, 0x29, 
+0xC0, 0xB5, 0xC7, 0x58, 0x34, 0x4C, 0x60, 0xE7, 
+0xBE, 0x97, 0x6D, 0x1A, 0xB7, 0x13, 0x28, 0x72, 
+0xFC, 0x74, 0x70, 0xDF, 0x08, 0x34, 0xE2, 0x06, 
+0x68, 0xA9, 0x12, 0x33, 0x3E, 0x28, 0xED, 0x19, 
+0x28, 0xAD, 0xFA, 0x25, 0x7E, 0xE5, 0xC6, 0xA7, 
+0x60, 0x8A, 0x6A, 0xA5, 0x76, 0x2F, 0xC1, 0x3B, 
+0x83, 0x16, 0x2E, 0xAB, 0x63, 0x6E, 0xAA, 0x3C, 
+0x70, 0x6E, 0x29, 0x64, 0x90, 0xD7, 0x3E, 0xA1, 
+0xA6, 0x4D, 0x8C, 0x3C, 0xF0, 0x7B, 0x48, 0x33, 
+0x30, 0xD0, 0x8F, 0x4F, 0x0D, 0x36, 0x60, 0x43, 
+0xD0, 0x69, 0x22, 0xF5, 0x6E, 0x95, 0xBD, 0xED, 
+0xEC, 0x02, 0x2E, 0x31, 0xC7, 0xFA, 0x0C, 0x08, 
+0xDB, 0xBF, 0x15, 0x3F, 0x8F, 0xC8, 0x5B, 0x79, 
+0xB2, 0x6A, 0xCC, 0x15, 0xA7, 0x62, 0x26, 0x26, 
+0xB5, 0x5E, 0x20, 0xA5, 0x28, 
+
+0xA3, 0x9B, 0xB6, 0xE2, 0x34, 0x59, 0x2D, 0xF1, 
+0x58, 0x56, 0xE7, 0x4B, 0xD4, 0x72, 0xBC, 0xBF, 
+0x4C, 0x87, 0x80, 0x78, 0x50, 0xCE, 0x7C, 0x9A, 
+0xF7, 0x6B, 0x09, 0x63, 0x61, 0xDE, 0xAF, 0x2B, 
+0x5B, 0xBC, 0xD8, 0x3E, 0x79, 0x5F, 0x48, 0x33, 
+0x7F, 0xF0, 0x86, 0xB1, 0x1D, 0x4E, 0x9C, 0x40, 
+0x81, 0x7A, 0xCF, 0x81, 0xAC, 0xE6, 0xE6, 0xD0, 
+0x50, 0x30, 0xE7, 0xBD, 0xA6, 0x10, 0x41, 0x9D, 
+0xC7, 0xD6, 0xDF, 0x5C, 0x39, 0x7B, 0x1C, 0x1B, 
+0xA3, 0x8B, 0x33, 0xDD, 0xDD, 0x62, 0xA6, 0x61, 
+0x95, 0x78, 0xBD, 0x5E, 0xE8, 0x14, 0xDA, 0xD3, 
+0xC0, 0xE5, 0x40, 0x05, 0x90, 0x81, 0xA7, 0x1F, 
+0x73, 0xA5, 0xEF, 0x44, 0xFD, 0x42, 0x64, 0x3D, 
+0x61, 0xF7, 0xA0, 0x90, 0x3B, 0xC0, 0x0D, 0xD1, 
+0x55, 0xF2, 0xC0, 0xDC, 0xC0, 0x8C, 0x08, 0x3E, 
+0xFE, 0xB7, 0x6B, 0x69, 0xCC, 0xCF, 0xAC, 0x44, 
+0xA2, 0x74, 0x70, 0x6F, 0xB0, 0xED, 0xF9, 0x01, 
+0x6C, 0xBD, 0x06, 0x36, 0xFB, 0xD7, 0x9C, 0x53, 
+0x10, 0xFC, 0x1F, 0xC6, 0x9A, 0xC8, 0xDE, 0x8C, 
+0xBE, 0x06, 0xB0, 0x86, 0x46, 0xDD, 0x11, 0x6A, 
+0x20, 0x48, 0x97, 0xFA, 0x95, 0x2E, 
+
+0x79, 0x16, 0xFF, 0x6B, 0xFD, 0x6F, 0x65, 0x92, 
+0x35, 0x4E, 0x36, 0x1C, 0x91, 0x78, 0xCC, 0xB3, 
+0x7B, 0x88, 0x6F, 0xE3, 0x47, 0x93, 0x6F, 0xFC, 
+0x78, 0xA0, 0xD9, 0x93, 0x5A, 0x9A, 0x77, 0x52, 
+0x4E, 0x42, 0xD7, 0x2D, 0x58, 0xAD, 0x20, 0x4E, 
+0x64, 0x85, 0xDE, 0x91, 0x9E, 0xC0, 0xE5, 0x08, 
+0xEF, 0x30, 0xA5, 0xB7, 0xBA, 0x4C, 0x89, 0x7D, 
+0x44, 0x82, 0x6C, 0xE6, 0xC0, 0xE7, 0xB3, 0x7D, 
+0x3B, 0x5C, 0x18, 0x1E, 0x72, 0x77, 0x7A, 0x24, 
+0xCB, 0x9A, 0xEA, 0xF1, 0x3D, 0x35, 0x67, 0x32, 
+0x74, 0x99, 0x60, 0x04, 0xB3, 0xA1, 0x6D, 0xA8, 
+0xF8, 0x3C, 0x4D, 0x51, 0xD0, 0x75, 0x09, 0x6F, 
+0xED, 0x94, 0xED, 0x69, 0xA8, 0x0F, 0xF4, 0x24, 
+0x74, 0x6B, 0xA7, 0x17, 0x4E, 0xD5, 0xA3, 0x4A, 
+0x25, 0x8D, 0x46, 0x4D, 0xF3, 0x8F, 0x88, 0x07, 
+0x0E, 0xAB, 0xF9, 0x64, 0xCD, 0xFD, 0x4A, 0x20, 
+0x35, 0x51, 0xE6, 0x89, 0x22, 0xB7, 0x15, 0x80, 
+0x58, 0xA3, 0x0C, 0xDA, 0x60, 0x74, 0x0A, 0xF9, 
+0xCC, 0x4E, 0x95, 0xB3, 0x60, 0xC3, 0x08, 0xB7, 
+0x02, 0x0C, 0xD3, 0x1B, 0xE6, 0x9E, 0xD1, 0x42, 
+0x74, 0x5E, 0xF3, 0x60, 0x24, 0x20, 0x5B, 
+
+0x5A, 0x36, 0xF6, 0x14, 0x17, 0x2A, 0xE3, 0x61, 
+0x07, 0x03, 0x54, 0x0C, 0xA7, 0xBE, 0x50, 0x12, 
+0xF6, 0xA3, 0xA8, 0xFF, 0x4F, 0x74, 0x8C, 0xE1, 
+0x2F, 0x7F, 0xB6, 0x50, 0xF4, 0x9A, 0xDB, 0x58, 
+0x2A, 0xF4, 0xF9, 0x38, 0xE9, 0x32, 0x89, 0x4E, 
+0xFD, 0x8F, 0xD1, 0xA9, 0x96, 0x38, 0x54, 0x17, 
+0x4D, 0x7D, 0xE7, 0x7A, 0x22, 0xAE, 0x0E, 0xF9, 
+0x5B, 0xCE, 0x10, 0x8F, 0x4E, 0x04, 0x93, 0xBA, 
+0x3E, 0xE4, 0xA3, 0xA1, 0x87, 0xA5, 0xDA, 0x8D, 
+0x95, 0x10, 0xAF, 0x5A, 0xB4, 0xD3, 0x06, 0x0F, 
+0x27, 0x31, 0x1F, 0x9A, 0x38, 0x14, 0x30, 0x49, 
+0x66, 0xA8, 0xDA, 0x15, 0x96, 0x2C, 0x21, 0xAA, 
+0x7E, 0xFD, 0xF0, 0x45, 0xE8, 0xDD, 0xEB, 0x95, 
+0xB8, 0xDE, 0x41, 0xD4, 0xF4, 0x8B, 0x9D, 0x91, 
+0x0B, 0xE1, 0x79, 0xF1, 0xBF, 0x6E, 0x97, 0x6C, 
+0xE5, 0xB6, 0x16, 0x24, 0x7D, 0x88, 0xE6, 0xFE, 
+0xCF, 0x70, 0xF2, 0xD2, 0x02, 0x0B, 0x26, 0xCE, 
+0x04, 0x59, 0x15, 0x26, 0x45, 0xDE, 0xF0, 0xEA, 
+0xCC, 0x0A, 0xB2, 0x9C, 0x40, 0x80, 0x06, 0x4C, 
+0x3A, 0x1E, 0xCF, 0xF2, 0xDC, 0x89, 0x40, 0x31, 
+0x75, 0x71, 0x82, 0x89, 0xB1, 0x2E, 0x32, 0xBF, 
+
+0x7F, 0x0E, 0xC0, 0x3E, 0x5A, 0x53, 0xC8, 0x45, 
+0xD7, 0xD4, 0x03, 0x04, 0xB6, 0x3B, 0x8B, 0xE9, 
+0x83, 0x98, 0xD0, 0x64, 0xBB, 0x96, 0xB4, 0x46, 
+0x96, 0x7A, 0x68, 0x5F, 0x81, 0x14, 0x94, 0xE5, 
+0x24, 0x34, 0x9F, 0xFC, 0xC5, 0x93, 0xBA, 0x41, 
+0x0F, 0x80, 0x17, 0x15, 0x84, 0xC8, 0xEA, 0x62, 
+0x36, 0x90, 0x23, 0x4C, 0x24, 0xEF, 0x8E, 0x9F, 
+0xE2, 0xBF, 0xC9, 0xFC, 0xAD, 0xD3, 0x8D, 0xEB, 
+0xEE, 0x6B, 0x37, 0xC6, 0xF2, 0xCA, 0x9A, 0xE8, 
+0xEC, 0xD4, 0xD2, 0xC9, 0x6B, 0x77, 0xD0, 0x40, 
+0x45, 0x62, 0x4A, 0x6F, 0xB3, 0x20, 0xA6, 0xE6, 
+0xA1, 0x7F, 0x03, 0x25, 0xE9, 0x4C, 0xCC, 0x1B, 
+0x2A, 0x00, 0x25, 0x6B, 0x7F, 0x9F, 0x57, 0x6D, 
+0xFB, 0x41, 0x28, 0x07, 0xFA, 0xB8, 0x33, 0x39, 
+0x3F, 0x30, 0x28, 0x88, 0x2F, 0xD3, 0x65, 0xEE, 
+0x70, 0x9D, 0xCC, 0xFE, 0xD1, 0x98, 0x8E, 0x37, 
+0x4B, 0x2E, 0xDD, 0x30, 0x99, 0x25, 0x95, 0x79, 
+0x76, 0x7B, 0xDA, 0xB3, 0x16, 0x95, 0x20, 0x10, 
+0xF3, 0xB8, 0xDF, 0xE9, 0x05, 0xB4, 0xA4, 0x8C, 
+0xC9, 0x60, 0x7B, 0x05, 0x88, 0xAF, 0x1F, 0x87, 
+0x09, 0x80, 0x45, 0x6F, 0x57, 0x66, 0x5A, 0xDE, 
+0x5F, 
+
+0xBD, 0x9C, 0x11, 0xE7, 0x4D, 0xD5, 0x82, 0xA3, 
+0xEB, 0x90, 0x80, 0xE5, 0x45, 0x65, 0x0A, 0xD0, 
+0xBF, 0xB1, 0xBD, 0x7D, 0xA1, 0xA4, 0x5B, 0x4B, 
+0xAB, 0xFA, 0x48, 0x83, 0x67, 0xBB, 0x73, 0xD6, 
+0x83, 0x29, 0xFF, 0xC5, 0x3D, 0x5F, 0x66, 0x29, 
+0x19, 0xC1, 0x36, 0x0C, 0xB4, 0x0A, 0x23, 0x88, 
+0xB1, 0xE7, 0x9F, 0x23, 0x55, 0x85, 0x79, 0x60, 
+0x5D, 0xC0, 0x7C, 0x27, 0x35, 0xAA, 0x97, 0x33, 
+0x02, 0x48, 0xCB, 0xDC, 0x92, 0xAE, 0xCB, 0xC0, 
+0xA4, 0x2B, 0xC6, 0xD8, 0x6E, 0xB9, 0x07, 0xBD, 
+0x1D, 0xB3, 0xBB, 0xED, 0x70, 0x8B, 0x22, 0x5A, 
+0xF6, 0xFA, 0xC1, 0x2F, 0xD4, 0x4D, 0x8E, 0x4F, 
+0x26, 0xAC, 0x65, 0x30, 0x9D, 0x97, 0x77, 0x70, 
+0xB8, 0xED, 0x97, 0x3A, 0xFC, 0x26, 0xB5, 0x99, 
+0x42, 0xE2, 0x73, 0x82, 0x9D, 0xFB, 0x68, 0xB2, 
+0xD7, 0x0E, 0xC7, 0x06, 0x9C, 0x38, 0x05, 0xD8, 
+0xBA, 0x53, 0xD9, 0x73, 0xD1, 0xD9, 0xA2, 0xDB, 
+0x8B, 0xD7, 0x7E, 0x13, 0x98, 0xDB, 0xB3, 0x02, 
+0xBB, 0x30, 0xCE, 0x14, 0x7A, 0xBF, 0x80, 0x89, 
+0x23, 0xD2, 0x3A, 0x47, 0x06, 0x07, 0x51, 0x59, 
+0xF5, 0x81, 0x38, 0xE9, 0xBF, 0x01, 0x77, 0x40, 
+0x6A, 0x59, 
+
+0x1C, 0x7B, 0x45, 0xAC, 0xB2, 0x74, 0x62, 0x99, 
+0x3F, 0x58, 0xD2, 0x48, 0x76, 0xA9, 0x4E, 0x08, 
+0x2B, 0x35, 0x5C, 0xAD, 0x30, 0x62, 0x42, 0x27, 
+0xE1, 0x23, 0x48, 0xDD, 0x97, 0xEA, 0x80, 0x2C, 
+0xD5, 0xE6, 0x33, 0x71, 0x36, 0x85, 0x9A, 0x66, 
+0x0F, 0x6F, 0x65, 0xB4, 0xF8, 0x97, 0xC4, 0x9F, 
+0xB7, 0x99, 0x27, 0x34, 0x30, 0x01, 0x7B, 0x45, 
+0xBB, 0xED, 0xAD, 0x0E, 0x0E, 0xEB, 0xBE, 0xAF, 
+0x38, 0xC5, 0x55, 0x41, 0xBE, 0xCD, 0x95, 0x2A, 
+0xD1, 0x98, 0xCD, 0x8E, 0x7B, 0x28, 0x94, 0x1F, 
+0x52, 0xEE, 0x8C, 0xA6, 0xDB, 0x72, 0x3B, 0x23, 
+0x04, 0x1A, 0x3D, 0xFB, 0x18, 0xC9, 0x22, 0x2E, 
+0x5E, 0xC0, 0xAA, 0x71, 0xC2, 0x72, 0x68, 0x7A, 
+0xA9, 0xE3, 0xEC, 0x71, 0x30, 0x30, 0x26, 0x72, 
+0x79, 0x46, 0x65, 0x29, 0xB5, 0x8E, 0x36, 0x0F, 
+0xC4, 0x00, 0xA1, 0xF2, 0x27, 0x5C, 0x6F, 0xD0, 
+0x74, 0x43, 0x6B, 0x39, 0xA8, 0x3E, 0x5C, 0xAF, 
+0x42, 0x9C, 0xD6, 0x07, 0xD9, 0xDA, 0x50, 0xA5, 
+0x68, 0x7F, 0xE9, 0x12, 0xA3, 0x66, 0x4C, 0x45, 
+0x6D, 0xD4, 0xD5, 0x27, 0xC1, 0x20, 0xBF, 0x14, 
+0x59, 0xED, 0xF1, 0xEA, 0x83, 0xD4, 0xD3, 0x20, 
+0xB4, 0xF3, 0x9C, 
+
+0x90, 0x8B, 0x17, 0xD3, 0x76, 0xF6, 0xBF, 0xE8, 
+0xA6, 0x04, 0xD2, 0xFC, 0xAE, 0x5D, 0xCB, 0x01, 
+0x83, 0xBE, 0x8C, 0xAD, 0x78, 0x9A, 0xF6, 0x44, 
+0xD8, 0x48, 0x74, 0x4C, 0xF8, 0x8E, 0x05, 0x8D, 
+0xD4, 0xC4, 0x5D, 0x28, 0x01, 0xB7, 0x87, 0xB7, 
+0x10, 0xF6, 0xC1, 0x10, 0xB0, 0xD8, 0xC8, 0x95, 
+0x35, 0x8D, 0xDF, 0xD0, 0x7C, 0xAB, 0x30, 0x9F, 
+0x2E, 0x3A, 0x84, 0x81, 0x9F, 0x2E, 0x0D, 0xE2, 
+0x96, 0x60, 0x8C, 0xB4, 0x80, 0x1D, 0xE5, 0x21, 
+0xAB, 0xC5, 0x28, 0x82, 0xD8, 0x99, 0x73, 0x33, 
+0x3D, 0xF8, 0xB9, 0x90, 0x51, 0xD6, 0x7D, 0x25, 
+0x89, 0xA1, 0x97, 0x6A, 0xCA, 0x04, 0xC2, 0x71, 
+0x3C, 0x1D, 0xDB, 0xA9, 0x35, 0x4F, 0xEE, 0x2A, 
+0x0C, 0x2F, 0x77, 0x7F, 0x65, 0xC1, 0x5E, 0xB4, 
+0x69, 0x27, 0x22, 0xAB, 0x2C, 0x08, 0x3A, 0x9C, 
+0x06, 0xE0, 0x51, 0xA1, 0x8E, 0x4F, 0x77, 0xA3, 
+0x81, 0x8E, 0xA3, 0x1E, 0x45, 0xD1, 0x86, 0x08, 
+0xFC, 0x46, 0x56, 0x92, 0xE5, 0xC0, 0xF4, 0x4E, 
+0x32, 0xA4, 0xEE, 0xB1, 0x4E, 0xC4, 0x8F, 0x4E, 
+0x74, 0xED, 0x3E, 0x41, 0x1F, 0x33, 0xEF, 0xB1, 
+0xC0, 0xCA, 0x21, 0x45, 0xB6, 0x65, 0xC5, 0x55, 
+0xE9, 0x14, 0x1C, 0x8B, 
+
+0x77, 0x53, 0xAB, 0x34, 0x24, 0xE7, 0x7B, 0xBF, 
+0x78, 0x8D, 0xF3, 0x56, 0xF3, 0x33, 0x21, 0xB6, 
+0x38, 0xA6, 0x0C, 0x82, 0x9A, 0x08, 0xD7, 0x75, 
+0xE5, 0x87, 0x1B, 0x4F, 0x0B, 0x67, 0xD0, 0x95, 
+0x8F, 0xE0, 0x6E, 0x87, 0x22, 0x59, 0xB5, 0x11, 
+0x10, 0xFE, 0xD2, 0xF5, 0xCF, 0xCD, 0x6E, 0xCC, 
+0x5C, 0x04, 0xA5, 0x60, 0x2E, 0x6F, 0xF0, 0x74, 
+0x21, 0x2C, 0xDF, 0x05, 0xE0, 0x23, 0x08, 0x97, 
+0xFC, 0xCC, 0x67, 0x00, 0x77, 0xF0, 0x35, 0x75, 
+0xF0, 0xED, 0xDA, 0xA7, 0xF9, 0x5D, 0x02, 0x62, 
+0x83, 0x10, 0x83, 0x81, 0x02, 0xB0, 0x58, 0x85, 
+0x97, 0x91, 0xC3, 0x05, 0x1F, 0xED, 0xDA, 0x69, 
+0x07, 0x6A, 0x07, 0xCE, 0xAB, 0x68, 0x97, 0x9D, 
+0x0B, 0xBE, 0xA2, 0x16, 0x6E, 0xAB, 0x1B, 0x92, 
+0x8E, 0x75, 0xBF, 0x0F, 0x35, 0xB4, 0x41, 0xCC, 
+0xA1, 0x69, 0x1F, 0xBB, 0x19, 0x40, 0x5D, 0x67, 
+0xC6, 0x6D, 0x89, 0xEA, 0x08, 0x13, 0x64, 0x21, 
+0x1F, 0xCD, 0x13, 0x8D, 0x3D, 0x9A, 0x45, 0xB3, 
+0x4C, 0x11, 0x26, 0xE3, 0xCD, 0x1E, 0xE7, 0xC8, 
+0xA4, 0x2F, 0x2D, 0x0D, 0x3A, 0x62, 0x33, 0x26, 
+0xF4, 0x3A, 0x0D, 0x43, 0xCC, 0x0A, 0x5F, 0x30, 
+0x83, 0xEE, 0xB1, 0xD4, 0x9D, 
+
+0x41, 0x6D, 0xD1, 0xAC, 0xF4, 0x9B, 0x40, 0xC0, 
+0xAF, 0x44, 0xF5, 0x23, 0xD2, 0x6B, 0xCF, 0x7D, 
+0x47, 0x73, 0xD7, 0x3D, 0xF4, 0xAC, 0x6E, 0x4D, 
+0x5C, 0x10, 0x44, 0xC1, 0x6D, 0x0C, 0x92, 0x9B, 
+0x57, 0x80, 0x24, 0x91, 0xBF, 0x99, 0x78, 0xBF, 
+0xFC, 0x47, 0x64, 0x9C, 0x79, 0xF9, 0x2A, 0x48, 
+0xC2, 0x20, 0x62, 0x2B, 0x97, 0xF0, 0xA6, 0xB1, 
+0x5F, 0xAD, 0xDE, 0xBF, 0x38, 0x83, 0x18, 0x71, 
+0xE8, 0xD3, 0xB2, 0x00, 0x8C, 0xC0, 0x34, 0x22, 
+0x8F, 0xE0, 0x52, 0x58, 0x92, 0x77, 0x64, 0x23, 
+0x8A, 0xEC, 0x8C, 0x0E, 0x30, 0x63, 0xC1, 0x9C, 
+0x90, 0xFA, 0xE7, 0xAD, 0xA6, 0x8C, 0x6C, 0xB0, 
+0x76, 0x8C, 0xD8, 0x76, 0x61, 0x99, 0xD4, 0x86, 
+0x27, 0xC5, 0x77, 0x90, 0x9B, 0xF9, 0x2E, 0x86, 
+0x6D, 0x17, 0x16, 0x9C, 0x78, 0x46, 0xD3, 0x8C, 
+0x0B, 0x4B, 0x35, 0xA5, 0xF6, 0x1B, 0x64, 0x00, 
+0x53, 0x7B, 0xCB, 0x0A, 0xC4, 0x18, 0x8D, 0x01, 
+0x49, 0x65, 0x60, 0xDF, 0x1A, 0x01, 0xAC, 0x5A, 
+0xE9, 0x4D, 0xA4, 0xFC, 0x84, 0x0E, 0x97, 0x7E, 
+0x6D, 0x1D, 0xE2, 0xA6, 0x33, 0xAA, 0xA8, 0xE4, 
+0x0E, 0xA8, 0x00, 0xFE, 0x8B, 0x9F, 0x88, 0xD2, 
+0xBF, 0x44, 0xFA, 0xAC, 0x7E, 0xF8, 
+
+0x61, 0xAE, 0x98, 0x1E, 0x98, 0x8D, 0x5E, 0x72, 
+0x4B, 0x69, 0x37, 0xA7, 0xB6, 0x07, 0xBF, 0xB5, 
+0x58, 0xB8, 0xF6, 0x42, 0xA8, 0xB6, 0x64, 0xBA, 
+0xA6, 0xB9, 0x69, 0x0F, 0xE8, 0xA8, 0x74, 0x37, 
+0x6C, 0x9F, 0xA2, 0x15, 0xA8, 0xEA, 0xB0, 0xC0, 
+0xA7, 0x42, 0x70, 0xD3, 0xE3, 0x1A, 0xD8, 0x42, 
+0x58, 0x09, 0x6E, 0xE2, 0xA1, 0x2D, 0x48, 0xCE, 
+0xC8, 0x7B, 0x7C, 0x1F, 0x8D, 0x6E, 0x7E, 0x56, 
+0x0F, 0xA2, 0x0E, 0x9D, 0x54, 0x51, 0xFE, 0xB5, 
+0x12, 0x67, 0x75, 0xD7, 0x5F, 0xD9, 0x36, 0x36, 
+0xB9, 0x58, 0x76, 0x26, 0x65, 0xD7, 0x8A, 0x31, 
+0x8A, 0x1A, 0x85, 0x50, 0xDF, 0xA0, 0xAC, 0xCF, 
+0xBA, 0x52, 0x11, 0xD3, 0x67, 0x74, 0x64, 0x22, 
+0xDD, 0x96, 0x0A, 0xF3, 0x20, 0xF2, 0x63, 0xE7, 
+0x2A, 0xC1, 0x43, 0xC3, 0x8C, 0xB4, 0x40, 0x62, 
+0x29, 0xA0, 0xD9, 0xD8, 0xAE, 0x4F, 0x44, 0xF2, 
+0xE1, 0x96, 0x24, 0x96, 0xE8, 0xA1, 0x74, 0x0E, 
+0xED, 0xF1, 0x3B, 0x77, 0x61, 0xEE, 0xC0, 0x0C, 
+0x8A, 0x18, 0x39, 0xD9, 0x64, 0xF2, 0x47, 0x0D, 
+0x33, 0xA5, 0xF5, 0x6E, 0x97, 0x2A, 0x41, 0xD6, 
+0xC4, 0x89, 0x40, 0x1B, 0xE2, 0x7E, 0x81, 0x45, 
+0xC6, 0x9A, 0x40, 0xAE, 0xE3, 0xE1, 0xC6, 
+
+0x4D, 0x01, 0xE5, 0x8E, 0xDA, 0xCB, 0xD6, 0x54, 
+0xE4, 0xE4, 0x36, 0xAA, 0xAE, 0x62, 0x9C, 0xC4, 
+0x4E, 0x71, 0x00, 0xEF, 0x17, 0xBA, 0xB1, 0xDC, 
+0xDB, 0x84, 0xE5, 0xBB, 0xEF, 0x1F, 0xAA, 0xD9, 
+0x65, 0x32, 0xE5, 0x21, 0xE1, 0xEB, 0xF1, 0x71, 
+0xD7, 0x93, 0x09, 0x6B, 0x45, 0xF2, 0x71, 0x62, 
+0x14, 0xE5, 0xEF, 0x1E, 0xBC, 0x7A, 0x12, 0x68, 
+0xCA, 0xCB, 0xDC, 0x34, 0xC4, 0xFE, 0x69, 0xC1, 
+0x89, 0x3A, 0x44, 0x3C, 0xDD, 0x7E, 0x29, 0x12, 
+0x38, 0xDD, 0x6D, 0x7E, 0xA4, 0xAE, 0x3D, 0xB4, 
+0xDB, 0xCE, 0x59, 0x24, 0x0C, 0x19, 0x12, 0x92, 
+0x0E, 0x98, 0x23, 0x89, 0xDF, 0x48, 0x1A, 0x03, 
+0xBA, 0x14, 0x10, 0x49, 0x25, 0xF2, 0x56, 0x1C, 
+0x84, 0x40, 0x01, 0x1D, 0xD1, 0x83, 0x25, 0xE4, 
+0x73, 0xF3, 0x0A, 0x73, 0xCC, 0x7A, 0x31, 0x89, 
+0x0B, 0x07, 0x6B, 0xE9, 0x38, 0x93, 0xAD, 0x0C, 
+0xCC, 0xF2, 0xEA, 0xD2, 0x44, 0xE9, 0x0D, 0x0D, 
+0x89, 0x70, 0x64, 0x2B, 0x72, 0x35, 0xAA, 0xF2, 
+0xE5, 0x68, 0x58, 0x3A, 0x23, 0x67, 0xDA, 0xAC, 
+0x6D, 0x4D, 0x73, 0x95, 0x4F, 0xDF, 0xB1, 0xDB, 
+0xD3, 0xA8, 0x3A, 0xA1, 0xF5, 0xAF, 0xE9, 0xBB, 
+0x99, 0x4A, 0x9A, 0xEF, 0xB0, 0xAC, 0x39, 0xD9, 
+
+0x8E, 0x0D, 0xFB, 0x18, 0x53, 0x2C, 0x19, 0xF4, 
+0x76, 0x9D, 0xFA, 0x16, 0xAD, 0xFE, 0xC8, 0x22, 
+0xDD, 0x01, 0xC6, 0x4D, 0x32, 0xB5, 0xFA, 0xDB, 
+0xCA, 0xDD, 0x71, 0xAB, 0x0D, 0x40, 0x31, 0xE0, 
+0xF5, 0x75, 0x62, 0x37, 0x9D, 0x94, 0x3B, 0xA1, 
+0x39, 0x6B, 0x88, 0x23, 0x92, 0x7A, 0xCD, 0xCE, 
+0xB5, 0x2F, 0xBA, 0x6D, 0xB2, 0x75, 0x3A, 0x8D, 
+0xEE, 0x60, 0x7C, 0x1E, 0xF0, 0xED, 0xA8, 0x96, 
+0x51, 0xE2, 0x49, 0xD6, 0xFA, 0xE5, 0xE5, 0xA4, 
+0xD2, 0x81, 0x95, 0x48, 0xBD, 0x4B, 0x0C, 0x3D, 
+0xC6, 0x08, 0x66, 0x94, 0x34, 0x77, 0xF2, 0x88, 
+0x88, 0xE3, 0x6D, 0xE1, 0x61, 0x75, 0xCA, 0x30, 
+0xF9, 0xE7, 0x41, 0x60, 0xD9, 0x6C, 0xE9, 0x65, 
+0xA2, 0x37, 0x02, 0x0F, 0xA8, 0x4C, 0x8A, 0x42, 
+0x42, 0x57, 0x94, 0x2D, 0xD8, 0x6B, 0xF5, 0x9C, 
+0x36, 0xE2, 0x2B, 0x00, 0x85, 0x3B, 0x3A, 0x43, 
+0x6E, 0xBF, 0xD9, 0x8A, 0xCD, 0x41, 0x9C, 0x19, 
+0x9F, 0xEB, 0x78, 0x0C, 0x0F, 0x16, 0x57, 0xC2, 
+0x1D, 0x47, 0x37, 0x95, 0x23, 0xA4, 0xE7, 0xF1, 
+0x0A, 0x4A, 0x8A, 0x29, 0x11, 0xB4, 0x5A, 0x7F, 
+0xF2, 0xA1, 0xBE, 0xEF, 0x71, 0xB3, 0xD8, 0xFB, 
+0x90, 0x28, 0x83, 0x43, 0x09, 0xAD, 0x5F, 0x44, 
+0xE8, 
+
+0x1A, 0xF5, 0x1C, 0x6E, 0xF5, 0x84, 0xFB, 0xA7, 
+0x06, 0x60, 0xA6, 0xF6, 0x05, 0x74, 0x73, 0xD7, 
+0x3E, 0x4A, 0x16, 0x7A, 0x38, 0xCB, 0x38, 0xAE, 
+0x46, 0x77, 0x68, 0xCD, 0xAC, 0xF9, 0x7C, 0x1A, 
+0x26, 0x6E, 0xFD, 0x1F, 0x6F, 0x1D, 0xD2, 0xDD, 
+0x1F, 0xEE, 0x1D, 0x30, 0x9F, 0x10, 0x88, 0x12, 
+0xC5, 0x6C, 0x9B, 0x60, 0xFC, 0xB7, 0x10, 0xCA, 
+0xAC, 0x50, 0x8B, 0xA5, 0x4D, 0x19, 0x36, 0x80, 
+0x01, 0x43, 0xC6, 0xB4, 0x46, 0xA7, 0x19, 0x59, 
+0xBC, 0xC0, 0x79, 0x37, 0xCA, 0x13, 0xCC, 0x6E, 
+0xBD, 0x5A, 0xE4, 0xE2, 0xEE, 0x93, 0xF4, 0xE0, 
+0xFF, 0xAE, 0x39, 0x05, 0x0A, 0x84, 0x33, 0x53, 
+0x36, 0x29, 0xA2, 0x80, 0x7E, 0x82, 0xF0, 0xF3, 
+0xB6, 0x27, 0x84, 0x47, 0x74, 0x7C, 0x25, 0xC7, 
+0x88, 0xEA, 0x53, 0x76, 0xC3, 0x1D, 0xFB, 0x47, 
+0x40, 0x90, 0x8E, 0xFD, 0x46, 0x80, 0xBB, 0x44, 
+0x25, 0x71, 0xA4, 0x5E, 0xDE, 0x20, 0xF4, 0x98, 
+0x5E, 0x6C, 0xB4, 0x3C, 0xEB, 0x88, 0x54, 0x54, 
+0x4D, 0x6A, 0xB2, 0x2A, 0x61, 0xE7, 0xC6, 0x5E, 
+0x5E, 0x07, 0x84, 0x28, 0xC7, 0x65, 0xE7, 0x71, 
+0x40, 0x0D, 0xA4, 0x72, 0xF7, 0xCB, 0x74, 0x02, 
+0xC7, 0x26, 0x34, 0x91, 0xFC, 0x1D, 0xCD, 0xE0, 
+0x8C, 0x78, 
+
+0x15, 0xD2, 0x96, 0x25, 0xB1, 0xAA, 0xB5, 0x2F, 
+0xBE, 0x1A, 0x9A, 0xD2, 0xC5, 0x15, 0x2D, 0x5A, 
+0x1B, 0x26, 0x9B, 0xE0, 0xCB, 0xE5, 0xDC, 0x6B, 
+0x92, 0x2C, 0x3A, 0x3D, 0x96, 0x4A, 0x25, 0x83, 
+0x3C, 0x6C, 0xA0, 0x18, 0xF6, 0x71, 0xA8, 0x30, 
+0x4D, 0xEB, 0x4C, 0x2D, 0x32, 0x9A, 0x88, 0x20, 
+0xD6, 0x4B, 0x15, 0xC4, 0xA6, 0xF4, 0x25, 0x49, 
+0x82, 0x20, 0x9A, 0x57, 0x60, 0x47, 0xC6, 0xCF, 
+0xB7, 0x3C, 0x85, 0xDA, 0x61, 0x27, 0x86, 0xAB, 
+0x72, 0x3A, 0x63, 0x92, 0xE0, 0xFB, 0xB1, 0x5F, 
+0x40, 0x67, 0x9A, 0x08, 0xBE, 0xC9, 0xEF, 0x44, 
+0x1D, 0x6D, 0x71, 0xD6, 0x99, 0x2D, 0x44, 0xB8, 
+0x4B, 0x47, 0x0C, 0xAB, 0x1C, 0xAA, 0x3B, 0x5F, 
+0x99, 0x87, 0xD1, 0x82, 0x72, 0xEE, 0x34, 0xE1, 
+0x3A, 0x91, 0x0C, 0x6D, 0x5A, 0x64, 0x18, 0xDD, 
+0xE1, 0x12, 0x40, 0x01, 0x16, 0x5D, 0x23, 0x32, 
+0x1B, 0x0F, 0x22, 0xFC, 0x62, 0x8F, 0x06, 0xCA, 
+0x52, 0x51, 0x5A, 0xED, 0x24, 0xEB, 0x9C, 0xA1, 
+0x0D, 0x79, 0xE8, 0x95, 0xC7, 0x75, 0x4C, 0xCC, 
+0x52, 0xE0, 0x4E, 0xFC, 0x91, 0xE3, 0x1F, 0x15, 
+0x56, 0xE0, 0xA8, 0x0A, 0x6C, 0x9F, 0x75, 0xE6, 
+0xAB, 0xBC, 0x09, 0xC8, 0x1C, 0x8E, 0xFA, 0xBF, 
+0x9D, 0x15, 0x9E, 
+
+0x3D, 0x69, 0xD6, 0x53, 0x09, 0xC5, 0xE4, 0xB3, 
+0x43, 0x25, 0x93, 0xC1, 0x38, 0x1A, 0x45, 0x2D, 
+0x27, 0xAB, 0xA4, 0x68, 0xCB, 0xAE, 0x4C, 0x1C, 
+0xCC, 0xF0, 0x22, 0x27, 0x3D, 0xA6, 0x29, 0x53, 
+0x52, 0xF6, 0xDD, 0x76, 0xC3, 0x56, 0x71, 0x12, 
+0x4B, 0xE2, 0x06, 0xD6, 0x7C, 0x25, 0x98, 0x22, 
+0xF0, 0x2F, 0x09, 0x37, 0x05, 0x49, 0xED, 0x1C, 
+0xB1, 0x5E, 0x7E, 0x25, 0xC3, 0x6D, 0xAB, 0x34, 
+0x67, 0x97, 0x3C, 0xE3, 0x60, 0xE9, 0x39, 0xFA, 
+0x68, 0xEE, 0xC1, 0x3B, 0x20, 0x65, 0xF4, 0xC1, 
+0x35, 0x5C, 0x85, 0x5B, 0x47, 0x36, 0x12, 0xE3, 
+0x7E, 0x40, 0x01, 0xD0, 0x31, 0x87, 0xD4, 0x3E, 
+0x02, 0x3E, 0x09, 0x08, 0x16, 0xAC, 0xE0, 0xD4, 
+0x79, 0xB3, 0x25, 0xD7, 0x5A, 0x17, 0x29, 0xA5, 
+0x25, 0x4A, 0x09, 0x1D, 0x92, 0x0A, 0x72, 0xA2, 
+0x46, 0x6F, 0xAE, 0x83, 0xEC, 0x75, 0x72, 0xCB, 
+0xD2, 0xA1, 0xE9, 0xC4, 0x57, 0x20, 0xF2, 0xF8, 
+0x88, 0xA0, 0x51, 0xFE, 0x24, 0x66, 0x5A, 0xA3, 
+0xF1, 0xF9, 0xA0, 0x3E, 0x91, 0x0A, 0xB2, 0xF8, 
+0x25, 0xF0, 0x76, 0x68, 0xDA, 0x0E, 0xC4, 0xEC, 
+0xE2, 0x80, 0xC4, 0xF6, 0x78, 0xA3, 0x0F, 0x51, 
+0xA5, 0xB9, 0xEE, 0x4D, 0xB8, 0x37, 0xD0, 0xC1, 
+0x37, 0x8E, 0xC7, 0x66, 
+
+0x3F, 0xF7, 0x59, 0x83, 0x7F, 0xA8, 0x28, 0x4B, 
+0xB6, 0x8C, 0xF1, 0x98, 0x4C, 0x27, 0x77, 0x31, 
+0xA3, 0x12, 0x9E, 0xDD, 0xED, 0x19, 0x81, 0xEF, 
+0x1F, 0xA1, 0xF5, 0x9C, 0xCC, 0xAA, 0x4A, 0x62, 
+0xB4, 0x62, 0xCF, 0x97, 0xF5, 0x80, 0x9A, 0x79, 
+0x90, 0x65, 0x14, 0xE5, 0x41, 0x11, 0x9A, 0xFB, 
+0x03, 0xD0, 0x4E, 0x5F, 0xE1, 0x9D, 0x55, 0x74, 
+0xA4, 0x35, 0xAE, 0xB6, 0x86, 0xC1, 0x83, 0xC9, 
+0xD4, 0xF6, 0xB2, 0x75, 0xE9, 0xAD, 0x01, 0xAC, 
+0x1D, 0x72, 0x33, 0xF4, 0xE3, 0x8B, 0x3E, 0x26, 
+0xC3, 0xA6, 0x99, 0x54, 0xE5, 0x6E, 0x62, 0x57, 
+0x76, 0xC8, 0x7D, 0x17, 0x21, 0x69, 0xDF, 0xCC, 
+0x62, 0xE9, 0x47, 0x2B, 0xBC, 0xA5, 0xDF, 0x5F, 
+0x00, 0xDE, 0x7E, 0x6E, 0x76, 0xA7, 0xBC, 0x0D, 
+0x47, 0x1E, 0x8A, 0x12, 0x70, 0x8E, 0x9D, 0x85, 
+0x94, 0x5D, 0x8E, 0x95, 0xE0, 0x84, 0x16, 0xB0, 
+0x36, 0x9A, 0x32, 0x5A, 0xA6, 0xC5, 0x3D, 0xF0, 
+0x3A, 0x44, 0xA4, 0x22, 0x20, 0x89, 0x0F, 0x25, 
+0x0F, 0x0A, 0x22, 0xF5, 0x71, 0x98, 0xD3, 0xFA, 
+0x88, 0xF2, 0x6E, 0x8C, 0xA0, 0xA3, 0x74, 0x7B, 
+0x71, 0x8A, 0x35, 0xC2, 0xA3, 0x0F, 0x36, 0xB0, 
+0x84, 0xFA, 0xBE, 0x34, 0xB0, 0x77, 0x6F, 0x98, 
+0xCE, 0xF4, 0x17, 0x63, 0xB3, 
+
+0x95, 0xE4, 0x00, 0x45, 0x03, 0x37, 0xE8, 0x3E, 
+0x95, 0xBB, 0x22, 0x6F, 0xB1, 0x3F, 0xF7, 0x27, 
+0xAD, 0x47, 0x74, 0x67, 0x13, 0x63, 0x41, 0xD9, 
+0x22, 0xBE, 0xD8, 0xEA, 0x45, 0xEF, 0x4C, 0x4A, 
+0x39, 0x51, 0x8A, 0x70, 0x31, 0x68, 0x55, 0x10, 
+0x00, 0x42, 0x04, 0xB2, 0xF8, 0xA6, 0xFB, 0x13, 
+0x64, 0x22, 0x85, 0x35, 0x1F, 0xB2, 0x3A, 0x86, 
+0x86, 0x29, 0x95, 0x77, 0xAC, 0x9D, 0xD6, 0xE1, 
+0x81, 0xE9, 0x68, 0x7C, 0x7E, 0xE5, 0xA5, 0xB2, 
+0x8A, 0x46, 0xE6, 0xD6, 0xB8, 0x1E, 0x47, 0x79, 
+0x30, 0x47, 0xF9, 0xE7, 0x59, 0x29, 0xF0, 0x64, 
+0xB9, 0x30, 0xA6, 0x37, 0x33, 0xE9, 0x56, 0xF8, 
+0xFC, 0x27, 0x6A, 0x55, 0xA7, 0xD1, 0xB1, 0x82, 
+0x92, 0x32, 0xBE, 0x1E, 0xDA, 0xDE, 0x27, 0x5D, 
+0x92, 0xD5, 0x0D, 0x49, 0x40, 0x35, 0xA9, 0xA1, 
+0x3E, 0x99, 0x4C, 0xB8, 0x09, 0x3A, 0x3F, 0x2B, 
+0x17, 0xB3, 0xC1, 0xDC, 0x50, 0x5A, 0x71, 0x65, 
+0x91, 0xCD, 0x20, 0x46, 0x59, 0x17, 0xF9, 0x05, 
+0x11, 0xDC, 0xB5, 0x0F, 0x3C, 0x7B, 0x4E, 0x7A, 
+0x6B, 0x92, 0x30, 0x7B, 0xA4, 0x53, 0x99, 0x0B, 
+0xEB, 0x14, 0xC0, 0xA0, 0x3C, 0xFB, 0x27, 0xD6, 
+0x9A, 0x96, 0xF2, 0x67, 0xD8, 0xEF, 0xEB, 0xFD, 
+0x1D, 0xF5, 0x88, 0x6C, 0x24, 0xFC, 
+
+0xBF, 0x8D, 0xB5, 0x47, 0xAB, 0x7E, 0x16, 0x98, 
+0xB5, 0x09, 0xD5, 0x11, 0xE1, 0x96, 0x1C, 0xFB, 
+0xC0, 0x06, 0xF0, 0x7E, 0x40, 0xB4, 0x0A, 0x9F, 
+0x17, 0x9C, 0xA8, 0x66, 0x0D, 0xF1, 0xA8, 0x5C, 
+0xF8, 0x2A, 0x91, 0xB0, 0x76, 0x49, 0xC1, 0x43, 
+0x3F, 0x91, 0x26, 0xDE, 0x51, 0xA1, 0xFD, 0x0A, 
+0x5D, 0x07, 0x70, 0x69, 0x6F, 0xA6, 0x07, 0xD3, 
+0x42, 0x44, 0xBE, 0x91, 0xE6, 0x76, 0xDC, 0x1A, 
+0x9B, 0xC7, 0x08, 0x44, 0x30, 0xCC, 0x11, 0xE6, 
+0xFF, 0xD6, 0x38, 0xB1, 0x7F, 0xD8, 0xC7, 0xCB, 
+0xBF, 0x4A, 0x1A, 0xA1, 0x31, 0x32, 0x37, 0xBD, 
+0xCC, 0x90, 0x4A, 0x3E, 0x2C, 0x8E, 0x3E, 0x09, 
+0xBB, 0x7D, 0x6E, 0x56, 0xCF, 0x28, 0x1D, 0x49, 
+0xA7, 0x98, 0x86, 0x28, 0x28, 0x56, 0x97, 0x79, 
+0x93, 0x77, 0x05, 0xE7, 0x78, 0x60, 0x02, 0xA0, 
+0x48, 0x00, 0x50, 0xB3, 0x1C, 0x05, 0x11, 0x91, 
+0x07, 0xBC, 0xF3, 0x8F, 0x87, 0xC6, 0x9D, 0x57, 
+0xAA, 0x33, 0xA1, 0x5B, 0x1E, 0xFD, 0x6D, 0x06, 
+0x8E, 0xF6, 0x94, 0xBE, 0xD4, 0x5F, 0x5C, 0xF3, 
+0x87, 0xE0, 0xC6, 0x50, 0x78, 0x97, 0xED, 0xA0, 
+0x48, 0x25, 0xAF, 0x1D, 0x9C, 0xB3, 0x0A, 0x99, 
+0xF9, 0x6D, 0x01, 0xA0, 0xE7, 0xFC, 0x8F, 0x0A, 
+0x17, 0xCB, 0xEF, 0xC1, 0x2C, 0x12, 0x6F, 
+
+0xC0, 0x40, 0xF6, 0xC7, 0xAF, 0xA9, 0xC3, 0xD9, 
+0x08, 0xF9, 0xA5, 0x68, 0x89, 0x25, 0x1E, 0xF3, 
+0xE6, 0x09, 0x77, 0x16, 0xF4, 0x0F, 0x6C, 0x16, 
+0x2A, 0x34, 0xD4, 0x3B, 0x3D, 0xFC, 0x10, 0xA3, 
+0xD5, 0x86, 0xEF, 0x7E, 0x79, 0x16, 0xA1, 0x8D, 
+0x7B, 0x35, 0xEA, 0x03, 0x4E, 0x18, 0x0E, 0x75, 
+0x17, 0xDE, 0x40, 0x03, 0x96, 0x7D, 0x5C, 0x39, 
+0x6D, 0x2A, 0x21, 0x80, 0x68, 0x4B, 0x44, 0xCD, 
+0xCE, 0x2F, 0xD7, 0xD5, 0x8E, 0xAF, 0x2E, 0x61, 
+0x9B, 0x37, 0x58, 0x9D, 0x80, 0xD5, 0x17, 0x75, 
+0x9E, 0x9E, 0xC8, 0xFB, 0xE5, 0x08, 0x41, 0x3D, 
+0x69, 0xC2, 0x59, 0xDC, 0xF1, 0xE9, 0x9E, 0x00, 
+0x44, 0xA3, 0x91, 0x0A, 0xDE, 0xFF, 0x09, 0xBB, 
+0xF2, 0x20, 0xD3, 0x11, 0x5B, 0xA1, 0xFB, 0x74, 
+0x0C, 0xF4, 0x46, 0x5D, 0xE3, 0x2C, 0x1F, 0xA2, 
+0x3F, 0xD7, 0x61, 0x3D, 0x0E, 0x5E, 0xF9, 0x7D, 
+0x8D, 0xDB, 0x8E, 0xD8, 0xE3, 0xFF, 0x4F, 0x7F, 
+0x98, 0x54, 0xDC, 0xB4, 0xE3, 0xE3, 0x4F, 0x0E, 
+0x7B, 0x02, 0x78, 0x5C, 0x99, 0xB7, 0x80, 0x61, 
+0xC9, 0xC5, 0xE0, 0x97, 0x9F, 0x35, 0xC6, 0x69, 
+0xB6, 0xDB, 0xD3, 0x6F, 0x54, 0xF6, 0x23, 0xD1, 
+0x43, 0x75, 0x87, 0x9D, 0x7C, 0x60, 0x49, 0x28, 
+0x1F, 0x7B, 0xFF, 0x5A, 0xAB, 0x2C, 0x72, 0xD6, 
+
+0x5E, 0x4A, 0x1B, 0x62, 0x3B, 0xEC, 0xE3, 0xF7, 
+0xD1, 0xEE, 0xA3, 0x73, 0x91, 0x02, 0xE7, 0x83, 
+0x87, 0x15, 0x94, 0x20, 0x34, 0x8E, 0x43, 0xE4, 
+0x1E, 0xFF, 0x98, 0x00, 0x44, 0xFC, 0x35, 0xCD, 
+0x93, 0x95, 0xCC, 0xCA, 0xCE, 0xAC, 0x9A, 0xCB, 
+0xBF, 0xDF, 0xEA, 0xB7, 0x09, 0x49, 0x0E, 0x20, 
+0xEC, 0x94, 0x73, 0xE9, 0xB9, 0xD8, 0xD2, 0x54, 
+0x6A, 0x93, 0xB9, 0x98, 0xBC, 0xA8, 0xED, 0x28, 
+0x89, 0x86, 0xBF, 0xFE, 0x27, 0xB9, 0xB9, 0xFF, 
+0xE5, 0x66, 0x6C, 0x01, 0x1A, 0x45, 0xE9, 0xA1, 
+0x27, 0xF8, 0x32, 0xD0, 0xBE, 0x7C, 0xD8, 0xC3, 
+0x59, 0x6C, 0xF1, 0xA8, 0xEE, 0x1F, 0x47, 0x9D, 
+0xCD, 0xC3, 0x89, 0x85, 0xE7, 0x08, 0xAD, 0x25, 
+0x5D, 0x2C, 0x65, 0x27, 0xA9, 0x76, 0x39, 0x57, 
+0xA8, 0x13, 0x8F, 0xF6, 0xF0, 0xB4, 0x10, 0x96, 
+0xFF, 0x7D, 0x33, 0xA3, 0x39, 0x24, 0x7D, 0x7B, 
+0xDE, 0x19, 0x44, 0xCB, 0xC6, 0x68, 0x61, 0x71, 
+0xFD, 0x03, 0x35, 0xF8, 0x5B, 0x16, 0x1B, 0x5E, 
+0x4A, 0xB0, 0x30, 0x60, 0xD3, 0xBF, 0x6F, 0x18, 
+0xFC, 0x2B, 0x94, 0x7B, 0x34, 0x55, 0xED, 0xD4, 
+0xC0, 0x84, 0x40, 0x87, 0x85, 0xA4, 0xB8, 0x5A, 
+0x69, 0x2F, 0x88, 0xCE, 0x39, 0x82, 0x68, 0x13, 
+0x73, 0xA7, 0xF5, 0xFF, 0x3F, 0x61, 0xD8, 0xAA, 
+0x16, 
+
+0x28, 0xFD, 0x43, 0xF9, 0xDD, 0x04, 0x44, 0x1C, 
+0x84, 0xE6, 0x05, 0x3F, 0x99, 0x98, 0x06, 0xBB, 
+0x60, 0x4A, 0xBF, 0xD7, 0xD9, 0xDD, 0xBB, 0x67, 
+0xC8, 0x50, 0x0A, 0xB5, 0x46, 0x53, 0xE0, 0x95, 
+0xF2, 0x32, 0x71, 0x69, 0x5E, 0x3C, 0x48, 0xFE, 
+0xD3, 0xC6, 0xEA, 0xE6, 0x79, 0xC7, 0xC6, 0xE1, 
+0xDF, 0x00, 0x7B, 0x6D, 0x9F, 0x81, 0x8E, 0x47, 
+0xFF, 0x53, 0x3B, 0xF5, 0x5F, 0x55, 0xAC, 0x9F, 
+0xF2, 0x0A, 0x5E, 0x5C, 0xF9, 0x5F, 0x57, 0xBA, 
+0x7E, 0x3B, 0x5A, 0x2F, 0x37, 0x84, 0x55, 0x2E, 
+0x5B, 0x64, 0x1C, 0x3D, 0x27, 0x5E, 0x28, 0xF8, 
+0x10, 0xC8, 0xDD, 0x6D, 0x33, 0xCD, 0x9D, 0xA0, 
+0x4D, 0x68, 0x19, 0x38, 0x32, 0x5D, 0x21, 0x97, 
+0x70, 0x24, 0xD9, 0x17, 0x31, 0xB9, 0xF0, 0x9F, 
+0xC5, 0x36, 0x25, 0x69, 0xA5, 0xA5, 0x9F, 0x36, 
+0x41, 0x27, 0x00, 0x40, 0x05, 0x03, 0xE7, 0x32, 
+0x66, 0x79, 0x1A, 0xDB, 0xCF, 0xFC, 0x47, 0xDB, 
+0xEB, 0x15, 0x27, 0xB7, 0x3F, 0xF1, 0x18, 0x36, 
+0xFF, 0xDB, 0xB6, 0x89, 0xE3, 0xF6, 0xF0, 0xF5, 
+0x5A, 0xFF, 0x50, 0x06, 0x7B, 0x50, 0x76, 0x72, 
+0x3F, 0x22, 0xAA, 0xEA, 0x8C, 0xB2, 0xD5, 0x7C, 
+0xE8, 0x2C, 0x78, 0xAE, 0x78, 0xB5, 0xEB, 0x4F, 
+0x5F, 0x60, 0x0E, 0xED, 0x5B, 0x1B, 0x77, 0x72, 
+0x7F, 0x5A, 
+
+0x5B, 0xCE, 0x97, 0xCA, 0x30, 0x69, 0x6C, 0x76, 
+0xEE, 0xDC, 0x1C, 0xCC, 0xF6, 0x27, 0x7C, 0x84, 
+0x71, 0x78, 0xBC, 0x8F, 0x12, 0xAD, 0x30, 0xE0, 
+0x7B, 0x28, 0x02, 0x21, 0x24, 0x6A, 0xFF, 0xEA, 
+0x2E, 0x14, 0xEC, 0xD2, 0x6A, 0xA5, 0xE7, 0x6C, 
+0x2C, 0x5C, 0x08, 0xFB, 0xC8, 0x5B, 0xA8, 0x16, 
+0xE8, 0x08, 0xD4, 0xC3, 0x61, 0x56, 0x47, 0x17, 
+0x23, 0xE8, 0x39, 0x29, 0x3C, 0xA8, 0x56, 0xA4, 
+0xA5, 0xE2, 0xCE, 0x1C, 0xA8, 0x38, 0x54, 0x39, 
+0x31, 0x89, 0xA0, 0x9C, 0x7A, 0x68, 0x69, 0xCC, 
+0x35, 0x94, 0x1E, 0x9E, 0x4B, 0x03, 0xF6, 0x17, 
+0xFD, 0xB0, 0x44, 0x24, 0x6D, 0x76, 0x8B, 0x80, 
+0xEC, 0x08, 0xC4, 0x45, 0xF2, 0x01, 0x65, 0x10, 
+0x1A, 0x41, 0xAF, 0x68, 0x55, 0x2E, 0x7C, 0xB3, 
+0x94, 0x4C, 0xF3, 0x73, 0xD5, 0xB6, 0xAD, 0x97, 
+0x6F, 0x4E, 0x0A, 0xDF, 0x2B, 0x86, 0x19, 0x33, 
+0xA0, 0xDE, 0xA0, 0xE5, 0x70, 0x9A, 0x80, 0xDA, 
+0x52, 0x8F, 0x0D, 0x6F, 0x81, 0x2D, 0x0E, 0x6F, 
+0xA6, 0xB2, 0x7E, 0x14, 0xE3, 0x06, 0x7E, 0xF7, 
+0x5B, 0xF3, 0xD5, 0xFF, 0x67, 0xA6, 0xC5, 0xD2, 
+0x40, 0xE9, 0x73, 0x5D, 0x4F, 0x50, 0xB0, 0x7C, 
+0xD5, 0x9E, 0x01, 0x05, 0x99, 0xEA, 0x49, 0x46, 
+0xAF, 0xF5, 0x2F, 0xCE, 0xD2, 0x5F, 0x45, 0x68, 
+0xD8, 0x68, 0xF7, 
+
+0x07, 0x77, 0x43, 0x01, 0x34, 0x02, 0x09, 0xC2, 
+0x47, 0x78, 0x4B, 0x45, 0x10, 0xB2, 0x12, 0xF9, 
+0x5E, 0xBD, 0x32, 0xF4, 0x66, 0xC5, 0x81, 0xF8, 
+0x90, 0x11, 0xC6, 0xAC, 0x95, 0x53, 0x74, 0xBC, 
+0xE3, 0x44, 0x28, 0x90, 0x72, 0x19, 0x86, 0xF8, 
+0x84, 0x68, 0x8C, 0x97, 0xBF, 0x5B, 0x3E, 0x06, 
+0xA1, 0x70, 0x28, 0x5A, 0x0E, 0xE4, 0xF5, 0xFB, 
+0x7C, 0x77, 0x0B, 0x00, 0x81, 0xD8, 0xDD, 0x38, 
+0x10, 0xBB, 0x0D, 0xB0, 0xDA, 0x2B, 0x60, 0xA0, 
+0xD7, 0x51, 0xEB, 0x9C, 0xFE, 0x9A, 0x88, 0x48, 
+0x2D, 0x7D, 0x8F, 0x7C, 0xF9, 0xE5, 0xBC, 0xCE, 
+0x9B, 0xB5, 0x6C, 0x2F, 0x09, 0x1A, 0x61, 0xBD, 
+0x66, 0xF7, 0x07, 0x1F, 0xC8, 0x48, 0xB3, 0xF8, 
+0x6A, 0x43, 0xB7, 0x3F, 0x58, 0x2B, 0xDC, 0xE8, 
+0x0F, 0x83, 0xEC, 0xA5, 0xF8, 0x7E, 0x9A, 0xDD, 
+0x25, 0xEA, 0x33, 0x02, 0x47, 0x14, 0xF5, 0xA2, 
+0x77, 0xE0, 0xBE, 0x9A, 0xC3, 0x91, 0x08, 0x76, 
+0xC4, 0x75, 0x33, 0xAE, 0xD4, 0x28, 0xA0, 0x12, 
+0xDD, 0xA4, 0xDE, 0xC8, 0xB4, 0x2A, 0x6D, 0x96, 
+0x3A, 0x11, 0xBB, 0x4F, 0xA0, 0x91, 0x88, 0x78, 
+0x4B, 0xF9, 0x2A, 0x43, 0x90, 0xAE, 0x7D, 0xF8, 
+0x8E, 0x09, 0x3B, 0x83, 0x30, 0xB2, 0x39, 0x45, 
+0x86, 0x7F, 0x21, 0x53, 0xC7, 0xB2, 0x4F, 0xB2, 
+0x19, 0xE5, 0xD5, 0x8F, 
+
+0x8E, 0x38, 0xD1, 0x2A, 0xAC, 0x6D, 0xCB, 0x3C, 
+0x9F, 0xF2, 0x00, 0x9E, 0x4A, 0x18, 0xA0, 0xDA, 
+0x9D, 0x20, 0xF1, 0xC5, 0xA0, 0x65, 0x08, 0x7E, 
+0x06, 0x4B, 0x6D, 0xED, 0xFB, 0x76, 0x8A, 0x0F, 
+0x55, 0xFE, 0xA9, 0x99, 0x39, 0xCA, 0x56, 0xC4, 
+0x65, 0xFE, 0xA6, 0x87, 0x27, 0x96, 0x27, 0xFB, 
+0x66, 0x22, 0xF0, 0xA1, 0xC9, 0xA4, 0xB6, 0x60, 
+0x9B, 0x65, 0x7C, 0xF3, 0x1B, 0x1B, 0xB7, 0x5A, 
+0xB0, 0x80, 0x0D, 0x2E, 0xAE, 0xC8, 0x10, 0x13, 
+0x0B, 0xBF, 0x15, 0x1F, 0x05, 0xD2, 0x42, 0x90, 
+0xE4, 0x36, 0x9B, 0x93, 0xF6, 0x39, 0x06, 0xB6, 
+0xCE, 0x9A, 0xA3, 0xC0, 0xF3, 0x22, 0x59, 0x07, 
+0x73, 0x04, 0x2D, 0x0C, 0x15, 0xA5, 0x53, 0x13, 
+0xFB, 0x29, 0xE9, 0x96, 0x5F, 0x1B, 0x8A, 0xED, 
+0x98, 0x7B, 0x4E, 0x6D, 0x7F, 0x81, 0x13, 0x0B, 
+0xD3, 0xEC, 0x3A, 0xE1, 0x97, 0xBD, 0x5A, 0x90, 
+0x14, 0xED, 0x49, 0x09, 0x10, 0x38, 0xB7, 0x9C, 
+0x2F, 0xFA, 0x43, 0x02, 0xB2, 0x98, 0x53, 0x96, 
+0x10, 0xE1, 0x21, 0xBC, 0x15, 0x96, 0x3E, 0x34, 
+0x23, 0xE2, 0x98, 0x51, 0xFC, 0x3F, 0x4C, 0x59, 
+0x22, 0x1A, 0xD8, 0xEA, 0x00, 0x31, 0xFC, 0x7E, 
+0xAB, 0x87, 0x96, 0xC1, 0xC3, 0x07, 0xA8, 0x79, 
+0xC2, 0xB9, 0xC1, 0x3D, 0x66, 0xBA, 0x1D, 0xF3, 
+0x3D, 0x42, 0xC2, 0x90, 0x6D, 
+
+0x22, 0x9A, 0x86, 0x27, 0x43, 0xED, 0xB0, 0xD1, 
+0x5B, 0x8A, 0xF0, 0xF0, 0xF7, 0xAD, 0x64, 0xBD, 
+0xF6, 0x57, 0x1B, 0x5B, 0xB8, 0x90, 0x7F, 0x84, 
+0xA2, 0x8A, 0xFE, 0x6F, 0x1B, 0xF3, 0x3F, 0x7D, 
+0x13, 0x75, 0xDD, 0x89, 0xAC, 0xA8, 0x1E, 0x44, 
+0x76, 0x70, 0x04, 0x51, 0x37, 0xE1, 0xF7, 0x07, 
+0xC7, 0x05, 0x47, 0xEC, 0xBD, 0x11, 0x9F, 0x9B, 
+0x16, 0x8B, 0xE3, 0x11, 0xB7, 0xE5, 0x7B, 0x68, 
+0x76, 0x54, 0xF1, 0x6B, 0xFE, 0xC5, 0x46, 0x56, 
+0xEF, 0xED, 0x2C, 0xA9, 0x25, 0x98, 0xEA, 0x85, 
+0x50, 0x8D, 0x10, 0xD5, 0x47, 0x93, 0xE4, 0xE1, 
+0x68, 0xAA, 0x0A, 0x7D, 0x76, 0x24, 0xFF, 0xF2, 
+0xD1, 0x3C, 0xBA, 0xD4, 0x36, 0x79, 0xD9, 0x2E, 
+0xC4, 0x74, 0xEA, 0xB6, 0x3F, 0x6D, 0xAD, 0x21, 
+0x14, 0x77, 0xC9, 0x97, 0xA1, 0x89, 0x63, 0xC0, 
+0xBA, 0xFD, 0x7A, 0x7C, 0x17, 0xD8, 0x42, 0x1C, 
+0xB7, 0x57, 0x19, 0xBC, 0xFC, 0x72, 0xD0, 0x1C, 
+0xF3, 0xA6, 0x12, 0x53, 0x38, 0x0D, 0x69, 0x7E, 
+0xE3, 0x04, 0x1F, 0xC1, 0x87, 0xF5, 0x3B, 0x6B, 
+0x33, 0xB2, 0x73, 0x2C, 0x46, 0xEA, 0xC5, 0x89, 
+0x5F, 0x3C, 0x0D, 0x6A, 0x64, 0x46, 0x40, 0x91, 
+0x0B, 0x67, 0xE5, 0xA1, 0x8A, 0x1D, 0xD7, 0x3C, 
+0x21, 0x8A, 0xA9, 0x9A, 0xA1, 0x88, 0xE4, 0x36, 
+0x75, 0x7A, 0x29, 0xA6, 0xDB, 0x0C, 
+
+0x27, 0x0B, 0x50, 0xC8, 0x81, 0xA0, 0xA4, 0xF6, 
+0x57, 0xA5, 0x67, 0xD6, 0x8A, 0x5D, 0x26, 0x3E, 
+0x5B, 0xD5, 0xE9, 0x78, 0xCB, 0xA6, 0xC4, 0x22, 
+0xDE, 0x76, 0xA4, 0x8C, 0x49, 0x61, 0x72, 0xD0, 
+0xF5, 0xF7, 0x2C, 0x3E, 0xC5, 0x57, 0xA8, 0x4C, 
+0xAD, 0x14, 0xE6, 0x77, 0x33, 0xFF, 0xC8, 0x61, 
+0x74, 0x13, 0xE6, 0xD9, 0xEB, 0x86, 0x9C, 0x32, 
+0xB4, 0xD1, 0x0E, 0xFE, 0x05, 0x4E, 0x0D, 0x6A, 
+0x17, 0x86, 0x88, 0x11, 0xA3, 0xE5, 0xBD, 0xCF, 
+0x4C, 0xCA, 0x60, 0x07, 0x48, 0x45, 0x7E, 0x39, 
+0x40, 0x34, 0x84, 0xAA, 0x8F, 0x90, 0xCB, 0x4A, 
+0x36, 0xFF, 0x49, 0x8F, 0xFD, 0x91, 0x77, 0xDC, 
+0x69, 0xAF, 0xD6, 0x5B, 0x40, 0x0F, 0x8A, 0x40, 
+0xA9, 0x80, 0xF1, 0xB6, 0x13, 0x8D, 0xA0, 0xB1, 
+0x06, 0x89, 0x7C, 0xA3, 0xBA, 0x43, 0x35, 0x8C, 
+0x81, 0xD4, 0xC2, 0x98, 0x9B, 0x99, 0x0F, 0xAE, 
+0x1D, 0x67, 0xB4, 0x41, 0x5A, 0xA3, 0x7D, 0xCD, 
+0xC8, 0xE8, 0xE8, 0x68, 0xC9, 0xCA, 0x70, 0xE0, 
+0x86, 0x19, 0x5F, 0x92, 0xE6, 0xB1, 0x2B, 0xC9, 
+0x06, 0x58, 0x21, 0xFF, 0x7F, 0x18, 0xFB, 0x90, 
+0xDF, 0x58, 0xEA, 0x17, 0x44, 0x2B, 0x46, 0x47, 
+0x02, 0xD8, 0x39, 0x5C, 0xEA, 0x77, 0x5B, 0x78, 
+0x0C, 0x76, 0xEE, 0x4D, 0x59, 0xF2, 0x40, 0xA5, 
+0xE6, 0x47, 0xEF, 0xF6, 0x0A, 0xEA, 0xAA, 
+
+0x74, 0xF4, 0x81, 0x66, 0xB6, 0xEA, 0x7C, 0x27, 
+0xF2, 0x3A, 0xA2, 0x4B, 0x7B, 0x5A, 0x18, 0x1D, 
+0x34, 0x3F, 0xE0, 0x9D, 0x75, 0xDF, 0x74, 0x05, 
+0x37, 0x14, 0xE8, 0x3D, 0x4E, 0x6B, 0x05, 0xEE, 
+0xE9, 0x2F, 0x98, 0x46, 0x6D, 0x55, 0x3D, 0x57, 
+0x90, 0xC9, 0x55, 0x83, 0x84, 0x64, 0xC5, 0xDE, 
+0x4F, 0x32, 0x37, 0x87, 0x42, 0x20, 0xDD, 0x8B, 
+0x92, 0xA2, 0x4F, 0xC4, 0x7B, 0x95, 0xA9, 0x47, 
+0x5B, 0x81, 0x6E, 0x3A, 0x33, 0x7E, 0x26, 0xF8, 
+0x54, 0x33, 0x18, 0xA8, 0x21, 0xBA, 0x4D, 0xD7, 
+0xB6, 0xD4, 0xB8, 0xBA, 0xC2, 0x75, 0x5D, 0x1A, 
+0xDD, 0xFE, 0x62, 0x62, 0x42, 0x46, 0x58, 0x0B, 
+0x06, 0xF1, 0xA2, 0x2F, 0x70, 0x60, 0xDA, 0xD4, 
+0x45, 0xED, 0xC2, 0xE3, 0x2D, 0x42, 0x33, 0xF7, 
+0x68, 0x59, 0x96, 0x5B, 0x3F, 0xA8, 0x5F, 0x06, 
+0xF9, 0x69, 0x69, 0x8E, 0x82, 0x25, 0xDA, 0xE1, 
+0x90, 0xBF, 0x35, 0x79, 0x5F, 0x29, 0xD7, 0x92, 
+0xAF, 0x6B, 0x6C, 0xE5, 0xAA, 0xF4, 0x88, 0xC3, 
+0x32, 0x5A, 0x12, 0x1F, 0x31, 0x95, 0x1F, 0x4C, 
+0x95, 0x0C, 0x5D, 0x8D, 0x41, 0x99, 0x18, 0x90, 
+0x33, 0x8D, 0x68, 0x87, 0x63, 0x2E, 0x99, 0x6C, 
+0x0D, 0x50, 0xCD, 0xFC, 0x9C, 0xDF, 0xBF, 0xB9, 
+0x81, 0xB7, 0xCD, 0xA0, 0x0E, 0x7E, 0x35, 0xFD, 
+0xB1, 0x89, 0xEA, 0xDD, 0x7B, 0xC2, 0xBB, 0x3C, 
+
+0x6D, 0xEE, 0xC6, 0x3F, 0x32, 0x2E, 0xD2, 0xA4, 
+0x39, 0x51, 0x2C, 0x24, 0xF0, 0x99, 0x04, 0xCE, 
+0xFE, 0xFC, 0x47, 0x87, 0x74, 0x34, 0x12, 0x05, 
+0x3E, 0x5B, 0xF7, 0x80, 0xFC, 0xC6, 0xE8, 0x99, 
+0xC0, 0x9C, 0x5B, 0x43, 0xCC, 0xEC, 0xA3, 0x15, 
+0xE7, 0x62, 0x35, 0xC4, 0x5A, 0xD6, 0x0F, 0x3C, 
+0x45, 0xE7, 0x22, 0xC3, 0x23, 0x97, 0x5C, 0x5D, 
+0x85, 0x31, 0x6C, 0xB2, 0xF8, 0x5A, 0xD3, 0x6F, 
+0xCA, 0x66, 0xFD, 0x5A, 0xD2, 0x9A, 0x9E, 0xE1, 
+0x82, 0x65, 0x11, 0x44, 0x0C, 0x14, 0xA9, 0x23, 
+0x6B, 0x77, 0x08, 0xF5, 0xCB, 0x02, 0x8A, 0xF1, 
+0xD1, 0x88, 0xF1, 0x92, 0xBF, 0xF2, 0x22, 0x61, 
+0x55, 0x42, 0x8B, 0xAA, 0x5C, 0xBA, 0xE7, 0x9A, 
+0x1C, 0x86, 0x3F, 0xEF, 0x40, 0x61, 0x03, 0xC0, 
+0x78, 0xBE, 0x7C, 0xCC, 0x69, 0x7B, 0x12, 0x69, 
+0x08, 0x4F, 0x72, 0x54, 0x82, 0xAF, 0x8F, 0x1E, 
+0x5A, 0x5F, 0x26, 0x64, 0x56, 0xA0, 0x48, 0x22, 
+0x75, 0x45, 0xB2, 0x11, 0x3C, 0x87, 0x0E, 0x32, 
+0x6D, 0xB7, 0x7C, 0x7C, 0x44, 0x8E, 0x68, 0x48, 
+0xD5, 0x87, 0xFB, 0x90, 0x95, 0x60, 0x43, 0xC1, 
+0x70, 0xA3, 0xA5, 0x99, 0x2F, 0x5A, 0xCD, 0x0C, 
+0x27, 0x79, 0xAE, 0x4F, 0x5E, 0x68, 0xBE, 0x8C, 
+0x8A, 0x88, 0xB8, 0xF3, 0xCE, 0x2A, 0xE0, 0x01, 
+0xF9, 0x4B, 0x9F, 0x32, 0x7E, 0x0B, 0x56, 0xC6, 
+0xA6, 
+
+0x86, 0x7E, 0x59, 0x32, 0x85, 0x7A, 0xB6, 0x9B, 
+0x1F, 0x67, 0x41, 0xF7, 0x2C, 0xD2, 0x9B, 0xEE, 
+0x80, 0x72, 0x41, 0xF1, 0xCE, 0x70, 0x28, 0x47, 
+0x30, 0xA9, 0xFA, 0xAC, 0x7C, 0x1A, 0xEC, 0x8F, 
+0x96, 0x0E, 0xC4, 0x32, 0x1A, 0x31, 0x63, 0x81, 
+0xCF, 0xD0, 0x5F, 0xA7, 0x23, 0x6F, 0x2F, 0xF9, 
+0x9D, 0xBD, 0xBB, 0x82, 0xD6, 0x65, 0x28, 0xF5, 
+0x7E, 0xAB, 0xF8, 0x62, 0x2B, 0x93, 0xA7, 0xBD, 
+0x57, 0xA5, 0x0F, 0x3A, 0x10, 0x80, 0xEE, 0x9A, 
+0xC8, 0xC2, 0xE3, 0xF4, 0x55, 0x1D, 0xE0, 0x06, 
+0xDD, 0xE1, 0xB0, 0xB7, 0x2D, 0xA0, 0x6F, 0x92, 
+0x6A, 0x6D, 0xED, 0xAA, 0x5B, 0x5D, 0xEE, 0xE4, 
+0xC9, 0x7E, 0x9D, 0x74, 0x6A, 0xCD, 0xC5, 0x0B, 
+0xD3, 0x62, 0xBB, 0xEF, 0x79, 0xDC, 0xDD, 0xF8, 
+0xA5, 0x0E, 0xFA, 0x5F, 0xDD, 0x6A, 0x9B, 0x7D, 
+0xF5, 0x78, 0xEC, 0xC8, 0x7E, 0xB7, 0x6E, 0xB6, 
+0xA5, 0x53, 0xD9, 0xE0, 0x27, 0x3F, 0x1A, 0x5C, 
+0x48, 0x7E, 0x61, 0xF5, 0x36, 0x86, 0x6C, 0x7D, 
+0x4B, 0x22, 0xB5, 0xE8, 0x9A, 0x71, 0x7D, 0x80, 
+0x36, 0xEB, 0xD3, 0x18, 0x10, 0xD5, 0xB5, 0x23, 
+0xAA, 0x6D, 0x6E, 0xBB, 0x95, 0x27, 0xCF, 0x45, 
+0xF1, 0xA8, 0xA3, 0x79, 0x24, 0x64, 0x7C, 0xD7, 
+0x86, 0x70, 0x42, 0xB1, 0x50, 0xC7, 0x11, 0xE6, 
+0x81, 0xC7, 0x3E, 0x2E, 0x79, 0xA2, 0xA4, 0xBE, 
+0xC6, 0xE8, 
+
+0x1F, 0x5A, 0xD8, 0x9E, 0xA8, 0x7A, 0xBD, 0x9D, 
+0xBC, 0x94, 0xCA, 0x35, 0xF2, 0x75, 0xE7, 0x3A, 
+0xF8, 0xC0, 0xDD, 0x0C, 0xB6, 0xE7, 0x3E, 0xEA, 
+0x9F, 0x58, 0xAD, 0x6D, 0xCA, 0x3A, 0x29, 0xCB, 
+0x03, 0xA5, 0x1D, 0xEA, 0x05, 0xC7, 0xD9, 0x9E, 
+0xC1, 0xC2, 0xC9, 0x0E, 0xE0, 0x82, 0xE7, 0xEE, 
+0xDF, 0x7A, 0x54, 0xAC, 0x2F, 0x08, 0x13, 0x85, 
+0x56, 0x4A, 0x62, 0x07, 0x01, 0xCA, 0x9B, 0x1C, 
+0xE4, 0x84, 0xB3, 0x1C, 0x64, 0xFE, 0x85, 0x08, 
+0xE2, 0x7D, 0x2D, 0x13, 0x41, 0x90, 0x41, 0x17, 
+0xFC, 0x6D, 0xAD, 0x10, 0xE9, 0x79, 0xB4, 0x0D, 
+0x34, 0xC4, 0x9C, 0xD5, 0x3D, 0xC1, 0x3A, 0x55, 
+0xB5, 0xF5, 0xA3, 0xBB, 0x9F, 0x28, 0x27, 0x47, 
+0x0E, 0xFF, 0x78, 0xD7, 0xAA, 0xA9, 0x3A, 0x2D, 
+0x32, 0xD1, 0x04, 0x36, 0x21, 0xBC, 0x8E, 0x7B, 
+0x09, 0xE0, 0x1D, 0x12, 0x78, 0xC6, 0xA3, 0x41, 
+0x5E, 0xFD, 0x5A, 0x85, 0x9F, 0xD2, 0xE0, 0xBA, 
+0x66, 0x1B, 0xC8, 0x96, 0xE3, 0xD3, 0x98, 0xA7, 
+0x73, 0xD0, 0x21, 0x30, 0x77, 0x9C, 0xFE, 0x6F, 
+0xCD, 0x7C, 0x1E, 0x6D, 0xD3, 0x80, 0x0D, 0x45, 
+0x0B, 0x14, 0x32, 0xF3, 0x39, 0x34, 0xD2, 0xA4, 
+0x88, 0x5C, 0xEE, 0x12, 0x95, 0xAD, 0x78, 0x0B, 
+0x49, 0x84, 0x43, 0x70, 0x9F, 0x4E, 0x96, 0x2C, 
+0x35, 0x19, 0x4F, 0x4A, 0xB9, 0xA3, 0x33, 0x17, 
+0xBF, 0x59, 0x64, 
+
+0xBD, 0x63, 0x28, 0x46, 0x39, 0x86, 0xBD, 0x04, 
+0x28, 0x43, 0x25, 0x34, 0xEB, 0x07, 0x38, 0x25, 
+0x8A, 0x8C, 0x76, 0x07, 0xB8, 0x9C, 0x2E, 0x17, 
+0x91, 0x13, 0xFD, 0x7E, 0x43, 0xEF, 0x11, 0x85, 
+0x60, 0xD0, 0x93, 0xAD, 0x2E, 0xE4, 0xA0, 0x75, 
+0xA6, 0xE7, 0xD1, 0x15, 0x40, 0x9E, 0x35, 0xAA, 
+0x14, 0x98, 0x22, 0xBF, 0x7B, 0x54, 0x8E, 0x24, 
+0x39, 0x77, 0x1D, 0x95, 0xFE, 0xCF, 0x17, 0x79, 
+0xA0, 0x2B, 0x28, 0xA5, 0x02, 0x7E, 0x9A, 0x50, 
+0xD0, 0x7A, 0x90, 0x0F, 0x57, 0x64, 0x52, 0x64, 
+0x57, 0xBE, 0x18, 0x22, 0x1C, 0x48, 0xE6, 0x47, 
+0x23, 0x8C, 0x86, 0x39, 0x84, 0xBA, 0x06, 0xB0, 
+0xEB, 0xAF, 0xC9, 0x2D, 0xC7, 0xA9, 0xE3, 0xB5, 
+0x00, 0x01, 0x6E, 0xB0, 0x8F, 0x9E, 0xEF, 0xC5, 
+0x8F, 0x93, 0x05, 0xF9, 0x28, 0x24, 0xE9, 0x9C, 
+0x53, 0xFA, 0xE7, 0xF5, 0x50, 0x00, 0x51, 0x39, 
+0x98, 0x77, 0xDC, 0xDA, 0xA4, 0x85, 0xBC, 0xCB, 
+0x66, 0x9C, 0xE0, 0xDD, 0x7E, 0xF3, 0x6F, 0xF4, 
+0x58, 0x62, 0x97, 0x32, 0xEA, 0xA0, 0xF5, 0x83, 
+0xE4, 0xA5, 0xAD, 0xCB, 0xF8, 0xFB, 0x24, 0x93, 
+0x17, 0x52, 0xB4, 0xB5, 0xCF, 0xC8, 0x4B, 0x31, 
+0x33, 0xD9, 0x70, 0xC6, 0x89, 0x37, 0x49, 0x09, 
+0x2B, 0x27, 0x18, 0x1E, 0x84, 0xCC, 0x72, 0xC9, 
+0x51, 0xBF, 0xF5, 0x8E, 0x63, 0x18, 0x64, 0x46, 
+0xFF, 0x1E, 0xBA, 0x2C, 
+
+0x47, 0x33, 0x88, 0x48, 0xCA, 0x1F, 0x7F, 0x33, 
+0xE2, 0x61, 0xE2, 0x10, 0xB5, 0x4C, 0x88, 0x2E, 
+0xA6, 0x2E, 0x35, 0xB9, 0x95, 0x61, 0x90, 0xB3, 
+0x34, 0xE5, 0xE7, 0xF9, 0x0A, 0xCF, 0x51, 0xC5, 
+0x8F, 0xC4, 0xD5, 0xA1, 0x12, 0xC5, 0xE4, 0x3D, 
+0x7D, 0xB6, 0x88, 0x9E, 0x65, 0x6A, 0x87, 0x4A, 
+0x0C, 0x87, 0xEA, 0xBF, 0x21, 0x81, 0x4E, 0x4F, 
+0x63, 0x52, 0x69, 0xB1, 0xCF, 0x39, 0xBE, 0x51, 
+0xE5, 0x63, 0xB2, 0x7F, 0x57, 0x41, 0x74, 0x79, 
+0x16, 0x7D, 0x3F, 0xB5, 0xCC, 0x5E, 0x0D, 0x37, 
+0xC2, 0xB1, 0x5E, 0xA2, 0xD8, 0x07, 0xDC, 0x59, 
+0xFA, 0xF3, 0x8C, 0xA9, 0xE8, 0xCB, 0x6E, 0x87, 
+0x7A, 0x94, 0xF8, 0xE2, 0x16, 0x7E, 0xB4, 0x81, 
+0x5F, 0x81, 0x08, 0x70, 0x21, 0x43, 0x6E, 0xF0, 
+0x19, 0xF6, 0x4E, 0x40, 0x8D, 0xAB, 0xD9, 0xDE, 
+0xEA, 0xA1, 0x03, 0xA1, 0x87, 0x97, 0x9F, 0xDF, 
+0x9B, 0x34, 0x3B, 0x48, 0xCE, 0x25, 0xD3, 0x92, 
+0x35, 0x9B, 0xAB, 0xF9, 0x5F, 0xF3, 0xC7, 0x28, 
+0xA4, 0x03, 0x76, 0x27, 0x52, 0xDF, 0x26, 0x0E, 
+0xBC, 0xD9, 0x9E, 0x79, 0xA7, 0xFC, 0x50, 0x55, 
+0xBE, 0x8D, 0xB7, 0x57, 0x6A, 0x3E, 0xD7, 0x1A, 
+0x7A, 0xC5, 0x21, 0x4B, 0x7C, 0x21, 0x14, 0x56, 
+0xBB, 0xCB, 0x1B, 0x51, 0xC4, 0xD4, 0x80, 0xAD, 
+0xA2, 0x9D, 0x2B, 0x9F, 0xB7, 0x41, 0x10, 0x5E, 
+0xDB, 0xFB, 0xDD, 0xAA, 0x69, 
+
+0x9E, 0x7B, 0x37, 0xCC, 0xE9, 0x41, 0xEA, 0xD4, 
+0xC9, 0xAA, 0x82, 0xF9, 0xC9, 0xB7, 0x2C, 0xCF, 
+0x62, 0xB0, 0x23, 0x3A, 0x73, 0x5F, 0xF3, 0x49, 
+0xBD, 0x23, 0xB1, 0x6C, 0xB2, 0x6E, 0xAA, 0xA4, 
+0x47, 0x4A, 0xA5, 0xC6, 0x74, 0xE2, 0x6A, 0x5B, 
+0x7A, 0xB6, 0x4A, 0x84, 0xB6, 0x42, 0xF0, 0x22, 
+0x2F, 0x6A, 0x61, 0x71, 0xC2, 0x0E, 0x40, 0x42, 
+0xE9, 0xF2, 0xD8, 0x48, 0x63, 0x8A, 0x6E, 0x43, 
+0xF2, 0xE4, 0x53, 0xAD, 0xEE, 0x82, 0x0F, 0xC1, 
+0xCB, 0xE4, 0x7E, 0xE1, 0x16, 0x34, 0x19, 0xC8, 
+0xCE, 0x04, 0xA6, 0x29, 0xA5, 0x7D, 0x49, 0xC3, 
+0x95, 0x93, 0x8B, 0xB2, 0x9D, 0xA2, 0xF3, 0xF4, 
+0x4E, 0x93, 0x5C, 0x68, 0x4C, 0xC4, 0x49, 0xDC, 
+0x41, 0xB5, 0x90, 0x0A, 0xB9, 0x2A, 0xE1, 0xC9, 
+0x17, 0x65, 0xB2, 0xFC, 0x59, 0xDD, 0x5E, 0xD6, 
+0x00, 0x11, 0xCE, 0x15, 0x6D, 0xE8, 0x29, 0x8E, 
+0xA5, 0x3B, 0xD3, 0xED, 0x9B, 0x8C, 0x9D, 0x1B, 
+0x12, 0x15, 0xF9, 0xAD, 0x6C, 0x45, 0xC6, 0x6A, 
+0x65, 0x3E, 0x97, 0xBC, 0xE3, 0xE7, 0x1B, 0x54, 
+0x13, 0xF0, 0xED, 0x5B, 0x28, 0x74, 0xBD, 0xB1, 
+0x05, 0xF8, 0x55, 0xBD, 0x0E, 0x25, 0x1F, 0x7F, 
+0xAA, 0xF9, 0x12, 0xBF, 0x1A, 0xD7, 0x0C, 0x88, 
+0x37, 0xE4, 0x0D, 0x10, 0x38, 0xF8, 0x48, 0x36, 
+0x13, 0xC8, 0x81, 0x10, 0xDF, 0x43, 0x0E, 0x26, 
+0x36, 0xBC, 0xB7, 0xA8, 0x2E, 0x25, 
+
+0xB1, 0xC9, 0xB7, 0xDC, 0x08, 0x83, 0xC6, 0x73, 
+0xFA, 0xCE, 0x12, 0x99, 0x2A, 0x81, 0xA4, 0x5A, 
+0x2A, 0xA4, 0xD6, 0x39, 0xB4, 0x9E, 0xC4, 0x74, 
+0x50, 0x82, 0xCC, 0x4D, 0x25, 0x57, 0x81, 0x3A, 
+0xC1, 0x6F, 0xAA, 0xC5, 0xEF, 0x22, 0x1C, 0x75, 
+0x46, 0xB4, 0x36, 0x61, 0x11, 0x03, 0x8A, 0xA4, 
+0xD8, 0xBC, 0xB0, 0xCA, 0x75, 0x47, 0xE2, 0x16, 
+0x1F, 0xD7, 0x1D, 0x04, 0x48, 0x85, 0xA1, 0x67, 
+0xD4, 0x17, 0x4B, 0xF1, 0xD8, 0x8B, 0xFF, 0x03, 
+0x20, 0x5C, 0x3D, 0xA0, 0xB7, 0x9B, 0x31, 0xA7, 
+0xBB, 0x9C, 0x29, 0xC7, 0x57, 0x95, 0x7A, 0xA7, 
+0x28, 0x5E, 0x4D, 0xB7, 0x42, 0xA2, 0xDC, 0x79, 
+0x06, 0x8A, 0x93, 0xC9, 0x49, 0xA1, 0x52, 0xCD, 
+0x44, 0xAD, 0x55, 0x00, 0xB4, 0xFC, 0x94, 0xBC, 
+0x9D, 0x6F, 0x09, 0xB8, 0x79, 0x99, 0x1B, 0xB8, 
+0x2C, 0x42, 0x34, 0xBB, 0x97, 0x0F, 0xE7, 0x82, 
+0x2C, 0x30, 0x62, 0x51, 0x80, 0xF1, 0x48, 0x6B, 
+0xA0, 0x3E, 0x5A, 0x3C, 0x44, 0xD4, 0x59, 0x51, 
+0x6B, 0x7A, 0xE9, 0x24, 0xAF, 0xAA, 0x73, 0x47, 
+0xDE, 0x3F, 0xC8, 0x24, 0x5B, 0xC0, 0x04, 0xC1, 
+0x5C, 0xD5, 0xE7, 0x1C, 0xFF, 0x96, 0x60, 0x95, 
+0x0D, 0x30, 0xC7, 0x98, 0x32, 0x7E, 0xAF, 0x85, 
+0x59, 0xC5, 0xC2, 0x3C, 0x82, 0x7F, 0xB0, 0xA4, 
+0xE9, 0xD8, 0x0B, 0xB3, 0xB4, 0xDD, 0xEE, 0x05, 
+0xFD, 0xDB, 0xE4, 0x38, 0xF9, 0x2F, 0xEA, 
+
+0x6F, 0xE0, 0x41, 0xB0, 0x7B, 0xA2, 0x09, 0x3A, 
+0x3B, 0x15, 0x09, 0x20, 0xE5, 0x70, 0x23, 0xB7, 
+0x23, 0x42, 0xFF, 0xC2, 0x55, 0x58, 0x50, 0xB5, 
+0x29, 0xD4, 0xC5, 0x03, 0x64, 0x10, 0x97, 0xAE, 
+0xF4, 0x03, 0x0F, 0xD1, 0xD7, 0xDA, 0x15, 0x2E, 
+0x5B, 0x1F, 0xA6, 0xE6, 0xA6, 0x9B, 0xCC, 0xA3, 
+0x80, 0xA5, 0x6B, 0x76, 0x3D, 0x08, 0x7D, 0xA7, 
+0x00, 0x52, 0x34, 0x79, 0x12, 0x53, 0x32, 0x66, 
+0xB0, 0xE2, 0x9C, 0xB3, 0xE6, 0x19, 0x24, 0xD2, 
+0xAD, 0x09, 0x9A, 0x68, 0x54, 0x04, 0x48, 0x32, 
+0x15, 0x25, 0x6C, 0x3D, 0x86, 0x68, 0x9A, 0xAB, 
+0xF7, 0xEA, 0xE3, 0x4E, 0xB7, 0x8B, 0xCE, 0x9E, 
+0xC0, 0x9D, 0xAF, 0xF9, 0x71, 0x40, 0x98, 0xD2, 
+0x3C, 0x44, 0x23, 0x46, 0x5B, 0x96, 0x7D, 0x4C, 
+0x59, 0x8F, 0x5B, 0x58, 0xEF, 0x6F, 0x47, 0x63, 
+0x6F, 0x51, 0xF1, 0x1E, 0x3F, 0xEC, 0x9E, 0x9E, 
+0x4E, 0xC5, 0x91, 0x55, 0xA8, 0x1E, 0x0A, 0x7B, 
+0x6E, 0xE4, 0x71, 0x83, 0x25, 0xAE, 0xDD, 0xA4, 
+0xFE, 0x99, 0xF4, 0xDD, 0x21, 0x1A, 0x40, 0x8F, 
+0x1F, 0x33, 0xD1, 0x77, 0x48, 0x36, 0xE7, 0xA5, 
+0x5B, 0x2B, 0x2E, 0xB6, 0x67, 0x57, 0xA5, 0x4C, 
+0xFA, 0xF6, 0x9F, 0x81, 0xDD, 0x6A, 0x49, 0x5E, 
+0x33, 0x27, 0x72, 0x98, 0xAA, 0x91, 0x9B, 0xBF, 
+0x24, 0x1E, 0x32, 0x77, 0xFE, 0x7C, 0x2B, 0x31, 
+0xA7, 0xFB, 0x8C, 0x47, 0xEE, 0xE8, 0xC1, 0x6F, 
+
+0x65, 0x3B, 0x05, 0x46, 0x23, 0x04, 0x9E, 0xFB, 
+0x1F, 0x18, 0x6C, 0xF7, 0x74, 0xAB, 0xA2, 0x4D, 
+0xEF, 0x66, 0xC6, 0x80, 0x60, 0xD3, 0x08, 0x13, 
+0x0F, 0xBC, 0x56, 0x4B, 0x76, 0x71, 0x4E, 0x3F, 
+0xFF, 0xC8, 0x2F, 0x1C, 0xB1, 0x17, 0xFE, 0x2E, 
+0x40, 0xB2, 0x38, 0x24, 0xE4, 0x13, 0xBE, 0x20, 
+0xEB, 0x07, 0x38, 0x91, 0xDB, 0x48, 0xB4, 0xA6, 
+0xAB, 0x61, 0x62, 0xF9, 0x86, 0x66, 0xD1, 0x09, 
+0x75, 0x5D, 0xDF, 0xAE, 0x49, 0xC8, 0x1A, 0x67, 
+0xE6, 0x04, 0x21, 0x95, 0x12, 0x9A, 0x8E, 0xEC, 
+0xFB, 0x17, 0x83, 0xDC, 0xFE, 0x8D, 0x4C, 0x28, 
+0x2F, 0xC9, 0xD7, 0x42, 0x40, 0xF6, 0x02, 0xE3, 
+0xF7, 0x44, 0xE4, 0x6D, 0x44, 0xB6, 0x82, 0xE8, 
+0x3B, 0x33, 0x03, 0xFF, 0xBE, 0x2F, 0xE2, 0x0B, 
+0x64, 0x95, 0x23, 0x40, 0x75, 0xDB, 0x1D, 0xEC, 
+0x06, 0x11, 0xF3, 0xC0, 0x0B, 0xDE, 0x37, 0x85, 
+0x6B, 0xEC, 0xBB, 0x2D, 0x50, 0xBF, 0x14, 0x73, 
+0x1A, 0xF2, 0x04, 0xDB, 0xA7, 0x93, 0x9D, 0x92, 
+0x4F, 0xC7, 0xD2, 0x50, 0x50, 0x18, 0xD9, 0x26, 
+0x10, 0x7A, 0xE8, 0x69, 0xCA, 0x07, 0xEA, 0xA2, 
+0x0F, 0x42, 0xBA, 0x58, 0xA2, 0xE5, 0x79, 0xED, 
+0x55, 0x9A, 0x83, 0x68, 0xAD, 0xCD, 0xF8, 0x53, 
+0x7B, 0x68, 0x6B, 0x46, 0x30, 0xA2, 0x27, 0x1F, 
+0x0F, 0xB2, 0x2E, 0x4A, 0x01, 0x07, 0x94, 0x54, 
+0x5E, 0x5D, 0x11, 0x5D, 0x6D, 0x95, 0x8D, 0xDA, 
+0x1B, 
+
+0xDE, 0x3A, 0x67, 0x24, 0xE0, 0x38, 0xB4, 0x28, 
+0xB7, 0x69, 0x88, 0xBC, 0xAA, 0x77, 0x7F, 0x00, 
+0x3B, 0xDD, 0x72, 0x26, 0x7B, 0xBD, 0xF5, 0xFF, 
+0x50, 0x5B, 0x2F, 0xFE, 0x2C, 0xB4, 0xAA, 0x96, 
+0xD0, 0x63, 0x30, 0x54, 0x15, 0xEE, 0x79, 0xEF, 
+0x3A, 0x51, 0xFA, 0xBF, 0x37, 0x86, 0x36, 0x3D, 
+0xEE, 0x7A, 0x70, 0x2B, 0xEF, 0xFD, 0xE9, 0xDD, 
+0x8A, 0x42, 0xE8, 0x4D, 0x80, 0x26, 0xE8, 0x06, 
+0x48, 0x9B, 0x69, 0xE8, 0x5F, 0xDF, 0xBB, 0x69, 
+0x70, 0xAC, 0xF7, 0xB9, 0x5A, 0x2A, 0x76, 0x18, 
+0x9E, 0xFD, 0xCC, 0xEF, 0x8F, 0x5A, 0x0F, 0xC4, 
+0x05, 0x5E, 0x80, 0x19, 0x2A, 0x5E, 0x0B, 0x39, 
+0xCD, 0xD8, 0x7A, 0x33, 0x9E, 0x1A, 0x05, 0xBF, 
+0x59, 0xFD, 0x6F, 0x39, 0x2E, 0xAB, 0x46, 0x01, 
+0x42, 0x5E, 0x51, 0xE0, 0xCF, 0x90, 0xA5, 0xBB, 
+0x3A, 0x7C, 0xC5, 0x9D, 0x19, 0x15, 0xF6, 0x67, 
+0xFC, 0x45, 0x88, 0xB5, 0x96, 0x6F, 0x35, 0xAF, 
+0xCC, 0xB0, 0xE8, 0xB2, 0xAA, 0x30, 0xD0, 0xB2, 
+0x50, 0xF0, 0x45, 0x06, 0xA8, 0xF3, 0xE5, 0x7F, 
+0x73, 0x47, 0xD2, 0x15, 0x1D, 0x4B, 0x18, 0x26, 
+0x73, 0x26, 0xF2, 0x7C, 0x27, 0x1D, 0xED, 0xD6, 
+0x9C, 0x26, 0xB2, 0x59, 0x92, 0xC2, 0x49, 0x8D, 
+0xE4, 0xF6, 0x74, 0x7C, 0xF7, 0xC5, 0x85, 0x73, 
+0xF6, 0x62, 0x8C, 0x51, 0xC0, 0xDA, 0x18, 0xDA, 
+0x49, 0xD5, 0xE2, 0x04, 0xEE, 0x40, 0xE5, 0x12, 
+0xA6, 0x86, 
+
+0xF0, 0x63, 0x6F, 0xF3, 0xD4, 0xAB, 0x91, 0x5D, 
+0x1E, 0xEC, 0xDB, 0xEC, 0x19, 0xEB, 0x4C, 0xC4, 
+0xF7, 0xD0, 0x54, 0x83, 0x70, 0xD1, 0x7E, 0x7A, 
+0x2A, 0xB7, 0x45, 0x0C, 0xFD, 0xF9, 0x51, 0xFC, 
+0x9B, 0x03, 0x21, 0x93, 0x05, 0x28, 0x58, 0x03, 
+0xD8, 0x2E, 0x17, 0x43, 0x4F, 0xBD, 0x2A, 0x28, 
+0xED, 0xF6, 0x9F, 0xA8, 0x76, 0x0C, 0x60, 0x8D, 
+0x94, 0x00, 0xD4, 0xE9, 0xAE, 0xD2, 0x26, 0xB8, 
+0x51, 0x06, 0x1B, 0xA4, 0x36, 0x7F, 0x62, 0x09, 
+0xF9, 0xCB, 0x83, 0x49, 0xD6, 0x59, 0xB3, 0xC4, 
+0x5D, 0xEF, 0x1E, 0xE1, 0x9D, 0x0F, 0x0E, 0xAD, 
+0xCB, 0x11, 0x69, 0xC8, 0x96, 0x6A, 0x1A, 0xB8, 
+0x67, 0x02, 0x63, 0x2B, 0x9E, 0x08, 0xE3, 0x39, 
+0xA8, 0x8A, 0x1B, 0xDC, 0x40, 0xBA, 0x7D, 0xF2, 
+0x54, 0x2E, 0x19, 0x47, 0x6F, 0x57, 0xD6, 0x49, 
+0x23, 0x92, 0xDB, 0xFF, 0xA8, 0x02, 0x81, 0x3C, 
+0xBA, 0x5C, 0xCF, 0xA4, 0xBF, 0xF0, 0x99, 0xDA, 
+0x3B, 0x0E, 0xA7, 0x42, 0xC4, 0x05, 0xDF, 0x14, 
+0xF6, 0x3B, 0x91, 0x1A, 0xB7, 0xD9, 0x4C, 0xBE, 
+0xFB, 0xF8, 0x28, 0xAC, 0x5A, 0xED, 0xF3, 0x87, 
+0x21, 0xFC, 0x24, 0x44, 0xB9, 0x2A, 0xBD, 0x45, 
+0x45, 0x03, 0x36, 0xC0, 0x07, 0xF5, 0x12, 0x90, 
+0x5B, 0x86, 0xAF, 0xE2, 0x70, 0xF1, 0x88, 0x3B, 
+0x19, 0x25, 0x98, 0xCC, 0x32, 0x81, 0x1A, 0xFA, 
+0x58, 0x63, 0xF9, 0x63, 0x67, 0x4C, 0x91, 0xDF, 
+0x29, 0xBC, 0x26, 
+
+0x49, 0x8D, 0x39, 0x2D, 0x11, 0x98, 0xC6, 0x46, 
+0x35, 0xEC, 0xDE, 0x38, 0xEF, 0xD5, 0xD5, 0x34, 
+0x3F, 0x13, 0xA8, 0x5B, 0xBE, 0x32, 0xC1, 0xDF, 
+0xB0, 0xCB, 0x51, 0x1D, 0x42, 0x9E, 0xFD, 0xC3, 
+0xE9, 0x29, 0xB2, 0x27, 0x80, 0x27, 0x0E, 0x1C, 
+0x37, 0x67, 0x2E, 0x12, 0xD4, 0x27, 0x24, 0x03, 
+0x59, 0x63, 0x68, 0xB4, 0x35, 0x86, 0xA2, 0x84, 
+0x73, 0xFD, 0xD9, 0x9A, 0x58, 0x98, 0xD2, 0xDE, 
+0xD5, 0x79, 0x8C, 0x31, 0xD9, 0x3E, 0x9E, 0xB4, 
+0x02, 0xB4, 0xDB, 0xBB, 0x9B, 0x21, 0x3D, 0x51, 
+0x1E, 0x42, 0x92, 0xA8, 0x37, 0xAD, 0x84, 0x6E, 
+0xBC, 0xA9, 0xB2, 0x88, 0x6E, 0x1E, 0xF0, 0x57, 
+0xCC, 0x28, 0x4F, 0x28, 0x03, 0xA1, 0x71, 0x9A, 
+0x47, 0x77, 0x5F, 0x4D, 0x0B, 0x42, 0xE3, 0xBB, 
+0x19, 0x8C, 0xFF, 0xE6, 0x61, 0x98, 0xE2, 0x05, 
+0xF1, 0x91, 0x33, 0x9F, 0x34, 0x18, 0xD0, 0xBF, 
+0x5A, 0xA3, 0x0A, 0xBF, 0x3E, 0x26, 0x14, 0x2E, 
+0x77, 0x73, 0x0C, 0x46, 0x5C, 0x5A, 0xA0, 0xB0, 
+0x15, 0xA9, 0xA2, 0x3F, 0x46, 0x76, 0x93, 0x47, 
+0x9E, 0xB2, 0x77, 0x76, 0x4A, 0xC2, 0xA4, 0x71, 
+0x90, 0x19, 0xC0, 0x06, 0xF2, 0x80, 0x1C, 0xBE, 
+0xB9, 0x44, 0x33, 0x79, 0xD1, 0x24, 0x2A, 0x7C, 
+0x31, 0xFB, 0x1A, 0x4C, 0x09, 0xE2, 0x70, 0x7F, 
+0x52, 0xCE, 0x4A, 0xDC, 0xF3, 0xFE, 0x5B, 0xE5, 
+0x99, 0x99, 0xB4, 0xEB, 0x8E, 0xE0, 0xB0, 0x66, 
+0x09, 0x4C, 0xEB, 0x9E, 
+
+0x8A, 0x9F, 0x8C, 0x8A, 0x82, 0xE4, 0x1F, 0xB8, 
+0x91, 0x54, 0x99, 0xD9, 0x69, 0xC6, 0x83, 0x92, 
+0xCD, 0x96, 0x89, 0x19, 0x74, 0xE2, 0xD7, 0x42, 
+0x0F, 0x2A, 0xE6, 0x94, 0x5C, 0xD2, 0x32, 0xA6, 
+0xEF, 0x3F, 0x68, 0xA7, 0xC7, 0x16, 0xF8, 0x85, 
+0x29, 0x75, 0xAA, 0xF2, 0x0C, 0xF3, 0xB9, 0xFD, 
+0x1C, 0x02, 0x92, 0x96, 0xD4, 0x45, 0xB4, 0xA5, 
+0x6B, 0xDD, 0x3C, 0x3C, 0xD1, 0xDB, 0x02, 0x95, 
+0xD3, 0x5B, 0x3A, 0x42, 0x5D, 0x78, 0xE5, 0x7B, 
+0xF8, 0xBC, 0x7E, 0x3F, 0x9B, 0x53, 0xCC, 0xF5, 
+0x97, 0x89, 0x33, 0x81, 0x97, 0x13, 0x29, 0xC3, 
+0x0D, 0xDD, 0x38, 0xAB, 0x20, 0xB1, 0x86, 0x08, 
+0x71, 0xB2, 0xA4, 0x97, 0x55, 0xB4, 0xC5, 0xFE, 
+0x32, 0xFD, 0xFB, 0x82, 0xEC, 0xDA, 0xE1, 0x6F, 
+0x9A, 0xCA, 0x4B, 0xE5, 0x08, 0xB7, 0xAF, 0x9C, 
+0x99, 0x1B, 0xB1, 0x35, 0xFC, 0xFA, 0x6D, 0x96, 
+0xEE, 0xB3, 0xF9, 0xFA, 0xDD, 0x1F, 0xEF, 0x98, 
+0x93, 0xBC, 0x93, 0xD3, 0x7A, 0xF5, 0x72, 0x5C, 
+0x7C, 0x37, 0x77, 0xB1, 0x17, 0x86, 0x83, 0xBC, 
+0x47, 0x2C, 0x43, 0x91, 0xBE, 0xE8, 0x19, 0x3C, 
+0x3D, 0xB0, 0xDC, 0x13, 0x6D, 0x99, 0x44, 0xE3, 
+0xEC, 0x67, 0x3B, 0xFD, 0x13, 0x74, 0x8F, 0xEB, 
+0x46, 0xC7, 0x98, 0x0E, 0xDC, 0x66, 0xA7, 0x16, 
+0xB6, 0x12, 0xAB, 0x25, 0x82, 0x6B, 0xA4, 0x5E, 
+0x27, 0x06, 0x7F, 0x81, 0x45, 0x34, 0xD4, 0x85, 
+0x12, 0xEB, 0xEF, 0x7C, 0x7C, 
+
+0xF2, 0xDD, 0xE5, 0x7A, 0x20, 0xEC, 0xAB, 0xA3, 
+0x68, 0x1A, 0x1A, 0x90, 0x5B, 0xE3, 0xFC, 0x24, 
+0x44, 0x97, 0x07, 0x13, 0x2E, 0x02, 0xC8, 0xA0, 
+0x1B, 0x69, 0x7A, 0x2B, 0x97, 0x83, 0x77, 0x2F, 
+0x48, 0x72, 0x1C, 0x7A, 0xD6, 0xC9, 0xCF, 0x92, 
+0x01, 0x49, 0x90, 0x4D, 0x2A, 0x69, 0xE2, 0x89, 
+0xC5, 0xB6, 0xEF, 0x7C, 0xEB, 0xF0, 0xED, 0x17, 
+0x15, 0x48, 0x64, 0xA0, 0xA0, 0x01, 0x0B, 0x6A, 
+0xEE, 0x29, 0x80, 0xAA, 0xFC, 0xB2, 0x87, 0x5E, 
+0x21, 0x4C, 0xB9, 0x33, 0x8B, 0xE6, 0x36, 0x56, 
+0xB7, 0x3D, 0xF6, 0xAC, 0x7B, 0x0E, 0x34, 0x79, 
+0x9E, 0x5A, 0x3A, 0x5B, 0xE8, 0xD6, 0x5E, 0x30, 
+0x24, 0x93, 0x5A, 0xD5, 0x52, 0xB9, 0x72, 0x92, 
+0x22, 0xA7, 0xE3, 0xA8, 0x33, 0xDB, 0x3B, 0xAA, 
+0x48, 0x96, 0x42, 0xC1, 0x4D, 0x5A, 0x30, 0xE6, 
+0xB5, 0x0E, 0x76, 0x2A, 0xFC, 0xAC, 0xA1, 0x96, 
+0xDF, 0x57, 0x97, 0xCB, 0x62, 0xF4, 0x4E, 0xEB, 
+0x6C, 0xBD, 0x6B, 0xC2, 0x90, 0x30, 0xD8, 0x85, 
+0xC1, 0x63, 0xCD, 0x97, 0x14, 0x87, 0xF8, 0x53, 
+0x41, 0x06, 0xC0, 0x40, 0xA3, 0x2E, 0xA8, 0x6E, 
+0xEE, 0xB1, 0x18, 0x47, 0xC2, 0xF4, 0x56, 0x93, 
+0xDA, 0x2E, 0x70, 0xB4, 0x99, 0xF6, 0xB0, 0x67, 
+0xFF, 0x94, 0x4F, 0xA8, 0x4B, 0x72, 0xE9, 0x17, 
+0xC3, 0x62, 0x44, 0x1C, 0xFD, 0x48, 0xB9, 0xDD, 
+0x96, 0xF7, 0xBC, 0xD8, 0xB1, 0x91, 0xF7, 0xDF, 
+0xDB, 0x94, 0xEF, 0xF6, 0xDC, 0x8B, 
+
+0x3C, 0x5A, 0xDA, 0x36, 0xFA, 0xEC, 0x29, 0xEC, 
+0xD3, 0xC1, 0xAC, 0xDF, 0xBC, 0xE3, 0xF6, 0x57, 
+0x72, 0xB2, 0x93, 0xC4, 0xEB, 0x04, 0xA9, 0xF6, 
+0xC1, 0x4A, 0x27, 0x07, 0xD2, 0x6F, 0xA0, 0xA2, 
+0x18, 0x64, 0x34, 0x04, 0x3B, 0x09, 0x00, 0xE2, 
+0x00, 0x75, 0xE1, 0x05, 0x7E, 0x49, 0x04, 0xDF, 
+0x7D, 0x4F, 0xCD, 0xE8, 0x5C, 0x4B, 0x5B, 0xE5, 
+0x6B, 0xC9, 0xE9, 0xF1, 0x6E, 0x95, 0x0D, 0xA4, 
+0xFD, 0x38, 0xCB, 0x0F, 0xAC, 0x61, 0x21, 0xE1, 
+0xDF, 0xC2, 0x30, 0xDA, 0x38, 0x56, 0xBD, 0xA4, 
+0xC2, 0x9A, 0xEA, 0xD0, 0x36, 0x69, 0xA1, 0xC4, 
+0xE4, 0x0B, 0x97, 0x96, 0xA9, 0x07, 0x45, 0x55, 
+0x00, 0xA0, 0xB5, 0xB8, 0x65, 0xB7, 0x29, 0x94, 
+0x70, 0x65, 0xAE, 0x40, 0xE8, 0xEC, 0xEF, 0x9C, 
+0x74, 0x8E, 0xCE, 0x7C, 0x47, 0x61, 0xB6, 0x97, 
+0x3B, 0x2E, 0xFE, 0xD0, 0x0A, 0x1A, 0x0B, 0x2C, 
+0xE5, 0x60, 0x76, 0x5C, 0x61, 0x37, 0x77, 0x4E, 
+0x3C, 0xBD, 0x3C, 0x7D, 0x1D, 0x47, 0x40, 0xB5, 
+0x9B, 0x41, 0xB3, 0x30, 0x4C, 0x04, 0x32, 0x25, 
+0x40, 0x66, 0x9E, 0x16, 0x28, 0xF2, 0x9E, 0x58, 
+0x7A, 0x5D, 0x54, 0xAB, 0x03, 0xB5, 0xEF, 0xBF, 
+0xF2, 0xCD, 0x1E, 0x3B, 0x17, 0xAE, 0xA2, 0xF1, 
+0x8D, 0xE8, 0x44, 0x70, 0xC2, 0x61, 0x06, 0xF7, 
+0xAB, 0x04, 0x47, 0x23, 0x50, 0xA3, 0x98, 0xB6, 
+0x7F, 0xFD, 0x8D, 0x3D, 0x1C, 0x23, 0x85, 0x12, 
+0x7C, 0x2E, 0x8F, 0x9A, 0x5D, 0x16, 0x0A, 
+
+0x32, 0x21, 0x8A, 0x97, 0x05, 0xC1, 0x47, 0xCB, 
+0x96, 0xA8, 0xA7, 0x64, 0xFF, 0x70, 0x0E, 0x2C, 
+0xC6, 0xD9, 0x28, 0xBF, 0xFA, 0x74, 0xD5, 0xEC, 
+0x97, 0x66, 0xAE, 0x87, 0x84, 0xF9, 0xB2, 0x4D, 
+0x7E, 0xA0, 0xA2, 0xBD, 0xD6, 0x13, 0xA4, 0x4D, 
+0x07, 0x28, 0x6D, 0xF4, 0xDC, 0xE9, 0x92, 0x62, 
+0x41, 0x10, 0x45, 0xCB, 0x6F, 0x63, 0x44, 0x69, 
+0x7F, 0x59, 0x2B, 0x46, 0x23, 0xBF, 0xB4, 0xC0, 
+0x7E, 0xEA, 0xB3, 0xAC, 0xBC, 0x2D, 0xEF, 0xBD, 
+0x53, 0x77, 0x9F, 0x3D, 0x6B, 0x8A, 0xC2, 0xB9, 
+0xBA, 0x9E, 0x81, 0x13, 0x40, 0x4F, 0x8C, 0xE1, 
+0x69, 0x02, 0x02, 0x58, 0xE4, 0x90, 0x85, 0xC8, 
+0xAA, 0x4A, 0x1A, 0x1F, 0x8F, 0x45, 0x16, 0xB8, 
+0x8B, 0xAF, 0xA0, 0x58, 0x34, 0xD5, 0xC8, 0x7C, 
+0xD1, 0x52, 0xB6, 0x86, 0x7C, 0x76, 0x14, 0x97, 
+0x05, 0x20, 0xB2, 0xCB, 0x46, 0xBE, 0xB1, 0x2A, 
+0x7D, 0xBE, 0x66, 0xBB, 0x4E, 0x41, 0x66, 0x44, 
+0x4F, 0x19, 0xD4, 0xA6, 0x91, 0x2F, 0x6B, 0xE6, 
+0x08, 0x54, 0x7A, 0x1E, 0x0D, 0x07, 0x4B, 0xD0, 
+0xB2, 0x7D, 0x21, 0x4C, 0xD3, 0x22, 0x86, 0x70, 
+0xF3, 0x14, 0xC7, 0xC1, 0x90, 0xF2, 0xE7, 0x63, 
+0xC8, 0x90, 0x8A, 0x96, 0xDC, 0xE9, 0xA9, 0xFD, 
+0x38, 0xC5, 0x9E, 0x78, 0x29, 0x89, 0xC4, 0xF4, 
+0x7E, 0x68, 0x80, 0xC2, 0x35, 0x04, 0xAB, 0x8F, 
+0xFA, 0xB4, 0x11, 0xD2, 0x72, 0x7E, 0x2F, 0xCD, 
+0x8C, 0x8D, 0x74, 0x6F, 0xE5, 0x50, 0xD1, 0xB0, 
+
+0xD3, 0x36, 0x17, 0x72, 0x6E, 0x12, 0x5E, 0x99, 
+0xA0, 0x74, 0x48, 0x49, 0xD0, 0x68, 0xD4, 0x23, 
+0x8D, 0xF9, 0xA6, 0x84, 0xAA, 0xCA, 0x7F, 0xE8, 
+0x58, 0x9F, 0xB5, 0x3F, 0x44, 0xFA, 0x2D, 0x9B, 
+0x8D, 0x63, 0x9F, 0x24, 0xA2, 0xE1, 0x47, 0xAF, 
+0xB2, 0xD4, 0x88, 0x36, 0x8C, 0x78, 0x6A, 0x59, 
+0xFD, 0x94, 0xD8, 0x1E, 0xC6, 0xF7, 0xEF, 0x74, 
+0x9D, 0xEF, 0x09, 0xFE, 0xB1, 0xF2, 0x3E, 0x29, 
+0xB9, 0x7C, 0xEE, 0x58, 0xA0, 0x96, 0x10, 0x26, 
+0x96, 0x1C, 0x42, 0x0F, 0x2D, 0xD0, 0xA8, 0xD3, 
+0xAA, 0xE3, 0x2F, 0xAD, 0x81, 0xD7, 0xFA, 0xE4, 
+0xDA, 0x6A, 0x1B, 0x19, 0x8A, 0x9E, 0x0C, 0x17, 
+0x4F, 0xE2, 0xD2, 0x7D, 0x9B, 0xE8, 0x1E, 0x76, 
+0xFD, 0xD3, 0x25, 0xA1, 0x91, 0xC4, 0xE8, 0x67, 
+0x9A, 0xA0, 0x48, 0x51, 0xD9, 0xDB, 0x6E, 0xA4, 
+0xC6, 0x70, 0x18, 0x67, 0x6F, 0x33, 0x77, 0xA8, 
+0x53, 0x3E, 0x09, 0xED, 0x58, 0xC6, 0xB6, 0xAF, 
+0xC6, 0xB8, 0x78, 0xF6, 0xCE, 0xD0, 0x7E, 0x95, 
+0x03, 0x9D, 0xEC, 0x15, 0xFD, 0x53, 0x7F, 0x1A, 
+0xBE, 0x0A, 0xC1, 0xAE, 0x98, 0xCA, 0x6B, 0x49, 
+0x61, 0x47, 0x01, 0x51, 0x39, 0x23, 0x9B, 0x04, 
+0x14, 0xE5, 0x4E, 0x65, 0x03, 0xC5, 0x51, 0x2B, 
+0xDC, 0x65, 0x21, 0x4F, 0xBA, 0xE7, 0x49, 0x14, 
+0x2F, 0x21, 0xDE, 0x2F, 0xEE, 0x8E, 0xA8, 0x1D, 
+0x0E, 0xEC, 0x93, 0xCA, 0x36, 0xE8, 0x21, 0x56, 
+0xEC, 0x8E, 0x47, 0xF9, 0xD9, 0xAF, 0xCD, 0x0E, 
+0xBB, 
+
+0xA5, 0x33, 0x87, 0x4A, 0xFC, 0xEF, 0x6A, 0xE3, 
+0xAF, 0x62, 0x89, 0x3D, 0x66, 0xEC, 0x5A, 0xF2, 
+0x43, 0x61, 0x61, 0x04, 0x92, 0x5D, 0xF3, 0x18, 
+0x29, 0xDD, 0x28, 0xDA, 0xF1, 0xC7, 0xFE, 0xA0, 
+0x9A, 0x60, 0xBC, 0x1D, 0x52, 0x40, 0xD3, 0xF5, 
+0x15, 0x50, 0xFD, 0x76, 0xE0, 0x3E, 0xE4, 0xA3, 
+0xE9, 0x91, 0x87, 0xC7, 0xAC, 0x09, 0x1A, 0xAD, 
+0xEF, 0xE8, 0x5B, 0x90, 0xEB, 0x26, 0xAB, 0xF4, 
+0xC5, 0x7A, 0x60, 0xC3, 0xF8, 0xAB, 0x8C, 0xF8, 
+0xD3, 0x9F, 0xDF, 0xD5, 0xA6, 0x5F, 0x53, 0xD1, 
+0x53, 0x7B, 0x3B, 0xDA, 0x7B, 0x43, 0xF3, 0x56, 
+0xE9, 0xA7, 0x49, 0xC6, 0xA2, 0x34, 0x10, 0xCE, 
+0x93, 0x66, 0xB3, 0x10, 0xEB, 0x9B, 0xCA, 0x74, 
+0x3E, 0xF6, 0xB2, 0x4C, 0xDA, 0x6F, 0x57, 0x20, 
+0x4C, 0x78, 0x13, 0xBF, 0x4D, 0x20, 0x18, 0xFD, 
+0x3D, 0x3B, 0xEF, 0x8E, 0xFC, 0x4D, 0xD4, 0xC6, 
+0x68, 0xBD, 0x43, 0x3B, 0x4A, 0xFD, 0x3E, 0xF3, 
+0xEC, 0x33, 0xD0, 0x0F, 0x63, 0x39, 0x9D, 0x20, 
+0x1E, 0x56, 0xB5, 0x01, 0x36, 0xE4, 0xD2, 0x66, 
+0xFB, 0xB0, 0xAF, 0x58, 0x18, 0x75, 0x10, 0xEE, 
+0x66, 0x71, 0x5B, 0xE9, 0x81, 0x60, 0x65, 0xB8, 
+0xAB, 0x43, 0xC1, 0xD3, 0x86, 0x73, 0x98, 0x4A, 
+0xE6, 0x63, 0xBC, 0x89, 0x5D, 0x50, 0x7F, 0x34, 
+0x60, 0x8F, 0x1E, 0x91, 0xEC, 0x55, 0x68, 0xB7, 
+0x91, 0x71, 0xCD, 0xD8, 0x8E, 0xB0, 0x9C, 0xCD, 
+0xF3, 0x1C, 0x89, 0x11, 0xC5, 0xA4, 0x44, 0xFF, 
+0x54, 0x50, 
+
+0xF2, 0x2C, 0x11, 0xB0, 0x21, 0xA9, 0x8F, 0x00, 
+0x8D, 0xE4, 0xC3, 0xC5, 0xFE, 0xFE, 0x7B, 0xDA, 
+0x4A, 0xD1, 0x9D, 0x64, 0x5C, 0x61, 0xDC, 0x01, 
+0x0F, 0x4A, 0x59, 0x73, 0x2A, 0x03, 0xBE, 0x40, 
+0x88, 0xA6, 0xAE, 0x6E, 0x5A, 0x11, 0x3A, 0x24, 
+0xB5, 0x84, 0x2B, 0x0A, 0x2A, 0xF1, 0x99, 0xA7, 
+0xEA, 0xDA, 0xC2, 0xA0, 0xF7, 0x2E, 0xF8, 0xBA, 
+0x7A, 0x8C, 0x04, 0x1A, 0xE8, 0xB8, 0x05, 0x8B, 
+0x9A, 0x6C, 0x75, 0xAE, 0x13, 0x42, 0xD8, 0x34, 
+0x9D, 0x4B, 0x47, 0x48, 0x94, 0x42, 0xF1, 0x28, 
+0xC1, 0x74, 0xBD, 0x70, 0x03, 0x61, 0x5F, 0xA1, 
+0x47, 0x4F, 0x21, 0x0E, 0x81, 0x03, 0x25, 0x45, 
+0x23, 0xF2, 0xB4, 0xF2, 0x7A, 0x0E, 0xCE, 0x87, 
+0x95, 0xD6, 0x8E, 0x15, 0xE6, 0x09, 0x4B, 0x16, 
+0xAF, 0x83, 0x7C, 0xF1, 0x82, 0x68, 0x25, 0x1E, 
+0x1A, 0xF4, 0xE3, 0xEE, 0x95, 0xC2, 0x84, 0x50, 
+0x95, 0xC5, 0xEC, 0x01, 0xCE, 0x6D, 0xFF, 0x3E, 
+0x3A, 0xCF, 0x8A, 0x22, 0xEA, 0xB4, 0x28, 0x93, 
+0x10, 0x7A, 0xBA, 0x59, 0x68, 0xEE, 0x87, 0xE6, 
+0x2B, 0x44, 0xB3, 0x8B, 0x0F, 0x6F, 0xF7, 0x7B, 
+0x72, 0x72, 0x74, 0x10, 0x8C, 0xE8, 0x7B, 0x8E, 
+0x77, 0xEB, 0xC6, 0xF1, 0x51, 0x91, 0x01, 0xF8, 
+0x06, 0x1F, 0x93, 0x53, 0x28, 0x51, 0xEC, 0xBE, 
+0x99, 0xD2, 0xEB, 0xEB, 0xC7, 0x58, 0x3C, 0x90, 
+0x41, 0x17, 0x3B, 0xD2, 0xF8, 0x6D, 0x45, 0xFE, 
+0x81, 0x44, 0x3E, 0x2F, 0x6E, 0xB8, 0xB5, 0x45, 
+0xD0, 0x89, 0x3F, 
+
+0xF2, 0x19, 0x0C, 0x87, 0x67, 0x80, 0xB2, 0xE0, 
+0x9A, 0x85, 0xE1, 0x68, 0x08, 0x46, 0x77, 0x8A, 
+0xA3, 0x45, 0xF8, 0xAC, 0xAB, 0x97, 0xDF, 0xA2, 
+0x38, 0x46, 0x31, 0xBD, 0xA8, 0x53, 0x69, 0xE6, 
+0xB7, 0x51, 0x6C, 0x8B, 0x26, 0xA1, 0x9A, 0x27, 
+0x36, 0x8A, 0x18, 0x29, 0x84, 0x23, 0xA4, 0xA7, 
+0xD0, 0x0E, 0xF7, 0xA9, 0x89, 0xCA, 0xD5, 0xF6, 
+0x52, 0xB6, 0x43, 0xD3, 0x94, 0x77, 0xFC, 0x0F, 
+0xB9, 0x44, 0x75, 0x07, 0xDE, 0x39, 0x68, 0x17, 
+0x5E, 0x38, 0xE0, 0xF1, 0xBD, 0xAA, 0x9A, 0xF5, 
+0xD9, 0x7F, 0x01, 0xDE, 0x30, 0x48, 0x57, 0x1E, 
+0x8B, 0xAE, 0xA9, 0x92, 0x60, 0x39, 0xEA, 0xC3, 
+0xED, 0xB0, 0xA7, 0x18, 0x4F, 0x0F, 0xBB, 0x3F, 
+0x68, 0xD4, 0xFC, 0x17, 0xF8, 0x5B, 0x7F, 0x36, 
+0x02, 0x3A, 0xBA, 0x3A, 0x6F, 0xC6, 0xD4, 0x72, 
+0x76, 0x62, 0xA9, 0xB7, 0xAD, 0xEC, 0x44, 0x2D, 
+0xDF, 0x57, 0xEF, 0x2F, 0x9E, 0x25, 0xB2, 0xBC, 
+0xA3, 0xC1, 0x68, 0xF5, 0x8B, 0xA3, 0x93, 0xB5, 
+0x54, 0x16, 0x50, 0xE2, 0xE5, 0x5C, 0x1E, 0xBF, 
+0x1C, 0x0E, 0xFE, 0x97, 0x35, 0x6E, 0xD3, 0x2C, 
+0xCE, 0x40, 0x09, 0x82, 0x3B, 0x1B, 0x11, 0x70, 
+0x8A, 0xE4, 0x7C, 0x9B, 0x82, 0xCE, 0xFC, 0x3C, 
+0x10, 0x62, 0x6D, 0x92, 0xC6, 0x26, 0xF1, 0x79, 
+0xF7, 0x24, 0x35, 0x9E, 0x7E, 0x45, 0xAF, 0x17, 
+0xC0, 0x9B, 0xDD, 0xD0, 0x3D, 0x8E, 0x01, 0x38, 
+0x85, 0xC6, 0x4D, 0xB4, 0x06, 0x78, 0x77, 0xE6, 
+0x94, 0x65, 0x84, 0x77, 
+
+0xAF, 0x20, 0x71, 0xB0, 0x35, 0x01, 0xAA, 0x42, 
+0x06, 0xA7, 0x7E, 0xA0, 0xB0, 0x6C, 0x03, 0x8B, 
+0x94, 0xB0, 0x56, 0x73, 0x08, 0xEB, 0x63, 0xBA, 
+0x87, 0xB4, 0xA9, 0xE6, 0xFC, 0x41, 0xBE, 0x19, 
+0x73, 0x02, 0x18, 0x24, 0xF8, 0x27, 0xFB, 0x14, 
+0x73, 0x0F, 0xFC, 0x61, 0x5D, 0x7A, 0x89, 0x0D, 
+0xC7, 0xE9, 0x37, 0x38, 0x7D, 0x2C, 0x1E, 0x60, 
+0x0D, 0xBA, 0x08, 0x7D, 0xF7, 0xC7, 0xBD, 0x15, 
+0xE2, 0x35, 0x71, 0x78, 0x94, 0x03, 0xA9, 0xC2, 
+0x89, 0xFA, 0x6F, 0x0B, 0x38, 0x83, 0xF6, 0x31, 
+0xC7, 0x2D, 0x71, 0x68, 0x18, 0x34, 0x8A, 0x86, 
+0x86, 0xED, 0xFB, 0xD2, 0xC7, 0xFC, 0xC1, 0x01, 
+0xD7, 0x03, 0x10, 0xBF, 0xE9, 0x02, 0x1C, 0x5E, 
+0x6D, 0x1B, 0x5D, 0xFC, 0x18, 0x05, 0x1E, 0xC5, 
+0x08, 0x61, 0xF6, 0x40, 0x0E, 0x6A, 0xAE, 0x28, 
+0x3C, 0x32, 0xFF, 0xE9, 0x3F, 0xB2, 0xC6, 0x5B, 
+0x3F, 0xBD, 0x4B, 0xCE, 0xA2, 0x9C, 0xDB, 0x3E, 
+0xBE, 0xFE, 0x21, 0xFB, 0x4C, 0x8B, 0x87, 0xF6, 
+0x08, 0x46, 0x79, 0x92, 0xF9, 0x5A, 0xFB, 0xF0, 
+0x9A, 0x28, 0x2E, 0x7D, 0x0D, 0x86, 0x19, 0x24, 
+0x83, 0x41, 0xEE, 0xD8, 0xB7, 0xC5, 0xD3, 0xD7, 
+0x75, 0xBF, 0x2E, 0xF3, 0xD5, 0xA3, 0x52, 0x7C, 
+0xA4, 0x77, 0xA2, 0xBB, 0x88, 0xDC, 0x5A, 0x3C, 
+0x5B, 0x1B, 0xDE, 0xE9, 0x8C, 0x3B, 0x27, 0x81, 
+0xF8, 0xFC, 0xD2, 0x7C, 0xDD, 0x26, 0x24, 0xB8, 
+0x10, 0x05, 0xF8, 0xA4, 0xEE, 0x03, 0x8F, 0x63, 
+0x2F, 0x41, 0x70, 0xF5, 0x27, 
+
+0x4B, 0x27, 0x2E, 0x50, 0xBE, 0x54, 0xBF, 0xCC, 
+0x54, 0xE8, 0x04, 0xB0, 0x70, 0x01, 0x39, 0x92, 
+0x3D, 0xA4, 0xF9, 0x00, 0xA4, 0x82, 0xD2, 0x84, 
+0x57, 0xDE, 0x5D, 0x74, 0x7F, 0x2B, 0x7C, 0x43, 
+0x6D, 0x72, 0x59, 0x29, 0xE0, 0x25, 0x6C, 0x20, 
+0x64, 0xE8, 0x79, 0x42, 0x7C, 0xDD, 0x94, 0xE7, 
+0x14, 0x51, 0x0D, 0xBF, 0x48, 0x90, 0xAE, 0x02, 
+0x66, 0xF0, 0x36, 0x22, 0x39, 0xC1, 0xAC, 0xF3, 
+0x67, 0xE1, 0x6B, 0x4B, 0x26, 0xB6, 0x00, 0x92, 
+0xB6, 0x36, 0x90, 0x0A, 0x73, 0x56, 0x59, 0x29, 
+0xDA, 0x22, 0x56, 0x92, 0x0E, 0x2B, 0xC2, 0xD9, 
+0x8A, 0xDF, 0x6F, 0x81, 0x0B, 0x7A, 0xA2, 0xC1, 
+0xEA, 0xFD, 0xA5, 0x09, 0xE2, 0xDD, 0x9D, 0xB4, 
+0xD6, 0x03, 0x3D, 0x0A, 0x64, 0xD8, 0x09, 0xE4, 
+0x17, 0xB0, 0xF0, 0x6E, 0xB8, 0x88, 0x30, 0x63, 
+0xA1, 0x21, 0xD9, 0x7A, 0x58, 0xCC, 0x50, 0xFE, 
+0x28, 0x26, 0xBC, 0xA9, 0x20, 0x9C, 0xF7, 0x08, 
+0xF7, 0x83, 0x88, 0x79, 0xC2, 0x31, 0xF3, 0x04, 
+0xAD, 0xC4, 0x26, 0xEE, 0x6E, 0x99, 0xE0, 0x82, 
+0xEF, 0x00, 0xA4, 0xCC, 0xA3, 0x37, 0x34, 0x7E, 
+0x31, 0x3C, 0x20, 0x83, 0x74, 0x09, 0xC9, 0xBD, 
+0xD0, 0x92, 0x59, 0xEE, 0x73, 0xC8, 0x9A, 0xEF, 
+0xC1, 0x27, 0xBB, 0x06, 0x5E, 0x1B, 0xFA, 0x4D, 
+0x29, 0xBD, 0x21, 0x8C, 0x1A, 0xA4, 0x8E, 0x55, 
+0xC9, 0xC2, 0xD2, 0x97, 0xD9, 0xF5, 0x47, 0x11, 
+0x59, 0x41, 0x45, 0x72, 0x10, 0xB1, 0x56, 0xC5, 
+0xB7, 0xF3, 0xB4, 0x55, 0x25, 0x99, 
+
+0x3C, 0x20, 0x01, 0x11, 0x1C, 0xA4, 0xD5, 0x66, 
+0x7B, 0x12, 0x75, 0xA6, 0x2C, 0xB3, 0x2D, 0xB7, 
+0x6D, 0x66, 0xF9, 0x4D, 0x9F, 0xF3, 0xD5, 0xD3, 
+0x21, 0xBB, 0xDB, 0xC8, 0x58, 0xBB, 0x52, 0x03, 
+0x9A, 0x23, 0xB8, 0xC4, 0xA1, 0x32, 0x7D, 0xA0, 
+0xA5, 0xCC, 0x1A, 0x17, 0x59, 0x6F, 0x2F, 0xA4, 
+0x04, 0x30, 0x9B, 0x3B, 0xCD, 0xBE, 0x67, 0x3E, 
+0x0C, 0x8C, 0xBA, 0x98, 0x0D, 0xA3, 0xD3, 0x22, 
+0x53, 0x05, 0xC2, 0x25, 0x89, 0x19, 0xFF, 0xE9, 
+0x15, 0x1E, 0x12, 0xE8, 0x12, 0x7F, 0x47, 0x8F, 
+0x24, 0x30, 0x1E, 0x85, 0xB7, 0x66, 0x56, 0x87, 
+0xF4, 0xEE, 0x97, 0x0D, 0x70, 0xDD, 0xB7, 0x40, 
+0x41, 0x23, 0x2B, 0xA6, 0xF9, 0x5F, 0xF5, 0xDB, 
+0xD5, 0xA5, 0x75, 0x50, 0x4E, 0x85, 0xDB, 0x82, 
+0xC3, 0x0E, 0x61, 0xF9, 0xD3, 0x46, 0x27, 0x4E, 
+0x52, 0x1F, 0x78, 0x60, 0x71, 0x94, 0xC4, 0x0B, 
+0x7E, 0xA4, 0x46, 0xBC, 0x91, 0xB2, 0x7E, 0x4E, 
+0x8E, 0x68, 0xEB, 0x9B, 0x54, 0x2B, 0xC5, 0xA3, 
+0x0C, 0x4C, 0xD0, 0x03, 0x67, 0xAD, 0x97, 0x56, 
+0x8D, 0x45, 0xC8, 0x67, 0x04, 0x52, 0x78, 0x7A, 
+0xA1, 0x82, 0xC5, 0xE8, 0xC2, 0x77, 0xE0, 0x42, 
+0x64, 0x68, 0xD8, 0x81, 0x31, 0xF5, 0x2F, 0x90, 
+0x1D, 0x0C, 0xBD, 0x1B, 0xE8, 0xE6, 0x93, 0x43, 
+0x32, 0xCE, 0x24, 0xDC, 0xE2, 0x1C, 0x56, 0x8E, 
+0x0D, 0xD9, 0xF8, 0x94, 0xCA, 0xBC, 0xC6, 0x81, 
+0x82, 0x58, 0xBE, 0x2B, 0xC1, 0xC5, 0x11, 0x85, 
+0x4B, 0xFB, 0xC8, 0xF5, 0xD3, 0x80, 0xFC, 
+
+0xDB, 0x07, 0xC0, 0x74, 0xB1, 0xA3, 0xEE, 0x45, 
+0x22, 0x95, 0x03, 0xAC, 0xE4, 0x81, 0x90, 0xEB, 
+0xD7, 0x5D, 0xA7, 0xF8, 0x46, 0x70, 0x46, 0x9A, 
+0x05, 0xA4, 0xA8, 0x44, 0xB5, 0xD7, 0xB7, 0xC4, 
+0xE3, 0x2D, 0x86, 0x71, 0x60, 0x03, 0x4C, 0xEA, 
+0x03, 0xA1, 0xDB, 0xA7, 0xE5, 0x37, 0xDB, 0x32, 
+0x51, 0x10, 0x58, 0x54, 0x09, 0x4D, 0x7C, 0x00, 
+0xAB, 0xC1, 0xE3, 0xDC, 0x45, 0x69, 0x91, 0xA7, 
+0x20, 0x5B, 0xD3, 0x46, 0x1F, 0xA1, 0x66, 0x18, 
+0x28, 0x8D, 0xCA, 0xCC, 0xA0, 0x9A, 0xCC, 0xFD, 
+0xD9, 0x66, 0xBB, 0x10, 0x04, 0xD5, 0xAF, 0xFA, 
+0x0B, 0xE2, 0xCC, 0x81, 0x3E, 0x35, 0x16, 0xCF, 
+0x03, 0xDA, 0xAB, 0x32, 0xF7, 0xE1, 0xB6, 0x09, 
+0x24, 0xEC, 0xA9, 0x7A, 0xFE, 0x66, 0x84, 0x6C, 
+0xB2, 0x00, 0x7A, 0x40, 0x1E, 0xAB, 0xC7, 0x1E, 
+0x35, 0x7B, 0xC2, 0x2C, 0xD5, 0xF5, 0x21, 0x29, 
+0x87, 0xD4, 0x88, 0x3E, 0x8D, 0x7E, 0x08, 0x2C, 
+0x58, 0x6B, 0x90, 0x22, 0x18, 0x6F, 0x1F, 0xBC, 
+0x4C, 0x9E, 0x84, 0x6C, 0x96, 0xE7, 0x09, 0x41, 
+0xB0, 0x5C, 0x45, 0x21, 0x14, 0xBB, 0x17, 0x32, 
+0xCD, 0x35, 0x9E, 0x4D, 0xC2, 0x18, 0x07, 0x1F, 
+0x67, 0x36, 0xFA, 0xBC, 0x98, 0x76, 0x0B, 0x1B, 
+0x3B, 0x1B, 0xDA, 0x6B, 0x7D, 0x44, 0x93, 0x41, 
+0x3F, 0xE8, 0xA0, 0x4E, 0x5C, 0x4B, 0x5F, 0x31, 
+0xA9, 0x7A, 0x47, 0xC6, 0x99, 0xA5, 0x26, 0xAF, 
+0x9A, 0x4E, 0xA2, 0x11, 0xB0, 0x5C, 0xEF, 0x78, 
+0x60, 0x47, 0x21, 0xE8, 0xDD, 0xE9, 0xF6, 0x6D, 
+
+0x51, 0x5D, 0x86, 0x87, 0x6F, 0x30, 0x38, 0xF1, 
+0x61, 0x48, 0xCA, 0xF9, 0x2F, 0x24, 0xD1, 0x26, 
+0xF0, 0xF6, 0x23, 0x63, 0x92, 0xDF, 0x50, 0xD0, 
+0xAD, 0x75, 0x4E, 0x3D, 0xC2, 0xA0, 0x11, 0x11, 
+0x3B, 0x62, 0x58, 0x57, 0x52, 0x3E, 0x36, 0x4C, 
+0x0C, 0x5B, 0x18, 0xD5, 0x93, 0x31, 0x90, 0xE6, 
+0x37, 0x37, 0x91, 0xB5, 0xAA, 0x1E, 0x4C, 0x2E, 
+0xF3, 0x7D, 0x2A, 0xC4, 0x54, 0x19, 0x95, 0xB6, 
+0x09, 0x6A, 0xC1, 0x95, 0xC5, 0x81, 0xE5, 0x5C, 
+0xB4, 0x4F, 0x18, 0x2A, 0x60, 0x13, 0xAE, 0xBF, 
+0x46, 0xF9, 0x77, 0x2E, 0x15, 0x1D, 0xB8, 0xCE, 
+0x1B, 0xBA, 0x5E, 0x20, 0x8F, 0x78, 0x0E, 0xF8, 
+0x87, 0x2A, 0xDB, 0x67, 0xCE, 0xF8, 0x24, 0x66, 
+0x1A, 0xD2, 0x6E, 0x37, 0xEC, 0x23, 0xA7, 0x7E, 
+0xD5, 0xB9, 0xB5, 0xC2, 0x8B, 0xAD, 0x1E, 0xB3, 
+0xE2, 0xE6, 0x68, 0x91, 0x86, 0xB3, 0x6A, 0x7A, 
+0xB6, 0x0F, 0x56, 0x5A, 0x59, 0xD9, 0x37, 0x15, 
+0x61, 0xF7, 0x42, 0x30, 0xEB, 0xD2, 0xAD, 0x75, 
+0xD1, 0x86, 0x7E, 0x52, 0x96, 0x1C, 0xF6, 0x78, 
+0x75, 0x13, 0x34, 0x23, 0x08, 0xD0, 0x50, 0x05, 
+0xB2, 0x1F, 0xD6, 0xB0, 0x0E, 0x2D, 0xFC, 0x75, 
+0x51, 0x62, 0x55, 0x15, 0xBA, 0x65, 0x32, 0xA6, 
+0x9A, 0xEE, 0x5A, 0x9E, 0xC6, 0xB4, 0xC5, 0xC8, 
+0x3D, 0xD6, 0x97, 0x19, 0x44, 0xEA, 0x61, 0x1F, 
+0x59, 0xFA, 0x97, 0xE2, 0xAD, 0x12, 0x96, 0x28, 
+0xAC, 0x31, 0xC2, 0x6C, 0x93, 0x03, 0xD9, 0x02, 
+0x3C, 0xDC, 0xB3, 0x5B, 0x9B, 0x49, 0xC5, 0xD0, 
+0xCA, 
+
+0x00, 0xD9, 0xA4, 0x27, 0x62, 0x53, 0x49, 0x38, 
+0x5F, 0x9B, 0x60, 0xEE, 0x92, 0xFC, 0xC0, 0xD0, 
+0x25, 0x5C, 0x01, 0x61, 0xA6, 0xE6, 0x5C, 0xCD, 
+0x29, 0x37, 0xCB, 0xAC, 0xE4, 0x34, 0x05, 0xBF, 
+0x58, 0xBD, 0x9C, 0x4F, 0x48, 0x54, 0xEF, 0x21, 
+0x65, 0x62, 0x9A, 0x5C, 0xF4, 0xC1, 0x64, 0x60, 
+0xA9, 0x41, 0x29, 0x9A, 0x9F, 0x52, 0x56, 0x17, 
+0xF6, 0x3B, 0x05, 0x45, 0x08, 0xA6, 0x83, 0xB2, 
+0x54, 0x8D, 0x88, 0xA7, 0xF4, 0x1B, 0xE0, 0x10, 
+0x82, 0x28, 0x27, 0xE8, 0x35, 0xDC, 0x6D, 0x69, 
+0x4D, 0x05, 0x27, 0x86, 0x19, 0xDA, 0x34, 0xEF, 
+0xC5, 0x4E, 0x4B, 0xA0, 0x32, 0xA4, 0x3E, 0x5B, 
+0x75, 0x46, 0xF4, 0x98, 0x11, 0x44, 0x4A, 0xEA, 
+0x98, 0x32, 0xE6, 0x02, 0x81, 0xD3, 0x28, 0x85, 
+0x92, 0x70, 0x86, 0x6C, 0x8F, 0x4C, 0xCA, 0xB8, 
+0x71, 0x03, 0xA1, 0x93, 0xBA, 0xEF, 0x76, 0x81, 
+0xDC, 0x4F, 0xFD, 0xBF, 0xC0, 0x32, 0xE9, 0xAD, 
+0xC5, 0xAC, 0x50, 0xCC, 0xED, 0x5A, 0x20, 0x32, 
+0xE9, 0xD2, 0x8F, 0x5C, 0x71, 0x93, 0x83, 0x51, 
+0x8B, 0x2F, 0x26, 0x2D, 0x8C, 0x42, 0x04, 0x97, 
+0x7D, 0x2F, 0xC4, 0x41, 0x75, 0x1B, 0x76, 0xF2, 
+0x46, 0xE7, 0x8C, 0x21, 0x4A, 0x4D, 0x54, 0xF4, 
+0x37, 0xD6, 0x8D, 0xE8, 0xB1, 0x3F, 0xD0, 0x84, 
+0xBB, 0x84, 0x59, 0x0B, 0x02, 0xF5, 0x08, 0xD2, 
+0xF3, 0xCA, 0x4B, 0xAB, 0x45, 0x2B, 0x48, 0xE0, 
+0xBD, 0xE4, 0xA3, 0x31, 0x43, 0x49, 0x98, 0xCF, 
+0x6E, 0x3F, 0xA2, 0x31, 0x9D, 0x53, 0x59, 0xED, 
+0xF4, 0x4B, 
+
+0x8E, 0x01, 0xBE, 0xE0, 0x94, 0x90, 0x3F, 0x8D, 
+0x53, 0x96, 0x19, 0xBE, 0x30, 0x16, 0xCA, 0x1D, 
+0x1E, 0xAF, 0xFA, 0xD0, 0xAD, 0x73, 0xBA, 0xBA, 
+0xA9, 0x6F, 0xAE, 0xA6, 0x04, 0xE4, 0x9D, 0x3A, 
+0x8F, 0xA9, 0xFE, 0xDF, 0x37, 0x64, 0x1B, 0x69, 
+0xB1, 0x2B, 0xB8, 0xA7, 0x0E, 0x1B, 0x8D, 0x35, 
+0x1F, 0x8D, 0x05, 0x8B, 0x8C, 0x6D, 0x48, 0xA9, 
+0x75, 0x28, 0x3C, 0x97, 0x72, 0xA1, 0x66, 0xC3, 
+0xB8, 0x2D, 0xC8, 0x6C, 0x11, 0x08, 0x2C, 0x12, 
+0xE0, 0x0B, 0xCD, 0xF6, 0x03, 0x50, 0xBB, 0x31, 
+0x52, 0xE0, 0x5D, 0x76, 0x1F, 0xB5, 0x4C, 0x02, 
+0x68, 0xA1, 0xE3, 0x2F, 0x11, 0xE0, 0x5E, 0x3B, 
+0x5D, 0xD7, 0x23, 0x89, 0x61, 0x00, 0x9C, 0x39, 
+0x47, 0x41, 0xC7, 0x6B, 0xC0, 0x77, 0x46, 0x42, 
+0xD8, 0x2D, 0xA9, 0xEA, 0xF8, 0x33, 0x5F, 0x60, 
+0xE2, 0x53, 0x56, 0x48, 0x1F, 0x2D, 0x2D, 0x79, 
+0x6E, 0xB8, 0xD4, 0xB4, 0x63, 0x70, 0x7D, 0x1B, 
+0xF5, 0xEE, 0x29, 0xB8, 0x89, 0x84, 0xD3, 0x55, 
+0x36, 0x4D, 0xD7, 0x21, 0x6C, 0x8F, 0x51, 0xB3, 
+0x65, 0xE6, 0xA6, 0xD1, 0xE8, 0x0E, 0xA5, 0x9F, 
+0x68, 0x10, 0xC5, 0x67, 0x17, 0x89, 0x4B, 0x57, 
+0x48, 0xE4, 0x7C, 0xBC, 0xE7, 0xDA, 0x05, 0xBD, 
+0x5B, 0x24, 0x33, 0xCC, 0xB0, 0x52, 0x9D, 0xEE, 
+0x11, 0xCF, 0x06, 0xE3, 0xAA, 0x4D, 0xFF, 0x4C, 
+0x66, 0xE5, 0x5B, 0x1C, 0xCD, 0x31, 0xC7, 0xC1, 
+0x56, 0x25, 0x3D, 0xF4, 0xC6, 0xC0, 0x9E, 0x11, 
+0x1D, 0x80, 0x0E, 0x3D, 0x00, 0x58, 0xCC, 0x6E, 
+0x5F, 0x59, 0x2F, 
+
+0xB4, 0x13, 0x3A, 0xCB, 0xF9, 0xD8, 0x8A, 0x10, 
+0xF6, 0x9D, 0x9E, 0xBE, 0x8C, 0x7B, 0xF2, 0x7B, 
+0x4D, 0x38, 0xF6, 0x63, 0xCE, 0xDD, 0xED, 0xA8, 
+0x9B, 0xF7, 0x6D, 0xF9, 0x08, 0x39, 0x9B, 0x22, 
+0xBB, 0x36, 0xA2, 0xA2, 0xEA, 0x2D, 0xBB, 0xCD, 
+0xBE, 0xA1, 0xBC, 0xF6, 0x76, 0x35, 0x5D, 0x17, 
+0x58, 0xBC, 0x44, 0x0C, 0xBB, 0xFA, 0xBC, 0x3E, 
+0xCA, 0xA0, 0x3A, 0xD4, 0xAC, 0x09, 0xBD, 0x4C, 
+0x8E, 0xC8, 0x8A, 0xB9, 0x21, 0xC4, 0x04, 0xBF, 
+0xCB, 0x1E, 0xD1, 0xEE, 0x46, 0xD1, 0xD5, 0xCB, 
+0xF7, 0xAA, 0x2B, 0xF8, 0x5C, 0x10, 0x5D, 0x85, 
+0xDB, 0xC0, 0x69, 0x77, 0xE8, 0x5F, 0x44, 0x5A, 
+0xEE, 0xF3, 0x23, 0xC4, 0x21, 0x9F, 0xAD, 0xD7, 
+0x62, 0xA6, 0x61, 0xC1, 0xE6, 0xBE, 0x10, 0xFD, 
+0xD0, 0x30, 0x5D, 0x3F, 0xA4, 0x88, 0x2A, 0x8F, 
+0xED, 0xCB, 0x06, 0x28, 0x5B, 0x9B, 0x6C, 0xC2, 
+0x22, 0x9E, 0xB5, 0xCC, 0x8A, 0x7B, 0xA5, 0x77, 
+0x8E, 0xBB, 0x37, 0x62, 0xF8, 0x4B, 0x9C, 0x97, 
+0xB6, 0xA0, 0x76, 0xFC, 0x18, 0x54, 0x38, 0x30, 
+0x13, 0x18, 0x01, 0x72, 0x1B, 0xA7, 0xB9, 0xC5, 
+0x34, 0xB6, 0xAE, 0x7F, 0xFD, 0xF1, 0x42, 0xFD, 
+0x0B, 0x16, 0x44, 0xAE, 0xC1, 0x3B, 0x3D, 0x9F, 
+0xB3, 0xA0, 0xA3, 0x0D, 0x4E, 0xEB, 0x8A, 0x78, 
+0x30, 0xFA, 0xB1, 0x4F, 0x40, 0x26, 0x47, 0xB1, 
+0x0B, 0x3B, 0x01, 0xB9, 0x12, 0x7A, 0x8A, 0x98, 
+0xEA, 0x6F, 0x0D, 0x2F, 0x3A, 0x25, 0xB0, 0xC0, 
+0x5D, 0x0C, 0x91, 0xDD, 0xA4, 0x5E, 0xF7, 0x1D, 
+0x70, 0x23, 0xFF, 0x0E, 
+
+0xA0, 0x1B, 0x42, 0xB8, 0xE9, 0xD6, 0xA3, 0x54, 
+0xCF, 0xB6, 0x5D, 0x2E, 0xFA, 0x43, 0xB7, 0x10, 
+0x95, 0xC4, 0x5D, 0x12, 0xE7, 0x10, 0x4D, 0xAC, 
+0xC6, 0x6D, 0xDB, 0x54, 0x82, 0xAB, 0xAF, 0xDB, 
+0x6A, 0x65, 0xCC, 0xFC, 0xB1, 0x74, 0xD8, 0xD5, 
+0x7A, 0x29, 0xCD, 0x9D, 0xED, 0xAB, 0x35, 0x94, 
+0x00, 0x9C, 0xB3, 0xD4, 0x9A, 0x49, 0x31, 0x7F, 
+0xD0, 0x38, 0xB5, 0x36, 0x82, 0x9F, 0x18, 0x36, 
+0x63, 0x10, 0xDA, 0x46, 0x6D, 0x85, 0x65, 0xE0, 
+0x57, 0x40, 0xFD, 0x15, 0x0C, 0x2E, 0xD4, 0x5D, 
+0x58, 0x6C, 0x45, 0x1C, 0x0C, 0x13, 0x13, 0x5B, 
+0x5E, 0x92, 0xBD, 0x37, 0xF1, 0xC9, 0xF0, 0x3A, 
+0xD2, 0x3C, 0x92, 0x26, 0xD0, 0x75, 0x14, 0x2D, 
+0xF5, 0x7E, 0xCA, 0x70, 0x77, 0x26, 0x58, 0xEF, 
+0x6D, 0x9B, 0x53, 0x55, 0x10, 0x78, 0x88, 0xA1, 
+0x05, 0xB6, 0x8B, 0x53, 0xB6, 0xCF, 0x82, 0x09, 
+0x34, 0x02, 0xF2, 0xC1, 0x1E, 0xC9, 0x8A, 0xDE, 
+0xB8, 0xF5, 0x5B, 0x70, 0x1C, 0x2A, 0x5B, 0x02, 
+0xC2, 0xB1, 0xD1, 0x07, 0x7C, 0x33, 0x36, 0x98, 
+0xC2, 0xD4, 0x34, 0x7E, 0x63, 0x95, 0x8C, 0xC1, 
+0x8F, 0x19, 0xCB, 0x79, 0xA1, 0x17, 0xF1, 0x07, 
+0xB9, 0xDC, 0xBE, 0xB4, 0xBB, 0x08, 0xC1, 0xB8, 
+0xD7, 0x85, 0x94, 0xE5, 0x80, 0x2F, 0x20, 0xF3, 
+0xF6, 0xFF, 0x39, 0x7D, 0x36, 0xD6, 0x3C, 0x3C, 
+0xB7, 0x2A, 0xA4, 0xCB, 0xF2, 0x76, 0x61, 0xDD, 
+0x46, 0x1A, 0xBB, 0x63, 0xCF, 0x06, 0x05, 0x52, 
+0x36, 0x2C, 0xE5, 0x1F, 0x6D, 0xFC, 0xF7, 0x5C, 
+0xE8, 0xA4, 0x6C, 0x9C, 0xB8, 
+
+0xA6, 0xCD, 0x31, 0xAB, 0xB5, 0xB7, 0x06, 0x91, 
+0x33, 0x96, 0x70, 0xD5, 0x3F, 0x2D, 0x1F, 0x69, 
+0x1D, 0x7F, 0xE7, 0x76, 0x60, 0x30, 0x6F, 0x1D, 
+0x1C, 0x2A, 0x11, 0x4D, 0x25, 0xA7, 0x93, 0xA8, 
+0x05, 0x42, 0x79, 0x24, 0x6F, 0x54, 0xCF, 0xE6, 
+0x2A, 0x4C, 0x04, 0x2B, 0xE7, 0xA1, 0xC9, 0x95, 
+0xB8, 0x2C, 0x13, 0xD1, 0xE9, 0xCB, 0x18, 0x04, 
+0xA3, 0x72, 0x78, 0xA1, 0x91, 0xEC, 0x3D, 0x66, 
+0x35, 0x44, 0x3F, 0x3B, 0x2C, 0x57, 0xAC, 0xD7, 
+0x92, 0x7A, 0xB6, 0x83, 0x24, 0x19, 0xAC, 0xBF, 
+0x41, 0x86, 0x18, 0xE7, 0x07, 0xB1, 0x00, 0x6C, 
+0xC9, 0xF4, 0x73, 0xC4, 0x64, 0xC2, 0xE6, 0xC6, 
+0x0A, 0xCD, 0x88, 0xF0, 0x2E, 0xE8, 0x9D, 0x63, 
+0x3D, 0x2C, 0x65, 0x13, 0x53, 0xBA, 0xC5, 0xE6, 
+0xB3, 0xFE, 0xA9, 0xA9, 0xA1, 0xC8, 0x7B, 0x43, 
+0xA5, 0x94, 0xAD, 0x5D, 0xBF, 0xCC, 0x83, 0x8D, 
+0x16, 0x89, 0x80, 0xE6, 0xBA, 0x24, 0x97, 0x15, 
+0xA1, 0xB9, 0x75, 0xD9, 0xF5, 0x6D, 0x3D, 0x07, 
+0xC5, 0x5E, 0xC0, 0xED, 0x4B, 0x2D, 0x76, 0x7E, 
+0x68, 0xF4, 0x93, 0xEA, 0x44, 0xBA, 0x7E, 0xA4, 
+0xC1, 0x9B, 0xF1, 0x10, 0xAA, 0xFF, 0xAF, 0xF0, 
+0x5A, 0xC8, 0xEC, 0x3A, 0xC6, 0x49, 0x24, 0xEA, 
+0x71, 0xA8, 0x8D, 0x5E, 0xB0, 0x0D, 0x7D, 0x8D, 
+0x67, 0xBF, 0x95, 0x85, 0x2B, 0xE0, 0x09, 0x64, 
+0xC8, 0xE6, 0xBA, 0xBB, 0x7F, 0x6F, 0xE3, 0xF3, 
+0xB0, 0x1C, 0x62, 0xCB, 0x4B, 0xF9, 0x27, 0xC9, 
+0x63, 0x4C, 0xA3, 0xB5, 0xFA, 0x88, 0x19, 0x53, 
+0x70, 0xC5, 0x35, 0xBB, 0x15, 0x82, 
+
+0xDE, 0x20, 0x24, 0xC3, 0x3D, 0x65, 0x26, 0x51, 
+0x86, 0x98, 0xE9, 0x2D, 0x7B, 0x34, 0xAA, 0x09, 
+0xFE, 0xB2, 0x95, 0x34, 0x7B, 0x36, 0x86, 0x54, 
+0x86, 0x1B, 0x6E, 0xC2, 0xB2, 0x0D, 0x36, 0x69, 
+0x6C, 0x8A, 0xC4, 0x99, 0x42, 0xA0, 0x88, 0x07, 
+0xBA, 0x08, 0xCA, 0xB6, 0xD3, 0xA5, 0x33, 0x27, 
+0x28, 0x81, 0x0E, 0x60, 0x0E, 0xA8, 0x51, 0x6C, 
+0xBB, 0x33, 0x3A, 0x95, 0x5A, 0xC7, 0x37, 0x72, 
+0xD1, 0x9E, 0xE8, 0x45, 0x28, 0xF2, 0xC7, 0x5E, 
+0xB2, 0x10, 0x6A, 0x3F, 0x09, 0xB2, 0x0F, 0x1E, 
+0x01, 0x74, 0x82, 0x8D, 0x08, 0x08, 0x68, 0x3E, 
+0x8A, 0xF7, 0xD2, 0x57, 0x82, 0x63, 0xD0, 0x0B, 
+0xB8, 0xBF, 0x49, 0x0D, 0x27, 0x17, 0x73, 0x2B, 
+0xE0, 0x82, 0xBF, 0xCC, 0x32, 0xE5, 0xF9, 0xC2, 
+0xBD, 0x26, 0x8C, 0xDB, 0x67, 0xD7, 0x9D, 0x98, 
+0x59, 0x2E, 0x87, 0x7F, 0x62, 0x31, 0xFB, 0xB7, 
+0x91, 0x3C, 0xA7, 0x55, 0x46, 0xD0, 0xB6, 0xBD, 
+0xE2, 0x2B, 0x3B, 0x41, 0x3F, 0x5C, 0x88, 0xB8, 
+0xD8, 0xE3, 0x6E, 0x9D, 0x8C, 0x55, 0x8F, 0x8B, 
+0x9E, 0xF5, 0x7A, 0x23, 0x26, 0xF1, 0x76, 0x06, 
+0xFE, 0x14, 0x17, 0x71, 0x5D, 0xCB, 0xBC, 0x6C, 
+0xAC, 0xF9, 0x65, 0xA4, 0xC1, 0x20, 0x11, 0xC3, 
+0xBD, 0x28, 0x6D, 0x58, 0x53, 0x2A, 0x11, 0xFE, 
+0x51, 0x3E, 0x01, 0x02, 0xE6, 0x2D, 0x35, 0xA5, 
+0x3F, 0xDE, 0x63, 0xC0, 0x15, 0xDB, 0xC8, 0xD3, 
+0x55, 0x1F, 0xE8, 0xF5, 0xE4, 0xE5, 0x65, 0x5B, 
+0xB6, 0xB7, 0x51, 0x47, 0xFF, 0x92, 0x99, 0x35, 
+0x13, 0x4C, 0x1C, 0x48, 0x04, 0xD3, 0x70, 
+
+0x82, 0xCE, 0x0C, 0x9C, 0x6A, 0xE4, 0xE3, 0x78, 
+0x63, 0xDB, 0xBB, 0x04, 0x59, 0xAA, 0xE3, 0x6C, 
+0x4E, 0x7E, 0x2B, 0xEE, 0x49, 0x04, 0x14, 0x25, 
+0xBB, 0x56, 0xB0, 0x32, 0x1E, 0x1E, 0xE3, 0x30, 
+0xDC, 0x35, 0x13, 0xFE, 0xB7, 0x3E, 0xCF, 0xD1, 
+0x92, 0x74, 0x1C, 0xEF, 0x17, 0xDB, 0x9F, 0x11, 
+0x05, 0x75, 0x71, 0x41, 0xED, 0x57, 0x37, 0x47, 
+0x95, 0x50, 0xE7, 0x3C, 0x3D, 0x66, 0xEA, 0x1D, 
+0x68, 0x55, 0x57, 0x63, 0xAE, 0x59, 0x3C, 0xE8, 
+0x3D, 0x41, 0x59, 0x01, 0xEC, 0xDD, 0xFF, 0x98, 
+0x52, 0x01, 0x48, 0x05, 0x6D, 0x06, 0x77, 0xEE, 
+0xF1, 0x14, 0x39, 0x82, 0x57, 0x58, 0xA6, 0x41, 
+0x00, 0x61, 0xCE, 0x88, 0x76, 0x7B, 0x0A, 0xBD, 
+0xEC, 0xB9, 0xD3, 0x17, 0xA9, 0x44, 0x31, 0x82, 
+0xF8, 0xAB, 0xE0, 0x72, 0xE5, 0x52, 0x8D, 0x7F, 
+0xFD, 0x0A, 0x4B, 0x80, 0xD4, 0x45, 0x3B, 0x36, 
+0x0E, 0x7B, 0x7C, 0x16, 0xB1, 0xF5, 0x9D, 0xDA, 
+0x81, 0xF2, 0x7A, 0x5A, 0xEE, 0xF5, 0x9A, 0xD0, 
+0x71, 0x78, 0xCA, 0x66, 0x15, 0x3B, 0xB3, 0x03, 
+0x96, 0x47, 0x70, 0xB8, 0x70, 0x34, 0x22, 0x49, 
+0x6D, 0xCD, 0x0B, 0x5E, 0x47, 0xC4, 0x7E, 0xC5, 
+0xB2, 0xAE, 0x4E, 0xCB, 0xFB, 0x60, 0x2C, 0x9B, 
+0xDC, 0x2C, 0xE7, 0x1C, 0xBB, 0x29, 0xA4, 0x65, 
+0x79, 0x86, 0x94, 0xF9, 0x82, 0x5F, 0x6C, 0xAC, 
+0x4D, 0x8D, 0x44, 0xE6, 0x60, 0x90, 0x59, 0x53, 
+0xA7, 0xC1, 0x14, 0xBF, 0x90, 0xD9, 0x21, 0x9B, 
+0x2B, 0x8F, 0x8D, 0xEA, 0x3E, 0xA1, 0xB8, 0x74, 
+0xFC, 0x5E, 0x91, 0x69, 0xE5, 0x16, 0x93, 0x11, 
+
+0x9E, 0xB1, 0xF8, 0x3F, 0x59, 0x89, 0xBE, 0xBD, 
+0x18, 0x00, 0x48, 0xF7, 0x27, 0x20, 0x2D, 0x79, 
+0x45, 0xCE, 0x3B, 0x44, 0x74, 0x20, 0xE8, 0xE5, 
+0xD9, 0x99, 0x76, 0xAC, 0xBE, 0x72, 0xF4, 0xB0, 
+0x21, 0xA9, 0xA1, 0xB4, 0xBC, 0x04, 0x6C, 0x83, 
+0x68, 0x3B, 0xBF, 0xCF, 0x7C, 0x8C, 0xC0, 0x8E, 
+0x93, 0x37, 0x15, 0xD2, 0xB6, 0x60, 0xF5, 0x64, 
+0x60, 0xDD, 0x2F, 0xA5, 0xCD, 0x9F, 0xC5, 0x01, 
+0x3E, 0x1D, 0x14, 0xA1, 0xBF, 0xB4, 0x13, 0x4E, 
+0x6B, 0xF8, 0x20, 0x94, 0xA1, 0x75, 0x2A, 0x76, 
+0x2C, 0x20, 0x38, 0xBC, 0xCD, 0x44, 0xCF, 0x44, 
+0xC4, 0xAB, 0xE2, 0x34, 0x65, 0x67, 0x5F, 0x96, 
+0x48, 0x1D, 0x52, 0x27, 0x74, 0xED, 0xFD, 0x4E, 
+0x1C, 0xF3, 0x3C, 0xE3, 0x68, 0x44, 0xB8, 0x10, 
+0x6D, 0xC4, 0x8C, 0x65, 0x94, 0xDA, 0xB6, 0x64, 
+0x18, 0xAB, 0xEF, 0x01, 0xE1, 0xAB, 0x1E, 0x35, 
+0xD8, 0xD9, 0xD4, 0xFD, 0xCA, 0xDD, 0x28, 0xD8, 
+0x37, 0x9F, 0x2C, 0xFA, 0x58, 0xF1, 0x6E, 0x1B, 
+0x73, 0xCD, 0x14, 0x77, 0x44, 0xB3, 0x72, 0x90, 
+0x14, 0x25, 0x16, 0xA1, 0x8D, 0x11, 0x0B, 0x80, 
+0x72, 0x6E, 0xEF, 0x52, 0xB8, 0x69, 0x1F, 0x0F, 
+0x15, 0xEA, 0x6E, 0xC2, 0x32, 0x24, 0xED, 0x02, 
+0xDF, 0xDF, 0xFE, 0xAF, 0xC7, 0x85, 0x3F, 0x61, 
+0x12, 0xDC, 0xA8, 0x4D, 0xDE, 0xF0, 0xF8, 0x68, 
+0x3C, 0x34, 0x96, 0xCD, 0x53, 0x43, 0x2C, 0x55, 
+0xF5, 0xED, 0xF5, 0x7D, 0x3E, 0x1C, 0x33, 0x78, 
+0x08, 0xED, 0x08, 0xE7, 0xBB, 0x29, 0x43, 0x2A, 
+0x18, 0x51, 0x35, 0xCC, 0xF1, 0xCF, 0x78, 0xCA, 
+0xBA, 
+
+0x8F, 0xC9, 0x49, 0xFF, 0x86, 0xAB, 0x9A, 0x35, 
+0xF2, 0x69, 0xEA, 0x1A, 0xA4, 0xA1, 0xEC, 0x6B, 
+0x8C, 0xD9, 0xB2, 0x85, 0x66, 0x77, 0x47, 0x99, 
+0x93, 0xE1, 0x42, 0x78, 0x0B, 0xE5, 0xD0, 0x28, 
+0x28, 0x4B, 0x69, 0x2C, 0x70, 0xFC, 0xBA, 0xAC, 
+0xAE, 0x8E, 0x68, 0xF2, 0xF4, 0x10, 0xF3, 0x9E, 
+0xCD, 0x60, 0x23, 0x77, 0x45, 0x21, 0xF2, 0x9A, 
+0xF1, 0x82, 0x9B, 0x14, 0x8E, 0x47, 0x8E, 0x46, 
+0x52, 0x87, 0xCC, 0x6C, 0xFE, 0x00, 0x98, 0xA6, 
+0x71, 0xC5, 0x39, 0xA2, 0xD2, 0x0D, 0x30, 0x90, 
+0x91, 0x66, 0x5C, 0x9C, 0x5A, 0x56, 0x3E, 0x79, 
+0x2D, 0x9D, 0x64, 0x4D, 0x27, 0x04, 0x12, 0x62, 
+0x26, 0x6E, 0x9F, 0x94, 0x0E, 0x6E, 0xAF, 0x6B, 
+0x59, 0x53, 0xE2, 0x09, 0x31, 0xCF, 0x97, 0x93, 
+0xFA, 0x0F, 0xD1, 0x4D, 0xD0, 0x39, 0xFF, 0x6A, 
+0x36, 0x58, 0xF2, 0x8A, 0xAD, 0xFD, 0xD2, 0x96, 
+0x16, 0xC3, 0x95, 0xBB, 0xD4, 0x1A, 0x28, 0xDE, 
+0x71, 0x5A, 0x0E, 0x82, 0xBE, 0x27, 0x16, 0xA9, 
+0xAF, 0xAE, 0xAE, 0x64, 0xBC, 0x2E, 0xA8, 0xBE, 
+0xD7, 0xD5, 0xE0, 0xB6, 0xFC, 0x85, 0xBF, 0xC3, 
+0x4A, 0x66, 0xBC, 0x82, 0xB9, 0x3D, 0x9E, 0x3C, 
+0x57, 0x0D, 0xAF, 0x79, 0x9C, 0xAA, 0xE3, 0x5A, 
+0x39, 0x58, 0x28, 0xB6, 0xDC, 0x59, 0x06, 0xBD, 
+0x15, 0x20, 0xCD, 0x81, 0xB0, 0x52, 0x24, 0x2B, 
+0xB2, 0xC7, 0xC1, 0x28, 0xCC, 0xA9, 0xBD, 0x88, 
+0x81, 0x40, 0xB3, 0xFC, 0x1D, 0x17, 0xE3, 0xB5, 
+0x7E, 0x94, 0x52, 0x76, 0xC8, 0x84, 0x99, 0x0D, 
+0x2C, 0x24, 0x16, 0xEE, 0xC6, 0xE1, 0x58, 0x32, 
+0x44, 0x52, 
+
+0x0A, 0x41, 0xCB, 0xFD, 0x6E, 0xB6, 0x97, 0xE0, 
+0x64, 0x08, 0xFA, 0xF8, 0x5D, 0x1D, 0x8B, 0xB1, 
+0x65, 0xE7, 0x12, 0xFB, 0x73, 0xC8, 0xB7, 0x41, 
+0xE1, 0xED, 0x51, 0x34, 0x76, 0x58, 0x91, 0xD7, 
+0xA5, 0x49, 0xFB, 0x9C, 0x90, 0x8F, 0xC5, 0xFF, 
+0xE7, 0xE5, 0xD3, 0x88, 0xA9, 0x54, 0x58, 0xDD, 
+0x79, 0x61, 0xD2, 0xD1, 0x2F, 0x61, 0x0F, 0xED, 
+0xC2, 0xDD, 0x77, 0x1A, 0x31, 0xAF, 0x42, 0xB2, 
+0x00, 0x24, 0x38, 0x2B, 0xE0, 0x8C, 0xAB, 0xA3, 
+0xF1, 0x25, 0x22, 0xAA, 0xC4, 0xCF, 0x21, 0xAE, 
+0xFF, 0xFB, 0xDE, 0x48, 0xD3, 0x73, 0x22, 0xE6, 
+0xA1, 0xE1, 0x10, 0xED, 0x2F, 0x37, 0x97, 0x0C, 
+0xF8, 0x66, 0xE7, 0x98, 0xE2, 0x8C, 0xBD, 0x66, 
+0x62, 0x87, 0x61, 0x72, 0xD7, 0xF5, 0xE5, 0x5F, 
+0x75, 0xAB, 0x8A, 0x78, 0x1D, 0xC3, 0xAF, 0xB2, 
+0xD4, 0x67, 0x6D, 0x2C, 0x97, 0x0D, 0x93, 0xD1, 
+0xBD, 0x70, 0xC8, 0xD4, 0x0C, 0xE2, 0x3D, 0x8E, 
+0x98, 0x55, 0x26, 0xF3, 0x6F, 0x13, 0x0D, 0xC4, 
+0x7B, 0x5E, 0xFE, 0xC0, 0x96, 0x1B, 0x6A, 0xFB, 
+0x61, 0x15, 0x40, 0x2F, 0x68, 0x70, 0xFC, 0xAF, 
+0x04, 0x7B, 0x91, 0x7F, 0x74, 0x21, 0x7A, 0xF1, 
+0x02, 0xB9, 0x5D, 0x4D, 0x9C, 0x97, 0xB0, 0x03, 
+0x18, 0x12, 0x9B, 0x21, 0x82, 0xC1, 0x00, 0x3C, 
+0x4C, 0xA6, 0x2D, 0x05, 0xF0, 0x29, 0xB1, 0x29, 
+0xF2, 0xD6, 0x0C, 0xE6, 0x0A, 0x05, 0xA9, 0x09, 
+0xFA, 0x07, 0xF5, 0x89, 0xE5, 0xC8, 0x49, 0xE2, 
+0xED, 0x03, 0x23, 0x8D, 0xA0, 0xB3, 0x1A, 0x35, 
+0xCE, 0x6F, 0x5A, 0xC7, 0x68, 0x19, 0xFB, 0xD0, 
+0xDD, 0x56, 0x20, 
+
+0x80, 0xDD, 0xB4, 0x3F, 0x85, 0x45, 0x61, 0xEA, 
+0x91, 0x38, 0xB0, 0x3E, 0x55, 0xA9, 0xF9, 0x66, 
+0xFC, 0x83, 0x0A, 0x48, 0x10, 0x04, 0xB5, 0x42, 
+0xFA, 0xEE, 0xEE, 0x55, 0x3D, 0xE9, 0x94, 0x5F, 
+0x41, 0x7B, 0x23, 0xDE, 0x8E, 0x0E, 0xDB, 0xD5, 
+0x81, 0x21, 0x6D, 0x9E, 0x5F, 0x02, 0xAA, 0xF2, 
+0x0B, 0x9B, 0x97, 0x28, 0x83, 0x4A, 0x7D, 0x38, 
+0xBC, 0x9F, 0x05, 0x5A, 0x3E, 0x6B, 0x62, 0xDB, 
+0xA4, 0x53, 0xEE, 0xA0, 0xC6, 0x41, 0x40, 0xA1, 
+0xCA, 0x2A, 0x78, 0x9B, 0x73, 0x23, 0xCC, 0x57, 
+0x9E, 0xD7, 0xC3, 0x9B, 0x6D, 0x08, 0x92, 0x97, 
+0xA5, 0x6B, 0xD8, 0x9C, 0x8D, 0x45, 0x07, 0x63, 
+0x33, 0x30, 0x1E, 0x1C, 0xAF, 0x77, 0xEC, 0xD6, 
+0xEA, 0x0D, 0xF3, 0x48, 0x4E, 0x72, 0x8B, 0x98, 
+0x1C, 0x94, 0x6B, 0x6A, 0x2D, 0x2E, 0x82, 0x7F, 
+0x5A, 0x24, 0x43, 0x8A, 0x1B, 0x91, 0x93, 0x3F, 
+0x46, 0xE0, 0x86, 0x7A, 0x26, 0x52, 0xE1, 0xDA, 
+0x0D, 0xF2, 0xD6, 0xAC, 0xE9, 0x9A, 0xED, 0xF4, 
+0x17, 0xBB, 0x8F, 0x91, 0xAC, 0x34, 0xD0, 0x56, 
+0xF8, 0x18, 0xC4, 0xD8, 0x5B, 0x12, 0x4D, 0x3A, 
+0x22, 0x70, 0x0F, 0x0B, 0xFA, 0xB7, 0x29, 0x22, 
+0xE4, 0x27, 0x00, 0xE8, 0x8C, 0xCE, 0xCB, 0xA5, 
+0x94, 0x4F, 0xD6, 0x59, 0x3F, 0x05, 0xC1, 0xE5, 
+0x67, 0x6A, 0xF4, 0xF3, 0x35, 0x85, 0xBF, 0x0E, 
+0xEC, 0x86, 0x9F, 0x1A, 0x9F, 0x19, 0x6D, 0x36, 
+0x31, 0x36, 0x38, 0xF1, 0xED, 0x07, 0x98, 0x1B, 
+0x45, 0x69, 0xEA, 0xF1, 0xD1, 0x84, 0xCF, 0x9D, 
+0x8C, 0xE1, 0xF1, 0x3A, 0x8D, 0x15, 0x49, 0xD2, 
+0x8F, 0x45, 0xDD, 0x8F, 
+
+0x6A, 0x8C, 0xCD, 0x44, 0x70, 0x28, 0x32, 0x73, 
+0x32, 0x36, 0x4D, 0x8B, 0xB8, 0x54, 0x59, 0x08, 
+0x1D, 0x21, 0xF3, 0xA7, 0x3A, 0xED, 0x58, 0xF8, 
+0x03, 0x95, 0x01, 0x6A, 0x31, 0xED, 0x56, 0x23, 
+0xAD, 0x51, 0x05, 0x87, 0xBC, 0x5A, 0x5C, 0x93, 
+0x89, 0x0B, 0xC3, 0x25, 0xBB, 0xB6, 0x5C, 0x36, 
+0x0F, 0x09, 0x64, 0x6A, 0x45, 0x4C, 0xE3, 0xEE, 
+0x39, 0xE8, 0xE4, 0x34, 0x80, 0x8A, 0xAD, 0x97, 
+0x58, 0x4E, 0x59, 0xCE, 0x06, 0x66, 0xE6, 0xAC, 
+0x1E, 0xB5, 0xBF, 0x88, 0xA6, 0x0A, 0x3E, 0x7B, 
+0x9B, 0xBD, 0x48, 0x5B, 0x9F, 0x21, 0xE6, 0xDF, 
+0x82, 0x22, 0xE2, 0xFF, 0x18, 0x4E, 0x86, 0x4F, 
+0x93, 0xE9, 0x63, 0xA3, 0x4C, 0x3A, 0x90, 0x17, 
+0xA7, 0xFB, 0x2E, 0x94, 0x3A, 0xBB, 0xD9, 0xFF, 
+0xEE, 0x11, 0x2E, 0x02, 0x4A, 0x6B, 0x5B, 0xFD, 
+0x0D, 0xE4, 0xCF, 0xFC, 0x45, 0x0B, 0x7F, 0xEC, 
+0x68, 0xD7, 0xE7, 0xCB, 0x9A, 0x0A, 0x2C, 0x4F, 
+0x95, 0x7A, 0xB2, 0x6B, 0x55, 0x42, 0x0D, 0x03, 
+0x00, 0xAC, 0x70, 0xFF, 0xA0, 0x9B, 0xFA, 0x88, 
+0x01, 0x16, 0x5F, 0x4E, 0xFA, 0x07, 0x64, 0x63, 
+0x56, 0xD6, 0x84, 0xE7, 0x6A, 0x4E, 0x54, 0xF9, 
+0xDF, 0x45, 0x00, 0xDF, 0x4C, 0x47, 0x6C, 0x63, 
+0x7E, 0x28, 0xF0, 0x29, 0x20, 0x02, 0x16, 0xDF, 
+0x04, 0x5D, 0xCD, 0x01, 0xB7, 0x2A, 0x81, 0x35, 
+0x23, 0xEF, 0x6A, 0xBD, 0xF9, 0x78, 0x8F, 0x03, 
+0x1E, 0x13, 0x20, 0xCC, 0x4B, 0x77, 0xC2, 0xFD, 
+0x6D, 0xEB, 0xE0, 0x53, 0x80, 0xB1, 0xFD, 0x50, 
+0x89, 0x23, 0x90, 0xBF, 0x7A, 0xBE, 0x9D, 0xE6, 
+0xB7, 0xA2, 0xA7, 0x80, 0x90, 
+
+0xE7, 0x3D, 0xA5, 0xCE, 0x1E, 0xFC, 0x50, 0x72, 
+0x08, 0x51, 0x30, 0xF6, 0x56, 0x13, 0x54, 0x78, 
+0xF7, 0x7A, 0x63, 0x02, 0x22, 0xD2, 0x10, 0x2D, 
+0xC2, 0x49, 0xF2, 0xFD, 0x5A, 0xFD, 0x17, 0xFD, 
+0x5D, 0xB6, 0xF8, 0x0A, 0x62, 0x6D, 0x9D, 0x04, 
+0x63, 0x2A, 0x43, 0xE6, 0x72, 0x73, 0x7A, 0xFA, 
+0x70, 0x90, 0x30, 0x10, 0x5E, 0xD4, 0x69, 0x76, 
+0x28, 0x9B, 0xAF, 0x7B, 0x70, 0x11, 0xEE, 0xB5, 
+0xA9, 0xA2, 0x50, 0xCA, 0x14, 0x1F, 0xD4, 0xF7, 
+0xF5, 0xF0, 0x8B, 0x95, 0x4C, 0x30, 0x47, 0x1C, 
+0xA4, 0xD4, 0xC1, 0xE8, 0x01, 0xBA, 0x86, 0x57, 
+0xE3, 0xB4, 0x7F, 0x82, 0x8B, 0x55, 0xF3, 0xD2, 
+0x44, 0x99, 0x0F, 0x16, 0x43, 0xE9, 0x00, 0x6A, 
+0xA0, 0x4D, 0x71, 0x6A, 0xC4, 0xA0, 0x79, 0x0E, 
+0x16, 0x20, 0x4A, 0x69, 0xFA, 0xE7, 0x91, 0x67, 
+0xA8, 0x0D, 0x5E, 0x05, 0x65, 0x78, 0xDD, 0x6B, 
+0x76, 0x5A, 0x30, 0x1F, 0x91, 0xD7, 0x71, 0xB6, 
+0x86, 0xF8, 0xA1, 0xDC, 0xF1, 0x92, 0xA0, 0xC6, 
+0x8F, 0x8E, 0xD1, 0xC1, 0x5E, 0x98, 0xDD, 0x1F, 
+0xA4, 0x6C, 0x11, 0xCB, 0x68, 0xF8, 0x74, 0x82, 
+0x3E, 0x00, 0xB9, 0xCC, 0x22, 0x1A, 0xE5, 0x88, 
+0x1D, 0xCE, 0xB7, 0x9B, 0x68, 0xA0, 0x5D, 0xDD, 
+0x7F, 0xDD, 0x05, 0x72, 0xD5, 0x47, 0x2B, 0x5D, 
+0x99, 0xB5, 0xFD, 0x1A, 0x58, 0x92, 0x21, 0xF3, 
+0xC3, 0x01, 0x9F, 0xED, 0x0A, 0x84, 0xC4, 0x0E, 
+0xF0, 0x35, 0x62, 0xAA, 0xE5, 0x71, 0x88, 0x6D, 
+0x0E, 0x8B, 0xBE, 0x7C, 0xF7, 0x24, 0x6F, 0xAB, 
+0xAC, 0xE8, 0x3D, 0x34, 0x9A, 0x47, 0x35, 0xF4, 
+0xD4, 0xD3, 0xA6, 0xC0, 0x59, 0x8D, 
+
+0x3E, 0xF0, 0xDF, 0x6F, 0x3D, 0x39, 0x1E, 0xE5, 
+0x8D, 0xA3, 0x80, 0x7C, 0xEA, 0xBA, 0xB8, 0x10, 
+0x01, 0xCA, 0x1D, 0xB5, 0xA3, 0x7C, 0xA4, 0x74, 
+0x8C, 0xE9, 0x65, 0x94, 0x26, 0xE7, 0xE7, 0x4E, 
+0x22, 0x75, 0xDF, 0x62, 0x34, 0x92, 0x35, 0x72, 
+0xA6, 0x9D, 0x41, 0x9E, 0x03, 0xCA, 0x34, 0x93, 
+0x69, 0x4B, 0xCF, 0xF5, 0x50, 0xAC, 0xCF, 0x91, 
+0x9B, 0xF8, 0xB2, 0xF6, 0x0A, 0xD6, 0x4E, 0xC5, 
+0xF0, 0x5B, 0xBD, 0x40, 0xB1, 0x7D, 0x2A, 0xD8, 
+0x27, 0x1E, 0xD0, 0xB5, 0x5C, 0x59, 0x23, 0xF9, 
+0xC3, 0x3F, 0x2D, 0x7F, 0xE6, 0xFD, 0xB0, 0xA7, 
+0x04, 0xAB, 0xEC, 0xB4, 0xFC, 0xA1, 0xFF, 0xF1, 
+0xCB, 0x3D, 0x5E, 0x3A, 0x96, 0xDF, 0xEE, 0x63, 
+0xF9, 0xEC, 0x91, 0x4B, 0x55, 0x11, 0x02, 0x28, 
+0x51, 0x5F, 0x2F, 0x7F, 0xF3, 0xAC, 0xCE, 0xFA, 
+0xDE, 0xB4, 0xBB, 0x69, 0x60, 0xBD, 0x65, 0x1C, 
+0xA7, 0x03, 0xDA, 0xB5, 0x6A, 0xAD, 0x91, 0x94, 
+0x0B, 0xDB, 0x85, 0xFE, 0xCF, 0xC1, 0xFC, 0x74, 
+0x37, 0x7E, 0x6A, 0x11, 0xE1, 0x3A, 0x4C, 0x0B, 
+0xAC, 0x3B, 0x85, 0x36, 0x2D, 0x56, 0x6F, 0x59, 
+0xD9, 0xAF, 0x8B, 0xC5, 0xD2, 0xBC, 0x01, 0x02, 
+0x47, 0xA4, 0xC8, 0x13, 0x5A, 0x08, 0x3D, 0x5D, 
+0x10, 0x69, 0x1F, 0xC7, 0x57, 0xA2, 0xE3, 0x3D, 
+0x20, 0x6A, 0xFD, 0xBE, 0xC6, 0x46, 0x83, 0x40, 
+0xE8, 0x4C, 0xFA, 0x03, 0x1D, 0x3A, 0x11, 0x6E, 
+0xED, 0xAF, 0x20, 0xFC, 0xE3, 0xA3, 0x4D, 0xFB, 
+0xF7, 0x92, 0xC3, 0x32, 0xE4, 0xDD, 0x58, 0xA7, 
+0x67, 0xBB, 0xC2, 0x73, 0x40, 0xC4, 0xE3, 0x3D, 
+0x47, 0xB4, 0x80, 0x9F, 0x6B, 0x74, 0x5F, 
+
+0x9B, 0x3A, 0xCA, 0xA1, 0xB8, 0xC5, 0x8E, 0xA9, 
+0x07, 0xF3, 0x3A, 0x28, 0x2F, 0x15, 0x0A, 0xA3, 
+0x89, 0xEA, 0xF6, 0x4E, 0x24, 0x2A, 0xD3, 0x0A, 
+0x84, 0x0D, 0x14, 0x78, 0x7B, 0xF8, 0x77, 0xE1, 
+0xB1, 0xEA, 0x6E, 0x69, 0x54, 0x99, 0x67, 0xC3, 
+0x07, 0xF4, 0x41, 0x3B, 0x84, 0xEF, 0xF7, 0x9A, 
+0xF3, 0xE6, 0x39, 0xE7, 0xB2, 0x56, 0x97, 0x12, 
+0x14, 0x54, 0x2B, 0x72, 0x1E, 0x02, 0x8E, 0x8E, 
+0x89, 0x9E, 0x41, 0x22, 0xBC, 0xB8, 0x53, 0xDA, 
+0xE6, 0x14, 0x4B, 0x7D, 0xB7, 0xE9, 0x0A, 0xC7, 
+0xF4, 0x68, 0x9F, 0x63, 0x6C, 0xF8, 0x34, 0x6D, 
+0xD0, 0x9E, 0xFB, 0xA0, 0x30, 0x7E, 0xBB, 0xDD, 
+0x0D, 0x5D, 0x42, 0x8E, 0x4B, 0x99, 0x52, 0xAC, 
+0xB4, 0x81, 0x56, 0x7E, 0xF8, 0x7D, 0x50, 0x1A, 
+0x98, 0xD2, 0xF4, 0xAE, 0xC8, 0x36, 0x0A, 0x73, 
+0xA4, 0xA3, 0x1E, 0x1B, 0xE3, 0x77, 0xAD, 0x90, 
+0xD2, 0xE1, 0x44, 0x0C, 0xCF, 0x25, 0x1E, 0x0E, 
+0xB6, 0xD0, 0xF6, 0xD9, 0x0D, 0x1E, 0xC3, 0xB6, 
+0x04, 0x46, 0xF5, 0xBC, 0x6A, 0x2D, 0x0A, 0xBA, 
+0x59, 0xDE, 0xCE, 0x40, 0xF0, 0xEF, 0xBF, 0x37, 
+0xE7, 0xF4, 0x58, 0x08, 0xD7, 0x6C, 0x21, 0x5E, 
+0x0A, 0x14, 0xF1, 0xF5, 0xDA, 0xA9, 0xE8, 0x1E, 
+0x7F, 0x95, 0x68, 0x98, 0x80, 0x2F, 0x68, 0xC6, 
+0xDF, 0xEC, 0x42, 0x6C, 0xD0, 0x4E, 0x2F, 0x43, 
+0xF5, 0x91, 0x37, 0xF7, 0x7D, 0x0B, 0x2D, 0x90, 
+0xBD, 0xD3, 0x54, 0xE7, 0x5D, 0xC4, 0xA2, 0x7E, 
+0x8B, 0xDE, 0x67, 0x97, 0xD1, 0x07, 0x96, 0x56, 
+0x50, 0x31, 0x85, 0x84, 0x87, 0x3E, 0x75, 0x8D, 
+0x63, 0xFF, 0xD2, 0x2A, 0x33, 0x0D, 0x8B, 0x6A, 
+
+0x37, 0xEC, 0xEF, 0xC1, 0x41, 0x33, 0xCA, 0x63, 
+0x29, 0x91, 0xB2, 0x26, 0x9D, 0xB7, 0xA4, 0x37, 
+0x0C, 0x84, 0x2D, 0xB0, 0x23, 0x1A, 0xB6, 0xFA, 
+0x94, 0x81, 0x96, 0x0C, 0x01, 0x22, 0x9A, 0x5F, 
+0xA3, 0x16, 0xD3, 0x73, 0x33, 0x3B, 0x91, 0x18, 
+0x3D, 0x18, 0xAB, 0xE7, 0xCE, 0xB9, 0x48, 0xDD, 
+0xA5, 0xBF, 0x97, 0x35, 0xBA, 0x4D, 0x28, 0x4C, 
+0xC3, 0x89, 0x25, 0x4E, 0x81, 0xD4, 0x77, 0x17, 
+0xAC, 0xA0, 0x49, 0xA1, 0xF4, 0x11, 0x76, 0xC8, 
+0x50, 0xCF, 0xAC, 0x8E, 0x86, 0xB7, 0x08, 0x7A, 
+0x41, 0x3F, 0x13, 0x5B, 0xFB, 0xC6, 0xCF, 0xB4, 
+0xC8, 0x7B, 0xE0, 0xBC, 0xA1, 0xC8, 0x5F, 0x55, 
+0xB9, 0x78, 0x58, 0xE0, 0xE7, 0xBE, 0x25, 0xB4, 
+0x39, 0xCB, 0x02, 0x8C, 0xB0, 0xA8, 0xEF, 0x7D, 
+0xFA, 0x8F, 0x7E, 0x4F, 0xFE, 0xF3, 0x7E, 0xBD, 
+0xA9, 0x85, 0x73, 0xAE, 0xEA, 0x45, 0x43, 0xF5, 
+0x33, 0x64, 0x50, 0x4A, 0x9C, 0xB3, 0x87, 0x03, 
+0xE7, 0x70, 0xB6, 0x6D, 0x00, 0xFB, 0x8F, 0x2E, 
+0x4C, 0x9F, 0x95, 0x3F, 0x1A, 0x26, 0x30, 0xD1, 
+0xB6, 0x79, 0x1F, 0x2D, 0x75, 0x5B, 0xE0, 0xDD, 
+0x43, 0x27, 0xEA, 0x86, 0x42, 0x46, 0xC8, 0x0F, 
+0x16, 0xBE, 0xC0, 0xB9, 0xD7, 0xCC, 0xA8, 0x2C, 
+0xDE, 0xEF, 0xB1, 0x25, 0x3B, 0x5C, 0x07, 0x76, 
+0xF9, 0x1A, 0xD3, 0xD0, 0x40, 0x50, 0x4D, 0x9B, 
+0x05, 0xCD, 0xF1, 0xA6, 0xF2, 0xF0, 0x33, 0xB0, 
+0x08, 0xF7, 0xA3, 0x54, 0x04, 0x95, 0xDD, 0xA4, 
+0xAE, 0xF5, 0xFA, 0x89, 0x62, 0xE6, 0x29, 0x88, 
+0x27, 0xA6, 0x06, 0x83, 0x61, 0x72, 0x4B, 0x14, 
+0xB7, 0x55, 0x65, 0xE1, 0x40, 0x05, 0x4A, 0xCF, 
+0xEE, 
+
+0x65, 0x47, 0x53, 0x7B, 0x79, 0x05, 0x78, 0x9E, 
+0x7B, 0xCB, 0x2E, 0x44, 0x06, 0xA3, 0xF5, 0x02, 
+0x3D, 0x08, 0xDE, 0x09, 0xF5, 0xBE, 0x64, 0xD6, 
+0x8C, 0x86, 0x6D, 0x57, 0xA8, 0x95, 0x29, 0x61, 
+0x59, 0x3C, 0x07, 0xA2, 0x35, 0x61, 0xCA, 0xC5, 
+0xF7, 0x5F, 0x36, 0x22, 0x40, 0xF2, 0xFB, 0xCD, 
+0x42, 0xB3, 0xF6, 0x69, 0x57, 0xF4, 0x8E, 0x80, 
+0x94, 0x2B, 0xAC, 0x1F, 0x78, 0x59, 0x1E, 0xC3, 
+0xB7, 0x61, 0x2E, 0x7E, 0xD0, 0x1F, 0x58, 0x26, 
+0x74, 0xA9, 0x10, 0xAE, 0x68, 0x74, 0x89, 0xD8, 
+0x69, 0xBA, 0x06, 0x0B, 0xFB, 0xD0, 0x93, 0x56, 
+0x88, 0x97, 0x58, 0xF4, 0x57, 0x88, 0xD4, 0xB9, 
+0x97, 0xD6, 0x85, 0xA8, 0x2D, 0x2D, 0x3F, 0x19, 
+0xDB, 0xC1, 0xB4, 0xB0, 0xEF, 0x78, 0x10, 0x86, 
+0xB6, 0x31, 0xD6, 0xA4, 0x4B, 0x10, 0xF7, 0xCE, 
+0xDA, 0xA5, 0x3B, 0xE6, 0xBF, 0x6D, 0x6E, 0x2A, 
+0x85, 0xCC, 0x62, 0xD9, 0xA1, 0xB2, 0x98, 0xEB, 
+0xE2, 0x65, 0x85, 0x33, 0xCD, 0xDA, 0x9C, 0x84, 
+0x5A, 0x72, 0xA4, 0x4B, 0x9A, 0x06, 0xAA, 0x14, 
+0xB1, 0x5F, 0xF1, 0xAC, 0xE8, 0x45, 0xC6, 0x98, 
+0xAC, 0x29, 0x4C, 0x88, 0xB0, 0x21, 0xEB, 0x58, 
+0xB3, 0x0F, 0x8C, 0x4D, 0x0D, 0x23, 0xB8, 0x28, 
+0xC7, 0x6D, 0xC9, 0x1F, 0xDA, 0xDF, 0x3A, 0xDE, 
+0x22, 0xCA, 0x62, 0x2B, 0xC6, 0x05, 0x1A, 0x73, 
+0xA7, 0xC5, 0xDC, 0x16, 0x9F, 0x35, 0x2B, 0xAA, 
+0x1C, 0x55, 0xC0, 0xE8, 0xB2, 0xA2, 0x43, 0x88, 
+0x00, 0xD7, 0x8B, 0x52, 0xFE, 0xE2, 0xCC, 0x07, 
+0x03, 0x08, 0x4B, 0x1A, 0xCB, 0x1B, 0x8D, 0x3C, 
+0xC6, 0xBB, 0x82, 0xE1, 0x24, 0x5C, 0x49, 0x78, 
+0x0B, 0xD2, 
+
+0x0F, 0x77, 0x91, 0xF1, 0x01, 0x79, 0x08, 0x0D, 
+0x35, 0x35, 0x86, 0x3B, 0xF5, 0x92, 0x0F, 0x01, 
+0x1A, 0x69, 0x04, 0x24, 0xE0, 0x00, 0x22, 0xF5, 
+0x8C, 0xB8, 0xFF, 0x56, 0x92, 0xC6, 0x9A, 0x6A, 
+0xC5, 0xD8, 0xB7, 0x78, 0xBD, 0x82, 0xB6, 0x97, 
+0x66, 0x29, 0xB2, 0x48, 0x97, 0x22, 0x9C, 0xFC, 
+0x05, 0xF9, 0x0C, 0xE8, 0x7A, 0x94, 0x1C, 0xE2, 
+0x68, 0xDD, 0x2F, 0xCD, 0x8D, 0x90, 0x48, 0x81, 
+0xF0, 0x1D, 0xD0, 0x3C, 0x57, 0xD0, 0x43, 0xD2, 
+0x65, 0xF5, 0x4A, 0xFD, 0x74, 0x28, 0x44, 0xC0, 
+0x19, 0x15, 0xA9, 0x02, 0x6F, 0xFB, 0x1E, 0x73, 
+0x5A, 0x52, 0x95, 0x76, 0x3D, 0xF6, 0x36, 0xE1, 
+0xB4, 0xAF, 0x98, 0x1B, 0x94, 0x04, 0x0C, 0xAE, 
+0xCC, 0x61, 0x33, 0xCB, 0x3A, 0x5B, 0x16, 0xA1, 
+0x17, 0xCC, 0xDE, 0xAE, 0xF8, 0x86, 0x68, 0x5B, 
+0xBC, 0xC2, 0xC9, 0xAF, 0xE8, 0xCA, 0xB0, 0xE2, 
+0xA7, 0x26, 0x25, 0x14, 0x5B, 0xF7, 0xE2, 0xD2, 
+0xDC, 0xBE, 0xF5, 0xEE, 0xCF, 0x8C, 0xF5, 0x62, 
+0x35, 0xF5, 0xA1, 0x9B, 0xD0, 0xA8, 0x39, 0xDE, 
+0xE3, 0x06, 0x1F, 0x89, 0xC9, 0x2B, 0xAF, 0x6C, 
+0x35, 0x03, 0xF6, 0x89, 0x5F, 0x93, 0x71, 0x6D, 
+0x0E, 0xAE, 0x40, 0x39, 0xB2, 0x49, 0xAA, 0xCF, 
+0x9D, 0x59, 0xCA, 0x38, 0x7C, 0xB4, 0x6F, 0x46, 
+0xA7, 0xF2, 0xD0, 0x2B, 0xA8, 0x25, 0xE1, 0xBD, 
+0x11, 0x0C, 0xF0, 0x34, 0x33, 0x1F, 0xE7, 0x53, 
+0xF8, 0x58, 0x6D, 0xA6, 0x1F, 0x90, 0x65, 0x85, 
+0x8A, 0x72, 0xBE, 0xB0, 0xF2, 0x89, 0xA1, 0x11, 
+0x1E, 0xA9, 0xBD, 0xE2, 0xC1, 0xEC, 0x09, 0xE1, 
+0x5F, 0x50, 0xF5, 0x07, 0xC0, 0xC8, 0x7F, 0x29, 
+0x8F, 0x9A, 0x65, 
+
+0x3B, 0xF7, 0x5B, 0xC9, 0x81, 0x84, 0x5F, 0x3E, 
+0x74, 0xCF, 0x86, 0xE8, 0x7F, 0xF8, 0x4D, 0xEE, 
+0x62, 0x23, 0xCE, 0xC0, 0x57, 0xAE, 0x0A, 0xAA, 
+0x83, 0xB2, 0x1D, 0x3C, 0x6E, 0x02, 0x38, 0x4E, 
+0xF4, 0xDB, 0xE2, 0x50, 0x36, 0x7F, 0x9B, 0xA7, 
+0x1F, 0xCF, 0xC8, 0xF8, 0x81, 0x6A, 0xB3, 0xE1, 
+0x74, 0x68, 0xE1, 0x46, 0xC7, 0x04, 0x90, 0xDB, 
+0x45, 0xB9, 0xB3, 0x12, 0x46, 0xDD, 0xD9, 0x7D, 
+0x85, 0x2B, 0x67, 0x54, 0x43, 0x46, 0xA3, 0xD2, 
+0x97, 0x73, 0x1A, 0x06, 0x60, 0x59, 0xC5, 0x1F, 
+0x04, 0x72, 0x89, 0xB1, 0xA0, 0x34, 0x11, 0xA2, 
+0xA3, 0xB1, 0x56, 0x04, 0x2F, 0x9F, 0x99, 0xDE, 
+0x2B, 0x07, 0xD3, 0xB2, 0xE8, 0x56, 0xFC, 0xC3, 
+0x7B, 0x9D, 0x73, 0x6D, 0xB7, 0x48, 0x53, 0xC6, 
+0x20, 0xB2, 0xEC, 0x74, 0x84, 0x6C, 0x40, 0x4B, 
+0x7A, 0xB2, 0x40, 0xA6, 0x9C, 0x07, 0x10, 0x62, 
+0x41, 0xA1, 0x02, 0x41, 0xE6, 0x2C, 0xA2, 0xFB, 
+0x25, 0xCB, 0x7E, 0xBA, 0xB4, 0x94, 0xCB, 0x35, 
+0x0F, 0x2F, 0x88, 0x88, 0x90, 0x7E, 0x39, 0xD9, 
+0xA4, 0x3B, 0xAA, 0xC9, 0xCE, 0xB4, 0xB4, 0xAA, 
+0x96, 0x3A, 0xF0, 0x3D, 0xAD, 0x44, 0xFF, 0xB5, 
+0x68, 0x32, 0xCE, 0x8B, 0x30, 0x27, 0x75, 0x20, 
+0x0C, 0x8B, 0xF2, 0x53, 0x22, 0x2A, 0xE8, 0xFB, 
+0xA5, 0x58, 0xFC, 0x67, 0x82, 0xAF, 0x89, 0x24, 
+0x45, 0xCF, 0x8C, 0x6D, 0x63, 0x98, 0x80, 0x3C, 
+0xB0, 0xF7, 0x28, 0xE5, 0x49, 0x61, 0x67, 0x61, 
+0x43, 0xF7, 0x3B, 0xBE, 0x8D, 0x45, 0x06, 0xEE, 
+0xCF, 0x78, 0x55, 0x5B, 0x68, 0x95, 0x6F, 0xC2, 
+0x86, 0x0F, 0xD5, 0x53, 0x4D, 0x63, 0x08, 0x35, 
+0x08, 0xB0, 0xF0, 0xAA, 
+
+0x43, 0xED, 0x21, 0xD6, 0x15, 0xA1, 0x14, 0x96, 
+0xC5, 0x6C, 0x41, 0x2E, 0xE4, 0x6D, 0x65, 0xB5, 
+0xA9, 0x3A, 0xB7, 0xC2, 0xAE, 0xB6, 0x22, 0x97, 
+0x7E, 0xD1, 0x06, 0x8A, 0x68, 0x5B, 0x9A, 0xFF, 
+0xC0, 0xC3, 0xF5, 0x8E, 0x92, 0xDD, 0xD5, 0xB6, 
+0x16, 0x26, 0x87, 0xF4, 0xAF, 0xE1, 0x8C, 0x54, 
+0xA8, 0x93, 0xC1, 0xC1, 0x25, 0xCC, 0xFA, 0x07, 
+0x24, 0x07, 0xB5, 0x55, 0x00, 0x66, 0x3B, 0x7C, 
+0xE2, 0x6F, 0x59, 0xEF, 0x7D, 0xC6, 0x05, 0xD3, 
+0xA9, 0x06, 0xBC, 0xEC, 0x1D, 0x00, 0x08, 0x43, 
+0xD4, 0x33, 0xE2, 0xB1, 0x12, 0xA4, 0x3E, 0x87, 
+0x24, 0xBD, 0x2E, 0x38, 0x91, 0xB0, 0xFC, 0xE3, 
+0x35, 0xDE, 0xB9, 0x26, 0xF4, 0x38, 0xA9, 0x8E, 
+0x36, 0x5D, 0xF2, 0x96, 0x96, 0x10, 0xAB, 0xD6, 
+0xCA, 0x34, 0xBF, 0xAF, 0x9F, 0xE4, 0x12, 0x50, 
+0x8B, 0x22, 0x2A, 0x84, 0x5F, 0x35, 0x11, 0xDE, 
+0x89, 0xA1, 0xF9, 0x6B, 0xE7, 0x3C, 0xED, 0x85, 
+0x3B, 0xAB, 0xE4, 0x06, 0xF5, 0x06, 0xF2, 0xB2, 
+0x4A, 0x61, 0x78, 0x7B, 0x2F, 0x3B, 0xCD, 0x09, 
+0x6C, 0x1E, 0x08, 0x00, 0x65, 0x3B, 0x06, 0x4D, 
+0x8B, 0xE5, 0x39, 0x5F, 0x0A, 0x99, 0x04, 0xA3, 
+0x4A, 0x08, 0x41, 0xAB, 0x65, 0x5F, 0xBC, 0x11, 
+0x5E, 0x9C, 0x5D, 0xFB, 0xC1, 0xC4, 0x90, 0x96, 
+0xDA, 0x06, 0x2E, 0xA4, 0x7D, 0xBB, 0x52, 0x65, 
+0x07, 0xC7, 0x88, 0xA6, 0x33, 0x29, 0x39, 0x9A, 
+0x5F, 0x46, 0xE4, 0x1C, 0x5A, 0xB1, 0x24, 0xA5, 
+0xAB, 0xB6, 0x85, 0x54, 0xDC, 0xFD, 0x03, 0x34, 
+0x62, 0xC8, 0x73, 0xE3, 0xBC, 0x94, 0x07, 0x0A, 
+0x50, 0x6B, 0x47, 0x4A, 0x7B, 0x08, 0x28, 0xCB, 
+0x0E, 0x38, 0x4F, 0x96, 0x74, 
+
+0x3C, 0xED, 0x9F, 0x67, 0x04, 0x26, 0x6F, 0x3A, 
+0xC8, 0x52, 0x47, 0x52, 0x4E, 0x69, 0xA4, 0x46, 
+0xB0, 0xBC, 0x8F, 0x1F, 0x60, 0x7C, 0xC1, 0x69, 
+0xD0, 0x3E, 0xDC, 0xC6, 0xCB, 0xC8, 0x77, 0x4C, 
+0xC8, 0xA7, 0x81, 0x1F, 0x9E, 0x59, 0xDA, 0x7E, 
+0xF7, 0xA6, 0x0D, 0x4C, 0x43, 0x2D, 0xB9, 0x80, 
+0x94, 0x58, 0x1B, 0x79, 0x3E, 0xD8, 0xDF, 0x9C, 
+0xE1, 0xA3, 0xCA, 0x77, 0x0D, 0xEC, 0x2F, 0x46, 
+0x0E, 0x74, 0x99, 0x24, 0x62, 0xEB, 0x2A, 0x2C, 
+0xC2, 0x9B, 0xB4, 0xC6, 0x11, 0x21, 0x60, 0xC9, 
+0x86, 0x2B, 0xD8, 0x5E, 0x04, 0x88, 0xC0, 0x3B, 
+0xD2, 0xF0, 0x7B, 0x42, 0xAB, 0x91, 0x22, 0x25, 
+0xEB, 0x1F, 0x06, 0x7D, 0xE1, 0xAE, 0x4F, 0x3E, 
+0xDF, 0xE9, 0xEE, 0xFD, 0x29, 0x65, 0x1F, 0x81, 
+0xB2, 0x8A, 0xA1, 0x03, 0x0D, 0xE7, 0x54, 0x66, 
+0xF4, 0x41, 0xEC, 0x14, 0x84, 0x68, 0x5E, 0x3C, 
+0x03, 0x3A, 0xEE, 0x72, 0xC6, 0x86, 0x98, 0x21, 
+0x81, 0x3D, 0x8E, 0xB5, 0xBD, 0x68, 0x46, 0x5B, 
+0x97, 0xB9, 0x4F, 0x96, 0xC9, 0x47, 0x30, 0x98, 
+0x33, 0x7B, 0x98, 0x57, 0x1D, 0x1D, 0x31, 0xBC, 
+0x13, 0xB7, 0x41, 0x76, 0x6C, 0x8E, 0x17, 0x2E, 
+0xE9, 0x4F, 0xC9, 0xB6, 0x91, 0x8B, 0x8E, 0x0C, 
+0x45, 0x98, 0x87, 0xDC, 0x7F, 0xB4, 0x92, 0xA8, 
+0xF2, 0xC1, 0x56, 0x2A, 0xA1, 0xF3, 0xF3, 0x76, 
+0x0A, 0x0B, 0x93, 0x7E, 0xC0, 0xE3, 0x8C, 0xE8, 
+0x47, 0x13, 0x06, 0xE9, 0x0B, 0xF8, 0xDA, 0x82, 
+0x22, 0x41, 0x68, 0xF7, 0x9C, 0x32, 0x40, 0xBE, 
+0xA3, 0xFF, 0x68, 0xCC, 0x77, 0x69, 0x92, 0x1D, 
+0xB7, 0x3A, 0x70, 0xB9, 0x28, 0xA4, 0xCE, 0xB6, 
+0x80, 0x99, 0x7F, 0x87, 0x90, 0x0C, 
+
+0xB5, 0xA5, 0xA4, 0x99, 0xE1, 0x40, 0x66, 0xCA, 
+0x64, 0x13, 0x9B, 0xE2, 0xD7, 0xE0, 0x10, 0x3E, 
+0x9D, 0xFD, 0xD1, 0x18, 0x73, 0xD4, 0xAC, 0x8B, 
+0x6F, 0x86, 0xFD, 0x3D, 0x5B, 0x03, 0x61, 0x55, 
+0x79, 0x8C, 0x28, 0x25, 0x44, 0xE4, 0x2F, 0xEE, 
+0x81, 0x55, 0xC7, 0x67, 0x29, 0x58, 0x8A, 0x82, 
+0xFE, 0xBA, 0x98, 0x63, 0xBD, 0xF6, 0x43, 0xB9, 
+0x47, 0x81, 0xBF, 0xA5, 0xB1, 0x40, 0xA3, 0x1F, 
+0x02, 0xB5, 0x9A, 0xC7, 0x6C, 0x05, 0x89, 0xD0, 
+0xFC, 0x54, 0x2E, 0x6F, 0xAA, 0xFC, 0x9F, 0xA1, 
+0xBC, 0x7F, 0xCD, 0x79, 0xCB, 0xB4, 0xA3, 0xA9, 
+0x2F, 0x30, 0xBA, 0xB1, 0x60, 0x36, 0xF7, 0xDF, 
+0x02, 0x4C, 0x7D, 0xE5, 0xEE, 0x4A, 0xFA, 0x77, 
+0xB4, 0xDA, 0x71, 0x5E, 0xEB, 0x8F, 0x4F, 0xC4, 
+0x77, 0x94, 0x8E, 0x75, 0x1B, 0xFA, 0xD0, 0xF8, 
+0x63, 0x14, 0x08, 0x72, 0x4A, 0xED, 0x76, 0x70, 
+0x2E, 0xBF, 0x69, 0xD6, 0x57, 0xC0, 0x60, 0x73, 
+0xD0, 0x45, 0x05, 0x64, 0x60, 0xAA, 0xA8, 0xD3, 
+0x93, 0xAA, 0x97, 0x9A, 0x97, 0x1B, 0x73, 0xB2, 
+0x58, 0xE0, 0x1D, 0x17, 0xE8, 0xEE, 0x32, 0x1A, 
+0x79, 0x37, 0xAF, 0xA3, 0x96, 0xC9, 0x0B, 0xF5, 
+0x0A, 0xF1, 0x17, 0xD5, 0x37, 0x07, 0x29, 0xF9, 
+0x14, 0x7C, 0x9A, 0x25, 0x57, 0xE9, 0x4C, 0x9E, 
+0x41, 0xC0, 0xB7, 0xBF, 0x70, 0xB9, 0x61, 0x83, 
+0x6A, 0xF0, 0xAF, 0x97, 0xAF, 0xE9, 0xCF, 0x04, 
+0x7A, 0xE0, 0x21, 0xE5, 0x8D, 0xB3, 0x5A, 0x6F, 
+0x16, 0xD4, 0xA5, 0xB8, 0x36, 0x41, 0xDE, 0x92, 
+0xA4, 0x3C, 0x65, 0xC8, 0x12, 0xE4, 0xF8, 0xDC, 
+0x27, 0xB2, 0x5D, 0xDC, 0x28, 0xAC, 0xF8, 0x91, 
+0x84, 0xBE, 0xBE, 0x62, 0xF8, 0x29, 0x50, 
+
+0xAD, 0xEB, 0x69, 0xCB, 0x69, 0xAF, 0x2E, 0xF7, 
+0xA7, 0x72, 0xE4, 0xAE, 0xAE, 0x0D, 0xA7, 0xB6, 
+0xE7, 0x11, 0x91, 0x6F, 0xEE, 0x46, 0x69, 0x30, 
+0x4F, 0x14, 0xF9, 0xDB, 0x7D, 0x0A, 0xB1, 0x73, 
+0x32, 0xA4, 0x32, 0x52, 0xDA, 0x86, 0x15, 0x28, 
+0xE7, 0xF9, 0x75, 0x0D, 0xB5, 0xBD, 0xE0, 0x0E, 
+0xAF, 0xE7, 0x15, 0x79, 0x9E, 0x4C, 0x32, 0xAB, 
+0x9D, 0x0E, 0x3E, 0x61, 0x71, 0x9F, 0x9B, 0x2D, 
+0x4A, 0x77, 0x09, 0x32, 0x4D, 0xA6, 0x7C, 0x34, 
+0x22, 0xE1, 0x8B, 0x19, 0x04, 0x7C, 0x99, 0x4C, 
+0x85, 0x0A, 0x5C, 0xA8, 0xF7, 0xF5, 0x2D, 0x4E, 
+0x50, 0x65, 0xA4, 0xF2, 0x68, 0x2A, 0xCD, 0x58, 
+0xE4, 0x31, 0x27, 0xE8, 0x7E, 0x56, 0xC2, 0xA6, 
+0x6F, 0x1F, 0xA0, 0x08, 0xF0, 0x66, 0x09, 0xD1, 
+0x0B, 0xF8, 0xFB, 0x30, 0xC7, 0xB1, 0x86, 0x09, 
+0x8A, 0x22, 0xAA, 0xC5, 0x6F, 0x6C, 0xB1, 0xE6, 
+0x6D, 0x48, 0xB9, 0x04, 0x99, 0xB8, 0x8C, 0xA1, 
+0x20, 0x27, 0xA8, 0x3C, 0x93, 0xA1, 0xE0, 0x64, 
+0xFA, 0x63, 0x18, 0x34, 0xB0, 0x98, 0x89, 0x44, 
+0x70, 0x39, 0x46, 0x9D, 0x80, 0x9F, 0x1A, 0x86, 
+0xA7, 0x92, 0x3A, 0x23, 0x36, 0x0A, 0x20, 0x24, 
+0x37, 0x3E, 0xF5, 0x9D, 0xE0, 0x89, 0x00, 0xAA, 
+0xB2, 0x76, 0xB1, 0x84, 0xC8, 0x4A, 0x4B, 0x59, 
+0xE5, 0x35, 0x2D, 0xD7, 0x1C, 0xA9, 0x82, 0xF5, 
+0x0E, 0xEE, 0xE1, 0xF7, 0x5F, 0x03, 0xAD, 0x2A, 
+0xC8, 0x1C, 0x58, 0x36, 0x31, 0xE4, 0xAC, 0xC1, 
+0x6F, 0xFF, 0x01, 0xBA, 0xD5, 0x34, 0x03, 0x4B, 
+0x9D, 0xA5, 0x2A, 0x15, 0x7D, 0xFA, 0xD0, 0x8D, 
+0x27, 0x18, 0xB9, 0x79, 0xCB, 0x4C, 0x27, 0x67, 
+0x0F, 0xA2, 0x35, 0x3D, 0x5A, 0x3C, 0x97, 0xF7, 
+
+0x82, 0xEF, 0x9A, 0xDA, 0x27, 0x48, 0xB1, 0xAE, 
+0x05, 0x9A, 0xE5, 0x79, 0x59, 0xD0, 0xC8, 0xD2, 
+0xD2, 0x35, 0xB4, 0x48, 0xE2, 0x56, 0x0C, 0x06, 
+0xCE, 0x38, 0x67, 0xFA, 0xEF, 0xD8, 0x83, 0xDB, 
+0x63, 0x39, 0x53, 0x28, 0x23, 0x82, 0xBA, 0x9E, 
+0xDE, 0x75, 0x98, 0xE5, 0x0D, 0xBF, 0xE2, 0xEF, 
+0xD7, 0xA3, 0xEE, 0x6D, 0xEE, 0xA5, 0x20, 0x0E, 
+0x1B, 0xCB, 0xDC, 0x45, 0x48, 0x37, 0xFA, 0xFF, 
+0x99, 0xE2, 0x81, 0x73, 0xC2, 0x08, 0x27, 0x79, 
+0x90, 0x90, 0xF1, 0x89, 0x6C, 0xCA, 0xB6, 0xE8, 
+0xAD, 0x5D, 0xAD, 0x4B, 0x54, 0x34, 0x76, 0x31, 
+0x0C, 0xE2, 0xEE, 0xC4, 0x2C, 0x69, 0x37, 0xD5, 
+0xF1, 0xDD, 0x99, 0x33, 0xDC, 0xB8, 0x96, 0x4A, 
+0xCC, 0x0C, 0x62, 0xA5, 0x3A, 0x09, 0x60, 0xC7, 
+0x60, 0xF0, 0x57, 0x64, 0x23, 0x68, 0x5B, 0xE0, 
+0x21, 0x78, 0x1D, 0x26, 0x39, 0xAB, 0x49, 0x50, 
+0x3B, 0xA3, 0x1C, 0x55, 0x5D, 0xAC, 0x7C, 0x31, 
+0x73, 0x79, 0x64, 0x6F, 0x25, 0xE9, 0xC3, 0xA3, 
+0xB3, 0x45, 0xCF, 0x6F, 0xEA, 0x16, 0x7C, 0xA5, 
+0xDD, 0x5E, 0x9F, 0x26, 0xB6, 0x2D, 0xB8, 0x00, 
+0xF9, 0xA5, 0xA4, 0x6A, 0x28, 0x37, 0xAC, 0x4E, 
+0x7B, 0xF2, 0x35, 0x40, 0xAA, 0xC5, 0x91, 0xAB, 
+0x35, 0x33, 0x4A, 0x1C, 0xBA, 0x0E, 0x98, 0x03, 
+0x74, 0x17, 0x17, 0x20, 0x3A, 0x4F, 0x8F, 0x38, 
+0x81, 0x10, 0x70, 0x0B, 0xA7, 0x6B, 0x31, 0x2D, 
+0x22, 0x85, 0x72, 0x2E, 0x69, 0x24, 0x3C, 0x6A, 
+0x95, 0x2A, 0x53, 0xB9, 0xE6, 0x41, 0x48, 0xFF, 
+0xCB, 0x03, 0x36, 0x78, 0x7C, 0x0B, 0x2B, 0xC5, 
+0x4A, 0xD9, 0x91, 0x3A, 0x53, 0x82, 0xF7, 0xFE, 
+0x9A, 0xC4, 0x22, 0x11, 0x9D, 0x14, 0x16, 0xD7, 
+0xF9, 
+
+0x2E, 0x70, 0x48, 0x01, 0x62, 0x49, 0xE9, 0x67, 
+0x25, 0x7C, 0x96, 0x54, 0xA7, 0x3F, 0xD4, 0xD7, 
+0xA1, 0x15, 0x00, 0xB2, 0xFF, 0xD7, 0x17, 0xC1, 
+0xD1, 0x17, 0xD9, 0x44, 0x21, 0xFD, 0x91, 0xE3, 
+0x04, 0x11, 0x98, 0x84, 0x00, 0x85, 0x0D, 0x43, 
+0x09, 0x10, 0xA3, 0x3F, 0x89, 0x96, 0xC5, 0x2B, 
+0xA7, 0xA4, 0x93, 0xFB, 0x77, 0x28, 0xBF, 0x2D, 
+0x5E, 0xC6, 0xA0, 0x55, 0x4D, 0x25, 0x19, 0x04, 
+0x36, 0x6D, 0x21, 0x01, 0x63, 0x3F, 0x1A, 0x6B, 
+0x53, 0x9D, 0x8F, 0x5C, 0xAA, 0xC2, 0xD2, 0xFF, 
+0xB0, 0xC5, 0xB0, 0xED, 0x60, 0xB9, 0x4F, 0x80, 
+0x7D, 0xBA, 0xE4, 0xD0, 0xD2, 0x22, 0x9D, 0x7F, 
+0x11, 0x2B, 0x97, 0x54, 0x4A, 0xB9, 0xF6, 0xE8, 
+0xCE, 0xA7, 0xD7, 0xAD, 0x21, 0xEF, 0xC3, 0x39, 
+0x43, 0x73, 0x9A, 0x6E, 0x06, 0x45, 0x85, 0xDE, 
+0xAF, 0xFC, 0x80, 0x17, 0x62, 0xE3, 0xE6, 0x2D, 
+0xBB, 0x76, 0x9C, 0x79, 0x27, 0x9C, 0xA5, 0x8C, 
+0x80, 0x81, 0xDB, 0xBE, 0x3E, 0x1A, 0x6F, 0xEF, 
+0x8D, 0x4F, 0xE3, 0x54, 0x57, 0xB1, 0xF7, 0x02, 
+0xF8, 0x5A, 0x45, 0x2E, 0xB0, 0xDF, 0x6C, 0x9E, 
+0x12, 0x4E, 0x4C, 0xFA, 0x56, 0x64, 0x83, 0x6B, 
+0x5F, 0x7C, 0x1E, 0xB5, 0xEC, 0x2C, 0xB8, 0x33, 
+0x80, 0x9D, 0xBC, 0x77, 0x20, 0x59, 0x77, 0xA5, 
+0xC0, 0x8F, 0x35, 0x09, 0x5C, 0x4B, 0x0A, 0x36, 
+0x1D, 0x98, 0xEC, 0x40, 0x9B, 0xB6, 0x65, 0x96, 
+0x19, 0xCB, 0xE1, 0x79, 0x4D, 0x5D, 0xFD, 0x42, 
+0x75, 0x11, 0x6C, 0xC1, 0xC2, 0xBC, 0xE9, 0xB0, 
+0x66, 0xE1, 0x25, 0xC8, 0x31, 0xCA, 0x1D, 0x3F, 
+0x88, 0xA6, 0xB4, 0x31, 0xC2, 0x06, 0x81, 0x02, 
+0xC7, 0xBA, 0x27, 0x80, 0x2A, 0x56, 0xBB, 0x30, 
+0x2E, 0x63, 
+
+0x21, 0x2E, 0x84, 0xE8, 0x4B, 0xF9, 0x36, 0x35, 
+0x14, 0xA5, 0xC4, 0x93, 0xC2, 0xC2, 0xAC, 0xA2, 
+0xBE, 0x8C, 0x0F, 0x00, 0x73, 0x49, 0x66, 0xE7, 
+0x61, 0x39, 0x54, 0x54, 0x77, 0x6C, 0x4E, 0xD0, 
+0x98, 0x53, 0x33, 0xF4, 0x06, 0x5F, 0xC2, 0xC7, 
+0x09, 0x25, 0xAB, 0x7E, 0xBA, 0x1C, 0x08, 0xF3, 
+0x24, 0x3E, 0x47, 0xAC, 0x22, 0xB3, 0x05, 0x3B, 
+0xC2, 0xAB, 0x38, 0x1E, 0x84, 0xE9, 0x85, 0x72, 
+0xAD, 0x0D, 0x0C, 0xBD, 0xBE, 0x6E, 0x74, 0x1B, 
+0xE8, 0x73, 0xF6, 0x0E, 0xB3, 0x03, 0x59, 0x7B, 
+0x81, 0xD5, 0x63, 0x8A, 0x20, 0xCD, 0x54, 0x68, 
+0x90, 0xD7, 0xD2, 0x9B, 0xD5, 0x3E, 0x92, 0x8A, 
+0x58, 0x92, 0x8C, 0xE8, 0x20, 0xB2, 0x7B, 0x02, 
+0x6D, 0xDD, 0x38, 0x1D, 0xA8, 0x9E, 0x9A, 0x81, 
+0x71, 0xD6, 0xF4, 0x5B, 0xE6, 0x14, 0xA0, 0xE7, 
+0xAA, 0x3E, 0x1F, 0xB7, 0x12, 0xD6, 0x4D, 0x5A, 
+0xC4, 0x37, 0x1D, 0x9A, 0x53, 0x1B, 0xB7, 0x5A, 
+0x14, 0x3F, 0x79, 0x90, 0x9D, 0x75, 0x81, 0xEF, 
+0x05, 0x0B, 0x8D, 0xBD, 0xFF, 0x2C, 0xBD, 0xA1, 
+0x36, 0x8C, 0xF4, 0xC5, 0x2A, 0x98, 0xD9, 0xDF, 
+0xC4, 0x74, 0x35, 0xCB, 0xCA, 0x2A, 0xB8, 0xB0, 
+0x32, 0x13, 0x0D, 0x2C, 0x7B, 0xE6, 0x75, 0xF7, 
+0x64, 0xF9, 0xDF, 0xA1, 0xA4, 0xDA, 0x88, 0xF8, 
+0x64, 0x81, 0x58, 0xC8, 0xF8, 0x33, 0x51, 0x67, 
+0xF8, 0x6C, 0x33, 0x1D, 0x51, 0xF4, 0xC5, 0x20, 
+0x45, 0x8A, 0x78, 0x35, 0xE1, 0x41, 0xBB, 0xC8, 
+0xD9, 0xF7, 0x78, 0xC4, 0x23, 0x55, 0x96, 0x03, 
+0xC1, 0x48, 0xC3, 0xEB, 0x85, 0x24, 0x6F, 0x6D, 
+0x6A, 0xCB, 0x31, 0x6B, 0x79, 0x5F, 0x96, 0x4F, 
+0x84, 0xB5, 0x3B, 0xFC, 0x60, 0xF7, 0x81, 0x06, 
+0x2A, 0x8D, 0xE1, 
+
+0xA0, 0xC6, 0x78, 0xB5, 0xCC, 0x23, 0xD5, 0xB5, 
+0x22, 0x7C, 0x29, 0x12, 0x34, 0xA0, 0xBD, 0xA5, 
+0xE8, 0x61, 0x48, 0xD9, 0xAB, 0x5B, 0x39, 0xE7, 
+0x42, 0x2F, 0x9E, 0x71, 0x1D, 0x02, 0xE3, 0x72, 
+0x36, 0x03, 0xB7, 0xF7, 0xA2, 0x95, 0xDD, 0x44, 
+0x04, 0x23, 0x43, 0x93, 0xCF, 0x6D, 0x2D, 0x96, 
+0x07, 0x4D, 0x9C, 0x0B, 0xE4, 0xDF, 0x92, 0x7C, 
+0x42, 0xB3, 0xAC, 0x28, 0xA7, 0xAF, 0x64, 0x49, 
+0xF3, 0xB4, 0x4E, 0x17, 0xB7, 0x20, 0x48, 0xD4, 
+0x8B, 0x28, 0x18, 0xC7, 0xCC, 0xBA, 0x33, 0x61, 
+0x81, 0x02, 0x8C, 0x06, 0x7C, 0xEC, 0x77, 0xB6, 
+0xD8, 0x9E, 0x5F, 0x48, 0x35, 0x25, 0x38, 0x13, 
+0xD5, 0x42, 0xB3, 0x33, 0x65, 0xF6, 0xB9, 0xA8, 
+0x92, 0x35, 0x35, 0xDE, 0x09, 0x08, 0x8C, 0x0F, 
+0xB0, 0xA7, 0x23, 0xA6, 0x5A, 0x2E, 0xE2, 0x2C, 
+0xE5, 0x93, 0x7D, 0x69, 0x09, 0x2A, 0xAB, 0xA6, 
+0xC5, 0xF4, 0xFE, 0xD9, 0xE1, 0xBA, 0x76, 0xC4, 
+0xB9, 0x6C, 0xA3, 0x74, 0x91, 0xBF, 0x14, 0xE2, 
+0xD8, 0x40, 0x1E, 0x46, 0x09, 0xC0, 0xD6, 0xF0, 
+0xFA, 0x7A, 0x5C, 0xC2, 0x53, 0xD9, 0xE3, 0x73, 
+0x39, 0x11, 0x94, 0xC3, 0x07, 0xBF, 0xF1, 0xBF, 
+0x7B, 0xDE, 0xF0, 0xD6, 0xAF, 0x68, 0xD9, 0x92, 
+0xE8, 0x5C, 0xFC, 0x1E, 0x21, 0x54, 0x88, 0x38, 
+0x68, 0x8A, 0x6E, 0x4B, 0x77, 0x4C, 0x9F, 0xED, 
+0x88, 0x80, 0xF8, 0x5A, 0xD9, 0xB7, 0xD2, 0xF5, 
+0x98, 0xCD, 0x6D, 0x91, 0xDF, 0xDD, 0x07, 0xF0, 
+0xD6, 0x82, 0xB3, 0x5A, 0x90, 0xBD, 0x87, 0x74, 
+0x99, 0x92, 0x4E, 0xA2, 0x81, 0xED, 0x7E, 0x0B, 
+0x93, 0x7E, 0x42, 0x18, 0x9C, 0xCB, 0x05, 0x19, 
+0x28, 0x78, 0xBF, 0xDF, 0xE7, 0x73, 0x46, 0xF0, 
+0x57, 0x3A, 0xD0, 0x02, 
+
+0x1B, 0xFF, 0x40, 0x33, 0xBC, 0xB4, 0xF9, 0x1A, 
+0xAC, 0xC6, 0x87, 0xDF, 0x18, 0x08, 0x6E, 0x4D, 
+0x76, 0x9F, 0x03, 0x75, 0xDE, 0x0D, 0xB2, 0x59, 
+0xDF, 0xA6, 0xC0, 0xCD, 0xB7, 0x5B, 0x1D, 0x56, 
+0x9C, 0xEC, 0x10, 0x42, 0x70, 0x2A, 0x29, 0x0B, 
+0x80, 0x5B, 0x96, 0x67, 0xED, 0x88, 0x1A, 0xA0, 
+0x59, 0x9C, 0xB4, 0x18, 0x9D, 0xDB, 0xE8, 0x70, 
+0xB0, 0x33, 0x8E, 0x77, 0x45, 0x4F, 0xBC, 0x78, 
+0x06, 0x04, 0x90, 0xDD, 0x43, 0x8C, 0x25, 0x3D, 
+0xEA, 0x74, 0x61, 0xAE, 0x0A, 0xF1, 0x19, 0x17, 
+0xB2, 0xDD, 0x9F, 0x90, 0x2D, 0x25, 0x44, 0xAC, 
+0xA5, 0x52, 0x3F, 0x25, 0x04, 0x76, 0xAF, 0xD8, 
+0xAB, 0x73, 0x36, 0x1D, 0x8D, 0x1A, 0x0F, 0xB4, 
+0xEC, 0x9E, 0xCB, 0x5E, 0xD3, 0xDE, 0xFE, 0x29, 
+0x43, 0x50, 0x5A, 0xD2, 0x4F, 0x74, 0x92, 0x8F, 
+0x28, 0x13, 0x53, 0xAD, 0xD5, 0xF5, 0x9D, 0x3A, 
+0x2E, 0x5D, 0xB8, 0x60, 0xDA, 0x23, 0x97, 0xE4, 
+0xB7, 0x28, 0xE5, 0xDB, 0x59, 0x01, 0xBF, 0xEE, 
+0x7E, 0xD2, 0x30, 0x24, 0x5E, 0xEA, 0x90, 0x3B, 
+0xA3, 0x25, 0x74, 0x2A, 0x90, 0x5A, 0x0E, 0x58, 
+0x19, 0x1D, 0xF9, 0x7B, 0xFF, 0x2C, 0xE4, 0x69, 
+0x7E, 0x93, 0x00, 0x9A, 0x84, 0x83, 0x36, 0xEC, 
+0xFD, 0x2F, 0x14, 0xFA, 0xC6, 0x67, 0x38, 0xA5, 
+0xCC, 0x6E, 0x12, 0x78, 0x17, 0xC0, 0xDC, 0x1D, 
+0x94, 0x15, 0x85, 0x90, 0x26, 0xC6, 0x10, 0xC1, 
+0xD7, 0x72, 0xD3, 0xC1, 0xDF, 0x2C, 0xB0, 0x31, 
+0x60, 0x9A, 0x30, 0xCD, 0xFC, 0x7B, 0x21, 0x6E, 
+0x95, 0x3B, 0x53, 0xC0, 0x11, 0xFB, 0xCF, 0x37, 
+0xFE, 0x64, 0x2D, 0x47, 0xF3, 0xF2, 0x42, 0x8F, 
+0xCA, 0x2B, 0x35, 0x03, 0xD7, 0x9F, 0x94, 0x1C, 
+0xA7, 0xF9, 0x98, 0xF1, 0x06, 
+
+0x4F, 0x04, 0xE4, 0x52, 0x95, 0x76, 0x4B, 0xB9, 
+0xD5, 0xDD, 0x79, 0x79, 0xC9, 0x67, 0x12, 0x46, 
+0x2E, 0xAA, 0xA8, 0x3E, 0xA5, 0xD0, 0x2B, 0x76, 
+0xE1, 0xE9, 0xA7, 0xA6, 0xD6, 0x31, 0x7D, 0xB9, 
+0x22, 0x05, 0xDF, 0x1D, 0xFF, 0x4A, 0xA1, 0x7A, 
+0xFD, 0xDB, 0x1D, 0xFA, 0x38, 0x2F, 0x22, 0x39, 
+0x31, 0x1E, 0x62, 0xAD, 0x53, 0x4A, 0xE5, 0x94, 
+0x7E, 0x3E, 0xD7, 0x4F, 0x87, 0x93, 0x1A, 0xAE, 
+0xF6, 0xBF, 0x04, 0x9B, 0xAB, 0x24, 0xF0, 0x48, 
+0xAD, 0x91, 0x84, 0x36, 0xF5, 0xB6, 0x6F, 0x36, 
+0x24, 0x3E, 0xBA, 0x32, 0xBD, 0x92, 0x95, 0xBC, 
+0x9A, 0xF2, 0x3A, 0xB4, 0x40, 0x49, 0xA7, 0x3A, 
+0xA2, 0xE0, 0x57, 0xB8, 0x23, 0x74, 0x9C, 0x9E, 
+0x85, 0xF1, 0x44, 0xF5, 0x6D, 0xE1, 0xDF, 0x8A, 
+0xEA, 0x24, 0x48, 0x13, 0xCB, 0x54, 0x8E, 0x66, 
+0x39, 0xDE, 0x43, 0x4C, 0x92, 0x93, 0x66, 0xD2, 
+0x6A, 0xB9, 0xAD, 0xE2, 0x12, 0xEB, 0x53, 0x0D, 
+0xD3, 0xBF, 0xBA, 0x5E, 0x18, 0xC9, 0x32, 0xF1, 
+0xFD, 0x5A, 0x98, 0x57, 0x58, 0xF3, 0x78, 0x29, 
+0x63, 0x73, 0x5C, 0xA2, 0xAA, 0x03, 0xE5, 0xD7, 
+0x8F, 0xF8, 0x36, 0xBA, 0x28, 0xF4, 0xF3, 0xCA, 
+0x7D, 0x5D, 0x18, 0xCA, 0x60, 0xDA, 0xBD, 0xFC, 
+0x02, 0x08, 0x90, 0xE6, 0xA6, 0xD3, 0x08, 0x06, 
+0x22, 0xB4, 0x53, 0x80, 0x96, 0xCD, 0x72, 0xFF, 
+0x0B, 0x7B, 0x7D, 0x40, 0x4E, 0x8F, 0xB5, 0x39, 
+0xD0, 0xB4, 0x33, 0x6E, 0x94, 0x7D, 0xFF, 0xFE, 
+0x5F, 0xFB, 0x0F, 0xBE, 0x7B, 0x9E, 0x07, 0xD1, 
+0x12, 0x88, 0x47, 0xD3, 0xF3, 0x1D, 0xB0, 0xE7, 
+0xA8, 0xF6, 0xF5, 0x3A, 0xE7, 0xF5, 0xF2, 0x45, 
+0xFB, 0x40, 0xC7, 0x86, 0xCC, 0x6F, 0x10, 0x55, 
+0xFC, 0x8F, 0xCE, 0xD3, 0xE3, 0x1A, 
+
+0x1B, 0x4E, 0xFD, 0x8F, 0x88, 0x7F, 0x7D, 0x38, 
+0x43, 0x9E, 0x2C, 0x45, 0x0D, 0x7F, 0x9E, 0xDE, 
+0x41, 0xA8, 0xDF, 0x2D, 0xB3, 0xF3, 0xEB, 0xE0, 
+0x95, 0x77, 0x12, 0xC2, 0x5D, 0x95, 0x95, 0x37, 
+0x1A, 0x36, 0xE6, 0x3E, 0xD4, 0x66, 0x9C, 0xCC, 
+0x37, 0xC5, 0x93, 0x5B, 0x41, 0x5D, 0x67, 0xCC, 
+0x04, 0x99, 0x75, 0x43, 0x3B, 0x5D, 0xB6, 0x84, 
+0x5B, 0xF1, 0x88, 0xAC, 0x60, 0x94, 0x8D, 0x06, 
+0xE8, 0xC2, 0xAB, 0x0A, 0xFC, 0xEF, 0x3D, 0xA0, 
+0x21, 0x42, 0x5A, 0xC8, 0xC8, 0x70, 0x56, 0xE0, 
+0x82, 0x53, 0x98, 0x57, 0xFF, 0x52, 0xB8, 0xC4, 
+0x8D, 0x71, 0xC7, 0xA0, 0x7F, 0xD8, 0x44, 0xCE, 
+0xA6, 0x2A, 0x39, 0x17, 0x7C, 0x54, 0x5B, 0x72, 
+0x9F, 0x48, 0x28, 0xCD, 0x07, 0x33, 0x00, 0xC2, 
+0x0D, 0x1B, 0x2A, 0x69, 0xA6, 0x2D, 0x3E, 0xFA, 
+0x2E, 0x65, 0xB0, 0x7A, 0x75, 0x48, 0x1F, 0xA7, 
+0xE4, 0x24, 0xC8, 0x9E, 0x99, 0xBE, 0x46, 0x93, 
+0x25, 0xF0, 0xEF, 0xBC, 0x91, 0x35, 0xBD, 0x90, 
+0x88, 0xCD, 0x40, 0xE5, 0xEB, 0x60, 0xAD, 0x80, 
+0x50, 0xA0, 0x2D, 0x1F, 0x8E, 0x85, 0x1B, 0x1F, 
+0xC6, 0x62, 0x6E, 0x4E, 0x48, 0x89, 0x2D, 0x73, 
+0xAF, 0x4C, 0xDD, 0x07, 0x09, 0x5A, 0xB9, 0x00, 
+0xE9, 0x4E, 0xC5, 0xC7, 0x5F, 0x53, 0xFD, 0xAA, 
+0xF9, 0x52, 0x0F, 0x3D, 0xAB, 0xAF, 0x6A, 0xD5, 
+0x32, 0xB8, 0xF0, 0xE2, 0x52, 0x6B, 0xAF, 0x23, 
+0xB7, 0x91, 0xBC, 0xFA, 0xBB, 0x24, 0x0B, 0xCB, 
+0x7F, 0xC3, 0xD9, 0xBF, 0x5B, 0xE4, 0x89, 0x3E, 
+0x1E, 0x7C, 0x24, 0x72, 0x68, 0xB0, 0xA2, 0x85, 
+0xB6, 0x9F, 0xBC, 0xB7, 0x7F, 0xC9, 0xCA, 0x44, 
+0xBB, 0x14, 0x34, 0xB5, 0xA2, 0x42, 0x62, 0x33, 
+0x5C, 0x91, 0x10, 0x05, 0x04, 0x22, 0x0E, 
+
+0x0E, 0xC7, 0x7E, 0xE0, 0x62, 0x3F, 0x08, 0x34, 
+0x0C, 0xFA, 0x22, 0x15, 0xDA, 0xE8, 0x2E, 0x7E, 
+0x47, 0x8A, 0xB7, 0x03, 0x3B, 0x42, 0xF8, 0x7A, 
+0x78, 0x62, 0x35, 0xCB, 0x9B, 0x23, 0x39, 0xCD, 
+0x32, 0x5A, 0x53, 0x7F, 0x51, 0x73, 0x11, 0x79, 
+0xEE, 0x4B, 0x7A, 0xD5, 0x6F, 0x0E, 0x07, 0x7E, 
+0xF2, 0x5E, 0xF6, 0x0E, 0x8A, 0x11, 0xAD, 0x7C, 
+0x39, 0x1C, 0x01, 0x21, 0x3D, 0xD6, 0x56, 0x46, 
+0xB7, 0xD2, 0xCF, 0xE2, 0xBC, 0xC1, 0xA1, 0x7B, 
+0x0F, 0x78, 0xB4, 0x26, 0x74, 0x1E, 0x0F, 0x33, 
+0x42, 0x9B, 0x27, 0xAF, 0xA3, 0xA7, 0x9B, 0x03, 
+0x6D, 0xCB, 0x81, 0x44, 0xA7, 0xB7, 0x27, 0x1E, 
+0xA3, 0x1F, 0xE4, 0x0E, 0xDB, 0x6F, 0x22, 0x53, 
+0xD1, 0x91, 0x1F, 0xB0, 0x21, 0x80, 0xBF, 0xED, 
+0x7B, 0xAB, 0x92, 0x3A, 0x35, 0x82, 0xBD, 0x28, 
+0x2B, 0x91, 0xE6, 0x01, 0xEB, 0x9D, 0x72, 0x93, 
+0x76, 0x1F, 0x50, 0x78, 0xE3, 0xD2, 0x13, 0x57, 
+0x96, 0x2B, 0xDF, 0x11, 0x34, 0x69, 0x4C, 0x2B, 
+0x74, 0xA9, 0x41, 0x82, 0xB0, 0xE6, 0x49, 0x0B, 
+0x4C, 0xA7, 0xB5, 0x7A, 0x2C, 0x9F, 0x32, 0x83, 
+0x67, 0x39, 0xF3, 0x75, 0x0E, 0x40, 0x5A, 0xF5, 
+0xD9, 0x03, 0x72, 0x60, 0xDB, 0x10, 0x3B, 0xA7, 
+0xC2, 0xDD, 0xEA, 0x4F, 0xF4, 0xC5, 0xD5, 0xE3, 
+0x8B, 0x3C, 0xBF, 0x10, 0xF6, 0x8A, 0x79, 0x51, 
+0x60, 0x5B, 0x3C, 0xF8, 0x94, 0xB6, 0xE3, 0x06, 
+0x73, 0x65, 0xA3, 0x12, 0xB3, 0x77, 0x35, 0x6C, 
+0x3A, 0x8B, 0x9A, 0xD6, 0x3D, 0x52, 0x6C, 0x41, 
+0x24, 0xF6, 0xEC, 0x57, 0xEA, 0x4C, 0xAA, 0xAA, 
+0xA3, 0x40, 0xDF, 0xE8, 0x48, 0x2F, 0x30, 0xF4, 
+0x8A, 0x65, 0x82, 0x29, 0x15, 0x9F, 0xFB, 0x51, 
+0xB7, 0xED, 0x5D, 0x5C, 0x26, 0xBC, 0x50, 0x3E, 
+
+0x66, 0x36, 0x44, 0xAD, 0xE8, 0x21, 0x00, 0x52, 
+0x67, 0x00, 0x4F, 0xD9, 0x8F, 0x07, 0x38, 0x8D, 
+0x7F, 0x62, 0xFB, 0x1B, 0xA9, 0xE9, 0x15, 0xA5, 
+0xA0, 0xE9, 0x89, 0xD2, 0xCC, 0xA2, 0x05, 0x8C, 
+0xD6, 0xB3, 0x77, 0x71, 0x72, 0x9F, 0x4A, 0x50, 
+0x33, 0x78, 0xB0, 0x04, 0x9D, 0xB3, 0x74, 0x9D, 
+0x17, 0x1A, 0xBE, 0x89, 0x9B, 0xF2, 0xA5, 0xC8, 
+0x1C, 0xAD, 0xA0, 0xA1, 0x49, 0xDE, 0xC5, 0xD8, 
+0xEC, 0xA1, 0xD5, 0x90, 0xAD, 0xD7, 0xED, 0x24, 
+0x8D, 0x64, 0xF4, 0x23, 0x92, 0x88, 0x59, 0xC9, 
+0x35, 0x1A, 0xF6, 0x30, 0x54, 0x2B, 0xC5, 0x0A, 
+0xEC, 0xEA, 0x37, 0xF2, 0x16, 0x36, 0x4E, 0x2E, 
+0x1C, 0xE8, 0xCD, 0x63, 0x17, 0x6D, 0xAE, 0x78, 
+0xC0, 0xF1, 0x46, 0xD9, 0x4B, 0xD6, 0xE1, 0x63, 
+0xE7, 0xC3, 0x93, 0x21, 0x9C, 0x09, 0x05, 0x1F, 
+0x37, 0x0A, 0x1A, 0xE9, 0x90, 0x50, 0x50, 0xB3, 
+0xF2, 0xFD, 0x37, 0x71, 0xEF, 0x4F, 0xC8, 0xC2, 
+0xBC, 0x53, 0x99, 0x1C, 0x7E, 0x00, 0x1B, 0x0C, 
+0x46, 0x49, 0xE4, 0x01, 0xE6, 0x9C, 0xEE, 0x87, 
+0x5B, 0xCC, 0x92, 0x97, 0xEE, 0xEC, 0x56, 0x95, 
+0x78, 0xAC, 0x9A, 0x60, 0x60, 0xA2, 0x82, 0xFA, 
+0x0E, 0x11, 0xB7, 0x4B, 0x94, 0x71, 0x56, 0x92, 
+0x4D, 0x6F, 0xA4, 0x4D, 0x01, 0x13, 0x40, 0x7C, 
+0xA0, 0x2C, 0x95, 0x56, 0xDC, 0xD1, 0x50, 0x33, 
+0x27, 0x05, 0x20, 0xBC, 0x15, 0xF0, 0xB6, 0x95, 
+0x54, 0x2F, 0x1E, 0x34, 0x24, 0x2A, 0xD2, 0x80, 
+0x8F, 0xD4, 0xF5, 0xD8, 0x6E, 0xEE, 0x28, 0x3F, 
+0xDC, 0x93, 0x22, 0xA4, 0x3B, 0xAF, 0x41, 0x8A, 
+0x79, 0x41, 0xF3, 0xD2, 0xB8, 0xAB, 0xC8, 0x40, 
+0x43, 0xB6, 0x55, 0xF0, 0x17, 0x10, 0x82, 0x50, 
+0xE5, 0x38, 0xBD, 0x34, 0x5F, 0xC9, 0x85, 0xAC, 
+0x47, 
+
+0x53, 0xC5, 0xED, 0x73, 0xF1, 0x21, 0x87, 0xD0, 
+0x53, 0xA2, 0x51, 0x41, 0x3C, 0x49, 0x15, 0xA7, 
+0x10, 0x0E, 0xCF, 0x80, 0xB9, 0xCC, 0xD7, 0x96, 
+0x93, 0xB5, 0x22, 0x9E, 0x73, 0x6F, 0x6C, 0xFF, 
+0xA0, 0x59, 0xB3, 0xA9, 0xE4, 0x7D, 0xED, 0x7E, 
+0x78, 0xFE, 0x28, 0xD3, 0x24, 0x76, 0xEF, 0xA2, 
+0x6C, 0xB5, 0xBE, 0xA2, 0x14, 0x59, 0x63, 0x6F, 
+0xE4, 0xE7, 0xB8, 0x29, 0xC5, 0x60, 0x61, 0x47, 
+0x03, 0xA8, 0x12, 0xC5, 0xF1, 0xA9, 0x94, 0x30, 
+0xD9, 0x0C, 0xA2, 0xA9, 0x84, 0xD8, 0x6A, 0x26, 
+0x26, 0x6E, 0xC6, 0x0F, 0xD3, 0xE1, 0x3F, 0xBD, 
+0x13, 0x78, 0x90, 0x00, 0xB0, 0xB4, 0xDA, 0xB4, 
+0x8E, 0xB2, 0x23, 0xE2, 0xD0, 0xA4, 0xE5, 0x46, 
+0xF1, 0x58, 0x00, 0x48, 0x95, 0x10, 0xDE, 0xA1, 
+0x6C, 0x3D, 0xFF, 0x77, 0x16, 0xCE, 0x45, 0xE1, 
+0x06, 0x66, 0xA0, 0xC1, 0x3C, 0x72, 0xBD, 0x0D, 
+0x08, 0x63, 0x0B, 0x98, 0x51, 0x9F, 0x8C, 0x36, 
+0x5D, 0xCA, 0xC2, 0x68, 0xEA, 0xFB, 0x19, 0x03, 
+0xA3, 0xB8, 0x66, 0x56, 0x76, 0x5B, 0xE5, 0xC7, 
+0xDD, 0xED, 0x39, 0x29, 0x3D, 0xAD, 0x00, 0xAD, 
+0x35, 0xA0, 0x81, 0x58, 0x88, 0x3A, 0x85, 0x8F, 
+0xDF, 0xB5, 0xD9, 0xFC, 0x0F, 0x71, 0x19, 0xBC, 
+0xD8, 0xFE, 0x84, 0x90, 0x56, 0xFF, 0x18, 0x45, 
+0x1A, 0x74, 0x1D, 0xBB, 0xB1, 0x1D, 0x12, 0xB0, 
+0xCD, 0x4C, 0xC3, 0x6D, 0x63, 0xAA, 0x8A, 0xD2, 
+0xA0, 0x71, 0x8A, 0x10, 0xAF, 0x45, 0xA1, 0xEC, 
+0x6A, 0x88, 0xF8, 0xAA, 0x96, 0x1F, 0x07, 0x12, 
+0x19, 0xD3, 0x15, 0xF1, 0x1A, 0xDA, 0x85, 0x9B, 
+0xFD, 0xCE, 0x62, 0xFA, 0x38, 0x76, 0x06, 0x65, 
+0xA2, 0x8C, 0x22, 0x5F, 0x44, 0x69, 0x5A, 0x03, 
+0x66, 0xA2, 0xE3, 0x73, 0x6C, 0x7E, 0x83, 0xDE, 
+0x09, 0xE3, 
+
+0xBF, 0xC7, 0x80, 0x76, 0x2D, 0x52, 0xEF, 0x82, 
+0x54, 0x30, 0x70, 0x2B, 0x53, 0x24, 0x2A, 0xC3, 
+0x2A, 0x4C, 0x2A, 0x08, 0x22, 0x46, 0xDF, 0x8C, 
+0xC8, 0x68, 0xC6, 0xB1, 0xDD, 0xAD, 0xE9, 0x4C, 
+0x68, 0x6C, 0xB3, 0x3D, 0x75, 0x87, 0xDF, 0xAD, 
+0x02, 0xE0, 0x51, 0xEE, 0xD1, 0xFD, 0x1D, 0xF4, 
+0x5A, 0x96, 0xBB, 0xF7, 0x49, 0x45, 0x8C, 0x68, 
+0xA2, 0xCA, 0xD1, 0x11, 0x45, 0x1A, 0x17, 0x3E, 
+0xDE, 0xEE, 0xF4, 0xBB, 0xFB, 0xCC, 0xFA, 0xBA, 
+0xEF, 0xC5, 0xA6, 0x8A, 0x71, 0x62, 0xF7, 0x01, 
+0xCD, 0x70, 0xE7, 0x34, 0x61, 0x99, 0xA1, 0x58, 
+0x36, 0x27, 0x0E, 0xAC, 0x68, 0x4C, 0x3C, 0xB1, 
+0x4F, 0x5E, 0x65, 0x41, 0x81, 0x16, 0xB8, 0xB4, 
+0x27, 0x77, 0x39, 0x6F, 0x7D, 0x63, 0x83, 0xAC, 
+0x2A, 0xD3, 0x08, 0x49, 0xD5, 0x16, 0x75, 0xD9, 
+0xA4, 0x73, 0x1B, 0x87, 0x6E, 0xFB, 0xB2, 0x09, 
+0x53, 0xA4, 0xB4, 0x38, 0x9A, 0xCA, 0x63, 0x73, 
+0x59, 0x6A, 0x13, 0xD8, 0x3D, 0x30, 0x68, 0x73, 
+0x03, 0x44, 0xF1, 0x20, 0x9B, 0x63, 0x7A, 0x4C, 
+0xFA, 0x9B, 0xC2, 0xD3, 0x63, 0x94, 0xAF, 0x5F, 
+0xA9, 0x44, 0x95, 0x92, 0x7B, 0x09, 0x3C, 0x89, 
+0x44, 0x36, 0x61, 0x57, 0xD3, 0x9F, 0xC0, 0xD4, 
+0xAB, 0xD7, 0x5C, 0x00, 0x37, 0xB7, 0xB0, 0xF4, 
+0x12, 0x42, 0x26, 0x55, 0x03, 0xCA, 0x61, 0x20, 
+0xF2, 0xD2, 0xEA, 0xF2, 0x93, 0x8B, 0x06, 0xF9, 
+0xD7, 0xF5, 0x2A, 0x28, 0x40, 0xF1, 0x7E, 0x8C, 
+0x59, 0xDC, 0x46, 0x8B, 0x14, 0x5A, 0x5D, 0x4B, 
+0x27, 0xFA, 0xB8, 0x02, 0x9A, 0x22, 0x64, 0x30, 
+0x0E, 0xB9, 0xB8, 0xDA, 0x93, 0xCF, 0xF5, 0xD4, 
+0xB6, 0x5F, 0x1C, 0x96, 0x53, 0x1A, 0xB4, 0xAF, 
+0x96, 0xD6, 0x18, 0x97, 0xB4, 0x8B, 0x1C, 0x56, 
+0x33, 0xB7, 0x6E, 
+
+0x3F, 0x14, 0x25, 0x2D, 0x40, 0x77, 0x23, 0x35, 
+0x74, 0x41, 0xC7, 0xEA, 0xD9, 0x99, 0xC2, 0x88, 
+0xEF, 0x27, 0x5E, 0x34, 0x45, 0x17, 0x6F, 0x85, 
+0x8F, 0x2C, 0xBE, 0xD0, 0xE6, 0x25, 0xE9, 0xF7, 
+0x79, 0x0F, 0x40, 0xE5, 0x4C, 0x15, 0x5C, 0x48, 
+0xF1, 0x25, 0x7B, 0xEA, 0x84, 0xBE, 0x1E, 0x09, 
+0x24, 0x29, 0x50, 0xD2, 0xEA, 0x83, 0x91, 0x5D, 
+0xAB, 0x96, 0x88, 0xEF, 0xB1, 0xDE, 0xE3, 0xF0, 
+0xBF, 0xAB, 0x42, 0x6C, 0x6C, 0xB3, 0x5F, 0xA2, 
+0xC8, 0x96, 0x99, 0x96, 0x0E, 0x3A, 0x9A, 0xE0, 
+0x25, 0x8B, 0xAB, 0x44, 0x28, 0x54, 0xD8, 0xDD, 
+0xD6, 0x18, 0x05, 0xE5, 0xCA, 0x7B, 0xC5, 0xFA, 
+0xAC, 0x46, 0xFD, 0x6F, 0xFD, 0x19, 0x07, 0x00, 
+0x5B, 0x18, 0x9B, 0x3D, 0x36, 0x14, 0x81, 0x5E, 
+0xBB, 0x01, 0xB5, 0xA7, 0xE5, 0xA0, 0xAF, 0xD2, 
+0x36, 0x1B, 0x14, 0x2B, 0x49, 0xF6, 0x4F, 0x84, 
+0xE4, 0xD0, 0xF8, 0x90, 0x43, 0xCC, 0xEE, 0x7B, 
+0x65, 0x7B, 0x56, 0x7E, 0xB5, 0x60, 0x6B, 0x73, 
+0x43, 0xE0, 0x41, 0x2E, 0x57, 0x9E, 0x30, 0xE2, 
+0x43, 0x8F, 0xC8, 0x48, 0xF8, 0xBC, 0xF2, 0xB0, 
+0xCC, 0x67, 0x50, 0x11, 0x41, 0x31, 0x88, 0x60, 
+0xA0, 0xFC, 0x31, 0x3E, 0x30, 0x75, 0x97, 0xBC, 
+0x7E, 0x0E, 0xA2, 0xBD, 0xA9, 0xB3, 0x09, 0x74, 
+0x84, 0xE8, 0x1D, 0xBC, 0xA2, 0x1C, 0x40, 0x64, 
+0x3A, 0x0D, 0xDF, 0x3D, 0x58, 0x6D, 0x7C, 0x63, 
+0x79, 0x2F, 0x83, 0x2D, 0x59, 0x73, 0x3E, 0x15, 
+0x43, 0x69, 0x00, 0x27, 0x4E, 0xBA, 0x31, 0x6E, 
+0x84, 0xE7, 0x9D, 0x48, 0x86, 0x88, 0x0D, 0x18, 
+0x1E, 0xD3, 0x52, 0x01, 0x8E, 0xD1, 0x45, 0x06, 
+0x07, 0x59, 0x95, 0x94, 0x49, 0x26, 0x2B, 0xCB, 
+0x49, 0x5A, 0x50, 0x17, 0xB1, 0xFC, 0x78, 0x67, 
+0xA2, 0x01, 0xBC, 0x63, 
+
+0x73, 0xB2, 0x90, 0xB4, 0x2F, 0x58, 0xC9, 0xCA, 
+0x3A, 0xDF, 0xD6, 0x0D, 0xBD, 0x38, 0xB0, 0xA2, 
+0x4A, 0x7F, 0xC4, 0xEA, 0x9B, 0x89, 0x9E, 0x7C, 
+0x54, 0x43, 0xE1, 0xB6, 0x77, 0x77, 0xD1, 0x41, 
+0x53, 0x0B, 0x06, 0x7E, 0x54, 0xB4, 0x72, 0x63, 
+0x8C, 0x8B, 0x2C, 0xC6, 0x89, 0x1D, 0xF2, 0x7C, 
+0x7B, 0x4D, 0xC2, 0xAC, 0x3F, 0x00, 0x54, 0xD0, 
+0x26, 0x95, 0x23, 0x79, 0x8E, 0x8C, 0xEC, 0xF4, 
+0x74, 0x41, 0x7A, 0xCB, 0x47, 0x67, 0x21, 0x52, 
+0x90, 0x83, 0x3A, 0xA6, 0xCB, 0x2E, 0x7B, 0xA2, 
+0xA7, 0x95, 0x17, 0x50, 0xE7, 0x46, 0x40, 0x3D, 
+0xD5, 0x3C, 0x5D, 0xFC, 0x93, 0x96, 0x49, 0xB2, 
+0xE4, 0xEA, 0x65, 0x46, 0xAE, 0xEF, 0x11, 0xF5, 
+0x4D, 0xDC, 0xEC, 0x91, 0xC0, 0x2E, 0x8C, 0xFC, 
+0x9E, 0x7F, 0x9A, 0x79, 0xE9, 0xBA, 0x66, 0x29, 
+0x90, 0x55, 0xBD, 0xEC, 0x63, 0xEA, 0x62, 0xB1, 
+0x78, 0x35, 0x5B, 0x8C, 0xB6, 0x99, 0xE6, 0xF6, 
+0xBB, 0x74, 0x29, 0x00, 0x7A, 0x59, 0x69, 0xA4, 
+0x09, 0x84, 0x18, 0x46, 0x13, 0x83, 0xB9, 0x0C, 
+0xD9, 0xF6, 0x74, 0xAE, 0x1A, 0xE2, 0x86, 0xD9, 
+0x03, 0x2F, 0x36, 0x5E, 0x14, 0xED, 0xBA, 0x69, 
+0xA3, 0x1F, 0x33, 0x02, 0xBE, 0xD2, 0x07, 0xFD, 
+0xBE, 0xE4, 0x04, 0x85, 0x3B, 0x00, 0x7B, 0xCB, 
+0x3E, 0x5D, 0x59, 0xFE, 0xCB, 0xCC, 0x60, 0x9F, 
+0x8A, 0xE8, 0xBF, 0x23, 0xB1, 0xFE, 0xC1, 0x1B, 
+0x1E, 0xE6, 0xB5, 0xAE, 0x25, 0xD3, 0x55, 0x86, 
+0xC9, 0x28, 0x4F, 0x6A, 0xC8, 0xD7, 0x14, 0xBC, 
+0xEA, 0x14, 0x40, 0xF6, 0xBA, 0x55, 0x4A, 0x21, 
+0x60, 0x5B, 0x15, 0x90, 0xB6, 0x9D, 0x7E, 0x84, 
+0x06, 0x21, 0x02, 0xCE, 0x61, 0xAB, 0xA2, 0xE2, 
+0x8F, 0xA2, 0xA8, 0x2E, 0x02, 0x27, 0x88, 0x37, 
+0x90, 0xFD, 0xA9, 0x93, 0xD1, 
+
+0x61, 0x05, 0x0A, 0x72, 0x19, 0x39, 0x6B, 0xEB, 
+0xF8, 0xF9, 0x64, 0x69, 0x41, 0x7E, 0xB7, 0x6F, 
+0xAC, 0x95, 0x14, 0x38, 0x92, 0x7F, 0x29, 0x50, 
+0x1A, 0xA7, 0xD4, 0x72, 0xE4, 0x38, 0xDC, 0x60, 
+0xEB, 0xDF, 0x40, 0x67, 0xE5, 0xE4, 0x21, 0x93, 
+0xCD, 0x1C, 0xFC, 0x5F, 0x56, 0x11, 0xE2, 0x99, 
+0x96, 0xF7, 0xF1, 0x37, 0xD7, 0xA2, 0xF2, 0xEA, 
+0x32, 0xBF, 0x34, 0xD2, 0xEE, 0xF9, 0xF3, 0xF7, 
+0x4B, 0x97, 0x0C, 0x8C, 0xF6, 0x43, 0x5B, 0x5C, 
+0xE9, 0xA8, 0x73, 0xC9, 0xE5, 0x64, 0x0D, 0x98, 
+0x71, 0x97, 0xAF, 0x0F, 0x4B, 0x95, 0xCB, 0x33, 
+0x99, 0x63, 0x98, 0xF8, 0xD0, 0xD8, 0x74, 0x64, 
+0x18, 0x0B, 0xDF, 0x72, 0x72, 0x61, 0x74, 0xC1, 
+0x58, 0x87, 0xC5, 0xDD, 0xA1, 0x57, 0xB9, 0x66, 
+0x45, 0x65, 0xE4, 0x78, 0x08, 0x78, 0xA0, 0xF8, 
+0x4D, 0x9C, 0xE7, 0x59, 0xA6, 0x18, 0x9E, 0x83, 
+0xB0, 0x31, 0xDB, 0x72, 0x21, 0xCB, 0xAD, 0xA7, 
+0x87, 0x06, 0x7D, 0xFF, 0xC0, 0x0E, 0x4B, 0x21, 
+0x7F, 0x9E, 0x3B, 0xCF, 0x08, 0x5B, 0x84, 0x35, 
+0xD3, 0x0E, 0x8B, 0x7E, 0x87, 0xB2, 0x47, 0xF9, 
+0xC6, 0xA2, 0x4D, 0x71, 0xF3, 0x85, 0x43, 0x71, 
+0xD8, 0xBB, 0xA4, 0xB2, 0x7F, 0xBB, 0xFA, 0x00, 
+0xC7, 0xF7, 0x97, 0xA9, 0x37, 0x87, 0x67, 0xDC, 
+0x57, 0x6C, 0xA8, 0x42, 0xFD, 0x41, 0x38, 0x73, 
+0x1F, 0xF1, 0x74, 0x9E, 0xC1, 0x9D, 0xD8, 0xDD, 
+0xD9, 0xFF, 0x36, 0x6A, 0x72, 0x6E, 0x6A, 0xCE, 
+0xC3, 0xA4, 0x65, 0xAD, 0xB2, 0x03, 0xB5, 0x09, 
+0x11, 0x98, 0xC3, 0x51, 0x82, 0xE5, 0x6D, 0x40, 
+0x8C, 0x22, 0x9D, 0x52, 0x60, 0xC7, 0x4D, 0x4C, 
+0xDB, 0xF2, 0x9D, 0x6A, 0x10, 0x16, 0x7F, 0x03, 
+0xA4, 0xAA, 0xAF, 0x93, 0x32, 0xF4, 0x5A, 0xE6, 
+0x8C, 0x4A, 0x59, 0x67, 0x4D, 0x6E, 
+
+0x38, 0xE5, 0x1C, 0x6A, 0xA4, 0x78, 0x25, 0xBF, 
+0x86, 0x2C, 0x2A, 0x7D, 0x40, 0x7F, 0x4D, 0x86, 
+0x07, 0xF0, 0xEC, 0x60, 0xAD, 0x79, 0xA2, 0xDD, 
+0x7D, 0x6A, 0xA7, 0xDC, 0x68, 0xDA, 0x71, 0x41, 
+0x4C, 0x64, 0x17, 0x35, 0x7A, 0x7C, 0xFD, 0x5E, 
+0xB2, 0x70, 0xEC, 0xB2, 0xDE, 0x44, 0x9F, 0xCF, 
+0xCD, 0x82, 0xF8, 0x5A, 0x2F, 0x4B, 0x82, 0xAB, 
+0xED, 0xDB, 0xD0, 0x79, 0x80, 0x0A, 0x54, 0x64, 
+0xDA, 0x26, 0x94, 0x68, 0x83, 0xB4, 0xA8, 0xD5, 
+0x62, 0x36, 0x16, 0x23, 0xAA, 0xDF, 0xA3, 0xD9, 
+0x24, 0x6C, 0xDA, 0x0C, 0xA9, 0x7F, 0x43, 0x7E, 
+0x87, 0x4B, 0x63, 0x85, 0x4E, 0xB9, 0x8D, 0xD9, 
+0x92, 0xD3, 0x08, 0x70, 0xFF, 0x41, 0xA6, 0x42, 
+0xB6, 0x41, 0x55, 0xB9, 0x80, 0xFE, 0xAB, 0xF0, 
+0x5E, 0xE7, 0xA1, 0x72, 0xC5, 0x6D, 0x82, 0x08, 
+0x7B, 0xFD, 0x81, 0xF4, 0x5E, 0x5F, 0x90, 0x72, 
+0xF1, 0xA0, 0xE8, 0x36, 0x90, 0xE0, 0x8B, 0x63, 
+0x36, 0x2A, 0x98, 0x88, 0x2D, 0x4F, 0x37, 0xF1, 
+0x1F, 0xAA, 0xA1, 0x36, 0xDA, 0xC0, 0xAF, 0x88, 
+0x20, 0xAE, 0x5D, 0xE4, 0x8F, 0x3F, 0x39, 0x85, 
+0x1C, 0xD6, 0x70, 0xF2, 0xCB, 0x82, 0x22, 0xB6, 
+0x2E, 0x58, 0x11, 0x23, 0x02, 0xD2, 0x6B, 0xAA, 
+0x9E, 0x97, 0xEE, 0xF1, 0xEA, 0x5C, 0x41, 0xB0, 
+0xA0, 0x40, 0x38, 0xBB, 0xD6, 0x76, 0x0F, 0x29, 
+0xA4, 0xDD, 0x4A, 0x54, 0x79, 0xEE, 0x43, 0x32, 
+0xD4, 0xCC, 0xE6, 0x92, 0xFE, 0x6B, 0x23, 0x13, 
+0x9B, 0xC4, 0xFD, 0x47, 0x79, 0x71, 0xB3, 0x0B, 
+0xF4, 0x55, 0xBB, 0xBA, 0x21, 0x8A, 0x89, 0xEC, 
+0x86, 0x26, 0x45, 0xF1, 0x43, 0x47, 0x25, 0x86, 
+0x4A, 0x0C, 0x05, 0x4C, 0x03, 0xE2, 0x4A, 0xD0, 
+0x2E, 0x67, 0xAE, 0x7F, 0x75, 0x2A, 0x8A, 0xC3, 
+0x66, 0x88, 0x49, 0xC6, 0xCA, 0x81, 0x44, 
+
+0xC7, 0x1D, 0x93, 0x59, 0x29, 0x38, 0x02, 0xCA, 
+0xEF, 0x94, 0x6A, 0xF1, 0x6A, 0x67, 0x62, 0x6B, 
+0x25, 0xFC, 0x58, 0x72, 0x0E, 0x70, 0xEC, 0xA0, 
+0xB6, 0xAB, 0x1F, 0x54, 0x08, 0xA2, 0xCD, 0x5C, 
+0xE3, 0x07, 0x2A, 0x9E, 0xE6, 0x79, 0x2E, 0x06, 
+0xAE, 0x9D, 0x65, 0x6F, 0x28, 0xF9, 0x67, 0xF0, 
+0xDA, 0x90, 0x2C, 0x13, 0xB9, 0x94, 0xD2, 0x8A, 
+0xF7, 0xAE, 0xE8, 0x6D, 0x64, 0x57, 0x80, 0x69, 
+0xFC, 0xFC, 0x02, 0x94, 0x0C, 0xE6, 0xB0, 0x00, 
+0x53, 0xB7, 0x6C, 0x82, 0x33, 0xE0, 0x1F, 0xDC, 
+0x76, 0x65, 0x54, 0x46, 0x97, 0x8E, 0xCB, 0xC5, 
+0xA0, 0xDB, 0x51, 0x82, 0xE3, 0x31, 0x4A, 0x55, 
+0x07, 0x79, 0xFB, 0x7D, 0xFE, 0x9B, 0x43, 0x69, 
+0x04, 0x84, 0x61, 0xB0, 0x40, 0xE3, 0x34, 0x67, 
+0xBE, 0x18, 0x4F, 0xED, 0x81, 0x98, 0x8C, 0xFF, 
+0x4C, 0x6F, 0x91, 0xC3, 0x6D, 0xE4, 0x7F, 0x01, 
+0x0E, 0x06, 0xEA, 0x55, 0xFA, 0xE6, 0x0B, 0x65, 
+0xF3, 0xFB, 0x91, 0x63, 0x7C, 0x56, 0xC0, 0x8F, 
+0xD4, 0xDE, 0x61, 0x70, 0x3F, 0x24, 0xF8, 0xF5, 
+0x8A, 0x59, 0x33, 0x6D, 0x8D, 0x88, 0x23, 0x87, 
+0x17, 0x16, 0x97, 0xE1, 0xAC, 0x63, 0x52, 0x98, 
+0x28, 0x43, 0xDF, 0x10, 0x9E, 0x2A, 0xBF, 0x08, 
+0x2D, 0xFB, 0xD4, 0xB6, 0x8B, 0x3E, 0x92, 0xCD, 
+0x70, 0xDF, 0xDF, 0xA6, 0x87, 0x7C, 0xB4, 0x19, 
+0x53, 0xF1, 0xAF, 0xBD, 0x10, 0x89, 0xDF, 0x3D, 
+0xCB, 0x24, 0x03, 0xDB, 0xB2, 0xE5, 0xBE, 0xAD, 
+0xCE, 0x71, 0x49, 0x42, 0x3E, 0x46, 0xE7, 0x73, 
+0xD4, 0xE0, 0xD3, 0xE0, 0xEA, 0x21, 0xEC, 0x79, 
+0xD6, 0xCF, 0x36, 0x9A, 0xAB, 0xB8, 0xA6, 0x76, 
+0xEC, 0x0F, 0xEB, 0x96, 0x6F, 0x93, 0xC5, 0x52, 
+0xA1, 0x43, 0xF7, 0x1F, 0x20, 0x09, 0xC2, 0x8C, 
+0xFE, 0x8B, 0xC8, 0x94, 0xA3, 0xF6, 0x6D, 0x5D, 
+
+0x65, 0xDA, 0xAB, 0x86, 0x44, 0xC6, 0xFE, 0x8D, 
+0x24, 0x1F, 0x33, 0x6E, 0x5A, 0x61, 0x19, 0xDD, 
+0xF4, 0xDE, 0xAC, 0x24, 0x39, 0x97, 0x15, 0xAD, 
+0x85, 0x8B, 0xCF, 0x44, 0x88, 0x53, 0x4A, 0xA6, 
+0x3A, 0x84, 0xF7, 0x4B, 0x0B, 0x4C, 0x5E, 0xC0, 
+0x7A, 0xA8, 0xA4, 0xEB, 0x89, 0x0D, 0xDE, 0x3F, 
+0xE6, 0x83, 0x8B, 0x91, 0x05, 0x0F, 0x33, 0x26, 
+0xBE, 0x46, 0x6D, 0xA9, 0xA9, 0xCA, 0xCC, 0x12, 
+0x04, 0x02, 0xF0, 0x88, 0x03, 0xC3, 0xE5, 0x64, 
+0xFB, 0x41, 0xC7, 0xA8, 0x44, 0x89, 0xBC, 0xBC, 
+0xAD, 0xAF, 0x09, 0x42, 0xAB, 0x19, 0xBC, 0x4C, 
+0xF4, 0x20, 0x02, 0x55, 0x3E, 0xC8, 0x08, 0x27, 
+0xE8, 0x56, 0x8C, 0x34, 0x89, 0xCC, 0xF5, 0x39, 
+0xF1, 0x5D, 0x3B, 0x6B, 0xDC, 0xDD, 0x86, 0x78, 
+0x8D, 0x2B, 0x4F, 0x38, 0x02, 0xF1, 0xE5, 0xD3, 
+0xD7, 0xB6, 0xF1, 0x20, 0x6D, 0x61, 0xBE, 0x90, 
+0xA8, 0xEA, 0xF6, 0x25, 0x55, 0x0F, 0x6D, 0x87, 
+0x99, 0x0F, 0x23, 0xA3, 0x7B, 0xAD, 0x70, 0x5E, 
+0x12, 0x94, 0x15, 0xB8, 0x9E, 0x88, 0xDB, 0x51, 
+0x01, 0x97, 0x30, 0x22, 0x21, 0x01, 0xB0, 0x2D, 
+0x7D, 0x52, 0x5E, 0x53, 0xC0, 0x7E, 0xB0, 0xC7, 
+0x72, 0x86, 0x90, 0x0C, 0x48, 0x76, 0x27, 0xC5, 
+0x85, 0xA1, 0x16, 0x50, 0xAD, 0x66, 0xC0, 0x43, 
+0xF2, 0x7B, 0x1D, 0x2A, 0x71, 0x1B, 0xF0, 0x67, 
+0xF4, 0x07, 0x04, 0x18, 0xA0, 0x5A, 0xED, 0x76, 
+0xD4, 0xD6, 0xC8, 0xA8, 0xAB, 0x44, 0x08, 0x9C, 
+0x08, 0x67, 0x44, 0xDB, 0x17, 0x08, 0x60, 0x24, 
+0x31, 0x73, 0x8C, 0xC7, 0xD8, 0x1E, 0x21, 0x6E, 
+0x10, 0x37, 0x94, 0x6B, 0x7A, 0x82, 0x8D, 0xAD, 
+0x6A, 0xC8, 0xE8, 0x06, 0x01, 0xE4, 0xBA, 0x42, 
+0x52, 0x94, 0x4A, 0x0C, 0xFD, 0x28, 0x7F, 0x33, 
+0x3B, 0xF4, 0x2B, 0xC5, 0x06, 0xB5, 0xD0, 0xC3, 
+0xA3, 
+
+0x9D, 0xF2, 0xCE, 0x16, 0x17, 0x63, 0x88, 0x60, 
+0x3B, 0x9D, 0xB9, 0x89, 0x71, 0x85, 0xBC, 0x5F, 
+0xC6, 0xB0, 0xD0, 0xFB, 0xF3, 0xDA, 0x2D, 0x14, 
+0xF2, 0xA1, 0x5A, 0x2D, 0x28, 0x9A, 0xFE, 0xEC, 
+0x4E, 0x26, 0xE3, 0x3E, 0xF2, 0x96, 0xD2, 0xA8, 
+0xC9, 0xD4, 0x69, 0xE1, 0x70, 0x09, 0xBD, 0xA0, 
+0x38, 0x2F, 0xBE, 0xAC, 0x54, 0x71, 0xC6, 0x2E, 
+0x27, 0x0D, 0xB3, 0x49, 0xAC, 0xC0, 0xD5, 0xAE, 
+0x11, 0xF5, 0x3F, 0x0F, 0x90, 0xC1, 0xA5, 0xE4, 
+0x63, 0x14, 0x0B, 0x90, 0x89, 0x6B, 0xA1, 0xFB, 
+0x58, 0x04, 0x37, 0xCF, 0xED, 0x5E, 0x30, 0xCE, 
+0x4E, 0x89, 0xEE, 0x6C, 0x70, 0xA8, 0x50, 0x98, 
+0x0E, 0x75, 0x10, 0xAB, 0x8A, 0xED, 0x6C, 0x16, 
+0x2E, 0x9E, 0x13, 0x0D, 0x4A, 0x01, 0xFA, 0x67, 
+0x8F, 0x7F, 0xF2, 0x5A, 0xB4, 0xC3, 0x75, 0xEC, 
+0x6F, 0xC4, 0xA1, 0x18, 0x0D, 0xEC, 0x42, 0xDB, 
+0x55, 0x7E, 0xFE, 0xAF, 0x91, 0x79, 0xA4, 0xBE, 
+0x79, 0x67, 0xCD, 0xE6, 0x61, 0x81, 0xE8, 0x67, 
+0x77, 0x58, 0x34, 0x07, 0x4B, 0xB3, 0x2F, 0x75, 
+0xE7, 0x6D, 0xE2, 0xD8, 0xFF, 0x27, 0x11, 0x86, 
+0x0F, 0x6D, 0x07, 0xEA, 0xDB, 0x5E, 0x4A, 0xE4, 
+0x1B, 0xD3, 0xBC, 0x03, 0x6C, 0x13, 0x54, 0xDC, 
+0x64, 0x9F, 0x71, 0xDD, 0x1C, 0x10, 0x4F, 0xA8, 
+0x70, 0x29, 0xD8, 0x49, 0x0A, 0x58, 0xB2, 0x69, 
+0xF9, 0x65, 0x21, 0x80, 0x2F, 0x70, 0x27, 0x1E, 
+0x10, 0xB6, 0x79, 0x90, 0xE5, 0x91, 0x3A, 0x76, 
+0xB1, 0x91, 0x9C, 0xC2, 0x68, 0x80, 0x72, 0x89, 
+0x30, 0x1C, 0x07, 0xA1, 0xB8, 0xC3, 0xB9, 0xB1, 
+0xBA, 0xFD, 0xB1, 0xB7, 0x5C, 0x92, 0x57, 0xB0, 
+0x3F, 0xCB, 0x55, 0xE8, 0xE3, 0x97, 0xCA, 0x2C, 
+0x80, 0xA5, 0x9B, 0x6B, 0x33, 0x23, 0x4E, 0x09, 
+0x0B, 0x9F, 0x0C, 0x82, 0x61, 0x60, 0x32, 0xD7, 
+0xC7, 0x51, 
+
+0xEB, 0xBE, 0x91, 0xF3, 0x96, 0x95, 0x89, 0x48, 
+0x5D, 0xC5, 0xBD, 0x12, 0x94, 0xA8, 0x13, 0x1C, 
+0x2C, 0xA4, 0x87, 0x3D, 0xF4, 0x5C, 0xC9, 0x80, 
+0xEE, 0xDC, 0x8B, 0x81, 0x9B, 0x6D, 0x33, 0x35, 
+0xCC, 0xA7, 0x09, 0xD3, 0x46, 0xBC, 0x6E, 0x7B, 
+0x7A, 0x2C, 0x2D, 0x5F, 0xB0, 0xA1, 0x94, 0x3D, 
+0x25, 0x03, 0xF6, 0x95, 0x9F, 0x83, 0xAF, 0x9B, 
+0xA3, 0x23, 0x71, 0xE9, 0xA4, 0x40, 0x45, 0x81, 
+0xBD, 0xD1, 0x03, 0x1E, 0x0A, 0x85, 0xC3, 0xFA, 
+0x48, 0xB0, 0x2D, 0xC6, 0xB9, 0xD2, 0x3C, 0x37, 
+0x1F, 0xBB, 0xB2, 0x00, 0x01, 0x72, 0x16, 0xEF, 
+0x21, 0xE7, 0x3E, 0xC5, 0x99, 0x32, 0xDE, 0x64, 
+0xF4, 0xCB, 0x7C, 0xC5, 0x0F, 0xED, 0x62, 0x7F, 
+0x95, 0xFF, 0xDF, 0x05, 0x7F, 0x71, 0x76, 0x67, 
+0x52, 0x5C, 0xD9, 0x26, 0xCE, 0x1E, 0x26, 0xB2, 
+0xAB, 0xD4, 0xFE, 0xDE, 0xCD, 0xD2, 0x4B, 0x90, 
+0x9F, 0x16, 0xB7, 0x2C, 0x3B, 0xBC, 0x5E, 0x35, 
+0x27, 0x37, 0x21, 0x72, 0xC7, 0x5C, 0xA0, 0xAE, 
+0xAF, 0xD7, 0xEE, 0x94, 0xDF, 0x0B, 0xEC, 0x1B, 
+0x2F, 0x78, 0xC1, 0x23, 0x4C, 0xC2, 0x9B, 0x02, 
+0x1A, 0x2B, 0x05, 0x70, 0x58, 0xB9, 0x5F, 0x3B, 
+0x35, 0xF7, 0xF7, 0x75, 0x15, 0xCE, 0x9A, 0x70, 
+0x31, 0xD9, 0x91, 0x49, 0xAE, 0xC4, 0x7D, 0x4A, 
+0x54, 0x70, 0xF9, 0xF2, 0x98, 0x61, 0xD2, 0xD8, 
+0x8A, 0xFD, 0x80, 0x22, 0x3E, 0x3F, 0x3A, 0x25, 
+0x6F, 0x20, 0xC8, 0xB1, 0xBF, 0x8C, 0x38, 0xC4, 
+0x17, 0xA3, 0xD4, 0xD7, 0xA0, 0x30, 0xB4, 0x6C, 
+0x2F, 0x74, 0x72, 0x67, 0x9A, 0xB1, 0x63, 0xC1, 
+0x03, 0x55, 0x14, 0x99, 0x99, 0xC0, 0x32, 0xA0, 
+0x6F, 0x00, 0xC3, 0xC0, 0x70, 0x90, 0xE9, 0x6C, 
+0xE2, 0xC6, 0x73, 0x6E, 0x00, 0x25, 0xBA, 0x06, 
+0x1B, 0x4D, 0x3B, 0xDE, 0x06, 0x8C, 0x66, 0x2A, 
+0xF4, 0x01, 0xAD, 
+
+0x92, 0x1F, 0xC0, 0xFA, 0x2C, 0x01, 0x5E, 0x95, 
+0xE9, 0x84, 0x1F, 0x0C, 0x71, 0xE8, 0x60, 0x35, 
+0xD8, 0xDE, 0xA1, 0xA0, 0xFF, 0x6B, 0x28, 0x81, 
+0xDF, 0x0E, 0x48, 0xF8, 0x62, 0xE3, 0xF2, 0x8F, 
+0x68, 0xCE, 0x89, 0x79, 0x9F, 0xC0, 0x77, 0xE0, 
+0x52, 0x0D, 0x6E, 0xCA, 0x76, 0x32, 0xAB, 0xA4, 
+0xEB, 0x95, 0x8B, 0xE1, 0xBA, 0x63, 0x10, 0x28, 
+0x50, 0xEF, 0x21, 0x3B, 0xDA, 0x36, 0x11, 0x74, 
+0x70, 0x2B, 0xCA, 0x34, 0xBB, 0x60, 0xFC, 0xDA, 
+0x80, 0x27, 0xB7, 0x6C, 0x7E, 0xD5, 0x0F, 0x23, 
+0x70, 0x16, 0xC6, 0xD6, 0x2F, 0x85, 0xB4, 0xBA, 
+0x1B, 0xF2, 0x50, 0x45, 0x80, 0xE7, 0xC4, 0x94, 
+0x23, 0x04, 0x27, 0x3F, 0x03, 0x67, 0x90, 0x6A, 
+0x2D, 0x9F, 0xA9, 0x3D, 0x5D, 0x1B, 0x84, 0xED, 
+0x92, 0xE7, 0x7E, 0xF3, 0x4E, 0x75, 0x7A, 0x08, 
+0x73, 0x18, 0x58, 0x00, 0x6C, 0x26, 0x4A, 0x66, 
+0xE2, 0x2F, 0x8C, 0x87, 0xF8, 0xE9, 0xA4, 0xA3, 
+0xD9, 0x3A, 0x42, 0x7C, 0xCD, 0x88, 0xFC, 0x2A, 
+0xC7, 0x86, 0x4D, 0xA9, 0x6F, 0x58, 0x7C, 0x35, 
+0xEA, 0x7F, 0xE2, 0x2E, 0xB6, 0xD0, 0x25, 0x6A, 
+0x8A, 0x26, 0x08, 0x03, 0x58, 0x6F, 0xBA, 0x02, 
+0x08, 0x35, 0xB9, 0xF7, 0x1A, 0x4E, 0xD6, 0xD5, 
+0x91, 0xE8, 0x70, 0x1C, 0x22, 0x0D, 0x58, 0xC7, 
+0x77, 0xF1, 0x73, 0x3A, 0xF9, 0x45, 0x2E, 0xC4, 
+0x5A, 0x3C, 0x08, 0xCA, 0xD4, 0x0E, 0x94, 0x75, 
+0xF7, 0x4B, 0xB1, 0x68, 0x1A, 0x2D, 0xA7, 0xF9, 
+0x50, 0xAA, 0x81, 0xE1, 0xAB, 0xFC, 0x0D, 0xA5, 
+0x92, 0xEF, 0x62, 0xD7, 0x40, 0xE2, 0xD4, 0x1D, 
+0xC3, 0x07, 0x58, 0x2C, 0xC4, 0xE7, 0xA2, 0xE4, 
+0x26, 0xD7, 0xCA, 0x85, 0x6E, 0x9B, 0xC3, 0x40, 
+0x29, 0xED, 0x9A, 0x60, 0x59, 0x9C, 0xD8, 0x15, 
+0x62, 0x31, 0x1E, 0x6D, 0x91, 0xEA, 0xDD, 0x5D, 
+0xE2, 0x43, 0x36, 0x82, 
+
+0x64, 0x0C, 0x9E, 0x81, 0x7A, 0x3D, 0x71, 0x35, 
+0x79, 0x9E, 0x9A, 0xA9, 0x29, 0x84, 0xA4, 0x0E, 
+0xBA, 0xDD, 0x22, 0x06, 0xF7, 0x28, 0xBA, 0x9F, 
+0xBF, 0xFE, 0x61, 0xCC, 0xBE, 0x03, 0xE2, 0x03, 
+0x6B, 0xA2, 0xA7, 0x0B, 0x52, 0x48, 0xA6, 0xDF, 
+0x05, 0x1A, 0xC1, 0xB8, 0x05, 0x25, 0xB8, 0x54, 
+0x74, 0x64, 0xCA, 0xEA, 0x45, 0xE5, 0x93, 0x15, 
+0x83, 0x59, 0xDF, 0x90, 0xFE, 0x1A, 0xF1, 0x56, 
+0x18, 0xF5, 0x40, 0x97, 0x7D, 0x53, 0x45, 0xA5, 
+0x59, 0x8F, 0xDD, 0xE8, 0xE3, 0x20, 0x64, 0x40, 
+0xBD, 0x60, 0xAC, 0xC5, 0xA5, 0x01, 0x7A, 0x77, 
+0x53, 0xB6, 0x7F, 0x60, 0x61, 0x3B, 0x78, 0x83, 
+0x31, 0xBC, 0x59, 0x9A, 0x6D, 0x96, 0xB2, 0x7A, 
+0xAB, 0x00, 0xE9, 0x4E, 0x6A, 0xBB, 0x3B, 0x9A, 
+0x70, 0x9C, 0xCB, 0x2C, 0x24, 0x05, 0x4D, 0xAC, 
+0x5F, 0x54, 0x8B, 0x9A, 0xE7, 0x50, 0x91, 0xF3, 
+0x6C, 0xA8, 0xA3, 0x46, 0x48, 0x79, 0x5F, 0x53, 
+0x04, 0x98, 0x2C, 0x92, 0x1B, 0xA5, 0x6E, 0x39, 
+0x2C, 0x55, 0xD1, 0xD1, 0x15, 0x4F, 0xC9, 0x8F, 
+0x26, 0xC5, 0xA8, 0x8E, 0xA6, 0x10, 0x19, 0x68, 
+0xEF, 0x0D, 0x21, 0x90, 0xA8, 0x37, 0x8F, 0x7F, 
+0xCD, 0x57, 0x34, 0x28, 0xEF, 0x91, 0xDD, 0x20, 
+0x0A, 0xC0, 0x13, 0x76, 0xF9, 0x66, 0xF4, 0x2A, 
+0x57, 0xBB, 0xE1, 0xCB, 0x2A, 0x86, 0x91, 0x86, 
+0x61, 0x22, 0x1D, 0x09, 0xAA, 0x12, 0x36, 0x98, 
+0x2C, 0x64, 0xC5, 0x24, 0x5D, 0x17, 0xD4, 0x2B, 
+0xB5, 0x27, 0x24, 0x04, 0x10, 0xD9, 0xC1, 0x9E, 
+0x7A, 0x74, 0x16, 0xAD, 0xCB, 0x70, 0x22, 0x4B, 
+0x9A, 0xB5, 0xCC, 0xDC, 0x01, 0x8C, 0xEF, 0x2A, 
+0x55, 0x87, 0xF3, 0xAB, 0x0E, 0x61, 0xC7, 0xFA, 
+0xDA, 0x87, 0xA5, 0x29, 0x7E, 0xD7, 0x71, 0xED, 
+0x11, 0xBE, 0xD3, 0x21, 0xF7, 0x63, 0xB7, 0x63, 
+0x72, 0x77, 0xB0, 0xB7, 0x36, 
+
+0x46, 0x2A, 0xC4, 0x9D, 0x98, 0xC9, 0xD3, 0xF0, 
+0xFE, 0x3F, 0x88, 0x2D, 0x5B, 0xDF, 0x84, 0x22, 
+0x52, 0x60, 0x55, 0xD7, 0x85, 0x41, 0x6F, 0xBD, 
+0xC5, 0x81, 0xE2, 0x57, 0x7D, 0x95, 0x5A, 0x24, 
+0x3C, 0x9D, 0xA6, 0x17, 0xEB, 0x06, 0xB1, 0x7F, 
+0xB4, 0xF6, 0xF2, 0xCC, 0xD7, 0xF8, 0x0A, 0x03, 
+0xF3, 0x2F, 0x72, 0x7A, 0x16, 0xC3, 0x35, 0xEC, 
+0x70, 0x96, 0xB0, 0x3B, 0x6F, 0x04, 0x9F, 0x4D, 
+0xFF, 0xFD, 0xE8, 0xCD, 0x24, 0xED, 0x35, 0x70, 
+0x6E, 0x71, 0x99, 0xE6, 0xBD, 0x23, 0x81, 0x93, 
+0x2A, 0x52, 0xB9, 0x02, 0xA8, 0x2D, 0x81, 0xA8, 
+0x73, 0x21, 0xE8, 0x97, 0x60, 0x69, 0x56, 0x64, 
+0x7B, 0x94, 0x4C, 0x23, 0x89, 0x56, 0xF8, 0xE1, 
+0x07, 0x74, 0x0B, 0x4B, 0x71, 0xE0, 0x51, 0xF2, 
+0xF0, 0x62, 0x43, 0xB0, 0xB3, 0xBA, 0x94, 0x78, 
+0x9F, 0xA5, 0xA2, 0xFF, 0xA0, 0xAA, 0xC4, 0x55, 
+0x0C, 0x88, 0x29, 0xE7, 0xBC, 0x4E, 0x4A, 0x10, 
+0x7C, 0x98, 0x73, 0x73, 0x35, 0x58, 0xFF, 0x4A, 
+0xAF, 0xBF, 0x8C, 0x47, 0xF5, 0xC3, 0x0F, 0x2E, 
+0xD6, 0xEE, 0xCB, 0xC0, 0x66, 0x48, 0xB6, 0x51, 
+0x8C, 0xD6, 0x84, 0x34, 0xBA, 0xA1, 0x79, 0x72, 
+0x62, 0x79, 0xD1, 0x7E, 0x53, 0xCC, 0xF8, 0x5C, 
+0x65, 0x38, 0xAB, 0xE7, 0xE0, 0x21, 0xB9, 0x1F, 
+0x49, 0xD1, 0x17, 0xFD, 0x36, 0x49, 0x50, 0x3F, 
+0x23, 0x7E, 0xD9, 0xDF, 0x0A, 0x76, 0x89, 0x12, 
+0x28, 0x9D, 0xD3, 0x8C, 0x1B, 0x0F, 0xEE, 0x71, 
+0x2B, 0x17, 0x1A, 0xDA, 0x23, 0xF7, 0xF3, 0xB2, 
+0x71, 0x79, 0x9D, 0xBE, 0xCE, 0x8F, 0xF2, 0x62, 
+0x5A, 0x4B, 0x98, 0x14, 0xCE, 0x1B, 0x8F, 0x69, 
+0xB6, 0x12, 0x5C, 0x93, 0x1A, 0x5D, 0x19, 0x2E, 
+0x6D, 0x88, 0xED, 0xEB, 0x06, 0x68, 0xC9, 0xBE, 
+0x78, 0xB4, 0x54, 0xF4, 0xB6, 0x56, 0xED, 0x20, 
+0xF1, 0x35, 0x6F, 0xE4, 0xF1, 0x45, 
+
+0x0F, 0xD4, 0x1A, 0x3C, 0x22, 0x5B, 0x10, 0x9B, 
+0x65, 0x2A, 0x32, 0x47, 0x51, 0x1D, 0xC5, 0x42, 
+0x12, 0x81, 0x1D, 0x3C, 0x7E, 0xBD, 0xD7, 0x7F, 
+0xF2, 0x6E, 0x01, 0x8D, 0x54, 0x9E, 0x4E, 0xF4, 
+0x1D, 0x1E, 0xC5, 0x0D, 0x3B, 0xBB, 0x5B, 0x72, 
+0x07, 0xF3, 0xFA, 0xBA, 0x82, 0xEC, 0xAD, 0x49, 
+0xE8, 0x92, 0xDA, 0x33, 0x50, 0x84, 0xD4, 0x3C, 
+0x3D, 0x88, 0x1F, 0xB9, 0x4F, 0xDF, 0x1B, 0x93, 
+0x01, 0x96, 0x5D, 0x56, 0xF7, 0x1F, 0xF9, 0x59, 
+0xD3, 0x3C, 0x9F, 0xF7, 0x6F, 0x78, 0x36, 0x24, 
+0xFF, 0x96, 0xFE, 0x8E, 0x9E, 0x0E, 0x99, 0x1E, 
+0x4D, 0xB2, 0x5C, 0x07, 0xE2, 0x3C, 0x68, 0xE1, 
+0x8E, 0x18, 0x2D, 0x03, 0x68, 0x02, 0xD6, 0xA2, 
+0x08, 0xA6, 0xB8, 0x2E, 0xA1, 0x45, 0x1C, 0x9A, 
+0xB6, 0x65, 0xF9, 0x30, 0xDD, 0x67, 0x73, 0xEA, 
+0x88, 0xB0, 0xBD, 0x82, 0xAE, 0xF3, 0xD9, 0xCB, 
+0x28, 0x52, 0x72, 0xA2, 0x33, 0x83, 0xD3, 0xCF, 
+0xEE, 0x0E, 0x18, 0x08, 0x8D, 0x7F, 0x69, 0xCA, 
+0x6E, 0x9E, 0xC0, 0x62, 0xE9, 0xDC, 0x3A, 0xD6, 
+0x80, 0xA2, 0xDF, 0xDF, 0x96, 0x8C, 0xE7, 0xAD, 
+0x30, 0x19, 0x53, 0xA8, 0x7C, 0xF7, 0x9E, 0x0B, 
+0x65, 0xFC, 0x88, 0x19, 0x98, 0x41, 0x35, 0xA8, 
+0x17, 0xB3, 0x00, 0x7A, 0xB6, 0x00, 0x55, 0xB5, 
+0x6C, 0x78, 0xF7, 0x90, 0x68, 0x20, 0xE4, 0xCE, 
+0x38, 0x75, 0x40, 0x0F, 0x75, 0x2F, 0x18, 0x94, 
+0x59, 0x19, 0x06, 0xE0, 0x05, 0xB5, 0x2E, 0x59, 
+0x1B, 0x65, 0x9C, 0x16, 0xC5, 0x1E, 0x45, 0x1A, 
+0x50, 0x28, 0xAB, 0x39, 0xF8, 0xB5, 0x1D, 0x7E, 
+0xB7, 0xE5, 0x4E, 0x28, 0xF6, 0x2A, 0x9B, 0xF3, 
+0x60, 0x6A, 0xD2, 0xE8, 0x04, 0xAB, 0xC0, 0x8D, 
+0x48, 0x1C, 0xF0, 0x18, 0x5E, 0xD9, 0xC7, 0x52, 
+0xE2, 0xF9, 0xF7, 0x4B, 0x95, 0x35, 0x93, 0xF5, 
+0x94, 0x6B, 0x01, 0x51, 0x7B, 0xAB, 0xD4, 
+
+0x1C, 0xFD, 0xC7, 0xE4, 0x74, 0x04, 0x0E, 0xC2, 
+0xE2, 0x94, 0xA1, 0x4F, 0xA6, 0x8F, 0xD0, 0x29, 
+0xA1, 0xF1, 0x0F, 0x13, 0xE2, 0xA6, 0xE6, 0x39, 
+0x3F, 0x55, 0xAA, 0x90, 0x68, 0x0B, 0xC1, 0x2E, 
+0xCA, 0xCF, 0xC5, 0x89, 0x94, 0x04, 0x08, 0x04, 
+0xD9, 0xBF, 0x33, 0xD2, 0xF3, 0x6F, 0xCA, 0xF8, 
+0x55, 0x0A, 0xC0, 0x17, 0x92, 0xA2, 0x0C, 0x11, 
+0xD2, 0xC2, 0x81, 0x43, 0xC9, 0xD0, 0x85, 0x62, 
+0x6D, 0x60, 0x3B, 0xD2, 0x8F, 0x0D, 0xB5, 0xE5, 
+0xCB, 0x64, 0x57, 0xBA, 0xD4, 0xBD, 0x25, 0x3E, 
+0x00, 0x5A, 0xC3, 0xB6, 0x71, 0xED, 0x21, 0x23, 
+0xC4, 0x5F, 0xCA, 0x27, 0xA0, 0x8B, 0x31, 0x3E, 
+0x78, 0xFC, 0x3E, 0x7D, 0xF0, 0x45, 0x31, 0x55, 
+0x41, 0xB3, 0x0A, 0x36, 0x66, 0x45, 0x9D, 0x4E, 
+0x11, 0x4B, 0x05, 0x7C, 0x1C, 0x0F, 0x69, 0x85, 
+0x11, 0xBC, 0x04, 0xFF, 0x98, 0x66, 0x96, 0xA7, 
+0x32, 0xBD, 0xDF, 0x45, 0xB2, 0x11, 0xCD, 0xFD, 
+0x53, 0xEE, 0x90, 0x7B, 0x4D, 0x4F, 0xD2, 0x94, 
+0xDD, 0x23, 0x1F, 0x78, 0xBD, 0xF4, 0xA7, 0x6A, 
+0x6D, 0x95, 0x2C, 0x99, 0x86, 0x98, 0xD0, 0x2B, 
+0xB7, 0x88, 0x22, 0x6C, 0x6D, 0x5F, 0x45, 0x30, 
+0x38, 0x54, 0x79, 0xFB, 0x60, 0xAD, 0x06, 0x99, 
+0xD2, 0x9A, 0x95, 0xD4, 0x51, 0xC7, 0x0E, 0x2D, 
+0x8E, 0xF4, 0xC2, 0x49, 0x2D, 0xD8, 0x59, 0x22, 
+0xCD, 0xE9, 0x04, 0xB7, 0xB5, 0x7C, 0xF4, 0x8C, 
+0xA6, 0x5E, 0xB9, 0x44, 0x1C, 0x3B, 0xD8, 0x71, 
+0xA4, 0x4F, 0xCB, 0x8C, 0x51, 0x5D, 0x41, 0x53, 
+0xDF, 0x4F, 0xF7, 0xF6, 0xC3, 0x10, 0x74, 0xBE, 
+0x50, 0x56, 0x5D, 0xF5, 0x35, 0x82, 0xFD, 0x13, 
+0x60, 0xA6, 0x65, 0x5D, 0x44, 0x04, 0x2A, 0x3C, 
+0xBA, 0x73, 0xAC, 0xCC, 0x57, 0xBA, 0x92, 0x3D, 
+0xCA, 0x4B, 0x4F, 0xF8, 0x48, 0x97, 0xAA, 0xE4, 
+0x49, 0xAC, 0x14, 0x1C, 0xE6, 0xCB, 0x45, 0xBC, 
+
+0x63, 0x3D, 0x2A, 0xA6, 0xD6, 0x29, 0xD1, 0x69, 
+0x4E, 0x25, 0xE0, 0xE5, 0x46, 0xA6, 0x61, 0xB7, 
+0x7B, 0x64, 0x7D, 0xF3, 0x30, 0xBA, 0x04, 0x3B, 
+0x27, 0x21, 0x3F, 0x22, 0xDE, 0xD5, 0x1B, 0xF5, 
+0xB7, 0x79, 0x3E, 0x79, 0x99, 0xB8, 0x2E, 0x65, 
+0xA5, 0x7F, 0xAF, 0xB1, 0x7A, 0x34, 0xAC, 0x4C, 
+0x98, 0xAB, 0x47, 0x9C, 0x22, 0x44, 0x96, 0xAD, 
+0x9A, 0x72, 0x56, 0x58, 0x29, 0x41, 0x77, 0x53, 
+0x0A, 0x11, 0x8B, 0x99, 0x7B, 0xE4, 0x8D, 0x14, 
+0x98, 0x75, 0x97, 0x58, 0x34, 0xFB, 0xCD, 0xB0, 
+0x1D, 0x3B, 0xBA, 0xD1, 0x9C, 0x80, 0x20, 0xDF, 
+0x39, 0x3B, 0x33, 0xA0, 0x73, 0x45, 0xE6, 0xA3, 
+0x94, 0x5E, 0x05, 0x06, 0xC9, 0x81, 0xAB, 0xC4, 
+0x15, 0xD1, 0x69, 0xB0, 0x6B, 0x79, 0xBD, 0x99, 
+0xFD, 0xC2, 0x90, 0x18, 0xFD, 0x60, 0xF8, 0x94, 
+0x76, 0x5C, 0x8E, 0xF7, 0x32, 0xC2, 0x9E, 0x48, 
+0xD0, 0x0B, 0x4B, 0x99, 0x05, 0x90, 0x07, 0xE6, 
+0xFF, 0xDC, 0xC4, 0x35, 0x79, 0x8C, 0xFC, 0xB5, 
+0x16, 0xF3, 0xC2, 0xE7, 0x4B, 0x5A, 0x39, 0x0A, 
+0xC3, 0x01, 0x18, 0x41, 0x9E, 0x8C, 0x65, 0x7F, 
+0xD4, 0x47, 0xED, 0x10, 0xF5, 0xAC, 0xAD, 0x9E, 
+0x15, 0x7A, 0x3C, 0x67, 0x11, 0xC9, 0xEB, 0x81, 
+0x95, 0xDE, 0x98, 0xE5, 0x77, 0x29, 0xE1, 0x51, 
+0x67, 0xC8, 0xF5, 0xF7, 0x2D, 0xF5, 0x1A, 0x99, 
+0x58, 0xDC, 0x0B, 0xC2, 0xF8, 0xE0, 0xEE, 0xB4, 
+0x21, 0xED, 0xD6, 0xEA, 0x27, 0x99, 0x2A, 0xB6, 
+0xB8, 0xEC, 0x48, 0x70, 0x45, 0x12, 0xC2, 0x17, 
+0x95, 0x31, 0xD6, 0x1C, 0xF6, 0x22, 0x03, 0xA1, 
+0xF7, 0x68, 0xF5, 0xCF, 0x96, 0x3F, 0x75, 0xC7, 
+0xE6, 0xF8, 0xE8, 0x85, 0x5F, 0x73, 0x13, 0x0B, 
+0x94, 0xD4, 0xA7, 0xFC, 0x12, 0x65, 0x5A, 0x75, 
+0x59, 0xDD, 0xA8, 0x12, 0xBB, 0xD4, 0x4F, 0x3C, 
+0x16, 0xA3, 0xF2, 0x4D, 0xC3, 0xAF, 0x08, 0x90, 
+0xF5, 
+
+0x94, 0x7D, 0x6B, 0xE7, 0x22, 0xE4, 0x8A, 0x7C, 
+0x55, 0xF2, 0xB3, 0x71, 0x0F, 0xF2, 0xAC, 0xB5, 
+0x41, 0x40, 0x2F, 0x38, 0x81, 0x6A, 0xEA, 0xD4, 
+0x03, 0x34, 0x55, 0x73, 0xBA, 0x13, 0x32, 0x02, 
+0xDB, 0xB2, 0xE7, 0xD7, 0xC7, 0xC5, 0x79, 0x9B, 
+0x80, 0x3D, 0x67, 0x23, 0x4B, 0x9B, 0xA3, 0xD1, 
+0x9A, 0x95, 0xA2, 0x01, 0x61, 0x4D, 0x50, 0xA5, 
+0x11, 0xE8, 0x27, 0x0D, 0xEF, 0x85, 0x4D, 0x9A, 
+0xE9, 0x66, 0x1B, 0xC9, 0xA8, 0xBD, 0xB8, 0x4D, 
+0xA5, 0xB6, 0x26, 0x96, 0xDB, 0x29, 0xEB, 0x91, 
+0xD6, 0x03, 0x44, 0x99, 0x29, 0xFD, 0x7E, 0x18, 
+0xC4, 0x0F, 0xB7, 0xA3, 0x0B, 0x51, 0x96, 0xD3, 
+0x4F, 0xFD, 0x4A, 0xC8, 0x4B, 0x07, 0xF6, 0xF7, 
+0xD3, 0x26, 0x58, 0x45, 0x72, 0xF1, 0xAE, 0x56, 
+0xA7, 0x84, 0xD9, 0x17, 0xC6, 0x49, 0xBF, 0x49, 
+0xC8, 0xD8, 0x3A, 0x28, 0x91, 0x7E, 0xF5, 0x9F, 
+0x52, 0xF2, 0x54, 0x49, 0xED, 0xC4, 0x62, 0x7F, 
+0xA9, 0x6F, 0x70, 0xB7, 0x79, 0x70, 0xF1, 0x49, 
+0x6B, 0x41, 0x98, 0xD8, 0xA7, 0x8F, 0xE9, 0x84, 
+0x00, 0x7B, 0x68, 0x4A, 0xAB, 0xC4, 0xA5, 0x96, 
+0xA0, 0x86, 0x2D, 0x55, 0xCB, 0xBA, 0xAE, 0x45, 
+0xE3, 0xAF, 0x17, 0xE6, 0x28, 0x1C, 0x27, 0xCD, 
+0x0E, 0xF1, 0x9E, 0x49, 0xE8, 0xDC, 0xAE, 0x3C, 
+0xAC, 0x72, 0x87, 0x6E, 0x4B, 0x0B, 0xC1, 0xE6, 
+0x8C, 0x77, 0x87, 0x33, 0xC3, 0x35, 0x06, 0x27, 
+0x60, 0xE8, 0xF5, 0x3A, 0x20, 0x53, 0xA9, 0xD2, 
+0x76, 0xB6, 0x80, 0xEF, 0x87, 0x82, 0x34, 0xD1, 
+0xA7, 0xDE, 0xA6, 0x0E, 0x01, 0xF7, 0xAE, 0x67, 
+0x98, 0xF0, 0x3E, 0xCD, 0xE8, 0x8A, 0xC9, 0x78, 
+0x50, 0xD7, 0x2E, 0x53, 0x5A, 0xD0, 0xDB, 0x54, 
+0x6F, 0x32, 0x5A, 0xB9, 0x8C, 0xAE, 0x9A, 0x21, 
+0x39, 0x36, 0xB4, 0x33, 0x36, 0xBB, 0x12, 0x10, 
+0x6F, 0x9D, 0x39, 0x9B, 0x6D, 0x12, 0x49, 0x61, 
+0x0A, 0x09, 
+
+0xBF, 0x03, 0xB7, 0x9C, 0x6B, 0x0C, 0x8D, 0xA6, 
+0xEB, 0x19, 0x69, 0xA9, 0xC1, 0xC9, 0xBC, 0x0B, 
+0xB4, 0x6E, 0x3A, 0xBE, 0x2B, 0x2F, 0x19, 0x73, 
+0x1B, 0x41, 0x21, 0xBB, 0x50, 0xF9, 0xCB, 0xF2, 
+0x53, 0xE5, 0x7C, 0xAE, 0x06, 0x45, 0xD7, 0x9B, 
+0xA7, 0x81, 0x52, 0xFF, 0x9F, 0x64, 0x2A, 0xFD, 
+0x70, 0x07, 0x94, 0xE2, 0x6F, 0xA7, 0x17, 0x93, 
+0x8B, 0xEE, 0x88, 0x2E, 0x62, 0xCC, 0xCD, 0xB6, 
+0x95, 0x94, 0x9A, 0xDC, 0xFC, 0x4E, 0x11, 0x40, 
+0x95, 0x90, 0xA3, 0x1E, 0x03, 0xB2, 0x4C, 0x8E, 
+0x68, 0xCB, 0x0D, 0x3E, 0xDA, 0xB1, 0x3B, 0xCA, 
+0x25, 0xB7, 0xEB, 0xEC, 0x2D, 0x74, 0x40, 0x01, 
+0x76, 0x58, 0x32, 0x44, 0x63, 0x35, 0x8D, 0x1A, 
+0x76, 0xCB, 0x02, 0x7E, 0xB6, 0x27, 0x15, 0x19, 
+0xD1, 0xB9, 0xA7, 0x2E, 0x1E, 0xDF, 0x6D, 0x1D, 
+0x21, 0x85, 0x83, 0x80, 0xF3, 0x28, 0xD9, 0x2E, 
+0x81, 0xBF, 0x15, 0x6C, 0xA1, 0x08, 0xAF, 0xC3, 
+0x5E, 0x8B, 0x39, 0x64, 0x5D, 0x20, 0x4F, 0x57, 
+0x8E, 0xC1, 0x6E, 0x82, 0x7C, 0x81, 0x65, 0x39, 
+0xF0, 0x2B, 0x3B, 0xC0, 0xD7, 0x4E, 0x36, 0xB4, 
+0xE3, 0x37, 0x3D, 0x55, 0x8B, 0xEE, 0xBE, 0xD0, 
+0x83, 0x85, 0x97, 0xCB, 0x7D, 0x25, 0x31, 0x82, 
+0x52, 0xFD, 0x8E, 0x24, 0x0F, 0xD2, 0x12, 0x27, 
+0x40, 0x7E, 0x01, 0xED, 0xD0, 0x41, 0x85, 0xAB, 
+0x0C, 0x6F, 0x7F, 0x05, 0x06, 0xF4, 0x2B, 0xCE, 
+0xF0, 0x44, 0x83, 0xB7, 0x34, 0x38, 0x3B, 0xFB, 
+0xC5, 0x70, 0xA0, 0xFC, 0x40, 0xE1, 0x65, 0x9E, 
+0x72, 0xB3, 0xF3, 0x15, 0x47, 0x17, 0x1B, 0xAD, 
+0x59, 0x95, 0xBB, 0xC2, 0x2F, 0x8B, 0x1C, 0xEA, 
+0x27, 0x74, 0x9F, 0xF6, 0x25, 0x2A, 0x32, 0x68, 
+0x77, 0x8F, 0x3A, 0x09, 0x0B, 0x7F, 0xC7, 0x0C, 
+0xFC, 0xE3, 0x8A, 0x65, 0x60, 0x88, 0xAE, 0xD2, 
+0x6C, 0x5B, 0x91, 0xB9, 0xEA, 0x76, 0xA8, 0x9D, 
+0x8E, 0x4F, 0x40, 
+
+0x99, 0x21, 0xE9, 0x37, 0xD5, 0xC0, 0xD3, 0x25, 
+0xE5, 0xEB, 0x83, 0xB8, 0x08, 0xFC, 0x91, 0xD0, 
+0x96, 0x3F, 0x8A, 0x36, 0x03, 0xE1, 0xE2, 0x66, 
+0x37, 0x98, 0x10, 0x4E, 0x2A, 0x90, 0x41, 0x0D, 
+0x9B, 0x66, 0xE7, 0xD9, 0xFE, 0x1A, 0x1E, 0xEB, 
+0x55, 0x5A, 0x7C, 0x93, 0xF3, 0x7E, 0x52, 0x13, 
+0xC8, 0xC8, 0xE7, 0x7A, 0x60, 0x24, 0xCE, 0xEB, 
+0x83, 0xF0, 0x8C, 0xA0, 0x02, 0xA0, 0x29, 0x4B, 
+0xCF, 0x4E, 0x53, 0x36, 0xCD, 0x4C, 0xA4, 0xAF, 
+0x05, 0xE7, 0xB5, 0xC9, 0x86, 0x2D, 0x59, 0x95, 
+0x4B, 0xD2, 0xBE, 0xC1, 0xC2, 0x62, 0x0B, 0x94, 
+0x01, 0x21, 0x81, 0x87, 0x4D, 0x50, 0x76, 0xA9, 
+0x38, 0x8A, 0xBF, 0x08, 0x68, 0xF8, 0xB5, 0x2B, 
+0x82, 0xBA, 0x4C, 0xB1, 0x79, 0x7E, 0x1A, 0xE5, 
+0x73, 0x77, 0x03, 0xD3, 0x88, 0xF6, 0xC4, 0xAD, 
+0x59, 0x2D, 0x1F, 0x80, 0x8C, 0xE1, 0xD2, 0xAA, 
+0x88, 0x8A, 0xCD, 0x81, 0x26, 0x0A, 0x61, 0x6A, 
+0x7B, 0xCB, 0x21, 0xEC, 0x69, 0xE3, 0x17, 0xD3, 
+0xB9, 0x8A, 0xF0, 0x29, 0x9C, 0xE2, 0xE5, 0xC4, 
+0x41, 0xBC, 0xEE, 0x61, 0x51, 0x68, 0xFD, 0xC1, 
+0x6C, 0xB0, 0x0C, 0x92, 0x8B, 0x01, 0x25, 0x8E, 
+0xB3, 0x9B, 0x61, 0x2A, 0x48, 0xBF, 0x3C, 0x91, 
+0xAA, 0xDC, 0xAF, 0x31, 0x5C, 0x47, 0xC4, 0xD6, 
+0x4C, 0x01, 0xC5, 0x8F, 0xE3, 0x90, 0x30, 0x18, 
+0x63, 0xDE, 0xD3, 0x3A, 0xE2, 0x78, 0xA3, 0x48, 
+0x1C, 0x06, 0x6C, 0x1D, 0xA2, 0x92, 0xE4, 0x52, 
+0x3A, 0xB4, 0x06, 0x4C, 0x79, 0xCA, 0x1B, 0xED, 
+0x67, 0x44, 0xD5, 0xF0, 0x6C, 0x8A, 0x14, 0xAA, 
+0xAA, 0x08, 0xED, 0x27, 0x90, 0xF0, 0xC2, 0xBA, 
+0xAC, 0x9F, 0x83, 0xF3, 0x0D, 0x9C, 0x5D, 0x88, 
+0x45, 0x73, 0x19, 0x8E, 0x22, 0x1C, 0x14, 0x7F, 
+0x17, 0x6E, 0x47, 0x47, 0x55, 0xB2, 0x75, 0x1E, 
+0x99, 0xF1, 0xCF, 0x1B, 0x88, 0x82, 0x9E, 0x29, 
+0xB5, 0xAD, 0x82, 0x59, 
+
+0x6B, 0xD2, 0x20, 0xD1, 0xDC, 0x91, 0xF7, 0x07, 
+0xB2, 0xA1, 0xB9, 0xCD, 0x2E, 0xBA, 0xE0, 0xDB, 
+0x79, 0x29, 0xF5, 0x8F, 0x26, 0xC1, 0xAA, 0x61, 
+0xE9, 0xB7, 0x4E, 0xB6, 0x38, 0xF0, 0xAF, 0xAA, 
+0x8D, 0xB4, 0xA0, 0x70, 0x7D, 0x37, 0xE5, 0x35, 
+0x5E, 0x2B, 0x04, 0xBD, 0x07, 0xF2, 0x27, 0xE9, 
+0x96, 0x2C, 0xAF, 0x92, 0x3F, 0xFE, 0x01, 0xFE, 
+0xD0, 0x68, 0xA8, 0x63, 0x99, 0x9F, 0x94, 0x64, 
+0xC1, 0xF8, 0x95, 0x00, 0xDB, 0x6A, 0xA9, 0x5A, 
+0x03, 0x4A, 0x57, 0xAD, 0xCD, 0x12, 0xC9, 0x4B, 
+0x5C, 0xB1, 0x6B, 0x16, 0x6E, 0x8F, 0xFC, 0xE6, 
+0x4D, 0x92, 0xE2, 0x13, 0x8D, 0xC1, 0xB8, 0x67, 
+0xE8, 0xDE, 0xF7, 0x30, 0x31, 0xB5, 0xAC, 0x00, 
+0x73, 0x4D, 0xA2, 0x84, 0x41, 0x04, 0x88, 0xAA, 
+0x68, 0xE3, 0xBE, 0xBE, 0xD2, 0x3B, 0x42, 0xAB, 
+0x24, 0x47, 0x1F, 0xE9, 0xCD, 0xC7, 0xA3, 0xB9, 
+0xD8, 0x8C, 0x1B, 0x40, 0x26, 0x6B, 0x2C, 0x3F, 
+0xAB, 0x88, 0x03, 0x59, 0x10, 0x9D, 0xEE, 0x01, 
+0x8E, 0x79, 0x82, 0xCB, 0xCE, 0x15, 0x7D, 0xE9, 
+0x20, 0x6B, 0x40, 0x8D, 0x36, 0x0C, 0xE7, 0x67, 
+0xEB, 0x6E, 0x97, 0x6A, 0xC4, 0x64, 0x72, 0x02, 
+0xB7, 0xB2, 0x0C, 0xC6, 0xF4, 0xBC, 0x6C, 0x96, 
+0xA1, 0xA8, 0xF8, 0xC1, 0x45, 0x12, 0xDE, 0x0C, 
+0x86, 0x62, 0x81, 0x55, 0xF7, 0x98, 0x60, 0xE9, 
+0x68, 0x8F, 0x6A, 0xD6, 0x23, 0x62, 0x6D, 0xAE, 
+0x64, 0x82, 0xBA, 0x81, 0x4D, 0x78, 0xB2, 0x43, 
+0x02, 0x16, 0x97, 0xC8, 0x56, 0xF6, 0xD8, 0xB7, 
+0x9F, 0x72, 0x66, 0xA3, 0xDD, 0x66, 0x45, 0x2C, 
+0xAB, 0x11, 0x45, 0x7B, 0x7C, 0x91, 0x0F, 0x78, 
+0x0E, 0xB4, 0x34, 0xAD, 0xFE, 0x8A, 0x43, 0x87, 
+0x29, 0x2F, 0x92, 0xD5, 0x98, 0x87, 0x53, 0xF6, 
+0x20, 0x01, 0x00, 0xE6, 0x7D, 0x81, 0x36, 0x31, 
+0x8F, 0x1B, 0x05, 0x49, 0x75, 0x4A, 0x25, 0xC4, 
+0xBB, 0x23, 0x5C, 0x35, 0xA4, 
+
+0xBA, 0xEF, 0x6A, 0x24, 0xED, 0x4F, 0x18, 0x8C, 
+0xAF, 0x21, 0xF8, 0x82, 0x1A, 0xB1, 0xD2, 0x79, 
+0x6C, 0xBC, 0xFE, 0x1D, 0x93, 0xBE, 0xD2, 0x9E, 
+0xD7, 0xF0, 0x79, 0x34, 0x11, 0xDD, 0x74, 0xBE, 
+0x06, 0x65, 0x90, 0xFC, 0x10, 0x42, 0x88, 0xCC, 
+0x8B, 0x03, 0x80, 0xC8, 0x0E, 0x8C, 0xFC, 0xD9, 
+0x63, 0xA6, 0x72, 0x37, 0x26, 0x76, 0x29, 0xE0, 
+0x1D, 0x99, 0xB6, 0x10, 0x37, 0xEE, 0xBA, 0x93, 
+0x8A, 0x84, 0x04, 0x9B, 0xD9, 0xE1, 0xEE, 0x0C, 
+0x12, 0xD5, 0xB0, 0xEB, 0x25, 0x97, 0xE8, 0x30, 
+0x73, 0x1B, 0x0F, 0x77, 0x4B, 0xE4, 0xA4, 0x93, 
+0xD3, 0x85, 0x48, 0xD4, 0xDD, 0x28, 0xFD, 0x36, 
+0xEF, 0xCA, 0xEE, 0x2B, 0x97, 0x94, 0x87, 0x38, 
+0x8C, 0xA8, 0xC9, 0xAB, 0xE6, 0x24, 0x92, 0x69, 
+0xFC, 0xEE, 0xE9, 0xA3, 0x1D, 0x8C, 0x8F, 0x3C, 
+0x77, 0xE8, 0xC9, 0x0A, 0x94, 0x7B, 0xA3, 0x97, 
+0x4B, 0x0D, 0x9D, 0x7B, 0x90, 0xE6, 0x1C, 0x72, 
+0x05, 0xDE, 0xE5, 0x83, 0x72, 0x27, 0xE4, 0x85, 
+0x14, 0xE6, 0xE2, 0x6E, 0x5B, 0xAC, 0x30, 0x47, 
+0xF7, 0x42, 0x76, 0xAB, 0xA7, 0x04, 0x0B, 0xBF, 
+0x02, 0xBC, 0x1F, 0x86, 0xEB, 0x41, 0x04, 0x0F, 
+0x2A, 0x72, 0x80, 0x8F, 0xCF, 0x95, 0x9B, 0x26, 
+0x2B, 0xAA, 0xE5, 0xAE, 0x9D, 0x9D, 0xFC, 0xC1, 
+0x04, 0xA4, 0x75, 0x33, 0x83, 0x49, 0xDA, 0x68, 
+0xDE, 0xEB, 0xBC, 0xEB, 0x54, 0x4C, 0x0A, 0x08, 
+0x63, 0x3D, 0xE0, 0xDD, 0x6A, 0x45, 0xFC, 0x24, 
+0x15, 0xAD, 0x7B, 0x5B, 0x86, 0x28, 0xF6, 0x66, 
+0x04, 0x82, 0xD2, 0x3F, 0x09, 0xD1, 0xFF, 0x14, 
+0x96, 0xC6, 0xAF, 0x8D, 0x5E, 0xDF, 0x74, 0x27, 
+0xEB, 0x8D, 0xC8, 0x7C, 0x0E, 0x1E, 0x64, 0x3F, 
+0x12, 0xEA, 0x05, 0xB8, 0x4A, 0xB9, 0xDD, 0x78, 
+0xD6, 0x1A, 0xC2, 0x36, 0xBF, 0x74, 0xCE, 0x70, 
+0x8E, 0x8E, 0x7D, 0xE4, 0x5E, 0x4A, 0xA1, 0xDD, 
+0xB2, 0x93, 0x2C, 0x7B, 0x8C, 0xF0, 
+
+0x0C, 0x13, 0xCC, 0x7B, 0x3D, 0x80, 0xD2, 0x81, 
+0x4C, 0x1B, 0xC2, 0x4E, 0x1C, 0x23, 0xAE, 0xDE, 
+0x52, 0x94, 0x0A, 0x3D, 0x12, 0x25, 0x05, 0x5C, 
+0x88, 0xE7, 0x1A, 0x00, 0x40, 0x94, 0x11, 0x54, 
+0x08, 0xA9, 0x75, 0x12, 0x30, 0x2C, 0x65, 0x24, 
+0xB2, 0x1C, 0x7F, 0xFC, 0x8C, 0x42, 0xA9, 0xF9, 
+0xD9, 0x99, 0xFC, 0x64, 0x92, 0x38, 0x7E, 0x6D, 
+0xCF, 0x1D, 0x70, 0x84, 0x9A, 0xA7, 0xBB, 0x49, 
+0x0F, 0x3B, 0x0A, 0xC2, 0x4C, 0xA0, 0x5F, 0xB4, 
+0x25, 0x1D, 0x8E, 0x27, 0xCB, 0xF5, 0x2F, 0xF4, 
+0xD9, 0x56, 0x52, 0x8E, 0x46, 0xD7, 0xCF, 0x8E, 
+0xA0, 0x5C, 0x7F, 0xF4, 0x21, 0x75, 0xDF, 0x7D, 
+0xDC, 0x61, 0x95, 0xE6, 0x2F, 0xFD, 0x25, 0x8E, 
+0xA4, 0x7F, 0x65, 0x1E, 0xA1, 0x39, 0xE4, 0xFC, 
+0x2C, 0x79, 0xB4, 0x6D, 0x2F, 0xC0, 0xD5, 0xF7, 
+0x66, 0xDF, 0x7D, 0x92, 0x73, 0x71, 0x05, 0xDD, 
+0x50, 0xFA, 0xC7, 0x47, 0x78, 0x58, 0x12, 0x8B, 
+0x53, 0x37, 0xA5, 0x09, 0x4F, 0xA0, 0x40, 0x0B, 
+0xF1, 0x87, 0x11, 0x9E, 0x6C, 0xB2, 0xB8, 0xFA, 
+0x58, 0x16, 0xCA, 0xF6, 0xBC, 0xA7, 0xAB, 0x6F, 
+0x68, 0x63, 0x33, 0xB8, 0x23, 0x48, 0x68, 0x92, 
+0x47, 0xDA, 0xCA, 0x26, 0x84, 0xC2, 0x8D, 0x7A, 
+0x7E, 0xC4, 0xC4, 0x82, 0x86, 0x88, 0x04, 0x17, 
+0x9D, 0x31, 0xCF, 0xC2, 0x7B, 0xE1, 0xF4, 0x8F, 
+0x54, 0xC6, 0x99, 0x0F, 0xCB, 0x5C, 0x15, 0xF1, 
+0xBE, 0x2E, 0x2C, 0x70, 0x5E, 0x25, 0x28, 0x3C, 
+0xB6, 0x49, 0x85, 0x3E, 0xB0, 0xAE, 0x7D, 0xE8, 
+0xFB, 0x59, 0xB6, 0x0D, 0x37, 0x0F, 0xF6, 0xD9, 
+0x5F, 0x0C, 0x97, 0x24, 0xC2, 0xA8, 0x3A, 0xE9, 
+0xB9, 0x82, 0x9C, 0xEF, 0x6A, 0x71, 0x80, 0x8F, 
+0xD9, 0x18, 0xAC, 0xBD, 0x69, 0xD8, 0x2D, 0xE0, 
+0xF7, 0xBD, 0x34, 0x18, 0x93, 0x1F, 0x7A, 0xC0, 
+0xB2, 0x7F, 0x2C, 0xEF, 0x71, 0xB2, 0x96, 0xEE, 
+0xB8, 0xBD, 0x87, 0x50, 0xE9, 0xFB, 0xA7, 
+
+}
+return kat[i:j]
+}
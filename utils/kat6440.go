@@ -0,0 +1,5478 @@
+package utils
+func getkat6440(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xDC, 0xA4, 0xFE, 0x04, 0x9B, 0x81, 0xD2, 0x9F, 
+0x43, 0xCD, 0x87, 0x5B, 0x20, 0xF0, 0xA5, 0xFA, 
+0xAC, 0xB9, 0x81, 0xAC, 0xFC, 0xC7, 0xAE, 0x2D, 
+0xA5, 0x5B, 0xEC, 0xFD, 0xCD, 0x2E, 0xB6, 0xFE, 
+
+0xAC, 0xB7, 0xC2, 0xD5, 0xBF, 0xC8, 0x9A, 0x1B, 
+0xEB, 0xC2, 0x4B, 0x60, 0xB4, 0xBF, 0x46, 0x4A, 
+0xAB, 0x35, 0x89, 0x4C, 0xBF, 0xA8, 0xBC, 0x01, 
+0x32, 0x7D, 0x17, 0x61, 0x6F, 0xAD, 0x36, 0x9A, 
+0xE3, 
+
+0x3D, 0x92, 0xB5, 0x3F, 0xDF, 0xAD, 0x83, 0xFE, 
+0xEF, 0x78, 0x0D, 0x78, 0x5A, 0x53, 0x22, 0x4A, 
+0x51, 0x78, 0x13, 0xD4, 0x4A, 0x03, 0xC4, 0xF2, 
+0xCB, 0x9E, 0x0C, 0xF5, 0xF7, 0xC8, 0xCF, 0x68, 
+0xD4, 0x5F, 
+
+0xA9, 0xB1, 0x9D, 0xB8, 0xC8, 0x93, 0xCD, 0x41, 
+0xE2, 0x1C, 0x93, 0x59, 0xE1, 0xC5, 0x76, 0x3D, 
+0x00, 0x9D, 0x92, 0x7C, 0x20, 0x4F, 0xB9, 0x8A, 
+0xC6, 0xCE, 0xF7, 0x8F, 0x2D, 0x02, 0x1F, 0x6B, 
+0x0D, 0xB1, 0xC7, 
+
+0x03, 0xB8, 0x27, 0x3C, 0x5B, 0x5F, 0x8B, 0x15, 
+0x6C, 0x01, 0xDA, 0xA7, 0x2B, 0x01, 0x90, 0xD9, 
+0x1A, 0xA6, 0xDD, 0x5D, 0xCF, 0xF3, 0x83, 0xF7, 
+0x2E, 0x02, 0x09, 0xC5, 0xDE, 0x82, 0x87, 0x54, 
+0x95, 0x58, 0x92, 0xF6, 
+
+0xF2, 0x30, 0xF1, 0xB7, 0xAE, 0x47, 0x40, 0x03, 
+0x39, 0xFB, 0xDC, 0x6E, 0x7F, 0x23, 0x6C, 0xAA, 
+0x96, 0xDD, 0x66, 0xD9, 0xBC, 0xAA, 0xCC, 0x41, 
+0xA7, 0x51, 0x8C, 0xD2, 0x6B, 0x7D, 0xFA, 0xE1, 
+0x8B, 0x06, 0xC7, 0x99, 0x06, 
+
+0xE3, 0xA7, 0xA2, 0x9B, 0xB1, 0x07, 0x45, 0x31, 
+0xE6, 0x9C, 0x41, 0x37, 0xCD, 0xE7, 0x1D, 0xE9, 
+0xCC, 0xCE, 0x7D, 0xF1, 0x11, 0xD9, 0xDC, 0xA0, 
+0x2B, 0x5D, 0x06, 0xC4, 0x57, 0x57, 0xB9, 0x43, 
+0x63, 0xCD, 0x80, 0xC6, 0x0A, 0x6F, 
+
+0xC3, 0xAB, 0x60, 0xCA, 0x52, 0xCD, 0xC7, 0x18, 
+0xD6, 0xD1, 0xBE, 0x99, 0x9F, 0x31, 0xA8, 0x36, 
+0x0C, 0x84, 0x70, 0x27, 0x50, 0x59, 0xEE, 0x6D, 
+0xF6, 0x58, 0xD3, 0xC5, 0xF4, 0x2D, 0x5A, 0xE4, 
+0xD0, 0xEF, 0x89, 0xF5, 0xA7, 0x56, 0xC4, 
+
+0x2B, 0xE1, 0xC5, 0x5D, 0x45, 0x03, 0x69, 0x50, 
+0x89, 0xA5, 0x66, 0x63, 0x84, 0xC4, 0x73, 0x47, 
+0x24, 0x14, 0x8A, 0x60, 0xFF, 0x48, 0xFF, 0x2E, 
+0x86, 0x44, 0xC3, 0xD7, 0xF5, 0xCE, 0xF3, 0x4A, 
+0xAD, 0x59, 0x64, 0x19, 0xA6, 0x80, 0x5C, 0x3B, 
+
+0x8C, 0x41, 0x94, 0xC4, 0x33, 0x0F, 0x87, 0x4A, 
+0xCB, 0xC3, 0x5B, 0x78, 0xAE, 0x65, 0x98, 0x94, 
+0x3D, 0x23, 0x36, 0x2F, 0x5F, 0x90, 0xAC, 0x47, 
+0xCB, 0x33, 0xF6, 0x3D, 0x74, 0x03, 0xE3, 0x31, 
+0xDA, 0x14, 0xA7, 0x31, 0xB0, 0x1C, 0x4D, 0xDA, 
+0x4E, 
+
+0x41, 0xC5, 0x15, 0x82, 0xFD, 0x09, 0xEB, 0x64, 
+0x95, 0xDE, 0x35, 0x3E, 0x34, 0x12, 0xE5, 0xEC, 
+0x79, 0xC3, 0x20, 0xD7, 0x5A, 0xFB, 0xC0, 0x5F, 
+0xF8, 0x0D, 0xA6, 0xED, 0xEA, 0xDD, 0xFE, 0x36, 
+0x06, 0x29, 0x5D, 0x86, 0x4B, 0x0F, 0xCD, 0x49, 
+0x73, 0xFB, 
+
+0x06, 0x16, 0xBD, 0xFF, 0x5B, 0x4F, 0x98, 0x92, 
+0x0F, 0x09, 0x97, 0xAE, 0xCE, 0x8F, 0x87, 0x5A, 
+0x92, 0xD4, 0xB3, 0x53, 0xAC, 0x12, 0xA1, 0xDB, 
+0x95, 0x27, 0x6A, 0x45, 0xFC, 0x60, 0x6F, 0xBF, 
+0x42, 0x8C, 0xD3, 0x6B, 0x37, 0x27, 0x3A, 0xB9, 
+0x03, 0xF2, 0x63, 
+
+0xD5, 0x7C, 0x84, 0xB2, 0x79, 0xD9, 0x40, 0x01, 
+0xA3, 0x19, 0xE4, 0x96, 0x60, 0x75, 0x3D, 0xAA, 
+0x3F, 0x15, 0x99, 0x32, 0x55, 0x48, 0x6F, 0xE3, 
+0x04, 0x05, 0xD6, 0x15, 0xE3, 0x38, 0x49, 0xCF, 
+0x09, 0x53, 0x13, 0xB8, 0x5A, 0x01, 0x4F, 0x4B, 
+0x29, 0x5F, 0xAF, 0x78, 
+
+0xD6, 0x34, 0x50, 0x70, 0xF9, 0xB4, 0x75, 0x3F, 
+0x81, 0xF1, 0x47, 0xB3, 0x22, 0xCA, 0x63, 0x05, 
+0x20, 0x71, 0x31, 0x49, 0xB5, 0x66, 0x5B, 0xC7, 
+0x14, 0xDC, 0x78, 0x74, 0x36, 0xE0, 0x49, 0x8D, 
+0x78, 0x13, 0xC7, 0x77, 0x3E, 0x49, 0xC7, 0x1D, 
+0xBE, 0x05, 0x69, 0xEF, 0xD5, 
+
+0x38, 0xC2, 0x63, 0x88, 0xED, 0x3B, 0xDC, 0x5E, 
+0x47, 0x77, 0x5C, 0xC3, 0x06, 0x0D, 0x44, 0xFD, 
+0xAB, 0x54, 0x40, 0x1C, 0x15, 0x2B, 0xCF, 0xE0, 
+0x72, 0x5F, 0x21, 0x62, 0xAC, 0x7A, 0xC2, 0x15, 
+0xCD, 0x77, 0x11, 0x47, 0xF7, 0x67, 0x55, 0x9E, 
+0x81, 0x5D, 0xEA, 0xAD, 0x31, 0xFA, 
+
+0x78, 0xF9, 0x1B, 0x86, 0x61, 0x2F, 0xA0, 0x3E, 
+0x21, 0x0E, 0x88, 0xD6, 0x84, 0x55, 0xAE, 0xE8, 
+0xE9, 0x82, 0xF6, 0x62, 0xBC, 0x4D, 0x86, 0x89, 
+0xE4, 0xCA, 0xB4, 0xD6, 0x97, 0x13, 0x2A, 0x82, 
+0x8D, 0xF1, 0xF8, 0x29, 0x98, 0x05, 0xD3, 0x37, 
+0x60, 0xC4, 0x46, 0x9D, 0x97, 0xFC, 0x84, 
+
+0x9E, 0x41, 0x17, 0x8D, 0xC2, 0xF7, 0x99, 0x51, 
+0x05, 0x3B, 0x83, 0xA5, 0xAB, 0x28, 0x5A, 0xC9, 
+0x43, 0x4F, 0x63, 0x7F, 0xF2, 0x14, 0x7B, 0xAE, 
+0x0A, 0x22, 0x7B, 0x7E, 0x3E, 0xA8, 0x05, 0x33, 
+0xAB, 0x36, 0x90, 0x0F, 0xAE, 0xC5, 0x52, 0x88, 
+0xAE, 0x64, 0xEF, 0x2D, 0x30, 0x5D, 0x75, 0xB5, 
+
+0x7A, 0x6F, 0xC9, 0xA9, 0xBA, 0x66, 0xD6, 0x8B, 
+0x5A, 0x95, 0x04, 0x18, 0x85, 0xCE, 0xDA, 0xB9, 
+0x22, 0x6E, 0xE5, 0xF9, 0xA4, 0x84, 0xFA, 0x65, 
+0x7F, 0xA5, 0x42, 0xB4, 0xE3, 0xAD, 0x2D, 0x9F, 
+0x2D, 0x47, 0x4B, 0xE7, 0x63, 0x95, 0x75, 0x29, 
+0xF0, 0x99, 0x19, 0x56, 0x05, 0xD7, 0x4E, 0x22, 
+0x39, 
+
+0x43, 0x7A, 0x91, 0x09, 0x41, 0x9B, 0x45, 0x3B, 
+0xA4, 0x3B, 0x05, 0xCB, 0xD2, 0xDE, 0xC6, 0x12, 
+0x2A, 0x4A, 0xFD, 0x28, 0x5A, 0x75, 0xCA, 0xDF, 
+0xA9, 0xC0, 0xC5, 0xAE, 0xE5, 0xFC, 0x73, 0x75, 
+0x92, 0xE2, 0x59, 0xF3, 0x4C, 0x26, 0xCA, 0x46, 
+0xE2, 0xBA, 0xD1, 0xA9, 0xEF, 0xA9, 0x72, 0x67, 
+0x23, 0xEF, 
+
+0xB9, 0x72, 0xC5, 0x43, 0x70, 0x95, 0x75, 0x70, 
+0x4A, 0x01, 0x45, 0xA4, 0xE1, 0x83, 0xD6, 0xE8, 
+0x8B, 0x29, 0x0B, 0x75, 0xC2, 0xDC, 0xF3, 0xA6, 
+0xDF, 0x54, 0x10, 0xEB, 0x84, 0x28, 0x78, 0x58, 
+0x89, 0x20, 0xE8, 0xD6, 0xCF, 0x08, 0x2C, 0xD4, 
+0x65, 0xEF, 0x78, 0xC4, 0x02, 0x4C, 0xBD, 0x1B, 
+0x59, 0x70, 0x8B, 
+
+0x54, 0xE3, 0xAD, 0x70, 0x80, 0x14, 0xEB, 0x70, 
+0x03, 0x94, 0x2F, 0xFA, 0x54, 0x8B, 0x47, 0x85, 
+0x08, 0x4D, 0xC9, 0xEA, 0x4C, 0x10, 0x38, 0xEF, 
+0xAF, 0x40, 0x40, 0x10, 0x83, 0x06, 0xA8, 0x62, 
+0x58, 0x5D, 0x38, 0xC1, 0x47, 0xE5, 0xD5, 0x74, 
+0x19, 0x2E, 0x17, 0x25, 0xFC, 0x82, 0x59, 0x07, 
+0xC3, 0xD6, 0x1C, 0x69, 
+
+0x5B, 0x7F, 0x5B, 0x9D, 0x38, 0xFF, 0x97, 0xC9, 
+0xA4, 0x82, 0x8F, 0x40, 0x3F, 0x9D, 0x85, 0x95, 
+0x23, 0xC7, 0xB6, 0xAF, 0x54, 0x9B, 0x38, 0x8B, 
+0x03, 0x38, 0x4C, 0xF3, 0x14, 0x40, 0x42, 0x7A, 
+0x9F, 0xA1, 0xCB, 0x1A, 0xFA, 0xAC, 0xCC, 0xB5, 
+0xBB, 0x34, 0x6F, 0x75, 0x43, 0xBF, 0xA0, 0x42, 
+0x2F, 0xBB, 0x47, 0x45, 0x2A, 
+
+0x3E, 0x5F, 0xA1, 0x82, 0x2C, 0xE3, 0xE9, 0x0C, 
+0x4A, 0xCF, 0x53, 0x14, 0x66, 0xF1, 0x7F, 0x10, 
+0x1B, 0x36, 0x26, 0x5A, 0xE6, 0xBA, 0xFF, 0xA0, 
+0xDA, 0x63, 0xE3, 0x6A, 0x50, 0xDD, 0x39, 0x01, 
+0xCE, 0x24, 0xFB, 0x6B, 0x07, 0xBE, 0xF8, 0x32, 
+0xEE, 0x50, 0x23, 0x80, 0x58, 0x71, 0x7B, 0x3B, 
+0x7C, 0xF4, 0x1B, 0xF0, 0xE9, 0x68, 
+
+0x7F, 0xEC, 0xE2, 0x44, 0xF3, 0x92, 0x66, 0xD4, 
+0xF4, 0x31, 0xD1, 0xD3, 0xE2, 0x9C, 0xBF, 0xFD, 
+0x81, 0x45, 0xA0, 0x19, 0x5A, 0xF7, 0x08, 0x71, 
+0x18, 0x65, 0x6A, 0x8B, 0xE6, 0xB2, 0x95, 0xFA, 
+0x00, 0xEF, 0xD4, 0x55, 0xF8, 0x50, 0xE4, 0x3D, 
+0x60, 0xCA, 0xDB, 0x9C, 0x36, 0x45, 0x77, 0x09, 
+0xD7, 0xCE, 0xBD, 0x3A, 0xE6, 0xA6, 0x80, 
+
+0x7F, 0xB7, 0x2A, 0x18, 0x50, 0xD9, 0x17, 0xC5, 
+0xEC, 0x30, 0x84, 0x3D, 0x3F, 0x07, 0xCD, 0x9C, 
+0x91, 0x9E, 0x7E, 0x13, 0xF4, 0xB3, 0xF5, 0x55, 
+0x5D, 0x5C, 0x47, 0x08, 0x7C, 0x49, 0xFB, 0x4A, 
+0xC1, 0x2C, 0x3E, 0xB5, 0xB7, 0xDF, 0xEA, 0xEF, 
+0x4E, 0x8E, 0x02, 0x08, 0x68, 0x2A, 0xA0, 0xD6, 
+0xCF, 0x56, 0x82, 0x7D, 0xB9, 0x19, 0xA6, 0x33, 
+
+0xEA, 0x7A, 0x2A, 0xB6, 0x9B, 0x42, 0x7F, 0xE1, 
+0xA0, 0x89, 0xDF, 0x36, 0x09, 0x83, 0x0C, 0x0F, 
+0xD2, 0x1A, 0x86, 0x3D, 0x8C, 0xEC, 0x46, 0xCF, 
+0x9C, 0xDE, 0x58, 0xBB, 0x1F, 0x0A, 0x6E, 0x93, 
+0x47, 0xD1, 0x08, 0x17, 0x78, 0xF6, 0x59, 0x81, 
+0x7C, 0x25, 0x56, 0xF2, 0x7C, 0x50, 0x1B, 0x95, 
+0x2B, 0xBE, 0x67, 0x50, 0xE4, 0x7B, 0xB0, 0xC6, 
+0xFD, 
+
+0x6F, 0x69, 0xC5, 0xD7, 0x38, 0xAB, 0xCC, 0xB3, 
+0x64, 0xC3, 0xE4, 0xE7, 0x83, 0x3F, 0x7C, 0xB6, 
+0xAA, 0xE2, 0x37, 0x16, 0xEF, 0x93, 0x0E, 0x67, 
+0x53, 0x37, 0x2E, 0xFC, 0x08, 0x0D, 0xCE, 0xC7, 
+0xF4, 0x76, 0x03, 0xE7, 0x07, 0x5C, 0xA9, 0x05, 
+0x7F, 0x8A, 0x2A, 0x4C, 0xDC, 0x42, 0xCC, 0x43, 
+0x13, 0xFF, 0x4C, 0xFD, 0x3C, 0x51, 0x5A, 0xDA, 
+0x5F, 0x62, 
+
+0x6E, 0xAF, 0x03, 0x5E, 0x4B, 0xC9, 0x8E, 0xF4, 
+0x4B, 0x65, 0x72, 0x79, 0xC4, 0x6B, 0xCD, 0x2A, 
+0xB4, 0x3B, 0x86, 0xAE, 0xB2, 0x40, 0x37, 0x9D, 
+0x4F, 0xB9, 0xB2, 0x81, 0xF5, 0x88, 0xE5, 0xEC, 
+0xF1, 0x59, 0x15, 0xAE, 0x34, 0xD3, 0x7F, 0x6B, 
+0x77, 0x50, 0xB8, 0xE0, 0x23, 0xD2, 0xA9, 0xAE, 
+0x38, 0x32, 0xCF, 0x0E, 0x8B, 0xF5, 0xCB, 0xD2, 
+0x29, 0x25, 0xE3, 
+
+0xB2, 0xBE, 0xE3, 0x0B, 0x31, 0x8B, 0xBF, 0x74, 
+0xC6, 0xE0, 0x06, 0x73, 0x47, 0xED, 0x3D, 0xFE, 
+0xFB, 0x1F, 0x3D, 0x9B, 0xF9, 0x11, 0x45, 0x7A, 
+0x47, 0xDA, 0x10, 0x0B, 0x60, 0xE3, 0x67, 0x7F, 
+0xB3, 0xB3, 0x06, 0xEE, 0xBB, 0x9E, 0xFD, 0xF8, 
+0xA9, 0x77, 0xBB, 0x06, 0x70, 0x73, 0xF9, 0xA1, 
+0x4E, 0x77, 0x9D, 0x16, 0x63, 0x86, 0xAF, 0x52, 
+0xDB, 0x58, 0x7A, 0xFC, 
+
+0x84, 0x5A, 0xD1, 0x68, 0xF7, 0x61, 0x3B, 0xA2, 
+0x4D, 0xCC, 0x39, 0x52, 0xCC, 0x3F, 0xFE, 0x86, 
+0x25, 0x24, 0xA6, 0x40, 0x55, 0x36, 0x5A, 0xBE, 
+0x84, 0xDB, 0x94, 0xE4, 0x80, 0x46, 0xC2, 0xB6, 
+0x58, 0x63, 0x55, 0xE7, 0x14, 0x0D, 0xB7, 0x6C, 
+0xAF, 0x57, 0x0A, 0x6E, 0x89, 0xE0, 0x78, 0x7E, 
+0xB1, 0x50, 0x2A, 0xEF, 0x20, 0xC4, 0x15, 0x8F, 
+0x02, 0xFA, 0xE3, 0x28, 0xCF, 
+
+0x8E, 0xEE, 0x5F, 0xD3, 0x78, 0x08, 0x1A, 0xE0, 
+0x8A, 0x2B, 0xB4, 0xAF, 0x15, 0xF9, 0xFC, 0xC0, 
+0x58, 0x46, 0x79, 0x36, 0xFC, 0x6F, 0x17, 0x64, 
+0x52, 0x10, 0x11, 0xC4, 0x56, 0x1F, 0x02, 0x49, 
+0x2D, 0xD9, 0x89, 0x0C, 0x81, 0xE0, 0x2F, 0xAC, 
+0x9C, 0x5E, 0x22, 0xAC, 0x63, 0x9D, 0xB0, 0x9A, 
+0x17, 0x18, 0x53, 0xAA, 0xE5, 0x37, 0xD4, 0xE7, 
+0xB7, 0x39, 0xD4, 0xBA, 0x5E, 0xD6, 
+
+0xBC, 0x6A, 0x7E, 0xEB, 0xA1, 0xD2, 0x2B, 0x5D, 
+0x71, 0x87, 0x3E, 0x0A, 0xBE, 0x17, 0xB0, 0xE7, 
+0x6A, 0x74, 0x45, 0xD9, 0xFA, 0x04, 0x02, 0x5F, 
+0x66, 0x20, 0x15, 0x63, 0x0F, 0xFE, 0x54, 0xA4, 
+0xE6, 0x46, 0xED, 0x47, 0xBF, 0x9B, 0xC6, 0x7A, 
+0x15, 0xCA, 0x7B, 0x16, 0xEA, 0xAC, 0x94, 0x15, 
+0xFF, 0x84, 0xEB, 0x3B, 0xAE, 0x9E, 0x4A, 0x7A, 
+0x89, 0xF8, 0xA5, 0x32, 0xA7, 0xE0, 0x2E, 
+
+0xBA, 0xAB, 0xF6, 0x3A, 0xEA, 0x84, 0x49, 0x33, 
+0x91, 0xC2, 0x48, 0xA3, 0x5B, 0xD7, 0xAD, 0x2F, 
+0xCE, 0x95, 0xEA, 0xCF, 0x8C, 0x95, 0xD0, 0x7A, 
+0x99, 0x03, 0xC1, 0x64, 0xAC, 0x61, 0x34, 0x48, 
+0x36, 0x41, 0xEB, 0x10, 0x6B, 0xC6, 0xB4, 0x3B, 
+0x61, 0x90, 0x55, 0x6D, 0x98, 0x0B, 0x0D, 0xB8, 
+0xB4, 0x87, 0x72, 0x4D, 0x1C, 0x51, 0xD1, 0x6C, 
+0xB7, 0x09, 0x18, 0x2C, 0x33, 0xD2, 0x29, 0x2E, 
+
+0xFB, 0x80, 0x35, 0x0F, 0x12, 0xA4, 0x10, 0x14, 
+0x60, 0x12, 0xF9, 0xAC, 0xA1, 0x53, 0x0C, 0x2F, 
+0x47, 0x73, 0x68, 0x5C, 0x37, 0x10, 0x3D, 0xB2, 
+0xFB, 0xE6, 0x55, 0x34, 0xFF, 0x88, 0xF6, 0xC1, 
+0x67, 0x82, 0x86, 0x63, 0x74, 0xED, 0xEA, 0xAE, 
+0x93, 0x6A, 0x01, 0x54, 0x63, 0x98, 0x8C, 0x28, 
+0x71, 0x1B, 0x50, 0x1E, 0x99, 0x67, 0xB8, 0x75, 
+0x3C, 0xEF, 0x39, 0xF9, 0x27, 0x01, 0x32, 0xEF, 
+0xF1, 
+
+0xC5, 0x31, 0x69, 0xCB, 0x0A, 0x73, 0xD3, 0x3F, 
+0xEF, 0xFA, 0xC4, 0xD2, 0x4E, 0xE2, 0xBC, 0xA5, 
+0x08, 0x55, 0x8D, 0xAB, 0x2C, 0x5F, 0x80, 0x15, 
+0xED, 0xBE, 0xB2, 0xFF, 0x17, 0x00, 0x05, 0xF5, 
+0x07, 0xD4, 0x10, 0xC7, 0x05, 0xBB, 0x8B, 0x1E, 
+0x69, 0x0F, 0xE1, 0xDB, 0x21, 0xDF, 0xF7, 0xC3, 
+0x2C, 0xDA, 0xBF, 0x27, 0xD3, 0x1E, 0xD5, 0x14, 
+0x59, 0xB0, 0x8D, 0x4A, 0x1B, 0xCA, 0x5C, 0x57, 
+0x4C, 0x76, 
+
+0x3D, 0x86, 0x50, 0xAA, 0xC0, 0x55, 0xD1, 0xD1, 
+0xF6, 0x1F, 0xEC, 0xF4, 0x33, 0x72, 0x18, 0xEA, 
+0x4D, 0xBB, 0xE7, 0x02, 0xA8, 0xB0, 0xB3, 0x4E, 
+0x3B, 0x4F, 0x17, 0x5C, 0x76, 0x04, 0xC9, 0x7D, 
+0x73, 0xF1, 0xBF, 0x16, 0x16, 0x0D, 0xF2, 0xC9, 
+0x32, 0x01, 0x82, 0x01, 0x4C, 0x59, 0xBD, 0xDD, 
+0xFB, 0x61, 0x16, 0x97, 0x64, 0x92, 0x69, 0x28, 
+0xD8, 0x8A, 0x08, 0x66, 0xB9, 0xA2, 0xAD, 0x0C, 
+0x31, 0xD9, 0xD2, 
+
+0xA6, 0xB1, 0x6D, 0xF3, 0xF8, 0x21, 0x0D, 0x7E, 
+0xEE, 0x4C, 0xFF, 0x20, 0x1C, 0x75, 0x9F, 0x97, 
+0x95, 0xEC, 0x29, 0xA3, 0xD2, 0x94, 0x13, 0xD2, 
+0xCB, 0xFB, 0x8B, 0xA5, 0xFE, 0x9C, 0x15, 0x76, 
+0x38, 0x17, 0xB0, 0xFE, 0xAE, 0x97, 0x11, 0x37, 
+0x7F, 0x21, 0x20, 0x81, 0x62, 0x62, 0x17, 0x14, 
+0x39, 0x47, 0x37, 0x1F, 0xCA, 0x05, 0x68, 0x8A, 
+0xCB, 0x1C, 0x6B, 0x9D, 0xA7, 0x2B, 0x3E, 0x43, 
+0x1E, 0x58, 0xF7, 0x56, 
+
+0x46, 0xF9, 0x7A, 0x74, 0xBB, 0x45, 0x40, 0xA1, 
+0x37, 0x4A, 0xB5, 0xD3, 0x62, 0x6F, 0xFB, 0xF1, 
+0x29, 0xC4, 0xD2, 0xF4, 0x53, 0x6C, 0xC9, 0x4A, 
+0xDE, 0xD8, 0x57, 0x57, 0x04, 0xA7, 0x3B, 0x8B, 
+0x43, 0x41, 0xBB, 0x95, 0xA5, 0xF6, 0x45, 0xCC, 
+0xB0, 0xB5, 0x21, 0xF8, 0x00, 0xFC, 0xB4, 0x10, 
+0xE6, 0xEB, 0x19, 0x76, 0x08, 0x38, 0xCD, 0x24, 
+0x4C, 0xF4, 0xD2, 0x38, 0x52, 0x46, 0x2A, 0x25, 
+0xB9, 0x9E, 0x9F, 0x89, 0xA4, 
+
+0x8D, 0x75, 0xCB, 0x8E, 0x14, 0xF1, 0xE6, 0xDE, 
+0x02, 0xAE, 0x87, 0x4F, 0x62, 0xB0, 0x99, 0x18, 
+0xFD, 0xA0, 0x9E, 0xF5, 0x68, 0x4D, 0xBD, 0x30, 
+0x16, 0x04, 0xDB, 0xC2, 0xCA, 0x65, 0x32, 0x82, 
+0x82, 0x16, 0xCB, 0xD3, 0x27, 0xE9, 0x52, 0x1B, 
+0x65, 0x20, 0xE3, 0x11, 0x72, 0xBF, 0x2E, 0xE8, 
+0xD7, 0xD0, 0x8C, 0x76, 0x45, 0xF5, 0xE6, 0xAB, 
+0x09, 0x06, 0x8D, 0xBB, 0xEF, 0xEA, 0x1F, 0xB4, 
+0x35, 0x6D, 0x5D, 0x68, 0x0E, 0xC7, 
+
+0xD2, 0x85, 0x2A, 0xFC, 0x8F, 0xA7, 0x9C, 0x2B, 
+0x89, 0xD9, 0x64, 0x8E, 0xFE, 0x6F, 0x2D, 0x3D, 
+0xAB, 0x39, 0x26, 0x70, 0xAB, 0xAB, 0xE9, 0xB4, 
+0x48, 0x06, 0x1C, 0xDC, 0xAA, 0x61, 0x55, 0x69, 
+0xBA, 0x7D, 0xF2, 0x28, 0x26, 0x8B, 0xDA, 0x3C, 
+0x94, 0x37, 0x8C, 0x6C, 0xC9, 0x9F, 0xEE, 0xC5, 
+0xA2, 0x32, 0xA5, 0xFD, 0x0B, 0x0B, 0x34, 0x4A, 
+0xFA, 0xAA, 0xC5, 0xB5, 0xA6, 0xA8, 0x86, 0x7E, 
+0x80, 0xBB, 0x36, 0x86, 0xAC, 0xF7, 0xE1, 
+
+0x84, 0x36, 0x46, 0x3B, 0xFA, 0x33, 0x4C, 0x98, 
+0x55, 0xFF, 0xF1, 0x5F, 0x52, 0xA5, 0x16, 0xCA, 
+0xC3, 0xB3, 0x84, 0x1A, 0x45, 0xD6, 0x24, 0x0E, 
+0x0E, 0x7E, 0x71, 0x03, 0x55, 0x5E, 0x4D, 0xF3, 
+0x87, 0xFA, 0x82, 0x18, 0xBC, 0x6E, 0xA3, 0xB7, 
+0xE9, 0xCD, 0x93, 0x7E, 0xD0, 0x32, 0x72, 0x90, 
+0x26, 0xE5, 0x12, 0xAD, 0xE8, 0x73, 0xD1, 0xF3, 
+0x98, 0x3F, 0x1D, 0x8B, 0xEC, 0x2F, 0x8B, 0x4B, 
+0xA8, 0xDD, 0xFF, 0x4F, 0x8A, 0x06, 0xF5, 0x57, 
+
+0x26, 0x83, 0xE6, 0x55, 0x0F, 0xB1, 0x46, 0x9B, 
+0x5D, 0xAD, 0xDE, 0xEC, 0x10, 0xA0, 0xD3, 0xE5, 
+0x09, 0x23, 0x42, 0x36, 0x57, 0xC6, 0x3F, 0xCB, 
+0x99, 0xFC, 0xFA, 0xD4, 0x63, 0xB0, 0xCE, 0xBC, 
+0xC1, 0xAE, 0x47, 0x50, 0x9A, 0xA5, 0x00, 0x65, 
+0x9A, 0x4B, 0x3C, 0x8D, 0x2E, 0x82, 0x5D, 0x20, 
+0xB5, 0xB7, 0xA5, 0x7C, 0x4B, 0xC3, 0x5D, 0x39, 
+0x52, 0x6A, 0x3D, 0xF2, 0x66, 0x70, 0x34, 0x80, 
+0x7F, 0x72, 0x86, 0x9F, 0x16, 0x4B, 0xF4, 0x2E, 
+0x33, 
+
+0x75, 0xFA, 0xBE, 0xC1, 0x94, 0xB0, 0x35, 0x33, 
+0x8A, 0xC1, 0xA2, 0xB3, 0x67, 0xEA, 0x34, 0xCC, 
+0x7C, 0x66, 0x48, 0x56, 0x1A, 0x3F, 0xE7, 0x5B, 
+0x74, 0x95, 0xC7, 0x76, 0xA0, 0xBF, 0xBC, 0x71, 
+0xE1, 0xC7, 0x84, 0x58, 0x7B, 0xFA, 0xAA, 0x79, 
+0x93, 0x9B, 0xF9, 0x1C, 0x3F, 0x34, 0xF7, 0xED, 
+0x0D, 0x07, 0xE9, 0x2C, 0x3F, 0xBD, 0xC9, 0xAF, 
+0xEB, 0xF8, 0x51, 0xC5, 0x17, 0x54, 0x6A, 0x5F, 
+0xB3, 0x77, 0xDA, 0x20, 0x11, 0x13, 0x2A, 0xD8, 
+0x50, 0x0D, 
+
+0x86, 0xC0, 0x31, 0x6A, 0xB1, 0xE8, 0x61, 0x24, 
+0x01, 0x4D, 0xC3, 0xB9, 0x35, 0x42, 0xE1, 0x14, 
+0x9A, 0x2E, 0xBA, 0xFA, 0xEC, 0x4D, 0x7E, 0x23, 
+0x02, 0x9F, 0x6A, 0xFB, 0x19, 0x7A, 0x07, 0x99, 
+0x81, 0x0E, 0x08, 0x05, 0x06, 0xEA, 0x42, 0x7E, 
+0xA1, 0xFB, 0x37, 0x5A, 0x5F, 0x6C, 0x30, 0xBD, 
+0xD0, 0x43, 0xA3, 0x94, 0x9C, 0x5F, 0xCE, 0xDF, 
+0x9B, 0x48, 0xD5, 0x7D, 0x40, 0xEB, 0x6A, 0xD8, 
+0x91, 0x8D, 0x21, 0x5B, 0xE8, 0x9A, 0x0F, 0x64, 
+0x13, 0x9E, 0x36, 
+
+0xDC, 0x23, 0xEA, 0x07, 0xF5, 0x4B, 0xC7, 0xE5, 
+0x9A, 0xB4, 0xCB, 0x47, 0xB8, 0xAB, 0x20, 0xC7, 
+0xF1, 0x9C, 0x71, 0xFF, 0xC5, 0xA2, 0x40, 0x7D, 
+0xAB, 0x4C, 0x57, 0xCF, 0x78, 0x35, 0x19, 0x82, 
+0x35, 0xCF, 0x12, 0x0C, 0x0D, 0xA8, 0x35, 0x8C, 
+0x53, 0x24, 0xE9, 0x49, 0x29, 0x7A, 0x12, 0xDD, 
+0xB3, 0x59, 0x0A, 0x40, 0x33, 0xAB, 0x9A, 0x75, 
+0xB9, 0x33, 0x31, 0x6E, 0x32, 0x47, 0x82, 0xDD, 
+0x71, 0x3A, 0xB8, 0x85, 0x9C, 0x45, 0x21, 0x63, 
+0x9A, 0xE7, 0xB9, 0xC6, 
+
+0x02, 0xCC, 0x94, 0x9C, 0x06, 0x18, 0xA3, 0x1B, 
+0xEB, 0xE7, 0x3D, 0xBD, 0x25, 0xA1, 0x00, 0x6E, 
+0x6E, 0xEE, 0x01, 0x47, 0x0D, 0x0B, 0x70, 0x55, 
+0x08, 0xB0, 0x36, 0x41, 0x47, 0x90, 0xD0, 0x3E, 
+0xBC, 0x7B, 0x9D, 0xB5, 0xD3, 0x8F, 0x1E, 0x77, 
+0xEA, 0xE8, 0x97, 0x35, 0x85, 0xC7, 0xF4, 0x5C, 
+0x1E, 0x6A, 0x9D, 0x6E, 0x95, 0x97, 0x54, 0xED, 
+0x3E, 0x41, 0xA3, 0x99, 0x3C, 0x58, 0x6C, 0x77, 
+0xF4, 0xA5, 0x64, 0x16, 0x61, 0x15, 0x64, 0xA1, 
+0x7A, 0x0D, 0x58, 0xF6, 0xE5, 
+
+0xE0, 0xAD, 0x22, 0xC2, 0x04, 0xF6, 0xB7, 0xCD, 
+0xA3, 0xAE, 0x54, 0x6C, 0x1B, 0xFB, 0x98, 0xA6, 
+0x74, 0x83, 0x45, 0x9B, 0xE7, 0x71, 0xD7, 0x26, 
+0x99, 0x3D, 0x78, 0x06, 0xCC, 0x12, 0x57, 0x35, 
+0x39, 0x2F, 0x22, 0x3A, 0x06, 0xE5, 0x68, 0x7A, 
+0x5D, 0x21, 0x5D, 0x94, 0x9B, 0xCD, 0x18, 0x70, 
+0x32, 0xE2, 0x0A, 0x03, 0x8E, 0xB0, 0x40, 0x8D, 
+0x00, 0xC2, 0x0C, 0x11, 0xD9, 0x62, 0xF7, 0x1C, 
+0x40, 0x7D, 0xFD, 0xCD, 0x53, 0xA3, 0xA0, 0x27, 
+0xC5, 0x20, 0x7A, 0x03, 0x28, 0x89, 
+
+0x6E, 0xF6, 0xC1, 0x1D, 0xE0, 0xEF, 0x0D, 0xE7, 
+0xDB, 0xD6, 0x5E, 0xF6, 0x70, 0x7C, 0xDA, 0xF1, 
+0xEA, 0xE6, 0xF9, 0x58, 0xFD, 0x47, 0xEA, 0xF0, 
+0x65, 0xCE, 0xE1, 0x0D, 0x23, 0x65, 0x99, 0x21, 
+0x17, 0xE9, 0x77, 0xD6, 0xA8, 0x09, 0x1C, 0xAE, 
+0xFA, 0x7D, 0xF0, 0x3D, 0x39, 0x01, 0x60, 0xE1, 
+0xF6, 0x11, 0xF6, 0xC3, 0xD7, 0x7E, 0x44, 0x03, 
+0x15, 0xE7, 0x75, 0x39, 0xCB, 0x22, 0x21, 0xCD, 
+0x1E, 0x97, 0xE7, 0x21, 0x49, 0x33, 0xCD, 0xD1, 
+0xCA, 0x3D, 0xF7, 0xE4, 0xCC, 0xF7, 0x3D, 
+
+0x42, 0x63, 0x03, 0x6A, 0xFC, 0x75, 0xAF, 0x27, 
+0xEB, 0x18, 0x9F, 0x9F, 0x2B, 0xB6, 0xC5, 0xC0, 
+0x20, 0x33, 0x01, 0x96, 0xA2, 0xCC, 0xD8, 0xBE, 
+0x8F, 0xAF, 0x23, 0x19, 0xC6, 0xC6, 0x12, 0x35, 
+0x81, 0x75, 0xCE, 0x25, 0x40, 0x60, 0x99, 0x16, 
+0xB1, 0x8F, 0xF5, 0xEA, 0x9D, 0xEC, 0xA4, 0xD5, 
+0x04, 0x6C, 0xE1, 0x89, 0xE0, 0xDE, 0x4B, 0x0F, 
+0x88, 0x24, 0xC5, 0x57, 0xA0, 0xB0, 0x5B, 0x7B, 
+0x89, 0x31, 0x5B, 0x91, 0xAD, 0x4E, 0xDB, 0x1B, 
+0x33, 0xEC, 0xEC, 0xDE, 0xC9, 0x21, 0xA6, 0x20, 
+
+0xD3, 0x5E, 0x2C, 0x38, 0x05, 0x1C, 0x56, 0x5B, 
+0x15, 0xA6, 0x85, 0x45, 0x5D, 0x27, 0x07, 0x5E, 
+0x3D, 0xB8, 0xAD, 0x07, 0xBB, 0x85, 0x69, 0x7B, 
+0x7D, 0xD9, 0x59, 0x1E, 0xD3, 0x9B, 0x02, 0x43, 
+0xDB, 0x59, 0xC2, 0x53, 0x85, 0xB5, 0xE2, 0x05, 
+0xF2, 0xF7, 0x11, 0xD9, 0x15, 0x4C, 0x9A, 0x99, 
+0x08, 0x56, 0x5C, 0x20, 0x72, 0x3B, 0xA8, 0x93, 
+0x67, 0xBF, 0x35, 0x2F, 0x1E, 0xB6, 0xE2, 0x35, 
+0x56, 0x08, 0xBE, 0x3D, 0x20, 0xF3, 0xD5, 0x16, 
+0xF6, 0x2B, 0x98, 0x73, 0xBB, 0xE7, 0x11, 0x87, 
+0x21, 
+
+0x08, 0x87, 0x44, 0x9D, 0x34, 0x5A, 0xCA, 0xAF, 
+0x52, 0x94, 0x00, 0xD6, 0x68, 0xA6, 0x2D, 0x93, 
+0x1C, 0x0D, 0x4F, 0xC7, 0x22, 0x72, 0x04, 0xD8, 
+0x0B, 0x9A, 0x77, 0x38, 0xEA, 0x53, 0xE6, 0xBF, 
+0xE2, 0x57, 0x32, 0xA0, 0xCA, 0x6D, 0xAB, 0xD3, 
+0x17, 0x72, 0x87, 0x6B, 0xDA, 0xAE, 0x5D, 0x7C, 
+0x61, 0x04, 0x94, 0x6F, 0x6B, 0xA3, 0xBA, 0xA1, 
+0x55, 0xA5, 0xD3, 0x41, 0xF9, 0xCF, 0x3C, 0xAE, 
+0xB9, 0x26, 0xFF, 0x35, 0x78, 0x1E, 0x4D, 0x88, 
+0xB1, 0x31, 0x71, 0x69, 0x6C, 0x9C, 0x77, 0xAE, 
+0xA3, 0xEC, 
+
+0x18, 0xE3, 0x5E, 0xF5, 0xD6, 0x37, 0x6F, 0x7F, 
+0x6D, 0xBC, 0xE3, 0x0E, 0x41, 0x35, 0xF9, 0x66, 
+0x1F, 0xE0, 0x52, 0xC4, 0x50, 0x0D, 0x61, 0x27, 
+0x4D, 0x9D, 0x0F, 0xF9, 0x51, 0x63, 0xA1, 0xFD, 
+0x02, 0xBA, 0x62, 0xA5, 0xFB, 0x13, 0x15, 0xF0, 
+0x63, 0x1F, 0x58, 0x7B, 0x4B, 0xD3, 0x8A, 0x3D, 
+0xB3, 0x5B, 0xF4, 0xAB, 0x68, 0x19, 0xC6, 0x8C, 
+0x08, 0x69, 0x62, 0xC2, 0x4F, 0x8C, 0x70, 0x88, 
+0x7B, 0x76, 0x03, 0xE2, 0x4B, 0x88, 0x5D, 0x62, 
+0xB2, 0xB8, 0x41, 0x3E, 0x56, 0xEA, 0x00, 0x64, 
+0x78, 0xA5, 0xFE, 
+
+0x01, 0xA9, 0x3E, 0x5E, 0x7E, 0x0F, 0xFB, 0x3F, 
+0xA2, 0x94, 0xC2, 0x73, 0x08, 0x8A, 0x9B, 0x2F, 
+0x94, 0xF5, 0x89, 0x2D, 0x8A, 0xEA, 0xDF, 0xA3, 
+0xF1, 0xDF, 0x86, 0xB5, 0x9C, 0x01, 0xDB, 0xB1, 
+0xB4, 0x33, 0x0F, 0x1E, 0x00, 0x92, 0x8D, 0xA8, 
+0x86, 0x6A, 0x32, 0x68, 0x77, 0x40, 0xF6, 0xAB, 
+0x02, 0xD9, 0x76, 0x5A, 0x69, 0xEA, 0x18, 0x8A, 
+0x84, 0xCF, 0xD5, 0xF5, 0x22, 0x11, 0x10, 0xF8, 
+0xCA, 0xBA, 0x30, 0xC5, 0xE9, 0xA4, 0x72, 0x05, 
+0x8E, 0x9B, 0x44, 0xA5, 0xEE, 0x15, 0xE0, 0xF7, 
+0x89, 0xD9, 0x3E, 0x5C, 
+
+0x72, 0x6E, 0x45, 0xB2, 0xBD, 0x01, 0x84, 0xCB, 
+0x45, 0x20, 0x9C, 0x7C, 0x60, 0x73, 0xA4, 0x29, 
+0x95, 0x37, 0xDB, 0xB4, 0x6D, 0x34, 0x5B, 0xA1, 
+0x51, 0x05, 0xAF, 0x3C, 0xB1, 0x79, 0x27, 0xB1, 
+0x81, 0xCA, 0xCC, 0xC8, 0xE3, 0x69, 0xBC, 0x48, 
+0xB1, 0xA7, 0xB8, 0x33, 0x73, 0xF3, 0xE7, 0x07, 
+0x30, 0x1C, 0xFF, 0x59, 0xD5, 0xC2, 0x23, 0xD1, 
+0xE6, 0x8D, 0xAF, 0x27, 0xD9, 0xF2, 0xC6, 0x8A, 
+0x79, 0x2F, 0x11, 0xBD, 0xFE, 0x8E, 0x7E, 0x01, 
+0x7A, 0x9D, 0x27, 0xF1, 0x5A, 0x34, 0xF7, 0x96, 
+0xE9, 0x89, 0xC7, 0x2C, 0xA4, 
+
+0x18, 0x8F, 0xCE, 0x84, 0xCA, 0xBD, 0xF9, 0x64, 
+0x77, 0x3C, 0x3A, 0xE6, 0x08, 0x7E, 0xE1, 0x02, 
+0xB6, 0xE6, 0xA5, 0x7C, 0xF5, 0xD7, 0x46, 0x64, 
+0x9C, 0x70, 0x4F, 0x1C, 0x5E, 0x1D, 0x57, 0x0D, 
+0x3E, 0x3D, 0xF9, 0xB3, 0x04, 0xC0, 0x9E, 0xE2, 
+0x60, 0xBA, 0xE3, 0xCF, 0x27, 0xDE, 0xFE, 0x23, 
+0x2A, 0xEC, 0x60, 0x9B, 0x57, 0xCE, 0x79, 0x17, 
+0x26, 0x3D, 0x8F, 0x8E, 0x17, 0xB3, 0x54, 0xDB, 
+0x34, 0xBF, 0x5D, 0xBC, 0xD3, 0xEC, 0x7E, 0x09, 
+0xB0, 0x99, 0xDC, 0xDD, 0x71, 0xAE, 0x35, 0x31, 
+0x08, 0x45, 0x02, 0x78, 0x5B, 0x29, 
+
+0x84, 0x15, 0x51, 0x8E, 0x2B, 0x3B, 0x84, 0xB4, 
+0x7B, 0x9C, 0x04, 0x93, 0x3C, 0x0A, 0x19, 0x18, 
+0x27, 0x22, 0x03, 0x0C, 0x2A, 0x6D, 0x50, 0x60, 
+0x4E, 0x3C, 0x1F, 0x7D, 0xEE, 0x4C, 0xC7, 0x17, 
+0x90, 0x91, 0x43, 0xDF, 0xA2, 0xE7, 0xDB, 0x6E, 
+0xC2, 0x95, 0xAA, 0xF7, 0x75, 0xF8, 0xA9, 0x71, 
+0x36, 0x55, 0xC5, 0x8B, 0x74, 0xFF, 0x12, 0xCD, 
+0x93, 0xD9, 0x20, 0x4B, 0x7A, 0x79, 0xFE, 0x84, 
+0xCC, 0x5B, 0x67, 0x56, 0xA4, 0x1F, 0xBE, 0xC2, 
+0xC9, 0xBB, 0x52, 0xF3, 0xDE, 0x27, 0x71, 0x0A, 
+0x1B, 0x70, 0xFA, 0x20, 0x05, 0x2D, 0xC1, 
+
+0xF9, 0xAD, 0x51, 0x72, 0xFE, 0xEC, 0xBA, 0x48, 
+0x72, 0x12, 0xEC, 0x7B, 0x6F, 0x0D, 0xED, 0x1E, 
+0x0A, 0x97, 0x81, 0xAC, 0xB3, 0x7B, 0xE8, 0x62, 
+0x85, 0x87, 0xC9, 0x1E, 0x02, 0x4F, 0x3D, 0xC8, 
+0xAA, 0x11, 0xCC, 0x13, 0x67, 0x1E, 0x8C, 0x6A, 
+0xEB, 0xE2, 0x1D, 0x3B, 0x6B, 0xAD, 0x5B, 0xBC, 
+0x91, 0x52, 0x5B, 0xB5, 0x79, 0x0E, 0x42, 0x26, 
+0x24, 0xEC, 0xDF, 0x18, 0x8F, 0x91, 0x2A, 0x3A, 
+0x91, 0xD3, 0xE0, 0xB7, 0x46, 0x89, 0x7C, 0xF9, 
+0xBB, 0x1C, 0x65, 0x60, 0x36, 0x97, 0x08, 0x63, 
+0xF5, 0x63, 0xFA, 0xB3, 0xAC, 0x7C, 0x0D, 0x76, 
+
+0xAC, 0x77, 0x04, 0x21, 0xDC, 0x02, 0xC0, 0x5E, 
+0xEF, 0xCB, 0x2E, 0x0E, 0x07, 0x42, 0x98, 0xC3, 
+0xCE, 0x55, 0xCC, 0x50, 0x24, 0xE8, 0xA7, 0x61, 
+0xDD, 0x3F, 0xA6, 0x16, 0x76, 0x65, 0x25, 0xD1, 
+0xCE, 0xCE, 0x75, 0xDA, 0x79, 0x52, 0x2A, 0x52, 
+0xDD, 0xE3, 0xF0, 0xB9, 0x2E, 0x52, 0xA9, 0xBF, 
+0x53, 0x44, 0x9E, 0x79, 0xB2, 0x46, 0xD0, 0x19, 
+0x10, 0x7C, 0x8D, 0x09, 0x85, 0x2C, 0x95, 0xEC, 
+0x6A, 0x5E, 0x48, 0x7E, 0x8B, 0x7B, 0xFC, 0x96, 
+0x0F, 0x44, 0x4D, 0x2B, 0x34, 0x00, 0xFD, 0x53, 
+0x3E, 0x51, 0x5B, 0xD7, 0xA9, 0xD7, 0x51, 0xF9, 
+0xC8, 
+
+0xC1, 0x28, 0x32, 0x77, 0xCE, 0x81, 0xC3, 0x12, 
+0x8A, 0x70, 0x3A, 0x7B, 0xCA, 0xEA, 0x74, 0xEA, 
+0x66, 0xD1, 0xB4, 0xC5, 0x33, 0xF3, 0xBF, 0xF9, 
+0x27, 0x42, 0x61, 0x91, 0x81, 0xAA, 0xB3, 0x6A, 
+0xD3, 0x4E, 0x51, 0xD4, 0xF7, 0x9F, 0x17, 0x3B, 
+0x13, 0xD3, 0x47, 0x64, 0x4A, 0x6B, 0x74, 0x92, 
+0xB7, 0x01, 0x64, 0xBA, 0x60, 0xBF, 0x85, 0x3E, 
+0x6D, 0xC0, 0x0C, 0xCA, 0x54, 0x02, 0x8E, 0x37, 
+0x1F, 0x87, 0xA9, 0x76, 0xD2, 0x09, 0x18, 0xF7, 
+0xEB, 0x9F, 0x75, 0xF8, 0x85, 0xBD, 0x96, 0x0D, 
+0x54, 0x6A, 0x13, 0xA1, 0x32, 0xE9, 0x3E, 0x50, 
+0xBC, 0x58, 
+
+0xAC, 0xF8, 0xCF, 0xC3, 0xE6, 0xE6, 0xE6, 0x5D, 
+0x3D, 0x61, 0x03, 0xE0, 0xC5, 0x09, 0x95, 0x7B, 
+0x14, 0xC4, 0x8D, 0xB6, 0x72, 0x8C, 0xDF, 0x05, 
+0xDE, 0xBC, 0x13, 0x1C, 0x7E, 0xF6, 0x09, 0xAD, 
+0xA3, 0xD5, 0x44, 0x0F, 0xB7, 0x71, 0xC4, 0x34, 
+0x08, 0x0B, 0x14, 0x39, 0x3B, 0x2E, 0x9A, 0x48, 
+0xAA, 0xC3, 0xBB, 0x2F, 0x6D, 0x61, 0xD1, 0x9A, 
+0xE2, 0xED, 0xDE, 0x05, 0xD7, 0xAC, 0x41, 0xB3, 
+0x13, 0x9A, 0xEC, 0x49, 0x75, 0xE3, 0x1E, 0x44, 
+0xF2, 0xFF, 0x7B, 0xEA, 0x3D, 0x8C, 0x40, 0x63, 
+0x18, 0xAC, 0x1A, 0x8E, 0xB0, 0xC0, 0xD7, 0x6B, 
+0x66, 0xBE, 0x02, 
+
+0x6F, 0xAE, 0x6D, 0x6D, 0x03, 0x16, 0x46, 0xC0, 
+0x6B, 0x10, 0x44, 0x4E, 0x39, 0xE9, 0x52, 0xFA, 
+0xDB, 0x0A, 0x84, 0x70, 0x1C, 0xF4, 0x87, 0x39, 
+0x7D, 0x84, 0x0A, 0x43, 0x78, 0x91, 0xA5, 0xE9, 
+0xB8, 0x7A, 0x66, 0x29, 0x44, 0xF0, 0x44, 0x26, 
+0x43, 0x5C, 0x1D, 0x5B, 0x92, 0x49, 0xC8, 0x38, 
+0x50, 0xBE, 0x8C, 0x48, 0x8A, 0x67, 0x8A, 0x5A, 
+0x99, 0xD5, 0x2C, 0xBF, 0x7A, 0x63, 0xD2, 0x3A, 
+0xF7, 0xA1, 0x8E, 0x34, 0x69, 0x6A, 0x52, 0x6A, 
+0xDA, 0x26, 0xFF, 0x2F, 0xC1, 0x2D, 0xE5, 0x34, 
+0x34, 0xB7, 0xED, 0xEE, 0xDA, 0xF6, 0xC2, 0x60, 
+0xA7, 0x82, 0x67, 0xF1, 
+
+0x0C, 0xDE, 0x58, 0x05, 0x29, 0x5F, 0xB0, 0x2A, 
+0x3F, 0xF7, 0x3F, 0x44, 0x1D, 0xB8, 0x32, 0x93, 
+0x55, 0x2C, 0xB8, 0x81, 0x0B, 0xB3, 0xA2, 0xED, 
+0xCE, 0x12, 0x2A, 0xF8, 0xD5, 0xE1, 0x7B, 0x36, 
+0xF4, 0x3C, 0x5C, 0x06, 0xE0, 0x34, 0x3B, 0x3A, 
+0xCE, 0xD3, 0xA8, 0x56, 0xC9, 0xFC, 0x85, 0xA4, 
+0x77, 0x44, 0x84, 0x19, 0x4A, 0xF9, 0xA2, 0xA0, 
+0xB5, 0x0D, 0x70, 0x86, 0x9F, 0xC0, 0x0B, 0xCE, 
+0x65, 0xE1, 0x7F, 0x2D, 0xDF, 0x98, 0x6D, 0x0A, 
+0x2A, 0x1E, 0xC2, 0x70, 0xEF, 0xDC, 0x5B, 0xE2, 
+0x51, 0xFC, 0x0E, 0x7B, 0x7D, 0x7A, 0xB4, 0x7E, 
+0x7F, 0xFB, 0x97, 0x90, 0xC0, 
+
+0x7B, 0xD4, 0xC7, 0x1E, 0x0F, 0xAF, 0xAF, 0xFB, 
+0x46, 0x43, 0x4C, 0x39, 0x39, 0x98, 0xBE, 0x58, 
+0x42, 0x04, 0xF0, 0x22, 0x67, 0x2C, 0x21, 0x59, 
+0x9A, 0xEA, 0x38, 0xF9, 0x08, 0x1A, 0x5D, 0x28, 
+0x70, 0x93, 0x8E, 0x22, 0xB3, 0xB9, 0x0C, 0x15, 
+0x4D, 0x5B, 0xA7, 0x32, 0x9E, 0xD9, 0x8C, 0x1E, 
+0xDB, 0x18, 0xF1, 0xFD, 0x8A, 0x3F, 0xF4, 0x59, 
+0x0A, 0x92, 0x68, 0xEB, 0xAC, 0x75, 0xDF, 0x0C, 
+0x43, 0x03, 0x78, 0x8C, 0x26, 0x12, 0x72, 0xD1, 
+0xB0, 0xF8, 0xA3, 0x54, 0xB8, 0x18, 0x30, 0x82, 
+0x2A, 0xF5, 0x50, 0xFE, 0x83, 0x37, 0xB3, 0x2F, 
+0xE1, 0xD4, 0x5E, 0xC7, 0x20, 0x7A, 
+
+0x58, 0x97, 0xF7, 0x71, 0xC6, 0xE8, 0x91, 0x72, 
+0xDB, 0x92, 0xD2, 0x16, 0x45, 0x1E, 0xF0, 0x0A, 
+0x5D, 0x60, 0xEB, 0x7F, 0x97, 0x6F, 0x41, 0x60, 
+0x7A, 0xDE, 0x03, 0x78, 0xB0, 0x22, 0xFD, 0x83, 
+0x10, 0x6B, 0xF6, 0xB6, 0xDF, 0xDB, 0x3C, 0xB4, 
+0x6B, 0x07, 0xC8, 0x5A, 0x9E, 0x56, 0x87, 0xC0, 
+0xEA, 0x23, 0x46, 0x6F, 0xC9, 0xD6, 0x87, 0x6E, 
+0xE4, 0xF6, 0x4F, 0x58, 0xE5, 0x14, 0x86, 0x3B, 
+0x5D, 0xB0, 0x8F, 0xDE, 0x5F, 0xB7, 0x43, 0xBE, 
+0x4A, 0x77, 0x9F, 0xEB, 0x66, 0xA5, 0xB7, 0xCA, 
+0x30, 0x7E, 0xDC, 0x6E, 0x3B, 0x59, 0xAF, 0xB2, 
+0xE5, 0x52, 0x86, 0xFC, 0xF8, 0x17, 0x97, 
+
+0x29, 0x2B, 0xC8, 0x01, 0xB4, 0xE8, 0x50, 0x99, 
+0xCB, 0xA7, 0x9F, 0x52, 0x18, 0xA2, 0xA2, 0xF3, 
+0x78, 0x65, 0x37, 0x0C, 0x47, 0x89, 0xC1, 0x2F, 
+0x76, 0xBF, 0xE4, 0x96, 0x6B, 0x96, 0xBB, 0x39, 
+0xCF, 0xD9, 0x73, 0xF7, 0xBD, 0x7F, 0x78, 0x0D, 
+0x16, 0x86, 0x6D, 0x79, 0x4F, 0xAE, 0x32, 0x08, 
+0x98, 0x86, 0x80, 0x5D, 0x40, 0x52, 0x1C, 0x43, 
+0xDF, 0xA1, 0x34, 0x37, 0x77, 0x57, 0xB4, 0x5B, 
+0xB0, 0x62, 0x12, 0xDB, 0x4A, 0x71, 0xB7, 0xB3, 
+0xC6, 0xCC, 0xBF, 0xBC, 0x95, 0xF4, 0x0E, 0x35, 
+0x96, 0x89, 0xF3, 0xFD, 0x42, 0x0F, 0x4D, 0x93, 
+0x13, 0x1C, 0xF1, 0x80, 0x3C, 0x77, 0xB4, 0x03, 
+
+0x71, 0x5A, 0xE9, 0x5E, 0xF3, 0x96, 0x2C, 0x84, 
+0xAF, 0x73, 0x4F, 0xDC, 0xF2, 0x1D, 0xA0, 0xD8, 
+0xAB, 0x5B, 0xC1, 0xF7, 0xED, 0x6E, 0xC1, 0xE3, 
+0xD6, 0x30, 0xCB, 0x86, 0x1F, 0x4C, 0x11, 0x4D, 
+0x2B, 0x0F, 0x14, 0x53, 0x28, 0xE8, 0x1C, 0xB0, 
+0x2B, 0x13, 0x48, 0x9C, 0x66, 0x9A, 0xA8, 0x1B, 
+0x5A, 0x31, 0x99, 0xB9, 0x0B, 0x85, 0x28, 0xD2, 
+0x66, 0x7F, 0x8F, 0x4E, 0xFC, 0x2F, 0x54, 0x3D, 
+0x71, 0x95, 0x05, 0xFA, 0x16, 0x74, 0xAF, 0xC2, 
+0x23, 0x37, 0xFA, 0x16, 0x6B, 0x4F, 0x69, 0x6C, 
+0xC5, 0xD1, 0xF7, 0xEF, 0xAC, 0xD6, 0xD9, 0xE0, 
+0x8F, 0x9B, 0xBB, 0xE0, 0xA9, 0xD7, 0x49, 0x65, 
+0x78, 
+
+0x2A, 0x2E, 0x6C, 0x93, 0xCB, 0xD5, 0x2D, 0x77, 
+0xB8, 0x03, 0xB7, 0x6F, 0x3E, 0x8E, 0x09, 0xB1, 
+0x2B, 0x46, 0xAD, 0x3C, 0xEB, 0xA2, 0x6D, 0x3A, 
+0x22, 0x52, 0x61, 0xA5, 0xFC, 0x64, 0x8E, 0x34, 
+0x07, 0xE2, 0x01, 0x90, 0x60, 0x25, 0xA2, 0x0D, 
+0x46, 0x2F, 0x44, 0x6D, 0x2E, 0xEC, 0xAD, 0x5C, 
+0x1A, 0xEC, 0xF4, 0x18, 0x30, 0x38, 0x5C, 0xE6, 
+0xE6, 0xE9, 0x80, 0xFD, 0x1C, 0x12, 0x71, 0xA4, 
+0xC5, 0xCD, 0xB2, 0xA9, 0x89, 0x7F, 0xFF, 0x6B, 
+0x6E, 0x01, 0x54, 0xBC, 0x9B, 0xD4, 0x9E, 0x49, 
+0xA1, 0xA7, 0x80, 0x0C, 0xAE, 0x19, 0x61, 0x73, 
+0xED, 0x5C, 0xC2, 0xC2, 0xF9, 0x38, 0x88, 0x7D, 
+0x6B, 0xE2, 
+
+0xEB, 0x0D, 0x6C, 0x28, 0xA7, 0x49, 0xA5, 0x22, 
+0x7F, 0x7D, 0xC4, 0xA2, 0x79, 0x34, 0x79, 0x9B, 
+0x3B, 0x2C, 0xCF, 0x13, 0x7F, 0xC1, 0x69, 0xF4, 
+0xFD, 0x7F, 0xD1, 0xF5, 0x40, 0xDB, 0x5A, 0x36, 
+0x82, 0xF5, 0xE9, 0x6F, 0xFD, 0x59, 0x2F, 0x77, 
+0x87, 0x7D, 0x55, 0x5A, 0x42, 0x88, 0x6C, 0xED, 
+0xA2, 0xF6, 0x00, 0x5B, 0xF3, 0x2B, 0xF2, 0x9D, 
+0xBC, 0x0A, 0xA5, 0x9F, 0xA8, 0xD4, 0xF2, 0xAA, 
+0xFC, 0x62, 0x2D, 0x9C, 0x1E, 0x3D, 0xE7, 0x4B, 
+0x16, 0x6C, 0xD2, 0x89, 0x28, 0x0F, 0xBE, 0x1D, 
+0xE5, 0xD8, 0x7D, 0x7D, 0xFE, 0xA1, 0xD2, 0x0A, 
+0xA6, 0x4D, 0x63, 0x00, 0xD5, 0x87, 0x30, 0x2D, 
+0x40, 0x5A, 0xEF, 
+
+0xD6, 0x30, 0x68, 0xB6, 0x2B, 0x77, 0xBA, 0x8E, 
+0x0D, 0xD2, 0x70, 0x82, 0xC3, 0xF5, 0x9F, 0xF1, 
+0x94, 0x1E, 0x00, 0x0C, 0x80, 0xA4, 0x83, 0x04, 
+0x36, 0x0D, 0x83, 0xFA, 0x4A, 0xA0, 0x14, 0x21, 
+0x87, 0x58, 0xB6, 0x14, 0x17, 0x04, 0x58, 0x5F, 
+0x90, 0x44, 0x3F, 0x74, 0x72, 0x33, 0x7F, 0xEC, 
+0x95, 0xA3, 0x2E, 0x98, 0x9A, 0x02, 0x41, 0x4E, 
+0x66, 0xDA, 0x85, 0x29, 0x16, 0x64, 0xB2, 0xE8, 
+0x68, 0x9A, 0xA3, 0x40, 0x5C, 0xEF, 0x42, 0x46, 
+0x7B, 0x38, 0xA2, 0xCD, 0x11, 0xBA, 0x53, 0x78, 
+0x4B, 0xC9, 0x7C, 0x7A, 0xB5, 0xA8, 0x0A, 0xFB, 
+0x56, 0xA7, 0x58, 0x16, 0xD4, 0xF4, 0xE3, 0xDC, 
+0x6C, 0x50, 0x2E, 0xFD, 
+
+0x4A, 0xF4, 0x46, 0x3B, 0xED, 0x66, 0xC7, 0xD9, 
+0x48, 0x7B, 0xB5, 0x78, 0x62, 0xFA, 0x07, 0xA0, 
+0x14, 0xDF, 0x7B, 0xD6, 0xE9, 0x28, 0xB9, 0x28, 
+0xC5, 0x03, 0x12, 0xCE, 0x56, 0xD3, 0x4F, 0x4E, 
+0x94, 0xC9, 0x2F, 0xCB, 0x54, 0xF7, 0xB0, 0xC0, 
+0xAA, 0x86, 0x91, 0xDB, 0xD3, 0x48, 0xF7, 0x0B, 
+0xAE, 0x75, 0x0D, 0xDD, 0x65, 0xD4, 0x12, 0x42, 
+0xB1, 0x6D, 0x7D, 0xCD, 0x3D, 0xBB, 0xD0, 0x5C, 
+0x95, 0x5C, 0x86, 0xEC, 0x2A, 0x2F, 0x69, 0x5E, 
+0xD4, 0x73, 0xC3, 0x20, 0x46, 0x5C, 0x56, 0x01, 
+0xC6, 0x13, 0xCA, 0x84, 0x47, 0x57, 0x9B, 0xA0, 
+0xE1, 0xAD, 0x87, 0xCC, 0xCF, 0x43, 0xE3, 0x00, 
+0x9B, 0x04, 0xBB, 0x5B, 0xF5, 
+
+0x1A, 0x0F, 0x61, 0x3E, 0x67, 0x59, 0xAC, 0x9B, 
+0xE0, 0xEC, 0x90, 0x95, 0x02, 0x69, 0x16, 0x47, 
+0x58, 0x87, 0xF5, 0x6D, 0xAA, 0x23, 0x63, 0x82, 
+0x4A, 0xEF, 0x12, 0x76, 0xEC, 0x18, 0x0F, 0xD7, 
+0xDA, 0x83, 0xFB, 0x44, 0x06, 0xB2, 0xBA, 0xFF, 
+0x6E, 0xBA, 0x30, 0x9D, 0x3F, 0x26, 0x6D, 0xB7, 
+0x97, 0x69, 0x90, 0xBF, 0xAA, 0xE6, 0x55, 0xDD, 
+0x46, 0xF1, 0x39, 0xF9, 0x1F, 0x66, 0xE4, 0x07, 
+0x47, 0x40, 0x72, 0x97, 0xD5, 0xAA, 0x78, 0xCE, 
+0x81, 0x8C, 0xBC, 0xB6, 0xE2, 0x65, 0xD2, 0xD0, 
+0x83, 0xE3, 0x62, 0xD1, 0xF6, 0x6F, 0xB2, 0xE1, 
+0x3A, 0xF3, 0x4A, 0x7A, 0xD1, 0x08, 0xF8, 0x24, 
+0x6E, 0x45, 0x1A, 0xE2, 0x23, 0x91, 
+
+0xAB, 0xB0, 0xE8, 0x92, 0x46, 0xB2, 0x41, 0xA9, 
+0xC9, 0xE1, 0x4C, 0xA3, 0xBC, 0xCF, 0x82, 0x28, 
+0xBD, 0x05, 0xE4, 0x91, 0x8B, 0xEA, 0xF6, 0xAE, 
+0xEB, 0x5F, 0xBD, 0x55, 0xA3, 0xD9, 0x8D, 0xFC, 
+0xC7, 0x8E, 0x94, 0x70, 0xBE, 0x67, 0x9C, 0x2D, 
+0xDE, 0x1B, 0xBA, 0x15, 0x06, 0xE4, 0x7C, 0x3E, 
+0x9C, 0x99, 0x09, 0xE9, 0x5F, 0x24, 0xA9, 0xFC, 
+0x0D, 0x0D, 0xDA, 0xC1, 0x5F, 0x46, 0xA3, 0xEE, 
+0xDD, 0x47, 0x19, 0xC0, 0xC9, 0xD4, 0x48, 0x68, 
+0x9B, 0xD7, 0x77, 0x09, 0xC7, 0x7C, 0x55, 0x40, 
+0x26, 0xE4, 0x30, 0xEB, 0xC8, 0x3A, 0xEE, 0x5F, 
+0xE9, 0x39, 0x72, 0x65, 0x53, 0xB7, 0x9F, 0xE1, 
+0x85, 0x36, 0x49, 0x23, 0xDE, 0x6E, 0x26, 
+
+0xA3, 0xB0, 0xEA, 0x52, 0xCF, 0xA8, 0xF8, 0xD4, 
+0xE9, 0xF6, 0x5A, 0xE0, 0x82, 0xCA, 0x37, 0x4D, 
+0x35, 0x6F, 0x96, 0x60, 0x39, 0x6E, 0x4C, 0x7C, 
+0xFA, 0xE6, 0x28, 0x98, 0x59, 0xC2, 0xF1, 0xFE, 
+0x6F, 0x32, 0xA8, 0x53, 0xC1, 0x63, 0x3D, 0xF9, 
+0xB7, 0x79, 0x5E, 0x51, 0x44, 0x29, 0xB8, 0x4A, 
+0xFB, 0x61, 0x21, 0xE9, 0xB6, 0xE3, 0x8A, 0xC9, 
+0x64, 0x60, 0x7A, 0xD3, 0xEA, 0x19, 0x2A, 0x26, 
+0xD2, 0xF7, 0xC0, 0xD4, 0xD0, 0x96, 0x57, 0x9C, 
+0x3B, 0x39, 0xF3, 0x2F, 0x79, 0xFA, 0xC8, 0xC0, 
+0x73, 0x61, 0xAA, 0x94, 0x55, 0x6A, 0x55, 0xCB, 
+0x05, 0x63, 0xD5, 0x3D, 0xFB, 0x82, 0xF1, 0x7F, 
+0x87, 0xA6, 0xA1, 0x2A, 0xDF, 0xDC, 0x71, 0xED, 
+
+0x6B, 0x50, 0xDF, 0xB6, 0xCE, 0xFA, 0x9B, 0x26, 
+0x49, 0x88, 0x65, 0x6E, 0x43, 0xAE, 0x02, 0x85, 
+0xB0, 0xE8, 0x89, 0x4D, 0x1B, 0x89, 0x50, 0x4E, 
+0xFC, 0xE5, 0xEF, 0x2B, 0xB1, 0x57, 0x6C, 0xB0, 
+0x60, 0x00, 0x34, 0x6C, 0x24, 0x88, 0x62, 0x3A, 
+0xBB, 0x9F, 0x05, 0xFD, 0x69, 0x01, 0x5C, 0x14, 
+0x58, 0xB4, 0xFB, 0xD6, 0x52, 0xEF, 0xD0, 0xFE, 
+0xBE, 0x14, 0xAE, 0xD1, 0xF7, 0x53, 0xD2, 0xF4, 
+0x14, 0xF5, 0x16, 0x05, 0x9E, 0x86, 0x95, 0x16, 
+0x3B, 0x6B, 0x70, 0x52, 0x36, 0x49, 0x9C, 0x9D, 
+0x21, 0xC7, 0x6D, 0xEB, 0x40, 0x6B, 0x8D, 0x2D, 
+0xCD, 0x8B, 0x0B, 0x60, 0x1B, 0xBF, 0x4B, 0x9D, 
+0x23, 0xFE, 0x4C, 0xDB, 0x1D, 0xFB, 0x72, 0x95, 
+0x2E, 
+
+0x9D, 0xCE, 0x2B, 0x65, 0x69, 0xDF, 0xD7, 0xE5, 
+0xE4, 0xEC, 0xAC, 0xD5, 0xDB, 0xD0, 0x3A, 0x0E, 
+0x9C, 0xD0, 0xA9, 0x7C, 0xC7, 0x26, 0x41, 0x83, 
+0xEE, 0x24, 0x74, 0xE5, 0x48, 0x65, 0x5C, 0xA0, 
+0xEF, 0xBA, 0x86, 0x24, 0xDC, 0xC6, 0x5F, 0x73, 
+0xC2, 0xB6, 0x6F, 0xA7, 0x7F, 0xC0, 0x7E, 0xA8, 
+0xC2, 0x3C, 0x7D, 0x5C, 0x85, 0xAD, 0xE7, 0x0A, 
+0x02, 0x1F, 0xD0, 0x96, 0xAE, 0x51, 0x80, 0x7F, 
+0xFA, 0xE6, 0x1B, 0xD3, 0xE5, 0xD4, 0x08, 0x2D, 
+0x43, 0x0F, 0x61, 0x85, 0xF5, 0xB7, 0x91, 0xC0, 
+0x0E, 0x09, 0x29, 0xC7, 0xC5, 0x4F, 0xD8, 0x73, 
+0x62, 0x6F, 0xA5, 0xB2, 0x8D, 0x57, 0x33, 0x35, 
+0x72, 0xB1, 0x4D, 0xC5, 0x4C, 0x6D, 0xE3, 0x11, 
+0xF8, 0xC7, 
+
+0xF1, 0x1B, 0x95, 0x6A, 0xCB, 0x84, 0xA5, 0x27, 
+0xC7, 0xD2, 0xAB, 0x99, 0x3A, 0x65, 0xA6, 0x93, 
+0xC1, 0x8F, 0x11, 0xAD, 0xC1, 0xAF, 0x26, 0x5B, 
+0xE1, 0x25, 0x83, 0x18, 0x6D, 0x20, 0x5F, 0xAB, 
+0x65, 0x9E, 0xAE, 0xEF, 0x11, 0xD9, 0xC5, 0x75, 
+0x3C, 0x77, 0xC9, 0x15, 0x02, 0xBC, 0x1D, 0x56, 
+0x12, 0xD1, 0x67, 0x00, 0x85, 0xE0, 0x18, 0xA0, 
+0x49, 0x9A, 0x8F, 0xCB, 0xEF, 0x09, 0xF3, 0x86, 
+0x1A, 0xC1, 0x7F, 0x6A, 0xDC, 0x52, 0xEC, 0x6C, 
+0x33, 0xD5, 0x08, 0xA3, 0x77, 0x44, 0x22, 0x1E, 
+0xD5, 0x0C, 0x93, 0xB8, 0x67, 0x4D, 0x59, 0x7F, 
+0xBA, 0xA0, 0x2B, 0xEF, 0xAE, 0x99, 0xF9, 0xE8, 
+0x7A, 0x65, 0x68, 0x89, 0x92, 0xB4, 0x91, 0x1F, 
+0x26, 0xE9, 0x5E, 
+
+0x06, 0xD3, 0xE8, 0x62, 0x43, 0x2B, 0x6A, 0x43, 
+0x69, 0xB0, 0xE9, 0x16, 0x2A, 0x51, 0x14, 0x59, 
+0xFE, 0x66, 0xC6, 0x90, 0xB0, 0x18, 0x36, 0xA5, 
+0xEE, 0x34, 0x63, 0x6A, 0x2A, 0x2D, 0xB4, 0x53, 
+0x6A, 0x1A, 0xAA, 0xFD, 0x90, 0x63, 0x82, 0x71, 
+0x0D, 0xBE, 0x17, 0xE4, 0xF8, 0xA0, 0x8F, 0x35, 
+0xFE, 0x0D, 0x61, 0x11, 0x36, 0x05, 0x35, 0x92, 
+0xD8, 0x58, 0xB6, 0x77, 0xB3, 0xDC, 0xAF, 0x7F, 
+0x8F, 0xDA, 0x8C, 0xF9, 0xFD, 0xA8, 0x28, 0x3C, 
+0xE8, 0x1D, 0xFE, 0x9E, 0x27, 0x8A, 0x3B, 0x54, 
+0x3D, 0x2E, 0xBB, 0x58, 0xBC, 0x2B, 0x23, 0x48, 
+0x27, 0xDA, 0x70, 0xAD, 0xF9, 0x95, 0x6A, 0x87, 
+0xF0, 0x56, 0xE5, 0xB5, 0x93, 0xD1, 0x9B, 0x78, 
+0x08, 0xEA, 0xD2, 0x77, 
+
+0xC8, 0x63, 0x2F, 0x67, 0x9E, 0xC2, 0x98, 0xA2, 
+0x36, 0x54, 0xB4, 0xF5, 0xD8, 0xEE, 0x60, 0x48, 
+0x95, 0x69, 0xD2, 0x14, 0x2C, 0x00, 0x50, 0xAE, 
+0xDF, 0xC7, 0xD7, 0xE0, 0x50, 0x8B, 0x71, 0xD3, 
+0x42, 0x78, 0x1B, 0xB9, 0xB7, 0xB6, 0x80, 0x86, 
+0xC8, 0xD8, 0x3C, 0x8F, 0x74, 0x45, 0x66, 0x59, 
+0x3E, 0xF2, 0x6F, 0xDB, 0x49, 0x4F, 0x8B, 0x5C, 
+0x26, 0xDA, 0x9B, 0xD9, 0x88, 0x5A, 0x94, 0xCF, 
+0x87, 0x83, 0x20, 0x3A, 0x13, 0x1D, 0xB4, 0xD9, 
+0xB5, 0x28, 0x72, 0xE1, 0xF4, 0x49, 0x9A, 0x5F, 
+0x3B, 0x7B, 0x2C, 0xB4, 0xF7, 0xBE, 0x29, 0x63, 
+0x4D, 0x9A, 0x7D, 0x33, 0x98, 0xA3, 0x58, 0x2B, 
+0x2C, 0x9C, 0xA7, 0x4B, 0x4B, 0x1F, 0x14, 0xF7, 
+0x82, 0x2F, 0x06, 0xB2, 0x76, 
+
+0x9A, 0xAB, 0x0F, 0x1D, 0x02, 0xA2, 0x40, 0xDA, 
+0x6C, 0xC3, 0x8C, 0xDE, 0x04, 0x6D, 0x6D, 0x54, 
+0xF4, 0x89, 0xF8, 0xB1, 0xE4, 0x24, 0xF5, 0x4E, 
+0xE9, 0xA4, 0x49, 0xF4, 0x15, 0x79, 0x03, 0x85, 
+0x77, 0xDE, 0x10, 0xDA, 0x6E, 0xCC, 0x2B, 0x19, 
+0x54, 0x4B, 0xCC, 0x7B, 0xBA, 0x12, 0xD1, 0xF4, 
+0x35, 0xB2, 0x93, 0x59, 0xFC, 0xD5, 0xFD, 0x60, 
+0xFA, 0x28, 0x42, 0xD4, 0x82, 0xE4, 0xC0, 0x81, 
+0xCB, 0x6E, 0xC4, 0x91, 0x0E, 0x2A, 0x8C, 0xFC, 
+0x99, 0x45, 0x0D, 0xF1, 0x82, 0xBE, 0x32, 0xD3, 
+0x31, 0xC1, 0xED, 0xB9, 0xBB, 0x6A, 0xCE, 0x8C, 
+0x63, 0x89, 0x4B, 0xAF, 0xE3, 0xCB, 0x0B, 0x8C, 
+0x7C, 0x0E, 0xA9, 0x23, 0x2E, 0x20, 0xF4, 0xAE, 
+0xF6, 0x20, 0x63, 0x80, 0x37, 0x68, 
+
+0xEA, 0xCB, 0xD7, 0x96, 0x80, 0x4E, 0xBF, 0xA3, 
+0x19, 0x98, 0x67, 0x08, 0xEC, 0xB9, 0x23, 0x76, 
+0x5D, 0xE4, 0xE7, 0x67, 0x3E, 0xC3, 0x98, 0x43, 
+0x1D, 0x36, 0xD5, 0x5D, 0x7E, 0x87, 0x38, 0xDA, 
+0x1C, 0x0D, 0x83, 0x5D, 0x02, 0x67, 0xED, 0xD4, 
+0xA5, 0x62, 0x7B, 0xF6, 0x84, 0x7D, 0x70, 0xA4, 
+0x05, 0x75, 0x15, 0x3D, 0x13, 0xFB, 0xF8, 0xAD, 
+0xBA, 0x3D, 0xE1, 0xB6, 0xE0, 0x87, 0xD1, 0x90, 
+0x6E, 0x23, 0x8D, 0xE1, 0x1D, 0x97, 0x66, 0x16, 
+0xCF, 0xA6, 0xFC, 0xE5, 0x00, 0x75, 0x3D, 0x4B, 
+0x0B, 0xEB, 0x62, 0x4E, 0xE2, 0x09, 0x2B, 0x51, 
+0xEC, 0x5B, 0x37, 0x3F, 0xC9, 0x76, 0x46, 0x52, 
+0xB6, 0x3C, 0x94, 0x73, 0x00, 0x73, 0x9A, 0xBC, 
+0xDB, 0x49, 0x02, 0xC2, 0x0D, 0x32, 0xFE, 
+
+0xDE, 0xD2, 0x7D, 0x39, 0x3D, 0xBA, 0x68, 0x89, 
+0x64, 0xDD, 0x11, 0xBE, 0x21, 0xE8, 0xAE, 0x58, 
+0xA3, 0x40, 0x29, 0x27, 0x07, 0xF0, 0xF3, 0x2F, 
+0x2D, 0x68, 0xDA, 0x65, 0x9E, 0xF8, 0xE5, 0x67, 
+0xE3, 0x61, 0xE8, 0x2B, 0x4A, 0xD8, 0x02, 0xF8, 
+0xD6, 0x95, 0xCE, 0xCA, 0x39, 0x86, 0xA5, 0x2C, 
+0x16, 0x4F, 0x55, 0x8B, 0x43, 0xE1, 0x42, 0xDB, 
+0xD0, 0xEC, 0x5F, 0x85, 0xDE, 0x4D, 0xD7, 0x8B, 
+0x91, 0x79, 0x85, 0x10, 0xA0, 0xED, 0x21, 0x4B, 
+0x8D, 0x34, 0x3D, 0x90, 0x05, 0x9B, 0x79, 0x1B, 
+0x57, 0x47, 0xF9, 0xB6, 0xEC, 0x84, 0xFC, 0xA3, 
+0xBD, 0xF6, 0xDB, 0xFF, 0x35, 0x97, 0x70, 0x6B, 
+0xE3, 0xA7, 0x70, 0xBD, 0x21, 0x3C, 0xD7, 0x28, 
+0x01, 0x97, 0x9A, 0xA1, 0xFF, 0xE6, 0xB5, 0xFE, 
+
+0x22, 0x75, 0xB8, 0x3D, 0x35, 0x80, 0x38, 0x76, 
+0xF4, 0xDB, 0x86, 0x5A, 0x3E, 0xE6, 0x63, 0x58, 
+0xC7, 0x73, 0x1B, 0x9C, 0xEE, 0x89, 0x28, 0x65, 
+0xAC, 0xDF, 0x44, 0xBE, 0x53, 0xB3, 0x98, 0xAB, 
+0x23, 0x70, 0xEC, 0x7F, 0x7C, 0xFC, 0x24, 0xB9, 
+0xF4, 0x25, 0xE4, 0x8D, 0x23, 0x0F, 0x8C, 0xDD, 
+0x46, 0x81, 0x8C, 0xC3, 0xC8, 0x1A, 0xD5, 0xAA, 
+0xBA, 0x62, 0xEB, 0x3D, 0x8B, 0x4E, 0xE4, 0x38, 
+0x59, 0x0A, 0x0D, 0x28, 0xDF, 0x63, 0x22, 0x2A, 
+0x4E, 0xB4, 0xD8, 0xD4, 0xCC, 0xC7, 0x94, 0xA3, 
+0x8B, 0xEA, 0xED, 0x36, 0x39, 0x29, 0xBF, 0x1E, 
+0x70, 0xC4, 0xB1, 0x53, 0x10, 0x88, 0xFF, 0xF4, 
+0xFD, 0x4A, 0x5B, 0x39, 0x8C, 0xDC, 0xAD, 0xC5, 
+0xA3, 0xD4, 0x42, 0x2A, 0x79, 0x78, 0xCD, 0x4D, 
+0xC0, 
+
+0x74, 0x24, 0xB1, 0x6C, 0xEA, 0x17, 0x5D, 0x90, 
+0x9E, 0xFA, 0x45, 0x9C, 0xF9, 0x98, 0x11, 0xDB, 
+0x5F, 0xB4, 0x3B, 0xDB, 0xBC, 0x2F, 0x0C, 0xCF, 
+0x87, 0xDD, 0x89, 0x56, 0x58, 0xD7, 0xC9, 0xE8, 
+0x04, 0x9C, 0xE9, 0x42, 0xF9, 0xC1, 0x78, 0x90, 
+0x60, 0x02, 0x83, 0xF6, 0xC9, 0xA6, 0x3F, 0x1A, 
+0xDC, 0xF5, 0x4D, 0xF2, 0x50, 0x2D, 0x8C, 0xB5, 
+0xA6, 0xE0, 0x0E, 0x43, 0xB2, 0x98, 0x44, 0x43, 
+0xB5, 0x30, 0x0F, 0x0C, 0x03, 0xE4, 0xF3, 0x77, 
+0x15, 0x17, 0x7B, 0x51, 0x98, 0x53, 0xD7, 0x9C, 
+0x1F, 0x70, 0x14, 0xCA, 0xD9, 0x64, 0xF3, 0x13, 
+0x3E, 0x53, 0x60, 0x1B, 0xA5, 0x98, 0x83, 0xDF, 
+0xD8, 0xF1, 0x5A, 0x38, 0x5A, 0xCD, 0xE6, 0x68, 
+0xF4, 0x54, 0x2C, 0xF9, 0x5F, 0x33, 0xE0, 0xA8, 
+0xDD, 0x47, 
+
+0xB9, 0xA0, 0x68, 0xCC, 0x1B, 0xA4, 0x9B, 0x5A, 
+0x10, 0xE5, 0xF8, 0x28, 0xFD, 0xD0, 0x30, 0x6A, 
+0xF7, 0x70, 0xB9, 0xB7, 0xED, 0x4D, 0xD3, 0x8D, 
+0x5B, 0x6F, 0x21, 0xB8, 0xFF, 0x9B, 0x20, 0xE6, 
+0x8E, 0xB9, 0xB3, 0xAA, 0x23, 0xC5, 0xCB, 0x59, 
+0x42, 0xF5, 0x8B, 0x53, 0x81, 0x9C, 0xCB, 0x2D, 
+0x94, 0xB2, 0x83, 0x64, 0x3C, 0xF5, 0xFF, 0x3C, 
+0x3B, 0x6D, 0x09, 0x32, 0x61, 0x84, 0x08, 0x34, 
+0xE1, 0x5E, 0xB4, 0x84, 0x44, 0xE4, 0x41, 0xC9, 
+0xC5, 0x1D, 0x07, 0x35, 0x35, 0x45, 0xAD, 0xC9, 
+0x0A, 0x6A, 0xE9, 0x04, 0xBB, 0x60, 0xD1, 0xFC, 
+0x09, 0x87, 0xE5, 0x0D, 0x0E, 0x6A, 0x16, 0x3F, 
+0xA5, 0x32, 0x9C, 0x71, 0x00, 0xC5, 0x07, 0x69, 
+0x8B, 0x4E, 0x92, 0xAD, 0xAF, 0x92, 0xD0, 0x92, 
+0xF0, 0x66, 0x5A, 
+
+0xD8, 0xC8, 0xEC, 0x7F, 0xF6, 0xAC, 0x6D, 0x42, 
+0xC9, 0x2A, 0x16, 0x10, 0x31, 0x72, 0x34, 0x92, 
+0x3C, 0x9E, 0x0A, 0xBB, 0xD6, 0x7F, 0x03, 0x6D, 
+0xCA, 0x0E, 0x42, 0x91, 0xB1, 0xDC, 0xD2, 0xAC, 
+0xCD, 0xB1, 0x82, 0xEC, 0xA9, 0xE2, 0x65, 0x93, 
+0xC3, 0xE7, 0x15, 0xBB, 0x21, 0xBE, 0x44, 0xD9, 
+0xD1, 0xBF, 0xBD, 0xDB, 0xE7, 0x4A, 0xD0, 0x03, 
+0x70, 0x65, 0x52, 0xE2, 0x7F, 0xE1, 0x7A, 0x5C, 
+0xAB, 0x03, 0x6C, 0xAC, 0x2B, 0xF5, 0xF1, 0x93, 
+0xD5, 0x32, 0xD3, 0xC2, 0xFD, 0x32, 0xEC, 0xCB, 
+0xC7, 0xA7, 0x28, 0xE0, 0x6E, 0xDD, 0x88, 0x4A, 
+0x4C, 0xB4, 0x0E, 0x41, 0xB5, 0x16, 0x6B, 0x17, 
+0x32, 0x7C, 0x56, 0xCA, 0xE8, 0x59, 0x68, 0xA1, 
+0x2C, 0x54, 0x3B, 0x53, 0xF4, 0x84, 0x75, 0x73, 
+0x7D, 0xCA, 0xB7, 0x9B, 
+
+0x4A, 0x48, 0x08, 0x42, 0xB2, 0xD6, 0xF8, 0x8F, 
+0x74, 0x44, 0x26, 0x50, 0xB4, 0x57, 0x7A, 0x5A, 
+0x9F, 0xA1, 0x03, 0xE3, 0x73, 0x0E, 0xCA, 0x84, 
+0x75, 0x8C, 0x97, 0xE9, 0xA0, 0xF5, 0x63, 0x6D, 
+0x68, 0x8E, 0xED, 0x73, 0x76, 0x8E, 0xE6, 0x51, 
+0xDE, 0xCF, 0x19, 0xA8, 0x2A, 0x95, 0xB7, 0x26, 
+0x44, 0xAE, 0xCE, 0xEA, 0x61, 0x63, 0xC0, 0x13, 
+0x14, 0x53, 0x6F, 0xEF, 0x98, 0xF8, 0x54, 0xE4, 
+0xCC, 0x4C, 0xEB, 0x98, 0x1F, 0xC8, 0xE2, 0x57, 
+0xCE, 0xEE, 0x0A, 0xC7, 0xEE, 0x06, 0x02, 0xC5, 
+0x0B, 0xB5, 0x79, 0x96, 0x5F, 0x51, 0xE9, 0x7C, 
+0x4E, 0x9E, 0xE2, 0xB9, 0x58, 0x15, 0x77, 0xFF, 
+0x66, 0xA6, 0x4A, 0x1F, 0xAE, 0xB6, 0x41, 0xC5, 
+0x2A, 0x95, 0x55, 0x34, 0xF4, 0x46, 0x08, 0x4C, 
+0xE4, 0x63, 0xDD, 0xB4, 0xEB, 
+
+0xFE, 0x4D, 0xD4, 0xB1, 0x32, 0xE4, 0xC7, 0xF1, 
+0xED, 0x84, 0x43, 0x63, 0x94, 0x76, 0xB4, 0x23, 
+0x41, 0x3C, 0x0C, 0x07, 0xFD, 0xA1, 0x9C, 0x22, 
+0xDC, 0x7C, 0x9A, 0xAE, 0xD9, 0x76, 0x0E, 0x60, 
+0xF3, 0x63, 0x04, 0xAB, 0x82, 0x84, 0x6F, 0x79, 
+0x12, 0x01, 0xEB, 0x0A, 0x3A, 0x8C, 0x6B, 0x6D, 
+0x43, 0x38, 0x89, 0x3B, 0x3F, 0xE7, 0xDA, 0x50, 
+0x4D, 0xDA, 0xB9, 0x5A, 0x88, 0xF8, 0x3E, 0x17, 
+0xC7, 0x0C, 0xBA, 0x43, 0x93, 0x52, 0xD1, 0xA0, 
+0x28, 0x2D, 0xC2, 0x52, 0x4D, 0x20, 0xCA, 0x9C, 
+0xC4, 0xB1, 0x5C, 0x0C, 0x10, 0xEF, 0x30, 0x52, 
+0xC9, 0xBD, 0xB5, 0x6E, 0xE2, 0x87, 0x8E, 0x6F, 
+0x5C, 0x51, 0xCD, 0x78, 0xF8, 0xD0, 0xBD, 0xC3, 
+0x92, 0xCF, 0x35, 0x10, 0xD2, 0xC0, 0xB7, 0xB9, 
+0xCA, 0x3A, 0x1C, 0x59, 0xD1, 0xF0, 
+
+0x0E, 0x77, 0x47, 0xDC, 0x62, 0x4C, 0x73, 0xF8, 
+0x2C, 0xAA, 0xE4, 0xF3, 0x7D, 0x25, 0x5D, 0xE3, 
+0x3D, 0x61, 0xDA, 0x38, 0x5E, 0xF9, 0xA6, 0x20, 
+0xD5, 0xC5, 0x89, 0x3B, 0x29, 0xE0, 0x7A, 0x90, 
+0x2C, 0xA7, 0x79, 0xD3, 0xC9, 0xED, 0xA2, 0x1A, 
+0x27, 0x8E, 0x94, 0xCF, 0x4B, 0xBC, 0xA8, 0xA0, 
+0xD7, 0xE0, 0x33, 0x2C, 0xE4, 0x13, 0x10, 0x0B, 
+0x47, 0xFA, 0x7D, 0xB1, 0x26, 0x16, 0x2D, 0x42, 
+0x43, 0xFB, 0x2E, 0xDE, 0xA7, 0x80, 0x30, 0xA6, 
+0x48, 0xAB, 0x14, 0x20, 0x2B, 0x8F, 0xC5, 0xC8, 
+0x88, 0x74, 0x2E, 0xA2, 0xF1, 0x87, 0xD9, 0xF5, 
+0xB9, 0xFC, 0x6E, 0xDE, 0x1C, 0xC6, 0x73, 0x3E, 
+0x96, 0x3A, 0x0D, 0x4A, 0xB4, 0xA7, 0xEE, 0x84, 
+0xCA, 0x12, 0x54, 0xFB, 0x0E, 0x09, 0x41, 0x41, 
+0x02, 0x8C, 0x29, 0x74, 0x67, 0x49, 0x1B, 
+
+0x9E, 0x58, 0xD7, 0x8C, 0x2C, 0xCE, 0xDE, 0xDA, 
+0x88, 0x0B, 0xD2, 0xFF, 0x44, 0x59, 0x17, 0x76, 
+0x75, 0x46, 0x07, 0xC7, 0x3F, 0x99, 0xBA, 0x6A, 
+0xF6, 0x16, 0x23, 0xD6, 0xA2, 0x25, 0x4C, 0xC9, 
+0xD8, 0x9A, 0x5F, 0x13, 0xCD, 0x4C, 0xEF, 0x49, 
+0x9B, 0x15, 0x0D, 0xA2, 0x76, 0x09, 0x87, 0x29, 
+0x60, 0x7B, 0xCE, 0x0F, 0x17, 0x0E, 0x8C, 0xEA, 
+0x98, 0x46, 0x02, 0xD6, 0xAE, 0xD1, 0xF1, 0x5B, 
+0x54, 0x93, 0xAE, 0xF5, 0xA7, 0xEA, 0x00, 0x20, 
+0x86, 0x7D, 0x12, 0xFE, 0x07, 0xC8, 0x5B, 0x56, 
+0x15, 0xCA, 0xD1, 0x12, 0xD0, 0x6B, 0xE8, 0x69, 
+0x67, 0xC3, 0x28, 0x5A, 0x95, 0x26, 0xA1, 0x0D, 
+0x8E, 0xAD, 0xDC, 0xAB, 0xA4, 0xE7, 0xAA, 0xDE, 
+0x0D, 0x6A, 0x77, 0x43, 0xDA, 0x66, 0x5A, 0xFA, 
+0xDA, 0x2B, 0x64, 0x55, 0x8D, 0x45, 0x7F, 0x5F, 
+
+0x80, 0x64, 0xEA, 0x48, 0x6F, 0xE0, 0xA2, 0xAC, 
+0xED, 0x51, 0xB1, 0xD6, 0x4D, 0xFF, 0xDE, 0x5D, 
+0xEF, 0x9B, 0x59, 0x03, 0x5D, 0x53, 0x0E, 0xE1, 
+0x0E, 0x6F, 0x29, 0x94, 0xD0, 0x56, 0x20, 0xFA, 
+0x2E, 0x88, 0x67, 0xE2, 0x68, 0x0D, 0xF1, 0xD8, 
+0x3B, 0x90, 0xDD, 0xE6, 0xD0, 0xF8, 0x1F, 0x24, 
+0x4C, 0xBF, 0xD3, 0x51, 0x27, 0xF7, 0xDC, 0xF0, 
+0xB1, 0x4E, 0x49, 0xB7, 0x93, 0xBB, 0xE8, 0xC7, 
+0xC6, 0x19, 0x54, 0x77, 0x4C, 0x2F, 0xAB, 0x64, 
+0xA7, 0xD1, 0x32, 0x70, 0xCB, 0x88, 0xC9, 0xA3, 
+0x97, 0x70, 0x22, 0x59, 0xC9, 0x99, 0x86, 0xE0, 
+0x90, 0x0C, 0x81, 0x85, 0x3C, 0xA5, 0x1F, 0xE4, 
+0x6C, 0x5C, 0xFB, 0xB3, 0xE4, 0x7D, 0xA7, 0x23, 
+0x8E, 0x84, 0x36, 0x4A, 0x97, 0x6A, 0x95, 0x64, 
+0x8E, 0x46, 0xAF, 0x05, 0x69, 0xCB, 0x31, 0x15, 
+0x79, 
+
+0xB8, 0x39, 0xE7, 0x7F, 0x2F, 0x00, 0xD0, 0xD3, 
+0x6A, 0xDD, 0x98, 0x84, 0x9B, 0xD9, 0x84, 0xB7, 
+0x07, 0x8E, 0x17, 0xDE, 0x4F, 0xCA, 0x2F, 0x0A, 
+0xA6, 0x50, 0xFA, 0x52, 0x3C, 0x05, 0x73, 0x3E, 
+0xB7, 0xF5, 0xE5, 0x8F, 0xF7, 0x70, 0x0F, 0x18, 
+0x44, 0x73, 0x0E, 0x61, 0x1E, 0x0D, 0x8B, 0x17, 
+0x64, 0x4E, 0xE8, 0x45, 0x80, 0xDD, 0x8A, 0x0D, 
+0x6F, 0xD9, 0xD6, 0xFA, 0xE5, 0xF3, 0xBD, 0xE7, 
+0x60, 0x75, 0x05, 0x24, 0xF1, 0x8D, 0xBE, 0x15, 
+0x40, 0xC7, 0x3C, 0x33, 0xC0, 0x1A, 0x64, 0x24, 
+0x1D, 0x68, 0x97, 0xC9, 0xA7, 0x97, 0x44, 0xF6, 
+0x85, 0x08, 0xCD, 0x4C, 0x21, 0xF1, 0xF0, 0xE2, 
+0xA0, 0xD4, 0x4D, 0x01, 0x34, 0xD3, 0x3F, 0xE7, 
+0xF9, 0xB2, 0x21, 0xAC, 0xDC, 0xE4, 0x2C, 0xDA, 
+0xBC, 0x0B, 0x58, 0x8F, 0xA3, 0x03, 0xE5, 0xEA, 
+0x7D, 0xD4, 
+
+0x9B, 0x95, 0x3E, 0x61, 0x26, 0x27, 0x44, 0x03, 
+0xB8, 0x70, 0x72, 0xDE, 0x98, 0x86, 0x28, 0x2B, 
+0x6B, 0x27, 0x9F, 0x7B, 0xE3, 0xE6, 0x96, 0x56, 
+0x2A, 0x14, 0xB6, 0x5F, 0xF3, 0x20, 0x3F, 0x33, 
+0x5B, 0x56, 0x64, 0x5B, 0xC7, 0x52, 0x34, 0xD9, 
+0x39, 0x9B, 0x2D, 0x96, 0x31, 0x50, 0xAE, 0xDE, 
+0xFC, 0x5E, 0x76, 0x36, 0xAC, 0xF5, 0xBA, 0x70, 
+0xF4, 0xFD, 0x02, 0x11, 0xB5, 0x04, 0xDF, 0xA5, 
+0xD9, 0x27, 0xAC, 0xA6, 0x32, 0xF7, 0x2E, 0x3E, 
+0x05, 0x9C, 0xD4, 0x53, 0x13, 0xE1, 0x3F, 0x47, 
+0x7F, 0x62, 0x59, 0x2D, 0x4C, 0xEF, 0x08, 0x68, 
+0x67, 0xDE, 0x0D, 0x4A, 0xD4, 0x82, 0x51, 0xA6, 
+0x12, 0xAF, 0x37, 0x0F, 0x3A, 0x02, 0x00, 0x7A, 
+0xF0, 0x6C, 0x06, 0xA8, 0xA1, 0xAB, 0xCD, 0x7C, 
+0x5D, 0x05, 0x0E, 0xC9, 0x4F, 0xF0, 0x7E, 0xBD, 
+0xD9, 0xC2, 0xAC, 
+
+0x33, 0x90, 0x57, 0x5C, 0x87, 0x2F, 0x23, 0x1D, 
+0x28, 0xCB, 0x32, 0x04, 0xE2, 0x17, 0xC4, 0x9C, 
+0xFE, 0x70, 0x6D, 0xE4, 0x54, 0xD0, 0x29, 0x88, 
+0x27, 0xF8, 0xA8, 0x44, 0x36, 0xDC, 0xA8, 0x5C, 
+0x0E, 0xA4, 0xFE, 0xD2, 0x73, 0x9A, 0xEE, 0xC8, 
+0xC7, 0x9D, 0xAA, 0x44, 0x53, 0x9B, 0x27, 0x4B, 
+0x9D, 0xA8, 0xE3, 0x78, 0x67, 0x90, 0x6E, 0x46, 
+0xF0, 0x26, 0xCF, 0x44, 0x4C, 0x4D, 0x8D, 0x69, 
+0x2E, 0xB4, 0xF2, 0x4E, 0x00, 0x1B, 0x94, 0x14, 
+0xBC, 0x95, 0x85, 0xBF, 0x1F, 0x89, 0x69, 0xB3, 
+0x92, 0x90, 0x6F, 0x38, 0x34, 0xF0, 0x7D, 0xF7, 
+0x95, 0xAE, 0xB7, 0x3C, 0xBB, 0x43, 0xA0, 0xFD, 
+0x4F, 0x31, 0x04, 0x88, 0x41, 0xD4, 0x2C, 0xEC, 
+0xD5, 0xDC, 0x98, 0x3B, 0xF1, 0x8D, 0x8B, 0x32, 
+0xB8, 0x84, 0xA8, 0xBE, 0xBC, 0x2D, 0x2A, 0xA6, 
+0x43, 0x03, 0xA0, 0x22, 
+
+0xC2, 0xB4, 0x74, 0x6B, 0x15, 0x7E, 0xDF, 0x04, 
+0x69, 0x0B, 0x93, 0xFF, 0xCB, 0x2E, 0x91, 0xF3, 
+0x8D, 0x1A, 0xBB, 0x42, 0x3B, 0x1B, 0x59, 0xEB, 
+0x55, 0x95, 0x43, 0xC0, 0x28, 0xD4, 0xFD, 0x58, 
+0x68, 0x84, 0x5D, 0x04, 0x09, 0xA4, 0x6D, 0x6B, 
+0xBD, 0xC9, 0x97, 0x39, 0xC5, 0x8A, 0xD9, 0x00, 
+0x1F, 0x76, 0x6E, 0x4D, 0xE4, 0xB8, 0x74, 0x7A, 
+0x6A, 0x9F, 0xAE, 0x96, 0x4E, 0x73, 0x97, 0x55, 
+0xBE, 0x4D, 0x4A, 0xC5, 0x67, 0xAE, 0x40, 0x20, 
+0xBF, 0x9F, 0x34, 0x8C, 0x32, 0xA0, 0x67, 0x92, 
+0x44, 0x84, 0xBD, 0x7E, 0xA0, 0x49, 0xB9, 0x77, 
+0x7A, 0xA7, 0xFD, 0x01, 0x6D, 0xFE, 0x34, 0x88, 
+0x4F, 0x74, 0x6D, 0xEB, 0x2A, 0x46, 0x0D, 0xF9, 
+0x2A, 0x28, 0xD2, 0x10, 0x5C, 0xB5, 0x0B, 0xED, 
+0x2F, 0x98, 0x39, 0xD1, 0x06, 0x3E, 0xAA, 0x77, 
+0xFF, 0x9C, 0x91, 0x89, 0xC7, 
+
+0xA3, 0x58, 0xAA, 0x42, 0x9C, 0x4B, 0x20, 0x79, 
+0xE3, 0xED, 0xC8, 0x31, 0x97, 0x8D, 0x98, 0xD4, 
+0x26, 0x00, 0xB6, 0xF6, 0x0F, 0x6A, 0x2F, 0x25, 
+0xC1, 0xDB, 0xB0, 0x57, 0x93, 0xDE, 0x53, 0x8F, 
+0xF4, 0x6C, 0xE7, 0xA9, 0x1A, 0x4A, 0x3E, 0x48, 
+0xAC, 0xA4, 0x5C, 0xDA, 0x14, 0x20, 0xCC, 0x18, 
+0xB1, 0xDD, 0x94, 0xA0, 0x25, 0x02, 0x72, 0x72, 
+0xA1, 0xE1, 0x42, 0x15, 0xAD, 0x2E, 0xAC, 0xF0, 
+0x2D, 0x76, 0xEF, 0x09, 0x0B, 0x58, 0x28, 0xD0, 
+0xEF, 0xDF, 0x2B, 0x61, 0x35, 0xA4, 0xF3, 0xD2, 
+0xAD, 0xBC, 0x81, 0x26, 0x20, 0xD1, 0x81, 0xE3, 
+0x50, 0x5C, 0xB5, 0x25, 0x68, 0xA2, 0xF5, 0x26, 
+0xC2, 0x56, 0x7C, 0x8D, 0x33, 0x50, 0xE5, 0x12, 
+0x48, 0x3E, 0xAD, 0xFF, 0x5D, 0x97, 0xA1, 0xB9, 
+0xB6, 0xD9, 0xE7, 0xD3, 0x8C, 0x2A, 0x66, 0xC1, 
+0x8F, 0xAA, 0xD9, 0xE7, 0x75, 0x20, 
+
+0x67, 0x96, 0xE5, 0x8B, 0x08, 0x81, 0x25, 0x1B, 
+0xE9, 0xAF, 0x86, 0x68, 0x85, 0x98, 0x64, 0x07, 
+0x12, 0x83, 0x11, 0x3F, 0xFB, 0xA5, 0xFC, 0x9B, 
+0x4E, 0x82, 0x75, 0xE4, 0x09, 0x44, 0x71, 0x68, 
+0x23, 0x37, 0xAF, 0x1F, 0x58, 0x53, 0x07, 0x22, 
+0x0A, 0x6C, 0x64, 0xE6, 0x4D, 0x7D, 0x96, 0xAE, 
+0x5B, 0x73, 0xD1, 0x9C, 0x7F, 0xA5, 0x12, 0x39, 
+0x4E, 0x9B, 0x72, 0xF0, 0x41, 0x0C, 0x47, 0x41, 
+0x39, 0x86, 0x17, 0xA4, 0x98, 0xDE, 0x69, 0x0C, 
+0x18, 0x5A, 0x38, 0x75, 0x9A, 0x82, 0x73, 0x8A, 
+0x9D, 0x7A, 0xCF, 0x8A, 0xF5, 0x38, 0x5F, 0x82, 
+0xB4, 0xDE, 0xEA, 0xDB, 0x3B, 0x5E, 0x9A, 0x90, 
+0xC5, 0x66, 0x72, 0x44, 0x75, 0xED, 0x8D, 0x26, 
+0x4E, 0x24, 0xF5, 0xFB, 0xA7, 0xE6, 0x95, 0x57, 
+0x1A, 0x99, 0xC3, 0x5B, 0x10, 0x31, 0x5F, 0xCB, 
+0x94, 0x64, 0x96, 0xFA, 0x68, 0x21, 0x35, 
+
+0x81, 0xB0, 0x45, 0xBE, 0x92, 0x90, 0xFC, 0x30, 
+0x74, 0xDC, 0x2B, 0x0C, 0x95, 0xF6, 0xAA, 0x31, 
+0x0E, 0x7D, 0x18, 0x92, 0x9F, 0xEB, 0xD1, 0x58, 
+0x74, 0xFE, 0x3F, 0x31, 0xDA, 0x7D, 0x09, 0x57, 
+0xC0, 0x41, 0xF7, 0xDF, 0x74, 0x30, 0xA9, 0x3A, 
+0x89, 0xF2, 0x6F, 0x2D, 0xB5, 0xFD, 0xB9, 0x15, 
+0x86, 0x12, 0x82, 0xE5, 0x4A, 0x3D, 0xF4, 0xD3, 
+0x28, 0x5C, 0xB8, 0x02, 0x7C, 0x9E, 0x4F, 0x71, 
+0x89, 0xDC, 0xAC, 0x6F, 0x9E, 0x6B, 0x19, 0x72, 
+0xEA, 0xC1, 0x6C, 0x33, 0x8D, 0xC6, 0x18, 0xC9, 
+0x24, 0x98, 0x97, 0x2D, 0xF3, 0x98, 0x19, 0x10, 
+0x14, 0xC8, 0x10, 0xBB, 0x8E, 0x4C, 0x42, 0xCB, 
+0xB9, 0xD1, 0x5B, 0x8E, 0x5D, 0x1F, 0xE1, 0xAE, 
+0x40, 0xF8, 0x96, 0x90, 0x13, 0x78, 0x8E, 0x47, 
+0x64, 0x81, 0x9F, 0xA4, 0xFE, 0x11, 0x93, 0xE0, 
+0x3C, 0xE1, 0xAE, 0x0E, 0xE8, 0x32, 0xFD, 0x0C, 
+
+0x97, 0x93, 0x44, 0x38, 0xAB, 0x80, 0x73, 0x0E, 
+0xBE, 0x9C, 0x8C, 0x71, 0x23, 0xFE, 0x56, 0x6A, 
+0xA0, 0xFB, 0x79, 0xF8, 0x5C, 0xE4, 0x3C, 0x97, 
+0xAE, 0xEF, 0x1B, 0xFD, 0x8D, 0x91, 0xAF, 0xEA, 
+0x83, 0x4D, 0x06, 0x81, 0xCD, 0x02, 0x90, 0xEB, 
+0xD8, 0x14, 0x59, 0xA7, 0xB0, 0xB6, 0xFE, 0x7E, 
+0xF2, 0x06, 0x97, 0x88, 0xD7, 0x34, 0x8A, 0x70, 
+0x5F, 0x85, 0xC0, 0xE0, 0xF0, 0xDB, 0xBD, 0xAB, 
+0xEC, 0xFA, 0xCC, 0xBA, 0x6B, 0x9B, 0x28, 0x26, 
+0x23, 0x2B, 0x6B, 0x87, 0x82, 0x53, 0x7B, 0xAA, 
+0x7E, 0x7F, 0x54, 0xA0, 0xF4, 0x16, 0xC7, 0x37, 
+0x71, 0x19, 0x8A, 0xF6, 0x01, 0x03, 0x57, 0x5A, 
+0xBB, 0x21, 0xBC, 0x83, 0x0F, 0x9B, 0xB3, 0x3A, 
+0x39, 0x35, 0x63, 0xEE, 0x9E, 0xEF, 0xA4, 0xBA, 
+0xEB, 0x11, 0xFA, 0x14, 0x2F, 0x51, 0x80, 0xAC, 
+0xAD, 0xC1, 0x02, 0x2A, 0x68, 0xE2, 0xE6, 0x77, 
+0xC9, 
+
+0xF5, 0x3F, 0xC9, 0xC7, 0xDD, 0x61, 0x9F, 0xEB, 
+0xE5, 0xE3, 0x95, 0xA1, 0x20, 0x77, 0x9D, 0x17, 
+0x69, 0xF1, 0x42, 0x6C, 0x4D, 0x4E, 0xB3, 0x59, 
+0x19, 0xAD, 0xC0, 0x4C, 0x98, 0xB1, 0xF6, 0xB3, 
+0xD7, 0x4A, 0x72, 0xE2, 0x8E, 0x25, 0x2F, 0x2F, 
+0x5C, 0xDC, 0x54, 0x83, 0x38, 0x71, 0x42, 0x4C, 
+0xDB, 0x1E, 0x66, 0x49, 0x62, 0x7A, 0xC0, 0x63, 
+0xC2, 0x68, 0x2D, 0x81, 0x35, 0x78, 0x14, 0x2B, 
+0xCA, 0xD0, 0xE0, 0x48, 0x1F, 0xEB, 0x2E, 0xA4, 
+0x2C, 0x47, 0x42, 0x4E, 0x91, 0x01, 0x8A, 0xF9, 
+0x8A, 0x65, 0x0A, 0xA8, 0x88, 0x88, 0xF6, 0x57, 
+0xD2, 0x4B, 0x32, 0x39, 0x8C, 0x04, 0xF3, 0xE0, 
+0x88, 0x26, 0x74, 0x23, 0xE8, 0x0A, 0x14, 0xDF, 
+0xAD, 0xAD, 0x73, 0x4C, 0x21, 0xB3, 0xEB, 0x46, 
+0x00, 0xA5, 0x73, 0x65, 0xA2, 0x70, 0x2B, 0x9C, 
+0xAE, 0xDF, 0xA3, 0xA1, 0x0C, 0x7C, 0xF8, 0x74, 
+0xA9, 0x84, 
+
+0xD6, 0x2C, 0x7A, 0x7E, 0xEE, 0x8B, 0x5C, 0x02, 
+0x72, 0x89, 0x1A, 0x6A, 0x61, 0x4E, 0xF9, 0x9A, 
+0x2C, 0x8A, 0x14, 0x47, 0x26, 0xD3, 0x0F, 0x46, 
+0x64, 0x4F, 0xF3, 0x07, 0xD9, 0xBA, 0x6E, 0x5D, 
+0x28, 0x23, 0xBA, 0xF1, 0x1C, 0xAB, 0xA0, 0x25, 
+0x49, 0xFB, 0x5D, 0x40, 0xCF, 0x54, 0x9F, 0x89, 
+0x6C, 0xB1, 0x30, 0xD0, 0xF5, 0x9A, 0x59, 0xE0, 
+0xBA, 0x46, 0x5E, 0x4B, 0x95, 0x79, 0x1D, 0x2F, 
+0x94, 0x2F, 0x70, 0x28, 0x76, 0xB8, 0x94, 0x27, 
+0xE4, 0x76, 0x4F, 0x88, 0x19, 0xC0, 0x4F, 0x81, 
+0xF6, 0xBE, 0x39, 0x48, 0x86, 0x4D, 0x91, 0x16, 
+0x0E, 0x35, 0xAB, 0x1B, 0x2D, 0x7D, 0x5D, 0xC1, 
+0x8C, 0xB3, 0x30, 0x85, 0x71, 0xA6, 0x30, 0x8C, 
+0x01, 0x28, 0x6F, 0x3D, 0xDB, 0x1B, 0x6A, 0xCD, 
+0x1D, 0x3D, 0xC9, 0x4F, 0x64, 0x98, 0x35, 0xFF, 
+0x55, 0x13, 0x5A, 0xCD, 0x17, 0x29, 0x27, 0x54, 
+0x4A, 0x81, 0x38, 
+
+0x42, 0x99, 0xBF, 0x3E, 0xCC, 0xA1, 0x79, 0xB2, 
+0xE7, 0x60, 0x98, 0xEF, 0x49, 0xD9, 0x78, 0xA4, 
+0x49, 0x3F, 0x7D, 0x97, 0x53, 0x45, 0x29, 0x22, 
+0x2A, 0x79, 0x22, 0xD1, 0x93, 0x54, 0x99, 0x8E, 
+0x2F, 0x38, 0x14, 0x1D, 0x6C, 0xCA, 0x0A, 0x4D, 
+0xAD, 0xFF, 0x39, 0x09, 0xC9, 0xCB, 0xDA, 0x64, 
+0x16, 0x01, 0x5C, 0xBC, 0x83, 0x7F, 0x88, 0x73, 
+0x88, 0xAA, 0x70, 0x53, 0x96, 0xE0, 0xC7, 0x5E, 
+0xE7, 0x83, 0xE2, 0xD5, 0x8E, 0x07, 0x5A, 0xF3, 
+0xBD, 0x9E, 0x1E, 0xD8, 0x14, 0x96, 0x5C, 0x19, 
+0xC1, 0x34, 0x14, 0xD9, 0x88, 0x0F, 0xFD, 0xE1, 
+0x25, 0x62, 0x12, 0x7E, 0x8D, 0x6B, 0x23, 0xA9, 
+0x57, 0x57, 0xCA, 0x0C, 0x21, 0x89, 0x0B, 0x67, 
+0x97, 0x0A, 0xD7, 0xD1, 0x20, 0xC7, 0x47, 0xC6, 
+0x82, 0xB2, 0x6A, 0x69, 0xA7, 0xC0, 0x1E, 0xC4, 
+0x51, 0x68, 0xD3, 0xEC, 0xE3, 0xCF, 0x3F, 0xC9, 
+0xE8, 0xB6, 0x8C, 0x71, 
+
+0x64, 0x04, 0x30, 0x4E, 0xAE, 0x56, 0x56, 0x95, 
+0x71, 0x45, 0x7E, 0xBB, 0xD8, 0xDC, 0x9B, 0xA1, 
+0xD9, 0x8E, 0x8B, 0x0C, 0xF1, 0x0D, 0xFD, 0xCB, 
+0xFE, 0x24, 0xD7, 0xD5, 0x7C, 0x90, 0x00, 0x10, 
+0xA8, 0x83, 0x51, 0x7C, 0x06, 0x41, 0x65, 0xAD, 
+0x4C, 0x77, 0x4E, 0x75, 0x08, 0xF8, 0x6F, 0x49, 
+0x39, 0x97, 0xC5, 0x12, 0x9C, 0xD7, 0xB3, 0xB7, 
+0x36, 0x25, 0x40, 0x2B, 0x49, 0x4D, 0xE7, 0x1C, 
+0xAA, 0x11, 0xFD, 0x13, 0x7E, 0x59, 0xF0, 0x05, 
+0x11, 0x47, 0x6B, 0x08, 0x9B, 0x28, 0x13, 0xAA, 
+0x73, 0x29, 0x29, 0xB1, 0x66, 0x02, 0x89, 0x88, 
+0xE0, 0xC6, 0x0C, 0xAA, 0xB0, 0x54, 0x9B, 0x73, 
+0x7B, 0x7C, 0xAE, 0x76, 0x6C, 0x87, 0xEA, 0xC8, 
+0x39, 0x0A, 0xD8, 0x8C, 0xE4, 0x0F, 0xD2, 0x7E, 
+0xE5, 0x9F, 0xB3, 0xCB, 0x14, 0xE3, 0x94, 0x75, 
+0xC7, 0x76, 0xE0, 0x67, 0x22, 0xCC, 0x42, 0x88, 
+0xD3, 0xBF, 0x3A, 0x59, 0x96, 
+
+0xF3, 0xD7, 0xA3, 0x99, 0x33, 0xF1, 0xBF, 0x18, 
+0xEC, 0xB4, 0xA1, 0x73, 0x79, 0x46, 0x6A, 0xB0, 
+0x21, 0xC3, 0xB6, 0x86, 0x24, 0x65, 0x72, 0x44, 
+0xBC, 0x3D, 0x6C, 0x47, 0xCF, 0x35, 0xA9, 0x24, 
+0x52, 0xAA, 0xB9, 0x56, 0xA2, 0xAF, 0xB7, 0xC4, 
+0x53, 0x4A, 0xEC, 0x6C, 0xC3, 0xB9, 0x4E, 0xEE, 
+0x14, 0xF3, 0xFA, 0x8B, 0x44, 0x71, 0xDD, 0x56, 
+0x63, 0x13, 0x2F, 0xB2, 0x2D, 0xA2, 0x5A, 0xCD, 
+0x88, 0x4A, 0x3E, 0xF8, 0x17, 0x41, 0xCE, 0x26, 
+0x9B, 0x3A, 0x05, 0xDD, 0x81, 0x39, 0x6B, 0x87, 
+0x50, 0xA9, 0xAC, 0xD4, 0xC4, 0xFE, 0x83, 0x06, 
+0x60, 0x41, 0xBD, 0x86, 0x62, 0x99, 0x56, 0x3A, 
+0x45, 0x48, 0x56, 0xAD, 0xFC, 0xD8, 0x57, 0x47, 
+0x5B, 0x36, 0x6E, 0x91, 0x22, 0xCA, 0xB7, 0x56, 
+0x8D, 0x9F, 0x23, 0x6E, 0x32, 0xC0, 0xBE, 0xE8, 
+0x6B, 0x97, 0x70, 0x3F, 0x73, 0x73, 0xDC, 0x24, 
+0x3D, 0x7B, 0x17, 0x62, 0x98, 0x65, 
+
+0xA5, 0x36, 0x76, 0x11, 0x83, 0xBC, 0xAA, 0x67, 
+0x3E, 0x57, 0x98, 0xF4, 0xDA, 0xEC, 0xDC, 0xC5, 
+0x52, 0x0F, 0x8A, 0x02, 0x5B, 0x82, 0x96, 0xDE, 
+0x21, 0x04, 0x9D, 0x2B, 0x8E, 0x1B, 0x56, 0xBD, 
+0x94, 0x61, 0xAA, 0x0D, 0xD9, 0xBD, 0xEF, 0xD7, 
+0x8F, 0x30, 0x34, 0xCF, 0xCE, 0x53, 0x84, 0x19, 
+0x9F, 0xF2, 0x88, 0x84, 0x09, 0x98, 0x34, 0x2B, 
+0x21, 0x11, 0x9B, 0x43, 0x51, 0xC0, 0xA1, 0xC4, 
+0x51, 0xF5, 0xFB, 0x79, 0xB2, 0x65, 0xB7, 0x1C, 
+0x7B, 0xAE, 0x94, 0xB6, 0xF6, 0x57, 0x7C, 0x9A, 
+0xCA, 0xA5, 0x10, 0xD7, 0x30, 0x68, 0xC4, 0x68, 
+0x07, 0xD2, 0x71, 0xDB, 0x4B, 0x69, 0x38, 0xFA, 
+0x1B, 0xF1, 0x8C, 0xC8, 0x87, 0x3B, 0xAC, 0x20, 
+0x34, 0x40, 0x4F, 0x5D, 0x2C, 0x3D, 0x4F, 0xEE, 
+0x00, 0x02, 0x33, 0xB9, 0x30, 0xAB, 0x3A, 0x09, 
+0x8C, 0x92, 0x1E, 0x85, 0x6A, 0xAB, 0xD2, 0x53, 
+0x2E, 0x77, 0x39, 0x6C, 0x8F, 0x4F, 0x69, 
+
+0xD6, 0xDC, 0x30, 0xDE, 0xF5, 0x14, 0xD9, 0x22, 
+0xA2, 0xDE, 0xD2, 0x02, 0xF2, 0x81, 0xA2, 0x83, 
+0x95, 0x97, 0x4C, 0xFC, 0xA7, 0xC9, 0x67, 0xE9, 
+0xF7, 0x90, 0x53, 0x74, 0x65, 0x3D, 0x24, 0x3E, 
+0x27, 0xB6, 0xD2, 0xE1, 0xDA, 0x2C, 0xD2, 0xE3, 
+0x79, 0xD3, 0x19, 0xDF, 0xC1, 0x58, 0x17, 0xD5, 
+0xE9, 0x4E, 0x81, 0xEC, 0x48, 0x2D, 0x53, 0xFB, 
+0x93, 0x49, 0x3A, 0xE7, 0x1D, 0x47, 0xCF, 0xE3, 
+0x0E, 0x7F, 0xC1, 0x93, 0x30, 0xD8, 0x24, 0x3F, 
+0x5C, 0x04, 0x1C, 0x97, 0x2E, 0xBE, 0x9D, 0x33, 
+0x5E, 0xEA, 0xA2, 0x02, 0xFF, 0xD9, 0xD5, 0xA7, 
+0xE7, 0x88, 0x02, 0xEF, 0xEF, 0x6C, 0x78, 0x19, 
+0x54, 0xB4, 0x91, 0x73, 0x7A, 0x42, 0x54, 0x38, 
+0x8E, 0x9A, 0x54, 0x0E, 0x2A, 0x94, 0x9B, 0x85, 
+0xEA, 0x91, 0xB9, 0xCC, 0xBB, 0xDE, 0x4E, 0x6F, 
+0x5C, 0x9C, 0xB9, 0xB4, 0x93, 0x12, 0xEC, 0xE5, 
+0xD3, 0xBC, 0xBD, 0xBF, 0xE5, 0xAC, 0x6A, 0x56, 
+
+0x7C, 0xD0, 0xE0, 0x23, 0x87, 0x59, 0x32, 0xA1, 
+0x81, 0x33, 0x85, 0x79, 0x9B, 0x13, 0xEE, 0xF0, 
+0xED, 0xDA, 0xA1, 0xDD, 0x6E, 0xE7, 0xF1, 0xC6, 
+0xB7, 0x56, 0x16, 0x29, 0x26, 0x7D, 0x1A, 0x50, 
+0x56, 0x4F, 0xEF, 0x66, 0x6E, 0x2F, 0x5C, 0xAB, 
+0xB8, 0x98, 0x75, 0xB5, 0x33, 0x41, 0x8F, 0x7A, 
+0x6C, 0x72, 0x26, 0x32, 0x91, 0x64, 0xDD, 0x14, 
+0x8A, 0x46, 0x92, 0xFC, 0xF7, 0x48, 0x53, 0x59, 
+0x2E, 0x91, 0x9E, 0xA2, 0x81, 0xA1, 0x38, 0x8F, 
+0x64, 0x41, 0x89, 0x46, 0x7B, 0x29, 0xB4, 0x43, 
+0x31, 0x7B, 0x2E, 0xEF, 0x6C, 0x0E, 0xA1, 0xFD, 
+0x9E, 0xBD, 0xA7, 0x05, 0x16, 0xB5, 0x1D, 0x25, 
+0x2E, 0xDD, 0xCA, 0x8F, 0x65, 0xC4, 0x47, 0xA3, 
+0x5E, 0x4D, 0xEA, 0x6F, 0x77, 0x33, 0x52, 0x4E, 
+0x4D, 0x05, 0x3B, 0x81, 0x91, 0x3A, 0xD7, 0x23, 
+0xD8, 0x08, 0x20, 0x36, 0x8E, 0x25, 0x38, 0x9C, 
+0xF2, 0xBB, 0xC4, 0xE0, 0x98, 0x05, 0x67, 0x98, 
+0xE0, 
+
+0x3D, 0xED, 0xAE, 0x83, 0x5E, 0x92, 0x0F, 0xE5, 
+0x6E, 0x93, 0xF5, 0xA0, 0x75, 0x58, 0xEC, 0xD2, 
+0x50, 0x20, 0x17, 0x91, 0x5F, 0xED, 0x8B, 0x75, 
+0x21, 0x00, 0x60, 0x57, 0xA4, 0xA8, 0x60, 0x9D, 
+0x36, 0x34, 0x75, 0x39, 0xC9, 0x42, 0x34, 0x17, 
+0xD8, 0xC2, 0x37, 0xC6, 0x43, 0x95, 0xE2, 0x19, 
+0xE5, 0xD8, 0x56, 0x73, 0xBE, 0x7D, 0xF6, 0x70, 
+0x79, 0x5F, 0x32, 0xA1, 0x93, 0x8B, 0xF4, 0xC8, 
+0xD5, 0xAF, 0x90, 0x4B, 0x6D, 0x12, 0x25, 0x25, 
+0x92, 0xB1, 0x4B, 0x87, 0x24, 0x32, 0xC2, 0x9F, 
+0x75, 0x71, 0x66, 0x1F, 0x1E, 0xD0, 0xE4, 0x98, 
+0x0F, 0x21, 0xB9, 0x69, 0xDF, 0xED, 0x1D, 0x24, 
+0x12, 0xEA, 0x55, 0x28, 0x3B, 0x71, 0xBF, 0xCB, 
+0xB9, 0x0E, 0xC5, 0x7E, 0x30, 0x50, 0x16, 0xF6, 
+0xC6, 0x7F, 0x0B, 0x91, 0x97, 0xA0, 0xFD, 0x89, 
+0x35, 0x25, 0x26, 0x9E, 0x5D, 0x9E, 0xE6, 0x3F, 
+0x11, 0x91, 0xB1, 0x71, 0x30, 0xBD, 0x00, 0x82, 
+0x23, 0x6B, 
+
+0x85, 0x00, 0x15, 0xB0, 0x51, 0xE8, 0x5E, 0xB5, 
+0x06, 0x6B, 0xCC, 0x3C, 0x00, 0xEC, 0xC8, 0x70, 
+0xA7, 0xB4, 0x01, 0xCE, 0x1C, 0x3F, 0x24, 0x4D, 
+0x6D, 0x57, 0xF0, 0xA7, 0xF9, 0x76, 0xDD, 0xA9, 
+0xF8, 0x8F, 0x90, 0x73, 0x0B, 0x01, 0xC4, 0x17, 
+0x11, 0x3C, 0xE7, 0xEB, 0xB5, 0xF3, 0xA4, 0x72, 
+0xBC, 0x4F, 0xA8, 0x66, 0x6A, 0x6A, 0xEF, 0x3E, 
+0x69, 0x85, 0x72, 0x20, 0x7A, 0xE1, 0x5D, 0x05, 
+0xDE, 0x9D, 0x92, 0x80, 0x3E, 0xFC, 0xB9, 0x56, 
+0xB0, 0x12, 0xAF, 0xC4, 0x0A, 0x63, 0x7A, 0x50, 
+0xB5, 0x53, 0x4B, 0xAB, 0x3E, 0x10, 0x7D, 0x55, 
+0x1C, 0x4A, 0xFF, 0xBB, 0x5C, 0x9A, 0x18, 0x23, 
+0x4C, 0x7E, 0x38, 0xB9, 0x4E, 0x42, 0x90, 0x2E, 
+0x1D, 0xC7, 0x87, 0x4F, 0xF9, 0xCD, 0xE8, 0x68, 
+0x37, 0xDA, 0x2E, 0xFB, 0x35, 0xDE, 0xCD, 0xBB, 
+0xDE, 0xBB, 0xB8, 0x28, 0x99, 0xD9, 0xBE, 0x31, 
+0xFA, 0xA5, 0x05, 0xF5, 0x7D, 0x28, 0x44, 0xF5, 
+0x9D, 0xA6, 0x57, 
+
+0x76, 0x01, 0xF1, 0x47, 0x7B, 0x0D, 0x42, 0x44, 
+0x8B, 0x93, 0xB5, 0x05, 0x11, 0x8C, 0x46, 0x2C, 
+0x0D, 0xD0, 0x54, 0x7B, 0xC0, 0xFE, 0x66, 0x94, 
+0x56, 0x81, 0xD4, 0xB8, 0xBC, 0x3B, 0x51, 0xCE, 
+0xE2, 0xA1, 0x10, 0x84, 0xF1, 0xE8, 0x1E, 0xFD, 
+0xCC, 0x12, 0x2A, 0x48, 0x9E, 0x4F, 0x9C, 0x61, 
+0x94, 0x49, 0x04, 0x91, 0x97, 0xB2, 0xFD, 0x48, 
+0x81, 0xD6, 0xF4, 0x48, 0x1A, 0xFA, 0x1F, 0x83, 
+0x2B, 0xE5, 0xA8, 0x91, 0x89, 0x03, 0x0F, 0x25, 
+0x4B, 0x5D, 0x48, 0xA4, 0xEE, 0xB7, 0x0A, 0x69, 
+0x8D, 0x9E, 0xD8, 0xC6, 0x23, 0xAE, 0xD8, 0x79, 
+0x63, 0x09, 0x37, 0x67, 0x64, 0xAC, 0xF7, 0x66, 
+0x52, 0x43, 0xE4, 0x23, 0x8A, 0x16, 0x69, 0xD9, 
+0xFA, 0xFB, 0x07, 0xA6, 0xCF, 0xDB, 0x13, 0xD3, 
+0x7B, 0x35, 0x6E, 0x3B, 0x3B, 0x07, 0x84, 0x96, 
+0xF6, 0xAB, 0xB1, 0x8E, 0xC4, 0x5B, 0x87, 0x04, 
+0x25, 0x2D, 0x3A, 0xCF, 0x9F, 0x74, 0x16, 0xCC, 
+0x9A, 0x54, 0xAC, 0x23, 
+
+0xD8, 0x57, 0x8A, 0x6B, 0x83, 0xA7, 0x48, 0xCE, 
+0xBA, 0x0E, 0xAE, 0x4A, 0x9A, 0x92, 0x59, 0xB4, 
+0x4A, 0xBF, 0x26, 0x55, 0x2A, 0x47, 0x2E, 0x88, 
+0x52, 0x85, 0xAA, 0x8C, 0x94, 0x53, 0x9D, 0xEA, 
+0x8B, 0xC4, 0x23, 0xFF, 0x31, 0x34, 0x95, 0xF0, 
+0x35, 0xBA, 0x36, 0x96, 0x37, 0x3C, 0xA6, 0x0E, 
+0x86, 0xE0, 0x0F, 0xEE, 0x4E, 0x4F, 0x67, 0x73, 
+0x1D, 0x24, 0x76, 0xA5, 0xAE, 0x30, 0x97, 0xE0, 
+0xE5, 0x88, 0x2A, 0x24, 0x66, 0x2C, 0x45, 0x83, 
+0x57, 0xFA, 0x1F, 0xAF, 0xE1, 0x3B, 0x19, 0x95, 
+0x27, 0xBA, 0xD0, 0x78, 0xAB, 0xDE, 0x29, 0x49, 
+0x05, 0xC7, 0xE0, 0x60, 0x4C, 0xCF, 0x01, 0xA3, 
+0x3A, 0x1F, 0xA8, 0x8A, 0xB7, 0x40, 0xE9, 0xE2, 
+0xE3, 0x5D, 0x8C, 0xBB, 0x26, 0x52, 0x9F, 0x50, 
+0x1E, 0xCA, 0x3C, 0x25, 0xA0, 0xAD, 0x76, 0xDF, 
+0x1F, 0x60, 0xB2, 0x6A, 0x06, 0x25, 0xA5, 0xF6, 
+0x5A, 0x41, 0x6B, 0x81, 0x40, 0x8F, 0x85, 0x9E, 
+0x1C, 0x0C, 0x02, 0x76, 0xF5, 
+
+0x17, 0x4A, 0xFA, 0xB7, 0xC0, 0x9E, 0x07, 0xEC, 
+0x2A, 0x33, 0xC7, 0x2B, 0x41, 0xAC, 0x6C, 0xD6, 
+0x0C, 0xA6, 0x19, 0xA6, 0xF7, 0xED, 0xC1, 0x5B, 
+0x10, 0xA5, 0x09, 0x10, 0x8C, 0x86, 0x1C, 0x0E, 
+0x9D, 0x61, 0xB6, 0xB3, 0x6D, 0xFD, 0x14, 0xBA, 
+0x04, 0xAE, 0x06, 0x26, 0xFF, 0xE7, 0x5A, 0xD5, 
+0x8F, 0xB7, 0x31, 0xDF, 0x64, 0x2B, 0x96, 0xEE, 
+0xE8, 0x34, 0xB7, 0xC2, 0x9C, 0x77, 0x4A, 0xAB, 
+0x4B, 0x23, 0xE8, 0x1A, 0x87, 0x5B, 0xBC, 0x6A, 
+0x67, 0xDF, 0x82, 0x6F, 0x29, 0x73, 0x0B, 0x31, 
+0x26, 0xF9, 0xBA, 0xA3, 0x63, 0xFF, 0x9F, 0x61, 
+0x79, 0xD8, 0x06, 0x15, 0x6F, 0x81, 0x58, 0xA0, 
+0x36, 0x53, 0x83, 0x5B, 0x82, 0xBC, 0xD2, 0x11, 
+0xAE, 0x9F, 0xE9, 0xF7, 0x12, 0xAE, 0x49, 0xD1, 
+0x29, 0x8D, 0x82, 0x7C, 0x2A, 0x37, 0x77, 0xFE, 
+0x5E, 0x3E, 0x32, 0x40, 0x3B, 0x82, 0x2E, 0x35, 
+0x91, 0x3C, 0x26, 0x3C, 0xA6, 0x97, 0xDC, 0x59, 
+0xAE, 0x9D, 0xDC, 0x8C, 0x1D, 0x30, 
+
+0x7D, 0x03, 0x84, 0xD5, 0xAA, 0xDA, 0xE5, 0xA4, 
+0x82, 0x37, 0xCC, 0x5C, 0x00, 0x7E, 0x9E, 0x3D, 
+0x80, 0x5A, 0x44, 0xB1, 0xB7, 0x95, 0x3B, 0x72, 
+0xD2, 0x8F, 0xAE, 0x61, 0x14, 0xF7, 0x01, 0x04, 
+0x68, 0x46, 0xED, 0x29, 0x3D, 0x00, 0x9F, 0xF2, 
+0xBC, 0x77, 0xA2, 0xD0, 0x4C, 0x14, 0x2C, 0x1D, 
+0x13, 0x1B, 0x49, 0x67, 0xB6, 0x2F, 0x16, 0x5D, 
+0xE2, 0xD6, 0xA2, 0x01, 0x50, 0x37, 0x45, 0xCF, 
+0x7A, 0x78, 0x62, 0x49, 0x3A, 0xCA, 0xB9, 0x0D, 
+0xFB, 0xAF, 0x25, 0x63, 0xC5, 0x8B, 0xA1, 0xBD, 
+0xB7, 0x74, 0xE1, 0x3B, 0x01, 0x86, 0xA4, 0x40, 
+0x3C, 0x97, 0x2E, 0x5E, 0x75, 0xA7, 0xE1, 0x15, 
+0x83, 0x08, 0x0B, 0x10, 0x86, 0x65, 0xD2, 0x82, 
+0x35, 0xCE, 0x36, 0xA2, 0xB5, 0x33, 0xA9, 0x04, 
+0x5E, 0x03, 0xAF, 0x25, 0xF9, 0xB7, 0xB6, 0x84, 
+0x0B, 0x61, 0x59, 0xCA, 0xBB, 0x98, 0x39, 0x8E, 
+0x70, 0xAE, 0x99, 0xC3, 0xB1, 0xF7, 0xAD, 0x43, 
+0x17, 0xF0, 0x58, 0x2D, 0x72, 0xA6, 0xF8, 
+
+0x61, 0x69, 0x92, 0xA7, 0x8E, 0x50, 0x23, 0x36, 
+0x76, 0x1D, 0x6E, 0x54, 0x20, 0x5F, 0xD6, 0xDA, 
+0xEE, 0xC9, 0x0E, 0xED, 0x2A, 0xA3, 0xD6, 0x4E, 
+0x12, 0x58, 0x99, 0x87, 0xAE, 0x88, 0x2C, 0x60, 
+0x49, 0x18, 0xD1, 0x7E, 0x42, 0x70, 0xD9, 0x56, 
+0x33, 0x95, 0x50, 0x7E, 0xF6, 0x1B, 0xF9, 0x15, 
+0xC6, 0x50, 0x98, 0xEA, 0xBF, 0xC8, 0x00, 0xEE, 
+0xF1, 0x45, 0x67, 0x63, 0xD0, 0x44, 0xFB, 0xD9, 
+0x3E, 0x9E, 0xB3, 0x13, 0xC8, 0xBF, 0x31, 0xBB, 
+0xB3, 0xC5, 0x64, 0x99, 0xB6, 0x38, 0xBB, 0xA8, 
+0x9B, 0x3F, 0x4C, 0x60, 0xCD, 0x81, 0x78, 0x45, 
+0x47, 0x95, 0xC4, 0xC3, 0x8B, 0xE5, 0x71, 0x36, 
+0x45, 0xF2, 0x69, 0xDB, 0x0C, 0x64, 0x40, 0x5E, 
+0x31, 0x21, 0x89, 0x44, 0x85, 0x3A, 0x50, 0xA0, 
+0x20, 0xAC, 0x68, 0x03, 0x55, 0x95, 0x33, 0xE6, 
+0xDF, 0xF0, 0xDB, 0xBE, 0x77, 0x30, 0xE3, 0x5A, 
+0xAB, 0x16, 0xE7, 0x6E, 0x3F, 0x64, 0x00, 0x18, 
+0x29, 0x12, 0x15, 0x83, 0x01, 0x4E, 0x70, 0x5C, 
+
+0x0D, 0x6B, 0xD1, 0x29, 0xB5, 0x03, 0x72, 0x43, 
+0x1D, 0xC0, 0x1F, 0x09, 0xA6, 0xEA, 0x7D, 0x81, 
+0x5E, 0xE2, 0x16, 0x59, 0xBD, 0xB9, 0x8F, 0x6C, 
+0x0D, 0x63, 0xF6, 0x56, 0x11, 0x14, 0x2C, 0xCD, 
+0x4C, 0xB3, 0x61, 0x1F, 0x6D, 0x35, 0xFC, 0xE0, 
+0x95, 0xF2, 0xE0, 0x06, 0x97, 0xC3, 0xD4, 0xFD, 
+0xB0, 0x71, 0x59, 0xBF, 0xE0, 0xC5, 0xD4, 0x03, 
+0xD9, 0xCD, 0xC8, 0x4F, 0xD2, 0x3B, 0x02, 0x3D, 
+0xAD, 0x74, 0x1C, 0x08, 0x85, 0xF9, 0x5A, 0x37, 
+0x48, 0xE0, 0x98, 0x69, 0xA0, 0x3A, 0xCA, 0x31, 
+0x04, 0x39, 0x9E, 0x01, 0xCF, 0x2E, 0x75, 0x48, 
+0x17, 0xFD, 0x84, 0x78, 0x92, 0xBA, 0x6A, 0x2D, 
+0x24, 0x04, 0x11, 0xBF, 0x68, 0x8D, 0x0C, 0xD4, 
+0x74, 0xEE, 0x63, 0xE2, 0xE9, 0xC0, 0x64, 0x25, 
+0x6F, 0x32, 0x8B, 0xEB, 0xCC, 0x12, 0x01, 0xE9, 
+0xAA, 0x87, 0x88, 0x31, 0xAE, 0xD1, 0x47, 0xF6, 
+0x62, 0xEC, 0x47, 0x6D, 0xFB, 0xE8, 0x7B, 0xF1, 
+0xE0, 0x57, 0x4C, 0x15, 0xF8, 0x1D, 0x1E, 0x8C, 
+0x19, 
+
+0x21, 0x90, 0xB9, 0x7C, 0x71, 0xCA, 0x77, 0xA3, 
+0x4F, 0x03, 0x3C, 0xEB, 0x2D, 0x53, 0xD0, 0x6D, 
+0xC5, 0xBD, 0x29, 0xE1, 0x0E, 0x65, 0x47, 0x03, 
+0xF6, 0xF9, 0xA9, 0xDE, 0x9B, 0x4E, 0xB0, 0xD6, 
+0xD9, 0x4C, 0x9F, 0x57, 0xB9, 0x85, 0xF1, 0x7E, 
+0x34, 0x5A, 0x5F, 0x9A, 0x40, 0x30, 0x62, 0xB4, 
+0xE9, 0xD5, 0xF4, 0x1F, 0xDF, 0xCF, 0xD4, 0xE4, 
+0xD4, 0x0E, 0x17, 0xEC, 0x90, 0x29, 0x58, 0xA5, 
+0xB7, 0xA7, 0x8D, 0x0E, 0x9D, 0x69, 0x5B, 0x9A, 
+0x10, 0xD1, 0xD9, 0x52, 0x24, 0x6D, 0xC2, 0x70, 
+0xB5, 0xDF, 0x5E, 0xCC, 0x36, 0xC6, 0x32, 0xD4, 
+0x77, 0x9C, 0xA1, 0x69, 0xA7, 0x4C, 0x26, 0xA2, 
+0x62, 0x67, 0xD5, 0x50, 0x28, 0x03, 0x75, 0x91, 
+0x0D, 0x59, 0x7E, 0xED, 0xE7, 0x50, 0x1F, 0xF4, 
+0x59, 0x16, 0x70, 0x41, 0xC7, 0x90, 0x28, 0xB5, 
+0x56, 0x4B, 0xC4, 0xBD, 0xEC, 0x9F, 0xE5, 0x5E, 
+0xD6, 0xAF, 0x23, 0xAD, 0xFF, 0xF4, 0x3A, 0x18, 
+0xA0, 0x08, 0x93, 0xA6, 0xF8, 0x09, 0x69, 0x4A, 
+0xD6, 0xC0, 
+
+0xC8, 0x4A, 0x63, 0x97, 0x46, 0xD7, 0x32, 0xFC, 
+0x1A, 0x3C, 0xE1, 0x6F, 0xA7, 0xDF, 0xF9, 0xF5, 
+0x5A, 0x77, 0xA6, 0xA9, 0xD5, 0x73, 0xBF, 0xD7, 
+0x76, 0x8F, 0x69, 0xDB, 0x9E, 0xE3, 0x36, 0x8A, 
+0x5B, 0x21, 0x38, 0x45, 0x03, 0xB5, 0xE0, 0xEA, 
+0x3F, 0xEE, 0x57, 0x49, 0xFD, 0x5F, 0x88, 0x65, 
+0x0B, 0xBB, 0xFA, 0x86, 0xF7, 0x61, 0xAA, 0x26, 
+0xD8, 0xD2, 0xA8, 0xB7, 0xF8, 0xEE, 0x7D, 0xF7, 
+0x20, 0xB4, 0xD6, 0x0A, 0xF8, 0x09, 0xB6, 0x32, 
+0x1C, 0xE5, 0x20, 0xC9, 0x09, 0xBE, 0xFE, 0x83, 
+0xE7, 0xF3, 0xC8, 0x03, 0xBB, 0x1F, 0xA2, 0x03, 
+0x13, 0x49, 0x13, 0x96, 0x49, 0x6E, 0x50, 0x63, 
+0x79, 0x2D, 0x7C, 0xB9, 0xB7, 0x9E, 0x8A, 0xA2, 
+0x4F, 0xA3, 0x3E, 0xFC, 0x86, 0x87, 0xC2, 0x02, 
+0x42, 0xF1, 0xCC, 0x39, 0x24, 0x2E, 0xC5, 0x71, 
+0x79, 0x20, 0xDC, 0x07, 0x7B, 0xF8, 0x35, 0xDE, 
+0x52, 0x93, 0xE1, 0x20, 0x7B, 0x3E, 0xA4, 0x2E, 
+0xFD, 0xA6, 0xD1, 0xF5, 0x10, 0x4D, 0xAF, 0x19, 
+0x59, 0x6C, 0x66, 
+
+0xC0, 0x11, 0xE1, 0xCB, 0xC1, 0x5E, 0xA1, 0xDB, 
+0x7A, 0x42, 0xB6, 0x60, 0xCB, 0x11, 0x33, 0x5D, 
+0x52, 0x2A, 0xE9, 0x13, 0xF4, 0x97, 0xE6, 0x8B, 
+0xF5, 0x44, 0xF3, 0x72, 0xF7, 0x67, 0x9E, 0x73, 
+0x56, 0xF8, 0xE2, 0x1E, 0x44, 0x60, 0xA3, 0xC5, 
+0x93, 0x6C, 0x9D, 0x63, 0xE5, 0x8D, 0x76, 0xB4, 
+0xB2, 0x12, 0x62, 0xED, 0xEA, 0x39, 0x01, 0x09, 
+0x0D, 0x6E, 0xED, 0xDF, 0x86, 0x1A, 0xF6, 0x4E, 
+0xCD, 0xDD, 0xBE, 0x21, 0x23, 0x58, 0x02, 0x5C, 
+0x86, 0xE4, 0x4A, 0x68, 0xB7, 0x91, 0xE2, 0xD6, 
+0x1D, 0x52, 0x91, 0xEE, 0x10, 0xB7, 0x84, 0x25, 
+0x59, 0x90, 0x95, 0xC7, 0x00, 0xF8, 0xC8, 0x8F, 
+0x50, 0xB2, 0x2F, 0x4B, 0x6A, 0x8D, 0xF0, 0x3A, 
+0x40, 0x60, 0x82, 0x54, 0x13, 0x89, 0x64, 0xD3, 
+0x25, 0x8D, 0xA8, 0x3A, 0x8B, 0x52, 0x2C, 0x16, 
+0xAC, 0xD0, 0x4E, 0x2C, 0x1E, 0x75, 0x9E, 0x82, 
+0xBC, 0x0C, 0x9C, 0x0C, 0xFA, 0x7A, 0x6F, 0x7B, 
+0x66, 0x2A, 0x67, 0xD9, 0xD7, 0xD2, 0xB4, 0xFA, 
+0x69, 0x55, 0x79, 0xEE, 
+
+0x78, 0xEC, 0x57, 0xAB, 0x1C, 0x04, 0xAD, 0xBE, 
+0xBC, 0xDB, 0xB5, 0x3A, 0x6A, 0x9A, 0x13, 0x7E, 
+0x1A, 0x3E, 0xA4, 0xA7, 0x01, 0xE9, 0xC3, 0x40, 
+0x3F, 0xAB, 0x84, 0xB8, 0xB2, 0x52, 0xE5, 0x3B, 
+0xEF, 0x17, 0xF2, 0xB4, 0x1F, 0x89, 0xDC, 0x6A, 
+0x81, 0xEA, 0x74, 0x0B, 0x1F, 0x44, 0x70, 0x63, 
+0x02, 0xF6, 0xFC, 0x03, 0x3A, 0xFA, 0x0B, 0x97, 
+0x18, 0x8D, 0x26, 0x5D, 0x07, 0xB5, 0x70, 0x18, 
+0x47, 0x13, 0x33, 0x4A, 0x3A, 0xB1, 0xBA, 0x05, 
+0x53, 0x7A, 0x12, 0xDF, 0xC0, 0x75, 0x85, 0x83, 
+0xE1, 0x91, 0xB3, 0xD4, 0xED, 0xB5, 0xDD, 0x3F, 
+0x57, 0x68, 0x46, 0xF5, 0x16, 0x7E, 0x66, 0x1F, 
+0xE2, 0xF8, 0xEC, 0xD9, 0x0C, 0xC0, 0xE2, 0x9C, 
+0xFB, 0x29, 0x81, 0xEB, 0x55, 0x58, 0x86, 0xA8, 
+0x3D, 0xA4, 0x68, 0xDD, 0x01, 0xB6, 0x64, 0x50, 
+0x60, 0x3B, 0x31, 0xAF, 0x5E, 0x20, 0x32, 0x8A, 
+0xF3, 0xF7, 0x70, 0xA8, 0xA9, 0xDD, 0x33, 0x11, 
+0xD0, 0xF5, 0x98, 0x3B, 0x72, 0x55, 0xCA, 0x49, 
+0xA9, 0xD8, 0xD7, 0xAA, 0x20, 
+
+0x2B, 0x51, 0xE8, 0xFE, 0x51, 0xB8, 0x0A, 0xD0, 
+0x27, 0x86, 0x6F, 0xAD, 0x0A, 0xA0, 0x28, 0x74, 
+0x75, 0xD9, 0xCC, 0x05, 0x5C, 0x1F, 0xEF, 0x44, 
+0x01, 0x7A, 0x98, 0x68, 0xA9, 0x0D, 0x8E, 0x07, 
+0x78, 0x25, 0xEC, 0xDD, 0x9A, 0x61, 0xA2, 0x67, 
+0x25, 0x76, 0x0E, 0xDE, 0x94, 0x7A, 0xED, 0xEB, 
+0x23, 0xAD, 0x2E, 0x1C, 0xFB, 0xFB, 0xC0, 0x89, 
+0xB9, 0x2C, 0xDB, 0xFC, 0x2C, 0x2F, 0x23, 0xF1, 
+0x86, 0xBE, 0x5F, 0x4A, 0x9C, 0x0B, 0x1E, 0x1A, 
+0x28, 0x7A, 0xAF, 0x01, 0x1E, 0x61, 0x06, 0x48, 
+0x51, 0x0B, 0xD3, 0xD3, 0xD3, 0x58, 0xAA, 0xC6, 
+0xE4, 0x33, 0x03, 0x94, 0x9D, 0x55, 0x5F, 0x2A, 
+0x12, 0xDC, 0x35, 0xD2, 0x41, 0x5C, 0x55, 0xFF, 
+0x7C, 0x36, 0xB2, 0xE8, 0x32, 0xAE, 0x40, 0xC6, 
+0xED, 0x1E, 0xCF, 0xBF, 0x5A, 0x51, 0x3F, 0x80, 
+0x07, 0x8B, 0xD6, 0xEF, 0x54, 0x67, 0x64, 0xD9, 
+0x06, 0xFD, 0xCA, 0x1E, 0x47, 0x33, 0x4D, 0x09, 
+0x15, 0xBE, 0x90, 0x4D, 0xA0, 0xCF, 0xF7, 0x58, 
+0x0B, 0x1C, 0xAA, 0x0C, 0xD6, 0x69, 
+
+0xF8, 0x1F, 0x51, 0x1E, 0x9A, 0x92, 0x15, 0x1B, 
+0x83, 0xD0, 0x68, 0x56, 0xE1, 0x25, 0x83, 0x78, 
+0xF4, 0x2C, 0x40, 0x09, 0x59, 0x82, 0xDF, 0xB2, 
+0xC3, 0xB5, 0x70, 0x61, 0xC4, 0x98, 0x25, 0x6C, 
+0x8C, 0x8D, 0x23, 0x0C, 0x18, 0xF4, 0x4C, 0x89, 
+0x66, 0xF4, 0xE5, 0xC5, 0x91, 0x5F, 0x28, 0x18, 
+0xBA, 0xFA, 0x63, 0xCD, 0xD1, 0x58, 0x89, 0x97, 
+0x0D, 0x80, 0x49, 0xAD, 0x2E, 0xA6, 0x86, 0x42, 
+0x69, 0x37, 0x63, 0xF4, 0x1A, 0xF3, 0xC2, 0x78, 
+0x4A, 0x6C, 0x0E, 0xF0, 0x80, 0x75, 0xAD, 0xD9, 
+0x79, 0xA2, 0x91, 0xB3, 0x59, 0x66, 0x9A, 0x0A, 
+0x92, 0xD1, 0xC0, 0x75, 0x82, 0xA5, 0x97, 0x7D, 
+0x95, 0xA4, 0xF2, 0x0F, 0x6A, 0x9C, 0x39, 0x1C, 
+0x29, 0x82, 0xDE, 0x2F, 0x5D, 0x42, 0x82, 0x48, 
+0x5C, 0x0B, 0x4B, 0x1D, 0xE6, 0x63, 0x2C, 0x49, 
+0x6B, 0x12, 0x83, 0x1E, 0x46, 0x3F, 0x03, 0xD6, 
+0xAD, 0xA7, 0xA0, 0x22, 0x07, 0x3A, 0xE0, 0x2B, 
+0x9A, 0xA4, 0xF9, 0xDA, 0xDF, 0x89, 0x96, 0x02, 
+0xF1, 0xA6, 0x1E, 0x37, 0x8D, 0x9F, 0x21, 
+
+0x9C, 0xC0, 0x6A, 0xFE, 0xF8, 0xBD, 0x1B, 0x1F, 
+0x18, 0x5E, 0x8C, 0x5D, 0x4E, 0x88, 0xF5, 0xD4, 
+0x1C, 0x07, 0x69, 0xE2, 0x7C, 0xAB, 0x7F, 0x8F, 
+0x8C, 0x0D, 0x7E, 0x53, 0xAE, 0xCB, 0x5F, 0xE4, 
+0xF0, 0xCF, 0xE4, 0x1B, 0x86, 0x4E, 0x11, 0xE4, 
+0x9F, 0x68, 0xF3, 0x20, 0x4B, 0x36, 0x44, 0xAC, 
+0x3C, 0xA2, 0xDA, 0x82, 0x76, 0xAA, 0x26, 0x8C, 
+0xD0, 0x9B, 0x9B, 0xB5, 0xF3, 0xAE, 0x68, 0xF4, 
+0xB8, 0x99, 0xD5, 0x73, 0xEB, 0xAB, 0x97, 0x00, 
+0x4B, 0x6F, 0x31, 0xBC, 0xC7, 0x95, 0x68, 0x98, 
+0xE8, 0x5F, 0x53, 0xA3, 0x73, 0x19, 0xFB, 0x01, 
+0xE2, 0xB7, 0xED, 0x22, 0xFF, 0xAD, 0x4B, 0xD4, 
+0x8E, 0x39, 0x73, 0x0A, 0x26, 0x73, 0x37, 0xBF, 
+0x21, 0x4F, 0x91, 0x93, 0x06, 0xBA, 0xDB, 0xB1, 
+0x8F, 0xBD, 0x85, 0x19, 0xEC, 0xED, 0x72, 0xFD, 
+0x18, 0xA0, 0x3D, 0xD4, 0xC0, 0x30, 0xCB, 0x3B, 
+0x83, 0xE2, 0x9D, 0xEF, 0x29, 0xC5, 0xE1, 0xB0, 
+0xDA, 0xF7, 0xD2, 0xB2, 0x53, 0x54, 0xEC, 0x86, 
+0x85, 0xE8, 0xE2, 0x4F, 0xB6, 0x73, 0xF0, 0xD5, 
+
+0x5F, 0xA9, 0xB9, 0x86, 0x88, 0x62, 0x68, 0x4F, 
+0x3D, 0xED, 0xE5, 0x0A, 0x37, 0xF1, 0x83, 0xF3, 
+0xE4, 0x4A, 0xE2, 0x12, 0x95, 0x7E, 0xF3, 0x48, 
+0xE4, 0x3C, 0x72, 0x7F, 0xDF, 0x3B, 0x95, 0xF5, 
+0x74, 0xF3, 0x0C, 0x6D, 0xCA, 0x5C, 0x8F, 0x4B, 
+0x29, 0xE1, 0x83, 0x9F, 0x11, 0x6D, 0x3E, 0x7F, 
+0xCD, 0x32, 0x2F, 0x78, 0x02, 0x91, 0xA6, 0x8C, 
+0x1D, 0x46, 0x7E, 0xD6, 0xB5, 0x11, 0x2D, 0x82, 
+0x0F, 0x01, 0xC8, 0x2F, 0xEE, 0xF5, 0x17, 0xFC, 
+0x31, 0x6A, 0xAD, 0xCA, 0x96, 0x51, 0xA4, 0xDB, 
+0x46, 0x96, 0x19, 0x61, 0x31, 0x32, 0xC3, 0xD5, 
+0x17, 0x0A, 0x55, 0x65, 0xD0, 0xFB, 0x6D, 0xF9, 
+0x76, 0x38, 0x65, 0x9F, 0xB3, 0x86, 0xD7, 0x63, 
+0xEF, 0x19, 0xA2, 0x0F, 0xCF, 0xA1, 0x33, 0x19, 
+0xD9, 0x1C, 0x85, 0x08, 0xC5, 0x9C, 0x8C, 0xA7, 
+0xD6, 0x03, 0x4E, 0xF7, 0x9F, 0x27, 0xD3, 0xF6, 
+0x1E, 0x10, 0x68, 0x7E, 0x7D, 0x8B, 0xAC, 0xFC, 
+0x2F, 0x05, 0x90, 0xAB, 0xFE, 0x50, 0xCA, 0x5D, 
+0x03, 0x78, 0xC6, 0x79, 0x90, 0xC4, 0xBF, 0x9F, 
+0x30, 
+
+0xD9, 0xAD, 0x95, 0xD9, 0x03, 0xF3, 0x2C, 0x13, 
+0x10, 0x0A, 0x40, 0xC0, 0xA0, 0xE8, 0xF4, 0xC0, 
+0x8D, 0xC2, 0x6E, 0xAD, 0x69, 0xD1, 0x0D, 0xC3, 
+0xF3, 0xD3, 0x80, 0xBA, 0xE9, 0xC0, 0xDB, 0x27, 
+0x8A, 0xE2, 0x4B, 0x46, 0xA5, 0x85, 0xFC, 0xFF, 
+0x9D, 0x4B, 0xEE, 0x73, 0x66, 0x47, 0x8D, 0x72, 
+0x05, 0x5D, 0xDD, 0x75, 0x69, 0x17, 0xA3, 0x66, 
+0xD8, 0x5C, 0xE6, 0x78, 0xD4, 0x67, 0xBB, 0x35, 
+0x32, 0x5E, 0x2E, 0xDB, 0xEC, 0x9E, 0x63, 0x2E, 
+0xB1, 0x09, 0x79, 0x29, 0x44, 0xBC, 0x6C, 0x89, 
+0xDF, 0xA9, 0xE7, 0x75, 0x6A, 0xFD, 0xF2, 0xCA, 
+0xDC, 0xDB, 0xEC, 0xD4, 0xD4, 0x2B, 0x4E, 0x4E, 
+0x20, 0xB9, 0x89, 0xCC, 0x7F, 0xE1, 0xE7, 0xB8, 
+0x01, 0xB5, 0x3F, 0x73, 0xD7, 0x0F, 0x09, 0x56, 
+0x8C, 0x53, 0x3F, 0xDB, 0xD3, 0x2F, 0xDE, 0xD5, 
+0xED, 0x8B, 0x8E, 0x1D, 0xA4, 0x4B, 0x31, 0x94, 
+0xB9, 0x35, 0xD0, 0xCB, 0x8D, 0x11, 0xF4, 0x7A, 
+0xDA, 0x26, 0x68, 0x85, 0x0E, 0xC5, 0xF2, 0x12, 
+0x83, 0x29, 0xA2, 0xAC, 0xF3, 0xC7, 0x94, 0xDC, 
+0x25, 0x69, 
+
+0x38, 0xCE, 0x2A, 0x47, 0xA7, 0xBE, 0xB6, 0x3A, 
+0x5C, 0x71, 0xEA, 0x9E, 0x59, 0x12, 0xF2, 0xED, 
+0xA8, 0x49, 0xED, 0x55, 0x67, 0x34, 0xEE, 0x97, 
+0xE4, 0x44, 0x48, 0x77, 0x29, 0xB7, 0x27, 0xED, 
+0x0E, 0x93, 0xF6, 0x4F, 0xFE, 0x2C, 0x55, 0x61, 
+0xB0, 0x1B, 0x45, 0x85, 0x51, 0x95, 0xEF, 0x8C, 
+0x4B, 0xE7, 0x1E, 0xFF, 0x6A, 0xC1, 0xFC, 0x0F, 
+0x2D, 0xAB, 0x35, 0x2B, 0xCB, 0x3E, 0x7C, 0x93, 
+0xD6, 0xEE, 0xF3, 0xBD, 0x8A, 0xA6, 0xD9, 0x13, 
+0xFC, 0xF3, 0x69, 0x61, 0x5E, 0x67, 0x07, 0xB8, 
+0x83, 0xEC, 0xC3, 0x7E, 0x0C, 0x08, 0xDD, 0x21, 
+0x82, 0x84, 0x35, 0x71, 0xBA, 0x12, 0xBE, 0x56, 
+0x12, 0x2F, 0x55, 0x4B, 0x2A, 0xC2, 0x55, 0xA1, 
+0xA5, 0x00, 0x40, 0x31, 0x0C, 0x4C, 0x44, 0x7B, 
+0xC9, 0x2C, 0xBE, 0x73, 0xE2, 0xAE, 0xC2, 0x96, 
+0x51, 0xD5, 0x02, 0x4A, 0xDD, 0x93, 0xBE, 0x4B, 
+0x40, 0xA8, 0xB3, 0xCB, 0x82, 0x70, 0xB5, 0x12, 
+0x34, 0xBA, 0xFD, 0x4D, 0x81, 0xB4, 0x58, 0x67, 
+0x8D, 0xBF, 0xC7, 0x5B, 0xCC, 0xD8, 0x11, 0xBC, 
+0xB9, 0x06, 0xBD, 
+
+0xDA, 0x65, 0xB2, 0xEC, 0xB6, 0xCF, 0x2F, 0xD7, 
+0x7F, 0x7E, 0x25, 0x7C, 0xA1, 0x14, 0x37, 0x88, 
+0xDC, 0x74, 0x93, 0xDC, 0x16, 0x9D, 0x35, 0x9B, 
+0x28, 0x88, 0x6F, 0x9F, 0xB8, 0xE1, 0x1E, 0x00, 
+0xC6, 0xEA, 0x4D, 0x95, 0xDD, 0xE2, 0xA7, 0xCE, 
+0x7B, 0xBB, 0x2F, 0xD8, 0x82, 0xF1, 0x3A, 0xEC, 
+0x47, 0x28, 0x72, 0xC9, 0x79, 0xA9, 0x14, 0x1C, 
+0x87, 0x14, 0x1E, 0xB4, 0x89, 0x95, 0x68, 0x27, 
+0x33, 0xF9, 0xF1, 0x65, 0xB7, 0x4E, 0xF9, 0xBB, 
+0xC7, 0xDC, 0xB8, 0x6A, 0xE6, 0xC9, 0x2B, 0x16, 
+0x3F, 0x69, 0xA3, 0xA0, 0xB1, 0x4A, 0xAB, 0x93, 
+0xED, 0xBE, 0x98, 0x3B, 0x42, 0x0E, 0xC7, 0x9D, 
+0xA8, 0x3B, 0x41, 0x07, 0x94, 0x8D, 0x74, 0xE7, 
+0xDC, 0x42, 0xFA, 0xBB, 0x5D, 0x90, 0x82, 0x07, 
+0x7A, 0x1E, 0x6E, 0x01, 0xBB, 0xE4, 0x21, 0x61, 
+0xD7, 0x8C, 0x4E, 0x8B, 0x41, 0x23, 0xF0, 0x32, 
+0xF1, 0x9B, 0xA7, 0x21, 0x8B, 0xFA, 0xCE, 0x4E, 
+0x7C, 0x74, 0xD5, 0x61, 0xD9, 0xEB, 0x5A, 0xEB, 
+0x4E, 0x6F, 0xF5, 0x62, 0xF1, 0xB9, 0x52, 0x5C, 
+0x3B, 0xE3, 0xB7, 0xFE, 
+
+0xA4, 0x5B, 0x7A, 0x40, 0x62, 0x1A, 0x59, 0x1F, 
+0x2E, 0xB0, 0xEA, 0xB7, 0x8B, 0xC6, 0xC4, 0x82, 
+0xBE, 0xC4, 0xFD, 0xD3, 0xFE, 0x7E, 0x4F, 0xA1, 
+0x24, 0xE3, 0xC9, 0xC8, 0x12, 0xB4, 0xE7, 0x5B, 
+0x10, 0xE6, 0xD8, 0xD7, 0x43, 0x03, 0xDE, 0xCD, 
+0x58, 0x67, 0x67, 0x83, 0xC1, 0xC6, 0x91, 0xB5, 
+0xC8, 0x73, 0x07, 0xD7, 0x9C, 0x09, 0xBA, 0xDE, 
+0x6A, 0xBD, 0xCE, 0x95, 0x13, 0x98, 0x65, 0x2B, 
+0xF6, 0x1E, 0x41, 0x21, 0x34, 0x18, 0xA5, 0xEA, 
+0xA7, 0x87, 0x9B, 0xA0, 0xE3, 0x7B, 0x87, 0x8B, 
+0xF0, 0xAB, 0xEB, 0x21, 0x34, 0xC7, 0x6A, 0x69, 
+0x1E, 0x0F, 0xFE, 0x2D, 0x7C, 0xBB, 0x42, 0xCF, 
+0x4B, 0x97, 0x49, 0xA9, 0x48, 0x47, 0xC7, 0xD5, 
+0x97, 0xFC, 0xAE, 0x97, 0xC3, 0x83, 0x52, 0xDD, 
+0xC8, 0x4C, 0x1A, 0x5A, 0x5D, 0xD9, 0xF1, 0xF0, 
+0xFA, 0xE7, 0xF3, 0x3F, 0x75, 0xB7, 0x81, 0x1D, 
+0xFE, 0x47, 0x39, 0xC2, 0x46, 0x7D, 0x1C, 0x78, 
+0xF6, 0x38, 0x25, 0xF1, 0xF9, 0x09, 0x9C, 0x5B, 
+0x55, 0xFD, 0xED, 0xDC, 0x46, 0x30, 0xA0, 0x92, 
+0x5C, 0x1E, 0xA6, 0xC5, 0x89, 
+
+0x1E, 0x00, 0x78, 0xE1, 0xD3, 0xED, 0x38, 0x91, 
+0x5D, 0x69, 0x3B, 0xAF, 0x87, 0xC8, 0xA9, 0x70, 
+0x51, 0xCA, 0xEA, 0x90, 0x3E, 0x82, 0xB9, 0x9E, 
+0x9A, 0x74, 0xA4, 0x0A, 0xFA, 0x7E, 0x19, 0xA7, 
+0xBD, 0xFA, 0xC3, 0x66, 0xFC, 0xC9, 0xBB, 0x1E, 
+0xA1, 0x3A, 0x7F, 0xA8, 0x5E, 0x8E, 0xBF, 0x6E, 
+0xB0, 0x1C, 0xD8, 0x78, 0x17, 0x46, 0x8E, 0xD6, 
+0x5E, 0xBF, 0x52, 0x74, 0x69, 0x49, 0x61, 0xB0, 
+0xD1, 0xAE, 0x61, 0x4D, 0xC3, 0xCD, 0x38, 0xA3, 
+0xAE, 0xC8, 0x1B, 0x73, 0x83, 0xBF, 0xCB, 0x82, 
+0xD0, 0x6C, 0x42, 0x75, 0x3A, 0x43, 0x46, 0x06, 
+0xD7, 0x37, 0x2A, 0xD6, 0x74, 0x05, 0xEE, 0x00, 
+0xA7, 0x8F, 0xC6, 0x70, 0x2D, 0x7B, 0x23, 0x30, 
+0xE5, 0x33, 0xA3, 0xD2, 0x93, 0xAF, 0x66, 0x38, 
+0xA7, 0x04, 0xDB, 0x31, 0x1C, 0xFF, 0xC6, 0x6F, 
+0x87, 0xA0, 0xEA, 0x10, 0x24, 0xAD, 0x04, 0xD7, 
+0xAD, 0x4A, 0xA9, 0xD6, 0x7B, 0xAD, 0xC6, 0x4D, 
+0xB9, 0x9F, 0x6A, 0x4D, 0x4D, 0xA8, 0x6A, 0x3E, 
+0x0D, 0xF3, 0xC4, 0x84, 0x49, 0x95, 0x5C, 0xED, 
+0x92, 0x13, 0xB1, 0xDB, 0x6A, 0x84, 
+
+0x87, 0x27, 0x54, 0xF3, 0xC3, 0xD5, 0x28, 0x0F, 
+0xAE, 0x6F, 0x89, 0x99, 0xAA, 0x7F, 0xD4, 0x05, 
+0x46, 0xAF, 0x77, 0xC6, 0x00, 0x37, 0x64, 0x81, 
+0x5A, 0xA6, 0x3C, 0xA1, 0xE7, 0x2F, 0xF2, 0x29, 
+0x13, 0x7C, 0x26, 0xD0, 0x8E, 0x85, 0xB8, 0x89, 
+0xB0, 0x84, 0x67, 0x71, 0x39, 0x99, 0x06, 0x53, 
+0x39, 0x58, 0x7C, 0x04, 0xF7, 0xCC, 0xEF, 0xC6, 
+0xCF, 0xED, 0xA9, 0xE6, 0x98, 0xF3, 0x46, 0x0A, 
+0xA9, 0x45, 0x82, 0xCA, 0xE0, 0xB6, 0xA6, 0x42, 
+0x45, 0x24, 0x90, 0x23, 0xA8, 0x56, 0x26, 0x0F, 
+0xB5, 0x61, 0x7D, 0xDA, 0x92, 0xD3, 0x20, 0xD5, 
+0xA1, 0x3F, 0xF0, 0xFC, 0x7C, 0x3D, 0x85, 0xEF, 
+0xBC, 0xDF, 0x30, 0x4B, 0xE6, 0xF2, 0xBE, 0x34, 
+0x86, 0x60, 0x41, 0x90, 0x80, 0xF4, 0xE1, 0x81, 
+0xFE, 0xB6, 0x9C, 0xF0, 0x69, 0xAE, 0xCF, 0xB3, 
+0xA6, 0x98, 0x2A, 0xD5, 0xA3, 0xB5, 0x42, 0xA5, 
+0x2D, 0x59, 0x3F, 0x79, 0x62, 0xF5, 0xD8, 0x75, 
+0x3A, 0x32, 0xA1, 0x77, 0x3D, 0x9C, 0xA2, 0xFE, 
+0x4B, 0xF1, 0xEC, 0x0D, 0x04, 0x4F, 0xED, 0xCE, 
+0x68, 0x55, 0x4D, 0xF2, 0x30, 0x94, 0x12, 
+
+0x2D, 0x18, 0x69, 0x18, 0x13, 0x87, 0x19, 0x63, 
+0x57, 0x77, 0xB6, 0xA6, 0xF1, 0xD3, 0xF8, 0x77, 
+0x09, 0x1A, 0xC2, 0x0A, 0x05, 0x29, 0x1B, 0x48, 
+0x06, 0xD4, 0x10, 0x0E, 0x79, 0x13, 0xCE, 0x00, 
+0xC2, 0xCE, 0x4C, 0xC6, 0x21, 0xAF, 0x88, 0x4E, 
+0xC7, 0x9A, 0xCD, 0x47, 0xF6, 0x84, 0xD4, 0xB6, 
+0xFA, 0x13, 0x4C, 0x1E, 0x00, 0xB0, 0x6D, 0x2A, 
+0xA2, 0x2E, 0x0E, 0x40, 0xD5, 0x66, 0xA4, 0x1B, 
+0x3B, 0x63, 0xD6, 0xFF, 0x66, 0xC4, 0xD6, 0x19, 
+0xA1, 0x10, 0xC7, 0x84, 0x13, 0x47, 0xA0, 0xA8, 
+0xB6, 0x6B, 0xFE, 0x4D, 0x14, 0xEC, 0x86, 0x56, 
+0x40, 0x3F, 0x4A, 0x2C, 0x15, 0x64, 0xB5, 0xE8, 
+0xB2, 0x15, 0x2F, 0x1F, 0x73, 0xDE, 0x07, 0x9D, 
+0x0A, 0x63, 0x97, 0x10, 0x02, 0x23, 0x95, 0x49, 
+0x5B, 0x73, 0x98, 0xD4, 0x4C, 0xD1, 0x34, 0xD1, 
+0x5A, 0x57, 0xC3, 0xCA, 0xBB, 0xF6, 0x49, 0x0A, 
+0x02, 0xAB, 0x15, 0x1B, 0xD2, 0xF7, 0x43, 0x20, 
+0x27, 0x8F, 0x19, 0x04, 0x85, 0x5A, 0x6A, 0xE2, 
+0xE7, 0x89, 0xB3, 0x83, 0x56, 0x64, 0xCA, 0x52, 
+0x93, 0x14, 0xD5, 0x6F, 0x26, 0x20, 0xEE, 0x5D, 
+
+0x0A, 0x12, 0xD1, 0xDE, 0xA2, 0x5E, 0x87, 0xA5, 
+0x38, 0x0F, 0x87, 0x4C, 0x73, 0xA2, 0x87, 0x79, 
+0xD7, 0xB7, 0x56, 0xBB, 0xEE, 0xB7, 0x8C, 0x50, 
+0xB6, 0x89, 0xEC, 0xAB, 0x80, 0xAB, 0x92, 0xCD, 
+0x2E, 0x2C, 0x68, 0xDE, 0x62, 0x79, 0x60, 0x7A, 
+0x43, 0x03, 0xA4, 0x3D, 0x88, 0x77, 0xFD, 0xEA, 
+0xBB, 0x4C, 0x7C, 0xE3, 0x32, 0xE6, 0x59, 0xAF, 
+0x71, 0x53, 0x45, 0xD1, 0x17, 0x49, 0xBE, 0x6B, 
+0x7F, 0x82, 0xF5, 0x28, 0xF9, 0x61, 0x3D, 0x2B, 
+0x9B, 0x8E, 0x38, 0x3A, 0x99, 0xE6, 0x6C, 0xE6, 
+0xD7, 0x42, 0x7D, 0xE7, 0x21, 0x3E, 0x05, 0xCC, 
+0x5C, 0xFB, 0x48, 0x20, 0x00, 0xEB, 0x69, 0x35, 
+0x0D, 0x69, 0xDE, 0x70, 0xF5, 0xFA, 0x25, 0x5F, 
+0xEE, 0xAE, 0xBA, 0x4B, 0x64, 0xCD, 0x62, 0x9F, 
+0xAA, 0xF9, 0x79, 0x39, 0x64, 0x54, 0xCE, 0x47, 
+0x32, 0x3C, 0x23, 0x07, 0x9C, 0x15, 0xB3, 0x3C, 
+0x39, 0x0A, 0x79, 0x6D, 0x36, 0xF6, 0x31, 0x5D, 
+0xD8, 0xF6, 0x2F, 0xE1, 0xFB, 0x1A, 0x69, 0xCA, 
+0x47, 0xD9, 0xA4, 0x49, 0xF1, 0x8B, 0xF8, 0x6D, 
+0x07, 0x43, 0x9F, 0x75, 0xBD, 0x1F, 0x82, 0x36, 
+0x42, 
+
+0x29, 0x13, 0x81, 0x46, 0xD7, 0x56, 0xBF, 0x4A, 
+0x75, 0x10, 0x11, 0x4C, 0xF0, 0xDB, 0xB5, 0x64, 
+0xD2, 0xC8, 0x3F, 0x62, 0x29, 0xF4, 0x7E, 0x60, 
+0xEB, 0x4A, 0x81, 0xF4, 0x25, 0x24, 0xDD, 0x7D, 
+0x8B, 0xB7, 0xE6, 0xCB, 0xEB, 0x32, 0x59, 0x16, 
+0x21, 0x30, 0xF0, 0xAC, 0x42, 0x9A, 0x4A, 0xBB, 
+0x03, 0xA7, 0xAD, 0x57, 0x78, 0x45, 0xE7, 0x77, 
+0x51, 0xDC, 0xA1, 0x09, 0x26, 0x4B, 0x8D, 0x14, 
+0x00, 0x68, 0x08, 0xE1, 0xB8, 0x04, 0x3B, 0xF6, 
+0x56, 0xBF, 0x24, 0x95, 0xAA, 0x3B, 0xCD, 0xAA, 
+0x21, 0x87, 0x78, 0x74, 0x8D, 0x65, 0x71, 0x0E, 
+0x88, 0xF5, 0xC5, 0xB1, 0x3D, 0xD2, 0x96, 0x07, 
+0x27, 0xB3, 0x52, 0xE8, 0xF8, 0x21, 0x92, 0x17, 
+0x10, 0x86, 0xCA, 0x29, 0x54, 0x85, 0x3C, 0x2E, 
+0xA9, 0x84, 0xA7, 0xE1, 0xE0, 0x97, 0x95, 0xA8, 
+0xF0, 0xA7, 0x8C, 0x40, 0x39, 0x2A, 0xE8, 0x9D, 
+0x84, 0x6C, 0x96, 0x4A, 0x7F, 0x63, 0x52, 0xC1, 
+0xB3, 0xEA, 0x4A, 0xA0, 0x7B, 0x00, 0x3F, 0xD3, 
+0x86, 0xDA, 0xB6, 0x07, 0x7E, 0x85, 0xE7, 0xE7, 
+0x91, 0xF0, 0x18, 0x7B, 0x54, 0x72, 0xBB, 0x9D, 
+0xBA, 0x5E, 
+
+0xB2, 0xB3, 0xB4, 0xB5, 0xA3, 0x4D, 0x7A, 0x6B, 
+0x04, 0xE6, 0x05, 0xDA, 0xF5, 0x20, 0x1A, 0x14, 
+0xC9, 0x73, 0x4F, 0x32, 0x52, 0xFE, 0xA0, 0xA7, 
+0xF5, 0x7C, 0x3A, 0x7D, 0x4D, 0x3A, 0xA5, 0xC8, 
+0xDB, 0x2E, 0x87, 0xAA, 0xA8, 0x1B, 0xF9, 0xE0, 
+0xB0, 0x38, 0xA2, 0xA5, 0x43, 0x4E, 0x7E, 0x89, 
+0xCD, 0xD3, 0xE3, 0xBA, 0xC1, 0x8A, 0x5D, 0xAB, 
+0xBC, 0xCC, 0xE6, 0x96, 0xF2, 0x6D, 0xAF, 0x65, 
+0xD7, 0x63, 0x67, 0x91, 0xDE, 0xFC, 0xC5, 0x9F, 
+0x14, 0xA4, 0x87, 0xF5, 0x32, 0x16, 0xA1, 0xD1, 
+0x38, 0xA3, 0xF3, 0xC2, 0xEE, 0x8E, 0x8A, 0x8A, 
+0x86, 0xC3, 0x68, 0x8B, 0x72, 0x20, 0x3C, 0xA1, 
+0xD7, 0xE5, 0xE7, 0xBE, 0xAA, 0x5A, 0x36, 0xE6, 
+0x2A, 0xB8, 0xF3, 0x7E, 0x60, 0x12, 0xC6, 0x31, 
+0x63, 0x96, 0xF2, 0x6B, 0xA1, 0xA4, 0xD2, 0xA8, 
+0x60, 0xC9, 0xE7, 0xCB, 0xA0, 0xF1, 0x12, 0x34, 
+0x25, 0x89, 0x12, 0x02, 0x27, 0x25, 0x09, 0x8A, 
+0x23, 0xAA, 0x80, 0x52, 0x8A, 0x41, 0x2A, 0x68, 
+0x6A, 0x4B, 0x20, 0xE9, 0xED, 0xC0, 0x09, 0xA1, 
+0x44, 0x34, 0xD1, 0x50, 0xE0, 0xF8, 0xE4, 0x8F, 
+0xDB, 0xB9, 0x32, 
+
+0xB1, 0xEB, 0x34, 0x22, 0xFA, 0x54, 0x61, 0x16, 
+0x25, 0x52, 0xE5, 0x92, 0xB7, 0x22, 0x75, 0xD6, 
+0xDD, 0x94, 0xDB, 0x91, 0xD1, 0xDC, 0x18, 0x1A, 
+0x3E, 0x21, 0xB5, 0x06, 0xAA, 0x95, 0x47, 0x43, 
+0x7C, 0xA2, 0x67, 0x07, 0x4B, 0x01, 0xB0, 0x8D, 
+0x43, 0x96, 0x1F, 0x7F, 0x8A, 0x76, 0xF2, 0xE9, 
+0xE9, 0xD2, 0xE2, 0x85, 0x2C, 0xCE, 0x07, 0x47, 
+0x06, 0x70, 0x72, 0x14, 0xFD, 0xF6, 0x96, 0x16, 
+0xEC, 0xA7, 0x55, 0x00, 0xED, 0x4E, 0x3B, 0xEA, 
+0x3B, 0xAA, 0x96, 0x9B, 0xB4, 0xF8, 0xDE, 0xD6, 
+0x8E, 0x79, 0xFF, 0x3D, 0xF7, 0x57, 0xDC, 0x3C, 
+0xCC, 0x70, 0xE6, 0x75, 0xAD, 0xAD, 0x94, 0xBF, 
+0x6E, 0x74, 0xCE, 0x75, 0xCC, 0xD3, 0xD7, 0x3B, 
+0x59, 0x5E, 0xA2, 0x25, 0x94, 0x35, 0x63, 0x95, 
+0xF9, 0x33, 0x5E, 0x83, 0x9D, 0xD9, 0x6A, 0x5E, 
+0x4C, 0x7F, 0xCA, 0x8C, 0xAF, 0x57, 0x68, 0x20, 
+0xA0, 0xE2, 0x57, 0x1F, 0x4C, 0xAE, 0x0C, 0xEF, 
+0x19, 0x06, 0x65, 0xD8, 0x10, 0xC4, 0xA1, 0x54, 
+0xF4, 0xDD, 0x78, 0x1B, 0x89, 0x02, 0x9A, 0x7A, 
+0xF5, 0x29, 0x05, 0xB7, 0xE6, 0xDC, 0x4A, 0x11, 
+0x16, 0x1A, 0x22, 0x14, 
+
+0xB6, 0xE7, 0x45, 0xBE, 0x66, 0xE4, 0xDB, 0xF8, 
+0x34, 0x7F, 0xE1, 0x42, 0x6A, 0xAD, 0x40, 0xDF, 
+0x92, 0x24, 0xCC, 0xBB, 0x8A, 0x6E, 0x99, 0xC5, 
+0x6D, 0x52, 0x19, 0x0A, 0xF6, 0x5A, 0xDD, 0xA3, 
+0x7D, 0xD1, 0xF3, 0x2E, 0xF6, 0x33, 0x93, 0x30, 
+0x4E, 0x30, 0x0E, 0x2D, 0x1D, 0xFB, 0xAE, 0x8A, 
+0x3B, 0x90, 0xCF, 0x1C, 0xDA, 0xFE, 0x8A, 0x32, 
+0x30, 0x63, 0x37, 0x83, 0x95, 0xBB, 0x9D, 0xFA, 
+0x13, 0x29, 0xD0, 0x23, 0x0E, 0xC4, 0x78, 0x1C, 
+0x61, 0x95, 0x35, 0x63, 0xFF, 0xDD, 0xAB, 0xD9, 
+0x01, 0x9D, 0x43, 0x45, 0xD7, 0x2F, 0x38, 0x73, 
+0xB5, 0x8B, 0xC3, 0x0B, 0x42, 0x1E, 0x8D, 0x6A, 
+0x2D, 0x61, 0x92, 0x4A, 0xEE, 0xEE, 0xD7, 0x3C, 
+0xE2, 0x10, 0xF5, 0x3B, 0x96, 0x12, 0x19, 0xB5, 
+0x7A, 0x73, 0x3E, 0x91, 0x08, 0x1B, 0xB5, 0xF0, 
+0xD1, 0x0C, 0xB4, 0x43, 0x05, 0x1D, 0x53, 0xB3, 
+0xA2, 0x5B, 0x9E, 0x2A, 0x07, 0x29, 0xF0, 0x4D, 
+0x43, 0xB7, 0xBA, 0xEF, 0xCB, 0x5F, 0x04, 0x82, 
+0x29, 0x6E, 0x97, 0xE6, 0x76, 0xA2, 0xD0, 0x1A, 
+0xF1, 0xC8, 0x6A, 0xFB, 0x9D, 0xE6, 0x55, 0x1F, 
+0x2E, 0x41, 0x2F, 0x93, 0x79, 
+
+0xA1, 0x5F, 0x0A, 0x3D, 0x7F, 0x8F, 0x9E, 0xF1, 
+0x4B, 0x54, 0x58, 0x89, 0x7A, 0x92, 0xFF, 0x73, 
+0xFD, 0x69, 0x5B, 0x08, 0xAA, 0x59, 0x55, 0xE6, 
+0xB0, 0xC7, 0x53, 0x8F, 0x0C, 0x31, 0x10, 0x9A, 
+0xC5, 0xF9, 0x45, 0x4B, 0x44, 0xBC, 0x4E, 0xEF, 
+0x49, 0x35, 0xF5, 0x00, 0x41, 0x2F, 0x9C, 0xDB, 
+0x34, 0xAB, 0xE5, 0xC5, 0x4C, 0x40, 0xB9, 0xE4, 
+0x61, 0x2E, 0xFA, 0x8B, 0x19, 0x74, 0x56, 0x21, 
+0x0D, 0x3F, 0x6C, 0xB4, 0xDF, 0x19, 0xE9, 0x4C, 
+0xEB, 0x8E, 0x62, 0xAD, 0xF6, 0xBE, 0xC8, 0xAA, 
+0xE8, 0x73, 0x64, 0xFE, 0xCF, 0x22, 0xA5, 0x9D, 
+0xB4, 0x7F, 0x7C, 0x05, 0x91, 0xE7, 0x81, 0x91, 
+0x72, 0xF7, 0x86, 0x83, 0x06, 0xEA, 0x34, 0xC9, 
+0x4D, 0xEA, 0x5D, 0x11, 0x68, 0x9B, 0x82, 0x68, 
+0x7B, 0x09, 0xB9, 0x23, 0x94, 0x72, 0xF8, 0x6C, 
+0x2C, 0xC6, 0x7E, 0xE4, 0x3F, 0xA6, 0xCD, 0xC7, 
+0xE0, 0x96, 0x01, 0xFA, 0x31, 0x74, 0x76, 0x32, 
+0x38, 0x73, 0x57, 0xCF, 0xB6, 0xA7, 0x01, 0x55, 
+0xD7, 0xA6, 0x81, 0x14, 0x66, 0xFC, 0x28, 0xA9, 
+0x7A, 0x84, 0x22, 0x72, 0xDF, 0x12, 0xEF, 0x93, 
+0xFD, 0x6F, 0x1D, 0x96, 0x41, 0x71, 
+
+0xB6, 0x4E, 0xE7, 0x03, 0xC0, 0x77, 0xF0, 0xAE, 
+0x79, 0xEF, 0x06, 0xAF, 0x80, 0x2F, 0xB3, 0x68, 
+0x90, 0xD7, 0x5A, 0x18, 0xB5, 0x6B, 0x5D, 0x42, 
+0x1D, 0x41, 0xA2, 0xAF, 0xB8, 0xF6, 0x1A, 0x9C, 
+0x33, 0x50, 0x24, 0x6F, 0x32, 0xAF, 0x16, 0xAD, 
+0x53, 0xEB, 0xDF, 0x36, 0xB0, 0x6A, 0x92, 0xAC, 
+0x35, 0x43, 0x55, 0x25, 0xE4, 0x40, 0x80, 0x6D, 
+0xAC, 0x39, 0x94, 0x2B, 0x3E, 0xFC, 0xAC, 0x00, 
+0xAD, 0xF0, 0x48, 0xC9, 0xD1, 0xEF, 0x4D, 0xAA, 
+0x77, 0x1F, 0x92, 0xE2, 0x43, 0x06, 0x8A, 0xB0, 
+0x81, 0xA3, 0xDF, 0xDF, 0xA2, 0x82, 0xE0, 0x4C, 
+0xC7, 0x70, 0x89, 0x99, 0x69, 0x86, 0xC3, 0xE3, 
+0x36, 0xBB, 0x6F, 0xB5, 0xD3, 0xC6, 0x13, 0xA3, 
+0x78, 0x0A, 0xFC, 0xA8, 0xF7, 0x6B, 0xB7, 0x0F, 
+0x93, 0x01, 0x29, 0x98, 0xFE, 0x2B, 0x9E, 0xAA, 
+0x61, 0x6F, 0x98, 0x03, 0x80, 0x9C, 0x06, 0x51, 
+0x51, 0x30, 0xF9, 0xB0, 0x35, 0x7A, 0xC6, 0xB4, 
+0x62, 0x90, 0x7D, 0x5E, 0x27, 0x1E, 0x8A, 0xB6, 
+0x39, 0x75, 0xC0, 0x88, 0x71, 0x73, 0x70, 0x32, 
+0xFF, 0x3D, 0xD4, 0x5E, 0x5B, 0x08, 0xB5, 0x05, 
+0x25, 0xE2, 0x05, 0xD7, 0x49, 0x93, 0x30, 
+
+0xF2, 0x47, 0x5B, 0xED, 0x81, 0x25, 0x5C, 0x90, 
+0x5A, 0x1A, 0x4A, 0x13, 0x2E, 0xDF, 0xF8, 0xC7, 
+0xF0, 0x5B, 0x63, 0xFD, 0xA6, 0x87, 0xCA, 0x35, 
+0x50, 0xEF, 0xFA, 0x69, 0x29, 0x69, 0x44, 0xED, 
+0x3A, 0xDD, 0xDF, 0x31, 0xC9, 0x1B, 0x66, 0x27, 
+0x83, 0xC5, 0xE4, 0x80, 0xB8, 0x19, 0xFE, 0x13, 
+0x3A, 0x09, 0xE6, 0x96, 0xAA, 0x09, 0x37, 0x92, 
+0xD3, 0xF9, 0xAC, 0x7E, 0x96, 0xD2, 0x14, 0xA4, 
+0xEE, 0xD5, 0xEC, 0x4F, 0x14, 0xED, 0x3B, 0x68, 
+0x63, 0xB6, 0xC2, 0x55, 0xC9, 0xE7, 0xA1, 0x93, 
+0xAD, 0x24, 0xA8, 0x48, 0x75, 0xB9, 0xA4, 0xFB, 
+0x26, 0x1D, 0xE8, 0x6A, 0x91, 0x24, 0xCF, 0x29, 
+0x52, 0x9A, 0xF2, 0xAB, 0x79, 0x66, 0xA8, 0x50, 
+0x11, 0x28, 0x41, 0xD4, 0xBD, 0x5F, 0x8B, 0x3D, 
+0x6E, 0xE9, 0x2F, 0xD6, 0x5E, 0x05, 0x33, 0x41, 
+0x79, 0x7E, 0xD8, 0x35, 0x56, 0x98, 0xB4, 0x74, 
+0x0C, 0xD9, 0xE0, 0x1F, 0xD8, 0xE9, 0x00, 0xC5, 
+0x2A, 0xA1, 0x39, 0x7F, 0x19, 0x9A, 0x95, 0xA3, 
+0x4E, 0xF5, 0xF7, 0xF6, 0x43, 0xAA, 0x05, 0x2B, 
+0x6A, 0x9D, 0x3A, 0x0D, 0xB6, 0xFA, 0x9D, 0x29, 
+0x23, 0xED, 0x73, 0xE7, 0x69, 0xD9, 0xE8, 0x2A, 
+
+0x05, 0x6E, 0x8E, 0xAF, 0xB7, 0x2B, 0x13, 0xDA, 
+0x19, 0x73, 0xB6, 0x60, 0x74, 0x7E, 0x14, 0x93, 
+0x6E, 0x67, 0xB7, 0x5B, 0xD0, 0x24, 0xE0, 0xCF, 
+0xBB, 0x5A, 0x22, 0x89, 0x2C, 0x86, 0x73, 0x87, 
+0x96, 0x04, 0xC4, 0x66, 0xCD, 0xC5, 0x25, 0x8C, 
+0xF2, 0x8E, 0xB8, 0xB8, 0x65, 0xB1, 0xD0, 0xBD, 
+0xF6, 0x51, 0xE3, 0x70, 0x13, 0x53, 0x51, 0xA9, 
+0x71, 0x9E, 0x46, 0xC5, 0xAC, 0x31, 0xB9, 0xED, 
+0xF4, 0x65, 0xFB, 0x86, 0xB1, 0x00, 0x12, 0x32, 
+0x90, 0x84, 0x60, 0x80, 0x23, 0xF4, 0xDE, 0x1A, 
+0x21, 0xDB, 0x7F, 0x9B, 0xAE, 0x4C, 0x23, 0x10, 
+0xE0, 0x97, 0x0D, 0x5A, 0x0A, 0x3A, 0x4D, 0x06, 
+0x0A, 0xE4, 0xB4, 0x46, 0x49, 0x3E, 0xBE, 0x14, 
+0x23, 0xA8, 0x7A, 0xA8, 0x8A, 0xA0, 0x01, 0x64, 
+0x61, 0x37, 0xF9, 0x7B, 0x00, 0x70, 0x38, 0x04, 
+0xC9, 0x19, 0x04, 0x13, 0xDC, 0x34, 0x77, 0x84, 
+0x54, 0xD0, 0xA6, 0x2A, 0x17, 0x34, 0x86, 0x97, 
+0x92, 0x1B, 0x19, 0x75, 0x95, 0x30, 0xB3, 0x88, 
+0xEF, 0x17, 0x0D, 0x5E, 0xEC, 0x42, 0x00, 0x78, 
+0xF3, 0x5B, 0x41, 0xFD, 0x93, 0x4E, 0x30, 0x9B, 
+0xF0, 0x52, 0xDD, 0x3F, 0x82, 0xE4, 0x8E, 0x7B, 
+0xE7, 
+
+0x6D, 0x6C, 0x34, 0xD0, 0x4C, 0xD0, 0xE2, 0x64, 
+0x60, 0x27, 0x26, 0xD3, 0xA8, 0x6F, 0x44, 0x99, 
+0xAD, 0x1E, 0x2D, 0xB6, 0x4E, 0x61, 0x2B, 0xFA, 
+0x6B, 0x5E, 0x51, 0x32, 0xD9, 0x36, 0x6C, 0x7C, 
+0x3F, 0x60, 0x2E, 0x9E, 0x51, 0xBB, 0xC2, 0x02, 
+0x29, 0x23, 0x29, 0x24, 0x60, 0xA1, 0x3B, 0x83, 
+0xAF, 0xC2, 0x2A, 0x88, 0xB6, 0xC8, 0x03, 0x08, 
+0xAF, 0x9F, 0x95, 0x57, 0x2D, 0xB1, 0x02, 0x29, 
+0x64, 0x44, 0xE3, 0x99, 0x0A, 0x2C, 0x62, 0xB3, 
+0x8A, 0x2B, 0x74, 0xEE, 0xD5, 0xE3, 0xD3, 0x21, 
+0x6B, 0xCD, 0x51, 0xE5, 0xA3, 0x6E, 0xE0, 0xAF, 
+0x47, 0xC3, 0x2F, 0xDD, 0xF7, 0x0A, 0xB2, 0xB8, 
+0xDF, 0x7F, 0x51, 0x82, 0xF5, 0x85, 0x8F, 0x37, 
+0x6E, 0x61, 0xB3, 0x9C, 0xCF, 0x5C, 0xA1, 0x4F, 
+0x50, 0x31, 0x63, 0x18, 0x69, 0x12, 0x85, 0x4F, 
+0x0D, 0xA9, 0x4C, 0x30, 0x57, 0xBB, 0x9F, 0xC1, 
+0xCF, 0x9A, 0x81, 0x54, 0xE8, 0xD3, 0x22, 0xA8, 
+0x92, 0xA4, 0x71, 0x67, 0x20, 0x65, 0x9C, 0xD6, 
+0x9B, 0xA4, 0xFD, 0xE6, 0xE5, 0x0C, 0x26, 0x44, 
+0x5E, 0x28, 0xE3, 0x90, 0x7B, 0xED, 0x11, 0x04, 
+0x62, 0x7D, 0xCE, 0x26, 0xCF, 0xDF, 0x3D, 0xCE, 
+0x3E, 0xD2, 
+
+0xC9, 0x5B, 0x97, 0xFB, 0x51, 0xD7, 0x31, 0x51, 
+0x9C, 0x2C, 0x29, 0x55, 0xDA, 0xE0, 0x78, 0x9C, 
+0x13, 0x83, 0x44, 0xDE, 0x4E, 0xDB, 0xED, 0xA7, 
+0xAE, 0x7A, 0x5C, 0x99, 0xB3, 0x10, 0x3B, 0xDA, 
+0xFC, 0xB1, 0x5A, 0x67, 0x1A, 0xF4, 0xC5, 0x7B, 
+0xE1, 0x75, 0x6A, 0xDF, 0x54, 0x1E, 0xEC, 0x42, 
+0x06, 0xF0, 0xE4, 0x9F, 0xF4, 0xAF, 0x9C, 0x8C, 
+0x87, 0x7E, 0xF1, 0x6F, 0xC7, 0xA0, 0x39, 0x0B, 
+0x8C, 0xA9, 0xED, 0xF5, 0x8D, 0xF3, 0xDA, 0x13, 
+0x79, 0x65, 0xED, 0x7D, 0xD1, 0x10, 0x23, 0xC2, 
+0xDE, 0xE8, 0xFD, 0xBD, 0x44, 0xCE, 0x4C, 0xE3, 
+0x0F, 0x49, 0x3F, 0xC3, 0x1B, 0x2E, 0x47, 0x0B, 
+0x11, 0x3E, 0x1F, 0x2B, 0x50, 0xBA, 0x22, 0x17, 
+0x25, 0xB2, 0x10, 0x29, 0x46, 0x9B, 0x19, 0xAA, 
+0x91, 0x49, 0x5D, 0x82, 0xDB, 0x33, 0x01, 0xA3, 
+0x44, 0x10, 0x31, 0xA2, 0x93, 0x19, 0x5B, 0xFF, 
+0x28, 0xA4, 0x2A, 0x03, 0xF1, 0x1C, 0x0C, 0xB8, 
+0xD3, 0xD6, 0x6A, 0x2D, 0xBB, 0xD8, 0xCF, 0x76, 
+0x16, 0x12, 0xA9, 0xCF, 0x63, 0x61, 0xF4, 0x0F, 
+0x71, 0x9E, 0xA6, 0xBF, 0xBE, 0x08, 0x6E, 0x80, 
+0xC6, 0x07, 0xF3, 0x35, 0x8B, 0xFE, 0x1F, 0xAD, 
+0x6A, 0x51, 0x51, 
+
+0xE3, 0x15, 0xF7, 0x78, 0x24, 0xEF, 0x71, 0x94, 
+0xF4, 0xAE, 0x5B, 0x1D, 0x8C, 0xF2, 0x67, 0x93, 
+0xD8, 0x56, 0x51, 0x52, 0xD5, 0x46, 0xAF, 0xAF, 
+0xF5, 0x23, 0x3A, 0xE4, 0x62, 0xE0, 0x8A, 0x6F, 
+0xDA, 0x3C, 0x17, 0xB3, 0x76, 0xCC, 0x0C, 0x5C, 
+0x38, 0x84, 0x35, 0xE3, 0x48, 0x47, 0x7F, 0x19, 
+0x3E, 0xFA, 0xD6, 0x34, 0x98, 0x18, 0x3E, 0xFC, 
+0x08, 0x2A, 0x4B, 0xC5, 0xB4, 0x11, 0x6E, 0x22, 
+0xCF, 0x24, 0x82, 0xFD, 0xC3, 0xB0, 0x40, 0x4A, 
+0xAA, 0xD4, 0xA1, 0x20, 0x16, 0x64, 0xD4, 0x2D, 
+0xCA, 0xCC, 0x9C, 0x03, 0x21, 0xFF, 0x9A, 0x8C, 
+0x81, 0x18, 0x20, 0xEC, 0xED, 0x38, 0x50, 0x58, 
+0xBF, 0x19, 0x17, 0x97, 0x44, 0x00, 0xD3, 0x39, 
+0x2C, 0x14, 0xE6, 0xC3, 0x48, 0xF6, 0x9B, 0xA2, 
+0x8C, 0x0C, 0x67, 0x0B, 0x28, 0x5A, 0xA5, 0xFB, 
+0x2E, 0xAD, 0x68, 0x44, 0xC6, 0x50, 0xEE, 0x74, 
+0x1A, 0xF5, 0x76, 0xA4, 0x8B, 0x46, 0x13, 0x70, 
+0xB9, 0x21, 0xE3, 0x2B, 0xFD, 0xCA, 0x40, 0xE1, 
+0xE3, 0x05, 0xB3, 0x6E, 0x72, 0x56, 0x8F, 0x5B, 
+0x1F, 0x76, 0x3E, 0x30, 0x7B, 0xAF, 0xDE, 0xD3, 
+0xF7, 0x8E, 0xF2, 0x10, 0xDB, 0x52, 0x9F, 0x42, 
+0x15, 0xEB, 0x9B, 0xA9, 
+
+0xF6, 0x45, 0x27, 0xF4, 0xD6, 0xE9, 0x72, 0xD4, 
+0x62, 0xDC, 0x12, 0x71, 0xF1, 0x95, 0x43, 0xA0, 
+0xD6, 0x2D, 0xAF, 0xF3, 0x0B, 0x92, 0x61, 0x77, 
+0xBE, 0xC7, 0xEF, 0xA5, 0x38, 0xCD, 0x68, 0x98, 
+0x3A, 0xEC, 0x5E, 0x2F, 0x33, 0xE1, 0x50, 0xEC, 
+0xF2, 0xE8, 0x99, 0x10, 0xAD, 0xE0, 0xCB, 0x57, 
+0x22, 0x52, 0x4E, 0xE7, 0x1F, 0x90, 0xD9, 0xCA, 
+0xE8, 0x19, 0x7B, 0x5A, 0xF0, 0xB8, 0x8B, 0xB3, 
+0x3A, 0x2F, 0x95, 0x2A, 0x17, 0x95, 0x4D, 0xD2, 
+0xE4, 0xFA, 0xD9, 0x7B, 0xBF, 0xBF, 0x4E, 0x71, 
+0xE5, 0x58, 0xBB, 0x0C, 0x78, 0x89, 0x73, 0x68, 
+0x36, 0xF7, 0x15, 0x24, 0x9B, 0x5C, 0x52, 0xAE, 
+0xF5, 0x2A, 0x61, 0x7E, 0x57, 0x2F, 0xCF, 0x0C, 
+0xCA, 0xA7, 0xAF, 0x9C, 0xA8, 0x29, 0x2F, 0x88, 
+0xBD, 0x8A, 0x71, 0x9F, 0xCF, 0x40, 0x09, 0x7B, 
+0xBF, 0x82, 0xAC, 0x7C, 0xBA, 0xEC, 0x65, 0xBC, 
+0x36, 0xB8, 0xDB, 0x94, 0x0E, 0x01, 0xFB, 0xAF, 
+0x4C, 0x1F, 0x77, 0xE9, 0xA1, 0x86, 0xEC, 0x1D, 
+0xB2, 0x0D, 0xA7, 0x19, 0xC5, 0xF4, 0x26, 0xCD, 
+0x36, 0x22, 0x27, 0x0E, 0xC9, 0x07, 0xBA, 0xDF, 
+0xF3, 0x3F, 0xF0, 0x19, 0x55, 0x18, 0x71, 0x48, 
+0x78, 0xD4, 0x1B, 0x1B, 0x86, 
+
+0x3B, 0x4E, 0x75, 0xDE, 0x46, 0x9C, 0xDF, 0x27, 
+0x77, 0x45, 0x03, 0x89, 0x6D, 0x26, 0x30, 0xE8, 
+0xE9, 0xA8, 0x67, 0x92, 0x22, 0x06, 0xC3, 0x2D, 
+0xDF, 0xBD, 0x97, 0x93, 0x9E, 0x9A, 0xB3, 0xDA, 
+0x98, 0x44, 0x9A, 0x36, 0x3F, 0x8D, 0x67, 0xB4, 
+0xEB, 0xB9, 0xA8, 0x2C, 0xA7, 0x76, 0x5B, 0x44, 
+0xE1, 0xEA, 0x01, 0x35, 0x39, 0xE1, 0xCF, 0xC8, 
+0x49, 0xF1, 0x3E, 0x2A, 0x4C, 0x3E, 0x08, 0x6A, 
+0xA5, 0xCE, 0xF8, 0x7A, 0x3C, 0xE2, 0x2B, 0x6C, 
+0x97, 0xF0, 0x58, 0xA5, 0xA1, 0x91, 0x7B, 0x67, 
+0xBA, 0xEF, 0x21, 0x07, 0xB0, 0xA1, 0x0B, 0x53, 
+0x4F, 0xED, 0x07, 0xA7, 0xC6, 0x69, 0xDD, 0x13, 
+0x08, 0xDB, 0xA5, 0x33, 0x2A, 0x67, 0x26, 0x07, 
+0xFC, 0x1C, 0x15, 0x80, 0xCB, 0x3F, 0x6D, 0x6C, 
+0x02, 0x82, 0x21, 0xFF, 0x6D, 0x7E, 0x36, 0xBD, 
+0xD1, 0x27, 0x5A, 0xD3, 0x86, 0x52, 0x62, 0x25, 
+0xD2, 0xC1, 0xB0, 0x3F, 0x0B, 0x58, 0x76, 0xAD, 
+0xB7, 0x44, 0xCA, 0x6E, 0x12, 0x0D, 0x07, 0x10, 
+0x62, 0x85, 0x5D, 0x28, 0x48, 0xC7, 0xAF, 0x06, 
+0x4E, 0x4E, 0x88, 0xC6, 0x3F, 0x22, 0xE2, 0x6C, 
+0x5E, 0xDE, 0xBC, 0x1B, 0x3D, 0xFA, 0xFF, 0x78, 
+0xE9, 0xE2, 0xFF, 0xB9, 0xFB, 0x81, 
+
+0x81, 0x30, 0xD0, 0xFB, 0x58, 0xE5, 0x63, 0xC5, 
+0x54, 0xAE, 0xFE, 0x7F, 0xF0, 0x06, 0x15, 0xB4, 
+0x17, 0x9E, 0x33, 0xC2, 0x73, 0x4B, 0x57, 0x1E, 
+0xB8, 0xF9, 0x38, 0xE1, 0x87, 0xA1, 0x0F, 0xAE, 
+0x8C, 0x88, 0xDF, 0xC0, 0xB0, 0xC2, 0x7B, 0x0A, 
+0x62, 0x18, 0x74, 0x92, 0x99, 0x31, 0x02, 0x71, 
+0x6D, 0x8B, 0x13, 0x25, 0x81, 0x7C, 0xDD, 0x28, 
+0xD0, 0xD2, 0x53, 0x46, 0x0A, 0x3A, 0x71, 0x6B, 
+0xA6, 0x36, 0x7C, 0x2D, 0xA4, 0x60, 0x01, 0x81, 
+0xFE, 0x79, 0x9C, 0xD3, 0xD1, 0x83, 0x10, 0x96, 
+0x18, 0xF0, 0x34, 0xC4, 0xC1, 0x6E, 0x60, 0xA5, 
+0xC3, 0x09, 0x1A, 0x42, 0xDB, 0xEE, 0x95, 0x6F, 
+0x99, 0x93, 0x41, 0x53, 0x4C, 0xAB, 0x6A, 0x0C, 
+0xBD, 0x36, 0xE0, 0xA9, 0x48, 0xCD, 0x7E, 0x4F, 
+0xC6, 0xF8, 0x16, 0xC2, 0x97, 0x6A, 0x4A, 0x7C, 
+0x3A, 0xF2, 0x3B, 0x7D, 0xB1, 0x5B, 0x97, 0x34, 
+0x40, 0xFC, 0x7D, 0x81, 0x2F, 0x3D, 0x1A, 0x21, 
+0x70, 0x3C, 0x0D, 0xD8, 0xB0, 0x70, 0xE7, 0x44, 
+0xE4, 0x5A, 0xEF, 0x84, 0xC9, 0xEB, 0x83, 0x48, 
+0x7C, 0x1A, 0x3C, 0x17, 0x06, 0xC8, 0x97, 0x4D, 
+0xBA, 0x1B, 0xD6, 0xBD, 0x00, 0xB5, 0xF7, 0x87, 
+0x99, 0x43, 0xC3, 0x07, 0x5D, 0x5E, 0xFB, 
+
+0xCF, 0x40, 0x8A, 0x54, 0x5E, 0x1F, 0x1D, 0xCB, 
+0xAA, 0xA4, 0x5D, 0x47, 0x84, 0xBF, 0x9F, 0xF5, 
+0x65, 0x66, 0x3C, 0x28, 0xFA, 0x91, 0xAC, 0x15, 
+0xD0, 0xFA, 0xD8, 0x20, 0x3E, 0xF0, 0x24, 0x1D, 
+0xDF, 0xF4, 0xC9, 0x17, 0xA2, 0x16, 0x73, 0x3F, 
+0xBB, 0xBE, 0x69, 0x7B, 0x13, 0x41, 0x56, 0xF0, 
+0xFB, 0x81, 0x50, 0xCC, 0x38, 0x0F, 0xEE, 0x2C, 
+0x01, 0xFD, 0x11, 0x46, 0xA0, 0xAF, 0xA8, 0xA3, 
+0xE3, 0xB1, 0xF6, 0x8D, 0x2B, 0xEC, 0xDE, 0x5A, 
+0xEF, 0x17, 0x33, 0xA3, 0x9F, 0xA3, 0xBC, 0x17, 
+0x52, 0xAF, 0x07, 0xF2, 0x76, 0xB1, 0x21, 0xA6, 
+0x70, 0x8D, 0xAB, 0xB4, 0x0D, 0xED, 0x28, 0xA2, 
+0x5D, 0x35, 0x46, 0x1D, 0xD3, 0xF2, 0x4B, 0xDA, 
+0x52, 0xC9, 0xBD, 0xE8, 0x34, 0x30, 0x7A, 0xE3, 
+0xCE, 0x81, 0x10, 0x36, 0x5F, 0x2F, 0x5C, 0x09, 
+0x69, 0x2D, 0xED, 0xBF, 0x67, 0x76, 0x8C, 0x3E, 
+0x7C, 0x28, 0x2E, 0x43, 0xEE, 0x5A, 0xC3, 0x9B, 
+0x04, 0xCC, 0x6C, 0x11, 0xE2, 0xDF, 0x9F, 0x4B, 
+0x78, 0x65, 0x0E, 0xC4, 0x1A, 0xF2, 0x92, 0x4F, 
+0xD9, 0x1C, 0x37, 0x3E, 0xAA, 0x9B, 0x20, 0xAD, 
+0x51, 0x78, 0x64, 0x88, 0x7F, 0xF0, 0x9E, 0x81, 
+0xD6, 0x1D, 0x65, 0x41, 0xE8, 0xA7, 0xCA, 0xAC, 
+
+0xEE, 0xBC, 0xA6, 0x66, 0xC5, 0x88, 0x48, 0x10, 
+0x14, 0x1D, 0x04, 0x37, 0xEF, 0xAE, 0x27, 0xE9, 
+0x83, 0x3D, 0xBB, 0x15, 0x84, 0x9F, 0x8A, 0x38, 
+0x1D, 0x87, 0x67, 0x0C, 0xEC, 0x3A, 0x4F, 0x51, 
+0xF2, 0x32, 0x49, 0x6B, 0x42, 0x68, 0xD5, 0x0E, 
+0x7C, 0xDA, 0x8B, 0x26, 0x83, 0x58, 0x8D, 0xA0, 
+0x5E, 0x7B, 0x08, 0xC0, 0x36, 0x49, 0xA8, 0x57, 
+0x80, 0xC6, 0x42, 0x2B, 0x39, 0x93, 0x92, 0xC2, 
+0xB3, 0xCD, 0x62, 0x7A, 0x50, 0xEF, 0x0C, 0x16, 
+0x20, 0xBA, 0x73, 0x25, 0xA9, 0xBC, 0xDC, 0xA3, 
+0x12, 0x79, 0xBF, 0xEE, 0x80, 0x1D, 0x9B, 0x02, 
+0x61, 0x8F, 0x2A, 0x55, 0xD3, 0x6E, 0x03, 0x44, 
+0xD2, 0xFB, 0xE8, 0xF4, 0x64, 0xE3, 0xF4, 0x76, 
+0xC3, 0x68, 0xAA, 0xCE, 0xEF, 0xE4, 0x06, 0x01, 
+0x52, 0x53, 0xCE, 0xB8, 0x32, 0xEC, 0xB6, 0x0C, 
+0xCB, 0x5B, 0x0B, 0xCA, 0x1F, 0xB5, 0x93, 0x25, 
+0x81, 0xB6, 0xED, 0xC4, 0x3C, 0x68, 0xC9, 0xC0, 
+0xAE, 0x4E, 0x0C, 0x72, 0xF5, 0x93, 0x1C, 0x31, 
+0x87, 0x0D, 0x25, 0x96, 0xC0, 0x86, 0xBE, 0x2C, 
+0xBF, 0xD2, 0x3C, 0x0D, 0xCA, 0x31, 0x2F, 0xBF, 
+0x1C, 0xB5, 0xCC, 0x97, 0x12, 0xEE, 0x1C, 0x3D, 
+0x40, 0xB2, 0x2C, 0x5C, 0xDD, 0x56, 0xF3, 0x6B, 
+0x7D, 
+
+0x94, 0xB0, 0x6E, 0x2B, 0xA7, 0xAA, 0xEB, 0x62, 
+0x97, 0x41, 0xE9, 0x64, 0x1F, 0x01, 0x2A, 0xAB, 
+0xC9, 0x97, 0x19, 0x60, 0x78, 0x4D, 0x8F, 0x7F, 
+0xAD, 0x12, 0x99, 0xF6, 0x82, 0xE0, 0x12, 0x26, 
+0x40, 0x6C, 0xE9, 0x4F, 0xB3, 0xA1, 0x62, 0xFC, 
+0xDD, 0x18, 0xD7, 0x2C, 0xBE, 0xDC, 0xA2, 0x67, 
+0xF1, 0xF5, 0x2A, 0x01, 0x56, 0xE5, 0x4F, 0x6E, 
+0x18, 0xD6, 0xCD, 0xDB, 0x72, 0x18, 0xDD, 0xD0, 
+0xD2, 0xFB, 0xD1, 0x02, 0x86, 0x8C, 0x00, 0xF2, 
+0xC4, 0xEB, 0xE8, 0xC6, 0x83, 0xC5, 0x3C, 0x98, 
+0x71, 0xED, 0xC4, 0xF5, 0x6E, 0xF4, 0x1A, 0x77, 
+0x95, 0x7B, 0xB9, 0x07, 0x3B, 0xBF, 0xA1, 0x3C, 
+0x7F, 0x4C, 0x76, 0x32, 0xA3, 0xB3, 0xC0, 0x19, 
+0xF7, 0x58, 0x7A, 0xC3, 0x00, 0x4B, 0x7A, 0x32, 
+0xF4, 0xD1, 0x73, 0xE0, 0xC9, 0xB1, 0xBB, 0x1E, 
+0x72, 0x8A, 0xC7, 0x5E, 0xE1, 0xD0, 0xA0, 0x84, 
+0xD0, 0xD3, 0xBD, 0x5F, 0x88, 0x04, 0xDD, 0xF4, 
+0x71, 0xB5, 0x04, 0x90, 0xEF, 0x8C, 0x5C, 0xEB, 
+0xA7, 0x48, 0xE7, 0x17, 0xE8, 0xED, 0x32, 0xE8, 
+0x3F, 0xA9, 0xB2, 0xAF, 0x9A, 0x59, 0x72, 0xC1, 
+0x83, 0x15, 0x32, 0x26, 0xB7, 0x32, 0x8F, 0x68, 
+0x03, 0x2C, 0x46, 0xF0, 0x78, 0xE5, 0x3C, 0xFB, 
+0x4E, 0x06, 
+
+0x07, 0x69, 0x04, 0xFD, 0x0C, 0x71, 0x8B, 0x5E, 
+0xE0, 0x5D, 0x84, 0xBE, 0xFB, 0xCD, 0x25, 0xB0, 
+0x30, 0x40, 0x84, 0x02, 0x73, 0x86, 0x8E, 0x67, 
+0x2B, 0x97, 0x37, 0xAF, 0x2B, 0xF9, 0xE6, 0xA6, 
+0x65, 0x7F, 0x50, 0xFC, 0x77, 0x26, 0x18, 0x3A, 
+0x78, 0xD3, 0xC7, 0xB6, 0x16, 0x2A, 0xF4, 0x05, 
+0x0F, 0x30, 0x8E, 0x17, 0x61, 0xA6, 0x87, 0x13, 
+0x1E, 0x1C, 0x4E, 0x56, 0x4E, 0x64, 0xA5, 0x9D, 
+0x55, 0x9A, 0xD3, 0x83, 0x3D, 0x15, 0x99, 0xBA, 
+0xE9, 0xD6, 0xDE, 0x8F, 0xE7, 0xC4, 0xD8, 0xD5, 
+0xBD, 0x6A, 0x8A, 0xCF, 0xC0, 0x18, 0xC9, 0x13, 
+0xCB, 0x7C, 0xCE, 0x72, 0xF3, 0x5A, 0xA0, 0x80, 
+0xE4, 0x80, 0xEC, 0x12, 0xF4, 0xD7, 0xA8, 0xB6, 
+0x49, 0x5D, 0x93, 0xD9, 0xD3, 0xF1, 0x13, 0xC0, 
+0x24, 0x63, 0x66, 0x9E, 0xE0, 0x00, 0xD5, 0x9C, 
+0x68, 0x87, 0x90, 0x1C, 0xC6, 0x41, 0x56, 0xFB, 
+0x46, 0xBE, 0x81, 0xEF, 0x66, 0xE6, 0x48, 0x5A, 
+0xA0, 0x85, 0xC4, 0xF9, 0x0B, 0xD7, 0x4D, 0xA2, 
+0x79, 0xA1, 0x4D, 0x4D, 0x3C, 0xDF, 0xC2, 0xF8, 
+0x15, 0xAD, 0xAC, 0xA3, 0xC3, 0x00, 0xAA, 0x3D, 
+0x97, 0xC1, 0x8E, 0xF5, 0x42, 0xBD, 0xCE, 0x6F, 
+0x11, 0xC9, 0x08, 0x80, 0xC2, 0x4D, 0x9F, 0x09, 
+0xF4, 0xBD, 0x90, 
+
+0x6E, 0x9E, 0x63, 0x62, 0xEF, 0x92, 0xB3, 0x1A, 
+0x63, 0x09, 0x3D, 0x06, 0x39, 0x71, 0x5A, 0x59, 
+0x3F, 0x0D, 0x17, 0x56, 0x05, 0xBB, 0x97, 0xAE, 
+0x1F, 0x95, 0xB5, 0x85, 0x2B, 0x36, 0xF5, 0xBC, 
+0xCC, 0x62, 0x2E, 0x7A, 0x80, 0xB8, 0x98, 0xE6, 
+0xD0, 0xC4, 0xCA, 0xD0, 0xD2, 0x1B, 0x03, 0x9A, 
+0x9A, 0xD9, 0xCF, 0x33, 0x90, 0xD3, 0x91, 0x4F, 
+0x18, 0x98, 0x51, 0x7B, 0xC6, 0x9B, 0x70, 0xA8, 
+0x8A, 0x0B, 0x8A, 0x7F, 0xCE, 0x3F, 0x0B, 0x6C, 
+0x80, 0xF3, 0x8C, 0x8F, 0xD0, 0xF1, 0x78, 0x55, 
+0x8A, 0xDF, 0x6B, 0x5B, 0xCA, 0x20, 0xC4, 0x2B, 
+0x07, 0x9C, 0xDB, 0x09, 0xA7, 0x8E, 0xAE, 0x9F, 
+0xB3, 0xE2, 0xE3, 0xEB, 0xE3, 0xF4, 0x2B, 0x43, 
+0xCD, 0x61, 0x01, 0x2B, 0x41, 0x51, 0xC1, 0xD4, 
+0x5B, 0x7D, 0x03, 0xC0, 0x97, 0x25, 0x5E, 0x72, 
+0x0C, 0x52, 0xAC, 0x6D, 0x3C, 0x29, 0xF7, 0xFD, 
+0xE1, 0x09, 0x64, 0xF5, 0xEF, 0x69, 0x1A, 0x9D, 
+0x41, 0xE3, 0x35, 0x30, 0xEE, 0x38, 0x56, 0x24, 
+0x1E, 0xBC, 0x95, 0x7B, 0x61, 0x16, 0xB0, 0x5D, 
+0xBF, 0x75, 0x69, 0xF7, 0xE5, 0x01, 0xE0, 0xF6, 
+0x9C, 0xD6, 0x9D, 0xDE, 0xBC, 0xD6, 0x6F, 0x89, 
+0xE2, 0x12, 0x2D, 0x84, 0x5C, 0x15, 0x97, 0x99, 
+0x13, 0xFF, 0xB5, 0x2B, 
+
+0x28, 0x3C, 0x5A, 0xAC, 0x84, 0xD2, 0x83, 0x17, 
+0xA5, 0x52, 0x63, 0xCD, 0x7B, 0x57, 0x8B, 0xE6, 
+0x97, 0xB3, 0x3D, 0x90, 0xB6, 0x27, 0xF6, 0xB9, 
+0xC7, 0x1F, 0x98, 0x33, 0xE2, 0x1D, 0xEC, 0xFF, 
+0x87, 0x0A, 0x6F, 0x5D, 0x88, 0x4C, 0xC3, 0xE5, 
+0x44, 0xF6, 0x23, 0x35, 0x0C, 0xCB, 0x44, 0xA7, 
+0xFB, 0x9B, 0xDB, 0x58, 0x65, 0xE2, 0x0B, 0x17, 
+0xDC, 0x0D, 0xE9, 0x7D, 0x7A, 0x3F, 0x7D, 0xDF, 
+0x12, 0xA6, 0xFC, 0xD9, 0x17, 0x48, 0x50, 0x5E, 
+0xA2, 0x98, 0x8D, 0x0F, 0xFB, 0x2F, 0x08, 0x4C, 
+0x92, 0x18, 0x68, 0x47, 0xF2, 0x46, 0x02, 0x99, 
+0x5D, 0x86, 0x81, 0xA1, 0x8E, 0x3C, 0x42, 0xF7, 
+0xB2, 0x68, 0xB2, 0x99, 0x5E, 0xF6, 0xDB, 0xDD, 
+0xD2, 0xFB, 0x16, 0x65, 0x45, 0xDA, 0xFE, 0xA2, 
+0x93, 0xBB, 0xFC, 0x3E, 0x8A, 0xFF, 0x08, 0xEF, 
+0xFF, 0xD6, 0x8A, 0xBA, 0xF7, 0x56, 0x81, 0xCB, 
+0x1A, 0x67, 0xC2, 0xD9, 0x5F, 0x10, 0x47, 0x27, 
+0xB2, 0xDB, 0x1E, 0xC0, 0x54, 0x44, 0x64, 0x54, 
+0x31, 0x73, 0x5A, 0xCF, 0xA3, 0x90, 0x35, 0xB5, 
+0xE6, 0x99, 0x4D, 0x52, 0x76, 0x80, 0x28, 0xB6, 
+0xB6, 0xB7, 0xD1, 0x45, 0xA9, 0x39, 0xA7, 0xBE, 
+0x82, 0x13, 0x53, 0xBD, 0x79, 0xC6, 0xA7, 0xB6, 
+0x29, 0xD1, 0x2A, 0x5F, 0x2C, 
+
+0x30, 0x15, 0xDC, 0x38, 0x2B, 0x52, 0xA6, 0x1B, 
+0x50, 0x1E, 0xF5, 0x3C, 0x6D, 0x8D, 0x8D, 0x1F, 
+0x38, 0xA5, 0x4F, 0xA1, 0xC0, 0x5A, 0xAE, 0xA5, 
+0xB3, 0xBD, 0x10, 0xCF, 0xDD, 0x9E, 0x6C, 0xDE, 
+0xC1, 0xE6, 0xFC, 0xC5, 0xCD, 0xEC, 0x60, 0xC7, 
+0x67, 0x64, 0x97, 0x45, 0x4D, 0x5C, 0xD7, 0x90, 
+0xFC, 0x86, 0x04, 0x59, 0x58, 0x3A, 0x6C, 0x39, 
+0x30, 0xAD, 0xFC, 0xFC, 0x33, 0x59, 0x8D, 0x89, 
+0x89, 0x63, 0xE4, 0xA6, 0xBE, 0xA4, 0x02, 0x8B, 
+0x69, 0x3C, 0xD8, 0x89, 0x2D, 0x03, 0xE3, 0x48, 
+0x8F, 0x35, 0xCB, 0xB1, 0x97, 0x4C, 0x84, 0x8B, 
+0x7F, 0x8A, 0x56, 0xA6, 0xA9, 0x5E, 0x60, 0xB2, 
+0x77, 0xAA, 0x61, 0xD2, 0x80, 0xB3, 0xC5, 0xDE, 
+0xCE, 0x81, 0xD2, 0x06, 0x47, 0xCA, 0xDE, 0xF0, 
+0xFD, 0xA5, 0x8A, 0xB0, 0xFD, 0x65, 0x42, 0xD5, 
+0xAB, 0x69, 0xBD, 0xD5, 0xB4, 0x9B, 0xA1, 0x8B, 
+0x52, 0xE3, 0x42, 0xF9, 0xEA, 0x2E, 0xF7, 0x2C, 
+0xE4, 0xD9, 0xAE, 0x91, 0x01, 0xD1, 0x3A, 0x2E, 
+0x49, 0x24, 0xC7, 0x68, 0xC4, 0xC4, 0x5C, 0x5F, 
+0x15, 0xB7, 0x3A, 0x42, 0xED, 0x1F, 0x10, 0xDD, 
+0x70, 0xAA, 0xAD, 0xE6, 0x67, 0x6F, 0x92, 0x56, 
+0x80, 0xC3, 0xA5, 0x56, 0x22, 0xFA, 0x5D, 0x55, 
+0xD0, 0xA5, 0xDF, 0x83, 0xC2, 0x7E, 
+
+0x12, 0xB3, 0x0C, 0x74, 0x61, 0x29, 0xC8, 0xAC, 
+0xEC, 0xAD, 0x93, 0x1C, 0xBE, 0x02, 0x23, 0x6E, 
+0x2A, 0xD7, 0x53, 0x4C, 0x82, 0x61, 0x87, 0x08, 
+0x19, 0xCF, 0x58, 0xDA, 0x26, 0x1C, 0x26, 0xB8, 
+0x65, 0x5F, 0xC3, 0xC2, 0x0A, 0x39, 0xB3, 0x00, 
+0x3F, 0x4C, 0x75, 0x09, 0xD9, 0xF8, 0xD0, 0xF2, 
+0xAE, 0x3A, 0x59, 0x81, 0xA3, 0x6C, 0x12, 0x0F, 
+0xE8, 0x34, 0x3A, 0xB4, 0xBC, 0xE0, 0xEB, 0xCB, 
+0x53, 0xB3, 0xD1, 0x2B, 0x04, 0x01, 0xC9, 0xC9, 
+0xE2, 0x5A, 0x97, 0xF7, 0x2D, 0x5D, 0x63, 0x77, 
+0xA6, 0x4B, 0xA7, 0xF7, 0xE9, 0x8B, 0x6A, 0x06, 
+0x3D, 0x24, 0xA1, 0x46, 0x2A, 0x55, 0x6A, 0x79, 
+0xC1, 0xC4, 0x9C, 0xC5, 0x03, 0x5A, 0xA1, 0xD2, 
+0x65, 0x41, 0xD4, 0x84, 0xB3, 0x2C, 0x25, 0xE7, 
+0xBA, 0xA3, 0x50, 0xFB, 0x39, 0x7A, 0x34, 0x18, 
+0xAD, 0xC0, 0xE9, 0xDB, 0xCE, 0xED, 0xDF, 0x14, 
+0x90, 0x75, 0x2C, 0x18, 0xA8, 0xF7, 0xBE, 0x0C, 
+0x61, 0xF8, 0x25, 0x56, 0x13, 0xF8, 0x6C, 0x64, 
+0x59, 0xE8, 0x9C, 0x47, 0x69, 0xCB, 0x92, 0x2C, 
+0x5B, 0x8C, 0x68, 0x6A, 0xB5, 0xF7, 0x2C, 0x03, 
+0x6F, 0x08, 0xA3, 0x6B, 0x4A, 0xED, 0x92, 0x51, 
+0xBE, 0x22, 0x4E, 0x15, 0xF2, 0xBB, 0x54, 0x36, 
+0x30, 0xCF, 0x02, 0xED, 0x45, 0xCA, 0xB5, 
+
+0xC8, 0x74, 0x14, 0xFC, 0xD8, 0xAA, 0xFA, 0xDE, 
+0xEF, 0x99, 0x14, 0x6B, 0xBC, 0x96, 0x70, 0x48, 
+0x8D, 0x6B, 0x76, 0xA9, 0x4B, 0xD5, 0xD7, 0x0E, 
+0x2C, 0x48, 0xBC, 0x78, 0x57, 0x82, 0xB5, 0x67, 
+0xD5, 0x26, 0xD4, 0xAD, 0x7F, 0x03, 0x3C, 0x36, 
+0x67, 0x41, 0x76, 0xBA, 0xDF, 0x55, 0x0C, 0xA4, 
+0x06, 0x61, 0x61, 0xF3, 0x9E, 0x37, 0x5C, 0x6F, 
+0xA6, 0xB3, 0xA6, 0x72, 0x1D, 0x43, 0xC2, 0xFC, 
+0xA2, 0x08, 0x5F, 0xC6, 0x15, 0x21, 0x62, 0x1E, 
+0x7A, 0x68, 0x37, 0x9D, 0xA6, 0x76, 0xCF, 0x32, 
+0x82, 0xE0, 0xD0, 0xD0, 0x3C, 0xFD, 0x9E, 0x4A, 
+0xA3, 0x25, 0x74, 0xE4, 0xBA, 0xB2, 0x89, 0x1E, 
+0xEA, 0xBA, 0xAA, 0x56, 0xAA, 0x36, 0x91, 0x52, 
+0x0F, 0x1D, 0xBE, 0x04, 0x74, 0xB0, 0xD0, 0x23, 
+0xAF, 0x8F, 0xD9, 0x85, 0x57, 0x43, 0xCD, 0x3C, 
+0x41, 0x1E, 0xC2, 0xC2, 0x67, 0x91, 0x49, 0xCE, 
+0x6B, 0xDF, 0x97, 0xF7, 0x7C, 0xDA, 0xD3, 0x0B, 
+0xC5, 0xC1, 0x05, 0x2F, 0xF3, 0xA2, 0x63, 0xE3, 
+0x07, 0x63, 0xE9, 0x83, 0xB0, 0xDE, 0xCE, 0x1E, 
+0x68, 0x9D, 0x85, 0x9F, 0x71, 0xAB, 0xD8, 0x3F, 
+0xA4, 0xCB, 0xD9, 0xB9, 0xF8, 0x2D, 0x29, 0x28, 
+0x5C, 0xC5, 0xE5, 0x43, 0xB8, 0xFA, 0x14, 0x0A, 
+0xAE, 0xDC, 0xA0, 0x00, 0xFD, 0x27, 0xBF, 0x38, 
+
+0x03, 0x32, 0xBB, 0x95, 0x74, 0xD4, 0x6D, 0x63, 
+0x8C, 0x92, 0xA8, 0xB6, 0xB4, 0xA0, 0x1A, 0xC1, 
+0x8A, 0x83, 0x52, 0x5E, 0x65, 0xAC, 0xE5, 0x91, 
+0x5E, 0xD5, 0x9C, 0x8A, 0x00, 0xC3, 0xEA, 0x58, 
+0x0D, 0x4B, 0x31, 0x7B, 0x5E, 0x11, 0x28, 0x88, 
+0x6E, 0x01, 0x36, 0x86, 0x94, 0x75, 0xA6, 0x17, 
+0xA4, 0x4F, 0x5D, 0x00, 0x57, 0x0C, 0x9B, 0xC3, 
+0x42, 0x89, 0xE8, 0xC1, 0x10, 0x01, 0x6F, 0x50, 
+0x74, 0x98, 0x63, 0x4F, 0xC5, 0xDC, 0x39, 0x01, 
+0x34, 0xD0, 0xD2, 0xCE, 0xDC, 0x17, 0x7A, 0x93, 
+0x2B, 0xD1, 0x15, 0x6C, 0x74, 0xA2, 0xB3, 0xDB, 
+0xB0, 0x67, 0xB4, 0x0E, 0x7F, 0x55, 0x48, 0xAE, 
+0xA5, 0xE4, 0xE6, 0xAB, 0x8F, 0x7A, 0xB0, 0x89, 
+0x63, 0xF3, 0x81, 0x18, 0x3B, 0x05, 0xD7, 0x0F, 
+0x2D, 0x86, 0x84, 0x52, 0x89, 0xEB, 0x9A, 0xF2, 
+0x38, 0xD6, 0xD5, 0xA3, 0x8C, 0x56, 0x34, 0x4E, 
+0xA9, 0xAF, 0xBE, 0x92, 0x8C, 0xA8, 0xA5, 0x8B, 
+0xE5, 0xC4, 0x94, 0x8B, 0xC0, 0x83, 0x4E, 0x25, 
+0x90, 0x10, 0xC1, 0xD3, 0x1A, 0xE7, 0x39, 0xAB, 
+0xDC, 0x87, 0x14, 0x48, 0x81, 0x90, 0x45, 0xC8, 
+0xF4, 0x50, 0x37, 0xC0, 0xF1, 0xA2, 0xCB, 0xA1, 
+0x18, 0xAF, 0xD8, 0xBD, 0xE5, 0x38, 0x63, 0x29, 
+0xAC, 0xCF, 0x70, 0xB1, 0xDE, 0x16, 0x7C, 0xAD, 
+0x03, 
+
+0xC4, 0x71, 0x8B, 0xB0, 0x82, 0x7B, 0x37, 0x48, 
+0x2F, 0x59, 0x9D, 0x51, 0xBA, 0x98, 0x9E, 0xCB, 
+0x90, 0x40, 0x03, 0x3A, 0x83, 0xA4, 0x0C, 0x78, 
+0xC6, 0xD5, 0x93, 0xB3, 0x9F, 0x49, 0x21, 0x67, 
+0x62, 0x98, 0x84, 0xAF, 0xA1, 0xA4, 0x96, 0xB1, 
+0xDC, 0xEA, 0x75, 0xFD, 0x11, 0xA5, 0x94, 0x27, 
+0xD4, 0x87, 0xFE, 0x91, 0x2F, 0xE7, 0x65, 0x12, 
+0x89, 0xBF, 0xBD, 0x08, 0xFF, 0x5C, 0xE9, 0xE1, 
+0x0E, 0x0A, 0x2A, 0xAD, 0xCA, 0xE4, 0x3E, 0x8C, 
+0x37, 0x19, 0x24, 0x1D, 0xEE, 0x65, 0x13, 0x57, 
+0xB4, 0x95, 0x94, 0x56, 0xF0, 0x05, 0xB4, 0x68, 
+0x03, 0xBD, 0x79, 0x31, 0x63, 0xDD, 0x47, 0x24, 
+0x6B, 0xCD, 0x40, 0x13, 0x9A, 0x31, 0xA6, 0xF6, 
+0xF9, 0x34, 0xF0, 0x6B, 0xD2, 0x01, 0x23, 0xC6, 
+0xD8, 0xC2, 0xE6, 0x9C, 0xB1, 0x6B, 0xB7, 0xA9, 
+0x5F, 0x2B, 0x34, 0x27, 0xE2, 0x36, 0x94, 0x5C, 
+0x9A, 0xCA, 0x8C, 0x59, 0xBA, 0x54, 0x59, 0x08, 
+0x57, 0xD2, 0x8A, 0xBB, 0x6A, 0xFD, 0xDF, 0x65, 
+0x8C, 0x16, 0x7A, 0x0C, 0x5B, 0xF1, 0x60, 0x42, 
+0x49, 0x3B, 0xDF, 0xC0, 0xED, 0xDB, 0x15, 0xB0, 
+0x90, 0xE2, 0x28, 0x9B, 0xA3, 0x3F, 0x5F, 0x85, 
+0xAE, 0x1D, 0x8A, 0xFB, 0x4D, 0xFE, 0x74, 0x84, 
+0x3D, 0x3A, 0xA4, 0x26, 0x16, 0xB6, 0x36, 0x6B, 
+0xCA, 0xF1, 
+
+0xF1, 0x9E, 0x94, 0x22, 0xB3, 0xA0, 0x36, 0xD4, 
+0x4F, 0x7C, 0xEA, 0x2E, 0x60, 0xA1, 0xEB, 0xB1, 
+0xF0, 0xC1, 0x61, 0xD9, 0x99, 0xD8, 0x45, 0xFF, 
+0x0B, 0x3A, 0xF4, 0x6C, 0xDF, 0x70, 0x32, 0x7C, 
+0xAE, 0x33, 0x45, 0x98, 0x0E, 0xC6, 0x1B, 0xD8, 
+0xBE, 0x62, 0xCC, 0xDE, 0x6F, 0xDC, 0x71, 0x1C, 
+0x3A, 0xEC, 0x6B, 0x7D, 0x25, 0x88, 0xB6, 0x77, 
+0x8A, 0x07, 0x1B, 0x9D, 0x67, 0xEC, 0x35, 0x55, 
+0x3F, 0x5D, 0xCF, 0xF2, 0x6E, 0x62, 0xE5, 0x97, 
+0xE3, 0x82, 0x72, 0x23, 0x0B, 0xC5, 0xB3, 0x3A, 
+0x13, 0xD7, 0x22, 0x04, 0xB0, 0xBF, 0xB8, 0x55, 
+0x7D, 0x76, 0x9B, 0x65, 0x78, 0x4B, 0x5B, 0x33, 
+0xF3, 0x0D, 0x07, 0x0F, 0x65, 0x3C, 0x48, 0xC5, 
+0x25, 0x48, 0x3A, 0xBB, 0x16, 0xAF, 0xCA, 0x46, 
+0xEA, 0xB6, 0x74, 0x5C, 0xD8, 0x42, 0x91, 0xC6, 
+0x2D, 0x5F, 0x42, 0xFC, 0x20, 0xEC, 0xF3, 0xEB, 
+0xFC, 0x31, 0x9C, 0xAA, 0x49, 0x94, 0xE0, 0x7A, 
+0x2E, 0xD4, 0x3D, 0x2D, 0x6B, 0x67, 0x1D, 0xD8, 
+0x49, 0x9C, 0x08, 0x19, 0xA2, 0x8F, 0x49, 0x53, 
+0xB7, 0xAA, 0x0D, 0xE0, 0xA1, 0xA9, 0x78, 0xF5, 
+0x2E, 0x03, 0xDB, 0x69, 0xB1, 0xB4, 0x5C, 0x74, 
+0xE8, 0x2B, 0xDF, 0x02, 0xDC, 0x7B, 0xEC, 0x87, 
+0xC5, 0x57, 0xFD, 0x5D, 0x4A, 0x64, 0x8C, 0x4B, 
+0x38, 0x99, 0xA4, 
+
+0x9A, 0xA4, 0x7A, 0xEB, 0x6C, 0x4D, 0xD1, 0x67, 
+0xA1, 0x0D, 0xE5, 0xF2, 0xE6, 0x7F, 0xD9, 0x51, 
+0xF1, 0xB2, 0x2B, 0xB4, 0x67, 0xD8, 0x69, 0x6C, 
+0xA4, 0x5F, 0x36, 0x81, 0x8C, 0x1E, 0xB3, 0xAA, 
+0xF0, 0xAB, 0xA3, 0x1C, 0xDF, 0x15, 0x20, 0xD5, 
+0x1D, 0x9B, 0x4D, 0xF7, 0xB8, 0xCE, 0x1D, 0x33, 
+0x11, 0x1A, 0x25, 0xD7, 0x83, 0x48, 0x94, 0x82, 
+0xD6, 0x94, 0x7F, 0xAC, 0xE4, 0xFD, 0xA6, 0x94, 
+0x56, 0x2F, 0xC4, 0x70, 0xF5, 0x0E, 0x9F, 0xD7, 
+0xE7, 0x24, 0xE7, 0x29, 0x26, 0xC8, 0xE5, 0x56, 
+0xE9, 0xDE, 0x3B, 0xF9, 0xFB, 0x64, 0x50, 0xB1, 
+0xC6, 0x93, 0xB9, 0x0B, 0x3E, 0xC4, 0x91, 0xFF, 
+0xF6, 0xD2, 0x98, 0xF2, 0x6A, 0x58, 0x0B, 0x12, 
+0x79, 0xF0, 0x35, 0x46, 0x3A, 0x01, 0xE0, 0xFF, 
+0x95, 0x21, 0x87, 0x66, 0x13, 0x0F, 0x25, 0x80, 
+0x6F, 0x94, 0xAF, 0x25, 0x85, 0x01, 0x72, 0xD8, 
+0x12, 0x43, 0x4F, 0xFD, 0x0D, 0xAB, 0x8D, 0xF8, 
+0x01, 0x2B, 0x21, 0xD3, 0xF1, 0x47, 0x58, 0x17, 
+0x21, 0x9D, 0x0D, 0x6E, 0x3F, 0x17, 0x9C, 0x43, 
+0x9A, 0xA5, 0x9C, 0x6D, 0xFE, 0x38, 0x87, 0xAD, 
+0xDE, 0x75, 0xDC, 0xD0, 0x56, 0xF8, 0x6D, 0x1B, 
+0xD8, 0xCC, 0xF1, 0x60, 0xFB, 0xD5, 0xCC, 0x61, 
+0x11, 0xC7, 0x94, 0xC7, 0x0F, 0x5F, 0x29, 0xFB, 
+0x9C, 0xBC, 0x7D, 0xE9, 
+
+0xF5, 0xA3, 0xE0, 0xBE, 0xFB, 0xF4, 0xAE, 0x92, 
+0xD0, 0x9A, 0x7B, 0x72, 0xDE, 0xFB, 0x40, 0x09, 
+0xF4, 0x21, 0xF7, 0x08, 0x2D, 0xD1, 0xE7, 0x12, 
+0x86, 0x51, 0x40, 0xBE, 0xC5, 0x81, 0xD3, 0x1B, 
+0x15, 0x55, 0x2F, 0x8F, 0x7E, 0x63, 0x65, 0x04, 
+0x36, 0xC9, 0x82, 0x8B, 0x58, 0x22, 0xCD, 0xE7, 
+0xBF, 0x62, 0xAB, 0xC8, 0xA4, 0x78, 0xB2, 0x9C, 
+0xF0, 0x02, 0x36, 0x5F, 0xBB, 0x8D, 0xDB, 0xDD, 
+0x7D, 0xEE, 0x91, 0x6B, 0x06, 0xD7, 0xAA, 0x80, 
+0x91, 0x3B, 0x51, 0x3A, 0x2E, 0xB2, 0x7F, 0x76, 
+0xE0, 0x2B, 0xFA, 0xE7, 0xB5, 0x5F, 0x73, 0x17, 
+0xF6, 0x00, 0x02, 0xD9, 0x61, 0x1B, 0x7B, 0x67, 
+0x0D, 0x64, 0xB1, 0x9A, 0x50, 0x35, 0xC1, 0x1E, 
+0xDF, 0x0B, 0x49, 0x67, 0xA9, 0x48, 0xEC, 0x45, 
+0x19, 0x45, 0xC6, 0x8C, 0x53, 0xC7, 0xEF, 0xE9, 
+0x9C, 0xE8, 0x83, 0x1A, 0x1F, 0x09, 0x15, 0xC7, 
+0xF6, 0xE8, 0x0A, 0x09, 0x63, 0x0F, 0xAF, 0x9F, 
+0x6A, 0x67, 0x55, 0xC8, 0x78, 0x38, 0x11, 0x4D, 
+0x63, 0x79, 0xA5, 0xFC, 0x09, 0x41, 0xF5, 0x21, 
+0x8B, 0x9C, 0x20, 0x39, 0xEE, 0xE1, 0x6E, 0x46, 
+0xCF, 0x7F, 0xC7, 0x87, 0x86, 0x2C, 0xEC, 0xF7, 
+0x4E, 0x20, 0xB6, 0x86, 0x1F, 0x27, 0xCE, 0x4E, 
+0xA9, 0xE9, 0xF0, 0xA3, 0x5F, 0x08, 0x3B, 0x81, 
+0xCA, 0x0D, 0xA2, 0x40, 0x83, 
+
+0x57, 0x04, 0x39, 0x7E, 0xAF, 0x6A, 0x00, 0xB2, 
+0x91, 0xBF, 0x98, 0x38, 0x36, 0x37, 0x54, 0xB5, 
+0x22, 0xAA, 0x5D, 0xBC, 0x42, 0x00, 0x79, 0x4E, 
+0xF4, 0x4E, 0xAC, 0x3C, 0x4C, 0x58, 0x53, 0xC2, 
+0xCA, 0xC1, 0x00, 0x1E, 0xBD, 0xBD, 0x1F, 0x57, 
+0x51, 0x0A, 0x8B, 0x84, 0xC2, 0xD0, 0x14, 0x91, 
+0x82, 0x55, 0x80, 0xA8, 0xC1, 0x57, 0xC8, 0x66, 
+0x65, 0xA3, 0xBE, 0x9F, 0x87, 0x1B, 0x80, 0x46, 
+0x3D, 0x5F, 0x07, 0xA2, 0x55, 0x8D, 0x34, 0xD4, 
+0xF6, 0x78, 0x4F, 0x12, 0x20, 0xAE, 0xD8, 0xFC, 
+0xAD, 0x4D, 0x2C, 0xB3, 0xB2, 0xBE, 0x89, 0x3E, 
+0xC5, 0x5F, 0x1C, 0xA2, 0xC0, 0x2A, 0x6B, 0x06, 
+0xE9, 0x2E, 0x3E, 0xBF, 0x0C, 0xDE, 0x7F, 0xC0, 
+0x51, 0x90, 0x27, 0xA5, 0x8B, 0x8B, 0x63, 0x23, 
+0xF1, 0x32, 0x21, 0x4F, 0xDB, 0x6A, 0x64, 0x9A, 
+0x8F, 0xAF, 0xD7, 0xE8, 0xD1, 0xA2, 0x2B, 0x4D, 
+0x8E, 0x71, 0x49, 0xCF, 0x3E, 0xFA, 0xE6, 0x9F, 
+0x1B, 0xED, 0x56, 0xEE, 0xA8, 0x5E, 0x37, 0xD6, 
+0x6F, 0x54, 0xA6, 0x7E, 0x5A, 0x2F, 0x48, 0x93, 
+0x0F, 0x23, 0x64, 0xF5, 0x0A, 0xE3, 0xD9, 0xCB, 
+0xBC, 0x35, 0x23, 0x05, 0x04, 0x87, 0x16, 0xE9, 
+0x40, 0x71, 0x3A, 0x53, 0x81, 0x30, 0x8E, 0x09, 
+0xA5, 0xE9, 0x4D, 0xC9, 0x35, 0x25, 0xE3, 0xD7, 
+0xE5, 0x21, 0x61, 0xEC, 0xDF, 0x3A, 
+
+0xD6, 0x61, 0x6A, 0x1E, 0xD1, 0x7E, 0xA1, 0x82, 
+0x10, 0x63, 0x21, 0xA6, 0xD7, 0xC0, 0xF4, 0xE0, 
+0xC6, 0xB5, 0xF9, 0x94, 0xDF, 0x2C, 0x90, 0xCB, 
+0xEE, 0x98, 0x5E, 0x65, 0x4D, 0x86, 0x9F, 0x5F, 
+0x16, 0xE6, 0xB9, 0x98, 0x4A, 0x94, 0xA8, 0x7A, 
+0x8D, 0x59, 0x6E, 0xE0, 0x92, 0x19, 0xD4, 0xF5, 
+0x93, 0x2C, 0xBF, 0x80, 0xFD, 0x9B, 0x6B, 0xEA, 
+0x81, 0xFB, 0x6C, 0x40, 0x0B, 0x5D, 0xDD, 0xFE, 
+0x6C, 0xDF, 0x1D, 0xA0, 0x02, 0x53, 0xD6, 0x55, 
+0xB9, 0xCC, 0x18, 0x06, 0x94, 0x8B, 0x37, 0xBB, 
+0x7E, 0xC7, 0x56, 0x28, 0x15, 0x58, 0x3A, 0xC0, 
+0x30, 0xB7, 0x1E, 0xFA, 0x46, 0xD7, 0x1A, 0x9D, 
+0xD8, 0x43, 0x10, 0x77, 0x5D, 0x5C, 0x71, 0x73, 
+0xD3, 0x64, 0xC9, 0xC6, 0xCB, 0x16, 0xC2, 0x17, 
+0xB5, 0x0D, 0x15, 0xF8, 0x81, 0xAE, 0xBF, 0x3B, 
+0x3F, 0x0B, 0x92, 0x46, 0x48, 0xF9, 0xB1, 0xB2, 
+0x90, 0xC0, 0xEA, 0x94, 0x3D, 0xA7, 0x9E, 0x66, 
+0x44, 0x95, 0xD0, 0xD1, 0x3C, 0xA0, 0x72, 0x73, 
+0xB0, 0xB8, 0x79, 0x0E, 0xD5, 0xF0, 0x72, 0xF0, 
+0x78, 0x28, 0x06, 0x3C, 0xA4, 0xD7, 0x1A, 0x78, 
+0xDB, 0xD2, 0xEA, 0x80, 0xF4, 0x1B, 0x29, 0x47, 
+0x5E, 0x7B, 0x03, 0xA8, 0xBE, 0xBB, 0xAB, 0x13, 
+0x76, 0x8B, 0x4F, 0x3A, 0x16, 0x4E, 0xF2, 0x85, 
+0x84, 0x87, 0x8B, 0x16, 0x52, 0x80, 0x64, 
+
+0x47, 0xF1, 0x69, 0x52, 0x4E, 0x81, 0x0A, 0x6B, 
+0x5E, 0xC0, 0x61, 0x05, 0x76, 0x0B, 0x71, 0x34, 
+0x4F, 0x80, 0xE1, 0xCA, 0xFA, 0x49, 0x90, 0xEE, 
+0x26, 0x05, 0xCE, 0x72, 0x53, 0x7E, 0xBA, 0xE5, 
+0x9A, 0x68, 0x01, 0x73, 0x93, 0x25, 0x58, 0x55, 
+0x7F, 0xFC, 0x50, 0x7F, 0x3A, 0xA5, 0x08, 0x3E, 
+0x2E, 0xF8, 0x18, 0xAC, 0xEA, 0x0A, 0x3A, 0xDA, 
+0x72, 0x7B, 0x7B, 0xE3, 0xEB, 0x5F, 0x95, 0xAD, 
+0xC2, 0x5D, 0xB8, 0x16, 0xAC, 0xB6, 0xF9, 0xD8, 
+0x1F, 0x57, 0x22, 0x46, 0xAD, 0x91, 0x78, 0x48, 
+0x22, 0x02, 0xBE, 0xFB, 0xA6, 0x4D, 0xCF, 0x1D, 
+0x1D, 0x0F, 0x7C, 0x08, 0x67, 0x84, 0x3D, 0x56, 
+0x68, 0xAC, 0xD6, 0xBC, 0xF1, 0xEC, 0xEE, 0x14, 
+0x8E, 0x9A, 0x69, 0xAC, 0xCF, 0x17, 0xF7, 0x21, 
+0x51, 0xF5, 0x2F, 0xA8, 0xAF, 0xE3, 0xAE, 0x68, 
+0x14, 0x19, 0xB2, 0xE3, 0x6D, 0x2E, 0x5E, 0xE1, 
+0x78, 0x25, 0xE9, 0x45, 0x1B, 0xEF, 0xBE, 0xD4, 
+0x25, 0x2D, 0xF9, 0xEE, 0xAE, 0x1C, 0x34, 0x16, 
+0xE1, 0xDE, 0x6E, 0x8F, 0xBA, 0xAB, 0xB6, 0x86, 
+0x02, 0xEC, 0x2E, 0xFE, 0x46, 0xAF, 0x01, 0x31, 
+0x82, 0xBE, 0xE1, 0x39, 0x96, 0xDB, 0xA9, 0x7F, 
+0x30, 0x54, 0x5F, 0x1F, 0xF1, 0x17, 0x5A, 0x3A, 
+0xF7, 0x05, 0x9E, 0x42, 0xEF, 0x18, 0x97, 0x23, 
+0x98, 0x03, 0x49, 0xBE, 0x5C, 0xCE, 0x0A, 0x5F, 
+
+0xB5, 0xED, 0xE8, 0xD0, 0xEB, 0x9D, 0x11, 0xFE, 
+0xD2, 0xD6, 0xE0, 0x7E, 0x70, 0x7D, 0x61, 0x9D, 
+0xF4, 0xD6, 0x1B, 0x83, 0xB4, 0x0C, 0x8F, 0xC9, 
+0xF5, 0xD5, 0x70, 0xFD, 0x50, 0x51, 0x8D, 0xC6, 
+0x31, 0x16, 0x23, 0x43, 0x39, 0xE5, 0x45, 0x03, 
+0xDB, 0xD6, 0xA9, 0x00, 0x46, 0x0F, 0x40, 0xC4, 
+0x70, 0xEE, 0x58, 0x0E, 0xCF, 0x24, 0xDC, 0x2A, 
+0xE8, 0xDE, 0x53, 0xEF, 0xAF, 0x1C, 0x24, 0x27, 
+0x09, 0xA9, 0x97, 0xAB, 0x79, 0x4A, 0x1D, 0xB5, 
+0xD1, 0x66, 0x55, 0xDD, 0x8E, 0x86, 0x2E, 0xEB, 
+0xA0, 0xB2, 0x1F, 0xA8, 0x10, 0x2E, 0xEC, 0xA8, 
+0x26, 0x1F, 0x58, 0x4B, 0xD3, 0xAF, 0xF2, 0x5B, 
+0x18, 0x69, 0x4E, 0xB2, 0xDF, 0x8B, 0x4A, 0x6D, 
+0x10, 0x4C, 0xE8, 0x6E, 0xE3, 0xB7, 0x19, 0x07, 
+0xD3, 0x12, 0x71, 0x79, 0x9A, 0x6C, 0xA5, 0x05, 
+0x28, 0x4E, 0xB7, 0x76, 0x50, 0xD9, 0xE6, 0x35, 
+0x22, 0xD4, 0x50, 0xB6, 0x4E, 0xC9, 0xFD, 0x14, 
+0x4D, 0x39, 0x3E, 0xE4, 0x30, 0x9D, 0x88, 0x1A, 
+0x33, 0x29, 0xBA, 0x72, 0x41, 0x56, 0xD0, 0x20, 
+0x54, 0x86, 0xAB, 0x20, 0x2B, 0xBD, 0x5A, 0x1A, 
+0x85, 0xFE, 0x6B, 0xF5, 0xFE, 0xC2, 0x14, 0x0C, 
+0xEE, 0x9B, 0x54, 0xB0, 0x8B, 0x25, 0x32, 0x84, 
+0x20, 0x55, 0x3D, 0xF1, 0x59, 0x79, 0xE3, 0x9F, 
+0xBE, 0xBE, 0x6E, 0x88, 0xD0, 0x44, 0x28, 0x85, 
+0x08, 
+
+0x75, 0x95, 0xC1, 0x53, 0x5A, 0xC8, 0xB9, 0x3A, 
+0x25, 0x67, 0xC9, 0x3E, 0x07, 0x78, 0x27, 0xA9, 
+0x50, 0x7C, 0x88, 0xA7, 0x60, 0xB2, 0x79, 0xC3, 
+0x08, 0x1A, 0x8E, 0xD9, 0x39, 0x19, 0x82, 0x06, 
+0x46, 0x23, 0xDB, 0x8F, 0x83, 0xB2, 0xB0, 0x94, 
+0x97, 0xD4, 0x79, 0x0E, 0x15, 0x9D, 0x60, 0x1F, 
+0x41, 0xFB, 0xA5, 0x31, 0x73, 0x0A, 0x50, 0x8A, 
+0x4B, 0x7D, 0xE4, 0xFB, 0xD4, 0xF5, 0xAD, 0xBB, 
+0x09, 0x87, 0x4F, 0x87, 0x9E, 0x71, 0xBB, 0xE6, 
+0x65, 0x48, 0xC3, 0x2F, 0xEB, 0xFD, 0x16, 0x29, 
+0xEE, 0x91, 0x50, 0x7F, 0x97, 0x45, 0x19, 0x40, 
+0x3D, 0x35, 0x8F, 0x99, 0x8E, 0xC4, 0x0C, 0xF7, 
+0xF4, 0x73, 0xF2, 0x77, 0xBF, 0xF6, 0x2B, 0xE8, 
+0x0E, 0x61, 0xE7, 0xCA, 0x0C, 0x2D, 0xAB, 0x19, 
+0xE8, 0x6A, 0x0A, 0x66, 0xF5, 0x3C, 0x42, 0xFB, 
+0x7B, 0x45, 0x85, 0xEB, 0x66, 0x07, 0x98, 0x8F, 
+0xAB, 0x92, 0xAA, 0x31, 0x48, 0x93, 0x6D, 0x71, 
+0x9A, 0x38, 0x7C, 0x4E, 0x2D, 0x27, 0xE3, 0x36, 
+0x59, 0x7C, 0x3C, 0x6B, 0x0E, 0x9E, 0x6E, 0xAD, 
+0xFB, 0x2C, 0x1A, 0x77, 0x86, 0x8C, 0x69, 0x77, 
+0xEF, 0x70, 0xA1, 0x1E, 0x17, 0xD9, 0x48, 0x07, 
+0x02, 0x7F, 0x47, 0xF2, 0x59, 0x82, 0xDE, 0xA3, 
+0x32, 0x6D, 0xC3, 0x7D, 0x69, 0x27, 0x7A, 0x56, 
+0x5E, 0xD5, 0x65, 0x3F, 0x43, 0xB6, 0x25, 0x12, 
+0x13, 0x84, 
+
+0x71, 0xB8, 0x35, 0x68, 0x5B, 0x48, 0x0F, 0x77, 
+0x97, 0x38, 0x36, 0xF0, 0xE5, 0xFB, 0x69, 0x4B, 
+0x25, 0x90, 0x9D, 0xD6, 0x65, 0x22, 0xB4, 0x0E, 
+0xE0, 0x0B, 0xB2, 0x8C, 0xB9, 0xBD, 0xEF, 0x90, 
+0xEB, 0xFE, 0x58, 0xA4, 0xD6, 0x15, 0x7D, 0x87, 
+0x98, 0x7F, 0xE3, 0x8F, 0xD7, 0xF8, 0xAB, 0xDE, 
+0xC3, 0xF8, 0x72, 0xA7, 0x8F, 0x69, 0x4B, 0x89, 
+0x45, 0x67, 0x12, 0x08, 0xC1, 0x30, 0x7D, 0x55, 
+0xEF, 0x5D, 0xE5, 0x08, 0x7C, 0x61, 0x26, 0x3F, 
+0xD9, 0xCF, 0x2E, 0xBC, 0x8D, 0x07, 0xE0, 0x9E, 
+0xFF, 0x98, 0x5C, 0x52, 0xB6, 0xAA, 0x22, 0x1D, 
+0xC3, 0x37, 0x04, 0xB3, 0x77, 0x06, 0xBE, 0x5F, 
+0x8E, 0x76, 0x7E, 0x1F, 0xA5, 0x87, 0xBB, 0xE0, 
+0x39, 0x24, 0x97, 0x87, 0xBB, 0x24, 0x17, 0xDE, 
+0xB3, 0x17, 0xF2, 0x99, 0x88, 0x16, 0x6F, 0x8F, 
+0x30, 0x38, 0x96, 0x28, 0xCC, 0x52, 0x5D, 0xEE, 
+0x0B, 0xEE, 0xD4, 0x92, 0xBF, 0xEA, 0xA8, 0xB3, 
+0x4F, 0xBC, 0x89, 0x65, 0x13, 0x3A, 0x88, 0x29, 
+0x93, 0xE9, 0xFF, 0x62, 0xE1, 0x43, 0xD9, 0x11, 
+0xD3, 0x4E, 0xDF, 0x28, 0xC9, 0xC7, 0xBD, 0xE6, 
+0x24, 0x54, 0x7E, 0x4C, 0x7F, 0x90, 0x48, 0x86, 
+0x33, 0x4A, 0xD8, 0x64, 0x2C, 0xCC, 0xBD, 0x5C, 
+0xF4, 0x6D, 0x95, 0x50, 0x21, 0x7C, 0xF5, 0x8B, 
+0xA3, 0x6B, 0xC4, 0xAB, 0x1D, 0xD9, 0x57, 0xDF, 
+0x8A, 0xDE, 0x6C, 
+
+0x18, 0xB2, 0xFD, 0x47, 0xAA, 0xC8, 0xEF, 0x1E, 
+0x5C, 0x83, 0xFF, 0xF5, 0x64, 0xD6, 0xC3, 0x1B, 
+0x8C, 0x20, 0x1D, 0x9F, 0x02, 0x50, 0xB9, 0x12, 
+0x98, 0xD5, 0x69, 0x1C, 0x97, 0x64, 0x62, 0x37, 
+0x42, 0x2A, 0xE4, 0x4F, 0x3B, 0xF6, 0xC9, 0xC7, 
+0xB1, 0x18, 0x46, 0x21, 0x02, 0xDB, 0x11, 0x88, 
+0x0B, 0x7D, 0x4B, 0x61, 0x0F, 0x31, 0xBC, 0x12, 
+0xF1, 0xAB, 0x19, 0xE7, 0x1C, 0x5F, 0x13, 0x06, 
+0x45, 0x3D, 0x1A, 0x4F, 0x58, 0xF3, 0xED, 0x68, 
+0xBD, 0x1A, 0xA8, 0x7F, 0x7F, 0x60, 0xDF, 0xDB, 
+0xAA, 0x23, 0xB0, 0x1A, 0x04, 0x5B, 0x60, 0x7B, 
+0xD3, 0xC1, 0x1C, 0x47, 0x6B, 0x8A, 0x97, 0x2B, 
+0x2A, 0x17, 0x9D, 0xB1, 0x9F, 0x97, 0x98, 0x74, 
+0xA4, 0x08, 0x5B, 0x53, 0xA3, 0x3E, 0x0D, 0x91, 
+0xD8, 0xAC, 0x04, 0x3D, 0x79, 0x90, 0x20, 0xCF, 
+0xF2, 0x55, 0x4B, 0x3F, 0x39, 0xA9, 0x93, 0xC4, 
+0xA3, 0x33, 0xEB, 0x26, 0x29, 0x26, 0xA3, 0xE8, 
+0x3E, 0xBB, 0xC6, 0xC8, 0x5F, 0x2F, 0xF0, 0x74, 
+0x9B, 0xE7, 0x1D, 0x4A, 0xB4, 0x9F, 0xBF, 0x3B, 
+0xFD, 0xD5, 0x04, 0xB4, 0xA9, 0x7B, 0x28, 0x94, 
+0xFE, 0x67, 0x0F, 0x3D, 0x44, 0xC6, 0x77, 0x52, 
+0x26, 0x72, 0x78, 0xA8, 0x19, 0xDF, 0xAF, 0x61, 
+0x5B, 0xA5, 0x23, 0x55, 0x18, 0x52, 0xF1, 0x9B, 
+0x04, 0xD0, 0x98, 0x90, 0x39, 0x06, 0xAC, 0x2C, 
+0x91, 0x0F, 0x8C, 0x41, 
+
+0xB6, 0xD9, 0x5E, 0x33, 0x24, 0x38, 0x71, 0x69, 
+0x06, 0xAB, 0xDB, 0xA0, 0x18, 0x3F, 0xC2, 0x02, 
+0xF3, 0x78, 0xEE, 0x4E, 0x67, 0x54, 0xDA, 0xB0, 
+0xE3, 0x27, 0x0D, 0xE9, 0x3A, 0x47, 0x86, 0x0A, 
+0x82, 0xE0, 0xB2, 0x96, 0x99, 0xE1, 0xCC, 0x90, 
+0x6A, 0xCB, 0x80, 0xE7, 0x42, 0xDE, 0xA0, 0xA6, 
+0x1C, 0xB0, 0x7F, 0x7A, 0xA4, 0x8E, 0xE1, 0x60, 
+0x91, 0xE2, 0x15, 0x4C, 0x69, 0x26, 0x72, 0xE5, 
+0x96, 0xC6, 0xDB, 0x37, 0x38, 0xDA, 0x8B, 0xC1, 
+0xC3, 0x1F, 0x66, 0xD4, 0xE0, 0xC1, 0x15, 0xAA, 
+0x64, 0x82, 0x5F, 0xD6, 0x7F, 0x65, 0x9C, 0x4A, 
+0xA2, 0x8A, 0xA0, 0x0A, 0xDB, 0xB7, 0x87, 0x61, 
+0x6A, 0xE3, 0xE6, 0x09, 0xFB, 0x97, 0x06, 0xA1, 
+0x5C, 0x60, 0xD6, 0xA3, 0x84, 0x9F, 0x8B, 0x1F, 
+0xE9, 0xC8, 0x06, 0xD5, 0xE5, 0x8C, 0x06, 0xED, 
+0xB8, 0x3E, 0x37, 0x40, 0x63, 0xA2, 0x8C, 0xE9, 
+0xE9, 0x6E, 0x6D, 0xBC, 0x16, 0x1B, 0x2B, 0x45, 
+0x62, 0x5D, 0x0F, 0xD8, 0x31, 0xD7, 0xDC, 0x31, 
+0x0F, 0xAF, 0x07, 0xB5, 0xD3, 0x43, 0x7C, 0x04, 
+0xFE, 0xF3, 0x88, 0xD8, 0x4D, 0x80, 0x0B, 0x1D, 
+0x4F, 0x10, 0x4E, 0x96, 0x9A, 0xDB, 0x01, 0x90, 
+0xE6, 0x11, 0x21, 0x18, 0x35, 0xA0, 0x91, 0x99, 
+0xE6, 0x21, 0xF9, 0x4A, 0x74, 0x83, 0x7B, 0xCE, 
+0x85, 0xAF, 0xB5, 0xAC, 0x52, 0x66, 0xD9, 0xBA, 
+0x8E, 0xF3, 0xD7, 0x02, 0xD5, 
+
+0xAF, 0x9B, 0x37, 0x0B, 0xD4, 0x5E, 0x98, 0x61, 
+0x87, 0x0F, 0xE6, 0xA2, 0xB7, 0xCF, 0xF6, 0x4E, 
+0x1F, 0xA6, 0x26, 0xCE, 0x00, 0x76, 0x47, 0x6F, 
+0xEC, 0xC1, 0x2D, 0x01, 0x4B, 0x8E, 0x78, 0x18, 
+0x59, 0x90, 0xFF, 0x53, 0x79, 0x23, 0x2E, 0xF3, 
+0x7A, 0xA4, 0x95, 0xE7, 0xAB, 0xEC, 0x2B, 0x05, 
+0x7F, 0x4D, 0x42, 0x18, 0x76, 0x34, 0xD8, 0x81, 
+0x95, 0x3D, 0x2E, 0x1E, 0xE9, 0x1D, 0xE9, 0x0A, 
+0x55, 0x47, 0xDF, 0x63, 0x78, 0x54, 0x4C, 0x59, 
+0xF3, 0xEC, 0x23, 0x50, 0x65, 0xC2, 0x24, 0xC9, 
+0x85, 0x69, 0x0F, 0x56, 0x5A, 0x51, 0x57, 0x64, 
+0xB0, 0x6B, 0xE1, 0xAE, 0x67, 0x56, 0x47, 0x90, 
+0xEE, 0x0A, 0xE4, 0xA8, 0x1C, 0x8F, 0x9C, 0xC8, 
+0xC2, 0xFB, 0xF8, 0x50, 0xB7, 0x6C, 0xDD, 0x35, 
+0x15, 0x9F, 0x25, 0x55, 0xFA, 0xE1, 0x5D, 0xEC, 
+0x76, 0xE7, 0xA5, 0xBC, 0x08, 0x8A, 0xDD, 0x99, 
+0xF6, 0xEA, 0xFA, 0x9C, 0x4C, 0xD7, 0x35, 0x6F, 
+0x36, 0x78, 0x18, 0x05, 0xFB, 0x78, 0x21, 0x34, 
+0x74, 0xE9, 0xDC, 0xAD, 0x58, 0x21, 0xEC, 0x61, 
+0x3F, 0x2A, 0x5A, 0x04, 0xEE, 0x83, 0xF8, 0x45, 
+0x91, 0x66, 0xA2, 0x00, 0xCC, 0xFB, 0x81, 0x76, 
+0x50, 0x45, 0xE1, 0xD5, 0xF3, 0x80, 0xB5, 0x6A, 
+0xCC, 0x89, 0x91, 0x75, 0x10, 0x59, 0xF5, 0xBD, 
+0xCE, 0x47, 0xC6, 0xB5, 0x63, 0xA9, 0xFD, 0xAA, 
+0xE7, 0x4C, 0xB9, 0xDA, 0x38, 0x1A, 
+
+0x40, 0xC9, 0x29, 0x74, 0x28, 0xB2, 0xD9, 0x60, 
+0x58, 0x89, 0x96, 0x42, 0x88, 0x72, 0x98, 0x29, 
+0xE3, 0xED, 0x3B, 0x5C, 0x44, 0xC7, 0xFC, 0xDA, 
+0xDD, 0x02, 0x97, 0x5C, 0x38, 0xC7, 0x82, 0x03, 
+0xE2, 0x5B, 0x63, 0x52, 0x4D, 0x2F, 0xBA, 0xA6, 
+0x1C, 0xB0, 0x68, 0xC0, 0x93, 0x2F, 0x4F, 0x21, 
+0xB4, 0x93, 0x17, 0x72, 0x3B, 0xFA, 0xCB, 0xDA, 
+0x8A, 0x9F, 0x1B, 0x59, 0x30, 0xED, 0xCC, 0x2B, 
+0x92, 0x41, 0x24, 0x7D, 0xDB, 0x49, 0xCA, 0x66, 
+0xA6, 0xA0, 0xEB, 0xEA, 0x4A, 0xB1, 0xBD, 0x52, 
+0x57, 0x71, 0xAC, 0xF4, 0x2C, 0x95, 0x4F, 0x48, 
+0xF3, 0x99, 0x8D, 0xC7, 0xF0, 0xCB, 0x1B, 0x1D, 
+0x86, 0x28, 0xBF, 0x7C, 0x33, 0xFC, 0xAB, 0xEE, 
+0x86, 0x5B, 0xD9, 0x12, 0xF2, 0x27, 0x70, 0xFB, 
+0xD0, 0xF6, 0x5E, 0x04, 0x9D, 0x0A, 0x36, 0x6E, 
+0xA2, 0xE4, 0x69, 0x9C, 0x06, 0x71, 0x63, 0x61, 
+0x78, 0x1C, 0x16, 0xCF, 0x6D, 0x22, 0x9E, 0x06, 
+0x0F, 0x73, 0xD9, 0xFD, 0x7B, 0x39, 0x29, 0x55, 
+0x3D, 0x06, 0x77, 0x9C, 0xDA, 0x16, 0xD4, 0x2F, 
+0xB1, 0x39, 0x05, 0x9B, 0x49, 0x24, 0xF8, 0xE1, 
+0x58, 0x53, 0x70, 0x4E, 0xED, 0xC8, 0x2F, 0xE0, 
+0xAE, 0x5D, 0x51, 0x6B, 0x25, 0xEE, 0x8E, 0xA8, 
+0xB4, 0xAF, 0xB3, 0xD6, 0x0F, 0x51, 0xD4, 0x8B, 
+0xD2, 0x9A, 0xD0, 0x03, 0x35, 0x04, 0xE4, 0x89, 
+0x9C, 0xA4, 0x1E, 0x26, 0x89, 0x6B, 0x69, 
+
+0xF5, 0x03, 0xC6, 0x37, 0xEC, 0x74, 0x44, 0x52, 
+0x96, 0x45, 0x29, 0xF9, 0x01, 0xDB, 0xF4, 0xD8, 
+0x04, 0x34, 0xBB, 0x76, 0x3A, 0x2E, 0x08, 0x30, 
+0xFD, 0x5C, 0xEE, 0x3C, 0x8C, 0x22, 0x4D, 0x8F, 
+0x64, 0xED, 0xE2, 0x21, 0x1A, 0xF1, 0xE7, 0x5F, 
+0x37, 0xAA, 0x5D, 0x13, 0x8E, 0xD9, 0xFD, 0x9A, 
+0x05, 0x74, 0xDD, 0xD9, 0x8F, 0x36, 0xDE, 0x22, 
+0xF5, 0xC2, 0xF8, 0x43, 0xBF, 0x37, 0x47, 0xB7, 
+0x94, 0xC9, 0x00, 0xFD, 0x02, 0x64, 0x8B, 0xA4, 
+0x52, 0x8C, 0x20, 0x0F, 0x19, 0x83, 0x9E, 0xAA, 
+0x7E, 0xA7, 0x87, 0xA9, 0x0A, 0x71, 0xE4, 0xE5, 
+0x69, 0xD6, 0x96, 0xB7, 0x9B, 0x41, 0xB6, 0x96, 
+0x54, 0xE6, 0xE6, 0x1A, 0x3D, 0xE3, 0x16, 0xE3, 
+0x8A, 0x38, 0x60, 0xC4, 0x79, 0x0A, 0xE9, 0xFC, 
+0x7E, 0x08, 0x35, 0xBF, 0x8D, 0x44, 0xB9, 0x4B, 
+0x28, 0xC8, 0xE6, 0x20, 0xA7, 0x48, 0xE9, 0x7C, 
+0xED, 0xE1, 0xF3, 0x9A, 0x7D, 0x54, 0x3B, 0x9E, 
+0x41, 0x08, 0x29, 0xC6, 0xD8, 0x49, 0x61, 0xFC, 
+0x27, 0xA6, 0xA8, 0x61, 0x28, 0x0F, 0x9A, 0x17, 
+0x3A, 0xB7, 0x75, 0x3F, 0x0F, 0x33, 0x4D, 0x86, 
+0x49, 0x67, 0x07, 0x3B, 0x0B, 0x16, 0x2C, 0xC9, 
+0x60, 0xD1, 0xB5, 0x52, 0x04, 0x9B, 0xFC, 0xF0, 
+0x36, 0xE8, 0xEF, 0x09, 0x77, 0xFC, 0x84, 0x75, 
+0x7B, 0x43, 0x3A, 0xD7, 0xE6, 0x40, 0xB8, 0xBF, 
+0x99, 0xF4, 0x7A, 0xFD, 0xFF, 0x87, 0xF4, 0xF3, 
+
+0x71, 0x96, 0xAC, 0x75, 0x99, 0xAD, 0x3C, 0x97, 
+0xA2, 0x46, 0xCB, 0x1D, 0x46, 0x5E, 0x2F, 0x39, 
+0x26, 0x0D, 0x39, 0x19, 0xC4, 0x77, 0x85, 0x79, 
+0x75, 0x47, 0xB8, 0xC9, 0x94, 0xCC, 0x6B, 0xC1, 
+0x26, 0x2B, 0x85, 0xB4, 0x44, 0xE0, 0x4A, 0x42, 
+0xF7, 0x47, 0x81, 0x61, 0x82, 0x77, 0x06, 0x86, 
+0x6E, 0xA3, 0x73, 0xA8, 0xDC, 0xF7, 0xCB, 0xDA, 
+0xDB, 0x37, 0xA0, 0x9B, 0x33, 0x2A, 0xE0, 0x0B, 
+0x16, 0x84, 0xE4, 0x95, 0x5D, 0x11, 0x49, 0x56, 
+0xCC, 0xBA, 0x26, 0x92, 0x9D, 0x21, 0x63, 0x1B, 
+0xCC, 0x7E, 0xFD, 0xEE, 0xEC, 0xA2, 0x3B, 0x1A, 
+0x8B, 0x1E, 0x25, 0x81, 0x69, 0x6D, 0xBE, 0xCB, 
+0x81, 0x81, 0xE9, 0x96, 0x1C, 0x5F, 0x6F, 0x82, 
+0x10, 0xEA, 0xFE, 0xFF, 0x53, 0xD7, 0xD8, 0x48, 
+0x2B, 0xAF, 0x59, 0x2F, 0x49, 0x44, 0x13, 0x66, 
+0x96, 0xAF, 0x5F, 0x64, 0x99, 0x72, 0x87, 0x89, 
+0x6E, 0xD6, 0x6B, 0xA1, 0xE9, 0xC3, 0xAB, 0xFE, 
+0x6F, 0xC0, 0x31, 0x57, 0x74, 0xC8, 0x64, 0x78, 
+0x0B, 0x86, 0xE7, 0x4E, 0xF2, 0x4D, 0xD5, 0x6B, 
+0x7C, 0x0B, 0x00, 0x12, 0x28, 0xE7, 0x8E, 0x93, 
+0xB8, 0x4F, 0xA2, 0x70, 0x27, 0x3E, 0xD0, 0xCD, 
+0x69, 0x97, 0x85, 0x6E, 0xF1, 0x2B, 0xCD, 0x5C, 
+0x92, 0xCA, 0xA2, 0x96, 0x0E, 0xA8, 0x20, 0x70, 
+0xB0, 0x7C, 0x73, 0xAE, 0xC9, 0x43, 0xE3, 0x11, 
+0x82, 0x92, 0x82, 0x40, 0xC3, 0xA5, 0x23, 0x44, 
+0x92, 
+
+0x13, 0x1D, 0x0A, 0x08, 0xCF, 0x6E, 0x3C, 0x73, 
+0x33, 0xAE, 0x90, 0x40, 0x62, 0x23, 0x75, 0xD8, 
+0xAC, 0x3D, 0x01, 0xD4, 0xFB, 0xD9, 0x3A, 0xBC, 
+0x8E, 0x34, 0x54, 0x0F, 0xE3, 0x5A, 0x49, 0xA9, 
+0xF5, 0xDB, 0x16, 0x46, 0xF3, 0xF6, 0xA2, 0x49, 
+0x2C, 0xFF, 0x75, 0x61, 0x3F, 0xE1, 0x60, 0xD5, 
+0x1E, 0xD9, 0x81, 0x53, 0x0D, 0xE8, 0xDE, 0xA0, 
+0xAB, 0x64, 0xC6, 0x32, 0x31, 0x5D, 0x4A, 0xCE, 
+0x63, 0x52, 0x16, 0x5D, 0x95, 0x50, 0x3C, 0xC0, 
+0x0A, 0x55, 0x80, 0x7B, 0xD7, 0x64, 0x1C, 0x14, 
+0x4B, 0x7F, 0x9C, 0x1B, 0x0F, 0x1D, 0x70, 0x44, 
+0xB4, 0xF6, 0x9D, 0x77, 0x9D, 0xE2, 0x51, 0x2E, 
+0xA9, 0xED, 0xF9, 0x08, 0x76, 0xA9, 0x9F, 0x34, 
+0x94, 0xA0, 0x25, 0x88, 0x68, 0x33, 0x06, 0x7F, 
+0x65, 0xA7, 0x5D, 0xB5, 0x75, 0xDD, 0x9D, 0x2F, 
+0x6B, 0xD4, 0xCD, 0x3D, 0x3D, 0x05, 0xAE, 0xD7, 
+0x04, 0x88, 0x21, 0x25, 0x51, 0x7F, 0x89, 0xB6, 
+0xBD, 0x11, 0xED, 0xEC, 0xD3, 0xE0, 0xEE, 0x92, 
+0xDB, 0x2F, 0x70, 0x2E, 0xB3, 0xCB, 0x67, 0x88, 
+0x74, 0xF6, 0xB8, 0x98, 0xF2, 0xF6, 0x79, 0x86, 
+0xA6, 0x06, 0x5C, 0x92, 0x3B, 0x73, 0x34, 0x23, 
+0xC9, 0xD0, 0xED, 0x1A, 0x72, 0x49, 0xD2, 0x70, 
+0xE8, 0xE9, 0x0C, 0x80, 0x68, 0x4C, 0x77, 0x95, 
+0x97, 0xBB, 0x13, 0x3E, 0x97, 0xF8, 0xE7, 0x53, 
+0x67, 0x5A, 0xDF, 0xC4, 0xCB, 0x39, 0x14, 0x7A, 
+0x7A, 0xF0, 
+
+0xB9, 0x8B, 0xDF, 0xC4, 0xB3, 0x9F, 0x36, 0x82, 
+0x7E, 0xFB, 0x6B, 0x0A, 0xA9, 0xFB, 0x7C, 0x89, 
+0xD4, 0xB1, 0x05, 0xDD, 0x46, 0x8B, 0x6A, 0x10, 
+0xC2, 0x68, 0x4A, 0x8F, 0x7F, 0x97, 0x90, 0xC7, 
+0x19, 0xCB, 0xCA, 0x94, 0x19, 0x76, 0x7F, 0xA2, 
+0x73, 0x7B, 0xCF, 0x8B, 0x41, 0x2A, 0x4A, 0x6A, 
+0xBC, 0x25, 0x1C, 0x2A, 0xA0, 0x57, 0x72, 0x00, 
+0xC2, 0x8F, 0xFE, 0x7F, 0x7F, 0x81, 0x1E, 0x1B, 
+0xFA, 0x38, 0x0D, 0xE5, 0x89, 0xD2, 0x82, 0x97, 
+0x0F, 0x35, 0x02, 0x89, 0x4F, 0x8B, 0x66, 0xCF, 
+0x6B, 0x80, 0xCF, 0x99, 0x6F, 0x10, 0x9B, 0x7A, 
+0x53, 0x6A, 0x31, 0x83, 0x8F, 0x70, 0xCB, 0xE9, 
+0x1D, 0xEF, 0x34, 0x9A, 0xB2, 0x5D, 0xD3, 0xB8, 
+0x70, 0x2A, 0xDF, 0x0D, 0xC2, 0x3F, 0x91, 0xDE, 
+0x89, 0x87, 0xD9, 0x40, 0xC7, 0x86, 0x88, 0xBE, 
+0x24, 0xA3, 0x48, 0x9A, 0x7B, 0xD3, 0x55, 0xCF, 
+0x45, 0x04, 0x31, 0xF9, 0x98, 0xC6, 0x06, 0x32, 
+0x5D, 0xD1, 0x64, 0x86, 0xCD, 0x24, 0x4E, 0x75, 
+0xD7, 0x37, 0x0C, 0x0D, 0xA2, 0xD5, 0x73, 0x30, 
+0x83, 0xAE, 0x04, 0xCF, 0xDC, 0x3D, 0xA3, 0xDC, 
+0xD5, 0xC3, 0x70, 0xED, 0xD5, 0xCB, 0x27, 0x0C, 
+0xF3, 0x6F, 0x33, 0x66, 0x81, 0xEF, 0xE5, 0x1E, 
+0x88, 0x48, 0x75, 0x87, 0x82, 0xC8, 0x1A, 0x59, 
+0xCE, 0x2A, 0x37, 0xEE, 0x63, 0x02, 0xA9, 0xF2, 
+0xEA, 0xD9, 0x24, 0x6A, 0x5C, 0xD7, 0x39, 0x95, 
+0x3F, 0xAE, 0x7C, 
+
+0x68, 0x98, 0x3A, 0x05, 0xFE, 0xEB, 0x49, 0xB8, 
+0xE4, 0x8E, 0x22, 0x7C, 0xE8, 0xF4, 0xC4, 0xB2, 
+0x37, 0x4C, 0x97, 0x42, 0x75, 0x34, 0xBF, 0x22, 
+0xFE, 0x7A, 0x87, 0x28, 0x81, 0xBC, 0xAE, 0x5C, 
+0x05, 0x6B, 0x3C, 0x43, 0xA1, 0xEF, 0xE3, 0x7E, 
+0x34, 0xA9, 0x58, 0xDC, 0x67, 0x6D, 0x62, 0x4C, 
+0x1C, 0x5E, 0x38, 0x78, 0x24, 0x32, 0x33, 0x1C, 
+0x63, 0x8C, 0x51, 0xC3, 0x36, 0x10, 0x12, 0x55, 
+0xB8, 0x06, 0x9E, 0xD9, 0xEC, 0x80, 0xF4, 0x7F, 
+0xBC, 0x8B, 0xA8, 0x86, 0x6D, 0xAE, 0x76, 0x7D, 
+0xE5, 0x37, 0xE6, 0xB8, 0x66, 0x2F, 0xF7, 0x2D, 
+0x53, 0xA4, 0x4E, 0xF8, 0xBB, 0xE2, 0xAB, 0x68, 
+0x21, 0x6E, 0xE1, 0xF2, 0x7B, 0x64, 0xD7, 0x5A, 
+0x43, 0xBA, 0x85, 0xEB, 0xFC, 0x67, 0x52, 0x84, 
+0x25, 0x74, 0x39, 0xC9, 0x5B, 0x0A, 0x5F, 0x5C, 
+0x40, 0x3B, 0x54, 0x49, 0xBB, 0xED, 0x8D, 0x3C, 
+0x37, 0xF7, 0x99, 0x69, 0xA6, 0x31, 0x88, 0xDB, 
+0x7B, 0xDC, 0xD3, 0xA4, 0x65, 0x51, 0x5E, 0x34, 
+0xB0, 0xF3, 0x93, 0x2F, 0x76, 0x2F, 0x8B, 0xFD, 
+0x6D, 0x77, 0xBF, 0xAE, 0xEB, 0xE6, 0xCA, 0x06, 
+0x5E, 0x6A, 0xE7, 0x27, 0x87, 0x50, 0xDB, 0xBA, 
+0x3E, 0xF6, 0x1A, 0x8D, 0x98, 0x00, 0x20, 0xDF, 
+0x01, 0xD9, 0x99, 0xE4, 0x74, 0xE7, 0xD7, 0xF7, 
+0x39, 0x07, 0x01, 0x65, 0x5C, 0xE2, 0xA4, 0x3E, 
+0x17, 0x53, 0x95, 0x81, 0x94, 0xBD, 0xC2, 0x3F, 
+0xA9, 0x3D, 0xF9, 0xC9, 
+
+0x46, 0x60, 0x95, 0x39, 0x5D, 0x35, 0xDA, 0x2F, 
+0xDD, 0xD9, 0x46, 0x72, 0xF8, 0xD9, 0xDE, 0x21, 
+0xED, 0x80, 0x62, 0x02, 0x4E, 0xA3, 0x74, 0x1E, 
+0x1E, 0xCB, 0x31, 0x26, 0x9E, 0x68, 0x23, 0xF0, 
+0xD0, 0x15, 0x0D, 0x94, 0x23, 0xBC, 0x24, 0xE3, 
+0x7F, 0x35, 0xF5, 0x2E, 0xE9, 0x4D, 0x23, 0x72, 
+0xC9, 0x6D, 0x4E, 0x34, 0x93, 0x07, 0x08, 0xD8, 
+0xF3, 0x71, 0xB1, 0x41, 0xD1, 0x34, 0x0C, 0x32, 
+0x52, 0x6B, 0x1E, 0x9A, 0xD3, 0xC4, 0x5C, 0xB4, 
+0x12, 0x26, 0x28, 0xDD, 0x67, 0xD0, 0xBF, 0x60, 
+0xA6, 0x3E, 0x00, 0x30, 0xEA, 0x23, 0x95, 0x78, 
+0x31, 0x95, 0x59, 0x73, 0x97, 0x35, 0xB8, 0xF1, 
+0x63, 0xD8, 0x35, 0x0D, 0x2D, 0x2F, 0xEE, 0xD5, 
+0x09, 0x45, 0x97, 0xBA, 0x92, 0xB6, 0xD8, 0x28, 
+0x88, 0xE7, 0xA7, 0xA3, 0x9F, 0x44, 0x6A, 0x64, 
+0xE6, 0xC2, 0x05, 0x2B, 0x13, 0xAA, 0xCE, 0xDE, 
+0x21, 0xC7, 0x4A, 0x0F, 0xFC, 0xE5, 0xBE, 0x4F, 
+0xAC, 0xDB, 0xB2, 0xC5, 0x06, 0x21, 0x81, 0xB0, 
+0x78, 0x0A, 0xFA, 0x7B, 0x62, 0x7F, 0x29, 0x27, 
+0x93, 0xA9, 0x41, 0x24, 0x39, 0x86, 0x3D, 0xB4, 
+0x00, 0x29, 0x6C, 0x29, 0x28, 0x1C, 0xDE, 0xB5, 
+0xF2, 0x90, 0x4D, 0x1C, 0x37, 0xDC, 0x4E, 0x20, 
+0xB8, 0xB6, 0x78, 0xA2, 0x9A, 0xAD, 0xAA, 0x11, 
+0x09, 0x2A, 0x6E, 0xEB, 0x1E, 0x0A, 0x28, 0xEA, 
+0x3E, 0xE9, 0x21, 0xAB, 0x56, 0xDD, 0xFF, 0x6C, 
+0x1A, 0x3C, 0x07, 0x36, 0x53, 
+
+0x94, 0xE0, 0x03, 0xBF, 0x36, 0x56, 0xFC, 0xAD, 
+0x04, 0x21, 0x57, 0x76, 0xF1, 0xCA, 0xD7, 0x42, 
+0xAC, 0x49, 0xBA, 0x20, 0x47, 0xE4, 0x76, 0x27, 
+0x98, 0xDE, 0xE7, 0xC7, 0xE4, 0x61, 0xA2, 0x40, 
+0xA4, 0x7F, 0x34, 0x6F, 0x9A, 0x1A, 0x1F, 0x68, 
+0x23, 0xA2, 0x83, 0x20, 0x25, 0x92, 0x9C, 0xEA, 
+0x59, 0x4F, 0x52, 0x30, 0xDA, 0x96, 0xE2, 0x06, 
+0x1A, 0x9A, 0x3D, 0x9E, 0xF6, 0x50, 0xDE, 0x77, 
+0x56, 0xB0, 0x14, 0x83, 0x7B, 0x49, 0x63, 0xCC, 
+0xB6, 0xB9, 0xF9, 0x8F, 0x42, 0x7E, 0x1D, 0x4B, 
+0x77, 0x6B, 0xC7, 0xB9, 0x5F, 0xE8, 0x22, 0xD1, 
+0x26, 0xB8, 0x97, 0x1B, 0x43, 0x89, 0xAA, 0x7B, 
+0xF2, 0xDE, 0xEF, 0x95, 0xB9, 0x86, 0x6A, 0x9A, 
+0x7C, 0x2D, 0x37, 0xDF, 0x19, 0x73, 0x61, 0xF5, 
+0x05, 0x67, 0x78, 0x68, 0x3A, 0xAF, 0x48, 0x69, 
+0xD2, 0xC3, 0xF1, 0xCF, 0xD0, 0xE8, 0xCD, 0xED, 
+0x55, 0xDD, 0xA2, 0x59, 0x93, 0x7E, 0xC1, 0x76, 
+0xCF, 0xF9, 0xF3, 0x39, 0xE6, 0x95, 0x25, 0x47, 
+0x8C, 0x27, 0xA5, 0xAE, 0xD0, 0x3B, 0x1F, 0x8D, 
+0x83, 0xA6, 0xA5, 0xE7, 0x9F, 0x2C, 0x4C, 0x1E, 
+0xA2, 0x96, 0x8B, 0xA4, 0x18, 0x11, 0x61, 0x92, 
+0x6F, 0xAF, 0x73, 0x23, 0x24, 0x45, 0x01, 0x6B, 
+0x83, 0xAF, 0xA1, 0xAA, 0xFD, 0xAE, 0xC0, 0x13, 
+0x08, 0xBF, 0x37, 0xD5, 0x77, 0xE0, 0xDC, 0xB1, 
+0xF1, 0x8F, 0x56, 0x6C, 0x45, 0xAC, 0x37, 0xBB, 
+0x4F, 0x22, 0xB3, 0xC4, 0xA1, 0x95, 
+
+0x01, 0x23, 0x4A, 0xBE, 0x7C, 0xD1, 0x4E, 0xAD, 
+0xD2, 0xE5, 0x2F, 0xEA, 0xA9, 0x62, 0xA5, 0xCB, 
+0x87, 0x34, 0x6B, 0x5F, 0xB9, 0xBD, 0xF8, 0x36, 
+0x6C, 0xA8, 0xDE, 0x58, 0x94, 0x27, 0x00, 0xCB, 
+0x06, 0x5F, 0x5E, 0x76, 0xBC, 0x08, 0xB0, 0x7F, 
+0x41, 0xA7, 0xDE, 0x73, 0x32, 0xE2, 0xFB, 0x98, 
+0x17, 0x2A, 0x7D, 0x4D, 0xB1, 0x5F, 0x2D, 0xF5, 
+0xA8, 0x4B, 0x60, 0x74, 0x75, 0x54, 0x8A, 0xCA, 
+0x64, 0xC5, 0x81, 0x53, 0x1F, 0x79, 0xAD, 0xEA, 
+0x38, 0x01, 0xDF, 0x54, 0x4D, 0x9F, 0x28, 0xA5, 
+0x8B, 0xB2, 0xC5, 0x24, 0xF2, 0x31, 0x33, 0x9A, 
+0xBE, 0x99, 0x23, 0xFC, 0x02, 0x79, 0x89, 0x17, 
+0xE0, 0x15, 0x54, 0x25, 0x1F, 0xEB, 0xB7, 0x10, 
+0x47, 0xF4, 0xC5, 0xE7, 0x60, 0xBF, 0x6E, 0x43, 
+0xC3, 0xD6, 0xAB, 0xEA, 0x2A, 0x23, 0x88, 0x62, 
+0xFF, 0xC6, 0x2F, 0xDD, 0x3A, 0x7D, 0xFF, 0xFB, 
+0x28, 0x65, 0x34, 0x1C, 0x01, 0xC4, 0x3A, 0x5C, 
+0x8C, 0x5D, 0x1C, 0x98, 0x0E, 0x12, 0xDD, 0x15, 
+0x78, 0x36, 0xE2, 0x99, 0x4B, 0xF6, 0x85, 0x97, 
+0xEB, 0x2A, 0x81, 0xBA, 0xDE, 0x30, 0x99, 0x1F, 
+0x06, 0xB1, 0x85, 0x67, 0x48, 0x0D, 0x3C, 0x7D, 
+0x5E, 0x62, 0x8C, 0xFF, 0xBD, 0xEB, 0xB1, 0xC8, 
+0xF1, 0x90, 0xC1, 0x36, 0x71, 0x1A, 0x91, 0xC2, 
+0xB5, 0x0D, 0x1E, 0xD9, 0xE7, 0xF4, 0x29, 0x6D, 
+0xAF, 0x33, 0x61, 0x30, 0x49, 0x54, 0xA1, 0x65, 
+0x25, 0x3B, 0x25, 0x6B, 0xF8, 0x67, 0x89, 
+
+0xB5, 0xBA, 0xF8, 0x67, 0x4B, 0x8F, 0xA1, 0x64, 
+0xB8, 0x71, 0xF7, 0x32, 0x99, 0x3B, 0x6C, 0x99, 
+0xE8, 0xDC, 0xC9, 0xEA, 0xCE, 0x80, 0x9B, 0xA1, 
+0xE3, 0xE5, 0x64, 0x55, 0x07, 0x17, 0x75, 0x82, 
+0x71, 0xE9, 0xA9, 0x09, 0x8D, 0x0D, 0x4E, 0xFB, 
+0xE8, 0x50, 0x97, 0x1A, 0x36, 0x10, 0x1B, 0xFD, 
+0x54, 0xD3, 0x5C, 0x74, 0x10, 0x58, 0xF0, 0xE3, 
+0x0F, 0x08, 0x37, 0x89, 0x40, 0xAD, 0x66, 0x5B, 
+0x15, 0x77, 0xA7, 0xB1, 0xB0, 0x36, 0xDC, 0x4E, 
+0xD6, 0x76, 0x72, 0x23, 0x67, 0x02, 0x33, 0x86, 
+0x70, 0x9A, 0xCD, 0xB6, 0xD3, 0xEB, 0x2E, 0x7D, 
+0x8A, 0x30, 0x36, 0xED, 0xAB, 0x3B, 0xE0, 0xB3, 
+0x8D, 0x82, 0x32, 0xD5, 0xAB, 0xC6, 0x1E, 0x77, 
+0x0B, 0x13, 0x37, 0x27, 0xC6, 0x1A, 0xBE, 0x29, 
+0xFA, 0xAB, 0xF3, 0x6B, 0x81, 0x3A, 0x2C, 0x67, 
+0x37, 0xFD, 0x36, 0x1F, 0x2F, 0x89, 0x5D, 0xB2, 
+0xB2, 0xA0, 0x74, 0x3F, 0xE8, 0xF5, 0x7C, 0x4C, 
+0x55, 0x9E, 0xC4, 0x91, 0xE7, 0x17, 0x56, 0xC7, 
+0x80, 0xD0, 0x4B, 0xC3, 0x1E, 0x79, 0xA0, 0xCD, 
+0x16, 0xC3, 0xC6, 0x94, 0xF2, 0xA9, 0xC9, 0x6D, 
+0x97, 0xBA, 0xE7, 0x7E, 0x42, 0x3F, 0x00, 0x6B, 
+0x8B, 0x6B, 0x4D, 0xC3, 0x61, 0x57, 0x83, 0x40, 
+0x66, 0x55, 0xFD, 0xE5, 0x61, 0xFD, 0xC8, 0xA2, 
+0x22, 0x8D, 0x6E, 0xCB, 0x12, 0x80, 0x73, 0x7F, 
+0x0B, 0xF5, 0xAF, 0xE5, 0xE8, 0x6B, 0x71, 0x7E, 
+0xA8, 0x99, 0x28, 0x47, 0xB1, 0x5F, 0xAF, 0x94, 
+
+0x12, 0xF1, 0xFA, 0xF7, 0x3A, 0xA8, 0x87, 0xDD, 
+0xDE, 0x6B, 0x17, 0xAB, 0x78, 0x35, 0x8C, 0xED, 
+0x08, 0x90, 0xD9, 0x4A, 0x14, 0x83, 0xF3, 0xB4, 
+0x81, 0x8A, 0xD4, 0xB2, 0x5B, 0x0E, 0x63, 0x4D, 
+0x42, 0xBE, 0x87, 0x55, 0xCE, 0xAF, 0x77, 0xFA, 
+0x8C, 0xD3, 0xC8, 0xCA, 0xFE, 0x17, 0x6C, 0x13, 
+0xBA, 0xE8, 0x0C, 0xEF, 0xEE, 0x0A, 0x1F, 0x79, 
+0x51, 0x30, 0x99, 0x29, 0x21, 0xEC, 0xD9, 0xA9, 
+0x97, 0x27, 0x44, 0xF9, 0xC3, 0x6B, 0x69, 0xEB, 
+0x0C, 0xE5, 0x18, 0x66, 0x16, 0xE2, 0x90, 0x9C, 
+0xF6, 0x80, 0x7B, 0xCF, 0xB1, 0x88, 0x36, 0x62, 
+0x2D, 0x93, 0xA5, 0xAB, 0x4C, 0xA4, 0x79, 0xF1, 
+0xC4, 0x23, 0x34, 0xCD, 0x22, 0xBE, 0x36, 0x01, 
+0xD0, 0x8F, 0x0B, 0x5F, 0xA5, 0x4B, 0x8F, 0x3F, 
+0xE0, 0x84, 0xE0, 0x4F, 0xEC, 0x5A, 0xCC, 0x91, 
+0xD2, 0x88, 0x49, 0xEE, 0x6E, 0x41, 0xF6, 0x1C, 
+0xAF, 0xC1, 0xD0, 0xC3, 0x7F, 0x52, 0x7B, 0xFB, 
+0x69, 0xDE, 0x6B, 0x99, 0x96, 0x2F, 0x26, 0x35, 
+0x37, 0xDF, 0xE6, 0x59, 0xF1, 0x50, 0x5C, 0x63, 
+0x69, 0x63, 0x2A, 0xA8, 0x28, 0x2A, 0xFA, 0x1A, 
+0x84, 0xE8, 0x12, 0xDE, 0x82, 0x84, 0xA5, 0x94, 
+0x16, 0x67, 0x88, 0x89, 0x2C, 0x15, 0x7F, 0xBD, 
+0x74, 0xE3, 0x23, 0xDA, 0x9B, 0xE6, 0xEF, 0xD1, 
+0x59, 0xB0, 0x11, 0x78, 0xB6, 0x40, 0xC5, 0x6A, 
+0x27, 0x6A, 0x2A, 0x63, 0xF6, 0x7F, 0xFC, 0xC9, 
+0x1C, 0x46, 0xAF, 0xF0, 0x1B, 0xFB, 0x80, 0x1B, 
+0x88, 
+
+0xD1, 0xFF, 0x22, 0x60, 0xA9, 0x31, 0xC8, 0x55, 
+0x07, 0x37, 0xD6, 0x7C, 0x88, 0x4D, 0xCE, 0x40, 
+0x15, 0xBE, 0x96, 0x02, 0xE8, 0x48, 0x17, 0x7B, 
+0xB2, 0xF6, 0x8D, 0x92, 0xC7, 0x4D, 0x15, 0xC5, 
+0xAC, 0x73, 0x87, 0x7A, 0x3C, 0xB0, 0xBB, 0x90, 
+0xF0, 0xDA, 0x27, 0x72, 0x4A, 0xE5, 0x0D, 0x12, 
+0xBB, 0x0D, 0x56, 0xEE, 0xA4, 0x98, 0xE6, 0x66, 
+0xC9, 0x2C, 0x8D, 0x28, 0x8C, 0x29, 0xF0, 0x1F, 
+0xA3, 0xF9, 0x89, 0x81, 0x2C, 0x78, 0xCE, 0x0A, 
+0x43, 0xF0, 0x5D, 0xA9, 0xC6, 0x25, 0x60, 0x00, 
+0xE5, 0x6C, 0xCC, 0x75, 0x92, 0xF5, 0xCF, 0xFE, 
+0xD3, 0xAB, 0x84, 0xDB, 0x70, 0x91, 0x57, 0x26, 
+0x3B, 0x4A, 0x2A, 0x0E, 0x0F, 0x5E, 0x96, 0xC9, 
+0x52, 0x9D, 0x78, 0x53, 0x97, 0x23, 0x29, 0x42, 
+0x09, 0x41, 0x0F, 0x9C, 0x9C, 0xA3, 0x7A, 0x00, 
+0xDE, 0xDC, 0xF8, 0xC3, 0x20, 0xE4, 0x54, 0x14, 
+0x81, 0x5B, 0xE1, 0xFC, 0x7B, 0xA9, 0x95, 0x5A, 
+0xEC, 0x6E, 0xC5, 0xEC, 0x01, 0x86, 0x9D, 0x38, 
+0x7A, 0x2A, 0x1A, 0x55, 0xC3, 0xA4, 0x17, 0xD7, 
+0xB9, 0x67, 0xE5, 0xBE, 0x22, 0xFA, 0xEB, 0x8B, 
+0x9F, 0x60, 0x24, 0x85, 0x6C, 0xA9, 0x4F, 0x8D, 
+0x4D, 0x90, 0xE4, 0x50, 0x70, 0x57, 0x0F, 0x5C, 
+0xF7, 0x04, 0x84, 0x79, 0x86, 0x86, 0x8E, 0x92, 
+0x90, 0xB0, 0xA1, 0xE4, 0x4F, 0xAF, 0x72, 0xD1, 
+0xB5, 0x46, 0x0C, 0xBA, 0x67, 0x05, 0x40, 0x29, 
+0x05, 0x75, 0xA2, 0x2A, 0x01, 0xDD, 0x54, 0x98, 
+0xA3, 0xE1, 
+
+0x88, 0x76, 0x57, 0x19, 0x12, 0x31, 0xC4, 0x68, 
+0xC4, 0xBE, 0x1A, 0x98, 0x74, 0x0E, 0x3E, 0xA8, 
+0x01, 0x90, 0x67, 0xC0, 0xF1, 0x9F, 0x10, 0xB6, 
+0x6F, 0xF8, 0x61, 0xDD, 0xB1, 0x95, 0x90, 0x54, 
+0x3B, 0x6A, 0x27, 0x3B, 0x73, 0x5A, 0x28, 0x20, 
+0x4F, 0x73, 0x53, 0x73, 0x09, 0xFF, 0x1F, 0x82, 
+0xE1, 0x9C, 0x57, 0xCD, 0xDF, 0x70, 0xE2, 0x55, 
+0x9A, 0xDD, 0xFF, 0xD1, 0x48, 0x64, 0xD6, 0xA2, 
+0x75, 0xBE, 0xFA, 0x57, 0xE1, 0x2A, 0x53, 0x9C, 
+0x7C, 0x71, 0x2F, 0x45, 0x1C, 0x4E, 0x9D, 0xBD, 
+0x96, 0x57, 0x3B, 0xBF, 0xE0, 0xE0, 0xA0, 0x00, 
+0x87, 0x9C, 0xC5, 0x99, 0x6C, 0xC7, 0x66, 0xE4, 
+0x10, 0x06, 0xCE, 0x60, 0x7C, 0x4B, 0x68, 0x90, 
+0x72, 0x0E, 0xE4, 0x43, 0x89, 0xA8, 0x0E, 0x08, 
+0xB8, 0x1D, 0x0F, 0xF8, 0x28, 0x22, 0x36, 0x9E, 
+0x2E, 0x59, 0x06, 0x5C, 0x9A, 0xCB, 0x6B, 0xB4, 
+0xC8, 0x9D, 0x3F, 0x65, 0x70, 0xE7, 0x4A, 0xC2, 
+0x92, 0x23, 0x9D, 0x58, 0xC0, 0xCF, 0x2E, 0xCD, 
+0x52, 0x61, 0xB3, 0x17, 0xF7, 0x8E, 0xC3, 0x6B, 
+0x72, 0x35, 0xB5, 0xB2, 0x9E, 0x48, 0x2F, 0x93, 
+0x27, 0x01, 0xAD, 0x83, 0xA8, 0x82, 0x48, 0xE3, 
+0x5B, 0x38, 0x9A, 0x1D, 0x17, 0x2C, 0xCF, 0x04, 
+0xC8, 0x72, 0x4F, 0x98, 0xAA, 0xF7, 0x66, 0x88, 
+0x67, 0x88, 0xBE, 0xE7, 0x33, 0xDA, 0x12, 0x99, 
+0x9E, 0xB0, 0xEF, 0xB1, 0xAF, 0x8F, 0xC1, 0x48, 
+0xB3, 0x81, 0x30, 0xA9, 0x31, 0xA1, 0x84, 0xC9, 
+0xFA, 0xF1, 0x7E, 
+
+0xED, 0x04, 0xA0, 0xE7, 0xBE, 0x48, 0xF9, 0x02, 
+0x7A, 0x23, 0xF5, 0x2A, 0xF0, 0x4C, 0x7D, 0x91, 
+0x2E, 0x20, 0x54, 0x02, 0x42, 0xC7, 0x95, 0xF8, 
+0x7B, 0x44, 0xB2, 0x8F, 0x2B, 0xD6, 0x18, 0x51, 
+0x8F, 0x6C, 0xF6, 0x59, 0x78, 0xFF, 0xA5, 0xD2, 
+0xFA, 0x9D, 0xC7, 0x58, 0xC6, 0x49, 0xB3, 0x02, 
+0xB6, 0x36, 0x79, 0x22, 0x39, 0xE5, 0x08, 0x1D, 
+0xE2, 0x0C, 0x27, 0xEC, 0x2B, 0x80, 0xAF, 0x0B, 
+0x3F, 0x83, 0x74, 0xE1, 0x01, 0x45, 0xCF, 0x67, 
+0xC9, 0x3E, 0x3A, 0xB4, 0x84, 0x43, 0x11, 0x95, 
+0x3D, 0x52, 0x04, 0x9A, 0xD6, 0x57, 0x9E, 0x3F, 
+0x18, 0x1A, 0x9C, 0xD7, 0x82, 0x4A, 0x6B, 0x8B, 
+0x6F, 0xFF, 0x59, 0x3B, 0x31, 0x40, 0xF1, 0x6F, 
+0x92, 0x74, 0x49, 0x76, 0xDE, 0x80, 0x51, 0xFE, 
+0xE3, 0x73, 0x35, 0xB5, 0x4B, 0x21, 0xB4, 0x5D, 
+0x24, 0xCF, 0x4C, 0x9D, 0xB7, 0x18, 0x23, 0xB0, 
+0xA1, 0x3B, 0x4B, 0x21, 0xE0, 0x48, 0xA0, 0x4F, 
+0x33, 0xE5, 0x17, 0xEE, 0xDC, 0xE8, 0x90, 0x00, 
+0xD5, 0x54, 0x02, 0xED, 0x51, 0x31, 0x15, 0xF3, 
+0xA3, 0xD7, 0xB6, 0x17, 0xBC, 0x70, 0x1A, 0x3B, 
+0xF5, 0x9D, 0x09, 0xD0, 0x36, 0xEE, 0x77, 0x85, 
+0x2D, 0xD2, 0x15, 0xB7, 0x02, 0x19, 0xCD, 0x88, 
+0x41, 0xD7, 0x03, 0x25, 0xA7, 0x79, 0x51, 0x22, 
+0xF7, 0x2F, 0x61, 0x56, 0xF0, 0xC5, 0x1D, 0xD5, 
+0xF4, 0x52, 0xC0, 0x8C, 0x9E, 0x71, 0xA2, 0xED, 
+0xF3, 0xB2, 0xB3, 0x36, 0xAB, 0x6F, 0x4F, 0x99, 
+0x85, 0xB2, 0x86, 0x9F, 
+
+0x27, 0xB1, 0xCF, 0xD4, 0x55, 0x88, 0xE6, 0x89, 
+0x0C, 0xA8, 0x6A, 0x67, 0x9E, 0x0A, 0xE1, 0x53, 
+0xEA, 0xD5, 0xA2, 0x66, 0x26, 0x07, 0x21, 0xD0, 
+0xBF, 0x8F, 0xC7, 0x53, 0x3A, 0xD0, 0x81, 0x63, 
+0x24, 0x59, 0xD8, 0xF5, 0x5E, 0xD1, 0xFB, 0x42, 
+0xC9, 0x13, 0xB7, 0xA3, 0xBB, 0x66, 0x0E, 0x8E, 
+0xDB, 0x54, 0xA9, 0x8F, 0x0E, 0xCB, 0xA0, 0x1C, 
+0xDA, 0xAF, 0x07, 0xDD, 0xA8, 0x5B, 0x8F, 0x5E, 
+0x26, 0xCA, 0x6D, 0x4B, 0x6C, 0x3A, 0x7A, 0x73, 
+0x4A, 0x0F, 0x43, 0x70, 0x24, 0x72, 0x88, 0xB0, 
+0x14, 0x3B, 0xC2, 0xFA, 0xE9, 0x9E, 0x8A, 0x7F, 
+0x98, 0x97, 0x63, 0xF0, 0x59, 0x23, 0x1B, 0x97, 
+0xC5, 0x46, 0xD8, 0xDF, 0xFC, 0xB9, 0xE1, 0xD9, 
+0xBC, 0x06, 0x71, 0x80, 0x18, 0x72, 0xC3, 0xD1, 
+0x7D, 0x9F, 0x5B, 0x21, 0x5B, 0xD9, 0xAA, 0xB8, 
+0x66, 0x13, 0x8D, 0x9C, 0x92, 0x43, 0x3B, 0xE9, 
+0x7E, 0x76, 0x44, 0x59, 0xB0, 0xB3, 0x72, 0x9B, 
+0xD0, 0x23, 0x76, 0xF6, 0xA7, 0x12, 0x94, 0x57, 
+0xB3, 0xF0, 0xB6, 0x21, 0x32, 0x49, 0x21, 0x8F, 
+0x88, 0x0C, 0xE2, 0x5A, 0xE6, 0xF7, 0xAA, 0x82, 
+0xD5, 0xC9, 0x8E, 0x52, 0xDC, 0x58, 0x3F, 0x74, 
+0xD5, 0x55, 0x03, 0xC8, 0x7B, 0x67, 0x1B, 0xFF, 
+0xAD, 0x16, 0xC4, 0xA5, 0x15, 0x65, 0xDC, 0x69, 
+0xF8, 0x3A, 0x4F, 0x29, 0x58, 0x07, 0x34, 0x68, 
+0xDF, 0x6D, 0xAA, 0xFA, 0x65, 0x24, 0x98, 0x95, 
+0xA1, 0x16, 0xF1, 0x83, 0x4B, 0x46, 0xC6, 0xF8, 
+0x8D, 0x57, 0x33, 0x34, 0x3D, 
+
+0x0A, 0x34, 0x63, 0x70, 0xF5, 0x50, 0xE0, 0x61, 
+0x87, 0x5C, 0xBE, 0x61, 0x46, 0x01, 0x6E, 0xEB, 
+0x71, 0xDB, 0x4C, 0x76, 0x89, 0x20, 0x47, 0x7C, 
+0x6A, 0xB3, 0xAE, 0x21, 0x83, 0x1F, 0x4A, 0xB9, 
+0xE7, 0x74, 0x74, 0x2D, 0xFA, 0x1D, 0x98, 0x17, 
+0xE2, 0x98, 0x7B, 0x18, 0x7E, 0x7F, 0xBD, 0xFC, 
+0x56, 0xFA, 0x3A, 0xE9, 0x7C, 0xC2, 0x0F, 0xD7, 
+0x00, 0xCC, 0xCE, 0x92, 0x89, 0xD0, 0x84, 0x11, 
+0x66, 0xD6, 0x46, 0x97, 0x30, 0x11, 0x40, 0x04, 
+0xE4, 0x67, 0x2E, 0x6F, 0xF8, 0xC4, 0x9C, 0xAE, 
+0x7B, 0xE5, 0x2B, 0xF4, 0x5B, 0x99, 0x5A, 0x4E, 
+0x65, 0xC2, 0x33, 0x83, 0xD6, 0x41, 0x9C, 0x0D, 
+0x59, 0x50, 0x1E, 0xCA, 0xFC, 0x6B, 0xAC, 0xD7, 
+0x53, 0x04, 0x2C, 0xF5, 0xA0, 0x00, 0x35, 0x28, 
+0x03, 0x41, 0x33, 0xEC, 0xC2, 0x02, 0xB4, 0x0D, 
+0xD8, 0xE4, 0xFC, 0x04, 0x91, 0x91, 0x18, 0xD5, 
+0x55, 0xBA, 0x8B, 0xD6, 0xAC, 0x74, 0x66, 0x80, 
+0x61, 0x62, 0xC7, 0x1B, 0x96, 0x81, 0x03, 0x14, 
+0x52, 0x4D, 0x4B, 0x72, 0x46, 0x59, 0x68, 0xA2, 
+0x18, 0xF8, 0x93, 0xEA, 0x4E, 0x09, 0x65, 0x77, 
+0x37, 0x1A, 0x41, 0xE0, 0x91, 0x14, 0xF2, 0x18, 
+0xAF, 0xDE, 0x00, 0x96, 0xC9, 0x02, 0x47, 0xD0, 
+0xF8, 0xFA, 0xFB, 0xB3, 0x15, 0x45, 0xBE, 0xCB, 
+0x02, 0x35, 0x53, 0x8B, 0x30, 0x64, 0x2C, 0xC5, 
+0x36, 0x20, 0xB0, 0x0B, 0xDE, 0xBE, 0x62, 0xFF, 
+0xD0, 0x5A, 0xDE, 0xB1, 0x28, 0x35, 0x67, 0xAD, 
+0x69, 0x7D, 0x34, 0xB1, 0x01, 0x0B, 
+
+0x34, 0x5C, 0xA5, 0xF4, 0xC3, 0xC8, 0xA0, 0xE7, 
+0xA5, 0xB6, 0x1B, 0x24, 0x5F, 0xD1, 0x06, 0x5D, 
+0x0A, 0xBC, 0xA2, 0x12, 0x24, 0xB8, 0xEE, 0x37, 
+0x0F, 0xCE, 0x36, 0x6C, 0x51, 0x31, 0x33, 0xAB, 
+0xBE, 0xA0, 0x14, 0x17, 0x0C, 0x1A, 0xCE, 0xF3, 
+0xCC, 0x4A, 0xD3, 0xA0, 0xC2, 0x35, 0xF9, 0x32, 
+0x93, 0xC9, 0xB5, 0x85, 0x48, 0xB2, 0xBE, 0xA8, 
+0x4D, 0x8D, 0x60, 0x04, 0xF8, 0x4D, 0xCB, 0xE3, 
+0xF6, 0x7D, 0x99, 0xFE, 0xD5, 0xC9, 0xF4, 0x9A, 
+0x05, 0x15, 0x58, 0x62, 0x23, 0xEE, 0xFE, 0xEE, 
+0xF3, 0x95, 0x84, 0xA4, 0x27, 0xFE, 0x23, 0x95, 
+0xE3, 0xB5, 0x39, 0x81, 0x9B, 0x1A, 0x08, 0x43, 
+0x67, 0xB8, 0x4C, 0xAD, 0x5F, 0x19, 0xF5, 0xE7, 
+0xAA, 0x80, 0x80, 0x19, 0xF5, 0x65, 0x86, 0xD2, 
+0x56, 0xF4, 0x04, 0xC0, 0x14, 0x41, 0x8E, 0x29, 
+0x16, 0x9C, 0x39, 0xDC, 0x97, 0x46, 0x47, 0xA4, 
+0xE2, 0x69, 0x8E, 0x91, 0xF6, 0x6A, 0xEB, 0x04, 
+0xF3, 0xD1, 0xF6, 0xD9, 0xE9, 0x29, 0x9D, 0x86, 
+0x02, 0x9B, 0xF8, 0x33, 0x63, 0xE1, 0x54, 0xBF, 
+0x29, 0xF8, 0x7C, 0xE0, 0x6F, 0x4D, 0x0A, 0x96, 
+0x4A, 0xCF, 0x57, 0x62, 0xEE, 0x03, 0xE3, 0x73, 
+0x5F, 0x6F, 0x03, 0xF5, 0x0A, 0xE9, 0x02, 0xF9, 
+0x34, 0xF1, 0x7F, 0x0D, 0xB8, 0x32, 0x3C, 0xAA, 
+0x4F, 0xA8, 0x28, 0x7A, 0x08, 0xEF, 0x62, 0x7C, 
+0xAE, 0x32, 0xF9, 0xF2, 0xA5, 0x44, 0x17, 0x39, 
+0xA9, 0x20, 0x55, 0x94, 0x99, 0x14, 0xD0, 0x19, 
+0xAF, 0xCB, 0x06, 0x8B, 0xF3, 0xD8, 0x66, 
+
+0xE0, 0x0C, 0x02, 0x90, 0xCF, 0x2F, 0x82, 0xC7, 
+0xDF, 0xA0, 0xD5, 0x6A, 0x38, 0x45, 0x37, 0x24, 
+0xF1, 0xF8, 0x08, 0xFE, 0x62, 0xDA, 0xB5, 0x1F, 
+0x53, 0xCA, 0x7A, 0x3E, 0x2B, 0xB1, 0x0D, 0xDF, 
+0xEF, 0xEA, 0xCD, 0x86, 0x3F, 0x4A, 0x16, 0xCB, 
+0x1F, 0xA2, 0xA6, 0xC0, 0xDD, 0x94, 0xB4, 0xF0, 
+0x93, 0x26, 0x97, 0x50, 0x05, 0x9F, 0x69, 0xBC, 
+0x56, 0x99, 0x46, 0xB1, 0x1D, 0xA9, 0x39, 0xE4, 
+0x22, 0x6A, 0xF6, 0xEC, 0xAC, 0x3B, 0xF2, 0x24, 
+0x77, 0xDD, 0x2F, 0xFF, 0xBC, 0x8E, 0xCC, 0xCB, 
+0x56, 0xA5, 0xB3, 0x68, 0x81, 0x09, 0x1E, 0x7B, 
+0x0D, 0x43, 0x35, 0xF7, 0x9B, 0x8E, 0x0D, 0xF7, 
+0xEA, 0x5F, 0x92, 0xC7, 0x02, 0xEF, 0xBB, 0x40, 
+0x20, 0x66, 0x4F, 0xBB, 0xB3, 0xB8, 0xA7, 0xC2, 
+0xF0, 0x26, 0x6F, 0x60, 0xB8, 0x87, 0x2E, 0x72, 
+0x98, 0xC1, 0x9F, 0xC0, 0x8D, 0x2A, 0x4C, 0x3B, 
+0xED, 0x75, 0xE1, 0x45, 0x81, 0x4F, 0x29, 0x9C, 
+0xD6, 0xD0, 0x71, 0x81, 0x23, 0xD9, 0x89, 0x89, 
+0xFA, 0xFD, 0xEF, 0x3D, 0x1E, 0x82, 0xC8, 0xAE, 
+0x45, 0xB3, 0xC6, 0x30, 0x77, 0x8A, 0x3B, 0x28, 
+0xF1, 0xEE, 0x53, 0x84, 0x79, 0xEA, 0x72, 0xA3, 
+0xB6, 0x0D, 0xA9, 0xEA, 0x2F, 0x14, 0xAF, 0x0D, 
+0x1D, 0xF0, 0x86, 0x37, 0xD9, 0xA6, 0xF3, 0x27, 
+0x14, 0xE7, 0x6F, 0xFB, 0x61, 0x0C, 0x1F, 0xD7, 
+0xBB, 0x25, 0x2D, 0x83, 0xF1, 0xCA, 0x24, 0xD6, 
+0x84, 0xC3, 0xB7, 0xAC, 0x24, 0x31, 0x4D, 0x67, 
+0x87, 0x95, 0x41, 0x81, 0x23, 0x9F, 0x53, 0x38, 
+
+0x3E, 0xDE, 0x8B, 0xD6, 0x27, 0x78, 0x56, 0xF4, 
+0xC5, 0x07, 0xE5, 0xB1, 0x87, 0x1D, 0x65, 0xE5, 
+0x11, 0x97, 0x40, 0xC6, 0x16, 0x2A, 0xA9, 0x59, 
+0x09, 0x34, 0xA1, 0xF7, 0x5B, 0x53, 0x26, 0xD5, 
+0x03, 0xD9, 0xC5, 0xD2, 0xE0, 0x10, 0xF9, 0x3B, 
+0x6C, 0x04, 0xAF, 0x88, 0x2A, 0x53, 0xA8, 0x13, 
+0x33, 0x54, 0xD0, 0x10, 0x8D, 0xCC, 0xB7, 0x6C, 
+0xAB, 0xA1, 0xA2, 0x9D, 0x0E, 0xB8, 0xAC, 0x53, 
+0x61, 0x86, 0xD8, 0x9B, 0xB0, 0xC8, 0x31, 0x18, 
+0x5A, 0x22, 0x80, 0x3C, 0x37, 0xBC, 0xEF, 0x71, 
+0x8A, 0x4D, 0x6C, 0xD1, 0x41, 0x29, 0x75, 0xEF, 
+0xF8, 0x2E, 0x66, 0xBE, 0x5C, 0x26, 0x3B, 0x04, 
+0xB3, 0x15, 0xE4, 0x1D, 0xDD, 0xF3, 0xE2, 0xF0, 
+0x58, 0x5C, 0x85, 0x01, 0x70, 0x3B, 0x9B, 0xE0, 
+0x09, 0x4E, 0xC4, 0x0F, 0x3D, 0x2E, 0x1B, 0x0B, 
+0x95, 0xA2, 0x5F, 0x74, 0x7D, 0xD2, 0xB2, 0xEB, 
+0xC1, 0x83, 0x53, 0x4B, 0x1A, 0xBF, 0x05, 0xC8, 
+0xED, 0xF6, 0x5C, 0xE8, 0x63, 0x5B, 0x76, 0xBE, 
+0x91, 0xAD, 0x1F, 0x37, 0x25, 0x67, 0xAD, 0xE3, 
+0xE5, 0x72, 0x43, 0x87, 0x0C, 0x1F, 0x25, 0xFC, 
+0xE5, 0x7D, 0x51, 0x8B, 0x0E, 0x1C, 0x7E, 0x7C, 
+0xA9, 0x0D, 0xCB, 0x9B, 0xD9, 0x2B, 0xF3, 0x58, 
+0x04, 0x87, 0x06, 0x23, 0x00, 0x4A, 0x97, 0x18, 
+0xA3, 0xCE, 0xD9, 0xCC, 0xCF, 0xA9, 0x90, 0xD2, 
+0x1E, 0x1C, 0x39, 0xBC, 0x75, 0xD5, 0xA1, 0xDE, 
+0x3F, 0x16, 0x5F, 0xE5, 0xF1, 0x4F, 0xCA, 0x28, 
+0xCE, 0xA5, 0x94, 0x3E, 0xA9, 0x94, 0x64, 0x23, 
+0x7C, 
+
+0x7D, 0xA4, 0x3C, 0xD4, 0x39, 0x99, 0xBD, 0x46, 
+0x4A, 0x01, 0xC5, 0x16, 0x81, 0xAB, 0xE4, 0xB5, 
+0xE4, 0x0A, 0xC9, 0x2C, 0xA7, 0xE5, 0x16, 0xDE, 
+0xDF, 0x66, 0xB2, 0x71, 0x1F, 0x56, 0xD2, 0x0B, 
+0x01, 0x75, 0x40, 0x6B, 0xF0, 0xF4, 0xF2, 0x6E, 
+0xD0, 0xC2, 0x31, 0x4E, 0xBB, 0xBE, 0x09, 0xBB, 
+0x2C, 0xC2, 0x6B, 0x3A, 0xA1, 0x3B, 0xB7, 0x74, 
+0x39, 0x74, 0x13, 0x51, 0xA7, 0x10, 0x1F, 0x0D, 
+0x48, 0x7B, 0xA5, 0xEE, 0xBC, 0x67, 0xB5, 0xB3, 
+0x17, 0xBB, 0x6C, 0xE9, 0x4A, 0x4D, 0x48, 0x64, 
+0xFE, 0x23, 0x58, 0x3C, 0xBF, 0xD2, 0x46, 0x7F, 
+0x0B, 0x9F, 0x49, 0x7F, 0x4D, 0x74, 0x30, 0x30, 
+0x86, 0xA1, 0xDF, 0xF6, 0xEE, 0xDC, 0x88, 0xBB, 
+0x13, 0x71, 0x82, 0x40, 0x8B, 0x83, 0x0B, 0x63, 
+0x9B, 0x3D, 0xCF, 0xDC, 0x8E, 0x6E, 0xD1, 0xB7, 
+0x22, 0x34, 0x39, 0x6F, 0x38, 0xB8, 0xEB, 0x10, 
+0x4B, 0x7A, 0x96, 0xE1, 0x0E, 0xC9, 0x07, 0x9E, 
+0x2A, 0xA9, 0x1D, 0x9F, 0x87, 0x56, 0x50, 0xB5, 
+0x30, 0xBF, 0xBC, 0x64, 0x29, 0x23, 0xF4, 0x11, 
+0x04, 0x80, 0x48, 0xF8, 0x8E, 0x57, 0x7D, 0x76, 
+0xF4, 0x68, 0x0C, 0x3C, 0xD3, 0x10, 0x9A, 0x67, 
+0x3B, 0x59, 0x72, 0x66, 0x13, 0x3F, 0xB0, 0xCC, 
+0x2D, 0x5F, 0xDC, 0xD8, 0xD2, 0x69, 0x10, 0xBE, 
+0x49, 0x92, 0xFD, 0xC3, 0xAE, 0xE3, 0xB4, 0x9F, 
+0x3E, 0xDB, 0x30, 0x7D, 0x6A, 0xD4, 0x4B, 0x7A, 
+0xE5, 0x8D, 0xA6, 0x56, 0xE1, 0xA9, 0x62, 0x4D, 
+0x80, 0xE0, 0xED, 0x5D, 0xA5, 0xB5, 0x2F, 0xA9, 
+0xCE, 0x46, 
+
+0xC5, 0xC0, 0x9F, 0xD5, 0x37, 0x60, 0xB8, 0x5B, 
+0xDD, 0xB9, 0x20, 0xC8, 0x27, 0xA4, 0x96, 0xAC, 
+0xA8, 0xEF, 0xC3, 0x53, 0x0C, 0xCB, 0xC6, 0x50, 
+0x1C, 0x54, 0xBB, 0xAA, 0x56, 0x32, 0x18, 0x6B, 
+0x51, 0x64, 0x05, 0xDA, 0xDD, 0x99, 0x9B, 0x69, 
+0xDF, 0x66, 0x59, 0x3B, 0xC4, 0x8B, 0x34, 0x1E, 
+0xAE, 0x42, 0x9C, 0xAF, 0xEB, 0x9A, 0x98, 0x69, 
+0x31, 0x23, 0x5B, 0x20, 0x89, 0xA0, 0xFC, 0x33, 
+0x12, 0x63, 0x00, 0x72, 0xE6, 0x1D, 0x14, 0xD6, 
+0x31, 0x2A, 0x57, 0x5C, 0x47, 0xBB, 0x9A, 0x72, 
+0x01, 0x0D, 0x65, 0x8D, 0x85, 0xFC, 0x27, 0xA7, 
+0xE8, 0x50, 0xD5, 0xC5, 0xB5, 0x4E, 0xEF, 0x9C, 
+0x17, 0xB4, 0x22, 0x3F, 0x76, 0xE7, 0xBB, 0x0A, 
+0x1A, 0x1B, 0xE9, 0xF0, 0x63, 0x64, 0x39, 0x57, 
+0xAC, 0x09, 0xD1, 0xF5, 0xB0, 0x99, 0xB8, 0x34, 
+0x2D, 0x20, 0x1B, 0x50, 0xFA, 0x25, 0xE7, 0xF6, 
+0x42, 0x94, 0x44, 0x72, 0x8C, 0x37, 0xF6, 0xBE, 
+0xDD, 0x21, 0xA8, 0xB4, 0xF1, 0x8B, 0x4E, 0xC5, 
+0x94, 0xB3, 0xA7, 0x4B, 0x43, 0x92, 0xD3, 0xB8, 
+0x85, 0x39, 0xE5, 0x4A, 0xC3, 0x66, 0x9D, 0xE5, 
+0x72, 0x5A, 0xD0, 0x95, 0x0A, 0x96, 0xC6, 0xC4, 
+0xA2, 0x18, 0x77, 0xCC, 0x46, 0xEA, 0x7B, 0x5B, 
+0xE6, 0x19, 0x8C, 0xA0, 0x81, 0x5E, 0xA5, 0x89, 
+0x6A, 0xD4, 0x01, 0x1E, 0x32, 0xE6, 0xBB, 0x8F, 
+0x9E, 0x4B, 0xAE, 0xE5, 0xD6, 0x68, 0x21, 0x15, 
+0xFD, 0x3C, 0x5C, 0x80, 0xC5, 0x56, 0x1B, 0x84, 
+0xBA, 0x6D, 0x68, 0x64, 0x5A, 0xCA, 0x8F, 0x82, 
+0x16, 0xE2, 0x56, 
+
+0x7A, 0xD3, 0x10, 0x0F, 0xB6, 0x03, 0x1B, 0x55, 
+0x6A, 0x3F, 0x73, 0xA9, 0x00, 0x17, 0x5A, 0x16, 
+0x80, 0xA3, 0x5A, 0x1D, 0xE3, 0xB5, 0x67, 0xB1, 
+0xB6, 0x61, 0xF0, 0x65, 0x85, 0x5C, 0x1E, 0x31, 
+0xE8, 0x53, 0x58, 0xE5, 0x43, 0x19, 0x4D, 0x87, 
+0x84, 0x71, 0xFD, 0x05, 0x8D, 0x62, 0xE9, 0xA5, 
+0xC0, 0xCF, 0xCD, 0x5D, 0xCE, 0xBB, 0x87, 0x88, 
+0xFE, 0x09, 0x71, 0x6C, 0x62, 0xB6, 0x3C, 0x2C, 
+0xA9, 0x4E, 0x5D, 0x68, 0x3A, 0xF2, 0x4D, 0x30, 
+0xA1, 0xC9, 0x2D, 0x2C, 0x70, 0x03, 0x29, 0x7D, 
+0x84, 0x57, 0xDE, 0xD7, 0x5F, 0x89, 0x71, 0xA3, 
+0xEA, 0x3F, 0xDA, 0x7F, 0xD8, 0xA9, 0x02, 0x8F, 
+0x76, 0x66, 0xA0, 0x6A, 0x8B, 0x83, 0x28, 0x23, 
+0x18, 0x90, 0xBF, 0x79, 0x68, 0xC3, 0x16, 0xE0, 
+0xCF, 0x16, 0x79, 0x47, 0xC5, 0xF1, 0x30, 0xD5, 
+0x78, 0x2F, 0xD7, 0x14, 0xBA, 0xA9, 0xE1, 0x28, 
+0x72, 0x98, 0x0C, 0xD5, 0xD0, 0x06, 0xC1, 0x0D, 
+0xE0, 0x2D, 0x5A, 0xBD, 0xED, 0xBC, 0xD9, 0x2C, 
+0x05, 0x06, 0x56, 0x71, 0xAB, 0x6F, 0x84, 0xCA, 
+0xBF, 0x2D, 0xCE, 0x28, 0x51, 0x86, 0x46, 0x08, 
+0x70, 0x05, 0x90, 0x70, 0xBB, 0x75, 0x10, 0xE3, 
+0xE3, 0xD5, 0x7C, 0x7B, 0x05, 0x52, 0x1B, 0xFF, 
+0xF3, 0xF0, 0xF2, 0xB7, 0x55, 0x4E, 0xDE, 0x91, 
+0x0B, 0x85, 0xD0, 0x69, 0xD7, 0xE8, 0xE6, 0xB5, 
+0x82, 0x7D, 0x9F, 0xAC, 0xED, 0x13, 0x7D, 0x24, 
+0x64, 0xD8, 0xAC, 0xF0, 0xE7, 0x59, 0x3E, 0xFF, 
+0xC3, 0x4A, 0xEA, 0x74, 0x12, 0xB5, 0x36, 0xF8, 
+0x49, 0x51, 0xF1, 0xFF, 
+
+0x20, 0x13, 0xF0, 0x23, 0x5A, 0x3D, 0x1E, 0x16, 
+0xEB, 0x6C, 0x1A, 0x28, 0x35, 0x59, 0x61, 0xEB, 
+0x5C, 0xA7, 0x40, 0x86, 0x1B, 0x18, 0xB6, 0x81, 
+0x0E, 0xB8, 0x3B, 0xBB, 0x4A, 0x9A, 0xCC, 0xF4, 
+0xB3, 0x98, 0x6C, 0xDC, 0x57, 0xFE, 0xE8, 0x21, 
+0xCF, 0x7E, 0xA9, 0x87, 0xED, 0xDB, 0xB9, 0x71, 
+0x12, 0xE3, 0x54, 0x48, 0x6D, 0x83, 0xEE, 0x20, 
+0x4F, 0x69, 0x8C, 0x9D, 0x88, 0xEE, 0x8D, 0x04, 
+0x37, 0x60, 0xFC, 0x46, 0x75, 0xA2, 0x65, 0x48, 
+0x54, 0xC1, 0x98, 0x32, 0x6A, 0x9E, 0xF7, 0x85, 
+0x06, 0x04, 0xBA, 0xCD, 0xCB, 0x74, 0xC2, 0x93, 
+0x26, 0xFA, 0x3E, 0x47, 0xAB, 0xFE, 0xE2, 0xA8, 
+0x68, 0x49, 0xEE, 0x6D, 0xCB, 0xB9, 0xEC, 0x39, 
+0x97, 0xEC, 0x5B, 0x49, 0x95, 0xAA, 0xEC, 0x3F, 
+0x2D, 0x67, 0x98, 0x6B, 0xD8, 0x77, 0xB9, 0x52, 
+0x8A, 0xD6, 0xF6, 0xFC, 0x57, 0x0F, 0xF8, 0xC7, 
+0x0D, 0xAA, 0xFB, 0x76, 0x43, 0x78, 0xD4, 0x02, 
+0xA7, 0x2B, 0xBB, 0x50, 0x2D, 0xF4, 0xBB, 0x4F, 
+0x4C, 0xA1, 0x14, 0xAC, 0x64, 0x48, 0xD9, 0x37, 
+0x87, 0x06, 0xF3, 0xAE, 0x1F, 0xCA, 0xB1, 0xE9, 
+0xA5, 0x28, 0x26, 0x36, 0xEC, 0x69, 0xD1, 0x37, 
+0x44, 0x3A, 0x70, 0x5D, 0xEB, 0xD4, 0xEB, 0xC0, 
+0x39, 0x7E, 0x35, 0x82, 0xE8, 0x9E, 0xB4, 0x3B, 
+0x3B, 0x4B, 0xB8, 0xA2, 0x8F, 0xBF, 0x7A, 0x49, 
+0xE5, 0xC7, 0xF8, 0x93, 0x8D, 0x51, 0x53, 0xE0, 
+0xDF, 0x22, 0xBD, 0x5C, 0x2B, 0x4B, 0xB4, 0x07, 
+0x26, 0xB4, 0x1A, 0x26, 0x98, 0xF0, 0x22, 0xE4, 
+0x7F, 0xA0, 0xEB, 0xBC, 0x77, 
+
+0x17, 0xD0, 0x73, 0xD3, 0x6C, 0x02, 0x4E, 0x78, 
+0x47, 0x7E, 0x78, 0x98, 0xAF, 0x5E, 0x1E, 0xC0, 
+0x94, 0x94, 0xC6, 0x3A, 0x8E, 0xC3, 0xC7, 0x67, 
+0x6E, 0x20, 0x10, 0x7B, 0xA0, 0x60, 0x38, 0xEC, 
+0xFE, 0xEC, 0x57, 0xB2, 0xD1, 0xB1, 0x0C, 0x63, 
+0x5D, 0x2E, 0xD0, 0x3B, 0x47, 0xCE, 0xA5, 0x8B, 
+0xBC, 0x05, 0xFF, 0xBE, 0xDD, 0xF9, 0x07, 0x2C, 
+0xF6, 0x8B, 0x6C, 0x70, 0x54, 0xDF, 0xE9, 0x44, 
+0x25, 0x6D, 0x05, 0xCD, 0x6F, 0x8F, 0x68, 0x85, 
+0x22, 0x6D, 0x8B, 0x82, 0x5F, 0x8E, 0x08, 0x77, 
+0xFF, 0x98, 0xB8, 0x7B, 0xC9, 0xB7, 0xCA, 0xB1, 
+0x86, 0x61, 0x27, 0xD2, 0xD3, 0x05, 0x50, 0x98, 
+0x79, 0x1B, 0x46, 0xFE, 0xDB, 0x57, 0x46, 0x54, 
+0xDA, 0xAC, 0x9A, 0x41, 0x0E, 0x99, 0x3D, 0xD7, 
+0x9D, 0xF9, 0xF8, 0x82, 0x3B, 0x51, 0x78, 0x2B, 
+0x74, 0xB4, 0x89, 0x7F, 0x51, 0xC8, 0x08, 0x26, 
+0x01, 0x27, 0xDE, 0x64, 0x18, 0x2D, 0x17, 0x06, 
+0x70, 0x8F, 0xE5, 0x24, 0x2F, 0xA0, 0x46, 0x0F, 
+0x19, 0xBC, 0x1E, 0x72, 0x74, 0xD7, 0x9A, 0x4D, 
+0x0C, 0x0B, 0xCA, 0xC7, 0xCE, 0x67, 0xA2, 0x7D, 
+0xBF, 0x2A, 0xD7, 0xB1, 0x69, 0x61, 0x50, 0x56, 
+0x97, 0xA4, 0x96, 0x7C, 0x0F, 0xF0, 0x90, 0xBC, 
+0xE0, 0x72, 0x95, 0x78, 0x57, 0xF0, 0xCF, 0x25, 
+0x41, 0x6A, 0x74, 0x5C, 0x4D, 0xD3, 0x1D, 0x28, 
+0xA9, 0x57, 0xA2, 0x06, 0x79, 0x6D, 0x86, 0x44, 
+0xD8, 0xC0, 0x54, 0xC3, 0xED, 0xD3, 0xCC, 0x6A, 
+0xC6, 0x21, 0xDD, 0xE5, 0xD6, 0xA8, 0x8A, 0xF3, 
+0xE8, 0xE8, 0x79, 0x38, 0xCC, 0x1A, 
+
+0x0A, 0x61, 0xFB, 0x38, 0x65, 0xFA, 0x9C, 0x5A, 
+0x9A, 0x7B, 0x81, 0x1B, 0x45, 0xAE, 0x22, 0xA4, 
+0xBA, 0xED, 0xEB, 0x04, 0x6D, 0x2B, 0x09, 0x10, 
+0x0A, 0xA2, 0x85, 0xF7, 0x16, 0x02, 0x8A, 0x32, 
+0x9A, 0x4D, 0x6A, 0x2F, 0xDF, 0xE5, 0xB4, 0xD2, 
+0x03, 0x90, 0x66, 0x7A, 0xD7, 0x2D, 0x45, 0x16, 
+0x44, 0x52, 0xA1, 0xEE, 0x55, 0x8F, 0xE8, 0xDC, 
+0x30, 0x46, 0x04, 0xB2, 0x7F, 0xBE, 0x84, 0xA9, 
+0xF2, 0xA0, 0x3E, 0x2E, 0x28, 0x24, 0x1E, 0xEE, 
+0xCA, 0x95, 0xBF, 0x04, 0xFD, 0xC1, 0x9A, 0xF7, 
+0x15, 0xF7, 0xE4, 0xF2, 0x99, 0x58, 0x74, 0x90, 
+0x88, 0xCE, 0x6A, 0x7D, 0x11, 0xB5, 0x17, 0x0A, 
+0x7C, 0x12, 0x16, 0xA8, 0x68, 0x5B, 0x06, 0x4E, 
+0x2D, 0x07, 0x1D, 0xD3, 0xDF, 0xBE, 0xF7, 0xF6, 
+0xB1, 0x75, 0x18, 0x02, 0x1E, 0xFC, 0xD1, 0xED, 
+0xAA, 0x5B, 0x5C, 0x49, 0x37, 0xA5, 0xE8, 0x43, 
+0xF8, 0x8D, 0x9B, 0x32, 0x0F, 0x62, 0x69, 0xF7, 
+0x67, 0xB6, 0x14, 0x88, 0xEE, 0xF2, 0x98, 0x99, 
+0xE0, 0x45, 0x39, 0x2A, 0x2D, 0xCC, 0x55, 0x5C, 
+0xBB, 0x63, 0xC8, 0x06, 0xD9, 0x97, 0xAD, 0x48, 
+0x4F, 0x0C, 0xE1, 0xC3, 0x76, 0xC5, 0xC2, 0xC1, 
+0x83, 0x53, 0x6F, 0xC0, 0x75, 0x89, 0xBE, 0x11, 
+0xFB, 0x6A, 0x6C, 0xE3, 0x7A, 0x32, 0xD8, 0x04, 
+0x37, 0x79, 0x54, 0x80, 0xE8, 0x47, 0xDE, 0xAC, 
+0x0D, 0x51, 0x21, 0xCB, 0x78, 0xEC, 0xC2, 0x7F, 
+0x2E, 0x52, 0x27, 0x07, 0x6A, 0x03, 0xE4, 0x3F, 
+0x0F, 0x19, 0xA2, 0x59, 0xD5, 0x7D, 0x91, 0xBD, 
+0xCE, 0x8B, 0x42, 0xEC, 0x0A, 0x0F, 0x93, 
+
+0xEF, 0x73, 0x34, 0x26, 0xAA, 0x03, 0xA2, 0xA2, 
+0x0F, 0xE3, 0xBB, 0x8C, 0xD2, 0x71, 0xAA, 0x0B, 
+0x94, 0x69, 0xBB, 0x7A, 0x57, 0xB3, 0x71, 0x31, 
+0x7C, 0x48, 0xA8, 0xF1, 0x23, 0xB9, 0xDD, 0x68, 
+0x8F, 0x8F, 0x24, 0x6A, 0xD6, 0xD1, 0x27, 0xCE, 
+0xCB, 0x70, 0x15, 0x96, 0x90, 0xC9, 0xA9, 0xA2, 
+0xC9, 0xDB, 0x25, 0x7A, 0x96, 0x5E, 0xCD, 0xB8, 
+0x22, 0xE5, 0x7D, 0x29, 0x50, 0x41, 0x9D, 0x83, 
+0x0B, 0xEC, 0x1D, 0xB8, 0x97, 0x3A, 0x1F, 0xB6, 
+0x58, 0x6D, 0x67, 0x6A, 0x2B, 0x8A, 0x7A, 0x72, 
+0xCB, 0xB0, 0x09, 0x8D, 0x51, 0x5E, 0x44, 0x54, 
+0xCD, 0x06, 0x75, 0xE7, 0xF9, 0x99, 0xA8, 0x9B, 
+0x83, 0x3F, 0x8F, 0xB2, 0x9A, 0x7B, 0x0B, 0x11, 
+0x96, 0xDF, 0x7E, 0xB6, 0xAE, 0xE5, 0x4A, 0x48, 
+0xB5, 0x2F, 0xBD, 0x90, 0x7C, 0x44, 0x41, 0x1E, 
+0x03, 0xE9, 0xA2, 0x3D, 0x72, 0xD3, 0xB3, 0xDB, 
+0x0D, 0x00, 0x90, 0xD4, 0x9D, 0xAB, 0xAC, 0xA3, 
+0xEE, 0xB5, 0xAB, 0x58, 0xD8, 0x5C, 0x6F, 0x88, 
+0x01, 0x40, 0xC8, 0x0D, 0x80, 0x63, 0x1A, 0x87, 
+0x1B, 0xCA, 0x62, 0xA0, 0x41, 0xDD, 0xFC, 0xB3, 
+0x18, 0xDE, 0xD6, 0xA8, 0x6C, 0xD4, 0x25, 0x01, 
+0x4C, 0xE0, 0xF3, 0x0A, 0x9C, 0xB0, 0x90, 0x5E, 
+0xA8, 0xF6, 0xA1, 0x69, 0xA8, 0x53, 0x20, 0xA3, 
+0x4A, 0x91, 0x52, 0xCC, 0x34, 0x0B, 0x9C, 0x03, 
+0x5C, 0xF9, 0xE6, 0x9E, 0x49, 0xCD, 0xDA, 0x85, 
+0x1A, 0x92, 0xCA, 0xEB, 0x28, 0x7B, 0x72, 0xA6, 
+0xD4, 0xB6, 0x86, 0x07, 0xFF, 0x44, 0x3F, 0xC7, 
+0x31, 0x58, 0xB1, 0x51, 0xF9, 0xC5, 0x35, 0xC9, 
+
+0xE8, 0x71, 0x92, 0xEC, 0x06, 0x14, 0xDD, 0x62, 
+0x7E, 0x8F, 0x74, 0xCA, 0x55, 0xBC, 0x22, 0xB5, 
+0x8D, 0xF1, 0x7A, 0xF7, 0x50, 0x13, 0xD0, 0x2B, 
+0x66, 0xBB, 0x28, 0xD0, 0x7D, 0x58, 0xD9, 0x86, 
+0x3D, 0x7B, 0x40, 0x70, 0x03, 0xAA, 0xE7, 0xE4, 
+0xC9, 0xBC, 0x4C, 0x7E, 0x0F, 0xF2, 0xE2, 0x41, 
+0xEC, 0x5F, 0x66, 0x96, 0xC2, 0xA3, 0x70, 0xE5, 
+0xCA, 0xB9, 0xFF, 0xC2, 0x52, 0x77, 0x93, 0xA2, 
+0x74, 0x67, 0xD8, 0xA1, 0xFC, 0x28, 0xAF, 0x97, 
+0xE3, 0xDD, 0xF5, 0x92, 0x34, 0x11, 0x4A, 0xBE, 
+0x17, 0x9B, 0x0E, 0xFF, 0x84, 0x05, 0xE5, 0x57, 
+0x48, 0x5A, 0x80, 0xAE, 0xDD, 0x8C, 0xE6, 0x11, 
+0x32, 0x59, 0x04, 0x9E, 0xF4, 0x53, 0x80, 0xF3, 
+0xCF, 0x2B, 0x66, 0xAC, 0x1D, 0x3C, 0xDB, 0x32, 
+0x04, 0x7A, 0xC7, 0xED, 0xB2, 0x93, 0xBE, 0x08, 
+0x05, 0x15, 0x95, 0x52, 0xE1, 0x52, 0x0C, 0xCF, 
+0xAD, 0xB6, 0x87, 0x48, 0xAD, 0x6E, 0x6D, 0xC4, 
+0xE5, 0xAA, 0x57, 0x96, 0x72, 0xD2, 0x58, 0x0C, 
+0x19, 0x49, 0xBE, 0x04, 0x6B, 0x0A, 0x6F, 0xDA, 
+0xB8, 0x12, 0x78, 0x83, 0x39, 0x5C, 0x70, 0xAB, 
+0x5A, 0x21, 0xEA, 0xC5, 0x2A, 0x74, 0x8F, 0xA0, 
+0x6B, 0xFA, 0x64, 0x1D, 0x77, 0xC0, 0xCD, 0x27, 
+0xC9, 0x8D, 0x5D, 0x98, 0xE5, 0x74, 0xE2, 0x1A, 
+0xE0, 0x76, 0x01, 0x62, 0x2F, 0x1C, 0x00, 0xDC, 
+0xD6, 0x19, 0x5C, 0x20, 0x3B, 0x1F, 0x24, 0xB7, 
+0x4E, 0xF6, 0x21, 0xD5, 0xFD, 0x7C, 0x76, 0x49, 
+0xA9, 0xCA, 0x82, 0x4B, 0xE2, 0x39, 0x01, 0xD4, 
+0x68, 0x54, 0xEF, 0x98, 0xA2, 0xAC, 0x0F, 0x56, 
+0x12, 
+
+0xC3, 0x0F, 0xC9, 0x15, 0x1B, 0x48, 0xBE, 0xFB, 
+0x5F, 0x0D, 0xA2, 0xCD, 0x9B, 0xAC, 0xE2, 0x72, 
+0x06, 0x3B, 0xB4, 0xB6, 0x95, 0x1E, 0x51, 0xE8, 
+0xB6, 0xB6, 0x8E, 0x85, 0xAF, 0x3F, 0xF7, 0x30, 
+0x16, 0x2D, 0x4E, 0xCA, 0x4B, 0x70, 0x24, 0x10, 
+0x17, 0xCE, 0x01, 0x01, 0x82, 0xF2, 0x6E, 0xF4, 
+0xDE, 0x30, 0xEA, 0xBA, 0x1C, 0xA3, 0x79, 0x35, 
+0x67, 0x52, 0xCD, 0xFE, 0x59, 0x10, 0xC8, 0xF9, 
+0x1C, 0xD7, 0x9D, 0xB4, 0x82, 0xC0, 0x39, 0xC8, 
+0x37, 0xD9, 0x13, 0x2A, 0x21, 0x0A, 0x96, 0x93, 
+0x72, 0x6C, 0x3A, 0x3F, 0x21, 0xF5, 0xAB, 0x6C, 
+0xDF, 0x0C, 0x41, 0x7D, 0xDF, 0x81, 0xE9, 0xCB, 
+0xFF, 0x40, 0xDA, 0x63, 0x3E, 0xCC, 0xC8, 0x03, 
+0x46, 0x43, 0xFF, 0xFC, 0x2D, 0x9B, 0x94, 0x16, 
+0x25, 0xB5, 0xB7, 0x58, 0x75, 0x28, 0x40, 0xDA, 
+0x3C, 0x77, 0x2F, 0xDC, 0xA7, 0xF7, 0x6E, 0xC2, 
+0xA4, 0xF0, 0xDE, 0xAD, 0xDF, 0x68, 0x1E, 0xC6, 
+0xA3, 0x84, 0x35, 0x9B, 0x29, 0xE8, 0x26, 0x8F, 
+0x0F, 0xBD, 0x4C, 0x12, 0xE3, 0xC8, 0x1E, 0x5F, 
+0xEE, 0xA5, 0x19, 0x77, 0xC2, 0x59, 0x8A, 0x0F, 
+0xFC, 0xB8, 0xE0, 0x84, 0xFF, 0xAA, 0x3C, 0xF5, 
+0x03, 0xD2, 0x3D, 0x68, 0x4E, 0x62, 0xD0, 0x49, 
+0x66, 0x60, 0x52, 0xAB, 0x90, 0xD0, 0x99, 0x7C, 
+0xE1, 0xD6, 0xBA, 0x93, 0xD3, 0xDF, 0x50, 0xB4, 
+0x98, 0xDF, 0x15, 0xE9, 0xAC, 0xC9, 0x61, 0x46, 
+0x74, 0x6E, 0x89, 0x19, 0x42, 0x52, 0x83, 0x13, 
+0x8A, 0xDE, 0x03, 0x29, 0x67, 0x48, 0x56, 0x0E, 
+0xBA, 0x98, 0xDD, 0xB4, 0x00, 0x7C, 0xB1, 0xFA, 
+0x17, 0x97, 
+
+0xB1, 0x4F, 0x4B, 0xF6, 0x8F, 0xB4, 0xC6, 0x93, 
+0x1E, 0xD8, 0x78, 0xE7, 0xD8, 0xEE, 0x05, 0x06, 
+0x3A, 0x63, 0x97, 0x6C, 0x9A, 0x5C, 0xAF, 0x87, 
+0x4A, 0x16, 0xFC, 0x50, 0xEA, 0x2D, 0xDF, 0xC1, 
+0xC3, 0x70, 0x4D, 0xA4, 0x9F, 0xBC, 0x2D, 0x0A, 
+0xC7, 0x38, 0xE2, 0xFE, 0xF4, 0x8E, 0xC2, 0x24, 
+0x6C, 0x86, 0xC8, 0x50, 0x27, 0x03, 0xA5, 0xFC, 
+0x4B, 0x61, 0x14, 0x5F, 0x85, 0xEB, 0x77, 0xA7, 
+0x45, 0x57, 0x4A, 0x01, 0x94, 0x88, 0xCA, 0x01, 
+0x8B, 0x3D, 0x9F, 0x06, 0x38, 0x7A, 0x27, 0x2C, 
+0x0C, 0xFB, 0x87, 0xDC, 0xAF, 0x6E, 0x18, 0x9C, 
+0x5A, 0xD1, 0xDE, 0x5B, 0xB4, 0x38, 0xAB, 0x1F, 
+0x60, 0x38, 0xAD, 0x22, 0x83, 0xE4, 0x34, 0xD4, 
+0x25, 0xFC, 0x28, 0x6A, 0xB8, 0xEA, 0x83, 0xB1, 
+0x37, 0x5B, 0xEF, 0xB4, 0xE3, 0x01, 0xF6, 0x8A, 
+0xAA, 0xFC, 0xB4, 0x1D, 0x26, 0x03, 0xE2, 0xFF, 
+0x8A, 0x02, 0x59, 0x1B, 0xD8, 0xAA, 0x87, 0xDD, 
+0xAD, 0xF3, 0x82, 0x4F, 0x1C, 0x27, 0x71, 0xF5, 
+0xD0, 0x31, 0x90, 0xD7, 0x0D, 0x6F, 0x57, 0xC7, 
+0xD3, 0x8D, 0xC3, 0xBD, 0xB4, 0xC3, 0x84, 0xB4, 
+0x96, 0x85, 0xE4, 0x38, 0x6F, 0x90, 0x9B, 0xB3, 
+0x47, 0xDE, 0x73, 0x52, 0x54, 0x69, 0xA0, 0xD6, 
+0xD9, 0xA2, 0x16, 0x06, 0x16, 0x98, 0x24, 0xF8, 
+0x9E, 0x42, 0x08, 0x9D, 0xCE, 0x9C, 0x5D, 0xB4, 
+0x12, 0x55, 0xAC, 0xE4, 0x4D, 0x17, 0xCC, 0x5B, 
+0x33, 0xE6, 0xDE, 0xA8, 0xAA, 0xB3, 0x73, 0x5E, 
+0x56, 0x5B, 0x8E, 0x3B, 0x56, 0xD2, 0xF6, 0x9E, 
+0xE8, 0xAE, 0x44, 0x57, 0x21, 0x1A, 0xA7, 0x07, 
+0x12, 0xB7, 0xF9, 
+
+0xF2, 0x2E, 0xC7, 0x8D, 0x99, 0x4E, 0x21, 0x9D, 
+0x50, 0xA9, 0x7C, 0x66, 0x67, 0x4E, 0xC2, 0x6C, 
+0x65, 0x5D, 0x36, 0x54, 0x44, 0x1D, 0x7B, 0x07, 
+0xD1, 0xFF, 0xE6, 0x39, 0x10, 0xE2, 0xE5, 0xBB, 
+0x9A, 0xE9, 0xF8, 0x7C, 0x2D, 0x23, 0x34, 0xAA, 
+0x52, 0x9D, 0xA0, 0x92, 0x77, 0x43, 0xDF, 0x0A, 
+0x7C, 0xAC, 0xBE, 0x9A, 0x7F, 0xC7, 0x36, 0x82, 
+0x72, 0xB8, 0x37, 0x1D, 0x21, 0x82, 0x3C, 0x83, 
+0xF4, 0x6B, 0x12, 0xFA, 0xBD, 0x04, 0x1D, 0xFD, 
+0x7C, 0x2B, 0x16, 0x4B, 0x17, 0x4C, 0x76, 0x49, 
+0x56, 0xB2, 0xAC, 0xBB, 0x8B, 0x5B, 0xC8, 0x15, 
+0x23, 0x8D, 0xFE, 0x70, 0x46, 0x75, 0x73, 0x4A, 
+0xE5, 0x5F, 0xA0, 0x9C, 0x5A, 0x1C, 0xD7, 0x5A, 
+0xB3, 0xEC, 0x95, 0x7F, 0xC6, 0x05, 0x3F, 0x40, 
+0x8C, 0x2A, 0xF8, 0xFB, 0xA7, 0x3B, 0xE5, 0x3A, 
+0xA5, 0x2E, 0x32, 0xA1, 0xBF, 0x75, 0x52, 0xBD, 
+0x33, 0xAD, 0x7C, 0x14, 0xBE, 0xFB, 0x3D, 0xDA, 
+0xED, 0x65, 0xD2, 0x9D, 0xA0, 0x52, 0x62, 0x02, 
+0xB9, 0x90, 0x2D, 0xD1, 0xEF, 0xFA, 0xBF, 0x3D, 
+0x7D, 0xE6, 0x2E, 0xB2, 0xBC, 0x06, 0xF0, 0x31, 
+0xBA, 0x32, 0x79, 0xE8, 0x4F, 0xC8, 0x38, 0x0C, 
+0xA4, 0x21, 0x01, 0xBC, 0xCA, 0xE1, 0x71, 0xE6, 
+0xF3, 0x3A, 0x96, 0x6A, 0xFE, 0xC2, 0xDA, 0x7C, 
+0x2A, 0xD4, 0xED, 0x6A, 0xDD, 0x26, 0x57, 0x5E, 
+0x35, 0xD0, 0x99, 0x8B, 0x7F, 0x4F, 0xE9, 0x14, 
+0xFD, 0x29, 0x0E, 0x02, 0xFA, 0xE3, 0xB4, 0x16, 
+0x87, 0xB5, 0xC8, 0x4E, 0xA0, 0x9C, 0xE4, 0x7B, 
+0xF2, 0x0D, 0x79, 0x5C, 0x09, 0xAA, 0x02, 0xA3, 
+0x58, 0x32, 0x8B, 0xB5, 
+
+0xB3, 0xDA, 0x6E, 0x94, 0x37, 0xB8, 0x82, 0x3D, 
+0xA3, 0x03, 0x93, 0x5B, 0x78, 0x57, 0x56, 0xD6, 
+0x50, 0xD3, 0xAF, 0x44, 0xFF, 0x81, 0xAA, 0xFA, 
+0x6C, 0x3A, 0x06, 0xAF, 0x12, 0x26, 0x6D, 0x7E, 
+0x34, 0xD0, 0x5F, 0x71, 0x50, 0xD1, 0x79, 0xA5, 
+0xEC, 0x10, 0x63, 0xEA, 0x8D, 0x53, 0xF0, 0xF3, 
+0xA7, 0x44, 0x32, 0xB8, 0x8D, 0x4E, 0xB0, 0x4E, 
+0x4D, 0x81, 0x69, 0xD2, 0xB1, 0xF7, 0xB6, 0x28, 
+0x26, 0xBC, 0x7A, 0x5E, 0x3A, 0x3A, 0x86, 0x58, 
+0x2F, 0x9E, 0xB9, 0xE0, 0x34, 0xC2, 0x7B, 0xF3, 
+0x3C, 0x3E, 0x2D, 0x9B, 0x60, 0x3F, 0x12, 0x26, 
+0xD7, 0xF2, 0xE4, 0xDF, 0x64, 0xF1, 0x21, 0x0B, 
+0xF6, 0xD3, 0xE8, 0x49, 0x54, 0x97, 0xDD, 0x08, 
+0x34, 0x21, 0xF2, 0x7B, 0x4F, 0xDE, 0xB6, 0xF3, 
+0x04, 0x5F, 0x79, 0x6F, 0xBF, 0xBE, 0xD0, 0xA8, 
+0xA1, 0x55, 0xB9, 0xF8, 0x77, 0xA4, 0x57, 0x56, 
+0xF4, 0x8E, 0xB8, 0xBF, 0x29, 0x2D, 0x7D, 0x58, 
+0xE1, 0x66, 0x2F, 0xC5, 0x27, 0xAB, 0x4D, 0x4E, 
+0xED, 0x43, 0xD8, 0xE0, 0xC6, 0x54, 0x0D, 0x82, 
+0x4B, 0xC0, 0x33, 0xCC, 0x51, 0x08, 0x38, 0x5F, 
+0x17, 0x24, 0xDB, 0xCB, 0xAC, 0xCD, 0x50, 0xD8, 
+0xBC, 0x9B, 0x11, 0x33, 0xEF, 0xA5, 0x1B, 0x8F, 
+0x05, 0x49, 0xD6, 0xD9, 0x00, 0x11, 0x18, 0x5A, 
+0x0E, 0x55, 0x97, 0xFB, 0xEC, 0x33, 0xEA, 0x9E, 
+0x4F, 0xCD, 0x09, 0x74, 0x9C, 0xFE, 0xA3, 0x73, 
+0xA9, 0x86, 0xDB, 0xDC, 0x01, 0x2D, 0xC5, 0x91, 
+0x63, 0xB2, 0xB5, 0x78, 0xA5, 0xBD, 0xDA, 0x1C, 
+0x6A, 0xE9, 0xAE, 0xF2, 0x69, 0xE8, 0xE3, 0x23, 
+0xFD, 0xE6, 0xBA, 0x41, 0x5A, 
+
+0x3A, 0xB7, 0x25, 0xAC, 0xB9, 0x03, 0x0A, 0xCC, 
+0x37, 0x54, 0x05, 0xCD, 0x28, 0x11, 0x70, 0x7D, 
+0xCC, 0x27, 0xAA, 0x79, 0x8F, 0x1A, 0x99, 0x7D, 
+0xD7, 0xFF, 0x63, 0x94, 0x00, 0x89, 0xF8, 0xFD, 
+0xB0, 0x44, 0xD6, 0x37, 0x5B, 0x3F, 0x2C, 0xC8, 
+0x2D, 0x90, 0xBB, 0x22, 0x82, 0x2F, 0xAD, 0xAE, 
+0x82, 0xC8, 0xB2, 0x05, 0x5C, 0x7F, 0x84, 0x33, 
+0x2C, 0xFB, 0xDA, 0x77, 0x21, 0xF1, 0xCA, 0x63, 
+0x06, 0x3D, 0x10, 0x13, 0x74, 0xA0, 0xA1, 0x2E, 
+0xC9, 0x12, 0x67, 0x16, 0x91, 0x70, 0x1E, 0xE7, 
+0xF2, 0x51, 0xFE, 0x4B, 0xD1, 0x85, 0xFE, 0xCD, 
+0x01, 0x9C, 0x65, 0x10, 0x94, 0x80, 0x2D, 0xC2, 
+0xB1, 0x28, 0x1C, 0xB3, 0x97, 0xD0, 0x2D, 0xB0, 
+0x75, 0x70, 0x03, 0xE7, 0x71, 0x4B, 0x0E, 0xFC, 
+0x7C, 0x69, 0xF8, 0x6D, 0x74, 0x9C, 0x71, 0xF2, 
+0x27, 0x7F, 0x1E, 0x66, 0xAA, 0x76, 0x26, 0x4A, 
+0x1A, 0x35, 0x68, 0xA1, 0x33, 0xA0, 0x6F, 0xF6, 
+0xB7, 0x46, 0x3D, 0xE7, 0x1C, 0x19, 0x05, 0x63, 
+0x46, 0xEB, 0x69, 0x7B, 0xE0, 0xCD, 0x63, 0xDA, 
+0xD0, 0xB1, 0x85, 0x62, 0xC4, 0x24, 0x85, 0xB6, 
+0xF2, 0xC1, 0x7C, 0x72, 0x3C, 0x3C, 0x1E, 0xD1, 
+0x53, 0x47, 0x60, 0xB7, 0x79, 0x4A, 0x7C, 0x0A, 
+0x6F, 0xFF, 0xF8, 0xAA, 0xF0, 0xA6, 0x24, 0x4C, 
+0x7D, 0xD8, 0xA8, 0xE5, 0xC4, 0x2C, 0xF6, 0x9F, 
+0xE3, 0xF5, 0x74, 0x9F, 0x90, 0xAF, 0x6F, 0x3F, 
+0xFC, 0x7D, 0x7A, 0x63, 0x98, 0xFD, 0xC0, 0xCB, 
+0xD9, 0x9A, 0xCE, 0x96, 0xA3, 0xA6, 0x2B, 0x51, 
+0xDE, 0x40, 0x9A, 0x52, 0xBA, 0xE4, 0x6B, 0xF4, 
+0x1B, 0x38, 0x32, 0x53, 0xB7, 0x66, 
+
+0xFC, 0x59, 0xCA, 0xFD, 0x5F, 0x7D, 0x9F, 0xAC, 
+0x15, 0x4C, 0x04, 0x20, 0x32, 0xF4, 0x6B, 0xFB, 
+0xF7, 0x7B, 0x70, 0x88, 0x7D, 0xC0, 0xD3, 0x3B, 
+0x5D, 0x0E, 0x65, 0x2C, 0x7E, 0x0F, 0xAE, 0xBD, 
+0x97, 0x3B, 0x95, 0x27, 0x29, 0xCD, 0xD5, 0x40, 
+0x89, 0x3A, 0xD9, 0x67, 0xE4, 0x71, 0x0A, 0x2B, 
+0xE0, 0x29, 0x53, 0xE8, 0xDA, 0x2C, 0xE0, 0xC3, 
+0x6B, 0x1D, 0x16, 0x6B, 0x14, 0x07, 0x34, 0x64, 
+0x98, 0x2C, 0x96, 0xDD, 0x35, 0x6A, 0xBC, 0xB2, 
+0x50, 0x1D, 0xF0, 0x3D, 0x21, 0x33, 0xF3, 0xD7, 
+0x07, 0x79, 0xAF, 0xF4, 0x85, 0xB3, 0x47, 0xFA, 
+0xA4, 0x33, 0xC4, 0x0B, 0xF3, 0xDA, 0x7B, 0x1A, 
+0x68, 0x33, 0x8C, 0x0D, 0xF0, 0xB0, 0x77, 0xAE, 
+0x4C, 0x11, 0xC0, 0x49, 0xEA, 0xEE, 0x25, 0x72, 
+0xE3, 0x1A, 0x6F, 0x99, 0xD1, 0xB0, 0xD4, 0x8A, 
+0x1E, 0x42, 0xF5, 0x64, 0x3E, 0x26, 0x2F, 0x59, 
+0x71, 0x05, 0x86, 0xD6, 0xC6, 0xFA, 0x36, 0x74, 
+0x9D, 0x40, 0x59, 0xF2, 0x23, 0xC3, 0x6B, 0xAD, 
+0x44, 0x61, 0x0B, 0xB8, 0x53, 0xA7, 0x59, 0x58, 
+0x89, 0x93, 0xEA, 0x86, 0x55, 0x1E, 0x4D, 0x8D, 
+0x4A, 0x8C, 0x84, 0xA8, 0x9A, 0xBD, 0x68, 0x19, 
+0x12, 0x16, 0x1A, 0xF0, 0x3A, 0x4B, 0xA4, 0xD7, 
+0x82, 0x80, 0x81, 0x6E, 0x3F, 0x6B, 0x52, 0xDE, 
+0xA5, 0x01, 0xD6, 0xC3, 0x8E, 0xEC, 0x8B, 0x63, 
+0x68, 0xF2, 0xE2, 0xF3, 0xAC, 0x1B, 0x95, 0x62, 
+0x65, 0x21, 0x01, 0x2F, 0x9B, 0x61, 0xA7, 0x1C, 
+0xF4, 0x27, 0x29, 0x3A, 0xAB, 0xF1, 0x87, 0xB3, 
+0x95, 0x3A, 0x06, 0x28, 0x0C, 0xB5, 0x8A, 0xFB, 
+0xD0, 0xAD, 0x3E, 0x8A, 0x87, 0xF0, 0xF8, 
+
+0x41, 0x37, 0x4B, 0xE9, 0x11, 0x07, 0x02, 0x22, 
+0x71, 0xAA, 0xCD, 0x9E, 0xC6, 0x66, 0xE7, 0x15, 
+0xC1, 0xCE, 0x2B, 0x5C, 0xFF, 0xA3, 0xB7, 0x39, 
+0x27, 0x0B, 0x33, 0xFA, 0xFA, 0x03, 0xE4, 0x49, 
+0xAC, 0x1F, 0xE3, 0xD3, 0xF9, 0xA6, 0x3D, 0x68, 
+0x51, 0xA5, 0x07, 0xF4, 0x22, 0x5C, 0xD8, 0xE7, 
+0xA7, 0xF6, 0xB6, 0x0B, 0xCF, 0x15, 0x48, 0xC9, 
+0x6A, 0x6A, 0x36, 0xA6, 0x6D, 0x9D, 0x93, 0xD5, 
+0xC1, 0x5F, 0xA5, 0x38, 0x24, 0x65, 0xEB, 0x0C, 
+0x87, 0x30, 0x4B, 0x17, 0xC2, 0x19, 0xB3, 0xCF, 
+0x45, 0x7A, 0xA8, 0x5B, 0x49, 0xCA, 0xE7, 0xBE, 
+0x9D, 0x56, 0x5A, 0xE6, 0xE0, 0xB7, 0x3B, 0x5C, 
+0xCF, 0x27, 0x62, 0x49, 0x46, 0xD7, 0xFE, 0x0C, 
+0xEE, 0x9E, 0xB1, 0x0B, 0x6C, 0xBA, 0x64, 0xC9, 
+0xC9, 0x97, 0xD0, 0x1B, 0x8E, 0xF5, 0xFF, 0xB9, 
+0xF3, 0x6A, 0xFA, 0x3A, 0xAF, 0x74, 0xEB, 0xB7, 
+0xE0, 0x6C, 0x0E, 0xDE, 0xE6, 0x78, 0xEC, 0x14, 
+0x33, 0x3A, 0xE7, 0xCF, 0xA6, 0x2D, 0xAC, 0xAD, 
+0x22, 0x5C, 0x98, 0x87, 0xD9, 0x57, 0x1E, 0x33, 
+0x1A, 0x03, 0xC1, 0xE3, 0x5F, 0xB1, 0x1C, 0xEC, 
+0x76, 0x22, 0xAC, 0x45, 0x72, 0x3B, 0xCC, 0x94, 
+0xD4, 0xB0, 0x79, 0x63, 0x14, 0x5C, 0xCD, 0x3B, 
+0x55, 0xC6, 0x02, 0xD4, 0x5B, 0x49, 0x0E, 0x2D, 
+0x7E, 0x8A, 0xA4, 0x10, 0xFA, 0x13, 0x0C, 0x0B, 
+0xDD, 0x41, 0x65, 0x8B, 0x81, 0xF2, 0x1F, 0x0E, 
+0x61, 0x95, 0xE3, 0xE5, 0x1B, 0x2E, 0x33, 0x49, 
+0x94, 0x59, 0x52, 0xA3, 0x99, 0x9F, 0xE3, 0xDA, 
+0xA9, 0x8F, 0x65, 0x2B, 0x63, 0xD1, 0xFD, 0x5D, 
+0x6B, 0x4B, 0xD6, 0xF4, 0x5A, 0xD8, 0xE4, 0x44, 
+
+0xE2, 0xBF, 0xD2, 0x43, 0x32, 0x11, 0x07, 0xC5, 
+0xF0, 0x37, 0xC6, 0xA6, 0x7D, 0x4D, 0x62, 0xEF, 
+0x28, 0x29, 0x92, 0x5B, 0x68, 0x59, 0x5C, 0xC3, 
+0x27, 0xF2, 0x3E, 0xF5, 0xAA, 0xD2, 0xF0, 0x0B, 
+0x3E, 0x5B, 0xA7, 0xFF, 0x2F, 0x5D, 0x76, 0xD6, 
+0x71, 0xCD, 0x91, 0xC1, 0x32, 0xBF, 0x4B, 0xA0, 
+0x91, 0x37, 0xF4, 0xBE, 0xA9, 0x00, 0xD8, 0x12, 
+0xA1, 0x07, 0x0D, 0x84, 0xCD, 0x49, 0x33, 0xE2, 
+0xB5, 0xF0, 0x39, 0x2F, 0x12, 0xC9, 0xB3, 0x00, 
+0xF5, 0xDF, 0xB3, 0xFC, 0x80, 0x2B, 0xE5, 0x0C, 
+0x20, 0x60, 0x72, 0xE4, 0x1F, 0x3E, 0x3B, 0x97, 
+0x35, 0xB0, 0x3F, 0x65, 0xAF, 0x7B, 0x43, 0x71, 
+0x83, 0xBE, 0x1F, 0x9E, 0x7D, 0xCD, 0x6B, 0x80, 
+0xAB, 0xCC, 0x24, 0xDD, 0x99, 0xEF, 0xBC, 0xF0, 
+0x02, 0x9B, 0x33, 0x08, 0x6F, 0xF0, 0x10, 0x1B, 
+0x1D, 0xA5, 0x63, 0xC3, 0x1E, 0x73, 0x19, 0x14, 
+0x16, 0xAD, 0x21, 0x30, 0x89, 0x39, 0xD7, 0xAD, 
+0xCC, 0xCC, 0x6F, 0x60, 0xAE, 0xD7, 0xC6, 0x47, 
+0xF9, 0x94, 0xA4, 0xE0, 0x70, 0xFC, 0xBE, 0xAF, 
+0x96, 0x16, 0x4F, 0x79, 0xB0, 0xF6, 0xA6, 0x99, 
+0x84, 0xC6, 0xDE, 0x4E, 0x27, 0x71, 0xB0, 0x70, 
+0x1E, 0x04, 0x51, 0xE5, 0x2E, 0xC3, 0x3E, 0x47, 
+0x43, 0x6C, 0x34, 0xA0, 0x0C, 0xDE, 0xA4, 0xCD, 
+0xAD, 0x3B, 0x41, 0x2B, 0xFD, 0x0A, 0xA7, 0x75, 
+0x64, 0x2E, 0x2F, 0x12, 0xFE, 0x01, 0x29, 0xAC, 
+0xD4, 0x82, 0xD3, 0x0F, 0x17, 0x9A, 0x0A, 0xE5, 
+0x5E, 0xF7, 0xB3, 0xF3, 0x7F, 0x44, 0x6D, 0x96, 
+0x2A, 0xB5, 0x0C, 0x45, 0x6A, 0x3E, 0xAD, 0x93, 
+0x21, 0xEC, 0xD2, 0x44, 0xA7, 0x22, 0x21, 0x9D, 
+0xEB, 
+
+0x61, 0xBC, 0x8A, 0xEB, 0x07, 0xC7, 0xF1, 0x37, 
+0x05, 0xA3, 0x65, 0x25, 0x54, 0x70, 0xB7, 0xC7, 
+0x88, 0xE8, 0x87, 0x26, 0xFA, 0x5F, 0x38, 0x8A, 
+0x9E, 0x08, 0x07, 0x14, 0x28, 0x16, 0xE5, 0x74, 
+0xBE, 0x14, 0x62, 0x81, 0xB0, 0xF4, 0x84, 0xDA, 
+0xD0, 0xF6, 0xB0, 0x60, 0x88, 0x36, 0xB4, 0x01, 
+0x19, 0x40, 0x57, 0x1A, 0x05, 0x3D, 0xEB, 0x1B, 
+0x6F, 0x19, 0xEA, 0x1E, 0xC6, 0x37, 0xFD, 0x1A, 
+0x7B, 0x6D, 0x41, 0xD5, 0x18, 0x81, 0x30, 0xF6, 
+0xAD, 0x7E, 0x18, 0xE9, 0xDC, 0xE4, 0x79, 0xD6, 
+0x8D, 0xD6, 0x41, 0xA5, 0x24, 0x12, 0xA7, 0xF9, 
+0x25, 0xC6, 0x1E, 0x71, 0x91, 0x49, 0xC1, 0xF9, 
+0x20, 0x62, 0x2A, 0x3B, 0xFB, 0xA6, 0xF1, 0x0E, 
+0x1C, 0x14, 0xDC, 0x41, 0x7B, 0x4E, 0xB5, 0xC9, 
+0x93, 0xC4, 0x30, 0xE2, 0x12, 0x6A, 0x35, 0x6D, 
+0xF7, 0x3E, 0x79, 0xB3, 0xF7, 0xB1, 0x3C, 0xBE, 
+0xB6, 0x18, 0x27, 0xC1, 0x24, 0xA1, 0x11, 0x3C, 
+0xAB, 0x42, 0x26, 0x1B, 0x5B, 0xAF, 0x9B, 0xE5, 
+0x98, 0x5E, 0xE3, 0xEF, 0xB2, 0xE9, 0x11, 0xB1, 
+0xB6, 0x45, 0xC6, 0x6A, 0x59, 0xCC, 0x2D, 0x08, 
+0x6A, 0xB6, 0x65, 0x8D, 0x0F, 0xE1, 0x2A, 0x7E, 
+0x78, 0xD1, 0x1E, 0x22, 0x69, 0xEB, 0xEE, 0xE8, 
+0xE4, 0x05, 0xC5, 0x00, 0x28, 0x86, 0x49, 0xB8, 
+0x93, 0xF5, 0x5B, 0xBD, 0x9C, 0x1C, 0x1F, 0x4E, 
+0xD7, 0x36, 0x07, 0xB4, 0x21, 0xB0, 0x19, 0x33, 
+0x8E, 0x92, 0x13, 0x00, 0xB6, 0x79, 0x98, 0xDD, 
+0xEC, 0xEB, 0x14, 0x19, 0x16, 0xC6, 0x35, 0xAF, 
+0x47, 0xDB, 0x64, 0x12, 0xDE, 0xB8, 0x2D, 0xD4, 
+0x44, 0xE7, 0xF6, 0x68, 0x68, 0x0D, 0xBD, 0x19, 
+0x05, 0xFB, 
+
+0xE7, 0x5A, 0x33, 0xC7, 0xC1, 0x30, 0x2D, 0xEE, 
+0x28, 0x64, 0x20, 0x66, 0xE3, 0x22, 0x3B, 0x1F, 
+0x7B, 0x10, 0x8F, 0xFB, 0x12, 0xF5, 0xC2, 0x99, 
+0x6A, 0x40, 0x6D, 0x3D, 0x09, 0xBD, 0xB5, 0x70, 
+0x68, 0x91, 0xEA, 0x31, 0xEB, 0xB1, 0xA7, 0xBC, 
+0xE9, 0x50, 0xE7, 0xFF, 0x13, 0x40, 0xA3, 0x2C, 
+0x08, 0xE1, 0x70, 0x4B, 0xD2, 0x14, 0xE2, 0xC1, 
+0x8C, 0x30, 0xE3, 0x86, 0xEB, 0xDF, 0xCC, 0xF8, 
+0xC0, 0x5D, 0xC0, 0x68, 0x7E, 0x84, 0xC1, 0x64, 
+0x44, 0xB7, 0x87, 0x79, 0x46, 0x6A, 0x0D, 0x50, 
+0x1B, 0x8F, 0x42, 0x26, 0x2E, 0x58, 0x98, 0x8C, 
+0x12, 0x44, 0x23, 0x1F, 0x57, 0x04, 0xBB, 0x98, 
+0xCD, 0xB5, 0x24, 0xFB, 0x68, 0xEB, 0x42, 0x37, 
+0x1D, 0x3C, 0x28, 0xA9, 0xBC, 0x31, 0x3A, 0x44, 
+0xAD, 0x07, 0x25, 0xA1, 0x76, 0xF9, 0xA6, 0xCA, 
+0x6A, 0x2D, 0xC6, 0xBA, 0x46, 0x41, 0x80, 0x04, 
+0xE8, 0x55, 0x27, 0x67, 0x85, 0x6C, 0x74, 0xD4, 
+0x89, 0x6C, 0x3B, 0xB8, 0x6F, 0x90, 0xEE, 0x3E, 
+0x34, 0x54, 0xCE, 0xCD, 0x07, 0xDE, 0x39, 0x0B, 
+0x0A, 0x8E, 0x07, 0x79, 0xDE, 0xEC, 0xD4, 0x72, 
+0x08, 0xD1, 0xC0, 0x29, 0xE3, 0xA9, 0x88, 0x06, 
+0xF3, 0xC4, 0xA5, 0x82, 0x9F, 0x81, 0x60, 0xFD, 
+0xAF, 0xCD, 0x64, 0x6D, 0xB5, 0x4F, 0xC7, 0x9B, 
+0xC5, 0xA6, 0x05, 0xB9, 0xB6, 0xFC, 0x7D, 0x8F, 
+0x8F, 0x29, 0xE4, 0x95, 0x2B, 0x3E, 0x93, 0xBF, 
+0xB4, 0x56, 0x83, 0x4B, 0xA7, 0x4D, 0x3B, 0x11, 
+0x87, 0x96, 0x39, 0x1E, 0x34, 0x51, 0xB1, 0x89, 
+0xC2, 0xC5, 0xDE, 0xE6, 0x6D, 0x83, 0x43, 0xC7, 
+0x33, 0x3F, 0xD4, 0xF6, 0x10, 0x2A, 0x44, 0x5C, 
+0xF0, 0x7D, 0xE8, 
+
+0xE2, 0xED, 0xB6, 0xFC, 0x37, 0xA3, 0x01, 0x74, 
+0xCB, 0x33, 0x73, 0x9E, 0x16, 0x39, 0x32, 0x5D, 
+0xDA, 0x6D, 0x89, 0x58, 0x13, 0x58, 0x33, 0xE4, 
+0xB8, 0x81, 0x46, 0xEE, 0x79, 0x2D, 0xBE, 0xDF, 
+0x34, 0xC5, 0xD8, 0x09, 0x8E, 0x09, 0x79, 0x0C, 
+0x94, 0x57, 0x32, 0xB3, 0x28, 0x60, 0x9B, 0xC2, 
+0xB7, 0x5F, 0xBE, 0x51, 0x64, 0x04, 0x3A, 0x25, 
+0xA6, 0x32, 0x4F, 0x4A, 0x36, 0xF0, 0x67, 0x59, 
+0x3E, 0x31, 0xDF, 0x1E, 0x79, 0xB8, 0xC2, 0x79, 
+0x36, 0x23, 0x54, 0x89, 0xCD, 0x07, 0x3B, 0xE0, 
+0x2D, 0x34, 0x99, 0x2F, 0xCF, 0x13, 0x6D, 0xA5, 
+0xE5, 0xFF, 0x6A, 0x7E, 0xBB, 0x87, 0x1D, 0xA0, 
+0x50, 0x70, 0xE4, 0x41, 0xDC, 0xBE, 0x76, 0x8D, 
+0x0B, 0xCF, 0x4A, 0x23, 0xC9, 0xFB, 0xDB, 0x99, 
+0xBD, 0x87, 0x30, 0x64, 0x8C, 0x2E, 0xEA, 0xCC, 
+0xA9, 0xC9, 0x2B, 0xCB, 0xA1, 0xB9, 0xC2, 0x2C, 
+0x47, 0xA1, 0xEA, 0x42, 0xD6, 0x89, 0x8E, 0xCA, 
+0x04, 0x41, 0xF2, 0x32, 0x82, 0xE7, 0x00, 0x43, 
+0x7D, 0x12, 0x5B, 0x63, 0x1C, 0x84, 0x22, 0xA1, 
+0x24, 0x0D, 0xD5, 0x9A, 0xC4, 0xBE, 0x14, 0xF9, 
+0x67, 0xA8, 0xF8, 0xFA, 0x86, 0x71, 0x26, 0x02, 
+0xDA, 0x88, 0x5D, 0x32, 0x1A, 0x22, 0x5D, 0x2D, 
+0x23, 0xB4, 0x79, 0xA5, 0xD6, 0xF9, 0x5C, 0x92, 
+0x53, 0x4C, 0xDD, 0xED, 0x31, 0x9B, 0xF4, 0x69, 
+0x58, 0x52, 0x3A, 0x0F, 0x5A, 0xCA, 0xBE, 0x6C, 
+0x94, 0xD3, 0x83, 0x89, 0xD2, 0xCC, 0x40, 0x57, 
+0x37, 0x7C, 0xE1, 0xF1, 0x17, 0xA3, 0x87, 0x09, 
+0xFE, 0x9F, 0xAA, 0xCA, 0x79, 0x88, 0xCA, 0x81, 
+0x75, 0xF1, 0x9B, 0x24, 0xEE, 0x6E, 0x1F, 0x5F, 
+0xDD, 0x50, 0x7D, 0x41, 
+
+0xE4, 0xA5, 0xBB, 0x99, 0xAF, 0x54, 0xE9, 0xDA, 
+0xDA, 0x30, 0xAB, 0x5D, 0x03, 0xB5, 0x09, 0x22, 
+0xCD, 0x0E, 0xA1, 0x6E, 0x9B, 0x55, 0x99, 0xEE, 
+0xCF, 0xC5, 0xAA, 0x31, 0xE4, 0x4C, 0xC6, 0xB4, 
+0x2D, 0xBE, 0xA8, 0x1E, 0x2E, 0xE3, 0x67, 0x44, 
+0x37, 0x86, 0xD5, 0x67, 0x53, 0xBA, 0x3F, 0x92, 
+0x5F, 0x16, 0x08, 0xEC, 0x16, 0xF0, 0x31, 0x21, 
+0x50, 0x6E, 0xAC, 0xAF, 0xC3, 0xA0, 0x83, 0x8F, 
+0x51, 0xDE, 0x61, 0xEE, 0x9C, 0x43, 0xAA, 0x6B, 
+0x9B, 0x50, 0x74, 0x66, 0xD5, 0x15, 0x90, 0x9B, 
+0x7E, 0x50, 0x9E, 0x31, 0xFA, 0xB5, 0xC2, 0x68, 
+0xB5, 0x85, 0x16, 0xD7, 0xFC, 0x00, 0x63, 0xDF, 
+0xB7, 0xAD, 0x1A, 0xBA, 0x25, 0xDD, 0x84, 0x18, 
+0x2B, 0xF9, 0x64, 0xD1, 0x78, 0xF4, 0x6D, 0xBA, 
+0xBB, 0xAC, 0x0F, 0x35, 0xB5, 0x31, 0xC2, 0xCD, 
+0x64, 0xB4, 0x52, 0xF7, 0xE5, 0x96, 0xF4, 0xEC, 
+0xD6, 0x91, 0x10, 0x41, 0x90, 0x75, 0x54, 0x49, 
+0x6C, 0xAB, 0x35, 0x41, 0x71, 0xDA, 0x38, 0xE7, 
+0x90, 0x66, 0x45, 0xF8, 0xC2, 0x9F, 0xC6, 0x6F, 
+0xAD, 0xA0, 0xC0, 0x63, 0x17, 0xFD, 0xB3, 0x1C, 
+0x1A, 0x0C, 0x58, 0x77, 0xF0, 0x38, 0xD8, 0x20, 
+0x3D, 0x4A, 0x65, 0xB8, 0xC6, 0xCB, 0x7B, 0x4B, 
+0xDD, 0xE9, 0x91, 0x10, 0xF7, 0x3C, 0xA6, 0x36, 
+0x90, 0x72, 0x5C, 0x58, 0x5F, 0xBF, 0x57, 0x62, 
+0xDB, 0xA0, 0x70, 0xFC, 0xF4, 0x17, 0x6C, 0xA8, 
+0x6B, 0x95, 0x3E, 0x4A, 0x5F, 0xE1, 0x5F, 0xBF, 
+0xF6, 0x05, 0x45, 0x6F, 0xE7, 0xFB, 0x8D, 0xCC, 
+0xE1, 0xF3, 0xB4, 0x39, 0x9B, 0x63, 0x79, 0xB6, 
+0xB3, 0xD0, 0xCF, 0x19, 0x8D, 0xE6, 0x81, 0xE3, 
+0x1A, 0x8E, 0xF4, 0x14, 0xF3, 
+
+0x6E, 0xC1, 0xA0, 0xEB, 0x32, 0x94, 0x2C, 0x3B, 
+0x8F, 0xF1, 0xDC, 0x75, 0x02, 0x4F, 0xF0, 0x5B, 
+0x25, 0xE6, 0x51, 0x0C, 0x30, 0x02, 0xB8, 0xBB, 
+0x84, 0x29, 0xEB, 0xAC, 0x73, 0x2B, 0x1B, 0x67, 
+0x57, 0xDA, 0x1C, 0x3E, 0xA5, 0x57, 0x5C, 0x12, 
+0x39, 0x86, 0x54, 0x28, 0xA4, 0x76, 0xC0, 0x34, 
+0x29, 0xAF, 0xA6, 0x81, 0xA7, 0xE9, 0xD0, 0xC9, 
+0x39, 0x58, 0xBF, 0x3E, 0x04, 0x94, 0xD7, 0x4D, 
+0x4E, 0xF4, 0xCF, 0x0F, 0xEA, 0xAE, 0x66, 0x71, 
+0x59, 0xED, 0xB6, 0xB0, 0x39, 0xC8, 0xDE, 0x31, 
+0x2F, 0x29, 0x51, 0x14, 0xEB, 0xDB, 0x2B, 0x3C, 
+0x34, 0x4C, 0x43, 0x47, 0x33, 0xE2, 0x9F, 0x2F, 
+0x76, 0x06, 0x05, 0x19, 0x9D, 0xE7, 0x26, 0x5E, 
+0x05, 0xC1, 0xDB, 0xB1, 0x2C, 0x7C, 0x77, 0x04, 
+0x35, 0xD7, 0xA5, 0x77, 0xB3, 0x2E, 0x9D, 0xCF, 
+0x1A, 0x6A, 0x03, 0xCC, 0x44, 0xFC, 0x93, 0x6A, 
+0x3E, 0xEA, 0xF0, 0x96, 0xBF, 0xC2, 0x2F, 0xCD, 
+0xCE, 0x54, 0x17, 0x90, 0x56, 0xCF, 0x10, 0x7D, 
+0x70, 0xD9, 0x55, 0xF2, 0xB8, 0xF9, 0xBE, 0x4B, 
+0x4D, 0x76, 0xD5, 0x35, 0x7F, 0x37, 0x32, 0x3E, 
+0x97, 0xE1, 0xF9, 0xB0, 0x67, 0xF5, 0xC8, 0x27, 
+0x19, 0x81, 0xD4, 0x26, 0x26, 0x4F, 0x9D, 0xAB, 
+0xBC, 0x61, 0xF7, 0x41, 0x3F, 0x9C, 0x0C, 0xE0, 
+0x26, 0x39, 0x7A, 0x22, 0x7F, 0xEB, 0x7C, 0x07, 
+0x47, 0xDE, 0x4E, 0xF4, 0x2B, 0x5B, 0x73, 0xF3, 
+0xFB, 0x13, 0x76, 0xBD, 0x95, 0xB9, 0x8A, 0xE2, 
+0x1D, 0xFB, 0xD2, 0x0B, 0x08, 0x00, 0x2F, 0x4F, 
+0x06, 0x08, 0x46, 0xFF, 0xCD, 0x9D, 0x97, 0x8A, 
+0x1C, 0x7A, 0x18, 0x29, 0xEE, 0x7B, 0xB9, 0xFD, 
+0x38, 0xF3, 0x1D, 0x78, 0x34, 0xF6, 
+
+0x28, 0x25, 0x65, 0x0A, 0x07, 0x64, 0xF5, 0xEF, 
+0xB8, 0xFE, 0xB6, 0x4A, 0x41, 0x15, 0x85, 0xB3, 
+0x59, 0x3B, 0xC7, 0x96, 0xAE, 0xC0, 0x86, 0xC0, 
+0x4A, 0x0E, 0x55, 0x70, 0x48, 0xA7, 0xD7, 0xD0, 
+0x63, 0x6A, 0x88, 0x76, 0xC0, 0xA0, 0x78, 0x5B, 
+0x33, 0x18, 0xE4, 0xFE, 0x31, 0xE5, 0xC3, 0x2A, 
+0xD2, 0x17, 0xA9, 0x68, 0x7F, 0xDD, 0xA5, 0x45, 
+0x3D, 0x7D, 0xBB, 0x21, 0xA0, 0x99, 0x36, 0x58, 
+0xE9, 0x50, 0x4C, 0x2B, 0xCB, 0xD1, 0x0B, 0x5B, 
+0x74, 0x07, 0xBA, 0x48, 0xB9, 0x30, 0x89, 0x13, 
+0x08, 0x9C, 0x6A, 0x23, 0xAD, 0x8E, 0x57, 0xBD, 
+0x2D, 0xC1, 0x14, 0xE4, 0x91, 0x70, 0xF3, 0x4E, 
+0x36, 0x33, 0xA8, 0x30, 0xFD, 0xD5, 0xB7, 0x83, 
+0xA7, 0x39, 0x08, 0x61, 0xAB, 0xA3, 0xFA, 0x17, 
+0x0B, 0x44, 0x80, 0x8F, 0x38, 0xD6, 0xB0, 0x1F, 
+0xC1, 0xE7, 0xC3, 0xE6, 0x79, 0xF3, 0xF1, 0x10, 
+0x4A, 0x66, 0x2C, 0xFE, 0x78, 0xAB, 0x28, 0xCB, 
+0x9D, 0x8B, 0xFF, 0x4A, 0xDC, 0x12, 0x26, 0x98, 
+0xDC, 0x6F, 0x51, 0x9A, 0xC1, 0xAC, 0xE5, 0x52, 
+0x39, 0xE3, 0xA0, 0xA4, 0x26, 0xB7, 0x7A, 0x8A, 
+0xFF, 0x57, 0x68, 0x37, 0x88, 0x76, 0x79, 0xAC, 
+0x85, 0x85, 0xB3, 0x53, 0x9D, 0x92, 0x93, 0xE3, 
+0x14, 0x5C, 0xF8, 0x00, 0x28, 0xDD, 0xB5, 0x99, 
+0xDB, 0x8C, 0xE9, 0x61, 0xC1, 0x26, 0x97, 0x8C, 
+0x2D, 0xE6, 0xE7, 0xAA, 0x41, 0xE9, 0xC6, 0x7E, 
+0x5F, 0x5C, 0x52, 0x0A, 0x24, 0xD6, 0xB6, 0x9A, 
+0x90, 0xD2, 0xAB, 0xD1, 0xDD, 0x28, 0xB7, 0xE2, 
+0x19, 0xC2, 0xF9, 0xB7, 0x76, 0x95, 0x28, 0x1A, 
+0x61, 0xF7, 0x35, 0x76, 0x6B, 0xD6, 0x65, 0x2F, 
+0xD8, 0x6D, 0x1A, 0x44, 0xA4, 0xC1, 0xBA, 
+
+0xDD, 0xBC, 0xBA, 0x25, 0xA6, 0xC1, 0x18, 0x4A, 
+0x11, 0xB6, 0xEF, 0x5D, 0x4E, 0x00, 0x2B, 0x50, 
+0x4A, 0x47, 0x08, 0x86, 0x1B, 0x23, 0x15, 0x88, 
+0x9A, 0xDA, 0xDE, 0x15, 0x17, 0x03, 0x4F, 0xF4, 
+0x81, 0x86, 0x8B, 0xB6, 0x29, 0x91, 0x4A, 0x75, 
+0x43, 0x10, 0xA9, 0xC3, 0x0B, 0xBF, 0x65, 0x5A, 
+0xF9, 0x58, 0x05, 0xF3, 0x56, 0x84, 0xE6, 0x6C, 
+0x5D, 0x55, 0x65, 0xF0, 0xBE, 0x68, 0x11, 0xB8, 
+0x1B, 0x98, 0x9A, 0x8E, 0x8D, 0x52, 0x7A, 0x5D, 
+0x7C, 0xCE, 0x90, 0x1A, 0xD4, 0xE5, 0x73, 0xE0, 
+0x95, 0x69, 0x76, 0xE4, 0x63, 0xD0, 0xE5, 0x7D, 
+0x02, 0x21, 0xAA, 0x84, 0x14, 0x71, 0xC1, 0x56, 
+0xEC, 0xF6, 0x44, 0x4E, 0x70, 0xB9, 0xB4, 0x0D, 
+0x78, 0xC9, 0x8E, 0x78, 0x6E, 0x29, 0xFE, 0xBC, 
+0xF2, 0xFF, 0x6B, 0xB5, 0x4A, 0x76, 0x75, 0xB8, 
+0xB0, 0x76, 0x1E, 0x54, 0xEF, 0x74, 0x5F, 0xBC, 
+0x16, 0xE0, 0x7C, 0x27, 0x2F, 0x9C, 0x2E, 0x3B, 
+0x60, 0x32, 0xCE, 0x06, 0xCD, 0xCD, 0xEC, 0xFD, 
+0x37, 0x84, 0x1E, 0xF1, 0xA9, 0x46, 0x49, 0x58, 
+0xD1, 0x53, 0x28, 0xF4, 0x3C, 0x64, 0xCF, 0x67, 
+0x37, 0x2C, 0xB1, 0x0B, 0xAD, 0x97, 0x2A, 0x28, 
+0x16, 0x9D, 0xFB, 0x2A, 0x3C, 0x8E, 0xB9, 0x5D, 
+0xD9, 0x29, 0xA0, 0xDB, 0x48, 0x56, 0x53, 0x11, 
+0xB2, 0x78, 0x70, 0x4C, 0xD8, 0xCE, 0x21, 0x7C, 
+0xBB, 0xE2, 0x9B, 0xFA, 0xDD, 0x2F, 0xB5, 0x68, 
+0x32, 0x33, 0x9D, 0x43, 0x12, 0x14, 0x36, 0x92, 
+0x50, 0x1D, 0x27, 0xDB, 0x65, 0x85, 0xBA, 0x44, 
+0x36, 0x3A, 0xF1, 0x1F, 0xA8, 0x12, 0xD3, 0xC6, 
+0xD1, 0x38, 0x87, 0x67, 0x14, 0xFD, 0x30, 0x52, 
+0x3B, 0xC9, 0x0D, 0x2D, 0x18, 0x5D, 0xF9, 0x9C, 
+
+0x87, 0xE5, 0xD8, 0x37, 0xAE, 0xE5, 0x35, 0xAD, 
+0x6B, 0x0E, 0x75, 0x7F, 0x64, 0x6D, 0xFF, 0xC8, 
+0x3F, 0x08, 0x5F, 0xC0, 0x8C, 0xB3, 0x7B, 0x05, 
+0xF9, 0x7B, 0xDE, 0x09, 0x1F, 0xF3, 0x85, 0xD2, 
+0xF4, 0x57, 0x09, 0x1E, 0xC8, 0x5F, 0xD4, 0xB6, 
+0x25, 0xF1, 0x1E, 0x40, 0xA7, 0x54, 0x0D, 0xEE, 
+0xBD, 0x86, 0xD0, 0x13, 0x2A, 0x68, 0xF6, 0xA6, 
+0xAF, 0x7B, 0x4F, 0x46, 0x5A, 0xAC, 0x18, 0x6E, 
+0x6A, 0x9D, 0xF8, 0x4B, 0x64, 0x64, 0x5B, 0x6D, 
+0x2E, 0x84, 0xCA, 0xB4, 0x6C, 0x65, 0x50, 0x71, 
+0x58, 0x5D, 0xA5, 0xB8, 0x62, 0xCE, 0x8F, 0x1E, 
+0x1D, 0xDD, 0x86, 0x5A, 0xD4, 0x59, 0x14, 0xD3, 
+0xBA, 0xF9, 0x74, 0x20, 0xD7, 0x14, 0x89, 0xA6, 
+0x8A, 0x59, 0xAE, 0xD9, 0x05, 0x3C, 0xAC, 0xE3, 
+0x83, 0x74, 0xB4, 0x26, 0xC8, 0x4D, 0x97, 0x88, 
+0x9E, 0x68, 0xC5, 0x95, 0x90, 0x7B, 0x54, 0xBB, 
+0x7A, 0x9B, 0x07, 0xDA, 0xD7, 0xCB, 0x9F, 0x2C, 
+0xE6, 0x0A, 0x56, 0x48, 0x5E, 0xD3, 0x65, 0x84, 
+0x51, 0x4F, 0x82, 0x80, 0x18, 0xEF, 0x8B, 0xF7, 
+0x28, 0x0A, 0x15, 0x3F, 0xD0, 0x40, 0xF1, 0x97, 
+0x54, 0xE5, 0x01, 0xFA, 0xB5, 0xE1, 0x2E, 0x33, 
+0x8E, 0x4B, 0x57, 0x9D, 0x3D, 0xFD, 0x80, 0x28, 
+0x66, 0xEF, 0x7E, 0x58, 0x8B, 0xA4, 0x74, 0xED, 
+0x57, 0x29, 0x1B, 0xE2, 0x26, 0xC8, 0x97, 0xA8, 
+0x47, 0x9B, 0x57, 0x05, 0xC1, 0xEF, 0x2B, 0xFD, 
+0x9B, 0xC8, 0x32, 0xE6, 0x99, 0x2F, 0x07, 0x8B, 
+0x73, 0x74, 0x41, 0xCC, 0xEC, 0x0D, 0x80, 0xB8, 
+0xAF, 0x18, 0x45, 0x9F, 0x8A, 0xF2, 0x3F, 0x2D, 
+0x3A, 0x08, 0x5E, 0x07, 0x70, 0x1F, 0xD8, 0x71, 
+0x16, 0x47, 0x32, 0x97, 0xAB, 0x2C, 0x47, 0x32, 
+0x09, 
+
+0x2C, 0x0A, 0x29, 0x8A, 0x21, 0x40, 0x1C, 0xE8, 
+0x76, 0xED, 0x28, 0x11, 0x76, 0x31, 0x9E, 0xAC, 
+0xAC, 0x50, 0x5C, 0xA9, 0xEE, 0x1F, 0x70, 0x38, 
+0xAB, 0x5F, 0xE8, 0x3F, 0x49, 0x38, 0x2D, 0x38, 
+0x97, 0xFC, 0xE2, 0x49, 0x33, 0xFC, 0x25, 0x81, 
+0xD9, 0xC7, 0x4C, 0xA4, 0xE0, 0x64, 0xAE, 0x7F, 
+0x3B, 0x21, 0x87, 0x5D, 0x95, 0x0D, 0xA8, 0x11, 
+0xEA, 0xA6, 0xC2, 0x66, 0x12, 0xC3, 0x3A, 0xC3, 
+0xAD, 0x73, 0x6A, 0x7E, 0xB7, 0xCE, 0xCD, 0xDA, 
+0xA6, 0x7C, 0x4D, 0x01, 0xCE, 0x93, 0xB7, 0x62, 
+0xF0, 0x5C, 0x8A, 0xE9, 0xBD, 0x21, 0xFA, 0xB4, 
+0xCD, 0x7D, 0x08, 0x26, 0xDC, 0x8C, 0x32, 0xBB, 
+0x76, 0x7B, 0x9C, 0x5D, 0x13, 0x6F, 0xA2, 0x31, 
+0x6D, 0x13, 0x43, 0x34, 0x65, 0xA4, 0x9F, 0x6D, 
+0x90, 0x6B, 0x12, 0x35, 0x04, 0x19, 0x26, 0xA9, 
+0x54, 0xD5, 0x1A, 0xF9, 0x8C, 0x03, 0x64, 0x68, 
+0x45, 0xAD, 0xBF, 0x34, 0x54, 0x7D, 0x7C, 0x85, 
+0x98, 0x00, 0x5C, 0x88, 0x38, 0xAB, 0x57, 0x5D, 
+0x1E, 0x75, 0x02, 0x46, 0x27, 0x76, 0x97, 0xE7, 
+0x64, 0xA8, 0xAC, 0xE5, 0x19, 0x2C, 0x6B, 0x55, 
+0x50, 0x03, 0xF3, 0x63, 0x2C, 0xFE, 0xB8, 0x26, 
+0xCA, 0x4E, 0xA7, 0x08, 0x07, 0xCD, 0x21, 0x73, 
+0xAE, 0x8D, 0xDF, 0x6E, 0xC9, 0xB3, 0x2A, 0xCE, 
+0x27, 0x34, 0xB3, 0x57, 0x45, 0x8A, 0x31, 0xA9, 
+0x46, 0x23, 0x99, 0xF0, 0x85, 0xDE, 0x2E, 0xA1, 
+0xF6, 0x65, 0x8A, 0x23, 0x3B, 0x58, 0xA9, 0x4C, 
+0xA9, 0xD0, 0xF7, 0xB8, 0x77, 0x71, 0xF6, 0xD1, 
+0x81, 0xA7, 0x57, 0xE7, 0xC6, 0x4E, 0xDF, 0x7D, 
+0x1C, 0xB3, 0x90, 0x82, 0xB1, 0x9C, 0x94, 0x31, 
+0x13, 0xF9, 0x0B, 0xFD, 0xC1, 0x91, 0x18, 0xDE, 
+0x55, 0x0D, 
+
+0x17, 0x5A, 0xD6, 0x31, 0x69, 0xA2, 0x9E, 0x3A, 
+0x60, 0x41, 0x30, 0x14, 0x1C, 0x7F, 0x56, 0xF8, 
+0xF9, 0x80, 0x69, 0xDE, 0xB0, 0xF2, 0x97, 0x5F, 
+0x35, 0xE2, 0x3A, 0x64, 0xFF, 0x6D, 0x48, 0x4E, 
+0x05, 0x89, 0x89, 0xDB, 0xD4, 0x9E, 0x62, 0x39, 
+0x31, 0xA1, 0x33, 0x0C, 0x3D, 0x17, 0xE5, 0xEF, 
+0xE5, 0x99, 0x30, 0x38, 0x54, 0xCC, 0x77, 0x89, 
+0x96, 0xD8, 0x19, 0xA2, 0x75, 0x57, 0x0A, 0x4A, 
+0x4A, 0x59, 0xC4, 0x39, 0x34, 0x8A, 0x45, 0xB9, 
+0x61, 0xA3, 0x27, 0xFF, 0x17, 0x0E, 0x6E, 0x2C, 
+0xA4, 0xAD, 0xF0, 0x5F, 0xA0, 0x97, 0xD0, 0x41, 
+0x5D, 0x63, 0x44, 0xD8, 0x99, 0xE6, 0x8D, 0xF2, 
+0x60, 0x48, 0x02, 0xDF, 0x50, 0x93, 0x56, 0xA1, 
+0xF8, 0x15, 0xC3, 0xD1, 0x3C, 0xE5, 0x90, 0xC2, 
+0x21, 0xB7, 0x50, 0x62, 0xBF, 0x50, 0x23, 0x57, 
+0x43, 0x5C, 0x02, 0xC6, 0x38, 0xD4, 0x50, 0xB6, 
+0x58, 0x71, 0x29, 0xFF, 0x19, 0x9B, 0xE7, 0x09, 
+0x3E, 0x4C, 0xE8, 0xED, 0x95, 0x6B, 0x8A, 0x0F, 
+0x55, 0xF7, 0x47, 0x26, 0xE5, 0x5A, 0x82, 0xDD, 
+0x84, 0xCD, 0x83, 0x32, 0xCC, 0xCC, 0x94, 0x5B, 
+0xA9, 0x05, 0xFB, 0xE1, 0xCE, 0x6D, 0x9D, 0x3C, 
+0xAB, 0x8A, 0x18, 0xC1, 0x00, 0x74, 0x84, 0x85, 
+0x09, 0xDE, 0x71, 0xA1, 0xF0, 0x4A, 0xE3, 0xE8, 
+0xFF, 0xE2, 0xD3, 0xA0, 0x96, 0x4B, 0xFE, 0x21, 
+0x8B, 0xE8, 0x23, 0x44, 0x11, 0x3A, 0xC0, 0x2D, 
+0xEF, 0xB5, 0x52, 0xE3, 0x91, 0x17, 0x3F, 0x96, 
+0xC7, 0xF8, 0x55, 0x84, 0xC5, 0xC8, 0xD5, 0xD8, 
+0x0F, 0x54, 0xED, 0x8D, 0x5A, 0x7F, 0xA1, 0x3B, 
+0x1B, 0xBB, 0x6C, 0x08, 0xFC, 0x61, 0xDE, 0x84, 
+0xE6, 0xC6, 0x7F, 0x05, 0xBC, 0x53, 0x81, 0xBE, 
+0x77, 0xC3, 0x0D, 
+
+0x7E, 0xEF, 0xF3, 0x55, 0x42, 0x1C, 0x00, 0x72, 
+0x08, 0x2B, 0xBD, 0x1D, 0x11, 0xA0, 0x49, 0x6F, 
+0xA1, 0x4C, 0xCF, 0x74, 0x4E, 0xF1, 0xD1, 0x6D, 
+0x18, 0x46, 0x4F, 0x13, 0x8A, 0x37, 0xBA, 0x68, 
+0xC4, 0xD7, 0xA0, 0x5F, 0x53, 0x6B, 0xDD, 0xA5, 
+0x05, 0xB5, 0x6E, 0x9A, 0x10, 0x16, 0x6E, 0x85, 
+0xC8, 0x5D, 0x71, 0xC0, 0x02, 0x8C, 0x78, 0x24, 
+0xA4, 0x86, 0x21, 0x38, 0x14, 0xB1, 0xCB, 0x01, 
+0xED, 0x1D, 0xE8, 0x0B, 0xB1, 0xBF, 0x5D, 0xA3, 
+0xBD, 0x40, 0x51, 0x70, 0x58, 0xA0, 0xB2, 0xA0, 
+0xF2, 0x85, 0x2A, 0x68, 0x2B, 0x41, 0x3D, 0xEE, 
+0x20, 0xCA, 0x69, 0xE1, 0x5D, 0x47, 0xEC, 0x9C, 
+0xF7, 0xEC, 0x1D, 0xFB, 0x53, 0xC7, 0x4D, 0xB3, 
+0xE1, 0x41, 0xC8, 0xAD, 0x78, 0x99, 0x78, 0x44, 
+0x7E, 0x58, 0x15, 0xC0, 0xEF, 0x41, 0x23, 0x56, 
+0xA5, 0x86, 0x3C, 0xF5, 0xEE, 0x92, 0x2E, 0x35, 
+0xAC, 0x74, 0x1A, 0xCD, 0x95, 0x65, 0xE1, 0x29, 
+0x3A, 0xDC, 0xB9, 0xCA, 0x5C, 0x44, 0x2E, 0x42, 
+0xC7, 0x89, 0xC4, 0xE5, 0x2E, 0xF9, 0x82, 0x39, 
+0x34, 0x1C, 0x0D, 0x60, 0x90, 0x3D, 0xAB, 0x2B, 
+0x53, 0xC0, 0xBE, 0x89, 0x9A, 0x6C, 0xF1, 0x9C, 
+0x29, 0x6E, 0xD3, 0x66, 0xB2, 0xDD, 0x5C, 0x63, 
+0x12, 0x33, 0xC1, 0xFD, 0xD9, 0x77, 0xC2, 0x3A, 
+0x3B, 0xD4, 0xAA, 0xDF, 0x3F, 0x09, 0x7C, 0x1F, 
+0x0C, 0xCF, 0x98, 0x03, 0xC4, 0x45, 0xA4, 0x8A, 
+0xA3, 0xAA, 0x3E, 0xEE, 0x7B, 0x7F, 0x74, 0x47, 
+0x89, 0xDC, 0xC9, 0xF2, 0xCF, 0x58, 0xF8, 0xC6, 
+0xED, 0x24, 0x66, 0x84, 0xBB, 0x9F, 0x96, 0x41, 
+0x50, 0x05, 0x51, 0xF4, 0x45, 0x32, 0x62, 0xF3, 
+0xB9, 0xCF, 0x51, 0x1D, 0xF1, 0x48, 0xCE, 0xAC, 
+0xB5, 0x3E, 0xA4, 0x18, 
+
+0xCA, 0xB2, 0xF4, 0x9C, 0xF0, 0xEE, 0x88, 0x3E, 
+0x0E, 0x70, 0xB0, 0xE0, 0xBA, 0xAB, 0xC6, 0x5F, 
+0x62, 0x07, 0xD7, 0x84, 0xEB, 0x48, 0x08, 0x27, 
+0xB5, 0x57, 0x40, 0xA9, 0x5C, 0x3E, 0x63, 0xBB, 
+0xB9, 0x75, 0xD0, 0x67, 0x82, 0x35, 0x2B, 0x5A, 
+0x6A, 0xB2, 0xF9, 0x9D, 0x82, 0x69, 0xC5, 0x07, 
+0x36, 0xF7, 0x1E, 0xF6, 0x39, 0x0D, 0x3C, 0xB7, 
+0x37, 0xDB, 0xD4, 0x0C, 0xBE, 0xC9, 0x97, 0x0C, 
+0x07, 0xD2, 0x27, 0xFE, 0x6A, 0x0D, 0x33, 0x2E, 
+0xD5, 0x20, 0x4F, 0x58, 0x4A, 0x15, 0x51, 0xCD, 
+0x02, 0xB8, 0x0B, 0xC2, 0x49, 0x1B, 0x3D, 0xEB, 
+0xDF, 0x14, 0x1F, 0xD4, 0x4C, 0x0E, 0xD7, 0x00, 
+0x75, 0xD4, 0x38, 0x46, 0x50, 0x87, 0x55, 0x01, 
+0x0B, 0x97, 0x42, 0xA6, 0xB3, 0xDA, 0x4B, 0x66, 
+0x11, 0xEC, 0x96, 0x9C, 0xD7, 0x5D, 0x2F, 0x96, 
+0xC2, 0xCD, 0xE9, 0x3C, 0x8E, 0xEA, 0x57, 0x84, 
+0x7E, 0x75, 0x77, 0x17, 0x49, 0xE0, 0xA7, 0xDB, 
+0x4C, 0xD2, 0x2F, 0x40, 0x58, 0xD4, 0x73, 0x30, 
+0xAE, 0x6D, 0xC8, 0x25, 0x1C, 0x90, 0x1C, 0x57, 
+0xE4, 0x49, 0x76, 0xF1, 0xB4, 0x80, 0x76, 0x82, 
+0xA2, 0x8E, 0x8C, 0x87, 0x2D, 0xFD, 0x79, 0xD9, 
+0xB2, 0xC7, 0xBD, 0xD2, 0xB8, 0x4C, 0x6E, 0xD7, 
+0xE7, 0xDF, 0x65, 0x4F, 0xD9, 0xE7, 0xAF, 0xE2, 
+0x5B, 0xC1, 0x47, 0xBC, 0xCE, 0xEA, 0x01, 0x03, 
+0xE1, 0x30, 0x50, 0x12, 0x08, 0x4F, 0x36, 0xF5, 
+0x0C, 0x90, 0x52, 0x8E, 0xDB, 0x9B, 0xA2, 0x40, 
+0x40, 0xB7, 0xCE, 0xF0, 0xDF, 0xFA, 0x61, 0xF4, 
+0x2C, 0x3E, 0x9A, 0xCB, 0x9B, 0x1D, 0x54, 0xE3, 
+0xC4, 0xA1, 0xC1, 0xE2, 0x48, 0x09, 0xE6, 0x1D, 
+0x45, 0x5C, 0x77, 0xF1, 0x5C, 0x78, 0x13, 0x52, 
+0xA1, 0xC4, 0x99, 0xC6, 0x67, 
+
+0x0E, 0x6A, 0xA6, 0xFD, 0x8D, 0x9E, 0x2A, 0x46, 
+0x99, 0xDF, 0x9C, 0xBA, 0x98, 0x81, 0xE3, 0x4B, 
+0x53, 0x94, 0x21, 0x0D, 0xC6, 0xEF, 0x8A, 0xAC, 
+0x11, 0xD1, 0x4F, 0x9C, 0xA3, 0x33, 0xE0, 0x51, 
+0x11, 0xF8, 0x35, 0x18, 0xF7, 0xB0, 0x28, 0x2B, 
+0x70, 0x0C, 0x73, 0x78, 0x39, 0x04, 0xDF, 0x1D, 
+0xF9, 0x24, 0x9A, 0x9D, 0xCE, 0x6B, 0x60, 0x93, 
+0x28, 0xCC, 0xDB, 0x9E, 0x2D, 0xED, 0x84, 0xFE, 
+0x4E, 0xEF, 0x9A, 0xDD, 0x70, 0x82, 0x09, 0x24, 
+0x56, 0x9E, 0xD6, 0x9F, 0x4E, 0x4D, 0x92, 0xA5, 
+0x9F, 0x65, 0x80, 0x89, 0x25, 0x3D, 0x96, 0x20, 
+0xC6, 0xAC, 0x6A, 0x40, 0x1D, 0xCA, 0xCA, 0x34, 
+0x83, 0x77, 0x40, 0x42, 0xB0, 0x5D, 0xA2, 0xAA, 
+0x38, 0x57, 0xB4, 0x1D, 0xD9, 0xF9, 0x1A, 0x53, 
+0xC9, 0x6F, 0xF6, 0xE4, 0x16, 0x56, 0x91, 0x10, 
+0x44, 0xFB, 0x3B, 0xFD, 0xE6, 0x5E, 0x44, 0x55, 
+0x3A, 0xCA, 0x48, 0xEA, 0xB4, 0xD1, 0xE7, 0x34, 
+0x42, 0xBF, 0x14, 0x07, 0xC3, 0xDC, 0x79, 0xA6, 
+0x81, 0xE1, 0x79, 0xF8, 0x20, 0xA7, 0xB6, 0x80, 
+0x12, 0xBC, 0xCF, 0x42, 0x02, 0x52, 0x7F, 0x3D, 
+0xFD, 0x0F, 0x30, 0x64, 0x55, 0xCF, 0x2A, 0x12, 
+0x48, 0xB4, 0x92, 0x71, 0x34, 0x6F, 0x0A, 0x0E, 
+0x5E, 0x44, 0x6C, 0xB7, 0xE5, 0x1D, 0xDB, 0x1D, 
+0x07, 0x39, 0xB6, 0x8D, 0x4D, 0x8B, 0xA8, 0x31, 
+0xA3, 0xF5, 0xE0, 0x57, 0xCD, 0x6B, 0xC0, 0xB3, 
+0x5C, 0x65, 0x8A, 0x3F, 0x61, 0xAB, 0x82, 0x29, 
+0xE8, 0xF3, 0xC6, 0x93, 0x19, 0xEC, 0x43, 0x05, 
+0x42, 0x3A, 0x2E, 0x78, 0x93, 0x2F, 0x01, 0x61, 
+0x37, 0x53, 0x19, 0x00, 0x6F, 0x56, 0x30, 0x50, 
+0x52, 0x05, 0xFF, 0x4F, 0x50, 0xC9, 0xFF, 0x48, 
+0xA9, 0x86, 0x44, 0x59, 0x7A, 0xEA, 
+
+0x49, 0xAC, 0x4E, 0xAC, 0xD4, 0x06, 0x55, 0x27, 
+0x61, 0xD1, 0xAE, 0xB1, 0x16, 0x57, 0x08, 0xE7, 
+0x06, 0x6D, 0x3D, 0x14, 0xFF, 0x8A, 0x20, 0xF4, 
+0x8E, 0x55, 0xD2, 0x01, 0x75, 0xF1, 0x1A, 0xE5, 
+0xC5, 0x0F, 0x14, 0xCB, 0xFC, 0x39, 0x3D, 0x1F, 
+0xE7, 0xA3, 0x82, 0xBA, 0x7B, 0x32, 0x94, 0x48, 
+0xBE, 0xD9, 0x8D, 0x4F, 0x74, 0x87, 0x68, 0xEF, 
+0x8F, 0x2F, 0xE4, 0x6F, 0xA5, 0xB7, 0x5B, 0x23, 
+0xD8, 0x0F, 0x88, 0x3F, 0xBE, 0x59, 0xE8, 0x1F, 
+0xA3, 0x07, 0xA8, 0xAD, 0xA7, 0x5D, 0xF8, 0xBE, 
+0xB2, 0x83, 0x86, 0x91, 0x53, 0x1A, 0x85, 0x52, 
+0x84, 0x81, 0xAE, 0x68, 0x35, 0x9E, 0x24, 0xEB, 
+0x5B, 0x37, 0x54, 0x51, 0x3D, 0x51, 0x38, 0xC8, 
+0xC8, 0x89, 0x75, 0x94, 0x53, 0x97, 0xC0, 0x95, 
+0xB1, 0x30, 0x04, 0x57, 0x5E, 0x13, 0x8B, 0x40, 
+0x26, 0x41, 0xD6, 0x59, 0xF5, 0x67, 0xDF, 0x1B, 
+0x89, 0x34, 0x72, 0x8B, 0x81, 0xCE, 0x46, 0xF0, 
+0xDE, 0x6B, 0x93, 0x5B, 0xDB, 0x39, 0xC9, 0x6F, 
+0xF0, 0x78, 0x25, 0xC8, 0x93, 0xA7, 0xCD, 0x70, 
+0x3A, 0xF6, 0x91, 0xB7, 0xD4, 0x91, 0xAD, 0xFD, 
+0x00, 0x3B, 0x45, 0xF7, 0x9B, 0x00, 0x4E, 0x35, 
+0x1D, 0x0A, 0xEA, 0xDB, 0x70, 0xE5, 0x44, 0xD8, 
+0x31, 0x76, 0xF4, 0x99, 0x38, 0x35, 0x31, 0x11, 
+0x3D, 0x92, 0xB6, 0xEA, 0x89, 0x1A, 0x9A, 0x3A, 
+0x51, 0xAC, 0x91, 0x1E, 0x2E, 0x78, 0xF2, 0x45, 
+0xA7, 0x84, 0x8A, 0xC6, 0x3D, 0x59, 0xDA, 0x25, 
+0x70, 0x39, 0x53, 0xF1, 0x25, 0xA3, 0x30, 0xD6, 
+0x62, 0x92, 0xC9, 0xD7, 0x25, 0xCB, 0x53, 0x73, 
+0x45, 0x9A, 0xB6, 0x20, 0xA4, 0x41, 0xAE, 0x0B, 
+0xD8, 0x69, 0x5F, 0x75, 0xAB, 0x9C, 0xBB, 0xBD, 
+0x61, 0x20, 0xDE, 0xFD, 0x90, 0x1D, 0xE8, 
+
+0xD5, 0x5D, 0xCC, 0xFB, 0xFB, 0xC4, 0x1E, 0xEB, 
+0x26, 0xED, 0x3E, 0x02, 0xF0, 0x2E, 0x06, 0x26, 
+0x8B, 0x12, 0x10, 0x51, 0x5B, 0x22, 0x14, 0x73, 
+0xDD, 0x0F, 0xF8, 0x30, 0x88, 0xB3, 0x2C, 0xE6, 
+0xAF, 0x25, 0x28, 0x42, 0x21, 0x22, 0x94, 0x7E, 
+0xB1, 0x74, 0x9B, 0x1B, 0x08, 0xCF, 0x2D, 0xDD, 
+0xA6, 0xAB, 0xA3, 0x6C, 0x1F, 0x5F, 0x20, 0xA7, 
+0x23, 0x88, 0x38, 0xB5, 0xCB, 0x07, 0x9B, 0xF1, 
+0xB5, 0xAA, 0xD0, 0x5D, 0x30, 0x17, 0xB5, 0x5C, 
+0x71, 0xFC, 0x25, 0xAF, 0x9C, 0x7F, 0x4A, 0x44, 
+0x4B, 0xDC, 0x62, 0xD5, 0xBC, 0x5A, 0x7D, 0x1D, 
+0xCB, 0x19, 0xD8, 0x18, 0xB4, 0xE9, 0x85, 0xEA, 
+0xBE, 0x60, 0x9C, 0x02, 0x83, 0x2B, 0xED, 0x29, 
+0x6C, 0x31, 0x53, 0x64, 0x6D, 0xFC, 0x8D, 0x57, 
+0x7D, 0x68, 0x5C, 0x52, 0x37, 0xCF, 0xA0, 0x52, 
+0x64, 0xC8, 0xAC, 0x94, 0x26, 0xBF, 0xB7, 0x86, 
+0x7B, 0xB3, 0x2D, 0x10, 0x4C, 0x66, 0x4E, 0x08, 
+0xCE, 0x2E, 0xB6, 0xF2, 0x63, 0x8C, 0x5F, 0x56, 
+0x13, 0x2F, 0x08, 0x08, 0x4D, 0x00, 0x3C, 0x88, 
+0x9A, 0x8F, 0x81, 0xD6, 0x9C, 0x74, 0xCB, 0xA1, 
+0x29, 0xD9, 0x19, 0x49, 0xFB, 0x4C, 0xAD, 0xDD, 
+0xD3, 0xC4, 0x05, 0xB9, 0xDC, 0x55, 0xBF, 0x4F, 
+0x05, 0xD6, 0x9E, 0xEC, 0x3B, 0x4B, 0x6D, 0x8C, 
+0xB1, 0x7B, 0x0F, 0x2B, 0xBC, 0xF7, 0x7F, 0xB1, 
+0x77, 0xCA, 0x99, 0xF8, 0xA6, 0xC6, 0xE1, 0x7D, 
+0x92, 0xB9, 0xB6, 0x22, 0xDC, 0x73, 0xD4, 0x97, 
+0xD1, 0xED, 0x3C, 0x50, 0x11, 0xB7, 0xEC, 0x4B, 
+0x8A, 0x2F, 0x26, 0x4B, 0xFF, 0x4F, 0x1F, 0xFD, 
+0x5A, 0x36, 0x7B, 0x80, 0xCA, 0x2E, 0x99, 0xC7, 
+0x43, 0xAF, 0x96, 0x9C, 0xF7, 0x1F, 0xC9, 0x6A, 
+0x0E, 0x77, 0x09, 0x3F, 0x0A, 0xE4, 0xC7, 0x08, 
+
+0xE2, 0x3D, 0x0C, 0xF3, 0x8A, 0x71, 0x40, 0x63, 
+0x37, 0x2A, 0xA2, 0x84, 0x0A, 0x2E, 0x7D, 0x36, 
+0x42, 0x9F, 0x42, 0x4A, 0x96, 0x16, 0x6E, 0xB1, 
+0xDE, 0x30, 0x46, 0x06, 0x46, 0x6E, 0xE1, 0x2F, 
+0x35, 0x24, 0x71, 0x74, 0xD8, 0xAF, 0xC8, 0x62, 
+0x73, 0xBD, 0xF1, 0x8B, 0x12, 0x91, 0x90, 0x6F, 
+0x84, 0x03, 0xAD, 0xBF, 0xD2, 0x96, 0x28, 0xD0, 
+0x84, 0x2A, 0x90, 0x4C, 0x05, 0x30, 0xDD, 0x67, 
+0x7D, 0x33, 0xA9, 0xC3, 0xA5, 0xE7, 0xAD, 0xB2, 
+0x97, 0x04, 0x02, 0xB3, 0x8A, 0xF5, 0x8C, 0x09, 
+0x3D, 0xAC, 0x5E, 0x56, 0xD9, 0xFB, 0x32, 0xC0, 
+0x79, 0x52, 0x52, 0x5A, 0x04, 0x01, 0x9F, 0x63, 
+0x05, 0xFA, 0x47, 0x31, 0x9F, 0xFF, 0x6E, 0x51, 
+0x9A, 0x1D, 0x64, 0x6E, 0xF9, 0xB5, 0xE0, 0xFC, 
+0x5D, 0xED, 0x31, 0xEA, 0xFE, 0x0E, 0x0C, 0x6B, 
+0xC0, 0x4C, 0x7F, 0x05, 0x99, 0x65, 0x8F, 0xB7, 
+0x32, 0x22, 0x21, 0x73, 0x6D, 0xC5, 0x53, 0x2D, 
+0xED, 0x5C, 0xD4, 0x5B, 0x4F, 0xAC, 0x8E, 0x1B, 
+0x22, 0xAC, 0xE7, 0x1D, 0xEB, 0x9A, 0x40, 0x70, 
+0x4B, 0x5C, 0x54, 0x66, 0xEA, 0x22, 0x6F, 0x40, 
+0x64, 0x57, 0xC6, 0xD8, 0x7F, 0x72, 0x75, 0x00, 
+0x52, 0x5C, 0xC8, 0xDD, 0xB9, 0x67, 0xF9, 0x57, 
+0xF1, 0xDB, 0xA9, 0x1B, 0xF3, 0x96, 0xFB, 0x02, 
+0x30, 0x75, 0xC1, 0x3F, 0x52, 0x74, 0xEA, 0xBD, 
+0x3E, 0x4A, 0xEE, 0x80, 0xB0, 0xB3, 0x66, 0x8D, 
+0x68, 0xD8, 0xA5, 0x35, 0x23, 0x14, 0xED, 0xB7, 
+0x14, 0xC6, 0x3D, 0x9D, 0xB2, 0x68, 0x7A, 0xC0, 
+0x64, 0xC4, 0x23, 0x59, 0x93, 0x01, 0xA2, 0x97, 
+0x89, 0x00, 0xA1, 0xB1, 0x04, 0x41, 0xA6, 0xD6, 
+0xB2, 0x1D, 0xD7, 0x48, 0xFF, 0x7E, 0x88, 0x44, 
+0x9E, 0x25, 0xE0, 0xEB, 0xEB, 0x33, 0x93, 0x79, 
+0xB5, 
+
+0x58, 0xDF, 0x45, 0x5F, 0x1C, 0xA5, 0x67, 0x9F, 
+0x99, 0xB9, 0x72, 0x52, 0x1E, 0xB2, 0x9F, 0xDC, 
+0x32, 0x5D, 0x1E, 0x00, 0xC4, 0x62, 0x7A, 0x44, 
+0xD0, 0x4D, 0xFD, 0xA3, 0x6D, 0x44, 0xEA, 0x1B, 
+0xB8, 0x84, 0xD4, 0xC3, 0xDC, 0xFC, 0x4D, 0xB9, 
+0x5B, 0x92, 0x66, 0x7A, 0x14, 0xAC, 0xAE, 0x33, 
+0x06, 0xD0, 0x33, 0xA4, 0x6A, 0x04, 0xD6, 0xA1, 
+0xE9, 0xAC, 0x69, 0x84, 0xFE, 0x30, 0x7A, 0x87, 
+0x48, 0x88, 0x59, 0x47, 0x74, 0x53, 0x59, 0x36, 
+0x86, 0xCB, 0x79, 0xB7, 0x17, 0x1F, 0xAE, 0xB8, 
+0x12, 0x92, 0xAD, 0x6D, 0xB5, 0x4A, 0x0E, 0xDF, 
+0x44, 0xFD, 0xC5, 0x19, 0xB3, 0x87, 0x30, 0xE5, 
+0xB7, 0x5D, 0x47, 0xF8, 0x1A, 0x56, 0xD4, 0x2A, 
+0xCF, 0x40, 0xF1, 0x00, 0xB4, 0xA8, 0x0A, 0xDD, 
+0x2C, 0x3C, 0xE1, 0x8E, 0xB1, 0xBB, 0x4D, 0x9A, 
+0xF8, 0x95, 0x96, 0x3F, 0xAE, 0x82, 0x92, 0x30, 
+0x54, 0xAC, 0x4C, 0xC3, 0x47, 0x22, 0xC7, 0x5A, 
+0x42, 0x07, 0x11, 0xC9, 0xFA, 0x16, 0x81, 0x79, 
+0x5F, 0x80, 0xC2, 0x5C, 0xD9, 0xFE, 0x8E, 0x9E, 
+0x3C, 0x76, 0x64, 0x1D, 0xC5, 0xAC, 0xD0, 0x0F, 
+0x6D, 0xF7, 0xBE, 0xB8, 0x37, 0x3C, 0xEC, 0x69, 
+0x3E, 0xBB, 0x85, 0xFB, 0xB2, 0xD3, 0x53, 0xF2, 
+0x81, 0xCC, 0x00, 0xCF, 0x52, 0x63, 0x20, 0x23, 
+0xA5, 0xE4, 0x2A, 0x3E, 0x38, 0x7F, 0x34, 0xC3, 
+0x40, 0xDC, 0x07, 0x31, 0x47, 0x29, 0x78, 0xC4, 
+0xC9, 0xDD, 0xB0, 0x94, 0x29, 0xB0, 0xC7, 0xF9, 
+0x1D, 0xD1, 0x17, 0xB5, 0x97, 0x67, 0x2D, 0x11, 
+0x58, 0x7A, 0x96, 0x98, 0xE1, 0x39, 0xD6, 0xB8, 
+0xDD, 0xDB, 0xF7, 0x32, 0x99, 0xB0, 0xC7, 0xBC, 
+0x46, 0xAC, 0x1A, 0x55, 0x92, 0x22, 0x50, 0x41, 
+0xF9, 0x5D, 0xFF, 0x9C, 0xCB, 0x3D, 0xC2, 0x46, 
+0xA5, 0x6E, 
+
+0xC2, 0x1F, 0x42, 0xF3, 0xA0, 0x6D, 0x68, 0x96, 
+0x14, 0xEE, 0xF0, 0xDC, 0x50, 0x0F, 0x6E, 0x41, 
+0x34, 0x72, 0x09, 0x30, 0x9E, 0x39, 0xDA, 0x25, 
+0x6A, 0x50, 0x7D, 0xBF, 0xB7, 0xE8, 0xEF, 0x8F, 
+0xEF, 0xD6, 0x3D, 0xBD, 0xFF, 0x82, 0x37, 0x1D, 
+0xB2, 0xF7, 0x3C, 0x4A, 0xF4, 0xBA, 0x4F, 0x1E, 
+0x15, 0x1E, 0x2A, 0x34, 0x05, 0xFE, 0x12, 0x69, 
+0xE9, 0x8F, 0x6D, 0xA6, 0xCB, 0x96, 0x15, 0x8C, 
+0xE8, 0xEE, 0x19, 0xCF, 0x28, 0x74, 0x74, 0x6D, 
+0x24, 0x58, 0xBD, 0x54, 0x9A, 0x01, 0x5C, 0xBF, 
+0xB3, 0x2D, 0x38, 0xA6, 0xAF, 0x87, 0xB7, 0xC9, 
+0x28, 0xFD, 0xC4, 0x56, 0xFC, 0x43, 0x39, 0xC6, 
+0xD7, 0x58, 0x52, 0xF7, 0xC4, 0x7D, 0x47, 0x58, 
+0xD3, 0xBC, 0x44, 0x21, 0xE0, 0x54, 0xBD, 0xCE, 
+0xF3, 0xE2, 0x13, 0x66, 0x20, 0x4A, 0xB7, 0xBC, 
+0xDB, 0xA8, 0x4A, 0xC7, 0xF4, 0x8F, 0x04, 0x91, 
+0xD3, 0xA3, 0xF0, 0x06, 0x8C, 0xCA, 0xAE, 0x1A, 
+0xB9, 0x4D, 0xD5, 0x1E, 0x1A, 0xF6, 0x58, 0x74, 
+0x5F, 0xC4, 0x0E, 0xEB, 0x1A, 0xCB, 0x89, 0x3D, 
+0x73, 0xC2, 0x82, 0xF9, 0xC8, 0x22, 0x07, 0x21, 
+0x45, 0xEE, 0x1C, 0x9D, 0x46, 0x08, 0x20, 0x99, 
+0x43, 0x4C, 0x05, 0xDE, 0x23, 0x48, 0x1C, 0x6B, 
+0x19, 0x33, 0xA3, 0x7D, 0x97, 0x65, 0x54, 0x8D, 
+0x89, 0xF2, 0xB7, 0xFD, 0x41, 0x3A, 0x09, 0xC3, 
+0x07, 0xAB, 0x56, 0x9C, 0x79, 0x44, 0x5A, 0x35, 
+0x86, 0x5D, 0xE0, 0x3E, 0xFA, 0xA1, 0x66, 0x62, 
+0xC2, 0xC5, 0xC9, 0x73, 0x8A, 0x30, 0x8E, 0x7A, 
+0x0F, 0x15, 0x9B, 0x04, 0x3D, 0x44, 0x4D, 0x51, 
+0x57, 0xF8, 0xB1, 0x88, 0x47, 0xB9, 0xDE, 0x3F, 
+0xF5, 0x7A, 0xA2, 0x19, 0x45, 0xC3, 0x34, 0x5A, 
+0xEA, 0x08, 0xCF, 0x78, 0x30, 0xA8, 0x06, 0x5B, 
+0x57, 0x9F, 0x0F, 
+
+0x1A, 0xAB, 0x5A, 0x19, 0x29, 0x38, 0x9E, 0xFA, 
+0x8C, 0x61, 0xAF, 0x98, 0x87, 0x00, 0xE3, 0x9A, 
+0xCB, 0xA4, 0x7C, 0x41, 0x98, 0x62, 0x1E, 0x59, 
+0xE3, 0xC2, 0xF5, 0x3C, 0x31, 0xB2, 0xB6, 0xAF, 
+0xCD, 0x03, 0x2F, 0x1A, 0xEB, 0xB5, 0x04, 0x3D, 
+0x71, 0x9D, 0x68, 0x02, 0x3D, 0x2C, 0xA0, 0xC8, 
+0x15, 0x8E, 0x61, 0x6B, 0xB7, 0x5A, 0x7E, 0xFD, 
+0x86, 0xF3, 0x28, 0xF0, 0xCE, 0x9E, 0xF7, 0xB8, 
+0x0D, 0x97, 0xC3, 0x21, 0x65, 0xC3, 0x88, 0x01, 
+0xBA, 0x02, 0x46, 0x77, 0x6C, 0x5C, 0xDF, 0x19, 
+0xC6, 0x89, 0xBC, 0x53, 0xE4, 0x80, 0x29, 0x73, 
+0x83, 0x5D, 0xF7, 0xC4, 0x55, 0x0C, 0x86, 0x26, 
+0xE2, 0x03, 0x00, 0xA5, 0x35, 0x6F, 0x86, 0x94, 
+0xDD, 0xF3, 0x09, 0x88, 0x0D, 0x00, 0x74, 0x86, 
+0x8C, 0xAC, 0x3E, 0x00, 0x2B, 0xB9, 0x6C, 0x39, 
+0xE4, 0x10, 0x31, 0x2C, 0xB1, 0x79, 0x5E, 0xF0, 
+0x52, 0x1B, 0xDA, 0xF0, 0x92, 0x8A, 0x21, 0x50, 
+0xE0, 0x6B, 0x6D, 0x35, 0x8B, 0xA4, 0xFA, 0x65, 
+0xF2, 0xBE, 0x5D, 0x1B, 0x5A, 0x8B, 0x6A, 0xFB, 
+0xCE, 0x68, 0xF0, 0x4D, 0x0C, 0x63, 0x73, 0xB7, 
+0x8F, 0x47, 0xF3, 0x7B, 0x2F, 0x63, 0x43, 0x6B, 
+0x53, 0x42, 0x62, 0x9E, 0x88, 0x42, 0xED, 0xEF, 
+0x5B, 0x30, 0x26, 0x5F, 0xD2, 0xFC, 0xE3, 0xF9, 
+0x41, 0xEF, 0x53, 0xE6, 0x79, 0x7C, 0xA7, 0x49, 
+0x25, 0xA7, 0xA3, 0x12, 0x87, 0x30, 0x82, 0x1E, 
+0x49, 0x35, 0xAC, 0xBF, 0x88, 0xC9, 0x08, 0x79, 
+0xBE, 0x41, 0x10, 0xA6, 0xD2, 0xDD, 0x3D, 0xE8, 
+0x3D, 0xE0, 0x71, 0x56, 0x84, 0x67, 0x74, 0xE7, 
+0x84, 0xFA, 0x96, 0x3F, 0x7D, 0xDA, 0x39, 0x7A, 
+0x27, 0x8B, 0x1D, 0x56, 0xA3, 0x50, 0xCA, 0xEB, 
+0x6B, 0xF5, 0x7D, 0x53, 0x60, 0xAE, 0x14, 0xD5, 
+0xA2, 0x1D, 0xFC, 0x5D, 
+
+0x78, 0xD6, 0xCC, 0x24, 0x71, 0x89, 0xDF, 0x27, 
+0xAA, 0x2E, 0xF4, 0x47, 0xFC, 0x90, 0x5D, 0x57, 
+0x17, 0x5F, 0xA1, 0x3B, 0xDA, 0xC6, 0xDB, 0xB4, 
+0x17, 0x25, 0x18, 0x7A, 0x99, 0x79, 0xCA, 0x7C, 
+0x41, 0x1B, 0x67, 0xCE, 0x41, 0x45, 0xD0, 0x18, 
+0x75, 0x38, 0x14, 0xE2, 0x1E, 0xC5, 0xDA, 0xC3, 
+0x8B, 0x72, 0x2A, 0xED, 0xB1, 0xC7, 0x45, 0x43, 
+0x3A, 0x74, 0x19, 0xCB, 0xED, 0x4F, 0x8C, 0xA6, 
+0x41, 0xAF, 0x33, 0x00, 0x14, 0x7D, 0xC4, 0xBE, 
+0x06, 0x2E, 0xFC, 0x5B, 0x0D, 0x78, 0x7D, 0x2B, 
+0xD4, 0x60, 0xA5, 0x0F, 0x73, 0xBC, 0x42, 0x16, 
+0xCD, 0x1D, 0x97, 0x67, 0x77, 0x64, 0x59, 0x44, 
+0xDB, 0x07, 0x53, 0x2F, 0x2A, 0xBB, 0x99, 0xA9, 
+0x9D, 0x4C, 0x3E, 0x41, 0x3E, 0xF1, 0x4C, 0xD5, 
+0x90, 0x95, 0x91, 0xB9, 0xAD, 0xF3, 0xF9, 0xC9, 
+0x1F, 0xB6, 0x7C, 0x35, 0x42, 0xA1, 0xF9, 0x7E, 
+0x57, 0x62, 0xE7, 0x3B, 0x65, 0xC4, 0xBD, 0xA2, 
+0x22, 0xAB, 0x07, 0xB4, 0xE7, 0xF0, 0x2D, 0xB3, 
+0xDD, 0xFD, 0xF8, 0xB3, 0xBE, 0x45, 0xEA, 0xD3, 
+0xBB, 0x5D, 0x56, 0xFA, 0x5D, 0x09, 0x57, 0x2D, 
+0xA0, 0xD5, 0xCD, 0x49, 0xCD, 0x42, 0xB5, 0x68, 
+0x3A, 0x40, 0xAD, 0x4B, 0x2C, 0xC9, 0x53, 0x74, 
+0x1B, 0xD8, 0x14, 0x90, 0x56, 0x17, 0xE5, 0x71, 
+0x92, 0x57, 0xBD, 0x02, 0x46, 0xF4, 0x16, 0x3F, 
+0xE4, 0x44, 0x77, 0x62, 0xC3, 0x0F, 0xB2, 0x7E, 
+0x4B, 0xD9, 0x4F, 0xBF, 0x81, 0x98, 0x17, 0xA6, 
+0x70, 0x1F, 0x25, 0x88, 0xC9, 0x9C, 0x1C, 0x82, 
+0xB2, 0x07, 0x52, 0x1C, 0xC7, 0xF4, 0x12, 0x29, 
+0xB3, 0xA3, 0x64, 0x5A, 0xB7, 0x5D, 0xE8, 0x97, 
+0x14, 0x95, 0x1C, 0x98, 0x7F, 0xC7, 0x3C, 0x26, 
+0x05, 0xB4, 0x1F, 0x24, 0x40, 0x7C, 0xA9, 0x10, 
+0xF3, 0xF2, 0xE8, 0xE7, 0x4C, 
+
+0x12, 0x42, 0x3B, 0x0A, 0x62, 0x53, 0x92, 0xE4, 
+0x9E, 0xA2, 0x25, 0xF0, 0xD4, 0xF0, 0xA8, 0x19, 
+0x38, 0x63, 0x4C, 0xC5, 0x44, 0x6E, 0x4B, 0xCB, 
+0x0D, 0xFA, 0x1D, 0x1E, 0xC1, 0x53, 0x06, 0xD3, 
+0x9D, 0x93, 0x4A, 0xC1, 0x33, 0x23, 0x75, 0xAB, 
+0x6F, 0xB0, 0xAB, 0xBE, 0x6E, 0x52, 0xE8, 0x3C, 
+0xA6, 0x3F, 0xE6, 0x6F, 0x6D, 0x4F, 0xF3, 0x02, 
+0xEC, 0xDE, 0x66, 0x79, 0xF7, 0xDA, 0xB1, 0x4D, 
+0x21, 0x93, 0x46, 0x2C, 0xC0, 0xBB, 0x8E, 0x6A, 
+0x5B, 0xC1, 0xA6, 0x2E, 0x3B, 0xF9, 0x60, 0xDB, 
+0x13, 0x6E, 0x3A, 0x19, 0xC3, 0xFD, 0x9C, 0xEF, 
+0x5B, 0xA8, 0xEF, 0x88, 0x2D, 0x80, 0xEB, 0xB6, 
+0x68, 0xDC, 0x39, 0xFC, 0x1E, 0x18, 0xE8, 0x98, 
+0x52, 0x1B, 0x79, 0x3A, 0xEF, 0x29, 0x3F, 0x72, 
+0x51, 0xD4, 0xEA, 0x56, 0x00, 0xAD, 0x45, 0x98, 
+0x59, 0x76, 0x23, 0xEA, 0xF6, 0x2F, 0x60, 0xAE, 
+0x8D, 0x89, 0xE9, 0x7A, 0xBC, 0xE5, 0x73, 0x23, 
+0xD6, 0x48, 0xE2, 0xD4, 0xD8, 0x32, 0xA1, 0xCD, 
+0x9F, 0xF8, 0x3F, 0x10, 0x2C, 0xAB, 0x6F, 0x78, 
+0x01, 0x05, 0x45, 0x40, 0xFA, 0x34, 0xE4, 0x9C, 
+0x5E, 0xE0, 0x55, 0x73, 0x38, 0x1B, 0x87, 0xC6, 
+0x98, 0x66, 0x06, 0x79, 0xE1, 0xC8, 0x1C, 0x63, 
+0xF2, 0xFE, 0x30, 0x87, 0xE0, 0x6E, 0x38, 0xCE, 
+0x0D, 0x37, 0xEF, 0x2A, 0x1A, 0x92, 0xD4, 0x36, 
+0xBE, 0x5F, 0xBF, 0x35, 0x38, 0xA3, 0xAA, 0x36, 
+0x58, 0xA5, 0x5E, 0x52, 0xA7, 0xC5, 0x2E, 0x49, 
+0x67, 0xE0, 0x73, 0x35, 0xE4, 0x9A, 0x92, 0xD0, 
+0xF6, 0x8F, 0x34, 0xA6, 0xB6, 0xA5, 0x56, 0x6F, 
+0xCE, 0xAD, 0x64, 0x49, 0xA8, 0xF2, 0xDD, 0xBB, 
+0xC4, 0xEE, 0x4E, 0x04, 0xFA, 0x4D, 0xC4, 0xB6, 
+0xDD, 0x36, 0x22, 0xBB, 0x73, 0xF9, 0xFE, 0x1E, 
+0x2E, 0x70, 0x52, 0x38, 0x20, 0xAC, 
+
+0x53, 0xC0, 0x19, 0x2B, 0x14, 0x7F, 0x23, 0xB3, 
+0x47, 0xBC, 0xB0, 0x0C, 0x15, 0xF9, 0x9B, 0x68, 
+0x24, 0xDB, 0xA2, 0xFA, 0x00, 0x76, 0x47, 0x26, 
+0xB1, 0xD4, 0xDE, 0xAB, 0x40, 0x7E, 0x84, 0xD4, 
+0x79, 0x33, 0xF2, 0xD0, 0x52, 0x16, 0x84, 0xA7, 
+0xB2, 0xCC, 0x57, 0x92, 0xB7, 0x02, 0xA2, 0x3F, 
+0xED, 0xEA, 0x5D, 0xC7, 0xA7, 0xDB, 0xFC, 0x69, 
+0x5B, 0xD2, 0x8A, 0xDF, 0x1C, 0xFA, 0xBC, 0x11, 
+0xE0, 0x90, 0xFC, 0x95, 0xE8, 0x70, 0x4E, 0x0F, 
+0x66, 0x00, 0x3D, 0x63, 0x50, 0xB1, 0xAC, 0x22, 
+0xC5, 0x86, 0x34, 0x8B, 0x77, 0x69, 0x5F, 0xF3, 
+0x9F, 0x05, 0x13, 0x87, 0xF3, 0x69, 0xB1, 0x9C, 
+0xFC, 0x6A, 0xF4, 0x46, 0xBE, 0x83, 0xB7, 0x7E, 
+0x3A, 0xBC, 0x7F, 0x58, 0xCD, 0xB0, 0xB5, 0x50, 
+0x3D, 0x11, 0x75, 0xD9, 0x9F, 0xC9, 0x1A, 0x71, 
+0xA0, 0xDC, 0x6D, 0xFA, 0x6C, 0x25, 0xEB, 0xF1, 
+0xE6, 0x20, 0x30, 0x52, 0x76, 0x78, 0xC8, 0xC6, 
+0x65, 0xB3, 0x94, 0x8D, 0xD7, 0x27, 0x9C, 0xDF, 
+0xD5, 0x55, 0xC3, 0xEE, 0xA7, 0x46, 0x82, 0x2C, 
+0x1D, 0x1A, 0x5B, 0xDD, 0x55, 0x82, 0xC9, 0x70, 
+0xEE, 0xBC, 0x50, 0x58, 0x74, 0x8C, 0x4F, 0xD1, 
+0x1B, 0x35, 0xB5, 0x8B, 0x20, 0xCE, 0x25, 0x64, 
+0x88, 0x8E, 0x3D, 0xA0, 0x3E, 0x43, 0x74, 0x4B, 
+0x95, 0x1B, 0x36, 0xF4, 0xA3, 0x97, 0x11, 0x1C, 
+0x73, 0xB7, 0x9A, 0x6F, 0x04, 0x40, 0xC9, 0xFB, 
+0x72, 0x79, 0x7C, 0x0D, 0x3A, 0xEC, 0x05, 0x91, 
+0xC5, 0x53, 0xE9, 0x45, 0x5F, 0x44, 0x9D, 0xA0, 
+0x9C, 0x28, 0x18, 0xFA, 0xA5, 0x88, 0x18, 0x1C, 
+0xC3, 0x86, 0x89, 0xAB, 0xE6, 0x59, 0x4A, 0x66, 
+0xF4, 0xFF, 0xBC, 0x49, 0x1C, 0x63, 0xEE, 0xB9, 
+0x3E, 0x00, 0xBA, 0xA6, 0x57, 0xDA, 0x76, 0x41, 
+0x1E, 0xE6, 0xDB, 0xF9, 0xF1, 0xB1, 0xD8, 
+
+0x6C, 0x5B, 0xF6, 0xF4, 0x7C, 0x8B, 0x21, 0x37, 
+0x52, 0x6F, 0x5D, 0xC4, 0x28, 0x8E, 0xCE, 0x18, 
+0x20, 0x37, 0x39, 0x16, 0x39, 0x2E, 0xF7, 0xAA, 
+0xD6, 0xFB, 0x8C, 0x4A, 0x50, 0xD3, 0xF7, 0x34, 
+0x07, 0x3C, 0x59, 0x6A, 0x4A, 0x30, 0x1B, 0xC0, 
+0x88, 0x9D, 0x47, 0xC4, 0x37, 0x6B, 0x59, 0x73, 
+0xEE, 0xF6, 0x2A, 0x3C, 0x39, 0x59, 0x25, 0xEC, 
+0x8F, 0x75, 0xF7, 0x76, 0x9F, 0x33, 0xFF, 0xF1, 
+0x70, 0xDC, 0x82, 0x91, 0xD4, 0xEB, 0x79, 0x04, 
+0x70, 0x9B, 0x0A, 0xA4, 0x61, 0x8A, 0x4C, 0x14, 
+0x10, 0x4A, 0x27, 0x47, 0xF4, 0x35, 0xE2, 0xDA, 
+0xD8, 0x8D, 0xB6, 0xC3, 0xC8, 0xD9, 0x3A, 0xCD, 
+0x00, 0x2A, 0x7D, 0xAC, 0x78, 0x76, 0xFE, 0xE1, 
+0xC9, 0x0A, 0x44, 0x45, 0xA1, 0x3C, 0x2E, 0x9C, 
+0x42, 0x4C, 0x09, 0x0F, 0xF0, 0x4F, 0xD8, 0x07, 
+0x22, 0x4F, 0xED, 0xAC, 0x76, 0x6F, 0x1B, 0xBF, 
+0xC8, 0xCF, 0x6D, 0x3D, 0xDB, 0x6E, 0x0F, 0x3F, 
+0xDD, 0x11, 0x9E, 0x9F, 0x98, 0xBD, 0xD8, 0x99, 
+0xC8, 0xDB, 0xCA, 0x80, 0x29, 0x61, 0x1C, 0xDF, 
+0x61, 0x6F, 0x89, 0x2F, 0xD6, 0xC5, 0x9D, 0x1B, 
+0x20, 0x43, 0x78, 0xA0, 0xF5, 0xAC, 0x17, 0x3B, 
+0x80, 0xDB, 0x0F, 0x32, 0x37, 0x83, 0x39, 0x5F, 
+0x0F, 0xEC, 0x94, 0xF3, 0x8E, 0x37, 0x3E, 0x76, 
+0xB7, 0xF9, 0x63, 0x42, 0xB7, 0xDB, 0x5D, 0x9A, 
+0x57, 0x30, 0x41, 0x1A, 0x48, 0x0D, 0x10, 0x97, 
+0x6C, 0x16, 0x18, 0x70, 0x83, 0x47, 0x14, 0x89, 
+0x90, 0x71, 0x76, 0x0B, 0x3A, 0x62, 0xCF, 0x4B, 
+0x27, 0x52, 0x3B, 0x9A, 0xA6, 0xC3, 0x55, 0x1C, 
+0x21, 0x76, 0xA5, 0x51, 0xA5, 0xE9, 0x41, 0xF3, 
+0xD9, 0xA6, 0xE3, 0x98, 0x48, 0x35, 0x34, 0x78, 
+0xCF, 0x4A, 0xF5, 0x41, 0xE0, 0xC1, 0x12, 0x1D, 
+0x3F, 0xB0, 0x1E, 0x2F, 0x2F, 0x31, 0x40, 0xF3, 
+
+0xF7, 0xC1, 0x7A, 0xA0, 0xB5, 0x7D, 0xA1, 0x6F, 
+0x81, 0xA2, 0x01, 0xF1, 0x2E, 0xCB, 0xA3, 0x5B, 
+0x7E, 0xE9, 0xD4, 0x49, 0x01, 0x6C, 0x3A, 0x67, 
+0xE2, 0xF0, 0x9A, 0xED, 0xBA, 0xA9, 0xC5, 0xEA, 
+0xA1, 0x29, 0x04, 0xAB, 0x01, 0xAE, 0xB1, 0x8A, 
+0x8F, 0xDF, 0x38, 0xFA, 0x36, 0xA6, 0xCB, 0x82, 
+0x5B, 0x12, 0x67, 0xC8, 0x43, 0xDD, 0xC6, 0xDE, 
+0x9A, 0x2F, 0xEE, 0xAB, 0x82, 0x5C, 0x7B, 0x34, 
+0x1B, 0xCB, 0x93, 0x36, 0x02, 0x93, 0x92, 0x24, 
+0x0D, 0x7F, 0xAA, 0xB9, 0x10, 0x10, 0xD6, 0x03, 
+0xE4, 0xA0, 0xD9, 0x5B, 0x7C, 0x1B, 0x1F, 0xAC, 
+0xBB, 0xCE, 0xE2, 0xE2, 0xE0, 0xCA, 0x55, 0x35, 
+0x09, 0xBE, 0x75, 0x5E, 0x31, 0x79, 0xC4, 0x3E, 
+0xEF, 0x2F, 0x77, 0x4F, 0x5F, 0xBD, 0x8A, 0x62, 
+0x2C, 0xFB, 0x43, 0x6A, 0xEF, 0xC5, 0x15, 0xB1, 
+0x21, 0x91, 0xCE, 0xD1, 0x58, 0x06, 0x40, 0xB7, 
+0x55, 0xC1, 0xA5, 0x2C, 0xAB, 0x97, 0x2E, 0xCB, 
+0x4E, 0xD7, 0x8D, 0xDA, 0x05, 0xD7, 0x41, 0xB7, 
+0xB8, 0x2A, 0x86, 0xF0, 0x27, 0xBF, 0xB3, 0x04, 
+0xB6, 0x26, 0x95, 0x88, 0x20, 0xC4, 0x6C, 0x73, 
+0x31, 0x53, 0x52, 0xF1, 0x24, 0x8A, 0x58, 0x59, 
+0x12, 0xA8, 0xD4, 0xFE, 0xF2, 0xE9, 0x20, 0x9F, 
+0xF8, 0x81, 0xAE, 0x57, 0x15, 0x30, 0x0E, 0xC9, 
+0xA1, 0x53, 0x5D, 0x66, 0x35, 0xD7, 0x79, 0x12, 
+0xA9, 0x9C, 0xED, 0xD7, 0x64, 0x8C, 0x2B, 0xDE, 
+0x44, 0x1E, 0x04, 0x19, 0xC4, 0x47, 0x54, 0x6D, 
+0xD1, 0xFD, 0x37, 0xF4, 0xE5, 0x22, 0x04, 0xB9, 
+0x87, 0xC5, 0xF3, 0x87, 0xC8, 0x2C, 0x02, 0x72, 
+0x09, 0xAA, 0x3F, 0xE2, 0xB2, 0xCE, 0x06, 0x19, 
+0x45, 0xE1, 0xBF, 0xD0, 0x7D, 0xAC, 0xEF, 0x2A, 
+0x03, 0x0A, 0xDC, 0x04, 0x43, 0xBC, 0xAA, 0xC3, 
+0x08, 0x20, 0xB4, 0x1F, 0x98, 0xC2, 0x05, 0x1D, 
+0xBA, 
+
+0x5E, 0xC6, 0xF2, 0xB8, 0x62, 0xC5, 0x23, 0x82, 
+0xCF, 0xC4, 0xEA, 0xF2, 0x3D, 0xEB, 0xE9, 0x6F, 
+0x44, 0xD2, 0x0E, 0x07, 0x8D, 0x4B, 0x50, 0xA7, 
+0x2E, 0x16, 0x4C, 0x82, 0x56, 0xB0, 0x95, 0x17, 
+0x18, 0x96, 0xC5, 0x8E, 0x12, 0xE9, 0x33, 0x86, 
+0x7E, 0xDE, 0xB7, 0xD0, 0x74, 0x10, 0xD2, 0x23, 
+0x33, 0x43, 0x7A, 0xEF, 0x5B, 0x0A, 0xAC, 0xD5, 
+0xC2, 0x10, 0x57, 0xFB, 0x35, 0x4C, 0x01, 0xEB, 
+0x48, 0xD5, 0xD7, 0x51, 0x05, 0xEF, 0x4F, 0xF9, 
+0x2B, 0x2B, 0xF8, 0x9D, 0xFA, 0xA5, 0x5E, 0xF0, 
+0x24, 0x3F, 0xC5, 0xD4, 0xA0, 0x6E, 0x25, 0xE1, 
+0x06, 0xD2, 0x61, 0xA1, 0x37, 0x1D, 0x5A, 0xDC, 
+0x3B, 0x3D, 0x8A, 0x59, 0x9D, 0x5B, 0xFA, 0x87, 
+0xF6, 0x56, 0xE2, 0x27, 0x26, 0x4B, 0xA5, 0xFB, 
+0x9A, 0x38, 0x7E, 0x73, 0x9D, 0x3F, 0xEC, 0x81, 
+0xF0, 0x2D, 0x32, 0x78, 0x45, 0xCD, 0xE3, 0x34, 
+0x2A, 0xF0, 0x01, 0x8B, 0x6C, 0xC3, 0xE8, 0x5A, 
+0xE6, 0xDE, 0xE6, 0x25, 0xD5, 0x99, 0x76, 0x15, 
+0xD9, 0xA5, 0xA7, 0x26, 0xC8, 0xDB, 0x54, 0x6E, 
+0xF9, 0xB3, 0x70, 0x79, 0x81, 0xCC, 0xF0, 0x31, 
+0xC2, 0xAE, 0xF5, 0x68, 0x59, 0xF0, 0xA5, 0xC4, 
+0xC3, 0x15, 0xE8, 0xD8, 0xD7, 0xAC, 0x73, 0x15, 
+0x11, 0x17, 0xCD, 0xBF, 0x0E, 0xCC, 0xC3, 0xBA, 
+0x3E, 0x45, 0xFE, 0x8A, 0x8D, 0x76, 0xD9, 0x28, 
+0x9D, 0x15, 0xEB, 0xF0, 0x25, 0xFD, 0xD6, 0x85, 
+0x06, 0x6F, 0xFE, 0xBF, 0xD2, 0x95, 0xD9, 0x1C, 
+0xEC, 0xA7, 0x64, 0x32, 0x75, 0xE0, 0x3E, 0xFD, 
+0x84, 0xE9, 0xD3, 0x3D, 0x7B, 0x66, 0x14, 0x79, 
+0xF5, 0x80, 0xC8, 0xDE, 0xFF, 0x78, 0x43, 0x09, 
+0xD9, 0xD7, 0x28, 0xBA, 0x0D, 0x50, 0xC4, 0xDC, 
+0xE9, 0x0B, 0xB2, 0xCB, 0xE6, 0x05, 0x16, 0x96, 
+0x94, 0x9D, 0x6C, 0x62, 0xD3, 0x45, 0xDA, 0x46, 
+0x9B, 0x8D, 
+
+0x94, 0xEC, 0xD1, 0xB1, 0x4B, 0xA8, 0x1C, 0x4A, 
+0xFE, 0xF4, 0x3F, 0xA7, 0xD5, 0x15, 0xFC, 0x73, 
+0xDA, 0xF5, 0xAA, 0x37, 0xE6, 0x28, 0x80, 0x61, 
+0x27, 0x25, 0xC8, 0xB0, 0x10, 0x0C, 0x7E, 0xB9, 
+0x5F, 0xA2, 0xB3, 0xD1, 0x46, 0x9A, 0xF9, 0x19, 
+0x04, 0x63, 0xA9, 0x32, 0x12, 0xB9, 0xD7, 0x73, 
+0xBD, 0x2F, 0x85, 0x4C, 0x3F, 0x24, 0x3E, 0xE5, 
+0x7D, 0x40, 0xC7, 0xF2, 0xA7, 0xE1, 0x94, 0xA3, 
+0xE4, 0xBB, 0xED, 0xBF, 0x0A, 0x79, 0x1B, 0xC5, 
+0x19, 0x5C, 0xCE, 0x02, 0xCB, 0xD4, 0xAD, 0x6C, 
+0x9B, 0xE0, 0xDF, 0xF7, 0xBA, 0xCB, 0x7E, 0xF6, 
+0xEE, 0x62, 0x2D, 0xE4, 0x61, 0xBE, 0x8F, 0x45, 
+0x44, 0x71, 0x44, 0x0E, 0x0F, 0x1B, 0xA7, 0xB2, 
+0x55, 0xE0, 0x80, 0x85, 0x3F, 0x6D, 0x03, 0x51, 
+0xB8, 0x93, 0xD9, 0x54, 0xCD, 0x94, 0x35, 0x0B, 
+0x53, 0x26, 0x2B, 0x93, 0x76, 0x33, 0x33, 0x5E, 
+0x34, 0x98, 0xB1, 0x36, 0x47, 0x9B, 0x66, 0x99, 
+0x46, 0x4E, 0x71, 0xB4, 0x2E, 0x04, 0x74, 0x87, 
+0xE0, 0x7F, 0xF3, 0x7F, 0xBA, 0xA7, 0xE6, 0x66, 
+0x56, 0x8F, 0xB9, 0x31, 0xB0, 0xA3, 0xF5, 0x07, 
+0x22, 0x67, 0x45, 0x7F, 0x6E, 0xA8, 0x43, 0x91, 
+0x4C, 0x42, 0x24, 0x80, 0x1D, 0x90, 0xAB, 0x3D, 
+0xD9, 0xC0, 0xC5, 0x8B, 0x17, 0x9E, 0x68, 0x3E, 
+0xD4, 0x50, 0xE9, 0x40, 0x0F, 0x87, 0xB0, 0xA7, 
+0x52, 0x38, 0x37, 0xD7, 0x18, 0xD1, 0x7D, 0x3A, 
+0xB2, 0x73, 0x33, 0xA6, 0xFF, 0x51, 0xE5, 0x7A, 
+0x0A, 0xA3, 0x97, 0x45, 0x40, 0x7E, 0xC7, 0x40, 
+0xEC, 0x4D, 0x3B, 0xA8, 0xAF, 0x7E, 0x26, 0xCA, 
+0xE5, 0x69, 0x84, 0xC7, 0xF5, 0x82, 0x3B, 0x61, 
+0x08, 0x54, 0x8C, 0x90, 0x9C, 0xA2, 0x03, 0x2E, 
+0xFD, 0x8F, 0x01, 0x8C, 0x65, 0x31, 0xC9, 0x61, 
+0x64, 0x00, 0x6C, 0x46, 0x56, 0x2C, 0x57, 0x64, 
+0x6F, 0x5E, 0x77, 
+
+0xA1, 0xAD, 0xED, 0xBF, 0xE5, 0x79, 0x4A, 0x68, 
+0xDD, 0xCF, 0x07, 0xB7, 0x69, 0x14, 0xED, 0xC9, 
+0x83, 0x75, 0x28, 0x4F, 0x35, 0xAC, 0x3A, 0x6B, 
+0xBB, 0xE2, 0x53, 0x14, 0x37, 0x72, 0xF5, 0xA4, 
+0x8E, 0x65, 0x26, 0xBD, 0x95, 0xB0, 0x76, 0x2B, 
+0x7A, 0x86, 0x3B, 0x0F, 0x8C, 0xF5, 0x25, 0xE1, 
+0x58, 0x99, 0x16, 0x49, 0x3F, 0x13, 0x08, 0x6C, 
+0xEF, 0x19, 0x2F, 0xEF, 0x90, 0x2C, 0x44, 0xE7, 
+0x9E, 0x45, 0x84, 0xE4, 0x99, 0x49, 0x05, 0x61, 
+0x5B, 0x09, 0xA4, 0x56, 0xFB, 0xE4, 0x7D, 0x73, 
+0x75, 0x18, 0x42, 0x1C, 0xE3, 0xD1, 0x89, 0x85, 
+0xBA, 0x50, 0x41, 0x2A, 0xD2, 0xD0, 0xCC, 0xA7, 
+0x60, 0x88, 0xA1, 0x03, 0xC0, 0x33, 0x87, 0xFA, 
+0x35, 0xA6, 0x40, 0x8B, 0x34, 0xC0, 0x31, 0x46, 
+0xFF, 0xB5, 0x56, 0x71, 0x36, 0x60, 0x63, 0xED, 
+0x74, 0xD8, 0xBA, 0xA1, 0x53, 0xAF, 0x2F, 0xFD, 
+0xC1, 0x82, 0x26, 0xC5, 0x65, 0x98, 0x19, 0x7C, 
+0x2D, 0x8B, 0xB3, 0xCA, 0x6A, 0x0C, 0xF0, 0x0C, 
+0x96, 0xB5, 0x3F, 0x1D, 0x6A, 0x2D, 0x0E, 0x62, 
+0x62, 0x59, 0xFC, 0x69, 0x4C, 0xAE, 0x55, 0xED, 
+0xE9, 0x3F, 0xFB, 0x58, 0x57, 0x58, 0x5E, 0x08, 
+0x59, 0x8B, 0x51, 0x7D, 0x02, 0x43, 0xE6, 0x6D, 
+0xDB, 0xB9, 0xEB, 0xFD, 0x4C, 0xE0, 0xD7, 0x9C, 
+0x89, 0xEE, 0x87, 0x9E, 0xBF, 0x03, 0x01, 0x4E, 
+0xEA, 0x8A, 0x36, 0xF8, 0x2F, 0x56, 0xCB, 0xCD, 
+0xCD, 0x3C, 0x7D, 0x61, 0x19, 0x19, 0x43, 0xC1, 
+0x99, 0x6B, 0x9B, 0xA7, 0x20, 0xC5, 0x91, 0x01, 
+0x9C, 0xC0, 0x0F, 0xD1, 0x9D, 0x63, 0x32, 0xBE, 
+0x40, 0x40, 0x01, 0x46, 0xD6, 0x7F, 0x83, 0x05, 
+0xFA, 0x86, 0x4A, 0xDB, 0xDB, 0x0C, 0x60, 0x8E, 
+0x5B, 0xE9, 0xEC, 0x55, 0x7D, 0x9E, 0x7B, 0x3A, 
+0x55, 0x43, 0x2E, 0xAB, 0x09, 0xB8, 0xDD, 0x5A, 
+0xA2, 0x53, 0xD6, 0x54, 
+
+0xAB, 0x9D, 0x45, 0x38, 0xD8, 0xA1, 0x75, 0x97, 
+0x19, 0xB9, 0x5F, 0x85, 0xE7, 0xD1, 0x8B, 0x35, 
+0x34, 0x7E, 0xA3, 0xC7, 0x8C, 0xA8, 0xB2, 0xD3, 
+0xD3, 0xEA, 0x93, 0x02, 0xB7, 0x99, 0x52, 0xC1, 
+0x6B, 0x2D, 0x8B, 0x9B, 0xD5, 0x5C, 0xCB, 0x2B, 
+0xE4, 0xB4, 0x90, 0x83, 0x80, 0x09, 0x23, 0xAA, 
+0xA3, 0x2E, 0x77, 0xA1, 0xBF, 0x65, 0xDE, 0x4A, 
+0x4A, 0xB3, 0xCC, 0xBF, 0x74, 0x72, 0xDD, 0x37, 
+0x97, 0x43, 0x96, 0xC8, 0x9F, 0x94, 0x3F, 0xF9, 
+0xF1, 0xAC, 0x77, 0xB2, 0x19, 0x31, 0xED, 0x50, 
+0xC0, 0xF6, 0x03, 0x28, 0xDC, 0xC7, 0x35, 0x70, 
+0x04, 0x61, 0x48, 0xC4, 0x88, 0x7D, 0xAA, 0x45, 
+0x2B, 0x63, 0xC2, 0xC7, 0x2A, 0xF4, 0x65, 0x80, 
+0x62, 0x55, 0x2A, 0x56, 0x8F, 0xCB, 0x11, 0xF0, 
+0x2D, 0x72, 0x59, 0x5D, 0x91, 0xFB, 0x81, 0x4F, 
+0xE8, 0x94, 0xFE, 0xB2, 0xCE, 0x48, 0xB5, 0x0B, 
+0xBA, 0x69, 0x39, 0xD7, 0x23, 0x6A, 0x3C, 0x98, 
+0x77, 0xC1, 0x3A, 0x76, 0x7F, 0x64, 0xD6, 0x3A, 
+0xB1, 0x9C, 0x05, 0xEF, 0x6C, 0x89, 0x28, 0x82, 
+0x6A, 0xA3, 0x6C, 0x76, 0xDE, 0x9E, 0xB6, 0x27, 
+0xEC, 0x8C, 0xFF, 0x0D, 0x80, 0x9D, 0x6A, 0x84, 
+0xEC, 0xB4, 0xB8, 0xB7, 0x80, 0x16, 0x62, 0xF8, 
+0x9F, 0x00, 0x60, 0xAE, 0x08, 0x94, 0x7C, 0x56, 
+0x74, 0x6E, 0x83, 0x7C, 0x8C, 0xA0, 0xB1, 0x2B, 
+0x4B, 0x2E, 0x40, 0xB3, 0x3D, 0xB6, 0x18, 0x7D, 
+0x81, 0xE4, 0x77, 0x6F, 0x9B, 0xC3, 0x95, 0xB0, 
+0x9B, 0x54, 0xCC, 0x35, 0x6C, 0xF3, 0xD6, 0xCB, 
+0xF3, 0x3F, 0x8F, 0x26, 0xCC, 0xF2, 0xEF, 0x96, 
+0x6A, 0x9F, 0x21, 0x33, 0x9F, 0xD5, 0x1B, 0xD2, 
+0x52, 0xB5, 0x97, 0x12, 0x89, 0xC4, 0xE8, 0x2C, 
+0x60, 0x3D, 0x4F, 0xAA, 0x3F, 0x51, 0xEA, 0xEE, 
+0xE6, 0xA1, 0xB5, 0xED, 0xFB, 0x56, 0x74, 0xF3, 
+0x85, 0xB8, 0x5D, 0x79, 0x5F, 
+
+0xFE, 0x1D, 0x19, 0x36, 0x3A, 0x7D, 0xCB, 0x15, 
+0x6A, 0x1E, 0x73, 0x37, 0xDF, 0x6C, 0x71, 0x62, 
+0x35, 0xDD, 0x28, 0x36, 0x21, 0xA7, 0x24, 0x4F, 
+0x5B, 0x11, 0x36, 0x07, 0x1B, 0xA9, 0xF0, 0x9E, 
+0x86, 0xC2, 0xC3, 0xDD, 0x96, 0xAE, 0x25, 0x79, 
+0xE7, 0x8D, 0x4D, 0x76, 0x38, 0x13, 0xE5, 0xC9, 
+0x00, 0xD4, 0x4A, 0x88, 0x27, 0xBC, 0x39, 0x63, 
+0xAA, 0x4C, 0xFE, 0x39, 0xB0, 0xC0, 0xBC, 0x7B, 
+0x80, 0xDD, 0x6B, 0xD8, 0xE7, 0x1B, 0xD2, 0xFB, 
+0xD5, 0xC8, 0x6D, 0x15, 0x95, 0x9C, 0xDF, 0x0C, 
+0x35, 0x2D, 0xEF, 0x13, 0x90, 0x04, 0x49, 0xC0, 
+0x28, 0xD9, 0xD8, 0x35, 0x4D, 0xA3, 0xE6, 0x3A, 
+0x45, 0xF7, 0xAF, 0x0A, 0x5F, 0x56, 0x4A, 0x8F, 
+0x23, 0xC0, 0xE9, 0x8F, 0xFD, 0x92, 0x78, 0xD1, 
+0x4A, 0xB1, 0xC8, 0xCC, 0x2F, 0x64, 0xF2, 0xB3, 
+0x2E, 0xF7, 0x3B, 0x30, 0xFB, 0x43, 0x5B, 0x95, 
+0xAD, 0x96, 0xF2, 0x88, 0xB6, 0x0E, 0xEE, 0xBB, 
+0x82, 0x6E, 0xA5, 0x9D, 0xD7, 0xCE, 0x39, 0x53, 
+0x93, 0xBC, 0x0A, 0x56, 0xD5, 0x08, 0x46, 0x56, 
+0xA5, 0x04, 0xE3, 0xC3, 0x60, 0x02, 0x7A, 0xF6, 
+0x31, 0x5F, 0xB2, 0x07, 0xF8, 0x51, 0x20, 0xB2, 
+0x34, 0x6F, 0x66, 0x62, 0x6C, 0x67, 0x24, 0x30, 
+0x11, 0x56, 0xC1, 0xC1, 0x7F, 0x62, 0xFE, 0xF5, 
+0xD4, 0xF4, 0x7A, 0xF8, 0x1C, 0xF7, 0xA4, 0xC7, 
+0x01, 0x25, 0x4B, 0x54, 0xB5, 0xB1, 0x73, 0x95, 
+0xA8, 0x9C, 0xE4, 0x1A, 0x73, 0x23, 0x1F, 0x46, 
+0xCE, 0xA5, 0x11, 0xC1, 0x9B, 0xA0, 0x64, 0xB5, 
+0x1F, 0x49, 0x16, 0xCA, 0x10, 0x9A, 0x3A, 0xDE, 
+0x0D, 0x64, 0xCC, 0xFC, 0x6C, 0x97, 0x73, 0xCC, 
+0xB7, 0x4C, 0x48, 0xEC, 0xDE, 0x39, 0x7D, 0x8D, 
+0x09, 0xF3, 0x17, 0x9C, 0xF0, 0x22, 0xF2, 0xE6, 
+0x4F, 0xD6, 0x47, 0x24, 0xA6, 0xE9, 0x4F, 0x75, 
+0x4E, 0xA5, 0xE3, 0xDE, 0x0B, 0xC7, 
+
+0x76, 0x1C, 0x85, 0xF6, 0x8C, 0xE6, 0xAB, 0xA8, 
+0xBD, 0x25, 0x67, 0x33, 0x18, 0xA1, 0xA7, 0x18, 
+0xC9, 0x6E, 0x95, 0x35, 0x50, 0xC3, 0xD9, 0x52, 
+0x73, 0xD5, 0x68, 0xFF, 0x1D, 0x7D, 0x81, 0x50, 
+0x67, 0xB4, 0xBE, 0x9D, 0xBE, 0xEA, 0x99, 0xA6, 
+0xF5, 0x9C, 0xBE, 0x2D, 0x45, 0xC1, 0x33, 0x27, 
+0x06, 0xD2, 0xA7, 0xBA, 0xF5, 0x17, 0xB4, 0x1C, 
+0x2B, 0x38, 0xFD, 0xE5, 0xA1, 0xA8, 0x25, 0x18, 
+0xC3, 0x95, 0x84, 0x65, 0x80, 0x8C, 0x80, 0xE2, 
+0x7B, 0x4D, 0x37, 0x91, 0xEA, 0xB2, 0x8A, 0xD8, 
+0x4B, 0x45, 0xAF, 0xB3, 0x9E, 0x2F, 0xE4, 0xD6, 
+0x7E, 0x87, 0xF5, 0xD7, 0x8E, 0x1F, 0x21, 0xD0, 
+0x5D, 0x62, 0x10, 0xDE, 0xAC, 0xB7, 0x0A, 0x7F, 
+0x42, 0x8E, 0x1D, 0xD9, 0xC8, 0x95, 0xC6, 0x45, 
+0x90, 0x45, 0x58, 0xB9, 0x80, 0x71, 0xDA, 0x62, 
+0xCA, 0x64, 0x1A, 0x73, 0x2F, 0x1C, 0x35, 0x4E, 
+0xE6, 0x3F, 0x43, 0x44, 0x86, 0x63, 0x5B, 0x72, 
+0x24, 0x92, 0x67, 0xB5, 0x8B, 0xFB, 0x34, 0xA3, 
+0x3C, 0x00, 0xA3, 0x48, 0x24, 0xC0, 0x88, 0x88, 
+0x73, 0x20, 0x33, 0x96, 0xF8, 0xA3, 0x7D, 0x67, 
+0xE4, 0x20, 0xE6, 0x8B, 0x7F, 0x97, 0xF6, 0xB3, 
+0x1E, 0xD1, 0x33, 0x8E, 0xCB, 0x99, 0xE5, 0xC6, 
+0x28, 0xD8, 0xDE, 0xF3, 0x8E, 0xAE, 0xC5, 0xA4, 
+0x25, 0xCD, 0x6E, 0xFA, 0x0F, 0xF3, 0x37, 0x9F, 
+0x0B, 0xB5, 0x35, 0xF4, 0xAA, 0x1D, 0xEB, 0x73, 
+0x12, 0xD4, 0x5E, 0x57, 0x9E, 0x3C, 0xFA, 0xE8, 
+0x6E, 0xEB, 0x88, 0xF7, 0x34, 0xB5, 0x7A, 0x8B, 
+0xAD, 0xFB, 0x83, 0x82, 0x12, 0x51, 0x50, 0xCA, 
+0x64, 0x3A, 0xC7, 0x6A, 0xB8, 0x3A, 0x81, 0x5F, 
+0x5B, 0xC1, 0x79, 0xF8, 0x22, 0x15, 0x89, 0x2D, 
+0x86, 0x05, 0x2F, 0xBD, 0x52, 0x51, 0x24, 0xA6, 
+0xC1, 0xEE, 0xEA, 0xC9, 0xC7, 0xD3, 0xD9, 0xDB, 
+0x84, 0x9B, 0x06, 0xFB, 0x60, 0x38, 0x16, 
+
+0x0D, 0x0B, 0x0E, 0xBC, 0x29, 0xC0, 0x75, 0x9D, 
+0x64, 0x70, 0xD9, 0x68, 0xEF, 0x1F, 0xBB, 0xF9, 
+0x2F, 0x1D, 0x13, 0x1F, 0x18, 0x32, 0xFA, 0xA6, 
+0x6A, 0xC8, 0x50, 0x3E, 0x5A, 0xEA, 0x40, 0x59, 
+0x2E, 0xC4, 0x5D, 0xA7, 0xA1, 0x21, 0x84, 0x08, 
+0x59, 0x54, 0xD3, 0x0A, 0x44, 0xA0, 0x15, 0xAC, 
+0x4F, 0x0E, 0x03, 0x9A, 0x7D, 0x05, 0x48, 0x14, 
+0x0B, 0xDB, 0x07, 0x14, 0x3E, 0x1C, 0xF7, 0xB5, 
+0x64, 0x8E, 0x8D, 0x95, 0x8F, 0x95, 0x4B, 0x48, 
+0x30, 0x8B, 0x02, 0xC8, 0x49, 0xF4, 0xAC, 0xAD, 
+0x06, 0xF0, 0x02, 0xA2, 0x6A, 0xEC, 0x0A, 0x82, 
+0xEA, 0x4D, 0x01, 0x9F, 0xAD, 0x98, 0x09, 0x81, 
+0xD0, 0x81, 0x93, 0xB1, 0x90, 0xE2, 0x1D, 0xDC, 
+0x00, 0xCD, 0x39, 0xAB, 0xF8, 0xD0, 0x71, 0x09, 
+0x91, 0x69, 0xE7, 0xA0, 0xB3, 0xCF, 0x6E, 0xB9, 
+0xDA, 0x1E, 0x65, 0xE4, 0xED, 0x57, 0x0D, 0x45, 
+0xCB, 0xB6, 0xB9, 0xEA, 0x6A, 0xEC, 0x79, 0x38, 
+0xB7, 0x5E, 0x3A, 0x00, 0x22, 0x0A, 0x4E, 0xCB, 
+0xDB, 0x91, 0xCD, 0x32, 0x22, 0xD7, 0x60, 0xE2, 
+0x32, 0x95, 0x7B, 0x51, 0x53, 0x0E, 0xE5, 0x56, 
+0x29, 0xF4, 0x99, 0x5C, 0x94, 0x11, 0x50, 0x39, 
+0x9F, 0x90, 0xD3, 0xCC, 0x2E, 0xB3, 0xC6, 0x3A, 
+0xE3, 0x95, 0xA9, 0xF1, 0x44, 0xE5, 0xB8, 0x3C, 
+0xE7, 0xA0, 0x30, 0x91, 0xC8, 0x6F, 0x05, 0xD5, 
+0x9E, 0xA2, 0x9F, 0x53, 0x31, 0xDA, 0x3A, 0x5D, 
+0x27, 0xF7, 0x6F, 0x07, 0x47, 0x2C, 0x78, 0x34, 
+0x5B, 0xEA, 0x57, 0x78, 0x19, 0x76, 0x69, 0x6F, 
+0x46, 0x62, 0x37, 0xB0, 0xA1, 0x99, 0x7A, 0x9A, 
+0x04, 0x08, 0x7B, 0xF0, 0x01, 0xAB, 0x62, 0x0C, 
+0x93, 0x13, 0x5E, 0x21, 0x93, 0xD9, 0x36, 0x64, 
+0x2E, 0xEE, 0x62, 0x57, 0xA5, 0x39, 0x9D, 0x95, 
+0x52, 0xD2, 0x8C, 0x7F, 0x98, 0xA9, 0xDD, 0xF9, 
+0xE4, 0x98, 0xB2, 0x90, 0xE9, 0x52, 0x27, 0x54, 
+
+0xB4, 0xA9, 0xFB, 0x3C, 0xC3, 0x1F, 0x6C, 0x2E, 
+0xC1, 0xA9, 0x83, 0x36, 0xD7, 0x86, 0xFF, 0xC1, 
+0x0B, 0x05, 0x73, 0x86, 0x11, 0xFB, 0x90, 0xA5, 
+0xEE, 0xF5, 0x0C, 0xB0, 0x08, 0x97, 0xB9, 0xFA, 
+0xC0, 0xF0, 0x63, 0x00, 0xC2, 0x47, 0x7D, 0x31, 
+0x40, 0x20, 0x26, 0x4D, 0x8B, 0x93, 0xB6, 0x5B, 
+0x76, 0xE8, 0xB8, 0x6D, 0x0B, 0x6B, 0x18, 0x5B, 
+0x58, 0xCF, 0x44, 0x34, 0xBD, 0x5F, 0x82, 0x14, 
+0x7B, 0x27, 0x47, 0xCD, 0x8E, 0x0C, 0x9D, 0xE7, 
+0x59, 0xB7, 0xC6, 0x27, 0x1E, 0x9C, 0x65, 0x70, 
+0x01, 0x70, 0x67, 0x51, 0x20, 0x64, 0x2C, 0x32, 
+0xF1, 0x5E, 0x04, 0xAA, 0x72, 0x7B, 0x22, 0xA5, 
+0x02, 0xD6, 0xDF, 0x5F, 0x3C, 0xB7, 0x69, 0x7B, 
+0x8A, 0xB2, 0x9D, 0x75, 0x9D, 0xB0, 0xE4, 0x4A, 
+0x58, 0x8E, 0x8A, 0xA3, 0x87, 0x74, 0x7F, 0x35, 
+0x77, 0xB8, 0x8F, 0x92, 0x89, 0x16, 0x2F, 0xB7, 
+0xAB, 0x2D, 0xDB, 0x8C, 0x95, 0x33, 0x11, 0x93, 
+0xD2, 0x59, 0x3C, 0xD5, 0xFF, 0x6C, 0xFA, 0xFF, 
+0xC7, 0x77, 0xAE, 0x6E, 0xC7, 0xF5, 0xFE, 0xEE, 
+0xC1, 0x60, 0xB0, 0x48, 0xF3, 0xE7, 0xE1, 0x84, 
+0xDD, 0x91, 0x50, 0x51, 0x3C, 0x79, 0x5B, 0x50, 
+0xB3, 0xEC, 0x71, 0xD3, 0x96, 0x1D, 0x3A, 0xE8, 
+0x55, 0x1F, 0x2C, 0xDE, 0x68, 0x0B, 0x90, 0xA7, 
+0x4B, 0x6C, 0xBD, 0x2C, 0x13, 0xDA, 0xA1, 0x76, 
+0x61, 0x57, 0x16, 0x94, 0xBD, 0x6B, 0x49, 0x7B, 
+0xB3, 0x29, 0xF7, 0x40, 0x38, 0xD2, 0xB1, 0xE4, 
+0x07, 0xC1, 0x37, 0x2A, 0x15, 0x5C, 0x74, 0xB7, 
+0xA2, 0x4D, 0x01, 0x7F, 0x8A, 0x6D, 0xAC, 0x29, 
+0xF9, 0xCC, 0x94, 0x65, 0x87, 0x07, 0xE3, 0x65, 
+0xE3, 0x69, 0x6C, 0x80, 0x30, 0x3F, 0x5B, 0x7D, 
+0x45, 0xE7, 0xDC, 0xF5, 0x07, 0x77, 0xFD, 0xF6, 
+0x84, 0xC3, 0x29, 0xB9, 0x9C, 0xD7, 0x43, 0xEB, 
+0x3F, 0x2F, 0xBA, 0x9D, 0x15, 0xC7, 0xE5, 0x99, 
+0x1E, 
+
+0x5D, 0x67, 0x07, 0x3A, 0x44, 0x44, 0x42, 0xD2, 
+0x42, 0x98, 0x85, 0xA7, 0x19, 0x00, 0x0E, 0xCA, 
+0xB0, 0x1D, 0x4A, 0x11, 0x6B, 0xC9, 0x27, 0x88, 
+0xB9, 0x99, 0xDB, 0x37, 0x38, 0x68, 0x29, 0xFC, 
+0xF6, 0xB7, 0x29, 0xE3, 0x94, 0x96, 0xB8, 0x5A, 
+0xE3, 0x8D, 0xB9, 0x84, 0x8D, 0x11, 0x85, 0x15, 
+0x7F, 0x34, 0x8B, 0xC2, 0x3A, 0xEE, 0x5A, 0xF9, 
+0x12, 0x41, 0x49, 0x1B, 0x95, 0x4A, 0x55, 0x62, 
+0x28, 0xAF, 0xEE, 0x57, 0x5A, 0x4F, 0xCA, 0x7A, 
+0xC1, 0x41, 0x88, 0x38, 0xFB, 0x71, 0xD4, 0xB4, 
+0x39, 0xF9, 0xD2, 0x4B, 0x69, 0xAE, 0xCF, 0xC7, 
+0x29, 0x01, 0xB2, 0xA3, 0x28, 0xFD, 0x40, 0x50, 
+0x78, 0xED, 0xB4, 0x47, 0xFD, 0xEA, 0x5A, 0x98, 
+0x12, 0xBD, 0xB7, 0xF8, 0x71, 0x5C, 0x51, 0x71, 
+0x03, 0x02, 0x3E, 0x26, 0x28, 0x33, 0x9D, 0x0A, 
+0x1F, 0xA6, 0xE6, 0x12, 0x10, 0xAA, 0x5C, 0xD5, 
+0x27, 0x4C, 0x2C, 0xF5, 0xE9, 0xFE, 0x46, 0x20, 
+0x92, 0xC2, 0xD2, 0x1C, 0xD7, 0xCE, 0x7C, 0x09, 
+0x1E, 0xDC, 0x59, 0x03, 0xA5, 0xCD, 0x88, 0xC8, 
+0x37, 0xE8, 0xA1, 0xF5, 0xC6, 0x4D, 0x1B, 0x8A, 
+0xF3, 0x60, 0x2D, 0xA8, 0xB9, 0x14, 0x35, 0x26, 
+0x8E, 0xB6, 0x17, 0x23, 0x46, 0xC2, 0xEE, 0xFD, 
+0x39, 0x07, 0x6D, 0x87, 0x6F, 0xB4, 0xF2, 0xA9, 
+0x02, 0x3A, 0x94, 0x5F, 0x37, 0xC2, 0x35, 0xB0, 
+0x5B, 0x52, 0xCC, 0x18, 0x1A, 0x50, 0x2B, 0x99, 
+0xA4, 0x98, 0xCA, 0x7A, 0x7A, 0xFC, 0xCE, 0x80, 
+0x19, 0xF8, 0xF6, 0x58, 0x14, 0x3C, 0x26, 0x32, 
+0x71, 0xA4, 0x3A, 0x44, 0x7C, 0x67, 0xA4, 0x81, 
+0x13, 0x6F, 0xAC, 0x17, 0xE9, 0x8E, 0x2F, 0x34, 
+0x2B, 0xE8, 0xDB, 0xA7, 0x2C, 0x9B, 0x34, 0x4B, 
+0x9E, 0xC3, 0x8C, 0xCB, 0x61, 0x9B, 0x4D, 0x9A, 
+0x61, 0x9B, 0x05, 0x52, 0x19, 0xAF, 0x83, 0x65, 
+0xEE, 0x06, 0x50, 0xBC, 0xF8, 0xA4, 0xDF, 0x8C, 
+0x0F, 0xA6, 
+
+0xA4, 0xC9, 0x8F, 0x37, 0xDC, 0x11, 0xE0, 0xF4, 
+0xFB, 0x42, 0x0F, 0xBE, 0x4D, 0x51, 0x3A, 0xC9, 
+0x4E, 0x1D, 0x16, 0x59, 0x0F, 0x2F, 0xC4, 0x0A, 
+0x2D, 0xEE, 0xA0, 0xED, 0x6A, 0x32, 0x0A, 0x8B, 
+0x65, 0xBB, 0x00, 0x89, 0x9F, 0x82, 0x4F, 0x9B, 
+0x40, 0x75, 0xE7, 0x2A, 0x0A, 0x76, 0x5C, 0x35, 
+0xBB, 0xCA, 0x00, 0xCD, 0x03, 0xE1, 0xCF, 0x9E, 
+0x3D, 0x6D, 0xF3, 0xE6, 0xD0, 0x09, 0x21, 0xAD, 
+0x09, 0x4F, 0xB7, 0xB9, 0xD8, 0x26, 0x93, 0x2B, 
+0xA4, 0x1C, 0xF5, 0x37, 0x94, 0x51, 0x96, 0x4C, 
+0xBF, 0x76, 0x25, 0x2C, 0xBC, 0x53, 0xF2, 0x93, 
+0x4D, 0xB0, 0xC7, 0xFE, 0xE7, 0x22, 0x9B, 0x92, 
+0xC3, 0x93, 0x33, 0x82, 0x9C, 0x06, 0x95, 0xBE, 
+0xDB, 0x67, 0x69, 0xA0, 0xD2, 0xF9, 0x53, 0x29, 
+0xAE, 0x7A, 0x18, 0xDF, 0x64, 0x1C, 0x04, 0x22, 
+0xF9, 0x55, 0x82, 0x5A, 0xBC, 0x3D, 0xAA, 0xB7, 
+0xEC, 0xB9, 0x55, 0x57, 0xFD, 0x1C, 0x38, 0x94, 
+0xAD, 0x47, 0x81, 0x4E, 0xF7, 0xE2, 0xEF, 0xD1, 
+0x74, 0xB9, 0x5D, 0xCB, 0xA6, 0x55, 0x18, 0xA5, 
+0xB0, 0xB8, 0x90, 0xD8, 0xCA, 0x15, 0x69, 0x94, 
+0x76, 0x4C, 0xB6, 0x79, 0x3B, 0x4B, 0x3F, 0xE7, 
+0x29, 0x09, 0x4B, 0xCC, 0x97, 0x85, 0x52, 0x29, 
+0x6F, 0x33, 0x28, 0xFD, 0x3D, 0x46, 0x09, 0xBC, 
+0x98, 0xB5, 0x4D, 0xE5, 0x75, 0x9D, 0xB7, 0x67, 
+0x3B, 0x45, 0x42, 0x3F, 0x5B, 0xB0, 0x6B, 0x72, 
+0xD4, 0x11, 0x9A, 0x1B, 0xF2, 0xE4, 0x8A, 0x41, 
+0xDD, 0x3A, 0x1C, 0x2F, 0xF2, 0xD2, 0xD1, 0x1A, 
+0x2C, 0x4C, 0xAC, 0xD9, 0x48, 0x72, 0x9B, 0x0F, 
+0xBF, 0x83, 0xFF, 0xA8, 0x0C, 0xE6, 0x42, 0x7E, 
+0x37, 0x29, 0x93, 0xE9, 0xF6, 0x56, 0xDB, 0x92, 
+0x25, 0xB1, 0xD0, 0xBF, 0x0B, 0xBA, 0xCC, 0x37, 
+0x8E, 0x3F, 0x27, 0x40, 0x8F, 0x58, 0x7D, 0x6A, 
+0x46, 0x58, 0x38, 0xE6, 0x01, 0xD5, 0xB5, 0xF5, 
+0x2F, 0x54, 0x36, 
+
+0x5C, 0xBA, 0x63, 0x2A, 0xF0, 0x06, 0x50, 0x67, 
+0xA9, 0x5A, 0xC0, 0x06, 0x68, 0x5A, 0x98, 0xEE, 
+0x94, 0x33, 0x03, 0x79, 0x37, 0xBC, 0x97, 0xDA, 
+0x14, 0x11, 0x0D, 0x31, 0x26, 0x2A, 0x28, 0xAD, 
+0xFC, 0x63, 0xBC, 0xC0, 0x0A, 0xE5, 0x6F, 0x0B, 
+0x66, 0xF0, 0x13, 0xFF, 0xC8, 0xCF, 0x30, 0xA8, 
+0x8E, 0x8C, 0x15, 0x7B, 0x00, 0x71, 0xB4, 0x27, 
+0x59, 0x5E, 0x63, 0x13, 0x33, 0xB0, 0xE8, 0x1E, 
+0x74, 0x78, 0x60, 0xEF, 0x1B, 0x2C, 0xC5, 0x70, 
+0xF1, 0x51, 0x10, 0x61, 0x1B, 0xCB, 0xD6, 0x94, 
+0xAE, 0x46, 0xFF, 0x93, 0x1B, 0xB4, 0xB5, 0x51, 
+0x52, 0xCD, 0x11, 0xEE, 0x57, 0x42, 0xB8, 0xBD, 
+0xCB, 0x7E, 0x64, 0xAC, 0xA9, 0x25, 0xF1, 0x21, 
+0x6A, 0x8D, 0x64, 0xA1, 0x17, 0xE3, 0x4D, 0xDC, 
+0xA8, 0xE5, 0x79, 0x60, 0x2F, 0x01, 0xD2, 0xA1, 
+0x21, 0x1A, 0x15, 0xAE, 0x12, 0xC7, 0x85, 0xD5, 
+0x43, 0x38, 0xFE, 0x97, 0xE8, 0x32, 0xE3, 0x91, 
+0x32, 0xC6, 0x94, 0x1B, 0x3F, 0x5E, 0x98, 0x3E, 
+0x23, 0xB3, 0x34, 0x29, 0x10, 0x97, 0x87, 0x3D, 
+0x24, 0x0D, 0x35, 0x04, 0x32, 0x51, 0xB3, 0x76, 
+0xE7, 0xCA, 0x80, 0x9F, 0xA3, 0x6D, 0x96, 0xCA, 
+0x7C, 0xF9, 0x7C, 0xAF, 0x88, 0xD7, 0xBB, 0x72, 
+0xF6, 0xDB, 0xCC, 0xF1, 0x98, 0xD0, 0x89, 0x80, 
+0xE3, 0xF3, 0xD4, 0xAA, 0x12, 0x40, 0x75, 0x30, 
+0x96, 0xAE, 0x2F, 0xB8, 0x20, 0xDF, 0xE1, 0x52, 
+0xEB, 0x65, 0x7C, 0x04, 0x92, 0x0C, 0xEA, 0xD0, 
+0x4E, 0xAC, 0x2D, 0xF2, 0x9F, 0xBB, 0xF6, 0x3C, 
+0x2E, 0x1F, 0xEE, 0x1E, 0x7A, 0x9F, 0xE8, 0x27, 
+0x0D, 0xD0, 0x64, 0xEE, 0x42, 0xE2, 0x04, 0x38, 
+0x84, 0x35, 0x09, 0xC2, 0xF8, 0x73, 0x86, 0x08, 
+0x76, 0x7D, 0x53, 0x15, 0x12, 0xD2, 0x82, 0xC8, 
+0x27, 0x3F, 0x5A, 0x9E, 0x22, 0x4E, 0xC2, 0xF3, 
+0xFD, 0x69, 0x95, 0x43, 0xE6, 0x75, 0x64, 0xE8, 
+0x47, 0x06, 0x28, 0xFC, 
+
+0x91, 0xFC, 0x24, 0x27, 0xE4, 0x84, 0x35, 0xBB, 
+0xDC, 0x2E, 0x22, 0x81, 0x0C, 0xA9, 0xF2, 0x9D, 
+0x35, 0x7C, 0xFC, 0x47, 0x22, 0xE6, 0x4C, 0xB3, 
+0x83, 0x69, 0x2F, 0xB5, 0x2A, 0x28, 0xAC, 0xD2, 
+0x4D, 0x66, 0x77, 0x7F, 0xBC, 0x5D, 0xD0, 0x29, 
+0xE6, 0xC9, 0xAD, 0xB8, 0x50, 0x6E, 0x75, 0xC0, 
+0x8A, 0x99, 0xAC, 0xEA, 0xC9, 0x1D, 0xC2, 0xA9, 
+0xFD, 0x6D, 0xC1, 0xBA, 0x23, 0x3B, 0xED, 0x96, 
+0x90, 0x77, 0xBF, 0x4E, 0xE5, 0xEB, 0x4D, 0x25, 
+0x04, 0xBA, 0xCC, 0x6A, 0x9B, 0xFE, 0x7B, 0x08, 
+0xA0, 0x80, 0x05, 0x11, 0x4B, 0xF2, 0xEE, 0x23, 
+0x74, 0xCE, 0xBF, 0x36, 0x28, 0x10, 0xF0, 0x8D, 
+0xF1, 0x50, 0x7E, 0xFD, 0x72, 0x6F, 0x35, 0x23, 
+0xB0, 0xDE, 0xBF, 0x4E, 0x53, 0xF9, 0x61, 0x3D, 
+0xBD, 0x5C, 0x18, 0xBA, 0x5D, 0xD3, 0x95, 0x77, 
+0x61, 0xDB, 0xBC, 0xD0, 0xDE, 0x15, 0xCA, 0xD3, 
+0xC2, 0x8E, 0xD2, 0xC4, 0xBA, 0xD6, 0x8D, 0x66, 
+0x69, 0x8B, 0x99, 0x76, 0x17, 0x39, 0x1F, 0x30, 
+0x0B, 0x78, 0x3E, 0x9D, 0xEC, 0x9D, 0x29, 0x9F, 
+0x96, 0x59, 0x99, 0x0D, 0x21, 0x17, 0xF6, 0x37, 
+0xA8, 0x8C, 0x86, 0x04, 0xC3, 0x7C, 0x72, 0x64, 
+0x24, 0xF2, 0xD7, 0x54, 0xFA, 0xD4, 0x68, 0xF8, 
+0x17, 0x26, 0x4C, 0x24, 0x8C, 0xEE, 0xF0, 0x2B, 
+0x62, 0x68, 0x58, 0x83, 0xB8, 0x3B, 0x43, 0xFC, 
+0xFC, 0x73, 0x68, 0xB1, 0xA2, 0xD9, 0x24, 0x37, 
+0x95, 0x37, 0x5C, 0xDE, 0xC2, 0x5C, 0xE6, 0x0A, 
+0xCA, 0xF4, 0x67, 0xDF, 0x7B, 0x0E, 0xD6, 0xDD, 
+0x71, 0x77, 0x10, 0x58, 0x0C, 0xDC, 0x8F, 0x59, 
+0x59, 0x9F, 0xE2, 0x3C, 0x11, 0xDF, 0xBD, 0x80, 
+0xC0, 0x4D, 0xB8, 0x96, 0xF7, 0xA4, 0x85, 0x4E, 
+0x43, 0x88, 0xF7, 0xD9, 0xE4, 0xDC, 0x5B, 0x00, 
+0x84, 0x1D, 0xBD, 0x14, 0xE7, 0x60, 0x0C, 0x73, 
+0xCB, 0xB1, 0x8C, 0xE0, 0x69, 0x5C, 0xE1, 0x86, 
+0xF6, 0x5C, 0x36, 0x7F, 0x89, 
+
+0x64, 0xDC, 0x28, 0x34, 0xDE, 0x7E, 0x60, 0x5B, 
+0xC0, 0x6D, 0xB8, 0x59, 0x72, 0xC2, 0xBF, 0x70, 
+0x95, 0xC3, 0x24, 0x58, 0x00, 0xCA, 0x77, 0xD4, 
+0xEA, 0x97, 0x38, 0x04, 0x0F, 0x4F, 0xF8, 0x32, 
+0xF9, 0xED, 0x09, 0x59, 0xA8, 0xDA, 0xF7, 0xC6, 
+0xF0, 0xA1, 0xFF, 0x91, 0x50, 0xA7, 0xE7, 0xAA, 
+0x13, 0x3A, 0xED, 0xF6, 0x56, 0x56, 0x07, 0x67, 
+0x20, 0xD4, 0x27, 0xF9, 0x45, 0xAB, 0xD6, 0x08, 
+0x40, 0xA5, 0x09, 0x98, 0x84, 0x4A, 0xEC, 0x2C, 
+0xBE, 0xB4, 0x05, 0x89, 0x00, 0xBB, 0x2D, 0x9E, 
+0xBA, 0xFB, 0x10, 0x00, 0xD8, 0x28, 0x5D, 0xD1, 
+0xE6, 0x91, 0x24, 0x3E, 0x58, 0xAA, 0x1A, 0x5B, 
+0x57, 0x83, 0x17, 0xF3, 0x9A, 0x76, 0x0D, 0xFF, 
+0x48, 0x5E, 0x8D, 0xBD, 0x34, 0xDD, 0xD8, 0xAF, 
+0x3D, 0xD9, 0x7D, 0x3A, 0xD1, 0x21, 0xD4, 0x3F, 
+0xFA, 0x89, 0x9D, 0x64, 0xAD, 0x04, 0x98, 0x4B, 
+0xBB, 0x06, 0x0D, 0x64, 0x7A, 0x58, 0x6E, 0xB4, 
+0xC1, 0x1F, 0xB9, 0x56, 0x4C, 0x65, 0x54, 0x22, 
+0xBB, 0x39, 0xB2, 0xEC, 0x44, 0xC8, 0xA1, 0x1B, 
+0xA8, 0x2B, 0x87, 0x9E, 0x9D, 0x70, 0xE3, 0xF7, 
+0x09, 0xA6, 0xFA, 0x1C, 0xA4, 0x6D, 0xCA, 0x44, 
+0x48, 0x34, 0xD3, 0x98, 0xC2, 0x4A, 0x54, 0x74, 
+0x3B, 0xC8, 0x27, 0x66, 0x50, 0xD1, 0x58, 0x90, 
+0x5C, 0x29, 0x3E, 0xE7, 0x5A, 0xB9, 0xB8, 0xF3, 
+0x3F, 0xA1, 0x90, 0x13, 0x75, 0x77, 0xB3, 0x65, 
+0xA9, 0xFE, 0xB1, 0x6B, 0xE0, 0x6E, 0xF5, 0xC9, 
+0xDA, 0xA9, 0xC8, 0x2D, 0x8C, 0xBB, 0x06, 0x0B, 
+0x4C, 0xBA, 0x46, 0x5B, 0x3D, 0xF5, 0xD9, 0x2C, 
+0x16, 0x35, 0xE6, 0x35, 0x4F, 0x4E, 0x35, 0xAB, 
+0xDE, 0x15, 0x22, 0xC8, 0x0E, 0x36, 0xC0, 0x22, 
+0x1E, 0xB5, 0xEC, 0xFA, 0xFF, 0x63, 0x43, 0x1F, 
+0x22, 0x63, 0xA1, 0x8E, 0xC1, 0x78, 0x28, 0x63, 
+0xF3, 0xF5, 0x33, 0x13, 0x1D, 0x20, 0x32, 0xE6, 
+0x82, 0x72, 0xC2, 0x08, 0xF7, 0xC2, 
+
+0x09, 0xB7, 0x14, 0x51, 0x09, 0x15, 0xFF, 0xEB, 
+0x6D, 0x5E, 0xD4, 0xD1, 0xAB, 0x37, 0x7B, 0x4D, 
+0x33, 0x97, 0x41, 0xCC, 0x4C, 0x8F, 0x53, 0x56, 
+0x1A, 0xBD, 0x09, 0x21, 0x8F, 0x8D, 0x4E, 0x81, 
+0xDB, 0xEE, 0xF4, 0xF6, 0x19, 0xE6, 0x45, 0x9E, 
+0x92, 0xC4, 0x79, 0x98, 0xF6, 0x77, 0xB3, 0x4E, 
+0xB4, 0x4C, 0x9B, 0x65, 0xCF, 0x3A, 0x13, 0x2D, 
+0x93, 0x2B, 0x05, 0x42, 0xE8, 0x30, 0x09, 0x14, 
+0x37, 0x5C, 0x15, 0xDD, 0xB8, 0xC7, 0xEC, 0xF0, 
+0xC4, 0xD3, 0xBC, 0x3E, 0xA7, 0x3E, 0x90, 0xE0, 
+0x0D, 0xB9, 0x43, 0xC6, 0xBD, 0x2C, 0xC1, 0xBA, 
+0x8E, 0x5E, 0xBB, 0xB0, 0x1C, 0x9B, 0xA8, 0x54, 
+0x6B, 0x78, 0xA9, 0xA1, 0x84, 0x7D, 0xFE, 0xCA, 
+0xC4, 0x79, 0xE6, 0x04, 0x43, 0x7D, 0x97, 0x3D, 
+0x0D, 0x37, 0x1E, 0xDC, 0xE9, 0x69, 0x52, 0xC2, 
+0xDD, 0x1D, 0x1F, 0x3D, 0xF9, 0xCD, 0xA2, 0x77, 
+0x45, 0x1A, 0x31, 0x4B, 0x68, 0x52, 0x2A, 0xFE, 
+0x53, 0xEA, 0x4D, 0xAF, 0xE8, 0xA8, 0xBE, 0xB9, 
+0x58, 0x56, 0x1B, 0x1D, 0xAF, 0x31, 0xA0, 0x7A, 
+0x5A, 0x18, 0xC4, 0xF1, 0xA9, 0xF3, 0x65, 0xDA, 
+0x0B, 0xC9, 0xC7, 0x42, 0x5E, 0xAC, 0xF9, 0xFC, 
+0x67, 0xBF, 0xD3, 0x79, 0x67, 0x2B, 0x80, 0x7A, 
+0x60, 0xEF, 0xEB, 0xF8, 0xE5, 0x9D, 0x8D, 0x22, 
+0xE7, 0x36, 0x41, 0x17, 0xA0, 0xFA, 0xC5, 0x5E, 
+0xB7, 0x05, 0x35, 0xAF, 0x6C, 0xE1, 0xCA, 0x33, 
+0x53, 0x3E, 0x12, 0xCA, 0xC8, 0x6D, 0x67, 0x78, 
+0x16, 0x47, 0x4E, 0x86, 0x93, 0x19, 0x36, 0x33, 
+0xDE, 0x2F, 0x25, 0xF2, 0xEC, 0x46, 0xDA, 0x45, 
+0x94, 0x02, 0xFB, 0xF2, 0x94, 0x5E, 0xD2, 0x73, 
+0x9A, 0x61, 0xF4, 0xB3, 0xC5, 0xFA, 0x8E, 0x33, 
+0x57, 0x8A, 0x28, 0xB9, 0x09, 0x41, 0x29, 0x61, 
+0x50, 0x7D, 0x77, 0xAC, 0x96, 0xFA, 0xAA, 0xCA, 
+0x79, 0x3D, 0xB5, 0xC3, 0xF4, 0x1F, 0x0E, 0xAC, 
+0x5D, 0x71, 0x0F, 0xE6, 0xFC, 0x29, 0xBE, 
+
+0xBA, 0x71, 0x2D, 0xA3, 0xEF, 0xBE, 0xDB, 0x3A, 
+0xEB, 0x26, 0x63, 0x4A, 0x89, 0x91, 0xE0, 0x3F, 
+0x8B, 0x61, 0x44, 0x02, 0xBC, 0xFA, 0xDB, 0xA5, 
+0x9A, 0x5C, 0x1D, 0x59, 0xF4, 0x39, 0xE9, 0x55, 
+0x11, 0x88, 0x36, 0x34, 0xBD, 0x9D, 0xA2, 0xA1, 
+0x9A, 0xD7, 0x47, 0x98, 0x11, 0x6E, 0x5F, 0x75, 
+0xF2, 0xD1, 0xEF, 0xEC, 0x96, 0x69, 0x61, 0x15, 
+0x61, 0x61, 0xBA, 0x1F, 0x1E, 0xF2, 0xC4, 0x04, 
+0x4B, 0x60, 0x93, 0x75, 0x57, 0x44, 0x86, 0x34, 
+0x7C, 0x2F, 0x44, 0x06, 0x6E, 0x13, 0x6F, 0xF7, 
+0x8D, 0x61, 0xD3, 0xA6, 0x6F, 0xCF, 0x4A, 0xB7, 
+0xD2, 0xBD, 0xD9, 0x13, 0x8A, 0xC1, 0x09, 0x14, 
+0x31, 0x32, 0xF3, 0x88, 0xF7, 0xDD, 0xBD, 0xA0, 
+0x6B, 0x4E, 0x0D, 0x2E, 0x3A, 0xCF, 0x7E, 0x76, 
+0x74, 0xBC, 0x5F, 0xB9, 0xCB, 0xEC, 0x73, 0x45, 
+0xEA, 0xAA, 0x04, 0x18, 0xD6, 0x05, 0x97, 0xC2, 
+0xA4, 0xAA, 0x42, 0x51, 0xD2, 0x86, 0x29, 0x5F, 
+0x83, 0x1F, 0xF2, 0x74, 0x77, 0x0F, 0x7C, 0x33, 
+0x63, 0xC8, 0xB6, 0xF2, 0xC7, 0x1A, 0xCC, 0x2F, 
+0xF4, 0x8B, 0xC0, 0x7B, 0x0A, 0x73, 0xD8, 0x68, 
+0x33, 0xA0, 0xAD, 0x20, 0x95, 0x38, 0xA4, 0xA9, 
+0x5A, 0xAA, 0x13, 0xB9, 0x26, 0x99, 0xEF, 0xF2, 
+0x16, 0x2E, 0xCB, 0xE9, 0x07, 0xD1, 0x86, 0x56, 
+0xD4, 0xCB, 0x6E, 0x80, 0x84, 0x75, 0xBB, 0x6C, 
+0x78, 0xDC, 0x92, 0x65, 0xB1, 0x0A, 0x2E, 0xB0, 
+0x32, 0xBE, 0x1D, 0x5F, 0xE6, 0x91, 0x28, 0xC0, 
+0x7B, 0xCA, 0x5B, 0x58, 0x28, 0xC5, 0x1E, 0x37, 
+0xE9, 0x00, 0x76, 0x1A, 0xCA, 0x34, 0x81, 0xCE, 
+0x7C, 0x8D, 0x7A, 0xE2, 0x63, 0x72, 0x6A, 0x4C, 
+0xAF, 0x8A, 0x06, 0xF8, 0x60, 0x2D, 0x70, 0xBC, 
+0x22, 0x87, 0x88, 0xFF, 0x01, 0x15, 0x2F, 0x23, 
+0x05, 0x68, 0x13, 0x7E, 0x12, 0xEC, 0x9E, 0xCF, 
+0x57, 0x62, 0x52, 0xAD, 0x8C, 0xF7, 0xE8, 0x0E, 
+0x41, 0x4E, 0x5F, 0xEE, 0x33, 0x8C, 0xCC, 0xCC, 
+
+0x42, 0xCC, 0x43, 0x66, 0x46, 0xB2, 0xE1, 0x0F, 
+0x93, 0x40, 0x68, 0x27, 0xF6, 0x7E, 0xFE, 0x77, 
+0x31, 0xDF, 0x8D, 0x50, 0x5A, 0xB9, 0xE9, 0x31, 
+0x7D, 0xE6, 0x51, 0x3A, 0xC7, 0x07, 0x99, 0xCF, 
+0xF9, 0x20, 0x9D, 0xBD, 0x71, 0x53, 0x06, 0xFA, 
+0x55, 0x4C, 0xE4, 0x51, 0xE5, 0x15, 0x0C, 0x64, 
+0x4C, 0x01, 0x4E, 0x46, 0x34, 0x41, 0xF6, 0x49, 
+0xE2, 0x93, 0xB5, 0xED, 0xBB, 0xCB, 0xCD, 0xD1, 
+0xC3, 0x32, 0x44, 0xE7, 0xBF, 0x78, 0x4E, 0xD8, 
+0x8A, 0xA9, 0xA2, 0x97, 0x58, 0x87, 0x3B, 0x9C, 
+0x6E, 0x8E, 0xFA, 0x8D, 0xB4, 0xEB, 0x5F, 0x5C, 
+0x05, 0x8F, 0x16, 0x90, 0x7F, 0xF1, 0x2B, 0x45, 
+0x44, 0xDA, 0xFA, 0x83, 0x9A, 0x48, 0x6A, 0xA2, 
+0x98, 0xE7, 0xA6, 0x0D, 0xD0, 0x78, 0x6C, 0xBC, 
+0xC0, 0xDF, 0xE7, 0xAF, 0xB1, 0x41, 0x0B, 0x8E, 
+0xF8, 0x35, 0xE1, 0xD4, 0x12, 0xD4, 0xAC, 0x63, 
+0xBB, 0xDA, 0x45, 0xF1, 0x43, 0x94, 0x29, 0x36, 
+0x19, 0x6E, 0xBB, 0xFF, 0x66, 0xAD, 0xB6, 0x0E, 
+0x02, 0x0F, 0xD3, 0xC2, 0x75, 0x62, 0xB3, 0xF4, 
+0xB2, 0x9F, 0xCC, 0xCF, 0xC9, 0x49, 0xA1, 0x83, 
+0x3B, 0x75, 0x7A, 0x3F, 0x62, 0x37, 0x9A, 0x84, 
+0xAE, 0x49, 0x20, 0x40, 0xAA, 0xAB, 0x2D, 0x5A, 
+0x3F, 0x07, 0x7A, 0x11, 0x55, 0x65, 0xE5, 0xD4, 
+0x0F, 0xFB, 0x91, 0x3E, 0xC4, 0x2E, 0x4E, 0x0B, 
+0xA1, 0xAC, 0x88, 0x70, 0x81, 0x44, 0xD4, 0x29, 
+0x68, 0x45, 0xE1, 0xF5, 0x56, 0xC0, 0x9A, 0xE3, 
+0x6B, 0x57, 0x28, 0x5F, 0x41, 0xB5, 0x44, 0xFE, 
+0xC2, 0x4C, 0x7D, 0x69, 0xE3, 0x9B, 0x6C, 0xD5, 
+0xC1, 0xE1, 0xCA, 0x82, 0xD9, 0x71, 0xEF, 0xF1, 
+0xD2, 0xFD, 0x41, 0x41, 0xA8, 0x7F, 0xF1, 0xEC, 
+0x68, 0xC9, 0xF3, 0xF3, 0xCA, 0xF0, 0xA8, 0x64, 
+0xCC, 0x09, 0xFA, 0x89, 0xF6, 0x45, 0x8D, 0x3D, 
+0x9E, 0xA2, 0x2A, 0x51, 0xC7, 0xD1, 0xAB, 0x52, 
+0xA6, 0x39, 0x15, 0xA0, 0x69, 0x34, 0x87, 0xD5, 
+0x1C, 
+
+0xB0, 0x2F, 0xAD, 0xFB, 0x5C, 0x05, 0x75, 0x90, 
+0x24, 0x4F, 0x2E, 0xD3, 0xD1, 0x62, 0x2D, 0x92, 
+0xC1, 0x31, 0xFE, 0x05, 0xCD, 0xE7, 0x1C, 0x6F, 
+0x2C, 0xEE, 0xF2, 0x29, 0xA2, 0x7D, 0xC3, 0x86, 
+0x04, 0xF8, 0xD6, 0xA5, 0x6F, 0xB9, 0x11, 0xA5, 
+0xAF, 0x5E, 0x14, 0x97, 0x91, 0xE9, 0xC4, 0xF5, 
+0xF2, 0x63, 0xBB, 0xA0, 0xF5, 0x3D, 0xC7, 0xF1, 
+0x39, 0xF7, 0x6A, 0xA2, 0x78, 0xAF, 0x63, 0x68, 
+0x43, 0xCA, 0x79, 0xCB, 0x90, 0x94, 0x22, 0xC5, 
+0xCA, 0x77, 0xE3, 0x44, 0x38, 0xC4, 0x7C, 0x5C, 
+0xDC, 0x48, 0xE1, 0xE3, 0xF4, 0x68, 0xB8, 0xA1, 
+0x90, 0xCB, 0xB2, 0x50, 0x35, 0xE2, 0x0E, 0x82, 
+0xC2, 0x9D, 0xE3, 0x83, 0x7A, 0x0F, 0x33, 0xF4, 
+0x88, 0xB0, 0x2B, 0x99, 0x9C, 0x7F, 0xD5, 0x75, 
+0x5A, 0x12, 0x3B, 0xCE, 0xA7, 0x78, 0x14, 0x96, 
+0x51, 0x9B, 0x58, 0xCA, 0xFA, 0xD7, 0x41, 0xE3, 
+0x1D, 0x78, 0x1C, 0xAE, 0xB6, 0x0B, 0x9F, 0x9F, 
+0x66, 0x07, 0x67, 0x51, 0x00, 0xF8, 0x25, 0x44, 
+0xCB, 0x25, 0x52, 0x11, 0x16, 0xDF, 0x15, 0xF8, 
+0xB5, 0x26, 0xF8, 0xFC, 0x69, 0xC1, 0x8C, 0x45, 
+0x6B, 0xE7, 0x29, 0x61, 0x71, 0xC6, 0x9D, 0x6C, 
+0x63, 0x22, 0x3D, 0xDC, 0x5B, 0x31, 0xAB, 0x8D, 
+0x96, 0xB8, 0x1C, 0xEB, 0xAC, 0x51, 0xB9, 0x15, 
+0xF3, 0x82, 0xE0, 0x90, 0x7A, 0xE0, 0xE1, 0xDA, 
+0x48, 0xCB, 0x13, 0x12, 0xAA, 0x6E, 0x02, 0xAE, 
+0x97, 0x67, 0xAE, 0xE8, 0x5D, 0x98, 0x19, 0x21, 
+0x44, 0xF7, 0x8B, 0xB3, 0xEB, 0xA7, 0x72, 0x30, 
+0x46, 0x8D, 0xF6, 0x0F, 0x2A, 0xD8, 0xF1, 0x63, 
+0x19, 0x5C, 0x89, 0x8A, 0x8A, 0xEC, 0x50, 0x81, 
+0x8E, 0x93, 0x2C, 0xD9, 0x56, 0x93, 0xEC, 0xB2, 
+0x05, 0xCD, 0x9C, 0x76, 0x7A, 0x7B, 0xBB, 0x31, 
+0x9F, 0x84, 0xCF, 0xFF, 0x2C, 0x3B, 0x9D, 0xE4, 
+0x3D, 0x9B, 0x09, 0xDC, 0x61, 0xAB, 0x8E, 0xE2, 
+0x3F, 0x25, 0x3A, 0x29, 0x71, 0x36, 0xB2, 0x69, 
+0x1D, 0xBF, 
+
+0x93, 0x11, 0x66, 0xE8, 0xAF, 0x33, 0xB6, 0x2E, 
+0x20, 0xC8, 0x28, 0xA0, 0x5D, 0x76, 0xAD, 0x10, 
+0x7D, 0xEF, 0x55, 0xBD, 0x07, 0x7A, 0x82, 0xDB, 
+0x6E, 0xBD, 0xA4, 0xAE, 0x4E, 0x6D, 0x7B, 0x7A, 
+0x84, 0xBC, 0x55, 0xC2, 0x1A, 0x62, 0x31, 0xAE, 
+0x0D, 0x36, 0x4C, 0xFC, 0xD9, 0x3D, 0x0E, 0xBC, 
+0xBA, 0x57, 0x85, 0xB2, 0x6B, 0xBC, 0xAE, 0x37, 
+0x0F, 0x4E, 0x61, 0x8D, 0x09, 0x92, 0x04, 0xCC, 
+0x36, 0x95, 0xCA, 0x04, 0x0E, 0x77, 0xF0, 0x32, 
+0xE2, 0x04, 0xEF, 0x37, 0x43, 0x87, 0x5F, 0x06, 
+0xEB, 0xE8, 0x5A, 0x8A, 0x3C, 0xEF, 0xF3, 0xAB, 
+0xD9, 0x2A, 0x4E, 0x83, 0x24, 0xDB, 0x1E, 0x71, 
+0xBB, 0x36, 0xA9, 0xFB, 0x54, 0xC3, 0xE1, 0x80, 
+0x7F, 0x34, 0x4F, 0xDF, 0x5C, 0x3C, 0x76, 0x35, 
+0xEE, 0x14, 0x6F, 0x43, 0x38, 0x2E, 0x0B, 0x97, 
+0xD3, 0x8F, 0xF3, 0xD8, 0x91, 0xAE, 0x7A, 0x18, 
+0xE8, 0xFD, 0x20, 0x92, 0x77, 0x0D, 0x70, 0x65, 
+0x16, 0xB1, 0x36, 0xB0, 0xEB, 0x68, 0x62, 0x05, 
+0x5A, 0xCE, 0xDB, 0xD1, 0xE7, 0xB1, 0x80, 0x32, 
+0xB4, 0x8D, 0x12, 0x4E, 0xA4, 0x6A, 0x61, 0x95, 
+0x31, 0x8C, 0x9C, 0xEC, 0xA6, 0xEA, 0xAA, 0x85, 
+0x2B, 0xFE, 0x75, 0x51, 0x1F, 0x26, 0xC9, 0x0A, 
+0x32, 0xF4, 0x3D, 0xD2, 0x35, 0x4C, 0xCA, 0x7E, 
+0xA8, 0x4C, 0xAD, 0xFB, 0xB1, 0x8A, 0xF0, 0xA3, 
+0x7D, 0x6A, 0xB1, 0x2B, 0x87, 0xF5, 0x17, 0x4F, 
+0xA7, 0xFE, 0x3F, 0x8C, 0x70, 0xC7, 0x57, 0x2A, 
+0x52, 0x74, 0x3C, 0xC1, 0x30, 0x88, 0x63, 0x02, 
+0x51, 0x5D, 0x8B, 0x22, 0x41, 0x1C, 0x6E, 0x32, 
+0xC3, 0xB6, 0xD4, 0xF0, 0x4A, 0x6E, 0x58, 0xC1, 
+0x61, 0xD5, 0xBA, 0x67, 0x5F, 0xF0, 0x37, 0x75, 
+0x92, 0x37, 0x6A, 0x5F, 0xDD, 0xF4, 0xFC, 0x87, 
+0xDF, 0x6E, 0xBF, 0x7B, 0x02, 0xC2, 0x1B, 0x5E, 
+0xA3, 0x8B, 0x17, 0x10, 0x08, 0x13, 0x11, 0xA3, 
+0xA6, 0xB8, 0xC1, 0x36, 0x5D, 0x42, 0x07, 0xAE, 
+0x37, 0x86, 0xC7, 
+
+0x9A, 0xAC, 0x4F, 0x65, 0xAB, 0x70, 0x47, 0x13, 
+0xE1, 0xFD, 0x02, 0x21, 0xFD, 0x49, 0x01, 0x0D, 
+0x54, 0xF0, 0x48, 0x8F, 0x79, 0x1A, 0xAC, 0xA6, 
+0x67, 0xE8, 0x88, 0x92, 0x6D, 0x4D, 0x16, 0x8B, 
+0x3F, 0x77, 0x37, 0x7F, 0x33, 0x19, 0x90, 0xA8, 
+0xAA, 0xDA, 0x5F, 0x7D, 0x93, 0x85, 0xCD, 0x82, 
+0x04, 0xAD, 0xE3, 0xE8, 0x57, 0x80, 0x74, 0x01, 
+0xD7, 0xF0, 0x86, 0x0E, 0x66, 0x9F, 0x6B, 0x24, 
+0x3F, 0x23, 0x27, 0x32, 0xEA, 0x3C, 0xD5, 0x16, 
+0x93, 0xFE, 0xEB, 0x12, 0x63, 0x1F, 0x4A, 0x54, 
+0x0F, 0x5C, 0x96, 0xFC, 0xF3, 0x9D, 0xD2, 0x02, 
+0x10, 0xB6, 0x34, 0xD5, 0xD5, 0xD9, 0xF4, 0xAC, 
+0x47, 0xC7, 0xED, 0xCF, 0x61, 0x35, 0x2A, 0xA9, 
+0xC3, 0x3B, 0x36, 0x07, 0x68, 0xD1, 0x15, 0xFD, 
+0xCC, 0x09, 0xFD, 0xC2, 0x3A, 0x5E, 0xED, 0x13, 
+0xB8, 0xFD, 0x20, 0x2C, 0x60, 0x84, 0x46, 0xD2, 
+0xB0, 0xA7, 0x69, 0xFF, 0x46, 0xD0, 0x60, 0x6C, 
+0xAD, 0x21, 0x9F, 0xC0, 0x5D, 0x5D, 0x30, 0xBC, 
+0x72, 0xC4, 0xD5, 0x53, 0x18, 0x8D, 0x3B, 0x0C, 
+0x4F, 0xD9, 0x57, 0xC0, 0xB7, 0xB4, 0x78, 0x03, 
+0xC7, 0x47, 0x49, 0x1D, 0x9B, 0x9E, 0xBB, 0xC7, 
+0xAB, 0xC9, 0xD2, 0x10, 0x62, 0x1E, 0xEC, 0x03, 
+0x72, 0x79, 0x94, 0x30, 0x69, 0x61, 0x40, 0x63, 
+0x64, 0x3B, 0xF9, 0x2A, 0xEF, 0xF2, 0x4A, 0x53, 
+0x09, 0x58, 0x02, 0x0C, 0x5A, 0xC7, 0xC4, 0xDE, 
+0x16, 0xB8, 0x2E, 0xE1, 0x4F, 0x40, 0x65, 0xAA, 
+0x4D, 0x1B, 0xDA, 0xC5, 0x4D, 0x40, 0xBA, 0x89, 
+0x07, 0xA4, 0x86, 0xD4, 0xE8, 0xA7, 0x87, 0x09, 
+0xDE, 0x65, 0x16, 0xAD, 0xA5, 0xC2, 0xDC, 0x64, 
+0xDB, 0x75, 0x0B, 0x83, 0x95, 0x0A, 0xAF, 0xF2, 
+0x03, 0x85, 0xFB, 0xD6, 0x98, 0x8C, 0xA0, 0x69, 
+0x56, 0x3B, 0x29, 0x96, 0xE7, 0x50, 0x63, 0x3C, 
+0xBF, 0x8F, 0x4F, 0x82, 0x75, 0xF4, 0xB0, 0x17, 
+0x6C, 0xEE, 0x02, 0x91, 0x89, 0x5B, 0x1B, 0x3D, 
+0x71, 0x91, 0x59, 0x1E, 
+
+0x40, 0xAF, 0x79, 0x43, 0xC8, 0x92, 0x99, 0xFE, 
+0x1D, 0x31, 0x65, 0x85, 0xB6, 0x60, 0xE9, 0x8E, 
+0x93, 0x15, 0x67, 0x03, 0xB6, 0x57, 0xF4, 0xAB, 
+0x33, 0x6B, 0xE6, 0xD2, 0xC4, 0xF7, 0x4C, 0xA1, 
+0xFD, 0x3C, 0x35, 0x6D, 0x9E, 0x36, 0xC5, 0x8D, 
+0x91, 0xD8, 0x8A, 0x70, 0xDA, 0x91, 0x74, 0x83, 
+0x6E, 0xBC, 0x14, 0xDB, 0xB5, 0x2A, 0x59, 0x79, 
+0xA5, 0xBD, 0xB5, 0x66, 0xFB, 0x1E, 0xEF, 0x53, 
+0x95, 0x1C, 0xF2, 0xDB, 0x9A, 0x1D, 0x8F, 0x4F, 
+0x1A, 0xCF, 0x8D, 0x29, 0x16, 0x56, 0x09, 0x51, 
+0xE6, 0xCB, 0xA2, 0xED, 0xB2, 0x6F, 0x54, 0x4D, 
+0x9D, 0xC1, 0xF6, 0x4A, 0x35, 0xCC, 0x00, 0x58, 
+0xEF, 0x1F, 0x76, 0x10, 0xAE, 0x3A, 0x4A, 0x9E, 
+0xF5, 0xAD, 0x53, 0xC5, 0x4F, 0x0C, 0xD7, 0x53, 
+0xFA, 0x21, 0x59, 0x70, 0x86, 0xD4, 0x01, 0xBA, 
+0x62, 0xE7, 0xA7, 0x43, 0xB2, 0x6A, 0x4C, 0x91, 
+0x96, 0x76, 0xE9, 0x65, 0xF3, 0x45, 0x80, 0x01, 
+0xFC, 0x16, 0xA5, 0x1F, 0xE1, 0x31, 0x61, 0xD6, 
+0xB8, 0x1B, 0x98, 0x75, 0x87, 0xEF, 0x16, 0x2E, 
+0xD5, 0x8B, 0x00, 0x21, 0xD6, 0x34, 0x0E, 0x45, 
+0xE5, 0x4B, 0x8E, 0xE3, 0xC1, 0x63, 0xF8, 0x14, 
+0xA5, 0xAC, 0x97, 0x87, 0x48, 0xBF, 0xD3, 0x09, 
+0xD2, 0x60, 0xF2, 0xDF, 0xA2, 0xC0, 0x30, 0x7C, 
+0x45, 0x65, 0x2A, 0x38, 0x72, 0x58, 0x0F, 0x0B, 
+0x26, 0xEC, 0x9E, 0x4B, 0xF1, 0xA0, 0x4A, 0xBA, 
+0x3C, 0x68, 0xBB, 0xBF, 0x9E, 0x18, 0xB0, 0x16, 
+0x65, 0x98, 0x10, 0x2A, 0x7D, 0x72, 0xB8, 0x2F, 
+0x70, 0xC6, 0x17, 0xE0, 0x0B, 0xFF, 0x5E, 0xC7, 
+0x4C, 0x1E, 0xA5, 0x27, 0x9F, 0xAD, 0x88, 0x5D, 
+0x41, 0x19, 0x7D, 0x3C, 0x91, 0x58, 0x7F, 0x5E, 
+0x10, 0xEB, 0x52, 0x00, 0x78, 0xE0, 0x82, 0x68, 
+0x9D, 0xE5, 0x5D, 0xC6, 0xA3, 0xB9, 0x19, 0x24, 
+0x26, 0x20, 0x3F, 0xE3, 0x0C, 0x05, 0x97, 0x9E, 
+0x0B, 0xBA, 0xED, 0xC7, 0xF4, 0xFD, 0x74, 0xCD, 
+0xF2, 0x01, 0xAF, 0xB7, 0x7D, 
+
+0xA2, 0xB6, 0x80, 0x57, 0x39, 0xB5, 0x0C, 0x7C, 
+0x51, 0x9E, 0x41, 0x31, 0x04, 0xC5, 0xEC, 0x11, 
+0x27, 0xE7, 0x7F, 0x70, 0x02, 0x8C, 0x0F, 0x15, 
+0xD9, 0x0E, 0xEA, 0x96, 0x4B, 0x21, 0xD0, 0x05, 
+0x40, 0x57, 0x09, 0x75, 0xDB, 0x81, 0x7B, 0x59, 
+0xB1, 0xE0, 0xAA, 0x69, 0x60, 0x4E, 0xB5, 0x1F, 
+0xFB, 0xB4, 0x74, 0xA7, 0x42, 0xD5, 0x7A, 0x3A, 
+0xB6, 0x98, 0x84, 0x64, 0xA5, 0x3B, 0x7C, 0xA5, 
+0xA0, 0xA0, 0xCD, 0xC7, 0x4D, 0x7C, 0x29, 0x98, 
+0xDC, 0x9B, 0x83, 0x42, 0x76, 0x83, 0x13, 0x15, 
+0x93, 0x89, 0x34, 0x38, 0x39, 0x39, 0x37, 0x22, 
+0x32, 0x14, 0xD9, 0xCA, 0xD3, 0xAA, 0x41, 0xCE, 
+0xCF, 0xDA, 0x65, 0x14, 0x6F, 0x1F, 0x27, 0x77, 
+0xF4, 0x7B, 0x9A, 0xD4, 0x07, 0x3A, 0xD2, 0x04, 
+0x64, 0xB3, 0x57, 0x12, 0x21, 0xA1, 0x2A, 0x14, 
+0x11, 0xA7, 0x70, 0xE1, 0xB3, 0x3D, 0x76, 0x03, 
+0x92, 0xFF, 0x94, 0x58, 0x59, 0x25, 0x12, 0x04, 
+0xBB, 0xDF, 0x24, 0x9B, 0x3E, 0xA9, 0x30, 0x9E, 
+0x5E, 0xF9, 0x1F, 0x76, 0x60, 0x28, 0x88, 0x5B, 
+0xF0, 0x48, 0x91, 0x15, 0xA3, 0x4C, 0xD5, 0x73, 
+0xD0, 0x9A, 0x99, 0xDB, 0xBD, 0xD2, 0x23, 0x50, 
+0xBC, 0x55, 0x07, 0x01, 0x04, 0xFB, 0x1F, 0xE1, 
+0x28, 0x4E, 0x46, 0xAE, 0x38, 0x96, 0x52, 0xDA, 
+0xD6, 0xA9, 0xD9, 0x94, 0xD2, 0x48, 0x9F, 0x0C, 
+0xE5, 0x55, 0x18, 0x1E, 0x92, 0x91, 0x44, 0x92, 
+0x10, 0xE4, 0x2E, 0xB7, 0x95, 0xB4, 0x29, 0x18, 
+0x3F, 0x35, 0x6A, 0x2F, 0x7D, 0x2F, 0x88, 0xA7, 
+0x76, 0xDB, 0xAA, 0xB6, 0xB9, 0xF3, 0x9F, 0x51, 
+0x70, 0x04, 0xBB, 0x20, 0xA4, 0x66, 0x93, 0xF9, 
+0x68, 0x3D, 0xC0, 0x92, 0x2D, 0xAF, 0xEC, 0x9E, 
+0xEC, 0x8B, 0xC1, 0x54, 0x90, 0xE0, 0x81, 0xE9, 
+0x99, 0xAB, 0xA6, 0x37, 0x2A, 0x9D, 0x27, 0x2B, 
+0x8E, 0x70, 0x22, 0x53, 0xBC, 0x53, 0x06, 0x3E, 
+0x68, 0x67, 0x6E, 0x22, 0x43, 0xF6, 0x11, 0x9F, 
+0x12, 0x7C, 0x6E, 0xF1, 0x80, 0x3E, 
+
+0x7F, 0x29, 0xE5, 0xA4, 0x2D, 0x03, 0xA1, 0xA6, 
+0x7B, 0xCB, 0xDA, 0xEA, 0xD8, 0xAB, 0xE5, 0x59, 
+0xD6, 0xD5, 0x71, 0x2D, 0xF9, 0x90, 0x5E, 0xCC, 
+0xBE, 0x22, 0x46, 0xE6, 0x23, 0xDD, 0x11, 0x3B, 
+0x65, 0xEF, 0xE4, 0xEC, 0x8D, 0x10, 0xFA, 0x68, 
+0x6D, 0x8A, 0xB6, 0xF5, 0x74, 0xBD, 0x54, 0x09, 
+0x17, 0xD3, 0xA6, 0x71, 0xF1, 0xDD, 0x54, 0xA6, 
+0xBE, 0x12, 0xEB, 0x99, 0xB1, 0x02, 0x5C, 0x2C, 
+0xB6, 0x3A, 0x53, 0x20, 0xF7, 0xCE, 0xFA, 0xB7, 
+0x2F, 0x27, 0xDA, 0xB7, 0x49, 0x5A, 0x00, 0xB3, 
+0x7F, 0x14, 0xEA, 0x83, 0x26, 0x0D, 0x95, 0x21, 
+0xD4, 0xE6, 0x99, 0x4E, 0xA2, 0x85, 0xBE, 0x7A, 
+0x56, 0x79, 0x18, 0x81, 0x82, 0x80, 0xD3, 0xF2, 
+0x26, 0x98, 0x41, 0xA6, 0xA1, 0x01, 0x16, 0xBF, 
+0x7E, 0x5A, 0x7F, 0x67, 0xF5, 0x1A, 0x4A, 0x8B, 
+0xEE, 0x5F, 0x42, 0x31, 0xCC, 0x95, 0x70, 0x34, 
+0xFE, 0xA3, 0x42, 0xED, 0xE7, 0xC9, 0xAC, 0x2D, 
+0x45, 0x16, 0x75, 0x32, 0x85, 0x6F, 0xF6, 0x32, 
+0xA7, 0x91, 0x02, 0x6E, 0xB9, 0x0C, 0xA4, 0x52, 
+0xDF, 0x3F, 0x94, 0x05, 0xD5, 0x1B, 0x4F, 0x60, 
+0x7D, 0x24, 0x0B, 0xA2, 0x3F, 0x53, 0x36, 0xAA, 
+0x1A, 0x8D, 0x29, 0x76, 0xC4, 0x0E, 0xD1, 0x5F, 
+0x63, 0x66, 0xA4, 0x70, 0x08, 0x69, 0x32, 0x2D, 
+0x9F, 0x04, 0x2D, 0xEA, 0xB5, 0x6A, 0x9A, 0x58, 
+0x7E, 0xD4, 0x38, 0xED, 0xDC, 0xAF, 0x43, 0x0C, 
+0x23, 0x96, 0xFA, 0x49, 0xAB, 0xCA, 0xE2, 0x6D, 
+0x7A, 0x51, 0xB0, 0xE4, 0xCC, 0x9F, 0x39, 0xE5, 
+0x6D, 0x40, 0xF7, 0x10, 0x69, 0x59, 0x4C, 0x55, 
+0xC7, 0x92, 0xF2, 0x2A, 0xD4, 0x9E, 0x7F, 0x82, 
+0x23, 0x09, 0xC7, 0x37, 0x99, 0xF2, 0x77, 0xD7, 
+0x6C, 0x10, 0xFB, 0x6B, 0xD7, 0xBA, 0x8E, 0x1F, 
+0xE7, 0xBD, 0x1B, 0x05, 0x44, 0x74, 0x0C, 0xDD, 
+0x35, 0xDA, 0x6C, 0xD2, 0x0B, 0x11, 0x32, 0x45, 
+0x3A, 0x2C, 0x38, 0xBC, 0x1B, 0x57, 0xBC, 0xE4, 
+0x7C, 0x37, 0x42, 0x3F, 0xDD, 0xCF, 0xC9, 
+
+0xC0, 0x90, 0x69, 0x8A, 0x4B, 0x43, 0xE4, 0xA2, 
+0xE3, 0xE4, 0xB6, 0xB8, 0xFB, 0x70, 0xD3, 0x27, 
+0x9E, 0xEE, 0xAF, 0x6C, 0x25, 0x6F, 0x16, 0x40, 
+0x9C, 0xA1, 0x70, 0x58, 0x3A, 0xE3, 0xD6, 0x16, 
+0x2D, 0x07, 0x85, 0x37, 0xD8, 0x90, 0xBA, 0x41, 
+0x2C, 0xCC, 0xD6, 0x3C, 0x04, 0x72, 0x12, 0x2B, 
+0x56, 0x3F, 0xE5, 0x2F, 0x90, 0x09, 0x90, 0x63, 
+0x27, 0xF8, 0x3A, 0x9F, 0x6C, 0x90, 0xF1, 0x42, 
+0x8A, 0x4C, 0xB3, 0xEB, 0xBF, 0x96, 0x6D, 0xA4, 
+0xF5, 0xDE, 0x65, 0x81, 0x48, 0x63, 0x7A, 0xCC, 
+0xF4, 0x70, 0x89, 0x8F, 0xBA, 0xF2, 0xC7, 0x85, 
+0x40, 0x8A, 0xC0, 0xCC, 0x8D, 0xD5, 0x57, 0x8E, 
+0xEB, 0x31, 0x92, 0xB1, 0x19, 0x1C, 0xBC, 0x57, 
+0xF6, 0xCC, 0x78, 0x2F, 0x25, 0x7E, 0x3F, 0x48, 
+0x1D, 0x66, 0xF2, 0xC6, 0x67, 0x87, 0x52, 0xC8, 
+0x3C, 0x3F, 0x2A, 0x3F, 0xFB, 0x0D, 0x08, 0xCB, 
+0x62, 0x9F, 0xA5, 0x9A, 0xE9, 0xB1, 0x32, 0x60, 
+0xD5, 0x36, 0xF9, 0xB6, 0x4E, 0xDE, 0xE1, 0xFA, 
+0xDA, 0xD4, 0xB4, 0xC8, 0x9D, 0x0E, 0x79, 0x0F, 
+0x3B, 0xCE, 0x22, 0xDE, 0xE0, 0x29, 0x20, 0xC6, 
+0xEB, 0x6B, 0x83, 0x74, 0xAB, 0xE6, 0xE0, 0xF1, 
+0x88, 0x85, 0x09, 0x0F, 0x66, 0x56, 0x5B, 0xD4, 
+0x27, 0x4A, 0xB6, 0xDC, 0xFF, 0x08, 0xFD, 0xB3, 
+0xD7, 0x64, 0x96, 0x67, 0xDB, 0x05, 0xB4, 0x35, 
+0xE2, 0xB1, 0x5F, 0xDA, 0xB6, 0x7D, 0x5F, 0xF5, 
+0xCB, 0x26, 0x70, 0xA1, 0x16, 0x72, 0x1C, 0x2E, 
+0x85, 0x4B, 0x61, 0xF5, 0x10, 0x82, 0xB8, 0x9F, 
+0x26, 0x41, 0x32, 0xAA, 0x53, 0x87, 0xEE, 0x9E, 
+0x51, 0x4F, 0xD6, 0x21, 0x01, 0x3B, 0x91, 0x56, 
+0xBE, 0x17, 0xC7, 0x98, 0xDD, 0x28, 0x42, 0xEF, 
+0xD2, 0x4C, 0x73, 0x9E, 0xC9, 0xA2, 0x72, 0xF4, 
+0x79, 0x22, 0x83, 0xF2, 0xB3, 0x56, 0x6B, 0x50, 
+0x1C, 0x19, 0x65, 0xF7, 0x5A, 0x51, 0x5B, 0x13, 
+0x0F, 0xDF, 0xD7, 0x9D, 0x10, 0x28, 0x03, 0x27, 
+0xBB, 0x57, 0x40, 0x13, 0xEA, 0x0C, 0x8D, 0x68, 
+
+0x9A, 0x6B, 0x19, 0x21, 0x50, 0x30, 0x5D, 0x6D, 
+0x2B, 0xA7, 0xE6, 0x32, 0x80, 0x94, 0xF4, 0x7F, 
+0xBF, 0xB9, 0x18, 0x15, 0xA1, 0x8A, 0xFC, 0x03, 
+0x24, 0xD7, 0xEC, 0x10, 0xC4, 0xEC, 0xC2, 0x5C, 
+0x52, 0x61, 0xF1, 0xF2, 0xEE, 0xE4, 0x55, 0xA9, 
+0xA8, 0xE6, 0xDE, 0xB5, 0x1E, 0x4C, 0x22, 0xE2, 
+0x8C, 0xE4, 0xD9, 0x9E, 0x11, 0xC0, 0x39, 0x9B, 
+0xD0, 0x5C, 0x04, 0x08, 0x23, 0xC0, 0x86, 0xC4, 
+0xD7, 0xDC, 0x37, 0x3D, 0xAE, 0xD3, 0xC1, 0x0D, 
+0x04, 0x72, 0xB1, 0x6A, 0xBC, 0x55, 0xB3, 0x32, 
+0xB4, 0xD4, 0xA0, 0x65, 0x7E, 0x7F, 0xB8, 0xA4, 
+0x91, 0x9C, 0x23, 0xDC, 0xC5, 0x3F, 0x55, 0x4E, 
+0x5D, 0xB9, 0x39, 0xE4, 0x36, 0xEB, 0xFA, 0x83, 
+0x82, 0x50, 0x10, 0xA6, 0x33, 0xFC, 0x2F, 0x2B, 
+0x6D, 0x95, 0xA4, 0xFD, 0x1F, 0xB3, 0xCC, 0xE3, 
+0xE3, 0xA5, 0x06, 0x57, 0xE4, 0x9C, 0xC8, 0x1C, 
+0x67, 0x8C, 0xC6, 0xE7, 0xF0, 0xDC, 0xB3, 0xA8, 
+0x2B, 0x37, 0xE1, 0xB3, 0xDA, 0x68, 0x85, 0xFF, 
+0xE2, 0xEE, 0xF0, 0x42, 0xB7, 0x22, 0xE8, 0x44, 
+0x14, 0x68, 0x93, 0x93, 0xF2, 0x52, 0x04, 0x65, 
+0x46, 0x49, 0xCC, 0x73, 0xAA, 0xD5, 0xBF, 0x71, 
+0x71, 0xDD, 0x0B, 0xD6, 0x2C, 0x2C, 0xED, 0xA9, 
+0x89, 0x9C, 0x04, 0xBB, 0x11, 0x36, 0x32, 0xF5, 
+0x6A, 0xB0, 0x7F, 0x72, 0x69, 0xE8, 0x3A, 0x77, 
+0x69, 0xDA, 0xC9, 0x4E, 0x24, 0x47, 0x81, 0x66, 
+0x73, 0xBF, 0x9F, 0x09, 0xAB, 0xD2, 0xB4, 0x9B, 
+0xE6, 0xA2, 0x98, 0x22, 0x44, 0x59, 0xDE, 0x32, 
+0x70, 0x46, 0x59, 0x41, 0xC8, 0x54, 0x0D, 0xFF, 
+0xE5, 0x43, 0xF6, 0xC3, 0x1B, 0xD4, 0xEA, 0x20, 
+0x1D, 0x74, 0x39, 0x18, 0x80, 0x02, 0xE8, 0x85, 
+0x9D, 0xD9, 0xD5, 0x8D, 0xE6, 0x17, 0x20, 0x13, 
+0x3C, 0x25, 0x69, 0xFB, 0xA7, 0xDC, 0x91, 0x71, 
+0x95, 0x29, 0xD5, 0x6D, 0x4C, 0xF2, 0x58, 0x2D, 
+0x77, 0xC6, 0x83, 0xCC, 0x60, 0x37, 0xDF, 0xDF, 
+0x45, 0x52, 0x23, 0x67, 0xA8, 0x00, 0xBC, 0x41, 
+0xF9, 
+
+0xF0, 0x9A, 0x00, 0x20, 0x6A, 0x94, 0x7A, 0x8A, 
+0x63, 0xB2, 0x21, 0x53, 0xAC, 0xCF, 0xC1, 0xE6, 
+0x8C, 0x90, 0x26, 0x89, 0xB4, 0x0F, 0x2F, 0xFB, 
+0x67, 0x82, 0x9A, 0xFE, 0x7C, 0x27, 0x09, 0x2D, 
+0x1B, 0x58, 0xA3, 0x91, 0x23, 0x55, 0x30, 0xC4, 
+0xC1, 0x1D, 0x68, 0xC1, 0x3D, 0x33, 0x06, 0x63, 
+0xFF, 0xF3, 0x7F, 0x9A, 0x21, 0x67, 0x07, 0x4A, 
+0xB6, 0xAA, 0x14, 0x47, 0x46, 0x7C, 0xC1, 0x73, 
+0xD1, 0x0D, 0xE9, 0xF4, 0x9A, 0xEE, 0x3C, 0x85, 
+0x21, 0x47, 0xE1, 0xBC, 0x4E, 0xC6, 0x2D, 0x7F, 
+0x3A, 0x34, 0x8D, 0x75, 0x4D, 0x99, 0x40, 0x32, 
+0x74, 0xAF, 0x7D, 0xC5, 0xEE, 0x05, 0x99, 0xBD, 
+0xEF, 0x9E, 0x50, 0xDF, 0xE9, 0xCE, 0x9A, 0x7C, 
+0xA7, 0x86, 0x87, 0x7B, 0x7E, 0x2B, 0xE4, 0x32, 
+0xF8, 0xFC, 0xC5, 0x6E, 0xA7, 0xC6, 0xBD, 0x6B, 
+0x91, 0xB6, 0x79, 0x3F, 0x1F, 0xC2, 0xE6, 0x36, 
+0xFF, 0x27, 0x4A, 0x5D, 0x70, 0x38, 0xE1, 0xFA, 
+0xEC, 0x79, 0x24, 0x23, 0xE7, 0xB8, 0x73, 0x90, 
+0x96, 0x0E, 0x9B, 0x21, 0x16, 0xDC, 0xD3, 0x2B, 
+0xCB, 0xF3, 0x9B, 0x6C, 0x96, 0x43, 0x5A, 0xE0, 
+0x6E, 0x07, 0x29, 0xA0, 0x39, 0x6C, 0x53, 0x5B, 
+0x4D, 0x0C, 0xC1, 0x6F, 0xD0, 0x08, 0xCF, 0x56, 
+0xB9, 0xAE, 0x46, 0xDB, 0x8E, 0x46, 0x6E, 0x2B, 
+0x2A, 0x79, 0x0A, 0x05, 0x0C, 0x57, 0x1A, 0xE7, 
+0x89, 0x8F, 0x31, 0x24, 0x62, 0xAD, 0xB4, 0x36, 
+0xA2, 0xA3, 0xAB, 0xEF, 0xAE, 0x1F, 0x2E, 0xE1, 
+0x91, 0x10, 0xF6, 0xF1, 0xA2, 0xC0, 0xBB, 0x7A, 
+0x04, 0x49, 0x81, 0xDB, 0x27, 0x8B, 0x19, 0xA8, 
+0xD3, 0xDE, 0xFA, 0x44, 0x1B, 0x3E, 0x58, 0x87, 
+0xCE, 0x29, 0x37, 0xF1, 0x44, 0xF0, 0x1D, 0x50, 
+0x0A, 0xC5, 0x44, 0x63, 0x6D, 0x09, 0x66, 0x9A, 
+0x5E, 0x70, 0xC7, 0x90, 0xF2, 0x3E, 0xBB, 0xEC, 
+0x03, 0x7F, 0x7C, 0xCC, 0x9B, 0x06, 0xC1, 0x05, 
+0xCB, 0xB0, 0x27, 0xAF, 0x9F, 0xFD, 0x94, 0x2D, 
+0x95, 0xBD, 0xE6, 0x3E, 0xFE, 0xEE, 0xAF, 0x88, 
+0xEA, 0xD7, 
+
+0xAC, 0x8E, 0xFD, 0x35, 0xC8, 0x71, 0xD5, 0x1D, 
+0xAC, 0xEE, 0xA5, 0x18, 0xE6, 0x65, 0xD4, 0x6B, 
+0x1F, 0xC0, 0xE8, 0xFD, 0x05, 0x55, 0xA9, 0x62, 
+0x86, 0x4C, 0x47, 0x99, 0x81, 0xE5, 0x0E, 0xB4, 
+0xFF, 0xB7, 0xCB, 0x6C, 0x31, 0xE9, 0x90, 0x6B, 
+0x72, 0x5F, 0xA8, 0xB1, 0xC6, 0x01, 0x2A, 0xBC, 
+0x99, 0xC4, 0xA9, 0x34, 0x49, 0xB2, 0x2D, 0xE1, 
+0x61, 0xF0, 0xA8, 0x9B, 0x2E, 0x26, 0xA0, 0x8B, 
+0x32, 0x36, 0x81, 0x0B, 0x91, 0x78, 0x55, 0xC4, 
+0x6C, 0xC8, 0xED, 0xD1, 0xD0, 0xF3, 0x4A, 0x42, 
+0xD8, 0xEC, 0x96, 0xB0, 0x65, 0xE3, 0xB8, 0x91, 
+0xCD, 0xB0, 0x12, 0xF0, 0x82, 0xDF, 0x95, 0x01, 
+0xB6, 0x83, 0x87, 0x49, 0x5C, 0xA8, 0x34, 0xEB, 
+0xE4, 0xC0, 0x05, 0x03, 0x14, 0x14, 0x09, 0x00, 
+0x20, 0x40, 0x94, 0x83, 0x7F, 0x76, 0x4E, 0x1E, 
+0x53, 0x9B, 0x22, 0x24, 0x51, 0xB2, 0x3E, 0x33, 
+0x04, 0x5C, 0xBF, 0xA8, 0x0B, 0x67, 0x79, 0xE7, 
+0x53, 0xA9, 0x20, 0x5A, 0x1B, 0xEF, 0x1D, 0xEE, 
+0xD7, 0xF4, 0xB9, 0xB9, 0x50, 0x14, 0x1C, 0x91, 
+0xB0, 0xFD, 0x9B, 0x1C, 0xB9, 0xB0, 0xA1, 0x08, 
+0xC0, 0x0F, 0xEA, 0x76, 0xDE, 0xD3, 0x5E, 0xB1, 
+0x07, 0x62, 0xDD, 0x1F, 0x44, 0xD8, 0x6B, 0xCB, 
+0x31, 0x20, 0x9A, 0x75, 0x76, 0x4A, 0x7D, 0xA9, 
+0x1E, 0x88, 0xE2, 0x8F, 0x45, 0x9B, 0xC9, 0xAF, 
+0x13, 0x79, 0x14, 0xBC, 0x98, 0xD0, 0x2D, 0x08, 
+0xE8, 0xB4, 0x89, 0xA3, 0x5D, 0x20, 0x84, 0x54, 
+0x65, 0x53, 0x75, 0xC6, 0x60, 0x6A, 0x69, 0x46, 
+0x4B, 0x3A, 0x8D, 0xE4, 0x65, 0x01, 0x08, 0x71, 
+0x23, 0xAA, 0xB8, 0x3D, 0x8D, 0x9A, 0x3C, 0xBD, 
+0x9B, 0x9A, 0x48, 0x56, 0xC0, 0x89, 0x89, 0xF9, 
+0x9F, 0x23, 0x9E, 0xB6, 0x53, 0xF6, 0x10, 0xE9, 
+0x4D, 0x9D, 0xA3, 0x79, 0xF0, 0xE9, 0x1F, 0x6E, 
+0x62, 0x75, 0xAD, 0x24, 0x22, 0x57, 0x9C, 0xDC, 
+0xFA, 0x03, 0x77, 0xD9, 0x27, 0x9E, 0x46, 0x4C, 
+0x32, 0x29, 0xB5, 0xAB, 0x7F, 0x7B, 0x23, 0xF6, 
+0xDC, 0x91, 0x23, 
+
+0x67, 0x68, 0x77, 0xB9, 0x1C, 0x33, 0x17, 0xF6, 
+0x22, 0x05, 0x5F, 0x65, 0xBD, 0x9C, 0xF5, 0x97, 
+0x93, 0x6D, 0x89, 0x75, 0x44, 0x8F, 0xAC, 0x7F, 
+0xC2, 0x17, 0xD8, 0xD2, 0xFB, 0x3B, 0x03, 0x5F, 
+0xAD, 0x4F, 0x68, 0x46, 0xFC, 0x23, 0x07, 0xAC, 
+0xBB, 0x1F, 0xE0, 0xC7, 0x94, 0x9D, 0x74, 0x84, 
+0x81, 0x5B, 0x50, 0x84, 0x40, 0x63, 0xA7, 0x39, 
+0xAE, 0xBF, 0xCB, 0xC4, 0x57, 0x27, 0xC4, 0x84, 
+0x09, 0xF3, 0x36, 0x37, 0xF6, 0xD4, 0x0F, 0xB1, 
+0x43, 0xA5, 0x9E, 0x37, 0xED, 0xE9, 0x1E, 0x90, 
+0x15, 0xCA, 0x40, 0x11, 0xDC, 0x05, 0xBA, 0xAE, 
+0x26, 0xC2, 0x43, 0x2D, 0x35, 0x88, 0x99, 0x09, 
+0x75, 0x5A, 0x1C, 0x6F, 0xFF, 0xCC, 0x13, 0x47, 
+0x33, 0x72, 0x9F, 0x32, 0x26, 0x80, 0xB7, 0x3B, 
+0x4E, 0xAF, 0xCA, 0x6F, 0x14, 0x2C, 0x2A, 0x2E, 
+0xC7, 0x50, 0x56, 0x3D, 0xE6, 0x48, 0x0F, 0x8F, 
+0xEF, 0x0F, 0x8F, 0xB1, 0xE0, 0x5D, 0x9A, 0x1C, 
+0x8A, 0x4E, 0xDD, 0x80, 0x48, 0x9F, 0xED, 0x9C, 
+0xF6, 0x2D, 0x05, 0x49, 0xA3, 0xC3, 0xCA, 0xE7, 
+0xD1, 0x0B, 0x13, 0xB3, 0x88, 0x78, 0x72, 0x20, 
+0x79, 0x17, 0x1F, 0x88, 0x7E, 0x9B, 0x61, 0xFC, 
+0x06, 0x38, 0xA3, 0xA4, 0x2F, 0xD9, 0x14, 0xB7, 
+0x81, 0xF7, 0x9D, 0xB3, 0x17, 0xD7, 0xE1, 0x49, 
+0x08, 0x73, 0x35, 0xD0, 0xA7, 0xD6, 0x56, 0xA5, 
+0xE9, 0xFC, 0x0D, 0xAB, 0xCF, 0x87, 0x92, 0xFB, 
+0x8A, 0xEE, 0xCA, 0x60, 0x9A, 0x3A, 0x93, 0xA3, 
+0x16, 0x3D, 0xFC, 0x4B, 0x52, 0x6E, 0x08, 0x1F, 
+0x06, 0xF7, 0x82, 0xD4, 0x88, 0x5A, 0x40, 0x4C, 
+0x06, 0x85, 0x31, 0xCB, 0xF5, 0xB1, 0x77, 0x1D, 
+0x74, 0x90, 0xDE, 0xD3, 0x0C, 0x6E, 0xCF, 0x9C, 
+0x6B, 0x9E, 0x87, 0xF2, 0xE3, 0xBD, 0x9F, 0xBE, 
+0x71, 0x18, 0x51, 0xFC, 0x21, 0xCC, 0xDC, 0x9C, 
+0x4F, 0xF0, 0x67, 0x16, 0x2F, 0xA2, 0x6C, 0x13, 
+0x4A, 0x1C, 0x0F, 0xDB, 0x50, 0xAA, 0x0A, 0xF1, 
+0xAC, 0x78, 0x2C, 0x62, 0x7B, 0xF4, 0x30, 0xB7, 
+0xED, 0x68, 0x51, 0x09, 
+
+0xB6, 0x3E, 0xC1, 0x89, 0xA8, 0xE3, 0xE3, 0x01, 
+0x8C, 0x0B, 0x5F, 0xDC, 0xA8, 0x56, 0xFF, 0x3D, 
+0x60, 0x30, 0x84, 0x3C, 0x97, 0xE2, 0xEE, 0xD4, 
+0xE6, 0x7A, 0x43, 0x2E, 0x0E, 0xC4, 0x3C, 0xFA, 
+0xD8, 0x5B, 0xEB, 0x08, 0xC8, 0xF7, 0xB3, 0xAF, 
+0x53, 0x67, 0x42, 0xBF, 0x5A, 0x81, 0x10, 0x4A, 
+0x7B, 0x4D, 0xFA, 0x8A, 0xB3, 0x37, 0x7E, 0x21, 
+0x21, 0xFB, 0x00, 0x50, 0x41, 0x9C, 0xDC, 0xAE, 
+0xE7, 0xAC, 0xE7, 0x5D, 0x11, 0xAB, 0x41, 0x30, 
+0xBB, 0xE8, 0x0B, 0xA6, 0x71, 0xFB, 0xDA, 0xBC, 
+0xE0, 0x96, 0xFC, 0x70, 0x76, 0x2A, 0x92, 0xB3, 
+0xF8, 0xB0, 0x2D, 0x35, 0x6E, 0x89, 0x8C, 0xFD, 
+0x49, 0x12, 0x4C, 0x9B, 0xD4, 0x5C, 0x48, 0x45, 
+0xD7, 0xB5, 0xB3, 0xB2, 0x73, 0x00, 0xE7, 0xCB, 
+0x9B, 0xD1, 0x6D, 0xBE, 0x4C, 0xED, 0xA1, 0xFB, 
+0x2F, 0xA4, 0x33, 0xEC, 0xF6, 0x6D, 0x36, 0x6D, 
+0x53, 0x57, 0x75, 0x7C, 0x11, 0x30, 0xDC, 0xBB, 
+0x38, 0x1E, 0x55, 0x2C, 0xA9, 0xEB, 0xC7, 0x75, 
+0xA0, 0x72, 0x03, 0x20, 0xD7, 0x33, 0xDA, 0x43, 
+0xA8, 0x32, 0x53, 0xA8, 0x2E, 0x59, 0x21, 0x21, 
+0x19, 0x68, 0x6D, 0x3D, 0x70, 0x3F, 0xF3, 0x9C, 
+0x34, 0x83, 0x6E, 0xEE, 0x14, 0xD8, 0xED, 0x5C, 
+0x93, 0x0F, 0xD7, 0x9C, 0x40, 0x0E, 0x1E, 0x9F, 
+0xFB, 0x8D, 0x35, 0x1C, 0xF1, 0xBE, 0xCE, 0x38, 
+0x98, 0x1B, 0x03, 0xD6, 0x0D, 0xA9, 0x50, 0xA4, 
+0xA9, 0x53, 0x79, 0x6B, 0x2A, 0x5F, 0x29, 0xB2, 
+0x7C, 0x01, 0x07, 0xEA, 0x23, 0x54, 0x63, 0xF6, 
+0xB2, 0x97, 0x57, 0xC5, 0x88, 0xE9, 0xA1, 0x7E, 
+0x1C, 0xA0, 0xBB, 0xDB, 0x7A, 0x56, 0x89, 0xC3, 
+0x64, 0x08, 0x00, 0x84, 0xF5, 0xA5, 0x86, 0xFF, 
+0xC7, 0x72, 0xC5, 0x6F, 0x41, 0x19, 0x2A, 0x23, 
+0x28, 0xDD, 0xEF, 0x9E, 0x8D, 0x58, 0xF4, 0x41, 
+0xAD, 0x95, 0x50, 0x4D, 0xA6, 0x90, 0x38, 0x5F, 
+0x44, 0xFE, 0xD6, 0xBF, 0x52, 0x36, 0x7A, 0xA1, 
+0xDB, 0x2C, 0x41, 0x14, 0xFE, 0x76, 0x34, 0xEB, 
+0xC4, 0x3A, 0x8D, 0xEA, 0x55, 
+
+0x10, 0x02, 0x05, 0x10, 0x13, 0x13, 0xCE, 0x5E, 
+0x42, 0xCF, 0xAE, 0x08, 0x95, 0xB2, 0x67, 0x08, 
+0x36, 0x66, 0x5E, 0xFB, 0x14, 0xA8, 0xF7, 0x60, 
+0x91, 0xE7, 0x66, 0xE4, 0x6C, 0x29, 0xFF, 0x75, 
+0xB5, 0x7C, 0x6F, 0x2B, 0x01, 0xE8, 0x9E, 0x9B, 
+0x90, 0x35, 0x78, 0x51, 0x41, 0x5B, 0x63, 0x67, 
+0x10, 0x08, 0x40, 0x81, 0x9F, 0x46, 0xAF, 0x77, 
+0x53, 0x34, 0x74, 0x5A, 0xF4, 0x90, 0x88, 0x40, 
+0x71, 0x57, 0x89, 0xF3, 0x42, 0xBB, 0x92, 0x4D, 
+0xA9, 0xA3, 0xA5, 0x52, 0x9C, 0xE5, 0x94, 0x58, 
+0x14, 0x83, 0xFC, 0xCE, 0x9F, 0x84, 0xAE, 0x12, 
+0xB0, 0x75, 0xC1, 0x37, 0x8E, 0xE6, 0xE1, 0xB3, 
+0xEF, 0x04, 0xB2, 0xBD, 0x80, 0x57, 0xE4, 0x30, 
+0x7F, 0xC4, 0x87, 0x22, 0x06, 0x8F, 0x61, 0xE1, 
+0x10, 0x8E, 0xEF, 0x76, 0x4F, 0xE9, 0xA7, 0x67, 
+0xC0, 0x8D, 0xEC, 0x6C, 0xF1, 0x7E, 0x10, 0xEE, 
+0x08, 0xDF, 0xA0, 0xF0, 0xD5, 0x49, 0xE6, 0xBF, 
+0x09, 0x18, 0xB6, 0xD5, 0x9E, 0xC6, 0xFA, 0x42, 
+0xDB, 0xD6, 0x8A, 0x9F, 0x17, 0xE4, 0x39, 0xC2, 
+0x9A, 0x3D, 0x6E, 0x51, 0x52, 0xBA, 0xDD, 0xEE, 
+0x0F, 0xFD, 0x51, 0x44, 0x5E, 0xF3, 0x3B, 0x22, 
+0xB5, 0x7C, 0xC0, 0x25, 0x79, 0x4E, 0xDD, 0x99, 
+0x53, 0xEB, 0x94, 0x7A, 0xEA, 0xCA, 0x26, 0x9D, 
+0xB6, 0x9A, 0xC2, 0x69, 0x5D, 0xA0, 0x7B, 0xB7, 
+0xBF, 0x48, 0xD2, 0x43, 0x87, 0x54, 0x7A, 0x12, 
+0xB6, 0x88, 0xA7, 0x4D, 0x6D, 0xD1, 0x36, 0xE9, 
+0xD2, 0xD5, 0x21, 0x64, 0x09, 0x0F, 0xDF, 0x7E, 
+0x07, 0x39, 0x94, 0xD3, 0x2E, 0x66, 0x7D, 0xCA, 
+0xF4, 0x6F, 0xC2, 0x5E, 0x4F, 0x7D, 0x03, 0xBA, 
+0xC6, 0x14, 0xE9, 0x54, 0xE8, 0x1C, 0x4C, 0xEF, 
+0xE3, 0x06, 0xD4, 0x6F, 0x17, 0x98, 0xD2, 0x05, 
+0xDF, 0x8F, 0xD5, 0xC3, 0x42, 0x9D, 0x96, 0x30, 
+0x61, 0x90, 0x71, 0x02, 0x5E, 0x45, 0x11, 0x09, 
+0x70, 0x5E, 0x19, 0x43, 0x6C, 0x8A, 0x88, 0xDD, 
+0xB2, 0x66, 0x0D, 0xCD, 0xEE, 0xE8, 0xE7, 0xA1, 
+0x89, 0x18, 0xEA, 0x60, 0xF6, 0xAA, 
+
+0x6C, 0x5D, 0xD7, 0xB7, 0xFF, 0x30, 0x9D, 0x5E, 
+0x33, 0x7F, 0xD0, 0x82, 0x69, 0xA4, 0x70, 0x63, 
+0x76, 0x92, 0xD1, 0x92, 0x8B, 0xB8, 0x6E, 0x45, 
+0xA5, 0xDB, 0xF6, 0x13, 0x16, 0xDC, 0x30, 0x3C, 
+0x59, 0x78, 0xBE, 0x59, 0xEB, 0xB8, 0xF8, 0x45, 
+0xC2, 0xB1, 0x56, 0x50, 0x9F, 0x55, 0xFD, 0x20, 
+0x79, 0x68, 0x83, 0x9C, 0xE1, 0xAF, 0x15, 0xE5, 
+0xCB, 0x08, 0xCA, 0xA7, 0x3C, 0x42, 0x84, 0x71, 
+0x48, 0x01, 0xE9, 0x26, 0x36, 0xC5, 0xA7, 0x3C, 
+0x78, 0x96, 0xE9, 0xA6, 0x39, 0x21, 0x51, 0xCA, 
+0xD6, 0x58, 0x53, 0x55, 0x66, 0x40, 0xCE, 0x86, 
+0x5B, 0xFD, 0xC6, 0x02, 0x92, 0x84, 0x43, 0xF0, 
+0xDA, 0x5F, 0xCA, 0x1C, 0x81, 0x0C, 0x13, 0x02, 
+0xB6, 0xA2, 0xEC, 0x1D, 0xCF, 0xB1, 0x9D, 0x58, 
+0x91, 0x3A, 0xDE, 0xC6, 0x5A, 0x3E, 0xC7, 0x01, 
+0xA7, 0x1C, 0x6F, 0x8A, 0x25, 0x42, 0x05, 0xA9, 
+0xA9, 0x3B, 0x7C, 0xDC, 0x59, 0x12, 0xC0, 0xC7, 
+0xA6, 0x09, 0xB7, 0x97, 0x3E, 0xEE, 0x2E, 0xB2, 
+0xBE, 0x5F, 0x9F, 0x31, 0x04, 0x3C, 0xE3, 0x0A, 
+0x47, 0x27, 0xA7, 0x54, 0x17, 0x05, 0xE4, 0xAF, 
+0xD8, 0xF2, 0xE2, 0x62, 0x6A, 0xE0, 0x58, 0x77, 
+0xA5, 0xC9, 0x74, 0xC5, 0x18, 0x33, 0x66, 0xC7, 
+0xB0, 0x21, 0x8C, 0xB1, 0xAE, 0xA7, 0x08, 0xAF, 
+0xCF, 0x98, 0x3E, 0xC0, 0xA1, 0x16, 0x31, 0xAC, 
+0xD2, 0x7E, 0xBF, 0x1E, 0x53, 0x74, 0x9A, 0xAB, 
+0xCA, 0x10, 0xDB, 0xD4, 0xA7, 0xE9, 0xA7, 0x80, 
+0x80, 0x60, 0x3E, 0x9F, 0x47, 0x1B, 0x5C, 0x91, 
+0x5C, 0x8C, 0xDA, 0x33, 0xE1, 0xDB, 0x42, 0xBC, 
+0x9F, 0x6D, 0xAB, 0x85, 0xEF, 0x81, 0x0E, 0x1A, 
+0x5C, 0x06, 0x83, 0xF8, 0x1D, 0x4D, 0xD2, 0x11, 
+0x5B, 0x8D, 0x5B, 0xE2, 0xE6, 0x22, 0x85, 0x40, 
+0x97, 0xF0, 0x42, 0x8C, 0x1E, 0x0A, 0x1C, 0xF7, 
+0x8C, 0xD1, 0xAF, 0x74, 0x3D, 0x15, 0x1F, 0x1E, 
+0xE0, 0x0E, 0x72, 0xA3, 0x1B, 0x1F, 0xAB, 0x1F, 
+0xAF, 0xDC, 0x7B, 0x7C, 0xB3, 0x3E, 0x64, 0x5A, 
+0x96, 0xD5, 0xE7, 0xF9, 0x11, 0x5F, 0xB1, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,2841 @@
+package utils
+func getkat6440_trailer(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xCC, 0x7F, 0x70, 0x62, 0xC2, 0xC3, 0x74, 0x83, 
+0xD6, 0x00, 0xE9, 0xAD, 0x08, 0x36, 0xA3, 0x18, 
+0x8F, 0x52, 0xC4, 0xCF, 0x15, 0x4C, 0x40, 0xD2, 
+0xBD, 0x93, 0xE7, 0x74, 0xFA, 0x32, 0x1D, 0x69, 
+
+0x10, 0x84, 0xDD, 0x82, 0x1E, 0x23, 0xA9, 0x70, 
+0x8E, 0xBA, 0x8A, 0xA4, 0x69, 0x98, 0xA7, 0xF0, 
+0xD4, 0x3E, 0xD8, 0xD8, 0xEE, 0x14, 0x87, 0xF9, 
+0xE8, 0x2F, 0x86, 0xDD, 0x79, 0x8B, 0x5B, 0xA8, 
+0x87, 
+
+0xB6, 0x3E, 0x85, 0x08, 0x73, 0x15, 0xEB, 0xF2, 
+0xFC, 0xAB, 0x93, 0xE4, 0xA7, 0xB4, 0x89, 0x58, 
+0x13, 0xAE, 0x13, 0x9C, 0x9E, 0xE1, 0x11, 0x15, 
+0xBC, 0x43, 0xE2, 0x74, 0x85, 0xF0, 0x75, 0x97, 
+0x3A, 0x75, 
+
+0x67, 0x68, 0x77, 0x97, 0x67, 0xFF, 0xA5, 0x65, 
+0xEB, 0xD7, 0xF7, 0x46, 0x63, 0x89, 0x3D, 0xA9, 
+0x3D, 0xED, 0x1F, 0x4E, 0xCD, 0x88, 0xD3, 0x43, 
+0xFF, 0x39, 0xAB, 0x8E, 0x85, 0x43, 0x33, 0x6F, 
+0x49, 0xC5, 0x13, 
+
+0xAC, 0x8E, 0xFD, 0x35, 0xA7, 0x55, 0xF0, 0xD5, 
+0xF7, 0x13, 0x31, 0x1E, 0x5C, 0x1E, 0xA3, 0xB1, 
+0x37, 0x14, 0x29, 0x22, 0x7D, 0x42, 0xE9, 0x4D, 
+0x42, 0xDF, 0xD4, 0x8A, 0x58, 0x4E, 0xF6, 0xD7, 
+0xAB, 0x3E, 0x07, 0xBB, 
+
+0xF0, 0x9A, 0x00, 0x20, 0x6A, 0x18, 0xB5, 0xC8, 
+0x1E, 0x2D, 0x7C, 0x60, 0xF5, 0xCE, 0x60, 0xD2, 
+0x52, 0x68, 0x98, 0x54, 0x4C, 0xE7, 0xF5, 0x39, 
+0xD2, 0x81, 0x5B, 0x3C, 0x64, 0x3F, 0xEE, 0x59, 
+0x2E, 0xD3, 0x86, 0xEE, 0xA6, 
+
+0x9A, 0x6B, 0x19, 0x21, 0x50, 0x30, 0xB3, 0xC9, 
+0x22, 0x4E, 0xE3, 0x69, 0xE7, 0xC6, 0x70, 0xC1, 
+0x44, 0xA6, 0xA4, 0x8F, 0x28, 0xE8, 0x80, 0x48, 
+0x88, 0xD5, 0x0C, 0x32, 0xC4, 0xDB, 0xAC, 0xC8, 
+0x43, 0x64, 0x33, 0x63, 0x8F, 0x51, 
+
+0xC0, 0x90, 0x69, 0x8A, 0x4B, 0x43, 0xE4, 0x63, 
+0xCE, 0x0D, 0xB0, 0xE4, 0xAB, 0x2D, 0xCB, 0x5F, 
+0x37, 0x99, 0x92, 0xC3, 0x63, 0x02, 0xFE, 0xE3, 
+0xA1, 0x47, 0x0C, 0x0A, 0x54, 0xA8, 0x31, 0xB7, 
+0xF6, 0xE4, 0x0F, 0x4F, 0xC1, 0x0F, 0xFD, 
+
+0x7F, 0x29, 0xE5, 0xA4, 0x2D, 0x03, 0xA1, 0xA6, 
+0x53, 0x13, 0x56, 0x80, 0x86, 0x9D, 0x5A, 0xB8, 
+0xD2, 0xD2, 0x2B, 0xC2, 0x24, 0x3B, 0x29, 0xBC, 
+0x1F, 0x33, 0xA9, 0x39, 0x9B, 0x51, 0x7E, 0x89, 
+0xBB, 0xD0, 0xE3, 0x7B, 0xDD, 0x06, 0x30, 0x01, 
+
+0xA2, 0xB6, 0x80, 0x57, 0x39, 0xB5, 0x0C, 0x7C, 
+0x51, 0x13, 0x0D, 0x14, 0x51, 0xA1, 0x8E, 0x61, 
+0x65, 0xD8, 0x9A, 0x29, 0x5F, 0xC2, 0xC2, 0xDE, 
+0x68, 0xD4, 0x9E, 0x52, 0x8D, 0xD7, 0xC3, 0xB1, 
+0xCB, 0x74, 0x3F, 0x0F, 0x6C, 0x5D, 0x13, 0xA9, 
+0xA5, 
+
+0x40, 0xAF, 0x79, 0x43, 0xC8, 0x92, 0x99, 0xFE, 
+0x1D, 0x31, 0xD6, 0xDD, 0x9A, 0x36, 0xC9, 0x6A, 
+0xDF, 0x6C, 0x30, 0x37, 0x57, 0x38, 0x91, 0xAE, 
+0x03, 0x5F, 0x04, 0x3E, 0x6A, 0xAA, 0xDE, 0x98, 
+0x3A, 0x9F, 0x73, 0xD1, 0x23, 0xA3, 0x9D, 0xA5, 
+0x11, 0xF2, 
+
+0x9A, 0xAC, 0x4F, 0x65, 0xAB, 0x70, 0x47, 0x13, 
+0xE1, 0xFD, 0x02, 0x9A, 0x02, 0xC8, 0x62, 0x10, 
+0x3E, 0x46, 0xF1, 0xC2, 0xDB, 0x40, 0xEF, 0xB5, 
+0xFF, 0x4F, 0x8B, 0x13, 0x78, 0xA3, 0x91, 0xDC, 
+0xEC, 0xFE, 0x13, 0xF0, 0xE9, 0x69, 0x0E, 0xCC, 
+0xFB, 0x60, 0x89, 
+
+0x93, 0x11, 0x66, 0xE8, 0xAF, 0x33, 0xB6, 0x2E, 
+0x20, 0xC8, 0x28, 0xA0, 0x1F, 0x43, 0x7C, 0x7F, 
+0x5B, 0x12, 0x96, 0x51, 0x8C, 0x82, 0x64, 0x4D, 
+0x63, 0x66, 0x72, 0xBD, 0x6A, 0xA5, 0x57, 0xEA, 
+0x24, 0x18, 0xB6, 0xD9, 0xFF, 0xFD, 0x35, 0xBD, 
+0x20, 0x58, 0x10, 0x5E, 
+
+0xB0, 0x2F, 0xAD, 0xFB, 0x5C, 0x05, 0x75, 0x90, 
+0x24, 0x4F, 0x2E, 0xD3, 0xD1, 0xF1, 0x2F, 0x10, 
+0xDC, 0x6B, 0xCF, 0x55, 0x59, 0xC6, 0x62, 0x99, 
+0x96, 0x49, 0xC4, 0x10, 0x68, 0x36, 0x93, 0x38, 
+0xC0, 0x92, 0x6F, 0xF5, 0xFB, 0x17, 0x35, 0x8B, 
+0xA0, 0xF1, 0x1B, 0x1B, 0xBF, 
+
+0x42, 0xCC, 0x43, 0x66, 0x46, 0xB2, 0xE1, 0x0F, 
+0x93, 0x40, 0x68, 0x27, 0xF6, 0x7E, 0xE6, 0x57, 
+0xF4, 0xA1, 0xC6, 0xEA, 0x97, 0xC4, 0x1B, 0x36, 
+0x0A, 0x65, 0x49, 0x4D, 0xC1, 0x56, 0x61, 0x21, 
+0xF9, 0x78, 0xF9, 0x1E, 0x2B, 0x60, 0xF9, 0x2C, 
+0x85, 0xE7, 0x22, 0xFD, 0x1F, 0x9D, 
+
+0xBA, 0x71, 0x2D, 0xA3, 0xEF, 0xBE, 0xDB, 0x3A, 
+0xEB, 0x26, 0x63, 0x4A, 0x89, 0x91, 0xE0, 0xE6, 
+0xEE, 0x23, 0x0C, 0x2C, 0x00, 0x12, 0x0A, 0x90, 
+0x48, 0x76, 0x45, 0x08, 0x04, 0x2E, 0x93, 0x48, 
+0x8D, 0xC6, 0xD2, 0x5C, 0x4B, 0xBF, 0x3E, 0x7C, 
+0xB1, 0xAF, 0x4E, 0xD1, 0x2A, 0x20, 0x4B, 
+
+0x09, 0xB7, 0x14, 0x51, 0x09, 0x15, 0xFF, 0xEB, 
+0x6D, 0x5E, 0xD4, 0xD1, 0xAB, 0x37, 0x7B, 0x4D, 
+0x8B, 0x4A, 0x1E, 0xF5, 0x48, 0x0B, 0x69, 0xB5, 
+0x86, 0x81, 0xF0, 0xD0, 0x24, 0x11, 0xB7, 0x15, 
+0xD4, 0x7F, 0x18, 0xD1, 0xDA, 0xC8, 0xFB, 0x34, 
+0xAB, 0xB2, 0x28, 0xAA, 0xD9, 0x31, 0x76, 0x7E, 
+
+0x64, 0xDC, 0x28, 0x34, 0xDE, 0x7E, 0x60, 0x5B, 
+0xC0, 0x6D, 0xB8, 0x59, 0x72, 0xC2, 0xBF, 0x70, 
+0x95, 0xDD, 0x1C, 0x22, 0xCC, 0x73, 0xFC, 0x98, 
+0x19, 0x99, 0x40, 0x76, 0x16, 0x52, 0xAC, 0x72, 
+0xE0, 0xF0, 0x67, 0xAF, 0x60, 0xE8, 0x78, 0x99, 
+0xC7, 0xD5, 0xF9, 0xA6, 0x9B, 0x2D, 0x79, 0x0F, 
+0x2E, 
+
+0x91, 0xFC, 0x24, 0x27, 0xE4, 0x84, 0x35, 0xBB, 
+0xDC, 0x2E, 0x22, 0x81, 0x0C, 0xA9, 0xF2, 0x9D, 
+0x35, 0x7C, 0xF4, 0xE6, 0x21, 0x44, 0x02, 0xC5, 
+0xB5, 0xA7, 0x65, 0x5F, 0xEF, 0x19, 0x9F, 0xF6, 
+0x5D, 0xC6, 0x89, 0xC4, 0xFB, 0xC0, 0x80, 0x17, 
+0x27, 0xE7, 0x09, 0x8B, 0x4C, 0x1E, 0x1D, 0xDD, 
+0xDF, 0xA8, 
+
+0x5C, 0xBA, 0x63, 0x2A, 0xF0, 0x06, 0x50, 0x67, 
+0xA9, 0x5A, 0xC0, 0x06, 0x68, 0x5A, 0x98, 0xEE, 
+0x94, 0x33, 0x03, 0xF7, 0x47, 0x01, 0xA0, 0xC2, 
+0x22, 0x39, 0xDB, 0x5A, 0x44, 0x31, 0xDB, 0x60, 
+0x36, 0xD9, 0xAD, 0x21, 0xC2, 0x55, 0xB9, 0xF7, 
+0xA1, 0xF7, 0x91, 0x00, 0x54, 0x64, 0x13, 0x58, 
+0xF8, 0x41, 0x3C, 
+
+0xA4, 0xC9, 0x8F, 0x37, 0xDC, 0x11, 0xE0, 0xF4, 
+0xFB, 0x42, 0x0F, 0xBE, 0x4D, 0x51, 0x3A, 0xC9, 
+0x4E, 0x1D, 0x16, 0x59, 0xDD, 0x1F, 0xF5, 0xF5, 
+0x0A, 0x8A, 0xDC, 0x99, 0x4A, 0xB5, 0x20, 0xD4, 
+0x37, 0xD1, 0x68, 0x70, 0x85, 0x2B, 0xBA, 0x78, 
+0xD6, 0x24, 0xFC, 0xE9, 0x40, 0x8C, 0xDD, 0xE4, 
+0x53, 0x0B, 0xB2, 0x2A, 
+
+0x5D, 0x67, 0x07, 0x3A, 0x44, 0x44, 0x42, 0xD2, 
+0x42, 0x98, 0x85, 0xA7, 0x19, 0x00, 0x0E, 0xCA, 
+0xB0, 0x1D, 0x4A, 0x11, 0x6B, 0x19, 0x0A, 0x81, 
+0xFF, 0x86, 0x0F, 0xA2, 0xB0, 0x6B, 0xD9, 0x51, 
+0x7D, 0x8D, 0x6F, 0xF5, 0x3C, 0x03, 0xCC, 0xD5, 
+0x40, 0x92, 0x14, 0x14, 0xF9, 0x23, 0xE6, 0x1E, 
+0x01, 0x71, 0x96, 0x91, 0x93, 
+
+0xB4, 0xA9, 0xFB, 0x3C, 0xC3, 0x1F, 0x6C, 0x2E, 
+0xC1, 0xA9, 0x83, 0x36, 0xD7, 0x86, 0xFF, 0xC1, 
+0x0B, 0x05, 0x73, 0x86, 0x11, 0xFB, 0x48, 0xF4, 
+0xAD, 0x9E, 0xE8, 0xCB, 0x1A, 0x16, 0x0A, 0xFA, 
+0xE6, 0x64, 0x36, 0x99, 0xAE, 0x27, 0x9E, 0xA0, 
+0x60, 0x6E, 0xCD, 0xEA, 0x43, 0xC6, 0xFE, 0xF4, 
+0xBE, 0xA5, 0xA8, 0xDA, 0x1E, 0x35, 
+
+0x0D, 0x0B, 0x0E, 0xBC, 0x29, 0xC0, 0x75, 0x9D, 
+0x64, 0x70, 0xD9, 0x68, 0xEF, 0x1F, 0xBB, 0xF9, 
+0x2F, 0x1D, 0x13, 0x1F, 0x18, 0x32, 0xFA, 0x41, 
+0xED, 0x75, 0x04, 0x13, 0xAB, 0x02, 0x3B, 0xB3, 
+0x58, 0x19, 0xD4, 0x79, 0xF0, 0x1D, 0x62, 0x26, 
+0xC8, 0x66, 0x0D, 0x05, 0xFD, 0x09, 0xE4, 0x9E, 
+0x16, 0x7F, 0xF2, 0xC0, 0x97, 0x4A, 0x05, 
+
+0x76, 0x1C, 0x85, 0xF6, 0x8C, 0xE6, 0xAB, 0xA8, 
+0xBD, 0x25, 0x67, 0x33, 0x18, 0xA1, 0xA7, 0x18, 
+0xC9, 0x6E, 0x95, 0x35, 0x50, 0xC3, 0xD9, 0x52, 
+0x38, 0xF7, 0xBD, 0x1F, 0xFA, 0xA9, 0x49, 0xC1, 
+0x10, 0x97, 0x0C, 0xD8, 0x5B, 0xF6, 0x95, 0x90, 
+0x16, 0x37, 0xDA, 0xB8, 0xAB, 0xF8, 0x2F, 0xF0, 
+0x03, 0x35, 0x9F, 0xE1, 0x8D, 0xCE, 0xDA, 0x29, 
+
+0xFE, 0x1D, 0x19, 0x36, 0x3A, 0x7D, 0xCB, 0x15, 
+0x6A, 0x1E, 0x73, 0x37, 0xDF, 0x6C, 0x71, 0x62, 
+0x35, 0xDD, 0x28, 0x36, 0x21, 0xA7, 0x24, 0x4F, 
+0x5B, 0xCB, 0xAE, 0xDE, 0x3A, 0xFA, 0x62, 0x35, 
+0x3A, 0xA9, 0x86, 0x30, 0xC8, 0x6C, 0x0F, 0x95, 
+0x46, 0xD1, 0xAC, 0xB5, 0xAF, 0x86, 0xE0, 0xDD, 
+0xEA, 0x48, 0x82, 0xA5, 0xF1, 0xFA, 0xAC, 0xCA, 
+0x55, 
+
+0xAB, 0x9D, 0x45, 0x38, 0xD8, 0xA1, 0x75, 0x97, 
+0x19, 0xB9, 0x5F, 0x85, 0xE7, 0xD1, 0x8B, 0x35, 
+0x34, 0x7E, 0xA3, 0xC7, 0x8C, 0xA8, 0xB2, 0xD3, 
+0xD3, 0xEA, 0xD1, 0x4A, 0x95, 0x31, 0xEF, 0xD9, 
+0xE9, 0x39, 0x78, 0xC9, 0x8B, 0x1D, 0xC3, 0x91, 
+0xA2, 0x19, 0xD5, 0xEE, 0x5D, 0xC6, 0x9E, 0x4B, 
+0xDF, 0xFE, 0x3F, 0xB1, 0xD0, 0xCE, 0x2E, 0x90, 
+0xD1, 0xCD, 
+
+0xA1, 0xAD, 0xED, 0xBF, 0xE5, 0x79, 0x4A, 0x68, 
+0xDD, 0xCF, 0x07, 0xB7, 0x69, 0x14, 0xED, 0xC9, 
+0x83, 0x75, 0x28, 0x4F, 0x35, 0xAC, 0x3A, 0x6B, 
+0xBB, 0xE2, 0x53, 0xC8, 0xB9, 0x86, 0xA5, 0x00, 
+0x85, 0x60, 0x6D, 0x8F, 0x7F, 0x24, 0x30, 0xD5, 
+0x1E, 0xC0, 0xAF, 0x6A, 0x29, 0xC0, 0x62, 0x96, 
+0x8B, 0x10, 0x46, 0xBA, 0x0E, 0x33, 0x00, 0x1E, 
+0x5E, 0x79, 0x1B, 
+
+0x94, 0xEC, 0xD1, 0xB1, 0x4B, 0xA8, 0x1C, 0x4A, 
+0xFE, 0xF4, 0x3F, 0xA7, 0xD5, 0x15, 0xFC, 0x73, 
+0xDA, 0xF5, 0xAA, 0x37, 0xE6, 0x28, 0x80, 0x61, 
+0x27, 0x25, 0xC8, 0xB0, 0xD0, 0xCA, 0x54, 0xE3, 
+0xC8, 0xF1, 0xA1, 0xFB, 0xFE, 0xA2, 0x9F, 0x46, 
+0x2D, 0x0E, 0x3B, 0xA3, 0xB5, 0xF5, 0xD5, 0x32, 
+0xD3, 0x44, 0x3B, 0x20, 0xB3, 0x8B, 0xB9, 0x26, 
+0x4E, 0x02, 0x98, 0xE7, 
+
+0x5E, 0xC6, 0xF2, 0xB8, 0x62, 0xC5, 0x23, 0x82, 
+0xCF, 0xC4, 0xEA, 0xF2, 0x3D, 0xEB, 0xE9, 0x6F, 
+0x44, 0xD2, 0x0E, 0x07, 0x8D, 0x4B, 0x50, 0xA7, 
+0x2E, 0x16, 0x4C, 0x82, 0x56, 0x22, 0xA2, 0xE9, 
+0xFC, 0xA3, 0x85, 0xB4, 0xCD, 0x80, 0x39, 0x53, 
+0xB1, 0x92, 0xCF, 0xFB, 0x85, 0x1A, 0x74, 0x5F, 
+0xA3, 0xCF, 0x2C, 0x49, 0xFD, 0x7F, 0x22, 0xD4, 
+0x84, 0x75, 0x89, 0x1A, 0xC4, 
+
+0xF7, 0xC1, 0x7A, 0xA0, 0xB5, 0x7D, 0xA1, 0x6F, 
+0x81, 0xA2, 0x01, 0xF1, 0x2E, 0xCB, 0xA3, 0x5B, 
+0x7E, 0xE9, 0xD4, 0x49, 0x01, 0x6C, 0x3A, 0x67, 
+0xE2, 0xF0, 0x9A, 0xED, 0xBA, 0xA9, 0xB7, 0xC5, 
+0x07, 0x63, 0x5C, 0x90, 0xBC, 0x38, 0x70, 0x4C, 
+0x95, 0xF2, 0xD6, 0x6B, 0xD9, 0x17, 0x28, 0xD3, 
+0x50, 0xC9, 0x04, 0xC7, 0xD5, 0xEE, 0xD4, 0x5D, 
+0x4A, 0xB0, 0x80, 0xB5, 0xD8, 0x1C, 
+
+0x6C, 0x5B, 0xF6, 0xF4, 0x7C, 0x8B, 0x21, 0x37, 
+0x52, 0x6F, 0x5D, 0xC4, 0x28, 0x8E, 0xCE, 0x18, 
+0x20, 0x37, 0x39, 0x16, 0x39, 0x2E, 0xF7, 0xAA, 
+0xD6, 0xFB, 0x8C, 0x4A, 0x50, 0xD3, 0xF7, 0x3E, 
+0xAE, 0xE9, 0x45, 0x3C, 0x70, 0x24, 0xE2, 0xBB, 
+0x39, 0xE0, 0x89, 0x96, 0x51, 0xF8, 0x10, 0xEB, 
+0x16, 0x17, 0xF5, 0x7F, 0x66, 0xB6, 0x38, 0x21, 
+0x3B, 0x7E, 0xA7, 0x7A, 0xCA, 0x1E, 0xE8, 
+
+0x53, 0xC0, 0x19, 0x2B, 0x14, 0x7F, 0x23, 0xB3, 
+0x47, 0xBC, 0xB0, 0x0C, 0x15, 0xF9, 0x9B, 0x68, 
+0x24, 0xDB, 0xA2, 0xFA, 0x00, 0x76, 0x47, 0x26, 
+0xB1, 0xD4, 0xDE, 0xAB, 0x40, 0x7E, 0x84, 0xD4, 
+0xC5, 0xB2, 0x6D, 0x2B, 0x0C, 0xDA, 0x85, 0x53, 
+0x60, 0x75, 0xA5, 0x10, 0xC3, 0xCE, 0xDF, 0x38, 
+0xA1, 0xB2, 0x3F, 0x2C, 0x75, 0xB9, 0x73, 0x73, 
+0x33, 0xB5, 0x57, 0xA9, 0xD8, 0xA1, 0x20, 0xF0, 
+
+0x12, 0x42, 0x3B, 0x0A, 0x62, 0x53, 0x92, 0xE4, 
+0x9E, 0xA2, 0x25, 0xF0, 0xD4, 0xF0, 0xA8, 0x19, 
+0x38, 0x63, 0x4C, 0xC5, 0x44, 0x6E, 0x4B, 0xCB, 
+0x0D, 0xFA, 0x1D, 0x1E, 0xC1, 0x53, 0x06, 0xD3, 
+0x9D, 0xBA, 0x92, 0x60, 0x08, 0x9C, 0x8E, 0xE5, 
+0x60, 0xD7, 0x9D, 0x65, 0x87, 0x64, 0x73, 0x27, 
+0x1C, 0x67, 0x84, 0x61, 0x50, 0x72, 0xDB, 0xDF, 
+0x18, 0x37, 0xDE, 0xD0, 0x02, 0x4F, 0xF5, 0x27, 
+0xEA, 
+
+0x78, 0xD6, 0xCC, 0x24, 0x71, 0x89, 0xDF, 0x27, 
+0xAA, 0x2E, 0xF4, 0x47, 0xFC, 0x90, 0x5D, 0x57, 
+0x17, 0x5F, 0xA1, 0x3B, 0xDA, 0xC6, 0xDB, 0xB4, 
+0x17, 0x25, 0x18, 0x7A, 0x99, 0x79, 0xCA, 0x7C, 
+0x41, 0x1B, 0xB9, 0xBC, 0x30, 0xA8, 0xA6, 0xB4, 
+0x2A, 0x89, 0x97, 0x1E, 0xCA, 0xBF, 0xEA, 0x23, 
+0x45, 0x18, 0x73, 0x53, 0xD9, 0x2A, 0x2D, 0x51, 
+0x5A, 0x64, 0x42, 0x2C, 0x94, 0xC3, 0xFB, 0x75, 
+0xE9, 0x1C, 
+
+0x1A, 0xAB, 0x5A, 0x19, 0x29, 0x38, 0x9E, 0xFA, 
+0x8C, 0x61, 0xAF, 0x98, 0x87, 0x00, 0xE3, 0x9A, 
+0xCB, 0xA4, 0x7C, 0x41, 0x98, 0x62, 0x1E, 0x59, 
+0xE3, 0xC2, 0xF5, 0x3C, 0x31, 0xB2, 0xB6, 0xAF, 
+0xCD, 0x03, 0x2F, 0x7D, 0xFB, 0xEC, 0x9F, 0x94, 
+0x72, 0x59, 0xA7, 0xD4, 0x30, 0xEF, 0x7B, 0x10, 
+0x8F, 0x95, 0x66, 0xBA, 0xEE, 0x8D, 0xDB, 0x95, 
+0x86, 0x2D, 0xFA, 0xC8, 0x5F, 0x0B, 0xD4, 0x28, 
+0xA6, 0x07, 0x21, 
+
+0xC2, 0x1F, 0x42, 0xF3, 0xA0, 0x6D, 0x68, 0x96, 
+0x14, 0xEE, 0xF0, 0xDC, 0x50, 0x0F, 0x6E, 0x41, 
+0x34, 0x72, 0x09, 0x30, 0x9E, 0x39, 0xDA, 0x25, 
+0x6A, 0x50, 0x7D, 0xBF, 0xB7, 0xE8, 0xEF, 0x8F, 
+0xEF, 0xD6, 0x3D, 0xBD, 0x68, 0x7A, 0x99, 0xF8, 
+0x06, 0x6F, 0x7F, 0x32, 0xD2, 0xA8, 0x67, 0x4A, 
+0xFF, 0xAA, 0x65, 0x3C, 0xA4, 0x58, 0x17, 0x51, 
+0x34, 0x92, 0xBE, 0x2C, 0xBD, 0x16, 0x97, 0x44, 
+0x68, 0x20, 0x93, 0x28, 
+
+0x58, 0xDF, 0x45, 0x5F, 0x1C, 0xA5, 0x67, 0x9F, 
+0x99, 0xB9, 0x72, 0x52, 0x1E, 0xB2, 0x9F, 0xDC, 
+0x32, 0x5D, 0x1E, 0x00, 0xC4, 0x62, 0x7A, 0x44, 
+0xD0, 0x4D, 0xFD, 0xA3, 0x6D, 0x44, 0xEA, 0x1B, 
+0xB8, 0x84, 0xD4, 0xC3, 0xDC, 0xC3, 0x49, 0x43, 
+0xD0, 0xE7, 0x0B, 0x3E, 0x54, 0x97, 0x86, 0x6A, 
+0x86, 0x0A, 0xC6, 0xD7, 0x23, 0x38, 0xA8, 0x83, 
+0x99, 0x33, 0x13, 0xC8, 0x8B, 0xE9, 0x02, 0xB5, 
+0x8B, 0x10, 0x46, 0x30, 0x43, 
+
+0xE2, 0x3D, 0x0C, 0xF3, 0x8A, 0x71, 0x40, 0x63, 
+0x37, 0x2A, 0xA2, 0x84, 0x0A, 0x2E, 0x7D, 0x36, 
+0x42, 0x9F, 0x42, 0x4A, 0x96, 0x16, 0x6E, 0xB1, 
+0xDE, 0x30, 0x46, 0x06, 0x46, 0x6E, 0xE1, 0x2F, 
+0x35, 0x24, 0x71, 0x74, 0xD8, 0xAF, 0x47, 0x63, 
+0xD9, 0x65, 0xBD, 0xFF, 0xFC, 0x04, 0xDE, 0xE2, 
+0xEF, 0x2C, 0x99, 0xF9, 0xEE, 0xD6, 0x00, 0xD8, 
+0x40, 0x63, 0x2C, 0xB0, 0x94, 0xED, 0x6D, 0xE9, 
+0x1A, 0xF7, 0xF8, 0x70, 0xD1, 0xD0, 
+
+0xD5, 0x5D, 0xCC, 0xFB, 0xFB, 0xC4, 0x1E, 0xEB, 
+0x26, 0xED, 0x3E, 0x02, 0xF0, 0x2E, 0x06, 0x26, 
+0x8B, 0x12, 0x10, 0x51, 0x5B, 0x22, 0x14, 0x73, 
+0xDD, 0x0F, 0xF8, 0x30, 0x88, 0xB3, 0x2C, 0xE6, 
+0xAF, 0x25, 0x28, 0x42, 0x21, 0x22, 0x94, 0xDC, 
+0xD2, 0xFD, 0x42, 0x22, 0xF7, 0x64, 0xE8, 0xC6, 
+0x20, 0x9C, 0xF2, 0x10, 0x24, 0x01, 0xAE, 0x61, 
+0xC8, 0xB8, 0x42, 0x93, 0x2E, 0x3B, 0xFB, 0xF4, 
+0x3F, 0x82, 0x17, 0x3A, 0x7C, 0xC8, 0x73, 
+
+0x49, 0xAC, 0x4E, 0xAC, 0xD4, 0x06, 0x55, 0x27, 
+0x61, 0xD1, 0xAE, 0xB1, 0x16, 0x57, 0x08, 0xE7, 
+0x06, 0x6D, 0x3D, 0x14, 0xFF, 0x8A, 0x20, 0xF4, 
+0x8E, 0x55, 0xD2, 0x01, 0x75, 0xF1, 0x1A, 0xE5, 
+0xC5, 0x0F, 0x14, 0xCB, 0xFC, 0x39, 0x3D, 0x1F, 
+0xE6, 0xB9, 0xE4, 0xBB, 0x10, 0xBD, 0x31, 0x61, 
+0x3B, 0xA8, 0x66, 0x5C, 0x5D, 0x49, 0x06, 0x82, 
+0xC2, 0x24, 0xB0, 0x8B, 0x51, 0x16, 0x17, 0xDD, 
+0x12, 0xB4, 0x8B, 0x8F, 0x28, 0x12, 0x2B, 0x8B, 
+
+0x0E, 0x6A, 0xA6, 0xFD, 0x8D, 0x9E, 0x2A, 0x46, 
+0x99, 0xDF, 0x9C, 0xBA, 0x98, 0x81, 0xE3, 0x4B, 
+0x53, 0x94, 0x21, 0x0D, 0xC6, 0xEF, 0x8A, 0xAC, 
+0x11, 0xD1, 0x4F, 0x9C, 0xA3, 0x33, 0xE0, 0x51, 
+0x11, 0xF8, 0x35, 0x18, 0xF7, 0xB0, 0x28, 0x2B, 
+0x70, 0xC4, 0xD0, 0x17, 0x1C, 0x58, 0x89, 0x2F, 
+0xBB, 0xAD, 0x06, 0x32, 0x40, 0x0D, 0xE5, 0x5C, 
+0x18, 0x7F, 0x5A, 0x48, 0x7F, 0x64, 0xEF, 0x91, 
+0x79, 0x18, 0x61, 0x01, 0x6E, 0x91, 0x59, 0xC4, 
+0x50, 
+
+0xCA, 0xB2, 0xF4, 0x9C, 0xF0, 0xEE, 0x88, 0x3E, 
+0x0E, 0x70, 0xB0, 0xE0, 0xBA, 0xAB, 0xC6, 0x5F, 
+0x62, 0x07, 0xD7, 0x84, 0xEB, 0x48, 0x08, 0x27, 
+0xB5, 0x57, 0x40, 0xA9, 0x5C, 0x3E, 0x63, 0xBB, 
+0xB9, 0x75, 0xD0, 0x67, 0x82, 0x35, 0x2B, 0x5A, 
+0x6A, 0xB2, 0x9D, 0x33, 0x58, 0x7C, 0x25, 0x8A, 
+0x66, 0xA0, 0x58, 0x15, 0xF5, 0x2F, 0x6A, 0x41, 
+0xFC, 0x2F, 0xEB, 0x48, 0x68, 0x05, 0x98, 0xDD, 
+0x29, 0x30, 0x60, 0x54, 0xC6, 0xD7, 0x99, 0xBB, 
+0x94, 0xC2, 
+
+0x7E, 0xEF, 0xF3, 0x55, 0x42, 0x1C, 0x00, 0x72, 
+0x08, 0x2B, 0xBD, 0x1D, 0x11, 0xA0, 0x49, 0x6F, 
+0xA1, 0x4C, 0xCF, 0x74, 0x4E, 0xF1, 0xD1, 0x6D, 
+0x18, 0x46, 0x4F, 0x13, 0x8A, 0x37, 0xBA, 0x68, 
+0xC4, 0xD7, 0xA0, 0x5F, 0x53, 0x6B, 0xDD, 0xA5, 
+0x05, 0xB5, 0x6E, 0xEA, 0x2A, 0x22, 0x9B, 0x6D, 
+0x4B, 0xEB, 0x44, 0x78, 0xEE, 0x77, 0x9F, 0x1D, 
+0xC3, 0xD9, 0x78, 0x57, 0xCD, 0xE0, 0x33, 0xB6, 
+0x33, 0x7C, 0xA2, 0x4E, 0xFA, 0xBA, 0xC1, 0x68, 
+0x4D, 0x3E, 0x7C, 
+
+0x17, 0x5A, 0xD6, 0x31, 0x69, 0xA2, 0x9E, 0x3A, 
+0x60, 0x41, 0x30, 0x14, 0x1C, 0x7F, 0x56, 0xF8, 
+0xF9, 0x80, 0x69, 0xDE, 0xB0, 0xF2, 0x97, 0x5F, 
+0x35, 0xE2, 0x3A, 0x64, 0xFF, 0x6D, 0x48, 0x4E, 
+0x05, 0x89, 0x89, 0xDB, 0xD4, 0x9E, 0x62, 0x39, 
+0x31, 0xA1, 0x33, 0x0C, 0xAA, 0xD0, 0x71, 0x36, 
+0x07, 0x44, 0x8F, 0x5B, 0x07, 0xA6, 0x93, 0x45, 
+0x24, 0xE8, 0x0D, 0x9F, 0xEA, 0x55, 0xE6, 0x78, 
+0x45, 0xB1, 0xF9, 0x10, 0xD5, 0xA3, 0xC3, 0xE6, 
+0xFF, 0x32, 0x54, 0xBF, 
+
+0x2C, 0x0A, 0x29, 0x8A, 0x21, 0x40, 0x1C, 0xE8, 
+0x76, 0xED, 0x28, 0x11, 0x76, 0x31, 0x9E, 0xAC, 
+0xAC, 0x50, 0x5C, 0xA9, 0xEE, 0x1F, 0x70, 0x38, 
+0xAB, 0x5F, 0xE8, 0x3F, 0x49, 0x38, 0x2D, 0x38, 
+0x97, 0xFC, 0xE2, 0x49, 0x33, 0xFC, 0x25, 0x81, 
+0xD9, 0xC7, 0x4C, 0xA4, 0xE0, 0x58, 0xFA, 0x80, 
+0xE7, 0xB4, 0xEC, 0xD8, 0xC3, 0x3F, 0x49, 0x99, 
+0x5F, 0x17, 0xD1, 0x9C, 0x50, 0xF5, 0xE6, 0xA8, 
+0xFE, 0xE5, 0xF9, 0x20, 0x4B, 0x6B, 0x4B, 0x8A, 
+0x65, 0x25, 0x40, 0x1F, 0xFC, 
+
+0x87, 0xE5, 0xD8, 0x37, 0xAE, 0xE5, 0x35, 0xAD, 
+0x6B, 0x0E, 0x75, 0x7F, 0x64, 0x6D, 0xFF, 0xC8, 
+0x3F, 0x08, 0x5F, 0xC0, 0x8C, 0xB3, 0x7B, 0x05, 
+0xF9, 0x7B, 0xDE, 0x09, 0x1F, 0xF3, 0x85, 0xD2, 
+0xF4, 0x57, 0x09, 0x1E, 0xC8, 0x5F, 0xD4, 0xB6, 
+0x25, 0xF1, 0x1E, 0x40, 0xA7, 0x54, 0x11, 0x5F, 
+0xC3, 0x27, 0xAB, 0x07, 0x67, 0x2E, 0x47, 0x78, 
+0x23, 0x4E, 0x58, 0x18, 0x8E, 0xE7, 0x92, 0x24, 
+0x2F, 0x09, 0xE0, 0xEF, 0xD5, 0xF6, 0x88, 0x81, 
+0x09, 0x66, 0x7D, 0x8F, 0xC9, 0xB2, 
+
+0xDD, 0xBC, 0xBA, 0x25, 0xA6, 0xC1, 0x18, 0x4A, 
+0x11, 0xB6, 0xEF, 0x5D, 0x4E, 0x00, 0x2B, 0x50, 
+0x4A, 0x47, 0x08, 0x86, 0x1B, 0x23, 0x15, 0x88, 
+0x9A, 0xDA, 0xDE, 0x15, 0x17, 0x03, 0x4F, 0xF4, 
+0x81, 0x86, 0x8B, 0xB6, 0x29, 0x91, 0x4A, 0x75, 
+0x43, 0x10, 0xA9, 0xC3, 0x0B, 0xBF, 0x65, 0xC4, 
+0x52, 0x06, 0x4A, 0x6A, 0x5C, 0xDD, 0x29, 0x39, 
+0xB1, 0x76, 0xA7, 0x25, 0xE6, 0xF3, 0x33, 0xEE, 
+0xDC, 0x75, 0xF6, 0xDF, 0xE1, 0xAE, 0xC6, 0x99, 
+0xBB, 0xED, 0x33, 0x38, 0x9F, 0x2E, 0x44, 
+
+0x28, 0x25, 0x65, 0x0A, 0x07, 0x64, 0xF5, 0xEF, 
+0xB8, 0xFE, 0xB6, 0x4A, 0x41, 0x15, 0x85, 0xB3, 
+0x59, 0x3B, 0xC7, 0x96, 0xAE, 0xC0, 0x86, 0xC0, 
+0x4A, 0x0E, 0x55, 0x70, 0x48, 0xA7, 0xD7, 0xD0, 
+0x63, 0x6A, 0x88, 0x76, 0xC0, 0xA0, 0x78, 0x5B, 
+0x33, 0x18, 0xE4, 0xFE, 0x31, 0xE5, 0xC3, 0x2A, 
+0x76, 0x8B, 0x04, 0xF9, 0x3A, 0x00, 0x54, 0x7D, 
+0x29, 0xF5, 0xE3, 0x7E, 0xDF, 0xA8, 0x2A, 0x63, 
+0x0B, 0x41, 0x5E, 0x10, 0x39, 0x87, 0x82, 0x9A, 
+0xD8, 0x38, 0xF7, 0x48, 0x28, 0x54, 0xD4, 0x65, 
+
+0x6E, 0xC1, 0xA0, 0xEB, 0x32, 0x94, 0x2C, 0x3B, 
+0x8F, 0xF1, 0xDC, 0x75, 0x02, 0x4F, 0xF0, 0x5B, 
+0x25, 0xE6, 0x51, 0x0C, 0x30, 0x02, 0xB8, 0xBB, 
+0x84, 0x29, 0xEB, 0xAC, 0x73, 0x2B, 0x1B, 0x67, 
+0x57, 0xDA, 0x1C, 0x3E, 0xA5, 0x57, 0x5C, 0x12, 
+0x39, 0x86, 0x54, 0x28, 0xA4, 0x76, 0xC0, 0x34, 
+0x29, 0xD3, 0x68, 0x8A, 0xC3, 0x63, 0x3E, 0x9E, 
+0xD4, 0x8D, 0xB6, 0x1B, 0x6D, 0xF4, 0xAD, 0xDA, 
+0x59, 0x7E, 0x0E, 0x3E, 0x10, 0xC5, 0x5F, 0x87, 
+0xAB, 0xB4, 0x40, 0xA5, 0x83, 0x4D, 0xB2, 0x8E, 
+0x81, 
+
+0xE4, 0xA5, 0xBB, 0x99, 0xAF, 0x54, 0xE9, 0xDA, 
+0xDA, 0x30, 0xAB, 0x5D, 0x03, 0xB5, 0x09, 0x22, 
+0xCD, 0x0E, 0xA1, 0x6E, 0x9B, 0x55, 0x99, 0xEE, 
+0xCF, 0xC5, 0xAA, 0x31, 0xE4, 0x4C, 0xC6, 0xB4, 
+0x2D, 0xBE, 0xA8, 0x1E, 0x2E, 0xE3, 0x67, 0x44, 
+0x37, 0x86, 0xD5, 0x67, 0x53, 0xBA, 0x3F, 0x92, 
+0x5F, 0x16, 0x04, 0x64, 0x2C, 0xB7, 0x79, 0x87, 
+0xF6, 0x16, 0xE1, 0x39, 0x23, 0xB9, 0x71, 0xA8, 
+0xF1, 0xD9, 0x27, 0x5F, 0xC0, 0x00, 0x00, 0x8C, 
+0x3F, 0x63, 0x3D, 0xB5, 0x00, 0x4E, 0xFD, 0x27, 
+0x62, 0x0C, 
+
+0xE2, 0xED, 0xB6, 0xFC, 0x37, 0xA3, 0x01, 0x74, 
+0xCB, 0x33, 0x73, 0x9E, 0x16, 0x39, 0x32, 0x5D, 
+0xDA, 0x6D, 0x89, 0x58, 0x13, 0x58, 0x33, 0xE4, 
+0xB8, 0x81, 0x46, 0xEE, 0x79, 0x2D, 0xBE, 0xDF, 
+0x34, 0xC5, 0xD8, 0x09, 0x8E, 0x09, 0x79, 0x0C, 
+0x94, 0x57, 0x32, 0xB3, 0x28, 0x60, 0x9B, 0xC2, 
+0xB7, 0x5F, 0xBE, 0xDE, 0x58, 0xA0, 0xD4, 0xA8, 
+0x0C, 0x50, 0x64, 0x19, 0xC9, 0x29, 0x5F, 0x4D, 
+0x5E, 0xBE, 0xEB, 0xC5, 0x1A, 0x3D, 0xED, 0x13, 
+0x9C, 0xA6, 0x95, 0xA8, 0x64, 0x2F, 0x7E, 0x96, 
+0xCB, 0xCE, 0x66, 
+
+0xE7, 0x5A, 0x33, 0xC7, 0xC1, 0x30, 0x2D, 0xEE, 
+0x28, 0x64, 0x20, 0x66, 0xE3, 0x22, 0x3B, 0x1F, 
+0x7B, 0x10, 0x8F, 0xFB, 0x12, 0xF5, 0xC2, 0x99, 
+0x6A, 0x40, 0x6D, 0x3D, 0x09, 0xBD, 0xB5, 0x70, 
+0x68, 0x91, 0xEA, 0x31, 0xEB, 0xB1, 0xA7, 0xBC, 
+0xE9, 0x50, 0xE7, 0xFF, 0x13, 0x40, 0xA3, 0x2C, 
+0x08, 0xE1, 0x70, 0x4B, 0x83, 0xED, 0x8E, 0x66, 
+0x29, 0x6F, 0xA4, 0x40, 0x75, 0x73, 0xF2, 0x7D, 
+0x69, 0x57, 0x3F, 0xDB, 0x80, 0x16, 0xF2, 0x5B, 
+0x07, 0x53, 0xED, 0x5C, 0x72, 0x34, 0xC2, 0x9F, 
+0x18, 0x00, 0xE4, 0x5B, 
+
+0x61, 0xBC, 0x8A, 0xEB, 0x07, 0xC7, 0xF1, 0x37, 
+0x05, 0xA3, 0x65, 0x25, 0x54, 0x70, 0xB7, 0xC7, 
+0x88, 0xE8, 0x87, 0x26, 0xFA, 0x5F, 0x38, 0x8A, 
+0x9E, 0x08, 0x07, 0x14, 0x28, 0x16, 0xE5, 0x74, 
+0xBE, 0x14, 0x62, 0x81, 0xB0, 0xF4, 0x84, 0xDA, 
+0xD0, 0xF6, 0xB0, 0x60, 0x88, 0x36, 0xB4, 0x01, 
+0x19, 0x40, 0x57, 0x1A, 0x05, 0xDA, 0xD8, 0x35, 
+0x17, 0x7A, 0x01, 0x5D, 0x78, 0xD0, 0xFC, 0xE8, 
+0x19, 0x2D, 0x63, 0xC2, 0xCC, 0x33, 0x95, 0x39, 
+0xE8, 0x43, 0x8C, 0x3A, 0x8E, 0x53, 0x8A, 0xD2, 
+0x16, 0x40, 0x4B, 0x42, 0x20, 
+
+0xE2, 0xBF, 0xD2, 0x43, 0x32, 0x11, 0x07, 0xC5, 
+0xF0, 0x37, 0xC6, 0xA6, 0x7D, 0x4D, 0x62, 0xEF, 
+0x28, 0x29, 0x92, 0x5B, 0x68, 0x59, 0x5C, 0xC3, 
+0x27, 0xF2, 0x3E, 0xF5, 0xAA, 0xD2, 0xF0, 0x0B, 
+0x3E, 0x5B, 0xA7, 0xFF, 0x2F, 0x5D, 0x76, 0xD6, 
+0x71, 0xCD, 0x91, 0xC1, 0x32, 0xBF, 0x4B, 0xA0, 
+0x91, 0x37, 0xF4, 0xBE, 0xA9, 0x00, 0x8E, 0xE7, 
+0xEE, 0xC7, 0x79, 0x92, 0x9E, 0x05, 0x33, 0x1D, 
+0x21, 0x08, 0x54, 0xB4, 0x03, 0xC1, 0xA1, 0xF3, 
+0x12, 0xA0, 0x56, 0x80, 0x5A, 0x4E, 0x8C, 0x9C, 
+0x9A, 0x0A, 0x00, 0xC3, 0x63, 0x33, 
+
+0x41, 0x37, 0x4B, 0xE9, 0x11, 0x07, 0x02, 0x22, 
+0x71, 0xAA, 0xCD, 0x9E, 0xC6, 0x66, 0xE7, 0x15, 
+0xC1, 0xCE, 0x2B, 0x5C, 0xFF, 0xA3, 0xB7, 0x39, 
+0x27, 0x0B, 0x33, 0xFA, 0xFA, 0x03, 0xE4, 0x49, 
+0xAC, 0x1F, 0xE3, 0xD3, 0xF9, 0xA6, 0x3D, 0x68, 
+0x51, 0xA5, 0x07, 0xF4, 0x22, 0x5C, 0xD8, 0xE7, 
+0xA7, 0xF6, 0xB6, 0x0B, 0xCF, 0x15, 0x48, 0xED, 
+0x68, 0x16, 0xA2, 0xB6, 0x06, 0xA0, 0x3D, 0xA9, 
+0xBC, 0x5E, 0x1C, 0x15, 0xD0, 0x2B, 0xE9, 0x3B, 
+0x79, 0x30, 0xC8, 0x31, 0xDC, 0x33, 0xEF, 0xB4, 
+0xEF, 0xE1, 0x07, 0x7F, 0x5C, 0xFE, 0xE6, 
+
+0xFC, 0x59, 0xCA, 0xFD, 0x5F, 0x7D, 0x9F, 0xAC, 
+0x15, 0x4C, 0x04, 0x20, 0x32, 0xF4, 0x6B, 0xFB, 
+0xF7, 0x7B, 0x70, 0x88, 0x7D, 0xC0, 0xD3, 0x3B, 
+0x5D, 0x0E, 0x65, 0x2C, 0x7E, 0x0F, 0xAE, 0xBD, 
+0x97, 0x3B, 0x95, 0x27, 0x29, 0xCD, 0xD5, 0x40, 
+0x89, 0x3A, 0xD9, 0x67, 0xE4, 0x71, 0x0A, 0x2B, 
+0xE0, 0x29, 0x53, 0xE8, 0xDA, 0x2C, 0xE0, 0xC3, 
+0x64, 0x39, 0xB8, 0x3C, 0x06, 0x25, 0x14, 0xF5, 
+0x06, 0x00, 0xF2, 0xF1, 0x6E, 0x6D, 0xED, 0x71, 
+0xB5, 0x46, 0xE2, 0x57, 0xC0, 0xEB, 0x20, 0xA7, 
+0x8D, 0x0C, 0xB4, 0x5E, 0x80, 0x69, 0x3D, 0x0B, 
+
+0x3A, 0xB7, 0x25, 0xAC, 0xB9, 0x03, 0x0A, 0xCC, 
+0x37, 0x54, 0x05, 0xCD, 0x28, 0x11, 0x70, 0x7D, 
+0xCC, 0x27, 0xAA, 0x79, 0x8F, 0x1A, 0x99, 0x7D, 
+0xD7, 0xFF, 0x63, 0x94, 0x00, 0x89, 0xF8, 0xFD, 
+0xB0, 0x44, 0xD6, 0x37, 0x5B, 0x3F, 0x2C, 0xC8, 
+0x2D, 0x90, 0xBB, 0x22, 0x82, 0x2F, 0xAD, 0xAE, 
+0x82, 0xC8, 0xB2, 0x05, 0x5C, 0x7F, 0x84, 0x33, 
+0x2C, 0xEE, 0xF0, 0x35, 0xFB, 0x22, 0x68, 0x7B, 
+0x4A, 0x5F, 0x04, 0x36, 0xA9, 0x90, 0xCC, 0xA6, 
+0x91, 0xC7, 0x85, 0xB0, 0x2A, 0xFC, 0x71, 0xE8, 
+0x0D, 0x39, 0x0A, 0xB8, 0xDD, 0x7F, 0x33, 0x26, 
+0x8B, 
+
+0xB3, 0xDA, 0x6E, 0x94, 0x37, 0xB8, 0x82, 0x3D, 
+0xA3, 0x03, 0x93, 0x5B, 0x78, 0x57, 0x56, 0xD6, 
+0x50, 0xD3, 0xAF, 0x44, 0xFF, 0x81, 0xAA, 0xFA, 
+0x6C, 0x3A, 0x06, 0xAF, 0x12, 0x26, 0x6D, 0x7E, 
+0x34, 0xD0, 0x5F, 0x71, 0x50, 0xD1, 0x79, 0xA5, 
+0xEC, 0x10, 0x63, 0xEA, 0x8D, 0x53, 0xF0, 0xF3, 
+0xA7, 0x44, 0x32, 0xB8, 0x8D, 0x4E, 0xB0, 0x4E, 
+0x4D, 0x81, 0x2B, 0x27, 0xCA, 0x64, 0x5E, 0x3E, 
+0x54, 0x16, 0xF9, 0xA3, 0x05, 0x75, 0x15, 0x77, 
+0x33, 0x26, 0x10, 0xC5, 0x7F, 0xF4, 0xCD, 0xF4, 
+0x24, 0x96, 0x0C, 0x64, 0xF9, 0xD2, 0x6D, 0x94, 
+0x58, 0x1D, 
+
+0xF2, 0x2E, 0xC7, 0x8D, 0x99, 0x4E, 0x21, 0x9D, 
+0x50, 0xA9, 0x7C, 0x66, 0x67, 0x4E, 0xC2, 0x6C, 
+0x65, 0x5D, 0x36, 0x54, 0x44, 0x1D, 0x7B, 0x07, 
+0xD1, 0xFF, 0xE6, 0x39, 0x10, 0xE2, 0xE5, 0xBB, 
+0x9A, 0xE9, 0xF8, 0x7C, 0x2D, 0x23, 0x34, 0xAA, 
+0x52, 0x9D, 0xA0, 0x92, 0x77, 0x43, 0xDF, 0x0A, 
+0x7C, 0xAC, 0xBE, 0x9A, 0x7F, 0xC7, 0x36, 0x82, 
+0x72, 0xB8, 0x37, 0x8F, 0x8D, 0x72, 0x65, 0x2A, 
+0x95, 0xFF, 0xB8, 0xB6, 0xE6, 0x88, 0x42, 0x29, 
+0x8A, 0xE1, 0x0D, 0x17, 0x66, 0x58, 0x19, 0x0A, 
+0x17, 0x81, 0xDF, 0x7A, 0x78, 0x94, 0xF0, 0x81, 
+0xE6, 0x6F, 0x53, 
+
+0xB1, 0x4F, 0x4B, 0xF6, 0x8F, 0xB4, 0xC6, 0x93, 
+0x1E, 0xD8, 0x78, 0xE7, 0xD8, 0xEE, 0x05, 0x06, 
+0x3A, 0x63, 0x97, 0x6C, 0x9A, 0x5C, 0xAF, 0x87, 
+0x4A, 0x16, 0xFC, 0x50, 0xEA, 0x2D, 0xDF, 0xC1, 
+0xC3, 0x70, 0x4D, 0xA4, 0x9F, 0xBC, 0x2D, 0x0A, 
+0xC7, 0x38, 0xE2, 0xFE, 0xF4, 0x8E, 0xC2, 0x24, 
+0x6C, 0x86, 0xC8, 0x50, 0x27, 0x03, 0xA5, 0xFC, 
+0x4B, 0x61, 0x14, 0x5F, 0xD5, 0x8D, 0xAC, 0x89, 
+0x08, 0x64, 0x75, 0x63, 0xBC, 0x21, 0xC7, 0xBD, 
+0x1B, 0x97, 0x67, 0xAF, 0xFD, 0x55, 0xF2, 0x57, 
+0xFA, 0x4D, 0x9F, 0x39, 0xD6, 0x13, 0x7D, 0xBD, 
+0x00, 0x3A, 0x5E, 0xE3, 
+
+0xC3, 0x0F, 0xC9, 0x15, 0x1B, 0x48, 0xBE, 0xFB, 
+0x5F, 0x0D, 0xA2, 0xCD, 0x9B, 0xAC, 0xE2, 0x72, 
+0x06, 0x3B, 0xB4, 0xB6, 0x95, 0x1E, 0x51, 0xE8, 
+0xB6, 0xB6, 0x8E, 0x85, 0xAF, 0x3F, 0xF7, 0x30, 
+0x16, 0x2D, 0x4E, 0xCA, 0x4B, 0x70, 0x24, 0x10, 
+0x17, 0xCE, 0x01, 0x01, 0x82, 0xF2, 0x6E, 0xF4, 
+0xDE, 0x30, 0xEA, 0xBA, 0x1C, 0xA3, 0x79, 0x35, 
+0x67, 0x52, 0xCD, 0xFE, 0x59, 0xC5, 0xEE, 0xF4, 
+0x81, 0x12, 0x68, 0xCC, 0xAF, 0xE2, 0x25, 0x75, 
+0xBE, 0x27, 0x76, 0xAB, 0x08, 0xEE, 0x17, 0xAF, 
+0xBE, 0x59, 0x9D, 0x46, 0xA3, 0xE6, 0xDB, 0x41, 
+0xAF, 0x14, 0xD6, 0xEA, 0x2E, 
+
+0xE8, 0x71, 0x92, 0xEC, 0x06, 0x14, 0xDD, 0x62, 
+0x7E, 0x8F, 0x74, 0xCA, 0x55, 0xBC, 0x22, 0xB5, 
+0x8D, 0xF1, 0x7A, 0xF7, 0x50, 0x13, 0xD0, 0x2B, 
+0x66, 0xBB, 0x28, 0xD0, 0x7D, 0x58, 0xD9, 0x86, 
+0x3D, 0x7B, 0x40, 0x70, 0x03, 0xAA, 0xE7, 0xE4, 
+0xC9, 0xBC, 0x4C, 0x7E, 0x0F, 0xF2, 0xE2, 0x41, 
+0xEC, 0x5F, 0x66, 0x96, 0xC2, 0xA3, 0x70, 0xE5, 
+0xCA, 0xB9, 0xFF, 0xC2, 0x52, 0x77, 0x0D, 0x43, 
+0x41, 0x9D, 0x62, 0xA9, 0x79, 0xBF, 0x0B, 0xB3, 
+0x20, 0x4F, 0x88, 0x17, 0xC4, 0x55, 0x10, 0xEA, 
+0x2B, 0xCF, 0xEE, 0xB3, 0x48, 0xDA, 0x29, 0x01, 
+0x29, 0xB4, 0x64, 0xDC, 0xB2, 0x91, 
+
+0xEF, 0x73, 0x34, 0x26, 0xAA, 0x03, 0xA2, 0xA2, 
+0x0F, 0xE3, 0xBB, 0x8C, 0xD2, 0x71, 0xAA, 0x0B, 
+0x94, 0x69, 0xBB, 0x7A, 0x57, 0xB3, 0x71, 0x31, 
+0x7C, 0x48, 0xA8, 0xF1, 0x23, 0xB9, 0xDD, 0x68, 
+0x8F, 0x8F, 0x24, 0x6A, 0xD6, 0xD1, 0x27, 0xCE, 
+0xCB, 0x70, 0x15, 0x96, 0x90, 0xC9, 0xA9, 0xA2, 
+0xC9, 0xDB, 0x25, 0x7A, 0x96, 0x5E, 0xCD, 0xB8, 
+0x22, 0xE5, 0x7D, 0x29, 0x50, 0x41, 0x9D, 0xB6, 
+0x44, 0xE0, 0xED, 0xC0, 0x51, 0xC3, 0x76, 0x8A, 
+0xD4, 0x82, 0x1F, 0x7B, 0x9E, 0x46, 0x87, 0xDC, 
+0x26, 0x9F, 0x27, 0x01, 0xAB, 0xA9, 0x92, 0x43, 
+0xAA, 0x67, 0x14, 0x12, 0x6F, 0x69, 0x70, 
+
+0x0A, 0x61, 0xFB, 0x38, 0x65, 0xFA, 0x9C, 0x5A, 
+0x9A, 0x7B, 0x81, 0x1B, 0x45, 0xAE, 0x22, 0xA4, 
+0xBA, 0xED, 0xEB, 0x04, 0x6D, 0x2B, 0x09, 0x10, 
+0x0A, 0xA2, 0x85, 0xF7, 0x16, 0x02, 0x8A, 0x32, 
+0x9A, 0x4D, 0x6A, 0x2F, 0xDF, 0xE5, 0xB4, 0xD2, 
+0x03, 0x90, 0x66, 0x7A, 0xD7, 0x2D, 0x45, 0x16, 
+0x44, 0x52, 0xA1, 0xEE, 0x55, 0x8F, 0xE8, 0xDC, 
+0x30, 0x46, 0x04, 0xB2, 0x7F, 0xBE, 0x84, 0xA9, 
+0x96, 0x36, 0x41, 0x72, 0x7E, 0x8F, 0x9B, 0x81, 
+0xFC, 0x3F, 0x98, 0x6B, 0x29, 0xEE, 0x7F, 0xAC, 
+0xAA, 0x8A, 0x3F, 0xAC, 0xA1, 0x32, 0xA4, 0x3D, 
+0x9C, 0xD5, 0xB9, 0x8D, 0x1A, 0x13, 0xC9, 0xFD, 
+
+0x17, 0xD0, 0x73, 0xD3, 0x6C, 0x02, 0x4E, 0x78, 
+0x47, 0x7E, 0x78, 0x98, 0xAF, 0x5E, 0x1E, 0xC0, 
+0x94, 0x94, 0xC6, 0x3A, 0x8E, 0xC3, 0xC7, 0x67, 
+0x6E, 0x20, 0x10, 0x7B, 0xA0, 0x60, 0x38, 0xEC, 
+0xFE, 0xEC, 0x57, 0xB2, 0xD1, 0xB1, 0x0C, 0x63, 
+0x5D, 0x2E, 0xD0, 0x3B, 0x47, 0xCE, 0xA5, 0x8B, 
+0xBC, 0x05, 0xFF, 0xBE, 0xDD, 0xF9, 0x07, 0x2C, 
+0xF6, 0x8B, 0x6C, 0x70, 0x54, 0xDF, 0xE9, 0x44, 
+0x25, 0x4D, 0xE5, 0x17, 0x0F, 0xEC, 0xB0, 0x59, 
+0xFB, 0xE9, 0xF6, 0x14, 0x0C, 0xBB, 0x2B, 0x55, 
+0xCA, 0x85, 0xA2, 0x3D, 0xD3, 0x15, 0xD6, 0x58, 
+0x1F, 0xEF, 0xFA, 0xC8, 0x74, 0x15, 0xC8, 0xD9, 
+0x73, 
+
+0x20, 0x13, 0xF0, 0x23, 0x5A, 0x3D, 0x1E, 0x16, 
+0xEB, 0x6C, 0x1A, 0x28, 0x35, 0x59, 0x61, 0xEB, 
+0x5C, 0xA7, 0x40, 0x86, 0x1B, 0x18, 0xB6, 0x81, 
+0x0E, 0xB8, 0x3B, 0xBB, 0x4A, 0x9A, 0xCC, 0xF4, 
+0xB3, 0x98, 0x6C, 0xDC, 0x57, 0xFE, 0xE8, 0x21, 
+0xCF, 0x7E, 0xA9, 0x87, 0xED, 0xDB, 0xB9, 0x71, 
+0x12, 0xE3, 0x54, 0x48, 0x6D, 0x83, 0xEE, 0x20, 
+0x4F, 0x69, 0x8C, 0x9D, 0x88, 0xEE, 0x8D, 0x04, 
+0x37, 0x60, 0x2A, 0xD2, 0x25, 0x0A, 0x0C, 0xC5, 
+0xE8, 0x02, 0x3F, 0xEF, 0xAF, 0xDC, 0x98, 0xEA, 
+0x87, 0xE8, 0xED, 0xEF, 0xAC, 0x45, 0x07, 0xA6, 
+0x66, 0x55, 0xAC, 0x8A, 0x8D, 0xAD, 0xBF, 0x1A, 
+0xB1, 0x5C, 
+
+0x7A, 0xD3, 0x10, 0x0F, 0xB6, 0x03, 0x1B, 0x55, 
+0x6A, 0x3F, 0x73, 0xA9, 0x00, 0x17, 0x5A, 0x16, 
+0x80, 0xA3, 0x5A, 0x1D, 0xE3, 0xB5, 0x67, 0xB1, 
+0xB6, 0x61, 0xF0, 0x65, 0x85, 0x5C, 0x1E, 0x31, 
+0xE8, 0x53, 0x58, 0xE5, 0x43, 0x19, 0x4D, 0x87, 
+0x84, 0x71, 0xFD, 0x05, 0x8D, 0x62, 0xE9, 0xA5, 
+0xC0, 0xCF, 0xCD, 0x5D, 0xCE, 0xBB, 0x87, 0x88, 
+0xFE, 0x09, 0x71, 0x6C, 0x62, 0xB6, 0x3C, 0x2C, 
+0xA9, 0x4E, 0x5D, 0x91, 0xB3, 0xCD, 0x6F, 0x2F, 
+0x03, 0xD2, 0xC9, 0xA5, 0x9C, 0xE8, 0x25, 0x3E, 
+0x46, 0xF2, 0x90, 0xF0, 0x8D, 0x8C, 0x28, 0x73, 
+0x7B, 0xCE, 0xB1, 0x53, 0x58, 0x72, 0xE2, 0x06, 
+0x53, 0x53, 0x31, 
+
+0xC5, 0xC0, 0x9F, 0xD5, 0x37, 0x60, 0xB8, 0x5B, 
+0xDD, 0xB9, 0x20, 0xC8, 0x27, 0xA4, 0x96, 0xAC, 
+0xA8, 0xEF, 0xC3, 0x53, 0x0C, 0xCB, 0xC6, 0x50, 
+0x1C, 0x54, 0xBB, 0xAA, 0x56, 0x32, 0x18, 0x6B, 
+0x51, 0x64, 0x05, 0xDA, 0xDD, 0x99, 0x9B, 0x69, 
+0xDF, 0x66, 0x59, 0x3B, 0xC4, 0x8B, 0x34, 0x1E, 
+0xAE, 0x42, 0x9C, 0xAF, 0xEB, 0x9A, 0x98, 0x69, 
+0x31, 0x23, 0x5B, 0x20, 0x89, 0xA0, 0xFC, 0x33, 
+0x12, 0x63, 0x00, 0x72, 0x98, 0xF5, 0x22, 0xA3, 
+0x64, 0x2B, 0x46, 0xEB, 0x6E, 0xB7, 0x95, 0x90, 
+0x72, 0xE9, 0xBB, 0xF3, 0xA7, 0xB5, 0xD2, 0x40, 
+0xE0, 0xD1, 0x0F, 0x49, 0x10, 0x0C, 0x06, 0x57, 
+0x51, 0x8F, 0xAA, 0xE7, 
+
+0x7D, 0xA4, 0x3C, 0xD4, 0x39, 0x99, 0xBD, 0x46, 
+0x4A, 0x01, 0xC5, 0x16, 0x81, 0xAB, 0xE4, 0xB5, 
+0xE4, 0x0A, 0xC9, 0x2C, 0xA7, 0xE5, 0x16, 0xDE, 
+0xDF, 0x66, 0xB2, 0x71, 0x1F, 0x56, 0xD2, 0x0B, 
+0x01, 0x75, 0x40, 0x6B, 0xF0, 0xF4, 0xF2, 0x6E, 
+0xD0, 0xC2, 0x31, 0x4E, 0xBB, 0xBE, 0x09, 0xBB, 
+0x2C, 0xC2, 0x6B, 0x3A, 0xA1, 0x3B, 0xB7, 0x74, 
+0x39, 0x74, 0x13, 0x51, 0xA7, 0x10, 0x1F, 0x0D, 
+0x48, 0x7B, 0xA5, 0xEE, 0xBC, 0x07, 0xA6, 0x80, 
+0xB2, 0x24, 0xDB, 0xC0, 0x88, 0xF6, 0xB0, 0xC0, 
+0xDB, 0xC3, 0xBB, 0xD9, 0x86, 0x2B, 0xB1, 0x54, 
+0xE0, 0x38, 0x8F, 0xBE, 0x91, 0xFF, 0xA1, 0x27, 
+0xB6, 0x30, 0x4A, 0xC8, 0xB7, 
+
+0x3E, 0xDE, 0x8B, 0xD6, 0x27, 0x78, 0x56, 0xF4, 
+0xC5, 0x07, 0xE5, 0xB1, 0x87, 0x1D, 0x65, 0xE5, 
+0x11, 0x97, 0x40, 0xC6, 0x16, 0x2A, 0xA9, 0x59, 
+0x09, 0x34, 0xA1, 0xF7, 0x5B, 0x53, 0x26, 0xD5, 
+0x03, 0xD9, 0xC5, 0xD2, 0xE0, 0x10, 0xF9, 0x3B, 
+0x6C, 0x04, 0xAF, 0x88, 0x2A, 0x53, 0xA8, 0x13, 
+0x33, 0x54, 0xD0, 0x10, 0x8D, 0xCC, 0xB7, 0x6C, 
+0xAB, 0xA1, 0xA2, 0x9D, 0x0E, 0xB8, 0xAC, 0x53, 
+0x61, 0x86, 0xD8, 0x9B, 0xB0, 0xC8, 0x9F, 0xA4, 
+0xEC, 0x4C, 0x08, 0xF3, 0xB7, 0x01, 0xD9, 0xFB, 
+0x05, 0x53, 0x03, 0xA4, 0xC5, 0xDE, 0xE7, 0xCB, 
+0x44, 0xE9, 0x02, 0xEE, 0xB2, 0x25, 0x69, 0x1E, 
+0x2A, 0x3D, 0xC0, 0xEE, 0x02, 0x1A, 
+
+0xE0, 0x0C, 0x02, 0x90, 0xCF, 0x2F, 0x82, 0xC7, 
+0xDF, 0xA0, 0xD5, 0x6A, 0x38, 0x45, 0x37, 0x24, 
+0xF1, 0xF8, 0x08, 0xFE, 0x62, 0xDA, 0xB5, 0x1F, 
+0x53, 0xCA, 0x7A, 0x3E, 0x2B, 0xB1, 0x0D, 0xDF, 
+0xEF, 0xEA, 0xCD, 0x86, 0x3F, 0x4A, 0x16, 0xCB, 
+0x1F, 0xA2, 0xA6, 0xC0, 0xDD, 0x94, 0xB4, 0xF0, 
+0x93, 0x26, 0x97, 0x50, 0x05, 0x9F, 0x69, 0xBC, 
+0x56, 0x99, 0x46, 0xB1, 0x1D, 0xA9, 0x39, 0xE4, 
+0x22, 0x6A, 0xF6, 0xEC, 0xAC, 0x3B, 0xF2, 0x5E, 
+0x99, 0x99, 0x9A, 0xFA, 0xC7, 0x3E, 0xDD, 0xED, 
+0xD0, 0xA6, 0xC5, 0x63, 0xA3, 0x2A, 0xD4, 0xDC, 
+0xBE, 0x85, 0x4B, 0x9F, 0xE3, 0x51, 0x82, 0x06, 
+0x37, 0x31, 0x67, 0xCC, 0x4E, 0x45, 0x8B, 
+
+0x34, 0x5C, 0xA5, 0xF4, 0xC3, 0xC8, 0xA0, 0xE7, 
+0xA5, 0xB6, 0x1B, 0x24, 0x5F, 0xD1, 0x06, 0x5D, 
+0x0A, 0xBC, 0xA2, 0x12, 0x24, 0xB8, 0xEE, 0x37, 
+0x0F, 0xCE, 0x36, 0x6C, 0x51, 0x31, 0x33, 0xAB, 
+0xBE, 0xA0, 0x14, 0x17, 0x0C, 0x1A, 0xCE, 0xF3, 
+0xCC, 0x4A, 0xD3, 0xA0, 0xC2, 0x35, 0xF9, 0x32, 
+0x93, 0xC9, 0xB5, 0x85, 0x48, 0xB2, 0xBE, 0xA8, 
+0x4D, 0x8D, 0x60, 0x04, 0xF8, 0x4D, 0xCB, 0xE3, 
+0xF6, 0x7D, 0x99, 0xFE, 0xD5, 0xC9, 0xF4, 0x9A, 
+0x38, 0x6A, 0x28, 0x15, 0x87, 0x62, 0x3A, 0x65, 
+0x70, 0xF1, 0xFC, 0x9A, 0x13, 0x03, 0x77, 0xA4, 
+0x12, 0x42, 0xE2, 0x67, 0x53, 0x9A, 0x58, 0x73, 
+0x98, 0xEE, 0xC3, 0x85, 0x97, 0x44, 0x27, 0xB1, 
+
+0x0A, 0x34, 0x63, 0x70, 0xF5, 0x50, 0xE0, 0x61, 
+0x87, 0x5C, 0xBE, 0x61, 0x46, 0x01, 0x6E, 0xEB, 
+0x71, 0xDB, 0x4C, 0x76, 0x89, 0x20, 0x47, 0x7C, 
+0x6A, 0xB3, 0xAE, 0x21, 0x83, 0x1F, 0x4A, 0xB9, 
+0xE7, 0x74, 0x74, 0x2D, 0xFA, 0x1D, 0x98, 0x17, 
+0xE2, 0x98, 0x7B, 0x18, 0x7E, 0x7F, 0xBD, 0xFC, 
+0x56, 0xFA, 0x3A, 0xE9, 0x7C, 0xC2, 0x0F, 0xD7, 
+0x00, 0xCC, 0xCE, 0x92, 0x89, 0xD0, 0x84, 0x11, 
+0x66, 0xD6, 0x46, 0x97, 0x30, 0x11, 0x40, 0x04, 
+0xE4, 0x1E, 0x65, 0x9D, 0x87, 0x99, 0x2E, 0x9D, 
+0x34, 0x26, 0x1D, 0xB7, 0x72, 0x7B, 0x5B, 0x0F, 
+0x7C, 0xC5, 0x82, 0xCA, 0x48, 0x4F, 0x93, 0x9F, 
+0xA3, 0x1D, 0xDA, 0x16, 0x5D, 0x72, 0x25, 0x55, 
+0x0B, 
+
+0x27, 0xB1, 0xCF, 0xD4, 0x55, 0x88, 0xE6, 0x89, 
+0x0C, 0xA8, 0x6A, 0x67, 0x9E, 0x0A, 0xE1, 0x53, 
+0xEA, 0xD5, 0xA2, 0x66, 0x26, 0x07, 0x21, 0xD0, 
+0xBF, 0x8F, 0xC7, 0x53, 0x3A, 0xD0, 0x81, 0x63, 
+0x24, 0x59, 0xD8, 0xF5, 0x5E, 0xD1, 0xFB, 0x42, 
+0xC9, 0x13, 0xB7, 0xA3, 0xBB, 0x66, 0x0E, 0x8E, 
+0xDB, 0x54, 0xA9, 0x8F, 0x0E, 0xCB, 0xA0, 0x1C, 
+0xDA, 0xAF, 0x07, 0xDD, 0xA8, 0x5B, 0x8F, 0x5E, 
+0x26, 0xCA, 0x6D, 0x4B, 0x6C, 0x3A, 0x7A, 0x73, 
+0x4A, 0x0F, 0xC8, 0x0E, 0x96, 0xA0, 0xB7, 0xED, 
+0x48, 0xB2, 0xB7, 0xF3, 0x7E, 0xA3, 0xF3, 0x2E, 
+0x45, 0xCD, 0xFC, 0xC7, 0x41, 0x86, 0xFA, 0xFF, 
+0xC0, 0x04, 0xD7, 0xB7, 0xEE, 0xD4, 0xED, 0xDE, 
+0x26, 0xBE, 
+
+0xED, 0x04, 0xA0, 0xE7, 0xBE, 0x48, 0xF9, 0x02, 
+0x7A, 0x23, 0xF5, 0x2A, 0xF0, 0x4C, 0x7D, 0x91, 
+0x2E, 0x20, 0x54, 0x02, 0x42, 0xC7, 0x95, 0xF8, 
+0x7B, 0x44, 0xB2, 0x8F, 0x2B, 0xD6, 0x18, 0x51, 
+0x8F, 0x6C, 0xF6, 0x59, 0x78, 0xFF, 0xA5, 0xD2, 
+0xFA, 0x9D, 0xC7, 0x58, 0xC6, 0x49, 0xB3, 0x02, 
+0xB6, 0x36, 0x79, 0x22, 0x39, 0xE5, 0x08, 0x1D, 
+0xE2, 0x0C, 0x27, 0xEC, 0x2B, 0x80, 0xAF, 0x0B, 
+0x3F, 0x83, 0x74, 0xE1, 0x01, 0x45, 0xCF, 0x67, 
+0xC9, 0x3E, 0x3A, 0xC2, 0x2D, 0xB2, 0xBF, 0x0F, 
+0xD4, 0x2C, 0xAF, 0xD4, 0x85, 0x67, 0x71, 0x68, 
+0xC1, 0x2E, 0xB2, 0x80, 0x9E, 0xA2, 0xE0, 0x4D, 
+0xE4, 0x5B, 0x5F, 0x7E, 0x4A, 0xE0, 0x69, 0x7B, 
+0xAA, 0x83, 0x0C, 
+
+0x88, 0x76, 0x57, 0x19, 0x12, 0x31, 0xC4, 0x68, 
+0xC4, 0xBE, 0x1A, 0x98, 0x74, 0x0E, 0x3E, 0xA8, 
+0x01, 0x90, 0x67, 0xC0, 0xF1, 0x9F, 0x10, 0xB6, 
+0x6F, 0xF8, 0x61, 0xDD, 0xB1, 0x95, 0x90, 0x54, 
+0x3B, 0x6A, 0x27, 0x3B, 0x73, 0x5A, 0x28, 0x20, 
+0x4F, 0x73, 0x53, 0x73, 0x09, 0xFF, 0x1F, 0x82, 
+0xE1, 0x9C, 0x57, 0xCD, 0xDF, 0x70, 0xE2, 0x55, 
+0x9A, 0xDD, 0xFF, 0xD1, 0x48, 0x64, 0xD6, 0xA2, 
+0x75, 0xBE, 0xFA, 0x57, 0xE1, 0x2A, 0x53, 0x9C, 
+0x7C, 0x71, 0x2F, 0x45, 0x17, 0xD5, 0xA5, 0xDB, 
+0x14, 0xD1, 0x4A, 0x64, 0x5B, 0xA1, 0x9D, 0xED, 
+0x6F, 0xB5, 0xF3, 0xE6, 0x1A, 0xDF, 0x4C, 0x1B, 
+0xDB, 0x7B, 0x3C, 0x1C, 0x75, 0x33, 0xAA, 0x9D, 
+0x3A, 0xB1, 0xD0, 0xAF, 
+
+0xD1, 0xFF, 0x22, 0x60, 0xA9, 0x31, 0xC8, 0x55, 
+0x07, 0x37, 0xD6, 0x7C, 0x88, 0x4D, 0xCE, 0x40, 
+0x15, 0xBE, 0x96, 0x02, 0xE8, 0x48, 0x17, 0x7B, 
+0xB2, 0xF6, 0x8D, 0x92, 0xC7, 0x4D, 0x15, 0xC5, 
+0xAC, 0x73, 0x87, 0x7A, 0x3C, 0xB0, 0xBB, 0x90, 
+0xF0, 0xDA, 0x27, 0x72, 0x4A, 0xE5, 0x0D, 0x12, 
+0xBB, 0x0D, 0x56, 0xEE, 0xA4, 0x98, 0xE6, 0x66, 
+0xC9, 0x2C, 0x8D, 0x28, 0x8C, 0x29, 0xF0, 0x1F, 
+0xA3, 0xF9, 0x89, 0x81, 0x2C, 0x78, 0xCE, 0x0A, 
+0x43, 0xF0, 0x5D, 0xA9, 0xC6, 0x88, 0xA3, 0xF1, 
+0xD2, 0xA7, 0x08, 0xFD, 0x21, 0xA3, 0x7B, 0xDE, 
+0xDE, 0x1F, 0xCC, 0xFF, 0x07, 0x99, 0x5D, 0x17, 
+0x41, 0xC7, 0x1C, 0xF7, 0xAB, 0x67, 0x4F, 0xE2, 
+0x1C, 0xD6, 0x23, 0x6B, 0x89, 
+
+0x12, 0xF1, 0xFA, 0xF7, 0x3A, 0xA8, 0x87, 0xDD, 
+0xDE, 0x6B, 0x17, 0xAB, 0x78, 0x35, 0x8C, 0xED, 
+0x08, 0x90, 0xD9, 0x4A, 0x14, 0x83, 0xF3, 0xB4, 
+0x81, 0x8A, 0xD4, 0xB2, 0x5B, 0x0E, 0x63, 0x4D, 
+0x42, 0xBE, 0x87, 0x55, 0xCE, 0xAF, 0x77, 0xFA, 
+0x8C, 0xD3, 0xC8, 0xCA, 0xFE, 0x17, 0x6C, 0x13, 
+0xBA, 0xE8, 0x0C, 0xEF, 0xEE, 0x0A, 0x1F, 0x79, 
+0x51, 0x30, 0x99, 0x29, 0x21, 0xEC, 0xD9, 0xA9, 
+0x97, 0x27, 0x44, 0xF9, 0xC3, 0x6B, 0x69, 0xEB, 
+0x0C, 0xE5, 0x18, 0x66, 0x16, 0xE2, 0xD5, 0xF1, 
+0x15, 0xA3, 0xB4, 0x95, 0x39, 0xF0, 0x4A, 0x2F, 
+0xEC, 0x53, 0x94, 0x73, 0xB3, 0x8B, 0xAB, 0x81, 
+0x92, 0x89, 0x78, 0x5F, 0x10, 0xC9, 0x92, 0xF9, 
+0x9C, 0x86, 0x43, 0x3E, 0xFB, 0xFA, 
+
+0xB5, 0xBA, 0xF8, 0x67, 0x4B, 0x8F, 0xA1, 0x64, 
+0xB8, 0x71, 0xF7, 0x32, 0x99, 0x3B, 0x6C, 0x99, 
+0xE8, 0xDC, 0xC9, 0xEA, 0xCE, 0x80, 0x9B, 0xA1, 
+0xE3, 0xE5, 0x64, 0x55, 0x07, 0x17, 0x75, 0x82, 
+0x71, 0xE9, 0xA9, 0x09, 0x8D, 0x0D, 0x4E, 0xFB, 
+0xE8, 0x50, 0x97, 0x1A, 0x36, 0x10, 0x1B, 0xFD, 
+0x54, 0xD3, 0x5C, 0x74, 0x10, 0x58, 0xF0, 0xE3, 
+0x0F, 0x08, 0x37, 0x89, 0x40, 0xAD, 0x66, 0x5B, 
+0x15, 0x77, 0xA7, 0xB1, 0xB0, 0x36, 0xDC, 0x4E, 
+0xD6, 0x76, 0x72, 0x23, 0x67, 0x02, 0x33, 0x7A, 
+0xAE, 0x8A, 0x25, 0x5D, 0x1A, 0xF3, 0xFE, 0xAD, 
+0x64, 0x97, 0xCE, 0x73, 0x11, 0x1E, 0x2E, 0x3A, 
+0x33, 0x5D, 0x82, 0xFD, 0xC0, 0x1F, 0x90, 0xAD, 
+0x8C, 0xDC, 0xCC, 0x2F, 0x40, 0x3A, 0x22, 
+
+0x01, 0x23, 0x4A, 0xBE, 0x7C, 0xD1, 0x4E, 0xAD, 
+0xD2, 0xE5, 0x2F, 0xEA, 0xA9, 0x62, 0xA5, 0xCB, 
+0x87, 0x34, 0x6B, 0x5F, 0xB9, 0xBD, 0xF8, 0x36, 
+0x6C, 0xA8, 0xDE, 0x58, 0x94, 0x27, 0x00, 0xCB, 
+0x06, 0x5F, 0x5E, 0x76, 0xBC, 0x08, 0xB0, 0x7F, 
+0x41, 0xA7, 0xDE, 0x73, 0x32, 0xE2, 0xFB, 0x98, 
+0x17, 0x2A, 0x7D, 0x4D, 0xB1, 0x5F, 0x2D, 0xF5, 
+0xA8, 0x4B, 0x60, 0x74, 0x75, 0x54, 0x8A, 0xCA, 
+0x64, 0xC5, 0x81, 0x53, 0x1F, 0x79, 0xAD, 0xEA, 
+0x38, 0x01, 0xDF, 0x54, 0x4D, 0x9F, 0x28, 0xA5, 
+0x13, 0x90, 0x77, 0x42, 0xB9, 0x2C, 0x98, 0xB7, 
+0x01, 0x5F, 0x15, 0xF9, 0x4F, 0xF3, 0x0D, 0xA2, 
+0x23, 0xE1, 0x3F, 0xBF, 0x3C, 0x8A, 0xA6, 0x5E, 
+0xD4, 0xB7, 0xBD, 0x1D, 0x60, 0xF4, 0x4F, 0x4D, 
+
+0x94, 0xE0, 0x03, 0xBF, 0x36, 0x56, 0xFC, 0xAD, 
+0x04, 0x21, 0x57, 0x76, 0xF1, 0xCA, 0xD7, 0x42, 
+0xAC, 0x49, 0xBA, 0x20, 0x47, 0xE4, 0x76, 0x27, 
+0x98, 0xDE, 0xE7, 0xC7, 0xE4, 0x61, 0xA2, 0x40, 
+0xA4, 0x7F, 0x34, 0x6F, 0x9A, 0x1A, 0x1F, 0x68, 
+0x23, 0xA2, 0x83, 0x20, 0x25, 0x92, 0x9C, 0xEA, 
+0x59, 0x4F, 0x52, 0x30, 0xDA, 0x96, 0xE2, 0x06, 
+0x1A, 0x9A, 0x3D, 0x9E, 0xF6, 0x50, 0xDE, 0x77, 
+0x56, 0xB0, 0x14, 0x83, 0x7B, 0x49, 0x63, 0xCC, 
+0xB6, 0xB9, 0xF9, 0x8F, 0x42, 0x7E, 0x1D, 0x4B, 
+0x77, 0xB6, 0xA0, 0xD5, 0xDD, 0x9C, 0x52, 0xD5, 
+0xBA, 0x80, 0x33, 0xDD, 0x6B, 0xF5, 0x90, 0x73, 
+0x79, 0xA3, 0x7B, 0x27, 0xD4, 0xA2, 0xE4, 0x16, 
+0x40, 0x17, 0xEC, 0x8D, 0xBB, 0x6C, 0xC5, 0xFF, 
+0xAB, 
+
+0x46, 0x60, 0x95, 0x39, 0x5D, 0x35, 0xDA, 0x2F, 
+0xDD, 0xD9, 0x46, 0x72, 0xF8, 0xD9, 0xDE, 0x21, 
+0xED, 0x80, 0x62, 0x02, 0x4E, 0xA3, 0x74, 0x1E, 
+0x1E, 0xCB, 0x31, 0x26, 0x9E, 0x68, 0x23, 0xF0, 
+0xD0, 0x15, 0x0D, 0x94, 0x23, 0xBC, 0x24, 0xE3, 
+0x7F, 0x35, 0xF5, 0x2E, 0xE9, 0x4D, 0x23, 0x72, 
+0xC9, 0x6D, 0x4E, 0x34, 0x93, 0x07, 0x08, 0xD8, 
+0xF3, 0x71, 0xB1, 0x41, 0xD1, 0x34, 0x0C, 0x32, 
+0x52, 0x6B, 0x1E, 0x9A, 0xD3, 0xC4, 0x5C, 0xB4, 
+0x12, 0x26, 0x28, 0xDD, 0x67, 0xD0, 0xBF, 0x60, 
+0xA6, 0x3E, 0xB7, 0x06, 0x47, 0x18, 0x63, 0x8C, 
+0x59, 0x8D, 0x5C, 0x7F, 0x5F, 0x73, 0xC6, 0x6A, 
+0x87, 0x6D, 0xDD, 0x89, 0x6C, 0xF8, 0x6E, 0x00, 
+0x31, 0x6C, 0x22, 0xF5, 0x24, 0x58, 0x02, 0x52, 
+0x67, 0xC5, 
+
+0x68, 0x98, 0x3A, 0x05, 0xFE, 0xEB, 0x49, 0xB8, 
+0xE4, 0x8E, 0x22, 0x7C, 0xE8, 0xF4, 0xC4, 0xB2, 
+0x37, 0x4C, 0x97, 0x42, 0x75, 0x34, 0xBF, 0x22, 
+0xFE, 0x7A, 0x87, 0x28, 0x81, 0xBC, 0xAE, 0x5C, 
+0x05, 0x6B, 0x3C, 0x43, 0xA1, 0xEF, 0xE3, 0x7E, 
+0x34, 0xA9, 0x58, 0xDC, 0x67, 0x6D, 0x62, 0x4C, 
+0x1C, 0x5E, 0x38, 0x78, 0x24, 0x32, 0x33, 0x1C, 
+0x63, 0x8C, 0x51, 0xC3, 0x36, 0x10, 0x12, 0x55, 
+0xB8, 0x06, 0x9E, 0xD9, 0xEC, 0x80, 0xF4, 0x7F, 
+0xBC, 0x8B, 0xA8, 0x86, 0x6D, 0xAE, 0x76, 0x7D, 
+0xE5, 0x37, 0xE6, 0x87, 0xE2, 0xB3, 0xF8, 0xB4, 
+0xF1, 0x3D, 0x83, 0x57, 0x16, 0xC9, 0xC3, 0xBD, 
+0x8B, 0x2D, 0x8D, 0xDC, 0x73, 0xE8, 0xB4, 0xF7, 
+0xF7, 0xA0, 0xDE, 0x85, 0xA6, 0x60, 0x69, 0x52, 
+0x73, 0xAF, 0x20, 
+
+0xB9, 0x8B, 0xDF, 0xC4, 0xB3, 0x9F, 0x36, 0x82, 
+0x7E, 0xFB, 0x6B, 0x0A, 0xA9, 0xFB, 0x7C, 0x89, 
+0xD4, 0xB1, 0x05, 0xDD, 0x46, 0x8B, 0x6A, 0x10, 
+0xC2, 0x68, 0x4A, 0x8F, 0x7F, 0x97, 0x90, 0xC7, 
+0x19, 0xCB, 0xCA, 0x94, 0x19, 0x76, 0x7F, 0xA2, 
+0x73, 0x7B, 0xCF, 0x8B, 0x41, 0x2A, 0x4A, 0x6A, 
+0xBC, 0x25, 0x1C, 0x2A, 0xA0, 0x57, 0x72, 0x00, 
+0xC2, 0x8F, 0xFE, 0x7F, 0x7F, 0x81, 0x1E, 0x1B, 
+0xFA, 0x38, 0x0D, 0xE5, 0x89, 0xD2, 0x82, 0x97, 
+0x0F, 0x35, 0x02, 0x89, 0x4F, 0x8B, 0x66, 0xCF, 
+0x6B, 0x80, 0xCF, 0x99, 0x72, 0xC6, 0xDF, 0x51, 
+0xED, 0x07, 0x8D, 0x2E, 0x0E, 0xE7, 0xE6, 0xF9, 
+0xAD, 0xF3, 0x1F, 0xE9, 0x3D, 0xF4, 0xDB, 0x27, 
+0xC4, 0x0C, 0x35, 0x83, 0x62, 0x49, 0x35, 0xEA, 
+0x43, 0x47, 0x2C, 0x91, 
+
+0x13, 0x1D, 0x0A, 0x08, 0xCF, 0x6E, 0x3C, 0x73, 
+0x33, 0xAE, 0x90, 0x40, 0x62, 0x23, 0x75, 0xD8, 
+0xAC, 0x3D, 0x01, 0xD4, 0xFB, 0xD9, 0x3A, 0xBC, 
+0x8E, 0x34, 0x54, 0x0F, 0xE3, 0x5A, 0x49, 0xA9, 
+0xF5, 0xDB, 0x16, 0x46, 0xF3, 0xF6, 0xA2, 0x49, 
+0x2C, 0xFF, 0x75, 0x61, 0x3F, 0xE1, 0x60, 0xD5, 
+0x1E, 0xD9, 0x81, 0x53, 0x0D, 0xE8, 0xDE, 0xA0, 
+0xAB, 0x64, 0xC6, 0x32, 0x31, 0x5D, 0x4A, 0xCE, 
+0x63, 0x52, 0x16, 0x5D, 0x95, 0x50, 0x3C, 0xC0, 
+0x0A, 0x55, 0x80, 0x7B, 0xD7, 0x64, 0x1C, 0x14, 
+0x4B, 0x7F, 0x9C, 0x1B, 0x0F, 0x15, 0x89, 0xD2, 
+0x7F, 0x7A, 0xA1, 0x50, 0xFA, 0x5F, 0xA9, 0xDB, 
+0x2D, 0x62, 0xF9, 0x5B, 0xF4, 0x11, 0x12, 0x26, 
+0xEA, 0x7A, 0xF1, 0x1A, 0x75, 0x4C, 0x06, 0x7C, 
+0xB0, 0x99, 0xF2, 0x39, 0x27, 
+
+0x71, 0x96, 0xAC, 0x75, 0x99, 0xAD, 0x3C, 0x97, 
+0xA2, 0x46, 0xCB, 0x1D, 0x46, 0x5E, 0x2F, 0x39, 
+0x26, 0x0D, 0x39, 0x19, 0xC4, 0x77, 0x85, 0x79, 
+0x75, 0x47, 0xB8, 0xC9, 0x94, 0xCC, 0x6B, 0xC1, 
+0x26, 0x2B, 0x85, 0xB4, 0x44, 0xE0, 0x4A, 0x42, 
+0xF7, 0x47, 0x81, 0x61, 0x82, 0x77, 0x06, 0x86, 
+0x6E, 0xA3, 0x73, 0xA8, 0xDC, 0xF7, 0xCB, 0xDA, 
+0xDB, 0x37, 0xA0, 0x9B, 0x33, 0x2A, 0xE0, 0x0B, 
+0x16, 0x84, 0xE4, 0x95, 0x5D, 0x11, 0x49, 0x56, 
+0xCC, 0xBA, 0x26, 0x92, 0x9D, 0x21, 0x63, 0x1B, 
+0xCC, 0x7E, 0xFD, 0xEE, 0xEC, 0xA2, 0x77, 0x6F, 
+0x8A, 0x92, 0xFA, 0x26, 0xFB, 0x5D, 0xAC, 0xD8, 
+0xA4, 0x27, 0xC4, 0x61, 0x15, 0x2A, 0x79, 0x12, 
+0x2A, 0x44, 0x5E, 0x86, 0x43, 0xA5, 0xDB, 0xE8, 
+0xB8, 0x90, 0xD3, 0xF2, 0x7F, 0xF3, 
+
+0xF5, 0x03, 0xC6, 0x37, 0xEC, 0x74, 0x44, 0x52, 
+0x96, 0x45, 0x29, 0xF9, 0x01, 0xDB, 0xF4, 0xD8, 
+0x04, 0x34, 0xBB, 0x76, 0x3A, 0x2E, 0x08, 0x30, 
+0xFD, 0x5C, 0xEE, 0x3C, 0x8C, 0x22, 0x4D, 0x8F, 
+0x64, 0xED, 0xE2, 0x21, 0x1A, 0xF1, 0xE7, 0x5F, 
+0x37, 0xAA, 0x5D, 0x13, 0x8E, 0xD9, 0xFD, 0x9A, 
+0x05, 0x74, 0xDD, 0xD9, 0x8F, 0x36, 0xDE, 0x22, 
+0xF5, 0xC2, 0xF8, 0x43, 0xBF, 0x37, 0x47, 0xB7, 
+0x94, 0xC9, 0x00, 0xFD, 0x02, 0x64, 0x8B, 0xA4, 
+0x52, 0x8C, 0x20, 0x0F, 0x19, 0x83, 0x9E, 0xAA, 
+0x7E, 0xA7, 0x87, 0xA9, 0x0A, 0x71, 0xE4, 0x13, 
+0x8C, 0xD0, 0xFF, 0x51, 0x01, 0x92, 0x68, 0x47, 
+0x1C, 0xBC, 0x45, 0xD4, 0x95, 0x79, 0x2B, 0xDE, 
+0xE7, 0x1F, 0x5A, 0xF2, 0x07, 0xE5, 0xD6, 0xA8, 
+0xA0, 0xEF, 0xC2, 0x75, 0x05, 0xD9, 0x12, 
+
+0x40, 0xC9, 0x29, 0x74, 0x28, 0xB2, 0xD9, 0x60, 
+0x58, 0x89, 0x96, 0x42, 0x88, 0x72, 0x98, 0x29, 
+0xE3, 0xED, 0x3B, 0x5C, 0x44, 0xC7, 0xFC, 0xDA, 
+0xDD, 0x02, 0x97, 0x5C, 0x38, 0xC7, 0x82, 0x03, 
+0xE2, 0x5B, 0x63, 0x52, 0x4D, 0x2F, 0xBA, 0xA6, 
+0x1C, 0xB0, 0x68, 0xC0, 0x93, 0x2F, 0x4F, 0x21, 
+0xB4, 0x93, 0x17, 0x72, 0x3B, 0xFA, 0xCB, 0xDA, 
+0x8A, 0x9F, 0x1B, 0x59, 0x30, 0xED, 0xCC, 0x2B, 
+0x92, 0x41, 0x24, 0x7D, 0xDB, 0x49, 0xCA, 0x66, 
+0xA6, 0xA0, 0xEB, 0xEA, 0x4A, 0xB1, 0xBD, 0x52, 
+0x57, 0x71, 0xAC, 0xF4, 0x2C, 0x95, 0x4F, 0x48, 
+0x9D, 0x5F, 0x3C, 0x1C, 0x1F, 0xA5, 0xD9, 0x31, 
+0x69, 0xA5, 0xC6, 0x3F, 0x85, 0x83, 0x30, 0x7D, 
+0x9D, 0x97, 0xBB, 0xDD, 0xA5, 0x7C, 0x7F, 0x76, 
+0xC6, 0x0C, 0x16, 0x16, 0x9B, 0x42, 0xDE, 0xAA, 
+
+0xAF, 0x9B, 0x37, 0x0B, 0xD4, 0x5E, 0x98, 0x61, 
+0x87, 0x0F, 0xE6, 0xA2, 0xB7, 0xCF, 0xF6, 0x4E, 
+0x1F, 0xA6, 0x26, 0xCE, 0x00, 0x76, 0x47, 0x6F, 
+0xEC, 0xC1, 0x2D, 0x01, 0x4B, 0x8E, 0x78, 0x18, 
+0x59, 0x90, 0xFF, 0x53, 0x79, 0x23, 0x2E, 0xF3, 
+0x7A, 0xA4, 0x95, 0xE7, 0xAB, 0xEC, 0x2B, 0x05, 
+0x7F, 0x4D, 0x42, 0x18, 0x76, 0x34, 0xD8, 0x81, 
+0x95, 0x3D, 0x2E, 0x1E, 0xE9, 0x1D, 0xE9, 0x0A, 
+0x55, 0x47, 0xDF, 0x63, 0x78, 0x54, 0x4C, 0x59, 
+0xF3, 0xEC, 0x23, 0x50, 0x65, 0xC2, 0x24, 0xC9, 
+0x85, 0x69, 0x0F, 0x56, 0x5A, 0x51, 0x57, 0x64, 
+0xB0, 0xFE, 0x4B, 0xE7, 0xF6, 0xB6, 0xAB, 0x0D, 
+0x79, 0xB1, 0xBD, 0xAF, 0x6E, 0x53, 0x1F, 0x8D, 
+0xB4, 0xB2, 0xCA, 0x9F, 0x13, 0x5B, 0x8E, 0xCD, 
+0xBA, 0x81, 0x0F, 0xD8, 0x85, 0x71, 0x09, 0x80, 
+0xF1, 
+
+0xB6, 0xD9, 0x5E, 0x33, 0x24, 0x38, 0x71, 0x69, 
+0x06, 0xAB, 0xDB, 0xA0, 0x18, 0x3F, 0xC2, 0x02, 
+0xF3, 0x78, 0xEE, 0x4E, 0x67, 0x54, 0xDA, 0xB0, 
+0xE3, 0x27, 0x0D, 0xE9, 0x3A, 0x47, 0x86, 0x0A, 
+0x82, 0xE0, 0xB2, 0x96, 0x99, 0xE1, 0xCC, 0x90, 
+0x6A, 0xCB, 0x80, 0xE7, 0x42, 0xDE, 0xA0, 0xA6, 
+0x1C, 0xB0, 0x7F, 0x7A, 0xA4, 0x8E, 0xE1, 0x60, 
+0x91, 0xE2, 0x15, 0x4C, 0x69, 0x26, 0x72, 0xE5, 
+0x96, 0xC6, 0xDB, 0x37, 0x38, 0xDA, 0x8B, 0xC1, 
+0xC3, 0x1F, 0x66, 0xD4, 0xE0, 0xC1, 0x15, 0xAA, 
+0x64, 0x82, 0x5F, 0xD6, 0x7F, 0x65, 0x9C, 0x4A, 
+0xA2, 0x8A, 0x2E, 0x5C, 0xD8, 0x38, 0x00, 0x1A, 
+0xCE, 0xF3, 0x67, 0x23, 0x0E, 0x55, 0x35, 0xE5, 
+0x10, 0x59, 0xEA, 0x24, 0x62, 0x4E, 0xC1, 0xAC, 
+0x7E, 0xA1, 0x6F, 0x14, 0xEB, 0x72, 0xCC, 0xCD, 
+0xA9, 0x76, 
+
+0x18, 0xB2, 0xFD, 0x47, 0xAA, 0xC8, 0xEF, 0x1E, 
+0x5C, 0x83, 0xFF, 0xF5, 0x64, 0xD6, 0xC3, 0x1B, 
+0x8C, 0x20, 0x1D, 0x9F, 0x02, 0x50, 0xB9, 0x12, 
+0x98, 0xD5, 0x69, 0x1C, 0x97, 0x64, 0x62, 0x37, 
+0x42, 0x2A, 0xE4, 0x4F, 0x3B, 0xF6, 0xC9, 0xC7, 
+0xB1, 0x18, 0x46, 0x21, 0x02, 0xDB, 0x11, 0x88, 
+0x0B, 0x7D, 0x4B, 0x61, 0x0F, 0x31, 0xBC, 0x12, 
+0xF1, 0xAB, 0x19, 0xE7, 0x1C, 0x5F, 0x13, 0x06, 
+0x45, 0x3D, 0x1A, 0x4F, 0x58, 0xF3, 0xED, 0x68, 
+0xBD, 0x1A, 0xA8, 0x7F, 0x7F, 0x60, 0xDF, 0xDB, 
+0xAA, 0x23, 0xB0, 0x1A, 0x04, 0x5B, 0x60, 0x7B, 
+0xD3, 0xC1, 0x1C, 0xAE, 0x9D, 0x2F, 0xC3, 0xC4, 
+0xBC, 0xB3, 0x8B, 0x7F, 0x38, 0xBD, 0x11, 0xAD, 
+0xD9, 0xF6, 0xEA, 0xEF, 0x93, 0x62, 0xD0, 0xCA, 
+0xA1, 0x31, 0x2F, 0x4B, 0x78, 0xED, 0x9E, 0xD7, 
+0x21, 0x84, 0xA5, 
+
+0x71, 0xB8, 0x35, 0x68, 0x5B, 0x48, 0x0F, 0x77, 
+0x97, 0x38, 0x36, 0xF0, 0xE5, 0xFB, 0x69, 0x4B, 
+0x25, 0x90, 0x9D, 0xD6, 0x65, 0x22, 0xB4, 0x0E, 
+0xE0, 0x0B, 0xB2, 0x8C, 0xB9, 0xBD, 0xEF, 0x90, 
+0xEB, 0xFE, 0x58, 0xA4, 0xD6, 0x15, 0x7D, 0x87, 
+0x98, 0x7F, 0xE3, 0x8F, 0xD7, 0xF8, 0xAB, 0xDE, 
+0xC3, 0xF8, 0x72, 0xA7, 0x8F, 0x69, 0x4B, 0x89, 
+0x45, 0x67, 0x12, 0x08, 0xC1, 0x30, 0x7D, 0x55, 
+0xEF, 0x5D, 0xE5, 0x08, 0x7C, 0x61, 0x26, 0x3F, 
+0xD9, 0xCF, 0x2E, 0xBC, 0x8D, 0x07, 0xE0, 0x9E, 
+0xFF, 0x98, 0x5C, 0x52, 0xB6, 0xAA, 0x22, 0x1D, 
+0xC3, 0x37, 0x04, 0xB3, 0x94, 0xFE, 0xE0, 0xEE, 
+0x2B, 0x51, 0xE3, 0xAA, 0x22, 0x4C, 0xEA, 0xE8, 
+0xA5, 0x2C, 0x8C, 0x24, 0xEB, 0x09, 0xDD, 0x95, 
+0x1E, 0x1B, 0x1F, 0x98, 0xD7, 0xE8, 0x98, 0x32, 
+0x39, 0x8D, 0xE2, 0x9C, 
+
+0x75, 0x95, 0xC1, 0x53, 0x5A, 0xC8, 0xB9, 0x3A, 
+0x25, 0x67, 0xC9, 0x3E, 0x07, 0x78, 0x27, 0xA9, 
+0x50, 0x7C, 0x88, 0xA7, 0x60, 0xB2, 0x79, 0xC3, 
+0x08, 0x1A, 0x8E, 0xD9, 0x39, 0x19, 0x82, 0x06, 
+0x46, 0x23, 0xDB, 0x8F, 0x83, 0xB2, 0xB0, 0x94, 
+0x97, 0xD4, 0x79, 0x0E, 0x15, 0x9D, 0x60, 0x1F, 
+0x41, 0xFB, 0xA5, 0x31, 0x73, 0x0A, 0x50, 0x8A, 
+0x4B, 0x7D, 0xE4, 0xFB, 0xD4, 0xF5, 0xAD, 0xBB, 
+0x09, 0x87, 0x4F, 0x87, 0x9E, 0x71, 0xBB, 0xE6, 
+0x65, 0x48, 0xC3, 0x2F, 0xEB, 0xFD, 0x16, 0x29, 
+0xEE, 0x91, 0x50, 0x7F, 0x97, 0x45, 0x19, 0x40, 
+0x3D, 0x35, 0x8F, 0x99, 0x8E, 0xDB, 0x1B, 0xE8, 
+0x83, 0xBD, 0x0B, 0x01, 0xD9, 0xA7, 0x12, 0x53, 
+0x67, 0x3B, 0x34, 0xF8, 0x67, 0xCC, 0x53, 0x4A, 
+0x15, 0xE9, 0x42, 0xCB, 0x57, 0x83, 0xAC, 0x5A, 
+0xA5, 0x5F, 0x37, 0x25, 0x1A, 
+
+0xB5, 0xED, 0xE8, 0xD0, 0xEB, 0x9D, 0x11, 0xFE, 
+0xD2, 0xD6, 0xE0, 0x7E, 0x70, 0x7D, 0x61, 0x9D, 
+0xF4, 0xD6, 0x1B, 0x83, 0xB4, 0x0C, 0x8F, 0xC9, 
+0xF5, 0xD5, 0x70, 0xFD, 0x50, 0x51, 0x8D, 0xC6, 
+0x31, 0x16, 0x23, 0x43, 0x39, 0xE5, 0x45, 0x03, 
+0xDB, 0xD6, 0xA9, 0x00, 0x46, 0x0F, 0x40, 0xC4, 
+0x70, 0xEE, 0x58, 0x0E, 0xCF, 0x24, 0xDC, 0x2A, 
+0xE8, 0xDE, 0x53, 0xEF, 0xAF, 0x1C, 0x24, 0x27, 
+0x09, 0xA9, 0x97, 0xAB, 0x79, 0x4A, 0x1D, 0xB5, 
+0xD1, 0x66, 0x55, 0xDD, 0x8E, 0x86, 0x2E, 0xEB, 
+0xA0, 0xB2, 0x1F, 0xA8, 0x10, 0x2E, 0xEC, 0xA8, 
+0x26, 0x1F, 0x58, 0x4B, 0xD3, 0xAF, 0x1F, 0x75, 
+0xC1, 0xD4, 0xF5, 0xF5, 0x41, 0x25, 0xC4, 0xB0, 
+0x90, 0x10, 0x85, 0x8F, 0x9C, 0x83, 0xAE, 0xA1, 
+0x5D, 0x5C, 0xAE, 0x1F, 0x29, 0x40, 0x2D, 0x34, 
+0x3D, 0x76, 0x2F, 0x9E, 0xE4, 0xCB, 
+
+0x47, 0xF1, 0x69, 0x52, 0x4E, 0x81, 0x0A, 0x6B, 
+0x5E, 0xC0, 0x61, 0x05, 0x76, 0x0B, 0x71, 0x34, 
+0x4F, 0x80, 0xE1, 0xCA, 0xFA, 0x49, 0x90, 0xEE, 
+0x26, 0x05, 0xCE, 0x72, 0x53, 0x7E, 0xBA, 0xE5, 
+0x9A, 0x68, 0x01, 0x73, 0x93, 0x25, 0x58, 0x55, 
+0x7F, 0xFC, 0x50, 0x7F, 0x3A, 0xA5, 0x08, 0x3E, 
+0x2E, 0xF8, 0x18, 0xAC, 0xEA, 0x0A, 0x3A, 0xDA, 
+0x72, 0x7B, 0x7B, 0xE3, 0xEB, 0x5F, 0x95, 0xAD, 
+0xC2, 0x5D, 0xB8, 0x16, 0xAC, 0xB6, 0xF9, 0xD8, 
+0x1F, 0x57, 0x22, 0x46, 0xAD, 0x91, 0x78, 0x48, 
+0x22, 0x02, 0xBE, 0xFB, 0xA6, 0x4D, 0xCF, 0x1D, 
+0x1D, 0x0F, 0x7C, 0x08, 0x67, 0x84, 0x3D, 0x3D, 
+0x30, 0x41, 0x5C, 0xC3, 0x85, 0x75, 0xC4, 0xBD, 
+0xD2, 0x76, 0xC4, 0x85, 0x95, 0x9C, 0x50, 0x6E, 
+0xA4, 0xF5, 0x35, 0x42, 0xEA, 0x4E, 0x92, 0x57, 
+0x57, 0x6E, 0x88, 0xB0, 0x86, 0x40, 0xE1, 
+
+0xD6, 0x61, 0x6A, 0x1E, 0xD1, 0x7E, 0xA1, 0x82, 
+0x10, 0x63, 0x21, 0xA6, 0xD7, 0xC0, 0xF4, 0xE0, 
+0xC6, 0xB5, 0xF9, 0x94, 0xDF, 0x2C, 0x90, 0xCB, 
+0xEE, 0x98, 0x5E, 0x65, 0x4D, 0x86, 0x9F, 0x5F, 
+0x16, 0xE6, 0xB9, 0x98, 0x4A, 0x94, 0xA8, 0x7A, 
+0x8D, 0x59, 0x6E, 0xE0, 0x92, 0x19, 0xD4, 0xF5, 
+0x93, 0x2C, 0xBF, 0x80, 0xFD, 0x9B, 0x6B, 0xEA, 
+0x81, 0xFB, 0x6C, 0x40, 0x0B, 0x5D, 0xDD, 0xFE, 
+0x6C, 0xDF, 0x1D, 0xA0, 0x02, 0x53, 0xD6, 0x55, 
+0xB9, 0xCC, 0x18, 0x06, 0x94, 0x8B, 0x37, 0xBB, 
+0x7E, 0xC7, 0x56, 0x28, 0x15, 0x58, 0x3A, 0xC0, 
+0x30, 0xB7, 0x1E, 0xFA, 0x46, 0xD7, 0x1A, 0x9D, 
+0xB2, 0x42, 0x79, 0x5E, 0x64, 0x17, 0xB3, 0x48, 
+0xD0, 0x17, 0x98, 0x7B, 0x0A, 0x0A, 0x1F, 0x66, 
+0xF8, 0x94, 0x12, 0xBA, 0x7C, 0x8A, 0x38, 0x5B, 
+0x37, 0x88, 0xCD, 0xDF, 0x0F, 0xCB, 0x38, 0xCC, 
+
+0x57, 0x04, 0x39, 0x7E, 0xAF, 0x6A, 0x00, 0xB2, 
+0x91, 0xBF, 0x98, 0x38, 0x36, 0x37, 0x54, 0xB5, 
+0x22, 0xAA, 0x5D, 0xBC, 0x42, 0x00, 0x79, 0x4E, 
+0xF4, 0x4E, 0xAC, 0x3C, 0x4C, 0x58, 0x53, 0xC2, 
+0xCA, 0xC1, 0x00, 0x1E, 0xBD, 0xBD, 0x1F, 0x57, 
+0x51, 0x0A, 0x8B, 0x84, 0xC2, 0xD0, 0x14, 0x91, 
+0x82, 0x55, 0x80, 0xA8, 0xC1, 0x57, 0xC8, 0x66, 
+0x65, 0xA3, 0xBE, 0x9F, 0x87, 0x1B, 0x80, 0x46, 
+0x3D, 0x5F, 0x07, 0xA2, 0x55, 0x8D, 0x34, 0xD4, 
+0xF6, 0x78, 0x4F, 0x12, 0x20, 0xAE, 0xD8, 0xFC, 
+0xAD, 0x4D, 0x2C, 0xB3, 0xB2, 0xBE, 0x89, 0x3E, 
+0xC5, 0x5F, 0x1C, 0xA2, 0xC0, 0x2A, 0x6B, 0x06, 
+0xE9, 0x2B, 0x55, 0xF3, 0x63, 0x3D, 0x4F, 0x1A, 
+0x23, 0x06, 0xAB, 0x9C, 0xE5, 0xB6, 0xF3, 0x4E, 
+0x03, 0xD5, 0x90, 0xB9, 0x23, 0xD7, 0x86, 0xDA, 
+0xBB, 0x52, 0x2F, 0x2E, 0x5F, 0x77, 0xE2, 0xFA, 
+0x0E, 
+
+0xF5, 0xA3, 0xE0, 0xBE, 0xFB, 0xF4, 0xAE, 0x92, 
+0xD0, 0x9A, 0x7B, 0x72, 0xDE, 0xFB, 0x40, 0x09, 
+0xF4, 0x21, 0xF7, 0x08, 0x2D, 0xD1, 0xE7, 0x12, 
+0x86, 0x51, 0x40, 0xBE, 0xC5, 0x81, 0xD3, 0x1B, 
+0x15, 0x55, 0x2F, 0x8F, 0x7E, 0x63, 0x65, 0x04, 
+0x36, 0xC9, 0x82, 0x8B, 0x58, 0x22, 0xCD, 0xE7, 
+0xBF, 0x62, 0xAB, 0xC8, 0xA4, 0x78, 0xB2, 0x9C, 
+0xF0, 0x02, 0x36, 0x5F, 0xBB, 0x8D, 0xDB, 0xDD, 
+0x7D, 0xEE, 0x91, 0x6B, 0x06, 0xD7, 0xAA, 0x80, 
+0x91, 0x3B, 0x51, 0x3A, 0x2E, 0xB2, 0x7F, 0x76, 
+0xE0, 0x2B, 0xFA, 0xE7, 0xB5, 0x5F, 0x73, 0x17, 
+0xF6, 0x00, 0x02, 0xD9, 0x61, 0x1B, 0x7B, 0x67, 
+0x0D, 0x64, 0xFA, 0x20, 0x9D, 0xC3, 0xE8, 0x9F, 
+0x07, 0x9D, 0xB2, 0x3A, 0x3F, 0xEB, 0x5A, 0xB8, 
+0xBC, 0xA7, 0xF7, 0xF7, 0xEB, 0x49, 0xC0, 0xB3, 
+0xDC, 0x14, 0xAD, 0x2B, 0x43, 0x0F, 0xAF, 0xA7, 
+0xF0, 0x87, 
+
+0x9A, 0xA4, 0x7A, 0xEB, 0x6C, 0x4D, 0xD1, 0x67, 
+0xA1, 0x0D, 0xE5, 0xF2, 0xE6, 0x7F, 0xD9, 0x51, 
+0xF1, 0xB2, 0x2B, 0xB4, 0x67, 0xD8, 0x69, 0x6C, 
+0xA4, 0x5F, 0x36, 0x81, 0x8C, 0x1E, 0xB3, 0xAA, 
+0xF0, 0xAB, 0xA3, 0x1C, 0xDF, 0x15, 0x20, 0xD5, 
+0x1D, 0x9B, 0x4D, 0xF7, 0xB8, 0xCE, 0x1D, 0x33, 
+0x11, 0x1A, 0x25, 0xD7, 0x83, 0x48, 0x94, 0x82, 
+0xD6, 0x94, 0x7F, 0xAC, 0xE4, 0xFD, 0xA6, 0x94, 
+0x56, 0x2F, 0xC4, 0x70, 0xF5, 0x0E, 0x9F, 0xD7, 
+0xE7, 0x24, 0xE7, 0x29, 0x26, 0xC8, 0xE5, 0x56, 
+0xE9, 0xDE, 0x3B, 0xF9, 0xFB, 0x64, 0x50, 0xB1, 
+0xC6, 0x93, 0xB9, 0x0B, 0x3E, 0xC4, 0x91, 0xFF, 
+0xF6, 0xD2, 0x98, 0x9F, 0x61, 0x47, 0xAA, 0xFB, 
+0x34, 0xF4, 0x53, 0xF5, 0xB0, 0x3B, 0x24, 0xBD, 
+0xB5, 0x5F, 0xEC, 0x62, 0x6D, 0xD0, 0x94, 0x0F, 
+0x6A, 0x9E, 0xEB, 0x94, 0x45, 0x22, 0x52, 0xBE, 
+0x69, 0x11, 0xDC, 
+
+0xF1, 0x9E, 0x94, 0x22, 0xB3, 0xA0, 0x36, 0xD4, 
+0x4F, 0x7C, 0xEA, 0x2E, 0x60, 0xA1, 0xEB, 0xB1, 
+0xF0, 0xC1, 0x61, 0xD9, 0x99, 0xD8, 0x45, 0xFF, 
+0x0B, 0x3A, 0xF4, 0x6C, 0xDF, 0x70, 0x32, 0x7C, 
+0xAE, 0x33, 0x45, 0x98, 0x0E, 0xC6, 0x1B, 0xD8, 
+0xBE, 0x62, 0xCC, 0xDE, 0x6F, 0xDC, 0x71, 0x1C, 
+0x3A, 0xEC, 0x6B, 0x7D, 0x25, 0x88, 0xB6, 0x77, 
+0x8A, 0x07, 0x1B, 0x9D, 0x67, 0xEC, 0x35, 0x55, 
+0x3F, 0x5D, 0xCF, 0xF2, 0x6E, 0x62, 0xE5, 0x97, 
+0xE3, 0x82, 0x72, 0x23, 0x0B, 0xC5, 0xB3, 0x3A, 
+0x13, 0xD7, 0x22, 0x04, 0xB0, 0xBF, 0xB8, 0x55, 
+0x7D, 0x76, 0x9B, 0x65, 0x78, 0x4B, 0x5B, 0x33, 
+0xF3, 0x0D, 0x07, 0x0F, 0xAE, 0x7C, 0x03, 0x07, 
+0xA0, 0xFD, 0x9F, 0x8F, 0x09, 0x3F, 0x97, 0x55, 
+0xE7, 0xE3, 0x48, 0x00, 0xEF, 0xF9, 0x27, 0x48, 
+0xC9, 0x32, 0x99, 0x4D, 0xAB, 0x0B, 0x8B, 0xA1, 
+0x5F, 0x96, 0x50, 0x76, 
+
+0x88, 0x30, 0x8A, 0x5B, 0x3C, 0x7C, 0x0E, 0x36, 
+0x39, 0x67, 0xA1, 0x85, 0xDC, 0xDB, 0xE0, 0xDC, 
+0xAB, 0xB8, 0x20, 0xB4, 0x8B, 0x9D, 0x21, 0x69, 
+0xE8, 0x5D, 0x1C, 0x12, 0x6F, 0x3E, 0x0D, 0xC9, 
+
+0x03, 0x4F, 0xA6, 0x65, 0xC2, 0x2B, 0xAD, 0x99, 
+0xA8, 0x95, 0xDE, 0x93, 0x32, 0xEA, 0x51, 0xDD, 
+0x9A, 0xB7, 0x5B, 0x6F, 0xE8, 0xC8, 0x41, 0xF6, 
+0x6F, 0x9F, 0xDC, 0xE4, 0x3F, 0x9A, 0x88, 0x96, 
+0x0C, 
+
+0xC8, 0x74, 0x23, 0xA6, 0xB1, 0x00, 0xD4, 0xAA, 
+0x51, 0x4B, 0xDA, 0xB1, 0xFC, 0x4C, 0xAC, 0x31, 
+0x2B, 0x8E, 0xF1, 0x3E, 0xEA, 0x7A, 0x89, 0x0C, 
+0xB4, 0xC9, 0x9E, 0xEB, 0x91, 0x95, 0xC3, 0x8C, 
+0x20, 0x99, 
+
+0x12, 0xB3, 0x0C, 0x53, 0x3A, 0xE2, 0x92, 0x58, 
+0x55, 0xA9, 0x07, 0xFB, 0x63, 0x79, 0x5A, 0x22, 
+0x01, 0x54, 0x7A, 0xCA, 0x7E, 0xEA, 0x90, 0x8D, 
+0x05, 0x86, 0xDB, 0x79, 0xB0, 0x77, 0x36, 0x62, 
+0x74, 0x04, 0x7E, 
+
+0x30, 0x15, 0xDC, 0x38, 0x30, 0x6A, 0x7E, 0x57, 
+0x0F, 0xC1, 0xF5, 0xF4, 0x83, 0x55, 0xC6, 0xA6, 
+0x2F, 0x16, 0xCC, 0xC7, 0xF5, 0x5A, 0x66, 0x41, 
+0xFB, 0x35, 0xF0, 0x79, 0xE1, 0x9C, 0xE2, 0x58, 
+0x4A, 0x4E, 0x0A, 0xA6, 
+
+0x28, 0x3C, 0x5A, 0xAC, 0x84, 0x71, 0xF5, 0x37, 
+0x7D, 0x83, 0xDE, 0x59, 0xD8, 0x09, 0x2A, 0x22, 
+0xC6, 0x7C, 0xB9, 0x26, 0xB6, 0x4E, 0xDB, 0x14, 
+0x8B, 0xD7, 0x49, 0x10, 0x78, 0xCC, 0xD3, 0xDA, 
+0xE9, 0xCD, 0xE1, 0x49, 0x39, 
+
+0x6E, 0x9E, 0x63, 0x62, 0xEF, 0x92, 0xC7, 0xD5, 
+0xD1, 0xB2, 0x3E, 0x34, 0xA3, 0x7B, 0xA1, 0x08, 
+0x91, 0x0B, 0x58, 0xF5, 0x5D, 0x0B, 0xE3, 0x4D, 
+0x1D, 0x5B, 0x72, 0x3B, 0x5C, 0x9B, 0xDA, 0xE5, 
+0xB3, 0xAA, 0xE4, 0xC5, 0x1F, 0x66, 
+
+0x07, 0x69, 0x04, 0xFD, 0x0C, 0x71, 0x8B, 0xCD, 
+0xF8, 0xED, 0x1C, 0x6E, 0x67, 0x14, 0xFC, 0x11, 
+0x09, 0x2E, 0xA7, 0x79, 0xB9, 0xEA, 0x8A, 0x8A, 
+0xFD, 0xDA, 0x85, 0xA6, 0xA0, 0xB7, 0xF9, 0x24, 
+0x93, 0x71, 0x38, 0xDC, 0xE7, 0xC8, 0x2A, 
+
+0x94, 0xB0, 0x6E, 0x2B, 0xA7, 0xAA, 0xEB, 0x62, 
+0x43, 0xAC, 0xE8, 0x16, 0x77, 0xC6, 0x30, 0x2D, 
+0x0D, 0x02, 0x13, 0x90, 0xFA, 0xF1, 0xF7, 0x35, 
+0x3A, 0x8B, 0x9D, 0xFC, 0xA5, 0x1F, 0x2A, 0x85, 
+0xF2, 0x0A, 0x4B, 0x62, 0x08, 0xB9, 0x46, 0x7C, 
+
+0xEE, 0xBC, 0xA6, 0x66, 0xC5, 0x88, 0x48, 0x10, 
+0x14, 0x55, 0x62, 0xA4, 0x9B, 0x87, 0x81, 0x66, 
+0xDC, 0x1F, 0x89, 0xC7, 0x75, 0x32, 0x9E, 0x73, 
+0x6F, 0x59, 0x29, 0x1A, 0x02, 0x5F, 0x80, 0xF8, 
+0x8A, 0x66, 0xEE, 0x34, 0x76, 0x16, 0xA0, 0x84, 
+0x11, 
+
+0xCF, 0x40, 0x8A, 0x54, 0x5E, 0x1F, 0x1D, 0xCB, 
+0xAA, 0xA4, 0xD5, 0xA0, 0x5F, 0x0B, 0x48, 0x09, 
+0x86, 0xAC, 0xFA, 0x85, 0x64, 0xD6, 0xE6, 0x7F, 
+0xFD, 0xF7, 0x5B, 0x34, 0xE2, 0x80, 0x3E, 0x86, 
+0xD1, 0xDA, 0x1D, 0xB1, 0x64, 0x2B, 0xA6, 0xBE, 
+0x3C, 0xA4, 
+
+0x81, 0x30, 0xD0, 0xFB, 0x58, 0xE5, 0x63, 0xC5, 
+0x54, 0xAE, 0xFE, 0xE5, 0x42, 0x0E, 0x3E, 0x0D, 
+0xC5, 0xB3, 0xCD, 0x41, 0x37, 0x29, 0x15, 0x41, 
+0x63, 0x53, 0xDB, 0x3D, 0x5A, 0xCF, 0xC0, 0x55, 
+0x00, 0x90, 0x22, 0xE4, 0x5A, 0xBB, 0x1B, 0x61, 
+0x63, 0x96, 0x6A, 
+
+0x3B, 0x4E, 0x75, 0xDE, 0x46, 0x9C, 0xDF, 0x27, 
+0x77, 0x45, 0x03, 0x89, 0x12, 0xD6, 0xAD, 0x9A, 
+0x0E, 0x0C, 0xBF, 0xCB, 0xE7, 0x7D, 0xD8, 0x4F, 
+0xCD, 0xEA, 0xFD, 0x26, 0xF8, 0x58, 0xE5, 0x52, 
+0x23, 0x55, 0x88, 0x11, 0x72, 0xD3, 0x50, 0x61, 
+0x84, 0xDB, 0x42, 0x09, 
+
+0xF6, 0x45, 0x27, 0xF4, 0xD6, 0xE9, 0x72, 0xD4, 
+0x62, 0xDC, 0x12, 0x71, 0xF1, 0x79, 0x0B, 0x5A, 
+0x73, 0xD4, 0xB9, 0xF8, 0x0B, 0xE9, 0xB9, 0xA5, 
+0x03, 0xEF, 0x5A, 0xF2, 0x7E, 0xE3, 0x08, 0x98, 
+0x8E, 0xF1, 0x9B, 0x16, 0x19, 0xA9, 0x4D, 0x31, 
+0xAB, 0x93, 0x25, 0xB6, 0x9C, 
+
+0xE3, 0x15, 0xF7, 0x78, 0x24, 0xEF, 0x71, 0x94, 
+0xF4, 0xAE, 0x5B, 0x1D, 0x8C, 0xF2, 0xF5, 0x20, 
+0x8D, 0x9C, 0xDA, 0x6B, 0xDF, 0xFF, 0xBE, 0xC8, 
+0x7A, 0xF4, 0x00, 0x1A, 0x12, 0xAC, 0x36, 0xE4, 
+0xBA, 0x86, 0x49, 0xB9, 0xD2, 0xD0, 0x55, 0x00, 
+0xF6, 0xFD, 0x82, 0x7B, 0xD4, 0x2F, 
+
+0xC9, 0x5B, 0x97, 0xFB, 0x51, 0xD7, 0x31, 0x51, 
+0x9C, 0x2C, 0x29, 0x55, 0xDA, 0xE0, 0x78, 0x16, 
+0x63, 0x77, 0x09, 0x94, 0xD7, 0x16, 0xB4, 0xA2, 
+0xA0, 0x6C, 0xCE, 0xB1, 0xF2, 0xD7, 0xD8, 0x99, 
+0x86, 0x9E, 0x8F, 0x25, 0xB6, 0x59, 0x73, 0x7F, 
+0xCC, 0xBA, 0x10, 0xC1, 0x5E, 0xB8, 0xB3, 
+
+0x6D, 0x6C, 0x34, 0xD0, 0x4C, 0xD0, 0xE2, 0x64, 
+0x60, 0x27, 0x26, 0xD3, 0xA8, 0x6F, 0x44, 0x99, 
+0xD9, 0x1A, 0xCB, 0x3E, 0x25, 0xD7, 0xE6, 0x09, 
+0x78, 0xDE, 0xB2, 0x17, 0xD6, 0xE0, 0x08, 0x65, 
+0xCB, 0xF9, 0x48, 0x94, 0x1F, 0x31, 0x19, 0xDA, 
+0x62, 0x86, 0x63, 0x4E, 0x9D, 0x77, 0xFB, 0x4A, 
+
+0x05, 0x6E, 0x8E, 0xAF, 0xB7, 0x2B, 0x13, 0xDA, 
+0x19, 0x73, 0xB6, 0x60, 0x74, 0x7E, 0x14, 0x93, 
+0x6E, 0x6D, 0xA4, 0x02, 0x57, 0x47, 0xAA, 0x21, 
+0x6C, 0xCE, 0x5A, 0xD1, 0x11, 0x6F, 0x46, 0x33, 
+0xD8, 0xF5, 0x83, 0x41, 0xD6, 0xB1, 0x8D, 0x91, 
+0xE5, 0x93, 0x1F, 0x08, 0xA9, 0x74, 0xBD, 0x93, 
+0x9B, 
+
+0xF2, 0x47, 0x5B, 0xED, 0x81, 0x25, 0x5C, 0x90, 
+0x5A, 0x1A, 0x4A, 0x13, 0x2E, 0xDF, 0xF8, 0xC7, 
+0xF0, 0x5B, 0xF7, 0x90, 0xEE, 0x4E, 0xA1, 0x99, 
+0x2F, 0x17, 0xB1, 0x26, 0x57, 0x38, 0xD3, 0x06, 
+0x14, 0x30, 0x0D, 0x5D, 0xC1, 0x86, 0xAD, 0x7E, 
+0x4E, 0x5E, 0x5F, 0xA8, 0x2C, 0x0D, 0x02, 0xB5, 
+0xB0, 0x59, 
+
+0xB6, 0x4E, 0xE7, 0x03, 0xC0, 0x77, 0xF0, 0xAE, 
+0x79, 0xEF, 0x06, 0xAF, 0x80, 0x2F, 0xB3, 0x68, 
+0x90, 0xD7, 0x5A, 0x9F, 0x7C, 0x63, 0xA5, 0x19, 
+0x16, 0xF6, 0xC9, 0xBA, 0x0F, 0x07, 0x4E, 0x1A, 
+0x53, 0xE7, 0xFE, 0xA1, 0x5A, 0x00, 0xDA, 0x0B, 
+0x90, 0x8C, 0xBF, 0x1D, 0xD9, 0xED, 0xAB, 0x4F, 
+0x11, 0x07, 0x07, 
+
+0xA1, 0x5F, 0x0A, 0x3D, 0x7F, 0x8F, 0x9E, 0xF1, 
+0x4B, 0x54, 0x58, 0x89, 0x7A, 0x92, 0xFF, 0x73, 
+0xFD, 0x69, 0x5B, 0x08, 0x3A, 0xC3, 0x40, 0xED, 
+0x59, 0x06, 0x40, 0xEC, 0xD1, 0x86, 0x01, 0xCC, 
+0xB4, 0x26, 0xFA, 0xCE, 0x3D, 0xC6, 0x1B, 0x22, 
+0x71, 0xE3, 0x8B, 0xDC, 0x46, 0x47, 0xFC, 0xDD, 
+0x65, 0xB1, 0x0E, 0xF1, 
+
+0xB6, 0xE7, 0x45, 0xBE, 0x66, 0xE4, 0xDB, 0xF8, 
+0x34, 0x7F, 0xE1, 0x42, 0x6A, 0xAD, 0x40, 0xDF, 
+0x92, 0x24, 0xCC, 0xBB, 0x8A, 0xFB, 0xC5, 0xCA, 
+0x4C, 0xC5, 0xA4, 0xF4, 0xBF, 0xF5, 0xFD, 0xFA, 
+0x61, 0xBD, 0xA4, 0x49, 0x4E, 0xE1, 0xC2, 0xED, 
+0x82, 0xA1, 0x48, 0x18, 0x14, 0x17, 0x29, 0x46, 
+0xD6, 0x9D, 0x4B, 0x3B, 0xDA, 
+
+0xB1, 0xEB, 0x34, 0x22, 0xFA, 0x54, 0x61, 0x16, 
+0x25, 0x52, 0xE5, 0x92, 0xB7, 0x22, 0x75, 0xD6, 
+0xDD, 0x94, 0xDB, 0x91, 0xD1, 0xDC, 0xF4, 0x94, 
+0x89, 0xC3, 0x7F, 0x77, 0x6A, 0xCB, 0x98, 0x10, 
+0x23, 0xBA, 0xB3, 0x43, 0xA7, 0x29, 0xF5, 0xFC, 
+0x60, 0xFB, 0xAF, 0xAB, 0x30, 0x9E, 0xF1, 0x50, 
+0x26, 0xA4, 0x5B, 0xF2, 0x6B, 0x31, 
+
+0xB2, 0xB3, 0xB4, 0xB5, 0xA3, 0x4D, 0x7A, 0x6B, 
+0x04, 0xE6, 0x05, 0xDA, 0xF5, 0x20, 0x1A, 0x14, 
+0xC9, 0x73, 0x4F, 0x32, 0x52, 0xFE, 0xA0, 0xD2, 
+0xDA, 0xD3, 0x12, 0x16, 0x00, 0xB4, 0xE6, 0xBB, 
+0xF2, 0x9B, 0x6D, 0x34, 0xAF, 0x31, 0x16, 0x4A, 
+0xAC, 0x94, 0x52, 0xD4, 0x9F, 0xE5, 0x55, 0x3A, 
+0xAF, 0xBE, 0xFF, 0x10, 0xD9, 0xD9, 0x8E, 
+
+0x29, 0x13, 0x81, 0x46, 0xD7, 0x56, 0xBF, 0x4A, 
+0x75, 0x10, 0x11, 0x4C, 0xF0, 0xDB, 0xB5, 0x64, 
+0xD2, 0xC8, 0x3F, 0x62, 0x29, 0xF4, 0x7E, 0x60, 
+0xE3, 0x08, 0xA9, 0x86, 0x04, 0x48, 0xDA, 0xAA, 
+0xDA, 0xCC, 0xD2, 0xEF, 0x9B, 0x3A, 0xE6, 0xC3, 
+0x5D, 0x93, 0x32, 0xBB, 0x76, 0x79, 0x3C, 0xAA, 
+0x4F, 0xBC, 0x57, 0x00, 0x30, 0x7E, 0x1A, 0x7D, 
+
+0x0A, 0x12, 0xD1, 0xDE, 0xA2, 0x5E, 0x87, 0xA5, 
+0x38, 0x0F, 0x87, 0x4C, 0x73, 0xA2, 0x87, 0x79, 
+0xD7, 0xB7, 0x56, 0xBB, 0xEE, 0xB7, 0x8C, 0x50, 
+0xB6, 0xF9, 0x0C, 0x17, 0xE7, 0x37, 0xA0, 0x35, 
+0x5C, 0x5F, 0x93, 0x2F, 0x2A, 0xF7, 0x4A, 0xAF, 
+0x2C, 0xDA, 0x38, 0x6D, 0x34, 0xFC, 0x64, 0x06, 
+0xF1, 0x69, 0x89, 0x56, 0x32, 0x73, 0x69, 0xE7, 
+0x40, 
+
+0x2D, 0x18, 0x69, 0x18, 0x13, 0x87, 0x19, 0x63, 
+0x57, 0x77, 0xB6, 0xA6, 0xF1, 0xD3, 0xF8, 0x77, 
+0x09, 0x1A, 0xC2, 0x0A, 0x05, 0x29, 0x1B, 0x48, 
+0x06, 0xD4, 0x53, 0x51, 0xE7, 0x7C, 0x2F, 0x4D, 
+0x9F, 0x3A, 0xC7, 0xFE, 0x49, 0x1A, 0xC7, 0x26, 
+0x64, 0x04, 0x68, 0xE7, 0xF6, 0x50, 0x62, 0xB8, 
+0x55, 0xE1, 0xC8, 0xCE, 0x0C, 0x09, 0xB0, 0x84, 
+0xD6, 0xEE, 
+
+0x87, 0x27, 0x54, 0xF3, 0xC3, 0xD5, 0x28, 0x0F, 
+0xAE, 0x6F, 0x89, 0x99, 0xAA, 0x7F, 0xD4, 0x05, 
+0x46, 0xAF, 0x77, 0xC6, 0x00, 0x37, 0x64, 0x81, 
+0x5A, 0xA6, 0x3C, 0x44, 0x48, 0xD6, 0xB5, 0x04, 
+0x39, 0x2F, 0x4D, 0x07, 0xB1, 0x47, 0xB2, 0xC8, 
+0x55, 0xE6, 0x29, 0xCB, 0xC6, 0x80, 0xA9, 0x54, 
+0xD2, 0xFB, 0x3D, 0x25, 0x4D, 0x64, 0x94, 0xBD, 
+0xAF, 0x43, 0x03, 
+
+0x1E, 0x00, 0x78, 0xE1, 0xD3, 0xED, 0x38, 0x91, 
+0x5D, 0x69, 0x3B, 0xAF, 0x87, 0xC8, 0xA9, 0x70, 
+0x51, 0xCA, 0xEA, 0x90, 0x3E, 0x82, 0xB9, 0x9E, 
+0x9A, 0x74, 0xA4, 0x0A, 0x12, 0x5A, 0xB5, 0xD5, 
+0xB3, 0x83, 0x69, 0xA5, 0x55, 0xB4, 0x40, 0xB4, 
+0x28, 0x06, 0xE3, 0x4F, 0x2D, 0x8F, 0x77, 0x13, 
+0xCE, 0x84, 0x0D, 0xA4, 0x81, 0x4F, 0x77, 0x87, 
+0xA5, 0x99, 0x96, 0xF3, 
+
+0xA4, 0x5B, 0x7A, 0x40, 0x62, 0x1A, 0x59, 0x1F, 
+0x2E, 0xB0, 0xEA, 0xB7, 0x8B, 0xC6, 0xC4, 0x82, 
+0xBE, 0xC4, 0xFD, 0xD3, 0xFE, 0x7E, 0x4F, 0xA1, 
+0x24, 0xE3, 0xC9, 0xC8, 0x12, 0x44, 0x29, 0xF5, 
+0x69, 0xAE, 0x1B, 0x6B, 0x89, 0x31, 0x3F, 0x3D, 
+0x38, 0x5A, 0x8B, 0x3E, 0xD7, 0x0E, 0xC7, 0xFA, 
+0xCC, 0xBE, 0x76, 0x5E, 0x98, 0x10, 0xC9, 0xF6, 
+0x7D, 0x00, 0x7D, 0x01, 0x85, 
+
+0xDA, 0x65, 0xB2, 0xEC, 0xB6, 0xCF, 0x2F, 0xD7, 
+0x7F, 0x7E, 0x25, 0x7C, 0xA1, 0x14, 0x37, 0x88, 
+0xDC, 0x74, 0x93, 0xDC, 0x16, 0x9D, 0x35, 0x9B, 
+0x28, 0x88, 0x6F, 0x9F, 0xB8, 0xE1, 0xF2, 0x90, 
+0xE2, 0xB0, 0x6C, 0xB7, 0x1E, 0xD8, 0x54, 0xB5, 
+0x3E, 0x2A, 0xA6, 0x77, 0x4D, 0x11, 0x86, 0xE7, 
+0x4F, 0x3D, 0xF0, 0xE6, 0x77, 0xF5, 0x0D, 0xCE, 
+0x29, 0x9F, 0x90, 0x41, 0x7E, 0x02, 
+
+0x38, 0xCE, 0x2A, 0x47, 0xA7, 0xBE, 0xB6, 0x3A, 
+0x5C, 0x71, 0xEA, 0x9E, 0x59, 0x12, 0xF2, 0xED, 
+0xA8, 0x49, 0xED, 0x55, 0x67, 0x34, 0xEE, 0x97, 
+0xE4, 0x44, 0x48, 0x77, 0x29, 0xB7, 0x27, 0x6C, 
+0xFD, 0x93, 0x99, 0x3B, 0xEC, 0x5F, 0x8F, 0x04, 
+0xCA, 0x16, 0x9C, 0x31, 0xCE, 0xB4, 0x3B, 0x22, 
+0x57, 0x6A, 0x09, 0xC7, 0x02, 0x45, 0x3D, 0xD3, 
+0x06, 0x26, 0xC1, 0x4D, 0xD0, 0xFC, 0xD1, 
+
+0xD9, 0xAD, 0x95, 0xD9, 0x03, 0xF3, 0x2C, 0x13, 
+0x10, 0x0A, 0x40, 0xC0, 0xA0, 0xE8, 0xF4, 0xC0, 
+0x8D, 0xC2, 0x6E, 0xAD, 0x69, 0xD1, 0x0D, 0xC3, 
+0xF3, 0xD3, 0x80, 0xBA, 0xE9, 0xC0, 0xDB, 0x27, 
+0x57, 0xE2, 0x70, 0x9F, 0xDC, 0x7E, 0xF1, 0x8E, 
+0xD6, 0x81, 0xCF, 0x5C, 0xCE, 0x10, 0x18, 0x64, 
+0xA4, 0x54, 0xDC, 0x50, 0xC4, 0x5C, 0x38, 0xC7, 
+0x8B, 0xD7, 0x93, 0xD4, 0x44, 0xB8, 0xF7, 0xCD, 
+
+0x5F, 0xA9, 0xB9, 0x86, 0x88, 0x62, 0x68, 0x4F, 
+0x3D, 0xED, 0xE5, 0x0A, 0x37, 0xF1, 0x83, 0xF3, 
+0xE4, 0x4A, 0xE2, 0x12, 0x95, 0x7E, 0xF3, 0x48, 
+0xE4, 0x3C, 0x72, 0x7F, 0xDF, 0x3B, 0x95, 0xF5, 
+0x74, 0xC0, 0x9C, 0x30, 0x2D, 0x31, 0x5E, 0xF1, 
+0x45, 0x82, 0xA4, 0x44, 0xD2, 0xD1, 0xF1, 0x6D, 
+0x7C, 0xF8, 0xF1, 0x30, 0xF1, 0xAC, 0xCA, 0xED, 
+0x35, 0x32, 0x1B, 0xA3, 0x14, 0x70, 0xEF, 0x7A, 
+0x60, 
+
+0x9C, 0xC0, 0x6A, 0xFE, 0xF8, 0xBD, 0x1B, 0x1F, 
+0x18, 0x5E, 0x8C, 0x5D, 0x4E, 0x88, 0xF5, 0xD4, 
+0x1C, 0x07, 0x69, 0xE2, 0x7C, 0xAB, 0x7F, 0x8F, 
+0x8C, 0x0D, 0x7E, 0x53, 0xAE, 0xCB, 0x5F, 0xE4, 
+0xF0, 0xCF, 0xA1, 0x30, 0x35, 0x72, 0x88, 0xC1, 
+0x44, 0xF9, 0x7F, 0x27, 0x57, 0xCE, 0x81, 0x56, 
+0x2B, 0x31, 0x97, 0x70, 0x27, 0x87, 0xE6, 0x3A, 
+0x91, 0x78, 0x22, 0xC2, 0x9C, 0x5E, 0xCB, 0x23, 
+0xDC, 0x32, 
+
+0xF8, 0x1F, 0x51, 0x1E, 0x9A, 0x92, 0x15, 0x1B, 
+0x83, 0xD0, 0x68, 0x56, 0xE1, 0x25, 0x83, 0x78, 
+0xF4, 0x2C, 0x40, 0x09, 0x59, 0x82, 0xDF, 0xB2, 
+0xC3, 0xB5, 0x70, 0x61, 0xC4, 0x98, 0x25, 0x6C, 
+0x8C, 0x8D, 0x23, 0xDA, 0x1D, 0x0A, 0x06, 0x36, 
+0xAD, 0xA3, 0xE3, 0x74, 0x8B, 0xF9, 0x2B, 0x68, 
+0xEC, 0x04, 0x34, 0x1A, 0x57, 0x6E, 0x7A, 0xB8, 
+0x43, 0xAE, 0x00, 0x51, 0x8F, 0x46, 0x98, 0x33, 
+0x0C, 0x2C, 0xA3, 
+
+0x2B, 0x51, 0xE8, 0xFE, 0x51, 0xB8, 0x0A, 0xD0, 
+0x27, 0x86, 0x6F, 0xAD, 0x0A, 0xA0, 0x28, 0x74, 
+0x75, 0xD9, 0xCC, 0x05, 0x5C, 0x1F, 0xEF, 0x44, 
+0x01, 0x7A, 0x98, 0x68, 0xA9, 0x0D, 0x8E, 0x07, 
+0x78, 0x25, 0xEC, 0xDD, 0x58, 0x91, 0xB2, 0x16, 
+0x67, 0x19, 0xB2, 0x4D, 0x0E, 0x20, 0x55, 0x04, 
+0x61, 0xDA, 0x4B, 0xDA, 0x97, 0x42, 0xB2, 0xF4, 
+0x47, 0x5C, 0xAF, 0x65, 0x5F, 0xF1, 0x01, 0x9F, 
+0x64, 0x4E, 0x63, 0x12, 
+
+0x78, 0xEC, 0x57, 0xAB, 0x1C, 0x04, 0xAD, 0xBE, 
+0xBC, 0xDB, 0xB5, 0x3A, 0x6A, 0x9A, 0x13, 0x7E, 
+0x1A, 0x3E, 0xA4, 0xA7, 0x01, 0xE9, 0xC3, 0x40, 
+0x3F, 0xAB, 0x84, 0xB8, 0xB2, 0x52, 0xE5, 0x3B, 
+0xEF, 0x17, 0xF2, 0xB4, 0x1F, 0x02, 0xDC, 0xEE, 
+0x7A, 0x31, 0xFA, 0x28, 0x6C, 0xCE, 0x8B, 0x6A, 
+0x13, 0x1A, 0xEB, 0x96, 0xBD, 0x0F, 0xA2, 0x9C, 
+0x8D, 0xE9, 0x9B, 0x35, 0xFD, 0x81, 0xFA, 0x60, 
+0x50, 0xB9, 0x43, 0x3F, 0x4B, 
+
+0xC0, 0x11, 0xE1, 0xCB, 0xC1, 0x5E, 0xA1, 0xDB, 
+0x7A, 0x42, 0xB6, 0x60, 0xCB, 0x11, 0x33, 0x5D, 
+0x52, 0x2A, 0xE9, 0x13, 0xF4, 0x97, 0xE6, 0x8B, 
+0xF5, 0x44, 0xF3, 0x72, 0xF7, 0x67, 0x9E, 0x73, 
+0x56, 0xF8, 0xE2, 0x1E, 0x44, 0x60, 0x96, 0xCB, 
+0x33, 0x9A, 0xD3, 0x2C, 0xBE, 0xD9, 0x5D, 0x2A, 
+0xB7, 0x31, 0xD6, 0xA9, 0x9A, 0xF8, 0x35, 0x95, 
+0xF3, 0xD1, 0x85, 0xC8, 0xFC, 0x63, 0xF9, 0xB1, 
+0x95, 0x6D, 0x2A, 0xFC, 0x17, 0x36, 
+
+0xC8, 0x4A, 0x63, 0x97, 0x46, 0xD7, 0x32, 0xFC, 
+0x1A, 0x3C, 0xE1, 0x6F, 0xA7, 0xDF, 0xF9, 0xF5, 
+0x5A, 0x77, 0xA6, 0xA9, 0xD5, 0x73, 0xBF, 0xD7, 
+0x76, 0x8F, 0x69, 0xDB, 0x9E, 0xE3, 0x36, 0x8A, 
+0x5B, 0x21, 0x38, 0x45, 0x03, 0xB5, 0xE0, 0x8A, 
+0xBA, 0x88, 0x91, 0xDD, 0x26, 0x27, 0x37, 0x0F, 
+0x1E, 0x16, 0x33, 0x61, 0x98, 0x46, 0x2A, 0xE8, 
+0x7D, 0x2F, 0x72, 0x7D, 0x95, 0xA3, 0xAC, 0xDE, 
+0x59, 0x48, 0x2E, 0x51, 0xE6, 0x0F, 0x57, 
+
+0x21, 0x90, 0xB9, 0x7C, 0x71, 0xCA, 0x77, 0xA3, 
+0x4F, 0x03, 0x3C, 0xEB, 0x2D, 0x53, 0xD0, 0x6D, 
+0xC5, 0xBD, 0x29, 0xE1, 0x0E, 0x65, 0x47, 0x03, 
+0xF6, 0xF9, 0xA9, 0xDE, 0x9B, 0x4E, 0xB0, 0xD6, 
+0xD9, 0x4C, 0x9F, 0x57, 0xB9, 0x85, 0xF1, 0x7E, 
+0x99, 0x72, 0x0E, 0x82, 0x00, 0x90, 0x84, 0xD5, 
+0x3A, 0x84, 0xA0, 0x8D, 0x7D, 0xB7, 0xD4, 0x26, 
+0x94, 0xED, 0xE8, 0x94, 0x70, 0xB2, 0xC0, 0xAE, 
+0xA6, 0xF4, 0x5F, 0xFB, 0xAF, 0xFE, 0x8D, 0x34, 
+
+0x0D, 0x6B, 0xD1, 0x29, 0xB5, 0x03, 0x72, 0x43, 
+0x1D, 0xC0, 0x1F, 0x09, 0xA6, 0xEA, 0x7D, 0x81, 
+0x5E, 0xE2, 0x16, 0x59, 0xBD, 0xB9, 0x8F, 0x6C, 
+0x0D, 0x63, 0xF6, 0x56, 0x11, 0x14, 0x2C, 0xCD, 
+0x4C, 0xB3, 0x61, 0x1F, 0x6D, 0x35, 0xFC, 0xE0, 
+0x95, 0x8E, 0xA0, 0xCA, 0x64, 0xCB, 0xC3, 0xD7, 
+0x23, 0x01, 0x87, 0x9E, 0x76, 0x6D, 0x3B, 0x8B, 
+0x66, 0x0B, 0xB5, 0x0B, 0x53, 0x2F, 0x6F, 0x17, 
+0x41, 0xE2, 0x23, 0x75, 0x23, 0xF2, 0x69, 0x45, 
+0xB2, 
+
+0x61, 0x69, 0x92, 0xA7, 0x8E, 0x50, 0x23, 0x36, 
+0x76, 0x1D, 0x6E, 0x54, 0x20, 0x5F, 0xD6, 0xDA, 
+0xEE, 0xC9, 0x0E, 0xED, 0x2A, 0xA3, 0xD6, 0x4E, 
+0x12, 0x58, 0x99, 0x87, 0xAE, 0x88, 0x2C, 0x60, 
+0x49, 0x18, 0xD1, 0x7E, 0x42, 0x70, 0xD9, 0x56, 
+0x33, 0x95, 0x7F, 0x9F, 0x67, 0xF6, 0x50, 0xC9, 
+0xE8, 0x17, 0x0D, 0x0D, 0x8F, 0x20, 0x0D, 0xA7, 
+0xF7, 0xFE, 0xD0, 0x91, 0x95, 0xF0, 0x32, 0xA7, 
+0x7B, 0xE7, 0xE3, 0xD6, 0x8C, 0x3F, 0x99, 0xCF, 
+0x23, 0x92, 
+
+0x7D, 0x03, 0x84, 0xD5, 0xAA, 0xDA, 0xE5, 0xA4, 
+0x82, 0x37, 0xCC, 0x5C, 0x00, 0x7E, 0x9E, 0x3D, 
+0x80, 0x5A, 0x44, 0xB1, 0xB7, 0x95, 0x3B, 0x72, 
+0xD2, 0x8F, 0xAE, 0x61, 0x14, 0xF7, 0x01, 0x04, 
+0x68, 0x46, 0xED, 0x29, 0x3D, 0x00, 0x9F, 0xF2, 
+0xBC, 0x77, 0xA2, 0x30, 0x03, 0xA9, 0x83, 0xBB, 
+0xC9, 0x3D, 0x1C, 0x73, 0x88, 0xD4, 0x8F, 0xFA, 
+0x22, 0x36, 0x10, 0x45, 0xEC, 0x2B, 0xAC, 0x09, 
+0xE0, 0x5C, 0x8C, 0x53, 0x94, 0xDF, 0xF7, 0xD0, 
+0xB1, 0xFD, 0x27, 
+
+0x17, 0x4A, 0xFA, 0xB7, 0xC0, 0x9E, 0x07, 0xEC, 
+0x2A, 0x33, 0xC7, 0x2B, 0x41, 0xAC, 0x6C, 0xD6, 
+0x0C, 0xA6, 0x19, 0xA6, 0xF7, 0xED, 0xC1, 0x5B, 
+0x10, 0xA5, 0x09, 0x10, 0x8C, 0x86, 0x1C, 0x0E, 
+0x9D, 0x61, 0xB6, 0xB3, 0x6D, 0xFD, 0x14, 0xBA, 
+0x04, 0xAE, 0x06, 0x26, 0x3B, 0xE9, 0xBF, 0x83, 
+0x32, 0x61, 0x13, 0x28, 0x4F, 0x35, 0x2E, 0xC2, 
+0x21, 0x6F, 0xAA, 0xD8, 0xF3, 0x89, 0xF4, 0xB3, 
+0xB2, 0xB5, 0xF8, 0xD9, 0x0D, 0x8E, 0x9A, 0x01, 
+0x29, 0xCF, 0x19, 0xD3, 
+
+0xD8, 0x57, 0x8A, 0x6B, 0x83, 0xA7, 0x48, 0xCE, 
+0xBA, 0x0E, 0xAE, 0x4A, 0x9A, 0x92, 0x59, 0xB4, 
+0x4A, 0xBF, 0x26, 0x55, 0x2A, 0x47, 0x2E, 0x88, 
+0x52, 0x85, 0xAA, 0x8C, 0x94, 0x53, 0x9D, 0xEA, 
+0x8B, 0xC4, 0x23, 0xFF, 0x31, 0x34, 0x95, 0xF0, 
+0x35, 0xBA, 0x36, 0x96, 0x37, 0x2E, 0xEF, 0xB4, 
+0x1F, 0x58, 0xEB, 0x19, 0xC7, 0x92, 0xBA, 0x99, 
+0x32, 0x0B, 0x9E, 0xBB, 0x8B, 0x51, 0x77, 0x56, 
+0x0A, 0x6E, 0x3D, 0x16, 0x62, 0xF8, 0x8A, 0x1F, 
+0x72, 0x5A, 0x71, 0x9B, 0xF9, 
+
+0x76, 0x01, 0xF1, 0x47, 0x7B, 0x0D, 0x42, 0x44, 
+0x8B, 0x93, 0xB5, 0x05, 0x11, 0x8C, 0x46, 0x2C, 
+0x0D, 0xD0, 0x54, 0x7B, 0xC0, 0xFE, 0x66, 0x94, 
+0x56, 0x81, 0xD4, 0xB8, 0xBC, 0x3B, 0x51, 0xCE, 
+0xE2, 0xA1, 0x10, 0x84, 0xF1, 0xE8, 0x1E, 0xFD, 
+0xCC, 0x12, 0x2A, 0x48, 0x9E, 0x4F, 0x65, 0x01, 
+0x80, 0x60, 0x0E, 0x12, 0x9C, 0x33, 0xFC, 0xB6, 
+0x54, 0x53, 0x01, 0x8C, 0x57, 0x08, 0x13, 0x5A, 
+0xCA, 0xAE, 0x5D, 0x45, 0xF1, 0x35, 0xF5, 0x18, 
+0xDD, 0x5B, 0x97, 0xB5, 0x6D, 0x27, 
+
+0x85, 0x00, 0x15, 0xB0, 0x51, 0xE8, 0x5E, 0xB5, 
+0x06, 0x6B, 0xCC, 0x3C, 0x00, 0xEC, 0xC8, 0x70, 
+0xA7, 0xB4, 0x01, 0xCE, 0x1C, 0x3F, 0x24, 0x4D, 
+0x6D, 0x57, 0xF0, 0xA7, 0xF9, 0x76, 0xDD, 0xA9, 
+0xF8, 0x8F, 0x90, 0x73, 0x0B, 0x01, 0xC4, 0x17, 
+0x11, 0x3C, 0xE7, 0xEB, 0xB5, 0xF3, 0xA4, 0x40, 
+0xD2, 0xCF, 0x70, 0xBD, 0xE3, 0x0D, 0xFD, 0xC5, 
+0xBF, 0xE2, 0xFE, 0xE2, 0x21, 0x09, 0x36, 0x2A, 
+0xDE, 0x90, 0x3E, 0x0C, 0xE2, 0x0B, 0xCF, 0xEC, 
+0xE3, 0x64, 0xF4, 0xAD, 0x66, 0xF5, 0x86, 
+
+0x3D, 0xED, 0xAE, 0x83, 0x5E, 0x92, 0x0F, 0xE5, 
+0x6E, 0x93, 0xF5, 0xA0, 0x75, 0x58, 0xEC, 0xD2, 
+0x50, 0x20, 0x17, 0x91, 0x5F, 0xED, 0x8B, 0x75, 
+0x21, 0x00, 0x60, 0x57, 0xA4, 0xA8, 0x60, 0x9D, 
+0x36, 0x34, 0x75, 0x39, 0xC9, 0x42, 0x34, 0x17, 
+0xD8, 0xC2, 0x37, 0xC6, 0x43, 0x95, 0xE2, 0x19, 
+0x06, 0x33, 0xD2, 0x70, 0x2D, 0x49, 0x66, 0x77, 
+0x01, 0x43, 0x0A, 0x26, 0x53, 0x40, 0x1D, 0x33, 
+0xCB, 0x1D, 0xF4, 0x40, 0xE4, 0x73, 0x30, 0x5B, 
+0x95, 0x34, 0x04, 0x9C, 0x75, 0x13, 0x84, 0x43, 
+
+0x7C, 0xD0, 0xE0, 0x23, 0x87, 0x59, 0x32, 0xA1, 
+0x81, 0x33, 0x85, 0x79, 0x9B, 0x13, 0xEE, 0xF0, 
+0xED, 0xDA, 0xA1, 0xDD, 0x6E, 0xE7, 0xF1, 0xC6, 
+0xB7, 0x56, 0x16, 0x29, 0x26, 0x7D, 0x1A, 0x50, 
+0x56, 0x4F, 0xEF, 0x66, 0x6E, 0x2F, 0x5C, 0xAB, 
+0xB8, 0x98, 0x75, 0xB5, 0x33, 0x41, 0x8F, 0x7A, 
+0x6C, 0x0E, 0xBB, 0xEE, 0x4F, 0x3E, 0xF6, 0xFD, 
+0xEA, 0x7D, 0x7D, 0xE6, 0x6C, 0x5C, 0xDE, 0x06, 
+0x11, 0x8E, 0xE1, 0x41, 0x8F, 0x92, 0x08, 0xB9, 
+0xA0, 0xC2, 0x31, 0xD1, 0xD5, 0xEC, 0x64, 0x77, 
+0x77, 
+
+0xD6, 0xDC, 0x30, 0xDE, 0xF5, 0x14, 0xD9, 0x22, 
+0xA2, 0xDE, 0xD2, 0x02, 0xF2, 0x81, 0xA2, 0x83, 
+0x95, 0x97, 0x4C, 0xFC, 0xA7, 0xC9, 0x67, 0xE9, 
+0xF7, 0x90, 0x53, 0x74, 0x65, 0x3D, 0x24, 0x3E, 
+0x27, 0xB6, 0xD2, 0xE1, 0xDA, 0x2C, 0xD2, 0xE3, 
+0x79, 0xD3, 0x19, 0xDF, 0xC1, 0x58, 0x17, 0xD5, 
+0xE9, 0x4E, 0x55, 0x25, 0x62, 0x8C, 0xDB, 0xA2, 
+0x6C, 0x4C, 0xC3, 0x72, 0x4A, 0xDB, 0xBB, 0x8C, 
+0x25, 0xA3, 0xED, 0x22, 0x5F, 0xAF, 0x70, 0x68, 
+0x0B, 0xD8, 0x78, 0x93, 0xCC, 0x85, 0xBB, 0xFD, 
+0xB2, 0xF0, 
+
+0xA5, 0x36, 0x76, 0x11, 0x83, 0xBC, 0xAA, 0x67, 
+0x3E, 0x57, 0x98, 0xF4, 0xDA, 0xEC, 0xDC, 0xC5, 
+0x52, 0x0F, 0x8A, 0x02, 0x5B, 0x82, 0x96, 0xDE, 
+0x21, 0x04, 0x9D, 0x2B, 0x8E, 0x1B, 0x56, 0xBD, 
+0x94, 0x61, 0xAA, 0x0D, 0xD9, 0xBD, 0xEF, 0xD7, 
+0x8F, 0x30, 0x34, 0xCF, 0xCE, 0x53, 0x84, 0x19, 
+0x9F, 0xF2, 0x88, 0x5D, 0x32, 0x4F, 0xF6, 0xFF, 
+0xF9, 0x41, 0x98, 0xAA, 0x68, 0x59, 0x14, 0x93, 
+0x78, 0x4B, 0xD5, 0x49, 0xC2, 0x9B, 0x1A, 0x32, 
+0xCA, 0xD5, 0x49, 0xF2, 0xAB, 0x4C, 0x82, 0x5C, 
+0xE2, 0x09, 0x1C, 
+
+0xF3, 0xD7, 0xA3, 0x99, 0x33, 0xF1, 0xBF, 0x18, 
+0xEC, 0xB4, 0xA1, 0x73, 0x79, 0x46, 0x6A, 0xB0, 
+0x21, 0xC3, 0xB6, 0x86, 0x24, 0x65, 0x72, 0x44, 
+0xBC, 0x3D, 0x6C, 0x47, 0xCF, 0x35, 0xA9, 0x24, 
+0x52, 0xAA, 0xB9, 0x56, 0xA2, 0xAF, 0xB7, 0xC4, 
+0x53, 0x4A, 0xEC, 0x6C, 0xC3, 0xB9, 0x4E, 0xEE, 
+0x14, 0xF3, 0xFA, 0x8B, 0xB0, 0xF3, 0xB1, 0x34, 
+0x34, 0x89, 0xF5, 0xBF, 0xC3, 0x07, 0x13, 0xC8, 
+0x35, 0xB3, 0x8A, 0xFF, 0x50, 0xE2, 0x68, 0x3A, 
+0x09, 0x62, 0x04, 0x43, 0x42, 0x9C, 0x88, 0x0D, 
+0x74, 0x45, 0x2C, 0x98, 
+
+0x64, 0x04, 0x30, 0x4E, 0xAE, 0x56, 0x56, 0x95, 
+0x71, 0x45, 0x7E, 0xBB, 0xD8, 0xDC, 0x9B, 0xA1, 
+0xD9, 0x8E, 0x8B, 0x0C, 0xF1, 0x0D, 0xFD, 0xCB, 
+0xFE, 0x24, 0xD7, 0xD5, 0x7C, 0x90, 0x00, 0x10, 
+0xA8, 0x83, 0x51, 0x7C, 0x06, 0x41, 0x65, 0xAD, 
+0x4C, 0x77, 0x4E, 0x75, 0x08, 0xF8, 0x6F, 0x49, 
+0x39, 0x97, 0xC5, 0x12, 0x9C, 0x86, 0x79, 0xE6, 
+0x91, 0x78, 0x5D, 0x32, 0x16, 0xF6, 0xED, 0x88, 
+0x40, 0x84, 0xAD, 0xD3, 0x44, 0x63, 0x7D, 0x60, 
+0x96, 0x97, 0xDE, 0x57, 0x11, 0xC7, 0x45, 0xEE, 
+0xD7, 0x36, 0x12, 0x84, 0x55, 
+
+0x42, 0x99, 0xBF, 0x3E, 0xCC, 0xA1, 0x79, 0xB2, 
+0xE7, 0x60, 0x98, 0xEF, 0x49, 0xD9, 0x78, 0xA4, 
+0x49, 0x3F, 0x7D, 0x97, 0x53, 0x45, 0x29, 0x22, 
+0x2A, 0x79, 0x22, 0xD1, 0x93, 0x54, 0x99, 0x8E, 
+0x2F, 0x38, 0x14, 0x1D, 0x6C, 0xCA, 0x0A, 0x4D, 
+0xAD, 0xFF, 0x39, 0x09, 0xC9, 0xCB, 0xDA, 0x64, 
+0x16, 0x01, 0x5C, 0xBC, 0x83, 0x7F, 0x60, 0x68, 
+0x98, 0xF1, 0x53, 0xD6, 0xF1, 0x29, 0x77, 0x92, 
+0x7E, 0xD3, 0xAA, 0x08, 0x5C, 0x32, 0xBC, 0x35, 
+0x67, 0x5A, 0x45, 0x65, 0x09, 0xE9, 0x80, 0xE5, 
+0x31, 0x1A, 0xA2, 0x2C, 0xC9, 0x71, 
+
+0xD6, 0x2C, 0x7A, 0x7E, 0xEE, 0x8B, 0x5C, 0x02, 
+0x72, 0x89, 0x1A, 0x6A, 0x61, 0x4E, 0xF9, 0x9A, 
+0x2C, 0x8A, 0x14, 0x47, 0x26, 0xD3, 0x0F, 0x46, 
+0x64, 0x4F, 0xF3, 0x07, 0xD9, 0xBA, 0x6E, 0x5D, 
+0x28, 0x23, 0xBA, 0xF1, 0x1C, 0xAB, 0xA0, 0x25, 
+0x49, 0xFB, 0x5D, 0x40, 0xCF, 0x54, 0x9F, 0x89, 
+0x6C, 0xB1, 0x30, 0xD0, 0xF5, 0x9A, 0x59, 0x85, 
+0x7F, 0xAD, 0x9B, 0x71, 0xCA, 0x81, 0xB5, 0x66, 
+0x5A, 0x7D, 0x38, 0x40, 0x35, 0x2C, 0x7C, 0xD0, 
+0xC8, 0xC4, 0xA3, 0x9B, 0xF7, 0x3D, 0x4F, 0x29, 
+0x64, 0x41, 0xB4, 0x57, 0xB9, 0x7D, 0x23, 
+
+0xF5, 0x3F, 0xC9, 0xC7, 0xDD, 0x61, 0x9F, 0xEB, 
+0xE5, 0xE3, 0x95, 0xA1, 0x20, 0x77, 0x9D, 0x17, 
+0x69, 0xF1, 0x42, 0x6C, 0x4D, 0x4E, 0xB3, 0x59, 
+0x19, 0xAD, 0xC0, 0x4C, 0x98, 0xB1, 0xF6, 0xB3, 
+0xD7, 0x4A, 0x72, 0xE2, 0x8E, 0x25, 0x2F, 0x2F, 
+0x5C, 0xDC, 0x54, 0x83, 0x38, 0x71, 0x42, 0x4C, 
+0xDB, 0x1E, 0x66, 0x49, 0x62, 0x7A, 0xC0, 0x63, 
+0x57, 0xFB, 0x0B, 0xFF, 0xD6, 0x92, 0x0C, 0xAB, 
+0x08, 0x99, 0x40, 0x91, 0x92, 0x3E, 0x71, 0x87, 
+0x5A, 0xE6, 0x00, 0x60, 0xAF, 0x57, 0x68, 0x9F, 
+0xE4, 0x91, 0xD5, 0xE0, 0x89, 0x07, 0xBF, 0x83, 
+
+0x97, 0x93, 0x44, 0x38, 0xAB, 0x80, 0x73, 0x0E, 
+0xBE, 0x9C, 0x8C, 0x71, 0x23, 0xFE, 0x56, 0x6A, 
+0xA0, 0xFB, 0x79, 0xF8, 0x5C, 0xE4, 0x3C, 0x97, 
+0xAE, 0xEF, 0x1B, 0xFD, 0x8D, 0x91, 0xAF, 0xEA, 
+0x83, 0x4D, 0x06, 0x81, 0xCD, 0x02, 0x90, 0xEB, 
+0xD8, 0x14, 0x59, 0xA7, 0xB0, 0xB6, 0xFE, 0x7E, 
+0xF2, 0x06, 0x97, 0x88, 0xD7, 0x34, 0x8A, 0x70, 
+0x5F, 0xED, 0xBD, 0x3C, 0x27, 0x05, 0xB9, 0xA1, 
+0x92, 0xE5, 0x03, 0x1D, 0x9F, 0xC2, 0x15, 0xEB, 
+0x8C, 0x02, 0x56, 0x60, 0x5A, 0xCE, 0x26, 0x80, 
+0xDD, 0x80, 0x9D, 0x7B, 0xF8, 0x5C, 0x8E, 0x4E, 
+0x83, 
+
+0x81, 0xB0, 0x45, 0xBE, 0x92, 0x90, 0xFC, 0x30, 
+0x74, 0xDC, 0x2B, 0x0C, 0x95, 0xF6, 0xAA, 0x31, 
+0x0E, 0x7D, 0x18, 0x92, 0x9F, 0xEB, 0xD1, 0x58, 
+0x74, 0xFE, 0x3F, 0x31, 0xDA, 0x7D, 0x09, 0x57, 
+0xC0, 0x41, 0xF7, 0xDF, 0x74, 0x30, 0xA9, 0x3A, 
+0x89, 0xF2, 0x6F, 0x2D, 0xB5, 0xFD, 0xB9, 0x15, 
+0x86, 0x12, 0x82, 0xE5, 0x4A, 0x3D, 0xF4, 0xD3, 
+0x28, 0x5C, 0xA0, 0x82, 0x98, 0x51, 0xE6, 0x80, 
+0x98, 0x3E, 0x25, 0x86, 0xE7, 0x42, 0x13, 0x1A, 
+0x12, 0x3A, 0x3A, 0xD9, 0x46, 0x9F, 0x4D, 0x13, 
+0x16, 0x3A, 0x5C, 0xBE, 0x86, 0x74, 0x83, 0x30, 
+0x69, 0xB3, 
+
+0x67, 0x96, 0xE5, 0x8B, 0x08, 0x81, 0x25, 0x1B, 
+0xE9, 0xAF, 0x86, 0x68, 0x85, 0x98, 0x64, 0x07, 
+0x12, 0x83, 0x11, 0x3F, 0xFB, 0xA5, 0xFC, 0x9B, 
+0x4E, 0x82, 0x75, 0xE4, 0x09, 0x44, 0x71, 0x68, 
+0x23, 0x37, 0xAF, 0x1F, 0x58, 0x53, 0x07, 0x22, 
+0x0A, 0x6C, 0x64, 0xE6, 0x4D, 0x7D, 0x96, 0xAE, 
+0x5B, 0x73, 0xD1, 0x9C, 0x7F, 0xA5, 0x12, 0x39, 
+0x4E, 0x9B, 0x72, 0x6D, 0x6A, 0xE1, 0x3E, 0x8F, 
+0xDE, 0x44, 0xBF, 0x21, 0x98, 0x53, 0xDC, 0x35, 
+0x47, 0x97, 0x56, 0xE4, 0xAB, 0x19, 0xCA, 0x39, 
+0xD7, 0x34, 0x88, 0x97, 0x56, 0x13, 0x62, 0xF9, 
+0x77, 0x7A, 0x3D, 
+
+0xA3, 0x58, 0xAA, 0x42, 0x9C, 0x4B, 0x20, 0x79, 
+0xE3, 0xED, 0xC8, 0x31, 0x97, 0x8D, 0x98, 0xD4, 
+0x26, 0x00, 0xB6, 0xF6, 0x0F, 0x6A, 0x2F, 0x25, 
+0xC1, 0xDB, 0xB0, 0x57, 0x93, 0xDE, 0x53, 0x8F, 
+0xF4, 0x6C, 0xE7, 0xA9, 0x1A, 0x4A, 0x3E, 0x48, 
+0xAC, 0xA4, 0x5C, 0xDA, 0x14, 0x20, 0xCC, 0x18, 
+0xB1, 0xDD, 0x94, 0xA0, 0x25, 0x02, 0x72, 0x72, 
+0xA1, 0xE1, 0x42, 0x15, 0xB9, 0x7A, 0x6D, 0xAF, 
+0x2A, 0x5C, 0xB7, 0xF0, 0xA7, 0x06, 0x15, 0xA5, 
+0xAB, 0x52, 0x9B, 0x2C, 0xF0, 0x3B, 0x30, 0x67, 
+0x9D, 0xFA, 0x6A, 0x31, 0xFB, 0x4A, 0xF0, 0x3D, 
+0x4E, 0x74, 0x36, 0xAC, 
+
+0xC2, 0xB4, 0x74, 0x6B, 0x15, 0x7E, 0xDF, 0x04, 
+0x69, 0x0B, 0x93, 0xFF, 0xCB, 0x2E, 0x91, 0xF3, 
+0x8D, 0x1A, 0xBB, 0x42, 0x3B, 0x1B, 0x59, 0xEB, 
+0x55, 0x95, 0x43, 0xC0, 0x28, 0xD4, 0xFD, 0x58, 
+0x68, 0x84, 0x5D, 0x04, 0x09, 0xA4, 0x6D, 0x6B, 
+0xBD, 0xC9, 0x97, 0x39, 0xC5, 0x8A, 0xD9, 0x00, 
+0x1F, 0x76, 0x6E, 0x4D, 0xE4, 0xB8, 0x74, 0x7A, 
+0x6A, 0x9F, 0xAE, 0x96, 0x4E, 0xE2, 0x6E, 0x35, 
+0x10, 0x01, 0xFE, 0xB1, 0xDE, 0xF0, 0xF5, 0xA5, 
+0xF6, 0x18, 0x71, 0xF9, 0xBE, 0x98, 0xAD, 0x53, 
+0x5B, 0xC1, 0xD4, 0x56, 0x70, 0xC0, 0x47, 0x78, 
+0xC0, 0x8F, 0xC2, 0x73, 0x73, 
+
+0x33, 0x90, 0x57, 0x5C, 0x87, 0x2F, 0x23, 0x1D, 
+0x28, 0xCB, 0x32, 0x04, 0xE2, 0x17, 0xC4, 0x9C, 
+0xFE, 0x70, 0x6D, 0xE4, 0x54, 0xD0, 0x29, 0x88, 
+0x27, 0xF8, 0xA8, 0x44, 0x36, 0xDC, 0xA8, 0x5C, 
+0x0E, 0xA4, 0xFE, 0xD2, 0x73, 0x9A, 0xEE, 0xC8, 
+0xC7, 0x9D, 0xAA, 0x44, 0x53, 0x9B, 0x27, 0x4B, 
+0x9D, 0xA8, 0xE3, 0x78, 0x67, 0x90, 0x6E, 0x46, 
+0xF0, 0x26, 0xCF, 0x44, 0x4C, 0x4D, 0x3E, 0x34, 
+0x2A, 0x17, 0x73, 0x5C, 0xA4, 0xF8, 0x6E, 0x38, 
+0x6E, 0x90, 0x0C, 0x28, 0x7C, 0x4B, 0x4A, 0xE6, 
+0x63, 0x37, 0xEC, 0x28, 0xB4, 0x96, 0x0C, 0x05, 
+0xD8, 0xC7, 0x42, 0xCD, 0x6F, 0x32, 
+
+0x9B, 0x95, 0x3E, 0x61, 0x26, 0x27, 0x44, 0x03, 
+0xB8, 0x70, 0x72, 0xDE, 0x98, 0x86, 0x28, 0x2B, 
+0x6B, 0x27, 0x9F, 0x7B, 0xE3, 0xE6, 0x96, 0x56, 
+0x2A, 0x14, 0xB6, 0x5F, 0xF3, 0x20, 0x3F, 0x33, 
+0x5B, 0x56, 0x64, 0x5B, 0xC7, 0x52, 0x34, 0xD9, 
+0x39, 0x9B, 0x2D, 0x96, 0x31, 0x50, 0xAE, 0xDE, 
+0xFC, 0x5E, 0x76, 0x36, 0xAC, 0xF5, 0xBA, 0x70, 
+0xF4, 0xFD, 0x02, 0x11, 0xB5, 0x04, 0xDF, 0xCC, 
+0x8B, 0x75, 0x3D, 0x10, 0x86, 0x6E, 0xDF, 0x31, 
+0xBC, 0xBD, 0xA1, 0xE3, 0x9C, 0x08, 0xF8, 0x09, 
+0x05, 0x14, 0x6F, 0x1E, 0xDE, 0x44, 0xF3, 0x33, 
+0xF5, 0x3C, 0x1C, 0xE2, 0x3D, 0xAD, 0xA3, 
+
+0xB8, 0x39, 0xE7, 0x7F, 0x2F, 0x00, 0xD0, 0xD3, 
+0x6A, 0xDD, 0x98, 0x84, 0x9B, 0xD9, 0x84, 0xB7, 
+0x07, 0x8E, 0x17, 0xDE, 0x4F, 0xCA, 0x2F, 0x0A, 
+0xA6, 0x50, 0xFA, 0x52, 0x3C, 0x05, 0x73, 0x3E, 
+0xB7, 0xF5, 0xE5, 0x8F, 0xF7, 0x70, 0x0F, 0x18, 
+0x44, 0x73, 0x0E, 0x61, 0x1E, 0x0D, 0x8B, 0x17, 
+0x64, 0x4E, 0xE8, 0x45, 0x80, 0xDD, 0x8A, 0x0D, 
+0x6F, 0xD9, 0xD6, 0xFA, 0xE5, 0xF3, 0xBD, 0xE7, 
+0x9B, 0xAA, 0x7B, 0xB1, 0x2E, 0xB8, 0x07, 0x61, 
+0xE9, 0x76, 0x98, 0x87, 0x9A, 0xE8, 0x60, 0x1B, 
+0x61, 0x56, 0x4C, 0x83, 0x64, 0x12, 0xAA, 0x8B, 
+0x4C, 0xF5, 0xA3, 0x16, 0x34, 0xF0, 0x99, 0x70, 
+
+0x80, 0x64, 0xEA, 0x48, 0x6F, 0xE0, 0xA2, 0xAC, 
+0xED, 0x51, 0xB1, 0xD6, 0x4D, 0xFF, 0xDE, 0x5D, 
+0xEF, 0x9B, 0x59, 0x03, 0x5D, 0x53, 0x0E, 0xE1, 
+0x0E, 0x6F, 0x29, 0x94, 0xD0, 0x56, 0x20, 0xFA, 
+0x2E, 0x88, 0x67, 0xE2, 0x68, 0x0D, 0xF1, 0xD8, 
+0x3B, 0x90, 0xDD, 0xE6, 0xD0, 0xF8, 0x1F, 0x24, 
+0x4C, 0xBF, 0xD3, 0x51, 0x27, 0xF7, 0xDC, 0xF0, 
+0xB1, 0x4E, 0x49, 0xB7, 0x93, 0xBB, 0xE8, 0xC7, 
+0xC6, 0x1F, 0xD6, 0x25, 0x85, 0x48, 0x3D, 0x3A, 
+0xBF, 0x21, 0x17, 0x5E, 0x90, 0xE0, 0xE2, 0x93, 
+0x30, 0xE1, 0xD5, 0x54, 0x91, 0x13, 0x3E, 0x75, 
+0x88, 0xA5, 0x2F, 0xF1, 0x8F, 0x4A, 0x50, 0xDA, 
+0x18, 
+
+0x9E, 0x58, 0xD7, 0x8C, 0x2C, 0xCE, 0xDE, 0xDA, 
+0x88, 0x0B, 0xD2, 0xFF, 0x44, 0x59, 0x17, 0x76, 
+0x75, 0x46, 0x07, 0xC7, 0x3F, 0x99, 0xBA, 0x6A, 
+0xF6, 0x16, 0x23, 0xD6, 0xA2, 0x25, 0x4C, 0xC9, 
+0xD8, 0x9A, 0x5F, 0x13, 0xCD, 0x4C, 0xEF, 0x49, 
+0x9B, 0x15, 0x0D, 0xA2, 0x76, 0x09, 0x87, 0x29, 
+0x60, 0x7B, 0xCE, 0x0F, 0x17, 0x0E, 0x8C, 0xEA, 
+0x98, 0x46, 0x02, 0xD6, 0xAE, 0xD1, 0xF1, 0x5B, 
+0x54, 0x93, 0x0A, 0xF5, 0x09, 0xE2, 0x4B, 0xFD, 
+0x09, 0xB2, 0x09, 0x4A, 0x83, 0x46, 0xFE, 0x02, 
+0x71, 0xCA, 0xBB, 0xE1, 0xA7, 0x20, 0x00, 0x7E, 
+0xE2, 0xA8, 0x03, 0xF2, 0x1D, 0xBF, 0x6B, 0x4E, 
+0x28, 0x66, 
+
+0x0E, 0x77, 0x47, 0xDC, 0x62, 0x4C, 0x73, 0xF8, 
+0x2C, 0xAA, 0xE4, 0xF3, 0x7D, 0x25, 0x5D, 0xE3, 
+0x3D, 0x61, 0xDA, 0x38, 0x5E, 0xF9, 0xA6, 0x20, 
+0xD5, 0xC5, 0x89, 0x3B, 0x29, 0xE0, 0x7A, 0x90, 
+0x2C, 0xA7, 0x79, 0xD3, 0xC9, 0xED, 0xA2, 0x1A, 
+0x27, 0x8E, 0x94, 0xCF, 0x4B, 0xBC, 0xA8, 0xA0, 
+0xD7, 0xE0, 0x33, 0x2C, 0xE4, 0x13, 0x10, 0x0B, 
+0x47, 0xFA, 0x7D, 0xB1, 0x26, 0x16, 0x2D, 0x42, 
+0x43, 0xFB, 0x2E, 0x7F, 0xDF, 0x03, 0x24, 0x05, 
+0xA4, 0x06, 0xBB, 0xE1, 0x35, 0xAC, 0x60, 0x8F, 
+0x0D, 0xA9, 0xEA, 0x7E, 0x53, 0xDF, 0x84, 0xBC, 
+0x6E, 0x71, 0xD7, 0x20, 0x6E, 0x17, 0xA9, 0x75, 
+0xF8, 0x5D, 0xA2, 
+
+0xFE, 0x4D, 0xD4, 0xB1, 0x32, 0xE4, 0xC7, 0xF1, 
+0xED, 0x84, 0x43, 0x63, 0x94, 0x76, 0xB4, 0x23, 
+0x41, 0x3C, 0x0C, 0x07, 0xFD, 0xA1, 0x9C, 0x22, 
+0xDC, 0x7C, 0x9A, 0xAE, 0xD9, 0x76, 0x0E, 0x60, 
+0xF3, 0x63, 0x04, 0xAB, 0x82, 0x84, 0x6F, 0x79, 
+0x12, 0x01, 0xEB, 0x0A, 0x3A, 0x8C, 0x6B, 0x6D, 
+0x43, 0x38, 0x89, 0x3B, 0x3F, 0xE7, 0xDA, 0x50, 
+0x4D, 0xDA, 0xB9, 0x5A, 0x88, 0xF8, 0x3E, 0x17, 
+0xC7, 0x0C, 0xBA, 0x43, 0x1E, 0x7B, 0x58, 0x2D, 
+0x54, 0x1B, 0xDC, 0x06, 0xAB, 0xEA, 0xC7, 0x64, 
+0x49, 0xAC, 0xD1, 0xE1, 0x7B, 0xAE, 0x65, 0x4A, 
+0xF5, 0x0D, 0x14, 0x35, 0xB6, 0x2A, 0xED, 0x42, 
+0x03, 0x11, 0x69, 0x4C, 
+
+0x4A, 0x48, 0x08, 0x42, 0xB2, 0xD6, 0xF8, 0x8F, 
+0x74, 0x44, 0x26, 0x50, 0xB4, 0x57, 0x7A, 0x5A, 
+0x9F, 0xA1, 0x03, 0xE3, 0x73, 0x0E, 0xCA, 0x84, 
+0x75, 0x8C, 0x97, 0xE9, 0xA0, 0xF5, 0x63, 0x6D, 
+0x68, 0x8E, 0xED, 0x73, 0x76, 0x8E, 0xE6, 0x51, 
+0xDE, 0xCF, 0x19, 0xA8, 0x2A, 0x95, 0xB7, 0x26, 
+0x44, 0xAE, 0xCE, 0xEA, 0x61, 0x63, 0xC0, 0x13, 
+0x14, 0x53, 0x6F, 0xEF, 0x98, 0xF8, 0x54, 0xE4, 
+0xCC, 0x4C, 0xEB, 0x98, 0x1F, 0xCA, 0x5E, 0x55, 
+0x8B, 0xEF, 0x6E, 0x73, 0x1B, 0x56, 0x4F, 0x2E, 
+0xFF, 0xF5, 0xAD, 0xDF, 0xB5, 0x59, 0x16, 0x9E, 
+0x44, 0x38, 0xF6, 0x48, 0x31, 0x0A, 0x21, 0x7C, 
+0xA7, 0x5D, 0x47, 0x81, 0x1E, 
+
+0xD8, 0xC8, 0xEC, 0x7F, 0xF6, 0xAC, 0x6D, 0x42, 
+0xC9, 0x2A, 0x16, 0x10, 0x31, 0x72, 0x34, 0x92, 
+0x3C, 0x9E, 0x0A, 0xBB, 0xD6, 0x7F, 0x03, 0x6D, 
+0xCA, 0x0E, 0x42, 0x91, 0xB1, 0xDC, 0xD2, 0xAC, 
+0xCD, 0xB1, 0x82, 0xEC, 0xA9, 0xE2, 0x65, 0x93, 
+0xC3, 0xE7, 0x15, 0xBB, 0x21, 0xBE, 0x44, 0xD9, 
+0xD1, 0xBF, 0xBD, 0xDB, 0xE7, 0x4A, 0xD0, 0x03, 
+0x70, 0x65, 0x52, 0xE2, 0x7F, 0xE1, 0x7A, 0x5C, 
+0xAB, 0x03, 0x6C, 0xAC, 0x2B, 0xF5, 0xDB, 0x1F, 
+0xA4, 0x50, 0xBD, 0x37, 0xB0, 0xC5, 0x06, 0xFE, 
+0x41, 0x8A, 0xA2, 0x73, 0x98, 0xCE, 0x3A, 0x4F, 
+0x96, 0x06, 0x64, 0x51, 0xCF, 0xFA, 0x7A, 0x02, 
+0xD5, 0x25, 0xB7, 0x53, 0xB0, 0xF0, 
+
+0xB9, 0xA0, 0x68, 0xCC, 0x1B, 0xA4, 0x9B, 0x5A, 
+0x10, 0xE5, 0xF8, 0x28, 0xFD, 0xD0, 0x30, 0x6A, 
+0xF7, 0x70, 0xB9, 0xB7, 0xED, 0x4D, 0xD3, 0x8D, 
+0x5B, 0x6F, 0x21, 0xB8, 0xFF, 0x9B, 0x20, 0xE6, 
+0x8E, 0xB9, 0xB3, 0xAA, 0x23, 0xC5, 0xCB, 0x59, 
+0x42, 0xF5, 0x8B, 0x53, 0x81, 0x9C, 0xCB, 0x2D, 
+0x94, 0xB2, 0x83, 0x64, 0x3C, 0xF5, 0xFF, 0x3C, 
+0x3B, 0x6D, 0x09, 0x32, 0x61, 0x84, 0x08, 0x34, 
+0xE1, 0x5E, 0xB4, 0x84, 0x44, 0xE4, 0x41, 0x70, 
+0x5C, 0xCB, 0x60, 0x3C, 0xC2, 0xA2, 0xF5, 0x48, 
+0x26, 0xC4, 0xB0, 0x9D, 0x33, 0x11, 0x6F, 0xAB, 
+0x09, 0x95, 0x63, 0x63, 0x04, 0x5C, 0x62, 0xE6, 
+0x7D, 0x50, 0x23, 0x39, 0x04, 0x2F, 0x48, 
+
+0x74, 0x24, 0xB1, 0x6C, 0xEA, 0x17, 0x5D, 0x90, 
+0x9E, 0xFA, 0x45, 0x9C, 0xF9, 0x98, 0x11, 0xDB, 
+0x5F, 0xB4, 0x3B, 0xDB, 0xBC, 0x2F, 0x0C, 0xCF, 
+0x87, 0xDD, 0x89, 0x56, 0x58, 0xD7, 0xC9, 0xE8, 
+0x04, 0x9C, 0xE9, 0x42, 0xF9, 0xC1, 0x78, 0x90, 
+0x60, 0x02, 0x83, 0xF6, 0xC9, 0xA6, 0x3F, 0x1A, 
+0xDC, 0xF5, 0x4D, 0xF2, 0x50, 0x2D, 0x8C, 0xB5, 
+0xA6, 0xE0, 0x0E, 0x43, 0xB2, 0x98, 0x44, 0x43, 
+0xB5, 0x30, 0x0F, 0x0C, 0x03, 0xE4, 0xF3, 0x77, 
+0x17, 0xF7, 0xC5, 0xB1, 0x0E, 0xF4, 0x9B, 0xDB, 
+0x04, 0x1C, 0x50, 0x02, 0xDA, 0x46, 0x2A, 0x26, 
+0x83, 0xB8, 0x83, 0x5C, 0xB6, 0xBE, 0x6D, 0xF3, 
+0x25, 0x26, 0x53, 0x67, 0x4D, 0x72, 0x13, 0x0F, 
+
+0x22, 0x75, 0xB8, 0x3D, 0x35, 0x80, 0x38, 0x76, 
+0xF4, 0xDB, 0x86, 0x5A, 0x3E, 0xE6, 0x63, 0x58, 
+0xC7, 0x73, 0x1B, 0x9C, 0xEE, 0x89, 0x28, 0x65, 
+0xAC, 0xDF, 0x44, 0xBE, 0x53, 0xB3, 0x98, 0xAB, 
+0x23, 0x70, 0xEC, 0x7F, 0x7C, 0xFC, 0x24, 0xB9, 
+0xF4, 0x25, 0xE4, 0x8D, 0x23, 0x0F, 0x8C, 0xDD, 
+0x46, 0x81, 0x8C, 0xC3, 0xC8, 0x1A, 0xD5, 0xAA, 
+0xBA, 0x62, 0xEB, 0x3D, 0x8B, 0x4E, 0xE4, 0x38, 
+0x59, 0x0A, 0x0D, 0x28, 0xDF, 0x63, 0x22, 0x2A, 
+0x4E, 0x3B, 0x25, 0x74, 0xBD, 0x0B, 0xC9, 0x70, 
+0xC5, 0x45, 0x26, 0xCF, 0x4E, 0x68, 0x9F, 0x93, 
+0x23, 0x12, 0x0E, 0xCC, 0x9E, 0xE0, 0x35, 0x19, 
+0x55, 0xD6, 0x23, 0xDE, 0x96, 0xDA, 0x2E, 0x38, 
+0x67, 
+
+0xDE, 0xD2, 0x7D, 0x39, 0x3D, 0xBA, 0x68, 0x89, 
+0x64, 0xDD, 0x11, 0xBE, 0x21, 0xE8, 0xAE, 0x58, 
+0xA3, 0x40, 0x29, 0x27, 0x07, 0xF0, 0xF3, 0x2F, 
+0x2D, 0x68, 0xDA, 0x65, 0x9E, 0xF8, 0xE5, 0x67, 
+0xE3, 0x61, 0xE8, 0x2B, 0x4A, 0xD8, 0x02, 0xF8, 
+0xD6, 0x95, 0xCE, 0xCA, 0x39, 0x86, 0xA5, 0x2C, 
+0x16, 0x4F, 0x55, 0x8B, 0x43, 0xE1, 0x42, 0xDB, 
+0xD0, 0xEC, 0x5F, 0x85, 0xDE, 0x4D, 0xD7, 0x8B, 
+0x91, 0x79, 0x85, 0x10, 0xA0, 0xED, 0x21, 0x4B, 
+0x8D, 0x34, 0xA8, 0xF5, 0x7D, 0xC7, 0x7A, 0xF5, 
+0x78, 0x07, 0x6B, 0x7E, 0x18, 0xDE, 0x91, 0xF6, 
+0xD1, 0x38, 0x12, 0xB8, 0x09, 0x0F, 0x34, 0xC5, 
+0xF0, 0xC4, 0x71, 0x39, 0xE0, 0x68, 0x21, 0x3B, 
+0x5E, 0x1A, 
+
+0xEA, 0xCB, 0xD7, 0x96, 0x80, 0x4E, 0xBF, 0xA3, 
+0x19, 0x98, 0x67, 0x08, 0xEC, 0xB9, 0x23, 0x76, 
+0x5D, 0xE4, 0xE7, 0x67, 0x3E, 0xC3, 0x98, 0x43, 
+0x1D, 0x36, 0xD5, 0x5D, 0x7E, 0x87, 0x38, 0xDA, 
+0x1C, 0x0D, 0x83, 0x5D, 0x02, 0x67, 0xED, 0xD4, 
+0xA5, 0x62, 0x7B, 0xF6, 0x84, 0x7D, 0x70, 0xA4, 
+0x05, 0x75, 0x15, 0x3D, 0x13, 0xFB, 0xF8, 0xAD, 
+0xBA, 0x3D, 0xE1, 0xB6, 0xE0, 0x87, 0xD1, 0x90, 
+0x6E, 0x23, 0x8D, 0xE1, 0x1D, 0x97, 0x66, 0x16, 
+0xCF, 0xA6, 0xFC, 0x94, 0x2C, 0x39, 0x29, 0xD1, 
+0xBC, 0xA4, 0x0D, 0xD1, 0x4D, 0x3C, 0x25, 0x30, 
+0xF4, 0x0C, 0x01, 0xF2, 0x9D, 0x96, 0x78, 0x81, 
+0x8F, 0xF6, 0xCF, 0xAA, 0x34, 0x62, 0x74, 0x44, 
+0x61, 0xED, 0xF3, 
+
+0x9A, 0xAB, 0x0F, 0x1D, 0x02, 0xA2, 0x40, 0xDA, 
+0x6C, 0xC3, 0x8C, 0xDE, 0x04, 0x6D, 0x6D, 0x54, 
+0xF4, 0x89, 0xF8, 0xB1, 0xE4, 0x24, 0xF5, 0x4E, 
+0xE9, 0xA4, 0x49, 0xF4, 0x15, 0x79, 0x03, 0x85, 
+0x77, 0xDE, 0x10, 0xDA, 0x6E, 0xCC, 0x2B, 0x19, 
+0x54, 0x4B, 0xCC, 0x7B, 0xBA, 0x12, 0xD1, 0xF4, 
+0x35, 0xB2, 0x93, 0x59, 0xFC, 0xD5, 0xFD, 0x60, 
+0xFA, 0x28, 0x42, 0xD4, 0x82, 0xE4, 0xC0, 0x81, 
+0xCB, 0x6E, 0xC4, 0x91, 0x0E, 0x2A, 0x8C, 0xFC, 
+0x99, 0x45, 0x0D, 0xF1, 0x2C, 0xCA, 0x70, 0xA8, 
+0xFD, 0x16, 0x96, 0x63, 0x2F, 0x79, 0xDE, 0xE4, 
+0x62, 0x4B, 0xC8, 0xE6, 0x3D, 0xE0, 0x15, 0xD9, 
+0xF1, 0x5A, 0xDA, 0x1E, 0xAD, 0xBC, 0xD9, 0x5A, 
+0x4A, 0xA5, 0x69, 0xAD, 
+
+0xC8, 0x63, 0x2F, 0x67, 0x9E, 0xC2, 0x98, 0xA2, 
+0x36, 0x54, 0xB4, 0xF5, 0xD8, 0xEE, 0x60, 0x48, 
+0x95, 0x69, 0xD2, 0x14, 0x2C, 0x00, 0x50, 0xAE, 
+0xDF, 0xC7, 0xD7, 0xE0, 0x50, 0x8B, 0x71, 0xD3, 
+0x42, 0x78, 0x1B, 0xB9, 0xB7, 0xB6, 0x80, 0x86, 
+0xC8, 0xD8, 0x3C, 0x8F, 0x74, 0x45, 0x66, 0x59, 
+0x3E, 0xF2, 0x6F, 0xDB, 0x49, 0x4F, 0x8B, 0x5C, 
+0x26, 0xDA, 0x9B, 0xD9, 0x88, 0x5A, 0x94, 0xCF, 
+0x87, 0x83, 0x20, 0x3A, 0x13, 0x1D, 0xB4, 0xD9, 
+0xB5, 0x28, 0x72, 0xE1, 0xF4, 0x18, 0x37, 0x9B, 
+0x91, 0xDF, 0x47, 0x33, 0x79, 0xB4, 0x49, 0xAE, 
+0x36, 0x9F, 0x6C, 0x22, 0x37, 0xDA, 0xC0, 0x73, 
+0x6A, 0x46, 0x90, 0xA6, 0xCA, 0x1D, 0x3E, 0xC0, 
+0x9F, 0x07, 0x6A, 0x63, 0x6E, 
+
+0x06, 0xD3, 0xE8, 0x62, 0x43, 0x2B, 0x6A, 0x43, 
+0x69, 0xB0, 0xE9, 0x16, 0x2A, 0x51, 0x14, 0x59, 
+0xFE, 0x66, 0xC6, 0x90, 0xB0, 0x18, 0x36, 0xA5, 
+0xEE, 0x34, 0x63, 0x6A, 0x2A, 0x2D, 0xB4, 0x53, 
+0x6A, 0x1A, 0xAA, 0xFD, 0x90, 0x63, 0x82, 0x71, 
+0x0D, 0xBE, 0x17, 0xE4, 0xF8, 0xA0, 0x8F, 0x35, 
+0xFE, 0x0D, 0x61, 0x11, 0x36, 0x05, 0x35, 0x92, 
+0xD8, 0x58, 0xB6, 0x77, 0xB3, 0xDC, 0xAF, 0x7F, 
+0x8F, 0xDA, 0x8C, 0xF9, 0xFD, 0xA8, 0x28, 0x3C, 
+0xE8, 0x1D, 0xFE, 0x9E, 0x62, 0x83, 0x6A, 0x3B, 
+0x0F, 0x3E, 0x77, 0x7F, 0x0E, 0xED, 0x78, 0xC3, 
+0x5F, 0xD2, 0x5F, 0xDC, 0x02, 0xA0, 0x38, 0x50, 
+0x17, 0x71, 0x7E, 0xE3, 0x35, 0x40, 0x41, 0x46, 
+0xFC, 0xA4, 0xB9, 0x10, 0x8A, 0x02, 
+
+0xF1, 0x1B, 0x95, 0x6A, 0xCB, 0x84, 0xA5, 0x27, 
+0xC7, 0xD2, 0xAB, 0x99, 0x3A, 0x65, 0xA6, 0x93, 
+0xC1, 0x8F, 0x11, 0xAD, 0xC1, 0xAF, 0x26, 0x5B, 
+0xE1, 0x25, 0x83, 0x18, 0x6D, 0x20, 0x5F, 0xAB, 
+0x65, 0x9E, 0xAE, 0xEF, 0x11, 0xD9, 0xC5, 0x75, 
+0x3C, 0x77, 0xC9, 0x15, 0x02, 0xBC, 0x1D, 0x56, 
+0x12, 0xD1, 0x67, 0x00, 0x85, 0xE0, 0x18, 0xA0, 
+0x49, 0x9A, 0x8F, 0xCB, 0xEF, 0x09, 0xF3, 0x86, 
+0x1A, 0xC1, 0x7F, 0x6A, 0xDC, 0x52, 0xEC, 0x6C, 
+0x33, 0xD5, 0x08, 0x13, 0x00, 0x8F, 0xC9, 0x77, 
+0xD7, 0xFE, 0xDC, 0x54, 0x9A, 0xBB, 0x70, 0xAE, 
+0x12, 0xE1, 0x98, 0x74, 0x37, 0xFF, 0xBB, 0x13, 
+0xA3, 0xB8, 0x91, 0x4A, 0xB1, 0x05, 0x1F, 0x35, 
+0x94, 0x0C, 0xD9, 0x97, 0xA3, 0x73, 0xAC, 
+
+0x9D, 0xCE, 0x2B, 0x65, 0x69, 0xDF, 0xD7, 0xE5, 
+0xE4, 0xEC, 0xAC, 0xD5, 0xDB, 0xD0, 0x3A, 0x0E, 
+0x9C, 0xD0, 0xA9, 0x7C, 0xC7, 0x26, 0x41, 0x83, 
+0xEE, 0x24, 0x74, 0xE5, 0x48, 0x65, 0x5C, 0xA0, 
+0xEF, 0xBA, 0x86, 0x24, 0xDC, 0xC6, 0x5F, 0x73, 
+0xC2, 0xB6, 0x6F, 0xA7, 0x7F, 0xC0, 0x7E, 0xA8, 
+0xC2, 0x3C, 0x7D, 0x5C, 0x85, 0xAD, 0xE7, 0x0A, 
+0x02, 0x1F, 0xD0, 0x96, 0xAE, 0x51, 0x80, 0x7F, 
+0xFA, 0xE6, 0x1B, 0xD3, 0xE5, 0xD4, 0x08, 0x2D, 
+0x43, 0x0F, 0xBE, 0x21, 0x8D, 0x64, 0x53, 0xD0, 
+0x62, 0x36, 0xA6, 0xC8, 0x2E, 0x01, 0xAB, 0x89, 
+0x22, 0xF3, 0xBC, 0x51, 0x69, 0x6B, 0x9E, 0x0E, 
+0x2F, 0x41, 0x0C, 0x0E, 0xEA, 0xAD, 0xE6, 0x51, 
+0x66, 0x3E, 0x25, 0x0D, 0xA1, 0x01, 0x80, 0x5D, 
+
+0x6B, 0x50, 0xDF, 0xB6, 0xCE, 0xFA, 0x9B, 0x26, 
+0x49, 0x88, 0x65, 0x6E, 0x43, 0xAE, 0x02, 0x85, 
+0xB0, 0xE8, 0x89, 0x4D, 0x1B, 0x89, 0x50, 0x4E, 
+0xFC, 0xE5, 0xEF, 0x2B, 0xB1, 0x57, 0x6C, 0xB0, 
+0x60, 0x00, 0x34, 0x6C, 0x24, 0x88, 0x62, 0x3A, 
+0xBB, 0x9F, 0x05, 0xFD, 0x69, 0x01, 0x5C, 0x14, 
+0x58, 0xB4, 0xFB, 0xD6, 0x52, 0xEF, 0xD0, 0xFE, 
+0xBE, 0x14, 0xAE, 0xD1, 0xF7, 0x53, 0xD2, 0xF4, 
+0x14, 0xF5, 0x16, 0x05, 0x9E, 0x86, 0x95, 0x16, 
+0x3B, 0x70, 0x4D, 0x91, 0xDE, 0x30, 0xE6, 0x21, 
+0x5F, 0x9D, 0x89, 0xE0, 0x25, 0x50, 0xFE, 0x5F, 
+0x47, 0x12, 0x01, 0xE0, 0x6D, 0xC4, 0xA3, 0x79, 
+0xBF, 0x13, 0x5B, 0x47, 0x69, 0xB9, 0x54, 0x1B, 
+0x9F, 0x7E, 0x93, 0xA0, 0xFF, 0x6B, 0xE1, 0x0E, 
+0x58, 
+
+0xA3, 0xB0, 0xEA, 0x52, 0xCF, 0xA8, 0xF8, 0xD4, 
+0xE9, 0xF6, 0x5A, 0xE0, 0x82, 0xCA, 0x37, 0x4D, 
+0x35, 0x6F, 0x96, 0x60, 0x39, 0x6E, 0x4C, 0x7C, 
+0xFA, 0xE6, 0x28, 0x98, 0x59, 0xC2, 0xF1, 0xFE, 
+0x6F, 0x32, 0xA8, 0x53, 0xC1, 0x63, 0x3D, 0xF9, 
+0xB7, 0x79, 0x5E, 0x51, 0x44, 0x29, 0xB8, 0x4A, 
+0xFB, 0x61, 0x21, 0xE9, 0xB6, 0xE3, 0x8A, 0xC9, 
+0x64, 0x60, 0x7A, 0xD3, 0xEA, 0x19, 0x2A, 0x26, 
+0xD2, 0xF7, 0xC0, 0xD4, 0xD0, 0x96, 0x57, 0x9C, 
+0x7E, 0x39, 0x8D, 0x6D, 0x15, 0x3D, 0x90, 0x7A, 
+0xDC, 0x7A, 0x68, 0xD1, 0x29, 0xD9, 0xB0, 0xC0, 
+0x73, 0x8A, 0x16, 0xB2, 0x86, 0x86, 0x16, 0x17, 
+0x70, 0x54, 0x8B, 0x8E, 0xD9, 0x59, 0x14, 0xCB, 
+0x62, 0x6E, 0x41, 0xD0, 0x07, 0xCF, 0x0C, 0x78, 
+0x15, 0xBF, 
+
+0xAB, 0xB0, 0xE8, 0x92, 0x46, 0xB2, 0x41, 0xA9, 
+0xC9, 0xE1, 0x4C, 0xA3, 0xBC, 0xCF, 0x82, 0x28, 
+0xBD, 0x05, 0xE4, 0x91, 0x8B, 0xEA, 0xF6, 0xAE, 
+0xEB, 0x5F, 0xBD, 0x55, 0xA3, 0xD9, 0x8D, 0xFC, 
+0xC7, 0x8E, 0x94, 0x70, 0xBE, 0x67, 0x9C, 0x2D, 
+0xDE, 0x1B, 0xBA, 0x15, 0x06, 0xE4, 0x7C, 0x3E, 
+0x9C, 0x99, 0x09, 0xE9, 0x5F, 0x24, 0xA9, 0xFC, 
+0x0D, 0x0D, 0xDA, 0xC1, 0x5F, 0x46, 0xA3, 0xEE, 
+0xDD, 0x47, 0x19, 0xC0, 0xC9, 0xD4, 0x48, 0x2E, 
+0xFC, 0x9A, 0xC7, 0x24, 0x2C, 0xD4, 0xE0, 0x67, 
+0xA9, 0x49, 0x0E, 0x23, 0xC0, 0xE8, 0x3C, 0x02, 
+0x6A, 0x83, 0x7E, 0x2A, 0x5B, 0xC1, 0xAF, 0xA5, 
+0xD2, 0x10, 0xE7, 0x48, 0xC5, 0x46, 0x8D, 0xD9, 
+0x5F, 0x33, 0x34, 0x5D, 0x5D, 0xF3, 0x4A, 0x8B, 
+0x34, 0xDF, 0xD5, 
+
+0x1A, 0x0F, 0x61, 0x3E, 0x67, 0x59, 0xAC, 0x9B, 
+0xE0, 0xEC, 0x90, 0x95, 0x02, 0x69, 0x16, 0x47, 
+0x58, 0x87, 0xF5, 0x6D, 0xAA, 0x23, 0x63, 0x82, 
+0x4A, 0xEF, 0x12, 0x76, 0xEC, 0x18, 0x0F, 0xD7, 
+0xDA, 0x83, 0xFB, 0x44, 0x06, 0xB2, 0xBA, 0xFF, 
+0x6E, 0xBA, 0x30, 0x9D, 0x3F, 0x26, 0x6D, 0xB7, 
+0x97, 0x69, 0x90, 0xBF, 0xAA, 0xE6, 0x55, 0xDD, 
+0x46, 0xF1, 0x39, 0xF9, 0x1F, 0x66, 0xE4, 0x07, 
+0x47, 0x40, 0x72, 0x97, 0xD5, 0xAA, 0xC9, 0x3B, 
+0xBD, 0x37, 0x62, 0x8F, 0x66, 0x0D, 0xBD, 0xAD, 
+0xDE, 0x2F, 0xF6, 0x3C, 0x47, 0x14, 0x91, 0x9C, 
+0x61, 0xA1, 0xA2, 0x02, 0x7F, 0xC4, 0x31, 0x39, 
+0xCA, 0xCD, 0x42, 0x6F, 0x71, 0x5F, 0x84, 0x96, 
+0x1A, 0x09, 0x3A, 0x4C, 0x16, 0xDB, 0x3F, 0xCA, 
+0x72, 0x53, 0xC7, 0xC0, 
+
+0x4A, 0xF4, 0x46, 0x3B, 0xED, 0x66, 0xC7, 0xD9, 
+0x48, 0x7B, 0xB5, 0x78, 0x62, 0xFA, 0x07, 0xA0, 
+0x14, 0xDF, 0x7B, 0xD6, 0xE9, 0x28, 0xB9, 0x28, 
+0xC5, 0x03, 0x12, 0xCE, 0x56, 0xD3, 0x4F, 0x4E, 
+0x94, 0xC9, 0x2F, 0xCB, 0x54, 0xF7, 0xB0, 0xC0, 
+0xAA, 0x86, 0x91, 0xDB, 0xD3, 0x48, 0xF7, 0x0B, 
+0xAE, 0x75, 0x0D, 0xDD, 0x65, 0xD4, 0x12, 0x42, 
+0xB1, 0x6D, 0x7D, 0xCD, 0x3D, 0xBB, 0xD0, 0x5C, 
+0x95, 0x5C, 0x86, 0xEC, 0x2A, 0x57, 0x68, 0xBA, 
+0x50, 0x08, 0x9E, 0x21, 0x29, 0x38, 0x5F, 0xCB, 
+0xC4, 0xE8, 0x5E, 0xE8, 0x95, 0xD4, 0x0D, 0x30, 
+0x53, 0x4B, 0x23, 0xD9, 0xFD, 0xE5, 0x67, 0xDD, 
+0xA5, 0xA1, 0xCC, 0x30, 0xBD, 0xA5, 0xFE, 0x69, 
+0xEB, 0x71, 0xC8, 0xC4, 0xED, 0x20, 0x03, 0x37, 
+0x5D, 0xCC, 0x7B, 0xF5, 0xD3, 
+
+0xD6, 0x30, 0x68, 0xB6, 0x2B, 0x77, 0xBA, 0x8E, 
+0x0D, 0xD2, 0x70, 0x82, 0xC3, 0xF5, 0x9F, 0xF1, 
+0x94, 0x1E, 0x00, 0x0C, 0x80, 0xA4, 0x83, 0x04, 
+0x36, 0x0D, 0x83, 0xFA, 0x4A, 0xA0, 0x14, 0x21, 
+0x87, 0x58, 0xB6, 0x14, 0x17, 0x04, 0x58, 0x5F, 
+0x90, 0x44, 0x3F, 0x74, 0x72, 0x33, 0x7F, 0xEC, 
+0x95, 0xA3, 0x2E, 0x98, 0x9A, 0x02, 0x41, 0x4E, 
+0x66, 0xDA, 0x85, 0x29, 0x16, 0x64, 0xB2, 0xE8, 
+0x68, 0x9A, 0xA3, 0x40, 0x0C, 0x85, 0x09, 0x28, 
+0x7B, 0x04, 0x80, 0xFD, 0x9C, 0x43, 0x55, 0xEB, 
+0x1A, 0x89, 0x2E, 0x62, 0xB3, 0x9D, 0xB1, 0xB5, 
+0x00, 0x6D, 0x2B, 0xEA, 0xCE, 0x8E, 0x32, 0x3F, 
+0x2F, 0x83, 0x16, 0xB9, 0x2B, 0x02, 0xF4, 0xA1, 
+0x29, 0xEC, 0x76, 0x4E, 0xEF, 0x00, 0x21, 0x90, 
+0x92, 0xB8, 0xC6, 0x70, 0xE6, 0x45, 
+
+0xEB, 0x0D, 0x6C, 0x28, 0xA7, 0x49, 0xA5, 0x22, 
+0x7F, 0x7D, 0xC4, 0xA2, 0x79, 0x34, 0x79, 0x9B, 
+0x3B, 0x2C, 0xCF, 0x13, 0x7F, 0xC1, 0x69, 0xF4, 
+0xFD, 0x7F, 0xD1, 0xF5, 0x40, 0xDB, 0x5A, 0x36, 
+0x82, 0xF5, 0xE9, 0x6F, 0xFD, 0x59, 0x2F, 0x77, 
+0x87, 0x7D, 0x55, 0x5A, 0x42, 0x88, 0x6C, 0xED, 
+0xA2, 0xF6, 0x00, 0x5B, 0xF3, 0x2B, 0xF2, 0x9D, 
+0xBC, 0x0A, 0xA5, 0x9F, 0xA8, 0xD4, 0xF2, 0xAA, 
+0xFC, 0x62, 0x2D, 0x85, 0x2C, 0xD5, 0xAD, 0x8F, 
+0xB4, 0x84, 0xA4, 0x32, 0x22, 0xF1, 0xB7, 0xAA, 
+0x8D, 0x66, 0x64, 0xC0, 0xFF, 0xAF, 0x4D, 0xEC, 
+0x54, 0x43, 0x1D, 0x54, 0xCC, 0x60, 0xE7, 0x6B, 
+0x04, 0x7C, 0x04, 0x99, 0x3B, 0xBC, 0xF1, 0x01, 
+0xC3, 0xDE, 0xEF, 0x2D, 0xA5, 0x93, 0xF6, 0x8C, 
+0x7D, 0xB2, 0xE8, 0xAF, 0x69, 0xA9, 0x0C, 
+
+0x2A, 0x2E, 0x6C, 0x93, 0xCB, 0xD5, 0x2D, 0x77, 
+0xB8, 0x03, 0xB7, 0x6F, 0x3E, 0x8E, 0x09, 0xB1, 
+0x2B, 0x46, 0xAD, 0x3C, 0xEB, 0xA2, 0x6D, 0x3A, 
+0x22, 0x52, 0x61, 0xA5, 0xFC, 0x64, 0x8E, 0x34, 
+0x07, 0xE2, 0x01, 0x90, 0x60, 0x25, 0xA2, 0x0D, 
+0x46, 0x2F, 0x44, 0x6D, 0x2E, 0xEC, 0xAD, 0x5C, 
+0x1A, 0xEC, 0xF4, 0x18, 0x30, 0x38, 0x5C, 0xE6, 
+0xE6, 0xE9, 0x80, 0xFD, 0x1C, 0x12, 0x71, 0xA4, 
+0xC5, 0xCD, 0xFC, 0x37, 0xA0, 0xDB, 0x52, 0x41, 
+0xB2, 0x92, 0x0B, 0x36, 0x03, 0xC3, 0x21, 0x10, 
+0xFD, 0x05, 0xF3, 0x29, 0xA1, 0x9E, 0x19, 0x08, 
+0xAE, 0x1C, 0xFC, 0xDE, 0x08, 0x0D, 0xB3, 0x56, 
+0xFE, 0x95, 0x62, 0xB9, 0x54, 0xED, 0x06, 0x78, 
+0x80, 0x12, 0x0D, 0x9F, 0x4B, 0x05, 0xDB, 0x54, 
+0x51, 0x2C, 0xC5, 0x02, 0x7D, 0x0E, 0x2B, 0x95, 
+
+0x71, 0x5A, 0xE9, 0x5E, 0xF3, 0x96, 0x2C, 0x84, 
+0xAF, 0x73, 0x4F, 0xDC, 0xF2, 0x1D, 0xA0, 0xD8, 
+0xAB, 0x5B, 0xC1, 0xF7, 0xED, 0x6E, 0xC1, 0xE3, 
+0xD6, 0x30, 0xCB, 0x86, 0x1F, 0x4C, 0x11, 0x4D, 
+0x2B, 0x0F, 0x14, 0x53, 0x28, 0xE8, 0x1C, 0xB0, 
+0x2B, 0x13, 0x48, 0x9C, 0x66, 0x9A, 0xA8, 0x1B, 
+0x5A, 0x31, 0x99, 0xB9, 0x0B, 0x85, 0x28, 0xD2, 
+0x66, 0x7F, 0x8F, 0x4E, 0xFC, 0x2F, 0x54, 0x3D, 
+0x71, 0xAB, 0xC7, 0xE7, 0x79, 0xD9, 0xBC, 0x86, 
+0x65, 0x7B, 0x7C, 0x65, 0x99, 0x3B, 0x80, 0x10, 
+0x10, 0x3D, 0x5E, 0xB2, 0x13, 0x96, 0x06, 0x9F, 
+0xF2, 0xEE, 0x8E, 0x90, 0xB4, 0xA0, 0x34, 0x42, 
+0x76, 0x0C, 0xFC, 0x9E, 0xF3, 0xD5, 0x8F, 0x52, 
+0x9D, 0x54, 0x51, 0xB6, 0xFA, 0x51, 0x75, 0x87, 
+0xF8, 0x0A, 0x1E, 0x9B, 0x7E, 0xF5, 0x3D, 0x3B, 
+0xAE, 
+
+0x29, 0x2B, 0xC8, 0x01, 0xB4, 0xE8, 0x50, 0x99, 
+0xCB, 0xA7, 0x9F, 0x52, 0x18, 0xA2, 0xA2, 0xF3, 
+0x78, 0x65, 0x37, 0x0C, 0x47, 0x89, 0xC1, 0x2F, 
+0x76, 0xBF, 0xE4, 0x96, 0x6B, 0x96, 0xBB, 0x39, 
+0xCF, 0xD9, 0x73, 0xF7, 0xBD, 0x7F, 0x78, 0x0D, 
+0x16, 0x86, 0x6D, 0x79, 0x4F, 0xAE, 0x32, 0x08, 
+0x98, 0x86, 0x80, 0x5D, 0x40, 0x52, 0x1C, 0x43, 
+0xDF, 0xA1, 0x34, 0x37, 0x77, 0x57, 0xB4, 0x5B, 
+0xD4, 0x4A, 0x1B, 0x4C, 0xBE, 0x87, 0x20, 0x6E, 
+0x55, 0x4E, 0x39, 0xF3, 0xE6, 0x6E, 0xB1, 0x44, 
+0x57, 0xE7, 0xB5, 0xE1, 0xAF, 0x2A, 0xCB, 0xC6, 
+0x10, 0x47, 0x2C, 0x25, 0x24, 0x2E, 0x69, 0xDD, 
+0x49, 0xA2, 0x23, 0xCB, 0x84, 0x86, 0x61, 0x6F, 
+0xFF, 0x36, 0x67, 0x21, 0x01, 0x16, 0x87, 0x2C, 
+0x7B, 0xC3, 0xD2, 0x88, 0xDB, 0x6E, 0xA1, 0xE6, 
+0xFB, 0xFF, 
+
+0x58, 0x97, 0xF7, 0x71, 0xC6, 0xE8, 0x91, 0x72, 
+0xDB, 0x92, 0xD2, 0x16, 0x45, 0x1E, 0xF0, 0x0A, 
+0x5D, 0x60, 0xEB, 0x7F, 0x97, 0x6F, 0x41, 0x60, 
+0x7A, 0xDE, 0x03, 0x78, 0xB0, 0x22, 0xFD, 0x83, 
+0x10, 0x6B, 0xF6, 0xB6, 0xDF, 0xDB, 0x3C, 0xB4, 
+0x6B, 0x07, 0xC8, 0x5A, 0x9E, 0x56, 0x87, 0xC0, 
+0xEA, 0x23, 0x46, 0x6F, 0xC9, 0xD6, 0x87, 0x6E, 
+0xE4, 0xF6, 0x4F, 0x58, 0xE5, 0x14, 0x86, 0xE1, 
+0xD3, 0x7A, 0x86, 0x38, 0x4A, 0x84, 0xBE, 0xE3, 
+0x05, 0x1F, 0x3C, 0xC0, 0x69, 0x5A, 0xCC, 0x57, 
+0xED, 0xEE, 0x56, 0xDE, 0x56, 0x3E, 0xF1, 0x92, 
+0x0A, 0x28, 0x34, 0x27, 0x5D, 0x89, 0x17, 0xF9, 
+0x0E, 0xA7, 0xB2, 0x30, 0x37, 0xCC, 0xC0, 0x25, 
+0x39, 0xDD, 0xBF, 0x1B, 0x33, 0x59, 0x42, 0x88, 
+0x74, 0x7B, 0xB0, 0x42, 0x05, 0x47, 0xD6, 0xFB, 
+0x27, 0x67, 0xC4, 
+
+0x7B, 0xD4, 0xC7, 0x1E, 0x0F, 0xAF, 0xAF, 0xFB, 
+0x46, 0x43, 0x4C, 0x39, 0x39, 0x98, 0xBE, 0x58, 
+0x42, 0x04, 0xF0, 0x22, 0x67, 0x2C, 0x21, 0x59, 
+0x9A, 0xEA, 0x38, 0xF9, 0x08, 0x1A, 0x5D, 0x28, 
+0x70, 0x93, 0x8E, 0x22, 0xB3, 0xB9, 0x0C, 0x15, 
+0x4D, 0x5B, 0xA7, 0x32, 0x9E, 0xD9, 0x8C, 0x1E, 
+0xDB, 0x18, 0xF1, 0xFD, 0x8A, 0x3F, 0xF4, 0x59, 
+0x0A, 0x92, 0x68, 0xEB, 0xAC, 0x75, 0xA4, 0xEB, 
+0x72, 0x00, 0xE4, 0xDD, 0xAA, 0xF0, 0x9A, 0x68, 
+0x6E, 0x19, 0x6F, 0x8D, 0x7C, 0xC0, 0xDA, 0x70, 
+0xBC, 0xB6, 0x92, 0x77, 0x0B, 0x45, 0x3B, 0x5B, 
+0x23, 0xF4, 0x2E, 0x03, 0x90, 0x1F, 0x9B, 0x13, 
+0x5C, 0x67, 0xC8, 0xC2, 0x38, 0xEB, 0x01, 0xDD, 
+0x70, 0xB1, 0xD6, 0x40, 0x64, 0x48, 0x8B, 0x4A, 
+0x14, 0x7F, 0x9D, 0xEE, 0x0C, 0x1B, 0x91, 0xAD, 
+0xD4, 0x18, 0xF9, 0x3A, 
+
+0x0C, 0xDE, 0x58, 0x05, 0x29, 0x5F, 0xB0, 0x2A, 
+0x3F, 0xF7, 0x3F, 0x44, 0x1D, 0xB8, 0x32, 0x93, 
+0x55, 0x2C, 0xB8, 0x81, 0x0B, 0xB3, 0xA2, 0xED, 
+0xCE, 0x12, 0x2A, 0xF8, 0xD5, 0xE1, 0x7B, 0x36, 
+0xF4, 0x3C, 0x5C, 0x06, 0xE0, 0x34, 0x3B, 0x3A, 
+0xCE, 0xD3, 0xA8, 0x56, 0xC9, 0xFC, 0x85, 0xA4, 
+0x77, 0x44, 0x84, 0x19, 0x4A, 0xF9, 0xA2, 0xA0, 
+0xB5, 0x0D, 0x70, 0x86, 0x9F, 0x30, 0x5F, 0xF0, 
+0x29, 0x78, 0x62, 0xA6, 0x5A, 0x7E, 0x0F, 0x01, 
+0xDF, 0x7D, 0x60, 0x6D, 0x35, 0x00, 0xA8, 0x82, 
+0x4C, 0x8C, 0xE6, 0x86, 0x4B, 0x2A, 0x0E, 0xD8, 
+0xB6, 0xD5, 0x44, 0x97, 0x26, 0x78, 0x06, 0x16, 
+0xB3, 0x81, 0x9A, 0x65, 0x99, 0x7F, 0xB9, 0xB6, 
+0xEB, 0x91, 0x89, 0xEB, 0xE4, 0xA4, 0x96, 0xD3, 
+0xCA, 0xD1, 0xAD, 0x17, 0xDB, 0xF4, 0xF5, 0xA0, 
+0xB4, 0x09, 0x0B, 0xD0, 0x01, 
+
+0x6F, 0xAE, 0x6D, 0x6D, 0x03, 0x16, 0x46, 0xC0, 
+0x6B, 0x10, 0x44, 0x4E, 0x39, 0xE9, 0x52, 0xFA, 
+0xDB, 0x0A, 0x84, 0x70, 0x1C, 0xF4, 0x87, 0x39, 
+0x7D, 0x84, 0x0A, 0x43, 0x78, 0x91, 0xA5, 0xE9, 
+0xB8, 0x7A, 0x66, 0x29, 0x44, 0xF0, 0x44, 0x26, 
+0x43, 0x5C, 0x1D, 0x5B, 0x92, 0x49, 0xC8, 0x38, 
+0x50, 0xBE, 0x8C, 0x48, 0x8A, 0x67, 0x8A, 0x5A, 
+0x99, 0xD5, 0x2C, 0xBF, 0x6B, 0x75, 0x9E, 0x55, 
+0x6C, 0xBF, 0x01, 0x67, 0xB0, 0xD7, 0xBE, 0xE2, 
+0xDD, 0xD8, 0x3C, 0x1C, 0x8A, 0x16, 0x96, 0x89, 
+0x5F, 0x08, 0x22, 0x5C, 0x9A, 0xA4, 0xA2, 0x5D, 
+0x34, 0xAD, 0x2E, 0x36, 0xE8, 0x06, 0xAE, 0xEA, 
+0x20, 0x83, 0x4A, 0xB2, 0xD1, 0xCC, 0xD6, 0x6D, 
+0x6B, 0x31, 0xF8, 0xC4, 0x6B, 0xFF, 0xDD, 0x96, 
+0x15, 0x32, 0x93, 0xC5, 0x6C, 0xDD, 0x14, 0x2D, 
+0xA5, 0xA5, 0x60, 0x6E, 0x00, 0x81, 
+
+0xAC, 0xF8, 0xCF, 0xC3, 0xE6, 0xE6, 0xE6, 0x5D, 
+0x3D, 0x61, 0x03, 0xE0, 0xC5, 0x09, 0x95, 0x7B, 
+0x14, 0xC4, 0x8D, 0xB6, 0x72, 0x8C, 0xDF, 0x05, 
+0xDE, 0xBC, 0x13, 0x1C, 0x7E, 0xF6, 0x09, 0xAD, 
+0xA3, 0xD5, 0x44, 0x0F, 0xB7, 0x71, 0xC4, 0x34, 
+0x08, 0x0B, 0x14, 0x39, 0x3B, 0x2E, 0x9A, 0x48, 
+0xAA, 0xC3, 0xBB, 0x2F, 0x6D, 0x61, 0xD1, 0x9A, 
+0xE2, 0xED, 0xDE, 0xF4, 0xDC, 0x7B, 0xAC, 0x9F, 
+0x5D, 0x52, 0x57, 0x88, 0xF7, 0x50, 0x10, 0x9B, 
+0x34, 0x20, 0x28, 0xC2, 0xE2, 0xFD, 0xE5, 0x62, 
+0x9C, 0x78, 0xAE, 0xD9, 0x0C, 0x6D, 0x7A, 0x68, 
+0x58, 0x53, 0x11, 0x23, 0x77, 0xD5, 0x1D, 0x43, 
+0x1D, 0x63, 0x1D, 0xC7, 0x04, 0xAD, 0x96, 0xFD, 
+0xC1, 0x11, 0x23, 0x2F, 0xB8, 0x3E, 0x13, 0xDD, 
+0x26, 0x51, 0xB8, 0x02, 0x30, 0x39, 0x51, 0xD3, 
+0xDA, 0xE0, 0xA5, 0x1D, 0xF5, 0xE7, 0x8E, 
+
+0xC1, 0x28, 0x32, 0x77, 0xCE, 0x81, 0xC3, 0x12, 
+0x8A, 0x70, 0x3A, 0x7B, 0xCA, 0xEA, 0x74, 0xEA, 
+0x66, 0xD1, 0xB4, 0xC5, 0x33, 0xF3, 0xBF, 0xF9, 
+0x27, 0x42, 0x61, 0x91, 0x81, 0xAA, 0xB3, 0x6A, 
+0xD3, 0x4E, 0x51, 0xD4, 0xF7, 0x9F, 0x17, 0x3B, 
+0x13, 0xD3, 0x47, 0x64, 0x4A, 0x6B, 0x74, 0x92, 
+0xB7, 0x01, 0x64, 0xBA, 0x60, 0xBF, 0x85, 0x3E, 
+0x6D, 0xC0, 0x19, 0xE3, 0x2D, 0xBB, 0xB6, 0x77, 
+0xB3, 0x71, 0x19, 0xEB, 0x91, 0x5D, 0x05, 0x9B, 
+0x4D, 0xF6, 0xA3, 0x56, 0xE9, 0x62, 0x96, 0x81, 
+0x27, 0x1A, 0x45, 0x73, 0xCD, 0x03, 0x42, 0x9A, 
+0x42, 0x0B, 0x32, 0x03, 0x44, 0xDC, 0x2E, 0x86, 
+0xAE, 0x88, 0x9B, 0xE4, 0xD1, 0x9C, 0x40, 0x5D, 
+0xF9, 0x12, 0xB3, 0xB0, 0x6D, 0x9B, 0x76, 0x93, 
+0x5B, 0xBB, 0xFB, 0xC3, 0x4C, 0x85, 0xA5, 0x22, 
+0x16, 0x4B, 0x20, 0x02, 0xA3, 0xE0, 0x3F, 0x4E, 
+
+0xAC, 0x77, 0x04, 0x21, 0xDC, 0x02, 0xC0, 0x5E, 
+0xEF, 0xCB, 0x2E, 0x0E, 0x07, 0x42, 0x98, 0xC3, 
+0xCE, 0x55, 0xCC, 0x50, 0x24, 0xE8, 0xA7, 0x61, 
+0xDD, 0x3F, 0xA6, 0x16, 0x76, 0x65, 0x25, 0xD1, 
+0xCE, 0xCE, 0x75, 0xDA, 0x79, 0x52, 0x2A, 0x52, 
+0xDD, 0xE3, 0xF0, 0xB9, 0x2E, 0x52, 0xA9, 0xBF, 
+0x53, 0x44, 0x9E, 0x79, 0xB2, 0x46, 0xD0, 0x19, 
+0x10, 0xDE, 0x94, 0x16, 0x25, 0x38, 0xC3, 0xFF, 
+0xD2, 0xFA, 0x36, 0x33, 0xF5, 0xF0, 0x7A, 0x71, 
+0xB1, 0xFE, 0x8B, 0xC2, 0xE1, 0x6D, 0x75, 0x13, 
+0xDF, 0x2D, 0xCC, 0xC4, 0xEF, 0x7E, 0x06, 0x32, 
+0x32, 0x75, 0x11, 0xFE, 0xD0, 0x7C, 0xCE, 0xD8, 
+0xA5, 0x74, 0xA2, 0xCE, 0x92, 0xAF, 0x53, 0x41, 
+0xF2, 0x21, 0x83, 0xE2, 0x63, 0xB8, 0x32, 0xAC, 
+0x37, 0xC2, 0x48, 0x66, 0x12, 0x55, 0xE4, 0xE0, 
+0x4B, 0x17, 0x3C, 0x26, 0xD5, 0x45, 0x25, 0xA3, 
+0x80, 
+
+0xF9, 0xAD, 0x51, 0x72, 0xFE, 0xEC, 0xBA, 0x48, 
+0x72, 0x12, 0xEC, 0x7B, 0x6F, 0x0D, 0xED, 0x1E, 
+0x0A, 0x97, 0x81, 0xAC, 0xB3, 0x7B, 0xE8, 0x62, 
+0x85, 0x87, 0xC9, 0x1E, 0x02, 0x4F, 0x3D, 0xC8, 
+0xAA, 0x11, 0xCC, 0x13, 0x67, 0x1E, 0x8C, 0x6A, 
+0xEB, 0xE2, 0x1D, 0x3B, 0x6B, 0xAD, 0x5B, 0xBC, 
+0x91, 0x52, 0x5B, 0xB5, 0x79, 0x0E, 0x42, 0x26, 
+0xE3, 0xB7, 0x94, 0x7F, 0xE2, 0xB7, 0xDC, 0x06, 
+0x61, 0xF2, 0x9A, 0xA9, 0x42, 0xF7, 0xE2, 0x12, 
+0xEE, 0xFC, 0xEF, 0xD3, 0x89, 0xCD, 0xDE, 0x24, 
+0x8C, 0x89, 0xE5, 0xAE, 0x2A, 0x7B, 0x3A, 0x92, 
+0x28, 0xF6, 0x45, 0xA0, 0x98, 0xCB, 0xA4, 0x0E, 
+0x81, 0x36, 0x21, 0x7B, 0xBD, 0xBC, 0x17, 0x65, 
+0x29, 0xB9, 0xCA, 0x1C, 0xF7, 0xC8, 0x9C, 0x2A, 
+0x62, 0x65, 0xFB, 0xCB, 0x74, 0x5B, 0x0E, 0xD5, 
+0x8B, 0x9B, 0xB5, 0x4E, 0xFC, 0xC3, 0x34, 0xC4, 
+0x0B, 0x90, 
+
+0x84, 0x15, 0x51, 0x8E, 0x2B, 0x3B, 0x84, 0xB4, 
+0x7B, 0x9C, 0x04, 0x93, 0x3C, 0x0A, 0x19, 0x18, 
+0x27, 0x22, 0x03, 0x0C, 0x2A, 0x6D, 0x50, 0x60, 
+0x4E, 0x3C, 0x1F, 0x7D, 0xEE, 0x4C, 0xC7, 0x17, 
+0x90, 0x91, 0x43, 0xDF, 0xA2, 0xE7, 0xDB, 0x6E, 
+0xC2, 0x95, 0xAA, 0xF7, 0x75, 0xF8, 0xA9, 0x71, 
+0x36, 0x55, 0xC5, 0x8B, 0x74, 0xFF, 0x12, 0x1A, 
+0xBD, 0x3A, 0xA5, 0x81, 0x1D, 0xF5, 0x01, 0x2A, 
+0xF9, 0xEF, 0xDF, 0x01, 0x38, 0xB0, 0x49, 0xE8, 
+0x8E, 0x14, 0x1B, 0x6B, 0xD0, 0x2A, 0x18, 0x57, 
+0xCF, 0xCB, 0xEF, 0xB3, 0x8C, 0x5B, 0xC1, 0x88, 
+0xB4, 0x0B, 0x6C, 0x1F, 0x75, 0x5F, 0x99, 0x13, 
+0x33, 0x7D, 0xEA, 0x53, 0xAF, 0x16, 0x39, 0xDB, 
+0xB5, 0xC1, 0xB1, 0x0E, 0x26, 0xF3, 0xE7, 0x75, 
+0x5B, 0x77, 0xDA, 0x65, 0xF9, 0xAE, 0x82, 0x0A, 
+0x78, 0x91, 0x25, 0x55, 0x19, 0x4B, 0x07, 0x05, 
+0xDF, 0x98, 0xBB, 
+
+0x18, 0x8F, 0xCE, 0x84, 0xCA, 0xBD, 0xF9, 0x64, 
+0x77, 0x3C, 0x3A, 0xE6, 0x08, 0x7E, 0xE1, 0x02, 
+0xB6, 0xE6, 0xA5, 0x7C, 0xF5, 0xD7, 0x46, 0x64, 
+0x9C, 0x70, 0x4F, 0x1C, 0x5E, 0x1D, 0x57, 0x0D, 
+0x3E, 0x3D, 0xF9, 0xB3, 0x04, 0xC0, 0x9E, 0xE2, 
+0x60, 0xBA, 0xE3, 0xCF, 0x27, 0xDE, 0xFE, 0x23, 
+0x2A, 0xEC, 0x60, 0x9B, 0x57, 0xCE, 0x4C, 0x8C, 
+0x78, 0x96, 0x8D, 0x7E, 0x68, 0x3F, 0x45, 0x8A, 
+0x11, 0xE3, 0xBB, 0x75, 0x8A, 0x75, 0x05, 0x84, 
+0x15, 0x31, 0x1E, 0x05, 0x7F, 0x32, 0x0D, 0xEA, 
+0x07, 0xBF, 0x73, 0xFC, 0xD0, 0x31, 0xB2, 0xAA, 
+0x1D, 0xB1, 0xC5, 0x98, 0xAA, 0x4E, 0x79, 0x70, 
+0xCB, 0x78, 0x80, 0x58, 0x80, 0x8A, 0x9E, 0xC4, 
+0xEC, 0xEB, 0x25, 0xE8, 0x7C, 0xCA, 0xD2, 0xB7, 
+0x4F, 0x31, 0x48, 0x0D, 0xE2, 0xDF, 0x37, 0x50, 
+0x69, 0xA6, 0xD7, 0xC8, 0x3A, 0x1B, 0x92, 0xF7, 
+0xFD, 0x4F, 0x40, 0x7E, 
+
+0x87, 0x62, 0xFC, 0xFD, 0x85, 0x83, 0xE4, 0x28, 
+0x44, 0x86, 0xF3, 0x37, 0x02, 0xF4, 0x1E, 0x6C, 
+0x3F, 0xA8, 0xA3, 0xC4, 0xC0, 0xD0, 0x4C, 0x6E, 
+0xC2, 0xE2, 0x86, 0xD6, 0x8F, 0xF2, 0x34, 0xF2, 
+
+0x01, 0x52, 0x6E, 0x80, 0x85, 0x60, 0x71, 0xB8, 
+0x82, 0xD8, 0x84, 0x1C, 0x45, 0x64, 0x8B, 0x9E, 
+0xF0, 0x19, 0x6C, 0x05, 0x3E, 0x5E, 0x09, 0xA7, 
+0xB4, 0x32, 0x71, 0x80, 0x30, 0x32, 0x3B, 0xDB, 
+0x0B, 
+
+0x18, 0xE3, 0xA0, 0x84, 0x2D, 0x5D, 0x8D, 0x5C, 
+0xC3, 0xBE, 0x88, 0xCB, 0x65, 0x2A, 0xBF, 0x87, 
+0xD1, 0xD3, 0xA3, 0xA4, 0x28, 0x48, 0x83, 0x1B, 
+0xE5, 0x67, 0x0C, 0x62, 0xEC, 0x60, 0x09, 0x6C, 
+0x0D, 0x52, 
+
+0x08, 0x87, 0x44, 0x13, 0x4A, 0x67, 0x51, 0xE3, 
+0xD0, 0xE5, 0xAE, 0xC9, 0xC5, 0x03, 0xA3, 0x1C, 
+0x59, 0x7C, 0xCB, 0x0E, 0xF7, 0xED, 0x87, 0x2C, 
+0x60, 0xC6, 0x46, 0x7F, 0x67, 0xD8, 0x33, 0x04, 
+0x75, 0xF0, 0x3D, 
+
+0xD3, 0x5E, 0x2C, 0x38, 0x4A, 0x98, 0xAF, 0xE5, 
+0xB5, 0x19, 0xC7, 0x54, 0xC7, 0xA6, 0x34, 0xCD, 
+0x15, 0xA0, 0xD9, 0xAB, 0xC8, 0xFE, 0xBA, 0x1E, 
+0xA6, 0x00, 0xB7, 0xAA, 0x39, 0x05, 0xF2, 0xE7, 
+0x8C, 0xFE, 0xD0, 0x95, 
+
+0x42, 0x63, 0x03, 0x6A, 0xFC, 0x5E, 0x53, 0xDC, 
+0x04, 0x1B, 0xAA, 0x0C, 0x34, 0x06, 0x77, 0x64, 
+0x6B, 0xD3, 0xC2, 0xA0, 0x9F, 0xC1, 0x1B, 0x5A, 
+0xD3, 0xBE, 0x64, 0x4D, 0x6F, 0x75, 0x47, 0x76, 
+0x1F, 0xF2, 0xFB, 0x41, 0x86, 
+
+0x6E, 0xF6, 0xC1, 0x1D, 0xE0, 0xEF, 0x42, 0x74, 
+0x5C, 0x8E, 0x44, 0x79, 0xB0, 0x60, 0x6F, 0x14, 
+0x77, 0x87, 0x6C, 0xF7, 0x90, 0xC5, 0x08, 0x74, 
+0x58, 0xF5, 0x97, 0xA3, 0xC7, 0x45, 0x44, 0xCE, 
+0x4C, 0x97, 0x7F, 0x00, 0x80, 0x9D, 
+
+0xE0, 0xAD, 0x22, 0xC2, 0x04, 0xF6, 0xB7, 0x3E, 
+0xB1, 0x76, 0xC8, 0x1C, 0x98, 0x0E, 0xC4, 0xBB, 
+0x4C, 0x4D, 0xC3, 0xA9, 0x9F, 0x86, 0x49, 0xF8, 
+0xAF, 0x07, 0x2F, 0x62, 0x92, 0x0D, 0x50, 0xD7, 
+0x7C, 0x57, 0x85, 0x33, 0x6D, 0x2A, 0x8A, 
+
+0x02, 0xCC, 0x94, 0x9C, 0x06, 0x18, 0xA3, 0x1B, 
+0xB5, 0x7B, 0x1F, 0xF7, 0x31, 0x85, 0x3E, 0xDD, 
+0x42, 0x35, 0xF9, 0x34, 0x79, 0x29, 0x8E, 0xC1, 
+0x7D, 0x94, 0x10, 0xFE, 0x3F, 0xD8, 0x2B, 0xD5, 
+0xD6, 0x58, 0xE8, 0x65, 0x06, 0xF1, 0x7F, 0xD4, 
+
+0xDC, 0x23, 0xEA, 0x07, 0xF5, 0x4B, 0xC7, 0xE5, 
+0x9A, 0x0D, 0xA9, 0xEC, 0x81, 0x56, 0x63, 0xC7, 
+0x5A, 0x24, 0x4F, 0xB1, 0xE1, 0x71, 0x60, 0xD5, 
+0x7A, 0xB8, 0xBF, 0xEC, 0xB8, 0x29, 0x71, 0xD0, 
+0x5F, 0xC1, 0xE8, 0x85, 0xB1, 0x1C, 0x8E, 0x41, 
+0x08, 
+
+0x86, 0xC0, 0x31, 0x6A, 0xB1, 0xE8, 0x61, 0x24, 
+0x01, 0x4D, 0x51, 0x2C, 0x92, 0x13, 0xED, 0x88, 
+0xA0, 0x6B, 0xB0, 0x0A, 0xEA, 0xA0, 0x3A, 0xAD, 
+0x21, 0x40, 0x39, 0x96, 0xCD, 0x10, 0x3C, 0xE3, 
+0x7B, 0xFA, 0xCF, 0xA8, 0x10, 0x08, 0x4C, 0x76, 
+0xD7, 0xA7, 
+
+0x75, 0xFA, 0xBE, 0xC1, 0x94, 0xB0, 0x35, 0x33, 
+0x8A, 0xC1, 0xA2, 0xAA, 0x77, 0x6C, 0xC8, 0x0F, 
+0x03, 0xE5, 0xB2, 0x9F, 0x59, 0x94, 0x21, 0x32, 
+0xB9, 0xE8, 0x4F, 0x59, 0x74, 0x23, 0x6C, 0xD8, 
+0x2D, 0x85, 0xEA, 0xFA, 0xB4, 0xDD, 0x13, 0x8E, 
+0x85, 0x53, 0x0B, 
+
+0x26, 0x83, 0xE6, 0x55, 0x0F, 0xB1, 0x46, 0x9B, 
+0x5D, 0xAD, 0xDE, 0xEC, 0x28, 0x07, 0xDC, 0xF7, 
+0xF5, 0x6A, 0x28, 0x4E, 0x8F, 0x4B, 0xDE, 0x88, 
+0xAA, 0x4C, 0x3F, 0x11, 0x96, 0xC6, 0x77, 0xEA, 
+0x88, 0x0B, 0x8A, 0x5D, 0x50, 0x4A, 0x7B, 0x3E, 
+0xDC, 0x2D, 0xFC, 0x1C, 
+
+0x84, 0x36, 0x46, 0x3B, 0xFA, 0x33, 0x4C, 0x98, 
+0x55, 0xFF, 0xF1, 0x5F, 0x52, 0xB1, 0xE7, 0xAE, 
+0xA3, 0x77, 0xB4, 0x1D, 0xAC, 0x9C, 0x35, 0x8D, 
+0xC6, 0xBC, 0xD7, 0xB6, 0x17, 0xC4, 0x5D, 0x1A, 
+0xC9, 0x2C, 0x00, 0x58, 0x0B, 0x0F, 0xE0, 0x8B, 
+0x23, 0x28, 0x9A, 0x32, 0xB9, 
+
+0xD2, 0x85, 0x2A, 0xFC, 0x8F, 0xA7, 0x9C, 0x2B, 
+0x89, 0xD9, 0x64, 0x8E, 0xFE, 0x6F, 0x39, 0x6B, 
+0xF5, 0x19, 0x10, 0xD3, 0x99, 0xD2, 0x53, 0xA1, 
+0xA1, 0x03, 0x98, 0x9D, 0xB4, 0xA1, 0x4E, 0xD1, 
+0xA5, 0xFB, 0xA0, 0x14, 0xD9, 0x62, 0x4B, 0x64, 
+0xF8, 0x10, 0xDB, 0xA1, 0x7C, 0x0A, 
+
+0x8D, 0x75, 0xCB, 0x8E, 0x14, 0xF1, 0xE6, 0xDE, 
+0x02, 0xAE, 0x87, 0x4F, 0x62, 0xB0, 0x99, 0xC4, 
+0x3B, 0x27, 0x7C, 0x61, 0xB1, 0xE3, 0xD2, 0xFC, 
+0xCD, 0xD7, 0x37, 0x2B, 0x31, 0xE5, 0xFB, 0xD5, 
+0x0C, 0x30, 0x39, 0x17, 0x1E, 0x02, 0xEA, 0xFD, 
+0x22, 0x37, 0xEF, 0xB9, 0xB3, 0xA2, 0x75, 
+
+0x46, 0xF9, 0x7A, 0x74, 0xBB, 0x45, 0x40, 0xA1, 
+0x37, 0x4A, 0xB5, 0xD3, 0x62, 0x6F, 0xFB, 0xF1, 
+0xC2, 0x9B, 0x8B, 0x93, 0x77, 0x3F, 0x73, 0xF6, 
+0x49, 0x2A, 0xCC, 0x47, 0x08, 0xEA, 0x50, 0x90, 
+0x92, 0x05, 0xFA, 0x30, 0xEF, 0x40, 0x10, 0xE7, 
+0xDF, 0xAA, 0x03, 0x3B, 0xCC, 0xA5, 0x6A, 0xB8, 
+
+0xA6, 0xB1, 0x6D, 0xF3, 0xF8, 0x21, 0x0D, 0x7E, 
+0xEE, 0x4C, 0xFF, 0x20, 0x1C, 0x75, 0x9F, 0x97, 
+0x95, 0x55, 0xAD, 0xAC, 0x53, 0x48, 0xD7, 0x32, 
+0xA8, 0xE7, 0x45, 0xD2, 0x0A, 0x32, 0x66, 0x4C, 
+0x9B, 0x86, 0xEC, 0x68, 0x92, 0xA3, 0x8C, 0x98, 
+0x5B, 0xEA, 0xFA, 0xC9, 0x95, 0x72, 0x78, 0xD4, 
+0x0E, 
+
+0x3D, 0x86, 0x50, 0xAA, 0xC0, 0x55, 0xD1, 0xD1, 
+0xF6, 0x1F, 0xEC, 0xF4, 0x33, 0x72, 0x18, 0xEA, 
+0x4D, 0xBB, 0x4B, 0xEE, 0x57, 0xA6, 0x01, 0x91, 
+0xA0, 0xEF, 0x1E, 0xBA, 0x23, 0x81, 0x06, 0x0E, 
+0x40, 0x7C, 0xE0, 0x72, 0x20, 0xD1, 0x81, 0x36, 
+0x31, 0x6A, 0xA8, 0x08, 0xE4, 0x29, 0x5B, 0x5B, 
+0x76, 0x46, 
+
+0xC5, 0x31, 0x69, 0xCB, 0x0A, 0x73, 0xD3, 0x3F, 
+0xEF, 0xFA, 0xC4, 0xD2, 0x4E, 0xE2, 0xBC, 0xA5, 
+0x08, 0x55, 0x8D, 0x35, 0x1D, 0x27, 0x80, 0xF3, 
+0x45, 0xF2, 0xBC, 0xCD, 0x41, 0xB9, 0xB9, 0x93, 
+0x23, 0x6B, 0x5A, 0x1F, 0xA7, 0xE4, 0x91, 0xAB, 
+0xB4, 0x91, 0xBA, 0xF5, 0x99, 0xFB, 0xE1, 0x47, 
+0xB9, 0xDD, 0xFA, 
+
+0xFB, 0x80, 0x35, 0x0F, 0x12, 0xA4, 0x10, 0x14, 
+0x60, 0x12, 0xF9, 0xAC, 0xA1, 0x53, 0x0C, 0x2F, 
+0x47, 0x73, 0x68, 0x5C, 0x1B, 0x96, 0x87, 0xC7, 
+0xFA, 0x44, 0x4C, 0x34, 0xE6, 0xB8, 0x1E, 0x86, 
+0xF2, 0x36, 0xA1, 0xA6, 0xA9, 0x89, 0x18, 0xCC, 
+0x2C, 0x71, 0xB1, 0xB4, 0x71, 0xA6, 0x3E, 0x1D, 
+0xF4, 0xD5, 0x1D, 0x31, 
+
+0xBA, 0xAB, 0xF6, 0x3A, 0xEA, 0x84, 0x49, 0x33, 
+0x91, 0xC2, 0x48, 0xA3, 0x5B, 0xD7, 0xAD, 0x2F, 
+0xCE, 0x95, 0xEA, 0xCF, 0x8C, 0x0F, 0xC8, 0x64, 
+0x53, 0x58, 0xC3, 0x86, 0xFA, 0xDA, 0xC7, 0x0E, 
+0x42, 0xF7, 0xB0, 0x67, 0x87, 0xE1, 0xC4, 0x77, 
+0xAA, 0x45, 0x35, 0x86, 0x29, 0x6C, 0x9C, 0x72, 
+0xD6, 0xF6, 0xF8, 0xB5, 0x5E, 
+
+0xBC, 0x6A, 0x7E, 0xEB, 0xA1, 0xD2, 0x2B, 0x5D, 
+0x71, 0x87, 0x3E, 0x0A, 0xBE, 0x17, 0xB0, 0xE7, 
+0x6A, 0x74, 0x45, 0xD9, 0xFA, 0x04, 0xCC, 0x46, 
+0xF1, 0x1B, 0xF0, 0xCF, 0x99, 0x91, 0xEB, 0xD1, 
+0xD4, 0xF0, 0xDB, 0xB0, 0x1F, 0x1F, 0x4C, 0x4F, 
+0xB0, 0x71, 0xA9, 0xE4, 0x80, 0x92, 0x24, 0x52, 
+0x46, 0xDA, 0x62, 0xAD, 0x3C, 0x08, 
+
+0x8E, 0xEE, 0x5F, 0xD3, 0x78, 0x08, 0x1A, 0xE0, 
+0x8A, 0x2B, 0xB4, 0xAF, 0x15, 0xF9, 0xFC, 0xC0, 
+0x58, 0x46, 0x79, 0x36, 0xFC, 0x6F, 0x17, 0x33, 
+0xF9, 0xEC, 0x6D, 0x42, 0xF0, 0x5F, 0xE2, 0xDC, 
+0x0C, 0x8F, 0x65, 0x94, 0xE8, 0x4D, 0xFC, 0x40, 
+0xD0, 0x64, 0xDE, 0xD1, 0x9C, 0x78, 0xCE, 0x97, 
+0x35, 0x28, 0xE4, 0x43, 0x78, 0xF1, 0x40, 
+
+0x84, 0x5A, 0xD1, 0x68, 0xF7, 0x61, 0x3B, 0xA2, 
+0x4D, 0xCC, 0x39, 0x52, 0xCC, 0x3F, 0xFE, 0x86, 
+0x25, 0x24, 0xA6, 0x40, 0x55, 0x36, 0x5A, 0xBE, 
+0x4B, 0xBE, 0x18, 0x6B, 0x1F, 0x8C, 0x6C, 0x97, 
+0x6B, 0xCB, 0xDD, 0xB3, 0xAA, 0x12, 0x3A, 0x7A, 
+0x09, 0x66, 0x5B, 0x0B, 0x5F, 0xAB, 0x00, 0x49, 
+0xBA, 0x27, 0xD7, 0x9A, 0xCA, 0xE7, 0x05, 0x50, 
+
+0xB2, 0xBE, 0xE3, 0x0B, 0x31, 0x8B, 0xBF, 0x74, 
+0xC6, 0xE0, 0x06, 0x73, 0x47, 0xED, 0x3D, 0xFE, 
+0xFB, 0x1F, 0x3D, 0x9B, 0xF9, 0x11, 0x45, 0x7A, 
+0x47, 0xCE, 0x34, 0x50, 0x0B, 0x3E, 0x37, 0xDD, 
+0x36, 0x41, 0x5F, 0x55, 0x16, 0xCA, 0xA2, 0x61, 
+0xE0, 0xD5, 0x8F, 0xE8, 0x6F, 0xF8, 0x2A, 0x8C, 
+0x2E, 0xA5, 0x97, 0xE4, 0x8A, 0x43, 0x82, 0x72, 
+0x3E, 
+
+0x6E, 0xAF, 0x03, 0x5E, 0x4B, 0xC9, 0x8E, 0xF4, 
+0x4B, 0x65, 0x72, 0x79, 0xC4, 0x6B, 0xCD, 0x2A, 
+0xB4, 0x3B, 0x86, 0xAE, 0xB2, 0x40, 0x37, 0x9D, 
+0x4F, 0xB9, 0x19, 0x2F, 0x59, 0xCB, 0x79, 0xA7, 
+0x54, 0xF9, 0x5C, 0x74, 0x39, 0x5F, 0x8C, 0x0D, 
+0x83, 0x0D, 0x73, 0xEE, 0xD4, 0x7F, 0xF3, 0x3D, 
+0xA2, 0xD9, 0xC9, 0xDA, 0x7A, 0x67, 0x60, 0xCB, 
+0x84, 0xAD, 
+
+0x6F, 0x69, 0xC5, 0xD7, 0x38, 0xAB, 0xCC, 0xB3, 
+0x64, 0xC3, 0xE4, 0xE7, 0x83, 0x3F, 0x7C, 0xB6, 
+0xAA, 0xE2, 0x37, 0x16, 0xEF, 0x93, 0x0E, 0x67, 
+0x53, 0x37, 0x07, 0xF7, 0x3F, 0xA5, 0x58, 0x07, 
+0xDD, 0x74, 0x27, 0x4A, 0xF5, 0xF6, 0xD7, 0x6C, 
+0x04, 0x42, 0x06, 0x2E, 0x6B, 0xD9, 0xF4, 0x6E, 
+0x31, 0x1B, 0xED, 0xE3, 0x34, 0xE4, 0x61, 0xB5, 
+0x83, 0x35, 0x8B, 
+
+0xEA, 0x7A, 0x2A, 0xB6, 0x9B, 0x42, 0x7F, 0xE1, 
+0xA0, 0x89, 0xDF, 0x36, 0x09, 0x83, 0x0C, 0x0F, 
+0xD2, 0x1A, 0x86, 0x3D, 0x8C, 0xEC, 0x46, 0xCF, 
+0x9C, 0xCB, 0x00, 0xBA, 0xDF, 0x00, 0xC2, 0x09, 
+0xAC, 0x26, 0xF3, 0x67, 0x3B, 0x47, 0x24, 0xCC, 
+0xE3, 0x0D, 0x41, 0x42, 0x42, 0xF2, 0x0C, 0x26, 
+0xDC, 0xAE, 0x36, 0x5D, 0xA7, 0xFD, 0x0A, 0x40, 
+0x20, 0x10, 0x24, 0x3F, 
+
+0x7F, 0xB7, 0x2A, 0x18, 0x50, 0xD9, 0x17, 0xC5, 
+0xEC, 0x30, 0x84, 0x3D, 0x3F, 0x07, 0xCD, 0x9C, 
+0x91, 0x9E, 0x7E, 0x13, 0xF4, 0xB3, 0xF5, 0x55, 
+0xAB, 0xB9, 0x39, 0xC7, 0xFD, 0xAC, 0xF2, 0xAA, 
+0x07, 0xB7, 0x29, 0x4B, 0x15, 0x38, 0x93, 0x2C, 
+0x0F, 0x47, 0xEE, 0x90, 0x12, 0xE4, 0x0E, 0x8F, 
+0xA9, 0x73, 0x8A, 0x95, 0x7E, 0xAE, 0x78, 0x2E, 
+0x89, 0xC1, 0xED, 0xEF, 0x55, 
+
+0x7F, 0xEC, 0xE2, 0x44, 0xF3, 0x92, 0x66, 0xD4, 
+0xF4, 0x31, 0xD1, 0xD3, 0xE2, 0x9C, 0xBF, 0xFD, 
+0x81, 0x45, 0xA0, 0x19, 0x5A, 0xF7, 0x08, 0xEA, 
+0xE7, 0x6A, 0x8E, 0x94, 0xB1, 0xBC, 0xD2, 0xEC, 
+0x73, 0x97, 0xF9, 0x89, 0x30, 0xD0, 0xFA, 0xD5, 
+0x03, 0xAE, 0xBD, 0x4A, 0x14, 0x4C, 0x47, 0x89, 
+0x00, 0xB0, 0xFC, 0xD8, 0x56, 0x6C, 0x02, 0x03, 
+0xB5, 0x81, 0x83, 0x78, 0x40, 0xB4, 
+
+0x3E, 0x5F, 0xA1, 0x82, 0x2C, 0xE3, 0xE9, 0x0C, 
+0x4A, 0xCF, 0x53, 0x14, 0x66, 0xF1, 0x7F, 0x10, 
+0x1B, 0x36, 0x26, 0x5A, 0xE6, 0xBA, 0x8A, 0x28, 
+0xA6, 0x53, 0xDF, 0x65, 0x3D, 0xC3, 0x71, 0xA2, 
+0x61, 0xFD, 0x1E, 0xAF, 0xF5, 0xB0, 0x8C, 0x52, 
+0xC8, 0x61, 0x1D, 0xD7, 0xA2, 0x6E, 0x4D, 0x49, 
+0xEE, 0x9D, 0x92, 0x44, 0xB6, 0xDB, 0x88, 0x62, 
+0x4E, 0x88, 0x96, 0x57, 0xF4, 0x22, 0x39, 
+
+0x5B, 0x7F, 0x5B, 0x9D, 0x38, 0xFF, 0x97, 0xC9, 
+0xA4, 0x82, 0x8F, 0x40, 0x3F, 0x9D, 0x85, 0x95, 
+0x23, 0xC7, 0xB6, 0xAF, 0x54, 0xDD, 0x8D, 0x6F, 
+0x66, 0x4A, 0x16, 0x52, 0x3E, 0xBA, 0x88, 0xEB, 
+0xDE, 0x80, 0x66, 0xC7, 0xEB, 0x90, 0x6A, 0xF5, 
+0x06, 0x07, 0x5C, 0xAE, 0x19, 0x81, 0x48, 0x47, 
+0x67, 0x8D, 0x4C, 0x6D, 0xF3, 0xD6, 0x60, 0x2E, 
+0x95, 0x6B, 0xF5, 0x96, 0xFE, 0x85, 0x7B, 0x41, 
+
+0x54, 0xE3, 0xAD, 0x70, 0x80, 0x14, 0xEB, 0x70, 
+0x03, 0x94, 0x2F, 0xFA, 0x54, 0x8B, 0x47, 0x85, 
+0x08, 0x4D, 0xC9, 0xEA, 0x5B, 0xA6, 0x9D, 0x34, 
+0xC9, 0x84, 0xFC, 0x94, 0x7C, 0xC1, 0x65, 0xB4, 
+0xB9, 0xED, 0x4D, 0x4B, 0xF1, 0xD7, 0x93, 0xAA, 
+0xA3, 0x47, 0xD4, 0x25, 0xFB, 0x60, 0xE1, 0x76, 
+0xC8, 0xA8, 0xCF, 0xF2, 0x43, 0xBF, 0xD4, 0xEF, 
+0x5E, 0x18, 0x56, 0x55, 0x3C, 0xB6, 0x88, 0xB9, 
+0x22, 
+
+0xB9, 0x72, 0xC5, 0x43, 0x70, 0x95, 0x75, 0x70, 
+0x4A, 0x01, 0x45, 0xA4, 0xE1, 0x83, 0xD6, 0xE8, 
+0x8B, 0x29, 0x0B, 0xA8, 0xE9, 0x32, 0x27, 0x2F, 
+0xA7, 0x7E, 0x66, 0x1B, 0xAE, 0x70, 0x82, 0xF7, 
+0x9D, 0xDC, 0x8F, 0xF8, 0x67, 0x37, 0x45, 0x4D, 
+0xE0, 0xD7, 0xC7, 0x84, 0x9E, 0x72, 0x13, 0x5F, 
+0x33, 0xEA, 0x7B, 0xEC, 0x99, 0x1A, 0xAC, 0xBB, 
+0xD1, 0x2B, 0x7F, 0x8D, 0xFE, 0x7B, 0x8B, 0xB1, 
+0xE8, 0x60, 
+
+0x43, 0x7A, 0x91, 0x09, 0x41, 0x9B, 0x45, 0x3B, 
+0xA4, 0x3B, 0x05, 0xCB, 0xD2, 0xDE, 0xC6, 0x12, 
+0x2A, 0x4A, 0x27, 0x2C, 0x6C, 0xFD, 0xCB, 0x0E, 
+0xCD, 0x12, 0xA0, 0x33, 0x91, 0x04, 0x47, 0x82, 
+0x77, 0xC8, 0x69, 0x5B, 0x89, 0x6B, 0x26, 0xDC, 
+0x4D, 0x59, 0x07, 0x11, 0xC3, 0x7E, 0x64, 0x93, 
+0x01, 0x17, 0xF8, 0xA3, 0xE7, 0xB0, 0x15, 0x68, 
+0xF9, 0x87, 0x69, 0x2A, 0x34, 0x4D, 0x21, 0xB6, 
+0x5E, 0x29, 0xAE, 
+
+0x7A, 0x6F, 0xC9, 0xA9, 0xBA, 0x66, 0xD6, 0x8B, 
+0x5A, 0x95, 0x04, 0x18, 0x85, 0xCE, 0xDA, 0xB9, 
+0x22, 0x4A, 0x07, 0x99, 0x45, 0x1F, 0x92, 0x9C, 
+0xAF, 0x4C, 0x6F, 0xD2, 0xD8, 0x39, 0x6F, 0x1C, 
+0xA0, 0xB2, 0x4F, 0xB9, 0x99, 0x70, 0xE6, 0xB0, 
+0xD7, 0xC0, 0xA6, 0xCA, 0x03, 0x5D, 0xA0, 0x58, 
+0x26, 0x12, 0x1A, 0x3D, 0xB3, 0x85, 0x08, 0x10, 
+0xC6, 0x3F, 0x8A, 0x2D, 0x84, 0xBC, 0xBE, 0xA6, 
+0xD6, 0x14, 0xFC, 0xC4, 
+
+0x9E, 0x41, 0x17, 0x8D, 0xC2, 0xF7, 0x99, 0x51, 
+0x05, 0x3B, 0x83, 0xA5, 0xAB, 0x28, 0x5A, 0xC9, 
+0x70, 0x9B, 0xDF, 0x9A, 0xA1, 0x76, 0x91, 0x86, 
+0xEA, 0x8B, 0xF5, 0xAE, 0x58, 0x06, 0xF4, 0x06, 
+0xF4, 0xA3, 0x84, 0xF6, 0xDB, 0x39, 0xE9, 0x3B, 
+0xB6, 0x3F, 0x8B, 0xEA, 0x67, 0x2D, 0x28, 0x7B, 
+0x80, 0x7B, 0x2D, 0xB6, 0x43, 0x36, 0x9B, 0x7C, 
+0x94, 0xE4, 0xC2, 0x80, 0x0B, 0xE7, 0x21, 0x30, 
+0xE0, 0xB6, 0x64, 0xC7, 0xCD, 
+
+0x78, 0xF9, 0x1B, 0x86, 0x61, 0x2F, 0xA0, 0x3E, 
+0x21, 0x0E, 0x88, 0xD6, 0x84, 0x55, 0xAE, 0x94, 
+0xB0, 0xFA, 0xF1, 0xD8, 0x7F, 0xD6, 0x2A, 0x10, 
+0x7A, 0xD4, 0xD2, 0xDA, 0xA9, 0xAE, 0x7B, 0x30, 
+0x51, 0x14, 0x96, 0x05, 0x21, 0xD4, 0x2B, 0x3D, 
+0x11, 0xC3, 0x22, 0x2C, 0x4F, 0xE2, 0x9A, 0x60, 
+0x20, 0x30, 0xF9, 0xA6, 0x71, 0x60, 0x01, 0x71, 
+0xEB, 0x63, 0x75, 0xDC, 0xDB, 0x28, 0xB3, 0x75, 
+0x73, 0x17, 0x38, 0xAF, 0x93, 0xBD, 
+
+0x38, 0xC2, 0x63, 0x88, 0xED, 0x3B, 0xDC, 0x5E, 
+0x47, 0x77, 0x5C, 0xC3, 0x06, 0x0D, 0x4F, 0x9C, 
+0x61, 0x78, 0xF7, 0xD8, 0x5E, 0x3D, 0x45, 0x57, 
+0xFC, 0x8B, 0xC4, 0x42, 0xC4, 0x49, 0x05, 0xB4, 
+0xD4, 0x04, 0x0D, 0xCA, 0xA0, 0x5A, 0x30, 0xC7, 
+0x58, 0x56, 0xAE, 0x04, 0x9D, 0xFD, 0x43, 0x42, 
+0xE7, 0x2F, 0x88, 0x24, 0x85, 0x6F, 0x4C, 0xA1, 
+0x39, 0xE0, 0xD4, 0x2D, 0xF1, 0xCB, 0x37, 0xA1, 
+0xA6, 0xB7, 0x0F, 0xA3, 0xC0, 0x21, 0x9D, 
+
+0xD6, 0x34, 0x50, 0x70, 0xF9, 0xB4, 0x75, 0x3F, 
+0x81, 0xF1, 0x47, 0xB3, 0x22, 0x4C, 0x4E, 0x8C, 
+0x1F, 0x68, 0x2B, 0x78, 0xCB, 0x64, 0xEF, 0x34, 
+0x44, 0xA1, 0xA8, 0x6C, 0xFE, 0x67, 0xBA, 0x26, 
+0x1A, 0x73, 0xA2, 0x04, 0xB3, 0x1E, 0xEC, 0x91, 
+0x81, 0x4A, 0xE4, 0x95, 0xE6, 0x70, 0xB4, 0x0D, 
+0x53, 0xDB, 0x05, 0x31, 0x2D, 0x1A, 0xF6, 0x21, 
+0x3F, 0x12, 0x25, 0xB7, 0xE5, 0x2F, 0xE8, 0xFA, 
+0xAC, 0x55, 0x60, 0x8B, 0xBC, 0xDF, 0x4F, 0xC4, 
+
+0xD5, 0x7C, 0x84, 0xB2, 0x79, 0xD9, 0x40, 0x01, 
+0xA3, 0x19, 0xE4, 0x96, 0xAC, 0x7F, 0x1D, 0x56, 
+0xCF, 0x52, 0x48, 0x77, 0x59, 0x3B, 0xBE, 0xAB, 
+0x66, 0xFA, 0xA6, 0xCE, 0x6B, 0x13, 0x16, 0xDE, 
+0xBF, 0xCC, 0x72, 0x61, 0xCE, 0xBD, 0x68, 0xA9, 
+0x34, 0xA2, 0xB8, 0xFD, 0x29, 0xC0, 0x78, 0x3C, 
+0xD4, 0x4F, 0x61, 0xE0, 0xCF, 0xCA, 0xA5, 0x0E, 
+0x07, 0x43, 0x7D, 0xB5, 0x6B, 0xB9, 0xDA, 0xD4, 
+0x4C, 0xB6, 0x7A, 0x26, 0xA7, 0x5D, 0x08, 0x65, 
+0x82, 
+
+0x06, 0x16, 0xBD, 0xFF, 0x5B, 0x4F, 0x98, 0x92, 
+0x0F, 0x09, 0x97, 0xDA, 0x44, 0xD9, 0xFC, 0x67, 
+0x77, 0x5E, 0x2D, 0x3E, 0x4E, 0x91, 0x43, 0x22, 
+0xED, 0x77, 0x98, 0x9D, 0x06, 0x47, 0xA4, 0xB0, 
+0x46, 0x0A, 0x10, 0x95, 0xA7, 0x7C, 0x3C, 0x31, 
+0x1B, 0xCA, 0x6B, 0x1C, 0xD7, 0x2C, 0x58, 0x67, 
+0xB1, 0xAF, 0x85, 0xA7, 0x54, 0x43, 0x85, 0xA9, 
+0xF2, 0x06, 0x05, 0x9B, 0x4B, 0xA0, 0x0C, 0xB4, 
+0x22, 0xC4, 0xE9, 0xDE, 0x17, 0x9A, 0xC3, 0xFD, 
+0xBA, 0x8B, 
+
+0x41, 0xC5, 0x15, 0x82, 0xFD, 0x09, 0xEB, 0x64, 
+0x95, 0xDE, 0x7F, 0xEC, 0x0D, 0x57, 0x22, 0xD9, 
+0x64, 0xF2, 0xDB, 0x7B, 0xC9, 0xAD, 0x00, 0x79, 
+0x00, 0xFA, 0xE4, 0x75, 0xFE, 0x0D, 0xD1, 0x7C, 
+0x44, 0x55, 0x2F, 0xC6, 0xAA, 0xF8, 0x47, 0x40, 
+0x6D, 0x0B, 0x24, 0xD5, 0xEF, 0x1F, 0xE9, 0x91, 
+0x57, 0x3A, 0x9F, 0x00, 0xC6, 0x4C, 0xA5, 0x4C, 
+0xFD, 0x81, 0xB4, 0xBF, 0x91, 0x17, 0xC2, 0xEC, 
+0x1F, 0x52, 0x55, 0xB3, 0xB4, 0x56, 0x57, 0x96, 
+0x22, 0x6D, 0xF3, 
+
+0x8C, 0x41, 0x94, 0xC4, 0x33, 0x0F, 0x87, 0x4A, 
+0xCB, 0x04, 0xD5, 0x05, 0xFD, 0x19, 0x79, 0x5F, 
+0x83, 0xBC, 0x71, 0xB3, 0x8A, 0x86, 0xA7, 0x2F, 
+0xB7, 0xB6, 0x13, 0x24, 0x6C, 0x21, 0xDF, 0x88, 
+0xF4, 0xB6, 0xEB, 0x00, 0x6F, 0x40, 0x56, 0xB6, 
+0x5B, 0x41, 0x61, 0xAC, 0x4E, 0x07, 0xBA, 0x6A, 
+0xAE, 0xBC, 0x3F, 0x4C, 0x7E, 0x16, 0xEC, 0x3F, 
+0x91, 0xF7, 0x2A, 0x57, 0x58, 0x3C, 0x3F, 0xE8, 
+0x53, 0xB8, 0x2F, 0x4C, 0x53, 0xD4, 0xD1, 0xFF, 
+0xDC, 0x2D, 0x81, 0x31, 
+
+0x2B, 0xE1, 0xC5, 0x5D, 0x45, 0x03, 0x69, 0x50, 
+0xE2, 0x65, 0xCF, 0x91, 0x0D, 0xAF, 0xD1, 0x0D, 
+0xDC, 0xE0, 0x7E, 0x65, 0x9D, 0xF1, 0x8F, 0xDF, 
+0x32, 0x9C, 0x2F, 0x77, 0x77, 0x64, 0xBD, 0xFD, 
+0xB9, 0x9B, 0x0F, 0xF8, 0x13, 0xD9, 0x29, 0xBC, 
+0x71, 0x61, 0xB6, 0x87, 0x48, 0xBB, 0xA3, 0xF4, 
+0xA4, 0xB4, 0x7D, 0x36, 0x07, 0x4B, 0x52, 0xAB, 
+0xFA, 0x84, 0x5A, 0x58, 0x58, 0xE8, 0xD5, 0x98, 
+0xBD, 0x44, 0x67, 0x92, 0xF0, 0xD8, 0x18, 0x8E, 
+0xE2, 0xA7, 0xA2, 0xB9, 0x96, 
+
+0xC3, 0xAB, 0x60, 0xCA, 0x52, 0xCD, 0xC7, 0x59, 
+0xF9, 0x40, 0x85, 0x43, 0xFE, 0x89, 0x67, 0xF5, 
+0xC2, 0x58, 0x4A, 0x93, 0x5D, 0x09, 0x90, 0xC4, 
+0xFE, 0x64, 0x9D, 0x72, 0x5B, 0x00, 0x74, 0xBA, 
+0x5E, 0xE1, 0xF8, 0x73, 0xD6, 0xF7, 0xBD, 0x55, 
+0x0F, 0x83, 0x26, 0x8D, 0x84, 0x5F, 0x16, 0x2F, 
+0x4E, 0xDF, 0x84, 0xB9, 0xC5, 0x40, 0xCC, 0xD5, 
+0x41, 0xB1, 0x65, 0x92, 0x2A, 0x48, 0x55, 0x35, 
+0x98, 0x1F, 0x2B, 0xCD, 0xF5, 0x33, 0x73, 0x73, 
+0xE6, 0xAC, 0x21, 0x66, 0xD7, 0x66, 
+
+0xE3, 0xA7, 0xA2, 0x9B, 0xB1, 0x07, 0xB6, 0x08, 
+0x8D, 0xCB, 0xB5, 0x03, 0xD0, 0x08, 0xA7, 0x1D, 
+0xF4, 0x5E, 0x60, 0x6A, 0x66, 0xC5, 0x9B, 0x3E, 
+0x27, 0xAA, 0x3E, 0x4E, 0x46, 0xFF, 0x4B, 0x6C, 
+0xF9, 0x04, 0x77, 0x40, 0x81, 0xD4, 0x31, 0x98, 
+0x90, 0x7F, 0xAE, 0x1D, 0xFE, 0x79, 0xF0, 0x57, 
+0xB8, 0x7E, 0x79, 0x4D, 0xD2, 0xA7, 0xF4, 0x8A, 
+0x52, 0xB7, 0xE9, 0xB4, 0x51, 0x6B, 0x9A, 0x73, 
+0x25, 0x96, 0x2F, 0xB6, 0x51, 0xBB, 0x0A, 0x83, 
+0x82, 0xC9, 0xAD, 0xE0, 0xB8, 0xAF, 0xA6, 
+
+0xF2, 0x30, 0xF1, 0xB7, 0xAE, 0xE6, 0xBC, 0x87, 
+0x18, 0x34, 0xE6, 0x1D, 0x26, 0xE2, 0x8E, 0xD3, 
+0xFB, 0xEC, 0xC0, 0x15, 0x08, 0x6F, 0xDE, 0x11, 
+0x8F, 0x08, 0x8F, 0x3F, 0x9A, 0xCB, 0xF9, 0x45, 
+0x21, 0x93, 0x0A, 0x87, 0xB2, 0x0F, 0x34, 0xB8, 
+0x9E, 0x33, 0x3B, 0xFE, 0xD9, 0x9B, 0xD1, 0x22, 
+0xFB, 0x53, 0x2C, 0x08, 0x5F, 0xFF, 0xC5, 0xBF, 
+0x65, 0x68, 0x58, 0x27, 0x78, 0x2B, 0xAC, 0x48, 
+0xC1, 0x0C, 0x53, 0xA8, 0x00, 0xBB, 0xAD, 0x9C, 
+0xD7, 0x5A, 0xBE, 0x8E, 0x55, 0xA0, 0x11, 0x8E, 
+
+0x03, 0xB8, 0x27, 0x3C, 0x5F, 0xC8, 0xF4, 0xDB, 
+0x76, 0x40, 0xC9, 0xF6, 0x0D, 0xD5, 0x5D, 0xAA, 
+0x66, 0xF2, 0x11, 0x8D, 0x05, 0xCA, 0xB1, 0x22, 
+0xCC, 0xEA, 0x57, 0x88, 0x1C, 0x47, 0x87, 0x1C, 
+0x01, 0x29, 0xD6, 0x05, 0x03, 0x64, 0xEB, 0x09, 
+0x57, 0xF5, 0x0F, 0x69, 0x3B, 0x6E, 0x9E, 0xAF, 
+0x50, 0x10, 0x40, 0x91, 0x7A, 0x82, 0x1D, 0xC4, 
+0xDB, 0x75, 0xDC, 0xE3, 0x70, 0x52, 0x49, 0xB4, 
+0x90, 0x45, 0xAE, 0xB6, 0xD9, 0x43, 0x4E, 0xE5, 
+0x96, 0xB4, 0xF6, 0x93, 0x08, 0x4B, 0x8E, 0xCA, 
+0xBD, 
+
+0xA9, 0xB1, 0x9D, 0xE8, 0x00, 0x41, 0x4C, 0x5F, 
+0x69, 0x9D, 0x65, 0xC1, 0xDD, 0x1A, 0x38, 0xA9, 
+0x75, 0x60, 0x2C, 0x57, 0xAD, 0x10, 0x51, 0xBC, 
+0x80, 0xAA, 0x58, 0x5D, 0xD8, 0x6D, 0x8A, 0x36, 
+0x7C, 0x9C, 0xCA, 0xBD, 0x67, 0xF5, 0xB7, 0x89, 
+0x53, 0x22, 0x27, 0x24, 0xE3, 0x6A, 0x99, 0x97, 
+0xFF, 0xFC, 0xD3, 0x22, 0x81, 0x07, 0xF8, 0xF7, 
+0x6C, 0xE1, 0x23, 0x1F, 0xF4, 0xD2, 0x1A, 0x16, 
+0x65, 0x2B, 0xB3, 0x88, 0x75, 0xA1, 0xC4, 0x97, 
+0x61, 0x62, 0x2B, 0x9A, 0xA1, 0x64, 0xDF, 0xD4, 
+0x4C, 0xF4, 
+
+0x3D, 0x92, 0xB7, 0xF3, 0x29, 0xEA, 0x23, 0x42, 
+0x5D, 0x38, 0x40, 0xC8, 0xA0, 0x2B, 0xDB, 0x93, 
+0xE8, 0xC7, 0x2E, 0x81, 0x96, 0x36, 0xD8, 0xB9, 
+0x90, 0x36, 0xA6, 0x8F, 0xCA, 0x7D, 0xD6, 0x7D, 
+0x5A, 0xBA, 0x1A, 0x6A, 0xB4, 0x91, 0xDB, 0x47, 
+0x45, 0x71, 0xB8, 0xD2, 0x0B, 0x77, 0x9D, 0x32, 
+0x76, 0x3F, 0xFE, 0xB5, 0xA6, 0xC7, 0x58, 0x21, 
+0x0B, 0x21, 0x07, 0xC8, 0x56, 0x4A, 0xE5, 0xF6, 
+0x7C, 0x6D, 0xBE, 0xD3, 0x40, 0x55, 0x89, 0x3D, 
+0x1A, 0x13, 0x08, 0xE8, 0x86, 0x56, 0xFF, 0x7C, 
+0xED, 0xB7, 0xCE, 
+
+0xAC, 0x17, 0x28, 0x8A, 0xE1, 0x13, 0xCC, 0xD8, 
+0x1D, 0x2A, 0x1A, 0x32, 0xA3, 0x29, 0x51, 0x6B, 
+0x77, 0xEA, 0x7C, 0xB4, 0xD8, 0x4E, 0x29, 0x47, 
+0xC4, 0x68, 0x39, 0xA2, 0xDE, 0x2B, 0x5E, 0x7D, 
+0xC7, 0x84, 0x83, 0xC2, 0x06, 0xB1, 0x41, 0x04, 
+0x74, 0x9A, 0x6F, 0xFD, 0x8B, 0x88, 0x23, 0xEC, 
+0x4B, 0xC7, 0xBF, 0x33, 0x49, 0xC0, 0x1B, 0x7D, 
+0x73, 0x4D, 0xE2, 0x96, 0x4E, 0x15, 0x70, 0x19, 
+0x77, 0x2C, 0x3D, 0x42, 0xE1, 0xD1, 0x3D, 0xBC, 
+0x8C, 0x6F, 0xE9, 0xDD, 0x71, 0x9F, 0x7B, 0x75, 
+0xEB, 0x51, 0xFF, 0x02, 
+
+0xEB, 0xAD, 0x45, 0xC8, 0x44, 0x2F, 0xF0, 0x46, 
+0xD7, 0x5C, 0x13, 0x12, 0x9C, 0x06, 0x27, 0x41, 
+0x05, 0xF4, 0x2F, 0x9B, 0x21, 0x68, 0x5B, 0xA1, 
+0x05, 0x84, 0xE0, 0xE8, 0x13, 0x84, 0x99, 0xF5, 
+0xC4, 0x7A, 0x8F, 0x8A, 0x23, 0xF1, 0x2D, 0x68, 
+0xBE, 0xED, 0xE1, 0xC2, 0xF5, 0x83, 0xBF, 0x20, 
+0x10, 0xBB, 0x84, 0x81, 0xE2, 0x6D, 0x42, 0x19, 
+0x29, 0x33, 0x71, 0x75, 0xCC, 0xA4, 0xB7, 0xF5, 
+0x2D, 0xA3, 0x43, 0x2C, 0xBD, 0xBF, 0x29, 0x5C, 
+0xF2, 0xBD, 0xFA, 0x63, 0x86, 0x13, 0x5D, 0xB5, 
+0xEA, 0xE9, 0xFB, 0x35, 0x86, 
+
+}
+return kat[i:j]
+}
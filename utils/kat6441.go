@@ -1,5 +1,5 @@
 package utils
-func getkat(i uint64, j uint64) []uint8 {
+func getkat6441(i uint64, j uint64) []uint8 {
 kat := []uint8{
 0x0C, 0x70, 0x33, 0x3F, 0xC5, 0xE7, 0x2E, 0x0F, 
 0x0D, 0x22, 0x58, 0x04, 0x1C, 0xD2, 0x04, 0xF5, 
@@ -0,0 +1,2841 @@
+package utils
+func getkat6441_trailer(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xB5, 0xF7, 0xCB, 0x3F, 0xF2, 0x6D, 0x68, 0x7B, 
+0xEA, 0xE1, 0xDF, 0x24, 0xA6, 0x40, 0xA4, 0x4F, 
+0xDE, 0x67, 0x22, 0x15, 0x79, 0xBA, 0x67, 0x72, 
+0xD0, 0x23, 0x0D, 0x7D, 0x50, 0x12, 0xE8, 0x1C, 
+
+0x0F, 0x2F, 0x64, 0x78, 0x3A, 0xD3, 0xBB, 0xA6, 
+0xD6, 0xA7, 0xAC, 0x96, 0x24, 0x9E, 0xE5, 0x85, 
+0x2A, 0x9D, 0x92, 0x89, 0xD0, 0x94, 0x12, 0xBE, 
+0xD5, 0x64, 0xF5, 0x1A, 0xDB, 0xEC, 0xBD, 0x90, 
+0xAE, 
+
+0x74, 0x1F, 0xE5, 0x48, 0xD5, 0x61, 0x8D, 0x7F, 
+0xA5, 0x78, 0x6E, 0xF0, 0xE4, 0x82, 0x01, 0x85, 
+0xBE, 0xA7, 0xCF, 0x9B, 0x7D, 0x01, 0x99, 0xAE, 
+0xFF, 0x9A, 0x2E, 0xF6, 0xDE, 0x69, 0x35, 0xB1, 
+0x65, 0xE4, 
+
+0x42, 0xAB, 0x82, 0xD0, 0x48, 0xC9, 0xC0, 0xBD, 
+0x8B, 0xB0, 0x42, 0xAF, 0x38, 0x2C, 0xB7, 0x0D, 
+0x38, 0x46, 0x6B, 0xD1, 0xB6, 0xEF, 0x24, 0xB9, 
+0x1E, 0xB5, 0xF3, 0xD9, 0xA9, 0xF3, 0x0B, 0x7A, 
+0xC1, 0x79, 0x24, 
+
+0x45, 0x12, 0x9A, 0x36, 0x13, 0x9C, 0xAC, 0xA6, 
+0xA8, 0x3D, 0xCE, 0x82, 0x80, 0x7E, 0x1B, 0x21, 
+0x65, 0x59, 0x4D, 0x1D, 0xC7, 0x39, 0xE1, 0x8B, 
+0xC4, 0x2D, 0x52, 0x36, 0xB5, 0xD8, 0x33, 0x0E, 
+0x69, 0xBB, 0x61, 0x97, 
+
+0x00, 0xEC, 0x6B, 0xC9, 0xCF, 0x02, 0x7E, 0x46, 
+0xA7, 0x48, 0x89, 0xB8, 0xD6, 0x26, 0x58, 0x08, 
+0x12, 0xB7, 0x93, 0x8F, 0xA9, 0x80, 0xA3, 0xC9, 
+0x6C, 0x6E, 0xDF, 0x0F, 0x88, 0xA8, 0x93, 0x7B, 
+0xB7, 0xE5, 0x26, 0x22, 0xA0, 
+
+0xF4, 0xBE, 0xC4, 0xAB, 0x1A, 0x6A, 0x40, 0xA8, 
+0xDB, 0x76, 0xB9, 0x2F, 0x3F, 0xA8, 0xC2, 0x7B, 
+0xE1, 0xE4, 0x50, 0x76, 0xFA, 0xD2, 0x4F, 0xBB, 
+0xC1, 0x4D, 0x87, 0x76, 0x93, 0x2B, 0x28, 0xF4, 
+0xC9, 0x36, 0x78, 0x76, 0x6E, 0xF5, 
+
+0x4B, 0x4D, 0x0B, 0xE9, 0x4D, 0xC8, 0x48, 0x1B, 
+0xC2, 0xB5, 0xEE, 0x68, 0x0E, 0x00, 0x61, 0xB4, 
+0x02, 0x26, 0x56, 0xF3, 0xAC, 0xAC, 0xC9, 0x46, 
+0x82, 0x98, 0x10, 0x08, 0xE0, 0x3F, 0xC1, 0x1F, 
+0x79, 0x19, 0xF1, 0x03, 0x1A, 0x25, 0xC7, 
+
+0x48, 0x5C, 0x69, 0x68, 0x76, 0x55, 0x5C, 0x83, 
+0xCC, 0x79, 0xF5, 0x8C, 0x3C, 0xA8, 0x70, 0x34, 
+0x9A, 0x81, 0x09, 0x90, 0x7C, 0x5F, 0x2C, 0x4F, 
+0x03, 0xA1, 0xD0, 0x60, 0xA5, 0xC3, 0x45, 0xBE, 
+0x72, 0x45, 0x1D, 0x2F, 0x88, 0x97, 0x1D, 0xC9, 
+
+0x02, 0x8E, 0xCC, 0x8D, 0x7D, 0xBC, 0x82, 0xE2, 
+0xFD, 0x3F, 0x3D, 0xB0, 0x9F, 0xC7, 0x79, 0x3B, 
+0xEE, 0x8B, 0x39, 0x79, 0xA1, 0xE0, 0x2D, 0x4D, 
+0x34, 0x31, 0x3A, 0xB9, 0xC6, 0x24, 0xD5, 0x0C, 
+0x09, 0x64, 0x07, 0x9F, 0x78, 0x0E, 0xE5, 0x2B, 
+0x15, 
+
+0x4F, 0xED, 0x48, 0xDB, 0xE3, 0x48, 0x69, 0xF9, 
+0x93, 0xF5, 0x97, 0x33, 0x38, 0x99, 0x61, 0xFC, 
+0xCF, 0x18, 0x11, 0x9C, 0xA0, 0x21, 0x7D, 0xA1, 
+0x4D, 0x07, 0xDD, 0x03, 0xD4, 0x54, 0xEB, 0x3C, 
+0xCE, 0x83, 0xF1, 0xE5, 0x2E, 0x0A, 0xA3, 0x6B, 
+0xB7, 0x55, 
+
+0xD7, 0x2A, 0x5F, 0xA4, 0xC9, 0x04, 0x2C, 0xC8, 
+0xF1, 0x93, 0x9D, 0xDC, 0x35, 0x94, 0x45, 0x7B, 
+0x4F, 0x0B, 0x25, 0x4B, 0x85, 0x41, 0xD1, 0xA8, 
+0xB8, 0x9C, 0x80, 0xE1, 0x24, 0xC2, 0x0F, 0xDC, 
+0xAA, 0xCF, 0x69, 0x06, 0xC4, 0x77, 0xD1, 0xD0, 
+0xA0, 0x0F, 0x9C, 
+
+0x15, 0x63, 0x80, 0xE9, 0x83, 0x0E, 0x05, 0x53, 
+0x4A, 0x9E, 0xE5, 0x17, 0x9E, 0x04, 0x49, 0x1A, 
+0x67, 0xD5, 0x76, 0x44, 0xE7, 0xDB, 0xBA, 0x8D, 
+0x13, 0xEA, 0x74, 0xD5, 0x64, 0x99, 0x50, 0x56, 
+0x68, 0x85, 0x1D, 0x80, 0xC6, 0xC7, 0x4B, 0xC8, 
+0xE8, 0x1E, 0x0D, 0x44, 
+
+0x33, 0x8D, 0x1D, 0x30, 0x0C, 0xE1, 0xF9, 0xED, 
+0x5E, 0xEA, 0x2E, 0xDB, 0xB1, 0x73, 0xE4, 0xB5, 
+0x83, 0xF3, 0x6A, 0xB8, 0xC3, 0x73, 0x9F, 0xCF, 
+0x14, 0x59, 0xAB, 0x39, 0x3F, 0x14, 0x91, 0x28, 
+0x01, 0xCE, 0x59, 0xE8, 0x3C, 0x39, 0xEE, 0xB9, 
+0x07, 0x2D, 0x2E, 0xF2, 0x62, 
+
+0x1B, 0x9F, 0x50, 0x41, 0x05, 0x87, 0x26, 0xC6, 
+0x83, 0x14, 0x72, 0x59, 0xD4, 0xCB, 0xAA, 0x5A, 
+0x90, 0x15, 0x20, 0xF6, 0xC9, 0xE0, 0x4A, 0x83, 
+0x6A, 0x06, 0x28, 0x6F, 0x82, 0xFA, 0x7E, 0x8F, 
+0x4B, 0x75, 0x87, 0xF4, 0xCD, 0x58, 0xB8, 0x45, 
+0x9C, 0xBC, 0xDD, 0x08, 0xAB, 0x06, 
+
+0x74, 0xF5, 0x4E, 0x8D, 0xF2, 0xEA, 0xAE, 0x0E, 
+0xA7, 0x9E, 0x53, 0x12, 0x63, 0x5E, 0x5E, 0x77, 
+0xD4, 0xF8, 0x6A, 0x62, 0x24, 0xFD, 0x80, 0x1C, 
+0x9E, 0xE5, 0x49, 0x12, 0x80, 0xBD, 0x78, 0xA7, 
+0x1D, 0xF9, 0x03, 0xCA, 0x5D, 0x2C, 0x4F, 0x6D, 
+0x4F, 0x5D, 0xF3, 0x31, 0x7D, 0x2B, 0x12, 
+
+0x70, 0xCE, 0x62, 0x30, 0xC8, 0x2B, 0xAD, 0x63, 
+0x59, 0xE5, 0xFE, 0xE9, 0x14, 0xA8, 0x36, 0xD5, 
+0xAC, 0x21, 0x3D, 0xB1, 0x40, 0x14, 0xD0, 0x3E, 
+0x86, 0x9B, 0xB7, 0xD0, 0x23, 0xA8, 0x99, 0xFA, 
+0xBC, 0xE4, 0x67, 0xD9, 0x7C, 0x58, 0x18, 0x1D, 
+0x9F, 0x50, 0x8D, 0xE6, 0xAB, 0xA7, 0x45, 0xF9, 
+
+0xF4, 0x84, 0x58, 0x0E, 0xB4, 0x62, 0x34, 0xFB, 
+0x0B, 0xDC, 0xD8, 0xE5, 0xB5, 0x46, 0xE1, 0xE2, 
+0xE2, 0x76, 0xFD, 0x55, 0x49, 0x84, 0x8E, 0x07, 
+0x91, 0xD5, 0xD2, 0x72, 0x8C, 0x1B, 0x23, 0x51, 
+0x9F, 0x6E, 0x2C, 0x27, 0x60, 0x40, 0xD4, 0x82, 
+0x08, 0x35, 0xEA, 0x86, 0x90, 0xA2, 0xC4, 0x64, 
+0x0A, 
+
+0x3B, 0xEE, 0x87, 0xEC, 0x27, 0x55, 0xE1, 0x76, 
+0x51, 0xE0, 0xE4, 0x08, 0xDF, 0x64, 0x58, 0x3F, 
+0x21, 0x87, 0x72, 0x7C, 0x55, 0x10, 0x67, 0x80, 
+0xA2, 0x91, 0x5A, 0xB1, 0x19, 0x45, 0xE1, 0x20, 
+0x95, 0x29, 0x10, 0x94, 0x68, 0xEE, 0xCD, 0x7C, 
+0xE8, 0x8D, 0xEF, 0x91, 0x34, 0xB2, 0x2D, 0xED, 
+0x00, 0x09, 
+
+0xE2, 0x74, 0x2E, 0xA6, 0x3A, 0x94, 0xAA, 0xE5, 
+0x14, 0x46, 0x4E, 0xC6, 0x53, 0xE7, 0xA6, 0xB1, 
+0xA8, 0x91, 0x13, 0x9C, 0xAC, 0x2A, 0x8F, 0x6C, 
+0x62, 0x9C, 0x57, 0x17, 0xEE, 0x18, 0xD3, 0x8F, 
+0x28, 0x25, 0xE7, 0xE3, 0xC8, 0x4E, 0xA4, 0x92, 
+0xF0, 0xAB, 0xA8, 0x5C, 0xB2, 0x8B, 0x8A, 0x6B, 
+0x63, 0xE9, 0x74, 
+
+0x8A, 0xF8, 0x5E, 0xE5, 0x65, 0x04, 0x22, 0x75, 
+0xEA, 0xC3, 0x9E, 0xB1, 0xB2, 0x8F, 0x25, 0x03, 
+0x65, 0x3C, 0x5A, 0xE0, 0xE5, 0xCE, 0xD6, 0x88, 
+0x1C, 0x4C, 0x3F, 0xE6, 0x72, 0x8B, 0x7E, 0x30, 
+0x0B, 0xFD, 0xC0, 0xD3, 0xDF, 0x6E, 0x0A, 0x73, 
+0xE5, 0x65, 0x6B, 0xC6, 0x56, 0xF1, 0xF2, 0x94, 
+0x8B, 0xBC, 0x0A, 0x73, 
+
+0x50, 0xD7, 0x7C, 0x17, 0x23, 0xD2, 0x45, 0xE4, 
+0x0F, 0xBE, 0xB7, 0x6A, 0x4F, 0x5B, 0x2B, 0x38, 
+0x5D, 0x96, 0x80, 0x10, 0xAC, 0x4D, 0x81, 0x45, 
+0xFB, 0xBA, 0x26, 0x85, 0x56, 0x62, 0xD7, 0x5A, 
+0x3A, 0x1E, 0x09, 0x8B, 0xFB, 0x79, 0xCA, 0x3B, 
+0x18, 0xC3, 0x29, 0x9D, 0x4A, 0xAD, 0xDB, 0xF4, 
+0xB9, 0x11, 0x62, 0x56, 0xAA, 
+
+0x97, 0xFB, 0xEC, 0x4C, 0x7A, 0x2F, 0xFC, 0x22, 
+0x0E, 0xCD, 0x1B, 0x08, 0x4B, 0xA8, 0xBE, 0x34, 
+0x17, 0xB2, 0xC2, 0x7F, 0xC3, 0x3E, 0x9A, 0xC0, 
+0x40, 0x9A, 0xF8, 0x70, 0xEA, 0xFF, 0x6E, 0x86, 
+0xDB, 0x85, 0x1E, 0x9C, 0xE8, 0xC8, 0xEC, 0x24, 
+0xA5, 0x8A, 0xC1, 0x14, 0x88, 0x4B, 0x5A, 0x39, 
+0x2F, 0xEA, 0xCE, 0x09, 0x60, 0x3A, 
+
+0x42, 0xBB, 0xFC, 0x21, 0x44, 0x58, 0x38, 0x86, 
+0x36, 0x02, 0x78, 0x8A, 0xE4, 0xC6, 0xF8, 0x52, 
+0x81, 0x85, 0xEA, 0x77, 0x6B, 0xB7, 0x41, 0xBD, 
+0xC1, 0x83, 0xE2, 0x80, 0x66, 0xDA, 0x60, 0x97, 
+0x99, 0x6D, 0x40, 0x83, 0x73, 0xC8, 0xFB, 0x7C, 
+0xEA, 0xC2, 0xD0, 0xF8, 0xE4, 0x03, 0x41, 0xDB, 
+0x00, 0xAE, 0xFA, 0xBA, 0x80, 0x3D, 0xBC, 
+
+0x27, 0x0C, 0xD2, 0x21, 0x41, 0x12, 0x90, 0x93, 
+0x41, 0x5C, 0x29, 0x1B, 0x4E, 0x1F, 0xF3, 0x84, 
+0x1A, 0x32, 0xB3, 0xC1, 0xBD, 0x9A, 0x28, 0x60, 
+0x53, 0x27, 0xAA, 0xB5, 0xE1, 0x21, 0xCA, 0x36, 
+0xA7, 0xCE, 0xFA, 0x0A, 0xA6, 0xA7, 0x61, 0x6A, 
+0x8B, 0x90, 0xAD, 0x8E, 0x99, 0xFB, 0x2D, 0x20, 
+0xEB, 0x8E, 0x6A, 0xA1, 0x77, 0x23, 0x05, 0x58, 
+
+0x61, 0xFF, 0xA8, 0xA1, 0x20, 0xC7, 0xAA, 0x52, 
+0xB4, 0x66, 0x15, 0x29, 0xF1, 0x9C, 0x40, 0x67, 
+0x7F, 0xD9, 0xC5, 0x31, 0xC2, 0xC9, 0x1B, 0x65, 
+0x6A, 0x5B, 0x6F, 0x55, 0x1E, 0x2B, 0xE8, 0xB5, 
+0xBA, 0x05, 0xC7, 0x75, 0x4A, 0x49, 0x75, 0x51, 
+0xD0, 0xC8, 0x49, 0xD1, 0x45, 0xF1, 0x74, 0xB9, 
+0xE2, 0x8F, 0x90, 0x66, 0x5B, 0x43, 0xE5, 0x23, 
+0xD4, 
+
+0x67, 0xA3, 0x8D, 0x60, 0x21, 0x4E, 0xCA, 0xEB, 
+0x45, 0x11, 0x9D, 0x33, 0x91, 0xDE, 0xE5, 0x81, 
+0xC1, 0x86, 0x3C, 0xCC, 0x7E, 0x9E, 0x5D, 0xE9, 
+0x20, 0xCC, 0xA5, 0x57, 0xFA, 0x31, 0x3E, 0x04, 
+0x6C, 0x34, 0x9B, 0x4F, 0x26, 0xBD, 0x03, 0x8F, 
+0xB5, 0xF8, 0x8D, 0x50, 0x3A, 0xD9, 0x55, 0x5A, 
+0xF1, 0x94, 0x79, 0x0F, 0x88, 0x2E, 0x8C, 0x9F, 
+0xE5, 0xBC, 
+
+0xCC, 0x04, 0x17, 0x12, 0xCE, 0xFE, 0x8C, 0xC1, 
+0x6B, 0xDD, 0xEB, 0x2F, 0x7E, 0xD1, 0x85, 0x70, 
+0x01, 0x90, 0xCA, 0x51, 0xA0, 0x4D, 0x22, 0x57, 
+0x02, 0xBF, 0x6F, 0x54, 0x9D, 0xDE, 0x0C, 0xC4, 
+0x46, 0xDF, 0x7D, 0x24, 0x93, 0xF6, 0xB3, 0x94, 
+0x0F, 0xE2, 0xD4, 0xD4, 0x58, 0xBB, 0x32, 0x16, 
+0xFA, 0xDD, 0x09, 0x2C, 0x70, 0x8A, 0xBB, 0x6C, 
+0x37, 0xF0, 0xF5, 
+
+0x43, 0xA0, 0xA4, 0x06, 0x2D, 0x8A, 0x6D, 0x1F, 
+0x6E, 0xC0, 0x82, 0x89, 0x3E, 0x56, 0xD3, 0x32, 
+0x87, 0x2B, 0x2E, 0x6A, 0x0D, 0x16, 0x28, 0x8A, 
+0x9E, 0xC9, 0x18, 0x52, 0x0A, 0x2F, 0xFA, 0xE9, 
+0x1D, 0x46, 0xC7, 0x0E, 0x9B, 0x68, 0x1E, 0x47, 
+0x9C, 0xB4, 0xC7, 0x57, 0x69, 0x58, 0x5C, 0x49, 
+0x9F, 0xCD, 0x73, 0x7C, 0xEB, 0x3C, 0xAB, 0x9F, 
+0x38, 0x25, 0x1A, 0x20, 
+
+0xAB, 0x90, 0xD9, 0xD6, 0x8A, 0x93, 0xCF, 0xDD, 
+0xE6, 0xE5, 0xEA, 0xB3, 0x7C, 0x9C, 0x22, 0x36, 
+0xA1, 0x87, 0x78, 0x3B, 0x53, 0xD2, 0xE6, 0xB7, 
+0x63, 0x97, 0x52, 0x32, 0xBC, 0xFA, 0x6B, 0x0B, 
+0x46, 0xBA, 0x9D, 0x1A, 0xBE, 0xEC, 0xD4, 0x97, 
+0x3B, 0x30, 0xAE, 0xD6, 0x9A, 0xB6, 0x93, 0x3E, 
+0xC7, 0x64, 0xAB, 0x9E, 0xD6, 0xAA, 0x4E, 0x8E, 
+0x55, 0xDC, 0xD7, 0x07, 0xC9, 
+
+0x65, 0x16, 0x20, 0x99, 0x66, 0x0B, 0x5E, 0x46, 
+0x03, 0x4F, 0x73, 0x24, 0xCA, 0x1E, 0xF7, 0xC3, 
+0x1D, 0xAD, 0xFB, 0x49, 0x08, 0xC0, 0x87, 0xBF, 
+0x38, 0x8E, 0x7E, 0x8E, 0xEE, 0x0D, 0x39, 0x4A, 
+0x2B, 0x3F, 0x32, 0x51, 0x6E, 0xEA, 0x62, 0x3D, 
+0x54, 0xC5, 0x80, 0x23, 0x02, 0x53, 0x9E, 0x40, 
+0xE1, 0xFA, 0x97, 0x06, 0x7D, 0xBB, 0xCB, 0x1B, 
+0xAD, 0x83, 0xB5, 0x79, 0xF0, 0xA3, 
+
+0x5F, 0xF1, 0x00, 0xCF, 0xAA, 0x80, 0xA6, 0xA0, 
+0x20, 0xB7, 0x81, 0xFC, 0x07, 0x94, 0x30, 0xA2, 
+0x28, 0xD5, 0x98, 0x7F, 0xF4, 0xDE, 0x87, 0x32, 
+0x44, 0xCC, 0xFE, 0x9B, 0xA4, 0xB1, 0xF4, 0x08, 
+0x89, 0xBC, 0xFE, 0x7E, 0x0F, 0xA5, 0x7E, 0x94, 
+0xD7, 0x10, 0xDE, 0x68, 0xCB, 0xDF, 0x0E, 0x96, 
+0x20, 0xF0, 0xB5, 0x38, 0x89, 0x73, 0x34, 0x27, 
+0x12, 0xC5, 0xC5, 0xB6, 0x9D, 0xA9, 0xD8, 
+
+0x3F, 0x85, 0x62, 0x86, 0xE0, 0x48, 0x95, 0x97, 
+0xF6, 0x13, 0x7A, 0xFA, 0x3D, 0xF7, 0x4E, 0xED, 
+0x09, 0x3B, 0xB1, 0xC5, 0x6A, 0xAA, 0x94, 0xA8, 
+0xC9, 0xC6, 0x44, 0xAC, 0x11, 0x71, 0x88, 0xF8, 
+0xB2, 0x06, 0x48, 0x43, 0xBD, 0x5A, 0xDD, 0x5B, 
+0xA1, 0xB2, 0xEA, 0xE1, 0xD5, 0x31, 0xC8, 0x9E, 
+0xFF, 0xDC, 0x44, 0x73, 0x6A, 0x35, 0x35, 0xBB, 
+0x8F, 0xA5, 0x81, 0xA1, 0x01, 0x23, 0x28, 0xEB, 
+
+0x64, 0xBA, 0x3B, 0x1C, 0xBE, 0x95, 0xA7, 0x96, 
+0x04, 0x93, 0xC3, 0x07, 0x49, 0xAF, 0x28, 0x10, 
+0xDE, 0x2A, 0xAB, 0xB4, 0x7C, 0xDF, 0xF6, 0x3E, 
+0xC5, 0xA7, 0x3C, 0xF1, 0x81, 0xA2, 0xC3, 0xBB, 
+0xDF, 0x31, 0x4C, 0x0E, 0xEC, 0xCC, 0x28, 0xD6, 
+0x71, 0x7E, 0xEF, 0xD0, 0x70, 0x77, 0xB1, 0x78, 
+0xAE, 0xD2, 0x7A, 0x75, 0x7B, 0x8E, 0x67, 0x9B, 
+0x45, 0x40, 0xF5, 0x96, 0x1A, 0x7E, 0x05, 0xD8, 
+0x16, 
+
+0x6E, 0xAC, 0xB2, 0xE0, 0x87, 0x67, 0x3A, 0x12, 
+0x1C, 0xF2, 0x6C, 0x88, 0x01, 0xA6, 0xFA, 0xE9, 
+0xB5, 0x5B, 0xB9, 0x6B, 0xEE, 0x43, 0x58, 0xB7, 
+0x59, 0x39, 0xEC, 0xF8, 0x0E, 0xB1, 0x20, 0x35, 
+0xEF, 0x1C, 0x8A, 0x99, 0xA8, 0x35, 0x9C, 0x21, 
+0xC9, 0x39, 0x2D, 0x59, 0xC0, 0x55, 0xE4, 0x7B, 
+0xB4, 0x00, 0x5B, 0xC4, 0x2F, 0x68, 0x82, 0xA8, 
+0x20, 0x71, 0xDF, 0xD5, 0x85, 0x3C, 0x33, 0xF9, 
+0xD7, 0xFA, 
+
+0x20, 0x1F, 0xA3, 0x86, 0x79, 0x41, 0x90, 0x7F, 
+0xB9, 0x19, 0xF9, 0x3B, 0xE6, 0x6C, 0x3A, 0xAE, 
+0x25, 0xC3, 0x49, 0x8A, 0x98, 0x6E, 0xC0, 0x43, 
+0x64, 0x0F, 0xEC, 0x8A, 0xBB, 0x69, 0xDE, 0x75, 
+0xD0, 0xD1, 0x13, 0x4F, 0x39, 0x1A, 0xE9, 0x66, 
+0xD3, 0x80, 0xEB, 0x25, 0x8F, 0x8A, 0xFF, 0x04, 
+0x38, 0xF4, 0x32, 0x7E, 0xCD, 0x95, 0x07, 0x4A, 
+0x1C, 0x9D, 0x97, 0x0C, 0x24, 0xDA, 0xF2, 0x65, 
+0x83, 0x21, 0xCE, 
+
+0xE4, 0x04, 0xB1, 0x91, 0xCD, 0xF2, 0x45, 0xC2, 
+0xDA, 0xF7, 0x05, 0x15, 0x79, 0x1D, 0x89, 0xC4, 
+0x03, 0xE2, 0xE1, 0x4F, 0x8A, 0x89, 0x4B, 0x31, 
+0x9D, 0xEE, 0x02, 0x81, 0x54, 0xE5, 0xA8, 0xA7, 
+0x5B, 0x9F, 0x48, 0xEC, 0x1B, 0x30, 0xB7, 0x07, 
+0x58, 0x12, 0x85, 0xFE, 0x4B, 0x8C, 0x89, 0x3C, 
+0x4D, 0x7B, 0xA5, 0x6D, 0x20, 0x89, 0x5C, 0x82, 
+0xAC, 0x1E, 0x0C, 0xFC, 0xE6, 0xBF, 0xCA, 0x87, 
+0x67, 0x4A, 0x7A, 0x1C, 
+
+0x31, 0x29, 0x03, 0xF0, 0xBB, 0x1D, 0xB0, 0x1B, 
+0x43, 0x56, 0xAD, 0x8C, 0xB5, 0xDD, 0x8B, 0x5B, 
+0xA3, 0xCB, 0x01, 0xC6, 0xB5, 0x01, 0x08, 0xE2, 
+0x5A, 0x46, 0x2F, 0x6F, 0x0A, 0xB5, 0xE9, 0x29, 
+0x4E, 0xD1, 0x0C, 0xA5, 0x15, 0x67, 0xAF, 0x22, 
+0x70, 0xBD, 0x36, 0xA7, 0xD7, 0xA3, 0xD4, 0xB5, 
+0x98, 0x5C, 0xAA, 0x6D, 0xF9, 0x63, 0x85, 0xCA, 
+0x60, 0x17, 0x8B, 0x94, 0x8B, 0x0A, 0xBE, 0x90, 
+0xA3, 0xDA, 0x91, 0xCC, 0x17, 
+
+0xB3, 0x3C, 0x80, 0xA9, 0xAF, 0xFF, 0x13, 0x39, 
+0x0C, 0x6B, 0x59, 0x93, 0x63, 0x19, 0xCD, 0xDB, 
+0xF0, 0x47, 0x0F, 0x6E, 0x6F, 0x3D, 0x71, 0xB0, 
+0xD5, 0x5D, 0xC0, 0x87, 0x6B, 0x34, 0x8C, 0xFA, 
+0xF9, 0xF8, 0xE4, 0xEB, 0xE3, 0x7A, 0xBB, 0x1B, 
+0x91, 0x76, 0x28, 0x58, 0x43, 0xAF, 0x62, 0xB1, 
+0x37, 0x07, 0xDE, 0x67, 0x0F, 0x8F, 0xA5, 0x20, 
+0xD4, 0x24, 0xF4, 0xB3, 0xE5, 0x27, 0x08, 0xE0, 
+0x40, 0x6E, 0x82, 0x90, 0x30, 0x46, 
+
+0x5E, 0xB6, 0xDE, 0xF5, 0xFC, 0x98, 0x39, 0x6F, 
+0x7D, 0xF8, 0xA3, 0x7A, 0x48, 0xF6, 0x35, 0x29, 
+0x6C, 0x5F, 0x3B, 0xD3, 0x64, 0xC3, 0x1D, 0x92, 
+0x7C, 0x4D, 0x94, 0x3C, 0x84, 0xCB, 0x39, 0x36, 
+0x42, 0x7B, 0x36, 0x8C, 0x5F, 0x16, 0x52, 0x0C, 
+0xD9, 0x79, 0x01, 0x1A, 0x2A, 0xBF, 0xA5, 0xFE, 
+0x3E, 0x66, 0x3A, 0x46, 0xFE, 0xCD, 0x49, 0x29, 
+0xCF, 0x26, 0x32, 0xB5, 0x38, 0xAF, 0xF3, 0x38, 
+0xB0, 0x00, 0x65, 0xF8, 0xA9, 0x00, 0x42, 
+
+0xEB, 0x60, 0x1E, 0x88, 0xA6, 0x9A, 0x2D, 0x29, 
+0xEF, 0x4B, 0x24, 0x3A, 0x16, 0x4C, 0xA7, 0xF3, 
+0xD9, 0xAC, 0x9B, 0x3F, 0xCB, 0x48, 0x9C, 0xC5, 
+0x10, 0x8F, 0xAB, 0x8A, 0x63, 0x31, 0xDC, 0xF5, 
+0xE8, 0xB6, 0x10, 0xC7, 0xE6, 0x53, 0xBD, 0x22, 
+0xE9, 0xC0, 0x95, 0xB2, 0x0D, 0x4B, 0x48, 0x87, 
+0x02, 0x8D, 0xCE, 0xC5, 0x80, 0x82, 0xA4, 0xF9, 
+0x53, 0x87, 0xBD, 0x90, 0xA3, 0x23, 0x97, 0xF8, 
+0x52, 0xF1, 0x88, 0xFA, 0x51, 0x6F, 0x3C, 0x5A, 
+
+0xAF, 0xDC, 0x41, 0x73, 0xC8, 0x99, 0xD4, 0x01, 
+0x79, 0xF1, 0xBD, 0x3D, 0x13, 0xA7, 0x5D, 0x0A, 
+0x88, 0xC9, 0x42, 0xBD, 0xCA, 0xE6, 0xDA, 0xAA, 
+0x67, 0x87, 0x72, 0xD0, 0xE6, 0x6D, 0xDD, 0xAB, 
+0xC1, 0x66, 0xC5, 0x93, 0x76, 0x21, 0x5C, 0xDE, 
+0xAF, 0x1E, 0x7E, 0xFF, 0x93, 0xCA, 0x28, 0x76, 
+0x13, 0x9D, 0xD2, 0xF1, 0xD9, 0x6B, 0xF1, 0xB3, 
+0x5A, 0xD7, 0x70, 0x32, 0x5F, 0xD9, 0x55, 0xE5, 
+0x14, 0xDE, 0x49, 0xA4, 0x1C, 0x21, 0xD4, 0xD0, 
+0x0D, 
+
+0xF8, 0xF6, 0x4E, 0x4C, 0x24, 0x7D, 0xD6, 0x73, 
+0x21, 0x48, 0x56, 0xBF, 0xF0, 0xC6, 0xF6, 0x96, 
+0xD8, 0x59, 0xB7, 0x9B, 0x36, 0x53, 0x51, 0xCE, 
+0xAC, 0x36, 0x49, 0x4D, 0xB4, 0xB1, 0x43, 0x6D, 
+0x8D, 0x4F, 0x75, 0x19, 0x4E, 0xD7, 0xF4, 0xBC, 
+0x06, 0x13, 0xE4, 0xCB, 0x51, 0x58, 0xAC, 0x2F, 
+0x51, 0x9B, 0xC7, 0x81, 0xA9, 0xFD, 0x87, 0xED, 
+0x93, 0x4E, 0xAB, 0x6D, 0xC1, 0x7B, 0xAA, 0x6F, 
+0x4A, 0x4D, 0x0F, 0xB5, 0xAC, 0x44, 0x61, 0xDC, 
+0xD1, 0x67, 
+
+0xE3, 0x49, 0x44, 0xA1, 0x9C, 0x76, 0x70, 0x73, 
+0x80, 0x39, 0x1C, 0x89, 0x66, 0x7E, 0x02, 0x8C, 
+0x7B, 0x89, 0x02, 0xB7, 0x97, 0xE4, 0xD7, 0xF8, 
+0xD5, 0x82, 0x7C, 0x5B, 0x7F, 0x85, 0x4C, 0x9A, 
+0x7F, 0x23, 0xB8, 0x17, 0x72, 0xBD, 0xE2, 0x81, 
+0x11, 0xB0, 0xE5, 0xCF, 0x9A, 0xF2, 0x2F, 0x54, 
+0xC3, 0xF1, 0xDE, 0x40, 0x86, 0xA0, 0xCC, 0x56, 
+0x30, 0x81, 0x84, 0xAB, 0x43, 0x67, 0xF0, 0x28, 
+0xE4, 0xC4, 0x91, 0x2E, 0x40, 0x62, 0xDB, 0x85, 
+0x96, 0xB4, 0xBB, 
+
+0x51, 0x6A, 0xA7, 0xE5, 0xD8, 0xB6, 0x69, 0xBF, 
+0xE5, 0x85, 0x5C, 0xC6, 0xFC, 0xA5, 0xB6, 0xB5, 
+0xD6, 0xF4, 0xDC, 0xF2, 0x88, 0x57, 0x3F, 0x95, 
+0xF2, 0x3E, 0x37, 0x45, 0xF9, 0x4B, 0x9D, 0x31, 
+0xF4, 0xA7, 0x9C, 0x7C, 0x75, 0xF9, 0xC8, 0xFE, 
+0x91, 0x61, 0x9B, 0xA5, 0xBD, 0x5A, 0x33, 0x40, 
+0x62, 0xB7, 0x16, 0x19, 0xC1, 0xC8, 0xFB, 0xD1, 
+0xBC, 0xFB, 0x6B, 0x67, 0x91, 0x8F, 0x6D, 0x8E, 
+0xEA, 0x26, 0x7F, 0x8D, 0xAE, 0x81, 0x14, 0x48, 
+0x51, 0x16, 0xAA, 0x4B, 
+
+0x8A, 0x52, 0xBC, 0x5C, 0xEF, 0xD6, 0xF5, 0x96, 
+0xFF, 0x39, 0x7F, 0x3D, 0x98, 0x29, 0x1C, 0x41, 
+0xF1, 0xDC, 0x3A, 0x91, 0x9D, 0x8C, 0xA9, 0x54, 
+0x7F, 0xAA, 0xCB, 0x6D, 0x46, 0x41, 0x5D, 0x01, 
+0xBD, 0x43, 0x93, 0x6D, 0x89, 0x60, 0xB4, 0x7F, 
+0xA1, 0xE7, 0x67, 0x96, 0x21, 0x69, 0x11, 0x2F, 
+0x45, 0x67, 0xBD, 0xCF, 0x21, 0xB3, 0x15, 0xEC, 
+0xCC, 0xF3, 0xA0, 0x8E, 0xF1, 0xFF, 0x2D, 0x08, 
+0xBD, 0x71, 0x26, 0x1B, 0xC7, 0x0E, 0x9D, 0x1D, 
+0x94, 0x3E, 0x42, 0x88, 0x59, 
+
+0xD1, 0xB5, 0x05, 0x9E, 0x91, 0xB6, 0x93, 0xD1, 
+0x66, 0x6F, 0x36, 0xCF, 0xE5, 0xFD, 0xE8, 0x1D, 
+0x56, 0x0F, 0x2A, 0x05, 0x56, 0x25, 0x41, 0x90, 
+0xA2, 0xA8, 0xBE, 0x8A, 0x9B, 0xB5, 0x6A, 0x22, 
+0x30, 0xCE, 0x92, 0x42, 0x73, 0x32, 0x82, 0x05, 
+0xC0, 0x6A, 0xD9, 0x81, 0x5A, 0xD3, 0x2A, 0xA9, 
+0xC7, 0x2C, 0x27, 0xA3, 0x79, 0x38, 0xA8, 0x23, 
+0x30, 0xA0, 0x77, 0x2B, 0x70, 0x53, 0xB2, 0x0A, 
+0xAB, 0x82, 0xAB, 0x7A, 0x02, 0x4D, 0x32, 0x49, 
+0xD5, 0x5D, 0x01, 0xBC, 0x35, 0xE8, 
+
+0x72, 0x27, 0xD0, 0xC9, 0x0B, 0x9C, 0xF0, 0x0F, 
+0xEF, 0x34, 0xD9, 0xB9, 0xA6, 0x3B, 0x40, 0xEA, 
+0xFD, 0x8F, 0x7E, 0x83, 0x19, 0xE8, 0x3E, 0xBA, 
+0xC8, 0xEC, 0xB3, 0xB1, 0xE3, 0xE2, 0xF9, 0x5B, 
+0xAD, 0x5D, 0x6F, 0x81, 0x98, 0x47, 0x71, 0xB6, 
+0xF7, 0xBF, 0xF3, 0x6A, 0x1C, 0xBC, 0x66, 0xC2, 
+0x3B, 0xAF, 0x87, 0x68, 0xC7, 0x39, 0x4D, 0xC5, 
+0x06, 0xDD, 0x02, 0x25, 0xAB, 0xD1, 0x3B, 0xC2, 
+0x46, 0x08, 0xAD, 0x8C, 0xE2, 0x8A, 0x92, 0x6C, 
+0x9E, 0x47, 0x2C, 0x88, 0xE7, 0xC4, 0x01, 
+
+0xFF, 0x9D, 0x3C, 0x90, 0x1D, 0x74, 0x1A, 0xAC, 
+0xE8, 0x88, 0x3E, 0x74, 0x3E, 0xB8, 0xD9, 0xA9, 
+0xF5, 0x31, 0xD4, 0x1D, 0x2F, 0xEE, 0x20, 0x1F, 
+0x45, 0xA7, 0x5F, 0x0B, 0xD0, 0xED, 0x22, 0x69, 
+0x57, 0xC1, 0xC7, 0x07, 0xEC, 0x80, 0x46, 0x95, 
+0x72, 0x23, 0x88, 0xBC, 0x03, 0x01, 0xE2, 0xB1, 
+0x35, 0xF2, 0x94, 0x68, 0xDD, 0x8D, 0x62, 0x10, 
+0x3A, 0x12, 0x33, 0xAD, 0xFD, 0xEA, 0x2C, 0x5A, 
+0x17, 0xC8, 0xAE, 0x19, 0xB3, 0xBA, 0x14, 0xFA, 
+0x86, 0xC9, 0xED, 0x22, 0xEE, 0xA0, 0x6D, 0xB2, 
+
+0xA1, 0x7A, 0xBA, 0x19, 0xAF, 0x9B, 0x3F, 0x0F, 
+0xC7, 0xAD, 0x22, 0xC9, 0x19, 0x8A, 0x3E, 0x87, 
+0xD3, 0x74, 0x3B, 0xBC, 0x98, 0x58, 0x90, 0x25, 
+0x46, 0xBC, 0x02, 0x6F, 0xD8, 0x8C, 0x82, 0x04, 
+0x41, 0xCD, 0x10, 0xE3, 0x55, 0xF6, 0x36, 0xEA, 
+0x70, 0x7D, 0x2C, 0x88, 0x17, 0xE8, 0x1B, 0x32, 
+0x69, 0x2D, 0xC6, 0x65, 0x2F, 0xFE, 0x86, 0x29, 
+0x10, 0x8F, 0x35, 0x53, 0xA5, 0xB8, 0x09, 0x42, 
+0xB9, 0xEA, 0x4C, 0x8A, 0x8E, 0x90, 0x53, 0x5C, 
+0xA0, 0x76, 0xCE, 0x27, 0x21, 0xF9, 0x76, 0x78, 
+0x2F, 
+
+0x03, 0x25, 0x79, 0xF5, 0x85, 0xAB, 0xE0, 0xA6, 
+0xD1, 0x57, 0x87, 0x8F, 0x13, 0x64, 0xDF, 0x8E, 
+0x14, 0x9D, 0x85, 0xE4, 0xBF, 0xD0, 0x99, 0x8A, 
+0xA7, 0x6A, 0xA4, 0xFD, 0xDE, 0x8F, 0x71, 0x31, 
+0x63, 0x1C, 0xAF, 0x6C, 0xAE, 0xF0, 0xDD, 0xC3, 
+0xE7, 0x37, 0xB0, 0xA4, 0xBF, 0x0B, 0x44, 0x7C, 
+0x17, 0xBE, 0x6B, 0xD7, 0x19, 0x76, 0x46, 0xE0, 
+0xAD, 0x6D, 0x73, 0x10, 0xFA, 0xE2, 0x0B, 0x0D, 
+0xFE, 0x1F, 0x9E, 0x96, 0xB1, 0x5B, 0x8B, 0x95, 
+0xE3, 0x3B, 0x39, 0xB1, 0x11, 0x0C, 0xF6, 0x02, 
+0x69, 0x06, 
+
+0xB7, 0x7B, 0x77, 0xE2, 0xAF, 0x5B, 0x7C, 0xEE, 
+0xB2, 0x55, 0x1D, 0xEB, 0xA1, 0x2C, 0xE5, 0xFC, 
+0xA3, 0x0F, 0x9C, 0x74, 0x52, 0x4B, 0x5A, 0x22, 
+0xAB, 0xD6, 0xB1, 0x5E, 0x62, 0x69, 0x64, 0xFF, 
+0xE5, 0xBA, 0x62, 0xF5, 0xE1, 0xF3, 0x5E, 0x62, 
+0x31, 0x8D, 0x45, 0x75, 0x56, 0xDB, 0xFD, 0x32, 
+0xC0, 0x79, 0x77, 0x96, 0x3A, 0x5F, 0xDF, 0x25, 
+0x1F, 0x28, 0x83, 0xEA, 0x86, 0x76, 0xBC, 0x2B, 
+0xDB, 0xB5, 0x15, 0xD3, 0x91, 0x2F, 0xAD, 0x83, 
+0x52, 0x9B, 0x36, 0x12, 0xF0, 0x6C, 0x8C, 0xC4, 
+0x60, 0x7F, 0xD6, 
+
+0x25, 0x92, 0x82, 0x9C, 0x3B, 0x81, 0x70, 0x10, 
+0x1D, 0x18, 0x5B, 0x3B, 0x36, 0x0C, 0xCE, 0x4C, 
+0xF0, 0x62, 0xE3, 0x20, 0x94, 0x80, 0x48, 0x06, 
+0x6B, 0xA9, 0x42, 0x49, 0x99, 0x46, 0x74, 0x13, 
+0xF6, 0x67, 0x17, 0x38, 0xCD, 0x32, 0x6D, 0x94, 
+0x89, 0xFF, 0xA5, 0x25, 0x1A, 0x8F, 0xA0, 0x11, 
+0x28, 0x75, 0x56, 0x46, 0x8B, 0xAE, 0x99, 0xDD, 
+0x61, 0xDE, 0xF4, 0xDF, 0xD0, 0x11, 0x99, 0xC6, 
+0x97, 0xF3, 0xA3, 0xA4, 0xD6, 0x79, 0x40, 0x79, 
+0xD5, 0x86, 0x21, 0xC5, 0x60, 0x05, 0x28, 0x00, 
+0x50, 0xEE, 0x57, 0xA7, 
+
+0x3B, 0xB7, 0x50, 0xDE, 0x29, 0x70, 0xA2, 0xD9, 
+0x99, 0x8A, 0xE3, 0xA7, 0x27, 0xDE, 0x66, 0x7E, 
+0xB4, 0x8D, 0x93, 0x10, 0xDC, 0xEF, 0xC9, 0xAF, 
+0xFB, 0x1D, 0x1C, 0x41, 0xFA, 0xEB, 0xF9, 0x1C, 
+0x8C, 0x4C, 0xE6, 0x8B, 0x79, 0x7F, 0x24, 0x45, 
+0xA0, 0xED, 0xF7, 0x7A, 0x0D, 0x72, 0x0A, 0x70, 
+0x0D, 0x1C, 0x7A, 0x26, 0x6D, 0xB9, 0x49, 0xAE, 
+0xAE, 0xD9, 0x64, 0x85, 0xAC, 0x75, 0xE2, 0xAF, 
+0x8C, 0xE6, 0x55, 0xA9, 0x83, 0x84, 0xD2, 0xB9, 
+0xA1, 0x43, 0x4C, 0xA1, 0x4B, 0x70, 0x55, 0x49, 
+0xE3, 0x63, 0x0D, 0x0B, 0x2C, 
+
+0x83, 0x75, 0x19, 0x6A, 0x72, 0x70, 0xC6, 0xA9, 
+0x32, 0x28, 0xBB, 0x29, 0xC6, 0xF0, 0x13, 0x64, 
+0x91, 0x55, 0x3D, 0x59, 0xCD, 0xA3, 0xCE, 0x65, 
+0xDC, 0x14, 0xEE, 0xCC, 0x3B, 0x01, 0x75, 0x3A, 
+0x22, 0x08, 0x9D, 0x8C, 0x23, 0x57, 0xF6, 0xC1, 
+0xC0, 0x3B, 0x56, 0x23, 0xD0, 0xD6, 0x2C, 0xC9, 
+0x5C, 0xF0, 0x0A, 0x28, 0x9E, 0xEC, 0xF5, 0x1A, 
+0xD7, 0x9F, 0x8A, 0x8F, 0x2C, 0xAA, 0x38, 0x66, 
+0x98, 0xF2, 0x37, 0x27, 0xA9, 0xF0, 0x89, 0x9C, 
+0x0E, 0x02, 0x7A, 0xE3, 0x09, 0xA5, 0x12, 0x79, 
+0x7E, 0xCC, 0xDC, 0x18, 0xC9, 0x77, 
+
+0xF5, 0xD8, 0x77, 0xE1, 0x3E, 0x0B, 0x9A, 0x7C, 
+0x5D, 0x69, 0x1B, 0x1A, 0x28, 0xDB, 0x9B, 0x38, 
+0xCE, 0x61, 0xEF, 0xB5, 0xAE, 0x29, 0xFE, 0x01, 
+0x14, 0x3F, 0x28, 0x14, 0xE1, 0x45, 0x69, 0x52, 
+0x7F, 0xF6, 0x44, 0x40, 0x43, 0x86, 0xD1, 0xC1, 
+0xF1, 0x2A, 0x2E, 0xDF, 0x79, 0xF7, 0x59, 0x70, 
+0xCB, 0x3D, 0xA7, 0xDD, 0x37, 0x73, 0x13, 0x32, 
+0x5D, 0x3C, 0xB6, 0x00, 0x40, 0xED, 0x14, 0xA8, 
+0x34, 0x56, 0xA3, 0x6A, 0x5C, 0x8D, 0x28, 0xA3, 
+0x34, 0xA6, 0x57, 0x8E, 0x0D, 0x0F, 0x15, 0xEE, 
+0xE8, 0xA3, 0x86, 0x43, 0x69, 0x1B, 0xFD, 
+
+0x28, 0x7B, 0xE5, 0x0F, 0x01, 0x82, 0x80, 0x62, 
+0x1C, 0x12, 0x9E, 0x28, 0x20, 0xED, 0x5C, 0xD8, 
+0x0F, 0x37, 0x32, 0x02, 0x8B, 0xC1, 0xD1, 0x65, 
+0x73, 0x3B, 0x9A, 0x00, 0xD2, 0x99, 0xB5, 0xDA, 
+0x82, 0xAD, 0x61, 0xDE, 0x46, 0x8D, 0x84, 0xC1, 
+0xA2, 0xE5, 0x73, 0xFE, 0xE5, 0xE7, 0x9C, 0xDF, 
+0x80, 0x90, 0x14, 0x0A, 0x40, 0xA6, 0x66, 0xCB, 
+0xED, 0x65, 0xD9, 0x2E, 0xDD, 0x3E, 0x20, 0x69, 
+0x40, 0x26, 0x3A, 0xD9, 0xE0, 0xD8, 0x34, 0x3F, 
+0x05, 0x39, 0x2D, 0x38, 0x81, 0xC1, 0xD0, 0xD6, 
+0x3F, 0x2A, 0xE0, 0x2A, 0x59, 0x15, 0xF1, 0x9D, 
+
+0xEB, 0xEF, 0x04, 0xC8, 0xBF, 0x71, 0x06, 0x30, 
+0x62, 0x95, 0xB1, 0x6B, 0xD1, 0x97, 0xDA, 0x1F, 
+0x96, 0xB5, 0xAA, 0x8B, 0xA9, 0x24, 0x4B, 0x33, 
+0x6D, 0x56, 0x1E, 0xD3, 0x31, 0xC2, 0xE8, 0x56, 
+0x88, 0xD3, 0xC6, 0xAD, 0xC6, 0x4C, 0x75, 0x56, 
+0x6E, 0x40, 0x47, 0xE5, 0x3E, 0x1B, 0x30, 0x93, 
+0x42, 0xDA, 0xD3, 0x69, 0xD9, 0xF6, 0x76, 0x38, 
+0x2E, 0x25, 0x08, 0x5E, 0x4A, 0xBE, 0x23, 0x15, 
+0x70, 0x21, 0x70, 0xCC, 0x6C, 0x1F, 0x8A, 0x4E, 
+0x4E, 0x6F, 0x78, 0x1D, 0x9A, 0x52, 0x9C, 0x12, 
+0x16, 0x52, 0xEB, 0xE2, 0xAC, 0x37, 0x1A, 0x46, 
+0xA3, 
+
+0x5E, 0xD7, 0x63, 0x18, 0xC9, 0x78, 0x0F, 0xA4, 
+0x32, 0xAC, 0xB1, 0x96, 0x6B, 0x5E, 0x30, 0x65, 
+0x78, 0x5B, 0xF2, 0xA8, 0x4B, 0xB6, 0x16, 0x88, 
+0x19, 0x98, 0x55, 0x56, 0x39, 0x1F, 0x5C, 0x16, 
+0x38, 0x2A, 0xFC, 0x26, 0x60, 0x35, 0x49, 0xEA, 
+0xB5, 0xDF, 0xBE, 0xF0, 0xEB, 0x65, 0xF6, 0x2E, 
+0xC6, 0x9E, 0xE8, 0x97, 0xD6, 0x64, 0x7C, 0x55, 
+0x16, 0x66, 0xB8, 0xF4, 0x67, 0xEF, 0xF9, 0x26, 
+0x05, 0xA7, 0x26, 0x82, 0xE4, 0xD0, 0xAB, 0x59, 
+0x74, 0x12, 0x90, 0x7E, 0xC7, 0x10, 0x0D, 0xA2, 
+0x82, 0x06, 0x29, 0xE1, 0xEC, 0xD8, 0x99, 0xDB, 
+0xC1, 0x53, 
+
+0xC2, 0x4E, 0xEE, 0x7C, 0x6A, 0x6B, 0x38, 0x84, 
+0xB7, 0xDC, 0x61, 0xF4, 0x43, 0xB7, 0x11, 0x43, 
+0x80, 0x53, 0xD3, 0x30, 0x8A, 0x62, 0xE1, 0x6B, 
+0xD1, 0xB0, 0x62, 0x63, 0x5A, 0x0A, 0x65, 0xA6, 
+0x1C, 0xBE, 0x9F, 0x96, 0x0F, 0x9B, 0x20, 0x79, 
+0xB8, 0xE3, 0x0D, 0x51, 0x73, 0xF7, 0x95, 0xA9, 
+0xA9, 0xA2, 0xC0, 0x0D, 0x6C, 0xF6, 0x8A, 0x10, 
+0x43, 0x0F, 0x7A, 0x29, 0xFA, 0x79, 0xC7, 0xB5, 
+0x0D, 0xAE, 0xD4, 0x30, 0xAA, 0x2E, 0x2B, 0xF6, 
+0x07, 0x78, 0x77, 0xFC, 0x40, 0x26, 0x46, 0xFD, 
+0xDD, 0xD8, 0x02, 0xC0, 0x6B, 0x13, 0x59, 0xDE, 
+0x9F, 0xF1, 0xC4, 
+
+0xD3, 0xA4, 0x6B, 0x40, 0x62, 0xE6, 0xE8, 0xDC, 
+0xF4, 0x6F, 0x36, 0xD2, 0xA3, 0xDC, 0x24, 0x81, 
+0x3C, 0xE0, 0x7D, 0x4D, 0x56, 0x4F, 0xFB, 0xCB, 
+0xCA, 0xB8, 0xF9, 0xC1, 0x11, 0xC7, 0x7C, 0x2B, 
+0xCD, 0x15, 0xAC, 0x3D, 0xAB, 0x5A, 0x55, 0x6A, 
+0x0E, 0xFE, 0xCC, 0xDF, 0x84, 0xCB, 0xE7, 0x2E, 
+0x0A, 0xF7, 0xE3, 0x0E, 0x6B, 0x8E, 0x0A, 0xB1, 
+0xBC, 0x55, 0xA6, 0x7D, 0x6C, 0x35, 0xEF, 0xB9, 
+0xFF, 0x4E, 0x0F, 0x76, 0x21, 0xFD, 0x82, 0x96, 
+0x7E, 0x23, 0xB2, 0x4E, 0x34, 0x53, 0xDA, 0x2C, 
+0xC1, 0x0A, 0xE2, 0xC9, 0x04, 0x7F, 0xD2, 0x89, 
+0x36, 0xF5, 0xE4, 0x06, 
+
+0x5C, 0x9E, 0xF5, 0x15, 0x05, 0xC6, 0x6A, 0xB0, 
+0x72, 0x42, 0x22, 0x74, 0x52, 0x34, 0xEE, 0xF5, 
+0x9B, 0x0F, 0xE1, 0x17, 0x0C, 0xB2, 0x6C, 0xB3, 
+0xC1, 0x42, 0x61, 0x9D, 0xF6, 0xF3, 0x7C, 0x97, 
+0xC1, 0xE6, 0xF9, 0x44, 0x82, 0xE4, 0xC0, 0x7B, 
+0x18, 0xA4, 0xA9, 0x27, 0xD6, 0xFC, 0x19, 0x17, 
+0xF0, 0x13, 0x53, 0xEC, 0x3B, 0x43, 0xC4, 0x03, 
+0x36, 0xED, 0x24, 0xDE, 0x04, 0x83, 0x76, 0xEA, 
+0xF5, 0xD0, 0xB5, 0xF0, 0xF5, 0x93, 0x12, 0x6A, 
+0x5E, 0x83, 0x74, 0x5F, 0xCF, 0x3C, 0x71, 0xC7, 
+0x84, 0xEC, 0x83, 0xE4, 0x62, 0x2D, 0xD7, 0xAF, 
+0x4A, 0x31, 0x16, 0xBA, 0x83, 
+
+0x2F, 0xC1, 0x8A, 0xE6, 0xFB, 0x82, 0x36, 0x10, 
+0x36, 0xEA, 0x46, 0x31, 0x68, 0x76, 0x94, 0xAD, 
+0x22, 0x5A, 0x9E, 0x95, 0xFB, 0x6C, 0x4B, 0x3D, 
+0x67, 0x93, 0x58, 0xEB, 0x6C, 0xAD, 0x8F, 0x15, 
+0xC0, 0x80, 0x18, 0x7B, 0x79, 0x41, 0x3C, 0x7D, 
+0xA9, 0x30, 0x73, 0x26, 0x39, 0xB0, 0xAA, 0x01, 
+0x1A, 0x5E, 0xC7, 0xC5, 0x15, 0xEA, 0xCA, 0xA6, 
+0x17, 0xB9, 0xEB, 0xC4, 0x28, 0xC2, 0x06, 0x1D, 
+0x0F, 0xA5, 0x9F, 0xD5, 0x84, 0x57, 0x80, 0xE4, 
+0x2D, 0x42, 0x0E, 0x7F, 0xE0, 0x35, 0x98, 0xDB, 
+0xD5, 0x3B, 0x40, 0xC3, 0x8F, 0x25, 0xA4, 0x98, 
+0xDC, 0x64, 0x6F, 0xA1, 0xD7, 0x47, 
+
+0xDF, 0xF3, 0x7B, 0x35, 0x70, 0x15, 0xE9, 0x34, 
+0x11, 0x59, 0x98, 0x11, 0xEB, 0xA1, 0x95, 0x0E, 
+0x08, 0x3F, 0x76, 0x5C, 0x75, 0x64, 0x1B, 0x5B, 
+0x9E, 0xC1, 0xF4, 0xEB, 0x12, 0x2A, 0x0C, 0x6C, 
+0x13, 0x1D, 0x9D, 0xDF, 0x8C, 0xAE, 0x3C, 0xB6, 
+0x5A, 0x30, 0x95, 0x8F, 0x5C, 0x5A, 0x02, 0x32, 
+0x25, 0x22, 0xA8, 0x5F, 0x60, 0x2F, 0x51, 0xA3, 
+0x20, 0xB3, 0xD9, 0xB4, 0x64, 0x26, 0x5E, 0x92, 
+0x11, 0xEC, 0x93, 0x06, 0xE8, 0x3B, 0x66, 0x37, 
+0x80, 0xA4, 0x40, 0xB8, 0xF1, 0xB8, 0xF0, 0x27, 
+0x68, 0x50, 0x5C, 0x85, 0x32, 0xB2, 0x84, 0x78, 
+0x5F, 0xDF, 0xD7, 0xF4, 0xB0, 0x40, 0x56, 
+
+0xAA, 0x73, 0x39, 0xAB, 0x5E, 0x35, 0xB0, 0xD9, 
+0x90, 0x2A, 0x3F, 0x80, 0x59, 0x66, 0xC9, 0xCF, 
+0xF6, 0xBC, 0x67, 0x0F, 0x2B, 0x23, 0x3F, 0xAA, 
+0xC3, 0x31, 0x33, 0x3E, 0xB4, 0x7E, 0xDC, 0x34, 
+0x58, 0xF7, 0x3E, 0x37, 0xFE, 0x11, 0x64, 0x54, 
+0xE6, 0x02, 0x94, 0xF4, 0xED, 0xD1, 0x77, 0xB0, 
+0xC3, 0xB0, 0x8D, 0x8A, 0xEA, 0x32, 0x1C, 0x64, 
+0xC3, 0x1B, 0xAC, 0x75, 0x81, 0x64, 0x69, 0x19, 
+0xB5, 0xBB, 0xAA, 0x2B, 0xC3, 0x05, 0x63, 0x55, 
+0xE0, 0x6E, 0x6D, 0xB8, 0xCD, 0x3A, 0x8E, 0x9F, 
+0xC1, 0x8B, 0x2D, 0x90, 0x53, 0x10, 0xB0, 0x30, 
+0xDD, 0x2F, 0x96, 0xA7, 0x56, 0x39, 0x36, 0x1C, 
+
+0xA9, 0x03, 0xA6, 0x3D, 0x80, 0xAC, 0x22, 0x91, 
+0xE0, 0xF4, 0x63, 0x75, 0x6B, 0xCF, 0xE7, 0x6D, 
+0x33, 0xFF, 0xC9, 0x0E, 0x55, 0x4D, 0x3E, 0x97, 
+0x9A, 0x2D, 0xB7, 0x92, 0x89, 0xA1, 0x51, 0xF5, 
+0xFE, 0x79, 0xF8, 0x06, 0xC4, 0xB1, 0xE1, 0x26, 
+0x8C, 0xC4, 0x62, 0x23, 0x81, 0x62, 0x4B, 0x80, 
+0x32, 0xBB, 0x96, 0x5E, 0xA8, 0x20, 0x52, 0x14, 
+0x43, 0xE7, 0xB5, 0xD0, 0x4F, 0x17, 0xEA, 0x65, 
+0xB2, 0x0F, 0xA4, 0x12, 0xAB, 0x62, 0x12, 0x82, 
+0x0F, 0x53, 0xC6, 0xDE, 0x3F, 0xC1, 0x04, 0x5D, 
+0xB1, 0x23, 0xAD, 0x28, 0x6B, 0x43, 0x9E, 0x28, 
+0xF6, 0x46, 0xB2, 0x2C, 0x77, 0x22, 0x6F, 0x49, 
+0x04, 
+
+0xAE, 0x36, 0xA9, 0xD9, 0x0C, 0x37, 0x90, 0xD9, 
+0x26, 0x41, 0x71, 0x97, 0xEB, 0x45, 0x72, 0xB7, 
+0x80, 0x28, 0x1E, 0x0A, 0xA4, 0x28, 0x03, 0x21, 
+0x2B, 0x06, 0x84, 0xCD, 0xC4, 0xD9, 0x68, 0x09, 
+0x89, 0x20, 0xCD, 0x32, 0xDA, 0x5D, 0xE1, 0x05, 
+0xA9, 0xC3, 0x80, 0x4D, 0xE3, 0x19, 0x25, 0xC2, 
+0x1A, 0x6C, 0x88, 0x3E, 0xE0, 0x4A, 0xB8, 0xFC, 
+0xF9, 0x90, 0x00, 0x24, 0xEE, 0x47, 0x12, 0x42, 
+0x96, 0x96, 0x24, 0x38, 0x50, 0x7A, 0xF6, 0xA8, 
+0xD3, 0xEA, 0x86, 0xC3, 0x30, 0xD4, 0x0F, 0x98, 
+0xE3, 0x59, 0x7B, 0x95, 0x5A, 0x11, 0x22, 0xAD, 
+0xC6, 0x9D, 0x27, 0x31, 0xE1, 0x74, 0x88, 0xD6, 
+0xD8, 0x35, 
+
+0xD9, 0xE3, 0x12, 0x78, 0x36, 0x0E, 0xD0, 0xF2, 
+0xE7, 0x40, 0x15, 0x19, 0xCF, 0xEB, 0xF9, 0x78, 
+0x82, 0x7F, 0xDD, 0x05, 0xCD, 0xC9, 0x13, 0x1D, 
+0xBB, 0x53, 0x5F, 0x13, 0x67, 0x32, 0x20, 0xB9, 
+0x80, 0x96, 0x88, 0xDD, 0x63, 0x98, 0x17, 0x7A, 
+0x10, 0x66, 0x17, 0x15, 0x34, 0x4D, 0xBE, 0x1B, 
+0xAA, 0x5C, 0x84, 0x73, 0x60, 0x2F, 0x2C, 0x5E, 
+0x5A, 0x76, 0xC8, 0xD1, 0xAA, 0x7F, 0x12, 0x62, 
+0xB7, 0xD2, 0xE7, 0xDE, 0x99, 0x79, 0xE3, 0x7C, 
+0x81, 0xE9, 0x3F, 0x5D, 0xDC, 0x0F, 0xA2, 0x01, 
+0x4B, 0xAA, 0xED, 0x41, 0x5F, 0xA1, 0x97, 0xAE, 
+0x59, 0x87, 0xAA, 0xCC, 0xBD, 0xD7, 0xB0, 0x61, 
+0x48, 0x48, 0x6C, 
+
+0xE2, 0x97, 0x1F, 0x6B, 0x44, 0x25, 0x72, 0x28, 
+0x0A, 0xE5, 0x73, 0x74, 0x73, 0xE7, 0x74, 0x12, 
+0xAE, 0x31, 0xBA, 0x71, 0x03, 0x45, 0x9E, 0xCE, 
+0xFC, 0x27, 0x6A, 0x95, 0xD6, 0x2C, 0xF1, 0xD2, 
+0x04, 0x0A, 0x88, 0xF1, 0xEE, 0xB2, 0x09, 0x91, 
+0xF7, 0x2D, 0x73, 0x28, 0x4C, 0xFC, 0x58, 0x9B, 
+0x0C, 0x16, 0xC9, 0x95, 0xAB, 0xE0, 0x8D, 0xC4, 
+0x56, 0x7E, 0x20, 0x99, 0xF8, 0xBA, 0x5E, 0xA3, 
+0x46, 0x88, 0x6D, 0x20, 0x11, 0x42, 0x3C, 0xCF, 
+0xB4, 0xB3, 0x0D, 0x4A, 0x8D, 0x54, 0x76, 0xED, 
+0x72, 0xBD, 0x01, 0x02, 0xEC, 0x7E, 0xA1, 0xCE, 
+0x9D, 0xFD, 0xDF, 0xBB, 0xC0, 0x7F, 0x8F, 0xE4, 
+0x4A, 0xD7, 0x3B, 0x92, 
+
+0xB4, 0xD5, 0xEA, 0xFE, 0xDC, 0x30, 0x74, 0x38, 
+0x67, 0x71, 0x39, 0x61, 0x67, 0x97, 0xBC, 0xAE, 
+0x28, 0xDC, 0xE7, 0xD5, 0xA6, 0x20, 0xAE, 0x5E, 
+0x4C, 0xDE, 0x19, 0xC9, 0x4A, 0xE2, 0x89, 0x61, 
+0xC3, 0x4A, 0xE3, 0x7A, 0x66, 0x67, 0xB6, 0x4C, 
+0xC5, 0xE5, 0x1C, 0x57, 0x9A, 0x33, 0xF5, 0x39, 
+0xD7, 0x78, 0x7C, 0x98, 0xFC, 0x4B, 0x23, 0x72, 
+0x34, 0x97, 0x2C, 0x51, 0x50, 0x1A, 0x51, 0x0A, 
+0x9D, 0xC9, 0x18, 0x14, 0x22, 0xF8, 0x85, 0x1D, 
+0xFD, 0x53, 0x69, 0xD1, 0xDD, 0x43, 0xFA, 0xC2, 
+0x6C, 0xE1, 0x89, 0x1F, 0x0F, 0xC9, 0x21, 0x67, 
+0xCA, 0x5E, 0x06, 0xA8, 0x58, 0xF4, 0x5A, 0x12, 
+0x53, 0x63, 0xBA, 0x32, 0xB7, 
+
+0x2B, 0x18, 0x2F, 0x3A, 0x1B, 0xAD, 0xB0, 0x65, 
+0x41, 0x67, 0xC9, 0xDF, 0x2F, 0xDA, 0x0E, 0xEF, 
+0xE2, 0xBF, 0x01, 0x32, 0xF6, 0xDB, 0xC1, 0x3F, 
+0x78, 0x31, 0x5E, 0xBC, 0x2A, 0x0B, 0xAB, 0x61, 
+0xC3, 0xED, 0x52, 0x55, 0x50, 0xB9, 0xFD, 0x34, 
+0xBD, 0x33, 0x07, 0x1E, 0x7D, 0x38, 0x6F, 0xE7, 
+0xC6, 0x45, 0xF8, 0x1B, 0x87, 0xF1, 0x9B, 0xAE, 
+0x93, 0x0C, 0xA7, 0x7B, 0x13, 0x1B, 0x8D, 0x71, 
+0xC7, 0x96, 0xBC, 0xA1, 0xE3, 0x37, 0x3B, 0xE3, 
+0xEA, 0x52, 0x0B, 0x25, 0x3D, 0x38, 0xED, 0xCA, 
+0x77, 0x19, 0xDE, 0x26, 0x43, 0x52, 0x23, 0x03, 
+0x9E, 0x31, 0xC5, 0xE9, 0x52, 0xEF, 0xE7, 0x71, 
+0x6E, 0xFB, 0x61, 0x8C, 0xCB, 0x3C, 
+
+0x93, 0xBE, 0xE1, 0xA3, 0xD9, 0x20, 0xD5, 0x57, 
+0x63, 0xDA, 0xF1, 0xFF, 0xCC, 0x0C, 0x8E, 0x99, 
+0xB9, 0xCE, 0x7D, 0xFE, 0x9E, 0xFA, 0x48, 0xF8, 
+0x73, 0xB5, 0xD7, 0xF1, 0xDD, 0xBC, 0x62, 0xB2, 
+0xBD, 0xA4, 0x58, 0x1A, 0x7D, 0x1A, 0xF0, 0xD5, 
+0x33, 0x78, 0x41, 0xC9, 0x93, 0x7A, 0xF1, 0xE8, 
+0x7E, 0x51, 0xD3, 0xFF, 0xF0, 0x5F, 0x77, 0x1F, 
+0xB4, 0x17, 0x2A, 0x24, 0x03, 0x04, 0xD5, 0x25, 
+0xB3, 0x91, 0xF1, 0xBE, 0xA8, 0x05, 0xE7, 0xE3, 
+0xB3, 0x62, 0x37, 0xA1, 0x1A, 0x24, 0x12, 0x65, 
+0x05, 0xE6, 0xF8, 0x93, 0xB8, 0xFB, 0xB5, 0x4E, 
+0xAB, 0xE2, 0x34, 0xA3, 0x77, 0x63, 0xEB, 0x8C, 
+0x67, 0x28, 0xA6, 0x98, 0x40, 0xD6, 0x94, 
+
+0x66, 0xCF, 0x95, 0x2D, 0x4E, 0x2F, 0x3D, 0x16, 
+0xCB, 0x1C, 0x63, 0xEC, 0xA0, 0x56, 0x45, 0xDD, 
+0x93, 0x97, 0x3F, 0xB8, 0x11, 0x5F, 0x46, 0x30, 
+0x95, 0xD4, 0x76, 0xDD, 0xF6, 0x0D, 0x72, 0x3E, 
+0x99, 0x11, 0x95, 0xB0, 0xBF, 0x2C, 0xBC, 0x08, 
+0x2C, 0xEF, 0x4F, 0xA1, 0xFC, 0xD2, 0xCE, 0xAB, 
+0x17, 0x61, 0x63, 0x21, 0xD1, 0x75, 0xC9, 0x26, 
+0x5A, 0xCE, 0x8C, 0x13, 0x0D, 0x16, 0x1D, 0x2F, 
+0xB0, 0x56, 0x7A, 0xCC, 0x3C, 0x34, 0x93, 0x5E, 
+0x49, 0x13, 0x2E, 0xE0, 0x15, 0xD6, 0xF4, 0x26, 
+0xA4, 0x20, 0xCC, 0x97, 0xCA, 0x41, 0x58, 0x03, 
+0x10, 0xFC, 0x64, 0x0A, 0x58, 0x52, 0x37, 0xAF, 
+0x41, 0x9E, 0xD5, 0x79, 0x82, 0x25, 0x48, 0x14, 
+
+0xA5, 0x19, 0x09, 0x43, 0x72, 0x38, 0x09, 0xBA, 
+0x65, 0x70, 0xAA, 0x55, 0xA3, 0xDF, 0xA0, 0xAC, 
+0x36, 0x5A, 0x81, 0x9B, 0xFE, 0xD5, 0x23, 0x92, 
+0xC3, 0x6D, 0x28, 0xAC, 0x2A, 0x79, 0x00, 0x53, 
+0x16, 0x58, 0xD2, 0x11, 0x72, 0xC9, 0xF0, 0x2F, 
+0x76, 0x05, 0x93, 0x63, 0xF4, 0xAF, 0xC4, 0x2B, 
+0x51, 0xA2, 0x1A, 0xBA, 0x05, 0xFC, 0x85, 0xE6, 
+0xCC, 0x87, 0x14, 0x39, 0xE8, 0xB2, 0x4B, 0xBD, 
+0xC1, 0x90, 0x13, 0x85, 0x6E, 0xD8, 0x88, 0x80, 
+0xAF, 0x35, 0x2C, 0x12, 0xF4, 0x4B, 0x14, 0x3C, 
+0xEB, 0xEC, 0xD4, 0xAF, 0xE3, 0xF8, 0xE8, 0x51, 
+0xFF, 0x33, 0x4E, 0x31, 0xD2, 0x40, 0x29, 0xFD, 
+0x4B, 0x89, 0xA3, 0x74, 0x57, 0xCB, 0x9E, 0x17, 
+0x8B, 
+
+0x9B, 0xDF, 0x5A, 0x9F, 0x9C, 0x50, 0xB1, 0xC2, 
+0xEB, 0xAD, 0x7E, 0xE8, 0xEA, 0xC8, 0x51, 0x8D, 
+0xF5, 0xB2, 0x5B, 0x66, 0x1D, 0x8E, 0xC7, 0xD3, 
+0xEF, 0x6A, 0x5B, 0x0B, 0x3C, 0x2C, 0x6A, 0xA7, 
+0xF8, 0xFE, 0x17, 0xF7, 0xC7, 0x82, 0x35, 0xA4, 
+0xFF, 0x47, 0x3F, 0x1E, 0x90, 0x2E, 0xA9, 0x80, 
+0x53, 0x11, 0xC9, 0x69, 0x6C, 0x70, 0x4E, 0xD1, 
+0xCD, 0x41, 0x1D, 0x77, 0xDC, 0x41, 0x29, 0x18, 
+0x72, 0x58, 0xBD, 0x9B, 0x67, 0x00, 0x87, 0x7E, 
+0x10, 0xA7, 0xD1, 0x9F, 0x27, 0x46, 0x6A, 0xFD, 
+0xE8, 0x77, 0xB2, 0xA7, 0x2D, 0xB2, 0xBA, 0x68, 
+0x24, 0x75, 0xC6, 0xD9, 0x43, 0x49, 0xF5, 0xA4, 
+0x09, 0xCA, 0x65, 0xC2, 0x88, 0x00, 0x9F, 0x65, 
+0x4D, 0x60, 
+
+0x27, 0xB3, 0xA6, 0x3B, 0x0C, 0x0D, 0x7D, 0xF4, 
+0x51, 0xE4, 0x2C, 0x1E, 0x38, 0xDB, 0x6A, 0xE9, 
+0x6F, 0x42, 0x33, 0x0A, 0x81, 0x5F, 0x93, 0x90, 
+0x69, 0xF5, 0x54, 0x2F, 0x5F, 0xCF, 0x25, 0xE0, 
+0xD1, 0x15, 0xA6, 0xE1, 0x67, 0xBA, 0x4B, 0x8A, 
+0x26, 0x73, 0xB9, 0x94, 0xB9, 0x82, 0xE4, 0x40, 
+0x1B, 0xEC, 0xAD, 0x91, 0x15, 0x7E, 0xF6, 0x2D, 
+0xDA, 0xD6, 0x18, 0x10, 0x27, 0x8C, 0x83, 0x8D, 
+0xA9, 0xF0, 0x91, 0x70, 0xD8, 0x3E, 0x7B, 0x54, 
+0xCF, 0xC7, 0x75, 0xA7, 0x0F, 0x0F, 0x82, 0x33, 
+0xE5, 0x9E, 0x8A, 0xCE, 0x28, 0x6B, 0xE9, 0x60, 
+0xBB, 0xB5, 0xE5, 0x0A, 0xCC, 0xAA, 0xFB, 0x2B, 
+0xD4, 0x25, 0x21, 0xE6, 0xD9, 0x4C, 0xEA, 0xC3, 
+0x10, 0x1A, 0xD1, 
+
+0x95, 0x90, 0x29, 0x27, 0x44, 0x78, 0x21, 0x06, 
+0x8A, 0xBF, 0xC2, 0x99, 0xD1, 0x05, 0x49, 0xFE, 
+0x78, 0x82, 0x4E, 0x73, 0x21, 0x0E, 0xB0, 0x4C, 
+0x16, 0xFE, 0x04, 0x51, 0x68, 0x2D, 0x6D, 0x7E, 
+0x07, 0x3B, 0x4D, 0xC1, 0x3A, 0x6C, 0x47, 0x74, 
+0x86, 0x7A, 0x6B, 0xEA, 0xD2, 0xFF, 0xE8, 0x34, 
+0x72, 0x50, 0x28, 0xDA, 0xAD, 0xA5, 0x9E, 0x1F, 
+0xD6, 0x94, 0xF4, 0x92, 0x47, 0x31, 0x06, 0xD6, 
+0x0F, 0xC6, 0x66, 0x2F, 0xFD, 0xF3, 0x6E, 0x90, 
+0x1F, 0x3B, 0x91, 0x85, 0xD9, 0xA9, 0x69, 0x58, 
+0xF2, 0x6C, 0x7B, 0x5D, 0xC3, 0x1E, 0x81, 0xEB, 
+0xC4, 0xAB, 0x41, 0x86, 0x00, 0x18, 0x72, 0x82, 
+0x16, 0x0D, 0x34, 0x25, 0xAB, 0x19, 0xB6, 0xBD, 
+0xCF, 0x10, 0x12, 0x32, 
+
+0x9B, 0x5C, 0x94, 0x04, 0x18, 0xB5, 0xCF, 0x44, 
+0x5C, 0x85, 0x02, 0x1A, 0x93, 0x48, 0x09, 0x87, 
+0x6D, 0x46, 0x86, 0x90, 0xB6, 0x40, 0x58, 0x79, 
+0xB4, 0x6A, 0x8B, 0x25, 0xC4, 0x67, 0x87, 0x56, 
+0x2C, 0x36, 0x87, 0x2B, 0x04, 0x90, 0x29, 0x93, 
+0xCD, 0x49, 0xCA, 0xA0, 0x12, 0xD8, 0x3A, 0xEB, 
+0x8D, 0xDF, 0x2C, 0x6D, 0xF5, 0xD1, 0xB0, 0xFB, 
+0xD5, 0x7E, 0x4C, 0x02, 0x6F, 0xE3, 0x19, 0x4F, 
+0x08, 0x7D, 0xAB, 0xEB, 0x20, 0x42, 0x89, 0x78, 
+0x18, 0x63, 0xB0, 0x98, 0xAB, 0x5A, 0xC4, 0xD0, 
+0x24, 0x20, 0x72, 0xD7, 0xDC, 0xA0, 0x36, 0xFB, 
+0x00, 0x87, 0xFA, 0x80, 0x16, 0x43, 0xB3, 0xAB, 
+0xE5, 0x66, 0xB3, 0x10, 0x8E, 0x58, 0x44, 0x41, 
+0x81, 0x72, 0xEA, 0x17, 0x5A, 
+
+0xC0, 0x95, 0x3C, 0x09, 0xAD, 0xEF, 0xE7, 0xEE, 
+0x63, 0x94, 0x1A, 0x64, 0xA9, 0xBA, 0x28, 0xAA, 
+0xC8, 0x15, 0x41, 0xF9, 0x04, 0x74, 0x4C, 0xCC, 
+0x92, 0x7A, 0x0F, 0x9B, 0x62, 0x00, 0x20, 0xEC, 
+0xFD, 0x30, 0x2F, 0x48, 0xE1, 0xAE, 0x07, 0x00, 
+0x30, 0x47, 0x27, 0x64, 0xFA, 0xD0, 0x8C, 0x01, 
+0x35, 0xC2, 0x39, 0x7A, 0xD7, 0x1D, 0x19, 0x19, 
+0xE8, 0x2E, 0x06, 0x5C, 0x79, 0x54, 0xC7, 0x96, 
+0x6A, 0x94, 0x7B, 0x85, 0x4D, 0xB3, 0x89, 0x48, 
+0x97, 0xB3, 0x3F, 0x0D, 0x8D, 0x14, 0x92, 0x0D, 
+0xE9, 0x43, 0x15, 0x45, 0x78, 0x6A, 0x8E, 0x16, 
+0x43, 0x5C, 0xDD, 0x43, 0x88, 0x57, 0xD1, 0x5C, 
+0xF5, 0x90, 0x5A, 0x79, 0x9D, 0x64, 0xCD, 0xFD, 
+0xC3, 0x8D, 0x1E, 0x0D, 0x0C, 0x95, 
+
+0xAA, 0x64, 0xED, 0xBB, 0x03, 0xFD, 0x66, 0xBA, 
+0x59, 0x99, 0x78, 0xA1, 0xD6, 0xE6, 0x1D, 0x84, 
+0x0E, 0xC3, 0x63, 0x88, 0x93, 0x32, 0xF4, 0xE8, 
+0x3C, 0x6B, 0x55, 0x0C, 0xF6, 0xAD, 0xCA, 0x9E, 
+0xA2, 0x5D, 0xB7, 0x3F, 0xF7, 0x3E, 0x05, 0x10, 
+0xA4, 0x5F, 0xA9, 0xBC, 0x0D, 0x2B, 0xA9, 0x71, 
+0xA8, 0x24, 0x68, 0xF4, 0x71, 0x6C, 0xAD, 0x05, 
+0x16, 0xA1, 0xA0, 0x49, 0x8F, 0x33, 0x62, 0xA0, 
+0x31, 0xE4, 0x95, 0x9A, 0x1A, 0x35, 0x6B, 0xC4, 
+0xBE, 0xFF, 0xED, 0xA3, 0xD5, 0xA9, 0x7A, 0x37, 
+0xE1, 0xD1, 0x45, 0x41, 0xE5, 0x52, 0xEC, 0x17, 
+0x84, 0xB8, 0xAB, 0x5C, 0x65, 0xB1, 0xE5, 0xA2, 
+0x95, 0x2E, 0x2D, 0x8B, 0x30, 0xDA, 0x58, 0x1F, 
+0xD6, 0xA2, 0x84, 0xCA, 0xB1, 0x30, 0x89, 
+
+0xEF, 0x3A, 0x5C, 0xF3, 0x5B, 0x82, 0xC7, 0xAF, 
+0xF5, 0xAC, 0x7A, 0x84, 0x49, 0x33, 0x6A, 0xD6, 
+0x75, 0x27, 0xF9, 0x67, 0x4F, 0x67, 0xC0, 0x79, 
+0x63, 0xFB, 0xAE, 0x21, 0x0F, 0x69, 0x95, 0x19, 
+0x04, 0xDC, 0x46, 0x87, 0x14, 0x8D, 0x39, 0x76, 
+0x4C, 0x24, 0xDB, 0x6E, 0xD3, 0xDB, 0xD9, 0xBE, 
+0x11, 0xD0, 0x16, 0xC0, 0x26, 0xE0, 0x63, 0x34, 
+0x51, 0x43, 0xBE, 0x3F, 0x55, 0x0C, 0xE4, 0x1A, 
+0x28, 0xE6, 0xCA, 0xFA, 0x4F, 0x5C, 0x03, 0x0F, 
+0xF5, 0x54, 0x0A, 0x03, 0xC8, 0x58, 0xC6, 0xD7, 
+0x1F, 0x9E, 0x45, 0x44, 0xA8, 0x00, 0x6B, 0x1E, 
+0xD3, 0xE2, 0x3E, 0x53, 0x9E, 0x77, 0x4B, 0x77, 
+0xE4, 0x24, 0xA4, 0xEB, 0x96, 0x6D, 0x9D, 0xC1, 
+0xAB, 0xC7, 0x40, 0xAD, 0xE9, 0x39, 0x04, 0xB8, 
+
+0x2F, 0xF0, 0x39, 0x7D, 0x43, 0x2B, 0x91, 0x2E, 
+0x2A, 0x1C, 0x43, 0x98, 0xE0, 0xC4, 0xDA, 0x7F, 
+0x55, 0x22, 0xC7, 0xB2, 0x82, 0xE9, 0x96, 0x5D, 
+0xF8, 0x94, 0x01, 0xDE, 0xEA, 0x9A, 0x43, 0xB8, 
+0xEE, 0xEB, 0x84, 0x7C, 0xB8, 0x21, 0x1D, 0xC3, 
+0xF7, 0x15, 0x84, 0xB7, 0x48, 0xAA, 0xCD, 0x25, 
+0x6D, 0xF0, 0xBD, 0x30, 0xD3, 0x9F, 0xB3, 0x57, 
+0x23, 0xFA, 0x6F, 0xFE, 0x74, 0x9D, 0xA0, 0x1F, 
+0x88, 0xA6, 0x5D, 0x6E, 0xF0, 0x26, 0x73, 0x5A, 
+0xF0, 0xE4, 0x2B, 0xD7, 0x78, 0x22, 0x64, 0x5B, 
+0x8F, 0xB8, 0x31, 0x38, 0x76, 0xD4, 0x2A, 0x85, 
+0x43, 0x5D, 0x1C, 0x72, 0xA9, 0x08, 0x66, 0xBD, 
+0x20, 0x5A, 0x29, 0x55, 0xB9, 0x87, 0xC9, 0x48, 
+0x60, 0xEC, 0x3C, 0x42, 0xC4, 0x19, 0xB7, 0x7D, 
+0xA5, 
+
+0x94, 0xF6, 0xA2, 0x4C, 0x21, 0xE1, 0x93, 0x4E, 
+0xA6, 0xD6, 0x14, 0x60, 0xF9, 0xDC, 0xF6, 0x44, 
+0x2D, 0x8C, 0x0B, 0x31, 0xA3, 0x2C, 0xF6, 0xB1, 
+0x61, 0x25, 0x76, 0x24, 0x41, 0xA2, 0xCE, 0x23, 
+0x82, 0x00, 0xB6, 0xE5, 0x58, 0x6E, 0xFB, 0x8A, 
+0xE1, 0xB1, 0xF7, 0xA9, 0x4F, 0x73, 0x30, 0xAC, 
+0xC3, 0x48, 0x34, 0xE6, 0x6C, 0x41, 0x78, 0x56, 
+0x19, 0xE9, 0x9C, 0x8E, 0xFE, 0x08, 0x52, 0xE5, 
+0xD0, 0x51, 0x73, 0x29, 0x32, 0xE1, 0x29, 0x5F, 
+0xEC, 0xE8, 0x0E, 0x1E, 0xE7, 0x84, 0x65, 0xC0, 
+0x7E, 0x11, 0x11, 0xB1, 0x15, 0x56, 0xB0, 0xFB, 
+0x8B, 0x9F, 0xBC, 0x2A, 0x09, 0x80, 0x2E, 0xCD, 
+0xE5, 0x5D, 0xA6, 0xE1, 0x0E, 0xFE, 0x2C, 0xCB, 
+0x59, 0x72, 0xF8, 0x56, 0xA5, 0xA7, 0x53, 0x62, 
+0x22, 0xF6, 
+
+0xDF, 0xD1, 0x0A, 0x04, 0xBF, 0xAC, 0xC8, 0xFC, 
+0xF9, 0x6F, 0xDF, 0x26, 0x14, 0xCC, 0xF4, 0x11, 
+0xA9, 0x7F, 0x3F, 0x2E, 0x52, 0x47, 0x41, 0xFA, 
+0x3E, 0x42, 0x6B, 0xDA, 0xD1, 0x93, 0xF6, 0x83, 
+0x42, 0xE3, 0xDA, 0xC5, 0x95, 0x00, 0x46, 0xC1, 
+0x2F, 0x04, 0x1C, 0x60, 0xC5, 0xAD, 0x25, 0x3D, 
+0x91, 0x60, 0x1D, 0x40, 0x6A, 0x0E, 0x2B, 0xF6, 
+0xCD, 0xA4, 0xBA, 0xC9, 0x64, 0x21, 0xD1, 0x92, 
+0x49, 0x9B, 0x98, 0x7B, 0xC9, 0x10, 0xCD, 0x65, 
+0x1B, 0x59, 0x47, 0xED, 0xE0, 0x01, 0x03, 0xDF, 
+0x26, 0x98, 0x4F, 0xD9, 0x87, 0x42, 0x7E, 0x93, 
+0x03, 0x5C, 0xCE, 0x48, 0x01, 0xA8, 0xFD, 0x06, 
+0xB9, 0x19, 0x4D, 0xE1, 0xEB, 0x13, 0x11, 0x9C, 
+0xB6, 0x7D, 0xEA, 0x9C, 0xCA, 0x18, 0xB9, 0xDB, 
+0x63, 0x0F, 0x04, 
+
+0xAA, 0xCC, 0x8B, 0xBC, 0xCB, 0x71, 0xD6, 0x0F, 
+0x6C, 0xB9, 0x98, 0xF2, 0x75, 0x10, 0xCB, 0x13, 
+0x41, 0xE3, 0x22, 0x49, 0x42, 0x3A, 0x6C, 0x86, 
+0x37, 0x1C, 0xBD, 0x8B, 0x74, 0x45, 0x16, 0xCE, 
+0x5D, 0x58, 0x98, 0x7C, 0x46, 0x1B, 0x2E, 0x13, 
+0x64, 0x04, 0x2D, 0xDF, 0xF1, 0x11, 0x25, 0x4F, 
+0x07, 0x62, 0x6F, 0xF3, 0x32, 0x28, 0xAD, 0xCF, 
+0xBD, 0x4A, 0xA3, 0x51, 0xBA, 0x7F, 0x65, 0x4F, 
+0x0B, 0xBF, 0x73, 0x5E, 0x10, 0x4F, 0x6F, 0x3E, 
+0x81, 0x25, 0x0B, 0x65, 0x96, 0x53, 0x7E, 0xE6, 
+0xDE, 0x8A, 0x87, 0xD5, 0x70, 0xE7, 0x9D, 0x26, 
+0x07, 0x81, 0x27, 0xB3, 0xA4, 0xA4, 0x7F, 0xC6, 
+0xEF, 0x97, 0x64, 0xB9, 0x99, 0x9D, 0x84, 0xD9, 
+0x3B, 0x13, 0x49, 0x3C, 0x79, 0x81, 0xEF, 0x79, 
+0xA2, 0x07, 0x56, 0x9B, 
+
+0x25, 0x82, 0xCE, 0xC9, 0x54, 0x64, 0x02, 0xD7, 
+0x65, 0x89, 0x30, 0x14, 0xA4, 0xAE, 0x4B, 0x62, 
+0x56, 0x3C, 0x50, 0x38, 0xC9, 0x9A, 0x64, 0xF2, 
+0x17, 0x1B, 0xF0, 0xB2, 0x6B, 0xF3, 0x29, 0x0D, 
+0x8D, 0xA0, 0x39, 0xB6, 0x75, 0xE0, 0x91, 0x17, 
+0xDE, 0xAA, 0xE7, 0x54, 0xF7, 0x58, 0xB5, 0x41, 
+0xC3, 0xC3, 0xB1, 0x6E, 0xAD, 0x31, 0x99, 0x6D, 
+0x6D, 0x17, 0x52, 0x7C, 0x5A, 0x27, 0x50, 0x38, 
+0xFF, 0x28, 0x77, 0xCB, 0x12, 0x1B, 0x89, 0x81, 
+0x0C, 0xB9, 0x43, 0x36, 0xBC, 0x91, 0xA6, 0x08, 
+0x2C, 0x8E, 0xAA, 0x42, 0x10, 0x0D, 0x2B, 0xD7, 
+0x23, 0x52, 0x1E, 0x5F, 0x93, 0xA6, 0x1A, 0xB8, 
+0x0A, 0x3E, 0xBC, 0x93, 0xF8, 0xE4, 0xC6, 0xAE, 
+0x6B, 0x31, 0x19, 0x40, 0x05, 0x8B, 0x3A, 0xC8, 
+0x76, 0x85, 0xD0, 0xEE, 0xE3, 
+
+0x74, 0x81, 0x80, 0x3B, 0x68, 0x8F, 0x71, 0x6A, 
+0x8A, 0x69, 0x52, 0x78, 0xBC, 0x36, 0x18, 0xFC, 
+0x83, 0xCB, 0x5A, 0x92, 0x9B, 0x41, 0x7E, 0xFB, 
+0xDB, 0x28, 0xE6, 0xBE, 0x4C, 0x3F, 0xF5, 0xD4, 
+0x38, 0x33, 0xB1, 0x8D, 0x18, 0x7F, 0xB7, 0x69, 
+0x39, 0x6F, 0x67, 0x3B, 0x23, 0x34, 0x62, 0xD5, 
+0x82, 0x8F, 0xD8, 0xEC, 0x14, 0x24, 0x43, 0x72, 
+0x2C, 0xE5, 0x66, 0xEA, 0x41, 0x2E, 0x65, 0xA7, 
+0x5A, 0x3F, 0x2E, 0xD7, 0xF5, 0xA2, 0xD1, 0x32, 
+0x38, 0x82, 0x02, 0x4F, 0x2A, 0x7C, 0xBC, 0x79, 
+0xF3, 0xAC, 0x9E, 0xCE, 0x78, 0xD5, 0x34, 0xA3, 
+0x5D, 0x75, 0x22, 0xB3, 0xA7, 0x04, 0x6A, 0xE3, 
+0xD9, 0xF8, 0xDE, 0x53, 0xF9, 0x0A, 0xA8, 0xBF, 
+0xC9, 0x62, 0x77, 0x46, 0xF9, 0x43, 0xB6, 0xE0, 
+0x5C, 0x50, 0xF9, 0x9E, 0xC2, 0xC3, 
+
+0x3E, 0x21, 0xC1, 0xC5, 0x4F, 0xCB, 0x48, 0xFE, 
+0x54, 0x4F, 0x8C, 0x98, 0x65, 0xA4, 0xB9, 0x04, 
+0x28, 0x94, 0x1B, 0x95, 0x1F, 0x6C, 0x48, 0x3A, 
+0xAE, 0x74, 0xD2, 0xAB, 0x67, 0x75, 0x62, 0xCA, 
+0x0F, 0x0B, 0xD6, 0xDD, 0x3D, 0x18, 0xD0, 0x8C, 
+0x14, 0x17, 0xC1, 0xBF, 0x03, 0xD2, 0x51, 0xEB, 
+0xCD, 0xEA, 0x63, 0xC4, 0x51, 0xE4, 0xE3, 0x72, 
+0xB6, 0xDA, 0xDF, 0x8B, 0x5F, 0x7E, 0xFC, 0x0F, 
+0xA1, 0x8A, 0x17, 0xFB, 0x8C, 0x46, 0x90, 0xB8, 
+0x87, 0x97, 0x8E, 0x82, 0x4E, 0x92, 0x39, 0x58, 
+0x21, 0xE5, 0xC6, 0x1D, 0x07, 0x81, 0xA2, 0x75, 
+0xFF, 0x1D, 0xCE, 0x1B, 0xB6, 0xC8, 0x5E, 0x9A, 
+0xAB, 0xFB, 0x7A, 0x2A, 0x3E, 0x90, 0xA3, 0xA0, 
+0x51, 0x8E, 0x9B, 0xE4, 0x2E, 0x48, 0xB8, 0xD7, 
+0xCB, 0xD8, 0x6F, 0x12, 0x49, 0xB1, 0xF9, 
+
+0xED, 0xDE, 0x93, 0x50, 0x4A, 0xE3, 0xF1, 0xE1, 
+0x8E, 0xB6, 0x25, 0x96, 0x79, 0x97, 0xA4, 0x7B, 
+0x95, 0x98, 0xA7, 0x6B, 0x3E, 0x97, 0x8F, 0x8C, 
+0x00, 0xDF, 0x29, 0x18, 0xF6, 0xE3, 0xF2, 0xA1, 
+0x09, 0x3E, 0x44, 0x95, 0xD5, 0x81, 0xFC, 0xC3, 
+0x09, 0x8A, 0x7E, 0x64, 0x3D, 0x47, 0xB0, 0x38, 
+0x6F, 0xBF, 0xE4, 0xF9, 0x87, 0x09, 0x57, 0xAB, 
+0x42, 0x91, 0xF9, 0x60, 0xF9, 0x04, 0x42, 0x85, 
+0xDA, 0xE9, 0x17, 0x50, 0x7F, 0x41, 0x2E, 0xC5, 
+0xA4, 0x52, 0x57, 0xD9, 0x9E, 0x6D, 0x1D, 0x9D, 
+0x6D, 0xDD, 0xA9, 0x92, 0xA0, 0xD8, 0xB7, 0x37, 
+0x4A, 0x3F, 0x1D, 0x35, 0xB9, 0x4F, 0x56, 0x58, 
+0x7F, 0x32, 0x67, 0x1C, 0xE0, 0x31, 0x3A, 0x25, 
+0x1D, 0x50, 0x70, 0x75, 0xF3, 0xBE, 0x52, 0x37, 
+0xC4, 0x4C, 0xDC, 0x2F, 0x2A, 0x2A, 0xBB, 0x43, 
+
+0x4F, 0x29, 0xB1, 0x09, 0xF2, 0x58, 0x0A, 0x8E, 
+0xA7, 0x3C, 0xE1, 0xE6, 0x2A, 0xFC, 0x8A, 0xE6, 
+0xF8, 0x09, 0x55, 0x6D, 0xAF, 0xB1, 0xF0, 0xD5, 
+0x72, 0x71, 0xB7, 0x4E, 0xF6, 0x47, 0xB8, 0xFF, 
+0x0E, 0x35, 0x0B, 0xEC, 0x39, 0xB0, 0x40, 0xEF, 
+0xF0, 0x37, 0x60, 0x63, 0xA6, 0x95, 0x39, 0xAA, 
+0xE6, 0x7B, 0x51, 0x01, 0x89, 0x81, 0x78, 0xF0, 
+0x34, 0x37, 0x8C, 0x4C, 0x26, 0x4F, 0xA4, 0x24, 
+0x7C, 0x5F, 0xE8, 0x16, 0x9B, 0x95, 0xEE, 0xCA, 
+0x11, 0xEF, 0x2A, 0xFF, 0xF5, 0xFB, 0x08, 0x43, 
+0x16, 0x19, 0xC5, 0x4B, 0xC5, 0x99, 0xCA, 0x07, 
+0x3C, 0x4A, 0x53, 0x3F, 0x58, 0x57, 0x84, 0x0E, 
+0x82, 0xAB, 0x6B, 0x2F, 0xF5, 0xC3, 0x8B, 0xC8, 
+0x7E, 0x45, 0x2F, 0xA1, 0x66, 0x7C, 0xD4, 0x88, 
+0x70, 0x2D, 0x80, 0xBD, 0x7C, 0xD0, 0xD9, 0x25, 
+0x53, 
+
+0x45, 0x18, 0x4B, 0xEE, 0x51, 0x11, 0x5C, 0xAE, 
+0x68, 0x40, 0x8C, 0xC5, 0x8C, 0xBF, 0x61, 0x8E, 
+0x8D, 0xBD, 0xCB, 0xEA, 0x11, 0x0A, 0x55, 0xD7, 
+0xCF, 0x59, 0x8C, 0x7C, 0x73, 0xD8, 0xC2, 0x10, 
+0xD0, 0x70, 0x44, 0x0D, 0x75, 0x4E, 0x3B, 0xDC, 
+0xA9, 0xBF, 0xDA, 0x68, 0x11, 0x70, 0xB4, 0x20, 
+0x2B, 0x73, 0xB4, 0x54, 0x68, 0xD4, 0x91, 0xCA, 
+0xF9, 0xCA, 0xFB, 0xAA, 0x3D, 0x1F, 0x70, 0xDA, 
+0x3F, 0xBC, 0xFD, 0xF5, 0x7A, 0x8F, 0x26, 0xA2, 
+0x07, 0x79, 0x6E, 0x98, 0x31, 0x92, 0x97, 0xAD, 
+0x49, 0x93, 0x01, 0x51, 0x7E, 0xA1, 0xCC, 0xDD, 
+0xC0, 0x54, 0xE0, 0x22, 0x5E, 0xE1, 0xF1, 0xC7, 
+0xAA, 0x99, 0x5C, 0xD5, 0x7A, 0x14, 0x7F, 0xD5, 
+0x4D, 0xDB, 0x74, 0x7B, 0x30, 0x12, 0x22, 0xA7, 
+0x08, 0x44, 0xC6, 0x38, 0x95, 0x86, 0x68, 0xE0, 
+0x8B, 0x03, 
+
+0xF1, 0xC4, 0x7D, 0x36, 0x42, 0x47, 0xD0, 0x7E, 
+0x47, 0x91, 0x16, 0x00, 0x03, 0xDE, 0xE6, 0xCC, 
+0xF9, 0x1C, 0xC9, 0xFB, 0x39, 0x6D, 0xED, 0x4A, 
+0xB8, 0xDF, 0x6B, 0x43, 0xE3, 0xA2, 0x39, 0xFB, 
+0x90, 0x4E, 0x28, 0xB2, 0xFB, 0xB9, 0xE1, 0x5B, 
+0x3A, 0x8E, 0xC8, 0x01, 0x02, 0x11, 0x53, 0x9D, 
+0x6E, 0x47, 0xDA, 0x52, 0x15, 0x4A, 0x5E, 0xBC, 
+0x51, 0x50, 0x58, 0xBC, 0xBA, 0xAB, 0x73, 0x16, 
+0x74, 0x9C, 0x09, 0x9C, 0x6C, 0x97, 0x2C, 0x10, 
+0xB3, 0x57, 0x7D, 0x70, 0xAE, 0xA0, 0xEC, 0x89, 
+0xCD, 0x29, 0xA8, 0xC7, 0x23, 0x28, 0xD1, 0xA7, 
+0x09, 0x7B, 0xB9, 0xA6, 0xB8, 0x92, 0x57, 0xCD, 
+0x0C, 0x58, 0x3E, 0xEA, 0x0D, 0x36, 0x0D, 0xCC, 
+0x4A, 0xB8, 0x95, 0xA3, 0x36, 0xBD, 0xEB, 0x0B, 
+0xCE, 0x20, 0x5A, 0x09, 0x7D, 0xD2, 0xB6, 0xC3, 
+0xC8, 0x1C, 0x08, 
+
+0xCE, 0xBF, 0x6B, 0x0B, 0x02, 0xEA, 0x19, 0x3E, 
+0xFD, 0xE4, 0xBC, 0xA4, 0x35, 0x7E, 0xAD, 0xD2, 
+0x05, 0xD7, 0xFB, 0x05, 0xBE, 0xD2, 0xBF, 0xA8, 
+0xA5, 0xD9, 0xC0, 0xB1, 0x9B, 0xB9, 0x54, 0xC0, 
+0x2A, 0x89, 0x3F, 0xF2, 0x68, 0x45, 0xB0, 0x44, 
+0x6D, 0x7C, 0x7C, 0xF7, 0x51, 0xE9, 0x9E, 0xA8, 
+0x80, 0x58, 0xBF, 0x4F, 0xDB, 0x38, 0x2C, 0xE5, 
+0x3E, 0x50, 0x0A, 0xA9, 0xBB, 0x63, 0x97, 0xA6, 
+0xF5, 0x8F, 0x90, 0xC4, 0xAD, 0xBE, 0x90, 0x3E, 
+0x94, 0xEE, 0x28, 0xA5, 0x56, 0x82, 0xE3, 0x96, 
+0x6F, 0x3B, 0x2E, 0x41, 0xFB, 0xE6, 0x9D, 0x06, 
+0x41, 0x31, 0x0A, 0xBD, 0x00, 0xFD, 0x6B, 0x08, 
+0x76, 0x4F, 0x88, 0x53, 0xE4, 0x24, 0x3F, 0x5C, 
+0xE7, 0x1A, 0x6A, 0x9E, 0x9A, 0x1E, 0x83, 0x29, 
+0x49, 0x5F, 0xDE, 0xD1, 0x27, 0x57, 0x06, 0x5A, 
+0x40, 0x3D, 0x6E, 0x5F, 
+
+0x42, 0x7F, 0x68, 0xB0, 0xB0, 0x44, 0x64, 0x64, 
+0x9C, 0xEB, 0xA5, 0xE6, 0x65, 0xC3, 0x6B, 0xA8, 
+0xD1, 0xB3, 0x49, 0x55, 0xE1, 0x3C, 0x6E, 0xE0, 
+0x61, 0x19, 0x3A, 0x52, 0xAE, 0xE8, 0x69, 0xEB, 
+0xF1, 0xA6, 0xA4, 0x8D, 0xD9, 0x1E, 0x54, 0xB8, 
+0x03, 0x74, 0x81, 0x87, 0xED, 0x71, 0xF6, 0xA2, 
+0x01, 0xBA, 0xAB, 0x52, 0xD0, 0x8B, 0x84, 0x54, 
+0x0D, 0x39, 0x1D, 0xC9, 0xBF, 0x5F, 0x53, 0x21, 
+0x38, 0x99, 0xF5, 0x52, 0x33, 0x6F, 0x14, 0x9F, 
+0xA7, 0xEA, 0xA1, 0xEF, 0x40, 0xB7, 0x75, 0xE2, 
+0x5F, 0x33, 0x33, 0x18, 0x75, 0x13, 0x9F, 0x11, 
+0x18, 0xA1, 0xC6, 0xF0, 0xC6, 0x84, 0x09, 0x40, 
+0x45, 0xAB, 0x9A, 0x8F, 0x0D, 0x04, 0x3C, 0x2E, 
+0x02, 0x0E, 0x5A, 0x4C, 0xD7, 0x60, 0xA0, 0x02, 
+0xE7, 0x5D, 0x2A, 0x91, 0x1D, 0x5A, 0x04, 0xC8, 
+0xCE, 0x39, 0x75, 0xF6, 0xF2, 
+
+0xB1, 0x9C, 0xB9, 0x85, 0x9E, 0x8D, 0x5B, 0x18, 
+0x7B, 0xCC, 0x81, 0x36, 0xC2, 0x25, 0x8A, 0x8F, 
+0x18, 0x09, 0x87, 0x4F, 0x7F, 0xFA, 0x4E, 0x27, 
+0xF6, 0x8D, 0x15, 0x22, 0x50, 0x17, 0xA7, 0x54, 
+0xCB, 0xD3, 0x27, 0xEF, 0x7F, 0xFB, 0x51, 0x4B, 
+0xE6, 0x2F, 0x4B, 0xCD, 0xCB, 0x52, 0x00, 0x0F, 
+0xC7, 0x0B, 0xDA, 0xC0, 0x35, 0xF2, 0xA0, 0xEC, 
+0xED, 0x90, 0xEB, 0x94, 0xBB, 0x4D, 0xC3, 0x8F, 
+0x64, 0xFA, 0x6E, 0xA2, 0xF1, 0x7E, 0x34, 0xAB, 
+0xF4, 0xE9, 0x79, 0x88, 0x12, 0x12, 0x6E, 0x75, 
+0x9E, 0x18, 0x37, 0x25, 0x3F, 0x48, 0x38, 0xF5, 
+0xBF, 0x3D, 0xD1, 0x85, 0xFC, 0x76, 0x0A, 0x9D, 
+0xC2, 0x0C, 0x46, 0x49, 0xE4, 0x69, 0x95, 0xEB, 
+0x52, 0xFB, 0x4B, 0x86, 0x30, 0x98, 0xD5, 0xAD, 
+0x25, 0x69, 0x95, 0xF3, 0xEA, 0x0A, 0xFD, 0x45, 
+0x34, 0x1C, 0xC5, 0xA2, 0x58, 0x07, 
+
+0xC8, 0x58, 0xDA, 0xBB, 0x32, 0x63, 0x63, 0x4C, 
+0x4F, 0x7F, 0x8A, 0x13, 0xB6, 0xAC, 0x47, 0x62, 
+0xF9, 0x83, 0xC7, 0xEB, 0xE5, 0x4F, 0x24, 0xF9, 
+0x2A, 0x75, 0x83, 0xC1, 0xE1, 0xA4, 0xF8, 0x65, 
+0x03, 0x4F, 0xEA, 0x20, 0x3C, 0x3E, 0x8F, 0x1D, 
+0x4E, 0x45, 0xBC, 0x05, 0xEA, 0xAE, 0xA1, 0xC0, 
+0x3C, 0x59, 0x14, 0x63, 0xDC, 0xC1, 0xF8, 0x1E, 
+0x22, 0x86, 0x81, 0xAA, 0x69, 0xE4, 0xA3, 0x5E, 
+0xDC, 0xB3, 0xA0, 0x75, 0x98, 0xA6, 0x29, 0x79, 
+0xF9, 0x10, 0xF5, 0x5E, 0x4B, 0xCD, 0x5E, 0x78, 
+0xA5, 0xBA, 0x2E, 0xAE, 0xDE, 0x84, 0xEB, 0xB2, 
+0xA5, 0x1B, 0x41, 0x8A, 0xDA, 0xBE, 0x77, 0x80, 
+0x93, 0x8A, 0x80, 0xF2, 0xB4, 0x05, 0x3E, 0xDC, 
+0x3F, 0x95, 0x63, 0x87, 0xCF, 0xF0, 0x5C, 0x48, 
+0x92, 0x19, 0x76, 0xCA, 0x23, 0xE3, 0x23, 0x5A, 
+0x1E, 0xB5, 0x10, 0x4B, 0x9B, 0x0E, 0x3D, 
+
+0xBD, 0x5A, 0xC2, 0x20, 0xE4, 0x3A, 0x8A, 0x05, 
+0xA1, 0xF3, 0x82, 0x68, 0x9C, 0x41, 0xD3, 0x56, 
+0x93, 0x72, 0xD3, 0xCF, 0xD8, 0x71, 0x0B, 0xFC, 
+0x23, 0x28, 0x11, 0x25, 0x89, 0xDD, 0xA1, 0x47, 
+0xB5, 0xE8, 0xC6, 0x7B, 0xCD, 0x19, 0x63, 0x38, 
+0x4A, 0x72, 0x11, 0x76, 0xA9, 0x32, 0xE6, 0x6C, 
+0x4A, 0x02, 0xA2, 0x2C, 0xA2, 0xCF, 0xAE, 0x78, 
+0xFA, 0x67, 0x2B, 0xC5, 0x27, 0xA9, 0xDF, 0x28, 
+0xB9, 0xFB, 0x29, 0xF5, 0x57, 0x9C, 0xAB, 0x86, 
+0x3F, 0x16, 0x8A, 0xD3, 0x62, 0xB0, 0xE9, 0xA9, 
+0x41, 0x0B, 0x28, 0x75, 0x67, 0x1D, 0x31, 0xE1, 
+0xA2, 0xF2, 0x20, 0x6B, 0xDB, 0xC8, 0x5E, 0x47, 
+0x16, 0xD6, 0x62, 0x97, 0x34, 0xF9, 0xD3, 0x1E, 
+0x64, 0xA3, 0x2D, 0xA1, 0xB6, 0xA1, 0x8E, 0x8D, 
+0xEE, 0xA4, 0x96, 0x67, 0x00, 0xD0, 0x36, 0x05, 
+0x87, 0x43, 0xD8, 0x40, 0x27, 0x49, 0xB0, 0x13, 
+
+0x8E, 0x83, 0x22, 0x64, 0x6D, 0x8E, 0x01, 0xBD, 
+0xC9, 0xDF, 0x43, 0xFF, 0xDC, 0xB3, 0x9B, 0x54, 
+0x17, 0x2E, 0xE1, 0xBB, 0x85, 0xBD, 0x2C, 0x7C, 
+0xC4, 0x23, 0x56, 0x96, 0x6E, 0xDA, 0xCC, 0x2B, 
+0xF3, 0xB7, 0x50, 0xEA, 0x65, 0xDE, 0x3B, 0x06, 
+0x74, 0xCB, 0x35, 0x1A, 0xBA, 0xE3, 0x9F, 0xE3, 
+0x32, 0x9A, 0x2A, 0x26, 0x99, 0x0A, 0x52, 0x6D, 
+0xEA, 0x80, 0x4D, 0xCE, 0x70, 0xD8, 0x3F, 0xE9, 
+0x9A, 0xE6, 0x3C, 0x4A, 0x30, 0xF3, 0x9A, 0x95, 
+0x8D, 0x7A, 0xBE, 0x8F, 0xB3, 0xA1, 0xF0, 0x47, 
+0x8E, 0x3F, 0xCF, 0x1B, 0xDA, 0x5D, 0xE3, 0xAC, 
+0xBE, 0xF2, 0xF0, 0xEA, 0xA8, 0xA2, 0x5F, 0xD6, 
+0x58, 0x63, 0xE5, 0xB3, 0x53, 0x4E, 0x65, 0xF0, 
+0x9D, 0x20, 0x1A, 0xF9, 0x55, 0x4B, 0x3D, 0x1B, 
+0xBA, 0x6C, 0x0C, 0x3C, 0x54, 0x83, 0x37, 0x90, 
+0x48, 0x5F, 0x6D, 0x42, 0x1F, 0x19, 0x7C, 0x04, 
+0x9C, 
+
+0xC4, 0xD9, 0x84, 0xA3, 0x1D, 0x86, 0xFC, 0xB2, 
+0xE9, 0xE4, 0x48, 0x7A, 0x6C, 0x63, 0x17, 0x7A, 
+0x45, 0x4E, 0x5A, 0x0C, 0xB1, 0x54, 0x0E, 0x90, 
+0xBD, 0x81, 0x40, 0x05, 0x4F, 0xC1, 0x8B, 0x09, 
+0x9A, 0x59, 0xE9, 0xC4, 0xBC, 0xE0, 0x9F, 0xCE, 
+0x34, 0x50, 0x70, 0x99, 0x17, 0x17, 0x84, 0xFA, 
+0xD5, 0x37, 0xEB, 0x60, 0x91, 0x12, 0x37, 0x48, 
+0x21, 0xFF, 0x34, 0x81, 0x46, 0xDD, 0x7E, 0x47, 
+0x42, 0x31, 0x25, 0x3E, 0x83, 0xCD, 0x7C, 0x6D, 
+0x73, 0xFF, 0x36, 0x8B, 0xAE, 0x30, 0x10, 0xD0, 
+0x9B, 0xB9, 0xDA, 0x71, 0xAC, 0xDA, 0x3C, 0x64, 
+0x0F, 0x7B, 0xAA, 0x65, 0xFE, 0x19, 0x9A, 0x10, 
+0x25, 0x64, 0x2F, 0x17, 0x66, 0xFB, 0xFD, 0x9E, 
+0x10, 0xD6, 0x2D, 0x2C, 0x4F, 0xF9, 0x22, 0x4E, 
+0xA6, 0xC9, 0xA7, 0xFA, 0xF7, 0x26, 0xA9, 0x67, 
+0xE6, 0xD8, 0x8F, 0x14, 0xAA, 0x66, 0x3C, 0xEE, 
+0x70, 0x54, 
+
+0xDD, 0xC6, 0xDF, 0x4D, 0xA9, 0x2C, 0x70, 0xBF, 
+0x03, 0xEF, 0x8A, 0xA3, 0x67, 0x87, 0x09, 0x7B, 
+0x37, 0x4F, 0xCF, 0x34, 0x8C, 0x9F, 0x2C, 0x76, 
+0xA1, 0x6F, 0x24, 0xE1, 0x3A, 0x73, 0x26, 0x8C, 
+0x5D, 0xFA, 0x51, 0x59, 0x74, 0x4A, 0x60, 0xF6, 
+0x58, 0x9A, 0xBC, 0x9A, 0x55, 0xFD, 0xCC, 0x74, 
+0xF2, 0x1A, 0x36, 0xB2, 0xB0, 0xDC, 0xAD, 0xF2, 
+0x62, 0x2A, 0x2C, 0xFE, 0x64, 0xF6, 0x7C, 0xF5, 
+0xA4, 0x79, 0xCB, 0x43, 0x47, 0xC0, 0x17, 0x4A, 
+0x99, 0x6A, 0xF7, 0x9C, 0xDE, 0xA8, 0x63, 0x93, 
+0x9F, 0xA7, 0xA0, 0x6B, 0x2D, 0x5E, 0xB0, 0x56, 
+0x79, 0xA0, 0x86, 0x57, 0x52, 0x07, 0xE0, 0x3B, 
+0x0A, 0x07, 0x1C, 0x0B, 0xC2, 0x91, 0xF1, 0x62, 
+0xF3, 0xD9, 0x7F, 0xEA, 0x8C, 0x54, 0x50, 0xCA, 
+0xF6, 0x10, 0x9D, 0xA6, 0x53, 0xF8, 0x9F, 0xBA, 
+0xB3, 0xD7, 0x95, 0xED, 0x25, 0x49, 0x53, 0x63, 
+0x67, 0x8D, 0xA9, 
+
+0x61, 0x59, 0x2D, 0x05, 0x9F, 0xAE, 0x71, 0xE7, 
+0xD0, 0x6E, 0x8D, 0x3F, 0x1C, 0xEC, 0xB2, 0x33, 
+0x2B, 0xAA, 0x7F, 0x40, 0xFF, 0x53, 0x6D, 0xE6, 
+0xCC, 0x24, 0xC0, 0xF7, 0x77, 0x30, 0x11, 0x6A, 
+0x4B, 0x92, 0x59, 0x4C, 0xC4, 0x17, 0x23, 0x9C, 
+0x9A, 0x79, 0x45, 0x6A, 0x58, 0xAE, 0xDA, 0x70, 
+0xC6, 0xAA, 0xB6, 0xFD, 0xE6, 0xB6, 0x22, 0xB3, 
+0xE2, 0xAB, 0x10, 0xF6, 0x8C, 0xFD, 0x99, 0x0D, 
+0xF3, 0x00, 0xA0, 0xC0, 0x81, 0x4E, 0xF1, 0x92, 
+0xA5, 0x52, 0x6F, 0xCF, 0x37, 0x04, 0x1A, 0xBF, 
+0x84, 0xC0, 0x5E, 0x67, 0xD9, 0x3A, 0xD9, 0x60, 
+0x01, 0xF8, 0x32, 0xC0, 0x2B, 0xE8, 0x43, 0xDE, 
+0xD7, 0x05, 0x2A, 0x70, 0x50, 0x8E, 0x43, 0x6C, 
+0x6F, 0x46, 0x9A, 0xB0, 0xB7, 0x0A, 0xD4, 0x65, 
+0x93, 0xC4, 0xDF, 0x3B, 0x3F, 0xB8, 0x8A, 0x32, 
+0x04, 0x2B, 0x10, 0x31, 0x14, 0x18, 0x4F, 0xE0, 
+0x2C, 0xF4, 0x95, 0x7E, 
+
+0x89, 0x0F, 0x4E, 0xF3, 0x50, 0x21, 0x1B, 0xC3, 
+0xD7, 0xE6, 0xDD, 0xD4, 0x55, 0x61, 0xCA, 0x60, 
+0x24, 0x2D, 0x2B, 0x28, 0x4F, 0xEB, 0xAC, 0xC9, 
+0xA5, 0xE4, 0xEF, 0x7D, 0x49, 0x94, 0x94, 0x18, 
+
+0xE2, 0x92, 0x58, 0xAE, 0x1A, 0x16, 0x48, 0x01, 
+0xA5, 0xA2, 0xFF, 0x9B, 0xBB, 0x92, 0x5B, 0x75, 
+0x8B, 0x9F, 0x64, 0x8E, 0x78, 0x5B, 0x50, 0x15, 
+0x5B, 0xC7, 0x38, 0x12, 0x7A, 0x95, 0x62, 0x56, 
+0xFC, 
+
+0xF6, 0xDD, 0xA5, 0x44, 0x36, 0x7F, 0x73, 0xAB, 
+0xB7, 0x3E, 0x2D, 0x43, 0x12, 0xAC, 0x4D, 0x10, 
+0x6B, 0xFF, 0xFA, 0xA7, 0xE9, 0x04, 0xC5, 0x18, 
+0x80, 0xDA, 0x8B, 0xDB, 0x12, 0xD7, 0xC5, 0xEF, 
+0x65, 0x1C, 
+
+0x9B, 0xE8, 0x75, 0x90, 0x06, 0x0A, 0x48, 0xE2, 
+0xA0, 0xD0, 0xEC, 0xB0, 0xC6, 0xC3, 0xAE, 0xD0, 
+0x3B, 0xA6, 0x7F, 0x53, 0xFD, 0x34, 0xD0, 0xD3, 
+0x8B, 0x59, 0x09, 0x12, 0x7E, 0x32, 0xC9, 0x2C, 
+0xB6, 0x30, 0x46, 
+
+0xF5, 0xD2, 0x9C, 0xC6, 0x05, 0x1B, 0x8D, 0xD8, 
+0x7C, 0x83, 0x9A, 0xBD, 0x18, 0x8F, 0x74, 0x90, 
+0xF1, 0x13, 0x4B, 0x33, 0xE9, 0x28, 0xA5, 0xEE, 
+0x2A, 0x7A, 0x90, 0x99, 0x77, 0x54, 0xE2, 0xEB, 
+0xA5, 0x30, 0x28, 0xD6, 
+
+0x3F, 0xFA, 0x83, 0xA5, 0xC1, 0x35, 0x45, 0xEB, 
+0x30, 0xF0, 0xC5, 0xD7, 0x36, 0x67, 0x1B, 0x80, 
+0xB8, 0x7B, 0xCE, 0xAE, 0xA0, 0xBC, 0x9C, 0xA1, 
+0x4D, 0xBE, 0xD7, 0x3C, 0xB2, 0xC4, 0xAD, 0x73, 
+0xC7, 0x6C, 0xB6, 0xF8, 0xC4, 
+
+0xC0, 0x40, 0x9B, 0xE1, 0x23, 0x32, 0x58, 0x52, 
+0xA3, 0x57, 0x47, 0x58, 0x3F, 0x07, 0xBA, 0x19, 
+0xB9, 0x48, 0xEC, 0x1B, 0x9F, 0x4C, 0xCE, 0x90, 
+0x1C, 0xCC, 0xC9, 0x74, 0x67, 0x15, 0x34, 0x7A, 
+0x90, 0x8F, 0x04, 0x95, 0xC1, 0x70, 
+
+0x0C, 0x1C, 0xF2, 0x6F, 0x30, 0x9D, 0x60, 0x88, 
+0xB3, 0x83, 0x25, 0xBD, 0xD3, 0xB1, 0xB3, 0x82, 
+0x6F, 0xC1, 0xAB, 0xA7, 0x48, 0x23, 0x0E, 0x63, 
+0x4C, 0x5A, 0xC5, 0x12, 0x2F, 0x86, 0xB7, 0x39, 
+0x51, 0x95, 0x35, 0x3B, 0xD0, 0x31, 0xF2, 
+
+0xBE, 0x5E, 0xCE, 0x55, 0xF7, 0xE6, 0xD2, 0xDC, 
+0x02, 0xE8, 0x37, 0x32, 0x04, 0x46, 0xB4, 0x80, 
+0x96, 0x46, 0x65, 0x38, 0x8C, 0xF0, 0x23, 0xB9, 
+0x2B, 0xED, 0x88, 0xE1, 0xD3, 0x2A, 0xD1, 0x18, 
+0xE7, 0x3D, 0x88, 0xFC, 0x8F, 0x5B, 0x81, 0xA6, 
+
+0x07, 0x0C, 0x86, 0x7D, 0xAE, 0xE0, 0x4E, 0x7B, 
+0x7C, 0x46, 0x34, 0x96, 0x02, 0x2E, 0xB9, 0x44, 
+0x7C, 0x60, 0x4B, 0xEE, 0xF9, 0x0D, 0xB8, 0x1B, 
+0x52, 0xBD, 0x23, 0x2C, 0x60, 0x03, 0x46, 0x30, 
+0xEC, 0x74, 0x0E, 0x2D, 0x67, 0x41, 0xC0, 0x11, 
+0x7D, 
+
+0x90, 0x03, 0xC4, 0x05, 0x41, 0x56, 0xB6, 0x2F, 
+0xCA, 0xF6, 0xA9, 0xAC, 0x26, 0x01, 0x6C, 0x98, 
+0x3F, 0xAC, 0x25, 0xD0, 0x8E, 0x1D, 0x74, 0xA8, 
+0xA9, 0x74, 0x22, 0x0A, 0xCB, 0x95, 0x9E, 0x40, 
+0xF1, 0xC7, 0x2E, 0xBB, 0x2E, 0xC8, 0xEF, 0xDA, 
+0xD4, 0x04, 
+
+0x74, 0x40, 0x9A, 0x59, 0xAA, 0xE0, 0x7B, 0x12, 
+0x0C, 0x5B, 0x7D, 0x2F, 0x00, 0xFD, 0x4C, 0xFE, 
+0x99, 0x9F, 0x11, 0xAE, 0x46, 0xF5, 0xE7, 0xD2, 
+0xD9, 0x60, 0xC4, 0x60, 0x19, 0x7C, 0x4C, 0x9C, 
+0x1C, 0xDF, 0x3A, 0x40, 0x88, 0xA6, 0x58, 0x47, 
+0x25, 0xC0, 0x0E, 
+
+0x09, 0xAE, 0xC2, 0x6C, 0x29, 0xF0, 0x83, 0x1B, 
+0x74, 0xFC, 0x6B, 0x89, 0x4B, 0x03, 0xD8, 0x5C, 
+0x70, 0xD8, 0x94, 0x08, 0x23, 0x85, 0x1F, 0x95, 
+0xF2, 0x1B, 0x49, 0x43, 0xF3, 0xB9, 0xC6, 0xD8, 
+0x51, 0xAE, 0x24, 0xCC, 0x81, 0x76, 0xD9, 0x3B, 
+0x08, 0xD1, 0x04, 0x7F, 
+
+0x1F, 0xDE, 0xD4, 0x91, 0xF3, 0x71, 0xD5, 0x6E, 
+0x03, 0x07, 0x55, 0x1A, 0x8F, 0x22, 0x68, 0x60, 
+0xD3, 0x42, 0x71, 0x21, 0xDB, 0xC2, 0xD7, 0x95, 
+0xFD, 0x56, 0x2E, 0x56, 0x12, 0x87, 0x0B, 0xF1, 
+0x67, 0x94, 0x44, 0xDE, 0xD7, 0x49, 0xCF, 0x51, 
+0xDF, 0xF8, 0x7E, 0x9D, 0x15, 
+
+0xB2, 0x2C, 0x65, 0xC1, 0x0B, 0xA5, 0xD4, 0x8F, 
+0xF3, 0x82, 0xE2, 0x6A, 0x5A, 0x62, 0x12, 0x0D, 
+0x54, 0x97, 0x2F, 0x13, 0x9D, 0xBA, 0xC2, 0xBA, 
+0xD5, 0x10, 0xE1, 0x4D, 0xF9, 0x21, 0x7A, 0x6B, 
+0xBC, 0x82, 0xBC, 0x97, 0x76, 0x67, 0x25, 0xF9, 
+0xBB, 0x28, 0xAB, 0xE6, 0xD6, 0x4B, 
+
+0x1A, 0x41, 0xDD, 0xD9, 0x5E, 0x76, 0xC0, 0x07, 
+0xED, 0x76, 0x1E, 0x36, 0x46, 0x98, 0xE8, 0x84, 
+0xD0, 0x63, 0x34, 0x6C, 0xBF, 0x2F, 0xBB, 0x58, 
+0x34, 0xB1, 0x8C, 0xEB, 0x58, 0xE8, 0x3C, 0x36, 
+0xF0, 0x83, 0x59, 0xD3, 0x32, 0xC4, 0xC7, 0xC2, 
+0x47, 0x05, 0xFA, 0x2D, 0x72, 0x0E, 0x3C, 
+
+0x30, 0xF2, 0xD1, 0x34, 0xD8, 0x6A, 0x6E, 0x7B, 
+0x8C, 0x80, 0xDE, 0xB4, 0x46, 0x0C, 0x16, 0x5F, 
+0xF2, 0x47, 0x16, 0x60, 0x14, 0x26, 0x26, 0xF5, 
+0xA7, 0x31, 0x20, 0x93, 0x50, 0x0A, 0x1D, 0x61, 
+0xDC, 0xF0, 0x1A, 0x64, 0x91, 0x2E, 0x15, 0xBA, 
+0x6A, 0xB3, 0x2A, 0x51, 0x15, 0x30, 0x48, 0xFB, 
+
+0xA7, 0xA9, 0xEF, 0x8F, 0xDF, 0xF6, 0x72, 0x13, 
+0xBB, 0x49, 0x51, 0xCB, 0xAB, 0xF6, 0x7E, 0x9A, 
+0x12, 0x6D, 0xE1, 0xC9, 0x76, 0xA5, 0x1D, 0x38, 
+0xBE, 0x16, 0xA2, 0x80, 0x31, 0xC4, 0xED, 0x40, 
+0x47, 0xC7, 0x7F, 0x4E, 0xFB, 0x42, 0x8F, 0xD2, 
+0x3E, 0x22, 0x21, 0xBF, 0x0D, 0x05, 0x9B, 0xF0, 
+0xD5, 
+
+0x6D, 0xEE, 0x4F, 0x5D, 0x70, 0xEF, 0x6C, 0x88, 
+0x8D, 0x5D, 0x77, 0x4F, 0x54, 0x4F, 0xED, 0xB1, 
+0x6F, 0x9F, 0x5C, 0xFD, 0x7E, 0xEF, 0x1D, 0xDF, 
+0x52, 0xA0, 0x57, 0xA6, 0x40, 0x99, 0x61, 0x99, 
+0x11, 0x96, 0xEE, 0x47, 0x69, 0x0F, 0x66, 0x94, 
+0x31, 0x96, 0x60, 0x01, 0x70, 0x95, 0x3F, 0x33, 
+0xD3, 0x48, 
+
+0x89, 0x41, 0x93, 0x90, 0x3D, 0x12, 0xF1, 0xD3, 
+0xE8, 0xFB, 0xE7, 0x8F, 0xC8, 0xF7, 0x88, 0x33, 
+0x87, 0x30, 0x5F, 0x7B, 0x75, 0x8A, 0x33, 0x41, 
+0xEB, 0xF1, 0xDD, 0xD6, 0xB7, 0x4B, 0xA9, 0x1E, 
+0xB3, 0x87, 0xA7, 0x29, 0x55, 0xC1, 0x58, 0xB8, 
+0x0F, 0x7F, 0x85, 0x51, 0x61, 0x5E, 0x3E, 0xCB, 
+0x42, 0xEF, 0xC2, 
+
+0x0D, 0x96, 0x37, 0x1B, 0x11, 0xDF, 0x9F, 0x38, 
+0xCE, 0x59, 0x98, 0x31, 0xB3, 0x21, 0x53, 0x59, 
+0xEC, 0x6D, 0x96, 0x44, 0x7C, 0x86, 0x2C, 0xB9, 
+0x66, 0xC8, 0xE4, 0xBB, 0x45, 0x30, 0x4E, 0x46, 
+0x1A, 0x0B, 0xFA, 0x10, 0x16, 0xE0, 0xA8, 0x62, 
+0x0E, 0xF3, 0xA2, 0x35, 0xEA, 0x00, 0x3E, 0xF3, 
+0x5B, 0x32, 0x9A, 0xC0, 
+
+0xD6, 0x4A, 0x63, 0xFA, 0xAD, 0x1C, 0xD2, 0x8B, 
+0x9D, 0x8E, 0x13, 0xEC, 0x4A, 0x64, 0x31, 0xE0, 
+0x10, 0xDC, 0xDB, 0x6D, 0x9B, 0x5C, 0x0A, 0xC6, 
+0x26, 0x7A, 0xDB, 0x94, 0x85, 0xAC, 0xAA, 0xD9, 
+0x71, 0x36, 0x1B, 0x68, 0x8F, 0xFB, 0x49, 0x54, 
+0x38, 0x50, 0xC9, 0x8B, 0xB2, 0x34, 0x81, 0x96, 
+0x27, 0xCC, 0xA1, 0xB2, 0xFC, 
+
+0x41, 0x9D, 0x13, 0xDA, 0x79, 0xCA, 0xC8, 0xF4, 
+0x0D, 0x28, 0x99, 0x1C, 0x20, 0xD1, 0xCE, 0xEE, 
+0x95, 0xB9, 0xFE, 0x26, 0x0E, 0xEA, 0xFD, 0x3F, 
+0x03, 0xDB, 0x51, 0xF9, 0x07, 0x92, 0xD5, 0x4F, 
+0x5A, 0xDB, 0x3D, 0x54, 0x09, 0x7D, 0x15, 0x41, 
+0xCD, 0xA3, 0xC6, 0x29, 0xB0, 0xB5, 0xDE, 0xD3, 
+0x25, 0xEC, 0x75, 0x23, 0x78, 0x2A, 
+
+0xCF, 0x76, 0xCB, 0x3E, 0xC7, 0xA5, 0xE3, 0x78, 
+0x48, 0xD3, 0xD8, 0xE6, 0x9D, 0xAD, 0xD7, 0x3F, 
+0x43, 0x09, 0x30, 0xAA, 0xF5, 0xA1, 0x8D, 0x05, 
+0x03, 0x9A, 0x83, 0x5F, 0x32, 0x1C, 0x71, 0x94, 
+0xD2, 0xF7, 0x1D, 0x34, 0x2E, 0x7D, 0x68, 0x26, 
+0xB7, 0x93, 0xC4, 0x5D, 0xD8, 0x84, 0xAD, 0x97, 
+0xAB, 0x4E, 0xED, 0xF6, 0xC5, 0x38, 0xE9, 
+
+0xA3, 0x1A, 0xA1, 0xFA, 0x6D, 0xEE, 0x63, 0xCF, 
+0x16, 0xC7, 0x39, 0xFE, 0x1E, 0x9D, 0xB2, 0xF1, 
+0xB9, 0x63, 0x6B, 0x71, 0x59, 0xA9, 0x47, 0xCB, 
+0xC9, 0xB5, 0x41, 0xB7, 0xE2, 0xF8, 0x52, 0x0B, 
+0x8E, 0x27, 0xA0, 0xE8, 0x98, 0x44, 0x21, 0x8A, 
+0x51, 0x64, 0x5E, 0x93, 0x93, 0xF7, 0x0F, 0x19, 
+0x49, 0x3F, 0xF2, 0xDF, 0x9D, 0xB5, 0xB5, 0xE7, 
+
+0x30, 0x54, 0x27, 0x39, 0x22, 0x41, 0xD6, 0x24, 
+0x7B, 0xF6, 0x28, 0xD5, 0x2E, 0x9A, 0x5D, 0x83, 
+0xD6, 0x21, 0xF4, 0x3C, 0x1B, 0x0D, 0xFD, 0xFB, 
+0x16, 0x6A, 0x88, 0x84, 0xD9, 0xAE, 0xB2, 0xF5, 
+0x18, 0x50, 0x41, 0x7C, 0x07, 0x95, 0xE1, 0xA0, 
+0x9E, 0x95, 0x33, 0xA6, 0xED, 0x5A, 0xEC, 0x1F, 
+0xF7, 0xE3, 0x85, 0x5B, 0x1E, 0xD2, 0xC6, 0xAA, 
+0x6D, 
+
+0x6A, 0xBA, 0x19, 0x79, 0x36, 0x5C, 0x88, 0x29, 
+0xC6, 0xC5, 0x73, 0xDA, 0xF1, 0xFD, 0xCA, 0xFE, 
+0x88, 0x74, 0xA6, 0x51, 0x43, 0xAE, 0x86, 0xE1, 
+0x15, 0x1A, 0x8C, 0x25, 0x31, 0xF1, 0x39, 0x47, 
+0x2E, 0xFB, 0xAC, 0x4A, 0x64, 0xAE, 0xA7, 0x99, 
+0x41, 0x1C, 0x1A, 0x5D, 0x1B, 0x21, 0xE5, 0xC1, 
+0xAF, 0x1B, 0x7C, 0x4C, 0x7D, 0xFF, 0x82, 0x13, 
+0x97, 0x97, 
+
+0xAD, 0x52, 0x98, 0xB0, 0xEC, 0xD4, 0xB0, 0x64, 
+0x54, 0x25, 0x0F, 0x9F, 0x83, 0xAA, 0x24, 0x5F, 
+0x52, 0x8A, 0xD3, 0x7A, 0xAF, 0x69, 0x4C, 0xA4, 
+0x0C, 0x62, 0xFE, 0xB6, 0x6C, 0x25, 0xCB, 0xEB, 
+0xF7, 0x1C, 0x50, 0x2D, 0x0D, 0xC7, 0xCD, 0xCE, 
+0x1A, 0xDC, 0x11, 0xF2, 0xCD, 0x05, 0xE6, 0xF8, 
+0x2A, 0x4E, 0xB5, 0xC8, 0x76, 0xD9, 0xF0, 0xDA, 
+0x0D, 0x6A, 0x12, 
+
+0x1C, 0x36, 0x7D, 0xDF, 0xCA, 0xD9, 0x8C, 0xE1, 
+0xC9, 0xDF, 0x95, 0xFE, 0x63, 0x2D, 0xC4, 0xB1, 
+0x5B, 0xE2, 0x4A, 0xC1, 0x02, 0xDB, 0x78, 0x12, 
+0xB9, 0xE8, 0x6C, 0x44, 0x92, 0x99, 0x1A, 0x10, 
+0xF7, 0xC8, 0xE0, 0x99, 0xC5, 0xFE, 0xC4, 0x9A, 
+0x7F, 0x44, 0xD7, 0xAE, 0xE1, 0x5D, 0x05, 0x54, 
+0xC9, 0x82, 0x14, 0x90, 0xA2, 0x72, 0xA6, 0xB5, 
+0x55, 0x76, 0x40, 0x7F, 
+
+0x05, 0x27, 0x3B, 0x77, 0xEB, 0xB3, 0x36, 0xB7, 
+0xAD, 0xA2, 0x76, 0x28, 0x4D, 0x28, 0x8D, 0x50, 
+0xFC, 0x9E, 0xA7, 0xEE, 0xC6, 0x51, 0x8C, 0xC9, 
+0xA8, 0x61, 0x00, 0x9D, 0xA7, 0xC4, 0x2A, 0x0F, 
+0x08, 0xF8, 0x35, 0x9B, 0xF4, 0xF5, 0x7B, 0x2F, 
+0xCB, 0x93, 0x73, 0xB6, 0xFD, 0x56, 0x17, 0xE6, 
+0xDE, 0x5B, 0x98, 0x88, 0x64, 0x29, 0x32, 0xB4, 
+0xD4, 0x69, 0x17, 0x86, 0x3E, 
+
+0x7E, 0x99, 0x43, 0x91, 0x2E, 0xC4, 0xEA, 0xA5, 
+0x2B, 0x95, 0x6E, 0xAC, 0x4B, 0x82, 0xDD, 0xA3, 
+0x6E, 0x9C, 0xBB, 0x72, 0x3C, 0xE6, 0xB6, 0x9C, 
+0x48, 0x10, 0xF7, 0x9D, 0xA4, 0x4E, 0x18, 0x97, 
+0x70, 0xB7, 0x7C, 0x87, 0xF6, 0xDB, 0x20, 0x71, 
+0x27, 0x0B, 0xDF, 0xDE, 0x2E, 0x67, 0x16, 0xD5, 
+0x9F, 0x24, 0xD3, 0x52, 0x2F, 0xFC, 0xAC, 0x2C, 
+0xE8, 0x55, 0x2E, 0xB2, 0x53, 0xA8, 
+
+0xA9, 0x90, 0xC1, 0x49, 0xE7, 0xC8, 0x3E, 0xE7, 
+0xB6, 0xA0, 0xEE, 0xC0, 0x83, 0x73, 0x00, 0x01, 
+0x2F, 0x22, 0x81, 0x11, 0xDA, 0xD5, 0x32, 0x3B, 
+0xE1, 0x2C, 0x1B, 0xAE, 0x9E, 0xDE, 0x53, 0xCC, 
+0x1E, 0xEA, 0xAE, 0x8A, 0xC9, 0xD2, 0x0D, 0xB2, 
+0x64, 0x98, 0x63, 0x69, 0x09, 0xC3, 0x32, 0x1B, 
+0x2C, 0x95, 0xFA, 0x19, 0xBC, 0x56, 0x5E, 0xFF, 
+0x53, 0x8C, 0x33, 0xD7, 0xAF, 0x8D, 0x8B, 
+
+0x71, 0xBE, 0x87, 0x29, 0x32, 0xB5, 0x5F, 0x44, 
+0x40, 0x69, 0xF2, 0x71, 0x0F, 0x2A, 0xEF, 0x7D, 
+0x74, 0xA5, 0x9A, 0xF5, 0x12, 0x2A, 0xE1, 0xCF, 
+0x5E, 0xE3, 0x49, 0x23, 0x4A, 0x53, 0x6C, 0x25, 
+0xE7, 0x15, 0x89, 0x0D, 0x0F, 0x46, 0x7C, 0x5C, 
+0x4C, 0xAC, 0x45, 0x0B, 0x8C, 0x23, 0x2D, 0x86, 
+0xD8, 0x2D, 0x7B, 0x9C, 0xAF, 0xDB, 0x61, 0xCE, 
+0x02, 0xEB, 0xF0, 0x92, 0x71, 0x38, 0xB1, 0x76, 
+
+0x38, 0xB6, 0xE0, 0x37, 0x69, 0x4E, 0x98, 0xD9, 
+0x9E, 0xEE, 0xDD, 0x7C, 0xC5, 0xDD, 0xBB, 0xED, 
+0x54, 0x61, 0x1D, 0xA6, 0x39, 0xCC, 0x17, 0xED, 
+0xB4, 0x55, 0x0E, 0xAB, 0x88, 0x0B, 0x07, 0xF4, 
+0x34, 0xC0, 0x59, 0xA7, 0x51, 0xFC, 0x03, 0x5E, 
+0xF0, 0x08, 0xB7, 0x91, 0xBB, 0x60, 0xF1, 0x10, 
+0x9E, 0x73, 0x66, 0x83, 0x70, 0x6B, 0x56, 0xF9, 
+0x6D, 0xC6, 0x4C, 0xB9, 0xA4, 0xD9, 0x5E, 0xFE, 
+0x3F, 
+
+0x92, 0xA4, 0xD9, 0x0E, 0x97, 0x6C, 0x7A, 0xBB, 
+0x67, 0xFC, 0xEC, 0x49, 0xCD, 0x3C, 0xE7, 0x65, 
+0x86, 0x8F, 0x78, 0x08, 0x54, 0xED, 0x40, 0x62, 
+0x88, 0x74, 0x21, 0x5B, 0x4E, 0xE7, 0xEB, 0xEA, 
+0xD8, 0x71, 0xA2, 0xE8, 0xCE, 0xDD, 0x86, 0x29, 
+0x30, 0x5E, 0xC5, 0x5B, 0x99, 0xB3, 0x79, 0x61, 
+0x94, 0xEE, 0x93, 0x67, 0x83, 0x2B, 0x8A, 0xE8, 
+0x9A, 0xEF, 0xA6, 0x6E, 0x74, 0xAA, 0x88, 0x8F, 
+0xBB, 0xC2, 
+
+0xF4, 0xF3, 0xED, 0x96, 0x76, 0xB8, 0xCF, 0xC7, 
+0x20, 0xAF, 0x0E, 0xDC, 0x86, 0x02, 0x24, 0x67, 
+0x5A, 0x34, 0x03, 0xCD, 0x6C, 0x68, 0x48, 0xE7, 
+0xCE, 0x74, 0x2E, 0x40, 0x87, 0x2D, 0x4B, 0x5E, 
+0x37, 0x48, 0x6A, 0x58, 0xCF, 0xB7, 0xD8, 0xA5, 
+0x56, 0xC5, 0x5A, 0x45, 0xE9, 0xA0, 0x07, 0xD7, 
+0x71, 0x80, 0x04, 0x1F, 0xE9, 0x8B, 0x6E, 0x0B, 
+0x10, 0x56, 0x73, 0x6C, 0xC9, 0x11, 0x7C, 0xDA, 
+0x1C, 0x59, 0xAB, 
+
+0x40, 0x5D, 0x11, 0x39, 0xDC, 0xD0, 0x8D, 0xB3, 
+0x22, 0xAE, 0xFD, 0x30, 0x73, 0xAC, 0x4B, 0x76, 
+0x41, 0x76, 0x34, 0x47, 0x2D, 0x17, 0x00, 0x42, 
+0x27, 0x43, 0xFE, 0x5C, 0xE9, 0x26, 0x20, 0x84, 
+0xF7, 0xDF, 0x2E, 0xB9, 0x9D, 0xF9, 0x1C, 0x63, 
+0xC3, 0xAF, 0x6D, 0x7A, 0x9B, 0x7B, 0x99, 0x12, 
+0x0B, 0xE9, 0x54, 0x8B, 0x66, 0x7D, 0x64, 0x15, 
+0x6A, 0x38, 0x05, 0x8A, 0xAA, 0xD1, 0xD5, 0xB2, 
+0xCF, 0xF5, 0x0F, 0x13, 
+
+0x37, 0x0F, 0x69, 0xE2, 0xDE, 0xAA, 0xEC, 0xC7, 
+0x8B, 0x98, 0x5D, 0x6A, 0xF0, 0x82, 0xC1, 0x1F, 
+0xA5, 0xCA, 0x5A, 0xB4, 0xD3, 0x3C, 0x25, 0x57, 
+0x31, 0xBF, 0xAD, 0x1E, 0x40, 0x6A, 0xDA, 0x41, 
+0xF4, 0xFF, 0x50, 0x92, 0xF9, 0x9B, 0x8B, 0x37, 
+0x21, 0x9B, 0x14, 0x54, 0xD7, 0xA7, 0x23, 0x18, 
+0x3A, 0x93, 0xF0, 0xEA, 0x5F, 0xEB, 0xF4, 0x9A, 
+0xBC, 0xE0, 0x18, 0x91, 0xCE, 0x92, 0x70, 0xE0, 
+0x8C, 0x22, 0x6C, 0x02, 0xA9, 
+
+0xF1, 0xCD, 0xDE, 0x0A, 0x75, 0x68, 0x4A, 0x12, 
+0x3E, 0xF4, 0x46, 0xEA, 0x36, 0x78, 0x2F, 0xAC, 
+0x7B, 0x32, 0x89, 0x97, 0xD3, 0x08, 0xE4, 0x4E, 
+0x73, 0x78, 0xA8, 0x55, 0x70, 0xBD, 0x0C, 0xE3, 
+0xD3, 0x68, 0xAE, 0xE8, 0xC4, 0x7E, 0x9A, 0x7A, 
+0x19, 0x3A, 0x50, 0x7B, 0x00, 0x9A, 0xEE, 0x1D, 
+0x72, 0x47, 0x2C, 0x67, 0x91, 0x08, 0x70, 0x40, 
+0xE9, 0x7D, 0x2D, 0x1B, 0xBF, 0x2D, 0xB1, 0xD9, 
+0x7F, 0xF6, 0x6A, 0xC8, 0x47, 0x2E, 
+
+0x26, 0xC3, 0x00, 0x82, 0xAA, 0x41, 0x32, 0x6C, 
+0x8A, 0xDD, 0xF4, 0xD3, 0x47, 0x5C, 0xE5, 0x5A, 
+0x1F, 0x33, 0x6F, 0x66, 0xEF, 0x27, 0x7E, 0x5B, 
+0xEF, 0xE0, 0x26, 0x2E, 0x11, 0xD2, 0xE4, 0xFB, 
+0x2E, 0x34, 0x66, 0x58, 0xC3, 0x01, 0x95, 0x4F, 
+0xE2, 0x82, 0x93, 0x4A, 0x1E, 0x83, 0x63, 0x6B, 
+0xC2, 0x2B, 0x38, 0x90, 0x1F, 0x89, 0x63, 0xFA, 
+0x49, 0x9F, 0xAA, 0xC0, 0x2A, 0x13, 0xAD, 0xD6, 
+0xE2, 0x19, 0x2C, 0x7F, 0x98, 0xEC, 0x46, 
+
+0xA5, 0x21, 0x2D, 0x28, 0xC7, 0x1E, 0xA1, 0x2B, 
+0x75, 0x41, 0xA5, 0xF2, 0x1E, 0x4D, 0xE1, 0x67, 
+0x4E, 0xE7, 0x16, 0x33, 0xC8, 0xAE, 0xBC, 0x50, 
+0x1A, 0x11, 0x54, 0x09, 0x81, 0x2F, 0xED, 0x36, 
+0x9C, 0x82, 0xE6, 0xE0, 0xFD, 0xB7, 0x6D, 0x39, 
+0x59, 0x95, 0xC0, 0x40, 0x24, 0xA5, 0x64, 0x67, 
+0x1F, 0x3B, 0x35, 0xB1, 0x95, 0x06, 0xC2, 0x5C, 
+0xDC, 0xD1, 0xD7, 0x23, 0x91, 0xDA, 0x18, 0xD5, 
+0x62, 0xB0, 0xC8, 0xF6, 0x42, 0xB3, 0xE3, 0xDA, 
+
+0x03, 0xAA, 0x7A, 0xC4, 0x62, 0x38, 0xB4, 0x9D, 
+0xEA, 0x0B, 0x6A, 0x1F, 0xD8, 0xA3, 0x8D, 0xCD, 
+0xB2, 0x1F, 0xE7, 0xB5, 0xDA, 0x63, 0x76, 0x1D, 
+0xB7, 0x2F, 0xE3, 0xB4, 0x5E, 0x75, 0xB4, 0xB0, 
+0xCD, 0x68, 0x8D, 0x27, 0x0C, 0x6F, 0x11, 0xDA, 
+0x45, 0x12, 0xFE, 0x22, 0x75, 0xC4, 0xC3, 0xF7, 
+0x4C, 0x87, 0x2D, 0x84, 0x12, 0xEC, 0x37, 0x35, 
+0x83, 0xB5, 0x0E, 0xA4, 0xC6, 0x9B, 0xEC, 0x94, 
+0x6C, 0xD9, 0xFE, 0xB2, 0x22, 0x72, 0x40, 0xBE, 
+0xEC, 
+
+0xB3, 0x5E, 0x70, 0x7A, 0x09, 0x2E, 0x9B, 0xA8, 
+0xD3, 0xF0, 0x9E, 0xAA, 0x77, 0xCC, 0x44, 0xF1, 
+0xD2, 0x57, 0xDD, 0x29, 0x70, 0xA5, 0x81, 0x76, 
+0x9C, 0x9F, 0x3C, 0x2B, 0x3D, 0x9D, 0x00, 0x87, 
+0x6B, 0x99, 0x9A, 0x92, 0xE6, 0x1D, 0x77, 0xCF, 
+0x7F, 0x0A, 0xB5, 0xC4, 0xCC, 0x02, 0xB4, 0xA7, 
+0x36, 0xF3, 0x1C, 0xE6, 0x25, 0x40, 0x83, 0x55, 
+0xD1, 0x74, 0xEB, 0x89, 0x80, 0x13, 0x06, 0x48, 
+0x5D, 0x7A, 0xCA, 0x5F, 0xA5, 0xEC, 0x8C, 0x3D, 
+0x05, 0x7E, 
+
+0xE2, 0x84, 0xC7, 0x86, 0x2E, 0x18, 0x5A, 0x99, 
+0xD8, 0x0E, 0x81, 0x82, 0xB0, 0x68, 0xDC, 0xA6, 
+0xBE, 0xC5, 0x13, 0xCA, 0xC1, 0xF6, 0xF6, 0x37, 
+0x94, 0x29, 0x5A, 0x04, 0x90, 0x71, 0x37, 0x90, 
+0xC3, 0xEE, 0x57, 0x38, 0x0C, 0x67, 0x37, 0x3A, 
+0xB4, 0x57, 0x69, 0xC9, 0x6D, 0x23, 0x3B, 0xD7, 
+0x77, 0x96, 0x2F, 0x51, 0xFE, 0x40, 0xB6, 0xBB, 
+0x59, 0x94, 0xF2, 0xFA, 0x0E, 0xA3, 0xBF, 0x5A, 
+0x70, 0xDE, 0x15, 0xD1, 0x70, 0x2D, 0xF0, 0x92, 
+0xFA, 0x4A, 0x7B, 
+
+0x7A, 0xAA, 0xD9, 0x56, 0x2B, 0xAF, 0x31, 0xEF, 
+0xEE, 0x83, 0xEE, 0xD7, 0x68, 0x1C, 0x09, 0x82, 
+0xBB, 0xC9, 0x01, 0x8B, 0x9E, 0x23, 0xF0, 0x2D, 
+0xDE, 0x88, 0x68, 0x44, 0xDD, 0xD4, 0xCF, 0x57, 
+0x50, 0x07, 0xF1, 0xC2, 0x21, 0x50, 0x08, 0x91, 
+0x59, 0xAA, 0x00, 0x9F, 0x6B, 0x2E, 0xDB, 0xDE, 
+0x32, 0x44, 0x4D, 0x14, 0xF3, 0x05, 0xBB, 0x0C, 
+0x21, 0x9B, 0x5E, 0xBE, 0x91, 0x29, 0x8D, 0xC0, 
+0x42, 0xE9, 0x70, 0xED, 0x3C, 0x6A, 0xF0, 0x3B, 
+0x10, 0xC2, 0x99, 0xAD, 
+
+0x09, 0x53, 0x23, 0x25, 0x9F, 0x2C, 0xD5, 0xC4, 
+0x0B, 0x02, 0xB8, 0xE1, 0xC7, 0x94, 0x80, 0x6F, 
+0xC2, 0x3F, 0x61, 0xDE, 0x0C, 0x14, 0xEE, 0x11, 
+0xC4, 0x83, 0x4F, 0x95, 0x3C, 0x77, 0xF8, 0xCF, 
+0xB8, 0x96, 0x06, 0xD2, 0xB6, 0xE8, 0xD0, 0xA8, 
+0x75, 0xCD, 0x5C, 0xA4, 0x5D, 0xD5, 0x72, 0xE4, 
+0x46, 0xFF, 0x18, 0x4B, 0x71, 0xA2, 0xDC, 0x70, 
+0xC0, 0x26, 0xB9, 0xA7, 0x2D, 0x2B, 0x5F, 0x69, 
+0x83, 0x93, 0x2D, 0x93, 0x5F, 0x6D, 0x92, 0xCE, 
+0x06, 0xD1, 0xE5, 0x48, 0xB6, 
+
+0x46, 0x32, 0xB1, 0x6E, 0x75, 0x5A, 0x22, 0x4B, 
+0x1E, 0x4C, 0x65, 0x6C, 0x9F, 0xB2, 0x21, 0xF2, 
+0x93, 0x38, 0x80, 0x0C, 0x54, 0x46, 0xCF, 0x28, 
+0xB0, 0xFD, 0x12, 0x50, 0x52, 0x68, 0xAE, 0x52, 
+0x44, 0x29, 0xDC, 0x57, 0xD7, 0x5A, 0x1B, 0xE5, 
+0xEF, 0x88, 0x50, 0x0B, 0x4B, 0xC1, 0xC6, 0xBF, 
+0xC6, 0xCD, 0x58, 0xFE, 0xB3, 0x10, 0x88, 0x8E, 
+0xF8, 0xC4, 0x08, 0x1F, 0x30, 0x8C, 0x03, 0x28, 
+0xCD, 0xA2, 0xF8, 0xC0, 0xA5, 0x3A, 0xA0, 0x6D, 
+0x40, 0x91, 0xCC, 0x77, 0xB1, 0xF9, 
+
+0x6C, 0xEA, 0xBE, 0xAA, 0xB9, 0x3C, 0xE8, 0x91, 
+0xF7, 0x4A, 0x95, 0x5C, 0x9D, 0xD3, 0xCF, 0xAD, 
+0x47, 0xF9, 0x11, 0xB0, 0x31, 0xCE, 0x89, 0x03, 
+0x9A, 0x31, 0x6F, 0x1E, 0xA8, 0x54, 0x83, 0x7B, 
+0xAD, 0xBD, 0xFB, 0x73, 0x36, 0x4A, 0xCA, 0x7D, 
+0x74, 0xB7, 0x76, 0xAE, 0x74, 0xA3, 0xC6, 0xAB, 
+0x5A, 0xB9, 0xDB, 0x52, 0xD0, 0x51, 0x36, 0xF1, 
+0xF9, 0x25, 0xE4, 0x3F, 0x10, 0xBE, 0xB5, 0x2B, 
+0x04, 0x6E, 0x73, 0x88, 0x1C, 0xD4, 0x22, 0x4A, 
+0x58, 0xC3, 0x25, 0x5B, 0x3A, 0x41, 0xA9, 
+
+0x75, 0x97, 0x38, 0xD4, 0x73, 0xA4, 0xCC, 0xE8, 
+0x7E, 0x34, 0xF1, 0x97, 0x07, 0x6E, 0xF6, 0xE3, 
+0x7B, 0x7A, 0x13, 0xF8, 0xF1, 0xB2, 0x66, 0x86, 
+0x42, 0x53, 0x64, 0x06, 0xD9, 0x3A, 0x3A, 0xFD, 
+0xD6, 0xD5, 0xA7, 0x4E, 0x4B, 0xF4, 0xCC, 0xC2, 
+0xCE, 0xD7, 0xED, 0xD4, 0x25, 0xCE, 0x58, 0xB8, 
+0xF7, 0x3F, 0x25, 0x2D, 0x71, 0x2D, 0x37, 0x80, 
+0x4A, 0x37, 0x30, 0xC5, 0x91, 0x83, 0x23, 0xE4, 
+0x24, 0xE1, 0x1E, 0x4B, 0xDE, 0x8F, 0x75, 0xF9, 
+0x73, 0x7A, 0xD7, 0xE8, 0x03, 0x59, 0x5F, 0x9D, 
+
+0xBC, 0x21, 0x44, 0x01, 0x68, 0x0D, 0xF9, 0x35, 
+0x5D, 0x58, 0xB7, 0x6D, 0xC9, 0x6F, 0x2E, 0x0F, 
+0xF6, 0x2C, 0xAB, 0x7B, 0x01, 0xD6, 0x45, 0xBD, 
+0xB4, 0x13, 0x04, 0x08, 0x5B, 0x3E, 0xD2, 0xCD, 
+0x02, 0x03, 0x77, 0x73, 0xAB, 0x45, 0xFA, 0xD7, 
+0x34, 0x75, 0x61, 0x47, 0xE9, 0x2E, 0x0A, 0x93, 
+0xDE, 0xDA, 0x5F, 0x90, 0xE2, 0x72, 0x9C, 0x38, 
+0x11, 0xE6, 0x5F, 0x49, 0x87, 0x5F, 0x2A, 0xFD, 
+0x10, 0x0D, 0x9E, 0xF9, 0xB6, 0x67, 0x5D, 0x7E, 
+0xA3, 0xF6, 0x59, 0xC1, 0x09, 0xAA, 0x57, 0x19, 
+0x92, 
+
+0xFD, 0xBE, 0xBF, 0x07, 0x70, 0x83, 0x1A, 0xC3, 
+0x67, 0x7E, 0x59, 0x40, 0xCF, 0xFF, 0x8B, 0xBB, 
+0xB7, 0x12, 0x5B, 0xB4, 0xEE, 0xCB, 0x55, 0xAB, 
+0xD6, 0xF1, 0x95, 0xB8, 0xBE, 0x16, 0x26, 0x26, 
+0x69, 0xA0, 0xC4, 0x3F, 0x12, 0xB9, 0x8D, 0x5D, 
+0xC9, 0xF0, 0xB2, 0x31, 0x0F, 0xEE, 0x5D, 0xB8, 
+0x97, 0xE9, 0xAC, 0xAE, 0xD6, 0x46, 0xEC, 0x5D, 
+0x6D, 0x71, 0x73, 0x74, 0x2D, 0xAE, 0xB8, 0xE2, 
+0x62, 0x9D, 0xFB, 0x20, 0xB3, 0xEE, 0xF6, 0xCF, 
+0x54, 0x0F, 0x3F, 0xFD, 0x42, 0x7D, 0x66, 0x72, 
+0x4D, 0xE1, 
+
+0xF5, 0x5A, 0xFD, 0xAA, 0xD8, 0x29, 0xFA, 0x81, 
+0x4C, 0x16, 0x19, 0x86, 0x37, 0x37, 0x6B, 0x86, 
+0xE2, 0xC6, 0xAF, 0x33, 0x5F, 0x31, 0xF2, 0xDF, 
+0xC2, 0xA6, 0xFA, 0x10, 0x8B, 0xA4, 0x0C, 0x93, 
+0x22, 0xC9, 0x0D, 0xFC, 0x77, 0x04, 0x68, 0x3E, 
+0x47, 0xA2, 0xEE, 0x5F, 0x2F, 0x29, 0x51, 0x84, 
+0x77, 0x6B, 0x7F, 0xCA, 0x88, 0x80, 0x3C, 0x5D, 
+0xD7, 0x03, 0xA6, 0x6E, 0x59, 0x78, 0xC6, 0x85, 
+0x04, 0xB4, 0xD1, 0x4C, 0x38, 0xC7, 0xCB, 0xE5, 
+0x71, 0x91, 0xB6, 0x32, 0xF7, 0xB0, 0xBD, 0x53, 
+0x2A, 0xBE, 0xFC, 
+
+0x55, 0xC2, 0x1B, 0x13, 0xA9, 0xEE, 0x63, 0xD1, 
+0x0D, 0x03, 0x39, 0xE8, 0x06, 0x87, 0x6B, 0x40, 
+0xC8, 0x51, 0xD6, 0x2A, 0x35, 0x1E, 0x8A, 0xF1, 
+0x15, 0xC7, 0xCF, 0x1A, 0x4B, 0x33, 0x09, 0xD9, 
+0x3E, 0x82, 0xF3, 0xAB, 0xA7, 0x54, 0x0E, 0x3D, 
+0x15, 0x2C, 0xFF, 0x57, 0x7A, 0x41, 0xFE, 0x17, 
+0x2F, 0x41, 0x1C, 0xA1, 0x40, 0xCF, 0xAA, 0x02, 
+0x65, 0xEB, 0x5F, 0xD7, 0xBF, 0x16, 0x09, 0xB8, 
+0x7B, 0xAC, 0x23, 0x3C, 0x03, 0xC5, 0xA2, 0x19, 
+0x3F, 0x47, 0xF4, 0x12, 0x3D, 0xB7, 0xE1, 0xAB, 
+0xBD, 0x02, 0xE7, 0xF3, 
+
+0x7E, 0xF2, 0xFD, 0xF4, 0x8C, 0x8C, 0xAB, 0x83, 
+0x30, 0x02, 0x0D, 0x8A, 0x85, 0xCB, 0x85, 0x7B, 
+0x20, 0x58, 0x42, 0x4A, 0x71, 0x72, 0x97, 0x1E, 
+0xA1, 0x12, 0x76, 0x65, 0x43, 0x23, 0x74, 0x31, 
+0xF3, 0x81, 0xA2, 0x5E, 0xCB, 0x6F, 0xCF, 0xC1, 
+0x7A, 0x30, 0x9A, 0x71, 0xB0, 0x0C, 0x4D, 0xDD, 
+0xE5, 0x88, 0x3A, 0x3F, 0xA5, 0xE5, 0x32, 0xE4, 
+0x9E, 0x40, 0x3D, 0x33, 0x74, 0xB8, 0x16, 0x36, 
+0x5F, 0xD0, 0x85, 0x68, 0x8F, 0xC0, 0x98, 0x17, 
+0xCC, 0x02, 0x6F, 0x40, 0x35, 0x18, 0x8C, 0x95, 
+0x82, 0x2F, 0xDA, 0x3B, 0x64, 
+
+0x31, 0xF0, 0x74, 0xD4, 0xC7, 0x22, 0x2B, 0x66, 
+0xF7, 0x22, 0xAF, 0x73, 0xBB, 0x60, 0xE1, 0x19, 
+0xC7, 0x8F, 0x47, 0x5B, 0xA7, 0x0A, 0x2D, 0xBB, 
+0x2B, 0xA5, 0x4F, 0x24, 0xD6, 0xEF, 0xFF, 0x02, 
+0x2D, 0xD5, 0x5F, 0xBD, 0xFD, 0x57, 0xE2, 0x1E, 
+0x41, 0x2B, 0x46, 0x55, 0x1D, 0x5A, 0xC9, 0xFC, 
+0xA1, 0xBE, 0xE5, 0xE4, 0xFB, 0x42, 0x36, 0x37, 
+0x26, 0x83, 0xF1, 0x0A, 0x07, 0xB1, 0x66, 0x13, 
+0xCE, 0xE4, 0xAE, 0xB1, 0x38, 0x1E, 0x4D, 0x00, 
+0xA3, 0x17, 0x2D, 0xBC, 0xB7, 0x1C, 0x53, 0x8D, 
+0x75, 0x9C, 0x5E, 0x75, 0x62, 0x4F, 
+
+0xEC, 0x36, 0x4C, 0x85, 0x6E, 0x36, 0x48, 0x25, 
+0xAD, 0x51, 0xFF, 0x4E, 0x9F, 0xEE, 0x5A, 0x07, 
+0xEC, 0x72, 0xE7, 0x95, 0x16, 0xCC, 0x7C, 0x36, 
+0xC2, 0x52, 0x72, 0xCC, 0x5A, 0xC5, 0x8A, 0x50, 
+0xB5, 0x4C, 0xC1, 0xF1, 0xFB, 0xBE, 0xF6, 0x42, 
+0xCC, 0x8A, 0x96, 0x26, 0x2E, 0x35, 0x55, 0xF1, 
+0xA7, 0x76, 0xD1, 0x60, 0xD7, 0x56, 0x78, 0x47, 
+0xD6, 0x43, 0xBA, 0x91, 0x9F, 0x39, 0x74, 0xCF, 
+0x91, 0x47, 0xFA, 0x0A, 0xFE, 0x81, 0xE6, 0xB4, 
+0x3F, 0xC2, 0x86, 0xC1, 0x6C, 0xC5, 0x68, 0x60, 
+0x7D, 0x6B, 0x6A, 0x8E, 0xA4, 0x76, 0x8B, 
+
+0xBB, 0x90, 0xB8, 0x50, 0x5E, 0x66, 0x8D, 0x1B, 
+0x18, 0x21, 0xB4, 0xC4, 0x2C, 0x40, 0x26, 0xF2, 
+0x1D, 0x95, 0xEB, 0x4B, 0x7F, 0x8D, 0xC8, 0x32, 
+0xDB, 0xEA, 0x56, 0xB0, 0x54, 0x9C, 0xE9, 0x79, 
+0x39, 0x68, 0x68, 0x6D, 0x75, 0x93, 0x79, 0xF2, 
+0x8B, 0xBF, 0x22, 0xF9, 0x0A, 0xAC, 0xA6, 0xA9, 
+0x8C, 0x99, 0xDB, 0xAF, 0x63, 0x92, 0xD9, 0x58, 
+0x0C, 0xD4, 0x25, 0xD7, 0x50, 0x91, 0x56, 0x2D, 
+0x08, 0x71, 0x7C, 0x74, 0xEF, 0x56, 0x54, 0x01, 
+0x1F, 0xCB, 0x7C, 0x35, 0x6D, 0xFA, 0x6F, 0xF4, 
+0x78, 0x59, 0xE0, 0xA4, 0x60, 0x2F, 0x6B, 0xAE, 
+
+0xE5, 0xA0, 0x83, 0x79, 0x58, 0x77, 0xAC, 0x98, 
+0x8D, 0x9C, 0x44, 0xB2, 0x34, 0x8B, 0x90, 0x33, 
+0x18, 0x34, 0xF3, 0x68, 0x29, 0xFC, 0x28, 0xAB, 
+0x28, 0x92, 0x99, 0x04, 0xB0, 0x40, 0x1B, 0x6D, 
+0x41, 0xA6, 0xB9, 0x8E, 0x3E, 0xC7, 0x3B, 0xB1, 
+0xF8, 0x34, 0xE6, 0x38, 0xFB, 0x7B, 0x2D, 0x8B, 
+0xB3, 0xAA, 0x1A, 0xCD, 0xFB, 0xEF, 0x4E, 0x0B, 
+0xB4, 0xAE, 0x3A, 0x9F, 0x0E, 0xAE, 0x98, 0x86, 
+0x90, 0xA1, 0x60, 0xC4, 0x21, 0xC8, 0x80, 0x68, 
+0xB7, 0x5F, 0x1D, 0xFA, 0xB3, 0xFB, 0x15, 0x81, 
+0x8A, 0xD8, 0x94, 0x4F, 0x1B, 0x3E, 0x52, 0x0C, 
+0x3E, 
+
+0xF6, 0xEC, 0xE2, 0xC2, 0xDE, 0x57, 0x4E, 0x6A, 
+0x49, 0x50, 0xFB, 0xAF, 0x9F, 0x8F, 0x6B, 0xF3, 
+0xA1, 0x75, 0x3A, 0xB7, 0x88, 0xD0, 0x47, 0x2D, 
+0x5B, 0xFF, 0x65, 0xE1, 0x3C, 0x5A, 0x5D, 0xF6, 
+0x7C, 0x6F, 0xF3, 0xF1, 0x49, 0xDC, 0xB9, 0xC1, 
+0x94, 0x80, 0x8A, 0xD2, 0xEE, 0x87, 0xD5, 0x13, 
+0x28, 0x1A, 0xA0, 0x0C, 0x9A, 0xBE, 0x3D, 0x64, 
+0x3E, 0xFF, 0x3B, 0x94, 0x8B, 0xC6, 0xC2, 0xEE, 
+0xAB, 0xA0, 0x9D, 0xF5, 0x0D, 0xE3, 0xC2, 0x73, 
+0xB6, 0x72, 0xAF, 0x0A, 0xBD, 0x17, 0x20, 0x94, 
+0x31, 0x18, 0x22, 0x30, 0xCE, 0xCA, 0x65, 0xE7, 
+0x72, 0x8F, 
+
+0xE5, 0x82, 0xDD, 0x14, 0x9C, 0x77, 0x28, 0x4A, 
+0x1F, 0xC0, 0x36, 0xE8, 0x0B, 0x1D, 0xD2, 0x7D, 
+0x3E, 0xC9, 0xE2, 0x57, 0x14, 0x29, 0xBB, 0x45, 
+0xE3, 0x24, 0x06, 0x32, 0x2F, 0x56, 0xB8, 0xAE, 
+0xE5, 0x53, 0x26, 0x11, 0xFA, 0x35, 0x11, 0x18, 
+0x3C, 0xBC, 0x9B, 0x93, 0x89, 0xD6, 0x07, 0xA3, 
+0x14, 0x35, 0xB8, 0xCB, 0xDA, 0x70, 0x59, 0x2A, 
+0x2A, 0x99, 0x25, 0xCF, 0x17, 0x80, 0x4D, 0x6C, 
+0x36, 0x4B, 0x07, 0x3A, 0x52, 0x82, 0x5C, 0xB0, 
+0x27, 0x35, 0x52, 0x1D, 0x46, 0x70, 0x21, 0x73, 
+0x59, 0x9E, 0xF3, 0xDF, 0xE8, 0x26, 0x2E, 0x86, 
+0x0E, 0x8C, 0x6B, 
+
+0x95, 0x10, 0x14, 0x42, 0xD4, 0x7A, 0x20, 0x20, 
+0xAB, 0x13, 0xAE, 0x38, 0x37, 0xCF, 0x43, 0xB8, 
+0xC9, 0x3F, 0x3E, 0x65, 0x4C, 0x56, 0xA7, 0xAB, 
+0x13, 0xCB, 0xFF, 0x57, 0x36, 0xE0, 0x5E, 0x85, 
+0x44, 0x41, 0x6E, 0x6F, 0x67, 0x4E, 0x4E, 0x99, 
+0x94, 0xB5, 0x85, 0x6C, 0x63, 0x4B, 0x3F, 0xD4, 
+0x11, 0xBB, 0xF3, 0xE0, 0xBD, 0x6B, 0x39, 0x7F, 
+0x14, 0xCF, 0x1B, 0x94, 0x53, 0x6B, 0x66, 0x61, 
+0x97, 0xA4, 0x73, 0xC4, 0x92, 0xA9, 0xE7, 0xBE, 
+0xC9, 0x0C, 0x70, 0x64, 0xA5, 0x4B, 0x16, 0xC4, 
+0xC3, 0x24, 0x7B, 0xD8, 0xC6, 0x95, 0x4B, 0xD7, 
+0x1A, 0xFE, 0x9E, 0xDB, 
+
+0xB1, 0x5E, 0x41, 0x99, 0x11, 0x58, 0x57, 0x52, 
+0x8C, 0x8D, 0xCD, 0x1C, 0xE9, 0xC7, 0xDF, 0x89, 
+0xB6, 0x0E, 0xFC, 0xB2, 0xD1, 0x08, 0x10, 0xC1, 
+0x8E, 0x93, 0xA9, 0xA1, 0x48, 0x9E, 0x1B, 0xAC, 
+0xE9, 0x68, 0x49, 0x25, 0x5D, 0xC0, 0xD3, 0xAA, 
+0x92, 0x60, 0x3E, 0xC9, 0xED, 0x96, 0x39, 0xCD, 
+0x31, 0xD5, 0xF3, 0x40, 0x6D, 0x7D, 0xB4, 0x1F, 
+0x40, 0x05, 0x22, 0x2A, 0xBD, 0x1E, 0x81, 0xD8, 
+0xBD, 0xFC, 0x3C, 0x47, 0xBE, 0x98, 0x8A, 0x7A, 
+0x16, 0x21, 0x97, 0xF5, 0x27, 0x99, 0x0C, 0x95, 
+0x3F, 0x66, 0xF8, 0x96, 0x58, 0x0F, 0x1B, 0x29, 
+0xD2, 0x80, 0xB8, 0xEC, 0x7D, 
+
+0xB0, 0xB3, 0xFB, 0x47, 0xD8, 0x02, 0xFF, 0xC0, 
+0x6D, 0xF7, 0x63, 0x1A, 0xB7, 0x66, 0x80, 0x8B, 
+0xF3, 0xE4, 0x5F, 0x47, 0x44, 0x5F, 0x26, 0x71, 
+0x5A, 0x14, 0x5A, 0xBB, 0xED, 0x1D, 0x24, 0x28, 
+0xAC, 0x5F, 0x84, 0xAD, 0x8C, 0x54, 0x0C, 0xEF, 
+0xFB, 0x2E, 0x0F, 0xBE, 0x9B, 0x28, 0x5F, 0xB0, 
+0x21, 0xAA, 0x0A, 0xE0, 0x2F, 0x3B, 0xF9, 0x71, 
+0x13, 0xEF, 0x21, 0xC0, 0xAA, 0x27, 0x3E, 0x75, 
+0x05, 0x56, 0xA8, 0x81, 0xAD, 0x64, 0x61, 0x33, 
+0x99, 0xFF, 0xFB, 0xF0, 0x1E, 0x42, 0x71, 0xAF, 
+0x49, 0x82, 0xDC, 0x86, 0xFD, 0x32, 0xA6, 0xF5, 
+0xF8, 0xE0, 0xA8, 0x21, 0x2D, 0x38, 
+
+0x5B, 0x82, 0x1A, 0xF1, 0x1B, 0xA1, 0x8B, 0xC1, 
+0x31, 0x89, 0xDA, 0xCC, 0x1A, 0x03, 0x39, 0x97, 
+0xA4, 0xF2, 0x73, 0x65, 0x3A, 0xD2, 0xF4, 0x15, 
+0xE2, 0x84, 0xB9, 0x29, 0xD3, 0x6B, 0x8C, 0x49, 
+0x8D, 0xE3, 0xCE, 0xFF, 0x3C, 0x8A, 0xB5, 0xDE, 
+0xD4, 0xB2, 0x78, 0x59, 0xAF, 0xF6, 0x61, 0x07, 
+0xD5, 0xBA, 0xBF, 0xF4, 0xB3, 0x5E, 0x02, 0xD7, 
+0xC6, 0x95, 0xCA, 0x1A, 0x24, 0xCD, 0xCC, 0xBB, 
+0x5D, 0x1C, 0x51, 0xEA, 0x76, 0x84, 0xE9, 0xCE, 
+0xDA, 0x02, 0xF5, 0x6F, 0xD2, 0xCD, 0x13, 0x51, 
+0x5E, 0x38, 0x1D, 0x41, 0x1E, 0xF5, 0x3B, 0x0A, 
+0xEA, 0x5E, 0x9D, 0xBA, 0x71, 0x84, 0x7F, 
+
+0x89, 0xE0, 0x7E, 0xEB, 0xF2, 0xD3, 0x22, 0x2B, 
+0x7A, 0x82, 0x13, 0x58, 0x50, 0x46, 0x04, 0xC4, 
+0x14, 0x71, 0xD0, 0x7F, 0xF2, 0x97, 0xA6, 0x7B, 
+0xEC, 0x90, 0xA6, 0x7C, 0xDA, 0xB1, 0x42, 0xD1, 
+0x83, 0x8C, 0xA5, 0x63, 0x99, 0x0F, 0xA1, 0x18, 
+0x27, 0x6C, 0xAF, 0x13, 0xBD, 0xA9, 0x1E, 0xAB, 
+0x3A, 0x0F, 0x00, 0x0B, 0x83, 0x46, 0xCA, 0xF2, 
+0xE2, 0xE2, 0xFF, 0x5D, 0x9B, 0x1E, 0x70, 0x54, 
+0x9B, 0x90, 0x9B, 0x1D, 0xC5, 0x00, 0x58, 0x28, 
+0x93, 0xC7, 0x85, 0xFE, 0xF1, 0x58, 0xC8, 0x39, 
+0x47, 0xF4, 0xBA, 0x27, 0x2C, 0x66, 0xB6, 0x27, 
+0xE3, 0x31, 0x9D, 0x55, 0x66, 0xE6, 0x03, 0x83, 
+
+0xBE, 0xEC, 0xC1, 0x2E, 0xEF, 0x26, 0x62, 0xEF, 
+0x32, 0x90, 0xEE, 0x70, 0xA9, 0x0C, 0x2C, 0x54, 
+0xC6, 0x3D, 0xBD, 0x34, 0x48, 0x83, 0x51, 0x7F, 
+0x36, 0xDC, 0x98, 0xC5, 0xFA, 0x19, 0xA7, 0xD5, 
+0xC2, 0xDD, 0xCC, 0xA0, 0xCC, 0x0B, 0xB8, 0x0A, 
+0x57, 0x26, 0xAD, 0x63, 0xBC, 0x14, 0xDA, 0x0B, 
+0x84, 0x1D, 0x19, 0xFD, 0xC8, 0xF0, 0xC2, 0x54, 
+0xDF, 0x01, 0x78, 0x9B, 0xCD, 0xB4, 0xD5, 0x4D, 
+0x72, 0x82, 0xBC, 0xD0, 0xBD, 0x64, 0x42, 0x16, 
+0x2B, 0x95, 0x76, 0x76, 0x3A, 0xE1, 0x92, 0xDB, 
+0xCB, 0x8E, 0x78, 0xA6, 0x01, 0xD1, 0x76, 0x57, 
+0x8D, 0x32, 0xB5, 0xAC, 0x30, 0xDE, 0xF1, 0x0A, 
+0xB3, 
+
+0x25, 0x69, 0xE7, 0xEB, 0x7B, 0xA2, 0x05, 0x73, 
+0xC3, 0x7C, 0xC1, 0xB2, 0x49, 0x87, 0x24, 0x79, 
+0x84, 0x0F, 0x5D, 0xE6, 0x3C, 0x6B, 0x50, 0x7B, 
+0xBB, 0x96, 0xDD, 0xF5, 0x81, 0x30, 0x7B, 0x0E, 
+0xDD, 0xB9, 0xB0, 0x10, 0x6A, 0x5D, 0x23, 0xFE, 
+0x01, 0x30, 0x41, 0xBF, 0x25, 0x6D, 0xC0, 0x41, 
+0x46, 0x36, 0x62, 0xFF, 0x0C, 0x55, 0x48, 0x8E, 
+0xA9, 0x66, 0x65, 0xB5, 0x56, 0x90, 0x15, 0x9E, 
+0xDF, 0xD2, 0xD7, 0x9B, 0x22, 0x31, 0x8C, 0xDF, 
+0xFF, 0xD4, 0x8C, 0x91, 0x97, 0x6A, 0xAD, 0x34, 
+0xDB, 0xA2, 0xE2, 0x37, 0x67, 0x88, 0x6C, 0x0E, 
+0x62, 0x85, 0x16, 0x9C, 0x38, 0xCB, 0x1A, 0xA8, 
+0xB9, 0x4E, 
+
+0x0A, 0xE2, 0xF0, 0xCF, 0xBC, 0x58, 0x6B, 0xB9, 
+0x9C, 0x65, 0x1A, 0x8E, 0x5C, 0x96, 0x4B, 0xB7, 
+0xC9, 0xD1, 0xE1, 0x10, 0x08, 0xBE, 0xDF, 0xD2, 
+0xD3, 0xF4, 0xEF, 0x1C, 0xC8, 0xA4, 0xF2, 0x26, 
+0x41, 0x16, 0xD2, 0xC7, 0x4D, 0xB8, 0x34, 0x0F, 
+0x28, 0x2B, 0x85, 0x64, 0x5B, 0xC5, 0x6E, 0xA7, 
+0xC2, 0xE6, 0x8D, 0xF4, 0x31, 0x65, 0x8B, 0xAE, 
+0x8D, 0x3E, 0xBE, 0x56, 0x3B, 0x12, 0x54, 0xE7, 
+0x37, 0x63, 0x37, 0x27, 0x3D, 0x26, 0xCC, 0x8A, 
+0x93, 0x97, 0x4D, 0xD9, 0xE0, 0xC9, 0x37, 0x43, 
+0x5C, 0xFE, 0xA0, 0xFA, 0xFF, 0xDA, 0x70, 0x42, 
+0xE9, 0xD7, 0x1E, 0xB4, 0xFC, 0xD0, 0xA0, 0x7C, 
+0x53, 0x23, 0x90, 
+
+0xFE, 0x5E, 0x71, 0x15, 0x36, 0xA6, 0x91, 0xA5, 
+0x87, 0x46, 0x60, 0x9B, 0x3A, 0x56, 0x1A, 0x14, 
+0x2E, 0xF0, 0x66, 0x16, 0x9E, 0xCD, 0x4C, 0xC9, 
+0xCD, 0x7B, 0x4B, 0xA7, 0x53, 0xD8, 0x48, 0xB5, 
+0x44, 0x00, 0x04, 0x7A, 0x1E, 0xD6, 0xD5, 0x29, 
+0xEF, 0xB0, 0x41, 0x64, 0xDF, 0x34, 0x68, 0xC2, 
+0x86, 0x27, 0xCB, 0xDD, 0xDC, 0xD5, 0xFF, 0x01, 
+0x91, 0x25, 0xC7, 0x60, 0x85, 0xCE, 0xB7, 0x6D, 
+0xDE, 0x88, 0xB4, 0xA7, 0xB0, 0x3A, 0xAD, 0xF6, 
+0x29, 0x06, 0x38, 0xE5, 0x52, 0x85, 0xAA, 0x44, 
+0x80, 0x58, 0x12, 0xBD, 0xAC, 0x4D, 0x54, 0xB0, 
+0x14, 0xCA, 0xE2, 0xD4, 0xEA, 0x5C, 0xA2, 0xE6, 
+0x47, 0x0D, 0xD3, 0x28, 
+
+0x27, 0x64, 0x60, 0x9D, 0x08, 0x46, 0x1A, 0xAD, 
+0x4E, 0x57, 0x3A, 0xE2, 0x7E, 0x16, 0xF2, 0xB6, 
+0x43, 0xE8, 0x15, 0xBB, 0x76, 0xA7, 0x75, 0xA5, 
+0x46, 0x93, 0x03, 0x9C, 0x44, 0xCF, 0x25, 0x59, 
+0x0B, 0x94, 0xF5, 0x9B, 0x61, 0x23, 0xCF, 0xEF, 
+0xEE, 0x74, 0x84, 0x90, 0x66, 0x0C, 0x2A, 0x0F, 
+0xDA, 0xCB, 0x56, 0x92, 0x83, 0xF1, 0x21, 0x1B, 
+0x6A, 0x4E, 0xA0, 0x4B, 0x99, 0xFA, 0x8E, 0xBA, 
+0x8C, 0xD3, 0x33, 0xA7, 0x90, 0x2F, 0x02, 0xE4, 
+0xE5, 0xDC, 0x95, 0x5E, 0xD1, 0x9F, 0x6E, 0xE8, 
+0xBC, 0x1D, 0xDC, 0xD9, 0xA1, 0xEC, 0xF3, 0x00, 
+0x6A, 0xF0, 0xBC, 0xFE, 0xCD, 0x17, 0x97, 0xC5, 
+0xF9, 0x5E, 0xFF, 0x2C, 0x3D, 
+
+0xD4, 0x77, 0xAF, 0x20, 0x98, 0x5A, 0xC0, 0x31, 
+0xE7, 0x34, 0xAE, 0xD9, 0x32, 0xEE, 0x13, 0xEC, 
+0x08, 0x97, 0xF4, 0x99, 0x3C, 0x1E, 0xD9, 0xAD, 
+0x32, 0x83, 0x82, 0x52, 0x3C, 0x37, 0xE6, 0xC6, 
+0x21, 0xEB, 0x85, 0xA8, 0xB4, 0x06, 0x75, 0xCA, 
+0x72, 0x64, 0x4B, 0xB6, 0x2A, 0x50, 0x21, 0x2A, 
+0x5F, 0x72, 0xC3, 0x89, 0x00, 0x5B, 0xCB, 0xD0, 
+0x42, 0x7D, 0x31, 0x3F, 0xFB, 0xF1, 0x93, 0xDD, 
+0xD0, 0x5B, 0x7F, 0xA1, 0x52, 0x66, 0xEE, 0xD0, 
+0x82, 0x88, 0x87, 0x4C, 0xBE, 0x08, 0x5B, 0xE1, 
+0x51, 0x02, 0x25, 0x52, 0x03, 0x6F, 0xB2, 0x35, 
+0x64, 0x40, 0x8A, 0x94, 0x12, 0x15, 0x88, 0x36, 
+0x62, 0x62, 0xDC, 0xB4, 0xDA, 0x66, 
+
+0x82, 0x45, 0xF4, 0xB7, 0x38, 0xAE, 0xF5, 0x63, 
+0xBD, 0xAA, 0x16, 0x7B, 0x4C, 0x55, 0x26, 0x46, 
+0x74, 0xB6, 0xD3, 0xFE, 0xFE, 0x55, 0x22, 0x26, 
+0xDE, 0xD5, 0xCC, 0x35, 0xC7, 0xB5, 0x71, 0x18, 
+0xC2, 0xFC, 0x94, 0x77, 0x98, 0x69, 0x8D, 0xCC, 
+0xDE, 0xDE, 0x7A, 0x7F, 0x69, 0xA1, 0x7C, 0x62, 
+0xC0, 0xCD, 0xDC, 0xBF, 0xA3, 0x63, 0xA4, 0x67, 
+0xAC, 0x19, 0x18, 0x8E, 0xAB, 0x77, 0x10, 0x73, 
+0xAB, 0xB6, 0x3A, 0xF8, 0x6A, 0xB2, 0xBE, 0xF6, 
+0x5A, 0x0D, 0x8A, 0xD5, 0x97, 0x92, 0xB2, 0x6B, 
+0xA6, 0x17, 0x42, 0x27, 0x29, 0xCB, 0x32, 0x69, 
+0x8B, 0x4F, 0x74, 0x36, 0x33, 0x7D, 0x8D, 0x8C, 
+0x27, 0x9D, 0xAE, 0x4B, 0xC8, 0x9A, 0x22, 
+
+0xD4, 0xD1, 0xBC, 0x2A, 0xFB, 0xCD, 0xE8, 0x76, 
+0x62, 0x32, 0x9E, 0x7A, 0xC6, 0x8A, 0xF2, 0x73, 
+0xBB, 0x1F, 0xE1, 0x23, 0xDA, 0x6F, 0xE5, 0xAE, 
+0x03, 0x79, 0x74, 0xB8, 0x33, 0x9D, 0xE0, 0xEF, 
+0x5A, 0xEF, 0x28, 0x89, 0x08, 0x6E, 0x1D, 0xFB, 
+0x08, 0x21, 0x66, 0x08, 0x0A, 0x84, 0x90, 0xF9, 
+0x64, 0x70, 0x7C, 0xAB, 0x3D, 0xD2, 0xAD, 0x73, 
+0x18, 0x35, 0xFC, 0x96, 0x66, 0xF3, 0x65, 0xD2, 
+0x96, 0x85, 0x97, 0x1C, 0xB7, 0x91, 0x93, 0x3A, 
+0xE6, 0x05, 0xD4, 0x3C, 0xA0, 0xED, 0x5F, 0x58, 
+0x31, 0x62, 0x0D, 0xC4, 0x72, 0xDB, 0x87, 0xE0, 
+0xCD, 0x36, 0xC1, 0x03, 0xAF, 0x28, 0x4F, 0xA2, 
+0x98, 0xF4, 0x27, 0xA6, 0x34, 0x63, 0xA4, 0x22, 
+
+0xDB, 0xA6, 0x43, 0xBA, 0xD9, 0x30, 0x2B, 0xC8, 
+0x85, 0xEA, 0x5B, 0x25, 0xE1, 0x74, 0x8D, 0x82, 
+0x38, 0x9B, 0x84, 0x22, 0x09, 0xA3, 0x59, 0xA2, 
+0xC7, 0xE8, 0x2E, 0x12, 0x4C, 0xF7, 0xA7, 0x73, 
+0x84, 0x13, 0xD7, 0xD9, 0x9F, 0xD0, 0x43, 0xF2, 
+0x90, 0x91, 0x29, 0x26, 0xF7, 0x40, 0x3D, 0xCF, 
+0x91, 0x8A, 0x2C, 0xD5, 0xC5, 0x4B, 0x5A, 0xBB, 
+0xFB, 0xA7, 0x47, 0xFD, 0x63, 0x78, 0xD1, 0x0E, 
+0x20, 0xD8, 0x7F, 0x15, 0xEC, 0xF6, 0xAE, 0xA1, 
+0xBA, 0x0C, 0x2E, 0x6A, 0xB8, 0xCB, 0xB5, 0x6E, 
+0x5E, 0x91, 0x83, 0xD4, 0x7F, 0x66, 0x0E, 0x2F, 
+0x81, 0x05, 0x0B, 0xBC, 0xA5, 0x78, 0xA7, 0xBE, 
+0x4D, 0x1C, 0x3A, 0x2F, 0x7C, 0xC9, 0x82, 0xE0, 
+0x6F, 
+
+0x85, 0x7F, 0xFC, 0x80, 0xEF, 0x3B, 0x10, 0xB7, 
+0x4F, 0xAE, 0x53, 0xB7, 0x45, 0x5E, 0x30, 0xC2, 
+0x19, 0x3B, 0xA8, 0xD5, 0x50, 0xD5, 0xFA, 0x5B, 
+0x26, 0xB8, 0x15, 0x98, 0x98, 0x50, 0x4D, 0x9A, 
+0xE7, 0xE6, 0x65, 0xEB, 0xE3, 0xCD, 0xEB, 0x39, 
+0x1A, 0xD9, 0xD0, 0x32, 0x9D, 0x20, 0xE3, 0x53, 
+0x2B, 0x97, 0x7C, 0x2D, 0x07, 0xD8, 0xAD, 0x25, 
+0x0D, 0x94, 0x6B, 0x12, 0x35, 0x1C, 0xC8, 0x86, 
+0x3C, 0xF8, 0xD0, 0x06, 0x48, 0x3E, 0xC3, 0x8C, 
+0x64, 0x7B, 0x35, 0x97, 0x85, 0x9D, 0x38, 0x4C, 
+0x04, 0xA5, 0x6A, 0x10, 0x65, 0xB6, 0xDB, 0x37, 
+0xAC, 0x77, 0x73, 0x9C, 0x74, 0xAA, 0x6B, 0x16, 
+0x37, 0x86, 0xD6, 0x74, 0xDD, 0x98, 0x77, 0x84, 
+0x53, 0x62, 
+
+0x19, 0x34, 0x2B, 0x30, 0xC2, 0x6A, 0x75, 0xBC, 
+0xB5, 0xE1, 0xE7, 0x5F, 0x21, 0xC0, 0x21, 0x58, 
+0xB1, 0xA4, 0xB7, 0xCF, 0xE7, 0x59, 0x44, 0xCA, 
+0xFE, 0x96, 0x78, 0x76, 0x34, 0x50, 0x94, 0x89, 
+0x4B, 0x93, 0x39, 0x50, 0x6F, 0x82, 0x0C, 0x92, 
+0x23, 0x18, 0x1F, 0xED, 0xD4, 0x5F, 0x7E, 0x55, 
+0x54, 0xC4, 0x14, 0x97, 0x59, 0x5B, 0x56, 0x33, 
+0x2E, 0xF0, 0xAB, 0xCD, 0xD8, 0x3B, 0x18, 0xE9, 
+0x00, 0xE6, 0x27, 0xB1, 0xB8, 0xEA, 0x4C, 0x51, 
+0x8E, 0x51, 0x1E, 0x69, 0xE3, 0xF7, 0xBF, 0x3C, 
+0x10, 0xA6, 0xB0, 0x19, 0x69, 0x11, 0xA9, 0x47, 
+0x9A, 0x30, 0x6F, 0x1D, 0x8C, 0xDB, 0x51, 0xD9, 
+0xBC, 0x11, 0x67, 0xF6, 0x9B, 0x88, 0xEB, 0xA2, 
+0x9C, 0x8D, 0x96, 
+
+0x8D, 0x21, 0x8F, 0xF6, 0xB4, 0xDA, 0x50, 0x67, 
+0xDA, 0xE4, 0x7A, 0x97, 0x53, 0x57, 0xDD, 0x50, 
+0x6F, 0x89, 0x5A, 0xDD, 0x30, 0x19, 0x00, 0x97, 
+0x58, 0xC0, 0x46, 0x6D, 0xE5, 0xB1, 0x14, 0xCE, 
+0x7B, 0xB0, 0xC5, 0x2B, 0x63, 0xDA, 0xCB, 0x1A, 
+0x67, 0x7D, 0xB8, 0x3D, 0xDB, 0x78, 0x72, 0x09, 
+0xC8, 0x5D, 0x8A, 0xA7, 0xD4, 0x8A, 0x2C, 0x9D, 
+0xDB, 0xCC, 0x59, 0x0D, 0xCD, 0xB1, 0xF6, 0x5A, 
+0xEF, 0xF8, 0x5C, 0xED, 0x8E, 0x0F, 0x7D, 0x5A, 
+0xE9, 0x65, 0x3F, 0x19, 0x0A, 0x49, 0x2B, 0x36, 
+0xF3, 0x0D, 0x22, 0x51, 0x50, 0x1D, 0x4C, 0x42, 
+0x70, 0xC5, 0x80, 0x14, 0x0B, 0xC1, 0xC7, 0x6F, 
+0xEA, 0xF1, 0xB8, 0x45, 0x58, 0xAE, 0xE3, 0x16, 
+0x4B, 0xE6, 0x5F, 0x7A, 
+
+0x57, 0x8D, 0x4F, 0x2B, 0xEA, 0x8B, 0x8B, 0x67, 
+0x28, 0x10, 0x98, 0x44, 0x2F, 0xB6, 0x4A, 0x9B, 
+0xB9, 0x00, 0x17, 0x96, 0x38, 0x75, 0x8E, 0x5F, 
+0x03, 0xD3, 0x0D, 0xF7, 0xD5, 0x9A, 0x24, 0xE2, 
+0x8E, 0xF5, 0x6B, 0xF5, 0x33, 0x3E, 0x5E, 0x89, 
+0x66, 0xBD, 0x7C, 0x01, 0x7C, 0xFE, 0xAD, 0xF3, 
+0x4C, 0x40, 0xAC, 0xB1, 0x2B, 0xBC, 0xF1, 0x8B, 
+0x7A, 0x95, 0x13, 0x26, 0x7E, 0xC1, 0x0B, 0xD6, 
+0x31, 0x26, 0xF5, 0x8D, 0x59, 0x20, 0x55, 0xB0, 
+0x5D, 0xA9, 0xD2, 0xCB, 0x66, 0xF5, 0x09, 0x03, 
+0xA7, 0x11, 0x15, 0x2E, 0xB4, 0x7C, 0xB0, 0xC2, 
+0xF6, 0xE0, 0x34, 0x50, 0xA1, 0x3E, 0x11, 0x90, 
+0x40, 0xB1, 0x1A, 0x14, 0x74, 0x60, 0x2D, 0x82, 
+0xD5, 0xDA, 0xF5, 0x3E, 0x0B, 
+
+0xE3, 0xCA, 0x20, 0x23, 0xFB, 0x92, 0x31, 0xA8, 
+0xF7, 0x00, 0x07, 0x32, 0x0F, 0x11, 0x22, 0x91, 
+0x59, 0xAE, 0x3D, 0x37, 0xE3, 0x60, 0x73, 0x39, 
+0x0B, 0xE2, 0x78, 0xFA, 0x84, 0x14, 0x0D, 0x07, 
+0x6E, 0x2C, 0x75, 0x90, 0xB6, 0xD2, 0x70, 0xED, 
+0xE7, 0x6C, 0xEC, 0x71, 0xEC, 0x2C, 0xCE, 0xC1, 
+0x38, 0x05, 0x61, 0xEC, 0x44, 0x46, 0x12, 0xD6, 
+0xC5, 0xBE, 0x8B, 0x42, 0x91, 0xA1, 0xB9, 0x0B, 
+0xFB, 0xB6, 0xE6, 0xE5, 0x1B, 0xB6, 0x90, 0x34, 
+0x05, 0x34, 0xE2, 0x02, 0x34, 0x04, 0x78, 0xCF, 
+0x2D, 0xFD, 0x94, 0x6B, 0x76, 0x7F, 0x95, 0x81, 
+0xCC, 0x67, 0x2D, 0x0E, 0xF2, 0x5B, 0x1C, 0xFE, 
+0xE2, 0xFD, 0xE1, 0xCF, 0xE8, 0x9D, 0x61, 0xC5, 
+0x89, 0xA2, 0xFB, 0x62, 0xA7, 0x3D, 
+
+0xC5, 0x1A, 0xB3, 0xF6, 0x50, 0xD2, 0x43, 0x78, 
+0x99, 0xC9, 0x88, 0xED, 0xA3, 0x96, 0x2E, 0x08, 
+0x3A, 0x57, 0xBE, 0xAF, 0x99, 0x45, 0x2B, 0xB7, 
+0xB7, 0x1E, 0x78, 0x52, 0x5F, 0xF6, 0x8B, 0x39, 
+0xE9, 0x1B, 0xA1, 0x07, 0xBD, 0x65, 0x08, 0xD5, 
+0x80, 0x61, 0x8F, 0x95, 0xFC, 0xA0, 0xE7, 0xA0, 
+0xC7, 0xAD, 0x59, 0xD0, 0xA4, 0xD6, 0x94, 0x92, 
+0x4F, 0x04, 0xC5, 0xC9, 0x9C, 0x9A, 0x98, 0xBD, 
+0x69, 0x42, 0x78, 0x81, 0x2C, 0xFA, 0x63, 0x17, 
+0x17, 0xD5, 0xF4, 0x03, 0x08, 0xEA, 0xD4, 0xE7, 
+0x22, 0x37, 0x4F, 0x90, 0x4B, 0xA4, 0x42, 0x83, 
+0x72, 0x19, 0x6F, 0x77, 0xB1, 0xF0, 0xE2, 0x6D, 
+0xA7, 0x0C, 0x53, 0x48, 0x03, 0xA7, 0x02, 0xF1, 
+0xE0, 0xC5, 0x27, 0xFB, 0x66, 0x63, 0x1A, 
+
+0x2D, 0xCE, 0x57, 0xCD, 0x5E, 0x97, 0xF6, 0xF5, 
+0x5F, 0x2A, 0x0C, 0x3A, 0x44, 0xA7, 0xCF, 0x90, 
+0xA6, 0x5C, 0x47, 0xA1, 0x9F, 0xD8, 0x12, 0xBB, 
+0xAC, 0xB0, 0xD7, 0x2B, 0x30, 0x7C, 0x4C, 0xDF, 
+0x28, 0x3E, 0x75, 0xCF, 0x0F, 0x09, 0x1C, 0xBC, 
+0x95, 0x8F, 0x0B, 0x3A, 0xC7, 0x6F, 0x83, 0x12, 
+0x0A, 0x2B, 0xEA, 0xF2, 0xED, 0x2D, 0xB7, 0xAB, 
+0x60, 0xFC, 0x47, 0x23, 0x92, 0x55, 0xDF, 0x59, 
+0x53, 0x63, 0xFA, 0x4A, 0xEA, 0xD7, 0x8F, 0xC2, 
+0x68, 0x7F, 0xEC, 0x3E, 0xDE, 0x88, 0xFE, 0x84, 
+0x15, 0x35, 0x09, 0x60, 0xB6, 0xA7, 0x21, 0xDE, 
+0xCE, 0x8D, 0x20, 0xF3, 0x99, 0xA5, 0xE7, 0x0E, 
+0x6C, 0x23, 0xFC, 0x9D, 0xDB, 0xD1, 0xA3, 0x54, 
+0x44, 0x6B, 0x9C, 0x7F, 0x3D, 0x6B, 0xEC, 0x48, 
+
+0x5D, 0x17, 0xB5, 0xC5, 0x93, 0x79, 0xAC, 0x88, 
+0xD6, 0xBA, 0xC1, 0x1B, 0x1B, 0x0C, 0x0B, 0x5C, 
+0xF0, 0xD1, 0xFE, 0x3E, 0x9D, 0x35, 0xC8, 0x4D, 
+0xCF, 0xFC, 0xFC, 0x4A, 0x27, 0xDB, 0xBE, 0x79, 
+0xCF, 0x8E, 0xBD, 0x45, 0x91, 0xB2, 0x30, 0x92, 
+0x79, 0x04, 0xE7, 0x1B, 0xA4, 0x48, 0xF9, 0x95, 
+0x9B, 0x05, 0x4F, 0x7D, 0x62, 0xA3, 0x96, 0x9D, 
+0x43, 0xC0, 0xA5, 0xEF, 0x22, 0x5C, 0xCA, 0x26, 
+0xE7, 0x40, 0xC5, 0xDE, 0x24, 0xB8, 0x37, 0x7D, 
+0xD1, 0xB0, 0xC8, 0xB6, 0x84, 0x76, 0x57, 0x7B, 
+0x7B, 0xF4, 0x1D, 0x47, 0xEE, 0x4A, 0x83, 0x6A, 
+0x64, 0xB1, 0x8B, 0xBF, 0x64, 0xB4, 0xA6, 0xA7, 
+0xFD, 0x59, 0x15, 0xAC, 0x2C, 0x0D, 0x55, 0x54, 
+0xAF, 0xC2, 0xF9, 0x9D, 0xBE, 0x23, 0x66, 0x01, 
+0x95, 
+
+0x1B, 0xE7, 0x79, 0xE5, 0xFC, 0xDF, 0xAF, 0x00, 
+0x66, 0x05, 0xB2, 0x87, 0x0F, 0x88, 0xA5, 0x09, 
+0x67, 0x3D, 0x94, 0x88, 0x7C, 0x9D, 0xB5, 0xE1, 
+0xA3, 0xC6, 0x3B, 0x33, 0xB9, 0x77, 0x22, 0x47, 
+0x34, 0xBB, 0x68, 0xA3, 0x4A, 0x99, 0xB3, 0x75, 
+0x37, 0x7B, 0xC8, 0x32, 0xD9, 0x03, 0x4C, 0x86, 
+0xED, 0x3B, 0x70, 0xCB, 0xC9, 0x9B, 0x84, 0x19, 
+0x55, 0xC4, 0xC2, 0x72, 0x3C, 0x77, 0xD1, 0x7B, 
+0xC7, 0xEB, 0x59, 0x6D, 0x00, 0x35, 0xE6, 0xFE, 
+0xEB, 0x22, 0x25, 0x1F, 0xE9, 0x6C, 0x65, 0x94, 
+0xB3, 0x95, 0xB7, 0x61, 0x33, 0x8D, 0xC7, 0x4C, 
+0x8B, 0xE8, 0xE4, 0x48, 0xC8, 0xC4, 0xD7, 0xD9, 
+0x69, 0x3E, 0x3B, 0xF9, 0x13, 0x5D, 0x8D, 0x40, 
+0x70, 0x43, 0x4B, 0x38, 0x48, 0x50, 0x4A, 0xDC, 
+0xCF, 0x21, 
+
+0x1C, 0x52, 0xF8, 0x6D, 0x60, 0xC2, 0x7F, 0xED, 
+0x96, 0x2D, 0x6F, 0x27, 0xC1, 0x66, 0x4F, 0x0D, 
+0x7B, 0x36, 0x4E, 0x39, 0x3A, 0x7D, 0x70, 0x13, 
+0x7E, 0x24, 0x6D, 0x2D, 0xA6, 0x05, 0x01, 0xBE, 
+0xE4, 0x4B, 0xB6, 0xA3, 0x35, 0x40, 0x68, 0xFD, 
+0x24, 0x56, 0xBE, 0x5B, 0x1C, 0x42, 0xC0, 0x75, 
+0x79, 0xFE, 0x89, 0x41, 0x21, 0x70, 0x0B, 0x1E, 
+0xE0, 0x21, 0xA1, 0x66, 0xA4, 0x44, 0xF0, 0x6B, 
+0xC2, 0x53, 0x63, 0xC2, 0xC1, 0x9E, 0xD7, 0xD7, 
+0x5E, 0xEF, 0x65, 0x89, 0xF0, 0x64, 0xC9, 0xED, 
+0xEA, 0x1D, 0x80, 0x75, 0x5D, 0x61, 0xE1, 0x01, 
+0x88, 0x48, 0x72, 0xBB, 0x31, 0x9D, 0x53, 0x3C, 
+0x4D, 0x24, 0x33, 0x68, 0x64, 0x88, 0xE5, 0x19, 
+0x6E, 0x7B, 0xEF, 0x74, 0xD4, 0x7D, 0x59, 0x6A, 
+0xAC, 0x9E, 0x31, 
+
+0x73, 0xF7, 0xE1, 0x6C, 0x64, 0xE7, 0x5F, 0xE4, 
+0x92, 0xF3, 0x57, 0xDB, 0x15, 0xA1, 0xA5, 0x49, 
+0xC1, 0x5F, 0x2F, 0xEC, 0x47, 0x19, 0xB5, 0x22, 
+0x80, 0x2F, 0xC2, 0x09, 0x39, 0xD1, 0xB7, 0x9A, 
+0xCD, 0x62, 0x74, 0xE2, 0x64, 0xA1, 0x43, 0xF6, 
+0x55, 0x08, 0x75, 0x89, 0x9F, 0x81, 0x9B, 0xB2, 
+0xB8, 0xD2, 0xAC, 0x24, 0x37, 0x25, 0x81, 0xA6, 
+0x67, 0x9B, 0x68, 0xCD, 0xAC, 0x18, 0x83, 0xB6, 
+0x84, 0x5E, 0xBE, 0xFD, 0x69, 0xD7, 0xF8, 0x61, 
+0x7B, 0x45, 0xE1, 0x05, 0x98, 0xBC, 0x85, 0x21, 
+0x3E, 0x58, 0xB6, 0x1B, 0xF2, 0x83, 0xAA, 0x5A, 
+0x4B, 0x9B, 0xFE, 0x68, 0xD3, 0x05, 0x95, 0x8E, 
+0x64, 0xFD, 0x15, 0x75, 0x85, 0xCC, 0x3B, 0x94, 
+0xD1, 0xB3, 0xB1, 0xBE, 0xE9, 0xD0, 0xDE, 0x0D, 
+0x5B, 0x7B, 0x58, 0x5E, 
+
+0x1E, 0xF3, 0x08, 0x75, 0x53, 0x04, 0x33, 0xD0, 
+0x4E, 0x5E, 0xFB, 0x69, 0x0B, 0x9F, 0x65, 0xF7, 
+0x87, 0xAF, 0x2E, 0xAD, 0x82, 0xC3, 0xF6, 0xEF, 
+0x1E, 0xA8, 0x9A, 0xC7, 0x6A, 0xCB, 0xA8, 0x19, 
+0x2B, 0x70, 0xB4, 0x27, 0xE7, 0x0D, 0xD9, 0xCE, 
+0x0C, 0xA8, 0x49, 0xD8, 0xCC, 0xB0, 0x58, 0x51, 
+0x72, 0x81, 0x10, 0x85, 0xD2, 0xBB, 0x0A, 0x2D, 
+0x6C, 0x27, 0x58, 0xEF, 0xE8, 0x11, 0xD5, 0x55, 
+0x7D, 0x69, 0x0E, 0xE2, 0xF6, 0x9F, 0x1D, 0xC0, 
+0x5F, 0x97, 0x69, 0xEA, 0xC6, 0x37, 0x5F, 0x4E, 
+0x57, 0xA1, 0x1D, 0xF8, 0xDD, 0xFF, 0x82, 0x27, 
+0xAE, 0x7A, 0xC2, 0x07, 0x3F, 0x34, 0xF7, 0x87, 
+0xF6, 0x53, 0xCF, 0x28, 0xEF, 0x77, 0x20, 0x46, 
+0x67, 0x6F, 0xA7, 0xF3, 0x54, 0xE4, 0xC9, 0x5B, 
+0x06, 0xE2, 0xF2, 0x6D, 0x07, 
+
+0xFD, 0x11, 0x01, 0xB6, 0xA3, 0xAD, 0xC6, 0x4C, 
+0xFD, 0x73, 0xF2, 0xA1, 0xEA, 0x06, 0x39, 0xD1, 
+0x68, 0xCD, 0xF8, 0x02, 0xF9, 0x3A, 0x9D, 0x2B, 
+0x2A, 0x9E, 0xA5, 0x3A, 0x59, 0xC8, 0x0C, 0x75, 
+0x9F, 0x98, 0x7D, 0xA2, 0x6D, 0xE4, 0x1D, 0xC0, 
+0xA4, 0x1F, 0x32, 0x72, 0xC6, 0xE3, 0x78, 0xB0, 
+0x86, 0xD4, 0x55, 0xE7, 0x48, 0x1C, 0x69, 0x04, 
+0x3D, 0xCC, 0xEF, 0x57, 0xDE, 0x4F, 0xC7, 0x1B, 
+0xEA, 0x16, 0xC1, 0x63, 0xFD, 0xC4, 0x46, 0x83, 
+0xBC, 0xD5, 0xD4, 0x6D, 0xCB, 0x1C, 0x60, 0xE7, 
+0xD6, 0x04, 0xAE, 0xE5, 0x03, 0x52, 0x2C, 0x0D, 
+0x10, 0xB5, 0x06, 0x08, 0xFD, 0xF6, 0x51, 0x39, 
+0x5A, 0x47, 0x96, 0xDC, 0xBA, 0x41, 0xD3, 0x10, 
+0x93, 0xE3, 0xDE, 0x56, 0xDF, 0x44, 0xBE, 0x3C, 
+0x7B, 0x14, 0xED, 0xEA, 0x7E, 0x22, 
+
+0x73, 0x6D, 0xFD, 0x4F, 0x57, 0x2D, 0xCA, 0x7B, 
+0x6A, 0x14, 0x6D, 0x7D, 0x65, 0x6A, 0x6F, 0x88, 
+0xFA, 0x35, 0xFC, 0xC9, 0x0D, 0x06, 0x1E, 0x5D, 
+0x33, 0x36, 0x2A, 0x27, 0x12, 0xCB, 0xD5, 0x3A, 
+0xB6, 0xDB, 0xE2, 0x34, 0xC5, 0xA1, 0xC3, 0xEA, 
+0xDB, 0x6C, 0x0D, 0x69, 0xEB, 0xC2, 0x07, 0xF1, 
+0x2A, 0x12, 0x63, 0x70, 0xCA, 0x9A, 0x27, 0xD6, 
+0x9B, 0xB6, 0x22, 0x82, 0x1B, 0xFB, 0x15, 0xAA, 
+0x92, 0xBF, 0x7D, 0x75, 0xDB, 0x0A, 0x96, 0x1E, 
+0x86, 0x4C, 0xF4, 0xAF, 0xD0, 0xAB, 0x4A, 0x1C, 
+0xE2, 0x90, 0xD5, 0xE9, 0x43, 0x96, 0x4F, 0xE1, 
+0xF2, 0x0F, 0x5C, 0x85, 0x99, 0x68, 0x6D, 0x53, 
+0x4F, 0x1C, 0xD8, 0x1D, 0xED, 0x6E, 0xB2, 0x98, 
+0xDB, 0x1D, 0x22, 0xA8, 0x39, 0x65, 0x00, 0xFA, 
+0xA0, 0x9C, 0xFC, 0x9F, 0x1F, 0xB7, 0x77, 
+
+0x8F, 0x2C, 0x4E, 0xBA, 0x82, 0x16, 0xDE, 0xEA, 
+0xD3, 0x1E, 0xD2, 0x32, 0x65, 0xA5, 0x95, 0xB4, 
+0xDA, 0xD5, 0x02, 0x46, 0x21, 0xD2, 0xB6, 0xE8, 
+0xBA, 0x62, 0x0D, 0xDC, 0x10, 0x8D, 0xA6, 0x21, 
+0xC6, 0xF6, 0x03, 0xB2, 0x7D, 0x11, 0x99, 0x1A, 
+0xCD, 0x28, 0x06, 0x63, 0x13, 0xFD, 0xAD, 0x6E, 
+0x86, 0x71, 0x54, 0x07, 0x66, 0xB6, 0xEC, 0xA3, 
+0x57, 0x50, 0x04, 0x60, 0x7F, 0x49, 0x4B, 0xF6, 
+0xE5, 0x8B, 0xA1, 0x0B, 0xD1, 0xDF, 0x5C, 0x22, 
+0xA8, 0x5E, 0xE4, 0xE5, 0xE8, 0xB2, 0x55, 0x38, 
+0x05, 0x98, 0xE5, 0xCA, 0x20, 0x42, 0x37, 0xF7, 
+0x25, 0x6C, 0x16, 0x7D, 0x81, 0x51, 0x96, 0x67, 
+0x57, 0x04, 0x83, 0xA7, 0x70, 0x27, 0x5D, 0x4B, 
+0x08, 0xF7, 0x07, 0x0D, 0x18, 0x89, 0xEB, 0x7F, 
+0x05, 0xE0, 0x1B, 0xDA, 0x68, 0xAF, 0xC7, 0x52, 
+
+0x9B, 0x44, 0xED, 0x37, 0x3F, 0x36, 0xAC, 0x32, 
+0xF9, 0xF6, 0x2E, 0x16, 0x8B, 0xC5, 0x80, 0x6D, 
+0x74, 0x89, 0xA3, 0x96, 0x7C, 0xAB, 0x20, 0x9F, 
+0x0C, 0x2B, 0x9B, 0x6F, 0x86, 0xBF, 0x93, 0x25, 
+0xDE, 0xEF, 0x23, 0x0E, 0x89, 0xF1, 0xDF, 0xDE, 
+0x25, 0x24, 0x08, 0x55, 0x11, 0x4D, 0xD5, 0x2F, 
+0x0B, 0x4D, 0x4A, 0x32, 0x0C, 0xC9, 0x0C, 0xED, 
+0xEA, 0xEC, 0xBF, 0x27, 0x91, 0xEB, 0xB2, 0xB7, 
+0x6F, 0x47, 0xFA, 0x80, 0xC2, 0x37, 0xBD, 0xED, 
+0x40, 0xEC, 0x80, 0xEB, 0x15, 0x75, 0xCE, 0xED, 
+0x12, 0xCF, 0x03, 0x7C, 0x0C, 0xD6, 0xDF, 0x6F, 
+0x72, 0xE3, 0x1F, 0xEE, 0xD8, 0xEB, 0x21, 0x87, 
+0xBF, 0xDD, 0x46, 0x28, 0xC5, 0x68, 0xCD, 0xFD, 
+0x0D, 0x35, 0xF0, 0x26, 0xB5, 0xBA, 0x4A, 0xDE, 
+0x59, 0xE1, 0x9F, 0x77, 0x3C, 0x09, 0x69, 0x94, 
+0x8E, 
+
+0x6E, 0xBA, 0xDB, 0xCB, 0x5F, 0xB7, 0x0B, 0x7A, 
+0x3C, 0x65, 0x99, 0xF7, 0xB3, 0x02, 0x06, 0xB1, 
+0x3E, 0x58, 0x41, 0xDC, 0x26, 0x23, 0xAC, 0xB7, 
+0xBB, 0xD4, 0x41, 0x7F, 0x1F, 0x66, 0x50, 0x3F, 
+0xD3, 0xBD, 0xA4, 0xDA, 0xBC, 0xFF, 0x10, 0xC0, 
+0x64, 0x2F, 0x87, 0xFF, 0x64, 0xCF, 0xAE, 0xEF, 
+0x1E, 0xB6, 0xB0, 0x76, 0x8A, 0x13, 0xA7, 0x56, 
+0xA9, 0x21, 0xAF, 0xB7, 0x39, 0x2A, 0x00, 0xF9, 
+0xC2, 0xFD, 0x9D, 0x60, 0x76, 0x3C, 0xF5, 0xA5, 
+0xC2, 0xB3, 0xAF, 0x70, 0x96, 0x0D, 0xD6, 0x76, 
+0x17, 0x83, 0x67, 0xC4, 0x30, 0xA6, 0x60, 0xEE, 
+0x41, 0xEB, 0xE5, 0x3A, 0x0A, 0x04, 0x67, 0x6E, 
+0x59, 0x92, 0x68, 0x56, 0xB9, 0xE2, 0x56, 0x70, 
+0xD4, 0xDE, 0xFA, 0x66, 0x04, 0x55, 0xF1, 0x33, 
+0x7F, 0x3D, 0x32, 0x2E, 0x2C, 0xAD, 0x25, 0xBF, 
+0xAB, 0x83, 
+
+0xEC, 0x82, 0x08, 0x9A, 0xA5, 0x6C, 0xD1, 0x7E, 
+0xA3, 0x22, 0xA8, 0x22, 0x12, 0x79, 0x39, 0xE0, 
+0x8B, 0x37, 0x3A, 0x4C, 0x95, 0x1D, 0x91, 0x00, 
+0xCE, 0x51, 0x64, 0xEC, 0x1C, 0xE0, 0xA2, 0xB4, 
+0x87, 0x4A, 0x98, 0xE6, 0x98, 0x86, 0x17, 0x95, 
+0x8C, 0xF3, 0xD6, 0x4A, 0xD3, 0x64, 0x74, 0x3A, 
+0xA7, 0xFB, 0x0B, 0x4B, 0x71, 0xA9, 0xED, 0x7C, 
+0x41, 0x55, 0x68, 0x41, 0x2F, 0x3E, 0xD5, 0x6D, 
+0x30, 0xF7, 0xBC, 0x7C, 0x5C, 0xAB, 0x12, 0x20, 
+0x95, 0x77, 0xEB, 0x71, 0x60, 0x7A, 0x2A, 0xE5, 
+0x6B, 0x1E, 0x89, 0x25, 0x0D, 0x51, 0xF7, 0x42, 
+0x61, 0xEE, 0x09, 0xC5, 0x7A, 0xD6, 0x5B, 0x19, 
+0xF7, 0x19, 0xA1, 0xE6, 0x1D, 0x2E, 0x05, 0x1E, 
+0x7B, 0xC4, 0xE5, 0xB3, 0x2F, 0x13, 0xD9, 0x75, 
+0xA2, 0x1E, 0xCA, 0xC8, 0x19, 0x54, 0x78, 0x45, 
+0x59, 0x72, 0x52, 
+
+0x8D, 0x39, 0x53, 0xC8, 0xFA, 0x22, 0x96, 0xF2, 
+0x4B, 0xB3, 0xEB, 0x6A, 0x74, 0x48, 0x04, 0x39, 
+0x78, 0x86, 0xE6, 0xCB, 0x95, 0x10, 0x29, 0xD2, 
+0xDB, 0x69, 0x55, 0xE1, 0xE5, 0x37, 0x88, 0x64, 
+0xC1, 0x62, 0x4B, 0x3B, 0xD3, 0x77, 0x5A, 0x48, 
+0x49, 0xEE, 0x7B, 0x20, 0x4F, 0xDF, 0xFA, 0xA4, 
+0x1E, 0x45, 0xF7, 0x2C, 0x74, 0xA1, 0x17, 0xA9, 
+0xA6, 0x6B, 0xD1, 0x01, 0x40, 0x0E, 0x9D, 0xDB, 
+0x2F, 0xF7, 0x05, 0x52, 0x55, 0x4C, 0x05, 0x7C, 
+0xAD, 0xA0, 0xB1, 0x69, 0x55, 0xBC, 0x99, 0x5C, 
+0xD9, 0x34, 0x21, 0xBF, 0xDF, 0xA8, 0xC6, 0x97, 
+0x80, 0xAF, 0x7D, 0x46, 0x4D, 0xA3, 0x9C, 0x5E, 
+0x71, 0x1E, 0x00, 0x5C, 0xBD, 0x8D, 0xAA, 0xAE, 
+0x01, 0x80, 0x1B, 0x7F, 0x74, 0xC7, 0x16, 0x9C, 
+0x6E, 0xC1, 0x5C, 0x07, 0xCD, 0xB2, 0xCB, 0x68, 
+0x37, 0xA7, 0x30, 0x3A, 
+
+0x75, 0x90, 0x12, 0xD5, 0x22, 0x82, 0xA6, 0xA6, 
+0x96, 0xB2, 0x4E, 0x6A, 0xB3, 0x25, 0xE9, 0xE7, 
+0xBB, 0x14, 0xA6, 0x54, 0xDF, 0x71, 0xAE, 0xC7, 
+0x4D, 0x10, 0xD2, 0xDE, 0x42, 0x23, 0xFE, 0x19, 
+0x7C, 0xFF, 0x46, 0xA3, 0xE4, 0x9E, 0xF3, 0x46, 
+0x32, 0xF5, 0xE3, 0xD1, 0x5E, 0x49, 0xF5, 0x99, 
+0x96, 0x33, 0xF2, 0x30, 0xD2, 0xC4, 0xC8, 0xA2, 
+0xB7, 0xF6, 0x33, 0x07, 0xE2, 0x41, 0x7B, 0x10, 
+0x5D, 0x0F, 0x3A, 0x23, 0x8A, 0xC3, 0xC3, 0xEA, 
+0xFC, 0x0F, 0x4D, 0xBC, 0xCB, 0x35, 0x13, 0x97, 
+0xBA, 0x9E, 0xD2, 0x89, 0xBD, 0xF9, 0x28, 0xE7, 
+0xFC, 0x88, 0xFF, 0xD6, 0x59, 0xBF, 0x6D, 0x2E, 
+0x44, 0x2E, 0x7D, 0xCD, 0xF8, 0x0D, 0x40, 0x2A, 
+0xCC, 0x6A, 0xCF, 0x8B, 0x09, 0x5C, 0xA4, 0xB7, 
+0x83, 0x3F, 0xD3, 0x4F, 0xC9, 0x04, 0x21, 0x46, 
+0x4E, 0xB0, 0x26, 0x2D, 0x8B, 
+
+0x41, 0xBD, 0xD4, 0x7D, 0x9B, 0x7B, 0xA3, 0x57, 
+0xD4, 0x55, 0x06, 0xDA, 0xDF, 0xAE, 0xD4, 0xA3, 
+0x88, 0x7F, 0xAC, 0x07, 0xB1, 0x9A, 0x6E, 0x02, 
+0x96, 0x89, 0x68, 0x4F, 0xC9, 0x76, 0xAA, 0x74, 
+0x23, 0xAD, 0x7C, 0xAD, 0xEC, 0x67, 0x34, 0x03, 
+0x70, 0xDD, 0x4E, 0xF2, 0xB4, 0x70, 0x40, 0x80, 
+0x0E, 0x98, 0xDB, 0x6E, 0x15, 0xDA, 0x86, 0x67, 
+0xA1, 0x5B, 0x37, 0x17, 0x1F, 0xEF, 0x3E, 0x3B, 
+0xB5, 0xF8, 0x45, 0xE6, 0xD3, 0xB3, 0x66, 0xD2, 
+0xC8, 0x1C, 0xD8, 0xD0, 0x85, 0x23, 0x25, 0x6C, 
+0x21, 0x8F, 0x0C, 0xC3, 0xC5, 0xBC, 0x27, 0x3D, 
+0x37, 0x2A, 0x8F, 0x18, 0xB0, 0x60, 0xF5, 0xE3, 
+0xD5, 0x9A, 0x8C, 0xE4, 0xEA, 0xAB, 0x4D, 0xB2, 
+0x9E, 0x65, 0x82, 0x43, 0x5D, 0xAC, 0x69, 0x61, 
+0x23, 0x34, 0x59, 0x47, 0xF7, 0xA0, 0xA8, 0xDE, 
+0x5E, 0xDB, 0x8B, 0x71, 0x32, 0x16, 
+
+0xEE, 0xB6, 0xDB, 0x3E, 0x6F, 0xF3, 0x55, 0x8E, 
+0xDD, 0x54, 0xB5, 0x24, 0xE0, 0xE7, 0x97, 0x36, 
+0xCB, 0xF5, 0xF1, 0xF2, 0xF2, 0x0C, 0x6B, 0x72, 
+0xED, 0x8E, 0x9A, 0xC9, 0xD5, 0x16, 0xF8, 0x3B, 
+0x99, 0xD5, 0x87, 0xBD, 0xEB, 0xF0, 0x99, 0xB1, 
+0x52, 0xE7, 0xAF, 0xE4, 0x91, 0xBD, 0x6F, 0xA1, 
+0x5B, 0x03, 0x93, 0x8A, 0xBE, 0x38, 0xE1, 0x04, 
+0x39, 0x6A, 0x37, 0x26, 0x1A, 0x94, 0x42, 0x7C, 
+0x2F, 0x13, 0x49, 0xFF, 0x02, 0xA5, 0x15, 0xA9, 
+0x55, 0xFB, 0xEF, 0x37, 0xFC, 0xD1, 0x06, 0xCB, 
+0x85, 0x5C, 0x6B, 0x23, 0x24, 0xCF, 0x8B, 0x66, 
+0x3E, 0xF9, 0xD8, 0xC2, 0x8D, 0x44, 0x92, 0x56, 
+0x72, 0x2E, 0xC3, 0x5B, 0xCE, 0xE0, 0x9D, 0x04, 
+0x0C, 0xC2, 0x06, 0xE4, 0xAA, 0x80, 0x34, 0x33, 
+0x4E, 0x6C, 0x5E, 0x94, 0x7E, 0x74, 0x10, 0xB4, 
+0xFB, 0x0B, 0x79, 0xF5, 0x8E, 0x0A, 0x9A, 
+
+0xAF, 0xC6, 0x64, 0x4F, 0xB4, 0xBA, 0x2D, 0x6A, 
+0x4B, 0x6E, 0xCD, 0x80, 0xC5, 0xC8, 0x04, 0x63, 
+0xC9, 0x76, 0x01, 0x55, 0x2D, 0x4E, 0x89, 0xBB, 
+0xD0, 0xC7, 0x0A, 0x16, 0x45, 0x8E, 0xF9, 0x66, 
+0xE4, 0x76, 0x48, 0x4F, 0xEE, 0xB2, 0xAE, 0xD0, 
+0x94, 0x45, 0x79, 0xD1, 0xCD, 0xDC, 0x68, 0x7B, 
+0x74, 0x14, 0x3B, 0x93, 0xF0, 0xAE, 0xB5, 0x92, 
+0x88, 0x2A, 0x4B, 0xA4, 0x84, 0xDB, 0x7F, 0x02, 
+0xE0, 0x41, 0xB3, 0x1E, 0x00, 0x7C, 0x63, 0x23, 
+0xFF, 0xF3, 0xF7, 0xB8, 0x26, 0x48, 0x50, 0x0F, 
+0xEC, 0x28, 0xC6, 0x56, 0x4F, 0x79, 0xF1, 0xFF, 
+0xBE, 0x04, 0x4B, 0x19, 0x56, 0xDD, 0x7F, 0xF8, 
+0x1E, 0x24, 0x89, 0x48, 0x06, 0xB7, 0x94, 0xC4, 
+0x29, 0x33, 0xE3, 0x55, 0xAF, 0x44, 0x95, 0xBB, 
+0xC3, 0x73, 0x34, 0x03, 0x05, 0xFA, 0x39, 0x44, 
+0x83, 0x6D, 0x34, 0xE3, 0x4D, 0x3F, 0x8E, 0x21, 
+
+0x21, 0x98, 0x88, 0xAC, 0x7E, 0xBE, 0xB6, 0x6D, 
+0x42, 0x84, 0xE4, 0xF1, 0x58, 0x71, 0xB1, 0x9A, 
+0x71, 0xEF, 0x63, 0xC3, 0x2F, 0x0C, 0x0B, 0x58, 
+0x84, 0x13, 0x89, 0x02, 0xC9, 0x28, 0x09, 0x27, 
+0x3C, 0xC1, 0x28, 0x4A, 0x48, 0xF3, 0x20, 0x6B, 
+0x73, 0x74, 0xA4, 0x77, 0xEA, 0x5D, 0x9D, 0xF9, 
+0x39, 0x1C, 0x29, 0x1A, 0x63, 0x2B, 0x84, 0xD9, 
+0x35, 0x9A, 0xA6, 0x65, 0x55, 0xD8, 0xF1, 0x4E, 
+0xEB, 0xC1, 0x0A, 0xB3, 0x8C, 0x65, 0xEE, 0xE6, 
+0xEC, 0x26, 0x54, 0x9A, 0x8F, 0xCB, 0x9B, 0xD6, 
+0x94, 0xCA, 0x33, 0x35, 0x74, 0x8B, 0xE2, 0xDA, 
+0x45, 0xF9, 0xC2, 0x41, 0x28, 0x6B, 0xC6, 0xE8, 
+0x28, 0x8A, 0xA0, 0xB6, 0xA6, 0x51, 0x02, 0x72, 
+0xDC, 0x62, 0x61, 0xB9, 0x45, 0xE4, 0xF0, 0x78, 
+0x3E, 0x86, 0xA4, 0x46, 0x80, 0xEF, 0x6C, 0x32, 
+0x9F, 0x93, 0x80, 0xC4, 0xF9, 0x25, 0xB9, 0xEB, 
+0x07, 
+
+0x93, 0xCC, 0xF9, 0xD6, 0x6E, 0xFD, 0x88, 0xC6, 
+0x37, 0xB0, 0x46, 0x4B, 0xB4, 0x8C, 0x3A, 0x2E, 
+0x45, 0x0D, 0xAF, 0xAD, 0xA9, 0x8E, 0x5C, 0xBE, 
+0x75, 0xEB, 0xC8, 0x6A, 0x34, 0x1A, 0xE2, 0x21, 
+0x69, 0xF7, 0x4E, 0x6C, 0xA4, 0x4F, 0xDD, 0x64, 
+0x18, 0xAA, 0x96, 0xB9, 0x08, 0x19, 0xF8, 0xF4, 
+0xC4, 0x53, 0xD8, 0xEC, 0x80, 0x82, 0x1A, 0x63, 
+0x8C, 0xD4, 0x4F, 0xFB, 0x31, 0xBC, 0x6E, 0x59, 
+0x02, 0x8B, 0x7E, 0x33, 0xF4, 0x7B, 0x10, 0xE6, 
+0xDA, 0xAE, 0xA7, 0x15, 0x23, 0x4E, 0x1B, 0xA4, 
+0x38, 0x7E, 0x72, 0x09, 0xE3, 0x81, 0xE6, 0xC3, 
+0x61, 0x30, 0x4B, 0x7F, 0x5A, 0x96, 0x7F, 0x38, 
+0xC7, 0x34, 0xC8, 0x61, 0xAF, 0x73, 0x5E, 0x39, 
+0x30, 0x53, 0x8F, 0xC7, 0xB8, 0x5E, 0x51, 0x2D, 
+0xF9, 0x9B, 0x70, 0x0B, 0x9F, 0x12, 0x01, 0xCF, 
+0xC4, 0x0F, 0x03, 0x18, 0xE3, 0x76, 0xFE, 0x6C, 
+0x88, 0x27, 
+
+0x02, 0xC6, 0x1D, 0x1A, 0xE1, 0xDC, 0xCD, 0xFD, 
+0x93, 0xB4, 0x19, 0x9A, 0x25, 0xCD, 0x0E, 0x26, 
+0x2E, 0xDB, 0xE8, 0x13, 0xDE, 0xDD, 0x8C, 0x71, 
+0xF4, 0xA7, 0x5C, 0xDA, 0x9D, 0x60, 0x8A, 0x6B, 
+0x39, 0x6F, 0x42, 0x31, 0xD7, 0x12, 0x44, 0x3C, 
+0x0B, 0x62, 0x7F, 0x0F, 0xB5, 0x94, 0x97, 0xC6, 
+0x68, 0xB4, 0x9F, 0xDE, 0x21, 0xAF, 0xFD, 0xD9, 
+0xCF, 0xDC, 0x68, 0x0C, 0xC7, 0x49, 0xC6, 0xBF, 
+0xF4, 0x3C, 0x1F, 0x31, 0x86, 0x14, 0x94, 0x4B, 
+0xF6, 0xFE, 0xAE, 0xE0, 0x9D, 0x4D, 0x96, 0x0B, 
+0x9C, 0x15, 0x11, 0x53, 0xE6, 0x88, 0xCD, 0xC3, 
+0x80, 0x35, 0x5B, 0x19, 0x87, 0xE5, 0x67, 0x2A, 
+0xA2, 0xA8, 0x99, 0x1D, 0xF0, 0xAA, 0x37, 0x29, 
+0x5F, 0xDB, 0x9F, 0x38, 0x9D, 0x48, 0x69, 0x0B, 
+0x8C, 0x2B, 0xE0, 0x72, 0x05, 0x70, 0xD9, 0xEF, 
+0x4F, 0xEC, 0x74, 0xA9, 0xE6, 0xF2, 0xA9, 0xAD, 
+0x78, 0x4D, 0x10, 
+
+0x33, 0x67, 0xF5, 0xC8, 0x0C, 0xE7, 0x1B, 0xCE, 
+0x19, 0xCC, 0x3B, 0xBB, 0x14, 0x4D, 0x5E, 0x63, 
+0x6A, 0x91, 0xC1, 0xBE, 0xC1, 0xF6, 0xF4, 0x7E, 
+0xE8, 0x5E, 0x36, 0x51, 0x24, 0x41, 0x81, 0x0C, 
+0xCC, 0x38, 0xB3, 0x2F, 0x5B, 0xDA, 0x18, 0xD5, 
+0xEB, 0xD6, 0xEE, 0x72, 0x3A, 0xB8, 0x52, 0xF8, 
+0x1D, 0x0B, 0xE2, 0xE2, 0xAD, 0x6B, 0x7F, 0x3D, 
+0x50, 0x56, 0x13, 0xF9, 0xDA, 0x1E, 0x02, 0x2D, 
+0x91, 0x50, 0x41, 0x93, 0x14, 0x3C, 0xF7, 0xEA, 
+0xC6, 0xD4, 0x54, 0xDA, 0x89, 0xC3, 0xE4, 0xAD, 
+0x7C, 0x08, 0xD0, 0x6C, 0x21, 0x44, 0x10, 0x40, 
+0x7D, 0x55, 0xC9, 0x15, 0x80, 0xCC, 0x10, 0x45, 
+0xAB, 0x78, 0x90, 0x25, 0x48, 0x2A, 0xFA, 0x01, 
+0x81, 0x84, 0x8F, 0x39, 0xC1, 0x8A, 0x3E, 0x09, 
+0x2B, 0xE7, 0x2B, 0x18, 0xEF, 0x38, 0x7A, 0xDC, 
+0xB2, 0x36, 0x7A, 0x42, 0x09, 0xDC, 0xBA, 0xA7, 
+0x7F, 0x41, 0x68, 0x1B, 
+
+0x99, 0xBD, 0x4A, 0x3F, 0xE7, 0x5E, 0xCC, 0x2D, 
+0x37, 0x71, 0x58, 0xE4, 0x78, 0x71, 0x2B, 0x8F, 
+0x0C, 0x43, 0x6D, 0x84, 0x1C, 0xBD, 0x6E, 0x82, 
+0x32, 0x42, 0xDE, 0xF5, 0x0E, 0x36, 0x00, 0x01, 
+
+0xCE, 0x54, 0xC4, 0xB6, 0x94, 0xBC, 0x30, 0x8B, 
+0x08, 0xD7, 0x15, 0x94, 0x5C, 0x9B, 0xB8, 0xB5, 
+0xDA, 0x9F, 0x6E, 0x2A, 0xC3, 0x76, 0x08, 0xCF, 
+0x5A, 0x67, 0xC4, 0xF0, 0x73, 0x61, 0x7F, 0x08, 
+0x30, 
+
+0x41, 0xF6, 0x9F, 0x06, 0x8B, 0x45, 0xDD, 0xCB, 
+0x6F, 0x45, 0xF5, 0x1D, 0xFD, 0xF2, 0x99, 0xA7, 
+0x19, 0x75, 0x04, 0x0E, 0x4C, 0xF8, 0x25, 0x06, 
+0x0E, 0x3B, 0xFB, 0x5A, 0xD1, 0x5C, 0x76, 0x06, 
+0x37, 0xA2, 
+
+0xDF, 0xA2, 0xB7, 0x05, 0xBF, 0xE7, 0x0A, 0x75, 
+0x34, 0x20, 0x2A, 0xBC, 0xED, 0xA5, 0xB5, 0x11, 
+0xD5, 0xAC, 0x06, 0x21, 0x8D, 0x23, 0x38, 0x2D, 
+0xED, 0xC1, 0x85, 0x74, 0x57, 0xA3, 0x67, 0xE1, 
+0x14, 0x19, 0xC3, 
+
+0xB0, 0xF6, 0x5C, 0xFE, 0x71, 0x30, 0x03, 0x16, 
+0x42, 0x27, 0x14, 0x70, 0x0D, 0x9E, 0x2C, 0x55, 
+0xC8, 0x6F, 0xAE, 0x58, 0x54, 0x27, 0xF2, 0x18, 
+0xEC, 0xA8, 0xAD, 0x9F, 0xAE, 0xCF, 0x04, 0x0C, 
+0xEC, 0x36, 0xC1, 0x82, 
+
+0xA8, 0x5D, 0x0F, 0xD7, 0xF0, 0x64, 0xA1, 0x1A, 
+0xE8, 0x88, 0x9D, 0x79, 0x18, 0x39, 0xE7, 0xB3, 
+0x69, 0x38, 0x10, 0x72, 0xE4, 0xC8, 0x80, 0xF6, 
+0xD3, 0x8E, 0x5D, 0x88, 0x2D, 0xF8, 0xA3, 0xC1, 
+0x13, 0x1E, 0x7F, 0xFE, 0x76, 
+
+0x9D, 0xFD, 0x47, 0xAA, 0x74, 0x8E, 0x2E, 0xDE, 
+0x1D, 0xD4, 0xDD, 0x65, 0xC2, 0xDD, 0xBF, 0xC6, 
+0xEE, 0x5D, 0xF5, 0xB3, 0xE2, 0x2F, 0x4F, 0x48, 
+0x35, 0x16, 0x8E, 0xB3, 0x45, 0x25, 0x05, 0x2C, 
+0xAE, 0x98, 0xDA, 0xF7, 0x18, 0x43, 
+
+0xF1, 0x11, 0x4D, 0x1B, 0x9B, 0x1B, 0x85, 0xBB, 
+0x85, 0xBA, 0x16, 0x53, 0x5F, 0xF1, 0xDC, 0x29, 
+0xE2, 0x4D, 0xC0, 0x00, 0xDA, 0xE1, 0x04, 0x6D, 
+0x4B, 0xD9, 0xEF, 0x81, 0x72, 0xC7, 0x3A, 0xCE, 
+0xF7, 0x5A, 0x7E, 0xD7, 0x21, 0x1C, 0x5D, 
+
+0xB1, 0x4E, 0x44, 0x29, 0x02, 0xC1, 0x0D, 0x0B, 
+0x1A, 0x54, 0x51, 0x40, 0xEB, 0x2B, 0x79, 0x6D, 
+0x7B, 0x64, 0xE8, 0x0E, 0x82, 0x52, 0xB6, 0xD3, 
+0x78, 0x18, 0x10, 0xEB, 0x59, 0x72, 0x50, 0xDF, 
+0x57, 0x03, 0x23, 0x79, 0x8A, 0xCD, 0x81, 0x83, 
+
+0x3D, 0x18, 0xB0, 0x58, 0xB4, 0x9F, 0xD5, 0x77, 
+0xBE, 0xFE, 0x47, 0x09, 0xE0, 0x06, 0xD9, 0x6A, 
+0x0D, 0xA0, 0xB6, 0x3A, 0x6A, 0xDB, 0x54, 0x1B, 
+0x4D, 0x66, 0xFD, 0x78, 0xDE, 0xE7, 0x06, 0x4E, 
+0x86, 0x19, 0xE8, 0x69, 0x1E, 0xFC, 0x47, 0x31, 
+0xCF, 
+
+0x0D, 0x93, 0x50, 0x2D, 0xCC, 0x45, 0xAC, 0x5B, 
+0x23, 0xE2, 0x41, 0xBE, 0x0D, 0xDF, 0x50, 0x9E, 
+0xAD, 0x42, 0x99, 0x7C, 0xF9, 0x6A, 0x29, 0x23, 
+0x8D, 0xE9, 0xB4, 0x73, 0xFF, 0x5C, 0xC5, 0xB4, 
+0xE5, 0x82, 0xB8, 0x6D, 0x50, 0x06, 0x06, 0x38, 
+0x33, 0x51, 
+
+0x2B, 0x32, 0x40, 0xC6, 0x5F, 0x2E, 0x0E, 0x7B, 
+0x0E, 0x81, 0x82, 0x42, 0x89, 0xDA, 0x37, 0xEE, 
+0xD5, 0x77, 0xD5, 0x9B, 0xB6, 0x90, 0x71, 0xA8, 
+0xD4, 0xC9, 0x9A, 0xAD, 0x91, 0x34, 0xB5, 0x03, 
+0xA5, 0xC2, 0xF9, 0x92, 0x3F, 0xB4, 0x80, 0xBA, 
+0x9D, 0xC4, 0x45, 
+
+0xCA, 0xEF, 0x6D, 0x82, 0x4E, 0xDC, 0x3C, 0x25, 
+0x1A, 0x4B, 0x01, 0xD8, 0x4F, 0x4A, 0xBB, 0xD8, 
+0x80, 0xF4, 0x6C, 0xE2, 0x69, 0x48, 0x00, 0x30, 
+0xEB, 0x21, 0xFD, 0xD7, 0xA1, 0xA6, 0x33, 0x01, 
+0x64, 0xAB, 0x6B, 0xFA, 0xCE, 0x99, 0x8E, 0x2B, 
+0x82, 0x84, 0xC6, 0xFC, 
+
+0x9A, 0x2C, 0x3A, 0x10, 0xC7, 0x17, 0xD0, 0x9E, 
+0x09, 0x96, 0x2F, 0xF4, 0x6D, 0xC0, 0xC1, 0xD6, 
+0x72, 0xF1, 0x85, 0xE9, 0x85, 0x67, 0x8A, 0x6F, 
+0xBA, 0x64, 0xA1, 0x06, 0x2F, 0xEB, 0x1E, 0xA2, 
+0x39, 0x56, 0xF9, 0xDC, 0x39, 0xAE, 0x5A, 0x33, 
+0xFF, 0xBD, 0xC3, 0x07, 0xD7, 
+
+0xEB, 0xFC, 0x68, 0xFD, 0x8F, 0xF0, 0x90, 0x41, 
+0xEB, 0xCD, 0xC6, 0x1C, 0x47, 0x09, 0xA5, 0xE2, 
+0x0D, 0xA2, 0xFA, 0x86, 0x11, 0x5E, 0x38, 0xDC, 
+0x5F, 0x29, 0xAC, 0xC2, 0x06, 0xBC, 0xFA, 0x35, 
+0x17, 0x09, 0x9C, 0x33, 0x78, 0xA5, 0x42, 0x9A, 
+0x79, 0x1D, 0x72, 0xE0, 0xF9, 0xB9, 
+
+0x5C, 0xA4, 0x28, 0xAA, 0x07, 0xDA, 0x38, 0x23, 
+0xF1, 0x3C, 0xB2, 0xD7, 0x09, 0x58, 0xCF, 0x76, 
+0x48, 0xB9, 0xF0, 0x81, 0x76, 0x2D, 0xFE, 0xBD, 
+0xFA, 0x2E, 0x7A, 0x7A, 0xED, 0xCD, 0xFE, 0x69, 
+0x05, 0x2A, 0x63, 0xC3, 0xFE, 0x33, 0x0A, 0xCF, 
+0xC0, 0xCA, 0x49, 0x02, 0x75, 0xA3, 0x39, 
+
+0x24, 0x60, 0xF8, 0xC3, 0xFF, 0xD0, 0xD4, 0x84, 
+0xCA, 0xA6, 0x12, 0x5E, 0xAC, 0xD2, 0x67, 0xC2, 
+0x7C, 0x61, 0x9B, 0x34, 0x7A, 0xFB, 0xD8, 0xE5, 
+0x74, 0xFB, 0xDB, 0xE2, 0xB8, 0xB7, 0x2D, 0xCD, 
+0xDF, 0xF9, 0x09, 0x5C, 0xDC, 0x59, 0xED, 0x68, 
+0x66, 0x20, 0x42, 0x46, 0x0D, 0xFE, 0x7F, 0x9B, 
+
+0xBB, 0xFE, 0x60, 0xB0, 0x72, 0xF0, 0x6B, 0x85, 
+0x13, 0xDE, 0x24, 0xEB, 0xE4, 0x79, 0xC6, 0x86, 
+0x8A, 0x79, 0x41, 0xB9, 0x0A, 0xF8, 0xCA, 0x8C, 
+0x64, 0x7C, 0x53, 0x93, 0x6E, 0x0F, 0x44, 0x66, 
+0x2F, 0x76, 0x23, 0x5A, 0xAB, 0x00, 0xD8, 0x51, 
+0x7B, 0x84, 0x6C, 0x14, 0x23, 0x6F, 0xEB, 0xB2, 
+0xCE, 
+
+0x2E, 0x36, 0x80, 0x9D, 0x40, 0xEB, 0xB5, 0xEE, 
+0x73, 0xC8, 0x7A, 0xC2, 0xAB, 0x2F, 0x53, 0x4C, 
+0x94, 0xB1, 0xF8, 0xB6, 0x71, 0xCB, 0x39, 0x21, 
+0xB1, 0x65, 0xDC, 0x14, 0x80, 0x01, 0x32, 0xF7, 
+0x98, 0xDD, 0x52, 0xDE, 0x6F, 0x1E, 0x49, 0x2E, 
+0x4A, 0x34, 0xEC, 0x7E, 0xFD, 0xBA, 0x30, 0xAC, 
+0x6C, 0x0B, 
+
+0xA7, 0xE7, 0x87, 0xC9, 0x47, 0xD4, 0x1F, 0x88, 
+0x70, 0xFA, 0xDC, 0xBE, 0x42, 0x43, 0x36, 0x90, 
+0x5B, 0x22, 0xE5, 0x73, 0x89, 0xCA, 0xCB, 0xA7, 
+0xB3, 0x24, 0x5B, 0xB9, 0x76, 0x8A, 0x46, 0xDB, 
+0x7A, 0xAB, 0xD1, 0x4E, 0x19, 0xC3, 0x14, 0x97, 
+0x64, 0x8A, 0xD9, 0xC9, 0x3A, 0x17, 0x7E, 0x29, 
+0xAA, 0x7F, 0xAC, 
+
+0x7B, 0x0D, 0x18, 0x99, 0x5D, 0x2B, 0x7B, 0xD5, 
+0xA8, 0x90, 0xB8, 0xBB, 0x02, 0x4F, 0x2F, 0xE5, 
+0x0E, 0x34, 0x5B, 0x12, 0x1D, 0x35, 0xC0, 0xC7, 
+0x23, 0xD1, 0x61, 0x3E, 0x26, 0xF0, 0x2F, 0x61, 
+0x33, 0xDC, 0x64, 0x01, 0x94, 0x15, 0x2F, 0x4A, 
+0xC6, 0x40, 0x5B, 0x7E, 0x1F, 0xF0, 0xE6, 0x48, 
+0x6F, 0xAC, 0x69, 0x8D, 
+
+0x25, 0xF1, 0xB9, 0xCE, 0xB2, 0xFD, 0x4F, 0x7F, 
+0x32, 0xAF, 0xCC, 0x2F, 0x16, 0x94, 0xE0, 0x4B, 
+0xF3, 0xD3, 0xE6, 0x50, 0x93, 0x8A, 0xBE, 0xEF, 
+0x39, 0x1B, 0x64, 0x34, 0xFF, 0xC5, 0x1C, 0x78, 
+0x9F, 0xD8, 0x7A, 0x0C, 0x96, 0x06, 0x1F, 0xB2, 
+0x54, 0x7F, 0x34, 0x68, 0xE2, 0x28, 0xAA, 0x72, 
+0x2C, 0x93, 0x94, 0xB5, 0x18, 
+
+0x4D, 0xC1, 0x31, 0x8A, 0x4E, 0x14, 0xD5, 0x88, 
+0x7E, 0x18, 0x44, 0x84, 0x05, 0x3C, 0x71, 0x62, 
+0xD0, 0x4F, 0x60, 0x8F, 0xD4, 0x6A, 0xCA, 0xBC, 
+0x9E, 0xCE, 0x78, 0xBC, 0x7C, 0xB6, 0xF1, 0x5C, 
+0xDB, 0x72, 0xE6, 0xF6, 0xF9, 0x70, 0x0F, 0x8A, 
+0xA3, 0x01, 0xCE, 0x38, 0xC4, 0x4C, 0x31, 0x9E, 
+0x8E, 0x5E, 0x38, 0xE9, 0x59, 0xA2, 
+
+0x37, 0xB8, 0x0A, 0xF8, 0x7A, 0x32, 0x32, 0x1B, 
+0xD3, 0x4D, 0x5F, 0x87, 0x35, 0x9E, 0xD6, 0x68, 
+0x76, 0x83, 0xA3, 0xE6, 0x4C, 0x4D, 0x42, 0xA1, 
+0x1E, 0x99, 0xA1, 0x60, 0x60, 0x5A, 0x96, 0x8F, 
+0xB3, 0xE0, 0x0F, 0x6E, 0xFA, 0x2B, 0xE6, 0x5A, 
+0xDD, 0xDF, 0x7B, 0xE7, 0x34, 0xDE, 0x6D, 0x6C, 
+0x40, 0xA0, 0xD2, 0x25, 0xF0, 0x0B, 0x5B, 
+
+0x56, 0xA4, 0x72, 0x5B, 0x1B, 0x58, 0xC0, 0x78, 
+0xCB, 0xD6, 0x97, 0x21, 0x4F, 0x12, 0xED, 0xD6, 
+0x56, 0xEA, 0xA1, 0xF2, 0x03, 0xC4, 0xE8, 0xC8, 
+0x3B, 0x98, 0x48, 0x2D, 0xF0, 0x90, 0x4F, 0xE9, 
+0xEA, 0x37, 0x63, 0x1B, 0xB5, 0x7F, 0x92, 0xB2, 
+0x66, 0x5C, 0x68, 0xFC, 0xAE, 0xBA, 0xAC, 0x83, 
+0x9C, 0xD2, 0x18, 0x75, 0xAA, 0x7E, 0x11, 0xC8, 
+
+0xE4, 0x5A, 0xEB, 0xD0, 0xF7, 0xC5, 0x3A, 0x09, 
+0xDB, 0x2F, 0x24, 0x23, 0xF0, 0xCB, 0x29, 0x6F, 
+0xEE, 0x3E, 0xBD, 0xB4, 0x0A, 0x23, 0x7B, 0x45, 
+0xDE, 0x85, 0x20, 0x21, 0x3F, 0x0C, 0xBA, 0x22, 
+0x41, 0xE9, 0xF7, 0x87, 0xFB, 0xB6, 0x30, 0xAF, 
+0x75, 0x2C, 0xC6, 0x93, 0xB0, 0x00, 0x48, 0xDD, 
+0x01, 0xE2, 0x4B, 0xC9, 0xB0, 0xCF, 0x70, 0xA1, 
+0x6B, 
+
+0x39, 0xF2, 0x04, 0xA4, 0x75, 0x4B, 0xA7, 0x65, 
+0xE0, 0x47, 0x6B, 0x51, 0x08, 0xE4, 0xE2, 0x30, 
+0xBF, 0x0D, 0xA8, 0xCB, 0x9D, 0x55, 0x4C, 0xC7, 
+0x6F, 0x02, 0x0E, 0x6F, 0x7B, 0x64, 0xA9, 0x82, 
+0xA6, 0x59, 0x76, 0x9E, 0x05, 0x84, 0xBC, 0x67, 
+0x27, 0x74, 0xE7, 0xDA, 0xDD, 0x2B, 0x73, 0x2B, 
+0x2A, 0xFD, 0x2A, 0x97, 0xB4, 0xF8, 0xEB, 0xD9, 
+0xC9, 0x55, 
+
+0x02, 0xEF, 0x2D, 0x19, 0xA0, 0x31, 0xB2, 0x1A, 
+0x55, 0x0F, 0xED, 0x61, 0xE0, 0x31, 0x4F, 0xA5, 
+0x58, 0xBB, 0xC8, 0x26, 0x99, 0x5D, 0xE2, 0x83, 
+0x30, 0x0C, 0x83, 0x7E, 0x73, 0x15, 0x2F, 0x76, 
+0x5B, 0xC1, 0xB3, 0x0B, 0x65, 0x95, 0x5E, 0x0C, 
+0xB1, 0xFF, 0xD8, 0x65, 0x27, 0xAD, 0x0A, 0x5F, 
+0x95, 0xFA, 0x0E, 0x22, 0xFF, 0x03, 0x04, 0xA6, 
+0x7A, 0x3F, 0xF2, 
+
+0x56, 0x63, 0x4E, 0x4C, 0x7A, 0x62, 0x47, 0x13, 
+0xB5, 0x90, 0x38, 0xCF, 0x37, 0xC2, 0x39, 0xE8, 
+0x65, 0x69, 0x33, 0xD6, 0x3B, 0x67, 0x92, 0xA7, 
+0x7D, 0xBF, 0x33, 0xB4, 0x03, 0x7A, 0x1C, 0xA7, 
+0x53, 0x8F, 0x77, 0xCE, 0x1D, 0xB1, 0x7C, 0x68, 
+0x4D, 0xE6, 0xC3, 0x74, 0xA4, 0x89, 0x11, 0x80, 
+0xBA, 0x69, 0xD2, 0x61, 0xEB, 0xF8, 0x8D, 0x26, 
+0x9C, 0x11, 0x59, 0x8A, 
+
+0x43, 0xA8, 0x06, 0x5A, 0xE2, 0x63, 0x37, 0x2C, 
+0xB4, 0xFF, 0xF8, 0x67, 0x04, 0x9C, 0x42, 0xC1, 
+0x79, 0xFE, 0x03, 0xB6, 0x73, 0xB8, 0xE9, 0x9B, 
+0x2C, 0xEA, 0x91, 0x9D, 0x7C, 0x65, 0xF8, 0x73, 
+0xB3, 0x2D, 0x1B, 0x3E, 0xD4, 0x31, 0x23, 0xBF, 
+0xCA, 0xF4, 0x16, 0xF3, 0x42, 0x4B, 0x19, 0x2E, 
+0x63, 0x4E, 0x96, 0xB0, 0x4E, 0x31, 0x9F, 0xEF, 
+0xA4, 0x14, 0xA7, 0x01, 0x60, 
+
+0xB3, 0x94, 0xAB, 0x76, 0xB4, 0xCD, 0x95, 0xEE, 
+0x2B, 0x0D, 0xC7, 0xA1, 0x94, 0xBE, 0x62, 0x43, 
+0xC9, 0xCE, 0x2C, 0x44, 0x00, 0x81, 0x61, 0xFB, 
+0x70, 0xC7, 0x03, 0xDB, 0x50, 0x24, 0xD2, 0xB6, 
+0x97, 0x73, 0x07, 0x3E, 0xBF, 0xAE, 0xEC, 0x81, 
+0xE9, 0x75, 0x35, 0x7F, 0x77, 0x50, 0x70, 0x66, 
+0xC7, 0x4E, 0x00, 0x0B, 0xDF, 0x07, 0xB1, 0x46, 
+0x42, 0x83, 0x55, 0xF2, 0x9E, 0x9E, 
+
+0xD5, 0x2E, 0xD3, 0x4B, 0x0F, 0x9A, 0x8D, 0xFE, 
+0x6D, 0x14, 0x7B, 0x7A, 0xDC, 0xC5, 0x86, 0xA0, 
+0xD1, 0x7D, 0x53, 0xEC, 0xFE, 0xA9, 0xD9, 0xAF, 
+0x63, 0xB7, 0x72, 0x1E, 0x92, 0xCA, 0x07, 0xEE, 
+0x69, 0x0A, 0x9D, 0xDF, 0xD2, 0xF0, 0x4C, 0xF8, 
+0xB2, 0x8E, 0xD8, 0xCA, 0xC9, 0x0F, 0x3C, 0xE6, 
+0x8E, 0x0A, 0x49, 0x74, 0xDF, 0xDE, 0xE1, 0x94, 
+0x92, 0xC8, 0x87, 0x7F, 0x82, 0x24, 0x58, 
+
+0x0C, 0x0E, 0x1B, 0x45, 0xF3, 0x8C, 0x9B, 0x8F, 
+0x24, 0xDA, 0x40, 0xD4, 0xE9, 0x79, 0x2D, 0xEE, 
+0xBA, 0x8F, 0x9B, 0xF7, 0xDE, 0x64, 0xFA, 0xE6, 
+0x95, 0xBB, 0xEA, 0x57, 0x57, 0x88, 0x79, 0x57, 
+0x9C, 0x85, 0x8B, 0xD4, 0x67, 0x27, 0x11, 0x70, 
+0x80, 0xA5, 0x37, 0xD2, 0xA1, 0x9F, 0xA2, 0x25, 
+0x2D, 0xF1, 0x27, 0xC9, 0x23, 0x77, 0xB8, 0x72, 
+0xA4, 0x1B, 0x7F, 0x1F, 0x5D, 0x6E, 0xD2, 0xF5, 
+
+0xE5, 0x3B, 0x7C, 0x2C, 0xFA, 0x94, 0x6D, 0x66, 
+0x21, 0x52, 0xA6, 0xE8, 0xA9, 0x5E, 0xB1, 0x09, 
+0xDD, 0x0F, 0x1C, 0xCE, 0xF3, 0x0B, 0xDE, 0x41, 
+0xF8, 0xAF, 0x96, 0x7A, 0xD2, 0x21, 0x0B, 0xC0, 
+0x88, 0x2E, 0x2D, 0x1D, 0xA2, 0xDE, 0xD8, 0x7B, 
+0x13, 0x64, 0x76, 0xDA, 0x79, 0x48, 0x5C, 0x60, 
+0xA7, 0xE2, 0xFE, 0xAE, 0x0F, 0x77, 0xCD, 0x5A, 
+0xC3, 0x45, 0x80, 0x31, 0x3E, 0xDE, 0xBD, 0xB5, 
+0xFC, 
+
+0x1D, 0xCF, 0xB2, 0x37, 0x35, 0xBE, 0x7A, 0x4B, 
+0xFA, 0x33, 0xD4, 0x4A, 0xD9, 0x5F, 0xAB, 0xA6, 
+0x39, 0x6C, 0x19, 0xC0, 0x6D, 0xD4, 0x42, 0xC3, 
+0xAB, 0x13, 0xDF, 0x26, 0x40, 0x16, 0xD2, 0x33, 
+0xE0, 0x22, 0x1C, 0x93, 0x03, 0x99, 0x45, 0x04, 
+0x47, 0x7B, 0x7F, 0x9D, 0xFA, 0x88, 0xB7, 0x65, 
+0x5B, 0x84, 0xF7, 0xC5, 0xBD, 0x8E, 0x96, 0xE1, 
+0x9E, 0xA1, 0xC7, 0xD7, 0x01, 0x40, 0xC1, 0xB6, 
+0x9C, 0xD0, 
+
+0x83, 0xC8, 0xE7, 0xBE, 0x03, 0xF5, 0xC7, 0x28, 
+0x54, 0xE8, 0x4C, 0x96, 0xCF, 0x81, 0x5B, 0x09, 
+0xDC, 0x7C, 0x42, 0x1D, 0xA9, 0x7D, 0xCE, 0x1E, 
+0x14, 0x9E, 0xFC, 0x4B, 0x3A, 0x03, 0xEC, 0xB2, 
+0x24, 0x7B, 0xAC, 0x23, 0xEA, 0x8E, 0x53, 0x50, 
+0x25, 0x17, 0x6C, 0x14, 0x75, 0x6E, 0xAC, 0xF8, 
+0xAB, 0x47, 0xBF, 0x23, 0xB8, 0x6E, 0x65, 0xAB, 
+0x00, 0xFC, 0x85, 0xD0, 0xE6, 0x89, 0xB2, 0x60, 
+0x77, 0x9C, 0x38, 
+
+0x4C, 0x61, 0x12, 0x51, 0x97, 0x01, 0x15, 0x46, 
+0xD2, 0xC1, 0x4B, 0x4C, 0xFA, 0xF9, 0x9C, 0x48, 
+0x4A, 0x3E, 0x50, 0x59, 0xF7, 0x16, 0x34, 0x7F, 
+0x96, 0xF5, 0x7E, 0xBC, 0x52, 0x17, 0x68, 0xFF, 
+0x60, 0x42, 0x89, 0x94, 0x91, 0x54, 0xD4, 0x6D, 
+0x4D, 0xC6, 0x04, 0x3C, 0xE3, 0xD8, 0x7D, 0x52, 
+0xC8, 0x46, 0xBC, 0xAE, 0xA5, 0xA9, 0xD3, 0x89, 
+0xA2, 0x40, 0x49, 0x21, 0xC5, 0x9D, 0x58, 0x15, 
+0xFB, 0x3B, 0x99, 0x4C, 
+
+0x34, 0xA9, 0x9C, 0x83, 0xA0, 0x3A, 0xA4, 0x98, 
+0xCD, 0xF3, 0x57, 0x2B, 0xB0, 0x70, 0x7F, 0xFE, 
+0x4F, 0xB2, 0xE9, 0x0B, 0x26, 0x69, 0x86, 0xFC, 
+0xA1, 0x12, 0x05, 0x98, 0x01, 0x18, 0x1A, 0x46, 
+0x50, 0xD8, 0x0B, 0x55, 0x14, 0xA3, 0xA1, 0x95, 
+0x26, 0x25, 0x64, 0x99, 0xC1, 0x2A, 0x3E, 0xA9, 
+0xD2, 0x56, 0x19, 0x9A, 0x8C, 0xD1, 0xBC, 0xEA, 
+0x4E, 0xEF, 0xDC, 0x4E, 0xB2, 0x0E, 0x10, 0x11, 
+0x6D, 0x4E, 0xEC, 0x4B, 0x1B, 
+
+0x34, 0x38, 0xAB, 0x2F, 0xAE, 0x8C, 0x68, 0xE2, 
+0xAE, 0xA9, 0xC4, 0x58, 0x15, 0x3B, 0x4D, 0xE9, 
+0x12, 0x87, 0x9C, 0x06, 0x62, 0x6E, 0x52, 0xC6, 
+0x83, 0x61, 0x70, 0x0A, 0x07, 0xED, 0x4C, 0x19, 
+0xCB, 0x5E, 0x6D, 0x80, 0xE5, 0x70, 0x15, 0xE3, 
+0x77, 0x2C, 0x0E, 0xCF, 0x4E, 0x6E, 0x91, 0x27, 
+0xAB, 0x23, 0x17, 0xFE, 0x48, 0x93, 0x40, 0xA9, 
+0x6A, 0x92, 0x48, 0xF0, 0x76, 0x54, 0xC1, 0x80, 
+0xFD, 0x45, 0x75, 0x37, 0x98, 0xB2, 
+
+0xE2, 0x0D, 0xDE, 0xC3, 0xA4, 0xE5, 0x68, 0xAD, 
+0x75, 0xB5, 0xF6, 0x6C, 0x0D, 0xF6, 0xF0, 0x51, 
+0x23, 0x4A, 0x8F, 0x67, 0xD5, 0x2B, 0xDF, 0xB0, 
+0x8F, 0x9F, 0x2E, 0x5E, 0xE2, 0xBD, 0x01, 0x47, 
+0x62, 0x81, 0xA2, 0x54, 0x66, 0x81, 0x5C, 0x31, 
+0xA2, 0x2E, 0xA2, 0x6E, 0x64, 0x6D, 0x05, 0xB4, 
+0xAA, 0xC7, 0xF6, 0x26, 0x80, 0x9E, 0xA5, 0x7D, 
+0xFA, 0x7C, 0x99, 0x1D, 0x46, 0x47, 0x84, 0x23, 
+0x53, 0x7B, 0x6E, 0x5A, 0xE4, 0x9A, 0xB6, 
+
+0xBC, 0x3F, 0x3E, 0xB4, 0x96, 0x7B, 0x98, 0x08, 
+0x25, 0xF0, 0x92, 0xC5, 0x40, 0xCC, 0xD9, 0x74, 
+0x38, 0x11, 0x8A, 0x8D, 0x7B, 0x92, 0x19, 0xCF, 
+0x69, 0xD1, 0x83, 0xD5, 0xBC, 0x92, 0x60, 0xC4, 
+0x0F, 0x12, 0xF3, 0x55, 0x0E, 0xDA, 0x87, 0xE7, 
+0xF1, 0xF5, 0x30, 0xBC, 0x7F, 0xB0, 0x40, 0x1D, 
+0xA7, 0x11, 0x37, 0x34, 0xE9, 0x2E, 0x98, 0xD1, 
+0x8B, 0xFD, 0x2E, 0x89, 0x64, 0xD9, 0x13, 0xFC, 
+0x0F, 0x5C, 0xC4, 0x43, 0xEC, 0x56, 0x3F, 0x07, 
+
+0x0D, 0x8C, 0xD0, 0x30, 0xF5, 0xEE, 0x87, 0xEB, 
+0x21, 0xA6, 0x1D, 0x16, 0xB9, 0x12, 0x7A, 0xF5, 
+0x89, 0xD3, 0xD5, 0x19, 0xAB, 0x02, 0x26, 0x7C, 
+0x74, 0x67, 0xEA, 0x66, 0x8A, 0x35, 0xAD, 0x57, 
+0x6A, 0x63, 0x4A, 0xCD, 0xCA, 0x57, 0x8D, 0x22, 
+0x8F, 0x3F, 0x24, 0x25, 0x86, 0x7C, 0xA1, 0xD2, 
+0x92, 0x2E, 0xA3, 0x96, 0x99, 0x48, 0xB2, 0x7C, 
+0xDF, 0x9C, 0x0B, 0xC2, 0xEF, 0x91, 0xBC, 0x54, 
+0x52, 0xDF, 0x2F, 0x92, 0x01, 0xE4, 0x12, 0xB5, 
+0xE6, 
+
+0x65, 0xE4, 0x16, 0x72, 0x99, 0xBA, 0x92, 0x61, 
+0xCD, 0xAC, 0x6F, 0x42, 0x35, 0x7C, 0x9A, 0xE6, 
+0x6E, 0xA3, 0x20, 0x39, 0xD4, 0x9F, 0x62, 0xAD, 
+0x47, 0xE6, 0x32, 0x19, 0x5C, 0xAC, 0x1D, 0x56, 
+0x63, 0xE4, 0xBD, 0xB5, 0xC4, 0x65, 0x45, 0xAC, 
+0x70, 0xA5, 0x4F, 0x4B, 0x8A, 0x47, 0xDF, 0xE0, 
+0xAC, 0xCE, 0x18, 0xA6, 0xA3, 0x12, 0xCD, 0xE1, 
+0x3B, 0x0F, 0xA7, 0x81, 0x0A, 0x37, 0x6D, 0xDC, 
+0x91, 0x8D, 0x3A, 0xA2, 0x6C, 0x43, 0xE4, 0x96, 
+0x5F, 0x4D, 
+
+0x64, 0x52, 0x31, 0x26, 0xE8, 0x18, 0x86, 0xCA, 
+0xE9, 0xCF, 0xDD, 0x00, 0xF2, 0x6E, 0x92, 0x97, 
+0x85, 0xAC, 0x6B, 0x86, 0xF2, 0x59, 0xC5, 0x70, 
+0x64, 0x37, 0x7C, 0x72, 0x21, 0x62, 0x10, 0x58, 
+0x68, 0x6E, 0xA7, 0xED, 0x08, 0xA6, 0x6F, 0x5B, 
+0x9D, 0x5C, 0x72, 0x3A, 0x82, 0x71, 0xA1, 0x24, 
+0x51, 0x71, 0x32, 0x0A, 0x91, 0xD0, 0x61, 0x34, 
+0x2C, 0x5C, 0x8C, 0x92, 0xC7, 0x43, 0xED, 0xFA, 
+0x0C, 0xEF, 0xC5, 0x56, 0x06, 0x99, 0x88, 0x1E, 
+0x4E, 0x9F, 0x27, 
+
+0x0C, 0x72, 0x8D, 0x30, 0x4A, 0x63, 0xC3, 0x85, 
+0xA9, 0xD2, 0xB1, 0x66, 0xC5, 0xE6, 0xE8, 0xF2, 
+0x2F, 0x49, 0x2D, 0x6D, 0xF1, 0xA9, 0x49, 0xB6, 
+0x42, 0x7A, 0x13, 0x6E, 0xB0, 0xF1, 0x5B, 0xFC, 
+0xA6, 0x15, 0xBC, 0x64, 0x12, 0xCE, 0x76, 0x9D, 
+0xA6, 0x5F, 0x33, 0x31, 0x42, 0xE3, 0x3A, 0xCE, 
+0x32, 0xFD, 0x2F, 0x4F, 0xFA, 0x07, 0xA0, 0xFC, 
+0x93, 0x2E, 0x68, 0x7F, 0x50, 0x23, 0x69, 0xE7, 
+0x30, 0xA7, 0x12, 0x4B, 0x88, 0x46, 0x5B, 0x52, 
+0xAF, 0x26, 0xB1, 0x83, 
+
+0xD4, 0x2D, 0x4A, 0x4F, 0x6E, 0x64, 0x95, 0xAD, 
+0xD2, 0x59, 0xAA, 0xFB, 0x1D, 0x29, 0xFD, 0xBE, 
+0x6F, 0xEC, 0xCD, 0x98, 0xD8, 0xF8, 0x1B, 0x80, 
+0x61, 0x1F, 0x67, 0x0A, 0xF5, 0xE4, 0x9E, 0x92, 
+0x9E, 0xC3, 0xAA, 0xBA, 0x49, 0xBB, 0x62, 0x55, 
+0x58, 0x2D, 0x87, 0xE4, 0xA5, 0xE2, 0x32, 0xAE, 
+0xB4, 0x31, 0x56, 0x07, 0x56, 0xE6, 0x2B, 0xEE, 
+0x83, 0x47, 0xCC, 0xDB, 0x50, 0xCD, 0x31, 0x63, 
+0x5B, 0xE8, 0x67, 0x14, 0xE8, 0xDA, 0x2C, 0x4B, 
+0xA0, 0x54, 0xAB, 0x15, 0x1F, 
+
+0xB1, 0xDD, 0xCE, 0x39, 0xBC, 0x30, 0x6A, 0x66, 
+0xFA, 0x71, 0x9D, 0x2D, 0x71, 0xAB, 0xF1, 0x43, 
+0x0B, 0x0C, 0xF3, 0x54, 0x6F, 0xDB, 0x58, 0xD8, 
+0xB3, 0x41, 0x21, 0x4D, 0x07, 0x98, 0xF7, 0x29, 
+0x9A, 0x49, 0xD1, 0x82, 0x9D, 0x8D, 0x56, 0x40, 
+0x07, 0xB2, 0xEF, 0xB0, 0x77, 0x59, 0x7C, 0x27, 
+0xE5, 0xB7, 0xE2, 0x5D, 0x79, 0xF2, 0xFF, 0x85, 
+0x23, 0x99, 0x9C, 0x37, 0xEF, 0x7E, 0x85, 0x1F, 
+0x3D, 0xBE, 0xA3, 0xF2, 0xD9, 0xF2, 0x76, 0x00, 
+0xA0, 0xE2, 0x22, 0x92, 0xFA, 0x25, 
+
+0xFB, 0x4C, 0x2A, 0xD2, 0xE8, 0xFB, 0xF8, 0xC7, 
+0xB6, 0x46, 0x37, 0x3D, 0xB9, 0x47, 0xC7, 0x2E, 
+0xE2, 0xB6, 0x65, 0xD5, 0x07, 0xED, 0x69, 0x12, 
+0x31, 0xE2, 0x6E, 0xD0, 0x76, 0xC1, 0xA6, 0x09, 
+0x88, 0x99, 0xFF, 0x85, 0xAE, 0x12, 0xFA, 0x35, 
+0x5E, 0x5C, 0x2F, 0x4C, 0xC1, 0xAE, 0x54, 0x6B, 
+0x9E, 0x41, 0x63, 0x5F, 0x26, 0x6D, 0x50, 0x7A, 
+0xCF, 0xF4, 0x27, 0x0C, 0x59, 0x81, 0x8A, 0xF4, 
+0x86, 0x64, 0x01, 0x37, 0x03, 0x04, 0x05, 0x24, 
+0xDC, 0x1A, 0xC0, 0x2E, 0xEE, 0x17, 0x05, 
+
+0x08, 0xF8, 0xEE, 0xB4, 0xD2, 0x62, 0x22, 0x0C, 
+0xE0, 0x5F, 0xE0, 0x3F, 0x46, 0xAE, 0x23, 0xF3, 
+0x3D, 0xD5, 0xC7, 0x5A, 0x1D, 0xB5, 0xD3, 0x6D, 
+0xE5, 0x8B, 0x33, 0x59, 0x1B, 0x9B, 0xEC, 0xAF, 
+0x35, 0xF6, 0x9A, 0x32, 0xF0, 0x4B, 0x92, 0x2C, 
+0x44, 0xF1, 0x35, 0x9F, 0x8B, 0xD7, 0x9D, 0xF8, 
+0xAA, 0xFD, 0x71, 0xA8, 0xE5, 0x65, 0x63, 0x4C, 
+0xDD, 0x49, 0x86, 0x34, 0x76, 0x28, 0xCE, 0x01, 
+0xD6, 0xF2, 0x67, 0x48, 0x15, 0x8C, 0x2A, 0x1C, 
+0x86, 0x73, 0xC1, 0xDE, 0xD0, 0x7B, 0x6B, 0xA6, 
+
+0x5F, 0xE9, 0x44, 0x6D, 0x9C, 0x9C, 0x7F, 0x36, 
+0xBF, 0x64, 0xBE, 0x99, 0xAD, 0x04, 0x79, 0xB6, 
+0xB0, 0xF6, 0x84, 0x9A, 0x90, 0x8C, 0x41, 0x70, 
+0x14, 0xEA, 0x37, 0x9C, 0x80, 0x9C, 0x8A, 0x10, 
+0x61, 0xB2, 0xC9, 0xD2, 0x43, 0x6C, 0x1B, 0x66, 
+0xA2, 0x07, 0x66, 0x26, 0xC1, 0x69, 0x47, 0xF0, 
+0x29, 0xF6, 0xE7, 0xCF, 0xAC, 0xAE, 0x7E, 0xB3, 
+0xE0, 0xCD, 0x0B, 0xC6, 0x02, 0x17, 0xEF, 0x1A, 
+0xEC, 0xE2, 0xA3, 0xEB, 0xFC, 0xBD, 0xF1, 0xB4, 
+0xE3, 0x4C, 0x5F, 0x0C, 0xAA, 0xB8, 0xA2, 0xCF, 
+0xDE, 
+
+0xB6, 0x15, 0x84, 0x68, 0x42, 0x47, 0x31, 0x35, 
+0xA9, 0x5A, 0xE7, 0x5E, 0xE7, 0x8D, 0xB4, 0x32, 
+0x0E, 0x61, 0x85, 0x72, 0xE0, 0x4C, 0xEE, 0xC9, 
+0x99, 0xE0, 0x50, 0xE9, 0x6E, 0x44, 0xD2, 0xF1, 
+0x84, 0x13, 0xD5, 0x66, 0x6F, 0x08, 0xF9, 0x75, 
+0xD6, 0x9A, 0xA1, 0xE9, 0x39, 0xA1, 0x09, 0x90, 
+0x8F, 0x54, 0x28, 0x0D, 0xF3, 0x82, 0xA7, 0xD5, 
+0x07, 0xEA, 0xFF, 0xFF, 0x11, 0x43, 0x4D, 0xBE, 
+0x24, 0x41, 0x3A, 0xCB, 0xB1, 0x5B, 0x53, 0x5E, 
+0x63, 0x6C, 0x2F, 0x54, 0x6F, 0x94, 0xDF, 0xE9, 
+0xD4, 0x9D, 
+
+0x7C, 0x31, 0x7D, 0xD8, 0xBC, 0xD5, 0x7D, 0x8F, 
+0xE4, 0x53, 0x0B, 0xC8, 0xC2, 0x0F, 0x5F, 0x22, 
+0x1A, 0x7E, 0x3A, 0x76, 0xBB, 0xE8, 0x95, 0xB4, 
+0x0D, 0x94, 0x2E, 0x09, 0x06, 0xE9, 0xD4, 0x9B, 
+0x5F, 0x32, 0x0F, 0x9E, 0xC2, 0xB4, 0x2A, 0x05, 
+0x1D, 0x29, 0x1A, 0x4D, 0xE8, 0x13, 0x7A, 0x19, 
+0x47, 0x6F, 0xE8, 0x96, 0x01, 0x9A, 0x6C, 0xB7, 
+0x56, 0x87, 0x14, 0xF9, 0x08, 0xBF, 0xA2, 0xFF, 
+0x91, 0xCA, 0xA3, 0xC7, 0x6E, 0x71, 0xCD, 0x3F, 
+0xD7, 0x73, 0x4B, 0xB5, 0x3A, 0x26, 0x3F, 0xB5, 
+0x72, 0x00, 0x5D, 
+
+0xE8, 0x26, 0xA5, 0xBA, 0x28, 0x93, 0x33, 0x32, 
+0x47, 0x67, 0x17, 0xD7, 0xD8, 0x3C, 0xA5, 0xA5, 
+0x65, 0x7F, 0xE7, 0xE1, 0x5E, 0x07, 0x26, 0x61, 
+0x99, 0x59, 0xCD, 0xBF, 0xF0, 0x66, 0xB0, 0x5E, 
+0x53, 0x65, 0xFE, 0x74, 0x29, 0x55, 0x90, 0x13, 
+0xD6, 0x4C, 0x73, 0x17, 0xBD, 0x03, 0x4B, 0x7B, 
+0x2E, 0x49, 0x3B, 0x2B, 0xDC, 0xA0, 0xEF, 0x7F, 
+0x13, 0x4E, 0xF8, 0x33, 0x8A, 0xEE, 0x7B, 0xCD, 
+0xB6, 0xC0, 0x60, 0x29, 0xF1, 0x87, 0x49, 0xF2, 
+0xB6, 0x02, 0x36, 0x49, 0xE5, 0xC0, 0x0B, 0xFA, 
+0xDC, 0x81, 0xBC, 0x33, 
+
+0x94, 0xDC, 0x55, 0xF0, 0xC6, 0xB5, 0x61, 0x0F, 
+0xA3, 0xC3, 0x61, 0x81, 0x4E, 0x37, 0xC6, 0xE7, 
+0xE1, 0x38, 0xCE, 0x37, 0xC8, 0x2A, 0x23, 0x6B, 
+0x55, 0x1B, 0x3F, 0xA7, 0x0D, 0xD1, 0x46, 0x57, 
+0xBA, 0xC6, 0xD6, 0xAE, 0x6B, 0x00, 0xF9, 0x63, 
+0xDA, 0x0F, 0x80, 0x09, 0xA2, 0x1C, 0x6D, 0xEE, 
+0xA4, 0x14, 0xF4, 0x65, 0x70, 0xDB, 0x5F, 0x0E, 
+0xCB, 0xB4, 0x48, 0xB1, 0xE8, 0xF9, 0xD0, 0x3D, 
+0x3F, 0x7B, 0x61, 0x57, 0xB5, 0xC1, 0xDB, 0x47, 
+0x61, 0x94, 0x5F, 0x1E, 0x3B, 0x3E, 0x99, 0xE5, 
+0xEA, 0xDB, 0x66, 0x7A, 0x80, 
+
+}
+return kat[i:j]
+}
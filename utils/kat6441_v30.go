@@ -0,0 +1,5478 @@
+package utils
+func getkat6441_v30(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x9C, 0x89, 0x02, 0x43, 0x8E, 0x99, 0x52, 0x8E, 
+0xD8, 0x61, 0x28, 0x94, 0x8B, 0xB6, 0x97, 0x2F, 
+0xF1, 0xA6, 0x77, 0x86, 0x31, 0x36, 0x24, 0x4B, 
+0x4F, 0x80, 0xE9, 0x2E, 0xD8, 0xF6, 0xB5, 0xAA, 
+
+0x58, 0x7D, 0x70, 0xD2, 0x4D, 0x2B, 0x5A, 0x1F, 
+0x0E, 0xD2, 0x69, 0x99, 0xD2, 0x73, 0x6B, 0xEF, 
+0x11, 0x1A, 0xDF, 0xDA, 0xD6, 0xA0, 0x3E, 0x9B, 
+0xF3, 0x65, 0xFC, 0x01, 0x0A, 0x7C, 0x5D, 0xED, 
+0x03, 
+
+0xB6, 0x9D, 0x4B, 0x6C, 0x84, 0x22, 0xBC, 0xB1, 
+0x45, 0xE8, 0x47, 0x49, 0xA7, 0x98, 0x71, 0x8D, 
+0x17, 0xE3, 0x5D, 0xF2, 0xFD, 0x7E, 0x7F, 0x52, 
+0xDD, 0x69, 0x68, 0x0E, 0xC4, 0x27, 0x35, 0xE0, 
+0xAD, 0x51, 
+
+0x53, 0x09, 0x96, 0xDA, 0x0D, 0x88, 0x00, 0x01, 
+0xD5, 0x4F, 0x99, 0xBF, 0x8B, 0xCD, 0xC1, 0xD3, 
+0x87, 0x3F, 0x56, 0x67, 0xC7, 0x46, 0x17, 0x67, 
+0x19, 0x28, 0xCD, 0xF7, 0x2E, 0x1D, 0xC8, 0xC4, 
+0xE6, 0x11, 0x53, 
+
+0x20, 0x7F, 0x82, 0x2B, 0xC1, 0x1E, 0x6A, 0x33, 
+0x17, 0x27, 0x5A, 0x98, 0xD2, 0x2D, 0x69, 0x3A, 
+0x97, 0x99, 0xD7, 0x76, 0xDA, 0x58, 0x65, 0x17, 
+0x4C, 0x36, 0x74, 0xA7, 0x9B, 0xBC, 0xBB, 0xCA, 
+0x9C, 0xFE, 0x3D, 0x53, 
+
+0x04, 0xAF, 0x10, 0xDE, 0x94, 0x7F, 0x91, 0xCC, 
+0xBE, 0xF0, 0x8C, 0x52, 0x8C, 0xCE, 0xE8, 0xFF, 
+0x2F, 0x91, 0x98, 0xE4, 0x82, 0x65, 0xD8, 0xB9, 
+0xB8, 0x1D, 0x36, 0x11, 0xF6, 0xFB, 0xD4, 0x73, 
+0x8A, 0xDE, 0x23, 0xC7, 0x9A, 
+
+0x85, 0xCB, 0x0F, 0x76, 0x18, 0x9E, 0xBF, 0x17, 
+0xBA, 0x69, 0xA6, 0x65, 0x0E, 0x32, 0x23, 0xA7, 
+0xFB, 0x73, 0xC4, 0x04, 0x2A, 0xB2, 0xAA, 0xD7, 
+0xF7, 0x57, 0x92, 0x0E, 0x4F, 0x60, 0x22, 0x06, 
+0xB5, 0xF5, 0x1E, 0xA8, 0x57, 0x32, 
+
+0x09, 0x6C, 0xD3, 0x46, 0xF5, 0x92, 0x65, 0x27, 
+0x2E, 0xBE, 0x8C, 0x08, 0x16, 0x49, 0x22, 0x88, 
+0xEF, 0x30, 0x05, 0x81, 0x9C, 0xC1, 0x9A, 0x56, 
+0x6F, 0xBA, 0x84, 0x3C, 0xB2, 0xCA, 0x00, 0x65, 
+0x04, 0xBA, 0xE2, 0xFB, 0x30, 0xCC, 0x12, 
+
+0x0C, 0xC3, 0xBD, 0x0E, 0x35, 0x70, 0xCF, 0x44, 
+0xC3, 0x03, 0x2D, 0x57, 0x71, 0xEF, 0xD8, 0xC6, 
+0x30, 0x49, 0x25, 0x08, 0xEC, 0x69, 0x20, 0x9C, 
+0x29, 0xCE, 0x91, 0xFC, 0x09, 0x17, 0xD1, 0xFF, 
+0x97, 0x2F, 0xF3, 0x04, 0x4C, 0x63, 0xA2, 0xAD, 
+
+0xC4, 0x11, 0x3E, 0xE5, 0x6A, 0xB5, 0xB6, 0xEE, 
+0x2B, 0xD4, 0xDC, 0xF3, 0x70, 0x49, 0x89, 0xA1, 
+0x31, 0x74, 0x87, 0xF4, 0xC3, 0xB0, 0x30, 0xE7, 
+0xFE, 0x8C, 0x04, 0x8D, 0xEF, 0xA7, 0xA4, 0xA2, 
+0x1E, 0x39, 0x5B, 0xB7, 0x14, 0x65, 0x90, 0x82, 
+0x69, 
+
+0xC8, 0x9A, 0x4B, 0x76, 0x64, 0x44, 0x51, 0x22, 
+0xE6, 0xC0, 0x62, 0x33, 0x0F, 0x56, 0x65, 0xEB, 
+0xEC, 0x66, 0x0A, 0x99, 0x9E, 0x77, 0x4D, 0x3E, 
+0x7E, 0x5F, 0xC3, 0xEB, 0x0A, 0x82, 0x09, 0xD1, 
+0xCA, 0x18, 0x47, 0xD9, 0x69, 0x5E, 0x8E, 0xE8, 
+0x95, 0xBA, 
+
+0x4A, 0xAA, 0xC2, 0xF5, 0x5F, 0xA5, 0x76, 0xB8, 
+0xDC, 0x42, 0xEE, 0xC2, 0x6D, 0xB8, 0x45, 0x67, 
+0x4E, 0x63, 0xBD, 0x90, 0x8D, 0x12, 0xF3, 0xBB, 
+0x0E, 0x0B, 0xED, 0x96, 0x4B, 0xC9, 0x13, 0x5B, 
+0xBD, 0x0C, 0xC9, 0xE4, 0x96, 0xD2, 0xC8, 0x76, 
+0x8F, 0x82, 0x6C, 
+
+0x21, 0x35, 0x8B, 0xF5, 0x7C, 0x72, 0xEB, 0xA3, 
+0x85, 0x75, 0x0B, 0xAA, 0x08, 0xBB, 0x50, 0xF8, 
+0xAC, 0x73, 0xA0, 0x28, 0x81, 0x6A, 0x04, 0x8D, 
+0x7B, 0x53, 0x71, 0x28, 0x66, 0xE4, 0xCE, 0xA3, 
+0x6B, 0x1B, 0x92, 0xD6, 0xF9, 0xC7, 0xD8, 0x11, 
+0x02, 0x6F, 0x1A, 0xEA, 
+
+0x9A, 0xD7, 0xCF, 0xEE, 0xDC, 0x49, 0xDC, 0xE8, 
+0x06, 0x1A, 0xD9, 0x23, 0xAD, 0x39, 0x94, 0x1A, 
+0x53, 0xFB, 0xB2, 0x2D, 0x7F, 0x96, 0x85, 0x11, 
+0xF0, 0x38, 0x73, 0x1F, 0xAD, 0xD1, 0x84, 0x5B, 
+0xB3, 0x84, 0x70, 0x3E, 0x33, 0x75, 0x35, 0x24, 
+0x08, 0x9A, 0x5D, 0x21, 0x9A, 
+
+0x38, 0x74, 0xF9, 0xEE, 0xE2, 0xD3, 0x30, 0xA4, 
+0xE4, 0x46, 0x7D, 0xC8, 0x10, 0x7F, 0xF1, 0xE3, 
+0x06, 0x16, 0x05, 0x70, 0x3B, 0x80, 0xED, 0xFE, 
+0xE6, 0x97, 0x78, 0x47, 0x19, 0x84, 0x2D, 0x17, 
+0x67, 0x04, 0x01, 0xC7, 0x24, 0xAD, 0x7E, 0x4A, 
+0xC4, 0x76, 0xC8, 0xB2, 0xC0, 0x2F, 
+
+0x13, 0x8B, 0xD7, 0x2A, 0xC9, 0x6A, 0x29, 0x7A, 
+0xE2, 0x78, 0xE8, 0xCF, 0x67, 0x9B, 0x37, 0x29, 
+0x6C, 0xF8, 0x3D, 0x37, 0x6F, 0x21, 0xE8, 0xC2, 
+0x64, 0xE1, 0xD4, 0x66, 0x7A, 0xE4, 0x72, 0x77, 
+0xAD, 0x0A, 0x24, 0xB8, 0x3C, 0x9B, 0x8E, 0xC1, 
+0x66, 0x94, 0xCC, 0x9E, 0x83, 0xBA, 0x53, 
+
+0xC7, 0xAB, 0x7B, 0x60, 0x42, 0x31, 0xB6, 0x90, 
+0xE1, 0xFA, 0x60, 0xE2, 0xFD, 0x62, 0xEF, 0xEA, 
+0xB7, 0x6F, 0x30, 0xE5, 0xB2, 0xCB, 0x49, 0x84, 
+0xD6, 0xFD, 0x3D, 0x38, 0x35, 0xB8, 0xB8, 0x95, 
+0x95, 0x63, 0xE9, 0xE7, 0x84, 0xCC, 0xFE, 0x71, 
+0x69, 0x51, 0xD1, 0x3F, 0x9D, 0x64, 0xB1, 0x5A, 
+
+0xFD, 0xD7, 0x30, 0x62, 0x23, 0xBD, 0xB1, 0xFB, 
+0x64, 0xFF, 0x4D, 0x59, 0x09, 0xA2, 0xBB, 0x2B, 
+0xE7, 0x4E, 0x37, 0x97, 0x66, 0x7C, 0x31, 0x36, 
+0xA7, 0xB6, 0x0B, 0x89, 0x70, 0xBB, 0xF6, 0xF5, 
+0xEB, 0x39, 0x82, 0x28, 0xD1, 0x99, 0x35, 0xEB, 
+0x62, 0x11, 0xE6, 0xFF, 0x33, 0xB0, 0x1F, 0x6B, 
+0x57, 
+
+0xE6, 0xB3, 0xDB, 0x95, 0x46, 0x70, 0x8F, 0x1B, 
+0x76, 0xFB, 0x39, 0xF2, 0xA9, 0xB5, 0x68, 0xD9, 
+0x2A, 0xF9, 0x91, 0x13, 0x2B, 0x45, 0x36, 0x9C, 
+0xF3, 0xBA, 0x5D, 0xF3, 0x2D, 0xD7, 0x20, 0xCE, 
+0x32, 0x8D, 0x18, 0xE9, 0x03, 0x0B, 0x8D, 0x1C, 
+0xD6, 0xC8, 0x75, 0x6B, 0x6C, 0x7C, 0xB3, 0xA1, 
+0x78, 0x7A, 
+
+0xE8, 0xDA, 0x83, 0xD9, 0x90, 0x9A, 0x3B, 0x5C, 
+0x44, 0x85, 0x27, 0xE6, 0x14, 0x7A, 0x0C, 0xF8, 
+0x21, 0x90, 0xA4, 0xDD, 0x3C, 0x23, 0x55, 0x6C, 
+0x33, 0xAF, 0xD7, 0x34, 0x1F, 0xA0, 0x4D, 0x47, 
+0x5D, 0x6D, 0x60, 0x1A, 0x84, 0x26, 0x48, 0x99, 
+0xC3, 0xD7, 0x35, 0x74, 0xC5, 0x32, 0xC1, 0x94, 
+0x7F, 0x12, 0x0A, 
+
+0xC6, 0x49, 0x15, 0xA3, 0x0A, 0xC8, 0x28, 0xF0, 
+0x7B, 0x34, 0x10, 0xD7, 0x88, 0x2A, 0x63, 0x56, 
+0xB0, 0xCC, 0x0E, 0xDF, 0xE4, 0x21, 0xDF, 0x7C, 
+0xD4, 0xB7, 0xA9, 0xAA, 0xF3, 0xE5, 0xC7, 0x11, 
+0x6F, 0xF4, 0x49, 0xFD, 0xE7, 0xCC, 0xD0, 0xC8, 
+0x23, 0xBE, 0xF5, 0xB7, 0x87, 0x31, 0x28, 0xF2, 
+0xC1, 0x73, 0xEE, 0x10, 
+
+0x4B, 0xF0, 0xF4, 0x7B, 0x8D, 0x67, 0x29, 0x3C, 
+0x15, 0x04, 0x4A, 0x2F, 0xB3, 0x94, 0x1E, 0x93, 
+0xB2, 0x86, 0xE2, 0x81, 0xB4, 0x36, 0xEE, 0x0D, 
+0x5C, 0x13, 0x55, 0x84, 0x70, 0x51, 0xA7, 0x07, 
+0x38, 0xD6, 0xAD, 0xD2, 0xC0, 0x12, 0x9C, 0x91, 
+0xF6, 0x71, 0xF6, 0xFF, 0x0D, 0x69, 0x75, 0x8C, 
+0x56, 0x39, 0x95, 0x45, 0x03, 
+
+0x11, 0x8D, 0x0E, 0xF0, 0xBC, 0xFF, 0xC4, 0x63, 
+0x4D, 0x02, 0x59, 0x06, 0x0B, 0xD3, 0xC8, 0x93, 
+0x4D, 0xA0, 0x59, 0x16, 0xC2, 0xBD, 0xD7, 0x49, 
+0xF1, 0x5F, 0xCB, 0x76, 0x90, 0x2B, 0x9C, 0x30, 
+0xC7, 0x66, 0x5F, 0x3F, 0x7E, 0x9F, 0x2C, 0xFD, 
+0xDD, 0x1D, 0xC7, 0xD5, 0xA2, 0xF5, 0xFB, 0x29, 
+0x31, 0x09, 0xB8, 0x22, 0x26, 0x11, 
+
+0x4F, 0x94, 0x55, 0x3D, 0xE7, 0xA2, 0xF2, 0x2D, 
+0x71, 0x0F, 0x7C, 0xA1, 0x50, 0x55, 0x56, 0x98, 
+0x80, 0xCD, 0xC6, 0x9A, 0xF2, 0x02, 0x67, 0x97, 
+0x22, 0xEA, 0x21, 0xF9, 0x28, 0xC7, 0x26, 0xA7, 
+0x9F, 0xE1, 0x2A, 0x12, 0x0B, 0x01, 0x19, 0xB0, 
+0x64, 0x1F, 0x67, 0xE0, 0x1D, 0x9C, 0x43, 0xF8, 
+0x2B, 0xDE, 0x17, 0xF7, 0x0C, 0xCB, 0x17, 
+
+0x4B, 0xFB, 0x26, 0x08, 0x29, 0x33, 0xAB, 0xA5, 
+0x25, 0xA9, 0x95, 0x2A, 0x58, 0x0B, 0x43, 0x40, 
+0xF2, 0xC7, 0x08, 0x79, 0x2F, 0x1A, 0xD0, 0x07, 
+0x7C, 0x72, 0x1B, 0x58, 0xEB, 0x18, 0x40, 0x20, 
+0x92, 0x5E, 0x03, 0x0C, 0x43, 0xFA, 0x8A, 0xFD, 
+0x02, 0x2B, 0xE9, 0xFE, 0x74, 0x6A, 0x14, 0xF4, 
+0x05, 0x49, 0xF1, 0x7E, 0x30, 0x5F, 0x49, 0xC0, 
+
+0x62, 0xB4, 0xD9, 0x9B, 0x34, 0x10, 0xE6, 0x0A, 
+0xF6, 0xF9, 0xF1, 0x96, 0x33, 0x26, 0x8E, 0x60, 
+0x34, 0x89, 0xD8, 0xB8, 0x8B, 0xBE, 0x1F, 0x9B, 
+0x6C, 0x1A, 0xDA, 0xA4, 0x52, 0x42, 0xA2, 0x28, 
+0x0D, 0x03, 0xA1, 0x73, 0x0F, 0x4E, 0x57, 0xB9, 
+0x0C, 0xC7, 0x41, 0x36, 0x99, 0x60, 0xA6, 0x2B, 
+0xE9, 0xE2, 0x2F, 0x77, 0xAF, 0x26, 0x3C, 0xC9, 
+0x37, 
+
+0x80, 0xB6, 0x35, 0x94, 0xB1, 0x74, 0x62, 0xDD, 
+0x35, 0xC1, 0x54, 0x63, 0x87, 0xEA, 0x8B, 0xD6, 
+0x1E, 0xC9, 0xE0, 0xE9, 0x16, 0x60, 0xFE, 0x7B, 
+0x7E, 0xE3, 0xBE, 0x3C, 0xBA, 0xA8, 0x86, 0xB8, 
+0x90, 0x34, 0xB2, 0x3E, 0x79, 0x0A, 0x6A, 0x53, 
+0x8B, 0x06, 0xE2, 0xB9, 0x25, 0x15, 0x90, 0xE8, 
+0x7D, 0x47, 0x4A, 0x89, 0x35, 0x19, 0x64, 0xAA, 
+0x6A, 0x25, 
+
+0x9C, 0xC1, 0xE5, 0x09, 0x81, 0x05, 0x04, 0x41, 
+0xB8, 0x96, 0x9C, 0x4E, 0x0B, 0xED, 0xFC, 0xC8, 
+0x4B, 0xEF, 0x96, 0xEF, 0x58, 0xD6, 0x3B, 0x56, 
+0x37, 0xE4, 0xA2, 0x93, 0xB2, 0xA9, 0x06, 0x41, 
+0xF0, 0x80, 0x13, 0xAA, 0x4E, 0x6B, 0xDA, 0xB9, 
+0x95, 0xFA, 0x55, 0xDD, 0x7A, 0x9F, 0xC9, 0x87, 
+0x48, 0xC6, 0x09, 0xB2, 0x4A, 0xE0, 0x71, 0x6C, 
+0x5E, 0x3C, 0x9E, 
+
+0x03, 0xD9, 0x40, 0x07, 0xE3, 0x5B, 0x56, 0xAD, 
+0x87, 0x74, 0x31, 0xE6, 0xB4, 0x03, 0xD4, 0x48, 
+0x98, 0x3F, 0xB0, 0x56, 0xC6, 0xCC, 0x22, 0xAF, 
+0xCA, 0x6C, 0xC6, 0x52, 0x23, 0x32, 0x67, 0x9C, 
+0x06, 0xC6, 0x14, 0xCD, 0x8E, 0xB4, 0x6E, 0xF0, 
+0x76, 0x2B, 0x7D, 0x99, 0xE3, 0x96, 0x4B, 0xB6, 
+0x42, 0x60, 0xE3, 0x58, 0x27, 0x63, 0x9C, 0x32, 
+0x5A, 0xD7, 0xB4, 0x53, 
+
+0x77, 0x42, 0x89, 0xA2, 0xF2, 0xB8, 0x74, 0x66, 
+0x20, 0xE1, 0x21, 0x14, 0x57, 0xE5, 0xE1, 0x3F, 
+0x36, 0xC4, 0xDA, 0xB7, 0xAA, 0xF0, 0x9E, 0xB9, 
+0x69, 0x0C, 0x35, 0x6C, 0x18, 0x5F, 0x31, 0x81, 
+0x35, 0x13, 0x37, 0x5A, 0x50, 0xDA, 0xC4, 0xE5, 
+0x60, 0x07, 0xC6, 0xA6, 0x5C, 0xE7, 0xFF, 0x4E, 
+0xD9, 0x00, 0x1D, 0x20, 0xFE, 0x94, 0x35, 0x23, 
+0x20, 0xE8, 0x80, 0x58, 0x06, 
+
+0x50, 0xB5, 0x6E, 0xA2, 0x93, 0xBD, 0xCC, 0xA9, 
+0xAD, 0x4C, 0x7E, 0xD7, 0xBE, 0x26, 0xAD, 0x4D, 
+0x2E, 0x25, 0x1A, 0x09, 0xA8, 0x9C, 0x48, 0x6A, 
+0xEF, 0x11, 0x76, 0xEC, 0xE9, 0xB2, 0x04, 0x28, 
+0x89, 0x80, 0xF9, 0x1A, 0x60, 0xA1, 0x9D, 0xE1, 
+0x1A, 0x4C, 0x83, 0xAA, 0x7C, 0x63, 0x69, 0xD9, 
+0x02, 0x9D, 0x14, 0xF7, 0xF7, 0x0C, 0x26, 0x07, 
+0x16, 0x9B, 0x4A, 0x25, 0x4F, 0xF4, 
+
+0xCE, 0xBD, 0x1A, 0x2F, 0xCB, 0xF5, 0x8D, 0x8C, 
+0x1C, 0xA2, 0x4F, 0xD8, 0xD5, 0xB9, 0x04, 0x6E, 
+0x77, 0x0E, 0x49, 0xDA, 0x8D, 0x13, 0xD4, 0x49, 
+0x55, 0x12, 0x87, 0x30, 0x2D, 0xFB, 0xF8, 0x02, 
+0xE9, 0xD1, 0x54, 0x69, 0x5D, 0xB5, 0x00, 0x49, 
+0xA4, 0xC0, 0x8B, 0xD2, 0x92, 0x54, 0xC0, 0x05, 
+0xCA, 0xDD, 0xF1, 0x5B, 0x11, 0xEC, 0x59, 0xD7, 
+0x99, 0x08, 0x10, 0x2D, 0x06, 0xAD, 0xCA, 
+
+0xD9, 0x04, 0xA6, 0x9F, 0x1E, 0xE5, 0x5C, 0x5F, 
+0xC6, 0x1B, 0xA1, 0x1F, 0xA9, 0x63, 0x1F, 0x0A, 
+0xC1, 0x60, 0x0D, 0x81, 0x35, 0x97, 0x08, 0x23, 
+0x40, 0x1A, 0x41, 0xFC, 0x58, 0x51, 0x04, 0x97, 
+0xBD, 0xC3, 0xC3, 0x32, 0x5D, 0x28, 0xAF, 0xE7, 
+0xB5, 0xA4, 0x29, 0x61, 0x0C, 0xD8, 0xF2, 0xD6, 
+0x4F, 0xC2, 0x6F, 0x5B, 0xA1, 0x64, 0xFB, 0x55, 
+0x49, 0x27, 0x08, 0x17, 0x7D, 0x2B, 0x68, 0xD1, 
+
+0xBC, 0x89, 0x75, 0x83, 0x82, 0x6D, 0x98, 0x11, 
+0x4E, 0x04, 0x84, 0xC0, 0xCD, 0x93, 0x73, 0x29, 
+0x0E, 0x70, 0x9B, 0x62, 0xE0, 0x97, 0x63, 0x27, 
+0x70, 0x1E, 0x08, 0xE5, 0xF0, 0x88, 0xAF, 0xBC, 
+0xAC, 0x39, 0xC8, 0x93, 0x9D, 0x32, 0xF0, 0x03, 
+0xEA, 0x33, 0x25, 0xA5, 0xDA, 0xD5, 0xC4, 0x94, 
+0xF5, 0xB4, 0xC8, 0xF8, 0x2B, 0xDE, 0x13, 0x59, 
+0x8C, 0x04, 0x8C, 0xBC, 0x3A, 0xD8, 0x63, 0xCB, 
+0xAF, 
+
+0xCB, 0x95, 0xC1, 0xE9, 0x80, 0x91, 0xE9, 0xE7, 
+0xE6, 0x6B, 0x98, 0x55, 0xD2, 0x3B, 0x37, 0x27, 
+0x13, 0x37, 0x41, 0x2E, 0x2D, 0x49, 0xE6, 0x83, 
+0x37, 0x9D, 0x24, 0x37, 0x55, 0xB4, 0xEE, 0x29, 
+0xFC, 0x9E, 0x55, 0xA3, 0x94, 0xF3, 0x6C, 0x93, 
+0x7E, 0x90, 0x18, 0x1B, 0x0C, 0x0C, 0x22, 0x44, 
+0xB0, 0xB2, 0x3D, 0xC9, 0xAF, 0x47, 0x63, 0x56, 
+0x6D, 0x2D, 0xB4, 0xCA, 0x03, 0xF3, 0x99, 0xB8, 
+0x61, 0xD9, 
+
+0x4B, 0x94, 0xC4, 0x59, 0x93, 0x95, 0xD8, 0xB2, 
+0xCE, 0x8A, 0x89, 0x7F, 0x72, 0x07, 0x22, 0xC3, 
+0x69, 0x49, 0x86, 0xAB, 0xFC, 0xEC, 0x95, 0xDB, 
+0x7B, 0x59, 0x58, 0x13, 0x5C, 0xE9, 0x46, 0x41, 
+0x08, 0x08, 0x47, 0x76, 0xA9, 0xBB, 0x37, 0xA6, 
+0x88, 0xB3, 0x1D, 0x23, 0x2D, 0x23, 0x1E, 0x91, 
+0xDD, 0x6B, 0x6D, 0x9C, 0xEF, 0x0F, 0xCF, 0xB6, 
+0xBC, 0x02, 0x19, 0xED, 0x20, 0xFC, 0x86, 0xF7, 
+0x41, 0x6A, 0x3C, 
+
+0xBD, 0xEE, 0xA8, 0xFE, 0x8D, 0xE3, 0xB2, 0xB6, 
+0x92, 0xB4, 0xB7, 0x00, 0x5B, 0x59, 0x52, 0x53, 
+0x64, 0x7A, 0x7D, 0x2C, 0xE9, 0x73, 0x08, 0x09, 
+0xF8, 0x5C, 0x6B, 0xA0, 0xCD, 0xFE, 0x21, 0x9E, 
+0xE9, 0x05, 0x2D, 0x2C, 0x3A, 0x1D, 0xA2, 0xA9, 
+0x07, 0x50, 0x73, 0xCD, 0xD6, 0xE9, 0x8D, 0x64, 
+0xB4, 0x50, 0x85, 0x35, 0xAC, 0x88, 0x4A, 0x22, 
+0x6F, 0x5E, 0x24, 0xC9, 0xD3, 0x2B, 0xFB, 0x28, 
+0x5E, 0x97, 0x2A, 0x16, 
+
+0xAB, 0x5A, 0x77, 0x2F, 0xE5, 0x8F, 0x10, 0xB4, 
+0x66, 0xBB, 0x93, 0xE2, 0x82, 0x97, 0x7E, 0x2B, 
+0x8B, 0x5F, 0x7A, 0x0E, 0xC1, 0xA6, 0xA3, 0xE6, 
+0x2D, 0xA1, 0x12, 0xCB, 0x3D, 0x50, 0x89, 0x1A, 
+0xE2, 0x98, 0xF0, 0x84, 0xA8, 0x80, 0x85, 0xBB, 
+0x9D, 0x55, 0x48, 0x4D, 0xDC, 0x25, 0x94, 0x92, 
+0xB2, 0x5A, 0x3D, 0xB5, 0x9D, 0xC1, 0xB0, 0x50, 
+0x60, 0x09, 0x69, 0xB3, 0x38, 0xC9, 0x10, 0x50, 
+0x89, 0x4D, 0x86, 0x55, 0x7E, 
+
+0x51, 0x23, 0xF1, 0xFC, 0x7B, 0xC1, 0xED, 0x55, 
+0xD7, 0x56, 0x06, 0xCD, 0x0D, 0xD3, 0xCE, 0xE1, 
+0xA9, 0xD7, 0xC6, 0x93, 0xD3, 0x96, 0x5F, 0x2B, 
+0x6A, 0x67, 0xC1, 0xE2, 0xFC, 0xE5, 0x03, 0xAA, 
+0x5F, 0x81, 0x48, 0xD9, 0x18, 0xAC, 0x45, 0xC8, 
+0xD8, 0x90, 0xE4, 0xC2, 0x20, 0x3E, 0x55, 0xC7, 
+0xB8, 0x03, 0x83, 0x57, 0x8A, 0xF3, 0xA7, 0x64, 
+0x0A, 0xA0, 0xAE, 0x2B, 0x39, 0x59, 0x56, 0x59, 
+0x96, 0x48, 0x67, 0x44, 0xF8, 0xD6, 
+
+0x8E, 0xBE, 0xA6, 0xC8, 0x60, 0x67, 0xBB, 0xF3, 
+0xEB, 0x02, 0x2F, 0xEF, 0x78, 0x4C, 0x5E, 0xE9, 
+0xE9, 0x4B, 0xF5, 0x2E, 0x00, 0x7E, 0xBC, 0x88, 
+0x97, 0x60, 0x76, 0xCA, 0xF3, 0xC9, 0x82, 0x5E, 
+0x6B, 0x67, 0x42, 0x08, 0x36, 0xF6, 0x86, 0x05, 
+0xB9, 0x1F, 0xED, 0x47, 0x17, 0x9E, 0x12, 0x17, 
+0xF7, 0xA4, 0x27, 0x00, 0x0D, 0xBB, 0x0C, 0x77, 
+0xD8, 0x9D, 0xE4, 0xD1, 0xB2, 0x74, 0xEF, 0x6C, 
+0x69, 0x4A, 0x31, 0x71, 0x6F, 0x41, 0x72, 
+
+0x08, 0x20, 0xFB, 0xD7, 0xE8, 0x55, 0xED, 0x96, 
+0xEC, 0x11, 0x3B, 0xA4, 0x52, 0x36, 0x06, 0xDC, 
+0x44, 0x58, 0x8D, 0x7C, 0x81, 0xD2, 0xB8, 0x56, 
+0xE0, 0x85, 0x84, 0xE3, 0x17, 0x37, 0x75, 0x61, 
+0xDE, 0xE3, 0x1A, 0x61, 0xAE, 0xBB, 0x32, 0x02, 
+0xE3, 0xC6, 0x29, 0xFF, 0x75, 0xCD, 0xAD, 0x48, 
+0x4F, 0x9D, 0xBF, 0x77, 0x6B, 0x6E, 0x6D, 0x9C, 
+0x0B, 0x3F, 0x9B, 0x99, 0x24, 0x67, 0x07, 0xED, 
+0xDC, 0x00, 0x74, 0x35, 0xF1, 0x75, 0x8C, 0x39, 
+
+0x1F, 0x15, 0xD2, 0x4F, 0x1D, 0x0B, 0x2B, 0x3F, 
+0x8A, 0x9C, 0x43, 0xB9, 0x0C, 0xDF, 0xCD, 0xE2, 
+0x74, 0xDA, 0x3B, 0xA8, 0x5C, 0xB0, 0x6C, 0x96, 
+0xA7, 0xEF, 0x2D, 0x60, 0xFF, 0x5C, 0x47, 0x7F, 
+0x0A, 0x41, 0x8B, 0xBF, 0x71, 0x21, 0x48, 0x9F, 
+0x30, 0x03, 0x75, 0xEC, 0x5B, 0xE2, 0x6A, 0x61, 
+0xD5, 0x80, 0x12, 0x0B, 0x76, 0xDC, 0x76, 0x40, 
+0xD1, 0x43, 0xC9, 0xBD, 0xFC, 0xB2, 0xB8, 0x07, 
+0x80, 0x4E, 0x8F, 0xD1, 0xFC, 0xCF, 0xB5, 0x05, 
+0xC7, 
+
+0x34, 0x55, 0x64, 0x75, 0xB5, 0xF2, 0xEF, 0xF3, 
+0xEE, 0xCA, 0x43, 0x1C, 0xE3, 0xC7, 0x50, 0x27, 
+0x6A, 0xFA, 0x63, 0xDA, 0x99, 0x36, 0x6E, 0x42, 
+0x8A, 0x7A, 0xCF, 0x7C, 0xBE, 0x21, 0x83, 0x35, 
+0x20, 0x9F, 0x26, 0xAB, 0x52, 0x2D, 0xA7, 0x80, 
+0x92, 0xDA, 0x8B, 0xF6, 0x72, 0xF6, 0xA0, 0xB7, 
+0x78, 0xC0, 0xB2, 0x07, 0x0A, 0x6E, 0x78, 0x90, 
+0xD0, 0x55, 0x23, 0xB4, 0xD6, 0x0F, 0x0C, 0xBF, 
+0x5A, 0xDF, 0x4D, 0xA2, 0xA5, 0xC6, 0xA0, 0x53, 
+0x31, 0x7E, 
+
+0x0B, 0x8E, 0x7F, 0x62, 0x1D, 0xF8, 0x6D, 0x2C, 
+0xED, 0xBE, 0xF8, 0xE2, 0x04, 0x08, 0x15, 0x47, 
+0xB1, 0x05, 0xF8, 0x16, 0x40, 0xC3, 0x31, 0x33, 
+0x44, 0xEE, 0x20, 0x41, 0x26, 0x4E, 0x19, 0x0E, 
+0x0E, 0xEF, 0x77, 0x56, 0x5A, 0x28, 0x7F, 0xCD, 
+0x40, 0x5F, 0x8F, 0x24, 0x3A, 0x54, 0xC3, 0x67, 
+0x23, 0x07, 0x32, 0xC5, 0x5D, 0x71, 0x1B, 0x39, 
+0x8F, 0xCF, 0xC0, 0xD8, 0x3E, 0xBF, 0x8A, 0x72, 
+0xF2, 0x5B, 0x9C, 0x89, 0x36, 0x4D, 0xD9, 0xF5, 
+0x6C, 0xCA, 0x0E, 
+
+0x8C, 0x0E, 0x35, 0x4F, 0x79, 0x3F, 0x9A, 0xCD, 
+0x2B, 0xF2, 0x60, 0xC7, 0xE9, 0x4C, 0x9C, 0x7D, 
+0x28, 0xAB, 0x1A, 0xC5, 0x80, 0x02, 0x27, 0x1D, 
+0xD5, 0xD3, 0x2D, 0x78, 0x15, 0xE6, 0xB9, 0x77, 
+0x24, 0xE1, 0x68, 0x23, 0xB5, 0xA5, 0x45, 0x28, 
+0x0E, 0xFA, 0x7C, 0x05, 0x52, 0x47, 0x4C, 0xA4, 
+0x4D, 0x7C, 0x03, 0xC5, 0x03, 0x01, 0x6D, 0x7A, 
+0xC5, 0x95, 0xF5, 0x39, 0xE5, 0x3D, 0x28, 0x39, 
+0xA2, 0x2D, 0x31, 0x04, 0xBC, 0x8D, 0x2A, 0xC9, 
+0xF2, 0xA1, 0xED, 0x46, 
+
+0xCB, 0xE7, 0x62, 0xA5, 0x47, 0xCA, 0x96, 0x9E, 
+0x6F, 0x00, 0xA9, 0xF0, 0x18, 0x31, 0xAB, 0x10, 
+0xFF, 0x44, 0x6C, 0xD2, 0x00, 0xB9, 0x5A, 0x64, 
+0x1C, 0x23, 0xD5, 0xA0, 0x0D, 0x55, 0x37, 0xBC, 
+0xA9, 0xC8, 0x22, 0x0F, 0xE7, 0x35, 0x63, 0x5E, 
+0xDC, 0x2C, 0x3F, 0xC2, 0x0E, 0xBA, 0x0B, 0xCB, 
+0x1E, 0xDA, 0x4A, 0xBA, 0xB6, 0x2C, 0x85, 0x61, 
+0x53, 0x79, 0x88, 0x8C, 0xD6, 0xB0, 0xD5, 0x59, 
+0xD0, 0x7F, 0x5C, 0xB7, 0x49, 0xE0, 0xED, 0x9D, 
+0x1D, 0x91, 0xA6, 0x0D, 0xEF, 
+
+0xF3, 0x09, 0x05, 0x82, 0xBE, 0x90, 0x84, 0x30, 
+0xD7, 0x0A, 0x37, 0xDD, 0xC8, 0x70, 0x0C, 0xFE, 
+0x77, 0xDB, 0xE5, 0x16, 0x89, 0xC1, 0x94, 0x45, 
+0x0D, 0x89, 0xA2, 0xC5, 0x45, 0x83, 0x62, 0x49, 
+0x23, 0xF8, 0xE2, 0xE6, 0x52, 0xC6, 0x84, 0x27, 
+0x31, 0x43, 0x46, 0x72, 0x44, 0xCA, 0x65, 0x6C, 
+0x44, 0x8C, 0x10, 0x66, 0xE3, 0x0F, 0x1F, 0xD9, 
+0x4B, 0xB9, 0xC6, 0xAE, 0x11, 0x26, 0xD5, 0x33, 
+0x42, 0x14, 0xE0, 0x2A, 0x46, 0x9B, 0x1A, 0x05, 
+0x6F, 0x55, 0x80, 0x74, 0xEF, 0xEE, 
+
+0xD3, 0xF6, 0xC0, 0x37, 0xAD, 0xC9, 0x87, 0x7C, 
+0x8A, 0xC4, 0x60, 0x3B, 0xC1, 0x63, 0xC8, 0xFC, 
+0xC4, 0xBF, 0x89, 0xAB, 0xB7, 0x8A, 0x1F, 0xA1, 
+0xC9, 0x81, 0x71, 0xDE, 0xD5, 0x15, 0xB4, 0x18, 
+0xAE, 0x7F, 0xFB, 0x5B, 0x51, 0x2C, 0x47, 0x80, 
+0x25, 0xD0, 0x1D, 0xB5, 0x1E, 0xFF, 0xEB, 0x3C, 
+0x8A, 0xE5, 0x89, 0x1C, 0x0B, 0x54, 0x89, 0x6A, 
+0xC4, 0x98, 0xAD, 0x27, 0x10, 0x58, 0x91, 0xC7, 
+0xFB, 0xD5, 0xB9, 0x53, 0xC3, 0xAD, 0x40, 0xFE, 
+0xBA, 0xC9, 0x6E, 0x03, 0x70, 0xEF, 0x2E, 
+
+0xA0, 0x63, 0x21, 0xD3, 0xA9, 0x7D, 0x0C, 0xB5, 
+0x7A, 0x74, 0x87, 0x47, 0x9E, 0xAC, 0xC7, 0x43, 
+0x8F, 0x2D, 0x0B, 0xF2, 0xA5, 0x6A, 0x31, 0xD7, 
+0x80, 0xA7, 0xCE, 0xDF, 0xB8, 0x45, 0x1F, 0x5D, 
+0xCF, 0x07, 0x8D, 0x78, 0x71, 0xFA, 0x57, 0x10, 
+0x6E, 0xC9, 0x01, 0x86, 0xFA, 0xA2, 0x3C, 0xE6, 
+0x44, 0x87, 0x57, 0x53, 0x56, 0x05, 0x36, 0x33, 
+0x78, 0x01, 0x73, 0x60, 0x9F, 0xF0, 0xDA, 0x90, 
+0x0E, 0x5F, 0x61, 0x24, 0x16, 0x52, 0x80, 0xED, 
+0xB5, 0x85, 0x5F, 0x1D, 0x2F, 0xC4, 0xCB, 0x2A, 
+
+0x5F, 0x2F, 0x4F, 0xF7, 0x9D, 0xFB, 0x17, 0x87, 
+0x8E, 0xCB, 0x5C, 0xB9, 0x63, 0x2E, 0x10, 0xF9, 
+0x19, 0x60, 0x90, 0xDA, 0x4C, 0x28, 0xE8, 0x34, 
+0x0F, 0xE1, 0x5D, 0x72, 0x5B, 0x18, 0x23, 0x9C, 
+0xEF, 0x17, 0xF7, 0x4A, 0xD1, 0xE1, 0x26, 0x26, 
+0x1C, 0xE8, 0x0B, 0xB2, 0x4A, 0x86, 0xB1, 0x05, 
+0x75, 0x5F, 0x56, 0x12, 0x9E, 0x60, 0xE6, 0x50, 
+0xC2, 0x25, 0x43, 0x71, 0x25, 0xB7, 0xA6, 0xFC, 
+0x60, 0x24, 0x74, 0x01, 0x2C, 0x45, 0xA5, 0xF0, 
+0x45, 0x66, 0x4A, 0x17, 0x01, 0xE6, 0xC5, 0x7A, 
+0xE4, 
+
+0xD4, 0x1F, 0x5F, 0xC2, 0xA9, 0x2C, 0x9F, 0x77, 
+0x4E, 0xBC, 0x25, 0xA1, 0xC8, 0xF4, 0xF8, 0x7A, 
+0x74, 0xFE, 0x47, 0xDF, 0xE7, 0xA7, 0x08, 0x26, 
+0xC2, 0xE5, 0xB6, 0xAB, 0xD6, 0x52, 0x32, 0x97, 
+0x7F, 0xD7, 0xCE, 0xAA, 0xEF, 0xB2, 0x6A, 0xAB, 
+0xAC, 0xA9, 0xC7, 0xDD, 0x2A, 0x9D, 0xE4, 0x12, 
+0x40, 0x7E, 0xF4, 0xB5, 0xFF, 0x39, 0x00, 0x31, 
+0x0C, 0x1F, 0x24, 0xEC, 0x1A, 0xDF, 0xBE, 0x40, 
+0xE0, 0x51, 0x70, 0xCF, 0xDC, 0xB7, 0x4F, 0x3D, 
+0x0A, 0x52, 0x99, 0x76, 0x31, 0xED, 0x24, 0x09, 
+0x47, 0xB9, 
+
+0x7F, 0x78, 0x93, 0x1B, 0x69, 0xB9, 0xE9, 0x44, 
+0x69, 0xD9, 0xDB, 0xE6, 0xFD, 0x15, 0x8B, 0x82, 
+0xCD, 0x32, 0x91, 0x8B, 0x60, 0x98, 0x57, 0x3E, 
+0xB0, 0xF9, 0x3A, 0x86, 0x8F, 0xEF, 0xDA, 0xAA, 
+0x91, 0x2B, 0x38, 0xF3, 0x7C, 0xBF, 0xB7, 0x5E, 
+0x4C, 0xD0, 0xA0, 0x4D, 0x87, 0xEB, 0x3E, 0xD6, 
+0x0C, 0xD1, 0xA7, 0x9B, 0xDE, 0x43, 0xE0, 0x63, 
+0x70, 0x17, 0x1E, 0x07, 0x87, 0x0E, 0xDD, 0x37, 
+0x37, 0x38, 0x76, 0x99, 0x53, 0x1C, 0x9C, 0x7A, 
+0x37, 0x2B, 0x6C, 0xF1, 0xA5, 0x00, 0x73, 0x73, 
+0x74, 0x98, 0xD8, 
+
+0x45, 0x02, 0x7D, 0x54, 0xAC, 0x3C, 0x51, 0xC2, 
+0x9A, 0xC0, 0x1A, 0x65, 0xBE, 0x7F, 0x80, 0x57, 
+0x34, 0x9D, 0xDA, 0xC7, 0x8E, 0x95, 0x93, 0x31, 
+0xE5, 0xBE, 0xF4, 0x49, 0x56, 0x10, 0x8C, 0x66, 
+0x66, 0x9A, 0x16, 0x12, 0x06, 0x52, 0x4B, 0xD5, 
+0xE9, 0xEB, 0xBD, 0xFF, 0x91, 0xB7, 0xC2, 0x93, 
+0x71, 0xB9, 0x9A, 0xB2, 0xF9, 0xF7, 0xD0, 0xC4, 
+0x58, 0xA3, 0x89, 0x54, 0xCE, 0xA0, 0x31, 0x06, 
+0x71, 0x60, 0xBC, 0x11, 0xFC, 0xB2, 0xE0, 0x20, 
+0x8F, 0x73, 0xA1, 0x0D, 0xD6, 0x0A, 0x66, 0x80, 
+0x7D, 0xEF, 0x4D, 0x04, 
+
+0xB0, 0x38, 0x3D, 0xF5, 0x2F, 0xD8, 0x49, 0x84, 
+0x2E, 0x81, 0x44, 0xB8, 0x3F, 0x2A, 0x56, 0xD4, 
+0xC1, 0x5B, 0xF0, 0x80, 0x22, 0xC9, 0x4F, 0x13, 
+0x95, 0xA6, 0xFB, 0x1C, 0xBD, 0x82, 0xC9, 0x67, 
+0x37, 0x25, 0x21, 0x26, 0xA1, 0x4D, 0x71, 0xA7, 
+0xCC, 0x35, 0xB4, 0xF8, 0x9E, 0x92, 0xCB, 0x38, 
+0xBC, 0xAA, 0x9D, 0x5D, 0xFB, 0x04, 0x0B, 0xD7, 
+0xE2, 0x40, 0x0B, 0x84, 0x97, 0x22, 0x6A, 0x67, 
+0xA9, 0x6A, 0xB8, 0x43, 0x76, 0xA8, 0x66, 0xCC, 
+0x58, 0xB3, 0x3F, 0x98, 0x2A, 0xC5, 0x02, 0x77, 
+0x4B, 0xC7, 0x81, 0xFA, 0x9E, 
+
+0x3B, 0xFD, 0xCA, 0x9F, 0x2D, 0xE0, 0x46, 0x6D, 
+0x0A, 0x25, 0xDD, 0x6D, 0x26, 0x5A, 0x2F, 0x33, 
+0xEC, 0x4F, 0xCC, 0x13, 0xC5, 0x1B, 0x66, 0xF4, 
+0xC4, 0x9A, 0x16, 0xCC, 0x98, 0x2D, 0x34, 0x34, 
+0x40, 0xDD, 0x51, 0x22, 0xDB, 0xF6, 0x2A, 0xA7, 
+0x30, 0xD5, 0x63, 0xC0, 0x8F, 0x90, 0x57, 0xFC, 
+0x09, 0xBE, 0x22, 0xB1, 0x29, 0xC5, 0xDC, 0x81, 
+0x03, 0x99, 0x35, 0x7D, 0x1E, 0x85, 0xC2, 0xA0, 
+0x29, 0x55, 0xEA, 0xEB, 0xD7, 0x8C, 0xF4, 0x46, 
+0xA7, 0x6D, 0x29, 0x8A, 0x6B, 0x38, 0x00, 0x3C, 
+0x38, 0x52, 0x7B, 0x44, 0x28, 0x5E, 
+
+0xD5, 0x24, 0x00, 0xA6, 0xA7, 0x82, 0x5C, 0x52, 
+0xAB, 0x2A, 0xCE, 0xCB, 0xD5, 0x53, 0x3F, 0x12, 
+0x92, 0xB8, 0x32, 0x87, 0x16, 0xB5, 0xCA, 0x10, 
+0x75, 0xB5, 0x69, 0x86, 0x3D, 0xB1, 0x8D, 0x23, 
+0x29, 0x96, 0x7C, 0xB4, 0x7F, 0xE7, 0xC2, 0x59, 
+0xE8, 0x28, 0x9A, 0x38, 0x46, 0xB7, 0xE8, 0x7C, 
+0x48, 0xFA, 0x73, 0xD4, 0xD7, 0x98, 0xED, 0x1D, 
+0x58, 0xEC, 0xB6, 0xAA, 0x61, 0xC4, 0x68, 0xF9, 
+0xF7, 0xF6, 0xFD, 0x9F, 0xB8, 0x36, 0xA6, 0x10, 
+0x52, 0x0C, 0x6C, 0x8F, 0x6A, 0x66, 0xD8, 0x5C, 
+0x0E, 0x66, 0xF2, 0x6E, 0xBE, 0x65, 0x23, 
+
+0xDC, 0xA5, 0x73, 0x95, 0xA7, 0x94, 0xD7, 0x74, 
+0xD7, 0xE7, 0x7B, 0xBE, 0x30, 0x4E, 0xD5, 0x13, 
+0xF3, 0x64, 0x59, 0x73, 0xDC, 0x5C, 0xCD, 0x64, 
+0x99, 0xA7, 0xB5, 0x57, 0xCE, 0x11, 0x62, 0x3A, 
+0x41, 0xC1, 0xA1, 0x2C, 0x67, 0xFB, 0x7E, 0x71, 
+0xC7, 0xE6, 0x42, 0x3A, 0x70, 0x23, 0xD8, 0xA4, 
+0x65, 0x4F, 0x63, 0x92, 0xE1, 0x83, 0xF1, 0x38, 
+0x22, 0x82, 0x5C, 0xD2, 0xAB, 0xF7, 0x2A, 0x1E, 
+0x1D, 0xA9, 0xAB, 0xEE, 0xB5, 0x3D, 0xED, 0xDA, 
+0xF8, 0xD3, 0xBF, 0xFF, 0x51, 0xA1, 0x4A, 0x92, 
+0x96, 0x96, 0x5B, 0xCD, 0x2A, 0xAF, 0x0F, 0xF6, 
+
+0xF7, 0x6C, 0x9C, 0x10, 0x4F, 0x29, 0x68, 0x4E, 
+0x5B, 0x06, 0x71, 0xB4, 0x89, 0x40, 0xAD, 0x1C, 
+0x80, 0x18, 0x8F, 0xB5, 0xFE, 0xB5, 0x97, 0xF8, 
+0xE8, 0xDE, 0x12, 0x4C, 0x3C, 0x5E, 0x3F, 0xCC, 
+0xDC, 0xDD, 0x7B, 0xAD, 0x72, 0x61, 0x97, 0x9E, 
+0x62, 0xC4, 0xA4, 0x94, 0x07, 0x80, 0x3E, 0x22, 
+0x4E, 0x2E, 0xA9, 0x23, 0x36, 0xAE, 0x72, 0xA7, 
+0xE1, 0x29, 0x78, 0x98, 0x77, 0x37, 0x7B, 0xAE, 
+0x6E, 0xEF, 0x94, 0xCB, 0x99, 0x4E, 0x82, 0xE9, 
+0x28, 0x9B, 0xD0, 0xFA, 0x02, 0x9A, 0x7E, 0xB2, 
+0xBB, 0xBC, 0x70, 0xCF, 0x48, 0x76, 0x46, 0x75, 
+0xD2, 
+
+0xE2, 0x8D, 0x7E, 0xC1, 0x6A, 0x9C, 0x50, 0x8A, 
+0x8B, 0x7E, 0xBB, 0xE5, 0x41, 0x68, 0x2E, 0x55, 
+0xFC, 0x9F, 0xC7, 0xE2, 0x37, 0x6C, 0x3F, 0xEB, 
+0xA4, 0xD7, 0xD5, 0x08, 0x6A, 0x52, 0x6E, 0xE7, 
+0x83, 0x58, 0x11, 0x5C, 0x29, 0xA4, 0x29, 0x6C, 
+0xD2, 0xA7, 0xDD, 0x54, 0x3E, 0xEC, 0xD1, 0x7B, 
+0x84, 0xDD, 0xFD, 0x36, 0x12, 0x62, 0x74, 0x9F, 
+0x5C, 0xC8, 0xEA, 0x89, 0x4B, 0xFA, 0xC0, 0xCE, 
+0x1F, 0x8C, 0x33, 0x48, 0x98, 0xA0, 0xBB, 0x6E, 
+0x1E, 0xBD, 0xE7, 0xD7, 0xB3, 0x35, 0xC1, 0x28, 
+0x50, 0x5B, 0xFD, 0x06, 0x01, 0x56, 0xE4, 0xD8, 
+0x85, 0x13, 
+
+0x07, 0x47, 0x46, 0x65, 0xD1, 0x22, 0x89, 0x95, 
+0xB3, 0xF6, 0x20, 0xF0, 0x7E, 0x8A, 0x53, 0xE9, 
+0x68, 0x01, 0xEE, 0x60, 0xED, 0x14, 0x81, 0xA1, 
+0x45, 0x50, 0x57, 0x9B, 0x5F, 0xF9, 0xBE, 0xFE, 
+0x62, 0x49, 0x2F, 0xC3, 0x8B, 0x1A, 0x2B, 0xC6, 
+0x31, 0x9E, 0x0F, 0x0F, 0xEA, 0x36, 0xCE, 0x30, 
+0xA7, 0xD9, 0xE6, 0xD9, 0x6B, 0xE2, 0x5B, 0x46, 
+0x21, 0x1A, 0x7D, 0x31, 0x2D, 0xE1, 0xCA, 0xF7, 
+0xD9, 0xB7, 0xD0, 0x3C, 0xC6, 0x97, 0x59, 0xCC, 
+0xB0, 0xD0, 0xE1, 0x3A, 0x8A, 0x4B, 0x8A, 0x89, 
+0xC6, 0x9F, 0x14, 0x26, 0x51, 0x94, 0x6A, 0x08, 
+0x19, 0xE2, 0x63, 
+
+0xA7, 0x15, 0x38, 0x35, 0x0B, 0x71, 0xDC, 0x55, 
+0x2A, 0xAB, 0x54, 0x74, 0x38, 0x1B, 0x9A, 0x58, 
+0xA4, 0x0F, 0x77, 0x36, 0x28, 0x6D, 0x45, 0x76, 
+0xED, 0x09, 0xEA, 0xFC, 0x33, 0x01, 0x3B, 0x25, 
+0xA2, 0x8C, 0x8D, 0xD9, 0x03, 0x26, 0xD1, 0x78, 
+0x8F, 0x55, 0xF5, 0x6D, 0x29, 0xA5, 0x08, 0x4F, 
+0xE8, 0x6A, 0x47, 0x2F, 0x5F, 0x4D, 0xA9, 0x36, 
+0x2E, 0xE4, 0x07, 0x97, 0xE4, 0x9D, 0xCC, 0x94, 
+0x63, 0xBF, 0x8A, 0xD4, 0xEF, 0xD4, 0x59, 0x71, 
+0xDC, 0xFE, 0xF1, 0xEF, 0xE4, 0x95, 0x04, 0x56, 
+0xD8, 0xD9, 0x58, 0x97, 0x8E, 0x36, 0x41, 0x79, 
+0x0E, 0x5A, 0xC5, 0x7D, 
+
+0xDC, 0x2A, 0xCE, 0x12, 0x74, 0x49, 0x4C, 0xB1, 
+0x31, 0x95, 0x83, 0x52, 0x40, 0xD7, 0x89, 0xF8, 
+0xCB, 0x45, 0xCC, 0xCC, 0x9C, 0xAE, 0xE8, 0x1F, 
+0x06, 0xEA, 0x2A, 0x66, 0x83, 0x6E, 0x50, 0xC1, 
+0xC9, 0xFC, 0x48, 0x46, 0xC0, 0x24, 0xFB, 0x03, 
+0xB7, 0x31, 0xC4, 0x68, 0x6A, 0x59, 0x0D, 0xCE, 
+0x35, 0x03, 0x09, 0x7B, 0x5B, 0x61, 0xEA, 0x11, 
+0x6D, 0x48, 0x4D, 0x9F, 0xD9, 0x1D, 0x96, 0x4A, 
+0xE8, 0x36, 0x92, 0xE0, 0x25, 0x6C, 0x78, 0xE2, 
+0x7F, 0xB4, 0x0E, 0xEB, 0x3B, 0x6B, 0x1A, 0x2E, 
+0xA3, 0x4D, 0x18, 0x22, 0xF4, 0x54, 0xB7, 0xB3, 
+0xD7, 0x69, 0xDB, 0x86, 0x5A, 
+
+0x7A, 0x0F, 0x12, 0xA8, 0xD9, 0xED, 0x2A, 0xE3, 
+0x26, 0x10, 0x01, 0x46, 0x32, 0x86, 0xA3, 0x72, 
+0x27, 0x04, 0x3A, 0x26, 0xDF, 0x21, 0x25, 0x8D, 
+0x9C, 0x08, 0x59, 0xEE, 0xA6, 0x3C, 0xDB, 0xD4, 
+0x5D, 0x6E, 0xC4, 0x16, 0x25, 0x09, 0x37, 0x72, 
+0x40, 0x3D, 0xBE, 0x04, 0x60, 0xDD, 0xD3, 0xFA, 
+0xC9, 0xFF, 0x33, 0x09, 0x6E, 0x42, 0x95, 0x65, 
+0x56, 0x4D, 0x5A, 0xB0, 0x53, 0xA8, 0xF2, 0xC0, 
+0xCA, 0x09, 0x1D, 0xE2, 0xBB, 0x4F, 0xC3, 0x19, 
+0x95, 0x03, 0x88, 0xEB, 0x04, 0x81, 0xEC, 0xAB, 
+0xE6, 0x0E, 0xAE, 0x99, 0x11, 0x85, 0x99, 0x91, 
+0x95, 0xB7, 0x73, 0xBC, 0x54, 0xD9, 
+
+0x5A, 0x8E, 0xD3, 0xCE, 0x2A, 0xA2, 0xEE, 0x3B, 
+0x48, 0x98, 0x54, 0xFD, 0x75, 0x49, 0x95, 0x2D, 
+0x9E, 0xD0, 0xDE, 0x1E, 0xD2, 0x56, 0x88, 0x31, 
+0xDB, 0xCE, 0x98, 0x1C, 0x75, 0xDE, 0x44, 0x1D, 
+0x4B, 0xD5, 0xE3, 0x1E, 0x9E, 0x58, 0xB0, 0xC9, 
+0x52, 0x2E, 0x9D, 0x8F, 0xEF, 0x34, 0x96, 0x89, 
+0x65, 0x8F, 0x52, 0x29, 0xFA, 0x8B, 0xD7, 0x0A, 
+0xBE, 0xFE, 0x5C, 0xD9, 0x7C, 0x34, 0xB5, 0x32, 
+0xF1, 0x40, 0x64, 0xD0, 0xA0, 0x46, 0x7C, 0xB4, 
+0xBE, 0x1B, 0x97, 0xFA, 0x64, 0xCB, 0x0F, 0x77, 
+0x19, 0x77, 0xCC, 0x2A, 0x2F, 0x5D, 0x7E, 0x30, 
+0x7C, 0x8B, 0xE8, 0x5A, 0x1C, 0x14, 0xB9, 
+
+0x6D, 0x73, 0x03, 0x46, 0x6A, 0x95, 0xE6, 0x63, 
+0xF1, 0x7C, 0x15, 0xAF, 0x08, 0x12, 0xD1, 0x44, 
+0xC9, 0x7E, 0x84, 0xCF, 0xE4, 0x33, 0xD4, 0x23, 
+0x24, 0x19, 0x03, 0xE4, 0x4D, 0xC2, 0x4D, 0x0F, 
+0x1D, 0x5D, 0xE0, 0xE1, 0x84, 0x98, 0x71, 0x38, 
+0x45, 0xCF, 0x15, 0x57, 0x2A, 0x1C, 0x3F, 0xD8, 
+0xA6, 0x21, 0x5E, 0xEF, 0x1A, 0xDE, 0x97, 0x95, 
+0xE3, 0x29, 0xD7, 0xD2, 0x5A, 0x08, 0xE5, 0xD9, 
+0x89, 0x33, 0x62, 0x82, 0x2A, 0xA9, 0x11, 0x73, 
+0x6A, 0x2C, 0xD4, 0x58, 0xC7, 0xBB, 0xCD, 0x6A, 
+0x03, 0x51, 0x48, 0x37, 0xEC, 0x92, 0xCC, 0xBE, 
+0x34, 0x6D, 0xB8, 0x2E, 0x1F, 0x0B, 0xE4, 0x74, 
+
+0xBE, 0xD0, 0x1E, 0xF8, 0xB9, 0x6A, 0x92, 0x5C, 
+0xFC, 0x3A, 0x8D, 0x19, 0x88, 0x68, 0x55, 0xC9, 
+0xB9, 0xC3, 0xAF, 0xED, 0x5A, 0xF6, 0x48, 0x23, 
+0x64, 0x7B, 0x0F, 0x69, 0xD6, 0x9C, 0xA2, 0x73, 
+0xAF, 0xFA, 0x32, 0x82, 0x4A, 0xB6, 0x1D, 0x30, 
+0xDE, 0xAE, 0x09, 0x80, 0x77, 0x74, 0x42, 0x6E, 
+0x3E, 0x21, 0x0C, 0x25, 0xD9, 0xC6, 0x17, 0x7D, 
+0x7B, 0x6B, 0x4D, 0xA8, 0xB2, 0x14, 0x87, 0x5F, 
+0xBE, 0x35, 0x0A, 0x7E, 0x3C, 0xE2, 0x5C, 0x3E, 
+0x72, 0x54, 0x4A, 0xB3, 0x44, 0x13, 0x16, 0x78, 
+0x26, 0x16, 0xC3, 0x81, 0x6D, 0x20, 0x0E, 0xF6, 
+0x6B, 0x69, 0x0F, 0xBC, 0xA1, 0xFB, 0x45, 0xBE, 
+0xAE, 
+
+0xA5, 0x66, 0xC5, 0xED, 0x9E, 0xB6, 0xC6, 0xED, 
+0x4F, 0xA7, 0x13, 0x63, 0xE3, 0x09, 0x10, 0x3D, 
+0x86, 0xAB, 0x0D, 0x43, 0x4E, 0xC1, 0x94, 0xBF, 
+0x64, 0xA1, 0x30, 0x50, 0x1D, 0xC4, 0x3C, 0x27, 
+0x39, 0xE7, 0xE0, 0xD2, 0x3A, 0x50, 0x61, 0x0C, 
+0x2C, 0x27, 0xAF, 0xD0, 0xC3, 0x01, 0x82, 0x8A, 
+0x6D, 0x54, 0x00, 0x3F, 0xB8, 0xFF, 0x2B, 0x09, 
+0xF1, 0x99, 0xCC, 0x67, 0x72, 0x4E, 0x3B, 0x82, 
+0x4A, 0x2B, 0x6F, 0xE2, 0x35, 0xC2, 0x22, 0x2A, 
+0x25, 0x55, 0x68, 0x31, 0xD0, 0x75, 0x49, 0xB9, 
+0xBD, 0xAF, 0xA3, 0x55, 0xAB, 0x8A, 0x4E, 0x32, 
+0xE4, 0x5A, 0xA1, 0xB3, 0xB1, 0x02, 0x11, 0xD8, 
+0xCC, 0x20, 
+
+0x47, 0x42, 0xA4, 0x9B, 0x51, 0x38, 0x87, 0x6D, 
+0x3A, 0x21, 0x17, 0x31, 0xF5, 0x79, 0x48, 0x8C, 
+0x3D, 0xD7, 0x6E, 0xCC, 0xF2, 0x09, 0x3E, 0x2B, 
+0x5D, 0x69, 0x79, 0x43, 0x29, 0x02, 0x9C, 0xAA, 
+0x15, 0xD0, 0x4B, 0x32, 0xAD, 0x61, 0xEA, 0x00, 
+0xF0, 0x22, 0xAD, 0x7A, 0x12, 0xAF, 0xDE, 0xFB, 
+0x55, 0x38, 0xD8, 0xA9, 0x3F, 0x09, 0x34, 0xAF, 
+0x4C, 0x42, 0xD4, 0x20, 0x69, 0x7C, 0x0C, 0xBA, 
+0x34, 0xE3, 0xA5, 0xB8, 0x11, 0x43, 0x10, 0x41, 
+0xC9, 0x1A, 0x59, 0x74, 0x18, 0x5F, 0x3B, 0xB2, 
+0xA2, 0xA6, 0x9A, 0x4C, 0x23, 0x9F, 0x3F, 0xD8, 
+0x33, 0x64, 0x41, 0x84, 0xBF, 0x8D, 0x06, 0x12, 
+0x1E, 0x56, 0x67, 
+
+0x50, 0x7D, 0x3F, 0xA5, 0x4F, 0x79, 0xCE, 0xF9, 
+0xAE, 0x64, 0xC4, 0xC9, 0x59, 0x9C, 0x2D, 0x8E, 
+0x86, 0xDD, 0xC8, 0xAA, 0xE7, 0x1A, 0x21, 0xB6, 
+0x80, 0x23, 0xF4, 0xB1, 0xD0, 0x9E, 0x57, 0xCE, 
+0x08, 0xAB, 0x0D, 0xB8, 0xB0, 0xA8, 0x06, 0xD8, 
+0xE6, 0xA8, 0xD6, 0x31, 0xF8, 0x45, 0x62, 0xDF, 
+0x71, 0x41, 0x25, 0xB7, 0xEB, 0x3A, 0xFC, 0x93, 
+0x63, 0x49, 0x36, 0x07, 0x8F, 0x18, 0x3A, 0x1F, 
+0x1C, 0xA3, 0xCB, 0x90, 0xED, 0x68, 0x47, 0x58, 
+0xB9, 0xB6, 0x85, 0x8A, 0x2B, 0x05, 0xA7, 0x25, 
+0x03, 0xD2, 0x36, 0xF7, 0x04, 0xE4, 0xA3, 0xE2, 
+0x7F, 0xEE, 0x09, 0x6C, 0x57, 0xBB, 0xC0, 0x02, 
+0x21, 0xC3, 0xF5, 0x2F, 
+
+0xA8, 0x9B, 0xC0, 0x79, 0x9F, 0xC0, 0xEF, 0x00, 
+0xD4, 0x30, 0x90, 0xF2, 0xDB, 0xD6, 0x08, 0x36, 
+0x87, 0x38, 0xFF, 0x57, 0xC0, 0xB3, 0x91, 0x42, 
+0x4F, 0xE8, 0x11, 0xF5, 0xD6, 0x3E, 0xCE, 0xEA, 
+0x62, 0x23, 0xDA, 0x0C, 0x98, 0xF8, 0xEA, 0xA3, 
+0xC9, 0x21, 0xF6, 0x03, 0xD4, 0x71, 0x79, 0x6A, 
+0xE4, 0x2A, 0x0D, 0xF2, 0xD7, 0xF2, 0xE4, 0x04, 
+0xF3, 0xB7, 0xAD, 0x9D, 0xF5, 0xB4, 0xDC, 0x58, 
+0xF3, 0x6C, 0xE3, 0x95, 0xDE, 0xA8, 0x9F, 0x60, 
+0x59, 0xDF, 0x67, 0xC6, 0x47, 0xF7, 0x05, 0xE1, 
+0x6C, 0xDE, 0x50, 0x35, 0x35, 0x14, 0x22, 0xC1, 
+0x74, 0x7F, 0xE7, 0xB4, 0xA9, 0x51, 0x3F, 0x54, 
+0x9A, 0x89, 0x31, 0xFF, 0xDD, 
+
+0x05, 0xDC, 0xD4, 0xC5, 0xDB, 0xCD, 0x66, 0x3A, 
+0xC7, 0x70, 0x43, 0x83, 0x38, 0x21, 0x1C, 0x17, 
+0xB8, 0xC5, 0x59, 0x0D, 0x5E, 0x68, 0xF9, 0x1E, 
+0x10, 0xD7, 0x88, 0xB1, 0x61, 0x31, 0x7D, 0x10, 
+0x11, 0x27, 0xA2, 0x9E, 0x27, 0x99, 0x27, 0x66, 
+0x37, 0x6F, 0x2F, 0x2C, 0x43, 0xE4, 0x0C, 0xAA, 
+0x69, 0xEC, 0x9B, 0xE0, 0x99, 0xE8, 0x0B, 0x61, 
+0xDD, 0x6F, 0x97, 0xC2, 0x83, 0x6A, 0xE2, 0x98, 
+0x73, 0xA1, 0xB6, 0x84, 0x78, 0x16, 0xBD, 0xB5, 
+0x38, 0x39, 0x90, 0x9E, 0x30, 0x92, 0x5C, 0x4B, 
+0x0D, 0x7C, 0x45, 0xCE, 0x9B, 0x8A, 0xB1, 0x83, 
+0x41, 0xF0, 0x01, 0xBD, 0xFB, 0x5D, 0x61, 0x9A, 
+0xBE, 0x5C, 0x57, 0x33, 0xA5, 0xAC, 
+
+0xE6, 0x66, 0xF6, 0xF0, 0x8A, 0xD1, 0x3F, 0x25, 
+0xEB, 0xC4, 0x97, 0x47, 0x9A, 0x3D, 0xA4, 0xDE, 
+0x54, 0xC3, 0x71, 0xBE, 0x82, 0xD3, 0x4B, 0x41, 
+0xD4, 0x24, 0x38, 0xFE, 0x5A, 0x5B, 0x17, 0xE0, 
+0x6B, 0x7C, 0x01, 0xF0, 0xFF, 0x19, 0xF5, 0x07, 
+0xC3, 0xDA, 0x0F, 0x90, 0x41, 0x95, 0x00, 0xD2, 
+0xFB, 0x02, 0xB1, 0xAC, 0xDC, 0xA1, 0x03, 0xA1, 
+0xCD, 0x16, 0x12, 0xF1, 0x3A, 0xA8, 0x30, 0x53, 
+0x6C, 0x2A, 0x75, 0xDC, 0xA4, 0xAC, 0x76, 0xB3, 
+0x07, 0x8F, 0xB9, 0x32, 0x13, 0xF1, 0x79, 0xDA, 
+0x0A, 0x47, 0xF9, 0xF0, 0xCB, 0xEE, 0x62, 0x8D, 
+0x07, 0xF6, 0x06, 0xA3, 0xA3, 0xAA, 0xB3, 0x63, 
+0x63, 0xFB, 0xDE, 0x1A, 0x74, 0x7B, 0x79, 
+
+0xBF, 0x02, 0xFC, 0xAA, 0x8C, 0x22, 0x0E, 0x02, 
+0xD1, 0xD6, 0x57, 0x92, 0x43, 0xEB, 0x41, 0xBD, 
+0x1F, 0x49, 0x3D, 0x63, 0x25, 0x4A, 0x2B, 0x7F, 
+0xEF, 0x0E, 0x03, 0xA9, 0x40, 0x17, 0xA0, 0x7E, 
+0x2C, 0x17, 0x38, 0xB0, 0x16, 0xA9, 0xD4, 0xCC, 
+0xD8, 0x75, 0x60, 0x3B, 0x7C, 0x5F, 0x2E, 0xE0, 
+0xBD, 0x28, 0x25, 0xCB, 0xA4, 0x9D, 0xCE, 0x82, 
+0x81, 0xDB, 0xD9, 0xEB, 0x8B, 0xD2, 0x60, 0xC5, 
+0x4D, 0x48, 0xD7, 0x5C, 0xD0, 0xAB, 0x4D, 0x8B, 
+0x65, 0x90, 0x01, 0xCE, 0x53, 0x09, 0xA3, 0x30, 
+0x6B, 0x40, 0x59, 0x7B, 0xCE, 0x78, 0xC6, 0x8F, 
+0xBA, 0x7A, 0xB1, 0x1B, 0xA9, 0xBF, 0x51, 0xE3, 
+0x84, 0x3E, 0x93, 0x33, 0x82, 0x7E, 0x8A, 0xA3, 
+
+0x75, 0x4B, 0x38, 0x79, 0xF7, 0x5A, 0x23, 0x8A, 
+0x28, 0x45, 0x07, 0x1D, 0x38, 0xC8, 0x44, 0x4E, 
+0x8B, 0xB1, 0xFF, 0x08, 0x86, 0xDA, 0xDA, 0x4C, 
+0x1C, 0x2D, 0xA5, 0x50, 0xE6, 0x81, 0x12, 0x9A, 
+0x62, 0x12, 0x41, 0x9D, 0xE9, 0x84, 0x11, 0xBC, 
+0xA9, 0x20, 0xA5, 0xAF, 0xED, 0x4C, 0xFE, 0x80, 
+0x38, 0x72, 0x08, 0x6C, 0xF7, 0x0D, 0x83, 0xC1, 
+0xF0, 0x76, 0x6F, 0x99, 0x4D, 0xCA, 0x98, 0x21, 
+0xF3, 0xC8, 0xF4, 0x48, 0xD2, 0xC9, 0x10, 0x7B, 
+0x27, 0x75, 0xB9, 0xE8, 0x25, 0x3D, 0x85, 0xAF, 
+0x1D, 0xCF, 0x2E, 0x19, 0x81, 0x2C, 0x86, 0x5C, 
+0xBE, 0x67, 0x51, 0x16, 0x48, 0x74, 0xF8, 0xC8, 
+0xA1, 0x72, 0xB5, 0xF0, 0x2B, 0xB7, 0x5F, 0x9A, 
+0xC9, 
+
+0x2E, 0xB9, 0x2B, 0x7B, 0xED, 0x0F, 0x81, 0xB5, 
+0x17, 0xC7, 0x0E, 0x3B, 0x31, 0x61, 0xDC, 0x12, 
+0xE6, 0x75, 0x03, 0x8D, 0x6B, 0xE6, 0x73, 0x0C, 
+0xFB, 0xEC, 0x93, 0x47, 0xB2, 0x2E, 0x4F, 0xC6, 
+0x9E, 0x3C, 0xA9, 0x75, 0x49, 0xB2, 0xC2, 0x37, 
+0xA8, 0x4D, 0xE6, 0xA4, 0x4A, 0x31, 0xF3, 0x48, 
+0x1D, 0xF7, 0x24, 0x01, 0x97, 0xDA, 0x64, 0xBA, 
+0x0F, 0x39, 0x25, 0x95, 0x2C, 0x7A, 0x84, 0x77, 
+0xF8, 0x7B, 0x71, 0xAE, 0xE9, 0xA6, 0x80, 0x34, 
+0x38, 0xA4, 0x2C, 0xFB, 0xED, 0x5A, 0xD8, 0x45, 
+0x50, 0x16, 0xBA, 0xBC, 0xD4, 0xFC, 0xE5, 0x98, 
+0x4F, 0xA1, 0xA8, 0x21, 0x7E, 0x87, 0xF5, 0x4E, 
+0x6E, 0x5F, 0xCF, 0xBE, 0xC3, 0x62, 0xA2, 0x91, 
+0x8E, 0x77, 
+
+0x0A, 0xFB, 0xBA, 0xE5, 0x0A, 0x2F, 0x0B, 0x10, 
+0x61, 0xD1, 0x03, 0x33, 0x64, 0xE0, 0x50, 0xB0, 
+0x3D, 0x3A, 0x26, 0x36, 0xAC, 0xDF, 0xD2, 0x50, 
+0x6E, 0xB0, 0x78, 0x55, 0xBD, 0xAB, 0x41, 0xB9, 
+0x8A, 0x91, 0x26, 0x55, 0x2A, 0xB9, 0x35, 0x3B, 
+0xFC, 0x4E, 0x8E, 0x7F, 0x60, 0xFB, 0x57, 0x12, 
+0xAF, 0xFD, 0xAE, 0x80, 0x84, 0x1C, 0x63, 0x20, 
+0x68, 0xF5, 0x77, 0x0D, 0x11, 0xF8, 0xFD, 0x16, 
+0x41, 0x8A, 0x0C, 0x0A, 0x70, 0x39, 0xED, 0x58, 
+0x91, 0x04, 0x05, 0xC6, 0xCF, 0xA9, 0x17, 0x03, 
+0xB0, 0x67, 0x8A, 0x12, 0x7D, 0x32, 0xDB, 0x68, 
+0xA4, 0x48, 0xE2, 0x2C, 0xEF, 0x23, 0x93, 0x1C, 
+0x3D, 0xDA, 0xA4, 0xB5, 0xD5, 0x61, 0xF4, 0x6A, 
+0xEA, 0xE5, 0xB1, 
+
+0x2D, 0x14, 0xF7, 0x07, 0x0B, 0xFB, 0xAE, 0x8F, 
+0x16, 0xC9, 0xFD, 0x23, 0xBD, 0xD8, 0x96, 0x22, 
+0x59, 0x5A, 0x9C, 0x72, 0xD3, 0xA9, 0xF9, 0xC9, 
+0xD5, 0xC8, 0x72, 0x33, 0x8A, 0xDF, 0x2D, 0xF7, 
+0xA5, 0x5C, 0x9E, 0xE7, 0x7D, 0x08, 0xBF, 0x49, 
+0xDD, 0x01, 0xE0, 0x36, 0x32, 0x8D, 0xAC, 0xA0, 
+0xD4, 0xF7, 0x8F, 0x3B, 0xD8, 0x56, 0xB2, 0x03, 
+0x42, 0x72, 0x87, 0xF6, 0xA8, 0x57, 0x49, 0x9A, 
+0x76, 0xF3, 0xDE, 0xB6, 0x4A, 0xCC, 0xEC, 0x8D, 
+0xE9, 0x6F, 0x22, 0x1A, 0xFF, 0x5A, 0xEC, 0x0F, 
+0x40, 0xB7, 0x96, 0x08, 0x70, 0xEB, 0x2B, 0xB4, 
+0x2C, 0xDC, 0x69, 0x54, 0xC9, 0x95, 0xF0, 0x1E, 
+0x43, 0xC2, 0x16, 0x2C, 0xC6, 0xAD, 0xF4, 0xC9, 
+0x1A, 0xEE, 0xBA, 0xE8, 
+
+0xFF, 0x07, 0x0B, 0x99, 0xF2, 0x29, 0x7A, 0xBF, 
+0x77, 0x8E, 0x35, 0x03, 0x16, 0x88, 0xBF, 0xAB, 
+0xBC, 0x6C, 0x31, 0x80, 0x1B, 0xF1, 0x34, 0x06, 
+0x47, 0x70, 0x7E, 0x98, 0x42, 0x92, 0xD5, 0xFF, 
+0x23, 0x21, 0x57, 0x5E, 0x97, 0xA7, 0x7C, 0xD7, 
+0x75, 0xBD, 0x31, 0x82, 0xF9, 0xD9, 0x6D, 0xF1, 
+0x98, 0x17, 0xB0, 0x93, 0xDF, 0xA3, 0x6F, 0x37, 
+0x11, 0x41, 0x5D, 0x54, 0xCD, 0x5F, 0xD7, 0x07, 
+0x5F, 0x43, 0x53, 0x66, 0x04, 0x71, 0x24, 0xC5, 
+0x9A, 0x2D, 0xCE, 0x8C, 0x7B, 0x29, 0x00, 0x2E, 
+0x02, 0x2B, 0x5A, 0x6D, 0x87, 0x01, 0x50, 0xFF, 
+0xF2, 0x06, 0xA0, 0x9F, 0x9C, 0x50, 0x54, 0xB6, 
+0x02, 0xB7, 0x88, 0x99, 0x24, 0xAA, 0x1F, 0x2B, 
+0x65, 0x54, 0x6F, 0xB8, 0xF0, 
+
+0x79, 0xB8, 0xC3, 0x4E, 0x02, 0xE0, 0xE4, 0xB7, 
+0x95, 0x74, 0x8A, 0xFD, 0xD4, 0xE2, 0x3B, 0x6B, 
+0xA4, 0xC9, 0x3B, 0x52, 0xFE, 0xAA, 0x15, 0x71, 
+0x63, 0xE2, 0x9A, 0x19, 0x89, 0xE1, 0x64, 0x06, 
+0xC7, 0xA6, 0x3E, 0x0B, 0x2B, 0x0B, 0xB9, 0x28, 
+0x88, 0x3B, 0x07, 0x7B, 0x55, 0x8B, 0x69, 0x14, 
+0xF4, 0x54, 0xC0, 0x1B, 0x90, 0x9B, 0x92, 0x56, 
+0xB9, 0x8F, 0x66, 0x16, 0x8B, 0x67, 0x2E, 0xF5, 
+0xF8, 0x06, 0x3D, 0x33, 0xB6, 0x5B, 0x7A, 0x69, 
+0xA4, 0x46, 0x73, 0x66, 0x2B, 0x43, 0x6D, 0xFA, 
+0x4B, 0x30, 0x85, 0xE6, 0x5C, 0x91, 0xE1, 0xC6, 
+0x46, 0x10, 0x98, 0xF2, 0xEE, 0xEF, 0xCB, 0x4E, 
+0xB1, 0x70, 0xF8, 0xA0, 0xD5, 0x86, 0xC6, 0x77, 
+0xD7, 0x53, 0xC0, 0x9E, 0xA0, 0xD8, 
+
+0x97, 0xB9, 0xA6, 0xFD, 0xB3, 0xD4, 0xAA, 0x8F, 
+0xC2, 0x45, 0x7A, 0xF5, 0x13, 0x73, 0xA8, 0x80, 
+0x4F, 0x39, 0x59, 0x3A, 0x2D, 0xCA, 0x18, 0x88, 
+0x94, 0x8B, 0xC5, 0x3C, 0x4E, 0x15, 0xE4, 0x0A, 
+0xB1, 0xD8, 0x3D, 0xA0, 0xC1, 0x3E, 0x9F, 0x93, 
+0x08, 0x33, 0x58, 0x69, 0x1E, 0xEC, 0xF6, 0xDD, 
+0xBE, 0x4B, 0x89, 0x43, 0x03, 0x74, 0xAA, 0x6B, 
+0xEE, 0xC2, 0x1B, 0x72, 0xC6, 0x44, 0xAC, 0x1C, 
+0x4E, 0x89, 0xB9, 0x08, 0x25, 0x32, 0x69, 0xC4, 
+0x27, 0x8E, 0x44, 0xEA, 0x57, 0xE6, 0x02, 0x49, 
+0x4B, 0x03, 0xCB, 0x02, 0xEA, 0xDC, 0xE9, 0xF6, 
+0x2C, 0x27, 0x5E, 0xD9, 0x96, 0xC8, 0xC9, 0x58, 
+0x4A, 0x2A, 0xC8, 0x36, 0xDC, 0xC3, 0x1E, 0x83, 
+0x02, 0x79, 0x41, 0xDF, 0x18, 0x18, 0x19, 
+
+0xA5, 0x23, 0xAA, 0xC4, 0x3C, 0x45, 0x39, 0x82, 
+0x71, 0x3E, 0x3A, 0x57, 0x41, 0x0B, 0x2C, 0x7E, 
+0xBE, 0x06, 0x86, 0xD4, 0x9F, 0xB9, 0x1C, 0x60, 
+0x5E, 0xB7, 0x11, 0xB1, 0xC6, 0xD6, 0xF3, 0x3E, 
+0x20, 0x55, 0x1E, 0x23, 0xFE, 0x65, 0x0F, 0xF3, 
+0xD7, 0x82, 0x4C, 0xE5, 0x90, 0x95, 0xF2, 0x17, 
+0x31, 0xC4, 0x37, 0xC5, 0xE8, 0x3A, 0xF0, 0x92, 
+0x7C, 0x34, 0x7A, 0xD2, 0x16, 0x90, 0xD4, 0xBB, 
+0x5F, 0x7C, 0x6E, 0xD1, 0x68, 0xC2, 0xA7, 0x51, 
+0x04, 0x66, 0x3D, 0x78, 0xE9, 0x31, 0xF7, 0x4A, 
+0xE3, 0x8F, 0x6F, 0xCA, 0xC5, 0xEE, 0x28, 0xE7, 
+0x05, 0xAF, 0x29, 0x1E, 0x41, 0x3E, 0x83, 0xE3, 
+0xF4, 0xEA, 0xF2, 0xD2, 0xAF, 0x59, 0x57, 0x51, 
+0x44, 0x71, 0xF0, 0x05, 0x72, 0x9C, 0xBF, 0x92, 
+
+0x8F, 0x24, 0xFA, 0x4E, 0x3C, 0x12, 0xAF, 0x30, 
+0xB6, 0x95, 0x5E, 0x81, 0x48, 0xB2, 0xBA, 0xFA, 
+0x64, 0xFC, 0xF0, 0x95, 0xD2, 0x2A, 0x13, 0x3F, 
+0x56, 0xE3, 0x63, 0x9E, 0x73, 0x68, 0xCF, 0x74, 
+0x13, 0xCF, 0x2C, 0xC5, 0x2D, 0x90, 0x6E, 0x45, 
+0x94, 0x2F, 0x99, 0x29, 0xDE, 0x29, 0x1C, 0xED, 
+0x3C, 0xB6, 0x17, 0x68, 0x97, 0xB3, 0xB0, 0xC5, 
+0x52, 0xF8, 0x34, 0xB1, 0x36, 0x15, 0x0E, 0xC7, 
+0xBE, 0x55, 0xC0, 0xCD, 0x16, 0x1F, 0xBB, 0x95, 
+0x30, 0xC0, 0x2E, 0x27, 0x3E, 0x49, 0xBC, 0x8C, 
+0x55, 0x19, 0xC9, 0x4A, 0x4A, 0x9F, 0x27, 0x63, 
+0xB9, 0xFB, 0x04, 0x77, 0x83, 0x0F, 0xAD, 0xF9, 
+0x36, 0x2D, 0xA7, 0x7D, 0x36, 0x02, 0x34, 0xA6, 
+0x2F, 0x87, 0x80, 0xCB, 0x86, 0xA9, 0x89, 0xCD, 
+0x66, 
+
+0xA0, 0x24, 0xCB, 0x5F, 0x83, 0xC0, 0xFE, 0x64, 
+0x03, 0x0A, 0xA2, 0x1D, 0x9A, 0x0E, 0x25, 0x08, 
+0x23, 0x7C, 0x78, 0x59, 0x26, 0xFC, 0x07, 0x41, 
+0xD9, 0xC4, 0x0D, 0x4C, 0x65, 0x28, 0x73, 0x78, 
+0xB6, 0x3D, 0x92, 0x38, 0xEE, 0x44, 0x5B, 0x7A, 
+0x5D, 0xD4, 0x4F, 0x44, 0xAE, 0x5C, 0xF8, 0x34, 
+0x3E, 0xD3, 0xBC, 0x51, 0xDF, 0x76, 0x49, 0x8D, 
+0x97, 0xE1, 0x5D, 0x48, 0xAE, 0xE4, 0x11, 0x97, 
+0xC9, 0xAB, 0x0A, 0xC2, 0xF1, 0x2F, 0x93, 0x36, 
+0x3B, 0x98, 0x58, 0x98, 0xD1, 0x9C, 0xA6, 0x2A, 
+0x47, 0x73, 0xAC, 0x41, 0xF1, 0xC9, 0xD6, 0xE2, 
+0x91, 0x31, 0xD5, 0x19, 0x06, 0x67, 0x6D, 0xF8, 
+0x6B, 0xCD, 0x7D, 0x5E, 0xCE, 0xFF, 0x23, 0x1D, 
+0x69, 0xB7, 0x93, 0xEA, 0xDB, 0x5B, 0x21, 0x7A, 
+0x76, 0xBC, 
+
+0x1A, 0x15, 0xDC, 0x87, 0xDD, 0x83, 0xEB, 0x69, 
+0xF9, 0x6F, 0x09, 0xD7, 0x22, 0x8C, 0x5D, 0xE0, 
+0x94, 0xC1, 0x7F, 0x96, 0x3F, 0x61, 0x3C, 0x9A, 
+0x33, 0x45, 0x52, 0x1B, 0x9B, 0x2B, 0x22, 0x96, 
+0x0C, 0xBA, 0xB7, 0xD5, 0x75, 0x26, 0x0E, 0x6A, 
+0x58, 0x9E, 0xCE, 0x21, 0x51, 0x44, 0x3B, 0x67, 
+0x63, 0xAD, 0x83, 0xA1, 0xCD, 0x73, 0x12, 0x2A, 
+0xFB, 0x39, 0x65, 0xBD, 0x06, 0xA1, 0x32, 0x73, 
+0x91, 0x4A, 0x47, 0xB6, 0x24, 0xBF, 0x7B, 0xE5, 
+0x35, 0x4F, 0x9C, 0x48, 0xA7, 0xDC, 0x31, 0x48, 
+0xAA, 0x8E, 0x6A, 0xA8, 0x35, 0x59, 0x71, 0x54, 
+0x5C, 0x62, 0x29, 0xE9, 0xD2, 0xE2, 0xB7, 0x3A, 
+0xE8, 0x7A, 0x82, 0x48, 0xC9, 0x7B, 0x66, 0xC9, 
+0x78, 0x5B, 0xC4, 0xB2, 0x1C, 0x29, 0x50, 0xFF, 
+0x29, 0xCF, 0x24, 
+
+0x94, 0x28, 0xE6, 0x7F, 0xBA, 0x33, 0xF4, 0x6D, 
+0x99, 0xF9, 0x05, 0x7E, 0x1F, 0x55, 0xBB, 0x26, 
+0x06, 0x36, 0x5F, 0xC1, 0xE9, 0xE0, 0x75, 0x9D, 
+0x5B, 0xE4, 0x74, 0xF0, 0x51, 0x49, 0xB4, 0xEC, 
+0x4A, 0x19, 0xD0, 0xA8, 0xD3, 0xF8, 0xE9, 0xA6, 
+0xD7, 0x05, 0xD9, 0x4F, 0x39, 0x1C, 0xD1, 0x6E, 
+0xEA, 0xB0, 0x50, 0xC3, 0x8B, 0xD9, 0xEA, 0x20, 
+0x57, 0x51, 0xFB, 0xF2, 0xBB, 0xE5, 0x24, 0x1A, 
+0xEB, 0x26, 0xE3, 0x27, 0x2E, 0x62, 0x1F, 0x90, 
+0x61, 0x51, 0x0B, 0x9E, 0x5B, 0x92, 0x09, 0x59, 
+0x37, 0x67, 0xFE, 0x72, 0xA6, 0xCE, 0x3D, 0xB8, 
+0x75, 0xD0, 0xC4, 0xDE, 0x0B, 0xFC, 0x59, 0x99, 
+0x59, 0x5C, 0x20, 0x44, 0x50, 0x6B, 0x09, 0x95, 
+0x3A, 0xEF, 0xC7, 0x68, 0x43, 0x4E, 0xB7, 0x1D, 
+0xE6, 0x36, 0xBF, 0x09, 
+
+0xA5, 0x15, 0xAA, 0x1F, 0xAC, 0xE4, 0x4A, 0x2E, 
+0x76, 0xE0, 0x97, 0xFB, 0xA1, 0x3F, 0x41, 0xDD, 
+0xAB, 0x64, 0x31, 0x4D, 0xA2, 0xEA, 0x8D, 0xDA, 
+0x4F, 0x07, 0x6F, 0x00, 0x1F, 0x3F, 0x71, 0x43, 
+0x15, 0x80, 0xFE, 0x13, 0xD4, 0xA7, 0x7B, 0x95, 
+0x63, 0xB4, 0x1C, 0xAA, 0xD1, 0x2B, 0x45, 0x70, 
+0x57, 0x7C, 0x0A, 0x0B, 0x5D, 0x5F, 0x90, 0x30, 
+0xB5, 0x43, 0xCD, 0xC3, 0x39, 0x59, 0x1B, 0xB0, 
+0xC2, 0x65, 0xCD, 0x86, 0x13, 0xB5, 0x29, 0xD5, 
+0xF7, 0xC5, 0x94, 0x2C, 0x27, 0x9C, 0x1C, 0x1B, 
+0x47, 0x8D, 0x7F, 0x11, 0x2D, 0x48, 0x4A, 0x50, 
+0x5B, 0x55, 0xE2, 0x4E, 0x69, 0xDB, 0x2C, 0x72, 
+0xE2, 0x46, 0xFA, 0x00, 0x08, 0x36, 0x7C, 0x34, 
+0x28, 0x02, 0x43, 0x78, 0x36, 0x73, 0x0C, 0x01, 
+0x1D, 0x4A, 0xA8, 0x4C, 0xDF, 
+
+0x4A, 0xFE, 0x5C, 0xB4, 0xB2, 0x1C, 0xE9, 0x4B, 
+0x64, 0xA9, 0xEA, 0xDE, 0x9E, 0xFD, 0x52, 0x4C, 
+0xEE, 0x01, 0xCE, 0x11, 0xA0, 0x54, 0x89, 0x88, 
+0xDE, 0x0C, 0x43, 0x32, 0x12, 0xCB, 0x2C, 0x9B, 
+0xCE, 0xEB, 0xD3, 0xBF, 0x3D, 0xDF, 0x63, 0x0F, 
+0x90, 0xBA, 0xD9, 0x0A, 0xC5, 0xB1, 0x0E, 0xE2, 
+0x90, 0x00, 0x90, 0xA5, 0x3B, 0xD1, 0x96, 0xED, 
+0x76, 0x92, 0x6D, 0x10, 0xF3, 0xB9, 0xC8, 0xBC, 
+0xBB, 0x40, 0x80, 0x25, 0x58, 0xFC, 0xB2, 0x23, 
+0x74, 0x9A, 0x02, 0x13, 0x4C, 0x1A, 0x13, 0x9E, 
+0x5D, 0x1D, 0x1B, 0xB7, 0x97, 0x37, 0xAD, 0x3B, 
+0xA7, 0x92, 0x48, 0x47, 0x62, 0x2E, 0xA8, 0x28, 
+0x36, 0x3E, 0xB5, 0x71, 0xA2, 0x5D, 0xFC, 0xB4, 
+0x0D, 0xBF, 0x28, 0x7D, 0x1A, 0x8C, 0x99, 0x78, 
+0xF3, 0x46, 0x3C, 0x61, 0xAB, 0xC1, 
+
+0xBC, 0xD6, 0x19, 0xA0, 0x63, 0x64, 0x51, 0xE7, 
+0x87, 0x4E, 0x2C, 0x0F, 0xE0, 0x84, 0xA8, 0x18, 
+0x89, 0xD9, 0x8B, 0x1E, 0x78, 0x06, 0x07, 0xA7, 
+0xA8, 0x42, 0xD5, 0x8E, 0x06, 0x15, 0x8E, 0xC5, 
+0xCE, 0x94, 0x6B, 0x93, 0x78, 0x4C, 0x48, 0xD3, 
+0x92, 0xAA, 0x82, 0x81, 0xF6, 0x0E, 0x10, 0xAC, 
+0x3E, 0x7C, 0x76, 0xC0, 0xA4, 0xB2, 0xDA, 0x57, 
+0xE5, 0xAE, 0xC9, 0xE8, 0x16, 0xBE, 0x1F, 0x12, 
+0x3F, 0x1D, 0x27, 0x12, 0x01, 0x3D, 0xA1, 0xF7, 
+0x27, 0x3C, 0xDB, 0x9D, 0x7B, 0x85, 0xC5, 0x16, 
+0x96, 0x4A, 0x00, 0xBD, 0xBE, 0x98, 0x65, 0xA8, 
+0xAB, 0x61, 0x76, 0x5E, 0xC1, 0x2E, 0x43, 0xE4, 
+0xBD, 0xEE, 0xEE, 0x4F, 0x6E, 0x67, 0x13, 0x4C, 
+0x27, 0x77, 0xE1, 0xBE, 0x75, 0x70, 0x38, 0xB3, 
+0xAC, 0x00, 0x2A, 0x7C, 0x8C, 0x6B, 0x09, 
+
+0x32, 0x00, 0x61, 0x4A, 0xF8, 0xA8, 0x67, 0xA1, 
+0x68, 0x8D, 0x41, 0x28, 0x26, 0x76, 0xA8, 0x95, 
+0xD0, 0xF5, 0x71, 0xC6, 0x91, 0x59, 0x46, 0x98, 
+0xFB, 0xB9, 0x23, 0xBB, 0x8B, 0x57, 0xAB, 0x4E, 
+0x53, 0x37, 0x8C, 0x82, 0x52, 0xB8, 0x2B, 0x74, 
+0x3B, 0xDE, 0xB6, 0xED, 0xF1, 0xC9, 0x6A, 0x77, 
+0xEE, 0xD6, 0x28, 0x0E, 0xA0, 0xA9, 0xB5, 0xE4, 
+0xB8, 0x75, 0x98, 0xC9, 0xA5, 0x04, 0xB5, 0x45, 
+0x53, 0x39, 0x9C, 0x8C, 0x5E, 0x95, 0xC4, 0x4C, 
+0xE1, 0x92, 0xEC, 0xB5, 0x22, 0xF0, 0x39, 0xB2, 
+0x41, 0xA2, 0xE6, 0x0F, 0x13, 0x28, 0xE8, 0x3D, 
+0x49, 0xB8, 0xD0, 0xCB, 0xBB, 0x1C, 0x88, 0x3F, 
+0x03, 0x48, 0xAB, 0xE8, 0x70, 0xAB, 0x3F, 0xEB, 
+0x77, 0x1F, 0x14, 0xD8, 0xC0, 0xAF, 0x69, 0xF2, 
+0x05, 0xAD, 0x08, 0x75, 0xA8, 0xA2, 0x0D, 0x7A, 
+
+0x99, 0x30, 0xBB, 0xF0, 0xBB, 0x99, 0x96, 0xF1, 
+0x66, 0x94, 0x2A, 0x59, 0x1E, 0x51, 0xA4, 0xDD, 
+0x05, 0xB0, 0x44, 0x58, 0xD2, 0x4E, 0x90, 0x0B, 
+0x0A, 0x28, 0xBE, 0x4C, 0x1E, 0xD3, 0x89, 0x2B, 
+0xEF, 0x23, 0xE9, 0x21, 0x17, 0x2F, 0xA5, 0xE3, 
+0xEC, 0xC0, 0x44, 0x9D, 0x4B, 0x77, 0xF8, 0xA6, 
+0x60, 0x19, 0x00, 0xDB, 0x47, 0x67, 0x65, 0x43, 
+0x9A, 0x89, 0xA8, 0x1F, 0xC6, 0x65, 0xCA, 0x57, 
+0xFF, 0x0F, 0xD4, 0x08, 0x95, 0x77, 0x92, 0x2F, 
+0xE5, 0x39, 0x5D, 0x21, 0xEE, 0x54, 0xE6, 0x7F, 
+0x35, 0x8C, 0xA6, 0x6C, 0xD2, 0xCC, 0x38, 0xF4, 
+0x13, 0x2E, 0x37, 0x42, 0x54, 0x1A, 0xF1, 0x55, 
+0x0A, 0x8A, 0x36, 0xA1, 0x86, 0x8E, 0x77, 0x74, 
+0x1B, 0x8E, 0xF7, 0xE9, 0x90, 0x3E, 0x15, 0x81, 
+0x75, 0xBE, 0xB2, 0x91, 0x75, 0x8B, 0x4E, 0x1D, 
+0x4F, 
+
+0x9C, 0xEB, 0x24, 0x43, 0x20, 0x8D, 0x4B, 0x67, 
+0xD9, 0x1E, 0x9A, 0xA3, 0xD3, 0x37, 0x08, 0xC1, 
+0x57, 0xE3, 0xEF, 0x14, 0xE2, 0xD1, 0x4C, 0x04, 
+0x46, 0x6B, 0x06, 0x86, 0x85, 0xB0, 0x1F, 0xE4, 
+0xBC, 0x40, 0x28, 0x55, 0x61, 0xFD, 0x68, 0x33, 
+0x38, 0x6F, 0x20, 0x54, 0xBA, 0xD1, 0x98, 0xAB, 
+0xD1, 0x09, 0xC7, 0xD5, 0x88, 0x86, 0x82, 0x81, 
+0x0C, 0x36, 0x56, 0x62, 0xC7, 0xD4, 0x48, 0xA5, 
+0x0D, 0xE9, 0xCD, 0x34, 0xB0, 0x46, 0xC4, 0xD9, 
+0x08, 0x28, 0xF9, 0x9B, 0xBF, 0xFE, 0xE3, 0xBD, 
+0x66, 0x3D, 0xF2, 0x88, 0xAB, 0xE0, 0xB8, 0x3F, 
+0x58, 0x9A, 0x9D, 0x28, 0xA1, 0x56, 0x1E, 0x63, 
+0x92, 0x36, 0x88, 0xE7, 0xE6, 0x58, 0xD9, 0x57, 
+0x88, 0x03, 0xAE, 0x7D, 0x6D, 0x11, 0xA6, 0xCB, 
+0x4F, 0xFD, 0x69, 0x64, 0xC1, 0xCA, 0x30, 0x6D, 
+0x4C, 0x22, 
+
+0xF2, 0x62, 0xB3, 0xE1, 0xF5, 0x42, 0xCF, 0xCF, 
+0x3D, 0x3B, 0xA2, 0xD0, 0x65, 0xBE, 0xB0, 0xEB, 
+0x9E, 0x16, 0x09, 0x55, 0x44, 0x0C, 0xE0, 0x78, 
+0xC8, 0xE3, 0x77, 0xEF, 0xA6, 0x15, 0x0A, 0xCA, 
+0xA6, 0xA0, 0x8F, 0x1D, 0x27, 0xDB, 0x73, 0x0E, 
+0x0E, 0x4A, 0xFD, 0xA8, 0x91, 0x40, 0xB2, 0xA5, 
+0x65, 0xFC, 0x66, 0xEE, 0x67, 0x7A, 0xA0, 0x24, 
+0x43, 0xBE, 0xE4, 0x1C, 0x5B, 0x13, 0xAE, 0xFF, 
+0x4C, 0xBF, 0x43, 0xCC, 0xD1, 0x5B, 0x05, 0x0E, 
+0x7B, 0xCF, 0x01, 0x75, 0xE8, 0x14, 0x81, 0x41, 
+0x7E, 0x88, 0x83, 0x73, 0x65, 0xE1, 0x6C, 0xA0, 
+0xA8, 0xBD, 0xE2, 0xE4, 0x59, 0xA1, 0x7F, 0x13, 
+0x0F, 0x72, 0x85, 0x3D, 0x07, 0x31, 0xE1, 0xC9, 
+0x26, 0x19, 0x6F, 0x46, 0x1F, 0x49, 0xB3, 0x76, 
+0xA8, 0x19, 0xD2, 0x07, 0xDD, 0xD0, 0x95, 0xA2, 
+0x81, 0xB1, 0xFE, 
+
+0x41, 0xFB, 0xCE, 0x5C, 0xBA, 0xB8, 0x30, 0x69, 
+0x5C, 0x9B, 0x46, 0xAB, 0x37, 0xC0, 0xE2, 0x70, 
+0x6C, 0x48, 0x18, 0x70, 0x97, 0x9F, 0xBB, 0x34, 
+0x50, 0x78, 0x66, 0x27, 0x6B, 0x37, 0xDD, 0x7C, 
+0x5D, 0xC7, 0xA8, 0xB5, 0xEE, 0x4F, 0x90, 0xAB, 
+0x3E, 0x34, 0x61, 0x34, 0x61, 0x40, 0x30, 0x0E, 
+0x2B, 0x51, 0xF1, 0xD9, 0x77, 0x2E, 0xF4, 0x27, 
+0x97, 0x1D, 0xF3, 0x1A, 0x89, 0x39, 0xAE, 0xC7, 
+0xCB, 0xE0, 0x6F, 0xA2, 0x89, 0xAE, 0x4E, 0x6C, 
+0x4D, 0x0C, 0xB5, 0x4B, 0xBB, 0xE5, 0xE0, 0xB0, 
+0xFA, 0x14, 0x4B, 0x16, 0xE0, 0x06, 0x87, 0x77, 
+0xC5, 0xC2, 0xFA, 0x57, 0xF0, 0x69, 0xE6, 0xC5, 
+0x51, 0x5D, 0x60, 0x3C, 0x44, 0x66, 0x7A, 0x9F, 
+0x6D, 0xC3, 0x2E, 0x8B, 0xE5, 0xCE, 0xB7, 0xF7, 
+0x0F, 0x97, 0xAB, 0xE5, 0x24, 0x45, 0x46, 0x66, 
+0xC9, 0x5E, 0xE2, 0x08, 
+
+0x40, 0x52, 0xA6, 0xA5, 0xFB, 0xF7, 0x1F, 0xFB, 
+0xD4, 0xC3, 0x92, 0x54, 0x89, 0x16, 0xA2, 0x8B, 
+0x13, 0xF9, 0x0A, 0xBF, 0x20, 0x6A, 0xF7, 0x7B, 
+0xFE, 0x93, 0x69, 0x9E, 0x95, 0x39, 0x50, 0xC1, 
+0x4C, 0xFB, 0x98, 0xAE, 0x48, 0x21, 0xA7, 0xA2, 
+0x8F, 0xBA, 0xB7, 0x35, 0xAE, 0x7F, 0x09, 0x7E, 
+0x40, 0x54, 0xDE, 0x41, 0xE5, 0xDB, 0x36, 0x0B, 
+0xBB, 0xA2, 0x0E, 0x60, 0x4E, 0xAD, 0x35, 0x82, 
+0xBD, 0xD1, 0xDB, 0xA3, 0x84, 0x7D, 0x72, 0xED, 
+0xA1, 0x06, 0x63, 0x66, 0x32, 0xF6, 0x90, 0x56, 
+0x6D, 0x0E, 0xE9, 0x76, 0xD7, 0x54, 0xEB, 0x80, 
+0x20, 0xC8, 0x3E, 0xBB, 0xAF, 0x24, 0x56, 0xAE, 
+0xBC, 0x93, 0xDD, 0x4D, 0xA1, 0x2B, 0xBA, 0x6F, 
+0x33, 0x44, 0xF1, 0x8A, 0xDA, 0x1D, 0x18, 0xF5, 
+0x7F, 0xB6, 0xB3, 0x27, 0x98, 0x22, 0xAC, 0x50, 
+0xF9, 0x90, 0x6D, 0xB8, 0x91, 
+
+0x67, 0xBE, 0x76, 0x28, 0x18, 0xF9, 0x89, 0x63, 
+0x1C, 0x85, 0xF9, 0x5F, 0x10, 0xFF, 0x4D, 0xF3, 
+0xCE, 0x0E, 0x4A, 0xC1, 0x31, 0x15, 0x92, 0x72, 
+0x12, 0x05, 0xFE, 0xD2, 0x5C, 0x42, 0x9B, 0x94, 
+0x9B, 0xE9, 0xE4, 0x3C, 0x97, 0xC9, 0x18, 0xF6, 
+0x2A, 0xE7, 0x81, 0x2C, 0xED, 0x55, 0xF7, 0x35, 
+0xFF, 0xD1, 0x3D, 0x98, 0xED, 0x57, 0x2D, 0x46, 
+0x5A, 0xA1, 0xAD, 0xFF, 0x4F, 0xBC, 0x80, 0x95, 
+0x36, 0x3A, 0x87, 0x15, 0xD0, 0x82, 0xDB, 0x12, 
+0xFC, 0x36, 0x72, 0xA6, 0x33, 0xFB, 0x07, 0x9C, 
+0xC6, 0x84, 0x04, 0xB8, 0x71, 0x83, 0xD0, 0x9C, 
+0xAC, 0x1B, 0xB0, 0x27, 0xA9, 0x7D, 0x3D, 0xA3, 
+0x37, 0x2E, 0x44, 0x1A, 0x7B, 0x2B, 0xF9, 0x4C, 
+0xED, 0x35, 0xAD, 0x7F, 0xC6, 0x0C, 0xAA, 0xCA, 
+0xEC, 0xA3, 0x90, 0x7A, 0x26, 0xCD, 0xBF, 0x78, 
+0x46, 0x57, 0x27, 0x15, 0x06, 0x6F, 
+
+0xB8, 0x63, 0x4E, 0xA7, 0xFD, 0x80, 0x9C, 0x1A, 
+0x18, 0xD5, 0x99, 0x33, 0xFE, 0x67, 0x41, 0x42, 
+0xE1, 0xD6, 0x3C, 0x9A, 0x3E, 0x9A, 0xCE, 0x47, 
+0xFF, 0xEA, 0xF2, 0xC6, 0xA9, 0x9F, 0xF3, 0x82, 
+0x22, 0xAB, 0xB8, 0xE5, 0x42, 0x37, 0xFB, 0xAC, 
+0xF6, 0x17, 0x5C, 0x08, 0x69, 0x89, 0x61, 0xC7, 
+0x8B, 0x8E, 0x2E, 0xDA, 0x5B, 0x82, 0xFF, 0x83, 
+0xFF, 0x1D, 0x53, 0x67, 0xDF, 0x79, 0x52, 0x06, 
+0xFF, 0xC7, 0x98, 0xBA, 0x9D, 0x6F, 0x73, 0x53, 
+0x90, 0xF9, 0x40, 0x01, 0x6B, 0xC6, 0x3D, 0x5D, 
+0xBC, 0x40, 0x35, 0x91, 0x59, 0xDA, 0xDD, 0xC7, 
+0x61, 0x4E, 0x11, 0x0C, 0x3A, 0x7A, 0xB7, 0x82, 
+0x38, 0x33, 0xA7, 0x79, 0x78, 0x44, 0x47, 0x7D, 
+0xB6, 0xF8, 0x71, 0x96, 0x94, 0xBC, 0x8C, 0xAF, 
+0x81, 0x4E, 0x23, 0xDF, 0x56, 0x75, 0xAF, 0x92, 
+0x47, 0x2F, 0xF2, 0xF1, 0xE9, 0x9B, 0xA0, 
+
+0x20, 0x0D, 0x87, 0x55, 0x4D, 0xA7, 0xD0, 0x0A, 
+0x17, 0x5D, 0xEC, 0x78, 0x1F, 0x22, 0x99, 0x87, 
+0x27, 0xD5, 0xA5, 0xD1, 0xE1, 0x67, 0x13, 0xB2, 
+0x68, 0x84, 0x86, 0x38, 0xBB, 0x2C, 0x2C, 0x57, 
+0xB3, 0x55, 0x2E, 0x86, 0xE2, 0x27, 0xAE, 0xF4, 
+0xAF, 0xF5, 0x42, 0x71, 0xE4, 0xC1, 0xC5, 0xAC, 
+0x64, 0x64, 0xEE, 0xA3, 0x6D, 0x88, 0xAF, 0xD2, 
+0x6A, 0x8B, 0xC6, 0x19, 0xB6, 0x7A, 0x28, 0x11, 
+0x96, 0x1C, 0x49, 0xB8, 0x81, 0x29, 0x92, 0x41, 
+0x7D, 0xB1, 0xB0, 0x6B, 0xC6, 0xB3, 0x71, 0x91, 
+0x0A, 0x29, 0xE5, 0x10, 0x1F, 0x88, 0xEB, 0xD4, 
+0x1D, 0x67, 0xB0, 0xB2, 0xF2, 0x20, 0x50, 0xFF, 
+0x20, 0xB4, 0xCC, 0x3B, 0x32, 0xC0, 0xBC, 0x23, 
+0x6C, 0x4C, 0x06, 0xC5, 0x09, 0x1C, 0xE4, 0xC2, 
+0xC9, 0xF6, 0x6B, 0x6D, 0x55, 0xC3, 0x13, 0x76, 
+0x34, 0x92, 0x83, 0x5A, 0xFA, 0xAE, 0x01, 0xB9, 
+
+0x69, 0x97, 0x9D, 0xAC, 0x98, 0x31, 0xD7, 0x1E, 
+0x55, 0x24, 0x9F, 0x96, 0x5D, 0xF8, 0x58, 0x17, 
+0xE1, 0x8D, 0x11, 0x06, 0xA2, 0xA5, 0xC6, 0xED, 
+0x81, 0x4D, 0x91, 0xEE, 0xD5, 0x33, 0x47, 0xCE, 
+0x52, 0xAF, 0x10, 0x4E, 0xEE, 0x69, 0xDB, 0xD5, 
+0xE7, 0x93, 0x28, 0x72, 0xAA, 0x60, 0x56, 0xE4, 
+0x9F, 0x9D, 0x2C, 0xBC, 0xBB, 0xA7, 0x23, 0x11, 
+0x3A, 0x2F, 0x23, 0x4D, 0x75, 0x24, 0x68, 0xF5, 
+0x26, 0x1A, 0x28, 0x05, 0xFD, 0x9F, 0x9A, 0x5B, 
+0x47, 0x74, 0x58, 0x96, 0xAA, 0xD6, 0x7D, 0x65, 
+0xA2, 0x89, 0xE2, 0x24, 0x98, 0x36, 0x1B, 0xB2, 
+0xA7, 0x0D, 0xD9, 0xFE, 0xFE, 0xA2, 0x46, 0xFC, 
+0x33, 0xBC, 0xF4, 0x2E, 0xBF, 0x98, 0x73, 0xEA, 
+0xFE, 0x2F, 0xBF, 0xF0, 0xFF, 0xD9, 0xEE, 0x10, 
+0x04, 0x9D, 0x26, 0x6D, 0xCC, 0xA4, 0x97, 0xCD, 
+0x2A, 0xF9, 0x63, 0x38, 0x15, 0x85, 0x71, 0x91, 
+0x96, 
+
+0xF6, 0x54, 0x0D, 0x68, 0x91, 0x95, 0x59, 0xEE, 
+0xA5, 0x1A, 0x8C, 0x7F, 0x9E, 0x9E, 0xA4, 0x4F, 
+0x37, 0xA3, 0x9F, 0x23, 0x01, 0x9E, 0xED, 0x59, 
+0x34, 0x2E, 0x6D, 0x22, 0x7E, 0x98, 0xBC, 0xE4, 
+0x22, 0x11, 0xA8, 0xF5, 0xF8, 0xB5, 0xC5, 0x84, 
+0x11, 0xCA, 0x2A, 0x18, 0xB7, 0x75, 0x9C, 0xB4, 
+0xC3, 0x29, 0x8B, 0x16, 0x6D, 0x0C, 0x75, 0x34, 
+0x41, 0x47, 0x70, 0x21, 0x87, 0xD8, 0x1A, 0x3C, 
+0xFA, 0xCD, 0xEA, 0xD1, 0xC0, 0x20, 0x26, 0xF0, 
+0xBE, 0x18, 0x51, 0x8F, 0x3E, 0x17, 0xDE, 0x37, 
+0x2C, 0x67, 0xB5, 0x0A, 0x25, 0xDB, 0x93, 0x1F, 
+0x9D, 0x27, 0x1F, 0x37, 0xCC, 0xE3, 0xD6, 0x1D, 
+0xDC, 0xEE, 0xB1, 0x4D, 0x24, 0x75, 0xB8, 0x2E, 
+0x53, 0x25, 0xBF, 0xF8, 0xFD, 0xBB, 0x7C, 0x72, 
+0xD0, 0x0E, 0xB7, 0x50, 0xFB, 0xAF, 0xFF, 0x28, 
+0xBF, 0xD7, 0x93, 0x40, 0x34, 0x40, 0x0C, 0xAB, 
+0x88, 0x8C, 
+
+0x2A, 0x4F, 0x01, 0xAA, 0x2C, 0xF0, 0xAB, 0x86, 
+0x6D, 0x60, 0xF5, 0x05, 0x5A, 0xD7, 0xB8, 0x3A, 
+0x2C, 0x7B, 0xB3, 0x55, 0x91, 0x46, 0x2A, 0xA3, 
+0x17, 0xA8, 0x2E, 0x65, 0xF7, 0x22, 0x1D, 0x44, 
+0xA7, 0x8D, 0x65, 0x65, 0xD3, 0xAE, 0xFA, 0xE8, 
+0x3D, 0x7C, 0x0A, 0x8C, 0xCF, 0xEF, 0x5F, 0x25, 
+0x42, 0x37, 0x3A, 0xCF, 0xD2, 0xC6, 0xEA, 0x30, 
+0xA9, 0x8F, 0x23, 0x29, 0x28, 0x01, 0xE3, 0x1C, 
+0xD2, 0x1F, 0xCA, 0xB2, 0x50, 0x4F, 0xBA, 0x8E, 
+0x83, 0x63, 0x3A, 0x72, 0x58, 0x6C, 0xA5, 0xA5, 
+0x93, 0x1F, 0xE0, 0x9D, 0xC4, 0x53, 0x0D, 0xE8, 
+0xC8, 0x20, 0xE5, 0x8E, 0x49, 0x1F, 0x3B, 0x45, 
+0xFA, 0x46, 0x55, 0x8E, 0x58, 0xBE, 0x43, 0x72, 
+0x2D, 0xA6, 0x24, 0xC1, 0x6A, 0x5F, 0xCE, 0x88, 
+0xE0, 0x40, 0x6D, 0xCD, 0x74, 0x0D, 0x66, 0xF3, 
+0xA0, 0x82, 0x4F, 0x40, 0xD3, 0x7B, 0x3D, 0x50, 
+0xC5, 0x07, 0xD1, 
+
+0x2A, 0x98, 0xF1, 0x6B, 0xF8, 0xDF, 0xE8, 0x5C, 
+0x7B, 0xD8, 0xE5, 0x50, 0x27, 0x35, 0x02, 0xC8, 
+0xA6, 0x8D, 0xCE, 0x68, 0xFD, 0x06, 0x0C, 0x10, 
+0xF3, 0x34, 0x9F, 0x3E, 0xA4, 0xA1, 0x4A, 0x0E, 
+0xA7, 0x9B, 0xE6, 0x17, 0xBB, 0xBE, 0xD5, 0x37, 
+0x81, 0xA0, 0xC3, 0xE0, 0xED, 0x11, 0xE8, 0x2C, 
+0xC7, 0xDD, 0x90, 0xAA, 0x7E, 0xC9, 0x71, 0x36, 
+0x90, 0xFF, 0x79, 0xFB, 0x8D, 0xC8, 0xFF, 0x5C, 
+0x9A, 0x64, 0xD2, 0x71, 0xBB, 0xA8, 0x6C, 0x9E, 
+0x93, 0xFF, 0x77, 0x49, 0x08, 0x2E, 0x3B, 0x5A, 
+0x91, 0x92, 0xBE, 0xAE, 0xF5, 0x2B, 0xC1, 0x45, 
+0xF6, 0xFD, 0x81, 0x9D, 0x61, 0xED, 0x05, 0x1F, 
+0xFE, 0xBF, 0xC3, 0xB1, 0xE5, 0xD9, 0xE3, 0x6C, 
+0x38, 0xA6, 0x8F, 0xBE, 0x6E, 0x85, 0x1C, 0x32, 
+0x26, 0xBD, 0x8F, 0xAD, 0xDF, 0xAF, 0xAD, 0x38, 
+0xA9, 0x22, 0x8A, 0x84, 0xA2, 0x64, 0xA5, 0xB3, 
+0x66, 0x9C, 0x25, 0x4F, 
+
+0x2D, 0x09, 0xE4, 0xB7, 0xAD, 0x96, 0xCB, 0x90, 
+0x6A, 0x56, 0xCD, 0xEF, 0x1A, 0x04, 0xEB, 0xB4, 
+0x9C, 0x34, 0x96, 0xF5, 0x5D, 0xFF, 0xDD, 0x8A, 
+0xC7, 0xFB, 0x21, 0x2F, 0x4E, 0x2F, 0x9D, 0xB4, 
+0x7F, 0x4C, 0x77, 0x4F, 0x14, 0xA7, 0x79, 0x51, 
+0x18, 0x55, 0xDD, 0xD1, 0x72, 0xDC, 0x2F, 0xA7, 
+0x63, 0xA1, 0xF6, 0xE4, 0x98, 0xDE, 0xCD, 0xA5, 
+0x88, 0xE8, 0x67, 0xCF, 0x10, 0x78, 0xF5, 0x8B, 
+0x68, 0x78, 0x1D, 0x7E, 0x1A, 0xF3, 0x7C, 0x18, 
+0x36, 0xFD, 0xA0, 0xDE, 0x17, 0x56, 0xEC, 0xB1, 
+0xB4, 0xF0, 0xB7, 0x73, 0x90, 0x14, 0xF2, 0xAF, 
+0x2D, 0xBE, 0xE1, 0xC3, 0xC2, 0x21, 0xDE, 0x4F, 
+0x35, 0x34, 0xC8, 0x5C, 0x56, 0x9D, 0x5E, 0x29, 
+0x41, 0x6F, 0x58, 0x50, 0x15, 0x38, 0x0A, 0x1B, 
+0x50, 0xE8, 0x7D, 0x2F, 0x9F, 0x45, 0xC7, 0x46, 
+0x39, 0x4F, 0xDF, 0x02, 0x34, 0x0B, 0x45, 0x93, 
+0x02, 0x2E, 0xEC, 0x14, 0xEF, 
+
+0x59, 0xFB, 0x16, 0x58, 0x0F, 0xAF, 0xD0, 0xAD, 
+0x05, 0x07, 0x9B, 0xBA, 0x45, 0x87, 0x3E, 0x40, 
+0x6D, 0xAB, 0x90, 0xE8, 0xA2, 0xE5, 0xBD, 0xA5, 
+0x28, 0x8F, 0xAC, 0xE6, 0xE9, 0xC8, 0x46, 0xA6, 
+0x84, 0x26, 0x0B, 0x16, 0x65, 0x47, 0x1A, 0x72, 
+0x52, 0x4C, 0xD3, 0x8A, 0xB1, 0x1F, 0x26, 0x81, 
+0x8F, 0x23, 0x17, 0x35, 0x4C, 0xCC, 0x72, 0xA3, 
+0x54, 0x80, 0x09, 0x51, 0x73, 0x3C, 0x49, 0x0F, 
+0x29, 0x86, 0x23, 0x5F, 0x6C, 0xA5, 0x7A, 0xF7, 
+0x23, 0x2A, 0x0F, 0x1F, 0x64, 0xA7, 0xBD, 0x36, 
+0xFB, 0xE6, 0xB7, 0x38, 0xEB, 0x64, 0x2A, 0x45, 
+0xDD, 0xE7, 0x7C, 0xDC, 0xE7, 0x3F, 0xBD, 0x53, 
+0xF5, 0xF5, 0x27, 0xAB, 0x89, 0xDF, 0x50, 0x24, 
+0x51, 0xBA, 0xD8, 0xAC, 0x6D, 0xDD, 0xAC, 0xB3, 
+0x89, 0x94, 0xE6, 0x26, 0x94, 0x23, 0xFF, 0xF3, 
+0x2A, 0x11, 0x98, 0xD6, 0x62, 0x11, 0x93, 0x0F, 
+0x3F, 0xFE, 0x76, 0x94, 0x8D, 0xEA, 
+
+0x4D, 0x91, 0x8E, 0xB7, 0x54, 0x12, 0xBC, 0x7C, 
+0x5C, 0xFF, 0xA6, 0x06, 0x37, 0xE1, 0x37, 0x05, 
+0x0F, 0xD1, 0x7B, 0xC2, 0x13, 0x3A, 0x59, 0xB3, 
+0xC4, 0x5C, 0x72, 0x63, 0x47, 0x0D, 0xC5, 0x38, 
+0x8E, 0xF5, 0x82, 0x2D, 0xBE, 0x74, 0x5E, 0xDF, 
+0xAD, 0xA5, 0xB0, 0xC9, 0x42, 0x25, 0xE5, 0x7C, 
+0xA1, 0x9C, 0xCD, 0x49, 0x85, 0xEF, 0x9B, 0xF8, 
+0x65, 0x8E, 0xEF, 0x9D, 0x5F, 0xE2, 0x2E, 0xC0, 
+0x57, 0x10, 0x35, 0x0C, 0xFC, 0x35, 0x43, 0x8E, 
+0x6A, 0xCE, 0x77, 0x8D, 0xF8, 0x0F, 0xC7, 0xD2, 
+0xE0, 0x8E, 0x4E, 0xFE, 0xBF, 0x27, 0xFD, 0x5A, 
+0x7E, 0x3F, 0xB9, 0x36, 0x3A, 0x31, 0x0F, 0x47, 
+0x96, 0x58, 0x63, 0x88, 0x55, 0x14, 0xEC, 0x66, 
+0xF8, 0x98, 0x03, 0xBE, 0x11, 0x97, 0x83, 0xB1, 
+0x85, 0xE3, 0x02, 0xF1, 0x7E, 0xA4, 0x45, 0xFC, 
+0x59, 0x71, 0xB4, 0x99, 0xB8, 0x47, 0x7D, 0x03, 
+0xAB, 0xB5, 0x65, 0x54, 0x70, 0xDE, 0x1D, 
+
+0xA6, 0xD2, 0xCB, 0x79, 0x09, 0x9C, 0xCE, 0xC9, 
+0xAA, 0x2E, 0x7A, 0x66, 0x79, 0xAE, 0xDD, 0x38, 
+0xAB, 0x8C, 0x7E, 0xAE, 0x8F, 0x3C, 0x68, 0xA7, 
+0x59, 0x7A, 0x2C, 0xEA, 0x85, 0x19, 0x58, 0x1B, 
+0x5F, 0xD3, 0xE7, 0x33, 0x59, 0xB0, 0x2D, 0x60, 
+0x22, 0x85, 0x08, 0xDF, 0x0B, 0xCB, 0x22, 0x90, 
+0x48, 0x09, 0xA5, 0x2D, 0x15, 0x5C, 0xA7, 0x8B, 
+0xBA, 0x6F, 0x49, 0xBE, 0x7D, 0xA1, 0xCB, 0x0B, 
+0xA4, 0x46, 0xA7, 0xE5, 0x63, 0xEF, 0xB2, 0x32, 
+0x6C, 0x14, 0x5F, 0x51, 0xA4, 0xC4, 0x85, 0x31, 
+0xCB, 0xB1, 0x87, 0xE1, 0x6E, 0xB4, 0xEB, 0x51, 
+0xEA, 0x1B, 0x63, 0x4A, 0x00, 0x3F, 0x2A, 0xFE, 
+0x1E, 0xCE, 0x59, 0xB5, 0x79, 0xFD, 0x92, 0xD8, 
+0xF2, 0x7D, 0x20, 0x55, 0x28, 0xA0, 0x91, 0x6E, 
+0x4A, 0xC9, 0x84, 0x6E, 0x7F, 0x97, 0xEA, 0x42, 
+0x36, 0xDC, 0x13, 0xB6, 0xD3, 0x2F, 0x11, 0x4B, 
+0x02, 0x5F, 0xDD, 0x4D, 0xE7, 0xDD, 0xE6, 0x91, 
+
+0x68, 0x81, 0xD8, 0xB6, 0x62, 0xE6, 0x17, 0x8A, 
+0x22, 0xE8, 0x15, 0xD5, 0xA6, 0x2E, 0xAE, 0x08, 
+0xA6, 0xB9, 0xE1, 0x20, 0x1C, 0x80, 0xDF, 0x54, 
+0xE2, 0xAE, 0x17, 0x61, 0x4A, 0x54, 0xD2, 0xF8, 
+0x30, 0x15, 0xE4, 0xF5, 0x5F, 0x7A, 0x18, 0xCF, 
+0x14, 0x59, 0xED, 0xBA, 0xEF, 0x88, 0x75, 0x87, 
+0xE9, 0x6C, 0xB8, 0x56, 0x48, 0x51, 0x54, 0x18, 
+0x31, 0x1F, 0xFD, 0x22, 0x70, 0xD6, 0xAE, 0x59, 
+0x72, 0x78, 0xF8, 0xE1, 0xA1, 0xC6, 0xD7, 0x7B, 
+0xE6, 0x2B, 0xE3, 0x05, 0xC8, 0xCF, 0x0D, 0xB2, 
+0xC8, 0x62, 0x27, 0xD6, 0x32, 0xB0, 0xDF, 0x10, 
+0x5E, 0x62, 0x97, 0x2D, 0xE3, 0x79, 0x22, 0x51, 
+0x6D, 0x9A, 0x57, 0x36, 0xBB, 0x63, 0x13, 0x1F, 
+0x2C, 0xE1, 0xC0, 0x73, 0xA7, 0xFE, 0x64, 0xBC, 
+0xF6, 0x2F, 0x4D, 0x34, 0x23, 0xDC, 0x0C, 0xF5, 
+0x02, 0x54, 0xDE, 0x26, 0x62, 0x09, 0xF2, 0xAE, 
+0xF4, 0xB6, 0x83, 0x46, 0x97, 0xA4, 0x3D, 0xF1, 
+0x33, 
+
+0x23, 0xB6, 0xBC, 0x5C, 0x5F, 0x68, 0x3C, 0xE9, 
+0x46, 0xF5, 0xD7, 0xD5, 0x1F, 0x84, 0x41, 0x06, 
+0x66, 0x72, 0xD0, 0x8A, 0xCF, 0xD8, 0x0A, 0xAF, 
+0x3E, 0x4B, 0x19, 0x09, 0x99, 0x8D, 0x5B, 0x63, 
+0xE1, 0x01, 0xC0, 0xDC, 0x60, 0x18, 0xE3, 0x32, 
+0x58, 0xA9, 0xE9, 0x88, 0x0F, 0x7D, 0x4A, 0xE1, 
+0x15, 0xC8, 0x94, 0x7C, 0x9D, 0x21, 0x13, 0x61, 
+0xC9, 0xD9, 0x6A, 0x4B, 0x2B, 0x55, 0x22, 0x09, 
+0x2A, 0x6A, 0xC3, 0xEC, 0x94, 0x5F, 0x5D, 0x1F, 
+0x4C, 0x47, 0x51, 0xED, 0x16, 0x78, 0x8C, 0x97, 
+0xEC, 0x50, 0xC8, 0x70, 0x81, 0x5E, 0x9D, 0x4D, 
+0x26, 0x63, 0x79, 0xCA, 0xC1, 0x0C, 0x3E, 0xFD, 
+0xE4, 0x9B, 0x85, 0x12, 0x3D, 0xAC, 0xFF, 0x7E, 
+0x42, 0x0B, 0x31, 0x9B, 0xF3, 0x26, 0x44, 0x0B, 
+0x2E, 0x3D, 0x13, 0xA3, 0x4E, 0x29, 0xBA, 0x28, 
+0xCC, 0x65, 0x32, 0x40, 0xAA, 0x61, 0x57, 0x37, 
+0x89, 0x05, 0x1F, 0x11, 0x72, 0x7B, 0x46, 0x5F, 
+0x20, 0xDE, 
+
+0x01, 0xFB, 0x28, 0x2D, 0xF1, 0x08, 0x02, 0xD4, 
+0x2C, 0x59, 0xE8, 0x54, 0x97, 0x45, 0xD3, 0xFC, 
+0xDC, 0x78, 0xA2, 0x86, 0x17, 0x0B, 0x33, 0x2A, 
+0x12, 0x86, 0x07, 0xCF, 0x00, 0xBC, 0x0B, 0xCE, 
+0x6A, 0x04, 0x70, 0xF9, 0xC7, 0xC3, 0x02, 0xC3, 
+0x42, 0xCE, 0x1E, 0xAD, 0x94, 0x80, 0x14, 0xB7, 
+0xFB, 0xDC, 0x42, 0x7B, 0xDE, 0x5D, 0x67, 0x4D, 
+0xD8, 0xFD, 0x65, 0x55, 0x02, 0xDA, 0x65, 0x96, 
+0x84, 0xB0, 0x1F, 0x1D, 0x05, 0x9D, 0x0D, 0xD1, 
+0x15, 0x30, 0xCF, 0x77, 0x5B, 0xFD, 0xDC, 0xAB, 
+0x48, 0xFF, 0xBB, 0xE7, 0xB8, 0xD2, 0x33, 0x2E, 
+0xA2, 0x29, 0x2C, 0x14, 0x0D, 0x33, 0x44, 0xC4, 
+0xF0, 0xF5, 0x21, 0xA6, 0x10, 0x44, 0xB0, 0x07, 
+0xB0, 0xBD, 0xE8, 0x88, 0xAB, 0xD1, 0xC1, 0x1F, 
+0x88, 0x42, 0x6F, 0x05, 0x6F, 0x3B, 0xF9, 0x0D, 
+0xCC, 0x14, 0x1E, 0x86, 0x19, 0x2B, 0xE8, 0xB2, 
+0x48, 0x76, 0x10, 0x55, 0x35, 0xBF, 0xCF, 0xAB, 
+0xCC, 0xAE, 0x78, 
+
+0x78, 0xAD, 0x82, 0x7B, 0x78, 0xC7, 0x66, 0x2A, 
+0xBC, 0x7A, 0xD6, 0xD0, 0x82, 0xE6, 0xF0, 0xC4, 
+0xC2, 0xF0, 0x1F, 0x1D, 0xE1, 0xE7, 0x9C, 0xCD, 
+0xAE, 0x32, 0x90, 0xF6, 0x5D, 0xE0, 0xDD, 0x7B, 
+0x2E, 0x44, 0x64, 0xDE, 0x65, 0xFC, 0x55, 0xB7, 
+0xC3, 0x4E, 0xB7, 0x86, 0x6A, 0x92, 0x34, 0x2D, 
+0x4A, 0xBF, 0x40, 0x51, 0x5F, 0x1E, 0xA6, 0x37, 
+0x47, 0x80, 0x29, 0x2A, 0x86, 0x81, 0x2D, 0xF1, 
+0x29, 0xE0, 0xA0, 0x5E, 0xC6, 0x41, 0xA5, 0xC6, 
+0xC2, 0x4E, 0x78, 0x3E, 0x89, 0x42, 0x08, 0x3B, 
+0x86, 0x2C, 0xD9, 0x8D, 0xAB, 0x18, 0xDB, 0x44, 
+0xED, 0x64, 0xAD, 0x90, 0x2A, 0x6E, 0x37, 0x17, 
+0xD2, 0xEB, 0x51, 0xA4, 0x7D, 0xE5, 0xAA, 0x12, 
+0xCD, 0x24, 0x10, 0xB8, 0xA2, 0x50, 0x90, 0x71, 
+0xCB, 0xD1, 0xA0, 0x45, 0xAA, 0xBD, 0x21, 0xC7, 
+0xD6, 0x95, 0xDA, 0x24, 0x38, 0x89, 0x70, 0x98, 
+0x74, 0x6E, 0x61, 0xF5, 0x92, 0xD4, 0xFA, 0x7D, 
+0xB5, 0x37, 0xE1, 0x56, 
+
+0x1E, 0x72, 0x01, 0x81, 0x4F, 0x98, 0xEC, 0xB2, 
+0xE1, 0x12, 0xD8, 0x51, 0xB2, 0x10, 0xBD, 0xE9, 
+0x0A, 0x2C, 0x80, 0x02, 0x63, 0xDD, 0x0C, 0x64, 
+0xC0, 0xB3, 0x25, 0x99, 0xF3, 0xEC, 0x79, 0x53, 
+0xB0, 0xC8, 0xC2, 0x6E, 0x19, 0x23, 0x3B, 0x85, 
+0x5A, 0x2B, 0x32, 0x26, 0x3C, 0xAE, 0xD5, 0x62, 
+0x62, 0xF2, 0x98, 0x95, 0xFB, 0x77, 0x21, 0x1C, 
+0xC5, 0xFF, 0x1E, 0xB6, 0x2F, 0x3B, 0x0E, 0x8E, 
+0x26, 0xD5, 0x4E, 0x5F, 0x7D, 0x4A, 0xCD, 0xE4, 
+0x1E, 0x32, 0x20, 0x24, 0x3A, 0xDB, 0x71, 0xF4, 
+0xC9, 0x34, 0x2C, 0x1A, 0x3E, 0xBF, 0xDD, 0x6E, 
+0x1B, 0x7E, 0x78, 0x7C, 0xF8, 0xD7, 0xB7, 0x7F, 
+0x65, 0x49, 0x0B, 0xDF, 0x5E, 0x73, 0x6A, 0x2B, 
+0xB4, 0xC6, 0x30, 0x88, 0x1C, 0x52, 0x5D, 0xB2, 
+0xBD, 0x83, 0x4F, 0xA7, 0x57, 0x5D, 0x3E, 0x9D, 
+0xDF, 0x5D, 0x1B, 0x1D, 0x14, 0xB8, 0xFC, 0x0E, 
+0xEA, 0x32, 0x8C, 0x3C, 0xF4, 0x58, 0x9C, 0x23, 
+0x22, 0xC5, 0xDD, 0x09, 0x3F, 
+
+0x14, 0x36, 0x67, 0xAA, 0xA5, 0x96, 0x16, 0x53, 
+0xE8, 0x70, 0x94, 0xFB, 0x54, 0x0F, 0x6F, 0x44, 
+0xAF, 0x4D, 0x57, 0x64, 0x82, 0x9A, 0xDD, 0x60, 
+0xC3, 0x94, 0x8A, 0xD7, 0x0C, 0xEA, 0xC4, 0xFA, 
+0x43, 0x93, 0x95, 0xA0, 0x5C, 0x2D, 0x98, 0x89, 
+0x66, 0xA3, 0x57, 0x4D, 0x22, 0x6B, 0x87, 0x05, 
+0xE3, 0xB6, 0x8C, 0x55, 0xB5, 0x07, 0x8D, 0x68, 
+0x8D, 0x28, 0x07, 0x26, 0x30, 0xC5, 0xC3, 0x45, 
+0x6F, 0x1C, 0x6C, 0xE3, 0xB4, 0x2A, 0x4E, 0xE6, 
+0x51, 0x18, 0x87, 0x77, 0xD0, 0xDE, 0x98, 0xFD, 
+0x27, 0xCF, 0xAD, 0xB6, 0x43, 0xAE, 0x07, 0xFD, 
+0xF8, 0xE8, 0x54, 0x4A, 0xAB, 0xBA, 0x8D, 0x66, 
+0x4E, 0xF4, 0x03, 0x91, 0x44, 0x07, 0x81, 0x78, 
+0x98, 0x1D, 0xE4, 0xF8, 0x59, 0xA9, 0x1C, 0x3D, 
+0xD6, 0x46, 0x37, 0x45, 0x73, 0x26, 0x06, 0xAC, 
+0x05, 0x7D, 0x98, 0x00, 0xD1, 0x04, 0xEF, 0x1F, 
+0x71, 0x67, 0x47, 0xF4, 0x32, 0x11, 0x6B, 0x23, 
+0x8D, 0xDA, 0x88, 0x2A, 0x0C, 0xE2, 
+
+0x44, 0xAF, 0x9C, 0x50, 0xA7, 0xEA, 0x78, 0x5B, 
+0x6F, 0x35, 0xD4, 0xC1, 0x4E, 0xD7, 0x25, 0xF7, 
+0x82, 0xC1, 0x7F, 0x11, 0xC8, 0xEF, 0x60, 0xC6, 
+0x60, 0x37, 0x24, 0x67, 0x5E, 0xE3, 0xC9, 0x87, 
+0x0C, 0x2D, 0x18, 0xB7, 0x25, 0x61, 0xE5, 0x3E, 
+0xB8, 0xFC, 0xF3, 0x05, 0x4F, 0x7D, 0xD8, 0x85, 
+0x9A, 0x22, 0x0C, 0xAA, 0xE7, 0x28, 0x5B, 0xD1, 
+0x0D, 0x8A, 0x46, 0x42, 0x88, 0x90, 0xA7, 0x49, 
+0xF6, 0x5E, 0x42, 0xC4, 0x74, 0xCE, 0xFB, 0xC5, 
+0xDF, 0x10, 0xE4, 0xE1, 0x10, 0xBF, 0xD3, 0x25, 
+0xDB, 0xC4, 0x2F, 0xBA, 0xA7, 0xDB, 0xA0, 0x0B, 
+0x38, 0x6E, 0x15, 0xCE, 0x6F, 0xB8, 0xEB, 0xF6, 
+0xB7, 0xDE, 0x85, 0x4E, 0x41, 0x15, 0x1D, 0xA9, 
+0xE6, 0x5F, 0x8B, 0x81, 0xD7, 0xFD, 0x38, 0xD9, 
+0xE4, 0x92, 0x29, 0x36, 0xB5, 0x3D, 0x35, 0x14, 
+0x04, 0xC8, 0x1B, 0xE5, 0x9E, 0x0F, 0x38, 0xF5, 
+0x0D, 0x85, 0x34, 0x00, 0x6D, 0xA2, 0x9E, 0x84, 
+0xF6, 0x3C, 0xCA, 0xAE, 0x9B, 0xDC, 0x38, 
+
+0x24, 0x65, 0x07, 0x27, 0xB0, 0x37, 0x3D, 0x3C, 
+0x11, 0xDB, 0x7C, 0x47, 0x78, 0x00, 0x1E, 0xEE, 
+0x4E, 0xA7, 0x8A, 0x12, 0x57, 0xD9, 0x16, 0x77, 
+0xA0, 0xCA, 0x4F, 0xD3, 0x5D, 0xC8, 0xDE, 0x95, 
+0xC5, 0x5A, 0xFD, 0xFF, 0x3F, 0xF1, 0xC0, 0xDA, 
+0x09, 0x42, 0xC3, 0x4D, 0x9F, 0xBD, 0x23, 0x91, 
+0xF9, 0x07, 0xD4, 0x7A, 0xB8, 0x44, 0x70, 0xFC, 
+0xA6, 0x9E, 0xB8, 0x7A, 0x89, 0xF0, 0x75, 0x44, 
+0xA8, 0x09, 0x2B, 0x2A, 0x0A, 0xB1, 0x26, 0x60, 
+0x35, 0x11, 0xC6, 0xAB, 0xCB, 0xD1, 0xDB, 0x19, 
+0x25, 0xCF, 0x60, 0x00, 0x9D, 0x84, 0x07, 0xBF, 
+0x2F, 0x58, 0x78, 0x17, 0xF9, 0x16, 0xFD, 0x70, 
+0xFF, 0x5A, 0x70, 0x52, 0xCB, 0xE1, 0x33, 0x73, 
+0x5B, 0xDA, 0x28, 0x6C, 0x2F, 0xF0, 0x17, 0xC2, 
+0x52, 0xB5, 0x73, 0x7D, 0x81, 0xE0, 0x7D, 0x34, 
+0x15, 0x33, 0xF7, 0xCD, 0x6E, 0xF1, 0x0E, 0x9B, 
+0x60, 0xA6, 0x23, 0x40, 0x5A, 0x3D, 0x06, 0x8C, 
+0x6A, 0xFC, 0xAD, 0x4C, 0x7F, 0xC7, 0xF4, 0x94, 
+
+0x8F, 0xEC, 0x57, 0xDF, 0xC7, 0x2D, 0x22, 0x69, 
+0xE4, 0x1F, 0xA0, 0xD6, 0xBD, 0xCF, 0x02, 0x3F, 
+0x31, 0xF2, 0x0E, 0x9E, 0xD5, 0x34, 0x1A, 0xA2, 
+0x9D, 0xD9, 0x4D, 0xD6, 0x45, 0xB6, 0xF2, 0xD9, 
+0x17, 0xAA, 0x26, 0x75, 0x30, 0x06, 0x70, 0x16, 
+0xDC, 0x89, 0x84, 0x3F, 0xB3, 0xD5, 0x2A, 0xDC, 
+0xB5, 0x4F, 0xA9, 0xC5, 0x49, 0xBE, 0x0F, 0xDC, 
+0xA2, 0x91, 0xB9, 0x41, 0x31, 0xBC, 0x56, 0x96, 
+0x74, 0x48, 0xF0, 0xAF, 0xA8, 0x4F, 0x3C, 0x25, 
+0xAF, 0x3E, 0xEF, 0x33, 0x33, 0x18, 0xDF, 0xE3, 
+0x64, 0xA2, 0xCD, 0xA0, 0x29, 0xF8, 0x31, 0x68, 
+0xC4, 0x34, 0x78, 0x1A, 0x3A, 0x4D, 0x83, 0xB8, 
+0x4A, 0xF2, 0xE2, 0x4E, 0xA8, 0x72, 0x78, 0x7C, 
+0xAB, 0x7A, 0xC7, 0xBB, 0x81, 0xCE, 0x30, 0xBD, 
+0x05, 0x8E, 0xB9, 0x6B, 0xC0, 0x1A, 0xFD, 0x22, 
+0x56, 0x4C, 0x12, 0x28, 0x76, 0x28, 0x44, 0xF4, 
+0x37, 0xFE, 0xAC, 0x2D, 0x0D, 0x2E, 0x78, 0x9A, 
+0x8E, 0x93, 0xC8, 0xB9, 0x1E, 0xA3, 0x9C, 0x4A, 
+0x09, 
+
+0xA0, 0x36, 0x27, 0x9A, 0xE3, 0x90, 0x3F, 0x1D, 
+0xAE, 0xD1, 0x4E, 0xB4, 0x5B, 0x6B, 0x2E, 0x02, 
+0x6B, 0x27, 0x22, 0x14, 0x8D, 0x16, 0xFC, 0xA6, 
+0xDC, 0xFA, 0xB9, 0xDD, 0xD5, 0xAA, 0x13, 0x8E, 
+0xD4, 0x23, 0xB4, 0x30, 0x4B, 0x55, 0x0E, 0x03, 
+0x37, 0xB4, 0x25, 0x35, 0xBC, 0x45, 0x65, 0xAE, 
+0xBF, 0xE9, 0x23, 0xC3, 0x56, 0x8C, 0xE9, 0xEE, 
+0x4E, 0x82, 0x8A, 0xD8, 0xDF, 0x93, 0xA2, 0x75, 
+0x13, 0xB8, 0x00, 0x83, 0xF2, 0x80, 0x53, 0x48, 
+0xDF, 0x95, 0xBB, 0xF3, 0x3D, 0xCF, 0x67, 0xEA, 
+0x10, 0x20, 0xAA, 0xF5, 0xD7, 0xF3, 0x94, 0x58, 
+0x3F, 0x59, 0x03, 0x5B, 0x82, 0x83, 0x90, 0x07, 
+0x46, 0x59, 0xDC, 0x58, 0x3D, 0x4F, 0x91, 0xBE, 
+0x13, 0x35, 0xC9, 0x1B, 0x2B, 0x26, 0x9F, 0x87, 
+0x8F, 0x2F, 0xA9, 0xB9, 0xD7, 0x5A, 0x68, 0xB2, 
+0xB3, 0xA8, 0x5C, 0x8E, 0x46, 0x0B, 0xE3, 0x2B, 
+0x33, 0x0A, 0xC5, 0xB9, 0xDA, 0x4B, 0xAB, 0xCC, 
+0xD3, 0x18, 0xC9, 0xB1, 0x4B, 0x7C, 0x0A, 0x3E, 
+0x66, 0xC0, 
+
+0x01, 0x41, 0xBD, 0x80, 0xE8, 0x7A, 0x5B, 0x4D, 
+0xCB, 0x33, 0xE1, 0x26, 0x0C, 0x44, 0x02, 0x92, 
+0x56, 0x81, 0x95, 0x35, 0x32, 0xE6, 0x87, 0xDD, 
+0x18, 0x48, 0x17, 0x68, 0xBC, 0xD9, 0x79, 0x88, 
+0xE4, 0xD9, 0xF8, 0xF1, 0xE5, 0x74, 0x2E, 0xFD, 
+0x37, 0xD8, 0xA3, 0x17, 0x93, 0xBB, 0xA9, 0xD4, 
+0x34, 0xDC, 0xCB, 0xCB, 0x70, 0xA7, 0xEA, 0x48, 
+0x8A, 0x54, 0x22, 0xDB, 0x1E, 0xED, 0xBE, 0x1A, 
+0x0B, 0x68, 0x45, 0xED, 0x61, 0xA9, 0x95, 0x5C, 
+0x96, 0x6C, 0x8E, 0x30, 0x5E, 0xE2, 0xD1, 0xEB, 
+0xB1, 0xAE, 0xE7, 0x01, 0x64, 0x36, 0x1C, 0xC8, 
+0x75, 0xDD, 0x52, 0xB6, 0x90, 0x1D, 0x25, 0xA3, 
+0x91, 0x5E, 0x48, 0xF7, 0xED, 0xD1, 0x70, 0x10, 
+0x6A, 0x75, 0xDF, 0x76, 0x98, 0x7F, 0xC2, 0xAE, 
+0xF4, 0xAC, 0x34, 0x29, 0x7B, 0xA8, 0xF7, 0x04, 
+0x0F, 0xFE, 0x08, 0xB9, 0x82, 0x81, 0x6C, 0x4E, 
+0x95, 0x14, 0xD2, 0xE7, 0x74, 0x05, 0xA2, 0x71, 
+0x86, 0x62, 0xBF, 0x6B, 0x44, 0xC5, 0x28, 0x7F, 
+0x92, 0xBD, 0xEC, 
+
+0xF5, 0x8C, 0x9B, 0x44, 0x49, 0x96, 0xBA, 0x9D, 
+0x97, 0x45, 0x94, 0xFF, 0x3B, 0xA6, 0x77, 0x8F, 
+0xB5, 0x0D, 0xAF, 0x8C, 0xD2, 0x97, 0x4E, 0xAA, 
+0x2F, 0xD0, 0x2B, 0xE9, 0xBA, 0x0E, 0xAE, 0xDB, 
+0xFE, 0x83, 0x37, 0xA9, 0xEA, 0x82, 0xF8, 0x05, 
+0x8B, 0x8E, 0xBC, 0x8A, 0xF0, 0xBC, 0x6D, 0xFD, 
+0xA9, 0x1C, 0x31, 0x36, 0x3D, 0x1F, 0xFB, 0x99, 
+0x96, 0x6A, 0x0A, 0x36, 0x99, 0xE7, 0xD5, 0xA8, 
+0xFF, 0x14, 0x8A, 0x1B, 0xC0, 0xCB, 0x94, 0x74, 
+0x74, 0x89, 0x2F, 0xF9, 0x2E, 0x47, 0x2A, 0x4B, 
+0x4B, 0x13, 0x13, 0x76, 0x4B, 0xF8, 0x1B, 0x61, 
+0x5B, 0xD2, 0x4A, 0xC4, 0xF3, 0x23, 0x8C, 0xFB, 
+0x19, 0x32, 0x3F, 0x41, 0xCC, 0x50, 0x8E, 0x07, 
+0x81, 0x4F, 0x00, 0x5E, 0xF6, 0x23, 0xC1, 0x41, 
+0x83, 0xB0, 0xFB, 0xE2, 0xBB, 0x57, 0xB1, 0xF0, 
+0x82, 0x7F, 0x8B, 0x9C, 0x3D, 0xAB, 0x30, 0xB8, 
+0x31, 0x88, 0xFA, 0x8E, 0xD9, 0x90, 0x55, 0x8B, 
+0x5F, 0xC9, 0x06, 0xF0, 0x06, 0xBB, 0x4F, 0x2D, 
+0x43, 0x96, 0x7E, 0x47, 
+
+0xED, 0x17, 0xCD, 0x7E, 0x1C, 0xB3, 0x1B, 0xE1, 
+0x21, 0x0D, 0x3C, 0xA6, 0x99, 0x00, 0xE6, 0x1E, 
+0x77, 0xC6, 0xE1, 0x5E, 0x2B, 0x16, 0xA5, 0x64, 
+0xC0, 0xEE, 0x64, 0x7C, 0xC2, 0x24, 0x16, 0x03, 
+0x10, 0x95, 0x1C, 0x21, 0x82, 0x77, 0xAB, 0xB8, 
+0x0E, 0x30, 0xF5, 0x8F, 0x8B, 0x9B, 0x64, 0xD4, 
+0x53, 0xA1, 0xD7, 0x90, 0xC8, 0x85, 0x81, 0xE6, 
+0x88, 0x6F, 0x53, 0x42, 0x74, 0x61, 0xCE, 0xCA, 
+0x66, 0xDC, 0x28, 0x3E, 0x6D, 0x8D, 0xEB, 0xF8, 
+0x8A, 0xB8, 0x18, 0x4C, 0x65, 0x01, 0xA4, 0xAF, 
+0x91, 0x46, 0xBD, 0xD7, 0xF3, 0xCC, 0x00, 0xEA, 
+0x2B, 0x75, 0x7A, 0x7F, 0x9B, 0x07, 0x26, 0x4A, 
+0x3A, 0x72, 0xB0, 0xF7, 0x03, 0x0D, 0x31, 0xCD, 
+0xBF, 0x7E, 0xEC, 0x34, 0x1A, 0x66, 0x18, 0x0E, 
+0x4A, 0x63, 0xEB, 0x02, 0x6E, 0x9C, 0x93, 0xAA, 
+0x24, 0x49, 0x47, 0xAF, 0xEA, 0x7A, 0x18, 0x5A, 
+0x26, 0x08, 0x97, 0x31, 0x7D, 0xED, 0x12, 0x9B, 
+0x6A, 0x01, 0xE3, 0xFF, 0x2F, 0x5F, 0x63, 0x59, 
+0xC2, 0xE8, 0x03, 0x17, 0xF9, 
+
+0x60, 0x98, 0x3E, 0x4F, 0xF9, 0x28, 0xBB, 0x55, 
+0xFD, 0x8F, 0xD1, 0xD6, 0x3F, 0xD7, 0x2A, 0x48, 
+0x77, 0x31, 0x59, 0xD6, 0xA8, 0x76, 0x54, 0x48, 
+0xE8, 0xB4, 0x48, 0x4E, 0x01, 0x92, 0xC8, 0xB5, 
+0x33, 0x3A, 0x1E, 0x4E, 0x0A, 0x7D, 0x55, 0x7E, 
+0xC0, 0x77, 0xB0, 0x6C, 0x29, 0xB2, 0xBF, 0xB8, 
+0x1A, 0xCC, 0x6F, 0x52, 0xE9, 0x80, 0x2C, 0x6C, 
+0xBF, 0x74, 0x71, 0xDE, 0xC5, 0x60, 0x17, 0x8F, 
+0x13, 0xC3, 0xBA, 0xE2, 0x08, 0xDA, 0x1F, 0xDC, 
+0x71, 0x5D, 0xC6, 0xF5, 0xB3, 0xA1, 0xBA, 0x6E, 
+0x05, 0x22, 0x34, 0x35, 0x35, 0x5F, 0x96, 0x9A, 
+0xDF, 0x9C, 0xD0, 0x97, 0xCB, 0xBF, 0x93, 0x84, 
+0x9A, 0xF5, 0x2F, 0xEE, 0x94, 0x4B, 0x37, 0x73, 
+0x23, 0xA7, 0x91, 0xF6, 0x2D, 0x07, 0x54, 0xCB, 
+0x53, 0xC4, 0x52, 0xAD, 0xAE, 0xA3, 0xE4, 0x53, 
+0x00, 0xEB, 0x11, 0x84, 0x7D, 0x40, 0xC6, 0x86, 
+0xEC, 0x2D, 0xCB, 0x03, 0xCD, 0x8B, 0xCF, 0xFC, 
+0xB1, 0xBD, 0xEE, 0x93, 0xB3, 0xB2, 0x0C, 0x4F, 
+0xF5, 0x08, 0xAD, 0x4B, 0xBD, 0x85, 
+
+0xD9, 0xD0, 0x9C, 0x36, 0xA0, 0x0A, 0xFA, 0xAB, 
+0x82, 0xB1, 0x7C, 0xD6, 0x21, 0xF3, 0x0A, 0xC5, 
+0x4B, 0x33, 0xA3, 0xEB, 0xD9, 0xC5, 0xDA, 0x49, 
+0xF8, 0xF1, 0x03, 0x4F, 0xE3, 0xCD, 0x88, 0x1A, 
+0xFF, 0x76, 0xD9, 0xBE, 0x7F, 0xB6, 0xA0, 0x6E, 
+0x4A, 0xB4, 0xDA, 0xA5, 0x89, 0xDB, 0x81, 0x8F, 
+0xDA, 0x99, 0x5C, 0x77, 0xCE, 0x08, 0x6E, 0xB3, 
+0x44, 0x4F, 0x85, 0xEF, 0xFA, 0xD6, 0xE3, 0x1B, 
+0x01, 0xD0, 0xAF, 0xB8, 0xF7, 0x23, 0xF5, 0x90, 
+0x77, 0xBA, 0xB4, 0x69, 0xB1, 0xA3, 0x45, 0x89, 
+0xEE, 0xB8, 0xCF, 0xEA, 0x66, 0x38, 0x95, 0xB7, 
+0xBA, 0xC5, 0x7C, 0x1D, 0x63, 0x43, 0x63, 0x14, 
+0x90, 0xA1, 0x46, 0x31, 0x79, 0x07, 0x82, 0x58, 
+0x09, 0x04, 0xED, 0xD7, 0x71, 0x74, 0x64, 0x8A, 
+0xB5, 0x66, 0x60, 0x1F, 0x8B, 0x7A, 0x03, 0x13, 
+0xA7, 0x4D, 0xC8, 0x88, 0x1B, 0xCF, 0x20, 0x61, 
+0x86, 0xD0, 0x96, 0x51, 0x20, 0x82, 0x27, 0x3B, 
+0x44, 0xFE, 0x95, 0xEA, 0x04, 0xD4, 0xF7, 0x27, 
+0x5C, 0xB4, 0x89, 0x54, 0x2F, 0x16, 0x2B, 
+
+0x36, 0x2E, 0x57, 0x62, 0x22, 0x2E, 0xA2, 0xA0, 
+0x88, 0xEC, 0xC3, 0x9E, 0x96, 0x4C, 0xAA, 0x43, 
+0x4D, 0xF1, 0x0A, 0x1E, 0x1B, 0xCE, 0x5C, 0x51, 
+0xF5, 0xF5, 0x16, 0x1D, 0x77, 0x82, 0xE2, 0xDD, 
+0x71, 0x20, 0x83, 0xC2, 0x7C, 0x80, 0xCE, 0x06, 
+0xAF, 0xEB, 0xAF, 0x06, 0xE3, 0xD7, 0x3A, 0x8D, 
+0x03, 0xD8, 0x6A, 0x18, 0x71, 0x3B, 0xBA, 0x81, 
+0x23, 0x8C, 0x1A, 0xB2, 0x29, 0x68, 0x93, 0xE9, 
+0xC2, 0x84, 0x89, 0x9C, 0x10, 0xAE, 0x27, 0xB2, 
+0x8A, 0xC6, 0xF9, 0x5B, 0x2B, 0x02, 0x20, 0x2B, 
+0x0D, 0xBE, 0x37, 0xF3, 0x94, 0x2C, 0x4B, 0xF3, 
+0x45, 0x55, 0xAF, 0x99, 0x16, 0x84, 0xB1, 0x6B, 
+0x2E, 0xA7, 0xEC, 0x8D, 0x2B, 0x5A, 0x95, 0x28, 
+0x91, 0xFE, 0x7B, 0x7B, 0xB0, 0x6B, 0xA3, 0x00, 
+0xB6, 0x15, 0x1B, 0x1D, 0x68, 0x91, 0x79, 0xAC, 
+0x48, 0xA6, 0xF9, 0x6A, 0x3D, 0x8D, 0x67, 0x73, 
+0x49, 0x09, 0x43, 0xD5, 0x96, 0xA3, 0x07, 0xC9, 
+0x94, 0x33, 0x3A, 0xA4, 0xDA, 0xA5, 0x91, 0x88, 
+0xF7, 0x1F, 0x60, 0x87, 0xBF, 0xC5, 0x18, 0x08, 
+
+0xA4, 0xDF, 0xDE, 0xA3, 0x07, 0x88, 0x14, 0x98, 
+0xC0, 0xEE, 0xED, 0xD3, 0xEB, 0xFE, 0x27, 0xB5, 
+0xD3, 0x60, 0x14, 0x33, 0x32, 0xF6, 0x90, 0x97, 
+0xF2, 0x89, 0xE0, 0xD4, 0x11, 0xD5, 0x19, 0x57, 
+0x19, 0x0C, 0x7F, 0xED, 0xD2, 0xD5, 0xB4, 0x3A, 
+0xB8, 0xE7, 0xE7, 0x24, 0xF7, 0xA8, 0x8C, 0x44, 
+0x8E, 0xDF, 0x1C, 0x1E, 0x03, 0xE1, 0x58, 0x56, 
+0x2F, 0xE7, 0x01, 0xBD, 0x9C, 0x8C, 0x40, 0x3D, 
+0x96, 0x43, 0xB5, 0x26, 0x6A, 0x10, 0xB9, 0x25, 
+0x83, 0x50, 0xD1, 0x78, 0xF1, 0xF7, 0x4B, 0x59, 
+0xF4, 0xAF, 0xBB, 0xCA, 0xA7, 0xC3, 0xE9, 0x3C, 
+0x2D, 0x8E, 0xB5, 0xB5, 0x4A, 0xC0, 0xF3, 0x1C, 
+0x80, 0xFC, 0xBF, 0xD0, 0x78, 0x98, 0x29, 0x0F, 
+0x5E, 0x0A, 0x2A, 0x5C, 0xC6, 0x4C, 0x1C, 0x29, 
+0xEF, 0xCA, 0xE4, 0xBB, 0x11, 0xA6, 0xCA, 0x68, 
+0x8C, 0xB8, 0xB8, 0x6E, 0x28, 0x4C, 0x1A, 0xF3, 
+0xA4, 0x33, 0xBC, 0x8B, 0x4D, 0xD9, 0xF9, 0x17, 
+0xDD, 0xE3, 0x90, 0x90, 0xCC, 0x5E, 0x77, 0x3F, 
+0xCC, 0xF4, 0x86, 0xC4, 0x9C, 0xBD, 0x72, 0x9E, 
+0x12, 
+
+0x85, 0x5E, 0xB9, 0x55, 0xFA, 0xCC, 0xAF, 0xC8, 
+0xB3, 0x75, 0xC6, 0x63, 0x9D, 0xEA, 0x0F, 0xC6, 
+0xF5, 0xBF, 0x0E, 0xA3, 0xB7, 0x8E, 0xE5, 0x7F, 
+0x65, 0xA6, 0x91, 0x22, 0x1B, 0x35, 0xD8, 0xE4, 
+0x82, 0x95, 0xC6, 0xA3, 0x9C, 0x4B, 0x93, 0x00, 
+0x4F, 0x1C, 0x66, 0x4A, 0x9D, 0x91, 0x3B, 0x05, 
+0x84, 0x5E, 0x46, 0x30, 0x5F, 0x4F, 0x99, 0xF9, 
+0xCB, 0x88, 0xE6, 0x5C, 0xEF, 0x5D, 0xEB, 0x2F, 
+0x71, 0x57, 0x53, 0xC1, 0x2E, 0xC4, 0xB3, 0x18, 
+0x9B, 0x9F, 0x5D, 0x9D, 0xF0, 0xCE, 0xEE, 0xA0, 
+0x2A, 0x3E, 0x71, 0x36, 0x13, 0x68, 0x03, 0x60, 
+0x24, 0x8B, 0xD3, 0x0D, 0x40, 0x41, 0x75, 0x1C, 
+0x45, 0xFE, 0x18, 0x88, 0xFE, 0xA9, 0x39, 0x5A, 
+0xD8, 0xA0, 0xF7, 0x04, 0xA1, 0xF3, 0xB5, 0xAA, 
+0xD1, 0x48, 0x3A, 0x1F, 0x5A, 0xA2, 0xE5, 0x30, 
+0x94, 0x5C, 0xDC, 0xA4, 0x9E, 0xE9, 0x42, 0xB0, 
+0x55, 0xAC, 0xE5, 0x34, 0xB6, 0x5E, 0x8A, 0x6E, 
+0x32, 0x76, 0xE1, 0x4F, 0xC9, 0xFC, 0xB7, 0xD8, 
+0x39, 0x0F, 0xA7, 0xBF, 0x57, 0x8A, 0x63, 0x63, 
+0xE5, 0x77, 
+
+0xC1, 0xE0, 0x92, 0xC5, 0xD9, 0x31, 0x46, 0x05, 
+0x34, 0xC5, 0x22, 0x1C, 0x5F, 0x9D, 0x12, 0x00, 
+0x49, 0x80, 0x8E, 0xBA, 0x48, 0x4A, 0xFD, 0x06, 
+0x4E, 0xD0, 0xEB, 0xC5, 0x54, 0xD4, 0x74, 0x48, 
+0x83, 0x6D, 0x4A, 0x78, 0x45, 0x97, 0xA9, 0xE8, 
+0x32, 0x1A, 0x16, 0x85, 0xCF, 0xEA, 0xE5, 0x40, 
+0x51, 0x05, 0x10, 0x76, 0x51, 0xFD, 0x9D, 0x0D, 
+0x18, 0xBF, 0x49, 0xA6, 0xAF, 0x3A, 0xBA, 0xDE, 
+0x8E, 0x37, 0xAB, 0x18, 0x5E, 0x03, 0x84, 0x3C, 
+0x43, 0x35, 0x51, 0x73, 0x66, 0xF7, 0xC1, 0x2F, 
+0xEE, 0x61, 0xCF, 0x5F, 0xD7, 0x88, 0x6C, 0x85, 
+0x3D, 0xE6, 0x2E, 0x70, 0x8D, 0x7D, 0x85, 0xC2, 
+0x9D, 0x80, 0xB8, 0xB9, 0xCA, 0x6B, 0x0A, 0x4B, 
+0x7F, 0x65, 0x90, 0xF3, 0x66, 0xC4, 0x2B, 0x8D, 
+0x2D, 0xAE, 0x32, 0xCA, 0x75, 0x48, 0x18, 0x06, 
+0x7E, 0x34, 0xB1, 0xB1, 0xDC, 0x94, 0xD2, 0xCE, 
+0x77, 0x81, 0xDD, 0x41, 0x39, 0x72, 0xC6, 0x01, 
+0x6C, 0xCD, 0xE4, 0xF8, 0x20, 0xEC, 0x61, 0x22, 
+0x14, 0x77, 0x79, 0xB0, 0x50, 0xAE, 0x15, 0x32, 
+0x1C, 0x99, 0xCE, 
+
+0xF0, 0x4E, 0xC9, 0xD5, 0x02, 0xC3, 0x86, 0xBF, 
+0x4D, 0x98, 0x4F, 0x0B, 0xCE, 0x3D, 0xFC, 0x18, 
+0x8B, 0xB5, 0x52, 0x76, 0xBC, 0x85, 0x5C, 0x83, 
+0xD4, 0x88, 0xBA, 0x16, 0xA1, 0x72, 0x9A, 0x00, 
+0x79, 0xED, 0x55, 0xF0, 0x95, 0x8C, 0x2B, 0x44, 
+0x7B, 0xCE, 0x74, 0xB8, 0x2D, 0x31, 0x96, 0xC3, 
+0x48, 0x70, 0x0E, 0xF1, 0x8F, 0xD1, 0x93, 0xDE, 
+0x77, 0xFE, 0x3F, 0x2E, 0xDC, 0x99, 0xD9, 0xC7, 
+0xA9, 0x23, 0x68, 0xF8, 0x1F, 0xDA, 0x5B, 0x55, 
+0x88, 0x7B, 0x9B, 0x45, 0xFB, 0x87, 0x55, 0x0F, 
+0x1F, 0x54, 0x21, 0xF2, 0xE6, 0x33, 0xF6, 0x5F, 
+0x4F, 0x2A, 0x3D, 0xAD, 0xA1, 0x24, 0x62, 0x98, 
+0x63, 0x0D, 0xBB, 0x59, 0x56, 0xEB, 0x64, 0x7F, 
+0x88, 0xFC, 0x2F, 0x88, 0x6F, 0x44, 0x5B, 0x1E, 
+0xE7, 0xBD, 0x10, 0xCB, 0x6D, 0xF3, 0xAB, 0x15, 
+0x3E, 0x64, 0x7A, 0x42, 0xBD, 0xFF, 0xD7, 0x51, 
+0xA4, 0x0D, 0x58, 0xDD, 0x8B, 0x23, 0xB4, 0xD9, 
+0xB0, 0x8E, 0x84, 0xB6, 0x09, 0x09, 0x93, 0x3F, 
+0xB0, 0x97, 0x18, 0xA4, 0x06, 0x90, 0xE8, 0xED, 
+0xCE, 0x9F, 0x15, 0xF9, 
+
+0x61, 0x25, 0xD1, 0xE5, 0x89, 0xDD, 0x70, 0xC0, 
+0xD1, 0xE4, 0xEB, 0x15, 0xFF, 0x20, 0x8B, 0xA8, 
+0x2F, 0xD8, 0x85, 0x53, 0x39, 0x7A, 0xDA, 0x20, 
+0x5A, 0x29, 0x17, 0xFA, 0x5A, 0x2B, 0x01, 0x8C, 
+0x07, 0x51, 0x68, 0x38, 0xD6, 0xA3, 0x0C, 0x03, 
+0x4C, 0xBE, 0x2A, 0xFB, 0x05, 0x7F, 0x21, 0x9F, 
+0x30, 0x57, 0x13, 0x32, 0x36, 0xEA, 0xF6, 0xE0, 
+0x46, 0xC0, 0x60, 0x1F, 0xE4, 0xCA, 0x9B, 0x5E, 
+0x55, 0x68, 0xFC, 0xDD, 0x80, 0xD4, 0x2A, 0xFB, 
+0x05, 0xAB, 0x0D, 0xF0, 0xA9, 0xC0, 0x77, 0x4B, 
+0x73, 0x5F, 0x35, 0x86, 0xC7, 0x78, 0xBB, 0xAB, 
+0xD3, 0x5E, 0x86, 0x41, 0x76, 0x7B, 0xFA, 0x47, 
+0x82, 0x90, 0x0F, 0x57, 0x67, 0x74, 0x88, 0xB0, 
+0x28, 0xBD, 0x47, 0xFB, 0x02, 0xA1, 0x6E, 0xD4, 
+0xBA, 0xEB, 0x2D, 0xF5, 0xD6, 0x0C, 0x2D, 0xE3, 
+0x01, 0x8A, 0xDD, 0x08, 0x6C, 0xFD, 0x0C, 0x1A, 
+0x53, 0xF0, 0xDF, 0x92, 0x8C, 0xEE, 0x6E, 0x65, 
+0x97, 0x6F, 0x65, 0x1D, 0x87, 0xB7, 0xE9, 0xA5, 
+0x9A, 0x77, 0x28, 0x6E, 0x94, 0x74, 0x75, 0xC3, 
+0xA6, 0xD6, 0x7E, 0xD2, 0x56, 
+
+0x87, 0x13, 0xD2, 0x36, 0x32, 0x7F, 0x8F, 0x49, 
+0x0C, 0x49, 0x15, 0xD7, 0x8F, 0x01, 0x6B, 0x31, 
+0x49, 0x08, 0x80, 0xBC, 0x14, 0x15, 0x99, 0x1A, 
+0x1F, 0x8C, 0x06, 0x22, 0x25, 0x35, 0x64, 0x0D, 
+0x7D, 0xC5, 0x7A, 0x84, 0xE2, 0x2F, 0x35, 0x2F, 
+0x6F, 0x18, 0xBB, 0xD1, 0x33, 0x8D, 0x27, 0xA5, 
+0x57, 0xA7, 0x5F, 0x12, 0x0E, 0xA0, 0x04, 0xFB, 
+0x4D, 0x70, 0xF3, 0x23, 0x7F, 0x7F, 0xC0, 0xF6, 
+0x47, 0xD0, 0xAE, 0x33, 0xC5, 0x67, 0x0A, 0xFC, 
+0x1B, 0x78, 0x35, 0x36, 0xE2, 0x06, 0x7E, 0x1E, 
+0x4B, 0xFA, 0xB5, 0x52, 0x83, 0xE7, 0x70, 0x88, 
+0xB0, 0x23, 0xEA, 0x6B, 0xD5, 0x90, 0xAE, 0x10, 
+0x03, 0x7E, 0x24, 0xE9, 0xC2, 0x07, 0xE0, 0xEF, 
+0xE8, 0xE0, 0x49, 0x25, 0x4F, 0xB9, 0x30, 0xDD, 
+0x5B, 0x13, 0x7C, 0x2B, 0xA1, 0xC4, 0x1A, 0x11, 
+0xF6, 0xA4, 0x96, 0xDB, 0xF5, 0x15, 0xD6, 0x1C, 
+0xC6, 0xBE, 0xF6, 0xAD, 0x85, 0x60, 0xB6, 0xE8, 
+0x5F, 0xE4, 0xE3, 0xA4, 0x7A, 0x90, 0xD9, 0xE9, 
+0x01, 0xBA, 0x65, 0x81, 0x67, 0x14, 0x38, 0x05, 
+0x9C, 0x66, 0xF2, 0x46, 0xB2, 0xBD, 
+
+0xB5, 0xEB, 0x44, 0x98, 0x9A, 0x21, 0x63, 0x60, 
+0x47, 0x81, 0x6D, 0x74, 0x25, 0xE7, 0x7D, 0x13, 
+0x9A, 0x06, 0x17, 0x0E, 0xCA, 0x11, 0xA6, 0x99, 
+0x52, 0x01, 0xCE, 0x4D, 0xBD, 0x6B, 0xBD, 0x88, 
+0xC1, 0x32, 0xE9, 0x4E, 0x30, 0xFB, 0xB4, 0x1C, 
+0x97, 0xBA, 0x10, 0xE8, 0xBD, 0xD7, 0xE4, 0x94, 
+0xE1, 0x0B, 0x67, 0xD3, 0x3B, 0xC1, 0x2F, 0x60, 
+0xBD, 0xE1, 0xE4, 0xB2, 0x64, 0x5F, 0xF3, 0x47, 
+0xB2, 0xD5, 0x63, 0x4A, 0x50, 0x14, 0xEB, 0x43, 
+0x65, 0xD6, 0x2A, 0x0D, 0xF0, 0x28, 0xD2, 0x63, 
+0xC5, 0x68, 0x50, 0xEE, 0x6D, 0x6F, 0xCF, 0x8E, 
+0x8B, 0x86, 0x5A, 0x1C, 0xBA, 0x60, 0xE7, 0x97, 
+0x11, 0x71, 0x43, 0x5D, 0xA6, 0x0B, 0x49, 0x16, 
+0xB9, 0x0D, 0x69, 0x6E, 0x8B, 0xF2, 0xAC, 0x70, 
+0xAA, 0xF6, 0xE8, 0xCB, 0x69, 0xFA, 0xAF, 0xF7, 
+0x34, 0x33, 0x0B, 0xD9, 0xF4, 0xFD, 0x19, 0x18, 
+0x05, 0x11, 0x41, 0x53, 0x2B, 0x83, 0x2A, 0xC7, 
+0xA7, 0xA3, 0x02, 0x4A, 0xB8, 0x43, 0x5B, 0x5C, 
+0x43, 0xD3, 0x97, 0x95, 0x03, 0xBE, 0xF1, 0x44, 
+0x28, 0x6C, 0x35, 0x0C, 0x75, 0x76, 0xCD, 
+
+0x4A, 0x52, 0x37, 0xDC, 0x4B, 0x83, 0x60, 0xFF, 
+0x29, 0x04, 0xA5, 0xD2, 0x34, 0xBC, 0x42, 0xC6, 
+0xDB, 0x39, 0x18, 0xE8, 0x3D, 0x15, 0x88, 0x0E, 
+0x7C, 0x88, 0x82, 0xD5, 0x97, 0x93, 0x02, 0xA0, 
+0x9C, 0x44, 0x06, 0x35, 0x5A, 0x26, 0x99, 0x8D, 
+0x5C, 0x99, 0x3C, 0xC0, 0x75, 0x45, 0xE0, 0xFC, 
+0xFA, 0x65, 0x00, 0x23, 0x6D, 0xB8, 0xC7, 0xA2, 
+0x48, 0x91, 0x86, 0xF4, 0xBD, 0xC2, 0x82, 0xFF, 
+0x11, 0x2A, 0x53, 0xED, 0x81, 0xD5, 0xB0, 0x4A, 
+0x94, 0x7F, 0x34, 0x64, 0xC6, 0xB8, 0x54, 0x9A, 
+0xED, 0x3E, 0xC1, 0x1D, 0x97, 0x71, 0xED, 0x2A, 
+0xDA, 0x24, 0x62, 0x57, 0xE2, 0xAF, 0xD3, 0x9C, 
+0xFD, 0x85, 0x5E, 0xCC, 0xDC, 0x31, 0x5A, 0x6C, 
+0x49, 0x01, 0xA9, 0x37, 0x98, 0x78, 0x1D, 0x82, 
+0x13, 0x28, 0x5C, 0xDB, 0x56, 0x0C, 0x44, 0xB3, 
+0xA1, 0x96, 0x77, 0x03, 0xCB, 0x13, 0x0A, 0x75, 
+0xBD, 0x6B, 0x8F, 0x37, 0x7B, 0x97, 0xC6, 0xEF, 
+0x0B, 0x67, 0x0A, 0x66, 0xCF, 0xE7, 0x23, 0x47, 
+0x9A, 0x20, 0x41, 0xB3, 0xC9, 0xEA, 0x69, 0x1B, 
+0x4A, 0x06, 0x4E, 0x8D, 0x3C, 0x21, 0x91, 0xA8, 
+
+0xE3, 0x4D, 0x9E, 0x76, 0x52, 0xFB, 0xAD, 0xBD, 
+0xC9, 0x68, 0x80, 0x14, 0x72, 0xD7, 0x20, 0xF0, 
+0x22, 0xD0, 0x6E, 0x95, 0x15, 0x1D, 0x19, 0x9B, 
+0xE4, 0x80, 0xA1, 0xC5, 0x4C, 0xF3, 0x09, 0x28, 
+0xED, 0x69, 0xBE, 0x50, 0xDC, 0x4A, 0x88, 0xE8, 
+0x6E, 0x8C, 0x23, 0x1B, 0x5F, 0xF8, 0xE9, 0xDD, 
+0x38, 0x60, 0x95, 0x2E, 0x9C, 0x2C, 0xED, 0x1B, 
+0x3D, 0x68, 0x3A, 0x64, 0x1E, 0x28, 0x8A, 0x19, 
+0xB0, 0x9D, 0xC0, 0xB6, 0xF1, 0x9F, 0x5E, 0x9F, 
+0x40, 0x0C, 0x10, 0x64, 0x48, 0x5A, 0x5B, 0xCA, 
+0x4C, 0x17, 0xB7, 0x4A, 0x19, 0x43, 0x4E, 0x66, 
+0x21, 0x33, 0xF3, 0x96, 0x9C, 0xC0, 0x9A, 0xD8, 
+0xB9, 0x7F, 0xAC, 0x6A, 0x8C, 0xDB, 0x9E, 0xDC, 
+0xEF, 0x4E, 0xA0, 0xB1, 0x0A, 0x85, 0xDD, 0xAA, 
+0xF8, 0x41, 0xD2, 0xDF, 0x4F, 0x09, 0x19, 0x48, 
+0x44, 0xC9, 0xD3, 0xF2, 0xAC, 0x55, 0x89, 0xF1, 
+0x3F, 0x38, 0x55, 0x40, 0xBF, 0x6E, 0x40, 0x8C, 
+0x32, 0x0C, 0x24, 0xF5, 0x53, 0xBB, 0x26, 0x89, 
+0xC5, 0x84, 0x76, 0x68, 0xD0, 0xAF, 0x71, 0xD1, 
+0xDF, 0xB0, 0x67, 0x9B, 0x68, 0xEB, 0x8C, 0xE6, 
+0x19, 
+
+0xFE, 0x25, 0xE1, 0x5B, 0xBB, 0xBD, 0x35, 0x9F, 
+0xD6, 0xA0, 0x77, 0x26, 0x28, 0x73, 0xA5, 0x44, 
+0x95, 0x6C, 0x23, 0xB9, 0x51, 0xBA, 0x45, 0x7A, 
+0xA9, 0x61, 0x7F, 0x48, 0x0B, 0x78, 0xBD, 0x6F, 
+0xC2, 0x42, 0x43, 0x5A, 0x25, 0x80, 0xE6, 0x03, 
+0xC4, 0xEB, 0x26, 0x05, 0xA4, 0xB5, 0x87, 0x5D, 
+0xF3, 0xC8, 0x6A, 0xD5, 0x60, 0x71, 0xBC, 0x7A, 
+0xD8, 0x85, 0x13, 0x4A, 0x8B, 0x44, 0xA9, 0xC4, 
+0x24, 0x29, 0xB2, 0x97, 0x49, 0x79, 0xE3, 0x64, 
+0x3E, 0x6E, 0x5F, 0xA6, 0xF3, 0xF4, 0xDF, 0x8F, 
+0x25, 0x03, 0x7E, 0xE2, 0xFA, 0xBE, 0xA3, 0xFF, 
+0x35, 0x2A, 0x8C, 0xE0, 0xA9, 0x74, 0x11, 0xC6, 
+0x44, 0x0B, 0x17, 0xC4, 0x15, 0xCC, 0x08, 0xEE, 
+0xF2, 0xC9, 0x0E, 0xEA, 0x00, 0xC0, 0xD6, 0xAD, 
+0xC8, 0x21, 0xD8, 0xCB, 0x86, 0x69, 0xF6, 0x7E, 
+0x26, 0x7F, 0x4B, 0xDB, 0x91, 0x7C, 0xA6, 0x89, 
+0x07, 0x1A, 0x71, 0xAC, 0x0F, 0x81, 0x6B, 0x8E, 
+0x99, 0x10, 0xB0, 0x99, 0x1B, 0x88, 0x6F, 0xF2, 
+0x7A, 0xB7, 0xA0, 0xC4, 0x76, 0x2B, 0xC4, 0x8E, 
+0x9F, 0xAE, 0xA5, 0xB6, 0x10, 0x4A, 0x01, 0xCA, 
+0xB7, 0x3A, 
+
+0x76, 0x01, 0x63, 0xA9, 0xFB, 0xDB, 0xD9, 0x5E, 
+0x9F, 0x9B, 0x1E, 0xC6, 0x8E, 0x78, 0xDB, 0x44, 
+0xF4, 0x61, 0xE5, 0x0C, 0x1E, 0x6C, 0xD4, 0xCC, 
+0xBD, 0x27, 0x0A, 0xE5, 0xB4, 0x53, 0x86, 0x05, 
+0xDD, 0xE0, 0x49, 0x24, 0x88, 0x1E, 0xEC, 0x5A, 
+0x09, 0x1B, 0xA3, 0x4A, 0xBE, 0xFD, 0x6C, 0xC6, 
+0x6A, 0x57, 0xFE, 0x8C, 0x79, 0xC5, 0x63, 0x7F, 
+0x11, 0x3A, 0x7C, 0x3F, 0x01, 0xCF, 0x14, 0x69, 
+0x44, 0xFA, 0xCB, 0xE4, 0x93, 0xDD, 0x4F, 0xF3, 
+0x8A, 0x9C, 0x73, 0xD9, 0x6E, 0xA0, 0xD3, 0xF0, 
+0x6E, 0x3E, 0x92, 0x58, 0x2D, 0xB4, 0x0F, 0xD5, 
+0x4B, 0x15, 0xEE, 0x7F, 0x54, 0x39, 0x86, 0xA3, 
+0x08, 0x47, 0x2B, 0xF3, 0xB8, 0x55, 0xC6, 0x92, 
+0xF1, 0x2B, 0x5C, 0x1F, 0x9B, 0x3B, 0x6B, 0x9F, 
+0x3E, 0x16, 0x35, 0x8D, 0xE4, 0x42, 0xEC, 0xF0, 
+0xEB, 0x2B, 0x2E, 0x78, 0x4E, 0x54, 0x49, 0x3E, 
+0x7E, 0x20, 0xAD, 0x41, 0x61, 0xEC, 0x07, 0x8A, 
+0x38, 0x3F, 0x8B, 0x7F, 0x4C, 0x92, 0x84, 0x54, 
+0x56, 0x2D, 0x0E, 0x53, 0xCB, 0x7C, 0x43, 0xE9, 
+0xE6, 0x29, 0xE6, 0x29, 0x6A, 0x78, 0x3F, 0x35, 
+0x7C, 0xB3, 0xE3, 
+
+0x1A, 0x09, 0xE5, 0x28, 0xDB, 0xFA, 0x7A, 0x05, 
+0x08, 0xC3, 0x34, 0xB3, 0x44, 0xCB, 0x74, 0x8D, 
+0x86, 0xFF, 0x68, 0x4A, 0xDD, 0x8C, 0x9C, 0x63, 
+0x7A, 0xF8, 0xE4, 0x2D, 0xDA, 0x3E, 0xBA, 0x02, 
+0x6E, 0xA8, 0x2D, 0x33, 0xDA, 0x82, 0x8F, 0xA0, 
+0x0E, 0x5F, 0xBA, 0x76, 0xF0, 0x51, 0xB7, 0xCE, 
+0xAE, 0x55, 0xE1, 0x55, 0x13, 0xB6, 0xF1, 0x19, 
+0xA9, 0xEE, 0x5D, 0x44, 0x7A, 0x97, 0xBD, 0xE0, 
+0x68, 0x98, 0x34, 0xC8, 0x15, 0xDC, 0xD5, 0x47, 
+0xFD, 0x1E, 0x10, 0x05, 0xCF, 0x02, 0x29, 0x90, 
+0x65, 0x67, 0xCA, 0x32, 0xE6, 0xFC, 0xC3, 0x44, 
+0x83, 0xC4, 0xC2, 0x56, 0xBC, 0x82, 0x72, 0x4A, 
+0x5F, 0x3E, 0x1C, 0x20, 0xB4, 0xB6, 0x40, 0xCA, 
+0x63, 0x3A, 0x35, 0xDC, 0x64, 0xE8, 0x84, 0x69, 
+0x25, 0x35, 0x6E, 0xEA, 0xB3, 0x90, 0x7A, 0xC7, 
+0xA9, 0xF6, 0x77, 0x6A, 0x2D, 0xDF, 0x9B, 0xD3, 
+0x21, 0xC0, 0xD9, 0x24, 0x1A, 0x02, 0xE4, 0x5A, 
+0xF9, 0xDA, 0xD9, 0x4A, 0x7F, 0xE0, 0xFF, 0x4B, 
+0xD5, 0xA3, 0x5B, 0xBE, 0xE0, 0xA5, 0x20, 0xB9, 
+0x70, 0x32, 0x29, 0x21, 0x2C, 0x66, 0x91, 0x6E, 
+0x4D, 0x49, 0x77, 0x46, 
+
+0xBA, 0x59, 0x2F, 0x5B, 0xD9, 0x94, 0x89, 0x97, 
+0x47, 0x3C, 0x2F, 0xB1, 0x1B, 0xC8, 0x0E, 0x76, 
+0xF0, 0xE7, 0x1F, 0xA0, 0x98, 0xA5, 0x91, 0xEA, 
+0x0B, 0x2F, 0x7A, 0x9E, 0x85, 0xEC, 0x5B, 0x5F, 
+0xA2, 0x4B, 0xF1, 0xCD, 0xD8, 0x9F, 0x4D, 0xC4, 
+0xDB, 0xEB, 0x16, 0xF1, 0x88, 0x30, 0x64, 0x83, 
+0xA2, 0x0A, 0xA9, 0x1C, 0x78, 0xF4, 0x9B, 0x7B, 
+0x90, 0x79, 0x8B, 0x2E, 0x80, 0x20, 0xC3, 0x9F, 
+0xBC, 0xFB, 0x6E, 0x7D, 0x32, 0x8E, 0xF0, 0x3F, 
+0x79, 0xD7, 0x04, 0xB0, 0xF8, 0xFD, 0xD6, 0xF8, 
+0xC3, 0x46, 0xC8, 0x6B, 0xDD, 0x8B, 0x6B, 0x63, 
+0xED, 0xB7, 0xCE, 0xCB, 0xDF, 0x4F, 0x1C, 0x28, 
+0x6C, 0x47, 0x90, 0x88, 0x97, 0x5B, 0x02, 0x7E, 
+0xA3, 0xAA, 0x17, 0xE5, 0xB6, 0x6C, 0x4D, 0x42, 
+0x69, 0xEF, 0x1D, 0x99, 0x0A, 0x40, 0x77, 0x4F, 
+0xED, 0x36, 0x60, 0xA2, 0xB2, 0x77, 0x59, 0xA6, 
+0xCE, 0xFE, 0x33, 0xF2, 0xED, 0x41, 0xF9, 0x93, 
+0x18, 0xC9, 0x32, 0x6A, 0xAA, 0x86, 0xC2, 0x7C, 
+0xF3, 0xF1, 0x01, 0x9F, 0x5E, 0x2D, 0x65, 0x23, 
+0x3E, 0x14, 0x08, 0xE9, 0xFB, 0xC6, 0x24, 0x10, 
+0x10, 0x7F, 0x25, 0x5F, 0x14, 
+
+0x67, 0x70, 0xB2, 0x88, 0x41, 0xC4, 0x8B, 0x36, 
+0xFA, 0x5E, 0x79, 0x02, 0x9D, 0x98, 0x00, 0x66, 
+0x1D, 0x8D, 0x54, 0x74, 0xFD, 0x2C, 0x7C, 0x23, 
+0x7C, 0x05, 0xA4, 0x59, 0x7E, 0x13, 0x5A, 0x10, 
+0x9C, 0xF7, 0xD4, 0x12, 0x73, 0x57, 0x4A, 0xDF, 
+0x8A, 0x3C, 0x3F, 0x35, 0x53, 0xC7, 0x9A, 0x13, 
+0x98, 0x4D, 0xB5, 0x88, 0x45, 0x13, 0x93, 0x27, 
+0xF3, 0xBA, 0xD9, 0x8C, 0xB9, 0xCB, 0x0A, 0x5C, 
+0xC5, 0xB0, 0xC2, 0x34, 0x50, 0xD5, 0x26, 0x75, 
+0xEB, 0xFB, 0x44, 0x3C, 0x31, 0x66, 0x8A, 0xBB, 
+0xCE, 0xF3, 0x83, 0xCE, 0x01, 0xBB, 0x53, 0xD0, 
+0x8D, 0x48, 0x3B, 0x21, 0xF3, 0x5C, 0x76, 0x92, 
+0xCE, 0x3F, 0x42, 0xF0, 0xCC, 0x79, 0xE4, 0x8E, 
+0x11, 0x54, 0xE3, 0x52, 0xED, 0xE9, 0x7E, 0x2B, 
+0x18, 0x11, 0x14, 0x67, 0x46, 0x75, 0x4A, 0x9C, 
+0xF8, 0x43, 0xD8, 0xFA, 0x3C, 0xE7, 0xDC, 0xD3, 
+0xCD, 0x01, 0x33, 0xA9, 0x99, 0x79, 0x44, 0x70, 
+0x67, 0x15, 0x75, 0xB2, 0x87, 0x96, 0x63, 0x75, 
+0x02, 0x8E, 0xA2, 0x0A, 0x37, 0x39, 0x8C, 0x9A, 
+0xB7, 0xEE, 0x9D, 0xC8, 0xB9, 0xA0, 0xFE, 0xBE, 
+0x1A, 0x7E, 0x0A, 0xC1, 0x86, 0xE4, 
+
+0x5E, 0x02, 0xEA, 0x3C, 0x14, 0x99, 0x54, 0x6F, 
+0x10, 0x11, 0x70, 0x05, 0x70, 0x5C, 0x5C, 0xD7, 
+0xD6, 0x1B, 0xCA, 0x05, 0x29, 0xA1, 0xA3, 0x00, 
+0x5D, 0xAC, 0x56, 0xFF, 0xC1, 0x09, 0x8B, 0x70, 
+0x7D, 0x7F, 0x49, 0x92, 0xC3, 0x75, 0x07, 0xCF, 
+0x36, 0x1A, 0xF0, 0x5B, 0xB5, 0xAE, 0xDB, 0xCC, 
+0x2E, 0x8A, 0xCC, 0x46, 0x1F, 0xAE, 0x09, 0x74, 
+0x40, 0x2F, 0x3B, 0xC1, 0x7B, 0x51, 0x08, 0x1F, 
+0x7C, 0xBB, 0x95, 0x9E, 0x70, 0x42, 0x30, 0xEB, 
+0x7B, 0xDE, 0xC7, 0xA2, 0x71, 0x44, 0x06, 0x4A, 
+0x77, 0x97, 0xD1, 0x3C, 0xFD, 0xFB, 0x2E, 0x06, 
+0x9C, 0x0E, 0xAD, 0xFE, 0x29, 0x53, 0xFB, 0xAE, 
+0x92, 0x18, 0x4F, 0xB0, 0xF5, 0xCD, 0xDC, 0xDF, 
+0x4E, 0x3C, 0x82, 0xBC, 0x4A, 0x62, 0x84, 0x8E, 
+0x7E, 0x63, 0x6E, 0x1F, 0x2D, 0xDC, 0x09, 0x5F, 
+0xDE, 0x76, 0x03, 0x81, 0x32, 0xD5, 0x9E, 0x88, 
+0xE6, 0xED, 0x2A, 0x58, 0xFC, 0x58, 0x1D, 0xCF, 
+0xA6, 0x89, 0xF1, 0x76, 0x19, 0xCA, 0xDB, 0x6E, 
+0xEB, 0xE8, 0x82, 0x73, 0x3D, 0x48, 0x69, 0xF6, 
+0x18, 0xF0, 0xBD, 0xEA, 0xA2, 0xF2, 0x23, 0xF7, 
+0x58, 0x58, 0x15, 0x7C, 0x79, 0x1B, 0x61, 
+
+0xD6, 0x80, 0x97, 0x69, 0xEF, 0x00, 0x1A, 0x83, 
+0xCA, 0xDB, 0x59, 0x1B, 0x5A, 0xC9, 0x7E, 0x42, 
+0x3C, 0xD5, 0x72, 0x10, 0x67, 0x26, 0xAE, 0x79, 
+0x40, 0x0D, 0xC4, 0xB2, 0x57, 0x93, 0x7A, 0x21, 
+0xEA, 0x56, 0x38, 0x67, 0xF2, 0x2D, 0xA6, 0xBE, 
+0xEA, 0xAE, 0xF9, 0x1E, 0xCD, 0xCA, 0xE7, 0x32, 
+0xCE, 0x3D, 0x5C, 0x09, 0x3E, 0x84, 0x4E, 0xD4, 
+0x68, 0xC0, 0x05, 0x2F, 0x95, 0x15, 0xD5, 0xBB, 
+0xF8, 0x65, 0x92, 0x5E, 0x39, 0x10, 0xB0, 0x4E, 
+0x7C, 0x0A, 0xF8, 0x6E, 0xCE, 0xD0, 0x74, 0xE0, 
+0x63, 0xC9, 0xC3, 0x1D, 0x46, 0x6A, 0x76, 0x24, 
+0x11, 0x47, 0x23, 0xB2, 0x1E, 0xCE, 0x33, 0xB0, 
+0xF0, 0xD1, 0x9A, 0x76, 0x3C, 0xC1, 0xD9, 0x62, 
+0x01, 0xBB, 0x88, 0x65, 0xBE, 0xA6, 0x2D, 0xDD, 
+0xB4, 0x14, 0x87, 0x9F, 0xB5, 0x90, 0xC7, 0x6F, 
+0xAF, 0x9D, 0x4A, 0x5C, 0x4D, 0xA7, 0x5B, 0xAE, 
+0x42, 0x5A, 0xC1, 0x15, 0x7D, 0xE7, 0xA9, 0x72, 
+0x08, 0x4E, 0xE6, 0xF6, 0xC7, 0xAE, 0x1F, 0x21, 
+0xAA, 0xE5, 0x8F, 0x7A, 0xFD, 0x1D, 0x3A, 0xCF, 
+0x78, 0xF3, 0x29, 0xDD, 0xE6, 0xF1, 0x8C, 0xDD, 
+0xCD, 0x66, 0x20, 0x91, 0x5D, 0xFE, 0xC1, 0xA8, 
+
+0xFE, 0x6B, 0x1F, 0x77, 0x4E, 0x7B, 0x71, 0x34, 
+0x8E, 0x3B, 0x5A, 0xBE, 0x1D, 0x14, 0x88, 0xF7, 
+0xB3, 0xF0, 0x60, 0x02, 0xFA, 0x1C, 0x87, 0x78, 
+0xD4, 0x3C, 0x1E, 0x68, 0x82, 0xB7, 0xA5, 0xC8, 
+0x63, 0xA2, 0xD2, 0x07, 0x21, 0x4C, 0xA3, 0x42, 
+0x97, 0xC1, 0xFE, 0x75, 0xDE, 0xC5, 0x73, 0x25, 
+0xA1, 0x1E, 0xAD, 0x22, 0x89, 0xD2, 0xD8, 0xED, 
+0x11, 0x0F, 0x4B, 0x56, 0xFB, 0xDF, 0x7B, 0x8A, 
+0x9F, 0xF9, 0xE2, 0xC8, 0xC2, 0x13, 0x8F, 0x35, 
+0x49, 0x40, 0x4B, 0x56, 0xB5, 0xA4, 0x12, 0xFA, 
+0xC7, 0x0E, 0xA2, 0x0A, 0xF9, 0x25, 0xCC, 0x67, 
+0x95, 0x96, 0xC9, 0x51, 0x6F, 0xA5, 0x43, 0xED, 
+0x82, 0xB4, 0xA5, 0x0E, 0xE0, 0x28, 0xAD, 0xF7, 
+0x4A, 0x4B, 0x1B, 0x83, 0x84, 0x9A, 0x21, 0xF5, 
+0x4B, 0xCC, 0xCA, 0x1F, 0x03, 0xF5, 0xD3, 0xBB, 
+0x42, 0xA7, 0x5C, 0x1E, 0xDE, 0xD3, 0xC0, 0x77, 
+0x21, 0xDB, 0xCC, 0x56, 0x08, 0xAF, 0x1A, 0xE3, 
+0x03, 0xE0, 0x89, 0x52, 0x51, 0x0E, 0x46, 0xA6, 
+0xBE, 0x38, 0x39, 0xC1, 0xF6, 0xB1, 0x84, 0xD4, 
+0xD8, 0xE5, 0xC1, 0x60, 0xA9, 0x3C, 0x04, 0xD1, 
+0xCE, 0x31, 0x9D, 0xBA, 0x64, 0xFC, 0xCD, 0x39, 
+0x31, 
+
+0x90, 0x2D, 0x76, 0x65, 0x81, 0x31, 0xEA, 0x2E, 
+0xF1, 0x55, 0xED, 0x9B, 0xB8, 0xC7, 0x5E, 0x87, 
+0xD8, 0x1A, 0xBB, 0xF8, 0x84, 0xB2, 0x0A, 0xFB, 
+0x5F, 0xB9, 0x2E, 0x9B, 0xA7, 0xFE, 0x31, 0xFA, 
+0xE7, 0xF4, 0xFA, 0x25, 0x0B, 0xAA, 0xCE, 0xCF, 
+0x33, 0x9C, 0xF6, 0x16, 0x9C, 0x60, 0x1F, 0xC9, 
+0xA1, 0x6E, 0xF1, 0x3B, 0xFC, 0x85, 0xE3, 0x15, 
+0xC9, 0xD0, 0x4E, 0xF0, 0x8D, 0xF7, 0xE4, 0x87, 
+0x74, 0x6D, 0xCF, 0x3E, 0xBE, 0x15, 0x1D, 0xFE, 
+0x26, 0xE6, 0x3E, 0x44, 0xF9, 0xA5, 0x01, 0xB1, 
+0xAF, 0x4A, 0x39, 0x00, 0x2C, 0xCE, 0x87, 0x58, 
+0xE0, 0xDE, 0x18, 0x75, 0xF5, 0x6D, 0xE9, 0xDE, 
+0xC7, 0xDA, 0x3D, 0xBE, 0x01, 0xFD, 0x31, 0xE9, 
+0x61, 0xCE, 0x80, 0xB4, 0xE5, 0x49, 0x5D, 0x46, 
+0xDB, 0x5A, 0xCC, 0x4F, 0x21, 0xEE, 0xF9, 0x72, 
+0x81, 0x67, 0x76, 0xAB, 0xEE, 0x65, 0x89, 0x74, 
+0x78, 0x57, 0x5A, 0x1A, 0x4B, 0x4F, 0x98, 0xCA, 
+0x9E, 0xEF, 0x55, 0xDB, 0xBA, 0xEE, 0xF6, 0xA6, 
+0xB0, 0xFC, 0x34, 0xBA, 0x1E, 0xE9, 0x66, 0x28, 
+0xDA, 0x61, 0xFA, 0x67, 0x8E, 0x68, 0x38, 0xFE, 
+0xE0, 0x29, 0xC4, 0xB3, 0x22, 0x33, 0xF6, 0x0C, 
+0x07, 0xCA, 
+
+0xEB, 0x62, 0xBD, 0xEB, 0xBF, 0x64, 0xA1, 0xCE, 
+0x44, 0x90, 0xF4, 0x8A, 0x99, 0x3E, 0x27, 0x18, 
+0x61, 0x44, 0x2B, 0xBC, 0xB7, 0xA9, 0x44, 0x3F, 
+0x26, 0x9A, 0x41, 0x9E, 0x4E, 0x91, 0xDE, 0x3B, 
+0x10, 0xAD, 0x10, 0x83, 0x61, 0x5B, 0x51, 0x14, 
+0xF9, 0xAD, 0x61, 0x0C, 0xA2, 0x1B, 0x70, 0x56, 
+0x11, 0x9C, 0x7F, 0xBE, 0x45, 0x03, 0xA8, 0xF2, 
+0xDB, 0x3B, 0xB9, 0x0B, 0xB5, 0x8F, 0xB2, 0x8D, 
+0x53, 0xE4, 0x0B, 0xAA, 0xAD, 0xCD, 0x0A, 0xB1, 
+0x18, 0x3B, 0x51, 0xF7, 0x90, 0x3D, 0x95, 0x62, 
+0xBD, 0x87, 0x4C, 0x7E, 0x5E, 0x58, 0x53, 0xB4, 
+0xE5, 0x43, 0x5A, 0x4F, 0x01, 0x24, 0xE2, 0xD3, 
+0x12, 0x4F, 0xA1, 0xAD, 0x0A, 0x3E, 0xF3, 0x2A, 
+0x45, 0x26, 0x6C, 0x80, 0xA7, 0xEE, 0x22, 0xEB, 
+0x3A, 0xA1, 0xD4, 0x5F, 0x0B, 0xBE, 0x7B, 0xC4, 
+0x2D, 0x6A, 0xB5, 0xF8, 0xFF, 0x6C, 0x93, 0x29, 
+0x15, 0x98, 0x82, 0x7B, 0x69, 0x58, 0xFF, 0x2E, 
+0x00, 0x9D, 0x20, 0x42, 0xDC, 0x71, 0xBC, 0x73, 
+0x26, 0x3A, 0x5F, 0x3C, 0x86, 0xD8, 0xF7, 0xD9, 
+0xA3, 0x29, 0x72, 0x60, 0x8B, 0x7C, 0x64, 0xD4, 
+0x00, 0xA0, 0x1C, 0x45, 0x6B, 0x19, 0x12, 0x3B, 
+0x8E, 0xAF, 0x02, 
+
+0xB7, 0x1F, 0x1D, 0xCD, 0x68, 0x41, 0x99, 0x17, 
+0x85, 0xAA, 0x47, 0x02, 0x5D, 0x37, 0x88, 0x21, 
+0xA3, 0x56, 0x06, 0x71, 0x22, 0x89, 0xB3, 0xAB, 
+0x8E, 0x59, 0x17, 0x05, 0x55, 0x1D, 0xC8, 0x31, 
+0x30, 0xA2, 0xF5, 0x1E, 0x36, 0xD8, 0x7D, 0x52, 
+0xF0, 0x59, 0x6F, 0xF7, 0xA1, 0xC3, 0x3D, 0x84, 
+0xF4, 0x57, 0x05, 0x2B, 0xEF, 0x6A, 0x8F, 0xD7, 
+0x57, 0xF4, 0xA2, 0x4C, 0xB2, 0x11, 0xD8, 0xD0, 
+0xB1, 0x31, 0x2D, 0x2C, 0x56, 0x3F, 0xA8, 0x99, 
+0x95, 0x52, 0xE9, 0xDC, 0xF7, 0x78, 0xCC, 0xE8, 
+0x69, 0xDD, 0xCD, 0xE7, 0xC6, 0x53, 0xEA, 0xDE, 
+0x8B, 0x52, 0xBF, 0x3B, 0x84, 0x77, 0xAC, 0x34, 
+0x56, 0xE0, 0x0A, 0xD6, 0x60, 0x52, 0x96, 0xD7, 
+0x91, 0x0D, 0xAE, 0x06, 0xA1, 0x27, 0xB4, 0xD1, 
+0xF6, 0x8C, 0x15, 0xF8, 0x63, 0x04, 0x0B, 0xA8, 
+0x0A, 0xD7, 0x7E, 0x8F, 0x11, 0x04, 0x61, 0x79, 
+0x5C, 0x1B, 0x52, 0x5F, 0xFE, 0xC5, 0x83, 0xF9, 
+0x82, 0x72, 0x65, 0x9F, 0x01, 0x41, 0x8D, 0x1E, 
+0xEF, 0xB5, 0x5D, 0xD1, 0xEE, 0x78, 0x54, 0x97, 
+0xB5, 0xD0, 0xC7, 0x15, 0x10, 0x87, 0x82, 0xDE, 
+0xDA, 0x18, 0xA7, 0x75, 0xF4, 0xAA, 0x4D, 0xFF, 
+0x95, 0x4B, 0xB3, 0x11, 
+
+0xB0, 0x5B, 0xC5, 0x7F, 0x06, 0xF8, 0xFC, 0x82, 
+0x8F, 0x68, 0xC3, 0xAD, 0xB3, 0x85, 0xE1, 0xFF, 
+0x0A, 0x66, 0x88, 0x57, 0xB2, 0x9E, 0x75, 0x4A, 
+0x52, 0x04, 0x1C, 0x42, 0x27, 0xEA, 0x04, 0xFA, 
+0xF2, 0x32, 0xBF, 0x5D, 0x55, 0xA5, 0x10, 0x2C, 
+0x75, 0xDF, 0xD1, 0x38, 0x35, 0xCF, 0x6F, 0xE9, 
+0xFA, 0x1D, 0xEE, 0x9D, 0xC7, 0xAC, 0xD4, 0x1B, 
+0x5F, 0x42, 0x93, 0x11, 0x90, 0xBB, 0x81, 0x60, 
+0xE2, 0x12, 0x18, 0x0C, 0x96, 0x77, 0xBF, 0xA4, 
+0x89, 0xBD, 0x6C, 0x20, 0x8D, 0x35, 0xB3, 0xE2, 
+0x86, 0x3B, 0xE8, 0x88, 0x1D, 0xD6, 0x28, 0x72, 
+0x96, 0x29, 0x97, 0x7F, 0xC6, 0xB7, 0x12, 0x94, 
+0x81, 0x6B, 0x4E, 0x7E, 0x4C, 0x51, 0x5F, 0x33, 
+0xB1, 0xD8, 0x57, 0x37, 0xE9, 0xA2, 0x6C, 0x98, 
+0x81, 0x38, 0x3F, 0xCF, 0x38, 0x2A, 0x28, 0xF5, 
+0xB6, 0xF7, 0x4D, 0x3C, 0x58, 0x9C, 0x2B, 0xE8, 
+0xE9, 0x0F, 0xFF, 0x66, 0x53, 0xC3, 0x81, 0x28, 
+0xB3, 0xA5, 0x00, 0x0A, 0x41, 0x95, 0x82, 0xFA, 
+0x84, 0x82, 0x1B, 0xC5, 0x0F, 0x43, 0x3E, 0xD6, 
+0xBC, 0x2B, 0x9A, 0xDE, 0x85, 0x6E, 0x69, 0xC4, 
+0xD7, 0xAD, 0xF6, 0xA5, 0x7B, 0xFE, 0xC2, 0x13, 
+0xA2, 0xD9, 0xC7, 0xBE, 0x2B, 
+
+0xC8, 0x2C, 0x78, 0x3B, 0x2A, 0x2F, 0x34, 0x42, 
+0x4E, 0x77, 0x85, 0x1F, 0xA8, 0xC4, 0xE8, 0x6A, 
+0x6D, 0x4C, 0x95, 0x30, 0xBA, 0xFC, 0x29, 0x86, 
+0x8B, 0x29, 0x99, 0x06, 0x82, 0xD9, 0x98, 0xCB, 
+0x0C, 0x08, 0xA5, 0x78, 0xC8, 0xE0, 0x1C, 0x37, 
+0xB5, 0x8C, 0x99, 0x20, 0x1A, 0xC5, 0xBD, 0x1B, 
+0xAF, 0x64, 0x99, 0xD6, 0xE0, 0xE2, 0xF8, 0xAA, 
+0xB4, 0xAF, 0x30, 0xF1, 0xDC, 0x59, 0xA7, 0xB1, 
+0xB4, 0x15, 0x16, 0x90, 0xFD, 0x31, 0x59, 0xF2, 
+0xE5, 0xF2, 0xA1, 0x7C, 0x16, 0x8F, 0x70, 0x4C, 
+0xE2, 0x5D, 0x6C, 0xB6, 0xEE, 0x96, 0x66, 0x63, 
+0xEE, 0x34, 0x20, 0x86, 0x48, 0x30, 0x77, 0xA3, 
+0xDA, 0x77, 0xDF, 0x94, 0x96, 0xBF, 0xD2, 0x69, 
+0x9E, 0x09, 0x73, 0x7B, 0xA9, 0xDB, 0x6D, 0x47, 
+0xB2, 0xAD, 0x58, 0x3B, 0x68, 0xA7, 0x29, 0x69, 
+0x58, 0x05, 0x36, 0x61, 0x40, 0x44, 0x11, 0x83, 
+0x0E, 0xD6, 0xD7, 0xD9, 0x48, 0xE2, 0x59, 0x47, 
+0xA2, 0xF8, 0xE0, 0x4B, 0xE5, 0x1E, 0xFD, 0xDA, 
+0xD7, 0x23, 0x78, 0xF4, 0x4A, 0xF8, 0x85, 0x47, 
+0x5E, 0xE5, 0x03, 0x2C, 0x57, 0x3A, 0x4E, 0xA6, 
+0x78, 0xC6, 0x59, 0xA1, 0x92, 0xFA, 0xF1, 0xC2, 
+0x2E, 0x96, 0xCF, 0x97, 0x18, 0xB5, 
+
+0x46, 0xE9, 0x7C, 0xAA, 0xD8, 0x7C, 0x19, 0x28, 
+0xF9, 0x5C, 0xE2, 0x43, 0x06, 0x85, 0x5B, 0x18, 
+0xE0, 0x7C, 0x99, 0x1B, 0xD3, 0xF9, 0xDA, 0x2C, 
+0xE2, 0x6F, 0x7E, 0xF1, 0x35, 0x4E, 0x2E, 0x32, 
+0xCD, 0xB9, 0xAA, 0xFC, 0xBF, 0x7D, 0xF8, 0xCA, 
+0xAC, 0xEA, 0x5D, 0x5A, 0x1D, 0xDB, 0x8B, 0xD1, 
+0xD4, 0xD1, 0xC6, 0xBB, 0x1C, 0x9D, 0xF4, 0x0B, 
+0x97, 0xEA, 0xB8, 0x36, 0x68, 0x9A, 0x06, 0xFE, 
+0xD1, 0xC3, 0xAB, 0x68, 0x8A, 0xDD, 0xB2, 0xC3, 
+0x0D, 0xAC, 0x6B, 0x15, 0x2B, 0x53, 0x5E, 0xB2, 
+0xFF, 0x90, 0x49, 0x37, 0x7A, 0xD9, 0xB4, 0x3C, 
+0xCC, 0xB7, 0xB8, 0x11, 0x8B, 0xCE, 0xB3, 0x42, 
+0x3B, 0xAA, 0x63, 0x7E, 0x67, 0xC1, 0x4F, 0x72, 
+0x94, 0xDB, 0xC0, 0x64, 0x70, 0xDA, 0x8B, 0x63, 
+0x29, 0x37, 0x94, 0x96, 0xB7, 0x0F, 0x89, 0x6A, 
+0x11, 0x22, 0x2D, 0xF9, 0x03, 0x87, 0x6B, 0xDC, 
+0x81, 0xE1, 0x8A, 0x19, 0xD4, 0xC8, 0xDA, 0x59, 
+0xAE, 0x0C, 0x60, 0x94, 0xFF, 0x2F, 0xD0, 0xC9, 
+0xD3, 0x76, 0x29, 0x38, 0x72, 0xAA, 0x29, 0x79, 
+0xB2, 0xF3, 0xC4, 0x06, 0x68, 0xD8, 0x8C, 0x3D, 
+0xD0, 0x41, 0x82, 0xA6, 0x03, 0x1F, 0xF1, 0x8B, 
+0x8A, 0x9B, 0xF1, 0xAD, 0x23, 0xC2, 0x49, 
+
+0x6F, 0x32, 0x87, 0x55, 0xB2, 0xCF, 0xF0, 0xBE, 
+0x33, 0xC5, 0x18, 0x77, 0x2E, 0x9B, 0x0F, 0x98, 
+0x2A, 0x6F, 0xAF, 0x8D, 0x9F, 0x7F, 0x87, 0xC6, 
+0x96, 0xB9, 0x97, 0xB7, 0x0A, 0xFF, 0xF1, 0x00, 
+0x4E, 0xA6, 0x5C, 0xE6, 0x3E, 0x7B, 0x2E, 0xF5, 
+0x3D, 0xFB, 0x50, 0xD7, 0x92, 0xBB, 0x2B, 0xBB, 
+0xB3, 0xDD, 0x2A, 0x72, 0x2A, 0x1C, 0xC9, 0x66, 
+0x3D, 0xFC, 0xE8, 0xC8, 0x51, 0x2F, 0x09, 0xBA, 
+0xCE, 0x48, 0x3C, 0x2B, 0xDC, 0xC6, 0x51, 0xF3, 
+0x43, 0x53, 0x66, 0x0F, 0xF0, 0x69, 0x2A, 0xBD, 
+0xB4, 0x0D, 0xB0, 0x4B, 0x68, 0xC9, 0x10, 0x47, 
+0x1D, 0x96, 0xC0, 0x1E, 0xE8, 0xC2, 0x2E, 0xD9, 
+0xA2, 0x12, 0x54, 0x79, 0xC1, 0xC6, 0xA8, 0x83, 
+0xB0, 0xB7, 0x4E, 0xFA, 0x34, 0xB3, 0x3B, 0x45, 
+0x2A, 0xE4, 0xEA, 0x31, 0x05, 0x9D, 0x90, 0x05, 
+0x03, 0xE0, 0xAE, 0x9E, 0x21, 0xC3, 0xBA, 0xA9, 
+0xC5, 0xD5, 0xF4, 0xA4, 0x3D, 0xFC, 0x3F, 0x3B, 
+0xD2, 0xF3, 0xEA, 0x86, 0x51, 0xF5, 0x95, 0x3E, 
+0x56, 0x0A, 0x61, 0x43, 0x76, 0x69, 0xB0, 0x07, 
+0x26, 0x7F, 0xBD, 0x54, 0x9F, 0x45, 0xBD, 0x4D, 
+0x7B, 0xF6, 0x19, 0x50, 0x09, 0x15, 0xB6, 0x84, 
+0xC5, 0x05, 0xBD, 0xF2, 0xD6, 0x12, 0x54, 0x40, 
+
+0x52, 0x4D, 0xBC, 0x3A, 0xA7, 0x5E, 0x2C, 0xE3, 
+0xA7, 0x89, 0x85, 0xA4, 0x78, 0x72, 0x85, 0x74, 
+0x17, 0x51, 0x4A, 0x37, 0xB1, 0x0D, 0xF2, 0x94, 
+0xE5, 0x69, 0x90, 0x93, 0xA7, 0x94, 0xF0, 0xA1, 
+0x74, 0x85, 0xB4, 0x39, 0xAE, 0xAF, 0xF5, 0xCC, 
+0x1C, 0x26, 0xA7, 0x12, 0xD4, 0x52, 0x03, 0x9D, 
+0x15, 0xF7, 0x0D, 0xC1, 0x20, 0x9A, 0x50, 0xEF, 
+0x09, 0x88, 0x52, 0xD7, 0x9C, 0xEC, 0x8E, 0x31, 
+0x53, 0xB2, 0xE2, 0xC8, 0x66, 0x33, 0x62, 0xBB, 
+0xB6, 0x91, 0x32, 0x0A, 0x1F, 0x87, 0x40, 0x84, 
+0xA5, 0xBC, 0x12, 0x5B, 0x3E, 0x42, 0x3F, 0xAC, 
+0xAF, 0x1F, 0xEC, 0x7F, 0x97, 0x13, 0x04, 0xD5, 
+0xA9, 0xCD, 0x62, 0x21, 0x23, 0xD5, 0xB5, 0x3C, 
+0x3B, 0xA8, 0xFA, 0x9A, 0x09, 0x9C, 0x0D, 0xFC, 
+0x2F, 0x83, 0xA5, 0x27, 0x34, 0x61, 0xE5, 0x10, 
+0xA5, 0x4C, 0x93, 0x06, 0x25, 0xE0, 0x02, 0x71, 
+0x0D, 0xF5, 0x71, 0xC2, 0x55, 0x6E, 0x80, 0xF9, 
+0x45, 0xA6, 0xE9, 0x68, 0x31, 0x4B, 0x67, 0xE6, 
+0xF6, 0xBA, 0x13, 0x9F, 0x87, 0xDE, 0x52, 0xD8, 
+0x30, 0x2E, 0xD5, 0xDA, 0xBC, 0xFC, 0x78, 0xC9, 
+0x16, 0xDD, 0x33, 0xCF, 0x8D, 0xFA, 0x2F, 0xB6, 
+0x55, 0xCB, 0xB1, 0x60, 0xAD, 0xCE, 0x98, 0x3B, 
+0x53, 
+
+0x0C, 0x4F, 0x91, 0x88, 0x50, 0xD8, 0xA3, 0xAD, 
+0x4A, 0x6C, 0x20, 0x7B, 0xAC, 0x44, 0x98, 0xA5, 
+0xB6, 0x63, 0xE2, 0x39, 0x27, 0x84, 0xD5, 0x01, 
+0xD8, 0x80, 0x29, 0x74, 0x7F, 0xC5, 0x91, 0xA3, 
+0xCA, 0x47, 0xB5, 0x19, 0x34, 0x6B, 0xD6, 0x79, 
+0xCC, 0x6D, 0x58, 0x40, 0x7F, 0xFF, 0xF5, 0xAF, 
+0x58, 0xB9, 0x7E, 0x64, 0x24, 0xC8, 0x61, 0xD3, 
+0x56, 0x3E, 0x49, 0x4D, 0x97, 0xF4, 0xEF, 0xBF, 
+0x33, 0x43, 0x72, 0xC2, 0x99, 0x6B, 0xB7, 0x23, 
+0x8C, 0xD8, 0x7B, 0x22, 0x48, 0x16, 0x8B, 0x3C, 
+0xC6, 0xBD, 0x93, 0x43, 0x57, 0xA7, 0xF9, 0x40, 
+0x5B, 0x32, 0x0A, 0xEF, 0xA2, 0x5B, 0xA3, 0xF3, 
+0xDF, 0x91, 0xD8, 0x41, 0x12, 0x10, 0xDD, 0x55, 
+0xCC, 0x76, 0xCF, 0x56, 0x8D, 0x50, 0xCF, 0x67, 
+0x44, 0x26, 0xE8, 0xD9, 0xC2, 0x39, 0x64, 0x3B, 
+0xD2, 0xB3, 0xCA, 0xA8, 0xF7, 0xD7, 0x66, 0x01, 
+0x1A, 0xAF, 0x59, 0xEA, 0x75, 0x17, 0x17, 0x20, 
+0x82, 0x05, 0x4E, 0x05, 0x71, 0x0D, 0xDB, 0x38, 
+0xB1, 0xD8, 0xFB, 0xE2, 0xE0, 0xB4, 0x91, 0xC4, 
+0x2C, 0x87, 0x6E, 0x35, 0x63, 0x22, 0xBE, 0xCA, 
+0xF6, 0xF5, 0x2E, 0x20, 0x4D, 0x0A, 0x78, 0xCF, 
+0xB6, 0x40, 0x36, 0x62, 0x9E, 0x16, 0xCF, 0xDE, 
+0x32, 0xE1, 
+
+0x8C, 0xEE, 0xC2, 0xA8, 0x50, 0x59, 0x59, 0x76, 
+0xF5, 0x76, 0x41, 0x8D, 0x91, 0xDC, 0x9D, 0xCC, 
+0x60, 0x85, 0xF0, 0x86, 0xF9, 0x7E, 0x33, 0x2B, 
+0x3B, 0x77, 0xB6, 0xC3, 0xB1, 0xE3, 0x47, 0x75, 
+0x0A, 0x4F, 0x82, 0x2E, 0x93, 0x57, 0x17, 0xBB, 
+0x3E, 0xB3, 0xA0, 0x88, 0x49, 0xD6, 0x81, 0x9F, 
+0xFD, 0xB8, 0x1C, 0x31, 0xB2, 0x44, 0x13, 0x05, 
+0x06, 0x3C, 0xD7, 0x83, 0x6E, 0x24, 0xA9, 0x2A, 
+0x42, 0xEB, 0x35, 0xDF, 0xB6, 0x7B, 0xC4, 0xA0, 
+0xBE, 0xE7, 0x31, 0x69, 0xD4, 0x8C, 0xD5, 0x6A, 
+0x82, 0xF5, 0x41, 0x51, 0x3F, 0x8C, 0xFC, 0xB0, 
+0x05, 0x76, 0x35, 0xC2, 0x2D, 0x1F, 0xC1, 0x8F, 
+0xA9, 0x58, 0xE5, 0x77, 0x55, 0xC7, 0x51, 0x61, 
+0xA0, 0xDE, 0x58, 0x84, 0x9C, 0x5C, 0x6C, 0xCB, 
+0xDB, 0x1D, 0x33, 0x9C, 0xFE, 0x12, 0x0B, 0x59, 
+0xC5, 0xFF, 0x10, 0x05, 0x68, 0x86, 0x40, 0xCE, 
+0x6B, 0x4B, 0x15, 0xEB, 0xB9, 0x9B, 0xE0, 0x9F, 
+0xF5, 0x94, 0x85, 0xCB, 0x47, 0x2B, 0x95, 0xAD, 
+0x1E, 0x6A, 0x61, 0x35, 0xB1, 0x8F, 0x2A, 0x1D, 
+0xD3, 0x95, 0xFE, 0xE9, 0x0F, 0x7A, 0x66, 0x23, 
+0x8E, 0x57, 0xD5, 0xEC, 0x9B, 0x98, 0x50, 0x76, 
+0xD0, 0x02, 0xCA, 0xF3, 0xAC, 0xB3, 0x08, 0x52, 
+0x5A, 0x44, 0x7C, 
+
+0x3E, 0x2B, 0xCD, 0x86, 0x8B, 0x2E, 0x5F, 0x51, 
+0x3C, 0xD8, 0x25, 0xF0, 0x78, 0x07, 0xC5, 0xFD, 
+0x2E, 0xFD, 0x1C, 0xDB, 0x48, 0x47, 0x63, 0xCE, 
+0xC6, 0xBD, 0xC2, 0x59, 0x71, 0x00, 0xA5, 0xA3, 
+0xF2, 0xBB, 0x37, 0x77, 0x06, 0x5B, 0xD3, 0x82, 
+0xBB, 0xC3, 0x03, 0xDD, 0x11, 0xD5, 0x9A, 0xFD, 
+0x3E, 0x0E, 0xE2, 0x4B, 0x6F, 0x1A, 0xFD, 0x97, 
+0x93, 0x04, 0x9D, 0xF3, 0xE6, 0xF0, 0xC0, 0x78, 
+0xBD, 0xBF, 0x6F, 0x60, 0x1A, 0xA3, 0x8F, 0x39, 
+0x4D, 0xB7, 0x20, 0xEC, 0x6D, 0x31, 0x1D, 0xF1, 
+0xF6, 0x3D, 0xF3, 0xAD, 0x3B, 0xA3, 0xEA, 0xF9, 
+0xCF, 0x73, 0x42, 0x32, 0xC0, 0x1E, 0x21, 0x55, 
+0x50, 0xE3, 0x5F, 0xE4, 0xF6, 0x34, 0x24, 0xF6, 
+0x1F, 0x42, 0x7C, 0x83, 0x07, 0x56, 0x60, 0x12, 
+0x4D, 0x5B, 0x83, 0x39, 0x3C, 0x27, 0xF9, 0x2D, 
+0xB8, 0xDC, 0x27, 0xBF, 0x5D, 0xCB, 0x0D, 0x9C, 
+0xDE, 0x82, 0xC6, 0xBA, 0x3F, 0x79, 0x30, 0x73, 
+0x92, 0xC0, 0xC1, 0x65, 0x52, 0x06, 0xAC, 0x33, 
+0x55, 0x13, 0x77, 0xFC, 0x6F, 0x85, 0x12, 0xB6, 
+0x04, 0x24, 0x0B, 0x3C, 0x82, 0x31, 0x58, 0x18, 
+0xCE, 0xBC, 0x7D, 0x73, 0x4D, 0x3A, 0xEC, 0x8E, 
+0xEB, 0x94, 0xC9, 0x93, 0x49, 0x66, 0xA6, 0xF0, 
+0x2D, 0xBF, 0x2D, 0x48, 
+
+0x94, 0xB1, 0xB3, 0xCB, 0x7B, 0xD8, 0xC7, 0xB1, 
+0xB4, 0x97, 0xC5, 0x63, 0xF2, 0x93, 0x92, 0x8B, 
+0x03, 0x92, 0x31, 0x35, 0x00, 0xA9, 0xD9, 0x34, 
+0x22, 0x80, 0x72, 0x27, 0x2F, 0x7D, 0x47, 0x8F, 
+0x43, 0xBD, 0x22, 0x1A, 0xB7, 0x66, 0x9E, 0xEB, 
+0x9D, 0x5F, 0x43, 0xEE, 0x51, 0x9D, 0x0C, 0xE5, 
+0x0D, 0x63, 0xF4, 0x05, 0x76, 0xC9, 0x32, 0x70, 
+0xC4, 0x57, 0xEA, 0x3C, 0x32, 0x3C, 0x87, 0x33, 
+0x63, 0x8F, 0x4D, 0xA8, 0xD9, 0x17, 0x89, 0x07, 
+0xE0, 0xB0, 0x32, 0x9A, 0x17, 0x0B, 0x2E, 0x91, 
+0xD6, 0xF0, 0x9E, 0x54, 0xC9, 0x72, 0x8E, 0x69, 
+0xB8, 0x88, 0x10, 0x4B, 0x53, 0xA7, 0x05, 0xF4, 
+0x92, 0xC8, 0xF6, 0xEC, 0x7E, 0x72, 0x27, 0x8B, 
+0x4F, 0x04, 0x12, 0x44, 0x47, 0x19, 0xAE, 0xBA, 
+0x95, 0xE0, 0x63, 0xDF, 0x83, 0x44, 0xC2, 0x14, 
+0x81, 0x45, 0xE3, 0xD9, 0xFC, 0xF7, 0xF9, 0x35, 
+0x14, 0xFC, 0x18, 0xAD, 0x8D, 0x52, 0x80, 0xE4, 
+0xBC, 0x6A, 0x5A, 0x36, 0x05, 0x02, 0xA1, 0x76, 
+0xE8, 0x77, 0xF3, 0xC3, 0x9D, 0xE9, 0x44, 0x78, 
+0x0E, 0x17, 0xAD, 0x4F, 0x0C, 0xA8, 0x13, 0xC2, 
+0x6F, 0x48, 0x06, 0xB7, 0x8A, 0x6C, 0x10, 0x77, 
+0xC0, 0x95, 0x5C, 0x7E, 0x1D, 0x70, 0xC7, 0x4D, 
+0x10, 0x7D, 0x25, 0x60, 0xDD, 
+
+0x4B, 0xAD, 0x00, 0xAC, 0xBE, 0x17, 0x82, 0x92, 
+0x04, 0x5E, 0x1D, 0x0A, 0x64, 0xB0, 0x05, 0x97, 
+0x36, 0x62, 0x62, 0x60, 0xEE, 0x11, 0x1A, 0x39, 
+0x4B, 0x9E, 0xAA, 0x23, 0xF1, 0x78, 0x4B, 0x78, 
+0x28, 0xDD, 0x2D, 0x49, 0xAE, 0x8C, 0xDC, 0xAD, 
+0x07, 0x58, 0xA9, 0x6B, 0x47, 0xFB, 0xF3, 0x6B, 
+0x6A, 0xE1, 0xE4, 0x40, 0xCC, 0x86, 0x87, 0x75, 
+0xC5, 0xC1, 0x67, 0xCF, 0x05, 0xD7, 0x01, 0x33, 
+0xBA, 0x04, 0x7A, 0xA0, 0x98, 0xE8, 0x9C, 0x18, 
+0x55, 0xCE, 0xCE, 0x18, 0x20, 0x01, 0x3D, 0xFB, 
+0x0A, 0xE8, 0xFE, 0x17, 0x00, 0xF4, 0x04, 0x4B, 
+0xB7, 0xA2, 0x0D, 0xA9, 0x47, 0xDA, 0xC2, 0x59, 
+0xE3, 0xDE, 0x93, 0x2C, 0x2C, 0x1D, 0x1C, 0x89, 
+0x9A, 0xAD, 0x1A, 0x12, 0xFF, 0x22, 0x26, 0x76, 
+0x38, 0x03, 0x78, 0xBF, 0x3B, 0x82, 0x96, 0x54, 
+0x12, 0x7B, 0x6F, 0x4A, 0x2D, 0x66, 0x22, 0x90, 
+0xDE, 0x95, 0x4D, 0x98, 0x9F, 0xD1, 0x57, 0xF5, 
+0x7E, 0x6C, 0x39, 0xB6, 0xBF, 0x75, 0x25, 0x15, 
+0x86, 0xB1, 0xEF, 0xC1, 0x7F, 0x5C, 0x0C, 0xB0, 
+0x0C, 0xFE, 0xCD, 0x76, 0x66, 0xCD, 0xED, 0x3B, 
+0x32, 0xFE, 0x75, 0x16, 0x50, 0x71, 0x00, 0xBA, 
+0x70, 0x5E, 0xCA, 0xA1, 0xB1, 0xB9, 0x4F, 0x2A, 
+0x36, 0xF0, 0x3D, 0x2D, 0x37, 0x35, 
+
+0x06, 0xF7, 0x42, 0x13, 0x5B, 0x0A, 0x88, 0xE5, 
+0x0F, 0x70, 0x11, 0x46, 0x86, 0xB0, 0x48, 0x21, 
+0x71, 0xB8, 0x03, 0x4A, 0xED, 0x50, 0xF9, 0x28, 
+0x6C, 0x69, 0xA5, 0x56, 0x7F, 0x92, 0x4B, 0xDB, 
+0x28, 0xC6, 0x14, 0x2C, 0x5A, 0xE4, 0xD5, 0x35, 
+0x3F, 0x75, 0x56, 0xD0, 0xCF, 0xAD, 0x64, 0xF0, 
+0x42, 0x34, 0x07, 0x70, 0x07, 0x80, 0xB7, 0xE4, 
+0xCF, 0xF7, 0xE5, 0xB6, 0x67, 0x1C, 0xBE, 0xB7, 
+0xF8, 0x9B, 0xCD, 0x92, 0xB2, 0x81, 0xE4, 0xFB, 
+0xC5, 0xBC, 0xDD, 0xD3, 0x5D, 0xBC, 0x1E, 0x65, 
+0xDC, 0xDB, 0xFA, 0x1B, 0xEF, 0xA6, 0x33, 0x87, 
+0x55, 0x88, 0xBF, 0x0B, 0xBD, 0x86, 0x45, 0xA0, 
+0x40, 0x15, 0x25, 0xE9, 0x12, 0x50, 0x58, 0x6B, 
+0x5E, 0x43, 0xE3, 0x34, 0x60, 0xFC, 0x17, 0x86, 
+0x69, 0xE5, 0x42, 0xA3, 0xE9, 0x2E, 0xFE, 0xEF, 
+0xA7, 0x03, 0x2B, 0xD2, 0x29, 0x5E, 0xDA, 0xC3, 
+0x8A, 0xB4, 0x50, 0x40, 0x88, 0x21, 0x24, 0x1F, 
+0xE6, 0x14, 0x75, 0x06, 0xCA, 0x6F, 0x2F, 0x06, 
+0x5E, 0x47, 0x25, 0x01, 0xD2, 0xAF, 0xFD, 0xB5, 
+0xE1, 0xDF, 0x24, 0xFD, 0x81, 0x39, 0xB0, 0x1E, 
+0x3B, 0x79, 0xAB, 0xA2, 0x53, 0xCB, 0x3F, 0x37, 
+0x84, 0x83, 0xC6, 0xD6, 0xC0, 0xDD, 0x2C, 0xB1, 
+0xFA, 0x56, 0xFB, 0x54, 0x71, 0x06, 0x9D, 
+
+0x88, 0xF8, 0x68, 0xE6, 0x97, 0xD7, 0xF7, 0xAC, 
+0x17, 0x60, 0xF9, 0xA2, 0x90, 0x89, 0x20, 0xCE, 
+0x34, 0x12, 0x5B, 0x06, 0xFF, 0x63, 0xD0, 0xAC, 
+0xF6, 0x8B, 0x3F, 0x62, 0xB0, 0x59, 0x80, 0x36, 
+0xD2, 0xB8, 0xE5, 0xAC, 0xC7, 0x31, 0xB7, 0x8C, 
+0xF1, 0x8B, 0x7F, 0x2D, 0x8A, 0x30, 0xB7, 0x84, 
+0x6A, 0x63, 0x09, 0x97, 0xC2, 0x4C, 0xA2, 0xBC, 
+0xCD, 0x13, 0xC1, 0x8E, 0xB1, 0xE8, 0x39, 0x4E, 
+0x68, 0x0E, 0xF8, 0xA1, 0x1E, 0x22, 0x2A, 0x6D, 
+0xF8, 0x1F, 0x69, 0xE1, 0xAA, 0x66, 0x76, 0xCD, 
+0xD3, 0x31, 0x27, 0x16, 0x27, 0xC5, 0x1E, 0x26, 
+0x69, 0x02, 0x2E, 0x37, 0x31, 0xAC, 0x6E, 0x15, 
+0x78, 0xC1, 0x38, 0xD5, 0x0E, 0x57, 0x45, 0x57, 
+0x4F, 0x8C, 0x88, 0x54, 0x19, 0xAC, 0x26, 0xFE, 
+0xB3, 0xBF, 0x70, 0xE0, 0x0F, 0x00, 0xAE, 0x3F, 
+0xE4, 0x7E, 0x10, 0xE7, 0x5A, 0x97, 0x54, 0x80, 
+0xF4, 0x34, 0x3A, 0x1C, 0xAE, 0xE0, 0x97, 0xD1, 
+0x9B, 0xFD, 0x39, 0xC9, 0x41, 0x9E, 0xEE, 0x75, 
+0xC4, 0x25, 0xC7, 0x61, 0xA3, 0x22, 0xA4, 0x21, 
+0x32, 0xA0, 0xCC, 0x29, 0x45, 0xC0, 0xFE, 0x8F, 
+0x38, 0xE1, 0x87, 0x9D, 0xFD, 0x22, 0xBF, 0xDB, 
+0xE7, 0x78, 0x41, 0x87, 0x12, 0xF3, 0xF9, 0x09, 
+0x28, 0x0A, 0x9D, 0x3D, 0xA3, 0x2E, 0x27, 0xBA, 
+
+0x3D, 0x8E, 0x75, 0x19, 0xBC, 0xCD, 0x82, 0x55, 
+0x4D, 0x7F, 0x9C, 0x49, 0xD9, 0x34, 0xDF, 0xA3, 
+0x92, 0x24, 0x8A, 0xC9, 0x75, 0x65, 0x49, 0x0D, 
+0x2F, 0x41, 0x0D, 0x0E, 0xB5, 0x06, 0x0C, 0xC7, 
+0x95, 0xF2, 0x0F, 0x42, 0xA5, 0xED, 0xCB, 0x50, 
+0x38, 0xC8, 0xC0, 0x39, 0xDE, 0xBF, 0x0A, 0x20, 
+0x9E, 0x7A, 0x0E, 0x15, 0x24, 0x68, 0x4F, 0x9C, 
+0xF6, 0x99, 0x46, 0xDD, 0x20, 0xE2, 0x7F, 0x32, 
+0x95, 0x6D, 0x1A, 0x92, 0x7F, 0x70, 0x0E, 0x3C, 
+0xC0, 0xA0, 0x65, 0x2B, 0xC3, 0x53, 0xE4, 0x62, 
+0xA8, 0x17, 0xC7, 0xE4, 0xE0, 0xC1, 0xA2, 0xA9, 
+0xF7, 0xF3, 0x43, 0xA3, 0xD1, 0xDA, 0x6C, 0x99, 
+0x89, 0x7C, 0x30, 0x47, 0x80, 0x0D, 0x4F, 0xEE, 
+0x45, 0xCC, 0x25, 0x56, 0x27, 0x37, 0x14, 0xE8, 
+0x4C, 0x33, 0x49, 0x1B, 0xA6, 0x9F, 0xC7, 0x8A, 
+0x1A, 0xE4, 0xCD, 0xDF, 0xEE, 0x09, 0x20, 0x79, 
+0x33, 0x5C, 0xEC, 0x43, 0x57, 0xCA, 0x5D, 0xC6, 
+0x80, 0xEC, 0xA5, 0x99, 0x64, 0xD5, 0x85, 0xE5, 
+0xEE, 0x92, 0x48, 0xCE, 0x01, 0xA1, 0x55, 0x57, 
+0xCE, 0xAA, 0x5A, 0x93, 0x07, 0x95, 0x03, 0x66, 
+0xE3, 0xAD, 0x2B, 0x45, 0x64, 0xC8, 0x68, 0x87, 
+0x58, 0xF5, 0xE2, 0x42, 0xCB, 0x18, 0x37, 0xE0, 
+0x32, 0x9B, 0x2A, 0x79, 0x2C, 0x8F, 0xB8, 0x35, 
+0xE9, 
+
+0xF8, 0x38, 0x98, 0x68, 0x1C, 0x04, 0xB5, 0x9B, 
+0x41, 0x37, 0xB2, 0x95, 0x2B, 0x34, 0xFD, 0x15, 
+0xF1, 0x54, 0x66, 0x92, 0xFC, 0xF3, 0xF1, 0xB3, 
+0xD9, 0x73, 0x03, 0x50, 0xA9, 0x38, 0x8E, 0x45, 
+0x9B, 0x70, 0xF3, 0x8A, 0x53, 0xE6, 0x90, 0x07, 
+0x03, 0x96, 0xBD, 0xAF, 0xB2, 0x44, 0x36, 0xA2, 
+0x5D, 0x25, 0x9D, 0x72, 0xFE, 0x24, 0xDB, 0xA4, 
+0x7E, 0x75, 0x41, 0xB9, 0x0F, 0xFB, 0xBA, 0xE8, 
+0x52, 0x41, 0xF6, 0xA4, 0xE1, 0xF5, 0x48, 0x8A, 
+0xE9, 0xFE, 0x1F, 0x83, 0xDE, 0x32, 0x78, 0x51, 
+0x39, 0x49, 0x82, 0x00, 0x47, 0x0B, 0xB3, 0xC2, 
+0x89, 0x11, 0x15, 0xDF, 0x09, 0xC5, 0x7B, 0xFC, 
+0x8E, 0xA3, 0xAA, 0x1A, 0x6F, 0x24, 0x53, 0x9D, 
+0x0A, 0x22, 0x26, 0x30, 0x8D, 0x96, 0x6D, 0x76, 
+0x0C, 0x79, 0x38, 0x82, 0xB1, 0x6D, 0x11, 0xE1, 
+0xA3, 0x41, 0xBC, 0xC4, 0x80, 0xA3, 0xAB, 0x67, 
+0x8C, 0x0E, 0x7A, 0x1F, 0x2A, 0x26, 0x07, 0xE3, 
+0xBE, 0x25, 0x71, 0x6D, 0xEE, 0x55, 0x07, 0xA7, 
+0x48, 0xFD, 0x1B, 0xAB, 0xC9, 0x58, 0x2A, 0xA3, 
+0xA5, 0x82, 0xEC, 0x46, 0x9D, 0x34, 0xB5, 0x19, 
+0x02, 0x3B, 0xC5, 0xE3, 0x4A, 0xBF, 0xD4, 0x6A, 
+0xAD, 0x7E, 0xD6, 0x5C, 0x43, 0xD7, 0xAE, 0xA7, 
+0x78, 0x67, 0xED, 0xC1, 0xA8, 0xBF, 0xDA, 0x07, 
+0xEA, 0x3F, 
+
+0x20, 0xE6, 0x7C, 0x30, 0x83, 0x82, 0x34, 0xA4, 
+0x1F, 0x15, 0x45, 0x2C, 0x5F, 0xAF, 0x3B, 0xEC, 
+0x63, 0x12, 0xC9, 0x7E, 0x16, 0xF1, 0xB9, 0xCD, 
+0xCA, 0x99, 0x04, 0x65, 0xA6, 0xF9, 0x46, 0xF8, 
+0x15, 0x01, 0xAE, 0x42, 0x57, 0xB1, 0x91, 0xDC, 
+0x83, 0xD7, 0x19, 0xA8, 0x4D, 0x9C, 0x34, 0x55, 
+0x4C, 0x52, 0xE7, 0x09, 0xA6, 0xE6, 0xEB, 0x04, 
+0xCC, 0x63, 0xCB, 0xC6, 0x0C, 0x57, 0xCC, 0xFD, 
+0x9F, 0xD8, 0x4B, 0x31, 0xB8, 0xF8, 0xFD, 0xB5, 
+0xCA, 0x2C, 0xC1, 0x60, 0xCC, 0xC1, 0x52, 0x64, 
+0x16, 0xA4, 0x57, 0x6A, 0x01, 0xCB, 0xAE, 0x74, 
+0x3A, 0x85, 0x1A, 0xD7, 0x6E, 0xA6, 0xBA, 0x42, 
+0x3C, 0x6C, 0xA4, 0x31, 0x53, 0x04, 0x07, 0xCA, 
+0x57, 0x24, 0x8C, 0x1D, 0xCD, 0x55, 0x6A, 0xC5, 
+0xF3, 0xDF, 0xD1, 0xEF, 0x04, 0xB1, 0x4F, 0x87, 
+0x16, 0xFB, 0x6F, 0xC9, 0xB7, 0x1B, 0xD0, 0xC0, 
+0x2B, 0xB2, 0x01, 0x4A, 0xBB, 0x54, 0xBA, 0xE6, 
+0xF3, 0x67, 0x3F, 0xB5, 0xD2, 0x1C, 0x79, 0x7F, 
+0x4A, 0x6F, 0x99, 0xBE, 0xFC, 0x70, 0xD5, 0x52, 
+0xFE, 0xFF, 0xA0, 0x0A, 0x6A, 0x61, 0x47, 0x8C, 
+0x25, 0x6E, 0x99, 0x55, 0x86, 0x19, 0x42, 0xC6, 
+0x2F, 0x39, 0x01, 0x77, 0x0F, 0x6F, 0x1C, 0x1D, 
+0xC8, 0xAF, 0x1D, 0x6E, 0x35, 0xD2, 0x7D, 0x3F, 
+0x5E, 0x4F, 0x74, 
+
+0x8D, 0xA9, 0x54, 0x7F, 0xBF, 0xB6, 0xC2, 0x10, 
+0x29, 0xF7, 0x34, 0xA4, 0x1B, 0x7F, 0xEF, 0xDB, 
+0x36, 0xD0, 0x83, 0x73, 0x7C, 0x3E, 0xA8, 0x62, 
+0x75, 0x31, 0x09, 0xAC, 0x11, 0x1B, 0x70, 0x8A, 
+0x81, 0x99, 0x30, 0xF4, 0x92, 0x6F, 0xF7, 0xDC, 
+0xB2, 0x52, 0x6E, 0xD5, 0xBA, 0x31, 0xD1, 0x83, 
+0x7E, 0x19, 0x75, 0x4A, 0xA1, 0xCE, 0x65, 0x04, 
+0x61, 0xAB, 0x8B, 0x21, 0x4F, 0x48, 0x4C, 0xD6, 
+0x08, 0x87, 0x2A, 0xBF, 0x68, 0x95, 0x53, 0x96, 
+0xC1, 0x63, 0x81, 0x85, 0x08, 0xA7, 0xC1, 0x27, 
+0x0C, 0x1F, 0xAA, 0xAF, 0xF3, 0x66, 0x57, 0xA4, 
+0xA2, 0xB5, 0x57, 0x63, 0xAC, 0xDC, 0xAE, 0x97, 
+0xA0, 0x2F, 0x63, 0x84, 0x2F, 0x1B, 0x62, 0x88, 
+0xFB, 0xBD, 0x18, 0x70, 0xEF, 0x54, 0xDB, 0xF3, 
+0x6D, 0x8F, 0xD6, 0xB0, 0x23, 0xE5, 0x4D, 0xC1, 
+0xB2, 0xED, 0xC2, 0xB7, 0xF7, 0xD1, 0xAE, 0x59, 
+0xB9, 0xF3, 0x62, 0x3F, 0x5F, 0xAA, 0x2B, 0xA1, 
+0xB9, 0xBA, 0x91, 0x47, 0x67, 0x15, 0xCE, 0x2A, 
+0x66, 0x88, 0xC8, 0x2D, 0x5B, 0xA3, 0xD1, 0x2D, 
+0x64, 0x25, 0x8F, 0xEC, 0xB2, 0x57, 0xAB, 0xCC, 
+0x6A, 0x24, 0x9B, 0xE5, 0x9A, 0x84, 0xD0, 0x53, 
+0x20, 0x89, 0xB3, 0x9A, 0xBF, 0xA6, 0x31, 0xB4, 
+0x8A, 0x89, 0x83, 0x87, 0xDE, 0xDE, 0xBF, 0x84, 
+0x62, 0x64, 0x5C, 0x53, 
+
+0x9B, 0x81, 0x4A, 0xB2, 0x99, 0x41, 0x14, 0xF6, 
+0xE7, 0x36, 0xF9, 0x91, 0x04, 0xC0, 0x46, 0x27, 
+0x9A, 0x07, 0x20, 0x32, 0xFF, 0xA2, 0x57, 0xC8, 
+0xB5, 0xE8, 0xF8, 0x64, 0xB0, 0x7D, 0x05, 0x2B, 
+0x90, 0xF2, 0xC9, 0x33, 0xC4, 0x81, 0xD9, 0x31, 
+0x25, 0x31, 0x79, 0x08, 0xC4, 0x01, 0x2C, 0x5C, 
+0x45, 0xC4, 0x94, 0x15, 0xE8, 0x48, 0x4E, 0x9B, 
+0x38, 0x0D, 0x81, 0x95, 0x79, 0xB5, 0x78, 0x55, 
+0x1F, 0xE1, 0xFC, 0x39, 0xA6, 0xDE, 0x97, 0x79, 
+0xFF, 0x20, 0x8B, 0x32, 0xFF, 0x84, 0x42, 0xBB, 
+0xB0, 0xF5, 0xD7, 0xDA, 0x8C, 0x3C, 0xE5, 0x5D, 
+0x7C, 0x99, 0x70, 0xE2, 0xF2, 0xA8, 0xD0, 0x47, 
+0xF1, 0x85, 0xC9, 0xD2, 0xF9, 0xFF, 0x14, 0x56, 
+0x34, 0xED, 0xDE, 0x51, 0x12, 0x2B, 0xF8, 0x55, 
+0x31, 0xE0, 0x02, 0x87, 0x52, 0x52, 0xEF, 0x17, 
+0x01, 0x9B, 0x52, 0x21, 0x9E, 0x38, 0xEC, 0xFF, 
+0xF2, 0x33, 0x8F, 0xE4, 0x9A, 0x2F, 0x27, 0x51, 
+0xE8, 0x5D, 0xD0, 0x61, 0xEA, 0x81, 0xC3, 0x55, 
+0x1C, 0x23, 0xA2, 0xC4, 0xA9, 0x88, 0xE1, 0x67, 
+0xCE, 0xD8, 0x04, 0xD7, 0xF2, 0xC7, 0x86, 0xF0, 
+0xF2, 0x87, 0x87, 0xBF, 0x44, 0x5D, 0x99, 0x65, 
+0x99, 0x99, 0x2F, 0x2C, 0x49, 0xE2, 0xC9, 0x4D, 
+0xEC, 0xAC, 0xFD, 0xF2, 0x76, 0x7C, 0x9E, 0x49, 
+0xE2, 0xD2, 0xDD, 0x60, 0x41, 
+
+0x33, 0x4E, 0x46, 0xDB, 0xFA, 0x46, 0x21, 0xAE, 
+0x74, 0xC9, 0x52, 0x24, 0x61, 0xFC, 0xE4, 0x60, 
+0xF8, 0xBC, 0x19, 0x97, 0x6B, 0x8D, 0x36, 0x7E, 
+0x85, 0xBB, 0x01, 0x4B, 0xEE, 0x8A, 0x99, 0xD2, 
+0x43, 0x1C, 0x11, 0xCB, 0x79, 0x98, 0xD2, 0x08, 
+0x96, 0x80, 0x07, 0xF9, 0x90, 0xE7, 0x57, 0x0A, 
+0x47, 0xB8, 0xFC, 0x33, 0x02, 0xBF, 0x75, 0xB2, 
+0x9C, 0xF9, 0x9E, 0x47, 0xFA, 0x5B, 0x53, 0xC6, 
+0x74, 0x98, 0x88, 0xE1, 0x24, 0x62, 0x9F, 0x4C, 
+0xF6, 0xA9, 0x45, 0x7D, 0xA6, 0x45, 0x5A, 0x75, 
+0xA5, 0x98, 0x8E, 0xE9, 0xA2, 0x65, 0x60, 0x74, 
+0x46, 0xC6, 0x38, 0xEB, 0xED, 0x9E, 0xEA, 0x94, 
+0x1C, 0x86, 0x91, 0xED, 0x0E, 0x09, 0xEB, 0xA3, 
+0x36, 0x8D, 0x5A, 0x31, 0xB1, 0xC0, 0xA0, 0x82, 
+0x88, 0x68, 0xEA, 0x28, 0x45, 0xBB, 0x9C, 0xF8, 
+0x28, 0x77, 0xF1, 0x2E, 0xCA, 0x00, 0xC6, 0xB7, 
+0x53, 0x23, 0x7D, 0x9A, 0x9F, 0x67, 0x68, 0x6D, 
+0xA2, 0x85, 0x4F, 0xDD, 0x55, 0xAB, 0x3C, 0x71, 
+0x6C, 0x99, 0x9F, 0x81, 0xD7, 0x7F, 0xA5, 0x50, 
+0x78, 0xAC, 0x01, 0x18, 0x57, 0xC3, 0xC8, 0x4E, 
+0xCC, 0x22, 0x40, 0xBC, 0xFC, 0xBA, 0xA2, 0x37, 
+0xB9, 0x96, 0x44, 0xEE, 0x8D, 0xE7, 0xC2, 0xDA, 
+0x1D, 0xCC, 0x0A, 0x42, 0x48, 0x48, 0xD1, 0x8E, 
+0xBA, 0x75, 0x23, 0xF3, 0x98, 0x5E, 
+
+0x0D, 0x99, 0x62, 0x84, 0xF7, 0xB6, 0x3F, 0x9C, 
+0x0F, 0x49, 0x6A, 0xBC, 0x46, 0x9C, 0x98, 0x37, 
+0xC8, 0xDA, 0xC2, 0xFC, 0xFA, 0x9E, 0x64, 0x43, 
+0x8D, 0x85, 0x9C, 0x0C, 0x42, 0x18, 0xD4, 0x44, 
+0x3F, 0xB7, 0xDC, 0x0E, 0xCF, 0x17, 0x6C, 0xD6, 
+0x7C, 0xE4, 0x2C, 0x91, 0xDC, 0x07, 0x0C, 0x77, 
+0x2F, 0x0C, 0xB6, 0x95, 0x43, 0x29, 0x9D, 0xD0, 
+0x66, 0x10, 0x57, 0xAB, 0x3C, 0xCA, 0x10, 0x9B, 
+0x95, 0x2F, 0x41, 0xB2, 0x03, 0x2A, 0x86, 0x80, 
+0x40, 0x51, 0x7B, 0x15, 0xFE, 0xAE, 0xA4, 0xA5, 
+0xAE, 0x00, 0xF3, 0x97, 0x9A, 0x6E, 0xDD, 0x79, 
+0xB7, 0x9C, 0x60, 0xC1, 0x64, 0xB5, 0x6E, 0x0A, 
+0x10, 0x24, 0x12, 0x7F, 0xEC, 0x81, 0xC8, 0xE3, 
+0xA8, 0xC3, 0x67, 0xFC, 0x24, 0x00, 0x8F, 0x43, 
+0x53, 0x3B, 0x08, 0x00, 0x1F, 0x94, 0xEE, 0x49, 
+0xD0, 0x87, 0xD7, 0x93, 0x9D, 0x86, 0x2B, 0x56, 
+0x14, 0x24, 0xE2, 0xC5, 0x81, 0x17, 0x00, 0xAE, 
+0x35, 0x84, 0xDE, 0xC9, 0x2C, 0xCF, 0x1B, 0xC2, 
+0x8A, 0xC4, 0x05, 0xFD, 0xA2, 0x83, 0x7D, 0x40, 
+0xA4, 0x03, 0xBE, 0x14, 0x74, 0x97, 0xED, 0x25, 
+0xC8, 0xD5, 0x6D, 0xCA, 0x06, 0xB0, 0x12, 0x0F, 
+0x1D, 0xEB, 0xD2, 0xF1, 0x6E, 0x53, 0x09, 0xAC, 
+0xE2, 0x0B, 0xF6, 0xDB, 0x0C, 0xC3, 0x1D, 0xAC, 
+0x9B, 0x2E, 0x9B, 0x72, 0xFD, 0x50, 0x58, 
+
+0x6E, 0xE2, 0xD6, 0xFF, 0xBD, 0x1B, 0x11, 0x30, 
+0xD2, 0xCD, 0x1E, 0xD5, 0xBC, 0xEE, 0x73, 0x7A, 
+0x93, 0x44, 0x92, 0xCE, 0xD0, 0x27, 0xA0, 0x87, 
+0xF1, 0x0B, 0xAA, 0x07, 0x27, 0x14, 0x68, 0x65, 
+0x37, 0x37, 0xB7, 0xA4, 0xCE, 0x31, 0x94, 0x9E, 
+0x7E, 0x17, 0x28, 0x36, 0x01, 0x68, 0x3C, 0x6B, 
+0x0D, 0xEA, 0x79, 0x85, 0xAC, 0x43, 0xA1, 0x53, 
+0x01, 0x8D, 0x47, 0x8E, 0x16, 0x23, 0xB3, 0xF6, 
+0xC1, 0x8A, 0x17, 0x0E, 0x1E, 0xB2, 0x53, 0x5F, 
+0x09, 0x66, 0x0A, 0xAF, 0x80, 0xA0, 0xDB, 0xBB, 
+0x19, 0x16, 0x7C, 0x12, 0x4D, 0xE0, 0x70, 0xC4, 
+0xDD, 0x2D, 0xF1, 0x76, 0xB6, 0x28, 0xE6, 0xB5, 
+0x96, 0xFD, 0x38, 0xCD, 0x14, 0xBA, 0xF0, 0x46, 
+0x4A, 0x2B, 0xD1, 0x41, 0x36, 0x18, 0x23, 0x14, 
+0x49, 0x9E, 0xE4, 0x3E, 0x5C, 0x55, 0xDD, 0x0B, 
+0xF3, 0x81, 0xDF, 0xEA, 0x94, 0x3E, 0x1A, 0xC6, 
+0xD3, 0x3A, 0xD0, 0x56, 0xFA, 0xB0, 0x3A, 0x06, 
+0xAA, 0x95, 0xD6, 0x9D, 0xAE, 0xA6, 0xCB, 0x69, 
+0x74, 0x6A, 0x23, 0xDA, 0xCD, 0x8D, 0xE1, 0x47, 
+0x3A, 0x9E, 0x6E, 0x54, 0xF1, 0x17, 0x9C, 0xB2, 
+0xC4, 0x7F, 0x2E, 0x96, 0xF8, 0x05, 0xCC, 0xA9, 
+0x04, 0xC5, 0xD8, 0x58, 0x6A, 0x14, 0x4C, 0x6C, 
+0x79, 0xCA, 0x57, 0x8D, 0xC7, 0x11, 0x0A, 0x50, 
+0x97, 0xCA, 0xC5, 0xC8, 0x7A, 0x4F, 0xD4, 0x14, 
+
+0x29, 0x01, 0xB7, 0xA9, 0xBC, 0x69, 0xEF, 0x33, 
+0xB4, 0x6B, 0x29, 0x59, 0x89, 0x21, 0xB5, 0x22, 
+0xDE, 0xCC, 0xAF, 0x7D, 0xBD, 0xC5, 0xAC, 0x5A, 
+0x90, 0x95, 0xA4, 0x90, 0x4B, 0x2C, 0xCB, 0x92, 
+0xE9, 0x50, 0xA8, 0x58, 0xE6, 0x41, 0xE8, 0x8E, 
+0x50, 0x4E, 0xB9, 0xDE, 0xC6, 0x19, 0x58, 0x72, 
+0xD3, 0xB9, 0xC8, 0xAB, 0x7B, 0xB6, 0xA7, 0x37, 
+0xF0, 0x55, 0x2A, 0x0F, 0x9F, 0x08, 0x07, 0x70, 
+0xBE, 0x53, 0xF0, 0x1C, 0xD0, 0x13, 0xCF, 0x49, 
+0xE3, 0xF0, 0x3E, 0x04, 0xEA, 0xC2, 0x4D, 0x18, 
+0x75, 0xC8, 0xA2, 0x56, 0x4E, 0x03, 0x40, 0x27, 
+0x37, 0x8D, 0x8E, 0x10, 0xF1, 0x01, 0xC2, 0xBA, 
+0x13, 0xA0, 0x57, 0x82, 0x66, 0x7B, 0x4A, 0x6E, 
+0xE3, 0x58, 0xD9, 0x1C, 0x36, 0xB5, 0x70, 0x7F, 
+0x7E, 0xE0, 0x42, 0xDA, 0x22, 0x9A, 0x54, 0x9E, 
+0xB7, 0xCF, 0x4E, 0xF0, 0xA7, 0xDA, 0xE0, 0xE7, 
+0x05, 0x86, 0x5C, 0xC5, 0xB5, 0xBE, 0x96, 0x39, 
+0x60, 0x73, 0x42, 0xD3, 0x1F, 0xD3, 0x53, 0xC8, 
+0x20, 0x4D, 0xA9, 0xB5, 0xD6, 0x05, 0xC5, 0x7D, 
+0x28, 0x49, 0xF6, 0x69, 0x3D, 0x97, 0x32, 0xA3, 
+0x04, 0x21, 0x6B, 0x20, 0x4D, 0x84, 0x5C, 0xF8, 
+0x9E, 0x88, 0x1F, 0x65, 0xE4, 0x69, 0x5D, 0x68, 
+0x42, 0xB9, 0xBE, 0xCC, 0x3C, 0xDC, 0x3F, 0x32, 
+0xBD, 0x3A, 0x4C, 0xEB, 0x8E, 0x97, 0x1C, 0xAD, 
+0xCB, 
+
+0x2C, 0xDA, 0x63, 0x67, 0xDD, 0xD5, 0xA0, 0xAD, 
+0x02, 0xCD, 0xB0, 0x0F, 0xB7, 0x6E, 0x44, 0x86, 
+0x4E, 0x63, 0x83, 0x64, 0x66, 0xE1, 0xAE, 0xE9, 
+0x03, 0xF5, 0x93, 0x7F, 0xB1, 0xAD, 0xC5, 0x3A, 
+0xFA, 0x6B, 0x64, 0xA4, 0xBD, 0xAB, 0x90, 0x0A, 
+0x2E, 0x5B, 0x08, 0x3E, 0xDC, 0x8F, 0x8C, 0xF7, 
+0xF0, 0x44, 0x91, 0x40, 0x78, 0xD6, 0x5D, 0x2A, 
+0x70, 0x17, 0xBC, 0xB0, 0xD1, 0x97, 0x14, 0xDC, 
+0x45, 0xE2, 0x5C, 0x20, 0x2D, 0x21, 0x74, 0x58, 
+0xD5, 0x9D, 0x2A, 0x51, 0xC4, 0x66, 0xE1, 0x3F, 
+0x23, 0xEC, 0xBB, 0x19, 0x45, 0xE0, 0x43, 0xDB, 
+0x84, 0x32, 0x6F, 0x98, 0xDF, 0x3A, 0xC4, 0xDB, 
+0x57, 0x5B, 0xB9, 0xE2, 0x18, 0x55, 0x4E, 0xE4, 
+0x60, 0x41, 0x65, 0x7B, 0xA4, 0x9D, 0x9B, 0x39, 
+0x28, 0x22, 0x2B, 0xAC, 0x0C, 0x7C, 0x9E, 0x6F, 
+0x58, 0x46, 0x60, 0x89, 0xD9, 0xE2, 0x88, 0xAD, 
+0x39, 0x85, 0x67, 0x24, 0xA3, 0x1A, 0x6A, 0x7A, 
+0xAD, 0xCF, 0x10, 0x98, 0x40, 0x79, 0x46, 0x5C, 
+0xC9, 0xE4, 0x8A, 0xE5, 0x40, 0x40, 0x76, 0x71, 
+0x9F, 0xDE, 0xAA, 0x6C, 0x83, 0x5B, 0xCD, 0x4F, 
+0x99, 0xD9, 0xA0, 0x48, 0xBC, 0xFC, 0xCA, 0xAC, 
+0x0A, 0x2B, 0xD4, 0x06, 0x30, 0x38, 0x20, 0x27, 
+0x90, 0xF1, 0x5E, 0x85, 0x3D, 0xFA, 0xDA, 0x2B, 
+0x8F, 0xAF, 0x0A, 0x1C, 0xDA, 0x71, 0xB0, 0x0A, 
+0xB0, 0x28, 
+
+0x91, 0x59, 0x87, 0x84, 0xE1, 0x67, 0x46, 0x45, 
+0xC8, 0xAC, 0x92, 0xC7, 0xED, 0x99, 0x2C, 0x44, 
+0x6D, 0x85, 0x82, 0x50, 0x45, 0x87, 0x53, 0x8E, 
+0x20, 0xBE, 0x15, 0x47, 0xB0, 0x65, 0x09, 0xC2, 
+0x07, 0xD3, 0x83, 0x8F, 0xEA, 0x63, 0x56, 0x09, 
+0x4F, 0x45, 0xAA, 0x51, 0x8B, 0x84, 0xDF, 0x56, 
+0x87, 0x21, 0x31, 0xAA, 0x52, 0x2D, 0x5D, 0x34, 
+0xC6, 0x57, 0xA1, 0xB7, 0xCB, 0x57, 0x25, 0x85, 
+0xD4, 0x86, 0x9D, 0x36, 0x52, 0xC1, 0x1A, 0x29, 
+0x3B, 0xD6, 0x03, 0x1F, 0x27, 0xBE, 0x3D, 0x39, 
+0x28, 0xE6, 0xF5, 0x52, 0xDE, 0xDF, 0x17, 0x5E, 
+0x2E, 0xAC, 0x9B, 0x00, 0xCE, 0x06, 0x6C, 0xFF, 
+0x33, 0xB1, 0xFD, 0x75, 0x7A, 0xA1, 0x01, 0xDC, 
+0x5F, 0x21, 0x21, 0x4C, 0x5D, 0x21, 0x26, 0x99, 
+0xCB, 0x36, 0xFE, 0xD1, 0xB6, 0xD1, 0x53, 0xFB, 
+0x6F, 0x9A, 0x36, 0xBF, 0x49, 0xCF, 0x83, 0x22, 
+0xDF, 0x04, 0x70, 0x3C, 0xB1, 0xFC, 0xC4, 0x16, 
+0x7B, 0x66, 0xCD, 0x8D, 0x12, 0xCE, 0x15, 0x72, 
+0x16, 0x22, 0xF4, 0xE0, 0xB5, 0xDA, 0x11, 0x03, 
+0xFA, 0xC6, 0xB2, 0x82, 0x17, 0x58, 0xF1, 0x01, 
+0x18, 0x5B, 0xFD, 0x92, 0x36, 0xDF, 0x39, 0xA9, 
+0xA5, 0xA5, 0x8E, 0x41, 0xD8, 0x4B, 0x56, 0x1F, 
+0xCF, 0x1D, 0x9A, 0x85, 0xE8, 0xAD, 0x7F, 0x6C, 
+0x7B, 0x1A, 0x6A, 0xFF, 0x87, 0xFF, 0xB6, 0xFC, 
+0x1E, 0x1D, 0xC5, 
+
+0x96, 0x9F, 0x1B, 0x53, 0xFE, 0x03, 0x49, 0xED, 
+0x81, 0xAF, 0x31, 0x1A, 0x8D, 0x5A, 0x4C, 0xF6, 
+0x53, 0x08, 0x0B, 0x5F, 0xA3, 0xEA, 0x3B, 0x8C, 
+0x68, 0xFA, 0x64, 0x94, 0xF6, 0x7C, 0xE1, 0x93, 
+0x96, 0xF7, 0x64, 0x62, 0xD4, 0xD8, 0xAD, 0x7E, 
+0x6A, 0xB0, 0x0C, 0xE5, 0xCA, 0xBB, 0xE9, 0x3C, 
+0x5C, 0x1A, 0x5E, 0x5C, 0xB4, 0xAF, 0x14, 0xBB, 
+0x37, 0x57, 0x93, 0x61, 0x15, 0x4F, 0xB3, 0x70, 
+0x7B, 0x16, 0x19, 0xE8, 0x4B, 0x47, 0x60, 0xE3, 
+0x88, 0xF7, 0x88, 0x3D, 0x4F, 0xB8, 0x80, 0x14, 
+0x0A, 0x27, 0x11, 0xEB, 0xE1, 0xDD, 0xD4, 0x9D, 
+0x10, 0x22, 0xBD, 0x81, 0x9D, 0x36, 0x8E, 0xFF, 
+0xF8, 0x07, 0xB7, 0x5E, 0x1A, 0x29, 0x85, 0xBB, 
+0x5D, 0x30, 0xD4, 0x42, 0xBE, 0x25, 0xD3, 0xCD, 
+0x77, 0x7F, 0x14, 0x15, 0xEC, 0x33, 0x92, 0x56, 
+0x5C, 0xC2, 0xDA, 0xD9, 0xD8, 0xEF, 0xFB, 0x28, 
+0xED, 0xE5, 0x33, 0x6A, 0xAD, 0xA3, 0x27, 0x74, 
+0xF4, 0x5D, 0xBD, 0x13, 0x62, 0x43, 0xA1, 0x85, 
+0x92, 0xBB, 0x90, 0x7D, 0x13, 0xE8, 0xC4, 0x9D, 
+0x52, 0x38, 0x5E, 0xF9, 0xBF, 0x24, 0x1A, 0x47, 
+0xA1, 0x88, 0x70, 0x25, 0x06, 0x6E, 0x90, 0xD2, 
+0x54, 0xA2, 0x27, 0x29, 0xD2, 0x7E, 0x43, 0xB4, 
+0x4B, 0xB1, 0x44, 0x8E, 0xA4, 0xD0, 0x77, 0xBB, 
+0xE7, 0xB2, 0xB7, 0x35, 0xCA, 0xCF, 0xFD, 0xF9, 
+0x82, 0xF9, 0x42, 0x11, 
+
+0x33, 0x10, 0x0E, 0xBA, 0xD9, 0x56, 0xE8, 0xDF, 
+0xB5, 0xDB, 0x9C, 0xB8, 0x54, 0x0A, 0x7E, 0x1B, 
+0x9A, 0xB6, 0x8F, 0xBF, 0x1B, 0x0F, 0x15, 0xA1, 
+0x63, 0x81, 0x7F, 0xDC, 0x76, 0x23, 0x7B, 0xFF, 
+0x48, 0xCA, 0xE4, 0x3C, 0x5D, 0xA0, 0x92, 0xA2, 
+0x10, 0x26, 0x12, 0x85, 0x31, 0xFC, 0x36, 0xCC, 
+0xD5, 0x43, 0x60, 0xC3, 0xF8, 0x57, 0x35, 0x0C, 
+0x60, 0x42, 0xD5, 0xC3, 0xEB, 0x1A, 0xDF, 0xDD, 
+0x30, 0x9A, 0x09, 0xAB, 0xF7, 0xEB, 0xAF, 0xFF, 
+0x5B, 0x3D, 0x41, 0x6D, 0x6C, 0x89, 0x39, 0xBC, 
+0x33, 0xB5, 0x2E, 0x17, 0xC4, 0xD5, 0xE0, 0xBE, 
+0xD1, 0x85, 0x85, 0x72, 0x40, 0x58, 0x78, 0x91, 
+0x46, 0x73, 0xFC, 0xDF, 0x25, 0x40, 0x9A, 0x69, 
+0x7E, 0x7D, 0xEB, 0xE4, 0x8B, 0xC5, 0xBC, 0x3C, 
+0x45, 0x14, 0xA8, 0x8A, 0x56, 0xE2, 0x83, 0x26, 
+0xB4, 0xD8, 0xAC, 0xA8, 0xD2, 0x97, 0x57, 0xC0, 
+0xBB, 0x1B, 0x25, 0xDB, 0x36, 0xA3, 0x65, 0x26, 
+0x6F, 0xB6, 0xC3, 0x94, 0xBC, 0xCA, 0x15, 0x92, 
+0xBC, 0xCD, 0xE6, 0x6F, 0x58, 0x7E, 0xB4, 0x68, 
+0xC8, 0xAF, 0xF5, 0xAD, 0x65, 0x7A, 0xBD, 0xF9, 
+0x54, 0x63, 0xAB, 0x66, 0x0E, 0x89, 0x66, 0x93, 
+0xEF, 0x9D, 0x68, 0x4F, 0x1E, 0x3D, 0x55, 0xAA, 
+0x7F, 0x7C, 0xE8, 0x20, 0x9D, 0xB6, 0xA3, 0x78, 
+0x59, 0xA2, 0x3E, 0x37, 0xC9, 0x68, 0xD9, 0xD7, 
+0x5C, 0xA7, 0x4D, 0x4E, 0x39, 
+
+0xB0, 0x84, 0x98, 0x23, 0xF4, 0x55, 0x8B, 0x12, 
+0xFE, 0x21, 0xCB, 0xD8, 0x88, 0x6B, 0x42, 0xA1, 
+0x35, 0xD4, 0x46, 0x5C, 0x4E, 0x0D, 0x36, 0x6C, 
+0x83, 0xA9, 0x4C, 0x12, 0x2D, 0x7F, 0xE6, 0x9F, 
+0xED, 0x05, 0x79, 0xEC, 0xD6, 0x0C, 0x6D, 0xFC, 
+0xB9, 0xA1, 0x69, 0x87, 0x6E, 0x49, 0x37, 0xCB, 
+0xC8, 0x24, 0x17, 0x95, 0x39, 0x8A, 0xFE, 0x03, 
+0xFF, 0x95, 0x10, 0xDC, 0x3F, 0xDF, 0x16, 0x40, 
+0x70, 0x24, 0x9C, 0x9E, 0x9B, 0x61, 0xF2, 0xB0, 
+0xD1, 0x93, 0x53, 0xFD, 0x44, 0x10, 0xD2, 0x2D, 
+0xE6, 0xD6, 0x90, 0x97, 0x25, 0xF5, 0xF0, 0x29, 
+0x10, 0xF4, 0xE4, 0xA8, 0x8B, 0xC0, 0xB0, 0xD5, 
+0x32, 0x03, 0x96, 0xD3, 0x92, 0x3E, 0x7A, 0x71, 
+0x03, 0xC2, 0xA9, 0x76, 0x3A, 0x4A, 0x4A, 0x04, 
+0x71, 0x86, 0x94, 0xED, 0xD0, 0x41, 0x16, 0x36, 
+0xD1, 0x38, 0x90, 0x46, 0xBB, 0xAA, 0x3F, 0xE6, 
+0x5F, 0x42, 0x14, 0x87, 0xFB, 0x60, 0x25, 0x07, 
+0xBB, 0xCC, 0x0D, 0xCB, 0xFF, 0xAD, 0xFE, 0xEC, 
+0xA1, 0x10, 0x56, 0x30, 0x30, 0xDF, 0xC4, 0xA5, 
+0x99, 0x96, 0xCB, 0xD1, 0x51, 0xE2, 0x77, 0xF1, 
+0x4F, 0x39, 0xDB, 0x06, 0x6A, 0xE3, 0x01, 0xC3, 
+0x02, 0x0C, 0x27, 0x82, 0x0B, 0x5A, 0x1E, 0x42, 
+0x4D, 0x7E, 0xF2, 0xB2, 0xAB, 0x70, 0x65, 0xCE, 
+0x4D, 0x44, 0x39, 0x4A, 0xDF, 0xB9, 0xAB, 0x8C, 
+0x14, 0x89, 0x9E, 0x9A, 0x26, 0xFC, 
+
+0xB1, 0x1B, 0xD2, 0x70, 0xE9, 0xA0, 0x11, 0x31, 
+0x6C, 0xF4, 0x64, 0x96, 0x93, 0xE5, 0x90, 0xC4, 
+0xFB, 0x8B, 0xEA, 0xAF, 0x27, 0x91, 0x47, 0x23, 
+0x3A, 0x12, 0xA2, 0x6C, 0x52, 0x8B, 0xF6, 0x2E, 
+0xAF, 0xA7, 0x81, 0x2B, 0xB8, 0x43, 0x4B, 0xEF, 
+0x35, 0x90, 0xBE, 0x1A, 0x6D, 0x8B, 0x8F, 0xD4, 
+0x71, 0x89, 0xE9, 0x94, 0x26, 0x82, 0x93, 0x09, 
+0xFB, 0xB8, 0xD1, 0xA1, 0x19, 0xDA, 0x94, 0x9A, 
+0xE3, 0x57, 0xD2, 0x66, 0x40, 0xD1, 0xBE, 0x49, 
+0x0A, 0x22, 0xE0, 0x35, 0x8B, 0x21, 0xDA, 0xCB, 
+0xF4, 0xED, 0xE6, 0xA2, 0x22, 0xF8, 0x5A, 0xFD, 
+0x45, 0x22, 0x53, 0xD6, 0xAA, 0x4B, 0xDE, 0x6C, 
+0x47, 0x07, 0x65, 0x72, 0x2C, 0x23, 0xB2, 0x31, 
+0xEE, 0x93, 0x14, 0x46, 0xDE, 0x9C, 0x51, 0x2A, 
+0x99, 0x42, 0x58, 0x11, 0x10, 0x57, 0xB6, 0x31, 
+0xC4, 0xFF, 0x74, 0xB8, 0x23, 0x36, 0x92, 0xF5, 
+0x75, 0x2E, 0x20, 0x49, 0x19, 0xE4, 0xC7, 0xFC, 
+0x4C, 0xC5, 0xA9, 0x2E, 0x58, 0xA1, 0x66, 0xA0, 
+0x9B, 0x00, 0x9B, 0xDC, 0x80, 0xBC, 0x78, 0xB4, 
+0xE7, 0xB0, 0xCE, 0x94, 0x5D, 0x0C, 0x70, 0x89, 
+0xFF, 0xC8, 0x13, 0x38, 0x30, 0xFB, 0x32, 0x4B, 
+0xD8, 0x05, 0xC3, 0x68, 0x3E, 0x89, 0x00, 0x1A, 
+0x61, 0x3B, 0xB0, 0x2A, 0xC4, 0xE9, 0x13, 0xFB, 
+0x80, 0xC3, 0xDD, 0x92, 0xE6, 0xB9, 0xBC, 0xAE, 
+0xE9, 0xBE, 0x4C, 0xE8, 0x2D, 0xC2, 0x9E, 
+
+0xA7, 0x9C, 0x5B, 0x54, 0x2B, 0x92, 0x1C, 0x5F, 
+0xCE, 0x37, 0xEB, 0x05, 0x76, 0x67, 0x39, 0xF4, 
+0x15, 0x2B, 0x89, 0x67, 0xDB, 0xC4, 0xB7, 0x36, 
+0xD3, 0x56, 0xE2, 0x9A, 0x25, 0xD6, 0x47, 0x52, 
+0xA5, 0xB5, 0x7D, 0x30, 0x25, 0xE4, 0x92, 0x39, 
+0x9C, 0xC0, 0xDB, 0x94, 0x03, 0x8D, 0x26, 0x57, 
+0x2E, 0xC6, 0x90, 0x88, 0x37, 0x62, 0xD4, 0x82, 
+0x0C, 0xD4, 0xDD, 0x7D, 0x88, 0xC1, 0xDA, 0xE8, 
+0xD8, 0x26, 0x8C, 0x58, 0x69, 0x87, 0xDB, 0x05, 
+0xDB, 0x97, 0x4F, 0x0A, 0x94, 0xF0, 0xA7, 0x0A, 
+0x13, 0x4A, 0x25, 0x69, 0xAC, 0x34, 0x7D, 0xB6, 
+0x15, 0x5A, 0x14, 0x1E, 0x94, 0x42, 0xB1, 0x02, 
+0x25, 0xD5, 0xBC, 0x9B, 0x15, 0x26, 0xD4, 0x74, 
+0x10, 0x85, 0x8A, 0x1A, 0x24, 0x65, 0xAB, 0x3C, 
+0xBD, 0xB8, 0x43, 0x27, 0x6A, 0x83, 0x93, 0x46, 
+0x39, 0x38, 0x30, 0xF2, 0xC8, 0x89, 0x58, 0xD7, 
+0x97, 0x02, 0x8F, 0x6C, 0x10, 0xD3, 0xAE, 0x17, 
+0x60, 0xF2, 0xE7, 0x43, 0xDD, 0x8A, 0x5D, 0xB3, 
+0x23, 0xAF, 0x08, 0x64, 0x50, 0xA3, 0x78, 0xC9, 
+0xC7, 0x83, 0xB0, 0x30, 0x4D, 0x53, 0xB9, 0xBD, 
+0x97, 0x3C, 0x65, 0x02, 0x3C, 0x49, 0xAC, 0xA4, 
+0x5D, 0x4C, 0x22, 0x12, 0x32, 0x03, 0x82, 0x1F, 
+0x07, 0x87, 0xFD, 0xF5, 0xDC, 0xA8, 0xFE, 0x8A, 
+0x35, 0x3C, 0xAC, 0xEF, 0x81, 0x7F, 0x74, 0x9B, 
+0x43, 0xA4, 0xDF, 0x8E, 0x4B, 0x8A, 0x4C, 0xF9, 
+
+0x26, 0x82, 0x9C, 0x64, 0x01, 0xB8, 0x57, 0x0C, 
+0x22, 0x95, 0x20, 0x5A, 0xF4, 0x29, 0xFD, 0xFD, 
+0x80, 0xD4, 0xF7, 0xB9, 0xE1, 0xF6, 0x2D, 0xDA, 
+0x7F, 0x40, 0x53, 0x90, 0xF4, 0x49, 0x08, 0x11, 
+0x0B, 0x21, 0x19, 0x9D, 0x3D, 0x98, 0xE8, 0x4D, 
+0xEE, 0x58, 0xCB, 0x20, 0x07, 0xEA, 0xEF, 0x86, 
+0x91, 0x81, 0xF5, 0x40, 0x73, 0xFB, 0xCF, 0x54, 
+0x18, 0x05, 0x23, 0x1D, 0x51, 0x21, 0x8B, 0x16, 
+0xC6, 0xFE, 0x94, 0x5F, 0xED, 0xC6, 0x0B, 0x30, 
+0x30, 0x3A, 0xEA, 0x8F, 0xE8, 0x49, 0x5D, 0x9A, 
+0xF2, 0xDB, 0x7A, 0x3E, 0x8D, 0xAC, 0x3E, 0xCF, 
+0xB5, 0x78, 0x31, 0x45, 0xC0, 0x3E, 0x8E, 0x8A, 
+0x6A, 0xDD, 0x3F, 0x9B, 0xD6, 0xDC, 0xFB, 0x0E, 
+0x1D, 0xA2, 0xF6, 0xDD, 0x8C, 0x9D, 0xCD, 0xD7, 
+0x57, 0x54, 0xB9, 0x8A, 0xC6, 0x52, 0xBD, 0x76, 
+0xE5, 0x3B, 0x79, 0xCD, 0x51, 0x64, 0x33, 0x42, 
+0xB6, 0xF8, 0xA8, 0x5D, 0xFF, 0x74, 0xE6, 0x26, 
+0x66, 0xE1, 0x8C, 0xD4, 0x42, 0xE4, 0xBA, 0x82, 
+0x40, 0x28, 0xE8, 0x42, 0xB6, 0x84, 0xEF, 0xB9, 
+0x46, 0xCB, 0x73, 0xA4, 0xFF, 0x56, 0x46, 0x21, 
+0x8F, 0x95, 0xD8, 0x9B, 0xE5, 0xFB, 0xC2, 0xF1, 
+0x8F, 0x10, 0x19, 0x88, 0xFC, 0x74, 0xA2, 0x69, 
+0xCE, 0xC1, 0xF2, 0x04, 0x29, 0x85, 0x78, 0x31, 
+0x6F, 0x97, 0x82, 0x44, 0x9C, 0x11, 0xB6, 0x28, 
+0x2D, 0x1A, 0xFB, 0xF8, 0x17, 0xBE, 0x51, 0x11, 
+0xBD, 
+
+0xD1, 0xFE, 0xC4, 0xDE, 0x12, 0x1A, 0x63, 0x36, 
+0x0A, 0x08, 0x8A, 0xFF, 0xFD, 0xAF, 0x0A, 0x2E, 
+0xB4, 0x0E, 0xB9, 0x2A, 0x5A, 0x2A, 0x0B, 0x3B, 
+0x46, 0x32, 0xDE, 0xA2, 0xC7, 0x07, 0x73, 0x42, 
+0xBE, 0x62, 0x01, 0xF1, 0xB3, 0xF0, 0x62, 0x67, 
+0x8C, 0x99, 0x53, 0x0B, 0x6C, 0xD3, 0x40, 0xEE, 
+0x8E, 0xE1, 0x97, 0xD1, 0xBE, 0x19, 0xCD, 0xDE, 
+0x75, 0x37, 0x6C, 0xE8, 0x21, 0xD7, 0x6E, 0x7A, 
+0x9D, 0xB5, 0xEA, 0xC0, 0xD9, 0x69, 0xB1, 0xBF, 
+0xE2, 0xEF, 0xF0, 0xBD, 0x01, 0xFB, 0x57, 0xDA, 
+0x8F, 0x62, 0x36, 0x46, 0x10, 0x45, 0xB8, 0xBB, 
+0xD4, 0xC6, 0xF6, 0x5C, 0x29, 0x9C, 0x7B, 0x53, 
+0xF7, 0x0A, 0x9C, 0xDB, 0x37, 0xB3, 0x0D, 0xFC, 
+0x3A, 0x3F, 0x6A, 0xE9, 0xB3, 0xEB, 0x24, 0x34, 
+0x3A, 0x3C, 0x62, 0x34, 0xEB, 0x7F, 0xD8, 0xC9, 
+0x76, 0x65, 0x95, 0x03, 0xC4, 0x5B, 0x90, 0x47, 
+0x36, 0x70, 0x33, 0x7D, 0x4C, 0x64, 0xA1, 0xCD, 
+0xA1, 0xDE, 0xB3, 0x83, 0x2A, 0x64, 0x12, 0xBC, 
+0xB9, 0xAC, 0x8D, 0x66, 0xF8, 0x63, 0xBE, 0x84, 
+0x2C, 0xA7, 0x8A, 0xB4, 0x5A, 0xB6, 0xCC, 0x31, 
+0x8A, 0x4E, 0xD0, 0x37, 0x08, 0x9F, 0xFE, 0xEC, 
+0xFE, 0xC5, 0x9C, 0x77, 0x19, 0x61, 0x84, 0x37, 
+0x2F, 0xA5, 0xA0, 0xF3, 0x7D, 0xD9, 0xDA, 0xA6, 
+0x26, 0x1A, 0xE2, 0x98, 0x25, 0x26, 0xBD, 0xDB, 
+0x4C, 0x2D, 0xF9, 0xB9, 0xD3, 0x6E, 0x74, 0x5E, 
+0x9F, 0x1C, 
+
+0x85, 0xBB, 0x36, 0x04, 0x3F, 0x4C, 0x21, 0x84, 
+0x25, 0x52, 0xC2, 0x0B, 0x29, 0xA9, 0x69, 0x50, 
+0x5A, 0x19, 0x72, 0xAA, 0xA9, 0x4C, 0x89, 0xA9, 
+0xDF, 0xA7, 0x72, 0x97, 0x96, 0xDE, 0xB5, 0x95, 
+0xFF, 0xF6, 0x5F, 0xFC, 0xF7, 0x0D, 0xE9, 0x61, 
+0x19, 0x5C, 0xC8, 0x0C, 0xCE, 0xD6, 0xC2, 0xB5, 
+0x58, 0x62, 0x32, 0x62, 0xD2, 0x38, 0x4F, 0xA4, 
+0x79, 0xFA, 0xAD, 0x37, 0x23, 0x80, 0xA8, 0xBF, 
+0xD3, 0xE9, 0x23, 0x32, 0x4C, 0xD0, 0x66, 0xE1, 
+0x24, 0x4F, 0x0B, 0x28, 0xCD, 0xB7, 0x9C, 0x7C, 
+0x5A, 0x7B, 0xF6, 0xB0, 0xF4, 0x58, 0xCB, 0xDC, 
+0x88, 0xC8, 0x10, 0x3B, 0x08, 0x1A, 0xB6, 0x81, 
+0x10, 0x8A, 0xCC, 0x14, 0xB9, 0xA8, 0x79, 0x09, 
+0x80, 0x98, 0x19, 0x33, 0x3E, 0x80, 0x2D, 0x82, 
+0x4D, 0x18, 0x0F, 0x6C, 0x17, 0xC1, 0x5C, 0x5C, 
+0x59, 0xD5, 0x27, 0x4A, 0x84, 0xE6, 0x1B, 0x13, 
+0x8B, 0x28, 0xB3, 0x47, 0x70, 0x97, 0x48, 0xA6, 
+0x53, 0x59, 0x24, 0x5B, 0xDB, 0xBA, 0x3F, 0xC6, 
+0x59, 0xBF, 0xAD, 0x7A, 0x2B, 0xF7, 0xAB, 0xF9, 
+0xA2, 0x8C, 0x3A, 0x7E, 0x9A, 0xC7, 0x18, 0xD7, 
+0xE7, 0x9E, 0x62, 0x7A, 0x36, 0x96, 0x8A, 0x2C, 
+0xDE, 0x26, 0x8B, 0x08, 0xB2, 0xE0, 0x26, 0x84, 
+0xD3, 0xD4, 0xC7, 0x18, 0xCF, 0xBD, 0x6A, 0x97, 
+0x45, 0x22, 0xE9, 0x6D, 0x3A, 0xD8, 0xA5, 0x60, 
+0x6D, 0x7B, 0x5D, 0x27, 0xF8, 0x77, 0x0C, 0x9F, 
+0x21, 0xBA, 0xD5, 
+
+0xB3, 0x81, 0xFD, 0x78, 0xA3, 0x15, 0x07, 0xFE, 
+0xD0, 0x0A, 0xB1, 0xCD, 0x44, 0x19, 0xC9, 0xA7, 
+0xE2, 0x87, 0xCF, 0x88, 0xFA, 0x40, 0x56, 0xD1, 
+0xC0, 0xCE, 0xD8, 0xE1, 0x31, 0xFE, 0xF8, 0x64, 
+0x6C, 0x25, 0xA3, 0x58, 0x73, 0xC6, 0x06, 0x44, 
+0x47, 0x9C, 0x66, 0x7F, 0x89, 0x15, 0x2E, 0x15, 
+0xDB, 0x44, 0x2B, 0x36, 0x5A, 0x0F, 0xAC, 0xAC, 
+0x41, 0x5D, 0x8D, 0xF6, 0x82, 0x61, 0xA5, 0x5F, 
+0x04, 0x2E, 0xE2, 0x86, 0x4F, 0x98, 0x40, 0xAA, 
+0xD5, 0xD6, 0x08, 0x02, 0xB7, 0x4F, 0x73, 0xF5, 
+0xEB, 0xDC, 0x31, 0x51, 0x87, 0x77, 0xAB, 0x3B, 
+0x6D, 0xCD, 0x84, 0x7C, 0xC9, 0xDE, 0x3B, 0x18, 
+0x0E, 0xE3, 0x31, 0x10, 0xD7, 0xC7, 0x1B, 0xED, 
+0xC4, 0x44, 0x38, 0x07, 0x9D, 0x8D, 0xA9, 0x6A, 
+0xE7, 0x3B, 0x15, 0x9C, 0xC6, 0x08, 0xE9, 0x78, 
+0x82, 0xDB, 0xD1, 0x6E, 0xD1, 0x6A, 0xB9, 0x19, 
+0xF3, 0x9B, 0x00, 0xD9, 0x1A, 0x1A, 0x6C, 0x62, 
+0xC2, 0x4D, 0x03, 0xC3, 0xF1, 0x65, 0xE8, 0x2F, 
+0xE6, 0xD2, 0x5B, 0x07, 0x88, 0xFB, 0xC5, 0x27, 
+0x41, 0xC3, 0xF6, 0xC6, 0xC2, 0xB7, 0x36, 0x44, 
+0xC6, 0x94, 0xD7, 0xCD, 0x65, 0x20, 0xBA, 0x75, 
+0xF6, 0x27, 0xE7, 0xAC, 0xFA, 0xA4, 0x9D, 0x1F, 
+0xC6, 0x08, 0xDF, 0x6E, 0xD0, 0x61, 0x6E, 0x8B, 
+0x29, 0x92, 0xA8, 0x49, 0xE5, 0xE7, 0x3F, 0x3D, 
+0x63, 0x49, 0x27, 0x36, 0xB8, 0xA6, 0x23, 0xBA, 
+0xA4, 0xE2, 0x05, 0x07, 
+
+0xA8, 0xBC, 0x1F, 0x2A, 0x63, 0x31, 0xC5, 0x24, 
+0xCA, 0xA0, 0x6E, 0x5B, 0x7E, 0xBF, 0xAA, 0x29, 
+0xBB, 0xB2, 0xA4, 0x7F, 0xF1, 0x25, 0x1A, 0xCF, 
+0x4B, 0xA3, 0x43, 0xAF, 0x4A, 0x2A, 0x79, 0x49, 
+0x7B, 0xD1, 0xAE, 0xE5, 0x13, 0x3B, 0xF2, 0x36, 
+0x83, 0xD2, 0x57, 0xE9, 0xFD, 0x7E, 0x4D, 0x01, 
+0x3D, 0x15, 0x72, 0xAF, 0x8C, 0x9C, 0xDB, 0xFD, 
+0xC3, 0xA2, 0xF8, 0xF6, 0x8C, 0x55, 0x49, 0xB9, 
+0xA3, 0xC1, 0xF9, 0x42, 0xB6, 0x98, 0xF4, 0x33, 
+0x6C, 0x14, 0xD3, 0x67, 0x44, 0x84, 0x7C, 0xED, 
+0x41, 0x61, 0xC7, 0xD1, 0xEB, 0x3D, 0x68, 0x26, 
+0xBA, 0x5B, 0x74, 0xB3, 0x12, 0xBF, 0xC9, 0x6F, 
+0x5D, 0xB4, 0x7D, 0x33, 0x19, 0xA2, 0xB7, 0x89, 
+0x69, 0x41, 0xE0, 0x63, 0x39, 0xDF, 0x11, 0xEE, 
+0x89, 0xBD, 0x82, 0x1B, 0xBC, 0xD4, 0x55, 0x7D, 
+0xBA, 0x27, 0xB6, 0x29, 0x20, 0xB6, 0xAF, 0x3F, 
+0x3C, 0x66, 0xF5, 0x82, 0x7A, 0xA4, 0xBA, 0x02, 
+0xCF, 0xC8, 0x70, 0xD1, 0x7B, 0x90, 0x1B, 0x58, 
+0x9B, 0x05, 0x66, 0x0E, 0xA9, 0xF8, 0x07, 0x52, 
+0x3A, 0xCB, 0x4C, 0x58, 0xBD, 0xD6, 0x53, 0xF7, 
+0x6B, 0x9C, 0x1D, 0x05, 0x4F, 0x4E, 0xCB, 0x14, 
+0xA7, 0x5B, 0x33, 0xB9, 0x83, 0x4A, 0x93, 0xEB, 
+0x73, 0xAB, 0xCF, 0x0E, 0x4E, 0xF2, 0x31, 0x2F, 
+0xED, 0x93, 0xFA, 0xDC, 0x44, 0xF5, 0xCB, 0x30, 
+0x9A, 0xF9, 0x41, 0xC6, 0x29, 0x03, 0x51, 0x61, 
+0x42, 0x42, 0xCA, 0x22, 0x48, 
+
+0x81, 0xB2, 0xBE, 0xEC, 0x28, 0x75, 0xA6, 0x88, 
+0x71, 0x66, 0x44, 0xF2, 0xB9, 0xA4, 0x2B, 0x7E, 
+0x34, 0x9F, 0xBB, 0x1A, 0xA0, 0x8E, 0xB0, 0x58, 
+0x6B, 0x01, 0xE0, 0x74, 0xBE, 0x9B, 0x73, 0xDB, 
+0x57, 0x95, 0x28, 0xBF, 0xD8, 0x27, 0x9E, 0x42, 
+0x01, 0x43, 0x73, 0x08, 0xF9, 0xE8, 0xFF, 0xC2, 
+0x1E, 0xEA, 0x30, 0xA1, 0xD4, 0x35, 0xBD, 0x18, 
+0xDB, 0x4A, 0xFD, 0x99, 0x28, 0xEA, 0x9B, 0xAC, 
+0x3C, 0xE4, 0x7B, 0x10, 0x72, 0x0D, 0x35, 0xFD, 
+0x40, 0xA0, 0xF3, 0xE4, 0xB5, 0xA5, 0xDB, 0xAB, 
+0x8E, 0x23, 0x69, 0xF5, 0xF9, 0xB8, 0x6B, 0x00, 
+0x60, 0x29, 0xAA, 0x74, 0xCA, 0x36, 0xE4, 0xD4, 
+0x43, 0xCC, 0xE0, 0xB3, 0xF7, 0x74, 0xF1, 0x69, 
+0xF1, 0x2B, 0x9D, 0x03, 0x6E, 0x7B, 0x27, 0x3F, 
+0x3B, 0xB2, 0x41, 0x04, 0x17, 0x5A, 0x59, 0xAD, 
+0xAB, 0x0B, 0x50, 0x5A, 0x69, 0x55, 0xAE, 0xB8, 
+0x4E, 0xCF, 0x35, 0x96, 0xAB, 0xD7, 0xC0, 0xEB, 
+0xED, 0x12, 0xF4, 0x69, 0xEE, 0xBF, 0x62, 0xD9, 
+0xBE, 0xF5, 0x19, 0xC8, 0xEF, 0xF0, 0x54, 0xCF, 
+0x8C, 0x7B, 0xD1, 0xF6, 0x9A, 0xE8, 0xF8, 0x19, 
+0x7E, 0xE6, 0x87, 0x40, 0x39, 0x95, 0x54, 0x2F, 
+0xAA, 0xED, 0xF4, 0x0D, 0x41, 0x3B, 0xE8, 0x37, 
+0xDA, 0xDF, 0x6D, 0x2B, 0xE5, 0xED, 0xBC, 0xBA, 
+0x49, 0x38, 0x6B, 0x86, 0xDC, 0xD0, 0xC7, 0x59, 
+0xB2, 0x2F, 0xC4, 0x00, 0x24, 0xFF, 0x2C, 0x29, 
+0x91, 0x89, 0xCE, 0xB5, 0xA3, 0x01, 
+
+0xF7, 0xF0, 0xD8, 0xBE, 0x57, 0x34, 0x32, 0x02, 
+0xF2, 0x39, 0x72, 0x4A, 0x04, 0xE0, 0xEB, 0x89, 
+0x66, 0x53, 0x71, 0x20, 0x53, 0xE8, 0x29, 0x17, 
+0x09, 0xD2, 0xD8, 0x31, 0x15, 0x9F, 0x66, 0x8F, 
+0xD4, 0x61, 0xB5, 0x0A, 0xF3, 0x7C, 0xB8, 0xE5, 
+0xCD, 0x13, 0xC5, 0xA6, 0x0D, 0x26, 0x81, 0xDE, 
+0x1E, 0x05, 0x28, 0x7D, 0x1C, 0xCB, 0xAC, 0x14, 
+0x51, 0x06, 0xCB, 0x44, 0x92, 0xD5, 0x48, 0x04, 
+0xA0, 0x10, 0x45, 0xAD, 0x26, 0xD3, 0xDB, 0xE5, 
+0xE3, 0xAF, 0x05, 0x10, 0x8B, 0x2A, 0x99, 0xB5, 
+0x4D, 0xE9, 0x88, 0xDF, 0x88, 0x1A, 0xD1, 0x13, 
+0x54, 0x5F, 0x8A, 0x25, 0x4F, 0x5A, 0xA9, 0x27, 
+0x9D, 0xD4, 0x7F, 0x8B, 0x13, 0xB7, 0xCF, 0x37, 
+0x36, 0x69, 0x65, 0x24, 0x36, 0x57, 0x39, 0x21, 
+0x14, 0xF0, 0x70, 0x1E, 0x40, 0xCB, 0x33, 0x78, 
+0x45, 0x1F, 0x9F, 0x90, 0x4A, 0xD4, 0xBF, 0xF8, 
+0x7C, 0x92, 0x16, 0x1A, 0xEE, 0xEA, 0xD5, 0x4A, 
+0x0C, 0xE4, 0x19, 0x13, 0xA9, 0x0F, 0xE2, 0x0C, 
+0x31, 0x08, 0xE1, 0x83, 0x66, 0x5D, 0x70, 0x02, 
+0xF7, 0x22, 0x2B, 0x19, 0xB6, 0xD0, 0xF9, 0x98, 
+0xC5, 0x4F, 0xEC, 0x6C, 0x92, 0x23, 0x37, 0x55, 
+0xA3, 0xAD, 0xA2, 0xD3, 0xFE, 0xA6, 0x11, 0xC8, 
+0x9A, 0x54, 0x19, 0xE4, 0x03, 0x4A, 0x97, 0xE5, 
+0xB3, 0x00, 0x4A, 0xD7, 0x8B, 0x03, 0x1E, 0xE8, 
+0x48, 0x4B, 0xB9, 0x43, 0x8C, 0x80, 0xC4, 0x19, 
+0x6C, 0xE8, 0xD7, 0xD4, 0xA3, 0xD7, 0x05, 
+
+0x50, 0x04, 0xD3, 0xA5, 0xC7, 0x66, 0x20, 0x16, 
+0x94, 0x65, 0xAA, 0xA8, 0x38, 0xC6, 0x4B, 0x63, 
+0x5E, 0xB1, 0x7A, 0xED, 0x51, 0x1C, 0xB6, 0xC0, 
+0xF1, 0xF7, 0x60, 0xF6, 0x4E, 0xA6, 0x00, 0x6B, 
+0x8F, 0x7B, 0x73, 0xDB, 0x8A, 0xBB, 0x19, 0xDE, 
+0x12, 0x22, 0xBE, 0xC7, 0x14, 0x93, 0x10, 0x78, 
+0x65, 0x5B, 0x13, 0x9B, 0x8C, 0xC7, 0xF8, 0xAB, 
+0x41, 0x67, 0x1F, 0xD9, 0x94, 0xB4, 0x2E, 0xEA, 
+0xD8, 0x29, 0x00, 0x26, 0x16, 0x25, 0xA9, 0xA1, 
+0xB0, 0x04, 0x9D, 0x6C, 0xCA, 0xF0, 0xDA, 0xA5, 
+0xA4, 0x1F, 0xA8, 0x4E, 0xFA, 0x30, 0x8E, 0x05, 
+0x4D, 0x88, 0x70, 0x23, 0x27, 0x01, 0x03, 0x17, 
+0x11, 0x05, 0x5C, 0xCB, 0x74, 0xBF, 0x20, 0x81, 
+0x5F, 0xC3, 0x92, 0xBD, 0xBD, 0xA3, 0xD1, 0xA4, 
+0x77, 0xE0, 0x6D, 0x97, 0x12, 0x46, 0x21, 0x42, 
+0xF7, 0xD3, 0xA7, 0xA0, 0x48, 0x6B, 0xC9, 0x97, 
+0x9A, 0xBE, 0xF9, 0xBD, 0x9D, 0x97, 0xF5, 0xB7, 
+0x58, 0x5E, 0xF4, 0x3B, 0x8C, 0x27, 0x83, 0xBC, 
+0xDF, 0x66, 0xE5, 0xC3, 0x8C, 0x2C, 0xE5, 0xAE, 
+0xBA, 0x34, 0x0A, 0xE5, 0x47, 0x04, 0x5E, 0x7F, 
+0x60, 0x41, 0x33, 0x1C, 0xA9, 0xA2, 0x51, 0x26, 
+0x5E, 0x43, 0x62, 0xCF, 0x7E, 0xA9, 0x9E, 0x60, 
+0x80, 0xCD, 0xE5, 0xE5, 0x0A, 0xB3, 0xF0, 0x8F, 
+0x2F, 0xCC, 0xE7, 0x70, 0x9F, 0x50, 0xE7, 0x9E, 
+0x17, 0xBB, 0x0B, 0xD8, 0x2B, 0xA1, 0xB0, 0x81, 
+0xAE, 0x9D, 0x68, 0x6A, 0x1B, 0x37, 0xBA, 0x08, 
+
+0x20, 0x43, 0x50, 0x8B, 0x58, 0xFB, 0x49, 0x9D, 
+0x17, 0x97, 0x72, 0x0A, 0x36, 0xF0, 0x1A, 0x01, 
+0xFD, 0xF9, 0xFC, 0x19, 0xC8, 0x04, 0x2F, 0x33, 
+0x9D, 0xCB, 0x26, 0xF0, 0x4B, 0xBB, 0xE0, 0xF8, 
+0x33, 0x04, 0x39, 0x78, 0xA8, 0xFE, 0x88, 0xE2, 
+0x13, 0x47, 0x87, 0x95, 0x10, 0x98, 0x2F, 0x65, 
+0x0F, 0xC7, 0xDB, 0xD6, 0x85, 0x7E, 0x28, 0x0B, 
+0x5C, 0x4F, 0x2F, 0xAB, 0x08, 0xD5, 0x0B, 0x0F, 
+0x38, 0xFB, 0xC2, 0x4F, 0x5F, 0x42, 0xA7, 0x11, 
+0x6E, 0x45, 0x00, 0x69, 0x4E, 0x33, 0x74, 0x6B, 
+0x4F, 0x2E, 0xBB, 0xD0, 0x5B, 0x94, 0x81, 0x50, 
+0xAE, 0xCD, 0x27, 0xC0, 0x15, 0x9C, 0xA0, 0x14, 
+0x9A, 0x49, 0x72, 0x63, 0xDD, 0xF7, 0x94, 0xEE, 
+0xFB, 0x23, 0x04, 0x82, 0x88, 0xFA, 0x13, 0x58, 
+0x56, 0x54, 0x77, 0x26, 0x99, 0x6A, 0xBD, 0x1F, 
+0xC8, 0xD9, 0x6B, 0xE9, 0xA2, 0x9E, 0xCB, 0x65, 
+0x24, 0x24, 0x03, 0x8F, 0x7C, 0x69, 0xF1, 0x32, 
+0x66, 0xA8, 0xE6, 0x18, 0xE5, 0xA7, 0x1C, 0x42, 
+0xFA, 0xF9, 0xD3, 0x4A, 0x04, 0xEE, 0x53, 0x49, 
+0xD0, 0x67, 0xBB, 0xAC, 0xB7, 0xD0, 0x16, 0x3C, 
+0x0A, 0xAB, 0x44, 0xE9, 0xCF, 0x8C, 0xC7, 0xFE, 
+0x28, 0x0D, 0x3F, 0xC2, 0x0D, 0xC9, 0x13, 0x34, 
+0x23, 0xF4, 0xA9, 0x3E, 0x29, 0xDF, 0x6E, 0xD0, 
+0xB2, 0xE9, 0x59, 0x98, 0x97, 0xCD, 0x5D, 0xB9, 
+0xF7, 0x94, 0xC0, 0x8D, 0x31, 0xB8, 0x38, 0x3D, 
+0x2D, 0x54, 0x3E, 0x6E, 0x63, 0xBB, 0x2A, 0x4A, 
+0x33, 
+
+0x33, 0x37, 0xA3, 0xB3, 0x5C, 0x4E, 0xF6, 0x5E, 
+0xBE, 0xC5, 0x4D, 0x8B, 0xC8, 0x3A, 0x73, 0x11, 
+0x14, 0x9C, 0xA0, 0x1F, 0x19, 0x2E, 0xDE, 0x9A, 
+0x32, 0xDA, 0xC5, 0x86, 0x82, 0xE9, 0x52, 0x5D, 
+0xE4, 0xDF, 0xF7, 0xFA, 0x84, 0xD2, 0x2F, 0x97, 
+0x2D, 0xBA, 0xCF, 0x8A, 0x4F, 0x18, 0xC2, 0x66, 
+0x00, 0xDC, 0x4B, 0x1F, 0x3C, 0x40, 0x1D, 0x12, 
+0x5C, 0x12, 0x7A, 0xD0, 0xA7, 0x63, 0xE1, 0x4B, 
+0x71, 0x8A, 0xAE, 0x78, 0x86, 0xDB, 0x7E, 0xAD, 
+0x82, 0xF6, 0x78, 0x97, 0x33, 0xFF, 0xDD, 0x41, 
+0x45, 0x8F, 0x13, 0x4C, 0x41, 0x46, 0x1D, 0x70, 
+0xF2, 0xCA, 0xB8, 0x97, 0x8B, 0x89, 0x0F, 0x13, 
+0xD1, 0xAE, 0xF8, 0xC0, 0x6C, 0xC5, 0x06, 0xF4, 
+0xCB, 0x0A, 0xC0, 0x90, 0xD9, 0x6A, 0x11, 0xDC, 
+0x39, 0xBB, 0x28, 0x3A, 0x68, 0xB0, 0x01, 0x28, 
+0xDD, 0xFC, 0x6C, 0xA8, 0x9F, 0x7B, 0x49, 0x3C, 
+0xC0, 0xCE, 0x46, 0x34, 0xE7, 0x77, 0x03, 0x4A, 
+0xDD, 0x92, 0x7C, 0xF7, 0xD0, 0x8B, 0x24, 0x9B, 
+0x94, 0x23, 0xE7, 0x3A, 0x27, 0xFD, 0x9E, 0x8A, 
+0x2F, 0x90, 0x51, 0x83, 0xCA, 0x60, 0xCA, 0x77, 
+0x2D, 0x8C, 0x7D, 0xAA, 0x62, 0x4F, 0xDC, 0x87, 
+0x67, 0xAD, 0x78, 0x18, 0xBF, 0x8A, 0xCB, 0x5B, 
+0x48, 0x7D, 0x21, 0xF7, 0x15, 0x8C, 0x09, 0xC0, 
+0x8C, 0x82, 0x45, 0xA1, 0xEF, 0x4F, 0xFD, 0x7D, 
+0x7F, 0x56, 0x65, 0x5B, 0x94, 0xDC, 0xF6, 0x0E, 
+0x9D, 0xBF, 0xC3, 0xF3, 0xDE, 0x09, 0xF6, 0x5B, 
+0xA0, 0x48, 
+
+0x73, 0xEA, 0xF4, 0xCA, 0x05, 0x5C, 0x05, 0x1A, 
+0x94, 0xAE, 0x2F, 0xEF, 0xAB, 0xF1, 0xDF, 0x1D, 
+0x11, 0x38, 0xFC, 0x06, 0x87, 0x27, 0xA4, 0xE9, 
+0xFC, 0xD7, 0xBB, 0xCB, 0x2B, 0x98, 0x78, 0xDE, 
+0x7B, 0x40, 0x5F, 0xA1, 0xBF, 0xCF, 0xF2, 0xF7, 
+0x29, 0x1A, 0x72, 0x47, 0x7B, 0x86, 0xC8, 0x67, 
+0x9C, 0xCE, 0x90, 0x85, 0xC6, 0xA2, 0x48, 0xB9, 
+0x01, 0xB3, 0x5F, 0x68, 0xF3, 0x43, 0x13, 0xE6, 
+0x20, 0x4B, 0xB2, 0xC2, 0xEC, 0xAF, 0x4C, 0x70, 
+0x80, 0xCD, 0x13, 0x11, 0x9C, 0x42, 0xFA, 0x51, 
+0x0A, 0x69, 0x88, 0x4C, 0xEC, 0x8E, 0xAB, 0x45, 
+0x16, 0x24, 0xB1, 0xA0, 0x1E, 0x7E, 0x54, 0x32, 
+0x2C, 0xD1, 0x5F, 0x94, 0x3A, 0x3B, 0xDC, 0x3E, 
+0xA6, 0x54, 0xCC, 0xB4, 0xFD, 0x5A, 0x51, 0x52, 
+0xFF, 0x06, 0xC0, 0x8B, 0xD5, 0x16, 0x06, 0xBF, 
+0x7B, 0x6D, 0x74, 0xA1, 0x47, 0x5E, 0x8D, 0x6C, 
+0xA3, 0x31, 0xC5, 0x1E, 0x1A, 0x55, 0x9B, 0x06, 
+0xBD, 0xA5, 0xF1, 0x2B, 0x4B, 0x32, 0x0B, 0x43, 
+0xDD, 0xCA, 0x33, 0xFD, 0x27, 0xBB, 0x16, 0xAD, 
+0xC5, 0xD9, 0x2A, 0x07, 0xE9, 0xAC, 0x6F, 0xCB, 
+0x3D, 0x17, 0xD2, 0xEC, 0x2F, 0x10, 0xA2, 0x0F, 
+0xCC, 0x23, 0xBE, 0x79, 0x02, 0xF3, 0x62, 0x2C, 
+0xE4, 0xE1, 0xFA, 0x7E, 0xCD, 0xDA, 0x2F, 0x19, 
+0x7A, 0xE4, 0xA0, 0x07, 0xF2, 0x69, 0x2E, 0x43, 
+0x92, 0x57, 0xEC, 0xA4, 0x70, 0x28, 0x5D, 0x63, 
+0x83, 0xAC, 0x3F, 0x5A, 0x3E, 0x7C, 0x20, 0xA7, 
+0x22, 0x4D, 0x9F, 
+
+0x94, 0x37, 0x7C, 0x1F, 0x68, 0x30, 0x1F, 0x83, 
+0x3B, 0xB7, 0xB0, 0x8D, 0x49, 0xB3, 0xE4, 0x85, 
+0xDA, 0xF5, 0x8A, 0x0E, 0x56, 0x41, 0x42, 0xAD, 
+0xEF, 0x02, 0xAA, 0xBA, 0x31, 0x59, 0x51, 0x15, 
+0xEB, 0xF7, 0x68, 0x06, 0xAE, 0x4A, 0xCF, 0x21, 
+0x24, 0xBE, 0xF1, 0x57, 0x9E, 0xC5, 0xC0, 0xCF, 
+0xE0, 0x1A, 0xC3, 0x9C, 0x70, 0xD3, 0xE4, 0x85, 
+0x4E, 0xAF, 0x68, 0x4F, 0x55, 0xF1, 0xD9, 0xD5, 
+0xEF, 0x41, 0xE5, 0xB6, 0xA2, 0xBB, 0x83, 0xA5, 
+0xC3, 0xE6, 0xF3, 0xB5, 0x24, 0x52, 0x3E, 0x77, 
+0x0D, 0x0D, 0x1F, 0xA7, 0xE8, 0x81, 0xE9, 0x0C, 
+0x62, 0x6F, 0x16, 0xE3, 0xFA, 0xFD, 0x23, 0x2C, 
+0xDE, 0xF7, 0x6F, 0x0D, 0x18, 0x06, 0x8A, 0x18, 
+0xE0, 0x40, 0x0C, 0x62, 0xC6, 0xE7, 0x63, 0xF2, 
+0xD2, 0x09, 0x0F, 0xA8, 0x3B, 0x3E, 0xA5, 0x0C, 
+0xF2, 0x48, 0x2C, 0x43, 0x9F, 0xB9, 0x6F, 0x38, 
+0xDC, 0x46, 0x98, 0x0C, 0x8B, 0x11, 0xF8, 0x04, 
+0x64, 0x63, 0x89, 0xA5, 0x73, 0x7D, 0xD8, 0x43, 
+0xF9, 0x56, 0x09, 0xC5, 0x2B, 0x7F, 0xFA, 0xC1, 
+0x24, 0x5C, 0xD7, 0xC3, 0x35, 0xE7, 0x66, 0xD1, 
+0x73, 0x88, 0xD1, 0x3F, 0xA2, 0x60, 0xB8, 0xB8, 
+0x98, 0x8A, 0x3D, 0xC7, 0xC6, 0xBC, 0xEE, 0x19, 
+0xA0, 0x3B, 0x79, 0x34, 0x50, 0xA3, 0xD2, 0x5E, 
+0xF1, 0x50, 0x96, 0x0F, 0x40, 0x88, 0xE7, 0x97, 
+0x09, 0xC1, 0x05, 0x72, 0x41, 0x96, 0x4C, 0x7B, 
+0xC1, 0x64, 0xA3, 0x23, 0xC1, 0x32, 0x81, 0xC9, 
+0x92, 0x58, 0xA2, 0x5C, 
+
+0xD8, 0xFC, 0xD2, 0xC4, 0x76, 0xC6, 0x55, 0xFA, 
+0x2C, 0x73, 0x10, 0x26, 0xE8, 0x2C, 0xF1, 0xF7, 
+0xE3, 0x15, 0xE4, 0xC7, 0x2B, 0x4F, 0x18, 0x50, 
+0xD2, 0xC9, 0x71, 0x8D, 0xB2, 0x1C, 0xE2, 0xDE, 
+0xD9, 0x9F, 0x61, 0xEF, 0x07, 0xF7, 0x85, 0x5B, 
+0x4F, 0x05, 0xF0, 0x0E, 0xE4, 0xD5, 0x21, 0x7A, 
+0x15, 0xE6, 0x32, 0xCA, 0xCA, 0x3D, 0xFD, 0x1E, 
+0x4B, 0xA8, 0x38, 0x61, 0xD0, 0x98, 0xFF, 0x91, 
+0x6E, 0x76, 0x1F, 0xE1, 0xDF, 0xDB, 0x9E, 0x1F, 
+0x40, 0x15, 0xC9, 0xF0, 0x00, 0x48, 0xCA, 0xC9, 
+0xC0, 0xE3, 0xC1, 0xA6, 0x1E, 0xB2, 0xBB, 0x4D, 
+0x17, 0x7E, 0xDB, 0x29, 0x23, 0x48, 0xF0, 0x9F, 
+0xD3, 0xD5, 0xE1, 0xA6, 0xA8, 0x6E, 0xB2, 0x75, 
+0x9A, 0xB6, 0xEC, 0x7A, 0x4A, 0xDA, 0x24, 0xC9, 
+0x90, 0x1C, 0x37, 0xD8, 0x1B, 0x70, 0xD1, 0x8A, 
+0x0C, 0xA8, 0xCB, 0xA4, 0x3E, 0x0B, 0x2A, 0x53, 
+0xB9, 0x65, 0xE9, 0x77, 0xE8, 0x5B, 0x7B, 0xBF, 
+0x60, 0x40, 0xF5, 0x97, 0xE9, 0xA0, 0xE0, 0x8F, 
+0x7F, 0x9A, 0x5F, 0x1A, 0xE1, 0xA5, 0xD0, 0xD1, 
+0xEE, 0x41, 0xEB, 0x7E, 0x77, 0xEF, 0xCB, 0xB5, 
+0x84, 0x42, 0x47, 0x41, 0xE9, 0xB8, 0xF7, 0x24, 
+0x58, 0xC5, 0x6C, 0xD5, 0x62, 0xA5, 0x3B, 0x0C, 
+0x4C, 0xF4, 0x19, 0x37, 0x72, 0x03, 0xF1, 0xBD, 
+0x67, 0x94, 0x3E, 0x59, 0xC0, 0xEB, 0xE8, 0xFA, 
+0x27, 0xA8, 0x02, 0xBB, 0xD3, 0x52, 0x71, 0x98, 
+0xBD, 0xA8, 0x65, 0x35, 0x7A, 0xA0, 0xF1, 0x74, 
+0x3B, 0x4A, 0xBC, 0xA0, 0xBE, 
+
+0xD4, 0x6B, 0x9D, 0xDC, 0x04, 0xB4, 0x63, 0x5F, 
+0x10, 0xA9, 0x32, 0x2F, 0x24, 0x52, 0x38, 0x37, 
+0x44, 0x01, 0x67, 0x11, 0x60, 0x0E, 0xC7, 0xAF, 
+0xD5, 0xD8, 0xC8, 0xA6, 0x0B, 0xCE, 0xF7, 0x7F, 
+0xA0, 0xB6, 0x1C, 0xA0, 0x98, 0x1C, 0x3F, 0x6D, 
+0x46, 0x73, 0x28, 0x7E, 0x56, 0x80, 0x37, 0x38, 
+0x93, 0x6B, 0x45, 0x22, 0xC9, 0x3F, 0x52, 0x53, 
+0xEA, 0x0D, 0x87, 0x90, 0xEB, 0x57, 0x07, 0x9E, 
+0xCA, 0xE3, 0x47, 0x8B, 0xCD, 0x8B, 0x25, 0x82, 
+0xE3, 0x50, 0x59, 0x3F, 0xD3, 0x7C, 0x63, 0xAE, 
+0xA8, 0xB3, 0xEE, 0x38, 0x9C, 0xFF, 0x8F, 0x21, 
+0x7D, 0x6B, 0xA1, 0xA1, 0x17, 0x06, 0x18, 0xC7, 
+0x1A, 0x41, 0x01, 0xD8, 0xF7, 0x3F, 0xC2, 0x63, 
+0x8A, 0x64, 0x7C, 0x66, 0x6A, 0x2A, 0x6C, 0x90, 
+0x18, 0x58, 0xE7, 0x66, 0xF3, 0x81, 0xC6, 0x5B, 
+0xFF, 0x55, 0x76, 0xCD, 0x48, 0xC5, 0xBD, 0x50, 
+0xF3, 0xAA, 0xFF, 0xBF, 0x5F, 0x74, 0xF6, 0x16, 
+0x02, 0x1E, 0x0A, 0x44, 0xD0, 0x06, 0xD1, 0x92, 
+0x95, 0x3D, 0xDC, 0xF3, 0xEF, 0x32, 0xE4, 0x18, 
+0x65, 0x1D, 0x1E, 0x41, 0x12, 0x9A, 0xD7, 0x23, 
+0x06, 0x6A, 0x23, 0x9E, 0x12, 0x48, 0xAD, 0x2E, 
+0x42, 0xAA, 0x66, 0x69, 0x62, 0xBD, 0x7F, 0x0E, 
+0x0C, 0x93, 0xA1, 0xBC, 0xD5, 0xA6, 0xA4, 0x24, 
+0xD9, 0xDA, 0xD4, 0xDC, 0xA5, 0x5A, 0x5F, 0xB7, 
+0xD8, 0x2D, 0x67, 0x3E, 0xA1, 0x00, 0x5B, 0xCD, 
+0xCE, 0xC8, 0x1C, 0x52, 0x44, 0xE5, 0x80, 0x47, 
+0xA8, 0xBD, 0x65, 0x6C, 0x6F, 0x3C, 
+
+0xFE, 0xA0, 0xA5, 0x60, 0x1E, 0x2D, 0x36, 0x6D, 
+0x6A, 0xBB, 0xD7, 0x71, 0x16, 0x0C, 0x80, 0xA3, 
+0x8F, 0x39, 0x78, 0x89, 0x75, 0x12, 0xD9, 0x10, 
+0xB4, 0xD6, 0x67, 0x58, 0x64, 0x0E, 0x74, 0x73, 
+0x4C, 0x2E, 0x10, 0x53, 0x05, 0x99, 0xA7, 0x85, 
+0xF2, 0x73, 0xCE, 0x8B, 0x96, 0xC4, 0xBF, 0xB4, 
+0xB4, 0x31, 0x23, 0x67, 0x21, 0xC1, 0xF3, 0x58, 
+0x55, 0x0A, 0xE0, 0x3B, 0xC7, 0xC6, 0x0B, 0x61, 
+0xFA, 0x1D, 0x7F, 0xFA, 0xF2, 0x84, 0x79, 0x4F, 
+0xF4, 0xA5, 0x74, 0x83, 0xB2, 0xFD, 0xCF, 0x00, 
+0xBC, 0x1F, 0xD1, 0x6D, 0x87, 0x88, 0xA6, 0x9A, 
+0xD8, 0x67, 0x56, 0x2D, 0x44, 0xAD, 0xA4, 0x18, 
+0xD6, 0x3D, 0x32, 0xBE, 0x52, 0x6D, 0x42, 0x21, 
+0xFA, 0x09, 0x9F, 0xCF, 0x85, 0xCA, 0x65, 0x62, 
+0x82, 0xC3, 0x8A, 0xE4, 0xA1, 0xC5, 0x63, 0x98, 
+0x51, 0x14, 0xBB, 0x95, 0xAE, 0x16, 0xEE, 0x91, 
+0xC5, 0x1B, 0x1A, 0x65, 0xDC, 0x5D, 0x5F, 0x1B, 
+0x22, 0xD1, 0x35, 0x6A, 0x37, 0xCC, 0xB4, 0xA4, 
+0x41, 0x2A, 0xB0, 0x4C, 0x86, 0xB4, 0xF4, 0xE1, 
+0x3D, 0x24, 0x30, 0x7E, 0xA6, 0xC1, 0x0F, 0x96, 
+0xA4, 0x36, 0xAF, 0x06, 0xE5, 0x40, 0xCE, 0x22, 
+0x4C, 0x2E, 0x8E, 0xBC, 0x5B, 0xAD, 0xFF, 0xF6, 
+0xF0, 0x03, 0xCD, 0x39, 0x02, 0xEC, 0x2C, 0x36, 
+0xB4, 0xB3, 0x3B, 0xCC, 0x7B, 0xDB, 0xEB, 0x1A, 
+0xC6, 0x03, 0x0C, 0xDF, 0x65, 0x05, 0xF2, 0xD2, 
+0x28, 0x4B, 0xAF, 0xE6, 0xD4, 0x66, 0xB6, 0xA6, 
+0x92, 0x40, 0x4A, 0x70, 0x0F, 0x3E, 0xEE, 
+
+0x0E, 0xB6, 0x22, 0xAF, 0x8C, 0xA4, 0x85, 0x62, 
+0x59, 0x06, 0x99, 0xF1, 0xCB, 0x5C, 0xA4, 0xAA, 
+0xC8, 0xEF, 0x6A, 0x42, 0xB9, 0xF6, 0x32, 0x62, 
+0x5F, 0x48, 0x68, 0x24, 0xEB, 0xCF, 0xCF, 0xEA, 
+0x1D, 0x86, 0x05, 0x2D, 0x75, 0xA1, 0x3B, 0xC5, 
+0xF6, 0x47, 0xA7, 0x3F, 0x3C, 0x6A, 0x68, 0x38, 
+0x40, 0x87, 0x92, 0x0A, 0x22, 0xD6, 0x1B, 0x52, 
+0x50, 0xAE, 0x5C, 0xA1, 0x58, 0x79, 0xCD, 0x0D, 
+0x25, 0xD6, 0x91, 0xEB, 0xF9, 0x9A, 0x40, 0x9C, 
+0xCC, 0xEA, 0xFC, 0x0E, 0x23, 0x18, 0x01, 0x27, 
+0xB2, 0x90, 0xC7, 0x40, 0xD4, 0xE4, 0xBF, 0x1F, 
+0x1A, 0x93, 0x0A, 0xAB, 0xDC, 0xAE, 0xD8, 0xB6, 
+0x1A, 0xB7, 0x5D, 0x7B, 0xCD, 0xBD, 0xA8, 0x52, 
+0xCB, 0x9F, 0x52, 0x49, 0x35, 0xB6, 0x24, 0x3C, 
+0x0A, 0x3B, 0x6F, 0xD0, 0x76, 0x0E, 0x9D, 0x21, 
+0x35, 0xF9, 0x2F, 0x58, 0xAA, 0x55, 0x8B, 0xB0, 
+0x6D, 0x8C, 0xE6, 0x4F, 0x91, 0x56, 0xA7, 0xBF, 
+0x7D, 0x1B, 0xC5, 0x84, 0x48, 0xBC, 0x91, 0xA8, 
+0xF8, 0x6F, 0xB7, 0x16, 0x39, 0x52, 0x7F, 0xB5, 
+0x5C, 0x94, 0x61, 0xB8, 0x21, 0x09, 0x2E, 0xE8, 
+0x36, 0xC9, 0x80, 0x54, 0x74, 0xAC, 0xFB, 0x0E, 
+0x83, 0x80, 0x2C, 0x68, 0x21, 0x2C, 0x5D, 0x36, 
+0x6F, 0x6F, 0xEC, 0x74, 0x23, 0x6F, 0xEB, 0xCB, 
+0x11, 0x41, 0x80, 0xF8, 0x86, 0x12, 0x90, 0x44, 
+0x30, 0x24, 0x55, 0x2E, 0xC0, 0x25, 0x81, 0x5B, 
+0x28, 0x76, 0x71, 0x85, 0x7D, 0x5B, 0x62, 0xEC, 
+0x53, 0xC9, 0xD0, 0x45, 0xD4, 0x95, 0xD6, 0xC2, 
+
+0xDE, 0x4F, 0x9F, 0xFE, 0xC9, 0xFC, 0x15, 0x6A, 
+0x13, 0xA5, 0x02, 0xF0, 0x13, 0xFD, 0x22, 0x5D, 
+0xBE, 0x90, 0x90, 0x1D, 0x1F, 0x9E, 0x68, 0x8C, 
+0xBF, 0xB0, 0x71, 0xA3, 0x16, 0x87, 0x81, 0xFF, 
+0xE6, 0x93, 0x73, 0x25, 0xB3, 0x7E, 0x15, 0xED, 
+0x92, 0x55, 0xD8, 0x20, 0xFF, 0x73, 0x16, 0x8E, 
+0x5C, 0x49, 0x75, 0xE0, 0x19, 0x81, 0x1C, 0x35, 
+0x15, 0x4B, 0xFF, 0x67, 0x0C, 0x97, 0x98, 0x6D, 
+0xA7, 0x6F, 0x4E, 0x96, 0xE5, 0x0B, 0x24, 0xF9, 
+0x0F, 0xBB, 0x86, 0x46, 0xF1, 0xFD, 0x7F, 0x05, 
+0x09, 0xD7, 0x07, 0xB1, 0x79, 0x9C, 0xDF, 0x83, 
+0xDD, 0xFD, 0xD5, 0xB3, 0x04, 0x53, 0x23, 0xC1, 
+0xFE, 0x49, 0x51, 0xDA, 0xA6, 0xD5, 0x23, 0xCA, 
+0x32, 0xD4, 0x6A, 0xA9, 0x8D, 0x86, 0x50, 0x98, 
+0x01, 0x7A, 0xFB, 0x1C, 0xFB, 0x79, 0xE6, 0x80, 
+0x3C, 0xD0, 0x20, 0xED, 0x42, 0xBE, 0x6F, 0x6A, 
+0x84, 0x4F, 0xD7, 0x40, 0x4D, 0x99, 0x77, 0xF7, 
+0x49, 0xFE, 0x5B, 0x9C, 0x29, 0x41, 0x6B, 0x06, 
+0xE5, 0x76, 0x9C, 0x9F, 0x34, 0xB1, 0x43, 0x75, 
+0x8B, 0x50, 0xCA, 0x33, 0x04, 0xAC, 0x83, 0x8C, 
+0x93, 0x9E, 0x45, 0x36, 0x60, 0x56, 0x5D, 0x60, 
+0x43, 0xB5, 0x58, 0x22, 0xEE, 0x2A, 0xC6, 0x76, 
+0x49, 0x6F, 0xCB, 0x4A, 0x3D, 0xFB, 0x17, 0x7B, 
+0x4C, 0x54, 0x0B, 0x64, 0xC3, 0xD6, 0x5E, 0x68, 
+0x0E, 0x52, 0xAB, 0x59, 0x3C, 0xBA, 0xC6, 0xAD, 
+0x36, 0x44, 0xE3, 0x31, 0x52, 0xEA, 0x0E, 0x53, 
+0x2F, 0xDB, 0xEA, 0x66, 0x0F, 0xDF, 0x84, 0x9D, 
+0x55, 
+
+0x02, 0x44, 0xC1, 0xD2, 0xA4, 0xB3, 0xB0, 0x19, 
+0x57, 0xE9, 0x2A, 0x38, 0x35, 0xC3, 0x3B, 0x79, 
+0x56, 0xE5, 0x9C, 0x26, 0xAC, 0x4C, 0x55, 0x06, 
+0x6A, 0x36, 0x3C, 0x63, 0xCB, 0x30, 0x73, 0xB6, 
+0x54, 0x08, 0xFB, 0x99, 0x78, 0xCF, 0x78, 0x56, 
+0xED, 0x0F, 0x1D, 0x99, 0xBE, 0xA4, 0x4E, 0x7C, 
+0xE4, 0xD1, 0xE1, 0xA1, 0x7F, 0x97, 0x92, 0x1F, 
+0xB8, 0x73, 0x7E, 0x97, 0xEB, 0xA4, 0xEE, 0x93, 
+0x7A, 0x02, 0xAF, 0x76, 0x83, 0x14, 0x56, 0x6E, 
+0x81, 0x85, 0xFB, 0xBA, 0x57, 0x80, 0xFC, 0xB2, 
+0xD9, 0xD6, 0xB8, 0xCD, 0x60, 0x01, 0x37, 0x6F, 
+0xFD, 0xBE, 0x72, 0x0A, 0x0E, 0xEA, 0x1B, 0x1D, 
+0x1A, 0xB2, 0xBB, 0xFD, 0xAB, 0x0E, 0x94, 0xF6, 
+0x08, 0x07, 0xA4, 0x98, 0xD3, 0xA8, 0xA6, 0x31, 
+0x86, 0xE6, 0xAE, 0x30, 0x05, 0x48, 0x9A, 0x37, 
+0x4A, 0xEE, 0x91, 0xA8, 0x65, 0x74, 0xA7, 0xF3, 
+0x8D, 0x69, 0x51, 0x6F, 0x8B, 0x0F, 0xF4, 0xE9, 
+0x23, 0x42, 0x09, 0x3C, 0x3C, 0x7B, 0x4A, 0x21, 
+0xB3, 0x35, 0xD7, 0xE2, 0x48, 0xD6, 0xCF, 0xB7, 
+0x07, 0x38, 0x9A, 0x4E, 0xE2, 0xC6, 0x30, 0x8D, 
+0x7F, 0x2C, 0xA7, 0x57, 0xAF, 0xAA, 0xC3, 0x39, 
+0xF2, 0x58, 0xC7, 0x39, 0x3D, 0x35, 0xB4, 0x2F, 
+0x0B, 0x44, 0xED, 0x0D, 0x45, 0x65, 0x8C, 0x04, 
+0x35, 0x19, 0xFC, 0xDA, 0x6C, 0x12, 0xAE, 0xC1, 
+0xAA, 0x5B, 0x23, 0x56, 0xFA, 0x00, 0x8C, 0xED, 
+0xB8, 0x6A, 0x5E, 0xEF, 0x50, 0xD4, 0x83, 0x29, 
+0xA3, 0x58, 0xC2, 0xCF, 0x4F, 0x40, 0x5C, 0xBA, 
+0xA2, 0xCE, 
+
+0xD6, 0x24, 0xAF, 0x4C, 0x8C, 0xFA, 0xD8, 0x99, 
+0xBA, 0x61, 0x1F, 0x0C, 0x61, 0x63, 0xB5, 0x54, 
+0xD7, 0x81, 0x3A, 0x44, 0xE8, 0x7E, 0xAB, 0x43, 
+0x2F, 0x2D, 0xE5, 0x06, 0xE1, 0x1D, 0xC1, 0x8D, 
+0x82, 0x1A, 0xDD, 0xE2, 0x92, 0x03, 0x76, 0xAE, 
+0xE1, 0xB1, 0xF4, 0x68, 0x41, 0xD4, 0x30, 0xCF, 
+0x85, 0x2A, 0xDA, 0x77, 0xC7, 0xF8, 0xB7, 0x0E, 
+0xDA, 0x00, 0x49, 0x24, 0xAC, 0x5F, 0xA7, 0xF5, 
+0x3D, 0x2E, 0x4C, 0xC1, 0xE7, 0x6C, 0xF9, 0x40, 
+0xE6, 0x7B, 0xCC, 0x4F, 0x75, 0x54, 0x16, 0xEA, 
+0x56, 0x4A, 0xB7, 0x1C, 0xF3, 0x23, 0xA1, 0xB8, 
+0xD1, 0xAA, 0xC1, 0x76, 0xA8, 0xB0, 0x61, 0xFC, 
+0x36, 0xCA, 0x7D, 0x42, 0x2A, 0x73, 0x7E, 0x0D, 
+0x06, 0x1A, 0x2B, 0x47, 0x10, 0xA9, 0x53, 0xD6, 
+0xE4, 0x1A, 0x9C, 0x56, 0x9B, 0xA9, 0x67, 0xF5, 
+0xF7, 0xF5, 0x32, 0x4B, 0xE2, 0x2F, 0x34, 0xE2, 
+0x61, 0x9A, 0x76, 0x07, 0x4D, 0xF3, 0x33, 0x9D, 
+0xFC, 0xFC, 0x22, 0x26, 0xBB, 0x2E, 0x55, 0xD6, 
+0xCF, 0xED, 0xA2, 0xE7, 0x2D, 0xB3, 0xF2, 0x42, 
+0x53, 0x09, 0xC2, 0xDF, 0x51, 0x5D, 0xCE, 0xE1, 
+0xD9, 0x3E, 0x2E, 0xE8, 0x98, 0x4B, 0xDE, 0x42, 
+0xC1, 0xBB, 0x88, 0xF5, 0x01, 0xD6, 0xD3, 0x23, 
+0x22, 0xD1, 0x39, 0x71, 0xF1, 0xC9, 0x2E, 0xC8, 
+0xAD, 0x0F, 0xFA, 0xEB, 0x6D, 0x47, 0xB1, 0x43, 
+0x60, 0x91, 0x49, 0x7E, 0xD7, 0x18, 0xB7, 0xBE, 
+0x66, 0x0C, 0xA5, 0xF9, 0x65, 0x62, 0x41, 0x4A, 
+0x9D, 0x77, 0xF7, 0x09, 0x3C, 0x98, 0x55, 0x46, 
+0x5E, 0xAF, 0x56, 
+
+0x8F, 0xA1, 0x40, 0xB9, 0xC4, 0x8E, 0x74, 0xE5, 
+0x4D, 0x77, 0xB3, 0x07, 0x2C, 0xDB, 0x4F, 0x40, 
+0xF8, 0x22, 0x87, 0x63, 0x32, 0x58, 0x16, 0x24, 
+0x43, 0x17, 0x0C, 0x8D, 0x63, 0xE3, 0x67, 0x92, 
+0x1B, 0x6E, 0x5F, 0xE5, 0xFC, 0x9B, 0xD5, 0xD5, 
+0x73, 0xE0, 0x57, 0xDE, 0x22, 0x70, 0x9C, 0xB9, 
+0x26, 0xB3, 0x41, 0x05, 0x62, 0x9D, 0x48, 0x64, 
+0x81, 0x81, 0x91, 0x58, 0xFC, 0xCB, 0xBC, 0x53, 
+0xE5, 0xC8, 0xB6, 0x2D, 0x00, 0xB6, 0x5F, 0xEB, 
+0xBC, 0x2C, 0x5A, 0xFD, 0xD9, 0xD4, 0x74, 0x58, 
+0x90, 0xE6, 0x37, 0xBF, 0xDE, 0x73, 0x35, 0x14, 
+0x37, 0x08, 0x21, 0x80, 0x1E, 0x68, 0x2B, 0xB4, 
+0x72, 0x7E, 0x81, 0x39, 0x7E, 0xF8, 0xDE, 0x07, 
+0xF3, 0xA3, 0xC0, 0xA1, 0x3F, 0x9A, 0xA4, 0x1D, 
+0x22, 0xF9, 0x52, 0x8F, 0x7D, 0x1D, 0x31, 0x6B, 
+0xE3, 0x81, 0xED, 0x97, 0x6F, 0x73, 0xA6, 0x00, 
+0xC9, 0x02, 0xD1, 0xA7, 0xBC, 0x9C, 0x63, 0xBB, 
+0xE3, 0x60, 0x3E, 0x20, 0x3F, 0x0B, 0x32, 0x02, 
+0x23, 0x9E, 0x09, 0xE3, 0x00, 0x44, 0xA6, 0x7D, 
+0x56, 0x99, 0x1B, 0xAF, 0x17, 0x07, 0xB6, 0x74, 
+0x05, 0x0F, 0x2B, 0x5A, 0xE4, 0xF5, 0x14, 0xA5, 
+0x69, 0xAE, 0xDA, 0x77, 0x13, 0x7A, 0x89, 0x5A, 
+0x90, 0xA1, 0x5E, 0x39, 0x63, 0x0A, 0xFF, 0xE5, 
+0x7C, 0xB4, 0xB2, 0xC1, 0xC3, 0x38, 0x7D, 0x95, 
+0xD9, 0x9E, 0x45, 0x6B, 0x10, 0xCB, 0x40, 0x16, 
+0x18, 0x78, 0xCC, 0x3E, 0xEF, 0x5C, 0x97, 0x19, 
+0x65, 0xDA, 0x78, 0xD4, 0x27, 0xD2, 0xFF, 0x6C, 
+0x6C, 0x15, 0x19, 0x21, 
+
+0x5D, 0xBA, 0x26, 0x65, 0xE5, 0xC3, 0x2F, 0xBB, 
+0x14, 0x04, 0x83, 0x26, 0x35, 0x31, 0x46, 0x0E, 
+0xB3, 0x60, 0xAA, 0x67, 0x52, 0x6F, 0xCA, 0x2E, 
+0xA6, 0xCF, 0x1B, 0x23, 0xBF, 0xD3, 0x07, 0xF1, 
+0x9C, 0x8B, 0x34, 0xF2, 0x67, 0x96, 0xCF, 0x79, 
+0x59, 0x33, 0x20, 0x12, 0x97, 0xB8, 0x58, 0x66, 
+0xDE, 0x94, 0xA3, 0xD9, 0x28, 0xC6, 0x30, 0xB9, 
+0x38, 0x19, 0x61, 0x65, 0xE7, 0xCD, 0x5B, 0x46, 
+0x6F, 0x1C, 0x6F, 0x73, 0xDA, 0xF6, 0x1D, 0xD6, 
+0x71, 0xB0, 0x64, 0xDC, 0x16, 0x48, 0x54, 0x00, 
+0x26, 0x16, 0x2D, 0xBA, 0x54, 0xC6, 0x52, 0xA1, 
+0xBE, 0xA7, 0x37, 0xB6, 0xB2, 0xC0, 0xDA, 0xE5, 
+0xE7, 0xE9, 0xF9, 0x9E, 0x45, 0x93, 0x9A, 0x49, 
+0x9F, 0xF3, 0x56, 0x6D, 0x46, 0x6C, 0x8A, 0x4F, 
+0x4E, 0xF6, 0x41, 0x7B, 0xA6, 0x8A, 0x18, 0xC0, 
+0xD7, 0x3E, 0xA9, 0x05, 0x21, 0x99, 0xA4, 0x97, 
+0x51, 0x8B, 0x9B, 0x37, 0x93, 0x92, 0x62, 0x71, 
+0xD9, 0x6F, 0xD8, 0x4C, 0xD2, 0x81, 0x06, 0xD2, 
+0xFB, 0x59, 0xFD, 0xD5, 0x8B, 0x09, 0xED, 0x28, 
+0x26, 0xB4, 0xD9, 0x57, 0xD8, 0xED, 0xCF, 0xD4, 
+0x95, 0xB0, 0x64, 0x68, 0xD5, 0xE0, 0x52, 0xDC, 
+0xE7, 0x6E, 0x24, 0xD1, 0x7F, 0x15, 0x71, 0xE6, 
+0x7B, 0x74, 0x31, 0xFB, 0x5B, 0x66, 0x55, 0x1A, 
+0xD7, 0x5F, 0x91, 0x53, 0x8C, 0x26, 0x41, 0x02, 
+0xF7, 0x4A, 0xC0, 0x4F, 0x7E, 0x49, 0x3A, 0xA6, 
+0x65, 0x30, 0x2D, 0x3D, 0x4A, 0xED, 0x4B, 0x63, 
+0x3A, 0xF8, 0xB0, 0x7D, 0x48, 0xA3, 0xD9, 0x77, 
+0x37, 0xFC, 0x54, 0x49, 0x06, 
+
+0x83, 0x69, 0x14, 0xF3, 0x3D, 0x48, 0x43, 0x28, 
+0xE6, 0xC4, 0x87, 0x37, 0x5A, 0xC4, 0x57, 0x0D, 
+0xC5, 0xF5, 0x2E, 0xC2, 0xC2, 0x68, 0x09, 0xE9, 
+0xB4, 0x86, 0x6C, 0xDE, 0x79, 0x39, 0x5D, 0xE5, 
+0x84, 0x71, 0xE1, 0x65, 0x7A, 0x0B, 0xB3, 0xCC, 
+0xA2, 0x3E, 0x99, 0x3E, 0x95, 0x47, 0xAD, 0xC1, 
+0x65, 0xFF, 0x82, 0x39, 0xBC, 0xD5, 0x64, 0x3D, 
+0x8A, 0x79, 0xC1, 0xDE, 0xFE, 0xDB, 0xA4, 0x00, 
+0x0F, 0xB9, 0xD5, 0x05, 0x79, 0x13, 0xFF, 0x40, 
+0x35, 0xDF, 0xAE, 0xE0, 0x6A, 0xCA, 0x73, 0x87, 
+0x7A, 0xE7, 0x95, 0x4E, 0xE6, 0x26, 0x41, 0x2D, 
+0xFF, 0x5F, 0xC9, 0xFB, 0x9A, 0x5E, 0x96, 0x07, 
+0xCB, 0xF4, 0x79, 0x2B, 0x40, 0x0F, 0x77, 0x82, 
+0x38, 0x9E, 0x11, 0xDB, 0xBE, 0xFD, 0x20, 0x76, 
+0xAB, 0xB7, 0x55, 0x3B, 0x92, 0xD6, 0xA6, 0xD0, 
+0xEB, 0xCF, 0xC0, 0x02, 0x78, 0xA0, 0x6B, 0x02, 
+0xFC, 0xC6, 0x0F, 0xAE, 0xAB, 0xDD, 0x10, 0x96, 
+0x1B, 0x50, 0xF1, 0x78, 0xB7, 0x11, 0xCD, 0x4D, 
+0x9B, 0xC6, 0x63, 0xBF, 0x66, 0x41, 0xA0, 0xA1, 
+0xED, 0x8B, 0xD6, 0x66, 0xD8, 0x0B, 0xD5, 0x71, 
+0xA5, 0xAF, 0x1F, 0x39, 0xCB, 0x9E, 0xC4, 0xDE, 
+0x23, 0x24, 0xBE, 0xFD, 0x52, 0xE8, 0x2C, 0x6E, 
+0x54, 0x63, 0x40, 0x02, 0xE2, 0x21, 0x7A, 0xBA, 
+0x59, 0x08, 0xDC, 0x89, 0xF2, 0x04, 0xD6, 0xE7, 
+0xAB, 0x62, 0xDF, 0x65, 0xF9, 0x36, 0x90, 0x1E, 
+0x06, 0xC9, 0x90, 0x8F, 0xB2, 0xB6, 0x66, 0xD2, 
+0x6B, 0x3C, 0xA2, 0x4E, 0x50, 0xAA, 0x0B, 0x9A, 
+0xE6, 0xDC, 0x28, 0x82, 0xE9, 0xAE, 
+
+0x31, 0x5A, 0xCD, 0x3A, 0x28, 0xB1, 0xF5, 0xA9, 
+0xAD, 0xE7, 0xBB, 0xA2, 0xF7, 0x78, 0x4D, 0xB8, 
+0x0C, 0x6E, 0x38, 0xE4, 0xFB, 0xEB, 0xD8, 0x0C, 
+0x99, 0x42, 0xE7, 0x90, 0xEC, 0xC4, 0x74, 0x20, 
+0xAF, 0x8B, 0x26, 0xC0, 0x1D, 0x6C, 0xD2, 0xE0, 
+0x41, 0x64, 0xAE, 0x9A, 0x35, 0xBE, 0xD1, 0x83, 
+0x73, 0x74, 0x0F, 0xAB, 0x2D, 0x28, 0x79, 0xB6, 
+0x96, 0x9E, 0x75, 0x13, 0xF8, 0xFE, 0x1D, 0xA9, 
+0x97, 0x6D, 0x0A, 0x22, 0x74, 0x97, 0xEE, 0x7E, 
+0xC1, 0x7E, 0x2C, 0x07, 0x0E, 0xA6, 0x62, 0xCC, 
+0x7C, 0xC6, 0xB7, 0xF1, 0x24, 0xB0, 0xAA, 0xAB, 
+0xDD, 0xB6, 0x62, 0x2F, 0x4C, 0x70, 0xF8, 0x6A, 
+0x3E, 0x84, 0xD5, 0x32, 0x11, 0xBA, 0x86, 0x3C, 
+0xB3, 0xF2, 0x9C, 0x99, 0x3C, 0x12, 0x50, 0xBE, 
+0x45, 0x93, 0x4D, 0x40, 0x0C, 0x71, 0xD9, 0xCE, 
+0x98, 0xE4, 0x38, 0x07, 0x63, 0xED, 0xA5, 0x69, 
+0x76, 0x38, 0x48, 0xF2, 0x7B, 0x13, 0x3B, 0xA5, 
+0x11, 0x03, 0xC5, 0xEA, 0xA4, 0x32, 0x77, 0xC2, 
+0xC8, 0xA7, 0x76, 0x1A, 0x78, 0x09, 0x89, 0x5E, 
+0xCD, 0xF0, 0xF9, 0x3B, 0xDC, 0xEF, 0x0C, 0xA9, 
+0xB8, 0x6D, 0xB5, 0x4B, 0xD0, 0x3C, 0x92, 0xE6, 
+0x03, 0xB2, 0x27, 0x07, 0x61, 0x34, 0xE1, 0x6C, 
+0x6B, 0xB4, 0xAF, 0x00, 0x9F, 0xD6, 0x5A, 0x7A, 
+0x00, 0x12, 0x0C, 0xB8, 0x05, 0x91, 0x81, 0x2D, 
+0x0E, 0xB8, 0xE8, 0x92, 0x87, 0x85, 0x5D, 0xED, 
+0x97, 0xD7, 0x96, 0xA8, 0xA2, 0xA4, 0xA2, 0xEF, 
+0xE7, 0x73, 0xF8, 0xC0, 0x65, 0xB6, 0xE5, 0xDF, 
+0x97, 0xB2, 0x93, 0x93, 0x23, 0xDD, 0x4A, 
+
+0x9A, 0x7C, 0xB4, 0xAE, 0x90, 0xD0, 0xB5, 0x39, 
+0x2A, 0xF9, 0xA6, 0x8C, 0xDA, 0xF8, 0x8F, 0x86, 
+0xEA, 0x7D, 0xE4, 0x3A, 0x67, 0xD0, 0xA6, 0xAA, 
+0x1F, 0x53, 0x34, 0x64, 0x67, 0xAC, 0x9C, 0x02, 
+0x8A, 0xD7, 0xBE, 0x7D, 0x47, 0x33, 0x22, 0x05, 
+0x25, 0xA6, 0x92, 0x6B, 0x5F, 0x40, 0x7B, 0x9F, 
+0x95, 0x07, 0x1E, 0x33, 0x88, 0x04, 0xC6, 0x7B, 
+0x5F, 0xE5, 0xEC, 0x90, 0xB6, 0x24, 0x5F, 0x4B, 
+0x10, 0xF7, 0x85, 0xD4, 0x16, 0x2C, 0x69, 0x76, 
+0x80, 0x32, 0x86, 0x3B, 0x3B, 0x78, 0x78, 0xCA, 
+0x61, 0x9E, 0x45, 0x4D, 0x7A, 0x24, 0x93, 0xE6, 
+0x1F, 0xD9, 0x9E, 0x75, 0x59, 0xB7, 0x94, 0x5F, 
+0x75, 0x5E, 0xB5, 0x51, 0x8B, 0xE5, 0x3E, 0x4E, 
+0x77, 0xBA, 0xA3, 0x32, 0x0F, 0x7C, 0x38, 0x57, 
+0x90, 0x71, 0xBA, 0x0B, 0x9F, 0xE8, 0xB5, 0xC3, 
+0x61, 0x2A, 0x6E, 0xC5, 0xE5, 0x71, 0x48, 0xA0, 
+0x72, 0x70, 0x07, 0xFE, 0x8F, 0xE9, 0x67, 0xC7, 
+0xB9, 0x31, 0x63, 0xB7, 0xF8, 0x15, 0xCC, 0xDC, 
+0x93, 0x8B, 0x90, 0x4E, 0x42, 0x23, 0xED, 0x70, 
+0x22, 0x99, 0xB5, 0xDD, 0xE3, 0x1C, 0xDF, 0x28, 
+0x9D, 0x01, 0xB3, 0xC7, 0xC0, 0x67, 0x06, 0x4B, 
+0x8F, 0x9B, 0xDD, 0x2E, 0x07, 0x2C, 0x60, 0xB0, 
+0xA3, 0x3F, 0x7C, 0x0B, 0x43, 0x17, 0xE4, 0xFA, 
+0x34, 0x78, 0xE9, 0x9B, 0xD5, 0xF8, 0xCC, 0xDE, 
+0xE2, 0xA9, 0x00, 0x6E, 0x20, 0x0E, 0x16, 0xB4, 
+0x68, 0x8B, 0x79, 0x8A, 0xC1, 0xBB, 0x8B, 0x2F, 
+0xD6, 0xF2, 0x71, 0x39, 0x30, 0x75, 0x6B, 0x5A, 
+0x04, 0x30, 0x0D, 0x71, 0x74, 0xFC, 0x10, 0xC1, 
+
+0x12, 0x2F, 0x6E, 0xC2, 0xB6, 0x22, 0x2E, 0x2E, 
+0x92, 0x8E, 0xDE, 0x8E, 0x47, 0xF8, 0x7B, 0xB8, 
+0xB6, 0xF8, 0xE0, 0x96, 0xF9, 0x9F, 0xAD, 0x15, 
+0xB8, 0x61, 0x4D, 0x80, 0xA1, 0x5C, 0x09, 0x79, 
+0x0F, 0xB6, 0x90, 0xA0, 0x0B, 0x2D, 0x93, 0x74, 
+0x7E, 0x87, 0x16, 0xE8, 0x5D, 0x8B, 0x7E, 0xD5, 
+0xD1, 0x69, 0xE0, 0xF5, 0x68, 0xD5, 0x49, 0xED, 
+0xC9, 0x22, 0x30, 0xF9, 0x0E, 0xB3, 0xAD, 0xC7, 
+0x40, 0x62, 0x35, 0x05, 0x6F, 0xFB, 0xA0, 0x73, 
+0xC3, 0x8E, 0xB7, 0xA5, 0x2C, 0x3F, 0x04, 0xF7, 
+0x71, 0x5D, 0x5C, 0xD7, 0xE8, 0xF4, 0x84, 0xC2, 
+0x3C, 0x8A, 0xE5, 0x7A, 0xF0, 0x6E, 0x6A, 0xA2, 
+0xF0, 0x09, 0x68, 0xBD, 0xD2, 0xB3, 0x47, 0xD9, 
+0x42, 0x82, 0xA5, 0xB9, 0x2D, 0x33, 0x97, 0x3E, 
+0x92, 0x62, 0xAC, 0xA9, 0x01, 0x04, 0x15, 0x77, 
+0xA4, 0xF8, 0x1F, 0x1C, 0x91, 0x98, 0xAD, 0x38, 
+0x61, 0x2A, 0xC2, 0x0F, 0x85, 0xBE, 0x2A, 0xCF, 
+0xC1, 0x4A, 0x64, 0x5E, 0x2C, 0xBB, 0xBA, 0xB1, 
+0xB7, 0xB5, 0x3F, 0x5F, 0x87, 0x30, 0xFD, 0x65, 
+0x64, 0xCE, 0x72, 0x37, 0x92, 0xF6, 0x62, 0xEA, 
+0x33, 0xCE, 0x78, 0xFF, 0x84, 0x4B, 0xFD, 0x13, 
+0x7D, 0xD1, 0x33, 0xF3, 0xF6, 0x32, 0xB4, 0xD7, 
+0x79, 0x8A, 0x70, 0x1A, 0xCE, 0xBF, 0x42, 0x1F, 
+0x4C, 0xB8, 0x62, 0x95, 0x94, 0x9D, 0xF2, 0xBE, 
+0x77, 0x70, 0xBD, 0x3B, 0x2F, 0x76, 0xAB, 0x18, 
+0x96, 0xC7, 0xF2, 0x8D, 0xAC, 0x3C, 0xB2, 0x48, 
+0xA9, 0xF5, 0xA5, 0xB8, 0x52, 0xEB, 0xE7, 0x9B, 
+0xE7, 0x6F, 0x88, 0x2E, 0x3F, 0xA5, 0x00, 0xAE, 
+0x7F, 
+
+0x89, 0x1B, 0xC8, 0xF9, 0x92, 0x73, 0x9A, 0x5F, 
+0xEB, 0x51, 0x24, 0x53, 0x52, 0x97, 0x03, 0x08, 
+0x4B, 0x5C, 0xAE, 0x9F, 0x31, 0x72, 0x8E, 0x72, 
+0xDA, 0x8F, 0x8F, 0x9F, 0x58, 0x75, 0x6B, 0xE1, 
+0xDC, 0x68, 0xC2, 0x68, 0xA1, 0x92, 0x9A, 0x20, 
+0x32, 0x32, 0x33, 0x73, 0x02, 0xB2, 0x1A, 0xCD, 
+0xE2, 0x45, 0xFB, 0x08, 0x0D, 0x2D, 0xF1, 0xD6, 
+0x30, 0x66, 0x4C, 0x01, 0x09, 0xF5, 0x8B, 0x9A, 
+0xDE, 0xFC, 0x49, 0xA5, 0x71, 0xA0, 0x6F, 0x7D, 
+0x31, 0x2C, 0xFB, 0x28, 0x50, 0x01, 0x3E, 0xDA, 
+0x21, 0xD8, 0xD0, 0xA3, 0x00, 0x9E, 0x92, 0x5B, 
+0x0D, 0x41, 0x4E, 0xBC, 0xDB, 0xAF, 0x50, 0x1D, 
+0x29, 0x09, 0x3C, 0x6F, 0xF9, 0xC3, 0x45, 0xD8, 
+0x51, 0x4E, 0xCF, 0x74, 0x77, 0xA8, 0xF7, 0xFB, 
+0x19, 0xC7, 0xEF, 0x24, 0x35, 0xAE, 0xA3, 0xCE, 
+0x2A, 0x07, 0x74, 0x70, 0x83, 0x6D, 0x47, 0xC8, 
+0xB3, 0x06, 0xEA, 0xF8, 0x4D, 0xFC, 0xAF, 0xA2, 
+0x94, 0xA7, 0x61, 0x0E, 0x90, 0x67, 0x6D, 0x7A, 
+0x3E, 0x16, 0x95, 0x29, 0xE2, 0x88, 0xAC, 0x9C, 
+0x2D, 0xDB, 0x35, 0x38, 0x39, 0x00, 0x25, 0x07, 
+0xB7, 0xEC, 0x6F, 0x32, 0x94, 0x92, 0xC2, 0x9E, 
+0xC8, 0xBD, 0x41, 0x1F, 0x31, 0x67, 0xD2, 0x08, 
+0x66, 0xB6, 0x59, 0x61, 0x20, 0xEA, 0x26, 0xB0, 
+0xAD, 0x1F, 0x6F, 0x0D, 0x9F, 0x41, 0x0E, 0xB0, 
+0x41, 0xA5, 0x5D, 0xA7, 0x6F, 0x2E, 0x0D, 0x0A, 
+0x16, 0x04, 0xAC, 0xEA, 0x41, 0x58, 0x79, 0x5C, 
+0x6E, 0x7E, 0x65, 0xC1, 0x79, 0x5E, 0xC6, 0x01, 
+0x6F, 0xAB, 0x7F, 0xF3, 0x07, 0x3B, 0x26, 0x3C, 
+0x1B, 0x49, 
+
+0x38, 0x1A, 0x06, 0x13, 0xE6, 0x67, 0x19, 0xD5, 
+0xA5, 0x16, 0xF6, 0x02, 0xEF, 0xFF, 0xFA, 0xB6, 
+0x37, 0xED, 0x5F, 0xB3, 0x9E, 0x88, 0x18, 0x97, 
+0xAC, 0xDA, 0xE2, 0x3B, 0x45, 0x5D, 0x0A, 0xD7, 
+0xCA, 0x89, 0x8F, 0x3F, 0x50, 0x03, 0xC9, 0x2F, 
+0xCE, 0x44, 0x27, 0x90, 0x33, 0xE7, 0xFB, 0xF0, 
+0x1C, 0x4B, 0x05, 0x6B, 0x15, 0xC0, 0x5E, 0x68, 
+0x47, 0x9C, 0x8A, 0xFE, 0xEC, 0x88, 0x1F, 0xBD, 
+0x2F, 0x53, 0x97, 0x11, 0x45, 0x2B, 0x74, 0x94, 
+0x28, 0x46, 0xAD, 0x40, 0x6B, 0xC8, 0x60, 0x62, 
+0x66, 0xAE, 0x73, 0x0E, 0x1B, 0xB8, 0xD3, 0x49, 
+0xE4, 0x1B, 0xE0, 0xA1, 0xA1, 0x33, 0x2C, 0xAF, 
+0x63, 0x04, 0x7E, 0xFD, 0x58, 0xF3, 0xA0, 0x20, 
+0xBA, 0x31, 0xA9, 0xA6, 0x41, 0xD3, 0x1E, 0x74, 
+0x94, 0xF1, 0x70, 0x2C, 0x3D, 0x3F, 0x86, 0xD1, 
+0x8E, 0x89, 0x86, 0x79, 0x8E, 0x60, 0x6A, 0xB3, 
+0xF8, 0x79, 0x7B, 0x42, 0xC2, 0x1D, 0xFD, 0x6D, 
+0x96, 0xBF, 0x09, 0xC5, 0x2D, 0xEC, 0xAD, 0xDE, 
+0xF3, 0xC3, 0x22, 0x6D, 0x2B, 0x28, 0x47, 0xA6, 
+0x46, 0x21, 0x53, 0xAF, 0x58, 0xEE, 0x5D, 0x9A, 
+0x8C, 0x48, 0x0D, 0xD1, 0x27, 0x26, 0x1A, 0x18, 
+0x2C, 0x78, 0x2D, 0x08, 0x41, 0x88, 0x21, 0x33, 
+0xA1, 0x69, 0xA1, 0x80, 0x82, 0x62, 0x77, 0xA2, 
+0x15, 0xB2, 0x11, 0x40, 0x30, 0xCF, 0xD9, 0xC9, 
+0x24, 0xA0, 0x04, 0xF4, 0xCB, 0xAE, 0x5D, 0x4D, 
+0x7C, 0x2D, 0x59, 0xBD, 0xE3, 0x15, 0x19, 0xFE, 
+0xDF, 0x82, 0xC0, 0x12, 0xBE, 0x36, 0xCA, 0x7C, 
+0x12, 0xE7, 0x31, 0x08, 0x2C, 0xD6, 0x09, 0xE6, 
+0x36, 0x05, 0x72, 
+
+0xF0, 0x0A, 0x19, 0x26, 0xD6, 0xE5, 0xAE, 0x32, 
+0xCD, 0x58, 0x7B, 0xE9, 0x8C, 0x49, 0xF3, 0xBF, 
+0x77, 0xCA, 0x34, 0x3E, 0xD0, 0x0B, 0xAA, 0xCE, 
+0x59, 0x5C, 0xFB, 0x4D, 0x55, 0xB2, 0x7D, 0xF5, 
+0x62, 0xC1, 0x99, 0xDE, 0xF0, 0x74, 0xB3, 0xA2, 
+0xBB, 0x2D, 0x8B, 0xC7, 0xC8, 0x18, 0x51, 0x94, 
+0x8B, 0xE6, 0x38, 0xC3, 0xF7, 0x9E, 0x3A, 0x1B, 
+0xB5, 0x98, 0x41, 0xBE, 0x99, 0xC0, 0x31, 0xA2, 
+0x4D, 0x3B, 0xAF, 0x36, 0xE3, 0x0B, 0xD9, 0xC8, 
+0xA8, 0x7B, 0xAE, 0xE9, 0x84, 0x13, 0x58, 0xB2, 
+0x03, 0x80, 0xF4, 0xB8, 0x48, 0x24, 0xD7, 0x45, 
+0xB4, 0xA4, 0x40, 0xEB, 0xE3, 0xBD, 0x4B, 0xC7, 
+0x96, 0xE1, 0x7D, 0x00, 0x1E, 0x4E, 0x96, 0x4B, 
+0x22, 0xE3, 0x81, 0xAB, 0xB0, 0x44, 0x3F, 0xD4, 
+0xBF, 0x88, 0x20, 0xF6, 0x65, 0x88, 0x04, 0xB8, 
+0xAE, 0xFF, 0x0A, 0x80, 0x54, 0x99, 0xFD, 0x72, 
+0xD8, 0x40, 0x0D, 0xB9, 0x04, 0x43, 0x76, 0x7B, 
+0x2C, 0x36, 0x50, 0xFA, 0x18, 0xD0, 0x6B, 0x46, 
+0x10, 0x1E, 0xE4, 0x87, 0xD2, 0x61, 0x1B, 0xC9, 
+0x62, 0x3C, 0xB5, 0x8B, 0xBF, 0x0B, 0xF5, 0x63, 
+0x64, 0x24, 0xC0, 0x38, 0x79, 0x83, 0xF2, 0x02, 
+0x1C, 0x4D, 0xD7, 0xBE, 0x3E, 0x70, 0x17, 0xA4, 
+0x71, 0x25, 0xA6, 0xB2, 0x0B, 0x22, 0x36, 0x09, 
+0x27, 0xF9, 0x7E, 0x46, 0x2B, 0xCE, 0x87, 0x43, 
+0x45, 0x81, 0x68, 0x29, 0xC9, 0x3B, 0xF7, 0x2A, 
+0xCD, 0xAE, 0xD9, 0x5E, 0xDC, 0xDC, 0x86, 0xB3, 
+0x12, 0x8F, 0x8B, 0xCA, 0xD0, 0x28, 0x67, 0x81, 
+0x2A, 0x21, 0xDC, 0x91, 0x2B, 0xC6, 0xAC, 0x42, 
+0x2D, 0x5D, 0xF3, 0xEF, 
+
+0x46, 0x20, 0x6F, 0x43, 0xE5, 0x00, 0xA9, 0xA4, 
+0xF9, 0xF4, 0x78, 0xC4, 0x96, 0x45, 0xA8, 0x91, 
+0x2B, 0x44, 0x4B, 0xED, 0x73, 0x6B, 0xCD, 0x16, 
+0x80, 0x27, 0x2E, 0x29, 0xA1, 0x24, 0xA2, 0x8A, 
+0xCC, 0x20, 0xE4, 0x67, 0xA1, 0x21, 0xF7, 0x1C, 
+0xD6, 0x3C, 0xCB, 0xF7, 0x6F, 0x5D, 0x9D, 0x2B, 
+0xD8, 0xD7, 0xCA, 0x13, 0x90, 0x55, 0xD1, 0x30, 
+0x61, 0xE2, 0x4F, 0x36, 0x55, 0xC2, 0x11, 0x8F, 
+0xF3, 0x2C, 0x2D, 0xCA, 0x8F, 0x24, 0xA7, 0x4C, 
+0xA0, 0x9A, 0x59, 0x60, 0xD6, 0x9A, 0x70, 0xFE, 
+0x2E, 0x2B, 0xEA, 0x80, 0xC3, 0x44, 0xF9, 0xE7, 
+0x14, 0x68, 0xDB, 0xF4, 0xBD, 0x39, 0xA3, 0x36, 
+0x40, 0xF8, 0xF8, 0xD8, 0xEF, 0xEC, 0xE9, 0x33, 
+0xDF, 0xAE, 0x61, 0x07, 0x78, 0xDC, 0x58, 0x47, 
+0x31, 0x5E, 0xBC, 0x03, 0x51, 0xF1, 0x73, 0x21, 
+0xB3, 0x3F, 0x63, 0x68, 0xFD, 0x8C, 0xB3, 0xD2, 
+0x39, 0xED, 0x5C, 0xBF, 0x2B, 0x1D, 0x24, 0xA2, 
+0x78, 0xF5, 0x81, 0x00, 0xFE, 0xBB, 0x32, 0xAA, 
+0x12, 0xF1, 0xE6, 0x95, 0x69, 0x52, 0x9E, 0xCA, 
+0x8E, 0x0A, 0x6A, 0xD6, 0xD1, 0x1F, 0x94, 0xE9, 
+0xA4, 0x45, 0x7F, 0x2D, 0xA8, 0x8E, 0x15, 0x89, 
+0xEE, 0x3B, 0x41, 0x9D, 0x99, 0x5D, 0xB8, 0x0E, 
+0x24, 0x33, 0xF5, 0xA9, 0x01, 0x3C, 0xB9, 0x84, 
+0x64, 0x96, 0x17, 0x51, 0x5E, 0x56, 0x60, 0x3C, 
+0xF4, 0xBA, 0x2A, 0x74, 0xE4, 0x8A, 0xF0, 0xF4, 
+0x85, 0x91, 0xC1, 0xEF, 0x50, 0xB0, 0x8D, 0x73, 
+0xD3, 0x13, 0x5D, 0x22, 0xB3, 0xBA, 0xDD, 0xC1, 
+0x95, 0x55, 0x6A, 0xC8, 0xC1, 0x59, 0xC0, 0xFA, 
+0x88, 0x1D, 0xC9, 0x9F, 0x06, 
+
+0x12, 0x6D, 0xBE, 0x2D, 0x17, 0x1A, 0x3F, 0xF2, 
+0x4C, 0x96, 0x48, 0xFD, 0x05, 0xEA, 0x73, 0xDE, 
+0xCF, 0x8C, 0x3F, 0xC3, 0x6E, 0x12, 0xE6, 0xD1, 
+0x74, 0xC3, 0xEA, 0xEF, 0x55, 0xDC, 0x4B, 0xAE, 
+0x32, 0x9E, 0x2F, 0xC8, 0xDD, 0x7C, 0x60, 0xAB, 
+0x4F, 0xD7, 0xFD, 0x49, 0xBC, 0x80, 0x11, 0x8A, 
+0xAF, 0xC0, 0xED, 0xB5, 0xD5, 0x35, 0xBE, 0x0F, 
+0x4F, 0xF4, 0x48, 0xD4, 0xD6, 0xC0, 0xF1, 0x1A, 
+0x5E, 0x1B, 0x70, 0x92, 0x97, 0x5D, 0x67, 0x75, 
+0xD8, 0xE0, 0xB5, 0xE0, 0x18, 0x30, 0x7E, 0x46, 
+0x37, 0x86, 0xF3, 0x4C, 0x13, 0x58, 0x68, 0x02, 
+0x72, 0xBD, 0xEB, 0x72, 0xE4, 0xBE, 0x8B, 0x59, 
+0x6F, 0x57, 0xEB, 0x61, 0x15, 0x00, 0xA6, 0x36, 
+0x2C, 0xAC, 0x74, 0xE7, 0xD6, 0xCC, 0x00, 0x6A, 
+0x30, 0x1D, 0xFB, 0xA5, 0xC5, 0x2D, 0x4C, 0xE7, 
+0x73, 0x32, 0xD2, 0x6D, 0x80, 0x64, 0x57, 0x93, 
+0x0C, 0x86, 0xA3, 0x08, 0x8F, 0x7D, 0xEA, 0x8C, 
+0x93, 0x78, 0xC9, 0x56, 0xCC, 0x33, 0xED, 0xA3, 
+0xB7, 0xAD, 0x2D, 0x27, 0x30, 0x87, 0x26, 0x8E, 
+0x79, 0x8C, 0xEE, 0x9F, 0xB0, 0x60, 0x53, 0x5C, 
+0xC6, 0x07, 0x7E, 0xDF, 0x54, 0x5C, 0x2E, 0xB5, 
+0xEF, 0x00, 0xE4, 0xDC, 0xF7, 0xC7, 0xAE, 0x60, 
+0xF6, 0xE5, 0x50, 0x75, 0x6A, 0x17, 0x4B, 0xDA, 
+0xCE, 0x4D, 0xFB, 0x8F, 0xAF, 0x97, 0x7A, 0x25, 
+0x2A, 0x9F, 0x04, 0xB8, 0xD1, 0x26, 0x72, 0x4A, 
+0x14, 0x8C, 0x8A, 0x1B, 0x7E, 0xCC, 0x42, 0x9E, 
+0x22, 0x67, 0x30, 0xC9, 0xC9, 0xC5, 0xF5, 0x0E, 
+0x95, 0xCD, 0xAE, 0xC2, 0x48, 0x7E, 0xA9, 0x00, 
+0x33, 0x9D, 0xE4, 0xD0, 0xD3, 0x6A, 
+
+0xC0, 0xB4, 0x3E, 0xCC, 0xD2, 0xA0, 0xCC, 0xD8, 
+0xDD, 0xBB, 0xF6, 0xF4, 0x26, 0x7A, 0xA8, 0xC2, 
+0x38, 0xAF, 0x52, 0xF1, 0xDB, 0xE4, 0xEA, 0xF2, 
+0xCD, 0x92, 0x5D, 0x1A, 0x9F, 0xA0, 0x0D, 0xE0, 
+0x86, 0x9A, 0xB7, 0x90, 0xE5, 0x1C, 0x96, 0x9E, 
+0x75, 0xA8, 0x59, 0x27, 0x19, 0x9A, 0xF4, 0xCA, 
+0x56, 0xC8, 0x66, 0xB9, 0x0D, 0x1E, 0x88, 0xE1, 
+0x78, 0x2A, 0x8A, 0x6B, 0x81, 0x95, 0xE7, 0x38, 
+0xD7, 0xE8, 0xFD, 0xD9, 0x67, 0xED, 0x25, 0xF5, 
+0x9F, 0x4E, 0x5B, 0xCA, 0x91, 0xDF, 0xDE, 0x2F, 
+0xAD, 0xE4, 0x37, 0xF3, 0x60, 0xE2, 0xF2, 0xD7, 
+0xEB, 0x94, 0x1C, 0x74, 0x7F, 0xE5, 0x54, 0xE8, 
+0xA5, 0x32, 0x0A, 0xAF, 0x1E, 0x6D, 0x14, 0xD2, 
+0xE3, 0x85, 0xC1, 0xB1, 0xF4, 0x91, 0xB9, 0xF1, 
+0x5D, 0xDE, 0x54, 0x80, 0x9A, 0x30, 0xF2, 0xD7, 
+0x11, 0xDF, 0x9D, 0x4A, 0x40, 0x48, 0x68, 0x3B, 
+0xEB, 0x18, 0xA9, 0xBE, 0x35, 0x36, 0x85, 0xEE, 
+0x38, 0x02, 0x35, 0xC7, 0x43, 0xEF, 0xD3, 0xE0, 
+0xAE, 0x18, 0xE6, 0xF2, 0x6A, 0x3C, 0xE9, 0x6C, 
+0x78, 0x60, 0xE0, 0x5A, 0x3C, 0xC9, 0xA7, 0xB1, 
+0x3A, 0xC3, 0xDF, 0x61, 0x46, 0xC6, 0x3D, 0x4E, 
+0x72, 0x41, 0x45, 0x8B, 0x9F, 0x8E, 0x49, 0x85, 
+0xCB, 0xD1, 0xA4, 0x1D, 0x91, 0xCE, 0x6A, 0x21, 
+0x65, 0xAB, 0xC2, 0x51, 0x6F, 0x0F, 0xEF, 0xE8, 
+0x30, 0x29, 0xDB, 0xBF, 0xD4, 0xB1, 0x77, 0x9A, 
+0x62, 0xA9, 0x0B, 0xB1, 0xC0, 0x88, 0x29, 0x43, 
+0x24, 0xD5, 0xBA, 0x86, 0x3E, 0x57, 0x08, 0x70, 
+0x84, 0x5E, 0xF8, 0x1B, 0xD4, 0x83, 0xD6, 0x03, 
+0x69, 0xEC, 0x16, 0x7A, 0x83, 0xB1, 0x1B, 
+
+0xB4, 0x60, 0x0F, 0xA6, 0xFA, 0x2C, 0x42, 0xE7, 
+0x9C, 0xF8, 0xE1, 0x47, 0x55, 0x38, 0xFF, 0xF7, 
+0x98, 0x3E, 0x36, 0xFA, 0xF5, 0x6E, 0x69, 0x2F, 
+0xA2, 0xB8, 0x26, 0xDF, 0x37, 0x88, 0x7E, 0x49, 
+0x50, 0x58, 0xE8, 0x2B, 0x15, 0x2E, 0x1F, 0x6C, 
+0x11, 0xA5, 0x10, 0x99, 0x4A, 0xF4, 0xDA, 0xDC, 
+0x3D, 0x15, 0x51, 0x48, 0x72, 0x91, 0x26, 0xA1, 
+0xF4, 0x2D, 0x60, 0xED, 0xCC, 0x25, 0x50, 0xC7, 
+0xA8, 0x83, 0x19, 0x87, 0x89, 0x0F, 0x3D, 0x06, 
+0x95, 0xBB, 0x66, 0x8A, 0x29, 0xDE, 0xF1, 0x0D, 
+0xC6, 0x95, 0x15, 0x6E, 0xFE, 0x39, 0x2E, 0xA5, 
+0xB8, 0x0E, 0xA9, 0x61, 0x7E, 0xDB, 0x6F, 0x39, 
+0x44, 0x76, 0xC8, 0x04, 0xD6, 0x49, 0x5D, 0xF4, 
+0xE1, 0x5D, 0x58, 0x76, 0xC3, 0xCB, 0xCF, 0x52, 
+0x17, 0x1B, 0x24, 0x6C, 0x00, 0x09, 0x81, 0x73, 
+0x06, 0xE3, 0x96, 0x70, 0x86, 0xF9, 0xC4, 0x76, 
+0x5D, 0xE8, 0xDA, 0xB1, 0xC5, 0x3A, 0x44, 0x32, 
+0x3C, 0x55, 0x49, 0x93, 0x43, 0x24, 0xE1, 0x31, 
+0xC5, 0xB7, 0xA8, 0x99, 0xD5, 0x80, 0x46, 0x37, 
+0x96, 0x2E, 0x0C, 0x89, 0x1F, 0xA7, 0x6F, 0x75, 
+0x95, 0x65, 0x9F, 0xB3, 0xBF, 0xE0, 0x01, 0xFC, 
+0xCE, 0xF0, 0x42, 0x5C, 0x6D, 0xBF, 0x11, 0x0C, 
+0xBD, 0x39, 0xAE, 0x0F, 0x08, 0x65, 0xBB, 0x4D, 
+0x26, 0x5A, 0x99, 0x2A, 0xE1, 0x0C, 0xA2, 0xBC, 
+0x96, 0x77, 0x7B, 0xF5, 0x61, 0x23, 0xD7, 0x58, 
+0x45, 0x65, 0x6D, 0x11, 0xC0, 0xA6, 0xAA, 0x3F, 
+0x52, 0xE6, 0xAB, 0xF3, 0xAE, 0xFE, 0xAE, 0xF1, 
+0x83, 0x7D, 0x92, 0x58, 0x08, 0xAA, 0x79, 0xB6, 
+0x73, 0xA8, 0x04, 0xF7, 0x0E, 0x4B, 0xFA, 0xD2, 
+
+0x93, 0x05, 0x79, 0x0C, 0xBE, 0xA0, 0x38, 0x9F, 
+0x70, 0xA9, 0x7E, 0x38, 0x4C, 0x2B, 0x03, 0xD9, 
+0xAC, 0x22, 0x63, 0xFD, 0x78, 0xE8, 0x5F, 0x0F, 
+0xFB, 0x8B, 0x20, 0xD3, 0xCE, 0x51, 0x69, 0x8D, 
+0x27, 0xBD, 0x4F, 0x17, 0x13, 0xC9, 0x20, 0xA0, 
+0x09, 0x5A, 0x77, 0x9F, 0xAB, 0xC4, 0xA0, 0xDB, 
+0x90, 0xC4, 0x87, 0xE8, 0x80, 0x32, 0xDA, 0x12, 
+0x4C, 0xB2, 0xF1, 0x96, 0x1F, 0x1F, 0x71, 0x73, 
+0xD3, 0xD0, 0x37, 0x60, 0x9F, 0xC6, 0x2D, 0x07, 
+0x35, 0xA3, 0xB0, 0x56, 0xF4, 0x2F, 0xDC, 0x79, 
+0x08, 0xDB, 0xF4, 0xCB, 0xC5, 0x91, 0xB7, 0xEB, 
+0x72, 0x72, 0xAC, 0x40, 0xAF, 0x4F, 0x0F, 0x9A, 
+0xE4, 0xF8, 0xAF, 0x6E, 0x65, 0x61, 0xA1, 0xA9, 
+0x82, 0x03, 0xEA, 0x16, 0x12, 0x91, 0x4B, 0xB6, 
+0xB2, 0xE3, 0x56, 0x4B, 0xE9, 0xBF, 0x79, 0xDE, 
+0x64, 0xB5, 0x3C, 0x34, 0xFC, 0xB7, 0x55, 0x9E, 
+0x51, 0x29, 0x1C, 0x86, 0xBB, 0xCD, 0xBD, 0x42, 
+0x32, 0x34, 0x46, 0xCA, 0xD2, 0x41, 0xA0, 0x05, 
+0xF3, 0x09, 0xD0, 0x55, 0x72, 0x3F, 0xD9, 0x06, 
+0x41, 0x3E, 0xA7, 0x0A, 0x6E, 0xE5, 0xD7, 0x3F, 
+0xDC, 0xAD, 0xCC, 0x2D, 0xD3, 0x25, 0xD2, 0x69, 
+0xC6, 0xF9, 0x39, 0xAA, 0xDB, 0xBD, 0xD1, 0x71, 
+0xE2, 0xC3, 0x63, 0x40, 0x19, 0x7A, 0x6B, 0x46, 
+0xC9, 0x67, 0xB3, 0x09, 0x51, 0xF8, 0xB1, 0x68, 
+0xD8, 0xB0, 0xC9, 0xB0, 0x4B, 0x6F, 0x7D, 0x89, 
+0xF6, 0x8F, 0xD4, 0x9E, 0xC3, 0xF0, 0xB6, 0x36, 
+0xD0, 0xE3, 0xEF, 0x1D, 0xA2, 0x9A, 0x43, 0x9F, 
+0xE4, 0x06, 0x1E, 0x71, 0x87, 0xAF, 0xC6, 0xBF, 
+0x6A, 0x3E, 0xD4, 0x35, 0x09, 0xAC, 0x70, 0xD6, 
+0x74, 
+
+0x30, 0x7B, 0xBB, 0x73, 0xFF, 0xF2, 0x10, 0xF4, 
+0xAA, 0x9F, 0xF2, 0x47, 0xF2, 0xC6, 0x2C, 0x7B, 
+0x72, 0x9E, 0x46, 0xA2, 0x7C, 0x1A, 0xBE, 0x96, 
+0x09, 0xD6, 0x44, 0xA2, 0x8C, 0x1C, 0x2C, 0x58, 
+0xB2, 0xCF, 0x04, 0xA0, 0xA6, 0x45, 0xBD, 0x8F, 
+0xCA, 0x7E, 0xE5, 0xCA, 0x7E, 0x5A, 0x78, 0x55, 
+0xF4, 0xF5, 0x64, 0xEC, 0xAC, 0x7F, 0x3E, 0x33, 
+0xF8, 0xE4, 0x02, 0x0D, 0x2D, 0xC5, 0x89, 0xFF, 
+0x82, 0xB3, 0x16, 0xD5, 0x2F, 0xDF, 0x7B, 0x23, 
+0x99, 0x38, 0xB2, 0x2B, 0xF9, 0x6B, 0x75, 0x64, 
+0x8E, 0x4A, 0xAE, 0x2C, 0xA3, 0x92, 0x2A, 0xF2, 
+0x5C, 0x9B, 0x4D, 0x07, 0x1E, 0x39, 0x5F, 0x39, 
+0x50, 0x7E, 0x9D, 0xC0, 0xBB, 0xBD, 0x2D, 0xA4, 
+0xE7, 0x8E, 0x3C, 0xCB, 0xA1, 0x5A, 0xA1, 0x30, 
+0x1D, 0xC8, 0x1C, 0x16, 0x09, 0x20, 0x6A, 0x8E, 
+0xD8, 0xDC, 0x79, 0xC3, 0xFA, 0xC4, 0x7D, 0x31, 
+0x8E, 0xC1, 0x02, 0x7C, 0xB0, 0x75, 0xE4, 0x36, 
+0xA0, 0x90, 0x0B, 0x9E, 0x0F, 0xB1, 0xF4, 0xAA, 
+0xA1, 0x05, 0x3E, 0x85, 0x02, 0xDC, 0xE5, 0xD8, 
+0xC2, 0x7E, 0x49, 0xD5, 0x76, 0x06, 0x09, 0xA5, 
+0x2C, 0x81, 0x20, 0x79, 0xD2, 0x92, 0x25, 0xBC, 
+0x8B, 0xDA, 0x4A, 0xC5, 0x09, 0xA7, 0x1D, 0xC5, 
+0x3A, 0xBD, 0x5D, 0x19, 0x53, 0x8D, 0x49, 0xFD, 
+0x65, 0xFA, 0x8A, 0xBB, 0xFF, 0xF1, 0x99, 0xE5, 
+0x5D, 0x9F, 0x30, 0x23, 0xB5, 0x58, 0x9E, 0x30, 
+0x38, 0x59, 0xCD, 0x20, 0x7D, 0x69, 0x5A, 0x74, 
+0xD1, 0x6D, 0x3C, 0xED, 0x34, 0x5A, 0x6F, 0xC1, 
+0xD4, 0x45, 0x4C, 0xE2, 0xE2, 0x31, 0x8F, 0x96, 
+0x75, 0xBE, 0x6E, 0xF9, 0x75, 0x99, 0x06, 0x84, 
+0x5F, 0x36, 
+
+0xBD, 0xBD, 0xED, 0x66, 0xF7, 0x17, 0xCC, 0x7A, 
+0x14, 0xB5, 0x83, 0x83, 0xBA, 0xC0, 0x96, 0x7D, 
+0x97, 0x36, 0x62, 0x80, 0x72, 0x5B, 0x84, 0xC8, 
+0x65, 0xF4, 0x18, 0xEC, 0x1C, 0xFC, 0x37, 0x18, 
+0x40, 0x26, 0x64, 0x0E, 0xF8, 0xA8, 0x66, 0x3C, 
+0x94, 0x0C, 0x98, 0xAE, 0x6B, 0x71, 0x74, 0xAC, 
+0x75, 0x11, 0x39, 0xDA, 0x27, 0x87, 0xB9, 0x8D, 
+0xAA, 0x0A, 0xE0, 0xB5, 0x25, 0x9F, 0xB5, 0x36, 
+0xC9, 0x3A, 0x91, 0x98, 0x2B, 0xB5, 0xDF, 0x68, 
+0x56, 0x84, 0x48, 0xB3, 0xCC, 0x2E, 0x50, 0x21, 
+0x15, 0x7B, 0xFF, 0x96, 0xB8, 0x1E, 0xC7, 0xCE, 
+0x8E, 0xD4, 0x51, 0x42, 0x65, 0x26, 0x38, 0xC1, 
+0x9A, 0x47, 0x52, 0x15, 0x16, 0x2E, 0x44, 0x03, 
+0xE8, 0x45, 0x9B, 0x64, 0x1F, 0xC8, 0x3C, 0x59, 
+0xA9, 0x7B, 0xBB, 0x09, 0xC2, 0x3D, 0x12, 0x94, 
+0x0F, 0xFC, 0xB2, 0x1A, 0x95, 0x0A, 0x83, 0x77, 
+0x82, 0xCC, 0x31, 0xBD, 0x75, 0xC3, 0x56, 0x87, 
+0xBA, 0x3F, 0x36, 0x44, 0xF5, 0x89, 0x19, 0x5B, 
+0x00, 0xA5, 0xE6, 0x41, 0x8B, 0x23, 0x81, 0x8B, 
+0x01, 0xBC, 0xCE, 0xE2, 0x58, 0x7A, 0x74, 0x02, 
+0xA2, 0x61, 0xB1, 0x6A, 0x42, 0xA0, 0xB4, 0x2E, 
+0x5B, 0x16, 0x1B, 0xB9, 0xC3, 0x3B, 0x1E, 0x89, 
+0x6B, 0xC3, 0x08, 0x16, 0x26, 0xE2, 0x75, 0x48, 
+0x29, 0xBA, 0x7C, 0xC2, 0x6D, 0x67, 0x58, 0xF3, 
+0xD3, 0xD1, 0x3B, 0x1D, 0xA1, 0x62, 0x52, 0x37, 
+0x96, 0xC7, 0x9D, 0xA0, 0xCE, 0x78, 0xB6, 0x9E, 
+0x97, 0x88, 0x59, 0xFC, 0x26, 0x45, 0x6D, 0x46, 
+0x5C, 0x39, 0xCD, 0xE5, 0x19, 0x26, 0x70, 0x3C, 
+0x70, 0x14, 0x85, 0x73, 0xC3, 0x41, 0xD5, 0x27, 
+0xA6, 0x3B, 0x8D, 
+
+0x20, 0xE0, 0x97, 0x10, 0x4E, 0x18, 0x60, 0xE9, 
+0x94, 0xC5, 0x03, 0x98, 0xF2, 0xA9, 0x43, 0x9F, 
+0xBD, 0x6C, 0xC8, 0xBB, 0x53, 0xEC, 0xD4, 0x8D, 
+0xE7, 0x26, 0x31, 0xC9, 0xF4, 0x1F, 0xD7, 0x09, 
+0xCB, 0x87, 0x13, 0x90, 0xAF, 0x18, 0x42, 0xF3, 
+0xB4, 0x22, 0x93, 0x00, 0xD0, 0x56, 0x36, 0x7C, 
+0xED, 0x4F, 0x0E, 0xB9, 0xD4, 0xA6, 0xEE, 0xAB, 
+0x42, 0x0D, 0x1D, 0xDD, 0xE2, 0x9C, 0x49, 0xBC, 
+0x78, 0xBA, 0xDF, 0x72, 0x6B, 0xC2, 0x2D, 0xE0, 
+0x7C, 0xC2, 0x02, 0x61, 0xAA, 0x7C, 0x43, 0x38, 
+0xB4, 0x28, 0xF4, 0x73, 0xF2, 0x6B, 0xDA, 0xA6, 
+0x08, 0x12, 0x9E, 0xEF, 0x3E, 0xC4, 0xA5, 0x75, 
+0x01, 0x1A, 0xEE, 0x77, 0x5D, 0xAA, 0x3A, 0x40, 
+0xFC, 0x2C, 0x3E, 0x2C, 0xBD, 0x19, 0x1D, 0x9D, 
+0x08, 0x88, 0x9D, 0xF7, 0x52, 0x60, 0xB5, 0x6B, 
+0x1D, 0x63, 0x64, 0xEF, 0xBC, 0x36, 0x85, 0x42, 
+0xFF, 0xBF, 0x15, 0x5D, 0x29, 0xEE, 0x75, 0x2B, 
+0x38, 0xD2, 0x35, 0x4F, 0xA0, 0x14, 0xE6, 0xC7, 
+0x85, 0x2F, 0xE6, 0xC0, 0x22, 0x28, 0xDA, 0xAE, 
+0x13, 0x5C, 0x06, 0x23, 0x81, 0xC5, 0x3E, 0x49, 
+0xBF, 0x3D, 0xEF, 0xD7, 0x32, 0xBE, 0xEB, 0x49, 
+0x66, 0x60, 0x14, 0xAD, 0x8A, 0xE9, 0x83, 0x0C, 
+0x23, 0x33, 0x49, 0x1B, 0x2E, 0x77, 0xF3, 0x15, 
+0x55, 0xBA, 0xC8, 0xA9, 0xF3, 0xAC, 0x97, 0xA9, 
+0x99, 0x31, 0x73, 0x4D, 0x65, 0x2F, 0xA9, 0x20, 
+0x2F, 0x40, 0x67, 0x80, 0x21, 0xBE, 0x9F, 0x59, 
+0x4A, 0x64, 0x10, 0x1A, 0x65, 0xA0, 0x37, 0x3B, 
+0x4F, 0x8C, 0xCD, 0xBF, 0x02, 0x04, 0xDC, 0x0D, 
+0xAE, 0xB5, 0xF5, 0x5E, 0xB7, 0x87, 0x32, 0x73, 
+0xF3, 0x01, 0xDD, 0x68, 
+
+0x96, 0x71, 0x89, 0xE4, 0xEC, 0x66, 0xCF, 0x80, 
+0xFF, 0xC3, 0x5B, 0xA8, 0x2F, 0xBA, 0x62, 0xD0, 
+0xA5, 0x46, 0xB0, 0xA7, 0x7C, 0x5B, 0x6F, 0x7D, 
+0x8F, 0xA2, 0x4B, 0x67, 0xE9, 0xAF, 0x14, 0x2E, 
+0x43, 0xB8, 0xD0, 0x13, 0x78, 0x78, 0xB5, 0x75, 
+0x80, 0x0C, 0xC2, 0xA0, 0x4C, 0x62, 0xB3, 0xC8, 
+0x7E, 0xA9, 0x12, 0xD0, 0xD7, 0x05, 0xD8, 0x0E, 
+0x78, 0xA3, 0x1F, 0x60, 0x0B, 0x28, 0xB9, 0x2A, 
+0x00, 0x80, 0xB5, 0x1C, 0xA0, 0xDC, 0x82, 0xC6, 
+0x37, 0x93, 0x21, 0x5E, 0x06, 0x6F, 0x34, 0xA5, 
+0xB2, 0x92, 0x8D, 0xC4, 0xD7, 0xF4, 0x98, 0xB9, 
+0x6D, 0x9A, 0xC8, 0x55, 0x6E, 0x33, 0xD2, 0x17, 
+0x60, 0xB8, 0xD0, 0xC3, 0x85, 0x67, 0x5B, 0x37, 
+0x4D, 0xAF, 0x22, 0x5A, 0x45, 0x3C, 0x4E, 0x8D, 
+0x8D, 0x6B, 0x70, 0x5A, 0xC6, 0xEE, 0xF9, 0x71, 
+0xA1, 0x75, 0xED, 0x02, 0xBF, 0xCB, 0xF9, 0xFE, 
+0x49, 0xB4, 0x48, 0xFC, 0x5B, 0xCC, 0x2F, 0x7D, 
+0x9E, 0x6D, 0x34, 0xC6, 0x6F, 0xE6, 0x76, 0xB6, 
+0x3A, 0xB6, 0x43, 0x69, 0x86, 0x88, 0x69, 0x30, 
+0x06, 0xA9, 0x2B, 0x25, 0x4D, 0xAF, 0x43, 0x6C, 
+0xFF, 0x1F, 0x7C, 0x2B, 0x09, 0xFA, 0x42, 0x15, 
+0x3A, 0x87, 0x6F, 0x8C, 0xC6, 0x59, 0x5D, 0x74, 
+0x04, 0xA0, 0xA9, 0x36, 0xF2, 0x47, 0x67, 0x71, 
+0x7D, 0x81, 0x19, 0x0C, 0xC4, 0x1E, 0xC5, 0x59, 
+0x8F, 0xB5, 0xAF, 0x44, 0x5C, 0xE4, 0x5A, 0xCC, 
+0xD4, 0xEA, 0x99, 0x39, 0xD9, 0xBE, 0x73, 0x90, 
+0x3C, 0x5B, 0x1C, 0xDF, 0xEF, 0x17, 0x7D, 0x3A, 
+0x98, 0x3A, 0xDF, 0xD5, 0xCC, 0xD3, 0x4A, 0xBE, 
+0xC0, 0x56, 0xB3, 0x1C, 0xCC, 0x57, 0xDB, 0xFE, 
+0xFA, 0x5B, 0x9C, 0x1A, 0x76, 
+
+0x2C, 0xFE, 0x9A, 0xA9, 0x43, 0x0E, 0x02, 0xD1, 
+0x24, 0x54, 0x00, 0x7B, 0xFD, 0x2A, 0x66, 0x10, 
+0xCA, 0xA4, 0xE4, 0xA5, 0xD2, 0x57, 0x48, 0xA5, 
+0x39, 0x7E, 0x2B, 0xCE, 0xAD, 0x40, 0x3A, 0xE6, 
+0x00, 0x54, 0xDE, 0xC3, 0x8D, 0x0F, 0x2D, 0x0B, 
+0x0B, 0x47, 0x45, 0x2A, 0x92, 0x35, 0x23, 0xE0, 
+0xB4, 0xB4, 0xFA, 0xCD, 0x5F, 0x86, 0x90, 0x48, 
+0x70, 0xE9, 0xD8, 0x1C, 0xEE, 0x1B, 0x9B, 0xFA, 
+0xC4, 0xC8, 0xA1, 0xF5, 0x00, 0x6D, 0x94, 0xD7, 
+0xE8, 0xF3, 0xB1, 0xDA, 0x64, 0x1C, 0x16, 0x40, 
+0xD4, 0xB4, 0x97, 0xFD, 0x24, 0x06, 0x88, 0xEE, 
+0xD3, 0x71, 0x64, 0x52, 0x82, 0xB0, 0xE0, 0xF4, 
+0xEC, 0xCB, 0x47, 0x60, 0xB1, 0x17, 0xD7, 0xBC, 
+0x6E, 0x49, 0x04, 0xE1, 0x21, 0x49, 0xA8, 0x1C, 
+0xC4, 0x1D, 0xB6, 0x5A, 0x70, 0xA5, 0x60, 0xA3, 
+0x87, 0xC6, 0x5E, 0x4F, 0xFB, 0xDD, 0x1A, 0x0A, 
+0x18, 0x4A, 0x41, 0xDC, 0x24, 0x3F, 0x47, 0x6E, 
+0x48, 0x1F, 0x10, 0x66, 0x10, 0x0A, 0xC3, 0xFA, 
+0x59, 0x0E, 0xC6, 0xB9, 0x4B, 0x9A, 0x42, 0xDC, 
+0xE9, 0x0F, 0xCB, 0x0E, 0x08, 0xB0, 0x06, 0x73, 
+0x36, 0x86, 0x27, 0x73, 0x90, 0xB2, 0xD1, 0x0D, 
+0x8F, 0x35, 0xCF, 0xB9, 0x0C, 0xFE, 0xF1, 0x47, 
+0x08, 0x41, 0x3E, 0x4D, 0x56, 0x90, 0x1E, 0xAC, 
+0x8C, 0x9D, 0xEE, 0x38, 0x45, 0x64, 0x12, 0x8A, 
+0xB4, 0x1B, 0xA2, 0x9F, 0xA2, 0xE0, 0xC6, 0xE9, 
+0x40, 0x1B, 0xCA, 0x86, 0xDB, 0x1F, 0x96, 0x4D, 
+0x0B, 0x83, 0xE2, 0xAA, 0x90, 0xD2, 0x72, 0x3F, 
+0xC3, 0xBF, 0x94, 0xFA, 0xDB, 0xA6, 0x49, 0x51, 
+0x8C, 0xF3, 0x2A, 0x07, 0xFB, 0xCE, 0x9C, 0x29, 
+0xB2, 0x00, 0x3C, 0xB8, 0xBF, 0x58, 
+
+0xDF, 0x48, 0x90, 0xC4, 0x10, 0xDB, 0x2D, 0x0E, 
+0x0C, 0x33, 0x30, 0xD3, 0x07, 0x67, 0xC1, 0x0B, 
+0xBC, 0xE3, 0x60, 0xCB, 0x88, 0x90, 0x72, 0xE5, 
+0x75, 0x49, 0xE9, 0x07, 0xBA, 0x87, 0xB2, 0x13, 
+0xBA, 0x15, 0x1D, 0x6D, 0xBB, 0x64, 0x18, 0x3A, 
+0x13, 0x56, 0x30, 0x5D, 0x2F, 0xDC, 0xC9, 0x18, 
+0x04, 0xE2, 0xEC, 0xF9, 0x75, 0xED, 0x47, 0x0C, 
+0xDA, 0x11, 0x5A, 0x9A, 0x60, 0xED, 0x9F, 0x27, 
+0x20, 0x31, 0xE3, 0xF6, 0x97, 0x52, 0x4A, 0x7E, 
+0x26, 0xF3, 0x3C, 0x66, 0x66, 0x7F, 0x96, 0xE3, 
+0xD2, 0x16, 0xB6, 0x68, 0xF4, 0x02, 0x82, 0xC4, 
+0xDC, 0x2F, 0xBD, 0x13, 0xF0, 0xAA, 0x2F, 0x89, 
+0xBB, 0x7D, 0xC5, 0x73, 0xBE, 0xCF, 0xBB, 0xFA, 
+0x5D, 0xDE, 0x61, 0xFD, 0x75, 0x76, 0x4F, 0xBA, 
+0xDA, 0x87, 0x69, 0x76, 0x83, 0x71, 0x5E, 0xE0, 
+0xD9, 0x4F, 0x30, 0xB9, 0x14, 0x4B, 0x48, 0x72, 
+0xF7, 0xD4, 0x6C, 0x15, 0x51, 0x51, 0x35, 0xB4, 
+0x00, 0x77, 0x9C, 0x54, 0xCE, 0x62, 0x0B, 0x5F, 
+0x88, 0x48, 0xC1, 0x80, 0x00, 0xD3, 0x58, 0x1D, 
+0x23, 0xE6, 0x3F, 0xA5, 0xB9, 0x5A, 0x83, 0x46, 
+0x58, 0xA1, 0x6E, 0x67, 0x20, 0xF9, 0xA3, 0x36, 
+0xC4, 0x00, 0x14, 0xB9, 0x42, 0xDA, 0x89, 0x60, 
+0xEB, 0x59, 0x41, 0x34, 0x5D, 0xAC, 0x15, 0x19, 
+0x9D, 0x81, 0xD2, 0x2A, 0x18, 0x63, 0x27, 0x9E, 
+0xBF, 0xE1, 0xBD, 0xB3, 0x70, 0xEB, 0xAA, 0xCD, 
+0xAB, 0x41, 0xEC, 0x97, 0x49, 0x6D, 0x51, 0xB1, 
+0x80, 0xA5, 0x13, 0xD0, 0x1B, 0x2E, 0x70, 0x46, 
+0x17, 0xB1, 0x23, 0xD2, 0x8A, 0x85, 0xA7, 0x21, 
+0x95, 0x71, 0xC2, 0x92, 0x0C, 0xC1, 0xDC, 0xB7, 
+0x07, 0x6A, 0x3A, 0x8F, 0x84, 0xEC, 0x33, 
+
+0xC8, 0xF4, 0xAC, 0xDE, 0x46, 0xD4, 0x1E, 0xD4, 
+0xF9, 0x02, 0x7A, 0x07, 0x56, 0x2C, 0x5C, 0xCA, 
+0xCA, 0xAF, 0xBD, 0xD7, 0x26, 0x3C, 0x92, 0x93, 
+0x4C, 0x49, 0x1D, 0x24, 0xC5, 0x33, 0x6F, 0x25, 
+0xD5, 0xCB, 0x99, 0xD1, 0x77, 0x62, 0x4C, 0x5F, 
+0xC2, 0x5C, 0x14, 0x6C, 0xD0, 0xC9, 0x8C, 0xCE, 
+0x8F, 0x7D, 0x2F, 0x4A, 0x72, 0xF4, 0xED, 0xC9, 
+0xD6, 0x9F, 0x09, 0xB7, 0x8E, 0xCE, 0x4B, 0x97, 
+0x95, 0xAE, 0x30, 0xFF, 0xF9, 0x54, 0x6E, 0xDE, 
+0x33, 0x66, 0x11, 0x55, 0x9E, 0x98, 0x00, 0xA4, 
+0x92, 0x5E, 0x00, 0x09, 0x3E, 0x9E, 0x50, 0xA3, 
+0xA1, 0xAE, 0x57, 0xF9, 0x91, 0xC8, 0x48, 0x50, 
+0x92, 0xAE, 0x5A, 0x7E, 0x2F, 0x98, 0xCC, 0xBD, 
+0x05, 0x64, 0x17, 0x3E, 0xF4, 0x87, 0xCE, 0xD5, 
+0x92, 0xE9, 0x7F, 0xF2, 0xB0, 0xDB, 0x72, 0x79, 
+0x20, 0x90, 0x43, 0xDB, 0x1A, 0x75, 0x7A, 0x00, 
+0xC9, 0x4E, 0xD6, 0xF4, 0x37, 0x5D, 0x87, 0xA6, 
+0xF5, 0x73, 0x01, 0xC0, 0x44, 0xB2, 0x60, 0x82, 
+0x72, 0x92, 0x6C, 0x75, 0x50, 0xEF, 0x76, 0xEA, 
+0xC4, 0x70, 0xD8, 0x92, 0x50, 0xA9, 0x52, 0x08, 
+0xDB, 0xBD, 0x69, 0x9B, 0xAF, 0x19, 0x7E, 0x8D, 
+0x14, 0xCA, 0x37, 0xB7, 0x82, 0x22, 0x21, 0x62, 
+0x9D, 0xEF, 0x2B, 0x78, 0x0B, 0x73, 0x9E, 0x98, 
+0xFC, 0xF0, 0x90, 0xCE, 0x34, 0xF7, 0x04, 0xC0, 
+0x5B, 0x1E, 0x27, 0xBA, 0xB6, 0xD2, 0xDD, 0x34, 
+0xCD, 0xDA, 0x99, 0x94, 0xA5, 0xA3, 0xFE, 0x4B, 
+0xB6, 0x87, 0x5D, 0xD1, 0x78, 0x00, 0x54, 0xC3, 
+0x00, 0x1D, 0x92, 0x9E, 0xD2, 0xD9, 0x92, 0x71, 
+0xC4, 0x8C, 0xA4, 0x58, 0xB9, 0xC4, 0x86, 0x21, 
+0x6F, 0x9A, 0x23, 0x6D, 0x80, 0xF1, 0x7E, 0x8C, 
+
+0x5D, 0x86, 0x96, 0xBC, 0xEA, 0xB5, 0x3B, 0x00, 
+0xC1, 0xFC, 0x22, 0xF0, 0x5F, 0x3D, 0x1E, 0x6B, 
+0x10, 0x0A, 0xE0, 0xB9, 0x3A, 0x52, 0x08, 0x25, 
+0x05, 0x6E, 0xA9, 0x19, 0x26, 0x36, 0x64, 0x3D, 
+0x3B, 0xA5, 0xBD, 0xBA, 0x3C, 0x71, 0xAE, 0x3B, 
+0x85, 0x37, 0x18, 0xCB, 0xC4, 0x62, 0x1D, 0xEA, 
+0x54, 0x47, 0xB2, 0xDC, 0x78, 0x98, 0x10, 0x22, 
+0xB4, 0xB8, 0xFD, 0xA2, 0x4C, 0x79, 0x16, 0xE7, 
+0x8C, 0x7B, 0xA3, 0x22, 0x2B, 0x8E, 0x9A, 0x9C, 
+0x0E, 0x7A, 0x64, 0xBD, 0x3B, 0x48, 0xAD, 0x8E, 
+0x7A, 0xBC, 0x55, 0xB6, 0x0A, 0x8C, 0xFD, 0x88, 
+0x83, 0xA6, 0x21, 0xC8, 0x4E, 0x9A, 0x2A, 0x97, 
+0xCC, 0x38, 0xA9, 0xD9, 0xD5, 0x5B, 0x38, 0xB4, 
+0x3D, 0xFB, 0xFB, 0x5C, 0x5B, 0xB6, 0x1B, 0x04, 
+0x02, 0xDB, 0x9B, 0x48, 0x4E, 0xE6, 0x46, 0x46, 
+0xDF, 0x9A, 0x80, 0x0B, 0x36, 0xA4, 0xBE, 0x66, 
+0x4A, 0x8C, 0xDC, 0xD7, 0xB8, 0x74, 0x88, 0x21, 
+0x79, 0x56, 0x25, 0x73, 0x61, 0xD2, 0xEC, 0x80, 
+0x7B, 0x4F, 0xF6, 0x1E, 0xFA, 0x44, 0x07, 0x14, 
+0x80, 0xD0, 0x1F, 0xCB, 0x70, 0xDC, 0x66, 0x36, 
+0xA2, 0xA9, 0x77, 0x45, 0x24, 0x60, 0xF4, 0xA2, 
+0x72, 0xF6, 0xE5, 0x57, 0x76, 0x58, 0x1A, 0x18, 
+0x17, 0xD6, 0x34, 0xAF, 0x61, 0xD4, 0x07, 0xBD, 
+0x5D, 0x18, 0xCF, 0x6B, 0x36, 0xAF, 0xD8, 0xC0, 
+0xAF, 0x0C, 0x2A, 0x3D, 0x93, 0x04, 0xCE, 0x50, 
+0xD8, 0x9E, 0xAE, 0x81, 0x80, 0xF6, 0xE9, 0xA4, 
+0xFB, 0xAE, 0xB6, 0x15, 0xC7, 0xC2, 0x8D, 0x49, 
+0x5F, 0x94, 0x8E, 0x9A, 0x32, 0x07, 0x0E, 0x77, 
+0x1D, 0x46, 0xD6, 0x55, 0x97, 0xFE, 0xBA, 0x0E, 
+0xCC, 0xF8, 0x84, 0x09, 0x84, 0x0C, 0x2D, 0xA9, 
+0xD6, 
+
+0x53, 0x72, 0xC8, 0x2C, 0xF6, 0x4A, 0xB5, 0xB2, 
+0x05, 0x3D, 0x71, 0x41, 0x8E, 0x40, 0xC4, 0x22, 
+0x50, 0x31, 0x1B, 0xBA, 0x3F, 0xCA, 0xB7, 0xBE, 
+0x83, 0x4B, 0x8B, 0x36, 0xC1, 0x9B, 0xA1, 0xE9, 
+0xA9, 0x5D, 0x5E, 0xD2, 0x03, 0xEA, 0x1E, 0xD2, 
+0x89, 0xE6, 0x90, 0xA7, 0x25, 0x2E, 0xE6, 0x95, 
+0x2B, 0x49, 0x06, 0xAF, 0x29, 0x78, 0xA5, 0x5B, 
+0x29, 0xDA, 0x9F, 0xE6, 0x45, 0xC4, 0x36, 0xDA, 
+0xCC, 0xDF, 0xF7, 0xA2, 0x4C, 0xFD, 0xCF, 0xC4, 
+0xB7, 0x1F, 0x3C, 0x62, 0x6C, 0x05, 0x05, 0x94, 
+0x1E, 0xE4, 0xC0, 0xCE, 0x3D, 0x26, 0x9A, 0xE2, 
+0xDA, 0xF5, 0x7C, 0xEC, 0x49, 0x49, 0x38, 0xF5, 
+0x54, 0xFD, 0x7F, 0xA2, 0xA9, 0x4F, 0x3E, 0xA8, 
+0xBC, 0x79, 0x94, 0xD7, 0x28, 0x86, 0x44, 0x45, 
+0xE7, 0x06, 0x86, 0xA7, 0x5B, 0x00, 0xF8, 0xC3, 
+0xDA, 0xC7, 0x7E, 0xA7, 0x42, 0xB0, 0xAA, 0xD5, 
+0x28, 0x97, 0xB0, 0xC4, 0x41, 0x7F, 0xBF, 0xD2, 
+0xF5, 0xEF, 0x08, 0x92, 0xC7, 0x21, 0xA8, 0x2E, 
+0x37, 0xF7, 0x99, 0x35, 0x53, 0x07, 0xFE, 0x21, 
+0xFE, 0x6B, 0xA1, 0xD0, 0x01, 0x0D, 0x23, 0x45, 
+0x68, 0x8D, 0xE2, 0x30, 0xE0, 0x94, 0x5B, 0x1D, 
+0xD2, 0xE5, 0x6A, 0xCB, 0xC2, 0xE7, 0xB0, 0xD7, 
+0x7F, 0x52, 0xA7, 0x9A, 0x3A, 0x3B, 0xA6, 0x7C, 
+0x82, 0x01, 0xE3, 0x0D, 0x03, 0xBE, 0x1D, 0x37, 
+0xA9, 0x88, 0x1A, 0xC8, 0x61, 0xD3, 0x51, 0xAE, 
+0xCD, 0xA0, 0x3C, 0x3D, 0x35, 0x89, 0xE2, 0x4C, 
+0x8A, 0xAB, 0xA7, 0x21, 0x6B, 0xB5, 0xA5, 0x9E, 
+0xF1, 0xA9, 0x8B, 0x9C, 0x01, 0x2A, 0x6A, 0x6B, 
+0xAE, 0x7B, 0x2E, 0xDB, 0x2B, 0xA9, 0x77, 0x5E, 
+0xD1, 0x85, 0xF6, 0xB8, 0x55, 0xD3, 0x59, 0x98, 
+0x61, 0x8D, 
+
+0xD3, 0xB1, 0xA7, 0x9B, 0xE7, 0x58, 0x9B, 0xF8, 
+0x74, 0x55, 0xAE, 0xA5, 0x41, 0x14, 0xE8, 0x64, 
+0x31, 0xC2, 0x92, 0xD1, 0x96, 0x27, 0x7A, 0xA6, 
+0x80, 0x26, 0x53, 0xB9, 0xDF, 0x80, 0xB6, 0x21, 
+0xC2, 0x4A, 0x98, 0x48, 0x49, 0xF9, 0x67, 0x5A, 
+0x01, 0x8A, 0x10, 0xAB, 0xEB, 0xA0, 0x73, 0x9B, 
+0x2F, 0x2B, 0x07, 0x08, 0x49, 0x36, 0x01, 0xF1, 
+0x17, 0xE3, 0x3A, 0xDF, 0x90, 0xE1, 0x75, 0x83, 
+0x4E, 0xE4, 0x92, 0x54, 0x6A, 0xE9, 0xB7, 0x5E, 
+0x99, 0x08, 0xA7, 0xF1, 0x06, 0x33, 0xD6, 0x1C, 
+0xD9, 0xF2, 0xBF, 0x5B, 0x49, 0xED, 0x37, 0x1A, 
+0x49, 0xF7, 0x37, 0xE5, 0x91, 0xAB, 0x8B, 0xE3, 
+0x48, 0xD0, 0x06, 0x14, 0x3A, 0x9F, 0x4F, 0xCE, 
+0x4A, 0xDD, 0x3A, 0x40, 0x0A, 0x57, 0x11, 0xD6, 
+0xB8, 0xCE, 0x21, 0xFB, 0x83, 0xFB, 0x5F, 0x1D, 
+0x04, 0xB5, 0x5F, 0x45, 0xB4, 0x41, 0xF0, 0x15, 
+0xF2, 0x3A, 0xCE, 0x80, 0x19, 0xDD, 0x77, 0x5E, 
+0xA1, 0x91, 0x4E, 0x38, 0x60, 0x15, 0x0C, 0xCF, 
+0x02, 0xAD, 0xD9, 0x88, 0x8F, 0x52, 0x06, 0xFD, 
+0x77, 0x20, 0x09, 0x75, 0xD6, 0x99, 0xAE, 0xFD, 
+0x23, 0xF5, 0x31, 0xD9, 0xB1, 0xED, 0x97, 0xDD, 
+0x45, 0x11, 0x13, 0x47, 0x1B, 0xB1, 0xBA, 0xDF, 
+0x31, 0xE2, 0xE8, 0x27, 0xD4, 0xD7, 0x72, 0x32, 
+0x28, 0x61, 0x31, 0x30, 0x02, 0xAA, 0x83, 0x1C, 
+0x71, 0xBE, 0xE0, 0xBE, 0x67, 0x49, 0xDB, 0x5C, 
+0x0D, 0x21, 0x45, 0x2B, 0xE3, 0x7F, 0x54, 0x31, 
+0x68, 0x0F, 0x62, 0xC8, 0xA2, 0xDA, 0x0F, 0x26, 
+0xA0, 0xB5, 0xB1, 0xF4, 0xDC, 0xDC, 0x5D, 0x3E, 
+0xEA, 0x68, 0x8A, 0xFF, 0xFA, 0xC9, 0xF5, 0x67, 
+0x61, 0x97, 0x39, 0xAE, 0xBF, 0xB8, 0xA9, 0x03, 
+0xA0, 0x96, 0x50, 
+
+0x1F, 0xC9, 0xF1, 0x2E, 0x74, 0x0D, 0x02, 0x1A, 
+0x21, 0xCD, 0xBA, 0xCB, 0x5D, 0x97, 0xD9, 0xE3, 
+0x96, 0x90, 0xDB, 0x29, 0x5D, 0x45, 0x4E, 0x58, 
+0x07, 0x81, 0xDA, 0xBE, 0x37, 0xEA, 0x4B, 0x98, 
+0x88, 0xBC, 0xE9, 0xBA, 0xAF, 0x8F, 0xA3, 0xF5, 
+0x8B, 0x78, 0x21, 0x21, 0x2C, 0x05, 0x79, 0x72, 
+0xE3, 0x12, 0x12, 0x49, 0xCF, 0x45, 0x81, 0x37, 
+0x96, 0x9A, 0xFF, 0xBC, 0xAA, 0x79, 0x22, 0x40, 
+0x01, 0x01, 0xA2, 0xCE, 0xEA, 0x11, 0x1E, 0x55, 
+0xB5, 0xAB, 0x91, 0xD0, 0xBA, 0x49, 0xA2, 0x07, 
+0xA2, 0xFB, 0x59, 0x50, 0xF1, 0x95, 0x43, 0xFB, 
+0xEE, 0xC0, 0x2A, 0xD7, 0xEB, 0x67, 0x51, 0x9B, 
+0x13, 0x3A, 0x17, 0xF0, 0x3B, 0xC6, 0x67, 0x00, 
+0xE7, 0x40, 0xAA, 0x74, 0xCD, 0x90, 0x2A, 0xCD, 
+0x3C, 0x48, 0x70, 0x0E, 0x80, 0x28, 0x36, 0xA1, 
+0x34, 0xF4, 0xB9, 0xE2, 0x34, 0x0A, 0x1B, 0x85, 
+0x8C, 0x91, 0x54, 0xCE, 0xB1, 0x32, 0x9D, 0x0D, 
+0x42, 0xC0, 0x94, 0x5C, 0x35, 0x9A, 0x41, 0x6D, 
+0x95, 0xAF, 0x7E, 0xD1, 0x4F, 0xE2, 0x3F, 0xBC, 
+0xA1, 0x18, 0x97, 0x35, 0xBA, 0x1A, 0x55, 0x04, 
+0x05, 0x71, 0xB3, 0x31, 0x47, 0x16, 0xA7, 0x3B, 
+0x4B, 0x7C, 0x6F, 0x5D, 0x76, 0x30, 0xAC, 0x6D, 
+0x0C, 0x8A, 0x93, 0x38, 0xEE, 0x19, 0x2B, 0x5E, 
+0x86, 0x4E, 0x48, 0xC1, 0x9C, 0x62, 0x0B, 0x4F, 
+0xC7, 0x62, 0xCD, 0xEF, 0x3C, 0xED, 0x93, 0x99, 
+0xD5, 0xC4, 0xF8, 0x33, 0x19, 0xCA, 0xE9, 0x24, 
+0x71, 0xAC, 0x38, 0x1C, 0x3A, 0x3E, 0xEF, 0xAB, 
+0x5F, 0x7D, 0xD6, 0xBF, 0x0E, 0x1D, 0x0C, 0xE7, 
+0x3E, 0xC8, 0xD6, 0x31, 0xE1, 0xF8, 0x8A, 0x24, 
+0x30, 0xD6, 0x28, 0x6E, 0xE9, 0xCC, 0x26, 0xE4, 
+0xE1, 0x46, 0xD7, 0x31, 
+
+0x64, 0x83, 0xDD, 0x30, 0x59, 0x47, 0x4F, 0x9D, 
+0xD4, 0xE1, 0x4D, 0x77, 0xB7, 0xCD, 0x73, 0x3E, 
+0xD3, 0x86, 0xD5, 0x73, 0x77, 0xB0, 0x0D, 0xDF, 
+0x8F, 0xF9, 0x5D, 0x6D, 0x52, 0xDD, 0xAA, 0x0E, 
+0x23, 0x38, 0xC5, 0xE8, 0xA7, 0x2D, 0x10, 0x1D, 
+0x7B, 0x6C, 0xF7, 0xEB, 0xD9, 0xC1, 0x71, 0x18, 
+0xDA, 0x50, 0xD6, 0x42, 0x31, 0x9D, 0xBE, 0x96, 
+0x01, 0x40, 0xF9, 0xDC, 0x05, 0x19, 0xDD, 0x76, 
+0x87, 0xEA, 0x0A, 0xF2, 0xBE, 0xA4, 0x96, 0x57, 
+0x24, 0x3E, 0x18, 0x2F, 0x2A, 0xF6, 0xAB, 0x0C, 
+0x08, 0xF0, 0xBA, 0xA0, 0x61, 0xE1, 0xD5, 0xFE, 
+0xF6, 0x12, 0x8F, 0xEF, 0xB2, 0xB4, 0xC8, 0x9F, 
+0x6C, 0xC4, 0xB4, 0xCE, 0x8C, 0x79, 0xCC, 0x32, 
+0x9A, 0xD3, 0x98, 0x12, 0x03, 0xB0, 0xD7, 0x15, 
+0x60, 0x90, 0xE1, 0x84, 0x5E, 0xE4, 0xEC, 0x07, 
+0x79, 0x78, 0x2D, 0xCB, 0x80, 0x71, 0x5D, 0xEC, 
+0x94, 0x75, 0x25, 0x00, 0xF4, 0x33, 0x43, 0x75, 
+0x67, 0xA8, 0x6B, 0x29, 0xA3, 0xC5, 0xE1, 0xAE, 
+0x20, 0xD7, 0xA1, 0xFF, 0x6D, 0x4C, 0x10, 0x4B, 
+0x0F, 0xCF, 0xB5, 0x0F, 0x67, 0xA8, 0xA7, 0x8A, 
+0x33, 0xB7, 0xD8, 0xC2, 0x0E, 0xDB, 0x21, 0xB2, 
+0xFD, 0xE4, 0xD3, 0x0F, 0xA7, 0x57, 0xFB, 0xD0, 
+0xDA, 0xBD, 0x36, 0x7C, 0x32, 0x6A, 0x8B, 0x1A, 
+0x2B, 0x44, 0x98, 0x58, 0xC2, 0x81, 0xFF, 0x5A, 
+0x4B, 0x61, 0xFE, 0x26, 0x3F, 0xBA, 0x2E, 0xB8, 
+0xE4, 0xAA, 0x0F, 0x3C, 0x94, 0x1C, 0xCA, 0xD0, 
+0x01, 0x94, 0x32, 0x14, 0xE5, 0x99, 0x08, 0xD3, 
+0x19, 0x10, 0x34, 0x6E, 0x16, 0x57, 0x4E, 0x10, 
+0x5F, 0xFB, 0xF5, 0x68, 0x00, 0x79, 0xA7, 0x8B, 
+0x57, 0xE4, 0x97, 0x7D, 0x66, 0x66, 0x9F, 0xDA, 
+0x95, 0x91, 0x96, 0x9E, 0x17, 
+
+0x6C, 0x9E, 0xA1, 0x29, 0xC0, 0x72, 0xB5, 0xCD, 
+0xEF, 0xC9, 0xEA, 0xAE, 0xD0, 0xC8, 0x99, 0x7A, 
+0xAB, 0xF9, 0x5A, 0xA9, 0x4B, 0x4A, 0x4E, 0x3E, 
+0xFC, 0x69, 0x27, 0x77, 0xD8, 0x00, 0x04, 0x59, 
+0x97, 0xC6, 0x34, 0x04, 0xD3, 0x6E, 0x08, 0x40, 
+0xEE, 0x92, 0xFA, 0x77, 0xE6, 0xFF, 0x5C, 0xBB, 
+0xDB, 0x4B, 0xA9, 0x3F, 0x27, 0xA7, 0x89, 0x66, 
+0xE2, 0x5D, 0xAB, 0x64, 0x94, 0x80, 0xBC, 0xC6, 
+0xDB, 0xAC, 0x15, 0x4B, 0x51, 0x35, 0x20, 0x70, 
+0xD1, 0xFA, 0x5F, 0xFF, 0xDA, 0x92, 0x74, 0x16, 
+0x0C, 0x7D, 0x75, 0xDB, 0x10, 0xE7, 0xCE, 0x01, 
+0x02, 0xE4, 0xC0, 0x3B, 0x1E, 0xBD, 0xF9, 0x61, 
+0x7F, 0x86, 0x31, 0x3E, 0x9D, 0x38, 0x39, 0x56, 
+0x2B, 0x6C, 0x0E, 0xFA, 0x30, 0x8A, 0xCF, 0xBA, 
+0x24, 0x19, 0x97, 0x4C, 0x69, 0x06, 0x20, 0x63, 
+0x90, 0x50, 0x5E, 0xFA, 0xF8, 0x3C, 0x95, 0x3B, 
+0x2B, 0x24, 0x91, 0x83, 0x2B, 0x51, 0x78, 0x6A, 
+0xC8, 0x76, 0xE2, 0xE6, 0xB4, 0xF5, 0x52, 0x4F, 
+0xD0, 0xF8, 0xAC, 0xF7, 0xC8, 0x41, 0x10, 0x56, 
+0x08, 0xDD, 0x17, 0x7C, 0x3D, 0xCE, 0x4F, 0x4B, 
+0x73, 0x16, 0x5D, 0x5F, 0x07, 0xC5, 0xD9, 0x7D, 
+0x3A, 0xDE, 0x94, 0x83, 0x3D, 0x2B, 0x7D, 0xDC, 
+0x90, 0xDE, 0x38, 0x3C, 0xA3, 0xFE, 0xC0, 0x2D, 
+0x03, 0x56, 0x09, 0x38, 0xA7, 0x3A, 0x7A, 0x9F, 
+0xAF, 0xAE, 0xB6, 0x04, 0x6F, 0xD5, 0x50, 0x24, 
+0x7B, 0xBB, 0x36, 0xFD, 0x0F, 0xBA, 0x78, 0xD1, 
+0x70, 0x59, 0xDA, 0x53, 0xFA, 0x60, 0x65, 0xE6, 
+0xE5, 0xE1, 0x8B, 0xFF, 0xCC, 0xC1, 0xE1, 0xB1, 
+0xFE, 0xAD, 0x8F, 0xCF, 0x2A, 0x6C, 0x8B, 0x12, 
+0xCD, 0xDA, 0xAC, 0xDA, 0xDB, 0x9E, 0xAD, 0x24, 
+0xDF, 0x97, 0x73, 0x83, 0x9F, 0xCC, 
+
+0xDE, 0x78, 0x42, 0x8F, 0x68, 0x7F, 0x6B, 0xCC, 
+0xCC, 0xA1, 0x5D, 0xE3, 0x17, 0x07, 0xE3, 0x60, 
+0x66, 0xC6, 0x46, 0x96, 0x14, 0xDD, 0x05, 0x6E, 
+0xC3, 0x02, 0x95, 0x79, 0x69, 0xC2, 0xFC, 0x83, 
+0x5B, 0x82, 0x8B, 0xE3, 0x69, 0xFC, 0x40, 0x94, 
+0x63, 0x2C, 0x53, 0xC3, 0xC2, 0x5B, 0x70, 0xCA, 
+0x1C, 0x1A, 0xC7, 0xC4, 0xFE, 0x24, 0x2A, 0x9F, 
+0x75, 0x46, 0x88, 0x2F, 0xFD, 0x4E, 0x51, 0x1A, 
+0x73, 0xF1, 0x72, 0xE5, 0x46, 0x85, 0x21, 0xB8, 
+0x50, 0xAC, 0x71, 0x9E, 0x01, 0xCF, 0x81, 0x54, 
+0x7A, 0x4D, 0xFE, 0x6E, 0x7C, 0x6E, 0x0A, 0xBA, 
+0x2D, 0x18, 0xAC, 0x20, 0xB1, 0x4D, 0x10, 0x90, 
+0x13, 0xFD, 0x14, 0x69, 0x0B, 0xA0, 0xBC, 0x9C, 
+0xDB, 0xD1, 0x99, 0x55, 0x77, 0x5F, 0xDB, 0x74, 
+0x1F, 0x75, 0x15, 0xEF, 0x00, 0xC3, 0xC2, 0xC4, 
+0xBB, 0xB0, 0xAB, 0x3C, 0x0F, 0xFC, 0x35, 0x0B, 
+0x6D, 0xA5, 0x9E, 0x70, 0x70, 0x7E, 0xB3, 0xD8, 
+0x89, 0x9F, 0x6E, 0x95, 0xD4, 0xBC, 0x4B, 0xAA, 
+0x70, 0x97, 0xD1, 0x1A, 0xD9, 0xD5, 0xCA, 0xFB, 
+0x11, 0x6A, 0x3E, 0x06, 0xC2, 0x18, 0xD1, 0x7D, 
+0xE4, 0x61, 0xD4, 0xEF, 0x2E, 0x03, 0x9B, 0xC9, 
+0xB7, 0x09, 0x56, 0x0F, 0xF9, 0x76, 0xC1, 0x5C, 
+0x6B, 0x00, 0x1C, 0x11, 0xD2, 0x7C, 0xD4, 0x6F, 
+0x1D, 0x24, 0x0D, 0x05, 0xDD, 0xAF, 0xA6, 0xE9, 
+0x16, 0x6B, 0xF9, 0xC9, 0xE3, 0x16, 0x47, 0x78, 
+0xC7, 0x97, 0xBC, 0x13, 0xD5, 0xDE, 0x2E, 0xC2, 
+0xCA, 0x99, 0x0D, 0x17, 0x5F, 0x8C, 0x9A, 0x44, 
+0xD3, 0x9C, 0x66, 0xD1, 0x6C, 0x5A, 0x12, 0xD4, 
+0xBC, 0x1C, 0x97, 0xA4, 0xE8, 0xB7, 0x86, 0x9F, 
+0x7D, 0xE4, 0x96, 0x9D, 0xF9, 0xC8, 0x5C, 0xDC, 
+0xC4, 0xEA, 0xC9, 0x05, 0xAD, 0x15, 0xC2, 
+
+0xE5, 0x02, 0x9D, 0x1A, 0xF8, 0xAF, 0xFE, 0x2A, 
+0x8E, 0x35, 0xF5, 0x6E, 0xF6, 0x94, 0x48, 0x16, 
+0x82, 0x4F, 0xE5, 0xB2, 0xF7, 0x61, 0x5D, 0x25, 
+0x79, 0xE2, 0xFC, 0xE6, 0x57, 0x14, 0x19, 0x5C, 
+0x53, 0xF5, 0x41, 0xCF, 0x33, 0xA1, 0xEA, 0xED, 
+0x8C, 0x2D, 0x9F, 0xA1, 0x0F, 0xBF, 0xDB, 0x92, 
+0x1B, 0xE5, 0x62, 0x4E, 0x56, 0x74, 0x81, 0x1F, 
+0x53, 0x10, 0x69, 0x44, 0xE6, 0x82, 0x86, 0xB1, 
+0x18, 0x5F, 0x70, 0xC1, 0x6D, 0x00, 0xB0, 0x5B, 
+0x04, 0x17, 0x0E, 0xDB, 0xAA, 0xB7, 0x62, 0x48, 
+0x2F, 0x80, 0x2F, 0x02, 0x01, 0x9F, 0x33, 0x36, 
+0x3E, 0xF3, 0x96, 0x4D, 0x23, 0x92, 0xEC, 0xB0, 
+0x59, 0x03, 0xB6, 0xCE, 0x26, 0xE4, 0x36, 0xE6, 
+0xBD, 0x6A, 0xE0, 0xEF, 0x51, 0xED, 0xE0, 0xAB, 
+0xA2, 0x45, 0x9C, 0x2F, 0xDD, 0x46, 0xB4, 0x9D, 
+0x89, 0xD1, 0xBB, 0x91, 0x29, 0xFC, 0xCA, 0x27, 
+0xA7, 0xE0, 0x22, 0x68, 0x1F, 0xDD, 0xCA, 0x49, 
+0x0B, 0xC9, 0x4F, 0xBC, 0xDA, 0xF1, 0xAA, 0x5D, 
+0x3A, 0x8E, 0xC2, 0x50, 0xAA, 0xE7, 0x7C, 0x30, 
+0x8E, 0x4B, 0x92, 0x22, 0xA7, 0x55, 0x92, 0xBB, 
+0x77, 0xD6, 0xDF, 0xFC, 0x3E, 0x43, 0x8B, 0xF1, 
+0x43, 0x6F, 0x9A, 0x93, 0x02, 0xBD, 0x16, 0xA2, 
+0xE7, 0x84, 0xDB, 0xF3, 0x5C, 0xF6, 0x49, 0xF6, 
+0x8A, 0xB4, 0x06, 0x2F, 0xF6, 0x9B, 0xE7, 0xD4, 
+0x3C, 0x89, 0x82, 0x3D, 0x59, 0x52, 0x6E, 0x9F, 
+0x55, 0xC6, 0x2E, 0x14, 0x9B, 0x97, 0xBD, 0x4C, 
+0xCD, 0x3A, 0xA3, 0xAC, 0xA1, 0x9B, 0x43, 0x92, 
+0x79, 0xD5, 0x08, 0x7A, 0x08, 0xA7, 0x08, 0x7E, 
+0x47, 0xC5, 0xBB, 0x14, 0x62, 0x8F, 0x31, 0x14, 
+0x1A, 0x3D, 0x11, 0x5D, 0x19, 0xBC, 0x92, 0xC9, 
+0x8B, 0xF7, 0x9D, 0x77, 0x55, 0x16, 0xE4, 0xD7, 
+
+0x88, 0x47, 0xFB, 0x24, 0xEE, 0x57, 0x38, 0x6E, 
+0x77, 0x56, 0xCF, 0xB4, 0x01, 0xE4, 0x0F, 0x7B, 
+0x7D, 0x05, 0xB1, 0xF1, 0x1A, 0x23, 0x97, 0xC0, 
+0x27, 0x0B, 0x91, 0xD9, 0xFE, 0x6E, 0x44, 0x1C, 
+0x50, 0x70, 0x90, 0xAD, 0xEB, 0x85, 0x7B, 0xC4, 
+0xA0, 0xAA, 0xCB, 0x90, 0xD5, 0x95, 0x5F, 0xB5, 
+0xEA, 0x85, 0x7C, 0x0D, 0xC4, 0x80, 0x13, 0xBD, 
+0xD6, 0xAB, 0x95, 0xAE, 0x57, 0xF8, 0x33, 0xAD, 
+0x96, 0x86, 0xAD, 0xAC, 0x41, 0x73, 0x7C, 0x71, 
+0x12, 0x89, 0xF3, 0x72, 0x50, 0x35, 0xD2, 0x49, 
+0x33, 0xD3, 0x79, 0xBC, 0xAD, 0x4B, 0xE4, 0xD3, 
+0x4E, 0x51, 0x1D, 0xD8, 0x8F, 0x7C, 0x8B, 0x81, 
+0xDC, 0xCE, 0x94, 0x6C, 0xCB, 0x69, 0x8D, 0x83, 
+0xC1, 0x4C, 0xF4, 0x53, 0x62, 0x8A, 0xBF, 0x89, 
+0xB5, 0x43, 0x8A, 0x87, 0x59, 0xDB, 0x9D, 0x13, 
+0xBE, 0xF8, 0x92, 0x76, 0x29, 0x7D, 0xBC, 0xF7, 
+0xCE, 0x1B, 0x00, 0xD0, 0xD1, 0x36, 0x56, 0x7E, 
+0x9D, 0x53, 0xFE, 0x19, 0x70, 0x74, 0xFB, 0xF6, 
+0xC4, 0xD0, 0xC2, 0x2D, 0x5B, 0x12, 0xD1, 0x3C, 
+0x19, 0x27, 0x29, 0xA8, 0x37, 0x61, 0x8A, 0x42, 
+0x97, 0x8A, 0x56, 0x39, 0x98, 0x0F, 0x83, 0xF5, 
+0x1F, 0x2A, 0x1C, 0xE6, 0xB7, 0xF3, 0xC5, 0x93, 
+0x0D, 0x31, 0xE0, 0xCE, 0xFC, 0x01, 0x78, 0xF8, 
+0x8D, 0x3B, 0x8A, 0x9F, 0x23, 0x9F, 0x26, 0x9F, 
+0x47, 0x95, 0x5C, 0x34, 0x0D, 0xD6, 0xE4, 0xE8, 
+0xB6, 0x58, 0x98, 0x19, 0xA2, 0x6F, 0x58, 0x28, 
+0x00, 0x60, 0xE1, 0x62, 0xC1, 0x68, 0xDF, 0x07, 
+0x52, 0xBF, 0x28, 0xB7, 0xC9, 0xA6, 0xCE, 0x1A, 
+0x61, 0x3A, 0xA7, 0x3D, 0x15, 0x67, 0xA0, 0xA3, 
+0x2A, 0x7B, 0x39, 0x38, 0x2C, 0xA5, 0xBB, 0xCE, 
+0x5E, 0xC6, 0xB3, 0x09, 0xFB, 0x61, 0xF4, 0x02, 
+0x8A, 
+
+0xD5, 0xAC, 0xFB, 0x4F, 0x0A, 0xD0, 0xCD, 0xB4, 
+0x95, 0x00, 0x85, 0x6F, 0xE7, 0x75, 0x3A, 0xDE, 
+0x03, 0xB8, 0xA9, 0x33, 0xA5, 0xE1, 0x96, 0x0A, 
+0xFF, 0x26, 0xBE, 0x39, 0x91, 0xD7, 0x41, 0xBA, 
+0xA5, 0x14, 0xC3, 0xFF, 0x8D, 0x40, 0x20, 0xE1, 
+0x4A, 0xBE, 0xE7, 0x1C, 0x42, 0x8E, 0x6A, 0xCB, 
+0x82, 0x36, 0xC7, 0xB5, 0x2C, 0xE1, 0xDF, 0x85, 
+0xF9, 0x06, 0xFA, 0x5C, 0x16, 0x14, 0x64, 0x7C, 
+0x80, 0xA8, 0xC5, 0xCB, 0xE8, 0xFD, 0xEF, 0x2F, 
+0xDC, 0xD6, 0xBA, 0x6A, 0xF7, 0xDA, 0x35, 0x6D, 
+0xBE, 0x24, 0xCD, 0xD1, 0x16, 0xC0, 0xF5, 0x4B, 
+0x04, 0x66, 0xEF, 0x49, 0x01, 0x96, 0xF6, 0x91, 
+0x2B, 0xA3, 0x73, 0xDA, 0x83, 0x8E, 0xA0, 0x59, 
+0xFE, 0x62, 0x5F, 0xB8, 0xCD, 0xDD, 0x5A, 0xFC, 
+0x14, 0xBA, 0x72, 0xE0, 0x87, 0xB4, 0x81, 0x2A, 
+0xB0, 0x65, 0x2F, 0x52, 0x22, 0x6F, 0xF0, 0x2C, 
+0xF5, 0xE9, 0x8D, 0x53, 0x3E, 0xE3, 0x18, 0x50, 
+0x8F, 0x20, 0x1E, 0xA4, 0x6C, 0x8F, 0x8E, 0xA8, 
+0xA3, 0xC4, 0x33, 0xF6, 0x29, 0x52, 0x16, 0x34, 
+0x6F, 0x75, 0x14, 0xE2, 0x4B, 0xB6, 0xE9, 0x30, 
+0x38, 0x40, 0x04, 0xD2, 0x87, 0xB3, 0x5A, 0xC9, 
+0xC8, 0x58, 0x93, 0x19, 0x3E, 0xC7, 0xC2, 0xB8, 
+0x4F, 0x89, 0xCB, 0x6C, 0x2B, 0x8D, 0xA6, 0x0B, 
+0xFF, 0xAE, 0xF9, 0xE5, 0xAC, 0x95, 0xC6, 0xA6, 
+0x99, 0x8C, 0x6F, 0xBC, 0x06, 0x98, 0x9B, 0xDE, 
+0xE7, 0x1D, 0x82, 0x63, 0xC3, 0x94, 0x41, 0x83, 
+0xE1, 0x83, 0x7F, 0xD2, 0xA5, 0x30, 0xC0, 0x23, 
+0x65, 0x18, 0xD6, 0xE8, 0x1E, 0x7A, 0x17, 0x50, 
+0xB5, 0xF9, 0x88, 0x8C, 0x66, 0x7D, 0x75, 0xF1, 
+0x2F, 0x17, 0xD2, 0x09, 0x04, 0x86, 0x20, 0xF4, 
+0xA2, 0x5B, 0x21, 0xC2, 0x3C, 0x33, 0xA2, 0xC3, 
+0xAD, 0xF7, 
+
+0x5F, 0x35, 0xFE, 0xB3, 0x32, 0x61, 0x6B, 0x7F, 
+0xFB, 0x75, 0x52, 0x1A, 0x03, 0x64, 0x8B, 0x68, 
+0x33, 0x11, 0x86, 0x7F, 0xE8, 0xDB, 0x9D, 0x5D, 
+0x12, 0xC4, 0xB3, 0x4C, 0x91, 0x87, 0xBA, 0xE3, 
+0x76, 0x3D, 0x0C, 0x93, 0x98, 0xCB, 0xE4, 0x46, 
+0xE8, 0xB8, 0x72, 0x43, 0xC6, 0x2B, 0xAE, 0xD2, 
+0xCA, 0x16, 0xF1, 0x27, 0xA4, 0x5A, 0x40, 0xE8, 
+0xB3, 0x63, 0x5D, 0xBF, 0xF8, 0x5E, 0x39, 0xBA, 
+0xB5, 0x6B, 0x25, 0x0A, 0xBC, 0x34, 0x61, 0xC9, 
+0x14, 0x69, 0x6A, 0x63, 0x09, 0xFC, 0xCD, 0x2C, 
+0x21, 0xAC, 0x81, 0x14, 0x8C, 0xBA, 0x6B, 0xDF, 
+0xFD, 0x8E, 0x10, 0x0D, 0xF1, 0x76, 0xC8, 0x8F, 
+0x73, 0xF3, 0xF3, 0x52, 0x87, 0x92, 0xA1, 0xCE, 
+0x16, 0x2D, 0x74, 0xED, 0x2A, 0x15, 0x8D, 0x6D, 
+0x29, 0xCF, 0xE8, 0xAF, 0xDE, 0xA7, 0xE3, 0xCC, 
+0x20, 0x8F, 0xC3, 0x87, 0xD4, 0x2E, 0x76, 0xAA, 
+0x9C, 0x75, 0xD0, 0xBB, 0x2B, 0xF9, 0xB0, 0x27, 
+0x3D, 0xB7, 0xEF, 0xC6, 0x9B, 0x64, 0xA1, 0x98, 
+0x80, 0x5F, 0xB2, 0x16, 0x75, 0xE7, 0xB0, 0x14, 
+0xE3, 0xBB, 0xFC, 0xCF, 0xA3, 0xC0, 0xB9, 0xFB, 
+0xAA, 0x92, 0x1F, 0x9D, 0x4D, 0x44, 0xC3, 0xF2, 
+0x76, 0x5B, 0x75, 0x38, 0xB9, 0x49, 0x8D, 0x80, 
+0xBB, 0xC8, 0xAD, 0xCC, 0x5C, 0xE5, 0xD7, 0x88, 
+0xCC, 0x28, 0x66, 0xF9, 0xE1, 0x7C, 0x51, 0xA4, 
+0x35, 0x39, 0x8B, 0xA0, 0xE3, 0x45, 0x20, 0xF1, 
+0x74, 0x27, 0xCD, 0xA4, 0x0C, 0xF6, 0xE4, 0xFB, 
+0xCA, 0x22, 0x67, 0x10, 0x32, 0xAA, 0xC8, 0x7C, 
+0xDE, 0x74, 0xF5, 0x19, 0x8C, 0x6D, 0xD4, 0xDA, 
+0x2C, 0x78, 0xF5, 0x58, 0x39, 0x24, 0xA5, 0xA2, 
+0xEE, 0x02, 0x0E, 0x13, 0x4C, 0x91, 0xAE, 0x22, 
+0x1B, 0x0D, 0x79, 0x55, 0x78, 0x26, 0x64, 0x13, 
+0x89, 0xB8, 0x31, 
+
+0xF5, 0x7C, 0xC8, 0x18, 0xF0, 0x80, 0xBE, 0x09, 
+0xE9, 0x67, 0x63, 0x48, 0x97, 0x91, 0x69, 0xD2, 
+0x47, 0x14, 0x3C, 0x1B, 0xCA, 0xBC, 0x76, 0xBC, 
+0xD8, 0x97, 0xA7, 0xEA, 0xDB, 0x6E, 0x77, 0x96, 
+0xEE, 0xAB, 0x37, 0x0F, 0x5E, 0xA7, 0xCE, 0xC6, 
+0xFF, 0x47, 0xD0, 0xDB, 0x05, 0x91, 0x16, 0xF6, 
+0x44, 0x68, 0x5B, 0x5C, 0x7C, 0x3F, 0xE1, 0x02, 
+0x3C, 0xA5, 0x57, 0x38, 0x78, 0x51, 0x6E, 0x78, 
+0x65, 0x7A, 0x8B, 0x4D, 0x85, 0x41, 0x1F, 0x86, 
+0x2C, 0xEA, 0xEC, 0x8E, 0x03, 0x96, 0x35, 0xE2, 
+0x6D, 0xE2, 0xED, 0x86, 0x28, 0xA0, 0x1D, 0x47, 
+0x99, 0x14, 0x1C, 0x8D, 0x88, 0x56, 0x34, 0x9F, 
+0xF9, 0x22, 0xDD, 0x80, 0x30, 0x20, 0x45, 0xDD, 
+0x75, 0x0D, 0x2E, 0x07, 0x73, 0xC4, 0x68, 0x97, 
+0x2B, 0x04, 0xA4, 0xAE, 0x87, 0xB6, 0x71, 0x76, 
+0x37, 0x7C, 0x06, 0x5C, 0x24, 0xE3, 0xDC, 0x2E, 
+0xEB, 0x40, 0x87, 0x36, 0xBB, 0x4E, 0xBC, 0xA9, 
+0xB9, 0xD1, 0xEB, 0x23, 0x51, 0x7C, 0x48, 0xEA, 
+0x39, 0x8E, 0xE0, 0xFA, 0x04, 0x60, 0xED, 0x25, 
+0x64, 0xB5, 0x35, 0x2D, 0xAA, 0x51, 0xE2, 0x52, 
+0x02, 0x21, 0x52, 0x2E, 0x00, 0x94, 0x09, 0x4C, 
+0xF6, 0x8A, 0xDC, 0xF3, 0xA7, 0xFC, 0xB4, 0xAB, 
+0x41, 0xF0, 0xEE, 0x21, 0x0A, 0x3D, 0xC3, 0xCD, 
+0x85, 0x9E, 0xDB, 0xF4, 0xDB, 0x1F, 0xCC, 0x76, 
+0xCF, 0xE3, 0x00, 0x92, 0xF1, 0xB8, 0xE4, 0x7C, 
+0xF7, 0x7C, 0x58, 0xDE, 0x33, 0x14, 0x29, 0x14, 
+0x3B, 0x09, 0x96, 0xD8, 0xC1, 0xA0, 0x13, 0xFD, 
+0x46, 0x41, 0xE4, 0xF2, 0xA3, 0xC7, 0x82, 0x09, 
+0x65, 0xC3, 0x31, 0xFD, 0xD7, 0x2B, 0x2C, 0xD3, 
+0xA7, 0xF6, 0x8D, 0xBD, 0x28, 0x71, 0x9A, 0x97, 
+0x2E, 0x4B, 0xE4, 0x8C, 0x41, 0xCA, 0x06, 0xA0, 
+0x2E, 0x98, 0x93, 0x5C, 
+
+0x4B, 0xF9, 0x62, 0x96, 0xBA, 0xF1, 0x1F, 0x4E, 
+0x1E, 0xA5, 0x83, 0xA0, 0x0E, 0x43, 0x65, 0xEA, 
+0x5F, 0x04, 0xD3, 0x95, 0x5C, 0x2F, 0x8E, 0xCB, 
+0xE9, 0xDC, 0xFD, 0xF1, 0xA4, 0x01, 0x4C, 0x69, 
+0x71, 0xF2, 0xEA, 0xF2, 0x35, 0x09, 0x55, 0x79, 
+0xFA, 0x14, 0x86, 0xB0, 0x1D, 0x3B, 0xBF, 0xBB, 
+0x1A, 0x38, 0x2B, 0x52, 0x36, 0x59, 0x1C, 0x9C, 
+0x35, 0x5A, 0xF8, 0x19, 0xE3, 0x2B, 0xA1, 0xF4, 
+0xAB, 0xA9, 0x31, 0x6E, 0x96, 0xBF, 0x74, 0x1E, 
+0x17, 0x27, 0x98, 0x6C, 0x40, 0x1C, 0xDE, 0x52, 
+0x69, 0x67, 0x16, 0x42, 0x0F, 0xAA, 0x0E, 0xB1, 
+0x63, 0xD0, 0x39, 0xD2, 0x69, 0x54, 0x72, 0x93, 
+0x8F, 0xBD, 0x48, 0x6B, 0x46, 0x54, 0xB5, 0x6A, 
+0x36, 0xEC, 0xE9, 0x12, 0xBE, 0xF6, 0x2E, 0xEB, 
+0xD2, 0xC9, 0x78, 0xE8, 0x8C, 0xEE, 0x8C, 0xCA, 
+0x8B, 0x5F, 0x11, 0xBD, 0xC1, 0x36, 0x36, 0x5F, 
+0x16, 0x82, 0x7D, 0xC7, 0xC9, 0x65, 0x57, 0x62, 
+0x9A, 0x4C, 0xE8, 0xB4, 0xA8, 0x43, 0x04, 0x81, 
+0x75, 0x00, 0x95, 0xAA, 0xE1, 0xA8, 0x2A, 0xA6, 
+0x25, 0xF8, 0x23, 0x8E, 0x61, 0x0A, 0x0F, 0x8B, 
+0x5A, 0xA1, 0xDA, 0xA3, 0x10, 0xAF, 0x4D, 0xBC, 
+0xB9, 0xE2, 0x52, 0x5E, 0x68, 0x9D, 0x45, 0x86, 
+0x60, 0x5F, 0x41, 0x3E, 0x3F, 0xD0, 0xF4, 0x05, 
+0x4F, 0x46, 0xD9, 0x51, 0xD7, 0x4E, 0x53, 0x3F, 
+0x0B, 0x1A, 0xCC, 0x8A, 0xE1, 0x22, 0x28, 0x7E, 
+0x98, 0x77, 0xBE, 0x14, 0x8A, 0x37, 0x1E, 0xD8, 
+0xA6, 0x22, 0x24, 0x0C, 0x0C, 0xA3, 0x59, 0xF9, 
+0x00, 0x16, 0x9D, 0xF6, 0xF0, 0x19, 0xD6, 0xC1, 
+0x1E, 0x72, 0x33, 0xB7, 0x03, 0xA1, 0x2C, 0x93, 
+0x87, 0x2B, 0xC0, 0x9A, 0x6B, 0xD0, 0x2F, 0xF3, 
+0x28, 0x28, 0x3A, 0x8C, 0x23, 0x44, 0x20, 0x06, 
+0x57, 0x14, 0x0A, 0x0F, 0x7D, 
+
+0xD0, 0x62, 0xF2, 0x9F, 0xAC, 0x51, 0xC2, 0x0D, 
+0xC6, 0xAE, 0xD0, 0x94, 0xAB, 0xC7, 0x0C, 0x1C, 
+0xCD, 0xA4, 0x61, 0x28, 0x95, 0xE5, 0xC2, 0x24, 
+0x3A, 0x86, 0xDB, 0x13, 0x65, 0xE4, 0xBD, 0xEF, 
+0x90, 0x77, 0xDE, 0x7E, 0xD1, 0x11, 0x6E, 0x8C, 
+0xF7, 0x0B, 0xA5, 0x2E, 0x00, 0xE3, 0x53, 0x30, 
+0x7E, 0xB3, 0x98, 0x86, 0x52, 0x98, 0x4D, 0xEE, 
+0xAC, 0xD5, 0x53, 0xD6, 0x44, 0x58, 0x1D, 0xA1, 
+0x9E, 0xCA, 0xC5, 0x86, 0xB9, 0x98, 0xEF, 0x50, 
+0x07, 0x56, 0x48, 0xCB, 0xB0, 0x22, 0x47, 0xE5, 
+0xFE, 0x88, 0xA0, 0xD3, 0xC8, 0xB0, 0xA1, 0xC4, 
+0xE0, 0x4C, 0xA7, 0x69, 0x4E, 0x6E, 0xDC, 0x01, 
+0x37, 0x69, 0x2B, 0xEB, 0x94, 0x02, 0x08, 0x9F, 
+0x1B, 0xB5, 0xAE, 0xA5, 0x6F, 0x87, 0x0B, 0xD1, 
+0x5A, 0x64, 0x27, 0x69, 0xE6, 0x4C, 0x8A, 0x5D, 
+0x11, 0x42, 0x58, 0x08, 0x13, 0xBC, 0x96, 0xF5, 
+0x3E, 0x34, 0x42, 0xC5, 0xFF, 0xBE, 0x0A, 0x75, 
+0x91, 0x85, 0x12, 0x24, 0xD1, 0x71, 0x8F, 0xAB, 
+0x05, 0x25, 0xDA, 0x4E, 0x2B, 0x6C, 0x34, 0x8B, 
+0xF0, 0x80, 0x45, 0x33, 0xA7, 0xEE, 0x5B, 0x4B, 
+0xA5, 0x60, 0xB8, 0x98, 0x0C, 0x69, 0x0F, 0x62, 
+0x14, 0xE3, 0x01, 0x16, 0x4C, 0x80, 0x66, 0xE2, 
+0xBE, 0x7D, 0x4E, 0xA8, 0xCC, 0xE5, 0xA1, 0xE2, 
+0xA1, 0x35, 0x4B, 0x1C, 0x3F, 0x3A, 0xB2, 0xC1, 
+0xE3, 0x64, 0x68, 0xB1, 0x7C, 0x38, 0xA9, 0xA0, 
+0x09, 0x66, 0xEB, 0x97, 0xEA, 0x2F, 0x22, 0x8C, 
+0x55, 0x25, 0x7E, 0xC5, 0x9D, 0x5C, 0xEE, 0x99, 
+0x41, 0x48, 0xC0, 0xCA, 0x20, 0xF8, 0x38, 0x65, 
+0xF1, 0x74, 0x45, 0x28, 0xC3, 0xD2, 0x47, 0xBF, 
+0xC3, 0xD1, 0xDC, 0x07, 0x68, 0x8B, 0x70, 0xC0, 
+0x60, 0xC8, 0x5E, 0x2A, 0x81, 0x0A, 0x0B, 0x40, 
+0x14, 0xC9, 0xF1, 0x48, 0xDC, 0xFF, 
+
+0x7F, 0x4E, 0x09, 0x33, 0x0D, 0xEF, 0x06, 0x2D, 
+0xA3, 0x79, 0x84, 0xB5, 0x65, 0x9E, 0x8D, 0x08, 
+0x6A, 0xA9, 0xC9, 0x9B, 0x34, 0xFA, 0x3D, 0x38, 
+0x0A, 0x6A, 0xDE, 0xDE, 0xDF, 0x45, 0x7C, 0xAC, 
+0xDC, 0x94, 0xC0, 0xD8, 0x1B, 0xA6, 0xAB, 0x18, 
+0x3A, 0x71, 0xEF, 0xD8, 0x43, 0xE6, 0x29, 0x39, 
+0x62, 0xF9, 0x4A, 0xAC, 0x02, 0xFB, 0x5E, 0x28, 
+0xE9, 0x66, 0xF3, 0x1C, 0x86, 0xC5, 0x0B, 0x87, 
+0xFF, 0xE1, 0x76, 0x0A, 0x74, 0x36, 0x77, 0x6B, 
+0x8F, 0x88, 0xD2, 0xF9, 0x5C, 0xFB, 0x5E, 0x64, 
+0xBF, 0xCD, 0x20, 0x73, 0x07, 0x1B, 0xF6, 0x95, 
+0x1F, 0xCB, 0xB5, 0x9F, 0x8E, 0xC2, 0xE2, 0x71, 
+0x13, 0xD1, 0x4E, 0xFC, 0x3A, 0x75, 0xC6, 0xDD, 
+0x3C, 0x55, 0xE4, 0xE5, 0x65, 0x5A, 0xD5, 0x70, 
+0xDF, 0x88, 0x66, 0x9F, 0x02, 0x95, 0x54, 0xFA, 
+0xBF, 0x2C, 0x20, 0x67, 0x29, 0x89, 0x6B, 0xC2, 
+0x83, 0x4C, 0xCD, 0xAD, 0x58, 0xF0, 0x8A, 0x1E, 
+0x30, 0xF1, 0xC6, 0x54, 0x25, 0x5B, 0x89, 0x67, 
+0x26, 0x11, 0x03, 0x69, 0xEF, 0xAB, 0x56, 0xB8, 
+0x46, 0x2A, 0xF7, 0x4B, 0xDF, 0x4E, 0xE8, 0x62, 
+0xE0, 0x27, 0x00, 0xA9, 0xE3, 0x47, 0x42, 0x41, 
+0xA3, 0x5B, 0xEB, 0x20, 0xEE, 0x3E, 0x28, 0x50, 
+0x00, 0x11, 0x18, 0x32, 0xC5, 0x2D, 0xE5, 0xC3, 
+0x96, 0x73, 0xEF, 0xAA, 0xF8, 0xAF, 0xF4, 0x89, 
+0xB6, 0x0E, 0xD9, 0xB1, 0xF0, 0xE4, 0x06, 0x69, 
+0x5C, 0x7F, 0x20, 0xE2, 0xD9, 0xDE, 0x2E, 0x5D, 
+0x8D, 0x73, 0x56, 0xD7, 0xE7, 0xE8, 0xAB, 0x33, 
+0xBB, 0x2D, 0x54, 0x0D, 0x96, 0xC1, 0x4B, 0xF9, 
+0x39, 0x31, 0x6E, 0x95, 0x67, 0xD8, 0x37, 0x8B, 
+0xCE, 0xDC, 0x4D, 0x67, 0x9E, 0x55, 0x17, 0x9C, 
+0x6F, 0x4F, 0xF9, 0x23, 0x70, 0x84, 0xA5, 0xB8, 
+0xA2, 0xC2, 0xE7, 0x6C, 0xBB, 0xF9, 0x45, 
+
+0x54, 0x2A, 0xE8, 0xD1, 0xC2, 0xCA, 0x67, 0xDB, 
+0xF1, 0xCD, 0x7F, 0x0A, 0xD1, 0x61, 0x3A, 0x42, 
+0x29, 0xAD, 0x8F, 0x3E, 0x66, 0x81, 0x9F, 0xDD, 
+0x15, 0x26, 0x6A, 0xE4, 0x69, 0x4B, 0x7C, 0xAB, 
+0xE2, 0x00, 0x36, 0xBF, 0x77, 0x53, 0x40, 0xF8, 
+0xB9, 0xBE, 0x5F, 0x17, 0x31, 0xEF, 0xEF, 0x83, 
+0xB8, 0x7E, 0x46, 0xDB, 0x99, 0x2F, 0x9A, 0x7F, 
+0x41, 0x38, 0xA1, 0x0F, 0x0A, 0xDA, 0x49, 0x45, 
+0x33, 0xA7, 0x07, 0xA5, 0xB5, 0xAF, 0x06, 0x2B, 
+0xA8, 0x07, 0xC4, 0x11, 0x71, 0x19, 0x36, 0x57, 
+0x0E, 0x09, 0xB9, 0x84, 0xB9, 0x6C, 0x8F, 0x5E, 
+0x24, 0xA2, 0x29, 0x36, 0x9A, 0x79, 0x0F, 0x20, 
+0xC4, 0x6C, 0xD8, 0xB8, 0xD6, 0x24, 0x40, 0x86, 
+0xA8, 0x41, 0x17, 0xA4, 0x84, 0x3C, 0x1B, 0x53, 
+0xA8, 0xFF, 0x52, 0xA1, 0x53, 0x35, 0xC8, 0xDB, 
+0x85, 0x79, 0xB8, 0x82, 0x4A, 0x52, 0x33, 0x68, 
+0xFC, 0xA0, 0x4C, 0x84, 0x31, 0x86, 0x4C, 0x1A, 
+0xD5, 0x7E, 0xE5, 0xD4, 0x3F, 0xDD, 0x97, 0x60, 
+0xD1, 0xA6, 0x1F, 0xA2, 0x2C, 0x64, 0xF0, 0xF2, 
+0xA9, 0x07, 0x02, 0x5C, 0x4F, 0xF3, 0x47, 0xA6, 
+0xB0, 0xA4, 0xA0, 0xE5, 0x12, 0xCA, 0x28, 0x59, 
+0x09, 0x66, 0x93, 0x41, 0x71, 0x1E, 0x74, 0x71, 
+0xCB, 0xC0, 0x01, 0xEA, 0x84, 0xAC, 0xAF, 0xA9, 
+0x62, 0xE3, 0xDD, 0xBD, 0x2D, 0x9C, 0x46, 0xE1, 
+0xAB, 0x9F, 0xB0, 0xBD, 0xE3, 0xE1, 0x7C, 0xB7, 
+0x55, 0xBF, 0x8E, 0xB5, 0x45, 0x88, 0xEC, 0xFD, 
+0xFE, 0x52, 0xFC, 0x51, 0xE7, 0x5B, 0x62, 0x7E, 
+0x39, 0x66, 0xAD, 0x6F, 0xE2, 0xEA, 0x99, 0xDB, 
+0xCB, 0x99, 0x7C, 0x14, 0x79, 0x19, 0xDF, 0x00, 
+0x71, 0xE1, 0x26, 0xC8, 0xFA, 0x22, 0x6E, 0xFD, 
+0xB7, 0x35, 0x57, 0xD5, 0xFC, 0x72, 0x4E, 0x84, 
+0x26, 0x69, 0x50, 0xD1, 0x5F, 0xA7, 0xE1, 0x93, 
+
+0x8D, 0x34, 0x0A, 0xCF, 0x32, 0x9C, 0xA6, 0x72, 
+0x28, 0x5D, 0xE3, 0xA3, 0x04, 0xAB, 0x6D, 0x3B, 
+0x9A, 0xC1, 0x30, 0x4F, 0x18, 0x37, 0xA3, 0x20, 
+0x5B, 0xE7, 0xC8, 0x5A, 0x0E, 0x63, 0x49, 0xD4, 
+0x90, 0x6D, 0xF0, 0xA3, 0xA3, 0xF9, 0xDF, 0xC0, 
+0x73, 0xE6, 0xBB, 0xE2, 0xC6, 0xBB, 0x02, 0x96, 
+0x69, 0x86, 0x65, 0xF1, 0x74, 0xB7, 0x22, 0xB4, 
+0x2C, 0x5B, 0xD4, 0x6D, 0x9C, 0xB8, 0xBB, 0x71, 
+0xB0, 0x71, 0x7F, 0xF8, 0xCE, 0xCE, 0xD3, 0x60, 
+0xC7, 0x40, 0x33, 0xCF, 0x7B, 0x27, 0xF9, 0x60, 
+0x82, 0x84, 0x51, 0xF9, 0x09, 0xE3, 0x61, 0xC0, 
+0x03, 0xF9, 0xDB, 0x84, 0xC7, 0xD5, 0xDE, 0xB8, 
+0x2A, 0x8F, 0x63, 0x47, 0x4B, 0x85, 0x66, 0xC1, 
+0xE8, 0xDF, 0x52, 0x2F, 0xBC, 0xD3, 0x9D, 0x7B, 
+0x32, 0xA6, 0x11, 0x5B, 0x1F, 0xB8, 0xC5, 0x94, 
+0xA5, 0x6C, 0xB3, 0x68, 0xA1, 0xB8, 0x43, 0xDA, 
+0x01, 0x79, 0xC8, 0xDF, 0xC1, 0x5F, 0x2E, 0xE7, 
+0x61, 0xFC, 0x87, 0x90, 0x64, 0x99, 0x6C, 0x13, 
+0x0F, 0xF9, 0x6E, 0xEE, 0x3E, 0xB3, 0x87, 0x00, 
+0x58, 0x98, 0x1C, 0x2C, 0xEA, 0x4A, 0x46, 0x31, 
+0xF3, 0x97, 0x65, 0xC1, 0x43, 0x57, 0x13, 0xC7, 
+0xB7, 0xFE, 0xC0, 0x59, 0xE9, 0x8E, 0x7B, 0xBF, 
+0x17, 0x87, 0x46, 0x21, 0xBD, 0x77, 0x30, 0xB5, 
+0xC1, 0xEF, 0xEE, 0x32, 0xC2, 0xC1, 0x4D, 0x88, 
+0xB0, 0xA2, 0xEB, 0xE1, 0xDF, 0xE1, 0x40, 0xA9, 
+0x17, 0x8A, 0x05, 0xDC, 0x29, 0x99, 0x4C, 0xC1, 
+0x29, 0xC7, 0x05, 0x42, 0x7A, 0x70, 0x1C, 0x9A, 
+0xB4, 0x9B, 0x7D, 0xFA, 0x79, 0x58, 0x90, 0x8F, 
+0x13, 0x39, 0xFC, 0x78, 0xF8, 0x4C, 0x54, 0x25, 
+0x01, 0x46, 0x8A, 0x01, 0x45, 0xFF, 0x44, 0x43, 
+0x27, 0x03, 0xA4, 0xD7, 0xD2, 0xAA, 0xC1, 0xC8, 
+0x39, 0x19, 0x16, 0x91, 0x65, 0xAF, 0xAE, 0x73, 
+0x5F, 
+
+0xFC, 0xA8, 0xE4, 0x40, 0x01, 0x91, 0xBB, 0x33, 
+0xF0, 0x85, 0x3F, 0xD1, 0xE9, 0x68, 0x59, 0xC9, 
+0x6C, 0x27, 0xBB, 0xE8, 0xCA, 0x1F, 0x16, 0x03, 
+0xB8, 0xF0, 0xC9, 0x12, 0xA4, 0xD9, 0xBC, 0x9F, 
+0x71, 0x9B, 0xDE, 0xF5, 0x92, 0x6C, 0xB8, 0xA0, 
+0x33, 0x7B, 0xBD, 0x62, 0x0B, 0xDA, 0x5A, 0x1C, 
+0x2C, 0x8D, 0x13, 0xDC, 0xF2, 0xFC, 0xB2, 0xC0, 
+0x19, 0xE0, 0x70, 0x57, 0x7B, 0x42, 0x5C, 0x6E, 
+0x68, 0xD6, 0x39, 0x04, 0x4D, 0x5A, 0xDC, 0xB1, 
+0x55, 0x74, 0x19, 0x06, 0xC3, 0xD3, 0x04, 0x75, 
+0xB6, 0x1C, 0x4E, 0x3F, 0x08, 0x82, 0x96, 0xD2, 
+0x37, 0x9E, 0xD3, 0x69, 0xCF, 0x23, 0xEF, 0x3A, 
+0xA4, 0x70, 0x10, 0xAE, 0x57, 0xC7, 0xD7, 0x17, 
+0x31, 0xCF, 0x2C, 0x95, 0xD0, 0x57, 0xB3, 0xC3, 
+0xC0, 0xC4, 0xE8, 0x92, 0x74, 0x21, 0x07, 0x69, 
+0xB6, 0xAA, 0xBD, 0x7E, 0x83, 0x2C, 0xC6, 0xBC, 
+0x36, 0x3B, 0xDD, 0x3B, 0x7F, 0xC3, 0xE9, 0xA5, 
+0xDD, 0xB1, 0x19, 0x23, 0x8D, 0x6F, 0x5A, 0x6E, 
+0xEE, 0x6B, 0x88, 0x50, 0x8D, 0x36, 0x06, 0x40, 
+0x76, 0x44, 0x5D, 0x89, 0x41, 0x79, 0x24, 0x31, 
+0x7A, 0x23, 0x36, 0x5C, 0xE9, 0xBD, 0x09, 0x53, 
+0x6E, 0x7E, 0xC1, 0xAF, 0x9D, 0x05, 0x2C, 0x1A, 
+0x15, 0x32, 0xE1, 0x20, 0xEF, 0x43, 0x8B, 0x27, 
+0x5A, 0x7F, 0x55, 0x3D, 0xA2, 0x83, 0x43, 0xAF, 
+0x29, 0xC7, 0xF7, 0xC8, 0x4D, 0x6F, 0x1C, 0xBF, 
+0xB2, 0x66, 0x48, 0x5C, 0xFD, 0xA3, 0xD5, 0x71, 
+0xDD, 0xC0, 0x5E, 0x9F, 0x99, 0x72, 0x78, 0x40, 
+0x61, 0x4D, 0x7E, 0x33, 0xD7, 0x06, 0x5F, 0x41, 
+0x85, 0xAA, 0x6A, 0x55, 0x27, 0x21, 0x80, 0xC4, 
+0x6B, 0xD8, 0xE8, 0x69, 0x91, 0x83, 0xF3, 0x62, 
+0x98, 0xB4, 0xF3, 0xCF, 0xB0, 0x00, 0x8E, 0x71, 
+0x50, 0xF6, 0x20, 0xEF, 0x69, 0x88, 0xAF, 0x5C, 
+0xF7, 0x85, 
+
+0x26, 0x79, 0x83, 0x1D, 0x83, 0x57, 0x06, 0xCD, 
+0x07, 0xCB, 0x21, 0x77, 0x14, 0x34, 0x34, 0xD4, 
+0x07, 0xBB, 0xFC, 0xBE, 0x8C, 0xDB, 0x21, 0x1A, 
+0xE6, 0x15, 0x8A, 0xFF, 0x4A, 0x45, 0x0B, 0x45, 
+0x2D, 0xBF, 0x5D, 0xA3, 0x2B, 0x08, 0x84, 0xC5, 
+0x3E, 0x68, 0xAF, 0x6E, 0x99, 0x50, 0x49, 0x49, 
+0x7A, 0x52, 0x1D, 0x02, 0xF8, 0x9A, 0x8C, 0xDF, 
+0xEA, 0x73, 0xBA, 0x47, 0xD3, 0x60, 0x8A, 0x84, 
+0x3A, 0xF6, 0x92, 0xAE, 0x00, 0xD3, 0x77, 0x07, 
+0x8F, 0xF2, 0xD5, 0xF8, 0x6C, 0xDB, 0x7D, 0x6F, 
+0x53, 0x04, 0xB2, 0x0E, 0x81, 0x32, 0x55, 0xBC, 
+0x73, 0x3F, 0x41, 0x7F, 0x87, 0x56, 0xF2, 0x69, 
+0xAD, 0x26, 0xB0, 0x54, 0xEF, 0xF7, 0xDF, 0x26, 
+0x99, 0x0E, 0xAA, 0x54, 0x65, 0x63, 0x96, 0x33, 
+0x1F, 0x12, 0x91, 0x25, 0x4A, 0x05, 0x10, 0xF8, 
+0xCA, 0x98, 0x78, 0x79, 0x2F, 0xA1, 0xAE, 0xF0, 
+0x83, 0x7E, 0xEB, 0xAF, 0x35, 0x0A, 0x0C, 0xC2, 
+0x7C, 0x3F, 0xE5, 0x21, 0x9E, 0x7C, 0x65, 0x4A, 
+0x8A, 0x20, 0xEA, 0x68, 0x4B, 0x62, 0x39, 0x74, 
+0x7B, 0x48, 0xBC, 0x62, 0xF0, 0xB0, 0x5B, 0x86, 
+0x07, 0x8A, 0x9C, 0xED, 0x18, 0x0A, 0xF8, 0x63, 
+0x4E, 0x3D, 0xF9, 0x8C, 0xF1, 0xC6, 0x56, 0xA4, 
+0xC5, 0x6E, 0xCC, 0x76, 0x5D, 0x03, 0x29, 0x2C, 
+0xD1, 0x17, 0xE9, 0x84, 0xB5, 0x41, 0x18, 0xDD, 
+0x4E, 0x7C, 0x4B, 0xA0, 0x97, 0xE3, 0x99, 0x3E, 
+0xAB, 0x90, 0x52, 0x26, 0x7D, 0x26, 0x2A, 0x02, 
+0x09, 0x70, 0xC2, 0x08, 0x05, 0x12, 0x5B, 0xD7, 
+0xEF, 0x69, 0xDE, 0x12, 0xAE, 0xA2, 0x10, 0xB5, 
+0xD9, 0xCE, 0x99, 0xC3, 0x1C, 0x85, 0x20, 0xA7, 
+0xBA, 0xB3, 0xC4, 0x4C, 0xC5, 0x35, 0xE0, 0xBA, 
+0xCB, 0x0F, 0xD9, 0x30, 0x29, 0xBD, 0x79, 0xCB, 
+0xC5, 0x9A, 0xFD, 0x74, 0x33, 0x27, 0x1D, 0xAE, 
+0xF7, 0xF9, 0x85, 
+
+0xDB, 0x03, 0xD0, 0xB9, 0x55, 0x8D, 0x8C, 0x01, 
+0x06, 0x67, 0x63, 0x37, 0x16, 0x63, 0x86, 0x3E, 
+0xA7, 0xDA, 0x8D, 0x87, 0x1F, 0xAB, 0x45, 0xAF, 
+0x06, 0x12, 0x3A, 0x33, 0xD1, 0x64, 0x88, 0x08, 
+0xD9, 0x3A, 0xF1, 0x8A, 0x14, 0x72, 0xD8, 0x94, 
+0x88, 0x9B, 0x20, 0xDD, 0x84, 0x05, 0x31, 0xDC, 
+0xD0, 0x8D, 0x0F, 0x30, 0x49, 0x16, 0x76, 0x21, 
+0xC8, 0x9E, 0x55, 0x08, 0x7D, 0x45, 0x53, 0x21, 
+0x18, 0x44, 0x4D, 0xD3, 0x29, 0x07, 0xB8, 0xAF, 
+0xF4, 0x37, 0x13, 0x20, 0xC7, 0x47, 0xC1, 0xDB, 
+0x0E, 0xBB, 0x60, 0x90, 0x88, 0x82, 0x0E, 0x1D, 
+0x1F, 0xBE, 0xB2, 0x4F, 0x8C, 0x27, 0x31, 0x6B, 
+0xEF, 0x77, 0x0E, 0xB6, 0x62, 0xE7, 0xB6, 0x08, 
+0xA7, 0x04, 0xC5, 0x31, 0x7E, 0x98, 0xC2, 0xA5, 
+0xB8, 0xB7, 0xAB, 0xE3, 0xFC, 0xF5, 0x42, 0x2B, 
+0x52, 0xCC, 0x22, 0x9B, 0x80, 0x96, 0xF3, 0x10, 
+0xA7, 0xC0, 0x8F, 0xDF, 0xC1, 0x49, 0xA1, 0xDE, 
+0x72, 0x0E, 0x67, 0xAA, 0x83, 0x91, 0x0C, 0x19, 
+0x23, 0xE5, 0xDE, 0x0E, 0x17, 0x4E, 0x0F, 0x7E, 
+0xE4, 0xF2, 0x82, 0xCE, 0xC2, 0xE7, 0x7A, 0xF5, 
+0x82, 0x0F, 0xCD, 0x27, 0x5F, 0xC7, 0x60, 0x48, 
+0x77, 0xF5, 0x1B, 0xC2, 0xA2, 0x6B, 0x91, 0xB1, 
+0x8A, 0x36, 0xA4, 0x9E, 0x01, 0xC3, 0x74, 0xB7, 
+0x2E, 0x94, 0x73, 0x52, 0xEA, 0xEF, 0x17, 0xD2, 
+0xFD, 0xE9, 0x05, 0x42, 0x68, 0xD1, 0xD1, 0x99, 
+0x06, 0x6E, 0x23, 0xC8, 0x11, 0xBB, 0x63, 0x8A, 
+0x46, 0x42, 0xCC, 0xC7, 0x69, 0x4C, 0x76, 0x48, 
+0x87, 0x21, 0xDF, 0xE7, 0x71, 0xF3, 0x02, 0x09, 
+0x1D, 0xBC, 0x05, 0x05, 0x92, 0xDA, 0xF9, 0xED, 
+0xE5, 0x97, 0x4D, 0xBA, 0x37, 0x39, 0x34, 0x2D, 
+0xC6, 0xFE, 0x22, 0x0F, 0xA7, 0x70, 0x22, 0xEA, 
+0x5B, 0x05, 0xB8, 0x37, 0xC2, 0x8F, 0x9C, 0x63, 
+0x4A, 0x76, 0xBA, 0x03, 
+
+0x86, 0xC7, 0x76, 0xE8, 0x37, 0x08, 0xD8, 0x26, 
+0xED, 0xB8, 0x2C, 0x22, 0xFB, 0x96, 0xE4, 0x2C, 
+0x3A, 0xB5, 0x54, 0x2C, 0x36, 0x70, 0xDF, 0x52, 
+0x9E, 0x00, 0x8B, 0x98, 0x97, 0x64, 0x21, 0xCE, 
+0xB9, 0x17, 0x1C, 0x3E, 0x0D, 0x09, 0x5E, 0xD8, 
+0x2D, 0x2B, 0x1F, 0xE0, 0x7A, 0x32, 0xD7, 0x8D, 
+0xD0, 0x75, 0x20, 0xF9, 0x5D, 0x02, 0x7E, 0xD1, 
+0xA6, 0xAF, 0x34, 0xED, 0x26, 0xA6, 0x75, 0x39, 
+0x9A, 0x04, 0x9B, 0x56, 0x8B, 0xE2, 0xAE, 0x70, 
+0xAE, 0x4F, 0x1C, 0x9E, 0x01, 0x81, 0x48, 0x09, 
+0x7C, 0x3F, 0x82, 0x63, 0x51, 0xE5, 0xFC, 0x6A, 
+0x7C, 0x9F, 0x44, 0x48, 0xC9, 0x87, 0x8C, 0x80, 
+0x76, 0xA5, 0x00, 0x0E, 0x5C, 0xE6, 0xA3, 0xC6, 
+0xED, 0x07, 0xA9, 0x39, 0xAB, 0x18, 0xEB, 0xAC, 
+0xE1, 0x9D, 0xBC, 0xC2, 0xB1, 0xD4, 0x0A, 0x05, 
+0x86, 0x68, 0x38, 0x6E, 0xF6, 0x75, 0xD7, 0x60, 
+0x53, 0x28, 0x44, 0x98, 0x02, 0x4C, 0xBF, 0x1D, 
+0x85, 0xD0, 0xC2, 0xEB, 0x94, 0x92, 0x06, 0x7C, 
+0x70, 0xF5, 0x6A, 0xD6, 0xBE, 0xA6, 0x5D, 0x2E, 
+0x14, 0xC0, 0xEA, 0xE5, 0xE4, 0x0D, 0x78, 0xE0, 
+0x80, 0xB5, 0xE3, 0xA5, 0x19, 0x0A, 0x8E, 0x82, 
+0x10, 0x1F, 0x3D, 0x2B, 0xC1, 0x55, 0x8D, 0x23, 
+0x0C, 0xB5, 0xF0, 0xD1, 0x25, 0x5F, 0x2F, 0x48, 
+0x84, 0x78, 0x7D, 0x11, 0xEF, 0x2D, 0xC1, 0xFE, 
+0xDA, 0xD0, 0xA8, 0x10, 0xD9, 0x0D, 0x50, 0x6E, 
+0x06, 0x22, 0xE0, 0x44, 0x79, 0x6F, 0x3C, 0xFD, 
+0xEF, 0xBF, 0x83, 0x3F, 0x35, 0x3A, 0xC7, 0x14, 
+0x71, 0x2C, 0x3B, 0xCB, 0x45, 0xB4, 0x3D, 0xA9, 
+0x14, 0xD1, 0x2D, 0x9E, 0x5C, 0xA0, 0xE8, 0x08, 
+0x64, 0xE2, 0xDB, 0x57, 0xBE, 0xAE, 0xEB, 0xE3, 
+0x73, 0x5D, 0x5C, 0xE0, 0xF6, 0xF3, 0x47, 0x3E, 
+0xB7, 0x45, 0x56, 0xAB, 0xB1, 0x27, 0x93, 0xBF, 
+0x2B, 0xE8, 0xD7, 0x0C, 0x06, 
+
+0x99, 0x7E, 0x6B, 0xCB, 0x26, 0x72, 0xF8, 0x3A, 
+0xB6, 0xD6, 0x6C, 0xE8, 0xCF, 0xEA, 0xA8, 0xC3, 
+0x41, 0x5E, 0x62, 0x0A, 0x34, 0x45, 0x5B, 0x58, 
+0x73, 0x7C, 0x36, 0x82, 0x37, 0x79, 0x5C, 0xF8, 
+0x10, 0xDE, 0x95, 0xBD, 0x3C, 0xC5, 0x76, 0x6E, 
+0x8E, 0x8D, 0xF2, 0xF7, 0x80, 0xB1, 0x29, 0xEC, 
+0x47, 0x7B, 0x5A, 0xEF, 0xAD, 0x48, 0xAB, 0x1E, 
+0x9B, 0x04, 0x89, 0xB1, 0x34, 0x1C, 0xB3, 0x15, 
+0x15, 0x0F, 0xBC, 0x80, 0x52, 0xF7, 0xCF, 0xB6, 
+0xA8, 0x0D, 0x0C, 0xFA, 0xD3, 0xCD, 0x31, 0x9C, 
+0x31, 0x54, 0xAB, 0xDF, 0x67, 0xC9, 0x75, 0xE6, 
+0x38, 0x4A, 0xE1, 0x95, 0x02, 0x90, 0xF2, 0x40, 
+0xA1, 0xE1, 0x9E, 0x7B, 0xC7, 0x66, 0xEF, 0x33, 
+0x6A, 0x67, 0x2E, 0xC1, 0x59, 0xDE, 0x15, 0xE7, 
+0xA7, 0xC5, 0x32, 0xD3, 0x4F, 0xA4, 0xCF, 0x09, 
+0x96, 0xC0, 0xE5, 0x84, 0x77, 0x9E, 0x08, 0x73, 
+0x83, 0xFE, 0x73, 0x2F, 0xB4, 0x03, 0x88, 0xAF, 
+0x73, 0x47, 0xB1, 0xBE, 0x76, 0xF3, 0x01, 0xCD, 
+0x9B, 0xDA, 0x08, 0x0B, 0x20, 0x00, 0x4A, 0x54, 
+0xA8, 0xD5, 0x99, 0xC7, 0xCD, 0x46, 0x0A, 0x3F, 
+0xDE, 0x27, 0x2F, 0xDD, 0xE9, 0x58, 0xA2, 0x7D, 
+0xF9, 0x59, 0xC2, 0xA8, 0x25, 0x8B, 0x20, 0x32, 
+0xF5, 0x09, 0x2C, 0x59, 0xBA, 0xAE, 0x76, 0x3C, 
+0x9F, 0x59, 0x22, 0xD6, 0x6E, 0x6D, 0xAE, 0x76, 
+0x85, 0x84, 0xDB, 0xCA, 0x1D, 0xEF, 0xCF, 0xA5, 
+0x39, 0x74, 0x49, 0xE2, 0x9F, 0x4A, 0x6E, 0x2B, 
+0x2E, 0x4F, 0x1B, 0x1A, 0x90, 0x5F, 0xA8, 0x35, 
+0xBC, 0x4D, 0xEC, 0x06, 0x17, 0x96, 0x08, 0xD1, 
+0x8B, 0x28, 0x8E, 0x3A, 0x8B, 0x05, 0x2A, 0x34, 
+0xA2, 0xEF, 0xBA, 0x51, 0xEE, 0x42, 0xA5, 0xC5, 
+0x35, 0xFA, 0xDA, 0x75, 0xFF, 0x53, 0x7A, 0x3F, 
+0x53, 0xED, 0x06, 0x0C, 0xBF, 0xAC, 0x4F, 0xA5, 
+0x43, 0xAB, 0x2B, 0x2F, 0xD1, 0x2C, 
+
+0x40, 0xD7, 0xCB, 0xD4, 0xB8, 0xB4, 0x5F, 0x6B, 
+0xDC, 0x5D, 0x96, 0xB9, 0xC7, 0x02, 0xF4, 0x49, 
+0xF0, 0xED, 0xB2, 0x55, 0xE4, 0x09, 0xC0, 0x33, 
+0x95, 0x54, 0xDF, 0xF9, 0xD5, 0x30, 0x0E, 0x8A, 
+0xBB, 0xDF, 0xEB, 0xFF, 0x27, 0x02, 0x1C, 0xA5, 
+0x43, 0x84, 0xB4, 0x46, 0x47, 0x0F, 0x8C, 0x81, 
+0x63, 0x80, 0x6A, 0x84, 0xB4, 0xDD, 0xBC, 0x3E, 
+0x5D, 0x7E, 0x95, 0x71, 0x06, 0xCA, 0x61, 0x97, 
+0xDA, 0x80, 0x52, 0x8B, 0xEF, 0x57, 0xCF, 0xFF, 
+0x39, 0x9C, 0x2C, 0xE2, 0x64, 0xDA, 0xEE, 0xF6, 
+0x1F, 0xEC, 0xA0, 0x68, 0xBF, 0x4A, 0x30, 0x3B, 
+0x74, 0xCF, 0x42, 0x2B, 0x0B, 0x84, 0x68, 0x17, 
+0x37, 0xFD, 0x05, 0x8E, 0xD0, 0xE6, 0x91, 0x30, 
+0xD3, 0xE5, 0xE7, 0xEE, 0xFD, 0x19, 0x50, 0xF0, 
+0xCD, 0x13, 0xD3, 0x3F, 0x35, 0x44, 0x12, 0x38, 
+0xA2, 0xE8, 0x47, 0xD0, 0xF2, 0xD4, 0x43, 0x46, 
+0xD3, 0xF1, 0x7D, 0x6C, 0xE2, 0x6B, 0x69, 0x8A, 
+0x35, 0xA9, 0x80, 0xA3, 0x27, 0x8F, 0x23, 0x46, 
+0xC8, 0x42, 0x4A, 0x16, 0x89, 0xD3, 0x1B, 0x29, 
+0x30, 0xB1, 0x63, 0x34, 0x2D, 0xCF, 0x72, 0x19, 
+0x66, 0x69, 0x24, 0xB2, 0x7D, 0x9B, 0x45, 0xB4, 
+0xFB, 0x9F, 0x5D, 0x28, 0x79, 0x00, 0x2D, 0x93, 
+0x47, 0x6C, 0xF4, 0x9D, 0x45, 0x59, 0x60, 0x25, 
+0xC4, 0x7A, 0x6E, 0x67, 0x39, 0xCA, 0xF7, 0x6E, 
+0x64, 0x31, 0x1E, 0xFE, 0x27, 0x37, 0x78, 0x28, 
+0x44, 0x7A, 0x61, 0xA3, 0x59, 0x31, 0xEF, 0x2B, 
+0x94, 0xC3, 0x18, 0xEC, 0x03, 0xFD, 0xD7, 0x30, 
+0x01, 0x7D, 0x28, 0x9A, 0xB6, 0x1C, 0x31, 0x51, 
+0x66, 0xCE, 0x9E, 0x53, 0xE9, 0x6F, 0x4C, 0xD7, 
+0xE0, 0x23, 0x2A, 0xB4, 0xEB, 0xD9, 0x09, 0x06, 
+0x20, 0x68, 0xC9, 0x28, 0xC4, 0xDE, 0xD0, 0x40, 
+0xD4, 0x60, 0x09, 0x6A, 0x38, 0x83, 0xBC, 0xB1, 
+0xD5, 0x49, 0x29, 0x05, 0xD6, 0x2B, 0x70, 
+
+0x14, 0x01, 0xDB, 0x36, 0x56, 0xDC, 0xB5, 0x5B, 
+0x83, 0xCE, 0xAE, 0x7B, 0x08, 0x4D, 0xAF, 0x28, 
+0x27, 0x10, 0xE4, 0x9F, 0x1E, 0x0A, 0x55, 0xE8, 
+0x26, 0x14, 0x23, 0xEB, 0x83, 0xEA, 0x15, 0xA6, 
+0x77, 0x52, 0x71, 0xC5, 0x4C, 0x72, 0xA7, 0xC5, 
+0xBA, 0xD0, 0x8C, 0x2D, 0x2A, 0x9F, 0xCC, 0x07, 
+0x28, 0x33, 0x17, 0x88, 0xD3, 0x18, 0x76, 0x42, 
+0xB6, 0xA6, 0x0B, 0xF2, 0xA6, 0x3F, 0x19, 0x9F, 
+0xE4, 0xEB, 0xC1, 0x61, 0x0E, 0xD3, 0xD5, 0x03, 
+0x45, 0xAF, 0xFE, 0x09, 0x89, 0x3A, 0xD3, 0xB8, 
+0x94, 0x86, 0x98, 0x2B, 0x67, 0x12, 0x4A, 0x6E, 
+0x5E, 0xFD, 0xEE, 0x5D, 0x27, 0x14, 0x99, 0xD1, 
+0x3E, 0x5B, 0xE9, 0xCA, 0xA9, 0xEE, 0x69, 0x7B, 
+0x55, 0x4B, 0xF8, 0xEC, 0x2F, 0x5C, 0x1C, 0xE9, 
+0xE1, 0x9E, 0x17, 0x7E, 0x5B, 0xB4, 0xFC, 0x91, 
+0xB7, 0xD0, 0x5C, 0x0D, 0x71, 0xF4, 0xF7, 0x9C, 
+0x30, 0x10, 0xE3, 0xE9, 0x61, 0xDA, 0xE4, 0x06, 
+0xCF, 0x2A, 0x2D, 0x99, 0xB3, 0x1E, 0xC5, 0x1D, 
+0x47, 0xA1, 0x23, 0x37, 0x1A, 0xD2, 0x08, 0x16, 
+0xE5, 0x0F, 0x7D, 0x55, 0x3D, 0xA4, 0xC8, 0xCD, 
+0x11, 0xD9, 0x86, 0x2E, 0x37, 0xFC, 0x20, 0x52, 
+0x91, 0x04, 0x84, 0x94, 0xF6, 0xDD, 0x5B, 0x2E, 
+0x01, 0xB1, 0xFD, 0x83, 0xFF, 0x41, 0x74, 0xA1, 
+0x40, 0x8A, 0xA5, 0xA6, 0xB7, 0x56, 0xFA, 0x42, 
+0x2B, 0x02, 0x1E, 0xFC, 0x4D, 0x29, 0x43, 0x31, 
+0x70, 0xCD, 0xB6, 0xA3, 0xA0, 0x04, 0xFD, 0x45, 
+0xE5, 0x91, 0x2D, 0xE1, 0xA8, 0xED, 0xF7, 0xDD, 
+0x25, 0x54, 0x57, 0x3F, 0x3A, 0x46, 0x76, 0x5B, 
+0xDC, 0x46, 0xF9, 0x4F, 0x75, 0x6A, 0xBC, 0x4C, 
+0x6F, 0xA6, 0x37, 0xC7, 0x11, 0x24, 0x57, 0x30, 
+0x87, 0x75, 0x22, 0x31, 0x36, 0x27, 0x03, 0x78, 
+0x39, 0x90, 0x1E, 0x5E, 0xCF, 0x88, 0x13, 0x9B, 
+0xB7, 0x0B, 0x0E, 0x18, 0x5D, 0x31, 0x68, 0xD9, 
+
+0xE8, 0xA8, 0x0C, 0x9E, 0xE7, 0xF0, 0x38, 0xED, 
+0x7F, 0xD0, 0x9D, 0xC7, 0xBC, 0xBA, 0x3F, 0x9F, 
+0x34, 0x12, 0xCF, 0xF4, 0x4C, 0x30, 0xD3, 0x92, 
+0x0D, 0x5B, 0xBA, 0xAC, 0x3A, 0xEE, 0xC2, 0x16, 
+0xFC, 0x21, 0x72, 0xD3, 0x69, 0xBB, 0x4F, 0x29, 
+0x06, 0x24, 0xBD, 0x53, 0xD1, 0x52, 0x89, 0xD3, 
+0xE4, 0x83, 0x60, 0x2F, 0x35, 0x03, 0x3E, 0xFA, 
+0xE4, 0xC3, 0x36, 0xAC, 0xAC, 0x77, 0xE8, 0x42, 
+0x51, 0x5B, 0x54, 0x41, 0x17, 0xAE, 0x84, 0x0F, 
+0xF7, 0x67, 0x12, 0x7D, 0x59, 0x34, 0xCB, 0x83, 
+0x71, 0x00, 0xC9, 0x72, 0xF4, 0x38, 0x8E, 0x48, 
+0x3A, 0x06, 0x57, 0x6D, 0xC0, 0x8B, 0xAE, 0x35, 
+0xA9, 0x15, 0xF6, 0x89, 0xEC, 0x32, 0x7D, 0x9A, 
+0x75, 0xB7, 0x31, 0xF3, 0x01, 0x09, 0x40, 0xFA, 
+0x10, 0xE5, 0xDE, 0xF7, 0xA4, 0x60, 0x04, 0x00, 
+0x11, 0x2A, 0xD7, 0x49, 0xD9, 0x14, 0x48, 0x32, 
+0x0A, 0x28, 0xB7, 0x6B, 0x36, 0x25, 0x94, 0x91, 
+0xD2, 0xBC, 0x4B, 0xC0, 0x24, 0xE0, 0x16, 0x43, 
+0x78, 0xB6, 0x1D, 0x9D, 0xDC, 0xB4, 0xB7, 0xF1, 
+0x81, 0xCB, 0x6C, 0x38, 0x5A, 0x93, 0x94, 0x2F, 
+0xA1, 0x99, 0x3E, 0xF6, 0x23, 0xFF, 0x02, 0xEF, 
+0x69, 0xA4, 0xBA, 0x31, 0x31, 0x4F, 0x69, 0xB6, 
+0x3F, 0x15, 0xFB, 0xB8, 0x3A, 0x79, 0x43, 0x6D, 
+0xA8, 0x2F, 0xB9, 0xAC, 0xCD, 0x1B, 0xE5, 0x03, 
+0xC3, 0x1F, 0x4F, 0x07, 0x55, 0x52, 0x6F, 0x38, 
+0x87, 0x26, 0xD0, 0x29, 0x96, 0xC6, 0x0D, 0x52, 
+0xAF, 0xCD, 0xC4, 0x3C, 0x31, 0xDB, 0x80, 0xE3, 
+0xCF, 0x5E, 0xBD, 0x8D, 0x78, 0x91, 0x17, 0x33, 
+0x01, 0x8B, 0xB9, 0xC0, 0x0F, 0xE0, 0x2D, 0xC4, 
+0x95, 0xA6, 0xC6, 0xA1, 0x44, 0xBC, 0x08, 0x03, 
+0x22, 0x9F, 0xDA, 0x0F, 0xAB, 0xE9, 0x8B, 0x21, 
+0xD6, 0xAA, 0x09, 0x99, 0x17, 0x24, 0x44, 0x18, 
+0x05, 0x26, 0xFB, 0x22, 0x97, 0x26, 0xA2, 0x71, 
+0xB6, 
+
+0x2D, 0x49, 0xF9, 0x7D, 0x95, 0xD0, 0x2D, 0xF1, 
+0xE9, 0x19, 0xE1, 0x3D, 0xB7, 0x7B, 0xFE, 0x9C, 
+0xB1, 0xC8, 0x9E, 0x55, 0x9A, 0x55, 0xF9, 0xFA, 
+0xA8, 0xAD, 0x6C, 0xDB, 0xAA, 0x73, 0x89, 0x43, 
+0xCF, 0x5E, 0x57, 0x62, 0x2A, 0xCB, 0x70, 0x35, 
+0x7B, 0x8E, 0xF4, 0x30, 0xF5, 0x7D, 0x25, 0x06, 
+0x57, 0x9E, 0x4E, 0x68, 0xB1, 0x4A, 0x67, 0xAB, 
+0x28, 0x1F, 0xDC, 0xCC, 0xA5, 0x40, 0xC3, 0x73, 
+0xD0, 0xB8, 0x72, 0x57, 0x40, 0x36, 0x24, 0x34, 
+0x28, 0x51, 0xCD, 0xB9, 0x02, 0xE9, 0xA9, 0x34, 
+0xBE, 0x15, 0x24, 0x54, 0x2E, 0x7B, 0xD4, 0x04, 
+0xA7, 0xFD, 0xC1, 0x2C, 0x96, 0xFF, 0xBF, 0xC1, 
+0x9B, 0x0F, 0x5D, 0x6B, 0x9B, 0x3D, 0x46, 0x9A, 
+0x83, 0x1F, 0x1C, 0x62, 0xD1, 0x52, 0x77, 0xD8, 
+0x18, 0x63, 0x4F, 0x7E, 0x68, 0x59, 0x03, 0xFA, 
+0x63, 0x05, 0x9F, 0x10, 0xC7, 0xEE, 0x69, 0x0C, 
+0xB8, 0xCF, 0x5C, 0xAC, 0xE6, 0xF5, 0x47, 0x15, 
+0x4C, 0x5A, 0x35, 0xCF, 0x4F, 0x4E, 0x85, 0xB0, 
+0x10, 0xB3, 0x86, 0xCE, 0x07, 0xFD, 0x7B, 0x7E, 
+0x98, 0xD2, 0xD5, 0xD5, 0x8D, 0xFC, 0x31, 0x30, 
+0x06, 0x10, 0xA7, 0xBF, 0x21, 0xBC, 0xBB, 0x23, 
+0x86, 0x26, 0x55, 0x8B, 0x66, 0xB5, 0x24, 0xEC, 
+0x9A, 0x97, 0xB0, 0x9D, 0x73, 0xA2, 0x63, 0x5F, 
+0x8B, 0xE3, 0xE5, 0x3C, 0xE4, 0xDB, 0x57, 0x0F, 
+0x99, 0xAF, 0xC2, 0xB9, 0xA3, 0x7A, 0x8E, 0x9B, 
+0x43, 0xF4, 0xE1, 0x7D, 0x69, 0xD0, 0xAE, 0x9E, 
+0x98, 0x17, 0x39, 0x40, 0xDD, 0x8F, 0x92, 0xB9, 
+0xEA, 0xF1, 0xBB, 0x45, 0x29, 0xB1, 0xA1, 0x13, 
+0xD7, 0x60, 0xDF, 0x77, 0xAA, 0xBA, 0xC5, 0x74, 
+0x41, 0xF2, 0x8B, 0x59, 0x85, 0xB3, 0xDB, 0x4D, 
+0x05, 0xCD, 0x91, 0xD0, 0xC2, 0x6F, 0x17, 0x90, 
+0x35, 0xDC, 0x23, 0x9A, 0xAB, 0x56, 0x7F, 0x51, 
+0x07, 0x43, 0x11, 0xA9, 0x2F, 0x64, 0xCA, 0xCC, 
+0x98, 0x12, 
+
+0xFF, 0x42, 0x4F, 0x93, 0xE9, 0xD9, 0x31, 0xAC, 
+0xF0, 0x74, 0x37, 0x22, 0xE9, 0xE9, 0x15, 0x5C, 
+0x3D, 0xE7, 0x33, 0x9B, 0x7E, 0x37, 0x2F, 0xF0, 
+0xCD, 0x2F, 0xDE, 0xF1, 0xB5, 0xEE, 0x49, 0x76, 
+0xF1, 0x9C, 0xCA, 0x0D, 0x57, 0x51, 0x7E, 0xDF, 
+0x87, 0xDF, 0xC7, 0x22, 0x6E, 0xF3, 0x1E, 0xD7, 
+0xD4, 0x04, 0xE2, 0x78, 0xB8, 0x61, 0x84, 0xE5, 
+0x80, 0xBD, 0x4B, 0x6D, 0xA9, 0x51, 0xAF, 0x87, 
+0xF1, 0xB3, 0xCA, 0xB2, 0x71, 0x3B, 0x5B, 0x5D, 
+0xF7, 0x86, 0x79, 0x69, 0x38, 0xF7, 0xA4, 0x7F, 
+0xCE, 0x35, 0xAA, 0x74, 0x8E, 0xFC, 0x3D, 0xC0, 
+0x31, 0x49, 0x45, 0x91, 0x39, 0xDA, 0x5F, 0x45, 
+0x59, 0x62, 0x81, 0x19, 0x4E, 0xF1, 0xE2, 0x0E, 
+0x92, 0xE4, 0xA9, 0x52, 0x4F, 0xBF, 0x44, 0x6D, 
+0x67, 0xBC, 0xFC, 0x77, 0x5D, 0x3A, 0x75, 0x8A, 
+0x7F, 0xB4, 0x1C, 0xFB, 0x63, 0x38, 0x3E, 0x34, 
+0x63, 0xEA, 0x19, 0x7C, 0x77, 0xA8, 0x98, 0x87, 
+0x21, 0xDD, 0xD9, 0x58, 0x3C, 0x00, 0xB4, 0x65, 
+0x98, 0x6A, 0xF6, 0x5C, 0x2F, 0x58, 0x7D, 0x90, 
+0x14, 0x17, 0xA4, 0x60, 0x78, 0x24, 0xDE, 0x42, 
+0x01, 0x79, 0xDF, 0x03, 0xB8, 0x10, 0xAE, 0x55, 
+0xC5, 0x47, 0xFA, 0x93, 0xB2, 0x7C, 0x6C, 0x88, 
+0xDF, 0xE7, 0xB7, 0x55, 0xAF, 0x6D, 0xED, 0xC2, 
+0xB9, 0xFF, 0x72, 0xA1, 0x20, 0x76, 0x01, 0xD8, 
+0x24, 0x64, 0xA6, 0xAB, 0x53, 0xED, 0x50, 0x94, 
+0xA1, 0x11, 0x49, 0x27, 0x08, 0x7F, 0x86, 0xA5, 
+0xAB, 0xE2, 0xD4, 0x4B, 0x95, 0x1B, 0x94, 0x55, 
+0xCC, 0xD3, 0x0A, 0xB0, 0xF5, 0xE6, 0x85, 0xE4, 
+0x12, 0x0F, 0x16, 0xEE, 0xAE, 0x91, 0x1A, 0x07, 
+0x85, 0x30, 0xD7, 0x60, 0xE5, 0x6F, 0x7F, 0x25, 
+0xAD, 0x59, 0x83, 0xC0, 0x61, 0x3F, 0xC2, 0x07, 
+0xEF, 0xB5, 0xE9, 0xE0, 0x0F, 0x08, 0x2D, 0x3C, 
+0x9D, 0x3F, 0x57, 0xA6, 0x27, 0x19, 0x6C, 0x61, 
+0x20, 0xA7, 0xE1, 
+
+0x0F, 0x1B, 0xE0, 0x90, 0x99, 0xED, 0xCA, 0x70, 
+0x73, 0x32, 0xCA, 0xB3, 0x6A, 0xF4, 0x95, 0xED, 
+0xA6, 0xEC, 0x2C, 0x58, 0xCA, 0xD0, 0x26, 0x47, 
+0x99, 0x71, 0xB4, 0x83, 0x83, 0x49, 0x63, 0xE0, 
+0xAC, 0xAB, 0x2D, 0x41, 0x6E, 0x4B, 0x40, 0x28, 
+0x95, 0xEA, 0xEA, 0x83, 0x22, 0x21, 0x46, 0xF3, 
+0xC7, 0x15, 0xB3, 0x3C, 0xBC, 0x25, 0x99, 0x4F, 
+0x04, 0x74, 0x20, 0xF2, 0x94, 0xE2, 0xFC, 0x61, 
+0xA6, 0xCF, 0x4B, 0x37, 0xD5, 0x2D, 0xFB, 0xAC, 
+0x16, 0x1C, 0x75, 0xD0, 0x79, 0xA2, 0x2D, 0x20, 
+0x0E, 0x6C, 0x8B, 0xCC, 0x49, 0x5E, 0x75, 0x40, 
+0x6F, 0x5D, 0x18, 0xF2, 0x98, 0x84, 0xAD, 0xEE, 
+0x0D, 0x03, 0xC1, 0x58, 0x8D, 0x00, 0x82, 0x3C, 
+0xFE, 0x64, 0xF1, 0x8B, 0xBC, 0x3B, 0x41, 0xF2, 
+0xA1, 0xD2, 0x45, 0x88, 0x6F, 0xAE, 0xCE, 0xE4, 
+0x3A, 0x33, 0xCC, 0x5C, 0x6F, 0x2D, 0x3E, 0x48, 
+0x59, 0x1F, 0x53, 0x44, 0x11, 0xD3, 0x53, 0xD9, 
+0x69, 0x10, 0x40, 0xBA, 0x23, 0x3D, 0x53, 0x61, 
+0x89, 0x88, 0x45, 0x04, 0x99, 0x7A, 0xD0, 0xDC, 
+0xE6, 0xCD, 0x32, 0x9C, 0x2B, 0x7D, 0x37, 0x82, 
+0xD9, 0x64, 0xD4, 0x05, 0x4E, 0xF4, 0xCA, 0x13, 
+0xB2, 0x02, 0xC6, 0x56, 0xF4, 0x83, 0xF3, 0xBD, 
+0x7C, 0x53, 0x3C, 0x54, 0x0D, 0x2C, 0x1A, 0x5F, 
+0x12, 0xDC, 0x4B, 0xE3, 0x46, 0xE0, 0x9B, 0x80, 
+0xB4, 0x36, 0x73, 0xB2, 0x2A, 0xDF, 0x0C, 0x2E, 
+0x49, 0xCC, 0x3E, 0x66, 0xBB, 0x00, 0x2E, 0x36, 
+0xCA, 0xC3, 0x7B, 0x1A, 0x0D, 0x24, 0x95, 0xAB, 
+0xD3, 0xF6, 0xB1, 0x1C, 0x65, 0x24, 0xB8, 0x4A, 
+0x60, 0x97, 0x42, 0x9E, 0xFC, 0x94, 0x2A, 0x73, 
+0xE3, 0xEE, 0x8F, 0xDA, 0x20, 0x21, 0x03, 0x45, 
+0x5D, 0x06, 0x38, 0x54, 0xBE, 0x2D, 0xA1, 0xC0, 
+0x97, 0x20, 0xC0, 0xD9, 0x68, 0xE0, 0x09, 0x1F, 
+0xC3, 0xC9, 0x75, 0xFA, 0xEE, 0x45, 0xE7, 0x6E, 
+0xD2, 0xE5, 0x14, 0x48, 
+
+0xF6, 0x46, 0x0E, 0x28, 0xDE, 0xB9, 0x0B, 0x95, 
+0x4E, 0xCE, 0xE2, 0x1D, 0xB0, 0xAC, 0x55, 0xCF, 
+0x43, 0xAF, 0xEC, 0xEF, 0x6E, 0xA0, 0x8B, 0x65, 
+0x35, 0xA6, 0x6D, 0x21, 0xAB, 0x52, 0xC3, 0xCC, 
+0xE0, 0xED, 0x0A, 0x7E, 0x64, 0x18, 0xAB, 0x78, 
+0xB2, 0xCD, 0xBD, 0x69, 0x23, 0x8C, 0x80, 0xE1, 
+0xA7, 0xC4, 0x2E, 0x81, 0x26, 0x4E, 0x25, 0x20, 
+0xBD, 0xB6, 0x72, 0x2E, 0xC2, 0x02, 0xB9, 0x96, 
+0x55, 0xBF, 0x04, 0xB6, 0x56, 0x05, 0x71, 0xA7, 
+0xA5, 0x89, 0xA5, 0x3E, 0xDB, 0xA4, 0xF5, 0x17, 
+0x95, 0xE6, 0x5A, 0x6C, 0x81, 0xF0, 0x14, 0x16, 
+0x87, 0xF3, 0x53, 0x0C, 0xB7, 0xB6, 0x25, 0x58, 
+0x7B, 0xBD, 0xB5, 0xE4, 0x48, 0x42, 0x3D, 0xDC, 
+0x7C, 0x17, 0xD3, 0x01, 0x07, 0x60, 0x27, 0x92, 
+0x7D, 0xB3, 0x3F, 0x21, 0xDC, 0xCC, 0x79, 0xA4, 
+0x21, 0x9B, 0x54, 0x8B, 0x31, 0x9C, 0x15, 0x79, 
+0x2B, 0xCC, 0xFA, 0xD7, 0xC6, 0x99, 0x0D, 0xE9, 
+0x8E, 0x68, 0x64, 0xFF, 0x17, 0xFF, 0x0E, 0x34, 
+0x51, 0xA1, 0x52, 0xAB, 0xC0, 0xE4, 0x3C, 0xF1, 
+0x2E, 0x57, 0xF2, 0x0D, 0x9C, 0xCB, 0x36, 0x25, 
+0xC4, 0xB8, 0x07, 0x2E, 0xAE, 0x0F, 0x75, 0xA7, 
+0x93, 0xA5, 0x64, 0xB7, 0x18, 0x44, 0xCD, 0x14, 
+0xC3, 0xED, 0xC8, 0x65, 0xE6, 0x39, 0xA5, 0x4D, 
+0x5C, 0x8A, 0x80, 0xA5, 0x8F, 0x38, 0xAC, 0x05, 
+0x16, 0x73, 0x65, 0x62, 0x5C, 0xA2, 0x3D, 0xD7, 
+0x99, 0x6C, 0x4B, 0x5C, 0xAE, 0xFF, 0x44, 0xE4, 
+0xE3, 0xC2, 0x7F, 0x9F, 0xE6, 0x82, 0x7B, 0x18, 
+0x4C, 0x49, 0xED, 0xE0, 0x7B, 0xEF, 0x4E, 0x95, 
+0x66, 0x28, 0x1A, 0x54, 0x90, 0x66, 0x52, 0x53, 
+0x71, 0x15, 0x15, 0xE5, 0xF5, 0x7B, 0xFE, 0x6C, 
+0xC9, 0x7C, 0x25, 0x26, 0xD5, 0xE7, 0x49, 0x79, 
+0x63, 0x9F, 0x44, 0x6B, 0xCA, 0xD2, 0x2C, 0x06, 
+0x09, 0xD3, 0xCE, 0xD0, 0x5C, 0x34, 0x08, 0x22, 
+0xEC, 0x1F, 0xF5, 0xE4, 0xF4, 
+
+0x70, 0x87, 0xDE, 0x4D, 0xE1, 0x87, 0x09, 0x7D, 
+0x3F, 0x04, 0x5E, 0x2E, 0xB9, 0x2E, 0x27, 0x24, 
+0x6B, 0x4B, 0xAC, 0xB5, 0xBB, 0x7E, 0x22, 0x36, 
+0x8F, 0x12, 0x6A, 0xE2, 0xC1, 0xA7, 0x03, 0x89, 
+0x1C, 0xEF, 0x0B, 0x47, 0x1C, 0xDA, 0x22, 0xF3, 
+0xF8, 0x41, 0x42, 0xF4, 0x3D, 0x38, 0x46, 0x35, 
+0x49, 0x89, 0x2E, 0x6A, 0x2D, 0x4C, 0xA3, 0x12, 
+0xBB, 0x0D, 0x29, 0xAB, 0xBA, 0xB7, 0xB4, 0xF1, 
+0x46, 0xB7, 0xEC, 0x2E, 0x66, 0xB4, 0xD4, 0x56, 
+0xCF, 0xAD, 0x86, 0x54, 0x40, 0xC9, 0x54, 0xC0, 
+0xCE, 0xF3, 0x96, 0xAC, 0xA1, 0xC9, 0x99, 0xD5, 
+0x5C, 0x15, 0x89, 0x18, 0xF3, 0x82, 0x01, 0x26, 
+0xBC, 0x3F, 0xCF, 0x64, 0xA4, 0x83, 0x90, 0xC2, 
+0x52, 0xEC, 0xA7, 0x2B, 0xFF, 0xD9, 0x79, 0x35, 
+0x01, 0x88, 0x9A, 0x0D, 0xF9, 0xD5, 0x87, 0x2A, 
+0xFF, 0x14, 0xAF, 0xA4, 0xBD, 0x2E, 0x49, 0xDF, 
+0x8C, 0xA2, 0x46, 0xB9, 0x3D, 0xD0, 0x93, 0xE6, 
+0xCB, 0x98, 0x5C, 0x38, 0x3B, 0x95, 0x25, 0x92, 
+0xE1, 0x17, 0x9F, 0xAE, 0x82, 0x21, 0x8A, 0x7F, 
+0x30, 0x96, 0x9A, 0x91, 0x2D, 0x20, 0xDF, 0x41, 
+0x60, 0xA1, 0x04, 0xB1, 0xCC, 0x9C, 0xB5, 0x0E, 
+0xB7, 0xA5, 0xB9, 0x61, 0xDF, 0xBB, 0x0F, 0x3C, 
+0x92, 0x5A, 0x1D, 0x7E, 0x7B, 0xE5, 0x53, 0x03, 
+0xB9, 0xAF, 0x7E, 0xCE, 0xEC, 0x3C, 0x40, 0x3B, 
+0xB9, 0x08, 0x4C, 0x63, 0xF1, 0x8B, 0x31, 0x51, 
+0xEC, 0x69, 0xEA, 0x4B, 0xB2, 0xA0, 0x4A, 0xCC, 
+0xE2, 0x13, 0x4A, 0xCC, 0x8C, 0xBF, 0x64, 0x0F, 
+0x4B, 0xA7, 0x25, 0x6D, 0xB7, 0x6C, 0x99, 0x28, 
+0x10, 0x15, 0x5D, 0x6B, 0xEA, 0x1A, 0xDB, 0xE6, 
+0xB6, 0xBD, 0xC6, 0xED, 0x28, 0xC5, 0x13, 0xEB, 
+0x54, 0x2C, 0xE3, 0x90, 0x44, 0x37, 0x11, 0x7A, 
+0x3E, 0x15, 0xFA, 0xA9, 0xB8, 0x5A, 0x63, 0x38, 
+0x89, 0xD0, 0x08, 0x7A, 0x63, 0xC6, 0xB6, 0xB9, 
+0x0B, 0x6A, 0x04, 0x18, 0x6D, 0x68, 
+
+0x92, 0x18, 0xFE, 0x70, 0xE0, 0x13, 0xB1, 0xFF, 
+0xA5, 0xFC, 0x57, 0x8B, 0x3A, 0x1F, 0xB4, 0x98, 
+0x20, 0x27, 0xBD, 0xD2, 0x9C, 0xDC, 0x6D, 0x12, 
+0x70, 0x3C, 0x5C, 0x0A, 0xB9, 0x0C, 0x09, 0xB2, 
+0x63, 0x2E, 0x47, 0xDB, 0xC6, 0x97, 0xCE, 0xA5, 
+0xB8, 0x5C, 0x22, 0x59, 0x69, 0xDC, 0xBD, 0x25, 
+0x24, 0x27, 0x81, 0x7A, 0xD4, 0xF2, 0xED, 0x35, 
+0xF2, 0xB2, 0x66, 0x2B, 0xD7, 0x38, 0xC4, 0xBB, 
+0xC0, 0x57, 0x6F, 0x4E, 0x48, 0xF6, 0xB5, 0x68, 
+0x62, 0xDD, 0x7D, 0xF9, 0x5D, 0x9A, 0x46, 0x34, 
+0xC1, 0x8D, 0xAF, 0x84, 0xFE, 0x1A, 0xD9, 0x52, 
+0x2E, 0x46, 0xED, 0x5B, 0xD9, 0x2A, 0xBA, 0xC0, 
+0xA8, 0xDF, 0xD0, 0xE2, 0x35, 0xB4, 0xEE, 0x70, 
+0x51, 0xA5, 0x72, 0x97, 0x99, 0xCB, 0xA9, 0x1F, 
+0xD8, 0x99, 0x84, 0xD5, 0xAB, 0xC4, 0x86, 0xAA, 
+0xDA, 0x28, 0x5A, 0x99, 0xDF, 0x7E, 0x09, 0x9E, 
+0x27, 0x6D, 0x52, 0xF1, 0x81, 0x4A, 0xDB, 0x7B, 
+0x6F, 0x96, 0xB8, 0x02, 0xA8, 0xB8, 0xAD, 0xFE, 
+0x16, 0x0F, 0xF5, 0xBD, 0x7C, 0x2C, 0x29, 0x06, 
+0x17, 0x61, 0xE5, 0xDA, 0x49, 0xC9, 0xF2, 0x2D, 
+0xC7, 0x6D, 0x72, 0x6C, 0x27, 0x6C, 0xCD, 0x3C, 
+0xFB, 0xA7, 0xD4, 0x97, 0x38, 0x90, 0xC1, 0xE0, 
+0x23, 0xD0, 0x8E, 0xEB, 0xAB, 0xB1, 0x76, 0x00, 
+0x18, 0xBD, 0xAB, 0xE2, 0x95, 0x43, 0xA6, 0x6E, 
+0x3C, 0x6A, 0x54, 0xAA, 0xFC, 0x39, 0xD2, 0x45, 
+0xF2, 0xBB, 0xE0, 0xB9, 0x6D, 0x96, 0xDF, 0xFE, 
+0x6D, 0xD3, 0x87, 0xC1, 0x58, 0x62, 0xCF, 0x7E, 
+0x70, 0x14, 0x08, 0xB7, 0x9A, 0xCB, 0x2A, 0x47, 
+0x77, 0xF5, 0x09, 0xE5, 0x86, 0x21, 0xBE, 0xC8, 
+0xDC, 0x5F, 0xF3, 0xC7, 0xBE, 0x5B, 0x9E, 0x89, 
+0xF5, 0x57, 0xFD, 0x95, 0x16, 0xCE, 0x48, 0x1E, 
+0x38, 0x39, 0x5A, 0xE2, 0x95, 0x5F, 0x5A, 0x3F, 
+0x81, 0x3A, 0xFB, 0xAD, 0x0C, 0xD4, 0x4C, 0x78, 
+0x6E, 0x17, 0x5E, 0x9F, 0x6A, 0x13, 0x18, 
+
+0x4B, 0xB9, 0xDB, 0x1F, 0xBC, 0x05, 0x08, 0x2A, 
+0x62, 0x8A, 0xC2, 0x7F, 0xE5, 0x19, 0x91, 0xAF, 
+0xC9, 0x36, 0x51, 0x1C, 0x0F, 0xD0, 0x90, 0xC6, 
+0xBE, 0x0F, 0x5F, 0x57, 0xE1, 0x81, 0xA3, 0x52, 
+0x38, 0x91, 0xCF, 0x8A, 0x1D, 0x93, 0x9F, 0x48, 
+0xEB, 0xF5, 0x87, 0x2E, 0x3B, 0x1A, 0xBF, 0x85, 
+0x6B, 0x3E, 0x12, 0x60, 0x03, 0x26, 0xCE, 0x29, 
+0xA2, 0xC1, 0x2B, 0xF7, 0xD9, 0x80, 0xF3, 0xFC, 
+0xB9, 0x82, 0x63, 0xD9, 0x49, 0xAB, 0x82, 0xB3, 
+0x42, 0xA4, 0x7A, 0x5E, 0xDF, 0x08, 0x79, 0x5C, 
+0xDE, 0xC8, 0x16, 0x42, 0x10, 0x44, 0x9F, 0x8F, 
+0xBB, 0xC2, 0xCB, 0x51, 0x0E, 0xCC, 0xD2, 0xB8, 
+0x69, 0x6E, 0x93, 0x7A, 0x55, 0x1D, 0x80, 0x0C, 
+0x08, 0x96, 0xC0, 0xD0, 0xF7, 0x7C, 0x25, 0xC6, 
+0x9A, 0xE1, 0x65, 0xF5, 0x54, 0xC8, 0x05, 0xBC, 
+0x50, 0x99, 0xE0, 0xCD, 0xE4, 0x94, 0x8B, 0x87, 
+0xEA, 0x8C, 0x11, 0xB1, 0x9D, 0xDA, 0x31, 0xF8, 
+0xE1, 0x47, 0x9B, 0x6C, 0x5D, 0xB4, 0x9C, 0xB3, 
+0x2F, 0x41, 0xB0, 0x70, 0x8D, 0x59, 0xB0, 0x4A, 
+0xD4, 0x4D, 0xA7, 0xCE, 0xA7, 0xF8, 0xB4, 0x32, 
+0x79, 0x17, 0xDA, 0x99, 0xEE, 0x80, 0x09, 0x24, 
+0x0C, 0x53, 0x39, 0x79, 0xEA, 0xFE, 0x4A, 0x78, 
+0x2C, 0x4A, 0x3E, 0x03, 0x14, 0xCB, 0xBC, 0x16, 
+0xC8, 0xE5, 0x7F, 0xEC, 0x4D, 0xDA, 0x60, 0xC4, 
+0xE6, 0xAB, 0xED, 0x85, 0x7D, 0x0F, 0x16, 0x51, 
+0x69, 0x3D, 0xDB, 0xC2, 0x98, 0x6E, 0x8B, 0x23, 
+0xEF, 0xB4, 0x9D, 0xBD, 0x68, 0x73, 0x78, 0x09, 
+0xB6, 0x5D, 0xFE, 0x90, 0x3B, 0x75, 0xF8, 0x27, 
+0x01, 0xD0, 0x9E, 0xAD, 0x0B, 0x20, 0xF6, 0x10, 
+0x92, 0xC4, 0x34, 0xD1, 0x77, 0x8E, 0xE9, 0xB3, 
+0x05, 0x0E, 0xFE, 0x14, 0xBB, 0xD6, 0x28, 0x49, 
+0xD5, 0x79, 0xF4, 0x7D, 0x4D, 0xF5, 0xCD, 0xB7, 
+0x64, 0x9D, 0x39, 0x1E, 0x9E, 0xB6, 0xE5, 0x2B, 
+0x02, 0xFF, 0xEC, 0xB3, 0x8E, 0xCD, 0x24, 0x92, 
+
+0xDB, 0x51, 0xEC, 0xC5, 0x3A, 0x50, 0x7D, 0xCF, 
+0xD0, 0x9C, 0xD3, 0x06, 0x97, 0x4F, 0xCE, 0xBB, 
+0x6E, 0x30, 0xE8, 0xB7, 0xE4, 0x91, 0x67, 0x87, 
+0xCD, 0xAF, 0x69, 0x24, 0xB3, 0x0A, 0xE5, 0x71, 
+0xE2, 0xA3, 0xC5, 0x79, 0xBC, 0x4F, 0x5F, 0x4A, 
+0x32, 0xA7, 0x7E, 0xE5, 0x92, 0x7F, 0x05, 0xBC, 
+0x0F, 0x2A, 0xA3, 0x40, 0xC4, 0x90, 0x88, 0x45, 
+0x3B, 0xF3, 0xE4, 0x74, 0x9E, 0x16, 0x88, 0xD2, 
+0x39, 0xE9, 0x2D, 0xB5, 0x0C, 0x0E, 0xD7, 0xF5, 
+0x73, 0x88, 0xCB, 0x17, 0x24, 0xAB, 0xD9, 0x7A, 
+0x2D, 0x05, 0x79, 0x35, 0xFB, 0xB6, 0x90, 0x83, 
+0xE4, 0x81, 0x10, 0x30, 0x96, 0x5C, 0x9A, 0xE3, 
+0xA4, 0x03, 0xFC, 0xE7, 0xFF, 0x83, 0x93, 0x6F, 
+0xAA, 0xEC, 0x8D, 0xF2, 0x87, 0xB9, 0x43, 0x31, 
+0x59, 0x01, 0x87, 0x22, 0x73, 0xE2, 0xB4, 0x4C, 
+0x4C, 0xF2, 0x42, 0x0D, 0xE3, 0x4B, 0x83, 0x49, 
+0xDB, 0xA2, 0xDC, 0xA7, 0x11, 0xF5, 0x9E, 0x10, 
+0xF3, 0x43, 0x89, 0x1B, 0x36, 0xF5, 0x86, 0x5E, 
+0xFB, 0xD3, 0x9C, 0x5D, 0x17, 0xF5, 0xEB, 0xA6, 
+0xAE, 0x2C, 0x68, 0xAA, 0xD5, 0x5D, 0x7A, 0xE5, 
+0x10, 0x69, 0xAB, 0x21, 0x75, 0x95, 0xED, 0xD4, 
+0x87, 0xCD, 0xFE, 0x4C, 0x39, 0x64, 0xB6, 0x4A, 
+0x77, 0x42, 0xC5, 0x52, 0x89, 0x9F, 0x10, 0x91, 
+0x7A, 0x61, 0x9C, 0xAA, 0xCA, 0xDB, 0x02, 0xD6, 
+0x88, 0x8F, 0xB0, 0x54, 0xFE, 0x11, 0xF1, 0xFD, 
+0x56, 0x4E, 0x01, 0x0D, 0x3D, 0x4B, 0x61, 0x89, 
+0x47, 0x36, 0x00, 0x38, 0x46, 0x02, 0x4A, 0x2F, 
+0x7A, 0xB7, 0xAA, 0x9F, 0xB6, 0x12, 0xB8, 0xCA, 
+0xE5, 0x55, 0x7F, 0xA4, 0xC7, 0xE6, 0x17, 0xF2, 
+0xA7, 0xB0, 0x05, 0xC6, 0x43, 0x9E, 0xFD, 0xF6, 
+0x7E, 0xE0, 0x9B, 0x37, 0x38, 0xCB, 0x35, 0x51, 
+0x2F, 0x6C, 0x70, 0x96, 0x80, 0x51, 0xC4, 0x74, 
+0x6C, 0xC5, 0x2F, 0xAA, 0x6A, 0xE5, 0x9B, 0x0C, 
+0xE2, 0x88, 0xF3, 0x13, 0xED, 0x12, 0xD0, 0xC3, 
+0xBE, 
+
+0xA3, 0x50, 0xA1, 0x42, 0x9F, 0xFA, 0x9E, 0xC8, 
+0x36, 0x6F, 0xDB, 0x39, 0x24, 0x5A, 0x8A, 0x24, 
+0xEF, 0xF1, 0x08, 0x3E, 0x20, 0x81, 0xDF, 0x4E, 
+0x72, 0x02, 0xD3, 0xB0, 0xAD, 0x38, 0xC5, 0x77, 
+0x0B, 0x35, 0x3D, 0x6A, 0x50, 0x5B, 0xD4, 0x09, 
+0x7F, 0x47, 0xA0, 0xF7, 0x27, 0x4C, 0xE1, 0x02, 
+0xF6, 0xF6, 0x90, 0x1C, 0x7B, 0xB4, 0x19, 0x79, 
+0xCC, 0xCA, 0x0E, 0x8E, 0x89, 0xF0, 0x5C, 0x0E, 
+0x46, 0x87, 0x94, 0x33, 0x7F, 0x90, 0x5C, 0x97, 
+0x89, 0x84, 0x89, 0x73, 0xBE, 0x33, 0xED, 0xC4, 
+0xA6, 0x4D, 0xA1, 0x12, 0x5A, 0x61, 0x18, 0x30, 
+0x11, 0xB0, 0xB5, 0x84, 0xC5, 0xE9, 0x81, 0x05, 
+0xA6, 0x4E, 0x83, 0x20, 0x67, 0x3F, 0xC0, 0xF6, 
+0x4B, 0xD0, 0x2B, 0x4A, 0xF2, 0xF4, 0x88, 0xA8, 
+0x87, 0x1B, 0xBA, 0x01, 0x81, 0x07, 0x72, 0xB5, 
+0xB7, 0x95, 0xE1, 0x93, 0x8C, 0x8F, 0x5D, 0x46, 
+0xB9, 0xC5, 0xD2, 0xEC, 0xAC, 0xEB, 0x82, 0x49, 
+0xCE, 0xF0, 0x89, 0x28, 0xCF, 0xC6, 0x06, 0x14, 
+0xD1, 0x52, 0xDE, 0x63, 0xFB, 0xC0, 0xCF, 0x19, 
+0x26, 0x88, 0x11, 0x34, 0x39, 0x02, 0xB3, 0x09, 
+0x4F, 0x7D, 0x51, 0xB2, 0x40, 0x96, 0x23, 0x8C, 
+0xB6, 0x91, 0xE1, 0x4C, 0xB3, 0x85, 0x2E, 0x7F, 
+0xA3, 0x72, 0xFD, 0xBE, 0xE8, 0x7B, 0xDE, 0xA4, 
+0x58, 0x1A, 0x11, 0xEC, 0xE6, 0xA1, 0x36, 0x4A, 
+0x4D, 0x68, 0x0B, 0x03, 0x35, 0xE8, 0x53, 0x94, 
+0x9D, 0x97, 0xD5, 0x6D, 0x61, 0x48, 0x16, 0x6C, 
+0xAC, 0xF3, 0x2A, 0x7F, 0x9E, 0x3A, 0xBB, 0x5F, 
+0xA3, 0x99, 0x4A, 0x5C, 0xAC, 0xB0, 0xB2, 0xAA, 
+0x9C, 0x4C, 0x5B, 0xCE, 0x34, 0xFC, 0x1C, 0xE9, 
+0xD6, 0xC7, 0xC1, 0xEA, 0xAB, 0x87, 0x10, 0xBA, 
+0x46, 0x61, 0x28, 0xB4, 0xB1, 0x46, 0x32, 0x24, 
+0x7E, 0x33, 0xF0, 0x24, 0x7F, 0xAA, 0xCD, 0x14, 
+0x6D, 0xAA, 0xB5, 0xD5, 0x11, 0xA9, 0x98, 0x90, 
+0x4F, 0xDD, 0x0F, 0xDC, 0xD9, 0x8B, 0xB6, 0x30, 
+0xCA, 0x6D, 
+
+0xED, 0x97, 0x39, 0x35, 0x61, 0x09, 0x47, 0xA0, 
+0xF7, 0xB4, 0xFD, 0x0F, 0xA4, 0x21, 0xC9, 0x3A, 
+0x31, 0x6A, 0x94, 0x12, 0xBB, 0x6F, 0xE8, 0x7A, 
+0x0A, 0x4E, 0xD5, 0xD3, 0x6D, 0xA2, 0x46, 0xB1, 
+0xD2, 0xEE, 0x84, 0xA6, 0xA6, 0xC8, 0x21, 0x08, 
+0xE7, 0xAC, 0xE9, 0x3B, 0xEC, 0x83, 0x17, 0x90, 
+0x40, 0x55, 0xDD, 0xD7, 0xC3, 0x60, 0x18, 0xD4, 
+0x83, 0x15, 0x04, 0x81, 0xCF, 0x99, 0x10, 0xD1, 
+0x7C, 0x52, 0x83, 0xAD, 0xB7, 0x66, 0x85, 0xFC, 
+0x4A, 0xC8, 0x4D, 0xC9, 0x07, 0x94, 0x4F, 0xE2, 
+0x6C, 0x4D, 0x76, 0x1B, 0xDF, 0x50, 0xC4, 0x75, 
+0xED, 0x4F, 0xEE, 0x05, 0x30, 0x93, 0xB5, 0xFA, 
+0xD5, 0x62, 0xC9, 0xE6, 0xE8, 0xD7, 0x07, 0x87, 
+0x8A, 0x01, 0x6F, 0xF5, 0x7E, 0xEE, 0xA6, 0xE1, 
+0x20, 0xCD, 0x3B, 0xFD, 0xC4, 0xC8, 0x6A, 0x8D, 
+0x0C, 0x8C, 0x24, 0x50, 0x39, 0x1C, 0xBD, 0x6D, 
+0x24, 0x78, 0x15, 0x59, 0x9E, 0x9D, 0x18, 0x6B, 
+0xC5, 0xEE, 0x06, 0x3E, 0xF2, 0x05, 0x3B, 0x9C, 
+0x5D, 0x5F, 0xB6, 0x7D, 0x59, 0x71, 0x52, 0xD0, 
+0xEE, 0xB0, 0xD1, 0x96, 0x3A, 0xEF, 0xA4, 0xD5, 
+0x02, 0xF8, 0x56, 0xC3, 0x19, 0xC1, 0x16, 0xBD, 
+0xCA, 0xA3, 0xAA, 0x1A, 0x6F, 0xFD, 0x73, 0xDD, 
+0x13, 0x87, 0x5E, 0xE9, 0x39, 0x9A, 0x4C, 0xCB, 
+0x6D, 0x2E, 0x89, 0xC3, 0x54, 0xDF, 0x75, 0xF0, 
+0xAA, 0x1B, 0x64, 0xB8, 0x02, 0x68, 0x7F, 0x24, 
+0x53, 0xE6, 0x0F, 0xBD, 0xA4, 0xAA, 0x4B, 0x92, 
+0xBD, 0xA6, 0xF1, 0xD0, 0xBC, 0x8A, 0x54, 0x61, 
+0xD3, 0x35, 0x5C, 0xB3, 0xE4, 0xD5, 0x30, 0x79, 
+0x22, 0xFB, 0x74, 0xE3, 0x3F, 0x90, 0x72, 0xD5, 
+0x05, 0xC3, 0x4B, 0x78, 0x3B, 0xF2, 0x0B, 0xC8, 
+0x29, 0xDB, 0x8E, 0x5B, 0xF5, 0x83, 0x2B, 0x39, 
+0x90, 0xE0, 0x53, 0xD9, 0xB8, 0x75, 0x86, 0xA3, 
+0x2C, 0x12, 0x0A, 0x7D, 0x97, 0xC3, 0xE9, 0x4A, 
+0x59, 0x19, 0x0C, 0x48, 0x8E, 0x23, 0x92, 0xAF, 
+0xB6, 0x0B, 0xA7, 
+
+0xED, 0x52, 0x36, 0x00, 0xA1, 0x0E, 0x87, 0x13, 
+0x07, 0x49, 0x28, 0xE6, 0x61, 0xD1, 0xE4, 0x15, 
+0xA4, 0xC7, 0x1A, 0xA5, 0x09, 0xE6, 0x3E, 0xAA, 
+0xED, 0x23, 0xAD, 0x95, 0x5A, 0x47, 0x29, 0x5C, 
+0xC7, 0xB7, 0xBA, 0x85, 0xC9, 0x90, 0x2A, 0x26, 
+0xD5, 0xC9, 0x67, 0xAC, 0xF1, 0xB2, 0x5A, 0x94, 
+0x28, 0xB9, 0x31, 0x54, 0xCF, 0xBA, 0xB5, 0x2E, 
+0x7A, 0x9F, 0xFF, 0x22, 0x5F, 0x74, 0xC5, 0xAC, 
+0x25, 0xAD, 0xBC, 0xB7, 0x35, 0x46, 0x33, 0xC1, 
+0x34, 0x43, 0x69, 0xEA, 0xDE, 0xBC, 0x4E, 0xDC, 
+0x19, 0x4F, 0x6F, 0x45, 0x54, 0xCE, 0xD8, 0x11, 
+0x81, 0x58, 0x48, 0x11, 0xFD, 0x24, 0x10, 0x83, 
+0xDD, 0x16, 0x59, 0xBB, 0x48, 0x5A, 0x72, 0x61, 
+0x39, 0xB1, 0x1C, 0x05, 0x8C, 0xF4, 0xBE, 0x4F, 
+0xDD, 0xB9, 0xCC, 0x44, 0x2D, 0x87, 0xE6, 0xFE, 
+0x35, 0x7D, 0x46, 0x67, 0x8B, 0x01, 0x0E, 0x76, 
+0x9C, 0x8D, 0x81, 0x0B, 0x1E, 0x32, 0xFA, 0x36, 
+0xAD, 0xF9, 0xEE, 0xAB, 0x91, 0x8B, 0x3B, 0xFA, 
+0xFB, 0x27, 0xA8, 0x1A, 0x23, 0xF6, 0xC0, 0x3B, 
+0x67, 0x83, 0x49, 0xC9, 0x52, 0x8B, 0x58, 0x3B, 
+0x2F, 0x75, 0x92, 0x15, 0xEB, 0x19, 0x09, 0x84, 
+0xEE, 0x37, 0x5B, 0x13, 0xB8, 0xBA, 0x31, 0xE8, 
+0x25, 0x6A, 0xDB, 0x72, 0x87, 0x24, 0xE9, 0x95, 
+0x4A, 0xC8, 0x0C, 0x87, 0xDD, 0x4C, 0x68, 0xB4, 
+0x63, 0xD0, 0x4B, 0x63, 0xC9, 0x4D, 0xD1, 0x12, 
+0xC2, 0x5B, 0xE5, 0x92, 0xA4, 0xEB, 0x5B, 0xE5, 
+0x91, 0x49, 0x82, 0x3C, 0xE2, 0x18, 0xB9, 0xFF, 
+0x70, 0x7B, 0xA1, 0x07, 0x0E, 0xC1, 0x90, 0x61, 
+0xAF, 0xA1, 0x60, 0xA1, 0x3A, 0xA5, 0xFE, 0xDB, 
+0x04, 0x78, 0x7F, 0xDC, 0x64, 0x60, 0x9C, 0x2C, 
+0x2D, 0xBC, 0xCC, 0x1F, 0xA5, 0x40, 0xF4, 0x4C, 
+0xFD, 0x87, 0xF8, 0x7E, 0x07, 0xA7, 0x4B, 0x11, 
+0xF1, 0x16, 0x0A, 0xC4, 0xCE, 0x64, 0x7C, 0x90, 
+0xDB, 0xA5, 0xE8, 0x80, 0x4C, 0x72, 0x5C, 0x3F, 
+0x40, 0x4F, 0xE9, 0x0D, 
+
+0x05, 0xD7, 0x49, 0x08, 0xE1, 0xBA, 0x8F, 0xB5, 
+0x58, 0x75, 0xCD, 0xCE, 0x0D, 0x73, 0x6A, 0x9C, 
+0x59, 0x60, 0xCD, 0x06, 0x42, 0xD6, 0xFE, 0x7C, 
+0x7D, 0xBA, 0xFF, 0xFF, 0xD5, 0x68, 0x51, 0x59, 
+0x6B, 0xFF, 0x5B, 0x6A, 0x08, 0x3E, 0x95, 0x33, 
+0x6E, 0xD5, 0x7D, 0x2F, 0x61, 0x14, 0xE7, 0x5D, 
+0x11, 0x43, 0xF8, 0x85, 0x81, 0x8B, 0x58, 0xD8, 
+0x2D, 0xBB, 0xFD, 0xC2, 0x71, 0xC4, 0x2C, 0xE5, 
+0xEA, 0x42, 0x11, 0x0F, 0x02, 0x4A, 0x4F, 0xF3, 
+0xC5, 0x40, 0xAE, 0x56, 0xD3, 0x38, 0x31, 0x04, 
+0x51, 0xE7, 0x43, 0x19, 0x98, 0xA7, 0x38, 0x55, 
+0x6C, 0xC7, 0x6C, 0xA0, 0x48, 0xB3, 0xF5, 0xE5, 
+0x47, 0x78, 0xF8, 0x0D, 0xC0, 0xAE, 0xC9, 0x14, 
+0xD4, 0x53, 0x9A, 0x33, 0x68, 0x6D, 0xD2, 0xCD, 
+0x30, 0x4B, 0xC9, 0x05, 0x8D, 0x40, 0x18, 0x75, 
+0x69, 0xBE, 0x90, 0x82, 0x54, 0xBF, 0x63, 0x35, 
+0xD6, 0xDA, 0x9D, 0x30, 0xD8, 0x28, 0x2B, 0xDC, 
+0x23, 0xAA, 0x59, 0x3F, 0xE9, 0x05, 0xA2, 0x8F, 
+0x67, 0xBC, 0x2C, 0x24, 0x06, 0x18, 0x63, 0x43, 
+0x52, 0xB5, 0xE4, 0x0A, 0xDE, 0xC3, 0x8E, 0xFF, 
+0xA1, 0x0E, 0xD0, 0xE0, 0xF6, 0x32, 0x81, 0xAC, 
+0xDE, 0xC4, 0xEA, 0x6D, 0x91, 0x91, 0xE8, 0x88, 
+0xD7, 0x6C, 0xFC, 0x00, 0x5C, 0xBA, 0x23, 0xE1, 
+0x07, 0x0B, 0x30, 0x00, 0xFE, 0x17, 0x7B, 0x67, 
+0xBD, 0x04, 0x33, 0xA0, 0xEE, 0xC7, 0x56, 0x22, 
+0x49, 0xAD, 0x73, 0x0F, 0xFB, 0x79, 0x7E, 0x61, 
+0x39, 0x61, 0x3B, 0x56, 0xAE, 0x0B, 0xBE, 0xCA, 
+0xAD, 0x95, 0xDE, 0xF2, 0xC4, 0xAC, 0x6C, 0x1A, 
+0x5D, 0xC5, 0x89, 0xE3, 0x07, 0xC6, 0xDA, 0x4C, 
+0xCB, 0xCE, 0x08, 0x2F, 0x84, 0x00, 0x84, 0x56, 
+0xD3, 0x27, 0x56, 0xA7, 0x64, 0x60, 0x7C, 0x82, 
+0x97, 0x8F, 0x1F, 0x94, 0x42, 0xF9, 0xBB, 0x38, 
+0x6D, 0x7A, 0xDC, 0x81, 0xB2, 0x34, 0x10, 0xED, 
+0xEB, 0x2F, 0xF9, 0x93, 0x4A, 0x0A, 0xD6, 0xC8, 
+0xE4, 0xCF, 0x66, 0x98, 0xFD, 
+
+0xFC, 0x06, 0xF2, 0x63, 0xA8, 0x38, 0xF2, 0xC9, 
+0x4C, 0xFA, 0x9E, 0x70, 0xB7, 0xD9, 0xA0, 0x93, 
+0xBC, 0x35, 0x91, 0x81, 0x3F, 0x1C, 0x39, 0x45, 
+0x56, 0x06, 0xCB, 0xE9, 0xCD, 0xAD, 0x04, 0xAA, 
+0x05, 0xB2, 0xCE, 0x4B, 0x07, 0x9B, 0x50, 0x6E, 
+0xA8, 0x10, 0x7E, 0x62, 0x8A, 0x97, 0xEA, 0x88, 
+0x47, 0x4A, 0xF9, 0xDD, 0x82, 0xC1, 0x2B, 0x5E, 
+0xAD, 0xDB, 0x41, 0x5F, 0xEE, 0x16, 0xF9, 0x83, 
+0xE7, 0x38, 0xCB, 0x74, 0xD1, 0x48, 0x7E, 0x4D, 
+0xAE, 0x0F, 0xE0, 0xED, 0x57, 0x0E, 0x69, 0xB3, 
+0x3A, 0x4A, 0xE9, 0x27, 0x88, 0x33, 0x38, 0xD8, 
+0x46, 0xC1, 0x09, 0xD2, 0x84, 0x13, 0xC5, 0x4C, 
+0x38, 0x14, 0x69, 0x1B, 0x96, 0x32, 0x21, 0xAD, 
+0x61, 0x61, 0x60, 0xFD, 0x2F, 0xC5, 0xE8, 0x17, 
+0x64, 0x17, 0xE0, 0x62, 0xF3, 0x27, 0x06, 0xDD, 
+0x25, 0x91, 0xB2, 0x2F, 0xD7, 0xCC, 0xB6, 0x70, 
+0xDD, 0x1F, 0xBC, 0xFD, 0x4E, 0x45, 0x90, 0x17, 
+0xA5, 0x66, 0xFC, 0xBB, 0x8C, 0x5B, 0x70, 0xD3, 
+0x1B, 0x7D, 0x68, 0xF9, 0x53, 0x90, 0x63, 0xD0, 
+0x12, 0x3F, 0xB4, 0xF4, 0x79, 0x75, 0x30, 0x1C, 
+0x26, 0xBC, 0xA2, 0x0C, 0x46, 0xB7, 0x16, 0x01, 
+0x91, 0x07, 0xDC, 0x84, 0x2C, 0x7A, 0xDC, 0x91, 
+0x5A, 0x18, 0xA4, 0x46, 0xDE, 0x04, 0x55, 0x8C, 
+0x17, 0x8B, 0x68, 0x9F, 0x35, 0xE8, 0xD8, 0x15, 
+0x49, 0x42, 0xB5, 0xD2, 0x7E, 0x37, 0x5A, 0x8F, 
+0xE9, 0x13, 0xB1, 0xFD, 0x39, 0xFA, 0x0A, 0xD5, 
+0x8E, 0xD6, 0x7D, 0xCC, 0xFC, 0x0A, 0x4B, 0xE9, 
+0xDA, 0x3A, 0x64, 0xA4, 0x5B, 0x7F, 0x2C, 0x2B, 
+0xE6, 0x24, 0x71, 0x1D, 0x9A, 0xF0, 0x57, 0x82, 
+0x91, 0xB0, 0x68, 0xC1, 0x0D, 0x8C, 0x97, 0x96, 
+0xA9, 0xE5, 0x3F, 0x0C, 0x44, 0xC0, 0xCF, 0x95, 
+0x8A, 0x01, 0x38, 0x23, 0x07, 0xD3, 0x8B, 0x59, 
+0x02, 0x27, 0xE0, 0xDD, 0x20, 0xF6, 0x50, 0x20, 
+0xD7, 0xC3, 0x27, 0xC4, 0xB8, 0xBE, 0x6D, 0x7F, 
+0x7A, 0x09, 0x05, 0x95, 0x4B, 0xDE, 
+
+0xD6, 0x99, 0xBB, 0x51, 0xD8, 0x94, 0x0D, 0x30, 
+0x0A, 0x8A, 0xB7, 0x7E, 0x4A, 0xBD, 0x7D, 0x1B, 
+0xC1, 0x83, 0xC8, 0xE6, 0x94, 0xB8, 0xCD, 0x9A, 
+0x62, 0x10, 0xC5, 0xC0, 0xF3, 0xB1, 0x35, 0xF1, 
+0x58, 0x70, 0x49, 0xEA, 0x0A, 0x52, 0xE8, 0x8A, 
+0xA7, 0xEA, 0x7D, 0x32, 0x79, 0x1C, 0x61, 0x4D, 
+0xAB, 0xA7, 0x1C, 0xC7, 0x80, 0xE2, 0x99, 0x66, 
+0x8F, 0x60, 0x09, 0xC1, 0x36, 0xDE, 0x4B, 0x00, 
+0x2B, 0x39, 0xAB, 0x1F, 0xF5, 0xB6, 0xC7, 0x4E, 
+0x4D, 0x2D, 0x77, 0x36, 0x76, 0x5B, 0xB2, 0xC7, 
+0x57, 0x7A, 0x4C, 0x35, 0xCC, 0x56, 0xB1, 0x59, 
+0x19, 0xB9, 0x90, 0x0B, 0x25, 0x2B, 0x23, 0xE1, 
+0xA1, 0xDE, 0xA6, 0xA0, 0x1E, 0x6E, 0x7A, 0x9E, 
+0xBF, 0xB0, 0x3B, 0xA2, 0x8B, 0xE2, 0x8A, 0x4A, 
+0x59, 0x05, 0xC7, 0xB8, 0x39, 0x7A, 0x70, 0x54, 
+0xEF, 0x29, 0xC9, 0xB5, 0x8C, 0x1C, 0x23, 0x98, 
+0x17, 0x3A, 0xAD, 0x7C, 0x23, 0x32, 0x50, 0x0C, 
+0x8C, 0x17, 0x00, 0x4F, 0x7E, 0x2F, 0x8B, 0x0E, 
+0xE4, 0x4C, 0xA2, 0x7F, 0x16, 0xE1, 0x0E, 0x81, 
+0x9D, 0x7D, 0x37, 0x4C, 0x3F, 0x47, 0xB0, 0x81, 
+0x28, 0x8B, 0x4B, 0xC2, 0x8F, 0x7C, 0x08, 0x98, 
+0x1F, 0x18, 0x89, 0xBB, 0x97, 0x75, 0x54, 0x32, 
+0xC9, 0xA3, 0x39, 0x5D, 0x4A, 0x83, 0x42, 0xFB, 
+0x1A, 0x06, 0xC3, 0x25, 0x48, 0x86, 0x6C, 0x7E, 
+0xD9, 0xEA, 0x7E, 0x61, 0xD1, 0x71, 0xA5, 0x5C, 
+0x82, 0x88, 0xE3, 0xDA, 0x56, 0xB2, 0x76, 0xCF, 
+0x53, 0xFE, 0x5E, 0xE5, 0xC2, 0x14, 0xC3, 0x9F, 
+0x9F, 0xDA, 0x86, 0xE0, 0xDF, 0x3A, 0xBC, 0x80, 
+0x61, 0x1F, 0x0D, 0x3C, 0x7E, 0x9C, 0x3E, 0x65, 
+0x7F, 0xF8, 0xF3, 0xC7, 0x09, 0x9B, 0x46, 0xA7, 
+0xFB, 0x77, 0xF3, 0xA7, 0xFE, 0x0B, 0xB8, 0xB9, 
+0x0D, 0xCF, 0x7E, 0x8B, 0xF0, 0xB6, 0x56, 0x31, 
+0x08, 0x79, 0x2B, 0xB2, 0x36, 0xDD, 0x57, 0x6A, 
+0xAF, 0xED, 0x3F, 0x29, 0x83, 0xA3, 0x21, 0xF1, 
+0x6F, 0x93, 0x9A, 0x8A, 0xBC, 0x0B, 0xE2, 
+
+0x0C, 0x62, 0x03, 0x4B, 0x55, 0x97, 0xC5, 0x5A, 
+0x2D, 0xFF, 0x64, 0xD9, 0xE9, 0x07, 0xA5, 0xCB, 
+0x9D, 0xA0, 0xA2, 0x6B, 0x08, 0x96, 0xA0, 0x50, 
+0x25, 0xF3, 0x97, 0x32, 0xCD, 0x84, 0xA7, 0x1D, 
+0x36, 0x9B, 0x2F, 0xF2, 0xC2, 0xE4, 0x21, 0x21, 
+0x37, 0x86, 0xCB, 0x84, 0x6B, 0x06, 0xCE, 0xA3, 
+0xA2, 0x1C, 0xF5, 0x01, 0xF8, 0x59, 0xAA, 0x55, 
+0xDF, 0x32, 0x89, 0x96, 0x66, 0x22, 0x34, 0x4A, 
+0xF7, 0x71, 0x45, 0x4D, 0x1E, 0x50, 0x84, 0x33, 
+0x20, 0xB6, 0x96, 0x59, 0xE8, 0xFB, 0x28, 0xD0, 
+0xA1, 0x8B, 0xF6, 0x37, 0xBF, 0xC5, 0x5F, 0x8B, 
+0x1E, 0x44, 0x9B, 0xFE, 0xCA, 0x6B, 0x69, 0x6C, 
+0xA2, 0xAD, 0x5B, 0x71, 0x0F, 0xE8, 0xFE, 0x5B, 
+0xB6, 0xA3, 0x5A, 0x01, 0x97, 0x8D, 0x0C, 0x6F, 
+0x10, 0x28, 0x11, 0x5A, 0x22, 0x50, 0x1B, 0x41, 
+0x3A, 0x7A, 0x78, 0x6A, 0xD0, 0x20, 0x22, 0x4D, 
+0x75, 0xFE, 0x36, 0x66, 0x30, 0xC1, 0x58, 0xAD, 
+0xAE, 0xE2, 0x43, 0xDC, 0x28, 0x4B, 0xCD, 0xE4, 
+0xB7, 0xAD, 0xB8, 0x12, 0x9D, 0xA9, 0x60, 0x4D, 
+0x63, 0xE1, 0x71, 0xE2, 0x27, 0xBA, 0x80, 0xCD, 
+0xAA, 0xA2, 0x96, 0xE1, 0x76, 0x9B, 0xDA, 0x45, 
+0x10, 0x83, 0xC9, 0x42, 0x6C, 0x97, 0xD8, 0x02, 
+0x07, 0xB7, 0xD8, 0x2B, 0xF7, 0x07, 0xEF, 0x28, 
+0xAE, 0x69, 0x84, 0x39, 0x30, 0xE8, 0xDF, 0x2E, 
+0xE6, 0xAB, 0xD2, 0x14, 0x8E, 0xDA, 0xEB, 0x7D, 
+0x02, 0x44, 0xB3, 0xC6, 0xD2, 0x16, 0x4C, 0x01, 
+0x0A, 0xBD, 0x4E, 0x2A, 0xCA, 0x24, 0x91, 0xA1, 
+0x36, 0x7C, 0x7B, 0x72, 0xB2, 0xBE, 0x88, 0xF3, 
+0x73, 0x27, 0xBD, 0xA7, 0x3A, 0xF1, 0x9D, 0xF7, 
+0x21, 0xA4, 0x43, 0x9C, 0x16, 0x17, 0xB3, 0x95, 
+0xA0, 0x27, 0xFC, 0xE2, 0x65, 0x3F, 0xD0, 0xB3, 
+0xA9, 0xDA, 0x44, 0x04, 0x65, 0x84, 0xE8, 0xB7, 
+0x67, 0xBD, 0xDA, 0x7D, 0xA2, 0xAF, 0xEB, 0x5A, 
+0x9C, 0x06, 0x8F, 0xDD, 0x11, 0x29, 0x76, 0xF9, 
+0x58, 0xF5, 0x3B, 0xB8, 0x82, 0x48, 0xF0, 0x37, 
+
+0x2E, 0xF3, 0xF4, 0x5D, 0x34, 0xF6, 0xE8, 0x3D, 
+0x2B, 0xD0, 0xA6, 0xB7, 0x4A, 0xF5, 0x09, 0x55, 
+0x2B, 0xB1, 0x39, 0x91, 0xF0, 0x9B, 0xEC, 0xFA, 
+0x40, 0xAE, 0xAC, 0xCB, 0x8C, 0x7C, 0x26, 0x53, 
+0xB3, 0x70, 0x11, 0x43, 0x25, 0xA4, 0xED, 0xF2, 
+0x45, 0x42, 0xAF, 0x8E, 0x69, 0x25, 0x81, 0xA0, 
+0x58, 0x59, 0xC2, 0xCD, 0x35, 0x48, 0x4B, 0xD8, 
+0xD0, 0x14, 0xAC, 0x32, 0xB6, 0x1F, 0xAD, 0xEA, 
+0x46, 0xFD, 0xDB, 0x22, 0x9A, 0xCB, 0xC4, 0x60, 
+0xA0, 0x95, 0xF9, 0x09, 0xF8, 0xD3, 0x41, 0xDC, 
+0x33, 0xE4, 0x32, 0x22, 0xC8, 0xDE, 0xA2, 0x28, 
+0xDC, 0x33, 0x23, 0xEA, 0xB0, 0xC9, 0x45, 0x81, 
+0x6D, 0xA9, 0x90, 0x05, 0xBA, 0x7C, 0x57, 0x31, 
+0x4B, 0x40, 0xA6, 0xCF, 0x4B, 0xB5, 0x81, 0x2C, 
+0x7C, 0x50, 0x4A, 0x1F, 0x7E, 0x82, 0x74, 0x31, 
+0xCF, 0xA0, 0x2D, 0xAB, 0xA2, 0x47, 0x4E, 0xF9, 
+0xE4, 0xC4, 0x88, 0x75, 0x76, 0x02, 0x6D, 0xEA, 
+0xCE, 0x25, 0x55, 0xE6, 0x19, 0xB9, 0x41, 0x4D, 
+0x6C, 0xCB, 0xA7, 0x3A, 0x4E, 0xDA, 0xAF, 0x5C, 
+0x79, 0xE5, 0x30, 0x32, 0xC9, 0x40, 0x95, 0x27, 
+0x47, 0x24, 0xEC, 0xF1, 0xF6, 0xCB, 0xF5, 0x2F, 
+0x4B, 0x0A, 0x0B, 0xFC, 0xAF, 0x4B, 0x58, 0x47, 
+0x20, 0x41, 0x5D, 0x38, 0x0F, 0xC5, 0xB2, 0x9C, 
+0x45, 0x89, 0xE6, 0x18, 0xCC, 0x95, 0x35, 0x41, 
+0x54, 0x47, 0x3A, 0xE6, 0x6E, 0x0C, 0xA5, 0x82, 
+0x7C, 0xEA, 0x17, 0x66, 0x85, 0x61, 0x39, 0xFD, 
+0x01, 0xBF, 0x88, 0xDE, 0xA1, 0x07, 0xFC, 0x20, 
+0x6E, 0x0E, 0x20, 0x8E, 0xF9, 0x34, 0x4E, 0xA4, 
+0xCE, 0x1B, 0xDA, 0xB4, 0xBB, 0x5A, 0x40, 0x94, 
+0x77, 0x02, 0xAA, 0x08, 0xF3, 0xDF, 0x0B, 0x1F, 
+0x7D, 0x1A, 0x07, 0x5E, 0x8A, 0xB9, 0x54, 0x00, 
+0xED, 0xD3, 0x69, 0xAA, 0x6D, 0x5B, 0xF3, 0xE5, 
+0x88, 0xEB, 0x66, 0xE5, 0xEF, 0x0B, 0x38, 0x0C, 
+0x9C, 0xA7, 0x04, 0x8E, 0x87, 0x08, 0x9C, 0x67, 
+0xA3, 0x26, 0x81, 0x4D, 0x63, 0x42, 0xAF, 0x3C, 
+0x0F, 
+
+0x00, 0xBD, 0xDA, 0xDC, 0x58, 0xB5, 0x9F, 0x21, 
+0xDD, 0x01, 0x1D, 0x00, 0x87, 0x25, 0x15, 0xDD, 
+0x26, 0x73, 0xBA, 0x00, 0xF1, 0x7D, 0xC9, 0x49, 
+0x03, 0x75, 0x8D, 0x2D, 0x65, 0x53, 0xE9, 0xC3, 
+0xE2, 0x47, 0x83, 0xFC, 0x61, 0x4B, 0x0F, 0x97, 
+0xFA, 0x83, 0xC6, 0xF7, 0x1C, 0x10, 0x75, 0x7A, 
+0xCF, 0x65, 0xAF, 0x8A, 0xCC, 0x4C, 0x8A, 0x81, 
+0xFD, 0x45, 0x33, 0xCF, 0x72, 0xA6, 0x3D, 0x2C, 
+0x0F, 0x7A, 0xDF, 0x1C, 0xF3, 0x41, 0xC2, 0xB8, 
+0x49, 0xB5, 0x0E, 0x3D, 0x73, 0x66, 0x30, 0x00, 
+0x81, 0x13, 0xCC, 0x1A, 0x9C, 0xE7, 0xEF, 0x37, 
+0x59, 0xC9, 0x60, 0x0F, 0xA8, 0x6A, 0xB9, 0x37, 
+0xC5, 0x1F, 0x3F, 0xB7, 0x39, 0xB3, 0x5F, 0x9E, 
+0xE9, 0x44, 0x02, 0x57, 0x6B, 0xDF, 0xB5, 0x77, 
+0x77, 0x34, 0xF1, 0x59, 0xCC, 0x6B, 0x0B, 0xD3, 
+0x58, 0x50, 0x32, 0xA9, 0xC9, 0xFA, 0xCE, 0xB9, 
+0x6D, 0x59, 0x4C, 0x81, 0x71, 0xB1, 0x74, 0xBE, 
+0xAC, 0x34, 0x95, 0x66, 0xD9, 0xA8, 0xBF, 0xCE, 
+0x19, 0x8E, 0xBE, 0xCF, 0xDA, 0x2F, 0xD9, 0x2A, 
+0xA9, 0x84, 0x1B, 0x2D, 0x7E, 0x99, 0xFF, 0xCD, 
+0x0C, 0x84, 0x8B, 0x7E, 0x80, 0x33, 0x22, 0xC4, 
+0x69, 0x02, 0xDF, 0x28, 0x6F, 0xAC, 0x0D, 0xED, 
+0x4B, 0x43, 0xB6, 0xCB, 0x17, 0x12, 0xF6, 0x29, 
+0xF9, 0x25, 0xF5, 0xA4, 0x6D, 0xA7, 0x63, 0x65, 
+0x97, 0x1F, 0xDB, 0x39, 0xFC, 0x93, 0x25, 0x90, 
+0xF4, 0x10, 0xB0, 0x90, 0x40, 0xD7, 0xEA, 0x67, 
+0xC4, 0xC0, 0x94, 0x67, 0x11, 0x94, 0x27, 0xF1, 
+0xEE, 0x11, 0x7C, 0xC3, 0xD8, 0xB2, 0xA7, 0xB0, 
+0xB3, 0x65, 0xF7, 0xEE, 0xB9, 0x66, 0x0E, 0xA8, 
+0x98, 0xF1, 0xCB, 0x69, 0xF1, 0x94, 0x36, 0xD3, 
+0xFF, 0x88, 0x23, 0x72, 0x38, 0x99, 0x3D, 0xB0, 
+0x4F, 0x42, 0x1A, 0x38, 0xA3, 0xCF, 0x2A, 0xD6, 
+0x6B, 0x46, 0xE5, 0xC9, 0x2E, 0x8A, 0x7F, 0xB2, 
+0x23, 0x6F, 0x24, 0x83, 0x9C, 0x4B, 0xCC, 0xEF, 
+0x9A, 0x01, 0x46, 0xEA, 0x0A, 0x59, 0xCD, 0x4D, 
+0x6C, 0x2E, 
+
+0x78, 0xF4, 0x15, 0x37, 0x79, 0x81, 0xAC, 0x40, 
+0x1A, 0x3C, 0x2C, 0x45, 0x09, 0x5C, 0x35, 0x4B, 
+0x6B, 0x4A, 0x71, 0xF3, 0x0E, 0x06, 0x82, 0x99, 
+0x81, 0x61, 0x33, 0xAF, 0x5A, 0x91, 0x60, 0x20, 
+0x29, 0xC6, 0xF0, 0xAA, 0x84, 0x48, 0xF7, 0x83, 
+0x11, 0xD0, 0x60, 0x0A, 0xE7, 0x6D, 0x38, 0x6E, 
+0x6E, 0xD1, 0x5C, 0xBF, 0x05, 0xAA, 0x3C, 0xE0, 
+0x56, 0x44, 0xC7, 0x01, 0xB8, 0xFC, 0xD6, 0xD4, 
+0x5B, 0x42, 0x3E, 0xA8, 0x0F, 0x4B, 0x72, 0xF9, 
+0xEE, 0xEE, 0x61, 0x75, 0x10, 0xF3, 0xC9, 0x40, 
+0xFF, 0x41, 0x9C, 0xE7, 0xBE, 0x4F, 0x11, 0x79, 
+0x83, 0xE9, 0xA2, 0xB1, 0xBA, 0xC8, 0x1A, 0x7E, 
+0xCD, 0x09, 0x37, 0xA4, 0x80, 0x65, 0x18, 0xA8, 
+0x1F, 0xFE, 0xBA, 0x8D, 0xFD, 0xC3, 0xF4, 0x25, 
+0x82, 0x90, 0x05, 0x78, 0x5A, 0xBA, 0xAD, 0x64, 
+0x72, 0xA9, 0x8A, 0x28, 0x2B, 0x4D, 0x60, 0xE1, 
+0xF1, 0x0E, 0x8C, 0xD8, 0x70, 0x20, 0x1E, 0xAC, 
+0x67, 0x45, 0x7E, 0x13, 0xD5, 0x3F, 0x7D, 0xAB, 
+0x76, 0xB8, 0x47, 0xCC, 0x5E, 0xAF, 0x75, 0xF8, 
+0x3D, 0x72, 0x2A, 0xB6, 0x24, 0xA9, 0x51, 0xAA, 
+0x58, 0xD2, 0x49, 0xD9, 0x7B, 0x2C, 0x34, 0x86, 
+0xE1, 0x24, 0x05, 0xC7, 0x82, 0xF6, 0xEB, 0xBE, 
+0xAD, 0xB9, 0x24, 0x98, 0xA3, 0x0E, 0xAC, 0x97, 
+0x8D, 0xCE, 0x79, 0x5D, 0xA1, 0x1C, 0xA8, 0xA6, 
+0xD0, 0xE8, 0x10, 0x48, 0xE6, 0x03, 0x44, 0xB2, 
+0xBE, 0x8F, 0xC8, 0x9A, 0x52, 0xC3, 0x18, 0x0B, 
+0xBD, 0x61, 0x29, 0x7D, 0xE5, 0x0C, 0x2E, 0x38, 
+0xC6, 0x15, 0xFB, 0x77, 0x71, 0x5D, 0x16, 0x27, 
+0x40, 0x31, 0x7C, 0x7D, 0x67, 0xAF, 0xD5, 0xD0, 
+0xD6, 0xBC, 0x34, 0x95, 0xF4, 0xA5, 0xA2, 0x83, 
+0x5A, 0xA5, 0x0F, 0x3A, 0x9C, 0x99, 0xAE, 0x87, 
+0x2D, 0x22, 0xF5, 0xA1, 0x37, 0x54, 0x0C, 0xBF, 
+0x86, 0x0F, 0x44, 0x2D, 0x91, 0xAD, 0x70, 0x64, 
+0xEB, 0xA0, 0x93, 0xB6, 0xE2, 0x36, 0xE0, 0x23, 
+0xAD, 0x5A, 0xB3, 0x42, 0x1F, 0x69, 0xD6, 0xE4, 
+0x2F, 0x89, 0xE9, 
+
+0xB2, 0x92, 0xA6, 0xC2, 0xB5, 0x5D, 0xEB, 0xD5, 
+0xB4, 0xA7, 0x78, 0x15, 0xD3, 0x35, 0xDA, 0x5B, 
+0x03, 0xAC, 0xD0, 0x51, 0x18, 0x50, 0x61, 0x70, 
+0x0F, 0x50, 0xD2, 0xD8, 0x68, 0xE9, 0x7E, 0xCB, 
+0xCF, 0x81, 0x9F, 0x4F, 0x07, 0xFF, 0xCA, 0x86, 
+0xDF, 0xAA, 0x44, 0x8B, 0xAC, 0xD3, 0x55, 0x3F, 
+0x81, 0x40, 0x0B, 0x1E, 0x97, 0x6B, 0x39, 0xFB, 
+0x0E, 0x92, 0x57, 0xF8, 0xF8, 0xFE, 0x73, 0xFE, 
+0x82, 0xA9, 0x62, 0x61, 0x98, 0xDE, 0x14, 0x49, 
+0xB3, 0x1B, 0xCC, 0x66, 0x24, 0x33, 0x21, 0x5E, 
+0x27, 0xDC, 0xD6, 0xD1, 0x84, 0x54, 0x2D, 0x89, 
+0x79, 0xBE, 0x2E, 0x2F, 0x10, 0xE2, 0xE5, 0x0E, 
+0x78, 0x22, 0x27, 0xBB, 0xE8, 0x4D, 0x2D, 0x43, 
+0x6B, 0x73, 0x80, 0x00, 0x68, 0x40, 0x45, 0x6D, 
+0xB0, 0x8D, 0xA4, 0x36, 0x30, 0xF3, 0x23, 0x0C, 
+0xE7, 0x3A, 0x5C, 0x99, 0xC6, 0x72, 0x12, 0xFD, 
+0x0E, 0x54, 0x26, 0xAC, 0x5E, 0xB9, 0xFA, 0x04, 
+0xCD, 0x21, 0x62, 0xE6, 0x36, 0x01, 0x63, 0x70, 
+0x13, 0x47, 0x75, 0x16, 0xDA, 0xE9, 0xB7, 0xB3, 
+0x9D, 0x31, 0x32, 0x4B, 0x3C, 0x4E, 0xE5, 0x5D, 
+0xE0, 0x0A, 0x4D, 0xD8, 0xBB, 0x40, 0xC2, 0x34, 
+0xC1, 0xD6, 0x18, 0x42, 0xB4, 0xEA, 0x59, 0x7A, 
+0xF5, 0xF9, 0xD5, 0x46, 0x49, 0x36, 0x3B, 0xC0, 
+0x72, 0x45, 0x9E, 0xC6, 0xB4, 0xFB, 0xC5, 0x66, 
+0x6C, 0x8B, 0x97, 0x01, 0xA7, 0x33, 0xAD, 0x88, 
+0xA4, 0xBF, 0xE4, 0xB7, 0x68, 0xA8, 0xB8, 0x20, 
+0x4B, 0xEB, 0x3A, 0x2B, 0x42, 0x6E, 0xA2, 0xD7, 
+0x6A, 0x5B, 0xE9, 0xAD, 0x4C, 0x50, 0xF4, 0x56, 
+0x7E, 0x36, 0xAA, 0x5D, 0xF4, 0x5F, 0x1D, 0x97, 
+0x45, 0x52, 0x09, 0xD4, 0xA5, 0xD2, 0xC6, 0x42, 
+0x2E, 0x14, 0x3E, 0xE1, 0xDB, 0xB1, 0xA3, 0xF4, 
+0x3F, 0x5D, 0x65, 0x3B, 0x37, 0x21, 0xD4, 0x6B, 
+0x8D, 0xDE, 0x84, 0x46, 0x82, 0x9B, 0xC3, 0xEE, 
+0x8A, 0xBA, 0xFF, 0x3F, 0x5A, 0xF6, 0xF4, 0x76, 
+0xDB, 0xA2, 0x8C, 0xF1, 0x68, 0xBE, 0x72, 0xC7, 
+0x5E, 0x76, 0x8C, 0xEF, 
+
+0x53, 0xF5, 0xCD, 0x4D, 0x8F, 0x7E, 0x78, 0xE1, 
+0x58, 0xD5, 0x84, 0xA3, 0x88, 0x9C, 0xC7, 0x8A, 
+0x5F, 0x19, 0x2F, 0xC3, 0x55, 0xFC, 0x64, 0xAB, 
+0x77, 0x77, 0xDD, 0xE2, 0x30, 0xCF, 0xEC, 0x48, 
+0x4E, 0x72, 0xB8, 0xBF, 0x9D, 0x6F, 0xA4, 0x41, 
+0x1D, 0x05, 0xA9, 0x50, 0x85, 0x53, 0x36, 0xE4, 
+0x80, 0xA8, 0xAB, 0x87, 0xFB, 0xC9, 0xEF, 0x61, 
+0x36, 0xF3, 0x13, 0xE2, 0xF1, 0xC3, 0x9A, 0x86, 
+0xA7, 0x25, 0x3B, 0x6B, 0xC3, 0xED, 0x97, 0xBE, 
+0xDC, 0x8F, 0x66, 0x4D, 0x1A, 0xFF, 0xFC, 0x7A, 
+0x7B, 0x84, 0x40, 0xA7, 0xE5, 0xAB, 0xBE, 0x0E, 
+0x41, 0x88, 0xF0, 0x6C, 0x55, 0x12, 0x47, 0xF0, 
+0x90, 0x77, 0xE3, 0x68, 0x77, 0x67, 0x42, 0x5A, 
+0x60, 0xD1, 0xB0, 0x96, 0x93, 0x59, 0x35, 0x5E, 
+0x01, 0xD1, 0xCB, 0x63, 0x19, 0x55, 0x61, 0x06, 
+0x5B, 0xD0, 0xEE, 0xD2, 0x89, 0x3B, 0x51, 0xA6, 
+0xEA, 0x04, 0x6B, 0x01, 0xB2, 0xC3, 0xCC, 0x59, 
+0x99, 0x49, 0x1C, 0x8C, 0x80, 0x7F, 0x42, 0xAC, 
+0xE3, 0xDE, 0x17, 0xF7, 0xF7, 0x42, 0x86, 0xB2, 
+0x8A, 0xC4, 0x91, 0x43, 0x29, 0xE6, 0x8C, 0x8E, 
+0x20, 0x7D, 0xDE, 0xD8, 0x07, 0x55, 0x32, 0xAF, 
+0xAF, 0x9E, 0x8C, 0x12, 0x7B, 0xAA, 0x68, 0xF2, 
+0xA6, 0x72, 0xE8, 0x49, 0xB9, 0x68, 0x27, 0x1B, 
+0xDC, 0xCA, 0xD6, 0x20, 0x73, 0x03, 0x26, 0x75, 
+0xEA, 0x5C, 0xF5, 0x42, 0x61, 0x9C, 0x33, 0x3A, 
+0x3A, 0xF5, 0xD5, 0xAA, 0xD7, 0xAE, 0x13, 0xAB, 
+0x15, 0x2A, 0xFF, 0x8D, 0xDE, 0xA0, 0x0A, 0x02, 
+0x4B, 0xEC, 0xB6, 0xBD, 0x28, 0x8C, 0xF1, 0x9C, 
+0x41, 0x34, 0x22, 0x1A, 0x62, 0xD7, 0xD3, 0xD3, 
+0x4B, 0xE1, 0xAF, 0x7F, 0x91, 0x2C, 0x52, 0x93, 
+0x65, 0x88, 0xB8, 0x5A, 0xD5, 0xD6, 0xA0, 0x23, 
+0x45, 0x2A, 0xB5, 0xDF, 0x88, 0x58, 0x18, 0x4E, 
+0x82, 0x50, 0x3C, 0xBE, 0x64, 0x16, 0xF2, 0x68, 
+0x49, 0x2E, 0x96, 0x6D, 0x3C, 0xA1, 0x89, 0x50, 
+0x0F, 0x6B, 0xF5, 0x50, 0x54, 0xA7, 0xD0, 0xB1, 
+0x22, 0x56, 0x12, 0x60, 0x01, 
+
+0xA7, 0xD5, 0xFC, 0x45, 0xA7, 0x79, 0x2D, 0x39, 
+0x04, 0xD7, 0xA2, 0x54, 0x4B, 0xE9, 0xE3, 0x0C, 
+0x6A, 0x8E, 0xE4, 0xF2, 0x68, 0xC8, 0x95, 0xB0, 
+0x73, 0x06, 0xC0, 0x39, 0xEA, 0x82, 0xC2, 0x08, 
+0x87, 0x42, 0x70, 0x3B, 0x0B, 0x65, 0x7B, 0x0B, 
+0xF6, 0xDA, 0x49, 0x69, 0x6C, 0x9D, 0x5F, 0xF4, 
+0x2D, 0x7D, 0x57, 0x0C, 0xB5, 0x9D, 0x4F, 0x9D, 
+0x68, 0x08, 0xAD, 0x7C, 0x16, 0xDC, 0x40, 0xA6, 
+0xFB, 0x74, 0x4A, 0x98, 0xD3, 0xBA, 0x59, 0xE6, 
+0x18, 0xBB, 0xC3, 0x81, 0x7C, 0x84, 0x8C, 0x82, 
+0x12, 0x29, 0x23, 0x59, 0x9F, 0x36, 0x96, 0x59, 
+0xFD, 0x07, 0xE4, 0x08, 0xFE, 0x1F, 0xEE, 0x93, 
+0xFF, 0x3B, 0xFC, 0x8B, 0x57, 0x85, 0x64, 0xF6, 
+0x6A, 0x5E, 0x61, 0xF1, 0xCF, 0xE4, 0x55, 0x17, 
+0x03, 0x32, 0x98, 0x1B, 0x3B, 0xF0, 0x5D, 0xA8, 
+0xF2, 0x7C, 0x58, 0x36, 0x38, 0x51, 0x5C, 0x0B, 
+0x55, 0x7D, 0x11, 0x24, 0x8A, 0x8A, 0xCA, 0xF3, 
+0x61, 0x39, 0x79, 0xA6, 0x0C, 0x70, 0x0E, 0x60, 
+0x13, 0x69, 0x98, 0xD5, 0xE7, 0x6B, 0x46, 0x33, 
+0xE7, 0x44, 0xA0, 0x9F, 0x7F, 0xE2, 0x86, 0x68, 
+0xB1, 0xA2, 0xA7, 0xE3, 0xFB, 0x1F, 0x49, 0x38, 
+0xA2, 0x7F, 0xBC, 0x01, 0x36, 0xE1, 0x06, 0x46, 
+0x6D, 0x62, 0xC4, 0x36, 0x3A, 0xE6, 0x99, 0x24, 
+0xAA, 0x14, 0xB1, 0xEB, 0x58, 0x87, 0xA2, 0xDB, 
+0x81, 0xE0, 0x16, 0x46, 0x45, 0x20, 0x66, 0x0A, 
+0x70, 0x80, 0x52, 0xA4, 0xDC, 0xBF, 0x34, 0xDC, 
+0xF3, 0xC3, 0xFB, 0xB6, 0xCD, 0x28, 0x25, 0x69, 
+0x89, 0x8E, 0xA1, 0x97, 0x46, 0x58, 0xCE, 0xD7, 
+0xD9, 0xF9, 0x5B, 0x2E, 0x95, 0x95, 0xC4, 0xC9, 
+0xB0, 0x89, 0x99, 0x42, 0x49, 0xA2, 0x86, 0x49, 
+0xCE, 0xCC, 0xF7, 0x34, 0x42, 0x65, 0x7F, 0xF4, 
+0xA2, 0xB3, 0x52, 0x69, 0x9F, 0xF1, 0xBA, 0xA9, 
+0x3C, 0x8A, 0xBF, 0x77, 0xE6, 0x00, 0x6F, 0x93, 
+0xC1, 0xC7, 0xFA, 0xEA, 0xD5, 0x0F, 0x63, 0xC6, 
+0xE3, 0x60, 0xC0, 0x9F, 0x2F, 0x46, 0x4C, 0xDF, 
+0xB7, 0x54, 0xD4, 0xD2, 0x0A, 0x68, 
+
+0x2B, 0xC2, 0xF6, 0xDD, 0xD6, 0xB4, 0x48, 0xEA, 
+0x19, 0xFC, 0x65, 0x40, 0x79, 0x4C, 0x90, 0xBE, 
+0xDC, 0x92, 0xC8, 0x61, 0xCD, 0xEC, 0x77, 0x90, 
+0x5D, 0x57, 0xE1, 0x0B, 0xC7, 0xB1, 0xDF, 0xAD, 
+0xC1, 0x81, 0x98, 0xF9, 0x24, 0xEC, 0xCB, 0xE6, 
+0x24, 0xE8, 0x55, 0x5B, 0xA9, 0x40, 0xDD, 0x13, 
+0xE3, 0x72, 0x18, 0x43, 0xE7, 0xF9, 0x3A, 0x51, 
+0x83, 0x67, 0x79, 0xEB, 0x2F, 0xB6, 0xDE, 0xB7, 
+0xF3, 0xC8, 0x5F, 0x61, 0xB4, 0xB4, 0xE1, 0xFD, 
+0x16, 0xD5, 0xAC, 0xC7, 0xDE, 0xFC, 0x58, 0x25, 
+0xDE, 0x9C, 0xB6, 0xCE, 0x6D, 0xB5, 0xBB, 0xD2, 
+0xB7, 0xB4, 0x15, 0x55, 0x28, 0xAE, 0xAE, 0x41, 
+0xBE, 0x57, 0xCD, 0x76, 0x1C, 0x1C, 0x9E, 0x09, 
+0x3C, 0x26, 0xE1, 0xE4, 0x98, 0xDE, 0x96, 0xAF, 
+0x7F, 0xCE, 0xE3, 0x3F, 0x1A, 0x63, 0xCB, 0x68, 
+0xAA, 0xF7, 0xF1, 0x17, 0xD1, 0xF3, 0xF1, 0xE2, 
+0x60, 0x4C, 0x05, 0x25, 0x13, 0xF6, 0xA4, 0xF0, 
+0x3C, 0x2C, 0x8D, 0x1F, 0xCB, 0xF7, 0x05, 0x2D, 
+0x11, 0x88, 0x68, 0x6B, 0x3A, 0xD6, 0xEB, 0x02, 
+0x54, 0xA1, 0x3A, 0xEB, 0x9B, 0xD7, 0x92, 0xA8, 
+0xFB, 0xDB, 0xFC, 0xE2, 0x8D, 0x68, 0x8B, 0x36, 
+0x2D, 0x56, 0x0C, 0x4F, 0xB1, 0x8D, 0xA5, 0xCE, 
+0x6E, 0xB8, 0xB6, 0xAD, 0x18, 0x4B, 0x32, 0x55, 
+0xE0, 0xBD, 0xEB, 0xB9, 0x2F, 0x20, 0xCC, 0x1A, 
+0x6B, 0x28, 0x63, 0x2A, 0xD1, 0xBC, 0xE7, 0x3E, 
+0x57, 0x73, 0x81, 0xC9, 0x27, 0xFC, 0xE9, 0x6F, 
+0x9A, 0x35, 0x89, 0xD4, 0x2D, 0x55, 0xF5, 0x20, 
+0x20, 0xB4, 0x62, 0xE9, 0x5E, 0x08, 0x6E, 0x9F, 
+0xA5, 0x12, 0x33, 0x96, 0xDF, 0x2C, 0xD7, 0xFD, 
+0x10, 0xC4, 0xAA, 0x4E, 0xC4, 0x3D, 0x02, 0x01, 
+0x4F, 0xBA, 0x94, 0x22, 0x9B, 0xE4, 0x18, 0xD3, 
+0x00, 0x31, 0xF2, 0xD8, 0xE2, 0x4A, 0xF1, 0xD6, 
+0x2B, 0x5D, 0xFD, 0x2E, 0xED, 0x42, 0x33, 0x8C, 
+0x77, 0x3F, 0xCB, 0xF6, 0x91, 0x5F, 0xCA, 0x0D, 
+0x9D, 0x7D, 0x8A, 0x1E, 0x34, 0xBE, 0xEF, 0x33, 
+0x54, 0x08, 0x3C, 0x4F, 0x21, 0x46, 0xD4, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,4966 @@
+package utils
+func getkat6441_v30_t128(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xE2, 0xF7, 0xF3, 0xC4, 0x28, 0x37, 0xE2, 0xCC, 
+0x26, 0xDD, 0x1E, 0x7C, 0xF8, 0xE8, 0xED, 0x1B, 
+
+0x45, 0x27, 0x4C, 0x29, 0x5F, 0x5B, 0x62, 0x70, 
+0x47, 0x0E, 0x0E, 0x76, 0xCF, 0xAC, 0xA9, 0x95, 
+0x64, 
+
+0x47, 0xF7, 0x63, 0xFB, 0x7F, 0x5D, 0x21, 0x37, 
+0xB1, 0xDA, 0x20, 0x19, 0x98, 0x06, 0x00, 0x61, 
+0x5F, 0x97, 
+
+0x0D, 0x10, 0x8B, 0x8E, 0x26, 0xD1, 0x22, 0xBB, 
+0x09, 0xE3, 0x69, 0xE8, 0x70, 0xAB, 0x25, 0x2E, 
+0xB2, 0x66, 0xB1, 
+
+0x8C, 0xEC, 0x29, 0x15, 0x99, 0xD9, 0x85, 0xAC, 
+0x46, 0x48, 0x5F, 0x6D, 0xF6, 0xC9, 0x4C, 0xB0, 
+0x8F, 0xFF, 0x98, 0xE5, 
+
+0xD2, 0x2E, 0x27, 0xE6, 0xEF, 0x20, 0x23, 0x4D, 
+0xCF, 0x95, 0xAA, 0xC6, 0xEC, 0xB7, 0x3E, 0x96, 
+0x93, 0x1D, 0xFA, 0xF3, 0x11, 
+
+0x86, 0xDE, 0x1C, 0xDF, 0x61, 0xC2, 0xD2, 0xC7, 
+0xAB, 0xB1, 0x3E, 0xE4, 0x26, 0x47, 0xB1, 0xD8, 
+0x5E, 0xC8, 0x61, 0xD4, 0xC6, 0xCB, 
+
+0x18, 0x88, 0x84, 0xC9, 0xB2, 0x15, 0x34, 0x5A, 
+0xFB, 0x2D, 0xC4, 0xF9, 0x94, 0xE3, 0x4C, 0x44, 
+0x27, 0xE9, 0x60, 0x83, 0x2B, 0x62, 0xD0, 
+
+0x09, 0x02, 0xD1, 0x60, 0x44, 0x08, 0x29, 0xEE, 
+0x7F, 0x9B, 0xDC, 0xA8, 0xC7, 0xC2, 0x81, 0x42, 
+0x4A, 0x1B, 0xD3, 0xEC, 0x7B, 0x8E, 0xED, 0xE8, 
+
+0x3A, 0x92, 0xC8, 0x03, 0xB4, 0x74, 0x2C, 0x41, 
+0xDC, 0x1A, 0x19, 0xEB, 0xB7, 0x31, 0x07, 0x6E, 
+0x24, 0xCC, 0x8C, 0xA8, 0x88, 0x96, 0x43, 0x9F, 
+0x25, 
+
+0x69, 0xC8, 0xC3, 0x79, 0xBB, 0x47, 0xD9, 0xE5, 
+0xE7, 0x6E, 0x43, 0x6F, 0xA6, 0xC5, 0x7F, 0x8E, 
+0x4F, 0xA1, 0x29, 0x4A, 0x6F, 0x3A, 0xAA, 0x21, 
+0x3B, 0x11, 
+
+0x93, 0x3A, 0xBC, 0x60, 0xEF, 0x24, 0x67, 0x31, 
+0x4F, 0x23, 0x8C, 0x1B, 0x7B, 0x05, 0xD5, 0xBE, 
+0x21, 0xA6, 0xC3, 0x32, 0xB7, 0x5E, 0x8B, 0x64, 
+0x98, 0xF9, 0x62, 
+
+0x58, 0xC8, 0x09, 0xF4, 0x39, 0x82, 0xEF, 0xFB, 
+0xF2, 0x3A, 0x72, 0xB2, 0x50, 0x36, 0xFD, 0x97, 
+0xF9, 0x5B, 0xB0, 0x49, 0x11, 0xB2, 0x9B, 0x17, 
+0xF9, 0x11, 0x11, 0x16, 
+
+0x63, 0xBF, 0x91, 0x65, 0x1F, 0x31, 0xEC, 0xB4, 
+0xEA, 0xBD, 0xED, 0x56, 0x23, 0x56, 0x7C, 0x78, 
+0x0A, 0x1A, 0xD4, 0x51, 0xC3, 0x80, 0xCD, 0xEC, 
+0x9C, 0x6A, 0xA0, 0xD1, 0x82, 
+
+0x38, 0xF0, 0xBC, 0xB8, 0x23, 0x4D, 0x65, 0x42, 
+0xA6, 0xF4, 0x18, 0xC5, 0xAC, 0x89, 0x4D, 0xE8, 
+0x0C, 0x3E, 0xD3, 0x8C, 0xCF, 0x0D, 0x92, 0x61, 
+0x1B, 0xC1, 0xEB, 0x01, 0xDB, 0xCE, 
+
+0x49, 0x9A, 0x2E, 0xBD, 0x41, 0x46, 0x82, 0xBD, 
+0xE4, 0xCB, 0x69, 0x7D, 0xBA, 0xAF, 0xB8, 0x07, 
+0xD4, 0xE6, 0x93, 0x3D, 0x17, 0x00, 0x1F, 0xFD, 
+0xAE, 0x50, 0x0D, 0x99, 0xCE, 0xCE, 0x30, 
+
+0x84, 0x4A, 0x83, 0xEA, 0xC4, 0xE6, 0x1F, 0xB1, 
+0x4E, 0xFF, 0x08, 0xC1, 0x46, 0xD1, 0x27, 0x6F, 
+0x4F, 0x77, 0x30, 0x6D, 0x10, 0xE5, 0x95, 0x39, 
+0x2E, 0x67, 0x71, 0xA1, 0x86, 0x6C, 0x2F, 0x07, 
+
+0x5A, 0xB1, 0x9C, 0x7C, 0x4D, 0x62, 0xBC, 0xA3, 
+0xDA, 0x42, 0xE5, 0x31, 0x07, 0x1A, 0x04, 0x2E, 
+0x37, 0x24, 0x30, 0xE5, 0xC1, 0x35, 0x2D, 0x4A, 
+0x19, 0x61, 0xB1, 0xA7, 0xF0, 0x2F, 0x5E, 0xEA, 
+0x7B, 
+
+0x3C, 0x50, 0xFE, 0x5B, 0x8A, 0xB2, 0x13, 0xBE, 
+0xD7, 0x67, 0xED, 0xC0, 0xB9, 0xA0, 0x9C, 0xCF, 
+0xB7, 0xFB, 0xE1, 0x1A, 0x9D, 0xD4, 0x1F, 0xD4, 
+0xCF, 0x08, 0xFB, 0x82, 0x54, 0x17, 0x73, 0xBD, 
+0x43, 0xD8, 
+
+0xA9, 0xDE, 0x54, 0x64, 0x79, 0xE3, 0xDB, 0xFA, 
+0xA8, 0x94, 0x38, 0xFC, 0x1F, 0x54, 0xD5, 0xB3, 
+0x8B, 0x5F, 0x3C, 0x44, 0xD9, 0x2D, 0xFE, 0xC5, 
+0x9F, 0x0B, 0x4C, 0xFC, 0x11, 0x77, 0x9C, 0x1C, 
+0x96, 0x2E, 0x6F, 
+
+0xC5, 0x4E, 0xBB, 0x48, 0xAC, 0x64, 0x17, 0x0B, 
+0x5D, 0xD7, 0xAC, 0xCB, 0xF5, 0x46, 0xC7, 0xA4, 
+0x2D, 0xE1, 0xAF, 0x28, 0x7E, 0x91, 0xC0, 0xF1, 
+0x2C, 0xA7, 0x43, 0xEC, 0x7F, 0x8B, 0x8C, 0x18, 
+0x33, 0x21, 0x65, 0xAE, 
+
+0x7D, 0x02, 0xC0, 0x49, 0xC9, 0x10, 0x34, 0x86, 
+0x08, 0x12, 0x09, 0x19, 0xB6, 0xCF, 0xAD, 0x95, 
+0x83, 0xA2, 0xC1, 0xCD, 0xD2, 0xCE, 0x88, 0x3A, 
+0xED, 0x87, 0xC0, 0xF0, 0x82, 0x17, 0x2C, 0xCD, 
+0x25, 0xB0, 0xED, 0xCD, 0x95, 
+
+0x33, 0xDD, 0x76, 0xC0, 0xBA, 0x96, 0x7B, 0xB0, 
+0x99, 0xEB, 0x49, 0xF3, 0xD6, 0x8C, 0xBE, 0x6D, 
+0xBB, 0xA3, 0x80, 0x5B, 0xB8, 0xB2, 0x05, 0x04, 
+0xF2, 0xC2, 0x79, 0x3B, 0xF9, 0xE1, 0xCB, 0x0C, 
+0xE0, 0xCF, 0x90, 0xA7, 0x80, 0xB6, 
+
+0x6F, 0x91, 0x84, 0x20, 0x49, 0xEC, 0x5D, 0x07, 
+0xE0, 0x6D, 0x7B, 0x9C, 0x4E, 0xB7, 0x3A, 0x71, 
+0xA0, 0xE3, 0x86, 0xF6, 0x67, 0xC3, 0x71, 0xF7, 
+0x57, 0x79, 0xEE, 0x38, 0x92, 0xEF, 0x0A, 0xE3, 
+0x90, 0xBB, 0x0C, 0xE9, 0x2C, 0xF0, 0x03, 
+
+0x48, 0x9E, 0x7D, 0x05, 0x64, 0xC7, 0x0D, 0xDA, 
+0x72, 0x8D, 0x7A, 0x76, 0x2A, 0xE8, 0x55, 0xCF, 
+0xE8, 0x00, 0x29, 0xEB, 0x59, 0xB4, 0x69, 0xA2, 
+0x04, 0x22, 0x96, 0x37, 0xD6, 0x49, 0xCA, 0xD8, 
+0x94, 0x9D, 0x6D, 0x77, 0xCB, 0x3A, 0xCB, 0xDB, 
+
+0xFE, 0x89, 0x30, 0x06, 0x2E, 0x9A, 0x45, 0x8A, 
+0x75, 0x19, 0xD6, 0xA0, 0xEF, 0x6F, 0x58, 0xF3, 
+0xEA, 0xF8, 0x39, 0xD7, 0xB8, 0x26, 0xE2, 0x3A, 
+0x67, 0x7A, 0x21, 0xEB, 0xDA, 0xD4, 0x10, 0xF7, 
+0x67, 0xF1, 0x48, 0x95, 0x0D, 0xA6, 0x31, 0x7A, 
+0xB7, 
+
+0xE5, 0xB3, 0x68, 0xC3, 0xC8, 0x9F, 0x2A, 0x3F, 
+0xB0, 0x1C, 0x77, 0xB9, 0x40, 0x71, 0x73, 0xB4, 
+0xD0, 0xBE, 0xBC, 0x46, 0x6B, 0x43, 0x0E, 0x51, 
+0xEF, 0xCD, 0x1B, 0xFA, 0x89, 0xF2, 0x55, 0x60, 
+0x66, 0x75, 0x20, 0x1D, 0xDA, 0x35, 0x72, 0x37, 
+0x6D, 0x1E, 
+
+0x43, 0x55, 0xE2, 0x55, 0x55, 0x9F, 0xCE, 0x78, 
+0x53, 0xF8, 0xCB, 0x4D, 0xA8, 0xC3, 0x0E, 0xC5, 
+0xF4, 0x85, 0x1B, 0xD2, 0x15, 0x2B, 0xF5, 0x66, 
+0x16, 0xFE, 0x70, 0x0F, 0xB3, 0x3D, 0x62, 0xF5, 
+0xA8, 0xA9, 0x9F, 0x54, 0x63, 0x2B, 0x29, 0xAB, 
+0x24, 0x77, 0x54, 
+
+0xF0, 0x65, 0x0F, 0x9B, 0x6C, 0xF6, 0x3F, 0x4A, 
+0x81, 0xCF, 0x33, 0x41, 0xDC, 0xA8, 0xC5, 0xE5, 
+0x62, 0x01, 0x12, 0x20, 0x76, 0x84, 0xF0, 0x31, 
+0x7F, 0xC5, 0x29, 0x53, 0xC5, 0x34, 0xE3, 0xC1, 
+0x8D, 0xF7, 0x6A, 0x29, 0x29, 0x6C, 0x0D, 0x47, 
+0x42, 0xDC, 0xC5, 0xA3, 
+
+0x55, 0xC0, 0xE8, 0xED, 0x7C, 0xEC, 0xC4, 0xF4, 
+0xD7, 0x98, 0x00, 0x85, 0xAD, 0x71, 0xD9, 0x4F, 
+0x8C, 0xDF, 0x01, 0x70, 0x80, 0x40, 0x2F, 0x2C, 
+0x09, 0xA5, 0x5F, 0x8C, 0x0E, 0x2A, 0xA7, 0xE8, 
+0x14, 0x05, 0x02, 0x77, 0xD6, 0xF4, 0x88, 0x41, 
+0xA9, 0x94, 0x1B, 0x0D, 0x3B, 
+
+0x6E, 0x66, 0x72, 0x5F, 0x3C, 0xBA, 0x84, 0x3B, 
+0x0E, 0xC4, 0xB1, 0x29, 0x30, 0xE7, 0x66, 0x9F, 
+0x45, 0x68, 0x12, 0x2D, 0x3C, 0x66, 0x74, 0x84, 
+0xF3, 0xEF, 0xCD, 0xE1, 0xC8, 0x58, 0x1E, 0x7D, 
+0x7E, 0x6D, 0x3B, 0x48, 0xD4, 0xC3, 0x5C, 0x14, 
+0x4A, 0xF9, 0xBA, 0xB6, 0x39, 0x6C, 
+
+0x7D, 0xFE, 0xD3, 0xE4, 0x23, 0x86, 0x64, 0x77, 
+0xB1, 0x83, 0x2D, 0xB8, 0x97, 0xEE, 0x3F, 0x13, 
+0x0A, 0x85, 0xE9, 0xEF, 0x56, 0x91, 0x0C, 0x6C, 
+0x53, 0x52, 0xE5, 0x43, 0x0F, 0xD6, 0x75, 0x8F, 
+0x99, 0xFA, 0x8E, 0x4B, 0x9E, 0x96, 0xAE, 0x69, 
+0xA5, 0x1F, 0x8F, 0x04, 0xF6, 0xFF, 0x00, 
+
+0xD1, 0xED, 0xFB, 0x4B, 0x5D, 0x86, 0x8B, 0xEE, 
+0x3A, 0xF8, 0xD5, 0xE6, 0x5B, 0x8E, 0xD7, 0xC4, 
+0x08, 0x1D, 0x93, 0x9F, 0x62, 0x83, 0x31, 0x95, 
+0x86, 0xA9, 0x63, 0x8A, 0x1F, 0x22, 0x9B, 0x30, 
+0x38, 0xBF, 0xC8, 0x54, 0xCB, 0xE9, 0x8C, 0xD5, 
+0xE9, 0x58, 0x0C, 0x04, 0xE4, 0x85, 0xB5, 0xED, 
+
+0x85, 0x14, 0x33, 0xD8, 0x60, 0x96, 0xDC, 0xD0, 
+0xE2, 0xB7, 0xD1, 0x7F, 0xEC, 0x59, 0xE6, 0x90, 
+0x24, 0x17, 0x2A, 0x08, 0x58, 0xEC, 0xC4, 0xB5, 
+0xAA, 0x61, 0xAE, 0x89, 0x05, 0xFC, 0x2F, 0x1E, 
+0xCA, 0xF5, 0xE3, 0x42, 0x9C, 0x88, 0xA0, 0x15, 
+0xC9, 0xA0, 0xC6, 0x40, 0x53, 0x1E, 0x8B, 0x3D, 
+0x9D, 
+
+0x68, 0x48, 0x7B, 0xB0, 0x45, 0x50, 0x1A, 0x8A, 
+0x33, 0x63, 0x43, 0x7D, 0xA7, 0x45, 0x7E, 0xA1, 
+0xCE, 0x55, 0x7B, 0xE7, 0xC6, 0x4F, 0xB8, 0xAD, 
+0x47, 0xBE, 0x1F, 0x24, 0x51, 0x87, 0x57, 0x56, 
+0x5A, 0x62, 0xDD, 0x2F, 0xF2, 0xA9, 0x43, 0xA0, 
+0xBD, 0x47, 0x0B, 0xD4, 0x7B, 0x4E, 0x71, 0xCF, 
+0x5A, 0xE8, 
+
+0xD5, 0x6E, 0x77, 0xEA, 0xA7, 0x4B, 0xC8, 0xDF, 
+0x4A, 0x86, 0xE6, 0x2F, 0x75, 0xC3, 0x40, 0xA2, 
+0x02, 0x00, 0x6D, 0xA7, 0x1E, 0xBC, 0x8F, 0xF1, 
+0x2B, 0xE4, 0x31, 0xB5, 0xE1, 0x0B, 0x79, 0x93, 
+0x3B, 0xC2, 0xCC, 0x9D, 0x67, 0x28, 0x72, 0x62, 
+0x14, 0x86, 0x51, 0x1E, 0xD8, 0x5C, 0x0A, 0x6A, 
+0x56, 0xF6, 0x26, 
+
+0xFF, 0x00, 0x14, 0x97, 0xB6, 0x50, 0x72, 0xBC, 
+0x38, 0x10, 0x54, 0x02, 0x79, 0xAB, 0xD3, 0x56, 
+0x22, 0x23, 0x24, 0xFA, 0xA8, 0x7B, 0x5B, 0xDA, 
+0xB1, 0x27, 0x7B, 0x22, 0x32, 0x5C, 0x8B, 0x18, 
+0x63, 0x4C, 0x23, 0xE3, 0x54, 0x79, 0x56, 0x60, 
+0x05, 0x81, 0xEE, 0x78, 0x24, 0xAE, 0x26, 0x59, 
+0x87, 0x87, 0xE2, 0x4D, 
+
+0xEC, 0x1C, 0xB6, 0x38, 0xAF, 0x5C, 0xE1, 0xFC, 
+0x92, 0x0F, 0xC9, 0x94, 0xCC, 0x51, 0x46, 0x75, 
+0x73, 0x90, 0xF5, 0x86, 0x8A, 0x54, 0x2C, 0xED, 
+0xEA, 0x81, 0x2E, 0x46, 0x44, 0x56, 0x38, 0x01, 
+0x5A, 0x7A, 0x60, 0xA6, 0x94, 0xD3, 0x1D, 0x31, 
+0x98, 0xCB, 0x11, 0xD8, 0xB9, 0x43, 0x63, 0x41, 
+0x60, 0xB9, 0x65, 0x04, 0x50, 
+
+0xB3, 0x09, 0xE3, 0xC7, 0xE5, 0xEB, 0xE2, 0x81, 
+0x3D, 0x53, 0x04, 0x93, 0xD0, 0x40, 0x70, 0x16, 
+0x9D, 0x2B, 0x6F, 0x29, 0x4B, 0x02, 0xAB, 0xCE, 
+0x1E, 0x08, 0x18, 0x89, 0xE5, 0x17, 0xE0, 0xA7, 
+0xD6, 0xCF, 0x95, 0x26, 0xDE, 0xC1, 0xC5, 0xFC, 
+0xC8, 0xAC, 0xCA, 0x25, 0x3A, 0xDB, 0xF4, 0xD3, 
+0x26, 0xF5, 0x91, 0xC6, 0x9B, 0x6A, 
+
+0x18, 0x86, 0x8A, 0x5D, 0xA6, 0xE5, 0xB2, 0x1D, 
+0x5D, 0xE7, 0xAF, 0x04, 0x89, 0x76, 0xF1, 0x31, 
+0xD3, 0xFC, 0x9D, 0x08, 0x87, 0x8E, 0x85, 0x2B, 
+0x7A, 0x40, 0x23, 0x34, 0xC1, 0xC6, 0x30, 0x26, 
+0x05, 0xFF, 0x20, 0xE8, 0x6B, 0xF7, 0x52, 0x22, 
+0x39, 0x1D, 0x9E, 0xA3, 0x3E, 0xC4, 0x4B, 0xF0, 
+0x52, 0x27, 0x20, 0x0C, 0xBC, 0x57, 0x56, 
+
+0xCF, 0x1F, 0xFC, 0xB1, 0x55, 0xE6, 0xAA, 0xC0, 
+0xFC, 0x75, 0x6C, 0xE0, 0x3F, 0x64, 0x63, 0x6D, 
+0x57, 0x86, 0xBF, 0xF7, 0xAC, 0x2D, 0x12, 0xA1, 
+0xB3, 0x41, 0x5B, 0xC5, 0xF2, 0x49, 0x9C, 0x99, 
+0x22, 0xEA, 0x23, 0x06, 0xBB, 0x1A, 0xA8, 0x03, 
+0xAC, 0x45, 0xD0, 0x30, 0x79, 0x27, 0x69, 0xB8, 
+0xF5, 0xC3, 0x8A, 0x0C, 0x4B, 0xF4, 0x2B, 0x7F, 
+
+0xBA, 0xD0, 0x0B, 0x61, 0xA2, 0x99, 0xF8, 0x0D, 
+0xED, 0xD6, 0x71, 0xBB, 0x07, 0x37, 0x4B, 0xB4, 
+0x23, 0xCF, 0xB8, 0xE6, 0x4A, 0xD2, 0x6B, 0x98, 
+0x58, 0xED, 0x9C, 0x7B, 0xFC, 0xD0, 0xC3, 0x4C, 
+0xA2, 0x7D, 0xF2, 0x26, 0xE6, 0x4F, 0xF7, 0xB1, 
+0x96, 0x06, 0x99, 0xD8, 0x2E, 0x09, 0xB0, 0xA7, 
+0x2A, 0x14, 0x20, 0xDE, 0xAA, 0x52, 0x59, 0x44, 
+0x22, 
+
+0x6B, 0x9E, 0x8A, 0x3D, 0xD2, 0xB0, 0x6C, 0x45, 
+0xBB, 0x30, 0x5B, 0xFA, 0xE5, 0x73, 0x28, 0x80, 
+0xA0, 0x29, 0xA2, 0x03, 0x1B, 0x37, 0xE7, 0x55, 
+0x6B, 0x45, 0x4F, 0x17, 0xAF, 0xCF, 0x5B, 0xA3, 
+0x5D, 0x6E, 0xFA, 0x82, 0x60, 0xBB, 0x37, 0xFB, 
+0xAF, 0xB6, 0x83, 0x47, 0x94, 0x1A, 0xA3, 0x1E, 
+0x3B, 0xDC, 0x28, 0x72, 0x9A, 0xFC, 0xC0, 0xDA, 
+0xA2, 0x32, 
+
+0xCE, 0xD4, 0x12, 0x5A, 0x6F, 0xF6, 0x1C, 0x6B, 
+0xF1, 0xEE, 0xC3, 0x8B, 0x56, 0x13, 0xB8, 0x1C, 
+0x96, 0xE4, 0x04, 0xD7, 0x79, 0xD3, 0xB2, 0xED, 
+0x36, 0x97, 0x56, 0xC0, 0xE4, 0x19, 0xDD, 0xB2, 
+0x9A, 0xF3, 0x15, 0xC9, 0xFD, 0xC1, 0xD6, 0xE4, 
+0xE5, 0xB1, 0xA8, 0x44, 0xC2, 0x83, 0x7D, 0xAA, 
+0x9E, 0xE9, 0x9E, 0xB4, 0x9D, 0x82, 0x7E, 0x26, 
+0xC0, 0xF3, 0x97, 
+
+0x7F, 0x44, 0xAB, 0x6D, 0xC7, 0xF1, 0xA1, 0xAF, 
+0x05, 0xBB, 0xFE, 0x08, 0xC6, 0x14, 0x39, 0xAF, 
+0x2D, 0x26, 0xA5, 0xD1, 0xA1, 0xA9, 0xA6, 0x3F, 
+0xC1, 0x5F, 0x64, 0xF1, 0x0E, 0x7E, 0x6C, 0x48, 
+0xE1, 0x26, 0x0C, 0x4C, 0xE3, 0x52, 0x1F, 0x91, 
+0x00, 0x7C, 0xAD, 0x2E, 0xA3, 0xB2, 0xCF, 0xC4, 
+0x11, 0x7D, 0x34, 0xED, 0xE2, 0x03, 0x3A, 0xF7, 
+0x07, 0x96, 0x46, 0x74, 
+
+0x75, 0xAC, 0x63, 0x0E, 0x1B, 0xAE, 0x02, 0x2A, 
+0x88, 0x32, 0xD6, 0x8C, 0x4E, 0xDC, 0xAC, 0xB3, 
+0x25, 0x96, 0xFF, 0x2E, 0x20, 0xEC, 0x1B, 0x9B, 
+0x08, 0x37, 0x40, 0xE2, 0xC0, 0xE9, 0xED, 0x0C, 
+0x61, 0xAC, 0xF1, 0xFA, 0x00, 0x74, 0xFA, 0x91, 
+0x8E, 0x3D, 0x01, 0x92, 0x17, 0xE7, 0x62, 0x21, 
+0x08, 0x9C, 0x80, 0x28, 0x1C, 0x18, 0x1D, 0x3F, 
+0x36, 0x8E, 0xDA, 0x1C, 0x6F, 
+
+0x98, 0x59, 0xF8, 0x59, 0xE0, 0x9F, 0xE2, 0x78, 
+0xA6, 0x95, 0xA1, 0x9A, 0x1C, 0xF9, 0x78, 0x44, 
+0x81, 0x3B, 0x59, 0xA1, 0xCE, 0x9B, 0x53, 0x81, 
+0x98, 0xC6, 0x49, 0x37, 0xD3, 0x01, 0x45, 0x5E, 
+0xAC, 0x1C, 0x8A, 0x97, 0x90, 0x10, 0xE1, 0xA5, 
+0x82, 0x4D, 0x77, 0x8B, 0xE7, 0x5F, 0x13, 0xA4, 
+0x74, 0xB4, 0xDD, 0xE8, 0xF6, 0x68, 0x83, 0xB9, 
+0xC1, 0xBE, 0x07, 0x7F, 0xE7, 0x89, 
+
+0x17, 0x23, 0xE8, 0xC1, 0xD6, 0x0D, 0x7F, 0xCE, 
+0xAE, 0x5F, 0x5C, 0xB7, 0x8E, 0x28, 0xB4, 0x63, 
+0x1B, 0xCF, 0x71, 0x7C, 0x15, 0x1D, 0x5F, 0x8D, 
+0x49, 0x03, 0xD0, 0xA1, 0xD2, 0x6A, 0xBF, 0x0E, 
+0xF5, 0x7E, 0x72, 0x9E, 0xDA, 0xA6, 0xFC, 0x7A, 
+0xF7, 0xC1, 0x11, 0x46, 0x5C, 0x84, 0x67, 0x3E, 
+0x9B, 0xBF, 0xC4, 0x49, 0x08, 0x05, 0x9E, 0x7A, 
+0xAF, 0xBD, 0x2C, 0x84, 0x71, 0xA0, 0xDC, 
+
+0x6F, 0xFA, 0x7D, 0x61, 0x4E, 0x27, 0x1E, 0xC8, 
+0x5A, 0x62, 0xC4, 0x68, 0x27, 0xDE, 0x0E, 0xEE, 
+0x0A, 0xE2, 0x82, 0x36, 0xB1, 0x0D, 0x64, 0x76, 
+0xC0, 0xA4, 0xB0, 0x7B, 0x5E, 0xC3, 0xFE, 0x69, 
+0x08, 0x66, 0x8E, 0x25, 0x44, 0xC7, 0x2C, 0xA9, 
+0xCE, 0xD3, 0x7C, 0x79, 0xDC, 0x26, 0xE8, 0x84, 
+0x4E, 0x19, 0xF4, 0xD7, 0xAF, 0x1E, 0x92, 0x36, 
+0xBE, 0x92, 0xE4, 0x31, 0x39, 0x8D, 0x9C, 0xC3, 
+
+0xDC, 0x91, 0x70, 0x18, 0x62, 0xC4, 0x54, 0x1B, 
+0xAC, 0x3E, 0xDE, 0x0D, 0xF8, 0x3B, 0xE1, 0xD0, 
+0xDB, 0x75, 0x69, 0x3D, 0x35, 0x55, 0xD9, 0xDD, 
+0xC5, 0x01, 0x7C, 0x56, 0xB2, 0x64, 0xF4, 0xC5, 
+0x2B, 0x7F, 0xDC, 0x75, 0xF6, 0xB5, 0x71, 0xF9, 
+0x04, 0xC0, 0x52, 0xD7, 0x1A, 0xAF, 0xAC, 0x0F, 
+0x17, 0xA9, 0x6A, 0xD2, 0x5E, 0x10, 0xA6, 0x8F, 
+0x19, 0x0B, 0x0D, 0xA1, 0xBF, 0xB7, 0x3F, 0xEC, 
+0x30, 
+
+0x94, 0xE0, 0x50, 0x60, 0x2E, 0xED, 0xA0, 0x62, 
+0xAF, 0x83, 0xC7, 0x2D, 0xE2, 0x18, 0xE9, 0xC4, 
+0xFE, 0xCF, 0x59, 0x44, 0x85, 0xFD, 0x1A, 0x9E, 
+0xBE, 0x7B, 0xA2, 0xB0, 0xE5, 0x7C, 0xA5, 0x3C, 
+0x27, 0x89, 0x4C, 0xD6, 0x72, 0x49, 0x08, 0xAF, 
+0x70, 0x67, 0xA4, 0xF3, 0xF8, 0x74, 0x4D, 0xFB, 
+0x8F, 0xD3, 0xB5, 0x4E, 0xF4, 0x18, 0xCA, 0x22, 
+0x87, 0x7A, 0x7E, 0xFD, 0x2E, 0x45, 0xAF, 0x54, 
+0x98, 0x96, 
+
+0xC6, 0x49, 0xE1, 0xAB, 0x0A, 0x22, 0x78, 0xAA, 
+0x95, 0x0C, 0xC9, 0x95, 0xE4, 0x2C, 0xC5, 0xEA, 
+0x5C, 0x7C, 0x80, 0x30, 0xFF, 0x82, 0xDE, 0x06, 
+0x8C, 0xA5, 0x8C, 0x1A, 0xBB, 0x63, 0x36, 0x95, 
+0xB0, 0x6F, 0x82, 0xC5, 0xDD, 0x0A, 0xCA, 0xFD, 
+0x58, 0x8B, 0x95, 0x8F, 0xF8, 0x69, 0xDD, 0xCB, 
+0xF1, 0xE8, 0x2E, 0x5C, 0x93, 0x06, 0xB4, 0x04, 
+0x18, 0xDA, 0x08, 0x05, 0xF7, 0x72, 0xD1, 0x1E, 
+0xE5, 0x1C, 0xC0, 
+
+0x60, 0x3E, 0xC0, 0x35, 0xB4, 0xDB, 0xD2, 0x1D, 
+0x81, 0xC2, 0xD0, 0xE9, 0x49, 0x1D, 0x88, 0x6C, 
+0x2C, 0x87, 0x34, 0x46, 0x9D, 0xDE, 0x48, 0xB9, 
+0x8A, 0x7F, 0x75, 0xA6, 0x75, 0xF8, 0x4D, 0xBA, 
+0x14, 0x90, 0x4E, 0x02, 0xB1, 0xAC, 0x08, 0x99, 
+0x67, 0xA4, 0xAA, 0xA2, 0xD4, 0x13, 0xFE, 0xE3, 
+0x82, 0x12, 0x22, 0xCE, 0x25, 0xAD, 0xB9, 0x05, 
+0x61, 0x4F, 0x19, 0xF0, 0x80, 0xF0, 0x6D, 0x99, 
+0x4E, 0x66, 0x17, 0xC2, 
+
+0x8E, 0xB1, 0xC2, 0xE3, 0xFA, 0x66, 0x39, 0x77, 
+0x59, 0x7D, 0xE8, 0x79, 0xC2, 0x44, 0xE9, 0x1D, 
+0x3B, 0xAD, 0x81, 0x2E, 0x35, 0xB0, 0xC9, 0xA2, 
+0x0C, 0x4C, 0x7B, 0x88, 0x62, 0x50, 0xEC, 0xAD, 
+0x0A, 0x37, 0x72, 0x0E, 0x75, 0xC3, 0x65, 0x25, 
+0x58, 0xE9, 0x2F, 0x6B, 0x65, 0x16, 0x05, 0xBF, 
+0x1B, 0x01, 0x03, 0xA5, 0x99, 0x8C, 0x8B, 0x28, 
+0x4F, 0x02, 0xB5, 0x86, 0x98, 0x49, 0x28, 0xD7, 
+0x22, 0x30, 0x0A, 0x12, 0x3C, 
+
+0xF6, 0xAD, 0x2E, 0x2A, 0x0B, 0x41, 0xF3, 0x6E, 
+0xB7, 0x9F, 0xBC, 0x03, 0x08, 0x30, 0x23, 0x97, 
+0x45, 0x0A, 0xBF, 0xF2, 0xC6, 0x2E, 0xE8, 0xF4, 
+0x9A, 0x0A, 0x83, 0xFB, 0xCB, 0xF5, 0xC6, 0x0C, 
+0xE4, 0x84, 0xED, 0xE0, 0x5A, 0x6B, 0x02, 0xA7, 
+0x54, 0x54, 0x63, 0x1A, 0x0B, 0x9A, 0x62, 0xA6, 
+0xB9, 0x33, 0x3F, 0x74, 0xFB, 0x8C, 0x35, 0x59, 
+0xE0, 0xCA, 0x46, 0x4C, 0x11, 0x8F, 0x1C, 0x6C, 
+0x24, 0x87, 0x29, 0xBA, 0x0C, 0x41, 
+
+0xC1, 0x02, 0x61, 0xDA, 0x59, 0xAA, 0x40, 0x1C, 
+0xD3, 0x9B, 0x22, 0xFB, 0x6E, 0x6D, 0x5A, 0x5B, 
+0x1A, 0x70, 0xEE, 0x70, 0x32, 0x98, 0xA7, 0x95, 
+0x9A, 0x8B, 0x0B, 0xD0, 0x2F, 0x62, 0x0C, 0x53, 
+0xE3, 0x64, 0xCD, 0xE3, 0x9F, 0x34, 0x0E, 0x39, 
+0xF4, 0x1D, 0x4B, 0xD5, 0x2C, 0xED, 0x96, 0xD9, 
+0x0B, 0x11, 0x87, 0x65, 0xEB, 0x2E, 0xC2, 0x9A, 
+0x02, 0xD7, 0x25, 0x61, 0x0B, 0x1A, 0xB9, 0x52, 
+0x90, 0x49, 0x8A, 0xF1, 0x4B, 0x33, 0x86, 
+
+0x98, 0x93, 0x36, 0x57, 0xF6, 0xEF, 0x8C, 0xD2, 
+0x0D, 0x13, 0x27, 0x9F, 0x29, 0xA0, 0x27, 0x75, 
+0x03, 0x2F, 0x99, 0x7A, 0x42, 0x20, 0x24, 0x47, 
+0x73, 0x39, 0x5F, 0xF9, 0xBE, 0x65, 0x26, 0x7E, 
+0x83, 0x7A, 0x10, 0xF9, 0x3F, 0x97, 0x1D, 0x3B, 
+0x52, 0x51, 0x27, 0x9E, 0x32, 0x46, 0xAA, 0x7B, 
+0x03, 0x8B, 0x7A, 0x5E, 0x89, 0x09, 0x2E, 0x7F, 
+0xF1, 0x3B, 0x77, 0x8B, 0x9C, 0xE7, 0x5A, 0xB4, 
+0xFD, 0x55, 0xCB, 0xD3, 0x19, 0x63, 0xBE, 0x4D, 
+
+0x30, 0x79, 0x7D, 0x52, 0x55, 0x44, 0x94, 0x67, 
+0x30, 0x15, 0x59, 0xAA, 0x3C, 0x4C, 0x0D, 0x5E, 
+0x54, 0xBF, 0xC5, 0xD1, 0x1D, 0xA2, 0xE0, 0x2D, 
+0x0D, 0xD2, 0x53, 0x0B, 0x7F, 0xA7, 0xA2, 0x49, 
+0xD9, 0x47, 0x4B, 0xFB, 0x4D, 0x6A, 0xF8, 0x72, 
+0xD9, 0x13, 0xAB, 0xD4, 0xFA, 0x46, 0x7F, 0x4C, 
+0x6B, 0x2E, 0x50, 0xD0, 0xED, 0x3E, 0x67, 0xF2, 
+0x8B, 0x69, 0xED, 0x45, 0xE3, 0xCC, 0x8D, 0x7B, 
+0x80, 0xE5, 0x2C, 0x84, 0x4D, 0xCC, 0xA6, 0xB8, 
+0x3E, 
+
+0x8F, 0x13, 0x79, 0xF0, 0x58, 0xBE, 0xBC, 0x05, 
+0xD9, 0x8F, 0x9F, 0x5C, 0x45, 0x89, 0x91, 0x72, 
+0x51, 0xBF, 0x2A, 0x92, 0xA5, 0x7B, 0x9E, 0xEB, 
+0x8E, 0x2C, 0x03, 0x8E, 0xB8, 0xE8, 0x91, 0xE4, 
+0x09, 0x5C, 0x38, 0x93, 0x04, 0x37, 0xB5, 0x8E, 
+0x6F, 0x7B, 0xBD, 0x58, 0x7A, 0xCE, 0xC9, 0xE1, 
+0x15, 0x6D, 0xCF, 0xBD, 0x1F, 0x3F, 0x64, 0x1E, 
+0x6F, 0x32, 0x7E, 0x7B, 0xA7, 0xAC, 0xCE, 0x91, 
+0xA5, 0xB0, 0x43, 0xDC, 0x8B, 0x7F, 0xDC, 0x3B, 
+0xF2, 0x3F, 
+
+0x22, 0x32, 0x0F, 0x3B, 0xA0, 0x14, 0x25, 0xF7, 
+0x61, 0x15, 0xE5, 0x75, 0x85, 0xE2, 0x64, 0x2A, 
+0x64, 0x78, 0x04, 0xAE, 0x79, 0xB3, 0xBD, 0x9D, 
+0x9B, 0xE9, 0xF9, 0x21, 0xE6, 0xF8, 0x92, 0x0E, 
+0x28, 0x3E, 0x40, 0x72, 0x09, 0xD0, 0x38, 0x02, 
+0x74, 0x90, 0x28, 0x94, 0xCE, 0x6F, 0x11, 0x03, 
+0xB2, 0x74, 0x23, 0x40, 0x11, 0x6C, 0x9E, 0xD5, 
+0xB2, 0xDB, 0x22, 0x07, 0x58, 0xFA, 0x6E, 0xE0, 
+0xC8, 0xA3, 0x6E, 0x23, 0xD3, 0xC7, 0xB8, 0x35, 
+0x2E, 0xED, 0x1F, 
+
+0x04, 0x89, 0x0F, 0x7E, 0x25, 0xE4, 0x37, 0x07, 
+0x2E, 0xF0, 0xB0, 0xD6, 0xF3, 0xFD, 0xCA, 0xE5, 
+0x67, 0x15, 0x7C, 0x8F, 0x35, 0x95, 0xB2, 0x9D, 
+0x8D, 0xC2, 0x44, 0x19, 0x52, 0xE1, 0x19, 0x80, 
+0x1D, 0x70, 0xF8, 0x1C, 0x26, 0x8C, 0x35, 0xA5, 
+0x37, 0x12, 0xDA, 0x6A, 0x13, 0x65, 0x7A, 0x3F, 
+0x16, 0xD1, 0x81, 0xAE, 0xD4, 0xEE, 0x3C, 0x40, 
+0xBB, 0xF0, 0xE4, 0x6A, 0x2D, 0x82, 0x21, 0x4A, 
+0x73, 0x1B, 0xDF, 0x27, 0xA2, 0xCF, 0xB4, 0x35, 
+0x23, 0xE7, 0x2D, 0x69, 
+
+0xEA, 0x2C, 0x95, 0x3C, 0x58, 0xB0, 0x5C, 0x44, 
+0xFF, 0x02, 0x35, 0x8B, 0x4A, 0x15, 0x27, 0x2E, 
+0x93, 0xFC, 0x8C, 0x19, 0xC5, 0x28, 0x0E, 0xC9, 
+0xEC, 0x6B, 0x7C, 0xB4, 0x05, 0x13, 0x59, 0x43, 
+0x19, 0x9C, 0xAF, 0xE8, 0xD8, 0x4A, 0x63, 0xAA, 
+0x28, 0x7A, 0xF2, 0x2E, 0xBA, 0xDC, 0x23, 0x2C, 
+0xC8, 0xE9, 0xA3, 0xDE, 0x04, 0x8E, 0x73, 0xC9, 
+0xA9, 0xC3, 0x11, 0xAD, 0x5D, 0xA9, 0x54, 0xCC, 
+0x4E, 0x2E, 0x7C, 0xBE, 0x4C, 0x5B, 0x27, 0xBB, 
+0x10, 0xEC, 0xAA, 0x29, 0xB4, 
+
+0x40, 0xD6, 0xA0, 0xC6, 0xB0, 0x85, 0xD6, 0xEC, 
+0x60, 0x22, 0x42, 0xB1, 0x38, 0xF4, 0x9A, 0xE9, 
+0x16, 0x71, 0x7C, 0x5A, 0x71, 0xCE, 0x30, 0x14, 
+0x1B, 0xEB, 0x94, 0x55, 0xF1, 0x2D, 0xFA, 0x32, 
+0x91, 0x2F, 0xF7, 0x0A, 0xF3, 0x02, 0x28, 0x98, 
+0xB1, 0xB1, 0x8B, 0x37, 0xAC, 0x40, 0x47, 0xF1, 
+0x3E, 0xD2, 0xC2, 0xE1, 0xAB, 0x59, 0xAB, 0x64, 
+0xB4, 0x40, 0x1A, 0xE4, 0xE5, 0x98, 0xF1, 0x9B, 
+0x55, 0xE2, 0x64, 0xD7, 0xF4, 0x3A, 0x5F, 0xE0, 
+0x95, 0xFE, 0x23, 0xD2, 0x62, 0xB6, 
+
+0x63, 0xBF, 0x42, 0xBD, 0xF3, 0x88, 0x1E, 0xB4, 
+0x5C, 0xDA, 0x68, 0x92, 0xAC, 0x85, 0x41, 0x73, 
+0x36, 0x4B, 0x29, 0x7F, 0xCD, 0xF9, 0xDB, 0x9C, 
+0x6F, 0x37, 0xF1, 0xEA, 0x86, 0xA2, 0xA8, 0x9B, 
+0x7C, 0x86, 0x58, 0xA8, 0xC6, 0x69, 0x33, 0x13, 
+0x0A, 0xC4, 0x91, 0xB5, 0xFB, 0xDA, 0xD2, 0x8E, 
+0xBB, 0xB5, 0x9A, 0x50, 0x4E, 0x3F, 0x12, 0x28, 
+0x51, 0x37, 0xA0, 0xD5, 0x75, 0x75, 0xEE, 0xF7, 
+0x29, 0x96, 0x2D, 0xB6, 0x78, 0x1F, 0xE8, 0xDA, 
+0x7F, 0xCB, 0x5A, 0x4C, 0x90, 0x9E, 0x78, 
+
+0x65, 0xCF, 0xEC, 0xC2, 0x5C, 0x49, 0x8F, 0xB4, 
+0x70, 0xA6, 0xCE, 0x3E, 0x92, 0xFB, 0x6C, 0x42, 
+0x45, 0x1A, 0x97, 0x9A, 0x01, 0x6D, 0xB9, 0x38, 
+0x94, 0x51, 0x49, 0xD5, 0x37, 0xB3, 0x41, 0x8A, 
+0x97, 0x24, 0xD0, 0x1E, 0xEE, 0xD3, 0x21, 0xCD, 
+0xC1, 0x96, 0x1E, 0x3B, 0x65, 0x15, 0x72, 0x20, 
+0x83, 0xF8, 0xD5, 0x84, 0x69, 0x7B, 0xDA, 0xAE, 
+0xD5, 0xE6, 0x4F, 0x87, 0x01, 0x86, 0xB3, 0x5B, 
+0x8D, 0xF3, 0x24, 0xF9, 0xB1, 0x91, 0x24, 0xEA, 
+0xBD, 0x30, 0x68, 0xFF, 0x2C, 0x1E, 0x2D, 0x50, 
+
+0xC8, 0x29, 0xBB, 0x05, 0x22, 0xDB, 0xC5, 0x3F, 
+0xE4, 0x36, 0x1C, 0x9E, 0x0B, 0x85, 0x60, 0xFF, 
+0xF6, 0x67, 0x0D, 0x0E, 0xA2, 0x3B, 0x5F, 0x88, 
+0x77, 0xD8, 0x45, 0x94, 0xCB, 0xC9, 0x46, 0x82, 
+0xE2, 0x34, 0x97, 0x46, 0xB5, 0x4C, 0x7C, 0x0B, 
+0x63, 0x3E, 0xFE, 0x8B, 0x30, 0x43, 0xEF, 0x85, 
+0xFD, 0x39, 0x67, 0x42, 0xC8, 0x2F, 0xCD, 0xE6, 
+0x58, 0xB0, 0x87, 0x46, 0x6C, 0x5C, 0x89, 0xF5, 
+0xD0, 0x43, 0xDE, 0x3C, 0xFD, 0x1A, 0xB7, 0x28, 
+0x40, 0x53, 0x56, 0x83, 0xA0, 0xAB, 0x64, 0x6E, 
+0x51, 
+
+0x63, 0x80, 0xBD, 0x82, 0x63, 0x55, 0x09, 0xA5, 
+0xD4, 0xC0, 0xDF, 0x7A, 0xEC, 0xDD, 0x43, 0x0F, 
+0x20, 0x13, 0x8B, 0xFE, 0xC2, 0xCD, 0x6F, 0xA9, 
+0x8A, 0xE7, 0x61, 0x48, 0x01, 0x76, 0x7C, 0xC1, 
+0xFF, 0x2F, 0x1E, 0x36, 0xDE, 0x2C, 0xFD, 0x15, 
+0x1C, 0xD0, 0xC2, 0x22, 0xF8, 0xB9, 0xF7, 0x0D, 
+0x80, 0xED, 0x81, 0x3B, 0x4C, 0x86, 0xFE, 0xED, 
+0xA5, 0x68, 0x4C, 0x80, 0x5B, 0xAC, 0x13, 0xCF, 
+0x84, 0xF1, 0x63, 0x12, 0x0D, 0xB0, 0x07, 0xB1, 
+0x92, 0x18, 0x2F, 0x7E, 0xC3, 0xAA, 0x6F, 0x29, 
+0x5D, 0xDA, 
+
+0xD8, 0x2D, 0x96, 0x7E, 0x5C, 0x39, 0x5D, 0x30, 
+0x43, 0x91, 0x42, 0x02, 0x3D, 0x38, 0xFF, 0x2F, 
+0x87, 0x01, 0x78, 0x06, 0xB6, 0x5E, 0xB2, 0x38, 
+0x1A, 0x0D, 0x66, 0x4B, 0x08, 0x40, 0x7F, 0x47, 
+0xBF, 0xDF, 0x8F, 0xBF, 0xBF, 0x66, 0x6A, 0x48, 
+0x1A, 0x54, 0x83, 0xFE, 0x00, 0x21, 0xF5, 0x5A, 
+0x7E, 0x30, 0xA7, 0x4A, 0x85, 0x1B, 0x0E, 0x33, 
+0xEA, 0x78, 0x60, 0x3B, 0x6C, 0xDA, 0xEE, 0xA3, 
+0x30, 0x66, 0x15, 0x9F, 0x68, 0x58, 0xBD, 0xF4, 
+0x40, 0x50, 0xFE, 0x29, 0xAB, 0xD2, 0xA7, 0x56, 
+0x92, 0x98, 0xFF, 
+
+0x74, 0x55, 0x0A, 0xC6, 0xD0, 0xBD, 0x00, 0xF1, 
+0x79, 0x9D, 0x04, 0x0C, 0xC4, 0x86, 0x58, 0xFD, 
+0x30, 0xB7, 0x47, 0xA5, 0x9C, 0xC7, 0xB4, 0xE0, 
+0x54, 0x78, 0x7D, 0x4C, 0xCC, 0x62, 0x4F, 0xFD, 
+0xA1, 0x20, 0x94, 0x5A, 0xF7, 0xF9, 0x35, 0xFE, 
+0x10, 0x52, 0x96, 0x40, 0x36, 0x28, 0x1D, 0xCF, 
+0xD3, 0x33, 0x99, 0x8C, 0x59, 0x9A, 0x6C, 0x0F, 
+0x24, 0x2C, 0x91, 0xDB, 0x5F, 0xC6, 0x1C, 0x18, 
+0x83, 0xCC, 0xDA, 0x69, 0xBF, 0x73, 0x01, 0x91, 
+0x1F, 0xB3, 0x15, 0x12, 0xF4, 0xFC, 0xCE, 0xA5, 
+0x01, 0x83, 0x40, 0x89, 
+
+0x80, 0xAB, 0x83, 0x34, 0x92, 0x95, 0x10, 0x4B, 
+0xA6, 0xDB, 0x0A, 0x93, 0xB2, 0xA9, 0x4D, 0xD0, 
+0x5C, 0x97, 0x9F, 0x17, 0x6B, 0xB2, 0x18, 0x13, 
+0x4C, 0xEE, 0x03, 0x14, 0xAB, 0xF5, 0x52, 0xAD, 
+0xE8, 0x74, 0xFC, 0xD8, 0xB1, 0x17, 0xF5, 0x93, 
+0x1B, 0x17, 0xD3, 0xF4, 0xCB, 0x6C, 0xAE, 0x24, 
+0x81, 0x13, 0x45, 0xA7, 0x1A, 0x90, 0x20, 0x9D, 
+0x87, 0x43, 0x92, 0x49, 0x17, 0x6B, 0xE1, 0x65, 
+0x33, 0x0B, 0xD4, 0x58, 0xF9, 0xF4, 0x16, 0xE7, 
+0x4E, 0xCA, 0xED, 0x63, 0x9C, 0xA4, 0x14, 0x5B, 
+0x84, 0x4D, 0xDF, 0x3C, 0x14, 
+
+0x59, 0x71, 0x19, 0x58, 0x0A, 0xA9, 0x76, 0xF4, 
+0x3E, 0x92, 0x2C, 0x40, 0x0D, 0xEF, 0xFE, 0xEA, 
+0x2A, 0x0A, 0x0E, 0x2C, 0xBD, 0xCC, 0xCF, 0x0F, 
+0xCB, 0xED, 0xF8, 0xEA, 0x82, 0x14, 0x42, 0xEC, 
+0x32, 0xE0, 0xA5, 0x60, 0x89, 0x62, 0xF8, 0xF6, 
+0x1D, 0xB5, 0x1C, 0xFA, 0xE4, 0xBF, 0x19, 0xFA, 
+0xF3, 0xA5, 0xD2, 0x3C, 0x45, 0xBD, 0xA5, 0xE2, 
+0x23, 0x83, 0xDC, 0xE8, 0x80, 0x99, 0x66, 0x59, 
+0x99, 0x15, 0xFC, 0xC6, 0x84, 0x8F, 0x77, 0x25, 
+0x0C, 0x86, 0x7C, 0xF2, 0x93, 0x1E, 0x58, 0xDB, 
+0x18, 0xEB, 0x18, 0x38, 0xBB, 0xE0, 
+
+0x8E, 0xB7, 0xD9, 0x3E, 0x2C, 0xD9, 0x36, 0x1E, 
+0x19, 0xA1, 0xCE, 0x72, 0x1F, 0x41, 0xCA, 0x9A, 
+0x2A, 0x55, 0x33, 0x64, 0x95, 0x7D, 0xBD, 0xDE, 
+0x21, 0x24, 0x99, 0x7E, 0x67, 0x28, 0x8D, 0x3B, 
+0x25, 0x80, 0xAA, 0x9E, 0x6E, 0x66, 0x4A, 0x1D, 
+0x54, 0xD3, 0xC3, 0x5A, 0x0B, 0x5A, 0xDF, 0x1A, 
+0x9F, 0xB4, 0x85, 0xEB, 0x72, 0x61, 0x5D, 0xAB, 
+0xA8, 0x15, 0x6E, 0x0C, 0xA9, 0xF8, 0xE1, 0x7A, 
+0x5E, 0xD0, 0x2C, 0x4E, 0x8B, 0x0A, 0x05, 0x1D, 
+0xFA, 0xA1, 0x1E, 0xF9, 0x8C, 0xAA, 0x19, 0x6D, 
+0xB3, 0x59, 0x71, 0x6D, 0x7A, 0xD3, 0x2B, 
+
+0x2A, 0x03, 0x59, 0xC9, 0x6C, 0x99, 0x48, 0xBF, 
+0x34, 0xE3, 0x96, 0xB3, 0xED, 0xC9, 0x05, 0x19, 
+0x70, 0xFC, 0x38, 0xB2, 0x7D, 0x64, 0x35, 0xB4, 
+0xDB, 0x10, 0xEA, 0xA0, 0x4C, 0x9A, 0xF5, 0xEF, 
+0xEC, 0xF6, 0x3A, 0x13, 0x87, 0x17, 0x3F, 0x60, 
+0xFC, 0xC0, 0x32, 0xCB, 0xAB, 0x4F, 0xB2, 0xD0, 
+0x22, 0x20, 0x4F, 0x8F, 0xAB, 0x00, 0x48, 0xF2, 
+0xB9, 0x71, 0x5D, 0x14, 0x9B, 0x15, 0x35, 0xD2, 
+0x38, 0xEE, 0x1D, 0x6B, 0xDD, 0xF8, 0xB7, 0xD9, 
+0xBD, 0x26, 0x2B, 0x6D, 0x5E, 0x2C, 0x85, 0xAF, 
+0xF2, 0x0E, 0x76, 0x70, 0xD4, 0xA7, 0xC3, 0xDE, 
+
+0x21, 0x14, 0x4A, 0x2A, 0xE3, 0x75, 0x80, 0x31, 
+0x19, 0x87, 0xAC, 0xC8, 0x0C, 0x15, 0x84, 0xAF, 
+0xB9, 0x27, 0xC9, 0xCB, 0xEF, 0x1A, 0x99, 0xBB, 
+0x1E, 0xC1, 0xC6, 0xC3, 0x23, 0xFC, 0x36, 0x12, 
+0xE6, 0x4A, 0x35, 0x73, 0x5F, 0x5A, 0x38, 0x82, 
+0x9E, 0x78, 0x4B, 0x3A, 0x54, 0x13, 0x96, 0x25, 
+0xFE, 0xC8, 0x21, 0x29, 0xB8, 0xA1, 0x2C, 0xB9, 
+0xA7, 0x26, 0xF9, 0xFE, 0x8E, 0x28, 0x5D, 0x98, 
+0xAA, 0x0A, 0x8B, 0x8B, 0x8E, 0x8C, 0xE2, 0x11, 
+0x34, 0xB4, 0xC0, 0xED, 0x99, 0xFE, 0x03, 0xE1, 
+0xB6, 0x77, 0x21, 0x4F, 0x3E, 0x13, 0xF1, 0x47, 
+0x26, 
+
+0xEB, 0x90, 0x2A, 0x1E, 0xF1, 0x6B, 0xF9, 0x27, 
+0x23, 0xCA, 0x1D, 0x42, 0xF7, 0x52, 0x2F, 0xF4, 
+0x23, 0x45, 0xFF, 0xDD, 0x08, 0x19, 0x5B, 0x96, 
+0x18, 0x1F, 0x88, 0xD9, 0x3F, 0x02, 0x2E, 0xC4, 
+0x9E, 0x3B, 0xFE, 0xD5, 0x84, 0x7D, 0x3D, 0xF4, 
+0x3D, 0x68, 0x85, 0x04, 0x73, 0x56, 0xD1, 0x79, 
+0x77, 0x23, 0xBC, 0x45, 0x3A, 0x52, 0x2C, 0x59, 
+0x7B, 0x62, 0xAC, 0xBB, 0x40, 0x97, 0xD6, 0x09, 
+0xFD, 0xAD, 0x6C, 0x85, 0x65, 0x1C, 0x60, 0xB0, 
+0xED, 0x62, 0x8C, 0x39, 0xAD, 0xE1, 0xC0, 0x56, 
+0x18, 0xBA, 0xAE, 0xD7, 0x3D, 0x91, 0x56, 0x6D, 
+0xE7, 0xBF, 
+
+0xE9, 0xFF, 0x9E, 0x0D, 0xAC, 0xC7, 0x29, 0x6B, 
+0x77, 0xAA, 0xE6, 0x5C, 0xCD, 0x71, 0x32, 0x81, 
+0xC0, 0x39, 0xFA, 0x2C, 0x6D, 0x2A, 0x7B, 0x20, 
+0x37, 0x1B, 0x6D, 0x2F, 0x2E, 0x96, 0x25, 0x17, 
+0xAF, 0x57, 0x14, 0x14, 0x11, 0xD5, 0xB8, 0x14, 
+0x46, 0x71, 0xF8, 0x1B, 0x51, 0x1F, 0x42, 0x9A, 
+0x58, 0x8C, 0x9E, 0x23, 0xB0, 0xB4, 0xED, 0xBB, 
+0xAD, 0xA9, 0x83, 0xEF, 0xA1, 0x19, 0x62, 0xCE, 
+0x63, 0x41, 0x75, 0x62, 0x4D, 0x7F, 0x2D, 0x01, 
+0x2C, 0x2D, 0x11, 0x39, 0x90, 0x4D, 0x56, 0x5E, 
+0x00, 0xD4, 0x0E, 0x98, 0xE6, 0x9E, 0x9C, 0x0E, 
+0xDA, 0x15, 0x19, 
+
+0x6D, 0xBD, 0x83, 0xCF, 0x9C, 0x60, 0x9B, 0x7C, 
+0x4A, 0xA5, 0x69, 0xA7, 0xD5, 0x11, 0xB1, 0x73, 
+0xAB, 0x23, 0xD9, 0x4D, 0x43, 0x8B, 0x68, 0xCF, 
+0x44, 0xD0, 0x35, 0x01, 0xC1, 0xBE, 0xDB, 0x75, 
+0x3B, 0xEE, 0x43, 0x89, 0xA1, 0x8D, 0x71, 0x35, 
+0x8E, 0x0C, 0xBD, 0x1D, 0xEB, 0x4D, 0x2F, 0xDD, 
+0xB5, 0x5E, 0xBC, 0xBE, 0x27, 0x45, 0xDE, 0x1B, 
+0xAF, 0xD2, 0x10, 0x3C, 0x9B, 0xE6, 0x39, 0xB6, 
+0x72, 0xD3, 0x5F, 0x9D, 0xE8, 0x40, 0x2C, 0xD0, 
+0x4F, 0xC7, 0x67, 0xA4, 0xF5, 0x33, 0x0F, 0x81, 
+0xD0, 0x92, 0x9B, 0x57, 0xAE, 0x8C, 0x68, 0x74, 
+0xD0, 0x7C, 0xEF, 0x59, 
+
+0xC8, 0xB1, 0xFA, 0x9C, 0x66, 0xF5, 0xD8, 0x11, 
+0x81, 0x82, 0xE7, 0xD7, 0xC9, 0x71, 0x1E, 0x4B, 
+0xCC, 0x54, 0x65, 0x95, 0x13, 0x24, 0x8A, 0x8F, 
+0x14, 0x5B, 0x77, 0xE0, 0xEF, 0x8F, 0x4A, 0xCB, 
+0x25, 0x93, 0x27, 0x39, 0x02, 0xFC, 0xC0, 0x1D, 
+0xDD, 0x7C, 0x9C, 0x6F, 0x61, 0x6D, 0xD6, 0xD8, 
+0x23, 0x00, 0x2D, 0xAC, 0x91, 0x94, 0x1C, 0x1A, 
+0x87, 0xA5, 0x62, 0xDC, 0x99, 0x9F, 0xB9, 0x86, 
+0x2D, 0xF2, 0xD6, 0xDE, 0x9D, 0x42, 0xC5, 0xDC, 
+0x6A, 0xAB, 0x02, 0xFE, 0x2F, 0xCC, 0x42, 0x50, 
+0xA8, 0x11, 0x2C, 0xB6, 0xB3, 0x46, 0x51, 0x65, 
+0xCE, 0x40, 0x37, 0xE8, 0xD4, 
+
+0xEE, 0x8A, 0x01, 0xCA, 0x4B, 0x96, 0xE8, 0x39, 
+0x8E, 0x89, 0x3E, 0x51, 0x39, 0x06, 0xC3, 0x10, 
+0x93, 0x7C, 0xDF, 0x86, 0x04, 0x9D, 0xC6, 0xBA, 
+0x35, 0x9F, 0x59, 0x3A, 0x4A, 0x11, 0x51, 0x5D, 
+0x83, 0x18, 0x98, 0xC3, 0xD2, 0xAB, 0xEA, 0x77, 
+0x26, 0xFF, 0x0C, 0x70, 0xA2, 0xB1, 0xFE, 0xFB, 
+0xBC, 0x97, 0x96, 0xB5, 0x82, 0x29, 0xB8, 0x30, 
+0x28, 0xE5, 0x0D, 0x5A, 0x7F, 0x83, 0xC6, 0xCB, 
+0xCB, 0x62, 0x32, 0x25, 0x9B, 0x5F, 0xFC, 0x49, 
+0xFC, 0x83, 0x3B, 0x34, 0xBF, 0x7E, 0xD9, 0x76, 
+0xAA, 0x52, 0xEA, 0x13, 0xD2, 0xCB, 0x0A, 0x8E, 
+0xCB, 0x5B, 0x09, 0xFF, 0x62, 0xC0, 
+
+0xDD, 0x1B, 0x78, 0x9A, 0x43, 0x5B, 0xF2, 0x6C, 
+0x01, 0x7C, 0x45, 0xDA, 0x95, 0x8A, 0x1D, 0x0E, 
+0xDA, 0x90, 0xAB, 0x3F, 0xD7, 0x1E, 0x9E, 0x66, 
+0xEF, 0xD6, 0xA0, 0xAE, 0x08, 0xF3, 0x00, 0xC9, 
+0x6E, 0xD6, 0x7A, 0x24, 0xFB, 0xC0, 0x0F, 0x9C, 
+0xFB, 0x6F, 0x31, 0x28, 0xB7, 0xCC, 0x90, 0x08, 
+0x22, 0x6D, 0x8E, 0xB6, 0xCF, 0x8B, 0xA2, 0x10, 
+0x07, 0x75, 0x4F, 0x24, 0xED, 0x84, 0x5D, 0x01, 
+0x39, 0x44, 0xAC, 0x36, 0xF5, 0xDC, 0xA4, 0x50, 
+0x92, 0x17, 0x22, 0xE2, 0xBF, 0xC5, 0xCD, 0xB6, 
+0x59, 0x04, 0x1E, 0xD4, 0xB0, 0x7B, 0x15, 0xAE, 
+0xB2, 0x8C, 0x83, 0x73, 0xD3, 0x4C, 0x9A, 
+
+0xD7, 0x1C, 0xA6, 0x63, 0x85, 0xA8, 0x1B, 0xB8, 
+0xB7, 0xB0, 0xBB, 0x6C, 0x4B, 0x99, 0x40, 0xD5, 
+0x17, 0xBF, 0xC4, 0x77, 0xC6, 0xBA, 0x66, 0x0C, 
+0xAF, 0xB8, 0x35, 0xB6, 0x4A, 0xBD, 0x12, 0xE4, 
+0x2E, 0xDB, 0xC9, 0x65, 0x92, 0xB7, 0x34, 0x20, 
+0x4D, 0x82, 0xD5, 0xB6, 0x1D, 0x23, 0x6B, 0x8D, 
+0x37, 0xED, 0xF7, 0x77, 0x38, 0xAB, 0x43, 0xA2, 
+0x7B, 0xEB, 0x13, 0xE4, 0xB2, 0xDA, 0x41, 0xB7, 
+0x05, 0x71, 0x44, 0xC6, 0xD4, 0xED, 0x3A, 0x8C, 
+0x6A, 0x66, 0x8F, 0x93, 0x48, 0xDB, 0x7F, 0x57, 
+0x39, 0xAD, 0xAB, 0xB8, 0xC4, 0xB6, 0x4A, 0x2C, 
+0xF2, 0x21, 0xB1, 0xA5, 0xDB, 0x36, 0xD4, 0x90, 
+
+0x61, 0x67, 0x96, 0x26, 0x1E, 0x99, 0x7B, 0x6F, 
+0xD2, 0x6B, 0xFC, 0x42, 0x87, 0xF6, 0x22, 0x9C, 
+0xFE, 0xD5, 0xF2, 0xA6, 0xB2, 0x71, 0x2A, 0x8F, 
+0x58, 0x95, 0x16, 0x2D, 0xF5, 0x63, 0x25, 0xB9, 
+0x36, 0xDF, 0x02, 0xAF, 0xE2, 0x04, 0x8D, 0x27, 
+0xE2, 0x3F, 0x32, 0xD9, 0x8B, 0x52, 0x04, 0x14, 
+0xD6, 0xD2, 0x24, 0xA8, 0xA6, 0xDC, 0x98, 0x72, 
+0x6E, 0xCC, 0xDE, 0x52, 0xAB, 0x99, 0x66, 0x1A, 
+0x9D, 0x7F, 0xB7, 0xFE, 0x8F, 0x71, 0x0B, 0x01, 
+0x95, 0x36, 0xCD, 0x22, 0xB6, 0xD2, 0x07, 0x71, 
+0x7C, 0x10, 0x63, 0x4D, 0x93, 0xB7, 0x74, 0x34, 
+0xAB, 0x6D, 0xA8, 0x16, 0xCE, 0xDD, 0xAE, 0x63, 
+0x5D, 
+
+0x1C, 0xDC, 0xB2, 0x15, 0x18, 0xBD, 0xD9, 0x49, 
+0x57, 0x6B, 0x7A, 0xA7, 0x9C, 0x0D, 0x0A, 0x56, 
+0xEC, 0xA2, 0xDA, 0x8B, 0x3E, 0x4C, 0xB7, 0x76, 
+0xB7, 0xCD, 0xB8, 0xCF, 0xB9, 0xA7, 0x25, 0xC2, 
+0x5A, 0x0F, 0x41, 0x27, 0x36, 0x2A, 0xB7, 0x02, 
+0x73, 0xEC, 0x7C, 0xC7, 0x76, 0x65, 0xBB, 0x2A, 
+0x38, 0x1E, 0x8A, 0xE3, 0x7D, 0xEF, 0x96, 0x9B, 
+0xB6, 0xBA, 0xD7, 0xF3, 0x5A, 0xF5, 0x4F, 0x21, 
+0xD9, 0x3E, 0x16, 0x71, 0x53, 0x49, 0x92, 0xD4, 
+0xA3, 0xAD, 0x9C, 0xE8, 0x3D, 0xA8, 0xE8, 0x8F, 
+0x82, 0x7F, 0xDC, 0x96, 0xA8, 0xD8, 0xB3, 0x7B, 
+0xFF, 0x86, 0x22, 0xCE, 0xAE, 0x36, 0xBB, 0xC5, 
+0xE3, 0x23, 
+
+0xB7, 0xD3, 0xE2, 0x8E, 0xA7, 0xB2, 0xC6, 0x57, 
+0xCA, 0x18, 0xD3, 0x1F, 0xD0, 0x53, 0x37, 0xF9, 
+0xC9, 0x31, 0x0B, 0x4F, 0x1C, 0xE3, 0x08, 0x3D, 
+0xAF, 0x4A, 0xA9, 0xF1, 0x45, 0xCC, 0x5B, 0xF3, 
+0xEE, 0x06, 0x10, 0x26, 0x70, 0xEC, 0xA1, 0xC7, 
+0x61, 0xF1, 0x40, 0x1B, 0x09, 0x52, 0xF7, 0xC9, 
+0x0A, 0x87, 0x42, 0xFB, 0x3C, 0x27, 0x46, 0x6E, 
+0x6D, 0xBA, 0xF2, 0x9E, 0xB1, 0x88, 0xDE, 0x9D, 
+0xF1, 0x3A, 0xC1, 0x6D, 0xBA, 0x31, 0x67, 0x03, 
+0xB7, 0xEA, 0x44, 0xFC, 0x6C, 0xE4, 0x3D, 0x30, 
+0x7D, 0x85, 0x0E, 0xC3, 0x14, 0xE6, 0x06, 0x9B, 
+0x97, 0x5F, 0x51, 0x32, 0x11, 0x7B, 0x64, 0x16, 
+0x78, 0x9D, 0xA1, 
+
+0xB7, 0xDD, 0x59, 0x9B, 0x74, 0xB1, 0x61, 0x10, 
+0xF0, 0x4C, 0x44, 0xBA, 0xBF, 0xB0, 0x6C, 0x31, 
+0x23, 0x9A, 0xC7, 0xAD, 0xD3, 0xE7, 0x48, 0x67, 
+0xC0, 0xDD, 0x77, 0x6D, 0x3E, 0x53, 0x17, 0x23, 
+0x4C, 0x07, 0x93, 0xDC, 0xAB, 0x56, 0xB6, 0x12, 
+0x3B, 0x7D, 0x49, 0xEF, 0x42, 0x3D, 0x05, 0xE8, 
+0x60, 0xE8, 0x95, 0x0A, 0xDD, 0x28, 0x7F, 0x88, 
+0xBC, 0x16, 0x6F, 0x14, 0x72, 0xEF, 0x4C, 0x68, 
+0xB9, 0xC1, 0x99, 0xE9, 0x33, 0x39, 0x9F, 0xA7, 
+0x80, 0x89, 0xDD, 0xB0, 0xC6, 0xE2, 0xD7, 0x89, 
+0xAE, 0x10, 0x89, 0x5E, 0x4E, 0xBE, 0xC1, 0x54, 
+0x5F, 0x69, 0x4B, 0x7F, 0xF8, 0x63, 0x65, 0x42, 
+0xDA, 0x27, 0x25, 0xFA, 
+
+0x64, 0x8A, 0x84, 0xB9, 0xBC, 0x6C, 0x6A, 0x5C, 
+0x53, 0x6A, 0xCC, 0x76, 0xBF, 0x0F, 0xF6, 0xD1, 
+0x9F, 0x2B, 0xAE, 0xC0, 0xA3, 0x21, 0x75, 0x57, 
+0x8D, 0xBE, 0x34, 0xC9, 0xB5, 0xAF, 0xE6, 0xAC, 
+0x15, 0xB4, 0x52, 0xE3, 0x37, 0xC5, 0x61, 0xE9, 
+0xA7, 0x7A, 0x18, 0x3D, 0x97, 0x95, 0xD8, 0x93, 
+0x51, 0x5D, 0x9C, 0x88, 0x34, 0xB2, 0x64, 0x9A, 
+0x59, 0x0B, 0xCB, 0x5C, 0x1E, 0x7E, 0x48, 0xEB, 
+0xC1, 0xD8, 0xFD, 0xF7, 0xDA, 0x6F, 0x66, 0x47, 
+0x2A, 0x5B, 0x70, 0x8A, 0x30, 0x63, 0x5F, 0x92, 
+0x9D, 0xD4, 0x32, 0x68, 0xC3, 0xCC, 0xD8, 0x69, 
+0x79, 0x73, 0x5C, 0x58, 0x02, 0xA9, 0x06, 0x1C, 
+0xF4, 0x8B, 0x98, 0xC7, 0x64, 
+
+0x25, 0xAE, 0x2A, 0x01, 0x2E, 0x0F, 0x26, 0x44, 
+0x80, 0x16, 0x13, 0xD6, 0x20, 0x19, 0x08, 0xAE, 
+0x42, 0xEB, 0x77, 0x57, 0x2C, 0xFF, 0x2B, 0x27, 
+0xF8, 0xF5, 0x8F, 0xF6, 0x3A, 0x56, 0x6C, 0x9E, 
+0xEB, 0x80, 0x0E, 0x8F, 0x41, 0xBA, 0xC8, 0x48, 
+0x8D, 0xA4, 0xF2, 0x36, 0xD5, 0xD9, 0x1E, 0xD7, 
+0x93, 0x0B, 0x6A, 0xE7, 0x91, 0x52, 0xF1, 0x50, 
+0x11, 0x78, 0xFB, 0xDA, 0x85, 0xEE, 0x93, 0xE3, 
+0x3A, 0x03, 0xD1, 0xE9, 0xA5, 0x37, 0x30, 0x97, 
+0x51, 0x06, 0xB3, 0xBA, 0x4F, 0x1A, 0x82, 0x60, 
+0x4C, 0x80, 0x24, 0x7A, 0x55, 0x09, 0xB4, 0x15, 
+0xF4, 0x42, 0x71, 0x42, 0xEB, 0x9A, 0x93, 0xDA, 
+0xF2, 0x1E, 0xF0, 0x2A, 0x18, 0xC6, 
+
+0x18, 0xFA, 0xA6, 0x8A, 0x3A, 0xFC, 0x0C, 0x97, 
+0x1F, 0x36, 0xD2, 0x10, 0x40, 0x51, 0xF1, 0x0A, 
+0xD2, 0x4F, 0x0D, 0xE4, 0x9F, 0x32, 0xC8, 0xD5, 
+0xCF, 0xDE, 0x28, 0x1A, 0x54, 0x59, 0x09, 0xA8, 
+0x43, 0x9F, 0x87, 0x5D, 0x04, 0xE1, 0xBF, 0x19, 
+0xFA, 0x6F, 0x58, 0xB3, 0xB7, 0xCF, 0x49, 0x63, 
+0xF1, 0xC3, 0xC7, 0xD9, 0x9D, 0x5B, 0x73, 0xF5, 
+0x45, 0x71, 0xD4, 0x3B, 0x32, 0x3E, 0x81, 0xC2, 
+0xD2, 0x51, 0x08, 0x67, 0x73, 0x49, 0xBD, 0xF5, 
+0xA0, 0x96, 0x83, 0x44, 0xFE, 0x84, 0x9A, 0x31, 
+0x97, 0xB9, 0x15, 0xDB, 0xD5, 0x7E, 0xBC, 0x1C, 
+0x9E, 0x5D, 0x67, 0x64, 0xF1, 0xD4, 0xC1, 0x0E, 
+0xD3, 0xDA, 0x22, 0x1D, 0xB1, 0xCC, 0x76, 
+
+0x94, 0xD8, 0xFC, 0x1B, 0xD9, 0x3F, 0x08, 0x5E, 
+0xEF, 0xA1, 0xAE, 0x3E, 0xAA, 0x49, 0x85, 0x16, 
+0xCE, 0x62, 0x10, 0x06, 0x9B, 0x2F, 0xDA, 0xDC, 
+0x88, 0x2C, 0xAC, 0xCA, 0x7F, 0x73, 0x43, 0xEE, 
+0x95, 0xA5, 0x6D, 0x23, 0xEC, 0x72, 0x3E, 0xAF, 
+0x4F, 0x93, 0x47, 0xB2, 0xB9, 0xE2, 0xB2, 0x47, 
+0x37, 0x13, 0x8F, 0x20, 0xB0, 0x7E, 0xDE, 0x59, 
+0x48, 0x0A, 0x33, 0xCE, 0xB6, 0xE4, 0x5A, 0xF9, 
+0x04, 0xFA, 0x7F, 0x83, 0xC7, 0x69, 0xAD, 0x5C, 
+0x64, 0x13, 0x03, 0x28, 0x86, 0xEB, 0x39, 0x8E, 
+0x7B, 0x7F, 0x25, 0xE5, 0xDB, 0x92, 0x4D, 0x45, 
+0x4E, 0xBF, 0xC5, 0xAB, 0x6C, 0x2C, 0x12, 0xD7, 
+0x52, 0x8B, 0x8B, 0xCF, 0xFC, 0x5B, 0x78, 0xE5, 
+
+0x47, 0xF1, 0x9A, 0xD6, 0x2D, 0x1E, 0xC1, 0xC8, 
+0x8E, 0xA5, 0x57, 0x67, 0x64, 0x38, 0x4C, 0x0A, 
+0xE6, 0xDF, 0x59, 0x9B, 0x4E, 0xAF, 0x8C, 0x07, 
+0x41, 0x23, 0x13, 0x21, 0x4B, 0x0C, 0xA3, 0x84, 
+0x23, 0xBE, 0x1C, 0x05, 0x58, 0xFA, 0xB3, 0xEC, 
+0x0D, 0x87, 0xBD, 0x05, 0x8B, 0xAD, 0xA8, 0x7A, 
+0xAF, 0x15, 0xF6, 0x8F, 0xB4, 0x96, 0xC0, 0x2C, 
+0x18, 0x56, 0x55, 0xD9, 0xDF, 0xA0, 0x3F, 0xAF, 
+0x76, 0x5C, 0x0D, 0xCB, 0x92, 0x2A, 0xD1, 0x3E, 
+0x13, 0xC0, 0x06, 0xA8, 0xDE, 0xA4, 0x68, 0x6D, 
+0xE0, 0x5C, 0xD7, 0xAA, 0x43, 0x30, 0xB0, 0x73, 
+0xB1, 0x46, 0xB4, 0xE0, 0x16, 0x9B, 0x06, 0x4C, 
+0x7A, 0x3E, 0xC1, 0x9E, 0x71, 0x43, 0x3C, 0xD7, 
+0x6A, 
+
+0xFC, 0x32, 0x4E, 0x24, 0x97, 0x80, 0x25, 0x96, 
+0x0C, 0xCE, 0xBD, 0x8E, 0xA3, 0x9B, 0x0C, 0xF0, 
+0x18, 0x87, 0x18, 0x0B, 0x81, 0x62, 0x4F, 0xC1, 
+0x57, 0xC1, 0xAC, 0x20, 0x4F, 0x32, 0x70, 0x38, 
+0x47, 0x22, 0x93, 0xB5, 0x5E, 0xE6, 0x3A, 0x77, 
+0x81, 0x02, 0xCD, 0x89, 0x6E, 0x5C, 0x84, 0xD6, 
+0x79, 0x73, 0x72, 0x42, 0xBB, 0x6B, 0x5D, 0x3E, 
+0x56, 0x38, 0x34, 0xD0, 0xC8, 0xCF, 0xC1, 0x8E, 
+0x68, 0xB9, 0xA8, 0xE9, 0x6C, 0xD2, 0x39, 0x4D, 
+0x8A, 0x79, 0xC2, 0x6A, 0xD5, 0xC2, 0xCA, 0x39, 
+0xD1, 0x0B, 0x69, 0x2A, 0x10, 0xE2, 0xB2, 0x5E, 
+0x80, 0xCE, 0x3E, 0xC6, 0xCB, 0x30, 0x47, 0x5D, 
+0x63, 0x4D, 0xDC, 0xB0, 0xB7, 0xD8, 0x00, 0x55, 
+0x85, 0xA0, 
+
+0x21, 0xD5, 0xF5, 0x83, 0x08, 0xE1, 0x96, 0x86, 
+0x03, 0x3D, 0x53, 0x5C, 0x71, 0x34, 0x63, 0x04, 
+0xD9, 0xD1, 0x73, 0x7C, 0xA8, 0x6A, 0x06, 0xE7, 
+0x98, 0xC1, 0xF8, 0xDA, 0x6B, 0x8A, 0xEE, 0x9F, 
+0x29, 0x9E, 0x16, 0xAB, 0x7F, 0x78, 0x52, 0x3A, 
+0x6D, 0xE2, 0xA4, 0x60, 0xEF, 0xD0, 0xF5, 0xCA, 
+0x3D, 0x8E, 0x7B, 0xDE, 0xDD, 0xD0, 0x4B, 0x64, 
+0x49, 0xF4, 0xD8, 0x6E, 0x16, 0xBB, 0xDC, 0x2B, 
+0xD3, 0x78, 0x75, 0x71, 0x4A, 0x87, 0x03, 0x0E, 
+0x5A, 0x2E, 0x28, 0x51, 0x5A, 0x67, 0x58, 0xAA, 
+0xED, 0x3E, 0x82, 0x4F, 0x86, 0xFD, 0xC8, 0x8E, 
+0xD7, 0x81, 0x2F, 0x02, 0x10, 0x68, 0x80, 0xA2, 
+0x0B, 0xFC, 0xAD, 0xC1, 0x98, 0x78, 0xB5, 0xC7, 
+0x9F, 0xCD, 0xEA, 
+
+0xE4, 0xA1, 0xE0, 0x1D, 0x80, 0x20, 0x46, 0xB6, 
+0x53, 0x69, 0x78, 0xFE, 0x35, 0x98, 0x80, 0xCE, 
+0x33, 0x79, 0x1F, 0x94, 0x0E, 0x33, 0xD2, 0xF8, 
+0xE3, 0xD2, 0x2C, 0x19, 0x3C, 0x3D, 0x2A, 0x3C, 
+0x05, 0x69, 0x38, 0x31, 0x81, 0x7A, 0xE6, 0xD9, 
+0x1D, 0xE0, 0x32, 0x5D, 0xA0, 0xBC, 0x86, 0x0C, 
+0x51, 0x71, 0x36, 0xB3, 0x06, 0x20, 0x75, 0x8D, 
+0xD0, 0xD9, 0xFD, 0x7B, 0x4D, 0x19, 0x26, 0xE1, 
+0xE6, 0xF1, 0x19, 0x59, 0xCF, 0x6F, 0xD8, 0xCA, 
+0x9E, 0x53, 0x74, 0xCC, 0x84, 0x17, 0xDD, 0x2F, 
+0xE6, 0x2C, 0xF6, 0xB6, 0x0D, 0x3D, 0xB3, 0xE0, 
+0x60, 0xA1, 0x1C, 0xD8, 0xA3, 0xD7, 0xCF, 0x06, 
+0x60, 0xEF, 0xE6, 0x62, 0xC8, 0x3B, 0x21, 0x80, 
+0x99, 0x94, 0x6D, 0x73, 
+
+0x4E, 0x1E, 0x49, 0xF7, 0xB7, 0xA0, 0x70, 0x8E, 
+0x29, 0x4B, 0x70, 0x13, 0xC3, 0x5C, 0x3E, 0xAB, 
+0x11, 0x41, 0xC6, 0x12, 0xFA, 0x49, 0x4E, 0x2C, 
+0xC8, 0x89, 0x92, 0x36, 0x34, 0x26, 0x40, 0x33, 
+0xAA, 0x1C, 0x50, 0x51, 0x40, 0x3B, 0x3D, 0x34, 
+0xA0, 0xC2, 0x81, 0x99, 0x9E, 0x7E, 0x24, 0x15, 
+0x7C, 0x39, 0xFD, 0x87, 0x5D, 0x16, 0x5F, 0x58, 
+0xB7, 0x61, 0x2F, 0xA4, 0xB2, 0x4D, 0x3F, 0x33, 
+0x5F, 0x00, 0xD8, 0x94, 0x2E, 0x63, 0x52, 0x72, 
+0x5A, 0x41, 0xDA, 0x0B, 0x27, 0x4E, 0x66, 0x61, 
+0x04, 0x72, 0x28, 0x5F, 0x85, 0x61, 0x21, 0x1C, 
+0x7E, 0x89, 0x32, 0xA5, 0xC0, 0x87, 0xEC, 0xC7, 
+0x68, 0xE7, 0x7F, 0xDF, 0x80, 0x45, 0x9F, 0x91, 
+0x68, 0xC2, 0xCE, 0xA0, 0x56, 
+
+0xF5, 0xFF, 0x0F, 0x7A, 0xCC, 0x06, 0x93, 0x60, 
+0xDE, 0xA0, 0x37, 0xD4, 0x24, 0xEC, 0xAA, 0x99, 
+0xEC, 0xE9, 0xEB, 0x47, 0x6E, 0x03, 0x2D, 0x95, 
+0xD4, 0x99, 0x63, 0xB7, 0x04, 0x8C, 0xEE, 0xF4, 
+0x43, 0x1E, 0x6C, 0x79, 0xE1, 0x66, 0x96, 0x19, 
+0x8A, 0x94, 0xE1, 0x06, 0xCC, 0xA6, 0xBC, 0xDD, 
+0xFD, 0x70, 0x47, 0x9E, 0x88, 0x2F, 0x21, 0xE0, 
+0x09, 0xA6, 0x35, 0xBD, 0xEE, 0x57, 0x56, 0x68, 
+0xA5, 0xDF, 0x34, 0x1D, 0x4C, 0x36, 0xA2, 0x59, 
+0xB7, 0xFE, 0xBB, 0xD3, 0xCF, 0x8A, 0xD4, 0xC1, 
+0xC9, 0xBD, 0x72, 0xED, 0xEA, 0x9B, 0x17, 0x50, 
+0x12, 0x9F, 0x7E, 0xBC, 0xFE, 0xF8, 0xE0, 0xAE, 
+0x93, 0x06, 0x31, 0xD4, 0xE5, 0xB8, 0x41, 0x4C, 
+0x61, 0x07, 0x00, 0xB8, 0x7A, 0x43, 
+
+0x09, 0x1B, 0x9E, 0x79, 0xF7, 0xC1, 0x84, 0xE7, 
+0x8D, 0xDD, 0x33, 0xDF, 0xFA, 0x27, 0xFA, 0x9F, 
+0xB0, 0x54, 0xB3, 0xED, 0xCC, 0xE1, 0xF8, 0x86, 
+0x1F, 0xE7, 0x76, 0x09, 0x23, 0x48, 0x37, 0x5E, 
+0xFF, 0xF1, 0xBA, 0x42, 0xDA, 0x2A, 0xE8, 0x25, 
+0x1C, 0xCA, 0x27, 0x18, 0xC4, 0xB0, 0x65, 0xE0, 
+0x3F, 0xDA, 0xF4, 0x94, 0xB1, 0x9C, 0x2D, 0xEF, 
+0xCC, 0xA1, 0x1D, 0x7E, 0x5E, 0xD8, 0x1F, 0x99, 
+0x32, 0xC8, 0xB6, 0xF3, 0x7C, 0x62, 0x5E, 0x36, 
+0x50, 0x42, 0x24, 0x49, 0x03, 0x5B, 0xF7, 0x8F, 
+0x78, 0x97, 0x20, 0xA2, 0xBA, 0x25, 0x5B, 0xE0, 
+0x77, 0xE9, 0x3E, 0x45, 0x66, 0x39, 0x6B, 0x2A, 
+0xF8, 0x49, 0xEF, 0xED, 0x67, 0x52, 0xC8, 0x20, 
+0xF6, 0x66, 0xBA, 0xC1, 0xFA, 0xC4, 0x08, 
+
+0x86, 0x6D, 0xB9, 0xC4, 0x28, 0x31, 0x99, 0x30, 
+0x3F, 0x68, 0x54, 0x1C, 0x70, 0x2F, 0xCE, 0x80, 
+0xDF, 0xEC, 0x8D, 0x0D, 0x29, 0x7F, 0x4F, 0x96, 
+0xA1, 0x9F, 0xC0, 0xA4, 0x9A, 0x22, 0xAB, 0x74, 
+0x51, 0x40, 0x14, 0xF0, 0xE7, 0xF7, 0xF5, 0x19, 
+0x34, 0x89, 0x4C, 0x84, 0xAB, 0x9A, 0xBD, 0x6E, 
+0x3B, 0x83, 0x1E, 0xDE, 0x37, 0x28, 0x58, 0x78, 
+0x9E, 0xA7, 0xD0, 0x73, 0x2D, 0x71, 0x44, 0x81, 
+0xAA, 0x38, 0x27, 0xAD, 0x63, 0x14, 0x46, 0xA6, 
+0x80, 0x17, 0x09, 0xDD, 0xB1, 0x18, 0x13, 0x7D, 
+0xCD, 0x45, 0x3D, 0x1F, 0x31, 0x18, 0x72, 0xF6, 
+0xA1, 0xBC, 0xE9, 0x78, 0x6F, 0xF1, 0x9E, 0x5E, 
+0x92, 0x4F, 0x44, 0x66, 0x4A, 0x2F, 0x6E, 0x83, 
+0x80, 0x67, 0x2B, 0xBA, 0x90, 0x36, 0xFA, 0x43, 
+
+0x4B, 0x98, 0x3F, 0xE8, 0xB9, 0x06, 0x80, 0xF1, 
+0x29, 0x79, 0x33, 0x3A, 0xA3, 0x65, 0xC5, 0xF7, 
+0x20, 0xA0, 0xF3, 0x0E, 0x39, 0xFE, 0xA0, 0xF8, 
+0x04, 0xD7, 0xBA, 0x17, 0xC2, 0x6B, 0xE0, 0x51, 
+0xBB, 0x80, 0xBD, 0x30, 0x8C, 0x55, 0xE7, 0x98, 
+0x1E, 0xBB, 0x91, 0x12, 0x45, 0x8F, 0x48, 0xE7, 
+0x16, 0xCA, 0x5D, 0x13, 0xFE, 0x3C, 0xC8, 0xC7, 
+0x9B, 0x15, 0x7A, 0x74, 0x28, 0x31, 0x46, 0x2E, 
+0x53, 0x4A, 0x9C, 0x9B, 0x8D, 0x61, 0x08, 0x9A, 
+0xED, 0x75, 0xD5, 0xD2, 0x2F, 0xF4, 0x8A, 0xDA, 
+0x92, 0x86, 0xD1, 0x7C, 0x49, 0xED, 0xDC, 0x48, 
+0xB0, 0xC8, 0x39, 0x48, 0x00, 0x97, 0x12, 0xD3, 
+0xB2, 0xDB, 0x7A, 0xDE, 0xE0, 0x1E, 0x99, 0x4E, 
+0x95, 0xF3, 0x3D, 0x34, 0xB2, 0x83, 0x17, 0x0D, 
+0x4A, 
+
+0x8B, 0x93, 0xE6, 0xB0, 0x2E, 0x52, 0x7D, 0xFA, 
+0xE4, 0x8C, 0x8C, 0x93, 0x9A, 0x59, 0x29, 0xA2, 
+0x4A, 0xBE, 0xE4, 0x81, 0x7F, 0xFD, 0x73, 0x27, 
+0xCC, 0x3E, 0x83, 0xB0, 0x42, 0x0E, 0x29, 0xA5, 
+0x62, 0x1F, 0x16, 0xDE, 0xB1, 0xDC, 0xE6, 0x34, 
+0x5A, 0x3E, 0x30, 0x04, 0x48, 0x93, 0xD9, 0x0A, 
+0x9F, 0x08, 0x0D, 0xE5, 0x95, 0x8F, 0x90, 0x4C, 
+0xB8, 0xC8, 0x45, 0x0F, 0x9C, 0x5D, 0xB5, 0xE6, 
+0xBE, 0x0A, 0xB3, 0x80, 0x8A, 0x56, 0x22, 0xB9, 
+0x39, 0x14, 0x65, 0x8F, 0x34, 0xFE, 0x4D, 0x5F, 
+0x22, 0xA7, 0xA7, 0x9C, 0xA2, 0x77, 0x50, 0xE2, 
+0xCE, 0xC4, 0x1E, 0xA2, 0x03, 0x94, 0xE1, 0x2D, 
+0xC7, 0x51, 0x8E, 0xE2, 0x7B, 0xC9, 0x63, 0x92, 
+0x66, 0xA3, 0x70, 0x67, 0x61, 0x0C, 0x30, 0x4B, 
+0x35, 0x4D, 
+
+0x8A, 0x87, 0x26, 0x28, 0x85, 0xB9, 0x54, 0x59, 
+0x57, 0x2F, 0x67, 0xA3, 0xA4, 0xB1, 0xC2, 0x62, 
+0xC6, 0x33, 0x8D, 0x7B, 0x34, 0x58, 0x28, 0x36, 
+0xF3, 0x2A, 0x26, 0x9C, 0x2E, 0x6A, 0x37, 0x1B, 
+0xAE, 0x9E, 0xB1, 0x64, 0x1A, 0x09, 0x59, 0x8B, 
+0x64, 0xE1, 0x5D, 0x31, 0x67, 0x9E, 0xFB, 0x9D, 
+0x5C, 0x55, 0x45, 0x3D, 0xF0, 0x16, 0x86, 0x8A, 
+0x2D, 0x88, 0x4E, 0x0E, 0xD3, 0x3A, 0x80, 0x7B, 
+0xF1, 0xBB, 0x4A, 0x14, 0x58, 0xBB, 0xF6, 0xD5, 
+0x55, 0xF3, 0x2C, 0x43, 0x0B, 0xCB, 0x10, 0xC1, 
+0xF2, 0xA1, 0x27, 0x77, 0xA8, 0x83, 0xE7, 0xC0, 
+0x80, 0x03, 0x61, 0x0A, 0x33, 0x88, 0x28, 0xA1, 
+0xE3, 0x35, 0xE8, 0xFC, 0x75, 0x18, 0xC7, 0x74, 
+0xD7, 0x6F, 0xEC, 0xBC, 0xC5, 0x30, 0x8F, 0x45, 
+0xF8, 0x56, 0x14, 
+
+0xDE, 0x62, 0x4C, 0xB6, 0x64, 0x31, 0x9A, 0xF2, 
+0x71, 0xD7, 0xF8, 0x19, 0xD5, 0xC7, 0x1B, 0x5E, 
+0xCC, 0x0F, 0x86, 0x01, 0xEB, 0xD1, 0xEB, 0xE0, 
+0xAA, 0x6B, 0x7E, 0xBD, 0x8A, 0x49, 0x28, 0x6E, 
+0x50, 0xD7, 0xF6, 0xA4, 0x44, 0x61, 0xC3, 0x34, 
+0x47, 0xFA, 0x6A, 0x73, 0x5A, 0x21, 0xB6, 0xF4, 
+0x10, 0x7D, 0x55, 0xBF, 0xF6, 0xA7, 0x47, 0x92, 
+0x5B, 0x21, 0x31, 0xC2, 0xBC, 0x4C, 0xA1, 0xA9, 
+0x0F, 0x5D, 0x51, 0x14, 0xCD, 0x08, 0xE6, 0x0B, 
+0x97, 0x6D, 0xB8, 0xAB, 0x96, 0xCF, 0x3C, 0x1C, 
+0xEE, 0xAC, 0xFE, 0x91, 0x2D, 0xE9, 0x8B, 0x8A, 
+0x1B, 0xAA, 0x0F, 0x78, 0x9A, 0x62, 0xBC, 0x48, 
+0xC2, 0x61, 0x6A, 0x1E, 0xA8, 0x10, 0x7D, 0x12, 
+0x8C, 0x9F, 0xEC, 0xD2, 0xFC, 0x4C, 0x47, 0xD3, 
+0x91, 0x46, 0x0A, 0x3C, 
+
+0xA7, 0x6E, 0xE5, 0x97, 0xEC, 0xB1, 0xD2, 0xDD, 
+0xE5, 0x00, 0x7B, 0x99, 0x6D, 0x33, 0x50, 0xD4, 
+0xD3, 0xCA, 0x5E, 0x45, 0xCF, 0xF2, 0x02, 0x6F, 
+0x89, 0xF8, 0x08, 0xCB, 0xF6, 0x87, 0xD8, 0x8A, 
+0xB1, 0xE6, 0x7C, 0x3D, 0xE3, 0x33, 0xD8, 0x17, 
+0xBA, 0xC0, 0x3E, 0x8C, 0xE8, 0xB2, 0x18, 0x42, 
+0x8B, 0xA9, 0x97, 0x4D, 0xAD, 0xE2, 0x61, 0x2D, 
+0xF1, 0x2D, 0x5B, 0x76, 0x87, 0x40, 0x9B, 0xB5, 
+0x29, 0x6C, 0xCD, 0xF3, 0xE2, 0xD5, 0x16, 0x8E, 
+0xA8, 0x71, 0x0F, 0xA6, 0xBB, 0xBC, 0x9A, 0xAF, 
+0x7F, 0xB4, 0x63, 0xD5, 0x53, 0xA8, 0x7E, 0x42, 
+0xF5, 0x5D, 0xC7, 0x34, 0x99, 0x7C, 0x27, 0x61, 
+0x9A, 0x37, 0x26, 0x7A, 0xDE, 0x5E, 0x65, 0x8A, 
+0x55, 0xE4, 0x7E, 0x29, 0xC6, 0xFD, 0xD8, 0xC5, 
+0x28, 0xFA, 0x32, 0x18, 0xE8, 
+
+0x9B, 0xAA, 0xB9, 0x78, 0xC2, 0x0A, 0x39, 0x1A, 
+0xB3, 0xAE, 0xC1, 0x09, 0xCD, 0x50, 0x3E, 0x62, 
+0xEC, 0xEB, 0x63, 0xF1, 0x46, 0x8E, 0x14, 0x54, 
+0x5A, 0xCF, 0x81, 0x49, 0x9A, 0x63, 0x68, 0x58, 
+0xDA, 0x75, 0xDE, 0xFE, 0xAA, 0x45, 0x4F, 0xF5, 
+0xCD, 0xE5, 0x3D, 0x7A, 0xD5, 0xF8, 0x85, 0x63, 
+0x34, 0xFD, 0xB9, 0x68, 0xC8, 0x8C, 0xBE, 0x61, 
+0x6B, 0xC9, 0x5C, 0x96, 0x6B, 0xE2, 0xE2, 0x85, 
+0xF8, 0xDF, 0xD1, 0x79, 0x84, 0xA5, 0x66, 0xF5, 
+0x21, 0x47, 0x41, 0x07, 0x52, 0xE7, 0x56, 0xCD, 
+0xD1, 0x6A, 0xB9, 0xA8, 0xDB, 0xDA, 0xD9, 0xFE, 
+0x9B, 0x90, 0x15, 0x0A, 0x35, 0x53, 0xA7, 0x61, 
+0xFA, 0x43, 0x26, 0x64, 0x75, 0x3D, 0xAE, 0x1A, 
+0x3B, 0x65, 0x55, 0x8D, 0xFE, 0xDA, 0xFB, 0xF9, 
+0x0D, 0x1C, 0xF3, 0x9C, 0x65, 0x5C, 
+
+0x60, 0x7F, 0x2F, 0x1E, 0x95, 0x77, 0x55, 0xB0, 
+0x3E, 0x52, 0xDE, 0x07, 0xEC, 0xA6, 0x46, 0x20, 
+0x40, 0x80, 0x9A, 0xA8, 0xEB, 0xCB, 0x60, 0x38, 
+0x85, 0x19, 0x1D, 0x11, 0x71, 0xBB, 0x4B, 0xBB, 
+0xDF, 0xA5, 0x3A, 0x7F, 0xF6, 0xBB, 0xD0, 0x62, 
+0xB7, 0xE2, 0x9F, 0xA7, 0x56, 0x6C, 0x94, 0xD4, 
+0x89, 0x9D, 0xF9, 0x4F, 0xF8, 0xDA, 0xD8, 0x1A, 
+0x05, 0x30, 0x78, 0x0D, 0x6E, 0x18, 0x59, 0x77, 
+0x9C, 0xC4, 0xEE, 0x70, 0x49, 0x11, 0x66, 0x67, 
+0x67, 0x02, 0x0C, 0xEC, 0x76, 0x13, 0xC6, 0xFB, 
+0x8C, 0x5E, 0x54, 0x38, 0x88, 0x46, 0xA4, 0x61, 
+0xB0, 0x7B, 0x80, 0x8E, 0x65, 0x54, 0x6B, 0xFC, 
+0x9D, 0x09, 0xC8, 0x71, 0xF4, 0x28, 0x5F, 0xE3, 
+0x75, 0xF2, 0x7C, 0xAB, 0xD0, 0x01, 0x00, 0x5E, 
+0xEA, 0x26, 0xFB, 0x96, 0x1C, 0xC1, 0xAB, 
+
+0xBF, 0xD0, 0x8A, 0xCC, 0x29, 0xA1, 0x61, 0x87, 
+0x7F, 0xC5, 0x7E, 0x41, 0xA7, 0x86, 0x76, 0x52, 
+0x48, 0xBC, 0x74, 0xDB, 0x6A, 0x87, 0x7F, 0xDA, 
+0xA0, 0xA9, 0xC4, 0x72, 0x1B, 0x2F, 0xE0, 0xF1, 
+0x75, 0xFA, 0xD9, 0x7F, 0xE1, 0xC2, 0xC2, 0x85, 
+0xF9, 0x61, 0x0F, 0x02, 0x51, 0xF8, 0x2E, 0x06, 
+0x6C, 0x0B, 0x91, 0xAC, 0x32, 0xD6, 0x17, 0x91, 
+0xDE, 0x6E, 0xBA, 0x23, 0xCD, 0x48, 0x82, 0x62, 
+0x62, 0x4A, 0x43, 0xF9, 0x36, 0xE4, 0x62, 0x28, 
+0xF3, 0x34, 0x41, 0x58, 0xB0, 0xB9, 0xBD, 0xA1, 
+0x9E, 0x43, 0x00, 0x7B, 0xEC, 0x6E, 0xE9, 0xFD, 
+0xD6, 0x4A, 0xDD, 0x02, 0xA3, 0x08, 0x1C, 0xF9, 
+0x1A, 0x0E, 0x02, 0xCC, 0x5F, 0x08, 0xAF, 0x0B, 
+0x86, 0x98, 0x03, 0x4C, 0xD3, 0xD6, 0xDC, 0x15, 
+0x30, 0x25, 0x2D, 0x31, 0x85, 0xDB, 0xC4, 0xBC, 
+
+0x71, 0x5A, 0x91, 0xEE, 0x89, 0xE6, 0xD2, 0x47, 
+0x0F, 0x8D, 0x21, 0x2F, 0x16, 0x3D, 0x1C, 0x2B, 
+0x2B, 0x85, 0xE4, 0x80, 0x52, 0x39, 0xE0, 0x38, 
+0xDD, 0x57, 0x05, 0xFD, 0xB5, 0xE5, 0x83, 0x56, 
+0x13, 0x53, 0x0D, 0x48, 0xC9, 0xD6, 0xD0, 0xD2, 
+0xCC, 0x7A, 0x8C, 0xB5, 0xC6, 0x70, 0x45, 0x0A, 
+0x24, 0xC5, 0x13, 0xEF, 0x5E, 0x67, 0x1E, 0xC1, 
+0x63, 0x24, 0x5F, 0x6C, 0x6D, 0x11, 0xFE, 0xA7, 
+0x8D, 0x7F, 0xBC, 0x26, 0x45, 0x33, 0x14, 0x15, 
+0xAC, 0x96, 0x68, 0x37, 0x03, 0xF8, 0x8F, 0x68, 
+0xBC, 0x16, 0x09, 0x3F, 0xCB, 0x43, 0xE9, 0xF2, 
+0xDE, 0x3A, 0xC7, 0xBB, 0xBE, 0x16, 0x07, 0x6B, 
+0x22, 0x55, 0x9C, 0xFF, 0x53, 0x42, 0xD9, 0xBC, 
+0x5F, 0x6D, 0x30, 0xC2, 0x54, 0x96, 0x27, 0xA9, 
+0xD6, 0xA9, 0x6D, 0xD5, 0xBD, 0x07, 0x29, 0xB7, 
+0x99, 
+
+0x57, 0x28, 0xB6, 0xEB, 0xEF, 0x95, 0x68, 0x82, 
+0x37, 0x91, 0xB6, 0x68, 0xAA, 0x75, 0xFA, 0xD5, 
+0xB6, 0x19, 0x8E, 0x9A, 0xA4, 0xEE, 0xAD, 0x31, 
+0x9C, 0x50, 0xBE, 0x73, 0xDA, 0xD8, 0x2D, 0xA1, 
+0x71, 0x82, 0x65, 0xCB, 0xA4, 0x45, 0x04, 0x2F, 
+0xAE, 0xFB, 0xA2, 0x7D, 0x9D, 0x0D, 0x47, 0xBC, 
+0x46, 0x43, 0xC8, 0x1A, 0x5C, 0xAA, 0x8C, 0x59, 
+0x92, 0xE4, 0x1B, 0x7C, 0x72, 0x40, 0xCC, 0x56, 
+0x89, 0x39, 0xB3, 0x98, 0x7C, 0x6A, 0xAF, 0x2C, 
+0x67, 0x71, 0x25, 0x14, 0x61, 0x1F, 0xC7, 0x7F, 
+0x6F, 0xEB, 0x3D, 0xD0, 0xA2, 0x60, 0x8D, 0xF3, 
+0xF9, 0x1B, 0xE5, 0xB3, 0xA7, 0xA7, 0x16, 0x53, 
+0xC5, 0xE6, 0x63, 0x02, 0xEB, 0x5F, 0x88, 0xE4, 
+0x4B, 0xC5, 0xF8, 0xDE, 0x35, 0xDA, 0x74, 0xAB, 
+0xC3, 0x61, 0x1F, 0x09, 0x92, 0xF9, 0xC2, 0xA4, 
+0x73, 0x00, 
+
+0x0B, 0x9E, 0xF7, 0x2B, 0x0A, 0xCD, 0x3E, 0x78, 
+0xF2, 0xB7, 0x20, 0xD2, 0xEE, 0x17, 0x0C, 0xA9, 
+0x0F, 0x65, 0xCA, 0x50, 0x4F, 0xAF, 0xF6, 0x3A, 
+0x7E, 0x85, 0x5C, 0x0C, 0xE3, 0xDB, 0x5F, 0x85, 
+0x71, 0x87, 0x57, 0x8A, 0x5C, 0x6C, 0xDE, 0xBF, 
+0x19, 0x21, 0x3C, 0x33, 0xAB, 0x76, 0x79, 0x3D, 
+0x25, 0x9C, 0xE3, 0x7D, 0x95, 0x65, 0xDB, 0xF3, 
+0xC1, 0xF9, 0x7A, 0x16, 0xCD, 0xFD, 0xC1, 0x04, 
+0x43, 0x63, 0x19, 0x07, 0x19, 0x09, 0x04, 0xCB, 
+0x29, 0x5A, 0x37, 0xC7, 0x2D, 0x95, 0x88, 0xD3, 
+0x40, 0x04, 0x1A, 0x14, 0x05, 0x45, 0x99, 0x8B, 
+0x0C, 0x85, 0xC2, 0xFB, 0x93, 0xAF, 0xF1, 0xDF, 
+0x30, 0x88, 0x2A, 0x13, 0x7D, 0x46, 0x87, 0x1E, 
+0x51, 0xA6, 0x50, 0xA8, 0xB4, 0xA9, 0x6C, 0x36, 
+0x7A, 0xB1, 0xD7, 0x65, 0x34, 0x09, 0x7A, 0x8C, 
+0x89, 0x24, 0x46, 
+
+0x6B, 0x3B, 0x58, 0x24, 0x5E, 0x8A, 0xC1, 0x6D, 
+0xE3, 0xA9, 0x20, 0xDC, 0x0E, 0x88, 0x2A, 0x5E, 
+0x4F, 0x81, 0x12, 0x52, 0x9E, 0x31, 0x03, 0x57, 
+0xDD, 0x7F, 0x96, 0x81, 0x7B, 0x13, 0x62, 0x90, 
+0x83, 0x5B, 0xD0, 0xAE, 0x89, 0x9E, 0x25, 0x6C, 
+0x92, 0x37, 0xB8, 0xB2, 0x11, 0x50, 0x75, 0x4C, 
+0x88, 0xBE, 0x65, 0x94, 0x1B, 0x93, 0x56, 0xB2, 
+0x35, 0x5F, 0x31, 0xFD, 0x87, 0x7E, 0x74, 0xF2, 
+0xD3, 0x74, 0x4D, 0x26, 0x6C, 0x79, 0x43, 0xEC, 
+0x45, 0xEB, 0x90, 0x53, 0x89, 0x4E, 0x88, 0xA7, 
+0x21, 0x85, 0x08, 0x24, 0xAE, 0x93, 0xC2, 0xE6, 
+0xF9, 0xC7, 0x0B, 0xE2, 0x73, 0xEF, 0x54, 0x48, 
+0xD5, 0xD3, 0xD1, 0x1F, 0xDF, 0x24, 0x3D, 0xBA, 
+0xB5, 0x41, 0xB8, 0x7A, 0x3B, 0x0E, 0x01, 0x1F, 
+0x01, 0x47, 0x26, 0x97, 0x90, 0xB1, 0x9C, 0xD1, 
+0x4E, 0xBC, 0x40, 0x37, 
+
+0x26, 0xC4, 0x9A, 0x4C, 0xC8, 0x08, 0xFD, 0x68, 
+0x02, 0x15, 0x75, 0xE0, 0xFE, 0xF6, 0x9B, 0xF7, 
+0xB6, 0x89, 0x4F, 0xBF, 0x2C, 0x9A, 0x19, 0xDF, 
+0xAB, 0xDC, 0x02, 0x13, 0x49, 0xFA, 0x32, 0x63, 
+0x81, 0xDF, 0xBF, 0x23, 0xBC, 0xB0, 0x77, 0x16, 
+0x5E, 0xAB, 0xAA, 0xB5, 0x40, 0x7B, 0x8A, 0xED, 
+0x2D, 0x5D, 0x63, 0x5F, 0xA2, 0x4A, 0x06, 0x5A, 
+0x59, 0xBA, 0x23, 0x68, 0x91, 0x50, 0x15, 0xCD, 
+0x13, 0x1D, 0x6B, 0x93, 0xD9, 0x00, 0x7C, 0x91, 
+0xE7, 0x62, 0x9C, 0x03, 0xEB, 0x88, 0x52, 0x2F, 
+0x99, 0x05, 0xF9, 0xBE, 0xF4, 0x9A, 0x18, 0x0E, 
+0x66, 0xDA, 0x96, 0xFF, 0xA1, 0xAB, 0x7A, 0x3E, 
+0xA7, 0x6A, 0x1E, 0x8C, 0x08, 0xBD, 0xCE, 0x48, 
+0x70, 0x96, 0xF6, 0xED, 0xBC, 0x81, 0xA6, 0x77, 
+0xFC, 0xFA, 0xD1, 0xA8, 0x58, 0xB8, 0x36, 0x0F, 
+0x61, 0xB0, 0xC7, 0x5C, 0xF7, 
+
+0xC3, 0xAE, 0x0E, 0x60, 0x2A, 0x74, 0x33, 0x7E, 
+0x21, 0x42, 0xBC, 0x83, 0xE6, 0xE5, 0x21, 0x0B, 
+0x20, 0x9D, 0x11, 0xB1, 0x1C, 0x3E, 0xAD, 0x81, 
+0x63, 0xC8, 0xCB, 0xD0, 0x16, 0xAF, 0x56, 0xA5, 
+0xC3, 0xD8, 0x13, 0xBD, 0xB3, 0xFF, 0xE0, 0x48, 
+0xF3, 0x58, 0x39, 0xA5, 0xD2, 0x08, 0xE8, 0x42, 
+0x8C, 0x42, 0xC8, 0x9A, 0x70, 0x2A, 0xB6, 0xAB, 
+0xAA, 0xDF, 0x45, 0x09, 0xAD, 0x6F, 0xD1, 0x29, 
+0x1A, 0x24, 0x43, 0xBF, 0x6A, 0x44, 0x99, 0xF8, 
+0xB2, 0xAB, 0x20, 0xAF, 0xE0, 0x23, 0x70, 0x8C, 
+0x9B, 0xE1, 0xB2, 0x22, 0x24, 0x59, 0x1D, 0x83, 
+0x29, 0x27, 0x2D, 0xD1, 0xDD, 0x50, 0x3F, 0x26, 
+0x63, 0x52, 0xC1, 0xB5, 0x4B, 0x43, 0x8C, 0xDB, 
+0x21, 0x8B, 0xDE, 0x74, 0x43, 0x86, 0xA1, 0x2B, 
+0x66, 0xF7, 0x9F, 0x92, 0x35, 0x13, 0x48, 0xA8, 
+0x58, 0x8C, 0xA0, 0x81, 0xFF, 0xFC, 
+
+0x35, 0xCE, 0xBF, 0x4B, 0x59, 0xD0, 0x4D, 0x82, 
+0x6D, 0x01, 0x21, 0x68, 0xDE, 0xA5, 0x72, 0x0A, 
+0xCD, 0xE5, 0x0D, 0x07, 0x93, 0x7F, 0x4B, 0x60, 
+0x33, 0x2A, 0xD4, 0x0F, 0xD7, 0xAE, 0x1A, 0x12, 
+0xFC, 0x7E, 0x17, 0xD8, 0x8F, 0xC9, 0x50, 0xC9, 
+0xD1, 0xC5, 0x52, 0x39, 0x17, 0x87, 0xA7, 0x1F, 
+0xB9, 0x35, 0xEA, 0x14, 0x60, 0xCB, 0x70, 0x13, 
+0x28, 0x1C, 0x73, 0xFB, 0x73, 0x8B, 0x7F, 0x47, 
+0x69, 0x37, 0xB8, 0xC3, 0x85, 0xC4, 0x9B, 0x61, 
+0xE8, 0xE2, 0x24, 0xEA, 0x29, 0x3A, 0x93, 0x86, 
+0xCB, 0x3B, 0x9D, 0x8C, 0x60, 0xC6, 0x2F, 0xF2, 
+0x63, 0x73, 0x59, 0xE7, 0x6C, 0xE9, 0xDE, 0xA1, 
+0x32, 0xDD, 0x9E, 0x94, 0x71, 0x41, 0x19, 0xB1, 
+0xC6, 0x88, 0xDD, 0x22, 0x7C, 0x22, 0xE2, 0xB7, 
+0xC6, 0x60, 0xBD, 0xC9, 0xCF, 0x56, 0xC2, 0xE3, 
+0x77, 0xD9, 0x5B, 0x57, 0x6B, 0xAB, 0x7D, 
+
+0xC7, 0x18, 0xFF, 0x7E, 0x1C, 0x9C, 0x29, 0x73, 
+0x52, 0x31, 0x67, 0x89, 0x16, 0x7C, 0xEC, 0xC2, 
+0xE5, 0xC3, 0x9B, 0x08, 0xD9, 0x71, 0x7C, 0x30, 
+0x9E, 0x9F, 0x12, 0x05, 0xEC, 0x20, 0x12, 0x9E, 
+0x3C, 0x51, 0x68, 0x3B, 0x23, 0x90, 0xE8, 0x96, 
+0xEB, 0x16, 0x32, 0x65, 0xA7, 0xDA, 0x88, 0x53, 
+0xA6, 0x92, 0x00, 0x46, 0xA6, 0x2D, 0x40, 0x4F, 
+0x1E, 0xF6, 0x9C, 0x0F, 0x17, 0xE1, 0x43, 0xC6, 
+0xED, 0x97, 0x10, 0x48, 0x15, 0x27, 0xFB, 0xAE, 
+0x8C, 0x9C, 0x50, 0xAC, 0x87, 0x8D, 0x81, 0x9D, 
+0xD8, 0xF1, 0x2C, 0xE0, 0xBD, 0xC9, 0x67, 0x20, 
+0xB0, 0x85, 0x9D, 0xB0, 0xBE, 0x0E, 0xC4, 0xAF, 
+0xCA, 0xC6, 0xB6, 0x76, 0x70, 0x25, 0x2F, 0xCE, 
+0xDF, 0x18, 0x78, 0x4B, 0xAA, 0xD7, 0x94, 0x32, 
+0xD7, 0x0C, 0xA2, 0xDD, 0xA4, 0xCC, 0xA2, 0x49, 
+0xA0, 0x63, 0xE5, 0x11, 0x32, 0x29, 0xCC, 0xDB, 
+
+0x74, 0xE7, 0xC5, 0xEE, 0x07, 0xAC, 0x94, 0x29, 
+0xDC, 0xD3, 0x4B, 0x07, 0xBE, 0xF7, 0xA4, 0x9E, 
+0x57, 0x4B, 0xE1, 0xD7, 0x7D, 0xCF, 0x74, 0x9A, 
+0x78, 0x6F, 0xB9, 0xDB, 0xD1, 0xD0, 0xD4, 0xE0, 
+0xED, 0xA6, 0x58, 0xF3, 0xFD, 0x46, 0x53, 0x59, 
+0x97, 0x98, 0x39, 0xCB, 0xF4, 0x01, 0x2D, 0x34, 
+0xD9, 0x45, 0x5D, 0x9B, 0xE4, 0xAB, 0xDA, 0xB5, 
+0x4A, 0xE0, 0x51, 0x0C, 0x86, 0x50, 0x63, 0x1D, 
+0x97, 0xA4, 0x04, 0x0D, 0x8D, 0x9B, 0x44, 0xA5, 
+0x55, 0x10, 0x9D, 0x49, 0x51, 0xA6, 0x08, 0xD2, 
+0x9A, 0x36, 0xF6, 0xC6, 0x06, 0x24, 0xC1, 0x71, 
+0xFB, 0xE7, 0xF6, 0x67, 0x28, 0xBD, 0x31, 0xF5, 
+0xE0, 0x67, 0xEF, 0x97, 0x9A, 0xB8, 0xFD, 0x19, 
+0xB3, 0x87, 0x09, 0x2B, 0x76, 0x4E, 0x87, 0xFB, 
+0xEE, 0xC4, 0xA6, 0x68, 0x41, 0x0F, 0x0C, 0x3C, 
+0x1A, 0xD7, 0xB2, 0x42, 0x9F, 0x6B, 0x90, 0x5C, 
+0x30, 
+
+0x4E, 0x7B, 0x00, 0xFE, 0xB6, 0xB8, 0x32, 0xCF, 
+0x2A, 0x24, 0x35, 0x6B, 0x63, 0xF0, 0xAA, 0xFC, 
+0x41, 0xFA, 0xFA, 0xD5, 0x47, 0x09, 0x79, 0xBE, 
+0x3A, 0x1E, 0x6A, 0x21, 0x86, 0x30, 0xE1, 0x7F, 
+0x3D, 0x84, 0x6D, 0xE8, 0x0A, 0xEF, 0x71, 0x07, 
+0x28, 0x25, 0x4D, 0x7C, 0x5C, 0x32, 0xAE, 0x4F, 
+0x4B, 0xD0, 0xFF, 0x82, 0x6C, 0x01, 0x99, 0xC1, 
+0x82, 0x23, 0xED, 0x43, 0xC2, 0xD6, 0x81, 0x72, 
+0x93, 0x1D, 0x84, 0xDA, 0x04, 0xAD, 0x3C, 0x3A, 
+0x2F, 0x77, 0xB1, 0x7B, 0x01, 0x63, 0x8C, 0xFE, 
+0x99, 0x40, 0x28, 0x90, 0xF4, 0xC6, 0xC6, 0x99, 
+0xFD, 0x8D, 0xF6, 0x13, 0x42, 0x01, 0xF4, 0x8A, 
+0x3A, 0x3F, 0x95, 0xB5, 0x02, 0x8B, 0x3B, 0xF0, 
+0xE4, 0x63, 0xFA, 0xBF, 0x46, 0x84, 0xCA, 0x5E, 
+0x9B, 0xAD, 0xA4, 0x7F, 0x42, 0xA9, 0x76, 0xFE, 
+0x68, 0xE1, 0xD8, 0x7F, 0x79, 0xA1, 0x41, 0x02, 
+0xD8, 0x81, 
+
+0xD5, 0x37, 0xC3, 0x1F, 0xF2, 0xC9, 0xFD, 0x89, 
+0x31, 0xF7, 0x72, 0xFE, 0xCA, 0xC2, 0xB9, 0xEB, 
+0xEA, 0x9F, 0xF5, 0x5D, 0x52, 0x82, 0x9E, 0xB1, 
+0x3B, 0xCF, 0x0E, 0xDC, 0x9D, 0x69, 0xF6, 0x22, 
+0x11, 0x82, 0x92, 0xE8, 0x22, 0xF2, 0x75, 0x2E, 
+0x0C, 0x9F, 0x0D, 0x38, 0x6D, 0x10, 0xBC, 0x73, 
+0xE6, 0xF8, 0xC3, 0x99, 0x44, 0x44, 0x2D, 0xAA, 
+0x3C, 0x22, 0x2F, 0x24, 0x91, 0xC9, 0xC5, 0x75, 
+0x27, 0x7B, 0x7A, 0xA7, 0x0E, 0x4B, 0x2F, 0x3C, 
+0xED, 0x5E, 0x23, 0x14, 0xD6, 0x52, 0xED, 0x99, 
+0xF5, 0xF5, 0xE9, 0xF6, 0xFD, 0x2E, 0x54, 0xD6, 
+0x03, 0x70, 0x1F, 0x7C, 0x38, 0xF8, 0x06, 0x72, 
+0xA8, 0x4D, 0xDC, 0x08, 0x57, 0x3C, 0x22, 0x3D, 
+0x74, 0x28, 0x30, 0xA8, 0x9F, 0xBD, 0x6C, 0xAD, 
+0x32, 0xED, 0xA5, 0x6A, 0x90, 0x06, 0xB9, 0xBB, 
+0xEC, 0x24, 0x55, 0xD4, 0xBF, 0xF9, 0x47, 0x5C, 
+0x05, 0x71, 0xAB, 
+
+0x5D, 0xD6, 0x85, 0x56, 0x7D, 0x24, 0xB5, 0x14, 
+0xED, 0x19, 0x14, 0x71, 0x64, 0x51, 0x7D, 0xCB, 
+0x69, 0x3B, 0xBE, 0x42, 0x00, 0xEF, 0xA9, 0xEC, 
+0x07, 0x45, 0x27, 0xBB, 0x35, 0x12, 0xF0, 0x2A, 
+0x92, 0xBC, 0x45, 0x9E, 0x80, 0x3A, 0x20, 0x1F, 
+0xE3, 0xB9, 0x2B, 0xB1, 0x6D, 0xB2, 0x31, 0x0A, 
+0x28, 0x12, 0xFE, 0xAB, 0x2F, 0xAE, 0xC8, 0x20, 
+0x36, 0xE1, 0xE8, 0x15, 0xA8, 0x2A, 0x78, 0x11, 
+0x2B, 0x5A, 0x4C, 0x5B, 0xFC, 0x88, 0x97, 0x8C, 
+0xBF, 0x70, 0xB8, 0x0F, 0x6C, 0x01, 0x4F, 0xCB, 
+0xE2, 0xF3, 0xB5, 0x45, 0xE5, 0xA8, 0xCB, 0xAD, 
+0x02, 0xFE, 0x64, 0x14, 0x0D, 0x98, 0x60, 0x71, 
+0x98, 0x8B, 0x10, 0x49, 0x68, 0x5C, 0x09, 0x4B, 
+0xEC, 0x38, 0x8F, 0xB4, 0x55, 0x05, 0x25, 0x9B, 
+0xC2, 0x18, 0xBD, 0xD8, 0x17, 0x41, 0x44, 0xC4, 
+0xA5, 0x03, 0x32, 0x44, 0xCC, 0xBD, 0xBD, 0x9D, 
+0x42, 0x0C, 0x5F, 0xE7, 
+
+0x6E, 0xCC, 0x5A, 0x66, 0x94, 0xDE, 0xDE, 0x67, 
+0xA5, 0x86, 0x24, 0x8C, 0x80, 0xE4, 0x5F, 0xCA, 
+0x5D, 0xF9, 0x9E, 0x79, 0x74, 0x0C, 0x0D, 0x1B, 
+0xF2, 0xD8, 0x08, 0x29, 0x50, 0xFF, 0x6D, 0x32, 
+0x4F, 0x2C, 0x10, 0x72, 0x44, 0xD6, 0xF9, 0xB6, 
+0x88, 0x34, 0xEE, 0xEC, 0x84, 0x95, 0xA5, 0x66, 
+0xFF, 0x83, 0xA0, 0x04, 0x6C, 0xDB, 0xC7, 0xCF, 
+0x4D, 0x6F, 0x21, 0x89, 0xD2, 0x96, 0xC1, 0x7C, 
+0x17, 0xC1, 0x61, 0xE9, 0x6E, 0xC5, 0x28, 0x66, 
+0xAE, 0xCF, 0x25, 0x64, 0x33, 0xA9, 0xA1, 0x4E, 
+0x9E, 0x5A, 0xED, 0xB1, 0x41, 0xAB, 0xE5, 0x44, 
+0x30, 0x61, 0xA3, 0xCA, 0x70, 0xBE, 0x98, 0x8B, 
+0xB3, 0x14, 0xFF, 0x70, 0xB2, 0x09, 0x8F, 0x75, 
+0x5D, 0x5B, 0xD5, 0x94, 0x6E, 0x0E, 0x2B, 0x95, 
+0xB7, 0x34, 0xF5, 0x5B, 0x55, 0xFB, 0x41, 0xC4, 
+0xE3, 0x31, 0x24, 0xD1, 0x00, 0x64, 0x59, 0x90, 
+0x17, 0x66, 0x11, 0xFD, 0x35, 
+
+0x2B, 0xF9, 0x89, 0x09, 0x76, 0xF0, 0x4B, 0x4C, 
+0x08, 0x97, 0x2D, 0x66, 0xCE, 0xA5, 0xD1, 0xC2, 
+0x0B, 0xA0, 0x5B, 0x87, 0x27, 0xB5, 0x1E, 0x77, 
+0x08, 0xA9, 0x16, 0xDA, 0x16, 0xEB, 0xCB, 0xFB, 
+0x92, 0xEE, 0x88, 0xFE, 0x4E, 0xB5, 0xE0, 0x0C, 
+0x6A, 0x75, 0x53, 0xBE, 0xED, 0x63, 0xB8, 0x30, 
+0x7D, 0x17, 0xB4, 0xF7, 0x95, 0x38, 0xE8, 0xB3, 
+0xDA, 0xE0, 0xE5, 0x6A, 0x59, 0x95, 0xBC, 0xD4, 
+0xA0, 0x6D, 0x81, 0xAF, 0x2C, 0xC1, 0x0C, 0xC4, 
+0x3F, 0xC7, 0x36, 0xC4, 0x5D, 0xA3, 0x0B, 0x80, 
+0xB7, 0xB4, 0x58, 0x1B, 0x14, 0x0F, 0xA1, 0x6F, 
+0x68, 0x0F, 0xE2, 0xE1, 0xDA, 0x01, 0x82, 0x17, 
+0xDC, 0x03, 0xA7, 0x26, 0xA7, 0x96, 0x78, 0x55, 
+0x4D, 0xD6, 0xB9, 0xF9, 0x2B, 0xEC, 0xB3, 0x60, 
+0x12, 0xB9, 0xAF, 0x42, 0xE0, 0x6F, 0x3A, 0x6F, 
+0x2B, 0xDE, 0x56, 0x6C, 0x69, 0x97, 0x23, 0xBB, 
+0x6A, 0x11, 0xD8, 0x85, 0x51, 0x65, 
+
+0xDC, 0x06, 0x71, 0x8A, 0x69, 0xAC, 0x35, 0x77, 
+0x26, 0x27, 0x9E, 0x29, 0xC9, 0x09, 0xC0, 0x71, 
+0xD6, 0xBC, 0x19, 0x1A, 0x3A, 0x03, 0x60, 0x07, 
+0x8F, 0x36, 0x3D, 0xF2, 0x61, 0x27, 0x0C, 0x06, 
+0xD1, 0xD6, 0x4C, 0x8B, 0x78, 0xF7, 0x3E, 0x34, 
+0x5D, 0x9E, 0x86, 0x7E, 0xE0, 0xEB, 0xAC, 0x00, 
+0xFC, 0x2B, 0xC3, 0xE8, 0x9F, 0x5F, 0x6A, 0x18, 
+0xB3, 0x61, 0x26, 0x68, 0xB3, 0x44, 0xC8, 0xC3, 
+0xDB, 0xB5, 0xE5, 0x7F, 0x48, 0x9B, 0xBC, 0x00, 
+0x6D, 0x5D, 0x2C, 0xA0, 0x50, 0xB5, 0xB6, 0x6C, 
+0x3C, 0xFD, 0x2C, 0x9A, 0x92, 0x65, 0xCE, 0x3E, 
+0x89, 0xA2, 0x94, 0x4E, 0xC9, 0xF3, 0x07, 0xD8, 
+0x39, 0x39, 0xB6, 0xCE, 0x83, 0xE8, 0x4C, 0xDA, 
+0x09, 0xA6, 0x9C, 0x17, 0xD8, 0x96, 0x0E, 0x88, 
+0x13, 0x20, 0xF2, 0x80, 0x58, 0x8A, 0x79, 0x1D, 
+0xAE, 0x51, 0xCB, 0x0E, 0x99, 0xC1, 0xA8, 0x6B, 
+0xAB, 0xA4, 0x57, 0x89, 0x5A, 0x11, 0x1B, 
+
+0xBC, 0xD1, 0x5B, 0xAA, 0xD4, 0x3C, 0x29, 0xD3, 
+0x34, 0x8C, 0xA7, 0xB0, 0x0D, 0x5E, 0xC1, 0x90, 
+0x71, 0x80, 0x63, 0x04, 0x5B, 0x81, 0x79, 0xCB, 
+0xC6, 0x51, 0x3A, 0x50, 0xA3, 0x94, 0xCE, 0x7C, 
+0x8A, 0x42, 0xAD, 0xDB, 0xFE, 0x60, 0x17, 0x91, 
+0x50, 0x4D, 0x6F, 0x48, 0xF1, 0xA1, 0x68, 0x83, 
+0x5E, 0x45, 0x37, 0x81, 0xEB, 0x57, 0x2F, 0x3E, 
+0x6B, 0x23, 0xE0, 0xC4, 0x1C, 0xC5, 0xCD, 0x3F, 
+0xC7, 0x92, 0x35, 0x7C, 0xAD, 0x9E, 0xE3, 0x18, 
+0x88, 0x6D, 0xDE, 0xFE, 0xBC, 0x3D, 0x12, 0x28, 
+0x24, 0xF4, 0x35, 0x57, 0xF9, 0x68, 0x5F, 0x6E, 
+0x75, 0x7E, 0x3F, 0xA5, 0xCA, 0xE4, 0x1F, 0x60, 
+0x6D, 0x7E, 0x8D, 0x7B, 0xEF, 0xF2, 0x3B, 0x4B, 
+0x24, 0x07, 0x6B, 0x58, 0x2A, 0xC8, 0x99, 0x4D, 
+0x67, 0x1B, 0x02, 0x53, 0x59, 0x0B, 0x73, 0xC1, 
+0xDC, 0x15, 0x26, 0xD7, 0x43, 0x25, 0xBF, 0xF6, 
+0xAC, 0xC5, 0xDA, 0x96, 0x73, 0x6F, 0x89, 0x7D, 
+
+0xC8, 0x49, 0xA0, 0xA5, 0x82, 0xDF, 0xD9, 0xBF, 
+0x8B, 0x24, 0x93, 0x3B, 0x97, 0x48, 0x52, 0x5F, 
+0x0F, 0xFF, 0x3F, 0x20, 0xA7, 0xD7, 0xFC, 0xA5, 
+0xF7, 0x8F, 0x9B, 0x24, 0xEB, 0xEF, 0x7A, 0xE0, 
+0xC9, 0xC2, 0x6F, 0x62, 0xBC, 0x73, 0x66, 0x50, 
+0x85, 0xE0, 0x0C, 0xA9, 0xA9, 0x4F, 0x60, 0xD0, 
+0x9B, 0xB4, 0x35, 0x3C, 0xD7, 0x0F, 0xB1, 0x73, 
+0x45, 0xDA, 0x8A, 0x96, 0xCD, 0xDD, 0xD5, 0xD4, 
+0xE6, 0x18, 0x42, 0x15, 0x32, 0xEE, 0xA8, 0x0D, 
+0x32, 0x2D, 0x46, 0xD8, 0x56, 0x72, 0x8F, 0xA8, 
+0x05, 0x17, 0x4C, 0x6A, 0x1C, 0x0D, 0x84, 0xF4, 
+0xFD, 0x9D, 0x2C, 0x2D, 0x9F, 0xED, 0x2A, 0xF0, 
+0xC8, 0xEE, 0x1E, 0xBB, 0x9F, 0x6B, 0x41, 0xCC, 
+0x11, 0xC8, 0xB2, 0x8D, 0x2A, 0x65, 0x47, 0xAE, 
+0x58, 0xBD, 0xF6, 0x55, 0x8A, 0x74, 0x23, 0x27, 
+0x2A, 0x5D, 0x1E, 0x4C, 0x50, 0x0E, 0x1E, 0xAB, 
+0x00, 0xBC, 0xC0, 0xEE, 0x77, 0xAD, 0x28, 0x78, 
+0x71, 
+
+0xF6, 0xDD, 0xFB, 0x41, 0x4A, 0xE0, 0x45, 0x7E, 
+0xAD, 0xB8, 0x32, 0xC6, 0x66, 0x47, 0x32, 0xFE, 
+0xCC, 0x83, 0xB6, 0x70, 0xDA, 0x8B, 0x7B, 0xE8, 
+0xC1, 0xFD, 0x4B, 0x9D, 0xE6, 0xB4, 0x59, 0xFD, 
+0xE0, 0x7F, 0x79, 0x19, 0x26, 0xC9, 0x7F, 0xAB, 
+0x25, 0x4C, 0x0E, 0x2A, 0x40, 0x3C, 0x9B, 0x20, 
+0x3B, 0x09, 0xC7, 0x86, 0xC1, 0xF0, 0x05, 0xCB, 
+0xD3, 0x4D, 0x44, 0x5A, 0xBE, 0x79, 0x13, 0xFB, 
+0xD1, 0x60, 0xEB, 0x0E, 0x8C, 0xD4, 0x7E, 0xB2, 
+0x4C, 0xA9, 0xBC, 0x8D, 0x45, 0xA6, 0x09, 0x71, 
+0x07, 0x78, 0x84, 0x9D, 0xC6, 0x91, 0x89, 0xB7, 
+0x0E, 0x1F, 0x4D, 0xD1, 0xA0, 0x7E, 0xCC, 0xC1, 
+0x70, 0xBC, 0xAE, 0x86, 0x9A, 0xF3, 0x4B, 0x0A, 
+0x67, 0x22, 0x25, 0xC2, 0xCE, 0x64, 0x99, 0x81, 
+0x46, 0x61, 0x47, 0x65, 0x51, 0x6D, 0xB2, 0x48, 
+0xB1, 0x00, 0x9A, 0x4A, 0x03, 0xDA, 0xE0, 0xE0, 
+0x6C, 0x26, 0x30, 0x41, 0x54, 0xA9, 0xDD, 0xA1, 
+0x1B, 0xEA, 
+
+0xDB, 0x41, 0xE5, 0xA9, 0x29, 0x35, 0x31, 0xAD, 
+0x76, 0x62, 0xE4, 0xD4, 0xE6, 0xB0, 0xE9, 0xBE, 
+0xB4, 0xFE, 0x8A, 0x9B, 0x21, 0xC3, 0x14, 0x0B, 
+0x1E, 0x71, 0xD2, 0xD9, 0x2E, 0xCF, 0xAF, 0x17, 
+0xFC, 0x25, 0xD7, 0x5E, 0x59, 0x43, 0xF0, 0x0F, 
+0xDD, 0x1C, 0x6E, 0x21, 0x51, 0x53, 0x68, 0xA0, 
+0x68, 0x8D, 0x26, 0xE5, 0xB1, 0xB9, 0x69, 0x37, 
+0x73, 0x57, 0x07, 0xBE, 0x4F, 0xE3, 0x11, 0x69, 
+0x6A, 0xD2, 0xB4, 0x68, 0x18, 0x26, 0x33, 0x58, 
+0x7A, 0x6F, 0x39, 0x1B, 0x83, 0x1D, 0x96, 0xF4, 
+0x01, 0xC5, 0x3D, 0x06, 0x43, 0x47, 0xA1, 0xB7, 
+0x12, 0xE5, 0x00, 0xA9, 0x2B, 0x3D, 0xE1, 0x95, 
+0x60, 0x9F, 0x72, 0x58, 0x01, 0x7F, 0xA9, 0x68, 
+0x60, 0x21, 0x1F, 0xA6, 0xD3, 0x5A, 0xAD, 0x2C, 
+0x8C, 0xB6, 0x8C, 0x9D, 0x4C, 0x81, 0x42, 0xEA, 
+0xDF, 0x31, 0x07, 0x52, 0x25, 0x8A, 0x20, 0xCB, 
+0x1E, 0x0A, 0x1E, 0x66, 0xCA, 0x54, 0xA0, 0xE1, 
+0x16, 0x61, 0xC3, 
+
+0xCE, 0x0B, 0xDA, 0x81, 0xA6, 0x1E, 0x2B, 0x29, 
+0x02, 0x3B, 0x3D, 0x96, 0xBE, 0x61, 0xEB, 0x68, 
+0x78, 0x89, 0x76, 0x66, 0xAA, 0x0D, 0x6A, 0x16, 
+0xB9, 0xBB, 0xDB, 0x31, 0xEA, 0xED, 0x86, 0x39, 
+0xCF, 0x3B, 0xDD, 0x6A, 0x08, 0x5E, 0x9C, 0x5B, 
+0x16, 0x49, 0x75, 0xDC, 0x87, 0xE7, 0x82, 0xF3, 
+0xDE, 0xFC, 0x3D, 0xA7, 0x60, 0x70, 0xFA, 0x33, 
+0x29, 0xF8, 0xD2, 0x6C, 0x38, 0x68, 0xDD, 0xA6, 
+0x4F, 0x92, 0xB5, 0xE4, 0x8B, 0xAF, 0x07, 0xF1, 
+0x28, 0xE3, 0x52, 0x31, 0x5C, 0x20, 0x4A, 0xBF, 
+0x58, 0xFB, 0xAB, 0xEA, 0x50, 0x64, 0xC3, 0x96, 
+0xB3, 0xA9, 0xE5, 0xEA, 0xC2, 0x19, 0xA7, 0x10, 
+0xCB, 0x96, 0xA6, 0xC6, 0x52, 0x18, 0xE5, 0x45, 
+0x8D, 0xB6, 0x70, 0xD0, 0x9A, 0xE5, 0x4C, 0x7F, 
+0x2B, 0x65, 0xFF, 0x1F, 0xD4, 0x15, 0xAD, 0xF9, 
+0xCE, 0x29, 0x30, 0x2A, 0xA3, 0xF5, 0x4D, 0x08, 
+0x86, 0x48, 0x4B, 0xBF, 0x97, 0x82, 0x6D, 0x1B, 
+0x9F, 0x0A, 0x53, 0xA5, 
+
+0x98, 0x60, 0x2D, 0xEF, 0x04, 0xFD, 0x93, 0x2F, 
+0xDB, 0x45, 0xE1, 0x65, 0x6D, 0xDC, 0xA0, 0x84, 
+0x4B, 0xC3, 0x8E, 0xB0, 0x7C, 0x0D, 0x3B, 0xC8, 
+0x47, 0x75, 0x2B, 0x2C, 0x83, 0x30, 0xAF, 0x6C, 
+0x24, 0x0B, 0x90, 0x5C, 0x55, 0xDB, 0x83, 0x06, 
+0x8C, 0x03, 0x23, 0xD7, 0x85, 0x47, 0xF6, 0xFE, 
+0xBA, 0xD6, 0xFD, 0x77, 0xE6, 0x2C, 0x4C, 0x99, 
+0x7B, 0xE6, 0x6F, 0x96, 0x62, 0xAA, 0x41, 0x5B, 
+0x0E, 0x96, 0xD7, 0x23, 0xFF, 0x3E, 0x80, 0x4B, 
+0x48, 0xF5, 0xE1, 0xD7, 0xBD, 0xB7, 0x9F, 0x95, 
+0xCB, 0xCC, 0x98, 0x82, 0x9A, 0x94, 0x92, 0x4F, 
+0x17, 0xAD, 0x92, 0xCF, 0x68, 0xCC, 0x7A, 0xE0, 
+0xDD, 0x29, 0x82, 0x7D, 0xED, 0xDF, 0xAA, 0xED, 
+0x30, 0x2A, 0xE1, 0x38, 0x7E, 0xC4, 0xC1, 0xBD, 
+0x8E, 0x32, 0xB9, 0x7A, 0x90, 0x30, 0x78, 0xC3, 
+0x97, 0xAB, 0xCD, 0xDB, 0x97, 0x69, 0xE5, 0x4A, 
+0xB1, 0x82, 0xA6, 0x9A, 0xC6, 0x78, 0xE6, 0x67, 
+0x37, 0x5A, 0x48, 0x54, 0x6A, 
+
+0xE0, 0xDD, 0xE4, 0xD0, 0xA4, 0x07, 0xBA, 0x5C, 
+0xB9, 0xE0, 0x1F, 0x48, 0xC3, 0xF7, 0x79, 0x5E, 
+0x31, 0xAC, 0x79, 0x4A, 0x4C, 0x84, 0x58, 0x2A, 
+0xCB, 0xD3, 0x50, 0xAE, 0xF3, 0x9C, 0x1C, 0x62, 
+0x2C, 0xED, 0x6D, 0xE2, 0x70, 0x16, 0x9D, 0x23, 
+0x18, 0xFB, 0x4D, 0xDD, 0x45, 0xBC, 0x5F, 0x79, 
+0xA6, 0xDD, 0x82, 0xD9, 0xC5, 0xE7, 0x96, 0x60, 
+0x00, 0x79, 0x33, 0x2D, 0x63, 0xE8, 0x46, 0x16, 
+0x2A, 0xD1, 0x13, 0x7C, 0x9C, 0x24, 0x9A, 0x9D, 
+0x2D, 0x76, 0x04, 0x57, 0x1D, 0xDE, 0x5F, 0x3F, 
+0xA7, 0x83, 0xB4, 0x8D, 0x8E, 0xAC, 0xB0, 0x39, 
+0xC4, 0xB0, 0x9D, 0xC7, 0x1B, 0x3F, 0x98, 0xDD, 
+0x4A, 0xF5, 0x3B, 0xA0, 0x74, 0x7C, 0xBB, 0x71, 
+0x3E, 0xBB, 0x1B, 0xA5, 0x14, 0x6D, 0x8B, 0xC1, 
+0x49, 0xA5, 0xB2, 0x30, 0x8E, 0xE3, 0x84, 0x5E, 
+0xF3, 0x9F, 0x4F, 0xF1, 0x82, 0x36, 0x9D, 0xB7, 
+0x09, 0x66, 0x57, 0xFF, 0xF6, 0x67, 0xD5, 0xF0, 
+0xD5, 0x12, 0xC6, 0xCD, 0x17, 0xCA, 
+
+0x7E, 0x1C, 0xDB, 0x18, 0x6B, 0x20, 0xBF, 0xBF, 
+0x98, 0xA9, 0x8D, 0xDF, 0xE1, 0x62, 0xF9, 0x71, 
+0x22, 0x01, 0xBA, 0xF8, 0x34, 0x86, 0xDB, 0x8F, 
+0x45, 0x51, 0xC9, 0x2C, 0x75, 0x0E, 0xAA, 0x39, 
+0x78, 0x49, 0xF1, 0x2C, 0x54, 0xB7, 0xB8, 0x7C, 
+0x96, 0x6A, 0xB5, 0xF7, 0xEE, 0x11, 0x9F, 0x04, 
+0xA7, 0xDC, 0xE6, 0x37, 0x20, 0x40, 0xC5, 0x55, 
+0x64, 0xB3, 0xA0, 0x6D, 0x7E, 0x08, 0x92, 0x69, 
+0x4D, 0xE2, 0xD9, 0xDF, 0xA7, 0x4A, 0xE1, 0xE0, 
+0x22, 0x30, 0xF0, 0x5A, 0xC5, 0xC9, 0x12, 0xB6, 
+0x8A, 0x9B, 0xFA, 0x2C, 0xF7, 0x93, 0x85, 0x77, 
+0x0A, 0x07, 0x2D, 0x90, 0x08, 0x3B, 0xA6, 0x8C, 
+0x85, 0xD3, 0xBA, 0x78, 0xBD, 0x87, 0x87, 0xB6, 
+0x3A, 0x48, 0xBC, 0x87, 0xC0, 0xF8, 0xBC, 0x2E, 
+0x5B, 0xA8, 0xCE, 0xA0, 0xC2, 0x7C, 0xEF, 0xD8, 
+0xC0, 0x4C, 0xAB, 0x11, 0x3F, 0x80, 0xED, 0x93, 
+0xE9, 0x0A, 0x1B, 0xFD, 0x13, 0xF4, 0xFE, 0x51, 
+0x2E, 0x6A, 0x10, 0xEF, 0x1F, 0xD5, 0x77, 
+
+0x55, 0xC4, 0xB6, 0x43, 0xD1, 0x37, 0x18, 0x37, 
+0x13, 0x6F, 0x13, 0xA6, 0x8F, 0xFD, 0x75, 0x5E, 
+0xDD, 0x7E, 0x80, 0x4F, 0x73, 0x8C, 0x6B, 0xAA, 
+0x2C, 0x54, 0x01, 0xFC, 0x34, 0x7B, 0x1F, 0x39, 
+0x39, 0x0C, 0xF6, 0x07, 0x38, 0x56, 0x9F, 0x23, 
+0xD3, 0x16, 0xFC, 0x65, 0x8B, 0x7C, 0x99, 0x4C, 
+0x29, 0xC8, 0x59, 0x6E, 0x22, 0x52, 0xDF, 0xE2, 
+0x5C, 0x20, 0x62, 0x8D, 0xDC, 0xBF, 0xEE, 0xB5, 
+0x63, 0x27, 0xEF, 0x61, 0xA8, 0x69, 0xB7, 0x85, 
+0xC6, 0xD4, 0x94, 0xBF, 0x23, 0x81, 0xCC, 0x61, 
+0x44, 0x04, 0xB9, 0x20, 0x24, 0xB2, 0x8A, 0xDA, 
+0x0B, 0xAD, 0xE1, 0x7E, 0x3A, 0x50, 0x37, 0x6D, 
+0x12, 0xB3, 0x25, 0x90, 0x35, 0x6D, 0x45, 0xD5, 
+0x35, 0xBD, 0x79, 0xBE, 0x04, 0x5C, 0xE2, 0x32, 
+0x9C, 0x5C, 0xD7, 0x54, 0xF7, 0x8A, 0x6A, 0x61, 
+0x7F, 0x2F, 0x9B, 0x55, 0xF4, 0x5D, 0x2F, 0x81, 
+0x79, 0xFA, 0x92, 0x77, 0x73, 0xBC, 0x22, 0x3F, 
+0x47, 0x31, 0x7C, 0xA1, 0xC1, 0xDD, 0x54, 0x35, 
+
+0x6B, 0xC8, 0x4F, 0x39, 0x37, 0x06, 0x49, 0x44, 
+0xC4, 0x7D, 0x8C, 0x7B, 0xBD, 0xA9, 0xBA, 0xFC, 
+0x5A, 0x76, 0xAD, 0x45, 0x8C, 0x1C, 0x30, 0x70, 
+0xBF, 0x74, 0x4D, 0xAD, 0x3F, 0x8D, 0x5B, 0x80, 
+0xB4, 0x5A, 0x0F, 0x66, 0x81, 0xB0, 0x25, 0x32, 
+0xFD, 0x76, 0xA6, 0x30, 0xB6, 0xA3, 0x8E, 0xF0, 
+0xE4, 0xFD, 0xB8, 0xD2, 0x4A, 0x85, 0xA0, 0xFC, 
+0x6F, 0xB9, 0x09, 0xFE, 0x58, 0x98, 0xE0, 0x94, 
+0x6D, 0xCE, 0xED, 0xCF, 0x03, 0xD4, 0x40, 0x0B, 
+0x25, 0xCA, 0x83, 0x8B, 0x4A, 0x1D, 0x49, 0x25, 
+0x17, 0xF2, 0x69, 0x09, 0xC4, 0xC2, 0xB1, 0xAF, 
+0xA5, 0x22, 0xEC, 0xE9, 0x85, 0x00, 0x30, 0xBA, 
+0x22, 0x26, 0x0E, 0x0A, 0xD7, 0x1A, 0xBC, 0x82, 
+0x0C, 0x9B, 0x3B, 0xFE, 0x6F, 0x53, 0x40, 0xCA, 
+0x6A, 0x4E, 0x7E, 0x3E, 0x6D, 0x3A, 0xB4, 0x9E, 
+0xD5, 0xC9, 0x9D, 0x4C, 0x35, 0x9D, 0x0E, 0x8F, 
+0x92, 0xC2, 0xEA, 0x54, 0x63, 0x0C, 0x5A, 0xF3, 
+0xA7, 0x4C, 0x5B, 0xCC, 0xD0, 0x1A, 0x56, 0xD1, 
+0x2E, 
+
+0x7B, 0xEE, 0x02, 0xEC, 0xC6, 0x8F, 0xC3, 0x3D, 
+0x99, 0x9D, 0x90, 0x63, 0xD9, 0x68, 0xE7, 0x29, 
+0x9E, 0x2B, 0x16, 0x53, 0x8C, 0xC0, 0x16, 0x47, 
+0x14, 0xB7, 0x0F, 0x47, 0xA6, 0x03, 0x46, 0x86, 
+0x7C, 0x9E, 0x40, 0x66, 0x74, 0x6F, 0xCD, 0xF9, 
+0x18, 0x79, 0xAC, 0xF8, 0xE0, 0x2D, 0x09, 0xAE, 
+0xED, 0x1F, 0x4B, 0xBA, 0x84, 0xAC, 0x2B, 0xA7, 
+0x43, 0xC2, 0x53, 0x6F, 0x30, 0xF0, 0x8A, 0x8E, 
+0xCA, 0x20, 0x65, 0x7C, 0x75, 0x12, 0xD8, 0xC9, 
+0xCC, 0x28, 0xEA, 0x74, 0x69, 0x2E, 0x08, 0x09, 
+0x18, 0xA2, 0x74, 0x52, 0x8A, 0x4A, 0xCA, 0x75, 
+0xB4, 0xD7, 0xC6, 0x78, 0xBB, 0xD2, 0xC5, 0x16, 
+0xC5, 0xCC, 0xC9, 0x90, 0x5C, 0x6D, 0x87, 0xE6, 
+0x7C, 0x92, 0x41, 0xBC, 0x82, 0xA0, 0xFF, 0xFF, 
+0x2E, 0xFA, 0xBB, 0xCB, 0x42, 0x1C, 0xBC, 0xC6, 
+0x1F, 0x15, 0xCB, 0x82, 0x98, 0x9E, 0x0D, 0x13, 
+0x89, 0x87, 0x30, 0x45, 0x21, 0x18, 0x9C, 0xB6, 
+0xB1, 0xD1, 0x2C, 0x3A, 0xCB, 0x5F, 0x5A, 0x94, 
+0xE7, 0x79, 
+
+0x89, 0x5A, 0x45, 0xB8, 0x63, 0xB8, 0xA8, 0x8A, 
+0x36, 0xA9, 0x05, 0x1A, 0xB0, 0x0E, 0x9F, 0xBD, 
+0x85, 0x84, 0x89, 0x8B, 0x7C, 0x33, 0x6E, 0x2B, 
+0xB6, 0xEF, 0x33, 0xCB, 0x7D, 0x77, 0xFA, 0x14, 
+0x81, 0x02, 0x4D, 0x0A, 0xE2, 0x15, 0x9A, 0xA1, 
+0x73, 0x6F, 0x48, 0x3C, 0x4D, 0xCE, 0x17, 0x69, 
+0xF2, 0xB5, 0x11, 0xAB, 0x20, 0xA0, 0x73, 0x6D, 
+0xF3, 0x51, 0x6E, 0xFE, 0x7D, 0x4F, 0xDE, 0xEE, 
+0x63, 0xD8, 0x4F, 0x97, 0xE7, 0x21, 0x1C, 0x15, 
+0xB8, 0x32, 0xB3, 0x60, 0xDC, 0x1D, 0xDE, 0x11, 
+0xC2, 0x54, 0x89, 0x84, 0xA8, 0x72, 0x11, 0x36, 
+0x69, 0x0D, 0x86, 0x96, 0x8B, 0x7C, 0xE8, 0xA5, 
+0xAF, 0x48, 0x30, 0x77, 0x4A, 0x3A, 0xC0, 0x65, 
+0x6D, 0x5C, 0xAD, 0xB5, 0xA8, 0xA4, 0xD3, 0x83, 
+0xF7, 0xA4, 0x8D, 0x6B, 0x8D, 0x07, 0xBD, 0x76, 
+0xC7, 0x35, 0xCC, 0x7B, 0x39, 0x23, 0xA1, 0x4B, 
+0x6B, 0x68, 0x50, 0x0A, 0x9E, 0x01, 0xFA, 0x52, 
+0xA4, 0x90, 0xB8, 0xF1, 0xA2, 0xA6, 0xAA, 0x66, 
+0xA7, 0x1C, 0xF7, 
+
+0x3A, 0x93, 0xAC, 0x1C, 0x2F, 0x52, 0x5A, 0x88, 
+0x3D, 0x6C, 0x65, 0x03, 0xCE, 0x81, 0xD3, 0xC5, 
+0x97, 0x63, 0x3C, 0x27, 0xE9, 0xD4, 0x4E, 0xCF, 
+0x76, 0x07, 0x97, 0x68, 0x98, 0x0E, 0x87, 0x38, 
+0x85, 0x58, 0x6B, 0x82, 0xC3, 0x85, 0x66, 0x8B, 
+0xA6, 0xDF, 0x27, 0x28, 0xC0, 0xAA, 0x64, 0x2B, 
+0x65, 0x13, 0x94, 0x4B, 0x7E, 0xCB, 0x65, 0x51, 
+0x1E, 0x16, 0x0F, 0x4C, 0x11, 0xF8, 0xD4, 0xFA, 
+0x18, 0xB4, 0x6A, 0xCC, 0x9F, 0x8B, 0x62, 0xE8, 
+0x27, 0xA4, 0xE2, 0xF0, 0xF2, 0xCB, 0xE0, 0x11, 
+0xF3, 0xD2, 0xAF, 0x92, 0x10, 0xD4, 0x05, 0x01, 
+0x89, 0xA8, 0x56, 0xC8, 0x1F, 0x75, 0x6B, 0x27, 
+0x46, 0x08, 0xF0, 0xC3, 0x9F, 0xF5, 0x9F, 0x28, 
+0xA3, 0x7D, 0x84, 0x8F, 0x4F, 0x73, 0xDF, 0x16, 
+0x74, 0xC3, 0x6F, 0x9D, 0x80, 0x42, 0x88, 0x24, 
+0x4F, 0xBF, 0x50, 0x15, 0x33, 0xFA, 0xE6, 0xAC, 
+0x04, 0x46, 0x84, 0xCE, 0x25, 0xD1, 0x7E, 0xF5, 
+0xA3, 0x1B, 0x5B, 0x74, 0x7D, 0x45, 0xBD, 0x59, 
+0x2E, 0x67, 0x24, 0x15, 
+
+0x68, 0xCE, 0xFE, 0x85, 0x84, 0x04, 0x15, 0x7E, 
+0x1E, 0xB4, 0x87, 0x63, 0xE4, 0xEC, 0x7C, 0x4F, 
+0x0A, 0xFF, 0x3A, 0x74, 0x66, 0x89, 0x64, 0x67, 
+0xCB, 0xF9, 0x81, 0x9D, 0x06, 0xD8, 0xF4, 0x2B, 
+0x5C, 0xA8, 0x3E, 0x64, 0xF1, 0x79, 0xB4, 0x40, 
+0xD1, 0x53, 0xA0, 0xD7, 0x48, 0xC9, 0xE2, 0x44, 
+0x1B, 0x4D, 0xF6, 0x7F, 0x3A, 0x56, 0x44, 0xD8, 
+0x5B, 0x7F, 0x8E, 0xA8, 0x7F, 0x20, 0x1D, 0x02, 
+0xFF, 0x57, 0xAC, 0xE1, 0xA3, 0x25, 0x93, 0x5D, 
+0x51, 0xAB, 0xCE, 0x1D, 0x36, 0x19, 0xC6, 0xF2, 
+0x64, 0xD9, 0x6A, 0x14, 0x7A, 0x48, 0x0E, 0xD1, 
+0x8D, 0x2B, 0xAD, 0x8D, 0x29, 0x00, 0x41, 0x2E, 
+0xA3, 0xAE, 0xD4, 0x36, 0x92, 0x3A, 0xFD, 0x5A, 
+0xF7, 0x84, 0x6B, 0x08, 0x4A, 0x00, 0xC2, 0x99, 
+0xF9, 0xE7, 0x20, 0xAF, 0xDA, 0xD5, 0xEB, 0x23, 
+0xDA, 0x76, 0x95, 0xA3, 0x50, 0x26, 0x16, 0x6A, 
+0xB8, 0x1A, 0xBE, 0x41, 0x54, 0x46, 0xF1, 0x6A, 
+0x52, 0xEF, 0x3C, 0x0D, 0x9D, 0x9B, 0x53, 0x31, 
+0x1C, 0x91, 0x22, 0x39, 0xD4, 
+
+0x4C, 0x3F, 0x3F, 0xD9, 0x5E, 0x6D, 0xF7, 0x0C, 
+0xE4, 0x8B, 0x78, 0x38, 0x1B, 0x84, 0xF0, 0x62, 
+0x14, 0xD9, 0x29, 0xBE, 0xA9, 0xF9, 0x6E, 0x22, 
+0xD1, 0xDC, 0xC5, 0xB0, 0x81, 0x33, 0x3A, 0xA3, 
+0x36, 0x70, 0xED, 0xAF, 0x78, 0x9E, 0x5E, 0xA4, 
+0xB0, 0x51, 0x87, 0x9D, 0x89, 0xD3, 0x17, 0xB1, 
+0x3B, 0xAE, 0xEF, 0xE6, 0x53, 0x60, 0x72, 0xD1, 
+0xDB, 0x92, 0x4F, 0xCF, 0x45, 0xC8, 0xAB, 0x26, 
+0x47, 0xF5, 0xCF, 0xDA, 0x3F, 0xB8, 0x7B, 0xFC, 
+0x2A, 0x26, 0xB8, 0x15, 0x31, 0x8F, 0xC4, 0xDE, 
+0x28, 0x99, 0x78, 0x1D, 0xB5, 0xA1, 0x65, 0x78, 
+0x6F, 0x31, 0x42, 0x6D, 0x4D, 0x7E, 0x45, 0xD7, 
+0x50, 0xFF, 0xCC, 0x1B, 0x62, 0x9A, 0xE5, 0x0E, 
+0x78, 0xEE, 0x48, 0x39, 0x1D, 0xEE, 0xEC, 0xC3, 
+0x4D, 0x1A, 0x6A, 0x6C, 0x19, 0x0E, 0x01, 0x15, 
+0xC4, 0x83, 0x4C, 0x51, 0x70, 0xA1, 0x7A, 0x68, 
+0xD6, 0x2B, 0xEB, 0x65, 0x31, 0x2E, 0x6B, 0x9F, 
+0xF6, 0x90, 0xB1, 0x41, 0x1B, 0x35, 0xD8, 0xEA, 
+0x68, 0xFB, 0x9F, 0x77, 0xEB, 0xBC, 
+
+0xE5, 0x65, 0xC0, 0xF8, 0xAD, 0xAD, 0x60, 0x6F, 
+0xB2, 0x8B, 0xAC, 0x0C, 0x42, 0xA4, 0xEC, 0xB8, 
+0x7F, 0xFF, 0xB8, 0xEF, 0x4A, 0x3C, 0x37, 0x05, 
+0x44, 0xE3, 0x92, 0x5B, 0xC2, 0x6E, 0x44, 0x2C, 
+0x05, 0xA8, 0xC7, 0xEE, 0x21, 0x3E, 0xE5, 0xAE, 
+0xB4, 0x8B, 0x8E, 0x8D, 0x00, 0x49, 0x3B, 0x4E, 
+0x9C, 0x71, 0xEC, 0x38, 0x8E, 0x3C, 0x40, 0x4E, 
+0xD4, 0x2D, 0x43, 0x5D, 0xF5, 0xDC, 0x18, 0xFB, 
+0xEC, 0xE9, 0x17, 0xA7, 0x2D, 0x26, 0x46, 0x97, 
+0x37, 0x15, 0x6D, 0x66, 0x6D, 0x21, 0x75, 0xCE, 
+0x32, 0x33, 0x04, 0x4F, 0x46, 0x3C, 0xA6, 0xB9, 
+0x6C, 0x6E, 0x5A, 0xFD, 0xC9, 0xEB, 0x5E, 0x6F, 
+0xC2, 0x6A, 0x49, 0x22, 0x49, 0x01, 0xB6, 0x18, 
+0x71, 0xC4, 0xA0, 0xF4, 0x10, 0xF6, 0xD4, 0xD0, 
+0xD4, 0xA7, 0x1D, 0xEF, 0x67, 0x30, 0x5D, 0x51, 
+0x8E, 0x04, 0xDE, 0x00, 0xF1, 0x38, 0x18, 0xC7, 
+0xB0, 0xED, 0x69, 0xB6, 0x59, 0x98, 0xEF, 0x7C, 
+0x8E, 0x8A, 0x50, 0xFA, 0x1D, 0x2D, 0xC4, 0x41, 
+0xCA, 0xDF, 0x69, 0xA6, 0x03, 0x32, 0x17, 
+
+0x11, 0x3C, 0x49, 0x04, 0xB5, 0x1A, 0x7C, 0x7E, 
+0xDF, 0xDF, 0x72, 0xBE, 0x5C, 0x41, 0xB9, 0xDD, 
+0x06, 0x3C, 0x69, 0x3D, 0xBA, 0x8A, 0xDD, 0x21, 
+0x60, 0xEE, 0x98, 0xC3, 0x97, 0x5D, 0xC6, 0x39, 
+0x22, 0x25, 0x2A, 0xE9, 0x91, 0xE6, 0xF0, 0x95, 
+0x35, 0xD6, 0xFB, 0x29, 0x07, 0x88, 0x18, 0x1A, 
+0x44, 0x71, 0x85, 0x90, 0xF9, 0x86, 0xB1, 0x34, 
+0xA4, 0x89, 0xC2, 0x7B, 0x21, 0x17, 0x48, 0x45, 
+0xC7, 0xE2, 0x13, 0x9C, 0x4A, 0x16, 0xFE, 0x1A, 
+0xBF, 0xB7, 0x00, 0xB7, 0xCC, 0x48, 0x9B, 0x3B, 
+0xDF, 0x31, 0x56, 0x12, 0x15, 0x19, 0xEA, 0x73, 
+0x7B, 0x7B, 0x74, 0xB1, 0x66, 0xC9, 0x77, 0x04, 
+0xD9, 0x57, 0x79, 0xA4, 0x5A, 0x3D, 0x76, 0x4D, 
+0x60, 0x42, 0x67, 0x3A, 0xEB, 0x85, 0xD5, 0xC4, 
+0xB0, 0x65, 0x5B, 0xEB, 0xBE, 0xFB, 0xAF, 0x61, 
+0x7B, 0xE1, 0x8B, 0x2D, 0x18, 0xBF, 0x3D, 0xEC, 
+0xEA, 0x76, 0xBA, 0xBB, 0x9F, 0xA5, 0x88, 0xBE, 
+0x13, 0x54, 0x8B, 0x4A, 0x86, 0x4C, 0xE3, 0xE3, 
+0xAB, 0x1E, 0x56, 0xE6, 0xAC, 0xBB, 0xC2, 0xFC, 
+
+0x7B, 0xF6, 0xDF, 0x1E, 0x5A, 0x1B, 0x9A, 0x52, 
+0xC2, 0x8C, 0xD3, 0xD7, 0x2F, 0x75, 0x3A, 0x59, 
+0xAA, 0x20, 0x0F, 0xD0, 0x07, 0x87, 0xEA, 0xDC, 
+0x11, 0xB1, 0x9C, 0xF8, 0x7A, 0xAC, 0x4C, 0xA1, 
+0x68, 0x99, 0xB0, 0x9B, 0x69, 0x30, 0x46, 0x3D, 
+0x48, 0x53, 0xC9, 0x09, 0x73, 0x9C, 0x29, 0xDD, 
+0x7D, 0x78, 0x51, 0xB7, 0x67, 0x9F, 0xC1, 0x1E, 
+0x70, 0xF3, 0x4D, 0x4B, 0xBA, 0xFC, 0x69, 0x24, 
+0x89, 0xF9, 0xA6, 0x22, 0x53, 0xEE, 0x4B, 0xFA, 
+0xE6, 0x50, 0xA4, 0x00, 0x83, 0x47, 0x58, 0x80, 
+0xBB, 0x6E, 0x67, 0x29, 0x26, 0x45, 0xA4, 0x4E, 
+0x54, 0x49, 0xA3, 0x30, 0x66, 0x8B, 0xF3, 0x66, 
+0xCC, 0x3E, 0x73, 0x31, 0xAB, 0xD1, 0x28, 0x77, 
+0xE0, 0xCC, 0xDE, 0xC9, 0x7D, 0x1F, 0x4F, 0x6E, 
+0xDE, 0x9B, 0xFC, 0xE6, 0x57, 0x52, 0xCD, 0x15, 
+0x89, 0x0D, 0xA9, 0x79, 0x85, 0xA8, 0x4D, 0x3B, 
+0x79, 0x1D, 0xF7, 0x63, 0x40, 0xF3, 0x11, 0x01, 
+0x1C, 0x96, 0xC9, 0x83, 0x31, 0x02, 0x3A, 0xA1, 
+0x8F, 0x7C, 0xB3, 0x6F, 0xCF, 0x6F, 0xA7, 0xB5, 
+0x53, 
+
+0xBD, 0x42, 0x44, 0x45, 0x92, 0x80, 0xB0, 0x2D, 
+0xE9, 0x70, 0xBC, 0x1D, 0x3F, 0x76, 0x58, 0xAA, 
+0xEB, 0x42, 0x7C, 0x89, 0xE8, 0xE8, 0xD6, 0x29, 
+0xE6, 0x8F, 0x47, 0xBB, 0xC3, 0x59, 0x30, 0x03, 
+0xD1, 0x71, 0xD1, 0xBA, 0xCA, 0xE6, 0x57, 0x18, 
+0xAB, 0x59, 0x2F, 0xEE, 0x67, 0x94, 0x45, 0x6F, 
+0xD4, 0x8C, 0x7F, 0xF9, 0xF0, 0xC7, 0x58, 0xB7, 
+0x57, 0x18, 0xE6, 0xB7, 0x9C, 0xE5, 0x97, 0x81, 
+0xB5, 0xB2, 0x3E, 0x50, 0xF7, 0x3B, 0x6A, 0x3A, 
+0x72, 0x4A, 0xA9, 0x3B, 0x17, 0x33, 0x55, 0x5D, 
+0xAC, 0xFA, 0x08, 0xFC, 0x6B, 0x7D, 0xB4, 0x73, 
+0x16, 0xD3, 0x9F, 0x39, 0x93, 0xD9, 0x41, 0x76, 
+0x50, 0xCB, 0xED, 0xDB, 0x55, 0xB7, 0xCE, 0xD8, 
+0xC1, 0xBC, 0x3A, 0x21, 0x83, 0x70, 0x69, 0x63, 
+0xAD, 0x07, 0x62, 0x74, 0x8D, 0x74, 0xA7, 0x11, 
+0xD0, 0xC6, 0xD6, 0xCE, 0x61, 0x7F, 0x0F, 0x17, 
+0xAD, 0x62, 0xC0, 0x42, 0x24, 0x84, 0x0D, 0x57, 
+0x56, 0xF1, 0xAF, 0x31, 0x95, 0x2D, 0x7B, 0x3C, 
+0x4A, 0x80, 0xDD, 0xB0, 0x01, 0xAF, 0x96, 0x3C, 
+0x19, 0x69, 
+
+0x30, 0x48, 0xA3, 0xF0, 0x90, 0xD9, 0xF7, 0x7D, 
+0x04, 0x92, 0x61, 0xDA, 0x77, 0xE1, 0xEE, 0x90, 
+0xAF, 0x12, 0xCF, 0xDE, 0xB2, 0x9A, 0xB2, 0xD8, 
+0x93, 0x98, 0xD5, 0xD3, 0xC9, 0x6F, 0x8A, 0xDC, 
+0x13, 0x4F, 0x9D, 0x69, 0x2B, 0xF3, 0xE2, 0x69, 
+0x98, 0x6F, 0xB0, 0xD3, 0x1B, 0x3B, 0x14, 0xC2, 
+0x24, 0x5C, 0x03, 0xE3, 0x67, 0x77, 0x82, 0xCC, 
+0xE4, 0x38, 0xE5, 0x27, 0x40, 0xDE, 0x1E, 0x0E, 
+0xBC, 0x07, 0x18, 0x93, 0x48, 0x4D, 0xCF, 0xE9, 
+0xEE, 0xFA, 0xA9, 0x3E, 0xC2, 0xCF, 0x46, 0xC5, 
+0x16, 0xA9, 0x57, 0x40, 0x09, 0x2B, 0x82, 0x1D, 
+0x7E, 0x2A, 0x01, 0xB5, 0xCC, 0xC9, 0x45, 0x81, 
+0x05, 0xFB, 0x3A, 0x1F, 0x4E, 0xAC, 0x4D, 0x44, 
+0xF2, 0xF1, 0x20, 0x21, 0x99, 0x69, 0x3F, 0x77, 
+0xBF, 0x2D, 0xD8, 0xA1, 0x02, 0x0F, 0x25, 0x7A, 
+0x08, 0x3C, 0xC8, 0xEB, 0x4C, 0x17, 0x15, 0x3B, 
+0xAE, 0x3D, 0xE2, 0xB4, 0x8E, 0x4A, 0x1A, 0x96, 
+0x4C, 0x8E, 0x7B, 0xCA, 0x03, 0x02, 0x20, 0xB2, 
+0x01, 0x1B, 0xEB, 0x69, 0x5F, 0x6E, 0x78, 0xB0, 
+0xBE, 0xB3, 0xD3, 
+
+0xB7, 0x1C, 0x84, 0x1E, 0x9D, 0x30, 0x2C, 0x40, 
+0x6D, 0xB5, 0xB6, 0x1E, 0x11, 0xF6, 0x59, 0xAC, 
+0x0C, 0xFF, 0x6A, 0x12, 0xC6, 0x79, 0xE7, 0x6E, 
+0x5C, 0x7A, 0xE9, 0x23, 0x2F, 0x64, 0xAD, 0x12, 
+0x1E, 0x7D, 0x4A, 0x25, 0xAF, 0x1D, 0x35, 0x12, 
+0xD7, 0xE3, 0x4C, 0xC9, 0xDB, 0x72, 0xEF, 0xE6, 
+0x57, 0x71, 0xFA, 0xF9, 0x08, 0x04, 0x87, 0xEC, 
+0xA4, 0x62, 0xEA, 0xE6, 0x1A, 0x9E, 0xA5, 0x5B, 
+0x04, 0xCE, 0x8B, 0xB3, 0x35, 0x8A, 0x9F, 0x90, 
+0x50, 0x9B, 0xFC, 0x6D, 0x6C, 0x7A, 0x76, 0x78, 
+0x6D, 0x73, 0xA1, 0x08, 0x8A, 0xB1, 0xD2, 0x94, 
+0xB1, 0xC8, 0x9D, 0xE0, 0x1F, 0x4D, 0x9E, 0x4A, 
+0x11, 0xFE, 0xB0, 0x01, 0x98, 0x7B, 0x98, 0x6A, 
+0x9A, 0x7E, 0xEE, 0xE0, 0x3A, 0xAA, 0xD8, 0xF3, 
+0x2A, 0x93, 0xD0, 0x0C, 0xC0, 0x80, 0x3E, 0x8E, 
+0x42, 0x98, 0x89, 0x83, 0xD0, 0xE8, 0x25, 0x43, 
+0x8D, 0xEC, 0x2C, 0x93, 0x88, 0xC9, 0x34, 0xEC, 
+0x58, 0x60, 0x18, 0x51, 0x49, 0x61, 0x08, 0x53, 
+0x91, 0x42, 0x23, 0x1F, 0xFF, 0x1F, 0x6F, 0x31, 
+0x27, 0xEF, 0xAE, 0xA5, 
+
+0x33, 0x71, 0xF4, 0xF7, 0x5D, 0x0D, 0x0A, 0x2D, 
+0x4D, 0xEB, 0x3A, 0x9D, 0x5C, 0xD4, 0x8C, 0xFC, 
+0x0C, 0xF5, 0x7C, 0x0D, 0x05, 0xBF, 0xCD, 0x68, 
+0xC6, 0xEE, 0xD3, 0x17, 0x61, 0x2E, 0x9C, 0x35, 
+0xBD, 0xB3, 0x86, 0xB6, 0x1D, 0xEB, 0x2F, 0xE7, 
+0xD4, 0x77, 0x2A, 0xD8, 0x48, 0x2A, 0xE2, 0x10, 
+0x5A, 0x0B, 0xA3, 0xB5, 0x03, 0xB8, 0xB0, 0xAD, 
+0x4B, 0x1B, 0x0D, 0x8E, 0x17, 0xCB, 0x45, 0x87, 
+0xCD, 0xB0, 0xA0, 0x01, 0x2B, 0xD2, 0xCC, 0xB5, 
+0x67, 0xEB, 0x29, 0x24, 0x10, 0xE5, 0x1E, 0x99, 
+0x84, 0x9E, 0xA5, 0xD9, 0x84, 0xF5, 0x5A, 0x86, 
+0x18, 0xC4, 0xB7, 0xB6, 0x69, 0xAD, 0xC9, 0x25, 
+0xB4, 0x71, 0x27, 0xBC, 0x3D, 0xD4, 0xB1, 0xBF, 
+0x66, 0x75, 0x8E, 0xB0, 0x77, 0x84, 0xB6, 0x39, 
+0xBF, 0x57, 0xCA, 0xB6, 0x1D, 0xBE, 0x1A, 0x92, 
+0x48, 0x84, 0x78, 0x63, 0xE5, 0xDB, 0xDD, 0x22, 
+0x00, 0xC6, 0x5B, 0xDB, 0xB6, 0x27, 0xD0, 0x00, 
+0x9E, 0xA5, 0x36, 0x6B, 0xE2, 0x94, 0x16, 0x32, 
+0xFF, 0x83, 0x97, 0x2D, 0x99, 0x63, 0xC6, 0x92, 
+0xEF, 0x93, 0x86, 0x46, 0xD9, 
+
+0x1C, 0x9E, 0x9B, 0x78, 0xF0, 0x3D, 0xF0, 0x9A, 
+0xB3, 0x7D, 0x23, 0x50, 0xD4, 0xB1, 0x26, 0xFC, 
+0xA1, 0xFD, 0x91, 0x7E, 0x1C, 0xBB, 0x3C, 0xC9, 
+0x7D, 0x0B, 0x04, 0x21, 0xE0, 0xC8, 0xE1, 0x1D, 
+0x09, 0x2E, 0xAD, 0x9A, 0xC1, 0x8C, 0xB4, 0xF7, 
+0x9D, 0xE8, 0xB0, 0x08, 0x20, 0xBB, 0x6D, 0x3C, 
+0x8A, 0xC5, 0xCE, 0xE1, 0x09, 0x86, 0xD7, 0x25, 
+0x8B, 0x64, 0xBD, 0xD5, 0x10, 0x55, 0x1B, 0x5A, 
+0x9F, 0x01, 0x4B, 0xDD, 0x6B, 0x7C, 0xBF, 0xEB, 
+0x24, 0x38, 0x45, 0xD4, 0xAD, 0xB8, 0xDE, 0xEE, 
+0x36, 0xB7, 0xE6, 0x5B, 0xF3, 0xBD, 0x69, 0x24, 
+0xF4, 0x78, 0x26, 0x25, 0xF3, 0x37, 0x46, 0x49, 
+0x5A, 0x7C, 0xC0, 0xED, 0x26, 0x10, 0xFD, 0x81, 
+0xE7, 0xD2, 0x11, 0xD7, 0xAE, 0x07, 0xC2, 0x7F, 
+0xD0, 0x61, 0xD0, 0x51, 0xA7, 0x64, 0x5F, 0xB4, 
+0x9B, 0x60, 0xEF, 0x0E, 0x08, 0xE0, 0xC5, 0x57, 
+0xFC, 0xE9, 0xB0, 0x57, 0x71, 0x06, 0x63, 0x29, 
+0x95, 0xFA, 0xB3, 0x6A, 0x3A, 0x78, 0x25, 0x3D, 
+0x56, 0xDE, 0x9C, 0x86, 0x69, 0x21, 0x36, 0x32, 
+0x7A, 0xB3, 0xE0, 0xB1, 0x5A, 0x6B, 
+
+0x8A, 0x55, 0x22, 0xAA, 0x73, 0x90, 0xFC, 0x0A, 
+0x32, 0x65, 0x9C, 0xC9, 0x42, 0xF1, 0x42, 0x5E, 
+0xC9, 0x4A, 0xC3, 0xB1, 0x65, 0xF2, 0xD2, 0x65, 
+0x1C, 0x77, 0x84, 0x7B, 0x97, 0xA8, 0x06, 0xD3, 
+0x32, 0x24, 0xEB, 0x57, 0x64, 0x7B, 0x9F, 0x58, 
+0x12, 0x1E, 0x1C, 0x32, 0xBC, 0x46, 0xDE, 0xE7, 
+0xE2, 0x99, 0xD2, 0x05, 0xDB, 0x01, 0x58, 0x27, 
+0x9B, 0xD3, 0x6C, 0xC4, 0x28, 0x1B, 0x4A, 0x1E, 
+0x8F, 0x80, 0x7B, 0xCC, 0x83, 0x32, 0xA5, 0x9D, 
+0x11, 0x4E, 0xE4, 0x9A, 0x11, 0x1D, 0xB1, 0x2D, 
+0x08, 0x70, 0xF7, 0x37, 0x82, 0x60, 0x04, 0x01, 
+0x32, 0xB1, 0x2B, 0x5C, 0x72, 0x1B, 0x8D, 0xF3, 
+0x52, 0xC9, 0xE4, 0x81, 0x63, 0xB8, 0x4A, 0x94, 
+0x7B, 0x72, 0xCF, 0x59, 0x27, 0xF7, 0x80, 0xCD, 
+0xBA, 0x83, 0xA4, 0xC9, 0x83, 0x83, 0x9B, 0x6E, 
+0x0A, 0x36, 0xED, 0x9A, 0xF6, 0xDB, 0x2D, 0x38, 
+0x64, 0xF9, 0xAC, 0x71, 0xB8, 0x00, 0x33, 0x2D, 
+0x95, 0xCF, 0x0E, 0x8E, 0x6B, 0xAD, 0x9F, 0x73, 
+0xB6, 0x72, 0x70, 0xA3, 0x24, 0x20, 0xE6, 0xB1, 
+0xD3, 0x69, 0xFF, 0xFE, 0xE3, 0x13, 0x66, 
+
+0x2C, 0xF5, 0x72, 0x5E, 0x23, 0x95, 0xBF, 0x0B, 
+0xB2, 0x79, 0xEE, 0x86, 0x7C, 0x42, 0xF5, 0x3C, 
+0xCC, 0xF7, 0x08, 0xA4, 0x9D, 0x03, 0x88, 0xEE, 
+0x88, 0x56, 0x20, 0x6F, 0x23, 0x29, 0x49, 0x8B, 
+0x93, 0xB7, 0x84, 0xDC, 0xAB, 0x28, 0xA5, 0x21, 
+0xCF, 0x03, 0x85, 0x0D, 0x70, 0x87, 0xE9, 0xAF, 
+0x92, 0x7B, 0xDC, 0xF9, 0x6E, 0x80, 0x64, 0xDC, 
+0xB3, 0x32, 0x39, 0x1D, 0xF0, 0x3E, 0x94, 0xFD, 
+0xBB, 0x94, 0x5F, 0x7F, 0xB4, 0x5D, 0x84, 0x40, 
+0x92, 0xD1, 0xFB, 0xED, 0xA7, 0x56, 0xE5, 0xB2, 
+0xFF, 0xFB, 0x61, 0x8B, 0x5A, 0x33, 0x49, 0xB9, 
+0x18, 0x8F, 0x4B, 0xCF, 0x2F, 0xE0, 0x43, 0xDB, 
+0x6B, 0x0F, 0xBD, 0xC6, 0xEE, 0x3B, 0xAD, 0x7F, 
+0x7E, 0x76, 0x6B, 0xBE, 0x69, 0xDA, 0xD4, 0x23, 
+0x9B, 0x0B, 0x79, 0x0E, 0x18, 0x41, 0x1B, 0x06, 
+0x88, 0xB2, 0xB9, 0x1B, 0x59, 0x0F, 0x3C, 0x23, 
+0xCA, 0x79, 0x11, 0xEA, 0x64, 0x46, 0x75, 0xCD, 
+0x1F, 0x4E, 0xB5, 0x6A, 0xB2, 0x06, 0xAE, 0x97, 
+0x2A, 0x73, 0x0F, 0x76, 0x79, 0xCE, 0xF7, 0x6F, 
+0x64, 0x17, 0x93, 0x1C, 0x8C, 0x5C, 0xC5, 0xC7, 
+
+0xB7, 0xE5, 0x62, 0x47, 0x58, 0xD4, 0x79, 0xB5, 
+0x78, 0xFB, 0xBC, 0xD9, 0x57, 0xB0, 0x6F, 0x90, 
+0x77, 0x62, 0x89, 0x4D, 0xA5, 0xED, 0x13, 0xB9, 
+0x7E, 0x16, 0xDA, 0x2C, 0x07, 0x07, 0xFA, 0x8D, 
+0x74, 0x2F, 0xDA, 0xE1, 0xFB, 0x5D, 0x70, 0x6A, 
+0x53, 0x0D, 0x1A, 0xE9, 0x4E, 0x42, 0x14, 0xBB, 
+0x7F, 0x70, 0x3D, 0x33, 0x77, 0xC1, 0xE1, 0x49, 
+0xB9, 0xBA, 0x15, 0x9C, 0xFC, 0x43, 0xF2, 0x24, 
+0x99, 0x92, 0x04, 0x56, 0x4C, 0x1F, 0x00, 0x3B, 
+0x66, 0x01, 0x38, 0x3E, 0x3E, 0xC2, 0x73, 0x14, 
+0xDA, 0xA4, 0x30, 0x1B, 0xDA, 0x01, 0xF7, 0x98, 
+0x4E, 0x6B, 0x05, 0xD3, 0xDC, 0xB8, 0xB3, 0xBD, 
+0x16, 0x92, 0xE8, 0xE2, 0x9A, 0x84, 0xF3, 0x93, 
+0x5C, 0x44, 0x42, 0xAC, 0x88, 0xB6, 0xB2, 0xAB, 
+0x46, 0xFE, 0x18, 0x68, 0xC3, 0x58, 0xC2, 0xD1, 
+0x31, 0xEE, 0x56, 0x88, 0x9D, 0x83, 0xF4, 0xB8, 
+0x58, 0x87, 0x1A, 0xCA, 0xB1, 0x69, 0xD0, 0x5C, 
+0x37, 0x9E, 0x2C, 0x93, 0x9B, 0xFD, 0xBC, 0x2B, 
+0x30, 0xAB, 0xC1, 0x1F, 0x2E, 0x87, 0x38, 0x68, 
+0x69, 0x35, 0xF3, 0x42, 0x63, 0x39, 0xAD, 0xFD, 
+0x28, 
+
+0x32, 0xF3, 0x57, 0x80, 0x96, 0x8F, 0x8C, 0x3F, 
+0x0B, 0x7A, 0x5D, 0x88, 0x6D, 0x49, 0x80, 0x09, 
+0x19, 0x88, 0x3B, 0x4E, 0x95, 0x4E, 0xB0, 0x94, 
+0x31, 0x65, 0x43, 0x7D, 0x0A, 0xAB, 0xB8, 0x83, 
+0xE8, 0x3E, 0xE9, 0x5A, 0xBC, 0x8D, 0x48, 0x84, 
+0xF2, 0x86, 0xC4, 0x9C, 0x7E, 0x71, 0xD3, 0x54, 
+0x10, 0x9D, 0x86, 0xA1, 0x41, 0x69, 0x1F, 0x50, 
+0xFE, 0xB8, 0x58, 0x74, 0x65, 0xF8, 0x6B, 0x82, 
+0x54, 0x02, 0x74, 0xEA, 0xE6, 0xD5, 0x1E, 0xC1, 
+0x2B, 0xE3, 0x5E, 0x6B, 0xC8, 0x0D, 0xF3, 0x3B, 
+0x10, 0x6C, 0x81, 0x7C, 0xF7, 0x4D, 0x9E, 0x76, 
+0x98, 0x02, 0xB3, 0x5B, 0x25, 0x71, 0xE9, 0x0E, 
+0x91, 0x85, 0x74, 0xA2, 0x56, 0x0E, 0x1D, 0xF1, 
+0x95, 0xD2, 0xD9, 0xB9, 0xB7, 0xBF, 0xDB, 0x35, 
+0xED, 0x72, 0x70, 0x32, 0x6B, 0x02, 0x60, 0x7F, 
+0x4D, 0x21, 0xF8, 0xFA, 0xF5, 0x6C, 0x90, 0x73, 
+0x26, 0x47, 0x73, 0x6B, 0x86, 0x2F, 0xD5, 0x17, 
+0x74, 0x5D, 0xB4, 0xEF, 0x03, 0xEE, 0x1E, 0x14, 
+0x1F, 0x55, 0x82, 0xFC, 0x97, 0x91, 0x6E, 0x83, 
+0x69, 0x00, 0xB1, 0x5F, 0xFD, 0xD9, 0x9E, 0x46, 
+0xF7, 0xB8, 
+
+0x5C, 0x9E, 0x38, 0x8D, 0x59, 0x10, 0xC0, 0x7B, 
+0xAE, 0x05, 0xB9, 0x2A, 0x5C, 0x48, 0x49, 0x0F, 
+0xC7, 0x09, 0x16, 0x5F, 0x94, 0xFB, 0x09, 0x7D, 
+0x58, 0x47, 0x4D, 0xEB, 0xD7, 0x91, 0xAF, 0xCB, 
+0x71, 0xE0, 0x49, 0xDA, 0x7E, 0x05, 0x9B, 0xC2, 
+0x1D, 0x76, 0x42, 0x45, 0xA1, 0x53, 0x4D, 0xCC, 
+0x4D, 0xEA, 0xD2, 0xE1, 0x1F, 0x0D, 0x1F, 0x6C, 
+0xB4, 0xC8, 0x0C, 0x0F, 0xD9, 0xB3, 0x98, 0x95, 
+0x98, 0x16, 0x9D, 0x93, 0xC0, 0xAA, 0x13, 0xB1, 
+0xA3, 0xA8, 0x65, 0x3B, 0xC5, 0xC8, 0x45, 0x38, 
+0x6A, 0x0D, 0xEF, 0xF3, 0x5D, 0xB1, 0xDD, 0x10, 
+0xF1, 0xFC, 0x4D, 0xC1, 0x1F, 0x46, 0x4E, 0xEB, 
+0x6E, 0x95, 0x14, 0x9A, 0xBC, 0x64, 0xDB, 0xCC, 
+0x6C, 0xF1, 0x2F, 0x0B, 0xF2, 0xE7, 0xF0, 0x49, 
+0x66, 0x11, 0x20, 0x29, 0x0A, 0xA0, 0xA2, 0x0F, 
+0x3F, 0xEF, 0xBA, 0xEE, 0x6C, 0x0A, 0xE6, 0xE6, 
+0x73, 0xB6, 0xFE, 0xC0, 0x4F, 0xE9, 0x5E, 0x72, 
+0x31, 0xE0, 0xDE, 0xAA, 0xC2, 0xA2, 0xC2, 0x25, 
+0x63, 0x1D, 0x87, 0x6A, 0xB2, 0xE8, 0x86, 0xB7, 
+0xF6, 0x9B, 0x7E, 0x80, 0x1A, 0x44, 0x62, 0xDC, 
+0xDA, 0xF3, 0x44, 
+
+0x09, 0x25, 0xA7, 0x7D, 0xC4, 0xA6, 0x40, 0x30, 
+0x39, 0x11, 0x3A, 0x17, 0x6E, 0x66, 0x35, 0x3A, 
+0x78, 0xD7, 0x2D, 0xEA, 0x92, 0xC5, 0xE5, 0x9F, 
+0xA4, 0xFD, 0x53, 0x9D, 0x59, 0xD8, 0x2E, 0xF1, 
+0x78, 0xB3, 0x9B, 0x4A, 0xD0, 0x1E, 0x85, 0x73, 
+0xA0, 0x95, 0x7F, 0x27, 0x94, 0x40, 0x4A, 0xD6, 
+0xF6, 0xF1, 0x7A, 0xDB, 0x74, 0xB3, 0xA8, 0x28, 
+0xF6, 0x1B, 0xDD, 0x0F, 0x56, 0xE4, 0x13, 0x7A, 
+0xCC, 0x56, 0xFB, 0xCA, 0x49, 0xAC, 0x67, 0xC4, 
+0x2F, 0x58, 0x10, 0x1D, 0xAA, 0x60, 0x66, 0xF7, 
+0x5E, 0x63, 0x8B, 0x04, 0x01, 0x9A, 0x8F, 0x61, 
+0xD6, 0x6A, 0xFC, 0xE8, 0xE4, 0x04, 0x46, 0x65, 
+0x8D, 0xB6, 0x4E, 0x63, 0x25, 0xA3, 0xE3, 0xE9, 
+0x8D, 0x0B, 0xBA, 0xB0, 0xEA, 0x85, 0x35, 0xB6, 
+0xDC, 0x88, 0x3B, 0x3E, 0xCB, 0xA3, 0xF1, 0xAD, 
+0x21, 0x8C, 0x1B, 0x75, 0x6E, 0xFC, 0x7A, 0x46, 
+0x5C, 0x9A, 0x23, 0x07, 0xAC, 0xD1, 0xD3, 0xA4, 
+0xAD, 0xEB, 0x03, 0x73, 0x2C, 0x69, 0x1D, 0x74, 
+0x42, 0x30, 0xF5, 0xCA, 0xBD, 0x1B, 0x92, 0xEE, 
+0xB8, 0xF3, 0x79, 0x48, 0x1E, 0xBF, 0x36, 0x2E, 
+0xB4, 0xA4, 0x20, 0x98, 
+
+0x6B, 0x06, 0x2C, 0x0D, 0x9F, 0xDA, 0x82, 0x59, 
+0x62, 0x0E, 0x75, 0xA1, 0x08, 0x65, 0x1F, 0x5B, 
+0x60, 0x3A, 0x4B, 0xEC, 0x37, 0xE7, 0x3F, 0xE8, 
+0x74, 0x36, 0xD6, 0xA9, 0xBC, 0x35, 0xE6, 0x84, 
+0x2B, 0xDD, 0x37, 0x0A, 0x3A, 0xB1, 0xC7, 0xCE, 
+0x46, 0x28, 0xFE, 0xE7, 0xB9, 0xB8, 0x15, 0x95, 
+0xB1, 0x10, 0x68, 0xE9, 0x55, 0xEF, 0x5E, 0xCD, 
+0xA1, 0xBF, 0xC7, 0x62, 0x6F, 0xC5, 0x7F, 0x60, 
+0x70, 0x94, 0xC2, 0x6B, 0x2E, 0x1B, 0x50, 0xE4, 
+0xA8, 0x68, 0x78, 0xA1, 0xF3, 0x25, 0x3E, 0xDA, 
+0xE7, 0x1B, 0x14, 0x0B, 0x71, 0x1E, 0xB6, 0x0E, 
+0x4E, 0xA5, 0x7A, 0x1D, 0xD8, 0x54, 0xF5, 0x40, 
+0xC4, 0x87, 0xE1, 0xCD, 0x23, 0x16, 0x2A, 0x77, 
+0x35, 0x54, 0x1B, 0xA1, 0x47, 0x77, 0xF9, 0xF0, 
+0xE7, 0xAE, 0xF3, 0x82, 0xD7, 0x4D, 0x71, 0x20, 
+0x5C, 0xC2, 0x5B, 0x20, 0x00, 0x47, 0xBF, 0x08, 
+0xBF, 0x50, 0x63, 0x0C, 0x22, 0x94, 0xD1, 0x72, 
+0xED, 0x68, 0xB3, 0xE3, 0x85, 0xD2, 0x90, 0x51, 
+0xA2, 0xDC, 0x1F, 0xB2, 0x31, 0xA6, 0x75, 0x0C, 
+0xFD, 0x26, 0x4E, 0x64, 0x5C, 0x8B, 0x7C, 0x4A, 
+0x87, 0x9A, 0xEE, 0x60, 0x5E, 
+
+0x81, 0xBA, 0xC8, 0x05, 0xDC, 0x52, 0xE7, 0xC2, 
+0xE4, 0xA2, 0xEE, 0x2A, 0xA1, 0x52, 0x3A, 0x98, 
+0xB6, 0x60, 0xB8, 0x4D, 0x4E, 0x63, 0x05, 0x80, 
+0xDA, 0xE0, 0x1B, 0xE8, 0xB9, 0x9B, 0x55, 0xBB, 
+0xCB, 0x2E, 0x11, 0x7C, 0x74, 0xA7, 0xAB, 0xDF, 
+0x73, 0x0E, 0x77, 0x46, 0x4E, 0x42, 0x38, 0x9A, 
+0x23, 0xC3, 0xB9, 0x28, 0xA7, 0x9A, 0x7F, 0x08, 
+0x8E, 0xCE, 0xB9, 0x51, 0x0E, 0xBC, 0x40, 0x4B, 
+0xCB, 0x84, 0x1A, 0x40, 0xB9, 0x61, 0xBA, 0x43, 
+0x0B, 0xFE, 0x12, 0x7C, 0x91, 0x9D, 0x27, 0x5B, 
+0x13, 0x6C, 0x98, 0x17, 0x02, 0x4E, 0xFD, 0x2D, 
+0x22, 0xB8, 0xF5, 0xD5, 0x6F, 0x01, 0x05, 0x88, 
+0x3A, 0xEE, 0xCD, 0xBA, 0x2E, 0xD4, 0x43, 0x4A, 
+0x57, 0x4C, 0xC8, 0xF7, 0x4F, 0x16, 0xA9, 0xA3, 
+0x3E, 0x6F, 0x59, 0x74, 0x5D, 0xFE, 0x41, 0xD9, 
+0x6E, 0x82, 0x41, 0x62, 0x85, 0x54, 0xD9, 0x69, 
+0x56, 0xCE, 0x8E, 0x22, 0xA9, 0xD4, 0x96, 0x79, 
+0xE1, 0xC1, 0xC1, 0xD2, 0x36, 0x92, 0xEE, 0xFE, 
+0xF4, 0xB7, 0xD8, 0x1F, 0xA1, 0x66, 0xE2, 0xCE, 
+0xC5, 0x58, 0xEA, 0x01, 0xE1, 0xB3, 0x4A, 0xC4, 
+0x58, 0x79, 0xF5, 0x09, 0x35, 0xE7, 
+
+0x56, 0x4B, 0x19, 0x66, 0x91, 0xE2, 0x97, 0x27, 
+0x4A, 0xFB, 0xA4, 0xB6, 0xAA, 0xEE, 0x7F, 0x6E, 
+0x5C, 0x1E, 0xCA, 0xAD, 0x30, 0xE3, 0x4E, 0x2A, 
+0x8F, 0x63, 0xF5, 0xE2, 0x0A, 0x15, 0x16, 0x33, 
+0x43, 0x6A, 0x21, 0xE9, 0xAF, 0x9D, 0xA8, 0xE2, 
+0x79, 0xB2, 0x30, 0x3D, 0x73, 0xCD, 0x50, 0xCC, 
+0x34, 0xBD, 0x90, 0x45, 0x23, 0x59, 0xCB, 0x28, 
+0x18, 0x0F, 0x21, 0x4C, 0x5F, 0xE8, 0x06, 0xA8, 
+0xF9, 0x53, 0x10, 0x04, 0xB3, 0x76, 0xAB, 0xF9, 
+0x34, 0x72, 0x32, 0xAD, 0xEF, 0xBA, 0xB6, 0x58, 
+0x6A, 0x64, 0x30, 0x8F, 0x06, 0xF7, 0xDC, 0xD5, 
+0xA8, 0x1A, 0x13, 0x37, 0x3C, 0x29, 0xFD, 0xBC, 
+0x26, 0x38, 0x50, 0xB4, 0x06, 0x03, 0xC1, 0x38, 
+0x00, 0x65, 0x51, 0x60, 0x01, 0x1B, 0x04, 0xF9, 
+0x90, 0x4E, 0x70, 0xAC, 0xF6, 0x42, 0xCC, 0x45, 
+0xEE, 0x1A, 0xE5, 0xFA, 0x05, 0xF2, 0xF0, 0x6A, 
+0x9D, 0x3D, 0xA2, 0x98, 0x5C, 0xD2, 0x39, 0xE7, 
+0xDB, 0xC0, 0xB2, 0x23, 0x9F, 0x57, 0x54, 0x3B, 
+0x6E, 0x10, 0x72, 0xFA, 0x4D, 0xC1, 0x7F, 0xDC, 
+0x35, 0xB0, 0x6F, 0xB2, 0x6F, 0x32, 0xF6, 0x81, 
+0xC4, 0x88, 0xC9, 0xD7, 0x87, 0xA9, 0x4D, 
+
+0xC0, 0x43, 0x75, 0xEE, 0x4B, 0x52, 0x70, 0xAF, 
+0x34, 0x8F, 0xD0, 0x71, 0xB5, 0x7E, 0x7F, 0xE7, 
+0x5E, 0x99, 0xF1, 0xC1, 0x8D, 0x7A, 0xCD, 0xC6, 
+0x99, 0xF9, 0x0C, 0x12, 0x6C, 0xA2, 0x1C, 0xB5, 
+0x0C, 0x9D, 0x8D, 0x22, 0xF5, 0x02, 0x97, 0x5C, 
+0x83, 0xB8, 0x41, 0x35, 0x87, 0x34, 0xD8, 0xF6, 
+0xF7, 0x9A, 0xD2, 0x01, 0x71, 0xCE, 0x46, 0x3E, 
+0x5F, 0x0D, 0x1A, 0xB8, 0x7A, 0x8F, 0x2D, 0x9E, 
+0x78, 0x9A, 0x12, 0xD3, 0x40, 0x64, 0x20, 0xCB, 
+0x76, 0xD9, 0xEA, 0x6A, 0x69, 0x96, 0x92, 0xE8, 
+0x36, 0x21, 0x52, 0x05, 0xDD, 0x89, 0x1B, 0x87, 
+0xAA, 0x09, 0x55, 0x0E, 0x97, 0x9E, 0x9D, 0x81, 
+0x15, 0x47, 0x3C, 0xAD, 0x91, 0xCE, 0x29, 0x2D, 
+0xC1, 0x15, 0x83, 0x1C, 0x49, 0xFA, 0x90, 0x0F, 
+0x5A, 0xC6, 0x67, 0x93, 0xD1, 0xBE, 0x79, 0xC8, 
+0x47, 0x5A, 0x15, 0x2B, 0x90, 0xEB, 0x0A, 0x11, 
+0x96, 0x93, 0xD3, 0x30, 0xC1, 0x7B, 0x34, 0x57, 
+0x85, 0x7F, 0x49, 0xC2, 0x0F, 0x26, 0x90, 0xF7, 
+0xF8, 0x62, 0xBF, 0x35, 0x9B, 0xD6, 0x54, 0xF9, 
+0xF6, 0x82, 0xF1, 0xCF, 0xD0, 0x3B, 0xBE, 0x76, 
+0xEE, 0xAF, 0x03, 0xCA, 0x61, 0xB6, 0x2C, 0x50, 
+
+0xE5, 0x17, 0xF7, 0x70, 0x93, 0x2B, 0x65, 0x29, 
+0xC3, 0x84, 0xD1, 0x9B, 0x0C, 0x80, 0x93, 0xA9, 
+0x23, 0x7E, 0x90, 0x04, 0x65, 0x64, 0xB8, 0xFF, 
+0xB6, 0x35, 0x70, 0x29, 0xF5, 0xDF, 0x7C, 0x53, 
+0xE6, 0xAF, 0x58, 0x81, 0xA8, 0xE3, 0x42, 0x0F, 
+0xD5, 0x55, 0xBA, 0x61, 0x16, 0x0D, 0x0F, 0x5E, 
+0x17, 0xAF, 0xD7, 0x95, 0xF4, 0x16, 0x71, 0x92, 
+0xB6, 0x37, 0x2D, 0x4B, 0xAB, 0xBF, 0xD4, 0xCC, 
+0x05, 0xE4, 0x0F, 0x65, 0x7C, 0x93, 0x62, 0x7A, 
+0x3A, 0x5A, 0x26, 0xEF, 0x5F, 0x9C, 0x44, 0x19, 
+0xFD, 0xE8, 0x64, 0xF8, 0x59, 0x0B, 0x13, 0xAC, 
+0xDF, 0x0C, 0xCD, 0xFA, 0x69, 0x0E, 0x18, 0x27, 
+0xF4, 0x37, 0x1F, 0x9D, 0x34, 0x1F, 0xF2, 0xDD, 
+0xAC, 0x0C, 0x16, 0xE3, 0xAB, 0x7A, 0xC3, 0x77, 
+0x2A, 0x6C, 0x9E, 0x40, 0x2E, 0xC1, 0x16, 0x5B, 
+0x2F, 0x5B, 0x88, 0x85, 0x83, 0xE4, 0x4E, 0xC2, 
+0x7E, 0x1E, 0x84, 0x8C, 0xFA, 0xDC, 0xC3, 0xA8, 
+0xD2, 0x73, 0xD5, 0x07, 0x1B, 0x8A, 0x6C, 0x6F, 
+0x23, 0x4B, 0x2E, 0xEA, 0xC8, 0xEE, 0x4B, 0x1E, 
+0x64, 0x0A, 0xF7, 0x6A, 0xA2, 0x97, 0x1B, 0x3E, 
+0x4D, 0x0D, 0x14, 0x22, 0x81, 0xDA, 0xA0, 0x78, 
+0x67, 
+
+0xDA, 0x3D, 0x34, 0xB2, 0x68, 0xB2, 0x7C, 0xC8, 
+0x86, 0x1B, 0xE0, 0xD6, 0x16, 0x8B, 0x6E, 0x5C, 
+0x44, 0x7A, 0x53, 0x60, 0xC8, 0x02, 0x03, 0x61, 
+0x50, 0x4A, 0xC9, 0x95, 0xBB, 0x52, 0xCB, 0x65, 
+0x3A, 0x0C, 0x69, 0xCB, 0xFE, 0x17, 0x11, 0xC1, 
+0x4F, 0x5B, 0x01, 0x66, 0xA8, 0x3A, 0xC3, 0x35, 
+0xD6, 0x67, 0xCC, 0x8B, 0xEE, 0xC6, 0x93, 0x1E, 
+0x86, 0x1B, 0x59, 0x05, 0xBF, 0xF5, 0x59, 0xF7, 
+0x86, 0xF9, 0x8D, 0x6E, 0x21, 0xE0, 0x34, 0x34, 
+0xBB, 0xFE, 0xB6, 0x3F, 0xD6, 0x42, 0x51, 0x7C, 
+0xD6, 0xEB, 0xD9, 0xA1, 0xF4, 0xF9, 0xC8, 0x8B, 
+0xF1, 0xBE, 0x4C, 0x00, 0x2C, 0xF0, 0x5C, 0xB7, 
+0x97, 0xE7, 0x85, 0xC6, 0x54, 0xD3, 0x9E, 0x5A, 
+0x16, 0x4E, 0x7D, 0x9B, 0x9E, 0x82, 0x27, 0xB7, 
+0xEF, 0xE9, 0x46, 0xE7, 0x81, 0xD1, 0x48, 0xEB, 
+0xC6, 0x47, 0x3B, 0x55, 0x9C, 0x91, 0x8C, 0x03, 
+0xEE, 0xFE, 0x6D, 0xE9, 0x76, 0x62, 0x40, 0x90, 
+0x7D, 0x65, 0xCF, 0xD7, 0xDB, 0xC0, 0x0E, 0x90, 
+0x18, 0x3A, 0xC9, 0xBC, 0x2D, 0x08, 0x57, 0xFC, 
+0xF0, 0x23, 0x6B, 0xE7, 0xB8, 0x8B, 0x21, 0xA0, 
+0x5D, 0x16, 0x8F, 0x37, 0x0E, 0x6C, 0x15, 0xDC, 
+0x32, 0x97, 
+
+0x4B, 0x5D, 0x21, 0x58, 0x63, 0x34, 0x9F, 0x7D, 
+0x29, 0x1E, 0xAE, 0x12, 0xA2, 0x7E, 0x0E, 0x78, 
+0xD3, 0xA0, 0x65, 0x7A, 0xEC, 0xF3, 0x80, 0x56, 
+0xBD, 0xD1, 0x59, 0xE7, 0x60, 0x7A, 0x61, 0xAA, 
+0x07, 0xD2, 0x3F, 0x85, 0x6E, 0x3D, 0x66, 0x64, 
+0xF4, 0xDE, 0xB4, 0xB2, 0x56, 0x40, 0x72, 0x5F, 
+0x29, 0xAD, 0x50, 0x79, 0x77, 0x27, 0x98, 0x3F, 
+0xFE, 0x64, 0xC2, 0x29, 0x91, 0xE4, 0x1F, 0x25, 
+0x6D, 0x1A, 0xED, 0xBB, 0x7F, 0x94, 0xE1, 0x36, 
+0xDB, 0x3F, 0x7A, 0x6E, 0x3F, 0xE3, 0x6B, 0x32, 
+0x1F, 0x5C, 0x9C, 0x33, 0x61, 0x12, 0x7A, 0x93, 
+0x34, 0xE5, 0xED, 0xC4, 0xF0, 0x9E, 0x15, 0x59, 
+0x0A, 0x3A, 0x9D, 0x5E, 0x23, 0xF9, 0x68, 0x9F, 
+0x67, 0xFC, 0xA2, 0x42, 0x5E, 0x6E, 0xB9, 0xBB, 
+0x10, 0xE6, 0x84, 0x56, 0x64, 0x5D, 0x9D, 0x8A, 
+0x2C, 0xC2, 0x82, 0xBC, 0xA1, 0xD2, 0xB4, 0xF4, 
+0xDA, 0xA7, 0x2F, 0xAD, 0x07, 0x6C, 0x55, 0x91, 
+0x89, 0x9E, 0x5C, 0x3E, 0xD4, 0xBA, 0x07, 0x6C, 
+0x39, 0xB2, 0xF1, 0x96, 0x3E, 0x75, 0xC6, 0x57, 
+0x9B, 0xF1, 0x7D, 0xCF, 0xEA, 0x12, 0x5E, 0xCC, 
+0x65, 0xA2, 0x73, 0xE3, 0x44, 0x9E, 0x8C, 0xD1, 
+0x05, 0x03, 0xBB, 
+
+0x11, 0xB1, 0x40, 0x9C, 0x75, 0xD5, 0x53, 0x50, 
+0x60, 0x53, 0x7A, 0xF7, 0x17, 0xE4, 0x9E, 0xA7, 
+0xB1, 0xCB, 0x28, 0xA5, 0x0F, 0xA1, 0x24, 0xFF, 
+0xF4, 0x70, 0xF8, 0x3B, 0xDC, 0x1E, 0xDD, 0xEE, 
+0x85, 0xC1, 0x42, 0xE4, 0x1F, 0x40, 0xC4, 0x9A, 
+0xAF, 0x93, 0x37, 0x4C, 0x75, 0x32, 0xB2, 0x9E, 
+0x22, 0x8A, 0x8E, 0x9C, 0x7B, 0x73, 0x7A, 0x3A, 
+0x48, 0xC1, 0xD4, 0x90, 0xDC, 0x9D, 0x51, 0xD0, 
+0xC1, 0x43, 0xC9, 0x93, 0xCB, 0x08, 0x5F, 0x0A, 
+0x2F, 0x34, 0xFD, 0x66, 0x7E, 0x99, 0x9F, 0x60, 
+0x93, 0xC2, 0xC7, 0x2D, 0x7C, 0xA2, 0xE3, 0x06, 
+0x53, 0xEF, 0x85, 0x04, 0xB0, 0x55, 0x23, 0x94, 
+0x73, 0x64, 0x70, 0x62, 0xF4, 0xF4, 0x76, 0xA3, 
+0x8A, 0x18, 0xAE, 0x59, 0xF2, 0xE3, 0x6A, 0x3A, 
+0x2A, 0x3E, 0xFB, 0x94, 0x00, 0xA0, 0x31, 0x09, 
+0x52, 0xE2, 0x7E, 0x44, 0x9C, 0xAE, 0x1A, 0x43, 
+0x8B, 0x14, 0xA4, 0x0B, 0x21, 0xC2, 0x18, 0x5B, 
+0xE2, 0x3B, 0x3F, 0xAE, 0xEA, 0xD6, 0xF9, 0x85, 
+0xB1, 0x6F, 0x34, 0x27, 0x7A, 0xB8, 0xA4, 0xC5, 
+0x26, 0x85, 0x45, 0xC3, 0xC4, 0xC9, 0x98, 0xE1, 
+0xB1, 0xBF, 0x37, 0xDE, 0x33, 0xD0, 0xA0, 0xA7, 
+0xEB, 0x19, 0x06, 0xBE, 
+
+0xB1, 0x85, 0xDF, 0x8B, 0x7F, 0x84, 0xB4, 0x11, 
+0x7D, 0x16, 0xE4, 0xA8, 0x39, 0xA9, 0x08, 0x67, 
+0x09, 0x97, 0xBF, 0xE9, 0x0B, 0xE9, 0x88, 0x64, 
+0xF1, 0xC3, 0x21, 0xBB, 0x94, 0xD0, 0x46, 0x0F, 
+0x5B, 0x41, 0x2C, 0xD8, 0xAB, 0x12, 0x33, 0xAB, 
+0x52, 0x48, 0x9F, 0xE2, 0x90, 0x29, 0xE3, 0x40, 
+0xAF, 0x89, 0x5D, 0x20, 0xA8, 0xCC, 0xAA, 0xF0, 
+0xB4, 0xAC, 0xDE, 0xD6, 0xA0, 0xB8, 0xC8, 0xE7, 
+0x97, 0x6F, 0x2F, 0xBA, 0x60, 0x59, 0x91, 0x1C, 
+0x18, 0xCB, 0xDA, 0xCE, 0x9D, 0xEB, 0x5F, 0xFF, 
+0xDC, 0x07, 0x92, 0xFD, 0x62, 0x93, 0xF8, 0x6F, 
+0xC0, 0x9D, 0x98, 0x4C, 0x1B, 0x28, 0xB7, 0x4F, 
+0x1E, 0xFB, 0xAE, 0x93, 0x6D, 0x23, 0x66, 0x41, 
+0x69, 0x2C, 0x3A, 0xEC, 0x1B, 0x70, 0xC3, 0x9C, 
+0x99, 0xB7, 0x3F, 0x47, 0x7E, 0xB5, 0xA3, 0x7A, 
+0x8A, 0x49, 0x42, 0x38, 0xBA, 0x2F, 0xC5, 0x54, 
+0x1D, 0x7E, 0xD2, 0x43, 0x27, 0xDC, 0x35, 0x79, 
+0x40, 0x35, 0xD6, 0x40, 0x55, 0x42, 0xAF, 0x3C, 
+0xBF, 0x1C, 0xF8, 0xDE, 0xFA, 0x62, 0xC6, 0x8E, 
+0xC3, 0x20, 0x04, 0xC4, 0x5B, 0x3A, 0x60, 0x2B, 
+0x8A, 0x66, 0x24, 0xE4, 0x91, 0x1D, 0x46, 0x33, 
+0xCB, 0xD3, 0x4C, 0xD7, 0x0D, 
+
+0xB7, 0x0D, 0xDC, 0xC3, 0x98, 0x3B, 0x2D, 0xA4, 
+0x1B, 0xEF, 0xF1, 0x09, 0x19, 0xA1, 0x63, 0x79, 
+0x69, 0xED, 0xFB, 0x41, 0x06, 0xD2, 0x17, 0xDF, 
+0x74, 0xA0, 0xFD, 0x9E, 0x6D, 0xCC, 0x42, 0x96, 
+0x3F, 0x36, 0x96, 0xC9, 0x13, 0x73, 0x60, 0xF9, 
+0x5B, 0x53, 0xC2, 0x71, 0x7F, 0x02, 0x44, 0xB7, 
+0x29, 0x7A, 0xA2, 0x53, 0xED, 0x52, 0x5C, 0xAB, 
+0x31, 0xBA, 0xE9, 0xE8, 0x84, 0x45, 0xE4, 0x5B, 
+0xD7, 0xA8, 0x93, 0x99, 0x0E, 0x15, 0x69, 0x65, 
+0x10, 0x64, 0xF8, 0x98, 0x61, 0x9B, 0xB1, 0x2D, 
+0x5A, 0x27, 0x20, 0x57, 0x15, 0x8F, 0x2B, 0x0C, 
+0xA6, 0x61, 0xD2, 0x46, 0x3B, 0xB6, 0xC3, 0x8F, 
+0x55, 0x42, 0x1C, 0xC1, 0x67, 0xE6, 0xFA, 0x8E, 
+0xCB, 0xB7, 0x79, 0x15, 0x98, 0xA6, 0x69, 0x55, 
+0x90, 0xC9, 0x8F, 0xF9, 0xA2, 0xCA, 0xA0, 0xC6, 
+0xB4, 0x4F, 0xD6, 0xE9, 0xC5, 0x50, 0x84, 0xA8, 
+0x2E, 0xDC, 0x25, 0xC8, 0x77, 0xBD, 0x7F, 0x89, 
+0xD3, 0x96, 0xDF, 0xE9, 0xA4, 0xAE, 0x4D, 0xD1, 
+0xA9, 0xC6, 0xB9, 0xA2, 0xB8, 0xB9, 0x7C, 0x63, 
+0xFE, 0xF2, 0xB0, 0x4B, 0xB7, 0xCC, 0x89, 0xEC, 
+0xF2, 0xA5, 0xDF, 0x86, 0x94, 0x3C, 0x66, 0x7E, 
+0xCB, 0xA3, 0x19, 0xE8, 0xAC, 0xDA, 
+
+0x6F, 0x3D, 0x6E, 0xFC, 0x67, 0x8B, 0x03, 0x9C, 
+0x5F, 0x9E, 0x9D, 0x73, 0x66, 0xEE, 0x35, 0xAE, 
+0x33, 0x7D, 0x10, 0x13, 0xC2, 0xC4, 0x80, 0x7C, 
+0x3C, 0x4A, 0x61, 0x20, 0x01, 0xBE, 0xFE, 0x27, 
+0x75, 0xEC, 0x57, 0xAE, 0x7E, 0xFA, 0x67, 0x02, 
+0x5D, 0xCF, 0x69, 0xE1, 0xD3, 0x84, 0xDE, 0x74, 
+0xDE, 0x89, 0x0A, 0x86, 0x5B, 0xC4, 0x8A, 0x0C, 
+0x45, 0xBD, 0x6C, 0x05, 0x87, 0xA9, 0x37, 0x41, 
+0x1F, 0x92, 0x34, 0x7D, 0xD1, 0xCA, 0xF0, 0xAF, 
+0xBA, 0x34, 0xA1, 0x81, 0x94, 0xEB, 0x90, 0xC8, 
+0x80, 0x5D, 0x6D, 0x5C, 0x64, 0x3B, 0xCA, 0xAD, 
+0xB7, 0x7C, 0x3D, 0xA1, 0x45, 0xD0, 0x45, 0x3F, 
+0xA1, 0x92, 0xA2, 0x11, 0x5F, 0x62, 0x3C, 0x2D, 
+0xA7, 0xC7, 0x71, 0x14, 0x25, 0xF5, 0x10, 0xAC, 
+0x8B, 0xC2, 0x2D, 0x4B, 0x02, 0x52, 0x62, 0x61, 
+0xA6, 0xFC, 0x38, 0x9B, 0x0D, 0xB9, 0xED, 0xFA, 
+0xFE, 0xF0, 0x55, 0x24, 0xDA, 0x23, 0xCA, 0x36, 
+0x57, 0x6E, 0x13, 0xF7, 0x3C, 0xE3, 0xC3, 0xB8, 
+0xFF, 0x2E, 0xAF, 0x0B, 0x92, 0x4A, 0x9E, 0xB0, 
+0x0A, 0x96, 0xAF, 0x34, 0xD4, 0xA3, 0x62, 0x68, 
+0xE9, 0xC7, 0xE4, 0xD3, 0xCC, 0x3B, 0xE4, 0xFA, 
+0x64, 0x74, 0xE8, 0xD7, 0x5E, 0x7C, 0x06, 
+
+0x6E, 0xF5, 0xBF, 0xFC, 0x6A, 0xA8, 0x7F, 0x39, 
+0xEE, 0x39, 0xA5, 0x47, 0xA1, 0x93, 0xB0, 0x25, 
+0xF5, 0x67, 0xF7, 0xB8, 0x04, 0xBA, 0x1C, 0xE8, 
+0xD2, 0xC6, 0x6C, 0x72, 0x32, 0x69, 0x9E, 0x12, 
+0xF2, 0xAD, 0x22, 0x38, 0x70, 0x2F, 0x1E, 0xC5, 
+0x1D, 0xEE, 0x5C, 0x97, 0xD9, 0x30, 0x87, 0xCA, 
+0x75, 0x94, 0xDC, 0xAD, 0x28, 0x51, 0x9F, 0x5B, 
+0x69, 0xE4, 0x61, 0x63, 0xC1, 0xDA, 0x36, 0x84, 
+0x4E, 0xDF, 0xD4, 0x6C, 0xA4, 0xB8, 0x4F, 0x93, 
+0xEF, 0x19, 0x2E, 0x59, 0x14, 0xBB, 0x06, 0x14, 
+0x36, 0x9B, 0x30, 0xE9, 0xA1, 0xC9, 0x7E, 0xB5, 
+0x61, 0xAD, 0x79, 0x91, 0x68, 0xF7, 0x45, 0x44, 
+0x79, 0x21, 0x4A, 0x69, 0x14, 0xFE, 0x07, 0xAC, 
+0x2A, 0x84, 0x13, 0x36, 0x30, 0xBC, 0x58, 0x68, 
+0x80, 0xF3, 0x7F, 0x8B, 0x1F, 0xC0, 0x3F, 0x8B, 
+0x70, 0x38, 0x25, 0xFE, 0x15, 0x6C, 0x8B, 0x1C, 
+0x87, 0xE8, 0x32, 0x04, 0x7C, 0x08, 0xE1, 0x49, 
+0x65, 0xAB, 0xA5, 0x0A, 0xCE, 0xE5, 0x28, 0x22, 
+0x48, 0x85, 0x93, 0x3C, 0x25, 0x76, 0x52, 0xAD, 
+0xDB, 0x5A, 0x09, 0x16, 0x18, 0xD8, 0x3E, 0x80, 
+0x72, 0xBE, 0x90, 0xFD, 0x97, 0xFE, 0xF7, 0x02, 
+0xCE, 0xF7, 0xE7, 0x25, 0x1A, 0xA8, 0xD9, 0x83, 
+
+0x4F, 0xBD, 0xF3, 0xEF, 0xDC, 0x56, 0x9A, 0x81, 
+0x16, 0xFE, 0x28, 0x61, 0x8E, 0x5C, 0xCD, 0xA3, 
+0x01, 0xD7, 0x23, 0xA7, 0xFB, 0xDD, 0x7A, 0xAC, 
+0x97, 0x39, 0x81, 0x3F, 0x51, 0x1E, 0xAE, 0x30, 
+0x28, 0x9B, 0x99, 0x14, 0x78, 0xFF, 0x21, 0x6B, 
+0x4E, 0xCE, 0x0E, 0xDB, 0x94, 0x03, 0x4E, 0x3C, 
+0x0E, 0x70, 0xFD, 0x94, 0xDF, 0xBD, 0x7D, 0x50, 
+0x4F, 0x63, 0x30, 0xA1, 0xAE, 0x9E, 0x98, 0x15, 
+0xF1, 0xE0, 0xEE, 0x53, 0x28, 0x94, 0xFD, 0xC9, 
+0x5C, 0xA1, 0x49, 0x53, 0xBD, 0x8E, 0x9D, 0xB3, 
+0x9D, 0x3C, 0x3D, 0x25, 0x00, 0xB4, 0x2B, 0x87, 
+0x11, 0x3E, 0x5C, 0xFB, 0x83, 0xFC, 0x38, 0x47, 
+0x95, 0xB6, 0x67, 0x27, 0xAE, 0x36, 0xA6, 0x21, 
+0xFC, 0x7B, 0x15, 0xBE, 0xF3, 0xFA, 0xED, 0xED, 
+0x0E, 0x4E, 0xA9, 0x51, 0xFF, 0x2C, 0x9D, 0xE9, 
+0xB6, 0xED, 0xFF, 0x2C, 0x64, 0x52, 0xF0, 0xDC, 
+0x1C, 0x19, 0x8D, 0xB3, 0x04, 0x82, 0xF5, 0xCB, 
+0x77, 0x66, 0x56, 0xC0, 0x8B, 0xD9, 0xCA, 0xD2, 
+0xF9, 0xEC, 0xB7, 0x2E, 0xAB, 0x13, 0x87, 0x33, 
+0x35, 0xEC, 0x85, 0x8D, 0x2D, 0x3C, 0x42, 0x11, 
+0xD1, 0xBD, 0xB8, 0x69, 0x67, 0x04, 0xCA, 0xEC, 
+0x72, 0x90, 0x08, 0xDB, 0x4F, 0x56, 0x53, 0x7F, 
+0x09, 
+
+0xC0, 0xFA, 0x78, 0x98, 0x10, 0x5A, 0x79, 0x50, 
+0x15, 0x78, 0xFA, 0xBD, 0x29, 0x64, 0xA4, 0xC3, 
+0x9B, 0xAF, 0x5F, 0xC2, 0xDF, 0x21, 0x1B, 0xDF, 
+0x81, 0x37, 0x72, 0xE3, 0xA2, 0xD2, 0xD1, 0xAE, 
+0x84, 0x8B, 0xD2, 0xF8, 0x10, 0x2B, 0x04, 0x0D, 
+0x1C, 0x71, 0xDB, 0x30, 0x0A, 0x01, 0x6A, 0x94, 
+0xFB, 0x5A, 0x94, 0x34, 0x89, 0x0D, 0x9F, 0xF2, 
+0x57, 0xC5, 0x9A, 0xF0, 0xAA, 0xF3, 0xB0, 0xA0, 
+0x6A, 0x68, 0xE1, 0xD3, 0x1F, 0x96, 0x5B, 0x6F, 
+0x3E, 0x5A, 0x73, 0xA8, 0x93, 0xF3, 0xC9, 0x76, 
+0x50, 0x84, 0x79, 0x15, 0x08, 0xB9, 0x9C, 0xF0, 
+0xB5, 0x59, 0x92, 0xE9, 0x58, 0xB8, 0xA8, 0x7F, 
+0xD5, 0xD7, 0x69, 0xAD, 0x34, 0x20, 0x88, 0xC7, 
+0x33, 0x4F, 0x64, 0xCF, 0x5B, 0x64, 0xA4, 0x75, 
+0x9E, 0x30, 0xD6, 0x46, 0xC4, 0xC8, 0xE8, 0x21, 
+0xCE, 0x73, 0xEA, 0x2A, 0xA7, 0xA5, 0x89, 0x39, 
+0xB5, 0x41, 0x49, 0x6A, 0x3A, 0x9A, 0x96, 0x69, 
+0x98, 0xA4, 0x30, 0xFC, 0x52, 0x1A, 0x66, 0x23, 
+0x0E, 0x24, 0x98, 0x07, 0x49, 0x66, 0x69, 0xA5, 
+0xC7, 0x5B, 0xE8, 0xB7, 0xF9, 0x85, 0x9E, 0x10, 
+0xE6, 0x37, 0x23, 0x44, 0x57, 0x7F, 0x36, 0x39, 
+0x0D, 0x2C, 0x88, 0x88, 0xA6, 0x9B, 0x2B, 0x61, 
+0x9C, 0xDF, 
+
+0x48, 0x53, 0x75, 0x13, 0x37, 0x98, 0x93, 0xAD, 
+0x83, 0x2C, 0x03, 0x51, 0xD2, 0xB9, 0x9C, 0x0F, 
+0xEA, 0xC2, 0xF2, 0xA9, 0x87, 0xBC, 0xB3, 0x24, 
+0x9B, 0x42, 0x54, 0x60, 0x5C, 0x8A, 0x4C, 0x5B, 
+0x0F, 0x1D, 0x03, 0xA9, 0xC3, 0xBB, 0x68, 0x17, 
+0xFF, 0xFD, 0xA7, 0xA1, 0xE5, 0x79, 0xD7, 0xC9, 
+0xA2, 0xB0, 0xC1, 0x88, 0xD3, 0xA3, 0xBD, 0x95, 
+0x00, 0x4B, 0xCB, 0xD1, 0x54, 0x46, 0x07, 0x92, 
+0x8C, 0x2C, 0xA5, 0x7C, 0x29, 0x42, 0x16, 0x2D, 
+0xFA, 0x04, 0xEC, 0x60, 0xEB, 0x0E, 0xB5, 0xD0, 
+0x68, 0x75, 0xB4, 0x42, 0x08, 0xF2, 0x19, 0x3B, 
+0x19, 0x6E, 0x13, 0xE9, 0xC3, 0x9C, 0x2C, 0xF7, 
+0xEF, 0xD0, 0x83, 0xB7, 0xB3, 0x24, 0xE1, 0x99, 
+0xF6, 0x03, 0x48, 0xDB, 0x4A, 0x4E, 0x7E, 0xDE, 
+0xEA, 0x65, 0x5F, 0xB4, 0x0F, 0x00, 0xD0, 0x22, 
+0x28, 0x05, 0x4B, 0x25, 0x3F, 0xEC, 0xD9, 0x32, 
+0x32, 0x20, 0x8A, 0xB9, 0x27, 0x7F, 0x5D, 0xC1, 
+0x89, 0x7F, 0xE6, 0xB7, 0x24, 0xDB, 0x85, 0x1B, 
+0x29, 0x12, 0xEB, 0x8E, 0x29, 0xD8, 0x98, 0xBC, 
+0x08, 0x74, 0x88, 0xAE, 0xD2, 0x55, 0xB1, 0xA6, 
+0xE8, 0x75, 0x95, 0xFD, 0xBF, 0x04, 0xB5, 0xDA, 
+0x5C, 0xB7, 0x83, 0xE5, 0x00, 0xD3, 0xBB, 0x19, 
+0x33, 0xD9, 0xA5, 
+
+0x82, 0xA9, 0xA4, 0x3D, 0x14, 0x8E, 0x0A, 0xF6, 
+0x59, 0xF7, 0xE4, 0x96, 0xC8, 0xE2, 0xC8, 0x6E, 
+0x76, 0x62, 0x11, 0xDD, 0x12, 0x1F, 0xBE, 0x8D, 
+0xF3, 0x47, 0x02, 0x23, 0x4C, 0x2B, 0x7E, 0xCD, 
+0xD4, 0x99, 0x29, 0x47, 0xBB, 0x13, 0xFA, 0x4C, 
+0xC4, 0xE8, 0xF1, 0x51, 0x60, 0x73, 0xE2, 0x66, 
+0x2D, 0x51, 0xCB, 0xCC, 0x82, 0x9C, 0x73, 0x12, 
+0x85, 0xBA, 0x4F, 0x1A, 0xDF, 0x43, 0xA9, 0x77, 
+0x9F, 0xB5, 0xA3, 0x6D, 0xF7, 0x34, 0xF3, 0x81, 
+0x9B, 0xD6, 0x5B, 0xAD, 0xB0, 0xE0, 0x41, 0x9A, 
+0xC9, 0x0E, 0x89, 0x09, 0xCF, 0xE8, 0x6C, 0x29, 
+0x8D, 0x2A, 0xC2, 0x83, 0x63, 0x01, 0xCF, 0xDA, 
+0xDB, 0xE9, 0x03, 0x8E, 0x6D, 0x65, 0xA1, 0x2A, 
+0xEF, 0xDD, 0x7F, 0x10, 0xBC, 0x11, 0xBB, 0xA7, 
+0x8B, 0x4E, 0x3F, 0x8D, 0xE3, 0x40, 0xD0, 0x66, 
+0x51, 0xF1, 0xAD, 0xAE, 0x72, 0xD3, 0x64, 0xD8, 
+0xF5, 0x84, 0x6E, 0x50, 0xBC, 0x23, 0x08, 0xB0, 
+0xF6, 0x4F, 0xB7, 0x30, 0xDC, 0xE7, 0xBF, 0x83, 
+0x02, 0x03, 0x73, 0xE2, 0x4B, 0xC6, 0x0F, 0x6A, 
+0x18, 0x25, 0x0D, 0xDF, 0x44, 0xC6, 0x3D, 0xDB, 
+0x55, 0x2C, 0xE9, 0x4B, 0x44, 0x0B, 0x34, 0x59, 
+0xB3, 0xD4, 0x50, 0x76, 0x8D, 0xB0, 0x10, 0xAC, 
+0xC9, 0x3A, 0xB6, 0x1A, 
+
+0xBF, 0x68, 0xD2, 0xE8, 0x5E, 0x9A, 0xF2, 0x4A, 
+0x5A, 0xC7, 0x16, 0xCD, 0x8E, 0x2B, 0x23, 0x10, 
+0xC6, 0x6F, 0x4E, 0xA8, 0x49, 0xA4, 0xF7, 0x37, 
+0x1D, 0xAB, 0xFB, 0x74, 0x4B, 0x1D, 0x86, 0x3A, 
+0xF1, 0xEC, 0x5A, 0xF1, 0x35, 0xE4, 0xA8, 0x1E, 
+0x87, 0x09, 0x31, 0x31, 0x36, 0xA4, 0xDA, 0x1A, 
+0xC5, 0xAD, 0x45, 0x0D, 0x25, 0x04, 0x67, 0x4B, 
+0x34, 0xCF, 0xD5, 0x2D, 0x95, 0xAA, 0xFD, 0xE8, 
+0x94, 0x5A, 0x59, 0xB8, 0x40, 0x71, 0xCB, 0xB1, 
+0x8F, 0x44, 0xFC, 0x33, 0x11, 0xF5, 0xCC, 0x53, 
+0xBF, 0xF2, 0x05, 0x12, 0xC1, 0xBE, 0x53, 0x6D, 
+0x10, 0xEF, 0xB7, 0x8D, 0xEA, 0xA7, 0x66, 0x38, 
+0x8E, 0x22, 0x9E, 0x2F, 0xDE, 0xC0, 0x08, 0xDF, 
+0x63, 0x92, 0x71, 0x91, 0x69, 0xB6, 0x48, 0x50, 
+0x62, 0x9E, 0xF5, 0xD7, 0x6A, 0x6F, 0xA5, 0xA4, 
+0x25, 0xF9, 0x97, 0x89, 0xA9, 0x77, 0xBB, 0xE9, 
+0x79, 0x52, 0xA0, 0x9D, 0x4F, 0x1F, 0xBC, 0x5C, 
+0x2A, 0x45, 0x9A, 0x2B, 0x18, 0xFC, 0x12, 0x73, 
+0x58, 0x00, 0x80, 0x09, 0xBA, 0x6C, 0x4D, 0xF4, 
+0x26, 0xDB, 0xC0, 0xAC, 0x13, 0x7B, 0xEF, 0x06, 
+0x8D, 0xA0, 0x70, 0x72, 0x6C, 0xC5, 0x1E, 0xC6, 
+0xA2, 0x16, 0x2B, 0x6E, 0x71, 0xF4, 0x16, 0xCC, 
+0xC3, 0xEF, 0x3B, 0x29, 0x8F, 
+
+0xF7, 0xF6, 0x74, 0xD3, 0xC1, 0x9B, 0x90, 0x17, 
+0x7A, 0xFF, 0x32, 0x9A, 0xEA, 0x69, 0x7A, 0x55, 
+0x4E, 0x0C, 0xD6, 0xCE, 0xAD, 0x49, 0xFD, 0x41, 
+0x48, 0xBF, 0x61, 0xD0, 0x41, 0x7C, 0xB3, 0xF8, 
+0xF6, 0x09, 0x36, 0x71, 0xCA, 0x84, 0x03, 0x12, 
+0x70, 0x03, 0x4E, 0x49, 0x2D, 0x0C, 0x5D, 0xE0, 
+0xB4, 0x6E, 0x3C, 0xE3, 0x18, 0x55, 0x49, 0x4A, 
+0xEF, 0x2E, 0x75, 0x13, 0x0F, 0xA2, 0x37, 0x03, 
+0x1D, 0x5B, 0x57, 0x50, 0xCA, 0x5F, 0x60, 0xAD, 
+0x15, 0x4A, 0x09, 0x35, 0x16, 0x4B, 0x37, 0xDA, 
+0x64, 0xA8, 0x22, 0xAA, 0x36, 0x83, 0x5A, 0x48, 
+0xB1, 0x9B, 0xBE, 0xE5, 0xC1, 0xBE, 0x40, 0x92, 
+0xA4, 0x07, 0xFD, 0xF9, 0xA5, 0x87, 0xC9, 0x2F, 
+0xC1, 0x79, 0x17, 0x70, 0xDA, 0xE8, 0x08, 0x1D, 
+0x05, 0x07, 0x08, 0x90, 0xCF, 0x73, 0x0D, 0xE3, 
+0x29, 0x86, 0x13, 0x2A, 0x84, 0x8A, 0xB6, 0xBA, 
+0xF2, 0xA6, 0xF6, 0xDD, 0x08, 0xD5, 0x68, 0xA9, 
+0x19, 0x9B, 0x95, 0x9F, 0x1F, 0x18, 0x8A, 0x02, 
+0xAA, 0xF6, 0x33, 0xDF, 0x4D, 0x33, 0x03, 0x1D, 
+0x76, 0xAE, 0x2D, 0xC3, 0xD3, 0xED, 0x08, 0x12, 
+0x9E, 0x08, 0xE9, 0x43, 0x0D, 0xC3, 0x57, 0xA5, 
+0xCA, 0x54, 0xE9, 0x01, 0xBB, 0xB0, 0xE0, 0x6D, 
+0x1C, 0x9D, 0xA9, 0x56, 0x3D, 0xFF, 
+
+0x77, 0x0E, 0x4E, 0x81, 0x38, 0x51, 0xA2, 0xC6, 
+0xF1, 0xB1, 0x77, 0x07, 0xE8, 0x9B, 0xBF, 0x95, 
+0x4F, 0xB5, 0x13, 0x41, 0xFD, 0x43, 0x62, 0xF8, 
+0x06, 0xA1, 0xF5, 0x2F, 0x1F, 0x2E, 0xD0, 0x6D, 
+0x7D, 0x66, 0x5F, 0xEC, 0xB8, 0xB0, 0x72, 0x94, 
+0xA5, 0xEF, 0xDB, 0xA3, 0xCD, 0x3A, 0xDC, 0x7B, 
+0xA9, 0x8A, 0xF3, 0x31, 0x08, 0x7F, 0x45, 0x79, 
+0xBE, 0xA1, 0xCF, 0x96, 0x40, 0x7D, 0x6E, 0x2C, 
+0xE5, 0x6A, 0x7D, 0x94, 0x7C, 0xF7, 0x70, 0x3C, 
+0x52, 0xBD, 0xF6, 0x52, 0xE5, 0x14, 0x1E, 0x5E, 
+0x8F, 0x56, 0xAF, 0x20, 0x8D, 0xA9, 0x59, 0x39, 
+0x07, 0xD2, 0x2D, 0x6C, 0xEA, 0x6A, 0x93, 0xF1, 
+0x9C, 0x90, 0x2C, 0xF0, 0xF9, 0xD9, 0x3B, 0x26, 
+0x7B, 0x29, 0x6E, 0xB4, 0x50, 0xE8, 0x91, 0x20, 
+0xD8, 0xF4, 0x4A, 0x91, 0x44, 0x06, 0x13, 0x9F, 
+0x01, 0x2E, 0xF2, 0x7A, 0xE2, 0x56, 0x25, 0x57, 
+0x98, 0xA5, 0x18, 0x00, 0xD4, 0xA7, 0xB6, 0x2C, 
+0xF8, 0x58, 0xBF, 0x05, 0xBD, 0x69, 0xF7, 0xE6, 
+0xAE, 0xBA, 0x55, 0xDE, 0x1C, 0x80, 0x59, 0x69, 
+0x4C, 0x08, 0x02, 0x44, 0x5C, 0x26, 0x34, 0xDF, 
+0xAF, 0xE4, 0x07, 0x80, 0xF7, 0x78, 0x60, 0x6A, 
+0xDA, 0x52, 0x40, 0x4D, 0x3E, 0x70, 0x9A, 0xBF, 
+0xE8, 0x4D, 0x9F, 0xF2, 0xC1, 0x13, 0xB4, 
+
+0xBE, 0x23, 0x2A, 0x06, 0x7F, 0xC6, 0x39, 0x12, 
+0x29, 0x43, 0xCA, 0xE8, 0x08, 0xB9, 0xDB, 0x68, 
+0x2D, 0x7E, 0x4B, 0xF3, 0xE4, 0xEB, 0x1A, 0x73, 
+0x07, 0x6C, 0x01, 0x82, 0x98, 0xF8, 0xA4, 0xE4, 
+0x14, 0x18, 0x2F, 0x34, 0x44, 0x3F, 0xE3, 0x50, 
+0x89, 0x38, 0x98, 0x7C, 0x29, 0x7E, 0xEE, 0xF9, 
+0xEE, 0xC8, 0x49, 0x4B, 0x4B, 0xE0, 0xAC, 0x5F, 
+0x26, 0x8F, 0x9D, 0x15, 0xDB, 0x18, 0xD1, 0x0C, 
+0x5E, 0x52, 0x28, 0x2F, 0x25, 0x23, 0x46, 0x9F, 
+0x9D, 0x15, 0x89, 0x15, 0xC3, 0x49, 0xB7, 0x5D, 
+0x06, 0xDD, 0xE7, 0xA8, 0xB8, 0x8C, 0xDF, 0x2D, 
+0x8D, 0x52, 0x08, 0xD3, 0x6B, 0x86, 0x80, 0xEE, 
+0x8B, 0x90, 0x54, 0x87, 0x1C, 0x37, 0x06, 0xB3, 
+0xB3, 0xBA, 0x3F, 0xA7, 0x1F, 0x46, 0xC6, 0x7A, 
+0x22, 0xA0, 0x2F, 0x6E, 0x38, 0x4A, 0xC2, 0x3B, 
+0x9A, 0xA5, 0x9F, 0x1A, 0x79, 0x07, 0x48, 0xBE, 
+0x91, 0x75, 0x75, 0x1C, 0x5B, 0xB7, 0x93, 0xDA, 
+0xCE, 0x82, 0x84, 0x76, 0x49, 0x4C, 0xEF, 0xBF, 
+0x33, 0xA0, 0x18, 0xE0, 0x8E, 0xF5, 0x41, 0xD2, 
+0x35, 0x8C, 0x4A, 0xCA, 0xA7, 0xC7, 0x8E, 0x7E, 
+0x1C, 0x06, 0x7D, 0x25, 0x57, 0x48, 0xED, 0xB7, 
+0xE6, 0x6C, 0x94, 0x5F, 0xA8, 0xA5, 0x33, 0x69, 
+0x4D, 0x4C, 0x6B, 0x8F, 0x65, 0x28, 0x08, 0xB0, 
+
+0x51, 0xA7, 0xFD, 0xB4, 0x47, 0x7C, 0x15, 0x16, 
+0x95, 0x8C, 0x1C, 0xA8, 0xA9, 0x9D, 0xD5, 0xC8, 
+0x23, 0x14, 0xAC, 0xF7, 0x8C, 0xC1, 0xF3, 0x9B, 
+0x82, 0xA5, 0x08, 0x7A, 0x56, 0x31, 0xD1, 0xD9, 
+0x7D, 0x45, 0x50, 0x6B, 0x22, 0x76, 0xFC, 0x02, 
+0x2F, 0x23, 0x1D, 0xCA, 0x0F, 0xF9, 0xC7, 0x6A, 
+0x9A, 0x4C, 0x42, 0x4E, 0x8C, 0x90, 0x17, 0x1F, 
+0x54, 0x79, 0x84, 0x33, 0x13, 0x74, 0x24, 0x75, 
+0xC8, 0xED, 0x19, 0x9C, 0xFF, 0x11, 0xE8, 0x6D, 
+0x6F, 0xF9, 0x9E, 0xBD, 0x0F, 0x4A, 0x68, 0x62, 
+0x80, 0x2E, 0x89, 0xB9, 0x9E, 0xC2, 0x58, 0xD3, 
+0x71, 0xC2, 0xEC, 0x94, 0xD7, 0xC3, 0xA6, 0xFB, 
+0x41, 0xAC, 0x79, 0xFC, 0x2A, 0xE9, 0x14, 0x0F, 
+0xD0, 0x5B, 0x2E, 0x6F, 0x45, 0x91, 0xE5, 0x83, 
+0xAC, 0x61, 0x0D, 0xAD, 0x59, 0xF5, 0x12, 0x56, 
+0x10, 0xC1, 0x1F, 0xDA, 0x61, 0xF8, 0x75, 0x3D, 
+0xFD, 0x5A, 0x04, 0x6F, 0xDB, 0x20, 0xCE, 0xEA, 
+0xB0, 0x70, 0xFC, 0x6F, 0xF0, 0xE3, 0xC3, 0xF5, 
+0x76, 0x99, 0x61, 0x4E, 0x99, 0x95, 0xDE, 0x11, 
+0x2A, 0xE1, 0x2D, 0x9B, 0x09, 0x1E, 0x08, 0x04, 
+0x9D, 0x78, 0xF7, 0xE1, 0xD6, 0x44, 0x63, 0x39, 
+0x2B, 0x66, 0xF7, 0xBE, 0xE0, 0xB5, 0xDB, 0x6A, 
+0x4B, 0x62, 0xC3, 0x4B, 0x03, 0x24, 0x65, 0x38, 
+0x60, 
+
+0x9F, 0xD3, 0x84, 0x75, 0xD5, 0xB7, 0x73, 0xA7, 
+0x2E, 0xB8, 0xDD, 0xAA, 0x71, 0x70, 0x9C, 0x0C, 
+0x38, 0x22, 0x27, 0x6C, 0x76, 0x3B, 0x87, 0x46, 
+0x5D, 0x3A, 0x8F, 0x47, 0xD2, 0x78, 0xD6, 0xD3, 
+0xB0, 0x37, 0xDD, 0x80, 0xEE, 0x82, 0xDD, 0x38, 
+0x84, 0x9E, 0xEE, 0xCA, 0x21, 0x87, 0x44, 0x35, 
+0xB1, 0x1C, 0xAD, 0x7E, 0x81, 0x2F, 0x05, 0xFF, 
+0x66, 0x32, 0x86, 0xEC, 0x19, 0x54, 0xA8, 0x64, 
+0x4B, 0xD8, 0x20, 0x55, 0x44, 0xE8, 0xEE, 0xEE, 
+0x1A, 0x1C, 0x02, 0x3A, 0x5B, 0xE8, 0x5A, 0x9A, 
+0x2C, 0x70, 0x45, 0x3F, 0x3E, 0x47, 0x9F, 0xBB, 
+0x84, 0xAB, 0xE7, 0x43, 0x4F, 0x43, 0x14, 0x8B, 
+0xE5, 0x62, 0x75, 0xE9, 0xB9, 0xF5, 0xCC, 0xBC, 
+0x7A, 0x42, 0x39, 0x3C, 0xBF, 0x89, 0xB0, 0x22, 
+0x68, 0xA4, 0x33, 0x77, 0xB9, 0x0D, 0x01, 0xED, 
+0x24, 0x7A, 0xE7, 0x5E, 0x73, 0xC5, 0xF5, 0x1B, 
+0x13, 0xFF, 0x33, 0x5F, 0xC9, 0x1F, 0x19, 0xD5, 
+0xEE, 0x5F, 0xF5, 0xE5, 0x76, 0x7F, 0xC6, 0x7E, 
+0x6F, 0x8A, 0x78, 0x4C, 0x41, 0x7A, 0x8F, 0x5C, 
+0x5C, 0xB0, 0x1D, 0x5F, 0xD4, 0xEA, 0x7C, 0xF2, 
+0x3C, 0xA0, 0xEB, 0x19, 0x97, 0xC3, 0xE9, 0xD2, 
+0x67, 0x64, 0x30, 0x96, 0x3F, 0x06, 0x2A, 0x69, 
+0x23, 0x94, 0xC7, 0xAE, 0xF7, 0x6F, 0x12, 0x76, 
+0xA3, 0x57, 
+
+0xA0, 0x7C, 0x06, 0xCD, 0x10, 0x86, 0x4A, 0x00, 
+0x62, 0x11, 0xB0, 0x7D, 0x49, 0xCF, 0xCF, 0x0E, 
+0xF9, 0xBC, 0x6F, 0x87, 0x52, 0x96, 0xBF, 0xF8, 
+0x58, 0xD8, 0xD7, 0xC1, 0xC2, 0x5A, 0xE4, 0xEC, 
+0x6E, 0xF9, 0x7C, 0x58, 0x17, 0xE7, 0x75, 0x8A, 
+0x3B, 0x91, 0x4B, 0xDA, 0xD9, 0x39, 0x2E, 0x5F, 
+0x2F, 0x84, 0xF3, 0xB5, 0x5B, 0x1D, 0x32, 0x6F, 
+0x2F, 0x5D, 0xA7, 0x96, 0xA1, 0x63, 0xF9, 0x39, 
+0xA9, 0xAD, 0x69, 0x17, 0xD0, 0x46, 0xF7, 0xF1, 
+0x41, 0xAC, 0xDD, 0x46, 0x78, 0x83, 0x51, 0x83, 
+0xD9, 0x46, 0xB0, 0xFD, 0x46, 0x69, 0xFC, 0x1D, 
+0xE8, 0x84, 0xAE, 0xA6, 0xB8, 0x81, 0xE7, 0xB5, 
+0x09, 0xE2, 0x32, 0x92, 0x09, 0x76, 0x27, 0x7D, 
+0xBB, 0x7B, 0x9F, 0x71, 0x45, 0x18, 0x86, 0xAA, 
+0x63, 0xFF, 0x90, 0xCD, 0x2D, 0xFF, 0x53, 0x57, 
+0x79, 0xD3, 0x72, 0x7D, 0x5F, 0xE4, 0x2F, 0x47, 
+0x47, 0x11, 0x7D, 0x02, 0x5A, 0xC8, 0xC1, 0x86, 
+0x3B, 0x35, 0xEE, 0xFC, 0xAC, 0x72, 0xB9, 0x30, 
+0x1E, 0x8C, 0xE5, 0xCD, 0x03, 0xF1, 0xF0, 0xCF, 
+0x80, 0x0A, 0xB6, 0x81, 0xE3, 0x10, 0x93, 0xA5, 
+0xF4, 0x57, 0x39, 0x51, 0x06, 0xD3, 0x7B, 0x08, 
+0x32, 0x3E, 0xD2, 0x2A, 0xD8, 0xAE, 0x43, 0x9B, 
+0x4D, 0x7C, 0x25, 0xC4, 0xD8, 0xFD, 0x0B, 0x2B, 
+0xDF, 0x3C, 0xE2, 
+
+0xB3, 0x31, 0x55, 0x18, 0xDB, 0x22, 0xC8, 0xA4, 
+0x67, 0x53, 0xA2, 0x08, 0x07, 0x35, 0x9D, 0x1B, 
+0xB7, 0xEB, 0x14, 0xFA, 0x54, 0x78, 0x83, 0x54, 
+0xA4, 0xBB, 0x4B, 0xA3, 0xC6, 0x69, 0x9B, 0xB4, 
+0xA8, 0x00, 0xE8, 0x77, 0xD0, 0xFE, 0xF0, 0x82, 
+0x6E, 0x40, 0xF3, 0x1D, 0x33, 0x8F, 0xB7, 0x2E, 
+0xD7, 0x94, 0x78, 0x22, 0x34, 0x3A, 0x49, 0x6C, 
+0xC9, 0x3D, 0x3D, 0x85, 0x62, 0xA1, 0x2D, 0xBC, 
+0xA7, 0x48, 0x3B, 0xF3, 0x2C, 0xA1, 0xCD, 0x41, 
+0x6C, 0x6D, 0x70, 0x75, 0x99, 0x69, 0x98, 0x9A, 
+0xC7, 0x0A, 0x8B, 0xA2, 0x63, 0xB0, 0x9D, 0x46, 
+0xA7, 0xEF, 0x77, 0x37, 0x49, 0xB0, 0x2C, 0x45, 
+0xFE, 0x16, 0x90, 0x86, 0x77, 0x92, 0xC6, 0x63, 
+0x07, 0x48, 0x4E, 0x99, 0x71, 0x57, 0x43, 0xDA, 
+0x5B, 0x46, 0xCA, 0x10, 0xFB, 0x50, 0xDC, 0x3D, 
+0xCB, 0x6A, 0x7E, 0x38, 0x21, 0x94, 0x95, 0xBE, 
+0x00, 0xB9, 0x95, 0x95, 0xAC, 0x13, 0xBE, 0x63, 
+0x62, 0x1C, 0x1B, 0xD9, 0xBD, 0xF2, 0xE2, 0x79, 
+0xF0, 0x75, 0x0B, 0x37, 0xE5, 0x7A, 0x74, 0x1A, 
+0xB0, 0x34, 0x2E, 0x31, 0x96, 0x3E, 0x7D, 0x27, 
+0xAB, 0xE2, 0x51, 0x5E, 0xFB, 0x68, 0x80, 0xC5, 
+0xE1, 0x73, 0x03, 0xE5, 0xDB, 0x0A, 0x39, 0x7B, 
+0x0C, 0xBE, 0x44, 0xAA, 0x8A, 0x4A, 0x07, 0x54, 
+0x47, 0x73, 0x0A, 0xF5, 
+
+0x94, 0x40, 0x9B, 0xAE, 0xFA, 0xE8, 0x85, 0x45, 
+0xB8, 0xEB, 0x6C, 0x54, 0x26, 0x1D, 0x78, 0xA0, 
+0x7D, 0x4E, 0x8F, 0x5A, 0x6C, 0x40, 0x29, 0x86, 
+0x6A, 0x0E, 0xDC, 0x94, 0x31, 0xBA, 0x1E, 0x0F, 
+0x02, 0x3F, 0xA9, 0x4B, 0xBA, 0xB3, 0x65, 0x1A, 
+0xE2, 0x96, 0x1F, 0x9D, 0x00, 0xAB, 0x92, 0xAA, 
+0x5E, 0xD7, 0xFE, 0x80, 0x4F, 0x39, 0x72, 0x87, 
+0xEF, 0x52, 0xC9, 0x42, 0x0D, 0x9F, 0xFB, 0x29, 
+0xDB, 0x4C, 0x8A, 0x07, 0x3F, 0x51, 0xEA, 0xDF, 
+0x28, 0xCC, 0xF9, 0xC9, 0x36, 0x94, 0x15, 0xE6, 
+0xC6, 0x3A, 0x3F, 0xA8, 0xC5, 0xB7, 0x61, 0xCD, 
+0xE9, 0xB5, 0xB8, 0x71, 0x23, 0x3D, 0xC1, 0x8C, 
+0xD9, 0x12, 0x81, 0x3B, 0x71, 0x23, 0xB2, 0xC5, 
+0xCF, 0x0E, 0x54, 0x11, 0x73, 0x68, 0x98, 0xF5, 
+0x03, 0x28, 0xF2, 0x3E, 0x91, 0xD7, 0x17, 0xD0, 
+0xD8, 0x3A, 0x4C, 0x75, 0x36, 0x1D, 0xA8, 0x3C, 
+0x8A, 0x60, 0x05, 0x01, 0x60, 0xB7, 0xCA, 0x00, 
+0xB6, 0xAC, 0x85, 0x09, 0xC5, 0x23, 0x89, 0x73, 
+0x44, 0x8F, 0xAB, 0x88, 0x4B, 0x5B, 0x6B, 0xA9, 
+0x0D, 0x14, 0x29, 0xF4, 0xC5, 0x73, 0x9C, 0x34, 
+0x0B, 0xA1, 0xAA, 0x9F, 0x62, 0x3D, 0x46, 0xEA, 
+0x63, 0x76, 0x3A, 0xFD, 0x1F, 0x06, 0x0C, 0xB0, 
+0xA9, 0x31, 0xFF, 0x34, 0x47, 0xAF, 0xFD, 0x1F, 
+0x98, 0x7B, 0xDF, 0xB2, 0x1E, 
+
+0x5B, 0x48, 0x8A, 0xC5, 0x9E, 0x53, 0x35, 0x92, 
+0x8F, 0x4D, 0xDB, 0x47, 0xE4, 0x1A, 0x9A, 0x31, 
+0xF8, 0xE2, 0x6D, 0x2E, 0x7C, 0x83, 0xAC, 0xEE, 
+0x43, 0x8F, 0x1B, 0x83, 0x8E, 0x03, 0x2D, 0x7A, 
+0x27, 0xC4, 0x62, 0x5F, 0x34, 0x79, 0xE0, 0x1D, 
+0xD3, 0xDC, 0x71, 0x5F, 0x2F, 0x28, 0xFA, 0xE2, 
+0x2D, 0xE0, 0xA9, 0x5F, 0x97, 0xA0, 0x79, 0x54, 
+0x0B, 0xD9, 0xF3, 0x9C, 0xB2, 0x5D, 0x58, 0x44, 
+0xA7, 0x4F, 0x86, 0x66, 0x18, 0x80, 0xE4, 0x88, 
+0x86, 0x08, 0xF5, 0x18, 0x62, 0x63, 0xA3, 0xAD, 
+0xC8, 0x44, 0xBD, 0xEE, 0x4D, 0x0D, 0x8A, 0x90, 
+0x1A, 0x3E, 0x53, 0xE0, 0xF7, 0xC6, 0x65, 0x68, 
+0xF4, 0xEB, 0x5A, 0x30, 0x09, 0x1F, 0xE6, 0xB1, 
+0xDE, 0xE5, 0x08, 0x42, 0x45, 0xD0, 0x77, 0x38, 
+0xFB, 0x4A, 0xB0, 0x7E, 0x11, 0x28, 0xCD, 0xEF, 
+0x21, 0x69, 0x03, 0x10, 0x77, 0x62, 0xD0, 0xC6, 
+0x7C, 0xE5, 0x8B, 0x69, 0xC4, 0xA1, 0xFE, 0x1D, 
+0x02, 0x35, 0x7B, 0x0A, 0x10, 0xD2, 0x29, 0x33, 
+0x59, 0x1B, 0x04, 0x23, 0xB1, 0x47, 0xD2, 0x62, 
+0x36, 0x4B, 0xD8, 0xC0, 0xEB, 0x95, 0xD8, 0x47, 
+0x21, 0x92, 0x09, 0x51, 0xB8, 0xA1, 0xAD, 0x63, 
+0x18, 0x6E, 0x23, 0xC7, 0x70, 0x9D, 0xD8, 0xA3, 
+0x2B, 0x14, 0x49, 0x29, 0xE0, 0x3E, 0x60, 0x75, 
+0x6A, 0x44, 0x05, 0x69, 0xAF, 0x51, 
+
+0xAC, 0x1F, 0x74, 0xDA, 0x8D, 0xBA, 0x95, 0xCE, 
+0x44, 0xA4, 0x22, 0xDB, 0x04, 0x08, 0xB4, 0xB4, 
+0x98, 0x14, 0x8E, 0x63, 0xEA, 0xB7, 0xE4, 0x16, 
+0xB1, 0x06, 0x9F, 0xD0, 0x93, 0xA8, 0x53, 0xE3, 
+0xD8, 0x8C, 0x2C, 0x3A, 0x9B, 0x99, 0x0D, 0x95, 
+0xCB, 0xAF, 0xB5, 0x8D, 0x0D, 0x88, 0xA1, 0x36, 
+0x02, 0x12, 0x2A, 0xEA, 0xE0, 0xD2, 0xF0, 0x26, 
+0x83, 0x58, 0x9F, 0xBC, 0xD6, 0xE5, 0x49, 0x22, 
+0xFC, 0xAB, 0x82, 0x5F, 0xAE, 0x52, 0x95, 0x1D, 
+0x23, 0x99, 0xFA, 0xFF, 0xB8, 0x4E, 0x3B, 0xB2, 
+0x4A, 0x35, 0x69, 0xB6, 0x39, 0x0D, 0xC9, 0x99, 
+0x66, 0x10, 0x6D, 0xE3, 0xFD, 0x9F, 0x22, 0x45, 
+0x32, 0x9A, 0xB5, 0x91, 0x5F, 0x13, 0x00, 0xF8, 
+0x11, 0x36, 0xF9, 0xFC, 0x6A, 0x28, 0x1C, 0x2E, 
+0x86, 0x0F, 0x89, 0x8D, 0x60, 0xE9, 0x07, 0xC0, 
+0x22, 0x02, 0xD2, 0x65, 0x05, 0x18, 0xEC, 0xD5, 
+0xC3, 0xEE, 0x19, 0x29, 0x49, 0x57, 0xDF, 0x3D, 
+0x66, 0x57, 0x0B, 0x7B, 0xB1, 0x20, 0x2B, 0xAE, 
+0xED, 0x74, 0x81, 0xC1, 0x93, 0xD1, 0xDF, 0x79, 
+0x87, 0xA6, 0x0D, 0xDD, 0xCC, 0xC3, 0x4C, 0x49, 
+0x4F, 0x89, 0xC0, 0xB3, 0xAC, 0xF9, 0xA9, 0x13, 
+0x98, 0x9C, 0x6B, 0x66, 0xB4, 0xD3, 0xA8, 0x18, 
+0x26, 0x2A, 0x8F, 0x82, 0x67, 0x57, 0x55, 0x4A, 
+0x1B, 0xFC, 0xF2, 0x8A, 0x44, 0x6E, 0x55, 
+
+0x13, 0x6C, 0x77, 0x1B, 0x6A, 0x9F, 0x7C, 0xCE, 
+0x47, 0x3B, 0xD7, 0x1A, 0xA5, 0x3F, 0x38, 0x90, 
+0x36, 0x4D, 0xB4, 0xAE, 0xCA, 0xAD, 0x70, 0x21, 
+0x3A, 0x9D, 0xFD, 0x35, 0x22, 0x2A, 0x9C, 0xE8, 
+0xDF, 0x30, 0x27, 0xE7, 0x8C, 0x22, 0xB7, 0xF5, 
+0x88, 0xF0, 0xB4, 0x00, 0x69, 0xA8, 0x99, 0x47, 
+0x09, 0xF2, 0x54, 0xB8, 0x6F, 0x24, 0xCE, 0x56, 
+0x1A, 0xD0, 0xA7, 0x74, 0xE7, 0xDE, 0x6C, 0x52, 
+0x5C, 0x15, 0x3A, 0x6F, 0xDA, 0xC9, 0xF2, 0x74, 
+0x24, 0x5F, 0x20, 0x83, 0xE1, 0xEE, 0xDD, 0x75, 
+0x68, 0x82, 0x84, 0xAD, 0xED, 0x4D, 0x3D, 0x1D, 
+0x47, 0xAF, 0x24, 0xEB, 0x1A, 0xA6, 0xD3, 0x6D, 
+0x75, 0x5E, 0x5F, 0x72, 0x40, 0x5C, 0x81, 0x00, 
+0x4E, 0x5D, 0x44, 0x92, 0x57, 0x71, 0x2C, 0xF4, 
+0x85, 0xBA, 0x73, 0x2F, 0x6D, 0x4A, 0x05, 0xB0, 
+0x74, 0x37, 0xCE, 0x23, 0x82, 0x96, 0x3A, 0x78, 
+0x1D, 0x1C, 0xCB, 0xE1, 0x82, 0x35, 0x95, 0x53, 
+0x48, 0x22, 0x3E, 0x22, 0xB1, 0x45, 0x56, 0x99, 
+0xC4, 0x41, 0xA4, 0x88, 0xF0, 0xA5, 0xEB, 0x5C, 
+0x23, 0xB6, 0x06, 0x7F, 0x86, 0xD1, 0x93, 0x22, 
+0x1C, 0x71, 0xA3, 0x5D, 0x57, 0x72, 0x92, 0x81, 
+0x90, 0x5A, 0x82, 0xFD, 0x51, 0x2A, 0x9D, 0xE1, 
+0x45, 0x78, 0x38, 0x2E, 0x12, 0xD1, 0x6B, 0xBA, 
+0x7F, 0x10, 0x73, 0x2E, 0x4E, 0xAA, 0x1E, 0x95, 
+
+0xF8, 0x5C, 0xD0, 0x5F, 0x9D, 0x18, 0x26, 0x30, 
+0x97, 0xA9, 0xC7, 0x6A, 0x51, 0x7A, 0x09, 0x7F, 
+0x89, 0xE5, 0x89, 0x22, 0x06, 0x12, 0x10, 0x09, 
+0xCF, 0xF5, 0xAF, 0x92, 0xD0, 0xC9, 0xEA, 0xF0, 
+0xE4, 0x6A, 0x26, 0xF6, 0x90, 0x47, 0xF3, 0x99, 
+0xB2, 0xA5, 0xBB, 0xC7, 0x74, 0x14, 0xF9, 0xDA, 
+0xF1, 0x08, 0xA5, 0xCA, 0xA9, 0xAD, 0x13, 0xEC, 
+0x2A, 0xB3, 0xEE, 0xAA, 0xD2, 0x0A, 0x2B, 0xD0, 
+0x85, 0x65, 0x35, 0x0A, 0xC2, 0x1D, 0x84, 0xDA, 
+0x32, 0x56, 0x48, 0x44, 0x67, 0x35, 0x45, 0x2A, 
+0x75, 0x51, 0x2D, 0x6A, 0xA0, 0x27, 0xA3, 0x12, 
+0xE8, 0xF4, 0x09, 0xDE, 0x6B, 0x4A, 0x8E, 0x32, 
+0x7B, 0xDF, 0x37, 0x6C, 0x41, 0x04, 0x6E, 0x4F, 
+0x46, 0x6C, 0x31, 0xFC, 0x5C, 0x49, 0xB2, 0x62, 
+0x8F, 0x04, 0xB3, 0xFF, 0x68, 0x95, 0x3B, 0x37, 
+0x52, 0xFF, 0x5E, 0x61, 0xE4, 0xCD, 0xD7, 0xC7, 
+0xA5, 0xFC, 0x1F, 0x85, 0x01, 0xB1, 0x59, 0x3A, 
+0xC1, 0x5A, 0x39, 0x69, 0xBD, 0xC7, 0x56, 0xE3, 
+0x98, 0xF3, 0xC2, 0xCC, 0x6D, 0x52, 0x85, 0xF7, 
+0xE7, 0xD7, 0x33, 0x05, 0x50, 0xDA, 0x80, 0xE4, 
+0xC4, 0x63, 0x07, 0x72, 0x22, 0x8B, 0x3B, 0xC3, 
+0xFD, 0x40, 0xBB, 0xDE, 0xEE, 0x28, 0xCB, 0xEC, 
+0x3B, 0x03, 0xB5, 0x18, 0x73, 0x91, 0xB7, 0x88, 
+0x44, 0x3A, 0xA2, 0xA7, 0x9E, 0xF9, 0x23, 0x0A, 
+0x7F, 
+
+0xE4, 0x36, 0x55, 0x89, 0xE3, 0x1B, 0xE0, 0x72, 
+0x50, 0xDC, 0x78, 0x32, 0x4F, 0x19, 0x73, 0x1F, 
+0x8D, 0x40, 0x30, 0xD6, 0xCA, 0x52, 0xC6, 0x41, 
+0x39, 0xD0, 0xEF, 0xBF, 0x0E, 0x60, 0x99, 0xBB, 
+0x47, 0xC2, 0xA4, 0x32, 0x48, 0xAF, 0x38, 0x5C, 
+0x66, 0xF0, 0x95, 0xF2, 0x78, 0x0C, 0x80, 0x72, 
+0x4E, 0x0A, 0xE9, 0x43, 0x5D, 0xC7, 0x06, 0xD0, 
+0x4A, 0xD3, 0xA2, 0xE3, 0x35, 0x69, 0x79, 0xD8, 
+0x04, 0x5A, 0x6A, 0xCA, 0xF7, 0x4F, 0x51, 0x0F, 
+0x96, 0x24, 0x72, 0xB7, 0xC0, 0xE7, 0xFB, 0xCB, 
+0x44, 0x1F, 0x92, 0x96, 0x3F, 0xAD, 0x10, 0x7D, 
+0x36, 0x3D, 0x2D, 0x78, 0x53, 0x76, 0x7E, 0x1C, 
+0x9E, 0x64, 0xA2, 0x19, 0x55, 0xD7, 0xF2, 0xF1, 
+0x9A, 0x52, 0x5C, 0x03, 0x55, 0x38, 0xEE, 0x0B, 
+0xD0, 0xE3, 0xB4, 0x66, 0x32, 0xFF, 0x39, 0xAC, 
+0x50, 0x2F, 0xA2, 0x31, 0xCE, 0x54, 0xEC, 0x76, 
+0x9E, 0x54, 0x52, 0x0F, 0xBC, 0x6C, 0x9B, 0xA7, 
+0x3A, 0xE1, 0x64, 0x8A, 0x18, 0x3F, 0x12, 0xDE, 
+0x1E, 0xBE, 0x44, 0xAD, 0x24, 0xE9, 0xBD, 0xB2, 
+0x59, 0x25, 0x6F, 0x89, 0x25, 0xE2, 0x62, 0x98, 
+0xE6, 0x87, 0x58, 0x36, 0x54, 0x93, 0x8A, 0x11, 
+0x3F, 0x70, 0xA5, 0xFE, 0x25, 0x35, 0x6F, 0x3A, 
+0x6D, 0x75, 0xA2, 0x6E, 0xFF, 0x5E, 0x38, 0x73, 
+0x65, 0xAC, 0xC6, 0x98, 0xDE, 0xB5, 0xB2, 0x34, 
+0xED, 0x30, 
+
+0x9E, 0x25, 0x27, 0x69, 0x56, 0x66, 0x0B, 0xDF, 
+0x1C, 0x15, 0xC8, 0xFF, 0x75, 0x5D, 0x42, 0xF3, 
+0x3D, 0xFE, 0x63, 0x0A, 0x87, 0x66, 0xB8, 0xAC, 
+0xC0, 0xE1, 0xE0, 0x48, 0x80, 0x5C, 0x30, 0x56, 
+0x55, 0x6F, 0xC0, 0x6E, 0x9C, 0x14, 0xFF, 0x15, 
+0x26, 0x0C, 0xF5, 0xCB, 0x41, 0xCF, 0x9D, 0x01, 
+0x45, 0xE0, 0x8A, 0x5C, 0xD6, 0x38, 0xBB, 0xB7, 
+0x65, 0x6A, 0x2B, 0x12, 0x5D, 0x46, 0xAC, 0x17, 
+0x7A, 0x7B, 0xAA, 0xDB, 0x37, 0xA7, 0x82, 0x64, 
+0xAC, 0xCC, 0x04, 0x9F, 0x49, 0xEB, 0xF9, 0x69, 
+0x2E, 0xDF, 0x91, 0x91, 0x00, 0xA5, 0x24, 0xEE, 
+0xC5, 0x3C, 0x93, 0x86, 0x2A, 0x5C, 0xD2, 0xFD, 
+0xC7, 0x00, 0x26, 0xF8, 0xF6, 0x33, 0x96, 0x12, 
+0x40, 0xE5, 0xC1, 0xAE, 0x78, 0x97, 0xD8, 0x5A, 
+0x0B, 0xB1, 0x97, 0x4A, 0xC5, 0x57, 0xF0, 0x56, 
+0x6D, 0x4B, 0x6D, 0xFA, 0x8D, 0x7C, 0x1F, 0xEF, 
+0x3D, 0x75, 0xC8, 0x0E, 0x8E, 0x67, 0xEF, 0x6C, 
+0xC4, 0xCC, 0xE2, 0xC8, 0x05, 0x0F, 0x7F, 0x2F, 
+0x52, 0xC3, 0xA2, 0x5B, 0x20, 0xB1, 0x33, 0x4D, 
+0x86, 0x9E, 0xB0, 0x9D, 0xF9, 0xCB, 0x0D, 0x36, 
+0x4B, 0x59, 0xEC, 0x14, 0xBC, 0x6E, 0xBE, 0xE1, 
+0x18, 0x90, 0xF3, 0x2C, 0x1B, 0x40, 0x71, 0xF5, 
+0x9A, 0xF0, 0x76, 0xFF, 0xE8, 0xAE, 0x91, 0x96, 
+0xA3, 0x79, 0xF5, 0x65, 0x9A, 0x16, 0x57, 0xCF, 
+0x5E, 0x4A, 0x25, 
+
+0xB0, 0xF5, 0xC3, 0x79, 0x67, 0x66, 0xC9, 0x73, 
+0x43, 0x2D, 0x80, 0x86, 0x74, 0xF8, 0xD7, 0x4C, 
+0xC6, 0x9E, 0x4B, 0xC6, 0xA4, 0x93, 0x61, 0xE9, 
+0x1D, 0xB7, 0x47, 0xAE, 0x3D, 0x8D, 0xF7, 0xF3, 
+0x0C, 0xF1, 0x6C, 0x18, 0x71, 0x3C, 0x31, 0xDC, 
+0x1A, 0xD6, 0x96, 0x08, 0x7C, 0x3E, 0xCB, 0xA8, 
+0x69, 0x30, 0x38, 0x29, 0x5A, 0x8A, 0x7B, 0x5D, 
+0xFC, 0x2E, 0x1A, 0xA0, 0x11, 0x76, 0x11, 0xFE, 
+0x31, 0x24, 0x61, 0xEB, 0x7D, 0x3F, 0x26, 0x9D, 
+0x0F, 0x93, 0xEC, 0xFA, 0xB6, 0x43, 0xB4, 0x95, 
+0x4F, 0x9D, 0x48, 0xA9, 0x96, 0x77, 0xC3, 0x61, 
+0xF4, 0x8C, 0x93, 0xF6, 0x37, 0x57, 0xFA, 0x27, 
+0xFA, 0xCC, 0x8B, 0x14, 0xD8, 0x51, 0xD4, 0xC1, 
+0x0E, 0x46, 0x1B, 0x72, 0x2D, 0x0C, 0xE0, 0x9D, 
+0x53, 0x43, 0x92, 0xC1, 0xBC, 0x78, 0x14, 0x90, 
+0x8D, 0x34, 0xD3, 0xD7, 0x01, 0xA6, 0xFF, 0x71, 
+0xC6, 0x39, 0x42, 0xB5, 0x8D, 0x4B, 0x56, 0xE5, 
+0xE4, 0x92, 0xB3, 0x7B, 0xFE, 0x7D, 0xAF, 0xBF, 
+0x3A, 0x0E, 0x32, 0x78, 0x4C, 0xB4, 0xEA, 0x58, 
+0x75, 0xD2, 0x2B, 0x50, 0xE8, 0x84, 0x7D, 0x12, 
+0xAA, 0x8F, 0x52, 0x67, 0x2C, 0x26, 0x26, 0xB4, 
+0x0E, 0x2D, 0x4A, 0x25, 0x3D, 0xEB, 0xEB, 0x81, 
+0xD2, 0x1E, 0x48, 0xA8, 0x48, 0x8F, 0xA4, 0x76, 
+0x3B, 0x89, 0xBA, 0x5F, 0xC4, 0x71, 0x49, 0x39, 
+0x8D, 0x64, 0xB9, 0xAE, 
+
+0xD5, 0x1D, 0xC9, 0x42, 0xF4, 0x8C, 0x99, 0x91, 
+0x6F, 0x43, 0x0A, 0xDD, 0x24, 0xEB, 0x14, 0x6F, 
+0x0C, 0x89, 0x26, 0xBB, 0x67, 0xA7, 0x64, 0x75, 
+0xC8, 0xE1, 0x79, 0xAE, 0x80, 0xC7, 0x25, 0x49, 
+0x25, 0x00, 0xE0, 0x52, 0x03, 0xA7, 0x95, 0x21, 
+0x4D, 0x27, 0xB0, 0x2A, 0xE4, 0x4F, 0xB7, 0x26, 
+0xCA, 0x30, 0x31, 0x74, 0xEA, 0x82, 0x0A, 0x51, 
+0x2D, 0xDC, 0x69, 0x6F, 0x2B, 0xBB, 0x6E, 0x65, 
+0x3A, 0xD6, 0x08, 0xE1, 0xDD, 0xC6, 0xBD, 0x1C, 
+0xCD, 0xD0, 0xEC, 0xF6, 0x7C, 0x98, 0x92, 0xE8, 
+0x71, 0x76, 0x16, 0xC4, 0x81, 0x04, 0xFD, 0x69, 
+0x61, 0x71, 0x2E, 0x2D, 0xF2, 0x0A, 0xBF, 0xDB, 
+0x28, 0xC7, 0x3D, 0xA9, 0x71, 0x23, 0x62, 0xDA, 
+0x5E, 0xA7, 0xE7, 0x11, 0x38, 0x4A, 0x9B, 0xDC, 
+0xCE, 0x98, 0x45, 0x51, 0x4E, 0x1E, 0xC9, 0x24, 
+0x3D, 0xBE, 0x3B, 0xB7, 0x65, 0xF4, 0x97, 0x4D, 
+0x2B, 0x18, 0x1D, 0xF4, 0x80, 0x0A, 0x25, 0x64, 
+0xD7, 0x13, 0x28, 0xEB, 0xBC, 0x61, 0x0D, 0xC3, 
+0x59, 0xC9, 0x32, 0x8B, 0x97, 0x8B, 0x1A, 0xE5, 
+0xAC, 0x9F, 0xC5, 0x38, 0x20, 0x0B, 0xFE, 0x0E, 
+0x94, 0x6A, 0xD0, 0x30, 0xD2, 0x16, 0xEE, 0x43, 
+0xB6, 0xBA, 0x5F, 0x3A, 0x17, 0x14, 0x88, 0xC1, 
+0xA1, 0x7F, 0x83, 0xC0, 0x6B, 0x23, 0xA4, 0xC2, 
+0x1F, 0x0E, 0x52, 0xDE, 0x15, 0xE8, 0xD1, 0xFD, 
+0x5E, 0xE6, 0xE7, 0x89, 0x59, 
+
+0xB8, 0xD3, 0x4D, 0xF9, 0xFC, 0xD2, 0x14, 0xB8, 
+0x73, 0x04, 0x7A, 0x21, 0x9B, 0x3D, 0x6D, 0x16, 
+0x5E, 0x0B, 0xC3, 0xF9, 0xF0, 0xA1, 0xE0, 0xCA, 
+0x44, 0x45, 0x83, 0xA2, 0xC8, 0x4A, 0xF2, 0xA3, 
+0x94, 0x28, 0xB0, 0x74, 0xFD, 0x19, 0xAB, 0x0E, 
+0xE5, 0x42, 0x85, 0xB0, 0x5E, 0x8B, 0x17, 0x77, 
+0x98, 0x6A, 0x24, 0x12, 0xED, 0x42, 0x82, 0x1B, 
+0xB4, 0xC6, 0xD7, 0x1C, 0x3B, 0x50, 0xB4, 0x33, 
+0x10, 0x9C, 0xD6, 0x37, 0x5C, 0x74, 0x5F, 0x85, 
+0xF3, 0x5B, 0xB6, 0xE5, 0x13, 0x9D, 0x48, 0xA9, 
+0x81, 0xA1, 0xE1, 0x03, 0xDE, 0xCA, 0xDC, 0xE0, 
+0x78, 0x9A, 0xAD, 0x01, 0x62, 0x16, 0x50, 0x59, 
+0x18, 0xAE, 0x8C, 0x20, 0x4D, 0xA1, 0x68, 0xE9, 
+0xE9, 0xF4, 0xC2, 0x85, 0xDC, 0xFC, 0x2B, 0x31, 
+0x09, 0xC5, 0x78, 0xBE, 0xAC, 0x38, 0xD9, 0x93, 
+0x54, 0xDA, 0xC0, 0x8E, 0x0D, 0xEE, 0xCA, 0x70, 
+0x81, 0x11, 0xA5, 0x3D, 0xEE, 0x89, 0xFC, 0x3A, 
+0x14, 0xC6, 0x6D, 0xCB, 0x2A, 0xFA, 0xE7, 0x8E, 
+0x5C, 0x4F, 0x39, 0xCE, 0x2D, 0x9F, 0x59, 0xCE, 
+0xBB, 0xE1, 0x4D, 0x31, 0xF8, 0x9F, 0xB5, 0x93, 
+0xA1, 0x08, 0x82, 0xAF, 0x89, 0x1B, 0x8E, 0x91, 
+0xED, 0x9E, 0x3C, 0x6A, 0xB1, 0x29, 0x5E, 0x8F, 
+0x6C, 0xC0, 0x53, 0x7A, 0x37, 0xCB, 0xBC, 0x0C, 
+0xB9, 0x32, 0xE0, 0x04, 0x72, 0xA1, 0x35, 0x00, 
+0x72, 0x45, 0xC1, 0x04, 0x54, 0x3E, 
+
+0xAF, 0xD3, 0x1C, 0x85, 0x1D, 0x99, 0xFD, 0x4F, 
+0x37, 0x12, 0xBE, 0xB1, 0x40, 0x87, 0xB0, 0xA3, 
+0xC5, 0x3B, 0xC1, 0xBB, 0xD3, 0x88, 0x0F, 0xDE, 
+0xF7, 0xAC, 0xA8, 0x31, 0x84, 0x02, 0x05, 0x0B, 
+0xFB, 0xF0, 0xB7, 0xCE, 0x38, 0x2C, 0xFE, 0x16, 
+0x7A, 0x51, 0xA8, 0xF2, 0x34, 0x60, 0x5C, 0xBD, 
+0x95, 0x40, 0x5B, 0x81, 0xD9, 0xCB, 0xC4, 0x5D, 
+0x74, 0x16, 0xC3, 0x66, 0xB9, 0xEC, 0xFC, 0x13, 
+0x52, 0x1A, 0xCC, 0x2D, 0xB3, 0x11, 0x8C, 0x3C, 
+0x31, 0x78, 0xFA, 0xB8, 0xA8, 0x74, 0x6D, 0x1D, 
+0xEB, 0x54, 0x59, 0x06, 0x11, 0xD5, 0xE0, 0xA6, 
+0xCF, 0x96, 0x03, 0x93, 0x2C, 0xD5, 0x68, 0x40, 
+0x70, 0xD1, 0xFD, 0xB1, 0xCA, 0x33, 0x66, 0x09, 
+0xE3, 0xA5, 0x57, 0x72, 0xC1, 0x34, 0x6D, 0xE2, 
+0x3D, 0x86, 0xF3, 0xF6, 0xCE, 0xE7, 0xDB, 0x26, 
+0x07, 0x7B, 0x62, 0x6C, 0xFE, 0xDE, 0xE4, 0x19, 
+0x68, 0x67, 0xBA, 0xBB, 0xF6, 0xD5, 0xF3, 0xA4, 
+0xDA, 0x11, 0x96, 0x49, 0x91, 0x15, 0x55, 0xA9, 
+0x45, 0x08, 0x90, 0x0B, 0x05, 0x9F, 0x49, 0x84, 
+0xB2, 0x74, 0x2A, 0xD4, 0x84, 0x72, 0x2D, 0xCF, 
+0x43, 0xEA, 0x36, 0x70, 0x2E, 0xF2, 0x47, 0x83, 
+0x50, 0x00, 0x37, 0x0A, 0x36, 0x8D, 0x83, 0x51, 
+0x08, 0x68, 0x85, 0x12, 0xF9, 0x2D, 0x38, 0xEE, 
+0xDD, 0x01, 0x87, 0x75, 0x7A, 0xC7, 0x0E, 0xC7, 
+0x70, 0xB1, 0x5F, 0x71, 0x93, 0xA0, 0x16, 
+
+0x3F, 0xFE, 0x66, 0xA3, 0xA4, 0xEA, 0x2A, 0xFA, 
+0xE6, 0xC0, 0xBD, 0xCF, 0xE8, 0x2A, 0x6E, 0x85, 
+0x8B, 0x0B, 0xF5, 0x24, 0xD7, 0x57, 0xA5, 0xF7, 
+0xC1, 0x99, 0x0F, 0xE4, 0xF5, 0xA5, 0x66, 0x37, 
+0xC4, 0xD6, 0x42, 0x8E, 0x2E, 0x29, 0xFE, 0xF0, 
+0x1A, 0x1D, 0xC9, 0x8F, 0x5C, 0xE2, 0x0B, 0x67, 
+0xF3, 0x5D, 0xE6, 0x88, 0x23, 0x8A, 0xC8, 0xED, 
+0xCA, 0x21, 0x96, 0x52, 0x9F, 0x18, 0x20, 0x5A, 
+0x00, 0x01, 0x6C, 0x00, 0x50, 0xA4, 0x68, 0x02, 
+0x64, 0xEA, 0xD2, 0xCA, 0x9A, 0x84, 0xEB, 0xF1, 
+0xCB, 0x59, 0x80, 0x8B, 0xC7, 0xF3, 0xAB, 0xB1, 
+0xE7, 0xF5, 0x4D, 0xFE, 0x0A, 0xE4, 0x77, 0x64, 
+0x48, 0xBC, 0x41, 0x47, 0x29, 0x90, 0x11, 0x65, 
+0x55, 0xF3, 0xE8, 0x36, 0x7D, 0xA3, 0xBE, 0x34, 
+0xF4, 0x34, 0x89, 0xFF, 0x71, 0xF2, 0x0E, 0xAC, 
+0x0A, 0xD2, 0x08, 0x9A, 0xDC, 0xCC, 0xF5, 0xA1, 
+0x8B, 0x87, 0x51, 0x04, 0xA3, 0x42, 0xB0, 0xC1, 
+0x1E, 0x42, 0x5A, 0x21, 0x3E, 0x04, 0xCB, 0x2F, 
+0xE5, 0x04, 0x4C, 0x42, 0x05, 0xC6, 0xE6, 0xE0, 
+0xD8, 0x4F, 0xF7, 0x72, 0x85, 0x64, 0x76, 0xB7, 
+0xAC, 0x1F, 0x7A, 0x0A, 0x30, 0x1F, 0xBF, 0x11, 
+0xEA, 0xE8, 0x91, 0xBD, 0x1D, 0x87, 0x3C, 0x56, 
+0x01, 0xCF, 0x2B, 0xC2, 0xA5, 0x6A, 0xB1, 0x57, 
+0xD2, 0x56, 0x0B, 0x37, 0xB7, 0xF0, 0x7E, 0xBA, 
+0x0E, 0x78, 0x09, 0x59, 0x6A, 0xA9, 0x0D, 0xD4, 
+
+0xE8, 0x79, 0xF3, 0x5E, 0x2F, 0x01, 0x05, 0x30, 
+0x4E, 0xDA, 0x11, 0xBE, 0x2F, 0x75, 0x82, 0x0E, 
+0xA1, 0x79, 0x9C, 0x0A, 0xB7, 0xC2, 0x5D, 0x47, 
+0x3B, 0x2A, 0xED, 0x43, 0x5B, 0x93, 0x90, 0xEA, 
+0x53, 0x84, 0x45, 0x6E, 0xD0, 0x65, 0x5B, 0xD0, 
+0xD3, 0x13, 0xFA, 0x8B, 0x7A, 0x0A, 0xFB, 0x37, 
+0xF7, 0xF0, 0x42, 0x8E, 0xA7, 0x64, 0xC0, 0xEC, 
+0x9D, 0xFE, 0xA5, 0x8E, 0xC7, 0x8F, 0x66, 0x2A, 
+0x3B, 0xF8, 0xDA, 0xC7, 0x67, 0x86, 0x38, 0x6F, 
+0x53, 0x65, 0xB4, 0x1D, 0xF2, 0x4D, 0xD7, 0xFB, 
+0xB8, 0xF1, 0x6F, 0xAF, 0x47, 0xB7, 0x9C, 0x66, 
+0x8E, 0xD8, 0xE8, 0xC6, 0x1B, 0xDC, 0xDF, 0x08, 
+0xF5, 0x7F, 0x74, 0x9E, 0x36, 0xE8, 0xB9, 0x5C, 
+0x82, 0x64, 0xC3, 0xCA, 0x2F, 0xB6, 0x74, 0xD6, 
+0xD1, 0x71, 0x01, 0x33, 0x40, 0x33, 0xCA, 0x5D, 
+0x15, 0x3B, 0xF9, 0x54, 0xCB, 0xFC, 0x9C, 0x8B, 
+0x81, 0xE7, 0x23, 0x2B, 0x36, 0xE5, 0x6D, 0x77, 
+0x54, 0x1E, 0x42, 0x34, 0xE4, 0x82, 0xBB, 0x14, 
+0x9F, 0xEB, 0xE6, 0x13, 0xD0, 0xD1, 0x72, 0xFE, 
+0x50, 0x59, 0x9A, 0x5A, 0x87, 0x39, 0x38, 0xE1, 
+0x8F, 0xF9, 0x8A, 0x35, 0x35, 0x9A, 0x0D, 0x92, 
+0xFC, 0xED, 0x37, 0x8E, 0x58, 0xE7, 0x5B, 0x35, 
+0x1A, 0x48, 0xEF, 0x59, 0x3D, 0x6F, 0x4B, 0xB5, 
+0xA3, 0x00, 0x37, 0xD9, 0x98, 0x8D, 0x59, 0x37, 
+0x94, 0xE4, 0xC1, 0xB5, 0x15, 0x77, 0x6F, 0xCF, 
+0x3B, 
+
+0xD1, 0xE9, 0x76, 0x11, 0x16, 0xEC, 0x32, 0x6B, 
+0x4D, 0x91, 0xA1, 0x28, 0x26, 0x5F, 0x92, 0xA3, 
+0x03, 0x95, 0x9D, 0x1F, 0x58, 0x45, 0x87, 0x8A, 
+0xEF, 0xA7, 0x16, 0x63, 0x30, 0x4A, 0x18, 0x53, 
+0xD5, 0xE1, 0x7A, 0x2E, 0x2B, 0x25, 0x54, 0xD1, 
+0x90, 0xBC, 0xE7, 0xE9, 0x8B, 0xEC, 0x83, 0xF9, 
+0xD3, 0x32, 0xB4, 0xF3, 0xFC, 0x55, 0x6C, 0x58, 
+0x97, 0x12, 0xBE, 0xDA, 0x0D, 0xC4, 0xF8, 0x57, 
+0x9B, 0x2D, 0xE6, 0x06, 0x69, 0x21, 0x3A, 0xD9, 
+0xA5, 0xB7, 0x7E, 0xBA, 0xB8, 0xFC, 0xEF, 0x3C, 
+0x52, 0x98, 0xED, 0xC9, 0xB4, 0xD5, 0x69, 0xC1, 
+0x3A, 0x32, 0x31, 0xD8, 0x35, 0x51, 0x69, 0xE8, 
+0x17, 0x35, 0x58, 0x5D, 0x91, 0x73, 0x0C, 0x6F, 
+0x63, 0xFC, 0x90, 0x69, 0x69, 0x8B, 0x4B, 0x33, 
+0x68, 0x2F, 0xA8, 0xAF, 0x7B, 0x41, 0xE8, 0x61, 
+0xA5, 0x2F, 0xF1, 0xC9, 0x23, 0x10, 0x41, 0x8D, 
+0x33, 0x2C, 0xD3, 0x4C, 0x5B, 0x5F, 0x32, 0x13, 
+0x07, 0x9D, 0x59, 0xF3, 0x3D, 0x76, 0x8C, 0xB0, 
+0x53, 0xD3, 0xA0, 0xE3, 0x97, 0x58, 0x19, 0xCF, 
+0xE3, 0x2B, 0x68, 0xAD, 0x7A, 0x6D, 0x39, 0xBD, 
+0x75, 0xD1, 0xBE, 0xD3, 0x37, 0x95, 0x37, 0xB2, 
+0xC5, 0x94, 0xA9, 0xD5, 0x33, 0xD3, 0x81, 0x4B, 
+0xF1, 0x82, 0x28, 0x9C, 0x34, 0x38, 0x28, 0xA1, 
+0x50, 0x35, 0xE6, 0x82, 0xE6, 0x70, 0xAE, 0x11, 
+0x79, 0xBE, 0xD5, 0x6C, 0x1E, 0x22, 0x43, 0x34, 
+0x5C, 0xFA, 
+
+0x14, 0x04, 0xC0, 0x42, 0x48, 0xB9, 0x92, 0x7D, 
+0xBC, 0x71, 0x92, 0xC0, 0x79, 0xF0, 0x0B, 0xFF, 
+0xB4, 0x1A, 0x6F, 0xFE, 0xB2, 0x7A, 0x19, 0x62, 
+0x72, 0x2D, 0xF0, 0xD1, 0xB4, 0xD5, 0xE4, 0x1F, 
+0x03, 0xFE, 0x59, 0xCC, 0x85, 0x17, 0xE5, 0xE3, 
+0x6E, 0xDC, 0xD0, 0xD4, 0x71, 0xA8, 0x7C, 0xE8, 
+0x5B, 0x30, 0x64, 0x95, 0x8A, 0xF3, 0xC3, 0x8B, 
+0x7D, 0xBA, 0xB0, 0x73, 0xDD, 0x22, 0x1E, 0x0F, 
+0x5D, 0x67, 0xD5, 0xF3, 0xC2, 0xB9, 0xBF, 0x23, 
+0xC5, 0xCB, 0x9E, 0x32, 0xD5, 0x8B, 0x20, 0x5D, 
+0xDD, 0x0E, 0x66, 0x08, 0x02, 0x42, 0x82, 0xAD, 
+0x29, 0x29, 0x83, 0xE7, 0x18, 0x92, 0xAE, 0x42, 
+0x19, 0x37, 0xAB, 0x82, 0x65, 0x38, 0xB1, 0x20, 
+0x64, 0xBE, 0xE9, 0x02, 0xDD, 0x74, 0x3B, 0x0E, 
+0x09, 0xDE, 0x68, 0x68, 0x1E, 0x1B, 0x72, 0x63, 
+0x31, 0xFC, 0xB0, 0x8F, 0x8B, 0xB4, 0x30, 0x4D, 
+0xD5, 0x54, 0x9B, 0xEF, 0x2D, 0x0C, 0x21, 0xD0, 
+0xF7, 0x62, 0x94, 0x48, 0x70, 0xC7, 0x9D, 0x19, 
+0x3D, 0x4E, 0x9E, 0xE6, 0xFF, 0x2B, 0x8D, 0x11, 
+0x6B, 0x29, 0x07, 0xFF, 0x3B, 0x5A, 0x8A, 0xF8, 
+0x66, 0xAB, 0x12, 0x18, 0x70, 0xE5, 0x35, 0x8C, 
+0x8D, 0xF3, 0x6F, 0x13, 0x55, 0x5C, 0xF8, 0xBF, 
+0x7F, 0xC2, 0xD2, 0xE0, 0xD8, 0x13, 0x90, 0xB5, 
+0x57, 0x14, 0xCB, 0xE5, 0xBD, 0xB2, 0xBB, 0x91, 
+0x55, 0xD3, 0xDB, 0x5A, 0x12, 0x87, 0x49, 0xD8, 
+0x3B, 0xEF, 0x69, 
+
+0x63, 0xEA, 0x2B, 0x59, 0xFA, 0x18, 0xEF, 0xF6, 
+0x94, 0x9E, 0x94, 0x2A, 0x15, 0x34, 0x03, 0xA4, 
+0x20, 0x5D, 0xF2, 0x93, 0xA7, 0x13, 0x92, 0x05, 
+0x26, 0x4C, 0x25, 0x7B, 0xB2, 0x39, 0x91, 0x4C, 
+0xEC, 0x9A, 0x81, 0x98, 0x76, 0xFE, 0xBD, 0x64, 
+0x8E, 0x63, 0xB8, 0x97, 0xEA, 0x77, 0xCE, 0x53, 
+0x54, 0xBB, 0xFE, 0x97, 0xC5, 0xB5, 0x80, 0x0D, 
+0x98, 0x8D, 0x87, 0x36, 0x75, 0x3F, 0xD8, 0x65, 
+0x7D, 0xC4, 0x0E, 0x23, 0x0B, 0xE4, 0x59, 0x28, 
+0x29, 0xE8, 0x81, 0x42, 0x8E, 0x85, 0x23, 0xDB, 
+0xB6, 0xD8, 0x35, 0xE9, 0xC9, 0xB5, 0x69, 0xA1, 
+0xA6, 0x30, 0x10, 0x00, 0xB3, 0x2A, 0x66, 0xFA, 
+0x16, 0xAC, 0x1E, 0x7A, 0x2A, 0x54, 0x1C, 0x40, 
+0x21, 0xED, 0xE4, 0x59, 0x9D, 0x44, 0xE6, 0xA9, 
+0xBD, 0x3F, 0xA4, 0x8F, 0x0D, 0x3E, 0xD8, 0xFC, 
+0x58, 0xD6, 0xFC, 0x49, 0xA5, 0x1D, 0xB6, 0xAE, 
+0xD3, 0x11, 0xFF, 0x54, 0xFE, 0x97, 0x4F, 0xAB, 
+0xB8, 0x78, 0x89, 0x05, 0xD4, 0x86, 0xFA, 0xD2, 
+0x2A, 0x0C, 0x2A, 0x6B, 0xF3, 0x1C, 0x3D, 0x27, 
+0x1A, 0xE7, 0x13, 0xC7, 0xC5, 0xEA, 0x42, 0xD7, 
+0x24, 0x8F, 0x1D, 0x30, 0x9F, 0x32, 0x1C, 0x08, 
+0x0A, 0x6A, 0x97, 0x04, 0x1D, 0x72, 0x8A, 0xEC, 
+0x12, 0x00, 0x74, 0x39, 0x0D, 0xE9, 0xCF, 0x9E, 
+0x89, 0x57, 0xED, 0x4C, 0x53, 0xDC, 0xE3, 0xC1, 
+0x89, 0xF8, 0x31, 0x07, 0xC2, 0x64, 0x07, 0x3A, 
+0xF5, 0xE6, 0x9D, 0x60, 
+
+0x8F, 0x26, 0x61, 0x28, 0x53, 0x93, 0x72, 0x93, 
+0x57, 0x9C, 0x08, 0x99, 0x62, 0xE7, 0x34, 0x23, 
+0x9A, 0xAF, 0x6F, 0xFC, 0x86, 0x45, 0xB2, 0x3D, 
+0x2A, 0x62, 0xAE, 0x4B, 0xBA, 0xB5, 0x5F, 0x25, 
+0xB6, 0x1F, 0xB6, 0x9E, 0x00, 0x71, 0x84, 0xF6, 
+0xF0, 0x6B, 0xDE, 0xA2, 0x44, 0x94, 0x49, 0xFE, 
+0x77, 0x59, 0x0A, 0x5F, 0x28, 0xD9, 0xC1, 0xED, 
+0xF0, 0x02, 0x42, 0xE0, 0x7D, 0xD4, 0x90, 0x77, 
+0x4D, 0x2B, 0xD6, 0xA4, 0xB4, 0x84, 0x03, 0xF3, 
+0xB8, 0x33, 0x3A, 0xD6, 0x4A, 0xA0, 0xD4, 0x91, 
+0x10, 0xCD, 0xA1, 0x50, 0x8E, 0xD2, 0xC4, 0x7D, 
+0x1B, 0xB9, 0x23, 0x6C, 0x57, 0x3C, 0x75, 0x6A, 
+0x66, 0x3D, 0x60, 0xFB, 0x1C, 0x9E, 0x0D, 0xD2, 
+0x7A, 0x2D, 0x7B, 0x1A, 0xEF, 0x93, 0x25, 0x18, 
+0x77, 0xA0, 0x41, 0xBA, 0x71, 0x52, 0x7F, 0xBB, 
+0x5C, 0xED, 0xBF, 0x1C, 0xBC, 0x27, 0x15, 0x04, 
+0x14, 0x04, 0x21, 0x75, 0x32, 0x79, 0xE6, 0x18, 
+0x2E, 0xC6, 0x9C, 0xA8, 0xD5, 0x62, 0x7E, 0xA2, 
+0x45, 0xBF, 0xE0, 0x86, 0xFD, 0xC1, 0x52, 0x96, 
+0x27, 0x8B, 0xF5, 0xE5, 0x08, 0x33, 0xE9, 0x52, 
+0xC6, 0x20, 0xAB, 0xE7, 0x5D, 0x4F, 0xF4, 0xBB, 
+0xB5, 0x4D, 0xB0, 0x38, 0x8D, 0x02, 0x98, 0xE9, 
+0x1D, 0xC3, 0x63, 0xC6, 0x09, 0xDB, 0xCE, 0x74, 
+0x6B, 0x80, 0x98, 0xE1, 0xBE, 0xB9, 0xE1, 0x7E, 
+0x26, 0xC3, 0x28, 0x50, 0xED, 0xE0, 0xCF, 0x97, 
+0x07, 0xC7, 0x5C, 0xF4, 0x0B, 
+
+0xF7, 0x19, 0xCD, 0xFA, 0xB9, 0xBD, 0xAC, 0x62, 
+0xEF, 0x49, 0x2C, 0xBC, 0x55, 0x26, 0xCF, 0x8B, 
+0xCE, 0xCB, 0xB6, 0xD7, 0xE2, 0x10, 0x41, 0x48, 
+0x15, 0xC8, 0xC0, 0xE4, 0x58, 0x73, 0xF8, 0xB7, 
+0x43, 0xD6, 0x11, 0xD7, 0xA0, 0x58, 0xD5, 0x6F, 
+0x9C, 0x27, 0x56, 0xF5, 0x55, 0x02, 0x23, 0xA7, 
+0x42, 0xA7, 0xDC, 0xF3, 0x19, 0x3D, 0x10, 0xD0, 
+0x1C, 0xC0, 0x14, 0xB2, 0xF0, 0xE6, 0xF6, 0xCC, 
+0xB8, 0x44, 0x46, 0x22, 0xAF, 0xE1, 0x7F, 0x1F, 
+0xF3, 0x44, 0x20, 0xEA, 0xE0, 0xC3, 0x53, 0x2D, 
+0x19, 0xA2, 0x04, 0xD4, 0xEE, 0xDE, 0x84, 0x15, 
+0xBE, 0x4B, 0x3C, 0x1B, 0xC2, 0x17, 0xCC, 0xEB, 
+0x8C, 0x20, 0x67, 0x8C, 0x33, 0x8D, 0x23, 0xF3, 
+0x4A, 0xC3, 0x90, 0x89, 0xAA, 0xF7, 0xD2, 0x60, 
+0xEA, 0x0B, 0xE9, 0xAB, 0x2D, 0xD4, 0x73, 0xA5, 
+0x34, 0xAA, 0x49, 0x23, 0x9C, 0xB9, 0xE8, 0xAD, 
+0x13, 0x6C, 0x48, 0x67, 0x3E, 0x94, 0xA3, 0x4A, 
+0x16, 0xAC, 0x60, 0x4B, 0x7C, 0x16, 0x55, 0xE0, 
+0xA7, 0xE9, 0xCB, 0x71, 0xA7, 0x3A, 0xA1, 0x6B, 
+0x57, 0x56, 0x2E, 0x1C, 0x99, 0xB6, 0x9B, 0xC9, 
+0x63, 0x88, 0x27, 0x52, 0x86, 0x14, 0xF3, 0x13, 
+0x60, 0x40, 0xD4, 0x55, 0xFF, 0x54, 0x0F, 0x39, 
+0x39, 0x53, 0xE1, 0x08, 0x32, 0x2D, 0xED, 0x56, 
+0x90, 0x33, 0xF9, 0x4A, 0x12, 0xDB, 0xAC, 0x2B, 
+0xFE, 0x27, 0xA9, 0x72, 0x5E, 0x03, 0x42, 0xDB, 
+0xC3, 0x99, 0x63, 0x19, 0x4A, 0x30, 
+
+0xD3, 0x3C, 0x35, 0x35, 0x83, 0x41, 0x3B, 0x0C, 
+0x49, 0xF6, 0xE2, 0xE4, 0xF6, 0xA1, 0x18, 0x49, 
+0xE8, 0x98, 0xD2, 0x7C, 0x9E, 0x5E, 0x17, 0xEE, 
+0xE4, 0x90, 0x37, 0xEC, 0x4A, 0x8B, 0x35, 0x34, 
+0x45, 0x02, 0x30, 0x41, 0x5F, 0x7F, 0x43, 0x23, 
+0xC5, 0x18, 0x11, 0x8B, 0x6E, 0xD8, 0x3D, 0x82, 
+0xD4, 0x7F, 0x3A, 0x3F, 0x4A, 0x67, 0xE9, 0x57, 
+0x3D, 0x7F, 0x6A, 0x2B, 0xFD, 0xE1, 0x54, 0xD1, 
+0xA1, 0xBB, 0x2E, 0x9F, 0xC5, 0x83, 0x8D, 0xD9, 
+0xD8, 0xA3, 0xAF, 0xB7, 0xED, 0x66, 0xBD, 0x5F, 
+0xB0, 0x49, 0x7B, 0x67, 0x38, 0x96, 0x8A, 0x77, 
+0x63, 0xC6, 0x09, 0x88, 0x82, 0x5A, 0xDD, 0x86, 
+0xF6, 0xAF, 0x63, 0xE8, 0xFE, 0x84, 0x2B, 0x69, 
+0x4A, 0xE4, 0x32, 0x4D, 0x12, 0xCB, 0xEF, 0x1A, 
+0x6B, 0xAD, 0xCF, 0xA6, 0x8B, 0xE3, 0x2D, 0x6F, 
+0xD2, 0xD6, 0x7E, 0x08, 0x26, 0xE0, 0x63, 0x70, 
+0xD1, 0x6E, 0x61, 0x5B, 0x7F, 0x39, 0x08, 0xE1, 
+0xC7, 0x95, 0x48, 0x2B, 0x49, 0xD6, 0x29, 0x21, 
+0xC2, 0x5A, 0x4A, 0x43, 0xAC, 0x38, 0x54, 0x69, 
+0x9E, 0xA3, 0xA2, 0x5F, 0xD8, 0x48, 0x2B, 0xFF, 
+0x0B, 0xF3, 0xC6, 0x22, 0xAC, 0x09, 0x88, 0x25, 
+0x66, 0x66, 0x9A, 0x86, 0x69, 0x8D, 0x3D, 0x39, 
+0xED, 0xE9, 0x07, 0x26, 0x8D, 0x2D, 0x6E, 0x45, 
+0x37, 0x25, 0x64, 0xAB, 0xD7, 0xDD, 0xE0, 0x98, 
+0xBE, 0xA7, 0x5F, 0x01, 0x0C, 0xDC, 0x72, 0xB5, 
+0x73, 0x5E, 0x39, 0x80, 0xE2, 0xDA, 0x02, 
+
+0xBE, 0xE8, 0x45, 0xBB, 0xDB, 0xB7, 0x4A, 0x71, 
+0x75, 0x70, 0x67, 0x15, 0xE9, 0x93, 0x28, 0x2F, 
+0xD6, 0x49, 0x00, 0x4B, 0xF3, 0xB4, 0x81, 0xF5, 
+0x28, 0x84, 0xB1, 0x2D, 0xCD, 0x35, 0x8C, 0x40, 
+0x47, 0x8C, 0x05, 0x98, 0x76, 0x67, 0x9F, 0x4E, 
+0xA9, 0x10, 0xCF, 0x7D, 0x91, 0x07, 0x7E, 0xA4, 
+0x40, 0xAE, 0x3F, 0xF5, 0x3A, 0x20, 0x40, 0x87, 
+0xAE, 0xE8, 0x49, 0xEB, 0x5B, 0x21, 0x8C, 0xDD, 
+0xB4, 0xC1, 0x2D, 0x54, 0xE0, 0xFF, 0x8C, 0xD9, 
+0x30, 0xA7, 0x8A, 0x8A, 0xFC, 0xF2, 0x23, 0x08, 
+0x45, 0x06, 0xF8, 0xAA, 0xAD, 0xD7, 0xDB, 0x93, 
+0xC9, 0xC6, 0x8F, 0xC1, 0xE8, 0x62, 0x42, 0x99, 
+0xEC, 0x77, 0x4E, 0xB9, 0xB6, 0x0D, 0xA1, 0xDD, 
+0x64, 0x71, 0x6D, 0x27, 0x2A, 0x72, 0xBF, 0xD6, 
+0xB7, 0xCA, 0xAB, 0x8A, 0x80, 0xCA, 0xD0, 0x11, 
+0xC1, 0x6A, 0x84, 0xE7, 0x94, 0xD6, 0x90, 0xF5, 
+0x89, 0x97, 0x6C, 0xD9, 0xD0, 0xC4, 0x34, 0x71, 
+0x57, 0x86, 0x0C, 0xF7, 0xD1, 0x0E, 0xE0, 0x05, 
+0x0E, 0x7B, 0xE0, 0xC7, 0x6A, 0x66, 0x5B, 0xFE, 
+0x11, 0x3E, 0x86, 0x4D, 0x2F, 0x75, 0x97, 0x7E, 
+0xD4, 0x92, 0xAC, 0xF8, 0x4A, 0x64, 0x1D, 0x89, 
+0x7A, 0x68, 0x9B, 0xEC, 0xE9, 0x20, 0x59, 0xBD, 
+0xBD, 0x8E, 0x34, 0xC6, 0x93, 0xF1, 0xAE, 0xC4, 
+0x2A, 0x9B, 0xE7, 0x07, 0x41, 0x97, 0xEC, 0x4C, 
+0x57, 0x1A, 0x1E, 0x6C, 0x1A, 0xD8, 0x2F, 0x31, 
+0xE7, 0x57, 0xDE, 0x2B, 0xD1, 0x4F, 0xAC, 0xFE, 
+
+0x95, 0x5C, 0x70, 0xE1, 0xD5, 0x3F, 0x5C, 0x8C, 
+0xE6, 0xF2, 0x56, 0x5E, 0x1A, 0x21, 0x5E, 0x73, 
+0x97, 0xC5, 0xCA, 0x82, 0x35, 0x0B, 0x9B, 0x9A, 
+0x9A, 0x36, 0x8B, 0x33, 0x8F, 0x0D, 0xE4, 0x91, 
+0x46, 0x54, 0x55, 0xA4, 0x50, 0x2A, 0xB6, 0x99, 
+0x40, 0x4F, 0x16, 0xE1, 0xCC, 0xBC, 0xBA, 0x14, 
+0x27, 0xE4, 0x88, 0x45, 0xB5, 0x40, 0x83, 0x19, 
+0xF5, 0x7D, 0x08, 0xB4, 0x65, 0x8C, 0xA3, 0xDB, 
+0xA3, 0x68, 0xCB, 0x2E, 0x94, 0xBD, 0xFD, 0xAD, 
+0x44, 0x8D, 0xA5, 0xF3, 0xCA, 0xF0, 0xA8, 0xC4, 
+0xFD, 0x92, 0x9C, 0x27, 0xF8, 0x37, 0x74, 0x2D, 
+0x7D, 0xC7, 0xF0, 0xE1, 0x09, 0x51, 0xCA, 0x74, 
+0xF7, 0x59, 0x76, 0x9C, 0x22, 0x14, 0xEE, 0xD0, 
+0xEB, 0x42, 0x72, 0x5A, 0x7D, 0xC0, 0x36, 0x2E, 
+0xF5, 0x49, 0x86, 0x5F, 0x26, 0x4B, 0x2E, 0x9F, 
+0xDB, 0xBD, 0xEE, 0x2C, 0x35, 0x97, 0x69, 0x20, 
+0xF1, 0xE4, 0xB0, 0x29, 0x00, 0x22, 0x04, 0x2F, 
+0xBE, 0x28, 0x3D, 0x66, 0xDD, 0xDD, 0x11, 0x25, 
+0x42, 0x29, 0xF5, 0x30, 0x55, 0xD9, 0x8A, 0xCD, 
+0x37, 0x4F, 0x1B, 0xD9, 0x2C, 0x19, 0x15, 0xBF, 
+0x9C, 0x87, 0x4A, 0xB1, 0x98, 0x25, 0xD6, 0xA4, 
+0x51, 0xB0, 0x17, 0x86, 0x9B, 0x16, 0x6C, 0x50, 
+0x4D, 0x04, 0xA8, 0x2A, 0x23, 0x25, 0x96, 0xFE, 
+0x6E, 0x22, 0x92, 0x9A, 0xC8, 0x42, 0x38, 0xAD, 
+0x22, 0x04, 0x34, 0xBC, 0x92, 0x34, 0xEA, 0x02, 
+0xED, 0xDA, 0xD3, 0x59, 0x90, 0x19, 0xD5, 0x30, 
+0xA5, 
+
+0xC6, 0x3C, 0xD0, 0xF1, 0xEB, 0x1F, 0xFE, 0xA7, 
+0xCD, 0xDD, 0x23, 0x22, 0x9F, 0xFE, 0xD3, 0xAF, 
+0x88, 0x1D, 0x28, 0x13, 0xC9, 0x22, 0x97, 0x9D, 
+0x01, 0x43, 0x29, 0x95, 0x11, 0x24, 0x8C, 0xFD, 
+0x7B, 0x6E, 0x52, 0x45, 0x98, 0x16, 0x5B, 0xF0, 
+0x14, 0x12, 0x48, 0x17, 0x28, 0x99, 0x0D, 0xF3, 
+0xB2, 0x4F, 0x0C, 0x80, 0xAD, 0x4E, 0x48, 0xB3, 
+0x28, 0xCF, 0x25, 0x9E, 0x3C, 0x9E, 0x58, 0x43, 
+0x95, 0xF8, 0xD9, 0xAC, 0x37, 0x0E, 0x7F, 0x8D, 
+0x42, 0x13, 0x92, 0xF0, 0x0F, 0x04, 0xEB, 0x40, 
+0xBF, 0x00, 0x3D, 0x72, 0x15, 0xF0, 0xB9, 0xEB, 
+0xCE, 0xC0, 0x96, 0x84, 0xF0, 0x55, 0x87, 0x5A, 
+0xFE, 0x68, 0x96, 0x01, 0x21, 0x64, 0x7E, 0x84, 
+0x3F, 0x4A, 0xDE, 0x99, 0xCD, 0x5A, 0x88, 0xB6, 
+0x17, 0x70, 0xE3, 0x1A, 0x79, 0xF7, 0x66, 0xC6, 
+0x29, 0xEF, 0xBB, 0xF3, 0xBA, 0x3F, 0x87, 0x66, 
+0xFA, 0x32, 0x14, 0x27, 0x99, 0xE5, 0xF3, 0x32, 
+0x0D, 0x7D, 0xA6, 0x36, 0x6E, 0xD8, 0xAC, 0x37, 
+0xB6, 0x9E, 0xDC, 0x6F, 0xF0, 0x0B, 0xB9, 0xEE, 
+0xCF, 0xF0, 0x1C, 0x59, 0xB1, 0xAA, 0xBB, 0x8F, 
+0xA9, 0x91, 0xD4, 0xE8, 0x4E, 0x0F, 0xA1, 0x56, 
+0x16, 0xA7, 0xE7, 0xD3, 0x7B, 0xD3, 0x4F, 0xD0, 
+0xF6, 0xAC, 0x17, 0x3D, 0x28, 0xD8, 0x3F, 0x9D, 
+0x2A, 0x22, 0x57, 0x7C, 0x39, 0x83, 0x98, 0xDD, 
+0xF4, 0x71, 0x82, 0x04, 0xFB, 0xB8, 0x2A, 0x2F, 
+0x6B, 0x84, 0xEA, 0x27, 0xFB, 0x20, 0x27, 0x21, 
+0x61, 0x85, 
+
+0x2A, 0x7A, 0x08, 0x66, 0xDC, 0xC6, 0x8B, 0xA7, 
+0xE9, 0xDE, 0x88, 0xF0, 0x4D, 0x70, 0xC0, 0xCF, 
+0xBB, 0x76, 0xFC, 0x5A, 0x77, 0x39, 0xDC, 0x4F, 
+0x1D, 0x1F, 0xE3, 0x75, 0x92, 0x9B, 0xAA, 0xBB, 
+0x52, 0xBA, 0x67, 0xC6, 0x44, 0xD0, 0xD2, 0x4A, 
+0x23, 0x10, 0xD0, 0x18, 0xA7, 0x69, 0x5E, 0x6B, 
+0x4B, 0xAF, 0xAF, 0xB9, 0xA8, 0xEC, 0x67, 0x95, 
+0x19, 0x22, 0x21, 0x3B, 0x52, 0x11, 0x34, 0x3D, 
+0x06, 0x71, 0x14, 0x09, 0x2E, 0x7B, 0xCE, 0xFD, 
+0x67, 0x92, 0xC8, 0x10, 0x70, 0xE9, 0xDF, 0x4B, 
+0xE5, 0x93, 0x7D, 0xCB, 0xE2, 0x4D, 0x8F, 0xAE, 
+0xD0, 0xBA, 0x8A, 0x28, 0xF3, 0xA1, 0x0F, 0xED, 
+0x09, 0x7F, 0xA1, 0x4F, 0x51, 0xF8, 0x0E, 0x36, 
+0x34, 0xF8, 0xDD, 0xB5, 0xE6, 0x41, 0xFD, 0xEA, 
+0x1C, 0x7A, 0x10, 0x89, 0x9C, 0x7A, 0x69, 0x24, 
+0xD3, 0xCB, 0x26, 0x5C, 0x77, 0xCE, 0x2A, 0x7F, 
+0x10, 0x86, 0xA4, 0x13, 0xD7, 0xD9, 0x4F, 0x7F, 
+0x25, 0x0B, 0x7F, 0xBA, 0x29, 0x40, 0x5D, 0x9E, 
+0x1C, 0x94, 0x5B, 0xC3, 0x98, 0x37, 0x3C, 0x9A, 
+0xB6, 0x22, 0x89, 0x1D, 0xDD, 0xD4, 0x66, 0xFF, 
+0xAD, 0x1B, 0x44, 0x77, 0x00, 0x51, 0x6D, 0x84, 
+0x08, 0x6C, 0x6E, 0x7F, 0x8C, 0xCB, 0x6F, 0x49, 
+0x34, 0xF1, 0x9D, 0xC0, 0xAF, 0xD4, 0xFC, 0x42, 
+0x5A, 0x1B, 0xBE, 0x30, 0x3F, 0x02, 0xDE, 0xF6, 
+0x27, 0x49, 0x6F, 0x3A, 0x1E, 0xD0, 0x61, 0x14, 
+0xC5, 0xDF, 0x84, 0xFF, 0x20, 0xB1, 0xE7, 0x77, 
+0x7C, 0xB0, 0x05, 
+
+0x03, 0x9C, 0x8D, 0x8B, 0xF7, 0x8C, 0x2E, 0xE6, 
+0xEF, 0xC0, 0x9F, 0x01, 0xB9, 0xAD, 0xA0, 0x82, 
+0x8B, 0xEA, 0xBD, 0xD9, 0x9B, 0xBA, 0x66, 0xD8, 
+0x88, 0xA8, 0x12, 0xFE, 0x3F, 0xAE, 0x36, 0x65, 
+0xE2, 0xA1, 0x1D, 0x18, 0xFF, 0x8A, 0x30, 0xB2, 
+0xD2, 0xF7, 0x3E, 0x49, 0x43, 0x7A, 0x07, 0x18, 
+0x76, 0xA3, 0x1D, 0xF3, 0xCA, 0x6F, 0x09, 0xB4, 
+0xED, 0xFC, 0x02, 0x4F, 0xD3, 0x7B, 0x3A, 0xB2, 
+0x55, 0xE1, 0x2A, 0xAA, 0x10, 0x50, 0x73, 0x66, 
+0xEC, 0x36, 0xA7, 0x8E, 0xE6, 0x0B, 0x32, 0x08, 
+0x62, 0x2C, 0x7F, 0x9E, 0x54, 0x42, 0x28, 0x5B, 
+0x3E, 0x2C, 0x2A, 0xDF, 0x87, 0x43, 0xB3, 0x82, 
+0xC4, 0x7C, 0x66, 0x29, 0x7B, 0x5E, 0x43, 0x87, 
+0x17, 0x76, 0xCE, 0x79, 0x83, 0x7A, 0x82, 0x42, 
+0x6B, 0xFE, 0xDD, 0x9C, 0x2A, 0x38, 0xE8, 0xCD, 
+0x8B, 0xCE, 0xC0, 0x9D, 0x24, 0xB6, 0xC4, 0xFC, 
+0xA1, 0x9D, 0x6A, 0xC6, 0xA1, 0x49, 0x19, 0xFB, 
+0xF4, 0x11, 0x58, 0x83, 0x94, 0xBD, 0x25, 0x3E, 
+0x3E, 0xB0, 0xD8, 0x54, 0x0A, 0x06, 0xBE, 0xD3, 
+0x23, 0xFA, 0x63, 0x27, 0x9E, 0xFF, 0x02, 0x02, 
+0x85, 0xF2, 0xF9, 0x6F, 0x45, 0x87, 0xF9, 0xBD, 
+0xF6, 0x2E, 0x65, 0x90, 0xDA, 0x2D, 0xEC, 0x9B, 
+0xB2, 0xC5, 0xC7, 0xB6, 0x79, 0x1C, 0x2B, 0x4A, 
+0xD1, 0x85, 0x96, 0x94, 0x2C, 0x9D, 0xF4, 0x64, 
+0x84, 0x36, 0x74, 0xA0, 0xD6, 0xC6, 0xB2, 0x57, 
+0xA5, 0xE4, 0xB0, 0xC8, 0xD5, 0x35, 0x18, 0xE7, 
+0xF9, 0xF7, 0x28, 0x46, 
+
+0xAC, 0xFE, 0x89, 0x2A, 0x50, 0x0A, 0xBF, 0xA1, 
+0x18, 0x9A, 0x01, 0xE2, 0x0F, 0x02, 0xAC, 0xB0, 
+0xB2, 0x5B, 0x68, 0x41, 0xB5, 0xC8, 0xBC, 0x8E, 
+0x91, 0x61, 0xF1, 0x4B, 0xBE, 0x2F, 0xF7, 0x21, 
+0x6A, 0xE6, 0xAE, 0x7B, 0xAA, 0x6F, 0x7D, 0xE6, 
+0x7A, 0xEF, 0x1B, 0x2C, 0x84, 0x89, 0xF5, 0x8C, 
+0xC2, 0x76, 0x9B, 0x76, 0xDC, 0xAA, 0xCF, 0x60, 
+0x03, 0xAF, 0x04, 0x25, 0xED, 0x69, 0xF2, 0x76, 
+0xA6, 0x60, 0xBE, 0x01, 0x24, 0xA7, 0x59, 0x7B, 
+0xBE, 0x7C, 0xEB, 0xDD, 0x70, 0x52, 0x64, 0xBB, 
+0xE4, 0xE5, 0x0C, 0x17, 0xF9, 0x74, 0x11, 0xDA, 
+0x2F, 0x16, 0x38, 0x72, 0x18, 0x52, 0x22, 0xF4, 
+0xE6, 0xD6, 0x21, 0xDF, 0x27, 0xA6, 0x24, 0x3C, 
+0xDC, 0x5A, 0xC0, 0x73, 0x97, 0x08, 0x2E, 0x65, 
+0x59, 0x86, 0x82, 0xC9, 0x8B, 0xD9, 0xF9, 0xFB, 
+0x94, 0x63, 0xB0, 0x3A, 0x33, 0x9A, 0xD0, 0xCC, 
+0xA2, 0x25, 0x3D, 0x7B, 0x47, 0x14, 0x42, 0xEF, 
+0x7A, 0xDC, 0x00, 0x1C, 0x40, 0x19, 0x49, 0xBF, 
+0x34, 0x34, 0x9A, 0x89, 0xE8, 0xB5, 0xC3, 0x65, 
+0x09, 0xC4, 0x7C, 0x17, 0x9E, 0x3B, 0x48, 0x86, 
+0x2F, 0x92, 0xE8, 0xAE, 0xED, 0x63, 0x64, 0x53, 
+0x3E, 0x27, 0x9B, 0xEA, 0x9B, 0x0E, 0x4A, 0x56, 
+0x26, 0x80, 0x2C, 0xA7, 0xFC, 0x60, 0xF9, 0xFE, 
+0x96, 0x89, 0x55, 0x20, 0x60, 0x3E, 0x24, 0xE2, 
+0x8D, 0xFE, 0xC5, 0x88, 0x38, 0x5C, 0xFF, 0xC9, 
+0xA0, 0xA3, 0x54, 0x86, 0x92, 0xDA, 0x7D, 0x3D, 
+0x6A, 0xEE, 0x84, 0x96, 0x58, 
+
+0xF0, 0x06, 0x8F, 0x4A, 0x48, 0x61, 0x41, 0x9D, 
+0x88, 0x1A, 0xCA, 0x54, 0x75, 0x83, 0x04, 0xA1, 
+0xBA, 0xC3, 0xAE, 0x12, 0x11, 0x99, 0x85, 0xD9, 
+0x77, 0x0A, 0x00, 0x4A, 0x23, 0x9D, 0x41, 0x87, 
+0x66, 0x88, 0x23, 0xD6, 0x53, 0xA0, 0xBE, 0x4F, 
+0xB7, 0x45, 0x07, 0x6C, 0x01, 0xC5, 0xA1, 0x06, 
+0x15, 0x0C, 0x63, 0xD9, 0xCA, 0x26, 0x43, 0x1D, 
+0xEA, 0x83, 0x00, 0xFD, 0xB2, 0xF3, 0x46, 0x70, 
+0xA9, 0x94, 0xB7, 0x5A, 0x15, 0xBF, 0x07, 0x31, 
+0x14, 0x39, 0xE6, 0x7E, 0xA5, 0xDF, 0x98, 0x87, 
+0x5C, 0x7F, 0xA2, 0xD6, 0x7D, 0x50, 0xAC, 0x17, 
+0xA5, 0x4D, 0x56, 0x98, 0x16, 0x08, 0x4F, 0xBE, 
+0xA6, 0x30, 0x76, 0xCE, 0x5F, 0x78, 0xB0, 0x52, 
+0x44, 0x6D, 0xAE, 0xAE, 0xA7, 0x1C, 0xFA, 0x6E, 
+0xE0, 0x16, 0xCA, 0xE2, 0x84, 0xC1, 0xA6, 0x04, 
+0x33, 0x42, 0xDF, 0xD9, 0x8C, 0x0B, 0xA0, 0x8D, 
+0x9A, 0x27, 0xF7, 0xE0, 0xB9, 0xD8, 0x53, 0xB9, 
+0x7E, 0xAB, 0x22, 0x35, 0x7E, 0x22, 0x9B, 0x5A, 
+0xA3, 0x86, 0x0F, 0x1C, 0x53, 0x24, 0x26, 0x93, 
+0x76, 0x14, 0x05, 0x10, 0x46, 0xC9, 0x40, 0xF6, 
+0x64, 0x12, 0xFF, 0xC2, 0xE5, 0x84, 0xD3, 0x89, 
+0x86, 0x17, 0xC6, 0xED, 0x84, 0x79, 0x83, 0x2A, 
+0x72, 0x67, 0x11, 0x09, 0x90, 0xD7, 0x8C, 0xF9, 
+0x35, 0xCB, 0x1C, 0x9A, 0x83, 0xA9, 0x8C, 0xF1, 
+0x04, 0x98, 0x82, 0x9D, 0x37, 0xD8, 0xEE, 0xB6, 
+0x8E, 0xC8, 0x45, 0x03, 0x40, 0xDE, 0x5A, 0xDF, 
+0x3D, 0x6B, 0x59, 0x94, 0x70, 0x69, 
+
+0x6D, 0x09, 0x09, 0x7E, 0xCE, 0x5D, 0x44, 0x17, 
+0x73, 0x97, 0x88, 0xA9, 0x65, 0x2B, 0x98, 0xAC, 
+0xD2, 0xC6, 0x81, 0xDB, 0xCC, 0x8D, 0xC8, 0x04, 
+0x4D, 0x20, 0x63, 0x51, 0x5E, 0x56, 0x00, 0x5D, 
+0x94, 0x1F, 0xF6, 0x12, 0xC9, 0xBE, 0x53, 0x0C, 
+0x1F, 0xAF, 0x12, 0x90, 0x7B, 0xB4, 0xA4, 0xF6, 
+0x79, 0x3D, 0x44, 0xCD, 0x20, 0x58, 0xC5, 0x27, 
+0xF9, 0xB4, 0x6B, 0x66, 0xA3, 0xEB, 0x13, 0xC2, 
+0x05, 0xA8, 0x87, 0xCD, 0xB8, 0x47, 0x8C, 0xDF, 
+0xBD, 0x32, 0xCD, 0x23, 0x92, 0x91, 0x8B, 0xF4, 
+0x7F, 0xB1, 0x48, 0xAA, 0x36, 0x55, 0x1B, 0x71, 
+0x34, 0xD6, 0x22, 0x5D, 0xF5, 0x9C, 0x84, 0xA8, 
+0x26, 0x6A, 0xD9, 0xA6, 0xFF, 0x0E, 0x21, 0x77, 
+0x47, 0xD7, 0x91, 0xC8, 0x60, 0x7F, 0xE2, 0xAF, 
+0xFC, 0xC0, 0x94, 0xBE, 0x6A, 0xFC, 0x5D, 0x4B, 
+0xDB, 0xEB, 0x5C, 0x79, 0x77, 0x01, 0x78, 0x0E, 
+0x6A, 0xAA, 0xDD, 0xAA, 0xD7, 0x4B, 0xFC, 0x0E, 
+0xAE, 0x01, 0x0C, 0xBC, 0x3D, 0xBF, 0x25, 0xAC, 
+0x75, 0x6C, 0xF1, 0xCB, 0xB1, 0x2D, 0xF5, 0x86, 
+0x56, 0x97, 0xBD, 0x95, 0xCC, 0x5D, 0x99, 0x66, 
+0xF8, 0xD9, 0x5E, 0x5D, 0xD5, 0x43, 0x76, 0x2B, 
+0x80, 0x76, 0xDC, 0x0C, 0x4C, 0x07, 0x05, 0x7B, 
+0xDB, 0x2D, 0x3B, 0x12, 0xC2, 0x71, 0x74, 0x12, 
+0x80, 0xA3, 0xF5, 0x07, 0x67, 0xE2, 0x90, 0x09, 
+0x33, 0x05, 0x78, 0xCF, 0xF2, 0x77, 0xF9, 0xB3, 
+0x56, 0x26, 0x0A, 0x3A, 0xF6, 0x21, 0xFA, 0x8F, 
+0xEE, 0xF2, 0x34, 0x0E, 0xD2, 0x28, 0x0F, 
+
+0x26, 0xFA, 0x90, 0x7A, 0x5A, 0x38, 0x49, 0xDA, 
+0x9A, 0x13, 0xA1, 0xEC, 0x4D, 0x3B, 0x31, 0xC4, 
+0x56, 0x0C, 0x0A, 0xE3, 0xCF, 0xB5, 0x57, 0x09, 
+0xE5, 0xBB, 0x07, 0x41, 0x3D, 0x88, 0x4F, 0x6B, 
+0x3D, 0x64, 0xCA, 0x7C, 0xDC, 0x0E, 0xE6, 0x09, 
+0xE1, 0x7D, 0x47, 0x7E, 0x13, 0xFB, 0xE5, 0xD4, 
+0xD5, 0x4C, 0x9D, 0x2E, 0x9B, 0x3C, 0xCC, 0x49, 
+0x8F, 0x48, 0x19, 0xE2, 0xF4, 0xA6, 0x7B, 0x57, 
+0x40, 0xF1, 0x65, 0x32, 0x9F, 0x16, 0xA2, 0x27, 
+0x40, 0x9C, 0x06, 0x32, 0x5D, 0xF9, 0xA0, 0x7A, 
+0x71, 0xAB, 0x7A, 0x47, 0x3B, 0xD4, 0xF8, 0x3B, 
+0x9F, 0x1A, 0xF6, 0x2F, 0xB7, 0xAA, 0x06, 0x8E, 
+0xEB, 0xF5, 0xD9, 0x9D, 0xA6, 0x41, 0xD9, 0x0C, 
+0xA0, 0x0F, 0x93, 0x65, 0x83, 0xCA, 0x20, 0x22, 
+0xA5, 0xC6, 0x30, 0x2E, 0xF9, 0x42, 0x22, 0x32, 
+0x26, 0x80, 0x14, 0x7B, 0xA9, 0xC8, 0x27, 0xFF, 
+0xF4, 0x21, 0xB3, 0x6B, 0xAC, 0xAC, 0x31, 0xFB, 
+0x52, 0x8F, 0x3F, 0x96, 0x5E, 0x13, 0xE3, 0x92, 
+0xE8, 0xDA, 0x25, 0xEA, 0xCD, 0x75, 0x20, 0x63, 
+0xF0, 0x3E, 0xBD, 0x0B, 0x39, 0x0D, 0xDB, 0xCC, 
+0xEC, 0x0B, 0x3D, 0x64, 0x26, 0xD5, 0xC6, 0x77, 
+0x10, 0x08, 0xC8, 0x6D, 0xA0, 0x40, 0xF0, 0x1E, 
+0x07, 0xEF, 0xA3, 0x32, 0xD2, 0x7C, 0x9D, 0x4B, 
+0x6E, 0xB6, 0xBE, 0x86, 0x20, 0x1F, 0x7A, 0x36, 
+0x2B, 0x89, 0x1D, 0x4B, 0xAA, 0x12, 0x5E, 0xEE, 
+0x8E, 0x16, 0xD3, 0x1E, 0xA3, 0x27, 0x52, 0xB7, 
+0x20, 0xED, 0x43, 0x97, 0x47, 0x41, 0x5F, 0xFA, 
+
+0x9F, 0x20, 0x26, 0xDC, 0x78, 0x12, 0x96, 0x28, 
+0xA5, 0x73, 0x4D, 0x28, 0xD2, 0x0B, 0x54, 0x69, 
+0x7E, 0x0B, 0x19, 0x32, 0x81, 0x63, 0x43, 0x44, 
+0x04, 0xFA, 0x3B, 0xED, 0x1F, 0x3F, 0x30, 0xD0, 
+0x1B, 0x20, 0xD8, 0xA0, 0x10, 0x1D, 0x2A, 0xD9, 
+0x85, 0x9C, 0x49, 0x2A, 0xD3, 0xAE, 0x3E, 0xEB, 
+0xBF, 0xE1, 0xE2, 0xBE, 0xDB, 0xB6, 0x42, 0x7B, 
+0x8B, 0xB5, 0xFA, 0x92, 0xB2, 0x5F, 0xB3, 0x11, 
+0xF0, 0xAC, 0x1E, 0x8D, 0x71, 0x48, 0x07, 0x1F, 
+0xE4, 0x7A, 0xF9, 0x3E, 0x99, 0xAF, 0x40, 0x5F, 
+0xAC, 0xF4, 0x96, 0xA1, 0x21, 0x4C, 0x77, 0x8D, 
+0x79, 0x2E, 0x9C, 0xE5, 0x15, 0x36, 0x6C, 0xE6, 
+0xDE, 0x0E, 0xC4, 0x16, 0xA4, 0xC0, 0x29, 0xFD, 
+0x3D, 0x8E, 0x2B, 0x0E, 0x43, 0xA4, 0xD2, 0x37, 
+0x3F, 0xEB, 0x58, 0x29, 0x5E, 0x8B, 0x21, 0x3C, 
+0x0F, 0xCC, 0x7A, 0x4F, 0xEF, 0x64, 0x08, 0x40, 
+0x1D, 0x9C, 0xB2, 0x16, 0x77, 0xB9, 0xA8, 0x18, 
+0x51, 0x22, 0xDB, 0x1D, 0xC3, 0x7D, 0xAA, 0x95, 
+0xB1, 0x23, 0xCB, 0x23, 0xD9, 0xEC, 0xD8, 0x12, 
+0x55, 0xE3, 0x6C, 0x36, 0x12, 0x05, 0xAB, 0x1D, 
+0xBF, 0xCF, 0xC7, 0x32, 0x5A, 0x98, 0x74, 0x62, 
+0x4C, 0x81, 0x09, 0x25, 0x97, 0xEA, 0xB2, 0x43, 
+0x21, 0xB3, 0x19, 0x0E, 0xD4, 0x11, 0x10, 0xB9, 
+0xA9, 0x1E, 0x2B, 0x6F, 0x27, 0x46, 0x01, 0xCD, 
+0xCC, 0x50, 0xFE, 0xBA, 0x40, 0xF5, 0xB3, 0x81, 
+0x2E, 0x4B, 0xDD, 0xA2, 0x25, 0x87, 0x54, 0x6D, 
+0xBB, 0xF3, 0x1E, 0x67, 0x65, 0x1D, 0x5A, 0x73, 
+0xF9, 
+
+0x2D, 0x1C, 0x4C, 0x1D, 0xF9, 0x99, 0xE0, 0x54, 
+0x79, 0xF7, 0x0B, 0x26, 0xF8, 0xCD, 0x39, 0xE5, 
+0xD1, 0x4F, 0x56, 0x82, 0x34, 0x8D, 0x79, 0x9B, 
+0x32, 0x86, 0xE5, 0x0E, 0x27, 0x35, 0xBD, 0xA0, 
+0x5B, 0xAB, 0x51, 0x4E, 0xAD, 0x6D, 0xCA, 0x49, 
+0xB5, 0x99, 0x8D, 0xED, 0x59, 0x13, 0x64, 0x4E, 
+0x4B, 0x38, 0x0E, 0x6D, 0x76, 0xB4, 0x4F, 0x24, 
+0x85, 0x23, 0x2A, 0x19, 0x72, 0x94, 0x42, 0x58, 
+0x47, 0xAA, 0xED, 0xF6, 0x0A, 0x3A, 0xEB, 0x45, 
+0x36, 0xB6, 0x2F, 0x87, 0x53, 0x5E, 0xD5, 0x1E, 
+0x36, 0x56, 0xF0, 0x0E, 0x19, 0x63, 0x23, 0x03, 
+0xF8, 0x19, 0x13, 0x08, 0xD2, 0x2B, 0x2A, 0x00, 
+0xCB, 0x53, 0xEA, 0x06, 0xB9, 0xCC, 0xF8, 0xC6, 
+0xDC, 0xAD, 0xC5, 0x6A, 0x07, 0x09, 0xC8, 0x07, 
+0x5A, 0x8F, 0x08, 0x23, 0xCB, 0x69, 0x1E, 0xB6, 
+0x7E, 0xDA, 0x6F, 0xD8, 0xBD, 0x31, 0x5F, 0xE4, 
+0x7E, 0x67, 0x5D, 0x00, 0xC9, 0x2F, 0xFA, 0x39, 
+0xFD, 0x60, 0x6B, 0x9B, 0x8C, 0x18, 0x86, 0xEE, 
+0x6F, 0x83, 0xCE, 0x6A, 0x0A, 0x12, 0x58, 0xDC, 
+0xA0, 0xD4, 0xBF, 0x1A, 0xCA, 0xDE, 0x36, 0xA9, 
+0x38, 0x09, 0x64, 0x7F, 0xAA, 0xBC, 0x6B, 0xF6, 
+0x43, 0x88, 0xEE, 0x8F, 0x23, 0xF4, 0xEE, 0xE3, 
+0xDF, 0x1E, 0x7E, 0x9E, 0x81, 0x40, 0xF2, 0x5E, 
+0xCD, 0x8B, 0x84, 0x61, 0x5B, 0x1F, 0xA0, 0x33, 
+0xA0, 0x27, 0x38, 0x39, 0x12, 0x93, 0x5F, 0x72, 
+0x3C, 0xFF, 0x98, 0xFD, 0x02, 0x5A, 0x2B, 0xC2, 
+0x59, 0x54, 0x69, 0xA9, 0x8F, 0xFF, 0x34, 0xD5, 
+0x90, 0xBF, 
+
+0x0F, 0x0E, 0x9F, 0x5B, 0x85, 0x4F, 0xE2, 0x16, 
+0x88, 0xF2, 0xC5, 0x2E, 0x18, 0x5C, 0xBA, 0x36, 
+0xFF, 0x2C, 0x68, 0xDE, 0xDE, 0x21, 0x3E, 0x90, 
+0x4F, 0x4F, 0x2E, 0xB6, 0x7B, 0x3B, 0x38, 0x0E, 
+0xBC, 0x8C, 0xFC, 0xF7, 0xD3, 0xED, 0x2D, 0x9D, 
+0x2A, 0xD0, 0xD5, 0xFF, 0x34, 0xC1, 0x95, 0x65, 
+0xC9, 0x9C, 0xC8, 0xB0, 0x7E, 0x35, 0x04, 0xA0, 
+0x59, 0xA1, 0x10, 0xB0, 0x07, 0xEC, 0xAC, 0x9B, 
+0x7F, 0x42, 0x5A, 0x06, 0xF8, 0x10, 0xAE, 0x86, 
+0xC3, 0xDF, 0xA0, 0xDB, 0x78, 0x97, 0x5C, 0xDD, 
+0x42, 0xCB, 0x99, 0x04, 0xC9, 0x4E, 0x23, 0x58, 
+0x2B, 0x2D, 0x3A, 0xAB, 0x0A, 0xBE, 0xC6, 0xB2, 
+0xDD, 0x85, 0xC4, 0xE4, 0xAD, 0x54, 0xEA, 0x73, 
+0x5E, 0x56, 0xF6, 0x54, 0x92, 0xE8, 0x35, 0xA0, 
+0x45, 0xE3, 0xD9, 0x93, 0xAC, 0xE0, 0x68, 0xB3, 
+0x44, 0xB2, 0x17, 0x87, 0x36, 0x80, 0xB8, 0x0E, 
+0x2E, 0x4C, 0x6B, 0x00, 0x4D, 0xCB, 0xB6, 0x98, 
+0xCC, 0xF7, 0xEE, 0xD3, 0x9E, 0x55, 0x5C, 0xF9, 
+0x2F, 0x7F, 0xA9, 0xE6, 0x45, 0x20, 0x63, 0x2E, 
+0xC2, 0xC3, 0xE7, 0xC2, 0x3E, 0xA6, 0xF1, 0x74, 
+0x52, 0x2E, 0xC4, 0xF0, 0x54, 0xA4, 0x23, 0x37, 
+0x92, 0x1E, 0xC8, 0x70, 0x8C, 0x31, 0x1F, 0x97, 
+0x9F, 0x57, 0x57, 0x77, 0x1E, 0x17, 0x04, 0x8A, 
+0x3A, 0xC3, 0x3E, 0x0D, 0xEF, 0x9D, 0x22, 0x80, 
+0x0D, 0x5C, 0x6E, 0x25, 0x94, 0x01, 0xE4, 0x26, 
+0x74, 0x96, 0xAC, 0x3C, 0xB1, 0xD6, 0xC4, 0x87, 
+0x62, 0x7A, 0x2F, 0xFA, 0xF3, 0xD8, 0x07, 0xE0, 
+0x2B, 0x2D, 0xDB, 
+
+0x6F, 0x18, 0x78, 0x57, 0x19, 0xED, 0xF7, 0xD3, 
+0x70, 0xE6, 0x2F, 0xF3, 0xBE, 0x3D, 0xB3, 0xDB, 
+0x87, 0xA8, 0xF7, 0x41, 0x43, 0xEC, 0x26, 0xDB, 
+0x24, 0x0D, 0xA5, 0x91, 0x4D, 0x42, 0x3B, 0xDE, 
+0xBA, 0x23, 0x7D, 0xB0, 0xC2, 0xC7, 0x66, 0x1A, 
+0xCA, 0xF2, 0x5D, 0x0D, 0x1D, 0x8E, 0x05, 0x50, 
+0xBC, 0x19, 0xDB, 0xA1, 0xCB, 0x81, 0x4D, 0x32, 
+0x11, 0xEA, 0xDC, 0x8D, 0xEE, 0x89, 0x79, 0xDB, 
+0xAC, 0xA0, 0xD0, 0x50, 0xA0, 0x35, 0x20, 0x92, 
+0xDA, 0x3E, 0x9A, 0xAF, 0xEE, 0xE8, 0xA1, 0xBB, 
+0x16, 0x2B, 0x2D, 0x2B, 0xB1, 0x7F, 0x4A, 0x45, 
+0x9C, 0x9F, 0x52, 0xEF, 0x25, 0x68, 0x36, 0xF9, 
+0x27, 0xAE, 0x98, 0xC0, 0xB3, 0x18, 0xC5, 0x19, 
+0x8A, 0xD5, 0x0C, 0xEC, 0x06, 0xCB, 0xC1, 0x8E, 
+0xFB, 0xAD, 0x0F, 0x37, 0x7B, 0x7B, 0xFC, 0xBA, 
+0xFC, 0x88, 0xD6, 0x96, 0xCC, 0x56, 0x7C, 0xAC, 
+0x62, 0xF5, 0x90, 0x5E, 0xAA, 0xD2, 0x38, 0x53, 
+0x9C, 0x24, 0xF3, 0x1C, 0x8D, 0xD7, 0x98, 0x06, 
+0x85, 0xD8, 0xA4, 0x0E, 0x92, 0xEA, 0x4B, 0x51, 
+0xA8, 0x3A, 0xB7, 0x7F, 0x33, 0x18, 0x69, 0x71, 
+0xD2, 0x67, 0x45, 0xE7, 0xFD, 0x01, 0x47, 0x2D, 
+0xB0, 0xC0, 0xED, 0xB2, 0xF3, 0x75, 0x55, 0xF2, 
+0x6C, 0xA1, 0xA6, 0x5A, 0x48, 0x0C, 0x2B, 0x76, 
+0x8E, 0xAA, 0x85, 0x3E, 0x3F, 0xAB, 0x57, 0x41, 
+0xBB, 0x0B, 0x2E, 0x27, 0xAE, 0xC9, 0x73, 0x9E, 
+0xFE, 0xFD, 0xAC, 0xAD, 0x66, 0x3E, 0x5B, 0x2B, 
+0x18, 0x0D, 0x8B, 0x17, 0x72, 0x9A, 0x4B, 0xC3, 
+0x77, 0xB2, 0x76, 0x04, 
+
+0x01, 0xAF, 0x96, 0xE8, 0x71, 0x37, 0xAA, 0x89, 
+0xF3, 0x6C, 0x18, 0x6B, 0xF1, 0x13, 0x0A, 0xFB, 
+0xC9, 0x25, 0xCA, 0x28, 0x63, 0xC6, 0x8A, 0xD8, 
+0x33, 0x62, 0xAB, 0x42, 0xC4, 0x1C, 0x60, 0x52, 
+0xD2, 0x77, 0x80, 0x68, 0xA4, 0x59, 0x0A, 0xE2, 
+0xDB, 0x69, 0x0E, 0xB5, 0x2B, 0xA7, 0xA1, 0xA0, 
+0xEB, 0x80, 0xD5, 0xA1, 0x9E, 0xC2, 0x11, 0xE0, 
+0x80, 0x81, 0xA2, 0xA5, 0x33, 0xC4, 0x0A, 0xC6, 
+0xB4, 0x60, 0x87, 0xA6, 0x71, 0x80, 0xAB, 0x34, 
+0xBE, 0x57, 0x7F, 0xFF, 0x11, 0x6C, 0x64, 0xC5, 
+0xB8, 0xBD, 0x7F, 0x2E, 0x27, 0xDF, 0xF9, 0x98, 
+0x47, 0xC8, 0xD4, 0x53, 0x12, 0x6B, 0x88, 0x46, 
+0x0E, 0x0B, 0x97, 0x32, 0x98, 0xB3, 0x58, 0xD1, 
+0x8C, 0x63, 0xAB, 0xE7, 0x0E, 0xEC, 0x33, 0xD3, 
+0x16, 0x86, 0x11, 0x06, 0x35, 0x44, 0x00, 0x0A, 
+0x23, 0x76, 0xE0, 0xF5, 0xDD, 0x6B, 0xED, 0x8B, 
+0xC4, 0x75, 0xA0, 0x6D, 0x07, 0xB8, 0x9B, 0x66, 
+0x09, 0x91, 0x18, 0x0B, 0xB2, 0x52, 0xAF, 0x92, 
+0xF5, 0xD9, 0x35, 0x1D, 0xCC, 0x22, 0xA0, 0x8F, 
+0x03, 0xD0, 0x0E, 0x61, 0x81, 0x7B, 0x59, 0x79, 
+0xA8, 0x19, 0x5D, 0xA3, 0xF4, 0x15, 0xE1, 0x0D, 
+0x0E, 0x97, 0xC3, 0x48, 0x1C, 0x5A, 0x32, 0x14, 
+0x17, 0x46, 0x89, 0x79, 0x94, 0x1C, 0x31, 0x6A, 
+0x88, 0x8C, 0x1C, 0x9E, 0xAC, 0x38, 0xFE, 0xD5, 
+0x94, 0xAE, 0x23, 0x4E, 0x3E, 0x7E, 0x50, 0xEA, 
+0xB5, 0xE4, 0x1E, 0x17, 0x0B, 0x06, 0xE0, 0xF5, 
+0x7A, 0x8A, 0x29, 0x82, 0x8F, 0x3A, 0x8A, 0xC2, 
+0x0A, 0xF5, 0x4E, 0x99, 0xBE, 
+
+0x79, 0x1C, 0xC3, 0xDF, 0x0D, 0x04, 0x2F, 0x5A, 
+0x17, 0x92, 0xA8, 0x4A, 0x34, 0xC6, 0xED, 0x01, 
+0xB9, 0x40, 0x51, 0xF0, 0xD6, 0xF1, 0xA3, 0x4D, 
+0x8A, 0xD3, 0x8E, 0xCC, 0xB7, 0x28, 0x66, 0xF7, 
+0x41, 0x3E, 0x7E, 0xD9, 0x58, 0x69, 0x83, 0x88, 
+0x20, 0x52, 0x51, 0x84, 0x01, 0x3F, 0xB1, 0xA2, 
+0x03, 0xAA, 0xCF, 0xA7, 0xE5, 0x0F, 0x02, 0xCB, 
+0xEF, 0xB2, 0x95, 0x62, 0x48, 0x33, 0x2B, 0x91, 
+0x98, 0xFB, 0x92, 0xF1, 0xFB, 0x66, 0xCF, 0xD5, 
+0x2D, 0xC2, 0xBD, 0x4F, 0xAC, 0x01, 0x02, 0x64, 
+0x61, 0x51, 0xCA, 0x8D, 0x3F, 0x26, 0xC8, 0x73, 
+0x0E, 0x7E, 0x3F, 0x25, 0xA9, 0x25, 0xBE, 0x94, 
+0xE0, 0x1E, 0x78, 0xF1, 0xC4, 0xC4, 0x05, 0x55, 
+0xE9, 0x2B, 0xA6, 0x43, 0x59, 0x7E, 0x79, 0xBD, 
+0xB2, 0x8E, 0x64, 0xA5, 0xCE, 0xAE, 0xD5, 0x16, 
+0xDF, 0x94, 0x5E, 0xB7, 0x1C, 0xA4, 0xD9, 0x15, 
+0xE1, 0xF3, 0x12, 0x76, 0x28, 0x5A, 0xA8, 0xD3, 
+0x47, 0x50, 0xD8, 0xED, 0x6F, 0xE1, 0x0F, 0xFD, 
+0xCF, 0x8D, 0xE0, 0x67, 0xD3, 0xBE, 0x95, 0x88, 
+0x04, 0x80, 0xCF, 0x0B, 0xBB, 0x43, 0xE5, 0xD8, 
+0xB1, 0x6B, 0x1A, 0x0E, 0x3F, 0x6C, 0xE6, 0xA2, 
+0xAF, 0xBF, 0x93, 0x93, 0x50, 0x8C, 0x4C, 0xC0, 
+0x01, 0x94, 0x43, 0xCF, 0xA8, 0x81, 0xD7, 0x69, 
+0x89, 0xA6, 0xAE, 0xCA, 0x66, 0x5D, 0xEF, 0xEA, 
+0xB2, 0x13, 0x68, 0x75, 0xEC, 0x08, 0x07, 0x6C, 
+0x36, 0xF2, 0x51, 0x11, 0x0A, 0xCD, 0x4A, 0xAE, 
+0x12, 0x41, 0xA4, 0x44, 0xC5, 0x62, 0xCC, 0xC2, 
+0x3C, 0x74, 0xBB, 0xF6, 0xA0, 0x15, 
+
+0x1E, 0x9A, 0xC0, 0xA3, 0x89, 0x49, 0x48, 0xB7, 
+0x0A, 0x2C, 0x19, 0xA0, 0xD7, 0x9C, 0x26, 0x07, 
+0xDE, 0xF2, 0x1A, 0x31, 0xCE, 0x30, 0x2F, 0x5B, 
+0xEE, 0x4B, 0xD6, 0xD6, 0xD1, 0x73, 0xA3, 0x33, 
+0x47, 0x1D, 0x22, 0xCA, 0x6C, 0x9C, 0x8D, 0x2E, 
+0xC9, 0x93, 0xE0, 0xE4, 0xC8, 0xA9, 0x72, 0x12, 
+0xB6, 0xE1, 0xD5, 0xBD, 0x75, 0x9C, 0x21, 0x64, 
+0xFF, 0x16, 0xE3, 0xF9, 0x91, 0x02, 0x68, 0x84, 
+0x37, 0x92, 0x7E, 0xD6, 0xC2, 0x2F, 0x90, 0x7C, 
+0x47, 0xF6, 0x64, 0x59, 0x08, 0x29, 0x3A, 0xCF, 
+0xE5, 0x7C, 0xF8, 0x33, 0x46, 0xF8, 0x0B, 0x0C, 
+0x70, 0x16, 0xAF, 0x84, 0xF4, 0x28, 0xCC, 0x3D, 
+0xE1, 0xEA, 0x17, 0xB0, 0x7F, 0x92, 0x6B, 0xE1, 
+0x2B, 0x73, 0x11, 0x03, 0xEF, 0xC7, 0x3D, 0xDB, 
+0x9B, 0x39, 0xB1, 0x31, 0x1C, 0x03, 0x77, 0x6F, 
+0x6C, 0xD7, 0x80, 0x87, 0x8D, 0xE4, 0x1A, 0x5E, 
+0xB0, 0xB9, 0x32, 0x84, 0x13, 0x24, 0x30, 0x85, 
+0xF8, 0x9E, 0x43, 0xFC, 0xCF, 0x62, 0xF7, 0x95, 
+0x90, 0xF8, 0xC1, 0xF3, 0x80, 0x98, 0x8A, 0xF6, 
+0x76, 0x5C, 0x12, 0xA1, 0x79, 0x5E, 0xDD, 0x71, 
+0xAD, 0xE0, 0x9A, 0x15, 0x2F, 0xA3, 0x87, 0x66, 
+0x14, 0x3E, 0xDF, 0xAF, 0xC9, 0xFF, 0x0F, 0x5C, 
+0x84, 0x3D, 0xE4, 0x4A, 0x0F, 0x18, 0x77, 0x97, 
+0xA0, 0x5E, 0xB4, 0x0C, 0x0D, 0xB1, 0x8F, 0x46, 
+0x30, 0x45, 0x65, 0x70, 0xC1, 0x73, 0xE1, 0x39, 
+0xD2, 0xEB, 0x18, 0x2F, 0x3B, 0xE8, 0xC4, 0xD3, 
+0xD6, 0x8A, 0xF1, 0x46, 0x47, 0x47, 0x15, 0x28, 
+0x1A, 0x15, 0x1A, 0x14, 0xE8, 0x10, 0x63, 
+
+0x22, 0xC2, 0xD8, 0x9B, 0x86, 0x9C, 0xFA, 0xDD, 
+0x83, 0xB7, 0x0A, 0x76, 0xA3, 0x84, 0x00, 0x80, 
+0xE5, 0xC4, 0x70, 0xD2, 0x06, 0x00, 0x62, 0x49, 
+0x1D, 0x82, 0x0E, 0x40, 0x9A, 0x79, 0x4D, 0x2F, 
+0xE6, 0x26, 0x4A, 0xC3, 0x9B, 0x55, 0x39, 0xBC, 
+0xEE, 0xCE, 0x65, 0xFC, 0xE4, 0xBB, 0x13, 0x21, 
+0x61, 0xFA, 0x26, 0x5F, 0x3E, 0xAE, 0xD4, 0x76, 
+0x7E, 0xEF, 0xB7, 0xE0, 0xCB, 0xF6, 0x2B, 0xB0, 
+0x49, 0xF8, 0x7B, 0x74, 0xFF, 0x48, 0xAA, 0xB8, 
+0x0F, 0x70, 0x34, 0x9C, 0xA9, 0xF5, 0xF0, 0x9F, 
+0xC4, 0x52, 0x33, 0x3A, 0x25, 0x21, 0xF2, 0xD1, 
+0x98, 0x83, 0xC6, 0x22, 0xC8, 0x64, 0x97, 0x1B, 
+0xA5, 0x5F, 0xDB, 0xC6, 0xBD, 0x23, 0x43, 0x79, 
+0x22, 0xD6, 0x6B, 0x36, 0x11, 0xB7, 0x9E, 0x23, 
+0xDC, 0x79, 0x81, 0x91, 0xC3, 0x67, 0xD1, 0xEC, 
+0xFD, 0x44, 0xD0, 0x03, 0xEB, 0xCB, 0x8D, 0x81, 
+0xE6, 0x05, 0x20, 0xD0, 0x27, 0xE7, 0x4B, 0x98, 
+0x56, 0x1D, 0xD3, 0xD5, 0xA5, 0x0E, 0x6B, 0x6C, 
+0xB2, 0x72, 0xAB, 0x56, 0x34, 0x0B, 0x1B, 0xF9, 
+0x85, 0x24, 0x20, 0xC0, 0x53, 0x38, 0xCF, 0x35, 
+0xB5, 0xA4, 0x24, 0x46, 0xA0, 0x71, 0x3F, 0x1F, 
+0x6A, 0x19, 0xC5, 0x4F, 0xD7, 0x35, 0xCD, 0x4A, 
+0x29, 0xAA, 0xFB, 0x11, 0xBA, 0x07, 0x06, 0xD8, 
+0x15, 0x6D, 0x61, 0x90, 0xD9, 0xC8, 0xA9, 0x38, 
+0xC3, 0xD8, 0x63, 0x54, 0x82, 0x2C, 0xC6, 0xDE, 
+0x2D, 0x38, 0x19, 0x30, 0x3B, 0x35, 0x5E, 0x99, 
+0xB3, 0xCD, 0xF2, 0xA9, 0x91, 0xCE, 0x92, 0x10, 
+0xD4, 0xBD, 0x78, 0x04, 0x15, 0x2D, 0x60, 0x88, 
+
+0x0B, 0x31, 0x51, 0x3B, 0x4D, 0x17, 0xCF, 0x69, 
+0xA3, 0xAE, 0xDF, 0x7B, 0x10, 0x72, 0x65, 0x93, 
+0x0D, 0x7E, 0xAA, 0x29, 0xE2, 0x6D, 0xDE, 0x78, 
+0xAB, 0xD4, 0x8D, 0x65, 0xC6, 0xA5, 0x56, 0x20, 
+0xDF, 0x3D, 0xD7, 0xD3, 0x78, 0xC9, 0x34, 0x03, 
+0x41, 0xEB, 0xF8, 0x2D, 0x3D, 0x69, 0xDC, 0x1C, 
+0xF6, 0x80, 0xB8, 0xC0, 0x47, 0x0B, 0x63, 0x1E, 
+0x8F, 0x2A, 0xB4, 0x4C, 0xAC, 0x3F, 0xFB, 0x04, 
+0xAC, 0x9E, 0x5E, 0xDD, 0x4C, 0x31, 0x4D, 0xF3, 
+0x2C, 0x6E, 0x71, 0x40, 0xAD, 0x30, 0x11, 0x18, 
+0x25, 0xCF, 0x16, 0x5D, 0x70, 0xBF, 0x52, 0x7C, 
+0x2D, 0xCA, 0xB6, 0xC6, 0x06, 0x5A, 0x0E, 0x76, 
+0xFD, 0x14, 0x31, 0x91, 0x6C, 0x05, 0xDA, 0x29, 
+0xE5, 0xEB, 0x8D, 0xE0, 0xCF, 0x02, 0x0E, 0x62, 
+0x85, 0x56, 0x9A, 0x64, 0x64, 0xBF, 0xFF, 0xB5, 
+0xAE, 0xA2, 0x3A, 0x82, 0xAE, 0x74, 0x5B, 0xF3, 
+0x8C, 0x09, 0x14, 0x7B, 0x9E, 0xCD, 0xEB, 0x90, 
+0x6D, 0x91, 0x61, 0x10, 0xD6, 0x49, 0xD8, 0xCF, 
+0xE2, 0xEE, 0x2B, 0x23, 0x6F, 0xA9, 0x78, 0x83, 
+0x8E, 0x15, 0x3D, 0xF0, 0xF0, 0x99, 0x4E, 0xD4, 
+0xB9, 0xA5, 0x70, 0x15, 0xF7, 0x29, 0xF4, 0x93, 
+0x9A, 0x40, 0x96, 0x7D, 0xD9, 0x58, 0x52, 0xAA, 
+0xD5, 0x5D, 0x22, 0x9E, 0x8D, 0xD8, 0x9B, 0x79, 
+0x1E, 0x01, 0x26, 0xF5, 0x39, 0x91, 0xE4, 0x77, 
+0x66, 0x54, 0xE4, 0xCF, 0x44, 0x13, 0x50, 0x46, 
+0x8E, 0xD0, 0x36, 0x58, 0xD2, 0xEE, 0xF7, 0xC3, 
+0x42, 0xEA, 0xC5, 0xBF, 0x84, 0x73, 0xED, 0x5D, 
+0xBB, 0x83, 0x7F, 0x8D, 0x44, 0x95, 0xF4, 0x0B, 
+0x57, 
+
+0x31, 0xCE, 0x55, 0x13, 0xB1, 0xD3, 0x13, 0x68, 
+0x07, 0xD6, 0xDC, 0xFC, 0xEE, 0x19, 0x69, 0x1F, 
+0xDD, 0x71, 0x5F, 0x6B, 0xC0, 0x0E, 0xEE, 0x91, 
+0x7D, 0x8A, 0x6B, 0x2C, 0xE1, 0x48, 0x25, 0x21, 
+0x4C, 0x4D, 0xB2, 0xB6, 0x99, 0xC3, 0x92, 0xEA, 
+0xA4, 0xD7, 0xE9, 0xC8, 0x1C, 0x5A, 0xC6, 0x18, 
+0x21, 0x2B, 0xDB, 0x1D, 0xC6, 0x07, 0x93, 0x79, 
+0x60, 0xF8, 0xBB, 0x63, 0x12, 0x08, 0x02, 0x94, 
+0x53, 0xE1, 0x0F, 0x5B, 0xA8, 0x94, 0x22, 0xC9, 
+0x5F, 0x26, 0x25, 0x6E, 0x89, 0x86, 0x55, 0xFD, 
+0x35, 0x5C, 0x2C, 0xA5, 0xC7, 0xC2, 0xDF, 0xC8, 
+0x51, 0x9D, 0x80, 0x88, 0x5D, 0xF7, 0x8D, 0xE3, 
+0x35, 0xB6, 0x88, 0x5D, 0xBD, 0x77, 0x51, 0xBF, 
+0x5E, 0xD9, 0xD2, 0x06, 0x18, 0x18, 0xA4, 0xCF, 
+0xB9, 0x54, 0x3F, 0x09, 0x78, 0x9F, 0xE1, 0x9C, 
+0x86, 0x96, 0x70, 0xED, 0x2A, 0x83, 0x52, 0x10, 
+0x49, 0xD4, 0x9F, 0x8B, 0xB9, 0xD3, 0x46, 0x06, 
+0x62, 0x32, 0x52, 0xD0, 0x46, 0x97, 0x94, 0xFD, 
+0xCB, 0x76, 0x9D, 0xDE, 0x2D, 0x1A, 0x41, 0x60, 
+0x05, 0x8F, 0x0C, 0x55, 0x91, 0xE5, 0x5F, 0x4E, 
+0x62, 0x9B, 0xE6, 0x34, 0xBB, 0x28, 0x49, 0x30, 
+0xD7, 0x49, 0x62, 0xA3, 0x67, 0x34, 0x9A, 0xAB, 
+0x7A, 0xC2, 0x6C, 0xD1, 0xAA, 0xBD, 0xE6, 0x39, 
+0xE5, 0x52, 0x28, 0x5A, 0x0F, 0x3D, 0x36, 0x78, 
+0xF9, 0xD6, 0xF8, 0x0D, 0xD2, 0x8B, 0xCC, 0xD5, 
+0xF7, 0xA1, 0xB9, 0xD7, 0xA9, 0x20, 0xB8, 0x2B, 
+0xD1, 0xF4, 0x8A, 0xFB, 0xFD, 0x5B, 0x56, 0x49, 
+0x8F, 0x61, 0x85, 0x1A, 0x33, 0xB1, 0x0E, 0x91, 
+0x3C, 0xE9, 
+
+0x77, 0xFE, 0x08, 0x7E, 0x4B, 0x6E, 0x50, 0x26, 
+0x1F, 0x69, 0x6F, 0x01, 0x16, 0x79, 0x5E, 0x75, 
+0xEA, 0xB4, 0x78, 0xC5, 0xB2, 0x1D, 0xB1, 0x9F, 
+0x68, 0x8B, 0xB1, 0x05, 0xD4, 0x99, 0x92, 0x38, 
+0xB3, 0x16, 0x88, 0xC9, 0x20, 0x0C, 0x74, 0xB5, 
+0x14, 0x0F, 0xD7, 0x7F, 0x17, 0xEE, 0xD3, 0xE4, 
+0xDE, 0xED, 0xAE, 0x67, 0x26, 0x82, 0x3D, 0x10, 
+0xC0, 0x93, 0x8E, 0xC4, 0xB5, 0xCF, 0xBF, 0x6A, 
+0xEA, 0x57, 0xD7, 0xDF, 0xE9, 0x39, 0x37, 0xBD, 
+0xE0, 0x49, 0x7D, 0xA5, 0x57, 0x47, 0x67, 0x4A, 
+0x2F, 0x9B, 0x24, 0xB0, 0x29, 0xED, 0x04, 0x69, 
+0x9D, 0xF6, 0xC6, 0x18, 0x87, 0xC8, 0x48, 0xD5, 
+0xDD, 0x11, 0xBB, 0xC6, 0xAE, 0x9A, 0x98, 0x2D, 
+0x11, 0xAE, 0xAF, 0x1B, 0xC3, 0x50, 0x82, 0x1C, 
+0x4A, 0x74, 0xC6, 0x26, 0x7D, 0x0A, 0xFC, 0xD7, 
+0x5A, 0xFA, 0xDD, 0x6B, 0x7F, 0xD5, 0x68, 0xDD, 
+0x1A, 0x24, 0xCD, 0x7D, 0xAA, 0x2B, 0xC0, 0x79, 
+0xD6, 0xEC, 0xCD, 0x66, 0xFA, 0x16, 0xCA, 0xEE, 
+0xF3, 0xD2, 0x17, 0x89, 0x57, 0x39, 0xEE, 0x3F, 
+0x41, 0x40, 0x1A, 0x9B, 0x46, 0xFE, 0x29, 0x72, 
+0x74, 0xB8, 0x38, 0x5E, 0x36, 0x94, 0x2B, 0xF6, 
+0xD3, 0x4C, 0x93, 0xB6, 0x5A, 0xBB, 0xCD, 0x41, 
+0x2D, 0x97, 0xB7, 0xC9, 0x93, 0xA8, 0x13, 0xE4, 
+0xC7, 0x0B, 0x74, 0x4D, 0x2D, 0xDB, 0xBF, 0x94, 
+0xB4, 0xBB, 0xBE, 0x69, 0xE5, 0xC3, 0x60, 0xA6, 
+0x9B, 0xC8, 0x57, 0x9E, 0xF1, 0x86, 0x15, 0x0D, 
+0xE7, 0x31, 0x6D, 0x5A, 0xD2, 0x3D, 0x2B, 0x8D, 
+0x77, 0x38, 0xB1, 0xD4, 0x84, 0xB6, 0x63, 0xBD, 
+0x8D, 0x41, 0x4D, 
+
+0x92, 0x3D, 0xF0, 0x77, 0x61, 0x5C, 0xE2, 0xC2, 
+0x1A, 0xC9, 0xF8, 0x03, 0x72, 0x03, 0xF4, 0x10, 
+0x42, 0x06, 0xAB, 0xC9, 0x67, 0x33, 0xC4, 0xDE, 
+0xED, 0xF2, 0xD0, 0xEA, 0xD2, 0xFD, 0x19, 0x13, 
+0xFF, 0xE8, 0x15, 0xAA, 0xD7, 0x67, 0x76, 0xCB, 
+0x32, 0xD7, 0xBB, 0x67, 0xAD, 0x92, 0x5F, 0x13, 
+0xE3, 0x9D, 0x5D, 0xE8, 0x49, 0x62, 0x2F, 0x0D, 
+0xC6, 0xA8, 0x56, 0x26, 0x02, 0x1F, 0x92, 0xA0, 
+0xA1, 0xCC, 0xF1, 0xA4, 0x44, 0x36, 0xEE, 0xA2, 
+0x98, 0x81, 0x63, 0x36, 0xA5, 0x1D, 0x6D, 0x18, 
+0xE8, 0x11, 0x85, 0x44, 0xAB, 0x86, 0x55, 0x5F, 
+0xAC, 0x24, 0x5A, 0x0B, 0x62, 0x27, 0xE5, 0xD1, 
+0x1C, 0xC9, 0x00, 0x27, 0x4D, 0x78, 0x70, 0x3D, 
+0x4F, 0x6C, 0x26, 0xCA, 0x3A, 0x9D, 0x9E, 0x16, 
+0x13, 0x62, 0x15, 0xE2, 0x90, 0xBB, 0xB5, 0xD7, 
+0xF3, 0x83, 0x64, 0x14, 0x7D, 0x81, 0xBF, 0x28, 
+0x5D, 0x50, 0x61, 0x3A, 0x03, 0x94, 0x4E, 0x67, 
+0x7B, 0x58, 0x5A, 0xE2, 0x9E, 0x94, 0xF7, 0x31, 
+0xEB, 0x14, 0xB9, 0xBF, 0x10, 0x51, 0x8A, 0x4A, 
+0xF0, 0xC8, 0xDD, 0x3E, 0x78, 0x99, 0x9E, 0x27, 
+0x7D, 0xC6, 0x60, 0xAD, 0x69, 0x5C, 0x8D, 0xA7, 
+0xB4, 0x4F, 0x74, 0xFB, 0x5D, 0x2C, 0x1C, 0x08, 
+0x24, 0xEA, 0x52, 0x45, 0xE4, 0x7D, 0x8E, 0x20, 
+0xBE, 0x85, 0x54, 0xDE, 0x8D, 0x48, 0x79, 0xBB, 
+0x26, 0x2D, 0xEF, 0x63, 0xDD, 0xAF, 0xAA, 0xB4, 
+0x0A, 0xC1, 0x0B, 0x46, 0xEE, 0x1E, 0xC0, 0x27, 
+0x3D, 0x14, 0x6A, 0xC6, 0xF9, 0xCD, 0x0C, 0x92, 
+0xE0, 0xF7, 0xF6, 0x59, 0x96, 0xCD, 0x06, 0xA2, 
+0xE1, 0x62, 0xD9, 0x34, 
+
+0x2C, 0x66, 0x66, 0x13, 0xB0, 0xFA, 0x3D, 0x93, 
+0xD9, 0x41, 0x31, 0x63, 0x1F, 0xB6, 0xEC, 0x17, 
+0x1D, 0x0F, 0x80, 0x6F, 0xB8, 0xF4, 0x84, 0xF8, 
+0x3E, 0xAB, 0x3B, 0xBC, 0x34, 0xCF, 0xB8, 0x2E, 
+0x45, 0x8D, 0xBD, 0x99, 0x6E, 0xFD, 0x7A, 0x7B, 
+0x71, 0xEB, 0xCA, 0xD1, 0xB0, 0xCF, 0xBF, 0xB3, 
+0x94, 0x8F, 0xBC, 0x7B, 0x76, 0x4E, 0x88, 0xC4, 
+0x37, 0x75, 0x0C, 0x41, 0x4E, 0xDE, 0x9C, 0x5C, 
+0xFD, 0x06, 0x36, 0xF3, 0x61, 0x3E, 0xC9, 0x16, 
+0xF2, 0x34, 0x85, 0x4D, 0x56, 0xDB, 0x89, 0xDF, 
+0xAA, 0x61, 0x7A, 0x34, 0x1D, 0x39, 0x63, 0x91, 
+0xEE, 0xDC, 0x42, 0xB2, 0x15, 0x8F, 0x2E, 0x37, 
+0xC1, 0x0E, 0xAD, 0x27, 0xCF, 0x5B, 0xDD, 0x92, 
+0x6E, 0x21, 0x5A, 0x36, 0xC7, 0xB3, 0xCE, 0x89, 
+0xA7, 0xB2, 0x7A, 0x43, 0x1E, 0x30, 0x7F, 0x01, 
+0x69, 0x48, 0x0D, 0xDA, 0x4C, 0x6E, 0x5F, 0xE7, 
+0xB0, 0x68, 0x12, 0x45, 0x65, 0x1B, 0xE6, 0xC0, 
+0x72, 0xFC, 0x04, 0x99, 0xEE, 0xEA, 0xA4, 0x55, 
+0x86, 0x4E, 0xA2, 0x37, 0x0B, 0xF5, 0x49, 0x25, 
+0x24, 0xF9, 0x65, 0x6F, 0xE5, 0x98, 0x87, 0xC5, 
+0xEE, 0xFD, 0x11, 0xC4, 0xD4, 0x0F, 0x21, 0x07, 
+0xB9, 0x57, 0x7D, 0xE0, 0xE1, 0xB9, 0xF0, 0xF1, 
+0xE7, 0xB5, 0xA9, 0x9C, 0xF4, 0xC5, 0xB4, 0x2D, 
+0xEE, 0x33, 0x13, 0x38, 0x68, 0xB0, 0x33, 0x9E, 
+0x33, 0x34, 0x55, 0x0B, 0x37, 0xCB, 0x98, 0xAE, 
+0x18, 0x03, 0x67, 0x59, 0x6A, 0x70, 0xCA, 0x3C, 
+0xE9, 0x38, 0xC4, 0x34, 0xAD, 0x37, 0x9B, 0xF4, 
+0x93, 0xF5, 0x14, 0x46, 0x5E, 0xB0, 0x5D, 0x19, 
+0x9B, 0xE7, 0x1B, 0x4B, 0x3D, 
+
+0x2C, 0xE4, 0x96, 0xF8, 0xBF, 0x09, 0x65, 0xC8, 
+0xE1, 0xC4, 0x16, 0x7F, 0xFC, 0x55, 0x1A, 0x6A, 
+0xAB, 0x49, 0xCF, 0xF8, 0x0D, 0x7B, 0x97, 0xF7, 
+0x52, 0x13, 0xF3, 0xF5, 0xAC, 0x14, 0x9B, 0x36, 
+0xC6, 0x4B, 0x9B, 0xB9, 0x1D, 0xF2, 0x41, 0xFC, 
+0x59, 0xFD, 0xD6, 0xFF, 0x67, 0xCD, 0x60, 0x0E, 
+0x04, 0x40, 0x4B, 0x5D, 0x62, 0x36, 0x67, 0x6D, 
+0x70, 0x4D, 0x21, 0xB1, 0x70, 0x6C, 0x21, 0x63, 
+0x47, 0x6B, 0x98, 0xC6, 0x80, 0x2F, 0x4A, 0x83, 
+0x79, 0xF7, 0x90, 0xF9, 0xCC, 0x87, 0x40, 0x83, 
+0x1D, 0xE2, 0xF9, 0x12, 0x6F, 0x73, 0x8E, 0x7A, 
+0x94, 0x11, 0x79, 0xA2, 0x2F, 0xDD, 0x6E, 0x57, 
+0xA9, 0x09, 0xB7, 0xEE, 0x8B, 0x18, 0xC0, 0x06, 
+0x17, 0x2E, 0x08, 0x68, 0x78, 0xB2, 0x22, 0xD0, 
+0xFC, 0x8D, 0x11, 0x49, 0xB7, 0x7C, 0x17, 0xBF, 
+0x09, 0xA6, 0xF0, 0x8D, 0xAB, 0xCF, 0xAE, 0xDC, 
+0xFC, 0x08, 0xBE, 0x6E, 0xD1, 0xBD, 0xA8, 0x58, 
+0xDD, 0x34, 0x42, 0x09, 0x43, 0xF6, 0x96, 0xE8, 
+0x05, 0x49, 0x64, 0x63, 0x22, 0x47, 0x33, 0x95, 
+0x0F, 0xEF, 0xD3, 0x59, 0xF4, 0x81, 0xE2, 0x6F, 
+0xD6, 0x8D, 0x30, 0x52, 0xE5, 0x55, 0xAF, 0x1E, 
+0x61, 0x6B, 0xB9, 0xFD, 0xF1, 0xBE, 0xA0, 0xCC, 
+0x1F, 0xB1, 0x22, 0xD0, 0x27, 0x1A, 0x4B, 0x66, 
+0x17, 0x74, 0x31, 0xE7, 0xF9, 0x55, 0xB5, 0xBB, 
+0x11, 0x83, 0x6F, 0x2D, 0x98, 0x73, 0x0E, 0x8D, 
+0xDD, 0x9B, 0xA6, 0xF6, 0x49, 0x97, 0x4D, 0xFB, 
+0x0F, 0x69, 0x14, 0x47, 0xD0, 0x96, 0x84, 0x5D, 
+0xDC, 0xBF, 0x20, 0x95, 0x76, 0x2D, 0xB2, 0xDF, 
+0xE6, 0xA3, 0x69, 0xDD, 0x0D, 0xEA, 
+
+0x10, 0xBA, 0x61, 0xD1, 0xD9, 0xAE, 0x69, 0x7F, 
+0x7D, 0x8A, 0x83, 0x43, 0x23, 0xC0, 0x3B, 0x93, 
+0x92, 0x2F, 0x1C, 0x42, 0xE1, 0xB4, 0x2F, 0x2D, 
+0x3B, 0x94, 0xF9, 0x4F, 0xF1, 0x23, 0xAA, 0x2B, 
+0xBF, 0x34, 0x36, 0x32, 0xC4, 0x88, 0xBB, 0x07, 
+0x65, 0xD3, 0xF7, 0x9D, 0x61, 0xDC, 0x7A, 0x87, 
+0xB6, 0xB2, 0xA7, 0xA4, 0x48, 0x59, 0xB9, 0xC2, 
+0xA6, 0x28, 0x68, 0xB3, 0x3F, 0x37, 0x22, 0x18, 
+0x00, 0x2B, 0x09, 0x97, 0x3B, 0xB8, 0x2A, 0x23, 
+0xCC, 0xFC, 0xA4, 0xAD, 0x70, 0x65, 0x67, 0xF3, 
+0xDF, 0x5F, 0x3A, 0x90, 0x96, 0x09, 0xFC, 0xDE, 
+0x6E, 0xAD, 0x86, 0x51, 0x19, 0xC5, 0xEC, 0xDE, 
+0xF2, 0x14, 0xD2, 0x63, 0x77, 0x31, 0xFC, 0x48, 
+0xC1, 0x86, 0xCE, 0x8D, 0xFB, 0x8A, 0xBD, 0x3B, 
+0x70, 0x7E, 0xD3, 0xE6, 0xFB, 0xB7, 0xF3, 0xE5, 
+0x78, 0x43, 0x0E, 0xAF, 0x09, 0xD3, 0x64, 0xCD, 
+0x79, 0x13, 0xB8, 0xC5, 0xE4, 0x7E, 0xC9, 0xDC, 
+0x46, 0x1A, 0xA0, 0x5D, 0x0E, 0xAF, 0xD8, 0x49, 
+0xF5, 0x8E, 0xC1, 0x1B, 0xCE, 0xA8, 0xC9, 0xF9, 
+0xED, 0x32, 0x6A, 0x23, 0x41, 0x58, 0xF6, 0xA0, 
+0x60, 0xFC, 0xAB, 0x54, 0x78, 0x61, 0x8C, 0x26, 
+0x8B, 0x0E, 0xE1, 0x4D, 0xAB, 0xE2, 0x55, 0x21, 
+0xE7, 0x26, 0xA9, 0x12, 0x09, 0x2F, 0xD1, 0x97, 
+0x36, 0x86, 0xD2, 0xEA, 0xEC, 0xC2, 0xAD, 0x67, 
+0xF5, 0x22, 0x97, 0xCE, 0x04, 0x26, 0xAA, 0x3A, 
+0x38, 0xD9, 0x9C, 0xDF, 0xF3, 0x33, 0xAC, 0xAD, 
+0x3A, 0x13, 0x71, 0x1B, 0xDB, 0xC0, 0x68, 0xB3, 
+0x77, 0xF3, 0x95, 0x21, 0xFA, 0x0E, 0x97, 0x23, 
+0xCF, 0x03, 0x81, 0x28, 0x6B, 0xF6, 0xA0, 
+
+0xD3, 0x5D, 0x8E, 0xCA, 0x26, 0x06, 0xFB, 0xF0, 
+0x74, 0x59, 0x56, 0x98, 0xF5, 0x57, 0x68, 0x9D, 
+0x7C, 0xF0, 0x20, 0x2D, 0x3C, 0xD3, 0xD2, 0xD3, 
+0x19, 0x8D, 0x98, 0x16, 0xAA, 0xBB, 0xF6, 0x0E, 
+0xAE, 0xE8, 0x81, 0x6D, 0xC5, 0x3E, 0xAE, 0xF8, 
+0x34, 0xF8, 0x51, 0xEA, 0x89, 0x73, 0xBC, 0xB7, 
+0xC9, 0xFA, 0x6D, 0x47, 0x6D, 0x58, 0xA4, 0x64, 
+0x72, 0xB9, 0x17, 0x4E, 0x8F, 0x18, 0x0F, 0xFD, 
+0x16, 0x1B, 0x6D, 0xFE, 0x62, 0xAC, 0xD8, 0xCC, 
+0x21, 0xD2, 0x62, 0xC8, 0x51, 0x16, 0x7A, 0x89, 
+0x53, 0x0E, 0x8C, 0xEB, 0x25, 0x22, 0x46, 0x3A, 
+0x8E, 0xA7, 0xE9, 0xE1, 0x89, 0xBD, 0x5B, 0x60, 
+0xE7, 0xEE, 0xE7, 0x47, 0xB6, 0xD3, 0x40, 0xAD, 
+0x1F, 0x2D, 0xDB, 0x35, 0xA1, 0x4D, 0x3A, 0xE2, 
+0x7E, 0x6A, 0x2A, 0xC3, 0x68, 0xFE, 0xFC, 0x98, 
+0xAF, 0x90, 0xB2, 0x8C, 0xBF, 0xAA, 0x07, 0x25, 
+0x04, 0xB5, 0x47, 0xB9, 0x99, 0x4C, 0x3B, 0xEB, 
+0x13, 0x6F, 0x69, 0x11, 0xE0, 0x50, 0x0C, 0x1E, 
+0x53, 0xD7, 0x6D, 0x53, 0x34, 0xD0, 0xF4, 0xB8, 
+0x59, 0x82, 0x84, 0x73, 0xA3, 0xB7, 0x7D, 0xFE, 
+0x7E, 0xB6, 0x07, 0x78, 0x8B, 0x85, 0x9C, 0xF1, 
+0x75, 0x4D, 0x24, 0x71, 0xC6, 0x46, 0xE2, 0x51, 
+0x6E, 0x63, 0x18, 0xB1, 0x4E, 0xF6, 0xB8, 0x27, 
+0x08, 0xF4, 0x56, 0xFA, 0x4C, 0x85, 0x45, 0x32, 
+0x44, 0x58, 0x5D, 0x8B, 0x70, 0x8A, 0x66, 0xDC, 
+0x27, 0x3F, 0x7C, 0xDA, 0xBD, 0x92, 0xEA, 0xB6, 
+0x04, 0xB4, 0xCB, 0xD6, 0xAB, 0xCB, 0x20, 0x95, 
+0x3C, 0xAE, 0x1A, 0x97, 0x3A, 0x62, 0x54, 0x04, 
+0xE3, 0xA4, 0xFE, 0x4E, 0x55, 0x90, 0xC7, 0x87, 
+
+0xD7, 0x36, 0x95, 0x21, 0x64, 0xA3, 0x86, 0xDD, 
+0x6C, 0xA0, 0xD1, 0x29, 0x45, 0x49, 0x8C, 0xD5, 
+0x05, 0xD5, 0x39, 0xB9, 0x04, 0x75, 0x53, 0xCC, 
+0xAB, 0x69, 0xB2, 0x3C, 0x34, 0x99, 0x18, 0x51, 
+0xEC, 0xD0, 0x4F, 0x71, 0x05, 0x4C, 0x60, 0xC9, 
+0x49, 0xBB, 0xB7, 0xB7, 0x36, 0xBD, 0xDB, 0x20, 
+0xFB, 0x4D, 0xDF, 0xDC, 0xFD, 0x76, 0x4D, 0xC6, 
+0x71, 0xA7, 0x61, 0x4B, 0xC8, 0x58, 0x4E, 0x05, 
+0xDC, 0xF4, 0xBC, 0x4E, 0xDE, 0x96, 0xEE, 0x4D, 
+0xE0, 0xE4, 0xCA, 0xF6, 0x16, 0xEF, 0xFA, 0x79, 
+0xCB, 0xAD, 0xDD, 0x41, 0xCC, 0xD7, 0x36, 0xEB, 
+0x20, 0x2C, 0xEF, 0x9A, 0xD9, 0x94, 0x0A, 0x87, 
+0x60, 0x9C, 0x26, 0x99, 0xE5, 0xA4, 0xCD, 0x03, 
+0x57, 0x44, 0x85, 0x25, 0xA6, 0x7D, 0x75, 0x74, 
+0x70, 0x84, 0x6E, 0xB5, 0x29, 0xCA, 0x82, 0x8B, 
+0x98, 0x89, 0xDB, 0xD5, 0x0F, 0xA1, 0x17, 0x58, 
+0x75, 0x94, 0x3D, 0x6F, 0x92, 0xFE, 0x83, 0xBC, 
+0x36, 0x2B, 0xAA, 0x84, 0x74, 0x5E, 0x8F, 0x75, 
+0x14, 0x91, 0xE9, 0x8E, 0xA1, 0xBD, 0xAC, 0x07, 
+0x8E, 0x69, 0xEF, 0xE3, 0xCB, 0x04, 0x01, 0x2E, 
+0x78, 0x44, 0x2E, 0x20, 0xA3, 0x08, 0x80, 0xC7, 
+0x3D, 0x0F, 0x1C, 0xC1, 0x81, 0xD4, 0xEF, 0xB8, 
+0x3F, 0x13, 0x05, 0x07, 0xC0, 0x94, 0x0D, 0x4B, 
+0x90, 0x7F, 0x9D, 0x11, 0x27, 0xCA, 0x9D, 0x8D, 
+0x63, 0xE0, 0x79, 0x1E, 0xC1, 0x91, 0x7F, 0x8C, 
+0xA7, 0x29, 0x4E, 0xC6, 0xE2, 0xA9, 0xDF, 0xC3, 
+0x1D, 0x9B, 0x4B, 0xEE, 0xAE, 0xC7, 0xC1, 0x63, 
+0x89, 0xDE, 0xA0, 0xA4, 0xB3, 0x29, 0x2A, 0x0D, 
+0xF9, 0x67, 0xC6, 0xF5, 0x08, 0x3E, 0x93, 0x95, 
+0x1A, 
+
+0xD4, 0x4A, 0x34, 0x09, 0x89, 0x6C, 0x8C, 0xE0, 
+0xE6, 0x2C, 0x85, 0x2E, 0xC9, 0xBF, 0x9A, 0x86, 
+0x83, 0xFC, 0x32, 0x07, 0x3B, 0x1F, 0xCE, 0xFF, 
+0x50, 0xEE, 0x65, 0x55, 0x0F, 0x2B, 0x8B, 0xAB, 
+0xAA, 0x63, 0x47, 0x77, 0x81, 0xC6, 0x89, 0x39, 
+0x8D, 0x22, 0x67, 0xC0, 0x87, 0xA4, 0x52, 0xC1, 
+0xEA, 0x6A, 0x2B, 0xC5, 0x27, 0xC2, 0x80, 0x94, 
+0x4A, 0x5D, 0x31, 0xCE, 0xFD, 0x0B, 0xAA, 0x66, 
+0x9E, 0x98, 0xA9, 0x39, 0x26, 0xC3, 0xEA, 0xAD, 
+0x38, 0x9A, 0xBD, 0xC2, 0xC2, 0x3C, 0x60, 0x1A, 
+0x52, 0xE6, 0x81, 0xF1, 0x9C, 0x42, 0x7F, 0xB9, 
+0x17, 0x3E, 0x49, 0xF9, 0x43, 0x9B, 0x65, 0xF5, 
+0xBD, 0x78, 0x5B, 0xDF, 0x4F, 0x40, 0xBF, 0x50, 
+0x80, 0x18, 0x44, 0x22, 0x3E, 0x65, 0x0C, 0x5A, 
+0x2E, 0x33, 0x05, 0xF2, 0xE7, 0x34, 0x3E, 0x0D, 
+0x9A, 0xEE, 0xDE, 0x60, 0x7F, 0xA8, 0xBE, 0xC0, 
+0xF5, 0x2F, 0x3A, 0x17, 0x00, 0x8E, 0xCE, 0xC1, 
+0x44, 0xB3, 0x00, 0x96, 0x11, 0x90, 0xC0, 0x45, 
+0xA1, 0x80, 0x27, 0xD8, 0xBE, 0x48, 0x80, 0x04, 
+0xEF, 0xBF, 0x82, 0xCD, 0xA4, 0x1F, 0x1E, 0x42, 
+0x70, 0x30, 0x15, 0xC0, 0x32, 0x0A, 0x05, 0x02, 
+0x35, 0x12, 0x0B, 0x9E, 0xE2, 0x96, 0x36, 0x3C, 
+0x01, 0x9D, 0xC6, 0x24, 0x47, 0xB7, 0xDF, 0xB6, 
+0xD1, 0xA0, 0x8B, 0xFB, 0x67, 0x55, 0x08, 0xE8, 
+0xD3, 0x89, 0x54, 0x8A, 0x23, 0x7F, 0x4A, 0x13, 
+0x33, 0x04, 0x72, 0xF0, 0x35, 0x31, 0x12, 0xEA, 
+0x5B, 0xAC, 0x7C, 0x73, 0xB5, 0x37, 0x22, 0x75, 
+0x5B, 0xB3, 0xC9, 0x9B, 0x49, 0x44, 0x27, 0x6C, 
+0x1E, 0x8A, 0xC7, 0x64, 0xEB, 0x17, 0x14, 0xD7, 
+0x43, 0x26, 
+
+0x49, 0x09, 0x7D, 0xE8, 0x18, 0x76, 0x91, 0xF6, 
+0x19, 0x07, 0x6B, 0xF9, 0x32, 0x67, 0x0F, 0x71, 
+0x46, 0x11, 0xD5, 0x68, 0xDC, 0x84, 0x6E, 0xFF, 
+0x90, 0x95, 0xA7, 0xA4, 0x14, 0xD7, 0x66, 0x9F, 
+0xD8, 0xD9, 0x89, 0x54, 0xA9, 0x55, 0x43, 0x26, 
+0x09, 0xA0, 0xA5, 0x45, 0xD2, 0xBC, 0xB1, 0x75, 
+0xE0, 0x86, 0x97, 0xD3, 0xA1, 0x66, 0x38, 0x5C, 
+0x2A, 0x40, 0x30, 0xBF, 0xAD, 0x34, 0x70, 0xB6, 
+0x04, 0x2C, 0x42, 0x86, 0x41, 0x6D, 0x16, 0xEC, 
+0xC4, 0xBE, 0x5F, 0x81, 0x74, 0x7A, 0xAE, 0x93, 
+0x44, 0x27, 0x50, 0x7B, 0x48, 0xC3, 0xEA, 0xC5, 
+0x81, 0x30, 0x1C, 0xD8, 0x3F, 0x56, 0xC1, 0x0C, 
+0x0C, 0x27, 0x7E, 0xD7, 0xAB, 0x7A, 0xA2, 0x82, 
+0x10, 0xDB, 0xD7, 0x54, 0x44, 0x3F, 0x2D, 0x03, 
+0x63, 0xFA, 0xFB, 0xF3, 0xED, 0xEC, 0xC9, 0x37, 
+0x77, 0x39, 0x31, 0x15, 0xBA, 0x40, 0x4D, 0x19, 
+0x1A, 0x34, 0x44, 0xB9, 0xC1, 0xD1, 0xAD, 0xA2, 
+0x7B, 0x70, 0x0B, 0x77, 0x15, 0x75, 0xFD, 0x2B, 
+0x60, 0x8E, 0x23, 0x8A, 0x92, 0x38, 0x62, 0xCE, 
+0xAD, 0x1E, 0x6D, 0x92, 0x37, 0x76, 0x20, 0x6D, 
+0x0D, 0xFC, 0x13, 0x59, 0x2F, 0xD5, 0xDD, 0x40, 
+0x40, 0x40, 0x34, 0xA1, 0xB1, 0xF3, 0xF4, 0x7F, 
+0x6B, 0x67, 0x10, 0x27, 0xF0, 0xCE, 0xFA, 0xF1, 
+0x46, 0x86, 0x16, 0xC8, 0x47, 0xCF, 0x4B, 0xCF, 
+0x4E, 0x72, 0xEB, 0xB1, 0xCB, 0x7A, 0xC7, 0x8E, 
+0xA3, 0xF6, 0xDD, 0x68, 0x07, 0x46, 0x95, 0x8F, 
+0x21, 0x32, 0x77, 0x9B, 0x3B, 0x4F, 0x86, 0x71, 
+0x8C, 0x90, 0x52, 0x39, 0x4D, 0xB2, 0xA6, 0xF0, 
+0xE9, 0x0A, 0xAA, 0x4F, 0x3F, 0x08, 0x0E, 0x10, 
+0x7C, 0x72, 0x4A, 
+
+0x4D, 0x16, 0xDB, 0xF1, 0xAF, 0xC0, 0x5E, 0x69, 
+0x92, 0xB6, 0x6E, 0x21, 0xE8, 0x97, 0xCB, 0x9D, 
+0x5E, 0xD4, 0xC4, 0x28, 0x40, 0x36, 0x38, 0x45, 
+0x47, 0xDD, 0x72, 0xCD, 0xCC, 0x07, 0x51, 0x08, 
+0x08, 0xDD, 0xFC, 0x2C, 0x6A, 0x53, 0x9D, 0x92, 
+0x84, 0x36, 0x0A, 0x7F, 0xF6, 0x2F, 0x53, 0xD9, 
+0xC2, 0x59, 0x64, 0xC2, 0x97, 0xD8, 0xF6, 0x89, 
+0x86, 0x3E, 0x04, 0x29, 0xCA, 0x11, 0x7D, 0x4E, 
+0x0C, 0x4F, 0x12, 0x40, 0x77, 0x8E, 0x6D, 0x1F, 
+0xFC, 0xE9, 0x11, 0x88, 0x2A, 0x8D, 0xA2, 0x20, 
+0xEB, 0x62, 0xA3, 0x40, 0x81, 0x85, 0x3C, 0xD8, 
+0xE0, 0xB5, 0xB3, 0x2E, 0x75, 0xB6, 0x34, 0x77, 
+0x70, 0xBB, 0x2A, 0x39, 0xA0, 0xFC, 0x98, 0xC2, 
+0x73, 0x24, 0x2C, 0x52, 0xA7, 0xB7, 0xE7, 0x33, 
+0xAA, 0x96, 0x9B, 0x18, 0x81, 0x91, 0x0B, 0x68, 
+0x55, 0x03, 0x4A, 0x89, 0x51, 0xCE, 0xA9, 0x52, 
+0xAC, 0x6A, 0x71, 0xC1, 0x8F, 0x43, 0xA1, 0x95, 
+0xE4, 0x2D, 0x4E, 0xBF, 0x17, 0xEA, 0x3C, 0xFF, 
+0x59, 0x91, 0x4A, 0xFA, 0x64, 0xE5, 0x4E, 0x2A, 
+0x51, 0x98, 0xBC, 0x29, 0x89, 0xC1, 0x5B, 0x88, 
+0x98, 0x01, 0x46, 0xF8, 0x5B, 0x94, 0x80, 0x0F, 
+0x6E, 0x2D, 0xE5, 0x17, 0x0F, 0x55, 0x3C, 0x32, 
+0x25, 0x8C, 0xA7, 0xDB, 0x70, 0x13, 0xC1, 0x18, 
+0x4F, 0xFB, 0x59, 0xF8, 0xB0, 0x55, 0x9D, 0x99, 
+0xEB, 0x7D, 0xB5, 0x46, 0xF1, 0x31, 0x5F, 0xD1, 
+0xBE, 0x5C, 0x60, 0x40, 0xCF, 0x3E, 0x85, 0x1A, 
+0x17, 0x2D, 0x41, 0xBE, 0x69, 0xB5, 0xD2, 0x55, 
+0x4E, 0x6D, 0x01, 0xBD, 0x94, 0x15, 0x97, 0xB5, 
+0x49, 0x94, 0x28, 0x62, 0x5E, 0xEA, 0x24, 0xED, 
+0x3A, 0x4C, 0x65, 0x58, 
+
+0xB5, 0xD3, 0x58, 0x67, 0x79, 0x76, 0xF3, 0x40, 
+0x80, 0x2E, 0x02, 0x58, 0x44, 0x85, 0xB8, 0xD6, 
+0x97, 0x4B, 0x32, 0x78, 0x59, 0x17, 0xDE, 0xD8, 
+0xF8, 0x10, 0x53, 0xCB, 0x7C, 0xE8, 0xC9, 0x4B, 
+0x49, 0x74, 0x36, 0xA8, 0xCB, 0xD3, 0x53, 0x52, 
+0x80, 0x47, 0x0B, 0xBC, 0xBD, 0xF5, 0x31, 0xC1, 
+0x15, 0x00, 0xD9, 0x93, 0x2F, 0xC9, 0x89, 0x0D, 
+0xBA, 0x97, 0x16, 0x9C, 0xAE, 0x82, 0xC5, 0x93, 
+0xDD, 0x65, 0xA7, 0xF3, 0xF5, 0x28, 0xE7, 0x15, 
+0x62, 0x6F, 0xC1, 0x67, 0x73, 0x45, 0xE6, 0x38, 
+0x1B, 0xA7, 0xEC, 0xE6, 0x77, 0xD1, 0x1C, 0xE7, 
+0x25, 0x0E, 0x99, 0x94, 0xCD, 0xDA, 0x9D, 0xD5, 
+0x8C, 0x38, 0x5C, 0x12, 0x49, 0x05, 0xD7, 0x06, 
+0x61, 0x59, 0x18, 0x48, 0x1F, 0x8B, 0x0A, 0x9A, 
+0x02, 0x4E, 0x3A, 0x6D, 0x46, 0x44, 0x33, 0x8D, 
+0xD0, 0xF4, 0x42, 0x98, 0x8F, 0xAE, 0x6A, 0xD1, 
+0xBC, 0x3C, 0x93, 0xE7, 0x3F, 0x3F, 0x21, 0x19, 
+0xAB, 0x64, 0xED, 0xB3, 0x7A, 0x79, 0xF1, 0xB7, 
+0x14, 0x9C, 0xE3, 0x87, 0x58, 0x03, 0x0F, 0x1C, 
+0xF9, 0xC2, 0xC6, 0x2F, 0x88, 0x15, 0xBF, 0xB4, 
+0xA8, 0xAB, 0x0B, 0x42, 0x52, 0x93, 0xD7, 0x2E, 
+0x28, 0xBA, 0xC2, 0xB7, 0x49, 0x1D, 0xD0, 0x0D, 
+0x7F, 0xB8, 0x7B, 0x56, 0xBD, 0x46, 0x61, 0x4F, 
+0xCE, 0x94, 0x6D, 0x38, 0x08, 0xA6, 0xA9, 0x14, 
+0xDA, 0x29, 0xA0, 0x69, 0xBE, 0x5C, 0x4C, 0xD4, 
+0xA4, 0xA6, 0xA1, 0x4F, 0x1E, 0x21, 0xD3, 0x0F, 
+0x16, 0x7A, 0x58, 0x27, 0x90, 0xE5, 0x60, 0x8A, 
+0x56, 0x7C, 0x32, 0x75, 0xFC, 0x81, 0x14, 0x1C, 
+0x92, 0xE5, 0x03, 0xD0, 0x24, 0x13, 0x83, 0xB6, 
+0x15, 0xE2, 0xC4, 0x08, 0x99, 
+
+0xAD, 0x01, 0xAB, 0x8E, 0x1B, 0xF4, 0xC7, 0x7E, 
+0xF7, 0x29, 0xB1, 0x53, 0xB2, 0x3C, 0x6F, 0xB1, 
+0x66, 0xED, 0x1D, 0x97, 0xFE, 0x38, 0x34, 0x39, 
+0xBE, 0xEE, 0x87, 0x62, 0xB0, 0xB6, 0x1C, 0x24, 
+0x85, 0x04, 0x5A, 0x58, 0xF9, 0xFF, 0x9A, 0x57, 
+0x57, 0xC6, 0x40, 0xF0, 0xEC, 0xAA, 0x17, 0x36, 
+0x58, 0x49, 0xD6, 0x76, 0xDA, 0x70, 0xBA, 0x4F, 
+0xA5, 0xB0, 0x72, 0x70, 0xA3, 0x40, 0xB2, 0x73, 
+0xEA, 0x23, 0xAC, 0x98, 0x94, 0x00, 0xB1, 0x91, 
+0x96, 0x75, 0x20, 0xB3, 0x3B, 0x5E, 0x38, 0x54, 
+0x69, 0x21, 0x6C, 0xE9, 0xE3, 0x3C, 0x0B, 0x37, 
+0x69, 0x10, 0xDA, 0x4E, 0x7F, 0x55, 0x49, 0x16, 
+0xF7, 0xA0, 0x72, 0xF9, 0xE8, 0x23, 0x7B, 0xF5, 
+0x7C, 0xC6, 0x3E, 0xED, 0x05, 0x4C, 0x30, 0xD0, 
+0x1D, 0x1B, 0x55, 0x65, 0x55, 0x09, 0x23, 0xD4, 
+0x1E, 0x26, 0x17, 0x87, 0x7B, 0x86, 0x1D, 0x79, 
+0x04, 0x83, 0x15, 0xB9, 0x2C, 0x3D, 0x37, 0xFC, 
+0x2D, 0xF2, 0xF0, 0x74, 0x17, 0xC2, 0x75, 0x9E, 
+0x90, 0x41, 0xFF, 0x86, 0x98, 0xE9, 0xC3, 0x9E, 
+0x52, 0x8C, 0xBF, 0x29, 0x63, 0xAF, 0xAE, 0x78, 
+0x56, 0x75, 0x9A, 0x93, 0x49, 0x4A, 0xA1, 0xCC, 
+0xFD, 0x61, 0xEC, 0x4D, 0xFE, 0xC0, 0x1E, 0x85, 
+0x59, 0x95, 0x81, 0x42, 0xDD, 0x93, 0x07, 0x04, 
+0x01, 0xED, 0x9F, 0x71, 0x1A, 0x95, 0x57, 0xD7, 
+0x56, 0xA6, 0x28, 0x3D, 0x67, 0xF8, 0xBE, 0x9A, 
+0x41, 0x21, 0x39, 0xE7, 0xEC, 0xE4, 0x0D, 0x48, 
+0x9E, 0xA7, 0xB2, 0xDD, 0x01, 0x5C, 0xA7, 0xAC, 
+0xE2, 0xD0, 0xB3, 0xD9, 0xCD, 0xEC, 0x9D, 0x7B, 
+0x8B, 0x0B, 0x28, 0xEE, 0x60, 0x4F, 0xEA, 0xB4, 
+0xFB, 0x35, 0x52, 0x8C, 0x23, 0xFA, 
+
+0x38, 0xE5, 0x2B, 0x73, 0x8E, 0xDC, 0x1F, 0x20, 
+0x82, 0x2E, 0xDC, 0xE5, 0xFD, 0x2B, 0xCC, 0x17, 
+0xF5, 0xEA, 0x1E, 0xCB, 0xAE, 0xD0, 0xD5, 0x69, 
+0x4C, 0xE8, 0xF1, 0x90, 0xCB, 0x9E, 0xF6, 0xA0, 
+0xA0, 0x87, 0x6A, 0x01, 0x10, 0x0A, 0x15, 0x48, 
+0x3B, 0x62, 0xAA, 0xA2, 0x35, 0xAD, 0xC2, 0x53, 
+0xDB, 0x19, 0xE4, 0x50, 0x25, 0xFB, 0x14, 0x17, 
+0x3C, 0x88, 0x97, 0x46, 0xD9, 0x35, 0x39, 0xCD, 
+0xDC, 0x3F, 0x50, 0x80, 0xBB, 0x6C, 0x34, 0x2D, 
+0xFE, 0xA4, 0x86, 0xBA, 0x4D, 0x85, 0xB6, 0x4B, 
+0x8A, 0x2C, 0x35, 0xCD, 0x43, 0xBE, 0x9B, 0xC2, 
+0x5C, 0x51, 0xBC, 0xEA, 0x4B, 0xDE, 0x38, 0xEA, 
+0x95, 0x64, 0x06, 0xF9, 0xF4, 0x4B, 0xF4, 0x7B, 
+0x94, 0xEC, 0x8C, 0x3E, 0x5A, 0x4A, 0xF2, 0xEF, 
+0xEC, 0xEF, 0x3C, 0xD8, 0x4E, 0x6F, 0xD6, 0xDC, 
+0x83, 0xB1, 0xE0, 0x87, 0x50, 0x0C, 0xB8, 0x12, 
+0xD5, 0x32, 0xBB, 0x6F, 0xC9, 0xD4, 0x40, 0x3E, 
+0xDE, 0x49, 0x33, 0x62, 0xEC, 0x2D, 0xB1, 0x4E, 
+0xAA, 0xC6, 0x32, 0xBB, 0xC2, 0xA4, 0x9B, 0x08, 
+0x41, 0x26, 0xD6, 0x83, 0x51, 0xB6, 0x4D, 0xD7, 
+0xF4, 0xD7, 0xEA, 0x76, 0xAD, 0x3E, 0x86, 0xF1, 
+0xB4, 0xCB, 0x01, 0x8F, 0x48, 0xE5, 0x96, 0x81, 
+0x22, 0xCB, 0x62, 0x22, 0xA7, 0x9D, 0x1A, 0x6E, 
+0x4F, 0xD5, 0x97, 0x3A, 0xB3, 0xD6, 0x31, 0xAD, 
+0x5A, 0xB3, 0x42, 0x09, 0x49, 0x53, 0xF4, 0x94, 
+0xCC, 0x35, 0x59, 0x72, 0x98, 0x7A, 0x36, 0x68, 
+0x28, 0x9F, 0xAA, 0xD7, 0x2E, 0x0D, 0x42, 0x22, 
+0xB7, 0x7D, 0x7E, 0x98, 0x0C, 0xA6, 0x61, 0x21, 
+0x13, 0x78, 0x9B, 0xE1, 0xF4, 0xB3, 0xA4, 0xAC, 
+0xBB, 0xF2, 0x00, 0x2B, 0xD6, 0xC8, 0x48, 
+
+0xEB, 0x05, 0x3D, 0x41, 0xDE, 0xA5, 0x7E, 0x74, 
+0x5E, 0xBD, 0xF7, 0x1B, 0xEE, 0x47, 0xA4, 0xE7, 
+0xA2, 0x78, 0xF6, 0x9A, 0xF8, 0xB7, 0x06, 0x51, 
+0xF0, 0x48, 0x4A, 0x87, 0x77, 0x4F, 0x9D, 0xEF, 
+0xF0, 0x52, 0x7B, 0xBD, 0x13, 0x2E, 0x93, 0xC5, 
+0x72, 0xC2, 0x27, 0x35, 0xF8, 0x63, 0xAA, 0xB5, 
+0xC2, 0x23, 0xAC, 0xB6, 0x85, 0x49, 0xB4, 0xBD, 
+0x9D, 0xDD, 0xD4, 0x11, 0x96, 0x64, 0x32, 0x3B, 
+0x33, 0x8F, 0xC3, 0x65, 0x71, 0x6E, 0x9E, 0x36, 
+0xCB, 0x00, 0x11, 0x15, 0x47, 0x50, 0x47, 0x4B, 
+0x54, 0x9B, 0xF2, 0xD5, 0x64, 0x6D, 0x0C, 0x93, 
+0xB9, 0x36, 0xCC, 0x05, 0x9F, 0x36, 0x9B, 0xF8, 
+0xDD, 0xCA, 0x76, 0xD7, 0xA7, 0x78, 0xCC, 0xED, 
+0xE3, 0xF8, 0x1D, 0x34, 0xE8, 0xC8, 0xC8, 0x10, 
+0xD8, 0xEC, 0xF2, 0x53, 0xCA, 0xBB, 0xC6, 0x82, 
+0x63, 0x18, 0xF0, 0x92, 0x1B, 0xB2, 0xEA, 0x50, 
+0x94, 0x29, 0xB6, 0x41, 0xCC, 0xE7, 0x49, 0x13, 
+0xE4, 0x28, 0xE2, 0xEE, 0x7F, 0xB3, 0x58, 0x3F, 
+0x3D, 0x6F, 0xC9, 0xD8, 0xFD, 0x49, 0x69, 0xC2, 
+0x58, 0x40, 0x5C, 0x24, 0x71, 0x7D, 0x30, 0x7A, 
+0xC1, 0xAA, 0x62, 0x83, 0x57, 0xCD, 0x61, 0x43, 
+0x6F, 0x15, 0xC9, 0x8B, 0x5C, 0xE8, 0xC6, 0x87, 
+0x58, 0xC5, 0xB8, 0x1F, 0xAE, 0x3B, 0x21, 0x55, 
+0x15, 0x0E, 0x64, 0x66, 0x04, 0x0A, 0x2C, 0x16, 
+0xD2, 0x58, 0xB5, 0x11, 0x03, 0x26, 0x6B, 0xCE, 
+0x18, 0xA0, 0x56, 0xA9, 0x1D, 0x53, 0x40, 0xBC, 
+0xD9, 0x99, 0x60, 0xAF, 0xE7, 0x1C, 0xB1, 0xB4, 
+0x64, 0x53, 0xC8, 0xD0, 0x11, 0x2A, 0x3E, 0x68, 
+0xEA, 0xF9, 0xC7, 0x10, 0xB0, 0xCF, 0xFF, 0x4F, 
+0xF0, 0x7B, 0xCC, 0xB4, 0x5A, 0x26, 0x9C, 0xE1, 
+
+0x2F, 0x87, 0xFA, 0x67, 0xB8, 0xF1, 0xB6, 0x56, 
+0x28, 0x44, 0x8B, 0x2F, 0xE6, 0xA1, 0x7F, 0xBF, 
+0x85, 0xD7, 0xAC, 0x61, 0xAC, 0x3F, 0xCE, 0x87, 
+0x56, 0x7D, 0x49, 0x9A, 0xEE, 0x08, 0x9B, 0x87, 
+0x74, 0xFA, 0xCD, 0x83, 0x9F, 0x38, 0x88, 0xDE, 
+0x86, 0xF2, 0x67, 0xFB, 0xD0, 0x0F, 0x64, 0xBE, 
+0xB4, 0xE2, 0x3D, 0xA0, 0x81, 0xA2, 0xEF, 0x1C, 
+0x57, 0xE7, 0x0B, 0xE3, 0x4F, 0x86, 0xF0, 0x2A, 
+0xD7, 0xBE, 0x4E, 0x3C, 0xB4, 0x50, 0x63, 0x88, 
+0x46, 0xBA, 0x22, 0x68, 0x3E, 0x14, 0x69, 0x1E, 
+0xEC, 0x26, 0xA9, 0xB7, 0xF9, 0x82, 0xF0, 0xFE, 
+0xD7, 0x5E, 0xB9, 0x46, 0xEB, 0xE3, 0xFD, 0xDE, 
+0x2B, 0x6E, 0x66, 0x31, 0x33, 0x6A, 0x8B, 0xAE, 
+0x77, 0xAC, 0xAA, 0x43, 0xC2, 0x70, 0xBD, 0x7D, 
+0x21, 0xCB, 0x71, 0xB5, 0x7B, 0x25, 0x02, 0x1B, 
+0xA9, 0xBC, 0xFE, 0x0A, 0x4E, 0xFD, 0xEF, 0x24, 
+0x08, 0xFD, 0xA4, 0xB9, 0x4B, 0x50, 0x42, 0x87, 
+0x04, 0x25, 0x05, 0xCD, 0x42, 0xED, 0x99, 0xED, 
+0x9F, 0x41, 0xD0, 0xA7, 0xC2, 0xD3, 0xB5, 0x2F, 
+0x9D, 0x1F, 0x0D, 0x1D, 0x09, 0x1A, 0x24, 0x9F, 
+0xA5, 0x71, 0xBC, 0x60, 0x98, 0x1F, 0x54, 0x51, 
+0xB9, 0xDD, 0xB7, 0x97, 0xF8, 0xE6, 0x2F, 0x5F, 
+0x46, 0xDA, 0x4A, 0xCE, 0x25, 0x76, 0x90, 0xD0, 
+0xDA, 0xA6, 0xD3, 0x80, 0xD1, 0xC6, 0x19, 0x51, 
+0x10, 0x4F, 0x65, 0xE4, 0x3F, 0x00, 0xDD, 0xDD, 
+0x40, 0xF8, 0xB8, 0xE9, 0x56, 0x94, 0x32, 0xB9, 
+0x89, 0xBA, 0x93, 0xBF, 0xE3, 0xD0, 0x49, 0x90, 
+0x64, 0xBA, 0x90, 0x80, 0x26, 0x4C, 0x7F, 0x0A, 
+0x05, 0x1B, 0x15, 0xAB, 0x22, 0xC4, 0x6A, 0xB1, 
+0x95, 0xA7, 0xA3, 0x9D, 0x35, 0x77, 0xC3, 0x3C, 
+0x91, 
+
+0x5A, 0xF4, 0xE2, 0x84, 0xFA, 0x7D, 0x72, 0x2E, 
+0xAD, 0xA5, 0xD6, 0x46, 0xF3, 0x4B, 0xB3, 0x4F, 
+0x02, 0x4C, 0xDB, 0xD5, 0x8A, 0x2D, 0x84, 0x70, 
+0xD6, 0x65, 0x7E, 0xFB, 0x34, 0xF7, 0x6A, 0x0B, 
+0x9B, 0x25, 0xB6, 0x1C, 0xC3, 0x56, 0x36, 0x4E, 
+0xD4, 0x1C, 0xA6, 0x16, 0xE9, 0xBE, 0xA2, 0xC3, 
+0xC6, 0x12, 0x16, 0x04, 0x14, 0x8A, 0x23, 0x8B, 
+0x62, 0x1B, 0xCC, 0x3E, 0x02, 0x1E, 0x41, 0xC2, 
+0x68, 0xDE, 0x17, 0xAA, 0x70, 0x86, 0x8E, 0x7A, 
+0x57, 0x40, 0xDA, 0xC3, 0x76, 0xCE, 0xDD, 0x3E, 
+0xB9, 0xA4, 0xCD, 0x90, 0x74, 0x30, 0xEE, 0x64, 
+0x01, 0xED, 0x65, 0x62, 0x90, 0x51, 0x6C, 0xAB, 
+0x8F, 0x21, 0x9F, 0x00, 0x01, 0xDC, 0x2A, 0x4C, 
+0xDB, 0x1C, 0x8D, 0x8C, 0xA3, 0x76, 0x93, 0xC9, 
+0x42, 0xFD, 0x07, 0x9C, 0xCC, 0xAF, 0x31, 0x31, 
+0x63, 0x9F, 0xD7, 0x47, 0xAA, 0x05, 0xA2, 0xE4, 
+0xC9, 0xA9, 0x46, 0x55, 0x44, 0x46, 0xCF, 0xBA, 
+0x7F, 0x69, 0xF1, 0xEC, 0x99, 0x12, 0x7A, 0x49, 
+0xA6, 0x52, 0x59, 0xED, 0xAC, 0x03, 0x36, 0xA1, 
+0xE3, 0x1E, 0x49, 0xA2, 0xE8, 0x00, 0x45, 0x30, 
+0xC0, 0x72, 0xD7, 0x2F, 0xB4, 0x23, 0xFA, 0xAC, 
+0xF8, 0x23, 0x9A, 0xCC, 0xE5, 0x64, 0x87, 0x0C, 
+0x50, 0x0D, 0x7C, 0xCA, 0xB8, 0x7F, 0x5C, 0x48, 
+0xFF, 0xA3, 0xA6, 0xEA, 0xEA, 0xF1, 0x29, 0xBE, 
+0x09, 0x22, 0x78, 0x3A, 0xBA, 0xDC, 0xEB, 0x42, 
+0xF5, 0x6C, 0x1D, 0x75, 0xCE, 0x08, 0xE5, 0x3F, 
+0x50, 0xD3, 0xD7, 0xBB, 0x2B, 0x75, 0x64, 0xF7, 
+0x44, 0xD2, 0x05, 0xC8, 0x84, 0x72, 0x7E, 0x80, 
+0x7D, 0xC3, 0x69, 0x73, 0xED, 0xE0, 0xE3, 0xEE, 
+0xDC, 0xE5, 0x44, 0xC8, 0x79, 0xEC, 0xB7, 0x78, 
+0x8F, 0xFF, 
+
+0x66, 0x7D, 0x3A, 0xC2, 0x92, 0x29, 0x88, 0x9D, 
+0xD1, 0xDD, 0xA3, 0xA4, 0x73, 0x12, 0x21, 0x6B, 
+0x5A, 0x0C, 0xE1, 0xC7, 0xC0, 0xC6, 0xA3, 0x17, 
+0x63, 0x69, 0x03, 0xB7, 0xE0, 0x63, 0x6F, 0x28, 
+0x5F, 0xE4, 0x1B, 0x3C, 0xFF, 0xED, 0x38, 0xD0, 
+0xCB, 0x96, 0x5E, 0x5D, 0x08, 0x3E, 0x23, 0xC5, 
+0xB8, 0xDA, 0x7C, 0x67, 0x45, 0x7E, 0x49, 0xDC, 
+0x14, 0xB2, 0xF8, 0xBD, 0x4D, 0x01, 0x41, 0x4C, 
+0xFC, 0xF1, 0xC8, 0x21, 0xAA, 0x21, 0x41, 0x63, 
+0x0F, 0x1B, 0x16, 0x98, 0x5F, 0x99, 0x31, 0xF9, 
+0x8F, 0x15, 0x87, 0xC9, 0xC9, 0xE1, 0x60, 0xC9, 
+0x86, 0xF9, 0x21, 0x7D, 0x83, 0x76, 0x34, 0x1B, 
+0xFD, 0x5C, 0x67, 0x66, 0x52, 0xF9, 0x5A, 0x3B, 
+0xA0, 0xC1, 0x1C, 0x72, 0x97, 0xFA, 0xBE, 0x8B, 
+0x06, 0xF9, 0x32, 0x64, 0x56, 0x89, 0xA7, 0x90, 
+0x79, 0xED, 0x65, 0x33, 0xE0, 0x8E, 0x97, 0xF7, 
+0x49, 0x50, 0xC2, 0xAB, 0xA9, 0x57, 0x0E, 0x65, 
+0x96, 0x5B, 0xF5, 0x54, 0xFE, 0xC3, 0x04, 0xDB, 
+0xC0, 0x0E, 0x20, 0x74, 0x9E, 0xEB, 0x2D, 0x29, 
+0x57, 0xFA, 0xF1, 0xF2, 0xF6, 0xF6, 0xFE, 0xFF, 
+0x3A, 0x88, 0x48, 0xB5, 0x26, 0xE7, 0xF2, 0xC7, 
+0x7F, 0x54, 0x11, 0x4E, 0x0A, 0x48, 0x99, 0x94, 
+0xF3, 0xD7, 0x2E, 0x00, 0x92, 0x7D, 0x9B, 0xA6, 
+0x47, 0x77, 0x08, 0x32, 0xCF, 0x94, 0xA0, 0xE9, 
+0xE7, 0xDA, 0x2F, 0x3B, 0x43, 0x1C, 0xC0, 0x0C, 
+0x50, 0x3D, 0x00, 0x6A, 0xDC, 0xA5, 0xD0, 0x32, 
+0xED, 0x82, 0x75, 0xAE, 0xEB, 0x65, 0x82, 0x60, 
+0xAA, 0x7C, 0x02, 0x7B, 0xC5, 0xF5, 0x98, 0x4B, 
+0x66, 0xF7, 0x05, 0x7E, 0x37, 0xDA, 0xED, 0xE8, 
+0x40, 0xA0, 0xB4, 0x43, 0x49, 0x7E, 0xB4, 0xA7, 
+0x07, 0x31, 0x53, 
+
+0x1D, 0xFF, 0x54, 0xC5, 0x5C, 0x44, 0xE0, 0xED, 
+0x53, 0xB1, 0xD7, 0x51, 0xE9, 0x02, 0x8D, 0xDF, 
+0x90, 0xAC, 0x8D, 0x09, 0x7B, 0x3B, 0x46, 0x95, 
+0x21, 0xFE, 0x18, 0x7D, 0x9A, 0x34, 0xF9, 0xF4, 
+0x68, 0xE5, 0xA9, 0x29, 0xA6, 0xCB, 0x68, 0x55, 
+0xF9, 0xFA, 0x09, 0x38, 0x1C, 0x41, 0xF8, 0xFB, 
+0x57, 0x0F, 0xEC, 0xAE, 0x24, 0x7B, 0xD0, 0x99, 
+0x3E, 0x1C, 0xE4, 0xC4, 0xFB, 0xC2, 0xD8, 0x6C, 
+0x8F, 0xE5, 0x5D, 0x95, 0x13, 0xB6, 0xA0, 0xF8, 
+0x45, 0x5D, 0xE4, 0x1C, 0xCF, 0x3A, 0xD3, 0xA6, 
+0xA0, 0x3D, 0x1E, 0x05, 0x99, 0x60, 0xC4, 0xFB, 
+0x2F, 0xFB, 0xCA, 0x63, 0xC7, 0xFF, 0xE0, 0x4A, 
+0xBD, 0xCA, 0x10, 0x3A, 0xD3, 0x6F, 0x1B, 0x4F, 
+0x25, 0x5D, 0x8F, 0x4A, 0x69, 0xD6, 0xAB, 0xC2, 
+0xC2, 0x0F, 0xA7, 0x5E, 0x00, 0x84, 0x32, 0xFF, 
+0x5E, 0x50, 0x59, 0x08, 0x9B, 0xC1, 0xEA, 0xA0, 
+0x4A, 0xE2, 0xCE, 0x54, 0xF6, 0xB7, 0xAB, 0x6B, 
+0x51, 0xE3, 0x64, 0x9E, 0x91, 0x5F, 0x98, 0xEA, 
+0x0E, 0x1B, 0xB7, 0x14, 0xFB, 0x18, 0x64, 0x68, 
+0x58, 0xEE, 0x18, 0x1E, 0x80, 0x9D, 0xDA, 0x34, 
+0x77, 0x12, 0xDC, 0x26, 0x30, 0xDF, 0x2B, 0x59, 
+0xBA, 0x34, 0x1A, 0x6E, 0xAB, 0x76, 0xD6, 0xA9, 
+0xFC, 0x51, 0xA1, 0x4C, 0xE9, 0x9A, 0x2F, 0x01, 
+0x5A, 0xD0, 0x48, 0xFF, 0x56, 0x9C, 0xFD, 0x59, 
+0x7A, 0xB4, 0x9F, 0x0C, 0xA3, 0x08, 0x8F, 0x4D, 
+0x14, 0x67, 0xB3, 0x49, 0x7B, 0x72, 0xE0, 0xEC, 
+0x83, 0xD3, 0x3F, 0x42, 0x33, 0xB3, 0x99, 0x32, 
+0xC5, 0x7E, 0xAF, 0xC6, 0x8B, 0xC2, 0xCA, 0xD8, 
+0x5C, 0xBB, 0xDE, 0xB9, 0x13, 0x74, 0xEC, 0xC0, 
+0xBD, 0xD9, 0x70, 0x70, 0x3B, 0x4C, 0xCE, 0x3B, 
+0xC3, 0x64, 0x21, 0xF8, 
+
+0x7C, 0x94, 0xB0, 0x81, 0xCB, 0xE1, 0x9F, 0xE2, 
+0xBA, 0x08, 0xDB, 0xBA, 0xF0, 0xB9, 0x90, 0x97, 
+0xA6, 0xA7, 0x03, 0xCA, 0x90, 0x4C, 0x0C, 0x6D, 
+0x8E, 0x57, 0x42, 0xEB, 0x01, 0x88, 0x74, 0x98, 
+0xCD, 0x51, 0x3B, 0xB3, 0xFB, 0x20, 0x18, 0x64, 
+0x3D, 0x49, 0x38, 0xDA, 0x8C, 0xE9, 0xFE, 0xFB, 
+0x0E, 0xFC, 0xE4, 0x74, 0x14, 0x59, 0xF4, 0x00, 
+0xAA, 0x28, 0x98, 0x28, 0x65, 0x96, 0x37, 0x16, 
+0xB6, 0x91, 0x6D, 0xC4, 0xE9, 0x21, 0xFD, 0x9A, 
+0x62, 0x1D, 0x73, 0xA2, 0x9F, 0xD5, 0x66, 0xBD, 
+0x4B, 0xB9, 0x9E, 0x53, 0x47, 0x07, 0x59, 0x46, 
+0x31, 0xF9, 0x00, 0xFB, 0xF1, 0x2E, 0x7C, 0x3C, 
+0xF2, 0x39, 0xD0, 0x53, 0xBA, 0x06, 0xA9, 0x68, 
+0xEA, 0x7C, 0x63, 0xC8, 0xEE, 0xCF, 0x0D, 0x35, 
+0xCB, 0xCA, 0x03, 0x38, 0x3A, 0xEF, 0x39, 0x06, 
+0xA2, 0xE3, 0x59, 0xAD, 0xF7, 0xF5, 0x13, 0x3A, 
+0x4B, 0x64, 0xEF, 0x47, 0x71, 0x54, 0x5B, 0x8A, 
+0x9E, 0x37, 0x8E, 0x42, 0xAC, 0xB9, 0xD6, 0x17, 
+0x18, 0x83, 0x0E, 0x5B, 0xF3, 0x16, 0x36, 0x07, 
+0xAA, 0x97, 0x28, 0x2E, 0xEA, 0x29, 0x98, 0x27, 
+0xE1, 0x20, 0xCD, 0x66, 0xAE, 0x67, 0xCE, 0x1A, 
+0xFC, 0x9C, 0xFB, 0xE5, 0xCB, 0xC7, 0x0F, 0x56, 
+0x7F, 0xFA, 0x48, 0x02, 0x0C, 0xE6, 0x1B, 0xDB, 
+0x67, 0xB9, 0x0C, 0xCD, 0x79, 0x3C, 0xB9, 0xC0, 
+0xB2, 0x25, 0x90, 0xF3, 0x7D, 0x04, 0xFA, 0xFC, 
+0x43, 0x32, 0x41, 0xCD, 0xE8, 0xEB, 0xC2, 0x46, 
+0x61, 0x76, 0xE4, 0x58, 0x49, 0x0C, 0x16, 0xAC, 
+0x07, 0x3F, 0x00, 0x92, 0xE8, 0xDB, 0x13, 0x7B, 
+0x48, 0x38, 0x79, 0x17, 0xD4, 0xD3, 0xA8, 0x05, 
+0x5D, 0x88, 0xB6, 0x8B, 0x6A, 0x0C, 0x86, 0x0B, 
+0xA7, 0x51, 0xAB, 0x99, 0x0D, 
+
+0x4E, 0xB8, 0x09, 0x01, 0xCF, 0xDE, 0x5D, 0x95, 
+0x47, 0xF3, 0x25, 0xEA, 0x91, 0x54, 0x4F, 0x7C, 
+0xD8, 0x08, 0x74, 0xF3, 0x49, 0x4A, 0xDA, 0xAD, 
+0x44, 0x14, 0xE0, 0x00, 0x5C, 0x8F, 0x4E, 0x42, 
+0xAF, 0x90, 0x05, 0x59, 0x54, 0x2F, 0xC5, 0xB0, 
+0x75, 0x27, 0x06, 0x17, 0xC6, 0x69, 0xBE, 0x67, 
+0xD5, 0xAE, 0xE5, 0x11, 0xA7, 0x40, 0x3B, 0xE8, 
+0x4C, 0xA9, 0x7B, 0x16, 0x5E, 0x3D, 0xCD, 0x30, 
+0x06, 0x62, 0x70, 0xC8, 0x2A, 0x85, 0x4E, 0xB2, 
+0x7F, 0x2A, 0x52, 0xB7, 0x0E, 0xDA, 0xD5, 0x1D, 
+0xED, 0xD9, 0x3D, 0x31, 0x56, 0x05, 0xD3, 0xF0, 
+0x30, 0xA4, 0x20, 0x3C, 0x29, 0xBF, 0x73, 0xA3, 
+0xC0, 0x76, 0xD9, 0x3A, 0x23, 0x57, 0x4D, 0x06, 
+0x6C, 0xFA, 0x06, 0x57, 0xA3, 0xCB, 0x3A, 0x4E, 
+0x7B, 0xF8, 0xDF, 0x25, 0xEA, 0x4B, 0xAC, 0x69, 
+0x8B, 0x35, 0x10, 0xC6, 0x9F, 0xB9, 0xB9, 0x8A, 
+0x80, 0xDE, 0x60, 0xBA, 0xDA, 0x31, 0xE3, 0xB2, 
+0xC9, 0xFF, 0xCA, 0x76, 0x4C, 0xE1, 0x53, 0xFE, 
+0x12, 0x30, 0x51, 0xA4, 0xC7, 0x00, 0x0D, 0x27, 
+0xCA, 0x5F, 0x44, 0xCE, 0x0A, 0x02, 0x3B, 0xCC, 
+0x0E, 0x77, 0x2C, 0xE9, 0x4F, 0xBE, 0x40, 0x83, 
+0x5E, 0x4B, 0x62, 0x38, 0x98, 0x5D, 0x0E, 0x71, 
+0x84, 0x91, 0x15, 0xA6, 0xC6, 0x8A, 0xC7, 0xE9, 
+0x97, 0x6E, 0x15, 0x3E, 0x3A, 0xCA, 0xB0, 0xFB, 
+0xB3, 0x3D, 0x63, 0x0D, 0x92, 0x62, 0xE1, 0x30, 
+0x1C, 0x49, 0x3E, 0x1B, 0xB5, 0x19, 0x42, 0x25, 
+0x9D, 0x9F, 0xA8, 0x6D, 0x7E, 0x9C, 0x92, 0xD4, 
+0x8D, 0x23, 0x8D, 0xEC, 0x12, 0xE4, 0x8C, 0x85, 
+0x67, 0x7C, 0x72, 0x61, 0xE7, 0xF6, 0x8D, 0x36, 
+0xD6, 0xA6, 0x23, 0x1D, 0xEA, 0xFA, 0x2A, 0x0C, 
+0x09, 0xBE, 0xE0, 0x5F, 0x22, 0xC8, 
+
+0x81, 0x95, 0x7B, 0x1E, 0xB3, 0xDB, 0x41, 0xD7, 
+0x4F, 0xD0, 0xB0, 0x99, 0x5C, 0x55, 0xF5, 0xD6, 
+0x1B, 0x35, 0xE0, 0x80, 0x2A, 0x6B, 0x63, 0x1D, 
+0x98, 0xE0, 0x90, 0x51, 0xD0, 0x5A, 0xD0, 0x66, 
+0xCE, 0xD7, 0x80, 0x7B, 0xE8, 0xAE, 0x81, 0x9D, 
+0xA5, 0x9C, 0xD4, 0xD6, 0xBE, 0x38, 0x00, 0x6E, 
+0x56, 0x25, 0x53, 0x86, 0x48, 0x3A, 0x43, 0x07, 
+0x5B, 0x4F, 0x94, 0x22, 0xDA, 0x1A, 0x84, 0x98, 
+0x74, 0xB2, 0xBC, 0xD1, 0xD0, 0xC8, 0x72, 0xD9, 
+0xFC, 0x29, 0x81, 0xEE, 0x98, 0x71, 0x79, 0x28, 
+0x77, 0x0E, 0x75, 0x35, 0xBD, 0x4E, 0x05, 0x16, 
+0x15, 0xBF, 0x21, 0x30, 0xB2, 0x44, 0x61, 0x5F, 
+0xA1, 0xDA, 0x64, 0x2A, 0xB5, 0xDA, 0x53, 0xD7, 
+0x86, 0xA5, 0x03, 0x94, 0x67, 0xA5, 0xB4, 0x1F, 
+0x64, 0x14, 0xCB, 0x95, 0xC2, 0x08, 0x0E, 0x2E, 
+0x13, 0xED, 0xF8, 0x1A, 0xC7, 0xE4, 0xD1, 0x61, 
+0x98, 0xAE, 0x39, 0x3F, 0xD5, 0xE6, 0x95, 0xC1, 
+0xD1, 0x8C, 0xD6, 0x62, 0x37, 0xB8, 0x93, 0x7F, 
+0xA8, 0x82, 0x47, 0xF2, 0x0E, 0x98, 0x46, 0xB5, 
+0x12, 0x8D, 0xB9, 0x50, 0xD2, 0x03, 0x09, 0x54, 
+0x2E, 0x28, 0x5F, 0x4B, 0x41, 0xAF, 0xE5, 0xD2, 
+0x5E, 0xA5, 0xBD, 0xE1, 0xAD, 0xD3, 0x5D, 0xE8, 
+0x46, 0xF7, 0x4E, 0x05, 0xCB, 0x34, 0x22, 0xDD, 
+0xE8, 0x08, 0x73, 0xB7, 0x10, 0xCD, 0xDC, 0x90, 
+0x3C, 0x3E, 0xAD, 0x8A, 0x54, 0x2E, 0x39, 0xA1, 
+0x9B, 0xDD, 0xE1, 0xED, 0xA4, 0xE0, 0x89, 0x86, 
+0x0A, 0xC5, 0x08, 0x6D, 0x43, 0xB8, 0x2B, 0x93, 
+0xDE, 0x05, 0x2E, 0xDC, 0xB2, 0xE5, 0x67, 0x4F, 
+0x76, 0xFA, 0xCB, 0x17, 0x0B, 0xD9, 0xB9, 0x51, 
+0xB9, 0xCF, 0x33, 0xB9, 0x43, 0x5A, 0x7A, 0x7E, 
+0xC3, 0xAC, 0x18, 0x4E, 0xCE, 0x83, 0x88, 
+
+0xCF, 0x95, 0xC4, 0x79, 0xE9, 0x77, 0x06, 0x59, 
+0xE6, 0xEB, 0x70, 0x83, 0xED, 0x24, 0x39, 0x65, 
+0x11, 0xF7, 0xEE, 0xBC, 0x58, 0x7D, 0x95, 0xCF, 
+0x07, 0xB0, 0xD8, 0xC5, 0xEB, 0xF7, 0x9B, 0xE2, 
+0x2E, 0xA7, 0x18, 0xEB, 0x45, 0x16, 0x3F, 0xBE, 
+0x46, 0xD7, 0x2B, 0x3F, 0xBC, 0x7A, 0x75, 0x20, 
+0x7A, 0xB5, 0x01, 0x0B, 0xBE, 0x21, 0xC2, 0xCE, 
+0x7A, 0x4D, 0xD1, 0x8D, 0xEC, 0xB6, 0x4F, 0xFF, 
+0x8F, 0x71, 0x93, 0x30, 0x49, 0x6A, 0x5C, 0xE4, 
+0x1B, 0x02, 0x87, 0x70, 0xFF, 0x9A, 0xBA, 0xCF, 
+0xFE, 0xA3, 0x95, 0x62, 0x60, 0x34, 0x24, 0x49, 
+0x5F, 0xFF, 0xDB, 0x95, 0x34, 0xEA, 0xEE, 0x66, 
+0xBF, 0xDC, 0x89, 0x16, 0x15, 0xEC, 0x6D, 0xF0, 
+0x31, 0x30, 0xF0, 0x89, 0x01, 0xCB, 0x72, 0xB5, 
+0x58, 0x12, 0x65, 0xB6, 0x50, 0x43, 0x70, 0x13, 
+0x15, 0x1F, 0x84, 0x41, 0xDB, 0xEB, 0x32, 0x62, 
+0x0A, 0x64, 0x28, 0xC3, 0xF7, 0x83, 0xD9, 0x3C, 
+0x65, 0x93, 0xC6, 0x76, 0xF6, 0xD3, 0x78, 0x19, 
+0xD6, 0x6A, 0x7E, 0x18, 0x4C, 0x5C, 0xB7, 0xEF, 
+0x8B, 0x84, 0xF8, 0x6C, 0xA8, 0x75, 0x4D, 0xA4, 
+0x1B, 0x2D, 0x55, 0xF7, 0x03, 0xB5, 0xF6, 0x34, 
+0x3C, 0x12, 0x2F, 0x18, 0x6F, 0x60, 0xEB, 0x92, 
+0x44, 0xE5, 0x40, 0x22, 0x6E, 0x18, 0xF2, 0x1E, 
+0x6C, 0x01, 0x82, 0xBA, 0x03, 0xC0, 0xB2, 0x09, 
+0x05, 0xD3, 0x43, 0xCA, 0x1E, 0xC3, 0x81, 0x5E, 
+0xDF, 0x83, 0x73, 0x8C, 0xD1, 0x60, 0x3A, 0xDE, 
+0xCC, 0xB1, 0xBA, 0x90, 0x7B, 0x51, 0x57, 0xE8, 
+0xB0, 0x1C, 0x12, 0xFE, 0x85, 0xB8, 0xF2, 0x56, 
+0xA5, 0x8A, 0x9B, 0xAF, 0x32, 0xD6, 0xE7, 0x00, 
+0x6E, 0x34, 0x01, 0x13, 0x85, 0x03, 0x01, 0x5C, 
+0x22, 0x84, 0x22, 0x2A, 0xEE, 0x54, 0x30, 0xB0, 
+
+0x76, 0xE2, 0x3C, 0x4E, 0xEC, 0xFD, 0x97, 0x89, 
+0x26, 0xE4, 0x1A, 0xC8, 0x83, 0xD7, 0x18, 0x15, 
+0x58, 0xC4, 0xB8, 0x71, 0xE8, 0x73, 0xD0, 0x57, 
+0xD4, 0x95, 0xDD, 0xE6, 0xE5, 0x9F, 0x2E, 0x15, 
+0x23, 0x47, 0x02, 0xE4, 0xDF, 0x87, 0x94, 0x3F, 
+0xD4, 0xD8, 0x7F, 0x1E, 0x7C, 0xD4, 0xB6, 0x85, 
+0x93, 0x0B, 0xD0, 0x96, 0x04, 0x37, 0x60, 0x33, 
+0xEC, 0x45, 0x78, 0xAF, 0x15, 0xE2, 0xC5, 0x5C, 
+0x55, 0x76, 0x87, 0x09, 0xF0, 0x5B, 0xB8, 0xE8, 
+0xE5, 0xDD, 0x4F, 0xFD, 0xEE, 0x94, 0xAE, 0xD3, 
+0x00, 0x2E, 0xAE, 0x43, 0x24, 0x4A, 0x2E, 0x9E, 
+0xAA, 0x27, 0xA2, 0xEF, 0x80, 0x0C, 0x39, 0x28, 
+0x3B, 0x52, 0x50, 0x7A, 0x4A, 0x30, 0x2E, 0x8D, 
+0x5E, 0x58, 0xE1, 0x04, 0x22, 0x1F, 0x36, 0xAC, 
+0xD3, 0x54, 0xEC, 0x79, 0x56, 0xC9, 0x34, 0x25, 
+0x60, 0xFC, 0xB2, 0xE7, 0xF6, 0xD5, 0x97, 0x05, 
+0x29, 0x24, 0x79, 0x6B, 0x1B, 0xB7, 0x6A, 0x6F, 
+0x3C, 0x95, 0x3A, 0x0B, 0x98, 0x03, 0xDD, 0x27, 
+0x7B, 0x87, 0x85, 0xB9, 0x3D, 0x0E, 0x43, 0x83, 
+0xAD, 0x47, 0x30, 0x86, 0x3C, 0xF8, 0xED, 0xD6, 
+0xB6, 0x79, 0xF0, 0x4A, 0x1C, 0x80, 0x76, 0x5D, 
+0x91, 0x16, 0x6E, 0x31, 0x31, 0x01, 0xC8, 0x22, 
+0x05, 0x74, 0x4F, 0xFC, 0xE0, 0xB6, 0x25, 0xC4, 
+0xB2, 0xCC, 0xFE, 0xAA, 0x35, 0x26, 0x9E, 0xF3, 
+0xDD, 0xAA, 0x56, 0x99, 0x5F, 0x77, 0xAD, 0xD4, 
+0x1B, 0xDD, 0x4E, 0x78, 0x74, 0x8D, 0xF9, 0x35, 
+0xCC, 0xC1, 0x4C, 0xCE, 0xC8, 0xA6, 0x39, 0x06, 
+0x3A, 0x49, 0xD3, 0xB0, 0x9D, 0x63, 0x51, 0x3A, 
+0xDA, 0xBB, 0x69, 0xB0, 0x2E, 0x7D, 0x25, 0x1E, 
+0xF7, 0x4D, 0xA9, 0x4E, 0x33, 0x1B, 0xCA, 0x72, 
+0x98, 0x45, 0xAC, 0x38, 0x30, 0x6B, 0x31, 0x42, 
+0x41, 
+
+0x9B, 0xE2, 0x30, 0x39, 0x18, 0xF5, 0x80, 0x62, 
+0xDE, 0x91, 0x6C, 0x3F, 0xAD, 0x6C, 0x97, 0x4F, 
+0xF8, 0x4D, 0x01, 0x06, 0x4B, 0xFD, 0x5B, 0xD0, 
+0x94, 0xBC, 0x07, 0xB3, 0x3E, 0x2F, 0xD7, 0x42, 
+0x49, 0xD1, 0x36, 0x44, 0xDD, 0xDB, 0xFE, 0x0A, 
+0xDB, 0xD6, 0x9C, 0x88, 0xC8, 0x38, 0x83, 0x56, 
+0xDC, 0x74, 0x87, 0x2A, 0xC2, 0x7B, 0xDE, 0x0F, 
+0xBE, 0x17, 0x4F, 0x5A, 0x80, 0x5D, 0xF2, 0x68, 
+0x6C, 0x37, 0x88, 0xB4, 0x07, 0x51, 0x94, 0x69, 
+0x4E, 0x22, 0xAC, 0x2B, 0x54, 0x93, 0x3E, 0x28, 
+0x4E, 0x73, 0x06, 0x83, 0xE2, 0xC2, 0xE1, 0x45, 
+0x67, 0x32, 0x3D, 0xD1, 0xB3, 0xD5, 0x19, 0xA1, 
+0xC4, 0xEA, 0x5B, 0x0F, 0xF4, 0x9F, 0xB3, 0xFD, 
+0x1B, 0x8E, 0x17, 0x74, 0x12, 0x3C, 0xDC, 0x9A, 
+0xEF, 0xA8, 0xDA, 0xDA, 0x79, 0x14, 0x65, 0xB6, 
+0x4E, 0xD5, 0xFE, 0xA7, 0x18, 0x5D, 0xCA, 0x61, 
+0x6C, 0x61, 0x96, 0xE9, 0x3C, 0x51, 0x72, 0xFB, 
+0x4B, 0xD9, 0x3F, 0x11, 0x70, 0x4E, 0x23, 0x53, 
+0xE2, 0x0E, 0xFB, 0xEE, 0xF9, 0x1B, 0xA8, 0x5C, 
+0xE7, 0xB2, 0x5E, 0x41, 0x09, 0x09, 0x09, 0x19, 
+0x39, 0x17, 0xC5, 0x96, 0x12, 0xEA, 0x68, 0xC3, 
+0x42, 0x2E, 0xA8, 0x78, 0x4F, 0xA5, 0x8B, 0xF7, 
+0x70, 0xBA, 0x58, 0x68, 0x60, 0xE6, 0xF0, 0xA3, 
+0xB9, 0xE4, 0x53, 0x22, 0x01, 0x7B, 0x58, 0x23, 
+0x54, 0xAF, 0xAF, 0x67, 0x46, 0xF2, 0x01, 0x99, 
+0xD0, 0x93, 0x47, 0x42, 0xB9, 0x1C, 0x1A, 0xD8, 
+0xEA, 0x14, 0xD4, 0x05, 0x80, 0xAA, 0xE9, 0x7C, 
+0xE2, 0x13, 0x26, 0x22, 0x78, 0x82, 0x28, 0x08, 
+0xBB, 0x48, 0x50, 0x7E, 0xBC, 0xA7, 0xF1, 0x31, 
+0x28, 0xBD, 0xA3, 0xED, 0xD4, 0x38, 0x6C, 0xC3, 
+0x80, 0x06, 0x22, 0xE1, 0xA9, 0x91, 0xF8, 0x5B, 
+0xFE, 0x93, 
+
+0xE7, 0xA6, 0xDD, 0xC6, 0x67, 0x5E, 0x31, 0x93, 
+0xB3, 0xB2, 0x98, 0x5B, 0xEE, 0x5E, 0xCB, 0xBD, 
+0x7E, 0xA8, 0xF1, 0x6B, 0xFB, 0xD3, 0xAB, 0x54, 
+0x60, 0x30, 0xD0, 0x12, 0x24, 0x98, 0x94, 0xA3, 
+0xE2, 0x67, 0x0C, 0x9F, 0xE2, 0x15, 0xD8, 0x13, 
+0x07, 0xCE, 0x18, 0xF0, 0xD1, 0xA0, 0xFC, 0xBB, 
+0x50, 0x80, 0x88, 0x85, 0x5C, 0xF1, 0xC8, 0x9F, 
+0x14, 0xC9, 0x8B, 0x9E, 0x8B, 0x64, 0x8B, 0x00, 
+0x82, 0xE9, 0xA1, 0x47, 0xE0, 0xE0, 0x54, 0x09, 
+0x33, 0x9C, 0xAE, 0xEC, 0x6A, 0x88, 0x09, 0xD9, 
+0x23, 0xCA, 0x2B, 0x7E, 0x32, 0x26, 0x37, 0x3B, 
+0xC9, 0x35, 0x3A, 0x8E, 0xAB, 0x7C, 0x21, 0xEF, 
+0xC1, 0xDF, 0x33, 0xF0, 0x75, 0xFE, 0xA0, 0xEA, 
+0xCE, 0x85, 0x05, 0x27, 0xBE, 0x08, 0xA4, 0x6F, 
+0x64, 0xB0, 0xDC, 0x3C, 0xD7, 0x61, 0x69, 0xA2, 
+0x85, 0x1E, 0x5E, 0xFF, 0x75, 0xF8, 0xC1, 0xBC, 
+0xC4, 0x85, 0xDB, 0xFE, 0x03, 0x2B, 0x81, 0xBF, 
+0x0C, 0x31, 0x72, 0xBF, 0xA5, 0xBF, 0x7E, 0xED, 
+0x22, 0xD2, 0x85, 0x6E, 0x95, 0xE7, 0x1B, 0x0D, 
+0x5C, 0x17, 0x07, 0x5F, 0x80, 0x96, 0x0A, 0xDD, 
+0xE2, 0x76, 0x14, 0xFB, 0xD2, 0x04, 0xE2, 0x9F, 
+0xE4, 0x20, 0x66, 0x93, 0x2F, 0xE7, 0xAC, 0x1D, 
+0x7E, 0x00, 0x49, 0x2E, 0xFD, 0xD8, 0xD2, 0xED, 
+0xD2, 0x91, 0xA7, 0x68, 0x5F, 0x3A, 0xFD, 0x67, 
+0x42, 0xFD, 0x65, 0x99, 0x8A, 0x4B, 0x17, 0xEB, 
+0x37, 0xF2, 0x09, 0xDD, 0x10, 0x77, 0x8A, 0x1A, 
+0x93, 0xE5, 0x63, 0x43, 0xC1, 0xD2, 0x33, 0x55, 
+0x3D, 0xEC, 0x5E, 0x9D, 0xA5, 0x18, 0x41, 0x1B, 
+0xB0, 0xB8, 0x30, 0x0E, 0x3E, 0xF3, 0xE6, 0x38, 
+0xD5, 0xB6, 0x09, 0x83, 0x44, 0x37, 0xDB, 0xF6, 
+0xE6, 0x1B, 0x65, 0xD8, 0xB6, 0x43, 0xCB, 0xB1, 
+0x83, 0x12, 0xD5, 
+
+0x88, 0x7B, 0xF7, 0x59, 0xA5, 0xAD, 0xAB, 0xC9, 
+0xD4, 0x89, 0xA1, 0xA4, 0xBD, 0xC0, 0xDF, 0x23, 
+0xE0, 0xFC, 0x57, 0x1E, 0xFF, 0x96, 0x53, 0xF3, 
+0xA3, 0x19, 0xE9, 0x1E, 0x17, 0x6D, 0x91, 0x4E, 
+0x46, 0x76, 0x8B, 0xDF, 0xE5, 0x9C, 0x14, 0xD8, 
+0xE5, 0xCC, 0xAA, 0xB9, 0xAD, 0x41, 0x58, 0x9B, 
+0x1D, 0xA6, 0xF8, 0xCA, 0x60, 0xDA, 0x61, 0xE5, 
+0x9A, 0x09, 0xA5, 0xA9, 0xD3, 0x9F, 0xCF, 0xF7, 
+0xF5, 0xA5, 0x51, 0x25, 0x2D, 0x5E, 0x96, 0xC1, 
+0x96, 0x40, 0x6B, 0x20, 0x9C, 0x30, 0x95, 0x92, 
+0xB5, 0x55, 0x3F, 0xC0, 0x06, 0x5E, 0x74, 0x9C, 
+0x5C, 0xC8, 0xAA, 0xE2, 0x1E, 0x6B, 0xB8, 0xBA, 
+0x66, 0xD9, 0x69, 0x05, 0x54, 0xD2, 0xCF, 0x4E, 
+0x32, 0xB1, 0xBB, 0xC4, 0xC3, 0x7F, 0xF7, 0x5F, 
+0x33, 0x0C, 0x7E, 0x50, 0x44, 0xD4, 0x1B, 0x0D, 
+0xD5, 0xA2, 0xCA, 0xB4, 0xA1, 0x71, 0xB1, 0x58, 
+0x97, 0xF0, 0x85, 0x7C, 0x7A, 0xEF, 0x6A, 0x40, 
+0xE6, 0x0A, 0x66, 0xA6, 0x72, 0x07, 0xE9, 0xFB, 
+0x74, 0xCA, 0x08, 0xEA, 0xE4, 0x34, 0x80, 0x6E, 
+0xB3, 0x46, 0x2D, 0xAA, 0xAE, 0xA0, 0x14, 0x86, 
+0x26, 0xF5, 0xEA, 0x13, 0xB0, 0x40, 0x25, 0x64, 
+0x00, 0xBE, 0x33, 0x1D, 0xAF, 0x48, 0xE1, 0x19, 
+0x2A, 0x2C, 0xC5, 0x0F, 0x30, 0xAA, 0x3F, 0xE2, 
+0xCD, 0x59, 0x6B, 0x9D, 0x8D, 0x49, 0x4C, 0x9B, 
+0xC6, 0xB2, 0xB5, 0xB1, 0xC8, 0x3F, 0xE8, 0x0E, 
+0xE5, 0xC6, 0x4C, 0x07, 0x48, 0x29, 0x08, 0x79, 
+0x64, 0x4B, 0x4E, 0x6F, 0x7D, 0x86, 0x55, 0x54, 
+0xAF, 0x17, 0xB2, 0x5D, 0x69, 0x7C, 0x0B, 0xAA, 
+0x72, 0x7A, 0x26, 0xD8, 0x45, 0x98, 0x07, 0x7F, 
+0x06, 0x60, 0x5E, 0x66, 0xA1, 0xDE, 0xDC, 0x00, 
+0xA0, 0xD5, 0x2F, 0xEA, 0xAB, 0x04, 0x9E, 0x7F, 
+0xDE, 0xFB, 0x3B, 0xC3, 
+
+0xA6, 0xD0, 0x76, 0xD3, 0x40, 0x8C, 0xED, 0x58, 
+0xD9, 0x46, 0x0C, 0xCC, 0xFC, 0x24, 0xB4, 0xF7, 
+0xCF, 0x2D, 0x38, 0x83, 0x67, 0xCF, 0xBD, 0x87, 
+0x31, 0x51, 0x97, 0x7F, 0x04, 0x3A, 0x1C, 0x82, 
+0x3C, 0xA0, 0xAF, 0xA5, 0x76, 0x38, 0xC6, 0x39, 
+0x05, 0x53, 0xDC, 0x7A, 0x0B, 0x14, 0xF3, 0x91, 
+0x82, 0x94, 0x7B, 0xE2, 0x0E, 0x75, 0x3D, 0x68, 
+0x65, 0x54, 0x10, 0xDA, 0x64, 0xF8, 0x4F, 0x5C, 
+0x2B, 0x47, 0x76, 0xA2, 0x41, 0xE4, 0xBD, 0x58, 
+0x24, 0xA5, 0xC7, 0x05, 0xE5, 0x88, 0xCD, 0x1F, 
+0xEC, 0x41, 0x40, 0xB4, 0x8C, 0xEB, 0xF8, 0xEB, 
+0xC6, 0xF2, 0xB6, 0xF3, 0xDC, 0x84, 0x5C, 0x25, 
+0xD8, 0xEC, 0x2A, 0x1D, 0xB2, 0xA1, 0x8B, 0x27, 
+0xD6, 0xBA, 0x9E, 0x50, 0x7F, 0x82, 0x98, 0x7C, 
+0xAD, 0x58, 0xE1, 0x55, 0x9E, 0x09, 0x02, 0x75, 
+0xF7, 0xCB, 0x00, 0x60, 0xFC, 0x5C, 0xBB, 0xBD, 
+0x7E, 0x76, 0x71, 0x87, 0x7F, 0x08, 0x9A, 0x5E, 
+0xF0, 0x77, 0x18, 0x92, 0x37, 0xE4, 0x32, 0x32, 
+0x32, 0x4B, 0x0C, 0x03, 0x92, 0x8C, 0xD0, 0x2E, 
+0x32, 0x10, 0x28, 0x0D, 0x43, 0x2F, 0x00, 0x79, 
+0xDC, 0x0C, 0x83, 0xEE, 0x56, 0x3D, 0xEF, 0xA2, 
+0x49, 0xF3, 0xD9, 0x6D, 0x23, 0xB1, 0x11, 0x10, 
+0x0A, 0x0B, 0x1C, 0xEF, 0x79, 0x7C, 0xF2, 0x02, 
+0x67, 0x0A, 0x87, 0x88, 0xE5, 0xFD, 0x7B, 0x1C, 
+0xC9, 0x1F, 0xDE, 0x11, 0x10, 0xAE, 0x2E, 0x60, 
+0xE0, 0x38, 0xD0, 0x93, 0x0D, 0xCA, 0x08, 0xF6, 
+0x96, 0xD6, 0xCE, 0xFB, 0x9B, 0xA0, 0xC1, 0xE6, 
+0x19, 0xF0, 0x03, 0x76, 0x2E, 0xDE, 0x1D, 0x64, 
+0x2D, 0x8B, 0xCC, 0x89, 0xD2, 0xB7, 0x45, 0xE2, 
+0xE5, 0x60, 0x26, 0x89, 0xC6, 0x4B, 0x7D, 0xEB, 
+0xA1, 0x65, 0x33, 0xFA, 0xF4, 0x6F, 0xA2, 0x10, 
+0xBB, 0x3F, 0x30, 0x5B, 0x10, 
+
+0x7D, 0x42, 0x7C, 0x26, 0x4E, 0x17, 0x53, 0x2E, 
+0x90, 0x81, 0xF8, 0xE5, 0x31, 0x2B, 0x60, 0x44, 
+0x1E, 0x75, 0xBD, 0x1E, 0x4A, 0x7B, 0x2D, 0xF1, 
+0x2A, 0x4B, 0x1C, 0x2F, 0x7B, 0xCE, 0x28, 0xE4, 
+0x50, 0x7D, 0xB4, 0xEE, 0xBE, 0xEA, 0x89, 0x80, 
+0x9A, 0x8F, 0x8D, 0xAD, 0xB6, 0xBE, 0x11, 0xCC, 
+0x61, 0xA9, 0x8C, 0x56, 0xD3, 0x6A, 0x9E, 0x05, 
+0xDA, 0x10, 0xC3, 0x1F, 0x1B, 0x7D, 0x33, 0xAB, 
+0x53, 0x08, 0x10, 0x14, 0x3A, 0xF2, 0xD7, 0x4F, 
+0xC9, 0x58, 0xAB, 0x2B, 0x8F, 0xE5, 0xE0, 0x1F, 
+0x46, 0x3D, 0x05, 0xCC, 0x60, 0xCF, 0xF9, 0x74, 
+0x06, 0x8D, 0xC0, 0x52, 0xBF, 0xE1, 0x80, 0x42, 
+0xB2, 0xF2, 0x90, 0x3E, 0xC6, 0x2C, 0x06, 0x96, 
+0x2C, 0xB0, 0x88, 0x79, 0xFE, 0xF9, 0x42, 0xA1, 
+0xCE, 0x66, 0x97, 0xD6, 0x9C, 0x99, 0xD5, 0x1E, 
+0x94, 0xB9, 0x97, 0x5F, 0x08, 0x71, 0x92, 0x93, 
+0x21, 0xEE, 0x0E, 0xE8, 0x58, 0x74, 0x21, 0xE9, 
+0x77, 0x9A, 0x7F, 0x77, 0x96, 0xC1, 0x60, 0xFD, 
+0x87, 0x26, 0xE3, 0xB0, 0x85, 0xA0, 0xD5, 0xB3, 
+0x01, 0x86, 0x73, 0x1A, 0xEF, 0x4B, 0xF8, 0xDB, 
+0x30, 0x7F, 0xF5, 0xE7, 0xB0, 0x5B, 0x39, 0xC9, 
+0xC3, 0x4B, 0xDF, 0x35, 0x4B, 0x7E, 0xCE, 0x80, 
+0x60, 0xCA, 0x19, 0xFB, 0x6D, 0x7D, 0xB9, 0x37, 
+0xE8, 0xA4, 0xBE, 0xEF, 0xF5, 0x5F, 0x2A, 0x86, 
+0x5F, 0x54, 0x56, 0x5D, 0x97, 0x31, 0x62, 0x31, 
+0x1B, 0x0A, 0x68, 0x8B, 0x16, 0x52, 0x10, 0x30, 
+0x75, 0x06, 0x92, 0x78, 0x6B, 0x82, 0xE2, 0x2D, 
+0x07, 0xF1, 0xFC, 0x42, 0xAA, 0x4D, 0x3E, 0xCE, 
+0x65, 0x0E, 0xED, 0x5E, 0x02, 0xC8, 0x45, 0x63, 
+0xB8, 0xF9, 0x01, 0xB8, 0x02, 0x66, 0x9B, 0x10, 
+0x45, 0x91, 0x5B, 0x5C, 0x68, 0x8F, 0xCB, 0xD8, 
+0x72, 0x57, 0x01, 0xA4, 0x2C, 0x21, 
+
+0xFB, 0x19, 0x3B, 0xE1, 0x08, 0x68, 0x26, 0x3B, 
+0xD1, 0x33, 0xB0, 0x07, 0x75, 0xCC, 0x6E, 0x8C, 
+0x00, 0x6C, 0x24, 0xCE, 0x08, 0x04, 0x0C, 0xF3, 
+0x59, 0xC7, 0xFF, 0xDB, 0x2C, 0x12, 0xC8, 0x62, 
+0x12, 0xC7, 0x0F, 0x12, 0x82, 0xB9, 0xBE, 0x41, 
+0x54, 0x28, 0x76, 0x72, 0xA8, 0xD9, 0xF7, 0x2A, 
+0x78, 0x3D, 0x68, 0xD9, 0xD1, 0xF5, 0x40, 0x9A, 
+0x9B, 0xEA, 0xD2, 0xCB, 0x56, 0xBB, 0x45, 0x09, 
+0x90, 0x96, 0xF6, 0x80, 0x44, 0x64, 0xA3, 0x30, 
+0xC3, 0x52, 0x8E, 0x47, 0x1A, 0x76, 0xCE, 0x18, 
+0x05, 0x0B, 0x2B, 0xA2, 0xE1, 0xEC, 0xA8, 0x95, 
+0x6A, 0x71, 0x21, 0xEF, 0xEC, 0xC4, 0x37, 0x8A, 
+0x58, 0x50, 0x29, 0xD8, 0xE4, 0x8F, 0x70, 0xFE, 
+0xE4, 0x09, 0xB4, 0xE4, 0x00, 0xCF, 0x30, 0x8B, 
+0x40, 0x38, 0x42, 0x95, 0xFE, 0x93, 0x48, 0x36, 
+0xBE, 0x50, 0x47, 0xED, 0xE0, 0x40, 0x8B, 0xCC, 
+0x7B, 0xA9, 0xE4, 0x87, 0xA2, 0x6D, 0x64, 0x3B, 
+0x7A, 0x0A, 0x73, 0x56, 0xD0, 0xB3, 0xF6, 0x66, 
+0xDF, 0x44, 0x0E, 0xFC, 0x69, 0x96, 0xAC, 0x61, 
+0xB0, 0x6A, 0x7D, 0x06, 0xB7, 0xD0, 0x5C, 0x68, 
+0x8C, 0x52, 0xD7, 0x30, 0xFC, 0x73, 0x6C, 0x70, 
+0x3D, 0x77, 0x87, 0xD3, 0xF3, 0x01, 0x91, 0x02, 
+0x8D, 0xC1, 0x98, 0xF4, 0xC3, 0xEF, 0xB4, 0x96, 
+0xD3, 0x35, 0xE6, 0x03, 0x35, 0xCD, 0x10, 0x52, 
+0x39, 0xCA, 0x09, 0xF5, 0xF8, 0xAF, 0x16, 0x93, 
+0x2A, 0xA5, 0x66, 0x3C, 0xB3, 0x61, 0x16, 0x46, 
+0x56, 0x37, 0x92, 0xA0, 0x44, 0x90, 0x85, 0xC9, 
+0xD8, 0x05, 0xE0, 0xF1, 0x91, 0x02, 0x2F, 0x14, 
+0x35, 0xC3, 0x3B, 0x1A, 0xFF, 0xD4, 0xE5, 0x78, 
+0x36, 0x5B, 0x11, 0xD3, 0x88, 0x1F, 0x6C, 0x28, 
+0x5C, 0x91, 0xC1, 0xC7, 0xE7, 0x13, 0x57, 0xE1, 
+0x32, 0x85, 0xB6, 0x98, 0xD6, 0x36, 0x99, 
+
+0xB2, 0xE7, 0x67, 0x99, 0x8C, 0x36, 0xE6, 0x02, 
+0xA4, 0xA8, 0xCA, 0x7C, 0x29, 0xF4, 0xE0, 0xC6, 
+0x35, 0x27, 0xE6, 0xC8, 0xC2, 0x67, 0x21, 0x94, 
+0x4A, 0xD5, 0x52, 0x42, 0x50, 0x51, 0x3C, 0xB5, 
+0xAD, 0x14, 0x52, 0x7B, 0x08, 0x92, 0x8E, 0x67, 
+0x12, 0x5E, 0xF2, 0x95, 0xA4, 0xC7, 0xB9, 0xE5, 
+0x11, 0x06, 0x86, 0xBE, 0x96, 0x68, 0x92, 0xDD, 
+0xE7, 0xE2, 0x3F, 0x4F, 0xB7, 0xEA, 0x05, 0x34, 
+0x8E, 0xAB, 0xEA, 0x77, 0x52, 0x45, 0x1F, 0xEB, 
+0xC7, 0xB7, 0xD2, 0x1F, 0x45, 0xC1, 0x94, 0x2F, 
+0x68, 0x53, 0x5F, 0x39, 0xE0, 0x7E, 0xE0, 0xA4, 
+0xE4, 0x62, 0xAD, 0x42, 0x86, 0xAA, 0xD2, 0xD9, 
+0xCF, 0x5E, 0xC1, 0xBF, 0x61, 0x59, 0xD4, 0x1C, 
+0x1C, 0x82, 0xCC, 0x09, 0xF4, 0xB3, 0x4B, 0x37, 
+0x81, 0x86, 0x20, 0x9C, 0x42, 0x43, 0xA6, 0xEA, 
+0xA5, 0x83, 0x65, 0x4A, 0x08, 0xBB, 0xCC, 0xC5, 
+0x9F, 0x9F, 0xBE, 0x10, 0x50, 0xFB, 0xEF, 0x6B, 
+0x2B, 0xAF, 0x37, 0xA4, 0xC8, 0x6F, 0xCA, 0x01, 
+0x2A, 0xCC, 0xB4, 0xAD, 0x11, 0xB0, 0x47, 0x59, 
+0xB3, 0x3C, 0x93, 0x51, 0xAA, 0x41, 0x7E, 0x7C, 
+0x92, 0x6D, 0xD2, 0x04, 0xEB, 0x39, 0xBA, 0x9F, 
+0xB0, 0xA2, 0xE7, 0xD3, 0x7F, 0x51, 0x5E, 0x74, 
+0x5B, 0xEF, 0x22, 0xB7, 0xD1, 0xEA, 0xCF, 0xAD, 
+0xB4, 0x75, 0x22, 0xEC, 0x98, 0xEF, 0x2C, 0x3A, 
+0x23, 0xA2, 0x35, 0x6D, 0xD2, 0xD6, 0x7C, 0xD3, 
+0xAF, 0x6D, 0xA4, 0x98, 0x1E, 0x49, 0xC0, 0x88, 
+0x4E, 0x0F, 0xCC, 0x6A, 0x4A, 0x5D, 0x66, 0x75, 
+0xC9, 0xF4, 0xB5, 0x2A, 0xB7, 0x71, 0xF8, 0x76, 
+0x6A, 0xF8, 0x3E, 0x59, 0xE9, 0xC3, 0xDF, 0x8E, 
+0xB9, 0x73, 0x2C, 0x4F, 0x31, 0x77, 0x37, 0x51, 
+0xFB, 0x4A, 0xC4, 0x3E, 0xE1, 0xA3, 0x91, 0x18, 
+0xA3, 0x67, 0x14, 0xB8, 0xC3, 0x2E, 0x69, 0xE1, 
+
+0xDF, 0x82, 0x7D, 0x52, 0x57, 0x7B, 0x5B, 0x8F, 
+0x80, 0x7D, 0x4C, 0x0C, 0xB6, 0x3D, 0x6B, 0xBB, 
+0x18, 0x30, 0xF7, 0x30, 0x70, 0x02, 0xF3, 0x35, 
+0xB5, 0xDE, 0x1A, 0x1A, 0x6B, 0xC9, 0x43, 0x6E, 
+0xF9, 0x20, 0x25, 0x02, 0xB4, 0xF4, 0x38, 0x1E, 
+0xB0, 0x9E, 0x57, 0x4B, 0x28, 0xEB, 0x44, 0x5A, 
+0xA7, 0x83, 0xA8, 0xB6, 0x79, 0x84, 0xF7, 0xC4, 
+0x35, 0x3E, 0x74, 0x17, 0x33, 0xA7, 0x82, 0x49, 
+0xED, 0x08, 0xAD, 0x56, 0xE2, 0x67, 0x56, 0x97, 
+0x4B, 0x87, 0x71, 0x3F, 0x95, 0x12, 0xB5, 0xE9, 
+0x81, 0xD8, 0xCE, 0xBF, 0x0E, 0x41, 0x55, 0x90, 
+0x84, 0xE3, 0x85, 0x90, 0xF7, 0xFD, 0x2C, 0xCF, 
+0x7B, 0xEA, 0xF1, 0xA6, 0x0E, 0xE7, 0xE0, 0x61, 
+0xD3, 0xB8, 0xD0, 0x91, 0xB0, 0x51, 0x0C, 0x75, 
+0x65, 0x79, 0xED, 0x8F, 0x65, 0xC2, 0x7D, 0xE3, 
+0xBA, 0xA4, 0x4E, 0x43, 0xBB, 0x7E, 0xD7, 0xF4, 
+0x40, 0x90, 0x49, 0x7D, 0x79, 0xC5, 0xD1, 0xE6, 
+0x0A, 0x06, 0x46, 0x25, 0xDD, 0xEC, 0xB2, 0x51, 
+0x4A, 0xD3, 0xF7, 0x78, 0x5B, 0xFC, 0xF0, 0x37, 
+0xB3, 0xF8, 0x5E, 0x1D, 0x02, 0x41, 0x8F, 0x6D, 
+0x47, 0x6B, 0x2C, 0x00, 0x27, 0x06, 0x76, 0x2D, 
+0x14, 0xE6, 0xEB, 0x0C, 0x97, 0xA1, 0x65, 0xB6, 
+0x84, 0xF2, 0xCA, 0x9E, 0xC3, 0x3B, 0x4A, 0x86, 
+0x30, 0x52, 0x42, 0x99, 0x67, 0xC7, 0xCD, 0x80, 
+0xA7, 0x46, 0xA2, 0x96, 0x06, 0x59, 0xE7, 0xAF, 
+0x98, 0x47, 0xF6, 0x66, 0x74, 0x30, 0x48, 0x83, 
+0x31, 0x15, 0x77, 0x1F, 0x0D, 0x8F, 0x8D, 0xDA, 
+0x90, 0xB3, 0x0A, 0x4C, 0x1E, 0x81, 0xC3, 0x2E, 
+0xD8, 0x6E, 0x25, 0x1D, 0x1B, 0xE4, 0x00, 0x83, 
+0x71, 0x31, 0xC3, 0xE6, 0x6F, 0x5C, 0xB3, 0x49, 
+0xFC, 0xD1, 0x76, 0x2A, 0x56, 0xDC, 0xC8, 0xC6, 
+0xED, 0x00, 0xF7, 0x97, 0x51, 0xC5, 0x84, 0xEB, 
+0x87, 
+
+0x7E, 0x35, 0x78, 0x27, 0xD3, 0xE0, 0x1F, 0x6B, 
+0xA9, 0xFB, 0x79, 0xCE, 0xFA, 0xB4, 0xB5, 0xA7, 
+0x22, 0xF0, 0x7D, 0x09, 0xEA, 0xA9, 0x19, 0x95, 
+0xC0, 0xC4, 0x02, 0xCC, 0x2E, 0x81, 0x06, 0xD3, 
+0xF9, 0x5E, 0x6B, 0x34, 0xDB, 0x06, 0x26, 0xDD, 
+0xE8, 0xFC, 0x6F, 0xE5, 0x2D, 0x93, 0x91, 0x47, 
+0xAC, 0x4B, 0x39, 0xF3, 0x48, 0x40, 0xD7, 0x19, 
+0x5C, 0x1C, 0xB6, 0x38, 0x64, 0x45, 0xEA, 0xB2, 
+0x45, 0x24, 0x4A, 0xA2, 0x53, 0xE7, 0x4F, 0xE7, 
+0xAE, 0xBE, 0x26, 0x0E, 0xF9, 0x8C, 0xC8, 0xF0, 
+0xCE, 0xDA, 0x35, 0xEB, 0x07, 0x16, 0xD6, 0x32, 
+0xF8, 0xFD, 0x02, 0x87, 0xEF, 0xAC, 0x09, 0x28, 
+0xC0, 0x2E, 0x1C, 0x3E, 0xEC, 0xBF, 0xDD, 0xCC, 
+0x66, 0x0E, 0xC6, 0xE1, 0xF7, 0xC2, 0xA3, 0xB6, 
+0x0E, 0x8D, 0xB2, 0xC0, 0x1C, 0x81, 0x6B, 0x46, 
+0x8F, 0xF2, 0xBE, 0xA0, 0x05, 0x0F, 0x52, 0x54, 
+0x08, 0x5F, 0xB9, 0xA3, 0x95, 0x87, 0x3A, 0xD1, 
+0x72, 0x57, 0x71, 0xCE, 0xD7, 0x78, 0xE1, 0x52, 
+0x06, 0x12, 0xF8, 0x43, 0xCE, 0xFF, 0xA7, 0xBE, 
+0xD3, 0x69, 0x0D, 0x1F, 0xBA, 0x27, 0xA4, 0xD7, 
+0x40, 0x37, 0x23, 0x9E, 0xF9, 0xB6, 0xDD, 0xB5, 
+0x02, 0x1D, 0xA9, 0x31, 0xA3, 0x9B, 0x58, 0x42, 
+0xC1, 0x17, 0xC4, 0x9A, 0xF6, 0x80, 0x4D, 0x14, 
+0x97, 0xE4, 0xEC, 0x3A, 0xEC, 0xB0, 0xFF, 0x95, 
+0x11, 0x7A, 0x1C, 0xAD, 0x21, 0x79, 0xF7, 0x84, 
+0xBB, 0xA0, 0x5E, 0x45, 0x49, 0x03, 0xE1, 0x7E, 
+0xB3, 0xEB, 0x84, 0x43, 0xF4, 0x2B, 0xEE, 0xAB, 
+0xDC, 0x7B, 0x44, 0x11, 0x9C, 0x73, 0xEA, 0x07, 
+0x5A, 0xCC, 0x1D, 0x28, 0x3B, 0xA2, 0x76, 0xE3, 
+0x3F, 0x74, 0x9E, 0x31, 0xF8, 0x9B, 0xFC, 0x09, 
+0x8A, 0x4E, 0x80, 0x9F, 0xFA, 0xCA, 0x55, 0xE0, 
+0xAF, 0x4D, 0x85, 0xE1, 0x72, 0x19, 0x44, 0xA0, 
+0x50, 0xBD, 
+
+0x00, 0x4B, 0x3A, 0x83, 0xC2, 0x5C, 0x5D, 0x53, 
+0xA3, 0x3C, 0xD5, 0x0C, 0x28, 0x6F, 0xEF, 0x36, 
+0xBB, 0x52, 0x11, 0xB5, 0x8C, 0xBD, 0xF9, 0xA8, 
+0xBA, 0xC3, 0x5D, 0x9D, 0x3A, 0x96, 0xEF, 0x06, 
+0x98, 0x31, 0x1B, 0x46, 0x0A, 0xE3, 0xBF, 0xA2, 
+0x46, 0x07, 0x40, 0xDB, 0x03, 0xA9, 0x72, 0xEE, 
+0x1A, 0xEF, 0x04, 0xEE, 0x52, 0xDC, 0xDE, 0x4B, 
+0x48, 0xEC, 0x34, 0xED, 0xD9, 0xA4, 0xFB, 0x3D, 
+0x0E, 0xE0, 0x05, 0x69, 0x34, 0xB9, 0xC7, 0x96, 
+0x93, 0xBA, 0x5A, 0xA1, 0x19, 0x42, 0xFC, 0x77, 
+0x13, 0x36, 0x73, 0x1E, 0x34, 0x4C, 0x0E, 0x4A, 
+0xB9, 0x81, 0xC7, 0xFC, 0x9F, 0x89, 0x64, 0x0B, 
+0xCA, 0xC5, 0x66, 0x8F, 0x08, 0x80, 0x5B, 0x01, 
+0xED, 0xDE, 0x05, 0xA6, 0x10, 0xCF, 0xC4, 0x9D, 
+0x53, 0x9B, 0x59, 0x9F, 0x9E, 0xC9, 0xBF, 0x27, 
+0xB5, 0x3A, 0xEE, 0xED, 0xCF, 0xFA, 0xC0, 0x0E, 
+0x67, 0xA3, 0x64, 0x02, 0x70, 0x2C, 0xB7, 0x17, 
+0x2E, 0x09, 0xBB, 0x60, 0x7A, 0xB7, 0x99, 0x85, 
+0x43, 0x05, 0xC5, 0x9A, 0x15, 0xF6, 0xA9, 0x07, 
+0x6D, 0xBE, 0x6C, 0x2E, 0x52, 0x37, 0x7F, 0xCB, 
+0x1D, 0x51, 0x80, 0x68, 0x4F, 0x7F, 0x29, 0x5E, 
+0xB5, 0x02, 0x07, 0xCF, 0x62, 0xB1, 0x5D, 0x2D, 
+0x4D, 0xB3, 0x1F, 0xC5, 0xF1, 0x85, 0x09, 0xE5, 
+0x6D, 0xD6, 0xE0, 0xA9, 0x0E, 0x9D, 0xDC, 0x1E, 
+0x52, 0x44, 0xEB, 0x05, 0xCE, 0x73, 0xEC, 0x30, 
+0x7E, 0xF8, 0xA0, 0x61, 0x00, 0x87, 0x7A, 0x11, 
+0xBF, 0x2C, 0x2D, 0x58, 0xFD, 0x5F, 0x48, 0x19, 
+0x50, 0x86, 0x0E, 0xA5, 0xBC, 0x99, 0xA8, 0x51, 
+0x92, 0xD3, 0x58, 0x54, 0x5F, 0x40, 0xAC, 0x1D, 
+0x40, 0x76, 0xC3, 0x02, 0xBB, 0xD5, 0x4B, 0xA7, 
+0xDC, 0x3B, 0x8A, 0x99, 0xEC, 0xB2, 0x13, 0xF2, 
+0x31, 0x5B, 0x58, 0x03, 0xD0, 0xD2, 0xA3, 0x17, 
+0x84, 0x55, 0x4C, 
+
+0xEE, 0xF5, 0x23, 0x06, 0x97, 0x13, 0xEC, 0x0D, 
+0xA6, 0x0D, 0xAD, 0xB9, 0xE1, 0x9F, 0x07, 0x31, 
+0x8D, 0x09, 0xAC, 0x3C, 0x16, 0x28, 0xBD, 0xD7, 
+0xD1, 0x00, 0xEC, 0xF1, 0x82, 0x3E, 0xAE, 0xC6, 
+0x9F, 0xBD, 0x80, 0x62, 0x17, 0xFF, 0xDE, 0xF5, 
+0xA4, 0x68, 0xDB, 0x00, 0x1B, 0xCB, 0x84, 0xF8, 
+0x7F, 0x5C, 0x2B, 0x44, 0x1E, 0x75, 0xD2, 0x0E, 
+0xB5, 0xDC, 0x13, 0xB9, 0x3B, 0xAB, 0xC8, 0xFE, 
+0xD0, 0xE7, 0x8F, 0xFF, 0x51, 0x82, 0x60, 0xBA, 
+0xE3, 0x72, 0x96, 0xE5, 0x9C, 0xEC, 0x55, 0x4B, 
+0x28, 0x83, 0x78, 0x63, 0x89, 0x43, 0x6B, 0x8B, 
+0xEE, 0xFF, 0x45, 0x2D, 0x9B, 0x37, 0x4E, 0x6A, 
+0x31, 0xA0, 0xA4, 0xA1, 0x09, 0x7D, 0xA4, 0xA7, 
+0xFA, 0xD1, 0x93, 0x2A, 0xCA, 0x67, 0xA6, 0x18, 
+0xEB, 0x00, 0x76, 0x1A, 0xAA, 0x49, 0x9B, 0x52, 
+0xD0, 0x4A, 0x73, 0x31, 0x0F, 0xD9, 0xA2, 0x36, 
+0xD9, 0x05, 0x46, 0x68, 0x23, 0xDA, 0x7B, 0xB1, 
+0x6C, 0xB6, 0x99, 0x52, 0xFC, 0xD8, 0x67, 0x65, 
+0xE0, 0x5D, 0x27, 0x6F, 0x06, 0x08, 0x18, 0xDC, 
+0xF7, 0xD8, 0x37, 0x34, 0xC4, 0xC2, 0xCE, 0xAC, 
+0x84, 0xC2, 0x35, 0xD1, 0x96, 0x9E, 0x14, 0x7F, 
+0x12, 0x41, 0x8A, 0x0E, 0x0A, 0x1B, 0x5D, 0x60, 
+0xBD, 0x83, 0xAD, 0x25, 0x5C, 0xFD, 0xA3, 0xA0, 
+0x8E, 0x10, 0xCB, 0xDB, 0xDA, 0xCC, 0xF7, 0x06, 
+0x57, 0xC3, 0xC1, 0x33, 0x86, 0x51, 0x50, 0xDB, 
+0xBD, 0x42, 0xE6, 0xDA, 0x34, 0x73, 0x5A, 0x9F, 
+0x08, 0x2E, 0xC5, 0xBD, 0xA1, 0x10, 0xFC, 0x55, 
+0x77, 0xBC, 0xE7, 0xEA, 0x08, 0x0A, 0xC9, 0xA5, 
+0x12, 0xD0, 0xD2, 0x76, 0x27, 0x34, 0x20, 0x5F, 
+0xD3, 0xF4, 0x1C, 0x5C, 0x84, 0x4C, 0x3B, 0xC2, 
+0xB8, 0x14, 0xD5, 0x70, 0xAC, 0xE6, 0xD1, 0x6C, 
+0x2E, 0xAC, 0xCF, 0x65, 0x19, 0x0F, 0x63, 0x33, 
+0x01, 0x16, 0xC8, 0xE5, 
+
+0x11, 0xE2, 0x38, 0x11, 0x1B, 0x7F, 0x52, 0xC9, 
+0x15, 0xDC, 0xD6, 0xCA, 0x29, 0xDF, 0x64, 0x42, 
+0xE5, 0xBC, 0x45, 0x58, 0x3C, 0x06, 0x5E, 0x6E, 
+0x8D, 0x04, 0x41, 0x93, 0x42, 0xF8, 0x3C, 0x65, 
+0x17, 0xB6, 0x80, 0xF0, 0x07, 0xF8, 0x35, 0xAC, 
+0x55, 0x5F, 0xEC, 0xE9, 0x43, 0xD2, 0x72, 0x1B, 
+0xC3, 0x6D, 0x3B, 0x31, 0x81, 0xA8, 0x71, 0x0F, 
+0x50, 0xCB, 0x07, 0xA3, 0x3F, 0x3D, 0x9E, 0x93, 
+0x5F, 0xEC, 0xF2, 0x70, 0x85, 0xD6, 0xC1, 0xB2, 
+0x5E, 0x3D, 0x40, 0x27, 0xAA, 0x6A, 0xEB, 0x06, 
+0x40, 0xD3, 0x57, 0x9C, 0x58, 0x7C, 0x61, 0xB9, 
+0x96, 0x5E, 0x21, 0x93, 0x86, 0x88, 0xE5, 0xAE, 
+0x97, 0x3A, 0x6D, 0x88, 0x18, 0x54, 0xA9, 0xA7, 
+0xAA, 0xFA, 0x3E, 0x24, 0x53, 0x87, 0x3D, 0x2D, 
+0xE0, 0x61, 0xFA, 0x49, 0x57, 0xAD, 0x51, 0xD3, 
+0xD2, 0xA4, 0x7B, 0x7D, 0x42, 0x23, 0x75, 0xF9, 
+0x40, 0xF9, 0xB6, 0xDE, 0xDD, 0x8C, 0x89, 0x71, 
+0x97, 0x18, 0xE4, 0xB4, 0x21, 0x25, 0x35, 0x33, 
+0x3A, 0x0F, 0x83, 0x15, 0x61, 0x05, 0x10, 0x86, 
+0x59, 0x12, 0x87, 0xED, 0x1C, 0xD4, 0xA1, 0xB8, 
+0xF5, 0xC6, 0x58, 0xC0, 0xCD, 0xAE, 0x04, 0x2C, 
+0x8B, 0x17, 0x10, 0x41, 0x9F, 0xD1, 0xF3, 0x38, 
+0x4D, 0xE3, 0x9D, 0x40, 0xEC, 0x36, 0x4C, 0x45, 
+0x8B, 0x1D, 0x09, 0xFE, 0xB1, 0xB2, 0x26, 0x54, 
+0xE3, 0x09, 0x58, 0xDC, 0x89, 0xBF, 0xAC, 0xA3, 
+0x78, 0x36, 0x66, 0x53, 0x0C, 0xA9, 0xAB, 0xD2, 
+0xD7, 0x08, 0x4A, 0x62, 0xFC, 0xCC, 0x20, 0xDA, 
+0x33, 0x8F, 0xA8, 0x4A, 0x12, 0x04, 0xA6, 0x92, 
+0xCF, 0x36, 0x40, 0xFF, 0x80, 0x7B, 0xB2, 0x5B, 
+0x47, 0xE6, 0x1F, 0xF6, 0x11, 0x94, 0xFE, 0xD9, 
+0x36, 0xD5, 0x02, 0x01, 0x1D, 0x72, 0xD2, 0x08, 
+0x92, 0x3C, 0x06, 0x2F, 0xE2, 0xEC, 0x11, 0xA1, 
+0xBC, 0x0C, 0x0A, 0x94, 0x0E, 
+
+0x95, 0x80, 0xF8, 0xC1, 0x60, 0x84, 0x20, 0xC9, 
+0x4C, 0x48, 0x63, 0x2F, 0x6A, 0x6D, 0x1C, 0x08, 
+0x5E, 0x3B, 0xFF, 0x62, 0xE5, 0xFC, 0xB2, 0xFA, 
+0xB2, 0x69, 0x7F, 0x87, 0x65, 0xFB, 0x92, 0x87, 
+0xF1, 0x55, 0x23, 0xCE, 0x8B, 0x1B, 0x3D, 0xCC, 
+0x08, 0xD1, 0x57, 0x16, 0x81, 0x3F, 0xB7, 0xEB, 
+0x74, 0xC0, 0xB9, 0xEC, 0x3F, 0xD2, 0x56, 0x76, 
+0x3D, 0x6A, 0x79, 0xE8, 0x2B, 0xF7, 0xBF, 0xE2, 
+0x1D, 0xE2, 0xBF, 0x7B, 0x1E, 0x0E, 0xC2, 0xB3, 
+0xF4, 0x97, 0x54, 0xB3, 0x0B, 0x4A, 0x03, 0x37, 
+0xB8, 0x29, 0xEE, 0x5A, 0x23, 0xF1, 0x61, 0x7C, 
+0x30, 0xCA, 0xCC, 0x8E, 0x73, 0x52, 0xE4, 0x55, 
+0xDE, 0x50, 0xEC, 0xF7, 0x9C, 0xAA, 0x19, 0x4F, 
+0x19, 0x1D, 0xE0, 0x79, 0x07, 0x1D, 0x64, 0x41, 
+0x0D, 0x2D, 0xB1, 0xF3, 0xB0, 0x67, 0x3C, 0xCD, 
+0xA2, 0x92, 0x19, 0x2C, 0x1C, 0xE0, 0x2E, 0xC3, 
+0x07, 0x21, 0x3B, 0x19, 0x0D, 0x6B, 0x64, 0x06, 
+0x6C, 0x79, 0x6D, 0x32, 0xF7, 0xD6, 0x43, 0x4F, 
+0xFB, 0x55, 0x03, 0xCC, 0x82, 0x45, 0x2B, 0x24, 
+0x9C, 0xBC, 0x1D, 0x47, 0xE5, 0x09, 0x84, 0x64, 
+0x5D, 0xC8, 0x33, 0xDD, 0x6B, 0xC3, 0xE8, 0x43, 
+0xF8, 0xA8, 0x2D, 0x18, 0xF0, 0x75, 0x02, 0xD5, 
+0x2A, 0xFD, 0x9B, 0xD7, 0x4E, 0x31, 0x53, 0xB8, 
+0xFF, 0x61, 0x1E, 0xF5, 0x65, 0xB3, 0x73, 0xDF, 
+0xCF, 0xBE, 0x7F, 0x3F, 0xC2, 0x3A, 0x0B, 0x95, 
+0x76, 0x21, 0x0A, 0xEA, 0x04, 0x38, 0x3C, 0xC7, 
+0xBF, 0xEF, 0xFD, 0x37, 0x72, 0xE6, 0xE1, 0x62, 
+0x65, 0x9D, 0xF3, 0xC9, 0x51, 0xA0, 0x2F, 0xC3, 
+0x6D, 0xF7, 0x25, 0x03, 0xB4, 0x51, 0x27, 0x25, 
+0xB2, 0x8C, 0x02, 0x6D, 0x5C, 0x34, 0xC9, 0xE2, 
+0x1A, 0xED, 0xE1, 0xD8, 0x72, 0x8C, 0xA5, 0x6C, 
+0xD3, 0x1B, 0xA4, 0x44, 0xEA, 0xD7, 0x0B, 0x42, 
+0xC2, 0xF9, 0xB7, 0xF2, 0x21, 0xD6, 
+
+0x7E, 0xF7, 0xC8, 0xF5, 0x7B, 0x4E, 0xED, 0x5A, 
+0xA6, 0x97, 0x8F, 0x56, 0xBB, 0x93, 0x1F, 0x77, 
+0x4C, 0x55, 0x25, 0xD1, 0x45, 0x30, 0xA4, 0xF2, 
+0x9F, 0xE4, 0x72, 0x9C, 0x02, 0x57, 0xDA, 0xC8, 
+0x12, 0x27, 0x5B, 0x98, 0x3E, 0x0D, 0x34, 0x70, 
+0xB6, 0x3D, 0xC7, 0x3A, 0x67, 0xAC, 0x7E, 0xDF, 
+0x19, 0x8B, 0x15, 0xCB, 0x5A, 0x99, 0xDD, 0xCD, 
+0xD0, 0xCE, 0xBF, 0xC4, 0xD6, 0x94, 0x3D, 0x84, 
+0x30, 0x28, 0x90, 0x4C, 0xB0, 0x83, 0xFB, 0x6B, 
+0x1C, 0x6B, 0xA8, 0xFF, 0xDF, 0x34, 0x68, 0xF8, 
+0x77, 0x5B, 0xF2, 0x71, 0xEB, 0x4D, 0x36, 0xD3, 
+0xC5, 0xD7, 0x0E, 0xFE, 0x46, 0xB1, 0xEE, 0xCF, 
+0xE0, 0xE9, 0xC5, 0x54, 0x0F, 0xF5, 0x5D, 0x60, 
+0x99, 0x72, 0xDE, 0x39, 0xE7, 0x21, 0xF3, 0xF9, 
+0x36, 0x4D, 0x59, 0xCB, 0xE2, 0xBD, 0x51, 0xCC, 
+0xF2, 0xBC, 0xD9, 0x25, 0x4A, 0x71, 0x42, 0x01, 
+0x8B, 0x70, 0x99, 0x46, 0x89, 0x91, 0x91, 0x0C, 
+0xEE, 0x11, 0x0F, 0x31, 0x47, 0xF0, 0x82, 0x04, 
+0x69, 0x7C, 0x4F, 0x9C, 0xCC, 0x12, 0x44, 0x5C, 
+0x09, 0x43, 0x8C, 0x86, 0xF9, 0x42, 0x18, 0xDE, 
+0x5E, 0x12, 0x06, 0x4B, 0x33, 0xCD, 0x06, 0x74, 
+0xD0, 0x4C, 0x81, 0x5E, 0x27, 0xA8, 0x70, 0xEB, 
+0x38, 0x83, 0x6B, 0xCF, 0x0A, 0x7A, 0xF7, 0xB0, 
+0x26, 0x68, 0x80, 0x0F, 0xF5, 0x31, 0xEC, 0xE7, 
+0x7E, 0xFF, 0xCE, 0x63, 0xCE, 0x33, 0x14, 0x1D, 
+0xB2, 0x9F, 0x66, 0x9A, 0x3E, 0xDA, 0x91, 0xA2, 
+0x5A, 0x93, 0xA2, 0xEA, 0x08, 0x1D, 0x78, 0xD3, 
+0x4E, 0x7B, 0xED, 0x96, 0xEB, 0x7A, 0x0B, 0xC5, 
+0xD4, 0x89, 0x56, 0x5B, 0xBB, 0xC9, 0xDF, 0xF4, 
+0x83, 0x7A, 0x6C, 0x0B, 0x42, 0x6B, 0x43, 0x01, 
+0x44, 0x14, 0x3C, 0xE2, 0x76, 0xBC, 0x33, 0xBB, 
+0x7A, 0xA0, 0xFC, 0x85, 0x41, 0xFA, 0xFB, 0xFE, 
+0xB8, 0x88, 0xB0, 0xFA, 0x2A, 0x95, 0x83, 
+
+0x54, 0xC8, 0xAD, 0xFE, 0x3E, 0xDE, 0xB9, 0xB6, 
+0x8B, 0xB0, 0x9E, 0xC6, 0x14, 0x53, 0x5F, 0x08, 
+0xE0, 0x00, 0x01, 0x74, 0x0C, 0x5A, 0x98, 0x5A, 
+0x26, 0xEE, 0x2A, 0x3F, 0x4A, 0x6B, 0x37, 0xD5, 
+0x03, 0x5A, 0x3C, 0xBF, 0xE8, 0xF4, 0x51, 0x85, 
+0xD8, 0x02, 0x78, 0x3C, 0xC9, 0xB5, 0xFD, 0x66, 
+0x00, 0x5E, 0x7D, 0xB8, 0x50, 0xED, 0x48, 0x41, 
+0x9F, 0xC4, 0x26, 0x6D, 0x9A, 0x33, 0x55, 0x4B, 
+0x03, 0x0C, 0x23, 0xDF, 0x00, 0x63, 0xD3, 0x05, 
+0xC7, 0x75, 0xD3, 0x2D, 0x5A, 0x50, 0xE7, 0x74, 
+0x11, 0xE9, 0x01, 0x52, 0x3A, 0x2B, 0x43, 0xAE, 
+0x2E, 0x15, 0x00, 0x20, 0x26, 0x9D, 0x05, 0x83, 
+0x96, 0x65, 0x23, 0x9D, 0x6D, 0xBF, 0x08, 0x56, 
+0x8F, 0xFA, 0xC0, 0xC6, 0x68, 0x38, 0x57, 0x0F, 
+0x9D, 0xDC, 0x3A, 0x57, 0x83, 0x01, 0xC3, 0xAA, 
+0x1F, 0x4E, 0x9D, 0x4E, 0x5C, 0x17, 0x76, 0xD3, 
+0xE7, 0x14, 0x9C, 0x2E, 0x65, 0xC4, 0x5E, 0x27, 
+0x95, 0xCF, 0xE7, 0x05, 0xCA, 0xC2, 0x30, 0x80, 
+0xFA, 0x42, 0x32, 0xA4, 0xE6, 0xB7, 0x77, 0x46, 
+0xA1, 0x3D, 0x4E, 0xE8, 0xEF, 0xEA, 0x79, 0x5B, 
+0xC4, 0x72, 0x97, 0xB6, 0x95, 0x4D, 0x68, 0x5A, 
+0x46, 0xFA, 0x52, 0xEE, 0xF4, 0x6E, 0x62, 0x6C, 
+0xC1, 0xED, 0x9A, 0x05, 0x70, 0x3E, 0x4B, 0xEA, 
+0x8C, 0xF3, 0xF4, 0xEF, 0x9F, 0xF3, 0x06, 0x1E, 
+0x13, 0x09, 0xF9, 0x15, 0x32, 0xF3, 0x7B, 0x2C, 
+0x25, 0xBA, 0x02, 0x6E, 0x1F, 0x4A, 0x5B, 0x72, 
+0x58, 0xB7, 0x64, 0x93, 0xAB, 0x8D, 0xF7, 0x45, 
+0xC5, 0x12, 0xBD, 0xA1, 0x03, 0xBC, 0x32, 0x6A, 
+0x29, 0x9F, 0xF9, 0xEE, 0xE9, 0x06, 0x80, 0xA0, 
+0x77, 0x67, 0xC1, 0x63, 0xF1, 0x4C, 0x7E, 0xA7, 
+0x62, 0xC6, 0x83, 0x55, 0x16, 0x8B, 0x39, 0x1C, 
+0xD1, 0xEF, 0x8B, 0x62, 0x39, 0x86, 0xE9, 0xED, 
+0x08, 0xAC, 0x2A, 0x0B, 0x19, 0x5F, 0x4D, 0xE1, 
+
+0x01, 0xF1, 0xB1, 0x95, 0xE6, 0xF8, 0x92, 0x4B, 
+0xB0, 0x94, 0xB6, 0x4A, 0xE8, 0x03, 0xFD, 0xD8, 
+0x5B, 0x5E, 0x55, 0x1F, 0x1B, 0xC3, 0x9D, 0x9A, 
+0x51, 0x60, 0xF6, 0xAE, 0x42, 0x23, 0xFD, 0xC1, 
+0x50, 0x78, 0x26, 0xF0, 0x2E, 0x32, 0x0D, 0xD2, 
+0x28, 0x83, 0x12, 0x65, 0x0A, 0x57, 0x68, 0x65, 
+0x99, 0x35, 0x32, 0x91, 0x86, 0x1C, 0xC8, 0x2E, 
+0x34, 0x72, 0x36, 0x01, 0x37, 0xEF, 0x24, 0x01, 
+0xB0, 0xAB, 0xF4, 0xE6, 0xB4, 0xDC, 0x68, 0xFA, 
+0xE4, 0x5D, 0xA6, 0x70, 0xEA, 0xC4, 0x81, 0x1F, 
+0xAB, 0x69, 0x61, 0x99, 0x59, 0x59, 0xE5, 0xDA, 
+0x1E, 0xD9, 0x99, 0x82, 0xDA, 0x85, 0x9F, 0x55, 
+0xA2, 0x07, 0x4D, 0x75, 0x34, 0x0C, 0xBA, 0xD4, 
+0x54, 0x69, 0xF6, 0x05, 0x62, 0x06, 0x2B, 0x5D, 
+0xCD, 0x20, 0xE6, 0x2D, 0xE9, 0xC4, 0x3A, 0x31, 
+0xC6, 0x5A, 0x48, 0xEC, 0x2C, 0x0D, 0x2E, 0xA2, 
+0xCD, 0xC1, 0xD5, 0xAA, 0x97, 0x01, 0x35, 0x70, 
+0xED, 0x12, 0x5B, 0x22, 0xD4, 0x93, 0xF7, 0x22, 
+0x1A, 0x9D, 0x73, 0xC6, 0xF5, 0xEE, 0x0C, 0xF8, 
+0xF5, 0xEC, 0x87, 0xA2, 0x83, 0xD8, 0xEE, 0xDE, 
+0x92, 0x14, 0x66, 0x42, 0xF2, 0xD0, 0xF3, 0x13, 
+0x15, 0x97, 0x1E, 0xBF, 0x00, 0xEA, 0xF8, 0x07, 
+0x93, 0x1E, 0x54, 0xAB, 0x34, 0xE0, 0x46, 0xB7, 
+0x62, 0xB8, 0xA9, 0xBB, 0xDC, 0x7B, 0xE6, 0xE2, 
+0x95, 0x4C, 0x8B, 0x0D, 0xB6, 0xF3, 0x36, 0x83, 
+0x8C, 0xFE, 0x64, 0xD8, 0x4A, 0x86, 0x2E, 0x0E, 
+0x22, 0x11, 0xE6, 0xE4, 0x94, 0x40, 0x84, 0xC4, 
+0xBC, 0xAC, 0xD3, 0x6F, 0x3A, 0x24, 0x86, 0x20, 
+0x70, 0xE0, 0x14, 0xB0, 0xD5, 0xA3, 0x07, 0xC3, 
+0xC5, 0xE9, 0x36, 0x11, 0x13, 0x7C, 0x68, 0xB2, 
+0x05, 0xE4, 0xB4, 0x11, 0x5A, 0xB4, 0xC8, 0x0E, 
+0x2D, 0x6C, 0x7F, 0x5E, 0x4B, 0xAD, 0xAB, 0x69, 
+0xC1, 0x36, 0xA3, 0xBF, 0x03, 0x8A, 0xB4, 0xE4, 
+0xE1, 
+
+0x68, 0x57, 0x07, 0x96, 0xB3, 0x16, 0xC2, 0x15, 
+0x86, 0x9E, 0xFC, 0x21, 0xE6, 0x29, 0x2A, 0x5D, 
+0x30, 0x8A, 0xED, 0xBD, 0x89, 0x8B, 0x4D, 0x08, 
+0x74, 0x65, 0xC6, 0x25, 0x94, 0x1F, 0xB3, 0x33, 
+0x90, 0xE1, 0x97, 0x46, 0x7A, 0x88, 0x9A, 0x27, 
+0xED, 0x05, 0xF4, 0xB3, 0x8D, 0x10, 0x29, 0xA3, 
+0x4B, 0xF8, 0x98, 0x17, 0x32, 0xC8, 0xF0, 0x47, 
+0x66, 0x21, 0x2F, 0x2A, 0x4D, 0xBE, 0xDF, 0xE0, 
+0xA6, 0x95, 0xEB, 0x1E, 0x8D, 0xFA, 0x76, 0xCB, 
+0xC9, 0xA9, 0xA4, 0xA3, 0x14, 0x3A, 0xA3, 0x86, 
+0xCF, 0x81, 0x94, 0xD4, 0x5D, 0x04, 0xFF, 0xD8, 
+0x1E, 0x08, 0x6E, 0x51, 0x13, 0x89, 0xE0, 0x3A, 
+0x8F, 0x51, 0x90, 0x44, 0xDC, 0xFB, 0x25, 0x88, 
+0xAD, 0x42, 0xF3, 0x15, 0xA5, 0xD0, 0xE1, 0xBF, 
+0x41, 0x76, 0x62, 0x1F, 0xE6, 0x0A, 0x9E, 0xE1, 
+0x17, 0x1C, 0xCB, 0x23, 0xE3, 0x4C, 0xF8, 0x46, 
+0x9C, 0xB1, 0x86, 0x49, 0x2A, 0x10, 0xBF, 0xE1, 
+0xE1, 0xC6, 0xB2, 0x04, 0x8F, 0x3F, 0x6E, 0x75, 
+0xF4, 0xB3, 0x2E, 0xBE, 0x74, 0x66, 0x30, 0xDC, 
+0x4E, 0x0F, 0x1F, 0x25, 0x63, 0xA2, 0xC8, 0x98, 
+0xDA, 0x8C, 0xF7, 0x39, 0x8B, 0x93, 0x83, 0x1A, 
+0xAD, 0xED, 0xA6, 0x52, 0x15, 0x5C, 0x4B, 0xC4, 
+0x00, 0x6F, 0x07, 0x4A, 0xFD, 0x0E, 0x09, 0xD2, 
+0xB7, 0x8D, 0x45, 0x6B, 0xCB, 0x00, 0xFE, 0x1F, 
+0xB6, 0xA4, 0x06, 0x90, 0x15, 0x44, 0xF7, 0x4E, 
+0x6B, 0xA1, 0x7E, 0xDE, 0xFA, 0x11, 0x4D, 0x61, 
+0x8B, 0x30, 0xEA, 0x65, 0x74, 0x21, 0xE0, 0xA5, 
+0xAE, 0x43, 0x5D, 0x53, 0xCD, 0x88, 0xA1, 0x20, 
+0x66, 0x6B, 0x57, 0x0F, 0xB5, 0x20, 0xDF, 0xFC, 
+0x03, 0xB3, 0x4B, 0x8D, 0x17, 0xCA, 0xEA, 0x9D, 
+0x4E, 0x00, 0x80, 0xAA, 0xC4, 0x72, 0xE2, 0x55, 
+0x71, 0x79, 0x65, 0xA2, 0xF5, 0x96, 0x37, 0x1B, 
+0x75, 0x3F, 0x98, 0x1D, 0x5F, 0xE4, 0x30, 0x0D, 
+0x0F, 0xF8, 
+
+0xB4, 0xC9, 0x96, 0x4A, 0x3E, 0xDA, 0xE4, 0xA0, 
+0x4D, 0x52, 0x78, 0xCE, 0xFA, 0xF2, 0x4F, 0x63, 
+0x22, 0xBD, 0xD7, 0x32, 0x83, 0xAF, 0xD1, 0xCC, 
+0x24, 0xE3, 0xDB, 0x07, 0x66, 0xFF, 0xE4, 0x2E, 
+0xEA, 0xB6, 0x96, 0x0A, 0x40, 0x07, 0x51, 0x00, 
+0xC6, 0x8C, 0x63, 0x13, 0xB8, 0x33, 0x85, 0x01, 
+0x26, 0xA8, 0xB8, 0x9D, 0xA3, 0x7E, 0xB5, 0x6E, 
+0xCF, 0x5A, 0x6F, 0x60, 0x25, 0xDA, 0x09, 0x19, 
+0x37, 0x40, 0xC4, 0x27, 0x3C, 0x3D, 0xF0, 0xD7, 
+0x8A, 0x56, 0x7A, 0xA8, 0x2D, 0x12, 0x21, 0x60, 
+0x29, 0xB0, 0x7D, 0xAC, 0x0E, 0xAE, 0xC4, 0x69, 
+0x7E, 0x7A, 0x9C, 0x14, 0x9F, 0x49, 0x95, 0x67, 
+0xF8, 0x27, 0xB5, 0x58, 0x04, 0xC2, 0xCD, 0xF1, 
+0x32, 0x0F, 0xAA, 0x58, 0xE0, 0x38, 0x21, 0x3F, 
+0x43, 0x60, 0x05, 0xD5, 0xFC, 0xD5, 0x32, 0x2D, 
+0x88, 0x9C, 0xC0, 0x62, 0x9E, 0xA1, 0x58, 0x48, 
+0x1E, 0x59, 0x11, 0x29, 0x5D, 0xB9, 0x2A, 0x05, 
+0x00, 0x6B, 0xF1, 0x66, 0x78, 0x7F, 0xE0, 0x88, 
+0x81, 0x81, 0x44, 0x15, 0x0C, 0xA2, 0x25, 0x4B, 
+0xA8, 0x41, 0x6E, 0x3A, 0xF5, 0xAC, 0xA9, 0x6D, 
+0x71, 0xC2, 0x43, 0xD3, 0x3C, 0xD9, 0xDD, 0x1F, 
+0x0C, 0x7A, 0x18, 0xB0, 0xE2, 0x62, 0xC9, 0x5C, 
+0x0D, 0x45, 0x4F, 0xA1, 0x78, 0x72, 0xA9, 0x71, 
+0xE6, 0x11, 0x15, 0x04, 0x9D, 0x7E, 0xC5, 0x8A, 
+0xEE, 0x57, 0x2F, 0xEB, 0xF8, 0xA1, 0x68, 0x19, 
+0xBE, 0xB1, 0xB8, 0xD3, 0xC4, 0x88, 0xD8, 0x7B, 
+0x6C, 0xFD, 0xDA, 0xA6, 0x63, 0xB8, 0x7C, 0x7B, 
+0xD2, 0x8A, 0x3F, 0xEF, 0x57, 0x0C, 0x14, 0x6F, 
+0x3C, 0xE7, 0x0B, 0x19, 0x47, 0x07, 0x65, 0x99, 
+0x05, 0x32, 0x8E, 0xE1, 0x6E, 0xB9, 0x3C, 0xA6, 
+0xE2, 0xF6, 0x8C, 0x0F, 0x8A, 0x7E, 0x85, 0x27, 
+0x89, 0x18, 0x9C, 0xD8, 0x52, 0x41, 0x4E, 0xA5, 
+0x58, 0x9E, 0x2D, 0xE4, 0x9A, 0xCD, 0x6F, 0x4F, 
+0x2A, 0xAC, 0x97, 
+
+0xB7, 0xBC, 0x2F, 0x05, 0x07, 0x47, 0x7B, 0xA7, 
+0x89, 0x95, 0x7A, 0xBB, 0x17, 0x01, 0x55, 0x9F, 
+0x32, 0x2F, 0x1F, 0x9C, 0x75, 0x71, 0xCD, 0xDA, 
+0x08, 0x8C, 0xD3, 0x28, 0xF3, 0x64, 0x68, 0xF5, 
+0xF7, 0xAB, 0x50, 0x3C, 0x91, 0x36, 0x19, 0x19, 
+0x91, 0xFF, 0xEF, 0x73, 0x88, 0x33, 0x17, 0x2E, 
+0xAA, 0xBD, 0x0B, 0xEC, 0x5C, 0x3A, 0x4E, 0x2A, 
+0x59, 0x7F, 0x6E, 0x17, 0xEB, 0x6F, 0xCC, 0x8E, 
+0xC0, 0x82, 0x8D, 0x8B, 0xFC, 0x1C, 0xE7, 0x56, 
+0x68, 0xAB, 0x68, 0x5F, 0xBC, 0x6F, 0x16, 0x15, 
+0x9C, 0xBC, 0xE2, 0xB6, 0x8D, 0xC0, 0x9C, 0x16, 
+0x1E, 0x5C, 0xE5, 0x04, 0x93, 0xCC, 0x72, 0x43, 
+0x9D, 0x5E, 0x61, 0xC4, 0x95, 0x09, 0x95, 0x14, 
+0xC0, 0x7C, 0x02, 0xAC, 0x61, 0x30, 0xE7, 0x2F, 
+0x10, 0xD7, 0xAE, 0x16, 0xA3, 0xA8, 0xCF, 0xE0, 
+0x4E, 0x86, 0xFA, 0xF0, 0x48, 0xEC, 0x5A, 0xB6, 
+0x96, 0x86, 0x72, 0x25, 0x8C, 0xB0, 0x0B, 0x10, 
+0xD6, 0x2A, 0x02, 0xD5, 0x19, 0xE9, 0x4A, 0x4B, 
+0x4D, 0x11, 0x92, 0xE6, 0x98, 0xFF, 0xAB, 0x69, 
+0xD6, 0x9E, 0x91, 0x95, 0xC1, 0x54, 0x9E, 0xC1, 
+0x5C, 0x1D, 0x21, 0x1E, 0xE7, 0x07, 0x2A, 0xF5, 
+0x29, 0x86, 0xD8, 0xDC, 0xE0, 0x0C, 0x9E, 0x0F, 
+0x18, 0xA1, 0xEF, 0x4F, 0x9D, 0x98, 0xD2, 0xFB, 
+0x25, 0x23, 0x2D, 0xC0, 0x75, 0x48, 0xE0, 0xD3, 
+0x25, 0x5F, 0x7C, 0xFD, 0xBF, 0x76, 0xA3, 0x0A, 
+0x91, 0x1A, 0x5F, 0xEF, 0x30, 0xEC, 0xD0, 0xFF, 
+0x77, 0xBA, 0xC4, 0x0D, 0xBB, 0xC0, 0xFC, 0x00, 
+0xBA, 0xBF, 0x1F, 0x04, 0x56, 0xC2, 0x99, 0x21, 
+0x4C, 0x64, 0x43, 0x34, 0xDA, 0x74, 0x2F, 0x22, 
+0xC6, 0xF3, 0x52, 0x6C, 0x14, 0xB7, 0xFC, 0x80, 
+0xB0, 0xFE, 0x27, 0xE7, 0x7E, 0x2D, 0xFA, 0xD7, 
+0x13, 0x96, 0x7A, 0x37, 0x23, 0x5F, 0xD3, 0x18, 
+0x41, 0x0F, 0x8F, 0xAB, 0xA7, 0x40, 0xFF, 0xD4, 
+0xA7, 0x36, 0x23, 0xC6, 
+
+0xDF, 0x00, 0x3A, 0xC9, 0x07, 0x0F, 0x39, 0xDA, 
+0xB9, 0x7C, 0x47, 0x0E, 0xDA, 0x4B, 0x0A, 0x6D, 
+0x1A, 0x14, 0xA1, 0xD7, 0xD8, 0x6F, 0x6F, 0x08, 
+0x11, 0xE3, 0x73, 0xF5, 0xA7, 0xCE, 0xC2, 0xF8, 
+0xC2, 0x3F, 0xBA, 0x1D, 0x32, 0xF4, 0xC2, 0xF5, 
+0x09, 0x1B, 0xB6, 0x91, 0xD5, 0xA6, 0xB2, 0xA4, 
+0x06, 0xF1, 0x41, 0x9A, 0xE8, 0x42, 0x2B, 0x16, 
+0x6E, 0xBA, 0xEB, 0xFB, 0xE7, 0xCA, 0x8D, 0x8F, 
+0xA9, 0xC7, 0x57, 0xF4, 0xDF, 0x49, 0x89, 0x0A, 
+0xC1, 0x5E, 0x7D, 0x32, 0xDE, 0x99, 0xE9, 0x6F, 
+0x7E, 0x8F, 0xB1, 0x41, 0xFF, 0xB2, 0xB0, 0x99, 
+0x7F, 0x69, 0x0E, 0xA9, 0x63, 0xED, 0xE5, 0xFC, 
+0xDC, 0x75, 0xBC, 0x02, 0x90, 0x4C, 0x1A, 0x91, 
+0xAD, 0x45, 0x10, 0x71, 0xD4, 0x22, 0xBD, 0xD7, 
+0xFD, 0x51, 0x02, 0x6A, 0x70, 0x7A, 0xDB, 0x91, 
+0x00, 0x3A, 0xB6, 0xE8, 0x9D, 0x20, 0x01, 0x3F, 
+0x82, 0x5A, 0x60, 0x69, 0x18, 0x70, 0x99, 0x28, 
+0x94, 0xC1, 0xD4, 0xD6, 0x3F, 0xF7, 0xE9, 0x6B, 
+0xE5, 0xB6, 0x55, 0xF7, 0xEB, 0x8E, 0x2E, 0x1E, 
+0x1F, 0xED, 0x03, 0xA5, 0x15, 0xAD, 0x85, 0xAC, 
+0xDA, 0xC6, 0xB4, 0x1D, 0xB9, 0x11, 0x42, 0xA7, 
+0xCB, 0xB6, 0x23, 0xBE, 0x65, 0x70, 0x14, 0xCE, 
+0xB0, 0xE6, 0xF7, 0x2C, 0xF6, 0xC9, 0x32, 0x2B, 
+0x43, 0xC0, 0xF1, 0x1B, 0x90, 0x88, 0xE7, 0x31, 
+0x5B, 0xFD, 0x60, 0x66, 0x75, 0x7F, 0x2E, 0x1B, 
+0x71, 0xC7, 0x34, 0xD9, 0x3F, 0x0F, 0x06, 0x45, 
+0xC0, 0x40, 0x0B, 0x3C, 0x4B, 0xB1, 0xB3, 0xDC, 
+0x6E, 0x06, 0x30, 0x1D, 0xDF, 0x06, 0xB6, 0x73, 
+0x00, 0x3B, 0x10, 0x5B, 0xA6, 0xA9, 0xBC, 0x4C, 
+0xC4, 0x87, 0x88, 0xA0, 0xC9, 0x88, 0x51, 0x70, 
+0xC5, 0x5B, 0x64, 0x7C, 0x26, 0x27, 0x95, 0x36, 
+0x4B, 0x0F, 0xBD, 0x82, 0x3E, 0x38, 0x6C, 0x32, 
+0xF7, 0xA1, 0x97, 0xC0, 0x74, 0x33, 0x7F, 0x45, 
+0x80, 0xA8, 0xE5, 0x3F, 0x16, 
+
+0x45, 0x1C, 0x5A, 0x30, 0xB5, 0xD1, 0x0F, 0x3A, 
+0x98, 0xB3, 0x4A, 0xEF, 0xBE, 0xB5, 0xD5, 0x51, 
+0xBA, 0x95, 0x80, 0xA8, 0xF9, 0x81, 0x49, 0x6D, 
+0x07, 0x6D, 0xBE, 0x3A, 0xDB, 0xC0, 0xE6, 0x35, 
+0xE5, 0xBB, 0xF4, 0x1A, 0xE9, 0x06, 0x6A, 0x12, 
+0x75, 0x8C, 0xD1, 0x44, 0xE9, 0x78, 0x78, 0x39, 
+0xF7, 0x6D, 0x63, 0x9C, 0xE2, 0xEF, 0xA3, 0x4A, 
+0x61, 0x7E, 0x3B, 0x23, 0x7D, 0x71, 0x3B, 0x89, 
+0xB1, 0xB8, 0x57, 0x8A, 0x75, 0xFC, 0x7D, 0x53, 
+0x5B, 0xFD, 0x6F, 0x82, 0x09, 0xD1, 0xB0, 0xCC, 
+0x42, 0x45, 0xF4, 0x23, 0x96, 0x31, 0x29, 0xBE, 
+0x6D, 0xD9, 0xAA, 0x2A, 0x51, 0xFB, 0xEE, 0x2E, 
+0x33, 0x06, 0x34, 0xC0, 0x1A, 0x83, 0x60, 0x02, 
+0x7B, 0x56, 0x87, 0x88, 0xB2, 0xCC, 0x2A, 0x60, 
+0xBD, 0x48, 0x1E, 0xAC, 0xAB, 0x7E, 0x3B, 0x3E, 
+0x7B, 0x47, 0x3D, 0xAC, 0x61, 0x43, 0x8B, 0x80, 
+0xB1, 0xED, 0xA7, 0x01, 0x59, 0x47, 0xA8, 0x10, 
+0xF5, 0xA9, 0xFA, 0x6E, 0x63, 0x4D, 0x41, 0x3B, 
+0x4F, 0xBE, 0x8A, 0x73, 0x30, 0xDF, 0xC6, 0xC1, 
+0x72, 0x4F, 0xD1, 0xA1, 0xAC, 0xBC, 0x4A, 0x81, 
+0x32, 0x48, 0x95, 0x97, 0x92, 0xC2, 0x4A, 0x7C, 
+0x8F, 0xD5, 0x9C, 0x69, 0xDF, 0x34, 0xF6, 0x13, 
+0xF2, 0x9B, 0x4F, 0x1E, 0xD4, 0xA6, 0x28, 0x65, 
+0xD4, 0x3A, 0x10, 0xF8, 0x89, 0x32, 0x62, 0x93, 
+0x9B, 0x1A, 0x65, 0xC4, 0x7E, 0x12, 0xDC, 0x84, 
+0x83, 0x3A, 0xCE, 0x3F, 0xB2, 0x38, 0x35, 0x71, 
+0xF9, 0x45, 0x25, 0x43, 0x35, 0xD2, 0xB4, 0xBB, 
+0x1B, 0xA5, 0x68, 0xE2, 0xA8, 0x66, 0x50, 0x40, 
+0x10, 0xD0, 0x62, 0xC7, 0x35, 0x6A, 0x86, 0xB7, 
+0x10, 0xB1, 0x48, 0xD1, 0x89, 0x3E, 0x5E, 0x81, 
+0xB4, 0x60, 0x64, 0xDD, 0xB3, 0x6B, 0x81, 0x7C, 
+0x40, 0xD8, 0x38, 0x76, 0xDC, 0x2F, 0xE3, 0x23, 
+0x4F, 0xC0, 0x0E, 0x80, 0x0B, 0xAB, 0xAC, 0x4E, 
+0xBA, 0xA7, 0x2D, 0x51, 0xB9, 0xDB, 
+
+0x92, 0x02, 0x68, 0x97, 0x23, 0x43, 0x8B, 0x5D, 
+0xA3, 0x81, 0x98, 0x23, 0xCD, 0x29, 0xF0, 0xE2, 
+0x2E, 0x1F, 0xAC, 0x50, 0x3D, 0xD7, 0x04, 0x6E, 
+0x38, 0xBD, 0x0E, 0x78, 0x99, 0x7C, 0x3D, 0x0D, 
+0xBE, 0x98, 0x61, 0x6F, 0x5F, 0xB3, 0x45, 0x3A, 
+0x47, 0xCB, 0x32, 0xBB, 0x9E, 0xDF, 0x81, 0xEB, 
+0x90, 0x31, 0xDE, 0x87, 0xD3, 0x4B, 0x1D, 0xE8, 
+0xF4, 0xFE, 0x27, 0xAF, 0x19, 0x25, 0x5F, 0xD2, 
+0x32, 0x45, 0x87, 0x07, 0x93, 0x00, 0xE8, 0xD4, 
+0xED, 0x13, 0x25, 0x40, 0xB3, 0x8F, 0xE9, 0x50, 
+0x69, 0xED, 0xC3, 0x23, 0x63, 0x4A, 0x7E, 0x65, 
+0x85, 0xD2, 0xC7, 0x92, 0x0A, 0x58, 0xFF, 0x28, 
+0x19, 0x75, 0x7D, 0x4C, 0x81, 0x42, 0x40, 0xBF, 
+0x6C, 0x71, 0x1B, 0xE2, 0xAF, 0xDD, 0x60, 0x51, 
+0x0E, 0xE3, 0xAC, 0x9B, 0x7C, 0x92, 0x62, 0xE0, 
+0xF7, 0x0E, 0xC7, 0xE4, 0x3A, 0xFF, 0x4C, 0x9F, 
+0x10, 0x45, 0xB5, 0x88, 0xAD, 0xFA, 0xCB, 0x5E, 
+0x1C, 0xC5, 0x44, 0xD1, 0xF6, 0x03, 0xCD, 0xA3, 
+0x90, 0x78, 0xEE, 0xE9, 0x16, 0xF8, 0xE0, 0xA4, 
+0x88, 0xD3, 0xB3, 0x0E, 0x82, 0xE7, 0xD4, 0x63, 
+0x85, 0x74, 0x32, 0x37, 0x89, 0x69, 0x5F, 0x90, 
+0xF1, 0x9F, 0x7A, 0x7D, 0x91, 0x2B, 0x1A, 0x75, 
+0x00, 0xE8, 0x6A, 0xA3, 0x78, 0xA8, 0x1E, 0x47, 
+0x3F, 0x7F, 0x16, 0x06, 0xAB, 0x7A, 0x95, 0xF3, 
+0xD2, 0x31, 0xD1, 0xCA, 0xA8, 0x6D, 0x59, 0xBA, 
+0xBF, 0xA4, 0x7C, 0xDE, 0xE6, 0x64, 0x25, 0x41, 
+0xCD, 0x62, 0x78, 0x5D, 0x5D, 0x98, 0x4A, 0x08, 
+0x04, 0xEA, 0x6B, 0x2A, 0x12, 0xDA, 0x1A, 0x4C, 
+0xAB, 0x22, 0x7E, 0xE3, 0x38, 0x17, 0x49, 0x3B, 
+0x80, 0x34, 0xF0, 0xCA, 0x8A, 0xB4, 0x62, 0xF9, 
+0xB0, 0xDB, 0x9B, 0x3D, 0x9B, 0xFB, 0xB2, 0xA3, 
+0x45, 0x4B, 0xAE, 0x81, 0xE2, 0x3D, 0x37, 0x87, 
+0xD3, 0xF0, 0xE2, 0x9B, 0x2B, 0x78, 0x03, 0x6C, 
+0x8A, 0xF2, 0x7C, 0x55, 0x11, 0xC7, 0x79, 
+
+}
+return kat[i:j]
+}
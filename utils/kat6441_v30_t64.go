@@ -0,0 +1,4710 @@
+package utils
+func getkat6441_v30_t64(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x08, 0xDC, 0x4D, 0x55, 0xA5, 0x97, 0xB0, 0x53, 
+
+0x19, 0xB6, 0xCC, 0x3F, 0x75, 0x81, 0x62, 0x24, 
+0xC8, 
+
+0x84, 0x62, 0xDF, 0x64, 0xBC, 0xA1, 0xFF, 0xB5, 
+0xB7, 0xAF, 
+
+0x4D, 0xD0, 0xCF, 0x5F, 0x21, 0x6B, 0xFA, 0x09, 
+0xC5, 0x35, 0xD6, 
+
+0xBA, 0x76, 0x31, 0x26, 0xAA, 0x20, 0x9B, 0xC9, 
+0x65, 0x05, 0xE2, 0xBF, 
+
+0xDE, 0xF7, 0xCA, 0x86, 0xB2, 0x12, 0x5F, 0x5B, 
+0xD2, 0x05, 0x5F, 0xFD, 0x4B, 
+
+0x7B, 0x38, 0xB8, 0x13, 0xBD, 0xBD, 0x25, 0x75, 
+0x05, 0x52, 0x52, 0xD8, 0x5D, 0x29, 
+
+0x9E, 0x58, 0x4F, 0x83, 0xB6, 0x10, 0x72, 0x60, 
+0xD6, 0x47, 0x0C, 0xB6, 0xA6, 0x03, 0xEE, 
+
+0x60, 0x42, 0x06, 0x42, 0xB6, 0x13, 0x40, 0xD9, 
+0x58, 0x46, 0x49, 0x6E, 0xC5, 0x41, 0xAD, 0xC1, 
+
+0x0F, 0x11, 0x4C, 0x23, 0x85, 0x2C, 0x7A, 0xBA, 
+0xE3, 0x09, 0xB9, 0x16, 0x20, 0x3E, 0x87, 0x38, 
+0xC4, 
+
+0x8A, 0x65, 0x15, 0x75, 0x26, 0xAA, 0x75, 0x71, 
+0x1E, 0x3C, 0x9F, 0x0C, 0x33, 0x51, 0x1F, 0x68, 
+0xE2, 0x0F, 
+
+0xFF, 0xEE, 0xF5, 0x04, 0x82, 0xE6, 0x6F, 0x60, 
+0xFF, 0x3A, 0x74, 0xA5, 0xA0, 0xD0, 0x43, 0x62, 
+0x68, 0x1A, 0x20, 
+
+0x59, 0xFA, 0x08, 0xCE, 0x7B, 0xEC, 0x94, 0x56, 
+0x24, 0xB2, 0x69, 0x9B, 0xE3, 0x7B, 0xF6, 0xC4, 
+0xB4, 0x94, 0x55, 0x78, 
+
+0x89, 0x7D, 0x96, 0x9F, 0x72, 0x58, 0x1E, 0x9B, 
+0xBA, 0xF5, 0x76, 0x55, 0x46, 0xCB, 0xAA, 0x82, 
+0xDC, 0xBA, 0xBC, 0x03, 0x98, 
+
+0xE3, 0x78, 0x5C, 0xEC, 0xE1, 0x42, 0xDD, 0x8C, 
+0x9B, 0xF6, 0x2D, 0x15, 0x56, 0xCC, 0x4C, 0xF9, 
+0xE7, 0x90, 0x80, 0x3D, 0x88, 0xEA, 
+
+0x82, 0x49, 0x4F, 0xB9, 0xDE, 0x95, 0x1D, 0xCE, 
+0xE3, 0x6C, 0x70, 0x4E, 0xD2, 0x2A, 0x79, 0x0E, 
+0xD7, 0x9B, 0xD0, 0x63, 0x46, 0x1F, 0xA7, 
+
+0x2C, 0x9C, 0xFF, 0xDF, 0x9D, 0x7A, 0x8C, 0xFA, 
+0x11, 0x63, 0x4D, 0x95, 0x92, 0x09, 0xCD, 0x91, 
+0x83, 0x5C, 0x8A, 0x22, 0xE0, 0x33, 0x07, 0xA1, 
+
+0x9C, 0xF5, 0x1A, 0x57, 0x2A, 0x42, 0xD4, 0xFB, 
+0x95, 0xDB, 0x5B, 0x1E, 0xCD, 0xF8, 0x47, 0xB1, 
+0x5A, 0x9A, 0x99, 0x54, 0xFA, 0x7A, 0x6A, 0x53, 
+0x25, 
+
+0x3E, 0x6F, 0xC0, 0xF2, 0xA7, 0x16, 0x71, 0x58, 
+0x11, 0xFC, 0x38, 0x7E, 0x99, 0x5E, 0xDC, 0x4C, 
+0xD5, 0x4F, 0xE9, 0xE0, 0x1D, 0xB4, 0xFE, 0x6A, 
+0x13, 0x3B, 
+
+0xF8, 0x20, 0xD5, 0xB2, 0x52, 0x13, 0x8C, 0x24, 
+0x2C, 0x80, 0x20, 0x1B, 0x8E, 0xB2, 0x5C, 0xA4, 
+0x17, 0xB6, 0x70, 0xEB, 0xB2, 0x00, 0x74, 0xB1, 
+0x1E, 0xF4, 0x63, 
+
+0x23, 0x3D, 0xA2, 0x83, 0xB6, 0xBD, 0x91, 0x27, 
+0xA6, 0x67, 0x07, 0x71, 0xA0, 0x0D, 0xB8, 0x77, 
+0x45, 0x39, 0xC9, 0xB8, 0x9E, 0x25, 0xF9, 0x95, 
+0xD1, 0xF5, 0x95, 0xEB, 
+
+0xD3, 0xEA, 0x8E, 0xCB, 0x23, 0xE2, 0x69, 0x9C, 
+0xDF, 0x17, 0x40, 0x12, 0x67, 0x63, 0x83, 0xB0, 
+0x02, 0x7E, 0x22, 0x0B, 0x3A, 0x6A, 0xFF, 0x98, 
+0x50, 0xD7, 0x6C, 0x83, 0x20, 
+
+0x5D, 0x97, 0x31, 0xD2, 0xE1, 0x07, 0xC3, 0x6B, 
+0x4A, 0x08, 0xE7, 0xCB, 0xA4, 0xFA, 0x3D, 0x8E, 
+0x14, 0x78, 0xEB, 0x1B, 0x64, 0x94, 0x92, 0x80, 
+0xEC, 0x8F, 0x14, 0x26, 0x1B, 0x68, 
+
+0x6D, 0x57, 0x2E, 0x17, 0xD9, 0x08, 0xC1, 0x14, 
+0xC5, 0x3E, 0xC6, 0x15, 0xE6, 0x82, 0xDB, 0x86, 
+0x6E, 0x2A, 0x1F, 0x50, 0xAF, 0x23, 0xB1, 0x95, 
+0x53, 0x81, 0x80, 0xA5, 0xBA, 0x47, 0x06, 
+
+0xCD, 0xAE, 0x54, 0xED, 0x20, 0x33, 0xD4, 0x90, 
+0xF1, 0xA8, 0x8F, 0x95, 0x62, 0xE2, 0x78, 0x52, 
+0xA5, 0x83, 0xB3, 0x31, 0x6B, 0xEA, 0x7A, 0xAF, 
+0x9E, 0xD9, 0x23, 0x5B, 0x3D, 0x84, 0xA8, 0x16, 
+
+0x68, 0x0B, 0x13, 0x1E, 0xAD, 0xAA, 0x2D, 0xD2, 
+0x6E, 0xB4, 0x32, 0xD8, 0x30, 0xE3, 0x14, 0x57, 
+0x4D, 0xE2, 0x11, 0x76, 0xFD, 0x5E, 0xA6, 0x2C, 
+0x7E, 0x9B, 0x19, 0xEC, 0x90, 0x75, 0xED, 0xC2, 
+0xB3, 
+
+0x6A, 0xA2, 0x16, 0x7A, 0xEE, 0xA6, 0xBA, 0x3F, 
+0x0B, 0xD9, 0xB3, 0xF1, 0xF4, 0x07, 0x80, 0xAA, 
+0x60, 0x83, 0x62, 0xB3, 0x35, 0xD5, 0x9E, 0xCC, 
+0xF7, 0x92, 0x58, 0xF0, 0x1D, 0x78, 0xAA, 0x09, 
+0x0A, 0x02, 
+
+0xB8, 0x17, 0x42, 0x1A, 0xD5, 0xAA, 0x61, 0xFF, 
+0x3E, 0xBE, 0x78, 0x79, 0x5A, 0x72, 0x8D, 0xBD, 
+0x8A, 0x71, 0x17, 0x40, 0x8E, 0xEA, 0x4C, 0xFE, 
+0xB4, 0x73, 0x9E, 0x88, 0x3C, 0xF4, 0x0D, 0x76, 
+0x84, 0xE6, 0xEA, 
+
+0xB6, 0x91, 0xEC, 0xD7, 0x31, 0x55, 0xBE, 0x27, 
+0x96, 0x07, 0xC0, 0xA3, 0x2C, 0x1E, 0x13, 0xAB, 
+0x08, 0x94, 0x88, 0x9C, 0x00, 0x31, 0xA1, 0x7E, 
+0x94, 0xA0, 0x6D, 0x05, 0xA0, 0x3E, 0x3F, 0x4A, 
+0xCF, 0x78, 0x46, 0xD4, 
+
+0x3B, 0xE8, 0xF7, 0x0A, 0xB2, 0x8A, 0x8F, 0xC7, 
+0x38, 0xBA, 0x5B, 0x9D, 0x2F, 0xA8, 0x97, 0x13, 
+0x90, 0xD4, 0x65, 0x60, 0x29, 0x13, 0x6D, 0xC0, 
+0xFF, 0x3B, 0x8C, 0xA8, 0x2A, 0xB7, 0xF9, 0x46, 
+0xB3, 0x76, 0xEC, 0xDC, 0xA4, 
+
+0x5A, 0x4D, 0x60, 0x27, 0x4B, 0xB4, 0x8F, 0xF2, 
+0xAA, 0x6D, 0x64, 0xA0, 0x7D, 0xC9, 0xB5, 0xBB, 
+0x6C, 0x3B, 0x42, 0x33, 0xFD, 0xF1, 0x13, 0x53, 
+0x61, 0xF0, 0x90, 0xBC, 0xB3, 0xDB, 0x71, 0x08, 
+0x16, 0xBD, 0x26, 0x08, 0x60, 0x01, 
+
+0x3C, 0x1C, 0x91, 0x9A, 0x9C, 0xBD, 0x06, 0xED, 
+0xE4, 0xDD, 0xB5, 0x65, 0x2A, 0xA0, 0x70, 0xFC, 
+0x15, 0xC7, 0xA9, 0xAA, 0xD5, 0x8E, 0x70, 0x0D, 
+0x8F, 0x43, 0xD5, 0x4C, 0xED, 0x63, 0x2D, 0x54, 
+0x67, 0xF1, 0x46, 0x2F, 0x7D, 0x10, 0x3C, 
+
+0xB2, 0xC3, 0x69, 0xA2, 0x9E, 0x16, 0xA0, 0xAC, 
+0x5C, 0xD1, 0x03, 0xF9, 0xE4, 0x10, 0xBC, 0x32, 
+0x13, 0xAE, 0xAF, 0xA1, 0x54, 0xC6, 0xC4, 0xF3, 
+0xF5, 0x48, 0x20, 0xD3, 0x1D, 0x2C, 0x35, 0xFC, 
+0xB6, 0xF6, 0x41, 0x2C, 0xC6, 0x16, 0x83, 0x1B, 
+
+0xE8, 0x5D, 0xBC, 0x51, 0xD0, 0x3F, 0x5B, 0x44, 
+0xD1, 0x4A, 0x5F, 0xED, 0x3C, 0x33, 0xE2, 0xA2, 
+0x77, 0x30, 0xB1, 0x7B, 0x88, 0x65, 0xC7, 0x97, 
+0x0E, 0x45, 0x6A, 0x46, 0xFF, 0x06, 0x56, 0xD0, 
+0x67, 0xC3, 0x6D, 0xC5, 0x0E, 0xF5, 0xD6, 0xB1, 
+0x95, 
+
+0x52, 0xAB, 0x4D, 0xFE, 0xEB, 0xBD, 0x38, 0x63, 
+0xDC, 0xE1, 0x80, 0xA4, 0x6B, 0xC4, 0xAF, 0x31, 
+0x66, 0x5A, 0x95, 0x58, 0xCF, 0x3D, 0xF2, 0x3D, 
+0xDA, 0x5E, 0x64, 0xF7, 0xE6, 0x15, 0xED, 0xC5, 
+0x73, 0xBF, 0x0F, 0x70, 0x55, 0x4A, 0x6B, 0xCE, 
+0x68, 0xF8, 
+
+0xD8, 0xC8, 0xCC, 0x80, 0x14, 0xAD, 0x2A, 0x88, 
+0x63, 0x08, 0xA5, 0x5E, 0xBA, 0x80, 0xF4, 0x14, 
+0x4D, 0x80, 0x32, 0x1A, 0x12, 0x25, 0x8D, 0x69, 
+0x47, 0x92, 0xF6, 0x3E, 0x8E, 0xBC, 0x27, 0x03, 
+0x30, 0xC8, 0x27, 0xB2, 0xF4, 0x03, 0xA3, 0x16, 
+0xD6, 0x42, 0xCD, 
+
+0xD2, 0xFC, 0xEA, 0x6D, 0x7B, 0xF7, 0x8A, 0x5F, 
+0x88, 0xA5, 0x33, 0xC3, 0x3A, 0x80, 0x77, 0x0F, 
+0x52, 0xBC, 0x73, 0xC4, 0xBC, 0xE2, 0x42, 0xFF, 
+0xD6, 0x0D, 0x5B, 0x3A, 0x4B, 0x0A, 0x17, 0xD1, 
+0x3C, 0x23, 0x87, 0x98, 0x44, 0x43, 0x51, 0xCC, 
+0x27, 0x6B, 0x22, 0x74, 
+
+0x21, 0x56, 0x33, 0xBE, 0x67, 0x00, 0xC6, 0x72, 
+0x38, 0x5B, 0x6A, 0x74, 0xEA, 0xF9, 0xB8, 0x82, 
+0x5E, 0x20, 0xD7, 0xFC, 0x5D, 0x39, 0xCF, 0xA7, 
+0x7E, 0x48, 0xAE, 0x9D, 0x37, 0xC4, 0x1F, 0xC4, 
+0xFE, 0x7D, 0x57, 0xCD, 0x01, 0x16, 0xA7, 0x66, 
+0x05, 0x49, 0x7A, 0x4A, 0xE6, 
+
+0xDB, 0x4A, 0x32, 0x51, 0xBF, 0x20, 0x18, 0x6A, 
+0xDC, 0x7C, 0x5E, 0xA2, 0x50, 0x33, 0x54, 0x96, 
+0x2D, 0x3A, 0x96, 0x03, 0x77, 0xA5, 0x48, 0x80, 
+0x58, 0x74, 0xD6, 0xBC, 0x4E, 0xDA, 0x27, 0xCB, 
+0xE7, 0x2D, 0x5D, 0xF6, 0x75, 0xA3, 0x86, 0xFD, 
+0xBD, 0xCA, 0x7A, 0x5C, 0x08, 0x3D, 
+
+0xD9, 0x67, 0x7B, 0x4A, 0x94, 0x53, 0xE7, 0xC9, 
+0xD3, 0xBE, 0x4A, 0xC7, 0x4C, 0x2E, 0x1C, 0x50, 
+0x35, 0x8D, 0x6C, 0xDD, 0x3B, 0xE3, 0x5A, 0x4B, 
+0xD4, 0x47, 0x38, 0x1A, 0xB6, 0x99, 0x30, 0x9A, 
+0x28, 0xA9, 0x7F, 0x31, 0xB0, 0xCE, 0x19, 0x36, 
+0xEF, 0x96, 0x5C, 0x1B, 0x1B, 0xD5, 0xEE, 
+
+0x18, 0xD7, 0x71, 0x1B, 0xD3, 0xD1, 0xE5, 0x48, 
+0xFC, 0xA5, 0x9E, 0xC9, 0x9C, 0x46, 0x71, 0x49, 
+0x97, 0x49, 0xC8, 0x9F, 0x7B, 0x95, 0xC1, 0x6B, 
+0xF8, 0xC8, 0x1D, 0x68, 0x1C, 0xE5, 0xB6, 0xB2, 
+0x58, 0x12, 0x9D, 0x48, 0x07, 0xE0, 0xBE, 0x44, 
+0xF0, 0x68, 0x8B, 0xDE, 0x17, 0x39, 0x99, 0x90, 
+
+0xE0, 0xAE, 0x1E, 0x49, 0x0D, 0x33, 0x51, 0xE8, 
+0x4C, 0xB5, 0x5B, 0x57, 0xC8, 0x78, 0xE7, 0x8D, 
+0x52, 0x37, 0xD6, 0x2D, 0x56, 0x73, 0x0D, 0x8A, 
+0x47, 0xAF, 0x7C, 0x63, 0x9B, 0xAD, 0xE6, 0xAC, 
+0xC2, 0xC4, 0xC8, 0xE3, 0x74, 0xF8, 0xDA, 0x6D, 
+0xD4, 0xAE, 0xD1, 0x17, 0xDA, 0xF3, 0x9B, 0x4C, 
+0x20, 
+
+0xF6, 0x76, 0xE6, 0x65, 0xF8, 0xD4, 0x1D, 0x28, 
+0x9D, 0x25, 0xD0, 0xAD, 0x38, 0x4A, 0x37, 0xA2, 
+0xD1, 0x07, 0xA8, 0xF2, 0xA7, 0x3B, 0x40, 0xCE, 
+0xCC, 0xA0, 0x06, 0x05, 0x0C, 0xD7, 0x0D, 0xF4, 
+0xB0, 0x37, 0x96, 0xE6, 0x59, 0xDB, 0xE7, 0x44, 
+0x1B, 0xA9, 0xFD, 0xFB, 0x08, 0x1D, 0xBD, 0x07, 
+0x8C, 0xD6, 
+
+0x8F, 0x86, 0x1B, 0x28, 0x55, 0x8A, 0xF1, 0xB4, 
+0xF5, 0x69, 0x3F, 0x59, 0xD6, 0x07, 0x9D, 0xAD, 
+0x3A, 0xB3, 0x73, 0x9F, 0x6F, 0x5F, 0xD2, 0x38, 
+0x87, 0x89, 0x38, 0xF9, 0x95, 0x63, 0x52, 0xEB, 
+0xFA, 0xCB, 0x7C, 0x6C, 0xE7, 0x5E, 0x73, 0xBA, 
+0x76, 0x2C, 0xAD, 0x78, 0x77, 0x8F, 0x40, 0x65, 
+0x82, 0x78, 0x5B, 
+
+0xC0, 0xED, 0x11, 0xAB, 0x54, 0x7E, 0x2E, 0x5A, 
+0xC1, 0x84, 0x0D, 0x58, 0xBB, 0x23, 0xF0, 0xFE, 
+0x15, 0x12, 0x8E, 0xCF, 0x9C, 0x84, 0x12, 0x98, 
+0xD6, 0xA0, 0xF0, 0x96, 0x89, 0xF6, 0xC2, 0xA6, 
+0xCA, 0x57, 0x93, 0x03, 0xBF, 0x13, 0xD9, 0x4E, 
+0x90, 0xC7, 0x18, 0x6E, 0xB8, 0x7C, 0x42, 0xF9, 
+0xBE, 0x68, 0xE0, 0xAB, 
+
+0xCB, 0x2A, 0x50, 0x15, 0x3D, 0xCF, 0x80, 0xE7, 
+0xD4, 0xB8, 0xBA, 0x1D, 0x30, 0x17, 0xB2, 0x55, 
+0x06, 0xB9, 0xF3, 0xE5, 0x15, 0xA7, 0xB9, 0x8C, 
+0xCB, 0xEA, 0x04, 0x04, 0x90, 0x99, 0x14, 0xEB, 
+0x0A, 0x6C, 0x10, 0x39, 0x72, 0xD0, 0xD6, 0x28, 
+0x7D, 0x1F, 0xDA, 0x3E, 0x79, 0x59, 0x01, 0xD1, 
+0xA6, 0x53, 0xC5, 0xF0, 0xF8, 
+
+0x5C, 0x6C, 0x50, 0x01, 0xE8, 0xBD, 0xA4, 0x22, 
+0x46, 0x17, 0x59, 0x57, 0x24, 0xFB, 0x87, 0x86, 
+0x61, 0x45, 0x30, 0xDF, 0xF2, 0x5C, 0xD1, 0xD3, 
+0x89, 0xBA, 0xA4, 0xF3, 0xD4, 0xAF, 0x0B, 0x1E, 
+0xEE, 0xF5, 0x0A, 0xB2, 0x77, 0xF4, 0xE3, 0x80, 
+0x6E, 0x8F, 0x32, 0x2E, 0xEC, 0xAF, 0xEC, 0x35, 
+0x53, 0xEA, 0x8D, 0x86, 0xA0, 0x3C, 
+
+0x5F, 0xD7, 0x62, 0xFA, 0xA4, 0x84, 0x3C, 0xA3, 
+0xFF, 0x17, 0x24, 0x34, 0x8C, 0xAA, 0x48, 0x3D, 
+0xDA, 0x2C, 0x85, 0x4C, 0x82, 0x89, 0xF6, 0x12, 
+0x65, 0x53, 0xF0, 0xFC, 0x4F, 0x17, 0xCC, 0xDA, 
+0xF7, 0xF3, 0x53, 0x27, 0x1F, 0x63, 0x5B, 0xF4, 
+0xD9, 0x15, 0x00, 0x96, 0xB5, 0x41, 0xF6, 0xFA, 
+0x0A, 0x5A, 0xC0, 0x76, 0xB8, 0xCA, 0x7B, 
+
+0x4A, 0xB8, 0x69, 0xD1, 0xCF, 0xDE, 0x41, 0x79, 
+0xC1, 0xBC, 0xE7, 0x4F, 0x12, 0xAD, 0x92, 0xD8, 
+0xB6, 0x95, 0xAF, 0xBD, 0x4A, 0x98, 0xD7, 0x43, 
+0x28, 0x6B, 0x46, 0x85, 0x50, 0x08, 0xF9, 0x6E, 
+0x56, 0xF9, 0xCB, 0x6D, 0x0E, 0x1A, 0xE4, 0x01, 
+0x4B, 0x11, 0x8D, 0x46, 0x9E, 0x67, 0xFF, 0x5E, 
+0xE6, 0xFE, 0xB0, 0x43, 0x7B, 0x18, 0xCF, 0x25, 
+
+0x35, 0x19, 0xC0, 0x1B, 0xAE, 0xED, 0xFF, 0x9B, 
+0xC4, 0x8C, 0x49, 0x64, 0x40, 0xCA, 0xD4, 0xA4, 
+0x56, 0x80, 0x89, 0xA5, 0xD0, 0x9F, 0xBA, 0x77, 
+0xE7, 0x21, 0x11, 0x08, 0xE9, 0xE1, 0xAB, 0x45, 
+0x69, 0xB2, 0xD9, 0x4D, 0x10, 0xD5, 0x62, 0xFE, 
+0xB6, 0x21, 0xCA, 0x50, 0x3A, 0x69, 0x65, 0x94, 
+0x22, 0xAC, 0xEC, 0xBD, 0x5C, 0xB4, 0x79, 0x6C, 
+0xBC, 
+
+0xD7, 0x9B, 0x25, 0x7F, 0x89, 0xE1, 0x2C, 0x83, 
+0x15, 0x8A, 0x5F, 0x99, 0xBB, 0xC4, 0x72, 0x90, 
+0x20, 0xCD, 0x4F, 0xD9, 0xD3, 0xC0, 0x75, 0xEB, 
+0xAF, 0x75, 0x8B, 0xA0, 0xD8, 0xAC, 0x7B, 0x8A, 
+0xFA, 0x8A, 0x8B, 0x8E, 0x72, 0xFD, 0xE3, 0x6E, 
+0xAC, 0xCD, 0x99, 0xF7, 0xDD, 0x2A, 0x12, 0x2C, 
+0x67, 0x5D, 0x18, 0x8F, 0x7D, 0x1C, 0x96, 0x7E, 
+0x0C, 0x09, 
+
+0xA7, 0x5E, 0x64, 0x76, 0x2B, 0xDC, 0xDE, 0xB5, 
+0x7C, 0x55, 0x02, 0x3F, 0x47, 0x93, 0x46, 0xE5, 
+0x52, 0xF2, 0x48, 0xC9, 0xE1, 0x64, 0xEF, 0x2D, 
+0x2B, 0x58, 0x37, 0xA2, 0xCD, 0xE8, 0xA5, 0x0D, 
+0xDD, 0x1F, 0x6F, 0x62, 0xC6, 0xFF, 0x1A, 0xF3, 
+0xF4, 0xF2, 0xD1, 0x4F, 0xE2, 0x79, 0xAB, 0x21, 
+0x58, 0x04, 0xEE, 0x87, 0x55, 0xCE, 0x4E, 0xC8, 
+0xC6, 0x03, 0x45, 
+
+0xB5, 0x21, 0xE9, 0xFB, 0xC1, 0x4D, 0x63, 0xA3, 
+0x70, 0x64, 0x83, 0x34, 0x10, 0xA4, 0xA7, 0xFB, 
+0xEC, 0x9F, 0xBF, 0x00, 0x05, 0x50, 0xDF, 0x2C, 
+0xA5, 0xAC, 0x4C, 0x59, 0x2E, 0x0E, 0x33, 0x3E, 
+0x74, 0x2B, 0x5F, 0x34, 0xF0, 0xAB, 0x62, 0x90, 
+0x8A, 0xF7, 0x12, 0x95, 0x4D, 0xE6, 0x2E, 0x60, 
+0x83, 0x40, 0x85, 0xE2, 0x24, 0x4F, 0x71, 0x0F, 
+0x2F, 0xF0, 0x77, 0x37, 
+
+0xA3, 0xB0, 0x0F, 0x4B, 0xA3, 0x2C, 0x4C, 0xB4, 
+0x4E, 0x1D, 0x16, 0xB2, 0x8F, 0x4E, 0x29, 0xE2, 
+0xA0, 0xE1, 0x03, 0x83, 0xA0, 0x1E, 0x94, 0x5F, 
+0xFF, 0x2A, 0x1D, 0x54, 0x17, 0xBD, 0xED, 0x68, 
+0x90, 0x86, 0x1B, 0xF8, 0x0F, 0xA5, 0x44, 0xA1, 
+0xF0, 0xA9, 0xDC, 0xFE, 0xA1, 0xBD, 0xD6, 0xB3, 
+0x96, 0x22, 0x95, 0x7F, 0xD2, 0x26, 0x71, 0x02, 
+0x1C, 0xF1, 0x7F, 0x69, 0x0A, 
+
+0x71, 0xC2, 0x81, 0x47, 0x95, 0x69, 0x15, 0xF6, 
+0x74, 0x5A, 0x85, 0x10, 0x6C, 0x44, 0x4B, 0x67, 
+0x5A, 0x25, 0x4B, 0x7F, 0x55, 0xC1, 0x67, 0xE9, 
+0x6E, 0x8E, 0x6F, 0x51, 0x00, 0x99, 0x14, 0x64, 
+0xEF, 0xE3, 0xDF, 0x69, 0xA5, 0x92, 0xFD, 0x6D, 
+0xF6, 0xC9, 0xA1, 0x14, 0xB6, 0x53, 0x2F, 0xC0, 
+0x5F, 0x30, 0xE2, 0xE7, 0x44, 0x0B, 0x15, 0xA4, 
+0x15, 0xDC, 0x2F, 0xB4, 0xB4, 0x74, 
+
+0x73, 0xF9, 0xEB, 0x94, 0x99, 0xDF, 0xB1, 0x16, 
+0x53, 0x5E, 0x19, 0x08, 0x4E, 0x54, 0x7D, 0xF4, 
+0xBC, 0xCD, 0x2E, 0x48, 0x8B, 0x5B, 0xA1, 0xB2, 
+0x95, 0xDE, 0x91, 0x27, 0x30, 0x9C, 0x96, 0xE5, 
+0x0B, 0x88, 0xD3, 0xC4, 0x56, 0xB5, 0xEF, 0xA4, 
+0xE5, 0x55, 0x69, 0x43, 0x6D, 0x18, 0xA8, 0xDC, 
+0x5E, 0xC7, 0xDA, 0xED, 0x91, 0x1D, 0x48, 0x33, 
+0x2A, 0x03, 0xC0, 0xC8, 0x41, 0x1E, 0x58, 
+
+0xEF, 0x3D, 0xD7, 0xBA, 0x7E, 0x22, 0xC9, 0xED, 
+0x26, 0xEB, 0x61, 0xF8, 0x80, 0xAE, 0xF9, 0x87, 
+0x8C, 0x3C, 0xAA, 0x51, 0x42, 0xAF, 0x09, 0xF7, 
+0x29, 0x56, 0xC6, 0xB9, 0x47, 0x93, 0xD0, 0xA6, 
+0x6B, 0x9C, 0x99, 0x82, 0x78, 0x1A, 0xB8, 0xE4, 
+0xBE, 0xCE, 0xA5, 0x13, 0x46, 0xF8, 0x21, 0xDC, 
+0x06, 0x4C, 0x7D, 0x14, 0x85, 0x68, 0xA9, 0x61, 
+0xC6, 0x5C, 0xCB, 0x46, 0x9B, 0xDC, 0x46, 0x3D, 
+
+0xD5, 0x89, 0xE2, 0xB0, 0x8C, 0xCD, 0xD0, 0x43, 
+0xF9, 0x0F, 0x17, 0xCD, 0x45, 0x60, 0x66, 0xAD, 
+0xFB, 0x55, 0x67, 0x91, 0xFA, 0xCA, 0xAD, 0xE1, 
+0x9D, 0x5C, 0x59, 0x1B, 0x32, 0x05, 0xF9, 0x95, 
+0x35, 0x4B, 0xA7, 0x7C, 0x8E, 0x9F, 0xD3, 0x02, 
+0x93, 0xE4, 0x90, 0x84, 0xF0, 0x2B, 0xBB, 0x8D, 
+0x23, 0xAB, 0x9F, 0xD5, 0x36, 0x5F, 0xCF, 0x07, 
+0x49, 0x07, 0xE6, 0x14, 0x57, 0x86, 0xD7, 0x4D, 
+0x59, 
+
+0x6C, 0x4E, 0xC9, 0x5D, 0x2C, 0x5D, 0xF0, 0x40, 
+0x45, 0xFD, 0x7F, 0xBC, 0x0E, 0xC2, 0xCD, 0x0B, 
+0x3A, 0x3B, 0x33, 0xAA, 0xB9, 0x10, 0xBA, 0xC0, 
+0xC3, 0xEE, 0x67, 0x9C, 0xE4, 0x1A, 0xE0, 0x6E, 
+0x5E, 0x6B, 0x5B, 0x71, 0x6A, 0x72, 0x39, 0x3F, 
+0xF6, 0x92, 0xA0, 0xBC, 0x4A, 0x84, 0xD3, 0xE4, 
+0x99, 0xC2, 0xE9, 0x11, 0x18, 0xB2, 0x71, 0xC8, 
+0xC7, 0x45, 0x4E, 0x1C, 0x67, 0xD4, 0x9B, 0x53, 
+0xBD, 0xF9, 
+
+0x8C, 0xC5, 0xA7, 0x7E, 0x49, 0xC2, 0x84, 0x05, 
+0x2D, 0x5F, 0x1A, 0x86, 0xDA, 0xD9, 0x22, 0xCB, 
+0x20, 0x96, 0x90, 0x52, 0x23, 0x1A, 0x49, 0x02, 
+0x00, 0x39, 0x3B, 0x07, 0xFC, 0xF3, 0xA1, 0x2D, 
+0x98, 0x07, 0xA8, 0xDC, 0x05, 0x3B, 0x9F, 0xA5, 
+0x20, 0xB6, 0xE4, 0x78, 0x82, 0x30, 0x27, 0x6B, 
+0x29, 0xB8, 0xDD, 0xF7, 0x0D, 0x09, 0x4F, 0x83, 
+0xFA, 0xBC, 0xB4, 0xF7, 0x54, 0x8C, 0xCC, 0xC0, 
+0xC8, 0xBB, 0x69, 
+
+0x2A, 0xB6, 0x54, 0xFC, 0x07, 0xE7, 0xCD, 0x4F, 
+0xBF, 0xB5, 0x73, 0xF1, 0x4C, 0x3B, 0xC8, 0xF2, 
+0xAE, 0x92, 0x70, 0x46, 0xC2, 0x42, 0x2A, 0xC3, 
+0xE5, 0x9F, 0xEC, 0x0A, 0x7C, 0x86, 0xBF, 0xE7, 
+0x84, 0xE8, 0x06, 0xF9, 0x79, 0x00, 0x53, 0xFD, 
+0x74, 0xBB, 0xAC, 0x70, 0x23, 0x67, 0xD0, 0x9D, 
+0x56, 0xD9, 0x16, 0xE0, 0x7E, 0xAF, 0x6E, 0x2D, 
+0x57, 0x13, 0xD8, 0xF8, 0xA3, 0x5B, 0x76, 0xC4, 
+0x86, 0x13, 0x00, 0xAC, 
+
+0x53, 0xB1, 0x5D, 0xFD, 0x95, 0x2A, 0x64, 0x48, 
+0x4B, 0x44, 0xDB, 0x41, 0x9D, 0xD5, 0xE9, 0x42, 
+0x30, 0x3F, 0xE5, 0xF2, 0x85, 0x76, 0xF8, 0xAE, 
+0x0C, 0x15, 0xBB, 0xC0, 0x71, 0xBA, 0xEB, 0x7E, 
+0xD0, 0x6F, 0xE4, 0x8F, 0x45, 0xDB, 0x13, 0xBC, 
+0x13, 0x4B, 0xA4, 0x69, 0xC3, 0xE1, 0x90, 0xBA, 
+0x0A, 0x1A, 0x35, 0x40, 0xB8, 0x36, 0xFD, 0x1C, 
+0x42, 0x86, 0x2F, 0xEB, 0x1D, 0x9A, 0x8F, 0x97, 
+0x65, 0x55, 0x36, 0x04, 0xE3, 
+
+0xA2, 0xC0, 0x1F, 0x29, 0x40, 0xC8, 0x58, 0xE6, 
+0xF3, 0x17, 0x49, 0xA6, 0x72, 0x46, 0x2C, 0xCB, 
+0x8E, 0xA2, 0x12, 0x8E, 0xCE, 0xAB, 0xCF, 0x27, 
+0x7E, 0x92, 0xE4, 0xCC, 0xCC, 0x70, 0x68, 0x6C, 
+0x11, 0xB9, 0x06, 0x34, 0x9E, 0xC7, 0x2D, 0x18, 
+0x72, 0x46, 0x90, 0x62, 0x9C, 0xE4, 0xCF, 0x14, 
+0x04, 0x70, 0x31, 0x4C, 0xA6, 0x70, 0x26, 0x36, 
+0xFE, 0x53, 0x93, 0x8D, 0xE6, 0x2D, 0xAC, 0x2E, 
+0x07, 0xCD, 0x49, 0x3E, 0x4A, 0x55, 
+
+0x2C, 0xC8, 0xA0, 0xD9, 0xC0, 0x24, 0xD1, 0xCF, 
+0xCA, 0xA8, 0x46, 0x6A, 0xDD, 0xD9, 0x13, 0x89, 
+0x4F, 0x3B, 0x60, 0x4B, 0x67, 0x5D, 0x4D, 0x27, 
+0x06, 0xD9, 0x53, 0x93, 0x7B, 0x2F, 0xDE, 0xC8, 
+0x0D, 0x9D, 0xD4, 0xFE, 0x66, 0x94, 0xD6, 0x21, 
+0x27, 0x13, 0x79, 0xF5, 0xD6, 0x03, 0xDC, 0xD1, 
+0xD4, 0x84, 0xF9, 0xF1, 0x98, 0xA6, 0xE5, 0xDF, 
+0x2D, 0x57, 0x5A, 0x94, 0xBE, 0xB3, 0x82, 0x72, 
+0x10, 0xF3, 0x91, 0xB6, 0x5A, 0x8F, 0x78, 
+
+0x60, 0xF0, 0xD0, 0x0B, 0xF4, 0xC4, 0xD3, 0x87, 
+0xC2, 0xFF, 0xE7, 0xBA, 0xAC, 0xE0, 0xDB, 0xC5, 
+0x18, 0xAD, 0x7D, 0x3A, 0x91, 0x58, 0x4D, 0xF3, 
+0x31, 0x7F, 0xE0, 0xA7, 0x74, 0xD1, 0xED, 0xD6, 
+0x2D, 0x41, 0x75, 0x99, 0x4D, 0xD4, 0x64, 0x06, 
+0xA2, 0x82, 0x4A, 0x90, 0xF9, 0xCD, 0xCD, 0x81, 
+0x81, 0x61, 0x5F, 0x24, 0x23, 0xEB, 0xB4, 0x82, 
+0xAF, 0x11, 0x53, 0x3E, 0x4F, 0xEF, 0xD6, 0x59, 
+0x6F, 0x2C, 0x57, 0xAC, 0xDE, 0x5E, 0x8A, 0x36, 
+
+0x92, 0x7B, 0xD8, 0x60, 0x39, 0xB8, 0xCA, 0x31, 
+0xC7, 0xE2, 0xEE, 0x19, 0x7E, 0xEE, 0x56, 0x20, 
+0x45, 0xE8, 0xC6, 0xE9, 0x11, 0x3C, 0x88, 0x1F, 
+0xDE, 0xAB, 0x8A, 0x15, 0x23, 0xF2, 0x9A, 0xF5, 
+0x5A, 0x9B, 0x31, 0x97, 0x01, 0x78, 0x68, 0x58, 
+0xE9, 0x0F, 0x1C, 0xEB, 0xE9, 0x2A, 0xA6, 0x22, 
+0xEE, 0xD0, 0x2F, 0x62, 0xF2, 0xBC, 0x1A, 0x4B, 
+0x85, 0x41, 0xD8, 0xBC, 0x50, 0xEF, 0x62, 0xCD, 
+0xBB, 0x58, 0xBA, 0x89, 0x08, 0x04, 0x24, 0x82, 
+0x65, 
+
+0x6E, 0xE6, 0x3A, 0x64, 0xC7, 0xA6, 0x0C, 0xDD, 
+0x7C, 0x51, 0x53, 0xD0, 0xF8, 0x31, 0x6B, 0x3B, 
+0xB2, 0xB0, 0xE2, 0xA0, 0x48, 0x69, 0xC6, 0x56, 
+0x72, 0x6A, 0x49, 0xAC, 0xAD, 0x01, 0xF6, 0x7D, 
+0xA2, 0xEC, 0x35, 0x0C, 0xED, 0xC3, 0xFB, 0xA5, 
+0x01, 0xBD, 0x1A, 0xB1, 0xE5, 0x6A, 0xFF, 0x83, 
+0x1F, 0x1D, 0xD3, 0x07, 0x93, 0x6E, 0xFC, 0xD8, 
+0xC4, 0xC8, 0xD2, 0xFE, 0x64, 0xBF, 0x57, 0xCC, 
+0xE1, 0x62, 0x0B, 0xB6, 0xCF, 0x62, 0xBD, 0x61, 
+0x8C, 0x4B, 
+
+0x50, 0xF4, 0x0B, 0xFB, 0xF5, 0xA8, 0x40, 0xBC, 
+0x2C, 0x47, 0x76, 0x25, 0x4E, 0x7C, 0xC6, 0xC5, 
+0xE4, 0x45, 0xA2, 0x42, 0xDC, 0xAB, 0xFE, 0x2C, 
+0x62, 0xC4, 0xF9, 0x2F, 0x71, 0x1A, 0x7E, 0xBC, 
+0xEF, 0xCB, 0x7B, 0xF2, 0xAE, 0x7D, 0x6A, 0x8A, 
+0x86, 0xCE, 0xEC, 0x6F, 0x47, 0x59, 0xC3, 0x68, 
+0x5D, 0xE3, 0x23, 0x45, 0xF0, 0x86, 0xC6, 0xE9, 
+0x3C, 0x5A, 0x74, 0x88, 0x76, 0x3E, 0x3E, 0x29, 
+0x93, 0xA7, 0xD5, 0x1A, 0x4E, 0xC3, 0xEC, 0x19, 
+0xDD, 0x3F, 0xA8, 
+
+0xC8, 0x39, 0x95, 0x46, 0x07, 0x24, 0x7C, 0x27, 
+0x29, 0xA1, 0x5E, 0xE0, 0x4A, 0x30, 0x47, 0x0D, 
+0x44, 0xB1, 0xFB, 0xE2, 0x0F, 0x33, 0xF5, 0x65, 
+0x31, 0x5E, 0x1B, 0x8F, 0x1D, 0x5E, 0x78, 0x38, 
+0xF8, 0x51, 0x3E, 0xE0, 0x9C, 0x48, 0x2E, 0x72, 
+0x4A, 0x63, 0x5B, 0x94, 0x18, 0x5A, 0xCC, 0xB2, 
+0xF9, 0xC6, 0x86, 0x0D, 0x33, 0x90, 0x3A, 0xDC, 
+0x4A, 0xE9, 0x58, 0x8D, 0xD3, 0x3C, 0x83, 0x36, 
+0x26, 0xF8, 0x73, 0x8A, 0xA2, 0x9B, 0x85, 0x45, 
+0xC0, 0xA0, 0x28, 0xAA, 
+
+0x39, 0x78, 0x1D, 0xCE, 0x66, 0x9F, 0x79, 0xF5, 
+0x69, 0x22, 0x5F, 0x00, 0xA1, 0x10, 0x65, 0xE5, 
+0xBD, 0xCF, 0x08, 0x9E, 0xED, 0x51, 0x6B, 0x4E, 
+0x98, 0x72, 0x46, 0x58, 0xBC, 0x40, 0xD0, 0x5D, 
+0x06, 0x63, 0x7A, 0x9D, 0xF7, 0x01, 0x88, 0xF0, 
+0x9B, 0xD1, 0x81, 0x4A, 0xCB, 0xBF, 0x69, 0x3C, 
+0x05, 0x94, 0xBD, 0x7E, 0x2A, 0xE0, 0x63, 0xDE, 
+0x41, 0xB3, 0x87, 0x9D, 0xD8, 0xE2, 0x34, 0x9C, 
+0xA3, 0xD7, 0x07, 0xE9, 0x46, 0xAF, 0x0F, 0x5B, 
+0x91, 0x3E, 0x39, 0x35, 0xCF, 
+
+0xD9, 0xF6, 0x9D, 0x18, 0xE3, 0x67, 0xFA, 0xB8, 
+0x3E, 0xE5, 0xF8, 0xC6, 0x02, 0x81, 0x63, 0xFC, 
+0xB8, 0xFC, 0xEA, 0x3A, 0xE7, 0xA8, 0x1D, 0x9B, 
+0x31, 0x7B, 0x90, 0x91, 0x1C, 0xBB, 0x7F, 0x4A, 
+0x38, 0x25, 0x44, 0xE0, 0xF7, 0x16, 0xF6, 0x64, 
+0x70, 0x7D, 0xEE, 0x4F, 0x92, 0x71, 0xEC, 0xE2, 
+0x4B, 0x06, 0x94, 0xCE, 0x68, 0x49, 0x78, 0x0C, 
+0x6E, 0x6F, 0x74, 0xE2, 0x3C, 0x7C, 0xA5, 0xAD, 
+0xA6, 0xFD, 0x88, 0x98, 0xF5, 0x3E, 0x63, 0x15, 
+0xCF, 0x91, 0xF0, 0x90, 0xBC, 0x8C, 
+
+0xE0, 0x13, 0xCB, 0xE6, 0x96, 0x81, 0x1E, 0x90, 
+0x93, 0xB7, 0xA2, 0xA8, 0x49, 0x22, 0x6C, 0x55, 
+0xF8, 0xD7, 0xEC, 0x3A, 0x25, 0x12, 0xB3, 0xCB, 
+0x80, 0x8F, 0x47, 0xDB, 0x86, 0x85, 0x2F, 0x02, 
+0x0D, 0x71, 0x4E, 0x55, 0x39, 0xD3, 0x69, 0xF6, 
+0xB3, 0x28, 0x21, 0xB2, 0x80, 0xBA, 0xBF, 0x9F, 
+0x84, 0xA2, 0x41, 0xE6, 0x8F, 0x00, 0x59, 0x2B, 
+0x26, 0x98, 0x18, 0x39, 0xA7, 0xEF, 0xC0, 0xE9, 
+0x3A, 0x71, 0x9E, 0x5D, 0x2F, 0xC6, 0xC7, 0x85, 
+0xE7, 0xD0, 0x03, 0x61, 0x7B, 0xF4, 0x40, 
+
+0x4B, 0x57, 0xC9, 0xC0, 0x09, 0x94, 0xBD, 0x82, 
+0x79, 0x9E, 0x08, 0xC0, 0x5C, 0x38, 0x0C, 0xF2, 
+0xDC, 0x33, 0x56, 0x42, 0xD5, 0x94, 0x28, 0x2F, 
+0x3E, 0xB8, 0xE6, 0x96, 0xA6, 0x54, 0xE2, 0xD5, 
+0x9C, 0x42, 0x6C, 0xC0, 0x73, 0xE8, 0x4D, 0x52, 
+0x15, 0x3F, 0x7F, 0x58, 0xAA, 0xCD, 0x72, 0xDD, 
+0x7F, 0x6A, 0x89, 0xDC, 0x3A, 0xCC, 0x3F, 0x15, 
+0xE6, 0x48, 0xCD, 0xE4, 0x98, 0xEF, 0xC2, 0xD8, 
+0x35, 0xF1, 0x7B, 0xB2, 0x82, 0xF0, 0x03, 0x17, 
+0x1D, 0x28, 0x82, 0x73, 0x13, 0x0A, 0x12, 0xDF, 
+
+0x3F, 0x70, 0xB9, 0xDA, 0x6B, 0xB4, 0xD3, 0xB8, 
+0x4B, 0x9F, 0xFB, 0xAB, 0x3C, 0x95, 0x1C, 0xDE, 
+0x8A, 0xAC, 0xA2, 0x06, 0x58, 0x21, 0x4C, 0xE5, 
+0x2B, 0x11, 0x20, 0x56, 0x8D, 0x69, 0x34, 0x0E, 
+0x9F, 0x88, 0x71, 0x8C, 0x88, 0x88, 0x56, 0x66, 
+0x79, 0x6A, 0x77, 0x32, 0x8C, 0x73, 0xD1, 0x58, 
+0xDA, 0x92, 0xF2, 0xF4, 0x8E, 0x0D, 0x13, 0xAB, 
+0x07, 0xB5, 0xAA, 0x79, 0xB4, 0xCE, 0x1A, 0x11, 
+0x38, 0xBF, 0xD0, 0x26, 0x33, 0x1B, 0x19, 0xC4, 
+0x2E, 0xCD, 0x4D, 0x70, 0x69, 0x4D, 0x04, 0xE1, 
+0x0F, 
+
+0xE3, 0xD1, 0x49, 0x26, 0xD5, 0x5F, 0x2D, 0x10, 
+0x00, 0x08, 0x63, 0x80, 0x35, 0x48, 0xFB, 0x76, 
+0xE4, 0xD8, 0x76, 0x5A, 0xAE, 0x01, 0x6B, 0xFA, 
+0x9C, 0xBA, 0xD8, 0xA0, 0x84, 0x2E, 0xD7, 0x4A, 
+0x38, 0x23, 0x35, 0x61, 0x64, 0xCF, 0xBE, 0x4F, 
+0x2F, 0xC7, 0x3D, 0xD1, 0x59, 0xB4, 0x4E, 0x32, 
+0x85, 0xE9, 0x15, 0x2A, 0xFE, 0x70, 0x98, 0xAE, 
+0xB5, 0x06, 0x04, 0x6D, 0x84, 0xFF, 0x8E, 0xCD, 
+0x16, 0xBB, 0xCB, 0xD1, 0xF5, 0xE6, 0xDD, 0x9D, 
+0xC1, 0x7B, 0x18, 0x09, 0x73, 0x6A, 0x37, 0x1F, 
+0x1C, 0xD4, 
+
+0xEA, 0x1C, 0xD2, 0x04, 0x49, 0x55, 0xCA, 0x41, 
+0xC9, 0x06, 0x03, 0xEA, 0xA4, 0xBF, 0xE2, 0x0E, 
+0xF1, 0x8D, 0xE9, 0xDF, 0x84, 0x54, 0x1E, 0x9E, 
+0xB7, 0xDF, 0xCE, 0x9F, 0xB1, 0x01, 0x81, 0x0C, 
+0xB7, 0x3C, 0x52, 0x19, 0xC2, 0x66, 0x9D, 0x5A, 
+0xF8, 0x05, 0x30, 0x0C, 0x0F, 0x5D, 0x03, 0x06, 
+0x21, 0xF1, 0x9F, 0xD5, 0x9A, 0x46, 0x06, 0xDC, 
+0x2B, 0x15, 0x60, 0x33, 0xF5, 0x35, 0x6B, 0x6D, 
+0x71, 0xA7, 0x30, 0xAD, 0x5A, 0x12, 0xF1, 0x56, 
+0x76, 0x40, 0x3B, 0x1D, 0x41, 0xCE, 0x79, 0x42, 
+0xC6, 0xE7, 0x28, 
+
+0x1D, 0x4A, 0x8F, 0xD9, 0x28, 0x9B, 0xA8, 0x7E, 
+0xCC, 0xF0, 0x6A, 0x3B, 0x34, 0x1B, 0x9E, 0xAE, 
+0xEF, 0x21, 0x67, 0xDE, 0x0C, 0x9E, 0x91, 0xF4, 
+0xD8, 0x0C, 0x27, 0x59, 0x43, 0x58, 0x0C, 0x68, 
+0x13, 0x0D, 0x14, 0xE5, 0x94, 0x71, 0x16, 0x92, 
+0xD6, 0x56, 0x76, 0xF4, 0x15, 0x1E, 0x05, 0xB7, 
+0x4F, 0xF4, 0x60, 0x09, 0xFF, 0x63, 0xF3, 0x70, 
+0xDA, 0xE2, 0x88, 0xCE, 0x8A, 0x12, 0xC5, 0xFD, 
+0x74, 0x73, 0x40, 0xB3, 0x81, 0x01, 0x8B, 0xE2, 
+0xB4, 0x0F, 0xFF, 0xE3, 0x12, 0x5E, 0x88, 0x1F, 
+0x2F, 0x8F, 0x0A, 0xC3, 
+
+0xA2, 0x13, 0x8F, 0x45, 0x90, 0x94, 0xFB, 0xF4, 
+0xC7, 0x4F, 0xA4, 0x84, 0xED, 0x1F, 0xC2, 0x8D, 
+0x2B, 0x1E, 0x14, 0x9A, 0x1C, 0x36, 0xB8, 0xAB, 
+0x02, 0x26, 0x8E, 0xC5, 0x1C, 0xAC, 0xD6, 0xA7, 
+0x6C, 0x8F, 0x37, 0xB8, 0xD2, 0x97, 0x03, 0x35, 
+0x73, 0xD8, 0x47, 0xFF, 0x0A, 0x77, 0x6F, 0x71, 
+0x5B, 0xA4, 0x72, 0xE1, 0x08, 0xE1, 0x6A, 0xA4, 
+0xCD, 0x28, 0xBE, 0x4A, 0x2D, 0x91, 0xE4, 0x46, 
+0xBF, 0xF8, 0xD5, 0xC4, 0x21, 0xEA, 0x8D, 0xB1, 
+0x50, 0x53, 0xBA, 0xB1, 0x10, 0x46, 0x7B, 0xCA, 
+0x12, 0x8E, 0x62, 0x72, 0x86, 
+
+0x41, 0xA0, 0x51, 0x14, 0x6E, 0xC2, 0x95, 0x19, 
+0x81, 0xE7, 0x91, 0x10, 0x66, 0x07, 0xB9, 0x4D, 
+0xC6, 0x4B, 0x86, 0x71, 0x49, 0xF7, 0x3B, 0x36, 
+0xA2, 0x6A, 0x76, 0x75, 0x35, 0x60, 0x36, 0xE1, 
+0x2F, 0x8E, 0xF4, 0xF7, 0x8E, 0x06, 0x13, 0xDA, 
+0xD3, 0x8F, 0x7E, 0xC0, 0xF5, 0xB2, 0x94, 0x8E, 
+0x25, 0xA8, 0x92, 0x43, 0x3F, 0x6F, 0x9B, 0xB2, 
+0x01, 0x09, 0x14, 0x3B, 0xFE, 0xD1, 0xA1, 0x86, 
+0x82, 0x9C, 0x91, 0xBF, 0x6E, 0x46, 0xD7, 0xC0, 
+0x25, 0x76, 0x98, 0xA1, 0xC5, 0x04, 0x2A, 0xCB, 
+0x79, 0x52, 0x91, 0x71, 0x85, 0x33, 
+
+0x8F, 0xE7, 0x89, 0xA4, 0x2C, 0xE0, 0x1E, 0xB0, 
+0x96, 0xA3, 0xF3, 0xC8, 0x7B, 0x97, 0xE0, 0xE1, 
+0x0B, 0xBC, 0x74, 0xE9, 0x2E, 0x3B, 0x6E, 0x81, 
+0xAC, 0x24, 0xA4, 0x76, 0xFA, 0xA0, 0x57, 0x0B, 
+0x19, 0x9D, 0x9F, 0x8B, 0xC3, 0x7E, 0xDE, 0xE7, 
+0xD6, 0x9E, 0xFF, 0x58, 0xBE, 0x29, 0x4B, 0xCB, 
+0x45, 0xF0, 0xA1, 0xCD, 0x46, 0x4E, 0x07, 0x09, 
+0x18, 0x3B, 0x42, 0xA5, 0x81, 0x70, 0x3E, 0x4E, 
+0xEA, 0x4C, 0x48, 0xB4, 0x4F, 0x8A, 0x4A, 0xCC, 
+0xEF, 0x79, 0x49, 0xE2, 0x5A, 0xB3, 0x00, 0x95, 
+0xC0, 0x91, 0xE3, 0xC8, 0x01, 0x43, 0xE8, 
+
+0xE3, 0x0A, 0x58, 0x5B, 0xD4, 0x3E, 0xCB, 0xE1, 
+0x94, 0x45, 0xAC, 0x2C, 0xC3, 0x4D, 0xD1, 0x99, 
+0xF5, 0x8B, 0x3D, 0xFE, 0x3B, 0xB4, 0xD3, 0xAF, 
+0x15, 0x7A, 0xD4, 0x6E, 0xDF, 0x3C, 0xD7, 0x5B, 
+0xC7, 0xC1, 0xC2, 0xD5, 0x8E, 0xDE, 0x6C, 0x0E, 
+0x2B, 0x2A, 0x90, 0x32, 0x79, 0xDE, 0x4F, 0x47, 
+0x4E, 0x59, 0xC0, 0x08, 0x9F, 0xEF, 0x6F, 0x89, 
+0x69, 0xBB, 0xEA, 0xD1, 0x90, 0xA5, 0x1E, 0x37, 
+0x6B, 0x9C, 0x8C, 0xEF, 0x24, 0x04, 0x95, 0xD6, 
+0x1B, 0x45, 0x8A, 0x64, 0xE0, 0xE3, 0x62, 0x0C, 
+0x4C, 0xC5, 0x3F, 0x52, 0xC3, 0x4B, 0x4A, 0x37, 
+
+0xFD, 0xE1, 0xC3, 0x99, 0x7B, 0xC0, 0x48, 0x7D, 
+0xFB, 0x88, 0xCC, 0x40, 0x37, 0xFE, 0xEC, 0xD4, 
+0x0B, 0x52, 0xD6, 0x07, 0x11, 0x81, 0x92, 0x23, 
+0x82, 0xBA, 0xA6, 0xDE, 0x1E, 0x59, 0xE2, 0xE8, 
+0x41, 0xDE, 0x16, 0xC6, 0x69, 0xA2, 0x79, 0x26, 
+0x34, 0x7D, 0xF7, 0x74, 0xA0, 0x43, 0x60, 0xF1, 
+0x2A, 0x8A, 0x95, 0x80, 0x13, 0x35, 0xBE, 0x80, 
+0xE4, 0xEB, 0x01, 0xEB, 0xFD, 0xC1, 0x0C, 0x41, 
+0x45, 0x4F, 0x99, 0xB9, 0x0A, 0x70, 0x17, 0x6F, 
+0xAD, 0x37, 0x1D, 0xB7, 0x01, 0xC1, 0x69, 0x9B, 
+0xB1, 0xA4, 0x08, 0x56, 0x4A, 0xE0, 0x98, 0x21, 
+0xCA, 
+
+0xF6, 0x9B, 0x9C, 0xC0, 0x01, 0xAF, 0x3E, 0xF6, 
+0x43, 0x73, 0xD0, 0x10, 0xFC, 0x1C, 0x0B, 0x83, 
+0xCA, 0xCC, 0x22, 0xD7, 0xEF, 0x1B, 0xF1, 0x57, 
+0x4F, 0x72, 0x61, 0x30, 0xDB, 0x55, 0x57, 0x32, 
+0x90, 0xC3, 0xE9, 0xAC, 0xC3, 0x60, 0x05, 0x25, 
+0x02, 0x66, 0xAA, 0xAE, 0x0C, 0x82, 0xAC, 0xB8, 
+0x0E, 0x92, 0xDD, 0xCD, 0x22, 0x43, 0x2B, 0xAA, 
+0x81, 0x52, 0xF7, 0x7A, 0x33, 0xBE, 0xB4, 0x46, 
+0x45, 0x85, 0x5E, 0x60, 0x0C, 0xA4, 0x16, 0x3E, 
+0x25, 0xD0, 0x9C, 0xCA, 0xEA, 0x6D, 0xB3, 0x77, 
+0x5B, 0x7D, 0x45, 0x84, 0x74, 0x84, 0x74, 0x34, 
+0x36, 0x02, 
+
+0x46, 0xE6, 0x1A, 0xC2, 0x32, 0x34, 0xB7, 0x49, 
+0x05, 0xF5, 0xF9, 0xF3, 0xF3, 0x15, 0x43, 0x1F, 
+0x0A, 0x56, 0x8D, 0x9D, 0x9F, 0xE5, 0xF0, 0xE6, 
+0x36, 0xA3, 0xF3, 0x11, 0x13, 0xA4, 0x92, 0x10, 
+0x98, 0x72, 0x63, 0xBA, 0x02, 0x3D, 0x1A, 0xC7, 
+0x11, 0xFD, 0xCF, 0x8A, 0xBE, 0x4A, 0x55, 0x68, 
+0x77, 0x18, 0xBA, 0x0D, 0x9C, 0x06, 0xE0, 0xA0, 
+0x6F, 0xFB, 0x2E, 0x64, 0x32, 0x4B, 0xE6, 0x48, 
+0x8E, 0xF4, 0xB1, 0x4C, 0xF0, 0xDB, 0x59, 0x95, 
+0xDD, 0xCF, 0x81, 0xB9, 0xAC, 0x0F, 0x22, 0xEF, 
+0x57, 0xCF, 0x7D, 0xCB, 0x64, 0xAF, 0x9B, 0xBA, 
+0x6E, 0x18, 0x17, 
+
+0x11, 0x6F, 0x9C, 0x3D, 0xDF, 0x96, 0x63, 0x20, 
+0xA6, 0x99, 0x3A, 0x35, 0x0E, 0xC7, 0x68, 0x29, 
+0x11, 0x09, 0x4B, 0x2B, 0x21, 0x01, 0xE3, 0x40, 
+0x47, 0x02, 0xD3, 0xF5, 0x38, 0x71, 0xFA, 0x12, 
+0xD3, 0x11, 0xDA, 0x34, 0x88, 0x8F, 0x82, 0x8B, 
+0x5A, 0x0F, 0x79, 0x4A, 0x6D, 0xF4, 0x47, 0x7E, 
+0x6C, 0x1D, 0x5C, 0x28, 0xEA, 0x33, 0x30, 0xFE, 
+0x8D, 0x02, 0xE4, 0x03, 0xAC, 0x3B, 0x2F, 0x8B, 
+0xB9, 0x28, 0xE3, 0x84, 0x72, 0x52, 0x40, 0x2B, 
+0xAA, 0xE8, 0x21, 0xFC, 0x3C, 0xB9, 0xCB, 0x84, 
+0x67, 0x3F, 0xD9, 0xB2, 0x0D, 0xE2, 0xA8, 0x51, 
+0x7C, 0xC1, 0x4C, 0xFC, 
+
+0xF7, 0x64, 0x5F, 0x83, 0x92, 0x4C, 0x81, 0x93, 
+0x7B, 0x5D, 0xE9, 0xB7, 0x09, 0x21, 0x97, 0x61, 
+0x44, 0xB3, 0x4F, 0xF7, 0x21, 0xE5, 0x10, 0x28, 
+0xAC, 0xB6, 0x68, 0x33, 0xCA, 0x26, 0xE6, 0xC7, 
+0x20, 0x0E, 0x22, 0x52, 0x82, 0x5C, 0x8E, 0x91, 
+0xEA, 0x37, 0x54, 0xDC, 0xF2, 0xB0, 0xB8, 0x01, 
+0x39, 0x22, 0x49, 0x68, 0xDD, 0x10, 0x45, 0xAD, 
+0xC8, 0xB0, 0xC6, 0x21, 0x9B, 0x34, 0xB7, 0xE4, 
+0x6B, 0x79, 0x5A, 0xF7, 0x29, 0x47, 0x3E, 0xB9, 
+0xC8, 0xB0, 0x36, 0x29, 0xA8, 0xCB, 0xED, 0xC9, 
+0xDE, 0x8A, 0x1D, 0x5F, 0x05, 0x0C, 0xB5, 0x3A, 
+0x81, 0x7C, 0x3C, 0x0A, 0xC1, 
+
+0x39, 0x4A, 0x6D, 0x50, 0x73, 0x6F, 0xE7, 0x8C, 
+0x21, 0xE2, 0x6A, 0xA4, 0x4D, 0xC0, 0xCC, 0x57, 
+0x79, 0x40, 0x4A, 0xC0, 0x44, 0x01, 0xAC, 0x96, 
+0xBB, 0xD3, 0x84, 0x88, 0x11, 0x98, 0x07, 0x27, 
+0x27, 0x3F, 0x07, 0xA8, 0xB5, 0xBB, 0x7D, 0x4D, 
+0x15, 0x08, 0xAB, 0x56, 0x5A, 0xDF, 0x58, 0xE5, 
+0xBE, 0x2C, 0x04, 0x8B, 0xFD, 0x3A, 0x90, 0xA2, 
+0x9F, 0x75, 0x9C, 0xF5, 0x85, 0xD7, 0x39, 0x7E, 
+0x6C, 0x52, 0x81, 0xDF, 0x2C, 0x41, 0xCE, 0x60, 
+0xB4, 0x00, 0x07, 0xC4, 0x03, 0x3E, 0x5C, 0xA2, 
+0x6A, 0xFC, 0xDA, 0xAD, 0x3A, 0x44, 0x02, 0x10, 
+0x84, 0x80, 0xDD, 0x18, 0xCA, 0x0D, 
+
+0x73, 0x0D, 0x37, 0xB8, 0x4F, 0x79, 0xE7, 0xAE, 
+0x22, 0x63, 0x79, 0x04, 0xEF, 0xDD, 0xFD, 0xD3, 
+0xE7, 0x9C, 0x40, 0x56, 0xCD, 0x13, 0x04, 0xEF, 
+0x35, 0x55, 0x54, 0x95, 0xA8, 0x03, 0x8B, 0x06, 
+0xB9, 0x2F, 0xB0, 0xEB, 0xF9, 0x90, 0x8E, 0x52, 
+0xE7, 0x2C, 0x7A, 0xCB, 0xC9, 0x06, 0x83, 0xF6, 
+0x46, 0xF6, 0x66, 0x56, 0x55, 0x59, 0x1D, 0x16, 
+0xC1, 0x2A, 0xB6, 0x84, 0xCE, 0x40, 0x83, 0x68, 
+0x46, 0x16, 0x2E, 0x74, 0x57, 0x84, 0x9D, 0x3C, 
+0x6A, 0x91, 0xED, 0xBF, 0xE5, 0xB4, 0x9B, 0x21, 
+0x64, 0x8C, 0x5E, 0x77, 0xA8, 0xAE, 0x4D, 0x6C, 
+0x9D, 0xA2, 0x75, 0xD1, 0xEB, 0x46, 0x24, 
+
+0x35, 0x7F, 0xEC, 0x82, 0x88, 0x03, 0x2C, 0xD8, 
+0x57, 0xC4, 0xC2, 0x9A, 0xAC, 0x0F, 0xB3, 0x98, 
+0xAE, 0x31, 0xA0, 0x80, 0x20, 0xDE, 0xD2, 0x6C, 
+0x36, 0x6D, 0x98, 0x72, 0x5E, 0xD1, 0xAF, 0xEA, 
+0x05, 0xD2, 0xCA, 0x2B, 0xD9, 0x72, 0xCE, 0x21, 
+0x57, 0x39, 0xBE, 0x2D, 0x81, 0xBB, 0xAB, 0x1F, 
+0x49, 0x10, 0xC5, 0x47, 0xB0, 0xE3, 0x38, 0xB9, 
+0x86, 0x4D, 0x74, 0x56, 0x25, 0xB1, 0x9F, 0x84, 
+0x1B, 0xE6, 0x19, 0x04, 0xE4, 0x82, 0x10, 0x5A, 
+0x7B, 0x9D, 0x72, 0xA0, 0x2E, 0x0A, 0xFF, 0x07, 
+0x51, 0xBC, 0x6C, 0x28, 0x91, 0x11, 0x29, 0xB6, 
+0xF5, 0xC8, 0xA2, 0x3A, 0xBC, 0xE4, 0xC4, 0xBA, 
+
+0xA4, 0x7E, 0x2E, 0xDA, 0x07, 0xBF, 0x0E, 0xCC, 
+0xBC, 0xA3, 0xD1, 0x8F, 0x8E, 0x69, 0xCC, 0xC7, 
+0x22, 0x9F, 0x3D, 0x38, 0xFF, 0xED, 0x1E, 0x17, 
+0x51, 0x07, 0x42, 0xBC, 0x7A, 0x45, 0x26, 0xAB, 
+0x71, 0x77, 0x84, 0x7B, 0x5A, 0x92, 0x42, 0x40, 
+0x91, 0xF8, 0x25, 0xD5, 0xB0, 0x94, 0x98, 0xC9, 
+0xB4, 0xDF, 0xF5, 0xE5, 0xAA, 0x58, 0x78, 0x20, 
+0xF9, 0xE1, 0x27, 0xA1, 0xD4, 0x7B, 0xDF, 0x6A, 
+0x15, 0x93, 0x1B, 0xA1, 0x6A, 0x04, 0x0B, 0xCC, 
+0x5A, 0x5C, 0xBB, 0xE1, 0x4D, 0xA2, 0x3E, 0x40, 
+0x26, 0x78, 0xF8, 0xCD, 0x5E, 0x6D, 0x74, 0xC1, 
+0x8F, 0xD0, 0xBD, 0x7C, 0x9B, 0x95, 0xCD, 0x53, 
+0x7E, 
+
+0xEA, 0x4D, 0xCC, 0x48, 0xF8, 0x31, 0x57, 0x7E, 
+0x37, 0xA2, 0xFE, 0x7B, 0xB4, 0x61, 0xED, 0xEE, 
+0x13, 0x48, 0x38, 0xAC, 0x16, 0x0E, 0x97, 0xD5, 
+0x4B, 0x0B, 0x8F, 0x56, 0x03, 0x6A, 0x6A, 0x67, 
+0xFD, 0xB1, 0x56, 0xDC, 0x11, 0x7C, 0x31, 0xC9, 
+0x64, 0xA6, 0x97, 0x01, 0x9C, 0xB0, 0x5B, 0x98, 
+0x83, 0x63, 0x81, 0x34, 0x92, 0x81, 0x84, 0x35, 
+0xF5, 0x1C, 0x33, 0x14, 0xDF, 0x2F, 0xB2, 0xC4, 
+0xC2, 0x56, 0x88, 0x75, 0x53, 0x3C, 0xCE, 0x6A, 
+0x1A, 0x95, 0x7C, 0x5F, 0xFA, 0x67, 0x94, 0x6D, 
+0x8B, 0xA9, 0xC0, 0x5A, 0x81, 0xBD, 0x25, 0x66, 
+0x98, 0xE8, 0x4C, 0x29, 0x78, 0x8D, 0x81, 0xE2, 
+0x10, 0x96, 
+
+0x51, 0x2B, 0x19, 0x02, 0xEE, 0xA9, 0xC8, 0x5C, 
+0x2F, 0x33, 0x36, 0xBF, 0x3E, 0x42, 0xEA, 0xCF, 
+0x13, 0x87, 0x45, 0x84, 0x47, 0xFB, 0x8F, 0x46, 
+0x02, 0x01, 0x90, 0xA7, 0xF9, 0x55, 0xD9, 0xCE, 
+0x38, 0x02, 0x99, 0x68, 0xB0, 0xDA, 0x32, 0x46, 
+0x97, 0x67, 0x7A, 0x82, 0x41, 0xD6, 0x9E, 0xA3, 
+0xE8, 0x9B, 0x53, 0x07, 0xE0, 0x66, 0xBE, 0xE5, 
+0x5E, 0x51, 0x5C, 0x0D, 0xEF, 0xBA, 0x77, 0x2F, 
+0x79, 0x7A, 0xC6, 0x90, 0xFE, 0xEA, 0x3F, 0xE4, 
+0xCD, 0x68, 0x72, 0x18, 0xB5, 0x5C, 0x83, 0x72, 
+0x53, 0x8D, 0xC5, 0x4E, 0x06, 0x45, 0x0D, 0xD8, 
+0x18, 0x5E, 0x59, 0x77, 0xE6, 0x1F, 0x2D, 0xE7, 
+0x3B, 0xA4, 0x70, 
+
+0x9D, 0x22, 0x80, 0x9A, 0xD8, 0x7A, 0x18, 0x87, 
+0x15, 0x0D, 0x0F, 0x5C, 0x9C, 0x0E, 0xB6, 0x48, 
+0x12, 0xD9, 0x2B, 0xDD, 0x5E, 0xF4, 0xEC, 0x2B, 
+0x91, 0xC0, 0xE9, 0xDD, 0xBB, 0x0A, 0x0D, 0x5D, 
+0xF0, 0xD0, 0x6C, 0x68, 0xE0, 0xE5, 0xCF, 0x3C, 
+0x33, 0x5A, 0x92, 0xAE, 0xCB, 0x5A, 0x0F, 0x36, 
+0x71, 0x5F, 0xC9, 0x51, 0x0B, 0xFF, 0xF4, 0x8F, 
+0x77, 0xD6, 0xCD, 0x3F, 0x0B, 0x4B, 0xC2, 0x98, 
+0xEB, 0x26, 0xAA, 0xA4, 0x8A, 0xDB, 0x1C, 0x3E, 
+0xFA, 0xC9, 0x11, 0x17, 0xE7, 0x87, 0x43, 0x07, 
+0xAD, 0xB1, 0xC7, 0x92, 0x00, 0x47, 0xF6, 0xC9, 
+0xE7, 0xDA, 0x26, 0x1B, 0x4F, 0xF0, 0x9D, 0x59, 
+0x79, 0x36, 0xFA, 0x57, 
+
+0xFB, 0xC1, 0x43, 0xF7, 0x3B, 0xFC, 0x72, 0x9F, 
+0xAC, 0x7C, 0x9C, 0x12, 0x72, 0xC1, 0xD1, 0x26, 
+0x0E, 0xDD, 0xDD, 0x63, 0x0A, 0x06, 0x9E, 0xFF, 
+0xEE, 0xCF, 0xA6, 0x10, 0x0E, 0x21, 0xFC, 0x0F, 
+0xBF, 0xA3, 0x24, 0xC2, 0x1B, 0x30, 0x2E, 0x5F, 
+0x9C, 0x79, 0x59, 0xC2, 0xAF, 0x76, 0x6B, 0x1B, 
+0xB9, 0x1D, 0xA1, 0xDE, 0xA8, 0xC1, 0x22, 0x91, 
+0xEF, 0x98, 0xEF, 0x8E, 0xE1, 0x64, 0x04, 0xC4, 
+0xE2, 0x5C, 0x37, 0x0B, 0x77, 0x05, 0x85, 0x6B, 
+0x79, 0x58, 0xA2, 0x1C, 0xA5, 0x2B, 0xCE, 0xB3, 
+0xA2, 0xD0, 0x41, 0x65, 0x50, 0x71, 0x4D, 0x6F, 
+0xFF, 0x19, 0x8E, 0xEC, 0xF4, 0x52, 0xFA, 0x3B, 
+0x3C, 0x4F, 0xA2, 0x35, 0x70, 
+
+0xCC, 0x79, 0xEF, 0xBB, 0x6D, 0x12, 0xF7, 0x80, 
+0xEB, 0xE0, 0x36, 0x05, 0xA4, 0x96, 0x2E, 0x1B, 
+0x34, 0x89, 0xEC, 0x8A, 0xE3, 0xEE, 0x85, 0x5C, 
+0x77, 0xAA, 0x0C, 0x54, 0x63, 0x4F, 0xBD, 0x27, 
+0x8C, 0xDC, 0x02, 0x84, 0x44, 0x4F, 0xF5, 0xE5, 
+0x0F, 0xD4, 0x19, 0x7C, 0xC5, 0x95, 0x0D, 0x72, 
+0x9B, 0xC0, 0x7D, 0x9F, 0xFC, 0x8F, 0xF0, 0xEC, 
+0x2E, 0x47, 0x11, 0x28, 0x5E, 0x77, 0x53, 0x4F, 
+0x7E, 0xA4, 0x08, 0xA0, 0x08, 0x7E, 0xE2, 0x49, 
+0x9E, 0x78, 0x2B, 0xC9, 0xF4, 0xED, 0xEF, 0x59, 
+0xE2, 0x06, 0xCD, 0xE5, 0x9D, 0xEB, 0x14, 0x6F, 
+0xC2, 0x2C, 0x49, 0x9A, 0xB8, 0xD2, 0x42, 0x86, 
+0x19, 0x37, 0xBC, 0x53, 0x11, 0x45, 
+
+0xF7, 0x11, 0x64, 0x41, 0xC4, 0x6D, 0xF4, 0xD1, 
+0x52, 0x1E, 0x1C, 0x67, 0xE9, 0xA2, 0x5D, 0x78, 
+0x06, 0x72, 0x33, 0x04, 0x56, 0xB6, 0x45, 0x02, 
+0x6E, 0x2D, 0x96, 0xC1, 0xC4, 0x5E, 0xED, 0xB8, 
+0x0A, 0x0F, 0x11, 0xC5, 0xC3, 0x8A, 0xE3, 0x1C, 
+0xD0, 0x7B, 0x5A, 0xF6, 0xFF, 0x3C, 0x8C, 0xA5, 
+0xC9, 0xC3, 0x59, 0xD0, 0x66, 0x25, 0xD9, 0xD9, 
+0x8C, 0xAE, 0xBC, 0xD4, 0xCC, 0x34, 0x33, 0x49, 
+0xE3, 0x89, 0xB6, 0xA6, 0xA9, 0x0A, 0xDC, 0xF2, 
+0x5D, 0x5A, 0xAE, 0x4E, 0x01, 0xD6, 0xAE, 0x19, 
+0x62, 0xF1, 0x7A, 0x71, 0x3B, 0x8D, 0xC0, 0xC7, 
+0x51, 0x74, 0x8A, 0x0F, 0xE1, 0x77, 0xC5, 0x86, 
+0xDD, 0x67, 0x16, 0x4C, 0x48, 0x72, 0x54, 
+
+0xB8, 0x5B, 0x05, 0x41, 0xD6, 0xD7, 0x6D, 0x9A, 
+0x37, 0x98, 0x6E, 0x93, 0xF5, 0x0A, 0x81, 0x6C, 
+0xC9, 0xB4, 0x92, 0x0D, 0xF5, 0x8D, 0x9F, 0x2E, 
+0x11, 0x03, 0x87, 0x70, 0xC2, 0xF2, 0x8C, 0x98, 
+0x89, 0x57, 0xC7, 0x7C, 0x2B, 0x17, 0xF8, 0xD8, 
+0x37, 0x3F, 0xCC, 0xAC, 0xCB, 0x14, 0xF8, 0x30, 
+0xE7, 0xA9, 0x15, 0x92, 0xDA, 0x77, 0xB2, 0xE7, 
+0xFB, 0x59, 0x95, 0x1D, 0x2D, 0x6F, 0x44, 0x61, 
+0xF8, 0xE4, 0x4D, 0xDD, 0xEF, 0xB1, 0xAE, 0x5A, 
+0x92, 0x0E, 0x45, 0x52, 0xBA, 0x29, 0x55, 0xBF, 
+0x0D, 0x41, 0x83, 0xAC, 0x12, 0x21, 0x51, 0x5A, 
+0x61, 0x7A, 0x7F, 0x91, 0x73, 0xE1, 0x5B, 0x9F, 
+0xAC, 0x7D, 0x56, 0xAB, 0x88, 0x36, 0x19, 0x79, 
+
+0xD3, 0x2D, 0x5A, 0x7E, 0x5C, 0x73, 0x00, 0xA7, 
+0x1C, 0x73, 0x1D, 0xA5, 0x47, 0xC8, 0x38, 0x2F, 
+0x38, 0x79, 0xC6, 0x20, 0xF5, 0x7B, 0x9E, 0x9D, 
+0x89, 0x7A, 0xAF, 0x7F, 0xD1, 0x80, 0x66, 0x65, 
+0xF3, 0x6B, 0xF2, 0xE5, 0xB0, 0x31, 0xB3, 0x2E, 
+0x0E, 0x9F, 0x3C, 0x1D, 0x0C, 0xEC, 0x07, 0xD2, 
+0x82, 0x96, 0xA8, 0x1F, 0xBE, 0x81, 0x62, 0xE1, 
+0xEE, 0x6A, 0x5B, 0x56, 0xA6, 0xEE, 0xB7, 0xF6, 
+0x10, 0x4E, 0xD7, 0xE5, 0xD4, 0xC3, 0x2E, 0x0D, 
+0x4F, 0xD3, 0x1E, 0xCF, 0x70, 0x12, 0x67, 0x8F, 
+0x48, 0x80, 0x70, 0x6E, 0x4C, 0x41, 0x24, 0x16, 
+0xBC, 0x84, 0x10, 0xA0, 0x08, 0x32, 0x26, 0xE4, 
+0xCA, 0x77, 0x9D, 0xC3, 0x6F, 0x36, 0x4B, 0xFD, 
+0xB9, 
+
+0x79, 0x24, 0x8A, 0x68, 0x64, 0xB7, 0xBB, 0xA6, 
+0x1B, 0x38, 0x62, 0xB2, 0x66, 0x3F, 0xB2, 0x48, 
+0x7C, 0x2E, 0x06, 0xDA, 0xD4, 0xFE, 0xF0, 0xF6, 
+0x29, 0x3C, 0xBB, 0xEE, 0xBD, 0x17, 0x0C, 0xD1, 
+0x41, 0xF4, 0x1F, 0x98, 0xFA, 0x95, 0x21, 0x8E, 
+0x6D, 0x0B, 0x0D, 0x91, 0xD7, 0xAF, 0x71, 0xA6, 
+0xD1, 0xED, 0x20, 0x53, 0x5B, 0xC6, 0xA3, 0x2D, 
+0xBF, 0x8D, 0xC5, 0xF8, 0x55, 0x50, 0xDF, 0x5F, 
+0x78, 0xCB, 0xDD, 0xE7, 0x67, 0xF5, 0x81, 0xAC, 
+0xC7, 0xC2, 0x44, 0xB0, 0x06, 0xDC, 0xC7, 0x0A, 
+0x1C, 0xC5, 0xC7, 0xC7, 0x15, 0xC2, 0x4F, 0xB6, 
+0xA2, 0x1F, 0xF4, 0x27, 0x3D, 0x58, 0x53, 0xF2, 
+0x7C, 0x05, 0xB7, 0x22, 0xEF, 0x18, 0xBB, 0x98, 
+0x13, 0xBE, 
+
+0x16, 0xED, 0x2E, 0xA1, 0xC4, 0x65, 0x46, 0x28, 
+0x8E, 0xBA, 0x9F, 0xF7, 0x46, 0x9D, 0x96, 0x12, 
+0xA1, 0xC8, 0x74, 0xDC, 0xCA, 0xD9, 0x8A, 0x23, 
+0x83, 0x98, 0x9F, 0xDC, 0x0A, 0xA1, 0x30, 0x58, 
+0xD3, 0xA4, 0x3C, 0x37, 0x0E, 0x3D, 0x2E, 0xAA, 
+0xD0, 0x49, 0x13, 0x37, 0xE1, 0xC2, 0x0F, 0x17, 
+0x6D, 0xE4, 0xA0, 0xE8, 0x1D, 0x07, 0x76, 0x18, 
+0xCE, 0xF2, 0xB2, 0x87, 0x1E, 0x91, 0x9D, 0x64, 
+0xE9, 0x6E, 0xA7, 0x19, 0x7C, 0xB1, 0xBA, 0x61, 
+0x26, 0xCA, 0x79, 0xC8, 0x61, 0x27, 0xA8, 0x97, 
+0x61, 0x1D, 0xEA, 0x7A, 0xB3, 0xA8, 0x60, 0xFF, 
+0x70, 0xB9, 0x82, 0x3E, 0x66, 0x36, 0x68, 0x95, 
+0xC0, 0x66, 0xDB, 0x19, 0xED, 0x8E, 0x0F, 0x5B, 
+0x52, 0x30, 0xF3, 
+
+0xE6, 0x94, 0x82, 0x7D, 0x08, 0xD8, 0xE8, 0x70, 
+0x4D, 0xB2, 0x3F, 0x1E, 0x69, 0x99, 0xD5, 0xB7, 
+0x22, 0x4A, 0x37, 0x10, 0x1A, 0xC8, 0x2D, 0x77, 
+0x0C, 0xAD, 0xEB, 0xCB, 0xE0, 0xC1, 0xCE, 0xA4, 
+0xA8, 0x14, 0x39, 0xDA, 0xA2, 0xE5, 0x35, 0xCB, 
+0x15, 0x6A, 0xFF, 0x94, 0xD9, 0x04, 0x2A, 0x54, 
+0x89, 0x61, 0xCC, 0x85, 0x2F, 0xDA, 0x92, 0xB1, 
+0x87, 0xFF, 0x48, 0x30, 0x63, 0x49, 0x93, 0x12, 
+0x67, 0xBA, 0xAB, 0xE2, 0x5A, 0xEF, 0x1A, 0x86, 
+0x70, 0xA6, 0x61, 0xE4, 0xD3, 0x97, 0x97, 0xC7, 
+0x6B, 0x74, 0xEA, 0xE2, 0x50, 0x3E, 0xA5, 0x0B, 
+0x78, 0xCB, 0x0F, 0xCD, 0xBF, 0x97, 0x4E, 0x47, 
+0x66, 0x08, 0x1A, 0xD0, 0x3D, 0x7B, 0x08, 0x15, 
+0x93, 0x8D, 0xDD, 0xD7, 
+
+0x51, 0x69, 0x04, 0x24, 0xEC, 0xEA, 0x75, 0x6B, 
+0x77, 0x5A, 0xAB, 0xDD, 0x71, 0x69, 0x84, 0xDB, 
+0xE2, 0x9D, 0x9F, 0x31, 0x3A, 0x9C, 0x73, 0xDD, 
+0x5F, 0xA0, 0xB7, 0xED, 0x19, 0x48, 0x48, 0x84, 
+0x83, 0x72, 0xF7, 0xA0, 0xBB, 0xCE, 0x4B, 0xF4, 
+0x5A, 0x1B, 0xF1, 0xF5, 0xCA, 0x98, 0x30, 0xF7, 
+0x4B, 0x28, 0x1D, 0x8F, 0x29, 0x44, 0xB9, 0xC4, 
+0xF3, 0xF6, 0x25, 0xB6, 0x54, 0x42, 0x99, 0x56, 
+0xB8, 0x4B, 0x8E, 0xE2, 0x4B, 0x0E, 0x6B, 0xB5, 
+0xAF, 0x0A, 0xFB, 0x76, 0x91, 0xCD, 0x75, 0x8D, 
+0xF5, 0xF6, 0x77, 0x0A, 0x93, 0x95, 0x29, 0x63, 
+0x8F, 0xF8, 0x93, 0x10, 0xAB, 0xA4, 0xA1, 0x1C, 
+0xA6, 0x89, 0xBB, 0xD1, 0x53, 0x74, 0xE0, 0xBC, 
+0xF6, 0xCA, 0xB4, 0x7B, 0xF9, 
+
+0x07, 0x90, 0x22, 0x23, 0x8A, 0x28, 0xA2, 0xD2, 
+0x08, 0x30, 0x5B, 0x3F, 0xBF, 0xEF, 0x6C, 0x3D, 
+0x86, 0x9F, 0xB3, 0xCB, 0xD4, 0x25, 0xC6, 0x4E, 
+0xB6, 0x3B, 0x6A, 0x49, 0x49, 0x2C, 0xA7, 0x4D, 
+0x38, 0x96, 0xC4, 0xF5, 0x11, 0x18, 0x29, 0x0D, 
+0x33, 0x4D, 0x39, 0xAB, 0x52, 0xE5, 0x17, 0xBF, 
+0xD4, 0x02, 0x04, 0xFC, 0xF1, 0x73, 0x9B, 0x32, 
+0x5D, 0x2F, 0x81, 0xDB, 0xB4, 0xE0, 0x57, 0x3B, 
+0x4E, 0xC7, 0x2C, 0x1E, 0xAA, 0x4D, 0x8E, 0x3C, 
+0xB2, 0x8D, 0xFC, 0xF0, 0xF1, 0x76, 0x2F, 0x12, 
+0xF9, 0x47, 0xB1, 0x59, 0x91, 0xFA, 0x6F, 0xC8, 
+0xDE, 0x82, 0x99, 0xA3, 0x36, 0xFE, 0xDA, 0x21, 
+0x6F, 0x3D, 0xEA, 0xE9, 0x3E, 0x79, 0xAE, 0x58, 
+0x15, 0x00, 0x0E, 0xA2, 0xB0, 0x73, 
+
+0xC7, 0x35, 0xCA, 0x54, 0x5D, 0x74, 0x82, 0x0D, 
+0x8F, 0xF7, 0x22, 0x3A, 0x2E, 0x72, 0x75, 0x23, 
+0x50, 0x07, 0x3B, 0xA6, 0x5D, 0x6B, 0xD3, 0x70, 
+0x02, 0x6E, 0x02, 0xDB, 0xE0, 0xAB, 0x87, 0x0F, 
+0xDA, 0xB4, 0x11, 0xAD, 0xED, 0x90, 0xE2, 0x5B, 
+0xA4, 0xCE, 0x44, 0xAF, 0x6D, 0x4D, 0x97, 0x33, 
+0x1D, 0xB9, 0x3D, 0x79, 0x57, 0x21, 0xB7, 0x68, 
+0xE9, 0x59, 0x60, 0x47, 0xB7, 0xFF, 0xD7, 0x70, 
+0xE7, 0x50, 0x86, 0x85, 0xAC, 0x12, 0x9E, 0x0E, 
+0x4D, 0x78, 0xE7, 0x5A, 0x91, 0x61, 0xEB, 0xFD, 
+0xDD, 0x6F, 0x7E, 0x86, 0x84, 0x8A, 0x99, 0x3B, 
+0xE8, 0xD6, 0xA4, 0x1A, 0x54, 0x9F, 0x62, 0xA8, 
+0x56, 0x1D, 0x08, 0x44, 0x82, 0xBE, 0x7C, 0x91, 
+0x4E, 0xA8, 0x06, 0xCB, 0x8C, 0x08, 0xE4, 
+
+0x8F, 0x10, 0x8A, 0xE5, 0xB5, 0xDC, 0xAD, 0x06, 
+0x67, 0x3E, 0xC9, 0x96, 0x4E, 0xB5, 0x6E, 0x43, 
+0x6C, 0x1B, 0x4D, 0x72, 0x39, 0xCF, 0x0D, 0xFC, 
+0x1C, 0xFA, 0x4F, 0x07, 0x31, 0xA7, 0xF8, 0xE6, 
+0xC5, 0xC7, 0xA0, 0x6D, 0xA8, 0x8F, 0x97, 0x4B, 
+0x69, 0xD4, 0x00, 0x98, 0xCC, 0x54, 0x92, 0x2A, 
+0xEC, 0xAA, 0x68, 0xF9, 0x97, 0xE1, 0xA1, 0x06, 
+0x6D, 0x97, 0x61, 0x53, 0x7B, 0x68, 0x2E, 0x6D, 
+0xA2, 0x74, 0x59, 0x4B, 0xA8, 0x38, 0xD8, 0xCA, 
+0x78, 0x68, 0xD2, 0x5D, 0x48, 0xA6, 0x59, 0x00, 
+0xAB, 0xBD, 0xD0, 0x60, 0xA2, 0xF6, 0xA4, 0xCB, 
+0x55, 0x08, 0xDE, 0x13, 0x81, 0xF6, 0x73, 0x83, 
+0xA5, 0x08, 0xAB, 0x9D, 0x08, 0xA3, 0x39, 0x82, 
+0x5F, 0xB6, 0xD8, 0xE4, 0x6C, 0x49, 0x14, 0x2D, 
+
+0xD6, 0x37, 0x37, 0x5B, 0x1A, 0x58, 0xD2, 0x07, 
+0x10, 0xE8, 0x1D, 0x02, 0x45, 0xA2, 0xEC, 0xB8, 
+0x3E, 0xCA, 0x9C, 0xDA, 0xFC, 0x84, 0x08, 0x14, 
+0x94, 0x39, 0x71, 0x72, 0xC3, 0x48, 0xDB, 0xFA, 
+0xFC, 0xD0, 0x4F, 0x4E, 0x3C, 0x31, 0x2F, 0x80, 
+0x12, 0x9B, 0xD5, 0xD2, 0xC2, 0xDE, 0x8B, 0xC4, 
+0x68, 0x4E, 0x48, 0x99, 0x55, 0x80, 0xC6, 0x0F, 
+0x5A, 0xF3, 0xBA, 0xF2, 0x41, 0x25, 0xA6, 0xAB, 
+0xA8, 0x60, 0x9A, 0x57, 0xC7, 0x01, 0xA6, 0x2C, 
+0x72, 0x82, 0x0C, 0xBB, 0xFD, 0x0B, 0xF0, 0xDF, 
+0x68, 0x0C, 0xE5, 0x06, 0x96, 0x56, 0x6D, 0xEB, 
+0x86, 0x12, 0x04, 0x49, 0x25, 0xBE, 0x4F, 0x6D, 
+0x01, 0x17, 0xC5, 0x9A, 0x7D, 0xE0, 0xFE, 0x64, 
+0xC7, 0x4B, 0xAE, 0x58, 0x9E, 0xA9, 0xCE, 0x27, 
+0xE8, 
+
+0x12, 0x50, 0xDE, 0xA0, 0x00, 0xD1, 0x3E, 0x52, 
+0x69, 0x1D, 0xFE, 0x43, 0x1C, 0xF9, 0x42, 0xEF, 
+0xE4, 0x21, 0x23, 0xF1, 0xF1, 0xCA, 0xFE, 0xF6, 
+0x6C, 0xE4, 0x26, 0x4C, 0x66, 0x41, 0x36, 0x2D, 
+0x08, 0x53, 0x57, 0x92, 0x71, 0x80, 0x3B, 0xA0, 
+0x0B, 0xE1, 0x3D, 0xE3, 0xA0, 0x27, 0xA1, 0xC1, 
+0x9C, 0x0F, 0xC8, 0x3D, 0xB9, 0x54, 0x48, 0x6A, 
+0x4A, 0x9A, 0x95, 0x66, 0x73, 0x2C, 0x01, 0xAD, 
+0x82, 0x96, 0x4E, 0xBC, 0x1B, 0x80, 0x64, 0xEC, 
+0x7A, 0x78, 0x9A, 0x9A, 0x6B, 0x8D, 0x3B, 0x1E, 
+0x37, 0x8C, 0xD0, 0x6A, 0x99, 0x5B, 0x1A, 0x2B, 
+0xD7, 0x82, 0xC5, 0x45, 0x73, 0x22, 0xBA, 0xD2, 
+0x07, 0x60, 0x80, 0x5F, 0xDD, 0x26, 0xA2, 0xC3, 
+0x93, 0xBB, 0x4D, 0x78, 0xA5, 0x47, 0x7C, 0x62, 
+0x3D, 0x43, 
+
+0xD5, 0xAD, 0x3B, 0xF5, 0x00, 0xF5, 0xBD, 0x4B, 
+0xBA, 0x30, 0x7E, 0xCB, 0x26, 0x32, 0x5E, 0x8C, 
+0x93, 0x70, 0x8E, 0xFB, 0x99, 0x13, 0x4A, 0xAF, 
+0x7D, 0xEE, 0xA6, 0x90, 0xCD, 0x1F, 0x70, 0xF9, 
+0x37, 0x7E, 0xD7, 0xDE, 0xFF, 0x25, 0xA7, 0x02, 
+0x70, 0xDE, 0x58, 0x1B, 0x40, 0x72, 0xD9, 0x39, 
+0x56, 0x32, 0x63, 0x8B, 0xCE, 0x66, 0x2D, 0x35, 
+0x7C, 0xD5, 0xD3, 0x62, 0xB0, 0x52, 0x1F, 0xDB, 
+0x42, 0xA2, 0xAA, 0xA7, 0xFF, 0xEB, 0xD8, 0xD5, 
+0x1D, 0xEB, 0x26, 0x00, 0x20, 0xA8, 0x59, 0xAB, 
+0xBC, 0xFD, 0x0C, 0x52, 0x55, 0x3F, 0x8E, 0x2F, 
+0x90, 0x91, 0x4A, 0xDC, 0x29, 0x8D, 0x02, 0xBA, 
+0x58, 0xA8, 0x51, 0xCF, 0x31, 0x27, 0x4A, 0x62, 
+0x6D, 0xDF, 0x2A, 0x82, 0x71, 0xF4, 0xDC, 0x01, 
+0x34, 0x76, 0x73, 
+
+0x9F, 0x43, 0xC1, 0x1B, 0xA7, 0x45, 0x2F, 0x24, 
+0x76, 0xF2, 0xAE, 0x75, 0xA6, 0xAE, 0x41, 0xBA, 
+0x4A, 0xB9, 0x1F, 0xA0, 0xA1, 0x7D, 0xE0, 0x39, 
+0x0B, 0x97, 0xB2, 0xB0, 0x8B, 0x31, 0x7A, 0x65, 
+0x8B, 0x9C, 0x85, 0xB9, 0x04, 0x3A, 0xC2, 0x95, 
+0x23, 0x3A, 0xD8, 0x8C, 0x5A, 0xCC, 0x66, 0x4C, 
+0xB4, 0xF4, 0x60, 0x14, 0x66, 0x08, 0x94, 0x9D, 
+0x31, 0x83, 0xD2, 0x82, 0x26, 0x86, 0x77, 0x74, 
+0x57, 0x81, 0x22, 0xB6, 0xE0, 0xDB, 0x75, 0x0A, 
+0x6F, 0x2F, 0xCC, 0x84, 0x33, 0x01, 0x8A, 0x8A, 
+0xD4, 0x4D, 0xAC, 0x88, 0xFD, 0x46, 0x9D, 0xBA, 
+0xDC, 0x33, 0xA0, 0x12, 0x37, 0x43, 0xDE, 0x8A, 
+0x43, 0xF0, 0x1E, 0xDE, 0xBD, 0x4D, 0x0F, 0x05, 
+0x7A, 0x40, 0x2C, 0x59, 0x24, 0x71, 0xEC, 0x11, 
+0x89, 0xAF, 0x04, 0xA8, 
+
+0xAA, 0xBD, 0xEE, 0x64, 0x92, 0xC8, 0x60, 0x1C, 
+0x63, 0x30, 0xB0, 0x83, 0x12, 0x7A, 0x87, 0x61, 
+0x20, 0xBE, 0x69, 0x2D, 0xBB, 0x39, 0xA1, 0x2C, 
+0x9B, 0xDD, 0x0F, 0xB8, 0x8E, 0x0C, 0x5B, 0xED, 
+0xA0, 0xBC, 0x2C, 0xB7, 0xE9, 0x8F, 0x88, 0xCD, 
+0x7B, 0x7D, 0x5E, 0x0F, 0xF7, 0xBD, 0xF1, 0x33, 
+0xF8, 0x8E, 0xA2, 0xCC, 0xF0, 0x75, 0xB3, 0xB9, 
+0xDA, 0x6A, 0xF8, 0x86, 0xC5, 0x24, 0x91, 0x7D, 
+0x1F, 0x80, 0x7E, 0xEA, 0x21, 0xE1, 0x99, 0xB3, 
+0xD8, 0x6C, 0x6B, 0x0E, 0x30, 0xBE, 0xF1, 0xF7, 
+0x02, 0xE6, 0x5F, 0x5E, 0x69, 0x28, 0xB4, 0x61, 
+0x00, 0x74, 0xC2, 0x8A, 0x85, 0x56, 0x0A, 0xB5, 
+0xB8, 0x0E, 0x3E, 0x05, 0xCD, 0x32, 0x94, 0xBE, 
+0xC5, 0x72, 0x76, 0x5E, 0x09, 0x12, 0x3D, 0x32, 
+0xFB, 0xB3, 0xF8, 0x2F, 0x67, 
+
+0xF1, 0x2D, 0xF0, 0xD5, 0x59, 0x3E, 0xD5, 0xB6, 
+0xBF, 0xBC, 0xDC, 0x92, 0x54, 0xD2, 0xC5, 0x5D, 
+0xB8, 0x07, 0x20, 0x6B, 0x1D, 0x4C, 0x00, 0x20, 
+0x25, 0x5D, 0x8B, 0x08, 0xBC, 0xA6, 0x05, 0x72, 
+0xCD, 0x39, 0xBF, 0xAC, 0x8E, 0xE4, 0x90, 0xE4, 
+0x80, 0x5A, 0x47, 0xBB, 0x46, 0x55, 0x4D, 0x0A, 
+0x8B, 0xD1, 0x63, 0x84, 0xCD, 0x27, 0x61, 0x6B, 
+0x1C, 0x7F, 0x01, 0x3C, 0x5D, 0x31, 0x5A, 0x33, 
+0x5A, 0xA8, 0x81, 0x76, 0xB4, 0x31, 0xD4, 0x6F, 
+0x95, 0x8B, 0x1E, 0x0E, 0xAD, 0xB0, 0x6F, 0x5F, 
+0xAD, 0xF4, 0x69, 0x55, 0x7B, 0x80, 0x3A, 0x1F, 
+0xE4, 0x5E, 0x61, 0x08, 0x05, 0xBC, 0xFB, 0x1B, 
+0x69, 0x10, 0x01, 0x2F, 0xDC, 0x4D, 0xC3, 0xDB, 
+0x79, 0x95, 0x79, 0x3B, 0x3F, 0xA3, 0xB5, 0x84, 
+0xCB, 0x47, 0x36, 0x59, 0xAC, 0xB6, 
+
+0xAB, 0x20, 0xD1, 0xDC, 0x47, 0x8A, 0x36, 0x30, 
+0x79, 0xFC, 0x5E, 0xD3, 0x11, 0x12, 0x75, 0x4D, 
+0x95, 0x99, 0x51, 0xFC, 0x65, 0x0D, 0xB2, 0x69, 
+0x32, 0x86, 0x68, 0x68, 0xF5, 0x98, 0x50, 0xB6, 
+0x98, 0x94, 0xF9, 0xFE, 0xE1, 0x84, 0xE5, 0x83, 
+0xE1, 0xE5, 0x54, 0x21, 0xFF, 0xBF, 0x5C, 0x2F, 
+0x45, 0x13, 0xFC, 0x28, 0x35, 0xBF, 0x2A, 0xB0, 
+0x1F, 0x84, 0x3D, 0x47, 0xC3, 0x6A, 0xD8, 0xC7, 
+0x5A, 0x69, 0x44, 0xE6, 0xCA, 0xDA, 0x36, 0xB2, 
+0xB4, 0xB7, 0x11, 0x96, 0x58, 0x43, 0x94, 0xCC, 
+0xA0, 0xA1, 0xA2, 0xF3, 0x23, 0x69, 0x5E, 0x5F, 
+0xAC, 0x25, 0x36, 0xBE, 0xFC, 0x6B, 0x0A, 0xEC, 
+0x04, 0xA0, 0xCB, 0xFE, 0x7B, 0xA8, 0x71, 0x3D, 
+0xE9, 0x32, 0xF0, 0xBD, 0xC2, 0x7F, 0x00, 0xEE, 
+0x69, 0xB1, 0xD8, 0x67, 0x72, 0x29, 0xDF, 
+
+0x51, 0x9B, 0x87, 0x96, 0xA0, 0x4C, 0xB5, 0x62, 
+0xA9, 0xFE, 0x7A, 0x3F, 0xCF, 0xF2, 0xC3, 0x0A, 
+0x4B, 0x05, 0xF2, 0x23, 0xE3, 0x6B, 0xEE, 0x67, 
+0x41, 0x91, 0xF9, 0xC7, 0xBC, 0x7E, 0x3A, 0xED, 
+0xC9, 0xAC, 0xA9, 0xD7, 0xEF, 0x6E, 0xD8, 0x7D, 
+0x5D, 0xAD, 0x4A, 0xF4, 0x5D, 0x64, 0xC4, 0x36, 
+0x11, 0x86, 0xED, 0x53, 0x62, 0x00, 0x26, 0xA4, 
+0xD1, 0x1D, 0x93, 0xE6, 0x77, 0x45, 0xDA, 0x36, 
+0x1C, 0x4B, 0x89, 0x2D, 0x2B, 0x38, 0xE7, 0x44, 
+0x73, 0xF0, 0x12, 0x9A, 0xFA, 0xF8, 0x9E, 0x0B, 
+0x3F, 0x00, 0xBF, 0xFA, 0xDB, 0xE6, 0xAE, 0xDD, 
+0x10, 0x0C, 0x4B, 0xB5, 0x1C, 0x86, 0x1F, 0x64, 
+0x12, 0xE3, 0x65, 0x13, 0x58, 0xBF, 0x64, 0xD3, 
+0x67, 0x17, 0xD1, 0xD7, 0x6F, 0x25, 0x48, 0x9B, 
+0x18, 0xC2, 0xD4, 0xD0, 0x30, 0xE2, 0xA6, 0xB7, 
+
+0x51, 0xFA, 0x5E, 0x93, 0xE7, 0x3B, 0x09, 0x9E, 
+0x45, 0xDA, 0x75, 0x19, 0xFD, 0x1D, 0xD6, 0x38, 
+0xA0, 0xB6, 0xF1, 0x3A, 0x66, 0x19, 0x2C, 0x65, 
+0x53, 0x01, 0xBD, 0x29, 0xBE, 0x01, 0x03, 0xD8, 
+0xEC, 0xC4, 0xEE, 0x4D, 0x2D, 0x1C, 0x6B, 0x11, 
+0x72, 0xCE, 0x43, 0x10, 0xB0, 0xC7, 0xC5, 0x02, 
+0x1D, 0x41, 0x78, 0x76, 0x51, 0x77, 0x79, 0xB1, 
+0x03, 0x23, 0x47, 0x52, 0x2F, 0x3C, 0xCB, 0x13, 
+0xD3, 0x28, 0xA0, 0x60, 0x23, 0x81, 0x73, 0xB7, 
+0x00, 0x6F, 0x0E, 0xAE, 0x35, 0x41, 0xD3, 0x16, 
+0xFB, 0x04, 0x84, 0x7C, 0x88, 0xAB, 0x74, 0x80, 
+0x34, 0x08, 0xBF, 0xA5, 0x71, 0x8A, 0xA1, 0x30, 
+0x80, 0xF7, 0x83, 0xFD, 0xFC, 0xDF, 0xE9, 0xE5, 
+0xC0, 0x96, 0x38, 0xDF, 0x79, 0x75, 0x2B, 0x07, 
+0x6A, 0xF8, 0xC8, 0x22, 0x09, 0x73, 0xBA, 0x60, 
+0x2A, 
+
+0x4B, 0x5C, 0xF6, 0xF6, 0xE3, 0xB5, 0x9F, 0xB4, 
+0xC7, 0x7B, 0xD4, 0x5F, 0x77, 0x2B, 0x88, 0xF1, 
+0x6C, 0xA4, 0x2D, 0xD5, 0x55, 0x55, 0xD7, 0xA3, 
+0x60, 0xDC, 0x05, 0x97, 0x54, 0x3B, 0x98, 0xD0, 
+0x70, 0x20, 0x35, 0x1D, 0x94, 0x57, 0x22, 0x06, 
+0x06, 0x95, 0xBA, 0x88, 0xB8, 0x9E, 0x20, 0x3C, 
+0xD8, 0xE7, 0xF7, 0x3C, 0x56, 0xCE, 0xDA, 0xAC, 
+0x4C, 0x97, 0x9D, 0xA8, 0xB1, 0xD2, 0x7C, 0xB5, 
+0x20, 0x4A, 0x2B, 0x14, 0xE3, 0x4D, 0x0B, 0xA4, 
+0x28, 0x84, 0x8D, 0x74, 0x4F, 0xD0, 0x18, 0xFC, 
+0x48, 0xC1, 0xAC, 0xD9, 0x10, 0xC5, 0xA2, 0x56, 
+0x0E, 0x18, 0x36, 0x08, 0xB6, 0x17, 0x08, 0x68, 
+0x41, 0xA0, 0x33, 0xE9, 0x1D, 0xC5, 0xB5, 0x22, 
+0x6D, 0x77, 0x31, 0x39, 0x8A, 0xD0, 0x9F, 0x8A, 
+0xA6, 0x00, 0x05, 0x17, 0x2B, 0x52, 0x9D, 0xCD, 
+0xCB, 0x18, 
+
+0x12, 0x0C, 0x7A, 0xB9, 0x51, 0xC6, 0x8A, 0x76, 
+0x07, 0xE2, 0xD8, 0xFC, 0xF1, 0x62, 0xA2, 0x6C, 
+0xBA, 0x53, 0x0A, 0x37, 0x49, 0x7C, 0xA5, 0x7A, 
+0xC2, 0x9F, 0xA7, 0xB2, 0x6C, 0xE7, 0x5D, 0x15, 
+0x3B, 0x87, 0xCB, 0x8F, 0xB8, 0x4E, 0xED, 0xDB, 
+0x96, 0xBE, 0x9D, 0xC4, 0x2B, 0x5B, 0x03, 0x48, 
+0xB9, 0x24, 0xBC, 0x95, 0xF8, 0xCF, 0x9B, 0xB7, 
+0x65, 0x04, 0xF3, 0x77, 0x99, 0xE8, 0x1F, 0xE2, 
+0x1F, 0x6A, 0xEA, 0x58, 0x2D, 0x0F, 0xBD, 0x8F, 
+0x9C, 0x51, 0xC1, 0x9D, 0x1A, 0x72, 0x7B, 0x10, 
+0x20, 0xE5, 0xC7, 0xF9, 0x15, 0xC9, 0x70, 0xCA, 
+0x42, 0x5B, 0xD4, 0x17, 0xEF, 0xD4, 0x47, 0x3B, 
+0x18, 0xC1, 0x90, 0xC1, 0x24, 0x9A, 0xC2, 0xF6, 
+0x44, 0x56, 0x17, 0xAD, 0x2E, 0x55, 0x05, 0x68, 
+0xA0, 0x6E, 0xC9, 0x0B, 0x95, 0x0F, 0xED, 0x9F, 
+0x3D, 0xAF, 0x3D, 
+
+0xC1, 0xA8, 0x99, 0x2C, 0xE9, 0x78, 0x13, 0x75, 
+0xA8, 0xA5, 0x01, 0x52, 0xDD, 0xF7, 0x18, 0x76, 
+0xDF, 0xF5, 0xE4, 0x23, 0x44, 0x3A, 0xA2, 0x52, 
+0x17, 0x7A, 0x96, 0x01, 0x10, 0x57, 0xC0, 0x3B, 
+0xA8, 0x0B, 0xC3, 0xAD, 0x4C, 0x91, 0x50, 0xCA, 
+0xA7, 0xE0, 0x0F, 0x34, 0xBF, 0x38, 0x23, 0x72, 
+0xC7, 0xBE, 0x37, 0x88, 0xDE, 0x67, 0xBB, 0xD8, 
+0x32, 0xB5, 0x18, 0x8B, 0xF6, 0x6A, 0x0E, 0x91, 
+0x7B, 0x08, 0x41, 0xF4, 0x35, 0x07, 0x17, 0x44, 
+0x9B, 0x60, 0x8B, 0x8B, 0xC8, 0x2A, 0xC8, 0xC0, 
+0x27, 0x12, 0xD1, 0x97, 0xD7, 0x4A, 0xE2, 0x6F, 
+0x0D, 0x0F, 0x75, 0xB5, 0xDB, 0x88, 0xB5, 0xFF, 
+0xE0, 0xD0, 0x59, 0x70, 0x14, 0x85, 0x3C, 0x11, 
+0x1C, 0xAA, 0xB0, 0x53, 0xA2, 0xE4, 0x2D, 0x64, 
+0xB7, 0x30, 0xD9, 0x26, 0x91, 0x3C, 0xE1, 0x88, 
+0x21, 0xA8, 0xD8, 0xCE, 
+
+0x10, 0x3F, 0x8A, 0x01, 0x50, 0xF5, 0x34, 0xF5, 
+0x17, 0x79, 0xBF, 0x1E, 0x5A, 0x5A, 0x7B, 0xB8, 
+0x75, 0x7D, 0xBE, 0xDC, 0xCF, 0x4B, 0x52, 0xEC, 
+0xFA, 0x58, 0x5C, 0xA9, 0x66, 0xA6, 0x65, 0xC5, 
+0xD8, 0xFC, 0xE2, 0x2E, 0xFA, 0x73, 0x42, 0x75, 
+0xCA, 0x56, 0x0F, 0x53, 0x36, 0x0E, 0x5A, 0x81, 
+0x16, 0xB6, 0x1F, 0x34, 0x75, 0x70, 0x18, 0xB0, 
+0x8C, 0x7B, 0x61, 0x64, 0xF6, 0xC5, 0x44, 0xE2, 
+0x1D, 0xD0, 0x35, 0x91, 0xDA, 0x3A, 0xBA, 0x1E, 
+0xB8, 0x34, 0x76, 0x16, 0xDE, 0xA5, 0x8A, 0x44, 
+0xBC, 0xE4, 0xD4, 0xAA, 0x73, 0xE0, 0xF7, 0x56, 
+0xDD, 0xF4, 0x32, 0xD7, 0x86, 0xCB, 0xDE, 0xE6, 
+0x86, 0x87, 0x54, 0x6F, 0x35, 0xDA, 0x75, 0xC6, 
+0xA8, 0xFF, 0xED, 0x90, 0xDB, 0xA5, 0x28, 0x53, 
+0x0F, 0x37, 0x30, 0xC0, 0x9A, 0x4A, 0x66, 0x00, 
+0xA8, 0xDA, 0x0A, 0x7B, 0x03, 
+
+0xF9, 0x71, 0x01, 0xAD, 0xEC, 0x99, 0xA3, 0x5E, 
+0x3F, 0x04, 0x74, 0x23, 0xFB, 0x31, 0x9B, 0x00, 
+0xD1, 0x29, 0x93, 0x00, 0xE8, 0x55, 0x4C, 0xB6, 
+0x63, 0x4E, 0x5A, 0x21, 0x4B, 0x87, 0x18, 0x2A, 
+0x68, 0x17, 0x92, 0x85, 0xC3, 0x9C, 0x18, 0xED, 
+0x9E, 0x04, 0xEB, 0x2B, 0xE7, 0x30, 0xFD, 0x89, 
+0x60, 0xEA, 0x58, 0xC4, 0xC6, 0x88, 0xFC, 0x86, 
+0x42, 0xC9, 0x5C, 0x6D, 0x65, 0x0F, 0xCA, 0xFF, 
+0xD4, 0x00, 0x27, 0xBD, 0xE9, 0x3A, 0x94, 0x54, 
+0xF7, 0x46, 0x5C, 0xC9, 0xBE, 0x47, 0xB6, 0x91, 
+0x70, 0x00, 0x7A, 0xFB, 0xDF, 0xD6, 0xA5, 0x11, 
+0x1F, 0xDF, 0x96, 0xF7, 0xBA, 0xC0, 0x6E, 0xE7, 
+0x77, 0xF1, 0x54, 0x85, 0xAA, 0x85, 0xC0, 0xCC, 
+0x4D, 0x17, 0xCA, 0xB4, 0x25, 0x52, 0x26, 0x22, 
+0x30, 0x5A, 0xAF, 0x5E, 0xCF, 0xA9, 0xA2, 0x92, 
+0x58, 0x18, 0xD0, 0x47, 0xF4, 0xB6, 
+
+0x57, 0x25, 0x61, 0x60, 0xA6, 0xA5, 0x2E, 0xAE, 
+0x20, 0x98, 0xA8, 0xBE, 0x0A, 0x23, 0xF0, 0x58, 
+0xCE, 0x5C, 0x4F, 0xE9, 0x0E, 0xA7, 0x4E, 0x22, 
+0xA6, 0xD3, 0x9A, 0x2C, 0x56, 0x9B, 0xFB, 0x91, 
+0xB3, 0xD8, 0x00, 0xB4, 0x02, 0x72, 0x91, 0x38, 
+0x48, 0x98, 0x4F, 0x5A, 0x9B, 0x2C, 0x35, 0xDF, 
+0xDE, 0xCE, 0x9B, 0x08, 0x67, 0xCB, 0x3E, 0xF1, 
+0xA9, 0x17, 0x74, 0x05, 0x0B, 0xB0, 0xE2, 0x9E, 
+0xD4, 0x88, 0xFF, 0x15, 0x66, 0xE9, 0x8D, 0x66, 
+0x33, 0x3F, 0xF8, 0xA4, 0xA7, 0x76, 0xAC, 0xBA, 
+0x6D, 0xBA, 0xD2, 0xB1, 0xCA, 0x9D, 0x09, 0x85, 
+0xAB, 0x31, 0x5A, 0xBC, 0x28, 0xDA, 0xC8, 0xFF, 
+0xA2, 0x55, 0xA3, 0x50, 0xB2, 0x26, 0xC1, 0x22, 
+0xD4, 0xBD, 0xB3, 0xD8, 0x6B, 0x8D, 0x7E, 0x82, 
+0x62, 0x91, 0xA9, 0x97, 0xFC, 0x12, 0xB3, 0x56, 
+0xA7, 0x08, 0x85, 0x44, 0x15, 0x55, 0x2E, 
+
+0x37, 0xDA, 0xFB, 0x71, 0xF0, 0x86, 0xE1, 0x86, 
+0x7C, 0x8D, 0x95, 0x38, 0xD0, 0xD9, 0x73, 0x09, 
+0x95, 0xDB, 0xCF, 0x95, 0xBE, 0xE0, 0xF8, 0x2E, 
+0xC2, 0x4B, 0x61, 0x34, 0xF2, 0x7F, 0xEF, 0x72, 
+0x87, 0x44, 0xE9, 0x86, 0x55, 0x6C, 0xA2, 0x28, 
+0x9A, 0x39, 0x63, 0xE5, 0x1E, 0x51, 0x0A, 0x4C, 
+0x9C, 0x1F, 0x3F, 0x7A, 0x7D, 0xA8, 0x4B, 0xD3, 
+0xDD, 0xE2, 0xC6, 0xF2, 0xF6, 0xC0, 0xB6, 0xB3, 
+0x67, 0x0F, 0xB5, 0xA8, 0x8E, 0xC2, 0x97, 0x23, 
+0x5D, 0x40, 0xA1, 0xCE, 0x88, 0xDC, 0x34, 0x4E, 
+0x94, 0x24, 0x56, 0x4E, 0x39, 0x98, 0xD7, 0x31, 
+0x68, 0xBD, 0x5E, 0x9B, 0xE6, 0x68, 0xAA, 0x4A, 
+0x97, 0xED, 0x0D, 0x3A, 0x0E, 0x18, 0xE8, 0x3F, 
+0x92, 0x86, 0x45, 0x85, 0xB5, 0xCC, 0x36, 0xAC, 
+0x94, 0x6E, 0xA4, 0xDF, 0xD7, 0xCE, 0x2F, 0x6E, 
+0x69, 0x2F, 0xCA, 0x6D, 0x5D, 0xFC, 0x52, 0x08, 
+
+0xD2, 0x6A, 0xBA, 0x55, 0x01, 0x4D, 0x3C, 0x6F, 
+0x73, 0x83, 0x60, 0xCD, 0xC4, 0xC6, 0xA2, 0x2D, 
+0x8A, 0x2B, 0x0C, 0xC3, 0x3E, 0xA4, 0x6E, 0xAB, 
+0xF3, 0xB5, 0xC3, 0xEF, 0x05, 0xF3, 0xDF, 0x8F, 
+0xE8, 0x6A, 0x09, 0x3E, 0x16, 0x49, 0x4E, 0xB0, 
+0x20, 0x7A, 0xA1, 0x3F, 0x33, 0x47, 0x68, 0x16, 
+0x5B, 0xDA, 0x80, 0xAE, 0x43, 0x4C, 0x11, 0xF3, 
+0x22, 0x20, 0x5E, 0xBF, 0x8E, 0x43, 0x35, 0x5E, 
+0xA1, 0x4C, 0x76, 0x9C, 0x87, 0x5B, 0xCB, 0xA7, 
+0x5D, 0x80, 0xE7, 0x6C, 0x79, 0x51, 0x41, 0x98, 
+0xAE, 0x7A, 0xC3, 0x05, 0x43, 0xBB, 0x2F, 0x82, 
+0x86, 0x0A, 0xF7, 0x47, 0x62, 0x30, 0xF3, 0x07, 
+0x23, 0xAD, 0xD1, 0xAC, 0xC9, 0x80, 0x0D, 0x16, 
+0x04, 0x6A, 0x8F, 0x41, 0x25, 0x2D, 0xD4, 0x1F, 
+0x7C, 0x68, 0xF7, 0x5D, 0xB1, 0xD4, 0x23, 0x54, 
+0xBB, 0x79, 0xB0, 0x56, 0x6A, 0xC6, 0x0B, 0xED, 
+0x6E, 
+
+0x06, 0x1B, 0x01, 0x9D, 0x23, 0x41, 0x87, 0x02, 
+0x58, 0x24, 0x00, 0xC4, 0x53, 0xFC, 0x5F, 0xCE, 
+0xCB, 0x29, 0xBA, 0xA4, 0xF2, 0x40, 0x60, 0x0A, 
+0x86, 0xAC, 0x01, 0x6F, 0x56, 0x29, 0xD2, 0x32, 
+0xE9, 0x45, 0x2B, 0x98, 0x05, 0x52, 0x12, 0x6D, 
+0xE6, 0xBC, 0x9F, 0x2E, 0xF1, 0xAB, 0x8E, 0x0C, 
+0x0A, 0xAF, 0x27, 0xA8, 0x59, 0xA1, 0x62, 0x8F, 
+0x5B, 0xB7, 0x27, 0x2F, 0x27, 0x9E, 0xD9, 0x59, 
+0x35, 0x76, 0x23, 0x31, 0x9E, 0x5B, 0x80, 0x65, 
+0xE2, 0x9C, 0x7F, 0x37, 0x7D, 0x19, 0xDC, 0x4B, 
+0x6D, 0xB3, 0xB9, 0x1D, 0x74, 0x22, 0xED, 0xC9, 
+0xC3, 0x28, 0x0E, 0x4F, 0x4C, 0x87, 0x3A, 0x15, 
+0x06, 0x88, 0xF8, 0x9E, 0xFA, 0xF0, 0x42, 0xD5, 
+0xAD, 0x07, 0x57, 0xBF, 0x2B, 0x6A, 0x21, 0xA9, 
+0xEE, 0x03, 0xC9, 0x6E, 0x8D, 0x04, 0xD6, 0xE0, 
+0xF2, 0xE4, 0x37, 0x7F, 0x8E, 0xFB, 0xDB, 0x87, 
+0x7F, 0x39, 
+
+0x3A, 0x9C, 0xEB, 0xC4, 0xE8, 0x3F, 0x4E, 0xDA, 
+0xA7, 0xC6, 0x03, 0xE6, 0x30, 0x23, 0x78, 0xDF, 
+0x23, 0x84, 0x63, 0x18, 0xA3, 0xDD, 0xC7, 0x8C, 
+0xEF, 0x43, 0x82, 0xD5, 0x86, 0x09, 0x98, 0xD1, 
+0x37, 0x00, 0x83, 0x60, 0xF2, 0xDC, 0x75, 0x41, 
+0xCF, 0x01, 0x66, 0x89, 0x5A, 0x5F, 0xF1, 0x68, 
+0xCC, 0x05, 0x09, 0xFD, 0x36, 0x13, 0xF6, 0xBF, 
+0xF0, 0x06, 0x9F, 0x01, 0xC6, 0x97, 0xDC, 0xD5, 
+0xCC, 0x16, 0x29, 0x87, 0x32, 0xED, 0x64, 0x4A, 
+0x23, 0x83, 0x31, 0xEF, 0xA8, 0x4F, 0xCE, 0x96, 
+0x91, 0xAE, 0xB4, 0x01, 0x85, 0xF6, 0x1E, 0x80, 
+0xCE, 0x9C, 0x25, 0x0A, 0x31, 0x62, 0xBC, 0x97, 
+0xFD, 0x4E, 0x51, 0xA2, 0xB5, 0x15, 0x0D, 0x86, 
+0xC2, 0x88, 0x3F, 0x87, 0xE7, 0x0D, 0x29, 0xF3, 
+0x8A, 0xAA, 0x76, 0x7B, 0xB3, 0x42, 0x43, 0x00, 
+0xDC, 0xD5, 0xA3, 0x3F, 0x9E, 0x9A, 0x2C, 0xFB, 
+0xF9, 0x8F, 0x60, 
+
+0xB1, 0x56, 0x54, 0x10, 0x73, 0xEB, 0x43, 0x85, 
+0x20, 0x87, 0xC9, 0xFB, 0x8A, 0x8F, 0xCC, 0xC8, 
+0x3C, 0x3B, 0xF7, 0x52, 0x81, 0xD2, 0x11, 0x78, 
+0xE2, 0x5A, 0x2F, 0x62, 0x56, 0x80, 0x96, 0xA6, 
+0xE6, 0xDC, 0x3D, 0xAF, 0x49, 0x38, 0xA7, 0xAC, 
+0x4B, 0xFA, 0x68, 0xA3, 0xDA, 0x1F, 0x04, 0x54, 
+0x5A, 0x74, 0xC6, 0xFD, 0xE6, 0xCD, 0x32, 0x41, 
+0x1D, 0xE3, 0x5C, 0xC9, 0x05, 0x75, 0x1E, 0xC9, 
+0x8F, 0xA2, 0x97, 0x66, 0x49, 0x63, 0x2C, 0x10, 
+0x30, 0xF6, 0x4F, 0x9B, 0x2E, 0xD4, 0x24, 0x39, 
+0xD8, 0xFB, 0xC2, 0x3A, 0x1E, 0xCE, 0xE0, 0x5C, 
+0x90, 0xF2, 0xBE, 0x4D, 0xA8, 0xD7, 0xE6, 0xA9, 
+0x80, 0x7E, 0xD9, 0xFA, 0x97, 0xBF, 0x2F, 0x16, 
+0x1D, 0x89, 0xF8, 0x22, 0x1D, 0xD4, 0xCA, 0x98, 
+0x5D, 0x1C, 0x34, 0xA1, 0xAF, 0x88, 0x9E, 0xA7, 
+0xF9, 0xA8, 0x32, 0x0F, 0xD3, 0x0A, 0xD8, 0x66, 
+0x1F, 0x29, 0x7F, 0xCC, 
+
+0xE1, 0x83, 0xD0, 0xEB, 0xFE, 0x3F, 0xED, 0xC5, 
+0xBF, 0x01, 0x67, 0x81, 0x1E, 0xE4, 0xA9, 0xBE, 
+0x91, 0x53, 0x2C, 0x06, 0xA0, 0x08, 0xE1, 0x03, 
+0x06, 0xE6, 0x69, 0xBE, 0xE9, 0xC6, 0xBB, 0xF2, 
+0x6F, 0x8D, 0x7C, 0x4A, 0x1E, 0xC5, 0x99, 0xB7, 
+0x8D, 0x6D, 0x44, 0x55, 0xD2, 0x17, 0x3B, 0xE5, 
+0x1D, 0x3B, 0x8D, 0xB0, 0x50, 0x02, 0x6A, 0xAD, 
+0x38, 0x72, 0xEF, 0x1E, 0xF8, 0x54, 0x5A, 0x1D, 
+0xDF, 0xB9, 0x96, 0xAC, 0x3F, 0xCE, 0xE7, 0x1C, 
+0xAB, 0x2A, 0xD8, 0x28, 0x38, 0x3D, 0x9B, 0x49, 
+0xA6, 0x59, 0x64, 0x26, 0x55, 0x0A, 0x80, 0xA1, 
+0x11, 0x5D, 0x8E, 0xBC, 0x5C, 0x74, 0x48, 0x32, 
+0x18, 0x85, 0x36, 0xD5, 0x13, 0x34, 0x91, 0x07, 
+0xEF, 0xC8, 0x3A, 0x12, 0x38, 0x4B, 0x54, 0x1C, 
+0x99, 0xDF, 0x4A, 0x38, 0x78, 0xC9, 0xA2, 0x63, 
+0x33, 0x7C, 0xEC, 0x6C, 0x69, 0x20, 0x84, 0x64, 
+0x1C, 0x8B, 0xAA, 0x4D, 0x96, 
+
+0xA8, 0x5A, 0xC8, 0xD8, 0xF4, 0x0B, 0x63, 0x9E, 
+0x26, 0x30, 0xA9, 0x41, 0x42, 0x7A, 0xD8, 0x2E, 
+0x41, 0xD4, 0xE0, 0x12, 0x4D, 0xEE, 0x4E, 0xA6, 
+0x0C, 0x25, 0xB4, 0xCE, 0x22, 0xF9, 0xC0, 0x47, 
+0x84, 0x71, 0x18, 0x8F, 0x7F, 0xFD, 0xF4, 0x8B, 
+0x6B, 0x1C, 0xDF, 0xB8, 0xE4, 0x44, 0x09, 0x35, 
+0xC8, 0x8B, 0x0D, 0xE1, 0xD0, 0xD1, 0x11, 0x83, 
+0x72, 0x28, 0x27, 0x96, 0x26, 0x5E, 0x2D, 0x85, 
+0x78, 0x69, 0x55, 0xE4, 0x2C, 0x2C, 0x13, 0xB7, 
+0xEB, 0x3F, 0xFF, 0x5D, 0x7D, 0x78, 0x03, 0x62, 
+0xE6, 0x0B, 0x5F, 0x75, 0x53, 0xA5, 0x10, 0xA6, 
+0xBA, 0x9B, 0x89, 0x8B, 0x39, 0xF7, 0xD2, 0x34, 
+0xCC, 0x2E, 0xA0, 0xF8, 0x12, 0xCD, 0x87, 0x3B, 
+0x62, 0x10, 0x90, 0x37, 0xCE, 0x18, 0x91, 0x0C, 
+0x6E, 0xE6, 0x16, 0xF2, 0xA0, 0x7E, 0x4E, 0x1C, 
+0x01, 0x7F, 0xAA, 0xA9, 0x24, 0x5C, 0xB4, 0x4F, 
+0x41, 0x3E, 0xB7, 0xE0, 0x1C, 0xF5, 
+
+0xE8, 0xEB, 0x2E, 0x28, 0x09, 0xC8, 0xC1, 0xF8, 
+0xED, 0xB0, 0x61, 0xE9, 0x40, 0x58, 0x26, 0x1A, 
+0x7B, 0xD5, 0xA8, 0x53, 0x3A, 0x4D, 0x71, 0x56, 
+0xEE, 0xCE, 0xA7, 0xA8, 0x55, 0x6C, 0x33, 0x19, 
+0x9B, 0xAF, 0x03, 0x26, 0xB1, 0x35, 0x7F, 0x44, 
+0x2F, 0x42, 0xF5, 0x6C, 0xBA, 0xB3, 0x88, 0x7B, 
+0x15, 0x74, 0x57, 0x4A, 0x39, 0x02, 0x63, 0x57, 
+0x65, 0x29, 0x2B, 0xF4, 0xF5, 0xB5, 0x60, 0x63, 
+0xC3, 0x86, 0xA5, 0xA4, 0xA5, 0x78, 0x2A, 0x2D, 
+0x6C, 0x31, 0x24, 0x12, 0x70, 0x3B, 0xB9, 0x78, 
+0xA3, 0x99, 0x24, 0x9E, 0x34, 0xBC, 0x7D, 0x4F, 
+0x8B, 0xFE, 0x95, 0x56, 0xE0, 0xBA, 0xB9, 0x01, 
+0x33, 0x1D, 0x2C, 0xBE, 0x20, 0x79, 0x07, 0xA0, 
+0xB6, 0xBB, 0x37, 0xE9, 0x80, 0x6F, 0x99, 0x78, 
+0x94, 0xAF, 0xF5, 0x26, 0xCC, 0xC1, 0xFE, 0x68, 
+0xCA, 0xD4, 0x62, 0x8D, 0x96, 0x7F, 0x0D, 0x99, 
+0x8C, 0x12, 0x1B, 0x27, 0xAC, 0x28, 0xA2, 
+
+0xCA, 0xBA, 0x58, 0xA9, 0x66, 0xD0, 0x83, 0xEF, 
+0x24, 0x7D, 0xB6, 0xFF, 0x9C, 0xD4, 0x99, 0xB6, 
+0x38, 0x15, 0x2E, 0xC3, 0xFE, 0xE4, 0x83, 0x98, 
+0xDA, 0x42, 0x0D, 0x82, 0x72, 0xE5, 0xFD, 0x6D, 
+0x66, 0x55, 0x9C, 0xC2, 0x0B, 0x1A, 0x25, 0x8F, 
+0x50, 0xE5, 0x1C, 0x5E, 0x40, 0xC8, 0x5C, 0xC6, 
+0x56, 0x81, 0xDC, 0x53, 0x4A, 0x2A, 0xFF, 0x9A, 
+0xC4, 0xA3, 0x93, 0xDE, 0x2F, 0x85, 0x5F, 0x2F, 
+0x8F, 0x37, 0x1D, 0xC1, 0xCE, 0x33, 0xC1, 0x75, 
+0xA8, 0x39, 0x5B, 0xA9, 0xF4, 0x12, 0x0E, 0xF9, 
+0x67, 0x15, 0x72, 0x6D, 0x2F, 0xEB, 0x10, 0x1C, 
+0xA8, 0xAE, 0x98, 0x85, 0x08, 0x51, 0xC7, 0xB0, 
+0x43, 0x5F, 0xF0, 0x23, 0x52, 0xD0, 0x55, 0x59, 
+0xE9, 0xB6, 0xC7, 0x85, 0x19, 0xDD, 0x5F, 0x3A, 
+0xDE, 0x22, 0x36, 0xD3, 0x58, 0x2B, 0x5A, 0xAA, 
+0xEC, 0x53, 0xAE, 0x07, 0xE4, 0x33, 0x72, 0x88, 
+0x4D, 0xAC, 0x74, 0xA8, 0x34, 0xAF, 0xF3, 0xD2, 
+
+0xC9, 0xE5, 0x12, 0x24, 0x9D, 0x9D, 0x4A, 0xF0, 
+0x05, 0xF9, 0x1C, 0x50, 0x1E, 0x36, 0xE4, 0x84, 
+0x22, 0x93, 0x0F, 0x1B, 0xA6, 0x3B, 0x42, 0x1D, 
+0x3F, 0x63, 0xE6, 0xD4, 0x9D, 0xA1, 0x2E, 0xAA, 
+0xE0, 0x47, 0x97, 0x2A, 0x0A, 0xB1, 0x71, 0xAF, 
+0xF6, 0x82, 0xAF, 0x8D, 0x96, 0x4F, 0x27, 0xEC, 
+0xA0, 0x35, 0xF6, 0x12, 0x4F, 0x87, 0x6B, 0x94, 
+0xFD, 0x5A, 0x27, 0xCA, 0x98, 0x79, 0x48, 0x80, 
+0x3C, 0xA5, 0x48, 0x11, 0xC2, 0x08, 0x24, 0x18, 
+0xD9, 0x3D, 0x18, 0xD6, 0x0B, 0x2A, 0x88, 0x1B, 
+0xC5, 0xDB, 0x39, 0x87, 0x48, 0x4F, 0x78, 0xDD, 
+0x8F, 0x9B, 0xC5, 0xD6, 0xBB, 0xE1, 0x0E, 0x2E, 
+0xAB, 0x29, 0x3D, 0xA8, 0x1D, 0x65, 0xE1, 0x54, 
+0x46, 0xFF, 0x40, 0x8A, 0xC6, 0xF0, 0x1B, 0x30, 
+0x7A, 0x1D, 0x2B, 0x99, 0x0A, 0x92, 0x93, 0xF6, 
+0x6B, 0xBF, 0xFE, 0x08, 0x7E, 0x9F, 0xCE, 0x85, 
+0xC3, 0xA5, 0x74, 0xA4, 0x01, 0x11, 0xAE, 0x2E, 
+0x2F, 
+
+0x82, 0xE7, 0x8E, 0x96, 0x5F, 0x73, 0x6D, 0x77, 
+0xD9, 0xE2, 0x54, 0x7D, 0x24, 0x33, 0x03, 0x8F, 
+0xAA, 0xDB, 0xF1, 0xB0, 0x5A, 0x7E, 0x33, 0xDD, 
+0x92, 0x7C, 0x29, 0x3A, 0xDF, 0x10, 0x76, 0x4F, 
+0x93, 0x83, 0x01, 0xC0, 0x4E, 0x3F, 0x2D, 0xD5, 
+0x35, 0xA4, 0x11, 0x6B, 0xA3, 0x81, 0xCD, 0x55, 
+0x16, 0x20, 0x08, 0x02, 0x84, 0x0B, 0xD7, 0x6A, 
+0x54, 0xC5, 0x80, 0x59, 0xE1, 0x53, 0x15, 0xCF, 
+0x01, 0x19, 0xDE, 0x16, 0xFA, 0x34, 0x03, 0xED, 
+0x5F, 0x14, 0xD7, 0x1F, 0x08, 0xE8, 0xCD, 0x1C, 
+0x82, 0xF8, 0x69, 0x5B, 0xA4, 0x99, 0xF1, 0x7C, 
+0xF0, 0x4E, 0x29, 0x09, 0xC2, 0x0B, 0x94, 0x81, 
+0x5B, 0xDD, 0xE7, 0xC7, 0x90, 0xA8, 0x77, 0x1A, 
+0x18, 0x7A, 0xC1, 0x6B, 0x79, 0xF3, 0xC9, 0x64, 
+0x46, 0x93, 0x6E, 0x7C, 0x02, 0x8B, 0x66, 0xE2, 
+0x01, 0xAB, 0x01, 0x49, 0xFE, 0x4C, 0x44, 0x81, 
+0x8E, 0x66, 0xF7, 0xD0, 0x1A, 0xCA, 0xF1, 0xF9, 
+0x96, 0x20, 
+
+0xB9, 0x0E, 0x3D, 0x56, 0x6B, 0x9C, 0x59, 0x9E, 
+0x72, 0xE4, 0x9D, 0xBD, 0xFA, 0xB8, 0xD3, 0xFA, 
+0x53, 0x3F, 0x35, 0x78, 0x8E, 0x37, 0x18, 0xD1, 
+0x1B, 0xCC, 0x4A, 0x70, 0x49, 0xF1, 0x15, 0xE0, 
+0x67, 0x63, 0x0F, 0x84, 0x5A, 0xB5, 0x37, 0x6A, 
+0x59, 0xEF, 0x25, 0xB8, 0x00, 0x07, 0xA3, 0x61, 
+0x5A, 0xC2, 0x9C, 0xB5, 0x5A, 0x3D, 0x2D, 0x99, 
+0x44, 0xB8, 0x7B, 0x76, 0x9D, 0x6B, 0x42, 0xF3, 
+0x20, 0x84, 0x6A, 0x8E, 0x94, 0xBD, 0x47, 0xE8, 
+0xF7, 0x97, 0xB1, 0xCC, 0xFB, 0x95, 0xA6, 0xA8, 
+0xDD, 0x1D, 0x50, 0x7D, 0x59, 0x5F, 0x17, 0x64, 
+0xEA, 0x2C, 0x29, 0xF4, 0x2E, 0x5B, 0x23, 0x65, 
+0x78, 0xD1, 0xF1, 0xE5, 0xE4, 0x53, 0x6B, 0xB0, 
+0xD8, 0x11, 0x4D, 0xDA, 0x46, 0x86, 0x4D, 0x03, 
+0x62, 0xA4, 0xBE, 0xA1, 0x27, 0x25, 0xDF, 0xB3, 
+0x60, 0x0B, 0x69, 0x65, 0x87, 0xF7, 0x8D, 0xC5, 
+0x93, 0x5E, 0xEC, 0xAD, 0x35, 0xF7, 0xE7, 0x94, 
+0x04, 0x68, 0xBD, 
+
+0x04, 0xC4, 0xD1, 0x4D, 0x5C, 0x27, 0x0A, 0xA8, 
+0x86, 0x87, 0x2A, 0x18, 0x93, 0xDA, 0x8B, 0xAF, 
+0x26, 0x30, 0xDC, 0xCE, 0x18, 0x3F, 0x27, 0x44, 
+0xBB, 0xD9, 0xCF, 0x77, 0x46, 0xA4, 0x38, 0x83, 
+0x76, 0x00, 0xDB, 0x77, 0xEF, 0x50, 0x7F, 0xEB, 
+0x46, 0xB4, 0xB9, 0x12, 0xC8, 0xE1, 0xA9, 0x92, 
+0x3E, 0x2C, 0xDC, 0xF7, 0x3D, 0x08, 0x72, 0xBC, 
+0x2C, 0xD0, 0x50, 0xDF, 0x08, 0x06, 0x35, 0x0F, 
+0x69, 0xBC, 0x2F, 0x7F, 0x37, 0xCF, 0xF7, 0x9B, 
+0xD1, 0x39, 0xB8, 0x66, 0xDA, 0x96, 0x09, 0x8D, 
+0xEC, 0xE3, 0x05, 0x43, 0xF4, 0xF3, 0xAE, 0x07, 
+0x6A, 0xB1, 0xF3, 0xB3, 0xB3, 0x83, 0x6E, 0x87, 
+0x42, 0x5F, 0x72, 0xF3, 0xE8, 0x64, 0x44, 0xEA, 
+0xBE, 0xFC, 0x4E, 0xE9, 0xA7, 0x4D, 0x0F, 0xCC, 
+0x54, 0xBD, 0x34, 0xFD, 0xB5, 0x4B, 0x02, 0x75, 
+0x28, 0xBD, 0xF4, 0xCC, 0xF8, 0x47, 0xCC, 0x11, 
+0x10, 0xA0, 0xE8, 0x7E, 0x6E, 0xDD, 0x37, 0x72, 
+0x22, 0x5F, 0xE8, 0x9B, 
+
+0x92, 0x19, 0x54, 0xFA, 0xA3, 0x89, 0x5A, 0x1A, 
+0x97, 0x69, 0x8E, 0xAF, 0x06, 0xC7, 0x6F, 0x32, 
+0xC7, 0xCC, 0x34, 0xFA, 0x55, 0x90, 0xA2, 0xEF, 
+0x1E, 0xD1, 0xB0, 0x27, 0xAD, 0xE9, 0xB0, 0x74, 
+0xAC, 0xBE, 0x73, 0x6B, 0xE6, 0x7E, 0x32, 0x1E, 
+0xA3, 0x37, 0xCC, 0x47, 0xA3, 0xCD, 0x69, 0xF5, 
+0x6F, 0x23, 0x04, 0xB1, 0x50, 0x3C, 0x3D, 0xA9, 
+0x7B, 0xFA, 0x31, 0x63, 0xED, 0x04, 0xA4, 0xBE, 
+0x11, 0x07, 0xB6, 0x82, 0xE0, 0x72, 0xCE, 0x96, 
+0x18, 0xA1, 0x1B, 0xC3, 0x61, 0x7E, 0x6E, 0xAD, 
+0x14, 0x3A, 0x48, 0xDB, 0x5C, 0x13, 0x72, 0xF1, 
+0xF2, 0xC4, 0xCA, 0x6D, 0x41, 0x99, 0x2B, 0xDA, 
+0x24, 0xA9, 0x5D, 0x16, 0x49, 0x4F, 0xC4, 0x1C, 
+0xDC, 0x3B, 0x18, 0x87, 0x2B, 0xF1, 0x47, 0x9D, 
+0xB4, 0x36, 0x47, 0x27, 0x3B, 0x2D, 0x9C, 0x4A, 
+0x84, 0x38, 0xEE, 0xCB, 0x7D, 0x6B, 0x56, 0x4A, 
+0xF4, 0x47, 0x37, 0x9A, 0x5F, 0x86, 0x1F, 0x65, 
+0x7A, 0x59, 0x34, 0xC7, 0xFC, 
+
+0x73, 0x6C, 0xA7, 0x62, 0x7E, 0x19, 0x8F, 0x58, 
+0xE5, 0xFD, 0xF7, 0xF1, 0x3B, 0x10, 0xD9, 0x83, 
+0xF7, 0x46, 0x23, 0xDD, 0xB5, 0xF9, 0x1B, 0x7D, 
+0xD6, 0x14, 0xF3, 0xDF, 0x2A, 0xA7, 0xAB, 0xAA, 
+0x4C, 0x2A, 0x76, 0xC6, 0x4D, 0xA8, 0xD8, 0xFD, 
+0xAD, 0xE3, 0x5A, 0xC8, 0x70, 0xDD, 0x7C, 0x90, 
+0x76, 0xD1, 0xCF, 0x0D, 0x2A, 0xA1, 0x36, 0xE1, 
+0xBA, 0x43, 0x99, 0x27, 0x25, 0xDE, 0xD2, 0x43, 
+0xF5, 0xF4, 0xDA, 0xFB, 0x20, 0x16, 0xBE, 0x6B, 
+0x1C, 0xAC, 0xB9, 0xC3, 0x21, 0xC6, 0x85, 0x6E, 
+0x1F, 0xCC, 0xC9, 0x19, 0xE6, 0xD0, 0x8F, 0xF9, 
+0xF0, 0x9C, 0xA6, 0xFF, 0xA6, 0x20, 0xC4, 0xFB, 
+0x80, 0x06, 0x43, 0xAE, 0x4B, 0x2E, 0x3E, 0x34, 
+0x95, 0x9D, 0xEF, 0x31, 0xC8, 0x39, 0xA0, 0x5D, 
+0x82, 0x83, 0xD4, 0xEF, 0x95, 0x72, 0x0C, 0x48, 
+0x60, 0xC4, 0xD1, 0x07, 0xF3, 0x67, 0xCE, 0xB5, 
+0x3C, 0xED, 0x4E, 0x86, 0x49, 0x3F, 0xD6, 0x31, 
+0x51, 0x66, 0x70, 0x21, 0x00, 0x90, 
+
+0x8F, 0x9C, 0x77, 0x11, 0x15, 0x5D, 0xBE, 0xD0, 
+0x69, 0x4A, 0x69, 0xC9, 0xD5, 0xD7, 0x05, 0xC9, 
+0xA3, 0x4A, 0x5C, 0xF1, 0x4D, 0x52, 0x07, 0xFF, 
+0x53, 0x5F, 0xFC, 0xFD, 0x9D, 0x85, 0x72, 0x31, 
+0x6B, 0xCF, 0x08, 0x79, 0x9F, 0x37, 0x1D, 0xAE, 
+0x81, 0x99, 0x5C, 0x52, 0x6D, 0x3E, 0xBA, 0xC6, 
+0xC0, 0x6B, 0x9E, 0x7B, 0xCE, 0xEB, 0xEE, 0x60, 
+0x05, 0x5F, 0x22, 0x08, 0x7F, 0x24, 0x49, 0x49, 
+0x09, 0xE0, 0xBF, 0xE0, 0xDA, 0xA6, 0x49, 0xC0, 
+0xE3, 0xF5, 0x61, 0x81, 0xB6, 0xCF, 0x1E, 0x85, 
+0xED, 0x15, 0x0C, 0x20, 0xF9, 0x9A, 0xE6, 0x97, 
+0x49, 0x56, 0x4E, 0x6B, 0xC7, 0x87, 0x19, 0x0C, 
+0x1D, 0x12, 0x28, 0x8A, 0x94, 0xEF, 0xED, 0x11, 
+0x0B, 0xB0, 0x67, 0x18, 0xF0, 0xE1, 0xFB, 0x8C, 
+0x6D, 0x16, 0x1A, 0x7A, 0xFA, 0x60, 0x6A, 0x0B, 
+0x4A, 0x4B, 0xA5, 0x55, 0x53, 0xD0, 0xB8, 0x8E, 
+0xF9, 0xC9, 0x3E, 0x6A, 0xF4, 0xD2, 0x38, 0x6A, 
+0x03, 0x94, 0x5E, 0x86, 0x4F, 0x91, 0x2B, 
+
+0x1F, 0x1F, 0x21, 0xD5, 0xE7, 0x91, 0x6F, 0x08, 
+0x30, 0x6A, 0x50, 0x06, 0x20, 0xB5, 0xF6, 0x6B, 
+0x85, 0x48, 0xFE, 0x13, 0x87, 0x51, 0x0C, 0x9A, 
+0x9E, 0xE0, 0xA4, 0xD0, 0x55, 0x11, 0x81, 0xFA, 
+0x0E, 0x88, 0x7A, 0x1E, 0xCA, 0xBE, 0xD1, 0x1C, 
+0xA2, 0x40, 0x85, 0x3E, 0x0B, 0x24, 0x51, 0x6A, 
+0xCE, 0x75, 0x02, 0xED, 0x2F, 0xFE, 0x5D, 0xD0, 
+0xA6, 0xA2, 0xEB, 0x07, 0x01, 0x14, 0x09, 0x20, 
+0xDA, 0xC8, 0x91, 0xDC, 0x82, 0xF4, 0xA0, 0xD6, 
+0x69, 0xEB, 0x88, 0xCB, 0x69, 0x6F, 0x5C, 0x20, 
+0x67, 0x2F, 0xE7, 0xCB, 0xA7, 0x01, 0x4E, 0x57, 
+0xC6, 0xB0, 0x77, 0x38, 0xBD, 0x63, 0x2B, 0x61, 
+0x0A, 0x4A, 0x60, 0xEE, 0xE1, 0xF3, 0xA7, 0x2F, 
+0xF6, 0x50, 0x21, 0x5E, 0xF9, 0xDC, 0x35, 0xA2, 
+0xDC, 0x14, 0x3C, 0x58, 0x27, 0xEB, 0xFB, 0x80, 
+0x9E, 0x76, 0x81, 0x01, 0x1A, 0x05, 0x1B, 0xA0, 
+0xED, 0x92, 0x95, 0x60, 0x31, 0x2F, 0xE4, 0xA0, 
+0x6A, 0x1F, 0xC5, 0x8B, 0xB4, 0x8B, 0x9F, 0xB8, 
+
+0x89, 0xCC, 0x52, 0x5F, 0xF4, 0xB0, 0x65, 0xB9, 
+0xA0, 0x68, 0xA2, 0x1B, 0xF2, 0xBC, 0x3D, 0xD8, 
+0xC5, 0x28, 0x87, 0xD0, 0x09, 0x6F, 0x94, 0x8B, 
+0x81, 0xFB, 0x1F, 0x1F, 0x86, 0x67, 0x26, 0xC1, 
+0xAB, 0xBA, 0x44, 0xD8, 0xF1, 0x74, 0x2C, 0x15, 
+0xFC, 0xD0, 0x21, 0xBC, 0xA9, 0x85, 0x77, 0xF5, 
+0xC7, 0x68, 0x5A, 0x26, 0x8B, 0xF6, 0x28, 0x7F, 
+0xBE, 0xFD, 0x76, 0xC9, 0xAA, 0xBA, 0x24, 0xE2, 
+0x36, 0x3A, 0xFA, 0x47, 0x0F, 0x1F, 0x47, 0xDA, 
+0x36, 0x09, 0x8E, 0x12, 0x85, 0xF0, 0xA8, 0x83, 
+0xDB, 0x17, 0xB6, 0x5F, 0x39, 0xEB, 0x9A, 0x32, 
+0x22, 0xB5, 0x82, 0x18, 0x0B, 0x13, 0x9D, 0xFD, 
+0xA9, 0xAE, 0x24, 0x77, 0x8C, 0xC1, 0xEB, 0xC0, 
+0x4E, 0x81, 0xCD, 0x2D, 0x4B, 0xC9, 0x25, 0xFE, 
+0x05, 0x6C, 0xF6, 0x8D, 0x87, 0x60, 0xE3, 0x3B, 
+0xB7, 0x82, 0xF8, 0xCD, 0xB9, 0x35, 0xFA, 0x70, 
+0xDE, 0xB0, 0x24, 0x4B, 0xDD, 0xC7, 0x7A, 0xEE, 
+0x57, 0xC7, 0x32, 0xB8, 0x39, 0x40, 0xDA, 0xC8, 
+0xF5, 
+
+0xB2, 0x1A, 0xBB, 0x5D, 0x04, 0x04, 0x64, 0xE1, 
+0x6B, 0x21, 0x2A, 0xC6, 0x7D, 0x2A, 0xE1, 0x9D, 
+0x13, 0x33, 0xBB, 0x3C, 0x5A, 0x8A, 0x91, 0x8E, 
+0x5E, 0x98, 0x45, 0x05, 0xAD, 0x7B, 0xAF, 0xDA, 
+0x44, 0xB5, 0x83, 0xD4, 0xE1, 0x16, 0x89, 0xF4, 
+0x5E, 0xD3, 0xAB, 0xD2, 0xA1, 0xD1, 0xEE, 0x43, 
+0x17, 0x25, 0xDE, 0x6B, 0x65, 0x9C, 0xEF, 0x9D, 
+0xC5, 0xD9, 0xBE, 0xE4, 0x40, 0xD2, 0x26, 0x20, 
+0x52, 0xED, 0xEA, 0xCA, 0x94, 0x27, 0x24, 0xDF, 
+0xD8, 0xAE, 0xB3, 0x67, 0xDD, 0x39, 0x72, 0xC0, 
+0xCA, 0xCA, 0xB4, 0x88, 0xE8, 0xFF, 0x0A, 0xFB, 
+0xDE, 0xBE, 0x4B, 0x26, 0x2D, 0xDD, 0x29, 0x7F, 
+0x7C, 0xC9, 0xF4, 0x9A, 0xAD, 0x39, 0xA5, 0xC4, 
+0x3E, 0x08, 0xA2, 0x92, 0xB0, 0x82, 0x8E, 0xFA, 
+0x33, 0x21, 0x96, 0xC0, 0x6B, 0x90, 0xC2, 0xC2, 
+0xAA, 0x37, 0x58, 0x62, 0x21, 0x00, 0x84, 0x52, 
+0x16, 0xC5, 0x17, 0x47, 0xB4, 0x08, 0xD5, 0x3C, 
+0xC0, 0xAD, 0x3B, 0x14, 0xCE, 0xFB, 0x4C, 0xA6, 
+0xDE, 0x21, 
+
+0xEA, 0xC1, 0x00, 0xC8, 0x9B, 0xFC, 0xAD, 0x45, 
+0x91, 0xA2, 0x45, 0xD1, 0x4C, 0x3B, 0xA4, 0x22, 
+0x80, 0x59, 0xCB, 0x4D, 0xD1, 0x27, 0x27, 0xD2, 
+0x78, 0x88, 0x58, 0x8C, 0x2B, 0xF2, 0xD4, 0x26, 
+0x10, 0x91, 0x30, 0xEF, 0x55, 0xE1, 0xB9, 0xE8, 
+0x0B, 0xB4, 0x37, 0x9B, 0xA3, 0x12, 0x86, 0x57, 
+0x18, 0x5A, 0xE3, 0xD6, 0xC7, 0x49, 0xEC, 0x08, 
+0x5B, 0xE9, 0x05, 0xE3, 0x7B, 0x90, 0xB8, 0x1E, 
+0x31, 0x8D, 0xBF, 0x76, 0xC8, 0x90, 0xF0, 0xBB, 
+0x24, 0x17, 0x81, 0x8E, 0x36, 0x9B, 0x19, 0x2B, 
+0xE7, 0xBC, 0xA9, 0xC7, 0xE6, 0x77, 0x89, 0xAB, 
+0x2E, 0x3F, 0xEA, 0x4E, 0x0F, 0x85, 0x7D, 0xBB, 
+0xEE, 0x01, 0x21, 0xE9, 0x3D, 0x87, 0x08, 0x7E, 
+0xA6, 0x7B, 0x3A, 0xC5, 0xF1, 0x0D, 0x87, 0x91, 
+0x09, 0x2B, 0xB6, 0xBC, 0x6D, 0xCD, 0x03, 0x41, 
+0x6B, 0xFC, 0x1B, 0x58, 0x68, 0x50, 0x34, 0x53, 
+0x82, 0xD6, 0x7E, 0x5A, 0xE4, 0x23, 0x60, 0xAB, 
+0xB6, 0x8D, 0xB2, 0x15, 0x98, 0xCC, 0x9E, 0x1D, 
+0x41, 0x0E, 0x9E, 
+
+0x41, 0xB5, 0x39, 0xF7, 0x27, 0xFD, 0x8F, 0x84, 
+0x93, 0x53, 0x87, 0xB3, 0x53, 0x44, 0xEB, 0x34, 
+0xCA, 0x5F, 0xA5, 0x96, 0xA6, 0xB1, 0x5B, 0x4E, 
+0xC1, 0x4D, 0x94, 0x9A, 0x6E, 0x9C, 0xA3, 0xA2, 
+0xA3, 0x87, 0x79, 0x0A, 0xF6, 0xC7, 0x28, 0xAC, 
+0x38, 0x67, 0xB6, 0x75, 0x41, 0xA2, 0xC4, 0xED, 
+0x6C, 0x77, 0x90, 0x8D, 0x9C, 0x80, 0x9E, 0xA9, 
+0x2A, 0x15, 0xC7, 0x81, 0xF0, 0xE8, 0x13, 0x52, 
+0x78, 0x92, 0x1B, 0x0A, 0x37, 0x9B, 0xD3, 0xA9, 
+0xCC, 0x8D, 0xEA, 0x09, 0xB4, 0xFE, 0x72, 0x15, 
+0xED, 0x61, 0xC9, 0x93, 0xD3, 0x5D, 0x2D, 0xE4, 
+0x21, 0x56, 0x86, 0xCE, 0x53, 0xF9, 0x02, 0x18, 
+0x7F, 0x1C, 0xED, 0x7E, 0x98, 0x02, 0x72, 0xCB, 
+0x9E, 0xC0, 0x50, 0x7A, 0x2E, 0xC6, 0x76, 0xF8, 
+0xB0, 0xB2, 0xFB, 0x8F, 0x0E, 0x6A, 0xCB, 0xB1, 
+0x81, 0x76, 0x5E, 0xE0, 0xD6, 0xEF, 0x68, 0x66, 
+0x58, 0xD2, 0x6A, 0xDE, 0xBC, 0xCF, 0x48, 0x99, 
+0x41, 0xBF, 0x4E, 0x45, 0xE3, 0x8A, 0x58, 0xEE, 
+0x71, 0xD0, 0x88, 0x4B, 
+
+0x9D, 0x9F, 0xC9, 0xE4, 0xC3, 0x3D, 0x07, 0x66, 
+0x8C, 0x9A, 0xCF, 0x79, 0x68, 0x47, 0xDA, 0x12, 
+0x94, 0xC1, 0x65, 0xDD, 0x90, 0xA9, 0xD3, 0x82, 
+0x0D, 0x0A, 0x60, 0x43, 0xF3, 0x58, 0x16, 0x20, 
+0xA9, 0x06, 0x42, 0x18, 0x7E, 0x8D, 0xBF, 0xAC, 
+0x21, 0x98, 0xF7, 0x23, 0x19, 0xAF, 0x6B, 0x76, 
+0x11, 0xA0, 0x02, 0xF8, 0x4D, 0x87, 0xE3, 0xC7, 
+0x12, 0x05, 0xC9, 0x86, 0xD5, 0x05, 0xAA, 0x0B, 
+0x1E, 0x7D, 0x41, 0x12, 0x23, 0xDC, 0x77, 0xEF, 
+0x55, 0x42, 0x05, 0xF2, 0x75, 0x51, 0x21, 0xBC, 
+0x3C, 0x98, 0x3A, 0x59, 0x6B, 0x0A, 0x14, 0xBC, 
+0xE5, 0x32, 0x0C, 0x96, 0x98, 0xC1, 0xBB, 0xE2, 
+0xB8, 0xC0, 0x0D, 0xE2, 0xC5, 0xDE, 0xEF, 0x4E, 
+0x3E, 0xD0, 0xE2, 0x1D, 0x14, 0x4C, 0x79, 0x50, 
+0xB7, 0xF0, 0xE5, 0xE4, 0x2D, 0x57, 0x85, 0x63, 
+0x16, 0x58, 0x2A, 0x6C, 0x69, 0x12, 0x83, 0xE4, 
+0xD3, 0xCA, 0x5E, 0xB3, 0xBE, 0x63, 0x66, 0x80, 
+0x9B, 0x8F, 0x9D, 0x3A, 0xA2, 0x44, 0xBB, 0x85, 
+0x7C, 0x9A, 0x1E, 0x01, 0xE8, 
+
+0x6F, 0xAA, 0x95, 0x51, 0xBC, 0xE6, 0xAA, 0xB5, 
+0x59, 0xDE, 0x12, 0xF0, 0xC8, 0x7D, 0x79, 0xAD, 
+0x1C, 0xB0, 0x23, 0xAF, 0xE9, 0x1C, 0x26, 0x23, 
+0xFB, 0x3F, 0x8C, 0x03, 0xFB, 0xED, 0x65, 0xA7, 
+0x62, 0xEB, 0xB3, 0x06, 0x56, 0xCE, 0xC8, 0x18, 
+0x76, 0xBC, 0x2F, 0x4E, 0xC2, 0x22, 0xDF, 0xBE, 
+0x96, 0xE0, 0x1C, 0x95, 0xA7, 0xAA, 0xBB, 0x2E, 
+0xDC, 0xFD, 0x4F, 0x50, 0x4A, 0x6F, 0x96, 0x47, 
+0xF2, 0x10, 0x91, 0x7D, 0xC2, 0x40, 0x01, 0x36, 
+0x34, 0x10, 0xAA, 0xCE, 0xB4, 0xDD, 0x58, 0xC4, 
+0x6E, 0x5D, 0xBE, 0x9B, 0xFD, 0xCA, 0xD8, 0x16, 
+0xBF, 0xCD, 0x74, 0x93, 0x1A, 0x75, 0x04, 0xAC, 
+0x47, 0xCF, 0xF7, 0xEE, 0x24, 0xA5, 0x9F, 0x95, 
+0x6C, 0x51, 0x88, 0x4B, 0xAD, 0x8F, 0xBE, 0x56, 
+0x7A, 0x08, 0x25, 0xFE, 0xA1, 0x00, 0x1F, 0xAB, 
+0x84, 0xFB, 0x60, 0xA3, 0xF9, 0x36, 0x4C, 0x87, 
+0xC1, 0xA3, 0xDB, 0x41, 0x4E, 0x3A, 0x41, 0x0C, 
+0x7A, 0xB7, 0x26, 0x1B, 0x7D, 0x5F, 0x9D, 0x87, 
+0x84, 0xD2, 0x47, 0x87, 0x80, 0x04, 
+
+0x10, 0x71, 0x46, 0xFD, 0xAA, 0x60, 0x6C, 0xA3, 
+0x1F, 0x2E, 0x83, 0x33, 0x8B, 0x33, 0xEA, 0x68, 
+0xD8, 0x4C, 0x09, 0xEC, 0x7F, 0x19, 0x0D, 0xA0, 
+0x84, 0xD4, 0x0E, 0xB7, 0xC7, 0xA0, 0xDF, 0xEB, 
+0x82, 0xAA, 0x08, 0x23, 0xD3, 0x56, 0xBC, 0x63, 
+0x0E, 0x60, 0x58, 0xCA, 0xC4, 0xD1, 0xDB, 0xFB, 
+0x8B, 0x70, 0x53, 0xDE, 0x60, 0x11, 0xBE, 0x20, 
+0x99, 0xB7, 0x95, 0x66, 0xF4, 0x3F, 0xD9, 0x3E, 
+0xAC, 0xFC, 0x8F, 0x15, 0x46, 0x73, 0x2E, 0xAB, 
+0xAE, 0x0B, 0xBE, 0xF7, 0x62, 0x52, 0xC4, 0xF4, 
+0xE1, 0x47, 0x0C, 0x6C, 0x38, 0x2F, 0x1B, 0x60, 
+0x71, 0x30, 0x68, 0x12, 0x2E, 0xD3, 0xBC, 0xF3, 
+0xF2, 0xDD, 0x56, 0x7D, 0xDE, 0x11, 0xDF, 0xA8, 
+0x0C, 0xE6, 0x01, 0x8D, 0x54, 0xA8, 0xEB, 0x47, 
+0xD8, 0xD2, 0xA4, 0xFE, 0xA2, 0x43, 0x46, 0xB9, 
+0x77, 0xE1, 0x86, 0xEC, 0xF3, 0x4D, 0xE8, 0xD3, 
+0xD1, 0x29, 0xAD, 0x4B, 0xA8, 0xCA, 0xF1, 0xDB, 
+0x63, 0xEA, 0xAF, 0x02, 0xA9, 0xA1, 0x09, 0x77, 
+0x01, 0x3A, 0xBB, 0x79, 0x51, 0x77, 0x2C, 
+
+0xAB, 0xB2, 0x0E, 0x70, 0x98, 0x6F, 0xF9, 0x46, 
+0x4A, 0x70, 0x7C, 0xD0, 0x61, 0xE8, 0x76, 0x0C, 
+0x3E, 0xE0, 0xEA, 0xEB, 0x48, 0xA4, 0xF3, 0x5D, 
+0x06, 0x67, 0x9C, 0xA1, 0xF8, 0x72, 0xBF, 0xA5, 
+0x89, 0x7B, 0xE6, 0x41, 0xA0, 0xB4, 0xC6, 0xF1, 
+0x51, 0x1A, 0x01, 0x4D, 0x93, 0xC4, 0xF4, 0xF2, 
+0x17, 0xC3, 0x6A, 0xB8, 0xF5, 0xE1, 0xFD, 0x47, 
+0x98, 0x50, 0x83, 0x63, 0xB0, 0x21, 0x07, 0xB7, 
+0xF6, 0xDA, 0x0B, 0x6C, 0xD6, 0x8C, 0xB9, 0xE1, 
+0xE1, 0xCC, 0x0C, 0xC3, 0x42, 0x07, 0x5A, 0x1B, 
+0xE0, 0xF9, 0x1B, 0xB2, 0xDD, 0xD5, 0x1F, 0x82, 
+0x8B, 0xB1, 0x08, 0xC2, 0xDB, 0xC6, 0x8F, 0x72, 
+0xC9, 0x64, 0x68, 0x48, 0x8A, 0xCE, 0xD3, 0xFB, 
+0xF9, 0xE0, 0x5D, 0x60, 0x99, 0x4C, 0xF0, 0xDE, 
+0xF9, 0x5A, 0x95, 0x2C, 0xD5, 0xFA, 0x9A, 0x66, 
+0x3F, 0x50, 0xB7, 0x9C, 0x92, 0xC0, 0x45, 0x5A, 
+0x96, 0xE7, 0x75, 0x14, 0x37, 0x2F, 0xBE, 0x87, 
+0x6C, 0x1C, 0x95, 0x0A, 0x01, 0x97, 0x9C, 0x36, 
+0xC3, 0xFE, 0xCD, 0x6A, 0x95, 0x53, 0x28, 0x29, 
+
+0x4A, 0xC6, 0x11, 0xA1, 0x98, 0x48, 0x47, 0xF4, 
+0xD0, 0xF2, 0x49, 0xEF, 0xAF, 0x1C, 0x6B, 0xAE, 
+0xC7, 0x3F, 0xD4, 0x1B, 0x49, 0xB8, 0x1A, 0x9A, 
+0x12, 0x26, 0x3D, 0x89, 0x39, 0xF4, 0x56, 0x21, 
+0xD7, 0xA0, 0x5C, 0xC3, 0x8C, 0x87, 0x45, 0x51, 
+0x67, 0xF8, 0x2B, 0x05, 0x3C, 0xF4, 0x33, 0xC8, 
+0xCA, 0xB3, 0x76, 0x8B, 0x62, 0x13, 0x29, 0xD8, 
+0xA9, 0x19, 0x74, 0x14, 0xC4, 0xC5, 0x53, 0x93, 
+0x06, 0xA2, 0x94, 0x7B, 0xD0, 0xF7, 0x5A, 0xED, 
+0xC8, 0xD2, 0x54, 0x3C, 0x57, 0x68, 0x50, 0x17, 
+0x00, 0x14, 0x21, 0xAF, 0x65, 0x21, 0x8F, 0x59, 
+0xB6, 0xAD, 0x2D, 0x55, 0xC4, 0x0F, 0xA5, 0x49, 
+0x24, 0xA2, 0xF3, 0x3A, 0x5A, 0xCF, 0x5C, 0x37, 
+0x6A, 0x34, 0x22, 0xC8, 0xA2, 0x72, 0x14, 0x61, 
+0x9B, 0x4B, 0x72, 0x1A, 0xBA, 0x22, 0x41, 0xEE, 
+0x68, 0x5D, 0x67, 0x38, 0x2F, 0x7F, 0x40, 0x67, 
+0x05, 0x8A, 0x6C, 0x39, 0xF8, 0x35, 0x20, 0xF7, 
+0x5A, 0xCD, 0xBF, 0x72, 0xC8, 0xFF, 0x08, 0x58, 
+0x0D, 0xA4, 0x8A, 0x35, 0xA7, 0x36, 0x18, 0xB2, 
+0x04, 
+
+0xAC, 0xEB, 0x4A, 0x8F, 0x44, 0xCF, 0x38, 0x3C, 
+0x38, 0x7A, 0xD5, 0x46, 0x6D, 0x85, 0x44, 0x9D, 
+0x31, 0x74, 0x68, 0x7D, 0x4B, 0xD6, 0x6A, 0x88, 
+0x7C, 0xCA, 0x2C, 0x99, 0x39, 0x48, 0x6A, 0x1E, 
+0x84, 0xA7, 0xCC, 0x0F, 0x8E, 0x77, 0xE0, 0x4D, 
+0x3E, 0x35, 0x2C, 0x5F, 0x6F, 0x85, 0x0D, 0xED, 
+0xB9, 0x64, 0xF8, 0x63, 0xC7, 0xE8, 0xE2, 0x37, 
+0x08, 0xBD, 0x2C, 0xF1, 0xE0, 0x24, 0x8F, 0x40, 
+0x31, 0x12, 0x03, 0xCD, 0x90, 0x11, 0xF6, 0x84, 
+0x80, 0x2A, 0x82, 0x07, 0x64, 0x5D, 0xE7, 0xE8, 
+0xB6, 0x64, 0x22, 0x26, 0x0F, 0x89, 0xD7, 0x95, 
+0x07, 0x36, 0x5D, 0xF5, 0x0E, 0x10, 0x09, 0x37, 
+0x4C, 0x41, 0xE7, 0x45, 0xCE, 0x9F, 0x92, 0x3B, 
+0x0D, 0x6E, 0xC3, 0xA4, 0x0D, 0x27, 0xC8, 0x63, 
+0x0B, 0xE1, 0x95, 0x22, 0xD3, 0x0E, 0x28, 0x8B, 
+0xBD, 0xD5, 0x2F, 0x6E, 0xC9, 0xAB, 0xFB, 0x16, 
+0xE6, 0xD6, 0xB8, 0x12, 0x13, 0x0F, 0xA5, 0x90, 
+0xA1, 0xCF, 0x4D, 0xC7, 0xBD, 0xC2, 0x31, 0x64, 
+0xEB, 0x2A, 0xF5, 0x1F, 0x4B, 0xE6, 0xCB, 0x10, 
+0x7C, 0x03, 
+
+0x0C, 0x2E, 0xCA, 0x5B, 0x8A, 0xE8, 0x55, 0x66, 
+0xC0, 0xDB, 0xF1, 0xE3, 0x72, 0xC1, 0x88, 0xBF, 
+0x24, 0xA0, 0x25, 0x69, 0x24, 0x0C, 0xC0, 0x72, 
+0x38, 0x3C, 0x28, 0x5D, 0xCE, 0x11, 0x4E, 0x2F, 
+0xF8, 0xCC, 0xDA, 0x67, 0xBF, 0x01, 0x31, 0x04, 
+0x50, 0x3C, 0x58, 0x23, 0x27, 0xB9, 0xAE, 0x35, 
+0x77, 0xF3, 0x71, 0xFE, 0x75, 0x58, 0x97, 0x1C, 
+0x1E, 0x95, 0x68, 0x93, 0x07, 0x43, 0x32, 0xEF, 
+0xBE, 0x3C, 0xE1, 0xCF, 0xB6, 0x0E, 0xAF, 0xC0, 
+0x05, 0xEF, 0x58, 0xB7, 0xC0, 0x9B, 0x60, 0x84, 
+0x3A, 0x56, 0x06, 0x30, 0x80, 0x9D, 0xB4, 0x37, 
+0x24, 0xAD, 0x36, 0x21, 0x0B, 0xBE, 0x95, 0x3E, 
+0xD6, 0x4F, 0x2C, 0x2C, 0x72, 0xD1, 0xAA, 0x08, 
+0x33, 0x5D, 0xA6, 0x4B, 0x51, 0x8C, 0xBF, 0x38, 
+0x95, 0xDF, 0x68, 0xF2, 0xAE, 0x97, 0xC0, 0x40, 
+0x30, 0xBE, 0x62, 0xFF, 0x14, 0x3E, 0xBA, 0xEE, 
+0x93, 0x14, 0xBE, 0x66, 0x39, 0x45, 0x79, 0xF0, 
+0x98, 0x32, 0x8A, 0x5D, 0x96, 0x81, 0xD5, 0x02, 
+0xF7, 0x66, 0x63, 0xA1, 0x60, 0xEC, 0x64, 0x63, 
+0x14, 0x2F, 0x81, 
+
+0xC8, 0x72, 0x62, 0xAA, 0x0E, 0x8D, 0x9A, 0x42, 
+0x19, 0xFD, 0xEB, 0xA1, 0x11, 0xA0, 0x2A, 0x4F, 
+0x35, 0x7C, 0x2A, 0xAA, 0xB5, 0x72, 0xE3, 0x14, 
+0xA6, 0xF5, 0x93, 0x61, 0x70, 0x61, 0x5E, 0x13, 
+0x1D, 0x9A, 0x88, 0x86, 0x15, 0x54, 0x3A, 0xBA, 
+0x5F, 0xED, 0x23, 0x73, 0xA3, 0xAC, 0xF2, 0xCA, 
+0xCB, 0xD2, 0xD6, 0x5F, 0xB7, 0xDD, 0x78, 0x93, 
+0xF4, 0xAB, 0x34, 0x11, 0x58, 0xEC, 0xD4, 0x45, 
+0xE2, 0x17, 0x14, 0xE0, 0x1F, 0x8D, 0xB7, 0x20, 
+0x35, 0x10, 0x91, 0xFB, 0x03, 0xD7, 0x06, 0x2F, 
+0x72, 0xDB, 0xB4, 0xEA, 0xB2, 0x0B, 0xC9, 0xD3, 
+0xC6, 0x1B, 0x57, 0x1E, 0x53, 0x68, 0x56, 0x16, 
+0xB1, 0xF3, 0xC5, 0x73, 0x32, 0x77, 0xF4, 0x6A, 
+0xBD, 0xB2, 0x0C, 0xF9, 0x36, 0xF0, 0x32, 0x45, 
+0x86, 0x2B, 0x60, 0x5D, 0x2B, 0xD2, 0xCA, 0x10, 
+0xDD, 0x51, 0x5C, 0x06, 0x8D, 0xEC, 0x21, 0x6A, 
+0xF3, 0x30, 0xE4, 0x36, 0x24, 0x21, 0xDA, 0x2B, 
+0x9C, 0x16, 0xC7, 0xB8, 0x5E, 0xF7, 0xB7, 0x23, 
+0x9F, 0x56, 0xF9, 0x39, 0x15, 0x7E, 0x29, 0x81, 
+0x58, 0xAB, 0xD4, 0xB8, 
+
+0x16, 0x0C, 0xAF, 0x1D, 0x69, 0x3A, 0xAF, 0xD4, 
+0x76, 0xD4, 0x9F, 0x9A, 0xDA, 0x3A, 0x70, 0xCA, 
+0xD9, 0xBD, 0xB8, 0xC7, 0xAB, 0x96, 0xA5, 0x07, 
+0x9D, 0xE9, 0x65, 0x8C, 0xF9, 0xBE, 0x43, 0x31, 
+0xDC, 0xCB, 0xC5, 0xEC, 0x5F, 0x46, 0xE2, 0x0E, 
+0x1E, 0x6B, 0x15, 0x34, 0xB7, 0x91, 0x0A, 0x1B, 
+0x0D, 0xBF, 0x49, 0xC8, 0x2C, 0x6E, 0x89, 0x07, 
+0x41, 0x09, 0x00, 0xB7, 0xAF, 0x4C, 0x8F, 0x75, 
+0xB6, 0xA5, 0xCD, 0xD1, 0x30, 0xE8, 0x37, 0xC5, 
+0x91, 0x86, 0x41, 0x52, 0xD5, 0xA0, 0x4C, 0x47, 
+0xDA, 0xE1, 0xCE, 0xE5, 0x3B, 0x0E, 0x17, 0x72, 
+0x5F, 0x7A, 0xF6, 0x4D, 0x55, 0xA1, 0xBD, 0xCB, 
+0x76, 0xC9, 0x63, 0xB0, 0x3D, 0x44, 0x78, 0x5C, 
+0xCB, 0x0D, 0xC0, 0x4A, 0x25, 0xDA, 0x7E, 0x17, 
+0x0A, 0xF3, 0x34, 0xD2, 0xC3, 0xC3, 0xA4, 0x17, 
+0xFF, 0x5C, 0x14, 0x14, 0xC0, 0x63, 0xBA, 0x3E, 
+0xD6, 0xB8, 0xDE, 0x35, 0xDA, 0xA7, 0xCF, 0xD3, 
+0x65, 0xBF, 0x9C, 0x86, 0xB9, 0xE2, 0x02, 0x87, 
+0x16, 0xBE, 0xA9, 0x04, 0x78, 0xE6, 0xFD, 0x98, 
+0xC7, 0x47, 0xD1, 0x82, 0x8C, 
+
+0xDF, 0x9A, 0x73, 0xE1, 0x0E, 0x7C, 0x00, 0xC2, 
+0x55, 0x11, 0xD0, 0x6F, 0x7B, 0xE5, 0x17, 0x7F, 
+0x72, 0x54, 0x72, 0x1C, 0x46, 0xE2, 0x71, 0xE4, 
+0xF2, 0x16, 0xA4, 0x3D, 0x1C, 0x07, 0x65, 0xAD, 
+0x47, 0x23, 0x14, 0x45, 0x4B, 0x05, 0xAC, 0x64, 
+0x35, 0x7C, 0x17, 0x60, 0x7B, 0xE6, 0xDB, 0x1D, 
+0xBA, 0x61, 0x4B, 0x61, 0x6B, 0x59, 0x9C, 0xD6, 
+0x3F, 0xE3, 0xFD, 0xFD, 0x2B, 0xEB, 0x84, 0x2E, 
+0x71, 0xA2, 0x92, 0x24, 0x9A, 0xB2, 0x0C, 0xD3, 
+0xE4, 0xF7, 0x99, 0x20, 0x3D, 0x84, 0xFE, 0x10, 
+0x99, 0xB7, 0x2E, 0x9B, 0xA4, 0xBC, 0x8B, 0x13, 
+0x8A, 0xD7, 0x3A, 0x8C, 0xBF, 0x1F, 0x59, 0xA2, 
+0xE1, 0xED, 0xEB, 0xC2, 0x08, 0x16, 0x44, 0x2C, 
+0x44, 0x53, 0x65, 0x0C, 0x3C, 0x54, 0xA1, 0xED, 
+0x0E, 0xD5, 0x7A, 0x3C, 0x29, 0x9B, 0x8A, 0x92, 
+0x3B, 0xCC, 0x58, 0x57, 0x30, 0xAB, 0xA6, 0xD4, 
+0x4B, 0x36, 0xC0, 0x29, 0x68, 0x9E, 0xD8, 0x5D, 
+0x2A, 0x6D, 0xCD, 0xC9, 0xB6, 0x94, 0x18, 0x07, 
+0x76, 0x7A, 0xE7, 0xE4, 0xC8, 0x57, 0x26, 0x4C, 
+0xF0, 0xD8, 0x2F, 0x9E, 0xDA, 0x73, 
+
+0x39, 0x5C, 0xBD, 0xB4, 0x12, 0xE9, 0x88, 0xA6, 
+0xEF, 0xC8, 0xC6, 0x50, 0x09, 0xBE, 0x57, 0x1B, 
+0xB9, 0xA6, 0x62, 0x14, 0xE3, 0x94, 0x79, 0xCE, 
+0x4C, 0x04, 0xA8, 0x2D, 0xEE, 0x6C, 0x4A, 0x1B, 
+0x5D, 0xC3, 0xEF, 0xA9, 0xED, 0xF7, 0xD8, 0x6B, 
+0x11, 0xE9, 0x3C, 0x3D, 0x9C, 0xE7, 0xA0, 0xE8, 
+0xC0, 0x7E, 0xA7, 0xD4, 0x06, 0x30, 0x03, 0x5C, 
+0x08, 0x47, 0xEB, 0x08, 0x85, 0xF0, 0x4A, 0x87, 
+0x51, 0x9F, 0x48, 0x54, 0xE8, 0x5A, 0xE6, 0x61, 
+0xCB, 0xD0, 0xF5, 0x9B, 0xD3, 0x37, 0xA4, 0xA0, 
+0x01, 0xF5, 0xD9, 0xA9, 0x72, 0x57, 0xB4, 0xD3, 
+0x5A, 0xE2, 0x63, 0xB4, 0x2E, 0x32, 0xA2, 0xD8, 
+0x67, 0x9B, 0x0D, 0x8F, 0x81, 0x12, 0xFB, 0xE4, 
+0x9C, 0xB2, 0x3F, 0x00, 0x8B, 0xF9, 0x6E, 0x91, 
+0x1A, 0x22, 0xA7, 0x64, 0xC1, 0x50, 0x1C, 0xB1, 
+0x32, 0xDC, 0x1B, 0xA6, 0x99, 0xE3, 0xC3, 0x9F, 
+0x45, 0xCB, 0x65, 0x80, 0x36, 0x0B, 0xFD, 0xDE, 
+0x53, 0x3E, 0x7F, 0x43, 0x29, 0x8F, 0xEC, 0x83, 
+0x7F, 0xE0, 0x18, 0x7F, 0x7A, 0x49, 0xB7, 0xC7, 
+0x11, 0xCB, 0xEC, 0xCD, 0xE4, 0xAA, 0x49, 
+
+0x52, 0xAC, 0x51, 0x1D, 0x2E, 0x78, 0x58, 0xFD, 
+0x40, 0xC3, 0x29, 0xD8, 0x97, 0x3F, 0xBF, 0xB2, 
+0x90, 0x13, 0x88, 0xBD, 0x56, 0x20, 0x2B, 0xF1, 
+0xC1, 0x82, 0x0C, 0xC1, 0x18, 0x8A, 0x76, 0xE1, 
+0xFE, 0x09, 0xFB, 0x00, 0x7C, 0xF6, 0x64, 0x91, 
+0xFC, 0x74, 0x09, 0x91, 0xF9, 0xB5, 0x7D, 0x5F, 
+0x8C, 0x61, 0x2E, 0xF5, 0xE7, 0x26, 0xCB, 0x9A, 
+0xF7, 0xF2, 0x91, 0xF1, 0x6E, 0x33, 0xFB, 0x18, 
+0x2C, 0xAF, 0x28, 0x54, 0x7D, 0x62, 0x89, 0x11, 
+0xE8, 0x7C, 0x95, 0x2E, 0x9F, 0x31, 0x8E, 0x59, 
+0x5D, 0x80, 0x55, 0xB4, 0xF2, 0xD3, 0x05, 0x05, 
+0x73, 0x86, 0xD0, 0xBD, 0xF6, 0x98, 0xB1, 0x06, 
+0x8B, 0xC4, 0xE2, 0x19, 0x04, 0xDB, 0x3C, 0x60, 
+0x12, 0x8B, 0x63, 0xAF, 0x28, 0x24, 0xEB, 0x81, 
+0x3C, 0x82, 0xBB, 0x62, 0xD4, 0xB9, 0x53, 0xC2, 
+0xDC, 0x0B, 0x7A, 0xB3, 0x7A, 0x71, 0xA5, 0xB4, 
+0x8D, 0xB2, 0xF0, 0xA0, 0x2A, 0x68, 0xD6, 0xC1, 
+0xCC, 0x3F, 0xAD, 0x6C, 0x9D, 0xDC, 0xA2, 0xC8, 
+0xBC, 0xCD, 0x7A, 0x1A, 0x70, 0x36, 0x25, 0x85, 
+0x96, 0x41, 0x44, 0x3B, 0xEF, 0xD3, 0xF3, 0xFE, 
+
+0x88, 0x72, 0xED, 0xBC, 0x74, 0x88, 0xEC, 0x34, 
+0xFE, 0xB1, 0x48, 0x4A, 0x6A, 0x6D, 0x24, 0x91, 
+0x45, 0x32, 0x0B, 0xAB, 0x44, 0x75, 0x5C, 0x5D, 
+0x96, 0x77, 0x36, 0x4D, 0xBB, 0x98, 0xDB, 0xE1, 
+0xE4, 0x0B, 0x02, 0xC5, 0x70, 0x9D, 0x90, 0x44, 
+0xF3, 0xD2, 0xA1, 0x6B, 0xEA, 0xAF, 0xE1, 0xD8, 
+0x5C, 0x43, 0x85, 0x77, 0x2D, 0xE8, 0xD0, 0xAA, 
+0x3B, 0x20, 0x0A, 0x5C, 0x28, 0x13, 0x4B, 0x18, 
+0xF4, 0x4C, 0xC3, 0x07, 0x11, 0x10, 0x60, 0xDF, 
+0x3F, 0xCD, 0xBB, 0x10, 0xCB, 0xF4, 0x02, 0x1C, 
+0x21, 0x98, 0xB8, 0x94, 0x50, 0xD8, 0x75, 0x96, 
+0x7B, 0xAF, 0xCF, 0x97, 0x6C, 0x6F, 0x73, 0x6D, 
+0xFD, 0x37, 0x92, 0x29, 0xF5, 0x30, 0xCB, 0xCE, 
+0x6D, 0xC2, 0xC9, 0xAF, 0x07, 0x04, 0xAB, 0x23, 
+0x84, 0xA7, 0xC0, 0xDF, 0xA7, 0x6E, 0x6E, 0xA5, 
+0x05, 0xE5, 0x9C, 0x23, 0x15, 0x2A, 0xE3, 0x4F, 
+0x42, 0x12, 0x01, 0x0B, 0x75, 0xBC, 0x3D, 0x0A, 
+0xFB, 0x39, 0xEA, 0x6E, 0xFE, 0x64, 0xE2, 0x1B, 
+0xDB, 0xB8, 0x84, 0x64, 0x87, 0xF0, 0xDF, 0x36, 
+0xFA, 0xC5, 0x43, 0x5D, 0xDA, 0xB5, 0xA2, 0x2D, 
+0x05, 
+
+0x0E, 0x7F, 0x33, 0xF2, 0x07, 0xA3, 0x09, 0xE9, 
+0x90, 0xFE, 0xFA, 0x99, 0xB6, 0xC3, 0xD2, 0xF5, 
+0x82, 0xAB, 0xCC, 0xDB, 0xEE, 0xBC, 0xE3, 0x9D, 
+0x39, 0xBF, 0x40, 0x0A, 0x80, 0x48, 0x18, 0xA9, 
+0xB2, 0x3B, 0xC7, 0xF6, 0x84, 0x91, 0x23, 0xCB, 
+0xAC, 0x89, 0x55, 0x89, 0xD8, 0xF8, 0x60, 0xDC, 
+0x3F, 0x31, 0x80, 0x1C, 0x4D, 0x6E, 0x42, 0x7B, 
+0x30, 0xBB, 0x4F, 0xEB, 0xDD, 0x35, 0x43, 0xEF, 
+0xAD, 0x98, 0xD6, 0x82, 0xE3, 0x51, 0x5E, 0xED, 
+0x9D, 0x86, 0x57, 0x98, 0xDB, 0x26, 0xC7, 0xAD, 
+0x1D, 0x65, 0xF7, 0x98, 0xE6, 0xB1, 0x5D, 0x9C, 
+0xDA, 0x12, 0x3B, 0xFC, 0x09, 0x34, 0x73, 0x0C, 
+0xB5, 0x6C, 0xB7, 0xCF, 0x4E, 0x7F, 0x09, 0x43, 
+0x08, 0x87, 0xAA, 0xDB, 0xBA, 0xE3, 0xA4, 0x87, 
+0x2C, 0x69, 0x75, 0xA8, 0xE2, 0xA5, 0x25, 0xB1, 
+0x32, 0x7B, 0x2B, 0x9E, 0xCF, 0x9D, 0xC8, 0x6A, 
+0x3C, 0xC3, 0x56, 0x26, 0x87, 0x29, 0xF9, 0x57, 
+0xDB, 0x88, 0x13, 0xF0, 0x08, 0xAD, 0xAF, 0xF1, 
+0x7A, 0xEC, 0xB0, 0x76, 0x96, 0x1A, 0x1B, 0x2B, 
+0x6A, 0x36, 0x1E, 0x8B, 0x1C, 0xFD, 0xA9, 0x0F, 
+0x1D, 0xEE, 
+
+0x53, 0x5D, 0xED, 0x77, 0x45, 0xFD, 0xBC, 0x75, 
+0xB3, 0xA0, 0x7E, 0x98, 0xB3, 0xAD, 0xD3, 0x16, 
+0x10, 0xF0, 0xBF, 0x0B, 0x7F, 0x18, 0xAC, 0x00, 
+0x0B, 0xEC, 0x2C, 0xD8, 0xF3, 0x63, 0x16, 0xBF, 
+0x56, 0x1C, 0x5B, 0x07, 0x0B, 0xEA, 0x03, 0xD4, 
+0xFD, 0x4A, 0x90, 0xB4, 0x20, 0x5C, 0x37, 0xF2, 
+0x20, 0x35, 0x4D, 0xB5, 0x63, 0xC9, 0xA7, 0x90, 
+0x40, 0x55, 0xB5, 0xF4, 0xB0, 0xDC, 0xC8, 0x7D, 
+0x53, 0x30, 0x52, 0x5D, 0x03, 0xCA, 0x7E, 0x61, 
+0xE8, 0xE2, 0x4A, 0xA6, 0x92, 0x85, 0x47, 0x5B, 
+0x63, 0x0B, 0xD8, 0xA0, 0x7A, 0x51, 0x5F, 0x63, 
+0x0C, 0x78, 0x04, 0x3A, 0x66, 0xAD, 0x67, 0x89, 
+0xBC, 0x4D, 0x75, 0x59, 0x51, 0x54, 0x74, 0xC9, 
+0x50, 0x31, 0x4A, 0x50, 0x40, 0xFB, 0xC8, 0xDB, 
+0x72, 0xF9, 0x5F, 0x07, 0xF2, 0x1F, 0x38, 0x68, 
+0xBA, 0xDA, 0x8E, 0xF2, 0x59, 0x9F, 0xED, 0xE6, 
+0xDE, 0xC6, 0xB5, 0x6C, 0xBF, 0xFA, 0x76, 0x19, 
+0xF6, 0xFB, 0xD0, 0x24, 0x1F, 0xF3, 0x40, 0x18, 
+0xDD, 0x22, 0x5A, 0xE4, 0x77, 0x1F, 0xD1, 0x94, 
+0x92, 0x49, 0x99, 0x1B, 0x2A, 0xE9, 0x49, 0x53, 
+0x2D, 0xB8, 0xD0, 
+
+0xBB, 0xF0, 0x43, 0xCC, 0x9A, 0xC6, 0x66, 0x21, 
+0xF6, 0x34, 0x39, 0xCE, 0x05, 0x4D, 0x15, 0x07, 
+0xB4, 0x9A, 0x10, 0x91, 0x13, 0x29, 0xB5, 0xA2, 
+0x00, 0x08, 0x7A, 0x03, 0x63, 0xE0, 0xA2, 0xAA, 
+0x84, 0x4A, 0xA7, 0x8B, 0x5B, 0x0C, 0x4F, 0xCC, 
+0x36, 0xF2, 0x54, 0xBF, 0x6E, 0xA7, 0x3C, 0x89, 
+0xD6, 0x15, 0x8F, 0xBF, 0x13, 0x16, 0x3C, 0x38, 
+0x40, 0x4E, 0xF8, 0x22, 0x07, 0xB6, 0xB9, 0x3D, 
+0x1B, 0xC4, 0xC8, 0x87, 0xD4, 0x29, 0x63, 0x6C, 
+0xCE, 0xC5, 0x2A, 0x63, 0x2C, 0xBC, 0xD0, 0xC8, 
+0xDC, 0xD3, 0xA4, 0x1E, 0xC9, 0xB5, 0x5E, 0x35, 
+0x27, 0x88, 0x04, 0x77, 0xB3, 0xCF, 0x63, 0x57, 
+0x9D, 0x2B, 0xB7, 0xE9, 0xC2, 0xC3, 0x6D, 0x23, 
+0xD3, 0xCC, 0xBF, 0x37, 0x02, 0xCB, 0x85, 0x25, 
+0xAF, 0x1A, 0x77, 0x1D, 0x97, 0xC1, 0x11, 0x8D, 
+0x32, 0x16, 0x86, 0xCD, 0x7C, 0xE1, 0x25, 0x5B, 
+0x8B, 0xEA, 0x89, 0xBF, 0xA4, 0x0B, 0x02, 0x5D, 
+0x52, 0xF3, 0x30, 0xBF, 0x82, 0xD9, 0xB9, 0x99, 
+0x4E, 0x98, 0x0E, 0x79, 0x83, 0x54, 0x3C, 0x6A, 
+0xF0, 0x12, 0xC2, 0xE0, 0x3A, 0x5D, 0x3A, 0xF1, 
+0x64, 0x33, 0xEE, 0xC6, 
+
+0x01, 0xF2, 0xB6, 0xC2, 0xF8, 0xEB, 0x1F, 0xD2, 
+0x4F, 0xC4, 0xDF, 0x2C, 0x32, 0xF1, 0x44, 0xA6, 
+0xA3, 0x24, 0xB2, 0x0A, 0xE5, 0x28, 0x22, 0x5B, 
+0x78, 0x3B, 0x12, 0x63, 0x42, 0x6C, 0xC6, 0xA7, 
+0x7C, 0x18, 0x05, 0x87, 0xE0, 0xFF, 0xCC, 0xA9, 
+0x2D, 0x1E, 0x19, 0x41, 0xB3, 0x40, 0x40, 0x23, 
+0xFE, 0xE9, 0x5F, 0x15, 0x3E, 0x0F, 0x1D, 0xDD, 
+0xC7, 0x37, 0x9B, 0xA9, 0x59, 0x23, 0x58, 0xB3, 
+0x4A, 0x25, 0x23, 0xED, 0x0A, 0x0D, 0x5B, 0x74, 
+0xF6, 0xAD, 0x1D, 0xFA, 0x6D, 0x43, 0x1A, 0x17, 
+0x41, 0xC8, 0x03, 0x44, 0x92, 0x79, 0x64, 0xEB, 
+0x9D, 0x9C, 0xD7, 0xAA, 0x39, 0x77, 0x20, 0x0E, 
+0x13, 0x01, 0x38, 0x2F, 0xA8, 0xE5, 0x9B, 0x01, 
+0x25, 0xC7, 0x87, 0x91, 0x65, 0xEE, 0x23, 0x32, 
+0x46, 0x51, 0xF9, 0x23, 0x79, 0x51, 0xA0, 0x65, 
+0x79, 0xA3, 0x62, 0x27, 0xC5, 0xF9, 0xF6, 0x0E, 
+0x6B, 0x61, 0x42, 0x9A, 0x3E, 0x19, 0x5B, 0x9B, 
+0xE5, 0x67, 0x51, 0xAF, 0x52, 0xF9, 0x92, 0xE9, 
+0x1E, 0x4E, 0x95, 0xDF, 0x82, 0x5C, 0xDE, 0x11, 
+0xCF, 0x7E, 0x02, 0x69, 0x46, 0xC5, 0xAA, 0xC7, 
+0x74, 0xD8, 0xF5, 0xA2, 0x1A, 
+
+0x27, 0xE7, 0x49, 0xCE, 0x06, 0x3E, 0xE7, 0x15, 
+0x61, 0x0C, 0x69, 0xA1, 0x1E, 0xDA, 0x93, 0x97, 
+0xBB, 0x1B, 0x37, 0x81, 0xAC, 0xC3, 0x05, 0xCE, 
+0xA3, 0xF8, 0xED, 0x6E, 0xEA, 0xD0, 0xEB, 0x78, 
+0x3A, 0x92, 0x8B, 0x3C, 0xBC, 0xE9, 0xC3, 0x75, 
+0x09, 0x43, 0x0E, 0x2B, 0x11, 0xE7, 0xAB, 0x9A, 
+0x3C, 0xEF, 0xA8, 0x73, 0xB4, 0x6C, 0x10, 0x16, 
+0xBB, 0x5E, 0x95, 0xB6, 0xDA, 0xB7, 0xA9, 0xE5, 
+0x62, 0xFD, 0x40, 0x3A, 0xF0, 0xF6, 0x56, 0x8C, 
+0xED, 0x98, 0xBE, 0xDD, 0x33, 0x9C, 0x56, 0xF9, 
+0xFC, 0x1C, 0x75, 0x9F, 0x90, 0xF8, 0x16, 0xCD, 
+0x68, 0x80, 0x80, 0xC8, 0xD3, 0x28, 0xCA, 0x89, 
+0x5D, 0x10, 0x04, 0xBA, 0x42, 0xF1, 0x88, 0xFE, 
+0x6B, 0x56, 0xF1, 0x1F, 0x3D, 0xF6, 0xF8, 0xBC, 
+0x6D, 0xE0, 0x4A, 0x78, 0x7A, 0x72, 0x0E, 0x2C, 
+0xA6, 0x51, 0x6C, 0xD2, 0x71, 0x15, 0x46, 0xCB, 
+0xAC, 0x43, 0xBE, 0xAA, 0x09, 0x37, 0xCB, 0x3D, 
+0x27, 0xF9, 0x0B, 0x4F, 0x3C, 0x04, 0xF9, 0xB7, 
+0x68, 0xFF, 0xBA, 0x6A, 0x8C, 0xAD, 0x07, 0xC9, 
+0xA2, 0xD3, 0xED, 0x13, 0xE8, 0x2A, 0xB0, 0x81, 
+0x7A, 0x20, 0x4D, 0xB9, 0x52, 0x78, 
+
+0xA9, 0xB5, 0x22, 0x8E, 0xEC, 0xFB, 0xCC, 0x49, 
+0x18, 0xE6, 0x35, 0xFB, 0x3D, 0xF2, 0x6A, 0x34, 
+0xF7, 0x83, 0xDB, 0x1D, 0x47, 0xB7, 0x51, 0x65, 
+0xEA, 0x3B, 0x7F, 0x52, 0xB5, 0x06, 0x90, 0x1D, 
+0x0D, 0x83, 0x66, 0x42, 0x71, 0xCA, 0x4D, 0x1B, 
+0x04, 0x59, 0x2D, 0xF2, 0x73, 0x46, 0x5C, 0x55, 
+0x07, 0xBF, 0xAE, 0x63, 0xB4, 0xE2, 0xEB, 0xC6, 
+0x2E, 0xCD, 0x28, 0x35, 0x99, 0x79, 0x39, 0x70, 
+0xEF, 0x47, 0xEA, 0xEB, 0x4A, 0xC5, 0x52, 0xE9, 
+0x0E, 0x9C, 0xAA, 0x2F, 0x00, 0xC8, 0xC0, 0x5B, 
+0xC1, 0xED, 0xF5, 0x7C, 0x53, 0xB5, 0x74, 0x21, 
+0x20, 0x49, 0xC2, 0xFF, 0x8C, 0x9C, 0x18, 0x41, 
+0xC4, 0x87, 0x4B, 0x01, 0x4E, 0xE1, 0xEB, 0x1B, 
+0xBE, 0xA3, 0x21, 0xF6, 0x9C, 0x6B, 0xD8, 0xCA, 
+0xD6, 0xD0, 0x20, 0x8F, 0xCE, 0x3B, 0x87, 0x6F, 
+0x08, 0xF9, 0xCE, 0xD4, 0x74, 0x8C, 0x68, 0xE4, 
+0xDF, 0x8D, 0xF9, 0x5A, 0x79, 0x17, 0xA4, 0x3F, 
+0xB1, 0x7E, 0x9B, 0x66, 0x32, 0x45, 0x41, 0x44, 
+0x2A, 0x3E, 0x2B, 0x74, 0x11, 0x07, 0x49, 0x3E, 
+0xDC, 0x78, 0x9B, 0x8C, 0x96, 0x42, 0xF3, 0xC2, 
+0xEF, 0xA7, 0xA5, 0x66, 0x76, 0xCB, 0x3F, 
+
+0x4A, 0x97, 0x00, 0x4D, 0x72, 0xEC, 0x32, 0x6B, 
+0x04, 0x56, 0xCE, 0xF2, 0xA7, 0xB6, 0x3A, 0x58, 
+0xCF, 0xD7, 0xFF, 0x75, 0xFE, 0x67, 0x28, 0xF9, 
+0x95, 0x4F, 0x44, 0x36, 0x7B, 0x1D, 0xC5, 0x67, 
+0x20, 0xE2, 0xE0, 0xA9, 0x88, 0x51, 0xCD, 0xAE, 
+0xCB, 0xA7, 0xFB, 0xEB, 0x74, 0x89, 0xD4, 0xFA, 
+0x88, 0x0D, 0x85, 0xB0, 0xC6, 0x57, 0xD2, 0x62, 
+0x17, 0xD9, 0xA0, 0x18, 0x78, 0x7E, 0xEE, 0x9E, 
+0x3B, 0xE4, 0x0F, 0x72, 0x77, 0xA2, 0x34, 0x97, 
+0x33, 0x87, 0x87, 0x98, 0x07, 0x89, 0xDB, 0x1F, 
+0xB5, 0xAC, 0x7C, 0x30, 0xE0, 0xAD, 0x21, 0xA2, 
+0x9E, 0xBC, 0x46, 0x82, 0xC4, 0xDE, 0x0D, 0xBF, 
+0xB0, 0x46, 0x36, 0x81, 0x45, 0x15, 0x35, 0x3E, 
+0x0D, 0xB0, 0x54, 0x7F, 0x1D, 0x77, 0xCE, 0x36, 
+0x92, 0x47, 0x62, 0x36, 0x22, 0xAC, 0xC9, 0xF7, 
+0x4E, 0xD9, 0xBE, 0xED, 0x70, 0xB8, 0xBB, 0x61, 
+0xF0, 0xFB, 0x9C, 0x83, 0x66, 0xE3, 0x9D, 0x9E, 
+0x82, 0xF0, 0xD8, 0x07, 0xED, 0x62, 0xD1, 0x5C, 
+0xC7, 0x61, 0xC2, 0x84, 0xF7, 0x66, 0xED, 0xA9, 
+0x5C, 0xC1, 0x42, 0x55, 0xA2, 0xFD, 0xDE, 0xC4, 
+0x6A, 0x33, 0x1D, 0x33, 0x81, 0xB6, 0xF4, 0x7E, 
+
+0x3D, 0x92, 0x51, 0xB9, 0xCD, 0x19, 0x34, 0x07, 
+0xBE, 0xE8, 0xA7, 0x1B, 0x8E, 0xDF, 0x96, 0x8A, 
+0x5C, 0x09, 0x46, 0x31, 0xB9, 0x0C, 0x13, 0x8F, 
+0x7A, 0x8D, 0x94, 0xCC, 0x48, 0x33, 0x06, 0x35, 
+0xA4, 0x15, 0x11, 0x25, 0x7E, 0x3F, 0x7C, 0x22, 
+0xA0, 0x41, 0x58, 0xF3, 0x75, 0x7C, 0xFC, 0x49, 
+0xFC, 0x5E, 0x0F, 0x3A, 0xD4, 0x8B, 0x5C, 0xD6, 
+0x92, 0x7B, 0x90, 0xA9, 0x35, 0x69, 0x58, 0x28, 
+0xE3, 0xBC, 0xF9, 0x01, 0x4B, 0x10, 0xFA, 0xAA, 
+0x7F, 0x4A, 0xFA, 0xFB, 0x99, 0x21, 0xE0, 0x1C, 
+0xDB, 0x90, 0xD0, 0x67, 0x21, 0xAD, 0x17, 0xBD, 
+0x02, 0x44, 0x9F, 0x64, 0x29, 0x46, 0x6C, 0x0E, 
+0xC5, 0xCB, 0xE1, 0x7F, 0x7B, 0x5A, 0x44, 0x44, 
+0x6A, 0x83, 0x84, 0x9F, 0xBB, 0x33, 0x0A, 0x38, 
+0x76, 0xD9, 0xB3, 0x5A, 0xEC, 0xD4, 0xFC, 0xE6, 
+0x45, 0x78, 0x0A, 0x79, 0x48, 0x52, 0x03, 0x3C, 
+0x68, 0xD5, 0x1E, 0x12, 0x44, 0x66, 0xE6, 0x88, 
+0x68, 0xED, 0x92, 0xC0, 0xD9, 0xB7, 0x7D, 0x26, 
+0x9B, 0x5B, 0xC8, 0xDA, 0xC7, 0xA3, 0xC0, 0xD0, 
+0x65, 0x0C, 0x79, 0xF5, 0x2C, 0x63, 0x56, 0xFB, 
+0xCB, 0x12, 0x15, 0xB1, 0x4C, 0x67, 0xB7, 0xD1, 
+0x7A, 
+
+0xAA, 0x9D, 0x55, 0x50, 0x00, 0x7C, 0x93, 0x0A, 
+0xBC, 0x0E, 0x8B, 0xB5, 0x4F, 0xD4, 0x10, 0x2C, 
+0xF7, 0x80, 0x65, 0xA7, 0x8F, 0x20, 0x9E, 0xA9, 
+0xDE, 0x00, 0x88, 0x13, 0xC0, 0x5F, 0x5B, 0x55, 
+0x53, 0xA4, 0x3A, 0x6A, 0x1C, 0x41, 0xF9, 0x66, 
+0x0C, 0x42, 0x0E, 0xF0, 0xFD, 0x9F, 0x02, 0x55, 
+0xDB, 0x7C, 0xE8, 0x68, 0x85, 0xE3, 0x1C, 0x89, 
+0x00, 0x3E, 0xE5, 0x4F, 0x78, 0xD2, 0xD9, 0xD8, 
+0x18, 0x0E, 0x85, 0x26, 0xB3, 0x1C, 0x58, 0x5F, 
+0x6F, 0xC0, 0xC4, 0x06, 0x07, 0x7B, 0xF1, 0x2D, 
+0x35, 0x2A, 0x77, 0xA4, 0x8D, 0xA7, 0xBD, 0xD4, 
+0x29, 0xF1, 0x5B, 0x95, 0xB9, 0x69, 0x83, 0x7B, 
+0x7F, 0xAC, 0x26, 0x72, 0x94, 0x2A, 0x2A, 0xE4, 
+0xA0, 0x04, 0xB4, 0xAC, 0xD0, 0x32, 0x44, 0x5B, 
+0x08, 0xDF, 0xD0, 0x1E, 0xFB, 0x86, 0x76, 0x6F, 
+0x16, 0x1E, 0xF4, 0x5C, 0x04, 0x02, 0x08, 0xDB, 
+0x0A, 0x8C, 0xBF, 0x83, 0xDA, 0x3A, 0x1E, 0xE7, 
+0xFD, 0x38, 0xDF, 0xD3, 0xF3, 0x66, 0x8A, 0x16, 
+0xFD, 0xEC, 0x2F, 0x6A, 0xDD, 0xF7, 0x87, 0x31, 
+0x9E, 0xE9, 0x32, 0x99, 0x0C, 0xA2, 0xBA, 0x56, 
+0x6C, 0xC5, 0x01, 0x33, 0xCE, 0xB7, 0x2F, 0xB4, 
+0xA8, 0xB2, 
+
+0x4A, 0x2C, 0xCE, 0x58, 0xEF, 0xA3, 0x9E, 0x5F, 
+0xB8, 0xC9, 0x97, 0xCB, 0x41, 0xC1, 0xD1, 0x52, 
+0xC6, 0x35, 0x15, 0x27, 0x23, 0xF5, 0x25, 0x21, 
+0x80, 0xE5, 0x4B, 0xB0, 0xC3, 0x31, 0xC2, 0x5E, 
+0x0E, 0x43, 0xEF, 0xCC, 0xD1, 0x4D, 0xE7, 0x52, 
+0x31, 0x47, 0x23, 0xF2, 0x2E, 0xC5, 0xF6, 0x56, 
+0x96, 0x92, 0x86, 0x32, 0x41, 0x03, 0xAF, 0x20, 
+0xCF, 0xC4, 0x96, 0x04, 0xB2, 0x58, 0x94, 0x38, 
+0x31, 0x03, 0xEC, 0xBA, 0x99, 0x6E, 0x2B, 0x5B, 
+0xB8, 0x33, 0xD4, 0x69, 0xA6, 0x0E, 0x03, 0x03, 
+0x5A, 0x8E, 0xE9, 0xCA, 0x3E, 0x52, 0xD6, 0x05, 
+0x14, 0x45, 0x89, 0x11, 0xA4, 0xFB, 0xCC, 0x8D, 
+0x4A, 0x16, 0xF4, 0x2C, 0x91, 0xE6, 0x96, 0x89, 
+0x4A, 0x3E, 0xDC, 0xCB, 0xE3, 0x3D, 0xDC, 0x17, 
+0x00, 0x62, 0xBA, 0x0D, 0xBF, 0x7F, 0xA5, 0x0C, 
+0xAB, 0x52, 0x23, 0xF0, 0xF4, 0xA3, 0x60, 0xA7, 
+0xFF, 0x04, 0x38, 0x12, 0xD2, 0x6B, 0x51, 0xB4, 
+0xF3, 0x1A, 0x11, 0x90, 0xB0, 0x26, 0xA9, 0x3F, 
+0xDB, 0x8E, 0x8F, 0xC4, 0x94, 0x0D, 0xAB, 0xAD, 
+0x40, 0x6A, 0xA8, 0xA1, 0xAF, 0xD3, 0xF2, 0x1E, 
+0xD2, 0x6C, 0xB9, 0xA4, 0x02, 0xD0, 0x0B, 0x0B, 
+0xBA, 0x6F, 0x71, 
+
+0xCE, 0xF8, 0x90, 0x63, 0xB2, 0xBE, 0x0B, 0x35, 
+0xB8, 0x74, 0xEA, 0xC6, 0xB4, 0xE3, 0x9E, 0x7F, 
+0xF3, 0x6F, 0x11, 0x10, 0xF0, 0x3C, 0xDD, 0x92, 
+0x5D, 0x10, 0xAE, 0x3D, 0xD4, 0xBE, 0x85, 0x50, 
+0x75, 0x6A, 0x14, 0x7E, 0x49, 0x88, 0x89, 0x84, 
+0xB9, 0x59, 0xE3, 0x1D, 0x55, 0xB6, 0x4D, 0xC8, 
+0x1B, 0xD7, 0xE0, 0x95, 0x09, 0xD9, 0x27, 0xBA, 
+0xE6, 0x10, 0xD4, 0x09, 0x39, 0xE6, 0xEC, 0x82, 
+0xEE, 0xB6, 0x5C, 0xB7, 0x72, 0x0E, 0x3A, 0xFC, 
+0xDA, 0x38, 0x94, 0xCA, 0x72, 0xF6, 0x79, 0xD7, 
+0xA3, 0xBB, 0x09, 0xCC, 0xB3, 0x3B, 0xAC, 0x75, 
+0x61, 0x84, 0xF4, 0xB8, 0xBD, 0xC2, 0xCA, 0x51, 
+0x22, 0x83, 0xDB, 0xB7, 0xEA, 0xA3, 0xE1, 0xF1, 
+0x6C, 0x32, 0xA2, 0xEF, 0x9A, 0x7C, 0x46, 0xAF, 
+0x75, 0x7F, 0xE0, 0x34, 0x00, 0x89, 0x53, 0xF3, 
+0xC5, 0xDB, 0xCE, 0x51, 0x34, 0xEB, 0x09, 0xD1, 
+0x83, 0xD8, 0x4F, 0xC5, 0xFC, 0x3C, 0x2D, 0x16, 
+0x72, 0xD5, 0x72, 0xE5, 0x58, 0xED, 0x56, 0x78, 
+0x1C, 0xE9, 0x17, 0xF0, 0xF8, 0x3F, 0x3A, 0x96, 
+0x43, 0xBC, 0xF6, 0xEC, 0xB9, 0x56, 0xFB, 0x80, 
+0x24, 0x46, 0x33, 0x03, 0x57, 0x3F, 0x95, 0x48, 
+0x3C, 0x0D, 0xFB, 0xC6, 
+
+0xE3, 0x73, 0x78, 0x2F, 0x3D, 0x21, 0xAB, 0xD2, 
+0x0A, 0x3C, 0xD9, 0x8C, 0xA9, 0x5E, 0x1A, 0xB0, 
+0x23, 0x6E, 0x26, 0x7F, 0xEA, 0x43, 0x17, 0x37, 
+0xF9, 0xF6, 0x65, 0x4E, 0x5E, 0x04, 0x41, 0x5E, 
+0x95, 0x76, 0xF7, 0x7F, 0x44, 0xBB, 0x05, 0x08, 
+0xCC, 0x7B, 0x08, 0x9B, 0x26, 0x56, 0xFC, 0x56, 
+0x66, 0x8A, 0x36, 0xBD, 0x67, 0x7C, 0x86, 0xD3, 
+0x4F, 0x68, 0xD5, 0xCB, 0xF7, 0x6C, 0x37, 0x41, 
+0xD8, 0x7F, 0x64, 0x11, 0x02, 0x31, 0xB6, 0xE6, 
+0xA2, 0xE3, 0xF7, 0x39, 0xA0, 0xB4, 0x05, 0x55, 
+0x18, 0x31, 0xAA, 0x5E, 0xC6, 0xF6, 0x72, 0xCB, 
+0xC1, 0x03, 0xB9, 0x93, 0x5A, 0x5E, 0x4A, 0xA6, 
+0xB2, 0x02, 0xCB, 0x02, 0x38, 0x2E, 0xB7, 0xD6, 
+0xF9, 0xED, 0x86, 0x4B, 0x67, 0x1A, 0x6A, 0x41, 
+0x23, 0x14, 0x12, 0x6C, 0x7C, 0x89, 0x5C, 0x76, 
+0xF5, 0x91, 0x26, 0xF4, 0x14, 0xF2, 0xEC, 0x13, 
+0x97, 0x6C, 0x4C, 0xF6, 0x80, 0x81, 0x69, 0x58, 
+0x1C, 0xD5, 0xDA, 0xC5, 0x14, 0xF9, 0x0E, 0x15, 
+0xE3, 0xEE, 0xDA, 0x54, 0x63, 0xD7, 0x19, 0xB0, 
+0x9A, 0x22, 0xBB, 0xDD, 0xE9, 0x4E, 0x1B, 0x5F, 
+0xED, 0xF5, 0xE9, 0x15, 0xAE, 0x48, 0x8A, 0x2B, 
+0xC6, 0x5C, 0x3F, 0x10, 0xCB, 
+
+0xAC, 0x71, 0x0B, 0xCA, 0x88, 0x6C, 0x67, 0x18, 
+0x6A, 0xBC, 0x72, 0x13, 0x10, 0xF0, 0x57, 0x97, 
+0xE9, 0x6F, 0x72, 0xAC, 0x6E, 0x28, 0x32, 0x66, 
+0x1E, 0xB0, 0xE8, 0x66, 0x10, 0xB0, 0x11, 0x27, 
+0x97, 0x91, 0xA1, 0xA5, 0xEA, 0x02, 0x8B, 0x6F, 
+0xDB, 0xE7, 0x01, 0xDA, 0x7D, 0xC2, 0x5D, 0xD7, 
+0x28, 0xDA, 0x7A, 0x1C, 0xF3, 0x89, 0x46, 0x9C, 
+0x7C, 0x21, 0x14, 0xB3, 0xED, 0x14, 0x9A, 0xD5, 
+0x37, 0xD3, 0x60, 0xB6, 0xBA, 0x2B, 0xC8, 0x10, 
+0x3E, 0x34, 0xDE, 0x69, 0x77, 0xC4, 0x21, 0xE5, 
+0x6F, 0x17, 0x9F, 0x54, 0x9F, 0xBC, 0x87, 0x57, 
+0x88, 0xB4, 0x79, 0xDF, 0xD7, 0xC7, 0x2A, 0xDA, 
+0xBE, 0xFC, 0xA3, 0xE9, 0xB6, 0xA5, 0x24, 0xB3, 
+0x7F, 0x81, 0xBA, 0x74, 0x4D, 0xEF, 0xC2, 0x5A, 
+0x21, 0x1C, 0xF2, 0x85, 0x69, 0x83, 0xA4, 0x10, 
+0x1F, 0xB7, 0x29, 0xCE, 0xE4, 0x9E, 0x97, 0xD3, 
+0xFB, 0x0C, 0xBD, 0xB3, 0xD3, 0xF6, 0x33, 0x26, 
+0xF9, 0x23, 0x0E, 0x51, 0x79, 0x01, 0x29, 0xCA, 
+0x15, 0x1A, 0xA4, 0xED, 0x9C, 0x61, 0x44, 0x72, 
+0xEA, 0xF1, 0xEF, 0xFF, 0xC9, 0x62, 0xEB, 0x7C, 
+0xCF, 0x8D, 0xA8, 0x32, 0xD9, 0xC1, 0xD9, 0xAE, 
+0x25, 0xF5, 0xA6, 0x76, 0x7D, 0xD9, 
+
+0x7B, 0x5C, 0x7D, 0x71, 0xD9, 0xBE, 0x09, 0x4E, 
+0xBA, 0xE9, 0xD2, 0x68, 0x4D, 0xDB, 0x19, 0x36, 
+0x09, 0x67, 0xF5, 0x7B, 0x10, 0x92, 0x71, 0x0D, 
+0xBC, 0x4D, 0x39, 0xC1, 0x79, 0x38, 0xD2, 0x06, 
+0x66, 0x2D, 0xD1, 0xF7, 0x5E, 0x11, 0x16, 0xF2, 
+0x76, 0x37, 0xE4, 0x12, 0x6F, 0x4D, 0xAF, 0xE5, 
+0xE6, 0xD7, 0x87, 0x6E, 0x20, 0xD7, 0xC6, 0x5B, 
+0x66, 0x8E, 0x5E, 0xD6, 0x32, 0x4E, 0x84, 0xBE, 
+0xEE, 0xE9, 0x56, 0xE0, 0xD1, 0xE9, 0x0A, 0xF7, 
+0xB6, 0xDE, 0xE7, 0x73, 0x9A, 0x73, 0xBF, 0xAB, 
+0x9F, 0x65, 0xF8, 0x73, 0x9C, 0xFA, 0xAA, 0x39, 
+0x5F, 0xE0, 0xFF, 0xAF, 0xE9, 0xF3, 0x91, 0x3A, 
+0xE0, 0xC0, 0x6D, 0x0A, 0x23, 0xE1, 0xC4, 0x59, 
+0x58, 0x32, 0x38, 0x16, 0x6B, 0x68, 0x16, 0xE1, 
+0x81, 0x6C, 0x83, 0x81, 0xA0, 0xFE, 0xE8, 0xDC, 
+0x40, 0xD8, 0xD0, 0xA3, 0xA0, 0xD5, 0xF0, 0x0C, 
+0x40, 0x50, 0x01, 0x88, 0x58, 0x3F, 0xB5, 0x6C, 
+0xC5, 0xE8, 0xD1, 0x14, 0x4E, 0x75, 0xB9, 0xB1, 
+0x84, 0xE7, 0x02, 0x03, 0x07, 0xEC, 0xEA, 0x1F, 
+0x77, 0xA6, 0x02, 0xF6, 0x25, 0xB1, 0x43, 0xA2, 
+0xC0, 0x9F, 0xA0, 0x5A, 0xDC, 0x94, 0xC7, 0x54, 
+0xFE, 0xD5, 0xA1, 0xAA, 0x92, 0xB2, 0xAB, 
+
+0xF7, 0xB7, 0xC2, 0x30, 0xDA, 0x43, 0x13, 0x63, 
+0xD1, 0xDD, 0xF9, 0xB3, 0x4F, 0x14, 0x37, 0x1E, 
+0x34, 0xE3, 0xB3, 0x5E, 0xC0, 0x4E, 0xED, 0xAF, 
+0x83, 0x03, 0x21, 0xC1, 0xF3, 0x26, 0x6A, 0xE3, 
+0xCB, 0x56, 0x5F, 0xC1, 0xAE, 0x58, 0x79, 0xB1, 
+0xDC, 0x23, 0x4C, 0x12, 0x1B, 0x23, 0x9F, 0x65, 
+0xCB, 0x77, 0x42, 0xFE, 0xF9, 0xE3, 0xC9, 0xAE, 
+0xE1, 0x6E, 0x85, 0x93, 0xF0, 0xA4, 0xDD, 0xC7, 
+0xD4, 0x26, 0xC0, 0x86, 0x6F, 0xF2, 0x9F, 0x16, 
+0x07, 0xC7, 0xDE, 0x9F, 0xD9, 0x3D, 0xBD, 0xE7, 
+0x5F, 0x35, 0x23, 0xC9, 0x54, 0xD1, 0xDC, 0x3B, 
+0x69, 0x15, 0x81, 0xCB, 0x3F, 0xA1, 0x47, 0x42, 
+0x77, 0xBF, 0xBD, 0xCA, 0x23, 0x6E, 0xE4, 0x8C, 
+0xB4, 0xA3, 0xEA, 0x4D, 0xFC, 0xDB, 0x65, 0x96, 
+0x59, 0x4E, 0xC8, 0xE2, 0x35, 0xD9, 0x25, 0xEF, 
+0x12, 0x7E, 0xBC, 0xD7, 0x74, 0xBB, 0x88, 0x28, 
+0x0E, 0xA4, 0xE9, 0xF0, 0x8F, 0xF8, 0xB2, 0x66, 
+0x29, 0xD5, 0xF6, 0x35, 0x06, 0xBE, 0x1C, 0xC6, 
+0x5D, 0xE4, 0x6F, 0x93, 0x22, 0x18, 0x09, 0x8A, 
+0x71, 0xFA, 0x45, 0x20, 0xAB, 0x33, 0xDC, 0x8C, 
+0x6D, 0xBA, 0x4E, 0x05, 0x19, 0xE9, 0xAC, 0xF8, 
+0xE4, 0x87, 0xA0, 0x14, 0x58, 0x53, 0x13, 0xD7, 
+
+0x2D, 0x46, 0x16, 0x72, 0xCE, 0xE7, 0x06, 0xB6, 
+0x29, 0x6D, 0xDD, 0x42, 0xB3, 0x74, 0x37, 0x00, 
+0x1A, 0x10, 0x3E, 0x6D, 0x02, 0xCA, 0x13, 0x81, 
+0x22, 0x65, 0xF5, 0xED, 0x73, 0x12, 0x1F, 0xBA, 
+0xE7, 0xCF, 0xFE, 0x35, 0x51, 0xF8, 0xC5, 0xBB, 
+0x1A, 0xA1, 0xC2, 0x0E, 0x1A, 0x9B, 0x4E, 0x68, 
+0x10, 0xA4, 0xC9, 0x3F, 0xC9, 0xB5, 0xF6, 0x91, 
+0xB0, 0xC3, 0x43, 0x86, 0x6E, 0x82, 0x19, 0x6E, 
+0xF3, 0xE9, 0x16, 0x6B, 0x39, 0xEE, 0xD5, 0x36, 
+0xB7, 0x32, 0x6C, 0x79, 0x70, 0x62, 0x88, 0xCD, 
+0xFD, 0xE2, 0x3C, 0xAB, 0xF5, 0x41, 0x8E, 0x82, 
+0x62, 0x1A, 0x12, 0x7D, 0xBD, 0x32, 0x6F, 0x87, 
+0x55, 0x64, 0xED, 0x07, 0xE5, 0x16, 0x57, 0x26, 
+0x4A, 0x10, 0xEC, 0x22, 0x4B, 0x4D, 0xB5, 0xCB, 
+0x1C, 0xD8, 0x39, 0x0F, 0xC0, 0xD8, 0xC6, 0x4C, 
+0x05, 0xA7, 0x1E, 0x7C, 0xA3, 0xCC, 0xC0, 0xA8, 
+0xBE, 0x7D, 0x7F, 0x31, 0x14, 0xF4, 0x90, 0x42, 
+0x8F, 0xC0, 0x66, 0x05, 0xEA, 0x3F, 0xA7, 0xAA, 
+0xD8, 0x72, 0x10, 0xFC, 0x0B, 0xE0, 0x8E, 0x8E, 
+0x1D, 0x3E, 0x1B, 0x6C, 0x0C, 0x76, 0x20, 0xBA, 
+0x72, 0x94, 0x51, 0x38, 0x54, 0xC0, 0xE5, 0xB7, 
+0x24, 0x47, 0x33, 0x87, 0x53, 0x9F, 0x78, 0xEE, 
+0x3F, 
+
+0x31, 0x9F, 0x76, 0xC4, 0x65, 0xC4, 0x24, 0x28, 
+0x20, 0x97, 0x79, 0x57, 0x9B, 0x2B, 0xBB, 0x14, 
+0x45, 0x62, 0x7C, 0xF3, 0x4B, 0x95, 0xD8, 0xEB, 
+0xEA, 0xDE, 0xC2, 0x50, 0x12, 0x5F, 0x99, 0x1A, 
+0xCB, 0xC3, 0xE6, 0x84, 0x0E, 0xF2, 0x23, 0xAC, 
+0x67, 0x08, 0x65, 0x17, 0xBA, 0xC0, 0x84, 0x8D, 
+0x4F, 0x6B, 0x0D, 0x06, 0x64, 0xC9, 0x41, 0x1D, 
+0xB4, 0x25, 0x55, 0xE3, 0xAD, 0x43, 0xAA, 0xF2, 
+0x75, 0x79, 0x80, 0xFE, 0x45, 0x3F, 0xCC, 0xD1, 
+0x87, 0x07, 0x65, 0x71, 0xA6, 0xAA, 0xE1, 0xBA, 
+0x4E, 0x7E, 0x0C, 0x4F, 0xD6, 0xF4, 0xD5, 0xA4, 
+0x0E, 0x13, 0x83, 0x8B, 0x97, 0xA2, 0x4E, 0x29, 
+0x24, 0x1E, 0xF4, 0xFC, 0xC0, 0xB3, 0x78, 0x77, 
+0x43, 0xC0, 0x01, 0xCC, 0xB3, 0x5E, 0xD9, 0x6E, 
+0x80, 0xE5, 0x44, 0x41, 0xC0, 0x67, 0x2D, 0x41, 
+0x8A, 0x2D, 0x4A, 0xAA, 0xAD, 0xEE, 0xB8, 0xEF, 
+0x60, 0xFD, 0x79, 0x3A, 0xB7, 0x1E, 0x0F, 0x09, 
+0x2B, 0x65, 0xC6, 0x9D, 0x38, 0x58, 0xE1, 0xB1, 
+0x4A, 0x5B, 0x62, 0x3D, 0x21, 0x2A, 0xE6, 0x01, 
+0x1F, 0x19, 0x5F, 0x99, 0xF3, 0xF8, 0xBA, 0x0C, 
+0xF8, 0x91, 0x79, 0xC0, 0xF2, 0x94, 0xE1, 0x52, 
+0x52, 0x5F, 0x1E, 0x0A, 0x0D, 0x52, 0x9B, 0x97, 
+0x24, 0x58, 
+
+0x05, 0x41, 0xC1, 0xD7, 0x20, 0x0C, 0x88, 0xE0, 
+0xED, 0xD0, 0x23, 0xB7, 0xA1, 0x49, 0xA2, 0xF5, 
+0x0F, 0x99, 0x8A, 0x6D, 0x76, 0xE6, 0xD1, 0x4C, 
+0xBB, 0x8B, 0x54, 0x0B, 0xA7, 0x0E, 0xB0, 0x69, 
+0x1A, 0x0E, 0xAC, 0x29, 0xBC, 0x51, 0xF2, 0x18, 
+0xCD, 0x6F, 0xA4, 0xBC, 0x68, 0xD8, 0x45, 0xD4, 
+0x93, 0xDD, 0x2B, 0x6E, 0x0E, 0xE8, 0x91, 0xCC, 
+0x48, 0x18, 0x20, 0x68, 0x40, 0x7A, 0xB0, 0x35, 
+0xD1, 0x24, 0xB5, 0xC4, 0xCE, 0x98, 0xBA, 0x56, 
+0xEF, 0x74, 0x01, 0xCD, 0xD8, 0x19, 0x6B, 0x66, 
+0x1C, 0xD6, 0x8F, 0xA7, 0x57, 0xA3, 0x52, 0xFC, 
+0x63, 0x75, 0xA2, 0x22, 0xAA, 0xE3, 0xCC, 0x53, 
+0x0E, 0x5D, 0x9B, 0x5B, 0xB1, 0xF5, 0x2C, 0xB1, 
+0xC6, 0xF2, 0x0F, 0xB6, 0x78, 0x84, 0xF9, 0x9C, 
+0x80, 0xE6, 0x32, 0x07, 0xBD, 0x7B, 0x3F, 0x89, 
+0x54, 0x85, 0xEE, 0x9B, 0x7C, 0x7C, 0x6B, 0x08, 
+0x8E, 0x1C, 0x3D, 0xED, 0x3C, 0xD8, 0x7E, 0x35, 
+0x91, 0x43, 0xEA, 0x16, 0xBD, 0x4D, 0x48, 0xDA, 
+0xFA, 0xC0, 0x04, 0xFF, 0x73, 0x3C, 0x8F, 0x5B, 
+0x23, 0x71, 0x0B, 0x45, 0xF7, 0x0D, 0xAF, 0xFB, 
+0x41, 0x5D, 0x0A, 0x19, 0xB8, 0xB4, 0xC9, 0x59, 
+0xFA, 0x79, 0x29, 0x86, 0xDA, 0x6D, 0xC1, 0xCF, 
+0xAB, 0x39, 0x1D, 
+
+0x91, 0x7E, 0x4D, 0xEE, 0x51, 0x77, 0x89, 0xBC, 
+0x23, 0x88, 0xE1, 0x70, 0x8B, 0x93, 0x77, 0xB3, 
+0x5E, 0xB7, 0x6F, 0x80, 0x12, 0xD8, 0x13, 0x49, 
+0x0A, 0x12, 0x09, 0x98, 0x9D, 0xB0, 0x69, 0x8C, 
+0xF5, 0x7E, 0xBF, 0x4D, 0x08, 0x07, 0xCE, 0x8C, 
+0xB8, 0x2B, 0xE7, 0xE8, 0xD3, 0xBA, 0xAB, 0xCC, 
+0x43, 0xE3, 0x84, 0x11, 0x51, 0xD9, 0x5C, 0xF6, 
+0xB6, 0x52, 0x54, 0x8C, 0xFB, 0x75, 0xBF, 0xEC, 
+0x73, 0x4A, 0x1A, 0xA9, 0x11, 0x14, 0x6E, 0x67, 
+0x03, 0xB4, 0x38, 0x22, 0x2D, 0xBC, 0xD1, 0x9A, 
+0x74, 0xC7, 0xA3, 0x8B, 0x11, 0xFD, 0xA5, 0xA9, 
+0x10, 0x3B, 0xE3, 0xB7, 0x9E, 0x12, 0xEB, 0x35, 
+0xC2, 0x0A, 0xA8, 0x04, 0x06, 0xE0, 0x86, 0x72, 
+0x49, 0x93, 0x9E, 0x42, 0xDC, 0xD8, 0xAA, 0x58, 
+0x79, 0xB3, 0x83, 0xAF, 0x3C, 0x78, 0xAC, 0x0C, 
+0x00, 0x30, 0x16, 0x6B, 0xF3, 0x0E, 0x5A, 0x9B, 
+0xCE, 0x33, 0x6D, 0x24, 0x57, 0xA5, 0xAA, 0x2E, 
+0x31, 0xBB, 0xF3, 0x52, 0x95, 0xF7, 0xCF, 0x1B, 
+0x05, 0x4B, 0x62, 0xB1, 0x9E, 0xC6, 0x99, 0x80, 
+0xE2, 0x4D, 0xB2, 0xA2, 0x83, 0xD6, 0xBC, 0x2D, 
+0xC3, 0xE1, 0x16, 0x2C, 0xBC, 0xF0, 0xA3, 0x71, 
+0x97, 0xD2, 0x17, 0xB5, 0x55, 0xA3, 0xB2, 0x5B, 
+0xD8, 0x4A, 0x2D, 0x40, 
+
+0xF3, 0x57, 0x24, 0x67, 0x70, 0x6F, 0xD9, 0xC6, 
+0x96, 0x25, 0xD4, 0x19, 0x3B, 0x6B, 0xA5, 0x87, 
+0x93, 0x44, 0x4E, 0x8B, 0xE2, 0x1A, 0x6E, 0xE1, 
+0xFB, 0x21, 0xC6, 0x65, 0x48, 0xF8, 0x64, 0xB4, 
+0x61, 0x4C, 0x1B, 0x13, 0x66, 0xA3, 0xB1, 0x46, 
+0x53, 0x03, 0x1E, 0xD4, 0xA8, 0xE2, 0xAF, 0x22, 
+0x3A, 0x90, 0xB3, 0x04, 0xF5, 0x44, 0x50, 0x6B, 
+0x95, 0x04, 0xDD, 0x83, 0x80, 0x2C, 0x8C, 0x18, 
+0x53, 0xF9, 0x7D, 0x34, 0xA0, 0x5D, 0x95, 0x3B, 
+0xA2, 0x9F, 0xED, 0x77, 0x14, 0x4D, 0x29, 0xF1, 
+0xBF, 0x25, 0x9D, 0xD0, 0x6A, 0x66, 0xB5, 0x25, 
+0x3D, 0xE3, 0x98, 0x08, 0x9E, 0x0B, 0xAD, 0x79, 
+0x29, 0xF1, 0xFE, 0x8F, 0x75, 0x8F, 0xC9, 0x04, 
+0x61, 0xB0, 0x68, 0x29, 0xDA, 0x15, 0x08, 0xC9, 
+0xEB, 0x82, 0x11, 0xDB, 0x61, 0xCF, 0x80, 0xDA, 
+0x5E, 0x5D, 0x68, 0x0A, 0x9F, 0xCE, 0xAB, 0x28, 
+0x4B, 0x73, 0xC9, 0xD9, 0x9A, 0x9E, 0xB0, 0x91, 
+0x01, 0x33, 0x59, 0x03, 0x4A, 0x63, 0xB2, 0xF2, 
+0x46, 0x37, 0xE2, 0x3D, 0xB7, 0x1C, 0xC2, 0xDB, 
+0x0F, 0x4A, 0xE2, 0xCC, 0x36, 0xCA, 0x9B, 0xF2, 
+0x72, 0x50, 0x23, 0xFC, 0x3F, 0x83, 0x0B, 0xE4, 
+0xB1, 0xFB, 0xBD, 0xFE, 0xBB, 0x3C, 0x72, 0x2E, 
+0xDB, 0xEC, 0xEF, 0x28, 0x47, 
+
+0xC2, 0xF8, 0x78, 0xE3, 0xB7, 0xF0, 0xC4, 0xE6, 
+0xB4, 0x58, 0x79, 0xBD, 0x57, 0x54, 0xD9, 0x11, 
+0x23, 0xC3, 0xC0, 0x6A, 0x72, 0x1D, 0xD6, 0x8B, 
+0x46, 0xA5, 0xBB, 0x9D, 0x42, 0x99, 0xC0, 0xC0, 
+0xAF, 0x19, 0x7C, 0x5F, 0x69, 0x70, 0x0C, 0xFC, 
+0xA1, 0x0B, 0xE2, 0x5C, 0x74, 0xF5, 0xB3, 0xD9, 
+0x22, 0x59, 0x6C, 0x38, 0x49, 0xBF, 0x79, 0x64, 
+0xA4, 0x19, 0x17, 0x06, 0x5D, 0x4C, 0xA4, 0x6C, 
+0xE3, 0x9D, 0x16, 0x96, 0x66, 0xAB, 0x32, 0xAA, 
+0x9C, 0xC8, 0xA6, 0x26, 0x69, 0xF5, 0x3A, 0x3A, 
+0x21, 0xF5, 0x5B, 0xEC, 0xA0, 0x5C, 0x71, 0xC2, 
+0x94, 0xDF, 0x13, 0xFE, 0xB8, 0xE9, 0x9E, 0xA1, 
+0xF3, 0xE4, 0x76, 0xE5, 0x99, 0xBE, 0xBA, 0x27, 
+0x1A, 0x05, 0x58, 0x24, 0xBD, 0xD1, 0xEF, 0xD4, 
+0xD9, 0x0B, 0x2E, 0xCE, 0xB6, 0xF4, 0xA0, 0x55, 
+0x28, 0xC0, 0x8D, 0x2F, 0xF4, 0x6C, 0x74, 0x06, 
+0xEA, 0xF9, 0x79, 0xF4, 0x96, 0x69, 0xC4, 0x57, 
+0x3F, 0x76, 0xDF, 0x9B, 0xB7, 0x02, 0x6E, 0x41, 
+0x5C, 0xD6, 0x2B, 0x9D, 0x7F, 0xF1, 0x26, 0x24, 
+0x09, 0x9B, 0x97, 0x59, 0x57, 0x07, 0xB5, 0xF8, 
+0x07, 0x6E, 0x77, 0xB2, 0x22, 0xEE, 0x1E, 0x87, 
+0xA7, 0x52, 0x84, 0x92, 0x39, 0x1B, 0x6E, 0xED, 
+0xE8, 0x7B, 0xF2, 0xBD, 0xC5, 0x70, 
+
+0x2E, 0xB2, 0xD1, 0x39, 0xAD, 0x09, 0x33, 0xEB, 
+0x31, 0xC4, 0x64, 0x67, 0x84, 0x04, 0x18, 0xE1, 
+0x63, 0x7B, 0xD9, 0xC8, 0xF2, 0x1E, 0xDA, 0x0E, 
+0xB2, 0x2A, 0x17, 0x8D, 0xD1, 0x53, 0xD1, 0x50, 
+0xFD, 0xE0, 0xBB, 0xEB, 0xC2, 0x4D, 0x7C, 0xDC, 
+0x79, 0x51, 0x61, 0xF6, 0x0E, 0x8C, 0x84, 0xB7, 
+0x3C, 0x38, 0x70, 0x0C, 0x4D, 0x72, 0xD3, 0x9C, 
+0x87, 0xFE, 0x7F, 0x53, 0x48, 0x7B, 0xBE, 0x1C, 
+0x9D, 0xD3, 0x81, 0x38, 0x76, 0xD4, 0x6E, 0xE9, 
+0xB1, 0xE5, 0x69, 0x5E, 0x84, 0x2C, 0x79, 0x94, 
+0x02, 0xB4, 0x9F, 0xF7, 0x44, 0x63, 0x9A, 0xF0, 
+0x76, 0xB4, 0x6F, 0xAA, 0x13, 0xC4, 0x6E, 0x45, 
+0x1A, 0xB7, 0xB0, 0xB1, 0x88, 0xF6, 0x92, 0xD7, 
+0x09, 0x4B, 0xC5, 0x13, 0xD2, 0xCE, 0x9B, 0xB7, 
+0x7B, 0x95, 0x6C, 0x50, 0x70, 0x40, 0x27, 0x35, 
+0x07, 0x0C, 0x3E, 0xC8, 0xB1, 0xBB, 0x87, 0xCF, 
+0xF5, 0xDF, 0x0F, 0xE9, 0xA4, 0x57, 0x35, 0x43, 
+0xCE, 0x81, 0xB6, 0xBD, 0x7B, 0x87, 0x4A, 0x7C, 
+0x5C, 0x38, 0x02, 0x00, 0x64, 0xB7, 0xE2, 0x42, 
+0xA0, 0x35, 0x56, 0xAB, 0xCD, 0xDF, 0x8F, 0x99, 
+0xFA, 0x6A, 0xC3, 0x68, 0xBF, 0x91, 0x69, 0x18, 
+0xB0, 0x57, 0xD6, 0x94, 0x58, 0xB8, 0x08, 0x1A, 
+0x07, 0x83, 0x6F, 0x87, 0xD6, 0x3C, 0x69, 
+
+0x57, 0x5C, 0x66, 0xA0, 0x81, 0x42, 0x17, 0xFD, 
+0x4A, 0x3B, 0x50, 0x1C, 0xB6, 0x7D, 0x08, 0xAA, 
+0xE6, 0x5F, 0x93, 0x12, 0x63, 0xB0, 0x6E, 0xD5, 
+0xBC, 0x76, 0x70, 0xA9, 0xE4, 0x29, 0x60, 0x97, 
+0x2A, 0x3C, 0x7F, 0xF5, 0xC1, 0xCF, 0x24, 0xAD, 
+0x6C, 0x2E, 0xF7, 0x7C, 0x78, 0x77, 0xF1, 0x94, 
+0x90, 0x8E, 0x79, 0x3C, 0x87, 0xFE, 0xCD, 0x80, 
+0xAC, 0xF8, 0x8E, 0x1C, 0x2D, 0xDB, 0x22, 0x43, 
+0xC6, 0xF5, 0x24, 0x16, 0x6D, 0xE8, 0x34, 0x20, 
+0x9C, 0x51, 0x29, 0xCB, 0x5D, 0x7A, 0x52, 0x3A, 
+0xB0, 0x7C, 0xDF, 0x38, 0x58, 0x32, 0x74, 0x3A, 
+0x07, 0xAF, 0x94, 0xB4, 0xB2, 0x64, 0x39, 0xB1, 
+0xDC, 0x69, 0xC9, 0xB8, 0xFE, 0xC9, 0xA4, 0x93, 
+0x60, 0x42, 0xA2, 0xE4, 0xED, 0xB3, 0x14, 0xB4, 
+0x04, 0x64, 0x06, 0x9D, 0xE6, 0x01, 0x29, 0xE8, 
+0xDC, 0x3B, 0x8D, 0xA1, 0x18, 0x04, 0x30, 0x9E, 
+0x16, 0xE8, 0x5B, 0x3C, 0xE1, 0x80, 0x76, 0x35, 
+0x48, 0x1C, 0x65, 0xF8, 0x38, 0xF4, 0x31, 0x0A, 
+0xD9, 0x43, 0x1F, 0xAA, 0x7E, 0x71, 0x9A, 0x88, 
+0x1E, 0x89, 0x79, 0xA0, 0x63, 0x2D, 0x9C, 0x93, 
+0x06, 0x20, 0xF8, 0x89, 0x3C, 0xB1, 0x23, 0xDD, 
+0x9B, 0x12, 0x0C, 0x37, 0x0D, 0x6C, 0x2D, 0x1F, 
+0x95, 0x42, 0x42, 0x9A, 0x14, 0x9E, 0x3E, 0x75, 
+
+0x43, 0x08, 0xD6, 0x23, 0xB3, 0x21, 0x5B, 0x22, 
+0xE5, 0xCD, 0xEA, 0xC5, 0x56, 0x06, 0x67, 0x17, 
+0x16, 0xF4, 0xCD, 0xBB, 0x75, 0x14, 0x4F, 0x61, 
+0x44, 0xA7, 0xBF, 0xCF, 0x93, 0xCD, 0xD3, 0x77, 
+0x73, 0x73, 0x2E, 0x85, 0xD0, 0xF1, 0x53, 0x62, 
+0xC4, 0x28, 0xDC, 0x3C, 0x6C, 0x2F, 0x16, 0xF6, 
+0x4E, 0x38, 0xBD, 0x6A, 0x55, 0x05, 0x17, 0x16, 
+0xF2, 0xC1, 0x69, 0x6C, 0x1E, 0x13, 0x27, 0x45, 
+0x99, 0x08, 0xEA, 0x59, 0xE9, 0xDC, 0x92, 0x5B, 
+0xCE, 0xAA, 0x3E, 0xD4, 0xE0, 0x41, 0x17, 0x22, 
+0x61, 0xA5, 0x9D, 0xE2, 0x65, 0x01, 0x9E, 0x59, 
+0xF9, 0xC6, 0x98, 0x85, 0x1C, 0xC3, 0x91, 0x4C, 
+0x62, 0x27, 0x64, 0x33, 0x6C, 0xE8, 0xB5, 0xD9, 
+0xFC, 0xCA, 0x44, 0x42, 0x84, 0x3D, 0xD1, 0x80, 
+0xF2, 0x47, 0xC1, 0x1D, 0x02, 0xFC, 0x90, 0x92, 
+0xA8, 0x85, 0x78, 0xAB, 0x96, 0xB0, 0xC9, 0x73, 
+0xF7, 0xE7, 0x60, 0xC5, 0xA2, 0xE2, 0xBE, 0x13, 
+0x11, 0xA4, 0x7B, 0xEF, 0x0F, 0xA1, 0x2B, 0xFE, 
+0x61, 0xB2, 0xF2, 0xA2, 0x86, 0xD1, 0x56, 0xAD, 
+0x90, 0x59, 0x97, 0xFE, 0x69, 0x58, 0x9D, 0xDB, 
+0x69, 0x58, 0x9B, 0x73, 0xF2, 0x72, 0xB7, 0x0B, 
+0x2B, 0x46, 0xED, 0x6C, 0xE0, 0x76, 0xF2, 0x82, 
+0x08, 0x18, 0xDF, 0x7C, 0xA7, 0x5B, 0x48, 0x69, 
+0x5A, 
+
+0xEB, 0xE3, 0x6B, 0x58, 0x15, 0x87, 0x37, 0x16, 
+0xAA, 0x82, 0x62, 0x4A, 0xAD, 0xC3, 0xAA, 0x21, 
+0x9E, 0x37, 0x96, 0x46, 0x21, 0xF1, 0x1F, 0xC5, 
+0x35, 0xFC, 0xDB, 0x65, 0xD4, 0x5C, 0x7A, 0x28, 
+0x38, 0x05, 0x2F, 0xB8, 0x06, 0xED, 0xB9, 0x57, 
+0x14, 0x02, 0x3B, 0x13, 0x86, 0x58, 0x0C, 0x95, 
+0x50, 0x4B, 0xCF, 0xB4, 0x25, 0x4D, 0xCB, 0xD1, 
+0xB6, 0xDD, 0x1F, 0xF3, 0x9C, 0xDC, 0xB0, 0x1A, 
+0x89, 0x4A, 0x9E, 0xF8, 0xC0, 0xAE, 0xFE, 0x54, 
+0x0A, 0x42, 0xD5, 0x4A, 0x34, 0xB4, 0x85, 0x31, 
+0x9B, 0x85, 0x88, 0xB4, 0x6C, 0x65, 0x8B, 0xF7, 
+0x8F, 0x4B, 0xBA, 0x96, 0x68, 0x02, 0xC0, 0x2F, 
+0x5D, 0xDA, 0x27, 0x7B, 0xE8, 0xD5, 0xBC, 0x48, 
+0xA3, 0xD4, 0x52, 0x62, 0x74, 0x25, 0x75, 0x16, 
+0x12, 0x76, 0x97, 0xCE, 0x7E, 0xC7, 0xF2, 0x70, 
+0xD8, 0x7D, 0xB5, 0x0F, 0xFE, 0xAC, 0xC9, 0xC0, 
+0xF9, 0xAA, 0x6B, 0xDA, 0x85, 0xA8, 0x90, 0xD7, 
+0xC0, 0x1C, 0x30, 0x45, 0xC4, 0x39, 0xE8, 0x04, 
+0xEA, 0xCC, 0x67, 0x2A, 0x7D, 0xB0, 0x82, 0x57, 
+0x4D, 0xC2, 0xC1, 0x87, 0x56, 0xCF, 0xE4, 0xDA, 
+0xAD, 0x3E, 0xCF, 0x1A, 0x9C, 0x6F, 0xD2, 0x66, 
+0x4B, 0x72, 0x48, 0xDA, 0x1D, 0x38, 0x9D, 0x5D, 
+0xAF, 0x46, 0x51, 0xFF, 0x37, 0x34, 0x83, 0xB3, 
+0x00, 0xF4, 
+
+0x2F, 0x73, 0xBD, 0x5B, 0xAF, 0xB5, 0xA9, 0x12, 
+0xED, 0x26, 0x88, 0x1D, 0x36, 0xBF, 0x46, 0x36, 
+0xF2, 0xD0, 0x81, 0x32, 0x00, 0x26, 0x4E, 0x84, 
+0x0D, 0xEE, 0x2E, 0xCB, 0x18, 0xBC, 0xC3, 0x8A, 
+0x9A, 0x66, 0x29, 0x14, 0xDE, 0xC2, 0xF2, 0x96, 
+0xCD, 0x7E, 0xF5, 0x06, 0xC5, 0x53, 0x2B, 0x51, 
+0xC3, 0x6B, 0x7E, 0xD4, 0x2F, 0x66, 0xAD, 0x97, 
+0xE7, 0x88, 0x5B, 0xD0, 0x84, 0x96, 0xCD, 0x9B, 
+0x5A, 0xDC, 0x41, 0xEA, 0xEB, 0xF8, 0xE7, 0xB8, 
+0x9F, 0x20, 0x6F, 0x97, 0x1E, 0x2F, 0xAD, 0x71, 
+0xDD, 0xCB, 0xA6, 0xCD, 0xFC, 0xDB, 0xEF, 0xFC, 
+0xA2, 0x9C, 0x10, 0xE2, 0x1A, 0x53, 0x35, 0x26, 
+0x73, 0xC7, 0xE8, 0xC8, 0xC6, 0xB8, 0x71, 0xD9, 
+0x31, 0x11, 0x09, 0x9A, 0x15, 0x81, 0xF2, 0xE5, 
+0x0F, 0x1A, 0x37, 0xE9, 0x42, 0x38, 0x05, 0x04, 
+0xF0, 0xE2, 0x0D, 0x52, 0x1D, 0xC7, 0x20, 0x04, 
+0x28, 0x14, 0xC4, 0x53, 0xBD, 0xD5, 0x5C, 0x8C, 
+0x2C, 0x1A, 0x02, 0xDC, 0x79, 0x48, 0x62, 0x39, 
+0x57, 0x16, 0x8E, 0xE1, 0x06, 0x9D, 0x13, 0x60, 
+0x69, 0x74, 0x4A, 0x92, 0x92, 0x70, 0x0B, 0xF2, 
+0x35, 0xA2, 0xBC, 0x28, 0xC1, 0x96, 0x08, 0x06, 
+0xF0, 0x86, 0xC0, 0x25, 0x4C, 0x36, 0x5B, 0xAC, 
+0x05, 0x0E, 0xBC, 0xD3, 0xE7, 0x1D, 0xC1, 0xD3, 
+0x9E, 0x5C, 0x83, 
+
+0xD5, 0x64, 0xC9, 0x89, 0xD1, 0x4E, 0xEB, 0x9A, 
+0x49, 0xE4, 0xC0, 0x30, 0x76, 0xE0, 0xFB, 0x22, 
+0xE3, 0x45, 0x7C, 0x4A, 0x3B, 0xBE, 0xAA, 0x5E, 
+0x94, 0x2D, 0xF8, 0x04, 0x15, 0x7A, 0x46, 0xB6, 
+0xF0, 0xD7, 0x0C, 0xBC, 0xDB, 0xD5, 0x6B, 0x1E, 
+0x4E, 0x63, 0xBA, 0x2B, 0xCC, 0x7C, 0x52, 0x52, 
+0xB3, 0xE8, 0xF5, 0x02, 0x22, 0xAC, 0x5D, 0xAC, 
+0xDF, 0x79, 0x46, 0xB9, 0xCD, 0xE4, 0xAD, 0x3B, 
+0x73, 0xB9, 0xB3, 0x40, 0x7B, 0x8B, 0x47, 0xAE, 
+0x9C, 0x53, 0xDA, 0xC4, 0x20, 0x5A, 0xDA, 0x33, 
+0x26, 0x8C, 0x06, 0xA2, 0xA4, 0xE9, 0xDD, 0xF7, 
+0x02, 0xD6, 0x1D, 0xB7, 0xA7, 0x75, 0x8D, 0xD0, 
+0xDE, 0x63, 0xB4, 0xAE, 0xCA, 0x52, 0x20, 0x99, 
+0x12, 0xE8, 0x5E, 0x72, 0xDB, 0x37, 0xB0, 0x5E, 
+0xBE, 0x43, 0xFA, 0x05, 0x85, 0xD0, 0x6B, 0xCF, 
+0xF7, 0x68, 0x87, 0x09, 0xB1, 0xCA, 0x83, 0x63, 
+0x93, 0x1E, 0x1A, 0x25, 0xDE, 0xEC, 0xFC, 0x70, 
+0xDF, 0xE8, 0xD0, 0xB6, 0x3D, 0x3A, 0xCB, 0xB8, 
+0xBA, 0x12, 0x21, 0x9E, 0x81, 0x83, 0x82, 0xFC, 
+0xBF, 0x9E, 0x6E, 0xED, 0x67, 0x06, 0x09, 0xBF, 
+0x2E, 0x03, 0xDC, 0xF8, 0x8A, 0x7F, 0x7F, 0x21, 
+0x6F, 0x7D, 0xB4, 0xB2, 0x09, 0xC4, 0x25, 0x62, 
+0x3C, 0x4D, 0x3D, 0xED, 0x0E, 0x19, 0x4A, 0x49, 
+0x61, 0x94, 0x90, 0x0E, 
+
+0x9C, 0xB4, 0x01, 0xAD, 0x9D, 0x73, 0x58, 0x5B, 
+0x2D, 0x39, 0x23, 0x5B, 0xA7, 0xA8, 0x04, 0x86, 
+0x69, 0xB7, 0xEF, 0x42, 0xDA, 0x34, 0xAA, 0xF5, 
+0x1F, 0x2E, 0xBA, 0x6E, 0x8A, 0x78, 0xDA, 0x1B, 
+0x8E, 0xB5, 0x68, 0x2D, 0x09, 0x17, 0x91, 0x33, 
+0xCF, 0x49, 0x62, 0x59, 0xB9, 0xD2, 0xE1, 0x49, 
+0xFB, 0x03, 0x76, 0xD1, 0x51, 0x6F, 0x8A, 0xD7, 
+0x4D, 0x13, 0x35, 0x92, 0x59, 0x69, 0xA6, 0xA1, 
+0x84, 0x46, 0x73, 0xE8, 0x04, 0xFC, 0xAA, 0xCD, 
+0x9D, 0x62, 0xE3, 0x51, 0x8D, 0x95, 0xD2, 0x30, 
+0x62, 0x23, 0xCF, 0xB9, 0xE4, 0x24, 0xFF, 0x83, 
+0x97, 0x3D, 0x82, 0x88, 0xBD, 0x38, 0x03, 0xF1, 
+0x54, 0xF0, 0xFB, 0x9D, 0xCC, 0x09, 0xA9, 0xEB, 
+0xD4, 0x19, 0x2F, 0x7F, 0x26, 0x8C, 0x48, 0x04, 
+0x06, 0x9C, 0xDD, 0xE0, 0xE8, 0x2B, 0xD6, 0x76, 
+0xDE, 0x1E, 0xAE, 0xC7, 0x67, 0xC5, 0xD5, 0x22, 
+0x80, 0x4A, 0x64, 0x72, 0xC4, 0x65, 0x94, 0xB1, 
+0x09, 0x10, 0x95, 0xB6, 0x44, 0xF3, 0x5C, 0x5E, 
+0x43, 0x31, 0xC8, 0x64, 0x9D, 0x64, 0xDE, 0x33, 
+0x68, 0xD3, 0xC8, 0x94, 0x62, 0xC3, 0xCC, 0x58, 
+0xC3, 0x6B, 0xED, 0x61, 0xE6, 0xC8, 0xE2, 0x69, 
+0x61, 0xE6, 0xEB, 0x04, 0x78, 0x1E, 0x4C, 0x87, 
+0xE5, 0x1D, 0x6F, 0xDE, 0xEE, 0xEE, 0xC2, 0xCB, 
+0x3B, 0x62, 0x35, 0x1B, 0xE7, 
+
+0x86, 0xA4, 0xFE, 0x7D, 0xD5, 0x96, 0x21, 0xD4, 
+0xB7, 0x0A, 0x17, 0x7A, 0x0E, 0x4B, 0x8C, 0x8D, 
+0xDF, 0xF8, 0x51, 0xF6, 0xF5, 0x4A, 0x25, 0x4B, 
+0xA5, 0xAE, 0xEB, 0xF8, 0x18, 0x9E, 0xDA, 0xAF, 
+0x66, 0x33, 0xF6, 0xDE, 0x20, 0xFF, 0x55, 0x06, 
+0xAA, 0xFF, 0xC1, 0x6C, 0x4F, 0xE8, 0xA9, 0x12, 
+0xB5, 0x4D, 0x90, 0xE3, 0xA7, 0x86, 0xD8, 0xB6, 
+0x67, 0x60, 0x31, 0x99, 0x60, 0x7E, 0x8A, 0x7E, 
+0x6F, 0xC5, 0xAD, 0x84, 0x8B, 0x20, 0x95, 0xDD, 
+0xC8, 0x88, 0xF8, 0xAD, 0x31, 0xE5, 0xEC, 0xA3, 
+0x6C, 0x15, 0x5E, 0x8E, 0x3D, 0xBB, 0xF1, 0xAC, 
+0xA4, 0xFA, 0x1D, 0x80, 0x48, 0xB0, 0xF0, 0x7B, 
+0x1D, 0x8B, 0xFB, 0x92, 0x8A, 0x2F, 0x60, 0x82, 
+0x38, 0x18, 0xAF, 0x96, 0x22, 0xEC, 0x83, 0x1C, 
+0x38, 0xBA, 0x63, 0x38, 0x55, 0x49, 0x60, 0xB0, 
+0x63, 0xD5, 0xF1, 0xF1, 0xD3, 0x1D, 0x79, 0x44, 
+0xE2, 0x8A, 0x6E, 0xD5, 0x51, 0x53, 0x91, 0xBD, 
+0x37, 0x49, 0xBB, 0x29, 0x89, 0x08, 0x7D, 0xE2, 
+0xB5, 0x92, 0xB1, 0x27, 0xA4, 0xC3, 0x14, 0xB6, 
+0x44, 0x51, 0x04, 0xEA, 0xC9, 0x0D, 0x20, 0x1B, 
+0xE1, 0x22, 0xC0, 0x0E, 0x1B, 0xC8, 0xB3, 0x73, 
+0x55, 0x67, 0xFD, 0x35, 0x1C, 0x72, 0x09, 0xC5, 
+0x02, 0xDE, 0x65, 0x5F, 0x0C, 0x00, 0x84, 0x77, 
+0x75, 0x8F, 0x91, 0x78, 0xD8, 0x39, 
+
+0x3A, 0x74, 0x7E, 0x23, 0xA1, 0xA4, 0x34, 0x59, 
+0x2A, 0x12, 0x86, 0x3C, 0x0B, 0xDC, 0x51, 0x4B, 
+0x2F, 0x6D, 0x11, 0x2A, 0x14, 0x74, 0x59, 0x98, 
+0xA4, 0x9B, 0xB2, 0x73, 0x49, 0x37, 0x6A, 0x6E, 
+0xA8, 0xD4, 0x91, 0x46, 0x60, 0xF7, 0x8B, 0x3F, 
+0x26, 0x4E, 0x76, 0x56, 0xFA, 0x58, 0xF5, 0xBB, 
+0xF1, 0xE4, 0x48, 0x9B, 0x45, 0xE2, 0x7E, 0x90, 
+0x80, 0x52, 0xC0, 0xA0, 0x2F, 0x62, 0x30, 0xD6, 
+0xA6, 0x6B, 0xBA, 0xCF, 0x0E, 0x92, 0x34, 0x32, 
+0x2A, 0xCE, 0x09, 0xB2, 0x1D, 0x30, 0x6F, 0xF9, 
+0x3D, 0x32, 0xB8, 0x17, 0xF6, 0x13, 0x56, 0x45, 
+0x9E, 0x35, 0x7D, 0xC1, 0x2B, 0x1A, 0x04, 0x91, 
+0x0A, 0x9E, 0xA3, 0x92, 0x48, 0x6E, 0xB6, 0xF7, 
+0x86, 0x95, 0x61, 0x9D, 0x03, 0x8C, 0xB8, 0x84, 
+0x20, 0xE1, 0x29, 0xD3, 0x95, 0xF2, 0x1F, 0x9C, 
+0xA0, 0x3F, 0x3D, 0xAA, 0xF6, 0xD4, 0x73, 0x60, 
+0xE4, 0xD9, 0x71, 0x75, 0x72, 0x6D, 0x63, 0xD0, 
+0x03, 0x66, 0xCD, 0x47, 0xF8, 0x14, 0xC7, 0x4E, 
+0x4E, 0x92, 0x90, 0x6D, 0x36, 0x22, 0x95, 0x25, 
+0x0B, 0xF6, 0x63, 0x59, 0xB9, 0x77, 0x8C, 0xE3, 
+0xF6, 0xA0, 0x7A, 0x5C, 0xA4, 0x0E, 0x38, 0x29, 
+0x75, 0x40, 0x89, 0x86, 0xE7, 0x7E, 0x61, 0x36, 
+0x69, 0x84, 0x91, 0x5C, 0x56, 0x8E, 0xC9, 0x3C, 
+0x81, 0x14, 0x45, 0x76, 0xB2, 0x7A, 0xCD, 
+
+0xC7, 0x6A, 0xB3, 0x26, 0x27, 0xF8, 0xE3, 0xA5, 
+0x15, 0x79, 0xFF, 0x9C, 0x4E, 0xAB, 0xA7, 0x72, 
+0x7A, 0xF6, 0x4E, 0xD1, 0x27, 0x96, 0xD1, 0x14, 
+0x2D, 0x4E, 0xFD, 0xDF, 0x20, 0xC5, 0x4C, 0x5F, 
+0x0F, 0xC0, 0xE3, 0x2C, 0xDC, 0xA5, 0x28, 0x3E, 
+0x02, 0xB0, 0xE5, 0x47, 0xCB, 0x70, 0x45, 0x6F, 
+0x87, 0x19, 0x5C, 0x83, 0x6A, 0x2A, 0x26, 0xF0, 
+0x03, 0x1A, 0x34, 0xFC, 0xA2, 0xD0, 0xB6, 0x9D, 
+0xCC, 0xC2, 0xB6, 0x24, 0xC2, 0x37, 0xCD, 0xEF, 
+0x73, 0x4D, 0x60, 0x1E, 0x95, 0xEE, 0x51, 0xDE, 
+0x06, 0x65, 0x77, 0x8C, 0x86, 0xDA, 0xF1, 0xA5, 
+0x97, 0x07, 0xC1, 0xBC, 0x4D, 0xC0, 0x29, 0x6E, 
+0xE8, 0x5C, 0x86, 0xA6, 0x78, 0x8F, 0x96, 0x68, 
+0x1F, 0x62, 0x68, 0xC5, 0x1E, 0x16, 0x38, 0xDE, 
+0x25, 0x32, 0x35, 0xAC, 0x39, 0xC1, 0xCB, 0x91, 
+0xC5, 0x17, 0x4D, 0x9E, 0x3D, 0x2D, 0x58, 0x5A, 
+0x33, 0x7A, 0x6A, 0x64, 0x81, 0x02, 0x08, 0x11, 
+0x22, 0x7A, 0x3E, 0xD2, 0x44, 0xCA, 0x04, 0x55, 
+0xC6, 0x3A, 0x1C, 0x0B, 0x72, 0x06, 0x89, 0x9F, 
+0xC6, 0x5F, 0x95, 0xEB, 0x94, 0xFA, 0x3D, 0x79, 
+0xAB, 0x62, 0x95, 0xCE, 0x3E, 0x38, 0xE3, 0x0C, 
+0xCE, 0x6C, 0xB9, 0xB1, 0xA3, 0x6C, 0x2D, 0xFD, 
+0x23, 0x02, 0x05, 0x10, 0xF0, 0xB9, 0xFF, 0x15, 
+0x96, 0x54, 0x35, 0x92, 0x6A, 0xE4, 0xB4, 0xB2, 
+
+0x54, 0xF0, 0x0F, 0xE3, 0xCA, 0xD8, 0xB5, 0x9F, 
+0x26, 0xED, 0x7B, 0xC9, 0x8D, 0xE3, 0x89, 0x3E, 
+0x0E, 0x26, 0x37, 0xE3, 0xD1, 0x0E, 0x9F, 0x4E, 
+0x31, 0xCE, 0x0C, 0xA2, 0xE8, 0x7C, 0x8E, 0x3B, 
+0x85, 0x92, 0x48, 0x93, 0x45, 0x3A, 0x4A, 0x74, 
+0x68, 0x65, 0x13, 0x53, 0xF6, 0x0E, 0x22, 0x1D, 
+0xAB, 0x17, 0x6E, 0x49, 0x8A, 0x4A, 0x2C, 0x41, 
+0x80, 0xDB, 0xBB, 0x74, 0x27, 0xAB, 0xC7, 0x33, 
+0x0E, 0xC5, 0xEE, 0xF5, 0x31, 0x9D, 0xEE, 0x7C, 
+0xDE, 0x34, 0x2E, 0x2D, 0xD1, 0x06, 0x14, 0xF5, 
+0x00, 0xDA, 0x3C, 0x37, 0x31, 0x9C, 0x84, 0x6F, 
+0xEB, 0xC7, 0x08, 0x5D, 0x35, 0x0F, 0x93, 0xEB, 
+0x23, 0xA8, 0xEA, 0x8D, 0xFC, 0x5D, 0x2E, 0x38, 
+0x3F, 0x06, 0xD7, 0xA7, 0x5A, 0x2F, 0x4D, 0x81, 
+0x2B, 0xC2, 0xAC, 0xBD, 0xA2, 0xB5, 0x23, 0x20, 
+0x3C, 0xB8, 0x99, 0xFA, 0x82, 0xAE, 0x9F, 0xAB, 
+0x75, 0x0D, 0x11, 0x9F, 0xF1, 0x81, 0xA6, 0x67, 
+0xE7, 0x8C, 0xA8, 0x3B, 0xDC, 0xA8, 0xEB, 0x7E, 
+0xE1, 0x3D, 0x0E, 0xB0, 0x66, 0xF8, 0xB4, 0x2C, 
+0x59, 0xA1, 0x35, 0x9F, 0xF0, 0x6F, 0x3F, 0xDC, 
+0x1A, 0x5C, 0x84, 0x2F, 0xCF, 0xC8, 0xAC, 0xF3, 
+0xBE, 0xB2, 0x34, 0xAF, 0x04, 0x5F, 0x59, 0x41, 
+0x46, 0xAC, 0x52, 0x65, 0xC8, 0x71, 0x64, 0x4F, 
+0x22, 0x11, 0x16, 0xC6, 0x7A, 0x62, 0xD4, 0x46, 
+0xBE, 
+
+0x0B, 0xA1, 0x59, 0x33, 0x17, 0xEB, 0x32, 0x55, 
+0xA0, 0xB8, 0x6E, 0xA5, 0x67, 0x8C, 0xBB, 0xBA, 
+0x53, 0x37, 0xAA, 0x0E, 0xD2, 0xA4, 0xC2, 0xF3, 
+0x77, 0xCE, 0x37, 0x90, 0xE1, 0x8E, 0xA4, 0x58, 
+0x86, 0x1C, 0xF1, 0xC5, 0xEB, 0xE8, 0x23, 0x17, 
+0x29, 0x98, 0x9F, 0x97, 0xD6, 0xB6, 0x37, 0x9C, 
+0x57, 0xD1, 0x3F, 0xF8, 0x97, 0xE3, 0x3D, 0x18, 
+0x55, 0x55, 0x85, 0x47, 0xE5, 0x0C, 0x3F, 0x1C, 
+0x99, 0x55, 0x5C, 0xBF, 0xB7, 0xB2, 0x7B, 0x8D, 
+0x14, 0x18, 0xA0, 0x02, 0x48, 0xC7, 0xA8, 0xFB, 
+0x9E, 0xAF, 0x62, 0x30, 0x1D, 0xA5, 0x4B, 0x20, 
+0xAB, 0x69, 0x56, 0x6F, 0x95, 0xEE, 0xFF, 0x32, 
+0x04, 0x63, 0xD8, 0x47, 0x67, 0x94, 0xB3, 0xFE, 
+0x39, 0x1C, 0x55, 0x18, 0xA4, 0x76, 0xA7, 0x49, 
+0x95, 0x95, 0x91, 0x1A, 0x63, 0x84, 0x0F, 0x4F, 
+0x48, 0xD1, 0xB3, 0xBC, 0xAF, 0xED, 0xC8, 0x0D, 
+0x70, 0xF3, 0xFC, 0xD8, 0x25, 0xAE, 0x16, 0xFF, 
+0xAB, 0x63, 0xFB, 0x7C, 0x35, 0x80, 0x6E, 0x1F, 
+0x19, 0x73, 0xDB, 0x8C, 0x11, 0x43, 0xB8, 0x2D, 
+0x82, 0x67, 0xB0, 0x34, 0x80, 0x49, 0x67, 0x86, 
+0xC7, 0xCA, 0xC4, 0xF5, 0x5C, 0x15, 0x35, 0x0F, 
+0xDE, 0x24, 0x46, 0x1A, 0xDB, 0xE6, 0x68, 0x39, 
+0xBC, 0x55, 0x78, 0x6E, 0x8F, 0x2F, 0x21, 0x08, 
+0x45, 0x99, 0x6F, 0xA4, 0x1D, 0xDE, 0x3D, 0x31, 
+0x15, 0x5B, 
+
+0x97, 0xD1, 0x09, 0x11, 0x32, 0xAF, 0xAF, 0xF0, 
+0x72, 0x46, 0xDF, 0xEC, 0x06, 0xD3, 0x24, 0x09, 
+0x20, 0xCD, 0x0D, 0xDB, 0x3D, 0x92, 0x7C, 0xDF, 
+0x86, 0x66, 0x90, 0x7D, 0xD4, 0x50, 0xB4, 0x1C, 
+0x24, 0x5F, 0xE5, 0xD2, 0x83, 0xE4, 0x7C, 0xCC, 
+0x20, 0x10, 0x67, 0xE3, 0xAA, 0x4E, 0xB0, 0x22, 
+0x6F, 0xF9, 0xD5, 0x95, 0x48, 0x2A, 0xBD, 0xF0, 
+0xB9, 0x07, 0x1A, 0x1D, 0xAC, 0x8F, 0x57, 0x76, 
+0x30, 0x24, 0x18, 0x72, 0x51, 0xD3, 0x5A, 0x05, 
+0xFF, 0x43, 0x4C, 0xFE, 0x42, 0xD0, 0xB4, 0x34, 
+0xBC, 0xDD, 0xE6, 0xD7, 0x6C, 0x7C, 0xF8, 0x2B, 
+0xC8, 0xBA, 0x60, 0xC5, 0x54, 0xFB, 0x02, 0x95, 
+0xDA, 0x26, 0x00, 0xDD, 0xBA, 0xF8, 0x29, 0x00, 
+0x69, 0xC5, 0x02, 0x47, 0x80, 0x57, 0xE0, 0x4F, 
+0xE0, 0xC6, 0x2B, 0xEE, 0x49, 0x87, 0xE3, 0x62, 
+0xBF, 0xED, 0x4A, 0x9B, 0x04, 0x58, 0xC2, 0xD6, 
+0x36, 0x11, 0x42, 0xDF, 0x35, 0xCD, 0x3D, 0xBF, 
+0x10, 0x88, 0x93, 0xD7, 0x94, 0x8A, 0x40, 0x8F, 
+0xFF, 0x62, 0x5B, 0x11, 0xB8, 0x71, 0x07, 0x74, 
+0xC5, 0xBA, 0xDD, 0x01, 0x28, 0x0D, 0x82, 0xA8, 
+0x04, 0xB4, 0x01, 0x1E, 0x34, 0x41, 0x3B, 0x0A, 
+0x96, 0x12, 0x32, 0x9D, 0x2D, 0xA4, 0x61, 0x10, 
+0xCB, 0x50, 0x46, 0x39, 0x0A, 0xA1, 0x1D, 0x74, 
+0x48, 0xA5, 0x46, 0x39, 0xEE, 0x5F, 0x13, 0x9E, 
+0x3C, 0xEF, 0x66, 
+
+0x65, 0xD7, 0xD0, 0x8D, 0x6D, 0x4D, 0xA8, 0x13, 
+0xE1, 0xA6, 0xE5, 0x32, 0x7D, 0x71, 0x96, 0xED, 
+0x5D, 0x4F, 0x04, 0xFB, 0xD8, 0x7F, 0x5B, 0xFA, 
+0x1F, 0xEC, 0xF0, 0x6A, 0x6F, 0xEE, 0x4B, 0x59, 
+0xC3, 0xBD, 0x8E, 0xBA, 0x4F, 0x75, 0x92, 0x84, 
+0x59, 0x1F, 0x4F, 0xC7, 0x59, 0xC8, 0x7D, 0x47, 
+0x21, 0x69, 0x7D, 0xDB, 0x77, 0xF8, 0xC1, 0xE5, 
+0xAE, 0x70, 0x70, 0x1A, 0x6B, 0x06, 0x81, 0xB2, 
+0x15, 0x3C, 0xD1, 0x68, 0x5C, 0xBB, 0x68, 0xF1, 
+0xFF, 0xEE, 0x49, 0x77, 0xFD, 0x00, 0x55, 0xA3, 
+0xDE, 0x16, 0x85, 0xD9, 0x41, 0x6E, 0x5C, 0xCA, 
+0xD1, 0x32, 0xD5, 0x4A, 0x63, 0xF4, 0x20, 0x9B, 
+0x7E, 0x11, 0x1F, 0x83, 0xB9, 0x6D, 0xE1, 0xF5, 
+0x32, 0x6A, 0x6E, 0xEB, 0xDC, 0x5C, 0xEE, 0xBF, 
+0x04, 0xE4, 0xA5, 0xD6, 0x39, 0x62, 0x03, 0xC0, 
+0xE0, 0x9F, 0x4B, 0x7C, 0xA2, 0xA3, 0x9C, 0xEB, 
+0x7D, 0x8B, 0xA8, 0x08, 0x0B, 0x4E, 0xC6, 0x37, 
+0x37, 0x82, 0xB1, 0x7A, 0xAA, 0x74, 0x86, 0xFA, 
+0x12, 0x1A, 0xEA, 0x87, 0x1B, 0x19, 0x84, 0x68, 
+0xC5, 0xC0, 0x01, 0x95, 0x59, 0xD1, 0xD2, 0x36, 
+0x28, 0x9A, 0x28, 0xF5, 0xC2, 0xD3, 0x1A, 0x2C, 
+0xAA, 0xAB, 0x9B, 0x6D, 0xDE, 0x57, 0xF3, 0xFF, 
+0xA8, 0x4E, 0x11, 0xF4, 0x21, 0xD2, 0xBD, 0x6E, 
+0x99, 0x31, 0x16, 0x82, 0xA7, 0x71, 0x71, 0x76, 
+0x24, 0x71, 0x78, 0xF3, 
+
+0xF9, 0x3F, 0x78, 0xB2, 0x62, 0xF5, 0xD3, 0xCB, 
+0x42, 0x92, 0x19, 0x08, 0xA3, 0x70, 0x10, 0xA8, 
+0x5E, 0x4C, 0xBF, 0x7E, 0x2F, 0x84, 0xA6, 0xF7, 
+0xF4, 0x69, 0x18, 0x6F, 0xDD, 0x69, 0x2C, 0x57, 
+0xB9, 0x88, 0xD3, 0xDD, 0x99, 0x4E, 0xB1, 0x33, 
+0x06, 0x82, 0x2E, 0xF1, 0x93, 0xC1, 0x1F, 0x34, 
+0xB4, 0x46, 0x30, 0xC3, 0xE9, 0xED, 0xC5, 0x05, 
+0xEE, 0x36, 0xE7, 0x4B, 0x6D, 0x88, 0x33, 0xF0, 
+0x1C, 0x8C, 0x1C, 0x28, 0x3A, 0x17, 0xBB, 0x5A, 
+0x24, 0x22, 0xCE, 0xE8, 0x45, 0x56, 0xE3, 0x23, 
+0x16, 0x3B, 0x56, 0xD7, 0x03, 0x4D, 0x10, 0xF4, 
+0xD5, 0xB4, 0xDF, 0xAD, 0xB8, 0xEA, 0xE7, 0x4A, 
+0x17, 0xE9, 0x74, 0x97, 0x73, 0x23, 0xA9, 0x5C, 
+0x1B, 0xEB, 0xF7, 0x35, 0x70, 0xEB, 0xA0, 0xC2, 
+0x1A, 0x5A, 0x3A, 0xDF, 0xF5, 0x17, 0x0B, 0x2A, 
+0x87, 0x3B, 0xAC, 0xB2, 0x39, 0x5E, 0x1E, 0x75, 
+0x6A, 0x1A, 0x65, 0x70, 0x56, 0x68, 0xA1, 0xF0, 
+0x8D, 0x5A, 0xF2, 0xA7, 0x52, 0x5C, 0x87, 0xE5, 
+0xBC, 0x04, 0x11, 0x74, 0x57, 0x2F, 0x46, 0x54, 
+0xC7, 0xE5, 0x65, 0x86, 0x0F, 0x3C, 0xCC, 0xF9, 
+0x42, 0xE1, 0xF9, 0xE4, 0x91, 0x4D, 0x76, 0x20, 
+0x40, 0x87, 0x91, 0xF5, 0x1E, 0xD2, 0x85, 0xEB, 
+0x43, 0x8F, 0xBB, 0x2E, 0x71, 0x2C, 0xEC, 0x70, 
+0xC2, 0x46, 0x6C, 0xDA, 0x30, 0x76, 0x8D, 0xF7, 
+0x0C, 0x79, 0x31, 0xDF, 0x6C, 
+
+0xF1, 0xBE, 0x80, 0x50, 0xE0, 0x94, 0xCE, 0xC1, 
+0xEB, 0xDA, 0x5E, 0xB0, 0x27, 0x03, 0x4E, 0x39, 
+0xF5, 0xCE, 0x47, 0x65, 0x61, 0x8B, 0xB6, 0x53, 
+0xD2, 0x6A, 0x25, 0x2E, 0xA3, 0x11, 0xD7, 0x8F, 
+0x7E, 0x4F, 0xBF, 0x12, 0x20, 0xDB, 0xE5, 0xB5, 
+0xFD, 0x8F, 0x27, 0x26, 0xC0, 0x69, 0x7A, 0xAF, 
+0xA6, 0xDA, 0x42, 0x2B, 0x4B, 0x5B, 0xE4, 0x8D, 
+0xB0, 0xF8, 0x86, 0x22, 0xCA, 0xDF, 0xA4, 0xA0, 
+0x18, 0xF4, 0x1D, 0x5F, 0x91, 0x6E, 0x93, 0x55, 
+0xCE, 0xAA, 0x18, 0x22, 0x4D, 0x7B, 0xDD, 0xB2, 
+0x77, 0xC8, 0xE9, 0xCA, 0x35, 0x30, 0x5F, 0xCC, 
+0x24, 0xA3, 0x06, 0x5F, 0xCC, 0xBC, 0x43, 0xAF, 
+0xA7, 0xE1, 0x8C, 0x6C, 0xE5, 0x76, 0x94, 0x63, 
+0x82, 0x1C, 0x75, 0x5A, 0xD5, 0x05, 0xBB, 0x05, 
+0x0B, 0xB7, 0x7A, 0xA9, 0x24, 0xCD, 0xCE, 0xE2, 
+0xAD, 0xA1, 0xEA, 0x75, 0x22, 0xAE, 0x0E, 0xAF, 
+0x9F, 0x88, 0x42, 0x21, 0xA9, 0xCE, 0x87, 0xBE, 
+0x1F, 0x3C, 0x14, 0x8E, 0x0C, 0x04, 0x74, 0x0C, 
+0xCA, 0x4E, 0xFF, 0xDF, 0x80, 0x51, 0xF6, 0x85, 
+0x36, 0xD8, 0x48, 0x62, 0x99, 0x4A, 0xD5, 0x82, 
+0xC1, 0x0B, 0x4D, 0x5A, 0x02, 0x13, 0xBA, 0x4F, 
+0x66, 0x76, 0x39, 0x69, 0x43, 0x39, 0x8C, 0x8A, 
+0x28, 0x87, 0x59, 0xCD, 0xCB, 0xE1, 0xF2, 0x52, 
+0xA4, 0x4D, 0xC4, 0x4A, 0xFD, 0x49, 0x1A, 0xFB, 
+0x5F, 0x4A, 0xD5, 0x11, 0x3D, 0xD7, 
+
+0x91, 0xFF, 0x81, 0x0D, 0x4A, 0x80, 0x90, 0x9D, 
+0x86, 0x6E, 0xA4, 0x33, 0x86, 0xF4, 0x91, 0xCB, 
+0x54, 0x3C, 0x2D, 0x5B, 0x46, 0xC6, 0xDC, 0x15, 
+0xA7, 0x05, 0x02, 0xA1, 0xA6, 0xED, 0xDE, 0x9A, 
+0x52, 0x90, 0xE0, 0xD0, 0xD8, 0x5F, 0xD7, 0x5C, 
+0x81, 0x17, 0xF1, 0xFF, 0xB7, 0x87, 0xED, 0x91, 
+0xCD, 0x0E, 0x63, 0xD9, 0xC5, 0x2D, 0xCE, 0x7A, 
+0x68, 0x10, 0xF6, 0x77, 0x32, 0x2B, 0x46, 0x89, 
+0x12, 0xAA, 0x42, 0x24, 0x88, 0xDD, 0x77, 0x04, 
+0x90, 0x86, 0x1D, 0x9D, 0x8A, 0x33, 0xEB, 0xA3, 
+0x6C, 0x7C, 0x57, 0x6E, 0x93, 0xE1, 0x8C, 0x47, 
+0x97, 0xF5, 0x61, 0x32, 0x0B, 0x53, 0x20, 0xD0, 
+0xF3, 0x21, 0xFB, 0x9D, 0xA5, 0xC7, 0x1A, 0xC7, 
+0xE5, 0x72, 0x2D, 0x21, 0x63, 0x92, 0xB2, 0x52, 
+0x27, 0x72, 0x54, 0xA1, 0xBF, 0x6F, 0x4D, 0x84, 
+0x95, 0xE1, 0xFE, 0xA3, 0x76, 0x79, 0x2C, 0xFE, 
+0xBE, 0x7B, 0x19, 0x0E, 0x59, 0x6F, 0x5C, 0x5D, 
+0x6B, 0x36, 0x35, 0x41, 0x0E, 0xFE, 0x84, 0xDD, 
+0xE2, 0xD7, 0x5C, 0x1E, 0xC9, 0xF2, 0x83, 0xB9, 
+0x92, 0xCF, 0xE2, 0x11, 0x1B, 0x8C, 0x48, 0x8D, 
+0xE0, 0x37, 0x22, 0x7E, 0xE1, 0x21, 0x31, 0x59, 
+0x84, 0x03, 0x5D, 0x3A, 0x16, 0x5B, 0xB9, 0xD2, 
+0xCB, 0x32, 0xE5, 0xE6, 0xA5, 0xC5, 0x77, 0x54, 
+0x64, 0xB3, 0xA9, 0x6D, 0x65, 0x93, 0x89, 0x91, 
+0xC6, 0xAC, 0xB4, 0xEC, 0x4D, 0x6E, 0x77, 
+
+0x09, 0xB7, 0xEE, 0xCE, 0xD8, 0xEA, 0x87, 0x0B, 
+0xAA, 0x8B, 0x8F, 0xDD, 0x72, 0xD2, 0x76, 0x73, 
+0x70, 0xBC, 0x0B, 0x63, 0x37, 0x84, 0x09, 0xE9, 
+0x0A, 0xCD, 0xED, 0x8F, 0xB1, 0xF1, 0x40, 0x36, 
+0xCA, 0x8A, 0x62, 0x6E, 0x2B, 0xAD, 0x82, 0x21, 
+0x41, 0x30, 0x20, 0xC2, 0x1D, 0x8A, 0xDD, 0x99, 
+0x66, 0xCE, 0x33, 0xB9, 0x37, 0xA7, 0x8A, 0x3B, 
+0x63, 0x1D, 0x24, 0x12, 0x08, 0xEB, 0xDF, 0x99, 
+0x0E, 0x19, 0xEC, 0xD2, 0xD5, 0xB4, 0x07, 0x0D, 
+0xA4, 0xC1, 0x07, 0x99, 0xF6, 0x74, 0x4A, 0x44, 
+0xD1, 0x0E, 0x2F, 0x3C, 0x06, 0x7A, 0x2F, 0xA9, 
+0xEF, 0x9C, 0x08, 0xC3, 0xDB, 0x9A, 0x6D, 0x21, 
+0x87, 0xA0, 0x34, 0x42, 0x2A, 0xD0, 0x29, 0xF1, 
+0x29, 0x8E, 0xAA, 0xC2, 0x6F, 0xCD, 0xD0, 0xE9, 
+0x55, 0x79, 0xC8, 0x3C, 0x21, 0x89, 0x59, 0x85, 
+0xA9, 0x70, 0xE7, 0x69, 0x4B, 0xB2, 0x9B, 0xEF, 
+0x93, 0x78, 0xA4, 0x62, 0x40, 0x40, 0xC4, 0xB8, 
+0xC3, 0xC2, 0xA0, 0xDF, 0xB0, 0x08, 0x1F, 0xCD, 
+0x64, 0x6C, 0xD0, 0xA3, 0xC2, 0x58, 0x18, 0x05, 
+0x6A, 0xA0, 0xB9, 0x49, 0x2F, 0x8D, 0x80, 0x26, 
+0x1A, 0x3A, 0x22, 0x4A, 0xFE, 0x0B, 0xDD, 0x0B, 
+0x3B, 0x75, 0x87, 0x62, 0x00, 0x98, 0x8E, 0xCC, 
+0x72, 0x91, 0x14, 0xA1, 0xF0, 0xC1, 0xC1, 0x5D, 
+0x79, 0xF8, 0xE6, 0xCD, 0xE4, 0x70, 0xEB, 0xBC, 
+0x64, 0xCC, 0x69, 0x78, 0x0F, 0x6F, 0x94, 0xCD, 
+
+0xB1, 0xDF, 0x78, 0x6F, 0x56, 0xDD, 0x51, 0x34, 
+0xB3, 0x8B, 0x02, 0x47, 0x0F, 0x8A, 0xF4, 0x9E, 
+0x12, 0x6B, 0x22, 0x05, 0xDB, 0x49, 0x37, 0x49, 
+0xC2, 0x64, 0xB4, 0x7C, 0xC3, 0x27, 0x30, 0x3D, 
+0x92, 0xB4, 0xB2, 0xFF, 0xFD, 0x4B, 0x0E, 0xCC, 
+0xB3, 0x0C, 0x29, 0x64, 0x9A, 0x1B, 0x12, 0x2F, 
+0x01, 0x0F, 0xEF, 0xCC, 0x5E, 0x64, 0xBE, 0x21, 
+0xC1, 0x9C, 0x4F, 0x8D, 0x65, 0x8C, 0xF4, 0x45, 
+0x93, 0xBD, 0x69, 0x9F, 0x61, 0xCB, 0x06, 0xF0, 
+0xE5, 0xCC, 0xC3, 0x86, 0x65, 0x8F, 0x85, 0x33, 
+0x6A, 0x2D, 0xD4, 0x1A, 0xE3, 0x42, 0xEB, 0x6B, 
+0x85, 0x70, 0x65, 0x76, 0xB7, 0xD1, 0x9E, 0x2A, 
+0x70, 0x30, 0x3B, 0x5D, 0x0F, 0xD1, 0x0E, 0x12, 
+0x82, 0x41, 0xF6, 0xD4, 0x51, 0x97, 0x04, 0x84, 
+0xF8, 0xB2, 0xEB, 0x2D, 0xFF, 0x7E, 0x3A, 0x39, 
+0x3A, 0x08, 0xF5, 0x11, 0xCC, 0xD2, 0x10, 0x7F, 
+0x46, 0xF7, 0x21, 0xF7, 0xAE, 0xC7, 0x4C, 0xCE, 
+0xF1, 0x51, 0xAC, 0x65, 0xE6, 0x3D, 0x70, 0xF8, 
+0x3C, 0xB9, 0x8F, 0x98, 0xE1, 0xC6, 0xF4, 0x64, 
+0xB1, 0x99, 0x80, 0x86, 0xA9, 0x0A, 0x3B, 0xB4, 
+0x9C, 0x6A, 0x39, 0x3A, 0xB8, 0xFE, 0x01, 0x73, 
+0x2E, 0x2B, 0xE2, 0x61, 0xC0, 0x28, 0xD3, 0x7D, 
+0x6D, 0xEC, 0xFA, 0xBD, 0x72, 0x1B, 0x30, 0xDC, 
+0x3A, 0xE8, 0x6F, 0xAE, 0x72, 0xC1, 0x40, 0x57, 
+0xEC, 0x4B, 0x17, 0x6F, 0x8B, 0xE7, 0xEF, 0x9B, 
+0x03, 
+
+0xAA, 0x44, 0xB1, 0x7E, 0x3F, 0x58, 0x65, 0x2F, 
+0xEB, 0xFC, 0x7E, 0x4F, 0x27, 0x11, 0x68, 0x10, 
+0x37, 0xDA, 0x2C, 0x41, 0x4C, 0x80, 0x60, 0x5C, 
+0xAE, 0x83, 0x60, 0x83, 0xC0, 0x79, 0x6F, 0xF1, 
+0x51, 0x75, 0xB0, 0xE9, 0x54, 0x0D, 0x52, 0x1C, 
+0xFC, 0xDD, 0xFB, 0x0D, 0xEC, 0xF4, 0xF5, 0x57, 
+0x61, 0x9E, 0xC4, 0x6E, 0x14, 0xEB, 0x4F, 0x1E, 
+0xC9, 0x39, 0xD8, 0x22, 0xF8, 0xB2, 0x20, 0x0A, 
+0x2D, 0x66, 0x41, 0x04, 0x5C, 0x12, 0xE1, 0xBE, 
+0xF0, 0xA4, 0x86, 0x4B, 0x9A, 0x42, 0x4A, 0xA5, 
+0x53, 0x17, 0xF3, 0xC1, 0x70, 0x31, 0x32, 0xB8, 
+0xC4, 0x93, 0x24, 0xF2, 0xC5, 0x9C, 0x86, 0x65, 
+0xB7, 0x08, 0x5B, 0x12, 0xB9, 0xBB, 0xBE, 0xC5, 
+0x08, 0xF2, 0xC6, 0xB7, 0x43, 0x6D, 0x01, 0x64, 
+0xCB, 0x0A, 0x2B, 0xC4, 0x50, 0x88, 0x1F, 0x70, 
+0xDB, 0xC6, 0x5B, 0x6C, 0x7F, 0x0D, 0x00, 0xDD, 
+0x99, 0xD4, 0xD8, 0x1D, 0xE3, 0x51, 0x6F, 0xB7, 
+0x24, 0xA9, 0xAD, 0x49, 0x8D, 0x98, 0xDC, 0xB2, 
+0x0E, 0xA4, 0x00, 0x34, 0xA7, 0xC7, 0xC4, 0x7A, 
+0x37, 0x82, 0xC8, 0xF1, 0x9A, 0x27, 0x22, 0xA0, 
+0x24, 0x21, 0xD0, 0x47, 0xE6, 0x1F, 0xA4, 0x13, 
+0xBD, 0x1B, 0x16, 0x63, 0x75, 0x70, 0x92, 0x2C, 
+0x5E, 0x9D, 0x8E, 0xFA, 0xCB, 0x40, 0xEE, 0x77, 
+0xEE, 0x5A, 0x29, 0xA1, 0xE0, 0xC9, 0x65, 0xDA, 
+0x74, 0x00, 0xB3, 0x4E, 0x9C, 0x92, 0x7E, 0xAD, 
+0x11, 0x8C, 
+
+0x6C, 0x68, 0xCB, 0xC1, 0xDA, 0x0D, 0xBD, 0xC1, 
+0x04, 0x4D, 0x9B, 0x46, 0x8E, 0x73, 0xD6, 0x7F, 
+0x04, 0x4B, 0x68, 0x6E, 0x13, 0xFA, 0x08, 0xA5, 
+0x9E, 0x30, 0x51, 0x34, 0x49, 0x1F, 0x20, 0x97, 
+0xBE, 0x96, 0xC3, 0x97, 0xC4, 0x80, 0x6A, 0x2C, 
+0xC9, 0xB7, 0xC5, 0x66, 0x94, 0xDE, 0xD6, 0x14, 
+0x6C, 0x22, 0x98, 0x37, 0x21, 0xCC, 0x2F, 0xB3, 
+0x8A, 0x7B, 0x65, 0x60, 0x27, 0x7F, 0xC6, 0x25, 
+0x93, 0xD4, 0x47, 0x26, 0xA7, 0x3D, 0x67, 0xDA, 
+0xAD, 0xB4, 0xC9, 0xEB, 0x9E, 0xF7, 0xA5, 0xA3, 
+0x5A, 0xD9, 0xC6, 0xD2, 0x2E, 0x56, 0xA4, 0x4B, 
+0x4F, 0x02, 0xDB, 0x40, 0x65, 0x1D, 0x49, 0x2C, 
+0xB3, 0xCC, 0x50, 0xFA, 0xF1, 0xEB, 0x22, 0x9E, 
+0xD0, 0xFA, 0x96, 0xF1, 0xB0, 0x1A, 0xDB, 0x9F, 
+0x34, 0x4D, 0x8B, 0x21, 0x30, 0x93, 0xE5, 0xEC, 
+0x0B, 0x07, 0x2C, 0xAE, 0x51, 0x69, 0xF8, 0x96, 
+0x1C, 0x17, 0xF6, 0x83, 0x8B, 0x2F, 0x74, 0x23, 
+0xA0, 0x4C, 0x8E, 0x32, 0x8D, 0x3F, 0x9D, 0xEE, 
+0x43, 0x2D, 0x47, 0x3C, 0x8B, 0xE6, 0x68, 0xF8, 
+0xFC, 0x68, 0xEA, 0x37, 0xC9, 0x9E, 0x34, 0xFA, 
+0x0A, 0x7D, 0x0D, 0x52, 0x37, 0x2C, 0x16, 0x77, 
+0x09, 0x61, 0x48, 0xE7, 0x62, 0x44, 0xAA, 0xA7, 
+0xA7, 0xE3, 0xE5, 0x8C, 0xE0, 0x99, 0x16, 0x08, 
+0x3A, 0x98, 0x41, 0x68, 0x8E, 0x15, 0xFD, 0xCF, 
+0x35, 0xD1, 0xAD, 0xED, 0xAE, 0x7F, 0xBF, 0xE5, 
+0xC4, 0x36, 0xB7, 
+
+0xD3, 0x06, 0x83, 0x44, 0x3E, 0xFB, 0x65, 0xA3, 
+0xF7, 0x5F, 0x77, 0x5D, 0x52, 0x77, 0x10, 0x15, 
+0xB4, 0x78, 0xE2, 0xFC, 0xAF, 0x3D, 0x19, 0xED, 
+0xF8, 0x64, 0xF9, 0x25, 0x16, 0xEB, 0x39, 0xB9, 
+0x63, 0x09, 0xC6, 0xA8, 0xA5, 0x2B, 0x76, 0xED, 
+0xE5, 0xDD, 0xD7, 0xFA, 0xB1, 0xB3, 0xD0, 0x81, 
+0x4A, 0x63, 0xF6, 0x07, 0x97, 0xF3, 0x86, 0xA0, 
+0xC0, 0x0A, 0x93, 0x2D, 0xC3, 0xCE, 0xA3, 0xE2, 
+0x56, 0xC2, 0x31, 0xF2, 0x51, 0x0E, 0xCF, 0x5D, 
+0x8F, 0x84, 0x8F, 0xB2, 0x1D, 0x49, 0xE9, 0x28, 
+0xF0, 0x14, 0xBE, 0x10, 0xFC, 0x71, 0x01, 0xC5, 
+0x1B, 0x94, 0x10, 0x96, 0xCC, 0x4A, 0xC3, 0xD8, 
+0xC4, 0xD1, 0xD9, 0xF6, 0x53, 0x14, 0xEA, 0x25, 
+0x21, 0x13, 0x57, 0x07, 0x8D, 0x10, 0xA1, 0xAE, 
+0x5B, 0xFD, 0x0C, 0x2F, 0x8A, 0x71, 0xEB, 0x6E, 
+0x9E, 0xF3, 0x12, 0x1A, 0xB4, 0x0F, 0x1E, 0xB1, 
+0x3A, 0x64, 0x7D, 0x5A, 0x91, 0x74, 0xD0, 0xBB, 
+0x68, 0xE2, 0xE4, 0x45, 0x50, 0x51, 0x1F, 0xDA, 
+0xE7, 0xAD, 0x94, 0xB1, 0xF4, 0x89, 0xC5, 0xBE, 
+0x56, 0x98, 0x08, 0xFC, 0xF2, 0xDB, 0xC0, 0x4A, 
+0x45, 0x00, 0x91, 0x0C, 0x0B, 0xA2, 0xD6, 0x24, 
+0x03, 0x11, 0xB1, 0xEA, 0xC0, 0xF9, 0x7D, 0x79, 
+0x08, 0x57, 0xA2, 0x54, 0x06, 0xEB, 0xC3, 0x78, 
+0xAC, 0x2C, 0xF4, 0x15, 0x26, 0xA3, 0xE7, 0xD0, 
+0x7B, 0x04, 0xF2, 0x07, 0xC4, 0x41, 0xA5, 0x0E, 
+0x35, 0x84, 0x1E, 0x6D, 
+
+0x19, 0xDD, 0xF3, 0xE3, 0x02, 0xB0, 0x9B, 0x50, 
+0x0E, 0x27, 0x83, 0xAE, 0x12, 0x5D, 0xDD, 0x37, 
+0xDC, 0x07, 0x3F, 0x9F, 0x39, 0xE3, 0x0E, 0x05, 
+0x62, 0xDF, 0x65, 0x69, 0x1C, 0x68, 0x34, 0x2D, 
+0x7F, 0x1C, 0x4B, 0x66, 0xA5, 0x54, 0x54, 0x09, 
+0x15, 0x44, 0x9B, 0x09, 0x48, 0x05, 0xB5, 0xC4, 
+0xDD, 0x6E, 0x23, 0x51, 0xA8, 0x8F, 0xD5, 0x62, 
+0xC0, 0x28, 0x9C, 0x5F, 0x7E, 0x8B, 0x15, 0x96, 
+0x50, 0xA5, 0xF6, 0xAB, 0x56, 0xF8, 0x74, 0x81, 
+0x23, 0xFE, 0xDB, 0xCD, 0xCC, 0x0B, 0xA6, 0x34, 
+0xD0, 0x76, 0xF8, 0xDA, 0xD4, 0x07, 0x5A, 0xD3, 
+0xB3, 0x75, 0x55, 0xF4, 0x69, 0x74, 0xF4, 0x5C, 
+0x5C, 0x77, 0xC5, 0xD0, 0xE2, 0x7C, 0x3F, 0x7A, 
+0x0D, 0x07, 0xBB, 0xA9, 0x26, 0x26, 0x5D, 0x8A, 
+0xF3, 0x7A, 0x34, 0x59, 0xBF, 0xA2, 0x7C, 0x10, 
+0xB4, 0x69, 0x0B, 0x1B, 0xB6, 0x8E, 0xD7, 0x09, 
+0xEB, 0xD0, 0x00, 0x61, 0xD7, 0xC8, 0xAA, 0x43, 
+0x6D, 0x46, 0x1E, 0x05, 0x3F, 0xB7, 0x56, 0x6D, 
+0xAB, 0x48, 0x26, 0x4F, 0x3F, 0xEB, 0x2C, 0xBB, 
+0x3D, 0x43, 0xB2, 0xBD, 0x42, 0xEF, 0x69, 0xEB, 
+0x33, 0x0D, 0x23, 0x9F, 0x3B, 0x0A, 0x42, 0x2B, 
+0x34, 0x49, 0xC0, 0x88, 0x56, 0xD5, 0x37, 0xBC, 
+0xD1, 0x93, 0x1E, 0x14, 0x79, 0x9A, 0x79, 0x92, 
+0x02, 0x44, 0x28, 0xAD, 0x65, 0x81, 0xF4, 0x90, 
+0xC9, 0xB6, 0x52, 0x8A, 0x80, 0xF8, 0x31, 0x30, 
+0x86, 0x2A, 0x9B, 0x7C, 0xA2, 
+
+0x95, 0xA2, 0xF5, 0x83, 0xE6, 0xC6, 0x7E, 0x5A, 
+0x9B, 0xDF, 0x2B, 0x5B, 0x18, 0x9E, 0xAA, 0xD2, 
+0x79, 0x11, 0x19, 0xA3, 0x63, 0xB7, 0xA1, 0x57, 
+0x2D, 0x02, 0x50, 0x37, 0x90, 0xFC, 0xE1, 0x67, 
+0x7E, 0x78, 0x80, 0xD0, 0xC7, 0xF2, 0xAC, 0x02, 
+0xEF, 0x9E, 0xE1, 0x01, 0xA5, 0x61, 0x5D, 0xFE, 
+0x34, 0xD7, 0x32, 0xB5, 0xF0, 0xA5, 0xDC, 0x29, 
+0x03, 0x68, 0x94, 0x0D, 0x53, 0x7D, 0xAC, 0x52, 
+0xA1, 0x4A, 0x81, 0x3A, 0xDD, 0xEE, 0x23, 0x6B, 
+0xEC, 0x87, 0x8E, 0xAA, 0x4A, 0xAC, 0x89, 0xF2, 
+0x14, 0x8C, 0x85, 0xEE, 0x08, 0x3D, 0x68, 0xD1, 
+0x56, 0x90, 0x51, 0xC0, 0x19, 0x33, 0x0F, 0x2C, 
+0xA9, 0x17, 0xDF, 0x3A, 0x0A, 0x2F, 0x76, 0xDF, 
+0x48, 0x72, 0xAB, 0x96, 0x00, 0x7F, 0xF4, 0x4D, 
+0x2C, 0xCC, 0x69, 0x27, 0xB1, 0xA9, 0xB8, 0x5F, 
+0x32, 0xA6, 0x9E, 0x14, 0x11, 0x27, 0x10, 0x6B, 
+0x6E, 0xE2, 0x98, 0xC5, 0x6C, 0xE0, 0x65, 0x7B, 
+0x0B, 0xF6, 0xB6, 0x29, 0x31, 0xF2, 0x15, 0x4C, 
+0xAF, 0x34, 0x6F, 0xDB, 0x13, 0x9C, 0xA8, 0x73, 
+0xD8, 0x10, 0x39, 0x13, 0x17, 0x1C, 0x0C, 0xAB, 
+0x99, 0x38, 0x21, 0x38, 0xC8, 0x83, 0x85, 0x3E, 
+0x49, 0x9A, 0xFA, 0x03, 0xFF, 0x9C, 0x7C, 0x28, 
+0x3C, 0x24, 0x18, 0x38, 0x89, 0x81, 0xD0, 0x78, 
+0xCC, 0x73, 0x2F, 0x9B, 0xC6, 0xD1, 0x24, 0xBF, 
+0x38, 0x92, 0xD0, 0x0A, 0xCD, 0x4E, 0x22, 0x2D, 
+0xA5, 0x75, 0x23, 0xF9, 0x46, 0x08, 
+
+0x40, 0x95, 0xA4, 0x17, 0x4F, 0x05, 0x72, 0x15, 
+0x3A, 0x69, 0x3A, 0xAE, 0x37, 0x21, 0x55, 0x1B, 
+0x1B, 0x0E, 0x0B, 0xDA, 0xCE, 0x60, 0x7B, 0x96, 
+0xA9, 0x7F, 0x12, 0x0B, 0xDA, 0x7D, 0xB1, 0xAA, 
+0x33, 0xF2, 0x1D, 0x00, 0xC7, 0x42, 0xE6, 0x4D, 
+0x5F, 0x94, 0x19, 0x0A, 0xF9, 0xDC, 0x06, 0x38, 
+0x57, 0x69, 0x49, 0x12, 0x7A, 0x16, 0x94, 0x02, 
+0x5B, 0xDA, 0x21, 0xA4, 0x5C, 0x15, 0x2A, 0xA9, 
+0x8F, 0x89, 0x91, 0x21, 0x19, 0x8E, 0x7A, 0xAF, 
+0x77, 0x58, 0x8A, 0xFF, 0xCF, 0x5F, 0xCB, 0x19, 
+0x05, 0x64, 0x76, 0x8D, 0x18, 0x0D, 0x37, 0xD2, 
+0x61, 0xE5, 0x2F, 0xC9, 0x37, 0xAB, 0x22, 0x38, 
+0xBC, 0x17, 0x63, 0x8C, 0xD5, 0x7B, 0x35, 0x77, 
+0xF1, 0x3F, 0xAE, 0xE0, 0x78, 0x30, 0x8D, 0x42, 
+0xC1, 0x99, 0x4A, 0xB2, 0xC6, 0x12, 0x5C, 0xFB, 
+0xA4, 0x55, 0xE6, 0x5A, 0x4D, 0x3C, 0xF1, 0xFC, 
+0x42, 0x01, 0x8B, 0x8B, 0x9E, 0x55, 0x6C, 0xF6, 
+0xE1, 0x08, 0xEA, 0x0D, 0xDA, 0x43, 0x09, 0xAF, 
+0x2B, 0x22, 0x32, 0x47, 0xC4, 0xF7, 0x9C, 0x27, 
+0x3C, 0x8F, 0xAF, 0xC0, 0x8B, 0xF7, 0xC9, 0xA7, 
+0x92, 0xA1, 0xFD, 0xE5, 0x5A, 0xA4, 0xA9, 0xAD, 
+0x22, 0xD2, 0x5C, 0x49, 0x5E, 0x9A, 0x30, 0x08, 
+0xB0, 0x42, 0x53, 0x04, 0x1A, 0x98, 0xDB, 0x4E, 
+0x2E, 0xE1, 0x04, 0xCC, 0x4D, 0xC9, 0x24, 0x3B, 
+0x2A, 0xF6, 0xA7, 0x83, 0x12, 0x43, 0xB1, 0x9F, 
+0xB5, 0x74, 0x8E, 0xEA, 0x69, 0x23, 0xE1, 
+
+0x8E, 0x99, 0x0F, 0x25, 0xDB, 0xB0, 0x61, 0x57, 
+0x3A, 0xAA, 0x84, 0x93, 0x39, 0xCD, 0x5F, 0x41, 
+0xF8, 0xE2, 0x0C, 0x18, 0xA2, 0x62, 0x13, 0x1F, 
+0xA4, 0x35, 0x63, 0x59, 0x2A, 0xF4, 0xE2, 0x9F, 
+0x88, 0xCC, 0x83, 0x3A, 0x17, 0xAE, 0x98, 0xEF, 
+0x1C, 0x65, 0x88, 0xAC, 0x02, 0x43, 0xEE, 0x9E, 
+0xC5, 0x51, 0xB6, 0xF9, 0x6C, 0xC6, 0x76, 0x8D, 
+0xA9, 0xF3, 0x5A, 0x51, 0x0C, 0x13, 0xD4, 0xEE, 
+0x1C, 0x63, 0x78, 0xC9, 0x3B, 0x1E, 0xB0, 0xAB, 
+0x0C, 0xC9, 0xA7, 0x61, 0xEE, 0xCF, 0x5F, 0xFF, 
+0x5D, 0x31, 0xA2, 0x7E, 0x9D, 0xCE, 0x8D, 0x0C, 
+0x55, 0xC2, 0x22, 0x35, 0xD8, 0x8A, 0xC5, 0xE9, 
+0xFD, 0x43, 0xA9, 0x01, 0xDB, 0x9B, 0x7A, 0xAD, 
+0x95, 0x31, 0x5E, 0xCB, 0xB8, 0x25, 0xA8, 0x28, 
+0x5C, 0x18, 0x88, 0xE4, 0xF9, 0xF6, 0x6B, 0xB7, 
+0xBB, 0x37, 0x85, 0x51, 0x1A, 0x21, 0xAE, 0xE3, 
+0x8D, 0x3C, 0xD6, 0x8E, 0x32, 0x9C, 0x1C, 0xC5, 
+0x91, 0xB8, 0x41, 0xF0, 0xF4, 0xC7, 0xE2, 0x67, 
+0x1F, 0x7B, 0xF7, 0xB2, 0xB4, 0x6A, 0x46, 0xBA, 
+0x0D, 0x67, 0x97, 0xA2, 0xEF, 0xD0, 0x92, 0x07, 
+0xEE, 0x03, 0x78, 0xFD, 0x1D, 0xE6, 0x94, 0x19, 
+0x3F, 0x71, 0xA7, 0x77, 0xD3, 0xBF, 0xCF, 0x97, 
+0x29, 0x3B, 0x46, 0xA2, 0xD5, 0x05, 0xCB, 0xD4, 
+0xDA, 0xB8, 0x97, 0x7B, 0x13, 0x04, 0x9F, 0x09, 
+0xCA, 0x6F, 0xB3, 0x69, 0x6C, 0x07, 0x51, 0xA5, 
+0x14, 0x07, 0x4D, 0x92, 0x40, 0x06, 0x7C, 0xC4, 
+
+0xD7, 0x60, 0x5B, 0xDA, 0xBB, 0xD8, 0x23, 0x77, 
+0xCF, 0xFC, 0x95, 0x1B, 0x44, 0x94, 0x28, 0x7A, 
+0x6C, 0x83, 0xC1, 0x41, 0xDA, 0x90, 0xB7, 0x48, 
+0x86, 0x2B, 0x89, 0xDD, 0x2B, 0xC0, 0x95, 0xE7, 
+0x29, 0xB4, 0x6D, 0x6F, 0x16, 0xCA, 0x25, 0x11, 
+0xEE, 0x2F, 0xE6, 0xB6, 0xB8, 0xC1, 0x08, 0x91, 
+0x66, 0xFB, 0xED, 0xB7, 0x26, 0x51, 0x26, 0x32, 
+0xD4, 0x3C, 0x3B, 0xE9, 0x0C, 0x7C, 0x64, 0xF6, 
+0x8F, 0xD0, 0x38, 0xF3, 0xF2, 0x89, 0x87, 0xC6, 
+0xFA, 0x90, 0x1A, 0x00, 0x80, 0x8F, 0xFD, 0x52, 
+0x29, 0xCA, 0x6F, 0x6F, 0x5C, 0xFF, 0x04, 0x6A, 
+0x50, 0x19, 0x6E, 0x57, 0xF1, 0x98, 0xE1, 0xF0, 
+0x21, 0x11, 0xE7, 0xAB, 0x5F, 0x47, 0xAF, 0x36, 
+0x46, 0x5B, 0x0E, 0xCD, 0x5E, 0xA9, 0x70, 0x92, 
+0x70, 0x04, 0xF3, 0x53, 0x56, 0x55, 0xF7, 0x0F, 
+0x42, 0xCF, 0x6F, 0x7C, 0xE5, 0xA8, 0x0D, 0x08, 
+0xA4, 0x7B, 0xD2, 0x51, 0xED, 0xBE, 0xF8, 0xA9, 
+0x86, 0xA6, 0x0B, 0x05, 0x9D, 0x34, 0x1E, 0x51, 
+0x57, 0x64, 0x6D, 0xAC, 0xE5, 0xAC, 0x9B, 0xB6, 
+0xDC, 0xF1, 0xAD, 0xB7, 0x31, 0x89, 0x3C, 0x63, 
+0x8E, 0xE9, 0xC8, 0x1B, 0xBA, 0x0D, 0xB0, 0x80, 
+0xC7, 0xE9, 0x7A, 0x2A, 0xDD, 0x19, 0x05, 0x7E, 
+0x45, 0x5B, 0x4B, 0x07, 0xE5, 0x98, 0x06, 0xDE, 
+0xB7, 0x60, 0xE1, 0x4D, 0x35, 0xE7, 0x15, 0x76, 
+0x7F, 0x8D, 0x72, 0xED, 0xFC, 0xAB, 0x80, 0xDC, 
+0x32, 0x01, 0x73, 0xFA, 0x85, 0x71, 0x0D, 0x58, 
+0xE1, 
+
+0x00, 0xF4, 0xA9, 0x54, 0x90, 0xFC, 0x2B, 0x16, 
+0xE9, 0x20, 0x32, 0x40, 0x31, 0x40, 0x9C, 0x48, 
+0x03, 0x90, 0x09, 0xE9, 0x37, 0x13, 0x59, 0xE8, 
+0xE2, 0x62, 0x88, 0x71, 0xBF, 0xE5, 0xE4, 0xE8, 
+0xFC, 0x22, 0xEB, 0x1C, 0x8C, 0xC5, 0x18, 0xE1, 
+0x3A, 0xB8, 0xBA, 0x16, 0x58, 0x9B, 0xAF, 0x4C, 
+0xF7, 0x45, 0xA7, 0x00, 0x6B, 0x44, 0xA1, 0x7D, 
+0xE7, 0x1E, 0x8E, 0x54, 0xAF, 0x92, 0x7D, 0xB4, 
+0x7B, 0x5A, 0x3D, 0xFA, 0x65, 0xD3, 0x9C, 0x99, 
+0xF8, 0x39, 0x57, 0x21, 0x68, 0x8A, 0x9E, 0x4C, 
+0x53, 0xE0, 0xE7, 0xCC, 0xA5, 0x86, 0x69, 0x40, 
+0x44, 0xDC, 0xAE, 0x7D, 0x2E, 0xE6, 0xB8, 0xD1, 
+0x80, 0x17, 0xEA, 0xAE, 0x20, 0x85, 0x7E, 0x71, 
+0x50, 0x9A, 0x2F, 0x63, 0x6D, 0x5E, 0x97, 0x8E, 
+0x45, 0x85, 0x01, 0xC3, 0x0A, 0x5B, 0xA3, 0xA8, 
+0x4A, 0x61, 0xE5, 0xBC, 0x5B, 0x29, 0x19, 0x84, 
+0x25, 0x7D, 0xB5, 0xF0, 0x20, 0xAD, 0x70, 0x4E, 
+0x7B, 0xCF, 0x0B, 0x36, 0xE7, 0x7D, 0xE7, 0x39, 
+0xFE, 0xFC, 0xE6, 0x63, 0x38, 0x84, 0x90, 0xA3, 
+0x95, 0x41, 0xC8, 0x56, 0xDE, 0xE2, 0x53, 0x7E, 
+0x06, 0xC0, 0xEB, 0x02, 0x3D, 0x23, 0xD7, 0xFC, 
+0x5D, 0xE0, 0x74, 0x4E, 0x32, 0x02, 0x77, 0x6B, 
+0xA6, 0x2C, 0x40, 0x6E, 0x73, 0xA5, 0xD5, 0xAB, 
+0x77, 0xD3, 0x92, 0xF5, 0x21, 0x05, 0x36, 0x42, 
+0xE1, 0x69, 0xBA, 0xD6, 0xF1, 0x96, 0xFC, 0x27, 
+0x34, 0xBE, 0xCD, 0xE1, 0x7B, 0x35, 0x90, 0xBA, 
+0x7B, 0x69, 
+
+0xBB, 0xCC, 0x6E, 0x52, 0xF6, 0x9F, 0x87, 0x4F, 
+0x81, 0x24, 0x49, 0x08, 0x0B, 0xB0, 0xEA, 0x1D, 
+0xBC, 0x08, 0x36, 0xD2, 0xD8, 0xAB, 0x55, 0x14, 
+0xF4, 0xBC, 0x7E, 0x7A, 0x6D, 0x8B, 0x4B, 0x2F, 
+0x4D, 0x51, 0xA5, 0xE5, 0xC3, 0x61, 0x7E, 0xE0, 
+0x43, 0xFF, 0xC1, 0x40, 0xF7, 0xF2, 0x97, 0x0B, 
+0xBD, 0x6E, 0xA0, 0xA5, 0x7C, 0x04, 0x97, 0x72, 
+0x20, 0x1B, 0xA3, 0xC9, 0x8E, 0xDB, 0x4C, 0x73, 
+0x4C, 0x27, 0xFD, 0xBD, 0x47, 0x43, 0x38, 0x9C, 
+0x09, 0xFC, 0xB1, 0x9E, 0x43, 0x57, 0x3D, 0xC7, 
+0x78, 0xC3, 0x31, 0x3D, 0xD8, 0x85, 0x01, 0x0D, 
+0x31, 0xCD, 0xF6, 0x16, 0x56, 0xA7, 0xA1, 0x57, 
+0xF2, 0xFB, 0xF8, 0x90, 0xE2, 0xA8, 0x71, 0x42, 
+0x9B, 0xF8, 0x73, 0x7A, 0x7D, 0x00, 0x65, 0xD0, 
+0xA8, 0xAB, 0x59, 0x33, 0xF6, 0x27, 0x8B, 0xB7, 
+0xC2, 0x6F, 0x68, 0x4D, 0xC2, 0xE0, 0x5F, 0x9D, 
+0x6F, 0xE1, 0x6B, 0x2B, 0xCE, 0xB8, 0x3D, 0x23, 
+0x0F, 0xD1, 0xA1, 0x19, 0x09, 0xA5, 0x4E, 0xD9, 
+0x41, 0x2D, 0x0C, 0x03, 0xFA, 0x1A, 0xD2, 0xCD, 
+0x1A, 0x31, 0x5D, 0x91, 0x04, 0x4B, 0x48, 0x4C, 
+0x35, 0xF9, 0x02, 0x9E, 0x77, 0x4F, 0x84, 0x31, 
+0xAF, 0x78, 0x6A, 0xC1, 0x3D, 0xAF, 0xB7, 0x8C, 
+0x6E, 0x9B, 0x29, 0xB5, 0xC2, 0x53, 0x92, 0x73, 
+0xF2, 0x9B, 0x33, 0xF9, 0x84, 0x43, 0xAD, 0xF0, 
+0x7B, 0xA1, 0x18, 0xF9, 0xB9, 0xB6, 0xCB, 0xC4, 
+0x5A, 0xEF, 0xFB, 0xB3, 0x31, 0xA0, 0xDF, 0x0F, 
+0xE1, 0x5D, 0xC6, 
+
+0x2A, 0x9B, 0x6A, 0xD8, 0x96, 0x1D, 0x5F, 0x33, 
+0xCB, 0x72, 0xA9, 0x04, 0xB9, 0x43, 0xA0, 0x66, 
+0xF8, 0xC0, 0xA6, 0xB6, 0x01, 0x90, 0x32, 0xAD, 
+0x4A, 0xCA, 0x51, 0xE5, 0x20, 0x60, 0x9C, 0xC2, 
+0x11, 0x1B, 0xFA, 0xB3, 0x9D, 0xD2, 0x9C, 0xC7, 
+0xD1, 0x69, 0xEF, 0x99, 0xB6, 0x49, 0xFE, 0xFE, 
+0x7D, 0x51, 0x09, 0xD9, 0xF1, 0xAA, 0x4A, 0xDC, 
+0x90, 0xE8, 0xEB, 0xD0, 0x97, 0x71, 0x3E, 0x2F, 
+0x78, 0x58, 0x19, 0x65, 0x0A, 0x31, 0x24, 0xBA, 
+0x69, 0x42, 0xD9, 0x44, 0xF8, 0xE1, 0x99, 0x8A, 
+0x00, 0x8D, 0x28, 0xBD, 0x53, 0xC4, 0x13, 0x75, 
+0xA4, 0x83, 0xB2, 0x84, 0xCD, 0xD0, 0x87, 0x7E, 
+0x47, 0x99, 0x4A, 0x36, 0xD7, 0x5F, 0x0D, 0x4B, 
+0xF1, 0x9E, 0x83, 0xEE, 0xFB, 0x93, 0x85, 0xFB, 
+0x71, 0x20, 0x84, 0xB0, 0x2C, 0xF7, 0xA4, 0x0B, 
+0xAD, 0xB4, 0x8A, 0x06, 0x2F, 0x7C, 0x9F, 0x4C, 
+0x87, 0x4A, 0x43, 0xF5, 0x0D, 0x0C, 0x27, 0xD6, 
+0xE5, 0x7E, 0xBA, 0x97, 0x6D, 0xA8, 0x3C, 0x20, 
+0x73, 0xDF, 0xA2, 0x9F, 0xEB, 0xAC, 0x9E, 0x2A, 
+0x6D, 0xB8, 0x60, 0x3D, 0x20, 0x9D, 0xD9, 0x4F, 
+0x41, 0x2F, 0x95, 0xA4, 0xE4, 0x27, 0x2D, 0x53, 
+0xF0, 0xA3, 0x24, 0xAC, 0x52, 0x60, 0x26, 0xDA, 
+0xA2, 0xD7, 0x51, 0x5B, 0xDD, 0x47, 0x48, 0xEE, 
+0x54, 0x05, 0xB9, 0x05, 0x0B, 0x2B, 0xB4, 0xF1, 
+0x1F, 0x06, 0x6D, 0x42, 0xFA, 0x52, 0xD5, 0x7F, 
+0xF8, 0x0A, 0x34, 0x4E, 0x52, 0x93, 0xCB, 0x4D, 
+0x63, 0x64, 0xF9, 0x39, 
+
+0x79, 0xBC, 0x0B, 0xE8, 0x63, 0x48, 0xE9, 0x41, 
+0xF8, 0x37, 0xDE, 0x47, 0x5F, 0xDE, 0x99, 0x77, 
+0x58, 0x24, 0x25, 0x9C, 0xBF, 0x5C, 0x6E, 0xE4, 
+0xB0, 0xF3, 0xA3, 0xC8, 0xC4, 0xAC, 0x6D, 0x2E, 
+0x86, 0x65, 0xC3, 0x7D, 0xF5, 0x64, 0xF4, 0xE3, 
+0x1A, 0x03, 0xBB, 0x9D, 0x98, 0x9D, 0x36, 0xC0, 
+0x3A, 0x4A, 0x0B, 0x03, 0xC1, 0x60, 0x02, 0xA9, 
+0xCB, 0x04, 0x67, 0x48, 0xE3, 0x2C, 0x76, 0x95, 
+0x6C, 0x86, 0x2B, 0xED, 0x64, 0xA9, 0x15, 0x8A, 
+0x85, 0xC0, 0xC5, 0x8A, 0x4E, 0x1C, 0x0A, 0x16, 
+0x01, 0xF1, 0x5C, 0xB1, 0x0A, 0x35, 0x78, 0xFA, 
+0xD6, 0x60, 0x8A, 0x0E, 0xBD, 0x62, 0xFD, 0xCB, 
+0x2E, 0xAD, 0x33, 0x3B, 0xDF, 0x7C, 0x2A, 0xA2, 
+0xDC, 0xDA, 0xDD, 0x34, 0x67, 0xAC, 0x89, 0xCB, 
+0x9D, 0x04, 0xED, 0xBF, 0xF5, 0x5E, 0x91, 0x9A, 
+0xBC, 0xB8, 0xEC, 0x73, 0x7A, 0xAA, 0xF2, 0x8B, 
+0xE5, 0x32, 0x65, 0x4C, 0xD9, 0x06, 0x61, 0x5E, 
+0xE8, 0xFF, 0x29, 0x58, 0x68, 0x23, 0xE5, 0xAC, 
+0xE1, 0x8A, 0x7C, 0x59, 0xFD, 0x25, 0xE9, 0x29, 
+0xC0, 0xD1, 0x7D, 0xE2, 0xBE, 0x2E, 0x76, 0x4D, 
+0x06, 0x65, 0xED, 0xAC, 0x34, 0x54, 0xEF, 0xE7, 
+0x30, 0xC4, 0xFD, 0xCB, 0x17, 0x86, 0x91, 0x9C, 
+0x27, 0xB6, 0xFB, 0x63, 0xD6, 0xFB, 0x12, 0x51, 
+0xF6, 0x8F, 0x60, 0x24, 0xC2, 0x60, 0xC7, 0xFA, 
+0xFB, 0x77, 0xD6, 0xA4, 0x52, 0x63, 0xBD, 0x6F, 
+0x82, 0x13, 0x65, 0x70, 0xA6, 0x15, 0x2A, 0x8B, 
+0xDD, 0x91, 0xD7, 0x9D, 0x1C, 
+
+0x32, 0x7E, 0xE4, 0x25, 0x91, 0x0E, 0x03, 0x5E, 
+0x8B, 0xEC, 0x69, 0x7C, 0x5D, 0x5A, 0x57, 0x29, 
+0x1F, 0x04, 0x68, 0xE8, 0x1C, 0xF2, 0x01, 0xED, 
+0xF1, 0xF9, 0xD1, 0xA6, 0xAB, 0x0C, 0x59, 0xCC, 
+0x43, 0x7F, 0x9A, 0xDB, 0x58, 0xD5, 0x07, 0xAA, 
+0x7D, 0x56, 0x57, 0xD7, 0xA8, 0x6A, 0xA1, 0xBE, 
+0x07, 0xBC, 0x22, 0x33, 0xB2, 0xE3, 0x26, 0x2E, 
+0x11, 0x14, 0xED, 0x20, 0x20, 0x65, 0xAC, 0x8E, 
+0x31, 0xD2, 0x53, 0xFC, 0xCC, 0xD6, 0x49, 0xE6, 
+0xA9, 0xC8, 0xAD, 0x84, 0x38, 0x27, 0xB1, 0x8B, 
+0x51, 0x3A, 0x3B, 0xCC, 0x8F, 0x26, 0x3C, 0x63, 
+0x32, 0xA3, 0xE8, 0x1E, 0x06, 0x61, 0xB7, 0x90, 
+0xB2, 0x5E, 0xBE, 0xA7, 0x56, 0xF5, 0xCD, 0x40, 
+0x64, 0x12, 0x70, 0xC9, 0xBC, 0x1C, 0x93, 0x0C, 
+0x70, 0x37, 0x5F, 0xB6, 0xCF, 0xA1, 0x48, 0x88, 
+0x19, 0x89, 0xF8, 0xFF, 0x56, 0x64, 0x8C, 0x1A, 
+0xC3, 0xDB, 0xE0, 0x4F, 0x36, 0x74, 0x84, 0x48, 
+0xC2, 0xAF, 0x07, 0xB4, 0x96, 0x8D, 0x93, 0x21, 
+0x79, 0x9D, 0x15, 0x19, 0x6C, 0x87, 0x44, 0xD7, 
+0x86, 0x16, 0xBA, 0x8B, 0xD7, 0x9D, 0x98, 0x2D, 
+0x7A, 0x39, 0xB1, 0x93, 0x58, 0x83, 0xCE, 0x91, 
+0x9A, 0xD1, 0x03, 0xF9, 0xBE, 0xBA, 0xF3, 0x71, 
+0xCF, 0x44, 0x8B, 0x55, 0xF2, 0x8A, 0x4B, 0xB8, 
+0x9B, 0x8E, 0x34, 0xFC, 0x20, 0xD4, 0x0B, 0xB3, 
+0x29, 0x28, 0x40, 0x61, 0x33, 0x78, 0x87, 0xBA, 
+0x3D, 0x60, 0xAE, 0xF3, 0xC0, 0x95, 0x1C, 0x11, 
+0xFE, 0x5B, 0xB6, 0x8B, 0x4A, 0xAB, 
+
+0x7C, 0xBE, 0xE9, 0x59, 0x99, 0x1C, 0x14, 0xB2, 
+0x30, 0x7A, 0x7C, 0x80, 0x57, 0x56, 0xD0, 0x97, 
+0x96, 0x08, 0xD2, 0x1C, 0x12, 0xA1, 0x03, 0xC3, 
+0x68, 0x01, 0xB7, 0xD7, 0x65, 0xCE, 0xAF, 0xC8, 
+0xA1, 0xA7, 0xD8, 0xB2, 0x3D, 0x3B, 0xFF, 0x12, 
+0x82, 0x1C, 0x9F, 0x4A, 0x44, 0xE9, 0xCC, 0xC4, 
+0x1F, 0x92, 0x6F, 0x16, 0xE2, 0xA8, 0x25, 0x2E, 
+0xC4, 0x22, 0x1E, 0x0F, 0x38, 0xE4, 0xBC, 0x83, 
+0xA9, 0x2E, 0xA9, 0x94, 0x76, 0xAF, 0x48, 0xE1, 
+0xD6, 0x4C, 0x98, 0xD6, 0x24, 0xF8, 0x3D, 0xFA, 
+0xE1, 0x03, 0x4B, 0x6C, 0x5E, 0xDB, 0x82, 0x50, 
+0x8D, 0x5C, 0x49, 0x71, 0xDB, 0x92, 0x0C, 0xD1, 
+0xA2, 0x58, 0x41, 0xF5, 0xBB, 0x63, 0x28, 0x90, 
+0x51, 0xD7, 0xEB, 0xE6, 0x2D, 0xC9, 0x64, 0x9F, 
+0xAD, 0x0A, 0x2D, 0x95, 0xC8, 0x98, 0x17, 0x3A, 
+0x40, 0xB6, 0xB8, 0x3F, 0x33, 0xFC, 0x68, 0x71, 
+0x55, 0xD6, 0xB8, 0xC3, 0xD1, 0x2B, 0xE5, 0x94, 
+0x27, 0x03, 0xDC, 0x3B, 0xBE, 0xE8, 0x2F, 0xF8, 
+0x49, 0x72, 0xB9, 0x72, 0x3C, 0xEB, 0x84, 0x12, 
+0x95, 0x45, 0xBE, 0x00, 0xE3, 0x90, 0x8E, 0x93, 
+0xC4, 0x95, 0x29, 0x35, 0x53, 0x10, 0xD4, 0x73, 
+0xAB, 0xDE, 0x45, 0x6E, 0x7C, 0xE2, 0x6B, 0xEF, 
+0x45, 0x58, 0xBC, 0x07, 0x7E, 0x10, 0x49, 0xB7, 
+0xC4, 0x06, 0xF5, 0x87, 0xC9, 0x4F, 0x56, 0x1C, 
+0x18, 0x6E, 0xAC, 0x1A, 0x56, 0xED, 0x4E, 0xCA, 
+0xEF, 0xC9, 0x58, 0x0B, 0xAA, 0x19, 0xC5, 0x31, 
+0x48, 0xFB, 0x45, 0x02, 0x8B, 0xA7, 0x54, 
+
+0x2D, 0xBA, 0x5B, 0x05, 0x42, 0x94, 0x71, 0xF7, 
+0xE4, 0x13, 0x4D, 0xDC, 0xFC, 0x96, 0xDF, 0x1F, 
+0xDB, 0xDB, 0x54, 0x2D, 0x6F, 0xDC, 0xFA, 0xB3, 
+0x2D, 0xF4, 0xED, 0x65, 0x8F, 0x1E, 0x43, 0x0F, 
+0xB8, 0xB8, 0xBD, 0x41, 0xD3, 0x3F, 0x98, 0xFC, 
+0x40, 0x79, 0xD1, 0x01, 0x01, 0x23, 0xDA, 0xDC, 
+0x77, 0x5B, 0xC5, 0x91, 0xDF, 0xAD, 0xB2, 0x0A, 
+0x48, 0xFE, 0xB0, 0xAC, 0xD6, 0xF2, 0x0D, 0xAB, 
+0x43, 0x83, 0xCF, 0xA7, 0x05, 0x18, 0x32, 0x91, 
+0xAD, 0xAE, 0xF5, 0xF5, 0x2F, 0x4E, 0x38, 0x7A, 
+0x2E, 0xB0, 0x42, 0x7F, 0x39, 0x00, 0x21, 0x3E, 
+0xA5, 0xB5, 0x6E, 0xF1, 0x4F, 0x13, 0xDE, 0xF0, 
+0x9D, 0x80, 0xF6, 0x48, 0x53, 0x45, 0x67, 0xE8, 
+0x17, 0x0A, 0xA0, 0xFF, 0x0E, 0x68, 0xA3, 0x7D, 
+0x7D, 0x96, 0xF2, 0x41, 0x4C, 0x4B, 0x71, 0xDA, 
+0xC9, 0x73, 0xB1, 0xB5, 0x72, 0x6F, 0x58, 0x1A, 
+0xF8, 0xEB, 0x8F, 0x22, 0xFB, 0xAA, 0xB9, 0x38, 
+0xFA, 0x38, 0xB4, 0x59, 0xA6, 0x3C, 0x09, 0xA4, 
+0xEC, 0xDE, 0x7C, 0x38, 0xFC, 0xE8, 0xD0, 0xAA, 
+0x14, 0xB8, 0xAB, 0x2B, 0xE8, 0xAD, 0xA7, 0x7A, 
+0x39, 0x5C, 0x32, 0x03, 0xB7, 0x77, 0x87, 0xF4, 
+0xF5, 0x69, 0x95, 0x5C, 0x6A, 0x85, 0x8A, 0xE6, 
+0x47, 0x68, 0x3B, 0xE7, 0xC4, 0xBF, 0xA8, 0x6F, 
+0xC5, 0x59, 0x8C, 0xAB, 0x91, 0x60, 0x1B, 0x05, 
+0xD3, 0x91, 0x7E, 0x10, 0x27, 0x1C, 0xD4, 0x94, 
+0x19, 0x8A, 0x69, 0xF5, 0xEF, 0x67, 0x17, 0x0C, 
+0x55, 0xD7, 0x96, 0xC4, 0x3C, 0x48, 0x24, 0xDA, 
+
+0xF6, 0x22, 0x3C, 0x95, 0x30, 0xAE, 0x63, 0xF4, 
+0x51, 0x06, 0xA1, 0xBB, 0xD6, 0xEE, 0xBD, 0xAD, 
+0x54, 0x02, 0x6E, 0x36, 0x1A, 0x4A, 0x8E, 0xF6, 
+0x6F, 0x53, 0x50, 0xB0, 0x61, 0x22, 0x77, 0x04, 
+0xFE, 0xA7, 0xC7, 0xCC, 0x36, 0x61, 0x11, 0xF1, 
+0x94, 0x54, 0x47, 0x76, 0xB0, 0x2E, 0x68, 0x4F, 
+0x47, 0x62, 0xB9, 0x55, 0x11, 0x91, 0x38, 0xA1, 
+0x02, 0x48, 0x35, 0xE7, 0x59, 0xAC, 0xF8, 0x3A, 
+0xC0, 0x70, 0xC6, 0x38, 0xBC, 0xE2, 0x65, 0xBF, 
+0x33, 0xC9, 0xEC, 0x11, 0x64, 0xCD, 0x5B, 0x85, 
+0xBA, 0x5C, 0xE1, 0x68, 0xC2, 0x30, 0xD6, 0x57, 
+0x8F, 0x4A, 0x54, 0x3A, 0x4C, 0x39, 0x2C, 0xC2, 
+0xF4, 0x9F, 0xFE, 0x89, 0x32, 0x71, 0xEE, 0xD6, 
+0x84, 0x2E, 0x97, 0x5A, 0x5F, 0x19, 0x5A, 0xF6, 
+0xEF, 0x9D, 0xAD, 0x57, 0x68, 0x3B, 0xA0, 0xF6, 
+0xDE, 0x11, 0xF3, 0x7D, 0xE3, 0xFC, 0xDC, 0x38, 
+0x6F, 0x49, 0x9D, 0xB4, 0x44, 0x88, 0xE5, 0xB0, 
+0xD3, 0x3B, 0xE2, 0xA7, 0x6C, 0x7E, 0x67, 0x3C, 
+0xBB, 0x65, 0x20, 0x51, 0xB4, 0x4F, 0x6E, 0xD5, 
+0x4F, 0x35, 0x7E, 0x5A, 0x40, 0x0D, 0x70, 0x7C, 
+0x1E, 0x12, 0xFF, 0x97, 0x35, 0xCC, 0x56, 0x75, 
+0xED, 0xFE, 0xDA, 0xA1, 0x2A, 0x67, 0x05, 0x0F, 
+0x71, 0xFE, 0x61, 0xF8, 0x32, 0xD1, 0x6B, 0x8F, 
+0x48, 0x59, 0xAA, 0x30, 0x83, 0x23, 0x2F, 0xBE, 
+0xC2, 0x67, 0x6F, 0xD8, 0xD1, 0xEF, 0xD3, 0x12, 
+0xD9, 0x73, 0xF0, 0x5D, 0xFD, 0x56, 0x9B, 0x1A, 
+0x2E, 0x26, 0xA5, 0x11, 0x31, 0x3C, 0xF9, 0x4B, 
+0x72, 
+
+0x2A, 0x9C, 0xA2, 0x31, 0x06, 0x55, 0x94, 0xAD, 
+0x81, 0xB2, 0x8A, 0x9E, 0xE9, 0x76, 0xDD, 0x3C, 
+0x36, 0x36, 0x5D, 0x04, 0x22, 0x42, 0xA5, 0x2D, 
+0xDE, 0x87, 0x03, 0x3E, 0x1A, 0x15, 0xFF, 0xBA, 
+0x98, 0xBF, 0x34, 0x79, 0x30, 0x19, 0xF0, 0xC5, 
+0xB2, 0xBF, 0xDE, 0xF8, 0x65, 0x63, 0x78, 0x37, 
+0xE9, 0xE7, 0x00, 0x8E, 0x81, 0xCC, 0xA1, 0xAA, 
+0x91, 0x0D, 0x36, 0x73, 0x3D, 0x36, 0xD7, 0xCC, 
+0xBC, 0x19, 0x62, 0x6B, 0xD8, 0xB6, 0xE6, 0x23, 
+0x0D, 0x6F, 0xF4, 0x1B, 0x52, 0x86, 0x4C, 0x40, 
+0x85, 0x2B, 0xB7, 0x58, 0x6B, 0xBE, 0xB5, 0xBF, 
+0xF2, 0x6B, 0x31, 0xCD, 0xE1, 0x52, 0x49, 0x6D, 
+0x76, 0x8B, 0xEF, 0x18, 0x68, 0x12, 0x08, 0x87, 
+0xE5, 0x3F, 0xF8, 0xA0, 0xCA, 0x6B, 0x2C, 0xF6, 
+0x9B, 0xDD, 0xD4, 0x6B, 0x24, 0xB4, 0x4C, 0x6F, 
+0xEF, 0x4D, 0x41, 0xA6, 0x9E, 0x5D, 0x7E, 0xB2, 
+0x7F, 0x32, 0x33, 0x69, 0x3F, 0xB5, 0xF3, 0x22, 
+0x4A, 0x25, 0xCC, 0xF6, 0x9D, 0x5E, 0xB1, 0xF0, 
+0xA1, 0x2F, 0x4A, 0x81, 0x06, 0xEF, 0x28, 0xA8, 
+0x76, 0x9E, 0x12, 0x2E, 0x9D, 0xF9, 0xB7, 0x00, 
+0xB3, 0x0E, 0x74, 0x80, 0xAA, 0x6F, 0x0C, 0xBE, 
+0xB1, 0x3F, 0xAA, 0x2E, 0x4E, 0xF6, 0xAB, 0x8D, 
+0x79, 0x16, 0xE7, 0xE1, 0x62, 0x69, 0xDF, 0x9F, 
+0xC5, 0x33, 0xAB, 0xC3, 0x36, 0xB0, 0xAF, 0xC7, 
+0x9C, 0xA0, 0xD2, 0x86, 0x42, 0xB8, 0xB5, 0x2E, 
+0x2A, 0x5F, 0xFA, 0x1B, 0x10, 0x4D, 0xEF, 0x8A, 
+0xF4, 0xE6, 0x8F, 0x65, 0xB5, 0x6F, 0x83, 0x30, 
+0x47, 0x33, 
+
+0xA1, 0xF7, 0x27, 0x56, 0xE7, 0xBC, 0x35, 0xE5, 
+0xE1, 0xE1, 0xCA, 0xF1, 0xB9, 0x4B, 0x2A, 0x1E, 
+0xB7, 0xFA, 0x14, 0xD8, 0x71, 0xAA, 0x09, 0xD6, 
+0xF1, 0x15, 0x03, 0x23, 0xC6, 0x36, 0xC2, 0x07, 
+0xAB, 0xCF, 0x6B, 0x3E, 0x16, 0xEE, 0x55, 0x1D, 
+0x0C, 0xFF, 0x6E, 0x51, 0x39, 0x20, 0x65, 0x5F, 
+0x6A, 0x1F, 0x9A, 0x85, 0x12, 0x6F, 0x22, 0xA8, 
+0x41, 0x28, 0xA9, 0x5A, 0x74, 0x31, 0x0E, 0xAC, 
+0x43, 0xF7, 0xC1, 0x8A, 0x53, 0x23, 0x7F, 0xAE, 
+0xAB, 0x9F, 0x77, 0x3D, 0xA6, 0xB5, 0xCB, 0xD8, 
+0x91, 0x9C, 0x73, 0xAF, 0x3A, 0x94, 0x99, 0xD7, 
+0xCE, 0x52, 0xA2, 0x9F, 0x42, 0x3D, 0x62, 0x14, 
+0x70, 0xEF, 0x69, 0x85, 0x85, 0x95, 0x3A, 0x87, 
+0xE0, 0x6E, 0xCA, 0xAD, 0x01, 0xE6, 0xCE, 0x27, 
+0x91, 0x83, 0x6A, 0x25, 0xC9, 0x45, 0xDE, 0x8E, 
+0xE0, 0x1A, 0x72, 0x04, 0x40, 0x50, 0xB0, 0x46, 
+0x6C, 0x78, 0x4A, 0xDA, 0x40, 0xB0, 0xCD, 0x99, 
+0xB8, 0x1D, 0xAE, 0x33, 0x8B, 0x9A, 0xA2, 0x86, 
+0xB2, 0x96, 0x29, 0xDB, 0xD6, 0x3C, 0x6F, 0x37, 
+0x20, 0x2B, 0x6E, 0x41, 0x1A, 0xF1, 0x2E, 0x34, 
+0x12, 0xF0, 0x03, 0x7D, 0x9B, 0x3B, 0x5B, 0x25, 
+0xCC, 0xDB, 0x50, 0x4A, 0x4F, 0x7F, 0xDF, 0xF2, 
+0x75, 0x89, 0x35, 0x8E, 0x08, 0x4E, 0x8E, 0x1E, 
+0x03, 0x22, 0xBD, 0x17, 0x4F, 0x70, 0x7F, 0xFE, 
+0x31, 0x75, 0x47, 0x46, 0xC9, 0xE3, 0xDA, 0x3C, 
+0x66, 0x45, 0x13, 0xD9, 0xF9, 0x4E, 0x71, 0xE8, 
+0xEB, 0xCA, 0xC8, 0x31, 0x97, 0xA0, 0x4A, 0x86, 
+0x4F, 0x72, 0x75, 
+
+0x08, 0x37, 0x41, 0x13, 0xFF, 0x45, 0xF6, 0x90, 
+0x02, 0x9B, 0x2B, 0xFC, 0xA1, 0x67, 0xD3, 0x8E, 
+0x78, 0x78, 0x29, 0xC6, 0x9F, 0x22, 0x76, 0x92, 
+0x6D, 0xC2, 0x6D, 0x99, 0xAE, 0x38, 0xD6, 0x33, 
+0x73, 0xF9, 0x73, 0xA5, 0x28, 0x41, 0x0B, 0x31, 
+0xD7, 0xB0, 0x40, 0xDF, 0x70, 0x5C, 0x65, 0x26, 
+0xBB, 0x34, 0x3C, 0xA2, 0x30, 0xBC, 0x57, 0x4A, 
+0x4A, 0x46, 0x4B, 0xFE, 0xE6, 0x3C, 0x2B, 0x11, 
+0x5E, 0xDC, 0x71, 0xE7, 0x77, 0x2A, 0xEC, 0x31, 
+0xBC, 0x14, 0x62, 0x19, 0x45, 0x0E, 0x07, 0xBA, 
+0x34, 0x0B, 0xBB, 0x27, 0x1B, 0x5F, 0x4B, 0xC4, 
+0xBC, 0x95, 0x65, 0x66, 0xCF, 0xEF, 0x1C, 0xF9, 
+0xB0, 0x96, 0x39, 0x9D, 0x92, 0xB4, 0x2E, 0x56, 
+0x78, 0x90, 0x70, 0xD2, 0x46, 0x16, 0x01, 0x3C, 
+0x41, 0x6E, 0x1D, 0x40, 0x85, 0xA4, 0x3D, 0xA7, 
+0x3A, 0xC9, 0x14, 0xB7, 0x16, 0xD8, 0xD4, 0x70, 
+0x6E, 0x7D, 0x56, 0x21, 0x9C, 0x4A, 0xF6, 0x06, 
+0x41, 0x31, 0xDA, 0xCE, 0xD8, 0x09, 0x1B, 0x62, 
+0xC0, 0xE4, 0x58, 0xB9, 0xBD, 0xBF, 0x5D, 0x16, 
+0x41, 0x07, 0xE2, 0x46, 0x28, 0x00, 0xE8, 0xFD, 
+0x5A, 0x82, 0xD0, 0x9D, 0xD0, 0xC4, 0x4D, 0xC8, 
+0x5F, 0x26, 0xAA, 0x9C, 0xDA, 0x1F, 0x50, 0x82, 
+0x7B, 0x28, 0x50, 0xE7, 0x85, 0x70, 0x4A, 0xDC, 
+0xC3, 0x5B, 0xD2, 0x94, 0x73, 0xC9, 0xA4, 0x5C, 
+0xA0, 0x36, 0xEF, 0x1A, 0xA6, 0x4A, 0xAD, 0xA4, 
+0x7E, 0xD2, 0xA5, 0xE0, 0x8B, 0xDB, 0x75, 0x65, 
+0x64, 0x8F, 0x08, 0x07, 0x54, 0x91, 0x31, 0xD2, 
+0xD7, 0x0A, 0xE3, 0x8A, 
+
+0x52, 0x56, 0x7D, 0xE3, 0x6F, 0xF6, 0xB2, 0x9D, 
+0xC4, 0xAB, 0xAB, 0xF7, 0x9A, 0x99, 0xFC, 0xD8, 
+0xBE, 0x70, 0xBE, 0x6E, 0xAE, 0x52, 0x81, 0x25, 
+0x1B, 0x7B, 0xEC, 0x03, 0xE8, 0xA6, 0xFC, 0xB5, 
+0x49, 0x9E, 0xBC, 0x68, 0x53, 0xB9, 0x05, 0xC2, 
+0x95, 0x3F, 0xA3, 0x93, 0x95, 0x5D, 0x56, 0x4B, 
+0x8C, 0xAF, 0x24, 0x5E, 0x4F, 0xE8, 0xAC, 0xE3, 
+0x11, 0x0C, 0x74, 0x3B, 0xCD, 0x88, 0x14, 0xE0, 
+0x7B, 0x0B, 0x1D, 0x6A, 0xBA, 0x7A, 0x87, 0x9A, 
+0xAC, 0xC2, 0x89, 0xE5, 0x15, 0x03, 0x05, 0xC7, 
+0x88, 0xD8, 0x62, 0x58, 0xB5, 0xFB, 0xE9, 0xD5, 
+0x2D, 0x07, 0x70, 0x64, 0x93, 0x92, 0x9D, 0xF3, 
+0xC7, 0xD7, 0xEB, 0x97, 0xF5, 0xC6, 0x6D, 0xD2, 
+0xD0, 0x4C, 0x13, 0x98, 0x54, 0x03, 0xD2, 0x05, 
+0x5E, 0xBF, 0x79, 0x58, 0x4B, 0x2C, 0x12, 0x16, 
+0x73, 0x9A, 0x74, 0x03, 0x8A, 0x7D, 0xD0, 0x0F, 
+0x27, 0x1E, 0x57, 0x91, 0xD8, 0xB4, 0xE8, 0xFF, 
+0xC7, 0x76, 0xA3, 0x2A, 0x1F, 0x7C, 0x2B, 0x3B, 
+0x72, 0xF8, 0x80, 0xEE, 0xD0, 0x22, 0xCF, 0x48, 
+0x68, 0x64, 0x63, 0x50, 0xFE, 0x69, 0x13, 0xD7, 
+0x65, 0xEE, 0xAD, 0x06, 0xCF, 0xA2, 0x28, 0x12, 
+0x51, 0xD2, 0xEC, 0x7B, 0xC8, 0xEE, 0x68, 0xF8, 
+0xEF, 0xDF, 0x4F, 0xD4, 0x12, 0x6A, 0x5F, 0xA6, 
+0xB6, 0xA3, 0x9B, 0x84, 0x87, 0x79, 0x79, 0xD3, 
+0xAB, 0xEC, 0xFB, 0x79, 0x59, 0xD7, 0xDF, 0x29, 
+0xD5, 0x15, 0x0B, 0x1D, 0xBC, 0xFD, 0x4F, 0x13, 
+0xE5, 0xE4, 0xF4, 0x59, 0x08, 0x69, 0x80, 0xF0, 
+0x79, 0x7D, 0xC7, 0xE2, 0xB0, 
+
+0x0D, 0x41, 0x19, 0xC4, 0x4B, 0xFB, 0xD7, 0x74, 
+0x2F, 0xE1, 0x58, 0xC3, 0x9C, 0x37, 0xC1, 0xC7, 
+0x76, 0xEC, 0x41, 0x9C, 0x8E, 0x56, 0xA7, 0x8B, 
+0x0F, 0xBC, 0x8D, 0x6F, 0xA5, 0xD4, 0xBA, 0x32, 
+0xEF, 0x5B, 0x7B, 0x8F, 0x61, 0xE5, 0x5A, 0xBF, 
+0xE6, 0x55, 0x6A, 0x3F, 0x10, 0x83, 0x3A, 0x63, 
+0xBB, 0xEF, 0x30, 0x79, 0x44, 0xA2, 0x8D, 0x52, 
+0xB6, 0x83, 0x63, 0x48, 0x8B, 0x93, 0x71, 0xC5, 
+0x4C, 0xAD, 0x81, 0x38, 0x08, 0x24, 0x63, 0x53, 
+0x6C, 0x78, 0xAD, 0x70, 0xD1, 0x17, 0x35, 0x5A, 
+0x54, 0xC0, 0x7E, 0x72, 0xA4, 0xF4, 0xE9, 0x0B, 
+0x03, 0xDF, 0x28, 0x5D, 0x2D, 0xF9, 0x49, 0x28, 
+0xB7, 0x8F, 0x0D, 0x13, 0xE5, 0xB4, 0xF0, 0xCE, 
+0xA9, 0x3F, 0x6B, 0xDB, 0xA5, 0x30, 0x0C, 0xAD, 
+0xC4, 0xFD, 0x77, 0xA1, 0xDF, 0xEF, 0xCC, 0x82, 
+0x08, 0xD0, 0x63, 0x7E, 0x8A, 0x65, 0x63, 0x9A, 
+0x71, 0x02, 0xD8, 0xDF, 0x26, 0xAD, 0xC8, 0x3D, 
+0xF0, 0x44, 0x1E, 0x7B, 0x2D, 0xD9, 0x43, 0x49, 
+0x64, 0x3F, 0x07, 0xC2, 0xDB, 0xDF, 0xA8, 0x87, 
+0x2E, 0xC8, 0x8B, 0xD4, 0xCF, 0x3A, 0xC7, 0x47, 
+0x5C, 0x7C, 0xF4, 0x31, 0xD3, 0x64, 0xD9, 0x9B, 
+0x44, 0x4D, 0xDA, 0x4A, 0xB9, 0x59, 0xDB, 0x91, 
+0xD2, 0x27, 0x25, 0x72, 0xF3, 0x36, 0x4F, 0x17, 
+0xC1, 0x9F, 0xC4, 0xF7, 0x81, 0xBD, 0xD3, 0x21, 
+0x85, 0x54, 0x33, 0x29, 0x44, 0xCF, 0x7F, 0x6F, 
+0xDD, 0x1F, 0x0B, 0x0F, 0xA0, 0x71, 0x2F, 0x4B, 
+0x0A, 0x2D, 0x96, 0x70, 0xC1, 0x37, 0x3B, 0xBD, 
+0xCC, 0x7B, 0x6A, 0x1E, 0xE0, 0xAE, 
+
+0xFE, 0x4A, 0xDB, 0xFE, 0x4E, 0xD6, 0xAC, 0xE6, 
+0xAE, 0x65, 0x6F, 0xAD, 0xFA, 0x05, 0x0C, 0x70, 
+0xA2, 0x79, 0x73, 0xFE, 0x48, 0xC7, 0xA8, 0x95, 
+0x91, 0x71, 0x68, 0xF5, 0x45, 0x75, 0xED, 0xEC, 
+0xC9, 0x24, 0x04, 0x39, 0x0C, 0x78, 0xB4, 0x8E, 
+0x1F, 0x8F, 0x96, 0xA8, 0xDA, 0x54, 0x6F, 0x3A, 
+0x95, 0xEA, 0x0A, 0x85, 0x5D, 0x06, 0xA7, 0xFB, 
+0xAB, 0x17, 0x32, 0xB5, 0x7A, 0xEA, 0x10, 0xFD, 
+0x85, 0x55, 0xBC, 0x02, 0xB0, 0xC1, 0xB4, 0xCE, 
+0x09, 0x3B, 0xDC, 0xA7, 0x5F, 0x4B, 0x07, 0xA1, 
+0x0A, 0x8B, 0x17, 0xB3, 0xEE, 0xD9, 0x08, 0xC3, 
+0x60, 0x6D, 0xE8, 0x1C, 0x45, 0x23, 0xCF, 0x3B, 
+0x51, 0x94, 0x60, 0xEE, 0x4F, 0xD6, 0x31, 0xDA, 
+0x7C, 0xAA, 0x87, 0x88, 0x11, 0x31, 0x1A, 0x64, 
+0x4B, 0x99, 0x7B, 0x86, 0xB4, 0x9B, 0x16, 0xAD, 
+0x0E, 0x06, 0x8C, 0xF7, 0x49, 0xB2, 0x66, 0xB6, 
+0x73, 0xF1, 0xB0, 0xB0, 0x6D, 0x3E, 0x55, 0x01, 
+0x81, 0x83, 0x49, 0x2B, 0xB0, 0xF9, 0x6F, 0x1E, 
+0x21, 0xAA, 0xB3, 0x00, 0xA1, 0x08, 0xD1, 0x3E, 
+0x98, 0x79, 0x2A, 0x55, 0x5D, 0x4A, 0x1F, 0x6F, 
+0x2F, 0x15, 0x30, 0x09, 0x48, 0x73, 0xE5, 0xA6, 
+0x78, 0x26, 0x38, 0x99, 0x85, 0x8D, 0xF3, 0x63, 
+0x78, 0x4E, 0x25, 0x6C, 0x8C, 0xE3, 0x7D, 0xA4, 
+0xE8, 0x24, 0x81, 0xAF, 0x3E, 0x25, 0x70, 0xC8, 
+0xD5, 0x0D, 0xEA, 0xA8, 0xC6, 0x07, 0x31, 0x80, 
+0xFD, 0x2E, 0x9B, 0x53, 0xE6, 0x79, 0xBA, 0xF2, 
+0xCC, 0x9A, 0x74, 0x85, 0x8C, 0xED, 0x47, 0x4C, 
+0x98, 0x6A, 0xC1, 0x90, 0x1E, 0xF5, 0xB3, 
+
+0xA4, 0x28, 0xCC, 0x3E, 0x35, 0x70, 0xFB, 0x3A, 
+0x91, 0x97, 0x53, 0x0F, 0xAB, 0x4A, 0xA0, 0x45, 
+0x7A, 0x43, 0x10, 0x4C, 0xCA, 0xD3, 0xDB, 0x21, 
+0xE9, 0x56, 0x54, 0x75, 0xD8, 0x11, 0x00, 0x7E, 
+0x7E, 0x33, 0x4C, 0x57, 0xF8, 0x8E, 0x61, 0x87, 
+0x0B, 0x87, 0x22, 0x0A, 0x79, 0x1B, 0x46, 0xA3, 
+0x91, 0xEF, 0x0F, 0x3A, 0x3E, 0x48, 0xBD, 0xC9, 
+0xF3, 0x67, 0x55, 0x9B, 0x29, 0x9D, 0x4A, 0xAA, 
+0xA6, 0x2F, 0x31, 0xB6, 0xAB, 0xBE, 0x9D, 0x87, 
+0xA5, 0xFC, 0x42, 0x02, 0x76, 0xD3, 0x80, 0xE1, 
+0xB1, 0xAB, 0xA3, 0x7F, 0x87, 0x08, 0x69, 0x21, 
+0xA2, 0x44, 0x38, 0x95, 0x15, 0x22, 0xBF, 0x38, 
+0x1C, 0xB6, 0x4E, 0x1A, 0x51, 0xB4, 0x6A, 0xFD, 
+0x81, 0x55, 0x62, 0x1E, 0x75, 0xFD, 0x9C, 0x73, 
+0x38, 0x50, 0x7C, 0x9B, 0xE0, 0xDA, 0x20, 0x69, 
+0x2A, 0x3C, 0xD1, 0xE4, 0x81, 0x8F, 0x04, 0xA9, 
+0x18, 0x36, 0xE7, 0xDE, 0xF4, 0x63, 0x06, 0x74, 
+0x92, 0x5A, 0xEC, 0xE4, 0x41, 0xB8, 0xD7, 0x37, 
+0x53, 0xB9, 0xE0, 0xA4, 0x03, 0x9C, 0x9E, 0x36, 
+0xA9, 0x40, 0xAB, 0xC7, 0xEC, 0x73, 0x10, 0x5E, 
+0x7D, 0x1A, 0x80, 0x0A, 0x72, 0x9A, 0x2A, 0xE0, 
+0xE8, 0x02, 0x0B, 0xFB, 0x09, 0x3F, 0x68, 0x84, 
+0xF6, 0xC8, 0x51, 0x21, 0x54, 0x16, 0xC8, 0x46, 
+0x0F, 0xED, 0xD9, 0x16, 0x47, 0x85, 0xBC, 0x75, 
+0x76, 0x5E, 0xC9, 0xF2, 0x33, 0xF3, 0xC3, 0xAC, 
+0xD1, 0x32, 0x2F, 0x8A, 0xA8, 0x0B, 0x80, 0xDA, 
+0x0D, 0xA6, 0xB1, 0x10, 0x4D, 0x43, 0x96, 0xC2, 
+0xE1, 0x03, 0x75, 0xA2, 0x68, 0x8D, 0x68, 0x76, 
+
+0x8A, 0x13, 0xED, 0x02, 0x9A, 0x21, 0x51, 0x56, 
+0x59, 0x15, 0x1D, 0x4B, 0xCC, 0x5F, 0xE1, 0x0C, 
+0x9E, 0xAF, 0x1E, 0x36, 0x79, 0x06, 0x76, 0x70, 
+0x88, 0xE0, 0xF2, 0xA6, 0x6E, 0x2C, 0xED, 0xE4, 
+0x6C, 0xEF, 0x86, 0xEE, 0x10, 0x5C, 0x28, 0x6B, 
+0xE6, 0xE8, 0x31, 0xEA, 0xBC, 0xDB, 0xDF, 0xC0, 
+0x25, 0xF7, 0xB8, 0x52, 0xB4, 0xE0, 0x10, 0x67, 
+0x60, 0xE7, 0x83, 0x30, 0x4E, 0x1D, 0xB0, 0xE4, 
+0xDA, 0x72, 0x65, 0x01, 0x2F, 0x89, 0x4E, 0x20, 
+0x65, 0xF8, 0x16, 0x0E, 0x45, 0x89, 0xCC, 0x40, 
+0xB2, 0xEC, 0x79, 0x62, 0xA2, 0x96, 0x40, 0x88, 
+0xCE, 0x75, 0xB1, 0x01, 0x57, 0xDD, 0x88, 0xC8, 
+0x8B, 0xE7, 0x44, 0xF8, 0x61, 0x0E, 0xC6, 0x9B, 
+0x61, 0xD5, 0xBA, 0xA5, 0x22, 0xC2, 0xB7, 0xCC, 
+0x79, 0x9A, 0xBE, 0xFF, 0x9E, 0x47, 0xFF, 0xD9, 
+0x05, 0xC0, 0x87, 0x1B, 0xD9, 0x44, 0xBB, 0x0B, 
+0x28, 0xE6, 0x66, 0x8E, 0x45, 0x3B, 0x6E, 0x41, 
+0x1D, 0xC1, 0x9A, 0xDF, 0xB9, 0x0F, 0xBB, 0x56, 
+0x90, 0xBA, 0x52, 0xDA, 0x6D, 0x79, 0x81, 0xEA, 
+0x4B, 0x02, 0x14, 0xAE, 0xC3, 0x6C, 0xD9, 0xF5, 
+0x1D, 0x52, 0x47, 0x4B, 0xF2, 0xAA, 0x12, 0xC9, 
+0xA2, 0x1F, 0xD1, 0x93, 0x9A, 0x8B, 0x0D, 0x1D, 
+0xEF, 0xF2, 0xFB, 0x5E, 0x55, 0x97, 0xB4, 0xD6, 
+0x39, 0x22, 0xF0, 0x9F, 0xC2, 0x68, 0x30, 0x73, 
+0x69, 0x07, 0xE6, 0xAD, 0xBA, 0x4D, 0x5C, 0x67, 
+0x8F, 0x99, 0x37, 0x37, 0x8B, 0xBA, 0x3F, 0x63, 
+0xAD, 0x54, 0x15, 0xC2, 0x47, 0xAB, 0x4B, 0xBD, 
+0x15, 0x00, 0x13, 0x19, 0xF2, 0xA0, 0xBF, 0x28, 
+0xE7, 
+
+0xC2, 0x4A, 0x03, 0xB9, 0x35, 0x8F, 0xCB, 0x17, 
+0xA5, 0x1D, 0xEE, 0xC2, 0x2C, 0xCA, 0x7F, 0x5F, 
+0x9C, 0x7B, 0xF6, 0xDC, 0xD8, 0x4C, 0x2E, 0x27, 
+0xF1, 0x11, 0xDB, 0x97, 0x00, 0xC1, 0x16, 0x95, 
+0xAD, 0x13, 0xDC, 0xF4, 0x1C, 0x31, 0xB1, 0x61, 
+0x88, 0x0C, 0x8E, 0xD1, 0xB1, 0xA0, 0x3F, 0x95, 
+0x74, 0x9B, 0x5F, 0x82, 0x00, 0x57, 0xF4, 0x12, 
+0xF8, 0x01, 0xC5, 0x76, 0xC6, 0xBB, 0x33, 0x4B, 
+0xDF, 0xBA, 0x8A, 0x09, 0x98, 0x1C, 0xA6, 0xB3, 
+0x92, 0xF5, 0x79, 0xF7, 0x8E, 0xCD, 0x40, 0x8C, 
+0x60, 0x00, 0x44, 0xC6, 0xF9, 0xFF, 0xB8, 0x99, 
+0xE5, 0x7A, 0x4E, 0x7E, 0x1E, 0x73, 0x64, 0x0D, 
+0x78, 0xDB, 0x04, 0x12, 0x53, 0x62, 0x3B, 0xF9, 
+0x72, 0x4B, 0xF6, 0xAB, 0x0B, 0xB7, 0x27, 0xE2, 
+0xD7, 0xFB, 0xF9, 0x2C, 0x0B, 0xFB, 0x99, 0x44, 
+0x2C, 0x84, 0x22, 0x71, 0xD2, 0x63, 0x00, 0x44, 
+0x95, 0xDA, 0x57, 0xBC, 0x90, 0xF0, 0x33, 0xF7, 
+0xEB, 0x4E, 0x83, 0x8C, 0x25, 0xB2, 0xE5, 0xDD, 
+0x61, 0x2E, 0xDD, 0xE4, 0xEB, 0x8A, 0x9A, 0xBD, 
+0xD8, 0xA0, 0x20, 0x4C, 0x3C, 0x70, 0xCB, 0xDC, 
+0x58, 0xB9, 0xA5, 0xA9, 0xDE, 0x08, 0xD2, 0x4E, 
+0xC0, 0x65, 0x47, 0x38, 0x98, 0x52, 0xA9, 0x45, 
+0xAD, 0x2D, 0xED, 0x50, 0x97, 0x48, 0x0F, 0xD5, 
+0x09, 0xB3, 0xCC, 0xAE, 0xC6, 0xD4, 0x95, 0x9B, 
+0xE1, 0x88, 0x61, 0x5D, 0x95, 0x9F, 0x01, 0xA6, 
+0x9A, 0x3B, 0x3B, 0xAA, 0xD6, 0xE3, 0xA5, 0xF7, 
+0x84, 0x80, 0x55, 0x17, 0xA3, 0x3F, 0xB7, 0x3D, 
+0x93, 0x4B, 0xAB, 0x25, 0x15, 0x80, 0xDF, 0xF4, 
+0x15, 0xA3, 
+
+0xF3, 0xE2, 0xE9, 0x6D, 0xE6, 0x96, 0x5E, 0x9E, 
+0x76, 0x77, 0x65, 0x87, 0xF9, 0xB0, 0xA7, 0x5D, 
+0x50, 0x14, 0xFE, 0x8D, 0x4D, 0x73, 0xAE, 0xF5, 
+0x15, 0x70, 0xDD, 0x70, 0xA8, 0x0B, 0xD6, 0x1F, 
+0x3F, 0x64, 0xE4, 0x84, 0xF9, 0x90, 0x87, 0xAF, 
+0xC1, 0x16, 0x73, 0x04, 0x20, 0x73, 0x82, 0xE1, 
+0xF8, 0x50, 0xEC, 0x51, 0xFA, 0xC4, 0xAC, 0xD2, 
+0x56, 0x5E, 0x02, 0x6E, 0x9D, 0x49, 0x86, 0xA4, 
+0x7B, 0xCE, 0x96, 0xA2, 0xF0, 0x60, 0x9F, 0x5C, 
+0xA4, 0x17, 0x5B, 0xA8, 0xDA, 0x6B, 0xDF, 0xB7, 
+0x00, 0x46, 0x81, 0x93, 0xF9, 0x54, 0x17, 0x7A, 
+0x87, 0x8F, 0xF9, 0x6C, 0x52, 0x92, 0x23, 0x87, 
+0x53, 0xC0, 0xD4, 0xD2, 0x9F, 0xFC, 0x10, 0x90, 
+0x5F, 0x02, 0x98, 0x1C, 0x79, 0x98, 0x9C, 0xB7, 
+0x2E, 0x88, 0x04, 0xCA, 0x6B, 0x32, 0xF8, 0x68, 
+0xC9, 0x98, 0x9B, 0xA9, 0x90, 0x28, 0xC3, 0xE1, 
+0x05, 0x6D, 0x39, 0x11, 0x75, 0x26, 0xC3, 0x63, 
+0x07, 0xF0, 0x7D, 0xFE, 0x4B, 0x79, 0xE2, 0x54, 
+0xF1, 0x33, 0xFC, 0x31, 0x99, 0x68, 0x05, 0xCD, 
+0xB1, 0x5F, 0x65, 0x8A, 0xA2, 0x52, 0x89, 0x4F, 
+0x6A, 0x9B, 0x25, 0xF2, 0x67, 0x3D, 0xF5, 0xF5, 
+0xCB, 0x1D, 0xD7, 0x87, 0xCE, 0x92, 0x55, 0xE0, 
+0x10, 0x08, 0xAA, 0x89, 0xED, 0x31, 0xCB, 0xD7, 
+0x80, 0x22, 0x5A, 0x1C, 0x18, 0x8C, 0x77, 0xB5, 
+0xFD, 0x67, 0x7A, 0xC2, 0xDF, 0xA6, 0x8C, 0xBC, 
+0x54, 0x46, 0x76, 0xFF, 0x2B, 0x26, 0x37, 0xA6, 
+0x1D, 0xD6, 0x89, 0xEA, 0xB7, 0xC7, 0xB7, 0x6A, 
+0xD6, 0x85, 0xCC, 0x73, 0xA4, 0x7D, 0x58, 0x4E, 
+0xB1, 0x5E, 0x55, 
+
+0x02, 0x84, 0x5D, 0x84, 0x5D, 0x97, 0x20, 0xCD, 
+0x7B, 0xBF, 0x63, 0xA3, 0x7A, 0xB7, 0xB9, 0xF0, 
+0xD5, 0x64, 0xA9, 0xE7, 0x53, 0x59, 0xCD, 0x95, 
+0x35, 0x67, 0x27, 0x2A, 0x4B, 0xBF, 0x4B, 0xBA, 
+0x59, 0xCB, 0x6F, 0xEC, 0xCC, 0xE1, 0x5F, 0xF9, 
+0xE1, 0x1E, 0xDE, 0x34, 0x4A, 0x82, 0xB7, 0x53, 
+0x6E, 0x32, 0xC6, 0xD3, 0xA6, 0xA1, 0x66, 0x1A, 
+0x54, 0x25, 0x04, 0xC5, 0xF2, 0xA1, 0xBC, 0x97, 
+0xBA, 0x87, 0x2C, 0x58, 0x99, 0x35, 0x25, 0x95, 
+0x14, 0x1E, 0x83, 0xF6, 0x12, 0x6C, 0x34, 0xAA, 
+0xD0, 0x90, 0x2D, 0xB9, 0x3F, 0x96, 0x9F, 0xE7, 
+0x93, 0x86, 0xEA, 0x62, 0x59, 0x1C, 0x36, 0x8D, 
+0x51, 0xC2, 0xB9, 0x42, 0x6C, 0x51, 0xD5, 0x7B, 
+0x20, 0x15, 0x25, 0xA4, 0xAE, 0x3C, 0x79, 0x1E, 
+0x21, 0x49, 0x62, 0xB4, 0xB2, 0xC2, 0xB1, 0x6A, 
+0x6D, 0xE7, 0xB9, 0x76, 0x6B, 0x4E, 0x94, 0xB5, 
+0x44, 0x4C, 0x1C, 0x14, 0x17, 0x8A, 0xC5, 0x3E, 
+0x80, 0xBE, 0xE7, 0x06, 0x69, 0xD6, 0xAB, 0x0E, 
+0xB8, 0x2F, 0x25, 0xB3, 0x91, 0x25, 0x6C, 0x30, 
+0xE4, 0x8E, 0xA4, 0x3B, 0xA8, 0xC8, 0x4B, 0x8A, 
+0xBB, 0xDB, 0xB9, 0xEE, 0xF2, 0x6B, 0xA2, 0x9F, 
+0x17, 0x86, 0x52, 0x70, 0x37, 0xDC, 0xAA, 0x10, 
+0x88, 0x16, 0x5E, 0xC2, 0xCA, 0xDE, 0x38, 0x65, 
+0x34, 0xA0, 0x40, 0x37, 0x11, 0xA3, 0xB5, 0x6B, 
+0xCD, 0x51, 0xA4, 0xE5, 0x7A, 0x40, 0x6C, 0xC5, 
+0x1A, 0xE4, 0xC1, 0x7C, 0xF7, 0x46, 0xA2, 0x5D, 
+0xC5, 0x70, 0x1F, 0xF4, 0x50, 0x15, 0xF8, 0x8A, 
+0x46, 0xA3, 0x92, 0x51, 0x36, 0xD6, 0xC1, 0x4C, 
+0x90, 0xC5, 0x98, 0xCD, 
+
+0x4E, 0xA3, 0x09, 0xDE, 0x26, 0xA0, 0xD1, 0x63, 
+0xF8, 0xEC, 0x51, 0x6C, 0xF3, 0x47, 0x28, 0xB3, 
+0x9C, 0xFC, 0xF4, 0xA4, 0x03, 0xD0, 0x50, 0x6A, 
+0x07, 0x74, 0x44, 0xFD, 0x4C, 0x46, 0x40, 0x66, 
+0x6C, 0x81, 0xA5, 0xB8, 0x54, 0xAA, 0x10, 0xCE, 
+0xBC, 0x39, 0x4E, 0x83, 0x76, 0x0E, 0xCD, 0xA1, 
+0x46, 0xAC, 0xC7, 0x44, 0x88, 0x9D, 0x0E, 0x2E, 
+0xF2, 0x85, 0xE7, 0x0C, 0x64, 0xF1, 0xEF, 0xF6, 
+0x16, 0xB2, 0x4B, 0x43, 0x78, 0x6F, 0x4F, 0x16, 
+0x14, 0x60, 0x0E, 0xB0, 0xA2, 0xEE, 0x3A, 0x64, 
+0x07, 0x43, 0xC7, 0xCD, 0x9C, 0xEA, 0x93, 0x2C, 
+0x93, 0xA9, 0xD4, 0x63, 0x1D, 0xC2, 0x17, 0x4B, 
+0x8A, 0x05, 0x6D, 0xBB, 0xC6, 0x44, 0x2B, 0xB1, 
+0x32, 0xF6, 0x45, 0xDE, 0x03, 0x3D, 0xC4, 0x8C, 
+0x61, 0x71, 0x43, 0x78, 0xDB, 0x14, 0xB3, 0x09, 
+0x2C, 0xF2, 0xF7, 0xDD, 0x49, 0xB1, 0x8D, 0xCC, 
+0x82, 0xA8, 0xE5, 0x8F, 0xE9, 0x91, 0x6D, 0x61, 
+0x74, 0x07, 0x77, 0x98, 0xF2, 0xA8, 0xF9, 0x0B, 
+0xA5, 0x9F, 0x20, 0x0D, 0x7D, 0x58, 0xB8, 0x7A, 
+0x0B, 0x77, 0x1A, 0xD9, 0x36, 0xFF, 0x46, 0x67, 
+0x59, 0x75, 0x24, 0xA3, 0xC0, 0x42, 0xE8, 0xF7, 
+0xF0, 0x40, 0x9C, 0x9B, 0x4D, 0xC6, 0xBD, 0xDD, 
+0xD1, 0x18, 0xCD, 0x8F, 0xC5, 0x65, 0xFF, 0xE7, 
+0x2E, 0x74, 0xD2, 0xFF, 0xA3, 0x89, 0x65, 0xDC, 
+0x3E, 0x90, 0xC8, 0x2F, 0x18, 0xAB, 0x80, 0xD3, 
+0x7E, 0x3F, 0x06, 0x49, 0x90, 0x5A, 0xCC, 0xC3, 
+0xC9, 0xE6, 0xE8, 0x1B, 0x28, 0xB5, 0xDE, 0xDF, 
+0xC0, 0x10, 0x60, 0xF3, 0x09, 0x67, 0x21, 0xE4, 
+0x2A, 0x77, 0xDA, 0xA7, 0xA3, 
+
+0xC3, 0x0C, 0x4D, 0x67, 0x32, 0x7F, 0xC1, 0x30, 
+0x10, 0x8C, 0x7D, 0x9F, 0xDB, 0x09, 0x71, 0x84, 
+0xB1, 0xAE, 0x1C, 0xC7, 0x7B, 0x8E, 0x93, 0x3A, 
+0xEB, 0x2F, 0x89, 0xEC, 0x20, 0x63, 0x80, 0x13, 
+0xB8, 0xD8, 0x85, 0xFE, 0xFD, 0xA0, 0xD3, 0x2E, 
+0xCC, 0xC7, 0x57, 0x6B, 0x3E, 0x3E, 0x60, 0x24, 
+0x27, 0x1F, 0x53, 0xAD, 0xDD, 0x35, 0x83, 0x19, 
+0xBD, 0x36, 0x2E, 0x93, 0xDE, 0x9F, 0xD0, 0xE5, 
+0x6A, 0x45, 0x50, 0x4A, 0xC6, 0xCD, 0xE3, 0x71, 
+0xD0, 0x8E, 0xC1, 0x3E, 0x45, 0xA1, 0x4D, 0x50, 
+0x0D, 0xAA, 0x69, 0xC3, 0xB5, 0xE7, 0x4E, 0xC0, 
+0x56, 0xB3, 0xE0, 0xFA, 0xFC, 0xC4, 0x9B, 0x0C, 
+0xE8, 0xA4, 0x39, 0x4A, 0x88, 0x04, 0x3B, 0x95, 
+0xCF, 0xBA, 0xEA, 0x52, 0x13, 0xD9, 0x90, 0xC1, 
+0xF4, 0x3C, 0x09, 0x03, 0x58, 0x15, 0x1C, 0x4C, 
+0x73, 0x26, 0x01, 0x5B, 0xFE, 0x3C, 0xF5, 0x34, 
+0xE4, 0xFB, 0x02, 0x9A, 0xF1, 0x13, 0xC5, 0x3B, 
+0x77, 0x7F, 0x79, 0x44, 0x1A, 0x7C, 0xF7, 0x2F, 
+0x1C, 0x97, 0x89, 0x7E, 0x83, 0x61, 0x17, 0xA1, 
+0x23, 0x6F, 0xD4, 0x45, 0xFE, 0x60, 0x2A, 0x46, 
+0x35, 0x8C, 0x7A, 0xB7, 0xEC, 0x9D, 0x99, 0x1E, 
+0xC5, 0xA7, 0x1B, 0xEA, 0xDD, 0x93, 0xA9, 0x56, 
+0x9B, 0x0C, 0x6A, 0x70, 0xF5, 0x86, 0x3E, 0x18, 
+0x39, 0x33, 0x12, 0x0D, 0xE2, 0x31, 0x95, 0xAC, 
+0x85, 0x6F, 0xAF, 0x00, 0xA6, 0x94, 0xAC, 0x4E, 
+0x0D, 0x62, 0x14, 0x45, 0x7C, 0x4E, 0x96, 0xA7, 
+0x88, 0x74, 0xA8, 0xEF, 0xCD, 0x1F, 0x8F, 0xA2, 
+0xB4, 0xFF, 0x06, 0x3D, 0x09, 0xBC, 0x1E, 0x83, 
+0x90, 0x79, 0xDD, 0xF5, 0x69, 0x43, 
+
+0xC4, 0xDE, 0x4F, 0xE2, 0xAF, 0xBD, 0x0D, 0x77, 
+0x35, 0x5F, 0xAB, 0x15, 0xD7, 0x29, 0xCE, 0xFA, 
+0x3E, 0xB3, 0x6A, 0x7C, 0xF1, 0xD1, 0x29, 0xA3, 
+0xE2, 0x34, 0xA1, 0x89, 0xA5, 0x53, 0x5C, 0x02, 
+0xD2, 0x82, 0x3B, 0x31, 0xC7, 0x6B, 0x60, 0x42, 
+0x98, 0x2A, 0xBF, 0x6D, 0xFE, 0x6A, 0xEB, 0xEA, 
+0x0B, 0xEC, 0x7A, 0x25, 0xCD, 0x28, 0xC4, 0x65, 
+0xF4, 0x9B, 0x8D, 0x23, 0x90, 0x2A, 0xA4, 0x7E, 
+0x1E, 0x62, 0x03, 0x64, 0x54, 0x09, 0x48, 0x41, 
+0xAE, 0xD8, 0xCA, 0x99, 0x77, 0xC8, 0x6E, 0xD0, 
+0x3E, 0x4A, 0x61, 0xA4, 0xF9, 0x81, 0xCD, 0x21, 
+0x52, 0x89, 0xDC, 0xD3, 0x9C, 0xC9, 0xEA, 0x00, 
+0x92, 0x36, 0x67, 0x56, 0x4B, 0xED, 0x96, 0x97, 
+0x40, 0xBE, 0x70, 0x05, 0x0F, 0x71, 0x4B, 0x56, 
+0x50, 0x31, 0x31, 0x74, 0xBC, 0xF7, 0x70, 0x90, 
+0x18, 0x78, 0x83, 0x0C, 0x35, 0x9B, 0x8C, 0xB2, 
+0xB6, 0x16, 0x39, 0x8A, 0x15, 0x72, 0x90, 0x19, 
+0x40, 0x13, 0xAA, 0xF3, 0x5F, 0xE3, 0xE8, 0xAB, 
+0xB9, 0xE9, 0xFB, 0xD7, 0xFC, 0x58, 0x86, 0x78, 
+0x7E, 0xFC, 0xEB, 0xCE, 0xF7, 0xD5, 0x1A, 0x7C, 
+0x7D, 0xDD, 0xA6, 0x4A, 0x68, 0xDC, 0x33, 0x14, 
+0xCA, 0xAD, 0xBF, 0x3E, 0x80, 0x77, 0xB6, 0x48, 
+0xC1, 0x54, 0x06, 0xC7, 0x5D, 0x54, 0x6E, 0xB0, 
+0x4F, 0xCD, 0x71, 0x4F, 0x11, 0xFD, 0xF8, 0x95, 
+0x44, 0xE1, 0x33, 0x30, 0x4E, 0xE8, 0xFA, 0x67, 
+0xF8, 0xC4, 0x36, 0x4D, 0x84, 0xE9, 0x1E, 0xB9, 
+0x62, 0x62, 0xA2, 0x94, 0xC9, 0xF2, 0x2C, 0x46, 
+0x44, 0x3D, 0x02, 0x1E, 0xBC, 0x64, 0x4D, 0x53, 
+0x48, 0xB9, 0x36, 0xB6, 0x8A, 0xF6, 0xA9, 
+
+0x1A, 0x06, 0xE2, 0x79, 0xFC, 0xF2, 0x23, 0xE1, 
+0x43, 0x40, 0x85, 0xDB, 0xAE, 0xFF, 0x4A, 0x64, 
+0xAE, 0xB5, 0x2C, 0x21, 0xC8, 0xBE, 0xD7, 0x2F, 
+0x5C, 0x76, 0x41, 0xA1, 0xA5, 0xC8, 0x29, 0xA0, 
+0x30, 0x73, 0x91, 0x08, 0xBA, 0xD2, 0xF6, 0x2D, 
+0xA4, 0x31, 0x95, 0xB2, 0xF9, 0x78, 0x5A, 0x45, 
+0xBF, 0xA7, 0x01, 0xF2, 0x1E, 0x8D, 0x1A, 0xD4, 
+0x4C, 0x80, 0x4D, 0x0B, 0x17, 0xBF, 0x5D, 0x5C, 
+0xC1, 0xD1, 0x56, 0xC5, 0xF0, 0xE9, 0xAC, 0xEC, 
+0x07, 0xE8, 0x7A, 0x47, 0xA4, 0x92, 0x22, 0x82, 
+0xC5, 0x91, 0xAD, 0xF6, 0x7C, 0x3C, 0x17, 0x95, 
+0xDA, 0x4B, 0x46, 0x8E, 0x2E, 0xF3, 0xCC, 0xFC, 
+0x01, 0x44, 0xC3, 0xB4, 0xAB, 0x54, 0xF6, 0xA6, 
+0x8B, 0x99, 0x3D, 0x92, 0x98, 0x58, 0x3E, 0x6E, 
+0x9E, 0xED, 0x85, 0x66, 0xE4, 0xF9, 0x10, 0x89, 
+0xFA, 0x16, 0xD7, 0x10, 0x2F, 0x0A, 0x0F, 0x82, 
+0x0D, 0x9F, 0x3F, 0xA8, 0x45, 0x85, 0xF2, 0x3A, 
+0xC5, 0xE1, 0x93, 0x54, 0x14, 0x87, 0xDC, 0x2C, 
+0xDE, 0xBA, 0xC4, 0x4A, 0xE9, 0x45, 0x85, 0x3C, 
+0x87, 0x42, 0x04, 0x6F, 0x89, 0x4E, 0x7D, 0x2F, 
+0xAD, 0x8F, 0x41, 0x81, 0x06, 0x4D, 0xC2, 0xA1, 
+0xD6, 0xA6, 0x22, 0x11, 0xE1, 0x17, 0x67, 0x1D, 
+0x77, 0xC3, 0x88, 0x90, 0xF9, 0xAF, 0x60, 0xDC, 
+0x5E, 0x2D, 0x35, 0xF8, 0x6E, 0xA5, 0x24, 0xFE, 
+0x86, 0x04, 0x08, 0x2F, 0x7C, 0x1B, 0x5D, 0x04, 
+0xE9, 0xBA, 0x54, 0x78, 0x7D, 0x2A, 0xB4, 0xC2, 
+0x01, 0xC4, 0xF9, 0x92, 0x29, 0x88, 0xEA, 0xA4, 
+0x6D, 0x00, 0x9C, 0xC4, 0x26, 0x11, 0xB1, 0x41, 
+0x5E, 0x45, 0xB1, 0xFE, 0x62, 0x20, 0xE6, 0x9F, 
+
+0x4D, 0xF3, 0xA2, 0x17, 0xA9, 0x1C, 0xB3, 0x36, 
+0x83, 0x0D, 0x1E, 0xD0, 0xBA, 0x2A, 0xF0, 0x13, 
+0x7C, 0xB8, 0xBA, 0x06, 0x2D, 0x17, 0x80, 0xCC, 
+0x8B, 0x31, 0x22, 0x35, 0x10, 0x52, 0x9E, 0xFF, 
+0xC6, 0xE1, 0x2C, 0x47, 0x23, 0x30, 0x26, 0xBD, 
+0x81, 0xAD, 0x7F, 0xE2, 0xDD, 0x9B, 0x61, 0x78, 
+0x52, 0x3A, 0xDC, 0x23, 0x7B, 0x60, 0x9E, 0xD1, 
+0xEC, 0xF4, 0xBF, 0x19, 0x7A, 0x69, 0x7A, 0x6A, 
+0xCA, 0x39, 0xB3, 0xC5, 0x60, 0x50, 0xB0, 0x85, 
+0xCB, 0x59, 0x9C, 0xE3, 0x55, 0x77, 0x8C, 0x81, 
+0x2D, 0xB9, 0x67, 0x5B, 0xCA, 0x7E, 0xB7, 0x2D, 
+0xDB, 0x94, 0x13, 0xFA, 0x63, 0xC5, 0xC9, 0x7A, 
+0x49, 0x72, 0x4D, 0xB6, 0x32, 0xD5, 0x66, 0xE2, 
+0x37, 0xC3, 0x1A, 0xE9, 0x39, 0x23, 0xE3, 0x09, 
+0xAF, 0x79, 0x6A, 0xA1, 0x03, 0x92, 0x50, 0x31, 
+0x6E, 0x16, 0xB5, 0xC0, 0x91, 0xED, 0x59, 0xF2, 
+0x70, 0x3A, 0xDB, 0x28, 0xF6, 0x2B, 0x91, 0xED, 
+0x24, 0x56, 0x30, 0x2A, 0x70, 0x53, 0x33, 0xC8, 
+0x3C, 0xF0, 0x4D, 0x40, 0x51, 0x59, 0x6E, 0x69, 
+0xA0, 0x66, 0xEC, 0x94, 0xD9, 0x74, 0x4E, 0xF3, 
+0xAA, 0x90, 0x01, 0x80, 0x4F, 0xD8, 0x27, 0xF0, 
+0x4C, 0xE5, 0x14, 0x23, 0xE1, 0x8E, 0x71, 0xA1, 
+0xA7, 0x79, 0x5F, 0x54, 0x47, 0x13, 0x73, 0xCA, 
+0xB2, 0xDE, 0xF5, 0xAE, 0x6C, 0xDB, 0x0B, 0x9D, 
+0xB0, 0x13, 0xBC, 0xB3, 0xC2, 0x79, 0xB0, 0x91, 
+0x83, 0xF1, 0xA7, 0x19, 0xEC, 0xA2, 0x27, 0x1A, 
+0xFF, 0x7A, 0x39, 0x33, 0xBE, 0x2B, 0x9C, 0x19, 
+0x33, 0x0C, 0xEA, 0x7B, 0x3E, 0x17, 0xD9, 0xFA, 
+0x0B, 0x20, 0x5A, 0xC1, 0xBD, 0x39, 0xDB, 0x28, 
+0xBD, 
+
+0xA1, 0x28, 0xD0, 0x1A, 0x1E, 0x97, 0x96, 0x65, 
+0xBC, 0xEE, 0x36, 0x1D, 0x4B, 0xCB, 0x13, 0x12, 
+0xF6, 0xD2, 0xB2, 0xE0, 0x33, 0x56, 0xC7, 0x1A, 
+0x54, 0x33, 0x06, 0xA3, 0xC3, 0xB2, 0x60, 0x27, 
+0xC1, 0xB6, 0x30, 0x3D, 0xCE, 0xCA, 0xD8, 0x37, 
+0x1E, 0xD4, 0xF5, 0xEE, 0xFB, 0x05, 0xE7, 0x19, 
+0xF7, 0x43, 0xFD, 0x95, 0xD5, 0x5E, 0xF0, 0x17, 
+0x5E, 0x30, 0xE8, 0x38, 0xFE, 0x43, 0x99, 0x88, 
+0xF3, 0x99, 0xBD, 0x66, 0x1D, 0xF7, 0x8D, 0xCB, 
+0x2E, 0xA2, 0xF8, 0x99, 0x0C, 0xD1, 0x57, 0xE2, 
+0xB2, 0x96, 0x01, 0xD9, 0x02, 0xC4, 0x8C, 0x8E, 
+0x7C, 0x7A, 0x03, 0x42, 0x29, 0x2A, 0xFF, 0x77, 
+0x0D, 0xF4, 0x6F, 0x5D, 0x5A, 0x7A, 0x91, 0x3C, 
+0x19, 0x5E, 0x91, 0xF0, 0xB9, 0xEF, 0xB1, 0x02, 
+0xF9, 0xB6, 0x5C, 0xD9, 0x45, 0xA3, 0xB0, 0xB6, 
+0x14, 0x61, 0xD6, 0x72, 0x2B, 0xDF, 0x3D, 0x81, 
+0xC8, 0x36, 0x00, 0xBB, 0x8D, 0x26, 0xAF, 0x59, 
+0x7E, 0xBC, 0xE4, 0xC5, 0xE0, 0x3E, 0x8F, 0x3E, 
+0xAF, 0x0C, 0xD7, 0x86, 0x6D, 0x7E, 0x12, 0x39, 
+0x0B, 0x04, 0x66, 0x66, 0x03, 0xE6, 0x71, 0x71, 
+0x3E, 0xA1, 0xB3, 0x17, 0xD7, 0xBB, 0x39, 0x17, 
+0xAC, 0x5B, 0x28, 0x9B, 0xD2, 0x00, 0xD5, 0x00, 
+0xCC, 0xEA, 0x5D, 0x20, 0xA2, 0x7E, 0x7B, 0x9E, 
+0xEA, 0x2D, 0x0D, 0x2B, 0x87, 0xB1, 0x4C, 0x26, 
+0x5F, 0x2D, 0x58, 0x9F, 0x80, 0x87, 0xDC, 0xF9, 
+0x80, 0x57, 0x25, 0x59, 0x60, 0x3C, 0x16, 0x75, 
+0x7B, 0x8F, 0x52, 0xBE, 0x54, 0x3F, 0x17, 0x31, 
+0x6E, 0x35, 0x7C, 0xD8, 0x52, 0xC3, 0xBA, 0xAC, 
+0xDA, 0x0D, 0xC6, 0xBC, 0x65, 0x0E, 0xCF, 0x5F, 
+0x56, 0x7C, 
+
+0x9A, 0x47, 0x36, 0xDC, 0xA7, 0x19, 0x35, 0x61, 
+0x9E, 0xC2, 0xBB, 0x92, 0xB8, 0x02, 0xD2, 0x1C, 
+0x32, 0xBD, 0x28, 0x28, 0x6B, 0x0C, 0xEB, 0xD2, 
+0x40, 0x5E, 0xF5, 0x14, 0x23, 0x43, 0x11, 0x0D, 
+0xD0, 0x32, 0x21, 0xD4, 0x10, 0x9E, 0x08, 0xFF, 
+0x08, 0xA7, 0xF2, 0x55, 0xA5, 0xF8, 0x2C, 0x7B, 
+0x35, 0x5A, 0xE4, 0x74, 0xF3, 0xFA, 0x14, 0xD2, 
+0x14, 0xDB, 0x32, 0xE0, 0x14, 0xE4, 0x8B, 0x4E, 
+0x77, 0x0A, 0x39, 0x25, 0x98, 0x2D, 0x5C, 0x9B, 
+0xFE, 0xC5, 0x4F, 0x5C, 0xA5, 0x3F, 0x5F, 0x0D, 
+0xAC, 0x04, 0x5E, 0x24, 0x95, 0x5C, 0xB4, 0xD2, 
+0x14, 0xA9, 0xC9, 0x81, 0xF3, 0x77, 0x3B, 0x4E, 
+0x16, 0x65, 0xA3, 0x8D, 0xAD, 0x4A, 0xF4, 0x0E, 
+0x8B, 0x43, 0x49, 0x0C, 0x71, 0x36, 0x2A, 0x0A, 
+0xB1, 0x9F, 0x85, 0xD0, 0x08, 0xB8, 0xE3, 0x84, 
+0xB0, 0x56, 0x65, 0x83, 0xC0, 0x4A, 0x49, 0x34, 
+0x40, 0x0D, 0x63, 0xB3, 0xB2, 0x47, 0x74, 0x1C, 
+0xA5, 0xA3, 0x9C, 0x22, 0xD9, 0xD8, 0x58, 0xDE, 
+0xAB, 0xB3, 0xAC, 0x48, 0x87, 0xBD, 0x01, 0x75, 
+0xEF, 0xCE, 0x72, 0x91, 0xF5, 0x0B, 0x96, 0x00, 
+0x50, 0xBA, 0xD4, 0xEA, 0xC9, 0x26, 0x98, 0xB6, 
+0xB6, 0xB3, 0xE0, 0x7E, 0xB5, 0x1E, 0x57, 0xD8, 
+0xD0, 0x0D, 0xC7, 0x04, 0x4B, 0x07, 0xD4, 0x8D, 
+0xAC, 0xBD, 0xBB, 0xE9, 0x05, 0xCA, 0xF7, 0x50, 
+0x26, 0x14, 0x39, 0xF8, 0x07, 0x72, 0x78, 0x89, 
+0xC4, 0xCE, 0x77, 0x87, 0xE4, 0xFC, 0xA4, 0xC0, 
+0xEC, 0x54, 0x3D, 0x99, 0x51, 0xC0, 0x54, 0x01, 
+0xCE, 0x6C, 0x5E, 0x35, 0x88, 0xB5, 0xEF, 0xC1, 
+0x10, 0xE3, 0x60, 0x1A, 0x95, 0xFD, 0x07, 0xC3, 
+0xE4, 0xAA, 0xA4, 
+
+0x88, 0x0E, 0x42, 0xC5, 0x9F, 0xA1, 0x16, 0xC4, 
+0x24, 0xE6, 0x0B, 0xED, 0x64, 0x31, 0xE9, 0x0E, 
+0x73, 0x3A, 0x0F, 0xC2, 0x8A, 0xF3, 0x25, 0x56, 
+0x93, 0xEE, 0xD0, 0x31, 0x73, 0x77, 0x7B, 0x9D, 
+0xA7, 0x75, 0x12, 0x64, 0x27, 0xDE, 0x41, 0x7B, 
+0x51, 0x07, 0xC7, 0x51, 0x47, 0xD0, 0xC4, 0x95, 
+0xEE, 0x9F, 0xC4, 0x52, 0x09, 0xBF, 0xE1, 0x33, 
+0x62, 0xFC, 0x45, 0x30, 0x50, 0xEE, 0x46, 0x64, 
+0x94, 0xA1, 0xF3, 0x95, 0xB1, 0x67, 0x47, 0x07, 
+0xC5, 0x3B, 0x4A, 0x4E, 0xAF, 0x87, 0xA6, 0x22, 
+0x9D, 0xC7, 0x2A, 0x88, 0xB4, 0x06, 0x91, 0x13, 
+0x81, 0xDC, 0x9E, 0x20, 0xD5, 0xA4, 0x47, 0xA1, 
+0x87, 0x33, 0x79, 0x07, 0x0F, 0x28, 0x70, 0x7D, 
+0x88, 0xEA, 0x3B, 0x4D, 0x54, 0x1D, 0xCC, 0x5E, 
+0x13, 0xE1, 0xDF, 0x79, 0xA9, 0xE5, 0x77, 0x5D, 
+0x96, 0xD1, 0x18, 0x42, 0xE9, 0x24, 0x93, 0xAC, 
+0xA3, 0x39, 0xBF, 0xE0, 0xEE, 0x13, 0x7D, 0xB5, 
+0xD1, 0xB7, 0x81, 0x3B, 0x9E, 0x19, 0x61, 0xE3, 
+0xC6, 0xC2, 0xAD, 0x3E, 0x6D, 0x1B, 0xA8, 0x51, 
+0x7E, 0xFF, 0x5E, 0x61, 0x84, 0xF1, 0x85, 0xAD, 
+0x35, 0x8E, 0x32, 0xE1, 0xA0, 0x9F, 0x37, 0xE3, 
+0x47, 0x78, 0x64, 0xA3, 0x9C, 0x36, 0x7F, 0xD9, 
+0x79, 0x08, 0x50, 0x2A, 0x62, 0x3C, 0x41, 0xF3, 
+0xD9, 0x41, 0x12, 0x68, 0xCF, 0x39, 0x75, 0x39, 
+0x99, 0x01, 0xDA, 0x11, 0x52, 0x55, 0x09, 0x69, 
+0xBE, 0xFE, 0x07, 0x1D, 0x35, 0x1B, 0xAD, 0x6F, 
+0x92, 0x25, 0x38, 0x7B, 0x81, 0x6F, 0x1F, 0xAF, 
+0xB6, 0x19, 0xB9, 0x03, 0x06, 0x86, 0xDE, 0xB9, 
+0xB5, 0x9C, 0xE3, 0x3E, 0x3F, 0x00, 0x26, 0x42, 
+0x63, 0x11, 0x56, 0x0A, 
+
+0x0D, 0xD0, 0x63, 0x20, 0x23, 0x2C, 0x45, 0x4E, 
+0x56, 0x11, 0xA7, 0xB9, 0x0C, 0xDD, 0x80, 0x5B, 
+0x6C, 0x5F, 0x39, 0xE8, 0x43, 0x4E, 0x64, 0x97, 
+0xF8, 0x11, 0x1F, 0xBF, 0xD2, 0x11, 0x06, 0x4F, 
+0x40, 0xF0, 0xE4, 0x1C, 0x27, 0x63, 0x71, 0x3D, 
+0xD7, 0xAE, 0x07, 0x50, 0x0F, 0xB8, 0xB5, 0xF5, 
+0x7E, 0x42, 0xBA, 0xCF, 0x2F, 0xF4, 0xC0, 0xDB, 
+0xF6, 0xEC, 0xD3, 0xDB, 0x3E, 0xC4, 0x15, 0x01, 
+0x26, 0x8A, 0x16, 0xB5, 0xE2, 0x37, 0x3F, 0x62, 
+0xB0, 0x5C, 0xC1, 0x8E, 0x27, 0x03, 0x89, 0xF6, 
+0x8B, 0xD9, 0xDB, 0x7C, 0xE9, 0xAD, 0x31, 0xC7, 
+0xE6, 0xDA, 0xD5, 0x20, 0x7C, 0x56, 0xF5, 0x74, 
+0xB6, 0x1F, 0xBB, 0xA4, 0x55, 0x51, 0x2C, 0xB3, 
+0x3A, 0x3F, 0x70, 0x8A, 0x38, 0x7E, 0xB2, 0xE9, 
+0x7E, 0xB9, 0x22, 0x65, 0xA3, 0xA8, 0xF4, 0xF1, 
+0x60, 0xFD, 0x45, 0x34, 0xB6, 0xE5, 0x28, 0xE7, 
+0xD1, 0x13, 0x3D, 0x27, 0xB1, 0x97, 0xA3, 0xFE, 
+0x01, 0xC7, 0x19, 0x85, 0x6A, 0x4B, 0x74, 0xF1, 
+0xF1, 0x3A, 0xC6, 0x4D, 0xF9, 0x96, 0x94, 0x4B, 
+0x89, 0x2C, 0x7B, 0x63, 0x09, 0x0D, 0xCA, 0xC2, 
+0x27, 0x99, 0x45, 0xA0, 0xAD, 0x36, 0x46, 0x0C, 
+0x9A, 0x36, 0xE2, 0x89, 0xE3, 0x76, 0x22, 0x87, 
+0xC2, 0x37, 0x67, 0x80, 0x78, 0xE7, 0x4E, 0x8E, 
+0xFB, 0x80, 0x40, 0xA4, 0x62, 0x9A, 0x81, 0xA8, 
+0xB0, 0x1A, 0x59, 0xB7, 0xC7, 0xF5, 0xD2, 0xFA, 
+0x11, 0x5C, 0x11, 0xC1, 0xFF, 0xBC, 0x97, 0x8E, 
+0x10, 0xF0, 0x68, 0x50, 0x02, 0x75, 0x4A, 0x13, 
+0xE7, 0xFC, 0x6C, 0x67, 0xFE, 0x1F, 0xCE, 0xF2, 
+0x85, 0xD8, 0xF8, 0x4F, 0x23, 0x77, 0x8C, 0x73, 
+0xDA, 0xCA, 0xFC, 0x58, 0xBD, 
+
+0x5B, 0x98, 0x69, 0x62, 0x13, 0x02, 0xE6, 0x52, 
+0x2B, 0xD9, 0xBD, 0x94, 0x99, 0x59, 0x5C, 0x41, 
+0x4F, 0xBC, 0xEF, 0x96, 0x4D, 0xBD, 0xB2, 0x1B, 
+0xD3, 0x7E, 0x28, 0xF3, 0xFF, 0xCE, 0x61, 0x85, 
+0xD5, 0x4B, 0x72, 0xC2, 0x33, 0x58, 0x3E, 0x06, 
+0xA7, 0x2F, 0x5E, 0xC5, 0x30, 0x21, 0x41, 0x6E, 
+0xD8, 0xC5, 0x3D, 0x94, 0x99, 0x3F, 0x20, 0x84, 
+0xCD, 0x15, 0xEE, 0x07, 0x83, 0xC4, 0x9D, 0xD9, 
+0xE1, 0xF6, 0xAF, 0x92, 0x66, 0x8C, 0x1A, 0x1E, 
+0x85, 0x0C, 0x51, 0x88, 0x33, 0x66, 0x18, 0x76, 
+0xD3, 0xB5, 0x8D, 0xCE, 0xB5, 0x3C, 0x90, 0x12, 
+0xC5, 0x5D, 0xF5, 0x0C, 0xB8, 0x5F, 0x62, 0x55, 
+0xEA, 0xDC, 0xA1, 0x77, 0x86, 0x86, 0x19, 0xDA, 
+0x32, 0x12, 0x02, 0x73, 0xD3, 0x9C, 0x24, 0xF6, 
+0xB9, 0x64, 0x37, 0xEA, 0x24, 0x85, 0x1F, 0x35, 
+0x64, 0x8D, 0x07, 0x03, 0xFF, 0x55, 0x79, 0x07, 
+0x7C, 0x21, 0x70, 0x4A, 0x43, 0x0C, 0x04, 0xD5, 
+0xF3, 0x0F, 0xAF, 0xCB, 0x7F, 0x37, 0x03, 0x80, 
+0xE5, 0x34, 0x76, 0x4C, 0x75, 0x23, 0x1A, 0x86, 
+0xB3, 0x94, 0x89, 0x30, 0xBC, 0xF1, 0xE3, 0x44, 
+0xB8, 0x4F, 0x51, 0x4C, 0xC0, 0x08, 0x06, 0xAD, 
+0xF9, 0xCF, 0x0B, 0xC9, 0xC9, 0xBA, 0x2A, 0x2E, 
+0xC0, 0x46, 0xC2, 0x64, 0xF9, 0xCB, 0x8F, 0x33, 
+0xB9, 0x95, 0x41, 0x68, 0xC0, 0x1C, 0x08, 0x8C, 
+0x28, 0x55, 0xD1, 0xA8, 0x8C, 0x39, 0x95, 0xBA, 
+0x6C, 0x4F, 0xD4, 0xE4, 0xB3, 0x7C, 0x2F, 0xA8, 
+0xC1, 0x21, 0x18, 0xC5, 0x2E, 0x48, 0xB0, 0x30, 
+0xC6, 0xD8, 0x07, 0x12, 0xF5, 0x02, 0xDE, 0x6C, 
+0xC6, 0x4B, 0x8D, 0x42, 0xB5, 0xCA, 0xC8, 0xD8, 
+0x96, 0x86, 0xC1, 0x96, 0x87, 0x01, 
+
+0x6B, 0x0B, 0x2C, 0xC3, 0xA0, 0xAF, 0x31, 0xB4, 
+0x8C, 0x86, 0xC6, 0x35, 0x7F, 0xC6, 0x71, 0x55, 
+0xD0, 0x0C, 0x02, 0x78, 0xAB, 0xC1, 0xEA, 0xCF, 
+0x28, 0xDA, 0x10, 0x29, 0x56, 0x22, 0xA8, 0x46, 
+0x9E, 0x1E, 0xBC, 0xC3, 0x70, 0x49, 0x21, 0xDE, 
+0xF2, 0x3D, 0xCE, 0x16, 0xFC, 0xFD, 0x18, 0x3A, 
+0x87, 0x7B, 0x76, 0x88, 0x79, 0x47, 0xDE, 0xCA, 
+0x70, 0x84, 0xF3, 0xBC, 0x86, 0x0C, 0xA0, 0xC9, 
+0xEA, 0xAC, 0xA8, 0x5E, 0x79, 0x95, 0x6B, 0xC2, 
+0xD3, 0x0F, 0x63, 0xF0, 0x8D, 0xCB, 0x0D, 0x32, 
+0xCB, 0x77, 0xB1, 0xC8, 0xDA, 0x0C, 0xAB, 0xCB, 
+0xBF, 0x20, 0x40, 0xBA, 0x18, 0x10, 0x6D, 0x7A, 
+0xC2, 0xFF, 0x6E, 0x55, 0xA5, 0xA1, 0xD3, 0xD9, 
+0x5B, 0xFD, 0x5D, 0x2A, 0xED, 0xBB, 0x5B, 0xDD, 
+0x26, 0xCC, 0x98, 0x5D, 0x6C, 0x67, 0x24, 0xD2, 
+0x17, 0x34, 0xF7, 0x30, 0x72, 0x35, 0x41, 0xB0, 
+0xFD, 0x7E, 0x61, 0x1B, 0x4A, 0xCC, 0x2B, 0x1A, 
+0xE7, 0x45, 0xD4, 0x33, 0xE1, 0x36, 0xA1, 0x0F, 
+0xEA, 0xBC, 0x58, 0xAA, 0x61, 0xEC, 0x26, 0xB0, 
+0xF6, 0xF9, 0x36, 0xBF, 0x8B, 0xC2, 0x28, 0xD9, 
+0x36, 0xBB, 0x82, 0x16, 0x15, 0x65, 0xEE, 0x60, 
+0xD7, 0x1C, 0x52, 0x95, 0x00, 0x4D, 0xA4, 0xE5, 
+0x7E, 0xA7, 0x00, 0x2E, 0x93, 0x28, 0x77, 0xA1, 
+0xFF, 0x95, 0xE8, 0xB5, 0x5A, 0x76, 0xAA, 0xB6, 
+0x1A, 0xBA, 0xD8, 0x95, 0xFD, 0xE7, 0x80, 0x58, 
+0xD1, 0x71, 0x4F, 0xE8, 0x3C, 0xFB, 0x6E, 0xB9, 
+0x78, 0xD5, 0x35, 0x66, 0x86, 0xC7, 0x68, 0x23, 
+0x2E, 0x36, 0x4C, 0x88, 0xBB, 0x01, 0x48, 0x45, 
+0xDD, 0x76, 0xC2, 0x15, 0xEC, 0x3F, 0xB4, 0x20, 
+0xC0, 0x94, 0xAE, 0x8E, 0x29, 0x94, 0x3C, 
+
+0x18, 0x36, 0xAD, 0xB5, 0x30, 0x2C, 0x84, 0xCD, 
+0xE7, 0x4E, 0xD1, 0x05, 0xA9, 0xC3, 0x5C, 0x77, 
+0x23, 0x21, 0x09, 0x46, 0x2D, 0xD8, 0xD8, 0x38, 
+0x0D, 0xAD, 0xB9, 0x27, 0x8F, 0x55, 0xB4, 0x95, 
+0xD7, 0xB1, 0x40, 0x0B, 0x47, 0xA8, 0x96, 0x70, 
+0xFA, 0x1B, 0x6B, 0xCD, 0x88, 0x9E, 0x4E, 0xD3, 
+0x1A, 0xA3, 0xE0, 0x49, 0xD8, 0x38, 0x53, 0xE0, 
+0x9C, 0x27, 0x03, 0xED, 0x86, 0x49, 0x8D, 0x22, 
+0xAE, 0xD1, 0x19, 0x29, 0xDD, 0x63, 0x6D, 0xF2, 
+0x6D, 0xE4, 0x13, 0xBE, 0xE0, 0x95, 0x57, 0xE1, 
+0xFB, 0x23, 0x09, 0x41, 0xFA, 0x4A, 0x62, 0xEB, 
+0x06, 0x17, 0xC6, 0x56, 0x4F, 0x00, 0x9F, 0x6E, 
+0xFC, 0x6B, 0x06, 0xD9, 0x4F, 0x3D, 0xB6, 0x27, 
+0x46, 0x4B, 0x91, 0x94, 0x34, 0x7B, 0xDC, 0xE1, 
+0xF2, 0x13, 0xB6, 0x45, 0x03, 0x65, 0x63, 0xEE, 
+0x5E, 0xE0, 0xD1, 0x47, 0x7C, 0xD7, 0x57, 0x0F, 
+0x03, 0xB9, 0x2C, 0x14, 0xE2, 0xE6, 0x51, 0xB8, 
+0x79, 0x9D, 0xBE, 0x2A, 0xEF, 0x24, 0x5A, 0xF7, 
+0x9B, 0x0F, 0xDA, 0xC8, 0xC1, 0x9F, 0x2C, 0x7D, 
+0x94, 0x32, 0x86, 0xBF, 0x1F, 0x30, 0xF3, 0x88, 
+0xC2, 0xB6, 0xE0, 0x77, 0xFA, 0x6A, 0xC3, 0xC9, 
+0xD9, 0x13, 0x3E, 0x1E, 0x4C, 0xC8, 0x9C, 0x44, 
+0xDE, 0x51, 0xF3, 0x55, 0x74, 0x53, 0x9D, 0x63, 
+0x95, 0xAD, 0xEA, 0xDF, 0x74, 0x56, 0x09, 0xD8, 
+0x19, 0x40, 0x42, 0xB1, 0xE7, 0xA9, 0x8D, 0x19, 
+0x91, 0xF4, 0xF2, 0x5D, 0x76, 0xF0, 0x54, 0x8D, 
+0x7A, 0x31, 0xB2, 0xA6, 0xC8, 0x43, 0xA5, 0xCD, 
+0x52, 0xBC, 0x5F, 0xFD, 0x88, 0x26, 0x60, 0xA8, 
+0x19, 0x32, 0xDB, 0xB3, 0xA3, 0xC1, 0xC9, 0xBA, 
+0x64, 0x7E, 0xE5, 0x16, 0x9B, 0x83, 0xFF, 0xFF, 
+
+0x57, 0x43, 0x47, 0x63, 0x18, 0xE1, 0x56, 0x2D, 
+0x1B, 0x02, 0x15, 0xD9, 0xAA, 0xE8, 0x5B, 0x43, 
+0x4D, 0xF8, 0x1F, 0x9A, 0xDA, 0xC2, 0xEF, 0x1F, 
+0xFE, 0xD7, 0x02, 0x76, 0xBD, 0xD2, 0xB8, 0x4A, 
+0x06, 0xF4, 0xE6, 0x5C, 0xF6, 0x08, 0x81, 0x7C, 
+0xE6, 0x66, 0xC8, 0x77, 0x34, 0xE3, 0xE8, 0x80, 
+0x1F, 0x29, 0x26, 0xA8, 0x46, 0x25, 0xE2, 0xF4, 
+0x44, 0x7A, 0xF7, 0x56, 0xF4, 0xC0, 0x38, 0x94, 
+0x23, 0xBD, 0x64, 0xD9, 0xF1, 0xD8, 0x6A, 0x9F, 
+0x2A, 0x14, 0x53, 0x85, 0xF8, 0x0D, 0xDE, 0xA7, 
+0x4A, 0x49, 0xE1, 0x09, 0xC5, 0xCE, 0x69, 0xAA, 
+0x1C, 0x07, 0x32, 0x8B, 0x03, 0x1E, 0xAC, 0xA5, 
+0xD7, 0xD9, 0xE4, 0x80, 0x9A, 0x25, 0xA4, 0xBA, 
+0x50, 0xA4, 0x84, 0x2F, 0xD5, 0x3E, 0xA4, 0xD8, 
+0xE5, 0xB0, 0xAE, 0x81, 0x49, 0x16, 0x4F, 0x8E, 
+0x67, 0xB1, 0xA4, 0x57, 0x3D, 0xB4, 0x1F, 0xF6, 
+0x8C, 0xE0, 0x78, 0x0D, 0xAF, 0xB6, 0x2B, 0x6C, 
+0xBF, 0x0D, 0xC7, 0x0C, 0xF0, 0xD7, 0x52, 0xA1, 
+0xA4, 0x4F, 0xAD, 0x44, 0xA4, 0x5D, 0x89, 0x64, 
+0xAE, 0xFE, 0x49, 0x51, 0x08, 0x47, 0x76, 0xD7, 
+0xCE, 0x86, 0xFF, 0x85, 0x7A, 0x17, 0xA5, 0x97, 
+0xFF, 0xAF, 0x43, 0x8F, 0x66, 0xD4, 0x9A, 0xA4, 
+0xEB, 0xBD, 0x7E, 0x36, 0x9C, 0x61, 0x6A, 0xC8, 
+0x3F, 0x56, 0x97, 0x2C, 0xE7, 0x90, 0x98, 0x69, 
+0x20, 0x93, 0xDE, 0x68, 0xA3, 0x83, 0xA9, 0x8E, 
+0xC4, 0x10, 0xEF, 0x75, 0x7D, 0xC1, 0x48, 0x21, 
+0x2E, 0x1F, 0x9A, 0x14, 0x23, 0x89, 0x16, 0x56, 
+0x57, 0xC1, 0x52, 0xC0, 0x84, 0xE6, 0xF4, 0xC1, 
+0x0B, 0x23, 0x34, 0x43, 0x47, 0x3B, 0x71, 0xE3, 
+0xE9, 0xE1, 0x9B, 0x5F, 0x12, 0x83, 0xCC, 0x4B, 
+0xE3, 
+
+0x86, 0x98, 0x1F, 0xB7, 0x66, 0xBA, 0x2F, 0x04, 
+0xC2, 0x83, 0xD9, 0x1E, 0x80, 0x94, 0xF7, 0xC7, 
+0xE4, 0x30, 0x38, 0x06, 0x8A, 0x2D, 0x13, 0x25, 
+0x40, 0x82, 0xE0, 0xE4, 0x05, 0x94, 0xED, 0x5E, 
+0x26, 0xD7, 0xE7, 0x09, 0xA2, 0x70, 0x59, 0x3F, 
+0xAE, 0x19, 0x3F, 0x17, 0x4F, 0xE9, 0x35, 0x74, 
+0xD3, 0x50, 0xD8, 0xC8, 0xB2, 0x34, 0xE9, 0xD6, 
+0x07, 0x54, 0x9D, 0xB8, 0x1F, 0xF8, 0x14, 0x23, 
+0x97, 0xB4, 0xEA, 0xD6, 0x5C, 0x73, 0x42, 0x72, 
+0x09, 0x27, 0xAE, 0x17, 0xEE, 0xF1, 0x83, 0xD8, 
+0x42, 0x02, 0xC3, 0x38, 0xEF, 0x54, 0x7C, 0xB8, 
+0x3D, 0xB1, 0xB2, 0xB5, 0x79, 0xA2, 0x85, 0x95, 
+0x97, 0x1A, 0x7D, 0x3D, 0x1A, 0x49, 0x2D, 0x3E, 
+0x19, 0x07, 0xDC, 0x5B, 0x67, 0xC0, 0x93, 0xF6, 
+0x67, 0xD5, 0xF5, 0x0A, 0x97, 0x1F, 0xF6, 0x32, 
+0x15, 0xAB, 0xBE, 0x06, 0x28, 0xD3, 0x36, 0x7E, 
+0xE1, 0xC8, 0x89, 0x13, 0x15, 0xAC, 0x99, 0xE9, 
+0x64, 0xCF, 0x5E, 0x6E, 0xAB, 0x9F, 0xD5, 0xA0, 
+0xF4, 0x6B, 0x19, 0x2E, 0x89, 0x0F, 0x04, 0x33, 
+0x8A, 0xC6, 0x6A, 0xBA, 0x64, 0x0E, 0x86, 0x76, 
+0x12, 0x10, 0xFD, 0xA3, 0x23, 0xA6, 0xF0, 0x18, 
+0x18, 0xFA, 0x31, 0xF5, 0x11, 0xFE, 0x11, 0xB6, 
+0x0D, 0x8D, 0xE1, 0x5B, 0xBB, 0xCD, 0x0D, 0x22, 
+0x9F, 0x49, 0xFB, 0xC8, 0x7F, 0xC6, 0x3A, 0x58, 
+0xCC, 0x9D, 0x8F, 0xE8, 0x75, 0x19, 0x86, 0x88, 
+0xE4, 0xEF, 0x69, 0xD3, 0xCE, 0x9B, 0x39, 0x1E, 
+0xA9, 0xCB, 0xA9, 0xA4, 0x70, 0x15, 0xE6, 0x5A, 
+0xB7, 0xD5, 0x1A, 0x33, 0x40, 0x4A, 0xC6, 0xF1, 
+0xC9, 0xFD, 0xDA, 0xB8, 0xDB, 0xA4, 0x0D, 0x55, 
+0xCD, 0x7B, 0x17, 0x0D, 0x59, 0xB2, 0xE0, 0xCC, 
+0xF7, 0x4E, 
+
+0x7A, 0xB5, 0x01, 0x66, 0xF1, 0xB0, 0xAB, 0xE5, 
+0x74, 0x93, 0xAD, 0xA0, 0xB9, 0xAF, 0x64, 0x73, 
+0x04, 0xAB, 0x95, 0x53, 0x03, 0x47, 0x08, 0xCF, 
+0xAE, 0xF8, 0x6E, 0x44, 0xCC, 0xD1, 0x26, 0x10, 
+0x54, 0x50, 0xEB, 0x8D, 0xE6, 0x13, 0x7C, 0x13, 
+0x9F, 0xD8, 0x9C, 0x46, 0xBD, 0xFD, 0xE8, 0x78, 
+0x57, 0x1A, 0x5C, 0x89, 0x95, 0xAC, 0x64, 0x0C, 
+0xE9, 0xC3, 0x65, 0xED, 0xC9, 0x91, 0xBA, 0x1A, 
+0x59, 0x5B, 0x18, 0x40, 0xA5, 0xCE, 0x82, 0x8E, 
+0xE3, 0x80, 0xFA, 0xA3, 0xDE, 0xB5, 0xCE, 0x48, 
+0xAD, 0xF8, 0x57, 0xEA, 0xE8, 0xF9, 0x7A, 0xC4, 
+0xF2, 0x82, 0x43, 0x3E, 0xB0, 0x56, 0xFE, 0x04, 
+0x05, 0xC1, 0xC0, 0x3E, 0x18, 0x30, 0xA3, 0x75, 
+0xD0, 0x50, 0xC3, 0x32, 0xBC, 0xD4, 0xCD, 0x5F, 
+0xFD, 0xBF, 0xA5, 0xEC, 0x31, 0x83, 0xB8, 0xAB, 
+0x5D, 0x23, 0x99, 0x13, 0x37, 0x1B, 0xE3, 0x7E, 
+0x63, 0x7F, 0xA5, 0x40, 0xDB, 0xE0, 0xCD, 0xA4, 
+0xF2, 0xB8, 0x55, 0x5F, 0x9F, 0xD2, 0x67, 0x58, 
+0x65, 0xC8, 0x38, 0x33, 0xCC, 0x81, 0x82, 0xCD, 
+0x25, 0x7F, 0x85, 0x2E, 0xDB, 0x61, 0xDD, 0xB5, 
+0x09, 0xD5, 0x5A, 0x2A, 0xF5, 0x47, 0xD9, 0x19, 
+0xE9, 0xDA, 0x43, 0x4F, 0x24, 0x2B, 0x41, 0xF3, 
+0xD3, 0xB5, 0x3E, 0xA8, 0x6B, 0x1B, 0x60, 0x46, 
+0x5D, 0xDD, 0x50, 0xB5, 0xA1, 0xBF, 0x83, 0x1D, 
+0x09, 0x3B, 0x85, 0x0E, 0xE1, 0x1D, 0x30, 0xF9, 
+0xFF, 0x39, 0xE5, 0x24, 0xF0, 0xA6, 0x20, 0xED, 
+0x9E, 0x26, 0x3D, 0x74, 0x85, 0x6F, 0xAB, 0xCB, 
+0x09, 0x40, 0xB2, 0x43, 0x28, 0xF2, 0x2D, 0x99, 
+0x1E, 0x9D, 0xF3, 0x20, 0x1B, 0xC7, 0xDD, 0x8E, 
+0xD6, 0x9F, 0xF8, 0x11, 0x36, 0x80, 0x51, 0x44, 
+0x6A, 0x44, 0x38, 
+
+0x4D, 0x1C, 0xFA, 0xC7, 0x6D, 0x37, 0x43, 0x9C, 
+0x1B, 0xDC, 0xEB, 0x48, 0x11, 0x51, 0x9A, 0x8F, 
+0xCD, 0x6C, 0x52, 0x29, 0xFB, 0x90, 0x3B, 0x76, 
+0x1A, 0x8A, 0x8F, 0xD6, 0xE9, 0x9F, 0x24, 0x4D, 
+0xAD, 0x04, 0xC2, 0x28, 0x6A, 0xF8, 0x9A, 0x40, 
+0x10, 0xF1, 0xE5, 0x8C, 0xB6, 0x36, 0x52, 0xD0, 
+0x76, 0x70, 0x8E, 0x2A, 0x86, 0x10, 0x37, 0xFF, 
+0x9B, 0xF1, 0x75, 0xE3, 0x57, 0x27, 0xC2, 0xEF, 
+0x8B, 0xA4, 0xFF, 0x1D, 0x1C, 0x51, 0x37, 0x86, 
+0x9D, 0x80, 0xED, 0x58, 0x81, 0x18, 0x7B, 0xC0, 
+0xDA, 0xC4, 0xF1, 0xF2, 0x8D, 0x40, 0x6F, 0x96, 
+0xB2, 0x1B, 0x0B, 0xEB, 0x9C, 0xE5, 0x4E, 0x91, 
+0xF0, 0x37, 0x2C, 0xCD, 0xF5, 0x46, 0x03, 0xB8, 
+0x92, 0xE3, 0xBD, 0x0F, 0x52, 0x89, 0x64, 0x41, 
+0xBB, 0x08, 0x95, 0x40, 0x3B, 0x02, 0xB5, 0x72, 
+0x15, 0x99, 0x13, 0xAA, 0xE5, 0xFC, 0x39, 0x66, 
+0x46, 0xD0, 0xC8, 0x9D, 0xB7, 0x8C, 0x2A, 0x00, 
+0x6F, 0x5F, 0xBC, 0xA1, 0x9A, 0x30, 0x43, 0x96, 
+0x94, 0xBE, 0xA3, 0x02, 0x8C, 0x4E, 0x3A, 0x78, 
+0x32, 0x69, 0x52, 0x50, 0xAC, 0x1E, 0x13, 0x5B, 
+0x75, 0x85, 0xBF, 0x08, 0x13, 0xF6, 0x0B, 0x5B, 
+0x16, 0xB6, 0xE3, 0x60, 0x34, 0x0E, 0xD7, 0x53, 
+0x3F, 0x11, 0x7C, 0x63, 0x9F, 0x80, 0x5D, 0xD5, 
+0x9A, 0xF1, 0xD7, 0x8B, 0x0C, 0x96, 0xF8, 0x2E, 
+0x85, 0x21, 0x31, 0xD9, 0x4C, 0xAB, 0x6C, 0x03, 
+0x20, 0xAA, 0xF2, 0xCA, 0xC1, 0x4F, 0xC9, 0x39, 
+0x8C, 0x7F, 0xF8, 0xDE, 0xEC, 0xFB, 0x23, 0xD4, 
+0x34, 0xC2, 0xB8, 0xE2, 0x8A, 0x41, 0xA2, 0xEC, 
+0x08, 0x44, 0x59, 0x74, 0x29, 0xDE, 0xFD, 0x29, 
+0xFB, 0x97, 0x91, 0x9F, 0x63, 0x0D, 0x4A, 0x5D, 
+0xF1, 0x90, 0x8D, 0x48, 
+
+0x17, 0x82, 0x03, 0xD9, 0x1D, 0x0F, 0x9A, 0x5C, 
+0xE9, 0xC6, 0xD1, 0x5D, 0x2A, 0x43, 0xD0, 0x38, 
+0x94, 0x68, 0x20, 0x05, 0x0B, 0x90, 0xF9, 0x97, 
+0xBE, 0xB7, 0x48, 0xDE, 0x93, 0x6C, 0xE4, 0xEB, 
+0xC3, 0xBE, 0xBA, 0x59, 0x9D, 0x3D, 0x40, 0xC8, 
+0x9B, 0x98, 0x6D, 0xA4, 0x3B, 0x94, 0x38, 0x2A, 
+0x4E, 0x18, 0x5A, 0x4F, 0x08, 0xED, 0x93, 0x4D, 
+0x3C, 0x42, 0x8C, 0x56, 0x2C, 0xB6, 0xA4, 0x66, 
+0x9B, 0xD4, 0x76, 0x72, 0xCD, 0x9B, 0x0E, 0x5F, 
+0x47, 0x8C, 0x2B, 0xB6, 0xED, 0xB9, 0xDA, 0x8E, 
+0x06, 0x85, 0x55, 0x10, 0x40, 0x7C, 0x00, 0xA7, 
+0x55, 0xB4, 0xC7, 0x8B, 0x39, 0x49, 0x86, 0xC1, 
+0x0A, 0x56, 0xA6, 0x4B, 0x3E, 0xA5, 0x79, 0xEA, 
+0x1D, 0xC8, 0xE2, 0xE1, 0x0E, 0xC8, 0x78, 0x1E, 
+0x05, 0xA9, 0x8F, 0x30, 0xAB, 0x0A, 0x9E, 0xA6, 
+0x1E, 0xEB, 0xA1, 0x41, 0x3D, 0x59, 0xC5, 0x99, 
+0x0E, 0xFF, 0xF4, 0x23, 0x8C, 0xC2, 0x38, 0x00, 
+0x9C, 0x68, 0x28, 0x08, 0x18, 0x1F, 0x77, 0x8F, 
+0x1A, 0x37, 0xE2, 0x54, 0x7A, 0xEE, 0x8B, 0x4F, 
+0x8D, 0xCE, 0xF1, 0xEF, 0xD6, 0x43, 0x85, 0xF3, 
+0xB2, 0xFC, 0x43, 0x57, 0xA5, 0x33, 0x24, 0x39, 
+0xC9, 0x17, 0xF8, 0x5B, 0xC5, 0x75, 0xC8, 0xFA, 
+0xCF, 0x57, 0x07, 0x5A, 0x0C, 0x7A, 0x3C, 0x96, 
+0xFE, 0xC2, 0xC2, 0x1B, 0xC3, 0xF5, 0xD3, 0x5B, 
+0x2C, 0x36, 0x72, 0x52, 0xC8, 0x77, 0xB1, 0x04, 
+0xBE, 0xAC, 0x96, 0x31, 0x31, 0x5C, 0x89, 0x87, 
+0x31, 0x2B, 0xB3, 0xC4, 0xA5, 0xF8, 0x57, 0xB4, 
+0xBA, 0x7B, 0x83, 0xB9, 0x88, 0xDF, 0x1D, 0x48, 
+0xC6, 0x12, 0x45, 0xD1, 0xE4, 0x06, 0x75, 0xFA, 
+0xB2, 0x12, 0x12, 0x37, 0x70, 0x2A, 0x9C, 0x2A, 
+0xB1, 0x83, 0x58, 0x1F, 0x48, 
+
+0x3B, 0x7F, 0xBE, 0x2C, 0x72, 0x7D, 0xB7, 0x0E, 
+0x63, 0x00, 0xB9, 0x20, 0xBF, 0x32, 0xD3, 0x24, 
+0x2C, 0xF4, 0x2E, 0xE2, 0xAF, 0xEA, 0xC2, 0xC8, 
+0xCF, 0xD0, 0xCD, 0x71, 0x20, 0x5C, 0x60, 0x33, 
+0xE2, 0xCE, 0x07, 0x81, 0xD4, 0x4A, 0xE0, 0x55, 
+0x18, 0x11, 0xD3, 0x15, 0x93, 0x65, 0x1F, 0xC1, 
+0x4E, 0x7E, 0xB5, 0xE1, 0x8B, 0x6B, 0xAE, 0xAF, 
+0x3E, 0x85, 0x9E, 0x3F, 0xAC, 0xBA, 0x24, 0xC6, 
+0x9F, 0x27, 0xBC, 0x58, 0x1C, 0x85, 0xAD, 0x39, 
+0x1A, 0xD4, 0x3B, 0x38, 0xE4, 0x86, 0x8A, 0xA4, 
+0x49, 0xD9, 0xCE, 0x03, 0x6F, 0x12, 0xEE, 0x2C, 
+0x87, 0xE4, 0x0D, 0x3F, 0xA3, 0x5C, 0xC7, 0x9F, 
+0x2C, 0x57, 0x58, 0x44, 0x1E, 0xE0, 0xB4, 0x64, 
+0x8E, 0xA4, 0x88, 0xF1, 0x95, 0x0D, 0x3C, 0xBB, 
+0xC0, 0x91, 0x75, 0xCE, 0x55, 0x9B, 0x2D, 0x20, 
+0x5C, 0x2C, 0x6A, 0xF5, 0x36, 0xEC, 0xBF, 0x6B, 
+0x83, 0x4B, 0xD2, 0xCF, 0xAA, 0xB0, 0x71, 0x1D, 
+0xF5, 0x9F, 0xA5, 0x09, 0xBC, 0x30, 0x06, 0x68, 
+0x5E, 0xF3, 0x80, 0x86, 0x18, 0xD4, 0x61, 0xA7, 
+0xCB, 0x79, 0x87, 0x07, 0x80, 0xE3, 0xD3, 0x6B, 
+0x6D, 0x7B, 0x47, 0x4A, 0xE4, 0xBD, 0xAC, 0x72, 
+0x64, 0xB2, 0x98, 0xAC, 0x3D, 0x13, 0xA2, 0x0F, 
+0x27, 0x83, 0x5A, 0x02, 0xCF, 0xBA, 0x1F, 0x37, 
+0x61, 0xB3, 0x0F, 0xB2, 0xBD, 0x44, 0x9E, 0x54, 
+0x2E, 0xB0, 0x12, 0x71, 0xE5, 0xCC, 0x78, 0x13, 
+0x9C, 0xC2, 0x91, 0xE7, 0xCC, 0x9C, 0xAF, 0xA4, 
+0x09, 0xC0, 0xC7, 0x14, 0x53, 0x52, 0xFA, 0x90, 
+0x6A, 0x26, 0x89, 0x24, 0x93, 0x1C, 0x4A, 0xA3, 
+0x9D, 0xBE, 0xEF, 0x7D, 0xD0, 0xD4, 0xFA, 0x4E, 
+0x69, 0xAC, 0xA3, 0x5E, 0xBE, 0x8D, 0x9F, 0xB1, 
+0xB3, 0x24, 0x42, 0xB1, 0xEF, 0x1E, 
+
+0xCD, 0x10, 0x1B, 0x2C, 0x71, 0x5F, 0xB0, 0x28, 
+0x65, 0x47, 0xCC, 0x3E, 0x74, 0x72, 0xD7, 0x7D, 
+0xF0, 0x33, 0x62, 0x59, 0x61, 0x90, 0x93, 0x9F, 
+0x5B, 0xAC, 0x69, 0xE4, 0x6F, 0x86, 0xF3, 0xCE, 
+0x72, 0xD5, 0xBD, 0xF4, 0x3F, 0xCE, 0x67, 0x1E, 
+0xBA, 0xFC, 0x24, 0x31, 0x7D, 0xC5, 0xCF, 0x4B, 
+0x55, 0xC8, 0x5E, 0xE7, 0x30, 0xA5, 0x59, 0x85, 
+0x57, 0x8C, 0x69, 0xF6, 0xDA, 0xDD, 0x0B, 0x0C, 
+0xA8, 0x5B, 0x6E, 0x48, 0xEE, 0xEA, 0xB8, 0xAE, 
+0xE0, 0xD6, 0x6D, 0x70, 0x8C, 0x3E, 0xB2, 0x00, 
+0xD6, 0x3C, 0x03, 0x06, 0x24, 0x65, 0x79, 0xC8, 
+0xAE, 0x39, 0x5E, 0x2D, 0xC1, 0x77, 0xBC, 0xB4, 
+0xBE, 0xDE, 0x35, 0x7D, 0x6B, 0x34, 0x53, 0x01, 
+0x1C, 0x2D, 0xF4, 0x2A, 0x7B, 0x57, 0x09, 0x89, 
+0x38, 0x08, 0x85, 0xD2, 0x02, 0x1F, 0x60, 0x6D, 
+0x49, 0x79, 0x0F, 0x36, 0x07, 0xDD, 0x6A, 0x9D, 
+0xA8, 0x74, 0x55, 0xC3, 0x30, 0x30, 0xF1, 0x23, 
+0x8B, 0xBF, 0x0A, 0xE1, 0xBD, 0xC0, 0x6A, 0xBA, 
+0x0D, 0xB7, 0x81, 0xE7, 0x40, 0x66, 0x06, 0x6E, 
+0xC1, 0xD9, 0xE6, 0xFD, 0xD6, 0x43, 0x31, 0x60, 
+0xA4, 0x7F, 0x87, 0xA6, 0x4F, 0x2A, 0xDB, 0x07, 
+0xB8, 0x30, 0xB6, 0x04, 0x47, 0xA7, 0x50, 0xD8, 
+0x83, 0x9F, 0x64, 0xB0, 0xF0, 0x8B, 0x40, 0xEE, 
+0x37, 0x9B, 0x29, 0x7E, 0x62, 0x14, 0xB7, 0x6E, 
+0xAC, 0x36, 0xCA, 0x16, 0x34, 0x38, 0xBB, 0x45, 
+0x5B, 0xCA, 0xF0, 0xE5, 0x0F, 0xD1, 0x15, 0xDB, 
+0xD3, 0x37, 0xCE, 0xA0, 0x02, 0x12, 0x34, 0x46, 
+0x30, 0x28, 0x7A, 0x16, 0xBF, 0x11, 0x3F, 0x26, 
+0xBD, 0x26, 0xE0, 0xB6, 0x57, 0x3A, 0x7B, 0xB7, 
+0x97, 0x79, 0x85, 0x8E, 0xEC, 0xD1, 0x9A, 0xAE, 
+0x67, 0x31, 0xA0, 0x9C, 0x54, 0x47, 0x81, 
+
+0x54, 0x51, 0x9D, 0xB9, 0x42, 0x98, 0xEC, 0x71, 
+0x9D, 0x4C, 0x4D, 0xF0, 0x6D, 0xC0, 0xAD, 0x2C, 
+0x41, 0xE0, 0x9C, 0x70, 0x95, 0x34, 0x77, 0x57, 
+0xE3, 0x3A, 0xA7, 0x41, 0x62, 0x26, 0xFC, 0xC5, 
+0xDF, 0xCF, 0xFF, 0xDA, 0xF8, 0x02, 0xE4, 0xB1, 
+0x20, 0x17, 0xC2, 0x58, 0x3D, 0x83, 0x4E, 0x03, 
+0x34, 0x0B, 0x6A, 0xC0, 0x9A, 0x52, 0x03, 0x9A, 
+0x0F, 0xC3, 0xCE, 0xD7, 0xA8, 0x56, 0x78, 0xCA, 
+0xFB, 0x2D, 0x88, 0x6F, 0x2A, 0x4C, 0x65, 0x99, 
+0xE3, 0xEB, 0x48, 0x82, 0x8C, 0xB3, 0x1E, 0x92, 
+0xF0, 0x47, 0xE9, 0xE3, 0x02, 0x34, 0xCD, 0x65, 
+0x45, 0xA9, 0x42, 0xA8, 0x4B, 0xF3, 0x6F, 0xF6, 
+0x79, 0xDC, 0xFB, 0x03, 0x0B, 0x5E, 0x75, 0x1A, 
+0x8A, 0xC9, 0x87, 0xA1, 0xEA, 0x91, 0xDF, 0xED, 
+0x3C, 0x50, 0xAF, 0x17, 0x72, 0xE6, 0x65, 0x0E, 
+0xF0, 0x9E, 0x79, 0x40, 0x4B, 0xE0, 0xD4, 0x19, 
+0x27, 0x4C, 0x32, 0x83, 0xA5, 0xAF, 0xA0, 0x23, 
+0xFC, 0x2D, 0x94, 0x01, 0xF0, 0xF7, 0x12, 0xFA, 
+0xD7, 0x38, 0x8C, 0x57, 0xD5, 0x0E, 0xD8, 0x27, 
+0x05, 0x0A, 0xCC, 0xD3, 0x99, 0xB5, 0x9A, 0x45, 
+0x1A, 0x05, 0x6A, 0x33, 0xA9, 0x56, 0x01, 0xF0, 
+0xC9, 0x7D, 0x0C, 0xA9, 0x4B, 0x1C, 0x59, 0xF1, 
+0xD1, 0xFD, 0x30, 0x79, 0x67, 0x2C, 0xA6, 0x6B, 
+0x88, 0x1F, 0xEB, 0x4B, 0xCB, 0x45, 0x40, 0x97, 
+0x5F, 0x1A, 0x6F, 0x3E, 0xB8, 0xEA, 0x32, 0xDD, 
+0xD3, 0xC0, 0x78, 0x3B, 0x0B, 0x7B, 0x81, 0x97, 
+0x3F, 0xFB, 0x4A, 0x24, 0xFE, 0x55, 0xA9, 0x35, 
+0x5D, 0xCF, 0x0B, 0x1C, 0xEB, 0x75, 0x07, 0xDA, 
+0x0E, 0x7D, 0xF3, 0x2B, 0x18, 0x20, 0x76, 0x4C, 
+0x0E, 0x66, 0x57, 0xE0, 0xDF, 0x26, 0x86, 0xB7, 
+0x60, 0x63, 0xD2, 0x82, 0xBA, 0x82, 0xEA, 0x9B, 
+
+0x49, 0x7C, 0xBE, 0x71, 0x0B, 0x02, 0x73, 0x7C, 
+0xDF, 0x0F, 0xB3, 0x09, 0xBB, 0x25, 0x5A, 0xA4, 
+0x3E, 0x37, 0xD2, 0x3E, 0x44, 0xF4, 0x26, 0xBA, 
+0x87, 0x8B, 0xE6, 0x88, 0x59, 0x52, 0xD6, 0x91, 
+0x8C, 0x12, 0x82, 0x9F, 0x36, 0x23, 0x60, 0xA3, 
+0x5C, 0x96, 0x9D, 0x9E, 0x01, 0x85, 0x44, 0x34, 
+0x58, 0x97, 0xE3, 0xA4, 0x28, 0x2B, 0x49, 0x86, 
+0x11, 0x22, 0x75, 0x93, 0x48, 0xDC, 0x77, 0x0D, 
+0x30, 0xB3, 0xCA, 0xCF, 0x5F, 0x89, 0x93, 0x85, 
+0x55, 0xB8, 0x56, 0x0C, 0xE3, 0x39, 0x23, 0x80, 
+0xD6, 0xB2, 0x21, 0x0F, 0x5B, 0x4F, 0x5A, 0x31, 
+0xEF, 0x3C, 0xCB, 0x5A, 0x83, 0x61, 0x61, 0x15, 
+0x02, 0xA1, 0x3C, 0x7B, 0x91, 0xEA, 0x6B, 0x3D, 
+0x5F, 0x41, 0xD4, 0x89, 0xD5, 0x79, 0x86, 0xAA, 
+0x02, 0x1F, 0x5C, 0x17, 0xAD, 0x8B, 0x2B, 0xE5, 
+0x98, 0x97, 0x8B, 0x76, 0x7B, 0x10, 0x76, 0x90, 
+0xFA, 0xF4, 0xC5, 0xD7, 0xCF, 0x1B, 0x04, 0x84, 
+0xC1, 0xB7, 0xCE, 0xD8, 0xD8, 0xBF, 0x95, 0x83, 
+0x26, 0x66, 0x51, 0x6A, 0xB3, 0x0D, 0xEB, 0xD6, 
+0xF3, 0x53, 0xC3, 0x18, 0xD5, 0xD7, 0x58, 0x76, 
+0x78, 0xD7, 0xBF, 0x00, 0x98, 0x61, 0x10, 0xAF, 
+0x9F, 0x82, 0x2F, 0x18, 0x12, 0xDD, 0x70, 0xF0, 
+0xF1, 0x8A, 0xF3, 0x9E, 0xE4, 0x98, 0xEA, 0xD2, 
+0x5D, 0x7E, 0x09, 0x6A, 0xD5, 0x79, 0x93, 0xEF, 
+0x7F, 0xA3, 0x32, 0xA4, 0x01, 0x15, 0x39, 0x56, 
+0x4E, 0xA6, 0xDA, 0xAE, 0x34, 0x2D, 0xBB, 0xA7, 
+0xF9, 0x64, 0x5B, 0xFD, 0xD3, 0x1B, 0xD4, 0xA6, 
+0x74, 0x4F, 0xFF, 0x15, 0xEE, 0xE2, 0x1D, 0xD6, 
+0x43, 0xCC, 0x6E, 0x6A, 0xC2, 0xEF, 0x09, 0xA6, 
+0xA5, 0x38, 0xD2, 0xEA, 0x1C, 0xC9, 0x61, 0x19, 
+0x8F, 0x0A, 0x34, 0x39, 0xE2, 0x18, 0xE7, 0x31, 
+0xD4, 
+
+0xAD, 0x73, 0xB9, 0x25, 0x77, 0xCE, 0xC5, 0xE3, 
+0x6C, 0x2F, 0x02, 0x2F, 0x0E, 0x17, 0xD5, 0xF0, 
+0x4D, 0xFD, 0xE1, 0xEC, 0x9F, 0x56, 0x9C, 0xF1, 
+0x9B, 0xF2, 0x48, 0x4B, 0x68, 0xE2, 0x42, 0x35, 
+0xBA, 0x3F, 0x5E, 0x77, 0x42, 0xEF, 0x3B, 0xF2, 
+0x16, 0xD8, 0x2F, 0x46, 0xF8, 0x9B, 0xDE, 0x68, 
+0x51, 0x1E, 0x83, 0x76, 0xC3, 0xDD, 0x50, 0x55, 
+0x54, 0x30, 0x28, 0x12, 0xDC, 0xCA, 0xF0, 0x7F, 
+0xCE, 0xE7, 0xE9, 0x1B, 0x8E, 0x7B, 0x12, 0xE6, 
+0x2A, 0x1B, 0xE0, 0x52, 0x1F, 0xDF, 0xBB, 0x41, 
+0x91, 0xB8, 0xEA, 0xC7, 0x4A, 0x3D, 0x44, 0x04, 
+0xD1, 0x8C, 0x61, 0xE7, 0xA8, 0xB7, 0x9D, 0x71, 
+0x89, 0xED, 0x3D, 0x83, 0x34, 0x0F, 0x46, 0xE9, 
+0x4E, 0xDE, 0x5C, 0x17, 0xE4, 0x8B, 0xA1, 0x18, 
+0x15, 0x4D, 0x57, 0x3D, 0x3F, 0xD3, 0xA7, 0x18, 
+0x9A, 0x80, 0x12, 0xCF, 0x18, 0x31, 0xEC, 0x06, 
+0x6D, 0x8C, 0x70, 0x9E, 0xD5, 0x6D, 0x25, 0x74, 
+0x23, 0x6B, 0x13, 0x8E, 0xA6, 0xD8, 0x75, 0xE9, 
+0xB5, 0xD6, 0xAD, 0xDC, 0x39, 0x27, 0xC1, 0x4B, 
+0xE9, 0xE1, 0xD3, 0xC6, 0xE3, 0x1A, 0x19, 0x4C, 
+0xBF, 0x53, 0x9C, 0xEC, 0x2A, 0x75, 0x92, 0x05, 
+0x14, 0x53, 0xE0, 0x82, 0x91, 0xFC, 0x25, 0xA4, 
+0x2E, 0x9F, 0xA1, 0x71, 0x0E, 0x0F, 0x46, 0xC3, 
+0x7C, 0x8C, 0xAE, 0x3D, 0xB0, 0x21, 0x9C, 0x37, 
+0x9B, 0xDD, 0x22, 0xAF, 0xE8, 0xB2, 0xA4, 0xDA, 
+0xC6, 0xE2, 0x4D, 0xAD, 0x6D, 0x06, 0x4E, 0xF0, 
+0x8B, 0x5D, 0x51, 0x2D, 0xDF, 0x9D, 0x89, 0xF5, 
+0xCE, 0x9C, 0x7E, 0xB7, 0x03, 0xCC, 0x24, 0x7A, 
+0xED, 0x4C, 0xEF, 0x83, 0x76, 0x79, 0x0B, 0x72, 
+0x0C, 0x27, 0x90, 0x25, 0xAF, 0xF0, 0x8B, 0xB4, 
+0xCD, 0xA9, 0x88, 0x24, 0xAA, 0x81, 0x8F, 0x9B, 
+0x9A, 0x6B, 
+
+0xFE, 0x95, 0xBC, 0x0D, 0x77, 0x23, 0x41, 0x41, 
+0x23, 0x4B, 0xBD, 0x5D, 0xBE, 0x75, 0xFC, 0x61, 
+0x57, 0xDC, 0x3A, 0xD1, 0xAF, 0xC7, 0xB4, 0xB3, 
+0xA0, 0x92, 0xB9, 0x31, 0xEC, 0xF4, 0x48, 0x70, 
+0x67, 0x08, 0xE5, 0xC7, 0x98, 0xC2, 0xED, 0xA7, 
+0xCC, 0x13, 0x53, 0x4E, 0x65, 0x82, 0x4E, 0xD6, 
+0x13, 0x4C, 0xC0, 0x10, 0xDF, 0x53, 0xE9, 0xC0, 
+0x92, 0x95, 0x80, 0x8F, 0xBB, 0x68, 0x80, 0x21, 
+0x20, 0x2F, 0x2C, 0x2D, 0xF2, 0xD5, 0x74, 0x7B, 
+0xB2, 0x7E, 0x06, 0x9D, 0x81, 0xF9, 0xF1, 0xCF, 
+0xDF, 0x32, 0xFD, 0xB4, 0x52, 0x69, 0xF6, 0x97, 
+0xD3, 0x4E, 0x7A, 0x40, 0x1B, 0x62, 0x74, 0x7A, 
+0x1B, 0x7E, 0x29, 0xA4, 0x47, 0x68, 0x78, 0x58, 
+0x93, 0xD5, 0x74, 0xC5, 0x4B, 0x7C, 0x95, 0xCE, 
+0x37, 0x48, 0x07, 0x99, 0x09, 0x57, 0x6F, 0x33, 
+0x6F, 0xCA, 0x5F, 0x4A, 0xB4, 0x7B, 0x41, 0xE5, 
+0x91, 0x46, 0x6A, 0xB7, 0x79, 0xD8, 0x8B, 0x3D, 
+0xAF, 0x79, 0x96, 0xF9, 0xEB, 0x26, 0x4A, 0x55, 
+0xD5, 0xAF, 0x32, 0x2A, 0x41, 0xFA, 0xB8, 0x60, 
+0x05, 0xFE, 0x06, 0xD0, 0xA6, 0x54, 0x7C, 0x78, 
+0x76, 0xE6, 0x50, 0xB3, 0x37, 0x55, 0x61, 0xD0, 
+0xC3, 0x39, 0xC3, 0x40, 0xF2, 0xA6, 0xD3, 0xEF, 
+0x90, 0x52, 0xAD, 0xE4, 0x15, 0x2C, 0xA4, 0xDD, 
+0xDB, 0x29, 0x58, 0x56, 0x52, 0xD6, 0xB0, 0x0E, 
+0x01, 0x96, 0x50, 0x97, 0x92, 0x96, 0xAF, 0x0C, 
+0x77, 0x51, 0x8F, 0xF2, 0xAB, 0xB6, 0x6F, 0x62, 
+0xB9, 0xDA, 0xBF, 0xF5, 0xA8, 0x24, 0xF0, 0xB0, 
+0xE7, 0x3B, 0x2C, 0x76, 0x6B, 0xB7, 0xF8, 0x6C, 
+0x8D, 0x52, 0x1C, 0xBB, 0x4B, 0x67, 0x7E, 0x22, 
+0x10, 0xFB, 0x7C, 0xB8, 0x77, 0x9E, 0x0A, 0xD4, 
+0x4A, 0x1D, 0x43, 0x17, 0x97, 0x2A, 0xA4, 0xC6, 
+0x69, 0x24, 0xFA, 
+
+0xAA, 0x4C, 0xC2, 0x53, 0x36, 0xD6, 0xE0, 0x87, 
+0xFE, 0x15, 0x2C, 0x96, 0x00, 0xF6, 0x43, 0x80, 
+0x2F, 0x40, 0xF8, 0x79, 0xFA, 0x2D, 0x62, 0x37, 
+0x8E, 0x34, 0x54, 0x3C, 0x2E, 0xC1, 0xE0, 0x45, 
+0x7A, 0x4E, 0x93, 0xBC, 0x84, 0x5B, 0x85, 0xAD, 
+0xFD, 0xC4, 0xA7, 0x7A, 0x38, 0x78, 0xAD, 0xC5, 
+0xC4, 0xE8, 0x94, 0xB5, 0x69, 0xA6, 0x0C, 0xDA, 
+0x99, 0x78, 0x77, 0x40, 0xFE, 0x49, 0xD9, 0x23, 
+0x9B, 0xD4, 0x08, 0xD7, 0xA5, 0x1F, 0x4F, 0x9C, 
+0x66, 0x80, 0xF8, 0x10, 0x84, 0x91, 0x2F, 0x12, 
+0xE4, 0x88, 0xDD, 0x37, 0xA8, 0xBC, 0xAE, 0xC4, 
+0x1B, 0x0E, 0xA8, 0x8E, 0x6D, 0x84, 0xF5, 0xF3, 
+0x54, 0x4C, 0x60, 0x22, 0x66, 0x1B, 0x21, 0x4A, 
+0x1B, 0x65, 0xF3, 0xBA, 0x61, 0xAB, 0x39, 0x4D, 
+0x18, 0xD8, 0xA5, 0xDA, 0x21, 0x41, 0x0B, 0x12, 
+0x2A, 0xB4, 0xAF, 0x27, 0xFF, 0xB1, 0xA9, 0xE5, 
+0xC1, 0xC7, 0x83, 0xFE, 0xAE, 0xEC, 0x5A, 0xC7, 
+0xBB, 0x87, 0x74, 0x23, 0x21, 0x5A, 0x52, 0xE2, 
+0x37, 0xCF, 0x07, 0x39, 0x59, 0x98, 0x4E, 0x38, 
+0x21, 0xA8, 0x08, 0x7D, 0x30, 0x4D, 0x4E, 0xF9, 
+0x59, 0xED, 0xE2, 0x74, 0xF3, 0x15, 0x56, 0x34, 
+0x0F, 0xB9, 0x93, 0x9A, 0xEE, 0x7C, 0xC0, 0x05, 
+0xB9, 0x67, 0x88, 0x77, 0x11, 0x1C, 0x9F, 0x4C, 
+0xED, 0x98, 0xE8, 0x96, 0x2A, 0xAA, 0x7F, 0x73, 
+0xBF, 0x11, 0x75, 0x78, 0x33, 0xEC, 0xE9, 0x47, 
+0x45, 0x3C, 0xDE, 0x4E, 0x60, 0xAF, 0x28, 0x97, 
+0x57, 0x35, 0x9F, 0xE8, 0x5A, 0xE9, 0x9D, 0x8E, 
+0x3F, 0xEF, 0x18, 0xC0, 0x0B, 0x75, 0x13, 0x75, 
+0xB8, 0xFC, 0x93, 0x54, 0xA9, 0x46, 0x88, 0xCF, 
+0xED, 0x06, 0xF1, 0x26, 0x39, 0xCD, 0x89, 0x5A, 
+0xC0, 0x0B, 0x00, 0x09, 0xD0, 0xA7, 0xCA, 0xDB, 
+0xA4, 0xC1, 0xCD, 0x6F, 
+
+0xFC, 0xA5, 0x5A, 0xE5, 0xE0, 0x35, 0xA9, 0xC8, 
+0x79, 0xAD, 0x42, 0xA4, 0x65, 0x51, 0xAD, 0x60, 
+0xA3, 0x1F, 0x87, 0x9A, 0x6B, 0xCA, 0x28, 0x17, 
+0x2F, 0x35, 0xF7, 0x16, 0x2A, 0x8D, 0xE3, 0x2C, 
+0x8F, 0xD9, 0x2B, 0xF5, 0x3F, 0x15, 0x33, 0x67, 
+0xFC, 0x19, 0x0F, 0xFE, 0xF1, 0x9F, 0x97, 0xB4, 
+0x1A, 0x7B, 0x43, 0x7D, 0xFC, 0x8D, 0xC2, 0x31, 
+0x46, 0xDE, 0xE0, 0x20, 0x05, 0x7D, 0x93, 0xAB, 
+0xB7, 0x8D, 0x3C, 0xE4, 0xE0, 0x4E, 0xD6, 0x57, 
+0x02, 0xF9, 0xFB, 0x91, 0x00, 0x45, 0x31, 0xCE, 
+0x0F, 0xB4, 0xEC, 0x25, 0x8A, 0xF4, 0x06, 0xB4, 
+0xA7, 0xCF, 0xEE, 0x1B, 0x49, 0x19, 0x31, 0x60, 
+0xC3, 0x8B, 0xE0, 0x92, 0x91, 0xD2, 0x04, 0x42, 
+0xB2, 0xB7, 0xDD, 0xC8, 0xF9, 0xB9, 0x14, 0xA0, 
+0x80, 0x92, 0x1B, 0xFE, 0xD5, 0x37, 0x5B, 0x0E, 
+0x04, 0xD0, 0x78, 0xDE, 0xD2, 0xE1, 0x3A, 0x37, 
+0xFE, 0x07, 0xB1, 0x8D, 0xE6, 0xB9, 0x98, 0xF2, 
+0x9A, 0x44, 0x5C, 0x1B, 0x07, 0xCB, 0xD0, 0x0B, 
+0x08, 0x89, 0x4F, 0xF4, 0x3E, 0xE9, 0xDA, 0x61, 
+0x14, 0x4F, 0xA8, 0x81, 0xBB, 0x0F, 0x51, 0xC9, 
+0x7A, 0x0B, 0x56, 0x04, 0xD5, 0x66, 0x33, 0xCA, 
+0x9A, 0x0A, 0x17, 0x76, 0xFD, 0xB6, 0x95, 0x67, 
+0xDB, 0xF9, 0xA1, 0x26, 0xD9, 0x5B, 0xE1, 0x54, 
+0xD2, 0x56, 0x8D, 0xC9, 0x56, 0x7D, 0x72, 0x88, 
+0x3C, 0x2F, 0xF3, 0xA7, 0x5A, 0x1D, 0xDE, 0xDC, 
+0xCE, 0x73, 0x64, 0xF2, 0x2C, 0xD5, 0x22, 0xDB, 
+0xE9, 0xDE, 0xE8, 0xF3, 0x6E, 0x9E, 0x60, 0x42, 
+0x56, 0xBC, 0x0E, 0xD4, 0x96, 0x33, 0x8A, 0x7A, 
+0x1E, 0x1A, 0x69, 0x57, 0x71, 0x8D, 0x62, 0x1D, 
+0x36, 0x9D, 0xDA, 0x2A, 0xBB, 0x60, 0xC6, 0xDB, 
+0xDA, 0x67, 0x6B, 0x75, 0xB3, 0xBE, 0xB8, 0x4E, 
+0x5B, 0xED, 0x6C, 0x60, 0x91, 
+
+0x9D, 0x64, 0x5A, 0xD8, 0x92, 0xCF, 0x79, 0x9B, 
+0x04, 0xDD, 0x71, 0x79, 0x22, 0xB3, 0x0D, 0x5A, 
+0xE0, 0x0F, 0x1F, 0x65, 0x0C, 0x04, 0x2A, 0xC9, 
+0xAC, 0x7F, 0x2F, 0xDA, 0xAE, 0xAC, 0x54, 0xC3, 
+0x7B, 0x27, 0x72, 0x62, 0x69, 0x56, 0xDE, 0xCF, 
+0x7A, 0xC1, 0x09, 0x35, 0x3E, 0x3B, 0x57, 0x12, 
+0x00, 0x6B, 0xC4, 0x89, 0x52, 0x03, 0x8C, 0x4B, 
+0xF7, 0x70, 0x1C, 0x87, 0xE4, 0x07, 0x27, 0x04, 
+0xE2, 0x41, 0x69, 0x87, 0x92, 0x43, 0xEC, 0x60, 
+0x58, 0x3E, 0x7E, 0x54, 0x6F, 0x9E, 0x27, 0x13, 
+0xEA, 0x03, 0x34, 0x23, 0x6F, 0x86, 0xD1, 0xCF, 
+0xDA, 0xB0, 0x04, 0x77, 0x87, 0x61, 0xEE, 0x1D, 
+0x9A, 0x61, 0x46, 0x42, 0x9E, 0xF0, 0x69, 0x90, 
+0x6C, 0x06, 0x81, 0xD5, 0xC2, 0xC8, 0x15, 0xE0, 
+0x3A, 0xE3, 0x54, 0x31, 0xA2, 0xA8, 0x74, 0x42, 
+0x32, 0x68, 0xE7, 0x57, 0x68, 0xA8, 0x09, 0x8F, 
+0x0F, 0x7E, 0x2A, 0xF7, 0x28, 0xAC, 0xDA, 0xC1, 
+0x26, 0x78, 0x27, 0x59, 0x5F, 0xE7, 0xB4, 0x77, 
+0x0A, 0x41, 0x5E, 0x1B, 0xFD, 0xDF, 0x7C, 0xDB, 
+0xA6, 0x67, 0xFE, 0xC5, 0xFB, 0xA5, 0x33, 0x3C, 
+0x74, 0x50, 0x29, 0x7B, 0x48, 0x67, 0xD7, 0xE4, 
+0xC1, 0x35, 0xCC, 0x3F, 0x7C, 0xD1, 0x34, 0xA0, 
+0xA3, 0x99, 0xDB, 0x5A, 0xB9, 0x52, 0xA0, 0x24, 
+0x04, 0xCC, 0x3B, 0xBF, 0xB6, 0xC2, 0x6E, 0x9C, 
+0x20, 0xCC, 0x93, 0x7A, 0xDA, 0x13, 0x63, 0x61, 
+0x4A, 0xA5, 0xD4, 0x73, 0x0D, 0x7D, 0x1A, 0x14, 
+0x01, 0x45, 0xCA, 0x29, 0xBD, 0xB6, 0xDF, 0x3D, 
+0x2B, 0x1D, 0x9F, 0x5D, 0x40, 0x92, 0x08, 0x9B, 
+0x2B, 0x59, 0x21, 0x7E, 0xB0, 0x47, 0x0B, 0xB3, 
+0xEF, 0xA2, 0xBD, 0x1A, 0xAA, 0xC2, 0xB6, 0x75, 
+0x6D, 0x17, 0x6B, 0xBF, 0xCC, 0xDE, 0xE8, 0x0C, 
+0x8D, 0x74, 0xE8, 0xF1, 0x0D, 0x79, 
+
+0x4E, 0x70, 0x5C, 0x53, 0x19, 0x11, 0x6F, 0x9D, 
+0x2C, 0xF7, 0x77, 0x7D, 0x2D, 0xBE, 0xAC, 0xD9, 
+0x56, 0xF2, 0x34, 0xB5, 0x27, 0x2A, 0x48, 0x55, 
+0xCB, 0xE3, 0xB6, 0x53, 0x40, 0x46, 0x4C, 0xA4, 
+0x56, 0x5E, 0x00, 0x60, 0xD5, 0xBB, 0xC6, 0x2B, 
+0x6F, 0x4C, 0x03, 0x07, 0xD5, 0x36, 0x03, 0xBE, 
+0x26, 0x3D, 0x94, 0x0A, 0xDC, 0x29, 0xAC, 0x7E, 
+0x13, 0x48, 0x32, 0x6C, 0x29, 0x88, 0xDA, 0xF5, 
+0x55, 0x8A, 0x8B, 0xAE, 0xB3, 0xB6, 0x20, 0x94, 
+0xA1, 0x30, 0x9B, 0xA2, 0xAD, 0x17, 0x6F, 0xC6, 
+0xC4, 0x8B, 0xA7, 0xB1, 0x2B, 0x98, 0x66, 0x34, 
+0x84, 0x85, 0xC2, 0xC9, 0x20, 0x87, 0x83, 0x51, 
+0x16, 0x55, 0x47, 0xF6, 0xAD, 0xDF, 0x86, 0xA7, 
+0x6E, 0x4C, 0x75, 0xCA, 0xC7, 0xC2, 0xE9, 0x2F, 
+0x6A, 0x85, 0x6D, 0xE1, 0xA0, 0x99, 0x2D, 0x12, 
+0xAA, 0xEB, 0x62, 0x70, 0x36, 0x62, 0x33, 0xDC, 
+0xF8, 0xB4, 0x5B, 0x3F, 0x6B, 0x92, 0xAF, 0x08, 
+0x80, 0xA5, 0x62, 0x76, 0x61, 0xC4, 0xE2, 0x39, 
+0x44, 0xE4, 0x06, 0xBE, 0xE0, 0x2A, 0xDE, 0x10, 
+0xEC, 0x84, 0x86, 0xB6, 0x97, 0xEB, 0x76, 0x93, 
+0x19, 0xB3, 0x35, 0xFC, 0x17, 0xC0, 0x40, 0x3F, 
+0x57, 0x16, 0xF4, 0x71, 0x3C, 0xFA, 0x51, 0x46, 
+0xD6, 0xD5, 0xC5, 0xB3, 0x20, 0x50, 0x1F, 0xA2, 
+0xC8, 0xA2, 0xBE, 0x45, 0x2F, 0xE6, 0xE4, 0x11, 
+0x89, 0xC4, 0xE4, 0xA0, 0x67, 0x95, 0xA6, 0xB8, 
+0x97, 0x26, 0x71, 0xE9, 0xBC, 0x95, 0xFC, 0x31, 
+0x9A, 0xE9, 0xFF, 0x32, 0x32, 0x0F, 0x36, 0x86, 
+0x40, 0x6A, 0x3F, 0x4C, 0x32, 0x3E, 0x91, 0xA9, 
+0x8F, 0x38, 0x9D, 0x2A, 0x0B, 0x0E, 0x50, 0x72, 
+0x44, 0xBC, 0x25, 0xE1, 0x0E, 0x3B, 0x6C, 0xA7, 
+0xB5, 0x46, 0x17, 0x31, 0xC8, 0x3F, 0xA6, 0xBE, 
+0xEC, 0xFB, 0x8B, 0x76, 0xFA, 0xEA, 0x98, 
+
+0xF7, 0x22, 0x2C, 0xBD, 0x48, 0x70, 0x20, 0x13, 
+0x36, 0x07, 0xA9, 0x5E, 0xEE, 0x50, 0x24, 0x58, 
+0x0B, 0x77, 0x32, 0xA0, 0x85, 0xC0, 0x39, 0x7A, 
+0x8A, 0xBB, 0xFE, 0x2B, 0x35, 0xF3, 0x36, 0x6A, 
+0x1E, 0x3D, 0x2A, 0x36, 0x84, 0xF0, 0x32, 0x87, 
+0xDA, 0x83, 0xDF, 0x14, 0xBD, 0x83, 0x3F, 0x93, 
+0x7F, 0x86, 0x18, 0x42, 0xB8, 0x77, 0xB4, 0x30, 
+0x72, 0x40, 0x04, 0x9E, 0x56, 0xBA, 0xCE, 0x3F, 
+0xFE, 0xE7, 0x47, 0x2B, 0xBA, 0x90, 0x2E, 0xF2, 
+0xDC, 0x0D, 0xF2, 0x43, 0xA7, 0x24, 0x73, 0xE9, 
+0x18, 0xE3, 0x3E, 0x5F, 0xB6, 0x1F, 0x39, 0xFD, 
+0x54, 0x64, 0x09, 0xF1, 0x12, 0x9F, 0x9A, 0xB1, 
+0xFD, 0x7D, 0x90, 0xE8, 0x4A, 0x6F, 0x72, 0x31, 
+0xEA, 0x3E, 0x0E, 0x54, 0x26, 0x48, 0xCC, 0x0E, 
+0x1D, 0x5A, 0xAD, 0x58, 0x0D, 0x51, 0xC2, 0xC2, 
+0x05, 0x05, 0x27, 0x43, 0xCD, 0x79, 0x2A, 0x1F, 
+0x0C, 0xB8, 0x12, 0x3E, 0xDA, 0xCB, 0xEF, 0xC6, 
+0x74, 0x36, 0xD6, 0x70, 0x71, 0xC4, 0xE4, 0x8B, 
+0x5D, 0x43, 0x9A, 0x54, 0xD6, 0x28, 0x21, 0x9C, 
+0xEB, 0x5B, 0x76, 0x26, 0x5A, 0x90, 0xFF, 0x1E, 
+0xB8, 0xB7, 0xD6, 0x0F, 0xAC, 0x0C, 0x91, 0xC9, 
+0x20, 0x39, 0xC4, 0x1F, 0xD3, 0x20, 0x98, 0x46, 
+0x30, 0x6A, 0xE3, 0xF8, 0xDE, 0x80, 0x47, 0xC2, 
+0x94, 0x92, 0xEE, 0x38, 0xA9, 0xFF, 0xAC, 0x06, 
+0xA5, 0xA8, 0x8D, 0x38, 0xCF, 0x02, 0x30, 0x20, 
+0x3A, 0x0F, 0x03, 0xC2, 0x32, 0xC8, 0x8F, 0xF6, 
+0xC6, 0x4A, 0xFC, 0x1B, 0x4B, 0x45, 0x01, 0x46, 
+0x66, 0x58, 0x73, 0x28, 0x62, 0xFF, 0xE2, 0xA8, 
+0x32, 0xE0, 0xB6, 0x83, 0xBB, 0x26, 0xCC, 0x1E, 
+0xD4, 0x04, 0x79, 0x4A, 0x4C, 0xEB, 0x72, 0xFB, 
+0x21, 0x8E, 0xAB, 0x12, 0x55, 0x55, 0x25, 0x50, 
+0x56, 0x88, 0x0E, 0xBD, 0x3C, 0x8D, 0x53, 0xE8, 
+
+0x41, 0xC0, 0xBD, 0xA4, 0x0F, 0xCF, 0x89, 0x09, 
+0x16, 0x37, 0xD4, 0xAC, 0xEE, 0x0E, 0xE8, 0xF5, 
+0x8E, 0x2B, 0x06, 0xD9, 0x63, 0x4E, 0x5B, 0x59, 
+0xC9, 0x46, 0x13, 0x59, 0xD4, 0x2D, 0xA4, 0x26, 
+0xB4, 0xE1, 0x0D, 0xE5, 0xA7, 0xBF, 0x6F, 0x55, 
+0x92, 0x06, 0xB9, 0xDB, 0x6D, 0x99, 0xD6, 0x04, 
+0xC4, 0x48, 0x2F, 0x94, 0xCB, 0x4C, 0x97, 0x82, 
+0xDD, 0x2A, 0xAA, 0x10, 0x79, 0x2F, 0xFA, 0x50, 
+0x13, 0xD7, 0x2E, 0x7F, 0x51, 0xDE, 0xC5, 0x28, 
+0x11, 0x67, 0x5F, 0x1E, 0x9F, 0xD9, 0x9F, 0x66, 
+0xE8, 0xF2, 0x67, 0xB2, 0x50, 0x44, 0xC5, 0x12, 
+0x0D, 0x12, 0xD8, 0xA9, 0xA3, 0xAD, 0x62, 0x2F, 
+0x71, 0xFB, 0x1C, 0x4B, 0x7A, 0x02, 0x96, 0x48, 
+0x5B, 0x70, 0x8D, 0x14, 0xD4, 0xE3, 0x52, 0x42, 
+0xF7, 0x52, 0x79, 0x99, 0x43, 0xC4, 0x0D, 0xB8, 
+0x87, 0x3F, 0x9C, 0x27, 0x35, 0x89, 0x0F, 0x6E, 
+0x31, 0x6E, 0xF6, 0x2C, 0xAF, 0xED, 0x5E, 0x00, 
+0x4C, 0xDC, 0xAE, 0xAE, 0xC1, 0xFD, 0x0B, 0x87, 
+0x36, 0xB4, 0xD6, 0x7E, 0x36, 0xC9, 0x5A, 0x8D, 
+0xBB, 0x21, 0xB2, 0xDF, 0xD4, 0x10, 0x80, 0x7E, 
+0xD1, 0x28, 0x87, 0x14, 0x92, 0x96, 0x00, 0xF2, 
+0x2F, 0xE9, 0xEB, 0xF2, 0xC7, 0x09, 0x22, 0x9B, 
+0x3C, 0x07, 0x92, 0xAC, 0x6B, 0x6F, 0xA1, 0xB3, 
+0xA2, 0xCC, 0x16, 0x2B, 0xB6, 0x33, 0x9B, 0x70, 
+0x35, 0x80, 0xE6, 0x28, 0x57, 0xCA, 0xE2, 0x29, 
+0x8D, 0x6E, 0x90, 0xD3, 0xFC, 0x0F, 0xA2, 0x85, 
+0x95, 0x5C, 0x54, 0x52, 0xA4, 0xF0, 0xF0, 0x53, 
+0xDC, 0xC0, 0x1A, 0x4D, 0x1E, 0xA4, 0xFC, 0x5D, 
+0x97, 0x55, 0x88, 0x45, 0x36, 0xCE, 0x7F, 0x36, 
+0x08, 0x65, 0x54, 0xF4, 0x83, 0xE0, 0xAE, 0xAA, 
+0x77, 0x84, 0xDA, 0x6F, 0xC2, 0xCC, 0x02, 0xDD, 
+0x16, 0x34, 0x6A, 0x3E, 0xEE, 0x44, 0xED, 0x60, 
+0xE8, 
+
+0x02, 0x24, 0xF2, 0x32, 0x79, 0x0B, 0xD8, 0x1F, 
+0x22, 0xC0, 0xE4, 0x55, 0x6D, 0x7D, 0x7B, 0x11, 
+0x4F, 0xFC, 0xAC, 0x73, 0x4B, 0x0E, 0xE1, 0x9E, 
+0xE7, 0x54, 0xA8, 0xDF, 0xCD, 0x27, 0x06, 0xA8, 
+0x57, 0x8B, 0x89, 0x33, 0x56, 0x8C, 0x8E, 0x3D, 
+0x6E, 0x2E, 0x34, 0x2D, 0x3E, 0x48, 0xB8, 0x92, 
+0xCD, 0xB3, 0x4F, 0x1E, 0x13, 0x1D, 0x20, 0x94, 
+0xF8, 0x69, 0x67, 0x90, 0x2A, 0x8A, 0xE2, 0x84, 
+0x00, 0x8C, 0x82, 0xD1, 0x5D, 0x80, 0xDA, 0xD4, 
+0xDD, 0xC2, 0x38, 0x5D, 0xB8, 0x34, 0x5A, 0x47, 
+0x97, 0x62, 0x7F, 0xF4, 0x2C, 0xCB, 0x46, 0x45, 
+0xE1, 0x8D, 0x1E, 0x7B, 0x50, 0x77, 0x45, 0x9D, 
+0x2A, 0xE2, 0x10, 0xBA, 0x42, 0xE3, 0x36, 0x86, 
+0x33, 0xF3, 0xA6, 0x51, 0x45, 0x86, 0x9D, 0xEC, 
+0x3F, 0xA6, 0xDB, 0x9A, 0xF7, 0xEC, 0xA3, 0xAD, 
+0x33, 0xDE, 0xBB, 0xDC, 0xE3, 0x15, 0xF2, 0x8F, 
+0xED, 0xAD, 0xBC, 0x14, 0x9D, 0xB4, 0x3F, 0x08, 
+0xA5, 0x7C, 0x51, 0xCE, 0x76, 0x45, 0x78, 0x48, 
+0x0E, 0xA0, 0x7E, 0x33, 0x0E, 0x85, 0xC5, 0x48, 
+0xBB, 0xB8, 0xC6, 0xBF, 0x34, 0x07, 0x2A, 0x5C, 
+0x66, 0x34, 0xCC, 0x94, 0x2C, 0x97, 0x3B, 0xD6, 
+0xC9, 0x82, 0x63, 0x3C, 0x81, 0x2C, 0x56, 0x97, 
+0xB6, 0x49, 0x03, 0xC2, 0x90, 0xF3, 0x97, 0x65, 
+0x44, 0x79, 0xC6, 0x2A, 0xB8, 0x57, 0xEF, 0x82, 
+0xA1, 0x20, 0x6D, 0x87, 0x33, 0xDB, 0x0A, 0x37, 
+0xD6, 0x2E, 0x30, 0x52, 0xFC, 0x1A, 0x7C, 0xF8, 
+0xFA, 0xBF, 0xE4, 0x87, 0xFB, 0x63, 0xE7, 0x12, 
+0x48, 0x67, 0x70, 0x12, 0x46, 0xB2, 0x65, 0x1F, 
+0xE0, 0xAE, 0x56, 0xA2, 0x09, 0xB0, 0xC5, 0x57, 
+0x3E, 0x43, 0x4D, 0x9E, 0xCB, 0xCA, 0x89, 0x8E, 
+0x85, 0x49, 0xDC, 0xCF, 0x83, 0x36, 0xC6, 0xE2, 
+0x9D, 0x64, 0xAD, 0x6D, 0xE7, 0x25, 0x52, 0x30, 
+0xB8, 0x52, 
+
+0x6A, 0x08, 0x31, 0x0B, 0x69, 0x8E, 0x0D, 0xF4, 
+0x1D, 0xE7, 0x83, 0xCB, 0xA0, 0x53, 0x85, 0x4B, 
+0x86, 0xAD, 0x86, 0x41, 0x4F, 0x45, 0xEE, 0x1A, 
+0x7F, 0x21, 0xD5, 0xF6, 0x6B, 0x7D, 0x27, 0xD4, 
+0x59, 0x68, 0x1B, 0x08, 0x19, 0x61, 0xF1, 0xAC, 
+0x1E, 0x7A, 0x74, 0x30, 0xBD, 0xC4, 0xB3, 0xD6, 
+0x74, 0x7C, 0xCE, 0x62, 0x92, 0x3F, 0x8C, 0xDB, 
+0xD9, 0x71, 0x8E, 0x20, 0x09, 0x3D, 0xE5, 0x8E, 
+0x2F, 0xA6, 0xFB, 0x0D, 0x57, 0x3F, 0xFA, 0x05, 
+0x23, 0x3A, 0x1F, 0x43, 0x70, 0x26, 0x20, 0x64, 
+0x40, 0xDA, 0x29, 0x24, 0x5C, 0xEE, 0x5B, 0xEE, 
+0xE2, 0xB9, 0x5A, 0x11, 0x64, 0x96, 0x44, 0xCF, 
+0x21, 0x6D, 0x5C, 0x84, 0xC4, 0xC9, 0x3F, 0xBB, 
+0xD5, 0x10, 0x2F, 0x4D, 0xA5, 0x2E, 0x10, 0x44, 
+0x8D, 0x7B, 0x2E, 0xE1, 0xB9, 0x02, 0x85, 0xEE, 
+0x41, 0x09, 0xFE, 0xFB, 0x59, 0xC1, 0x87, 0x7F, 
+0xDD, 0x58, 0x0B, 0x38, 0xD7, 0xA5, 0x98, 0xA7, 
+0x09, 0x17, 0x27, 0xEE, 0x4C, 0x27, 0xB1, 0x05, 
+0x07, 0xE6, 0x08, 0xE5, 0xBE, 0x0B, 0xB3, 0x21, 
+0x5D, 0xD1, 0xB3, 0x3C, 0x2A, 0x5A, 0x41, 0x3D, 
+0xBB, 0xDB, 0xB2, 0x04, 0x68, 0x18, 0xF8, 0xDE, 
+0x2E, 0x24, 0x62, 0x25, 0x65, 0x9D, 0xD4, 0x43, 
+0xCB, 0xC8, 0x3D, 0xDE, 0xE9, 0x18, 0x7C, 0xDA, 
+0x95, 0xDC, 0x5A, 0x21, 0xDF, 0x84, 0x3F, 0xAF, 
+0x4C, 0xB9, 0x4E, 0xA8, 0x79, 0x1B, 0xBD, 0x77, 
+0xE6, 0x96, 0x5C, 0xAC, 0xB8, 0xFC, 0x13, 0x46, 
+0xE5, 0x8C, 0x87, 0x43, 0x57, 0x92, 0xBC, 0x94, 
+0xF4, 0x47, 0xA6, 0x8B, 0xCB, 0x5E, 0xAB, 0x60, 
+0xB1, 0xD7, 0xE1, 0xC1, 0xDC, 0xEC, 0xA4, 0xB0, 
+0xEC, 0x0D, 0x4E, 0xD2, 0x30, 0x52, 0x00, 0x7F, 
+0x42, 0x9C, 0x53, 0x31, 0x4E, 0x1D, 0xB7, 0x8D, 
+0xE5, 0xA5, 0x39, 0xFA, 0x96, 0xB6, 0xF2, 0xF3, 
+0xEE, 0x4E, 0xC2, 
+
+0x4A, 0x34, 0x39, 0x6B, 0xB4, 0x17, 0xD5, 0x89, 
+0x32, 0x44, 0xDE, 0xD7, 0xFE, 0x00, 0x8B, 0x94, 
+0x8F, 0x7A, 0x50, 0xE1, 0xEF, 0xA3, 0x09, 0xF9, 
+0x28, 0x00, 0x1B, 0x3A, 0x61, 0x3A, 0xDC, 0xA6, 
+0x20, 0x1B, 0x97, 0xE2, 0x42, 0x15, 0x1E, 0x55, 
+0xAC, 0xEF, 0x69, 0x3C, 0xB2, 0x8F, 0x15, 0x2B, 
+0x89, 0x9B, 0x3B, 0x61, 0xF7, 0xD0, 0x9A, 0x89, 
+0x74, 0x06, 0x4B, 0x20, 0xB7, 0xFC, 0x09, 0xB9, 
+0x79, 0xAE, 0x0A, 0xE8, 0x1D, 0xAD, 0x29, 0xB7, 
+0x2E, 0x29, 0x07, 0x6E, 0x51, 0xD6, 0xD9, 0x8D, 
+0x7D, 0x48, 0xEA, 0x09, 0x33, 0xE1, 0x04, 0x86, 
+0xBF, 0xB2, 0x28, 0xBC, 0x53, 0xDC, 0xE1, 0x7A, 
+0x1A, 0x98, 0x5A, 0x58, 0xB0, 0x19, 0xC5, 0x9D, 
+0x75, 0xCF, 0xFA, 0x4E, 0xC4, 0x6B, 0x38, 0xB7, 
+0x51, 0x66, 0xEF, 0xE7, 0xB0, 0xF3, 0x28, 0x77, 
+0xC2, 0x45, 0xA5, 0xCE, 0xC0, 0x16, 0x9B, 0x02, 
+0xCE, 0xC4, 0xB2, 0x88, 0x19, 0xCB, 0xD3, 0xF1, 
+0x9A, 0xD9, 0x7F, 0x45, 0x31, 0x83, 0xE2, 0x7F, 
+0x01, 0xA8, 0x01, 0x31, 0x3F, 0x02, 0xC3, 0x5E, 
+0x88, 0x86, 0xCD, 0x28, 0xEB, 0x60, 0x90, 0x10, 
+0x4C, 0xCF, 0x04, 0x0A, 0x4B, 0x91, 0x22, 0x3B, 
+0x2C, 0xD2, 0x85, 0x27, 0x67, 0x45, 0xDB, 0xAC, 
+0xAA, 0x55, 0x42, 0xB5, 0xB1, 0x0D, 0x99, 0x96, 
+0xCE, 0xE6, 0x73, 0x96, 0xC1, 0xFC, 0x64, 0xD4, 
+0x14, 0x19, 0xE7, 0xF5, 0xDF, 0x20, 0x12, 0x78, 
+0x39, 0x50, 0x11, 0x9B, 0xCE, 0x03, 0x13, 0x81, 
+0x73, 0xA5, 0x56, 0xE9, 0x9D, 0x08, 0x57, 0x44, 
+0x5D, 0x27, 0xA4, 0x91, 0x84, 0x10, 0x88, 0xA7, 
+0x1B, 0x00, 0x20, 0x89, 0x9A, 0xE9, 0x55, 0x62, 
+0xCB, 0x66, 0x8E, 0xB6, 0xBE, 0xDE, 0x19, 0x70, 
+0x2E, 0xEF, 0x78, 0xEE, 0x0A, 0xA1, 0xC2, 0x63, 
+0x5F, 0x6B, 0x52, 0xF0, 0x02, 0xB3, 0x86, 0x36, 
+0xB1, 0x62, 0x2A, 0xB8, 
+
+0xE6, 0x26, 0xF4, 0x4D, 0x37, 0x75, 0x58, 0xE4, 
+0xED, 0xBF, 0x2A, 0xCB, 0x2E, 0xC2, 0x44, 0xE4, 
+0x7A, 0x94, 0x6F, 0x0E, 0xE0, 0xD3, 0x7C, 0x92, 
+0x19, 0x39, 0xA5, 0x2C, 0x38, 0x81, 0x9E, 0x64, 
+0x67, 0x2F, 0x84, 0xC4, 0x7E, 0x68, 0xA5, 0xC1, 
+0xAD, 0x45, 0xB7, 0x97, 0xC5, 0xAE, 0x33, 0x21, 
+0x61, 0x6E, 0x46, 0xE0, 0x27, 0xD8, 0x1B, 0x5C, 
+0xEC, 0x5F, 0x2E, 0xC6, 0xF3, 0x5E, 0x5A, 0xB6, 
+0x3D, 0xFA, 0xAD, 0x8F, 0x39, 0xE9, 0xBB, 0x2D, 
+0xEB, 0xB1, 0xEB, 0x8C, 0x8A, 0x23, 0x7A, 0xE6, 
+0xF9, 0x17, 0x61, 0xC6, 0xBC, 0xE5, 0xF5, 0xE9, 
+0x7B, 0x3A, 0x00, 0xBE, 0x5A, 0xA9, 0x8D, 0x9D, 
+0x03, 0x84, 0x59, 0x45, 0x5D, 0x33, 0xCD, 0x68, 
+0x39, 0xFC, 0x5B, 0x6A, 0xA8, 0x06, 0xE9, 0xEF, 
+0x0F, 0x9C, 0x17, 0x34, 0x44, 0xCE, 0x34, 0xDE, 
+0x58, 0x27, 0xA2, 0x6C, 0xEF, 0x6A, 0xBB, 0xEB, 
+0x71, 0xB5, 0x5A, 0xB8, 0xE6, 0x32, 0x03, 0x9D, 
+0x89, 0x0E, 0x1B, 0x22, 0x38, 0xF1, 0x05, 0xF4, 
+0xDA, 0xCA, 0x0F, 0x15, 0xEC, 0x72, 0x5D, 0xA9, 
+0xB4, 0x8E, 0x6C, 0x71, 0x4C, 0x5C, 0xC7, 0x84, 
+0x5D, 0xD0, 0xFC, 0x2B, 0x05, 0x82, 0x52, 0x66, 
+0xF9, 0xC7, 0x80, 0xF1, 0x0E, 0x0B, 0xB0, 0xD2, 
+0x13, 0xA1, 0xB4, 0xE0, 0xE4, 0x38, 0xD4, 0x0B, 
+0x3E, 0xD5, 0xB6, 0xC2, 0xFC, 0xBD, 0x2D, 0xD8, 
+0x69, 0x7F, 0x1C, 0x26, 0x13, 0x1C, 0xA2, 0x06, 
+0xD9, 0xAA, 0xC6, 0x6E, 0x93, 0x65, 0x30, 0xAF, 
+0xA7, 0x01, 0xF9, 0x85, 0x09, 0x66, 0x98, 0xA3, 
+0x28, 0xAC, 0xD7, 0x62, 0xC6, 0x7F, 0x83, 0x29, 
+0x01, 0x61, 0xC6, 0x2F, 0x3B, 0xE7, 0xF7, 0xBB, 
+0xAD, 0x1C, 0x71, 0xE2, 0x48, 0x33, 0x0E, 0x23, 
+0x72, 0x60, 0xC8, 0xE6, 0xA7, 0x03, 0x9A, 0x9F, 
+0xBE, 0x79, 0x64, 0xD4, 0xB8, 0x8B, 0x48, 0x3B, 
+0x70, 0xD3, 0x98, 0x8A, 0x15, 
+
+0x45, 0x96, 0x2E, 0x99, 0x9A, 0xD9, 0x29, 0xB0, 
+0x4F, 0x30, 0xFA, 0x11, 0xD0, 0xDE, 0x83, 0xEE, 
+0x79, 0xB4, 0xBB, 0x19, 0x69, 0x9D, 0xD5, 0x8D, 
+0xB2, 0x96, 0x09, 0xB4, 0xDC, 0xC5, 0x89, 0xF1, 
+0x2C, 0x0F, 0xFE, 0xA3, 0xFF, 0x96, 0x01, 0xA9, 
+0x45, 0xCA, 0x0E, 0x6E, 0x4F, 0xD8, 0x79, 0x15, 
+0xD2, 0xCE, 0xD8, 0x3F, 0xDA, 0x21, 0x2E, 0xAE, 
+0xBB, 0x76, 0xC1, 0xF0, 0x17, 0xBC, 0x78, 0x76, 
+0xD0, 0x37, 0xD6, 0x1D, 0xBF, 0xF9, 0x1F, 0x03, 
+0x04, 0xDA, 0xBA, 0xF2, 0x6C, 0xCE, 0xD0, 0x12, 
+0x7A, 0x02, 0x28, 0x05, 0x87, 0xA1, 0xC2, 0x47, 
+0x6A, 0x02, 0x1F, 0xE9, 0xCD, 0x8C, 0x6B, 0xF3, 
+0x67, 0x65, 0x44, 0xD1, 0x4C, 0x73, 0xD1, 0x78, 
+0x93, 0xC4, 0x4B, 0x6F, 0x0C, 0xC8, 0x25, 0xDC, 
+0x98, 0xCE, 0x67, 0x5D, 0x6E, 0x4C, 0x84, 0xCB, 
+0xEB, 0x4A, 0x16, 0xFB, 0xB5, 0x86, 0xA6, 0x14, 
+0xE5, 0x71, 0x24, 0x69, 0xF5, 0x6C, 0x1D, 0x2B, 
+0x10, 0x33, 0x79, 0xB2, 0x54, 0x43, 0xBB, 0xC0, 
+0x69, 0x71, 0x13, 0x8B, 0x4D, 0x91, 0x3F, 0xBF, 
+0xAE, 0xFA, 0xD0, 0x41, 0x2D, 0xBE, 0x1C, 0x46, 
+0x0B, 0x32, 0xCE, 0x4C, 0xDC, 0xFB, 0xA9, 0x65, 
+0xCE, 0xFC, 0x0B, 0x17, 0x9D, 0x60, 0x76, 0x1C, 
+0x32, 0xED, 0xD9, 0xFB, 0xB9, 0xBC, 0x02, 0x58, 
+0xC3, 0x01, 0x85, 0x50, 0x0D, 0xE9, 0xE1, 0x88, 
+0x0B, 0xD8, 0xD5, 0xAD, 0xA2, 0x7D, 0x0D, 0x25, 
+0xB7, 0x77, 0xA9, 0xC7, 0xF0, 0x18, 0x20, 0xB8, 
+0xDB, 0x20, 0x9E, 0x13, 0x37, 0x77, 0x68, 0xA6, 
+0x9D, 0x74, 0x5B, 0xE3, 0xA3, 0xFC, 0x4C, 0x3E, 
+0xF9, 0x00, 0x10, 0x63, 0x9C, 0xDF, 0xEE, 0x0E, 
+0x41, 0x57, 0x59, 0x82, 0xBF, 0xC0, 0xF3, 0x78, 
+0xF0, 0xDC, 0x73, 0xE1, 0xAD, 0xFE, 0xC4, 0x8E, 
+0xCA, 0x07, 0x7E, 0x29, 0x9F, 0x87, 0x81, 0x2A, 
+0x9B, 0xF5, 0x7E, 0x40, 0xB3, 0xD4, 
+
+0x2F, 0xB9, 0x3C, 0xF0, 0x79, 0x7B, 0xB5, 0xAA, 
+0xC0, 0xA2, 0x38, 0xA2, 0x81, 0x75, 0x9D, 0x00, 
+0xE2, 0xF4, 0xAD, 0x65, 0xE2, 0x56, 0xD1, 0xA7, 
+0x1A, 0x6D, 0x59, 0x6A, 0xBC, 0x52, 0x8A, 0x64, 
+0x68, 0x54, 0x4E, 0xB2, 0xB9, 0x30, 0x44, 0x16, 
+0xCB, 0x98, 0xBF, 0x26, 0x8B, 0xF1, 0x17, 0x97, 
+0x63, 0xBA, 0x1F, 0xF4, 0xA9, 0xC6, 0xE6, 0xEE, 
+0xAA, 0xE6, 0xE0, 0x6F, 0xE2, 0x6A, 0xB0, 0xEC, 
+0xC2, 0xF5, 0x01, 0x5A, 0x30, 0x9C, 0x24, 0xC4, 
+0x4E, 0x41, 0x3F, 0x45, 0xA0, 0x16, 0xCA, 0xC9, 
+0x42, 0x12, 0x71, 0x4C, 0x97, 0x1B, 0xBF, 0x93, 
+0xA9, 0xA1, 0x98, 0x7C, 0x57, 0xD1, 0x05, 0xA8, 
+0xF9, 0x2F, 0xB9, 0x83, 0x1F, 0xB1, 0xAE, 0x19, 
+0x62, 0x09, 0x38, 0x19, 0x21, 0x19, 0x70, 0x3C, 
+0x84, 0xFF, 0x56, 0x97, 0xB4, 0x0A, 0x8C, 0xC4, 
+0x3B, 0x44, 0x03, 0xF5, 0x99, 0x75, 0x42, 0x85, 
+0xED, 0x97, 0x49, 0xAB, 0x25, 0x27, 0x77, 0x69, 
+0x10, 0x2D, 0x25, 0xB9, 0x8F, 0xA5, 0x89, 0xCE, 
+0x13, 0x6B, 0xC9, 0xFC, 0xC4, 0x60, 0x4C, 0x66, 
+0x54, 0x9A, 0xE5, 0xD9, 0x1E, 0x56, 0xF2, 0x01, 
+0xE6, 0x63, 0x9C, 0xBC, 0x09, 0xB3, 0x74, 0xFB, 
+0x41, 0x3F, 0x8F, 0x50, 0x17, 0xA8, 0xE4, 0x98, 
+0xC2, 0x16, 0x4C, 0x7C, 0x8A, 0xFC, 0xB3, 0xB0, 
+0xCA, 0xAA, 0x9D, 0xC7, 0x8D, 0x5B, 0xAB, 0x8A, 
+0x9F, 0x73, 0xBB, 0xAC, 0xE1, 0x04, 0xEB, 0xC8, 
+0x89, 0x20, 0xE7, 0x69, 0xD8, 0xB9, 0x17, 0xEA, 
+0x91, 0xAE, 0x1B, 0x86, 0x82, 0xA0, 0xDF, 0x78, 
+0xA8, 0xE3, 0x6F, 0xAD, 0x04, 0x72, 0xB1, 0x44, 
+0x49, 0xFB, 0x3B, 0x89, 0x39, 0x67, 0x31, 0xDA, 
+0x21, 0x88, 0xA0, 0x18, 0xEB, 0x86, 0x33, 0x3F, 
+0x88, 0x6E, 0xA6, 0xDE, 0xA0, 0x42, 0x48, 0xA6, 
+0x45, 0xFC, 0x05, 0x69, 0x79, 0x5D, 0x04, 0xAA, 
+0x2C, 0x7C, 0xFF, 0x94, 0xD5, 0xAF, 0xF7, 
+
+}
+return kat[i:j]
+}
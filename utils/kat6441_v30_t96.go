@@ -0,0 +1,4838 @@
+package utils
+func getkat6441_v30_t96(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x63, 0x74, 0xCC, 0xC1, 0x07, 0xB7, 0xE4, 0x0E, 
+0x38, 0xBA, 0x5E, 0x4A, 
+
+0x28, 0x32, 0x0B, 0x3E, 0x8A, 0x1B, 0x81, 0xC4, 
+0xC1, 0x91, 0x7A, 0x3A, 0xE1, 
+
+0xD1, 0x82, 0xF2, 0xC8, 0x27, 0xDE, 0xB1, 0x1F, 
+0x02, 0x66, 0xB4, 0x1F, 0x40, 0x98, 
+
+0x6B, 0x7C, 0xA3, 0x05, 0xC5, 0x61, 0x61, 0x24, 
+0xAF, 0xE2, 0x07, 0x22, 0xBC, 0x03, 0xD1, 
+
+0x62, 0x22, 0xE8, 0x0D, 0xDF, 0x76, 0x65, 0x99, 
+0xB6, 0x04, 0xC1, 0x31, 0x3D, 0x5F, 0xB8, 0x93, 
+
+0x86, 0x61, 0xE8, 0x6F, 0xB4, 0xF3, 0x30, 0x20, 
+0xD0, 0x12, 0xB8, 0x13, 0x65, 0x64, 0x8C, 0xB5, 
+0xA6, 
+
+0xC0, 0x57, 0x17, 0x57, 0xB4, 0x2C, 0xB6, 0x41, 
+0x5A, 0xC2, 0x96, 0x96, 0x0A, 0xF5, 0x9D, 0xDD, 
+0x64, 0x87, 
+
+0x19, 0xCC, 0xA9, 0x25, 0xD4, 0x64, 0x5E, 0x67, 
+0x8C, 0xDE, 0x0E, 0xC1, 0x66, 0x52, 0x41, 0xC6, 
+0xAA, 0x4A, 0xF2, 
+
+0x59, 0x94, 0x32, 0x67, 0x3D, 0x0B, 0xEA, 0xD8, 
+0x43, 0x56, 0x22, 0xBE, 0x1A, 0x8C, 0xC8, 0xE9, 
+0x5E, 0xE0, 0x31, 0x2E, 
+
+0x73, 0x7B, 0x7A, 0x3E, 0x88, 0x3D, 0x50, 0xA8, 
+0x00, 0xED, 0xEA, 0x5F, 0x40, 0xFD, 0x7E, 0x55, 
+0x62, 0x00, 0x85, 0x7D, 0x50, 
+
+0x57, 0x81, 0xE0, 0x09, 0xBE, 0xB5, 0x82, 0x9B, 
+0x01, 0x55, 0x13, 0x93, 0xB2, 0xB1, 0x3E, 0x89, 
+0xB4, 0x78, 0x65, 0xA7, 0x0B, 0x1A, 
+
+0x00, 0x07, 0x90, 0xC2, 0x89, 0x6E, 0x9C, 0xF8, 
+0xF0, 0x32, 0x26, 0x9E, 0x4F, 0x97, 0xF0, 0xF8, 
+0x05, 0x2D, 0xE8, 0xF5, 0xA5, 0x8B, 0x11, 
+
+0x4F, 0x73, 0x05, 0x62, 0x0C, 0x30, 0x67, 0xAE, 
+0x75, 0xE5, 0x84, 0x9E, 0x3F, 0xF8, 0x8E, 0x97, 
+0xB3, 0x7B, 0x29, 0x9A, 0x5D, 0x4D, 0xE5, 0xEB, 
+
+0x8B, 0x94, 0xC2, 0x5F, 0x26, 0xFF, 0xAE, 0x73, 
+0xB2, 0x39, 0xBB, 0x7D, 0xF2, 0x53, 0xB2, 0x28, 
+0xB6, 0x71, 0x40, 0xC5, 0xF8, 0x11, 0x4D, 0x07, 
+0xDC, 
+
+0x69, 0x0D, 0x40, 0x0D, 0x2A, 0x10, 0xCE, 0xEF, 
+0x9A, 0x2D, 0xD3, 0x24, 0xF2, 0x11, 0xB2, 0x8A, 
+0x64, 0xDC, 0x48, 0x6B, 0xAC, 0x73, 0x62, 0x15, 
+0x53, 0x88, 
+
+0x17, 0x37, 0x85, 0x0E, 0x1F, 0x5F, 0xAE, 0x97, 
+0xE2, 0xB2, 0x82, 0x3F, 0x4A, 0xA9, 0x97, 0x2E, 
+0xCD, 0xEB, 0xFA, 0xC4, 0xDF, 0x40, 0x05, 0x09, 
+0x69, 0x6E, 0xB1, 
+
+0x27, 0xFE, 0xF8, 0x64, 0x33, 0x07, 0xD4, 0x30, 
+0x85, 0x9C, 0x94, 0xB4, 0x05, 0x2F, 0x3D, 0xD4, 
+0x0F, 0x2F, 0xD3, 0x69, 0x9A, 0x50, 0xAB, 0xF8, 
+0xBE, 0x92, 0x3E, 0xCF, 
+
+0x03, 0x9A, 0xF6, 0xE1, 0x72, 0xDA, 0x0E, 0x38, 
+0x85, 0x98, 0x1C, 0x63, 0xB7, 0x84, 0xB0, 0x6D, 
+0x01, 0x9B, 0x3B, 0x97, 0x04, 0x05, 0xF2, 0xAD, 
+0xFF, 0xD0, 0x97, 0xDE, 0x9C, 
+
+0x45, 0x30, 0x3D, 0x0B, 0xD8, 0xA0, 0xB3, 0xA8, 
+0xF6, 0x3D, 0xED, 0x6C, 0xBE, 0x6F, 0xA2, 0xC8, 
+0x96, 0x67, 0xF4, 0xCA, 0x80, 0x5E, 0xA3, 0x6D, 
+0x4C, 0x74, 0x31, 0xBE, 0x05, 0x3B, 
+
+0x34, 0x9B, 0x16, 0xA0, 0x4F, 0x6D, 0xC5, 0x52, 
+0xEF, 0x11, 0xFC, 0x7F, 0xDE, 0xBD, 0xDA, 0x8A, 
+0x0B, 0x34, 0x43, 0x82, 0x94, 0x90, 0x64, 0x1C, 
+0xE9, 0x44, 0x6D, 0x88, 0x1B, 0x09, 0xAB, 
+
+0x3A, 0xC2, 0x50, 0xA2, 0xA9, 0xA7, 0x93, 0xBC, 
+0x73, 0x20, 0xF3, 0x91, 0x78, 0x18, 0x21, 0xB4, 
+0x96, 0x0B, 0x16, 0xAA, 0xF6, 0xFB, 0x4E, 0xF5, 
+0xC1, 0x76, 0xAC, 0x6A, 0x0D, 0x88, 0xDE, 0xA6, 
+
+0xF1, 0x51, 0xE3, 0x17, 0xC7, 0x4E, 0xE8, 0x49, 
+0xD5, 0x43, 0x61, 0x99, 0x5C, 0xFF, 0x5F, 0x24, 
+0x06, 0x41, 0xA3, 0x0E, 0x48, 0x42, 0x32, 0xCE, 
+0x62, 0xEA, 0x94, 0xC9, 0x56, 0x41, 0x03, 0xBC, 
+0x1C, 
+
+0xED, 0x41, 0x3F, 0xF6, 0xAA, 0xC9, 0x02, 0xB0, 
+0x40, 0x5A, 0xD3, 0x0D, 0xAB, 0xC4, 0xEB, 0x4B, 
+0xD5, 0xA8, 0xC0, 0x08, 0x0E, 0xB8, 0x91, 0x51, 
+0x70, 0x36, 0xA9, 0x0A, 0xA1, 0x23, 0xB9, 0x9A, 
+0xD7, 0xCD, 
+
+0xCE, 0x54, 0xF5, 0x9B, 0x61, 0x28, 0x3A, 0x54, 
+0xBB, 0x06, 0xE6, 0xE1, 0xE0, 0x4C, 0x2A, 0xC4, 
+0x52, 0xD2, 0x54, 0x69, 0xD7, 0xDC, 0x24, 0x75, 
+0x86, 0xB6, 0x1E, 0x27, 0x5F, 0x69, 0x94, 0xF0, 
+0x1D, 0x62, 0x21, 
+
+0x11, 0x14, 0x13, 0x42, 0xDB, 0xD4, 0x52, 0x0A, 
+0x64, 0xC5, 0x7E, 0x8E, 0x1B, 0x08, 0x9E, 0xBF, 
+0x67, 0x9F, 0x55, 0xBB, 0xD4, 0x50, 0x64, 0xE1, 
+0x99, 0x60, 0x32, 0xA0, 0xDA, 0xDC, 0x46, 0xF6, 
+0x03, 0x0B, 0x11, 0x07, 
+
+0x18, 0x1E, 0xAF, 0x04, 0xFD, 0x93, 0x77, 0x7F, 
+0x48, 0x32, 0xAB, 0x34, 0x24, 0x08, 0xB1, 0x9E, 
+0x2A, 0x15, 0xF0, 0x09, 0x48, 0x0E, 0x13, 0x94, 
+0x23, 0xF0, 0x86, 0xAD, 0xDF, 0xDF, 0xB2, 0x95, 
+0x00, 0xA7, 0x71, 0x37, 0x0A, 
+
+0x1D, 0x1A, 0x91, 0x0C, 0xC7, 0x88, 0xB3, 0x5A, 
+0x87, 0x4F, 0x49, 0x24, 0x37, 0xBE, 0x3E, 0x73, 
+0x9D, 0x45, 0xF8, 0xC4, 0xC4, 0xFC, 0x8D, 0x12, 
+0x61, 0x92, 0xF4, 0xE5, 0x8B, 0x86, 0xCD, 0x1F, 
+0x9C, 0xBC, 0xD5, 0x32, 0x7A, 0x2E, 
+
+0x50, 0x21, 0x4A, 0xCC, 0x67, 0x84, 0x5D, 0x0F, 
+0x54, 0x52, 0x6D, 0x10, 0x55, 0x9E, 0x98, 0xCF, 
+0x4B, 0xA3, 0x6D, 0x63, 0x13, 0x83, 0xC9, 0xC4, 
+0xDC, 0xA0, 0x8A, 0x1D, 0x1B, 0x73, 0x5C, 0x35, 
+0x5D, 0xD0, 0xA0, 0x55, 0x61, 0xD6, 0xFB, 
+
+0x44, 0x1F, 0x03, 0xFA, 0x19, 0xCA, 0x98, 0xC7, 
+0x2A, 0xD9, 0xA8, 0x12, 0xC7, 0x39, 0x6D, 0xFB, 
+0x2C, 0x74, 0xD2, 0x12, 0x7C, 0x39, 0xF8, 0xAF, 
+0x61, 0x55, 0xA2, 0x99, 0x93, 0x69, 0x54, 0xDA, 
+0x25, 0x45, 0x26, 0xE0, 0x8E, 0x3A, 0x21, 0x76, 
+
+0x57, 0xA6, 0xA4, 0x5F, 0x6C, 0xDB, 0x15, 0x67, 
+0x18, 0x2B, 0xBA, 0xEF, 0xD3, 0xF3, 0x44, 0x75, 
+0x90, 0x5E, 0x10, 0xE2, 0x45, 0x36, 0xD1, 0x73, 
+0xDC, 0xDD, 0xBF, 0x98, 0xF5, 0x9D, 0x54, 0xB7, 
+0xE3, 0x45, 0x7E, 0x7F, 0xB2, 0x08, 0x0D, 0x88, 
+0x75, 
+
+0x6E, 0xC2, 0xFE, 0xD4, 0x9A, 0x68, 0x98, 0xED, 
+0x19, 0x72, 0x5F, 0xFF, 0xE0, 0x44, 0x47, 0xB2, 
+0x32, 0xC6, 0x3F, 0x75, 0x00, 0x44, 0x3B, 0x63, 
+0x5E, 0x9F, 0x3E, 0x9B, 0x02, 0x7D, 0xE6, 0x6F, 
+0x94, 0x57, 0xCB, 0x1E, 0x2D, 0x8C, 0x54, 0x2B, 
+0xD7, 0x43, 
+
+0x64, 0xA1, 0x8E, 0x5A, 0x05, 0xFF, 0x6D, 0x94, 
+0x12, 0x5B, 0xA9, 0x07, 0x18, 0xE0, 0xEB, 0xF1, 
+0x5E, 0x5D, 0x0F, 0x96, 0xBC, 0x4F, 0x9C, 0x71, 
+0xD2, 0x63, 0x82, 0x1D, 0x45, 0x9A, 0x87, 0xF5, 
+0xE6, 0x5B, 0x7B, 0x99, 0x76, 0xB9, 0xCB, 0x8C, 
+0x16, 0xC6, 0xD0, 
+
+0xE9, 0xEA, 0x61, 0x17, 0x94, 0x0B, 0x6E, 0x52, 
+0x17, 0x38, 0xED, 0xC7, 0x4B, 0xF7, 0xB5, 0x2C, 
+0x9F, 0xA7, 0x04, 0x6D, 0x36, 0x5D, 0x1F, 0xDE, 
+0x9D, 0xBE, 0x47, 0xC5, 0xA6, 0xA8, 0x76, 0x4F, 
+0x6E, 0xC1, 0x01, 0x6F, 0xA7, 0x14, 0x9A, 0xB5, 
+0x90, 0xFE, 0x0C, 0x09, 
+
+0x20, 0x1C, 0x2F, 0x63, 0xA3, 0x5E, 0xA5, 0xCB, 
+0x28, 0xF4, 0x9E, 0x10, 0xB3, 0x07, 0xB7, 0x15, 
+0x18, 0x87, 0x5E, 0x48, 0xB0, 0x61, 0x49, 0x16, 
+0x0D, 0x4D, 0x9E, 0xCA, 0xC2, 0x73, 0x27, 0xE9, 
+0xF2, 0x77, 0xC3, 0x1C, 0xE8, 0x50, 0xA2, 0x56, 
+0x88, 0xAD, 0xBF, 0x89, 0x55, 
+
+0xA2, 0xD0, 0x3A, 0xB4, 0xB3, 0x3B, 0x40, 0x17, 
+0x0B, 0xC9, 0x9B, 0x52, 0x54, 0x69, 0xE4, 0xF6, 
+0x1D, 0x53, 0x8D, 0xC0, 0x56, 0x00, 0xF5, 0xFA, 
+0xD7, 0xB5, 0x99, 0x17, 0xE4, 0x6D, 0x40, 0x86, 
+0xA4, 0x5E, 0x78, 0x35, 0x1C, 0xCF, 0x91, 0x7B, 
+0x77, 0xEA, 0x1E, 0xF1, 0x3B, 0xF9, 
+
+0xAD, 0x8C, 0x56, 0x56, 0xD7, 0x98, 0x52, 0x51, 
+0xBA, 0xFD, 0xD4, 0xF6, 0x66, 0x97, 0x94, 0x37, 
+0x1F, 0xD3, 0xC8, 0xA0, 0xB4, 0xD8, 0xE4, 0x99, 
+0x7C, 0x0F, 0x7F, 0xAB, 0x1F, 0xED, 0x19, 0x4D, 
+0x31, 0xD1, 0x3C, 0xFE, 0x26, 0x79, 0x10, 0xE7, 
+0xAC, 0x98, 0x32, 0x59, 0x9F, 0xB4, 0xB4, 
+
+0xAD, 0xDE, 0xE6, 0x46, 0x6F, 0xCE, 0x76, 0x61, 
+0xD8, 0x26, 0x7B, 0x5E, 0xFE, 0x10, 0xD7, 0x62, 
+0x3B, 0x56, 0xB8, 0x71, 0x8F, 0x84, 0x3E, 0x85, 
+0xC3, 0x60, 0x00, 0x8E, 0x80, 0xAA, 0x0B, 0x54, 
+0x9D, 0xA5, 0x85, 0xB0, 0x56, 0xAC, 0xE8, 0x64, 
+0xC7, 0x27, 0x9C, 0x1E, 0x14, 0x4E, 0xDB, 0x95, 
+
+0x49, 0xF0, 0xA7, 0xE4, 0x23, 0x31, 0xE2, 0xF0, 
+0x3A, 0x22, 0x3B, 0xE1, 0x21, 0x52, 0xF8, 0xE8, 
+0x61, 0x00, 0xD8, 0xDC, 0x9E, 0xAC, 0x22, 0x8C, 
+0x11, 0xCA, 0x55, 0x13, 0x97, 0x15, 0x3F, 0xEC, 
+0x03, 0x69, 0x26, 0xFE, 0x5A, 0x48, 0x8A, 0xB9, 
+0x6F, 0x98, 0x53, 0x2B, 0xDC, 0xD7, 0xD6, 0xFD, 
+0x78, 
+
+0xF9, 0x3A, 0x78, 0x74, 0xED, 0x49, 0xE6, 0xEC, 
+0x7C, 0xE4, 0x76, 0xF9, 0xAC, 0x08, 0x86, 0x69, 
+0xB3, 0x1A, 0x05, 0x07, 0x20, 0xEE, 0x5A, 0x01, 
+0x55, 0xF4, 0x4D, 0xD9, 0x1D, 0x96, 0xA6, 0x92, 
+0x8D, 0x28, 0x0E, 0xF8, 0x0F, 0x37, 0x48, 0x0F, 
+0x15, 0xD7, 0xB2, 0xE5, 0xBD, 0x8B, 0x97, 0xAE, 
+0x9D, 0x43, 
+
+0x85, 0x2D, 0x6F, 0xB8, 0x7F, 0xA5, 0xA3, 0x32, 
+0x24, 0x91, 0x4D, 0x12, 0x67, 0xFC, 0xD1, 0x7D, 
+0x1C, 0xFA, 0x21, 0x70, 0xDE, 0x90, 0x33, 0x13, 
+0x33, 0xA7, 0x29, 0xED, 0x0A, 0xC4, 0x69, 0x6B, 
+0xE2, 0xC3, 0x01, 0xBE, 0x98, 0x16, 0x98, 0xD0, 
+0x58, 0xF9, 0xB0, 0x13, 0xE3, 0x7D, 0x3C, 0x64, 
+0xCD, 0xF7, 0x5E, 
+
+0x68, 0x25, 0xE5, 0x56, 0xCB, 0xBC, 0xA4, 0xF5, 
+0x03, 0x07, 0x14, 0xAB, 0xB9, 0x91, 0xF6, 0x35, 
+0x74, 0x55, 0x62, 0xCD, 0xF5, 0xAC, 0x7A, 0x91, 
+0x01, 0x6B, 0x15, 0x78, 0x87, 0x77, 0xA1, 0xB8, 
+0x28, 0xC3, 0x2A, 0x7A, 0x16, 0x01, 0xB9, 0x1B, 
+0xA0, 0x3C, 0xF1, 0x8E, 0x3E, 0x88, 0x7B, 0xC8, 
+0x5E, 0x3D, 0xD7, 0x1C, 
+
+0x23, 0x4E, 0x6D, 0x3C, 0xD6, 0x0F, 0xB9, 0xF9, 
+0x65, 0x02, 0x02, 0x1A, 0xC6, 0x1F, 0xD3, 0x45, 
+0xD4, 0x43, 0x0A, 0x71, 0x99, 0xB3, 0x81, 0xAC, 
+0x2F, 0x3B, 0x89, 0xC6, 0x4B, 0x94, 0x01, 0x89, 
+0x03, 0x38, 0xD5, 0x91, 0x7D, 0x19, 0xCD, 0xDA, 
+0x99, 0x8E, 0x84, 0xB4, 0x82, 0xF6, 0x0F, 0x21, 
+0xA5, 0x28, 0xA1, 0x9D, 0x93, 
+
+0x8C, 0x86, 0x04, 0xE4, 0xA2, 0x29, 0x23, 0x86, 
+0xAA, 0xBD, 0xB9, 0x68, 0xD3, 0xEC, 0xAE, 0x7E, 
+0x0F, 0xFB, 0x3F, 0xBC, 0x65, 0x8A, 0x1A, 0xFC, 
+0xB7, 0x83, 0x80, 0x74, 0xEC, 0x24, 0xAC, 0xD7, 
+0x46, 0xFC, 0xD0, 0xE8, 0x1E, 0xA8, 0x66, 0xC4, 
+0xD2, 0xD4, 0x80, 0x05, 0x60, 0x97, 0x8A, 0xEE, 
+0x4B, 0xEE, 0x26, 0xF9, 0xA0, 0x83, 
+
+0x55, 0x3E, 0x82, 0xA4, 0xB0, 0xC0, 0x3E, 0xC6, 
+0x40, 0x88, 0xB1, 0x9B, 0x8E, 0x00, 0x72, 0xE0, 
+0x8A, 0x3E, 0xAE, 0xE1, 0x4C, 0xAD, 0x29, 0x71, 
+0x94, 0x4C, 0x98, 0x36, 0x53, 0x15, 0x7C, 0x65, 
+0x74, 0xD5, 0x8F, 0xB8, 0xC6, 0x34, 0x2A, 0x53, 
+0x12, 0xE8, 0xB6, 0x66, 0x22, 0x6B, 0x40, 0x60, 
+0x30, 0x74, 0xE7, 0x1B, 0x5E, 0x0F, 0xE9, 
+
+0x1E, 0x4A, 0x95, 0x8A, 0x05, 0x06, 0x2B, 0x1F, 
+0xDD, 0x06, 0x5A, 0x78, 0xDC, 0xE0, 0x26, 0xAB, 
+0x5F, 0xD2, 0x97, 0xF9, 0x44, 0x3F, 0x6C, 0x99, 
+0xA2, 0xB6, 0x2A, 0x08, 0x97, 0xF9, 0x77, 0x9F, 
+0xA5, 0xCC, 0xB7, 0x13, 0x24, 0xE3, 0x3B, 0xE4, 
+0xAF, 0x83, 0x8E, 0x16, 0x2E, 0xF5, 0x0E, 0x47, 
+0x7C, 0x91, 0x2B, 0xB4, 0x24, 0x47, 0x97, 0xA9, 
+
+0xD5, 0x60, 0xDD, 0x49, 0xD2, 0x31, 0xEE, 0x78, 
+0x68, 0x94, 0x31, 0xC5, 0xEA, 0xDC, 0x60, 0xB6, 
+0x88, 0xB9, 0x79, 0xC1, 0x2A, 0x50, 0x47, 0x25, 
+0x7B, 0xCA, 0x8C, 0xA8, 0x06, 0x95, 0xAA, 0x00, 
+0x04, 0x47, 0xF3, 0x5E, 0x5A, 0x99, 0x11, 0xF2, 
+0xD2, 0x3E, 0x81, 0x31, 0x6C, 0x65, 0xF2, 0xE3, 
+0x19, 0xF3, 0x69, 0xC1, 0x23, 0x14, 0x48, 0xF9, 
+0x67, 
+
+0xE4, 0x7A, 0x6E, 0xCB, 0x52, 0xC7, 0x4B, 0x88, 
+0x03, 0x61, 0xFE, 0x1F, 0x1A, 0xB7, 0x6A, 0x4D, 
+0xB7, 0x7B, 0xB9, 0x93, 0x3C, 0x7D, 0xEC, 0x71, 
+0x48, 0x3B, 0x79, 0x50, 0x6F, 0x5C, 0x2E, 0xD3, 
+0x6A, 0x0B, 0x22, 0x3B, 0xC3, 0xCB, 0x26, 0x04, 
+0xC7, 0x65, 0x66, 0xF3, 0x4F, 0x90, 0xFA, 0xC7, 
+0xAE, 0xAA, 0xFA, 0x5F, 0xC0, 0xB6, 0xA8, 0x59, 
+0x2F, 0x11, 
+
+0x4C, 0xCA, 0xDB, 0xEC, 0x54, 0x34, 0x86, 0x33, 
+0xE1, 0xE4, 0xAA, 0x41, 0xBB, 0x9C, 0xF2, 0x10, 
+0xB7, 0xE8, 0x29, 0x59, 0x01, 0x03, 0x4F, 0xC1, 
+0x21, 0x89, 0x38, 0xD6, 0x22, 0xC3, 0x40, 0xEF, 
+0xFB, 0x2D, 0x0A, 0xB3, 0xF6, 0xE6, 0x0F, 0xE8, 
+0xFA, 0x00, 0x73, 0x2A, 0xC3, 0x78, 0x7D, 0x4A, 
+0x83, 0x1E, 0x80, 0xF0, 0xF1, 0x7F, 0x68, 0x56, 
+0x83, 0x05, 0xF1, 
+
+0xC7, 0xF5, 0xD7, 0x4A, 0x1C, 0x59, 0x55, 0x15, 
+0xAD, 0xE1, 0xD2, 0x76, 0xEC, 0x36, 0xA6, 0x92, 
+0xB7, 0xE5, 0x22, 0xEB, 0x69, 0xD4, 0xE0, 0x90, 
+0xB9, 0xEF, 0x10, 0x6D, 0x92, 0x2B, 0x95, 0x73, 
+0xCC, 0x60, 0x18, 0x5F, 0x3B, 0x56, 0x30, 0xB8, 
+0x2C, 0xD1, 0xA5, 0xDE, 0x84, 0xCF, 0x54, 0x96, 
+0xF8, 0xDB, 0x91, 0x3A, 0x7C, 0x5B, 0x5A, 0xC6, 
+0x92, 0x98, 0x81, 0x54, 
+
+0xB2, 0xA6, 0x8C, 0xCD, 0xE3, 0xD6, 0x6D, 0xE1, 
+0x92, 0xCF, 0x76, 0x11, 0x89, 0xC6, 0x27, 0x43, 
+0x3B, 0x4A, 0x6F, 0x78, 0xC1, 0x94, 0x5F, 0xAF, 
+0xA8, 0x92, 0xDB, 0xFA, 0xBE, 0x38, 0x1F, 0xCB, 
+0xD9, 0xB0, 0x1A, 0xB5, 0x68, 0x7B, 0x3F, 0xF1, 
+0x75, 0xDE, 0xCF, 0x95, 0xEB, 0xA4, 0x34, 0xE9, 
+0x98, 0x6D, 0x09, 0x01, 0x71, 0x58, 0x8A, 0x35, 
+0x58, 0xA0, 0xD2, 0xF0, 0x3A, 
+
+0xC1, 0x7B, 0x75, 0xF0, 0x8A, 0x78, 0x69, 0xA3, 
+0xDF, 0x97, 0xDC, 0xBD, 0xB7, 0xDA, 0x94, 0x3A, 
+0xF8, 0x74, 0x7E, 0x04, 0x71, 0xD9, 0x32, 0xCD, 
+0xAC, 0x43, 0x5C, 0xB0, 0x1E, 0x2A, 0xF3, 0xA3, 
+0x15, 0x44, 0x28, 0x16, 0x85, 0xF9, 0x1F, 0x70, 
+0xBA, 0x52, 0x3F, 0x2E, 0xBF, 0xF3, 0x24, 0x5E, 
+0xA0, 0x64, 0x58, 0x73, 0xDF, 0x68, 0x57, 0xF3, 
+0x67, 0x48, 0x09, 0x4F, 0x87, 0x0F, 
+
+0x5D, 0x49, 0x90, 0x9B, 0x84, 0xED, 0x37, 0x2D, 
+0xCC, 0x3C, 0x92, 0x3E, 0x61, 0xC1, 0x2A, 0x7F, 
+0x00, 0x42, 0x10, 0x90, 0x6A, 0x06, 0x3D, 0x45, 
+0x65, 0x7E, 0x88, 0xD7, 0x77, 0xBB, 0x2D, 0xF7, 
+0x40, 0x5D, 0x3E, 0x9C, 0xB6, 0xF0, 0x96, 0xE6, 
+0xAF, 0x1C, 0xE8, 0x52, 0x42, 0xFB, 0x65, 0x6D, 
+0xEE, 0x59, 0x4A, 0x91, 0x22, 0x18, 0x14, 0x25, 
+0x33, 0x0D, 0x25, 0x43, 0x4C, 0x50, 0x0C, 
+
+0x66, 0x23, 0xF7, 0x77, 0xB2, 0xCF, 0x19, 0x08, 
+0x9D, 0xA9, 0x5C, 0x2F, 0x85, 0xF3, 0xFA, 0x89, 
+0x28, 0xFD, 0x63, 0xEA, 0xAC, 0xDD, 0x41, 0x5D, 
+0x2C, 0xA8, 0xBC, 0x45, 0x7F, 0x56, 0x3F, 0x6B, 
+0xCE, 0x07, 0x16, 0xDF, 0x07, 0x7F, 0xC2, 0xD4, 
+0xBC, 0xC7, 0xE2, 0x8D, 0x87, 0xFF, 0xDF, 0x26, 
+0xD5, 0xE5, 0x7B, 0xB9, 0x28, 0x10, 0x9E, 0x37, 
+0x82, 0x72, 0x84, 0x44, 0xB5, 0x28, 0x16, 0x6C, 
+
+0x46, 0xF8, 0x01, 0xB5, 0x44, 0x39, 0x9B, 0x04, 
+0x98, 0xBA, 0x05, 0xE5, 0x7D, 0x25, 0xE0, 0x9F, 
+0x71, 0x69, 0xA7, 0x0E, 0x27, 0xB6, 0xF6, 0x19, 
+0xBC, 0x59, 0xDE, 0xFF, 0x74, 0xBC, 0x1E, 0xB7, 
+0x80, 0xEA, 0x24, 0x4F, 0x44, 0x5E, 0x24, 0x89, 
+0xF8, 0xE3, 0x71, 0x98, 0x75, 0xA4, 0x9F, 0x51, 
+0xB0, 0xB5, 0x52, 0x80, 0xED, 0x6F, 0x81, 0xF7, 
+0x05, 0xC6, 0x73, 0xC3, 0xF2, 0x8C, 0x63, 0xCA, 
+0xDE, 
+
+0xE2, 0x3C, 0xEF, 0xB5, 0xCD, 0x1E, 0x3A, 0xCC, 
+0x63, 0xF0, 0x3D, 0xE1, 0xEA, 0xFE, 0xB9, 0x92, 
+0x59, 0x1E, 0xBF, 0xD9, 0x01, 0x6C, 0x25, 0xAB, 
+0xC5, 0x16, 0xE1, 0x88, 0xD9, 0x85, 0xC8, 0xEF, 
+0x0C, 0xFA, 0xC1, 0x28, 0xCF, 0xEB, 0xE4, 0xCE, 
+0x28, 0x4B, 0xB1, 0x5E, 0xC1, 0x71, 0xCB, 0xB9, 
+0x52, 0xCC, 0x57, 0x92, 0x7F, 0xE3, 0x76, 0xB5, 
+0xE0, 0xFD, 0x6F, 0x47, 0x5E, 0xE0, 0x59, 0x11, 
+0x86, 0x68, 
+
+0xEC, 0xBE, 0x5F, 0xEA, 0x08, 0xA2, 0x50, 0xD3, 
+0xE0, 0xA5, 0x46, 0x1E, 0xF7, 0x17, 0xD6, 0x5E, 
+0x96, 0xB9, 0xFE, 0xF9, 0x07, 0x3A, 0x7C, 0xDA, 
+0x9A, 0x44, 0x2A, 0xF2, 0xB4, 0x70, 0x29, 0xAB, 
+0x7A, 0x8C, 0xE8, 0x6D, 0x31, 0x7E, 0x76, 0xA7, 
+0x29, 0x2A, 0xC5, 0xB6, 0x97, 0x7C, 0xD8, 0x19, 
+0x2C, 0xA2, 0x30, 0x3F, 0x03, 0x69, 0xF9, 0x34, 
+0x11, 0x00, 0x04, 0xDE, 0xCC, 0x99, 0xCC, 0x43, 
+0x2B, 0x7D, 0xA2, 
+
+0x64, 0xA2, 0xC0, 0xBB, 0xE1, 0x42, 0x47, 0x0E, 
+0xBA, 0x61, 0x3C, 0xEF, 0xD2, 0x89, 0xA6, 0x19, 
+0x7E, 0x9A, 0x63, 0xAB, 0x9D, 0x12, 0x93, 0xAC, 
+0xDC, 0x4A, 0x0A, 0x89, 0xD2, 0xA6, 0xF4, 0x36, 
+0xF4, 0xE5, 0x83, 0xF4, 0xCE, 0xBE, 0x66, 0x6D, 
+0xB1, 0x77, 0x3D, 0x7C, 0x69, 0x05, 0x2C, 0x55, 
+0xB4, 0x55, 0xB5, 0x85, 0x08, 0x43, 0x65, 0x91, 
+0x15, 0x6A, 0x4D, 0x88, 0xFF, 0xA2, 0x00, 0xC0, 
+0x1E, 0xEA, 0xBF, 0x77, 
+
+0x78, 0x38, 0x6B, 0xFF, 0x61, 0x97, 0x6D, 0xBF, 
+0x95, 0xBE, 0x94, 0x23, 0x51, 0xDB, 0xC8, 0x30, 
+0x58, 0xB1, 0x39, 0x35, 0x90, 0xE4, 0x64, 0x52, 
+0x8C, 0x41, 0xAB, 0xA9, 0x17, 0x8E, 0x71, 0xDD, 
+0x93, 0x53, 0x3B, 0x9D, 0x73, 0xD3, 0x6A, 0x5C, 
+0x61, 0xB8, 0x34, 0x7A, 0xA4, 0xE2, 0xDF, 0x96, 
+0xFB, 0x38, 0x11, 0x8E, 0x07, 0xE6, 0xCD, 0xA5, 
+0xB3, 0x24, 0xE6, 0xCC, 0x6C, 0xD0, 0x48, 0x90, 
+0xB5, 0x8B, 0x48, 0xF6, 0x5A, 
+
+0x79, 0x31, 0x04, 0xBA, 0xB0, 0x6C, 0x01, 0x67, 
+0x31, 0x1E, 0x47, 0x23, 0x15, 0x63, 0x59, 0x48, 
+0xF4, 0x16, 0x5B, 0x74, 0x0C, 0x4C, 0xB7, 0x27, 
+0x31, 0x11, 0x5E, 0xC0, 0x1A, 0x66, 0xF5, 0x9E, 
+0x20, 0x4B, 0xC0, 0x9F, 0x60, 0x94, 0x3B, 0xE5, 
+0xDC, 0xF2, 0x80, 0x37, 0xCA, 0x74, 0x45, 0xB3, 
+0x2C, 0xED, 0x1B, 0x48, 0xD5, 0x1E, 0x86, 0xEE, 
+0x32, 0x89, 0xB0, 0x2A, 0xA4, 0xED, 0x45, 0xA9, 
+0x37, 0x15, 0xD6, 0x64, 0xC0, 0x19, 
+
+0xB2, 0xB7, 0xF1, 0xCF, 0xE3, 0x2B, 0x37, 0x13, 
+0x68, 0xDD, 0x3E, 0x4F, 0x6B, 0x8D, 0xC9, 0x4F, 
+0xEB, 0x20, 0x96, 0xA6, 0xF8, 0xFF, 0x75, 0x45, 
+0xF3, 0xD0, 0xB2, 0xDB, 0xFD, 0x66, 0x95, 0x47, 
+0xA8, 0x33, 0x81, 0xD2, 0x76, 0x2A, 0x6B, 0xE7, 
+0xA0, 0x2F, 0x9E, 0x01, 0x25, 0xC4, 0xCA, 0x24, 
+0xB2, 0xCA, 0x1D, 0x73, 0x7D, 0xCA, 0x73, 0x94, 
+0xEE, 0x52, 0xF9, 0xB0, 0x11, 0x22, 0xC5, 0xB3, 
+0x29, 0x06, 0x2D, 0xE9, 0x05, 0x53, 0x10, 
+
+0xCC, 0x4F, 0xF8, 0x06, 0xAD, 0x76, 0x42, 0x22, 
+0xEC, 0x2B, 0xCA, 0xBA, 0xD6, 0x32, 0x60, 0x1B, 
+0x1C, 0x62, 0xEB, 0x8D, 0x9A, 0xE8, 0x32, 0x1C, 
+0x56, 0xA3, 0xD1, 0xB2, 0xF2, 0x34, 0xEF, 0xFA, 
+0x12, 0xD4, 0xEC, 0x10, 0x7A, 0xCE, 0xCE, 0xB6, 
+0xCC, 0x9B, 0xBB, 0xA6, 0xC0, 0x68, 0xA2, 0xAE, 
+0x23, 0xEA, 0x14, 0xAE, 0x50, 0x89, 0x2C, 0x5A, 
+0x0B, 0x0E, 0x3F, 0x12, 0x17, 0xDB, 0xB9, 0x41, 
+0xCE, 0x5A, 0x30, 0x42, 0x95, 0x75, 0x04, 0x2B, 
+
+0x59, 0x1C, 0x62, 0x9A, 0xFC, 0x8B, 0xF4, 0x48, 
+0x48, 0x5D, 0x98, 0x71, 0x91, 0xF9, 0x2F, 0xBA, 
+0xE7, 0x96, 0x96, 0xB8, 0xB9, 0xAA, 0x18, 0x43, 
+0x9F, 0x35, 0xF1, 0x3C, 0x2B, 0x93, 0x00, 0x7D, 
+0xB0, 0x39, 0x0B, 0xE2, 0xC3, 0x37, 0x5F, 0x85, 
+0xE0, 0x64, 0x5A, 0x80, 0x46, 0x6C, 0x35, 0x52, 
+0x71, 0x37, 0x43, 0x17, 0x6C, 0xC4, 0xA0, 0x61, 
+0x65, 0xAB, 0x9F, 0x44, 0xF7, 0x8C, 0x5B, 0x18, 
+0xE2, 0x93, 0x3E, 0xB8, 0x56, 0x2A, 0x1F, 0x4C, 
+0xCA, 
+
+0x0F, 0xA9, 0xFA, 0xF1, 0x4B, 0x62, 0xEC, 0x0E, 
+0x47, 0x76, 0x5A, 0xAF, 0xBF, 0x12, 0x82, 0x8A, 
+0x44, 0xCF, 0x74, 0xDF, 0x73, 0xF3, 0x9E, 0x75, 
+0xFA, 0xF3, 0xCF, 0xE2, 0x3F, 0x0A, 0x30, 0x4C, 
+0x88, 0xF8, 0xD5, 0x6A, 0x00, 0x9D, 0x39, 0x56, 
+0x75, 0x5C, 0xBF, 0x78, 0x6A, 0x58, 0x8D, 0xAE, 
+0xAA, 0xD0, 0x16, 0xEA, 0xEF, 0x38, 0xEA, 0x6E, 
+0x3B, 0xE0, 0x55, 0x64, 0xA0, 0x86, 0x27, 0x03, 
+0xE7, 0x55, 0x14, 0x10, 0xA9, 0x34, 0xD0, 0x8A, 
+0x62, 0x63, 
+
+0x47, 0x84, 0xDF, 0xAB, 0x8C, 0x58, 0x49, 0xD2, 
+0x56, 0x16, 0xA5, 0xEF, 0x54, 0xC0, 0x6E, 0x5E, 
+0x43, 0x29, 0x4F, 0xA6, 0x0F, 0xBD, 0x27, 0x76, 
+0x98, 0x20, 0x28, 0x6B, 0xDA, 0x74, 0x03, 0x22, 
+0xAC, 0x8B, 0x3C, 0x00, 0xCD, 0x75, 0xC1, 0x57, 
+0xAE, 0xA2, 0x56, 0xC7, 0xD4, 0x96, 0xAB, 0x2B, 
+0x60, 0xF9, 0x43, 0x65, 0x2C, 0x2F, 0x23, 0xD9, 
+0xAE, 0x93, 0xF5, 0x9A, 0x87, 0x84, 0x51, 0x9D, 
+0x04, 0x5B, 0xA4, 0xE7, 0x37, 0x66, 0x59, 0x6E, 
+0x28, 0xAE, 0xAB, 
+
+0x34, 0x04, 0x77, 0x9C, 0x58, 0xE8, 0x0F, 0x15, 
+0xD4, 0x8C, 0x06, 0x7D, 0x95, 0x40, 0x00, 0x90, 
+0x6B, 0x5B, 0x81, 0x63, 0x43, 0xCF, 0x67, 0xE8, 
+0x4C, 0xFF, 0x20, 0xA5, 0xC1, 0x4F, 0x21, 0xA4, 
+0x38, 0xC8, 0x20, 0x7E, 0x9F, 0x62, 0xB3, 0x72, 
+0x13, 0x2F, 0x8A, 0x8B, 0xB3, 0xE1, 0xD7, 0x90, 
+0x0F, 0xFF, 0xE8, 0x65, 0xC6, 0x39, 0x6F, 0x79, 
+0xDA, 0x9A, 0x98, 0x04, 0xCC, 0xC0, 0xC8, 0x04, 
+0x70, 0x85, 0xBB, 0x7D, 0xA1, 0xD6, 0x3A, 0x5C, 
+0xB8, 0xCF, 0xF3, 0x47, 
+
+0x25, 0x62, 0x34, 0x37, 0x7A, 0xE2, 0xC1, 0x2D, 
+0x97, 0x80, 0x0A, 0x41, 0xC6, 0x35, 0x08, 0x0B, 
+0x86, 0x2E, 0x50, 0xDB, 0xC5, 0x7A, 0xAC, 0xD7, 
+0x3F, 0xA5, 0xF2, 0xFF, 0x35, 0xC2, 0x80, 0x92, 
+0xE9, 0x09, 0xE0, 0x4C, 0x5E, 0x77, 0x3A, 0xDD, 
+0x5D, 0x80, 0xB0, 0xD6, 0xC7, 0xC3, 0xA3, 0x29, 
+0x73, 0xE6, 0xD8, 0x76, 0x50, 0x94, 0x08, 0x63, 
+0xB8, 0x2B, 0x3A, 0x27, 0x16, 0x58, 0xFF, 0x9A, 
+0x6C, 0xBF, 0x11, 0x93, 0x67, 0x8D, 0xD7, 0x82, 
+0x98, 0xDB, 0x76, 0xA0, 0x65, 
+
+0xEF, 0xD4, 0x47, 0xFC, 0x49, 0x45, 0x18, 0xEB, 
+0x0F, 0x3E, 0xE2, 0x32, 0xDC, 0x08, 0x55, 0x23, 
+0xA3, 0x9F, 0x2B, 0xD6, 0xA7, 0x26, 0xC6, 0xDD, 
+0x1B, 0x76, 0xB4, 0x1E, 0x58, 0xE8, 0xA4, 0x88, 
+0xF2, 0x4E, 0x7C, 0x0E, 0x3E, 0xD7, 0x94, 0xA6, 
+0x7F, 0xC7, 0x85, 0x40, 0x60, 0x62, 0x5B, 0xFA, 
+0xDC, 0x85, 0x72, 0xA5, 0x39, 0x1D, 0x4E, 0x9D, 
+0x5F, 0xD7, 0xB1, 0x0D, 0x05, 0x9F, 0x3D, 0xBC, 
+0x0D, 0xB3, 0xBB, 0x76, 0x95, 0x1E, 0x41, 0xDD, 
+0x30, 0xCE, 0xA4, 0xE4, 0x91, 0x19, 
+
+0x11, 0xA9, 0x42, 0x41, 0x41, 0x39, 0x76, 0x96, 
+0x7C, 0x24, 0x2B, 0x3C, 0x7F, 0x72, 0x9F, 0x7B, 
+0x6E, 0xFC, 0x69, 0xEB, 0x26, 0x7E, 0x26, 0x5C, 
+0x92, 0xA7, 0xB2, 0x5E, 0xA0, 0xBA, 0x7A, 0x35, 
+0x7B, 0xFC, 0x1B, 0x50, 0xB5, 0x6D, 0xCA, 0xE1, 
+0x79, 0x9F, 0xFF, 0xB2, 0xD7, 0xA5, 0xA2, 0xB7, 
+0xB7, 0x07, 0x92, 0xCE, 0x1A, 0xCD, 0x38, 0x07, 
+0xE5, 0x87, 0xF3, 0xFF, 0xBF, 0xAF, 0x35, 0xB6, 
+0x6E, 0x2B, 0xB5, 0x61, 0xFF, 0x45, 0xF8, 0xA7, 
+0x86, 0x0B, 0x9C, 0xC0, 0x4D, 0x5A, 0xFF, 
+
+0xC3, 0x0A, 0x78, 0xB5, 0xED, 0xF1, 0xF1, 0x49, 
+0xE5, 0x29, 0x8C, 0xB0, 0x6C, 0xD0, 0xE1, 0xFD, 
+0xEF, 0xAA, 0xDA, 0xD9, 0xD8, 0xE2, 0x17, 0x39, 
+0x7F, 0x9A, 0x21, 0x35, 0xC0, 0xB7, 0x0C, 0x83, 
+0xCF, 0xE7, 0x94, 0xC1, 0x7B, 0x4B, 0x13, 0x92, 
+0xD4, 0x46, 0x25, 0xA8, 0xF2, 0xB1, 0x9C, 0xB4, 
+0x71, 0x31, 0xE7, 0x91, 0x22, 0xED, 0x68, 0x8D, 
+0x8F, 0x5C, 0xC2, 0x2C, 0xC7, 0xFC, 0x70, 0x20, 
+0x23, 0x5D, 0xB1, 0x16, 0x5E, 0x1B, 0x12, 0x15, 
+0xEE, 0xC0, 0xFA, 0x0A, 0x97, 0xE4, 0xB5, 0x67, 
+
+0x9E, 0xBF, 0xE1, 0x62, 0xD3, 0x3D, 0xFA, 0x35, 
+0x0F, 0xEC, 0xF2, 0x4D, 0xB2, 0xC4, 0x45, 0xE3, 
+0x8F, 0x29, 0xC6, 0xE0, 0x0B, 0x53, 0xD3, 0xD3, 
+0x0B, 0xF1, 0x3D, 0x4B, 0xA7, 0xE2, 0x1F, 0xA9, 
+0x51, 0xFB, 0x2F, 0xBA, 0x4E, 0x49, 0xCC, 0xBE, 
+0xD5, 0x57, 0x11, 0x4E, 0xAE, 0x76, 0x8E, 0x34, 
+0xA4, 0x72, 0xAB, 0x4E, 0x39, 0x7D, 0x01, 0x39, 
+0x17, 0xD9, 0x1B, 0x27, 0xEB, 0xA1, 0x2E, 0xFD, 
+0x91, 0x94, 0x86, 0x2B, 0x2E, 0x2D, 0x2D, 0x4A, 
+0x37, 0xE0, 0xF9, 0x10, 0xA8, 0x50, 0x3C, 0x7B, 
+0xAE, 
+
+0x13, 0x48, 0xE2, 0x8B, 0xA5, 0x0F, 0x62, 0xB0, 
+0x0E, 0x6F, 0xB2, 0xA8, 0xE2, 0xF5, 0xEB, 0x1D, 
+0xC8, 0x49, 0xB2, 0x02, 0x9E, 0x00, 0xD9, 0xE7, 
+0xD1, 0xF4, 0xDC, 0x47, 0x80, 0x6F, 0x8A, 0x26, 
+0xEF, 0x53, 0xBB, 0xD0, 0xA8, 0x39, 0x82, 0x91, 
+0x2B, 0xFF, 0x9D, 0x7C, 0xB4, 0xC7, 0x5B, 0xAA, 
+0xD6, 0xF4, 0xB6, 0x92, 0x58, 0x4E, 0x80, 0xA6, 
+0xB4, 0xD0, 0x10, 0x71, 0x00, 0xC3, 0x36, 0x17, 
+0x14, 0x77, 0x91, 0x30, 0x67, 0x84, 0xB3, 0x58, 
+0x4D, 0xC8, 0xE0, 0xB8, 0x57, 0xF3, 0x9E, 0x4E, 
+0x9B, 0x3C, 
+
+0xCE, 0x93, 0x6C, 0x98, 0x3B, 0x9F, 0x79, 0x77, 
+0x33, 0xDD, 0x3D, 0xFC, 0x32, 0x1C, 0xC8, 0x49, 
+0xFB, 0x94, 0x6C, 0xD3, 0x85, 0x0D, 0x8E, 0x00, 
+0xF9, 0x89, 0x95, 0x3B, 0x77, 0x36, 0x84, 0x5B, 
+0x3A, 0x5F, 0x28, 0xA9, 0xA0, 0xB2, 0x4C, 0xB0, 
+0x38, 0x26, 0x93, 0x82, 0x7E, 0xEF, 0xFA, 0x4F, 
+0x7A, 0xCE, 0xB3, 0x1E, 0xBB, 0xE3, 0x63, 0x7F, 
+0x71, 0x9A, 0x32, 0x58, 0xBE, 0x0C, 0x60, 0x9C, 
+0x2E, 0xF3, 0x0F, 0x0C, 0xDF, 0x10, 0xBC, 0x93, 
+0x2E, 0x45, 0xB0, 0xD6, 0x69, 0x03, 0x88, 0x89, 
+0xC1, 0x51, 0xBA, 
+
+0xE6, 0x18, 0xE6, 0x8F, 0x35, 0xDC, 0xB2, 0x65, 
+0xEC, 0x5C, 0x1A, 0x4F, 0xAC, 0xC3, 0x64, 0x3D, 
+0x60, 0x6B, 0xD1, 0xC7, 0xA3, 0x01, 0xE8, 0x3A, 
+0xDC, 0x1B, 0xFC, 0x2B, 0x25, 0x91, 0xF6, 0x70, 
+0xBF, 0xF3, 0xBA, 0x56, 0x18, 0x5E, 0xE5, 0x82, 
+0x58, 0x1E, 0x3F, 0x2A, 0x0E, 0x19, 0x8E, 0x33, 
+0xB2, 0x33, 0x10, 0x72, 0xD7, 0x9C, 0x74, 0xFC, 
+0x3C, 0x48, 0x36, 0xC7, 0x9B, 0x0C, 0xAC, 0x6C, 
+0x65, 0xB6, 0x74, 0x69, 0x3F, 0xCC, 0x23, 0xAD, 
+0x46, 0x23, 0xCB, 0xA9, 0xE4, 0x63, 0x8C, 0x83, 
+0x35, 0xC3, 0x55, 0x5D, 
+
+0xC6, 0xD8, 0xC1, 0xFD, 0x66, 0x33, 0x3A, 0x49, 
+0x0B, 0xD2, 0xD8, 0x03, 0xF9, 0x18, 0xAE, 0xE9, 
+0xFB, 0x5F, 0x2C, 0x81, 0x6B, 0x6E, 0x98, 0x7F, 
+0xE4, 0x91, 0x36, 0x05, 0x8D, 0xEE, 0xEF, 0x8E, 
+0xAF, 0xD6, 0x7E, 0xE0, 0x82, 0x85, 0xE3, 0x56, 
+0xE1, 0x24, 0xB9, 0xE5, 0xEC, 0x6C, 0xBC, 0xBB, 
+0xF4, 0xE3, 0x8F, 0x31, 0x4B, 0x44, 0x9E, 0x82, 
+0x20, 0x45, 0xD8, 0xC3, 0xAC, 0x7E, 0xE8, 0x26, 
+0xF1, 0xA6, 0x31, 0x25, 0xFA, 0xAB, 0x7F, 0x00, 
+0x1D, 0xD2, 0xF0, 0xDF, 0xEB, 0x8E, 0x14, 0x6A, 
+0x66, 0x01, 0xAD, 0x0B, 0xFE, 
+
+0x5A, 0x91, 0x99, 0x3B, 0x3D, 0x5B, 0xE5, 0xB7, 
+0x81, 0x34, 0x43, 0x9D, 0x80, 0x7F, 0xE1, 0x83, 
+0x5D, 0xD0, 0xF3, 0x90, 0xDE, 0xFA, 0x1B, 0x93, 
+0xDA, 0xDE, 0x90, 0x8D, 0x63, 0x4C, 0x4F, 0x4E, 
+0x33, 0x1C, 0x0E, 0xE3, 0xC9, 0x03, 0x93, 0x26, 
+0x41, 0xA0, 0xBC, 0x44, 0xFA, 0xC1, 0x3E, 0x8C, 
+0xDA, 0x19, 0x1E, 0x1E, 0xE3, 0x8A, 0x2D, 0x14, 
+0xAE, 0xD4, 0x6E, 0x18, 0x3E, 0xCD, 0xC2, 0x9E, 
+0x8A, 0xD6, 0xD3, 0x13, 0x41, 0xDF, 0x2A, 0xDB, 
+0x80, 0x05, 0x6D, 0x16, 0xF1, 0xF6, 0x9F, 0x99, 
+0xBF, 0xD9, 0xE2, 0xFD, 0xE9, 0x2C, 
+
+0x76, 0x86, 0xB5, 0x37, 0x5C, 0x12, 0xE3, 0xB5, 
+0x09, 0xE6, 0x95, 0x8C, 0xD5, 0xC1, 0x62, 0xE4, 
+0x68, 0x64, 0x7A, 0x95, 0x13, 0xE9, 0x17, 0x05, 
+0x4D, 0x2E, 0xEB, 0xBD, 0xE4, 0x38, 0x07, 0xA1, 
+0x96, 0xA2, 0x76, 0xB9, 0x21, 0xC6, 0x71, 0x80, 
+0xCE, 0x75, 0xC3, 0xE0, 0x3B, 0xD7, 0x51, 0x3D, 
+0x6C, 0xB1, 0x6D, 0xEE, 0x4F, 0x08, 0xA8, 0x07, 
+0x48, 0xC6, 0x7E, 0x98, 0x2E, 0x21, 0x45, 0x55, 
+0x50, 0x82, 0x34, 0x69, 0x1A, 0xC3, 0x19, 0xC8, 
+0x5B, 0x91, 0x21, 0x0C, 0x8B, 0xF4, 0x38, 0x36, 
+0x5B, 0x41, 0xF0, 0x2E, 0xBE, 0x25, 0x9A, 
+
+0xD3, 0xD2, 0x6C, 0x6E, 0xCB, 0x8C, 0x23, 0xA2, 
+0xB1, 0x07, 0xB2, 0xE3, 0x6D, 0x18, 0x55, 0x5D, 
+0x12, 0x42, 0xA0, 0x77, 0x65, 0xF4, 0x5B, 0x23, 
+0x8F, 0xF3, 0xE3, 0xF4, 0x18, 0x50, 0x73, 0xE9, 
+0x0F, 0x45, 0x30, 0xA9, 0x26, 0x0A, 0x37, 0x9F, 
+0x5B, 0xFA, 0x1B, 0x57, 0xAD, 0x83, 0xFB, 0x34, 
+0xA9, 0x90, 0x11, 0xFD, 0x99, 0x99, 0x43, 0x89, 
+0x1C, 0xCC, 0x55, 0xDE, 0x04, 0xD1, 0xD0, 0x12, 
+0x8B, 0x52, 0xAC, 0x5A, 0x11, 0xCE, 0x0C, 0x1A, 
+0x7B, 0xF5, 0x24, 0x4A, 0x50, 0x44, 0xAA, 0x44, 
+0xED, 0x09, 0x21, 0x50, 0x91, 0x4D, 0x71, 0xEB, 
+
+0x0C, 0xC1, 0x73, 0x4C, 0xE6, 0xA3, 0xFA, 0x00, 
+0x02, 0xC7, 0x3F, 0x1F, 0x22, 0x91, 0x01, 0x62, 
+0x37, 0x32, 0xD8, 0x77, 0x10, 0xCA, 0x1B, 0xA1, 
+0xFE, 0xC4, 0x89, 0xB4, 0xA4, 0xA4, 0x8D, 0xFA, 
+0xEE, 0x2E, 0x7D, 0x87, 0x80, 0xF9, 0x06, 0x91, 
+0x2F, 0x81, 0x10, 0xD5, 0x16, 0xCE, 0xA3, 0xF1, 
+0xAD, 0xCC, 0xDA, 0x87, 0x6E, 0x09, 0x69, 0xB9, 
+0x81, 0xF5, 0x1F, 0xCC, 0x79, 0xB6, 0x14, 0x1C, 
+0x54, 0x83, 0xD1, 0x39, 0x09, 0x59, 0xC7, 0xC7, 
+0x06, 0x0C, 0x7A, 0x51, 0x3F, 0xA2, 0x97, 0x5F, 
+0x56, 0x32, 0x2B, 0xA8, 0x35, 0x3D, 0xE1, 0x2D, 
+0xC0, 
+
+0x3A, 0x72, 0xE5, 0x95, 0xEA, 0x1D, 0x22, 0x5E, 
+0x64, 0xC8, 0x04, 0x3B, 0xB7, 0x54, 0xA8, 0xB1, 
+0x91, 0x3C, 0x69, 0xED, 0xF1, 0x99, 0x47, 0x99, 
+0x6F, 0x1E, 0x17, 0x7E, 0xD6, 0x36, 0xB7, 0xE9, 
+0x53, 0x18, 0xBC, 0x0B, 0xA7, 0xF1, 0x34, 0x76, 
+0x0F, 0xA2, 0xDE, 0x47, 0xA2, 0x95, 0x26, 0x4F, 
+0x2C, 0xD3, 0xF4, 0xEC, 0x40, 0xAE, 0x92, 0x96, 
+0x7C, 0x60, 0x09, 0x71, 0x2F, 0xE2, 0xA3, 0x2A, 
+0x0D, 0x5F, 0x74, 0x8C, 0x44, 0x07, 0x84, 0x78, 
+0xB9, 0x80, 0x98, 0xF3, 0x81, 0xDE, 0x87, 0x9E, 
+0xFE, 0xAE, 0x3B, 0xC7, 0xF8, 0x64, 0x3D, 0xF3, 
+0xF3, 0xB9, 
+
+0xAA, 0xC9, 0xDF, 0x24, 0x5F, 0xE9, 0xB6, 0x77, 
+0xBA, 0x03, 0x95, 0x2F, 0x40, 0xE1, 0x58, 0x39, 
+0x2C, 0x3A, 0xE1, 0x9E, 0x0D, 0xDA, 0xBE, 0xAC, 
+0xC8, 0xC2, 0xF8, 0x9E, 0xCA, 0xD0, 0xDC, 0x44, 
+0x2C, 0x82, 0x20, 0xCF, 0x90, 0xEF, 0xB6, 0x72, 
+0xC1, 0x06, 0x40, 0xAA, 0xB6, 0x7A, 0x21, 0xE9, 
+0xCA, 0xC4, 0x40, 0xF0, 0xFA, 0x72, 0xF3, 0x0B, 
+0xA9, 0x2F, 0x03, 0xB3, 0xDA, 0xB0, 0x6C, 0x39, 
+0xF4, 0x04, 0x30, 0x1A, 0x39, 0xAD, 0x2E, 0x4D, 
+0x41, 0xCB, 0xD1, 0x4F, 0xA7, 0x12, 0x6F, 0xA2, 
+0x1B, 0x07, 0x48, 0x41, 0x9A, 0xBB, 0x4B, 0xA2, 
+0xD2, 0x49, 0xF6, 
+
+0xE2, 0x56, 0x5E, 0x2B, 0x29, 0xB5, 0xC0, 0x7E, 
+0x75, 0x24, 0xB7, 0x5F, 0x8A, 0x7B, 0x94, 0x97, 
+0x60, 0x27, 0xA9, 0x63, 0xA5, 0xB9, 0x43, 0x0B, 
+0x8C, 0x1E, 0xE9, 0xE6, 0xED, 0x94, 0xDD, 0xE9, 
+0xB4, 0xCD, 0xF9, 0x14, 0x55, 0x95, 0xCC, 0xD9, 
+0x39, 0x59, 0x42, 0x80, 0xEF, 0x27, 0x13, 0x35, 
+0xB5, 0x8A, 0xCC, 0xFA, 0x3A, 0x11, 0x27, 0xA8, 
+0xD1, 0xAE, 0x18, 0x23, 0x7A, 0x0E, 0x03, 0x8D, 
+0x16, 0x6B, 0x1D, 0x4E, 0x6F, 0x51, 0x58, 0xE9, 
+0x29, 0xA1, 0x5C, 0x94, 0x9A, 0xA4, 0xB0, 0x53, 
+0x1B, 0x9E, 0x83, 0x48, 0xA8, 0x0C, 0x9F, 0x6D, 
+0xDE, 0x54, 0x07, 0x19, 
+
+0x3E, 0xD2, 0xDB, 0x28, 0x51, 0x71, 0x69, 0x60, 
+0x8D, 0x1D, 0xF5, 0x5B, 0xAC, 0xED, 0xA0, 0x4A, 
+0xFF, 0x9C, 0x4B, 0x3B, 0x9D, 0xE7, 0x99, 0x9A, 
+0xC1, 0xE7, 0xEF, 0x8C, 0x9C, 0x68, 0x78, 0x29, 
+0xE9, 0xA0, 0x90, 0x37, 0x4B, 0x76, 0x2B, 0xA0, 
+0xB1, 0xA4, 0x84, 0xCB, 0xEF, 0x9E, 0x2D, 0x46, 
+0xE5, 0x21, 0x22, 0x52, 0xDC, 0xD1, 0x4C, 0x59, 
+0x72, 0x01, 0x05, 0x01, 0x7F, 0x6C, 0x8C, 0xE3, 
+0x9C, 0xC0, 0xCC, 0xC6, 0xFC, 0xBA, 0x7E, 0x11, 
+0xA6, 0xFE, 0x47, 0x25, 0xEE, 0x40, 0x87, 0x83, 
+0xEE, 0x37, 0x8E, 0x1F, 0x07, 0xFD, 0x00, 0xA3, 
+0xC5, 0x09, 0xD0, 0x02, 0x04, 
+
+0xA5, 0xA1, 0x0F, 0x9A, 0x81, 0x2A, 0x1F, 0x19, 
+0x4D, 0x62, 0x44, 0xD7, 0xD8, 0xCE, 0xFF, 0xE5, 
+0xBF, 0xDE, 0xF2, 0xA9, 0xEF, 0xB3, 0xE5, 0xC0, 
+0x3C, 0x50, 0xE5, 0xA9, 0x44, 0x49, 0x41, 0x7A, 
+0xCA, 0xCD, 0xD5, 0xFB, 0x81, 0x58, 0xC5, 0x54, 
+0xF8, 0x25, 0x13, 0xD5, 0xAF, 0xF6, 0x27, 0xD6, 
+0x67, 0x4D, 0x85, 0x12, 0x32, 0xB6, 0x9D, 0x2F, 
+0x26, 0xD0, 0xBD, 0xF3, 0xE4, 0x91, 0xFF, 0x38, 
+0x6A, 0xAF, 0x8C, 0x41, 0xF2, 0x95, 0x7F, 0xC5, 
+0xA3, 0x38, 0xF5, 0xC4, 0xD7, 0x43, 0x6A, 0x54, 
+0x8F, 0x43, 0xE4, 0xA8, 0x58, 0x76, 0xAD, 0x48, 
+0x76, 0xFA, 0x75, 0xDF, 0xCC, 0x5B, 
+
+0xFF, 0x08, 0xEE, 0x28, 0xD2, 0x5E, 0x80, 0xDE, 
+0x65, 0x16, 0xEB, 0xA3, 0xE5, 0xA1, 0xCF, 0x75, 
+0x01, 0xC3, 0xE3, 0xBD, 0x6C, 0x47, 0x2D, 0xC3, 
+0x3E, 0xFC, 0xBE, 0xC1, 0xCF, 0xEC, 0xD7, 0xA8, 
+0xAA, 0xC1, 0xE3, 0x9F, 0x4B, 0xFD, 0x91, 0xD3, 
+0x7C, 0xB7, 0xE0, 0x9E, 0x14, 0x9C, 0x20, 0x03, 
+0xAC, 0xDB, 0xB9, 0xA5, 0xB7, 0xF5, 0x1D, 0x09, 
+0x26, 0x44, 0x27, 0x73, 0x9C, 0xB1, 0x04, 0x27, 
+0x59, 0x28, 0x65, 0x2E, 0x8F, 0xB3, 0x7C, 0x46, 
+0x2D, 0x8A, 0x40, 0x6D, 0x87, 0x26, 0x00, 0x0C, 
+0x2B, 0xB7, 0x53, 0xC5, 0x7E, 0x7E, 0x24, 0x3D, 
+0x23, 0xC1, 0x0B, 0xA7, 0xF5, 0x20, 0xB7, 
+
+0x3B, 0x5A, 0xC5, 0x0B, 0xE8, 0x6B, 0x80, 0x66, 
+0x69, 0xC6, 0x82, 0x42, 0x64, 0xD7, 0x94, 0x94, 
+0x00, 0xD1, 0x9B, 0x81, 0x02, 0x65, 0x0E, 0x5D, 
+0x0A, 0xEE, 0x85, 0xE4, 0xDE, 0x4A, 0xC8, 0x72, 
+0xD4, 0x4C, 0x02, 0xD8, 0x24, 0x07, 0x2E, 0xFF, 
+0xA6, 0xAB, 0xCF, 0x6C, 0x65, 0x9E, 0xBC, 0x0D, 
+0x7F, 0x6D, 0xC9, 0xA6, 0x5B, 0x95, 0x2A, 0x55, 
+0xE8, 0xC2, 0x77, 0xDE, 0x67, 0x9C, 0x01, 0x3B, 
+0x4E, 0x9D, 0xF4, 0xC9, 0x75, 0xE0, 0x2D, 0x84, 
+0x1D, 0x7E, 0xFE, 0x9D, 0x3B, 0x54, 0x63, 0x27, 
+0x57, 0xD9, 0x4A, 0x44, 0xAE, 0xDE, 0xA2, 0xD5, 
+0x6B, 0xF1, 0x41, 0xB2, 0x33, 0x54, 0x67, 0xFA, 
+
+0x26, 0x5A, 0x6E, 0xAF, 0x9D, 0xED, 0xF7, 0x2A, 
+0x20, 0xF5, 0x39, 0x3A, 0x47, 0x6A, 0x3A, 0x5C, 
+0x39, 0x0C, 0xD9, 0x48, 0x14, 0xB3, 0x2B, 0x52, 
+0xEF, 0x20, 0x48, 0xBB, 0x49, 0x6A, 0xF9, 0x90, 
+0x05, 0xD3, 0xBE, 0x0D, 0x0A, 0xA5, 0x30, 0x00, 
+0xDD, 0x94, 0x9D, 0x15, 0x5B, 0xE3, 0x03, 0x7F, 
+0x78, 0x19, 0x6F, 0xDB, 0x30, 0x5D, 0xF3, 0x47, 
+0xDD, 0xD6, 0xC9, 0x80, 0x65, 0x6A, 0x26, 0xD9, 
+0x94, 0x1C, 0x90, 0x5B, 0xB7, 0x7F, 0x39, 0x5E, 
+0xF2, 0x72, 0xF6, 0x36, 0x33, 0xDB, 0x21, 0x8D, 
+0x2C, 0xB3, 0x01, 0x88, 0xDE, 0x36, 0x6D, 0xA2, 
+0x0C, 0x8A, 0x91, 0xD0, 0x20, 0xFF, 0x36, 0xAE, 
+0x67, 
+
+0x2C, 0x9D, 0xE1, 0x82, 0xD1, 0xDC, 0x54, 0x30, 
+0x36, 0x35, 0xBB, 0xDD, 0x1E, 0x98, 0x94, 0x37, 
+0x72, 0xCF, 0x13, 0x4A, 0x2D, 0x3A, 0xE2, 0x4A, 
+0xA4, 0xDE, 0x17, 0x43, 0x9D, 0xEF, 0x18, 0x2F, 
+0x1D, 0xEC, 0xBA, 0x34, 0x39, 0x0F, 0x3E, 0xAA, 
+0x74, 0x12, 0xE4, 0xDB, 0x2B, 0x5E, 0xC6, 0xC4, 
+0xBF, 0x75, 0xC0, 0x63, 0x33, 0x5A, 0x2A, 0x40, 
+0x78, 0xF0, 0x82, 0x59, 0xB3, 0x31, 0x87, 0xB4, 
+0x12, 0xDC, 0x4F, 0x4B, 0xE9, 0xCD, 0xA8, 0x70, 
+0x6D, 0x4B, 0x90, 0x4B, 0xA1, 0x8F, 0xEE, 0xB1, 
+0x01, 0x29, 0x3E, 0x5E, 0x70, 0x00, 0xF2, 0x1F, 
+0xFE, 0xBB, 0xE9, 0xD3, 0x16, 0x7C, 0x68, 0x58, 
+0x1D, 0x96, 
+
+0x3D, 0xB4, 0xA1, 0x83, 0x71, 0xB4, 0x94, 0x53, 
+0x82, 0x94, 0x17, 0xCB, 0xD8, 0x84, 0x41, 0x57, 
+0xE2, 0x78, 0xE8, 0x16, 0x31, 0xC8, 0xD5, 0x42, 
+0xBC, 0x04, 0xFB, 0x68, 0xFB, 0xEE, 0x06, 0x6F, 
+0xA7, 0x8D, 0x50, 0xCF, 0xF3, 0x64, 0x64, 0x3B, 
+0x63, 0x97, 0xD7, 0x74, 0x50, 0x16, 0x5D, 0xB5, 
+0x0C, 0xDC, 0xD0, 0x8C, 0x45, 0xA8, 0xFA, 0x6C, 
+0xF8, 0xFD, 0x69, 0xF2, 0x6A, 0xD4, 0x77, 0xA6, 
+0x5B, 0xEE, 0x08, 0xDC, 0xEA, 0x5F, 0xFA, 0x28, 
+0xE2, 0xCE, 0x7F, 0xD6, 0x20, 0x2F, 0x09, 0x6F, 
+0xD4, 0x99, 0x8A, 0x75, 0xEF, 0x22, 0xD7, 0x8D, 
+0x77, 0xEE, 0xEC, 0x09, 0x93, 0xB4, 0x15, 0xA6, 
+0xD8, 0xE7, 0x43, 
+
+0xDB, 0xA5, 0x11, 0xDA, 0x27, 0x48, 0xA7, 0x4A, 
+0x08, 0xD0, 0xB5, 0x0D, 0x12, 0x2C, 0x4B, 0x88, 
+0xB3, 0x41, 0x79, 0xCB, 0xFC, 0xA0, 0x59, 0x5D, 
+0x3D, 0xF6, 0x7C, 0xD0, 0xCA, 0xED, 0x90, 0x27, 
+0x98, 0x88, 0xC3, 0x10, 0x60, 0xCF, 0xA6, 0x3F, 
+0xFE, 0x7B, 0x72, 0xE0, 0xC9, 0xC9, 0x3F, 0xB3, 
+0xBA, 0x74, 0x8A, 0x02, 0x6F, 0x16, 0xCD, 0x14, 
+0x95, 0x18, 0x46, 0x65, 0x10, 0xB7, 0x02, 0xDE, 
+0x9A, 0x2A, 0x29, 0x83, 0x40, 0xBD, 0xBE, 0xD4, 
+0xF9, 0x59, 0x63, 0x70, 0xEB, 0xCC, 0x73, 0x3E, 
+0x82, 0x6F, 0xB3, 0xC4, 0x66, 0x6A, 0x95, 0x1E, 
+0xE9, 0x5F, 0x0F, 0x15, 0x32, 0x6F, 0x70, 0x51, 
+0x6E, 0xF4, 0xB2, 0xDE, 
+
+0xF9, 0x5D, 0x5F, 0x50, 0x7B, 0x4E, 0x89, 0xC1, 
+0x66, 0xC7, 0x59, 0xB2, 0xF3, 0xA9, 0x71, 0xEC, 
+0x35, 0x3D, 0x70, 0x54, 0xC7, 0xB5, 0xDB, 0x31, 
+0x64, 0x14, 0x75, 0x68, 0x09, 0x75, 0x32, 0x5C, 
+0xE0, 0xB5, 0x12, 0x65, 0xD4, 0xE1, 0x21, 0xAE, 
+0x07, 0x82, 0x2A, 0xC4, 0xF4, 0xB1, 0x41, 0x76, 
+0x5C, 0x1E, 0xFA, 0x3B, 0x93, 0x5F, 0x93, 0x6E, 
+0x6A, 0x59, 0xD9, 0x99, 0xF9, 0x06, 0x84, 0x4B, 
+0x26, 0x35, 0xAC, 0x0A, 0x4F, 0x68, 0x05, 0xCB, 
+0x76, 0x0A, 0xA2, 0xED, 0xD7, 0x61, 0x07, 0x05, 
+0x98, 0xF4, 0x67, 0xD7, 0xA3, 0x42, 0x29, 0x03, 
+0xA8, 0xFD, 0xF5, 0x1D, 0x43, 0x5B, 0x77, 0xEE, 
+0xDC, 0x9F, 0xCF, 0x34, 0xB4, 
+
+0x82, 0xDD, 0x83, 0x89, 0x4E, 0x43, 0xB1, 0xAB, 
+0xA6, 0x6E, 0x2B, 0xC7, 0x02, 0x66, 0xE6, 0x3B, 
+0x66, 0xFA, 0x51, 0x6F, 0x12, 0x18, 0xD1, 0x4E, 
+0x1A, 0x2B, 0xC0, 0xB0, 0xB0, 0x80, 0xBA, 0x45, 
+0xFD, 0x1E, 0x81, 0x08, 0x57, 0xA0, 0xBE, 0x0C, 
+0x12, 0xB4, 0x55, 0xB0, 0x3A, 0xD5, 0x65, 0xBF, 
+0x7F, 0x3B, 0xB6, 0x16, 0x2F, 0x03, 0xFB, 0x12, 
+0x5C, 0xF0, 0x5D, 0xF6, 0x69, 0xA3, 0x9E, 0xE3, 
+0xEE, 0x07, 0x49, 0x18, 0x94, 0xC0, 0xEC, 0xE9, 
+0x8F, 0x09, 0xA8, 0x7C, 0xDA, 0xBB, 0x83, 0xBC, 
+0xEE, 0xD2, 0x2C, 0x37, 0xF0, 0x0C, 0x5A, 0x0A, 
+0x4A, 0xE0, 0xD8, 0x18, 0xEA, 0x19, 0x24, 0xB4, 
+0x56, 0x57, 0x14, 0xB2, 0x00, 0x10, 
+
+0x1F, 0x72, 0xC0, 0x91, 0x99, 0x56, 0xDB, 0x03, 
+0x1D, 0x6B, 0x60, 0x3D, 0x25, 0x99, 0x64, 0x40, 
+0x7D, 0x07, 0x7D, 0xA7, 0xF0, 0x34, 0x2C, 0xF6, 
+0x33, 0x3B, 0xE2, 0x0E, 0x16, 0x0C, 0x4F, 0x43, 
+0xFA, 0x43, 0x94, 0x3E, 0xA4, 0x69, 0x08, 0xD8, 
+0x3C, 0xC6, 0xCA, 0x93, 0xEC, 0x12, 0xDC, 0x35, 
+0xC5, 0x7D, 0x13, 0x35, 0xBA, 0x37, 0xD8, 0x11, 
+0xA9, 0x92, 0x25, 0x76, 0x4A, 0x2A, 0x78, 0x65, 
+0xC1, 0xC9, 0x97, 0x36, 0x7D, 0x24, 0xBF, 0x4C, 
+0xC2, 0x16, 0xB3, 0x47, 0xF3, 0x09, 0xFD, 0x97, 
+0x70, 0xC2, 0xDC, 0xA8, 0x78, 0x7E, 0x32, 0x11, 
+0x1C, 0xF5, 0xA9, 0xAF, 0x57, 0x38, 0x7E, 0xCE, 
+0xD8, 0x64, 0x38, 0xBF, 0x27, 0x9A, 0xE9, 
+
+0xA3, 0x96, 0x92, 0x18, 0xFB, 0x92, 0xD7, 0x38, 
+0x7D, 0xEF, 0xEC, 0xAA, 0x9F, 0x16, 0xEE, 0xD8, 
+0x94, 0x99, 0xBC, 0x6F, 0xBF, 0xE0, 0xBA, 0x28, 
+0xFD, 0x47, 0x96, 0xA2, 0x11, 0xFC, 0x36, 0x64, 
+0x55, 0xC4, 0xB5, 0x7D, 0x1D, 0xCF, 0x82, 0xFA, 
+0x48, 0x0D, 0x50, 0xD9, 0xCD, 0x6F, 0x0B, 0x83, 
+0xCA, 0xA7, 0xFD, 0x68, 0xA1, 0x4F, 0x37, 0x57, 
+0xC8, 0x18, 0x30, 0xD3, 0x78, 0xAB, 0xF9, 0x4C, 
+0x2B, 0x09, 0x53, 0xC1, 0x5B, 0x3C, 0x54, 0xCA, 
+0xD3, 0xBA, 0xA1, 0x4D, 0x04, 0x22, 0xD6, 0x23, 
+0x33, 0x6C, 0x76, 0x77, 0xE3, 0x2F, 0x6E, 0x38, 
+0xEE, 0xBF, 0xB6, 0x8D, 0x6F, 0x0C, 0xF3, 0x8B, 
+0x01, 0x0E, 0x64, 0xE6, 0xE7, 0x41, 0x51, 0x1A, 
+
+0x25, 0x8F, 0x78, 0xA6, 0x89, 0x03, 0x06, 0x69, 
+0x09, 0x2A, 0xEE, 0x62, 0xD8, 0x17, 0xCD, 0x45, 
+0x47, 0x65, 0xB7, 0x90, 0x01, 0xC7, 0xB6, 0xA0, 
+0x14, 0x63, 0x46, 0x69, 0x08, 0x58, 0x25, 0xB8, 
+0x37, 0xDB, 0x73, 0x27, 0xFC, 0x71, 0x54, 0x76, 
+0x62, 0x9F, 0xFC, 0xA5, 0x47, 0x1E, 0x9B, 0xAC, 
+0x0C, 0xAE, 0x35, 0x15, 0xB5, 0x7D, 0x9F, 0x08, 
+0x79, 0x50, 0x03, 0x98, 0x5E, 0xA4, 0x08, 0xDF, 
+0x5C, 0xBD, 0x80, 0x5C, 0xB3, 0x94, 0xD0, 0x00, 
+0x72, 0x46, 0xE6, 0x3D, 0xA7, 0xE1, 0x0D, 0xE0, 
+0xE8, 0xFE, 0xB7, 0x33, 0xEC, 0xCE, 0xC6, 0x28, 
+0x58, 0xB1, 0x9E, 0x48, 0x9B, 0x71, 0x0A, 0xEE, 
+0x49, 0x00, 0xC4, 0x02, 0x0D, 0x02, 0x99, 0x7D, 
+0xCA, 
+
+0x8E, 0xEC, 0x42, 0xD8, 0x50, 0xED, 0x66, 0xB8, 
+0x1A, 0x6B, 0x25, 0x6E, 0xEE, 0x3B, 0x5B, 0x7B, 
+0x2C, 0x39, 0xFF, 0x3E, 0xEF, 0x2E, 0xA1, 0xF1, 
+0x81, 0x58, 0x89, 0x76, 0x8F, 0x2E, 0xBC, 0x9B, 
+0xC5, 0x4F, 0xD0, 0xC0, 0x58, 0xC5, 0x9C, 0x49, 
+0x6E, 0x52, 0x8A, 0x9C, 0x7B, 0x39, 0x06, 0xC9, 
+0x96, 0x07, 0x73, 0xC9, 0x26, 0xEA, 0x16, 0x73, 
+0xD3, 0x57, 0x5B, 0x85, 0xB3, 0xF1, 0x67, 0x08, 
+0x05, 0x52, 0xE7, 0x96, 0xEE, 0x6A, 0x3E, 0xD2, 
+0xC3, 0x2D, 0x68, 0x03, 0x73, 0x1E, 0x26, 0x44, 
+0x1D, 0x24, 0x94, 0x08, 0x76, 0x3B, 0xB5, 0x37, 
+0x98, 0xC9, 0x82, 0x7F, 0xAC, 0x25, 0xCF, 0x66, 
+0x2E, 0x17, 0x86, 0xA2, 0xC7, 0x4B, 0x5B, 0xEE, 
+0xAD, 0x5A, 
+
+0x8F, 0x38, 0x7E, 0xE4, 0x73, 0xE3, 0x70, 0x3F, 
+0xBB, 0x33, 0x0C, 0x71, 0x8D, 0x5C, 0x4F, 0x36, 
+0x94, 0xE6, 0x71, 0x7F, 0xBE, 0x8B, 0x2C, 0x05, 
+0x9E, 0xA2, 0x83, 0x9E, 0x92, 0xA3, 0xAA, 0xAF, 
+0xF3, 0xC9, 0xF3, 0x88, 0xD0, 0x6B, 0x5A, 0x21, 
+0x4B, 0xFD, 0x62, 0x34, 0x9A, 0x3D, 0x3C, 0xAF, 
+0x7B, 0x64, 0xD8, 0x22, 0x8C, 0xBA, 0xAA, 0x53, 
+0xB2, 0x7E, 0xAB, 0x0F, 0x14, 0x64, 0x30, 0xF3, 
+0x94, 0xA9, 0x4B, 0x2B, 0x1E, 0xB9, 0x71, 0xED, 
+0x9A, 0xD7, 0xB6, 0xCE, 0xAB, 0x62, 0x50, 0x90, 
+0x17, 0x45, 0x03, 0x3A, 0x8B, 0x26, 0xB1, 0xA8, 
+0x1D, 0x7C, 0x03, 0x13, 0x46, 0x83, 0xFC, 0x0B, 
+0x7E, 0xC3, 0x22, 0xA4, 0xA9, 0xCB, 0xC6, 0x7A, 
+0xA0, 0x4B, 0x1C, 
+
+0x59, 0x31, 0x62, 0xFE, 0xE2, 0x8A, 0xE6, 0x32, 
+0xCA, 0xDF, 0xA8, 0x32, 0x3C, 0x9B, 0xA5, 0xAF, 
+0x1C, 0x22, 0x9B, 0x9B, 0x69, 0x5A, 0xDD, 0xCB, 
+0x49, 0x41, 0x77, 0x6F, 0xE5, 0x6D, 0x92, 0x77, 
+0x94, 0xAB, 0x77, 0x25, 0xE8, 0x96, 0x7C, 0xD3, 
+0x87, 0x10, 0xAE, 0x1E, 0x59, 0x63, 0x82, 0xBB, 
+0xBE, 0x9A, 0xF3, 0x96, 0xAC, 0xDA, 0xC3, 0x42, 
+0x2E, 0x2D, 0xE9, 0x9A, 0xB5, 0xA2, 0x75, 0x84, 
+0xB7, 0xAF, 0xA5, 0x13, 0x64, 0xED, 0xB6, 0xDA, 
+0xAC, 0x4C, 0x99, 0x4C, 0x72, 0x40, 0xD1, 0xC8, 
+0x7C, 0x1B, 0x09, 0xE3, 0xB0, 0xCA, 0x98, 0x94, 
+0x07, 0x98, 0xC2, 0x39, 0x46, 0x39, 0x59, 0x36, 
+0x71, 0x06, 0x55, 0x25, 0x1D, 0x29, 0x49, 0xB0, 
+0x9B, 0x27, 0x12, 0x43, 
+
+0x2B, 0x36, 0xEA, 0x77, 0xA7, 0xEE, 0x14, 0x93, 
+0x43, 0xD1, 0x22, 0xD1, 0xE8, 0xA1, 0x2F, 0x7F, 
+0xB3, 0x74, 0xDD, 0x52, 0x40, 0x38, 0x90, 0x19, 
+0xCB, 0xFE, 0xEC, 0x0E, 0x7A, 0x63, 0xFD, 0xD4, 
+0xA8, 0xCA, 0x19, 0x57, 0xAB, 0x51, 0x57, 0xBD, 
+0x70, 0xBD, 0x3A, 0x62, 0xF4, 0x1E, 0xD7, 0xB1, 
+0x6B, 0x48, 0x9C, 0xD7, 0xA9, 0x6A, 0xDF, 0xFC, 
+0x9A, 0xC2, 0xFA, 0x23, 0xE5, 0x7F, 0xBC, 0x02, 
+0x9B, 0x92, 0x7F, 0xF4, 0x2B, 0xCA, 0x65, 0xA7, 
+0x1C, 0xD3, 0x5F, 0x5E, 0x3C, 0x6E, 0xD5, 0x3F, 
+0xF6, 0xE5, 0xA0, 0x62, 0x6D, 0x47, 0x84, 0x4A, 
+0x01, 0xCE, 0x0C, 0xE8, 0x9A, 0x71, 0x73, 0x21, 
+0xD3, 0x1D, 0x4E, 0xA0, 0x58, 0x0B, 0x55, 0x4A, 
+0xCB, 0xBA, 0x7A, 0xE0, 0xFB, 
+
+0xF7, 0xB2, 0x9B, 0x9A, 0xEF, 0x02, 0x8E, 0xF5, 
+0x41, 0x8E, 0xDB, 0xD8, 0xA0, 0xB7, 0x0A, 0xDE, 
+0x65, 0xD6, 0xBD, 0x83, 0x9E, 0xC1, 0x8B, 0xB7, 
+0x1B, 0xF0, 0x94, 0xCF, 0xD4, 0x3C, 0x26, 0x5A, 
+0x25, 0x22, 0xCF, 0xE9, 0xC2, 0x4C, 0xEE, 0x11, 
+0x7C, 0xEA, 0x41, 0x37, 0x2F, 0x73, 0x98, 0xDC, 
+0x56, 0x57, 0x6F, 0xEF, 0x50, 0x9D, 0x62, 0x0C, 
+0xE5, 0xA4, 0xE5, 0x94, 0x38, 0xAF, 0x56, 0xB0, 
+0xD8, 0x43, 0x11, 0xD5, 0x57, 0x20, 0x98, 0x43, 
+0x8D, 0x81, 0x5F, 0x2C, 0x93, 0xC4, 0x64, 0xC6, 
+0xA5, 0x9D, 0x4F, 0x40, 0xB6, 0x0D, 0x20, 0xBA, 
+0xF7, 0x59, 0x2E, 0xAF, 0x32, 0xD8, 0x24, 0xF7, 
+0xCB, 0x89, 0xBB, 0xE3, 0x7A, 0x3E, 0x97, 0x0F, 
+0x3B, 0xB1, 0xC3, 0x16, 0x9D, 0x8A, 
+
+0x41, 0xDC, 0xB3, 0x49, 0x54, 0x85, 0x13, 0x01, 
+0x17, 0xF2, 0xE6, 0x68, 0x39, 0x78, 0x07, 0x15, 
+0x4E, 0x05, 0x55, 0xFC, 0x93, 0x05, 0xB2, 0x23, 
+0xCB, 0xFC, 0xD7, 0xE0, 0x03, 0xA1, 0x30, 0x96, 
+0x90, 0xF4, 0xD9, 0x92, 0x85, 0x95, 0xE4, 0x55, 
+0xFD, 0x69, 0x5E, 0x19, 0xBA, 0x14, 0x3A, 0x6E, 
+0xBA, 0x22, 0xF6, 0x54, 0xE7, 0x85, 0x18, 0xF4, 
+0xDC, 0x7C, 0xD2, 0xAC, 0x2F, 0x43, 0x64, 0x9C, 
+0x1E, 0x72, 0x5F, 0x12, 0xCE, 0x36, 0xA0, 0xBF, 
+0xDD, 0x91, 0xBF, 0xCD, 0xC9, 0x78, 0x1D, 0x47, 
+0x4B, 0xFE, 0x57, 0xE5, 0x3E, 0xFB, 0xA7, 0x6B, 
+0xA6, 0x4F, 0x6D, 0x0C, 0x15, 0x7C, 0x9A, 0xBE, 
+0x9B, 0x52, 0x6E, 0xBA, 0x78, 0x41, 0x48, 0xDB, 
+0x41, 0x10, 0xF4, 0xF4, 0x75, 0x4B, 0x13, 
+
+0xA2, 0x3D, 0x32, 0xFC, 0x23, 0xC9, 0xAC, 0x35, 
+0x10, 0xD4, 0x62, 0xB5, 0x39, 0x3A, 0x0F, 0x59, 
+0xF8, 0x45, 0xD4, 0xC3, 0x1A, 0xD6, 0xAC, 0xB3, 
+0x2C, 0x56, 0x65, 0x2D, 0x67, 0x0B, 0xDF, 0x68, 
+0x78, 0x4F, 0x7B, 0xA2, 0xCC, 0xFC, 0xB2, 0x75, 
+0x56, 0x2A, 0x95, 0x2B, 0x0B, 0xEF, 0x21, 0xD6, 
+0xF6, 0x34, 0x8A, 0xA4, 0x23, 0x99, 0x22, 0x94, 
+0xC4, 0xF1, 0x4D, 0xAE, 0xA8, 0x7B, 0xDF, 0x7C, 
+0xE0, 0x57, 0x4E, 0x79, 0x31, 0x39, 0x97, 0x14, 
+0x45, 0xDC, 0xED, 0x87, 0x92, 0xD6, 0x0E, 0xA3, 
+0xFD, 0x29, 0x2A, 0x1A, 0xA6, 0x4F, 0x90, 0x00, 
+0xBC, 0xEA, 0xD6, 0xD5, 0x57, 0x3F, 0x2C, 0xE7, 
+0x53, 0xF8, 0x85, 0xD1, 0xA4, 0x16, 0x0D, 0x1E, 
+0xBE, 0xAC, 0x4D, 0xEF, 0xC2, 0xC5, 0x28, 0x6C, 
+
+0xF2, 0x7A, 0x21, 0xA9, 0xF0, 0x45, 0x33, 0x1A, 
+0xD8, 0xE0, 0xBF, 0x2B, 0x5C, 0xC4, 0xC3, 0xFC, 
+0x4E, 0x2B, 0xE9, 0xA5, 0x07, 0x27, 0xFC, 0x89, 
+0x49, 0x8B, 0x1B, 0xA6, 0x4F, 0x65, 0xEA, 0x44, 
+0x35, 0x78, 0x6A, 0xE9, 0x08, 0xA7, 0x7F, 0x06, 
+0xA6, 0xE3, 0xE5, 0xEC, 0x1B, 0x8E, 0xB0, 0x26, 
+0xBB, 0xA7, 0x65, 0x59, 0x8B, 0x83, 0xA3, 0xB4, 
+0x15, 0x48, 0xCC, 0xA3, 0x33, 0x73, 0x66, 0x3D, 
+0xB4, 0x5F, 0x52, 0x58, 0x04, 0xE4, 0x18, 0xF0, 
+0xD5, 0x3A, 0x39, 0xE4, 0xE2, 0x04, 0x43, 0x7B, 
+0xDF, 0xDF, 0x73, 0x8A, 0x01, 0xAA, 0x39, 0x8F, 
+0x2F, 0x78, 0xED, 0xA2, 0xBD, 0x94, 0xD0, 0x8E, 
+0x49, 0x59, 0x11, 0x5F, 0x9B, 0x2D, 0x7C, 0xB1, 
+0xE8, 0x86, 0xD8, 0xC9, 0xF0, 0xAD, 0xB7, 0x21, 
+0x8D, 
+
+0x06, 0x53, 0xDF, 0xE9, 0xEA, 0xA7, 0x8F, 0x4D, 
+0xC8, 0x04, 0x30, 0x18, 0xF3, 0x6F, 0x4B, 0x54, 
+0xEB, 0xB2, 0xBA, 0x3A, 0xA1, 0xE0, 0x0A, 0xD8, 
+0x2B, 0xCA, 0xF6, 0x35, 0x47, 0x10, 0x20, 0xFC, 
+0x2C, 0x61, 0x96, 0x63, 0xEF, 0x85, 0xE9, 0x55, 
+0xA4, 0x20, 0xFD, 0x71, 0x14, 0xA0, 0x8A, 0x4C, 
+0xFE, 0x8F, 0xAF, 0x32, 0x96, 0x7D, 0x21, 0x92, 
+0x3C, 0x59, 0x09, 0xF6, 0x3C, 0xBE, 0x64, 0x42, 
+0xD5, 0xBB, 0xB3, 0x82, 0xF7, 0xF3, 0x72, 0x8D, 
+0x93, 0xD0, 0x5D, 0xEF, 0x09, 0x16, 0xC4, 0x31, 
+0xE0, 0xA4, 0xA9, 0x15, 0xDD, 0x3B, 0x82, 0xAC, 
+0x78, 0x71, 0x1A, 0x7F, 0xDD, 0x94, 0x63, 0x76, 
+0xEC, 0xC7, 0xE9, 0xBB, 0x2F, 0x6E, 0x91, 0xDD, 
+0xF1, 0x69, 0x3F, 0x0E, 0x8D, 0xD8, 0xD3, 0x02, 
+0xF9, 0xB4, 
+
+0x84, 0x43, 0xD1, 0xAF, 0x47, 0x33, 0x4A, 0x3B, 
+0x7A, 0x40, 0xD1, 0xB6, 0x96, 0x94, 0x29, 0xEA, 
+0x3E, 0xA7, 0x82, 0xFD, 0x0D, 0xDB, 0x63, 0xAC, 
+0x82, 0xCE, 0x65, 0xC8, 0x97, 0xDC, 0x14, 0x37, 
+0xDC, 0xE4, 0x7F, 0x13, 0xA7, 0xC3, 0x4F, 0xE2, 
+0x91, 0x48, 0x55, 0x45, 0x84, 0x1A, 0x55, 0xFB, 
+0x40, 0x61, 0x51, 0xA9, 0xED, 0x34, 0xE0, 0xEC, 
+0xE6, 0x6B, 0xEA, 0x19, 0x70, 0x9B, 0x66, 0x6A, 
+0x93, 0x83, 0xB1, 0xFD, 0x70, 0xC7, 0x08, 0x5B, 
+0x7D, 0xBA, 0xB4, 0xE0, 0x81, 0xCA, 0xBC, 0x70, 
+0xA4, 0xF1, 0x84, 0xFC, 0xE2, 0x72, 0x65, 0x3E, 
+0x2B, 0x3F, 0x83, 0x30, 0x3F, 0x41, 0x3C, 0xE7, 
+0xE0, 0x13, 0x7F, 0xA7, 0x65, 0x0A, 0x02, 0x4B, 
+0x23, 0x43, 0xC3, 0x48, 0xDA, 0xBD, 0x6B, 0xD0, 
+0xB4, 0xA8, 0x66, 
+
+0xA8, 0x1A, 0xF9, 0xAB, 0x9B, 0x7E, 0x79, 0x23, 
+0xD8, 0xC6, 0xE3, 0x4C, 0xE2, 0xD9, 0x63, 0x95, 
+0x50, 0xB3, 0x16, 0x38, 0xF7, 0x07, 0xEF, 0xCA, 
+0x01, 0xF4, 0xCF, 0xC2, 0x19, 0x98, 0x81, 0xA6, 
+0xF5, 0x87, 0xB4, 0x90, 0xCD, 0x38, 0x73, 0xBE, 
+0xC9, 0xB8, 0xDE, 0x0E, 0xC7, 0x68, 0x1B, 0xB8, 
+0xD9, 0xEA, 0x3D, 0x43, 0x08, 0x96, 0xCF, 0xF7, 
+0x6B, 0x56, 0xE9, 0x68, 0x6F, 0x6C, 0x91, 0x71, 
+0x42, 0x80, 0x10, 0xAD, 0x82, 0x12, 0x7A, 0x98, 
+0xD8, 0xF7, 0x33, 0x09, 0xC8, 0x68, 0x40, 0x82, 
+0x2F, 0x87, 0x52, 0x1D, 0xDE, 0xE6, 0x9B, 0x4B, 
+0x90, 0xA0, 0x90, 0xF6, 0xDB, 0x65, 0x66, 0x60, 
+0xE8, 0x10, 0xA8, 0x92, 0x60, 0x1C, 0x87, 0x7D, 
+0x62, 0xE4, 0xF8, 0x27, 0xAF, 0x0E, 0x25, 0x41, 
+0x47, 0x34, 0x86, 0x4F, 
+
+0x8F, 0x08, 0xCF, 0x8D, 0x77, 0x1A, 0x54, 0x83, 
+0x80, 0x1C, 0x58, 0xCF, 0xAE, 0x70, 0x54, 0x7D, 
+0x27, 0x6D, 0xA5, 0x5F, 0x35, 0x68, 0x54, 0x29, 
+0xE7, 0x7D, 0x34, 0x22, 0x78, 0x00, 0xC1, 0xB9, 
+0x39, 0x0E, 0xDE, 0x8E, 0xBF, 0x1A, 0x77, 0x02, 
+0xE7, 0xAD, 0x02, 0xFD, 0x1E, 0xF8, 0xE8, 0x14, 
+0x34, 0x30, 0xA2, 0x4F, 0x2B, 0x00, 0x2D, 0x63, 
+0x53, 0x54, 0x9B, 0x89, 0x7E, 0xE5, 0xB2, 0x29, 
+0x29, 0xC9, 0xC3, 0x87, 0xB9, 0xF5, 0xF1, 0x08, 
+0x68, 0x48, 0x86, 0x0B, 0x0E, 0x64, 0x49, 0xEF, 
+0x02, 0x6D, 0x48, 0xAF, 0x26, 0xDA, 0x92, 0x9D, 
+0xA8, 0xC9, 0x07, 0x93, 0xDB, 0x78, 0x3E, 0xC2, 
+0x9E, 0xCD, 0x28, 0xE0, 0x97, 0x4A, 0xCB, 0xA6, 
+0x9E, 0x01, 0x1A, 0x36, 0xAC, 0xBE, 0xB5, 0xC0, 
+0xDF, 0x45, 0xAF, 0x65, 0xBE, 
+
+0x6E, 0xD9, 0xE0, 0x76, 0x4C, 0x29, 0x92, 0x59, 
+0x71, 0x83, 0xA3, 0xDE, 0x5A, 0x52, 0x56, 0x63, 
+0xFA, 0xD8, 0x61, 0x5A, 0xF6, 0xC0, 0xC2, 0x7C, 
+0xA7, 0xD2, 0xAD, 0x76, 0x82, 0x32, 0x6A, 0xE8, 
+0x70, 0x59, 0x67, 0x6C, 0x82, 0xD5, 0xB4, 0x6E, 
+0x38, 0x4A, 0xF5, 0x35, 0x0D, 0x6E, 0x75, 0x76, 
+0x40, 0xF9, 0xBB, 0x6A, 0xB3, 0x64, 0x8C, 0xDA, 
+0x9E, 0xD2, 0x1A, 0xCC, 0x7B, 0xB1, 0x20, 0x11, 
+0x44, 0xE5, 0x28, 0x67, 0x47, 0x8C, 0x81, 0x92, 
+0xD9, 0x63, 0x1B, 0x04, 0xEB, 0xA1, 0xDC, 0x58, 
+0x1F, 0xEC, 0x1F, 0x24, 0x09, 0x3F, 0xF0, 0x07, 
+0xC6, 0xC1, 0x3E, 0xC1, 0xCB, 0x41, 0xE7, 0x7D, 
+0xF3, 0x22, 0x3E, 0xBD, 0x87, 0x26, 0x46, 0x48, 
+0xD7, 0xFB, 0xAD, 0x29, 0x33, 0xAE, 0xB3, 0x73, 
+0xB0, 0x8E, 0xC3, 0xCD, 0x19, 0xC1, 
+
+0x3B, 0x8D, 0xB8, 0x02, 0xA8, 0x46, 0x32, 0xBC, 
+0xC1, 0x36, 0xFF, 0x94, 0x41, 0xE7, 0x86, 0x6A, 
+0x79, 0x5A, 0x9F, 0x91, 0x8C, 0xAD, 0x72, 0xAF, 
+0xDB, 0xF2, 0x1E, 0xBB, 0x2D, 0x9A, 0x09, 0x9F, 
+0x9B, 0xB5, 0xB1, 0xC8, 0xED, 0x69, 0x14, 0x76, 
+0x0B, 0xAB, 0x36, 0x2E, 0xF8, 0xD9, 0x80, 0xB4, 
+0xE1, 0x52, 0xB6, 0x4E, 0x7B, 0x18, 0x28, 0x8E, 
+0xC5, 0x2D, 0x49, 0x8C, 0xC4, 0x96, 0xAB, 0x31, 
+0x31, 0x51, 0x46, 0x9D, 0x2C, 0x9C, 0x89, 0xAC, 
+0x5A, 0x61, 0x25, 0x10, 0x31, 0x34, 0x9E, 0x19, 
+0x80, 0xB4, 0x5C, 0x18, 0x72, 0x72, 0xFE, 0xBA, 
+0x58, 0x4D, 0xAD, 0x4B, 0x9A, 0x9D, 0xD5, 0xF3, 
+0x9B, 0x59, 0xBF, 0x8E, 0x33, 0x2E, 0xCB, 0xAF, 
+0xC1, 0x3F, 0xDB, 0x53, 0xE6, 0xA3, 0x70, 0x52, 
+0x0B, 0xEE, 0xFF, 0x5A, 0xE7, 0x03, 0x54, 
+
+0x72, 0xE3, 0x4D, 0x03, 0xAB, 0x8F, 0x3E, 0x55, 
+0x95, 0xCA, 0x16, 0x5D, 0x59, 0x99, 0x92, 0x9F, 
+0xFE, 0x98, 0x0B, 0xA5, 0xC1, 0xD4, 0xFD, 0x57, 
+0xDD, 0x44, 0x89, 0x06, 0xFD, 0xC5, 0x47, 0x50, 
+0x5D, 0xC3, 0x2C, 0xE0, 0x03, 0x67, 0x1E, 0x8D, 
+0x79, 0x29, 0x6B, 0x37, 0x79, 0x59, 0x78, 0xFC, 
+0xA9, 0x98, 0x70, 0xBB, 0x19, 0xCA, 0xFA, 0x85, 
+0x73, 0xC9, 0x6B, 0x19, 0x64, 0x4E, 0x28, 0xD9, 
+0xA4, 0xDE, 0xFE, 0x26, 0xB3, 0x40, 0x5F, 0xBD, 
+0xC8, 0xE3, 0xDF, 0x08, 0xB2, 0xA4, 0x5D, 0xF8, 
+0x25, 0x1C, 0x30, 0x40, 0xFF, 0x2C, 0x21, 0x45, 
+0xF9, 0x2C, 0x94, 0xB6, 0x3E, 0xBA, 0x39, 0x33, 
+0xB0, 0xA9, 0x7C, 0xD3, 0xA9, 0x21, 0x8F, 0xA3, 
+0x03, 0x04, 0xDE, 0x2F, 0xBF, 0xBA, 0xCE, 0x7D, 
+0x97, 0xB2, 0x6E, 0x6A, 0xA9, 0xA0, 0x4C, 0xAF, 
+
+0xC1, 0x36, 0xC5, 0x75, 0x23, 0x76, 0xBA, 0xC8, 
+0x9D, 0x39, 0xD5, 0x6F, 0x3B, 0x81, 0x65, 0x7C, 
+0xEE, 0x72, 0xC5, 0x9F, 0x57, 0x46, 0x86, 0xD3, 
+0x33, 0x25, 0xB0, 0x85, 0xBF, 0x36, 0xEA, 0xE0, 
+0x63, 0x13, 0x28, 0x15, 0xE4, 0x05, 0x40, 0xF3, 
+0xA0, 0x4F, 0x47, 0x75, 0x31, 0x8F, 0x21, 0xEE, 
+0x6E, 0x85, 0x7E, 0xCF, 0xEC, 0xA4, 0x0C, 0xA4, 
+0x10, 0x7E, 0xBD, 0xD1, 0xAB, 0xEF, 0x1C, 0x4F, 
+0x53, 0x3C, 0x93, 0x76, 0x4A, 0xD1, 0x6E, 0xEA, 
+0x63, 0xEF, 0xD2, 0xAE, 0xE6, 0x9B, 0xA8, 0x6B, 
+0xBC, 0xD9, 0xA0, 0xCA, 0x32, 0x55, 0x2C, 0x7D, 
+0x3F, 0x77, 0xD4, 0xED, 0xEC, 0x7D, 0x62, 0xD7, 
+0xF4, 0x8A, 0xCC, 0x87, 0xEA, 0xB0, 0x8C, 0x70, 
+0xAF, 0x85, 0xA3, 0xC0, 0x8A, 0x36, 0xE2, 0xB5, 
+0x2F, 0x7A, 0x8C, 0xEB, 0xB3, 0x89, 0x8B, 0xDC, 
+0xB8, 
+
+0xF3, 0xAA, 0x5B, 0x9F, 0x8A, 0x93, 0x5B, 0x65, 
+0xC0, 0x2A, 0x75, 0x07, 0xAC, 0x3F, 0x59, 0xED, 
+0x72, 0x88, 0x04, 0x65, 0x87, 0xDF, 0xC8, 0x24, 
+0xCE, 0x67, 0xE5, 0x07, 0x88, 0x57, 0x00, 0xF4, 
+0x0C, 0x09, 0x75, 0xB4, 0x6F, 0xF6, 0xE8, 0x43, 
+0x3C, 0xBC, 0x10, 0xC0, 0x70, 0x4E, 0x07, 0xB4, 
+0x8C, 0xA5, 0x69, 0x5E, 0x80, 0x54, 0xDF, 0xB1, 
+0xDE, 0x4A, 0x59, 0x55, 0x9D, 0xBE, 0x57, 0x6B, 
+0x36, 0xF4, 0x01, 0xA8, 0x01, 0x7C, 0x77, 0x17, 
+0x38, 0xEC, 0x28, 0xF7, 0xD0, 0x5A, 0xCA, 0x59, 
+0x2C, 0xEF, 0x09, 0x7F, 0x82, 0x32, 0x87, 0xDA, 
+0x6C, 0x68, 0x5E, 0x38, 0xD6, 0xDD, 0x0B, 0xFC, 
+0x47, 0xDD, 0x16, 0xD2, 0xEE, 0x0E, 0xE9, 0xF5, 
+0xDE, 0x3B, 0x4E, 0xA2, 0xD9, 0xB1, 0x95, 0x97, 
+0xF4, 0xB3, 0x10, 0x43, 0xBB, 0xC3, 0x60, 0x5F, 
+0x0D, 0x38, 
+
+0x9C, 0x76, 0x6F, 0x8C, 0x3D, 0x2A, 0x5E, 0x70, 
+0x74, 0x05, 0x30, 0x76, 0xC5, 0xE2, 0x62, 0x70, 
+0x97, 0x1E, 0x96, 0x0C, 0x5A, 0x07, 0x06, 0x5E, 
+0x04, 0xDF, 0x12, 0x54, 0x70, 0xAC, 0x04, 0x96, 
+0xCC, 0xE6, 0x1E, 0x5B, 0xF2, 0xC9, 0x7C, 0x1C, 
+0x4B, 0x3E, 0x1F, 0xBF, 0x46, 0xC5, 0xA2, 0x2D, 
+0x89, 0x39, 0x65, 0x65, 0xF3, 0x0B, 0x05, 0x71, 
+0xD9, 0x80, 0x0B, 0x74, 0x18, 0xDF, 0x11, 0xA5, 
+0x47, 0x4A, 0xA1, 0x6F, 0xE5, 0x8A, 0x19, 0xF1, 
+0x7E, 0x16, 0x86, 0x9E, 0x20, 0xC5, 0x84, 0x66, 
+0xFD, 0xD9, 0xCB, 0xE2, 0x95, 0x7A, 0x02, 0x65, 
+0x33, 0x4C, 0xFB, 0x1A, 0xA9, 0xF7, 0x57, 0xA8, 
+0x96, 0x24, 0x06, 0x3D, 0xF8, 0x5E, 0x26, 0x67, 
+0x81, 0xD8, 0x5B, 0x9D, 0x61, 0x77, 0xCD, 0xEE, 
+0xE8, 0x84, 0x2A, 0x32, 0xBD, 0xCA, 0x8D, 0xB6, 
+0x79, 0x37, 0xE9, 
+
+0x01, 0xC8, 0x4E, 0x82, 0x42, 0x21, 0x62, 0x5E, 
+0x26, 0x79, 0xCF, 0x0B, 0x5D, 0x3F, 0x40, 0x25, 
+0x90, 0xE5, 0x1B, 0x9C, 0xE5, 0x7D, 0x13, 0xE5, 
+0x8D, 0xC0, 0x3C, 0x50, 0x85, 0x7B, 0xCC, 0x5A, 
+0xE8, 0x4F, 0xBD, 0x96, 0x46, 0xF6, 0x7B, 0x09, 
+0x23, 0x3B, 0x0D, 0x8E, 0x6D, 0xFB, 0x03, 0x3A, 
+0xF5, 0x86, 0x6F, 0x70, 0x41, 0xD4, 0x0C, 0x1A, 
+0x2B, 0xEA, 0x1D, 0x6B, 0x3B, 0x44, 0x54, 0x41, 
+0xC3, 0x83, 0xC4, 0x13, 0xB7, 0x47, 0x4D, 0xD5, 
+0xAD, 0x26, 0x30, 0xAE, 0xC9, 0x12, 0x94, 0x49, 
+0x66, 0x8D, 0x50, 0x8F, 0x71, 0x6A, 0xD1, 0x4E, 
+0xB5, 0x94, 0x26, 0x16, 0x53, 0xF4, 0x8F, 0xEA, 
+0x01, 0xFD, 0xD8, 0x7A, 0x48, 0xCE, 0xC0, 0xDB, 
+0x1F, 0x24, 0x81, 0x8C, 0xC1, 0x65, 0x74, 0xF5, 
+0x33, 0xD7, 0xE2, 0x22, 0x59, 0x9C, 0x03, 0xDF, 
+0x12, 0x55, 0x4E, 0x2E, 
+
+0xF9, 0x22, 0x90, 0x65, 0x86, 0xA1, 0x28, 0x16, 
+0x1A, 0x81, 0x7E, 0x74, 0xC1, 0x80, 0x03, 0xE4, 
+0x2B, 0xD2, 0xD8, 0x05, 0xB2, 0x44, 0xD2, 0x2A, 
+0x58, 0x80, 0x37, 0xEB, 0x80, 0x05, 0x4E, 0x65, 
+0x7B, 0xBD, 0x70, 0x08, 0x56, 0xF7, 0xC7, 0xFD, 
+0xD2, 0x1E, 0x4E, 0x63, 0xF3, 0xF7, 0x07, 0x8A, 
+0x04, 0x9D, 0x3D, 0xE4, 0xC7, 0xF8, 0x83, 0xD2, 
+0x99, 0x21, 0x3D, 0x8D, 0x9D, 0xD8, 0x1A, 0x0A, 
+0x35, 0xCF, 0x6D, 0xEF, 0x0C, 0x07, 0xF0, 0xBD, 
+0xF9, 0x85, 0x31, 0x89, 0x0B, 0x9C, 0xF0, 0xFB, 
+0x9D, 0xDD, 0x18, 0x1A, 0xC5, 0x07, 0x26, 0x30, 
+0x0B, 0x29, 0xFE, 0x1F, 0xAB, 0x91, 0x0B, 0x76, 
+0x85, 0x82, 0x38, 0xE7, 0x4F, 0x26, 0x41, 0xF6, 
+0x20, 0x65, 0x49, 0xAE, 0x5F, 0x80, 0xAB, 0x53, 
+0xDF, 0x28, 0x72, 0x6C, 0x16, 0x1E, 0x02, 0x60, 
+0x8A, 0x92, 0x4D, 0xAB, 0x7D, 
+
+0x0B, 0x89, 0x27, 0x41, 0xB6, 0xAF, 0x04, 0x7B, 
+0x9F, 0xDC, 0x06, 0xD8, 0x7A, 0x70, 0xBC, 0x19, 
+0x33, 0xEC, 0xBA, 0xC3, 0x9E, 0x9E, 0x49, 0x0A, 
+0x79, 0x22, 0x15, 0x77, 0x24, 0x38, 0x76, 0x84, 
+0xF9, 0x5D, 0xEF, 0x7B, 0x2F, 0xB9, 0x87, 0xB1, 
+0xE2, 0xCF, 0xA1, 0xB4, 0x2C, 0x85, 0x68, 0x5C, 
+0x9C, 0x70, 0x30, 0xEE, 0x3C, 0x7B, 0x7A, 0xF1, 
+0xA6, 0x41, 0xCA, 0x6B, 0x3C, 0x02, 0x20, 0xD2, 
+0x79, 0x93, 0x7D, 0x74, 0xFC, 0x0D, 0x22, 0xE8, 
+0xCE, 0x74, 0xF9, 0x2B, 0xF0, 0xFD, 0xED, 0xEB, 
+0xA2, 0x1A, 0xDE, 0xE1, 0x64, 0x41, 0xC5, 0x14, 
+0xAB, 0x6B, 0x6F, 0x78, 0x3C, 0xF5, 0xDD, 0xFD, 
+0x3A, 0x52, 0x1C, 0x33, 0xC1, 0xC4, 0x4E, 0x02, 
+0x9E, 0xC9, 0x3F, 0xCD, 0xAD, 0xE1, 0xE7, 0x26, 
+0xE0, 0x44, 0x3A, 0x56, 0x26, 0x1C, 0x8C, 0x92, 
+0x28, 0x4C, 0xF3, 0x7A, 0x94, 0xE3, 
+
+0x4D, 0x39, 0x61, 0xC6, 0x3B, 0xCC, 0xB1, 0x65, 
+0x20, 0xA7, 0xD1, 0xBC, 0x27, 0x93, 0xCE, 0x59, 
+0x1F, 0x45, 0x67, 0x5C, 0x62, 0xA8, 0x7A, 0x0F, 
+0xBB, 0xFF, 0x00, 0xF0, 0x08, 0x90, 0x6E, 0x73, 
+0xC5, 0xC2, 0x6A, 0x76, 0x12, 0x5C, 0xD3, 0x38, 
+0x2C, 0xFA, 0x8D, 0x25, 0xC9, 0x02, 0x22, 0x29, 
+0x26, 0xCE, 0x32, 0xDC, 0x6A, 0x26, 0xFC, 0x87, 
+0x0B, 0xAA, 0xD7, 0x65, 0x96, 0x04, 0x32, 0xD9, 
+0xEA, 0xED, 0x5C, 0x89, 0xF6, 0xED, 0x7F, 0x4B, 
+0xCF, 0xF6, 0x87, 0xF1, 0xBA, 0x4A, 0x96, 0x37, 
+0xAF, 0x49, 0x8C, 0x03, 0x83, 0xEC, 0x4B, 0x61, 
+0x74, 0x56, 0x52, 0x89, 0xB2, 0xAD, 0x56, 0x83, 
+0x5B, 0xDF, 0x1F, 0x06, 0xC9, 0x23, 0xCA, 0xD3, 
+0x21, 0x02, 0x00, 0x62, 0x3A, 0xE1, 0x22, 0xD5, 
+0x5E, 0x4B, 0x85, 0xFF, 0x6E, 0xBB, 0xB8, 0x1E, 
+0xE4, 0xC6, 0x9A, 0xA5, 0x2B, 0x77, 0x60, 
+
+0x2E, 0x2C, 0xFB, 0xFA, 0xF2, 0x0D, 0x4E, 0xCA, 
+0x3D, 0x0F, 0x8D, 0x7B, 0x8B, 0xD1, 0x1E, 0x36, 
+0xEA, 0x3E, 0x29, 0xDF, 0xEA, 0xD5, 0xA9, 0x6C, 
+0x92, 0xF6, 0xCB, 0x5E, 0xA7, 0xB4, 0x51, 0xB1, 
+0x05, 0xFA, 0x42, 0x1B, 0xAF, 0xBD, 0xCB, 0x6E, 
+0x6A, 0xFD, 0xC6, 0x62, 0x26, 0xF3, 0x0F, 0x56, 
+0x97, 0x32, 0x6D, 0xBC, 0x89, 0xA5, 0x01, 0x48, 
+0x91, 0xF7, 0x68, 0x5A, 0x44, 0x54, 0xC0, 0x25, 
+0x87, 0x73, 0xB8, 0x85, 0xE7, 0x28, 0xC2, 0x6F, 
+0xEC, 0x71, 0xC1, 0x30, 0xDC, 0x9B, 0x44, 0x8D, 
+0x6A, 0x28, 0x00, 0x65, 0x01, 0x85, 0x10, 0xBC, 
+0x2B, 0xA4, 0xEC, 0x0E, 0x32, 0x80, 0x96, 0x53, 
+0x84, 0xC8, 0x9C, 0x51, 0xA8, 0x9B, 0xAC, 0xD8, 
+0xFD, 0xF5, 0xB2, 0x80, 0xCC, 0xFA, 0xD2, 0xAA, 
+0x3B, 0x90, 0xF2, 0x58, 0x71, 0x70, 0x01, 0xD6, 
+0x13, 0xAE, 0xCD, 0x45, 0xAF, 0x35, 0x77, 0xE6, 
+
+0xCC, 0x20, 0x20, 0x0C, 0x9E, 0x9F, 0x6F, 0xC3, 
+0xC2, 0xF5, 0x71, 0x8A, 0x21, 0x52, 0x51, 0x8C, 
+0xC6, 0xA2, 0x29, 0x4A, 0xC1, 0x14, 0x99, 0x1E, 
+0xDE, 0xCF, 0x5E, 0xBE, 0x8E, 0xCD, 0x08, 0x5B, 
+0x02, 0xE3, 0x06, 0x42, 0x48, 0x11, 0x85, 0x9A, 
+0x4C, 0xCC, 0x5A, 0xD3, 0xCF, 0x53, 0x46, 0x6E, 
+0xB8, 0xDE, 0xA7, 0xB1, 0x15, 0xEE, 0x4B, 0x4C, 
+0xF4, 0x11, 0x8D, 0xBD, 0x25, 0x75, 0x54, 0xED, 
+0x71, 0x93, 0x04, 0xFD, 0xF5, 0xF1, 0x2F, 0xB1, 
+0xD5, 0x1B, 0x30, 0x53, 0xAE, 0xA2, 0x89, 0x94, 
+0xD1, 0x9A, 0xCC, 0x60, 0x2A, 0x52, 0xC2, 0x24, 
+0xB1, 0xDC, 0x5F, 0x8B, 0xA7, 0x5E, 0xBF, 0xEB, 
+0x9E, 0x14, 0x7E, 0x45, 0x71, 0x6D, 0xEB, 0xF9, 
+0x28, 0x8D, 0x39, 0xBE, 0xF7, 0xED, 0x0A, 0x36, 
+0x1D, 0x02, 0xE9, 0x57, 0xA0, 0xB3, 0xE7, 0x9A, 
+0x26, 0x3E, 0x9B, 0x97, 0x22, 0x02, 0xA3, 0x1A, 
+0xCD, 
+
+0x08, 0xF7, 0x36, 0x14, 0x24, 0xC0, 0xF9, 0x16, 
+0x8F, 0xE8, 0xF4, 0xC4, 0xA3, 0xAD, 0x38, 0x7C, 
+0x3C, 0xFC, 0xF9, 0xCF, 0x04, 0x5C, 0x29, 0x46, 
+0xB2, 0x29, 0xC4, 0xDA, 0xF7, 0x92, 0x96, 0x9A, 
+0x86, 0x1C, 0xC0, 0xA4, 0x01, 0x13, 0x40, 0xB6, 
+0x1E, 0x79, 0x38, 0x44, 0xC5, 0x5F, 0x33, 0x77, 
+0x6D, 0x6E, 0xFF, 0xCC, 0xA5, 0x29, 0xEC, 0x83, 
+0xC8, 0xB3, 0x31, 0xB2, 0x4E, 0x8D, 0x9E, 0x26, 
+0xE4, 0xDB, 0xB6, 0xFB, 0xE7, 0x27, 0x97, 0x31, 
+0x0A, 0x7C, 0x37, 0xD2, 0x54, 0xE6, 0xAD, 0xF7, 
+0xFF, 0xA5, 0x93, 0x23, 0x5F, 0x24, 0x07, 0xF0, 
+0xDA, 0x5A, 0x24, 0x51, 0xA1, 0x12, 0x1B, 0x09, 
+0xD3, 0x7A, 0x8E, 0xC8, 0xE4, 0x87, 0x3B, 0x52, 
+0x44, 0x18, 0x42, 0xD0, 0x08, 0x71, 0x17, 0xB8, 
+0x0A, 0xE9, 0x45, 0x29, 0x9C, 0x7F, 0xDB, 0x13, 
+0x55, 0xBB, 0x9A, 0x4F, 0x36, 0xAC, 0xE7, 0xC9, 
+0xC6, 0xCF, 
+
+0xB0, 0x7D, 0xA5, 0x51, 0xFC, 0x59, 0xFF, 0x13, 
+0x36, 0xBE, 0x34, 0x8B, 0xE8, 0x19, 0x41, 0xE6, 
+0x8D, 0x4F, 0xC3, 0x7E, 0x66, 0x47, 0x3C, 0x93, 
+0xDC, 0x92, 0xBC, 0x36, 0xEA, 0x71, 0x97, 0x8F, 
+0xC2, 0xB8, 0x05, 0x04, 0xCB, 0x90, 0x74, 0xD6, 
+0x05, 0xE9, 0x7D, 0x4B, 0x6A, 0xB8, 0x42, 0x1C, 
+0x98, 0x52, 0x67, 0x38, 0xA2, 0x1A, 0xDD, 0xD6, 
+0xE2, 0x8A, 0x61, 0x97, 0x2C, 0x6E, 0xBF, 0xD6, 
+0x30, 0x03, 0x18, 0x0B, 0x53, 0x52, 0x9F, 0x3D, 
+0xAB, 0xED, 0x20, 0xE7, 0x82, 0x08, 0x65, 0xBE, 
+0x8B, 0x23, 0xB7, 0xAE, 0x8F, 0x9B, 0xC6, 0xB9, 
+0x26, 0x57, 0x50, 0x1E, 0x30, 0xF2, 0xFF, 0x99, 
+0x71, 0x22, 0x6D, 0xD9, 0x4B, 0x4C, 0x9D, 0x97, 
+0x25, 0x61, 0x62, 0x0C, 0x5A, 0x86, 0xEE, 0x6F, 
+0x32, 0x43, 0x78, 0xA0, 0xF4, 0x44, 0x31, 0x31, 
+0x9B, 0xA1, 0xAA, 0xE6, 0xDC, 0x95, 0x85, 0x4B, 
+0x21, 0x7F, 0x99, 
+
+0x91, 0x9A, 0xAA, 0x1B, 0x93, 0x2B, 0x1F, 0x84, 
+0xFB, 0x20, 0x61, 0x2A, 0x78, 0xEE, 0x1F, 0x8A, 
+0x74, 0xE5, 0x9A, 0xFC, 0x2C, 0x06, 0xE2, 0x83, 
+0x24, 0x50, 0xAA, 0xB0, 0x04, 0xE4, 0xE5, 0x14, 
+0xC4, 0xB7, 0x47, 0x3F, 0x16, 0x77, 0x7E, 0x21, 
+0xF4, 0x3C, 0xF4, 0x48, 0x22, 0x32, 0xF1, 0xD8, 
+0xC8, 0xB7, 0x25, 0x17, 0xC4, 0xCC, 0xCE, 0xCC, 
+0x0F, 0x1F, 0x6F, 0x95, 0xCF, 0xEA, 0x91, 0xAE, 
+0xDE, 0x80, 0x5B, 0x75, 0xD2, 0xAC, 0x89, 0x08, 
+0x2E, 0x37, 0x74, 0x18, 0x4D, 0xE5, 0x12, 0x39, 
+0xE7, 0x8E, 0x09, 0x97, 0xBF, 0x75, 0x2A, 0x11, 
+0x22, 0x23, 0x61, 0xF0, 0xBF, 0x39, 0x1E, 0x4A, 
+0x26, 0xE3, 0xBA, 0x26, 0xDB, 0xFE, 0x28, 0xE7, 
+0x9A, 0x14, 0xBB, 0x1A, 0x69, 0xD8, 0xB0, 0x1A, 
+0xE9, 0x63, 0xF9, 0xC9, 0xE2, 0x68, 0xC4, 0xDD, 
+0xCB, 0x35, 0x63, 0x80, 0xB9, 0x7A, 0x79, 0x7A, 
+0x47, 0x10, 0x30, 0xDE, 
+
+0x92, 0x3E, 0x69, 0x10, 0x19, 0xDB, 0x43, 0x28, 
+0x46, 0xD8, 0x9F, 0x8F, 0x7A, 0x5A, 0x96, 0xB1, 
+0x53, 0x60, 0xEC, 0x8B, 0x67, 0xF7, 0x67, 0xAD, 
+0xCF, 0x4B, 0xD4, 0xB8, 0x8F, 0xF3, 0xA8, 0xBA, 
+0xF4, 0x6F, 0xEC, 0x5F, 0x84, 0x05, 0x18, 0xCC, 
+0x76, 0x01, 0xD8, 0xC3, 0x1D, 0x88, 0xA7, 0x75, 
+0xF7, 0xD3, 0x87, 0x76, 0x7B, 0xB5, 0xE1, 0xC0, 
+0x9A, 0x6F, 0x51, 0xB3, 0xA6, 0xD2, 0x99, 0x74, 
+0xF4, 0xEA, 0xED, 0x6A, 0x31, 0x6B, 0xEC, 0x0D, 
+0xA4, 0xE5, 0xBB, 0xA7, 0xDF, 0xE3, 0xAB, 0xE6, 
+0x0E, 0x8E, 0xB3, 0x04, 0xD7, 0x10, 0x1E, 0xDD, 
+0x89, 0x3F, 0x8F, 0x5B, 0x43, 0xBD, 0xCF, 0xB1, 
+0xD8, 0xB3, 0xAF, 0x3D, 0xC8, 0x94, 0xBD, 0xB3, 
+0x2B, 0x8F, 0x99, 0x64, 0xBE, 0x87, 0xD4, 0x55, 
+0x41, 0xFB, 0x76, 0xB4, 0x02, 0x6C, 0x43, 0x95, 
+0x78, 0x2E, 0x52, 0x48, 0xA9, 0x04, 0x35, 0x7A, 
+0x02, 0xA4, 0x0A, 0x1A, 0x26, 
+
+0xAE, 0x9F, 0x68, 0x9F, 0xDE, 0xA6, 0x83, 0xF9, 
+0x01, 0xA1, 0xB3, 0x7B, 0xDE, 0xA6, 0xA9, 0xFD, 
+0x9F, 0x14, 0x7F, 0x6E, 0x8B, 0xD4, 0xC9, 0xE7, 
+0x70, 0xC5, 0xF8, 0xF0, 0xF4, 0x3A, 0xB7, 0xB4, 
+0x23, 0xAD, 0x5E, 0xCA, 0x56, 0x65, 0x69, 0xA6, 
+0x84, 0x53, 0xD3, 0xB2, 0x23, 0x2E, 0xCE, 0x99, 
+0xA8, 0x5A, 0xFB, 0xE9, 0xE4, 0xB7, 0x89, 0xF4, 
+0xFE, 0xDA, 0xAF, 0x74, 0x51, 0xC0, 0xA2, 0x90, 
+0x2D, 0xE8, 0xA0, 0xF5, 0x45, 0x51, 0x9B, 0xF5, 
+0xD0, 0xB3, 0x5D, 0x05, 0x28, 0x38, 0xE0, 0x18, 
+0x59, 0xFB, 0xFD, 0x99, 0x93, 0x6C, 0xA3, 0xB5, 
+0xFC, 0x92, 0x68, 0x1E, 0xE0, 0xC4, 0xB7, 0x31, 
+0xA6, 0x96, 0x4E, 0x0C, 0xED, 0xE6, 0x34, 0xD6, 
+0x5B, 0xCF, 0x23, 0xF2, 0x79, 0x3A, 0x88, 0x17, 
+0x7D, 0xB1, 0x26, 0x19, 0x7F, 0x12, 0x35, 0x8A, 
+0xB0, 0xE9, 0xAF, 0xE7, 0x75, 0xA6, 0x71, 0x80, 
+0x2D, 0x2A, 0x49, 0xCD, 0x0A, 0x1A, 
+
+0xA0, 0x83, 0x5A, 0xE9, 0x78, 0xD8, 0x1E, 0x78, 
+0x32, 0x59, 0xE7, 0x01, 0x6A, 0x95, 0x92, 0xF3, 
+0xFF, 0xB1, 0x9E, 0x20, 0xC9, 0x42, 0xD8, 0xF3, 
+0x4C, 0x5C, 0x2C, 0xAD, 0x4F, 0x45, 0x5F, 0x0E, 
+0xC4, 0xB1, 0xD0, 0xC8, 0x3B, 0xA5, 0x91, 0xDD, 
+0xBB, 0xB5, 0xEA, 0x13, 0x2C, 0x62, 0x35, 0x32, 
+0x06, 0x2A, 0x6E, 0x50, 0xF8, 0x1E, 0x60, 0xFB, 
+0x14, 0xD0, 0xC5, 0x00, 0x2B, 0x68, 0x89, 0xAB, 
+0xB4, 0x75, 0x12, 0x76, 0x75, 0x4D, 0x15, 0x90, 
+0x5E, 0x92, 0x7B, 0xB9, 0x7A, 0x18, 0x2E, 0xC9, 
+0xA2, 0xDB, 0xC0, 0xA2, 0x71, 0x19, 0xA2, 0x21, 
+0x98, 0x27, 0x7A, 0x8C, 0xCA, 0xA8, 0x48, 0xDA, 
+0xC5, 0xAF, 0x39, 0x97, 0x01, 0xFF, 0x57, 0x27, 
+0xC4, 0x4F, 0x22, 0x0B, 0xBA, 0xC7, 0x9F, 0x0A, 
+0xAA, 0x18, 0x24, 0xDD, 0x7F, 0x98, 0x53, 0xFA, 
+0x3C, 0x36, 0x94, 0xF1, 0xF3, 0xEE, 0x1E, 0x04, 
+0xC4, 0x9F, 0x64, 0x83, 0x68, 0xC5, 0xE8, 
+
+0x6F, 0x8B, 0x23, 0x0E, 0x9F, 0x32, 0x8C, 0xA4, 
+0x72, 0x04, 0x53, 0xAD, 0xE3, 0x4A, 0x27, 0x05, 
+0x34, 0xD7, 0x25, 0x89, 0x0F, 0x1E, 0xF2, 0xF0, 
+0x31, 0x04, 0x62, 0xC9, 0x2D, 0x77, 0x78, 0xAF, 
+0xF8, 0xA3, 0x63, 0xC4, 0xE5, 0xAB, 0x41, 0x39, 
+0x8C, 0x39, 0x55, 0xC9, 0x91, 0xEC, 0x1A, 0x28, 
+0x62, 0x38, 0x94, 0x4F, 0xCE, 0xA1, 0x46, 0x05, 
+0x56, 0xF6, 0x0C, 0x3A, 0x43, 0xEE, 0xC5, 0xB5, 
+0xD4, 0xB1, 0x23, 0x8B, 0x48, 0x96, 0x0C, 0x38, 
+0xDF, 0xE9, 0x9E, 0xB5, 0x60, 0xA4, 0x0D, 0x6E, 
+0x24, 0x2C, 0x72, 0x0F, 0xF8, 0xF1, 0x22, 0x7B, 
+0x6F, 0x03, 0x37, 0x60, 0x7C, 0x0D, 0xB6, 0x7F, 
+0xF8, 0x94, 0x6F, 0x6D, 0x64, 0xE5, 0x09, 0x66, 
+0x95, 0x82, 0x86, 0x84, 0x1C, 0xCC, 0x3B, 0xAF, 
+0x10, 0xF2, 0xD8, 0x77, 0x36, 0xC1, 0x45, 0xF6, 
+0x50, 0x78, 0x38, 0xBC, 0x0F, 0x93, 0xEF, 0x83, 
+0x78, 0x45, 0xE5, 0x94, 0x17, 0x49, 0x5D, 0xA3, 
+
+0xDD, 0xD8, 0x02, 0x84, 0x42, 0xDA, 0x43, 0xED, 
+0xE1, 0xFE, 0x64, 0xB9, 0x59, 0x33, 0xDC, 0x2E, 
+0x25, 0x5B, 0xC1, 0x69, 0x2F, 0x7F, 0x28, 0xBC, 
+0x82, 0x32, 0x94, 0xB1, 0xC7, 0x9A, 0x87, 0xBA, 
+0xC1, 0xF6, 0x73, 0x3A, 0x36, 0xD3, 0x86, 0x82, 
+0xB9, 0x76, 0x60, 0x87, 0x39, 0x93, 0xFD, 0xC0, 
+0xAB, 0xBA, 0xFC, 0x2E, 0x03, 0x72, 0x50, 0x37, 
+0x2E, 0x3C, 0xF7, 0x5B, 0xFD, 0xDE, 0x2A, 0xAB, 
+0x39, 0xE7, 0x15, 0x90, 0xE0, 0x66, 0xD5, 0x29, 
+0xDA, 0x4A, 0xB7, 0x89, 0xCA, 0x4B, 0x63, 0x4C, 
+0xA3, 0x96, 0x0F, 0xCB, 0x85, 0x8C, 0xF8, 0x64, 
+0xFE, 0x8A, 0xFF, 0xF4, 0xFC, 0x1A, 0x0A, 0x38, 
+0x81, 0xAE, 0xFD, 0x15, 0xC0, 0x48, 0x00, 0x1A, 
+0x3B, 0xD4, 0x05, 0x26, 0x20, 0x91, 0x10, 0x6C, 
+0xCF, 0x83, 0x1C, 0xFD, 0xD3, 0x3E, 0x65, 0x44, 
+0xA5, 0x5C, 0xD6, 0xF9, 0x63, 0x9D, 0x79, 0x53, 
+0xB7, 0xE5, 0xCB, 0xA5, 0x98, 0x48, 0x66, 0x0F, 
+0x2A, 
+
+0x6B, 0x0D, 0x77, 0xC3, 0xF9, 0x7E, 0xE7, 0x59, 
+0xD2, 0xED, 0x2E, 0xEB, 0x3A, 0x9A, 0x06, 0xBF, 
+0x50, 0x11, 0xC6, 0x1A, 0x6F, 0x40, 0x34, 0x4D, 
+0xA9, 0x5F, 0x94, 0x20, 0xEF, 0x05, 0x39, 0x90, 
+0x2E, 0x83, 0x75, 0xFC, 0xD5, 0x15, 0x87, 0x5D, 
+0x3E, 0xF4, 0x47, 0x0E, 0xED, 0x48, 0xE3, 0x1F, 
+0x74, 0x4E, 0x1E, 0xFA, 0x4D, 0x57, 0xFF, 0x35, 
+0x51, 0x70, 0x47, 0x28, 0xB2, 0x0E, 0xEE, 0xC3, 
+0x98, 0xC2, 0x0A, 0xAD, 0xB8, 0x78, 0xDC, 0xC1, 
+0x3A, 0x69, 0x2E, 0x06, 0xE3, 0x7D, 0xE4, 0xEA, 
+0x9D, 0x08, 0xF0, 0x13, 0x47, 0x09, 0xD1, 0x69, 
+0x00, 0x6B, 0xCC, 0xB1, 0x2E, 0x5B, 0x23, 0x8E, 
+0xBB, 0x6D, 0x50, 0xAB, 0x96, 0x2E, 0x50, 0xE2, 
+0x47, 0x3A, 0x09, 0xE2, 0xB2, 0x9F, 0x2B, 0xAC, 
+0x32, 0x1B, 0x81, 0xF2, 0x79, 0xE4, 0xBB, 0xA3, 
+0x5A, 0xF6, 0x5C, 0x2F, 0x65, 0x6C, 0xD0, 0x81, 
+0xD3, 0x2F, 0x14, 0xED, 0x2A, 0x2F, 0x56, 0x62, 
+0x17, 0x71, 
+
+0xA9, 0x71, 0x17, 0x51, 0xA2, 0x62, 0xC3, 0xE3, 
+0x5D, 0xF3, 0x34, 0xD0, 0xCD, 0x21, 0xB2, 0xCD, 
+0x3D, 0xC4, 0x57, 0x0C, 0x4B, 0x8A, 0xD8, 0x16, 
+0xC3, 0xF4, 0x5D, 0x20, 0xBB, 0x97, 0x54, 0x49, 
+0xDB, 0xB5, 0xEA, 0x5B, 0xD2, 0x5A, 0xDE, 0x8C, 
+0xD4, 0xD1, 0xE7, 0x06, 0xC8, 0x30, 0x6E, 0xBA, 
+0x54, 0x59, 0x75, 0x5B, 0x15, 0x2D, 0xB4, 0xA7, 
+0x7E, 0x95, 0x95, 0x3A, 0xB2, 0x4D, 0x14, 0x25, 
+0xAF, 0xE8, 0x1B, 0x61, 0x3E, 0x52, 0xBA, 0x02, 
+0xDF, 0x64, 0xA4, 0xAD, 0x3B, 0x88, 0xE6, 0x85, 
+0x07, 0x42, 0x8D, 0xE6, 0x4A, 0xEC, 0xBF, 0xFE, 
+0xF8, 0x36, 0xAF, 0x01, 0x02, 0x04, 0x4F, 0x34, 
+0x0D, 0x49, 0xEA, 0x02, 0x90, 0x2E, 0x8D, 0x76, 
+0x66, 0x52, 0x71, 0x35, 0xFE, 0x3C, 0x48, 0x9E, 
+0xB7, 0x8A, 0x6D, 0xF9, 0x3D, 0xA2, 0x50, 0xC8, 
+0x2E, 0x05, 0xC3, 0x8A, 0xD0, 0x9B, 0xC3, 0xF9, 
+0x8D, 0x4C, 0x89, 0xF2, 0xC3, 0xFB, 0xEF, 0x17, 
+0x88, 0x72, 0x2C, 
+
+0x63, 0xAF, 0x6A, 0x1D, 0x67, 0x64, 0x14, 0x3B, 
+0x2D, 0x93, 0x83, 0xBD, 0xA3, 0x70, 0xCA, 0xB3, 
+0xE0, 0x52, 0x4B, 0xA3, 0xDF, 0x8C, 0x58, 0xAD, 
+0x8A, 0xEC, 0xDE, 0x8F, 0xD9, 0x8C, 0x24, 0xB3, 
+0x0E, 0x76, 0xD4, 0xD3, 0xA4, 0x34, 0x35, 0x9F, 
+0x2E, 0x10, 0x22, 0xFA, 0xE7, 0xB7, 0xE9, 0x16, 
+0x8C, 0x3C, 0xA9, 0x0C, 0x67, 0xEE, 0x36, 0x77, 
+0xEA, 0x29, 0x16, 0x00, 0x0E, 0x9D, 0x9A, 0x50, 
+0x8D, 0xFA, 0xAD, 0xF4, 0x12, 0xC1, 0x43, 0xA2, 
+0xDB, 0xB7, 0xAE, 0x4A, 0x15, 0xC6, 0xE9, 0x44, 
+0x42, 0x2F, 0x7C, 0x62, 0xF4, 0x29, 0x6F, 0x91, 
+0xA7, 0xD3, 0xA3, 0x77, 0x55, 0xDA, 0x63, 0x80, 
+0x44, 0xB7, 0xA6, 0x38, 0x7F, 0x72, 0x69, 0x3F, 
+0xFB, 0xCD, 0xC9, 0x36, 0x0D, 0x8A, 0x86, 0x91, 
+0x57, 0x5D, 0xBB, 0xFE, 0x3E, 0xF5, 0x8D, 0x7A, 
+0xBF, 0xE6, 0x31, 0x8D, 0x39, 0x9E, 0xAE, 0xEA, 
+0x04, 0xF1, 0x7A, 0xB6, 0xA9, 0x42, 0x4E, 0xAB, 
+0xB6, 0xB2, 0x46, 0xEF, 
+
+0x6D, 0xEC, 0x5E, 0x60, 0xE0, 0x16, 0x6A, 0x9E, 
+0x22, 0xE2, 0xA5, 0xEA, 0xE2, 0x92, 0x89, 0x9E, 
+0x3B, 0xC4, 0xA7, 0x82, 0x4B, 0x08, 0x17, 0xC7, 
+0x85, 0xD2, 0x07, 0xD3, 0xA8, 0x95, 0x5C, 0xE4, 
+0xB3, 0x19, 0x75, 0x9F, 0x1F, 0x7C, 0x1D, 0x10, 
+0x6F, 0x6A, 0x62, 0xF1, 0xED, 0xB2, 0xD6, 0xCD, 
+0x22, 0xCF, 0xC3, 0x38, 0xEC, 0xFE, 0xDA, 0x61, 
+0x88, 0x05, 0x95, 0xE7, 0x14, 0xF8, 0x5D, 0x49, 
+0xEF, 0xEF, 0xFF, 0x89, 0x36, 0x9E, 0x05, 0x1B, 
+0xC5, 0x5D, 0x60, 0x10, 0xAC, 0x97, 0x51, 0x88, 
+0xB4, 0x0B, 0xD1, 0x5C, 0x16, 0x43, 0x60, 0xC6, 
+0xE2, 0xE5, 0x86, 0x8E, 0x1D, 0xF8, 0x0A, 0xA4, 
+0x06, 0x76, 0xDF, 0x3F, 0x12, 0x23, 0x09, 0x95, 
+0x32, 0x66, 0x00, 0x50, 0xF6, 0xCC, 0xD1, 0x4C, 
+0xE8, 0x5B, 0xEC, 0xD9, 0x9D, 0xD5, 0x18, 0x23, 
+0xE2, 0x3D, 0xF5, 0x1C, 0x7E, 0xD9, 0x82, 0xEC, 
+0x29, 0xAB, 0x23, 0xC4, 0xDA, 0x13, 0x47, 0x91, 
+0xC7, 0xEF, 0x16, 0x3C, 0xD0, 
+
+0x80, 0x2C, 0x0F, 0xE9, 0x78, 0x28, 0xCB, 0x46, 
+0x77, 0xB7, 0x66, 0x5A, 0x5C, 0x0A, 0x7C, 0x54, 
+0x28, 0xE3, 0xB5, 0x7C, 0xA9, 0x72, 0x77, 0xD1, 
+0x15, 0x5D, 0xEC, 0x78, 0xA2, 0xBF, 0x8B, 0x5D, 
+0xF7, 0x5D, 0x01, 0x01, 0xD2, 0x05, 0x2F, 0x43, 
+0x1C, 0x6A, 0x5D, 0x1E, 0x7D, 0x38, 0x02, 0x50, 
+0xCA, 0x24, 0x18, 0x37, 0xCF, 0x6A, 0xD3, 0xCE, 
+0x5A, 0x3E, 0x00, 0x31, 0x4D, 0x18, 0xE6, 0x23, 
+0xA7, 0xE5, 0x13, 0x49, 0x41, 0xA7, 0xD2, 0x22, 
+0x47, 0xC8, 0x2F, 0x76, 0x8E, 0xD8, 0x75, 0x58, 
+0x74, 0xF1, 0xD8, 0xDF, 0x9A, 0x94, 0xB4, 0x12, 
+0x98, 0x15, 0xA7, 0xF6, 0xEC, 0x33, 0xD2, 0x41, 
+0x6E, 0x8A, 0x57, 0x3A, 0x3D, 0x52, 0x76, 0x51, 
+0x6C, 0xE5, 0xF5, 0x88, 0x90, 0xA6, 0x04, 0x8E, 
+0x33, 0xA2, 0x40, 0x99, 0x1A, 0x66, 0xD6, 0x1F, 
+0xF5, 0xEE, 0xF6, 0xA3, 0xFC, 0x48, 0x34, 0xCF, 
+0x81, 0x93, 0xD8, 0x89, 0x7D, 0x45, 0x9A, 0x32, 
+0x81, 0x3C, 0x73, 0xB5, 0x7F, 0x17, 
+
+0x1F, 0xEF, 0xA3, 0xEE, 0x02, 0x39, 0xBD, 0x9F, 
+0xFE, 0x6B, 0xE1, 0x67, 0x18, 0x29, 0xAB, 0xBD, 
+0xD5, 0xA2, 0xDB, 0xE6, 0x4C, 0x47, 0x61, 0x8F, 
+0xA2, 0x6C, 0x99, 0xE2, 0x3F, 0x4D, 0x0F, 0x49, 
+0x3A, 0xDC, 0x55, 0x58, 0x55, 0xC1, 0x8A, 0x6B, 
+0xFB, 0x4C, 0x75, 0x1C, 0xC0, 0x90, 0x1B, 0xE7, 
+0x4F, 0xAA, 0xE8, 0xD9, 0x90, 0x3F, 0x03, 0xFE, 
+0x03, 0xDA, 0xD9, 0x2E, 0xC6, 0x54, 0x35, 0x16, 
+0x22, 0xB3, 0x3D, 0xA2, 0xDE, 0x5C, 0xAB, 0x76, 
+0xBD, 0x19, 0xA9, 0x73, 0x35, 0xFF, 0xD9, 0xB9, 
+0x26, 0xDE, 0x8E, 0xAE, 0x01, 0x6E, 0xFC, 0xA0, 
+0x52, 0xBB, 0x15, 0x45, 0x3B, 0x51, 0x9C, 0x25, 
+0x9D, 0xB1, 0x3D, 0x72, 0xC9, 0x37, 0xAD, 0x1B, 
+0x6D, 0x90, 0xE4, 0x6C, 0x77, 0xB1, 0xA1, 0x9F, 
+0x35, 0xCA, 0x3F, 0x39, 0xC1, 0x77, 0xF1, 0x50, 
+0x5E, 0xC2, 0x84, 0xC7, 0x27, 0x06, 0xFB, 0x9B, 
+0x0E, 0x89, 0x0F, 0x73, 0x6A, 0xE4, 0x0A, 0xE7, 
+0x6A, 0xCE, 0x9D, 0x38, 0xF8, 0x96, 0xD5, 
+
+0x6A, 0x13, 0xF0, 0xA6, 0x18, 0x6C, 0x96, 0x69, 
+0x40, 0x2F, 0x26, 0x70, 0xEE, 0x6C, 0xE6, 0xD4, 
+0xE9, 0x40, 0x7D, 0x3E, 0x22, 0xC5, 0x90, 0x88, 
+0x5A, 0xC0, 0x9E, 0x60, 0x26, 0xF6, 0x72, 0x72, 
+0x01, 0x7D, 0x5F, 0x08, 0x19, 0xFE, 0xFE, 0xB0, 
+0xB3, 0x31, 0x72, 0x6F, 0x30, 0xC7, 0x77, 0x4C, 
+0x97, 0x8A, 0x7C, 0x21, 0x8D, 0x6E, 0x17, 0xE6, 
+0x72, 0xD6, 0x1F, 0xAB, 0x76, 0x0C, 0xAC, 0x60, 
+0x99, 0xFC, 0xB0, 0x49, 0x8B, 0x1F, 0x72, 0x4C, 
+0xAE, 0x26, 0x77, 0x60, 0x78, 0x46, 0x08, 0xF2, 
+0x38, 0xE5, 0x6E, 0x34, 0x45, 0x7B, 0x7B, 0x21, 
+0x2A, 0x19, 0x1F, 0x07, 0x53, 0x14, 0xA1, 0xCE, 
+0x0C, 0xBE, 0x3A, 0xC4, 0xF7, 0x42, 0xC3, 0x75, 
+0x53, 0xBC, 0x8E, 0x53, 0x24, 0xD9, 0xED, 0x0B, 
+0xF2, 0xCC, 0x30, 0x35, 0x2B, 0x44, 0x75, 0xD7, 
+0x79, 0x90, 0x8E, 0x1B, 0xD2, 0x5B, 0x65, 0x4D, 
+0x8F, 0x54, 0xC1, 0xA3, 0x42, 0xC9, 0xB8, 0x96, 
+0x87, 0x17, 0xF3, 0xE3, 0x05, 0xB1, 0xDF, 0xCD, 
+
+0x1F, 0x5A, 0x41, 0x33, 0x4F, 0xEC, 0x30, 0xE1, 
+0x26, 0x9E, 0x49, 0xE8, 0x7D, 0x9A, 0x91, 0x3C, 
+0xB9, 0x9A, 0x3A, 0x30, 0x56, 0x8F, 0xDE, 0xBD, 
+0xB1, 0x30, 0x9A, 0x6D, 0x93, 0xE6, 0x9B, 0xBA, 
+0x2D, 0x9C, 0x7E, 0x5A, 0x7A, 0xFE, 0x33, 0x0C, 
+0xF1, 0x4F, 0x5F, 0x16, 0xDB, 0x91, 0x97, 0xF1, 
+0x46, 0x1A, 0x1B, 0x04, 0x7E, 0x08, 0xF8, 0xAA, 
+0xE9, 0x27, 0x05, 0xF0, 0x09, 0xA4, 0x3D, 0x6A, 
+0xF7, 0xD5, 0x62, 0x42, 0xC0, 0x73, 0x13, 0x39, 
+0x6C, 0x7E, 0xE4, 0x6D, 0x41, 0xAD, 0xF1, 0x54, 
+0x29, 0x11, 0xC9, 0xF3, 0x39, 0x7B, 0xA9, 0xE8, 
+0x3F, 0xBA, 0x79, 0x58, 0xD9, 0x37, 0x0C, 0xE0, 
+0x8B, 0xF9, 0x95, 0xCB, 0xDB, 0xCD, 0x75, 0x16, 
+0x77, 0xBF, 0x66, 0xCE, 0xC2, 0x4B, 0x2C, 0xD3, 
+0xFC, 0xAA, 0x5B, 0x3F, 0x05, 0x15, 0x0C, 0x28, 
+0xEA, 0xCF, 0x0B, 0x7C, 0x3C, 0x90, 0xE3, 0x57, 
+0xC8, 0x11, 0x2E, 0x06, 0x97, 0x32, 0xC8, 0xB4, 
+0x90, 0x4F, 0x8C, 0xAA, 0x12, 0xCF, 0xCD, 0xFE, 
+0x7C, 
+
+0x4C, 0x6C, 0x14, 0x8A, 0xF2, 0x8F, 0x6F, 0x94, 
+0x0D, 0x92, 0x89, 0x7C, 0x25, 0xB9, 0x7B, 0x9D, 
+0xE0, 0xC2, 0x68, 0xA9, 0x9A, 0x6F, 0x60, 0xEA, 
+0x75, 0x70, 0x7F, 0xF6, 0x5B, 0x9B, 0x79, 0xB1, 
+0xBE, 0x93, 0xBC, 0x5D, 0xBA, 0x4C, 0x29, 0x38, 
+0x26, 0x87, 0xC7, 0xB1, 0xA4, 0xDD, 0x50, 0xD3, 
+0x1F, 0x9A, 0x83, 0xC9, 0x84, 0x9E, 0xC0, 0x9E, 
+0x60, 0xB2, 0x59, 0xB3, 0xC1, 0x1F, 0x28, 0x23, 
+0x6A, 0x2A, 0x21, 0x12, 0xDD, 0x34, 0xC5, 0x2F, 
+0x53, 0xD0, 0xAF, 0x09, 0x6D, 0x46, 0x28, 0xB1, 
+0x0D, 0x6D, 0xE6, 0xFC, 0x6B, 0x4E, 0x52, 0x3F, 
+0x78, 0x5B, 0x1E, 0xA6, 0x6D, 0x0C, 0x48, 0x54, 
+0xA9, 0xF9, 0xCB, 0x76, 0x29, 0x6A, 0x30, 0xC9, 
+0x67, 0xFC, 0x75, 0x5F, 0x5B, 0x4C, 0x00, 0x19, 
+0xD0, 0x4C, 0xFB, 0xFA, 0xB7, 0xE2, 0x1C, 0x59, 
+0x73, 0x06, 0xEB, 0xCF, 0x7B, 0x85, 0x8C, 0xA4, 
+0x44, 0x6B, 0x14, 0x89, 0x43, 0x8D, 0xAD, 0xF4, 
+0xFA, 0xC7, 0xE5, 0x8B, 0x53, 0xB9, 0x49, 0xE7, 
+0x55, 0x29, 
+
+0x18, 0xD1, 0xEE, 0x5C, 0xB1, 0x34, 0x1C, 0xF5, 
+0xA6, 0x7B, 0x6D, 0xCB, 0x51, 0xC7, 0x40, 0x8C, 
+0x22, 0xCA, 0x6E, 0x40, 0x26, 0x73, 0xC6, 0x13, 
+0x8E, 0xF5, 0x38, 0xFD, 0x96, 0x8C, 0xDF, 0x73, 
+0x56, 0xBE, 0x74, 0xA3, 0xA1, 0x80, 0xA2, 0xCA, 
+0x52, 0x2C, 0x11, 0xE2, 0x52, 0x5A, 0xD3, 0x61, 
+0xEA, 0xC6, 0x71, 0x7E, 0x37, 0xB9, 0xF1, 0x06, 
+0x7B, 0x8C, 0xF1, 0x1A, 0x34, 0x03, 0x32, 0x5C, 
+0x3D, 0x2A, 0x78, 0x38, 0xCF, 0x6A, 0xB4, 0x15, 
+0x78, 0xFD, 0xBD, 0x85, 0xA0, 0xC5, 0xF1, 0x7D, 
+0xAE, 0x01, 0x26, 0x0C, 0x61, 0xDC, 0xC3, 0x6E, 
+0x51, 0x70, 0x84, 0x86, 0x3C, 0x10, 0x82, 0x4F, 
+0x52, 0x62, 0x5E, 0x18, 0xA8, 0x93, 0x8A, 0xE6, 
+0xC9, 0x30, 0xF6, 0x62, 0x6C, 0x46, 0x0A, 0x94, 
+0x2B, 0x49, 0xF0, 0x68, 0x6D, 0xD1, 0x1C, 0x73, 
+0x21, 0x02, 0x8A, 0x08, 0xE9, 0x5A, 0x2B, 0x56, 
+0x0F, 0xCC, 0x06, 0x3B, 0x8E, 0xC4, 0xA3, 0x45, 
+0xF9, 0xE4, 0x5A, 0x4E, 0xF7, 0x93, 0x61, 0xF7, 
+0x94, 0x5C, 0xC7, 
+
+0xF0, 0xDD, 0x03, 0x73, 0x25, 0xF7, 0xE5, 0x76, 
+0xCF, 0x24, 0x0C, 0xF1, 0x00, 0x7D, 0x52, 0xE8, 
+0x58, 0xA5, 0x31, 0x13, 0x9D, 0xD9, 0x3F, 0x5A, 
+0xA6, 0x73, 0x14, 0x67, 0xE8, 0x36, 0x8F, 0xFA, 
+0x55, 0x3D, 0xF7, 0x10, 0x19, 0x90, 0x8A, 0x8F, 
+0xD0, 0x97, 0xD3, 0x14, 0xF2, 0xD3, 0x16, 0x7D, 
+0xDD, 0x5A, 0x52, 0x73, 0x28, 0xDF, 0x8F, 0x31, 
+0xD9, 0x57, 0xE3, 0x1D, 0x9F, 0x97, 0x72, 0x68, 
+0x9B, 0xF6, 0xA0, 0xE7, 0x2D, 0x86, 0xC6, 0x1D, 
+0xD2, 0xDC, 0x6F, 0xFA, 0xDB, 0xDF, 0x86, 0x33, 
+0x8D, 0x48, 0x49, 0xCF, 0xA0, 0x66, 0x9D, 0x0A, 
+0x58, 0x4B, 0xF6, 0xB8, 0x3B, 0x94, 0x05, 0xE4, 
+0x1B, 0xB9, 0xA9, 0x89, 0x6A, 0x0D, 0xB8, 0x01, 
+0x3B, 0x69, 0x9C, 0x44, 0x36, 0xB0, 0xDF, 0x96, 
+0xCB, 0xC7, 0x8C, 0x95, 0x66, 0xE6, 0xAC, 0xCE, 
+0xBD, 0x8A, 0x3A, 0x63, 0x5F, 0xB3, 0x75, 0x42, 
+0xCC, 0x56, 0xD2, 0x87, 0x62, 0x14, 0x6C, 0xBC, 
+0x12, 0xD2, 0x37, 0x04, 0xDA, 0x20, 0x9B, 0xCF, 
+0xA0, 0x15, 0x35, 0xB5, 
+
+0xE0, 0x02, 0x5D, 0x45, 0x56, 0x46, 0x49, 0xA4, 
+0xDA, 0xE5, 0x8E, 0x46, 0x39, 0xCA, 0x7D, 0x27, 
+0x72, 0xED, 0x87, 0xD0, 0xF7, 0xD2, 0x01, 0x47, 
+0x00, 0xE2, 0x30, 0x45, 0x35, 0xD7, 0x7E, 0x5B, 
+0xD3, 0x37, 0x78, 0xB9, 0xED, 0x5A, 0x5A, 0xBF, 
+0xEB, 0x04, 0xBD, 0x14, 0xCD, 0x74, 0xA6, 0x51, 
+0x42, 0x38, 0x3F, 0x51, 0x1C, 0xB4, 0xE2, 0xE0, 
+0x06, 0xCE, 0xFD, 0xEA, 0xF9, 0x62, 0x19, 0xC9, 
+0x41, 0x09, 0xAA, 0x38, 0xA8, 0x21, 0xE0, 0xB2, 
+0x11, 0x65, 0x1C, 0x0D, 0x63, 0x30, 0x5B, 0x1C, 
+0xE3, 0x15, 0xD8, 0x3A, 0xB2, 0x01, 0x8C, 0xF5, 
+0x21, 0xBF, 0x1B, 0x3D, 0x73, 0x53, 0x35, 0xFB, 
+0x0C, 0x2C, 0xD2, 0x76, 0xFE, 0x44, 0x2F, 0x0A, 
+0x52, 0xD2, 0xD3, 0x51, 0x9B, 0x4C, 0x93, 0xE2, 
+0xDC, 0x7D, 0xE6, 0xA7, 0x6A, 0x61, 0x65, 0xC1, 
+0x7E, 0xEE, 0x66, 0x7E, 0x71, 0x54, 0xDC, 0xC8, 
+0x9C, 0xB7, 0x78, 0xC9, 0xED, 0xF8, 0x30, 0x25, 
+0x35, 0x1F, 0x7D, 0x0C, 0x49, 0xBA, 0x14, 0x9B, 
+0x55, 0x02, 0x5A, 0x36, 0x4A, 
+
+0x46, 0xCB, 0xC3, 0x43, 0x0F, 0x31, 0xF1, 0x63, 
+0xF5, 0x83, 0x05, 0xE5, 0x49, 0x7C, 0x99, 0xB4, 
+0x5C, 0x00, 0x93, 0x4F, 0x30, 0xB7, 0x99, 0x50, 
+0x53, 0x23, 0x09, 0xD8, 0xF7, 0x03, 0x4E, 0x8E, 
+0xAC, 0x26, 0xF1, 0x4C, 0xB1, 0x78, 0x3F, 0x55, 
+0x22, 0x14, 0x64, 0xE2, 0x7B, 0xC4, 0x07, 0x79, 
+0x75, 0x7F, 0xBE, 0x53, 0xEE, 0x1B, 0xA6, 0x8D, 
+0xD0, 0xE4, 0xF9, 0xC4, 0xA5, 0x99, 0xC4, 0x4C, 
+0x4C, 0xD2, 0xB7, 0x7F, 0x4C, 0x40, 0x66, 0x2B, 
+0x0C, 0xC9, 0xC3, 0x17, 0x69, 0x5A, 0x53, 0xA7, 
+0xC0, 0x36, 0xE5, 0x96, 0x9D, 0x97, 0x02, 0xBC, 
+0xCF, 0x70, 0x16, 0x7E, 0x3A, 0x24, 0xDC, 0x4A, 
+0xE4, 0x2F, 0xD8, 0x89, 0xEE, 0xAB, 0x3B, 0xD7, 
+0xCD, 0xF8, 0x47, 0x88, 0x4D, 0x15, 0x34, 0x39, 
+0x84, 0x31, 0xE9, 0xEC, 0x8A, 0xAD, 0x89, 0x94, 
+0x3B, 0x0E, 0x3E, 0x5D, 0x24, 0x79, 0xB7, 0x9D, 
+0x24, 0xA1, 0x2C, 0x91, 0x75, 0xE3, 0xA2, 0xB7, 
+0xF1, 0xDD, 0xC8, 0xFF, 0x1A, 0x4A, 0x09, 0x95, 
+0xFC, 0x53, 0xD6, 0xDA, 0xD3, 0x61, 
+
+0xAF, 0xB5, 0x21, 0x58, 0xEB, 0x87, 0xAC, 0x36, 
+0xB4, 0xC1, 0x5C, 0x76, 0x0F, 0xD1, 0xBA, 0xDD, 
+0x23, 0x50, 0xFF, 0xB7, 0xF6, 0xA0, 0xD5, 0x68, 
+0x0A, 0x08, 0x63, 0x74, 0xED, 0xD3, 0xB1, 0x48, 
+0xB8, 0x2A, 0x12, 0xCA, 0x27, 0xED, 0x0C, 0x14, 
+0x0B, 0x49, 0x34, 0xFF, 0x7D, 0x58, 0x17, 0x94, 
+0xCD, 0xD8, 0xE0, 0x03, 0xA7, 0xC5, 0x98, 0x28, 
+0x51, 0x80, 0x70, 0xDF, 0x08, 0x07, 0x69, 0x96, 
+0x84, 0x20, 0xAA, 0x55, 0xDA, 0xAD, 0x1D, 0x12, 
+0xC9, 0x0D, 0x54, 0x2E, 0xDF, 0x4F, 0xC1, 0x8D, 
+0x0E, 0xC8, 0x97, 0xB7, 0xDB, 0xE7, 0x0A, 0xA5, 
+0xD2, 0x59, 0xE7, 0x2E, 0xB1, 0xB6, 0x5D, 0xA5, 
+0x03, 0x1B, 0x19, 0x57, 0x15, 0x3F, 0xEC, 0xA2, 
+0xF6, 0x1D, 0x30, 0xAF, 0x83, 0x23, 0x6C, 0xEA, 
+0x3A, 0x44, 0x66, 0xDC, 0x3C, 0x9E, 0xDD, 0x80, 
+0x5D, 0xC7, 0x45, 0xCD, 0x57, 0xA1, 0x65, 0xED, 
+0xDE, 0xF8, 0x94, 0x3F, 0xC3, 0x30, 0xE5, 0xD2, 
+0x09, 0xBD, 0xF5, 0x5A, 0x21, 0x65, 0x39, 0xD8, 
+0x13, 0x6A, 0xDF, 0xF6, 0x3C, 0x94, 0x27, 
+
+0xB3, 0xF4, 0x21, 0x1E, 0xF6, 0x16, 0xCE, 0x3C, 
+0xA0, 0xAD, 0x3E, 0x9E, 0x88, 0x44, 0xC1, 0xB4, 
+0xDE, 0x31, 0x4A, 0xDD, 0x27, 0x76, 0x71, 0xBF, 
+0x7A, 0x3B, 0x30, 0xFD, 0x6D, 0x3B, 0xD5, 0x64, 
+0xDD, 0x64, 0xD8, 0xF3, 0xBF, 0x16, 0x57, 0x6B, 
+0xF4, 0xAA, 0x70, 0x9C, 0x96, 0x1F, 0x54, 0x1B, 
+0x70, 0x85, 0x02, 0x21, 0x68, 0x75, 0x92, 0x17, 
+0x3F, 0x80, 0x64, 0x1C, 0x91, 0x53, 0x95, 0xFE, 
+0x49, 0x71, 0xEE, 0xE7, 0x39, 0x05, 0x95, 0xD5, 
+0x88, 0x1B, 0x8C, 0x76, 0x5C, 0xE9, 0x3F, 0x97, 
+0xBE, 0x87, 0xF5, 0x1B, 0x6B, 0x0A, 0x3B, 0x23, 
+0x40, 0x17, 0xD8, 0x98, 0x9E, 0x79, 0x4A, 0xD4, 
+0x09, 0x37, 0xF0, 0xA3, 0xB5, 0xF8, 0x2B, 0x4F, 
+0xB4, 0x5C, 0xD2, 0xD9, 0x21, 0xA3, 0x06, 0xC1, 
+0xDD, 0x20, 0x8D, 0x94, 0xAC, 0x46, 0xAA, 0xD7, 
+0x71, 0xEC, 0xAA, 0xC4, 0xFD, 0xC1, 0xA5, 0x27, 
+0x17, 0x63, 0xDD, 0xDB, 0xEF, 0x90, 0x2A, 0x06, 
+0xE4, 0xBF, 0x38, 0x1F, 0x05, 0xD0, 0xDC, 0xD8, 
+0x5F, 0x43, 0xDE, 0xDD, 0xC7, 0xCA, 0x02, 0x2F, 
+
+0x42, 0x96, 0x4E, 0xE2, 0xFE, 0x11, 0x89, 0x2E, 
+0xB3, 0x96, 0x8E, 0x5A, 0x0E, 0x80, 0x1C, 0x53, 
+0xAE, 0x0E, 0x92, 0x29, 0xFA, 0xB7, 0x93, 0xC0, 
+0x72, 0xC3, 0x6C, 0x00, 0x60, 0xF5, 0x8D, 0x08, 
+0x60, 0x1C, 0x40, 0x6C, 0x21, 0x2F, 0x44, 0xA2, 
+0xA8, 0x27, 0x6D, 0x9B, 0x01, 0x15, 0x19, 0x83, 
+0xFA, 0x06, 0xE3, 0xAF, 0x83, 0x1F, 0x1D, 0x86, 
+0xAF, 0x6A, 0x8A, 0x2B, 0xEB, 0xDC, 0x28, 0x43, 
+0x94, 0xF1, 0x76, 0x05, 0xAD, 0x71, 0x5C, 0xD1, 
+0x7E, 0xD6, 0x77, 0x70, 0xC9, 0x58, 0xEE, 0x9A, 
+0x55, 0xA6, 0xF8, 0x5E, 0xF8, 0x93, 0x2B, 0x82, 
+0x9E, 0xA4, 0x08, 0xEB, 0x5C, 0x12, 0x96, 0xDA, 
+0x90, 0xDC, 0x38, 0x8F, 0xC8, 0x69, 0x98, 0x01, 
+0xBE, 0x45, 0x4F, 0xB4, 0xD2, 0xCB, 0x6A, 0x47, 
+0x1F, 0x29, 0x86, 0xCD, 0x2D, 0x18, 0x4B, 0x41, 
+0x8D, 0xE8, 0x1E, 0xE5, 0xC2, 0xD1, 0xC4, 0x0E, 
+0xA0, 0x76, 0xB7, 0xD9, 0xA8, 0xA9, 0x02, 0xFD, 
+0x03, 0x6E, 0x01, 0xCC, 0xCC, 0x30, 0xA4, 0x34, 
+0x77, 0x45, 0x9D, 0xCE, 0x5D, 0xE1, 0x79, 0xAA, 
+0xF3, 
+
+0xAB, 0x86, 0xDD, 0x42, 0x05, 0x9D, 0x31, 0xED, 
+0x47, 0x74, 0xC9, 0xD5, 0x04, 0x10, 0x94, 0x0D, 
+0x76, 0x73, 0x94, 0x1A, 0x88, 0xBA, 0x70, 0x43, 
+0x17, 0xAA, 0xA4, 0x4D, 0x31, 0x1E, 0xAC, 0x2B, 
+0x39, 0xF0, 0xEC, 0x6D, 0x89, 0x0C, 0x7C, 0xC1, 
+0x47, 0xF5, 0x72, 0x54, 0x4B, 0x97, 0x50, 0x23, 
+0xD1, 0xCF, 0x6A, 0x32, 0x95, 0x50, 0xDB, 0xAF, 
+0x45, 0x4A, 0x59, 0x3D, 0x40, 0x5F, 0x53, 0x7A, 
+0xFF, 0x9D, 0xE2, 0x2C, 0x8D, 0xE4, 0x91, 0x35, 
+0xAE, 0x5A, 0xC7, 0x4F, 0x76, 0x00, 0x3F, 0x59, 
+0xCA, 0xB5, 0xC3, 0x51, 0xE1, 0x31, 0x48, 0xD0, 
+0x54, 0x49, 0x2D, 0x16, 0x4A, 0x1A, 0x55, 0xD8, 
+0x2A, 0x7E, 0x9B, 0x9A, 0xE8, 0x2B, 0x2D, 0x3E, 
+0x47, 0x23, 0x35, 0x06, 0xF9, 0x33, 0x02, 0x72, 
+0x9F, 0xC5, 0x52, 0x96, 0x2F, 0x5A, 0xD3, 0xFD, 
+0x96, 0x6B, 0x9C, 0xCA, 0x8E, 0x4A, 0x67, 0x9E, 
+0x74, 0xCD, 0xB9, 0x4E, 0x35, 0xC0, 0x42, 0xAE, 
+0xBD, 0xFF, 0xC6, 0x72, 0x41, 0x36, 0x56, 0x9B, 
+0x5E, 0xC0, 0x6D, 0xEC, 0x33, 0xC8, 0x82, 0x68, 
+0x6C, 0x92, 
+
+0x01, 0x59, 0xF6, 0x7E, 0x49, 0x22, 0xE1, 0x29, 
+0xA4, 0xE4, 0xDF, 0xA9, 0x3C, 0x50, 0x71, 0xAC, 
+0xA9, 0x47, 0xCD, 0x15, 0x3D, 0x1B, 0x03, 0xF1, 
+0xA8, 0x82, 0x2C, 0x96, 0xE8, 0x00, 0xB6, 0x6B, 
+0xB1, 0xD7, 0x68, 0x2B, 0xD7, 0x69, 0x42, 0x06, 
+0xE4, 0x85, 0x9B, 0xA5, 0x2F, 0xBD, 0x24, 0x72, 
+0xF6, 0x5C, 0x8B, 0x91, 0x68, 0xA5, 0x0D, 0x34, 
+0x98, 0x5F, 0x36, 0x67, 0x90, 0xAA, 0xD3, 0x1C, 
+0x5B, 0xFF, 0x44, 0x55, 0x7C, 0xE9, 0x99, 0x4E, 
+0xFC, 0x58, 0xB0, 0x1D, 0x13, 0x66, 0x1F, 0xB4, 
+0x7C, 0x82, 0x4B, 0x20, 0x6A, 0xDB, 0x2C, 0x5E, 
+0x5B, 0xC6, 0xCB, 0x8E, 0x1D, 0x4B, 0xFF, 0xD2, 
+0x2D, 0x61, 0x14, 0xFD, 0x0C, 0x02, 0xD3, 0x91, 
+0x4C, 0x0F, 0x26, 0x3C, 0x67, 0x27, 0xF9, 0x6A, 
+0x7E, 0x8D, 0x50, 0x4F, 0xD5, 0x9C, 0x72, 0x70, 
+0x45, 0x7D, 0xDB, 0xFA, 0xE6, 0xC2, 0x07, 0x36, 
+0x29, 0x06, 0xBE, 0x01, 0x09, 0xEA, 0x16, 0x97, 
+0x58, 0xDD, 0xEB, 0xAF, 0x83, 0x39, 0xEE, 0x80, 
+0xF1, 0x88, 0xBB, 0x77, 0x0E, 0x7C, 0xEF, 0x2E, 
+0x01, 0xE4, 0x28, 
+
+0x75, 0xA3, 0x11, 0xB7, 0xB4, 0x28, 0x7E, 0xDD, 
+0x4F, 0x31, 0x5F, 0x3A, 0x6D, 0x55, 0x81, 0xE2, 
+0xB4, 0x6B, 0xD1, 0x52, 0x8B, 0x9A, 0xE2, 0xB3, 
+0x35, 0x7F, 0x3B, 0xC5, 0x41, 0x17, 0xC9, 0x0A, 
+0xEC, 0x3F, 0xA5, 0x88, 0xE7, 0xCC, 0xB7, 0x32, 
+0x7E, 0xEB, 0x65, 0xD0, 0x85, 0x57, 0xB5, 0x69, 
+0xD2, 0xFE, 0xEC, 0xF1, 0x2A, 0xC6, 0xB8, 0xB6, 
+0x06, 0xE0, 0xA7, 0xE0, 0x17, 0xD7, 0xDB, 0x4D, 
+0x1B, 0x4A, 0x29, 0x9A, 0xB1, 0x1B, 0x5D, 0xA2, 
+0xE9, 0xEA, 0x04, 0xE5, 0xBB, 0x4B, 0x09, 0xA2, 
+0xA6, 0xF4, 0xEF, 0xF3, 0x3D, 0xD2, 0xFE, 0xD2, 
+0x16, 0x41, 0x14, 0xBF, 0x57, 0xCA, 0x9B, 0xA4, 
+0x9D, 0x6F, 0x33, 0xA2, 0xD9, 0xBE, 0xB7, 0x1F, 
+0x2B, 0x18, 0x7D, 0x38, 0x03, 0x22, 0x07, 0xDA, 
+0x68, 0xC7, 0x65, 0x83, 0xB7, 0x38, 0xEF, 0xA7, 
+0x91, 0x83, 0xF8, 0xBD, 0x68, 0x8E, 0x49, 0xA3, 
+0x7F, 0xCE, 0x0A, 0xE9, 0x6C, 0x6D, 0x5B, 0x22, 
+0xAA, 0x39, 0x8D, 0x4B, 0xB9, 0x77, 0xA3, 0xDF, 
+0xA2, 0x72, 0x0A, 0x3D, 0x0A, 0x33, 0x32, 0xB0, 
+0xFF, 0x05, 0x50, 0xCE, 
+
+0x08, 0x94, 0x0A, 0x56, 0x2A, 0xA9, 0x47, 0xEC, 
+0x1B, 0x4C, 0xE5, 0xE3, 0x81, 0x6D, 0x32, 0x6E, 
+0x02, 0x22, 0x68, 0xAB, 0x4A, 0x30, 0x15, 0x23, 
+0x5C, 0x29, 0xFA, 0x61, 0xDD, 0xBA, 0x89, 0x3B, 
+0xD0, 0x02, 0x34, 0x4F, 0xFB, 0xB6, 0x39, 0x2A, 
+0xA1, 0x44, 0x81, 0x78, 0x1F, 0x4D, 0x2B, 0xD2, 
+0x38, 0xD4, 0x87, 0xC9, 0xEC, 0x25, 0xC9, 0x43, 
+0xAD, 0x51, 0x3F, 0xA8, 0x02, 0x61, 0xBB, 0xAE, 
+0x2E, 0x44, 0xFE, 0xA6, 0x36, 0xF2, 0x8C, 0x3A, 
+0x08, 0xCC, 0xFD, 0xAD, 0x7C, 0x91, 0x67, 0xBF, 
+0x13, 0x71, 0x85, 0x6C, 0x6F, 0xD9, 0x8B, 0x0B, 
+0x4A, 0xE3, 0x6D, 0x97, 0xD1, 0x87, 0x8F, 0x58, 
+0x35, 0xFD, 0x7C, 0xC2, 0x2F, 0xFE, 0xCF, 0x52, 
+0x02, 0x71, 0x21, 0xD8, 0xE5, 0xA1, 0x56, 0x80, 
+0x38, 0xC3, 0xDE, 0xF9, 0x42, 0x92, 0x92, 0x61, 
+0x1F, 0x34, 0xAC, 0xFD, 0x68, 0x8F, 0xB2, 0x1B, 
+0x77, 0xF1, 0xD5, 0x1B, 0x4D, 0x05, 0x47, 0xAE, 
+0x4A, 0x5E, 0x29, 0xD5, 0x63, 0x45, 0x26, 0x00, 
+0x0C, 0xE8, 0x02, 0x48, 0x63, 0x84, 0x6C, 0xCC, 
+0xA8, 0xA3, 0x53, 0x23, 0x49, 
+
+0xA1, 0x43, 0x0B, 0xBB, 0xF2, 0xA1, 0x51, 0xD7, 
+0x48, 0xE1, 0x99, 0x79, 0x03, 0x0C, 0x01, 0xB4, 
+0xED, 0xA0, 0xEF, 0x3F, 0x37, 0x3D, 0x58, 0x7D, 
+0xC5, 0x25, 0x18, 0x57, 0xB7, 0xF7, 0xB4, 0x68, 
+0x83, 0xDD, 0x8F, 0x53, 0x58, 0xB0, 0x87, 0x74, 
+0xB7, 0x8B, 0xC1, 0x5C, 0x22, 0x57, 0x27, 0x9C, 
+0x38, 0xC2, 0xE3, 0x6F, 0x10, 0x73, 0xEA, 0xA7, 
+0xAA, 0xAD, 0xF6, 0x89, 0x55, 0x73, 0x27, 0x1A, 
+0x8E, 0x5A, 0x25, 0xDA, 0x3C, 0x8E, 0xF7, 0x09, 
+0x39, 0xBD, 0x25, 0xCE, 0xEB, 0xD0, 0x25, 0x9B, 
+0x13, 0xF7, 0x23, 0x4C, 0x54, 0x53, 0x20, 0xEE, 
+0xD5, 0x27, 0x87, 0xC9, 0x47, 0x15, 0x0E, 0x1D, 
+0x74, 0x05, 0x44, 0xFC, 0x27, 0x93, 0x90, 0x80, 
+0xAB, 0x93, 0xDF, 0xC9, 0x95, 0x23, 0x44, 0xF0, 
+0x16, 0x98, 0xDA, 0xE7, 0x5F, 0xAC, 0xF9, 0x6A, 
+0x3A, 0x7C, 0x04, 0x68, 0x8A, 0x11, 0x91, 0x9F, 
+0x41, 0x53, 0x05, 0x4A, 0xD5, 0x10, 0xD2, 0xBC, 
+0xF1, 0x15, 0x28, 0x0E, 0xE9, 0x6C, 0xBD, 0xE1, 
+0xE4, 0x57, 0xEA, 0x4F, 0x6D, 0x9B, 0x29, 0xE6, 
+0x02, 0xD1, 0x8B, 0xF0, 0xC8, 0xED, 
+
+0xB6, 0x24, 0x80, 0x7B, 0xBB, 0xE4, 0xBA, 0xF7, 
+0xC6, 0xE8, 0x2F, 0x67, 0x67, 0xCB, 0x89, 0x7F, 
+0x1E, 0x6F, 0xFB, 0x47, 0xC5, 0xC6, 0xDF, 0xF8, 
+0x10, 0x2B, 0x32, 0x1F, 0x76, 0x8C, 0x83, 0x57, 
+0x81, 0x73, 0xE2, 0x76, 0xC4, 0xDC, 0x1A, 0xFD, 
+0x52, 0x37, 0x05, 0x2B, 0x0F, 0x69, 0x70, 0xDF, 
+0x82, 0x8B, 0x58, 0x5E, 0x72, 0x8A, 0x29, 0x2C, 
+0xA9, 0xB8, 0x95, 0xF3, 0xBF, 0xA0, 0x82, 0x98, 
+0xE6, 0xB0, 0x2D, 0xF0, 0xA6, 0x07, 0x47, 0x7C, 
+0xAE, 0x01, 0xA7, 0x0C, 0x73, 0xA8, 0x75, 0x96, 
+0x1E, 0x6C, 0x3A, 0x14, 0x68, 0xBB, 0x31, 0xAE, 
+0xB6, 0x6C, 0x07, 0x91, 0x68, 0x14, 0x7A, 0xB1, 
+0x3B, 0xD9, 0x54, 0xF8, 0xA4, 0x25, 0x7D, 0x67, 
+0x71, 0xA5, 0x1B, 0xF6, 0x48, 0x68, 0xEC, 0x5E, 
+0x7E, 0x20, 0x96, 0x0D, 0xA5, 0x33, 0x48, 0x52, 
+0x3C, 0xD4, 0x3E, 0x87, 0x59, 0x6E, 0x0F, 0x12, 
+0xD1, 0xE5, 0x5C, 0x6B, 0x13, 0x4D, 0xD1, 0x48, 
+0x1F, 0x86, 0xEC, 0x8E, 0xDF, 0x56, 0xC7, 0xD4, 
+0x2E, 0x20, 0x29, 0x2A, 0x9C, 0x9B, 0x54, 0x56, 
+0xEC, 0x4C, 0x65, 0x61, 0xE2, 0x45, 0xAC, 
+
+0x51, 0x43, 0xCE, 0xDC, 0x1E, 0x1A, 0xC2, 0x8C, 
+0xAA, 0x13, 0xCF, 0xD0, 0xEC, 0xFB, 0x90, 0xD2, 
+0x4D, 0x51, 0x4C, 0x9A, 0x7E, 0x6C, 0x57, 0x6F, 
+0x83, 0xBA, 0x4B, 0x42, 0x0E, 0x16, 0xD8, 0x25, 
+0x6C, 0xF2, 0xA6, 0x07, 0xFF, 0xA3, 0x25, 0x4D, 
+0x4A, 0xEC, 0xE2, 0x47, 0xD6, 0x52, 0xC8, 0x2B, 
+0x49, 0xE3, 0x35, 0x0D, 0x6E, 0xA7, 0xF9, 0x52, 
+0xA6, 0x8C, 0x8E, 0x50, 0xE7, 0x83, 0x79, 0x69, 
+0xCB, 0xD8, 0x8F, 0x71, 0x78, 0x86, 0x01, 0xB6, 
+0x89, 0xD3, 0x68, 0xAF, 0xE9, 0x28, 0xE9, 0xFD, 
+0x9A, 0x39, 0x20, 0xF5, 0x56, 0x82, 0xD1, 0xFE, 
+0x99, 0x1D, 0x5E, 0x66, 0xC5, 0x7A, 0x8C, 0xC2, 
+0xA1, 0x50, 0x5A, 0x74, 0x46, 0x26, 0x91, 0xFC, 
+0x32, 0x3C, 0x21, 0xDE, 0x70, 0xD2, 0xDA, 0xF1, 
+0x36, 0x3F, 0x91, 0x02, 0x2B, 0x41, 0x81, 0x62, 
+0xDD, 0xBF, 0x58, 0x71, 0x3A, 0xDE, 0xC0, 0x8A, 
+0x27, 0x6C, 0x46, 0x57, 0x84, 0xB2, 0xC0, 0x5D, 
+0x35, 0xFA, 0x84, 0x72, 0x3F, 0x62, 0x90, 0xC1, 
+0xFC, 0x42, 0x03, 0x9A, 0xEE, 0xF7, 0xD4, 0xF9, 
+0x1F, 0x6B, 0xEE, 0x4A, 0x95, 0xCD, 0xFA, 0x96, 
+
+0xB6, 0xB1, 0xDC, 0x16, 0x23, 0x9C, 0x82, 0x6B, 
+0x9C, 0x60, 0x0B, 0xEE, 0x5B, 0x20, 0xA4, 0xB0, 
+0x54, 0xC7, 0xE8, 0x83, 0xFD, 0x48, 0x76, 0x17, 
+0x07, 0x55, 0x5D, 0x06, 0x02, 0x26, 0x5D, 0xBE, 
+0x99, 0x67, 0x8C, 0xA6, 0xC0, 0xC5, 0x23, 0x2C, 
+0x07, 0xDB, 0x39, 0xC5, 0xD1, 0x6F, 0xAB, 0xB0, 
+0x8B, 0xF3, 0x89, 0xE6, 0x02, 0x88, 0x75, 0x9C, 
+0x54, 0x33, 0x6D, 0x48, 0xD3, 0x34, 0x52, 0x8A, 
+0x69, 0x6B, 0x1F, 0xF7, 0x88, 0x7C, 0xE3, 0x11, 
+0x7F, 0x93, 0xC9, 0x22, 0x10, 0x4E, 0xAC, 0x0F, 
+0xA0, 0xC3, 0xDA, 0x77, 0xCB, 0xE9, 0x7F, 0xF4, 
+0xA9, 0x49, 0x22, 0xC6, 0x91, 0x6A, 0x72, 0x43, 
+0x34, 0xB3, 0x3C, 0xDD, 0xE4, 0x5C, 0x95, 0xC4, 
+0x17, 0xC0, 0xFD, 0xED, 0x1A, 0x10, 0x2A, 0x59, 
+0xB2, 0x44, 0xA0, 0xC4, 0xA3, 0x6E, 0xE5, 0xFA, 
+0xC9, 0x63, 0xD4, 0xB1, 0xD6, 0xB5, 0xAE, 0x02, 
+0xE0, 0xAD, 0x36, 0x01, 0xD6, 0x18, 0x4D, 0x2A, 
+0xA5, 0xB2, 0x93, 0xB8, 0x15, 0x45, 0x38, 0x6C, 
+0xC8, 0x5C, 0xFC, 0x88, 0x00, 0x12, 0xA6, 0x90, 
+0x15, 0x4C, 0xF7, 0x27, 0xBF, 0xD3, 0xF6, 0x0A, 
+0x76, 
+
+0x10, 0xBD, 0x48, 0x78, 0x9B, 0x23, 0x3C, 0xB1, 
+0x3F, 0x77, 0x6C, 0x7D, 0xF3, 0x6E, 0x60, 0x5F, 
+0xD0, 0x89, 0xD9, 0xF3, 0x01, 0xC2, 0x9C, 0xDF, 
+0xC5, 0x9D, 0x83, 0x9A, 0x02, 0x6C, 0x08, 0x09, 
+0xE6, 0xD9, 0xA3, 0x94, 0x03, 0xD3, 0xB4, 0x66, 
+0x10, 0xFA, 0xD3, 0x16, 0x88, 0xFC, 0x9D, 0xB4, 
+0x02, 0x98, 0x59, 0xE0, 0x87, 0x19, 0xE3, 0x9E, 
+0x43, 0xCB, 0xD3, 0x1B, 0xD4, 0x20, 0xF8, 0xD2, 
+0xB2, 0x35, 0x54, 0xA9, 0xBC, 0xE0, 0x84, 0x4D, 
+0x55, 0x6E, 0x93, 0x18, 0xE2, 0xA5, 0x78, 0x0D, 
+0x8B, 0x43, 0x26, 0xCD, 0x86, 0xB0, 0x69, 0x5F, 
+0xEE, 0x67, 0x6F, 0xC5, 0x5D, 0x32, 0x7B, 0xFD, 
+0x39, 0x81, 0x61, 0x99, 0x90, 0x35, 0xA2, 0xCB, 
+0x42, 0x87, 0x3E, 0x01, 0x86, 0x14, 0x19, 0xAC, 
+0x4C, 0xB8, 0x5B, 0x54, 0x17, 0xDE, 0x49, 0x9A, 
+0xBB, 0x22, 0x5D, 0x84, 0x68, 0x4B, 0xB0, 0x57, 
+0x22, 0x9B, 0xC1, 0xE7, 0xE7, 0x9D, 0xA5, 0x8D, 
+0x2B, 0xF1, 0xC9, 0x82, 0x4B, 0x84, 0xE9, 0x89, 
+0xAB, 0x14, 0x75, 0xFD, 0x9F, 0xB5, 0x52, 0x5C, 
+0xF9, 0x79, 0x33, 0xE9, 0xD5, 0xD9, 0x0C, 0x3C, 
+0xBC, 0x39, 
+
+0x2F, 0xE7, 0xA4, 0x2F, 0xE9, 0x85, 0x6C, 0x5B, 
+0x23, 0xEF, 0x76, 0xF1, 0x21, 0x00, 0xDE, 0x5D, 
+0x6B, 0x06, 0x6C, 0x87, 0x8C, 0x11, 0x0B, 0x9E, 
+0x8E, 0x08, 0xCA, 0xBD, 0xF3, 0xB8, 0x59, 0xE9, 
+0x89, 0xB7, 0xD3, 0x95, 0x01, 0x14, 0xC8, 0x76, 
+0x30, 0x51, 0x7F, 0x79, 0xBA, 0x69, 0x90, 0x9F, 
+0xD8, 0x49, 0xC9, 0x0C, 0x03, 0xEE, 0xE9, 0x37, 
+0x9A, 0x71, 0xB9, 0x46, 0x73, 0xA1, 0x7A, 0x2B, 
+0xC8, 0xC8, 0xD2, 0xA7, 0xB1, 0x81, 0x0F, 0x8A, 
+0xCC, 0x72, 0xA2, 0x96, 0x1B, 0xF1, 0x62, 0xBF, 
+0xBD, 0x26, 0x14, 0x9B, 0x8D, 0xFF, 0x65, 0x8E, 
+0xA4, 0x08, 0xA5, 0xDA, 0xA6, 0x4E, 0x7C, 0xB4, 
+0xF3, 0x8C, 0x86, 0xBB, 0xEA, 0x87, 0x4A, 0xC6, 
+0x57, 0x38, 0xFD, 0x26, 0xB2, 0x90, 0xF4, 0xBC, 
+0xE5, 0x4B, 0x3D, 0x29, 0xB8, 0x22, 0xFE, 0xF4, 
+0x62, 0x10, 0x59, 0x2B, 0x9A, 0x96, 0xD2, 0xD2, 
+0xD6, 0x0B, 0x8C, 0x49, 0x3C, 0xBB, 0x50, 0xB3, 
+0x6E, 0x18, 0x81, 0x46, 0x84, 0x09, 0xC5, 0xB0, 
+0x4F, 0xC2, 0x69, 0xF6, 0x38, 0x97, 0xD2, 0xF7, 
+0x14, 0x68, 0xB3, 0x34, 0x23, 0x37, 0x10, 0xA5, 
+0xE0, 0x0D, 0x38, 
+
+0x20, 0x63, 0x46, 0x88, 0x59, 0x9E, 0xF4, 0xD6, 
+0xDF, 0xC2, 0x56, 0x2C, 0x2F, 0x42, 0x2B, 0xF1, 
+0xB7, 0x99, 0xC6, 0x12, 0x5C, 0xF6, 0x1B, 0x41, 
+0x2F, 0x48, 0xF1, 0x6A, 0xB0, 0xBC, 0xA7, 0xAD, 
+0x02, 0x95, 0x31, 0xD9, 0x9D, 0xE5, 0x68, 0xBA, 
+0xEC, 0x3C, 0xD7, 0x3A, 0x5F, 0x25, 0xCA, 0xE1, 
+0x7E, 0x16, 0x69, 0xA8, 0x70, 0x54, 0x0A, 0xAC, 
+0x59, 0xB4, 0xFC, 0xA1, 0x36, 0x58, 0x50, 0x84, 
+0xA2, 0xF3, 0x39, 0x0E, 0x16, 0x97, 0x44, 0x21, 
+0x3B, 0xAB, 0x39, 0xF3, 0x87, 0xBC, 0xD5, 0xDD, 
+0xFE, 0x6D, 0xE6, 0x92, 0xD1, 0x17, 0x28, 0x3A, 
+0x26, 0x41, 0x61, 0xCF, 0x00, 0x77, 0x32, 0x62, 
+0xE0, 0x8F, 0xF7, 0xCE, 0x90, 0xF5, 0x35, 0x45, 
+0x61, 0xD8, 0x4A, 0x9D, 0x0E, 0xB3, 0x6E, 0x87, 
+0xC2, 0x4C, 0xE0, 0xBF, 0xF6, 0x56, 0x67, 0xE5, 
+0x86, 0xBE, 0x71, 0xBA, 0x09, 0x20, 0x04, 0x7F, 
+0x99, 0xD9, 0xA9, 0xEB, 0x52, 0xEE, 0x18, 0xED, 
+0x5B, 0xCC, 0x15, 0x69, 0x87, 0x98, 0xFD, 0xC2, 
+0xFF, 0x79, 0xE8, 0x9A, 0x57, 0x21, 0xF8, 0xF3, 
+0x8E, 0x20, 0xEA, 0x82, 0x58, 0x1B, 0x0D, 0x8B, 
+0xC0, 0x98, 0xD6, 0xA3, 
+
+0x0B, 0x57, 0x5E, 0xD3, 0x33, 0xFF, 0x15, 0xE3, 
+0xB1, 0x33, 0xC9, 0xB9, 0x9C, 0xB5, 0x47, 0x76, 
+0x9D, 0xCB, 0xDA, 0x7A, 0xAC, 0x45, 0x58, 0xAA, 
+0xDA, 0xAC, 0xD9, 0x88, 0xA1, 0x8E, 0xCE, 0x5E, 
+0xF6, 0x19, 0xAF, 0x0E, 0x84, 0x86, 0x7B, 0x85, 
+0x59, 0x5D, 0x34, 0x69, 0xA8, 0x3F, 0x33, 0xF6, 
+0x4D, 0x69, 0x34, 0x9B, 0x83, 0xA5, 0x01, 0x9D, 
+0x8C, 0xE1, 0xB3, 0x40, 0x00, 0xBC, 0x6A, 0xBB, 
+0xBE, 0xF9, 0xAC, 0x56, 0xD9, 0xC1, 0xE9, 0xE0, 
+0x75, 0x0F, 0xA0, 0xD6, 0xF2, 0xC6, 0x96, 0x2C, 
+0x50, 0x1B, 0xCE, 0x44, 0xED, 0x81, 0x25, 0x3D, 
+0xBE, 0x0D, 0x52, 0xA7, 0x9C, 0x0C, 0x89, 0x48, 
+0xBD, 0xFA, 0x26, 0xCC, 0x7D, 0xAD, 0x1C, 0xA8, 
+0x23, 0x7A, 0x91, 0xEA, 0x4A, 0xA4, 0x50, 0xA5, 
+0x17, 0xC1, 0x5F, 0x08, 0x65, 0x4E, 0x23, 0x0C, 
+0x6A, 0x39, 0xE1, 0x62, 0x29, 0xAF, 0x31, 0x93, 
+0xE0, 0xB4, 0xA9, 0x8F, 0x6A, 0x73, 0x99, 0xDC, 
+0xE1, 0x90, 0x46, 0x1C, 0x57, 0x5A, 0x21, 0x71, 
+0xF2, 0x80, 0x7E, 0xE7, 0xBF, 0x54, 0x69, 0x02, 
+0x72, 0x48, 0xEB, 0xC9, 0x1E, 0x46, 0x1E, 0xAE, 
+0xAA, 0xE0, 0xDC, 0xD8, 0x33, 
+
+0x27, 0xE7, 0x5E, 0x57, 0xEA, 0x58, 0x15, 0xC9, 
+0x4D, 0x94, 0x41, 0xFA, 0x14, 0xFF, 0xE3, 0x3C, 
+0xB3, 0xBE, 0x2F, 0x36, 0x92, 0x53, 0xB9, 0x6F, 
+0xB9, 0x84, 0x3A, 0xC2, 0xC4, 0x94, 0xF9, 0x9B, 
+0x6D, 0xEA, 0xC9, 0x11, 0x7C, 0x51, 0xDD, 0x02, 
+0xD8, 0x6F, 0xB3, 0xE9, 0x5A, 0xB6, 0xBD, 0x72, 
+0xD4, 0x1E, 0x78, 0x79, 0x2B, 0x9E, 0xD0, 0x99, 
+0xFB, 0x4E, 0x22, 0x6A, 0x94, 0xE6, 0xEE, 0x8E, 
+0xC9, 0x11, 0x0B, 0xC4, 0x23, 0x3B, 0xA6, 0x7B, 
+0xB5, 0xB0, 0xC9, 0x6E, 0x1C, 0xD3, 0xB7, 0xA5, 
+0x23, 0x5B, 0xD4, 0x69, 0x04, 0x2D, 0x30, 0x12, 
+0x39, 0x27, 0xBC, 0xE3, 0x59, 0xDF, 0xA4, 0x6A, 
+0x6D, 0xAC, 0xC2, 0xFD, 0x02, 0xD8, 0x18, 0xC6, 
+0xC7, 0x44, 0x4E, 0x77, 0xD7, 0x7F, 0x77, 0xCB, 
+0x77, 0xAD, 0xB3, 0xD6, 0x41, 0x8E, 0xB4, 0xE7, 
+0x46, 0xA7, 0x0F, 0x91, 0x49, 0xC1, 0xE9, 0x1C, 
+0x7B, 0xB2, 0x04, 0x93, 0xF1, 0x1B, 0x47, 0xDF, 
+0xAB, 0xCD, 0xE3, 0x10, 0x09, 0x9C, 0x37, 0xCD, 
+0x79, 0xF1, 0xE8, 0x82, 0xA3, 0xD4, 0x47, 0x84, 
+0x30, 0xD5, 0x36, 0xD7, 0xE5, 0x5A, 0xB5, 0xDB, 
+0x7E, 0xB6, 0x1D, 0xF9, 0x46, 0x31, 
+
+0xAC, 0x9F, 0x1F, 0x93, 0xA2, 0xB8, 0xF7, 0xF6, 
+0x62, 0x3E, 0xD5, 0x92, 0x53, 0x2A, 0xCF, 0x97, 
+0x54, 0x2C, 0xE3, 0xF8, 0xC4, 0x5D, 0x2D, 0x0B, 
+0x8F, 0xBD, 0x31, 0xCF, 0x2B, 0xAD, 0x9F, 0xF2, 
+0xBB, 0xF8, 0x06, 0x04, 0xFF, 0xD1, 0x55, 0x81, 
+0xD9, 0xE4, 0x73, 0xA9, 0xF5, 0x64, 0xBC, 0x5C, 
+0xD1, 0x5F, 0x25, 0x3D, 0x1F, 0xE7, 0x40, 0x65, 
+0x08, 0xD3, 0x07, 0x1C, 0xAE, 0xAB, 0x80, 0x22, 
+0xCC, 0x66, 0x41, 0x3F, 0x36, 0x91, 0xFE, 0x1D, 
+0x7F, 0x81, 0xC2, 0xA5, 0x6D, 0xD2, 0x3D, 0x24, 
+0xE4, 0xD8, 0xB7, 0x24, 0xD2, 0xE7, 0xDC, 0xAF, 
+0x21, 0xEF, 0x9E, 0x1E, 0x5D, 0x1C, 0x3D, 0xDA, 
+0xD3, 0xF6, 0xE1, 0xFA, 0x3A, 0x1B, 0x06, 0xAD, 
+0x82, 0x75, 0xA5, 0xC3, 0x9F, 0xAD, 0x04, 0x1D, 
+0x29, 0x9E, 0xB0, 0x26, 0xEB, 0xD8, 0xAE, 0x45, 
+0x41, 0x45, 0xE0, 0x73, 0x97, 0x7B, 0xA0, 0xD6, 
+0x99, 0x0F, 0xFC, 0x46, 0xCE, 0x45, 0x5F, 0xC6, 
+0xE2, 0xB4, 0x83, 0x5B, 0x50, 0xA7, 0xC7, 0x67, 
+0x2C, 0x49, 0x82, 0x75, 0x27, 0x1F, 0x08, 0xD2, 
+0x15, 0x67, 0x39, 0x1C, 0x21, 0xA1, 0x0C, 0x0D, 
+0x54, 0xD8, 0x29, 0x91, 0x77, 0x22, 0xC4, 
+
+0x10, 0xEC, 0x14, 0xD3, 0xB4, 0x6F, 0xFF, 0x18, 
+0x09, 0x46, 0x2D, 0x0C, 0x8C, 0xAD, 0x77, 0x69, 
+0x81, 0x6A, 0xC9, 0xC1, 0x71, 0x41, 0x39, 0x44, 
+0x08, 0x3C, 0x62, 0x6D, 0x7B, 0xB9, 0x08, 0x88, 
+0x25, 0x2D, 0x97, 0x7D, 0xC7, 0xAF, 0x28, 0x5F, 
+0x1F, 0xC0, 0x5A, 0x76, 0x01, 0x04, 0x56, 0x33, 
+0x39, 0x92, 0x2C, 0x89, 0x16, 0x76, 0xBB, 0x1F, 
+0x33, 0xFB, 0x79, 0x4D, 0x22, 0x22, 0x12, 0x78, 
+0x7A, 0xB5, 0x2D, 0xBE, 0x48, 0xD1, 0x6F, 0xB3, 
+0x50, 0xC2, 0xEB, 0x31, 0xD2, 0xFF, 0xD7, 0x0C, 
+0x25, 0xA5, 0xC1, 0xDB, 0x0A, 0xC6, 0x26, 0x11, 
+0x57, 0xF8, 0xD3, 0xD7, 0x0A, 0x16, 0xB3, 0x0D, 
+0x40, 0x0E, 0x96, 0xE7, 0x89, 0xAE, 0x79, 0x51, 
+0xE8, 0x9C, 0x49, 0x2C, 0xC0, 0xCF, 0x6E, 0x48, 
+0xF8, 0x2D, 0xC1, 0xC2, 0x31, 0x0A, 0xA6, 0x2E, 
+0xF3, 0x4A, 0x47, 0x27, 0xBA, 0x0D, 0x50, 0xF1, 
+0xAB, 0x66, 0xD5, 0xFC, 0xD2, 0x33, 0x99, 0x51, 
+0x12, 0x9A, 0x53, 0x5A, 0x05, 0x80, 0xF7, 0x13, 
+0xE5, 0xC9, 0x44, 0xEE, 0x32, 0xA5, 0x74, 0xBE, 
+0x12, 0xA2, 0x35, 0x7B, 0xF0, 0x25, 0xF9, 0x4E, 
+0x11, 0x40, 0x40, 0x9A, 0x7A, 0x8F, 0xD9, 0x8E, 
+
+0x92, 0xAC, 0xC5, 0xAD, 0xB9, 0x2C, 0x9F, 0x92, 
+0xD9, 0xFF, 0xA0, 0xFA, 0x0C, 0x60, 0x4A, 0x32, 
+0xE7, 0x19, 0xF5, 0xBF, 0x62, 0x97, 0x0D, 0x53, 
+0x63, 0xEC, 0x20, 0x2F, 0x0A, 0x1F, 0x54, 0x89, 
+0xB4, 0xFC, 0xE8, 0x96, 0xB9, 0x38, 0xC0, 0xBE, 
+0xDC, 0x83, 0x9C, 0x19, 0x81, 0x5F, 0x23, 0xFF, 
+0xB5, 0x8C, 0xF0, 0x63, 0x07, 0x04, 0xDA, 0x97, 
+0x32, 0xC2, 0x38, 0x94, 0x14, 0x31, 0xFD, 0x1C, 
+0x15, 0x9A, 0xE3, 0xAB, 0x30, 0xC5, 0x8D, 0x5A, 
+0x03, 0x72, 0x03, 0xAD, 0xD0, 0xFD, 0x69, 0xCD, 
+0x3F, 0x13, 0xC9, 0x7B, 0xB5, 0x3B, 0x9C, 0x00, 
+0xF9, 0x1D, 0xE5, 0x01, 0xB8, 0xDD, 0x7C, 0x46, 
+0x74, 0x9A, 0x37, 0xE4, 0x4E, 0xF4, 0xCA, 0x0B, 
+0xCD, 0xAE, 0xAE, 0x38, 0x00, 0x68, 0xAD, 0xFB, 
+0x44, 0xB9, 0x87, 0xA7, 0x62, 0xBB, 0xEC, 0x95, 
+0x76, 0xF7, 0x0B, 0x2C, 0xE6, 0x89, 0x96, 0x8C, 
+0x88, 0x0D, 0xE8, 0x70, 0x09, 0xA8, 0x7D, 0xBA, 
+0xC1, 0x3B, 0x13, 0xF7, 0xD6, 0xBF, 0x2F, 0x46, 
+0x30, 0x64, 0x01, 0x56, 0x28, 0xE5, 0x1B, 0x36, 
+0x6C, 0x93, 0x44, 0xE5, 0x5D, 0xE8, 0x4C, 0x34, 
+0x7B, 0x6D, 0x12, 0xAE, 0xA5, 0x0D, 0xED, 0x4F, 
+0xA2, 
+
+0xE2, 0x01, 0xD2, 0xB9, 0xE9, 0x45, 0x9E, 0xA2, 
+0x68, 0x2D, 0x97, 0xB5, 0xFE, 0xF9, 0xBB, 0xC4, 
+0x9F, 0xB0, 0xE4, 0x28, 0x67, 0xAC, 0xB9, 0x9A, 
+0x0C, 0xDF, 0x92, 0xA8, 0xD3, 0xBE, 0x92, 0x2D, 
+0xAE, 0x02, 0x87, 0x93, 0xC6, 0xB1, 0x47, 0x37, 
+0x4E, 0x8E, 0x1E, 0x66, 0x29, 0x4B, 0x07, 0xE4, 
+0x26, 0x68, 0x19, 0xCA, 0x0C, 0xD6, 0x60, 0x3B, 
+0x27, 0xD7, 0x6A, 0x63, 0xAD, 0x23, 0xC5, 0x18, 
+0x9A, 0x73, 0x48, 0x9C, 0x4F, 0x33, 0xA2, 0xC6, 
+0x20, 0x6C, 0xC1, 0x01, 0x40, 0xAF, 0x65, 0x43, 
+0x65, 0xA1, 0xF2, 0xB3, 0x46, 0xC8, 0x82, 0xF4, 
+0xD3, 0x0B, 0xEB, 0x14, 0x3E, 0x6E, 0x9A, 0x39, 
+0x52, 0x3A, 0x06, 0x09, 0x4D, 0x2C, 0xAD, 0x4F, 
+0xC4, 0x11, 0x4C, 0x49, 0xDA, 0xAA, 0x11, 0x49, 
+0x88, 0xD9, 0x96, 0x54, 0xCB, 0xFB, 0x28, 0x06, 
+0xE8, 0x1E, 0x32, 0x6E, 0xB0, 0x4B, 0xA7, 0xBA, 
+0x26, 0xB5, 0x9D, 0xA4, 0xBF, 0xA1, 0xD1, 0x47, 
+0xC2, 0xF3, 0x0F, 0x8B, 0xE9, 0x07, 0x51, 0x71, 
+0x67, 0xA8, 0xD7, 0x8B, 0x22, 0x6A, 0x85, 0x56, 
+0x53, 0xE8, 0x14, 0x96, 0xE7, 0x2F, 0xEC, 0x0D, 
+0x79, 0x40, 0xA9, 0x49, 0xD5, 0xC6, 0x66, 0xE9, 
+0x69, 0xED, 
+
+0xE9, 0x71, 0xE3, 0x64, 0x64, 0xCE, 0xCF, 0xFC, 
+0xE0, 0x90, 0xC5, 0xC6, 0xAB, 0xFA, 0x8F, 0xE0, 
+0x45, 0x8C, 0x33, 0xD3, 0xFF, 0xD6, 0x95, 0xD3, 
+0x4B, 0xF8, 0xCC, 0xF0, 0xF7, 0x86, 0x10, 0xB0, 
+0xE5, 0x2D, 0x6F, 0xD5, 0x96, 0xCC, 0xB0, 0xF2, 
+0x4E, 0xB9, 0x69, 0x3F, 0xFB, 0x17, 0xF7, 0x51, 
+0x17, 0x61, 0x3B, 0xA1, 0xEA, 0x7B, 0xF0, 0x49, 
+0x5B, 0x80, 0x26, 0x0B, 0xC6, 0x13, 0x76, 0x29, 
+0xFF, 0x13, 0x91, 0x91, 0x0F, 0x11, 0xB9, 0x48, 
+0x6A, 0xD8, 0xDB, 0xE9, 0x0D, 0x18, 0x9C, 0x5C, 
+0x2B, 0x6A, 0x46, 0x94, 0xB1, 0xFB, 0x71, 0xE7, 
+0x2B, 0xE2, 0x71, 0x50, 0x38, 0x4E, 0x24, 0x9C, 
+0xC7, 0xF7, 0x21, 0x01, 0x8A, 0xF1, 0xE6, 0xBE, 
+0x87, 0x26, 0xBA, 0x13, 0xD2, 0xC6, 0x42, 0x95, 
+0xE8, 0xF2, 0xF1, 0xBB, 0xE7, 0x84, 0xE9, 0x2B, 
+0x4F, 0xAF, 0xA7, 0x34, 0x3A, 0x2E, 0x35, 0x75, 
+0x0C, 0x96, 0x84, 0xC4, 0xDB, 0x20, 0xF0, 0x5E, 
+0x70, 0x73, 0xE2, 0x74, 0x7E, 0x72, 0x70, 0xB9, 
+0x00, 0x09, 0x09, 0x71, 0x40, 0x6E, 0x64, 0xC2, 
+0x09, 0xA1, 0x50, 0xF4, 0x3D, 0x46, 0x59, 0xE3, 
+0x7B, 0x52, 0x40, 0x73, 0xD1, 0x65, 0xF4, 0xDC, 
+0xFC, 0x00, 0xC6, 
+
+0x00, 0x1F, 0x25, 0xAA, 0xC1, 0x8C, 0x56, 0x4E, 
+0x3B, 0x40, 0x19, 0xC3, 0xC3, 0x32, 0x0C, 0x47, 
+0xB8, 0x9D, 0xDF, 0x2B, 0xDD, 0x16, 0xE2, 0x56, 
+0xB5, 0xA7, 0x22, 0x89, 0xAA, 0x5C, 0xEB, 0x59, 
+0x63, 0x6E, 0xD5, 0x69, 0x53, 0x1B, 0x02, 0x7A, 
+0x5F, 0x8C, 0xA7, 0x58, 0x8F, 0x0A, 0xA7, 0xF1, 
+0x64, 0x2E, 0x6E, 0x86, 0x5E, 0x2D, 0xDB, 0x61, 
+0x28, 0xBD, 0xAB, 0x77, 0x50, 0x95, 0xCD, 0xBE, 
+0xE2, 0x28, 0xE5, 0xF2, 0x2D, 0x49, 0x3B, 0x88, 
+0x98, 0x0A, 0xF6, 0xAC, 0xAE, 0x87, 0xFD, 0xAB, 
+0x57, 0x23, 0xC4, 0xF6, 0x9C, 0x2C, 0x49, 0x74, 
+0x5E, 0x8D, 0x05, 0xC3, 0x84, 0x76, 0xE7, 0x83, 
+0x5C, 0x13, 0x20, 0x0F, 0xBA, 0x7D, 0xB2, 0x0C, 
+0xAE, 0xA0, 0x5C, 0xD8, 0x3F, 0xD4, 0x04, 0x94, 
+0xB5, 0xD0, 0xA2, 0xD8, 0xB1, 0x73, 0x5A, 0x9A, 
+0x85, 0xC1, 0xFA, 0x00, 0x6C, 0xC4, 0x46, 0x08, 
+0xEF, 0xAF, 0xF1, 0x3F, 0x1E, 0xCE, 0xB1, 0xC3, 
+0x53, 0x8F, 0x1A, 0x46, 0x87, 0x9C, 0x3F, 0x5D, 
+0xF8, 0xC2, 0x57, 0x47, 0x1F, 0xA2, 0x92, 0xB3, 
+0xA6, 0x0D, 0x53, 0xCC, 0xC0, 0xF0, 0x07, 0x34, 
+0x39, 0x50, 0x13, 0x54, 0x33, 0x3E, 0xD1, 0x0B, 
+0x8F, 0x94, 0xF5, 0xE9, 
+
+0x56, 0x11, 0x67, 0x8C, 0x02, 0x37, 0x7A, 0x34, 
+0x69, 0x65, 0x56, 0x3D, 0x8D, 0xFC, 0xFB, 0xBD, 
+0x72, 0x0F, 0x27, 0x6E, 0xE0, 0x39, 0x7B, 0x3A, 
+0xBE, 0x3C, 0x2B, 0x34, 0xF4, 0xBC, 0xA5, 0xCA, 
+0x8F, 0xBA, 0x5E, 0x05, 0x2A, 0xFC, 0x89, 0x33, 
+0x6C, 0x41, 0x47, 0xF8, 0x7B, 0xC6, 0x73, 0xC4, 
+0x71, 0x75, 0x09, 0x2D, 0x9C, 0xDF, 0x7D, 0x44, 
+0xDD, 0x68, 0xC5, 0xB3, 0x52, 0x78, 0xF3, 0x85, 
+0x53, 0x8C, 0xB2, 0x27, 0x9B, 0x7C, 0xB2, 0x31, 
+0x35, 0xD5, 0xBD, 0xFD, 0xAF, 0x32, 0xFA, 0x77, 
+0x87, 0x59, 0x90, 0xF2, 0x24, 0xA7, 0x74, 0x35, 
+0x8D, 0xD1, 0x60, 0x50, 0x12, 0x8B, 0xA0, 0x8A, 
+0xA4, 0x8D, 0xB0, 0x8E, 0x8B, 0x46, 0x41, 0x05, 
+0x19, 0xFD, 0xDF, 0xA7, 0x4C, 0x40, 0xAA, 0xE0, 
+0x85, 0xED, 0xF0, 0x03, 0xF4, 0xA1, 0xA9, 0xD5, 
+0x9D, 0x2B, 0xF9, 0x33, 0x8C, 0xEF, 0x52, 0x8A, 
+0x70, 0x52, 0x60, 0xC5, 0xE0, 0x81, 0xE3, 0x82, 
+0x3B, 0xD1, 0xDC, 0x5C, 0xC2, 0x0D, 0xD7, 0xD4, 
+0xAC, 0xAD, 0x2A, 0xBD, 0x8E, 0xBA, 0x04, 0x0D, 
+0x47, 0xEF, 0x61, 0x8A, 0x0C, 0xB0, 0xB3, 0x27, 
+0x2A, 0x24, 0xCC, 0xD5, 0x84, 0x16, 0x43, 0xCE, 
+0x36, 0x76, 0xE5, 0x65, 0xC0, 
+
+0xFB, 0x9E, 0x98, 0x99, 0x36, 0x67, 0xAE, 0x3A, 
+0x97, 0x0C, 0xC5, 0x96, 0xE3, 0xC6, 0x82, 0x27, 
+0x58, 0x1C, 0xC5, 0xD3, 0x0F, 0xE1, 0x5C, 0xF8, 
+0x87, 0x76, 0x2E, 0x15, 0xDB, 0x06, 0x34, 0x36, 
+0xC6, 0x4B, 0xCD, 0x40, 0xE4, 0x45, 0x26, 0x4A, 
+0x22, 0x3C, 0x34, 0x85, 0xBD, 0x7D, 0x40, 0x82, 
+0xFB, 0x16, 0xA6, 0x95, 0xBD, 0x02, 0xB2, 0xAF, 
+0x85, 0x22, 0x49, 0xD7, 0x1C, 0x64, 0xA8, 0x6D, 
+0x29, 0x40, 0x4D, 0x30, 0x77, 0x38, 0x9D, 0x01, 
+0x8D, 0xBE, 0xB0, 0x2C, 0x0A, 0x47, 0x61, 0xC6, 
+0x24, 0x1E, 0x37, 0x87, 0x59, 0x84, 0xC3, 0x62, 
+0x37, 0x75, 0x0A, 0x45, 0x13, 0x0D, 0x7B, 0x9B, 
+0xE4, 0x9A, 0x79, 0xBE, 0x28, 0x99, 0x55, 0x84, 
+0x0C, 0x16, 0xE8, 0xA5, 0xE7, 0xCB, 0xEA, 0xBD, 
+0x16, 0x7B, 0x19, 0x6F, 0xEB, 0xE3, 0x30, 0x51, 
+0x93, 0x69, 0x19, 0x44, 0x57, 0x24, 0x2B, 0xF9, 
+0x58, 0x6E, 0x41, 0xE3, 0xF2, 0x81, 0x3B, 0x0F, 
+0xA8, 0x0C, 0x8C, 0xC8, 0xE3, 0x46, 0x68, 0x40, 
+0x15, 0xDA, 0xA8, 0x74, 0x22, 0x28, 0xF9, 0xA9, 
+0x37, 0xD2, 0x46, 0x91, 0x5F, 0xEB, 0x5B, 0xAF, 
+0x08, 0x0A, 0xE4, 0x0E, 0xEA, 0x79, 0xF5, 0xB1, 
+0x11, 0x93, 0x51, 0xE5, 0x51, 0x0B, 
+
+0x3C, 0x11, 0xCD, 0xE1, 0x67, 0x29, 0xFE, 0x76, 
+0x1C, 0x2B, 0x41, 0xFE, 0x13, 0x65, 0xEA, 0x34, 
+0x06, 0xC7, 0x7D, 0x0E, 0xC1, 0xE6, 0x78, 0x9F, 
+0x0C, 0x61, 0x28, 0x1F, 0xE6, 0x99, 0x47, 0x7F, 
+0x5F, 0xDA, 0x73, 0xFE, 0x60, 0x18, 0x56, 0x77, 
+0xD9, 0x3A, 0x26, 0xC6, 0xD2, 0x74, 0x80, 0x4B, 
+0x74, 0xC4, 0xFA, 0xA9, 0x9F, 0x8C, 0x2E, 0x91, 
+0xA0, 0x93, 0xEB, 0xB7, 0x79, 0xAD, 0xE8, 0x6F, 
+0x40, 0x7E, 0x91, 0xAB, 0x71, 0x82, 0x13, 0x30, 
+0x90, 0xEF, 0x01, 0x68, 0xE2, 0x9C, 0x9A, 0x8D, 
+0xD6, 0x04, 0x4F, 0xEE, 0xC9, 0xD3, 0xF0, 0xB5, 
+0xDF, 0xA0, 0x1A, 0x06, 0x17, 0xD1, 0xE1, 0x73, 
+0xC4, 0x32, 0x2C, 0x54, 0x7B, 0xE1, 0xE0, 0xDB, 
+0x21, 0x6C, 0xF2, 0xD8, 0x39, 0x33, 0xC9, 0xA9, 
+0xFA, 0x4D, 0x55, 0x74, 0x44, 0x69, 0x70, 0x0C, 
+0xB1, 0x6B, 0xAA, 0xCD, 0x44, 0x94, 0x86, 0x8E, 
+0xEA, 0x6B, 0x6C, 0x55, 0x99, 0x01, 0xB2, 0x55, 
+0x15, 0xD5, 0x7C, 0x9A, 0xB5, 0xB6, 0x45, 0x48, 
+0x5A, 0xC1, 0x60, 0x33, 0x6B, 0xFE, 0x92, 0x0D, 
+0xCB, 0xA2, 0x9D, 0x55, 0x03, 0xA4, 0x85, 0x43, 
+0xE4, 0xA7, 0xF9, 0xAC, 0xC9, 0x12, 0xFB, 0xB6, 
+0x83, 0x21, 0x98, 0xE3, 0xA3, 0x3C, 0xEF, 
+
+0x42, 0x61, 0xA5, 0xD9, 0xE1, 0xC4, 0xD6, 0xB2, 
+0x2B, 0xC5, 0xED, 0x29, 0xF6, 0x0D, 0xF2, 0xBC, 
+0x20, 0x31, 0x40, 0x68, 0xF5, 0xFA, 0xD9, 0xBB, 
+0x2F, 0xE0, 0xCC, 0xE5, 0xF2, 0x2A, 0x0E, 0xFE, 
+0xCB, 0xF0, 0xFB, 0xB9, 0x2B, 0x0E, 0xC0, 0xDD, 
+0x6D, 0x2C, 0xDC, 0xEA, 0x18, 0xAF, 0xF6, 0x02, 
+0x74, 0x2D, 0x34, 0x4B, 0x5F, 0x2A, 0x1E, 0xE2, 
+0xAB, 0x5D, 0xBA, 0x76, 0x8F, 0xD3, 0xF4, 0xC2, 
+0xC1, 0x7E, 0xC5, 0xC7, 0x31, 0x21, 0x31, 0xC0, 
+0x5A, 0x0B, 0x67, 0x75, 0xF5, 0x7B, 0x44, 0xF5, 
+0x1D, 0x31, 0xA2, 0x62, 0x27, 0x42, 0x2A, 0x0D, 
+0x38, 0xE5, 0x18, 0x6D, 0x39, 0x16, 0x9A, 0xF9, 
+0x79, 0xE3, 0x0F, 0x9E, 0xCF, 0x57, 0x8D, 0xBE, 
+0xE1, 0x8B, 0x0F, 0xEC, 0x55, 0x7B, 0x82, 0x1A, 
+0x07, 0xF1, 0x00, 0xC7, 0xB0, 0xD1, 0x94, 0x66, 
+0x0A, 0xF6, 0xE1, 0xB4, 0x15, 0x41, 0xC6, 0xCD, 
+0xCA, 0xEB, 0xC4, 0x58, 0x38, 0x41, 0xE5, 0xCB, 
+0xA3, 0x79, 0xB0, 0xDE, 0xE4, 0xF9, 0x53, 0x67, 
+0x50, 0x6F, 0x5F, 0x60, 0x1C, 0x17, 0x14, 0x2F, 
+0x26, 0xC1, 0x0F, 0x91, 0xF6, 0xC6, 0x85, 0x3B, 
+0x03, 0x0E, 0x4C, 0x14, 0xC6, 0xF6, 0xFD, 0x61, 
+0x32, 0x5E, 0x56, 0x83, 0x23, 0x5D, 0xF4, 0xC5, 
+
+0x15, 0x46, 0x8F, 0x24, 0xBC, 0xAC, 0x5B, 0x4C, 
+0xA2, 0xD5, 0x89, 0x78, 0xA3, 0x44, 0x91, 0xB2, 
+0xC5, 0xB8, 0xFB, 0x97, 0xD5, 0x06, 0x64, 0xB0, 
+0xF4, 0xEE, 0x89, 0xE7, 0x0B, 0x1F, 0x7D, 0xF6, 
+0xEE, 0xFE, 0x71, 0x7B, 0xF6, 0x00, 0xE1, 0xE5, 
+0x3D, 0x40, 0x24, 0x3C, 0x36, 0xC4, 0x4B, 0x81, 
+0xAF, 0x8D, 0x56, 0x2E, 0xB3, 0xB5, 0x4E, 0x49, 
+0xE3, 0x33, 0xCE, 0x0D, 0x70, 0x8C, 0x38, 0xED, 
+0x6C, 0x0B, 0xBD, 0x3B, 0x9D, 0xDE, 0x23, 0x8B, 
+0xC5, 0x7E, 0x82, 0x50, 0xEA, 0x66, 0x9A, 0x88, 
+0x13, 0xED, 0x8D, 0x48, 0x14, 0x8F, 0x31, 0x1E, 
+0x50, 0x5C, 0xB4, 0x62, 0x16, 0xA6, 0xD5, 0x39, 
+0x12, 0x3D, 0xD4, 0xDF, 0x3E, 0xAD, 0x37, 0xF1, 
+0xD7, 0xFB, 0x7C, 0xB3, 0xD6, 0x21, 0x15, 0xEB, 
+0x20, 0x9E, 0x1E, 0x9C, 0xD6, 0xE3, 0xAE, 0x57, 
+0xF5, 0xEA, 0xE9, 0x16, 0xD2, 0x1A, 0x1C, 0x10, 
+0x1B, 0x75, 0x0E, 0x1D, 0x84, 0x61, 0x7A, 0xC1, 
+0x39, 0xF8, 0x3C, 0xE0, 0x5B, 0x4F, 0xCE, 0xB0, 
+0x44, 0xB7, 0xA5, 0x3C, 0xDE, 0x84, 0x96, 0x55, 
+0x55, 0x9E, 0x06, 0x68, 0x15, 0x6F, 0xF6, 0xFB, 
+0x15, 0x06, 0xF0, 0x82, 0xA1, 0x57, 0xF2, 0xFB, 
+0x55, 0x6C, 0x24, 0xB0, 0xC8, 0x43, 0xD3, 0x8E, 
+0x73, 
+
+0x40, 0x41, 0x11, 0x86, 0xE6, 0x02, 0x77, 0x86, 
+0xF8, 0x40, 0x29, 0x78, 0xE2, 0xA5, 0xA2, 0xE5, 
+0x14, 0xA2, 0x4C, 0x22, 0xC2, 0x2F, 0x06, 0xA3, 
+0xF0, 0xB0, 0x75, 0x9F, 0x14, 0xD5, 0x60, 0x9F, 
+0x2E, 0xFD, 0xDF, 0xEF, 0xAC, 0x73, 0x9D, 0xBA, 
+0x80, 0x56, 0x91, 0x8A, 0x70, 0x90, 0xCC, 0xE7, 
+0xAA, 0x83, 0xEF, 0x96, 0x16, 0xF1, 0x03, 0x22, 
+0x2B, 0x46, 0x0B, 0x39, 0xF2, 0xBD, 0x74, 0x29, 
+0x6C, 0xDD, 0x48, 0x2D, 0x33, 0x80, 0x17, 0xC4, 
+0x4C, 0xF0, 0x2E, 0x3A, 0x0A, 0xFE, 0xCB, 0x29, 
+0x37, 0x2D, 0x95, 0x5B, 0x1E, 0xE0, 0x12, 0x9E, 
+0xE4, 0xB7, 0x39, 0xD3, 0xB0, 0xC5, 0xA4, 0x97, 
+0x95, 0xDA, 0x36, 0xC1, 0x22, 0x1A, 0xFD, 0x78, 
+0x71, 0x1A, 0x4B, 0xC6, 0xF1, 0x54, 0x4A, 0x7E, 
+0xE2, 0xD4, 0x05, 0x0E, 0x3B, 0x3E, 0x8D, 0x11, 
+0xF2, 0xCF, 0x08, 0xB4, 0xB3, 0x75, 0xBE, 0x15, 
+0x85, 0xF9, 0xE7, 0xD9, 0x0C, 0x03, 0xE3, 0x12, 
+0xE3, 0x33, 0xD5, 0xF3, 0x87, 0xDB, 0xB5, 0x04, 
+0x2E, 0x4C, 0x5E, 0x9E, 0x9E, 0x38, 0xA1, 0x9D, 
+0xED, 0xB1, 0x48, 0x58, 0x19, 0x45, 0xD5, 0x28, 
+0x5A, 0xDC, 0x95, 0xB0, 0x12, 0xBF, 0x02, 0xC7, 
+0xC9, 0x47, 0x5B, 0x5E, 0xC4, 0x6F, 0x5F, 0x7B, 
+0xF4, 0x83, 
+
+0x8D, 0x46, 0x58, 0x13, 0xD9, 0x3D, 0xFB, 0x86, 
+0xC6, 0x93, 0x48, 0x5F, 0x7D, 0xFD, 0x0A, 0x79, 
+0xCF, 0x18, 0xF3, 0x0A, 0x31, 0x92, 0xEB, 0x8C, 
+0x18, 0x6A, 0x1C, 0x02, 0xBD, 0x44, 0x12, 0xE4, 
+0x05, 0x44, 0xCF, 0x30, 0x7A, 0xD1, 0xB2, 0xD5, 
+0x69, 0x88, 0x00, 0x20, 0x36, 0x85, 0x07, 0x4A, 
+0x38, 0x54, 0xD3, 0x9F, 0x47, 0x5B, 0x80, 0x41, 
+0x01, 0x41, 0xA6, 0x48, 0xA3, 0x56, 0xB2, 0x1F, 
+0xFE, 0xCF, 0xCF, 0xDB, 0x02, 0xAE, 0x1D, 0x58, 
+0xD2, 0x3A, 0x97, 0x32, 0x81, 0x86, 0xA4, 0x48, 
+0x8B, 0x6D, 0x1E, 0xDF, 0xDB, 0x57, 0xDF, 0xFB, 
+0x24, 0xBA, 0x67, 0x93, 0x2D, 0x5E, 0x9C, 0x77, 
+0xDD, 0x16, 0xCC, 0x5E, 0x54, 0x78, 0xFA, 0xC5, 
+0x92, 0x1B, 0x5A, 0x9F, 0x76, 0x4D, 0xA5, 0xF2, 
+0x0F, 0x0B, 0x46, 0x00, 0xCC, 0xAF, 0x5D, 0x57, 
+0x75, 0x67, 0xAA, 0x83, 0x98, 0xD4, 0xCE, 0x8F, 
+0xFF, 0x14, 0x77, 0x90, 0xFB, 0x14, 0x9D, 0x71, 
+0x0B, 0x61, 0xBF, 0xC9, 0x65, 0xBF, 0x4F, 0x5F, 
+0xFE, 0xE3, 0x73, 0xF0, 0xAC, 0x4F, 0x85, 0x97, 
+0x90, 0x5D, 0x66, 0x5D, 0xBA, 0xFF, 0x2E, 0xCD, 
+0xE4, 0xD7, 0xAC, 0xAC, 0x97, 0x6B, 0xCD, 0x5F, 
+0xE0, 0xF9, 0x56, 0xBA, 0x9E, 0x67, 0xFD, 0xCD, 
+0xC9, 0xAD, 0xA0, 
+
+0xDA, 0x73, 0x07, 0x53, 0x37, 0x63, 0x4C, 0xE9, 
+0xB5, 0x67, 0x32, 0x4C, 0x81, 0xBA, 0xBA, 0xA5, 
+0xD5, 0x45, 0xA1, 0x42, 0xE9, 0x5B, 0x49, 0x60, 
+0x54, 0x86, 0x14, 0x4C, 0x0A, 0x6C, 0xD6, 0xC9, 
+0x8E, 0x8E, 0x74, 0x7C, 0xD7, 0x64, 0xC3, 0xF7, 
+0xF8, 0x57, 0x6C, 0xC1, 0xD9, 0x17, 0x49, 0x1E, 
+0x8E, 0x9A, 0x59, 0x95, 0x06, 0x8A, 0xAD, 0x7C, 
+0x1C, 0x0F, 0x40, 0x3F, 0x81, 0x5A, 0x74, 0x0A, 
+0x44, 0x10, 0x70, 0x64, 0x20, 0xC3, 0x69, 0x39, 
+0x6F, 0x1F, 0xD3, 0xA1, 0xA3, 0x41, 0x1D, 0x5B, 
+0xB4, 0x50, 0xE3, 0xD6, 0x38, 0x29, 0xE7, 0xA1, 
+0xD5, 0x83, 0x0D, 0x1D, 0x5D, 0x01, 0x26, 0x4C, 
+0x32, 0x95, 0xA8, 0xB4, 0x40, 0x00, 0x69, 0xB4, 
+0xC3, 0xC8, 0x4C, 0x84, 0xA4, 0x60, 0xF9, 0x0D, 
+0x82, 0x8F, 0x22, 0x9A, 0xB6, 0xE9, 0xCD, 0x06, 
+0x20, 0x29, 0x8D, 0x5A, 0x87, 0xF9, 0xD1, 0xD2, 
+0xA9, 0x7E, 0x59, 0xFC, 0x5E, 0x06, 0x93, 0x42, 
+0xC4, 0xF2, 0x1F, 0xDE, 0xA1, 0x5B, 0x9D, 0x1E, 
+0x16, 0x95, 0xB7, 0x63, 0x77, 0x4F, 0xD8, 0x49, 
+0x5D, 0xD9, 0x6D, 0xE3, 0x90, 0xC5, 0x06, 0xCE, 
+0x51, 0x9A, 0x76, 0x32, 0x3B, 0xF4, 0x7A, 0xCF, 
+0x25, 0xBD, 0xFB, 0x44, 0x4E, 0x8B, 0x0D, 0x1E, 
+0x09, 0xAE, 0x2D, 0xB3, 
+
+0x8F, 0xB8, 0x28, 0x4B, 0x6C, 0x08, 0xEA, 0xD3, 
+0xB8, 0x92, 0xBD, 0xE0, 0x98, 0x95, 0x3C, 0x98, 
+0xD4, 0x1E, 0x7A, 0x4B, 0xC4, 0x79, 0x2A, 0x2A, 
+0x3D, 0xC1, 0x59, 0x00, 0xFE, 0x88, 0xB5, 0x67, 
+0xCE, 0xFD, 0xB1, 0xBF, 0x89, 0xAA, 0x71, 0xC4, 
+0xC1, 0xE2, 0x50, 0x24, 0x94, 0xDF, 0x47, 0x17, 
+0xE5, 0xC7, 0xF2, 0x49, 0x8D, 0x0C, 0x7B, 0xB5, 
+0xE9, 0xF1, 0x94, 0x4F, 0x54, 0x80, 0x4D, 0x35, 
+0x10, 0x62, 0x76, 0x0D, 0x11, 0x11, 0x25, 0xC1, 
+0xAF, 0xA7, 0x06, 0x95, 0x83, 0x15, 0xE0, 0x0B, 
+0x43, 0x45, 0x79, 0x5D, 0xE1, 0x9C, 0x02, 0xDA, 
+0x3C, 0x3E, 0xEF, 0x7C, 0x8B, 0xCB, 0x23, 0x1F, 
+0x52, 0xDC, 0xE7, 0x2F, 0x99, 0x1E, 0x4D, 0xB0, 
+0xE3, 0xF2, 0xA0, 0xCD, 0xDE, 0x5E, 0x3F, 0x5B, 
+0x8F, 0xFF, 0xCE, 0x61, 0xCD, 0x55, 0x20, 0x93, 
+0x43, 0x27, 0x6F, 0xF6, 0x02, 0x13, 0x9F, 0x28, 
+0x9A, 0xD9, 0x57, 0xC6, 0xE3, 0x55, 0xC2, 0x0A, 
+0x1D, 0xF7, 0xC2, 0x08, 0x88, 0x46, 0x26, 0xC9, 
+0x27, 0x16, 0xF0, 0x79, 0x89, 0x83, 0x3A, 0x6A, 
+0x95, 0x42, 0xD3, 0xC6, 0x66, 0x3A, 0xDF, 0xD9, 
+0xFB, 0x83, 0xAB, 0x67, 0x3E, 0x1D, 0xBE, 0x86, 
+0x47, 0x0E, 0x52, 0xAF, 0x48, 0x06, 0x13, 0xAA, 
+0x94, 0x43, 0x50, 0xF1, 0xC5, 
+
+0x75, 0xC5, 0xD7, 0xFF, 0x35, 0xFD, 0x6F, 0x32, 
+0x4B, 0x94, 0x88, 0xE3, 0x82, 0xE3, 0xB8, 0xB9, 
+0xBA, 0xEE, 0xB5, 0xF3, 0xF0, 0xF0, 0x34, 0xAB, 
+0x19, 0x59, 0x83, 0x47, 0x54, 0xDC, 0xAB, 0x76, 
+0x95, 0x37, 0x88, 0x2B, 0x93, 0xA3, 0xA1, 0x1C, 
+0xE8, 0x96, 0xC0, 0x2E, 0x29, 0x98, 0xA9, 0x85, 
+0x11, 0xAE, 0x16, 0xEB, 0x95, 0xE9, 0x7A, 0x60, 
+0x95, 0xB3, 0x7A, 0xA4, 0x31, 0xFE, 0xD6, 0xB5, 
+0x35, 0x82, 0x96, 0x14, 0x4E, 0x5E, 0x09, 0xAD, 
+0xD0, 0x06, 0x9B, 0x25, 0xC9, 0x4C, 0xE2, 0x5F, 
+0xF8, 0x62, 0xE6, 0xDA, 0x7F, 0x7C, 0x97, 0x20, 
+0x25, 0xC1, 0xB1, 0x5B, 0x91, 0x7E, 0xDF, 0x21, 
+0xD4, 0x17, 0x7E, 0x67, 0xE6, 0xD3, 0x73, 0xCE, 
+0x53, 0xF1, 0x54, 0xBB, 0xE7, 0x50, 0x60, 0xDA, 
+0x65, 0xA9, 0xDC, 0x5A, 0x8C, 0x40, 0xF6, 0x60, 
+0xEA, 0x2A, 0x77, 0x38, 0x5C, 0xA8, 0xC9, 0x9A, 
+0xB9, 0x9E, 0x62, 0x0A, 0x41, 0xF9, 0xF5, 0x70, 
+0xE3, 0x03, 0xA2, 0x4A, 0xE2, 0x4B, 0x08, 0x36, 
+0x7B, 0xA8, 0x8F, 0xC5, 0x97, 0x74, 0x0C, 0x92, 
+0x7A, 0x9B, 0xB3, 0x54, 0x7D, 0xDC, 0xB4, 0x57, 
+0x56, 0x9C, 0x36, 0xC3, 0xFD, 0xBD, 0xDD, 0x33, 
+0x55, 0x44, 0x49, 0x45, 0x23, 0x35, 0x60, 0x69, 
+0xAC, 0x75, 0x81, 0x39, 0xF3, 0x64, 
+
+0x0E, 0x23, 0x75, 0x8C, 0x64, 0x9C, 0x58, 0xC0, 
+0x60, 0x97, 0x60, 0x2B, 0xFB, 0xAD, 0x67, 0x54, 
+0x6C, 0x72, 0x62, 0xC4, 0xCA, 0x5C, 0x49, 0x3D, 
+0x63, 0xE7, 0x56, 0x12, 0x9D, 0xFD, 0xBF, 0x55, 
+0xE4, 0xC7, 0xAA, 0xFA, 0x9E, 0x6F, 0xC9, 0xD6, 
+0xC5, 0x73, 0x86, 0x80, 0x68, 0x97, 0xCB, 0x7A, 
+0xAA, 0x0C, 0xB7, 0x22, 0x6B, 0xF7, 0xB6, 0xF6, 
+0xC7, 0xA1, 0xF2, 0x06, 0x82, 0x82, 0xBB, 0xBA, 
+0x83, 0xB7, 0x24, 0x03, 0xE3, 0xEC, 0x0E, 0x6F, 
+0xE2, 0xF5, 0xD2, 0xFD, 0x47, 0x4E, 0xFD, 0xD9, 
+0xAD, 0xAB, 0x58, 0x18, 0x32, 0x5F, 0xFB, 0xE8, 
+0xED, 0xDA, 0x3E, 0xFE, 0xFB, 0x69, 0x37, 0xD5, 
+0x09, 0xA4, 0xE0, 0x9E, 0xB5, 0x30, 0x0C, 0x44, 
+0xF0, 0x0A, 0xD8, 0x51, 0x13, 0xEA, 0x54, 0x36, 
+0x1F, 0xB2, 0x1F, 0x63, 0x08, 0x92, 0xCA, 0x97, 
+0xEF, 0x0B, 0xAD, 0x5C, 0x37, 0x79, 0x7F, 0xC4, 
+0xB1, 0x55, 0x1C, 0xCC, 0x87, 0x86, 0x92, 0x88, 
+0x28, 0x3C, 0x9B, 0xC2, 0x17, 0x4B, 0x37, 0xF0, 
+0x3A, 0xF4, 0x43, 0x8E, 0x14, 0x0D, 0x18, 0x71, 
+0x77, 0xAD, 0x52, 0x2F, 0xAB, 0x26, 0xEC, 0x8A, 
+0x93, 0xC3, 0x3D, 0xDC, 0x8E, 0xF3, 0x55, 0x34, 
+0x86, 0xF7, 0x90, 0x9F, 0x7B, 0x44, 0x16, 0xD6, 
+0x5E, 0xD9, 0x27, 0xA5, 0x84, 0x6B, 0x6A, 
+
+0xD8, 0xC7, 0x8D, 0xF6, 0x9C, 0x31, 0x6C, 0x4A, 
+0x46, 0x2B, 0x8B, 0x8C, 0x7A, 0xE0, 0x12, 0x04, 
+0x19, 0x1C, 0x5D, 0xE0, 0xDC, 0xCA, 0xA7, 0xA6, 
+0x8B, 0xA0, 0xF3, 0x0C, 0xEE, 0xE5, 0xB1, 0x68, 
+0x9D, 0x59, 0xBE, 0x12, 0x2B, 0xCD, 0xFB, 0x94, 
+0xE2, 0xD4, 0x12, 0xA6, 0x40, 0x84, 0x98, 0x85, 
+0x8A, 0xAF, 0x85, 0x33, 0x92, 0x18, 0xD3, 0x00, 
+0x03, 0x44, 0x9E, 0x33, 0xB3, 0x6C, 0x66, 0xF1, 
+0x83, 0x6D, 0x06, 0xEA, 0x99, 0xFC, 0x63, 0xC9, 
+0x6F, 0x09, 0xE5, 0xA6, 0x7B, 0xD0, 0x89, 0x04, 
+0x6A, 0xE5, 0x25, 0xF5, 0xCD, 0x28, 0x33, 0xB2, 
+0x9C, 0x24, 0x41, 0xAE, 0x1F, 0x98, 0x97, 0xF5, 
+0xCA, 0xAA, 0x38, 0xD2, 0x38, 0x4D, 0x85, 0x84, 
+0xB6, 0x9F, 0x1D, 0xC1, 0xFB, 0xDE, 0x34, 0x63, 
+0x3C, 0xC2, 0x02, 0x37, 0xCD, 0x7C, 0xC3, 0x7A, 
+0x68, 0x6A, 0x96, 0x0A, 0xF0, 0x06, 0x8A, 0x87, 
+0xD9, 0xCA, 0xAE, 0x88, 0xB3, 0x1C, 0x31, 0xBF, 
+0x88, 0x56, 0xA9, 0x9A, 0x0A, 0xEF, 0xC2, 0xB0, 
+0xA9, 0x72, 0x1B, 0x64, 0xB2, 0xF7, 0x8D, 0x0A, 
+0xAF, 0x52, 0x7E, 0xD2, 0x1E, 0x39, 0x8B, 0x5E, 
+0x9B, 0x42, 0x8F, 0xFC, 0x2E, 0x1E, 0x69, 0x87, 
+0x0A, 0xC8, 0xA0, 0x7F, 0x41, 0x31, 0x63, 0x6D, 
+0xF9, 0xCD, 0x85, 0x4C, 0xDF, 0xCA, 0x7B, 0x8B, 
+
+0xB8, 0x8E, 0x54, 0xFB, 0xC8, 0x69, 0xE2, 0x71, 
+0x42, 0xEC, 0xF9, 0x3B, 0x5E, 0xF6, 0x55, 0x2E, 
+0xBE, 0xB2, 0x04, 0x7F, 0xCD, 0x0D, 0x93, 0x60, 
+0x3D, 0x2D, 0xB4, 0xCA, 0x6E, 0xF3, 0xE3, 0x27, 
+0xCE, 0xE7, 0x4F, 0xB8, 0xF1, 0x37, 0x74, 0xBB, 
+0x31, 0x4F, 0x2D, 0xA1, 0xCF, 0x99, 0xA5, 0xD7, 
+0x32, 0xAE, 0xF8, 0x7E, 0x9B, 0xF2, 0x51, 0x34, 
+0x81, 0x80, 0xFD, 0xBD, 0xD1, 0x94, 0x93, 0xAA, 
+0x28, 0xEC, 0x9A, 0x49, 0x28, 0xE4, 0xEF, 0xB5, 
+0x43, 0x58, 0x38, 0x66, 0x17, 0xF0, 0xFE, 0x1A, 
+0xD9, 0x26, 0x42, 0x3C, 0xCE, 0x68, 0xD3, 0x9F, 
+0x2E, 0x78, 0xFB, 0xF8, 0xFD, 0x29, 0x39, 0x5D, 
+0x95, 0x97, 0x23, 0xCE, 0x90, 0xE7, 0x6E, 0x61, 
+0xAB, 0x1A, 0x0D, 0x0E, 0x97, 0xB7, 0xEC, 0xD4, 
+0x05, 0x68, 0xA4, 0x18, 0x8B, 0x88, 0xA0, 0xB6, 
+0xF8, 0x3B, 0x1A, 0x02, 0xDD, 0xFC, 0xE2, 0xA0, 
+0x64, 0xE7, 0xCF, 0x81, 0x75, 0xEC, 0x78, 0x56, 
+0xAE, 0x37, 0x4B, 0x0B, 0x02, 0x9A, 0x75, 0x3C, 
+0x89, 0x43, 0xB9, 0xD2, 0x0E, 0x96, 0xBE, 0xD2, 
+0x38, 0xCD, 0xB0, 0x06, 0x10, 0xA7, 0xF3, 0x69, 
+0x70, 0x7B, 0xA3, 0x9B, 0x75, 0x54, 0xEB, 0xB3, 
+0xAE, 0xFC, 0x8A, 0xE0, 0x68, 0x56, 0x33, 0x22, 
+0x22, 0x0D, 0x1B, 0xEF, 0x90, 0xC8, 0x83, 0x21, 
+0xBF, 
+
+0xDB, 0x14, 0xF3, 0x01, 0xF8, 0x18, 0x76, 0x00, 
+0x1F, 0xF2, 0x10, 0xBB, 0xA2, 0x8C, 0x4D, 0x32, 
+0xCE, 0x9D, 0x63, 0xFA, 0xAD, 0xF4, 0xCA, 0x7D, 
+0x34, 0xA8, 0x49, 0x71, 0x14, 0x42, 0x38, 0x95, 
+0xDB, 0xAB, 0x34, 0x42, 0x28, 0x4F, 0x47, 0xFD, 
+0x63, 0x22, 0x5A, 0x4B, 0x80, 0x7D, 0x33, 0x42, 
+0x9F, 0x75, 0xAC, 0xBC, 0x5E, 0x3C, 0xFE, 0x7C, 
+0x32, 0x46, 0xDA, 0xF6, 0x31, 0xFB, 0x44, 0x23, 
+0x1D, 0x4C, 0x62, 0x27, 0xE5, 0xED, 0xBB, 0x0B, 
+0x17, 0xF8, 0x8F, 0x6C, 0xBD, 0xC4, 0x74, 0x42, 
+0x11, 0x87, 0xC4, 0xBE, 0x72, 0x9E, 0x8C, 0xA9, 
+0x02, 0xB0, 0x98, 0x3E, 0x48, 0x82, 0xCF, 0x2A, 
+0xE2, 0xF8, 0x2F, 0xAE, 0xBF, 0x77, 0xCA, 0x27, 
+0xE5, 0x6F, 0xD0, 0x58, 0x83, 0x6E, 0x82, 0x2B, 
+0xBC, 0x2B, 0xB5, 0x1F, 0xC7, 0x51, 0x91, 0x2C, 
+0x06, 0x70, 0x74, 0xA9, 0x94, 0xF9, 0xF9, 0xF4, 
+0x84, 0xF9, 0xEC, 0x27, 0x3D, 0x01, 0x07, 0x1D, 
+0x89, 0x23, 0xBF, 0x30, 0x4B, 0x9E, 0x1D, 0x38, 
+0x35, 0xB4, 0x8B, 0x03, 0xE4, 0x44, 0xEC, 0x7E, 
+0x91, 0x2A, 0xA9, 0x13, 0x78, 0xAD, 0x3E, 0xF9, 
+0x3B, 0x4C, 0x2D, 0xAB, 0xFD, 0x37, 0xC6, 0x52, 
+0xC4, 0x28, 0xE4, 0x78, 0xAD, 0x80, 0xB3, 0xF6, 
+0x02, 0xB4, 0x57, 0x4E, 0x88, 0xC7, 0x48, 0x15, 
+0x8A, 0xE8, 
+
+0x03, 0x2E, 0x6E, 0xBD, 0x03, 0xA1, 0xB9, 0x69, 
+0x50, 0x49, 0x5C, 0x6C, 0xCC, 0x39, 0xFC, 0x35, 
+0x31, 0xC9, 0xEC, 0xD4, 0x7C, 0x5A, 0x71, 0xB2, 
+0xB4, 0x5D, 0x56, 0x62, 0x68, 0xB1, 0x9A, 0xE1, 
+0xC5, 0x9F, 0xA8, 0xB4, 0x80, 0x6C, 0x4F, 0x55, 
+0x66, 0x5A, 0x5E, 0x75, 0xEA, 0xCD, 0xAD, 0xFD, 
+0xDC, 0xFD, 0x64, 0x18, 0x6D, 0x6E, 0x39, 0xB2, 
+0xF2, 0x7F, 0xDF, 0xE3, 0x74, 0x46, 0xDB, 0xA0, 
+0x5B, 0xA0, 0x0D, 0x5F, 0x1C, 0xB1, 0x19, 0x07, 
+0x04, 0xB9, 0x86, 0x23, 0xA9, 0xA3, 0xD2, 0x40, 
+0x79, 0x3F, 0xC3, 0xF0, 0xFD, 0x08, 0x5C, 0x67, 
+0xA2, 0xF2, 0xBD, 0xEA, 0x06, 0x48, 0x0D, 0x07, 
+0xDD, 0x0B, 0xDB, 0x13, 0x09, 0xA0, 0xED, 0xEC, 
+0x4C, 0xCC, 0x01, 0x90, 0xB1, 0x44, 0x4B, 0x91, 
+0x42, 0xAD, 0x43, 0x7C, 0x77, 0x7C, 0x57, 0x41, 
+0x6C, 0xF8, 0xA1, 0xDB, 0x60, 0x6F, 0x7F, 0x9D, 
+0xD5, 0xE7, 0x85, 0x94, 0x22, 0x90, 0x6C, 0x83, 
+0x27, 0x2E, 0x80, 0xE6, 0xB8, 0x65, 0x7B, 0xCE, 
+0x54, 0xAE, 0xD0, 0xD9, 0xB8, 0xE7, 0x0F, 0x56, 
+0x32, 0x70, 0x22, 0xC4, 0x4A, 0x68, 0x2A, 0x6F, 
+0x58, 0xB5, 0xF2, 0xD8, 0x36, 0x1B, 0x28, 0x50, 
+0xC6, 0xC4, 0x81, 0xA8, 0xFF, 0xC6, 0x8C, 0x36, 
+0x33, 0x47, 0x72, 0xED, 0x9F, 0xBB, 0x46, 0xF1, 
+0xDE, 0xA1, 0xDA, 
+
+0x4B, 0x3E, 0x1D, 0x74, 0xDC, 0x10, 0x64, 0x6F, 
+0x9C, 0x4F, 0x05, 0xDB, 0x3B, 0xC6, 0xC3, 0xB7, 
+0xBE, 0x0F, 0x0A, 0xB6, 0xFD, 0x24, 0xE9, 0x46, 
+0xDB, 0x34, 0x87, 0x27, 0x46, 0x98, 0x65, 0x2F, 
+0xF7, 0x1B, 0x8F, 0xF6, 0xDC, 0x74, 0xCC, 0xFB, 
+0xDD, 0x34, 0x80, 0xA2, 0xC1, 0xFA, 0x83, 0xDA, 
+0x84, 0x1D, 0x3F, 0xDF, 0x82, 0xB6, 0x7F, 0xE2, 
+0xF3, 0x93, 0xD4, 0xC1, 0xFF, 0x7C, 0x40, 0xD4, 
+0x96, 0x4C, 0x16, 0x43, 0x8A, 0x24, 0xEB, 0xD5, 
+0xB4, 0xCE, 0xDF, 0xEA, 0xA6, 0x21, 0x99, 0x16, 
+0x0A, 0x0F, 0x59, 0xD5, 0x43, 0x09, 0x72, 0xCD, 
+0x7F, 0xBF, 0x74, 0x48, 0x0A, 0x69, 0x2B, 0x0C, 
+0x9D, 0x88, 0x12, 0x45, 0xD8, 0x2F, 0x1C, 0x79, 
+0xA7, 0xB7, 0x89, 0x67, 0x17, 0xED, 0x8B, 0x31, 
+0xDC, 0x4C, 0x6D, 0x55, 0x20, 0x58, 0x6D, 0xD6, 
+0x71, 0xC5, 0x72, 0x78, 0x28, 0x8D, 0x11, 0x94, 
+0x87, 0x6E, 0xE9, 0xD6, 0x94, 0x52, 0x00, 0x68, 
+0x4A, 0x1D, 0xF5, 0xEC, 0x29, 0xA3, 0x79, 0xEB, 
+0xD0, 0xAB, 0xD7, 0xA7, 0x26, 0x4A, 0x41, 0x5D, 
+0x4F, 0xF8, 0x8C, 0xB2, 0x7F, 0xDD, 0x9A, 0x6A, 
+0x33, 0x55, 0xD9, 0x87, 0x13, 0xB4, 0x58, 0x4B, 
+0x49, 0x4F, 0xA9, 0xD5, 0x8C, 0x10, 0x04, 0xAE, 
+0x40, 0xAA, 0x25, 0x95, 0x30, 0x77, 0x4B, 0x2A, 
+0x01, 0xD7, 0x17, 0x2D, 
+
+0xCF, 0xDE, 0xB3, 0x34, 0xBF, 0x5C, 0xAE, 0x08, 
+0x4A, 0xE4, 0xA2, 0x5C, 0x6F, 0x73, 0x13, 0x48, 
+0x0B, 0x18, 0x1D, 0x06, 0xBB, 0xCB, 0xC9, 0x02, 
+0x78, 0x6F, 0xAC, 0x5F, 0x58, 0xC9, 0xBE, 0x2E, 
+0xAC, 0x99, 0xD4, 0x49, 0x28, 0xA6, 0x93, 0x5B, 
+0xBF, 0x22, 0x88, 0x97, 0xF8, 0x3F, 0xEF, 0x8D, 
+0xBC, 0x9A, 0x14, 0x81, 0xB5, 0x36, 0xFD, 0x9E, 
+0xCB, 0x7C, 0x49, 0xA5, 0x97, 0xE7, 0x78, 0xF0, 
+0xC1, 0x4A, 0x03, 0x7F, 0x7F, 0xF2, 0xE4, 0x7E, 
+0xB6, 0x27, 0x4C, 0x71, 0x6E, 0x98, 0xF6, 0xAD, 
+0x63, 0xF2, 0x38, 0x25, 0x9B, 0x50, 0xBD, 0x62, 
+0x0E, 0xE1, 0x60, 0xF5, 0x1C, 0x04, 0x25, 0x4F, 
+0x24, 0xBC, 0xEB, 0xC8, 0xAA, 0x0E, 0x33, 0xF2, 
+0x4C, 0x73, 0xC5, 0xF2, 0xCE, 0x8A, 0x3D, 0x1C, 
+0x62, 0x2B, 0xB1, 0x86, 0x76, 0xF0, 0x74, 0x27, 
+0xB6, 0x00, 0x60, 0xBE, 0xF9, 0x67, 0xAB, 0x39, 
+0x25, 0x13, 0x97, 0x12, 0xBA, 0xDD, 0x23, 0xC4, 
+0x1A, 0x13, 0x99, 0xB3, 0xDD, 0x50, 0x39, 0xAB, 
+0x23, 0x04, 0x09, 0xEB, 0x82, 0x32, 0x78, 0x99, 
+0xB9, 0x6B, 0x05, 0x31, 0x73, 0x50, 0x2E, 0xC3, 
+0x87, 0x45, 0x88, 0x8E, 0x2D, 0xB8, 0x83, 0x29, 
+0x72, 0x60, 0xFF, 0xFC, 0x73, 0x6F, 0xF2, 0xC5, 
+0x90, 0xC3, 0xED, 0x38, 0x74, 0x16, 0x7C, 0xB7, 
+0x31, 0xD4, 0x8D, 0xD9, 0x88, 
+
+0x2A, 0xE9, 0x2C, 0xF9, 0xD5, 0xF4, 0xB2, 0xA1, 
+0xC9, 0x09, 0xD4, 0x6E, 0x05, 0xC8, 0x8E, 0x22, 
+0xBE, 0x5B, 0xBF, 0x13, 0x4A, 0xEA, 0xFB, 0xCE, 
+0xAA, 0x4B, 0x62, 0x45, 0x25, 0xBA, 0xE8, 0xF0, 
+0x36, 0x84, 0x51, 0x23, 0x80, 0xD2, 0x74, 0x71, 
+0x4F, 0x5A, 0x22, 0x19, 0xAF, 0xC8, 0x80, 0x95, 
+0xFD, 0x87, 0x3A, 0x8F, 0x5D, 0xBD, 0xBF, 0x73, 
+0x68, 0xA8, 0x4A, 0xE9, 0xFC, 0x52, 0xBC, 0x26, 
+0xD8, 0x84, 0x19, 0x7A, 0x82, 0xDE, 0xC7, 0xEA, 
+0x00, 0x85, 0x6B, 0x12, 0xB1, 0x69, 0x37, 0x44, 
+0x25, 0xD2, 0xF6, 0x4C, 0x09, 0xAF, 0x46, 0x97, 
+0x79, 0xE2, 0xAA, 0x1F, 0x33, 0xF0, 0xA2, 0xE5, 
+0xDA, 0x7B, 0xD0, 0x94, 0x0C, 0xCA, 0xFD, 0xC8, 
+0x1B, 0x92, 0x19, 0xFC, 0xB8, 0x41, 0x8C, 0x9A, 
+0x4C, 0x99, 0x43, 0x8C, 0xBF, 0x7C, 0x6B, 0x41, 
+0xE0, 0x02, 0xF8, 0xD4, 0x66, 0x62, 0x77, 0x53, 
+0x84, 0x76, 0xB6, 0xCB, 0x6A, 0xDD, 0x14, 0x6B, 
+0x40, 0x5F, 0xB6, 0x60, 0x58, 0x7B, 0x32, 0xF6, 
+0xEF, 0x17, 0x55, 0xD7, 0x2D, 0x3F, 0x8B, 0x12, 
+0x95, 0x69, 0xCD, 0x73, 0xEE, 0x6D, 0xC7, 0x04, 
+0xF6, 0x38, 0x67, 0x9E, 0x20, 0x8A, 0x2D, 0x3C, 
+0x58, 0x56, 0xF4, 0x64, 0x75, 0xC7, 0x13, 0x3E, 
+0xAA, 0x6E, 0x28, 0x47, 0x8A, 0x4D, 0xCD, 0x61, 
+0x50, 0xCB, 0xD6, 0xDB, 0x82, 0xCD, 
+
+0x24, 0xEF, 0xDD, 0x27, 0xFF, 0x61, 0x5B, 0x30, 
+0x12, 0x30, 0x99, 0x14, 0xDD, 0xEF, 0x0B, 0xE6, 
+0x0A, 0x24, 0x3F, 0xED, 0x92, 0xCC, 0x87, 0xDE, 
+0xA5, 0xB4, 0xC9, 0xEC, 0x33, 0x6F, 0xF4, 0xBF, 
+0x21, 0x51, 0x64, 0xD9, 0x4B, 0xB0, 0x51, 0xDD, 
+0x9E, 0x9E, 0xF3, 0xE1, 0x24, 0x51, 0xF2, 0x9D, 
+0x1D, 0xA4, 0xFB, 0x6A, 0x61, 0x4C, 0xD4, 0x6F, 
+0xA6, 0x44, 0x4C, 0x86, 0xBC, 0xEF, 0xE3, 0x7B, 
+0x58, 0xFB, 0x99, 0x8C, 0x65, 0xE6, 0xBB, 0xCA, 
+0x54, 0x70, 0xA5, 0x1B, 0xFA, 0xEF, 0x17, 0x29, 
+0x8E, 0x9E, 0x27, 0x6A, 0x09, 0x54, 0x7D, 0xB0, 
+0xF8, 0xA7, 0x8C, 0x2C, 0x23, 0x6C, 0x02, 0x0E, 
+0xC0, 0x04, 0x29, 0x59, 0x19, 0x32, 0xD5, 0x20, 
+0x84, 0xAE, 0x6E, 0x65, 0x21, 0x44, 0x11, 0x5C, 
+0x26, 0xE7, 0x1A, 0x57, 0x6B, 0xA7, 0x19, 0x66, 
+0x1B, 0x43, 0x10, 0x8D, 0x1D, 0x4C, 0x46, 0x39, 
+0x19, 0xBB, 0x2B, 0xB7, 0x45, 0x64, 0xF6, 0xDA, 
+0xE6, 0xDF, 0x79, 0xC8, 0x6D, 0x49, 0x3D, 0xC0, 
+0x95, 0x3D, 0x4B, 0x06, 0x23, 0x97, 0x47, 0xFD, 
+0x70, 0xE7, 0xFC, 0x0E, 0xC5, 0x52, 0xA9, 0x74, 
+0x44, 0x3C, 0x2E, 0x9C, 0xF2, 0x10, 0xE7, 0x3B, 
+0xF1, 0x67, 0xCD, 0xF2, 0x5A, 0x71, 0xDC, 0x21, 
+0x63, 0xA1, 0x8F, 0xBF, 0xD7, 0x03, 0x20, 0x6E, 
+0x2E, 0xC3, 0xE5, 0xBC, 0x5B, 0xB7, 0x83, 
+
+0xBF, 0x44, 0x35, 0x06, 0x27, 0x6A, 0xD6, 0xFB, 
+0x73, 0x49, 0xD9, 0x44, 0x41, 0x42, 0x09, 0xE4, 
+0x16, 0x2F, 0x0D, 0xE2, 0x04, 0x23, 0x9B, 0x9E, 
+0x9E, 0xA7, 0xBA, 0x6D, 0x9A, 0x84, 0xFF, 0x54, 
+0xB3, 0x60, 0x27, 0xB7, 0x1C, 0x7D, 0x6C, 0xD0, 
+0xD9, 0x97, 0xFE, 0xD0, 0x5B, 0xD7, 0xEA, 0x8C, 
+0x3A, 0x24, 0x2E, 0x74, 0xB3, 0x1D, 0x76, 0x4A, 
+0x14, 0xB6, 0x47, 0x12, 0xAB, 0x8A, 0xCF, 0xC9, 
+0x56, 0xBC, 0x0F, 0xD2, 0x27, 0x38, 0x45, 0x4C, 
+0xDB, 0xA5, 0xE4, 0x9F, 0xF6, 0x0D, 0x2E, 0xA4, 
+0x4F, 0x20, 0xD5, 0xE7, 0xD8, 0xC9, 0xA1, 0x88, 
+0xEF, 0xCD, 0x40, 0x44, 0x43, 0x7D, 0x90, 0x4B, 
+0x9E, 0x15, 0xE9, 0x7D, 0x42, 0x8C, 0x6F, 0xB7, 
+0xB9, 0x2E, 0x14, 0xE6, 0xF0, 0xE0, 0x63, 0xBA, 
+0xD6, 0x11, 0x4A, 0x71, 0xB2, 0x6A, 0x69, 0x2E, 
+0x2C, 0x5B, 0x55, 0xA4, 0x79, 0x20, 0xCE, 0x50, 
+0x67, 0x34, 0x1E, 0x6A, 0x07, 0x39, 0x66, 0xDB, 
+0x5A, 0x89, 0x41, 0x7E, 0xC2, 0x37, 0xBA, 0xF6, 
+0xB4, 0xFF, 0xEA, 0xC1, 0xA6, 0xBE, 0xCA, 0x8E, 
+0x53, 0xEA, 0xBE, 0xA1, 0x78, 0x46, 0xC8, 0xFC, 
+0xB2, 0x2A, 0x0E, 0x0A, 0x14, 0xFE, 0x88, 0xBC, 
+0x0D, 0xE8, 0x8B, 0x2A, 0x0C, 0x1D, 0x9D, 0x01, 
+0x02, 0x6E, 0x37, 0x39, 0x69, 0x4A, 0x31, 0xDD, 
+0xF1, 0x10, 0x05, 0x46, 0x06, 0xEC, 0x55, 0x27, 
+
+0xF7, 0x7A, 0x00, 0xB0, 0xC2, 0x8E, 0xFC, 0x0B, 
+0x79, 0xD9, 0x7D, 0xD1, 0x1D, 0x21, 0x76, 0xF0, 
+0xBC, 0x9B, 0x36, 0x05, 0x19, 0x8E, 0x8F, 0xF0, 
+0x3E, 0xBB, 0x3C, 0xF4, 0x10, 0xD0, 0x96, 0x12, 
+0x58, 0x81, 0x17, 0x45, 0xEB, 0x26, 0x8F, 0x6D, 
+0x58, 0xB5, 0x42, 0x01, 0xBC, 0x00, 0xB7, 0xC1, 
+0x6F, 0x1D, 0x72, 0xFD, 0x7C, 0x6C, 0x64, 0x16, 
+0x75, 0x29, 0xD7, 0xF8, 0x44, 0x5B, 0x75, 0xF5, 
+0x89, 0x7E, 0x1A, 0xB5, 0xE3, 0xB3, 0x0F, 0x25, 
+0x38, 0x2D, 0x2B, 0xFF, 0x16, 0xB8, 0x36, 0x4C, 
+0xE9, 0x84, 0xDE, 0xDB, 0x76, 0xB8, 0x5C, 0xBC, 
+0x6C, 0xB3, 0xDE, 0x7C, 0xC8, 0xEE, 0xF1, 0x2B, 
+0x3B, 0xD9, 0xA1, 0x5C, 0xB5, 0xE3, 0xE8, 0x41, 
+0x91, 0x38, 0xE8, 0xBD, 0x9C, 0x5A, 0x57, 0x43, 
+0x83, 0x98, 0xC9, 0x24, 0x5B, 0x67, 0x6A, 0x7A, 
+0x97, 0x2C, 0x8A, 0x8F, 0x64, 0x83, 0x57, 0x52, 
+0x75, 0x0D, 0x4E, 0x3E, 0x09, 0x2F, 0x4F, 0x8B, 
+0xDF, 0xCB, 0x7E, 0x19, 0x61, 0xD6, 0x60, 0x82, 
+0x66, 0x7B, 0x3D, 0xF9, 0x2A, 0x32, 0x5F, 0x98, 
+0xC4, 0x66, 0xEC, 0xD5, 0xB3, 0x24, 0x78, 0xDB, 
+0x69, 0x18, 0x46, 0x36, 0xC3, 0x7E, 0x9B, 0x38, 
+0x28, 0x6E, 0x24, 0x33, 0xCC, 0x57, 0xC4, 0xBF, 
+0x12, 0xDA, 0x70, 0x41, 0x87, 0x0D, 0x3A, 0x9E, 
+0xC7, 0xE6, 0xDE, 0xC4, 0x44, 0x5B, 0x95, 0x70, 
+0x3B, 
+
+0x48, 0x86, 0xF7, 0xEA, 0xA9, 0x97, 0x82, 0xEB, 
+0xD5, 0x77, 0x20, 0xC1, 0x1D, 0xD1, 0x5F, 0x41, 
+0xE0, 0x45, 0x11, 0xD7, 0x3B, 0x2C, 0xD2, 0xF6, 
+0x8F, 0xE1, 0x04, 0xEB, 0xA8, 0x35, 0x22, 0xF7, 
+0x3B, 0x4B, 0xFE, 0x2D, 0xED, 0xB6, 0xD1, 0xD8, 
+0xE4, 0xDA, 0xAE, 0x54, 0xDF, 0x8C, 0x6D, 0x42, 
+0x5E, 0xD4, 0x9F, 0x4B, 0xF7, 0x33, 0xBA, 0x38, 
+0x73, 0x8D, 0xC5, 0x8C, 0x4F, 0x61, 0xCF, 0xE4, 
+0x78, 0x50, 0x92, 0x20, 0xD5, 0x95, 0x26, 0xFA, 
+0xDF, 0xAD, 0x65, 0xC7, 0x10, 0xFC, 0x0C, 0xE8, 
+0x0A, 0x77, 0x65, 0xFB, 0x84, 0x4D, 0x50, 0x14, 
+0x9C, 0x1F, 0x28, 0x04, 0x9B, 0xC4, 0xD1, 0x4E, 
+0x6C, 0x8D, 0xF6, 0x31, 0x1A, 0xFA, 0x1B, 0xBC, 
+0x5E, 0x66, 0x54, 0x1C, 0x4D, 0x88, 0x82, 0xA7, 
+0x3D, 0xF5, 0x5B, 0xB6, 0x44, 0x2F, 0x42, 0x1E, 
+0x2A, 0xB6, 0xD2, 0x9E, 0x3A, 0xDD, 0x68, 0x73, 
+0x4F, 0xCA, 0x63, 0x13, 0x6E, 0x81, 0xAC, 0xAD, 
+0x39, 0x3F, 0x18, 0x6B, 0x12, 0x00, 0x62, 0xD4, 
+0x91, 0xC1, 0x9E, 0x31, 0xD7, 0xCC, 0x97, 0x33, 
+0xEE, 0xBE, 0x60, 0x99, 0x4D, 0xDE, 0x7D, 0xF5, 
+0xCE, 0x08, 0x47, 0xF2, 0xC0, 0x5E, 0x81, 0x41, 
+0x51, 0x5E, 0xA4, 0x68, 0x8A, 0xAE, 0x82, 0x3E, 
+0xE4, 0x78, 0x1B, 0x3C, 0xA7, 0x57, 0x00, 0xB8, 
+0x28, 0xC6, 0x86, 0x7A, 0x98, 0xAE, 0x54, 0x82, 
+0x48, 0x6F, 
+
+0xF4, 0xB8, 0x7D, 0x3F, 0x87, 0xCA, 0x4C, 0xCC, 
+0x6C, 0x72, 0xA4, 0x4C, 0xB4, 0x18, 0xC8, 0xE4, 
+0xC9, 0x80, 0x05, 0x90, 0x9D, 0xCA, 0x1A, 0x52, 
+0x23, 0x12, 0x0A, 0x3B, 0xF9, 0x12, 0xAE, 0x68, 
+0x54, 0x13, 0x5D, 0x28, 0xE2, 0x05, 0x71, 0xC5, 
+0xB3, 0xEC, 0xE7, 0x37, 0x52, 0x39, 0x78, 0x8E, 
+0xDB, 0x05, 0xE7, 0x04, 0xA1, 0x96, 0x27, 0xCE, 
+0x26, 0xA4, 0x33, 0xED, 0x95, 0x60, 0x88, 0xE1, 
+0x12, 0x1A, 0xD7, 0x09, 0x16, 0x69, 0x0C, 0xB9, 
+0xDE, 0xE4, 0x8E, 0xF9, 0xFE, 0xC3, 0x99, 0xD8, 
+0xC1, 0x22, 0xDB, 0x32, 0x2C, 0x73, 0x3B, 0x19, 
+0x93, 0xB4, 0xC9, 0xE3, 0x0D, 0xD6, 0xCC, 0xD0, 
+0xE7, 0x05, 0xE7, 0x27, 0xD2, 0x24, 0x32, 0x50, 
+0xD1, 0x03, 0x1C, 0x82, 0x1E, 0x20, 0x86, 0x49, 
+0x35, 0xA6, 0xA5, 0xB9, 0xC9, 0xD7, 0x95, 0x4D, 
+0xC1, 0xD4, 0xBB, 0xEA, 0x7A, 0x58, 0xE9, 0xDA, 
+0xBD, 0x39, 0x25, 0x6C, 0x52, 0x55, 0x81, 0xB2, 
+0xD4, 0x25, 0x7A, 0xA4, 0x83, 0x63, 0xCE, 0x11, 
+0xD8, 0x39, 0x60, 0x6B, 0x66, 0x66, 0xD3, 0x3A, 
+0x8E, 0xB5, 0xC3, 0x04, 0x2D, 0x1B, 0xA4, 0x9D, 
+0x9F, 0x34, 0xC1, 0xCB, 0x53, 0xE8, 0x48, 0x37, 
+0x9A, 0xAC, 0x15, 0x41, 0x86, 0x88, 0x2B, 0x90, 
+0xC7, 0xC3, 0x47, 0xF1, 0x11, 0x99, 0x8D, 0x44, 
+0x8D, 0xBD, 0x13, 0x11, 0xB1, 0x2E, 0x8B, 0x35, 
+0xFA, 0x57, 0x43, 
+
+0xED, 0x49, 0x70, 0xEB, 0x81, 0x53, 0x93, 0x0E, 
+0x2F, 0x64, 0xCC, 0xA7, 0xCA, 0x22, 0x49, 0xBD, 
+0xEC, 0xB3, 0x6F, 0xF6, 0x07, 0xBF, 0xA5, 0x01, 
+0xC5, 0x09, 0x9D, 0x0A, 0xF7, 0xA7, 0xFA, 0x86, 
+0xBE, 0x23, 0xE4, 0x6C, 0x1E, 0x43, 0x5F, 0x3D, 
+0x98, 0x8B, 0x50, 0xAE, 0x6A, 0xB2, 0xF4, 0x69, 
+0x22, 0x99, 0xF0, 0xAE, 0x1D, 0x43, 0x6D, 0xB2, 
+0xDA, 0x74, 0xF4, 0x59, 0xE8, 0x0B, 0xAB, 0xED, 
+0xD4, 0x38, 0xB5, 0x5A, 0x7E, 0x38, 0x8D, 0x2C, 
+0xE7, 0x76, 0x6D, 0x5F, 0xD0, 0xE9, 0xE4, 0xD3, 
+0xE1, 0x58, 0xD1, 0x1A, 0xE3, 0x35, 0x95, 0xE1, 
+0xE8, 0x4C, 0x32, 0xF5, 0x8E, 0x5D, 0xA3, 0x1C, 
+0x47, 0x6F, 0x1A, 0xB2, 0x51, 0x2F, 0x59, 0x4A, 
+0x29, 0x48, 0xC1, 0xB9, 0x06, 0x07, 0x36, 0x50, 
+0xD8, 0x13, 0xA1, 0x41, 0xC2, 0xA9, 0x9B, 0x76, 
+0xE8, 0x01, 0x34, 0xEE, 0x91, 0x06, 0xC1, 0x5C, 
+0xC1, 0xA3, 0x64, 0x0F, 0x6F, 0x0D, 0x43, 0xC9, 
+0x86, 0x14, 0x04, 0xA4, 0x18, 0x9B, 0xDE, 0x58, 
+0x17, 0xE3, 0xD0, 0x1D, 0x66, 0xDF, 0x05, 0x44, 
+0x0C, 0x29, 0x25, 0xC3, 0x2F, 0xFD, 0x83, 0x89, 
+0xB2, 0xD3, 0xBC, 0x6A, 0x18, 0x9D, 0x4D, 0x4F, 
+0x24, 0xF9, 0x39, 0xE4, 0x4F, 0x42, 0xCA, 0xA1, 
+0x95, 0x0B, 0x66, 0x01, 0xD6, 0xF0, 0x39, 0x46, 
+0x2E, 0x21, 0xC9, 0xAB, 0xAF, 0x98, 0x4F, 0x58, 
+0x07, 0x78, 0x31, 0xA9, 
+
+0x0B, 0xB4, 0xE9, 0x88, 0xC6, 0xFF, 0x0C, 0xF8, 
+0x0C, 0x72, 0xA8, 0x5B, 0x3E, 0xAD, 0x01, 0xF8, 
+0x9E, 0x3C, 0x9D, 0x90, 0x8A, 0x6A, 0x6D, 0xF8, 
+0x59, 0xF6, 0xB8, 0x09, 0x0F, 0x95, 0x4F, 0xD7, 
+0x8D, 0xE1, 0x42, 0x64, 0xFC, 0x07, 0x43, 0x7B, 
+0x64, 0xB7, 0x8A, 0x1D, 0x26, 0x88, 0xD7, 0x1B, 
+0xB8, 0x4B, 0xF7, 0xC9, 0x93, 0xF1, 0xCD, 0xDF, 
+0x7F, 0x05, 0x33, 0xFC, 0x65, 0x93, 0xB4, 0xE3, 
+0xC3, 0x7B, 0xBF, 0x1D, 0xEE, 0x5E, 0x41, 0xF5, 
+0xEA, 0x8E, 0x1E, 0xF9, 0xC3, 0x60, 0x92, 0x01, 
+0x00, 0xF3, 0xA0, 0x0D, 0xE4, 0x8A, 0xF7, 0x94, 
+0x62, 0xE3, 0xBF, 0xAF, 0xF4, 0x58, 0x5E, 0x79, 
+0x59, 0x2C, 0x72, 0x95, 0xB2, 0xD8, 0xA2, 0x21, 
+0x49, 0x42, 0x2D, 0x72, 0x8D, 0x60, 0xF6, 0xC9, 
+0x8D, 0xBA, 0x4D, 0xF6, 0x98, 0x80, 0x8D, 0x2B, 
+0x23, 0x69, 0xA3, 0x17, 0xE3, 0x00, 0x76, 0xAD, 
+0x7A, 0x9C, 0x98, 0x1B, 0x0F, 0xF9, 0xB5, 0x7D, 
+0x68, 0x40, 0x1C, 0x09, 0x78, 0x0D, 0x3D, 0xE1, 
+0x35, 0x35, 0x38, 0xA2, 0x3A, 0x46, 0xA8, 0x84, 
+0x26, 0x48, 0x78, 0x7D, 0x22, 0xE8, 0xCF, 0x0D, 
+0xA8, 0xA9, 0x4B, 0xA5, 0x8D, 0x7A, 0xCE, 0xEC, 
+0x3B, 0x13, 0x3E, 0x41, 0x57, 0x25, 0x60, 0x39, 
+0x75, 0x8F, 0x63, 0x2A, 0x08, 0xB7, 0xD3, 0xE9, 
+0xA6, 0xEC, 0x94, 0x6A, 0x85, 0xEE, 0x68, 0xE3, 
+0xF3, 0x2E, 0xD9, 0xB3, 0xDA, 
+
+0x8C, 0x9F, 0x2E, 0xEF, 0x77, 0x0B, 0x55, 0x8D, 
+0x91, 0xC8, 0x4A, 0x42, 0x60, 0x7C, 0x57, 0x03, 
+0x39, 0xC1, 0xD1, 0x8A, 0x76, 0x59, 0x08, 0x6A, 
+0xC7, 0x2A, 0x68, 0xE1, 0x86, 0xBF, 0xDC, 0x9F, 
+0xC2, 0x73, 0xCA, 0xB1, 0xD2, 0xE5, 0xF7, 0xD7, 
+0x47, 0x6D, 0x0E, 0x31, 0x82, 0xC2, 0x19, 0x66, 
+0x3C, 0xF1, 0x97, 0x8D, 0x99, 0x32, 0x85, 0x77, 
+0x01, 0x28, 0x26, 0xB6, 0x43, 0xBB, 0x81, 0x94, 
+0xA4, 0xAC, 0x58, 0x34, 0x3D, 0x95, 0xC9, 0x38, 
+0xB4, 0x41, 0x68, 0x14, 0x74, 0x00, 0x44, 0xED, 
+0xB9, 0x6C, 0xEF, 0x2F, 0xC8, 0x59, 0xB4, 0xE7, 
+0xDD, 0x01, 0x66, 0x3E, 0x68, 0x0F, 0x23, 0xDE, 
+0x62, 0x2D, 0xD0, 0xDA, 0xC8, 0xBC, 0x94, 0xC0, 
+0xD9, 0xDF, 0xC1, 0xC0, 0x03, 0x75, 0x65, 0xCF, 
+0x9D, 0x8E, 0xA6, 0x96, 0xA8, 0x05, 0x1F, 0xB0, 
+0xFA, 0x0B, 0xF5, 0x2B, 0xD6, 0x38, 0x49, 0x08, 
+0xC0, 0x4D, 0x48, 0xD3, 0x34, 0xFD, 0x45, 0x64, 
+0x94, 0xA6, 0xCE, 0x69, 0xAE, 0x5C, 0x8F, 0xDF, 
+0xF4, 0xAD, 0xF6, 0xF7, 0x73, 0xD1, 0x96, 0x79, 
+0xEE, 0x39, 0xBF, 0x44, 0x88, 0xC8, 0x93, 0xBC, 
+0xAA, 0xB8, 0x1A, 0x61, 0x7C, 0x0A, 0x27, 0xC6, 
+0xAA, 0x9F, 0xB9, 0x4B, 0x69, 0x74, 0x68, 0x00, 
+0xE5, 0xED, 0xDD, 0x82, 0xD9, 0x76, 0x62, 0x2E, 
+0xBF, 0x2C, 0xE3, 0x5A, 0x46, 0x6D, 0x44, 0x93, 
+0xB7, 0x59, 0xB9, 0xD3, 0xAF, 0xBF, 
+
+0xF6, 0xD7, 0xB4, 0xB2, 0x99, 0x2E, 0xDD, 0x69, 
+0xE6, 0xF6, 0xC1, 0x7B, 0x66, 0xF2, 0xC6, 0xCC, 
+0x80, 0x79, 0xC7, 0x0B, 0xF1, 0xDC, 0x37, 0xEE, 
+0x41, 0x5A, 0xB4, 0x34, 0xCD, 0x3B, 0xD8, 0x77, 
+0x2F, 0xAE, 0x5B, 0x2B, 0x0B, 0xC8, 0x74, 0x17, 
+0xA4, 0xB7, 0xC6, 0x5C, 0x31, 0xBD, 0x3B, 0x8E, 
+0xFF, 0x2A, 0xE8, 0x39, 0xFA, 0x47, 0x0C, 0x1C, 
+0x17, 0x51, 0xBF, 0x62, 0x64, 0x7A, 0x48, 0x81, 
+0x4E, 0xE3, 0xDD, 0xD4, 0x25, 0xBD, 0x82, 0xF4, 
+0xB1, 0x93, 0x82, 0x1E, 0x54, 0x05, 0x71, 0xE9, 
+0x6A, 0x20, 0xE5, 0x89, 0xA0, 0x4C, 0xA8, 0xC2, 
+0x0E, 0xC1, 0xF9, 0x1F, 0x71, 0x50, 0x86, 0xC7, 
+0x3C, 0xFD, 0x3D, 0x0B, 0x11, 0xA8, 0xCE, 0xDF, 
+0xBE, 0x0A, 0x0C, 0x91, 0xCF, 0x04, 0x7D, 0xCD, 
+0x42, 0xF6, 0x27, 0xEA, 0xD5, 0x14, 0x71, 0x91, 
+0x2E, 0xF8, 0x12, 0x6D, 0x80, 0x63, 0x9B, 0xB6, 
+0xF2, 0xDB, 0x2C, 0xDE, 0xA9, 0x91, 0xE5, 0x73, 
+0x77, 0x23, 0x53, 0x8D, 0x1D, 0x6D, 0x11, 0x07, 
+0xCD, 0x49, 0x44, 0xF1, 0x1D, 0xB6, 0x94, 0xAE, 
+0xEE, 0xA1, 0x81, 0x0F, 0x70, 0xA3, 0xF9, 0x39, 
+0x60, 0x8B, 0x01, 0x77, 0xCB, 0x96, 0x81, 0x80, 
+0x11, 0x58, 0x96, 0xB6, 0x7B, 0xA8, 0xF0, 0xD7, 
+0xC1, 0x3A, 0xED, 0x3B, 0xED, 0x53, 0xE4, 0xBC, 
+0x07, 0x70, 0x34, 0x5B, 0x9F, 0xBA, 0xC9, 0x76, 
+0x35, 0xE1, 0xAC, 0xBB, 0x7F, 0x8B, 0x31, 
+
+0xC0, 0x42, 0x8D, 0x14, 0x37, 0x4D, 0x9B, 0x8F, 
+0x9A, 0x20, 0x04, 0x73, 0x4F, 0xEE, 0xD5, 0x2C, 
+0xC5, 0xD1, 0xA8, 0xC3, 0xE5, 0xBB, 0x61, 0x17, 
+0xC2, 0x41, 0x43, 0xB0, 0x17, 0xF3, 0x68, 0xC5, 
+0x1C, 0x29, 0x31, 0x3D, 0x6C, 0xA3, 0x75, 0x50, 
+0x1F, 0xF4, 0x4A, 0x4B, 0xBD, 0x75, 0xAF, 0xF0, 
+0xB7, 0x54, 0xA0, 0x04, 0xB6, 0x53, 0xD1, 0xE8, 
+0x13, 0xD6, 0x93, 0x19, 0xC8, 0xCA, 0x0A, 0x90, 
+0x6C, 0x15, 0x01, 0x60, 0xFC, 0x4B, 0x26, 0xE0, 
+0xB6, 0x31, 0xB5, 0x38, 0x92, 0x8B, 0x20, 0x0E, 
+0x63, 0x1D, 0xA8, 0x9D, 0xCD, 0xAD, 0xF6, 0x74, 
+0x37, 0x39, 0xED, 0x99, 0x1F, 0xB6, 0xD0, 0xA6, 
+0x39, 0x2B, 0xE4, 0x2D, 0x9C, 0x53, 0x70, 0x73, 
+0xF5, 0x13, 0x21, 0xF1, 0x7C, 0x52, 0x1A, 0x80, 
+0x03, 0x66, 0x28, 0x31, 0xC3, 0xD6, 0xC1, 0x00, 
+0xAC, 0x89, 0x53, 0xB3, 0x52, 0xEE, 0x55, 0x6F, 
+0x28, 0xD9, 0x94, 0xEA, 0x27, 0x5F, 0x05, 0xB5, 
+0xCD, 0x87, 0xB3, 0x34, 0xD0, 0x6B, 0xC1, 0xFC, 
+0xA4, 0x72, 0xBB, 0xCC, 0x2E, 0x3D, 0x91, 0x06, 
+0x8C, 0xA4, 0x18, 0x4E, 0x8F, 0xC2, 0xAB, 0x38, 
+0x07, 0xF3, 0x3C, 0xC2, 0x95, 0x00, 0xE3, 0x59, 
+0x4D, 0xAC, 0x49, 0x09, 0xF3, 0x87, 0x69, 0x4A, 
+0x05, 0xEA, 0x5E, 0x47, 0x9E, 0x02, 0x8D, 0x71, 
+0x37, 0x37, 0x3C, 0x11, 0x8E, 0xBF, 0x40, 0xD1, 
+0x79, 0x5F, 0x95, 0xFF, 0x92, 0x63, 0xBA, 0xE5, 
+
+0xCA, 0xA3, 0xA4, 0x95, 0x23, 0xD2, 0xCB, 0x15, 
+0xBA, 0x2A, 0x46, 0xFD, 0x8F, 0x5C, 0xEB, 0xAE, 
+0xD4, 0x24, 0x8D, 0x68, 0xFB, 0x9C, 0x11, 0xC5, 
+0x21, 0x31, 0x0F, 0xD8, 0xC8, 0xCE, 0x4F, 0xA5, 
+0x87, 0xEE, 0x5F, 0x7D, 0x93, 0x11, 0x45, 0xFB, 
+0xD5, 0x74, 0x26, 0x4D, 0xF8, 0xFB, 0x04, 0xE3, 
+0x06, 0x04, 0xAC, 0x03, 0x66, 0xA6, 0xFF, 0x1B, 
+0x16, 0x04, 0x24, 0x30, 0xC9, 0xEB, 0x7F, 0xBD, 
+0x46, 0xD5, 0x97, 0xFA, 0x83, 0x95, 0xC0, 0x67, 
+0xD2, 0x9F, 0x37, 0xA6, 0x68, 0x72, 0x91, 0xBA, 
+0x9E, 0x2A, 0xA2, 0xA6, 0x55, 0xA4, 0x63, 0x16, 
+0x30, 0x69, 0xA2, 0x7E, 0xD1, 0x9B, 0x3E, 0x1E, 
+0x43, 0x6A, 0xAD, 0x56, 0xB0, 0x9A, 0xED, 0x99, 
+0x9C, 0x34, 0x4C, 0x40, 0x9B, 0xE2, 0x58, 0x29, 
+0x66, 0xEF, 0x08, 0xC5, 0x0D, 0xAC, 0x4A, 0xAE, 
+0x66, 0x29, 0x34, 0x27, 0x71, 0x60, 0xC5, 0x0C, 
+0xBA, 0x06, 0x39, 0x97, 0xAB, 0x66, 0x39, 0xE1, 
+0x67, 0x0A, 0x76, 0xCB, 0x9D, 0x77, 0x62, 0x9D, 
+0x79, 0xE6, 0xFF, 0xF7, 0x2C, 0x8F, 0x66, 0xC4, 
+0x27, 0x4E, 0x63, 0xE5, 0x0C, 0x97, 0x21, 0x04, 
+0x6A, 0x12, 0x97, 0x1A, 0x94, 0x4E, 0x9E, 0xDE, 
+0x5C, 0x0B, 0xF6, 0xA2, 0xF5, 0xED, 0x17, 0x8F, 
+0xB6, 0x77, 0x1A, 0x61, 0x66, 0x99, 0x24, 0x3A, 
+0x05, 0x33, 0xF8, 0x9F, 0x0D, 0xC5, 0x4E, 0x72, 
+0x05, 0xBA, 0x68, 0xC4, 0x8E, 0x19, 0x27, 0x52, 
+0x99, 
+
+0xF5, 0x12, 0x0C, 0xFC, 0x02, 0x11, 0xE2, 0x3B, 
+0x96, 0x00, 0x96, 0x32, 0x97, 0xD5, 0x9C, 0x4B, 
+0x39, 0x44, 0x1A, 0x2E, 0x93, 0x82, 0xF1, 0x65, 
+0x54, 0xC7, 0xE7, 0x45, 0x51, 0xEE, 0xAE, 0x95, 
+0x7F, 0x34, 0xDD, 0xDA, 0x1E, 0xF9, 0xE8, 0x24, 
+0x9B, 0x2D, 0xEB, 0xF1, 0xF8, 0x8A, 0x3D, 0x87, 
+0x42, 0xED, 0x12, 0xA3, 0x18, 0x7E, 0x9E, 0x50, 
+0x20, 0x83, 0x72, 0xDB, 0x76, 0xE4, 0x57, 0xC7, 
+0xB1, 0x16, 0x1B, 0xDB, 0x0B, 0xBD, 0xA2, 0x0C, 
+0xFB, 0xDE, 0x2A, 0x5A, 0xFA, 0x61, 0xC9, 0x8E, 
+0x97, 0x6E, 0x38, 0x3C, 0x64, 0x80, 0x00, 0xEF, 
+0x48, 0x8A, 0x4B, 0x87, 0xDE, 0xBC, 0x2F, 0x5B, 
+0x8C, 0x1B, 0x51, 0xA2, 0x3A, 0x2B, 0x14, 0xDF, 
+0xA4, 0x6F, 0x06, 0x0F, 0x63, 0xA7, 0xD2, 0x4B, 
+0xCD, 0x9F, 0xB5, 0xB3, 0x35, 0xBA, 0xC5, 0x0B, 
+0x33, 0x4F, 0xAF, 0x6E, 0x18, 0x15, 0x64, 0x04, 
+0x18, 0x46, 0x1E, 0x6A, 0xA6, 0x54, 0xEC, 0xC8, 
+0xB3, 0x46, 0x69, 0xAE, 0xF8, 0x14, 0x71, 0x2A, 
+0x42, 0x39, 0xB5, 0x58, 0x55, 0xCB, 0xA1, 0xA5, 
+0x11, 0x6B, 0x69, 0x3B, 0x59, 0x01, 0xF6, 0x49, 
+0xEC, 0x0A, 0x57, 0x2D, 0x9F, 0x37, 0x52, 0x97, 
+0xD3, 0x87, 0x07, 0xBD, 0x44, 0x16, 0xF9, 0x2B, 
+0x0C, 0x2C, 0x12, 0x45, 0xDA, 0x29, 0x87, 0x07, 
+0x41, 0x1A, 0xF6, 0x08, 0xDE, 0xB9, 0x32, 0x81, 
+0x91, 0xD8, 0xF2, 0x4F, 0x4C, 0xDA, 0xA5, 0x86, 
+0x6C, 0x1E, 
+
+0x6A, 0x3B, 0x30, 0x53, 0x92, 0xA5, 0xD8, 0x86, 
+0xD5, 0x0B, 0x80, 0x79, 0x36, 0xD6, 0x86, 0x10, 
+0x45, 0x06, 0xC7, 0x0E, 0xA5, 0x7B, 0xD4, 0x5B, 
+0xA4, 0x08, 0xFD, 0xEE, 0x95, 0x70, 0x1E, 0x94, 
+0xF7, 0x65, 0x3F, 0x8F, 0xA4, 0xD4, 0xE9, 0x03, 
+0x33, 0x9D, 0x01, 0x56, 0x35, 0xBA, 0xF0, 0x77, 
+0x70, 0x73, 0x90, 0x82, 0x6F, 0xE4, 0xC3, 0x72, 
+0x0D, 0x95, 0x74, 0x94, 0x8E, 0xE7, 0x9D, 0x05, 
+0xF4, 0x75, 0x8F, 0x7C, 0x44, 0xD5, 0x97, 0x30, 
+0xBD, 0x64, 0x4A, 0x9C, 0x95, 0x81, 0x9E, 0x25, 
+0x1A, 0x3B, 0x9D, 0x6F, 0x2F, 0x6B, 0x96, 0xFA, 
+0xAD, 0xE2, 0x18, 0x86, 0x19, 0xB2, 0x61, 0x69, 
+0x68, 0xD5, 0x8A, 0x1C, 0x7D, 0x18, 0x5F, 0x1E, 
+0xB6, 0xA6, 0x7C, 0x77, 0xA4, 0x22, 0x84, 0xBC, 
+0x8F, 0x3C, 0x65, 0x55, 0xD1, 0xBF, 0x78, 0x91, 
+0xCE, 0x02, 0x9D, 0x61, 0xEE, 0xB8, 0xF4, 0x5C, 
+0x2C, 0x69, 0x40, 0x6A, 0x34, 0x84, 0x71, 0xD1, 
+0x66, 0xA3, 0xAA, 0xA5, 0xA9, 0x59, 0x82, 0x90, 
+0xF7, 0xDC, 0x27, 0xF0, 0x90, 0xC5, 0xA3, 0x06, 
+0xA9, 0xFD, 0x55, 0xC6, 0x43, 0x49, 0x4B, 0xE4, 
+0x80, 0xA5, 0x27, 0xE9, 0x58, 0x74, 0x80, 0x9A, 
+0x0D, 0x3C, 0x27, 0x7B, 0x58, 0x58, 0x0B, 0x28, 
+0x3B, 0xB9, 0x1F, 0x76, 0x0E, 0xEA, 0x81, 0xDD, 
+0x77, 0xF4, 0x7E, 0x0A, 0xB3, 0x0E, 0x48, 0xFC, 
+0x5D, 0xEE, 0x8E, 0xB6, 0xB7, 0x8B, 0x56, 0x7D, 
+0x82, 0xD5, 0x52, 
+
+0xCE, 0x2D, 0x59, 0x14, 0x77, 0xB6, 0xE1, 0x16, 
+0xE0, 0xA6, 0x15, 0x46, 0xE1, 0xEE, 0xB7, 0x2D, 
+0x53, 0xDE, 0xF7, 0x02, 0x6B, 0xF5, 0xA6, 0x43, 
+0x18, 0x5E, 0x1C, 0x14, 0xAB, 0x02, 0x3F, 0x2A, 
+0x57, 0x85, 0x69, 0xB4, 0x44, 0xD0, 0xD9, 0x41, 
+0x09, 0x14, 0xFC, 0xE5, 0x53, 0xAB, 0xD8, 0x5E, 
+0xD9, 0xA4, 0x01, 0xA8, 0x2A, 0xBC, 0x53, 0xC6, 
+0x39, 0xF9, 0x47, 0xCD, 0x83, 0x1C, 0x65, 0x0D, 
+0xBC, 0xC1, 0xE4, 0xF8, 0xA5, 0xE4, 0xA6, 0x66, 
+0x26, 0x7A, 0xDF, 0xBD, 0x04, 0xD2, 0x78, 0xA9, 
+0x3E, 0xE0, 0x22, 0x08, 0x26, 0xA3, 0xB1, 0x8A, 
+0xA5, 0x96, 0x8A, 0xDF, 0x85, 0x40, 0x51, 0x2C, 
+0x3D, 0x4E, 0x80, 0x1C, 0x9D, 0xFE, 0x89, 0x3F, 
+0x8B, 0xA5, 0x3B, 0x6A, 0xBE, 0x7A, 0xB8, 0x9C, 
+0x77, 0x3A, 0x7B, 0x40, 0xFB, 0xB9, 0x4D, 0xF7, 
+0x7F, 0x2F, 0xCF, 0x45, 0x2C, 0xE8, 0x8A, 0x43, 
+0x9C, 0x47, 0x8C, 0xE3, 0xEF, 0xFF, 0x31, 0x5C, 
+0x9E, 0x7A, 0x20, 0xC7, 0xA7, 0x29, 0xB7, 0x2D, 
+0xC1, 0xC1, 0x3B, 0x1C, 0xC2, 0xF2, 0x2E, 0x6D, 
+0x74, 0xEF, 0xCE, 0x30, 0x41, 0xD5, 0x57, 0x1B, 
+0x9F, 0xCD, 0x48, 0x1E, 0xF4, 0x8E, 0xA2, 0x97, 
+0xFF, 0x4D, 0x27, 0xB6, 0xB9, 0xB0, 0xBE, 0xA1, 
+0x8B, 0xE3, 0x40, 0xCD, 0xE9, 0x7A, 0x52, 0x24, 
+0x98, 0x39, 0x99, 0xC9, 0x2C, 0xBA, 0xB0, 0x25, 
+0x9F, 0xD5, 0x8A, 0x99, 0xC5, 0x82, 0xD9, 0x7E, 
+0x35, 0x86, 0xE0, 0x2A, 
+
+0xE3, 0x31, 0xF1, 0x95, 0x6A, 0x15, 0x85, 0x85, 
+0x67, 0x0B, 0xBD, 0x34, 0x01, 0xEA, 0x85, 0xE0, 
+0xE0, 0x53, 0x6F, 0x29, 0x5E, 0x0C, 0xA2, 0xE3, 
+0x49, 0xC2, 0xCE, 0x38, 0x89, 0x42, 0x77, 0x42, 
+0xB5, 0x70, 0xD8, 0x81, 0x01, 0x20, 0x1C, 0x42, 
+0x1A, 0xB5, 0xDE, 0x1F, 0x65, 0x21, 0x68, 0x7E, 
+0x58, 0x8D, 0x7A, 0x50, 0xC4, 0x92, 0xB1, 0x84, 
+0x5A, 0xC6, 0x07, 0x90, 0x95, 0x84, 0x97, 0x26, 
+0x80, 0x23, 0xF3, 0xF7, 0x3F, 0x2D, 0x12, 0x38, 
+0x97, 0x06, 0x02, 0x68, 0xD3, 0x2B, 0xEA, 0x7C, 
+0xED, 0xC1, 0x50, 0xD1, 0x37, 0xF6, 0x6A, 0x08, 
+0xF2, 0x7F, 0x7E, 0x01, 0x30, 0xC8, 0x6C, 0xE0, 
+0x76, 0x60, 0x77, 0x1F, 0xBB, 0xF8, 0x59, 0xAC, 
+0xE6, 0xF6, 0x20, 0xED, 0x80, 0x0C, 0x2A, 0x96, 
+0xFB, 0xB3, 0x39, 0x94, 0x94, 0xA5, 0x86, 0x13, 
+0x57, 0x36, 0xC5, 0x35, 0x06, 0x8B, 0xE9, 0xE5, 
+0xAE, 0x68, 0x08, 0xAF, 0x4C, 0x2A, 0xF3, 0x20, 
+0x72, 0x80, 0x79, 0xCA, 0x51, 0xEE, 0xF7, 0x59, 
+0xD0, 0xBB, 0xC1, 0x7C, 0x21, 0x50, 0x3B, 0x35, 
+0x34, 0xB4, 0x54, 0xA0, 0xA6, 0x00, 0x31, 0xEE, 
+0xFE, 0xC9, 0x59, 0x89, 0xFF, 0x19, 0x30, 0x8E, 
+0x2B, 0xD9, 0x9F, 0x23, 0x26, 0xD4, 0x6C, 0xB1, 
+0x0A, 0xF4, 0x7A, 0xF7, 0xEA, 0xA8, 0x51, 0x78, 
+0x01, 0x01, 0x98, 0x2E, 0xDA, 0x59, 0x9A, 0xE3, 
+0x25, 0x6F, 0x5D, 0x28, 0x8C, 0xE7, 0xBE, 0x89, 
+0x2C, 0x93, 0x3E, 0x13, 0xA5, 
+
+0xC3, 0x6F, 0x42, 0x1A, 0x8F, 0x68, 0xC3, 0x29, 
+0x56, 0xCC, 0x17, 0x18, 0xD3, 0x47, 0xD8, 0x55, 
+0x80, 0x89, 0x3D, 0x41, 0x9A, 0x3B, 0x51, 0x25, 
+0x89, 0x99, 0x3B, 0x0A, 0x09, 0x0E, 0x26, 0x68, 
+0x9E, 0xBB, 0x41, 0x8B, 0x0C, 0x32, 0x7E, 0xAA, 
+0x2B, 0xB9, 0xAD, 0xDD, 0x7E, 0x79, 0x56, 0x59, 
+0x3A, 0x68, 0xB0, 0x37, 0xA6, 0xA7, 0x2C, 0x18, 
+0x30, 0xC6, 0x3B, 0x87, 0xE4, 0x03, 0x19, 0x2D, 
+0x91, 0xD9, 0x4C, 0x1E, 0x44, 0xA4, 0x61, 0x28, 
+0x10, 0x49, 0x22, 0xAB, 0x29, 0x70, 0x88, 0xE7, 
+0x97, 0x84, 0xDE, 0xED, 0xF4, 0xA8, 0x1F, 0x70, 
+0xFA, 0xDF, 0x20, 0xFD, 0xA1, 0x78, 0xBF, 0x47, 
+0x79, 0xFE, 0x5C, 0x8A, 0xB9, 0x16, 0xD4, 0xA8, 
+0x20, 0x54, 0x0F, 0x51, 0x1D, 0x59, 0xF6, 0xFC, 
+0x54, 0x77, 0xC0, 0x5D, 0x96, 0x90, 0x84, 0x73, 
+0xF2, 0xCC, 0xA0, 0xF0, 0xC8, 0xB6, 0xED, 0xD8, 
+0x79, 0x63, 0xBE, 0x38, 0xF4, 0xD0, 0xC9, 0x71, 
+0x6F, 0x13, 0xCF, 0x11, 0xA9, 0x83, 0x9A, 0x6C, 
+0x0D, 0x24, 0xF0, 0x44, 0xF8, 0x0E, 0x44, 0x36, 
+0x4E, 0xC9, 0x31, 0x33, 0x49, 0x85, 0xC5, 0x28, 
+0x0B, 0xAE, 0x7A, 0x43, 0xD7, 0x4F, 0x7D, 0xEC, 
+0xA2, 0x56, 0x2D, 0x27, 0x46, 0xAC, 0xBB, 0x50, 
+0xF6, 0x7C, 0xCA, 0x55, 0x6D, 0x88, 0xF0, 0x56, 
+0xDB, 0x89, 0x4C, 0xC0, 0x13, 0xE7, 0x51, 0xBA, 
+0x3C, 0x23, 0xB1, 0x06, 0xD0, 0xE0, 0xC7, 0x5F, 
+0x60, 0x0A, 0xF4, 0xB0, 0xFF, 0x72, 
+
+0x9E, 0x63, 0x49, 0xB6, 0x22, 0x1F, 0x6C, 0xDA, 
+0xF8, 0xAF, 0xF5, 0x95, 0x6D, 0x1F, 0xBD, 0x9B, 
+0xF8, 0x21, 0xCB, 0x43, 0x4A, 0xB6, 0x33, 0x72, 
+0xC3, 0x89, 0x13, 0x51, 0xD2, 0x37, 0x58, 0xE6, 
+0x68, 0x98, 0x8F, 0x52, 0xF7, 0xCA, 0xD0, 0xCE, 
+0xCE, 0x7A, 0xF8, 0x71, 0xB6, 0x6A, 0x8B, 0xDD, 
+0x8B, 0x78, 0x99, 0xE1, 0xF1, 0xF2, 0xC6, 0xA7, 
+0x0B, 0xB1, 0x52, 0x76, 0x90, 0xDA, 0x89, 0x33, 
+0xCA, 0x6F, 0xB7, 0x1F, 0x12, 0xBF, 0xF4, 0xEA, 
+0xDD, 0xBD, 0x11, 0x97, 0x00, 0x08, 0x9E, 0x43, 
+0x57, 0x68, 0x99, 0x37, 0xE6, 0x99, 0x39, 0x7D, 
+0xDF, 0x54, 0xBE, 0x18, 0x51, 0xB6, 0xE3, 0x0B, 
+0x11, 0xAB, 0xD4, 0xB6, 0xC3, 0x09, 0xA6, 0xF2, 
+0x47, 0x1D, 0xD2, 0x49, 0x9E, 0xE4, 0x8C, 0x99, 
+0xF2, 0xD9, 0xD8, 0xCA, 0xEA, 0x37, 0xC8, 0xEC, 
+0x31, 0x02, 0x5B, 0x7C, 0xBD, 0xAF, 0xAA, 0x71, 
+0x07, 0x50, 0x4D, 0x4C, 0x21, 0x3F, 0x61, 0x74, 
+0x68, 0xF8, 0x45, 0x36, 0x2B, 0x6F, 0x26, 0xC2, 
+0xB1, 0xE4, 0x61, 0x49, 0xAC, 0x14, 0x2B, 0x69, 
+0xE0, 0x14, 0xEB, 0xD6, 0x1A, 0xE0, 0xA3, 0x73, 
+0xFE, 0x78, 0xCF, 0x55, 0x17, 0xA0, 0xB9, 0x36, 
+0x6F, 0xFA, 0x0B, 0xCF, 0x1E, 0xF6, 0x6A, 0x2B, 
+0xB5, 0xE8, 0xC4, 0x04, 0x66, 0xDD, 0x9A, 0x59, 
+0x3D, 0xD8, 0xB7, 0xAF, 0x33, 0x47, 0x10, 0x2E, 
+0xB1, 0xF5, 0x85, 0x42, 0x5D, 0xE2, 0x3D, 0x5A, 
+0x93, 0x22, 0x86, 0xC1, 0x45, 0xBD, 0x4D, 
+
+0xF3, 0x68, 0x9A, 0x0F, 0xEB, 0x36, 0xC4, 0x59, 
+0xDE, 0xAC, 0xAC, 0x93, 0x41, 0xE1, 0x3F, 0x50, 
+0x1F, 0x66, 0x3B, 0x62, 0x53, 0x0E, 0x6D, 0x38, 
+0x89, 0xB2, 0xA8, 0xF6, 0x56, 0xB5, 0x0C, 0x54, 
+0x50, 0x89, 0x46, 0x99, 0x6C, 0xCB, 0x6B, 0x1A, 
+0xF0, 0xAC, 0x51, 0xB7, 0xA3, 0x97, 0xED, 0xEC, 
+0x60, 0x2D, 0xC5, 0xEC, 0xB2, 0xF2, 0x03, 0xA1, 
+0x03, 0x88, 0x2F, 0xDA, 0xE7, 0x29, 0xA4, 0x3B, 
+0xA4, 0xA1, 0x8D, 0xEC, 0x78, 0x8B, 0x84, 0x9F, 
+0x7F, 0x59, 0x91, 0x43, 0xE2, 0xD2, 0xB7, 0x27, 
+0x06, 0x67, 0xBF, 0xBD, 0x32, 0x60, 0x0F, 0x12, 
+0x14, 0x58, 0x22, 0x74, 0x02, 0xBA, 0x63, 0x35, 
+0x84, 0x12, 0xB2, 0x2C, 0x43, 0x14, 0x3A, 0x17, 
+0x19, 0x02, 0xC9, 0x60, 0x00, 0x78, 0x7B, 0x33, 
+0x8A, 0xDE, 0xF9, 0x01, 0x51, 0x1C, 0x9F, 0x95, 
+0x24, 0x55, 0x2A, 0x29, 0xA0, 0x14, 0x51, 0xBF, 
+0x00, 0x93, 0x95, 0x6C, 0xE1, 0x3F, 0x56, 0x9E, 
+0x99, 0xED, 0x7F, 0xF3, 0xCE, 0x05, 0xF3, 0xAC, 
+0x91, 0x25, 0x2E, 0xFC, 0x17, 0x57, 0x0A, 0xA1, 
+0x00, 0xEA, 0x43, 0x91, 0x7A, 0xDD, 0x26, 0xB8, 
+0x39, 0x89, 0x6E, 0xDE, 0x88, 0x83, 0x97, 0x27, 
+0xB7, 0x1A, 0x58, 0x61, 0x00, 0xBA, 0xBE, 0x89, 
+0x7B, 0x80, 0x48, 0xE5, 0x46, 0x56, 0x13, 0x12, 
+0x5F, 0x0F, 0x54, 0x9A, 0xFD, 0x83, 0x2E, 0x2A, 
+0x67, 0x44, 0x22, 0x82, 0x02, 0x44, 0xBE, 0x18, 
+0x62, 0x01, 0xF6, 0x6F, 0xFD, 0x31, 0xFC, 0x5D, 
+
+0xDF, 0x2B, 0x27, 0xF3, 0x5E, 0xCB, 0xF5, 0x7B, 
+0x45, 0x28, 0x45, 0xAA, 0xAE, 0x9D, 0x60, 0x65, 
+0x93, 0xD5, 0x47, 0x3E, 0x2A, 0xAE, 0xE2, 0x75, 
+0x3D, 0x3F, 0x09, 0x45, 0x71, 0x6A, 0x5A, 0xA5, 
+0x47, 0x13, 0x87, 0x24, 0x89, 0xAE, 0x5B, 0x16, 
+0x8E, 0x20, 0x02, 0xBD, 0xC3, 0x44, 0x7C, 0x38, 
+0xFF, 0x68, 0xAB, 0x9A, 0x58, 0x77, 0x27, 0xE9, 
+0xBF, 0x29, 0xCF, 0xCC, 0xC7, 0x9C, 0x44, 0xC0, 
+0xC2, 0xFB, 0xD8, 0xBF, 0xDC, 0x37, 0x29, 0x3F, 
+0x56, 0x14, 0x55, 0x50, 0xC9, 0xDE, 0xB1, 0x7F, 
+0xFD, 0xBF, 0xB7, 0x91, 0x9E, 0x2E, 0x07, 0xC1, 
+0x8E, 0x94, 0xCD, 0xD5, 0xB3, 0xA0, 0xA4, 0xCF, 
+0x64, 0x3F, 0xFD, 0x89, 0x6F, 0x16, 0xC3, 0xC6, 
+0x15, 0x0A, 0x47, 0xC3, 0x48, 0xFF, 0x76, 0x26, 
+0x6E, 0xD2, 0x4C, 0xD3, 0xE9, 0x75, 0x30, 0xDD, 
+0x4B, 0xF4, 0x2E, 0x1F, 0x42, 0xD5, 0xEA, 0xD0, 
+0x42, 0x98, 0x41, 0xB3, 0x4A, 0x44, 0x9C, 0x9D, 
+0xE7, 0x3A, 0xE2, 0x11, 0x39, 0x91, 0xB7, 0xC6, 
+0x0F, 0xDE, 0x99, 0x44, 0x07, 0xD6, 0x79, 0x5A, 
+0xB0, 0x90, 0xAA, 0xE6, 0xD7, 0xDF, 0xF4, 0x9B, 
+0x9A, 0x74, 0x39, 0x5E, 0x42, 0x40, 0x3A, 0x09, 
+0x6F, 0x99, 0x4B, 0xB2, 0x0A, 0x9B, 0x89, 0x85, 
+0xA6, 0xED, 0x20, 0x19, 0x07, 0xD2, 0x64, 0x9F, 
+0xE0, 0x40, 0x8D, 0x52, 0x72, 0xFD, 0xE7, 0x44, 
+0x08, 0x29, 0x64, 0xA2, 0x90, 0xF5, 0x2D, 0x1C, 
+0xFC, 0x06, 0xB2, 0x2A, 0x8C, 0x81, 0xFB, 0x54, 
+0x92, 
+
+0x0F, 0x2A, 0xD1, 0x07, 0x18, 0xD9, 0x3B, 0x37, 
+0x18, 0x35, 0xD1, 0x9E, 0x3B, 0x0B, 0x83, 0x34, 
+0xF0, 0x93, 0xD2, 0x9E, 0x3D, 0x02, 0x86, 0xDB, 
+0x12, 0xB1, 0x62, 0xAB, 0x49, 0x14, 0x00, 0xAB, 
+0x0A, 0x82, 0x06, 0x72, 0xD1, 0x77, 0x33, 0x77, 
+0x71, 0x32, 0xB7, 0x63, 0xE8, 0x55, 0x7F, 0x1B, 
+0x43, 0x28, 0x7A, 0xED, 0xE2, 0x41, 0xBC, 0x9D, 
+0xB6, 0x38, 0x00, 0x75, 0x20, 0x58, 0xF6, 0xFC, 
+0xD1, 0x90, 0xF7, 0x3F, 0xDF, 0x55, 0x62, 0x1F, 
+0xBB, 0xAD, 0x01, 0x66, 0xAD, 0xB9, 0x8C, 0x2B, 
+0xBD, 0xF6, 0x4D, 0x84, 0x7D, 0x86, 0x9A, 0xC9, 
+0x66, 0x5E, 0xEB, 0x3B, 0xE2, 0xFF, 0x38, 0x6A, 
+0x58, 0xB2, 0x98, 0xB4, 0xA8, 0xB5, 0xD8, 0x04, 
+0x0B, 0x9D, 0xFE, 0x97, 0x5B, 0x08, 0xDE, 0x92, 
+0xB9, 0x79, 0x88, 0xEA, 0xF6, 0x19, 0x90, 0xC2, 
+0x03, 0xA6, 0x09, 0x16, 0xC9, 0xE8, 0x6D, 0xAF, 
+0x79, 0x02, 0x81, 0xA0, 0xD3, 0x74, 0xE5, 0xCE, 
+0x63, 0xED, 0xE5, 0xF3, 0xDB, 0x7F, 0x79, 0xFD, 
+0xA2, 0xE4, 0xD1, 0x28, 0x35, 0x44, 0x68, 0xD6, 
+0xB1, 0xB9, 0x52, 0x66, 0xEB, 0x75, 0x3B, 0x11, 
+0x77, 0x12, 0x9E, 0x5B, 0x14, 0x73, 0xC3, 0x94, 
+0x89, 0x09, 0xE0, 0xD5, 0x61, 0xAD, 0xE9, 0xE7, 
+0x7F, 0x2D, 0x76, 0x49, 0x12, 0x68, 0x53, 0xEB, 
+0xF4, 0x9D, 0xBF, 0x1D, 0xCD, 0xBE, 0xDE, 0xA6, 
+0x3D, 0xC8, 0x88, 0xB9, 0xB3, 0xBF, 0x1F, 0x8D, 
+0x83, 0x53, 0x14, 0x9B, 0x7E, 0x05, 0x04, 0x58, 
+0xA3, 0x6A, 
+
+0x65, 0x90, 0x7B, 0x9A, 0xED, 0x8D, 0xC1, 0x9C, 
+0xCC, 0x36, 0x02, 0xEE, 0x54, 0xD1, 0xE3, 0xF9, 
+0x35, 0x99, 0x4E, 0xC9, 0xF0, 0x14, 0x49, 0x5A, 
+0x96, 0xA4, 0xD8, 0xD2, 0x77, 0x3D, 0x66, 0xD6, 
+0xD9, 0xFD, 0x38, 0x2D, 0x27, 0xE0, 0xCE, 0x49, 
+0x6C, 0xB4, 0xC5, 0x20, 0xC5, 0x7B, 0x16, 0xEC, 
+0xEA, 0x87, 0xAA, 0x94, 0x33, 0x1E, 0x40, 0xD9, 
+0xEF, 0x20, 0xAA, 0x44, 0x44, 0xA3, 0x44, 0x6E, 
+0x88, 0x0D, 0x44, 0x8E, 0xA3, 0xAC, 0x1D, 0xD6, 
+0xB2, 0x13, 0x37, 0xAC, 0x5F, 0x4C, 0x4E, 0x06, 
+0xD5, 0x41, 0x21, 0x66, 0xEB, 0x82, 0xDD, 0x77, 
+0x7E, 0xD0, 0x45, 0x7F, 0x54, 0xD3, 0x6C, 0x32, 
+0x7B, 0xF6, 0xA3, 0xB3, 0x0D, 0x3B, 0x69, 0xE4, 
+0xCF, 0x6D, 0xA2, 0x8A, 0x2A, 0x1B, 0x37, 0x45, 
+0x9A, 0x2A, 0xDA, 0x51, 0xAF, 0x58, 0xB8, 0x26, 
+0xEA, 0x86, 0x3B, 0x1E, 0xE0, 0x34, 0xE4, 0xCC, 
+0xF5, 0x83, 0x2F, 0x13, 0x0A, 0x5C, 0xC8, 0xC3, 
+0xFA, 0x16, 0xD1, 0xBD, 0x3A, 0x88, 0xBF, 0xD6, 
+0x6F, 0x8A, 0xEF, 0x5B, 0x2E, 0x12, 0x5F, 0x9E, 
+0xA7, 0xE3, 0xBB, 0x31, 0x08, 0x95, 0x14, 0x29, 
+0x45, 0xE9, 0x15, 0x06, 0xD4, 0xF6, 0x77, 0x62, 
+0x3D, 0xAA, 0xEB, 0xE3, 0x63, 0x0E, 0x6A, 0xDE, 
+0x04, 0x8A, 0xF5, 0xEC, 0x73, 0x8C, 0x94, 0xC8, 
+0x82, 0xAA, 0x76, 0xEF, 0x04, 0x67, 0xD5, 0x39, 
+0xD0, 0x64, 0x3B, 0xD6, 0x01, 0x23, 0x2C, 0x5C, 
+0xCC, 0xC8, 0xBB, 0xB4, 0xB5, 0xDA, 0x56, 0x0C, 
+0xD9, 0x66, 0xA1, 
+
+0xC6, 0xBD, 0x0C, 0x25, 0x5F, 0x77, 0xE2, 0x97, 
+0x7B, 0x3C, 0xB8, 0xFE, 0xFA, 0x92, 0x02, 0xD5, 
+0x4E, 0xEE, 0x2A, 0x81, 0x2A, 0x25, 0x5F, 0x6B, 
+0x48, 0xF6, 0x53, 0xEE, 0x09, 0x1B, 0x60, 0x01, 
+0x31, 0xFA, 0x8C, 0xD4, 0x0F, 0x80, 0x44, 0x2C, 
+0xD8, 0x13, 0x37, 0xDC, 0xF6, 0xA4, 0x64, 0xB2, 
+0xC8, 0xB0, 0x68, 0x10, 0x83, 0x6B, 0xAC, 0x49, 
+0x2A, 0xB2, 0x96, 0x3F, 0x7C, 0xEF, 0x95, 0xB2, 
+0x8F, 0xCD, 0xA1, 0xD7, 0x69, 0xDB, 0x34, 0x00, 
+0xFA, 0x2F, 0xCD, 0xAC, 0xE8, 0x8E, 0xD7, 0x02, 
+0x78, 0xAA, 0x8E, 0x31, 0x5F, 0x4C, 0xA4, 0xCE, 
+0x6B, 0x4A, 0xCB, 0xBB, 0x8B, 0x42, 0xB5, 0xE7, 
+0xD7, 0x92, 0x1E, 0x33, 0x3E, 0x23, 0x84, 0x10, 
+0x20, 0x0E, 0xBF, 0x26, 0x1F, 0xF0, 0xF1, 0x46, 
+0x8F, 0x10, 0x3F, 0x8B, 0x87, 0x91, 0x89, 0x79, 
+0x80, 0x85, 0x74, 0x9E, 0xA8, 0x04, 0xE3, 0xF1, 
+0x34, 0xA7, 0x6F, 0x7C, 0x10, 0xC1, 0x75, 0x17, 
+0xE5, 0x0C, 0x6D, 0xB7, 0x70, 0x30, 0xE7, 0x8D, 
+0xCB, 0xFB, 0x38, 0xE4, 0x27, 0xC3, 0x66, 0xA7, 
+0x64, 0x78, 0xE6, 0x78, 0xD3, 0xAD, 0x94, 0xCB, 
+0xAD, 0xB2, 0x00, 0x42, 0x0B, 0x16, 0xA0, 0x4A, 
+0xB2, 0x14, 0x8C, 0x04, 0x5E, 0x53, 0x0B, 0x5B, 
+0x10, 0xF4, 0x22, 0x12, 0xA9, 0x54, 0x06, 0x00, 
+0x6C, 0x06, 0xBC, 0xB6, 0x23, 0x81, 0x17, 0x3B, 
+0x4B, 0xA0, 0xC0, 0xF7, 0x84, 0x65, 0xBB, 0x9E, 
+0x2B, 0x43, 0xC0, 0x35, 0x13, 0x09, 0xF0, 0x9E, 
+0xC0, 0xE1, 0x6F, 0xC4, 
+
+0x25, 0x76, 0xC2, 0x17, 0x43, 0x90, 0x15, 0x10, 
+0x00, 0x1B, 0x12, 0x7C, 0x4A, 0xE6, 0x7D, 0xB2, 
+0x3F, 0xC2, 0xD6, 0x27, 0x9C, 0x8E, 0xFF, 0x60, 
+0xF5, 0x05, 0x98, 0x91, 0x1B, 0x4D, 0xD8, 0x77, 
+0xE9, 0xDA, 0xD0, 0xF0, 0x64, 0x45, 0x08, 0x7C, 
+0xF2, 0xB1, 0x0C, 0xFF, 0xD0, 0x3C, 0x8F, 0xDE, 
+0x99, 0x58, 0x35, 0x44, 0xF2, 0x55, 0xC4, 0xB2, 
+0x51, 0x96, 0xAF, 0x98, 0xD5, 0x4B, 0x4E, 0x74, 
+0xC8, 0x02, 0x8A, 0xBB, 0x15, 0x62, 0x53, 0x72, 
+0x0C, 0x4F, 0x3D, 0x83, 0x8A, 0x93, 0x08, 0xB3, 
+0x26, 0xB7, 0x1A, 0xFD, 0xAD, 0x89, 0x8A, 0x8C, 
+0xE9, 0x8A, 0x64, 0x49, 0x8D, 0xBD, 0xBA, 0xE6, 
+0x53, 0xC4, 0xEC, 0x22, 0x34, 0xF7, 0x25, 0xD7, 
+0x17, 0xE3, 0xB9, 0xC9, 0xB1, 0x03, 0xD1, 0xCC, 
+0x0E, 0x4D, 0x78, 0x90, 0xB8, 0x95, 0x83, 0x9E, 
+0x9F, 0x90, 0xF5, 0xB5, 0x38, 0x99, 0x40, 0xF3, 
+0x8E, 0xA1, 0x49, 0xC5, 0x6B, 0xA9, 0x24, 0xC6, 
+0x31, 0x83, 0x56, 0x8F, 0x24, 0x25, 0xFA, 0x99, 
+0x48, 0x85, 0x30, 0x64, 0x4E, 0xFA, 0x21, 0x34, 
+0x0C, 0x45, 0xFF, 0x1D, 0x5E, 0x00, 0xCF, 0x82, 
+0xD7, 0x03, 0xA9, 0x81, 0x01, 0xDA, 0x7B, 0x93, 
+0xCC, 0x5D, 0x1B, 0x3F, 0xE0, 0xD0, 0x24, 0x9D, 
+0xB1, 0x37, 0xB5, 0xD4, 0x5B, 0xAF, 0xF9, 0x8B, 
+0xA5, 0xEE, 0x29, 0xD5, 0xBD, 0x57, 0x4F, 0xCD, 
+0xD2, 0x79, 0x95, 0x90, 0x86, 0xF0, 0x46, 0xFF, 
+0x45, 0x85, 0x8D, 0xAF, 0x7A, 0x5F, 0x6A, 0x3A, 
+0x40, 0xDF, 0xEA, 0x6A, 0x5A, 
+
+0x6F, 0xD8, 0x5C, 0x2C, 0x56, 0x8C, 0x36, 0x21, 
+0x5E, 0xAC, 0xFD, 0x69, 0x9C, 0xD3, 0x04, 0x18, 
+0xF0, 0xD4, 0x7A, 0xB7, 0xF4, 0x9C, 0x47, 0x65, 
+0xA9, 0x30, 0x3D, 0xAF, 0x9D, 0xB7, 0xA4, 0x47, 
+0xF1, 0xE8, 0xC7, 0x66, 0x8E, 0x71, 0x66, 0x8D, 
+0xC6, 0xC5, 0xC1, 0xCE, 0x7C, 0x69, 0xB7, 0xFC, 
+0x99, 0x8E, 0x77, 0x1D, 0xF7, 0xFB, 0xC5, 0xD8, 
+0x42, 0x90, 0x5A, 0xAD, 0x82, 0xFE, 0x97, 0x10, 
+0x87, 0xE8, 0xBF, 0x11, 0x24, 0x26, 0xC8, 0x3E, 
+0xEB, 0xCD, 0xA4, 0x5B, 0x2F, 0x37, 0x6F, 0x1B, 
+0x13, 0x83, 0x98, 0x9B, 0x70, 0x03, 0x41, 0x61, 
+0x57, 0xDC, 0x06, 0xDF, 0x92, 0xFB, 0xB0, 0x46, 
+0x50, 0x20, 0xAC, 0x7F, 0x12, 0xC8, 0x0B, 0xE5, 
+0x71, 0x68, 0x3D, 0x1E, 0x53, 0x5B, 0x1D, 0x28, 
+0xCB, 0x34, 0x72, 0x58, 0x48, 0xF6, 0x27, 0x75, 
+0xD9, 0x5D, 0xDF, 0x98, 0x56, 0x0F, 0xED, 0x17, 
+0xEF, 0x47, 0x6B, 0x3A, 0xD5, 0x18, 0x7E, 0x79, 
+0x4E, 0x53, 0x4D, 0xE5, 0x1C, 0xDB, 0x79, 0x85, 
+0xF2, 0x44, 0x76, 0xEB, 0xA8, 0x3C, 0x20, 0x11, 
+0x7F, 0x41, 0x7F, 0x8C, 0xB3, 0xDC, 0xBF, 0xBD, 
+0x89, 0x7B, 0x4B, 0x83, 0xC7, 0xBA, 0xF6, 0x22, 
+0xE4, 0x93, 0xD6, 0xD1, 0xB7, 0x9E, 0x28, 0xB5, 
+0x81, 0xE6, 0x89, 0x19, 0x7B, 0x0B, 0x80, 0x4B, 
+0xC6, 0xF6, 0xBE, 0x8B, 0x20, 0x8D, 0x85, 0x79, 
+0x4F, 0xF7, 0x75, 0x63, 0x9F, 0x94, 0xD8, 0xEA, 
+0x17, 0x28, 0x4E, 0x2A, 0x02, 0x89, 0xA5, 0x4E, 
+0xC7, 0xD6, 0x6D, 0xEB, 0x56, 0x1E, 
+
+0xB9, 0x3D, 0xE7, 0x30, 0x03, 0x4D, 0x16, 0x78, 
+0x4E, 0x7C, 0x7E, 0x09, 0xEF, 0x88, 0x53, 0x06, 
+0x87, 0x1B, 0x9C, 0x76, 0x17, 0x21, 0xE9, 0x20, 
+0x96, 0x3B, 0x44, 0xB9, 0xAF, 0xD0, 0xE5, 0x62, 
+0xCA, 0xA2, 0x9D, 0x20, 0xA4, 0x5B, 0x51, 0x9A, 
+0x46, 0x09, 0x60, 0x0B, 0x65, 0x66, 0x97, 0x70, 
+0xBE, 0x40, 0xB3, 0xF3, 0x71, 0xFB, 0x7A, 0x70, 
+0x12, 0x63, 0xA3, 0xEF, 0xEF, 0x80, 0x5D, 0x3D, 
+0x17, 0xBF, 0xF8, 0x89, 0xF8, 0x9F, 0xCA, 0x50, 
+0x99, 0x48, 0x47, 0xD9, 0x25, 0xB2, 0x96, 0x4F, 
+0xEE, 0x96, 0xAD, 0xF6, 0xF9, 0xB3, 0x27, 0x5D, 
+0x63, 0x7D, 0xBC, 0x4E, 0x81, 0x37, 0x2A, 0x24, 
+0x61, 0xEC, 0x9A, 0x09, 0x3E, 0xCE, 0x63, 0x23, 
+0xCA, 0x74, 0x71, 0x0E, 0x58, 0x91, 0xEB, 0x21, 
+0x40, 0xE3, 0x08, 0x43, 0x3B, 0x8D, 0x33, 0xCD, 
+0x06, 0x0E, 0x3B, 0x45, 0x4F, 0xA7, 0x53, 0xC0, 
+0xF8, 0x25, 0x16, 0x1C, 0x22, 0x84, 0x37, 0xE7, 
+0xEA, 0x99, 0x0E, 0x55, 0xD5, 0x09, 0xE6, 0xE0, 
+0x62, 0xB6, 0xA1, 0xDA, 0xE3, 0x96, 0x62, 0xA3, 
+0x4A, 0x38, 0x73, 0xC6, 0x74, 0x19, 0x06, 0xFF, 
+0x3C, 0xA5, 0xFC, 0x8D, 0xFA, 0x1F, 0x4B, 0x6C, 
+0xD1, 0x75, 0xD1, 0x01, 0x56, 0xA6, 0x9B, 0xF4, 
+0x27, 0x7F, 0x08, 0x7F, 0xF6, 0x7B, 0x47, 0xD4, 
+0xD2, 0xBE, 0x9C, 0xB7, 0x72, 0x2A, 0xD1, 0x12, 
+0xBF, 0x4B, 0xFE, 0x78, 0x31, 0x6E, 0xA5, 0x7A, 
+0x72, 0x9C, 0x6E, 0x2C, 0x76, 0x11, 0x99, 0x5E, 
+0x3A, 0xF4, 0x3F, 0xAB, 0x90, 0xDE, 0xAC, 
+
+0x0D, 0xE8, 0xE1, 0xEE, 0x54, 0x9C, 0x38, 0xE7, 
+0x42, 0x54, 0xAD, 0xDA, 0x1A, 0x03, 0x22, 0x5D, 
+0x80, 0x92, 0xF9, 0xF3, 0x87, 0x9C, 0xE1, 0x1B, 
+0xA2, 0x2D, 0xA4, 0x8D, 0x9B, 0x39, 0x72, 0x72, 
+0xE0, 0x82, 0x3C, 0x13, 0xB3, 0xDE, 0x62, 0xC5, 
+0x26, 0xD0, 0x56, 0xCB, 0xB1, 0x7B, 0xB2, 0x79, 
+0xA6, 0x21, 0xC0, 0xBA, 0xE3, 0xA0, 0x60, 0xA4, 
+0xD3, 0xFC, 0xCB, 0x7C, 0x5B, 0xF8, 0x33, 0x08, 
+0x29, 0x86, 0x1C, 0xDB, 0xD9, 0xE8, 0xB7, 0x1F, 
+0x01, 0x68, 0x78, 0x5F, 0x9B, 0xAA, 0x71, 0x5E, 
+0x54, 0x6E, 0x3D, 0xF0, 0x89, 0x4F, 0x7E, 0xBB, 
+0x05, 0x18, 0xDA, 0xC3, 0xCE, 0x2B, 0x5C, 0x67, 
+0xE2, 0xDB, 0x27, 0x37, 0xC5, 0xDE, 0xCA, 0xFA, 
+0x0C, 0xF0, 0x40, 0x7B, 0xCF, 0xEB, 0x05, 0x8D, 
+0x40, 0x01, 0xDE, 0xB0, 0xC0, 0x67, 0x85, 0xCE, 
+0xA8, 0xEF, 0x6F, 0x38, 0x1D, 0x61, 0x9C, 0xD9, 
+0x59, 0x00, 0xE4, 0xFB, 0x9A, 0x91, 0x9E, 0xAF, 
+0x71, 0xC3, 0xC3, 0xFE, 0x4E, 0x62, 0x1E, 0xEB, 
+0x10, 0x24, 0x36, 0x62, 0xD9, 0xC4, 0xE0, 0xA2, 
+0x17, 0x9A, 0x98, 0xF3, 0xEE, 0x3E, 0x5A, 0xC2, 
+0x06, 0x81, 0xC5, 0x19, 0x67, 0x3A, 0x1F, 0xE1, 
+0x80, 0xB1, 0x87, 0x06, 0x96, 0x86, 0x53, 0xEF, 
+0x6D, 0xB1, 0x6E, 0x9B, 0x5D, 0xD3, 0x1F, 0x8F, 
+0x95, 0x11, 0x11, 0x8D, 0x9B, 0x6D, 0x2B, 0x6A, 
+0x1A, 0x6F, 0xFE, 0xED, 0x0E, 0x42, 0x9B, 0x19, 
+0x26, 0x4F, 0x92, 0xC5, 0x5E, 0xAA, 0x46, 0x9A, 
+0x8C, 0x47, 0x84, 0x86, 0xC1, 0x32, 0xFF, 0x95, 
+
+0x97, 0x27, 0xD2, 0x9F, 0x75, 0x78, 0xC6, 0xBD, 
+0x21, 0x86, 0x1E, 0x6D, 0x9F, 0x8B, 0x20, 0xC7, 
+0x3B, 0x14, 0x63, 0x95, 0x03, 0x7F, 0x1A, 0x74, 
+0xBF, 0xA7, 0x4F, 0x8B, 0x8C, 0xF2, 0x3B, 0x8B, 
+0x96, 0x73, 0x78, 0x0C, 0xB1, 0x4E, 0x45, 0x9E, 
+0xB0, 0x10, 0x6D, 0x98, 0x3B, 0x0B, 0x4B, 0x3E, 
+0x4A, 0xD0, 0xCE, 0xD7, 0x17, 0x25, 0x4F, 0x9C, 
+0xD0, 0x78, 0x18, 0xD4, 0x79, 0x18, 0x1E, 0x6D, 
+0x77, 0xFC, 0x86, 0xC8, 0x58, 0xF0, 0xE8, 0xD0, 
+0x89, 0x4C, 0xB1, 0xCA, 0xF3, 0x0A, 0xB6, 0xC2, 
+0x7E, 0xD0, 0xCF, 0x26, 0x46, 0x10, 0x41, 0x8E, 
+0x28, 0x75, 0x3F, 0x1A, 0xE9, 0xBC, 0x01, 0x37, 
+0xB9, 0x13, 0x5B, 0x81, 0xC4, 0xDB, 0x7B, 0xB7, 
+0x24, 0x7E, 0xEB, 0x8C, 0xF4, 0x33, 0xCD, 0xA3, 
+0x14, 0xAB, 0xC9, 0x2E, 0x4D, 0x51, 0xD7, 0x53, 
+0xA5, 0xFC, 0x06, 0xCB, 0xF1, 0xAE, 0x34, 0xA5, 
+0x2B, 0x9B, 0x60, 0x83, 0x99, 0x4E, 0xDD, 0x4A, 
+0x7B, 0xE1, 0xC4, 0x71, 0x1F, 0xDF, 0xCA, 0x30, 
+0x5D, 0xC6, 0x31, 0xDB, 0x91, 0x5A, 0xFA, 0x6C, 
+0xAA, 0x4F, 0x11, 0x55, 0x5C, 0xDE, 0x46, 0x22, 
+0x23, 0xDA, 0x52, 0x0E, 0xF6, 0x73, 0x36, 0xFE, 
+0x1E, 0x42, 0x44, 0x57, 0xAA, 0x17, 0xEB, 0x62, 
+0xAF, 0xC5, 0xC6, 0xB2, 0x5A, 0x76, 0xC9, 0x8F, 
+0xA1, 0xA7, 0xEB, 0x20, 0x90, 0x5A, 0x4A, 0x3B, 
+0x7D, 0x6A, 0x58, 0x02, 0xD6, 0x91, 0xF3, 0xF0, 
+0x73, 0x70, 0xC8, 0x48, 0xE1, 0xD4, 0x99, 0x4E, 
+0x22, 0x5E, 0xE9, 0xA0, 0x88, 0xCD, 0x67, 0xFE, 
+0xC9, 
+
+0xE8, 0xC2, 0xD9, 0x44, 0x8B, 0xC4, 0x61, 0x09, 
+0x19, 0x90, 0x02, 0xB3, 0xB5, 0x31, 0x9D, 0x65, 
+0xAF, 0x09, 0xB1, 0xED, 0x6A, 0xFF, 0x41, 0x6F, 
+0x33, 0xF6, 0x95, 0x96, 0xE3, 0x56, 0xAB, 0x18, 
+0x81, 0xFC, 0x2A, 0x9E, 0xC4, 0x16, 0xAA, 0x4D, 
+0xFB, 0xD1, 0x54, 0x41, 0xF0, 0x0C, 0xBF, 0xC4, 
+0x4E, 0x7A, 0x11, 0x0F, 0xC7, 0x04, 0xD5, 0xFE, 
+0x9F, 0x0E, 0xC3, 0x36, 0xC7, 0x78, 0x7E, 0xDA, 
+0x12, 0xA1, 0x5A, 0xD7, 0x8C, 0xDE, 0x66, 0x95, 
+0xFF, 0x86, 0xD9, 0xDA, 0x31, 0xB0, 0x90, 0x3F, 
+0xC5, 0x6B, 0xD5, 0x71, 0xBA, 0xD9, 0x9C, 0x37, 
+0xEB, 0x24, 0xB1, 0x4B, 0xB1, 0xC3, 0x04, 0xB9, 
+0xCC, 0x39, 0x9B, 0x99, 0xE0, 0xC2, 0xF9, 0x0E, 
+0x49, 0xF3, 0x40, 0xB7, 0x3F, 0x12, 0xB5, 0x7E, 
+0xE3, 0x9C, 0xEE, 0xD2, 0xCA, 0xF2, 0x4D, 0xAC, 
+0xC7, 0x4D, 0xD3, 0xBD, 0xD9, 0xF9, 0xF8, 0xBD, 
+0x1A, 0x10, 0xD1, 0xD8, 0xD9, 0xC3, 0x8F, 0xF7, 
+0x76, 0x35, 0x3F, 0xCF, 0xDE, 0xD6, 0x0B, 0x28, 
+0x13, 0x2E, 0x04, 0x1A, 0x6E, 0xB8, 0xA0, 0xE5, 
+0xB8, 0xCD, 0xF4, 0xD4, 0xD0, 0x1F, 0xBA, 0xCC, 
+0xF2, 0x3C, 0x8B, 0xF4, 0x74, 0xC3, 0x3F, 0x0D, 
+0x42, 0x40, 0x43, 0x60, 0xEB, 0x00, 0xE6, 0x4D, 
+0xC9, 0x65, 0xFE, 0x69, 0xEF, 0x48, 0x61, 0x90, 
+0x06, 0xD0, 0x1B, 0x33, 0x53, 0xE0, 0x0F, 0x85, 
+0x65, 0xAC, 0x98, 0x2C, 0xDE, 0xC5, 0x32, 0xCB, 
+0x3F, 0x8F, 0x4F, 0x3B, 0xBA, 0x92, 0x53, 0xE2, 
+0xD4, 0x56, 0xB7, 0xD5, 0xDD, 0x68, 0x82, 0x24, 
+0x72, 0x6D, 
+
+0x73, 0xC5, 0xE6, 0x13, 0x28, 0x43, 0xE7, 0x01, 
+0x4C, 0x1E, 0x28, 0x3D, 0xE2, 0xC6, 0x05, 0x8F, 
+0xBB, 0x86, 0xC4, 0xAD, 0x62, 0xE5, 0x7C, 0x52, 
+0xCF, 0x0E, 0x91, 0x3E, 0x78, 0xC1, 0x9D, 0x26, 
+0x0B, 0xF7, 0xED, 0xCD, 0xC2, 0x5C, 0xBC, 0x34, 
+0x3F, 0x88, 0x0C, 0x2E, 0xD5, 0xAB, 0x95, 0xD9, 
+0x7F, 0x5D, 0xA2, 0x7B, 0x83, 0x19, 0x4C, 0xE9, 
+0x4D, 0xB8, 0x68, 0xA0, 0x62, 0xEA, 0x60, 0x79, 
+0xCB, 0x1F, 0xFF, 0x1A, 0xF4, 0x9E, 0xC8, 0xAC, 
+0xFF, 0xA0, 0xBC, 0xDE, 0x71, 0x7C, 0x0D, 0xC2, 
+0x36, 0x30, 0x8B, 0xC4, 0x40, 0x99, 0x0C, 0x34, 
+0x08, 0x73, 0x80, 0x09, 0x0E, 0xAF, 0xAD, 0xFC, 
+0xE8, 0x7F, 0x59, 0x92, 0x62, 0x32, 0xD0, 0x68, 
+0x2F, 0xEE, 0x9E, 0x84, 0x99, 0x40, 0xB3, 0xED, 
+0x2A, 0x2B, 0x26, 0x32, 0x39, 0xFF, 0x3E, 0x82, 
+0x58, 0x28, 0xA8, 0x34, 0x67, 0x1F, 0xB8, 0x1E, 
+0x78, 0x99, 0xFF, 0x66, 0xCF, 0xCC, 0xB9, 0xFD, 
+0x44, 0x56, 0x2A, 0xA2, 0x34, 0xDB, 0x3B, 0xF6, 
+0x8F, 0xBE, 0x34, 0x79, 0x7D, 0x7E, 0x07, 0xBE, 
+0x5F, 0x1F, 0x95, 0x7F, 0xD7, 0xF1, 0xF9, 0xAC, 
+0x0C, 0x2C, 0x38, 0x19, 0xC2, 0xCA, 0x34, 0xC9, 
+0xF2, 0x48, 0x15, 0x00, 0x4A, 0x46, 0x8C, 0x3F, 
+0x5A, 0x8D, 0x27, 0xA0, 0x5A, 0xDC, 0xC8, 0x57, 
+0xFD, 0x3C, 0x7D, 0x75, 0x9B, 0x05, 0x6C, 0x6C, 
+0x56, 0x06, 0xF6, 0xCB, 0xF3, 0xBA, 0xE3, 0xC3, 
+0x1C, 0xCC, 0x48, 0xB7, 0xE9, 0x4A, 0xC5, 0xC3, 
+0x42, 0xB5, 0xC3, 0x5C, 0xDB, 0xCD, 0x9E, 0x22, 
+0x09, 0xF1, 0xE9, 
+
+0x83, 0xF4, 0xDC, 0x49, 0x70, 0xB3, 0xF0, 0x98, 
+0x60, 0x11, 0xBA, 0x63, 0xC2, 0x27, 0xC7, 0xD7, 
+0xA2, 0xA1, 0xC6, 0x5F, 0xC6, 0x1F, 0xCF, 0xDA, 
+0x4E, 0x6A, 0x86, 0xDF, 0x82, 0x04, 0x07, 0x9A, 
+0x1F, 0x2E, 0x81, 0x0F, 0x85, 0x29, 0x30, 0x8B, 
+0x68, 0xC5, 0x74, 0x07, 0x5E, 0xBA, 0xBD, 0x9C, 
+0x67, 0x94, 0x56, 0xBA, 0x1C, 0xD2, 0x2F, 0x3B, 
+0xC4, 0x3C, 0xDC, 0xBA, 0x40, 0x34, 0xE6, 0xE7, 
+0x5F, 0x39, 0xE0, 0xA7, 0x03, 0x62, 0x78, 0x7E, 
+0x3E, 0x8B, 0xD3, 0x96, 0x1D, 0x03, 0x56, 0x5C, 
+0xBD, 0x12, 0x02, 0xE3, 0x3B, 0xA9, 0xAB, 0x18, 
+0xA1, 0x63, 0xEA, 0x69, 0x51, 0x36, 0x46, 0x4B, 
+0x22, 0xF0, 0xB9, 0x24, 0x2B, 0x49, 0xD3, 0x09, 
+0xB9, 0x7E, 0xEE, 0xC8, 0x9D, 0x6D, 0x85, 0xBB, 
+0xB3, 0x6C, 0xE5, 0xB7, 0xE6, 0x07, 0xB1, 0xA5, 
+0x6F, 0xB6, 0xDC, 0xB5, 0x05, 0x82, 0xDA, 0x26, 
+0xC9, 0x80, 0x74, 0xBB, 0x00, 0x6A, 0x31, 0x75, 
+0x2D, 0x56, 0xB7, 0xEA, 0xB9, 0xEC, 0xFF, 0xA3, 
+0xA1, 0x38, 0x8E, 0x9A, 0x39, 0xEB, 0xD7, 0x48, 
+0xBF, 0xFA, 0x4A, 0xAA, 0xEA, 0x5C, 0xAE, 0xA7, 
+0x15, 0x83, 0x24, 0x37, 0x05, 0xF5, 0xC5, 0x17, 
+0xA9, 0xAC, 0x37, 0x2D, 0xE7, 0x89, 0xE5, 0xE8, 
+0xD6, 0x86, 0x6B, 0x80, 0xA7, 0x4A, 0x02, 0xDD, 
+0xD5, 0xD5, 0x85, 0x1F, 0xF0, 0x17, 0x39, 0x9B, 
+0x0C, 0x32, 0xE5, 0xF8, 0x09, 0x68, 0xF5, 0x8A, 
+0x02, 0xA7, 0xD6, 0xBB, 0x38, 0x1E, 0x79, 0x2C, 
+0xD9, 0x54, 0x60, 0x0A, 0x83, 0x3C, 0xDA, 0x94, 
+0x33, 0xD3, 0x7A, 0x3B, 
+
+0xB5, 0xDE, 0xFE, 0xF3, 0x30, 0x8C, 0xFF, 0x1F, 
+0x3C, 0xB4, 0x30, 0x5A, 0x89, 0x0D, 0x2E, 0xF7, 
+0x59, 0x00, 0x02, 0x45, 0xFE, 0x75, 0xD5, 0x7B, 
+0xE5, 0x9B, 0xAA, 0xED, 0xFA, 0x2A, 0x21, 0x1A, 
+0xD5, 0x2A, 0xA9, 0x4D, 0x41, 0x2F, 0x01, 0xC7, 
+0xC3, 0x71, 0xD5, 0xF8, 0xDD, 0x56, 0xEA, 0x8E, 
+0x2A, 0x15, 0x3A, 0x4C, 0x74, 0x9A, 0x45, 0xC0, 
+0xCC, 0xC9, 0xF0, 0x82, 0x44, 0x23, 0x83, 0x4D, 
+0x42, 0x77, 0xFB, 0xED, 0xA2, 0x12, 0x7A, 0x23, 
+0x86, 0x86, 0x53, 0x35, 0xBD, 0x9A, 0xCC, 0x83, 
+0x5C, 0xF9, 0xE9, 0x02, 0x77, 0x7B, 0x07, 0xC1, 
+0x3F, 0x37, 0x03, 0x4A, 0xD0, 0x39, 0x9E, 0x9F, 
+0xDB, 0x8E, 0x7A, 0x32, 0xFF, 0x7B, 0x57, 0x29, 
+0x28, 0x63, 0xDF, 0x9E, 0x19, 0xEE, 0xC4, 0xDD, 
+0x80, 0x94, 0x7E, 0xD2, 0x79, 0x6E, 0xC4, 0x16, 
+0x15, 0x0F, 0xA0, 0x1F, 0x93, 0x1B, 0x65, 0x27, 
+0x88, 0xA3, 0xE7, 0x58, 0xC4, 0xE2, 0xA2, 0xEA, 
+0xC0, 0x2B, 0xD1, 0x05, 0xC6, 0xD5, 0x80, 0xA1, 
+0x61, 0x07, 0xBA, 0xF2, 0x8D, 0x88, 0x19, 0x05, 
+0x7F, 0xE1, 0x00, 0x05, 0xFE, 0x25, 0x3B, 0x3B, 
+0x77, 0x21, 0x9A, 0xD6, 0xE3, 0x26, 0x96, 0x26, 
+0xBB, 0x77, 0xFC, 0x71, 0x95, 0xE4, 0x03, 0x5C, 
+0xD1, 0xB2, 0x49, 0x9D, 0x50, 0xFD, 0x7B, 0x60, 
+0xEE, 0x93, 0xA5, 0x84, 0xCE, 0x28, 0x83, 0x75, 
+0xCD, 0x23, 0xDC, 0x1B, 0x56, 0x2A, 0xA3, 0x96, 
+0x24, 0xF3, 0xA6, 0x62, 0x16, 0xED, 0x36, 0x47, 
+0x31, 0x7A, 0xB1, 0xB6, 0x31, 0x79, 0x66, 0x47, 
+0x7B, 0x36, 0xAF, 0x07, 0xFF, 
+
+0xEF, 0xC5, 0xB4, 0xCE, 0x8B, 0x62, 0xB6, 0xC7, 
+0x43, 0xBD, 0x41, 0xCF, 0x0B, 0xF8, 0x3F, 0x5F, 
+0x84, 0xA4, 0xFD, 0xC3, 0x0C, 0xCD, 0x0B, 0xED, 
+0xC6, 0x32, 0xAC, 0xC2, 0x1F, 0x4F, 0x7B, 0x9B, 
+0xD9, 0x39, 0xF3, 0x1D, 0x44, 0x7F, 0x06, 0x81, 
+0x92, 0xC2, 0xCD, 0x57, 0x80, 0x66, 0xD2, 0x7F, 
+0x4D, 0x45, 0xD4, 0xC1, 0xFB, 0x6E, 0x1A, 0x93, 
+0xE3, 0xF0, 0x5A, 0x4A, 0x27, 0x2E, 0xA0, 0x5A, 
+0x78, 0xC1, 0xDD, 0xF9, 0x99, 0x71, 0x0D, 0x8D, 
+0x19, 0x50, 0x99, 0x19, 0x63, 0x47, 0x1A, 0x04, 
+0x3A, 0x22, 0xB8, 0x3B, 0xC3, 0x27, 0x79, 0xC5, 
+0x5F, 0x73, 0x9F, 0x88, 0x39, 0xAB, 0xF0, 0xA7, 
+0x88, 0x0F, 0x54, 0xBD, 0x9D, 0xBE, 0x2B, 0xD3, 
+0x4B, 0xC5, 0x62, 0x88, 0x58, 0xEC, 0xE0, 0x1F, 
+0x19, 0xEB, 0xBF, 0xDD, 0x0D, 0x2A, 0x63, 0xC6, 
+0xE4, 0x11, 0xC1, 0xB2, 0x8A, 0x06, 0x4B, 0xCA, 
+0x65, 0x7C, 0xB9, 0x5E, 0x8A, 0xCE, 0x41, 0x48, 
+0xFE, 0xA8, 0xAC, 0xB3, 0xF5, 0x0E, 0x31, 0x06, 
+0x58, 0x31, 0x86, 0x5E, 0x7E, 0xB1, 0x7B, 0x19, 
+0xAA, 0x88, 0x14, 0x28, 0xCD, 0x1A, 0x8E, 0x2F, 
+0x49, 0x89, 0x56, 0x60, 0x6F, 0xA1, 0x0E, 0xBC, 
+0xE8, 0x25, 0x8B, 0x17, 0x2D, 0x75, 0xC0, 0xB9, 
+0x24, 0xE5, 0xAC, 0xF2, 0x15, 0x5D, 0xA6, 0x07, 
+0x5A, 0xA2, 0x76, 0x4D, 0x55, 0xEC, 0xC7, 0x9C, 
+0x5F, 0xEB, 0xED, 0x02, 0x4B, 0x0E, 0x0A, 0xBC, 
+0x4A, 0x72, 0x81, 0x21, 0x7F, 0xC4, 0xE4, 0x84, 
+0xCA, 0x6B, 0x4E, 0xA6, 0x4C, 0xCE, 0xF5, 0xA1, 
+0xA3, 0x0B, 0x8B, 0x5E, 0xAA, 0xA0, 
+
+0x09, 0xEC, 0x07, 0x9D, 0x2D, 0xAB, 0xBE, 0x3B, 
+0xBD, 0xB2, 0xD1, 0x13, 0xA0, 0x98, 0x6D, 0x2D, 
+0x38, 0x68, 0xA1, 0x5E, 0x80, 0x57, 0x75, 0x8B, 
+0xF2, 0x68, 0x8B, 0x49, 0x88, 0x71, 0xA2, 0xE7, 
+0x25, 0x76, 0xB8, 0x81, 0x0A, 0x66, 0x59, 0xEE, 
+0xD7, 0xDB, 0x54, 0x44, 0x19, 0xE5, 0x53, 0xE0, 
+0xBC, 0x93, 0x1D, 0x9A, 0xEF, 0x22, 0x58, 0xCB, 
+0x7E, 0x84, 0xBA, 0xED, 0x44, 0x0C, 0x5D, 0xDB, 
+0x31, 0xC4, 0x46, 0xFA, 0xAF, 0xD0, 0xC8, 0xE2, 
+0xAF, 0xE3, 0x95, 0x20, 0x32, 0x1D, 0x9A, 0x10, 
+0xFD, 0x60, 0x5E, 0x30, 0x4C, 0xA8, 0x55, 0xE7, 
+0x06, 0x94, 0xD3, 0xED, 0xD8, 0x4C, 0xFA, 0x12, 
+0x8B, 0x8F, 0x77, 0x46, 0x86, 0x6F, 0x76, 0x1F, 
+0x79, 0x4F, 0x41, 0x56, 0x55, 0x21, 0xE2, 0x64, 
+0x82, 0x5A, 0xF8, 0x66, 0xBF, 0x8B, 0xF4, 0x0B, 
+0x4A, 0x6D, 0x29, 0xCA, 0xF7, 0x58, 0x36, 0xA3, 
+0x33, 0xA1, 0xC5, 0x9C, 0xF9, 0x66, 0x06, 0xB4, 
+0x82, 0x6E, 0xB6, 0x77, 0x59, 0xCA, 0xF3, 0xEA, 
+0x01, 0x2E, 0x2B, 0x30, 0x9B, 0x8F, 0x5B, 0x6B, 
+0x45, 0x75, 0x95, 0xA2, 0x8B, 0x5B, 0x76, 0xC7, 
+0x73, 0x64, 0xD1, 0xD2, 0xD5, 0x5E, 0xEB, 0x35, 
+0x15, 0xC6, 0xAB, 0x9F, 0xDB, 0x0A, 0xCE, 0xE0, 
+0xFC, 0x12, 0xCF, 0xD4, 0xE5, 0xB8, 0xA3, 0xB0, 
+0x59, 0xC1, 0xF6, 0x68, 0x14, 0x9F, 0xDF, 0x1B, 
+0xE0, 0xA4, 0x7A, 0xC5, 0x32, 0xE0, 0x58, 0x5E, 
+0x29, 0xE9, 0x80, 0x0D, 0x50, 0x49, 0x2C, 0x27, 
+0xB8, 0xA2, 0x85, 0x2D, 0x1F, 0x77, 0x58, 0x66, 
+0x0B, 0x46, 0x33, 0x04, 0x40, 0xCA, 0x06, 
+
+0xB5, 0x87, 0x20, 0x22, 0xA0, 0x3A, 0x6A, 0x32, 
+0x22, 0x2C, 0x18, 0x0C, 0x9C, 0xEE, 0xF0, 0x0F, 
+0x22, 0x47, 0x1A, 0xDB, 0x7C, 0x23, 0xBB, 0x00, 
+0xD0, 0xFD, 0x0B, 0x67, 0x3E, 0x7B, 0x8D, 0xA5, 
+0x20, 0x43, 0xA7, 0x08, 0x92, 0x16, 0xEA, 0xCC, 
+0x81, 0xDF, 0xA5, 0x7C, 0x73, 0x6B, 0x56, 0x2F, 
+0xA2, 0x55, 0x7C, 0x0F, 0x11, 0xB9, 0x7A, 0xAA, 
+0xEE, 0x5F, 0xD3, 0xD1, 0xE5, 0xA8, 0x6A, 0x70, 
+0xDC, 0x8E, 0x6F, 0x49, 0x17, 0x7A, 0x91, 0x19, 
+0x28, 0x68, 0xBB, 0x5D, 0x39, 0x72, 0x86, 0xF3, 
+0x90, 0xEB, 0xFF, 0x83, 0x4D, 0x01, 0x41, 0x65, 
+0xF8, 0x47, 0x5C, 0xED, 0x4A, 0x96, 0x1B, 0xB4, 
+0xF3, 0x46, 0x2D, 0xB9, 0xA1, 0x98, 0x07, 0xC0, 
+0x38, 0xB7, 0xD2, 0xA5, 0xB3, 0x27, 0xD8, 0xE7, 
+0xD8, 0x20, 0xA1, 0x39, 0xED, 0xFD, 0xAD, 0x02, 
+0xDA, 0xDA, 0x7F, 0x78, 0x6B, 0xB8, 0x3D, 0x6F, 
+0xE2, 0xAA, 0xFB, 0x0C, 0xF2, 0xF1, 0x2E, 0xDF, 
+0x7F, 0xD9, 0x81, 0xA8, 0xC8, 0x2C, 0x49, 0xA3, 
+0x47, 0x0D, 0x2A, 0xD5, 0x13, 0xDD, 0xEE, 0xEF, 
+0x5F, 0x6C, 0xFC, 0x6E, 0xF0, 0x01, 0xC6, 0x63, 
+0x9E, 0xA6, 0x18, 0x1A, 0x0F, 0x73, 0x58, 0x6D, 
+0xDE, 0x77, 0xE8, 0x61, 0x54, 0x1D, 0xE0, 0xC4, 
+0xC4, 0x4B, 0x2C, 0x38, 0x98, 0xA7, 0xE2, 0xE2, 
+0x4F, 0xC6, 0x13, 0x5A, 0x45, 0x92, 0xD8, 0xA5, 
+0x66, 0x47, 0x16, 0x8B, 0x05, 0xD4, 0x42, 0x1B, 
+0x69, 0xB7, 0x94, 0x7F, 0x3B, 0xB6, 0x5E, 0x14, 
+0x43, 0x1B, 0x77, 0xDC, 0xF6, 0xE9, 0x4E, 0x52, 
+0x2A, 0x93, 0x11, 0xF5, 0x72, 0xC1, 0x12, 0xA6, 
+
+0x5E, 0x35, 0xF3, 0x7D, 0xBA, 0x51, 0x57, 0x8B, 
+0x77, 0x1E, 0xC7, 0x70, 0xFA, 0x3B, 0xDE, 0x16, 
+0x51, 0xCE, 0x65, 0x71, 0x86, 0x6B, 0x1C, 0x82, 
+0xB9, 0xC8, 0x37, 0x9F, 0x29, 0x3C, 0x84, 0xF2, 
+0xE4, 0xF4, 0xE4, 0xA4, 0x0E, 0xFD, 0xE7, 0x65, 
+0x94, 0xD4, 0xF5, 0x3B, 0x72, 0x3C, 0x08, 0xFB, 
+0x75, 0x5C, 0xC7, 0xD1, 0xD7, 0x00, 0xC2, 0xE1, 
+0xA3, 0x0A, 0x8F, 0x2C, 0x1F, 0xA7, 0x82, 0x6B, 
+0x90, 0x41, 0xE4, 0x28, 0x19, 0xB6, 0x66, 0x66, 
+0xF5, 0xC4, 0x4F, 0x1E, 0xE7, 0xD0, 0x9B, 0x19, 
+0x04, 0x86, 0xF8, 0x63, 0x6C, 0xF8, 0x63, 0xF2, 
+0xE1, 0xA8, 0x06, 0xA2, 0x0B, 0xF1, 0x83, 0x00, 
+0x64, 0x4C, 0xD7, 0xDE, 0x9B, 0xE4, 0x82, 0x11, 
+0x2C, 0xF9, 0xBF, 0xF8, 0xA9, 0x67, 0x5B, 0xF8, 
+0x2D, 0xEA, 0xFB, 0x81, 0xBC, 0x4E, 0xC9, 0x93, 
+0xFA, 0xDE, 0xA9, 0x4A, 0x95, 0x74, 0x57, 0x6E, 
+0xBE, 0x21, 0x7A, 0x3D, 0x5A, 0xBD, 0x0A, 0x38, 
+0x6E, 0x40, 0xF5, 0x9D, 0x98, 0xCA, 0x2D, 0x04, 
+0x0C, 0x04, 0x2A, 0x70, 0x4F, 0xC1, 0x69, 0x38, 
+0x9D, 0x5D, 0xA5, 0xEB, 0x93, 0x27, 0x07, 0xBC, 
+0x63, 0xEC, 0x6D, 0x0E, 0xB4, 0x99, 0x58, 0x10, 
+0xB7, 0xFF, 0x60, 0xB4, 0xE7, 0xAB, 0xA2, 0x2A, 
+0x52, 0x1B, 0xEF, 0x72, 0xDE, 0x5C, 0x0F, 0xA0, 
+0x27, 0x8D, 0x53, 0x9C, 0x7E, 0x78, 0x7B, 0x25, 
+0x5A, 0x16, 0x57, 0x9A, 0x52, 0x24, 0xCD, 0xDA, 
+0xDC, 0x86, 0x14, 0x9D, 0xFF, 0x2C, 0x36, 0xAD, 
+0xDB, 0x19, 0x51, 0x13, 0x36, 0xDA, 0xE0, 0x81, 
+0x82, 0x03, 0x41, 0x52, 0xDD, 0x9A, 0xF3, 0x4D, 
+0x20, 
+
+0x44, 0xE1, 0xD9, 0xE5, 0x27, 0xB2, 0x08, 0xB3, 
+0xFF, 0x9F, 0xF2, 0xA9, 0x7B, 0x27, 0x19, 0xF0, 
+0x00, 0x5C, 0x02, 0xD9, 0xF7, 0xBA, 0xED, 0x05, 
+0x58, 0xB2, 0x49, 0x56, 0x43, 0x76, 0xAC, 0x79, 
+0xE8, 0xA8, 0xDB, 0x0B, 0x8C, 0xDF, 0xA2, 0xE1, 
+0xDA, 0xB1, 0xDD, 0xC0, 0x59, 0x5D, 0xFC, 0xEC, 
+0x58, 0x2B, 0xC4, 0x7B, 0xA3, 0x30, 0x1D, 0xBD, 
+0x90, 0xAC, 0x88, 0xAE, 0x69, 0xA1, 0x23, 0x55, 
+0x8E, 0xAF, 0x16, 0x43, 0x77, 0x26, 0xB0, 0xC9, 
+0xDB, 0x25, 0x67, 0xA5, 0xB1, 0x0B, 0xF3, 0x69, 
+0x6C, 0x2A, 0x82, 0x06, 0xB9, 0xD7, 0x0B, 0xAC, 
+0x8C, 0x77, 0xE2, 0x74, 0x9C, 0x1B, 0xBE, 0x0C, 
+0xC5, 0x10, 0x11, 0x73, 0xA1, 0x28, 0x58, 0x95, 
+0xC7, 0x97, 0x1E, 0xB9, 0x5D, 0x07, 0x12, 0x09, 
+0x23, 0xC0, 0x08, 0x6A, 0x89, 0xFC, 0x10, 0x32, 
+0xAB, 0x75, 0x95, 0x8A, 0xA2, 0x50, 0xD0, 0xEB, 
+0x1E, 0x98, 0x3D, 0x4B, 0x4F, 0xDE, 0x48, 0x92, 
+0x11, 0x19, 0xBD, 0xA5, 0x04, 0x6D, 0xB0, 0x85, 
+0x17, 0x6C, 0xC1, 0xB3, 0x8A, 0xD4, 0x1E, 0xD8, 
+0xF8, 0x0B, 0x95, 0x65, 0xD2, 0x04, 0x3A, 0x03, 
+0xC0, 0x4A, 0x76, 0x0B, 0x70, 0xE7, 0xAF, 0x24, 
+0x96, 0x9B, 0x65, 0x3E, 0xA9, 0xA4, 0x6D, 0x24, 
+0x42, 0x34, 0x91, 0xA6, 0x3A, 0x78, 0x8C, 0x8D, 
+0x1D, 0x9A, 0x47, 0x1D, 0x3D, 0x0E, 0x4B, 0xF1, 
+0xF0, 0x3B, 0x1B, 0xF1, 0x47, 0x0B, 0xB8, 0x3F, 
+0xFC, 0xE0, 0x47, 0x33, 0x94, 0x82, 0xB1, 0xF7, 
+0xD2, 0x21, 0xF0, 0xA0, 0xDB, 0x65, 0x81, 0x96, 
+0xC3, 0xBF, 0xDE, 0xDB, 0x5B, 0x0F, 0x59, 0xB0, 
+0xAF, 0xF0, 
+
+0xAF, 0x1E, 0x42, 0xFD, 0xD7, 0xA7, 0x69, 0xE8, 
+0x94, 0xCC, 0x56, 0x15, 0x2D, 0x36, 0xE8, 0xDC, 
+0x8D, 0xCE, 0x3F, 0x98, 0x32, 0x02, 0x0E, 0x28, 
+0x6A, 0x77, 0x2E, 0x3A, 0xC7, 0x14, 0xC0, 0x1D, 
+0x5D, 0xF5, 0x82, 0x2E, 0xF8, 0x43, 0xC6, 0x5A, 
+0x19, 0xE2, 0xE2, 0x9E, 0x00, 0x0B, 0x59, 0xE6, 
+0x39, 0xF4, 0x43, 0xE2, 0x7F, 0xD2, 0x19, 0xFD, 
+0xCC, 0xAE, 0xC2, 0x58, 0x27, 0x27, 0x30, 0x94, 
+0x74, 0x4E, 0x98, 0xA2, 0x32, 0x45, 0x2F, 0xBB, 
+0x80, 0xD5, 0xF1, 0x7F, 0xE8, 0x5F, 0x55, 0xD5, 
+0x04, 0xF8, 0x64, 0x2B, 0x1B, 0xD3, 0xBB, 0x95, 
+0x9B, 0x81, 0xE2, 0xC1, 0x9E, 0x4A, 0x52, 0xB1, 
+0xE8, 0xFF, 0xCA, 0x7A, 0x88, 0x8D, 0xB5, 0x77, 
+0xC1, 0xD2, 0x2F, 0xB5, 0x2E, 0xEB, 0xA3, 0x5A, 
+0x62, 0x60, 0xA6, 0x0A, 0x62, 0x9C, 0xD9, 0xC2, 
+0x2B, 0x1C, 0x93, 0x0E, 0x7B, 0x1F, 0x4A, 0x77, 
+0x48, 0xA2, 0xE8, 0x05, 0x49, 0x87, 0x8B, 0x61, 
+0xC3, 0x61, 0x84, 0x8D, 0x19, 0xF0, 0xBF, 0x9A, 
+0x03, 0x5C, 0x9F, 0x50, 0xA7, 0x5A, 0xF6, 0xA4, 
+0x18, 0xCB, 0x3D, 0x1C, 0xFB, 0x0D, 0x3C, 0x01, 
+0x0B, 0x69, 0xF2, 0xFD, 0x6F, 0x10, 0x44, 0x9F, 
+0x75, 0xA5, 0x93, 0xD5, 0xBF, 0xE0, 0x93, 0x0F, 
+0xCF, 0x09, 0xF1, 0x43, 0x95, 0xC5, 0x51, 0x09, 
+0x16, 0x7A, 0xE6, 0x63, 0xC6, 0x1E, 0x52, 0xBE, 
+0xBE, 0xBF, 0xDB, 0xA5, 0x3B, 0xAB, 0x9D, 0x59, 
+0x4C, 0x00, 0x4A, 0x71, 0xB4, 0xFB, 0xCD, 0x33, 
+0xDB, 0x3B, 0xDD, 0xE7, 0xF2, 0x2C, 0x81, 0xDC, 
+0x51, 0xE5, 0x10, 0xD5, 0xC7, 0xE4, 0xF6, 0x34, 
+0x00, 0xCB, 0x8E, 
+
+0xD4, 0xDA, 0x4F, 0x9B, 0xE4, 0x86, 0x74, 0xDA, 
+0x4B, 0x89, 0x69, 0x1F, 0xE6, 0x31, 0xD6, 0xCD, 
+0x09, 0x50, 0x2D, 0x41, 0x28, 0x56, 0x4D, 0xBB, 
+0x5C, 0xAB, 0x20, 0x06, 0xD6, 0x3F, 0x77, 0xD9, 
+0x1D, 0x79, 0x50, 0x9D, 0x35, 0xF8, 0x94, 0x7A, 
+0xD4, 0x31, 0xAE, 0x5F, 0xCC, 0xF1, 0xC2, 0xC5, 
+0xED, 0x43, 0xD8, 0x02, 0x03, 0x9E, 0xD0, 0xD6, 
+0xA5, 0x4E, 0x29, 0x7B, 0x90, 0x26, 0x56, 0x4D, 
+0x49, 0x13, 0xBE, 0x7B, 0x4F, 0xDD, 0x6E, 0x71, 
+0x77, 0x8C, 0xF3, 0x7E, 0xCA, 0xB0, 0x9E, 0x3B, 
+0xBF, 0xBA, 0x23, 0xD7, 0x79, 0x62, 0xD5, 0xB3, 
+0xD9, 0xDF, 0xE0, 0x90, 0x61, 0x44, 0xCE, 0x3E, 
+0x94, 0x54, 0x2C, 0x97, 0x06, 0x94, 0x13, 0xE2, 
+0x38, 0xD0, 0x4F, 0xAF, 0x8A, 0x79, 0x88, 0x93, 
+0x1E, 0x76, 0xDF, 0x61, 0x8D, 0x12, 0x01, 0x9D, 
+0xC0, 0x2A, 0x2D, 0x13, 0x79, 0x5A, 0x32, 0xFA, 
+0xE2, 0x52, 0xAD, 0x27, 0x7C, 0x85, 0xE7, 0x04, 
+0xCC, 0x78, 0x5B, 0xE3, 0xD9, 0x98, 0xEE, 0x8C, 
+0x18, 0xF7, 0x5E, 0x61, 0x11, 0xCB, 0x82, 0xFA, 
+0x88, 0x38, 0x81, 0x3D, 0x6A, 0xCF, 0x37, 0x84, 
+0x52, 0xE2, 0xC0, 0xEE, 0x7E, 0x45, 0x11, 0x5C, 
+0x4C, 0x7D, 0xF8, 0xE1, 0xC6, 0x76, 0x5D, 0xB3, 
+0x39, 0x40, 0xFD, 0x81, 0x48, 0x70, 0xF1, 0x14, 
+0x6E, 0x20, 0xEA, 0x1F, 0x3F, 0xE9, 0xA2, 0xBA, 
+0x7E, 0x75, 0x27, 0x79, 0x11, 0x4F, 0xEA, 0x4A, 
+0x64, 0x8B, 0x64, 0x30, 0x70, 0xAE, 0x34, 0xD5, 
+0xEE, 0x02, 0xB0, 0xC1, 0x88, 0xB4, 0x58, 0x51, 
+0x53, 0x38, 0x29, 0xF5, 0x1B, 0x3C, 0xBC, 0x66, 
+0x83, 0x36, 0xBD, 0x8B, 
+
+0xFF, 0x7C, 0xB8, 0x43, 0x36, 0x6A, 0x59, 0xD2, 
+0xF7, 0x10, 0xD5, 0x25, 0x78, 0x0B, 0x5B, 0xD6, 
+0x2C, 0xC4, 0x23, 0x24, 0xAF, 0xB2, 0x60, 0x80, 
+0xF1, 0x33, 0x4B, 0x09, 0x05, 0x9A, 0x4A, 0xD8, 
+0x81, 0x11, 0xA3, 0xFA, 0xD0, 0xFB, 0x1D, 0xD6, 
+0xFD, 0xD5, 0xA3, 0xA3, 0x64, 0x78, 0x23, 0xCC, 
+0x62, 0x1A, 0xD7, 0xC4, 0xFC, 0x53, 0x12, 0xEC, 
+0xB5, 0x8F, 0x0A, 0x01, 0xFC, 0xD4, 0x06, 0x73, 
+0xFC, 0x4C, 0x53, 0x5E, 0xB8, 0x5B, 0x37, 0x1E, 
+0x0F, 0x75, 0x2A, 0xE9, 0x11, 0x8B, 0x0B, 0x8E, 
+0x93, 0x96, 0x72, 0x27, 0xBE, 0xAE, 0xF5, 0x2D, 
+0x88, 0x31, 0x6B, 0x22, 0xCD, 0x72, 0xA8, 0xC1, 
+0x1B, 0x31, 0x40, 0x83, 0xA2, 0xBC, 0x8A, 0xA8, 
+0x90, 0xB8, 0x77, 0x02, 0xBC, 0xC3, 0xE0, 0xB9, 
+0x0C, 0x73, 0xE0, 0x7C, 0x4F, 0xF2, 0xC5, 0x6E, 
+0x6A, 0x34, 0x64, 0x4E, 0x2B, 0xB9, 0x57, 0xE9, 
+0xD0, 0x78, 0x77, 0x96, 0x76, 0xD5, 0x8F, 0x7D, 
+0x3F, 0x9B, 0x85, 0x6A, 0x38, 0x17, 0x10, 0x06, 
+0xBD, 0x09, 0xB9, 0xEA, 0xBF, 0x6A, 0x9A, 0x92, 
+0xC7, 0x35, 0x71, 0x97, 0x36, 0xB3, 0xC2, 0x10, 
+0xFA, 0xF2, 0x5B, 0x77, 0x0A, 0xFA, 0xB7, 0x63, 
+0x76, 0x89, 0xDA, 0x7A, 0x65, 0xB4, 0x5F, 0x5B, 
+0x83, 0x5F, 0x76, 0xF5, 0x48, 0x66, 0x9D, 0xF7, 
+0x52, 0x05, 0x33, 0xAF, 0x3D, 0xE5, 0x16, 0x7E, 
+0x6D, 0x21, 0x55, 0x17, 0x8B, 0x9E, 0xBC, 0x4C, 
+0x5F, 0x8A, 0x84, 0x6C, 0xDE, 0xAF, 0x12, 0x31, 
+0xB5, 0xDC, 0x36, 0x93, 0x44, 0x10, 0x6B, 0x16, 
+0xC2, 0xDA, 0x46, 0x3E, 0x3F, 0xAD, 0x92, 0x30, 
+0x64, 0x4D, 0x19, 0x4B, 0xC4, 
+
+0x6F, 0x57, 0xCF, 0xA5, 0xF6, 0x88, 0xC0, 0xEC, 
+0x5E, 0x7A, 0x2B, 0x76, 0x27, 0xC3, 0x17, 0x86, 
+0xB3, 0x74, 0x6A, 0xAD, 0x34, 0x86, 0x16, 0x66, 
+0x53, 0x55, 0xD5, 0x25, 0x50, 0x3F, 0x5F, 0x64, 
+0x3A, 0xC0, 0x1F, 0xC5, 0x21, 0xE6, 0x16, 0xB3, 
+0x5A, 0x92, 0xF7, 0x08, 0xC2, 0x06, 0xAA, 0xDD, 
+0xA5, 0xEE, 0x15, 0xFD, 0x0A, 0x05, 0xF7, 0x3F, 
+0x5B, 0x4B, 0xF7, 0xF5, 0xD1, 0xC3, 0x4A, 0x66, 
+0xC9, 0x1A, 0xD3, 0xEB, 0xB5, 0xD5, 0x51, 0x68, 
+0xC1, 0x5A, 0x23, 0x24, 0x2C, 0x90, 0xA1, 0x3E, 
+0x4A, 0x9C, 0x7A, 0x8F, 0x9B, 0x6F, 0x01, 0x01, 
+0xA1, 0xEC, 0xD3, 0x85, 0xF4, 0xC1, 0xCF, 0xC4, 
+0xD9, 0x22, 0x77, 0xCC, 0x27, 0xC5, 0x7F, 0xEC, 
+0xE9, 0x19, 0xBA, 0x75, 0x97, 0x75, 0x10, 0x3B, 
+0x69, 0x73, 0xF8, 0xC6, 0xFD, 0xFC, 0x53, 0xB5, 
+0x1A, 0x9C, 0x93, 0x4B, 0xEE, 0xEE, 0x6C, 0x7E, 
+0x82, 0xE8, 0x26, 0x19, 0x8D, 0xC4, 0xBD, 0x05, 
+0x9F, 0xDD, 0x57, 0x9E, 0x63, 0xFB, 0x67, 0x23, 
+0x14, 0x08, 0xF0, 0x22, 0x10, 0x35, 0x6E, 0xB0, 
+0x25, 0x48, 0x83, 0xA1, 0x01, 0xDB, 0xE5, 0x78, 
+0xE6, 0x68, 0x5D, 0x5A, 0x16, 0x5E, 0x99, 0x68, 
+0x9C, 0x45, 0x8B, 0x62, 0x1E, 0x2C, 0x5F, 0x0D, 
+0x00, 0xBC, 0x26, 0xB3, 0x29, 0x69, 0xF2, 0x91, 
+0xAD, 0xD6, 0x8C, 0x5C, 0x50, 0x5D, 0x76, 0x9D, 
+0x0F, 0x88, 0x38, 0x62, 0x4A, 0xA5, 0x10, 0x77, 
+0x83, 0xA3, 0xC2, 0x82, 0xD2, 0xCF, 0x93, 0xFC, 
+0xC6, 0x10, 0x20, 0x3A, 0x71, 0x0A, 0x60, 0xF0, 
+0xFA, 0xF5, 0xE7, 0xF3, 0x3B, 0x34, 0xCF, 0x0B, 
+0x82, 0x46, 0x51, 0x50, 0x02, 0x45, 
+
+0xB9, 0xD0, 0xFA, 0xDE, 0x1A, 0x3A, 0x12, 0xC7, 
+0x17, 0x30, 0xF8, 0xD4, 0xF9, 0x29, 0x48, 0x3D, 
+0x4A, 0xE8, 0x06, 0x86, 0x67, 0x09, 0xED, 0x58, 
+0x5A, 0x67, 0x13, 0x8E, 0x80, 0x03, 0xA9, 0x57, 
+0xC4, 0x4F, 0x90, 0xF9, 0x71, 0xA3, 0x2C, 0xAC, 
+0x1B, 0x0E, 0x84, 0xE5, 0xB3, 0xC7, 0x57, 0x20, 
+0x53, 0xB7, 0x08, 0xFA, 0xA7, 0x4B, 0x76, 0x10, 
+0xB9, 0x36, 0x65, 0xCD, 0x73, 0x47, 0xBF, 0x48, 
+0x16, 0xBB, 0x11, 0xED, 0x16, 0xBE, 0x08, 0x23, 
+0xC8, 0x66, 0x1D, 0xE7, 0x60, 0x72, 0x9D, 0xE6, 
+0x6C, 0x08, 0x46, 0xA0, 0x47, 0x23, 0x7B, 0x75, 
+0xF4, 0xAD, 0x9A, 0x2D, 0x82, 0xEE, 0xE9, 0x3E, 
+0xB9, 0xFD, 0x51, 0x3F, 0x5F, 0x42, 0xDB, 0xD1, 
+0xDE, 0xA8, 0x42, 0xC5, 0x6E, 0xAD, 0x43, 0x17, 
+0xA1, 0xD7, 0x74, 0xB9, 0xC3, 0x6E, 0x18, 0x3D, 
+0x6A, 0xAB, 0x30, 0x0F, 0xFB, 0x73, 0x9B, 0xC1, 
+0xDE, 0x1A, 0x76, 0x20, 0x22, 0x61, 0xB3, 0xA1, 
+0xB3, 0x17, 0x4E, 0xF6, 0xE7, 0xA4, 0x80, 0x60, 
+0x91, 0x11, 0xB7, 0x68, 0x65, 0x5F, 0xDB, 0xF5, 
+0x73, 0x3F, 0xF2, 0xDC, 0x63, 0xF6, 0xAC, 0x9F, 
+0x2E, 0x87, 0x23, 0x19, 0xAC, 0x4D, 0x3E, 0xC0, 
+0x3B, 0xC4, 0xDA, 0x20, 0xC5, 0x0D, 0xC5, 0xE2, 
+0x21, 0xEA, 0x4E, 0x16, 0x80, 0x3F, 0x65, 0xCF, 
+0xA5, 0x7B, 0x80, 0xAC, 0xE0, 0xA9, 0x85, 0xEF, 
+0x94, 0x89, 0x85, 0xCA, 0xD9, 0x0C, 0xC4, 0xA3, 
+0x0E, 0xE6, 0x61, 0xFA, 0xE3, 0xAD, 0xDE, 0xDE, 
+0x8E, 0xCB, 0x32, 0x56, 0x31, 0xD0, 0xD4, 0x16, 
+0x18, 0xC5, 0x18, 0xDF, 0x43, 0xDC, 0xD3, 0xA8, 
+0x3E, 0x3B, 0x5F, 0x2C, 0x93, 0x92, 0x7B, 
+
+0x0A, 0xDF, 0x50, 0xAD, 0x65, 0x20, 0x2F, 0x60, 
+0x98, 0xF2, 0xE7, 0xA9, 0xC2, 0x4F, 0x74, 0xE4, 
+0x05, 0x56, 0x76, 0xB1, 0xEE, 0x38, 0xD6, 0x70, 
+0x60, 0x14, 0xD2, 0xE3, 0x51, 0xA8, 0xC5, 0x53, 
+0xE1, 0xBF, 0xEF, 0x30, 0x79, 0x70, 0xC5, 0x3A, 
+0x41, 0xAA, 0x30, 0xF1, 0xD7, 0xCC, 0x16, 0xBB, 
+0x57, 0x7D, 0xD9, 0x4F, 0xCF, 0x3A, 0x87, 0x33, 
+0xD1, 0x72, 0x1A, 0xA8, 0x08, 0x91, 0x4C, 0xA6, 
+0xC6, 0xE4, 0x94, 0x3E, 0xA9, 0x0C, 0xAE, 0xB6, 
+0x53, 0x90, 0xF3, 0x30, 0x7F, 0x08, 0x06, 0x6F, 
+0x4F, 0xC9, 0x3C, 0x9E, 0x50, 0x31, 0xF7, 0x0D, 
+0x60, 0xB1, 0xBA, 0xF0, 0x02, 0x22, 0xB6, 0x7E, 
+0x6E, 0x43, 0xDF, 0xC2, 0x29, 0xEE, 0x64, 0x32, 
+0x0F, 0xE8, 0xFA, 0xFB, 0xAF, 0x16, 0x6C, 0xD2, 
+0x25, 0x2D, 0xFF, 0x9B, 0x49, 0x97, 0x0F, 0x5E, 
+0xCD, 0xEA, 0x73, 0x01, 0xE5, 0x6E, 0x2F, 0x37, 
+0x21, 0xAF, 0xCD, 0xB6, 0x74, 0x3D, 0x0E, 0x9E, 
+0x87, 0x68, 0x13, 0x39, 0x6F, 0xF4, 0xCE, 0xD4, 
+0x42, 0xEC, 0x30, 0x47, 0x22, 0x1D, 0x6B, 0x43, 
+0x43, 0xAD, 0xF8, 0xA2, 0x03, 0x96, 0x14, 0x0E, 
+0x79, 0xF8, 0x68, 0x28, 0xF1, 0x68, 0xDE, 0x06, 
+0xBF, 0x3D, 0x9B, 0x23, 0xD6, 0x51, 0xA6, 0xF7, 
+0xDA, 0xC9, 0xC9, 0x2D, 0x11, 0x36, 0xC1, 0xF9, 
+0x7F, 0x90, 0x22, 0x6B, 0xBB, 0x49, 0x0A, 0xCF, 
+0x30, 0x88, 0x7E, 0x5C, 0xB3, 0x2C, 0xC1, 0x1E, 
+0x90, 0xCB, 0x36, 0xDF, 0xA0, 0x6A, 0x40, 0xE3, 
+0x25, 0x10, 0xB7, 0x33, 0x09, 0xD4, 0x84, 0x99, 
+0xF6, 0x45, 0x96, 0x4D, 0x9F, 0x89, 0x10, 0x07, 
+0x8D, 0x60, 0x57, 0x7E, 0x7F, 0xBD, 0xDB, 0x55, 
+
+0x80, 0x9F, 0x6B, 0xD7, 0x69, 0xF5, 0xDA, 0xD1, 
+0x98, 0x70, 0x15, 0xCC, 0x6F, 0xE0, 0xFE, 0x3E, 
+0x45, 0x70, 0xA9, 0xE8, 0x65, 0xBC, 0x82, 0xBA, 
+0x92, 0xEA, 0x3E, 0xB2, 0x60, 0xE2, 0x0B, 0x46, 
+0x98, 0x61, 0xE9, 0xC6, 0xCB, 0x11, 0x31, 0x70, 
+0x8D, 0x43, 0xB6, 0xEF, 0xF1, 0xA0, 0xB5, 0x69, 
+0xEC, 0xD1, 0xC5, 0xE0, 0x97, 0xA8, 0x9A, 0x46, 
+0xA3, 0x4F, 0x80, 0x15, 0x40, 0x2E, 0x1C, 0xAC, 
+0x35, 0x12, 0x28, 0x44, 0x5F, 0xC1, 0x0A, 0xCB, 
+0xBD, 0x14, 0xA0, 0x8C, 0x2E, 0x6E, 0x8C, 0x3F, 
+0x34, 0xA3, 0x0C, 0x66, 0x76, 0xDE, 0xC8, 0x5D, 
+0xDA, 0x41, 0xC6, 0xCA, 0xD8, 0xF5, 0x56, 0x67, 
+0xD1, 0x1C, 0xD5, 0x46, 0xAB, 0x64, 0x3C, 0x42, 
+0x30, 0xBD, 0x8F, 0x55, 0xD0, 0x59, 0xC1, 0x97, 
+0x99, 0x52, 0x5A, 0xB1, 0x91, 0x9B, 0x2D, 0x6B, 
+0x64, 0x08, 0xA6, 0x77, 0x8C, 0x65, 0xED, 0x6B, 
+0xF8, 0xC4, 0x8A, 0xEB, 0xBE, 0xC6, 0x3E, 0x4F, 
+0x18, 0x04, 0xA6, 0xCD, 0x3A, 0xED, 0xD2, 0x8A, 
+0xE3, 0x25, 0xCB, 0xF6, 0x7B, 0x8F, 0x78, 0xC5, 
+0xEF, 0x2F, 0x58, 0x44, 0xCF, 0x87, 0x47, 0x63, 
+0x05, 0xB8, 0x76, 0x7A, 0x4F, 0x92, 0xD4, 0x99, 
+0x98, 0x47, 0xB7, 0x39, 0x3E, 0xD0, 0x2E, 0x79, 
+0xEC, 0x4C, 0xAC, 0xF7, 0x8C, 0xB3, 0x9C, 0xB9, 
+0x63, 0xC0, 0xC1, 0x6E, 0x4D, 0xA3, 0x49, 0x41, 
+0xD0, 0x74, 0xF0, 0x79, 0x10, 0xD4, 0xA3, 0xDD, 
+0x28, 0xFD, 0x2F, 0x14, 0x1E, 0xC2, 0xB2, 0xC5, 
+0x74, 0xD0, 0xC8, 0x1A, 0x5C, 0xA8, 0x30, 0x5A, 
+0x96, 0x69, 0x96, 0x3E, 0xC4, 0xDB, 0x20, 0x51, 
+0x18, 0x0B, 0x35, 0x7E, 0x0B, 0xC6, 0x6E, 0xE2, 
+0x54, 
+
+0xFB, 0x02, 0xA7, 0xC7, 0x89, 0x7D, 0xC1, 0x02, 
+0x3E, 0xCF, 0x70, 0xF0, 0xA0, 0xAA, 0x68, 0x2F, 
+0x1B, 0x78, 0x14, 0x10, 0xC2, 0x63, 0x55, 0x73, 
+0x01, 0xD2, 0x27, 0xB8, 0xCE, 0xFA, 0x0E, 0x91, 
+0xC8, 0xE9, 0x99, 0xFE, 0xED, 0xBE, 0x73, 0x78, 
+0x85, 0xD8, 0x65, 0xE7, 0x8E, 0x27, 0xC5, 0x22, 
+0xE5, 0x99, 0xFF, 0x24, 0x04, 0x75, 0xA9, 0x28, 
+0xB1, 0x25, 0xA0, 0x9F, 0x3E, 0x6B, 0xD9, 0x79, 
+0xA4, 0xD5, 0x8D, 0x5B, 0x3A, 0xBA, 0x9A, 0xE7, 
+0xEF, 0x90, 0x5B, 0x0E, 0xD8, 0x6A, 0x33, 0x26, 
+0xD9, 0xD7, 0xC1, 0x0B, 0x8E, 0xAE, 0x65, 0xD0, 
+0x98, 0x69, 0xEF, 0xB3, 0x2F, 0x88, 0x1B, 0xC3, 
+0xD9, 0xFB, 0x0D, 0x8A, 0x62, 0xE0, 0x4C, 0x46, 
+0x54, 0x0F, 0x3F, 0xEF, 0xA4, 0x01, 0x11, 0xF0, 
+0x68, 0x0E, 0x4E, 0x1C, 0x33, 0xC3, 0xCA, 0x4E, 
+0x5E, 0x51, 0xF3, 0xE0, 0x30, 0xDE, 0x12, 0x9D, 
+0x12, 0x53, 0x8A, 0x11, 0x94, 0xF8, 0x4E, 0x1C, 
+0x5F, 0x2D, 0xE0, 0xA0, 0xD0, 0x3B, 0xBB, 0xDB, 
+0x9C, 0xB3, 0x24, 0x6D, 0xFA, 0x91, 0xCF, 0xEC, 
+0x86, 0xD0, 0x7A, 0x76, 0xC2, 0x03, 0xE8, 0xDB, 
+0x9F, 0xBA, 0xB9, 0x58, 0x43, 0xFA, 0xB2, 0x37, 
+0xF7, 0xF1, 0x1B, 0x94, 0x9B, 0x53, 0x91, 0x7A, 
+0xAB, 0xC5, 0x6D, 0x83, 0xF4, 0x9B, 0x9B, 0x71, 
+0xB5, 0x00, 0x39, 0x93, 0xDD, 0x1B, 0xB6, 0xBA, 
+0xF4, 0xD0, 0x78, 0x6B, 0xCD, 0x7A, 0x00, 0xA9, 
+0xA4, 0x58, 0x21, 0xFD, 0x8C, 0x7E, 0x55, 0x7D, 
+0xDA, 0x20, 0xE1, 0xB6, 0xC3, 0xF0, 0x3B, 0x4C, 
+0x80, 0xA1, 0x12, 0x1B, 0xA3, 0xB1, 0xE7, 0xF1, 
+0x03, 0x78, 0xF7, 0x6A, 0xE6, 0xFA, 0xE5, 0xC6, 
+0xF4, 0xB2, 
+
+0x97, 0x4E, 0x1B, 0xFC, 0x60, 0x7A, 0xBA, 0xFB, 
+0x90, 0x1C, 0x7B, 0x78, 0x2C, 0x62, 0x7A, 0xA3, 
+0x1F, 0x28, 0x09, 0x3E, 0xE4, 0xB9, 0x1F, 0x02, 
+0xA2, 0x68, 0xAF, 0x8D, 0x60, 0x5B, 0x20, 0x71, 
+0x7B, 0x28, 0x82, 0xE1, 0x6F, 0xC5, 0xA2, 0xC2, 
+0x7A, 0xA5, 0x21, 0xB4, 0x49, 0xD7, 0xC7, 0x73, 
+0x7D, 0x60, 0x40, 0x15, 0x49, 0x54, 0x57, 0x4A, 
+0x2D, 0x91, 0xE3, 0x7C, 0x00, 0xDF, 0xBC, 0xB2, 
+0xE9, 0x8B, 0x02, 0x1B, 0x1C, 0x10, 0xBC, 0xB1, 
+0xF3, 0x96, 0x7F, 0x2B, 0x5F, 0x29, 0x2A, 0xF2, 
+0xD1, 0xA5, 0xE3, 0xF0, 0x9A, 0xFB, 0x05, 0xD3, 
+0x66, 0xC3, 0x26, 0x6C, 0xE5, 0xC1, 0x0B, 0x4E, 
+0x51, 0xC9, 0x80, 0xEC, 0x2F, 0x39, 0x99, 0xB6, 
+0xC7, 0x79, 0x7C, 0x8B, 0xB7, 0x4D, 0x1A, 0x78, 
+0x4F, 0xB6, 0xB8, 0x86, 0xC9, 0x9D, 0x66, 0x11, 
+0x05, 0xBA, 0x12, 0x82, 0x38, 0x91, 0x9F, 0x97, 
+0x5D, 0x0F, 0x55, 0xBC, 0x7F, 0xA9, 0x89, 0x7E, 
+0xEE, 0x1E, 0x0F, 0xB1, 0xFC, 0x50, 0xCC, 0xC7, 
+0x3A, 0x72, 0x82, 0x8A, 0xB5, 0xB2, 0x6E, 0x5F, 
+0xC2, 0x6A, 0x64, 0x06, 0x9A, 0x43, 0xCB, 0xF1, 
+0x3F, 0x49, 0xB8, 0x1A, 0x41, 0xB0, 0x89, 0x7B, 
+0xB9, 0xC7, 0xD1, 0xD0, 0x29, 0x8A, 0xFD, 0xB4, 
+0x36, 0xE8, 0x70, 0x2D, 0x3F, 0x88, 0x9E, 0x08, 
+0xFA, 0x8C, 0x97, 0x46, 0xF8, 0x68, 0xE5, 0x73, 
+0xCC, 0xB3, 0xCB, 0x22, 0x83, 0x23, 0x96, 0x34, 
+0x2F, 0xBD, 0x15, 0xDF, 0x29, 0xF2, 0xC6, 0x01, 
+0x66, 0xF5, 0x23, 0x7F, 0x36, 0xB5, 0x65, 0xF6, 
+0xA4, 0x52, 0x3A, 0xDD, 0x76, 0xCB, 0xF3, 0x7A, 
+0x1A, 0x58, 0xD6, 0x90, 0x1E, 0x01, 0xBB, 0xC2, 
+0xDD, 0xF4, 0x8B, 
+
+0x55, 0x07, 0x1F, 0x58, 0x71, 0x29, 0x9D, 0xDB, 
+0xEA, 0x39, 0xA5, 0xBF, 0xE5, 0xA3, 0x01, 0x65, 
+0x5D, 0x07, 0x98, 0xC5, 0x54, 0x1E, 0x13, 0x72, 
+0x4D, 0xF4, 0x60, 0xD7, 0x0D, 0x8C, 0x22, 0x3F, 
+0x9D, 0xFE, 0x76, 0xCA, 0x76, 0x64, 0x76, 0xF0, 
+0x62, 0x79, 0x70, 0x86, 0x06, 0x88, 0xCA, 0x3B, 
+0x85, 0xAD, 0x9E, 0x0F, 0xEF, 0x74, 0xDB, 0x97, 
+0x31, 0x0F, 0xCD, 0x2C, 0x0C, 0x54, 0xDB, 0x76, 
+0x79, 0xAE, 0xBD, 0xE8, 0x15, 0x5E, 0x01, 0x10, 
+0x1A, 0x96, 0x9D, 0xC6, 0x30, 0x4B, 0x7F, 0xBE, 
+0xDB, 0x05, 0x12, 0x93, 0x1F, 0xD3, 0x02, 0x7F, 
+0xAF, 0x7A, 0x11, 0x3C, 0x54, 0x78, 0xB7, 0xF1, 
+0x80, 0xDE, 0x4E, 0x78, 0xF4, 0x57, 0x1E, 0x58, 
+0xC0, 0x15, 0x4E, 0x56, 0x43, 0x68, 0xA9, 0x42, 
+0x1A, 0x0A, 0x04, 0x6D, 0x90, 0x0C, 0x08, 0x42, 
+0x15, 0xDE, 0x1D, 0x63, 0x18, 0x62, 0x24, 0xE2, 
+0x09, 0x69, 0x55, 0x12, 0x92, 0x85, 0x09, 0x25, 
+0x07, 0x40, 0xFC, 0x0E, 0x0F, 0xC8, 0x13, 0xEE, 
+0x0D, 0xA3, 0xEA, 0x5E, 0x4A, 0xE3, 0x26, 0x43, 
+0x41, 0x78, 0x9C, 0x70, 0xF9, 0x25, 0x12, 0x86, 
+0x0A, 0x5F, 0xE8, 0x00, 0xC6, 0xB7, 0x5A, 0x15, 
+0x9D, 0xF1, 0xB0, 0x67, 0x67, 0x70, 0x80, 0xDE, 
+0xDA, 0x46, 0x54, 0x17, 0xC9, 0x14, 0x00, 0xF1, 
+0x1D, 0xC2, 0x1E, 0xCD, 0x03, 0x40, 0x32, 0xAE, 
+0x8F, 0x3C, 0x10, 0x2B, 0xC9, 0xE7, 0x56, 0x8E, 
+0xA5, 0x86, 0xF4, 0x72, 0x3B, 0x06, 0xC3, 0x69, 
+0x1E, 0xAD, 0x1C, 0xD4, 0xAB, 0xBE, 0x59, 0x24, 
+0xF2, 0xB2, 0xF4, 0xE4, 0x9C, 0x70, 0x0F, 0xC7, 
+0xF9, 0xDB, 0x60, 0x10, 0x8D, 0x55, 0x20, 0xE9, 
+0x2E, 0x06, 0x79, 0xD3, 
+
+0x83, 0x3E, 0x3E, 0xEB, 0xB1, 0x59, 0xDD, 0xDB, 
+0x6C, 0x6F, 0x5B, 0xBE, 0x12, 0xF6, 0x6C, 0x9A, 
+0xAD, 0xC2, 0x82, 0xBD, 0x73, 0x5A, 0x6C, 0x9C, 
+0x7D, 0xCB, 0xA0, 0x3F, 0x34, 0x7A, 0xFE, 0xF4, 
+0x3F, 0x04, 0xF9, 0xBD, 0xE9, 0x76, 0x4B, 0x81, 
+0x1A, 0x4D, 0x0C, 0xDA, 0x69, 0x83, 0xDE, 0x29, 
+0xDD, 0x2C, 0x7D, 0x7C, 0x7B, 0xA2, 0xC1, 0x4E, 
+0xCD, 0x3C, 0x69, 0xF0, 0xFE, 0xB0, 0x11, 0x9C, 
+0x2A, 0xFB, 0x03, 0x44, 0xD3, 0x53, 0xA6, 0x90, 
+0x85, 0xBC, 0x34, 0x92, 0x2A, 0x04, 0xA1, 0xB3, 
+0x42, 0x2E, 0x44, 0x3E, 0x4F, 0x7E, 0x26, 0xA1, 
+0xDF, 0xD6, 0x15, 0x49, 0xE3, 0x8A, 0xCB, 0x98, 
+0x1D, 0xBB, 0x0C, 0x5E, 0xF3, 0xC7, 0x61, 0x0A, 
+0x70, 0x3B, 0x4A, 0x07, 0xF1, 0x19, 0x4B, 0x88, 
+0x49, 0x29, 0x4F, 0x7E, 0xAD, 0xDC, 0x0E, 0xC9, 
+0x31, 0xEB, 0x92, 0xAE, 0x39, 0x36, 0x32, 0x3C, 
+0xEA, 0x76, 0xF2, 0x50, 0x2F, 0x56, 0x8B, 0x5E, 
+0xCE, 0xF4, 0xA6, 0x71, 0x1E, 0xE9, 0x3D, 0x98, 
+0x14, 0x20, 0x32, 0x0A, 0x53, 0xCA, 0xFC, 0x1D, 
+0xE6, 0x8B, 0xAC, 0x23, 0x5E, 0x54, 0x87, 0x45, 
+0x1B, 0xBF, 0xB2, 0xD2, 0x8C, 0x17, 0x7E, 0x81, 
+0xBB, 0xF5, 0x54, 0x47, 0xFA, 0xD6, 0x34, 0x5E, 
+0xD4, 0x9A, 0x23, 0x53, 0x65, 0x5E, 0xFC, 0x28, 
+0x94, 0xD2, 0xC9, 0x31, 0x58, 0x52, 0x4E, 0x39, 
+0xC9, 0xA3, 0x2D, 0x49, 0x84, 0xA7, 0x7F, 0xB2, 
+0x9C, 0xE8, 0xE4, 0xB0, 0x69, 0x56, 0xBE, 0x5F, 
+0xAA, 0x7E, 0x16, 0xF0, 0xFE, 0xE0, 0xB6, 0x6F, 
+0x3B, 0x55, 0xA2, 0x8C, 0x90, 0xEE, 0xF6, 0xFB, 
+0x2B, 0x31, 0xF5, 0xF6, 0xCB, 0xA9, 0x79, 0xEC, 
+0x14, 0xBE, 0xBD, 0x20, 0xBF, 
+
+0xEB, 0x25, 0x75, 0x3F, 0x92, 0x56, 0x8F, 0x2E, 
+0xFF, 0x5C, 0x29, 0xFE, 0xCD, 0x9C, 0xAF, 0x6F, 
+0x21, 0xBC, 0x53, 0xC1, 0xEC, 0xC6, 0xD9, 0xA7, 
+0x7E, 0xBF, 0xD1, 0x63, 0xAB, 0xA7, 0xFC, 0xFE, 
+0x5C, 0xFA, 0xE1, 0xF4, 0x73, 0x95, 0x80, 0x79, 
+0x22, 0x98, 0xCF, 0xA4, 0xFC, 0xE5, 0x68, 0xB8, 
+0xB4, 0x40, 0x1A, 0xDA, 0x59, 0x2A, 0xDB, 0x0E, 
+0xFF, 0x9A, 0x57, 0xF7, 0x38, 0x41, 0xE7, 0x2F, 
+0xF3, 0x96, 0xF1, 0xC9, 0x21, 0xD8, 0xCD, 0xA9, 
+0x89, 0xF4, 0x7C, 0x6C, 0xEC, 0xC7, 0x75, 0x41, 
+0xCF, 0x51, 0x55, 0xFA, 0x95, 0x67, 0xA3, 0x52, 
+0x4C, 0x6F, 0x8D, 0x29, 0x7F, 0x7C, 0x06, 0x57, 
+0xA3, 0x3E, 0x91, 0x0E, 0xC0, 0x53, 0xCD, 0x16, 
+0x59, 0xDF, 0x34, 0x1A, 0x1A, 0xF1, 0x20, 0xD4, 
+0xBA, 0x45, 0x82, 0x9D, 0x07, 0x49, 0x94, 0x11, 
+0x2A, 0xF7, 0x57, 0xA7, 0x67, 0x0A, 0xFF, 0xF6, 
+0x87, 0x06, 0x8D, 0x71, 0x8E, 0xC2, 0xAF, 0xA0, 
+0x2D, 0x66, 0xA6, 0xF5, 0x74, 0x9E, 0xA8, 0x5A, 
+0x7C, 0x26, 0xE6, 0x2F, 0x70, 0x21, 0xC5, 0x28, 
+0x11, 0x83, 0x1F, 0x91, 0x21, 0xEB, 0xC4, 0x84, 
+0xF5, 0x69, 0xAD, 0xDA, 0x68, 0x3E, 0xC8, 0x83, 
+0x0A, 0x01, 0xB5, 0x04, 0xC9, 0x7A, 0x07, 0x80, 
+0x7C, 0x06, 0xA2, 0x9E, 0xED, 0x9D, 0x5F, 0x37, 
+0x49, 0x31, 0x00, 0xF8, 0xCC, 0x9A, 0x75, 0x99, 
+0x6C, 0x55, 0xB0, 0xE8, 0xEC, 0x91, 0x34, 0x0D, 
+0x4D, 0x6F, 0x97, 0xFB, 0x66, 0xCB, 0x78, 0x61, 
+0x9D, 0xB1, 0x89, 0x6E, 0x8F, 0x64, 0x3A, 0x5F, 
+0x84, 0xCD, 0x0C, 0x1B, 0x42, 0x13, 0xD7, 0xB5, 
+0x20, 0x9C, 0x63, 0xFC, 0x71, 0x38, 0x52, 0x58, 
+0xA2, 0xC2, 0x22, 0xCF, 0x92, 0x08, 
+
+0x6C, 0xDE, 0xA2, 0x78, 0x50, 0xAA, 0x49, 0x8D, 
+0x7B, 0x75, 0xA7, 0x0E, 0x8D, 0x5C, 0xDF, 0x98, 
+0xB3, 0x01, 0xF4, 0x21, 0x92, 0x38, 0x74, 0x67, 
+0xE4, 0x97, 0xB8, 0x84, 0x7F, 0x04, 0x4F, 0x71, 
+0x49, 0xC5, 0x27, 0xE7, 0x23, 0x40, 0x28, 0x86, 
+0x65, 0x2B, 0x78, 0x09, 0x0A, 0xEB, 0xC5, 0xA8, 
+0x0A, 0x09, 0x63, 0x92, 0xAF, 0x64, 0x49, 0xFD, 
+0x8C, 0x66, 0x1B, 0x20, 0x2B, 0xE8, 0x44, 0x41, 
+0x49, 0x0D, 0x3B, 0xB2, 0xAC, 0x51, 0x67, 0x63, 
+0xDC, 0x26, 0xD6, 0xB3, 0x4E, 0x34, 0x9C, 0x39, 
+0x36, 0x0A, 0x76, 0x3D, 0xF3, 0x01, 0xDC, 0x9C, 
+0xED, 0x4F, 0xC8, 0x23, 0xEE, 0x07, 0x5F, 0x1A, 
+0x16, 0x24, 0x3A, 0x16, 0x42, 0x69, 0xDE, 0x23, 
+0x15, 0x8B, 0x4F, 0x78, 0x73, 0x7A, 0x4F, 0x50, 
+0x36, 0xC8, 0xB3, 0x92, 0x36, 0x2C, 0x81, 0xEB, 
+0x95, 0x44, 0x50, 0x02, 0xD2, 0xDE, 0x4A, 0x28, 
+0x13, 0xA8, 0xA9, 0x02, 0xB2, 0xB6, 0x71, 0xC4, 
+0xDF, 0x8D, 0xA4, 0x74, 0xC5, 0xC6, 0xA7, 0x92, 
+0x48, 0x8B, 0xCB, 0xD9, 0x2A, 0xF4, 0x27, 0x70, 
+0x25, 0x8E, 0xE7, 0x32, 0x6F, 0xB3, 0x0E, 0xE7, 
+0xDF, 0x3B, 0x53, 0x6A, 0x1D, 0xDB, 0x38, 0xB5, 
+0x21, 0x30, 0x35, 0xE9, 0xDC, 0x25, 0x4C, 0x39, 
+0x2D, 0x09, 0x32, 0x1C, 0x11, 0xBC, 0x78, 0x30, 
+0xA0, 0xD1, 0xBD, 0xE7, 0xDB, 0x77, 0x41, 0x0A, 
+0x17, 0x86, 0x45, 0x6B, 0x2F, 0x80, 0xCB, 0xF3, 
+0x89, 0xBA, 0xD2, 0xEC, 0xDC, 0xA6, 0x3E, 0xD7, 
+0x69, 0xF9, 0x8C, 0xD8, 0x92, 0x7E, 0x0F, 0x81, 
+0xFC, 0x23, 0x45, 0x15, 0x50, 0xE0, 0xB4, 0xCE, 
+0x5C, 0x3C, 0x7F, 0x8B, 0x0B, 0xE3, 0x4F, 0xA4, 
+0x33, 0x04, 0x25, 0x0D, 0xD1, 0x62, 0x8D, 
+
+0x73, 0x71, 0x31, 0xFC, 0x20, 0x1E, 0xFA, 0x20, 
+0x8F, 0x34, 0x82, 0x6F, 0x78, 0x4E, 0x0D, 0xCE, 
+0xC8, 0xAE, 0xDC, 0x72, 0xC3, 0x33, 0x17, 0x17, 
+0x35, 0x6D, 0xF1, 0x69, 0xD6, 0x20, 0xED, 0x14, 
+0xBC, 0x1D, 0xD1, 0x36, 0xB4, 0x29, 0xFC, 0xA0, 
+0xCA, 0x06, 0xE8, 0x09, 0xFE, 0x51, 0xAC, 0x69, 
+0xB8, 0x15, 0x35, 0x25, 0x85, 0x43, 0xD1, 0x71, 
+0x60, 0x7F, 0x4E, 0x26, 0xEC, 0xB5, 0x49, 0x0E, 
+0x78, 0x19, 0x3F, 0xFF, 0x0C, 0xD4, 0x67, 0x9B, 
+0xA9, 0x21, 0x1E, 0x59, 0x29, 0xC4, 0x5E, 0x79, 
+0xCE, 0xA9, 0x3B, 0xF1, 0xF3, 0xD8, 0x57, 0x15, 
+0x4E, 0xB3, 0x76, 0x9E, 0x19, 0x2E, 0xEA, 0x68, 
+0x05, 0x37, 0x86, 0x05, 0x8B, 0x70, 0x34, 0x61, 
+0xD6, 0xB2, 0x4B, 0x3D, 0x75, 0xED, 0x83, 0xB1, 
+0xC3, 0x75, 0x00, 0xA4, 0x07, 0x78, 0xD1, 0x11, 
+0x78, 0xD0, 0x58, 0x0E, 0xF6, 0x5D, 0xBF, 0x15, 
+0xDF, 0xA3, 0x2D, 0x0A, 0xC6, 0x55, 0x49, 0x7D, 
+0x23, 0xA0, 0xF5, 0x8D, 0xD5, 0xDF, 0x18, 0x4E, 
+0x62, 0x53, 0x2F, 0x77, 0xDA, 0x45, 0x65, 0xC6, 
+0x31, 0xF3, 0x07, 0xA1, 0x9A, 0x22, 0x84, 0x4E, 
+0x28, 0x94, 0x8C, 0xC0, 0x8E, 0x29, 0x7F, 0xD6, 
+0x2F, 0xE9, 0xCA, 0xF0, 0x17, 0x24, 0x4C, 0x61, 
+0x3C, 0x6D, 0x96, 0x24, 0xDB, 0x86, 0xE1, 0x75, 
+0x0A, 0xD4, 0x3F, 0x42, 0x24, 0x4C, 0x37, 0x5F, 
+0x9F, 0xE7, 0x46, 0xA8, 0x44, 0x2A, 0xDF, 0xF9, 
+0x1C, 0xCD, 0x57, 0x30, 0xF7, 0x3E, 0x5E, 0x8A, 
+0x82, 0xF4, 0x01, 0x0F, 0x1B, 0xD7, 0x69, 0x90, 
+0xA2, 0x24, 0x44, 0x9C, 0xDA, 0xE0, 0xCF, 0xB1, 
+0x0B, 0x62, 0xF5, 0x3B, 0x68, 0xD2, 0xC4, 0x1D, 
+0x10, 0xDC, 0x86, 0xF1, 0x05, 0x2D, 0x04, 0x0B, 
+
+0xA2, 0xBC, 0x85, 0x31, 0xCC, 0x92, 0x93, 0xF8, 
+0x7D, 0x7C, 0x5D, 0xF9, 0xA0, 0xCB, 0x47, 0xFD, 
+0xAD, 0x4D, 0xF3, 0x29, 0xDC, 0xF8, 0x26, 0x82, 
+0x36, 0x2D, 0x86, 0xB5, 0x6B, 0x33, 0x67, 0x8D, 
+0x46, 0x56, 0xC3, 0x97, 0x10, 0x90, 0x2A, 0xC6, 
+0x11, 0x3E, 0x92, 0x0B, 0xEE, 0x2C, 0xC7, 0x01, 
+0xDE, 0x4F, 0x5E, 0x3A, 0xA3, 0xFB, 0xB7, 0x46, 
+0xDF, 0xFC, 0xDB, 0xF6, 0xDD, 0x6C, 0x00, 0x1E, 
+0xD5, 0x2B, 0xCF, 0x09, 0xC8, 0x62, 0xF0, 0x7A, 
+0x3A, 0xE4, 0x59, 0x1A, 0x60, 0xE8, 0x8E, 0xFF, 
+0x46, 0x76, 0x33, 0xBA, 0x65, 0x9C, 0xBA, 0x60, 
+0xD1, 0x8E, 0x40, 0xB5, 0x2D, 0x8C, 0xDE, 0x6C, 
+0x75, 0x1C, 0x8B, 0x97, 0x6E, 0x57, 0x0A, 0x67, 
+0x36, 0x0E, 0x70, 0x4E, 0xB3, 0x91, 0x7C, 0x94, 
+0x48, 0x31, 0x85, 0x0D, 0xDF, 0x44, 0x75, 0x7C, 
+0x64, 0x67, 0xF8, 0xF1, 0x57, 0x31, 0x91, 0x41, 
+0xDE, 0x65, 0xDB, 0x6A, 0x62, 0xEE, 0x06, 0xD5, 
+0xE2, 0xD9, 0x6C, 0x56, 0x04, 0xAF, 0x8C, 0x2D, 
+0xC4, 0xE0, 0x86, 0x4F, 0x3B, 0x68, 0x3E, 0x1F, 
+0xC3, 0x4D, 0x68, 0x15, 0xAE, 0x35, 0x5F, 0x46, 
+0xF7, 0x32, 0x2E, 0x42, 0xBE, 0x24, 0xBD, 0xC5, 
+0x80, 0x68, 0x25, 0xE9, 0xED, 0x9A, 0x59, 0xD4, 
+0x8E, 0xF3, 0xBA, 0x8F, 0xA6, 0xFB, 0x50, 0x8D, 
+0x8B, 0xEB, 0x79, 0x2D, 0x03, 0x9A, 0xFD, 0x17, 
+0xC9, 0x44, 0xAE, 0x30, 0x37, 0x9D, 0x66, 0x20, 
+0xEE, 0xD0, 0xCD, 0x85, 0x80, 0x92, 0xCC, 0xF0, 
+0x9E, 0xC8, 0x3C, 0x70, 0x3C, 0x69, 0xE2, 0x5A, 
+0x86, 0x3B, 0xFA, 0x32, 0x79, 0x4E, 0xEE, 0x2C, 
+0x3C, 0x61, 0xC7, 0x81, 0x01, 0x41, 0x50, 0x64, 
+0x2C, 0xE3, 0x18, 0x81, 0x10, 0xB9, 0x90, 0x31, 
+0x9F, 
+
+0x24, 0x12, 0x7E, 0x97, 0x59, 0x61, 0x4F, 0x84, 
+0xC0, 0x61, 0x0F, 0x79, 0xAD, 0xE2, 0x52, 0xFB, 
+0xC1, 0x8A, 0x79, 0x36, 0x50, 0xB1, 0x96, 0x66, 
+0x64, 0x35, 0x46, 0xC1, 0x1C, 0x46, 0x47, 0xED, 
+0x25, 0x18, 0xF8, 0x91, 0xE2, 0x42, 0xCF, 0x6E, 
+0x8E, 0xE9, 0x66, 0xD9, 0x34, 0x5F, 0x10, 0x42, 
+0xE8, 0x03, 0xD5, 0xB3, 0x6E, 0xAA, 0xC3, 0x4C, 
+0x1B, 0x6C, 0xD0, 0x13, 0xEA, 0xA6, 0x28, 0xBB, 
+0x87, 0x61, 0x9E, 0x19, 0xB3, 0xDE, 0x8D, 0xE5, 
+0xA4, 0x71, 0x92, 0xF0, 0x23, 0xD7, 0x59, 0xEC, 
+0x8E, 0x97, 0x65, 0xE1, 0xF9, 0xEF, 0x3E, 0xF4, 
+0x2F, 0x6C, 0xD8, 0x91, 0x2C, 0xFB, 0x12, 0xD1, 
+0x86, 0x72, 0x64, 0x81, 0xE3, 0xDA, 0x64, 0xD8, 
+0xA4, 0x9F, 0x62, 0x07, 0x1E, 0x97, 0xFA, 0x66, 
+0x86, 0xB2, 0xDF, 0x4F, 0x17, 0xE1, 0x28, 0x89, 
+0xB0, 0x3E, 0xA8, 0x63, 0x0F, 0xA3, 0xEF, 0x30, 
+0xE8, 0xC4, 0x59, 0x6D, 0xF0, 0xCA, 0xB2, 0x5B, 
+0x3F, 0x64, 0xC3, 0x3D, 0x4B, 0xDB, 0xC0, 0x44, 
+0x9B, 0xF4, 0x4F, 0xF7, 0xB2, 0xCD, 0xE8, 0xF9, 
+0x15, 0xD3, 0x53, 0x77, 0x67, 0xDD, 0x76, 0x99, 
+0x41, 0xB4, 0xB4, 0x99, 0x74, 0x9A, 0x22, 0xEF, 
+0xA7, 0x75, 0x87, 0x5B, 0x81, 0x2C, 0xE3, 0xBA, 
+0x75, 0xA4, 0x6E, 0xEA, 0x46, 0x41, 0x90, 0xBE, 
+0xAE, 0xDB, 0x53, 0xDF, 0x1F, 0xEA, 0x85, 0x34, 
+0x32, 0xCE, 0x8E, 0xE9, 0xF0, 0x88, 0xEE, 0x96, 
+0x52, 0xAE, 0xF5, 0xB1, 0x91, 0x18, 0xC3, 0x96, 
+0xA2, 0x6C, 0xBD, 0x0A, 0x02, 0xFA, 0xC0, 0x79, 
+0x24, 0x01, 0x0B, 0x2A, 0xE9, 0xDA, 0xB1, 0xEA, 
+0x88, 0xD1, 0x56, 0x14, 0x43, 0x77, 0xBF, 0x8C, 
+0xA2, 0x94, 0xA1, 0x3C, 0xA2, 0xC8, 0x91, 0x55, 
+0x88, 0x40, 
+
+0xEA, 0x92, 0x57, 0x27, 0x1A, 0x0E, 0x5D, 0xF4, 
+0xFA, 0x78, 0xF2, 0x1A, 0x68, 0x89, 0xD4, 0x0A, 
+0x26, 0x3F, 0x20, 0xAC, 0xDA, 0xEC, 0xB6, 0x07, 
+0x18, 0xB1, 0xBA, 0x38, 0x2A, 0x6F, 0xB6, 0x43, 
+0x22, 0x19, 0xA9, 0x36, 0xA1, 0xCD, 0x4E, 0xC1, 
+0xA6, 0xA9, 0x85, 0x6A, 0x12, 0x9F, 0xD5, 0xF6, 
+0x21, 0xA6, 0x1F, 0x02, 0x3B, 0x59, 0xB7, 0x5D, 
+0x4E, 0xD5, 0x38, 0x71, 0x5E, 0x97, 0x3E, 0xE5, 
+0xB4, 0xBE, 0xF8, 0x1B, 0x32, 0xE0, 0xAF, 0x38, 
+0xD9, 0x89, 0x4C, 0x83, 0xE0, 0x45, 0xFA, 0x2A, 
+0x4D, 0xCF, 0xA7, 0x16, 0x06, 0x2B, 0x04, 0xAD, 
+0x3D, 0xFB, 0xB1, 0xEF, 0x19, 0x69, 0x95, 0xD0, 
+0x06, 0x5A, 0x3A, 0xFB, 0x71, 0xC2, 0x49, 0x3F, 
+0x16, 0xEC, 0x6A, 0xD7, 0xD9, 0x2B, 0x67, 0x3C, 
+0xD1, 0xEF, 0x42, 0x21, 0x4A, 0x31, 0xB7, 0x29, 
+0xBF, 0x2E, 0xA0, 0x82, 0x4C, 0x4C, 0x68, 0x54, 
+0x25, 0xE8, 0x81, 0x58, 0x58, 0xF6, 0x67, 0x1F, 
+0x91, 0x8B, 0xD0, 0x6A, 0xD2, 0x5F, 0x5F, 0x89, 
+0x2E, 0x2B, 0x8F, 0x5E, 0x51, 0x51, 0xD1, 0xEB, 
+0xA8, 0xCB, 0x0C, 0xC1, 0x6C, 0x00, 0xB3, 0x6D, 
+0x67, 0xCE, 0x42, 0x77, 0x79, 0x05, 0x8E, 0x16, 
+0xAF, 0x2C, 0x8E, 0x66, 0x24, 0x8F, 0xDE, 0xBB, 
+0xFC, 0xEC, 0xCB, 0x6B, 0x52, 0xAF, 0xAC, 0x38, 
+0x17, 0xFA, 0xED, 0x27, 0x54, 0x52, 0xF0, 0xC1, 
+0x19, 0x95, 0xEB, 0x89, 0x6B, 0xB3, 0xB0, 0x09, 
+0xF7, 0x4E, 0x93, 0xC5, 0x3B, 0x1C, 0x17, 0x23, 
+0x9E, 0x0E, 0xE4, 0x60, 0x2F, 0x10, 0xAC, 0xDF, 
+0xB5, 0xE1, 0x13, 0x7A, 0xD0, 0xAA, 0xA7, 0x62, 
+0x3B, 0xF3, 0x61, 0x68, 0x51, 0xAD, 0x0B, 0x1F, 
+0x42, 0x30, 0xEB, 0x04, 0xFF, 0x3C, 0x1C, 0x87, 
+0x80, 0xB4, 0xDE, 
+
+0xB2, 0xE6, 0x38, 0x87, 0x24, 0x6A, 0xF5, 0xD1, 
+0x61, 0x65, 0x44, 0xE4, 0xF7, 0x3A, 0x28, 0x72, 
+0x42, 0x78, 0x91, 0xAB, 0x4F, 0xBC, 0x36, 0x0D, 
+0xBF, 0xB0, 0xAC, 0xD3, 0xF2, 0x9B, 0x03, 0x82, 
+0x16, 0x25, 0xD2, 0x0B, 0x9C, 0xC2, 0x3E, 0x00, 
+0xF1, 0xD5, 0x9E, 0x35, 0x1F, 0xB3, 0x52, 0xC7, 
+0x8C, 0x5A, 0x3A, 0x0D, 0x22, 0xDE, 0x3F, 0xD4, 
+0x93, 0xA0, 0x90, 0xB2, 0x67, 0xEF, 0x5C, 0x34, 
+0xAA, 0xD0, 0xFE, 0x3D, 0x42, 0x46, 0x29, 0xD5, 
+0xB1, 0x60, 0xA9, 0x60, 0x2E, 0x77, 0x51, 0xAB, 
+0xC2, 0x01, 0x74, 0xFE, 0x92, 0x38, 0x43, 0xB5, 
+0x24, 0x0A, 0x87, 0xEE, 0xE9, 0xF0, 0xFA, 0x1A, 
+0x9B, 0xB8, 0x3D, 0xA7, 0x63, 0x46, 0x1C, 0x55, 
+0xC8, 0x00, 0xBF, 0xE0, 0xE7, 0xFC, 0xA8, 0x52, 
+0x36, 0xF4, 0xBE, 0x0B, 0x38, 0x40, 0x93, 0x53, 
+0xEB, 0x1F, 0x9F, 0x97, 0x6F, 0x5A, 0x10, 0xA8, 
+0x2D, 0xBA, 0x70, 0xCA, 0xF7, 0x82, 0xC5, 0xD4, 
+0x07, 0x77, 0x8B, 0xE7, 0x66, 0xE9, 0x1B, 0x34, 
+0x47, 0x52, 0xD1, 0x9D, 0xD1, 0xEC, 0x6E, 0x94, 
+0x59, 0x6A, 0xAF, 0xC7, 0xAE, 0xD6, 0x6B, 0x40, 
+0x80, 0x02, 0x11, 0xFC, 0xC3, 0x58, 0x5D, 0x8D, 
+0x89, 0xE8, 0xE9, 0xC5, 0x65, 0x7D, 0xD0, 0x8A, 
+0x25, 0xEB, 0xA5, 0x21, 0x45, 0x9E, 0x4E, 0x26, 
+0x4B, 0xDF, 0xA7, 0x0D, 0x4D, 0xBC, 0x66, 0xF3, 
+0xF1, 0x56, 0x2F, 0xE7, 0xC5, 0xB7, 0x06, 0x79, 
+0x44, 0x10, 0xDB, 0x1B, 0xA7, 0xA8, 0x86, 0x16, 
+0xD6, 0xCC, 0xA9, 0xE7, 0x75, 0x66, 0x94, 0x29, 
+0xFA, 0xF0, 0xD4, 0xD9, 0x4D, 0xA6, 0x29, 0x04, 
+0x22, 0x30, 0x4E, 0x70, 0x37, 0x8C, 0x4E, 0x68, 
+0xF2, 0x80, 0xE2, 0xE2, 0x89, 0xF8, 0x66, 0x40, 
+0x03, 0x70, 0xA5, 0xA0, 
+
+0x75, 0x9E, 0x06, 0x8A, 0x00, 0xF3, 0x3E, 0xF4, 
+0x2F, 0x8B, 0x48, 0xFE, 0x9F, 0x4E, 0x0B, 0x9D, 
+0x40, 0xF1, 0x6A, 0x07, 0x3F, 0x82, 0xE3, 0xB0, 
+0xF2, 0x52, 0xA3, 0xD3, 0x37, 0x97, 0xC4, 0xBC, 
+0xE8, 0x01, 0xBF, 0x4B, 0xCF, 0xFF, 0x1E, 0x4A, 
+0xCC, 0x13, 0xAF, 0x04, 0xC1, 0x8D, 0x0A, 0xAC, 
+0x5B, 0xA9, 0x9C, 0x59, 0x59, 0x41, 0x33, 0x42, 
+0x43, 0xCB, 0x0E, 0xD8, 0x95, 0xBA, 0x0B, 0x08, 
+0x6B, 0x6B, 0xF2, 0x51, 0xBD, 0x86, 0x44, 0x7D, 
+0xFB, 0xD1, 0xF6, 0x6D, 0x8E, 0x91, 0xC4, 0x90, 
+0x66, 0x0C, 0x91, 0x7C, 0x6B, 0x29, 0xEA, 0xC3, 
+0x28, 0xF9, 0x66, 0x49, 0xFA, 0xE8, 0x55, 0xE0, 
+0xA3, 0x72, 0x3F, 0x6A, 0x32, 0x46, 0x17, 0x9B, 
+0x7E, 0x9E, 0x48, 0xA6, 0x5E, 0x03, 0xEF, 0xC5, 
+0x71, 0x4D, 0x78, 0x1A, 0xAD, 0x61, 0xE9, 0x3F, 
+0x26, 0x06, 0x22, 0x7F, 0x4D, 0x31, 0xA3, 0x17, 
+0xD9, 0x78, 0x91, 0xA7, 0xDE, 0x50, 0x2E, 0x2C, 
+0x57, 0x18, 0xFF, 0x2C, 0x21, 0xFB, 0x39, 0xA6, 
+0x73, 0x88, 0xBF, 0xC8, 0x0C, 0x8D, 0x9C, 0x14, 
+0x1D, 0xE4, 0xFF, 0xF5, 0x76, 0x4C, 0xFC, 0xB0, 
+0x08, 0x45, 0x7C, 0x73, 0xA0, 0x60, 0x7E, 0x48, 
+0x6E, 0x29, 0xB5, 0xE4, 0x17, 0x27, 0x50, 0x0F, 
+0x76, 0x5F, 0xB1, 0x57, 0x1E, 0xD1, 0x07, 0xCA, 
+0xF8, 0x68, 0xE6, 0xE0, 0x66, 0x4B, 0x2D, 0x11, 
+0x4E, 0x8B, 0xCB, 0xE8, 0xD9, 0x7F, 0xC5, 0xF1, 
+0x1A, 0xBA, 0xDF, 0x0C, 0x0C, 0xF5, 0x8F, 0x69, 
+0x3F, 0x26, 0x0C, 0x05, 0xA1, 0xCF, 0x36, 0x6C, 
+0x7A, 0x9C, 0x9B, 0x06, 0x9E, 0x09, 0x13, 0x35, 
+0x11, 0x49, 0x41, 0xD6, 0x62, 0xB3, 0x74, 0x74, 
+0x37, 0x14, 0xD4, 0x25, 0xA7, 0x90, 0x96, 0x48, 
+0xE1, 0x20, 0x9F, 0x96, 0xB2, 
+
+0xBA, 0x19, 0x80, 0x7E, 0x2D, 0x83, 0xD8, 0x1D, 
+0xFA, 0x01, 0xB9, 0x30, 0x2F, 0x79, 0xDC, 0xBC, 
+0x31, 0x01, 0xF7, 0xA4, 0x83, 0xA6, 0x75, 0xED, 
+0x89, 0x1D, 0x36, 0x61, 0xEB, 0x6C, 0x82, 0xA9, 
+0x30, 0x12, 0x07, 0x12, 0x4C, 0x5B, 0xBA, 0x9E, 
+0x37, 0x64, 0x46, 0x0E, 0xEF, 0x2C, 0x57, 0x15, 
+0x6B, 0x68, 0xC4, 0xB8, 0x38, 0x0D, 0xCD, 0xB5, 
+0x04, 0x45, 0x9D, 0xE1, 0x9F, 0xFF, 0x0D, 0x18, 
+0xA1, 0x8A, 0x0D, 0x85, 0x15, 0x83, 0xCD, 0xC5, 
+0xB3, 0x74, 0x5A, 0x8C, 0x80, 0x08, 0x6F, 0x04, 
+0x41, 0xD3, 0x23, 0x7D, 0xEC, 0x40, 0x3E, 0x2A, 
+0xA3, 0xA5, 0x70, 0x6F, 0x31, 0x29, 0x58, 0xAE, 
+0x37, 0xA1, 0x1C, 0xAD, 0x3E, 0x57, 0xB9, 0xE8, 
+0x3A, 0xA1, 0x08, 0xA0, 0xF3, 0x61, 0x2C, 0xE8, 
+0x13, 0x4F, 0xC2, 0xAF, 0x69, 0x2B, 0xC3, 0x6B, 
+0x61, 0x1F, 0x67, 0xA6, 0xCD, 0x9B, 0xFD, 0x4C, 
+0xFB, 0xEE, 0xB6, 0x8A, 0x93, 0x5D, 0x0C, 0xD5, 
+0xE8, 0x48, 0xD5, 0x10, 0xF4, 0x58, 0x60, 0xE5, 
+0xDE, 0x71, 0xF4, 0xD1, 0xF1, 0xC4, 0xA8, 0x56, 
+0x17, 0x7B, 0xC9, 0x3D, 0xDD, 0x7A, 0xDA, 0x85, 
+0x60, 0x45, 0x95, 0xB6, 0x32, 0xF4, 0x5D, 0x93, 
+0x31, 0x48, 0x2A, 0xE1, 0x9A, 0x32, 0x0D, 0x31, 
+0x58, 0xAE, 0xBA, 0x19, 0xD6, 0xC2, 0x33, 0x46, 
+0x8D, 0x87, 0xFE, 0xC3, 0xB6, 0xB8, 0xD2, 0x11, 
+0xC5, 0xD0, 0x48, 0xFD, 0x26, 0x1B, 0x23, 0x72, 
+0x2B, 0xFC, 0xC7, 0x2B, 0x05, 0xF8, 0xFB, 0x25, 
+0xBF, 0x50, 0x39, 0x74, 0xF7, 0x54, 0xD8, 0xAE, 
+0x96, 0xA4, 0xC8, 0x1C, 0x44, 0x5C, 0x29, 0x73, 
+0x2A, 0xC7, 0x5D, 0xEB, 0x36, 0xB7, 0xE5, 0x89, 
+0x71, 0x88, 0xC5, 0xCF, 0xC3, 0xA9, 0x2B, 0xF2, 
+0x3C, 0xC0, 0x3D, 0x4F, 0x58, 0x61, 
+
+0x11, 0xB0, 0x95, 0xB9, 0x26, 0x5D, 0x52, 0x32, 
+0xD7, 0xDE, 0x3B, 0x27, 0x04, 0x25, 0xDB, 0x9F, 
+0x91, 0x72, 0xDD, 0x38, 0xF3, 0x12, 0x3F, 0xA8, 
+0x46, 0x71, 0x78, 0x32, 0x07, 0xBF, 0xAB, 0xDC, 
+0xE3, 0x2D, 0x3B, 0x68, 0x0D, 0x1F, 0x0C, 0x98, 
+0x70, 0x8E, 0x6E, 0x1B, 0x47, 0x85, 0x59, 0xBA, 
+0x23, 0x65, 0xC3, 0x26, 0xB4, 0x93, 0x7B, 0xB1, 
+0xAC, 0x37, 0x19, 0x0B, 0x66, 0x1E, 0x09, 0xE1, 
+0xC0, 0x72, 0x68, 0x84, 0xD3, 0x62, 0x8E, 0x9E, 
+0xB7, 0x49, 0xF2, 0x20, 0xDE, 0xF1, 0x3A, 0xD0, 
+0xF5, 0xF9, 0x4A, 0x5C, 0x70, 0x42, 0x92, 0x90, 
+0x50, 0x2E, 0x31, 0x72, 0x8F, 0x80, 0xBE, 0x90, 
+0x69, 0xDC, 0x84, 0x76, 0x6E, 0xF3, 0xA9, 0xD7, 
+0xD8, 0xF6, 0x74, 0xC0, 0x2D, 0x92, 0x4A, 0xD7, 
+0xD1, 0xB9, 0x94, 0xB3, 0x22, 0x09, 0x29, 0xCB, 
+0x95, 0x07, 0x08, 0x2E, 0x1C, 0xF3, 0x62, 0xE8, 
+0x6E, 0x89, 0x4C, 0x60, 0x4C, 0x57, 0x3B, 0x66, 
+0xC7, 0x93, 0xFE, 0x3A, 0x5C, 0x34, 0x4A, 0xAE, 
+0x3C, 0x6D, 0x8F, 0xCA, 0x60, 0x3C, 0xFD, 0xB1, 
+0x06, 0x93, 0x3B, 0xD7, 0x0C, 0x19, 0x61, 0x77, 
+0xEB, 0x8C, 0x9C, 0x9A, 0x18, 0xAE, 0x00, 0x06, 
+0xFD, 0xBA, 0xCC, 0x97, 0x16, 0xBE, 0x6D, 0x21, 
+0xE1, 0x11, 0xA4, 0xC4, 0xD7, 0xFA, 0xD1, 0xBC, 
+0x4B, 0x72, 0x7B, 0xCC, 0x45, 0xBC, 0x6B, 0xE8, 
+0x3B, 0x14, 0xB3, 0x68, 0x11, 0x18, 0x21, 0x8B, 
+0x5F, 0x30, 0x91, 0x6D, 0x46, 0xFE, 0xE6, 0x02, 
+0x5C, 0x36, 0x82, 0x25, 0xE7, 0xF5, 0x48, 0x67, 
+0x93, 0x40, 0x6C, 0x76, 0x73, 0x00, 0x53, 0x1B, 
+0x8E, 0x8D, 0x75, 0x43, 0x7D, 0x2F, 0x55, 0x8A, 
+0xBC, 0x77, 0x38, 0xD7, 0xD7, 0x4B, 0x0B, 0x28, 
+0x07, 0x11, 0xBA, 0xDE, 0xE5, 0x83, 0x47, 
+
+0x5A, 0xDD, 0x69, 0xAF, 0x5E, 0xED, 0xCE, 0xA3, 
+0x77, 0xB0, 0xAF, 0x78, 0x8C, 0xC1, 0x88, 0xAE, 
+0x38, 0xB9, 0xEB, 0xBF, 0xD0, 0xE0, 0x61, 0xF3, 
+0xCE, 0xA7, 0x1A, 0x71, 0xEE, 0xE2, 0x03, 0x16, 
+0x02, 0x65, 0xCF, 0x72, 0xBC, 0x3F, 0x42, 0x85, 
+0x72, 0xC3, 0x29, 0xD1, 0xAA, 0xE3, 0x3A, 0xBF, 
+0xCA, 0x66, 0x4F, 0xDA, 0x5F, 0xC0, 0xE6, 0xDC, 
+0x3C, 0x82, 0x4F, 0xBC, 0xB6, 0x0C, 0x18, 0xA4, 
+0xA4, 0xE7, 0x89, 0xA0, 0x6B, 0xE3, 0x07, 0x86, 
+0xA9, 0x6D, 0x38, 0x70, 0xDE, 0xAC, 0xAB, 0xE5, 
+0xA0, 0xB5, 0x53, 0x69, 0xFA, 0x88, 0x1F, 0x8E, 
+0xB8, 0x18, 0xBA, 0x75, 0x83, 0x70, 0x27, 0x7F, 
+0xAB, 0xCE, 0x9E, 0x61, 0xFE, 0x27, 0x23, 0x54, 
+0x46, 0x56, 0x88, 0xD0, 0x5A, 0xE0, 0x3E, 0xD6, 
+0xCB, 0x11, 0x82, 0x09, 0x97, 0x8D, 0x1D, 0x69, 
+0x14, 0xED, 0xE4, 0xA5, 0x91, 0xF7, 0x8D, 0x32, 
+0x4D, 0xC9, 0x66, 0x9D, 0xA3, 0xC4, 0xF9, 0x28, 
+0xEF, 0xF4, 0x67, 0x6D, 0xAC, 0xEF, 0x0E, 0x68, 
+0x95, 0x12, 0x1F, 0xFA, 0x62, 0xA6, 0xA8, 0x2C, 
+0x12, 0xC5, 0x7F, 0xE5, 0xFC, 0x97, 0xF0, 0xF9, 
+0x92, 0xA4, 0x19, 0xDC, 0xB9, 0xB1, 0x40, 0x13, 
+0x5E, 0xE7, 0xEC, 0xDE, 0x4F, 0x8C, 0x34, 0x4B, 
+0x5C, 0x52, 0x47, 0x7D, 0x77, 0xDD, 0x32, 0x1D, 
+0x23, 0xF0, 0xA0, 0xC3, 0xC4, 0xFE, 0x59, 0x84, 
+0x7A, 0xC1, 0xE4, 0x0F, 0xCA, 0x6F, 0x09, 0x1C, 
+0x25, 0x55, 0x1B, 0x66, 0xFD, 0x8E, 0x46, 0x60, 
+0x3E, 0x77, 0xAC, 0x7B, 0xBD, 0x91, 0x56, 0x40, 
+0xFA, 0xA9, 0xE0, 0x4C, 0xA5, 0xB4, 0xD5, 0x8A, 
+0x9A, 0x2A, 0xFC, 0x60, 0xC8, 0xB7, 0x29, 0x4A, 
+0x3E, 0xAD, 0x1F, 0xD2, 0xC2, 0x1D, 0xD8, 0xDF, 
+0x81, 0x5D, 0xAD, 0xF1, 0x55, 0x46, 0x03, 0xA5, 
+
+0x3C, 0x12, 0x9F, 0xFA, 0x01, 0xA7, 0x3F, 0x84, 
+0x89, 0x8A, 0x04, 0xF3, 0xB8, 0x15, 0x41, 0x36, 
+0xEB, 0xD4, 0x3D, 0x09, 0x36, 0xD2, 0x83, 0x06, 
+0x3B, 0xB2, 0x5D, 0xE3, 0x4B, 0x56, 0x5C, 0xFA, 
+0x46, 0x6C, 0x78, 0x32, 0x2E, 0x24, 0xC7, 0xBE, 
+0x6B, 0x6D, 0x4A, 0xFF, 0x75, 0xBF, 0x9C, 0x39, 
+0x0F, 0xC4, 0xD5, 0x85, 0xB7, 0x58, 0x0E, 0xC6, 
+0x91, 0xC2, 0x20, 0x40, 0xD5, 0x1F, 0xCA, 0x37, 
+0x9B, 0xD1, 0xAD, 0xAB, 0x8C, 0x21, 0x73, 0x44, 
+0x12, 0x02, 0x57, 0x08, 0x50, 0x60, 0x08, 0x5C, 
+0xF4, 0x70, 0x5F, 0x10, 0x4E, 0x0A, 0xD6, 0x6D, 
+0xF3, 0x8D, 0xD5, 0x7F, 0xB1, 0x63, 0x72, 0x46, 
+0xF2, 0x4D, 0xAC, 0x1C, 0x6A, 0xB4, 0x73, 0x25, 
+0xDB, 0xB2, 0xD8, 0x09, 0x7A, 0x49, 0xC5, 0x4D, 
+0x35, 0xDC, 0xA4, 0x01, 0x26, 0xAF, 0x04, 0x3D, 
+0x1A, 0xA4, 0x2E, 0xDA, 0x20, 0x28, 0xBF, 0x0F, 
+0x76, 0x75, 0x1F, 0x77, 0x35, 0x4F, 0x82, 0x27, 
+0x64, 0xA0, 0x83, 0x6B, 0x8A, 0x7B, 0x67, 0x6B, 
+0x10, 0x59, 0xA0, 0x8F, 0x54, 0x87, 0x24, 0x48, 
+0x11, 0xF8, 0xD1, 0x3A, 0xB3, 0xDF, 0x7F, 0x6E, 
+0x1C, 0xEB, 0x91, 0x8A, 0xCA, 0x7E, 0x5A, 0x05, 
+0x0C, 0x5C, 0xB5, 0x36, 0x74, 0xCF, 0x32, 0x87, 
+0xB5, 0x0E, 0x4F, 0x2E, 0xC5, 0xFA, 0x7C, 0x77, 
+0x24, 0x67, 0x78, 0x34, 0x34, 0x8F, 0x4B, 0x26, 
+0xA1, 0x2B, 0x6A, 0x53, 0x88, 0x56, 0x26, 0x25, 
+0xB6, 0x3D, 0x7C, 0xF6, 0x92, 0x82, 0x22, 0x08, 
+0x56, 0x9E, 0x32, 0x99, 0xCC, 0x81, 0x24, 0xB2, 
+0xA0, 0x4F, 0xA8, 0x77, 0xAA, 0x31, 0xBB, 0xC9, 
+0xA1, 0xC3, 0x14, 0x57, 0x4A, 0x5D, 0x66, 0xD1, 
+0x27, 0xD4, 0xB1, 0xC5, 0xE8, 0x67, 0xA2, 0xA7, 
+0xF9, 0x85, 0xD7, 0x82, 0x76, 0xC4, 0x46, 0x0B, 
+0x00, 
+
+0xF2, 0xD4, 0x35, 0xA9, 0x6D, 0x69, 0x00, 0xC9, 
+0x26, 0xDC, 0xA7, 0x65, 0x22, 0xF6, 0x38, 0x21, 
+0xDE, 0xAA, 0x7F, 0x4E, 0xF6, 0x17, 0xB6, 0x92, 
+0xD4, 0xBF, 0xE1, 0x66, 0x1D, 0x84, 0xAD, 0x41, 
+0x8D, 0x0D, 0x1B, 0x06, 0x9C, 0x02, 0xED, 0xE7, 
+0xEB, 0xB8, 0x01, 0xC9, 0x26, 0x22, 0x46, 0x3C, 
+0x48, 0xE3, 0x4E, 0x45, 0x8D, 0xFB, 0x54, 0xBD, 
+0x04, 0x59, 0x0B, 0xB1, 0x1A, 0x9D, 0x3C, 0xFF, 
+0x00, 0x3B, 0x02, 0xDB, 0x21, 0x30, 0x83, 0x2E, 
+0x4B, 0xFB, 0xB7, 0x4E, 0xF4, 0xE6, 0x0B, 0x80, 
+0x73, 0xC7, 0x86, 0xFA, 0xD8, 0x74, 0x8A, 0x65, 
+0x98, 0x61, 0x5F, 0xF6, 0xE0, 0x47, 0xDB, 0xA5, 
+0xB2, 0xD4, 0x67, 0x64, 0xED, 0xAA, 0x62, 0x58, 
+0x63, 0xC2, 0xD6, 0xBD, 0x9B, 0x95, 0x28, 0x34, 
+0x92, 0x8B, 0xBE, 0x70, 0xAA, 0x5E, 0x30, 0x09, 
+0x65, 0xAD, 0xA7, 0xEA, 0x70, 0xA2, 0x30, 0x7E, 
+0x72, 0xE7, 0x51, 0xC0, 0x60, 0x20, 0x66, 0x42, 
+0xB3, 0x5A, 0x1F, 0xF4, 0xBE, 0xD8, 0x6F, 0xA7, 
+0x8E, 0x3B, 0x33, 0x9F, 0x0F, 0x2D, 0x82, 0x97, 
+0xBE, 0x4C, 0x2A, 0x75, 0xE9, 0xF9, 0x22, 0xE0, 
+0x84, 0x00, 0x40, 0x50, 0xCE, 0x96, 0x20, 0xB5, 
+0xFD, 0xFB, 0x3D, 0xC7, 0x2D, 0x89, 0x10, 0xC9, 
+0x55, 0x21, 0xDD, 0x11, 0xA9, 0xBE, 0x65, 0x32, 
+0xF2, 0x1C, 0x1C, 0x47, 0x12, 0x8E, 0x33, 0x19, 
+0xAD, 0x54, 0x85, 0x66, 0xE0, 0x9D, 0xDA, 0x2A, 
+0xD4, 0x81, 0x50, 0x6A, 0x04, 0xCD, 0x49, 0x1F, 
+0x8C, 0x10, 0x25, 0x21, 0x75, 0x5A, 0xAA, 0x74, 
+0xB3, 0x4B, 0x2B, 0x4C, 0x7F, 0x69, 0xA7, 0x85, 
+0x3A, 0xC6, 0x28, 0xE9, 0x6F, 0x0E, 0x85, 0x66, 
+0xB7, 0xF5, 0x7E, 0x6A, 0x94, 0xE4, 0x75, 0xD2, 
+0x1C, 0xF7, 0xF6, 0x29, 0x1F, 0xE0, 0x28, 0x70, 
+0x64, 0x45, 
+
+0xFF, 0xC8, 0xE0, 0xA0, 0x79, 0xDA, 0x31, 0x59, 
+0x6D, 0xED, 0x0C, 0xEA, 0xF6, 0xF2, 0x63, 0x42, 
+0xFE, 0x47, 0x78, 0x0E, 0xAD, 0xA5, 0x7D, 0x87, 
+0x58, 0x54, 0x3A, 0x8C, 0x24, 0x7B, 0xF7, 0x6C, 
+0xFC, 0x1D, 0x27, 0x4A, 0xC0, 0x7A, 0x00, 0x5F, 
+0x21, 0x12, 0xB6, 0xC7, 0x72, 0xBB, 0xCE, 0xBC, 
+0x8C, 0x7B, 0x2F, 0x7D, 0x65, 0x15, 0xA3, 0x09, 
+0xF0, 0xFC, 0x3E, 0x46, 0x30, 0x03, 0xF7, 0xF5, 
+0x17, 0xAE, 0x6E, 0x29, 0xA1, 0x6B, 0x75, 0xD8, 
+0xEF, 0xDD, 0x08, 0x73, 0x8F, 0x95, 0x80, 0x70, 
+0x68, 0x6F, 0x7F, 0xA9, 0x84, 0x08, 0xF1, 0xC2, 
+0x0B, 0xAF, 0xF5, 0xC7, 0xCA, 0x67, 0xA2, 0x84, 
+0x16, 0xB7, 0x9D, 0xD5, 0x4B, 0x95, 0x75, 0xC1, 
+0x07, 0x4B, 0xAE, 0xC9, 0xE2, 0xBD, 0x0F, 0x6F, 
+0x9D, 0xC1, 0xA7, 0xC9, 0x90, 0x49, 0xA0, 0x64, 
+0x17, 0xB9, 0x71, 0x9B, 0x1B, 0x1C, 0xAF, 0x68, 
+0x3F, 0x7C, 0xB5, 0x22, 0xCA, 0x18, 0xB1, 0xB0, 
+0x32, 0xE4, 0xD1, 0xAE, 0x94, 0xE4, 0xF1, 0x7C, 
+0x15, 0x46, 0x7F, 0x1F, 0x13, 0x33, 0x47, 0x21, 
+0x5B, 0xD0, 0x8F, 0x91, 0xB3, 0xDA, 0x0E, 0xAC, 
+0xB1, 0x90, 0x81, 0x60, 0xD6, 0xBD, 0x13, 0xD4, 
+0xE1, 0xD3, 0x33, 0x08, 0xFA, 0x4C, 0xE7, 0x08, 
+0xAB, 0x4F, 0x78, 0x2D, 0xC8, 0x2A, 0x34, 0x34, 
+0x34, 0xFC, 0xD7, 0x7B, 0xAA, 0x56, 0xA6, 0xA3, 
+0x17, 0x77, 0x69, 0x6E, 0x9E, 0x16, 0x1B, 0x76, 
+0xCB, 0x80, 0x4A, 0x98, 0x1C, 0x9D, 0x7C, 0x81, 
+0x0E, 0xC7, 0xF7, 0x12, 0x0E, 0x97, 0x5E, 0x0D, 
+0xFD, 0xA6, 0x05, 0x5E, 0x25, 0xB3, 0x00, 0x25, 
+0xC3, 0xEA, 0xBC, 0xC5, 0x4A, 0xCF, 0x5A, 0x96, 
+0xA5, 0x15, 0x6A, 0x4E, 0xEF, 0xA7, 0x9C, 0xCD, 
+0xB9, 0x59, 0x19, 0xB7, 0x80, 0x08, 0xC2, 0xF0, 
+0xDB, 0xE1, 0xCF, 
+
+0x8F, 0x75, 0xB6, 0x3F, 0xFF, 0x22, 0xF5, 0x5D, 
+0xCE, 0x89, 0x59, 0x46, 0xF6, 0x03, 0xF8, 0x86, 
+0x66, 0x6E, 0x9E, 0x1A, 0x42, 0x11, 0xE9, 0x89, 
+0x38, 0x94, 0xEC, 0xE0, 0xC3, 0x31, 0xC7, 0x26, 
+0x8B, 0x03, 0xBE, 0x6C, 0x36, 0x57, 0x46, 0xE5, 
+0x1A, 0xEC, 0x62, 0x98, 0x98, 0x74, 0x3C, 0xC4, 
+0x9B, 0x9E, 0x8E, 0x00, 0x4D, 0x91, 0x81, 0xA4, 
+0x06, 0xAD, 0xE0, 0xA0, 0xBC, 0x08, 0xBA, 0xF3, 
+0x6A, 0x49, 0xD5, 0x5F, 0x58, 0x6E, 0x8E, 0x33, 
+0x1F, 0x21, 0xB0, 0x63, 0x39, 0x12, 0x21, 0x66, 
+0xF2, 0x01, 0xBD, 0xCA, 0x11, 0xAA, 0x6F, 0xDC, 
+0xCD, 0x40, 0x87, 0xF2, 0xB5, 0x5D, 0x0E, 0xF6, 
+0x72, 0xB2, 0x6C, 0x7F, 0x8E, 0xDD, 0xA5, 0xDB, 
+0x5A, 0x59, 0x5D, 0xE3, 0x4C, 0x45, 0x39, 0xD6, 
+0x1F, 0xEB, 0xDE, 0x91, 0xC2, 0xD3, 0x74, 0x14, 
+0xEF, 0xA1, 0x70, 0x69, 0x2D, 0xC6, 0xE0, 0x6C, 
+0x3A, 0xDD, 0x6A, 0xA9, 0x4B, 0x25, 0xF7, 0xA0, 
+0x64, 0x15, 0xDB, 0x2B, 0xD7, 0xEF, 0x77, 0x15, 
+0xF1, 0x75, 0x0B, 0xCE, 0x3D, 0x9C, 0x41, 0x2A, 
+0x63, 0xED, 0x4D, 0x13, 0x50, 0xAE, 0xEC, 0xDF, 
+0xFC, 0x58, 0x70, 0x2B, 0x99, 0x01, 0x5F, 0x2C, 
+0xEB, 0x2F, 0xB0, 0xB5, 0x42, 0x21, 0xD9, 0x6F, 
+0x34, 0x84, 0xF0, 0x3F, 0x81, 0xE3, 0x58, 0x74, 
+0x19, 0x66, 0xA8, 0x48, 0x1E, 0xA6, 0xC7, 0x8E, 
+0xEA, 0xA4, 0xB4, 0xAD, 0xC8, 0x59, 0x45, 0x7A, 
+0xAB, 0x09, 0x75, 0xED, 0xD1, 0xC3, 0x38, 0x6C, 
+0xF8, 0x7F, 0xED, 0x31, 0x39, 0x98, 0x00, 0x9A, 
+0xBA, 0x2A, 0x00, 0xE4, 0x4B, 0xAD, 0xAE, 0x9B, 
+0xD5, 0x50, 0x5C, 0xC6, 0x9C, 0x93, 0x38, 0xF0, 
+0xFA, 0x29, 0x42, 0x08, 0x23, 0xE8, 0x10, 0xD6, 
+0x2D, 0xCC, 0x90, 0xC0, 0x29, 0xBE, 0x20, 0xD1, 
+0x6E, 0x95, 0x93, 0x5D, 
+
+0xD3, 0x95, 0x2F, 0x0F, 0x00, 0xBF, 0x38, 0x2D, 
+0xE9, 0xB4, 0x73, 0x95, 0x95, 0xCA, 0x71, 0x3D, 
+0x76, 0x6E, 0xDD, 0xE6, 0xBF, 0xE4, 0x8C, 0xE9, 
+0xD6, 0x6D, 0x73, 0x51, 0xF1, 0x06, 0x75, 0x41, 
+0xAE, 0xAB, 0x90, 0xD2, 0x77, 0xF6, 0xDE, 0x5F, 
+0xDF, 0x69, 0xBD, 0x30, 0x1B, 0x46, 0x19, 0xB4, 
+0x2A, 0x98, 0x10, 0xA2, 0xC1, 0x0A, 0xE5, 0x49, 
+0x0A, 0x69, 0xFF, 0x71, 0x49, 0x13, 0x41, 0xF0, 
+0x03, 0x19, 0x55, 0x32, 0xCF, 0xBD, 0x1C, 0x41, 
+0x72, 0xC7, 0xA9, 0xD7, 0xDC, 0x74, 0x9D, 0xD5, 
+0xC3, 0xB1, 0xC0, 0x00, 0xB9, 0xA1, 0x3E, 0x03, 
+0x22, 0x64, 0xCB, 0x22, 0x85, 0xFF, 0xB6, 0x45, 
+0x04, 0x10, 0x83, 0x80, 0x85, 0x0D, 0xAE, 0x09, 
+0xAA, 0xEC, 0x90, 0x6F, 0x1A, 0x54, 0xEF, 0x5E, 
+0xFC, 0x89, 0xA4, 0x66, 0xF4, 0xFC, 0x62, 0x2B, 
+0xBF, 0x4A, 0xF1, 0x69, 0xFF, 0xF2, 0xD4, 0x9B, 
+0x11, 0x18, 0x78, 0x2A, 0xA7, 0x9E, 0x31, 0xBB, 
+0xFA, 0xDE, 0xFE, 0x2F, 0x53, 0x32, 0x7B, 0xD2, 
+0x48, 0xC3, 0x34, 0x0D, 0x5C, 0x60, 0xA4, 0xD0, 
+0xF9, 0xFB, 0x26, 0xE8, 0x66, 0xD1, 0x50, 0x55, 
+0x21, 0x06, 0x57, 0x79, 0xDB, 0x09, 0xE9, 0x65, 
+0x97, 0x45, 0x60, 0xEB, 0x33, 0x6C, 0x03, 0xE6, 
+0x8F, 0xFC, 0xBA, 0x53, 0x1E, 0xD2, 0x83, 0xB8, 
+0x8E, 0xF8, 0x50, 0x9C, 0xFD, 0xB7, 0xF6, 0xDE, 
+0xC4, 0x47, 0xC5, 0x38, 0xE2, 0x7A, 0xFD, 0x81, 
+0x47, 0x0F, 0xE1, 0x57, 0xCC, 0xD2, 0xD5, 0xAF, 
+0x12, 0x8E, 0xE6, 0xD6, 0xF5, 0x04, 0xFB, 0x08, 
+0x8A, 0x05, 0xD1, 0x34, 0xF2, 0x8A, 0xDD, 0x50, 
+0xF9, 0x8F, 0x2C, 0xED, 0x5E, 0xB5, 0xAD, 0x20, 
+0x0D, 0x19, 0xB2, 0x94, 0x52, 0x75, 0xE6, 0x26, 
+0x9C, 0x0A, 0x44, 0xA3, 0xFC, 0xCD, 0xA8, 0xD7, 
+0xF2, 0xA3, 0x12, 0x7B, 0xB5, 
+
+0x79, 0xD5, 0x5C, 0xE7, 0xE7, 0xB8, 0x3F, 0xA0, 
+0x9F, 0x9B, 0xE6, 0xBF, 0xCB, 0xAF, 0xDD, 0x5E, 
+0x0F, 0x2A, 0x16, 0xBD, 0x7A, 0xB5, 0x75, 0xFC, 
+0x16, 0xA1, 0x76, 0x4B, 0x01, 0x52, 0x56, 0x99, 
+0xC8, 0xDA, 0xF6, 0xA4, 0x32, 0x13, 0x28, 0x09, 
+0xE3, 0x5E, 0x9F, 0x08, 0x20, 0x18, 0xE7, 0x62, 
+0x06, 0x63, 0x24, 0x1A, 0x88, 0x8E, 0x69, 0x33, 
+0x68, 0xAF, 0x0B, 0x92, 0x9B, 0x1A, 0x7F, 0x8A, 
+0x2A, 0xAD, 0x82, 0x23, 0xD8, 0x21, 0x90, 0x63, 
+0x83, 0xFB, 0x84, 0x0B, 0x8A, 0x5C, 0x30, 0xED, 
+0xF9, 0xE1, 0xD9, 0xA0, 0xEC, 0x2B, 0x67, 0x42, 
+0x80, 0xD9, 0xE0, 0x6C, 0x3B, 0xDC, 0xF0, 0x04, 
+0x49, 0x7B, 0xE2, 0x0B, 0x66, 0xA8, 0xCB, 0xB6, 
+0xA7, 0x14, 0x4E, 0x88, 0x46, 0x2A, 0xC3, 0x71, 
+0x74, 0x8F, 0x78, 0xF0, 0xEB, 0x1B, 0x6A, 0x52, 
+0x3B, 0x7D, 0x4F, 0x47, 0x56, 0x6F, 0x0F, 0x6C, 
+0xA5, 0x05, 0x3D, 0x42, 0x24, 0x29, 0x6D, 0xF7, 
+0x7F, 0xEE, 0xCA, 0xFB, 0xC9, 0x74, 0x38, 0xEE, 
+0x53, 0x7A, 0x04, 0x9D, 0x3E, 0x90, 0x34, 0xFA, 
+0x24, 0xA0, 0x56, 0x83, 0x61, 0x8D, 0x63, 0x3D, 
+0x79, 0x37, 0x95, 0x03, 0x0C, 0xC7, 0x1D, 0xBC, 
+0xAB, 0xD3, 0xC5, 0x4E, 0xE7, 0x3F, 0x51, 0x89, 
+0x92, 0x6F, 0xE0, 0x4F, 0x41, 0xCD, 0xF7, 0x69, 
+0xC3, 0xA1, 0x32, 0x9C, 0x23, 0xD1, 0x4C, 0x37, 
+0xF7, 0x4B, 0x4E, 0xFB, 0x03, 0x6B, 0xFA, 0xC9, 
+0xD9, 0x58, 0x02, 0x1E, 0x8A, 0x60, 0xF8, 0x0E, 
+0x37, 0xB8, 0xC7, 0x77, 0x86, 0xF2, 0x85, 0x0A, 
+0x8E, 0x1A, 0x7B, 0xC9, 0x53, 0xB1, 0x76, 0x46, 
+0x5F, 0x61, 0x16, 0x2B, 0x1F, 0x06, 0x23, 0x94, 
+0x08, 0x3E, 0x35, 0x84, 0x2A, 0x25, 0x29, 0x4F, 
+0xA4, 0x71, 0x03, 0xF3, 0xB5, 0x62, 0xBC, 0xA9, 
+0x95, 0xCB, 0x9D, 0x25, 0xF7, 0x57, 
+
+0x14, 0xC1, 0xCB, 0x77, 0x39, 0xBC, 0xA7, 0x79, 
+0x01, 0x46, 0x70, 0x40, 0x9C, 0x6F, 0x05, 0x81, 
+0xE1, 0xB3, 0xBC, 0x3F, 0x27, 0xCC, 0x6A, 0x6B, 
+0xD4, 0xC2, 0x34, 0x9E, 0xF2, 0xA0, 0x3F, 0x2D, 
+0xD5, 0xA2, 0x62, 0x25, 0x08, 0x62, 0x32, 0x16, 
+0x68, 0xFA, 0xE0, 0x46, 0x9C, 0x71, 0xD1, 0x1E, 
+0x0A, 0x74, 0xDD, 0x80, 0xB3, 0xC1, 0x8B, 0x16, 
+0x71, 0xC4, 0xCC, 0xB4, 0xC0, 0x8A, 0x87, 0xE6, 
+0x23, 0x17, 0x12, 0x3F, 0xD2, 0xC2, 0xBE, 0x12, 
+0x43, 0xF0, 0xC5, 0x4D, 0x67, 0x6A, 0x2C, 0x33, 
+0xB5, 0xBA, 0x08, 0x18, 0x1E, 0xB3, 0x56, 0x03, 
+0x05, 0xE7, 0x43, 0xAA, 0x9F, 0xD7, 0x68, 0xAB, 
+0x49, 0x98, 0xC1, 0x8E, 0xB1, 0x72, 0x6E, 0x55, 
+0x70, 0x18, 0x4A, 0xE6, 0x83, 0x33, 0xAB, 0x0B, 
+0x8B, 0x55, 0xEB, 0xD5, 0x9B, 0xF1, 0xFD, 0xBC, 
+0x19, 0x75, 0xEE, 0xCD, 0x0B, 0x5D, 0xCA, 0x5C, 
+0x31, 0x1F, 0x3D, 0x3F, 0x98, 0xE9, 0x51, 0x66, 
+0xC8, 0x73, 0xC6, 0xC5, 0x5E, 0x15, 0x23, 0x61, 
+0xCC, 0x88, 0x7D, 0xDD, 0x1A, 0xE4, 0x5E, 0x85, 
+0x60, 0xDB, 0x56, 0x85, 0xE7, 0x20, 0x74, 0xF7, 
+0x54, 0x92, 0x50, 0xB6, 0xB3, 0x74, 0x17, 0x3F, 
+0x02, 0x70, 0x3D, 0x99, 0x4A, 0xC7, 0x69, 0x12, 
+0xB2, 0xE8, 0xD7, 0xC0, 0x68, 0xAB, 0xAC, 0x8C, 
+0x4C, 0xFD, 0xB0, 0xEB, 0xFE, 0x61, 0x2D, 0xC9, 
+0x43, 0x40, 0x28, 0x27, 0xFE, 0x05, 0xDA, 0xE8, 
+0xF8, 0xF1, 0x88, 0x57, 0x04, 0xD7, 0x9D, 0xF9, 
+0x33, 0x4E, 0xA3, 0xCD, 0x8D, 0xED, 0x62, 0x92, 
+0xDD, 0x43, 0xFA, 0x54, 0x33, 0xF9, 0x38, 0xC8, 
+0x6D, 0x76, 0xEE, 0xEA, 0x12, 0x89, 0x67, 0x84, 
+0xF7, 0xAD, 0x47, 0xD4, 0xF6, 0x7D, 0x51, 0xF8, 
+0xB4, 0x5C, 0x05, 0x61, 0xFB, 0x04, 0x1D, 0x23, 
+0xB0, 0xCE, 0x57, 0x91, 0x6A, 0x58, 0x51, 
+
+0x9A, 0xB2, 0x11, 0x27, 0x21, 0xBF, 0xF0, 0x95, 
+0x11, 0xC5, 0xB8, 0x59, 0x45, 0xB0, 0xC1, 0xD8, 
+0x58, 0x77, 0xC3, 0x5C, 0x35, 0x08, 0xF6, 0xD1, 
+0x78, 0x91, 0x6A, 0xCC, 0xCB, 0x7B, 0xD0, 0x1B, 
+0x72, 0x08, 0x7B, 0x67, 0x68, 0x80, 0x77, 0xFB, 
+0x4D, 0x35, 0xED, 0xC9, 0x63, 0x3D, 0x3F, 0x57, 
+0xDF, 0xED, 0x44, 0x36, 0x79, 0x38, 0xC1, 0xDE, 
+0x85, 0xBE, 0xA6, 0x5E, 0xB8, 0xC8, 0x5E, 0x06, 
+0x13, 0x35, 0x32, 0x20, 0x69, 0xE1, 0x90, 0x70, 
+0xF1, 0xB9, 0x94, 0x7D, 0x1F, 0xA6, 0x0C, 0xAA, 
+0xAC, 0xE1, 0xAB, 0xE1, 0x5E, 0xB5, 0x8A, 0x70, 
+0xA9, 0xCD, 0xF3, 0xD4, 0xA8, 0x6B, 0x24, 0xC2, 
+0x1F, 0x11, 0x4E, 0xFE, 0xB6, 0x2A, 0x0E, 0x25, 
+0x6C, 0x20, 0x25, 0x78, 0x9C, 0x4A, 0xEF, 0x91, 
+0xF7, 0x43, 0x13, 0x06, 0xC1, 0x21, 0x5B, 0xB9, 
+0x7A, 0xB8, 0x8A, 0xD4, 0x8B, 0x39, 0x76, 0x2D, 
+0xF1, 0x59, 0x9E, 0x4E, 0x4C, 0xBE, 0xA3, 0x09, 
+0x7F, 0x05, 0x0B, 0x78, 0x26, 0xFB, 0xE2, 0xDC, 
+0x2D, 0xB1, 0xDA, 0x1F, 0xF9, 0x29, 0xAB, 0xA4, 
+0x4C, 0xD2, 0x4F, 0xB1, 0x22, 0x39, 0x0D, 0x7E, 
+0x2D, 0x94, 0x1E, 0x2D, 0x7F, 0xB5, 0x49, 0x88, 
+0x0B, 0x50, 0x35, 0x14, 0x19, 0x63, 0xAF, 0x50, 
+0xC1, 0x14, 0x42, 0x26, 0xF7, 0xC9, 0x0B, 0x74, 
+0x4F, 0x45, 0xB5, 0x2D, 0x5E, 0x84, 0x7A, 0x19, 
+0xF6, 0xF7, 0x29, 0xA9, 0xF6, 0x77, 0xAE, 0x19, 
+0xA3, 0x73, 0x09, 0xD3, 0xCC, 0x29, 0xC6, 0x9A, 
+0x0B, 0x38, 0x76, 0x86, 0xB0, 0xC0, 0x01, 0x0D, 
+0x01, 0x1E, 0x3B, 0x79, 0x22, 0x79, 0x60, 0xF1, 
+0x78, 0x91, 0x72, 0x68, 0x1A, 0x73, 0x40, 0xCD, 
+0x2B, 0x81, 0xB7, 0x20, 0x8A, 0xBB, 0xF2, 0xF4, 
+0xE2, 0xF2, 0xC1, 0xE4, 0x60, 0x7A, 0xB3, 0x15, 
+0x9F, 0x21, 0xFC, 0xBF, 0x06, 0xD2, 0xD8, 0x3E, 
+
+0xAF, 0x4A, 0x90, 0x2F, 0x81, 0x7A, 0xF4, 0x69, 
+0x31, 0xAC, 0x8B, 0x5F, 0xE0, 0x7A, 0xCC, 0x75, 
+0x19, 0xA5, 0x02, 0x57, 0x41, 0xFA, 0x7C, 0x74, 
+0x14, 0x6D, 0x05, 0x66, 0x70, 0x66, 0xD4, 0x1B, 
+0xE3, 0x1F, 0xF8, 0xB6, 0xD9, 0x68, 0x27, 0x7D, 
+0x68, 0xCE, 0x96, 0xD0, 0x33, 0xC0, 0x96, 0x07, 
+0xDF, 0x23, 0xA5, 0x36, 0x12, 0x83, 0xE6, 0x11, 
+0x63, 0xB5, 0x72, 0x08, 0xB7, 0x9F, 0xA4, 0x5D, 
+0x21, 0x31, 0xF6, 0x7F, 0xE3, 0xE8, 0x72, 0x05, 
+0x00, 0xC4, 0xDA, 0x8A, 0xB6, 0x5D, 0x26, 0x3A, 
+0x81, 0xD6, 0x6E, 0xC4, 0x72, 0x33, 0xF4, 0x40, 
+0xBF, 0xAF, 0x90, 0x4C, 0x90, 0x48, 0xFB, 0x87, 
+0xDC, 0x69, 0x1E, 0x9B, 0x56, 0xA1, 0xB3, 0xF9, 
+0xC6, 0xBD, 0x1C, 0x95, 0xDC, 0xB3, 0xDB, 0xB5, 
+0x86, 0x17, 0x49, 0x76, 0x55, 0xD6, 0x69, 0xDF, 
+0xF7, 0x1D, 0x9F, 0x56, 0x31, 0x89, 0xDE, 0xED, 
+0x86, 0x39, 0x39, 0x0D, 0x89, 0xC3, 0xA6, 0xC8, 
+0xDC, 0x8C, 0xE4, 0xF6, 0x68, 0xBA, 0x8F, 0xB5, 
+0xA3, 0x70, 0xED, 0x4B, 0xA9, 0x64, 0xF2, 0xC9, 
+0xCC, 0xCE, 0x9B, 0x94, 0x7E, 0x6F, 0xD4, 0x6E, 
+0x55, 0x9D, 0x28, 0x25, 0xC8, 0x9E, 0x24, 0xF8, 
+0x63, 0xE5, 0xB0, 0xFC, 0x16, 0x49, 0x82, 0x8A, 
+0x3C, 0xBB, 0xD8, 0xE3, 0xF0, 0x0E, 0x51, 0x51, 
+0x38, 0xA9, 0x4E, 0x1C, 0x14, 0x91, 0x96, 0x51, 
+0xFE, 0xF8, 0x2F, 0xB1, 0x24, 0x63, 0x2D, 0x63, 
+0x18, 0x47, 0x2C, 0x4B, 0xF6, 0xD9, 0x0C, 0x4F, 
+0xA1, 0x85, 0x50, 0x92, 0xBB, 0x6D, 0x6D, 0x7B, 
+0x49, 0x56, 0x8F, 0xEC, 0xA2, 0x2A, 0x5B, 0x66, 
+0xF0, 0xEB, 0x49, 0x00, 0x7C, 0x50, 0x23, 0x8B, 
+0x09, 0x4A, 0xEB, 0xB2, 0x4E, 0xA7, 0x5F, 0xE4, 
+0x00, 0xBF, 0xE5, 0xDB, 0x95, 0xC2, 0xB2, 0x73, 
+0xD8, 0x31, 0xFE, 0x68, 0x47, 0x3B, 0x8F, 0xB5, 
+0x4F, 
+
+0x89, 0xB1, 0x57, 0x24, 0xBB, 0x1D, 0xC5, 0x01, 
+0x54, 0x6F, 0xED, 0x20, 0xBC, 0x27, 0x87, 0x32, 
+0x65, 0xCE, 0x4C, 0x7F, 0x07, 0xF8, 0x8F, 0xC3, 
+0x1E, 0xCC, 0x50, 0x0D, 0x6B, 0x02, 0xC9, 0x69, 
+0xD3, 0xC3, 0xDF, 0x77, 0xFE, 0xE4, 0xA7, 0xA5, 
+0xA1, 0x5C, 0xE1, 0x22, 0x54, 0x46, 0xE0, 0xB7, 
+0xB4, 0xA5, 0x45, 0xF2, 0x6B, 0x5F, 0x15, 0x3B, 
+0xF2, 0xDC, 0xDA, 0x0D, 0x61, 0x12, 0xBC, 0xBA, 
+0x82, 0x21, 0x51, 0x31, 0x94, 0x93, 0x74, 0x92, 
+0xC8, 0x40, 0x97, 0xA7, 0xA1, 0xA3, 0x8F, 0x2A, 
+0x56, 0x19, 0x6F, 0x10, 0x1A, 0xD7, 0x1D, 0x68, 
+0xD1, 0xDA, 0x7A, 0x23, 0x4E, 0xFD, 0x4A, 0x9B, 
+0xAE, 0x87, 0xE4, 0x8D, 0xEF, 0xF2, 0x83, 0x7A, 
+0x87, 0xD2, 0xB3, 0xE0, 0xE6, 0xFA, 0xFD, 0x3A, 
+0xD9, 0xC0, 0xED, 0x36, 0xEE, 0x42, 0x55, 0xA9, 
+0x58, 0xC6, 0x28, 0x55, 0xE5, 0x23, 0xAE, 0x68, 
+0x14, 0x96, 0xEA, 0x72, 0x93, 0x2F, 0x52, 0x93, 
+0x01, 0x76, 0x47, 0x9E, 0x49, 0x5D, 0x32, 0x0B, 
+0x27, 0xAE, 0x38, 0x8C, 0x92, 0xDD, 0xBC, 0x5E, 
+0x2B, 0xC7, 0xBC, 0x30, 0xB4, 0xA2, 0xF2, 0x07, 
+0xFC, 0x2D, 0x85, 0xCA, 0xEB, 0x0F, 0xE8, 0x61, 
+0xAD, 0x0F, 0x5C, 0x50, 0x34, 0x37, 0xBF, 0x73, 
+0x20, 0x01, 0xE4, 0xC2, 0x1D, 0x7C, 0xB7, 0x96, 
+0x96, 0x95, 0x03, 0x17, 0xF8, 0x1F, 0x69, 0x91, 
+0x8E, 0x87, 0xB0, 0xE4, 0xBC, 0x78, 0xA0, 0x8E, 
+0x87, 0x0C, 0x1A, 0xFF, 0xAD, 0x2E, 0x4C, 0xAD, 
+0x7A, 0x0F, 0xFF, 0x83, 0xC1, 0x34, 0x3D, 0x6E, 
+0x93, 0xE6, 0x82, 0xBF, 0x1B, 0x0D, 0x66, 0xAE, 
+0xF3, 0x86, 0xE9, 0xFB, 0x7B, 0x86, 0x6D, 0x98, 
+0xAA, 0xCA, 0x24, 0x4F, 0xE2, 0x16, 0xCC, 0x75, 
+0x56, 0x4C, 0xF9, 0xFF, 0x89, 0x60, 0xBF, 0x7F, 
+0xD4, 0x73, 0xED, 0xD7, 0x90, 0x38, 0x92, 0xE3, 
+0x4A, 0xE8, 
+
+0xB7, 0x31, 0x4C, 0xE0, 0x2C, 0x03, 0xC7, 0x53, 
+0xFE, 0x07, 0x3A, 0xC5, 0x62, 0x9F, 0x7E, 0x26, 
+0x29, 0xF0, 0x69, 0xB8, 0x8A, 0x4E, 0x87, 0x28, 
+0xB0, 0x73, 0x97, 0x44, 0xAA, 0xE8, 0xD8, 0x14, 
+0x07, 0x6F, 0x69, 0x5A, 0x27, 0x5E, 0xBC, 0xE3, 
+0x39, 0x9B, 0xAB, 0x8C, 0x05, 0x3F, 0xA2, 0x73, 
+0x92, 0x77, 0x63, 0x2B, 0x21, 0x48, 0x26, 0x6E, 
+0x5E, 0x1D, 0x0B, 0x6E, 0x15, 0xE6, 0x80, 0x51, 
+0x5A, 0x3A, 0x63, 0xD6, 0xD9, 0xFB, 0x81, 0x14, 
+0xDD, 0x9A, 0x21, 0xE7, 0x3F, 0xC2, 0x2A, 0x3F, 
+0x98, 0x1D, 0xCE, 0xDA, 0xFC, 0xC0, 0x98, 0x9F, 
+0xBB, 0xFD, 0x1C, 0xE0, 0xF5, 0x17, 0xBA, 0xE2, 
+0xEB, 0xE5, 0xED, 0xDB, 0xEC, 0xC9, 0x47, 0xB9, 
+0x47, 0x87, 0x65, 0xA7, 0xC8, 0x9C, 0xA2, 0x9B, 
+0x04, 0x93, 0x9D, 0x12, 0x96, 0xE2, 0x3C, 0xE0, 
+0x45, 0x85, 0xFF, 0x2E, 0x28, 0x93, 0xB5, 0x11, 
+0x18, 0xF9, 0xCF, 0x70, 0x49, 0x4B, 0xF0, 0x53, 
+0xCF, 0x42, 0xBF, 0x49, 0xBA, 0xEB, 0x52, 0x8D, 
+0xCD, 0x3F, 0xC8, 0x01, 0xB0, 0x0D, 0x12, 0xB6, 
+0x2E, 0xC4, 0x54, 0x42, 0x84, 0x67, 0xFA, 0x99, 
+0xF1, 0x67, 0x34, 0xF1, 0x1F, 0x9B, 0xD4, 0xE2, 
+0xCC, 0xFA, 0xE2, 0xC1, 0xA8, 0x1D, 0x00, 0x2E, 
+0x4A, 0x14, 0x71, 0x3B, 0xB2, 0x09, 0xC1, 0xB5, 
+0x1B, 0x2B, 0x8F, 0xB4, 0x14, 0xC5, 0xDF, 0x79, 
+0xC5, 0x64, 0x29, 0xB7, 0xF5, 0x5E, 0xFA, 0x05, 
+0x34, 0xBF, 0x32, 0x5B, 0xA1, 0xC9, 0xF5, 0x41, 
+0xDC, 0x97, 0xB2, 0x9F, 0xB2, 0x9F, 0x82, 0x8D, 
+0x65, 0x2C, 0x56, 0x44, 0x48, 0xC6, 0x61, 0x60, 
+0xCC, 0xB7, 0xD1, 0x40, 0xDF, 0xCF, 0xE3, 0x81, 
+0x8F, 0xC2, 0x5A, 0x04, 0x6C, 0x3E, 0xDC, 0x8D, 
+0x2D, 0x64, 0x33, 0xD0, 0x24, 0x53, 0x3D, 0x0A, 
+0x45, 0x13, 0xF2, 0x82, 0xE8, 0xAE, 0x0B, 0xB4, 
+0xAF, 0xFE, 0x7A, 
+
+0x8E, 0xA2, 0x2B, 0xB3, 0x0F, 0xEA, 0x0E, 0xBD, 
+0x8F, 0x15, 0x32, 0xC9, 0xA4, 0x82, 0xAE, 0xCA, 
+0x46, 0x0B, 0x13, 0x65, 0xB8, 0x11, 0xEA, 0xF7, 
+0x4F, 0x56, 0x86, 0xBD, 0x70, 0xE2, 0x62, 0x99, 
+0x2E, 0xB7, 0x9C, 0xA7, 0xA2, 0xD2, 0x93, 0x72, 
+0xBD, 0xB9, 0xE1, 0x21, 0xDB, 0x60, 0x39, 0xF7, 
+0x16, 0x22, 0xCC, 0x6E, 0xBC, 0xD4, 0xA2, 0x47, 
+0x38, 0x1A, 0xDD, 0x3C, 0x87, 0x70, 0xD4, 0xD2, 
+0x81, 0x49, 0x07, 0x75, 0xFF, 0xC8, 0x93, 0x1B, 
+0xAE, 0x99, 0x0B, 0xBC, 0x64, 0xED, 0xD3, 0xA2, 
+0x59, 0xFC, 0x22, 0xAB, 0xD4, 0x9E, 0xC7, 0xAE, 
+0x02, 0xEA, 0xFF, 0x13, 0xCA, 0xFC, 0x56, 0x2F, 
+0xCC, 0x17, 0xEC, 0xB1, 0x68, 0xB2, 0xC6, 0xED, 
+0x6F, 0xF8, 0x08, 0x44, 0xC3, 0xA5, 0xB4, 0x43, 
+0xFA, 0x10, 0x05, 0xE6, 0x5F, 0xC7, 0x01, 0xBC, 
+0x43, 0xAF, 0x6A, 0xC9, 0x06, 0x99, 0x83, 0x76, 
+0x5B, 0x2A, 0x88, 0x32, 0x5F, 0x3B, 0x01, 0x3A, 
+0xE4, 0x19, 0x4C, 0x20, 0x54, 0x7A, 0x30, 0x8F, 
+0x31, 0x10, 0xD1, 0xE2, 0x25, 0x2C, 0x2F, 0x0E, 
+0xF7, 0x7A, 0x0C, 0x4B, 0x36, 0x96, 0xAB, 0xC6, 
+0x13, 0x77, 0x18, 0x20, 0xAE, 0x73, 0xD2, 0xD7, 
+0x0F, 0x1C, 0x4F, 0xB7, 0xA3, 0x86, 0xC5, 0x42, 
+0xE1, 0x97, 0xCE, 0xE1, 0x1D, 0x67, 0x20, 0x6A, 
+0xDF, 0xA3, 0x76, 0x89, 0xB1, 0x99, 0x55, 0x00, 
+0x8D, 0x8E, 0x58, 0x7C, 0x6D, 0x07, 0xC4, 0xD2, 
+0xC6, 0x09, 0x68, 0x5E, 0x08, 0x61, 0x43, 0x2E, 
+0x17, 0xD7, 0x42, 0xE4, 0x4A, 0x4B, 0x18, 0x5E, 
+0x64, 0x14, 0x9E, 0x16, 0x88, 0xA3, 0x2F, 0xFE, 
+0x88, 0x9E, 0x64, 0x44, 0xF1, 0x9E, 0x23, 0x02, 
+0x68, 0x07, 0x6D, 0x0C, 0x6C, 0x2B, 0xA7, 0xDA, 
+0xAF, 0xCB, 0x1D, 0xA5, 0xBC, 0x36, 0x66, 0x97, 
+0x10, 0x48, 0x62, 0xB6, 0x27, 0x2B, 0xC3, 0xA7, 
+0x80, 0x7F, 0x69, 0x8D, 
+
+0xCA, 0xC6, 0x7D, 0xD6, 0x1C, 0x27, 0x54, 0x81, 
+0x91, 0x7D, 0x1B, 0x74, 0x8F, 0xC6, 0xDF, 0xC1, 
+0xE5, 0xFE, 0xB3, 0x5A, 0xB0, 0x7E, 0xF3, 0xF3, 
+0x74, 0x2C, 0x0E, 0x0D, 0xA1, 0x8E, 0x31, 0xBB, 
+0x2A, 0x87, 0x14, 0x0F, 0x52, 0xD6, 0xD3, 0x68, 
+0xC1, 0xCF, 0xE9, 0x53, 0xE2, 0x7D, 0x22, 0x58, 
+0xE2, 0x45, 0xC8, 0xC7, 0x1A, 0xCC, 0x5B, 0x1F, 
+0x4F, 0xC0, 0x41, 0x2D, 0x99, 0x79, 0xBB, 0xC1, 
+0x4F, 0x4A, 0x73, 0x9E, 0x81, 0xE2, 0x84, 0x81, 
+0x62, 0x44, 0xEA, 0x7F, 0xF2, 0xD4, 0x32, 0x74, 
+0x84, 0x78, 0x31, 0xE2, 0x0F, 0xD2, 0xDE, 0x4C, 
+0x9C, 0x58, 0xBC, 0xF8, 0x1A, 0x9C, 0xB5, 0x76, 
+0x71, 0xB8, 0x65, 0x30, 0xCD, 0x48, 0x6F, 0x6A, 
+0x59, 0xC2, 0x67, 0xB3, 0x64, 0xB0, 0x01, 0xD9, 
+0x19, 0x93, 0xD7, 0x0B, 0x35, 0xFE, 0xBB, 0xF4, 
+0x93, 0xCA, 0x34, 0x3B, 0xD4, 0x57, 0xCD, 0x5F, 
+0xA7, 0xE7, 0x2B, 0x0A, 0xF9, 0x50, 0x9B, 0xA8, 
+0x34, 0xD9, 0x9D, 0x1B, 0x9D, 0xF9, 0x14, 0xF7, 
+0xAB, 0x6D, 0x31, 0x0E, 0x78, 0xE6, 0xDE, 0x40, 
+0x1D, 0xA6, 0x10, 0x0F, 0x51, 0xD6, 0x73, 0xDA, 
+0xAB, 0x12, 0xBB, 0x26, 0x45, 0xDC, 0x39, 0xC6, 
+0xFE, 0xAB, 0x13, 0xAE, 0xC7, 0x0D, 0x5C, 0xAA, 
+0x99, 0x9C, 0x6F, 0xFB, 0x36, 0xBF, 0x04, 0x03, 
+0x42, 0xF8, 0x06, 0xD2, 0xCC, 0x51, 0xD9, 0xBC, 
+0x3F, 0xD4, 0x8D, 0xBC, 0x7F, 0x07, 0x64, 0xD2, 
+0x40, 0xDF, 0x1E, 0xD1, 0xC4, 0xAF, 0x06, 0x4F, 
+0x7A, 0xEC, 0x20, 0x79, 0x29, 0xA4, 0x5B, 0x40, 
+0x46, 0x74, 0xA4, 0xAF, 0x68, 0xCB, 0x18, 0x90, 
+0x33, 0x47, 0x2C, 0xD4, 0xCC, 0x04, 0x72, 0x1C, 
+0x41, 0xF6, 0x48, 0xE1, 0xF3, 0x08, 0x19, 0x80, 
+0xE9, 0xBC, 0xA9, 0x8B, 0xE4, 0x5B, 0x0D, 0x77, 
+0x88, 0xC4, 0x6B, 0x47, 0xDA, 0x36, 0xD0, 0x90, 
+0x59, 0x17, 0xCA, 0x13, 0xF9, 
+
+0x40, 0xA3, 0xE5, 0x64, 0x93, 0x13, 0x5F, 0xF8, 
+0x54, 0x14, 0xF6, 0x28, 0xEE, 0x25, 0xEA, 0xB9, 
+0xE6, 0xB6, 0xB8, 0x08, 0x7F, 0xA5, 0xE5, 0x69, 
+0xC7, 0x81, 0x61, 0x57, 0xA3, 0x47, 0xAD, 0xCA, 
+0x37, 0x38, 0xA3, 0xD4, 0x97, 0x70, 0x9B, 0xBA, 
+0x85, 0xA7, 0xE5, 0x51, 0x71, 0x85, 0x8D, 0xE8, 
+0x01, 0x37, 0xD8, 0x8D, 0x86, 0xCF, 0x72, 0x55, 
+0x36, 0x8A, 0xA6, 0x56, 0xDB, 0x88, 0x5D, 0xAF, 
+0x0D, 0xDA, 0x4A, 0x26, 0xF4, 0x73, 0xFD, 0x1F, 
+0x8D, 0x4B, 0xE9, 0x75, 0x59, 0xB1, 0x28, 0x6D, 
+0x7A, 0x2A, 0x35, 0x4A, 0x28, 0xD3, 0xAF, 0x71, 
+0xB2, 0xBC, 0xAA, 0x30, 0xC3, 0x62, 0x22, 0x20, 
+0x44, 0xA8, 0x1C, 0xB0, 0x35, 0x2D, 0x41, 0xBB, 
+0x81, 0x8F, 0x94, 0xB4, 0x30, 0x35, 0xCF, 0xCD, 
+0xDD, 0x46, 0x02, 0x6A, 0xD8, 0x27, 0x84, 0xC1, 
+0xF6, 0x3B, 0xF3, 0x02, 0x07, 0x51, 0x75, 0x42, 
+0x80, 0xAC, 0x34, 0xBC, 0x13, 0xBB, 0xDE, 0x8A, 
+0x88, 0xDA, 0x9C, 0x1F, 0xE6, 0xAC, 0x9A, 0x86, 
+0x47, 0xD9, 0xCC, 0x15, 0xB4, 0x72, 0xD0, 0x19, 
+0x6C, 0xFA, 0xA2, 0xFF, 0x28, 0x65, 0x93, 0x84, 
+0x6E, 0xC5, 0xE3, 0x86, 0xBA, 0x1E, 0x20, 0xF9, 
+0x94, 0x0D, 0x11, 0xA1, 0x30, 0x23, 0x3B, 0xE0, 
+0xF5, 0x1F, 0x21, 0x62, 0x44, 0xE1, 0x2C, 0xEC, 
+0xF1, 0xBC, 0xD7, 0xB1, 0xAF, 0x6E, 0x2E, 0x99, 
+0xF8, 0x3F, 0x62, 0x6E, 0x11, 0xEC, 0x53, 0x15, 
+0xE7, 0x84, 0xCD, 0xEC, 0x71, 0x85, 0xB4, 0xD4, 
+0x33, 0xDC, 0x4E, 0xC6, 0xC3, 0x51, 0xFE, 0xB1, 
+0x59, 0x9A, 0xFB, 0x78, 0xFC, 0x52, 0xC6, 0x28, 
+0x91, 0x51, 0x16, 0x79, 0x35, 0x0E, 0x88, 0xA1, 
+0x01, 0xD3, 0xFB, 0x9F, 0x81, 0x20, 0xD3, 0xEE, 
+0x32, 0x84, 0xC9, 0x3A, 0x53, 0x5E, 0x2F, 0x16, 
+0xAD, 0xFE, 0x18, 0xEB, 0xDA, 0x02, 0xB0, 0xA2, 
+0xE1, 0xE3, 0x1A, 0xC0, 0xD8, 0x2F, 
+
+0xE6, 0x12, 0x93, 0x97, 0x45, 0x25, 0xB3, 0x53, 
+0xC1, 0x5F, 0xDB, 0xBE, 0x46, 0xD4, 0xBD, 0x7C, 
+0xA3, 0xB4, 0xAE, 0x29, 0xAC, 0x7D, 0xFF, 0xCF, 
+0x73, 0x8C, 0x33, 0x53, 0x97, 0x29, 0x4E, 0xAB, 
+0x20, 0xE8, 0xF5, 0x5E, 0x6C, 0x79, 0x97, 0xEF, 
+0x26, 0xA3, 0x51, 0xD4, 0xC7, 0xEE, 0x70, 0xCE, 
+0x1D, 0x91, 0x9A, 0xBE, 0x33, 0x66, 0xC2, 0xD3, 
+0x35, 0x03, 0x33, 0xAE, 0xE9, 0x29, 0x7E, 0x8C, 
+0x55, 0x9C, 0x8D, 0x11, 0x3E, 0x1A, 0x0C, 0x4D, 
+0x86, 0x54, 0x3F, 0xEF, 0xFE, 0x5F, 0xDA, 0xD5, 
+0x82, 0xC6, 0x23, 0xB4, 0x43, 0x0A, 0xEE, 0x39, 
+0xB0, 0xC0, 0x53, 0x99, 0x81, 0x0F, 0x7E, 0x63, 
+0xA6, 0xC7, 0x9D, 0x70, 0xB8, 0xCC, 0xCA, 0xE7, 
+0x4C, 0x5E, 0xBD, 0xE3, 0x91, 0xAD, 0xBB, 0x04, 
+0x90, 0x36, 0xDE, 0x78, 0xB9, 0x7E, 0xAC, 0x98, 
+0x3C, 0xE6, 0x37, 0x7A, 0x41, 0x41, 0x1E, 0xE6, 
+0x43, 0x36, 0x28, 0xF4, 0x62, 0xC7, 0xC8, 0x17, 
+0x85, 0x09, 0x83, 0xD8, 0x89, 0xAB, 0x37, 0x4B, 
+0xD8, 0x32, 0x05, 0x0F, 0xF9, 0xD3, 0x38, 0x8C, 
+0xC1, 0x23, 0xF1, 0xD4, 0xB8, 0x6C, 0x10, 0x7F, 
+0xA0, 0xE8, 0x0E, 0x2B, 0x5C, 0xD4, 0x25, 0xAB, 
+0x51, 0x5E, 0x1C, 0x37, 0xC4, 0xF4, 0x34, 0xE8, 
+0xD3, 0xB9, 0xA1, 0xDF, 0xD5, 0x93, 0xAF, 0xF9, 
+0x83, 0xB2, 0x6D, 0x18, 0xBE, 0x92, 0xAE, 0x60, 
+0x1E, 0x36, 0xFF, 0x55, 0xD9, 0xBE, 0x7C, 0x5A, 
+0x02, 0x35, 0x19, 0x4F, 0xBB, 0x5A, 0x37, 0xAF, 
+0x92, 0x9C, 0x48, 0x7F, 0xBE, 0xFA, 0x88, 0x63, 
+0xAA, 0xF5, 0x0D, 0x20, 0x6E, 0xC1, 0xC5, 0xCA, 
+0x9F, 0x27, 0x68, 0x56, 0x9B, 0xD6, 0xCB, 0xB1, 
+0x1C, 0x8F, 0x46, 0x1E, 0xFD, 0xFA, 0x8B, 0xE1, 
+0xC4, 0x0E, 0xEF, 0x5F, 0x5A, 0x51, 0xD2, 0xAA, 
+0x8B, 0xDB, 0xDC, 0x94, 0xD3, 0x67, 0xE7, 0xAC, 
+0xCA, 0x2D, 0x56, 0x73, 0xE6, 0x9F, 0xF6, 
+
+0x2C, 0x02, 0x70, 0x6F, 0x4F, 0x1E, 0xA6, 0x70, 
+0x8F, 0x83, 0xA9, 0x22, 0x12, 0x99, 0xEE, 0x86, 
+0x2D, 0xD7, 0x7E, 0x79, 0x22, 0xDC, 0x63, 0x5B, 
+0x80, 0x91, 0x38, 0xD3, 0x9C, 0xDF, 0x00, 0x59, 
+0xF2, 0x26, 0xC2, 0x6A, 0x75, 0x99, 0xCC, 0x2B, 
+0xA9, 0xE2, 0xF4, 0x55, 0x60, 0x0F, 0x0A, 0x5F, 
+0xA3, 0xAF, 0xF3, 0x0B, 0x43, 0x60, 0x8D, 0x96, 
+0x3A, 0xF4, 0x07, 0x89, 0x59, 0x1B, 0xDF, 0x58, 
+0xFB, 0xDD, 0x8D, 0x07, 0x13, 0x0D, 0x7E, 0xD5, 
+0x6B, 0xFD, 0x56, 0xFF, 0x3C, 0xA0, 0x5B, 0xE8, 
+0x23, 0x32, 0x98, 0xCE, 0x07, 0x05, 0x07, 0xBA, 
+0x52, 0xD6, 0x0A, 0x0F, 0x4E, 0xFB, 0xCB, 0x15, 
+0x29, 0x9B, 0xA5, 0xCD, 0x6D, 0x0C, 0x28, 0xA1, 
+0x6C, 0x57, 0xF9, 0xBE, 0x64, 0x4E, 0x08, 0xB3, 
+0x4C, 0xA3, 0x35, 0x12, 0x3F, 0x0A, 0xEB, 0xEC, 
+0x7E, 0xC1, 0x78, 0x2D, 0x25, 0xC1, 0x1B, 0xD9, 
+0x2D, 0xFF, 0xF9, 0xC9, 0xD5, 0x73, 0xFB, 0xD8, 
+0x8E, 0xB5, 0x84, 0xA6, 0x36, 0x9D, 0x5F, 0xF8, 
+0xE4, 0x71, 0x44, 0x6B, 0xAA, 0xEC, 0x45, 0x2E, 
+0xFD, 0x42, 0x15, 0x15, 0x39, 0x6C, 0x40, 0x39, 
+0xDC, 0x58, 0xBB, 0xF3, 0x19, 0x24, 0x15, 0xD3, 
+0x82, 0x26, 0xFB, 0x73, 0x77, 0x82, 0xD2, 0x88, 
+0x31, 0x1C, 0x20, 0x0E, 0x6A, 0xDC, 0x6C, 0xAF, 
+0x94, 0x4A, 0x6A, 0x84, 0x29, 0xFF, 0xCA, 0x61, 
+0x1E, 0x51, 0xDF, 0xE9, 0x57, 0x05, 0x10, 0xE6, 
+0x0A, 0xD1, 0x5B, 0xDB, 0xBA, 0x9D, 0x5C, 0x98, 
+0x6F, 0xAF, 0x33, 0xE6, 0x5B, 0xA5, 0xCD, 0xB0, 
+0xE9, 0xF6, 0xB0, 0x1A, 0x4E, 0x70, 0x04, 0x9B, 
+0x3F, 0xC2, 0x2B, 0x6E, 0x3A, 0x9B, 0xB7, 0xA2, 
+0xDA, 0x44, 0xB4, 0x45, 0x4F, 0xBC, 0x85, 0xEF, 
+0x54, 0x44, 0x57, 0xE7, 0xE1, 0xCD, 0x1B, 0x09, 
+0x5D, 0x4B, 0x9C, 0x64, 0xF9, 0x15, 0xDC, 0xAE, 
+0xE8, 0x6D, 0xF7, 0xB9, 0x60, 0x86, 0xD4, 0xC3, 
+
+0x8E, 0x16, 0x2C, 0xCE, 0xEA, 0xC2, 0xAD, 0xB2, 
+0xBD, 0x6F, 0x69, 0x8A, 0xCD, 0x63, 0xC6, 0xBC, 
+0xB4, 0x8C, 0x78, 0xC7, 0x60, 0xAB, 0xC1, 0x9A, 
+0x6A, 0xB4, 0xD0, 0x1B, 0x21, 0xFA, 0xE6, 0x6B, 
+0xE0, 0x4B, 0x47, 0x92, 0x93, 0x04, 0x5C, 0x8B, 
+0xAF, 0xF2, 0x3F, 0x7D, 0x8E, 0xA7, 0xF5, 0x5C, 
+0x6C, 0x14, 0xAF, 0xC3, 0x19, 0xD6, 0xAE, 0x14, 
+0xCE, 0x25, 0xD4, 0xFE, 0xD8, 0xC4, 0x53, 0x58, 
+0x51, 0x2C, 0x20, 0xB9, 0x03, 0x76, 0x33, 0x98, 
+0xD6, 0x41, 0x1C, 0x1F, 0x42, 0x10, 0x94, 0xB6, 
+0xE6, 0x8C, 0xC3, 0xA6, 0xDE, 0xD2, 0x68, 0x0E, 
+0x77, 0x86, 0x4D, 0x40, 0xC2, 0x10, 0x17, 0xAA, 
+0x5B, 0xF7, 0xC4, 0x5E, 0x96, 0x14, 0x43, 0x2E, 
+0x19, 0x88, 0x7D, 0x62, 0x79, 0x7D, 0xDF, 0x07, 
+0x30, 0x92, 0x3F, 0x3C, 0x1B, 0x07, 0x4C, 0x0D, 
+0xCF, 0x99, 0xB1, 0x64, 0x88, 0x9B, 0x2C, 0x88, 
+0x8F, 0x60, 0xB2, 0x00, 0xD2, 0xF3, 0x83, 0x99, 
+0x36, 0xF3, 0xFB, 0xEE, 0x55, 0x60, 0xA9, 0xB4, 
+0xBC, 0x1E, 0x0D, 0xCB, 0x37, 0xDB, 0x27, 0x61, 
+0x6D, 0xE5, 0x00, 0x67, 0x7B, 0xE2, 0xF1, 0xD6, 
+0xEB, 0x9C, 0xF1, 0xEA, 0x4C, 0xC1, 0x3C, 0xC2, 
+0x1A, 0x78, 0xA5, 0x24, 0xA6, 0x07, 0x3F, 0xA3, 
+0xDC, 0xB0, 0x45, 0x8D, 0x48, 0xE9, 0xB2, 0x94, 
+0xE1, 0x78, 0x21, 0x74, 0x0B, 0x5B, 0x8C, 0x6F, 
+0xC1, 0x6B, 0x59, 0x71, 0xC2, 0xB2, 0xC2, 0x1A, 
+0x86, 0xEB, 0x08, 0xF5, 0x21, 0xFC, 0x2B, 0x59, 
+0x0E, 0x57, 0xB1, 0xA3, 0xDA, 0xAA, 0x77, 0xC0, 
+0xCE, 0xE4, 0xFD, 0xA9, 0x34, 0x40, 0x60, 0xDC, 
+0xB3, 0x62, 0x46, 0x40, 0xF3, 0x28, 0x14, 0x22, 
+0x7E, 0x11, 0xF5, 0xF6, 0xA7, 0x19, 0x61, 0x1C, 
+0xC7, 0x19, 0x73, 0x4A, 0xE5, 0x6D, 0xA3, 0xE8, 
+0x65, 0x74, 0x7A, 0x8D, 0x44, 0x33, 0x58, 0x0F, 
+0xB4, 0x89, 0x8F, 0x96, 0x20, 0xBE, 0x9E, 0x63, 
+0x89, 
+
+0x52, 0xD3, 0x20, 0x01, 0xF2, 0x96, 0xC6, 0x5D, 
+0x0D, 0x30, 0xFC, 0x80, 0xAF, 0xA4, 0x31, 0x0F, 
+0xDE, 0x03, 0xA3, 0xA2, 0x04, 0xAF, 0x7E, 0x64, 
+0x4F, 0xA9, 0x68, 0x7A, 0xF9, 0x34, 0xF0, 0x51, 
+0x30, 0x58, 0x89, 0xCE, 0xB5, 0x0D, 0x83, 0xB1, 
+0x44, 0xFC, 0x8F, 0x5B, 0x15, 0xB0, 0x5C, 0xF3, 
+0x89, 0xB8, 0x37, 0xDF, 0x4E, 0x87, 0x5A, 0x7C, 
+0xFE, 0xCC, 0x9E, 0x93, 0x6C, 0x7F, 0x92, 0x85, 
+0xC8, 0x75, 0xFE, 0x5F, 0x4B, 0x3B, 0x44, 0x1D, 
+0x42, 0x82, 0xDF, 0x28, 0xFC, 0x1C, 0x29, 0xFA, 
+0x16, 0xD1, 0x23, 0x58, 0xCB, 0xA8, 0x38, 0xB3, 
+0x6B, 0x95, 0x4B, 0xA8, 0xFB, 0x01, 0xAC, 0x45, 
+0x41, 0x8E, 0x22, 0x04, 0x5B, 0x2C, 0xE4, 0x6C, 
+0x3C, 0xC3, 0x58, 0xAA, 0x81, 0x37, 0x3C, 0xF9, 
+0x88, 0x52, 0x24, 0x58, 0xD8, 0x07, 0xDC, 0x84, 
+0x8B, 0x58, 0x26, 0xB2, 0xE8, 0x89, 0xDB, 0xD6, 
+0x66, 0x5B, 0x40, 0xE7, 0xAE, 0x19, 0xF1, 0x6A, 
+0x02, 0xFB, 0x89, 0x54, 0xE8, 0x0C, 0x69, 0x68, 
+0x41, 0x7B, 0xE1, 0x90, 0xF5, 0xF6, 0xDE, 0x71, 
+0xEC, 0x4F, 0xAE, 0x85, 0xE6, 0xB9, 0xDB, 0xDB, 
+0xA2, 0x1E, 0x6A, 0x17, 0xE4, 0x73, 0x7B, 0xAA, 
+0xF5, 0xD9, 0x86, 0xF8, 0xDF, 0x7D, 0x37, 0xDF, 
+0x0E, 0xA8, 0x5E, 0x61, 0x78, 0x8B, 0x23, 0x13, 
+0xEE, 0x94, 0xEC, 0x23, 0x5D, 0xF6, 0x27, 0xBF, 
+0x38, 0x32, 0x29, 0xAE, 0x75, 0x7E, 0xD5, 0x0C, 
+0xDB, 0xC0, 0x4D, 0x76, 0x93, 0x29, 0xD7, 0x50, 
+0x7B, 0x06, 0xD3, 0x93, 0x04, 0x21, 0xBD, 0xDC, 
+0x67, 0x68, 0xBF, 0x87, 0x71, 0x74, 0xD3, 0x4D, 
+0xD2, 0x1C, 0x2C, 0xD5, 0x29, 0x64, 0x1F, 0x31, 
+0xAC, 0xC1, 0x60, 0x41, 0x35, 0x8C, 0x5D, 0x93, 
+0x51, 0x0B, 0xAF, 0xCF, 0x73, 0xBF, 0x34, 0x74, 
+0xD9, 0xDB, 0xCA, 0x5B, 0x63, 0xF7, 0xC0, 0x76, 
+0x0F, 0x3F, 0x8C, 0xB0, 0x5C, 0x1B, 0x4D, 0xB3, 
+0x02, 0xF9, 
+
+0x51, 0xC0, 0xCB, 0x32, 0x08, 0x05, 0x79, 0x70, 
+0x25, 0x15, 0x33, 0x44, 0x8A, 0x15, 0x21, 0xBE, 
+0xA4, 0x0C, 0x60, 0xA6, 0xD4, 0x92, 0x94, 0xB4, 
+0x0B, 0xCC, 0x1F, 0x0A, 0x76, 0x95, 0x1B, 0xCA, 
+0xB4, 0x51, 0x8A, 0x8A, 0xE1, 0x6E, 0x8F, 0x33, 
+0xF4, 0x39, 0xEB, 0x65, 0x96, 0xCA, 0xAC, 0x60, 
+0xE1, 0xF9, 0xE1, 0xDB, 0x33, 0xCF, 0x85, 0x35, 
+0xBD, 0x02, 0x23, 0x4F, 0xE7, 0xDC, 0x20, 0xD6, 
+0x67, 0x2B, 0x04, 0x81, 0xCD, 0xE6, 0xBC, 0x63, 
+0xB3, 0x12, 0x97, 0xD4, 0x27, 0x45, 0xC3, 0x1E, 
+0x6C, 0xAB, 0x56, 0x35, 0xC4, 0x8C, 0xA0, 0x2D, 
+0x8A, 0x3E, 0x65, 0x8E, 0x6F, 0x73, 0x64, 0x9A, 
+0x73, 0x85, 0xD7, 0xC1, 0xB6, 0x84, 0xBF, 0x27, 
+0x57, 0xEA, 0xD3, 0x67, 0xD1, 0x09, 0xF4, 0x1D, 
+0xD1, 0x82, 0xE6, 0xEA, 0x31, 0x2C, 0xAF, 0x44, 
+0xE3, 0xC2, 0x9A, 0xCF, 0xDD, 0x4B, 0x98, 0x52, 
+0xD2, 0x02, 0x30, 0x8B, 0x00, 0xCB, 0x01, 0xED, 
+0x24, 0x7F, 0x78, 0xB2, 0x29, 0x98, 0x4A, 0x8B, 
+0xEE, 0xDF, 0x32, 0x6B, 0xB5, 0x8C, 0x4B, 0x12, 
+0xD2, 0x23, 0x15, 0xC6, 0xE6, 0xCA, 0xBF, 0x94, 
+0xF9, 0x96, 0xCC, 0x75, 0xB2, 0xEF, 0x0D, 0x7F, 
+0x99, 0x50, 0xD2, 0xAF, 0x1E, 0x73, 0x09, 0x12, 
+0xE8, 0x12, 0xDC, 0xCB, 0xB8, 0x8B, 0xDC, 0x93, 
+0x68, 0xA6, 0xF8, 0x37, 0xF3, 0x70, 0x93, 0xFB, 
+0x12, 0xC6, 0x34, 0x0B, 0x26, 0xA8, 0xA6, 0x56, 
+0xCF, 0x63, 0x63, 0x44, 0xD7, 0xAD, 0x4B, 0x79, 
+0x5A, 0x71, 0xDE, 0x2E, 0xDA, 0x1D, 0x1C, 0x2E, 
+0xF0, 0xA4, 0x16, 0x9A, 0x19, 0xC2, 0x8A, 0x2A, 
+0x55, 0xAE, 0xA1, 0x31, 0x2E, 0x0E, 0xA4, 0xAE, 
+0xB6, 0x5C, 0xBA, 0xB4, 0xEA, 0x22, 0xD4, 0xBC, 
+0xB3, 0x8B, 0xDB, 0x5D, 0x6A, 0x6B, 0xB7, 0x86, 
+0xB4, 0x90, 0xD1, 0x5C, 0xF0, 0x1B, 0xF3, 0x4C, 
+0x94, 0x11, 0xA5, 0x19, 0x54, 0x86, 0x2D, 0xBC, 
+0x3F, 0xDA, 0x41, 
+
+}
+return kat[i:j]
+}
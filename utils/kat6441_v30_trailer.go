@@ -0,0 +1,2841 @@
+package utils
+func getkat6441_v30_trailer(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xFA, 0x4E, 0xB0, 0xF3, 0x24, 0xA7, 0x9A, 0x4F, 
+0x81, 0x09, 0x95, 0x81, 0x43, 0xCD, 0x98, 0xB4, 
+0xF8, 0x42, 0xA7, 0x21, 0x6C, 0x89, 0xF1, 0x70, 
+0xC3, 0x48, 0x0D, 0x64, 0x94, 0x18, 0x05, 0xFE, 
+
+0xA7, 0xC2, 0x04, 0xF5, 0xCB, 0x2D, 0xE2, 0x7F, 
+0xA4, 0xD0, 0x39, 0xAD, 0x13, 0xD9, 0x18, 0x1E, 
+0x2D, 0x24, 0xBB, 0x67, 0x5B, 0x36, 0x76, 0xEF, 
+0x4E, 0x57, 0x40, 0x4B, 0xE7, 0xA5, 0x8B, 0x52, 
+0x3C, 
+
+0x53, 0xF5, 0x25, 0xD4, 0xAC, 0x90, 0xB9, 0x2A, 
+0x14, 0xB0, 0x05, 0x77, 0x13, 0x32, 0x3F, 0x7E, 
+0x26, 0x90, 0x67, 0x48, 0xC5, 0x13, 0x16, 0xCB, 
+0xEF, 0x60, 0x99, 0xF2, 0xD4, 0x75, 0x3A, 0xF2, 
+0x35, 0xD6, 
+
+0xB2, 0x92, 0xA6, 0x75, 0xCD, 0x72, 0x87, 0x0F, 
+0x53, 0x1B, 0x94, 0x84, 0x11, 0x2C, 0x19, 0x0F, 
+0xD1, 0x3C, 0xEA, 0x8F, 0xE4, 0x6B, 0x56, 0xF7, 
+0x9F, 0x6C, 0xE1, 0xC3, 0x65, 0x02, 0x0D, 0xDF, 
+0x31, 0xD9, 0xB9, 
+
+0x78, 0xF4, 0x15, 0x37, 0x5C, 0xB4, 0x54, 0xD0, 
+0xA5, 0x09, 0x6C, 0xD6, 0x8A, 0x38, 0xA9, 0x13, 
+0x73, 0xFE, 0x23, 0x69, 0x7D, 0x1E, 0x70, 0x86, 
+0x9E, 0x87, 0xC9, 0xF9, 0x7E, 0x34, 0x88, 0x95, 
+0xB5, 0xF5, 0xD1, 0xF6, 
+
+0x00, 0xBD, 0xDA, 0xDC, 0x58, 0x89, 0xC6, 0x41, 
+0x02, 0x29, 0x8C, 0x4A, 0x64, 0x22, 0xEB, 0xE4, 
+0xA9, 0xC6, 0xCC, 0x71, 0xDA, 0x14, 0xC6, 0xA3, 
+0x82, 0x6F, 0x1C, 0x14, 0x27, 0xAE, 0x5F, 0x43, 
+0x31, 0x8E, 0x14, 0xA0, 0x0D, 
+
+0x2E, 0xF3, 0xF4, 0x5D, 0x34, 0xF6, 0xDA, 0x5C, 
+0xC9, 0xC1, 0xDF, 0xDD, 0x5C, 0xA0, 0xE6, 0xE6, 
+0xF1, 0x45, 0xE5, 0x10, 0xA1, 0x9D, 0xFE, 0x21, 
+0x61, 0x2D, 0x12, 0x8E, 0x95, 0x0D, 0x2E, 0x26, 
+0x28, 0x4E, 0x37, 0x85, 0xFD, 0xB2, 
+
+0x0C, 0x62, 0x03, 0x4B, 0x55, 0x97, 0xC5, 0x0D, 
+0x60, 0x4F, 0x4E, 0x05, 0x76, 0x45, 0xAE, 0xD0, 
+0x6B, 0x90, 0x5F, 0x43, 0xAA, 0xC8, 0xAB, 0xA7, 
+0xD7, 0x1F, 0xE0, 0x13, 0x7D, 0xA5, 0x39, 0x40, 
+0x98, 0xD6, 0xFD, 0xDA, 0x5F, 0x05, 0xD3, 
+
+0xD6, 0x99, 0xBB, 0x51, 0xD8, 0x94, 0x0D, 0x30, 
+0x45, 0x09, 0xA6, 0x0B, 0x63, 0x14, 0x54, 0xF9, 
+0x74, 0x66, 0xF2, 0x30, 0x1E, 0xCF, 0xE3, 0xB7, 
+0x66, 0xF1, 0x1E, 0x23, 0xC2, 0x41, 0x5E, 0x62, 
+0x58, 0x85, 0xE8, 0x22, 0xCD, 0xF1, 0xCC, 0xF5, 
+
+0xFC, 0x06, 0xF2, 0x63, 0xA8, 0x38, 0xF2, 0xC9, 
+0x4C, 0x5D, 0x31, 0xFF, 0x7D, 0xAB, 0x69, 0x8F, 
+0x29, 0x65, 0x0F, 0x7B, 0x6B, 0xEA, 0x12, 0xC0, 
+0xEB, 0x02, 0xD8, 0x75, 0xA6, 0xE6, 0xE1, 0xD0, 
+0x96, 0x20, 0x37, 0xC7, 0x4F, 0xD9, 0xB9, 0x5D, 
+0x00, 
+
+0x05, 0xD7, 0x49, 0x08, 0xE1, 0xBA, 0x8F, 0xB5, 
+0x58, 0x75, 0x68, 0x9B, 0x4C, 0xF2, 0x80, 0xFC, 
+0x6E, 0x3B, 0xCA, 0xFB, 0x89, 0x7E, 0x27, 0x92, 
+0x1D, 0x47, 0x47, 0x6A, 0x9F, 0x6B, 0xC7, 0x74, 
+0x70, 0x5D, 0x5C, 0xCE, 0x2C, 0xE5, 0x6F, 0xF3, 
+0x70, 0x79, 
+
+0xED, 0x52, 0x36, 0x00, 0xA1, 0x0E, 0x87, 0x13, 
+0x07, 0x49, 0x28, 0x8D, 0x35, 0x30, 0xBA, 0x18, 
+0xC7, 0x10, 0xD2, 0x43, 0x23, 0xB5, 0x51, 0x64, 
+0x38, 0xA0, 0xCC, 0x62, 0x11, 0x0B, 0x8F, 0x70, 
+0xE7, 0x7E, 0xF9, 0x70, 0x74, 0x3C, 0x4F, 0x29, 
+0xDC, 0xF2, 0x15, 
+
+0xED, 0x97, 0x39, 0x35, 0x61, 0x09, 0x47, 0xA0, 
+0xF7, 0xB4, 0xFD, 0x0F, 0x04, 0x61, 0x58, 0xF9, 
+0x7C, 0x45, 0xC7, 0xFC, 0x86, 0x22, 0x84, 0xCF, 
+0x23, 0xEF, 0x25, 0x17, 0xFD, 0xB9, 0xEB, 0x38, 
+0x25, 0xF6, 0x60, 0xD8, 0x03, 0xB1, 0x9E, 0x24, 
+0x2C, 0xEC, 0xE3, 0x5F, 
+
+0xA3, 0x50, 0xA1, 0x42, 0x9F, 0xFA, 0x9E, 0xC8, 
+0x36, 0x6F, 0xDB, 0x39, 0x24, 0x5F, 0x32, 0x91, 
+0xE0, 0x72, 0xD9, 0x15, 0x43, 0x8A, 0x39, 0x71, 
+0x58, 0x59, 0x0B, 0x5B, 0x7D, 0x8F, 0x46, 0x81, 
+0x44, 0xB2, 0x71, 0x96, 0xF8, 0x05, 0xC5, 0x16, 
+0x24, 0xE5, 0x73, 0xC3, 0xF8, 
+
+0xDB, 0x51, 0xEC, 0xC5, 0x3A, 0x50, 0x7D, 0xCF, 
+0xD0, 0x9C, 0xD3, 0x06, 0x97, 0x4F, 0x00, 0x14, 
+0x77, 0x82, 0xF3, 0x1A, 0x1F, 0xB5, 0xB8, 0xEB, 
+0x4B, 0x34, 0x0F, 0x18, 0x29, 0xDF, 0xCC, 0x62, 
+0xBC, 0x3F, 0xA8, 0x2D, 0xE4, 0x27, 0x05, 0xF9, 
+0xD0, 0x66, 0xCF, 0x98, 0x80, 0x43, 
+
+0x4B, 0xB9, 0xDB, 0x1F, 0xBC, 0x05, 0x08, 0x2A, 
+0x62, 0x8A, 0xC2, 0x7F, 0xE5, 0x19, 0x91, 0x64, 
+0xA4, 0xEB, 0xBF, 0x3D, 0x07, 0xBF, 0x8A, 0xA6, 
+0xAE, 0x1E, 0x72, 0xB7, 0x62, 0xBA, 0x4F, 0xFC, 
+0x32, 0x8B, 0x29, 0xB0, 0x4E, 0x98, 0x7C, 0x9E, 
+0x16, 0xC5, 0xAD, 0x2E, 0x75, 0x71, 0x7C, 
+
+0x92, 0x18, 0xFE, 0x70, 0xE0, 0x13, 0xB1, 0xFF, 
+0xA5, 0xFC, 0x57, 0x8B, 0x3A, 0x1F, 0xB4, 0x98, 
+0xD4, 0xF1, 0xA7, 0xC6, 0xE6, 0x79, 0xC3, 0x70, 
+0xEA, 0x21, 0x3A, 0x4F, 0x39, 0xC5, 0xEF, 0xD1, 
+0x28, 0xDB, 0xE6, 0x2B, 0x76, 0x02, 0x22, 0x07, 
+0x84, 0xEB, 0xF2, 0x0A, 0x77, 0x7F, 0x5E, 0x1C, 
+
+0x70, 0x87, 0xDE, 0x4D, 0xE1, 0x87, 0x09, 0x7D, 
+0x3F, 0x04, 0x5E, 0x2E, 0xB9, 0x2E, 0x27, 0x24, 
+0x6B, 0xCF, 0xB1, 0x51, 0x35, 0xD0, 0x61, 0xAD, 
+0x23, 0xF7, 0xB2, 0xD5, 0x3F, 0xEF, 0x97, 0x44, 
+0x76, 0x30, 0x83, 0x85, 0x52, 0xA5, 0x65, 0x04, 
+0x23, 0x1B, 0x6A, 0xBD, 0x8A, 0x35, 0x77, 0x1E, 
+0x44, 
+
+0xF6, 0x46, 0x0E, 0x28, 0xDE, 0xB9, 0x0B, 0x95, 
+0x4E, 0xCE, 0xE2, 0x1D, 0xB0, 0xAC, 0x55, 0xCF, 
+0x43, 0xAF, 0xA2, 0x27, 0x05, 0xFF, 0xFD, 0xD0, 
+0x5F, 0xE2, 0xE7, 0xC0, 0x13, 0xCE, 0x97, 0x26, 
+0xC5, 0x6F, 0xFD, 0x57, 0xE4, 0x45, 0x6C, 0x93, 
+0xA5, 0xD9, 0xF6, 0x07, 0x72, 0x6B, 0x72, 0x64, 
+0x87, 0xB0, 
+
+0x0F, 0x1B, 0xE0, 0x90, 0x99, 0xED, 0xCA, 0x70, 
+0x73, 0x32, 0xCA, 0xB3, 0x6A, 0xF4, 0x95, 0xED, 
+0xA6, 0xEC, 0x2C, 0xBC, 0x41, 0x11, 0x1F, 0x5C, 
+0xDE, 0x90, 0x8C, 0xAC, 0x9C, 0x68, 0x56, 0xA4, 
+0xA8, 0x8D, 0xEC, 0x52, 0x62, 0x13, 0x67, 0x6F, 
+0x8F, 0xE5, 0x24, 0xFF, 0xC2, 0xE9, 0x36, 0x0D, 
+0x14, 0xF7, 0x5C, 
+
+0xFF, 0x42, 0x4F, 0x93, 0xE9, 0xD9, 0x31, 0xAC, 
+0xF0, 0x74, 0x37, 0x22, 0xE9, 0xE9, 0x15, 0x5C, 
+0x3D, 0xE7, 0x33, 0x9B, 0x3B, 0xF5, 0x5E, 0x15, 
+0x3B, 0xB6, 0xCF, 0xDC, 0x3E, 0x90, 0xB2, 0x57, 
+0x75, 0xB5, 0x83, 0xBC, 0xC7, 0x8B, 0xC4, 0x62, 
+0x4B, 0xBC, 0x5C, 0x3F, 0x3C, 0x16, 0x0F, 0xF8, 
+0x1D, 0x81, 0x5B, 0xB1, 
+
+0x2D, 0x49, 0xF9, 0x7D, 0x95, 0xD0, 0x2D, 0xF1, 
+0xE9, 0x19, 0xE1, 0x3D, 0xB7, 0x7B, 0xFE, 0x9C, 
+0xB1, 0xC8, 0x9E, 0x55, 0x9A, 0xF0, 0x36, 0x14, 
+0xAB, 0x9E, 0xC9, 0xA1, 0xC0, 0x28, 0x38, 0x53, 
+0x8D, 0xE1, 0xA8, 0x4B, 0x95, 0x4D, 0xB5, 0xC7, 
+0xF2, 0xFA, 0x2C, 0xFC, 0x5D, 0x2A, 0x8E, 0xCE, 
+0xD1, 0x3E, 0x38, 0x25, 0xC6, 
+
+0xE8, 0xA8, 0x0C, 0x9E, 0xE7, 0xF0, 0x38, 0xED, 
+0x7F, 0xD0, 0x9D, 0xC7, 0xBC, 0xBA, 0x3F, 0x9F, 
+0x34, 0x12, 0xCF, 0xF4, 0x4C, 0x30, 0x16, 0x9B, 
+0xF4, 0x04, 0xF7, 0xF1, 0x48, 0x37, 0x7C, 0xE6, 
+0x69, 0x90, 0x7E, 0xA0, 0x72, 0xFC, 0x4F, 0x59, 
+0xA2, 0x7F, 0xAA, 0xF0, 0xAD, 0x9A, 0x11, 0x6E, 
+0x0D, 0x98, 0x2A, 0x4B, 0xE2, 0xBA, 
+
+0x14, 0x01, 0xDB, 0x36, 0x56, 0xDC, 0xB5, 0x5B, 
+0x83, 0xCE, 0xAE, 0x7B, 0x08, 0x4D, 0xAF, 0x28, 
+0x27, 0x10, 0xE4, 0x9F, 0x1E, 0x0A, 0x55, 0xAE, 
+0x35, 0x77, 0x83, 0xD6, 0x22, 0x38, 0xCA, 0xF9, 
+0x1D, 0x58, 0xC8, 0xF2, 0x4D, 0xEC, 0x17, 0xCC, 
+0xEA, 0xFD, 0xCE, 0x0B, 0xC0, 0x14, 0x1A, 0xE3, 
+0x52, 0x06, 0x23, 0x8B, 0x45, 0xD5, 0x1E, 
+
+0x40, 0xD7, 0xCB, 0xD4, 0xB8, 0xB4, 0x5F, 0x6B, 
+0xDC, 0x5D, 0x96, 0xB9, 0xC7, 0x02, 0xF4, 0x49, 
+0xF0, 0xED, 0xB2, 0x55, 0xE4, 0x09, 0xC0, 0x33, 
+0x74, 0xB5, 0x24, 0x50, 0xEA, 0x7C, 0x61, 0xF2, 
+0xAB, 0xBB, 0xA6, 0x2C, 0xDF, 0x87, 0xC4, 0x42, 
+0x75, 0x5E, 0x5A, 0x90, 0x54, 0x8C, 0xDC, 0x86, 
+0x70, 0xF4, 0x17, 0x11, 0x7F, 0xF9, 0x09, 0x16, 
+
+0x99, 0x7E, 0x6B, 0xCB, 0x26, 0x72, 0xF8, 0x3A, 
+0xB6, 0xD6, 0x6C, 0xE8, 0xCF, 0xEA, 0xA8, 0xC3, 
+0x41, 0x5E, 0x62, 0x0A, 0x34, 0x45, 0x5B, 0x58, 
+0x73, 0x90, 0xF1, 0x4E, 0x8F, 0xC4, 0x68, 0xD7, 
+0xC6, 0xBA, 0x0F, 0x26, 0xF4, 0x75, 0xD7, 0x30, 
+0x0D, 0xAB, 0x52, 0x69, 0xC0, 0x1B, 0x63, 0x20, 
+0x4D, 0x75, 0xC0, 0x50, 0x64, 0x60, 0x4D, 0xED, 
+0x7D, 
+
+0x86, 0xC7, 0x76, 0xE8, 0x37, 0x08, 0xD8, 0x26, 
+0xED, 0xB8, 0x2C, 0x22, 0xFB, 0x96, 0xE4, 0x2C, 
+0x3A, 0xB5, 0x54, 0x2C, 0x36, 0x70, 0xDF, 0x52, 
+0x9E, 0x00, 0x32, 0x2B, 0xA9, 0xEF, 0x7B, 0x0C, 
+0xB1, 0x28, 0x38, 0xD8, 0x58, 0xB3, 0xD9, 0x31, 
+0x2A, 0xB2, 0x5A, 0x00, 0xF0, 0x2D, 0xC1, 0x9A, 
+0xFF, 0xE2, 0x1E, 0xAB, 0x58, 0x7C, 0x34, 0x55, 
+0xDC, 0x18, 
+
+0xDB, 0x03, 0xD0, 0xB9, 0x55, 0x8D, 0x8C, 0x01, 
+0x06, 0x67, 0x63, 0x37, 0x16, 0x63, 0x86, 0x3E, 
+0xA7, 0xDA, 0x8D, 0x87, 0x1F, 0xAB, 0x45, 0xAF, 
+0x06, 0x12, 0x3A, 0x41, 0x62, 0x3E, 0xF4, 0xCE, 
+0x3C, 0x45, 0x13, 0xD7, 0x16, 0xB1, 0x07, 0xEE, 
+0x32, 0x44, 0xD5, 0x2D, 0x64, 0xFF, 0x89, 0x3E, 
+0x0E, 0x11, 0xFE, 0xEE, 0xAE, 0xB2, 0x71, 0x73, 
+0x07, 0xC4, 0x88, 
+
+0x26, 0x79, 0x83, 0x1D, 0x83, 0x57, 0x06, 0xCD, 
+0x07, 0xCB, 0x21, 0x77, 0x14, 0x34, 0x34, 0xD4, 
+0x07, 0xBB, 0xFC, 0xBE, 0x8C, 0xDB, 0x21, 0x1A, 
+0xE6, 0x15, 0x8A, 0xFF, 0x39, 0xE5, 0x5F, 0xE0, 
+0x4C, 0x0B, 0xAF, 0xA5, 0x06, 0x0D, 0xA1, 0xDA, 
+0xF2, 0x28, 0x73, 0xE7, 0x35, 0x3A, 0x4E, 0x23, 
+0xA7, 0x83, 0x39, 0x0D, 0xA5, 0x05, 0xB3, 0xED, 
+0xA6, 0x28, 0x72, 0x31, 
+
+0xFC, 0xA8, 0xE4, 0x40, 0x01, 0x91, 0xBB, 0x33, 
+0xF0, 0x85, 0x3F, 0xD1, 0xE9, 0x68, 0x59, 0xC9, 
+0x6C, 0x27, 0xBB, 0xE8, 0xCA, 0x1F, 0x16, 0x03, 
+0xB8, 0xF0, 0xC9, 0x12, 0xA4, 0x14, 0x74, 0x62, 
+0x5A, 0xDC, 0xB2, 0x09, 0xF5, 0x16, 0x06, 0x53, 
+0x90, 0x8C, 0x91, 0x9C, 0x54, 0x5C, 0x51, 0xD7, 
+0xE8, 0x06, 0xC9, 0x14, 0x71, 0xCA, 0x28, 0x6B, 
+0xDD, 0x96, 0x3D, 0xA9, 0xB1, 
+
+0x8D, 0x34, 0x0A, 0xCF, 0x32, 0x9C, 0xA6, 0x72, 
+0x28, 0x5D, 0xE3, 0xA3, 0x04, 0xAB, 0x6D, 0x3B, 
+0x9A, 0xC1, 0x30, 0x4F, 0x18, 0x37, 0xA3, 0x20, 
+0x5B, 0xE7, 0xC8, 0x5A, 0x0E, 0x63, 0xF9, 0xCF, 
+0xC4, 0x8B, 0x92, 0x8B, 0xDB, 0x82, 0x81, 0x29, 
+0xEA, 0xBB, 0xEE, 0xDA, 0xEA, 0x14, 0xFB, 0xF5, 
+0x85, 0xB2, 0x02, 0xC6, 0x7A, 0xC6, 0x8D, 0x5F, 
+0x25, 0xFC, 0x0A, 0x1E, 0x04, 0xC5, 
+
+0x54, 0x2A, 0xE8, 0xD1, 0xC2, 0xCA, 0x67, 0xDB, 
+0xF1, 0xCD, 0x7F, 0x0A, 0xD1, 0x61, 0x3A, 0x42, 
+0x29, 0xAD, 0x8F, 0x3E, 0x66, 0x81, 0x9F, 0xDD, 
+0x15, 0x26, 0x6A, 0xE4, 0x69, 0x4B, 0x7C, 0x5A, 
+0x70, 0xF3, 0x7B, 0x3E, 0x94, 0xFF, 0x59, 0x6C, 
+0x8F, 0x0A, 0x57, 0x47, 0x23, 0xB6, 0x1D, 0x94, 
+0xE0, 0xFC, 0x71, 0xA0, 0x33, 0xA5, 0x9C, 0x2D, 
+0xF2, 0xEC, 0xFD, 0x32, 0x50, 0x00, 0x9D, 
+
+0x7F, 0x4E, 0x09, 0x33, 0x0D, 0xEF, 0x06, 0x2D, 
+0xA3, 0x79, 0x84, 0xB5, 0x65, 0x9E, 0x8D, 0x08, 
+0x6A, 0xA9, 0xC9, 0x9B, 0x34, 0xFA, 0x3D, 0x38, 
+0x0A, 0x6A, 0xDE, 0xDE, 0xDF, 0x45, 0x7C, 0xAC, 
+0x42, 0x0C, 0xDA, 0x1E, 0x3B, 0xA2, 0x27, 0xA1, 
+0xF6, 0xE4, 0x73, 0x08, 0x86, 0xAA, 0xBE, 0x23, 
+0x33, 0xFC, 0x13, 0x14, 0xDF, 0xD5, 0xF5, 0x75, 
+0xD2, 0xAD, 0x7C, 0x3B, 0x7D, 0x14, 0xCA, 0x02, 
+
+0xD0, 0x62, 0xF2, 0x9F, 0xAC, 0x51, 0xC2, 0x0D, 
+0xC6, 0xAE, 0xD0, 0x94, 0xAB, 0xC7, 0x0C, 0x1C, 
+0xCD, 0xA4, 0x61, 0x28, 0x95, 0xE5, 0xC2, 0x24, 
+0x3A, 0x86, 0xDB, 0x13, 0x65, 0xE4, 0xBD, 0xEF, 
+0x90, 0xF0, 0x7D, 0xB1, 0xEA, 0xBB, 0xE9, 0xE5, 
+0x72, 0x43, 0x68, 0xEE, 0x52, 0xAC, 0xFC, 0x01, 
+0x22, 0x7F, 0xD6, 0x6C, 0xDC, 0x31, 0x8B, 0x48, 
+0x8D, 0x4A, 0x4C, 0xB6, 0x6B, 0x59, 0x58, 0xA1, 
+0x83, 
+
+0x4B, 0xF9, 0x62, 0x96, 0xBA, 0xF1, 0x1F, 0x4E, 
+0x1E, 0xA5, 0x83, 0xA0, 0x0E, 0x43, 0x65, 0xEA, 
+0x5F, 0x04, 0xD3, 0x95, 0x5C, 0x2F, 0x8E, 0xCB, 
+0xE9, 0xDC, 0xFD, 0xF1, 0xA4, 0x01, 0x4C, 0x69, 
+0x71, 0xF2, 0x93, 0xD3, 0xA8, 0xAE, 0xDC, 0x48, 
+0x8D, 0x37, 0x59, 0x59, 0xAF, 0x73, 0xBA, 0x69, 
+0x93, 0x61, 0x27, 0x9B, 0x58, 0x50, 0x05, 0x86, 
+0x5E, 0xCA, 0xB7, 0x7F, 0x86, 0x4B, 0x9F, 0x14, 
+0x4A, 0xC6, 
+
+0xF5, 0x7C, 0xC8, 0x18, 0xF0, 0x80, 0xBE, 0x09, 
+0xE9, 0x67, 0x63, 0x48, 0x97, 0x91, 0x69, 0xD2, 
+0x47, 0x14, 0x3C, 0x1B, 0xCA, 0xBC, 0x76, 0xBC, 
+0xD8, 0x97, 0xA7, 0xEA, 0xDB, 0x6E, 0x77, 0x96, 
+0xEE, 0xAB, 0x37, 0x1D, 0x59, 0xA0, 0x1B, 0x3A, 
+0x28, 0xAC, 0x18, 0x64, 0x3E, 0x0E, 0xF1, 0x42, 
+0xBB, 0xEA, 0x1F, 0xEF, 0xFD, 0xAA, 0xA3, 0xAC, 
+0xB0, 0x17, 0xF0, 0xBC, 0xA4, 0x31, 0xF3, 0xA6, 
+0xA8, 0x38, 0x64, 
+
+0x5F, 0x35, 0xFE, 0xB3, 0x32, 0x61, 0x6B, 0x7F, 
+0xFB, 0x75, 0x52, 0x1A, 0x03, 0x64, 0x8B, 0x68, 
+0x33, 0x11, 0x86, 0x7F, 0xE8, 0xDB, 0x9D, 0x5D, 
+0x12, 0xC4, 0xB3, 0x4C, 0x91, 0x87, 0xBA, 0xE3, 
+0x76, 0x3D, 0x0C, 0x93, 0x23, 0xC8, 0x97, 0xD0, 
+0x11, 0xA3, 0x94, 0xD8, 0x06, 0x06, 0x70, 0x56, 
+0x94, 0x35, 0x9E, 0x16, 0x0B, 0xF1, 0xEB, 0x04, 
+0x23, 0x8E, 0xD8, 0x05, 0x07, 0xE0, 0x11, 0x2A, 
+0xA2, 0xBD, 0x4F, 0x8C, 
+
+0xD5, 0xAC, 0xFB, 0x4F, 0x0A, 0xD0, 0xCD, 0xB4, 
+0x95, 0x00, 0x85, 0x6F, 0xE7, 0x75, 0x3A, 0xDE, 
+0x03, 0xB8, 0xA9, 0x33, 0xA5, 0xE1, 0x96, 0x0A, 
+0xFF, 0x26, 0xBE, 0x39, 0x91, 0xD7, 0x41, 0xBA, 
+0xA5, 0x14, 0xC3, 0xFF, 0x8D, 0xD1, 0x2D, 0x31, 
+0xC9, 0xFE, 0xFF, 0xF9, 0x4C, 0xA8, 0x90, 0xE2, 
+0x40, 0xB9, 0xEB, 0xA4, 0xB0, 0x3F, 0xD5, 0x86, 
+0x21, 0x97, 0x1E, 0x9A, 0x68, 0x43, 0x83, 0xE2, 
+0x29, 0x29, 0xC6, 0x46, 0xE6, 
+
+0x88, 0x47, 0xFB, 0x24, 0xEE, 0x57, 0x38, 0x6E, 
+0x77, 0x56, 0xCF, 0xB4, 0x01, 0xE4, 0x0F, 0x7B, 
+0x7D, 0x05, 0xB1, 0xF1, 0x1A, 0x23, 0x97, 0xC0, 
+0x27, 0x0B, 0x91, 0xD9, 0xFE, 0x6E, 0x44, 0x1C, 
+0x50, 0x70, 0x90, 0xAD, 0xEB, 0x85, 0x8B, 0x09, 
+0x10, 0xBE, 0x4B, 0xCD, 0xA2, 0x3C, 0xAD, 0x3F, 
+0x02, 0x77, 0x20, 0xDC, 0x96, 0x49, 0x04, 0x25, 
+0xAF, 0x3B, 0xB2, 0xAB, 0xB1, 0x3D, 0x46, 0x83, 
+0x45, 0x09, 0xD5, 0xF9, 0x27, 0xCA, 
+
+0xE5, 0x02, 0x9D, 0x1A, 0xF8, 0xAF, 0xFE, 0x2A, 
+0x8E, 0x35, 0xF5, 0x6E, 0xF6, 0x94, 0x48, 0x16, 
+0x82, 0x4F, 0xE5, 0xB2, 0xF7, 0x61, 0x5D, 0x25, 
+0x79, 0xE2, 0xFC, 0xE6, 0x57, 0x14, 0x19, 0x5C, 
+0x53, 0xF5, 0x41, 0xCF, 0x33, 0xA1, 0xEA, 0x3E, 
+0x67, 0x56, 0xAF, 0x6A, 0x83, 0x4D, 0x85, 0x62, 
+0x7B, 0x78, 0x0C, 0xE0, 0x1B, 0x46, 0x96, 0xB1, 
+0x5B, 0x55, 0xD7, 0xF9, 0x29, 0x88, 0x73, 0x9E, 
+0xCB, 0x0F, 0x0A, 0x0F, 0x63, 0x94, 0x83, 
+
+0xDE, 0x78, 0x42, 0x8F, 0x68, 0x7F, 0x6B, 0xCC, 
+0xCC, 0xA1, 0x5D, 0xE3, 0x17, 0x07, 0xE3, 0x60, 
+0x66, 0xC6, 0x46, 0x96, 0x14, 0xDD, 0x05, 0x6E, 
+0xC3, 0x02, 0x95, 0x79, 0x69, 0xC2, 0xFC, 0x83, 
+0x5B, 0x82, 0x8B, 0xE3, 0x69, 0xFC, 0x40, 0x94, 
+0x1A, 0xB2, 0x71, 0x56, 0xA8, 0xE0, 0x3F, 0x49, 
+0xC4, 0x71, 0x54, 0x85, 0x5B, 0xA9, 0x53, 0x4D, 
+0x57, 0x5E, 0x0A, 0x89, 0x16, 0x0F, 0xFC, 0x93, 
+0xB2, 0x34, 0xAE, 0xBE, 0x76, 0x39, 0xF8, 0xB3, 
+
+0x6C, 0x9E, 0xA1, 0x29, 0xC0, 0x72, 0xB5, 0xCD, 
+0xEF, 0xC9, 0xEA, 0xAE, 0xD0, 0xC8, 0x99, 0x7A, 
+0xAB, 0xF9, 0x5A, 0xA9, 0x4B, 0x4A, 0x4E, 0x3E, 
+0xFC, 0x69, 0x27, 0x77, 0xD8, 0x00, 0x04, 0x59, 
+0x97, 0xC6, 0x34, 0x04, 0xD3, 0x6E, 0x08, 0x40, 
+0xEE, 0x88, 0xD6, 0x8E, 0x4F, 0x47, 0xA9, 0xFD, 
+0xB6, 0xCE, 0xCD, 0x2C, 0xE2, 0x6C, 0x68, 0x8B, 
+0x46, 0x78, 0xB8, 0x1F, 0x54, 0xBC, 0xF9, 0xBF, 
+0xCC, 0x1B, 0x18, 0x29, 0xF4, 0xF0, 0x87, 0xDF, 
+0xA0, 
+
+0x64, 0x83, 0xDD, 0x30, 0x59, 0x47, 0x4F, 0x9D, 
+0xD4, 0xE1, 0x4D, 0x77, 0xB7, 0xCD, 0x73, 0x3E, 
+0xD3, 0x86, 0xD5, 0x73, 0x77, 0xB0, 0x0D, 0xDF, 
+0x8F, 0xF9, 0x5D, 0x6D, 0x52, 0xDD, 0xAA, 0x0E, 
+0x23, 0x38, 0xC5, 0xE8, 0xA7, 0x2D, 0x10, 0x1D, 
+0x7B, 0x6C, 0x79, 0x7E, 0x18, 0x09, 0x7D, 0xAF, 
+0xEE, 0xED, 0xCF, 0xAA, 0x8D, 0x9E, 0x95, 0x5A, 
+0x1D, 0xD4, 0x37, 0x03, 0xB8, 0xF7, 0x77, 0xD1, 
+0x38, 0x10, 0x9C, 0xB1, 0x3F, 0xA7, 0x5B, 0xDC, 
+0xA5, 0xDD, 
+
+0x1F, 0xC9, 0xF1, 0x2E, 0x74, 0x0D, 0x02, 0x1A, 
+0x21, 0xCD, 0xBA, 0xCB, 0x5D, 0x97, 0xD9, 0xE3, 
+0x96, 0x90, 0xDB, 0x29, 0x5D, 0x45, 0x4E, 0x58, 
+0x07, 0x81, 0xDA, 0xBE, 0x37, 0xEA, 0x4B, 0x98, 
+0x88, 0xBC, 0xE9, 0xBA, 0xAF, 0x8F, 0xA3, 0xF5, 
+0x8B, 0x78, 0x21, 0x0B, 0x6B, 0x61, 0xCC, 0x72, 
+0xEB, 0xA7, 0x49, 0xAF, 0xA9, 0xD6, 0x20, 0xC8, 
+0xFF, 0x89, 0xA0, 0x09, 0xFC, 0x0E, 0x3D, 0x10, 
+0xCE, 0xC1, 0xBA, 0x84, 0xFE, 0xAE, 0x8C, 0xED, 
+0xC2, 0xD4, 0x88, 
+
+0xD3, 0xB1, 0xA7, 0x9B, 0xE7, 0x58, 0x9B, 0xF8, 
+0x74, 0x55, 0xAE, 0xA5, 0x41, 0x14, 0xE8, 0x64, 
+0x31, 0xC2, 0x92, 0xD1, 0x96, 0x27, 0x7A, 0xA6, 
+0x80, 0x26, 0x53, 0xB9, 0xDF, 0x80, 0xB6, 0x21, 
+0xC2, 0x4A, 0x98, 0x48, 0x49, 0xF9, 0x67, 0x5A, 
+0x01, 0x8A, 0x10, 0xAB, 0x0E, 0xD5, 0x66, 0x8F, 
+0xCC, 0x20, 0x5C, 0x71, 0xD7, 0x23, 0x49, 0xB4, 
+0xAD, 0xE3, 0xCD, 0xE6, 0x00, 0x48, 0x22, 0x30, 
+0x3B, 0x3D, 0x7D, 0xD8, 0xF8, 0x11, 0x11, 0x2B, 
+0xC1, 0xB4, 0x6B, 0xA6, 
+
+0x53, 0x72, 0xC8, 0x2C, 0xF6, 0x4A, 0xB5, 0xB2, 
+0x05, 0x3D, 0x71, 0x41, 0x8E, 0x40, 0xC4, 0x22, 
+0x50, 0x31, 0x1B, 0xBA, 0x3F, 0xCA, 0xB7, 0xBE, 
+0x83, 0x4B, 0x8B, 0x36, 0xC1, 0x9B, 0xA1, 0xE9, 
+0xA9, 0x5D, 0x5E, 0xD2, 0x03, 0xEA, 0x1E, 0xD2, 
+0x89, 0xE6, 0x90, 0xA7, 0x25, 0x45, 0x1C, 0xE6, 
+0x4E, 0x40, 0xFA, 0xF1, 0xAE, 0x3A, 0x36, 0xD5, 
+0xF7, 0xE8, 0x50, 0x19, 0x9F, 0xA3, 0x1A, 0x00, 
+0x26, 0x5E, 0xEB, 0xB8, 0xCB, 0x45, 0x06, 0x6C, 
+0x66, 0xAB, 0xFD, 0x62, 0xF1, 
+
+0x5D, 0x86, 0x96, 0xBC, 0xEA, 0xB5, 0x3B, 0x00, 
+0xC1, 0xFC, 0x22, 0xF0, 0x5F, 0x3D, 0x1E, 0x6B, 
+0x10, 0x0A, 0xE0, 0xB9, 0x3A, 0x52, 0x08, 0x25, 
+0x05, 0x6E, 0xA9, 0x19, 0x26, 0x36, 0x64, 0x3D, 
+0x3B, 0xA5, 0xBD, 0xBA, 0x3C, 0x71, 0xAE, 0x3B, 
+0x85, 0x37, 0x18, 0xCB, 0xC4, 0x62, 0x9C, 0x56, 
+0x6E, 0x00, 0x25, 0x1A, 0xE8, 0x4D, 0x61, 0x55, 
+0x14, 0x5F, 0x05, 0xE4, 0x1B, 0xBB, 0xCE, 0xB2, 
+0xF4, 0x7D, 0xD7, 0xC2, 0xD2, 0x9B, 0x10, 0x4C, 
+0xDF, 0xA3, 0x94, 0xC3, 0x7A, 0x0C, 
+
+0xC8, 0xF4, 0xAC, 0xDE, 0x46, 0xD4, 0x1E, 0xD4, 
+0xF9, 0x02, 0x7A, 0x07, 0x56, 0x2C, 0x5C, 0xCA, 
+0xCA, 0xAF, 0xBD, 0xD7, 0x26, 0x3C, 0x92, 0x93, 
+0x4C, 0x49, 0x1D, 0x24, 0xC5, 0x33, 0x6F, 0x25, 
+0xD5, 0xCB, 0x99, 0xD1, 0x77, 0x62, 0x4C, 0x5F, 
+0xC2, 0x5C, 0x14, 0x6C, 0xD0, 0xC9, 0x8C, 0x50, 
+0xCC, 0x88, 0x9A, 0xBA, 0x9D, 0xE6, 0xC5, 0xFB, 
+0x1A, 0x28, 0xB7, 0xDD, 0x88, 0x3C, 0xCB, 0x3D, 
+0xF5, 0xD6, 0xC9, 0xA2, 0x4B, 0x20, 0x38, 0xF5, 
+0x45, 0x7C, 0x89, 0x7E, 0x0C, 0x63, 0x19, 
+
+0xDF, 0x48, 0x90, 0xC4, 0x10, 0xDB, 0x2D, 0x0E, 
+0x0C, 0x33, 0x30, 0xD3, 0x07, 0x67, 0xC1, 0x0B, 
+0xBC, 0xE3, 0x60, 0xCB, 0x88, 0x90, 0x72, 0xE5, 
+0x75, 0x49, 0xE9, 0x07, 0xBA, 0x87, 0xB2, 0x13, 
+0xBA, 0x15, 0x1D, 0x6D, 0xBB, 0x64, 0x18, 0x3A, 
+0x13, 0x56, 0x30, 0x5D, 0x2F, 0xDC, 0xC9, 0x18, 
+0x8E, 0x16, 0x7D, 0x8E, 0x42, 0xF0, 0x7E, 0xEC, 
+0x84, 0x8E, 0xB1, 0x2C, 0x24, 0xD5, 0xF8, 0x19, 
+0x7A, 0x4D, 0x0E, 0xAC, 0x2A, 0x14, 0xCE, 0xD6, 
+0x5B, 0x85, 0x83, 0xFA, 0xD0, 0x50, 0x47, 0xC8, 
+
+0x2C, 0xFE, 0x9A, 0xA9, 0x43, 0x0E, 0x02, 0xD1, 
+0x24, 0x54, 0x00, 0x7B, 0xFD, 0x2A, 0x66, 0x10, 
+0xCA, 0xA4, 0xE4, 0xA5, 0xD2, 0x57, 0x48, 0xA5, 
+0x39, 0x7E, 0x2B, 0xCE, 0xAD, 0x40, 0x3A, 0xE6, 
+0x00, 0x54, 0xDE, 0xC3, 0x8D, 0x0F, 0x2D, 0x0B, 
+0x0B, 0x47, 0x45, 0x2A, 0x92, 0x35, 0x23, 0xE0, 
+0xB4, 0x15, 0xAE, 0x0E, 0x04, 0x89, 0x43, 0x27, 
+0xE4, 0xF1, 0xE5, 0x4F, 0x21, 0x82, 0x55, 0xF0, 
+0x54, 0xD9, 0x97, 0x18, 0xEB, 0xFC, 0x84, 0x32, 
+0xCC, 0x59, 0x75, 0x8C, 0x49, 0x45, 0xE9, 0xD0, 
+0xE8, 
+
+0x96, 0x71, 0x89, 0xE4, 0xEC, 0x66, 0xCF, 0x80, 
+0xFF, 0xC3, 0x5B, 0xA8, 0x2F, 0xBA, 0x62, 0xD0, 
+0xA5, 0x46, 0xB0, 0xA7, 0x7C, 0x5B, 0x6F, 0x7D, 
+0x8F, 0xA2, 0x4B, 0x67, 0xE9, 0xAF, 0x14, 0x2E, 
+0x43, 0xB8, 0xD0, 0x13, 0x78, 0x78, 0xB5, 0x75, 
+0x80, 0x0C, 0xC2, 0xA0, 0x4C, 0x62, 0xB3, 0xC8, 
+0x7E, 0xA9, 0xD9, 0x81, 0x12, 0xE7, 0x4E, 0x32, 
+0xBC, 0x46, 0xE9, 0x0E, 0xF0, 0x34, 0x24, 0x12, 
+0x32, 0x5F, 0xDD, 0x5C, 0x11, 0x92, 0x89, 0xCE, 
+0xE4, 0xAC, 0x57, 0x13, 0xA5, 0x7F, 0x66, 0x67, 
+0xEA, 0xF7, 
+
+0x20, 0xE0, 0x97, 0x10, 0x4E, 0x18, 0x60, 0xE9, 
+0x94, 0xC5, 0x03, 0x98, 0xF2, 0xA9, 0x43, 0x9F, 
+0xBD, 0x6C, 0xC8, 0xBB, 0x53, 0xEC, 0xD4, 0x8D, 
+0xE7, 0x26, 0x31, 0xC9, 0xF4, 0x1F, 0xD7, 0x09, 
+0xCB, 0x87, 0x13, 0x90, 0xAF, 0x18, 0x42, 0xF3, 
+0xB4, 0x22, 0x93, 0x00, 0xD0, 0x56, 0x36, 0x7C, 
+0xED, 0x4F, 0x0E, 0x8C, 0x29, 0xB7, 0x49, 0x59, 
+0x83, 0x6B, 0x5B, 0x66, 0xBF, 0xC3, 0x4E, 0x76, 
+0x4F, 0x7A, 0x51, 0x02, 0x23, 0x34, 0x1E, 0xC3, 
+0xD0, 0x62, 0x5F, 0x04, 0xA8, 0xDF, 0x9B, 0x22, 
+0xA8, 0x2E, 0x88, 
+
+0xBD, 0xBD, 0xED, 0x66, 0xF7, 0x17, 0xCC, 0x7A, 
+0x14, 0xB5, 0x83, 0x83, 0xBA, 0xC0, 0x96, 0x7D, 
+0x97, 0x36, 0x62, 0x80, 0x72, 0x5B, 0x84, 0xC8, 
+0x65, 0xF4, 0x18, 0xEC, 0x1C, 0xFC, 0x37, 0x18, 
+0x40, 0x26, 0x64, 0x0E, 0xF8, 0xA8, 0x66, 0x3C, 
+0x94, 0x0C, 0x98, 0xAE, 0x6B, 0x71, 0x74, 0xAC, 
+0x75, 0x11, 0x39, 0xDA, 0x90, 0xBA, 0x57, 0x87, 
+0x33, 0x10, 0x2E, 0x42, 0x23, 0x89, 0x0B, 0xDC, 
+0x3F, 0x20, 0x34, 0x4C, 0xE2, 0x7A, 0x73, 0x49, 
+0x8F, 0x23, 0x4B, 0xB6, 0xCA, 0x0C, 0xF2, 0xF8, 
+0x9C, 0x0B, 0xC6, 0x3A, 
+
+0x30, 0x7B, 0xBB, 0x73, 0xFF, 0xF2, 0x10, 0xF4, 
+0xAA, 0x9F, 0xF2, 0x47, 0xF2, 0xC6, 0x2C, 0x7B, 
+0x72, 0x9E, 0x46, 0xA2, 0x7C, 0x1A, 0xBE, 0x96, 
+0x09, 0xD6, 0x44, 0xA2, 0x8C, 0x1C, 0x2C, 0x58, 
+0xB2, 0xCF, 0x04, 0xA0, 0xA6, 0x45, 0xBD, 0x8F, 
+0xCA, 0x7E, 0xE5, 0xCA, 0x7E, 0x5A, 0x78, 0x55, 
+0xF4, 0xF5, 0x64, 0xEC, 0xAC, 0xF0, 0xE0, 0x58, 
+0xF5, 0x7D, 0x2D, 0x81, 0x74, 0x25, 0x08, 0x1A, 
+0x40, 0xC0, 0x04, 0x33, 0x51, 0x6E, 0x2D, 0x0F, 
+0x07, 0x54, 0x64, 0x5F, 0x69, 0x5F, 0xBE, 0xC3, 
+0x55, 0xE8, 0xED, 0x67, 0xB9, 
+
+0x93, 0x05, 0x79, 0x0C, 0xBE, 0xA0, 0x38, 0x9F, 
+0x70, 0xA9, 0x7E, 0x38, 0x4C, 0x2B, 0x03, 0xD9, 
+0xAC, 0x22, 0x63, 0xFD, 0x78, 0xE8, 0x5F, 0x0F, 
+0xFB, 0x8B, 0x20, 0xD3, 0xCE, 0x51, 0x69, 0x8D, 
+0x27, 0xBD, 0x4F, 0x17, 0x13, 0xC9, 0x20, 0xA0, 
+0x09, 0x5A, 0x77, 0x9F, 0xAB, 0xC4, 0xA0, 0xDB, 
+0x90, 0xC4, 0x87, 0xE8, 0x80, 0x32, 0x5B, 0x1E, 
+0x71, 0x9B, 0x4D, 0xA6, 0x78, 0xCF, 0xB7, 0x7F, 
+0x15, 0xD8, 0x90, 0x4E, 0x55, 0x04, 0x5B, 0x7A, 
+0x84, 0x0E, 0xF8, 0xCB, 0xB9, 0x10, 0x84, 0x8B, 
+0xA1, 0x7F, 0xF7, 0x3E, 0x03, 0x6E, 
+
+0xB4, 0x60, 0x0F, 0xA6, 0xFA, 0x2C, 0x42, 0xE7, 
+0x9C, 0xF8, 0xE1, 0x47, 0x55, 0x38, 0xFF, 0xF7, 
+0x98, 0x3E, 0x36, 0xFA, 0xF5, 0x6E, 0x69, 0x2F, 
+0xA2, 0xB8, 0x26, 0xDF, 0x37, 0x88, 0x7E, 0x49, 
+0x50, 0x58, 0xE8, 0x2B, 0x15, 0x2E, 0x1F, 0x6C, 
+0x11, 0xA5, 0x10, 0x99, 0x4A, 0xF4, 0xDA, 0xDC, 
+0x3D, 0x15, 0x51, 0x48, 0x72, 0x91, 0x26, 0x06, 
+0xB1, 0xD3, 0x72, 0x1C, 0xD9, 0xF9, 0x68, 0xFB, 
+0x0C, 0xD7, 0x70, 0x9C, 0xCD, 0xA3, 0xE5, 0x95, 
+0xE2, 0xB5, 0xE4, 0x42, 0xAC, 0x26, 0x9C, 0x10, 
+0x3C, 0x7A, 0xA7, 0x64, 0x96, 0xC2, 0xDD, 
+
+0xC0, 0xB4, 0x3E, 0xCC, 0xD2, 0xA0, 0xCC, 0xD8, 
+0xDD, 0xBB, 0xF6, 0xF4, 0x26, 0x7A, 0xA8, 0xC2, 
+0x38, 0xAF, 0x52, 0xF1, 0xDB, 0xE4, 0xEA, 0xF2, 
+0xCD, 0x92, 0x5D, 0x1A, 0x9F, 0xA0, 0x0D, 0xE0, 
+0x86, 0x9A, 0xB7, 0x90, 0xE5, 0x1C, 0x96, 0x9E, 
+0x75, 0xA8, 0x59, 0x27, 0x19, 0x9A, 0xF4, 0xCA, 
+0x56, 0xC8, 0x66, 0xB9, 0x0D, 0x1E, 0x88, 0xE1, 
+0x31, 0xF6, 0xFA, 0xB6, 0x7D, 0x98, 0x69, 0x2D, 
+0x8F, 0xFF, 0xF6, 0x62, 0xEF, 0x93, 0xEF, 0xF5, 
+0x22, 0x10, 0x1A, 0x48, 0xE8, 0x05, 0xCF, 0x20, 
+0x1C, 0xE2, 0x6D, 0x83, 0x46, 0x40, 0xE0, 0x52, 
+
+0x12, 0x6D, 0xBE, 0x2D, 0x17, 0x1A, 0x3F, 0xF2, 
+0x4C, 0x96, 0x48, 0xFD, 0x05, 0xEA, 0x73, 0xDE, 
+0xCF, 0x8C, 0x3F, 0xC3, 0x6E, 0x12, 0xE6, 0xD1, 
+0x74, 0xC3, 0xEA, 0xEF, 0x55, 0xDC, 0x4B, 0xAE, 
+0x32, 0x9E, 0x2F, 0xC8, 0xDD, 0x7C, 0x60, 0xAB, 
+0x4F, 0xD7, 0xFD, 0x49, 0xBC, 0x80, 0x11, 0x8A, 
+0xAF, 0xC0, 0xED, 0xB5, 0xD5, 0x35, 0xBE, 0x0F, 
+0x4F, 0xA3, 0x32, 0xBD, 0x3F, 0x68, 0x8F, 0x29, 
+0x85, 0x43, 0xC8, 0x26, 0x68, 0x7A, 0x52, 0x00, 
+0xB2, 0x47, 0x87, 0xB9, 0xD8, 0xFA, 0x34, 0x6B, 
+0x0F, 0xA2, 0xAE, 0xE7, 0x0A, 0xAD, 0x36, 0x9E, 
+0x2E, 
+
+0x46, 0x20, 0x6F, 0x43, 0xE5, 0x00, 0xA9, 0xA4, 
+0xF9, 0xF4, 0x78, 0xC4, 0x96, 0x45, 0xA8, 0x91, 
+0x2B, 0x44, 0x4B, 0xED, 0x73, 0x6B, 0xCD, 0x16, 
+0x80, 0x27, 0x2E, 0x29, 0xA1, 0x24, 0xA2, 0x8A, 
+0xCC, 0x20, 0xE4, 0x67, 0xA1, 0x21, 0xF7, 0x1C, 
+0xD6, 0x3C, 0xCB, 0xF7, 0x6F, 0x5D, 0x9D, 0x2B, 
+0xD8, 0xD7, 0xCA, 0x13, 0x90, 0x55, 0xD1, 0x30, 
+0x61, 0xE2, 0x9B, 0x79, 0x11, 0xBA, 0x63, 0x72, 
+0x4E, 0x0E, 0xFD, 0x69, 0x9E, 0xDA, 0x50, 0xA3, 
+0xDF, 0x9D, 0x90, 0xA7, 0x24, 0xF0, 0x6C, 0x33, 
+0xE8, 0x79, 0x70, 0x2D, 0x28, 0x4F, 0x4C, 0xD7, 
+0xE8, 0x6A, 
+
+0xF0, 0x0A, 0x19, 0x26, 0xD6, 0xE5, 0xAE, 0x32, 
+0xCD, 0x58, 0x7B, 0xE9, 0x8C, 0x49, 0xF3, 0xBF, 
+0x77, 0xCA, 0x34, 0x3E, 0xD0, 0x0B, 0xAA, 0xCE, 
+0x59, 0x5C, 0xFB, 0x4D, 0x55, 0xB2, 0x7D, 0xF5, 
+0x62, 0xC1, 0x99, 0xDE, 0xF0, 0x74, 0xB3, 0xA2, 
+0xBB, 0x2D, 0x8B, 0xC7, 0xC8, 0x18, 0x51, 0x94, 
+0x8B, 0xE6, 0x38, 0xC3, 0xF7, 0x9E, 0x3A, 0x1B, 
+0xB5, 0x98, 0x41, 0x5F, 0x50, 0xB3, 0xC6, 0x96, 
+0x6E, 0x61, 0x1F, 0x98, 0x78, 0x78, 0xB5, 0xC5, 
+0x7B, 0x42, 0xCC, 0x31, 0x5C, 0xC4, 0x4F, 0x17, 
+0x1C, 0x95, 0xA8, 0x42, 0x5D, 0x11, 0x80, 0x45, 
+0x55, 0xF4, 0x14, 
+
+0x38, 0x1A, 0x06, 0x13, 0xE6, 0x67, 0x19, 0xD5, 
+0xA5, 0x16, 0xF6, 0x02, 0xEF, 0xFF, 0xFA, 0xB6, 
+0x37, 0xED, 0x5F, 0xB3, 0x9E, 0x88, 0x18, 0x97, 
+0xAC, 0xDA, 0xE2, 0x3B, 0x45, 0x5D, 0x0A, 0xD7, 
+0xCA, 0x89, 0x8F, 0x3F, 0x50, 0x03, 0xC9, 0x2F, 
+0xCE, 0x44, 0x27, 0x90, 0x33, 0xE7, 0xFB, 0xF0, 
+0x1C, 0x4B, 0x05, 0x6B, 0x15, 0xC0, 0x5E, 0x68, 
+0x47, 0x9C, 0x8A, 0xFE, 0x2F, 0xEF, 0xD3, 0xA6, 
+0xEF, 0xE2, 0x2C, 0x91, 0xA3, 0x19, 0x57, 0x99, 
+0xDF, 0x8D, 0x94, 0xCB, 0x23, 0x2A, 0xAA, 0x53, 
+0x91, 0x61, 0xEA, 0x55, 0xDE, 0xF8, 0xDA, 0xFB, 
+0xC6, 0x78, 0x14, 0x42, 
+
+0x89, 0x1B, 0xC8, 0xF9, 0x92, 0x73, 0x9A, 0x5F, 
+0xEB, 0x51, 0x24, 0x53, 0x52, 0x97, 0x03, 0x08, 
+0x4B, 0x5C, 0xAE, 0x9F, 0x31, 0x72, 0x8E, 0x72, 
+0xDA, 0x8F, 0x8F, 0x9F, 0x58, 0x75, 0x6B, 0xE1, 
+0xDC, 0x68, 0xC2, 0x68, 0xA1, 0x92, 0x9A, 0x20, 
+0x32, 0x32, 0x33, 0x73, 0x02, 0xB2, 0x1A, 0xCD, 
+0xE2, 0x45, 0xFB, 0x08, 0x0D, 0x2D, 0xF1, 0xD6, 
+0x30, 0x66, 0x4C, 0x01, 0x09, 0xC0, 0x0E, 0x88, 
+0x51, 0xAB, 0x15, 0x7C, 0x5F, 0x42, 0x77, 0x3C, 
+0xA0, 0x6D, 0x4C, 0x7E, 0x2E, 0x26, 0xC4, 0x65, 
+0x4D, 0xAD, 0x41, 0x96, 0x95, 0x8D, 0x9A, 0x24, 
+0x62, 0xE5, 0xD6, 0xBF, 0x9B, 
+
+0x12, 0x2F, 0x6E, 0xC2, 0xB6, 0x22, 0x2E, 0x2E, 
+0x92, 0x8E, 0xDE, 0x8E, 0x47, 0xF8, 0x7B, 0xB8, 
+0xB6, 0xF8, 0xE0, 0x96, 0xF9, 0x9F, 0xAD, 0x15, 
+0xB8, 0x61, 0x4D, 0x80, 0xA1, 0x5C, 0x09, 0x79, 
+0x0F, 0xB6, 0x90, 0xA0, 0x0B, 0x2D, 0x93, 0x74, 
+0x7E, 0x87, 0x16, 0xE8, 0x5D, 0x8B, 0x7E, 0xD5, 
+0xD1, 0x69, 0xE0, 0xF5, 0x68, 0xD5, 0x49, 0xED, 
+0xC9, 0x22, 0x30, 0xF9, 0x0E, 0xB3, 0xC2, 0x34, 
+0x88, 0xC7, 0x3C, 0xE2, 0x8A, 0x3D, 0x56, 0xF9, 
+0x27, 0xCA, 0xB5, 0x26, 0xAD, 0x0E, 0xDD, 0x20, 
+0xE2, 0x26, 0x4C, 0xEA, 0xE5, 0x91, 0x10, 0x44, 
+0x35, 0xFA, 0xF9, 0x2B, 0x2C, 0xFF, 
+
+0x9A, 0x7C, 0xB4, 0xAE, 0x90, 0xD0, 0xB5, 0x39, 
+0x2A, 0xF9, 0xA6, 0x8C, 0xDA, 0xF8, 0x8F, 0x86, 
+0xEA, 0x7D, 0xE4, 0x3A, 0x67, 0xD0, 0xA6, 0xAA, 
+0x1F, 0x53, 0x34, 0x64, 0x67, 0xAC, 0x9C, 0x02, 
+0x8A, 0xD7, 0xBE, 0x7D, 0x47, 0x33, 0x22, 0x05, 
+0x25, 0xA6, 0x92, 0x6B, 0x5F, 0x40, 0x7B, 0x9F, 
+0x95, 0x07, 0x1E, 0x33, 0x88, 0x04, 0xC6, 0x7B, 
+0x5F, 0xE5, 0xEC, 0x90, 0xB6, 0x24, 0x5F, 0x2A, 
+0xFA, 0x2C, 0x45, 0x22, 0x79, 0xA8, 0x20, 0x67, 
+0x70, 0xC2, 0xE0, 0x64, 0x69, 0x12, 0x9D, 0xC8, 
+0xA0, 0x10, 0x63, 0x7A, 0x3E, 0xDC, 0xF5, 0x29, 
+0x5B, 0x38, 0xB8, 0x5D, 0x38, 0xC7, 0x72, 
+
+0x31, 0x5A, 0xCD, 0x3A, 0x28, 0xB1, 0xF5, 0xA9, 
+0xAD, 0xE7, 0xBB, 0xA2, 0xF7, 0x78, 0x4D, 0xB8, 
+0x0C, 0x6E, 0x38, 0xE4, 0xFB, 0xEB, 0xD8, 0x0C, 
+0x99, 0x42, 0xE7, 0x90, 0xEC, 0xC4, 0x74, 0x20, 
+0xAF, 0x8B, 0x26, 0xC0, 0x1D, 0x6C, 0xD2, 0xE0, 
+0x41, 0x64, 0xAE, 0x9A, 0x35, 0xBE, 0xD1, 0x83, 
+0x73, 0x74, 0x0F, 0xAB, 0x2D, 0x28, 0x79, 0xB6, 
+0x96, 0x9E, 0x75, 0x13, 0xF8, 0xFE, 0x1D, 0xA9, 
+0xAF, 0xC2, 0x9E, 0x5A, 0x24, 0x73, 0xFD, 0xDA, 
+0xDA, 0x86, 0x18, 0xC0, 0x88, 0xA8, 0xEB, 0x13, 
+0xB9, 0x10, 0x07, 0x50, 0x8D, 0x9D, 0x1E, 0xBF, 
+0xDA, 0x92, 0x48, 0xFA, 0xC5, 0xCE, 0x42, 0x83, 
+
+0x83, 0x69, 0x14, 0xF3, 0x3D, 0x48, 0x43, 0x28, 
+0xE6, 0xC4, 0x87, 0x37, 0x5A, 0xC4, 0x57, 0x0D, 
+0xC5, 0xF5, 0x2E, 0xC2, 0xC2, 0x68, 0x09, 0xE9, 
+0xB4, 0x86, 0x6C, 0xDE, 0x79, 0x39, 0x5D, 0xE5, 
+0x84, 0x71, 0xE1, 0x65, 0x7A, 0x0B, 0xB3, 0xCC, 
+0xA2, 0x3E, 0x99, 0x3E, 0x95, 0x47, 0xAD, 0xC1, 
+0x65, 0xFF, 0x82, 0x39, 0xBC, 0xD5, 0x64, 0x3D, 
+0x8A, 0x79, 0xC1, 0xDE, 0xFE, 0xDB, 0xA4, 0x00, 
+0x0F, 0x1A, 0xE0, 0xE6, 0x9E, 0x01, 0x6B, 0xD4, 
+0x05, 0x8A, 0x94, 0x8A, 0xE1, 0x5F, 0xD5, 0x29, 
+0x9A, 0x6D, 0xD0, 0x9E, 0x38, 0x44, 0xDE, 0xA9, 
+0x41, 0xFC, 0xA3, 0xBD, 0x56, 0x76, 0xB2, 0xC2, 
+0x2C, 
+
+0x5D, 0xBA, 0x26, 0x65, 0xE5, 0xC3, 0x2F, 0xBB, 
+0x14, 0x04, 0x83, 0x26, 0x35, 0x31, 0x46, 0x0E, 
+0xB3, 0x60, 0xAA, 0x67, 0x52, 0x6F, 0xCA, 0x2E, 
+0xA6, 0xCF, 0x1B, 0x23, 0xBF, 0xD3, 0x07, 0xF1, 
+0x9C, 0x8B, 0x34, 0xF2, 0x67, 0x96, 0xCF, 0x79, 
+0x59, 0x33, 0x20, 0x12, 0x97, 0xB8, 0x58, 0x66, 
+0xDE, 0x94, 0xA3, 0xD9, 0x28, 0xC6, 0x30, 0xB9, 
+0x38, 0x19, 0x61, 0x65, 0xE7, 0xCD, 0x5B, 0x46, 
+0x6F, 0x1C, 0x6F, 0x43, 0xF1, 0x5B, 0xEA, 0x9D, 
+0xB6, 0x95, 0x20, 0x2E, 0x01, 0xAE, 0x63, 0x91, 
+0xA2, 0x71, 0x2F, 0x23, 0x0F, 0x44, 0xC2, 0xB1, 
+0x45, 0xAB, 0x8E, 0x61, 0x91, 0xBD, 0x82, 0x37, 
+0xB9, 0xE1, 
+
+0x8F, 0xA1, 0x40, 0xB9, 0xC4, 0x8E, 0x74, 0xE5, 
+0x4D, 0x77, 0xB3, 0x07, 0x2C, 0xDB, 0x4F, 0x40, 
+0xF8, 0x22, 0x87, 0x63, 0x32, 0x58, 0x16, 0x24, 
+0x43, 0x17, 0x0C, 0x8D, 0x63, 0xE3, 0x67, 0x92, 
+0x1B, 0x6E, 0x5F, 0xE5, 0xFC, 0x9B, 0xD5, 0xD5, 
+0x73, 0xE0, 0x57, 0xDE, 0x22, 0x70, 0x9C, 0xB9, 
+0x26, 0xB3, 0x41, 0x05, 0x62, 0x9D, 0x48, 0x64, 
+0x81, 0x81, 0x91, 0x58, 0xFC, 0xCB, 0xBC, 0x53, 
+0xE5, 0xC8, 0xB6, 0x3F, 0x33, 0x50, 0x42, 0x71, 
+0x04, 0xAA, 0x6D, 0xBA, 0x4E, 0x30, 0x29, 0xC3, 
+0x01, 0xE9, 0x78, 0xAE, 0xCF, 0xCD, 0xCD, 0xB8, 
+0x5F, 0x15, 0x3D, 0x1F, 0x65, 0x1A, 0x83, 0xC2, 
+0x05, 0xAA, 0x05, 
+
+0xD6, 0x24, 0xAF, 0x4C, 0x8C, 0xFA, 0xD8, 0x99, 
+0xBA, 0x61, 0x1F, 0x0C, 0x61, 0x63, 0xB5, 0x54, 
+0xD7, 0x81, 0x3A, 0x44, 0xE8, 0x7E, 0xAB, 0x43, 
+0x2F, 0x2D, 0xE5, 0x06, 0xE1, 0x1D, 0xC1, 0x8D, 
+0x82, 0x1A, 0xDD, 0xE2, 0x92, 0x03, 0x76, 0xAE, 
+0xE1, 0xB1, 0xF4, 0x68, 0x41, 0xD4, 0x30, 0xCF, 
+0x85, 0x2A, 0xDA, 0x77, 0xC7, 0xF8, 0xB7, 0x0E, 
+0xDA, 0x00, 0x49, 0x24, 0xAC, 0x5F, 0xA7, 0xF5, 
+0x3D, 0x2E, 0x4C, 0xC1, 0xF5, 0x3D, 0x89, 0x4E, 
+0x21, 0xD0, 0x68, 0xC6, 0x60, 0x42, 0x2C, 0x92, 
+0x34, 0x6D, 0xAC, 0x25, 0x3F, 0xF0, 0x97, 0xEE, 
+0x41, 0x40, 0x35, 0x8F, 0xF0, 0x03, 0x9E, 0x95, 
+0xB9, 0x57, 0x22, 0x26, 
+
+0x02, 0x44, 0xC1, 0xD2, 0xA4, 0xB3, 0xB0, 0x19, 
+0x57, 0xE9, 0x2A, 0x38, 0x35, 0xC3, 0x3B, 0x79, 
+0x56, 0xE5, 0x9C, 0x26, 0xAC, 0x4C, 0x55, 0x06, 
+0x6A, 0x36, 0x3C, 0x63, 0xCB, 0x30, 0x73, 0xB6, 
+0x54, 0x08, 0xFB, 0x99, 0x78, 0xCF, 0x78, 0x56, 
+0xED, 0x0F, 0x1D, 0x99, 0xBE, 0xA4, 0x4E, 0x7C, 
+0xE4, 0xD1, 0xE1, 0xA1, 0x7F, 0x97, 0x92, 0x1F, 
+0xB8, 0x73, 0x7E, 0x97, 0xEB, 0xA4, 0xEE, 0x93, 
+0x7A, 0x02, 0xAF, 0x76, 0x83, 0x42, 0x7C, 0x85, 
+0x07, 0xCC, 0x41, 0x68, 0x71, 0x4F, 0x57, 0xDA, 
+0xF8, 0xF5, 0x10, 0x02, 0x03, 0x6A, 0x90, 0x35, 
+0xED, 0x85, 0x5E, 0xB2, 0x55, 0xB4, 0x3D, 0x69, 
+0x44, 0xA7, 0x0A, 0x30, 0x5A, 
+
+0xDE, 0x4F, 0x9F, 0xFE, 0xC9, 0xFC, 0x15, 0x6A, 
+0x13, 0xA5, 0x02, 0xF0, 0x13, 0xFD, 0x22, 0x5D, 
+0xBE, 0x90, 0x90, 0x1D, 0x1F, 0x9E, 0x68, 0x8C, 
+0xBF, 0xB0, 0x71, 0xA3, 0x16, 0x87, 0x81, 0xFF, 
+0xE6, 0x93, 0x73, 0x25, 0xB3, 0x7E, 0x15, 0xED, 
+0x92, 0x55, 0xD8, 0x20, 0xFF, 0x73, 0x16, 0x8E, 
+0x5C, 0x49, 0x75, 0xE0, 0x19, 0x81, 0x1C, 0x35, 
+0x15, 0x4B, 0xFF, 0x67, 0x0C, 0x97, 0x98, 0x6D, 
+0xA7, 0x6F, 0x4E, 0x96, 0xE5, 0x0B, 0x27, 0x04, 
+0xEB, 0xE0, 0xAB, 0xD2, 0x72, 0xC2, 0xE2, 0x52, 
+0x1B, 0x23, 0xFA, 0x75, 0x04, 0xB7, 0x1F, 0x35, 
+0x9C, 0xF6, 0x5A, 0xCD, 0xB9, 0x54, 0xFA, 0x06, 
+0x7F, 0xBB, 0x17, 0xAC, 0x27, 0xD1, 
+
+0x0E, 0xB6, 0x22, 0xAF, 0x8C, 0xA4, 0x85, 0x62, 
+0x59, 0x06, 0x99, 0xF1, 0xCB, 0x5C, 0xA4, 0xAA, 
+0xC8, 0xEF, 0x6A, 0x42, 0xB9, 0xF6, 0x32, 0x62, 
+0x5F, 0x48, 0x68, 0x24, 0xEB, 0xCF, 0xCF, 0xEA, 
+0x1D, 0x86, 0x05, 0x2D, 0x75, 0xA1, 0x3B, 0xC5, 
+0xF6, 0x47, 0xA7, 0x3F, 0x3C, 0x6A, 0x68, 0x38, 
+0x40, 0x87, 0x92, 0x0A, 0x22, 0xD6, 0x1B, 0x52, 
+0x50, 0xAE, 0x5C, 0xA1, 0x58, 0x79, 0xCD, 0x0D, 
+0x25, 0xD6, 0x91, 0xEB, 0xF9, 0x9A, 0x40, 0xA1, 
+0xC4, 0x75, 0x22, 0x15, 0xA5, 0xDD, 0xC1, 0xE0, 
+0xA5, 0x9E, 0x28, 0xD9, 0xD4, 0x4C, 0xE3, 0xCA, 
+0xF7, 0x4A, 0x94, 0x30, 0x3E, 0x89, 0x98, 0x46, 
+0x13, 0xEE, 0x37, 0x10, 0xED, 0x08, 0x14, 
+
+0xFE, 0xA0, 0xA5, 0x60, 0x1E, 0x2D, 0x36, 0x6D, 
+0x6A, 0xBB, 0xD7, 0x71, 0x16, 0x0C, 0x80, 0xA3, 
+0x8F, 0x39, 0x78, 0x89, 0x75, 0x12, 0xD9, 0x10, 
+0xB4, 0xD6, 0x67, 0x58, 0x64, 0x0E, 0x74, 0x73, 
+0x4C, 0x2E, 0x10, 0x53, 0x05, 0x99, 0xA7, 0x85, 
+0xF2, 0x73, 0xCE, 0x8B, 0x96, 0xC4, 0xBF, 0xB4, 
+0xB4, 0x31, 0x23, 0x67, 0x21, 0xC1, 0xF3, 0x58, 
+0x55, 0x0A, 0xE0, 0x3B, 0xC7, 0xC6, 0x0B, 0x61, 
+0xFA, 0x1D, 0x7F, 0xFA, 0xF2, 0x84, 0x79, 0x4F, 
+0x4F, 0x92, 0x41, 0x9F, 0x39, 0xEC, 0x29, 0xC8, 
+0x9B, 0xB1, 0x8C, 0x76, 0x87, 0x04, 0x06, 0x89, 
+0xD3, 0x33, 0x01, 0x58, 0x82, 0xD1, 0xD2, 0x9D, 
+0x14, 0xB2, 0x5A, 0x6A, 0xA6, 0x1D, 0x3E, 0x6C, 
+
+0xD4, 0x6B, 0x9D, 0xDC, 0x04, 0xB4, 0x63, 0x5F, 
+0x10, 0xA9, 0x32, 0x2F, 0x24, 0x52, 0x38, 0x37, 
+0x44, 0x01, 0x67, 0x11, 0x60, 0x0E, 0xC7, 0xAF, 
+0xD5, 0xD8, 0xC8, 0xA6, 0x0B, 0xCE, 0xF7, 0x7F, 
+0xA0, 0xB6, 0x1C, 0xA0, 0x98, 0x1C, 0x3F, 0x6D, 
+0x46, 0x73, 0x28, 0x7E, 0x56, 0x80, 0x37, 0x38, 
+0x93, 0x6B, 0x45, 0x22, 0xC9, 0x3F, 0x52, 0x53, 
+0xEA, 0x0D, 0x87, 0x90, 0xEB, 0x57, 0x07, 0x9E, 
+0xCA, 0xE3, 0x47, 0x8B, 0xCD, 0x8B, 0x25, 0x82, 
+0xE3, 0x31, 0x19, 0x7C, 0x2B, 0x4D, 0x98, 0xDF, 
+0xBA, 0xBA, 0xA7, 0x3B, 0x58, 0xF7, 0x26, 0x41, 
+0xEF, 0x52, 0x65, 0xEE, 0x37, 0xA0, 0x0E, 0xCD, 
+0x2E, 0x2A, 0x87, 0x4F, 0xB4, 0x91, 0x5E, 0x2C, 
+0xD5, 
+
+0xD8, 0xFC, 0xD2, 0xC4, 0x76, 0xC6, 0x55, 0xFA, 
+0x2C, 0x73, 0x10, 0x26, 0xE8, 0x2C, 0xF1, 0xF7, 
+0xE3, 0x15, 0xE4, 0xC7, 0x2B, 0x4F, 0x18, 0x50, 
+0xD2, 0xC9, 0x71, 0x8D, 0xB2, 0x1C, 0xE2, 0xDE, 
+0xD9, 0x9F, 0x61, 0xEF, 0x07, 0xF7, 0x85, 0x5B, 
+0x4F, 0x05, 0xF0, 0x0E, 0xE4, 0xD5, 0x21, 0x7A, 
+0x15, 0xE6, 0x32, 0xCA, 0xCA, 0x3D, 0xFD, 0x1E, 
+0x4B, 0xA8, 0x38, 0x61, 0xD0, 0x98, 0xFF, 0x91, 
+0x6E, 0x76, 0x1F, 0xE1, 0xDF, 0xDB, 0x9E, 0x1F, 
+0x40, 0x15, 0x57, 0x0D, 0x44, 0xB9, 0xCF, 0xE7, 
+0x1D, 0x97, 0xA6, 0x73, 0xD0, 0x12, 0xE6, 0x41, 
+0x2F, 0xBD, 0x53, 0x34, 0x3B, 0x18, 0x0B, 0xCC, 
+0x36, 0x61, 0x7E, 0x56, 0xE3, 0x37, 0xF9, 0xBE, 
+0xCB, 0xCB, 
+
+0x94, 0x37, 0x7C, 0x1F, 0x68, 0x30, 0x1F, 0x83, 
+0x3B, 0xB7, 0xB0, 0x8D, 0x49, 0xB3, 0xE4, 0x85, 
+0xDA, 0xF5, 0x8A, 0x0E, 0x56, 0x41, 0x42, 0xAD, 
+0xEF, 0x02, 0xAA, 0xBA, 0x31, 0x59, 0x51, 0x15, 
+0xEB, 0xF7, 0x68, 0x06, 0xAE, 0x4A, 0xCF, 0x21, 
+0x24, 0xBE, 0xF1, 0x57, 0x9E, 0xC5, 0xC0, 0xCF, 
+0xE0, 0x1A, 0xC3, 0x9C, 0x70, 0xD3, 0xE4, 0x85, 
+0x4E, 0xAF, 0x68, 0x4F, 0x55, 0xF1, 0xD9, 0xD5, 
+0xEF, 0x41, 0xE5, 0xB6, 0xA2, 0xBB, 0x83, 0xA5, 
+0xC3, 0xE6, 0xF3, 0xF9, 0xB6, 0x5A, 0x3A, 0x11, 
+0x28, 0x91, 0xDC, 0x9D, 0x2C, 0x88, 0xC9, 0x28, 
+0x1C, 0x6B, 0xD3, 0x39, 0x95, 0xD5, 0xFD, 0x8F, 
+0x05, 0x0F, 0x43, 0x0A, 0x9D, 0xD9, 0x50, 0xAE, 
+0x40, 0x26, 0xCE, 
+
+0x73, 0xEA, 0xF4, 0xCA, 0x05, 0x5C, 0x05, 0x1A, 
+0x94, 0xAE, 0x2F, 0xEF, 0xAB, 0xF1, 0xDF, 0x1D, 
+0x11, 0x38, 0xFC, 0x06, 0x87, 0x27, 0xA4, 0xE9, 
+0xFC, 0xD7, 0xBB, 0xCB, 0x2B, 0x98, 0x78, 0xDE, 
+0x7B, 0x40, 0x5F, 0xA1, 0xBF, 0xCF, 0xF2, 0xF7, 
+0x29, 0x1A, 0x72, 0x47, 0x7B, 0x86, 0xC8, 0x67, 
+0x9C, 0xCE, 0x90, 0x85, 0xC6, 0xA2, 0x48, 0xB9, 
+0x01, 0xB3, 0x5F, 0x68, 0xF3, 0x43, 0x13, 0xE6, 
+0x20, 0x4B, 0xB2, 0xC2, 0xEC, 0xAF, 0x4C, 0x70, 
+0x80, 0xCD, 0x13, 0x11, 0x87, 0xFD, 0x99, 0x88, 
+0x5C, 0x3E, 0x31, 0xD9, 0x80, 0x67, 0x80, 0x56, 
+0xA0, 0x71, 0xFC, 0xF9, 0xE1, 0x99, 0x8C, 0xC5, 
+0x14, 0x82, 0x9E, 0x3A, 0xDC, 0x10, 0x99, 0x7F, 
+0xD6, 0x10, 0xFB, 0xAF, 
+
+0x33, 0x37, 0xA3, 0xB3, 0x5C, 0x4E, 0xF6, 0x5E, 
+0xBE, 0xC5, 0x4D, 0x8B, 0xC8, 0x3A, 0x73, 0x11, 
+0x14, 0x9C, 0xA0, 0x1F, 0x19, 0x2E, 0xDE, 0x9A, 
+0x32, 0xDA, 0xC5, 0x86, 0x82, 0xE9, 0x52, 0x5D, 
+0xE4, 0xDF, 0xF7, 0xFA, 0x84, 0xD2, 0x2F, 0x97, 
+0x2D, 0xBA, 0xCF, 0x8A, 0x4F, 0x18, 0xC2, 0x66, 
+0x00, 0xDC, 0x4B, 0x1F, 0x3C, 0x40, 0x1D, 0x12, 
+0x5C, 0x12, 0x7A, 0xD0, 0xA7, 0x63, 0xE1, 0x4B, 
+0x71, 0x8A, 0xAE, 0x78, 0x86, 0xDB, 0x7E, 0xAD, 
+0x82, 0xF6, 0x78, 0x97, 0x33, 0x9C, 0x40, 0x5F, 
+0x71, 0x40, 0xFB, 0xEB, 0xC7, 0x9B, 0xCA, 0x7B, 
+0xE0, 0x67, 0xB2, 0xB0, 0xC0, 0x3C, 0x7C, 0x09, 
+0xF7, 0x97, 0x16, 0xFD, 0xDC, 0x18, 0x5D, 0x59, 
+0xFF, 0xAD, 0x1A, 0xE6, 0x33, 
+
+0x20, 0x43, 0x50, 0x8B, 0x58, 0xFB, 0x49, 0x9D, 
+0x17, 0x97, 0x72, 0x0A, 0x36, 0xF0, 0x1A, 0x01, 
+0xFD, 0xF9, 0xFC, 0x19, 0xC8, 0x04, 0x2F, 0x33, 
+0x9D, 0xCB, 0x26, 0xF0, 0x4B, 0xBB, 0xE0, 0xF8, 
+0x33, 0x04, 0x39, 0x78, 0xA8, 0xFE, 0x88, 0xE2, 
+0x13, 0x47, 0x87, 0x95, 0x10, 0x98, 0x2F, 0x65, 
+0x0F, 0xC7, 0xDB, 0xD6, 0x85, 0x7E, 0x28, 0x0B, 
+0x5C, 0x4F, 0x2F, 0xAB, 0x08, 0xD5, 0x0B, 0x0F, 
+0x38, 0xFB, 0xC2, 0x4F, 0x5F, 0x42, 0xA7, 0x11, 
+0x6E, 0x45, 0x00, 0x69, 0x4E, 0x33, 0x38, 0x3F, 
+0x7E, 0x9E, 0xB8, 0x3F, 0xE4, 0x9F, 0x4E, 0x59, 
+0x31, 0x78, 0xAD, 0xEC, 0x6D, 0xBC, 0x51, 0xAB, 
+0xD8, 0x05, 0xE0, 0xD4, 0x37, 0x4E, 0x73, 0x99, 
+0xD0, 0x15, 0x7D, 0x0E, 0x24, 0x31, 
+
+0x50, 0x04, 0xD3, 0xA5, 0xC7, 0x66, 0x20, 0x16, 
+0x94, 0x65, 0xAA, 0xA8, 0x38, 0xC6, 0x4B, 0x63, 
+0x5E, 0xB1, 0x7A, 0xED, 0x51, 0x1C, 0xB6, 0xC0, 
+0xF1, 0xF7, 0x60, 0xF6, 0x4E, 0xA6, 0x00, 0x6B, 
+0x8F, 0x7B, 0x73, 0xDB, 0x8A, 0xBB, 0x19, 0xDE, 
+0x12, 0x22, 0xBE, 0xC7, 0x14, 0x93, 0x10, 0x78, 
+0x65, 0x5B, 0x13, 0x9B, 0x8C, 0xC7, 0xF8, 0xAB, 
+0x41, 0x67, 0x1F, 0xD9, 0x94, 0xB4, 0x2E, 0xEA, 
+0xD8, 0x29, 0x00, 0x26, 0x16, 0x25, 0xA9, 0xA1, 
+0xB0, 0x04, 0x9D, 0x6C, 0xCA, 0xF0, 0xDA, 0x67, 
+0xA9, 0x61, 0x0C, 0x91, 0xEA, 0x2A, 0x95, 0x36, 
+0xED, 0x8B, 0xE8, 0xA4, 0x14, 0x92, 0x0D, 0x5D, 
+0xF5, 0xE3, 0xA9, 0x01, 0xAF, 0x22, 0xFA, 0x2E, 
+0x94, 0x8B, 0x93, 0x2C, 0xA7, 0x5A, 0xD4, 
+
+0xF7, 0xF0, 0xD8, 0xBE, 0x57, 0x34, 0x32, 0x02, 
+0xF2, 0x39, 0x72, 0x4A, 0x04, 0xE0, 0xEB, 0x89, 
+0x66, 0x53, 0x71, 0x20, 0x53, 0xE8, 0x29, 0x17, 
+0x09, 0xD2, 0xD8, 0x31, 0x15, 0x9F, 0x66, 0x8F, 
+0xD4, 0x61, 0xB5, 0x0A, 0xF3, 0x7C, 0xB8, 0xE5, 
+0xCD, 0x13, 0xC5, 0xA6, 0x0D, 0x26, 0x81, 0xDE, 
+0x1E, 0x05, 0x28, 0x7D, 0x1C, 0xCB, 0xAC, 0x14, 
+0x51, 0x06, 0xCB, 0x44, 0x92, 0xD5, 0x48, 0x04, 
+0xA0, 0x10, 0x45, 0xAD, 0x26, 0xD3, 0xDB, 0xE5, 
+0xE3, 0xAF, 0x05, 0x10, 0x8B, 0x2A, 0x99, 0xB5, 
+0x07, 0xD0, 0x23, 0x43, 0x3D, 0x34, 0x9F, 0x8D, 
+0xCF, 0x90, 0x35, 0x0E, 0xDE, 0x48, 0xAC, 0x5E, 
+0x54, 0xC6, 0xCB, 0x43, 0x70, 0xD2, 0x59, 0x60, 
+0x1D, 0x3A, 0xBA, 0xAC, 0xED, 0xF6, 0x33, 0x5E, 
+
+0x81, 0xB2, 0xBE, 0xEC, 0x28, 0x75, 0xA6, 0x88, 
+0x71, 0x66, 0x44, 0xF2, 0xB9, 0xA4, 0x2B, 0x7E, 
+0x34, 0x9F, 0xBB, 0x1A, 0xA0, 0x8E, 0xB0, 0x58, 
+0x6B, 0x01, 0xE0, 0x74, 0xBE, 0x9B, 0x73, 0xDB, 
+0x57, 0x95, 0x28, 0xBF, 0xD8, 0x27, 0x9E, 0x42, 
+0x01, 0x43, 0x73, 0x08, 0xF9, 0xE8, 0xFF, 0xC2, 
+0x1E, 0xEA, 0x30, 0xA1, 0xD4, 0x35, 0xBD, 0x18, 
+0xDB, 0x4A, 0xFD, 0x99, 0x28, 0xEA, 0x9B, 0xAC, 
+0x3C, 0xE4, 0x7B, 0x10, 0x72, 0x0D, 0x35, 0xFD, 
+0x40, 0xA0, 0xF3, 0xE4, 0xB5, 0xA5, 0xDB, 0xAB, 
+0x8E, 0x95, 0xBE, 0x4D, 0xD6, 0x90, 0x67, 0x59, 
+0x4D, 0x5E, 0xFC, 0xA8, 0x52, 0xEF, 0xF7, 0xE1, 
+0x96, 0x5B, 0x5E, 0xAE, 0x8B, 0x88, 0x9F, 0x70, 
+0x43, 0x3E, 0x42, 0x9D, 0x4C, 0xF1, 0xC7, 0x76, 
+0x3F, 
+
+0xA8, 0xBC, 0x1F, 0x2A, 0x63, 0x31, 0xC5, 0x24, 
+0xCA, 0xA0, 0x6E, 0x5B, 0x7E, 0xBF, 0xAA, 0x29, 
+0xBB, 0xB2, 0xA4, 0x7F, 0xF1, 0x25, 0x1A, 0xCF, 
+0x4B, 0xA3, 0x43, 0xAF, 0x4A, 0x2A, 0x79, 0x49, 
+0x7B, 0xD1, 0xAE, 0xE5, 0x13, 0x3B, 0xF2, 0x36, 
+0x83, 0xD2, 0x57, 0xE9, 0xFD, 0x7E, 0x4D, 0x01, 
+0x3D, 0x15, 0x72, 0xAF, 0x8C, 0x9C, 0xDB, 0xFD, 
+0xC3, 0xA2, 0xF8, 0xF6, 0x8C, 0x55, 0x49, 0xB9, 
+0xA3, 0xC1, 0xF9, 0x42, 0xB6, 0x98, 0xF4, 0x33, 
+0x6C, 0x14, 0xD3, 0x67, 0x44, 0x84, 0x7C, 0xED, 
+0x41, 0x61, 0xE3, 0xF5, 0xB3, 0x60, 0xED, 0x82, 
+0x1A, 0xE2, 0x19, 0x46, 0x94, 0xB7, 0x29, 0x48, 
+0x74, 0xD4, 0x67, 0xEF, 0x10, 0xC8, 0x01, 0x0A, 
+0xDF, 0x8F, 0xDA, 0x14, 0x5B, 0xDD, 0x69, 0xD6, 
+0x54, 0x43, 
+
+0xB3, 0x81, 0xFD, 0x78, 0xA3, 0x15, 0x07, 0xFE, 
+0xD0, 0x0A, 0xB1, 0xCD, 0x44, 0x19, 0xC9, 0xA7, 
+0xE2, 0x87, 0xCF, 0x88, 0xFA, 0x40, 0x56, 0xD1, 
+0xC0, 0xCE, 0xD8, 0xE1, 0x31, 0xFE, 0xF8, 0x64, 
+0x6C, 0x25, 0xA3, 0x58, 0x73, 0xC6, 0x06, 0x44, 
+0x47, 0x9C, 0x66, 0x7F, 0x89, 0x15, 0x2E, 0x15, 
+0xDB, 0x44, 0x2B, 0x36, 0x5A, 0x0F, 0xAC, 0xAC, 
+0x41, 0x5D, 0x8D, 0xF6, 0x82, 0x61, 0xA5, 0x5F, 
+0x04, 0x2E, 0xE2, 0x86, 0x4F, 0x98, 0x40, 0xAA, 
+0xD5, 0xD6, 0x08, 0x02, 0xB7, 0x4F, 0x73, 0xF5, 
+0xEB, 0xDC, 0x31, 0x0A, 0xAF, 0x16, 0x19, 0xC6, 
+0xCC, 0x5A, 0x1F, 0x78, 0xE6, 0x1B, 0x22, 0xAB, 
+0xBF, 0x1E, 0x1F, 0x08, 0xE1, 0xDE, 0xA2, 0xDE, 
+0x92, 0x42, 0x6A, 0x05, 0x68, 0x58, 0x60, 0x3A, 
+0x01, 0x12, 0x7D, 
+
+0x85, 0xBB, 0x36, 0x04, 0x3F, 0x4C, 0x21, 0x84, 
+0x25, 0x52, 0xC2, 0x0B, 0x29, 0xA9, 0x69, 0x50, 
+0x5A, 0x19, 0x72, 0xAA, 0xA9, 0x4C, 0x89, 0xA9, 
+0xDF, 0xA7, 0x72, 0x97, 0x96, 0xDE, 0xB5, 0x95, 
+0xFF, 0xF6, 0x5F, 0xFC, 0xF7, 0x0D, 0xE9, 0x61, 
+0x19, 0x5C, 0xC8, 0x0C, 0xCE, 0xD6, 0xC2, 0xB5, 
+0x58, 0x62, 0x32, 0x62, 0xD2, 0x38, 0x4F, 0xA4, 
+0x79, 0xFA, 0xAD, 0x37, 0x23, 0x80, 0xA8, 0xBF, 
+0xD3, 0xE9, 0x23, 0x32, 0x4C, 0xD0, 0x66, 0xE1, 
+0x24, 0x4F, 0x0B, 0x28, 0xCD, 0xB7, 0x9C, 0x7C, 
+0x5A, 0x7B, 0xF6, 0xB0, 0xA2, 0x57, 0xCB, 0x3F, 
+0xFB, 0xE0, 0x70, 0x86, 0xEB, 0x54, 0x58, 0xAA, 
+0x90, 0xF4, 0x61, 0xBB, 0xAF, 0x18, 0xEF, 0x44, 
+0x61, 0x95, 0xE0, 0x4C, 0x11, 0x1F, 0x75, 0x4D, 
+0x00, 0xBB, 0x43, 0xF5, 
+
+0xD1, 0xFE, 0xC4, 0xDE, 0x12, 0x1A, 0x63, 0x36, 
+0x0A, 0x08, 0x8A, 0xFF, 0xFD, 0xAF, 0x0A, 0x2E, 
+0xB4, 0x0E, 0xB9, 0x2A, 0x5A, 0x2A, 0x0B, 0x3B, 
+0x46, 0x32, 0xDE, 0xA2, 0xC7, 0x07, 0x73, 0x42, 
+0xBE, 0x62, 0x01, 0xF1, 0xB3, 0xF0, 0x62, 0x67, 
+0x8C, 0x99, 0x53, 0x0B, 0x6C, 0xD3, 0x40, 0xEE, 
+0x8E, 0xE1, 0x97, 0xD1, 0xBE, 0x19, 0xCD, 0xDE, 
+0x75, 0x37, 0x6C, 0xE8, 0x21, 0xD7, 0x6E, 0x7A, 
+0x9D, 0xB5, 0xEA, 0xC0, 0xD9, 0x69, 0xB1, 0xBF, 
+0xE2, 0xEF, 0xF0, 0xBD, 0x01, 0xFB, 0x57, 0xDA, 
+0x8F, 0x62, 0x36, 0x46, 0x10, 0xAE, 0x95, 0xB3, 
+0x1B, 0x90, 0x65, 0x92, 0x96, 0xA9, 0x5D, 0x28, 
+0x83, 0xBE, 0x10, 0xA3, 0x82, 0x79, 0xAF, 0x7F, 
+0x77, 0xFB, 0xF8, 0x72, 0x76, 0xE5, 0x3A, 0x80, 
+0x0D, 0x53, 0x5C, 0x24, 0x2B, 
+
+0x26, 0x82, 0x9C, 0x64, 0x01, 0xB8, 0x57, 0x0C, 
+0x22, 0x95, 0x20, 0x5A, 0xF4, 0x29, 0xFD, 0xFD, 
+0x80, 0xD4, 0xF7, 0xB9, 0xE1, 0xF6, 0x2D, 0xDA, 
+0x7F, 0x40, 0x53, 0x90, 0xF4, 0x49, 0x08, 0x11, 
+0x0B, 0x21, 0x19, 0x9D, 0x3D, 0x98, 0xE8, 0x4D, 
+0xEE, 0x58, 0xCB, 0x20, 0x07, 0xEA, 0xEF, 0x86, 
+0x91, 0x81, 0xF5, 0x40, 0x73, 0xFB, 0xCF, 0x54, 
+0x18, 0x05, 0x23, 0x1D, 0x51, 0x21, 0x8B, 0x16, 
+0xC6, 0xFE, 0x94, 0x5F, 0xED, 0xC6, 0x0B, 0x30, 
+0x30, 0x3A, 0xEA, 0x8F, 0xE8, 0x49, 0x5D, 0x9A, 
+0xF2, 0xDB, 0x7A, 0x3E, 0x8D, 0xAC, 0xD6, 0xAD, 
+0xC3, 0xC4, 0xE8, 0xAE, 0x70, 0x37, 0xED, 0x91, 
+0x30, 0x91, 0xC0, 0x47, 0xD3, 0x43, 0xA3, 0xB8, 
+0xC8, 0xAB, 0xB6, 0xE2, 0xB4, 0x3B, 0xFC, 0x42, 
+0x6F, 0x12, 0x82, 0x08, 0x5D, 0x8B, 
+
+0xA7, 0x9C, 0x5B, 0x54, 0x2B, 0x92, 0x1C, 0x5F, 
+0xCE, 0x37, 0xEB, 0x05, 0x76, 0x67, 0x39, 0xF4, 
+0x15, 0x2B, 0x89, 0x67, 0xDB, 0xC4, 0xB7, 0x36, 
+0xD3, 0x56, 0xE2, 0x9A, 0x25, 0xD6, 0x47, 0x52, 
+0xA5, 0xB5, 0x7D, 0x30, 0x25, 0xE4, 0x92, 0x39, 
+0x9C, 0xC0, 0xDB, 0x94, 0x03, 0x8D, 0x26, 0x57, 
+0x2E, 0xC6, 0x90, 0x88, 0x37, 0x62, 0xD4, 0x82, 
+0x0C, 0xD4, 0xDD, 0x7D, 0x88, 0xC1, 0xDA, 0xE8, 
+0xD8, 0x26, 0x8C, 0x58, 0x69, 0x87, 0xDB, 0x05, 
+0xDB, 0x97, 0x4F, 0x0A, 0x94, 0xF0, 0xA7, 0x0A, 
+0x13, 0x4A, 0x25, 0x69, 0xAC, 0x34, 0x7D, 0x05, 
+0x14, 0x63, 0x69, 0x4D, 0x7D, 0xAC, 0xBF, 0x15, 
+0xCE, 0xCB, 0x38, 0xB2, 0xE6, 0x26, 0x56, 0x52, 
+0xD0, 0x31, 0x34, 0x3A, 0x3F, 0x25, 0xDE, 0xE5, 
+0xD1, 0x0B, 0x85, 0xAA, 0xB6, 0x12, 0x5C, 
+
+0xB1, 0x1B, 0xD2, 0x70, 0xE9, 0xA0, 0x11, 0x31, 
+0x6C, 0xF4, 0x64, 0x96, 0x93, 0xE5, 0x90, 0xC4, 
+0xFB, 0x8B, 0xEA, 0xAF, 0x27, 0x91, 0x47, 0x23, 
+0x3A, 0x12, 0xA2, 0x6C, 0x52, 0x8B, 0xF6, 0x2E, 
+0xAF, 0xA7, 0x81, 0x2B, 0xB8, 0x43, 0x4B, 0xEF, 
+0x35, 0x90, 0xBE, 0x1A, 0x6D, 0x8B, 0x8F, 0xD4, 
+0x71, 0x89, 0xE9, 0x94, 0x26, 0x82, 0x93, 0x09, 
+0xFB, 0xB8, 0xD1, 0xA1, 0x19, 0xDA, 0x94, 0x9A, 
+0xE3, 0x57, 0xD2, 0x66, 0x40, 0xD1, 0xBE, 0x49, 
+0x0A, 0x22, 0xE0, 0x35, 0x8B, 0x21, 0xDA, 0xCB, 
+0xF4, 0xED, 0xE6, 0xA2, 0x22, 0xF8, 0x5A, 0xFD, 
+0x4D, 0xEE, 0xD2, 0x7B, 0xA1, 0xAF, 0x1B, 0x64, 
+0x2E, 0x45, 0xC2, 0xD9, 0xC2, 0x83, 0x72, 0x4A, 
+0xC0, 0x20, 0xD9, 0xA5, 0x33, 0xD7, 0x14, 0x29, 
+0x94, 0x2F, 0xCE, 0xCB, 0x9A, 0x45, 0x9D, 0xDF, 
+
+0xB0, 0x84, 0x98, 0x23, 0xF4, 0x55, 0x8B, 0x12, 
+0xFE, 0x21, 0xCB, 0xD8, 0x88, 0x6B, 0x42, 0xA1, 
+0x35, 0xD4, 0x46, 0x5C, 0x4E, 0x0D, 0x36, 0x6C, 
+0x83, 0xA9, 0x4C, 0x12, 0x2D, 0x7F, 0xE6, 0x9F, 
+0xED, 0x05, 0x79, 0xEC, 0xD6, 0x0C, 0x6D, 0xFC, 
+0xB9, 0xA1, 0x69, 0x87, 0x6E, 0x49, 0x37, 0xCB, 
+0xC8, 0x24, 0x17, 0x95, 0x39, 0x8A, 0xFE, 0x03, 
+0xFF, 0x95, 0x10, 0xDC, 0x3F, 0xDF, 0x16, 0x40, 
+0x70, 0x24, 0x9C, 0x9E, 0x9B, 0x61, 0xF2, 0xB0, 
+0xD1, 0x93, 0x53, 0xFD, 0x44, 0x10, 0xD2, 0x2D, 
+0xE6, 0xD6, 0x90, 0x97, 0x25, 0xF5, 0xF0, 0x29, 
+0x10, 0x67, 0x30, 0xC9, 0x0D, 0x09, 0x08, 0x84, 
+0x0B, 0x88, 0x85, 0x38, 0x90, 0x3F, 0x82, 0x33, 
+0x71, 0xEB, 0x95, 0x91, 0xBE, 0xFA, 0x74, 0x7F, 
+0x0E, 0x4F, 0xE5, 0x8A, 0x3B, 0xFF, 0x38, 0x52, 
+0x2C, 
+
+0x33, 0x10, 0x0E, 0xBA, 0xD9, 0x56, 0xE8, 0xDF, 
+0xB5, 0xDB, 0x9C, 0xB8, 0x54, 0x0A, 0x7E, 0x1B, 
+0x9A, 0xB6, 0x8F, 0xBF, 0x1B, 0x0F, 0x15, 0xA1, 
+0x63, 0x81, 0x7F, 0xDC, 0x76, 0x23, 0x7B, 0xFF, 
+0x48, 0xCA, 0xE4, 0x3C, 0x5D, 0xA0, 0x92, 0xA2, 
+0x10, 0x26, 0x12, 0x85, 0x31, 0xFC, 0x36, 0xCC, 
+0xD5, 0x43, 0x60, 0xC3, 0xF8, 0x57, 0x35, 0x0C, 
+0x60, 0x42, 0xD5, 0xC3, 0xEB, 0x1A, 0xDF, 0xDD, 
+0x30, 0x9A, 0x09, 0xAB, 0xF7, 0xEB, 0xAF, 0xFF, 
+0x5B, 0x3D, 0x41, 0x6D, 0x6C, 0x89, 0x39, 0xBC, 
+0x33, 0xB5, 0x2E, 0x17, 0xC4, 0xD5, 0xE0, 0xBE, 
+0xD1, 0x85, 0xAD, 0xF4, 0xF3, 0x0E, 0x27, 0x09, 
+0x32, 0x3C, 0x37, 0x12, 0x1A, 0xA8, 0x60, 0x8E, 
+0x37, 0xC4, 0xA1, 0x1B, 0xE7, 0x6A, 0x1B, 0x9D, 
+0xB1, 0xF8, 0x38, 0x90, 0x51, 0x0F, 0x76, 0xA0, 
+0x1F, 0x95, 
+
+0x96, 0x9F, 0x1B, 0x53, 0xFE, 0x03, 0x49, 0xED, 
+0x81, 0xAF, 0x31, 0x1A, 0x8D, 0x5A, 0x4C, 0xF6, 
+0x53, 0x08, 0x0B, 0x5F, 0xA3, 0xEA, 0x3B, 0x8C, 
+0x68, 0xFA, 0x64, 0x94, 0xF6, 0x7C, 0xE1, 0x93, 
+0x96, 0xF7, 0x64, 0x62, 0xD4, 0xD8, 0xAD, 0x7E, 
+0x6A, 0xB0, 0x0C, 0xE5, 0xCA, 0xBB, 0xE9, 0x3C, 
+0x5C, 0x1A, 0x5E, 0x5C, 0xB4, 0xAF, 0x14, 0xBB, 
+0x37, 0x57, 0x93, 0x61, 0x15, 0x4F, 0xB3, 0x70, 
+0x7B, 0x16, 0x19, 0xE8, 0x4B, 0x47, 0x60, 0xE3, 
+0x88, 0xF7, 0x88, 0x3D, 0x4F, 0xB8, 0x80, 0x14, 
+0x0A, 0x27, 0x11, 0xEB, 0xE1, 0xDD, 0xD4, 0x9D, 
+0x10, 0x22, 0xBD, 0xC7, 0x04, 0x41, 0xAC, 0x11, 
+0xA6, 0x46, 0x8C, 0xFE, 0xE6, 0x4B, 0x46, 0x08, 
+0xBF, 0x04, 0x06, 0x36, 0x25, 0x3A, 0x50, 0x15, 
+0x42, 0x8F, 0x06, 0x15, 0x80, 0x67, 0xB4, 0x7F, 
+0x91, 0xFA, 0x67, 
+
+0x91, 0x59, 0x87, 0x84, 0xE1, 0x67, 0x46, 0x45, 
+0xC8, 0xAC, 0x92, 0xC7, 0xED, 0x99, 0x2C, 0x44, 
+0x6D, 0x85, 0x82, 0x50, 0x45, 0x87, 0x53, 0x8E, 
+0x20, 0xBE, 0x15, 0x47, 0xB0, 0x65, 0x09, 0xC2, 
+0x07, 0xD3, 0x83, 0x8F, 0xEA, 0x63, 0x56, 0x09, 
+0x4F, 0x45, 0xAA, 0x51, 0x8B, 0x84, 0xDF, 0x56, 
+0x87, 0x21, 0x31, 0xAA, 0x52, 0x2D, 0x5D, 0x34, 
+0xC6, 0x57, 0xA1, 0xB7, 0xCB, 0x57, 0x25, 0x85, 
+0xD4, 0x86, 0x9D, 0x36, 0x52, 0xC1, 0x1A, 0x29, 
+0x3B, 0xD6, 0x03, 0x1F, 0x27, 0xBE, 0x3D, 0x39, 
+0x28, 0xE6, 0xF5, 0x52, 0xDE, 0xDF, 0x17, 0x5E, 
+0x2E, 0xAC, 0x9B, 0x00, 0xAB, 0x54, 0xEA, 0x7E, 
+0xCF, 0x07, 0x47, 0x9F, 0x01, 0x1F, 0x26, 0x3D, 
+0xEE, 0xD8, 0x7F, 0x88, 0x61, 0xE2, 0x07, 0x47, 
+0x37, 0x91, 0xFD, 0x6F, 0xB1, 0x12, 0xEE, 0x25, 
+0x9A, 0xB5, 0x6C, 0x2C, 
+
+0x2C, 0xDA, 0x63, 0x67, 0xDD, 0xD5, 0xA0, 0xAD, 
+0x02, 0xCD, 0xB0, 0x0F, 0xB7, 0x6E, 0x44, 0x86, 
+0x4E, 0x63, 0x83, 0x64, 0x66, 0xE1, 0xAE, 0xE9, 
+0x03, 0xF5, 0x93, 0x7F, 0xB1, 0xAD, 0xC5, 0x3A, 
+0xFA, 0x6B, 0x64, 0xA4, 0xBD, 0xAB, 0x90, 0x0A, 
+0x2E, 0x5B, 0x08, 0x3E, 0xDC, 0x8F, 0x8C, 0xF7, 
+0xF0, 0x44, 0x91, 0x40, 0x78, 0xD6, 0x5D, 0x2A, 
+0x70, 0x17, 0xBC, 0xB0, 0xD1, 0x97, 0x14, 0xDC, 
+0x45, 0xE2, 0x5C, 0x20, 0x2D, 0x21, 0x74, 0x58, 
+0xD5, 0x9D, 0x2A, 0x51, 0xC4, 0x66, 0xE1, 0x3F, 
+0x23, 0xEC, 0xBB, 0x19, 0x45, 0xE0, 0x43, 0xDB, 
+0x84, 0x32, 0x6F, 0x98, 0xDF, 0x9A, 0xA2, 0x76, 
+0x04, 0x47, 0xBF, 0x33, 0xEA, 0x9B, 0xBB, 0x1A, 
+0xE3, 0xD9, 0xF9, 0xCD, 0xC0, 0x35, 0x12, 0xDB, 
+0xBD, 0x90, 0xED, 0xA5, 0xCC, 0xE7, 0x58, 0x66, 
+0x95, 0xB6, 0xE2, 0x41, 0xCB, 
+
+0x29, 0x01, 0xB7, 0xA9, 0xBC, 0x69, 0xEF, 0x33, 
+0xB4, 0x6B, 0x29, 0x59, 0x89, 0x21, 0xB5, 0x22, 
+0xDE, 0xCC, 0xAF, 0x7D, 0xBD, 0xC5, 0xAC, 0x5A, 
+0x90, 0x95, 0xA4, 0x90, 0x4B, 0x2C, 0xCB, 0x92, 
+0xE9, 0x50, 0xA8, 0x58, 0xE6, 0x41, 0xE8, 0x8E, 
+0x50, 0x4E, 0xB9, 0xDE, 0xC6, 0x19, 0x58, 0x72, 
+0xD3, 0xB9, 0xC8, 0xAB, 0x7B, 0xB6, 0xA7, 0x37, 
+0xF0, 0x55, 0x2A, 0x0F, 0x9F, 0x08, 0x07, 0x70, 
+0xBE, 0x53, 0xF0, 0x1C, 0xD0, 0x13, 0xCF, 0x49, 
+0xE3, 0xF0, 0x3E, 0x04, 0xEA, 0xC2, 0x4D, 0x18, 
+0x75, 0xC8, 0xA2, 0x56, 0x4E, 0x03, 0x40, 0x27, 
+0x37, 0x8D, 0x8E, 0x10, 0xF1, 0x01, 0x3E, 0xBF, 
+0x69, 0xDE, 0xA6, 0xFD, 0xEC, 0x1E, 0x3F, 0x90, 
+0xFD, 0x94, 0x57, 0xB1, 0x71, 0x30, 0x65, 0x5A, 
+0x60, 0xF2, 0x33, 0x5A, 0xC2, 0xA8, 0xEC, 0xED, 
+0x81, 0x6B, 0x70, 0xAD, 0xE4, 0xE5, 
+
+0x6E, 0xE2, 0xD6, 0xFF, 0xBD, 0x1B, 0x11, 0x30, 
+0xD2, 0xCD, 0x1E, 0xD5, 0xBC, 0xEE, 0x73, 0x7A, 
+0x93, 0x44, 0x92, 0xCE, 0xD0, 0x27, 0xA0, 0x87, 
+0xF1, 0x0B, 0xAA, 0x07, 0x27, 0x14, 0x68, 0x65, 
+0x37, 0x37, 0xB7, 0xA4, 0xCE, 0x31, 0x94, 0x9E, 
+0x7E, 0x17, 0x28, 0x36, 0x01, 0x68, 0x3C, 0x6B, 
+0x0D, 0xEA, 0x79, 0x85, 0xAC, 0x43, 0xA1, 0x53, 
+0x01, 0x8D, 0x47, 0x8E, 0x16, 0x23, 0xB3, 0xF6, 
+0xC1, 0x8A, 0x17, 0x0E, 0x1E, 0xB2, 0x53, 0x5F, 
+0x09, 0x66, 0x0A, 0xAF, 0x80, 0xA0, 0xDB, 0xBB, 
+0x19, 0x16, 0x7C, 0x12, 0x4D, 0xE0, 0x70, 0xC4, 
+0xDD, 0x2D, 0xF1, 0x76, 0xB6, 0x28, 0xE6, 0xF5, 
+0xF5, 0x11, 0xA7, 0x52, 0xD1, 0x5B, 0xAD, 0x93, 
+0xB7, 0x23, 0x89, 0xB7, 0xFE, 0x28, 0xE3, 0x36, 
+0xCB, 0x3F, 0x83, 0xA6, 0x1A, 0x3A, 0x14, 0xBC, 
+0xCE, 0x59, 0x88, 0x6C, 0xD9, 0xE8, 0x97, 
+
+0x0D, 0x99, 0x62, 0x84, 0xF7, 0xB6, 0x3F, 0x9C, 
+0x0F, 0x49, 0x6A, 0xBC, 0x46, 0x9C, 0x98, 0x37, 
+0xC8, 0xDA, 0xC2, 0xFC, 0xFA, 0x9E, 0x64, 0x43, 
+0x8D, 0x85, 0x9C, 0x0C, 0x42, 0x18, 0xD4, 0x44, 
+0x3F, 0xB7, 0xDC, 0x0E, 0xCF, 0x17, 0x6C, 0xD6, 
+0x7C, 0xE4, 0x2C, 0x91, 0xDC, 0x07, 0x0C, 0x77, 
+0x2F, 0x0C, 0xB6, 0x95, 0x43, 0x29, 0x9D, 0xD0, 
+0x66, 0x10, 0x57, 0xAB, 0x3C, 0xCA, 0x10, 0x9B, 
+0x95, 0x2F, 0x41, 0xB2, 0x03, 0x2A, 0x86, 0x80, 
+0x40, 0x51, 0x7B, 0x15, 0xFE, 0xAE, 0xA4, 0xA5, 
+0xAE, 0x00, 0xF3, 0x97, 0x9A, 0x6E, 0xDD, 0x79, 
+0xB7, 0x9C, 0x60, 0xC1, 0x64, 0xB5, 0x6E, 0x0A, 
+0xC6, 0x46, 0x36, 0x68, 0x27, 0x0A, 0x4B, 0xDA, 
+0xC9, 0xF5, 0xA0, 0x87, 0x30, 0x9F, 0x13, 0xAC, 
+0x7E, 0x59, 0xB9, 0x43, 0xFD, 0xB0, 0xB0, 0xCF, 
+0x0B, 0xA7, 0xEC, 0x8F, 0x52, 0xF0, 0x64, 0xEC, 
+
+0x33, 0x4E, 0x46, 0xDB, 0xFA, 0x46, 0x21, 0xAE, 
+0x74, 0xC9, 0x52, 0x24, 0x61, 0xFC, 0xE4, 0x60, 
+0xF8, 0xBC, 0x19, 0x97, 0x6B, 0x8D, 0x36, 0x7E, 
+0x85, 0xBB, 0x01, 0x4B, 0xEE, 0x8A, 0x99, 0xD2, 
+0x43, 0x1C, 0x11, 0xCB, 0x79, 0x98, 0xD2, 0x08, 
+0x96, 0x80, 0x07, 0xF9, 0x90, 0xE7, 0x57, 0x0A, 
+0x47, 0xB8, 0xFC, 0x33, 0x02, 0xBF, 0x75, 0xB2, 
+0x9C, 0xF9, 0x9E, 0x47, 0xFA, 0x5B, 0x53, 0xC6, 
+0x74, 0x98, 0x88, 0xE1, 0x24, 0x62, 0x9F, 0x4C, 
+0xF6, 0xA9, 0x45, 0x7D, 0xA6, 0x45, 0x5A, 0x75, 
+0xA5, 0x98, 0x8E, 0xE9, 0xA2, 0x65, 0x60, 0x74, 
+0x46, 0xC6, 0x38, 0xEB, 0xED, 0x9E, 0xEA, 0x94, 
+0x1C, 0xDB, 0xA7, 0x8B, 0xB6, 0xC0, 0x8C, 0x6C, 
+0x27, 0xA5, 0x4B, 0x47, 0x44, 0xA1, 0xAD, 0xCC, 
+0xE9, 0xC0, 0xDE, 0x7A, 0x8C, 0x26, 0x35, 0xC7, 
+0xBF, 0x51, 0xEC, 0x9A, 0x80, 0x49, 0xD4, 0xAE, 
+0x27, 
+
+0x9B, 0x81, 0x4A, 0xB2, 0x99, 0x41, 0x14, 0xF6, 
+0xE7, 0x36, 0xF9, 0x91, 0x04, 0xC0, 0x46, 0x27, 
+0x9A, 0x07, 0x20, 0x32, 0xFF, 0xA2, 0x57, 0xC8, 
+0xB5, 0xE8, 0xF8, 0x64, 0xB0, 0x7D, 0x05, 0x2B, 
+0x90, 0xF2, 0xC9, 0x33, 0xC4, 0x81, 0xD9, 0x31, 
+0x25, 0x31, 0x79, 0x08, 0xC4, 0x01, 0x2C, 0x5C, 
+0x45, 0xC4, 0x94, 0x15, 0xE8, 0x48, 0x4E, 0x9B, 
+0x38, 0x0D, 0x81, 0x95, 0x79, 0xB5, 0x78, 0x55, 
+0x1F, 0xE1, 0xFC, 0x39, 0xA6, 0xDE, 0x97, 0x79, 
+0xFF, 0x20, 0x8B, 0x32, 0xFF, 0x84, 0x42, 0xBB, 
+0xB0, 0xF5, 0xD7, 0xDA, 0x8C, 0x3C, 0xE5, 0x5D, 
+0x7C, 0x99, 0x70, 0xE2, 0xF2, 0xA8, 0xD0, 0x47, 
+0xF1, 0x85, 0xED, 0xF2, 0x6C, 0x1D, 0xF4, 0x89, 
+0xA8, 0xCF, 0xD6, 0xF4, 0x21, 0x5A, 0x8E, 0x65, 
+0x55, 0x49, 0x6E, 0x66, 0xC1, 0xAE, 0x81, 0xE2, 
+0xCB, 0xAF, 0xE5, 0x7C, 0x5B, 0x10, 0xFF, 0x8A, 
+0x71, 0x88, 
+
+0x8D, 0xA9, 0x54, 0x7F, 0xBF, 0xB6, 0xC2, 0x10, 
+0x29, 0xF7, 0x34, 0xA4, 0x1B, 0x7F, 0xEF, 0xDB, 
+0x36, 0xD0, 0x83, 0x73, 0x7C, 0x3E, 0xA8, 0x62, 
+0x75, 0x31, 0x09, 0xAC, 0x11, 0x1B, 0x70, 0x8A, 
+0x81, 0x99, 0x30, 0xF4, 0x92, 0x6F, 0xF7, 0xDC, 
+0xB2, 0x52, 0x6E, 0xD5, 0xBA, 0x31, 0xD1, 0x83, 
+0x7E, 0x19, 0x75, 0x4A, 0xA1, 0xCE, 0x65, 0x04, 
+0x61, 0xAB, 0x8B, 0x21, 0x4F, 0x48, 0x4C, 0xD6, 
+0x08, 0x87, 0x2A, 0xBF, 0x68, 0x95, 0x53, 0x96, 
+0xC1, 0x63, 0x81, 0x85, 0x08, 0xA7, 0xC1, 0x27, 
+0x0C, 0x1F, 0xAA, 0xAF, 0xF3, 0x66, 0x57, 0xA4, 
+0xA2, 0xB5, 0x57, 0x63, 0xAC, 0xDC, 0xAE, 0x97, 
+0xA0, 0x2F, 0x63, 0x3B, 0xB0, 0xC8, 0xD5, 0xFA, 
+0x38, 0xF3, 0x56, 0x07, 0x6F, 0x68, 0x05, 0xFC, 
+0x31, 0x58, 0xF7, 0xFA, 0x73, 0xF0, 0x8F, 0x03, 
+0xB3, 0xC6, 0xF9, 0x5C, 0xC3, 0x2D, 0xE0, 0x9E, 
+0xBB, 0xEF, 0xE5, 
+
+0x20, 0xE6, 0x7C, 0x30, 0x83, 0x82, 0x34, 0xA4, 
+0x1F, 0x15, 0x45, 0x2C, 0x5F, 0xAF, 0x3B, 0xEC, 
+0x63, 0x12, 0xC9, 0x7E, 0x16, 0xF1, 0xB9, 0xCD, 
+0xCA, 0x99, 0x04, 0x65, 0xA6, 0xF9, 0x46, 0xF8, 
+0x15, 0x01, 0xAE, 0x42, 0x57, 0xB1, 0x91, 0xDC, 
+0x83, 0xD7, 0x19, 0xA8, 0x4D, 0x9C, 0x34, 0x55, 
+0x4C, 0x52, 0xE7, 0x09, 0xA6, 0xE6, 0xEB, 0x04, 
+0xCC, 0x63, 0xCB, 0xC6, 0x0C, 0x57, 0xCC, 0xFD, 
+0x9F, 0xD8, 0x4B, 0x31, 0xB8, 0xF8, 0xFD, 0xB5, 
+0xCA, 0x2C, 0xC1, 0x60, 0xCC, 0xC1, 0x52, 0x64, 
+0x16, 0xA4, 0x57, 0x6A, 0x01, 0xCB, 0xAE, 0x74, 
+0x3A, 0x85, 0x1A, 0xD7, 0x6E, 0xA6, 0xBA, 0x42, 
+0x3C, 0x6C, 0xA4, 0x31, 0x20, 0x36, 0x7C, 0x3A, 
+0x0A, 0xA2, 0x4B, 0xC8, 0x61, 0x19, 0xE5, 0x68, 
+0x1D, 0x2F, 0x62, 0xD4, 0x70, 0x35, 0xAE, 0x27, 
+0xDD, 0xA0, 0x5D, 0x12, 0x81, 0x67, 0x2B, 0xA8, 
+0x23, 0xAA, 0xF5, 0x4C, 
+
+0x21, 0x98, 0x98, 0x99, 0x78, 0x7E, 0x47, 0x42, 
+0x0C, 0x3A, 0xAB, 0x1F, 0x07, 0x50, 0x44, 0xB3, 
+0x81, 0xAF, 0x58, 0xDB, 0xFF, 0xC5, 0x1B, 0x2B, 
+0xA2, 0x00, 0x35, 0xC3, 0x14, 0x30, 0x7E, 0x31, 
+
+0x3D, 0x2A, 0x14, 0x61, 0x7C, 0xDD, 0xC9, 0x5A, 
+0xA3, 0x52, 0xD4, 0x71, 0x1E, 0x00, 0x0A, 0x50, 
+0x4B, 0x52, 0xF4, 0x78, 0xE9, 0x61, 0x8A, 0xCF, 
+0xE0, 0x25, 0xAA, 0x84, 0xF9, 0xF8, 0xC1, 0xA7, 
+0x0B, 
+
+0x88, 0xF8, 0x51, 0x81, 0xED, 0x4E, 0x01, 0x22, 
+0x12, 0xD6, 0x98, 0xC6, 0x2E, 0xBE, 0x81, 0x6B, 
+0x25, 0xD1, 0xEA, 0x78, 0x22, 0x29, 0x86, 0x21, 
+0x5B, 0xD5, 0x3B, 0xBF, 0xD0, 0x56, 0x7A, 0x40, 
+0xEC, 0x17, 
+
+0x06, 0xF7, 0x42, 0xBF, 0xE2, 0x4C, 0x1E, 0xA6, 
+0x4B, 0xF6, 0xC0, 0x41, 0xFA, 0x77, 0x41, 0xBB, 
+0x9B, 0x01, 0x2F, 0x1A, 0x28, 0x00, 0xEC, 0xEE, 
+0x13, 0xCF, 0x50, 0xA8, 0xB8, 0x6C, 0xC8, 0x1F, 
+0x16, 0x7A, 0xF8, 
+
+0x4B, 0xAD, 0x00, 0xAC, 0x8F, 0x7A, 0x5B, 0xF7, 
+0xA6, 0x05, 0x63, 0x7E, 0x7D, 0x74, 0x9B, 0x3A, 
+0x6D, 0x66, 0xBF, 0xB0, 0x56, 0x58, 0x68, 0x27, 
+0x88, 0x87, 0x75, 0xDE, 0x46, 0x7C, 0x51, 0x71, 
+0x15, 0x33, 0x1A, 0x4B, 
+
+0x94, 0xB1, 0xB3, 0xCB, 0x7B, 0x09, 0x68, 0x20, 
+0x26, 0x62, 0x03, 0x18, 0xD8, 0x1A, 0x36, 0xCA, 
+0x59, 0xD6, 0x7F, 0xE7, 0x99, 0xFC, 0xAB, 0x73, 
+0xD0, 0xF9, 0x2C, 0x4D, 0x01, 0x5D, 0xB1, 0xE9, 
+0x33, 0xC4, 0x42, 0x79, 0x6C, 
+
+0x3E, 0x2B, 0xCD, 0x86, 0x8B, 0x2E, 0xE1, 0x76, 
+0x89, 0x80, 0xA1, 0x5E, 0xBA, 0x06, 0x29, 0xF4, 
+0xB1, 0xD9, 0xD2, 0x61, 0xB5, 0x04, 0x0E, 0x10, 
+0xFC, 0x65, 0xA8, 0x00, 0xF3, 0xED, 0xB1, 0x07, 
+0xA2, 0x97, 0xED, 0x30, 0x91, 0x95, 
+
+0x8C, 0xEE, 0xC2, 0xA8, 0x50, 0x59, 0x59, 0x15, 
+0xFD, 0x7B, 0xFB, 0x14, 0x77, 0xBE, 0x62, 0xCC, 
+0xE2, 0xD3, 0x12, 0x09, 0xDE, 0x21, 0xA8, 0xFC, 
+0xF7, 0x7A, 0x62, 0x2C, 0x27, 0x34, 0x07, 0xA1, 
+0x08, 0x35, 0xC1, 0x4C, 0x20, 0xC8, 0x11, 
+
+0x0C, 0x4F, 0x91, 0x88, 0x50, 0xD8, 0xA3, 0xAD, 
+0x07, 0x17, 0x48, 0x60, 0x0C, 0xD2, 0x00, 0xAC, 
+0xCC, 0xF7, 0x54, 0x28, 0xBC, 0xD2, 0x88, 0xEE, 
+0x0A, 0x50, 0x47, 0x5F, 0x99, 0x03, 0x6D, 0x5D, 
+0x50, 0xD9, 0xE1, 0x2F, 0x7B, 0x19, 0x95, 0x42, 
+
+0x52, 0x4D, 0xBC, 0x3A, 0xA7, 0x5E, 0x2C, 0xE3, 
+0xA7, 0xDD, 0x9F, 0x09, 0xD6, 0x51, 0xDC, 0x00, 
+0xE8, 0x8A, 0x92, 0xEC, 0x65, 0x95, 0x2E, 0x5D, 
+0xC7, 0x53, 0x73, 0x0E, 0x70, 0x01, 0x50, 0x46, 
+0x89, 0xB8, 0xE6, 0xFA, 0xE1, 0x63, 0xC7, 0x8B, 
+0x61, 
+
+0x6F, 0x32, 0x87, 0x55, 0xB2, 0xCF, 0xF0, 0xBE, 
+0x33, 0xC5, 0xB8, 0x59, 0x3C, 0x67, 0xA1, 0x11, 
+0xDF, 0x84, 0x79, 0x55, 0xDC, 0x52, 0xE4, 0xC0, 
+0x6B, 0x64, 0xEE, 0x42, 0x47, 0x88, 0x07, 0xBE, 
+0x7D, 0x46, 0xDE, 0x85, 0xB0, 0x7B, 0x37, 0x48, 
+0xC8, 0xFA, 
+
+0x46, 0xE9, 0x7C, 0xAA, 0xD8, 0x7C, 0x19, 0x28, 
+0xF9, 0x5C, 0xE2, 0xF5, 0x51, 0x39, 0x14, 0xEE, 
+0x74, 0x33, 0xD8, 0x34, 0xB6, 0x05, 0x06, 0x42, 
+0xA1, 0xC3, 0x83, 0xD9, 0x93, 0x43, 0x22, 0x10, 
+0xBF, 0x6C, 0xDE, 0x35, 0xFF, 0x37, 0x15, 0x73, 
+0x7C, 0x22, 0xC1, 
+
+0xC8, 0x2C, 0x78, 0x3B, 0x2A, 0x2F, 0x34, 0x42, 
+0x4E, 0x77, 0x85, 0x1F, 0xB6, 0x0B, 0x0B, 0xDA, 
+0xE6, 0xDC, 0x19, 0x15, 0xF2, 0x96, 0x38, 0x7C, 
+0x4E, 0x32, 0x89, 0x6D, 0xEE, 0x36, 0x4A, 0x0C, 
+0x54, 0xA7, 0x3A, 0x95, 0xCE, 0x6E, 0x1D, 0xA0, 
+0xB8, 0x2E, 0x54, 0x52, 
+
+0xB0, 0x5B, 0xC5, 0x7F, 0x06, 0xF8, 0xFC, 0x82, 
+0x8F, 0x68, 0xC3, 0xAD, 0xB3, 0xA7, 0xBD, 0x75, 
+0xEF, 0x74, 0x4E, 0xDC, 0x0E, 0x38, 0x1A, 0x67, 
+0x18, 0x67, 0x73, 0x67, 0x4A, 0x68, 0xC2, 0xAB, 
+0x33, 0x82, 0x4C, 0x8F, 0xE7, 0xB6, 0x0C, 0x5E, 
+0xCC, 0x84, 0x73, 0x05, 0x12, 
+
+0xB7, 0x1F, 0x1D, 0xCD, 0x68, 0x41, 0x99, 0x17, 
+0x85, 0xAA, 0x47, 0x02, 0x5D, 0x37, 0xF2, 0x97, 
+0x3A, 0x60, 0x78, 0x9C, 0x52, 0x02, 0x3B, 0x36, 
+0xFE, 0xCC, 0x61, 0x87, 0x37, 0x8C, 0x7C, 0x4B, 
+0x34, 0x31, 0xB0, 0x94, 0x27, 0xBC, 0xA1, 0xD4, 
+0x21, 0xE8, 0xBF, 0x19, 0x7F, 0x6C, 
+
+0xEB, 0x62, 0xBD, 0xEB, 0xBF, 0x64, 0xA1, 0xCE, 
+0x44, 0x90, 0xF4, 0x8A, 0x99, 0x3E, 0x27, 0xBF, 
+0xC4, 0xE6, 0x45, 0x0B, 0x71, 0xEF, 0xBE, 0x1E, 
+0x6B, 0xB3, 0xFF, 0xBB, 0x8E, 0xA2, 0x0A, 0x59, 
+0x75, 0x14, 0x18, 0x7B, 0x5A, 0x6E, 0x18, 0xD0, 
+0x5E, 0x19, 0xFA, 0xFD, 0xD1, 0xF5, 0x9A, 
+
+0x90, 0x2D, 0x76, 0x65, 0x81, 0x31, 0xEA, 0x2E, 
+0xF1, 0x55, 0xED, 0x9B, 0xB8, 0xC7, 0x5E, 0x87, 
+0x62, 0x11, 0xBC, 0x3B, 0xE2, 0x0E, 0x41, 0x56, 
+0xFC, 0xEE, 0x52, 0x62, 0xDE, 0xE6, 0x7B, 0xB8, 
+0xEE, 0xD6, 0xF1, 0x2E, 0x5C, 0xEE, 0x80, 0x31, 
+0x38, 0xAA, 0x38, 0x5D, 0xC1, 0xB4, 0xD4, 0x04, 
+
+0xFE, 0x6B, 0x1F, 0x77, 0x4E, 0x7B, 0x71, 0x34, 
+0x8E, 0x3B, 0x5A, 0xBE, 0x1D, 0x14, 0x88, 0xF7, 
+0xB3, 0x66, 0x5D, 0x33, 0xC2, 0xD1, 0xE9, 0x51, 
+0x8E, 0xC6, 0x23, 0xD2, 0xEF, 0x59, 0x1A, 0x13, 
+0xA1, 0xA9, 0xE5, 0x09, 0xF6, 0x07, 0x75, 0x34, 
+0x03, 0x4F, 0x90, 0x91, 0xC0, 0x69, 0xE5, 0x3B, 
+0xDE, 
+
+0xD6, 0x80, 0x97, 0x69, 0xEF, 0x00, 0x1A, 0x83, 
+0xCA, 0xDB, 0x59, 0x1B, 0x5A, 0xC9, 0x7E, 0x42, 
+0x3C, 0xD5, 0x24, 0x71, 0xEE, 0x35, 0x35, 0xAF, 
+0xD2, 0x1C, 0x97, 0xC8, 0x38, 0x1D, 0x7C, 0x90, 
+0xB5, 0xA0, 0x4B, 0x6D, 0x85, 0x34, 0xFD, 0x58, 
+0xCF, 0x03, 0x7E, 0xAB, 0xA5, 0xF3, 0x11, 0xD6, 
+0xE1, 0x5D, 
+
+0x5E, 0x02, 0xEA, 0x3C, 0x14, 0x99, 0x54, 0x6F, 
+0x10, 0x11, 0x70, 0x05, 0x70, 0x5C, 0x5C, 0xD7, 
+0xD6, 0x1B, 0xCA, 0x94, 0x2C, 0xED, 0xB8, 0xD1, 
+0x41, 0xCC, 0x5C, 0xE9, 0xD3, 0xD2, 0x84, 0x0C, 
+0x8B, 0xB0, 0x21, 0xCF, 0x4E, 0xE5, 0x74, 0xB8, 
+0xDD, 0x82, 0xF3, 0xAE, 0x0C, 0xFC, 0x3F, 0xC1, 
+0x5A, 0x08, 0x88, 
+
+0x67, 0x70, 0xB2, 0x88, 0x41, 0xC4, 0x8B, 0x36, 
+0xFA, 0x5E, 0x79, 0x02, 0x9D, 0x98, 0x00, 0x66, 
+0x1D, 0x8D, 0x54, 0x74, 0x4D, 0x78, 0x0A, 0x64, 
+0xB0, 0x46, 0xD1, 0xBA, 0xE9, 0x98, 0xAB, 0x93, 
+0x31, 0x70, 0x63, 0xBE, 0x8F, 0xF5, 0xCE, 0x84, 
+0x30, 0x1B, 0x69, 0xC1, 0x06, 0x65, 0x5D, 0xF2, 
+0xD6, 0xA8, 0x85, 0x12, 
+
+0xBA, 0x59, 0x2F, 0x5B, 0xD9, 0x94, 0x89, 0x97, 
+0x47, 0x3C, 0x2F, 0xB1, 0x1B, 0xC8, 0x0E, 0x76, 
+0xF0, 0xE7, 0x1F, 0xA0, 0x98, 0xAC, 0x5A, 0x4E, 
+0x30, 0x3E, 0xD7, 0x7E, 0x8E, 0x89, 0x21, 0x68, 
+0xBB, 0x1E, 0xEB, 0x35, 0xBA, 0xED, 0x6C, 0xAF, 
+0x31, 0x9C, 0xD3, 0xA5, 0x85, 0x5C, 0x52, 0x5E, 
+0x8B, 0x57, 0x78, 0xBE, 0x8E, 
+
+0x1A, 0x09, 0xE5, 0x28, 0xDB, 0xFA, 0x7A, 0x05, 
+0x08, 0xC3, 0x34, 0xB3, 0x44, 0xCB, 0x74, 0x8D, 
+0x86, 0xFF, 0x68, 0x4A, 0xDD, 0x8C, 0x26, 0xFB, 
+0x08, 0x35, 0x3B, 0x30, 0xAC, 0x82, 0x32, 0xB5, 
+0x3B, 0xAF, 0xE3, 0xEF, 0x9D, 0x2F, 0x05, 0x2D, 
+0x89, 0x8E, 0xBB, 0x32, 0x5B, 0x7B, 0x78, 0x41, 
+0xE0, 0x45, 0xF9, 0x98, 0x84, 0x49, 
+
+0x76, 0x01, 0x63, 0xA9, 0xFB, 0xDB, 0xD9, 0x5E, 
+0x9F, 0x9B, 0x1E, 0xC6, 0x8E, 0x78, 0xDB, 0x44, 
+0xF4, 0x61, 0xE5, 0x0C, 0x1E, 0x6C, 0xD4, 0xA8, 
+0x02, 0x36, 0xCE, 0x4D, 0xBE, 0xC2, 0x6E, 0xE4, 
+0xC1, 0x26, 0xC9, 0x94, 0xAF, 0x2B, 0x03, 0x43, 
+0xB2, 0x79, 0x07, 0x4B, 0x83, 0xA5, 0x85, 0xA1, 
+0xD3, 0xF2, 0xE8, 0xB7, 0x69, 0xA9, 0xD0, 
+
+0xFE, 0x25, 0xE1, 0x5B, 0xBB, 0xBD, 0x35, 0x9F, 
+0xD6, 0xA0, 0x77, 0x26, 0x28, 0x73, 0xA5, 0x44, 
+0x95, 0x6C, 0x23, 0xB9, 0x51, 0xBA, 0x45, 0x7A, 
+0x88, 0xBF, 0x15, 0x55, 0xBD, 0xA3, 0x29, 0x71, 
+0x51, 0xB6, 0xD5, 0x53, 0x66, 0xF3, 0xBB, 0xAC, 
+0x3A, 0xBE, 0xFB, 0xEC, 0x2F, 0xE0, 0xFF, 0x71, 
+0x00, 0x33, 0xA3, 0xD1, 0x33, 0xB2, 0x6B, 0x00, 
+
+0xE3, 0x4D, 0x9E, 0x76, 0x52, 0xFB, 0xAD, 0xBD, 
+0xC9, 0x68, 0x80, 0x14, 0x72, 0xD7, 0x20, 0xF0, 
+0x22, 0xD0, 0x6E, 0x95, 0x15, 0x1D, 0x19, 0x9B, 
+0xE4, 0x86, 0xF0, 0x24, 0x05, 0x46, 0x92, 0x88, 
+0x56, 0x0F, 0x60, 0x8C, 0xBD, 0x2D, 0x3D, 0x6D, 
+0x72, 0x27, 0x3F, 0xB3, 0x6E, 0x32, 0x4A, 0x96, 
+0xAC, 0x7C, 0x16, 0x8A, 0x99, 0x00, 0xF6, 0x06, 
+0x27, 
+
+0x4A, 0x52, 0x37, 0xDC, 0x4B, 0x83, 0x60, 0xFF, 
+0x29, 0x04, 0xA5, 0xD2, 0x34, 0xBC, 0x42, 0xC6, 
+0xDB, 0x39, 0x18, 0xE8, 0x3D, 0x15, 0x88, 0x0E, 
+0x7C, 0x88, 0x86, 0xFE, 0xA8, 0xFA, 0xA9, 0xC6, 
+0x9C, 0x1F, 0x5A, 0x80, 0x84, 0xE1, 0xE8, 0xE6, 
+0xE3, 0x29, 0x58, 0x76, 0x97, 0x50, 0x8E, 0x48, 
+0x28, 0xF8, 0x7D, 0x92, 0x6E, 0x8C, 0xDE, 0x4E, 
+0xE2, 0xF2, 
+
+0xB5, 0xEB, 0x44, 0x98, 0x9A, 0x21, 0x63, 0x60, 
+0x47, 0x81, 0x6D, 0x74, 0x25, 0xE7, 0x7D, 0x13, 
+0x9A, 0x06, 0x17, 0x0E, 0xCA, 0x11, 0xA6, 0x99, 
+0x52, 0x01, 0xCE, 0xEA, 0xD9, 0x9C, 0x6D, 0xA8, 
+0x58, 0x84, 0x10, 0xB0, 0x51, 0xED, 0x45, 0x80, 
+0x2D, 0x8D, 0x3F, 0x4F, 0xD1, 0xB6, 0xE9, 0x26, 
+0x26, 0xA2, 0x28, 0x4D, 0x4B, 0x9B, 0x37, 0xAD, 
+0xF6, 0x24, 0x61, 
+
+0x87, 0x13, 0xD2, 0x36, 0x32, 0x7F, 0x8F, 0x49, 
+0x0C, 0x49, 0x15, 0xD7, 0x8F, 0x01, 0x6B, 0x31, 
+0x49, 0x08, 0x80, 0xBC, 0x14, 0x15, 0x99, 0x1A, 
+0x1F, 0x8C, 0x06, 0x22, 0x91, 0xF7, 0x97, 0x11, 
+0xE3, 0x7A, 0xBB, 0x33, 0xE7, 0x7D, 0x79, 0x46, 
+0x02, 0x25, 0x53, 0x1A, 0x93, 0x67, 0x22, 0xC0, 
+0xBE, 0x7B, 0x71, 0x3F, 0x71, 0xF6, 0x19, 0xFB, 
+0x39, 0xBD, 0xBE, 0xDD, 
+
+0x61, 0x25, 0xD1, 0xE5, 0x89, 0xDD, 0x70, 0xC0, 
+0xD1, 0xE4, 0xEB, 0x15, 0xFF, 0x20, 0x8B, 0xA8, 
+0x2F, 0xD8, 0x85, 0x53, 0x39, 0x7A, 0xDA, 0x20, 
+0x5A, 0x29, 0x17, 0xFA, 0x5A, 0xE2, 0xBC, 0x59, 
+0x63, 0x84, 0xCF, 0x44, 0xEA, 0xF7, 0x30, 0x37, 
+0x4B, 0x31, 0xC7, 0xB0, 0x68, 0x80, 0x39, 0x75, 
+0xBE, 0x49, 0x99, 0xFA, 0x8D, 0x51, 0x14, 0xE4, 
+0x04, 0x8E, 0x04, 0x1D, 0xE8, 
+
+0xF0, 0x4E, 0xC9, 0xD5, 0x02, 0xC3, 0x86, 0xBF, 
+0x4D, 0x98, 0x4F, 0x0B, 0xCE, 0x3D, 0xFC, 0x18, 
+0x8B, 0xB5, 0x52, 0x76, 0xBC, 0x85, 0x5C, 0x83, 
+0xD4, 0x88, 0xBA, 0x16, 0xA1, 0x72, 0x11, 0x81, 
+0x3C, 0x5F, 0xA2, 0x28, 0x31, 0x3E, 0x9D, 0xF0, 
+0xDF, 0x19, 0x21, 0xB1, 0xC0, 0xE9, 0x85, 0xAB, 
+0xD3, 0xC0, 0xAE, 0x24, 0xBA, 0x0F, 0x31, 0xD5, 
+0x8A, 0x86, 0x35, 0x24, 0x6B, 0x5C, 
+
+0xC1, 0xE0, 0x92, 0xC5, 0xD9, 0x31, 0x46, 0x05, 
+0x34, 0xC5, 0x22, 0x1C, 0x5F, 0x9D, 0x12, 0x00, 
+0x49, 0x80, 0x8E, 0xBA, 0x48, 0x4A, 0xFD, 0x06, 
+0x4E, 0xD0, 0xEB, 0xC5, 0x54, 0xD4, 0x74, 0x35, 
+0xE1, 0xFD, 0x56, 0xCE, 0x70, 0x45, 0x7D, 0x92, 
+0xDF, 0xCF, 0x1C, 0xA0, 0x15, 0x58, 0xAD, 0xF8, 
+0xE5, 0x57, 0x1C, 0x82, 0xD8, 0x6E, 0x78, 0xBF, 
+0xF6, 0xA0, 0x8B, 0x6B, 0x59, 0xFB, 0x87, 
+
+0x85, 0x5E, 0xB9, 0x55, 0xFA, 0xCC, 0xAF, 0xC8, 
+0xB3, 0x75, 0xC6, 0x63, 0x9D, 0xEA, 0x0F, 0xC6, 
+0xF5, 0xBF, 0x0E, 0xA3, 0xB7, 0x8E, 0xE5, 0x7F, 
+0x65, 0xA6, 0x91, 0x22, 0x1B, 0x35, 0xD8, 0xE4, 
+0x82, 0x79, 0xE8, 0xF3, 0x34, 0x5F, 0xFE, 0x31, 
+0x8D, 0x7F, 0xD1, 0x4C, 0x15, 0xAD, 0xEA, 0x0C, 
+0x27, 0xB1, 0xBD, 0x18, 0x91, 0x3C, 0x40, 0xCC, 
+0x6B, 0xDC, 0xBE, 0x46, 0x33, 0xB4, 0x06, 0x73, 
+
+0xA4, 0xDF, 0xDE, 0xA3, 0x07, 0x88, 0x14, 0x98, 
+0xC0, 0xEE, 0xED, 0xD3, 0xEB, 0xFE, 0x27, 0xB5, 
+0xD3, 0x60, 0x14, 0x33, 0x32, 0xF6, 0x90, 0x97, 
+0xF2, 0x89, 0xE0, 0xD4, 0x11, 0xD5, 0x19, 0x57, 
+0x19, 0x34, 0xC3, 0x7D, 0xA3, 0xF2, 0x34, 0x5F, 
+0x28, 0x29, 0xCB, 0x89, 0x11, 0x28, 0x3E, 0x86, 
+0x68, 0x46, 0x18, 0xCB, 0x6E, 0xD4, 0x0F, 0x33, 
+0x54, 0x9B, 0x51, 0x1B, 0x01, 0x7F, 0x65, 0xDC, 
+0x31, 
+
+0x36, 0x2E, 0x57, 0x62, 0x22, 0x2E, 0xA2, 0xA0, 
+0x88, 0xEC, 0xC3, 0x9E, 0x96, 0x4C, 0xAA, 0x43, 
+0x4D, 0xF1, 0x0A, 0x1E, 0x1B, 0xCE, 0x5C, 0x51, 
+0xF5, 0xF5, 0x16, 0x1D, 0x77, 0x82, 0xE2, 0xDD, 
+0x71, 0x20, 0x35, 0x5F, 0x35, 0xD4, 0x10, 0x7C, 
+0xDB, 0x03, 0x0D, 0x79, 0x4D, 0xB3, 0xFF, 0x3C, 
+0x26, 0x1A, 0x98, 0x8F, 0xEC, 0x6A, 0xA1, 0xAE, 
+0xF8, 0x16, 0x73, 0x8E, 0x57, 0x12, 0x0F, 0x68, 
+0xC1, 0x6F, 
+
+0xD9, 0xD0, 0x9C, 0x36, 0xA0, 0x0A, 0xFA, 0xAB, 
+0x82, 0xB1, 0x7C, 0xD6, 0x21, 0xF3, 0x0A, 0xC5, 
+0x4B, 0x33, 0xA3, 0xEB, 0xD9, 0xC5, 0xDA, 0x49, 
+0xF8, 0xF1, 0x03, 0x4F, 0xE3, 0xCD, 0x88, 0x1A, 
+0xFF, 0x76, 0xD9, 0x55, 0x8C, 0x65, 0x5B, 0xDF, 
+0x4F, 0x38, 0x9F, 0x14, 0xAD, 0xE8, 0xE5, 0x96, 
+0x56, 0x87, 0xE9, 0xB6, 0x2E, 0xF2, 0x55, 0x90, 
+0xDA, 0xCC, 0x29, 0x4D, 0xC2, 0xA5, 0xCA, 0xBD, 
+0xA2, 0xEA, 0x10, 
+
+0x60, 0x98, 0x3E, 0x4F, 0xF9, 0x28, 0xBB, 0x55, 
+0xFD, 0x8F, 0xD1, 0xD6, 0x3F, 0xD7, 0x2A, 0x48, 
+0x77, 0x31, 0x59, 0xD6, 0xA8, 0x76, 0x54, 0x48, 
+0xE8, 0xB4, 0x48, 0x4E, 0x01, 0x92, 0xC8, 0xB5, 
+0x33, 0x3A, 0x1E, 0x4E, 0x17, 0x47, 0xE8, 0xF4, 
+0x7C, 0x95, 0xBF, 0x24, 0xA0, 0x60, 0xC1, 0xD7, 
+0x8C, 0x45, 0x13, 0x32, 0x3A, 0x37, 0x40, 0x27, 
+0x2A, 0xB0, 0xA4, 0x0A, 0xC2, 0xB6, 0xED, 0x6C, 
+0x5E, 0xBF, 0x8A, 0x4E, 
+
+0xED, 0x17, 0xCD, 0x7E, 0x1C, 0xB3, 0x1B, 0xE1, 
+0x21, 0x0D, 0x3C, 0xA6, 0x99, 0x00, 0xE6, 0x1E, 
+0x77, 0xC6, 0xE1, 0x5E, 0x2B, 0x16, 0xA5, 0x64, 
+0xC0, 0xEE, 0x64, 0x7C, 0xC2, 0x24, 0x16, 0x03, 
+0x10, 0x95, 0x1C, 0x21, 0x82, 0x59, 0xC8, 0x26, 
+0xC0, 0x9F, 0xCE, 0x6A, 0xF5, 0xF3, 0x3A, 0x00, 
+0xED, 0x64, 0x19, 0x09, 0x7F, 0xE3, 0x1F, 0x81, 
+0xA0, 0x44, 0x8A, 0xA9, 0x7A, 0x33, 0x58, 0xD7, 
+0x5D, 0x38, 0x22, 0x2C, 0x4E, 
+
+0xF5, 0x8C, 0x9B, 0x44, 0x49, 0x96, 0xBA, 0x9D, 
+0x97, 0x45, 0x94, 0xFF, 0x3B, 0xA6, 0x77, 0x8F, 
+0xB5, 0x0D, 0xAF, 0x8C, 0xD2, 0x97, 0x4E, 0xAA, 
+0x2F, 0xD0, 0x2B, 0xE9, 0xBA, 0x0E, 0xAE, 0xDB, 
+0xFE, 0x83, 0x37, 0xA9, 0xEA, 0x82, 0xD9, 0x9C, 
+0x77, 0x79, 0xBB, 0x2E, 0xD0, 0x18, 0xF6, 0x93, 
+0x9B, 0xEC, 0xBB, 0xCE, 0x7E, 0x60, 0x35, 0x2B, 
+0x75, 0xFC, 0x70, 0x57, 0xE4, 0x28, 0xA9, 0x7A, 
+0x15, 0xF8, 0x96, 0xFE, 0x2A, 0xAD, 
+
+0x01, 0x41, 0xBD, 0x80, 0xE8, 0x7A, 0x5B, 0x4D, 
+0xCB, 0x33, 0xE1, 0x26, 0x0C, 0x44, 0x02, 0x92, 
+0x56, 0x81, 0x95, 0x35, 0x32, 0xE6, 0x87, 0xDD, 
+0x18, 0x48, 0x17, 0x68, 0xBC, 0xD9, 0x79, 0x88, 
+0xE4, 0xD9, 0xF8, 0xF1, 0xE5, 0x74, 0x2E, 0x30, 
+0x2D, 0x83, 0x4A, 0x61, 0xFF, 0xE2, 0x33, 0xE7, 
+0x80, 0x71, 0xA3, 0xD8, 0x5E, 0x09, 0x9A, 0x05, 
+0xF0, 0x91, 0x4E, 0xBE, 0xE0, 0x80, 0xF8, 0x3E, 
+0xB1, 0x98, 0xB1, 0x14, 0x61, 0x7C, 0x72, 
+
+0xA0, 0x36, 0x27, 0x9A, 0xE3, 0x90, 0x3F, 0x1D, 
+0xAE, 0xD1, 0x4E, 0xB4, 0x5B, 0x6B, 0x2E, 0x02, 
+0x6B, 0x27, 0x22, 0x14, 0x8D, 0x16, 0xFC, 0xA6, 
+0xDC, 0xFA, 0xB9, 0xDD, 0xD5, 0xAA, 0x13, 0x8E, 
+0xD4, 0x23, 0xB4, 0x30, 0x4B, 0x55, 0x0E, 0x03, 
+0x93, 0xB9, 0x17, 0x3B, 0x17, 0xED, 0x1D, 0x0D, 
+0xBC, 0x83, 0x0F, 0xFD, 0x0A, 0x85, 0xF7, 0x77, 
+0x59, 0x82, 0x70, 0x01, 0x48, 0x66, 0x35, 0x93, 
+0x35, 0x8A, 0x47, 0xC7, 0x0C, 0xAE, 0xB0, 0x25, 
+
+0x8F, 0xEC, 0x57, 0xDF, 0xC7, 0x2D, 0x22, 0x69, 
+0xE4, 0x1F, 0xA0, 0xD6, 0xBD, 0xCF, 0x02, 0x3F, 
+0x31, 0xF2, 0x0E, 0x9E, 0xD5, 0x34, 0x1A, 0xA2, 
+0x9D, 0xD9, 0x4D, 0xD6, 0x45, 0xB6, 0xF2, 0xD9, 
+0x17, 0xAA, 0x26, 0x75, 0x30, 0x06, 0x70, 0x16, 
+0xDC, 0x25, 0x4D, 0x75, 0xC5, 0x13, 0x1D, 0xF5, 
+0x1A, 0x58, 0xA9, 0x3D, 0xD8, 0x90, 0x63, 0xA7, 
+0x30, 0x66, 0xFC, 0x0D, 0xB3, 0x13, 0xDA, 0x7C, 
+0x95, 0x7A, 0x47, 0x60, 0x76, 0xAE, 0xE7, 0x8E, 
+0x73, 
+
+0x24, 0x65, 0x07, 0x27, 0xB0, 0x37, 0x3D, 0x3C, 
+0x11, 0xDB, 0x7C, 0x47, 0x78, 0x00, 0x1E, 0xEE, 
+0x4E, 0xA7, 0x8A, 0x12, 0x57, 0xD9, 0x16, 0x77, 
+0xA0, 0xCA, 0x4F, 0xD3, 0x5D, 0xC8, 0xDE, 0x95, 
+0xC5, 0x5A, 0xFD, 0xFF, 0x3F, 0xF1, 0xC0, 0xDA, 
+0x09, 0x42, 0x6A, 0x74, 0xD8, 0x83, 0x35, 0xF4, 
+0x3F, 0xC0, 0x99, 0xDC, 0xBD, 0x51, 0xC5, 0x0D, 
+0xC4, 0x2B, 0x32, 0x0B, 0xE4, 0xD4, 0xC7, 0xB8, 
+0x44, 0xD6, 0xEF, 0xFE, 0xEE, 0x35, 0xDB, 0x30, 
+0x1B, 0xB4, 
+
+0x44, 0xAF, 0x9C, 0x50, 0xA7, 0xEA, 0x78, 0x5B, 
+0x6F, 0x35, 0xD4, 0xC1, 0x4E, 0xD7, 0x25, 0xF7, 
+0x82, 0xC1, 0x7F, 0x11, 0xC8, 0xEF, 0x60, 0xC6, 
+0x60, 0x37, 0x24, 0x67, 0x5E, 0xE3, 0xC9, 0x87, 
+0x0C, 0x2D, 0x18, 0xB7, 0x25, 0x61, 0xE5, 0x3E, 
+0xB8, 0xFC, 0xF3, 0x22, 0x22, 0x99, 0x0F, 0x5D, 
+0xE1, 0x89, 0x59, 0x8C, 0xED, 0x4D, 0x5C, 0x05, 
+0x09, 0xE2, 0x09, 0x5D, 0xD4, 0x8A, 0xE1, 0x42, 
+0xA0, 0x12, 0x7B, 0x2E, 0x33, 0x28, 0x8E, 0x24, 
+0xEE, 0x44, 0xF6, 
+
+0x14, 0x36, 0x67, 0xAA, 0xA5, 0x96, 0x16, 0x53, 
+0xE8, 0x70, 0x94, 0xFB, 0x54, 0x0F, 0x6F, 0x44, 
+0xAF, 0x4D, 0x57, 0x64, 0x82, 0x9A, 0xDD, 0x60, 
+0xC3, 0x94, 0x8A, 0xD7, 0x0C, 0xEA, 0xC4, 0xFA, 
+0x43, 0x93, 0x95, 0xA0, 0x5C, 0x2D, 0x98, 0x89, 
+0x66, 0xA3, 0x57, 0x4D, 0xF1, 0x9B, 0x1F, 0x7D, 
+0x6A, 0xC9, 0x18, 0x24, 0xCF, 0x4B, 0x32, 0x18, 
+0x31, 0xAC, 0x19, 0x02, 0x92, 0xD9, 0x44, 0x13, 
+0x4F, 0xBC, 0x5B, 0xDA, 0x08, 0x38, 0x6E, 0x61, 
+0x13, 0x26, 0x52, 0x12, 
+
+0x1E, 0x72, 0x01, 0x81, 0x4F, 0x98, 0xEC, 0xB2, 
+0xE1, 0x12, 0xD8, 0x51, 0xB2, 0x10, 0xBD, 0xE9, 
+0x0A, 0x2C, 0x80, 0x02, 0x63, 0xDD, 0x0C, 0x64, 
+0xC0, 0xB3, 0x25, 0x99, 0xF3, 0xEC, 0x79, 0x53, 
+0xB0, 0xC8, 0xC2, 0x6E, 0x19, 0x23, 0x3B, 0x85, 
+0x5A, 0x2B, 0x32, 0x26, 0x3C, 0x30, 0xA7, 0x91, 
+0x4A, 0x17, 0x90, 0x57, 0x7A, 0xD6, 0xCF, 0x38, 
+0xD7, 0xB5, 0xB3, 0x43, 0xC1, 0x9D, 0xD8, 0x6C, 
+0x15, 0x09, 0x5A, 0x6E, 0xE3, 0xF7, 0x5A, 0x54, 
+0x40, 0x8F, 0xF6, 0xBA, 0x3B, 
+
+0x78, 0xAD, 0x82, 0x7B, 0x78, 0xC7, 0x66, 0x2A, 
+0xBC, 0x7A, 0xD6, 0xD0, 0x82, 0xE6, 0xF0, 0xC4, 
+0xC2, 0xF0, 0x1F, 0x1D, 0xE1, 0xE7, 0x9C, 0xCD, 
+0xAE, 0x32, 0x90, 0xF6, 0x5D, 0xE0, 0xDD, 0x7B, 
+0x2E, 0x44, 0x64, 0xDE, 0x65, 0xFC, 0x55, 0xB7, 
+0xC3, 0x4E, 0xB7, 0x86, 0x6A, 0x92, 0x97, 0x54, 
+0x2B, 0xAF, 0x0F, 0x26, 0x2E, 0xB3, 0x07, 0xE7, 
+0xAC, 0x16, 0xB6, 0x21, 0x86, 0x1D, 0x14, 0x20, 
+0xD9, 0x38, 0xC9, 0xC6, 0x2C, 0x88, 0x5B, 0x9B, 
+0x92, 0xBA, 0xB7, 0xB1, 0xFC, 0xAC, 
+
+0x01, 0xFB, 0x28, 0x2D, 0xF1, 0x08, 0x02, 0xD4, 
+0x2C, 0x59, 0xE8, 0x54, 0x97, 0x45, 0xD3, 0xFC, 
+0xDC, 0x78, 0xA2, 0x86, 0x17, 0x0B, 0x33, 0x2A, 
+0x12, 0x86, 0x07, 0xCF, 0x00, 0xBC, 0x0B, 0xCE, 
+0x6A, 0x04, 0x70, 0xF9, 0xC7, 0xC3, 0x02, 0xC3, 
+0x42, 0xCE, 0x1E, 0xAD, 0x94, 0x80, 0x14, 0x06, 
+0xEC, 0x20, 0xED, 0x0E, 0x6D, 0x04, 0xAD, 0xC5, 
+0xB5, 0xFE, 0x42, 0x5A, 0x74, 0x6B, 0x8F, 0x3A, 
+0xC9, 0x22, 0x56, 0xE1, 0x00, 0xA5, 0x0D, 0x33, 
+0x54, 0x72, 0x00, 0xA7, 0x2A, 0x28, 0x65, 
+
+0x23, 0xB6, 0xBC, 0x5C, 0x5F, 0x68, 0x3C, 0xE9, 
+0x46, 0xF5, 0xD7, 0xD5, 0x1F, 0x84, 0x41, 0x06, 
+0x66, 0x72, 0xD0, 0x8A, 0xCF, 0xD8, 0x0A, 0xAF, 
+0x3E, 0x4B, 0x19, 0x09, 0x99, 0x8D, 0x5B, 0x63, 
+0xE1, 0x01, 0xC0, 0xDC, 0x60, 0x18, 0xE3, 0x32, 
+0x58, 0xA9, 0xE9, 0x88, 0x0F, 0x7D, 0x4A, 0xE1, 
+0xA7, 0x67, 0xA4, 0x4C, 0x89, 0x04, 0x75, 0x89, 
+0x87, 0x4C, 0xF5, 0x82, 0x57, 0xE7, 0x4E, 0x21, 
+0x72, 0xB2, 0x1D, 0xC6, 0x93, 0x02, 0x1C, 0x7B, 
+0x06, 0xFC, 0xDA, 0xB9, 0x28, 0xB1, 0x5F, 0xEF, 
+
+0x68, 0x81, 0xD8, 0xB6, 0x62, 0xE6, 0x17, 0x8A, 
+0x22, 0xE8, 0x15, 0xD5, 0xA6, 0x2E, 0xAE, 0x08, 
+0xA6, 0xB9, 0xE1, 0x20, 0x1C, 0x80, 0xDF, 0x54, 
+0xE2, 0xAE, 0x17, 0x61, 0x4A, 0x54, 0xD2, 0xF8, 
+0x30, 0x15, 0xE4, 0xF5, 0x5F, 0x7A, 0x18, 0xCF, 
+0x14, 0x59, 0xED, 0xBA, 0xEF, 0x88, 0x75, 0x87, 
+0xE9, 0x47, 0xFB, 0x52, 0x32, 0x6B, 0x7D, 0x6B, 
+0x5D, 0xF4, 0x49, 0xE1, 0x26, 0x9E, 0x24, 0x56, 
+0xAB, 0xAD, 0x55, 0x3F, 0xA4, 0x09, 0x1D, 0xF6, 
+0xBE, 0xEC, 0x3B, 0x7F, 0xAF, 0x97, 0xF2, 0x80, 
+0x00, 
+
+0xA6, 0xD2, 0xCB, 0x79, 0x09, 0x9C, 0xCE, 0xC9, 
+0xAA, 0x2E, 0x7A, 0x66, 0x79, 0xAE, 0xDD, 0x38, 
+0xAB, 0x8C, 0x7E, 0xAE, 0x8F, 0x3C, 0x68, 0xA7, 
+0x59, 0x7A, 0x2C, 0xEA, 0x85, 0x19, 0x58, 0x1B, 
+0x5F, 0xD3, 0xE7, 0x33, 0x59, 0xB0, 0x2D, 0x60, 
+0x22, 0x85, 0x08, 0xDF, 0x0B, 0xCB, 0x22, 0x90, 
+0x48, 0x09, 0x9D, 0xFF, 0xEB, 0x29, 0x36, 0x3D, 
+0xBD, 0xDE, 0x7D, 0xEB, 0x74, 0x71, 0xD1, 0xD1, 
+0x3A, 0x60, 0x0D, 0x67, 0x51, 0xD7, 0xC7, 0xDD, 
+0x0C, 0x7A, 0x60, 0xD4, 0x68, 0xBA, 0x33, 0x0B, 
+0xC5, 0xF7, 
+
+0x4D, 0x91, 0x8E, 0xB7, 0x54, 0x12, 0xBC, 0x7C, 
+0x5C, 0xFF, 0xA6, 0x06, 0x37, 0xE1, 0x37, 0x05, 
+0x0F, 0xD1, 0x7B, 0xC2, 0x13, 0x3A, 0x59, 0xB3, 
+0xC4, 0x5C, 0x72, 0x63, 0x47, 0x0D, 0xC5, 0x38, 
+0x8E, 0xF5, 0x82, 0x2D, 0xBE, 0x74, 0x5E, 0xDF, 
+0xAD, 0xA5, 0xB0, 0xC9, 0x42, 0x25, 0xE5, 0x7C, 
+0xA1, 0x9C, 0xCD, 0x06, 0x54, 0x0C, 0x77, 0xE8, 
+0xB3, 0xA1, 0xAA, 0xC6, 0x65, 0xF7, 0xCB, 0x05, 
+0xB7, 0xC3, 0x8E, 0x5E, 0xA2, 0x27, 0x70, 0x60, 
+0xF9, 0x3B, 0x35, 0x39, 0xAE, 0x28, 0xA8, 0x74, 
+0x54, 0xE2, 0x87, 
+
+0x59, 0xFB, 0x16, 0x58, 0x0F, 0xAF, 0xD0, 0xAD, 
+0x05, 0x07, 0x9B, 0xBA, 0x45, 0x87, 0x3E, 0x40, 
+0x6D, 0xAB, 0x90, 0xE8, 0xA2, 0xE5, 0xBD, 0xA5, 
+0x28, 0x8F, 0xAC, 0xE6, 0xE9, 0xC8, 0x46, 0xA6, 
+0x84, 0x26, 0x0B, 0x16, 0x65, 0x47, 0x1A, 0x72, 
+0x52, 0x4C, 0xD3, 0x8A, 0xB1, 0x1F, 0x26, 0x81, 
+0x8F, 0x23, 0x17, 0x35, 0x52, 0xF9, 0xB4, 0xDC, 
+0x00, 0x42, 0xFF, 0xEA, 0xCF, 0x93, 0x00, 0xC7, 
+0xE0, 0x66, 0x5F, 0x56, 0xE1, 0xAC, 0xCB, 0xA2, 
+0xE5, 0xBD, 0x95, 0x9F, 0x18, 0x14, 0xB3, 0xE4, 
+0x1E, 0x52, 0x5E, 0x45, 
+
+0x2D, 0x09, 0xE4, 0xB7, 0xAD, 0x96, 0xCB, 0x90, 
+0x6A, 0x56, 0xCD, 0xEF, 0x1A, 0x04, 0xEB, 0xB4, 
+0x9C, 0x34, 0x96, 0xF5, 0x5D, 0xFF, 0xDD, 0x8A, 
+0xC7, 0xFB, 0x21, 0x2F, 0x4E, 0x2F, 0x9D, 0xB4, 
+0x7F, 0x4C, 0x77, 0x4F, 0x14, 0xA7, 0x79, 0x51, 
+0x18, 0x55, 0xDD, 0xD1, 0x72, 0xDC, 0x2F, 0xA7, 
+0x63, 0xA1, 0xF6, 0xE4, 0x98, 0xCD, 0xBE, 0xE5, 
+0x01, 0x8C, 0x88, 0x8D, 0x10, 0x64, 0x71, 0x4C, 
+0x3C, 0x3A, 0x3F, 0xB1, 0x74, 0xD6, 0x2C, 0x03, 
+0xE4, 0x5E, 0x6D, 0x79, 0x76, 0x63, 0x2E, 0x02, 
+0xE1, 0x56, 0x84, 0x3C, 0xCD, 
+
+0x2A, 0x98, 0xF1, 0x6B, 0xF8, 0xDF, 0xE8, 0x5C, 
+0x7B, 0xD8, 0xE5, 0x50, 0x27, 0x35, 0x02, 0xC8, 
+0xA6, 0x8D, 0xCE, 0x68, 0xFD, 0x06, 0x0C, 0x10, 
+0xF3, 0x34, 0x9F, 0x3E, 0xA4, 0xA1, 0x4A, 0x0E, 
+0xA7, 0x9B, 0xE6, 0x17, 0xBB, 0xBE, 0xD5, 0x37, 
+0x81, 0xA0, 0xC3, 0xE0, 0xED, 0x11, 0xE8, 0x2C, 
+0xC7, 0xDD, 0x90, 0xAA, 0x7E, 0xC9, 0xB0, 0xBF, 
+0x2E, 0x92, 0xDB, 0x79, 0x62, 0x5C, 0x2A, 0x28, 
+0xFF, 0x14, 0xD9, 0x5A, 0xDD, 0xCE, 0x0D, 0x22, 
+0x54, 0xBE, 0x77, 0xB2, 0x14, 0x71, 0x8F, 0x65, 
+0xAF, 0x2A, 0x9C, 0xB1, 0x03, 0xD4, 
+
+0x2A, 0x4F, 0x01, 0xAA, 0x2C, 0xF0, 0xAB, 0x86, 
+0x6D, 0x60, 0xF5, 0x05, 0x5A, 0xD7, 0xB8, 0x3A, 
+0x2C, 0x7B, 0xB3, 0x55, 0x91, 0x46, 0x2A, 0xA3, 
+0x17, 0xA8, 0x2E, 0x65, 0xF7, 0x22, 0x1D, 0x44, 
+0xA7, 0x8D, 0x65, 0x65, 0xD3, 0xAE, 0xFA, 0xE8, 
+0x3D, 0x7C, 0x0A, 0x8C, 0xCF, 0xEF, 0x5F, 0x25, 
+0x42, 0x37, 0x3A, 0xCF, 0xD2, 0xC6, 0xEA, 0x13, 
+0x9F, 0xBB, 0x9B, 0xB9, 0xD7, 0x36, 0x36, 0x5C, 
+0xE3, 0xA4, 0xF1, 0x09, 0x9A, 0xCA, 0xC7, 0x7F, 
+0x76, 0x1B, 0xBA, 0x25, 0x25, 0xB7, 0x14, 0x27, 
+0xEA, 0x98, 0x0D, 0xB5, 0x4F, 0x33, 0x15, 
+
+0xF6, 0x54, 0x0D, 0x68, 0x91, 0x95, 0x59, 0xEE, 
+0xA5, 0x1A, 0x8C, 0x7F, 0x9E, 0x9E, 0xA4, 0x4F, 
+0x37, 0xA3, 0x9F, 0x23, 0x01, 0x9E, 0xED, 0x59, 
+0x34, 0x2E, 0x6D, 0x22, 0x7E, 0x98, 0xBC, 0xE4, 
+0x22, 0x11, 0xA8, 0xF5, 0xF8, 0xB5, 0xC5, 0x84, 
+0x11, 0xCA, 0x2A, 0x18, 0xB7, 0x75, 0x9C, 0xB4, 
+0xC3, 0x29, 0x8B, 0x16, 0x6D, 0x0C, 0x75, 0x34, 
+0xFA, 0x73, 0x27, 0x5C, 0xCF, 0x8A, 0xB1, 0xD5, 
+0xB0, 0x14, 0xC1, 0xDB, 0x8F, 0x91, 0x80, 0x52, 
+0x24, 0x3D, 0x49, 0xD5, 0xFC, 0x2D, 0x24, 0xD4, 
+0x57, 0x7F, 0x86, 0xF9, 0x5E, 0x67, 0x2D, 0xA6, 
+
+0x69, 0x97, 0x9D, 0xAC, 0x98, 0x31, 0xD7, 0x1E, 
+0x55, 0x24, 0x9F, 0x96, 0x5D, 0xF8, 0x58, 0x17, 
+0xE1, 0x8D, 0x11, 0x06, 0xA2, 0xA5, 0xC6, 0xED, 
+0x81, 0x4D, 0x91, 0xEE, 0xD5, 0x33, 0x47, 0xCE, 
+0x52, 0xAF, 0x10, 0x4E, 0xEE, 0x69, 0xDB, 0xD5, 
+0xE7, 0x93, 0x28, 0x72, 0xAA, 0x60, 0x56, 0xE4, 
+0x9F, 0x9D, 0x2C, 0xBC, 0xBB, 0xA7, 0x23, 0x11, 
+0x3A, 0x00, 0x1D, 0x42, 0xDC, 0x0C, 0x5D, 0x5E, 
+0x81, 0x55, 0xB4, 0x99, 0x47, 0xC5, 0xA8, 0xB2, 
+0x4B, 0xCA, 0x53, 0x1F, 0x42, 0x8D, 0x75, 0xF8, 
+0x80, 0x1C, 0x16, 0xE1, 0x6B, 0xA1, 0x60, 0x74, 
+0xF8, 
+
+0x20, 0x0D, 0x87, 0x55, 0x4D, 0xA7, 0xD0, 0x0A, 
+0x17, 0x5D, 0xEC, 0x78, 0x1F, 0x22, 0x99, 0x87, 
+0x27, 0xD5, 0xA5, 0xD1, 0xE1, 0x67, 0x13, 0xB2, 
+0x68, 0x84, 0x86, 0x38, 0xBB, 0x2C, 0x2C, 0x57, 
+0xB3, 0x55, 0x2E, 0x86, 0xE2, 0x27, 0xAE, 0xF4, 
+0xAF, 0xF5, 0x42, 0x71, 0xE4, 0xC1, 0xC5, 0xAC, 
+0x64, 0x64, 0xEE, 0xA3, 0x6D, 0x88, 0xAF, 0xD2, 
+0x6A, 0x8B, 0x24, 0xB0, 0x55, 0x55, 0x5F, 0x9C, 
+0x6A, 0x5F, 0x85, 0xA9, 0x8F, 0x63, 0xCF, 0xAF, 
+0xD8, 0x3D, 0x3D, 0xE5, 0xB0, 0x4A, 0x52, 0x3B, 
+0x65, 0x13, 0x3D, 0xD8, 0x0D, 0x1F, 0x29, 0x77, 
+0x0F, 0x87, 
+
+0xB8, 0x63, 0x4E, 0xA7, 0xFD, 0x80, 0x9C, 0x1A, 
+0x18, 0xD5, 0x99, 0x33, 0xFE, 0x67, 0x41, 0x42, 
+0xE1, 0xD6, 0x3C, 0x9A, 0x3E, 0x9A, 0xCE, 0x47, 
+0xFF, 0xEA, 0xF2, 0xC6, 0xA9, 0x9F, 0xF3, 0x82, 
+0x22, 0xAB, 0xB8, 0xE5, 0x42, 0x37, 0xFB, 0xAC, 
+0xF6, 0x17, 0x5C, 0x08, 0x69, 0x89, 0x61, 0xC7, 
+0x8B, 0x8E, 0x2E, 0xDA, 0x5B, 0x82, 0xFF, 0x83, 
+0xFF, 0x1D, 0x53, 0x32, 0x56, 0x98, 0x29, 0xA5, 
+0x2E, 0x0E, 0xBB, 0x9E, 0x95, 0x78, 0x00, 0x81, 
+0x9D, 0xA3, 0x71, 0xA9, 0xB0, 0x40, 0x88, 0x03, 
+0xBF, 0x1D, 0x2D, 0x0A, 0x6C, 0x1D, 0x1A, 0x81, 
+0xF7, 0x88, 0x21, 
+
+0x67, 0xBE, 0x76, 0x28, 0x18, 0xF9, 0x89, 0x63, 
+0x1C, 0x85, 0xF9, 0x5F, 0x10, 0xFF, 0x4D, 0xF3, 
+0xCE, 0x0E, 0x4A, 0xC1, 0x31, 0x15, 0x92, 0x72, 
+0x12, 0x05, 0xFE, 0xD2, 0x5C, 0x42, 0x9B, 0x94, 
+0x9B, 0xE9, 0xE4, 0x3C, 0x97, 0xC9, 0x18, 0xF6, 
+0x2A, 0xE7, 0x81, 0x2C, 0xED, 0x55, 0xF7, 0x35, 
+0xFF, 0xD1, 0x3D, 0x98, 0xED, 0x57, 0x2D, 0x46, 
+0x5A, 0xA1, 0xAD, 0xFF, 0x4B, 0x56, 0xCD, 0x9C, 
+0xD0, 0xFF, 0x4C, 0x34, 0x6B, 0x8D, 0xDF, 0x74, 
+0x59, 0xC4, 0x27, 0xFF, 0x02, 0x48, 0xC1, 0x18, 
+0x90, 0x6A, 0x8E, 0xD2, 0x3B, 0x40, 0x2E, 0x90, 
+0x60, 0x40, 0x26, 0x19, 
+
+0x40, 0x52, 0xA6, 0xA5, 0xFB, 0xF7, 0x1F, 0xFB, 
+0xD4, 0xC3, 0x92, 0x54, 0x89, 0x16, 0xA2, 0x8B, 
+0x13, 0xF9, 0x0A, 0xBF, 0x20, 0x6A, 0xF7, 0x7B, 
+0xFE, 0x93, 0x69, 0x9E, 0x95, 0x39, 0x50, 0xC1, 
+0x4C, 0xFB, 0x98, 0xAE, 0x48, 0x21, 0xA7, 0xA2, 
+0x8F, 0xBA, 0xB7, 0x35, 0xAE, 0x7F, 0x09, 0x7E, 
+0x40, 0x54, 0xDE, 0x41, 0xE5, 0xDB, 0x36, 0x0B, 
+0xBB, 0xA2, 0x0E, 0x60, 0x4E, 0xBC, 0x90, 0xFC, 
+0x64, 0x7F, 0xA8, 0x07, 0x87, 0x58, 0xFD, 0x93, 
+0x3A, 0x66, 0xEC, 0xA5, 0xF7, 0x2F, 0xF7, 0x87, 
+0x3B, 0x13, 0x91, 0xA3, 0xD1, 0xA0, 0xBC, 0x31, 
+0x5D, 0x5C, 0x01, 0x5F, 0x9F, 
+
+0x41, 0xFB, 0xCE, 0x5C, 0xBA, 0xB8, 0x30, 0x69, 
+0x5C, 0x9B, 0x46, 0xAB, 0x37, 0xC0, 0xE2, 0x70, 
+0x6C, 0x48, 0x18, 0x70, 0x97, 0x9F, 0xBB, 0x34, 
+0x50, 0x78, 0x66, 0x27, 0x6B, 0x37, 0xDD, 0x7C, 
+0x5D, 0xC7, 0xA8, 0xB5, 0xEE, 0x4F, 0x90, 0xAB, 
+0x3E, 0x34, 0x61, 0x34, 0x61, 0x40, 0x30, 0x0E, 
+0x2B, 0x51, 0xF1, 0xD9, 0x77, 0x2E, 0xF4, 0x27, 
+0x97, 0x1D, 0xF3, 0x1A, 0x89, 0x39, 0x05, 0x65, 
+0x5A, 0xA8, 0xBF, 0x04, 0x6E, 0xC0, 0x14, 0x49, 
+0x06, 0xE9, 0xA9, 0x56, 0xF4, 0xBC, 0x1D, 0x24, 
+0x99, 0xB2, 0x91, 0x22, 0xFA, 0xCE, 0xE6, 0xA1, 
+0x7F, 0x92, 0x49, 0x33, 0xC2, 0xC3, 
+
+0xF2, 0x62, 0xB3, 0xE1, 0xF5, 0x42, 0xCF, 0xCF, 
+0x3D, 0x3B, 0xA2, 0xD0, 0x65, 0xBE, 0xB0, 0xEB, 
+0x9E, 0x16, 0x09, 0x55, 0x44, 0x0C, 0xE0, 0x78, 
+0xC8, 0xE3, 0x77, 0xEF, 0xA6, 0x15, 0x0A, 0xCA, 
+0xA6, 0xA0, 0x8F, 0x1D, 0x27, 0xDB, 0x73, 0x0E, 
+0x0E, 0x4A, 0xFD, 0xA8, 0x91, 0x40, 0xB2, 0xA5, 
+0x65, 0xFC, 0x66, 0xEE, 0x67, 0x7A, 0xA0, 0x24, 
+0x43, 0xBE, 0xE4, 0x1C, 0x5B, 0x13, 0xAE, 0x6E, 
+0x4B, 0x8B, 0x6A, 0x87, 0x1B, 0x9C, 0x2F, 0x7E, 
+0x86, 0x24, 0x9B, 0x6E, 0x22, 0x31, 0x7C, 0xA4, 
+0xF5, 0x9A, 0xEA, 0xD5, 0xF1, 0x24, 0x0E, 0x0A, 
+0xD8, 0x1A, 0x22, 0x5E, 0xC1, 0xC4, 0xB0, 
+
+0x9C, 0xEB, 0x24, 0x43, 0x20, 0x8D, 0x4B, 0x67, 
+0xD9, 0x1E, 0x9A, 0xA3, 0xD3, 0x37, 0x08, 0xC1, 
+0x57, 0xE3, 0xEF, 0x14, 0xE2, 0xD1, 0x4C, 0x04, 
+0x46, 0x6B, 0x06, 0x86, 0x85, 0xB0, 0x1F, 0xE4, 
+0xBC, 0x40, 0x28, 0x55, 0x61, 0xFD, 0x68, 0x33, 
+0x38, 0x6F, 0x20, 0x54, 0xBA, 0xD1, 0x98, 0xAB, 
+0xD1, 0x09, 0xC7, 0xD5, 0x88, 0x86, 0x82, 0x81, 
+0x0C, 0x36, 0x56, 0x62, 0xC7, 0xD4, 0x48, 0xA5, 
+0x90, 0x86, 0x29, 0x04, 0x91, 0x56, 0x0C, 0xF6, 
+0x3F, 0x1E, 0x8C, 0x89, 0x0B, 0x67, 0x71, 0x30, 
+0x7C, 0x95, 0xAD, 0x52, 0x68, 0x6D, 0x4A, 0xDB, 
+0x32, 0xD9, 0x71, 0xD7, 0x25, 0x49, 0xE3, 0x1F, 
+
+0x99, 0x30, 0xBB, 0xF0, 0xBB, 0x99, 0x96, 0xF1, 
+0x66, 0x94, 0x2A, 0x59, 0x1E, 0x51, 0xA4, 0xDD, 
+0x05, 0xB0, 0x44, 0x58, 0xD2, 0x4E, 0x90, 0x0B, 
+0x0A, 0x28, 0xBE, 0x4C, 0x1E, 0xD3, 0x89, 0x2B, 
+0xEF, 0x23, 0xE9, 0x21, 0x17, 0x2F, 0xA5, 0xE3, 
+0xEC, 0xC0, 0x44, 0x9D, 0x4B, 0x77, 0xF8, 0xA6, 
+0x60, 0x19, 0x00, 0xDB, 0x47, 0x67, 0x65, 0x43, 
+0x9A, 0x89, 0xA8, 0x1F, 0xC6, 0x65, 0xCA, 0x57, 
+0xFF, 0xD7, 0x94, 0x30, 0xA8, 0x2D, 0xF1, 0x02, 
+0x57, 0x35, 0xD4, 0xCB, 0xE2, 0x85, 0x7D, 0xEC, 
+0xA5, 0x2D, 0x2A, 0xB6, 0xB2, 0x84, 0xAA, 0xFF, 
+0x05, 0xD7, 0xDD, 0x34, 0x41, 0x1B, 0xF3, 0x48, 
+0xEA, 
+
+0x32, 0x00, 0x61, 0x4A, 0xF8, 0xA8, 0x67, 0xA1, 
+0x68, 0x8D, 0x41, 0x28, 0x26, 0x76, 0xA8, 0x95, 
+0xD0, 0xF5, 0x71, 0xC6, 0x91, 0x59, 0x46, 0x98, 
+0xFB, 0xB9, 0x23, 0xBB, 0x8B, 0x57, 0xAB, 0x4E, 
+0x53, 0x37, 0x8C, 0x82, 0x52, 0xB8, 0x2B, 0x74, 
+0x3B, 0xDE, 0xB6, 0xED, 0xF1, 0xC9, 0x6A, 0x77, 
+0xEE, 0xD6, 0x28, 0x0E, 0xA0, 0xA9, 0xB5, 0xE4, 
+0xB8, 0x75, 0x98, 0xC9, 0xA5, 0x04, 0xB5, 0x45, 
+0x53, 0x39, 0x45, 0xE5, 0x67, 0xCE, 0x6F, 0xBF, 
+0xB8, 0x2E, 0x48, 0x03, 0xC0, 0xAB, 0xB4, 0x33, 
+0x23, 0x69, 0x2F, 0x00, 0xAC, 0xD2, 0xB5, 0x83, 
+0xBA, 0x88, 0xBC, 0x7B, 0xD6, 0xF4, 0xE2, 0x0A, 
+0xC1, 0x17, 
+
+0xBC, 0xD6, 0x19, 0xA0, 0x63, 0x64, 0x51, 0xE7, 
+0x87, 0x4E, 0x2C, 0x0F, 0xE0, 0x84, 0xA8, 0x18, 
+0x89, 0xD9, 0x8B, 0x1E, 0x78, 0x06, 0x07, 0xA7, 
+0xA8, 0x42, 0xD5, 0x8E, 0x06, 0x15, 0x8E, 0xC5, 
+0xCE, 0x94, 0x6B, 0x93, 0x78, 0x4C, 0x48, 0xD3, 
+0x92, 0xAA, 0x82, 0x81, 0xF6, 0x0E, 0x10, 0xAC, 
+0x3E, 0x7C, 0x76, 0xC0, 0xA4, 0xB2, 0xDA, 0x57, 
+0xE5, 0xAE, 0xC9, 0xE8, 0x16, 0xBE, 0x1F, 0x12, 
+0x3F, 0x1D, 0x27, 0x66, 0x96, 0xCD, 0x49, 0x3C, 
+0xC9, 0xED, 0x62, 0xF7, 0xEE, 0x16, 0xF7, 0x37, 
+0xF6, 0xA9, 0xB2, 0x71, 0xAC, 0x92, 0xC3, 0xCF, 
+0x81, 0x92, 0xBD, 0x6D, 0xF0, 0xFB, 0xDA, 0x68, 
+0x9B, 0x41, 0x47, 
+
+0x4A, 0xFE, 0x5C, 0xB4, 0xB2, 0x1C, 0xE9, 0x4B, 
+0x64, 0xA9, 0xEA, 0xDE, 0x9E, 0xFD, 0x52, 0x4C, 
+0xEE, 0x01, 0xCE, 0x11, 0xA0, 0x54, 0x89, 0x88, 
+0xDE, 0x0C, 0x43, 0x32, 0x12, 0xCB, 0x2C, 0x9B, 
+0xCE, 0xEB, 0xD3, 0xBF, 0x3D, 0xDF, 0x63, 0x0F, 
+0x90, 0xBA, 0xD9, 0x0A, 0xC5, 0xB1, 0x0E, 0xE2, 
+0x90, 0x00, 0x90, 0xA5, 0x3B, 0xD1, 0x96, 0xED, 
+0x76, 0x92, 0x6D, 0x10, 0xF3, 0xB9, 0xC8, 0xBC, 
+0xBB, 0x40, 0x80, 0x25, 0x25, 0xE1, 0x96, 0xE5, 
+0xDA, 0xF8, 0x76, 0x3D, 0x92, 0x94, 0x19, 0x42, 
+0x37, 0x1E, 0x1B, 0x97, 0xA5, 0x3A, 0x96, 0xC4, 
+0x65, 0x71, 0x79, 0x0A, 0xD0, 0x2A, 0x7A, 0xC4, 
+0xCC, 0xEE, 0x61, 0x67, 
+
+0xA5, 0x15, 0xAA, 0x1F, 0xAC, 0xE4, 0x4A, 0x2E, 
+0x76, 0xE0, 0x97, 0xFB, 0xA1, 0x3F, 0x41, 0xDD, 
+0xAB, 0x64, 0x31, 0x4D, 0xA2, 0xEA, 0x8D, 0xDA, 
+0x4F, 0x07, 0x6F, 0x00, 0x1F, 0x3F, 0x71, 0x43, 
+0x15, 0x80, 0xFE, 0x13, 0xD4, 0xA7, 0x7B, 0x95, 
+0x63, 0xB4, 0x1C, 0xAA, 0xD1, 0x2B, 0x45, 0x70, 
+0x57, 0x7C, 0x0A, 0x0B, 0x5D, 0x5F, 0x90, 0x30, 
+0xB5, 0x43, 0xCD, 0xC3, 0x39, 0x59, 0x1B, 0xB0, 
+0xC2, 0x65, 0xCD, 0x86, 0x13, 0x66, 0xEE, 0x04, 
+0x1B, 0xA9, 0xF5, 0x36, 0x80, 0xBF, 0x3A, 0x7E, 
+0x0D, 0x29, 0x87, 0x2D, 0xC8, 0x87, 0x0D, 0x3D, 
+0xF4, 0x00, 0xE5, 0x53, 0xF5, 0xEC, 0xBC, 0xDD, 
+0xB3, 0xF7, 0x76, 0x94, 0x31, 
+
+0x94, 0x28, 0xE6, 0x7F, 0xBA, 0x33, 0xF4, 0x6D, 
+0x99, 0xF9, 0x05, 0x7E, 0x1F, 0x55, 0xBB, 0x26, 
+0x06, 0x36, 0x5F, 0xC1, 0xE9, 0xE0, 0x75, 0x9D, 
+0x5B, 0xE4, 0x74, 0xF0, 0x51, 0x49, 0xB4, 0xEC, 
+0x4A, 0x19, 0xD0, 0xA8, 0xD3, 0xF8, 0xE9, 0xA6, 
+0xD7, 0x05, 0xD9, 0x4F, 0x39, 0x1C, 0xD1, 0x6E, 
+0xEA, 0xB0, 0x50, 0xC3, 0x8B, 0xD9, 0xEA, 0x20, 
+0x57, 0x51, 0xFB, 0xF2, 0xBB, 0xE5, 0x24, 0x1A, 
+0xEB, 0x26, 0xE3, 0x27, 0x2E, 0x62, 0x50, 0x92, 
+0x60, 0x8C, 0x27, 0x46, 0x08, 0x4A, 0x54, 0x66, 
+0x6F, 0xF9, 0xAE, 0x11, 0x99, 0xC4, 0xFE, 0x6F, 
+0x7B, 0x44, 0xC7, 0x46, 0xD2, 0x1F, 0x5A, 0xC8, 
+0x21, 0x40, 0x3E, 0x1D, 0x7D, 0xC4, 
+
+0x1A, 0x15, 0xDC, 0x87, 0xDD, 0x83, 0xEB, 0x69, 
+0xF9, 0x6F, 0x09, 0xD7, 0x22, 0x8C, 0x5D, 0xE0, 
+0x94, 0xC1, 0x7F, 0x96, 0x3F, 0x61, 0x3C, 0x9A, 
+0x33, 0x45, 0x52, 0x1B, 0x9B, 0x2B, 0x22, 0x96, 
+0x0C, 0xBA, 0xB7, 0xD5, 0x75, 0x26, 0x0E, 0x6A, 
+0x58, 0x9E, 0xCE, 0x21, 0x51, 0x44, 0x3B, 0x67, 
+0x63, 0xAD, 0x83, 0xA1, 0xCD, 0x73, 0x12, 0x2A, 
+0xFB, 0x39, 0x65, 0xBD, 0x06, 0xA1, 0x32, 0x73, 
+0x91, 0x4A, 0x47, 0xB6, 0x24, 0xBF, 0x7B, 0x7B, 
+0x88, 0xF0, 0xCC, 0x1F, 0xCE, 0x4C, 0x77, 0xA5, 
+0x62, 0xB3, 0x8D, 0x8C, 0xC5, 0x00, 0xB9, 0x57, 
+0xD2, 0x6B, 0x65, 0xFA, 0x1F, 0x3C, 0xB5, 0x88, 
+0x7D, 0x60, 0x1B, 0x07, 0x8A, 0x31, 0xF6, 
+
+0xA0, 0x24, 0xCB, 0x5F, 0x83, 0xC0, 0xFE, 0x64, 
+0x03, 0x0A, 0xA2, 0x1D, 0x9A, 0x0E, 0x25, 0x08, 
+0x23, 0x7C, 0x78, 0x59, 0x26, 0xFC, 0x07, 0x41, 
+0xD9, 0xC4, 0x0D, 0x4C, 0x65, 0x28, 0x73, 0x78, 
+0xB6, 0x3D, 0x92, 0x38, 0xEE, 0x44, 0x5B, 0x7A, 
+0x5D, 0xD4, 0x4F, 0x44, 0xAE, 0x5C, 0xF8, 0x34, 
+0x3E, 0xD3, 0xBC, 0x51, 0xDF, 0x76, 0x49, 0x8D, 
+0x97, 0xE1, 0x5D, 0x48, 0xAE, 0xE4, 0x11, 0x97, 
+0xC9, 0xAB, 0x0A, 0xC2, 0xF1, 0x2F, 0x93, 0x36, 
+0xD1, 0x63, 0x93, 0x98, 0x96, 0x55, 0xA2, 0xDB, 
+0xC9, 0x4A, 0x12, 0x8A, 0xEA, 0x09, 0xF5, 0x09, 
+0x0B, 0xA9, 0x25, 0x5D, 0xCC, 0x0C, 0x71, 0xC5, 
+0xA4, 0xFE, 0x17, 0x38, 0x04, 0x72, 0x5B, 0x6C, 
+
+0x8F, 0x24, 0xFA, 0x4E, 0x3C, 0x12, 0xAF, 0x30, 
+0xB6, 0x95, 0x5E, 0x81, 0x48, 0xB2, 0xBA, 0xFA, 
+0x64, 0xFC, 0xF0, 0x95, 0xD2, 0x2A, 0x13, 0x3F, 
+0x56, 0xE3, 0x63, 0x9E, 0x73, 0x68, 0xCF, 0x74, 
+0x13, 0xCF, 0x2C, 0xC5, 0x2D, 0x90, 0x6E, 0x45, 
+0x94, 0x2F, 0x99, 0x29, 0xDE, 0x29, 0x1C, 0xED, 
+0x3C, 0xB6, 0x17, 0x68, 0x97, 0xB3, 0xB0, 0xC5, 
+0x52, 0xF8, 0x34, 0xB1, 0x36, 0x15, 0x0E, 0xC7, 
+0xBE, 0x55, 0xC0, 0xCD, 0x16, 0x1F, 0xBB, 0x95, 
+0x30, 0x7C, 0x0D, 0x70, 0x56, 0x0C, 0xF0, 0x54, 
+0xB0, 0x43, 0x7E, 0x23, 0xFA, 0x96, 0xD6, 0x7D, 
+0xBF, 0xE4, 0xE4, 0xA2, 0x46, 0x23, 0x1A, 0x9C, 
+0xB5, 0xA1, 0xF2, 0x67, 0x88, 0xEE, 0x0E, 0x74, 
+0x75, 
+
+0xA5, 0x23, 0xAA, 0xC4, 0x3C, 0x45, 0x39, 0x82, 
+0x71, 0x3E, 0x3A, 0x57, 0x41, 0x0B, 0x2C, 0x7E, 
+0xBE, 0x06, 0x86, 0xD4, 0x9F, 0xB9, 0x1C, 0x60, 
+0x5E, 0xB7, 0x11, 0xB1, 0xC6, 0xD6, 0xF3, 0x3E, 
+0x20, 0x55, 0x1E, 0x23, 0xFE, 0x65, 0x0F, 0xF3, 
+0xD7, 0x82, 0x4C, 0xE5, 0x90, 0x95, 0xF2, 0x17, 
+0x31, 0xC4, 0x37, 0xC5, 0xE8, 0x3A, 0xF0, 0x92, 
+0x7C, 0x34, 0x7A, 0xD2, 0x16, 0x90, 0xD4, 0xBB, 
+0x5F, 0x7C, 0x6E, 0xD1, 0x68, 0xC2, 0xA7, 0x51, 
+0x04, 0x66, 0xBE, 0xE5, 0x12, 0xCD, 0xFE, 0xA0, 
+0xFC, 0x90, 0x4B, 0x72, 0x38, 0x2E, 0x14, 0x60, 
+0xE3, 0xEE, 0xA5, 0xEC, 0x32, 0x09, 0xE8, 0x0A, 
+0xDB, 0xF7, 0xB2, 0x56, 0x83, 0xB9, 0xA2, 0x06, 
+0xDF, 0x26, 
+
+0x97, 0xB9, 0xA6, 0xFD, 0xB3, 0xD4, 0xAA, 0x8F, 
+0xC2, 0x45, 0x7A, 0xF5, 0x13, 0x73, 0xA8, 0x80, 
+0x4F, 0x39, 0x59, 0x3A, 0x2D, 0xCA, 0x18, 0x88, 
+0x94, 0x8B, 0xC5, 0x3C, 0x4E, 0x15, 0xE4, 0x0A, 
+0xB1, 0xD8, 0x3D, 0xA0, 0xC1, 0x3E, 0x9F, 0x93, 
+0x08, 0x33, 0x58, 0x69, 0x1E, 0xEC, 0xF6, 0xDD, 
+0xBE, 0x4B, 0x89, 0x43, 0x03, 0x74, 0xAA, 0x6B, 
+0xEE, 0xC2, 0x1B, 0x72, 0xC6, 0x44, 0xAC, 0x1C, 
+0x4E, 0x89, 0xB9, 0x08, 0x25, 0x32, 0x69, 0xC4, 
+0x27, 0x8E, 0x44, 0x08, 0x4C, 0x99, 0x85, 0xA8, 
+0x46, 0x09, 0x0C, 0x0C, 0xD0, 0x84, 0xBF, 0x06, 
+0xB1, 0x5D, 0x1F, 0x41, 0x49, 0x87, 0x81, 0x74, 
+0x1C, 0x8E, 0x71, 0x18, 0x5A, 0x48, 0x2B, 0xEB, 
+0x3F, 0x96, 0x6F, 
+
+0x79, 0xB8, 0xC3, 0x4E, 0x02, 0xE0, 0xE4, 0xB7, 
+0x95, 0x74, 0x8A, 0xFD, 0xD4, 0xE2, 0x3B, 0x6B, 
+0xA4, 0xC9, 0x3B, 0x52, 0xFE, 0xAA, 0x15, 0x71, 
+0x63, 0xE2, 0x9A, 0x19, 0x89, 0xE1, 0x64, 0x06, 
+0xC7, 0xA6, 0x3E, 0x0B, 0x2B, 0x0B, 0xB9, 0x28, 
+0x88, 0x3B, 0x07, 0x7B, 0x55, 0x8B, 0x69, 0x14, 
+0xF4, 0x54, 0xC0, 0x1B, 0x90, 0x9B, 0x92, 0x56, 
+0xB9, 0x8F, 0x66, 0x16, 0x8B, 0x67, 0x2E, 0xF5, 
+0xF8, 0x06, 0x3D, 0x33, 0xB6, 0x5B, 0x7A, 0x69, 
+0xA4, 0x46, 0x73, 0x66, 0x28, 0x07, 0x52, 0x3F, 
+0xB0, 0xBD, 0x7E, 0xE0, 0xCC, 0x88, 0xE7, 0x42, 
+0x0E, 0xC5, 0xEA, 0x32, 0x40, 0x75, 0xE3, 0x11, 
+0xAB, 0xE7, 0x1D, 0x0D, 0xB7, 0x5E, 0xFF, 0x14, 
+0x77, 0xBE, 0xF6, 0x8E, 
+
+0xFF, 0x07, 0x0B, 0x99, 0xF2, 0x29, 0x7A, 0xBF, 
+0x77, 0x8E, 0x35, 0x03, 0x16, 0x88, 0xBF, 0xAB, 
+0xBC, 0x6C, 0x31, 0x80, 0x1B, 0xF1, 0x34, 0x06, 
+0x47, 0x70, 0x7E, 0x98, 0x42, 0x92, 0xD5, 0xFF, 
+0x23, 0x21, 0x57, 0x5E, 0x97, 0xA7, 0x7C, 0xD7, 
+0x75, 0xBD, 0x31, 0x82, 0xF9, 0xD9, 0x6D, 0xF1, 
+0x98, 0x17, 0xB0, 0x93, 0xDF, 0xA3, 0x6F, 0x37, 
+0x11, 0x41, 0x5D, 0x54, 0xCD, 0x5F, 0xD7, 0x07, 
+0x5F, 0x43, 0x53, 0x66, 0x04, 0x71, 0x24, 0xC5, 
+0x9A, 0x2D, 0xCE, 0x8C, 0x7B, 0xAF, 0x82, 0x65, 
+0xC9, 0x93, 0xD1, 0x2F, 0x6B, 0xC0, 0xAD, 0x92, 
+0xCE, 0xBE, 0x1B, 0xF7, 0xE3, 0x0B, 0x68, 0x8B, 
+0x26, 0x7C, 0xB7, 0xB0, 0x11, 0xE8, 0x4C, 0x65, 
+0x09, 0xC2, 0x4B, 0xF2, 0x72, 
+
+0x2D, 0x14, 0xF7, 0x07, 0x0B, 0xFB, 0xAE, 0x8F, 
+0x16, 0xC9, 0xFD, 0x23, 0xBD, 0xD8, 0x96, 0x22, 
+0x59, 0x5A, 0x9C, 0x72, 0xD3, 0xA9, 0xF9, 0xC9, 
+0xD5, 0xC8, 0x72, 0x33, 0x8A, 0xDF, 0x2D, 0xF7, 
+0xA5, 0x5C, 0x9E, 0xE7, 0x7D, 0x08, 0xBF, 0x49, 
+0xDD, 0x01, 0xE0, 0x36, 0x32, 0x8D, 0xAC, 0xA0, 
+0xD4, 0xF7, 0x8F, 0x3B, 0xD8, 0x56, 0xB2, 0x03, 
+0x42, 0x72, 0x87, 0xF6, 0xA8, 0x57, 0x49, 0x9A, 
+0x76, 0xF3, 0xDE, 0xB6, 0x4A, 0xCC, 0xEC, 0x8D, 
+0xE9, 0x6F, 0x22, 0x1A, 0xBA, 0x19, 0xB0, 0x8F, 
+0x2A, 0x7D, 0x9D, 0xD3, 0x18, 0xB0, 0x79, 0xB4, 
+0x6B, 0x80, 0x35, 0x31, 0xA4, 0x16, 0xBA, 0x47, 
+0x42, 0xA4, 0xFF, 0x7B, 0x08, 0xB4, 0xA8, 0xE8, 
+0x96, 0x7E, 0x10, 0x84, 0x8B, 0xD8, 
+
+0x0A, 0xFB, 0xBA, 0xE5, 0x0A, 0x2F, 0x0B, 0x10, 
+0x61, 0xD1, 0x03, 0x33, 0x64, 0xE0, 0x50, 0xB0, 
+0x3D, 0x3A, 0x26, 0x36, 0xAC, 0xDF, 0xD2, 0x50, 
+0x6E, 0xB0, 0x78, 0x55, 0xBD, 0xAB, 0x41, 0xB9, 
+0x8A, 0x91, 0x26, 0x55, 0x2A, 0xB9, 0x35, 0x3B, 
+0xFC, 0x4E, 0x8E, 0x7F, 0x60, 0xFB, 0x57, 0x12, 
+0xAF, 0xFD, 0xAE, 0x80, 0x84, 0x1C, 0x63, 0x20, 
+0x68, 0xF5, 0x77, 0x0D, 0x11, 0xF8, 0xFD, 0x16, 
+0x41, 0x8A, 0x0C, 0x0A, 0x70, 0x39, 0xED, 0x58, 
+0x91, 0x04, 0x05, 0x97, 0xD9, 0x07, 0xDE, 0x97, 
+0x11, 0xB2, 0xA4, 0x4F, 0xA3, 0x16, 0xAF, 0x98, 
+0xDE, 0x4B, 0xAD, 0xDF, 0x27, 0x11, 0xFD, 0x78, 
+0x14, 0x4E, 0xB5, 0x0C, 0xD5, 0x31, 0x25, 0xC7, 
+0x34, 0xB3, 0x9B, 0x15, 0xF0, 0x09, 0x25, 
+
+0x2E, 0xB9, 0x2B, 0x7B, 0xED, 0x0F, 0x81, 0xB5, 
+0x17, 0xC7, 0x0E, 0x3B, 0x31, 0x61, 0xDC, 0x12, 
+0xE6, 0x75, 0x03, 0x8D, 0x6B, 0xE6, 0x73, 0x0C, 
+0xFB, 0xEC, 0x93, 0x47, 0xB2, 0x2E, 0x4F, 0xC6, 
+0x9E, 0x3C, 0xA9, 0x75, 0x49, 0xB2, 0xC2, 0x37, 
+0xA8, 0x4D, 0xE6, 0xA4, 0x4A, 0x31, 0xF3, 0x48, 
+0x1D, 0xF7, 0x24, 0x01, 0x97, 0xDA, 0x64, 0xBA, 
+0x0F, 0x39, 0x25, 0x95, 0x2C, 0x7A, 0x84, 0x77, 
+0xF8, 0x7B, 0x71, 0xAE, 0xE9, 0xA6, 0x80, 0x34, 
+0x38, 0xA4, 0x32, 0x4D, 0x97, 0x3E, 0x43, 0xCD, 
+0xE1, 0xED, 0x89, 0x8F, 0x9F, 0x9E, 0xAC, 0xC0, 
+0xBC, 0x97, 0x7C, 0x26, 0x7E, 0x26, 0xAB, 0xF4, 
+0xF0, 0xF2, 0x79, 0x28, 0x0D, 0xB8, 0xA5, 0x12, 
+0x51, 0xFB, 0xFB, 0x63, 0xA1, 0x2A, 0xAC, 0x8C, 
+
+0x75, 0x4B, 0x38, 0x79, 0xF7, 0x5A, 0x23, 0x8A, 
+0x28, 0x45, 0x07, 0x1D, 0x38, 0xC8, 0x44, 0x4E, 
+0x8B, 0xB1, 0xFF, 0x08, 0x86, 0xDA, 0xDA, 0x4C, 
+0x1C, 0x2D, 0xA5, 0x50, 0xE6, 0x81, 0x12, 0x9A, 
+0x62, 0x12, 0x41, 0x9D, 0xE9, 0x84, 0x11, 0xBC, 
+0xA9, 0x20, 0xA5, 0xAF, 0xED, 0x4C, 0xFE, 0x80, 
+0x38, 0x72, 0x08, 0x6C, 0xF7, 0x0D, 0x83, 0xC1, 
+0xF0, 0x76, 0x6F, 0x99, 0x4D, 0xCA, 0x98, 0x21, 
+0xF3, 0xC8, 0xF4, 0x48, 0xD2, 0xC9, 0x10, 0x7B, 
+0x27, 0x50, 0x6B, 0x3D, 0x9A, 0x17, 0x3F, 0xE1, 
+0x46, 0x69, 0x2C, 0xC1, 0x92, 0xE3, 0xC8, 0x9A, 
+0x7E, 0x2D, 0xEE, 0xF9, 0x4F, 0xC5, 0x2F, 0x71, 
+0xC7, 0xE2, 0x20, 0xDB, 0x3F, 0xFF, 0x67, 0x1A, 
+0x14, 0x37, 0x93, 0x39, 0x5B, 0xD4, 0x0F, 0x45, 
+0xF0, 
+
+0xBF, 0x02, 0xFC, 0xAA, 0x8C, 0x22, 0x0E, 0x02, 
+0xD1, 0xD6, 0x57, 0x92, 0x43, 0xEB, 0x41, 0xBD, 
+0x1F, 0x49, 0x3D, 0x63, 0x25, 0x4A, 0x2B, 0x7F, 
+0xEF, 0x0E, 0x03, 0xA9, 0x40, 0x17, 0xA0, 0x7E, 
+0x2C, 0x17, 0x38, 0xB0, 0x16, 0xA9, 0xD4, 0xCC, 
+0xD8, 0x75, 0x60, 0x3B, 0x7C, 0x5F, 0x2E, 0xE0, 
+0xBD, 0x28, 0x25, 0xCB, 0xA4, 0x9D, 0xCE, 0x82, 
+0x81, 0xDB, 0xD9, 0xEB, 0x8B, 0xD2, 0x60, 0xC5, 
+0x4D, 0x48, 0xD7, 0x5C, 0xD0, 0xAB, 0x4D, 0x8B, 
+0xD3, 0xBB, 0x9A, 0xC7, 0x7C, 0x3A, 0xC2, 0xFF, 
+0x85, 0x9C, 0xDE, 0xEF, 0x6A, 0xD6, 0x98, 0x23, 
+0xC7, 0x8C, 0x52, 0x0A, 0x0E, 0xDD, 0x69, 0x8C, 
+0xC9, 0x85, 0xB8, 0xDA, 0x25, 0x60, 0x35, 0x40, 
+0x39, 0x5F, 0x52, 0x9A, 0xD9, 0x2E, 0x42, 0x68, 
+0x16, 0x51, 
+
+0xE6, 0x66, 0xF6, 0xF0, 0x8A, 0xD1, 0x3F, 0x25, 
+0xEB, 0xC4, 0x97, 0x47, 0x9A, 0x3D, 0xA4, 0xDE, 
+0x54, 0xC3, 0x71, 0xBE, 0x82, 0xD3, 0x4B, 0x41, 
+0xD4, 0x24, 0x38, 0xFE, 0x5A, 0x5B, 0x17, 0xE0, 
+0x6B, 0x7C, 0x01, 0xF0, 0xFF, 0x19, 0xF5, 0x07, 
+0xC3, 0xDA, 0x0F, 0x90, 0x41, 0x95, 0x00, 0xD2, 
+0xFB, 0x02, 0xB1, 0xAC, 0xDC, 0xA1, 0x03, 0xA1, 
+0xCD, 0x16, 0x12, 0xF1, 0x3A, 0xA8, 0x30, 0x53, 
+0x6C, 0x2A, 0x75, 0xDC, 0xA4, 0xAC, 0x76, 0x75, 
+0x9D, 0x7C, 0x8A, 0xDF, 0xA5, 0x49, 0x56, 0x35, 
+0xC6, 0xAA, 0xFF, 0xC8, 0xE6, 0x20, 0x0B, 0xD1, 
+0x40, 0x7E, 0xF1, 0x4A, 0xDC, 0x2F, 0xD6, 0xF2, 
+0xBB, 0x16, 0x4D, 0x26, 0x72, 0xC0, 0x25, 0x0A, 
+0xC5, 0x6A, 0xF7, 0xCB, 0xC4, 0x23, 0x93, 0x50, 
+0x0D, 0xB5, 0x7F, 
+
+0x05, 0xDC, 0xD4, 0xC5, 0xDB, 0xCD, 0x66, 0x3A, 
+0xC7, 0x70, 0x43, 0x83, 0x38, 0x21, 0x1C, 0x17, 
+0xB8, 0xC5, 0x59, 0x0D, 0x5E, 0x68, 0xF9, 0x1E, 
+0x10, 0xD7, 0x88, 0xB1, 0x61, 0x31, 0x7D, 0x10, 
+0x11, 0x27, 0xA2, 0x9E, 0x27, 0x99, 0x27, 0x66, 
+0x37, 0x6F, 0x2F, 0x2C, 0x43, 0xE4, 0x0C, 0xAA, 
+0x69, 0xEC, 0x9B, 0xE0, 0x99, 0xE8, 0x0B, 0x61, 
+0xDD, 0x6F, 0x97, 0xC2, 0x83, 0x6A, 0xE2, 0x98, 
+0x73, 0xA1, 0xB6, 0x84, 0x78, 0x16, 0xEB, 0xF4, 
+0xB4, 0x77, 0x3B, 0x62, 0x40, 0xCC, 0x71, 0xD8, 
+0x28, 0xCD, 0xCA, 0xB9, 0x80, 0x49, 0x4B, 0x8E, 
+0x28, 0x2A, 0xAC, 0x65, 0xB0, 0xBC, 0x3B, 0x2B, 
+0x02, 0x96, 0xF0, 0x4E, 0x82, 0x10, 0x1B, 0x5F, 
+0xF5, 0xBE, 0x23, 0x94, 0x03, 0x9F, 0xA0, 0xC5, 
+0x28, 0x68, 0xDB, 0xA0, 
+
+0xA8, 0x9B, 0xC0, 0x79, 0x9F, 0xC0, 0xEF, 0x00, 
+0xD4, 0x30, 0x90, 0xF2, 0xDB, 0xD6, 0x08, 0x36, 
+0x87, 0x38, 0xFF, 0x57, 0xC0, 0xB3, 0x91, 0x42, 
+0x4F, 0xE8, 0x11, 0xF5, 0xD6, 0x3E, 0xCE, 0xEA, 
+0x62, 0x23, 0xDA, 0x0C, 0x98, 0xF8, 0xEA, 0xA3, 
+0xC9, 0x21, 0xF6, 0x03, 0xD4, 0x71, 0x79, 0x6A, 
+0xE4, 0x2A, 0x0D, 0xF2, 0xD7, 0xF2, 0xE4, 0x04, 
+0xF3, 0xB7, 0xAD, 0x9D, 0xF5, 0xB4, 0xDC, 0x58, 
+0xF3, 0x6C, 0xE3, 0x95, 0xDE, 0xE3, 0x32, 0x32, 
+0xB6, 0x25, 0x72, 0xC8, 0xBF, 0xFB, 0x83, 0x0D, 
+0xB4, 0xCD, 0x4B, 0xBF, 0x37, 0x51, 0xD4, 0x9F, 
+0x8C, 0x82, 0x1B, 0x30, 0x0A, 0xC5, 0x4C, 0xAB, 
+0x78, 0x8B, 0xEB, 0x27, 0x50, 0x3D, 0xD3, 0x4F, 
+0x78, 0xB1, 0xE9, 0xB6, 0x13, 0xC7, 0x9F, 0xD4, 
+0xB2, 0x5F, 0x40, 0x4E, 0x29, 
+
+0x50, 0x7D, 0x3F, 0xA5, 0x4F, 0x79, 0xCE, 0xF9, 
+0xAE, 0x64, 0xC4, 0xC9, 0x59, 0x9C, 0x2D, 0x8E, 
+0x86, 0xDD, 0xC8, 0xAA, 0xE7, 0x1A, 0x21, 0xB6, 
+0x80, 0x23, 0xF4, 0xB1, 0xD0, 0x9E, 0x57, 0xCE, 
+0x08, 0xAB, 0x0D, 0xB8, 0xB0, 0xA8, 0x06, 0xD8, 
+0xE6, 0xA8, 0xD6, 0x31, 0xF8, 0x45, 0x62, 0xDF, 
+0x71, 0x41, 0x25, 0xB7, 0xEB, 0x3A, 0xFC, 0x93, 
+0x63, 0x49, 0x36, 0x07, 0x8F, 0x18, 0x3A, 0x1F, 
+0x1C, 0xA3, 0xCB, 0x90, 0x74, 0x1F, 0xE3, 0x57, 
+0x91, 0x3F, 0x0E, 0xB3, 0x61, 0x7F, 0xFA, 0xB8, 
+0x2D, 0x71, 0xAB, 0xF0, 0x17, 0xB0, 0x25, 0x85, 
+0xC7, 0x4B, 0xCF, 0x14, 0x73, 0x82, 0x5E, 0x16, 
+0x27, 0x46, 0x63, 0xA0, 0x20, 0xB7, 0x20, 0x0B, 
+0xC1, 0x46, 0x8F, 0x29, 0xCF, 0x90, 0x2B, 0xE6, 
+0xB0, 0xA3, 0xCC, 0xB7, 0xD9, 0x3D, 
+
+0x47, 0x42, 0xA4, 0x9B, 0x51, 0x38, 0x87, 0x6D, 
+0x3A, 0x21, 0x17, 0x31, 0xF5, 0x79, 0x48, 0x8C, 
+0x3D, 0xD7, 0x6E, 0xCC, 0xF2, 0x09, 0x3E, 0x2B, 
+0x5D, 0x69, 0x79, 0x43, 0x29, 0x02, 0x9C, 0xAA, 
+0x15, 0xD0, 0x4B, 0x32, 0xAD, 0x61, 0xEA, 0x00, 
+0xF0, 0x22, 0xAD, 0x7A, 0x12, 0xAF, 0xDE, 0xFB, 
+0x55, 0x38, 0xD8, 0xA9, 0x3F, 0x09, 0x34, 0xAF, 
+0x4C, 0x42, 0xD4, 0x20, 0x69, 0x7C, 0x0C, 0xBA, 
+0x34, 0xE3, 0xA5, 0xC3, 0x8E, 0x46, 0xCC, 0xEA, 
+0x83, 0x5B, 0xF3, 0xCD, 0x59, 0x21, 0x1F, 0xC8, 
+0xC9, 0x18, 0x34, 0xD1, 0xD3, 0xA8, 0xF6, 0x1A, 
+0x9F, 0x64, 0x6E, 0xD6, 0x15, 0xDA, 0xD9, 0xD3, 
+0x51, 0x58, 0x90, 0xC8, 0x5A, 0xA7, 0x56, 0xCF, 
+0xF0, 0x19, 0x7C, 0x82, 0xEF, 0x45, 0xBB, 0x42, 
+0x19, 0xF8, 0xC0, 0x8E, 0x1E, 0x36, 0x6A, 
+
+0xA5, 0x66, 0xC5, 0xED, 0x9E, 0xB6, 0xC6, 0xED, 
+0x4F, 0xA7, 0x13, 0x63, 0xE3, 0x09, 0x10, 0x3D, 
+0x86, 0xAB, 0x0D, 0x43, 0x4E, 0xC1, 0x94, 0xBF, 
+0x64, 0xA1, 0x30, 0x50, 0x1D, 0xC4, 0x3C, 0x27, 
+0x39, 0xE7, 0xE0, 0xD2, 0x3A, 0x50, 0x61, 0x0C, 
+0x2C, 0x27, 0xAF, 0xD0, 0xC3, 0x01, 0x82, 0x8A, 
+0x6D, 0x54, 0x00, 0x3F, 0xB8, 0xFF, 0x2B, 0x09, 
+0xF1, 0x99, 0xCC, 0x67, 0x72, 0x4E, 0x3B, 0x82, 
+0x4A, 0x2B, 0x36, 0xB5, 0x2F, 0x75, 0x45, 0x7D, 
+0xAF, 0x4D, 0x61, 0x16, 0xB9, 0x82, 0xAA, 0xA0, 
+0x05, 0x0E, 0x5A, 0x36, 0xE9, 0xD2, 0xE7, 0x63, 
+0xD5, 0xB9, 0x57, 0x8C, 0x06, 0xC4, 0xFD, 0xBD, 
+0x9B, 0x26, 0x65, 0x8A, 0xAE, 0xD5, 0xB4, 0x3B, 
+0xDF, 0xD4, 0x23, 0x4F, 0xA1, 0xA3, 0x07, 0x16, 
+0xC9, 0x4B, 0x3D, 0xBA, 0x46, 0x71, 0x1D, 0x70, 
+
+0xBE, 0xD0, 0x1E, 0xF8, 0xB9, 0x6A, 0x92, 0x5C, 
+0xFC, 0x3A, 0x8D, 0x19, 0x88, 0x68, 0x55, 0xC9, 
+0xB9, 0xC3, 0xAF, 0xED, 0x5A, 0xF6, 0x48, 0x23, 
+0x64, 0x7B, 0x0F, 0x69, 0xD6, 0x9C, 0xA2, 0x73, 
+0xAF, 0xFA, 0x32, 0x82, 0x4A, 0xB6, 0x1D, 0x30, 
+0xDE, 0xAE, 0x09, 0x80, 0x77, 0x74, 0x42, 0x6E, 
+0x3E, 0x21, 0x0C, 0x25, 0xD9, 0xC6, 0x17, 0x7D, 
+0x7B, 0x6B, 0x4D, 0xA8, 0xB2, 0x14, 0x87, 0x5F, 
+0xBE, 0xE7, 0x12, 0x32, 0xE2, 0xFA, 0x4A, 0xED, 
+0x64, 0xD2, 0x75, 0xD0, 0x80, 0xF8, 0x36, 0x7E, 
+0xD6, 0x4D, 0xD3, 0x15, 0x28, 0x1D, 0x4C, 0x59, 
+0x17, 0x72, 0x76, 0x27, 0xF1, 0x6C, 0x5B, 0xDA, 
+0x2F, 0xAE, 0xA0, 0x23, 0x60, 0x2B, 0x36, 0x0F, 
+0xE7, 0xC4, 0x26, 0xC3, 0xD2, 0x5B, 0x13, 0x4A, 
+0xD8, 0xEE, 0x2E, 0xA7, 0xAB, 0x18, 0x08, 0x15, 
+0x81, 
+
+0x6D, 0x73, 0x03, 0x46, 0x6A, 0x95, 0xE6, 0x63, 
+0xF1, 0x7C, 0x15, 0xAF, 0x08, 0x12, 0xD1, 0x44, 
+0xC9, 0x7E, 0x84, 0xCF, 0xE4, 0x33, 0xD4, 0x23, 
+0x24, 0x19, 0x03, 0xE4, 0x4D, 0xC2, 0x4D, 0x0F, 
+0x1D, 0x5D, 0xE0, 0xE1, 0x84, 0x98, 0x71, 0x38, 
+0x45, 0xCF, 0x15, 0x57, 0x2A, 0x1C, 0x3F, 0xD8, 
+0xA6, 0x21, 0x5E, 0xEF, 0x1A, 0xDE, 0x97, 0x95, 
+0xE3, 0x29, 0xD7, 0xD2, 0x5A, 0x08, 0xE5, 0xD9, 
+0x05, 0x9D, 0xD3, 0x9D, 0x26, 0xFA, 0x0C, 0xC9, 
+0x82, 0x9E, 0x2F, 0x54, 0x80, 0x2B, 0x29, 0x0E, 
+0x81, 0x19, 0x0E, 0xB2, 0x5C, 0x63, 0x47, 0x31, 
+0x3F, 0xBA, 0xDE, 0x06, 0xA3, 0x22, 0xF2, 0x5C, 
+0x80, 0x08, 0x44, 0x68, 0x1F, 0x35, 0x49, 0xA9, 
+0x30, 0x4C, 0x3E, 0x2D, 0xBE, 0x17, 0x25, 0xD7, 
+0xFF, 0xC2, 0x1E, 0x37, 0x91, 0xBC, 0x7C, 0x6C, 
+0x3E, 0x47, 
+
+0x5A, 0x8E, 0xD3, 0xCE, 0x2A, 0xA2, 0xEE, 0x3B, 
+0x48, 0x98, 0x54, 0xFD, 0x75, 0x49, 0x95, 0x2D, 
+0x9E, 0xD0, 0xDE, 0x1E, 0xD2, 0x56, 0x88, 0x31, 
+0xDB, 0xCE, 0x98, 0x1C, 0x75, 0xDE, 0x44, 0x1D, 
+0x4B, 0xD5, 0xE3, 0x1E, 0x9E, 0x58, 0xB0, 0xC9, 
+0x52, 0x2E, 0x9D, 0x8F, 0xEF, 0x34, 0x96, 0x89, 
+0x65, 0x8F, 0x52, 0x29, 0xFA, 0x8B, 0xD7, 0x0A, 
+0xBE, 0xFE, 0x5C, 0xD9, 0x7C, 0x34, 0xB5, 0x66, 
+0x34, 0x59, 0xBF, 0xDC, 0xC2, 0x78, 0xD3, 0x0F, 
+0x1B, 0x93, 0x29, 0x18, 0x8D, 0xA7, 0x52, 0x94, 
+0x69, 0x4F, 0xD1, 0x72, 0x62, 0x46, 0x5C, 0x46, 
+0xAA, 0xB0, 0xA5, 0x01, 0x25, 0x16, 0xFF, 0x3E, 
+0xC7, 0x99, 0x1C, 0xD9, 0xDA, 0x43, 0x65, 0x28, 
+0xD4, 0xF4, 0xBC, 0x2E, 0x7E, 0x3D, 0x50, 0x39, 
+0x83, 0x28, 0x40, 0x79, 0x1F, 0x9E, 0xF5, 0xE8, 
+0xE9, 0xE9, 0xA3, 
+
+0x7A, 0x0F, 0x12, 0xA8, 0xD9, 0xED, 0x2A, 0xE3, 
+0x26, 0x10, 0x01, 0x46, 0x32, 0x86, 0xA3, 0x72, 
+0x27, 0x04, 0x3A, 0x26, 0xDF, 0x21, 0x25, 0x8D, 
+0x9C, 0x08, 0x59, 0xEE, 0xA6, 0x3C, 0xDB, 0xD4, 
+0x5D, 0x6E, 0xC4, 0x16, 0x25, 0x09, 0x37, 0x72, 
+0x40, 0x3D, 0xBE, 0x04, 0x60, 0xDD, 0xD3, 0xFA, 
+0xC9, 0xFF, 0x33, 0x09, 0x6E, 0x42, 0x95, 0x65, 
+0x56, 0x4D, 0x5A, 0xB0, 0x53, 0xA8, 0xED, 0x24, 
+0x11, 0xBC, 0x10, 0x5F, 0xE5, 0xD1, 0x6D, 0x59, 
+0x31, 0x10, 0x28, 0x0B, 0x40, 0x22, 0xFE, 0x7A, 
+0x29, 0xA5, 0xA9, 0x79, 0xBF, 0x83, 0xE9, 0x8C, 
+0x81, 0xFE, 0xFC, 0x1B, 0x67, 0x9A, 0xAF, 0x38, 
+0x3A, 0x87, 0x63, 0x6F, 0x7F, 0x9C, 0x5E, 0x35, 
+0x0F, 0xA0, 0xE3, 0x8D, 0x4D, 0xC4, 0xFF, 0x30, 
+0xDE, 0x27, 0xE2, 0x0C, 0xFB, 0xEB, 0x0B, 0x22, 
+0xCE, 0x7D, 0xA1, 0x9A, 
+
+0xDC, 0x2A, 0xCE, 0x12, 0x74, 0x49, 0x4C, 0xB1, 
+0x31, 0x95, 0x83, 0x52, 0x40, 0xD7, 0x89, 0xF8, 
+0xCB, 0x45, 0xCC, 0xCC, 0x9C, 0xAE, 0xE8, 0x1F, 
+0x06, 0xEA, 0x2A, 0x66, 0x83, 0x6E, 0x50, 0xC1, 
+0xC9, 0xFC, 0x48, 0x46, 0xC0, 0x24, 0xFB, 0x03, 
+0xB7, 0x31, 0xC4, 0x68, 0x6A, 0x59, 0x0D, 0xCE, 
+0x35, 0x03, 0x09, 0x7B, 0x5B, 0x61, 0xEA, 0x11, 
+0x6D, 0x48, 0x4D, 0x9F, 0xD9, 0x8C, 0x76, 0x6F, 
+0x0C, 0xB6, 0x69, 0xC7, 0x8C, 0xC8, 0x32, 0x56, 
+0xDB, 0xDA, 0x67, 0xCB, 0xA6, 0x74, 0x93, 0x33, 
+0x6E, 0x24, 0x11, 0x61, 0x8D, 0x70, 0x2E, 0x09, 
+0x2F, 0xAE, 0x5B, 0x49, 0x63, 0x57, 0x23, 0x70, 
+0x63, 0x69, 0x8A, 0x81, 0xFC, 0xCB, 0xFE, 0x40, 
+0x34, 0x7E, 0x92, 0xDE, 0x6E, 0xE7, 0x28, 0x8F, 
+0x83, 0x43, 0x8E, 0x36, 0xA9, 0xD3, 0x86, 0xD9, 
+0xB7, 0xEC, 0x52, 0x05, 0xA9, 
+
+0xA7, 0x15, 0x38, 0x35, 0x0B, 0x71, 0xDC, 0x55, 
+0x2A, 0xAB, 0x54, 0x74, 0x38, 0x1B, 0x9A, 0x58, 
+0xA4, 0x0F, 0x77, 0x36, 0x28, 0x6D, 0x45, 0x76, 
+0xED, 0x09, 0xEA, 0xFC, 0x33, 0x01, 0x3B, 0x25, 
+0xA2, 0x8C, 0x8D, 0xD9, 0x03, 0x26, 0xD1, 0x78, 
+0x8F, 0x55, 0xF5, 0x6D, 0x29, 0xA5, 0x08, 0x4F, 
+0xE8, 0x6A, 0x47, 0x2F, 0x5F, 0x4D, 0xA9, 0x36, 
+0x2E, 0xE4, 0x07, 0x97, 0x39, 0x6C, 0x56, 0x58, 
+0xB3, 0x05, 0xBB, 0x60, 0x6B, 0x70, 0x2F, 0x31, 
+0x8F, 0xA1, 0xE0, 0xA5, 0x10, 0xEE, 0x20, 0x14, 
+0xAC, 0xE1, 0x6C, 0x9F, 0x80, 0xE0, 0x32, 0x35, 
+0xEE, 0x33, 0x57, 0x2D, 0xF9, 0xCF, 0x6F, 0x1B, 
+0xE7, 0x1B, 0x8B, 0x28, 0x6F, 0x56, 0xD9, 0x53, 
+0x41, 0xF7, 0x2C, 0x3A, 0xA6, 0x1B, 0x0E, 0xA9, 
+0x1B, 0xE2, 0x1F, 0x06, 0x07, 0x75, 0x95, 0xC6, 
+0x39, 0x32, 0xC7, 0x38, 0x04, 0x17, 
+
+0x07, 0x47, 0x46, 0x65, 0xD1, 0x22, 0x89, 0x95, 
+0xB3, 0xF6, 0x20, 0xF0, 0x7E, 0x8A, 0x53, 0xE9, 
+0x68, 0x01, 0xEE, 0x60, 0xED, 0x14, 0x81, 0xA1, 
+0x45, 0x50, 0x57, 0x9B, 0x5F, 0xF9, 0xBE, 0xFE, 
+0x62, 0x49, 0x2F, 0xC3, 0x8B, 0x1A, 0x2B, 0xC6, 
+0x31, 0x9E, 0x0F, 0x0F, 0xEA, 0x36, 0xCE, 0x30, 
+0xA7, 0xD9, 0xE6, 0xD9, 0x6B, 0xE2, 0x5B, 0x46, 
+0x21, 0x1A, 0x7D, 0xF3, 0x3B, 0x5C, 0x37, 0x43, 
+0x70, 0x9F, 0xED, 0xE5, 0xAA, 0x73, 0x93, 0x54, 
+0x86, 0x3A, 0x31, 0x5E, 0xFB, 0x79, 0x99, 0xF8, 
+0x79, 0x6B, 0xA9, 0x3A, 0xF6, 0xF2, 0x7C, 0x11, 
+0x43, 0x43, 0xC3, 0xA3, 0x4C, 0xDE, 0x3D, 0x40, 
+0x49, 0xB6, 0x17, 0xFA, 0x30, 0x2E, 0x79, 0xB1, 
+0xB9, 0x6E, 0x2C, 0x94, 0x9F, 0xCA, 0x80, 0xBC, 
+0xE6, 0x58, 0x0A, 0xB4, 0xD8, 0xE9, 0xF4, 0xF6, 
+0x1A, 0xC5, 0x25, 0xEF, 0x26, 0x90, 0xF8, 
+
+0xE2, 0x8D, 0x7E, 0xC1, 0x6A, 0x9C, 0x50, 0x8A, 
+0x8B, 0x7E, 0xBB, 0xE5, 0x41, 0x68, 0x2E, 0x55, 
+0xFC, 0x9F, 0xC7, 0xE2, 0x37, 0x6C, 0x3F, 0xEB, 
+0xA4, 0xD7, 0xD5, 0x08, 0x6A, 0x52, 0x6E, 0xE7, 
+0x83, 0x58, 0x11, 0x5C, 0x29, 0xA4, 0x29, 0x6C, 
+0xD2, 0xA7, 0xDD, 0x54, 0x3E, 0xEC, 0xD1, 0x7B, 
+0x84, 0xDD, 0xFD, 0x36, 0x12, 0x62, 0x74, 0x9F, 
+0x5C, 0xC8, 0xCF, 0xB1, 0x98, 0x45, 0x2E, 0xDD, 
+0xBB, 0x48, 0x4E, 0x82, 0xC6, 0x10, 0xC2, 0xDF, 
+0x86, 0xD7, 0xE4, 0xF1, 0x0D, 0xAB, 0xFD, 0x23, 
+0xAB, 0xE4, 0x7A, 0x4D, 0xB1, 0x5B, 0x6A, 0xF2, 
+0x7C, 0x74, 0x14, 0x8B, 0xD5, 0x97, 0x2C, 0x37, 
+0xB7, 0xA0, 0x86, 0x0B, 0x70, 0xCE, 0xFD, 0xC5, 
+0x64, 0x8C, 0xD5, 0xE0, 0xCA, 0x3E, 0x8A, 0x3F, 
+0x3E, 0x48, 0x16, 0xBA, 0x6E, 0x1D, 0x49, 0x63, 
+0x9F, 0xDD, 0xEB, 0xED, 0x00, 0x16, 0x80, 0x82, 
+
+0xF7, 0x6C, 0x9C, 0x10, 0x4F, 0x29, 0x68, 0x4E, 
+0x5B, 0x06, 0x71, 0xB4, 0x89, 0x40, 0xAD, 0x1C, 
+0x80, 0x18, 0x8F, 0xB5, 0xFE, 0xB5, 0x97, 0xF8, 
+0xE8, 0xDE, 0x12, 0x4C, 0x3C, 0x5E, 0x3F, 0xCC, 
+0xDC, 0xDD, 0x7B, 0xAD, 0x72, 0x61, 0x97, 0x9E, 
+0x62, 0xC4, 0xA4, 0x94, 0x07, 0x80, 0x3E, 0x22, 
+0x4E, 0x2E, 0xA9, 0x23, 0x36, 0xAE, 0x72, 0xA7, 
+0xE1, 0xEA, 0x99, 0x75, 0x86, 0x20, 0xC3, 0x27, 
+0xA4, 0x0D, 0x4D, 0x5C, 0xB7, 0xA3, 0xFD, 0x60, 
+0xCE, 0x9D, 0x75, 0x35, 0xE2, 0x86, 0x22, 0x4D, 
+0xF7, 0xB0, 0xA7, 0x0E, 0x46, 0x18, 0x4B, 0x1C, 
+0x12, 0x94, 0x48, 0x4F, 0x51, 0x9F, 0x68, 0x0F, 
+0x62, 0x9C, 0xDF, 0xD6, 0x3D, 0x18, 0x6D, 0x52, 
+0x75, 0x70, 0xD1, 0xB1, 0x5F, 0x18, 0x07, 0x82, 
+0xF5, 0xE2, 0x7A, 0x7A, 0x5E, 0x89, 0x8D, 0x00, 
+0x56, 0x94, 0x78, 0x53, 0x18, 0xE0, 0xD1, 0x53, 
+0x95, 
+
+0xDC, 0xA5, 0x73, 0x95, 0xA7, 0x94, 0xD7, 0x74, 
+0xD7, 0xE7, 0x7B, 0xBE, 0x30, 0x4E, 0xD5, 0x13, 
+0xF3, 0x64, 0x59, 0x73, 0xDC, 0x5C, 0xCD, 0x64, 
+0x99, 0xA7, 0xB5, 0x57, 0xCE, 0x11, 0x62, 0x3A, 
+0x41, 0xC1, 0xA1, 0x2C, 0x67, 0xFB, 0x7E, 0x71, 
+0xC7, 0xE6, 0x42, 0x3A, 0x70, 0x23, 0xD8, 0xA4, 
+0x65, 0x4F, 0x63, 0x92, 0xE1, 0x83, 0xF1, 0x38, 
+0x9A, 0xBD, 0xF0, 0x3B, 0xFA, 0x06, 0x95, 0x74, 
+0xAD, 0x45, 0x15, 0xCC, 0x34, 0x52, 0x23, 0xD8, 
+0x69, 0x0E, 0x10, 0xD7, 0x49, 0xE9, 0x0C, 0xFA, 
+0xF6, 0x15, 0x99, 0x80, 0xDE, 0xE6, 0x58, 0x0F, 
+0x2B, 0x5E, 0x08, 0x26, 0x23, 0x88, 0x42, 0xCE, 
+0xE4, 0xDE, 0x00, 0x3F, 0xCB, 0xF3, 0x3B, 0x49, 
+0x45, 0xE7, 0x63, 0x28, 0x40, 0xAB, 0xDB, 0x2F, 
+0x9A, 0x05, 0x76, 0xA1, 0x5F, 0xBE, 0x3C, 0xBC, 
+0x70, 0xB1, 0x2F, 0xF5, 0xD9, 0x6E, 0xC4, 0xB2, 
+0x4F, 0xB5, 
+
+0xD5, 0x24, 0x00, 0xA6, 0xA7, 0x82, 0x5C, 0x52, 
+0xAB, 0x2A, 0xCE, 0xCB, 0xD5, 0x53, 0x3F, 0x12, 
+0x92, 0xB8, 0x32, 0x87, 0x16, 0xB5, 0xCA, 0x10, 
+0x75, 0xB5, 0x69, 0x86, 0x3D, 0xB1, 0x8D, 0x23, 
+0x29, 0x96, 0x7C, 0xB4, 0x7F, 0xE7, 0xC2, 0x59, 
+0xE8, 0x28, 0x9A, 0x38, 0x46, 0xB7, 0xE8, 0x7C, 
+0x48, 0xFA, 0x73, 0xD4, 0xD7, 0x98, 0xED, 0xBF, 
+0x55, 0x0B, 0x0A, 0xDE, 0x79, 0xD0, 0x69, 0x94, 
+0xF1, 0xFF, 0xA2, 0x7E, 0xDD, 0x5A, 0xA1, 0xE4, 
+0x35, 0x1A, 0xC0, 0xBC, 0x65, 0x3B, 0xB7, 0x52, 
+0xC3, 0x31, 0xE6, 0xC7, 0xEC, 0x43, 0x8E, 0x98, 
+0x56, 0xA6, 0x36, 0x9E, 0xD4, 0x30, 0x84, 0x28, 
+0x8C, 0xCA, 0xC0, 0xC4, 0xA8, 0x69, 0x3D, 0x45, 
+0x4F, 0xF0, 0x4E, 0x61, 0xC8, 0x63, 0x68, 0x34, 
+0x47, 0x60, 0xB9, 0x58, 0x60, 0xDD, 0xFD, 0x15, 
+0x0B, 0x63, 0x67, 0x72, 0x33, 0x92, 0x86, 0x7F, 
+0xCE, 0x56, 0x0C, 
+
+0x3B, 0xFD, 0xCA, 0x9F, 0x2D, 0xE0, 0x46, 0x6D, 
+0x0A, 0x25, 0xDD, 0x6D, 0x26, 0x5A, 0x2F, 0x33, 
+0xEC, 0x4F, 0xCC, 0x13, 0xC5, 0x1B, 0x66, 0xF4, 
+0xC4, 0x9A, 0x16, 0xCC, 0x98, 0x2D, 0x34, 0x34, 
+0x40, 0xDD, 0x51, 0x22, 0xDB, 0xF6, 0x2A, 0xA7, 
+0x30, 0xD5, 0x63, 0xC0, 0x8F, 0x90, 0x57, 0xFC, 
+0x09, 0xBE, 0x22, 0xB1, 0x29, 0xC5, 0x07, 0x96, 
+0xB8, 0x2A, 0xCE, 0x12, 0xD3, 0x12, 0xF4, 0xCC, 
+0xD5, 0x8A, 0x7C, 0x9F, 0x3D, 0xDD, 0x52, 0x23, 
+0xBB, 0x68, 0x39, 0xD9, 0x18, 0x45, 0x83, 0x21, 
+0x9F, 0xE3, 0xFC, 0x9A, 0xBB, 0x18, 0xC8, 0x52, 
+0x01, 0xCF, 0x5F, 0xAD, 0x93, 0xED, 0x73, 0x13, 
+0x1E, 0x03, 0x6D, 0xA0, 0xFF, 0x2E, 0xFE, 0xF6, 
+0x93, 0xAD, 0xBD, 0xE2, 0x10, 0x8B, 0xE1, 0xA0, 
+0xB6, 0x7C, 0xA7, 0x64, 0xC8, 0x75, 0x0B, 0x6E, 
+0x1B, 0x1A, 0xA4, 0x4E, 0x7D, 0xD1, 0xE9, 0x8C, 
+0xF6, 0x8C, 0x7E, 0x52, 
+
+0x6C, 0x2B, 0xBE, 0xC8, 0xC3, 0x38, 0xB9, 0x20, 
+0x60, 0xE0, 0xA7, 0xD9, 0x4B, 0x9C, 0xDF, 0xF2, 
+0x14, 0xFF, 0x0F, 0xE7, 0xD3, 0x40, 0x0A, 0x66, 
+0xD6, 0x1D, 0x44, 0xEA, 0x13, 0xF1, 0xDC, 0x07, 
+
+0x45, 0x80, 0x10, 0x38, 0xFD, 0x0A, 0xC6, 0xF9, 
+0x0A, 0x08, 0xDD, 0x53, 0xFC, 0xCE, 0x04, 0xA0, 
+0xB9, 0x79, 0xFD, 0x20, 0x9D, 0x2F, 0x85, 0x86, 
+0x9E, 0x0D, 0x3B, 0xF7, 0xEE, 0x04, 0xED, 0x03, 
+0xAB, 
+
+0x7F, 0x78, 0x60, 0xF5, 0x3D, 0xED, 0x2B, 0xC4, 
+0x37, 0x08, 0x88, 0xD6, 0x72, 0x99, 0x71, 0xFA, 
+0x78, 0xF0, 0x91, 0x34, 0x97, 0x00, 0xEE, 0x00, 
+0xC1, 0x7E, 0xEC, 0x3F, 0x39, 0xD0, 0x82, 0x32, 
+0xF2, 0xFD, 
+
+0xD4, 0x1F, 0x5F, 0xF5, 0xD2, 0xBA, 0x4B, 0x15, 
+0x7C, 0x65, 0x1D, 0xDA, 0x8B, 0x6F, 0x50, 0x0F, 
+0x4A, 0xC8, 0xD0, 0x9D, 0xA2, 0x7F, 0x82, 0x4E, 
+0x16, 0xFA, 0x99, 0x91, 0xAC, 0x83, 0x09, 0xA5, 
+0xDE, 0x3B, 0x80, 
+
+0x5F, 0x2F, 0x4F, 0xF7, 0x8C, 0x61, 0xF1, 0xA8, 
+0x3A, 0x37, 0x92, 0x64, 0x10, 0xFC, 0x84, 0x73, 
+0xFA, 0xEC, 0xAA, 0xA3, 0x72, 0xDD, 0x40, 0x11, 
+0xFF, 0x55, 0x7A, 0x57, 0xA4, 0x19, 0x33, 0xBB, 
+0x77, 0x78, 0x25, 0x7C, 
+
+0xA0, 0x63, 0x21, 0xD3, 0xA9, 0xBD, 0xA9, 0x30, 
+0x33, 0x01, 0x4C, 0x72, 0x65, 0x6E, 0xB2, 0xD8, 
+0xC7, 0x40, 0x40, 0x1D, 0x69, 0x79, 0xAB, 0x45, 
+0x74, 0x71, 0x17, 0xB3, 0xE3, 0x2E, 0x50, 0x12, 
+0x26, 0xB8, 0x29, 0x9E, 0xE9, 
+
+0xD3, 0xF6, 0xC0, 0x37, 0xAD, 0xC9, 0x81, 0x0B, 
+0xFE, 0x85, 0x98, 0x48, 0x62, 0x55, 0x70, 0x97, 
+0x76, 0x61, 0x17, 0x95, 0x12, 0x44, 0x5B, 0xFF, 
+0x63, 0x05, 0xD7, 0x56, 0xC8, 0x5E, 0xC1, 0x2E, 
+0x19, 0x80, 0x6A, 0x18, 0xD2, 0xFE, 
+
+0xF3, 0x09, 0x05, 0x82, 0xBE, 0x90, 0x84, 0x38, 
+0x60, 0x8C, 0x5E, 0xF5, 0x97, 0xB1, 0x11, 0x8F, 
+0x2D, 0xCD, 0xB8, 0x10, 0xB2, 0x85, 0xAD, 0x18, 
+0xA5, 0x0A, 0x03, 0x6C, 0x53, 0x5F, 0x1E, 0x32, 
+0xA8, 0xA7, 0x15, 0x1A, 0xC7, 0x41, 0x00, 
+
+0xCB, 0xE7, 0x62, 0xA5, 0x47, 0xCA, 0x96, 0x9E, 
+0x7F, 0x83, 0x86, 0x23, 0xDB, 0xEB, 0xA7, 0xB2, 
+0xD9, 0xA2, 0x2B, 0xDE, 0x06, 0x97, 0xBB, 0x9E, 
+0x65, 0xBF, 0x60, 0x59, 0x52, 0xC2, 0x21, 0xD4, 
+0xF4, 0x7E, 0xAA, 0xD5, 0x0C, 0xA0, 0xE9, 0x27, 
+
+0x8C, 0x0E, 0x35, 0x4F, 0x79, 0x3F, 0x9A, 0xCD, 
+0x2B, 0x98, 0x0D, 0x29, 0xD5, 0x65, 0x3A, 0x5B, 
+0x16, 0xAA, 0x93, 0x4B, 0xC1, 0xD5, 0x89, 0xFF, 
+0x3B, 0x71, 0xFB, 0xAC, 0xF6, 0x1A, 0x80, 0x67, 
+0x52, 0xC5, 0xDE, 0x7C, 0xD0, 0x88, 0xEA, 0xF5, 
+0xCA, 
+
+0x0B, 0x8E, 0x7F, 0x62, 0x1D, 0xF8, 0x6D, 0x2C, 
+0xED, 0xBE, 0x0F, 0x73, 0xC0, 0x20, 0x80, 0xC4, 
+0x3E, 0x14, 0x97, 0x75, 0x5B, 0x66, 0x4E, 0xC5, 
+0xCF, 0x56, 0xBB, 0x66, 0x08, 0xF7, 0x6E, 0xA5, 
+0xAF, 0xFC, 0x35, 0x2A, 0x1E, 0x06, 0xA8, 0x91, 
+0x80, 0x03, 
+
+0x34, 0x55, 0x64, 0x75, 0xB5, 0xF2, 0xEF, 0xF3, 
+0xEE, 0xCA, 0x43, 0x4E, 0x36, 0x24, 0x61, 0x74, 
+0xFE, 0x6E, 0xF8, 0xC5, 0x2B, 0xBE, 0x3F, 0xBB, 
+0xE0, 0x71, 0x00, 0xFD, 0x6F, 0x83, 0x8A, 0x29, 
+0x09, 0x78, 0x45, 0xB8, 0xED, 0x54, 0x1E, 0x14, 
+0x3D, 0x99, 0x49, 
+
+0x1F, 0x15, 0xD2, 0x4F, 0x1D, 0x0B, 0x2B, 0x3F, 
+0x8A, 0x9C, 0x43, 0xB9, 0xA7, 0xFC, 0x1E, 0x3B, 
+0x37, 0x8D, 0x3F, 0x59, 0xF5, 0xA5, 0xFF, 0xD6, 
+0xAE, 0xE9, 0x51, 0x90, 0xE9, 0xDC, 0x46, 0x4F, 
+0xF1, 0x13, 0xB1, 0xA9, 0x9E, 0x1A, 0xE8, 0x4C, 
+0x6E, 0xC8, 0x6F, 0x15, 
+
+0x08, 0x20, 0xFB, 0xD7, 0xE8, 0x55, 0xED, 0x96, 
+0xEC, 0x11, 0x3B, 0xA4, 0x52, 0x3D, 0x4D, 0x7C, 
+0xED, 0x7C, 0x0F, 0xD0, 0xA3, 0x9C, 0x9C, 0x45, 
+0x08, 0xBC, 0x3A, 0x49, 0xE0, 0xDF, 0x64, 0xF4, 
+0x62, 0x1C, 0x4B, 0x6B, 0x55, 0xB9, 0x64, 0xD5, 
+0xB1, 0x07, 0xE2, 0xF5, 0xDC, 
+
+0x8E, 0xBE, 0xA6, 0xC8, 0x60, 0x67, 0xBB, 0xF3, 
+0xEB, 0x02, 0x2F, 0xEF, 0x78, 0x4C, 0x70, 0x5D, 
+0x56, 0xCC, 0x2C, 0x7D, 0xF1, 0x9E, 0x5E, 0x3B, 
+0xB3, 0xA8, 0xA2, 0x47, 0xDF, 0xE0, 0xFA, 0x67, 
+0x1D, 0x2B, 0x63, 0x44, 0x07, 0x8B, 0x81, 0x2C, 
+0xCD, 0xB9, 0xE4, 0xFB, 0x23, 0x6B, 
+
+0x51, 0x23, 0xF1, 0xFC, 0x7B, 0xC1, 0xED, 0x55, 
+0xD7, 0x56, 0x06, 0xCD, 0x0D, 0xD3, 0xCE, 0xFD, 
+0x93, 0x94, 0x88, 0x80, 0x49, 0xCF, 0x1A, 0xCC, 
+0xDC, 0x06, 0xD5, 0x5F, 0xB1, 0x0F, 0x59, 0x6C, 
+0x77, 0xD0, 0x75, 0x55, 0x21, 0x52, 0x93, 0xE4, 
+0xA4, 0x2A, 0x03, 0xD7, 0x28, 0x19, 0x6C, 
+
+0xAB, 0x5A, 0x77, 0x2F, 0xE5, 0x8F, 0x10, 0xB4, 
+0x66, 0xBB, 0x93, 0xE2, 0x82, 0x97, 0x7E, 0x2B, 
+0xE5, 0x69, 0x7A, 0xAC, 0xF9, 0xDF, 0xE3, 0x17, 
+0x4E, 0xAC, 0x0C, 0xC4, 0x6B, 0xFF, 0x29, 0xCE, 
+0xBA, 0xB1, 0x96, 0x45, 0xB6, 0x7F, 0x8B, 0x1D, 
+0x14, 0x49, 0x76, 0x75, 0x7A, 0xA2, 0x16, 0xA2, 
+
+0xBD, 0xEE, 0xA8, 0xFE, 0x8D, 0xE3, 0xB2, 0xB6, 
+0x92, 0xB4, 0xB7, 0x00, 0x5B, 0x59, 0x52, 0x53, 
+0x64, 0xE5, 0x60, 0x19, 0x8B, 0x67, 0x3F, 0xB2, 
+0x06, 0x2D, 0xA8, 0xA7, 0xC1, 0x6A, 0x83, 0xEE, 
+0xB8, 0x8F, 0x0E, 0xD2, 0x46, 0x7B, 0xD4, 0x33, 
+0xD2, 0x08, 0x0A, 0x3B, 0xA2, 0x2E, 0x5C, 0xB6, 
+0xB4, 
+
+0x4B, 0x94, 0xC4, 0x59, 0x93, 0x95, 0xD8, 0xB2, 
+0xCE, 0x8A, 0x89, 0x7F, 0x72, 0x07, 0x22, 0xC3, 
+0x69, 0x49, 0x29, 0x90, 0x05, 0x1F, 0xFF, 0xFF, 
+0x1F, 0x62, 0xA1, 0x89, 0x03, 0x89, 0x10, 0x9C, 
+0x16, 0x3C, 0x25, 0x31, 0x1E, 0xD3, 0x78, 0x9A, 
+0x22, 0x16, 0x46, 0x71, 0xF1, 0x99, 0x8C, 0x2B, 
+0xBF, 0xA0, 
+
+0xCB, 0x95, 0xC1, 0xE9, 0x80, 0x91, 0xE9, 0xE7, 
+0xE6, 0x6B, 0x98, 0x55, 0xD2, 0x3B, 0x37, 0x27, 
+0x13, 0x37, 0x41, 0xBD, 0xA2, 0xD7, 0xB5, 0x49, 
+0xEF, 0x8E, 0xA6, 0x83, 0xB9, 0x13, 0x5F, 0x5E, 
+0x13, 0x08, 0xDB, 0xFB, 0x48, 0x84, 0xD0, 0x3D, 
+0x56, 0xB7, 0x3D, 0xDF, 0xAC, 0x04, 0x14, 0x76, 
+0xB7, 0x3D, 0x62, 
+
+0xBC, 0x89, 0x75, 0x83, 0x82, 0x6D, 0x98, 0x11, 
+0x4E, 0x04, 0x84, 0xC0, 0xCD, 0x93, 0x73, 0x29, 
+0x0E, 0x70, 0x9B, 0x62, 0x7F, 0x88, 0x04, 0xB3, 
+0x2D, 0x3D, 0x5E, 0x95, 0x09, 0x32, 0x95, 0x4A, 
+0x13, 0xA5, 0xCE, 0x44, 0x45, 0x00, 0xF8, 0xF4, 
+0x15, 0x39, 0xE3, 0xA1, 0x2A, 0x97, 0x6F, 0xF4, 
+0x78, 0x1A, 0x03, 0x1C, 
+
+0xD9, 0x04, 0xA6, 0x9F, 0x1E, 0xE5, 0x5C, 0x5F, 
+0xC6, 0x1B, 0xA1, 0x1F, 0xA9, 0x63, 0x1F, 0x0A, 
+0xC1, 0x60, 0x0D, 0x81, 0x35, 0x23, 0xFB, 0xA0, 
+0xA7, 0xD9, 0xA7, 0x16, 0xA2, 0x9C, 0x69, 0x1E, 
+0x96, 0x39, 0x04, 0x8C, 0x52, 0xCA, 0x48, 0xB2, 
+0xC5, 0x82, 0x2E, 0x0C, 0x66, 0x10, 0x11, 0xD3, 
+0xBA, 0xDC, 0x79, 0x26, 0x9E, 
+
+0xCE, 0xBD, 0x1A, 0x2F, 0xCB, 0xF5, 0x8D, 0x8C, 
+0x1C, 0xA2, 0x4F, 0xD8, 0xD5, 0xB9, 0x04, 0x6E, 
+0x77, 0x0E, 0x49, 0xDA, 0x8D, 0x13, 0x33, 0x8F, 
+0x46, 0x73, 0x2D, 0x0A, 0xE6, 0x2E, 0xD1, 0xC8, 
+0x9A, 0xB2, 0xB6, 0xDA, 0xF1, 0x17, 0x59, 0xB6, 
+0x5C, 0x72, 0xC4, 0xFD, 0x18, 0xD4, 0xE1, 0x61, 
+0xDF, 0xF2, 0xE4, 0x78, 0x38, 0x0B, 
+
+0x50, 0xB5, 0x6E, 0xA2, 0x93, 0xBD, 0xCC, 0xA9, 
+0xAD, 0x4C, 0x7E, 0xD7, 0xBE, 0x26, 0xAD, 0x4D, 
+0x2E, 0x25, 0x1A, 0x09, 0xA8, 0x9C, 0x48, 0xF5, 
+0xBB, 0x80, 0x49, 0x90, 0xE5, 0xAD, 0xB4, 0xAB, 
+0x69, 0x6C, 0xF3, 0x7A, 0xB4, 0x53, 0x00, 0x16, 
+0x33, 0xE3, 0x47, 0xAD, 0x86, 0x8D, 0x74, 0xB8, 
+0x76, 0x63, 0x13, 0xE6, 0xED, 0xFA, 0x30, 
+
+0x77, 0x42, 0x89, 0xA2, 0xF2, 0xB8, 0x74, 0x66, 
+0x20, 0xE1, 0x21, 0x14, 0x57, 0xE5, 0xE1, 0x3F, 
+0x36, 0xC4, 0xDA, 0xB7, 0xAA, 0xF0, 0x9E, 0xB9, 
+0xA0, 0xC8, 0x72, 0xDF, 0x22, 0x54, 0x8A, 0xFF, 
+0x28, 0x50, 0x29, 0x5D, 0xF6, 0x42, 0xDE, 0x89, 
+0xEB, 0x0B, 0x0A, 0xB1, 0xEE, 0xB0, 0xD6, 0xA8, 
+0xB8, 0x62, 0xFF, 0xE3, 0x63, 0x7D, 0xC4, 0x79, 
+
+0x03, 0xD9, 0x40, 0x07, 0xE3, 0x5B, 0x56, 0xAD, 
+0x87, 0x74, 0x31, 0xE6, 0xB4, 0x03, 0xD4, 0x48, 
+0x98, 0x3F, 0xB0, 0x56, 0xC6, 0xCC, 0x22, 0xAF, 
+0xCA, 0xF1, 0x23, 0x71, 0x90, 0xCA, 0xE6, 0xC5, 
+0x5C, 0x4A, 0xD3, 0x6C, 0x76, 0xF3, 0xDE, 0xD4, 
+0x56, 0xF9, 0x39, 0xBF, 0xF4, 0x92, 0x41, 0xAD, 
+0x5C, 0x42, 0x73, 0x1C, 0xD7, 0x72, 0x4B, 0x6D, 
+0xC0, 
+
+0x9C, 0xC1, 0xE5, 0x09, 0x81, 0x05, 0x04, 0x41, 
+0xB8, 0x96, 0x9C, 0x4E, 0x0B, 0xED, 0xFC, 0xC8, 
+0x4B, 0xEF, 0x96, 0xEF, 0x58, 0xD6, 0x3B, 0x56, 
+0x37, 0xE4, 0x09, 0x67, 0xD7, 0xB7, 0xC2, 0x5F, 
+0x55, 0x2A, 0xCA, 0x47, 0x59, 0x7A, 0x68, 0xA2, 
+0x08, 0x1A, 0x01, 0xDE, 0xDB, 0x90, 0xC1, 0x50, 
+0x49, 0x40, 0xC3, 0xC6, 0x46, 0x23, 0x99, 0x28, 
+0x1E, 0x73, 
+
+0x80, 0xB6, 0x35, 0x94, 0xB1, 0x74, 0x62, 0xDD, 
+0x35, 0xC1, 0x54, 0x63, 0x87, 0xEA, 0x8B, 0xD6, 
+0x1E, 0xC9, 0xE0, 0xE9, 0x16, 0x60, 0xFE, 0x7B, 
+0x7E, 0xE3, 0x46, 0x6D, 0x11, 0x07, 0xC6, 0x86, 
+0x9C, 0x4E, 0x63, 0x9C, 0x5D, 0x63, 0x45, 0x22, 
+0x1F, 0x2E, 0x16, 0xDA, 0x72, 0x0D, 0x84, 0x7D, 
+0x0B, 0x94, 0x1D, 0x7B, 0x4B, 0x0B, 0xF6, 0xB3, 
+0x41, 0x4A, 0x7E, 
+
+0x62, 0xB4, 0xD9, 0x9B, 0x34, 0x10, 0xE6, 0x0A, 
+0xF6, 0xF9, 0xF1, 0x96, 0x33, 0x26, 0x8E, 0x60, 
+0x34, 0x89, 0xD8, 0xB8, 0x8B, 0xBE, 0x1F, 0x9B, 
+0x6C, 0xF2, 0x9A, 0xF3, 0xD6, 0xB2, 0x1F, 0xD2, 
+0x32, 0x47, 0x1E, 0x25, 0xD8, 0x1A, 0x8B, 0xC9, 
+0x2B, 0x5F, 0x62, 0xF9, 0xB2, 0x9A, 0x5F, 0x87, 
+0xB0, 0xDB, 0xFA, 0xE3, 0x05, 0x3F, 0x9D, 0xF2, 
+0x07, 0x3F, 0x21, 0x49, 
+
+0x4B, 0xFB, 0x26, 0x08, 0x29, 0x33, 0xAB, 0xA5, 
+0x25, 0xA9, 0x95, 0x2A, 0x58, 0x0B, 0x43, 0x40, 
+0xF2, 0xC7, 0x08, 0x79, 0x2F, 0x1A, 0xD0, 0x07, 
+0x1A, 0x70, 0x21, 0x3F, 0x83, 0x5C, 0x37, 0x56, 
+0xAD, 0x00, 0x2D, 0xDB, 0xB1, 0x6C, 0xB3, 0x4F, 
+0x56, 0x57, 0xAD, 0x9E, 0xE1, 0x58, 0x14, 0x36, 
+0x98, 0x56, 0xD4, 0x62, 0xD2, 0xB0, 0x3E, 0x5F, 
+0x55, 0x22, 0xFE, 0x5D, 0xEE, 
+
+0x4F, 0x94, 0x55, 0x3D, 0xE7, 0xA2, 0xF2, 0x2D, 
+0x71, 0x0F, 0x7C, 0xA1, 0x50, 0x55, 0x56, 0x98, 
+0x80, 0xCD, 0xC6, 0x9A, 0xF2, 0x02, 0x67, 0x4B, 
+0xB1, 0x81, 0xC6, 0x9E, 0x49, 0x91, 0x8E, 0x2D, 
+0x56, 0x51, 0xD0, 0xA7, 0xEB, 0x18, 0x16, 0x60, 
+0x8B, 0x9D, 0xE9, 0x87, 0x93, 0x87, 0xE5, 0x81, 
+0xC6, 0x2C, 0x11, 0x07, 0xB1, 0xF2, 0x61, 0x5A, 
+0xA4, 0xC9, 0xA6, 0x11, 0x02, 0x1D, 
+
+0x11, 0x8D, 0x0E, 0xF0, 0xBC, 0xFF, 0xC4, 0x63, 
+0x4D, 0x02, 0x59, 0x06, 0x0B, 0xD3, 0xC8, 0x93, 
+0x4D, 0xA0, 0x59, 0x16, 0xC2, 0xBD, 0x2A, 0xC6, 
+0x15, 0x8B, 0x87, 0x10, 0x35, 0x6C, 0x12, 0x46, 
+0xF3, 0x02, 0x7A, 0xEE, 0x12, 0x39, 0x14, 0x72, 
+0x9D, 0x2E, 0xD1, 0xF7, 0x17, 0x84, 0xBA, 0x87, 
+0x38, 0x22, 0xBA, 0x8F, 0x75, 0xB6, 0x6A, 0xB5, 
+0x7C, 0x16, 0xCC, 0xAE, 0x97, 0x53, 0x09, 
+
+0x4B, 0xF0, 0xF4, 0x7B, 0x8D, 0x67, 0x29, 0x3C, 
+0x15, 0x04, 0x4A, 0x2F, 0xB3, 0x94, 0x1E, 0x93, 
+0xB2, 0x86, 0xE2, 0x81, 0xB4, 0x76, 0xD9, 0xF0, 
+0xF9, 0x1F, 0xD3, 0xA6, 0x01, 0xE7, 0x27, 0x68, 
+0x1A, 0x1F, 0xBA, 0x1B, 0x0E, 0xA3, 0xF9, 0xAC, 
+0xEA, 0xA3, 0xA7, 0xB8, 0xEB, 0x1B, 0xB1, 0xE7, 
+0x7A, 0xB9, 0x36, 0x34, 0xAA, 0x6C, 0x00, 0x18, 
+0xC8, 0x9B, 0xD8, 0x15, 0x3D, 0x41, 0x2A, 0x62, 
+
+0xC6, 0x49, 0x15, 0xA3, 0x0A, 0xC8, 0x28, 0xF0, 
+0x7B, 0x34, 0x10, 0xD7, 0x88, 0x2A, 0x63, 0x56, 
+0xB0, 0xCC, 0x0E, 0xDF, 0x1F, 0xE2, 0x5F, 0xFE, 
+0xEC, 0x13, 0x3D, 0x7E, 0xB2, 0x57, 0x55, 0x19, 
+0xBC, 0xF9, 0xF3, 0x06, 0xEF, 0xF3, 0xFE, 0x1D, 
+0x3C, 0x3A, 0x2F, 0x73, 0xA6, 0x91, 0x3D, 0x4F, 
+0xD8, 0x3D, 0xBD, 0x99, 0x66, 0x16, 0x06, 0x3D, 
+0xB4, 0xC5, 0x8E, 0xE9, 0x8A, 0x5F, 0xB7, 0x60, 
+0x9E, 
+
+0xE8, 0xDA, 0x83, 0xD9, 0x90, 0x9A, 0x3B, 0x5C, 
+0x44, 0x85, 0x27, 0xE6, 0x14, 0x7A, 0x0C, 0xF8, 
+0x21, 0x90, 0xA4, 0xAA, 0x57, 0x2A, 0xD4, 0x67, 
+0xA3, 0xE4, 0x95, 0x7E, 0xA7, 0xF3, 0xA8, 0xA0, 
+0xD6, 0x53, 0xC1, 0x6A, 0xB2, 0x6F, 0x20, 0xEC, 
+0x38, 0xE8, 0x5C, 0xA9, 0x86, 0x0F, 0x54, 0xC9, 
+0x0D, 0x09, 0xFF, 0x05, 0x1E, 0x8C, 0xCC, 0x0E, 
+0x92, 0x08, 0x76, 0xE7, 0x4F, 0xA6, 0x00, 0xC6, 
+0x6B, 0x1B, 
+
+0xE6, 0xB3, 0xDB, 0x95, 0x46, 0x70, 0x8F, 0x1B, 
+0x76, 0xFB, 0x39, 0xF2, 0xA9, 0xB5, 0x68, 0xD9, 
+0x2A, 0xF9, 0xD4, 0xC2, 0xA7, 0xDD, 0x95, 0xF5, 
+0x7A, 0xED, 0x90, 0x6E, 0xB2, 0xCC, 0x1C, 0x0C, 
+0xA6, 0xBC, 0x4B, 0xDC, 0x8C, 0x9D, 0x0C, 0xD2, 
+0x7B, 0x7C, 0x21, 0xCE, 0xC6, 0xC4, 0xC7, 0xC6, 
+0x35, 0xEB, 0x62, 0x03, 0x0B, 0xEF, 0x5C, 0x0E, 
+0x5D, 0xB7, 0xAC, 0xBB, 0x5C, 0x3E, 0x2F, 0x90, 
+0x1F, 0x24, 0x8D, 
+
+0xFD, 0xD7, 0x30, 0x62, 0x23, 0xBD, 0xB1, 0xFB, 
+0x64, 0xFF, 0x4D, 0x59, 0x09, 0xA2, 0xBB, 0x2B, 
+0xE7, 0x37, 0xFF, 0x92, 0xF5, 0xC3, 0xE7, 0x96, 
+0x04, 0x9A, 0xCB, 0x5E, 0x1D, 0xE9, 0xFA, 0xA9, 
+0xB1, 0x41, 0xF4, 0xE9, 0x17, 0xB5, 0x2A, 0xF2, 
+0x82, 0x72, 0x8D, 0xA0, 0x86, 0x88, 0xEF, 0x44, 
+0x5F, 0x66, 0x00, 0x76, 0x85, 0xC3, 0x4E, 0x15, 
+0xD7, 0xEB, 0x8C, 0x95, 0xDD, 0xA0, 0x57, 0x7A, 
+0xF4, 0xCA, 0xA7, 0xBF, 
+
+0xC7, 0xAB, 0x7B, 0x60, 0x42, 0x31, 0xB6, 0x90, 
+0xE1, 0xFA, 0x60, 0xE2, 0xFD, 0x62, 0xEF, 0xEA, 
+0x56, 0x73, 0xB4, 0xB2, 0xEE, 0xCA, 0xD5, 0x7E, 
+0xB9, 0xFC, 0x0C, 0xFC, 0xBC, 0x2B, 0x0B, 0xBF, 
+0xC0, 0xD4, 0x39, 0x3D, 0x2F, 0xD8, 0x05, 0x4C, 
+0x52, 0xC0, 0xC8, 0xBE, 0x5A, 0x5D, 0xA4, 0xAD, 
+0x26, 0x57, 0x36, 0xEE, 0x6B, 0x90, 0x28, 0x4C, 
+0x75, 0xE8, 0x9E, 0x01, 0x3F, 0x22, 0xA2, 0xBB, 
+0x90, 0xEE, 0x7E, 0xB6, 0xF3, 
+
+0x13, 0x8B, 0xD7, 0x2A, 0xC9, 0x6A, 0x29, 0x7A, 
+0xE2, 0x78, 0xE8, 0xCF, 0x67, 0x9B, 0x37, 0x16, 
+0x3C, 0xCF, 0x8E, 0x33, 0x67, 0x5A, 0xBB, 0xE9, 
+0x47, 0x29, 0x9B, 0xF4, 0xE0, 0xE1, 0x24, 0x50, 
+0x16, 0x98, 0xF5, 0x54, 0x0E, 0xD4, 0xA5, 0xEC, 
+0x1F, 0x90, 0xE3, 0xE0, 0x50, 0xBC, 0x55, 0x2F, 
+0x46, 0x9A, 0x81, 0xD2, 0xA7, 0xAE, 0xBE, 0xCF, 
+0x37, 0x25, 0xE1, 0x93, 0x9F, 0x87, 0xB6, 0xCD, 
+0xD3, 0xFD, 0xEA, 0x41, 0x76, 0x99, 
+
+0x38, 0x74, 0xF9, 0xEE, 0xE2, 0xD3, 0x30, 0xA4, 
+0xE4, 0x46, 0x7D, 0xC8, 0x10, 0x7F, 0xDF, 0xDA, 
+0x3D, 0x64, 0xF3, 0xDD, 0xBF, 0x33, 0x74, 0x2A, 
+0x6A, 0x22, 0xBD, 0x3F, 0x55, 0xD4, 0xFE, 0xD3, 
+0x47, 0x80, 0x7C, 0x69, 0x2C, 0x9E, 0x07, 0xD4, 
+0x73, 0x79, 0x56, 0xFF, 0xB3, 0x1F, 0xF3, 0x89, 
+0xF7, 0x29, 0xDA, 0x2C, 0x9B, 0x0B, 0x0B, 0x22, 
+0xC9, 0x73, 0x28, 0x44, 0xB8, 0x21, 0x63, 0xB4, 
+0xC5, 0x6A, 0x23, 0x21, 0x3C, 0xAF, 0x4A, 
+
+0x9A, 0xD7, 0xCF, 0xEE, 0xDC, 0x49, 0xDC, 0xE8, 
+0x06, 0x1A, 0xD9, 0x23, 0xAD, 0x4B, 0xBA, 0xEA, 
+0xA1, 0x9E, 0x5A, 0x84, 0xEB, 0x87, 0x8F, 0x43, 
+0xCE, 0x96, 0x19, 0x9E, 0x6F, 0xE6, 0x06, 0xBA, 
+0x19, 0xA8, 0x46, 0x41, 0x17, 0xA7, 0x5E, 0xEC, 
+0x3C, 0xAD, 0xC7, 0x5B, 0xEF, 0xAD, 0xCB, 0xD1, 
+0x59, 0xDF, 0xF4, 0xB4, 0xBD, 0x80, 0x5C, 0xCB, 
+0x42, 0x15, 0x42, 0x76, 0x0D, 0xD5, 0x9F, 0x9E, 
+0x8B, 0x4B, 0x24, 0x17, 0xC2, 0xDD, 0x1C, 0xD4, 
+
+0x21, 0x35, 0x8B, 0xF5, 0x7C, 0x72, 0xEB, 0xA3, 
+0x85, 0x75, 0x0B, 0xAA, 0xF5, 0xB5, 0x9C, 0xA2, 
+0x57, 0x41, 0xE3, 0x2F, 0x5B, 0xDF, 0x1A, 0x7B, 
+0xB5, 0x6B, 0x3C, 0xA0, 0xF2, 0xFC, 0x04, 0xD9, 
+0x03, 0xD7, 0xE3, 0x5A, 0xA5, 0xAF, 0x3B, 0xF8, 
+0xE6, 0x9F, 0x72, 0x0A, 0x32, 0x03, 0x6C, 0x59, 
+0x73, 0xEE, 0xDD, 0x53, 0xFB, 0x37, 0x48, 0x03, 
+0x62, 0x2F, 0x24, 0x99, 0xE9, 0xD1, 0x7D, 0x9E, 
+0xD4, 0x4F, 0x9C, 0x3F, 0x2A, 0x69, 0xD6, 0xAF, 
+0x13, 
+
+0x4A, 0xAA, 0xC2, 0xF5, 0x5F, 0xA5, 0x76, 0xB8, 
+0xDC, 0x42, 0xEE, 0xA8, 0xD6, 0xCD, 0xE2, 0xC8, 
+0xD4, 0x9D, 0x88, 0x64, 0x1D, 0x88, 0x11, 0xBE, 
+0x46, 0xC3, 0x97, 0xBA, 0x12, 0xE6, 0xB8, 0x07, 
+0xB1, 0x40, 0x7C, 0x41, 0x4C, 0x54, 0x36, 0x95, 
+0x3A, 0xCB, 0x13, 0x11, 0xD6, 0xCB, 0xDF, 0xE7, 
+0x2F, 0x8E, 0x86, 0x6B, 0xF3, 0x54, 0xD8, 0x9D, 
+0x76, 0x5B, 0x99, 0x15, 0xE8, 0x7D, 0x81, 0x03, 
+0xA8, 0x22, 0xBA, 0x49, 0xD4, 0x58, 0x19, 0x97, 
+0xED, 0x77, 
+
+0xC8, 0x9A, 0x4B, 0x76, 0x64, 0x44, 0x51, 0x22, 
+0xE6, 0xC0, 0xA6, 0x47, 0xD7, 0x7D, 0x78, 0xC1, 
+0x8F, 0xA5, 0x7E, 0x30, 0x05, 0xA7, 0x66, 0xDE, 
+0x64, 0x23, 0xE3, 0xE9, 0xD9, 0x02, 0x12, 0xEE, 
+0x51, 0xA9, 0x3A, 0x1E, 0xD8, 0xAA, 0xE3, 0x4F, 
+0x97, 0x37, 0x23, 0x64, 0xB2, 0xBC, 0x05, 0x3F, 
+0x84, 0x98, 0x05, 0x3C, 0x2E, 0xE5, 0x09, 0x69, 
+0xFD, 0x70, 0x13, 0x01, 0x17, 0x3D, 0xC9, 0xE2, 
+0xDF, 0x14, 0xEA, 0x7E, 0xA7, 0x5E, 0xBC, 0xC1, 
+0x41, 0xF5, 0x37, 
+
+0xC4, 0x11, 0x3E, 0xE5, 0x6A, 0xB5, 0xB6, 0xEE, 
+0x2B, 0x9A, 0x9F, 0x70, 0xA0, 0xF2, 0x80, 0xAE, 
+0xB2, 0xD0, 0xF4, 0xA8, 0x9E, 0x4B, 0x38, 0x55, 
+0xEA, 0x83, 0x1B, 0x5E, 0x0D, 0x41, 0xC3, 0x0F, 
+0x42, 0xDF, 0xF0, 0x4C, 0x99, 0x6B, 0x68, 0x89, 
+0xD4, 0xBA, 0xC9, 0x2A, 0x57, 0x58, 0xFF, 0x39, 
+0x11, 0xBB, 0x77, 0xBD, 0x05, 0x71, 0x74, 0x87, 
+0xB4, 0x65, 0xDD, 0x55, 0x84, 0x99, 0xC4, 0xFA, 
+0xB5, 0x36, 0x6C, 0xE4, 0x90, 0x5B, 0x1B, 0x20, 
+0x94, 0x56, 0x9F, 0x6E, 
+
+0x0C, 0xC3, 0xBD, 0x0E, 0x35, 0x70, 0xCF, 0x44, 
+0x28, 0x15, 0x09, 0x07, 0x96, 0xDA, 0xA6, 0xDC, 
+0x58, 0x3C, 0xA3, 0x5E, 0xDD, 0x0F, 0xCA, 0x7F, 
+0x49, 0x7A, 0x13, 0x79, 0x86, 0x87, 0x97, 0x9F, 
+0xCD, 0x17, 0xBB, 0x5F, 0x7F, 0xCA, 0x8B, 0x2B, 
+0xBB, 0xBA, 0x88, 0xDA, 0xFF, 0xBE, 0x63, 0x78, 
+0xC7, 0x1B, 0xC2, 0x66, 0x5D, 0x19, 0x86, 0xAF, 
+0xAA, 0x16, 0x41, 0x46, 0x06, 0x4F, 0xE7, 0x29, 
+0x2D, 0x0A, 0xEE, 0xCD, 0x63, 0x08, 0x2C, 0x36, 
+0x49, 0xFA, 0xCC, 0x5C, 0x34, 
+
+0x09, 0x6C, 0xD3, 0x46, 0xF5, 0x92, 0x65, 0xE3, 
+0x56, 0x44, 0xE3, 0x0F, 0xCD, 0x6C, 0x16, 0x2E, 
+0xDB, 0x1D, 0x6C, 0x7F, 0x22, 0x52, 0x77, 0xC6, 
+0x86, 0x68, 0xA4, 0xD1, 0x1E, 0xC0, 0x52, 0x67, 
+0x15, 0x23, 0x13, 0xFC, 0xA2, 0x07, 0x8B, 0x6F, 
+0x9D, 0x5F, 0x4E, 0xF5, 0xF0, 0x06, 0xF1, 0x07, 
+0x4D, 0x11, 0x55, 0x8D, 0x91, 0x41, 0x1B, 0xE1, 
+0xBC, 0x9A, 0xDC, 0x48, 0x5D, 0x08, 0x8C, 0x64, 
+0x23, 0xCE, 0x55, 0xE9, 0x9D, 0xBB, 0xEC, 0x02, 
+0x09, 0x21, 0x79, 0x0D, 0xBC, 0xF7, 
+
+0x85, 0xCB, 0x0F, 0x76, 0x18, 0x9E, 0x84, 0xFF, 
+0x9A, 0x41, 0x6C, 0x03, 0x49, 0x25, 0xA3, 0x1C, 
+0x73, 0x94, 0x59, 0x23, 0xA6, 0x20, 0x82, 0x06, 
+0x05, 0x0B, 0x6F, 0xFF, 0x1F, 0xF3, 0xFF, 0xBD, 
+0x95, 0x42, 0xD6, 0x94, 0xAA, 0x55, 0x13, 0x89, 
+0x2A, 0x7C, 0xED, 0x36, 0x3D, 0xA3, 0x38, 0x04, 
+0x25, 0x90, 0xF7, 0x31, 0x16, 0xC7, 0x3D, 0xDF, 
+0x9B, 0xB1, 0x23, 0x7D, 0xF1, 0xE2, 0xD6, 0x61, 
+0x34, 0x03, 0x94, 0xDA, 0xFE, 0x95, 0xC2, 0x11, 
+0x62, 0x95, 0xB8, 0x61, 0x66, 0xF7, 0x04, 
+
+0x04, 0xAF, 0x10, 0xDE, 0x94, 0xE1, 0x15, 0x00, 
+0x00, 0xF5, 0x99, 0x1D, 0xC2, 0xEE, 0xE6, 0x89, 
+0x6E, 0x04, 0xF2, 0x1C, 0xC5, 0xC9, 0xA9, 0xD6, 
+0x16, 0xDD, 0xC4, 0xAB, 0xED, 0x16, 0xDA, 0x28, 
+0x9D, 0x5B, 0x33, 0x59, 0x95, 0xBC, 0x35, 0x49, 
+0x64, 0xD1, 0xA9, 0xAA, 0x72, 0x60, 0x6A, 0x90, 
+0x2A, 0xEE, 0xB1, 0x32, 0x8D, 0xD6, 0x9C, 0x70, 
+0x57, 0xBB, 0xBA, 0xEC, 0xEB, 0x11, 0x60, 0x8A, 
+0xD0, 0xB1, 0x2A, 0x20, 0x4F, 0xA2, 0x25, 0x7A, 
+0xFA, 0x22, 0x43, 0xEE, 0xF8, 0x4B, 0xFC, 0xA6, 
+
+0x20, 0x7F, 0x82, 0x2B, 0x6C, 0x0C, 0x68, 0xCA, 
+0x1D, 0x6D, 0x29, 0x07, 0x94, 0x2F, 0xC1, 0x01, 
+0x9F, 0xA7, 0xF2, 0x5E, 0x8C, 0xE2, 0x1E, 0xA7, 
+0xD0, 0x78, 0xF8, 0xEB, 0x0C, 0x2B, 0xA3, 0xCF, 
+0x21, 0x9E, 0x2A, 0x38, 0x6B, 0x61, 0xAC, 0x37, 
+0x1D, 0x53, 0x56, 0x06, 0x05, 0x8A, 0xC4, 0x95, 
+0xF3, 0x97, 0x19, 0xF0, 0xF2, 0xD0, 0x8E, 0x1A, 
+0x73, 0xB9, 0x24, 0xE6, 0x83, 0xE3, 0x72, 0x8A, 
+0xD3, 0x95, 0xCF, 0x11, 0xFC, 0x55, 0x6B, 0x9E, 
+0xCA, 0x05, 0x88, 0xC5, 0x9E, 0x48, 0x33, 0x9F, 
+0x95, 
+
+0x53, 0x09, 0x96, 0x12, 0xF3, 0x72, 0x4E, 0x28, 
+0x9B, 0x9D, 0xB3, 0x5E, 0x4D, 0x26, 0x6E, 0xCC, 
+0x45, 0xA4, 0xD0, 0xF4, 0x07, 0xAC, 0xDF, 0x27, 
+0x7D, 0x1F, 0xA4, 0x89, 0x73, 0xF1, 0xBD, 0x30, 
+0x0C, 0xB9, 0x1B, 0x23, 0x8F, 0x06, 0x01, 0x1A, 
+0x9D, 0xE0, 0xE9, 0x88, 0xBC, 0x96, 0xB0, 0xF4, 
+0x67, 0xE8, 0x29, 0x86, 0x1E, 0xAA, 0xC0, 0xE5, 
+0x39, 0x3C, 0x39, 0x41, 0xED, 0x6C, 0x46, 0x4F, 
+0x4B, 0x69, 0xE4, 0x23, 0xBC, 0xF7, 0xBB, 0x33, 
+0x47, 0x3C, 0x28, 0x7E, 0xC4, 0xFD, 0xC4, 0xD3, 
+0x5A, 0xA8, 
+
+0xB6, 0x9D, 0xDC, 0xA4, 0x64, 0xFA, 0x2B, 0xDC, 
+0x70, 0x5B, 0x7A, 0xD7, 0x93, 0x62, 0xD5, 0x8D, 
+0x64, 0x8E, 0xE1, 0xFC, 0x53, 0x77, 0x07, 0xC7, 
+0x1F, 0x46, 0x31, 0xA7, 0x22, 0xC4, 0x70, 0x69, 
+0x24, 0x7B, 0xF5, 0x3C, 0xA4, 0x95, 0x0E, 0xF7, 
+0xC2, 0xDD, 0xED, 0xAC, 0x66, 0x64, 0x50, 0x30, 
+0x74, 0x1D, 0x9F, 0xDE, 0xF5, 0x7F, 0x00, 0x7C, 
+0x22, 0xF2, 0x3F, 0x89, 0x8A, 0x8A, 0x5C, 0x2A, 
+0xBD, 0xEF, 0x86, 0xA8, 0xD9, 0x12, 0xF2, 0xEA, 
+0x0B, 0xE7, 0x51, 0xAF, 0xFC, 0x97, 0x8C, 0xB7, 
+0xD7, 0xD5, 0x42, 
+
+0x58, 0x97, 0xB5, 0x97, 0xAF, 0xEB, 0x4E, 0x3E, 
+0x49, 0x74, 0x5F, 0x63, 0x41, 0xDF, 0x96, 0x8D, 
+0x77, 0xF6, 0x3B, 0x49, 0xC9, 0x14, 0xC2, 0xD7, 
+0x67, 0x0C, 0xB1, 0x93, 0xB0, 0xE7, 0x30, 0x78, 
+0x20, 0x4D, 0x49, 0xAD, 0x8E, 0x2B, 0xC2, 0x09, 
+0x38, 0xDC, 0x9F, 0xD0, 0x65, 0x46, 0x26, 0x99, 
+0x12, 0x07, 0x1D, 0x02, 0x75, 0x4D, 0x3D, 0x2A, 
+0x23, 0x41, 0x9D, 0xB8, 0x0F, 0xB1, 0x60, 0xC9, 
+0x79, 0x6C, 0x36, 0x1E, 0xAD, 0x29, 0xBC, 0xED, 
+0xC4, 0xBA, 0x9D, 0x11, 0x52, 0x72, 0x4A, 0x6E, 
+0xFA, 0x8A, 0xCE, 0x39, 
+
+0xEE, 0xE2, 0x1A, 0xC1, 0x5E, 0x98, 0xA8, 0x91, 
+0x8A, 0x81, 0xAD, 0x06, 0x8C, 0xD6, 0xDA, 0xEF, 
+0xBF, 0x39, 0xBC, 0xC8, 0xFE, 0x75, 0x01, 0x26, 
+0xD7, 0x1B, 0x9D, 0x66, 0x57, 0x83, 0x89, 0x78, 
+0xB8, 0x80, 0x34, 0x1B, 0xD3, 0xB6, 0xCA, 0x63, 
+0x41, 0x1B, 0x6A, 0xD6, 0x82, 0x74, 0x67, 0x77, 
+0x37, 0x3F, 0x9C, 0xEB, 0xC6, 0x55, 0x24, 0x04, 
+0x92, 0x90, 0x10, 0x86, 0x69, 0x48, 0x77, 0x49, 
+0xC4, 0xF3, 0xAE, 0x0C, 0xCA, 0xFB, 0x73, 0xB9, 
+0x17, 0x22, 0x7D, 0x57, 0xF5, 0xE0, 0x87, 0x9C, 
+0x10, 0xF7, 0xAE, 0xA7, 0xF2, 
+
+}
+return kat[i:j]
+}
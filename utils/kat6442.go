@@ -0,0 +1,5478 @@
+package utils
+func getkat6442(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x2A, 0xB0, 0xBF, 0x74, 0xBC, 0xDD, 0x78, 0x9E, 
+0xB0, 0x56, 0x5E, 0x9C, 0x77, 0x81, 0x01, 0xF6, 
+0x6B, 0x93, 0xAC, 0x97, 0x68, 0x74, 0x3D, 0x1A, 
+0x48, 0x1D, 0x26, 0x49, 0x30, 0xFB, 0x62, 0xFD, 
+
+0x28, 0xCB, 0x39, 0xF8, 0x53, 0xAF, 0x51, 0x24, 
+0x6B, 0x0B, 0x56, 0xF7, 0x51, 0xBC, 0x0A, 0xAC, 
+0xC4, 0x06, 0xE6, 0x82, 0x46, 0xAA, 0xE2, 0xE0, 
+0xE3, 0xBC, 0x05, 0x7F, 0xC1, 0x4B, 0xD3, 0x4C, 
+0xBF, 
+
+0x8E, 0x63, 0xDB, 0xDB, 0xAA, 0xFD, 0x64, 0x35, 
+0xEA, 0xA7, 0xF3, 0x39, 0x09, 0x60, 0x43, 0x4D, 
+0xB5, 0x41, 0x63, 0xCC, 0x3D, 0x1A, 0x19, 0x68, 
+0x3C, 0x41, 0xC4, 0xBD, 0xFE, 0x24, 0x79, 0x3E, 
+0x06, 0x59, 
+
+0x4F, 0x43, 0xE1, 0xC1, 0x4A, 0x27, 0xE7, 0x42, 
+0x13, 0x75, 0xF8, 0x2E, 0xD3, 0xC8, 0xBB, 0xCE, 
+0x08, 0x8E, 0x71, 0x9C, 0x47, 0xB1, 0xB1, 0x75, 
+0x0D, 0x0D, 0xED, 0x3D, 0x1F, 0xA7, 0x8E, 0xC3, 
+0x24, 0x95, 0x19, 
+
+0x22, 0x3E, 0x99, 0x38, 0x31, 0xF7, 0xDE, 0xAA, 
+0x9C, 0xF3, 0x8D, 0xC1, 0xD4, 0xEA, 0xA5, 0x76, 
+0xD5, 0x7C, 0xE9, 0x51, 0xA4, 0x27, 0xBD, 0x69, 
+0x53, 0xE4, 0xBD, 0x40, 0x88, 0xF6, 0xB6, 0x16, 
+0x17, 0xE5, 0x85, 0xFA, 
+
+0x49, 0x9D, 0x8A, 0x8D, 0x35, 0x3E, 0xE0, 0x73, 
+0x81, 0xCE, 0xC6, 0xCD, 0xE8, 0x97, 0x1E, 0x2E, 
+0x35, 0x5C, 0x76, 0x9C, 0x34, 0x0A, 0x52, 0xB9, 
+0x7D, 0x49, 0x5B, 0xF0, 0xE1, 0x19, 0x56, 0x54, 
+0x69, 0x1F, 0x18, 0x47, 0xEE, 
+
+0xAF, 0x71, 0xB2, 0x33, 0x6B, 0x4D, 0x04, 0x49, 
+0x8C, 0x02, 0x1F, 0x4C, 0x44, 0xD6, 0xD6, 0x11, 
+0xC2, 0x15, 0x7C, 0x00, 0x83, 0x45, 0x78, 0xC5, 
+0x57, 0x43, 0x4F, 0x2E, 0xE4, 0x36, 0xDE, 0x22, 
+0x47, 0x6F, 0x54, 0x85, 0xF9, 0x3C, 
+
+0xE1, 0xB9, 0xD2, 0xF0, 0xBE, 0x0B, 0x55, 0xED, 
+0x58, 0xAC, 0x1B, 0x10, 0x31, 0x75, 0x75, 0xBD, 
+0xA0, 0xF4, 0x38, 0xA9, 0x73, 0x9F, 0xD1, 0x74, 
+0x62, 0xB9, 0xC5, 0x88, 0x7B, 0xE7, 0x03, 0xAC, 
+0x2D, 0x26, 0xC1, 0x54, 0xBA, 0x06, 0x42, 
+
+0x55, 0x09, 0xDA, 0x24, 0x6E, 0x6C, 0x9E, 0x6E, 
+0x28, 0xDB, 0xA6, 0xE0, 0x45, 0x9B, 0x20, 0x25, 
+0x28, 0xC8, 0x64, 0x31, 0x20, 0xA0, 0xCE, 0x75, 
+0x82, 0x1B, 0x90, 0x5F, 0x8D, 0x1F, 0x4F, 0x2A, 
+0xCE, 0xCD, 0x2A, 0xA1, 0x59, 0x19, 0x49, 0x47, 
+
+0xDD, 0xA6, 0xC1, 0xBB, 0x67, 0xEB, 0xDB, 0x4D, 
+0x65, 0x92, 0xA1, 0x58, 0xD4, 0xDB, 0x77, 0x58, 
+0x0A, 0x7B, 0xC7, 0x0C, 0xBD, 0x07, 0xB9, 0x33, 
+0xC4, 0x5B, 0xEA, 0x8B, 0xFF, 0x8E, 0x26, 0x5F, 
+0xC3, 0x20, 0x90, 0xB6, 0x56, 0x3B, 0xE8, 0xF4, 
+0x13, 
+
+0xB8, 0x7E, 0xF3, 0x26, 0xA0, 0xF7, 0xCF, 0xAC, 
+0x29, 0x79, 0xFF, 0x6D, 0x3D, 0x2A, 0xC8, 0x33, 
+0xE7, 0x7D, 0x68, 0xEF, 0x10, 0x62, 0x24, 0x7D, 
+0xCE, 0x7A, 0xEE, 0x10, 0x14, 0xCF, 0x4E, 0xEF, 
+0x67, 0x83, 0x01, 0x26, 0x20, 0x38, 0x3D, 0x0C, 
+0xDA, 0xBB, 
+
+0xB1, 0x95, 0xB9, 0x17, 0xD8, 0x19, 0xD1, 0xF3, 
+0x44, 0x67, 0x39, 0x7F, 0x03, 0x64, 0x63, 0x7D, 
+0x25, 0x8B, 0x87, 0x56, 0x8D, 0xF1, 0x2A, 0x2B, 
+0x71, 0x06, 0xBB, 0x77, 0x65, 0x1E, 0xDC, 0xDA, 
+0x6F, 0x63, 0xD7, 0x2B, 0xDC, 0xFB, 0x3A, 0x61, 
+0x24, 0xEB, 0x22, 
+
+0x14, 0xE7, 0x51, 0x12, 0xC2, 0xFB, 0xB0, 0xD6, 
+0x60, 0x0A, 0xF4, 0x65, 0x15, 0x6F, 0xF5, 0x32, 
+0x9E, 0xB4, 0x08, 0xC9, 0x69, 0x29, 0x1E, 0x8F, 
+0x9F, 0x80, 0x12, 0x81, 0x4C, 0x9D, 0x42, 0x9C, 
+0xCE, 0xB1, 0xA1, 0x39, 0x74, 0x3B, 0x1F, 0x58, 
+0x11, 0x26, 0x7E, 0x1C, 
+
+0xE0, 0xC7, 0x5F, 0xFA, 0x22, 0x6B, 0xD3, 0xCC, 
+0x15, 0x4C, 0xB7, 0x3E, 0x2E, 0xA7, 0x83, 0x67, 
+0x12, 0x78, 0x78, 0x73, 0xCF, 0x25, 0x83, 0xD8, 
+0xF1, 0xEA, 0x9D, 0x9E, 0xC0, 0xC3, 0x3C, 0x0C, 
+0xBE, 0x33, 0x76, 0x47, 0x70, 0x48, 0x74, 0x24, 
+0x59, 0xD9, 0xEC, 0xE5, 0x9E, 
+
+0xD1, 0x67, 0x05, 0x0E, 0xA8, 0xBC, 0x60, 0x44, 
+0xF3, 0xE7, 0x4F, 0x20, 0xF8, 0xEC, 0x69, 0x3B, 
+0x29, 0x23, 0x0A, 0x46, 0x64, 0xDC, 0xBB, 0x67, 
+0x98, 0x3B, 0x19, 0xEA, 0x99, 0x5E, 0xD8, 0x2C, 
+0x00, 0x17, 0x5A, 0xB5, 0xA9, 0xC6, 0x02, 0x24, 
+0x8F, 0xD4, 0x93, 0x47, 0xE5, 0xF3, 
+
+0xA8, 0xD0, 0x92, 0xBC, 0x58, 0x4E, 0x4B, 0xC8, 
+0xEB, 0x8D, 0xDB, 0x5F, 0x3D, 0xB2, 0xF6, 0x5F, 
+0xF8, 0xD2, 0x45, 0x48, 0x55, 0x18, 0x92, 0x31, 
+0xB7, 0xD5, 0x1F, 0x48, 0xFA, 0x76, 0xC6, 0x36, 
+0x03, 0x78, 0xC0, 0xEC, 0x66, 0xA1, 0x11, 0x08, 
+0x1B, 0xA7, 0x37, 0x7A, 0x98, 0x1B, 0x75, 
+
+0x93, 0x42, 0x10, 0xC2, 0x4A, 0xD4, 0xCF, 0x92, 
+0x52, 0x13, 0x48, 0xB6, 0x43, 0xFD, 0x82, 0x38, 
+0x46, 0x7B, 0x6E, 0xFC, 0x43, 0x47, 0x32, 0x57, 
+0xF0, 0x94, 0x62, 0x5A, 0xA5, 0x2A, 0x12, 0x40, 
+0x66, 0x50, 0x1D, 0xE0, 0x5E, 0x49, 0x40, 0x70, 
+0x6F, 0xC4, 0x6C, 0xC9, 0x27, 0x28, 0x5C, 0xBA, 
+
+0x2B, 0xFC, 0x34, 0xA0, 0xE8, 0xC3, 0x87, 0x60, 
+0x3B, 0x79, 0x15, 0x0D, 0xF4, 0x12, 0xF3, 0xFB, 
+0x59, 0x24, 0xC3, 0x73, 0x44, 0xAD, 0xE8, 0x04, 
+0x65, 0x56, 0xAA, 0xC2, 0x29, 0x68, 0x01, 0xBE, 
+0xCE, 0x67, 0x4C, 0x26, 0x9C, 0x75, 0xA1, 0x34, 
+0x48, 0x60, 0xF6, 0x15, 0x1F, 0x6D, 0x11, 0x4A, 
+0x11, 
+
+0x43, 0x86, 0xF0, 0x5E, 0x4E, 0x01, 0xF3, 0x00, 
+0x44, 0x8E, 0xA4, 0xFA, 0x3A, 0x26, 0x96, 0xD3, 
+0x40, 0x65, 0x7F, 0xDE, 0x17, 0x37, 0x5F, 0xD7, 
+0x09, 0xC6, 0x51, 0x08, 0x3A, 0x9C, 0x78, 0x08, 
+0xB3, 0xA4, 0x26, 0x78, 0xDB, 0xB0, 0xC0, 0xFE, 
+0x0E, 0x2D, 0xC5, 0xD6, 0xDC, 0x5F, 0xDA, 0x7D, 
+0x1E, 0x59, 
+
+0xEB, 0xCE, 0xFD, 0x6D, 0x11, 0x0A, 0x4A, 0x3A, 
+0xCF, 0x07, 0xF8, 0xA0, 0x7D, 0x2D, 0x43, 0xCA, 
+0x66, 0xBA, 0x28, 0xBD, 0xB5, 0x46, 0x8F, 0xB5, 
+0x55, 0x35, 0x23, 0xBD, 0x52, 0x7B, 0x1E, 0xA5, 
+0x51, 0xF0, 0xE2, 0xB3, 0x4E, 0xF1, 0x0E, 0xD7, 
+0x7A, 0x3A, 0xC5, 0xB3, 0x31, 0x50, 0xBB, 0xDF, 
+0x91, 0x72, 0x9E, 
+
+0xFA, 0x11, 0x8D, 0xBF, 0x86, 0x3E, 0x15, 0xE2, 
+0x14, 0x51, 0x7C, 0xB0, 0x7D, 0x63, 0xC6, 0x01, 
+0x42, 0xEC, 0x39, 0x20, 0x46, 0x0A, 0x15, 0xF6, 
+0xBD, 0xB3, 0xC3, 0xEC, 0xE1, 0xED, 0xBD, 0x65, 
+0x9E, 0xE6, 0xC2, 0x44, 0x17, 0x3B, 0x89, 0x24, 
+0x69, 0x21, 0x1F, 0x01, 0xCB, 0x35, 0x8D, 0x23, 
+0x11, 0xAC, 0x73, 0x1C, 
+
+0xF9, 0xC9, 0x51, 0xBC, 0x04, 0x4B, 0xE4, 0x7D, 
+0x20, 0x8C, 0xAC, 0x95, 0xFC, 0x52, 0xDA, 0xD0, 
+0xBA, 0xFF, 0x56, 0xD8, 0x9C, 0x99, 0x60, 0x91, 
+0x2E, 0xDE, 0x10, 0xA5, 0x0D, 0x6C, 0x81, 0x76, 
+0x3A, 0xE1, 0x53, 0xFA, 0xE3, 0x62, 0x25, 0x30, 
+0xF8, 0xF5, 0xC0, 0xC6, 0xAD, 0x67, 0xAB, 0x95, 
+0x81, 0x5C, 0xC3, 0x4D, 0x61, 
+
+0x7C, 0x4C, 0x55, 0x31, 0x0B, 0xCE, 0x0F, 0x66, 
+0x5D, 0x63, 0xBB, 0x51, 0xB5, 0x9D, 0xE1, 0x43, 
+0x54, 0x8D, 0x02, 0xFA, 0xA7, 0xA3, 0x6B, 0x16, 
+0x85, 0xFA, 0x2B, 0x5E, 0x2C, 0xA3, 0x06, 0xD6, 
+0xCF, 0xB4, 0xB4, 0x4B, 0x40, 0xAC, 0x31, 0x8F, 
+0x3B, 0xFB, 0xBB, 0x58, 0xCA, 0x3A, 0x51, 0x2E, 
+0x5C, 0x9D, 0x31, 0x18, 0x11, 0x64, 
+
+0xE6, 0x0B, 0x34, 0x2A, 0xB4, 0x51, 0xA4, 0x55, 
+0xD9, 0x49, 0x36, 0x8A, 0x3F, 0x5A, 0xFD, 0x22, 
+0xFD, 0x38, 0x2F, 0x3A, 0x3E, 0x14, 0xCC, 0xD2, 
+0x14, 0xB3, 0x81, 0x59, 0xD0, 0xA0, 0xE7, 0x7C, 
+0x2B, 0x56, 0x1D, 0x22, 0xFA, 0x72, 0x44, 0x67, 
+0x0F, 0x35, 0xB2, 0x40, 0x62, 0x6F, 0x41, 0x86, 
+0x09, 0xE7, 0x11, 0x5D, 0x81, 0x78, 0x7C, 
+
+0x34, 0x79, 0xA9, 0xF9, 0xC7, 0x59, 0x08, 0x4B, 
+0x4C, 0x9F, 0x32, 0x91, 0x76, 0x32, 0x8D, 0xC2, 
+0xE5, 0xA1, 0xDF, 0x38, 0xD2, 0x3A, 0x8F, 0x51, 
+0x29, 0x86, 0x0F, 0xE7, 0x54, 0x60, 0x10, 0x03, 
+0xBF, 0x29, 0x1B, 0x51, 0x2D, 0x16, 0x9A, 0xE1, 
+0x00, 0x79, 0x6A, 0x5C, 0xD9, 0xCB, 0x34, 0x76, 
+0xC7, 0xFC, 0x8C, 0xF2, 0x01, 0xDD, 0xFF, 0x1C, 
+
+0xDB, 0x12, 0x52, 0x29, 0xD9, 0x9E, 0xE4, 0xA8, 
+0x88, 0x53, 0x1C, 0x7F, 0xF8, 0xC2, 0x81, 0x38, 
+0xFD, 0xD8, 0x13, 0x93, 0x3A, 0x01, 0xB9, 0xF4, 
+0x5B, 0x01, 0xB7, 0x5B, 0xD8, 0xA9, 0xC4, 0x55, 
+0x2A, 0xDA, 0x7A, 0xA0, 0x62, 0x5E, 0x07, 0x34, 
+0x5E, 0xE5, 0xF2, 0x5C, 0xE0, 0x25, 0xBF, 0x7F, 
+0x62, 0x55, 0x3F, 0x04, 0x4D, 0xF9, 0xA4, 0xF9, 
+0x50, 
+
+0x99, 0x14, 0x74, 0x14, 0xF5, 0x5F, 0x27, 0x83, 
+0xA6, 0x70, 0x0C, 0xD4, 0x9A, 0xB4, 0xB0, 0xC5, 
+0xB9, 0x0A, 0xFC, 0x79, 0xB6, 0xD8, 0x74, 0x1B, 
+0x84, 0x2F, 0x4C, 0x52, 0x6B, 0xD7, 0x5E, 0x0C, 
+0xC1, 0x58, 0xBE, 0xF4, 0x11, 0x31, 0xAC, 0xBD, 
+0xD0, 0x31, 0xC0, 0x09, 0x1B, 0xFA, 0xE8, 0x9D, 
+0x49, 0x7E, 0x81, 0xE5, 0x1B, 0x48, 0x8F, 0x50, 
+0x9C, 0x80, 
+
+0x88, 0x6B, 0x01, 0x29, 0x9A, 0xD3, 0x68, 0x80, 
+0xFC, 0xDB, 0xE8, 0xFD, 0x6F, 0x6B, 0xAB, 0x32, 
+0x80, 0x34, 0x36, 0x79, 0xA6, 0x65, 0x0D, 0x92, 
+0x07, 0x9B, 0x72, 0x50, 0x13, 0x49, 0x37, 0x1D, 
+0xEE, 0xC7, 0xB1, 0xB8, 0x3B, 0x1D, 0x1A, 0xC7, 
+0xE0, 0xC5, 0x67, 0x52, 0x97, 0x74, 0x31, 0x77, 
+0x5F, 0xAD, 0x18, 0x3A, 0xAE, 0x9D, 0xB3, 0xC9, 
+0x83, 0x78, 0x27, 
+
+0x46, 0x1B, 0xE6, 0x2B, 0x2E, 0x73, 0x63, 0x62, 
+0x23, 0x43, 0x22, 0x0B, 0x0D, 0x57, 0xE8, 0xA0, 
+0xF0, 0x3E, 0x7D, 0x86, 0xF7, 0x28, 0x05, 0xC2, 
+0xEC, 0xD5, 0x28, 0x66, 0x07, 0x78, 0x08, 0x84, 
+0x40, 0xEC, 0xF9, 0x07, 0xA7, 0xAC, 0xD6, 0x7C, 
+0xB2, 0xFD, 0x1D, 0x01, 0x8E, 0x64, 0xBA, 0xE7, 
+0xFA, 0x02, 0x3A, 0x2A, 0x49, 0xBB, 0xD8, 0x1D, 
+0xE3, 0x73, 0xA5, 0xAA, 
+
+0x77, 0x64, 0x17, 0xD6, 0xBF, 0xC3, 0xC6, 0xE1, 
+0x1A, 0xE1, 0xEC, 0xF2, 0xA6, 0x54, 0x68, 0xDA, 
+0x51, 0x7F, 0x91, 0x8A, 0x8B, 0xE1, 0x6A, 0x30, 
+0x44, 0xAD, 0xB4, 0x86, 0xCA, 0xFF, 0x92, 0x90, 
+0xDA, 0x72, 0x33, 0xF0, 0xB7, 0x70, 0x2F, 0xF6, 
+0xBF, 0x3B, 0x6B, 0x4E, 0x05, 0x39, 0x2A, 0xB3, 
+0x79, 0xB5, 0x1B, 0x19, 0xE1, 0x62, 0xD4, 0x8C, 
+0xEA, 0xA4, 0xF1, 0xE8, 0x44, 
+
+0xD6, 0xFC, 0x6D, 0x38, 0x25, 0x98, 0x92, 0x80, 
+0x1C, 0xA4, 0x96, 0x96, 0x18, 0x32, 0x0E, 0xB4, 
+0xB6, 0x78, 0xD6, 0xE8, 0x2B, 0x98, 0xD4, 0x37, 
+0xDC, 0xE4, 0x5E, 0x1B, 0xAA, 0xFB, 0x3C, 0x13, 
+0x6F, 0x4A, 0xAA, 0x7D, 0xAA, 0x08, 0x13, 0x97, 
+0x9E, 0x60, 0xDF, 0xE1, 0x53, 0x42, 0xBB, 0x80, 
+0x22, 0x6E, 0x36, 0x18, 0xBE, 0x59, 0xCE, 0x5A, 
+0xC2, 0x2D, 0x28, 0xC7, 0xDF, 0x26, 
+
+0x6C, 0x73, 0xAF, 0x5A, 0x2E, 0x57, 0xEA, 0x37, 
+0x3E, 0x10, 0x53, 0x74, 0x82, 0xD5, 0x92, 0x04, 
+0x0E, 0x0C, 0x26, 0xBC, 0x91, 0x3A, 0x56, 0x90, 
+0x8E, 0x83, 0x56, 0x83, 0x0D, 0x68, 0xA5, 0x98, 
+0x95, 0x13, 0xB4, 0xB0, 0x32, 0xA7, 0xF4, 0x0B, 
+0x4B, 0xFF, 0xCC, 0xBE, 0xE9, 0xA3, 0xF7, 0xC7, 
+0xC2, 0x54, 0xF1, 0x0F, 0x27, 0x22, 0xBE, 0x25, 
+0x30, 0x74, 0xAE, 0x62, 0xEB, 0x3C, 0xBF, 
+
+0x2A, 0x38, 0xF6, 0x13, 0x11, 0x93, 0x90, 0x3E, 
+0xFC, 0x47, 0x92, 0x4E, 0x80, 0x9B, 0x9C, 0x8C, 
+0xA6, 0xD0, 0x5F, 0x2B, 0xC4, 0xEA, 0x9F, 0x65, 
+0x04, 0x60, 0xDC, 0x30, 0xEE, 0x6C, 0xD0, 0x2A, 
+0xC4, 0x9E, 0xEB, 0x83, 0xCC, 0xBB, 0xF9, 0x51, 
+0xBD, 0x41, 0xF5, 0x6F, 0x6A, 0x9E, 0xC4, 0x83, 
+0x0F, 0x58, 0x08, 0xFA, 0x70, 0x55, 0xA3, 0x59, 
+0x1C, 0xEA, 0xB7, 0xB6, 0xC9, 0xBC, 0xB6, 0xDA, 
+
+0x68, 0xEA, 0xE1, 0x0A, 0x16, 0xCF, 0xC8, 0xEB, 
+0xA9, 0x81, 0x2C, 0xC6, 0xC0, 0xEB, 0x86, 0x62, 
+0x76, 0x1F, 0x29, 0x26, 0x2F, 0x36, 0x59, 0x53, 
+0x2B, 0x2F, 0x73, 0x90, 0xB7, 0x73, 0xBA, 0xCE, 
+0xB3, 0xA5, 0x22, 0xB4, 0x8C, 0x40, 0xE3, 0x5E, 
+0xA1, 0x24, 0xDE, 0xC7, 0x3B, 0xC6, 0xFE, 0x13, 
+0x5F, 0x95, 0xB4, 0x49, 0x6E, 0x87, 0xAE, 0x28, 
+0x74, 0x27, 0xF1, 0x55, 0xD1, 0xDC, 0xE8, 0x8E, 
+0xCC, 
+
+0x79, 0x2E, 0xFC, 0x1C, 0x15, 0xD5, 0x06, 0x18, 
+0x6C, 0xBD, 0x7E, 0xDE, 0x3C, 0x3D, 0xFA, 0x45, 
+0x3F, 0x60, 0xEC, 0xBC, 0x89, 0xD1, 0x88, 0x2A, 
+0x49, 0xC4, 0xDF, 0x81, 0x68, 0xEE, 0xFB, 0xDF, 
+0x8C, 0xCD, 0x67, 0x51, 0x3C, 0x2C, 0x0C, 0x76, 
+0xED, 0xB7, 0xB5, 0x3D, 0x1B, 0x4E, 0x9A, 0xE8, 
+0xF7, 0xFB, 0x56, 0xEB, 0xA8, 0x84, 0xCE, 0x69, 
+0x3E, 0xCB, 0x9A, 0xFC, 0x07, 0xBB, 0xCE, 0x79, 
+0x22, 0x18, 
+
+0x26, 0xAE, 0x36, 0x03, 0xD8, 0xFC, 0x66, 0x9B, 
+0x86, 0x2A, 0xF8, 0x78, 0x6B, 0x83, 0xFB, 0x49, 
+0xA2, 0x7C, 0xA2, 0x19, 0x77, 0xC7, 0x21, 0x0A, 
+0xE9, 0x1E, 0x0B, 0x82, 0xE5, 0xFF, 0xDC, 0x74, 
+0x2E, 0xF1, 0x7E, 0xF2, 0xAC, 0xA0, 0x0A, 0x82, 
+0xB5, 0x86, 0x1B, 0xB9, 0xF8, 0x34, 0xE6, 0xB2, 
+0x39, 0x2F, 0x01, 0xE0, 0x81, 0x52, 0x0B, 0xC0, 
+0xC2, 0xE6, 0x5D, 0x51, 0xFE, 0x19, 0x68, 0x15, 
+0x06, 0xC6, 0x4E, 
+
+0x11, 0x07, 0xC5, 0xA9, 0xDC, 0x6F, 0x51, 0xA8, 
+0xAD, 0xFA, 0xBE, 0xA6, 0x41, 0xD7, 0x38, 0x9D, 
+0x1C, 0xFD, 0x80, 0x11, 0x66, 0x56, 0xA7, 0xC1, 
+0x04, 0x46, 0x4A, 0x8A, 0xF6, 0xB9, 0x53, 0xEF, 
+0x9F, 0xA6, 0x60, 0x6E, 0x5C, 0x44, 0xD4, 0x4B, 
+0xD1, 0x5E, 0x7C, 0xDB, 0xAD, 0x6B, 0xBE, 0x7C, 
+0xDF, 0xC3, 0x3C, 0xB6, 0xA4, 0xDD, 0x97, 0x62, 
+0x6B, 0x2D, 0x12, 0x5C, 0x84, 0x89, 0x4B, 0xDC, 
+0xD8, 0xD5, 0x17, 0x89, 
+
+0x30, 0x19, 0x36, 0x46, 0x8F, 0x3D, 0x44, 0x29, 
+0x1F, 0xF4, 0x80, 0x40, 0xB3, 0x62, 0xF2, 0x2B, 
+0x56, 0xA0, 0x17, 0x39, 0xB0, 0x27, 0x9C, 0xD4, 
+0x43, 0xE2, 0xFE, 0x82, 0x59, 0x66, 0x1D, 0x8B, 
+0x30, 0xD1, 0x46, 0x1E, 0xB7, 0xE9, 0x1A, 0x76, 
+0x96, 0x9F, 0x2F, 0xE3, 0x0C, 0xCD, 0x0C, 0x61, 
+0x14, 0x1E, 0x2E, 0x2A, 0xB7, 0x22, 0x64, 0xB5, 
+0x87, 0x6E, 0x18, 0xE0, 0x1E, 0xDC, 0x81, 0x64, 
+0x92, 0x3F, 0x70, 0xA3, 0xDB, 
+
+0xC0, 0x41, 0x31, 0xCF, 0x8F, 0x8D, 0x2C, 0x2C, 
+0xED, 0xDA, 0xA7, 0xFF, 0x7C, 0x36, 0xEE, 0xB3, 
+0xA8, 0x09, 0xC4, 0xA2, 0x9A, 0x42, 0x22, 0xEA, 
+0xF5, 0x87, 0x32, 0x66, 0x64, 0xFE, 0x25, 0xD2, 
+0x0C, 0xCB, 0x8A, 0x84, 0xEC, 0x65, 0xA1, 0xCE, 
+0xC2, 0x5F, 0x35, 0xEA, 0x6E, 0xBD, 0xF7, 0xC8, 
+0x92, 0x25, 0xC0, 0x0B, 0xA7, 0xE5, 0xD1, 0x61, 
+0x7D, 0xCB, 0x6E, 0xF8, 0x3E, 0x50, 0xDC, 0xDF, 
+0x3B, 0x8F, 0xFB, 0x60, 0x76, 0x50, 
+
+0xD2, 0x7A, 0x36, 0xA3, 0x5D, 0xC1, 0x25, 0x46, 
+0x56, 0x52, 0x03, 0x17, 0xC2, 0x08, 0xD2, 0xFE, 
+0x3F, 0xB4, 0x37, 0x6C, 0x1D, 0xF1, 0x91, 0xD8, 
+0x03, 0x3C, 0xDB, 0xFD, 0x0C, 0xF5, 0x8F, 0x5D, 
+0x6C, 0x8E, 0xF8, 0x78, 0xD1, 0xC3, 0xA7, 0xD1, 
+0x93, 0xC2, 0xFA, 0xC8, 0xB7, 0x2D, 0x96, 0x1F, 
+0x7A, 0x29, 0x8E, 0x50, 0x13, 0x40, 0x56, 0x2F, 
+0xBD, 0x20, 0x76, 0x07, 0x72, 0xE7, 0x93, 0x5D, 
+0x94, 0xD5, 0x07, 0x55, 0x06, 0x30, 0x4A, 
+
+0xBB, 0x93, 0x82, 0x70, 0x0A, 0x3A, 0x5D, 0xE0, 
+0x85, 0x97, 0xA0, 0x66, 0xA8, 0x85, 0x43, 0x85, 
+0xEA, 0x2D, 0x4B, 0x14, 0x84, 0x7A, 0xF3, 0xFF, 
+0x38, 0x44, 0x93, 0x4C, 0xE6, 0x34, 0xDC, 0x37, 
+0x6F, 0x91, 0xBF, 0xF9, 0xC1, 0x36, 0xFF, 0xDE, 
+0x01, 0xB3, 0x84, 0x3E, 0x5E, 0xCE, 0x7A, 0x49, 
+0xD5, 0x86, 0x49, 0x92, 0x65, 0xFE, 0xAB, 0xD5, 
+0x9F, 0xC0, 0xDD, 0x17, 0xCC, 0xD7, 0xE1, 0xC3, 
+0xEC, 0xA4, 0xBA, 0xC9, 0x65, 0x1B, 0x3D, 0xF6, 
+
+0x60, 0xC4, 0xF8, 0xBE, 0x14, 0x29, 0xC7, 0x09, 
+0x4C, 0x0A, 0x4C, 0xDD, 0x00, 0x0A, 0x2C, 0x98, 
+0x50, 0xE1, 0xE0, 0x3A, 0x47, 0x97, 0x89, 0x46, 
+0xE9, 0x65, 0x8E, 0x7F, 0x1E, 0x1D, 0xE0, 0xC6, 
+0x59, 0x80, 0xEB, 0x69, 0xBD, 0x16, 0x41, 0xA0, 
+0x66, 0x96, 0xD9, 0x47, 0xBB, 0xC1, 0xD1, 0xAB, 
+0xEA, 0xAB, 0x28, 0x12, 0x05, 0x36, 0x27, 0xA3, 
+0x2D, 0x7C, 0xB7, 0xD1, 0x9F, 0x87, 0xF1, 0x13, 
+0x31, 0x0C, 0x1A, 0x85, 0x71, 0xB2, 0xDD, 0x56, 
+0xBF, 
+
+0x01, 0x8A, 0xE3, 0x0A, 0xA6, 0xB4, 0x39, 0xBA, 
+0x00, 0xD6, 0x12, 0x20, 0xC8, 0xDD, 0x80, 0x6B, 
+0x7B, 0xF1, 0xE1, 0x49, 0x05, 0x80, 0xA8, 0x97, 
+0x3D, 0x6A, 0xBE, 0x82, 0xDF, 0x01, 0xA9, 0x10, 
+0x16, 0xEF, 0x69, 0x65, 0x48, 0xB2, 0xB1, 0x27, 
+0xA5, 0xA9, 0x75, 0x25, 0x3F, 0xCD, 0x13, 0x90, 
+0xFE, 0x9B, 0x9D, 0xB6, 0x57, 0xFB, 0x07, 0xAC, 
+0x44, 0x16, 0x88, 0x05, 0xB5, 0xBE, 0xA4, 0x79, 
+0x51, 0x4E, 0xC2, 0x1E, 0xFF, 0x85, 0xD5, 0xB9, 
+0x8C, 0xBC, 
+
+0xB9, 0x58, 0xAE, 0x08, 0xD0, 0xD0, 0x93, 0x37, 
+0xEF, 0x82, 0x7C, 0x18, 0xED, 0xEF, 0x85, 0x80, 
+0xC1, 0xE0, 0xEF, 0xEE, 0xA2, 0xE6, 0xD3, 0xDA, 
+0xFB, 0x0C, 0x54, 0xBC, 0xDC, 0x00, 0xF0, 0x7C, 
+0x65, 0xDC, 0x47, 0xB0, 0xEC, 0x88, 0xF4, 0xAE, 
+0x6E, 0x9F, 0x0F, 0x6F, 0x31, 0xDD, 0x92, 0x4D, 
+0x61, 0x15, 0x93, 0x21, 0x80, 0x42, 0x75, 0x14, 
+0x6E, 0x6E, 0x79, 0xCA, 0x4C, 0xC5, 0x58, 0x4B, 
+0xC9, 0xDB, 0x12, 0xA2, 0x20, 0x27, 0xC2, 0xAC, 
+0xDD, 0x9A, 0x0E, 
+
+0x42, 0xE6, 0x24, 0x61, 0x09, 0xBF, 0x86, 0x43, 
+0x52, 0x99, 0xE8, 0xC9, 0x3B, 0xD8, 0x0C, 0xCF, 
+0x21, 0xEC, 0x01, 0x17, 0x77, 0x29, 0xD8, 0x00, 
+0xED, 0xBB, 0xEA, 0xE3, 0xFB, 0xB8, 0x28, 0xF2, 
+0x6D, 0x41, 0x7B, 0x45, 0x40, 0xE3, 0xF6, 0x48, 
+0x30, 0xA3, 0xAA, 0xCF, 0xCC, 0x07, 0x8E, 0x30, 
+0xAE, 0x1A, 0x91, 0x79, 0x45, 0x63, 0x4E, 0xED, 
+0xAA, 0x1E, 0xB0, 0xCA, 0x5F, 0xAA, 0xA7, 0x28, 
+0xEC, 0xBB, 0xEB, 0x88, 0x07, 0xE8, 0x58, 0xBE, 
+0x80, 0x19, 0xB2, 0xCC, 
+
+0x26, 0xAC, 0x0E, 0xEE, 0x7B, 0x0B, 0xBA, 0xA4, 
+0x94, 0x0E, 0x94, 0xA3, 0x4D, 0x66, 0x08, 0xCF, 
+0x0F, 0x1C, 0xCB, 0x78, 0x15, 0xB4, 0x28, 0x02, 
+0x8B, 0x4F, 0x9C, 0x6D, 0xBE, 0x54, 0xD4, 0x52, 
+0xAB, 0x04, 0xBE, 0x75, 0xFE, 0x0C, 0x51, 0x08, 
+0x3B, 0xDA, 0x86, 0x9A, 0x9F, 0x51, 0xEE, 0x5D, 
+0xF2, 0x4D, 0x54, 0xC0, 0x0B, 0x8B, 0xEE, 0x50, 
+0x58, 0x34, 0xF5, 0xD7, 0x38, 0xF3, 0x15, 0xE4, 
+0xED, 0x70, 0x65, 0x4C, 0x59, 0x11, 0x3C, 0xF9, 
+0x03, 0x10, 0x7E, 0x38, 0xF7, 
+
+0x80, 0xA6, 0x0C, 0x85, 0x3F, 0xE0, 0xD4, 0xCA, 
+0xF4, 0xBA, 0xD8, 0x7E, 0x8F, 0xBF, 0x5B, 0x57, 
+0x9C, 0x74, 0x05, 0xA2, 0x46, 0x66, 0x6A, 0x5C, 
+0x03, 0x28, 0x98, 0x63, 0xBC, 0x11, 0xFD, 0x18, 
+0xC9, 0x3E, 0x66, 0xE1, 0x4D, 0xCD, 0x5E, 0x48, 
+0x4F, 0x1B, 0xA6, 0x72, 0x58, 0x3D, 0x84, 0x6F, 
+0xF8, 0x1F, 0x89, 0x62, 0x6F, 0x43, 0xD8, 0x0F, 
+0xDA, 0x84, 0x21, 0xBA, 0x23, 0xFE, 0xB3, 0x9A, 
+0x59, 0xA7, 0x9C, 0xF2, 0xB6, 0xE7, 0xB8, 0xEC, 
+0x8D, 0x48, 0x3F, 0x84, 0x80, 0x83, 
+
+0x6D, 0x7A, 0xC7, 0x2F, 0x87, 0xAA, 0xE7, 0xFA, 
+0x23, 0x88, 0x96, 0x09, 0x2E, 0xA0, 0x2B, 0x73, 
+0x7B, 0x23, 0x5F, 0x45, 0x2A, 0x39, 0x90, 0x96, 
+0xD0, 0xF7, 0x53, 0x2A, 0x8C, 0xBC, 0xD2, 0xCA, 
+0x40, 0x2B, 0x49, 0x2C, 0xB5, 0x33, 0x8B, 0x03, 
+0x63, 0xAA, 0x77, 0x87, 0x2F, 0x8A, 0x65, 0x2B, 
+0xC0, 0x68, 0x7B, 0xA7, 0x59, 0x27, 0x27, 0x2D, 
+0x42, 0x50, 0x34, 0x20, 0xC9, 0x36, 0xF8, 0xF9, 
+0x1E, 0x74, 0x4F, 0xB5, 0x4C, 0x0F, 0xFC, 0x66, 
+0xAC, 0x05, 0x0D, 0x1C, 0x89, 0x7E, 0x42, 
+
+0xD1, 0x71, 0x6C, 0x47, 0x1B, 0xD9, 0x11, 0x2A, 
+0x32, 0xC7, 0x68, 0x85, 0xF7, 0x78, 0x06, 0x52, 
+0xB3, 0xFA, 0xD6, 0x8A, 0xA8, 0x2A, 0xC2, 0xF3, 
+0x4C, 0x4E, 0x39, 0xB7, 0x46, 0x47, 0x2D, 0x3B, 
+0xA6, 0x08, 0x9F, 0x2E, 0x8E, 0x8E, 0xE6, 0x77, 
+0xAE, 0x7B, 0x09, 0x69, 0x87, 0x0C, 0x10, 0xC5, 
+0x98, 0x6A, 0x3B, 0x53, 0xD1, 0x3A, 0x24, 0x69, 
+0x36, 0xF1, 0xCF, 0x15, 0xE0, 0x1D, 0xFD, 0x21, 
+0x99, 0xC4, 0x3D, 0x12, 0x48, 0x4E, 0x63, 0x58, 
+0xCD, 0x33, 0x70, 0x63, 0xDE, 0x1B, 0xD9, 0x20, 
+
+0x99, 0xB9, 0x0A, 0x2E, 0x7D, 0x8C, 0xC6, 0xA4, 
+0x05, 0x38, 0x16, 0xAE, 0xEB, 0x65, 0xE6, 0xE8, 
+0x97, 0xD5, 0xA4, 0x81, 0xC7, 0xC9, 0x16, 0x27, 
+0x79, 0x81, 0x8D, 0x3F, 0x26, 0x35, 0xCB, 0x5F, 
+0xC1, 0x4C, 0x51, 0xA1, 0x97, 0x74, 0x26, 0xC4, 
+0x36, 0x4D, 0x6A, 0x73, 0x6D, 0x54, 0x25, 0xE8, 
+0x98, 0x42, 0x32, 0xC8, 0x7C, 0x56, 0xFC, 0xBF, 
+0x11, 0x22, 0x3F, 0xD4, 0xFB, 0x80, 0x2C, 0x8B, 
+0x13, 0x59, 0x15, 0xFA, 0x50, 0x1D, 0x57, 0x2F, 
+0x95, 0xFB, 0x40, 0xBD, 0x40, 0x71, 0xC7, 0x4C, 
+0x76, 
+
+0x5F, 0x96, 0x0E, 0x6C, 0x3F, 0x9D, 0x96, 0xAF, 
+0xA2, 0x53, 0xDE, 0xB8, 0xE3, 0x73, 0xA9, 0xFA, 
+0x4C, 0x15, 0xB2, 0x4C, 0xD7, 0x7C, 0x03, 0xC1, 
+0x7F, 0x88, 0x93, 0xF5, 0x61, 0xB8, 0x9A, 0xC4, 
+0x44, 0x96, 0x6D, 0xC5, 0x9C, 0x94, 0x0D, 0x15, 
+0x25, 0x68, 0x0C, 0x44, 0x13, 0x3F, 0xCE, 0xBB, 
+0x50, 0xD8, 0xAC, 0x76, 0xBE, 0xC7, 0x6E, 0x35, 
+0xF6, 0xC1, 0xA7, 0x2C, 0xDA, 0x62, 0x61, 0x88, 
+0xB0, 0xAE, 0x01, 0xF0, 0x99, 0xFA, 0x00, 0xEC, 
+0x42, 0x31, 0x59, 0x39, 0x09, 0x33, 0xB8, 0x05, 
+0x7A, 0x83, 
+
+0x92, 0xAD, 0x9F, 0xC1, 0x2D, 0xA4, 0xF7, 0x2F, 
+0x8C, 0x5B, 0xC6, 0x8F, 0x0C, 0x1B, 0x50, 0x10, 
+0x2B, 0x28, 0x78, 0x81, 0xE6, 0xAB, 0x23, 0xDE, 
+0xC0, 0x41, 0xB4, 0x5E, 0x4A, 0xC6, 0x71, 0xF8, 
+0x5B, 0x4C, 0xB4, 0x28, 0xB4, 0x52, 0x2C, 0x67, 
+0x32, 0xBB, 0x8F, 0xD4, 0xC2, 0xEB, 0xAC, 0x9D, 
+0x8E, 0x86, 0xCF, 0x9C, 0x75, 0xB4, 0x51, 0x2B, 
+0xBB, 0xF6, 0x92, 0x11, 0x6E, 0xA1, 0x3B, 0x73, 
+0x0A, 0x60, 0x4D, 0x5E, 0x94, 0xAB, 0x6D, 0xE2, 
+0x78, 0x1F, 0xCA, 0x6D, 0x74, 0x8D, 0xA8, 0x28, 
+0x57, 0xD4, 0x64, 
+
+0xB4, 0x15, 0xEA, 0xBC, 0xF5, 0x36, 0x8A, 0x26, 
+0x52, 0x2B, 0x34, 0x0A, 0xD8, 0xEF, 0xA9, 0xDD, 
+0x98, 0xCE, 0xF0, 0xBE, 0xAC, 0x25, 0x15, 0x99, 
+0xD1, 0xCE, 0xCC, 0x59, 0x4B, 0xF6, 0xA0, 0xA2, 
+0xAB, 0x2C, 0xD1, 0x35, 0x5F, 0x9A, 0xBE, 0x8D, 
+0xDC, 0x46, 0xEF, 0xE6, 0x7C, 0xB5, 0xCE, 0xB4, 
+0xC5, 0xA0, 0xE1, 0xDF, 0x99, 0x3C, 0xAF, 0x08, 
+0xA9, 0x13, 0xAB, 0x10, 0x97, 0x34, 0xD5, 0xC1, 
+0xFB, 0x6C, 0x3F, 0x1C, 0xA1, 0xDB, 0xA8, 0xF0, 
+0xBA, 0xC7, 0x01, 0xF7, 0xCC, 0xB4, 0xE2, 0xCE, 
+0x27, 0x09, 0x1B, 0x8E, 
+
+0xEE, 0xE4, 0x3D, 0x8F, 0xD4, 0xBD, 0xAB, 0x2C, 
+0xE4, 0x58, 0xEC, 0x8F, 0x90, 0xFF, 0x7F, 0xBF, 
+0xE5, 0x36, 0x9C, 0xA7, 0x7B, 0xE3, 0x33, 0xDC, 
+0x22, 0x15, 0x56, 0xC0, 0x19, 0x3E, 0x64, 0x4A, 
+0x03, 0xD7, 0x85, 0x72, 0x8F, 0xE8, 0x9D, 0xCA, 
+0x72, 0xF8, 0x23, 0x5A, 0x1D, 0x07, 0x57, 0xEE, 
+0xD8, 0x0A, 0x06, 0xC6, 0xC9, 0xFF, 0xEB, 0x21, 
+0x73, 0xC8, 0x5B, 0x6E, 0x3C, 0xD7, 0x03, 0xB0, 
+0x6D, 0xE8, 0x1B, 0x92, 0xC1, 0x99, 0xCF, 0x67, 
+0x97, 0xDC, 0xD5, 0x76, 0x13, 0xFE, 0x9C, 0xFB, 
+0xBA, 0x69, 0x31, 0x2E, 0x24, 
+
+0xA0, 0xF4, 0x86, 0xA9, 0x10, 0x43, 0xCC, 0xD8, 
+0x95, 0x83, 0x98, 0xD1, 0x67, 0x26, 0x50, 0x16, 
+0x4D, 0xF2, 0xEE, 0xCA, 0x01, 0x82, 0x2B, 0xF8, 
+0xFE, 0xDE, 0x1A, 0x08, 0xD2, 0x1C, 0x86, 0x25, 
+0x6E, 0x21, 0xBB, 0xB1, 0xA9, 0x71, 0xCD, 0x51, 
+0xA3, 0x2A, 0x46, 0x65, 0xD8, 0x93, 0xBA, 0x39, 
+0xC9, 0x1A, 0x62, 0xBA, 0xD9, 0xA9, 0xE5, 0x13, 
+0x21, 0x46, 0xB0, 0x5B, 0x83, 0x93, 0xA0, 0xD8, 
+0x44, 0xDB, 0x1F, 0x07, 0x39, 0xC9, 0x95, 0xB2, 
+0x10, 0xE1, 0x19, 0x72, 0xD2, 0x2B, 0xCE, 0x26, 
+0x39, 0x33, 0x95, 0xE8, 0x45, 0xE6, 
+
+0xBA, 0x69, 0x29, 0x71, 0x48, 0x22, 0x36, 0xA8, 
+0xE0, 0x2D, 0x67, 0xE5, 0xCB, 0x1D, 0xDA, 0x0D, 
+0x69, 0x29, 0x3D, 0x10, 0x98, 0x49, 0xF3, 0x51, 
+0xDE, 0x0D, 0x5B, 0x35, 0x6E, 0xCE, 0x06, 0x54, 
+0x17, 0xFF, 0xCD, 0x8D, 0xF6, 0xE6, 0x0B, 0xC5, 
+0xB9, 0xAF, 0x0B, 0xA3, 0x9A, 0x54, 0xFF, 0x79, 
+0x81, 0xBD, 0xE0, 0x0A, 0xF4, 0xC6, 0x03, 0x34, 
+0x4E, 0x9F, 0x2A, 0x51, 0xBD, 0xA5, 0x9B, 0xF2, 
+0xF3, 0x1C, 0xE8, 0x23, 0xA2, 0x4D, 0x8E, 0xC4, 
+0x9C, 0xF2, 0xCD, 0xCB, 0x4A, 0xC2, 0xB0, 0x01, 
+0xCF, 0xAA, 0x6B, 0x0E, 0xC8, 0xF9, 0x0F, 
+
+0xAF, 0xFE, 0x74, 0x73, 0x15, 0x68, 0xD8, 0x0A, 
+0x69, 0x59, 0x90, 0xDE, 0x63, 0x9A, 0x5A, 0xE7, 
+0xD8, 0x73, 0x43, 0x68, 0x03, 0x7A, 0x56, 0x62, 
+0x58, 0x69, 0x00, 0x86, 0xF7, 0xCD, 0x62, 0x4B, 
+0xA5, 0xD1, 0xF8, 0x9F, 0xF8, 0x0D, 0x55, 0xA5, 
+0x3E, 0x77, 0x4D, 0x77, 0xFB, 0x40, 0xF2, 0x7A, 
+0x89, 0x18, 0xF0, 0x04, 0x53, 0xAC, 0x11, 0xD9, 
+0xBE, 0x3B, 0xC4, 0x85, 0x9C, 0x80, 0x1D, 0x62, 
+0x34, 0x69, 0x01, 0x2D, 0x95, 0xBD, 0x17, 0x33, 
+0x1D, 0x44, 0x4D, 0x8E, 0x79, 0xA2, 0x8F, 0xC0, 
+0x6B, 0x21, 0x7B, 0xEA, 0x93, 0x90, 0x27, 0xFB, 
+
+0xDB, 0x7C, 0xB3, 0xF7, 0xEB, 0x36, 0x55, 0xF0, 
+0xBE, 0x42, 0x31, 0xBB, 0xE3, 0xFC, 0xE6, 0x0A, 
+0x3A, 0xD4, 0x29, 0x23, 0x9E, 0x85, 0x9D, 0x9B, 
+0x0F, 0xA6, 0xFC, 0x79, 0xFA, 0xB8, 0xD6, 0x0D, 
+0xF9, 0xB0, 0x97, 0x04, 0xC3, 0x12, 0x84, 0x2A, 
+0xDC, 0x24, 0x35, 0x04, 0xAA, 0xB2, 0x85, 0x62, 
+0x6B, 0x43, 0x2C, 0xC5, 0x2C, 0x03, 0x24, 0x03, 
+0xF5, 0x9C, 0x24, 0x99, 0xD1, 0x2A, 0x11, 0xED, 
+0x2A, 0x79, 0x06, 0x44, 0xA7, 0x20, 0x8F, 0xE8, 
+0xF4, 0xA0, 0x8F, 0xAF, 0x33, 0x24, 0x9D, 0x21, 
+0x12, 0xA1, 0x53, 0xB1, 0xDF, 0xFE, 0xEE, 0x63, 
+0xD3, 
+
+0x91, 0xAF, 0x4A, 0xC9, 0xBE, 0x10, 0xF8, 0x78, 
+0x85, 0xFD, 0x0C, 0x44, 0x63, 0x3A, 0xF9, 0x58, 
+0x78, 0x2D, 0x3E, 0xEF, 0x85, 0xF5, 0x5D, 0x18, 
+0xC8, 0xD2, 0x77, 0xC3, 0x37, 0x76, 0x38, 0x7D, 
+0x27, 0x6E, 0x20, 0x7C, 0x8A, 0xBE, 0xDA, 0x40, 
+0xD3, 0xB8, 0x76, 0x03, 0x2F, 0x75, 0xD3, 0x8A, 
+0x28, 0x59, 0x67, 0x4D, 0xFF, 0xC6, 0x11, 0x58, 
+0x78, 0xFF, 0xB2, 0xE6, 0x2F, 0x6C, 0xB0, 0xEB, 
+0x47, 0xEF, 0xD6, 0xEE, 0xBD, 0x1D, 0xD6, 0x8A, 
+0x9D, 0xFA, 0xD9, 0xB8, 0xD5, 0x97, 0x8B, 0xBF, 
+0xEF, 0x18, 0xA8, 0x56, 0x50, 0x08, 0x3E, 0x9B, 
+0x32, 0x5F, 
+
+0x76, 0x7E, 0xD0, 0x6E, 0x23, 0x32, 0x21, 0xB9, 
+0x4D, 0x85, 0xFD, 0x2D, 0x99, 0xE8, 0x48, 0x15, 
+0x9A, 0x48, 0xE6, 0x5F, 0x53, 0x09, 0x3E, 0x38, 
+0xDE, 0xFA, 0x46, 0xCA, 0x56, 0x85, 0x01, 0xF3, 
+0x70, 0x83, 0xAD, 0x46, 0xC2, 0x7D, 0x62, 0xD3, 
+0x88, 0x12, 0x83, 0x8A, 0x6C, 0x12, 0x03, 0x9F, 
+0x94, 0xBE, 0x7F, 0x6E, 0x58, 0xB7, 0x03, 0xBD, 
+0x1E, 0x8B, 0x95, 0xAE, 0x26, 0x56, 0x7D, 0x80, 
+0xE0, 0x15, 0x8B, 0x83, 0x40, 0x69, 0x22, 0xE6, 
+0xB2, 0x40, 0xCD, 0x1B, 0x1B, 0x1F, 0xF0, 0x5E, 
+0xBC, 0x5B, 0x3A, 0x98, 0x92, 0x80, 0xBA, 0x0B, 
+0x13, 0x26, 0xE9, 
+
+0x3E, 0xFE, 0x5B, 0x01, 0xB0, 0xEB, 0xF6, 0xCE, 
+0x88, 0x6A, 0xD8, 0x7D, 0xD9, 0x0C, 0x27, 0x3B, 
+0x46, 0x69, 0xED, 0xA6, 0xD5, 0xF6, 0xF6, 0xAA, 
+0x58, 0xC4, 0xAB, 0xCB, 0xDB, 0xF4, 0xF9, 0xD8, 
+0x62, 0xAD, 0x9B, 0x58, 0xB8, 0x15, 0x03, 0xE7, 
+0xDE, 0xAE, 0x95, 0xAC, 0x99, 0x84, 0x89, 0x7B, 
+0x55, 0xFE, 0xF2, 0x82, 0x73, 0x6A, 0x53, 0x71, 
+0xA4, 0x9C, 0x90, 0x6B, 0x20, 0x0A, 0x0D, 0x38, 
+0x23, 0xEC, 0x00, 0x34, 0xB2, 0x00, 0xFD, 0xD6, 
+0x95, 0x85, 0x94, 0x41, 0x56, 0xBA, 0xC9, 0xEC, 
+0xDF, 0xB7, 0x3B, 0xD4, 0xCF, 0x35, 0x59, 0xEF, 
+0x5E, 0x25, 0x4E, 0xBC, 
+
+0x30, 0xE9, 0x94, 0x00, 0x02, 0x16, 0x24, 0xB0, 
+0x9B, 0x55, 0xFB, 0xEF, 0x66, 0x64, 0xA3, 0xAB, 
+0xD3, 0x38, 0x2F, 0xB3, 0xBE, 0xAC, 0x87, 0x97, 
+0x87, 0xFD, 0x9D, 0x56, 0x99, 0x6E, 0x35, 0x3F, 
+0x0E, 0x58, 0x2E, 0xDB, 0x0C, 0x2A, 0xD1, 0x13, 
+0xFC, 0xDE, 0x8F, 0x5B, 0x66, 0xAC, 0xBA, 0xD0, 
+0xF9, 0xBA, 0x1C, 0xB1, 0xC0, 0x20, 0x48, 0x39, 
+0x37, 0xB4, 0x40, 0xA4, 0xE7, 0xFA, 0xE8, 0xEA, 
+0xB4, 0x65, 0x57, 0xAF, 0x61, 0x27, 0x66, 0x4D, 
+0xA7, 0xA9, 0xE5, 0x31, 0xAF, 0x1B, 0x5D, 0x08, 
+0x63, 0x7A, 0xFE, 0x35, 0x16, 0xBE, 0xC1, 0x3B, 
+0x5D, 0x30, 0x6F, 0x04, 0x20, 
+
+0x5E, 0xF3, 0x59, 0xA0, 0xA9, 0xF4, 0xC0, 0xD6, 
+0x18, 0xD8, 0xCA, 0x33, 0xD4, 0xCF, 0x65, 0x75, 
+0x18, 0xB5, 0xFE, 0x78, 0xB9, 0xE5, 0x4B, 0x5D, 
+0x9A, 0x02, 0x0B, 0x93, 0xF3, 0xE0, 0xE7, 0x63, 
+0xD0, 0x04, 0xE4, 0x9E, 0x1E, 0x13, 0x19, 0x69, 
+0xD5, 0x7C, 0xD6, 0x08, 0x8C, 0x07, 0xDD, 0x65, 
+0x3F, 0x8C, 0x72, 0x2C, 0x85, 0xC3, 0x0E, 0x5F, 
+0x39, 0xC4, 0xAC, 0xE7, 0x4A, 0xCE, 0xD2, 0xF4, 
+0x0E, 0xAA, 0x40, 0x33, 0x1B, 0x72, 0xA8, 0x7F, 
+0x26, 0xF0, 0x58, 0xCB, 0x23, 0x52, 0x37, 0x76, 
+0xE6, 0x3A, 0x16, 0xD1, 0xE8, 0x7D, 0x0D, 0x72, 
+0xF0, 0xA5, 0xE9, 0x36, 0x94, 0x49, 
+
+0x96, 0xDF, 0x56, 0xBD, 0x0B, 0x1B, 0x13, 0xD3, 
+0x22, 0xB5, 0x8D, 0x84, 0x7A, 0x27, 0x0E, 0x8A, 
+0xB3, 0xFC, 0x0E, 0x1E, 0x97, 0x1B, 0x78, 0xEB, 
+0x9D, 0xDB, 0x4D, 0xAA, 0x90, 0xBC, 0xCB, 0xB3, 
+0x3B, 0x14, 0x51, 0x4A, 0x1F, 0x62, 0x87, 0xC1, 
+0x2C, 0x5B, 0xC6, 0x0C, 0x0A, 0x68, 0x9D, 0xDD, 
+0xCF, 0xCC, 0x65, 0x3F, 0x23, 0xFC, 0xC8, 0xED, 
+0x78, 0x8F, 0xA0, 0xE4, 0xE8, 0x09, 0x25, 0x25, 
+0xC3, 0x19, 0xCF, 0xF4, 0x39, 0xE6, 0xB1, 0x47, 
+0xE6, 0xDE, 0x93, 0xDF, 0xEF, 0x1C, 0xA1, 0x3B, 
+0x75, 0x56, 0xF9, 0xB1, 0xE8, 0xA0, 0x0B, 0xF2, 
+0x3C, 0x83, 0x3A, 0xD4, 0x7B, 0xC8, 0x51, 
+
+0xC9, 0xAF, 0x55, 0x5A, 0xCC, 0x91, 0xDE, 0x8A, 
+0xDA, 0x3A, 0x8D, 0xD2, 0xCE, 0x7F, 0xA0, 0x0F, 
+0x56, 0x5F, 0xEF, 0x34, 0xBD, 0xF9, 0x95, 0xC0, 
+0xFF, 0xF7, 0xD4, 0x26, 0x9D, 0xE7, 0x6D, 0x2E, 
+0xAE, 0x11, 0x5E, 0x84, 0x54, 0xC7, 0x66, 0x86, 
+0xAA, 0xA7, 0x4C, 0xC5, 0x92, 0x1F, 0x9B, 0x7D, 
+0xA4, 0x9B, 0x06, 0x1D, 0xA4, 0xF8, 0xCA, 0x76, 
+0x97, 0x3E, 0xB0, 0x72, 0xCC, 0x53, 0xB8, 0x97, 
+0xBD, 0x47, 0x1D, 0xC3, 0x98, 0xA7, 0xB1, 0xE6, 
+0xAE, 0x16, 0xCD, 0xAA, 0xCB, 0xCE, 0xD2, 0xB9, 
+0x84, 0x12, 0x09, 0x6D, 0x44, 0xE0, 0xC9, 0xB1, 
+0x3E, 0x9B, 0x09, 0xEC, 0x9B, 0x5F, 0x44, 0xCB, 
+
+0xAA, 0xB0, 0xD8, 0xC1, 0x83, 0x1D, 0x15, 0x93, 
+0x9F, 0xE1, 0x08, 0x3A, 0xB8, 0xCE, 0x03, 0x9B, 
+0xD0, 0xC3, 0x5E, 0x72, 0xED, 0xA4, 0x7E, 0x86, 
+0x50, 0x80, 0x52, 0x02, 0x32, 0x69, 0x59, 0x24, 
+0xE5, 0x1D, 0x12, 0x53, 0x14, 0x9D, 0xBC, 0x55, 
+0x10, 0x9C, 0xF9, 0xFF, 0x05, 0xEA, 0x3D, 0x41, 
+0x4D, 0xA8, 0xCD, 0xE2, 0x84, 0x29, 0x30, 0x2F, 
+0x17, 0x19, 0x61, 0xDD, 0x06, 0xF4, 0x07, 0x8F, 
+0xA6, 0x6B, 0xE1, 0xC3, 0xDC, 0xAA, 0x5F, 0xC3, 
+0x52, 0x67, 0x0C, 0x8E, 0x43, 0xA0, 0x05, 0x5C, 
+0x51, 0x97, 0x21, 0x8D, 0xB5, 0x5B, 0x67, 0x8E, 
+0x95, 0x60, 0xD5, 0xD3, 0xB6, 0xD3, 0xC3, 0xE8, 
+0xC1, 
+
+0x0D, 0x64, 0xCC, 0x46, 0x15, 0x75, 0x21, 0xA1, 
+0x4B, 0xD8, 0xDB, 0x88, 0x7E, 0x69, 0x20, 0x92, 
+0xAD, 0xE7, 0x87, 0x08, 0xA3, 0x97, 0xA0, 0xE1, 
+0x50, 0xF5, 0x0B, 0xCE, 0xB0, 0xC5, 0x52, 0x3F, 
+0xD8, 0x48, 0xE2, 0x97, 0xC5, 0xDD, 0x25, 0xC7, 
+0x3C, 0x4B, 0xEC, 0xF5, 0xD0, 0xBC, 0xD7, 0xAE, 
+0xBC, 0xF5, 0x70, 0x5E, 0xDF, 0x26, 0x1A, 0x1F, 
+0x08, 0x07, 0xAB, 0xCD, 0xBF, 0xE5, 0x4C, 0x47, 
+0xBF, 0x9D, 0x62, 0x73, 0x3E, 0xFA, 0x59, 0xF9, 
+0x81, 0xC4, 0x2D, 0x36, 0x86, 0xE4, 0x33, 0xBD, 
+0xF3, 0xEC, 0x03, 0xC4, 0x70, 0xEC, 0xD8, 0xBA, 
+0xFA, 0xAC, 0x4B, 0x24, 0x57, 0x95, 0x6D, 0xD2, 
+0xCD, 0xBF, 
+
+0x88, 0x93, 0xEA, 0xF9, 0xE8, 0xE4, 0x38, 0x65, 
+0xB7, 0xB5, 0xEB, 0x0E, 0xB1, 0xF6, 0x40, 0x2F, 
+0x64, 0x71, 0xF0, 0xDB, 0xD0, 0xDB, 0x71, 0xAA, 
+0x3E, 0x06, 0xF1, 0x6E, 0x5A, 0xED, 0x6A, 0x00, 
+0xD7, 0x38, 0x82, 0x89, 0x9F, 0x41, 0xFF, 0x79, 
+0xE2, 0xDC, 0x77, 0x92, 0x1D, 0x7B, 0x6F, 0x17, 
+0x7F, 0x8F, 0x42, 0x9D, 0x8A, 0x37, 0xCC, 0x70, 
+0x6E, 0x20, 0x74, 0x20, 0xFD, 0x49, 0x6E, 0xEA, 
+0xEB, 0xDD, 0x6A, 0x84, 0x11, 0x03, 0x4A, 0x2A, 
+0xD1, 0xB0, 0xD2, 0x86, 0xE6, 0x91, 0x7C, 0xFA, 
+0xC9, 0xCF, 0xC7, 0xBF, 0x06, 0x48, 0xD9, 0x1D, 
+0x95, 0x7F, 0x04, 0xBB, 0x6B, 0xCE, 0x26, 0x9B, 
+0x44, 0xEA, 0x55, 
+
+0x42, 0x78, 0xDD, 0x1D, 0xF9, 0xB8, 0x0E, 0xC2, 
+0x30, 0xEC, 0x4C, 0x1C, 0xC4, 0x16, 0xC9, 0xFC, 
+0xAA, 0x22, 0x41, 0x39, 0xA6, 0x8D, 0x65, 0xCF, 
+0xF9, 0x9F, 0xE9, 0x24, 0x61, 0x2B, 0x4D, 0x82, 
+0x5A, 0x0A, 0x8A, 0x1E, 0x5A, 0x96, 0xCE, 0x64, 
+0xAB, 0xCE, 0xC9, 0xE8, 0x87, 0x41, 0x91, 0x0F, 
+0x8D, 0xE9, 0xFD, 0x49, 0xAF, 0x33, 0xB3, 0xCF, 
+0xD7, 0x2F, 0xED, 0x04, 0xC8, 0x4D, 0x5D, 0x11, 
+0x34, 0xC3, 0x2F, 0x18, 0x75, 0x09, 0x95, 0x1D, 
+0x0F, 0xF9, 0x04, 0x77, 0xF1, 0x36, 0x6F, 0xC3, 
+0x99, 0xCB, 0x4A, 0x32, 0xC5, 0x90, 0xB0, 0x11, 
+0xCC, 0x04, 0x0F, 0xDD, 0xEA, 0x6E, 0x11, 0x6F, 
+0xDA, 0x12, 0x74, 0xF5, 
+
+0x8C, 0x05, 0x0A, 0x6B, 0x5B, 0xBB, 0x01, 0x8E, 
+0xC1, 0xA1, 0xC8, 0x16, 0x69, 0xCA, 0xE0, 0x80, 
+0x33, 0x37, 0x7C, 0x1D, 0xD6, 0x9D, 0x28, 0x20, 
+0x43, 0x5D, 0x6A, 0x1F, 0xE7, 0x1B, 0x25, 0xAC, 
+0x90, 0x10, 0xBD, 0x72, 0xCE, 0x1E, 0x5B, 0x08, 
+0xF0, 0xF5, 0x6D, 0x7A, 0xCD, 0xDC, 0xEA, 0x6D, 
+0xB7, 0xB7, 0x2D, 0x81, 0x99, 0x0F, 0x41, 0xD6, 
+0x74, 0x46, 0x75, 0xEC, 0x54, 0xF4, 0x42, 0x1E, 
+0x37, 0x69, 0x32, 0x69, 0x20, 0xFC, 0x20, 0x14, 
+0xD4, 0xA6, 0x1C, 0xB1, 0x79, 0x82, 0xB3, 0x81, 
+0x34, 0x40, 0x44, 0x8B, 0x6A, 0x55, 0x10, 0xF2, 
+0xD6, 0x80, 0xC8, 0x64, 0xCF, 0x32, 0x0B, 0xE0, 
+0xF2, 0x0D, 0xA4, 0x20, 0x73, 
+
+0x14, 0x20, 0xB6, 0xCE, 0x8E, 0xE3, 0xD6, 0x27, 
+0x6E, 0xDF, 0x6E, 0x3E, 0x4C, 0xC9, 0x4E, 0x86, 
+0xD1, 0x68, 0x05, 0x39, 0xBD, 0xE2, 0xC0, 0x2A, 
+0x4B, 0xD3, 0xD5, 0x06, 0xA0, 0x35, 0xE2, 0xE0, 
+0x2D, 0x7A, 0xDD, 0xCC, 0xB1, 0x3B, 0xCD, 0xF6, 
+0x98, 0x2A, 0x9D, 0x39, 0x0B, 0x6A, 0xBF, 0xAC, 
+0x34, 0xD9, 0x49, 0xE2, 0x59, 0x9E, 0xF1, 0x45, 
+0x60, 0xD1, 0xB6, 0xEB, 0xD9, 0xCB, 0x49, 0x55, 
+0x9A, 0xD2, 0x44, 0x54, 0x5F, 0x10, 0x8F, 0xD1, 
+0xFB, 0xAD, 0xB7, 0x37, 0x4F, 0x47, 0x90, 0xAC, 
+0x8B, 0xF7, 0xC4, 0x0F, 0x68, 0x1C, 0x5E, 0xEA, 
+0xEA, 0x4D, 0xAE, 0x99, 0x4F, 0xFC, 0x68, 0x8A, 
+0xF4, 0xC1, 0xED, 0xD0, 0x79, 0x7E, 
+
+0x6D, 0x92, 0x88, 0x06, 0xEA, 0x88, 0xEA, 0x46, 
+0x55, 0x10, 0x8C, 0x60, 0x11, 0x8B, 0x14, 0xCA, 
+0x7A, 0xDB, 0x48, 0x31, 0xED, 0xCA, 0xD3, 0xFE, 
+0x46, 0xB6, 0xC6, 0x96, 0x0D, 0x74, 0xCA, 0xA4, 
+0xF4, 0xE5, 0x27, 0xE9, 0x62, 0x99, 0xF1, 0xB7, 
+0xF1, 0xE0, 0x3B, 0xBB, 0x6A, 0x79, 0x5B, 0x14, 
+0x8A, 0x26, 0x08, 0x17, 0x51, 0xB4, 0x95, 0xAC, 
+0x6D, 0x3C, 0xFE, 0x7B, 0x3A, 0xB5, 0xAC, 0x6E, 
+0x9B, 0xD5, 0x4B, 0x0B, 0xF2, 0x77, 0x8B, 0x74, 
+0x45, 0xD8, 0x1A, 0x38, 0xAC, 0x99, 0xBF, 0x7E, 
+0x1C, 0x1E, 0x78, 0x47, 0xEF, 0x45, 0x04, 0x66, 
+0x74, 0xB5, 0x17, 0x3A, 0xB1, 0x17, 0x8B, 0x4E, 
+0x33, 0xA9, 0xC3, 0x81, 0xDE, 0x39, 0x3F, 
+
+0x57, 0xC1, 0x32, 0x5B, 0x30, 0xE4, 0xFF, 0x54, 
+0x41, 0xBA, 0xE4, 0xF2, 0x96, 0xC2, 0xC2, 0x26, 
+0xB4, 0xC3, 0x6D, 0x69, 0xC4, 0x61, 0x7A, 0x96, 
+0xAB, 0x94, 0xEE, 0x59, 0x08, 0x94, 0x44, 0x92, 
+0x73, 0xBA, 0x41, 0xA3, 0x4C, 0x7B, 0xF8, 0xB2, 
+0x56, 0xDA, 0x40, 0xBC, 0x10, 0x24, 0x40, 0xD7, 
+0x21, 0x18, 0x51, 0x1B, 0x1E, 0xF0, 0x6B, 0xD0, 
+0x0F, 0x3C, 0x67, 0x73, 0x35, 0x2F, 0xC3, 0x6F, 
+0x52, 0x90, 0x74, 0xC8, 0x6F, 0x3A, 0xA0, 0xF3, 
+0xD0, 0x99, 0x5D, 0x16, 0x1B, 0x5D, 0xB9, 0x8A, 
+0x5D, 0x7E, 0x59, 0xB2, 0x4E, 0x3A, 0xEB, 0x3D, 
+0x92, 0x69, 0x13, 0xAA, 0xD5, 0x1B, 0xF1, 0x04, 
+0xD4, 0x4F, 0x64, 0x9F, 0xF6, 0x3F, 0x88, 0xC3, 
+
+0x46, 0x1B, 0x8D, 0x79, 0x4F, 0x50, 0xFD, 0x22, 
+0xEA, 0xB9, 0x5F, 0xC7, 0xA5, 0xCB, 0x29, 0xDA, 
+0x68, 0xCA, 0xA2, 0xD9, 0x2C, 0xDC, 0x0E, 0xAB, 
+0x8D, 0x55, 0xA9, 0x02, 0xDC, 0x0D, 0xD9, 0xC5, 
+0x43, 0x45, 0xD9, 0xBD, 0x57, 0xB3, 0xA4, 0x08, 
+0xD5, 0x6F, 0xB6, 0xEF, 0x31, 0xD3, 0xB4, 0xEB, 
+0x83, 0xC9, 0x79, 0xC1, 0xD6, 0xF1, 0xD4, 0x3E, 
+0xC6, 0xA7, 0x3B, 0x8F, 0x2E, 0x19, 0x8F, 0x6C, 
+0x19, 0x0C, 0x4D, 0x6B, 0x17, 0x45, 0x28, 0xE3, 
+0xDB, 0x34, 0x0C, 0xF7, 0xD4, 0x60, 0x6C, 0x78, 
+0xE0, 0xC7, 0xBD, 0x74, 0x57, 0x7C, 0xCA, 0x57, 
+0x86, 0x8E, 0xB0, 0x23, 0x4A, 0xFF, 0xF6, 0xFA, 
+0x26, 0x43, 0xED, 0x0F, 0x88, 0xF1, 0x64, 0x06, 
+0xA1, 
+
+0x41, 0xB9, 0x7B, 0x64, 0x70, 0x3E, 0xC3, 0xD3, 
+0x54, 0x60, 0x1D, 0x8F, 0xFB, 0xE6, 0x98, 0xB7, 
+0x7C, 0xB6, 0x2A, 0x4A, 0x45, 0x86, 0x07, 0xFD, 
+0x84, 0x4D, 0xA8, 0xA7, 0x4B, 0x1F, 0x91, 0xDF, 
+0x1F, 0xFF, 0x88, 0x67, 0x96, 0x0E, 0x9F, 0x52, 
+0x66, 0xA9, 0x45, 0x39, 0x5D, 0xCA, 0x83, 0x26, 
+0x78, 0xB2, 0x84, 0x64, 0x5A, 0x73, 0xB8, 0x19, 
+0x09, 0x72, 0x16, 0x1E, 0xCF, 0x27, 0xC7, 0x37, 
+0x82, 0xA7, 0xF5, 0x1E, 0x18, 0xA0, 0x45, 0x7B, 
+0xEA, 0x45, 0xDD, 0x40, 0x78, 0x64, 0xA9, 0x37, 
+0xAE, 0x84, 0x78, 0x2F, 0xB7, 0xB2, 0xE7, 0x06, 
+0x56, 0x5F, 0xF8, 0x95, 0x53, 0xC8, 0x89, 0xE7, 
+0x2E, 0xEB, 0x7D, 0x8A, 0x9B, 0xD2, 0x00, 0x2A, 
+0xE7, 0xF2, 
+
+0x05, 0xA5, 0xAC, 0x13, 0x67, 0xD2, 0x2A, 0x57, 
+0x46, 0x75, 0xA5, 0x32, 0x21, 0x0C, 0xAA, 0xE0, 
+0x51, 0x8F, 0x64, 0x05, 0xC4, 0x3B, 0x48, 0x68, 
+0xE2, 0x3E, 0x74, 0x8B, 0x5B, 0xEF, 0x5A, 0x1D, 
+0x8C, 0x61, 0xCF, 0x5C, 0xBB, 0x1B, 0x96, 0x2A, 
+0xBF, 0x5C, 0x12, 0x03, 0x12, 0x6F, 0xBB, 0x04, 
+0x54, 0xD3, 0x9A, 0xD9, 0xE5, 0x5E, 0x17, 0x67, 
+0x26, 0x67, 0xEA, 0xF0, 0x5C, 0x57, 0xD6, 0xAF, 
+0xE2, 0xE0, 0x94, 0x99, 0x27, 0x11, 0x87, 0xAC, 
+0x34, 0xDF, 0xEF, 0x7F, 0x0B, 0xC7, 0xB4, 0x4D, 
+0xBF, 0x12, 0x2F, 0x34, 0x2A, 0xE9, 0x16, 0xB4, 
+0xD9, 0xB4, 0x63, 0xF3, 0x76, 0xA3, 0x5F, 0x9C, 
+0x4D, 0x88, 0xCE, 0xFD, 0x29, 0x58, 0xAC, 0x64, 
+0xAC, 0x22, 0xDB, 
+
+0xD5, 0x65, 0x02, 0xEE, 0xB2, 0xBF, 0x37, 0x3F, 
+0x70, 0xAD, 0xB5, 0x29, 0x92, 0x16, 0x51, 0x55, 
+0x26, 0xDE, 0x6A, 0x8A, 0x80, 0x79, 0x1E, 0xA6, 
+0x81, 0x77, 0x9C, 0x92, 0x3A, 0x17, 0x79, 0x3E, 
+0x8D, 0x32, 0xBC, 0x79, 0xC7, 0x4D, 0xF2, 0xB1, 
+0x71, 0x43, 0xE8, 0x79, 0x1C, 0xD7, 0xA3, 0x74, 
+0xEB, 0xBF, 0x78, 0x91, 0x62, 0x2A, 0xDF, 0x99, 
+0xAA, 0xA5, 0xBE, 0xA0, 0xE9, 0xC0, 0x52, 0xA6, 
+0x8E, 0x3D, 0x9D, 0x26, 0xC5, 0x28, 0xFB, 0x02, 
+0xE8, 0xDF, 0xB8, 0xC5, 0x75, 0x4C, 0x96, 0x7F, 
+0x8C, 0x69, 0xF3, 0x60, 0x6B, 0x74, 0xAC, 0xA4, 
+0x63, 0xCD, 0x21, 0x33, 0x46, 0xE7, 0x33, 0xF8, 
+0xA5, 0xD8, 0x82, 0x07, 0x1D, 0x21, 0xD5, 0xED, 
+0x55, 0xBA, 0xC8, 0x60, 
+
+0xF0, 0xC7, 0x39, 0x87, 0x09, 0x34, 0xAC, 0xDE, 
+0xCC, 0xAA, 0x22, 0x7A, 0x8D, 0x1C, 0xC8, 0xFA, 
+0xCC, 0x7E, 0xC9, 0xA1, 0x43, 0xC0, 0xC0, 0x59, 
+0xB2, 0xD0, 0x64, 0xC6, 0x48, 0xA3, 0x3F, 0x35, 
+0x3B, 0x05, 0xAF, 0x80, 0x4E, 0x2D, 0xF8, 0x85, 
+0x82, 0x53, 0xB9, 0xA6, 0xF4, 0x07, 0xA4, 0xC0, 
+0x59, 0xAA, 0x83, 0xF7, 0xB6, 0x54, 0xA4, 0xE8, 
+0x44, 0xCC, 0xE0, 0xF8, 0xF6, 0x02, 0xA3, 0x19, 
+0xD0, 0x61, 0x02, 0xC8, 0x1C, 0x12, 0x46, 0x26, 
+0xD3, 0xCE, 0x41, 0x84, 0x57, 0x9F, 0x95, 0x27, 
+0x73, 0x28, 0x60, 0x7E, 0x62, 0x55, 0x1A, 0x11, 
+0x83, 0xE2, 0x24, 0x21, 0xF3, 0x31, 0xC3, 0x6C, 
+0x7C, 0x1B, 0x6D, 0x68, 0xD6, 0x49, 0xFC, 0xF6, 
+0x2F, 0xBC, 0xA6, 0x3D, 0x36, 
+
+0x0C, 0xF2, 0x60, 0xF8, 0xF4, 0xD2, 0x13, 0xEC, 
+0x83, 0x15, 0xF1, 0xEA, 0xE4, 0xB2, 0x4E, 0x63, 
+0x7B, 0x67, 0xCA, 0xB5, 0xDA, 0x6A, 0x9D, 0x52, 
+0xE9, 0xED, 0xF2, 0xF9, 0x77, 0xC0, 0x11, 0xC9, 
+0xA0, 0x8C, 0x5D, 0x16, 0xB3, 0x4C, 0xD7, 0xA4, 
+0xAF, 0x12, 0xD5, 0x00, 0x46, 0xFD, 0xC2, 0xCA, 
+0xB2, 0x77, 0x07, 0xF9, 0xC4, 0xC6, 0xC8, 0x39, 
+0xFF, 0xEF, 0xEB, 0xD6, 0x15, 0xA2, 0x09, 0xFF, 
+0x3B, 0x2E, 0x9C, 0x04, 0xD2, 0x74, 0x0D, 0x8F, 
+0x7B, 0xA4, 0xE4, 0xE3, 0x1A, 0x80, 0x89, 0x96, 
+0x29, 0x07, 0x63, 0x8C, 0x59, 0x32, 0x64, 0x33, 
+0x5C, 0x6F, 0x34, 0x86, 0x71, 0xFC, 0xD0, 0x63, 
+0x57, 0xE5, 0xD0, 0x60, 0x31, 0xB0, 0xDE, 0x77, 
+0x98, 0x20, 0x4D, 0x7C, 0x69, 0xEE, 
+
+0x52, 0x3F, 0x39, 0xAC, 0x0C, 0x09, 0x91, 0x9B, 
+0x72, 0x78, 0x43, 0x11, 0xFE, 0xEC, 0xD7, 0xF5, 
+0x58, 0x0D, 0x32, 0xB9, 0x2E, 0x5C, 0xC9, 0x95, 
+0xA4, 0x2E, 0x3A, 0xA7, 0x16, 0xC4, 0xE1, 0x32, 
+0xE1, 0x34, 0x1C, 0x67, 0xE5, 0xCA, 0xB3, 0xE6, 
+0x8C, 0xFA, 0x97, 0xFA, 0xE7, 0x6D, 0x6A, 0x99, 
+0x21, 0x9D, 0x2E, 0xA1, 0xE8, 0x82, 0xB0, 0xC0, 
+0xD6, 0x73, 0xA6, 0x09, 0xDB, 0x1C, 0x2E, 0x79, 
+0xB9, 0x1F, 0xF4, 0xF9, 0xCD, 0xC9, 0xDA, 0x06, 
+0x27, 0x05, 0x20, 0xFB, 0x6B, 0x6B, 0x02, 0xB1, 
+0x1D, 0x20, 0x78, 0x50, 0x68, 0x60, 0xA5, 0x3D, 
+0xD9, 0xDD, 0xDC, 0xB6, 0xDB, 0x9D, 0xE8, 0x3F, 
+0x63, 0xC3, 0x40, 0x40, 0x2C, 0xC7, 0xB5, 0x2C, 
+0x33, 0x66, 0xCC, 0x0D, 0x2A, 0x16, 0x84, 
+
+0x79, 0x01, 0x97, 0x59, 0x7C, 0x72, 0x0F, 0xED, 
+0x15, 0x2B, 0xAD, 0xAF, 0x6C, 0x94, 0x7B, 0x38, 
+0xDA, 0x52, 0xC8, 0x70, 0x48, 0xF7, 0x33, 0x98, 
+0xD9, 0x6C, 0x9B, 0x39, 0x5A, 0xC0, 0xDA, 0x71, 
+0x98, 0xC6, 0x8A, 0x48, 0x1D, 0x4B, 0x68, 0x81, 
+0x39, 0x8B, 0xEB, 0xF3, 0x26, 0xAE, 0xDC, 0x74, 
+0x09, 0x1F, 0x49, 0x1C, 0x35, 0xD4, 0x0D, 0x53, 
+0x0F, 0xC2, 0x05, 0x40, 0x23, 0xBE, 0xDA, 0x8E, 
+0x57, 0x80, 0xF2, 0x10, 0xD1, 0x1B, 0xC9, 0xB4, 
+0x03, 0x2A, 0x16, 0x11, 0xBF, 0x0D, 0xCF, 0x40, 
+0x25, 0xCD, 0x4B, 0x6B, 0xB1, 0x70, 0xA8, 0x90, 
+0xC8, 0xB7, 0x1E, 0x95, 0x36, 0x1D, 0x84, 0x90, 
+0x4B, 0x9E, 0x5A, 0xFC, 0xEC, 0x0C, 0x59, 0xBF, 
+0xC6, 0x75, 0x52, 0xC0, 0xA9, 0x5C, 0xA1, 0x6E, 
+
+0x2F, 0x77, 0x64, 0x8D, 0x73, 0xD9, 0x5F, 0xDC, 
+0xB2, 0x4C, 0xA4, 0x39, 0xD6, 0xF9, 0x69, 0xC6, 
+0x16, 0x9B, 0x4F, 0x22, 0x74, 0x0F, 0x8E, 0xC5, 
+0xD5, 0x89, 0x8D, 0x09, 0x78, 0xD4, 0x51, 0x47, 
+0x96, 0xC2, 0xC7, 0xF1, 0x64, 0xD9, 0x89, 0x4E, 
+0x49, 0x6F, 0xC5, 0x02, 0x05, 0xA7, 0x1D, 0x00, 
+0x5F, 0x9D, 0xB6, 0xF1, 0xA4, 0xCE, 0x46, 0x08, 
+0x17, 0x41, 0x2F, 0xBA, 0xA2, 0x0B, 0xB0, 0xC4, 
+0x6C, 0xD4, 0xB7, 0x45, 0x35, 0x97, 0x4C, 0x8D, 
+0x60, 0xF7, 0x6C, 0x9C, 0x85, 0x95, 0xD5, 0x07, 
+0x5E, 0x45, 0xDB, 0x76, 0xF2, 0xE7, 0x6D, 0xB4, 
+0xE4, 0x88, 0x38, 0xA8, 0x21, 0xB1, 0xA6, 0x24, 
+0xC6, 0x48, 0xB3, 0x99, 0xE3, 0xCE, 0x13, 0x7B, 
+0xE2, 0x81, 0xC7, 0x0A, 0x51, 0xEE, 0x92, 0x8D, 
+0x07, 
+
+0x42, 0x6F, 0x36, 0xBB, 0x9C, 0xC8, 0x5E, 0xAF, 
+0xB7, 0xAB, 0xF0, 0x88, 0x09, 0xB2, 0x95, 0xCB, 
+0xCE, 0xF9, 0x46, 0xE2, 0x69, 0x01, 0xBE, 0x13, 
+0x30, 0xBF, 0xCC, 0x11, 0xEF, 0xBF, 0xC8, 0x2F, 
+0x7F, 0x45, 0x13, 0x67, 0x4E, 0x84, 0x2E, 0xD2, 
+0x98, 0xDE, 0x5E, 0xA0, 0xB6, 0x10, 0x35, 0x1A, 
+0xE1, 0x22, 0x1B, 0x15, 0xE1, 0xE2, 0x33, 0xBB, 
+0x04, 0xE3, 0x5D, 0x05, 0x4C, 0xAC, 0x23, 0xF5, 
+0x83, 0x52, 0xEF, 0xEB, 0xEC, 0x94, 0x60, 0x2C, 
+0xFE, 0x68, 0x16, 0xBE, 0x97, 0xB3, 0xCA, 0xCF, 
+0x43, 0x94, 0x6C, 0x19, 0xC6, 0xAA, 0xC5, 0xE3, 
+0x66, 0x77, 0x14, 0x77, 0x67, 0x21, 0x51, 0x3F, 
+0xA2, 0x63, 0x9B, 0x39, 0xB5, 0x42, 0xFD, 0xCF, 
+0x7C, 0x9C, 0xA6, 0xBA, 0x06, 0xFD, 0x27, 0x78, 
+0xE4, 0x1C, 
+
+0xA0, 0x6F, 0x49, 0xA1, 0xE7, 0xD2, 0xB8, 0x99, 
+0x3C, 0x8D, 0xB8, 0xCF, 0xBE, 0xD6, 0xAB, 0xFD, 
+0x3D, 0x23, 0xFB, 0x21, 0x23, 0xEF, 0xFF, 0x1E, 
+0x68, 0x1B, 0x09, 0x56, 0x4C, 0x6F, 0x0D, 0xF8, 
+0xAB, 0xCA, 0x5F, 0x91, 0xD9, 0x9F, 0xBE, 0x73, 
+0xCF, 0x75, 0x52, 0xA8, 0x06, 0x96, 0x23, 0x24, 
+0x1D, 0x09, 0xC0, 0x59, 0x6E, 0xF4, 0xD3, 0xDF, 
+0xFD, 0xB0, 0xD7, 0xD7, 0xDD, 0x0E, 0x25, 0xAF, 
+0xB9, 0xFE, 0x33, 0xBE, 0x93, 0x1D, 0x9A, 0x46, 
+0x3E, 0xDD, 0x83, 0x0E, 0xE2, 0x91, 0xDC, 0x4E, 
+0xC2, 0x70, 0x15, 0x21, 0x81, 0xEE, 0xCA, 0x41, 
+0x83, 0x9D, 0xDB, 0xB9, 0x05, 0x8E, 0x66, 0x88, 
+0xF7, 0x0A, 0x78, 0x93, 0xC8, 0x86, 0xFA, 0x7C, 
+0xBF, 0x12, 0xAE, 0x30, 0xF1, 0x2C, 0x7C, 0x55, 
+0xA1, 0x14, 0x76, 
+
+0xB6, 0xEB, 0x06, 0x47, 0x2E, 0xC7, 0x84, 0x2D, 
+0x80, 0x4D, 0x4F, 0xE3, 0x57, 0xAC, 0xC0, 0xB7, 
+0x49, 0xE0, 0x4C, 0x82, 0x9A, 0x87, 0x3E, 0xBC, 
+0xD3, 0x40, 0x30, 0x9D, 0xB1, 0xBD, 0x9F, 0xC5, 
+0x6F, 0x8C, 0x3A, 0x99, 0x71, 0xD8, 0x60, 0xB5, 
+0x94, 0x0F, 0x1B, 0x47, 0xCB, 0x1B, 0x07, 0x0A, 
+0x21, 0x4B, 0x0A, 0x77, 0xE1, 0xC8, 0x9A, 0x6E, 
+0xEC, 0xB6, 0xAE, 0x4E, 0xAD, 0x5A, 0xC2, 0xA0, 
+0x34, 0xC9, 0xCE, 0x81, 0x4C, 0xF2, 0x82, 0xAC, 
+0x5A, 0xEC, 0x3A, 0x8B, 0xBF, 0xE3, 0xFD, 0x02, 
+0x2A, 0x8B, 0xB6, 0x7B, 0x52, 0x3F, 0x24, 0x47, 
+0xBE, 0xBF, 0x33, 0xCD, 0x5F, 0xD9, 0x16, 0x2A, 
+0x26, 0x9E, 0xD5, 0xA7, 0x34, 0x21, 0xCF, 0xAB, 
+0x95, 0xEB, 0x9B, 0x2A, 0x76, 0x1E, 0x88, 0xED, 
+0xED, 0x4B, 0xE9, 0x5B, 
+
+0xFF, 0x39, 0x1D, 0xFC, 0x53, 0x1A, 0xFC, 0x1E, 
+0x6D, 0xB3, 0x54, 0x31, 0xEF, 0x53, 0x32, 0x5A, 
+0x2E, 0x4C, 0xEA, 0x91, 0xED, 0xC0, 0x53, 0x9F, 
+0xD7, 0x7B, 0x8A, 0xE7, 0x58, 0x5D, 0x22, 0xF5, 
+0x23, 0x1B, 0xCE, 0x51, 0x1C, 0x97, 0x4A, 0xA9, 
+0x62, 0xF5, 0x40, 0xC3, 0xC9, 0x0B, 0x99, 0x5B, 
+0xD2, 0xA1, 0x05, 0xCB, 0xEE, 0xDE, 0xDB, 0x24, 
+0x92, 0x9F, 0x4E, 0xC0, 0xCF, 0xDE, 0xEF, 0xDC, 
+0x11, 0xBD, 0x24, 0x06, 0x20, 0x8F, 0xDF, 0x1B, 
+0x43, 0x07, 0x70, 0x09, 0x89, 0x26, 0xE7, 0x4A, 
+0xE3, 0xAC, 0x4D, 0xBF, 0x97, 0x36, 0x05, 0x59, 
+0xB4, 0x99, 0xDB, 0x2E, 0x12, 0x02, 0xA4, 0xA0, 
+0x5D, 0x20, 0xC5, 0x14, 0x6A, 0x2B, 0xBD, 0x96, 
+0xB4, 0xB5, 0x95, 0xBB, 0xDF, 0xC3, 0xFD, 0xBB, 
+0x03, 0x53, 0x64, 0xE8, 0x8A, 
+
+0xCC, 0xF4, 0x1B, 0x7D, 0xB1, 0x38, 0x5E, 0x9E, 
+0xB7, 0xEA, 0xB8, 0x21, 0xC8, 0xF2, 0x5F, 0x3C, 
+0x17, 0x56, 0xE3, 0xAA, 0xFD, 0x95, 0x58, 0x8B, 
+0xD3, 0xD1, 0x4F, 0x12, 0xDD, 0x75, 0xB2, 0xBD, 
+0x56, 0x04, 0x8A, 0x90, 0xEB, 0xF3, 0x03, 0xF7, 
+0x7B, 0xE1, 0x45, 0x8D, 0x0B, 0xB2, 0xE4, 0x61, 
+0xDD, 0xEC, 0x80, 0xB9, 0xBC, 0x4B, 0x2E, 0xE0, 
+0x3B, 0xEB, 0x7C, 0x78, 0x77, 0xE1, 0x7F, 0x42, 
+0xD9, 0x5C, 0xE0, 0xAB, 0xA9, 0x0E, 0xA9, 0x7C, 
+0xA1, 0xD0, 0x44, 0x9B, 0x3D, 0x02, 0x3B, 0x67, 
+0x9B, 0x80, 0xAB, 0xE3, 0xB4, 0x50, 0xE9, 0x50, 
+0x9F, 0xC6, 0x94, 0x33, 0xD2, 0x0F, 0x83, 0x68, 
+0x71, 0xC4, 0xB3, 0x44, 0xC7, 0x54, 0xF9, 0xAE, 
+0x47, 0x62, 0x92, 0x16, 0x8F, 0xC7, 0x59, 0x76, 
+0x9C, 0xAE, 0x67, 0x57, 0xE6, 0xCA, 
+
+0x28, 0x55, 0x5F, 0xC6, 0xDA, 0x45, 0x89, 0x72, 
+0xE7, 0xC5, 0xFF, 0x2D, 0xC1, 0xBA, 0x1C, 0x9B, 
+0x7A, 0xB9, 0x28, 0xB9, 0x7A, 0x67, 0x9C, 0xA2, 
+0xA1, 0x9F, 0xE8, 0xBA, 0xB3, 0xFF, 0xDE, 0x4F, 
+0xEF, 0x53, 0x50, 0xEB, 0x51, 0x53, 0x6B, 0x55, 
+0xE3, 0xB1, 0x44, 0x61, 0xCB, 0xCD, 0x27, 0xC6, 
+0x91, 0x01, 0x9F, 0x78, 0xC4, 0x49, 0x34, 0xF7, 
+0x84, 0x94, 0x52, 0x2C, 0x06, 0x91, 0x08, 0xD2, 
+0xC4, 0x03, 0x33, 0xEC, 0xD4, 0xE1, 0xFE, 0x20, 
+0x7B, 0x43, 0x39, 0xAD, 0x5B, 0x0F, 0x55, 0xAC, 
+0x73, 0xDC, 0x96, 0xAA, 0x9D, 0xA2, 0xA6, 0xCF, 
+0x28, 0x01, 0xA7, 0x39, 0x85, 0x05, 0x11, 0x25, 
+0x6E, 0x0B, 0x8D, 0xAF, 0x95, 0x80, 0xBB, 0x07, 
+0xD0, 0xE2, 0xAE, 0xD5, 0x92, 0x64, 0xE0, 0x26, 
+0x12, 0xE3, 0x6B, 0x25, 0x33, 0x68, 0x4D, 
+
+0x6B, 0xD7, 0x49, 0x8F, 0x4D, 0x28, 0xB6, 0x19, 
+0x0F, 0xF2, 0x91, 0xE0, 0xAF, 0x7E, 0x62, 0x16, 
+0x1C, 0x98, 0x01, 0x43, 0xAE, 0x25, 0xB2, 0x7F, 
+0x2D, 0xE7, 0x86, 0x7B, 0x23, 0xDC, 0x1B, 0x1D, 
+0x53, 0x4E, 0xFD, 0x49, 0xC3, 0x7C, 0xCE, 0xCB, 
+0x9D, 0xCE, 0xD3, 0x21, 0x93, 0x5F, 0x61, 0x42, 
+0x2C, 0xAB, 0x42, 0x96, 0x02, 0x2A, 0xEF, 0xF9, 
+0xE4, 0x27, 0xF6, 0xB2, 0x65, 0x98, 0x8F, 0x30, 
+0x67, 0xC8, 0x8B, 0x54, 0x88, 0x9C, 0xEA, 0x5C, 
+0x49, 0xB0, 0x23, 0xE3, 0x99, 0xA9, 0x83, 0xC4, 
+0x65, 0x3A, 0xB2, 0xFD, 0xA5, 0xD5, 0x53, 0x67, 
+0x5A, 0x7F, 0xAC, 0x76, 0xF3, 0x98, 0xB6, 0xFC, 
+0x1A, 0xC3, 0x92, 0xC4, 0x94, 0xD6, 0x27, 0x0E, 
+0xBF, 0x5A, 0x55, 0x50, 0x01, 0x76, 0x97, 0x54, 
+0xC6, 0xCC, 0xB9, 0x78, 0xA9, 0x4D, 0xD8, 0x47, 
+
+0xD7, 0x03, 0x62, 0x8A, 0x3E, 0x02, 0xA7, 0x9A, 
+0x75, 0xB8, 0x04, 0x19, 0xA6, 0x1A, 0xE4, 0x9C, 
+0x76, 0x51, 0x56, 0xF4, 0x7E, 0x7D, 0xDA, 0x30, 
+0xC4, 0xEA, 0x8E, 0x5C, 0x69, 0xDB, 0x20, 0xE1, 
+0x23, 0x8B, 0x4C, 0xD1, 0x39, 0x9E, 0x1C, 0xE0, 
+0x3A, 0xF5, 0xDC, 0xE0, 0x6E, 0xB8, 0xD0, 0x33, 
+0x8F, 0x0D, 0x7E, 0x7F, 0x76, 0x52, 0xD3, 0xE5, 
+0xD4, 0x2E, 0xE6, 0x82, 0x9E, 0xB3, 0xDE, 0x5A, 
+0x64, 0xB4, 0xBD, 0xDD, 0x72, 0x5F, 0x5F, 0xED, 
+0xB2, 0x59, 0x8E, 0x34, 0x99, 0xDE, 0xE2, 0x50, 
+0x13, 0x42, 0x87, 0xDF, 0x23, 0x82, 0xE0, 0xC9, 
+0xF5, 0x3B, 0xE6, 0x92, 0xF8, 0xA5, 0x18, 0xFD, 
+0xB3, 0x53, 0x80, 0xD2, 0x57, 0xE5, 0xA9, 0xE2, 
+0xA3, 0x53, 0xBF, 0x04, 0xA4, 0x9A, 0x96, 0xAB, 
+0xD8, 0xC6, 0x74, 0x04, 0x96, 0xF2, 0x74, 0x2F, 
+0x8C, 
+
+0x61, 0xD6, 0xC2, 0x0D, 0xCD, 0x05, 0xE8, 0x43, 
+0x04, 0x51, 0x13, 0x06, 0x7F, 0xC3, 0x2B, 0xF7, 
+0xEC, 0x94, 0xBB, 0x8C, 0x01, 0x3C, 0xA3, 0x0D, 
+0xB6, 0x80, 0x76, 0x4C, 0xC6, 0x76, 0x52, 0x0B, 
+0x6C, 0x30, 0x96, 0x8B, 0x93, 0xF8, 0x64, 0x96, 
+0x86, 0x25, 0x99, 0x38, 0x65, 0x88, 0x73, 0x05, 
+0xFB, 0xE6, 0xEE, 0x75, 0xBE, 0x49, 0x1C, 0xA0, 
+0xF2, 0xA5, 0x53, 0xA6, 0x7A, 0x6D, 0xA6, 0x34, 
+0xFF, 0x87, 0x0C, 0x7E, 0x00, 0xEC, 0x8B, 0x6C, 
+0xA9, 0x09, 0x45, 0xA2, 0xE6, 0xB4, 0x27, 0x93, 
+0x68, 0x14, 0xDD, 0x72, 0x69, 0x18, 0x66, 0xF5, 
+0xF5, 0x6A, 0x63, 0x46, 0xD8, 0xB2, 0x72, 0xC5, 
+0xF6, 0xE3, 0xC6, 0x06, 0xE9, 0xE3, 0x69, 0x9A, 
+0x7A, 0x49, 0x09, 0xF0, 0xD5, 0x90, 0xFA, 0xA9, 
+0xB5, 0xCA, 0xBD, 0x41, 0x21, 0x49, 0xDD, 0x22, 
+0xBC, 0x70, 
+
+0x7E, 0x8D, 0xFD, 0x68, 0x1D, 0xE0, 0x74, 0x44, 
+0x58, 0x0F, 0x81, 0x25, 0xAB, 0x9A, 0x16, 0xFA, 
+0xFA, 0xA0, 0xB7, 0x01, 0x7E, 0x6E, 0xA1, 0xA7, 
+0xA5, 0xE7, 0xBE, 0xDD, 0xD2, 0x3B, 0xAD, 0xB8, 
+0xF4, 0xCF, 0x10, 0xF7, 0x71, 0x84, 0x74, 0xAC, 
+0xCC, 0xD0, 0xFF, 0xC7, 0x52, 0x28, 0xE0, 0x7B, 
+0x3F, 0xD7, 0x14, 0x66, 0x74, 0x09, 0x08, 0xA6, 
+0xD1, 0x35, 0x9C, 0xC6, 0xC5, 0xEA, 0x91, 0x8C, 
+0x35, 0x3A, 0x32, 0x37, 0xC0, 0x4A, 0xEF, 0xB0, 
+0xE3, 0x5D, 0xA3, 0x55, 0x5F, 0x80, 0x69, 0xEA, 
+0xDF, 0x4C, 0xF2, 0x51, 0xE8, 0x61, 0x05, 0xB0, 
+0x96, 0x86, 0x90, 0x60, 0xC3, 0x1E, 0xBB, 0x0B, 
+0x49, 0x73, 0x75, 0xD7, 0x2C, 0x72, 0x35, 0x43, 
+0x67, 0x91, 0xCD, 0x8B, 0xDF, 0x07, 0xFD, 0x9D, 
+0x59, 0x35, 0x4A, 0x3D, 0xDF, 0xAF, 0xC3, 0xDA, 
+0xF5, 0x8E, 0x60, 
+
+0x39, 0xE1, 0xE2, 0xCA, 0x6C, 0xE0, 0x94, 0x7C, 
+0xC9, 0xA0, 0x07, 0x63, 0x6C, 0x09, 0x0C, 0x06, 
+0x83, 0x70, 0x4F, 0xFE, 0xD1, 0x83, 0x99, 0x00, 
+0xD9, 0xAE, 0xC5, 0x2A, 0x7F, 0x7F, 0x39, 0xA5, 
+0xDA, 0xF2, 0x11, 0xBA, 0xE7, 0x7F, 0xD1, 0xF2, 
+0xF4, 0x6B, 0xA5, 0x13, 0x81, 0xFC, 0xCE, 0xB0, 
+0x8C, 0xF7, 0xC7, 0xB7, 0xA8, 0x9F, 0xB8, 0x45, 
+0x55, 0xD0, 0x59, 0xB7, 0x38, 0x55, 0x40, 0xB7, 
+0x72, 0xE5, 0xE2, 0xE4, 0xCF, 0x9B, 0x0D, 0xE7, 
+0x55, 0x26, 0x0D, 0x00, 0x76, 0x82, 0xCC, 0x2B, 
+0xCB, 0x84, 0x4B, 0x85, 0xD6, 0xB2, 0x4D, 0xB4, 
+0xF7, 0x33, 0xE9, 0xAF, 0xA7, 0xD5, 0xF5, 0x75, 
+0x0A, 0xB0, 0xD4, 0xDA, 0x83, 0xBB, 0x36, 0xD5, 
+0xBE, 0x64, 0x23, 0x83, 0xE9, 0x54, 0x98, 0x84, 
+0x8B, 0x29, 0x5D, 0x5E, 0xFA, 0xBB, 0x7C, 0x16, 
+0x01, 0x28, 0x7B, 0xA7, 
+
+0xAA, 0x39, 0x6F, 0xA2, 0x8D, 0x28, 0xAA, 0x6B, 
+0x9D, 0x39, 0x21, 0x6B, 0xCF, 0x7A, 0x76, 0xFF, 
+0x93, 0xA2, 0xAB, 0xBB, 0xE4, 0xE2, 0x68, 0xD7, 
+0xA3, 0xCF, 0xC4, 0x37, 0xFD, 0x85, 0x8F, 0xFA, 
+0x0D, 0x1A, 0xB3, 0x46, 0x94, 0xC3, 0x39, 0xB1, 
+0x86, 0x11, 0x58, 0x3E, 0x30, 0xE0, 0x79, 0x84, 
+0xB6, 0xD4, 0x66, 0x29, 0x6A, 0x6B, 0x74, 0x6B, 
+0xE1, 0x8A, 0xED, 0x9E, 0x30, 0x7A, 0x70, 0x98, 
+0xCC, 0x87, 0xBE, 0x93, 0xCD, 0x88, 0x1D, 0x38, 
+0xC1, 0xB5, 0x69, 0xFF, 0x07, 0xAF, 0x19, 0x8B, 
+0xE0, 0x5B, 0xCD, 0x60, 0xFC, 0xDA, 0x68, 0xA0, 
+0x20, 0xFF, 0xF4, 0xF1, 0x87, 0xCF, 0xE5, 0x56, 
+0xF2, 0xAA, 0xE1, 0xB4, 0x1D, 0x68, 0xFE, 0xE9, 
+0x22, 0xA5, 0xBA, 0x3C, 0xC3, 0xAF, 0xAF, 0x2E, 
+0x6D, 0x7F, 0x07, 0xD9, 0x1B, 0x1C, 0xF7, 0x74, 
+0xD0, 0x6B, 0xF4, 0x9D, 0x68, 
+
+0xD7, 0xCE, 0x47, 0x7F, 0x15, 0x20, 0x3D, 0xDF, 
+0x09, 0xE3, 0xED, 0xDB, 0x7B, 0x51, 0x16, 0xE2, 
+0x0B, 0x6A, 0x85, 0xF3, 0xE8, 0xC5, 0x04, 0x8D, 
+0x3A, 0x47, 0x1D, 0xEC, 0xAC, 0x65, 0x30, 0x97, 
+0x87, 0xA2, 0x88, 0x25, 0x5E, 0x80, 0xFE, 0x2D, 
+0xAF, 0xFC, 0x37, 0x6E, 0x22, 0xB9, 0x52, 0xA1, 
+0xB0, 0x56, 0xDC, 0x2C, 0x0B, 0xF1, 0x1B, 0x19, 
+0x9F, 0xC4, 0x9A, 0xE5, 0x96, 0x6C, 0x21, 0xD5, 
+0xC9, 0x63, 0x44, 0x8E, 0xBF, 0x68, 0x58, 0x19, 
+0x76, 0x61, 0x00, 0x6D, 0x31, 0xC8, 0x80, 0x76, 
+0x84, 0x91, 0x47, 0x3E, 0x12, 0xC4, 0xEB, 0x0D, 
+0x5B, 0x07, 0xD8, 0xA6, 0xEF, 0x54, 0x08, 0xD9, 
+0x4B, 0xE5, 0x6B, 0x6C, 0x2A, 0xA9, 0xF5, 0x9A, 
+0x16, 0x08, 0xC6, 0x6E, 0x6E, 0x66, 0xB6, 0xDF, 
+0x33, 0x02, 0xA5, 0x02, 0xF0, 0xC0, 0xE3, 0x01, 
+0xB3, 0x45, 0x97, 0x02, 0xF0, 0xF6, 
+
+0xE4, 0x8B, 0x6A, 0x5E, 0xB7, 0x15, 0x9E, 0x32, 
+0x58, 0xFE, 0x1D, 0x17, 0x2D, 0xDD, 0xFB, 0x8A, 
+0x49, 0x66, 0x12, 0x94, 0xB1, 0x73, 0x3D, 0xE2, 
+0x69, 0xA2, 0x06, 0xF3, 0x75, 0x6C, 0xC5, 0x7F, 
+0x38, 0xFA, 0xFB, 0xE8, 0x43, 0x24, 0xFF, 0x55, 
+0xD4, 0x40, 0x48, 0x71, 0xB0, 0x0C, 0x43, 0x91, 
+0x6F, 0xE7, 0x09, 0x69, 0x79, 0x1B, 0xD0, 0xD5, 
+0xFB, 0xD8, 0xCB, 0x71, 0x25, 0xFC, 0x28, 0x86, 
+0x4D, 0x62, 0xF1, 0xF0, 0xE0, 0x68, 0x25, 0x92, 
+0xA3, 0x62, 0xDE, 0xA6, 0x19, 0xEB, 0x05, 0x37, 
+0x79, 0x25, 0xCF, 0x41, 0xEC, 0xBC, 0x02, 0x86, 
+0x0A, 0x4E, 0xD3, 0x79, 0x18, 0xB5, 0x57, 0x6F, 
+0x35, 0xE9, 0x66, 0x9B, 0x5C, 0x4E, 0xFF, 0x76, 
+0xA9, 0xD4, 0xFE, 0xD6, 0x52, 0x1B, 0x05, 0x54, 
+0x45, 0x20, 0xEA, 0x7D, 0x07, 0x80, 0x7E, 0x26, 
+0x3B, 0x8A, 0x66, 0x1D, 0x2E, 0xCB, 0x9F, 
+
+0xB4, 0x4B, 0x0F, 0x6F, 0xE9, 0xBD, 0xD1, 0xC3, 
+0x62, 0xB6, 0x90, 0x9D, 0xDC, 0xC2, 0x95, 0xC9, 
+0x3A, 0xD4, 0x6D, 0xF7, 0x70, 0xEE, 0x83, 0x7D, 
+0xD2, 0x5C, 0x02, 0x03, 0xDB, 0x9B, 0x12, 0xDE, 
+0x8A, 0x2F, 0x15, 0xA2, 0x28, 0xCF, 0xDE, 0xCE, 
+0xC5, 0x86, 0xE1, 0x67, 0x4A, 0xE7, 0x62, 0x83, 
+0x10, 0x08, 0x21, 0x69, 0x92, 0x55, 0x98, 0x39, 
+0x91, 0x07, 0xA4, 0xA7, 0xD2, 0xB7, 0x64, 0x0A, 
+0xE6, 0x03, 0x4D, 0xB3, 0x1C, 0x48, 0xC0, 0xF9, 
+0x59, 0x6F, 0xF5, 0xFA, 0xBB, 0xD4, 0xA7, 0x12, 
+0x18, 0x15, 0x4F, 0x7C, 0xCA, 0x2A, 0x48, 0xD8, 
+0xA9, 0xA1, 0x06, 0xDC, 0x0F, 0xDC, 0xA7, 0x2F, 
+0xF4, 0xA3, 0x7C, 0x35, 0xDC, 0x83, 0xD8, 0x54, 
+0x2C, 0x32, 0xB2, 0xC2, 0xAD, 0x7A, 0xCB, 0x42, 
+0x5A, 0xE7, 0xA4, 0xB2, 0xF4, 0xA1, 0xEE, 0x7B, 
+0xA2, 0x3C, 0x20, 0x02, 0xC2, 0x60, 0xC3, 0xF1, 
+
+0x2F, 0x2E, 0x93, 0x88, 0xDC, 0x1A, 0x65, 0x7D, 
+0x22, 0x0C, 0x93, 0x88, 0x22, 0xEF, 0x10, 0xD2, 
+0x46, 0xB2, 0xDA, 0xA6, 0xFA, 0x06, 0xE8, 0xD9, 
+0x1F, 0x69, 0x3F, 0xE3, 0xAE, 0xED, 0xC3, 0x00, 
+0xE8, 0x59, 0x10, 0xB0, 0x5F, 0x5D, 0x76, 0xAE, 
+0xD9, 0x55, 0xD6, 0x85, 0xBF, 0xCB, 0x84, 0xDB, 
+0xAD, 0xB8, 0xB2, 0x94, 0x17, 0xB8, 0x07, 0xFF, 
+0x71, 0x04, 0x88, 0x40, 0x46, 0x63, 0x57, 0xAC, 
+0xC9, 0x38, 0x62, 0x80, 0x1A, 0xD1, 0xCE, 0xC4, 
+0x16, 0x61, 0x8A, 0x9F, 0xF1, 0xD1, 0x5D, 0xD1, 
+0x96, 0xF0, 0xE9, 0x0D, 0xA3, 0xAF, 0x4D, 0x23, 
+0x10, 0xF9, 0x1D, 0x61, 0x7B, 0xD2, 0x83, 0x29, 
+0x30, 0x5B, 0xC0, 0x8E, 0x96, 0xB3, 0xD0, 0x0E, 
+0xA2, 0xB2, 0x1A, 0x67, 0x0D, 0x26, 0x6B, 0xA4, 
+0x8A, 0xD5, 0x2B, 0xB3, 0x72, 0x1D, 0x89, 0x09, 
+0x56, 0x19, 0xCB, 0xBC, 0x5F, 0xE7, 0x50, 0xC2, 
+0x43, 
+
+0xFB, 0x5E, 0x4A, 0x44, 0x39, 0xA4, 0x3E, 0xCF, 
+0x56, 0x0B, 0xCA, 0x64, 0x2C, 0xFD, 0x2F, 0x0F, 
+0x38, 0x4D, 0x0A, 0xAC, 0xFC, 0x93, 0xE5, 0x9B, 
+0x92, 0x13, 0xB0, 0x39, 0x72, 0x31, 0xB5, 0xDB, 
+0x77, 0x4B, 0x7E, 0x05, 0xE0, 0x75, 0xF7, 0xDB, 
+0x4D, 0x3B, 0x00, 0x3F, 0x73, 0x45, 0xE3, 0xB7, 
+0x43, 0xF7, 0xF3, 0x8D, 0xD8, 0xCD, 0x7C, 0xFC, 
+0x9E, 0xFC, 0xAC, 0xA3, 0xEA, 0x04, 0x70, 0xAF, 
+0x7F, 0x5C, 0x92, 0x03, 0xC5, 0x88, 0xC8, 0x06, 
+0x7B, 0x12, 0xF9, 0x6A, 0xCF, 0x5D, 0x78, 0xE5, 
+0xC7, 0xFB, 0xFA, 0x16, 0x5F, 0xFF, 0x77, 0x94, 
+0x63, 0x58, 0xFB, 0xA1, 0xEC, 0x65, 0x3B, 0x0C, 
+0xA7, 0xC0, 0xEE, 0xAF, 0xAF, 0x4A, 0xB9, 0x04, 
+0xD7, 0x16, 0x26, 0xE9, 0x98, 0x32, 0xBC, 0xE7, 
+0x43, 0x91, 0x79, 0xFF, 0xE5, 0x8D, 0x0B, 0xAF, 
+0x29, 0x20, 0xB2, 0x63, 0x03, 0xDD, 0x08, 0x28, 
+0xBD, 0x7D, 
+
+0x99, 0x49, 0x9A, 0xC4, 0xCA, 0xCA, 0xD5, 0x63, 
+0x52, 0xEB, 0x35, 0xA3, 0x92, 0x92, 0xDE, 0x55, 
+0x54, 0xE8, 0xB1, 0xF2, 0x6F, 0x29, 0xD1, 0x05, 
+0xD9, 0xF9, 0x95, 0x13, 0xCE, 0x04, 0xB1, 0xDF, 
+0x3F, 0xC0, 0x29, 0xEC, 0x12, 0x8C, 0xCB, 0x70, 
+0xB6, 0xFB, 0xE1, 0x9B, 0x53, 0x2A, 0xF9, 0x5D, 
+0x07, 0xDE, 0x8C, 0x06, 0x15, 0x6D, 0xC8, 0xC8, 
+0xA1, 0x5B, 0xC3, 0x16, 0xFB, 0x73, 0xB2, 0x79, 
+0x1C, 0x1D, 0x72, 0xD7, 0x00, 0x46, 0xA2, 0xC5, 
+0xA3, 0x41, 0x22, 0xD4, 0xF5, 0xB6, 0x10, 0xE1, 
+0x50, 0xD9, 0x42, 0xDE, 0x02, 0x8F, 0x6D, 0x5A, 
+0x16, 0x03, 0x8B, 0x96, 0x5C, 0x0E, 0xB3, 0xBA, 
+0x72, 0x70, 0x84, 0x75, 0x82, 0xC8, 0xFD, 0xCE, 
+0xA5, 0xA9, 0x58, 0xB7, 0x7A, 0x7F, 0x36, 0x19, 
+0x79, 0xC4, 0xE7, 0x2F, 0x83, 0x8E, 0x01, 0x05, 
+0x17, 0x9A, 0xBE, 0x64, 0x0D, 0x27, 0x46, 0x69, 
+0x6C, 0xCA, 0xE3, 
+
+0xFC, 0xA7, 0xFC, 0x4A, 0x8D, 0xEF, 0x63, 0x70, 
+0x36, 0xD4, 0x34, 0x19, 0x9B, 0x7B, 0x3D, 0x4B, 
+0x08, 0xA3, 0x2F, 0xC9, 0x10, 0x28, 0xC2, 0xCA, 
+0x46, 0x72, 0xEB, 0xCE, 0xFD, 0x27, 0xEB, 0x6E, 
+0xB3, 0xEF, 0x14, 0x4C, 0x8A, 0x74, 0x7F, 0xCB, 
+0x40, 0x4E, 0x24, 0xED, 0x02, 0xA8, 0x45, 0xAB, 
+0x31, 0x1A, 0xC7, 0x28, 0xEB, 0xC3, 0x68, 0xE1, 
+0xA4, 0x86, 0x85, 0xE6, 0x30, 0xC1, 0xB5, 0x51, 
+0xF1, 0xBF, 0xF2, 0x16, 0xB5, 0xDE, 0x46, 0x2C, 
+0xE4, 0xB3, 0xF5, 0xAE, 0x29, 0x65, 0x74, 0x1C, 
+0x56, 0x3A, 0x55, 0x75, 0x85, 0x7C, 0x5C, 0xE1, 
+0xC9, 0x92, 0x77, 0x7B, 0xE6, 0xA0, 0xAB, 0x3C, 
+0xCC, 0xD4, 0xDF, 0xA4, 0x03, 0xCF, 0x42, 0x06, 
+0x54, 0x7F, 0x82, 0xC5, 0x8A, 0x21, 0x4C, 0x05, 
+0x3C, 0x54, 0xFC, 0x42, 0x67, 0x25, 0xCD, 0x7E, 
+0xCC, 0x3B, 0xB0, 0xF3, 0x10, 0xA6, 0x28, 0x0A, 
+0x47, 0x66, 0x7D, 0xD1, 
+
+0xE8, 0x89, 0xE4, 0x24, 0x4C, 0xD5, 0x69, 0x2C, 
+0xA8, 0xB1, 0xE2, 0x7D, 0x57, 0xEF, 0xC7, 0x23, 
+0x57, 0xC9, 0x37, 0x4D, 0xAC, 0xB9, 0x9D, 0x51, 
+0xE5, 0x0B, 0x87, 0x96, 0x90, 0x3C, 0x2D, 0xC5, 
+0x9D, 0x53, 0x17, 0x7F, 0x9C, 0x3A, 0x33, 0xE3, 
+0xE3, 0x1F, 0xA3, 0xC9, 0xEA, 0xAB, 0x68, 0xF3, 
+0x92, 0x90, 0x03, 0xF3, 0xDB, 0x23, 0xDB, 0x0F, 
+0x7F, 0x17, 0x2E, 0x81, 0xBD, 0x31, 0x9F, 0x4F, 
+0x76, 0x63, 0x76, 0xC1, 0x00, 0x7D, 0x3D, 0x9C, 
+0x48, 0x04, 0x2B, 0xC6, 0x36, 0xE7, 0x82, 0xF2, 
+0x92, 0xA1, 0xAB, 0x14, 0x14, 0x9D, 0x58, 0xBF, 
+0xD7, 0xD8, 0x01, 0xC7, 0x31, 0x22, 0x31, 0xE3, 
+0x5F, 0xA1, 0xA1, 0x2B, 0xCA, 0xD8, 0x02, 0xEF, 
+0xBF, 0xA7, 0x23, 0x76, 0x25, 0x00, 0xC5, 0xCE, 
+0xA5, 0xFD, 0x4D, 0xD1, 0x2B, 0xFC, 0x8B, 0xA5, 
+0x44, 0xD0, 0x2B, 0x48, 0x58, 0x3D, 0x86, 0x4D, 
+0xB1, 0x89, 0xDF, 0x04, 0xCA, 
+
+0xC1, 0xBA, 0x5D, 0x77, 0xC4, 0xA5, 0x5D, 0x86, 
+0xB4, 0x9B, 0xB2, 0xE9, 0x17, 0x7B, 0x3F, 0x46, 
+0xAA, 0x2A, 0x5D, 0x79, 0xCA, 0x8E, 0x8A, 0x0A, 
+0x52, 0xF1, 0x32, 0x1D, 0x56, 0x18, 0x19, 0x61, 
+0xC4, 0xF7, 0x93, 0x9C, 0x76, 0xE5, 0x7C, 0xAC, 
+0xE8, 0xB2, 0x2C, 0xD4, 0x7C, 0x86, 0x1D, 0x3C, 
+0xB5, 0x7D, 0x62, 0x41, 0x77, 0xA6, 0x49, 0xDA, 
+0x69, 0xBB, 0x69, 0x31, 0x75, 0xCB, 0xEE, 0xE7, 
+0x30, 0x6D, 0x6E, 0xAE, 0x44, 0x91, 0x0A, 0x18, 
+0xE6, 0x8A, 0x37, 0xE1, 0x7D, 0xBA, 0x4F, 0x7F, 
+0xC6, 0x5B, 0x2D, 0xBE, 0xBE, 0x43, 0x45, 0x65, 
+0x17, 0x47, 0x94, 0x52, 0xAF, 0xFC, 0x8B, 0x2B, 
+0x27, 0x3D, 0x1D, 0x9D, 0x50, 0x1D, 0xAA, 0xBC, 
+0x40, 0xD8, 0xE7, 0x62, 0xB0, 0x11, 0x3A, 0xCF, 
+0xFF, 0x2D, 0xDC, 0x45, 0xAC, 0xD4, 0x53, 0xCC, 
+0x3D, 0x25, 0x2B, 0xC2, 0xEE, 0x40, 0x26, 0xA7, 
+0x62, 0xFD, 0xC5, 0xDA, 0x58, 0x97, 
+
+0x5C, 0x59, 0x39, 0x7C, 0x2F, 0xCC, 0x34, 0x0E, 
+0xAE, 0xA9, 0x0A, 0xAC, 0x74, 0xFB, 0xFC, 0xB8, 
+0xAD, 0x72, 0xA6, 0xBB, 0x92, 0x57, 0x97, 0x6B, 
+0x2F, 0x8A, 0x8D, 0xA9, 0xA6, 0xEF, 0x08, 0x15, 
+0x80, 0x38, 0x46, 0xE2, 0x93, 0x66, 0x2B, 0x04, 
+0xEB, 0xA8, 0xC6, 0xF5, 0xD7, 0x28, 0xF1, 0xC1, 
+0x28, 0x8C, 0xEB, 0x39, 0x50, 0x67, 0x5C, 0x67, 
+0x6A, 0xAC, 0x02, 0x82, 0x97, 0xB3, 0x1C, 0xDB, 
+0xEF, 0x3A, 0x39, 0x85, 0x21, 0x37, 0x04, 0x60, 
+0xC6, 0x32, 0x6A, 0x47, 0xD6, 0xE1, 0x54, 0xF1, 
+0xAE, 0x37, 0x2A, 0x67, 0x92, 0x6B, 0x1D, 0x3E, 
+0x2E, 0x78, 0x75, 0x6D, 0xCD, 0x33, 0x88, 0x15, 
+0x6C, 0xAC, 0x94, 0xF5, 0xF8, 0x52, 0xD6, 0xC2, 
+0x71, 0x10, 0x02, 0xC1, 0xC5, 0x19, 0x8C, 0xCF, 
+0x1D, 0x17, 0x53, 0xAD, 0x5C, 0xCE, 0xE8, 0x89, 
+0x5E, 0x7C, 0xF7, 0x15, 0xEC, 0x16, 0x3B, 0xC5, 
+0x28, 0xF1, 0x68, 0x1D, 0x03, 0x72, 0xF5, 
+
+0x96, 0x43, 0x5E, 0x3D, 0x68, 0xDA, 0x62, 0x72, 
+0xD6, 0x54, 0x45, 0xE5, 0x9D, 0xD5, 0x57, 0x02, 
+0x14, 0xCC, 0xF6, 0x43, 0x49, 0x7F, 0x47, 0xBB, 
+0x7D, 0x9E, 0x60, 0xA6, 0xE1, 0x67, 0x3E, 0x27, 
+0x8A, 0xD1, 0x09, 0x8B, 0x47, 0xAA, 0xC3, 0x8E, 
+0xDA, 0x7F, 0x2E, 0x68, 0x99, 0xE8, 0x91, 0x7C, 
+0xBB, 0xBF, 0x2F, 0x43, 0x5C, 0xAD, 0x5F, 0x01, 
+0x95, 0xDB, 0xF9, 0xE2, 0x14, 0xFE, 0xB0, 0x8B, 
+0x00, 0xF9, 0xE7, 0x65, 0xFF, 0x74, 0x05, 0xB2, 
+0x22, 0xEB, 0x17, 0xB1, 0xE0, 0x4E, 0x11, 0xEB, 
+0x84, 0x95, 0x05, 0x96, 0x5B, 0x2A, 0x61, 0xAA, 
+0x13, 0x4D, 0x68, 0xD1, 0xF8, 0x44, 0x70, 0x6B, 
+0x6F, 0x00, 0xD4, 0xAD, 0x1F, 0x1A, 0x6A, 0xF4, 
+0xAD, 0x6D, 0x3F, 0xFC, 0x23, 0x42, 0x00, 0x20, 
+0xB9, 0x98, 0xB8, 0xC7, 0xF5, 0xF2, 0x65, 0x4A, 
+0x53, 0x64, 0x62, 0xD2, 0x3F, 0xDD, 0x96, 0xE8, 
+0x14, 0xE8, 0xE3, 0x6F, 0xEC, 0xCD, 0x31, 0x59, 
+
+0x32, 0x49, 0xB7, 0xFF, 0x4E, 0x26, 0x60, 0xC8, 
+0xF0, 0x81, 0x9A, 0xF9, 0x89, 0xF6, 0x18, 0x1C, 
+0xB2, 0x79, 0x77, 0xD7, 0xAA, 0x68, 0x78, 0xB6, 
+0x74, 0x80, 0x8C, 0x40, 0xF1, 0xA3, 0xA9, 0xF4, 
+0x5D, 0x7E, 0xC6, 0x5E, 0x99, 0x05, 0x3C, 0xD9, 
+0xF4, 0xA3, 0x88, 0xC5, 0x78, 0xDD, 0x5B, 0x31, 
+0x03, 0x45, 0x1D, 0x7A, 0x07, 0x6E, 0x4F, 0xB7, 
+0x03, 0x49, 0x76, 0xD4, 0xF4, 0xCC, 0xD1, 0x37, 
+0x1D, 0x85, 0x8E, 0x01, 0x88, 0x0C, 0x5E, 0xC1, 
+0x4B, 0xAC, 0xA8, 0x8B, 0xC8, 0x3E, 0xBE, 0x7C, 
+0x5D, 0x91, 0x01, 0xEA, 0x72, 0x36, 0xCC, 0x3F, 
+0x73, 0x61, 0xFD, 0x4E, 0xAA, 0x27, 0xA7, 0x33, 
+0xEB, 0x1B, 0xDD, 0x77, 0xFD, 0xBB, 0xA4, 0x91, 
+0xC6, 0x06, 0xE7, 0x4C, 0xDD, 0xFF, 0xDE, 0x3A, 
+0xC0, 0x17, 0xE6, 0x8E, 0x3F, 0xBE, 0x75, 0x4F, 
+0xA7, 0x78, 0x2F, 0xEA, 0x54, 0x4A, 0xE7, 0x18, 
+0x32, 0x24, 0xDF, 0x11, 0xA3, 0xA1, 0x32, 0xE0, 
+0x1B, 
+
+0xC9, 0x51, 0x10, 0x2E, 0xA3, 0xC7, 0x48, 0x7E, 
+0x18, 0x65, 0x41, 0xE0, 0xB3, 0x0B, 0x71, 0x25, 
+0x56, 0xB6, 0xD0, 0xCA, 0xB9, 0x6A, 0x0C, 0xAF, 
+0xE9, 0x38, 0xBD, 0xB6, 0x8E, 0xD2, 0x6A, 0x5D, 
+0x3F, 0xD5, 0x9C, 0xCB, 0xBC, 0x6C, 0xD6, 0x42, 
+0x31, 0xE1, 0x1E, 0x69, 0xA4, 0x26, 0xAB, 0x4D, 
+0xB0, 0x0D, 0xF5, 0x0F, 0x72, 0x57, 0xE8, 0xE6, 
+0x74, 0x2E, 0x88, 0x38, 0x4F, 0x04, 0x20, 0xF2, 
+0x9A, 0x43, 0xEF, 0xEB, 0xC9, 0xB5, 0xDA, 0xFA, 
+0xA2, 0x92, 0x5F, 0x31, 0x77, 0x08, 0xDD, 0x0F, 
+0xB3, 0x1A, 0xBF, 0x0D, 0x0E, 0x88, 0x77, 0x67, 
+0x44, 0x9E, 0xBD, 0xF3, 0x73, 0x8A, 0xC9, 0xDB, 
+0xF5, 0xA7, 0x28, 0x3F, 0xF0, 0x2E, 0x4D, 0x8F, 
+0xE7, 0x83, 0x00, 0x27, 0x44, 0x0C, 0x73, 0x45, 
+0x2A, 0x1E, 0xA6, 0x53, 0x38, 0x36, 0x68, 0x10, 
+0xE9, 0x63, 0x28, 0xFA, 0x09, 0x86, 0xF2, 0x9E, 
+0xD9, 0xB6, 0xE8, 0x00, 0x47, 0x25, 0xEB, 0x88, 
+0x21, 0x9C, 
+
+0x09, 0x4F, 0x2F, 0x1E, 0x46, 0xC9, 0x38, 0xAE, 
+0xD2, 0xDE, 0x1E, 0x41, 0xA9, 0x77, 0xA0, 0xA2, 
+0xCC, 0x30, 0x35, 0x45, 0x81, 0x61, 0x92, 0x4B, 
+0x2D, 0x12, 0x38, 0x17, 0xAD, 0xA7, 0x5B, 0xF5, 
+0xF3, 0x76, 0xB0, 0x1B, 0x77, 0x80, 0x7D, 0xFB, 
+0x19, 0xAE, 0xAF, 0x26, 0x71, 0x90, 0x33, 0xE9, 
+0xE7, 0xFD, 0x6A, 0x28, 0xFC, 0xC7, 0xFE, 0x68, 
+0xF7, 0x9C, 0xE2, 0x8A, 0x3C, 0x5B, 0x2D, 0x47, 
+0x4D, 0xC7, 0x96, 0x1E, 0x1E, 0x30, 0xBD, 0xD0, 
+0xB3, 0x99, 0x2A, 0x9B, 0x1E, 0x07, 0x44, 0xBE, 
+0xFF, 0xE7, 0xE1, 0xAC, 0xA8, 0x64, 0x27, 0x76, 
+0xFF, 0x28, 0xF3, 0x84, 0x4A, 0xF9, 0x23, 0x46, 
+0x2E, 0x23, 0xFB, 0xC5, 0xBD, 0xAF, 0x00, 0xB4, 
+0xDE, 0xF7, 0x00, 0xC5, 0x1F, 0x28, 0x19, 0x1D, 
+0x4C, 0x09, 0xDC, 0x79, 0xF5, 0xC8, 0xEE, 0x2B, 
+0x5B, 0x5A, 0x1A, 0xF1, 0xAA, 0x22, 0xF3, 0xFB, 
+0x0D, 0xEB, 0x0E, 0xC1, 0x4A, 0x9C, 0xCC, 0x0E, 
+0xD5, 0xA2, 0xB4, 
+
+0xB4, 0xEA, 0xFD, 0x1D, 0xB0, 0xC8, 0x29, 0x82, 
+0x62, 0xEB, 0x14, 0x5D, 0x71, 0x0F, 0x11, 0x72, 
+0x52, 0x9F, 0x7C, 0x16, 0xD2, 0x75, 0x07, 0x96, 
+0x8A, 0x44, 0xD8, 0xC9, 0x89, 0x16, 0x34, 0x0A, 
+0x77, 0xA0, 0xF6, 0x1E, 0x4D, 0xDA, 0x73, 0x89, 
+0x8A, 0xCA, 0xAC, 0xEF, 0xF5, 0xD6, 0xDC, 0x7A, 
+0x2A, 0x10, 0x8A, 0xDA, 0xC5, 0x7C, 0x53, 0x57, 
+0xDC, 0x9E, 0x78, 0x9C, 0xB5, 0xAB, 0x75, 0xC7, 
+0xD4, 0x6F, 0xEC, 0x42, 0x12, 0x90, 0x82, 0xDC, 
+0x75, 0xF0, 0x4A, 0x5B, 0xB8, 0x6E, 0x2A, 0xC8, 
+0xBB, 0x73, 0x34, 0xB1, 0x69, 0x21, 0x81, 0x00, 
+0x17, 0x2B, 0x7B, 0xB6, 0xFF, 0xD3, 0xBA, 0xA7, 
+0x96, 0xB9, 0xE4, 0xE7, 0x57, 0xC9, 0x0E, 0xE8, 
+0x9A, 0x01, 0xA2, 0xBA, 0x7C, 0xA0, 0xCC, 0x6C, 
+0x30, 0xDF, 0xD5, 0xB2, 0x13, 0xBF, 0xBC, 0x96, 
+0xEA, 0x59, 0x35, 0x82, 0xF1, 0x4B, 0xF9, 0x3D, 
+0x1E, 0x36, 0x26, 0x14, 0x5D, 0x5F, 0x83, 0xD7, 
+0x84, 0xD5, 0x47, 0x57, 
+
+0x9E, 0xC0, 0x83, 0xEB, 0xB0, 0x50, 0xB8, 0x36, 
+0x77, 0xCF, 0xE1, 0x63, 0xA6, 0x54, 0xFD, 0x78, 
+0x06, 0xC8, 0xE0, 0x32, 0xE9, 0x6E, 0x44, 0x20, 
+0x66, 0x2A, 0xD2, 0x97, 0x62, 0x04, 0xC4, 0x22, 
+0x2C, 0x83, 0x10, 0x8D, 0x98, 0x87, 0xC3, 0x82, 
+0x6A, 0xC6, 0xAD, 0x14, 0x20, 0x9F, 0x4D, 0x69, 
+0xA6, 0xA1, 0x70, 0x04, 0x16, 0xD8, 0x9C, 0x2D, 
+0x63, 0x65, 0x35, 0x2D, 0x34, 0x8C, 0x97, 0x1C, 
+0xC2, 0x13, 0xC9, 0x14, 0x79, 0x81, 0xF3, 0x6D, 
+0xF0, 0x85, 0xE4, 0x62, 0xFC, 0x16, 0x88, 0x1B, 
+0x0A, 0xFE, 0x34, 0x7D, 0xE4, 0x55, 0xCE, 0x97, 
+0x93, 0x84, 0x47, 0xCF, 0xF4, 0xEA, 0x8D, 0xB1, 
+0x96, 0x2B, 0x9A, 0x55, 0xF7, 0x4D, 0xAD, 0xF7, 
+0x34, 0xCD, 0x77, 0x1D, 0x37, 0x92, 0x97, 0x6B, 
+0x0A, 0x1E, 0xF7, 0xA7, 0xB5, 0xB5, 0xE7, 0xEF, 
+0x80, 0x21, 0x64, 0x77, 0xBA, 0x0A, 0x6A, 0xFE, 
+0x96, 0x3E, 0xEC, 0x3B, 0xBD, 0x1B, 0x68, 0xEB, 
+0x25, 0xEB, 0xA9, 0x8A, 0x38, 
+
+0x14, 0x77, 0x30, 0x12, 0x9D, 0x28, 0xF7, 0xC8, 
+0xC7, 0x2B, 0xDC, 0xC0, 0x56, 0xCC, 0x21, 0xB3, 
+0x73, 0x25, 0xC8, 0x8E, 0x40, 0x9A, 0xA2, 0x1C, 
+0xFE, 0xFC, 0x5F, 0xF2, 0x8B, 0x42, 0x9F, 0x2C, 
+0xE3, 0x13, 0xF6, 0x6B, 0x16, 0xB8, 0x59, 0xF4, 
+0x5B, 0x9F, 0x84, 0x80, 0x12, 0x45, 0x05, 0x81, 
+0x82, 0x5D, 0x44, 0xEE, 0x8D, 0x3C, 0x25, 0x57, 
+0xFD, 0x0A, 0x53, 0x82, 0xEC, 0xE4, 0x45, 0xB7, 
+0xD3, 0xF8, 0x47, 0x8B, 0x93, 0xC3, 0xA6, 0x94, 
+0xAD, 0xCB, 0x54, 0x64, 0xBF, 0xA9, 0x09, 0x0C, 
+0xAF, 0x3B, 0x4E, 0x7D, 0x11, 0x03, 0x16, 0xBC, 
+0xCF, 0x48, 0x32, 0xC6, 0xB2, 0xA3, 0xD4, 0x35, 
+0x7B, 0x87, 0x66, 0x90, 0x5B, 0x4A, 0xF8, 0x33, 
+0x18, 0xA3, 0x38, 0xA5, 0x4B, 0x87, 0x72, 0x03, 
+0xCA, 0x88, 0x28, 0xF0, 0x60, 0xD3, 0x39, 0xBD, 
+0x78, 0x9C, 0x61, 0x38, 0xDB, 0xDA, 0x15, 0x2A, 
+0x7C, 0xC0, 0xCA, 0xAA, 0xD3, 0x2E, 0xF3, 0x16, 
+0xD9, 0xAF, 0xF1, 0x55, 0xE2, 0x40, 
+
+0x98, 0xD7, 0x95, 0xBC, 0x6A, 0x19, 0xBD, 0x92, 
+0x1A, 0x3D, 0xE0, 0xFB, 0x62, 0x39, 0x57, 0x1E, 
+0x8A, 0x4D, 0x2E, 0xA5, 0x71, 0x71, 0x85, 0x05, 
+0x7E, 0x02, 0x16, 0x0A, 0x20, 0xDD, 0xC7, 0x98, 
+0x69, 0x90, 0x59, 0x88, 0x83, 0xA9, 0x3A, 0x34, 
+0x03, 0x4B, 0xFC, 0xBC, 0xFA, 0x0F, 0xA2, 0xB0, 
+0xA3, 0xC7, 0x8E, 0x86, 0x65, 0xF0, 0x24, 0x4E, 
+0xF5, 0xD2, 0x35, 0xB5, 0x8B, 0xA2, 0x98, 0x0C, 
+0xBA, 0x72, 0x2D, 0xB3, 0xFF, 0x91, 0x86, 0x42, 
+0xE3, 0x7E, 0x51, 0xDC, 0x64, 0x60, 0x3D, 0xF6, 
+0xB7, 0xAE, 0x38, 0x93, 0x0B, 0x8F, 0xD4, 0x37, 
+0x79, 0xD6, 0x98, 0x1D, 0x84, 0x61, 0x46, 0x4D, 
+0xF1, 0x38, 0x5A, 0xEE, 0xFA, 0x8A, 0xE8, 0xF8, 
+0x8B, 0x46, 0x4C, 0xA4, 0xA3, 0x1C, 0x2D, 0xE9, 
+0x12, 0x15, 0x53, 0xBB, 0xBA, 0x2E, 0x50, 0x7B, 
+0xE9, 0x02, 0x84, 0xC3, 0x3C, 0xA1, 0xCB, 0xB0, 
+0x28, 0x61, 0xAA, 0xB5, 0x93, 0x54, 0xAD, 0xB6, 
+0x5E, 0x1F, 0xC9, 0x96, 0xBE, 0xE9, 0x3E, 
+
+0x94, 0xCD, 0xD8, 0x5A, 0x65, 0xF6, 0xC2, 0xF9, 
+0xC0, 0x7D, 0x51, 0x92, 0x98, 0xC0, 0x1C, 0x99, 
+0x83, 0x23, 0xF0, 0x12, 0x27, 0xC2, 0xD8, 0xC5, 
+0xF2, 0x8C, 0xE9, 0xC8, 0x35, 0x8E, 0x63, 0xC3, 
+0xB4, 0x5E, 0xD9, 0x65, 0x2B, 0x07, 0x60, 0x8A, 
+0xA1, 0x1D, 0x1B, 0x21, 0x47, 0x98, 0x1A, 0x32, 
+0x0A, 0x20, 0x71, 0x91, 0x78, 0xD1, 0xDD, 0x51, 
+0xF6, 0x3A, 0xD4, 0x9B, 0xCA, 0x41, 0x0A, 0x64, 
+0xE2, 0xCB, 0x9C, 0x55, 0x21, 0xAC, 0x18, 0x9A, 
+0xBC, 0x85, 0xED, 0xE5, 0xE8, 0x6D, 0x73, 0x66, 
+0xBF, 0xA3, 0xDA, 0x2B, 0x45, 0x31, 0x67, 0x19, 
+0x1E, 0xFE, 0xE5, 0x4E, 0x35, 0x66, 0x20, 0x11, 
+0x37, 0x3D, 0xA5, 0x31, 0x7F, 0x60, 0x8F, 0xA1, 
+0x26, 0x77, 0x81, 0x9A, 0x1F, 0x5E, 0x6E, 0x8E, 
+0x00, 0xA3, 0xED, 0xF7, 0x95, 0x1D, 0x6D, 0x7E, 
+0x56, 0x0B, 0xBB, 0xA9, 0xF7, 0x0D, 0xCA, 0xA6, 
+0xFF, 0x7A, 0x3E, 0xBA, 0xB5, 0x64, 0xD0, 0x9B, 
+0xFE, 0xDA, 0x8F, 0x75, 0xCE, 0xC1, 0xA2, 0x91, 
+
+0x16, 0x48, 0x04, 0xE3, 0xAF, 0x20, 0xB6, 0xD6, 
+0x2C, 0x89, 0x21, 0xA6, 0xF9, 0x19, 0x88, 0xD3, 
+0x7C, 0x65, 0x61, 0x3D, 0xFE, 0xD1, 0x0B, 0xB2, 
+0xE0, 0x8B, 0xAD, 0x1E, 0x52, 0xAA, 0x37, 0xC8, 
+0x0D, 0x28, 0x23, 0xC8, 0x47, 0x0D, 0xB8, 0x4A, 
+0xCB, 0x8A, 0xA0, 0xCB, 0x0B, 0xE6, 0xAB, 0x53, 
+0xB5, 0x95, 0x3D, 0x56, 0x5B, 0x02, 0x41, 0xBE, 
+0xDC, 0xBA, 0xF9, 0xDA, 0xC3, 0x6A, 0xF6, 0x43, 
+0x2C, 0xED, 0x9D, 0xB8, 0x03, 0x51, 0x1D, 0x0C, 
+0x2A, 0xB6, 0x8E, 0x84, 0x2D, 0x42, 0x27, 0x65, 
+0x31, 0xD1, 0x66, 0xA2, 0x69, 0x3F, 0x19, 0x86, 
+0x6C, 0x8F, 0xCD, 0x7F, 0x25, 0xEF, 0x33, 0xBD, 
+0x45, 0x61, 0x43, 0xC7, 0x19, 0xFD, 0x00, 0xED, 
+0x38, 0xAA, 0x95, 0xCE, 0x60, 0x26, 0x77, 0x86, 
+0xA8, 0x53, 0x0C, 0xA9, 0xBB, 0x26, 0x82, 0xCD, 
+0x13, 0xDB, 0x36, 0x41, 0xDC, 0x71, 0x1D, 0x67, 
+0x2B, 0x67, 0xEC, 0x16, 0xA6, 0x7B, 0xE8, 0xB1, 
+0x33, 0x65, 0x57, 0x6C, 0xD3, 0x2F, 0x8B, 0xE5, 
+0xAD, 
+
+0x8C, 0x65, 0x5F, 0xA9, 0x99, 0x10, 0x61, 0x8A, 
+0x77, 0x11, 0x9F, 0x13, 0x76, 0xBB, 0x86, 0x0C, 
+0x48, 0xB1, 0x1B, 0x39, 0xFF, 0x74, 0x9F, 0xC9, 
+0x37, 0xE2, 0x50, 0xB8, 0xF8, 0x97, 0x6A, 0x19, 
+0x9F, 0xCB, 0x60, 0xED, 0x50, 0xEF, 0xE0, 0xBF, 
+0x2E, 0x83, 0x4B, 0x75, 0xE9, 0x4E, 0x71, 0x6E, 
+0xC1, 0x99, 0x7B, 0x79, 0x8A, 0x09, 0x3F, 0x71, 
+0x82, 0x89, 0xBC, 0xD2, 0x2C, 0x8D, 0x38, 0x86, 
+0x7F, 0x02, 0x30, 0x3A, 0x7E, 0x18, 0x9A, 0xFA, 
+0xD8, 0x55, 0x9D, 0x22, 0xAF, 0x11, 0x50, 0x14, 
+0x6E, 0x57, 0x38, 0x59, 0x73, 0xF8, 0x98, 0x14, 
+0x46, 0xDB, 0xF1, 0xC4, 0x66, 0xB7, 0x6D, 0xD9, 
+0x69, 0x56, 0xCC, 0x12, 0x5B, 0xA4, 0x75, 0x3A, 
+0x8E, 0x32, 0x21, 0xFC, 0x91, 0x4F, 0x1B, 0x08, 
+0xA7, 0xCE, 0x2E, 0x59, 0x4A, 0x10, 0x8E, 0x7D, 
+0x4D, 0xDD, 0x84, 0xE7, 0xCC, 0x10, 0x3E, 0x83, 
+0x63, 0xF8, 0x68, 0x6F, 0x34, 0xF4, 0xF3, 0x53, 
+0x7B, 0xC2, 0x8F, 0xC7, 0x75, 0x19, 0xB2, 0x67, 
+0x7D, 0x6B, 
+
+0x41, 0x2B, 0xE7, 0xFF, 0xDE, 0x0A, 0xDB, 0xCD, 
+0xF4, 0xCE, 0x07, 0xF5, 0x34, 0x3E, 0x6B, 0xB5, 
+0x91, 0x03, 0x18, 0x40, 0x2D, 0x3E, 0xB4, 0xC8, 
+0x3F, 0x1E, 0x14, 0x47, 0x84, 0x58, 0x9C, 0xF4, 
+0xC0, 0x4D, 0x49, 0xDD, 0xD4, 0x37, 0x1E, 0xDF, 
+0xFD, 0x64, 0x38, 0xFC, 0x4E, 0xA4, 0xA5, 0xA2, 
+0xDA, 0xF6, 0x32, 0xDE, 0x8F, 0xDA, 0x22, 0xF1, 
+0xC5, 0xEF, 0xD8, 0x20, 0x7B, 0xBF, 0xB4, 0xB4, 
+0x70, 0x3A, 0xA1, 0xF2, 0xBF, 0xF7, 0x08, 0x2B, 
+0x0A, 0x13, 0xAA, 0x69, 0x56, 0x70, 0x18, 0x1B, 
+0x11, 0x7E, 0x93, 0x48, 0x76, 0x2B, 0x40, 0x0B, 
+0xA0, 0x2B, 0x20, 0x43, 0x3B, 0x2F, 0x19, 0x2A, 
+0xF6, 0x0D, 0xA3, 0x34, 0x30, 0x73, 0x47, 0x17, 
+0x2A, 0xEA, 0x93, 0xC7, 0x5F, 0x6E, 0xDB, 0x16, 
+0x4F, 0x51, 0x69, 0x8C, 0x82, 0x87, 0xF6, 0xB4, 
+0x3D, 0xD8, 0x4A, 0xEE, 0xBD, 0xF9, 0xA3, 0xE9, 
+0xB6, 0xDD, 0x70, 0x08, 0x4E, 0x86, 0xA8, 0xDA, 
+0x52, 0xF9, 0x44, 0x10, 0x5E, 0xAB, 0xF8, 0x48, 
+0x04, 0xE2, 0x37, 
+
+0x6C, 0xA6, 0x56, 0x6E, 0xA2, 0xC8, 0x4F, 0x0C, 
+0xA1, 0xFC, 0xB3, 0xEB, 0x17, 0xD7, 0xE0, 0xC1, 
+0x1B, 0xF7, 0x29, 0x3D, 0x9A, 0x46, 0x17, 0x9E, 
+0x50, 0x87, 0x09, 0xAD, 0x31, 0xF7, 0x4C, 0x8B, 
+0x59, 0xB2, 0xF1, 0xD1, 0xC9, 0xD5, 0x4B, 0x63, 
+0x62, 0x01, 0xF8, 0x53, 0xA4, 0x96, 0x04, 0xFE, 
+0x7E, 0xE6, 0xE4, 0xDA, 0x37, 0x81, 0xD0, 0xF0, 
+0x06, 0xDA, 0x0B, 0x0F, 0xEE, 0xB5, 0x7C, 0x17, 
+0xF1, 0xC9, 0xCF, 0x4F, 0x08, 0xF8, 0xB3, 0x95, 
+0xE7, 0xF9, 0x8D, 0xD3, 0x8F, 0x2C, 0x5E, 0xED, 
+0x95, 0xCC, 0x34, 0xF3, 0xD5, 0xC0, 0x1F, 0x50, 
+0xC9, 0x43, 0x89, 0xC5, 0xB4, 0x70, 0x3A, 0xD3, 
+0xB6, 0xB2, 0xF7, 0x93, 0x70, 0x1A, 0xAA, 0x98, 
+0xF1, 0x52, 0xD6, 0x01, 0xB9, 0x5E, 0x66, 0xE2, 
+0x8B, 0x51, 0x84, 0x0C, 0xE2, 0xA1, 0x39, 0xB5, 
+0xFA, 0x74, 0xF6, 0xE6, 0xB0, 0x61, 0xE3, 0xC2, 
+0xA3, 0x6E, 0xEC, 0xDF, 0x12, 0xA4, 0x5C, 0xCA, 
+0x19, 0x01, 0x22, 0xE4, 0x12, 0x75, 0x8B, 0x2A, 
+0x1C, 0xCD, 0xF0, 0x7F, 
+
+0x0C, 0x76, 0x81, 0x42, 0xEF, 0x1E, 0xDC, 0x66, 
+0x86, 0x46, 0xE1, 0x52, 0xA5, 0xC9, 0x8F, 0x15, 
+0xED, 0x30, 0x7D, 0x72, 0x64, 0xEA, 0xF3, 0xF0, 
+0xDA, 0xDE, 0x7F, 0x2B, 0x11, 0x39, 0x9B, 0xBA, 
+0x16, 0xF3, 0x0E, 0x33, 0x2F, 0x9F, 0x0E, 0x10, 
+0x9A, 0xCE, 0x70, 0x6A, 0x33, 0x5A, 0x54, 0x85, 
+0xB3, 0x54, 0x01, 0x8C, 0x37, 0x6B, 0x48, 0x6C, 
+0xDF, 0x85, 0x85, 0x5D, 0x59, 0x74, 0x91, 0x9B, 
+0x84, 0x33, 0x8C, 0xE9, 0x26, 0xCC, 0xB5, 0x61, 
+0x7A, 0xC7, 0x54, 0xAC, 0x76, 0xF3, 0x2B, 0xE0, 
+0x19, 0x74, 0x9F, 0xAD, 0xBF, 0x60, 0xEA, 0xB7, 
+0xF1, 0xF0, 0x2E, 0x52, 0x97, 0x34, 0x46, 0xC7, 
+0xED, 0x06, 0x2D, 0x6B, 0xFD, 0x8C, 0x35, 0x4B, 
+0xDD, 0xD6, 0x9C, 0xB5, 0x5B, 0x91, 0x4E, 0xBA, 
+0xA1, 0xF6, 0x19, 0xD9, 0xC7, 0x8B, 0x51, 0x18, 
+0x27, 0x9F, 0x9A, 0x5B, 0x55, 0x71, 0x78, 0xA1, 
+0x9C, 0x4A, 0xE1, 0xA4, 0x0A, 0x41, 0x6C, 0xFC, 
+0x6D, 0x9D, 0x9C, 0x5A, 0x6F, 0xC6, 0xF7, 0xCB, 
+0x8E, 0xFD, 0x38, 0x46, 0x16, 
+
+0x04, 0xF5, 0x75, 0x57, 0xC0, 0x47, 0xB4, 0x0B, 
+0x15, 0x8A, 0x90, 0x62, 0xA3, 0x11, 0x8E, 0x22, 
+0x97, 0x18, 0x36, 0x78, 0x17, 0x1C, 0x14, 0x96, 
+0xBA, 0xCA, 0xD1, 0xBB, 0x23, 0xC5, 0xD5, 0x94, 
+0xFE, 0xE7, 0x80, 0x38, 0x13, 0xD6, 0x4F, 0x36, 
+0x97, 0xAD, 0xD4, 0xF5, 0xF6, 0xF8, 0xE7, 0x68, 
+0x0C, 0x78, 0x3B, 0x83, 0x29, 0x9C, 0xBB, 0x77, 
+0x22, 0x3C, 0x47, 0x1A, 0x9A, 0xDD, 0x62, 0xF7, 
+0x3E, 0x08, 0x31, 0x40, 0xC9, 0x59, 0x14, 0x7A, 
+0x32, 0xAE, 0x89, 0x5F, 0x65, 0x52, 0x5D, 0x22, 
+0x85, 0x1A, 0x73, 0xA1, 0x9C, 0x80, 0xC6, 0x79, 
+0xC3, 0x47, 0x1A, 0x73, 0xCE, 0x33, 0x02, 0x70, 
+0x80, 0x7B, 0x60, 0xCC, 0xE2, 0x85, 0xDD, 0xFA, 
+0x4A, 0x56, 0x43, 0x2A, 0xC2, 0xC0, 0xBC, 0x90, 
+0xB4, 0x54, 0x2D, 0x60, 0x9D, 0x7B, 0x54, 0x7C, 
+0x5C, 0x83, 0x1B, 0xA4, 0xF6, 0x54, 0xD8, 0x57, 
+0x37, 0xB4, 0xB6, 0x8D, 0x27, 0xAC, 0xBD, 0x9D, 
+0x11, 0x83, 0xB6, 0x1C, 0x26, 0xC1, 0xC4, 0xA0, 
+0x7D, 0xF1, 0xCC, 0x45, 0x21, 0xFE, 
+
+0x04, 0x9D, 0xEB, 0x09, 0x8D, 0xD3, 0xE4, 0xB0, 
+0x9D, 0x2F, 0xBA, 0x03, 0x9D, 0x7D, 0x26, 0x9D, 
+0x3F, 0x75, 0x8C, 0xEF, 0x63, 0x92, 0xBB, 0x75, 
+0x93, 0x41, 0x24, 0x7D, 0x46, 0xF4, 0xD4, 0xBC, 
+0xE7, 0x56, 0x19, 0x5F, 0x09, 0x05, 0x00, 0x09, 
+0xCF, 0x67, 0xAE, 0x13, 0x78, 0xD7, 0xF2, 0xD3, 
+0x86, 0x0B, 0x8D, 0xD2, 0xC5, 0xB2, 0xF9, 0x72, 
+0xD8, 0x8B, 0xD1, 0x3F, 0xCC, 0x49, 0xF1, 0xD2, 
+0x76, 0xBE, 0x8D, 0x6F, 0x77, 0x2C, 0x94, 0x26, 
+0x3F, 0xA4, 0xFA, 0x6B, 0x02, 0xD3, 0x92, 0x16, 
+0x69, 0xA3, 0x4C, 0x74, 0xB2, 0xF0, 0x4D, 0x0B, 
+0xE8, 0xA0, 0xBA, 0x15, 0x65, 0x80, 0x81, 0x20, 
+0x8B, 0x30, 0x0E, 0xAF, 0x8F, 0xF5, 0x32, 0x17, 
+0xB5, 0x36, 0x0C, 0x0A, 0x2C, 0x6F, 0x32, 0xA7, 
+0xF2, 0xFC, 0xEC, 0x1E, 0x21, 0xB7, 0x9F, 0xCE, 
+0x35, 0x83, 0x8C, 0x1C, 0xA5, 0xAA, 0x8E, 0xA0, 
+0xEA, 0xA7, 0x6E, 0x0F, 0x36, 0xF3, 0xBC, 0xBA, 
+0xD0, 0xBB, 0x09, 0x18, 0xA7, 0x4B, 0xA5, 0xF0, 
+0x23, 0xA7, 0x22, 0x35, 0x7E, 0xC5, 0x05, 
+
+0xE2, 0x80, 0xFD, 0x5F, 0x03, 0x30, 0xF1, 0x6C, 
+0xCD, 0x63, 0xE9, 0x10, 0x9B, 0x15, 0xCC, 0x59, 
+0x58, 0x88, 0xC3, 0x03, 0x5B, 0x22, 0xF1, 0x0E, 
+0x3B, 0xA4, 0xD8, 0x6B, 0xCA, 0x4A, 0x50, 0xB1, 
+0x84, 0xE5, 0xD3, 0xEE, 0xCB, 0x38, 0xBA, 0xB9, 
+0xDD, 0xF7, 0x30, 0x5B, 0x3E, 0x09, 0x7B, 0x24, 
+0xC6, 0xC1, 0x3E, 0x38, 0xA6, 0x02, 0xD4, 0x01, 
+0x0C, 0xC8, 0x75, 0xCE, 0x07, 0xE8, 0x29, 0x76, 
+0xF3, 0xA7, 0xE5, 0x6A, 0x44, 0x9D, 0x68, 0xC0, 
+0x94, 0x58, 0x21, 0x91, 0x8E, 0xCF, 0xE4, 0xB4, 
+0xF8, 0x39, 0xA7, 0x47, 0xCF, 0x23, 0xFD, 0xC2, 
+0x7F, 0x25, 0xAF, 0x83, 0xA6, 0x2A, 0xA9, 0xD4, 
+0x6E, 0x50, 0xD7, 0x70, 0xD7, 0x47, 0x77, 0x6F, 
+0xC3, 0x45, 0xA1, 0x94, 0x37, 0x4B, 0x9D, 0x2A, 
+0xFF, 0xC1, 0xE8, 0xFF, 0xB5, 0x10, 0x58, 0xDE, 
+0x9A, 0x16, 0xF4, 0xFB, 0x0C, 0xE6, 0x86, 0x82, 
+0xA9, 0x90, 0x04, 0xE5, 0x14, 0x22, 0x87, 0x96, 
+0x9F, 0xDA, 0x67, 0x47, 0x21, 0xB0, 0xB0, 0x1F, 
+0x33, 0x6A, 0x99, 0x8B, 0x2B, 0xEC, 0x40, 0x5F, 
+
+0xC4, 0xAA, 0x2A, 0x33, 0x56, 0xA1, 0x12, 0xD2, 
+0xB1, 0x8A, 0xCF, 0x6B, 0xBC, 0x2E, 0x1A, 0x91, 
+0x38, 0x8D, 0x8E, 0xD3, 0xCE, 0xFE, 0x98, 0x41, 
+0xD7, 0xA9, 0x74, 0x59, 0x5D, 0xF9, 0xA0, 0x20, 
+0x62, 0x35, 0x9E, 0x92, 0x7C, 0x7B, 0x75, 0xEF, 
+0x06, 0xF2, 0x50, 0xE2, 0x04, 0xB4, 0xBA, 0x5C, 
+0x9B, 0x73, 0xCA, 0x8D, 0x9D, 0x7D, 0x16, 0xC6, 
+0x79, 0x50, 0x02, 0x9F, 0xE1, 0xE9, 0xDB, 0xF9, 
+0x68, 0xF6, 0x8D, 0xC4, 0x6C, 0x75, 0x46, 0x51, 
+0x6D, 0x31, 0x04, 0x7D, 0xB6, 0x32, 0xDE, 0x8F, 
+0x8F, 0xE0, 0xF8, 0x4A, 0x48, 0x08, 0xBE, 0xDE, 
+0x23, 0x3D, 0xD1, 0xEB, 0xF1, 0x75, 0xBE, 0xA4, 
+0x33, 0x8C, 0x96, 0xC1, 0xEC, 0x55, 0x6E, 0xBA, 
+0xE4, 0xB6, 0x25, 0x67, 0x47, 0x66, 0xCD, 0x4B, 
+0x5D, 0x73, 0x6D, 0x22, 0x63, 0x88, 0x64, 0x21, 
+0x8D, 0x25, 0xF0, 0xE7, 0x49, 0x01, 0x1E, 0xBA, 
+0xC1, 0xC7, 0x76, 0xDB, 0xB4, 0xB7, 0x6E, 0x92, 
+0x35, 0x7B, 0x0A, 0x91, 0x3A, 0x78, 0x5D, 0xAA, 
+0x00, 0x9F, 0xD9, 0xD2, 0xC5, 0x17, 0x74, 0x21, 
+0x8A, 
+
+0xE5, 0x7E, 0xBD, 0x71, 0x1C, 0x91, 0x59, 0xAD, 
+0xE4, 0xE2, 0xA4, 0xBB, 0xB2, 0x2D, 0x14, 0x2E, 
+0xB9, 0x9A, 0xAC, 0x9A, 0x72, 0xAA, 0xAF, 0x67, 
+0x60, 0xBE, 0x54, 0xB8, 0xAD, 0xDA, 0xF9, 0x82, 
+0x69, 0x17, 0x3F, 0x02, 0x92, 0xE0, 0xD9, 0x03, 
+0x91, 0x07, 0xC8, 0xE8, 0xE9, 0x27, 0x6C, 0x84, 
+0x09, 0xA0, 0xA3, 0xDB, 0x88, 0xA1, 0xC7, 0xCC, 
+0xC9, 0x6F, 0x77, 0xD5, 0x45, 0xEA, 0xF5, 0xA0, 
+0xEE, 0x94, 0xF5, 0x23, 0xD2, 0x8C, 0x92, 0x43, 
+0xF2, 0x62, 0xA7, 0x9E, 0x22, 0x9A, 0xBC, 0xC3, 
+0x2A, 0x8A, 0x4C, 0x58, 0xE1, 0x71, 0xFA, 0xAF, 
+0xD7, 0x58, 0x9B, 0x95, 0x69, 0x72, 0xB2, 0xEB, 
+0x3F, 0xEE, 0xE5, 0xE8, 0x23, 0x0B, 0xF4, 0xB9, 
+0xAB, 0x24, 0xC1, 0x09, 0x63, 0x7C, 0xBE, 0x65, 
+0xB8, 0xA2, 0x48, 0x94, 0xBB, 0x95, 0xC3, 0xA2, 
+0x1A, 0x1A, 0xC6, 0x3D, 0xB1, 0x64, 0x55, 0x8A, 
+0x44, 0x70, 0xE6, 0x7F, 0xCF, 0x16, 0x0B, 0xFD, 
+0xC6, 0x55, 0xE4, 0xD7, 0xD1, 0x2F, 0xB7, 0x3E, 
+0x99, 0xD0, 0x71, 0x51, 0xA0, 0xE0, 0x5B, 0xC2, 
+0x12, 0x6C, 
+
+0x8E, 0x62, 0x24, 0x5E, 0x39, 0xCE, 0x0A, 0x96, 
+0xF5, 0x31, 0x43, 0xFF, 0x29, 0xC3, 0xF2, 0x71, 
+0xA1, 0xB0, 0x0D, 0x8E, 0xD4, 0x13, 0x34, 0xBB, 
+0xAE, 0xF6, 0xD3, 0xA0, 0x3E, 0xE4, 0x5D, 0xAE, 
+0xC7, 0xEB, 0xB6, 0xCF, 0x1E, 0x1D, 0xEA, 0xF0, 
+0x2B, 0x77, 0xE6, 0x88, 0x9F, 0x63, 0x73, 0xC2, 
+0x68, 0xC9, 0xD5, 0xAD, 0x78, 0xF2, 0x85, 0x74, 
+0x47, 0xBE, 0xB5, 0x71, 0x20, 0xB2, 0xB1, 0xB9, 
+0x57, 0x2F, 0xF9, 0xB7, 0x61, 0x78, 0x6B, 0x46, 
+0xD0, 0xE0, 0xA3, 0xC6, 0x42, 0x72, 0x73, 0x42, 
+0xA1, 0xEF, 0xC3, 0x47, 0x65, 0xB5, 0xA1, 0xD6, 
+0x11, 0xD5, 0x4B, 0x2E, 0xC2, 0xE6, 0x14, 0xE3, 
+0x1F, 0x38, 0x72, 0x3F, 0x67, 0x81, 0x9E, 0xD2, 
+0x02, 0x60, 0xF5, 0x9D, 0x91, 0x26, 0xB6, 0x7F, 
+0xEF, 0x2E, 0x52, 0x34, 0xB0, 0x77, 0xBC, 0xCC, 
+0xC2, 0xB6, 0xCE, 0xE3, 0x88, 0x53, 0xCB, 0xA0, 
+0x38, 0xE3, 0x01, 0xB6, 0x07, 0xB4, 0xC1, 0xC6, 
+0xC1, 0x5B, 0xF0, 0x2A, 0x91, 0xD5, 0xE3, 0xED, 
+0xF1, 0xAA, 0xE9, 0xBB, 0xDE, 0x6B, 0x78, 0x41, 
+0xEE, 0x34, 0x5F, 
+
+0x04, 0xF4, 0xD3, 0x7E, 0xC0, 0xCA, 0xCF, 0xB2, 
+0x48, 0xF3, 0xE8, 0xE8, 0x9E, 0x51, 0x2C, 0x24, 
+0x2D, 0x23, 0xA5, 0x2D, 0xEF, 0xA4, 0x31, 0x02, 
+0x9A, 0x3B, 0xED, 0x65, 0x5A, 0x49, 0xFC, 0x27, 
+0xEF, 0x38, 0x44, 0x90, 0x2D, 0xE1, 0xF1, 0x39, 
+0xA3, 0x3E, 0x61, 0xFE, 0xE3, 0x5F, 0x0C, 0x67, 
+0x14, 0x3C, 0xC3, 0xB6, 0xA6, 0x2F, 0x00, 0x63, 
+0xB3, 0xE1, 0x95, 0xD7, 0xDC, 0x83, 0x57, 0xEF, 
+0xD6, 0x96, 0xC2, 0x95, 0xE3, 0x3C, 0xFC, 0x5D, 
+0x61, 0x4B, 0x9F, 0x95, 0x90, 0xFD, 0x1D, 0x1E, 
+0xE3, 0x39, 0x68, 0x59, 0x50, 0x9A, 0xDC, 0x65, 
+0x02, 0xAC, 0xFF, 0x71, 0x23, 0x74, 0x23, 0xE7, 
+0x44, 0x36, 0x38, 0x10, 0x50, 0x2A, 0x94, 0x68, 
+0xAF, 0x63, 0x72, 0x24, 0x4F, 0xCE, 0x35, 0xFF, 
+0xC5, 0x38, 0x57, 0xC7, 0xE2, 0x78, 0xC1, 0x4D, 
+0xD0, 0xA4, 0x4B, 0x17, 0x6B, 0xAF, 0xC5, 0xFE, 
+0x8A, 0xCB, 0xB2, 0x37, 0x3C, 0x71, 0xFC, 0xCB, 
+0x29, 0x84, 0xBE, 0x9A, 0x7C, 0x52, 0x1D, 0x08, 
+0x4C, 0x2F, 0x55, 0x03, 0x15, 0x3E, 0xD3, 0x0F, 
+0xB5, 0xB3, 0x52, 0x44, 
+
+0x72, 0x5C, 0x09, 0xD3, 0x7D, 0xBD, 0x02, 0x7B, 
+0x63, 0x1A, 0x3A, 0xD2, 0x62, 0x54, 0x94, 0x1A, 
+0xF6, 0x84, 0x14, 0x02, 0x4B, 0x83, 0x31, 0x20, 
+0x44, 0x36, 0x2C, 0x60, 0xC4, 0x70, 0x8B, 0xF5, 
+0x6C, 0xCF, 0x0A, 0x7E, 0xCD, 0x14, 0xC2, 0x4B, 
+0xCD, 0x3F, 0xFE, 0x9D, 0x8F, 0x6D, 0xC4, 0x41, 
+0x11, 0xD6, 0x4C, 0xFA, 0x4F, 0x63, 0x56, 0x95, 
+0x3C, 0xB6, 0x01, 0x46, 0xE5, 0x6B, 0x26, 0xDB, 
+0xBC, 0xA5, 0xD2, 0x0A, 0x54, 0x79, 0xFA, 0x8A, 
+0x82, 0xBD, 0xF7, 0x6C, 0x78, 0xEE, 0xF4, 0x4E, 
+0x13, 0x8B, 0x0E, 0xFE, 0xAF, 0x12, 0x05, 0xBC, 
+0x42, 0x91, 0xDD, 0x78, 0xF7, 0x6A, 0xE9, 0x78, 
+0x34, 0x6E, 0x53, 0x61, 0x7B, 0xCF, 0xED, 0x06, 
+0xEA, 0x8E, 0xEA, 0x6A, 0xEC, 0x84, 0x29, 0xBF, 
+0x8B, 0x21, 0x14, 0x70, 0xD1, 0x1F, 0x45, 0x6B, 
+0x78, 0x91, 0x2F, 0xB5, 0x00, 0x03, 0x4B, 0xEE, 
+0x94, 0xB4, 0x53, 0xAD, 0xF4, 0x45, 0x04, 0x08, 
+0x26, 0xC5, 0xF0, 0xBB, 0xF3, 0x8A, 0x4A, 0xEF, 
+0x1E, 0xDC, 0xAB, 0x0E, 0x46, 0x17, 0x24, 0x8E, 
+0xBC, 0x67, 0xDD, 0xA9, 0x4A, 
+
+0x37, 0x59, 0x9D, 0x81, 0x12, 0x4D, 0xF5, 0x66, 
+0xC0, 0xD9, 0x86, 0x7D, 0x89, 0x63, 0xA8, 0x86, 
+0x01, 0x16, 0xDA, 0x1A, 0x97, 0x74, 0x0E, 0xE8, 
+0x81, 0xE9, 0xA3, 0x4A, 0xA0, 0x54, 0xA7, 0x1A, 
+0x16, 0x47, 0x15, 0x45, 0x93, 0x3A, 0xC9, 0x49, 
+0x7D, 0xC7, 0x48, 0xD3, 0xDE, 0x5A, 0x46, 0x4B, 
+0x99, 0x78, 0xF4, 0x21, 0x8D, 0x36, 0x99, 0xA0, 
+0x3F, 0x49, 0xA9, 0xB6, 0xA0, 0x84, 0x54, 0xEE, 
+0x58, 0x66, 0x31, 0xF5, 0xA7, 0x42, 0xA2, 0xB0, 
+0xBA, 0x67, 0x49, 0xD1, 0x08, 0xEE, 0x4E, 0xDB, 
+0x3A, 0xBE, 0x5B, 0x56, 0x6E, 0x74, 0xEF, 0xC8, 
+0x91, 0xDC, 0xFC, 0x02, 0xD3, 0x38, 0xD3, 0x84, 
+0xCC, 0xD3, 0x35, 0xFF, 0xB2, 0xE9, 0x18, 0x73, 
+0xAA, 0x89, 0xF1, 0x27, 0x63, 0x73, 0x6D, 0x6E, 
+0xC1, 0xB9, 0xFB, 0x98, 0x19, 0xF2, 0xDA, 0x64, 
+0xE2, 0x86, 0x39, 0xAC, 0x74, 0x91, 0x89, 0x40, 
+0xF5, 0x2E, 0xA3, 0x50, 0x4C, 0xA1, 0x95, 0x0E, 
+0xB7, 0x4F, 0x9E, 0x35, 0x2E, 0x1B, 0xAE, 0x15, 
+0xEC, 0x8B, 0x11, 0xE4, 0x23, 0x83, 0x48, 0x87, 
+0x87, 0x7D, 0xE0, 0xBE, 0x04, 0x48, 
+
+0x6A, 0xA5, 0x8D, 0x72, 0xA1, 0x6F, 0x87, 0xC5, 
+0x5C, 0x27, 0x77, 0x43, 0x3C, 0xDC, 0x32, 0xF9, 
+0x72, 0x4A, 0x4D, 0x54, 0x33, 0x03, 0x5D, 0x5D, 
+0x25, 0x28, 0x8B, 0x5F, 0xF7, 0xE1, 0xE9, 0xD8, 
+0x01, 0xB2, 0x3C, 0xD2, 0x1E, 0xB5, 0xA3, 0x52, 
+0x86, 0xA1, 0xFA, 0x6D, 0x78, 0xD0, 0x26, 0x10, 
+0xB8, 0x71, 0x2A, 0x27, 0xC9, 0x9D, 0xDB, 0x3C, 
+0x6B, 0xFB, 0x68, 0xD7, 0xAB, 0x51, 0x4E, 0x54, 
+0x5E, 0x30, 0x9C, 0x06, 0x75, 0x73, 0x1D, 0xD6, 
+0x05, 0xD5, 0x4E, 0x63, 0x03, 0x8F, 0x92, 0x89, 
+0x07, 0xA2, 0xE3, 0x92, 0x9E, 0x65, 0xAF, 0x06, 
+0x96, 0xBE, 0x08, 0x59, 0xA6, 0x42, 0xDD, 0xFC, 
+0x75, 0x72, 0xC1, 0xDC, 0xC6, 0xA4, 0xDC, 0xCB, 
+0x30, 0x61, 0x10, 0xC2, 0x9F, 0x5D, 0x66, 0x5E, 
+0xF6, 0x59, 0x21, 0x4F, 0x6B, 0x19, 0xAB, 0x0A, 
+0x88, 0x7B, 0x04, 0x2B, 0xB5, 0x08, 0x71, 0x19, 
+0x14, 0x03, 0x17, 0xF2, 0xC2, 0x6D, 0xFF, 0x4E, 
+0x02, 0xC1, 0xB9, 0x09, 0x57, 0x27, 0xEB, 0xE2, 
+0x04, 0x66, 0xC9, 0x5A, 0xFE, 0x09, 0x84, 0xDE, 
+0xAC, 0x2F, 0x9B, 0x5E, 0xB2, 0x5E, 0x9E, 
+
+0xC8, 0x44, 0x0F, 0xA1, 0xED, 0x0F, 0xC6, 0x5A, 
+0x6A, 0xA2, 0x8F, 0x8C, 0x7A, 0xAE, 0x55, 0xC0, 
+0x78, 0x5C, 0x17, 0xEC, 0x9E, 0x77, 0xC7, 0xC3, 
+0x69, 0x55, 0x34, 0xEF, 0x67, 0x45, 0xB8, 0x8D, 
+0xEE, 0xF1, 0x6E, 0xEA, 0xFD, 0x87, 0xCA, 0x9E, 
+0xAD, 0x19, 0x09, 0x71, 0x19, 0xBD, 0x77, 0x0B, 
+0xDC, 0xBA, 0x8A, 0x49, 0x28, 0xC4, 0x66, 0x29, 
+0x80, 0x57, 0x2A, 0x4B, 0x06, 0xB0, 0x97, 0xC8, 
+0xFD, 0xEA, 0x22, 0xE5, 0x4B, 0x99, 0x57, 0x05, 
+0x14, 0x79, 0x43, 0x78, 0x3A, 0x71, 0xFB, 0x81, 
+0x7E, 0xF3, 0xD0, 0x4F, 0xFF, 0xA2, 0xCE, 0x36, 
+0x4C, 0x1C, 0xBF, 0x7B, 0x78, 0xE9, 0xC0, 0xF1, 
+0xF8, 0xD6, 0x30, 0x88, 0xA2, 0xA8, 0x24, 0x12, 
+0x8E, 0x18, 0xD3, 0x57, 0x92, 0xED, 0x47, 0xEB, 
+0x82, 0x21, 0x9C, 0x66, 0x51, 0x31, 0x82, 0xCF, 
+0x2B, 0xE4, 0xB6, 0x2D, 0xF5, 0x25, 0x6A, 0x0A, 
+0x7C, 0xF6, 0x45, 0xD0, 0xC4, 0x4A, 0x6C, 0xA4, 
+0x5E, 0xC7, 0x28, 0x67, 0x33, 0x17, 0x35, 0x56, 
+0x51, 0x23, 0x3C, 0x82, 0x05, 0x5C, 0x5F, 0x40, 
+0x45, 0x9C, 0xBF, 0x7B, 0xDB, 0x2F, 0xE3, 0xBF, 
+
+0x10, 0x98, 0x84, 0x0D, 0x65, 0x9B, 0x14, 0x8F, 
+0x17, 0x91, 0x04, 0xC7, 0x0A, 0x6A, 0x4E, 0x7E, 
+0x73, 0x60, 0xCF, 0x9E, 0xF5, 0x40, 0x37, 0x46, 
+0xED, 0x65, 0x36, 0xBC, 0xE5, 0xFC, 0xB6, 0x9F, 
+0x4D, 0x59, 0xD6, 0x7A, 0xE1, 0x5B, 0x15, 0x63, 
+0x49, 0x87, 0xBA, 0x8D, 0x23, 0x3D, 0x41, 0x88, 
+0xAD, 0xC3, 0xB9, 0x7B, 0xCF, 0xF7, 0x73, 0xA5, 
+0x85, 0x18, 0xBE, 0x00, 0x79, 0x28, 0x5A, 0xD8, 
+0xCC, 0x20, 0x8F, 0x41, 0x2A, 0x5F, 0x8E, 0x13, 
+0x95, 0x95, 0x0F, 0x22, 0x6D, 0xD9, 0x4E, 0xE7, 
+0xBE, 0xEF, 0x64, 0x90, 0x89, 0x64, 0x1F, 0xA7, 
+0x57, 0xC2, 0xC6, 0x55, 0x90, 0x0C, 0x30, 0x76, 
+0xB6, 0x23, 0xA3, 0x51, 0x74, 0xEB, 0x5E, 0xF3, 
+0x26, 0x4C, 0x73, 0xDB, 0x75, 0x8E, 0x7A, 0x6C, 
+0x9A, 0x06, 0x88, 0x31, 0xFA, 0x53, 0x41, 0xAD, 
+0x14, 0x54, 0xC7, 0x68, 0xA6, 0xDE, 0x35, 0x73, 
+0x95, 0x21, 0x12, 0xDB, 0xEC, 0x70, 0x01, 0x98, 
+0xB8, 0x8D, 0x20, 0x52, 0x16, 0xA3, 0x83, 0xD8, 
+0x81, 0x67, 0x09, 0x66, 0x98, 0x0E, 0xBA, 0xA1, 
+0xB2, 0x62, 0x1A, 0xC4, 0xBC, 0xD9, 0x52, 0xDD, 
+0x94, 
+
+0xBE, 0x8E, 0x82, 0xAA, 0x3C, 0xD4, 0xFE, 0x61, 
+0x65, 0x51, 0x73, 0xC0, 0x83, 0x3E, 0x68, 0x01, 
+0x61, 0x53, 0x05, 0xAC, 0x49, 0x9B, 0x83, 0xCE, 
+0x1F, 0x2D, 0xCB, 0x74, 0x54, 0x77, 0xBD, 0xA5, 
+0x5C, 0xE2, 0xAC, 0xA5, 0x0E, 0x42, 0xB1, 0xB3, 
+0x56, 0x16, 0x9B, 0x7A, 0xD0, 0xAF, 0xBE, 0x93, 
+0x05, 0x51, 0x03, 0xAB, 0x04, 0x6D, 0xC3, 0x14, 
+0xB7, 0x46, 0x17, 0xB4, 0x3A, 0xEA, 0x02, 0xDB, 
+0x41, 0x21, 0x3D, 0xCE, 0x1B, 0xC4, 0xA7, 0xEC, 
+0xB6, 0x0B, 0xD6, 0x89, 0x9B, 0xBB, 0xFC, 0x95, 
+0x06, 0x44, 0x76, 0x1D, 0x42, 0x43, 0x4B, 0x3B, 
+0xD0, 0x1F, 0x83, 0xA6, 0xDE, 0x5C, 0xB0, 0xB9, 
+0xAD, 0x93, 0xF3, 0xCD, 0x73, 0x32, 0x60, 0x3B, 
+0x00, 0x3B, 0xAA, 0xC7, 0x46, 0xDF, 0x10, 0x18, 
+0x40, 0xDA, 0x73, 0xC5, 0x29, 0x0D, 0x2B, 0xED, 
+0xC6, 0xCB, 0x7F, 0x8E, 0xE8, 0x1C, 0x45, 0x50, 
+0x42, 0x85, 0x3C, 0x0F, 0x8F, 0xEF, 0x8A, 0xDB, 
+0xDE, 0x08, 0xA0, 0xA1, 0x1D, 0xC3, 0x0D, 0xC3, 
+0x1A, 0x6A, 0xDE, 0xFD, 0x16, 0x97, 0x52, 0x93, 
+0x5A, 0x4F, 0x60, 0xD0, 0xD3, 0xB5, 0xCC, 0xB6, 
+0x48, 0x73, 
+
+0xEE, 0x50, 0x98, 0x44, 0xE2, 0x9E, 0xF6, 0x55, 
+0x7C, 0x44, 0xB7, 0xEE, 0x90, 0x88, 0xAC, 0x50, 
+0x33, 0xED, 0x3F, 0xE1, 0x65, 0x8E, 0x90, 0x60, 
+0xDD, 0x55, 0xEF, 0xD3, 0x59, 0xA1, 0x07, 0x32, 
+0xAC, 0x0B, 0x04, 0x57, 0x13, 0x0E, 0x3F, 0xF9, 
+0x0A, 0xA8, 0x6D, 0xA2, 0xE2, 0xE7, 0x6B, 0x6B, 
+0xE1, 0x32, 0xDE, 0x6C, 0xBB, 0x7D, 0x30, 0x95, 
+0x1E, 0x9E, 0xFB, 0xBE, 0x27, 0xD1, 0x42, 0x58, 
+0x44, 0x12, 0xFA, 0xB0, 0x99, 0x00, 0x8D, 0x40, 
+0xBD, 0x10, 0x7B, 0xEF, 0xBC, 0xE6, 0xF4, 0x3B, 
+0x0F, 0x73, 0x13, 0x4B, 0x05, 0xA7, 0x59, 0x04, 
+0x9A, 0xE9, 0x75, 0xB6, 0x02, 0xD0, 0xF2, 0xA1, 
+0xC7, 0x45, 0xDC, 0x74, 0xF9, 0x93, 0x8B, 0xE2, 
+0x71, 0x4E, 0xEF, 0x69, 0xE7, 0xB6, 0xD6, 0xF0, 
+0xB8, 0x7A, 0x65, 0x60, 0x0D, 0x07, 0x36, 0xAC, 
+0x50, 0x33, 0xA7, 0x6F, 0x11, 0xC0, 0x96, 0x6C, 
+0xCD, 0x00, 0x8F, 0x63, 0x38, 0x19, 0x85, 0xF1, 
+0x11, 0x47, 0xC5, 0xEA, 0x2A, 0x03, 0x89, 0x86, 
+0x6F, 0xC5, 0x55, 0x7B, 0xA4, 0x67, 0x2D, 0xCF, 
+0x85, 0xEE, 0x8C, 0x52, 0xFF, 0xDC, 0x0B, 0xD5, 
+0x4D, 0xEF, 0x36, 
+
+0x1D, 0x12, 0x3D, 0xE5, 0xE5, 0xE3, 0x74, 0x70, 
+0xB0, 0x9F, 0xB2, 0xBF, 0xE9, 0x6B, 0x5E, 0x04, 
+0x8E, 0x8F, 0x28, 0x22, 0x6C, 0xDC, 0xA9, 0xC4, 
+0xB6, 0x22, 0x07, 0xA7, 0xDA, 0x38, 0xF1, 0x58, 
+0x88, 0x4F, 0x11, 0x55, 0xB0, 0x24, 0xA1, 0xC7, 
+0xE3, 0x73, 0x5A, 0x6D, 0x49, 0x2B, 0x61, 0xAA, 
+0x70, 0x4F, 0xA5, 0x44, 0x94, 0xB4, 0x86, 0xA8, 
+0x95, 0xEA, 0xC5, 0xC6, 0xF9, 0x92, 0x87, 0x4B, 
+0xDC, 0x9A, 0x3E, 0x35, 0x69, 0x67, 0xBC, 0x73, 
+0x25, 0x1E, 0x17, 0x90, 0xDC, 0xC2, 0x2F, 0x3E, 
+0xAA, 0xC0, 0xF3, 0x54, 0x38, 0xFB, 0x5E, 0x50, 
+0xD0, 0x44, 0xA9, 0xD9, 0x6A, 0xFF, 0xE3, 0xF7, 
+0x4A, 0x38, 0x09, 0xC9, 0x5E, 0x7E, 0x99, 0x7E, 
+0x83, 0x66, 0xAF, 0xD9, 0x44, 0x26, 0xBA, 0x59, 
+0x0C, 0xAB, 0x56, 0x7A, 0x16, 0x39, 0x05, 0xDB, 
+0xB7, 0xA7, 0xD4, 0x17, 0xD8, 0x8A, 0x15, 0x26, 
+0xFD, 0xCA, 0x1D, 0x7D, 0x56, 0x48, 0x23, 0xF6, 
+0xD9, 0x53, 0x75, 0x23, 0xE9, 0xC1, 0xE2, 0xC5, 
+0xF7, 0x19, 0x7E, 0x40, 0x40, 0x4B, 0x6D, 0x1D, 
+0x04, 0xA6, 0x72, 0xF4, 0x39, 0x9D, 0xAF, 0x9B, 
+0xD3, 0xE1, 0xE7, 0xD7, 
+
+0xCA, 0x33, 0x80, 0x37, 0x6D, 0xF9, 0x73, 0x91, 
+0x59, 0x8B, 0x6C, 0x09, 0x74, 0xC8, 0x1E, 0x02, 
+0xB3, 0x4F, 0x1A, 0x57, 0x67, 0x67, 0x84, 0x52, 
+0x54, 0x36, 0x8A, 0x92, 0xAE, 0x4D, 0xD7, 0x2F, 
+0x6B, 0xD8, 0xCF, 0x50, 0x66, 0xB5, 0x5B, 0x53, 
+0x63, 0x5D, 0x37, 0xFF, 0xAE, 0xD9, 0xD6, 0x28, 
+0x22, 0x53, 0xA7, 0x91, 0xC9, 0xBB, 0xDC, 0x7E, 
+0x25, 0x20, 0xD2, 0x1F, 0x39, 0x0B, 0x3E, 0x74, 
+0xAF, 0x27, 0x3C, 0xAC, 0x53, 0x71, 0x31, 0xB6, 
+0xD7, 0xBE, 0x12, 0x3B, 0xEB, 0xAD, 0x41, 0x58, 
+0x03, 0x26, 0x98, 0x4C, 0x06, 0x0A, 0x2E, 0xC8, 
+0x7D, 0xC5, 0x13, 0x83, 0xE6, 0x14, 0x81, 0xB1, 
+0x12, 0x13, 0xF7, 0x42, 0xDE, 0x20, 0x1A, 0xCE, 
+0x7E, 0xAC, 0xA0, 0x6E, 0x7F, 0x78, 0xA5, 0xA3, 
+0xE3, 0x4D, 0xB1, 0xFD, 0xFA, 0x09, 0xB8, 0xA1, 
+0x3B, 0xD6, 0xB6, 0x5A, 0x98, 0x17, 0x8C, 0x6D, 
+0x78, 0x34, 0xAE, 0x39, 0x1A, 0xC6, 0x86, 0x3B, 
+0x17, 0x76, 0x42, 0x0F, 0x31, 0x72, 0x24, 0x96, 
+0x4E, 0xD4, 0x8A, 0xB7, 0x6B, 0x28, 0x9C, 0xA6, 
+0xA5, 0x49, 0x25, 0x3E, 0xE5, 0x7F, 0xCC, 0x35, 
+0x06, 0xC9, 0x05, 0x0E, 0xD0, 
+
+0x2D, 0x02, 0x22, 0x35, 0xA3, 0x53, 0x92, 0x8B, 
+0x5C, 0xDB, 0xB6, 0xB4, 0x77, 0xD9, 0x29, 0x73, 
+0xAC, 0x1E, 0x9C, 0x0B, 0x80, 0xD6, 0x10, 0xAD, 
+0xF5, 0x79, 0x08, 0x6C, 0x8D, 0xB0, 0x9D, 0x94, 
+0x49, 0x7F, 0x56, 0xDE, 0xB6, 0xBC, 0x5B, 0xDA, 
+0xC6, 0xAD, 0x79, 0xDB, 0x6B, 0x56, 0x27, 0xA9, 
+0xF1, 0x08, 0xD0, 0x9A, 0x70, 0xAB, 0x74, 0xB7, 
+0xFC, 0x5C, 0xFA, 0x05, 0x9B, 0x3E, 0x62, 0x89, 
+0xE1, 0x92, 0x57, 0xD2, 0x8D, 0x11, 0x67, 0xDA, 
+0x45, 0xAA, 0xE8, 0x53, 0x7B, 0x8E, 0x4A, 0x90, 
+0x68, 0x82, 0xA4, 0x44, 0x48, 0x5D, 0xED, 0xA1, 
+0x83, 0x90, 0x24, 0xA3, 0x32, 0x28, 0xA2, 0x7B, 
+0xF5, 0x43, 0x20, 0xA3, 0x40, 0x19, 0x78, 0x3F, 
+0x44, 0x57, 0xF1, 0x2F, 0x3A, 0x7A, 0x35, 0x38, 
+0x6D, 0xD3, 0xA9, 0x84, 0x22, 0x5D, 0x98, 0x0B, 
+0x21, 0x10, 0x17, 0x45, 0x28, 0xD1, 0x47, 0x2F, 
+0x68, 0x25, 0xEC, 0xD3, 0x9F, 0x20, 0xF0, 0x00, 
+0x64, 0x19, 0xBD, 0xB1, 0xC2, 0x2A, 0xB5, 0x48, 
+0x20, 0x33, 0xF7, 0x93, 0xBD, 0xA1, 0xB3, 0xEF, 
+0x2F, 0xCE, 0xDD, 0xD4, 0x9B, 0x0B, 0x8D, 0x09, 
+0x77, 0xAC, 0x10, 0x8D, 0x5D, 0x1D, 
+
+0xDB, 0xAF, 0x1E, 0x4B, 0x96, 0xF6, 0x9A, 0xDB, 
+0x14, 0x4F, 0x84, 0x56, 0xDA, 0x70, 0xFE, 0xAE, 
+0x05, 0xF5, 0x0C, 0x6D, 0x05, 0x10, 0x26, 0x00, 
+0xC8, 0xA3, 0x0A, 0x8F, 0x8B, 0x05, 0xF1, 0x54, 
+0x38, 0xC8, 0xD8, 0xEB, 0x4C, 0xBA, 0x3F, 0x96, 
+0x65, 0x61, 0x25, 0xC9, 0x0B, 0xB0, 0x9D, 0x6E, 
+0xC9, 0x97, 0x00, 0xB1, 0x47, 0x06, 0xE9, 0xC7, 
+0x79, 0x11, 0xF4, 0xC1, 0x19, 0xB1, 0x3D, 0xEE, 
+0xE6, 0x13, 0x6D, 0x6F, 0x35, 0xFA, 0xF8, 0xF8, 
+0xDF, 0xB7, 0x5D, 0x20, 0x42, 0x5A, 0x8E, 0x85, 
+0xD0, 0x1E, 0x06, 0xB2, 0x54, 0xF3, 0xF1, 0x6E, 
+0x0A, 0xCF, 0x79, 0x94, 0x0A, 0x42, 0xB3, 0xD6, 
+0x8C, 0x3B, 0xBA, 0xB3, 0xFC, 0x29, 0x64, 0xED, 
+0x34, 0x99, 0xF3, 0x5B, 0x28, 0x13, 0x7B, 0xAE, 
+0xE6, 0xC0, 0xB2, 0x39, 0xAE, 0xEC, 0x11, 0xEE, 
+0x44, 0x3F, 0x3D, 0x03, 0x2F, 0x6E, 0xB2, 0x50, 
+0xC2, 0x6F, 0xDB, 0x90, 0x15, 0xA2, 0xA9, 0x4F, 
+0xB3, 0x55, 0xB4, 0xC4, 0x0C, 0x53, 0x37, 0x5B, 
+0x1E, 0x85, 0xDC, 0x0F, 0xC2, 0x02, 0xD3, 0x33, 
+0x1A, 0x68, 0xAE, 0xB8, 0x3D, 0xCF, 0x3B, 0xC2, 
+0xB7, 0xED, 0xCB, 0xD6, 0x7A, 0xDD, 0x99, 
+
+0x42, 0x65, 0x32, 0xBF, 0x4A, 0x92, 0x39, 0xCF, 
+0xF3, 0x99, 0xB9, 0xD5, 0x26, 0x51, 0xC5, 0x36, 
+0x97, 0x3A, 0x67, 0xE4, 0x40, 0xC0, 0x59, 0xFE, 
+0xE2, 0x80, 0xA3, 0x15, 0x8D, 0xAE, 0x7E, 0x31, 
+0xBD, 0x70, 0xA2, 0xF1, 0x2F, 0x89, 0x9D, 0x29, 
+0x80, 0xED, 0x06, 0x42, 0xA4, 0x88, 0xC1, 0x29, 
+0x49, 0xDD, 0x02, 0x8B, 0x6B, 0x42, 0x06, 0xA6, 
+0xA0, 0x13, 0xB8, 0xB3, 0x7A, 0x3D, 0x26, 0x3D, 
+0x8A, 0x7D, 0x07, 0x6F, 0xBB, 0x93, 0xDC, 0x2C, 
+0x7B, 0xF8, 0x92, 0x7F, 0x4F, 0x14, 0xF0, 0x53, 
+0xBB, 0xAC, 0xDE, 0x7E, 0x51, 0x8A, 0xE9, 0x5F, 
+0x2D, 0x80, 0x7F, 0x67, 0xC2, 0xD6, 0x37, 0x2A, 
+0x6D, 0xE8, 0xC7, 0xFD, 0x2D, 0x51, 0x43, 0x2F, 
+0xED, 0x94, 0x0F, 0x08, 0xD9, 0x08, 0x88, 0xAC, 
+0xE0, 0xC2, 0x7D, 0xA5, 0x40, 0x7E, 0xCB, 0xF7, 
+0x6C, 0x6F, 0xF9, 0x6E, 0xBE, 0xC9, 0x2A, 0x8A, 
+0xA0, 0x2E, 0xC3, 0xBD, 0x25, 0x0B, 0xA3, 0xCB, 
+0x37, 0xB7, 0xC4, 0xBE, 0xE1, 0x1F, 0x62, 0x41, 
+0xBC, 0xC3, 0xE1, 0x8B, 0x96, 0x92, 0x96, 0xFF, 
+0x11, 0x4F, 0xF4, 0x8F, 0x40, 0x26, 0xFD, 0xBF, 
+0xA4, 0x11, 0x9E, 0xFE, 0xA4, 0xC3, 0xD1, 0xA0, 
+
+0xD0, 0x02, 0x91, 0x03, 0x01, 0xC5, 0xC0, 0x30, 
+0xC6, 0xA2, 0x83, 0x87, 0xDC, 0x41, 0xAD, 0x6C, 
+0x91, 0x21, 0xF1, 0xEA, 0x6C, 0x1A, 0xFF, 0xCD, 
+0xFC, 0x05, 0xB4, 0x29, 0x26, 0xF2, 0x4A, 0x68, 
+0x44, 0xBF, 0xBE, 0x15, 0x2B, 0xFE, 0x4A, 0xBF, 
+0xE2, 0x5C, 0x9E, 0x00, 0xE2, 0xC7, 0x60, 0x81, 
+0xD4, 0xF9, 0x3B, 0xF0, 0x88, 0xE8, 0x95, 0xF6, 
+0x01, 0x44, 0xE6, 0x12, 0xC1, 0x05, 0xFD, 0x1D, 
+0xF2, 0xE1, 0x7E, 0x62, 0x63, 0x58, 0xAD, 0x1C, 
+0xEB, 0x3C, 0x35, 0x4C, 0xB8, 0x65, 0xC0, 0x11, 
+0x6E, 0xC5, 0x22, 0x4A, 0x46, 0x33, 0xBF, 0x27, 
+0xFC, 0x54, 0x88, 0xF8, 0xFB, 0xF7, 0xB0, 0xF1, 
+0xE0, 0x1A, 0x0C, 0x96, 0x9E, 0x71, 0x8C, 0xEF, 
+0x1B, 0x65, 0xCF, 0x0D, 0xE9, 0xF9, 0x32, 0xAD, 
+0xF5, 0x74, 0x8C, 0x1D, 0x12, 0x4F, 0x94, 0xEC, 
+0xF7, 0x14, 0x80, 0x39, 0x52, 0xA3, 0x51, 0x26, 
+0xBF, 0xD7, 0x9C, 0xB8, 0x4C, 0x57, 0x50, 0x08, 
+0xD7, 0x74, 0x7E, 0x6F, 0xA3, 0xC7, 0x25, 0xB1, 
+0x36, 0x2A, 0x70, 0x1F, 0x8A, 0x43, 0xB6, 0x1E, 
+0x56, 0xA7, 0xD8, 0x27, 0xD8, 0x58, 0xAA, 0x3D, 
+0xE7, 0xC7, 0x6F, 0xAE, 0xF1, 0x52, 0xEF, 0xFC, 
+0x67, 
+
+0x90, 0xD3, 0x3A, 0xFA, 0xE4, 0x28, 0x6F, 0xDA, 
+0x6E, 0xED, 0x22, 0x11, 0xAA, 0x6F, 0x9D, 0xEE, 
+0x8B, 0x0B, 0x51, 0xE3, 0xA9, 0x15, 0x4D, 0x75, 
+0xF3, 0xB4, 0x09, 0x48, 0x39, 0x70, 0x6F, 0x54, 
+0x86, 0x1A, 0x50, 0x07, 0xC6, 0x96, 0x49, 0x5F, 
+0x90, 0xEA, 0x6E, 0xFF, 0xD5, 0xB7, 0x36, 0x58, 
+0xA7, 0xC1, 0x4C, 0xF1, 0x98, 0x2B, 0xF2, 0x9A, 
+0x0B, 0x89, 0xA6, 0x8F, 0xDC, 0x7E, 0xB2, 0x20, 
+0xA8, 0xC6, 0x0C, 0x98, 0x6B, 0x63, 0x2D, 0xA4, 
+0xBD, 0x4F, 0x76, 0x60, 0x79, 0x65, 0x32, 0x3C, 
+0x65, 0x17, 0x38, 0xC5, 0xA4, 0x46, 0x4F, 0x13, 
+0x2B, 0xEA, 0xED, 0xB1, 0xF4, 0x11, 0xF9, 0x5F, 
+0xC2, 0x20, 0xA7, 0xE6, 0x70, 0xE8, 0x6D, 0x03, 
+0x9B, 0x79, 0x3D, 0xF6, 0x32, 0x6A, 0x14, 0xC0, 
+0x02, 0x58, 0x8E, 0x05, 0x26, 0xC1, 0x70, 0x75, 
+0x34, 0x08, 0x6C, 0x87, 0x73, 0xE8, 0x36, 0x0E, 
+0xD3, 0x74, 0x0A, 0x02, 0xAE, 0x71, 0xE5, 0xB0, 
+0xC7, 0x24, 0x25, 0xA7, 0xCE, 0xCF, 0x7F, 0x1A, 
+0xE0, 0x6C, 0x5D, 0x8B, 0x1E, 0x8D, 0x3B, 0xC0, 
+0xC2, 0xD2, 0x7E, 0xA4, 0xA8, 0xE8, 0xB6, 0x03, 
+0x10, 0x42, 0xB5, 0x9D, 0xAF, 0x8D, 0xB1, 0xFE, 
+0xB7, 0xD7, 
+
+0x72, 0x6F, 0xC1, 0xE9, 0xC5, 0xFC, 0x3C, 0x26, 
+0xCA, 0xA1, 0x0D, 0x91, 0x3C, 0x81, 0x10, 0xE7, 
+0x75, 0x36, 0x54, 0x5F, 0x02, 0x33, 0xDE, 0xA8, 
+0xC4, 0xFB, 0xF9, 0x76, 0xFB, 0x24, 0x30, 0x58, 
+0x4A, 0xFE, 0x83, 0x42, 0x54, 0xF8, 0x7F, 0xC6, 
+0xFC, 0x6D, 0x4E, 0x17, 0xDC, 0x72, 0xC3, 0x69, 
+0x00, 0xCD, 0x63, 0x62, 0x0B, 0xAF, 0xC0, 0x54, 
+0xF3, 0x0F, 0x74, 0xBE, 0x38, 0xE7, 0x26, 0x88, 
+0x5B, 0x49, 0x1A, 0x3D, 0x06, 0x83, 0xD2, 0xB5, 
+0x5F, 0xA3, 0x1A, 0x09, 0x70, 0xC7, 0x5B, 0x49, 
+0xB6, 0x18, 0x90, 0x45, 0x2F, 0x20, 0xE6, 0x14, 
+0xA3, 0x6E, 0xF6, 0x9C, 0xC2, 0xBD, 0x3E, 0x8F, 
+0xA7, 0x0A, 0xE7, 0xA8, 0x97, 0x72, 0x47, 0x11, 
+0x88, 0x5B, 0x3B, 0xF7, 0xDA, 0x40, 0xA4, 0xB8, 
+0x8F, 0x8A, 0xB7, 0xD9, 0x8A, 0xD1, 0x0C, 0xE1, 
+0xA4, 0xFF, 0xE5, 0x5D, 0xAE, 0x7F, 0x44, 0x35, 
+0x17, 0x8D, 0x3A, 0x3D, 0xA9, 0x04, 0xC4, 0xEC, 
+0xAE, 0x03, 0xFD, 0x29, 0x75, 0x5D, 0x8D, 0xE4, 
+0x5B, 0xC2, 0xE7, 0xE1, 0x32, 0x80, 0xEA, 0xCF, 
+0x0D, 0x19, 0x60, 0xE5, 0x17, 0x66, 0xEB, 0x05, 
+0x92, 0x1B, 0xA1, 0x75, 0x66, 0x63, 0x30, 0x8A, 
+0xA9, 0x18, 0x30, 
+
+0x14, 0xAB, 0x56, 0x45, 0x96, 0xC9, 0x7E, 0xA8, 
+0x06, 0x75, 0x14, 0x92, 0x98, 0x19, 0x1E, 0x62, 
+0x59, 0xE0, 0x07, 0xF7, 0x31, 0xC6, 0x6E, 0xA7, 
+0x01, 0xC0, 0x67, 0x00, 0x2E, 0x37, 0x12, 0x42, 
+0x17, 0x6E, 0xDB, 0xB0, 0xF3, 0x72, 0xB9, 0x9E, 
+0x60, 0xE5, 0xC1, 0xC6, 0xB2, 0x6A, 0xC9, 0xC6, 
+0x2E, 0x5A, 0x43, 0xB0, 0x4A, 0xD0, 0xE2, 0x68, 
+0x94, 0xDF, 0xDA, 0xC4, 0x9D, 0xC9, 0x61, 0x9F, 
+0x24, 0x7A, 0x85, 0xF9, 0xDD, 0x23, 0x8E, 0x2D, 
+0xFF, 0x19, 0xF9, 0x14, 0x21, 0x41, 0x8F, 0x77, 
+0x6C, 0x72, 0x2B, 0x0E, 0x73, 0x47, 0x73, 0x31, 
+0xDE, 0xCF, 0xAF, 0x33, 0x04, 0x75, 0xF2, 0xAC, 
+0xE9, 0xFB, 0xA0, 0x2D, 0x6E, 0x78, 0xED, 0xA0, 
+0xCB, 0xA9, 0x72, 0xB5, 0x41, 0x60, 0x19, 0x57, 
+0xEA, 0xB6, 0x49, 0xE9, 0x6B, 0x59, 0x0D, 0x51, 
+0x65, 0xE3, 0xDD, 0xA7, 0xEA, 0x75, 0xE2, 0x65, 
+0x58, 0x58, 0xDB, 0x18, 0x03, 0x54, 0xC5, 0x93, 
+0x91, 0x35, 0x89, 0x82, 0x7B, 0x77, 0x82, 0x0B, 
+0xDA, 0xF2, 0xEC, 0x67, 0x82, 0x2C, 0x72, 0x56, 
+0xD4, 0x75, 0xEA, 0x90, 0x98, 0x5C, 0x54, 0x67, 
+0x8F, 0x87, 0x03, 0x99, 0xCA, 0x96, 0x0D, 0x99, 
+0xF6, 0xE1, 0x44, 0x70, 
+
+0x9A, 0x46, 0x51, 0xC5, 0xE3, 0xD3, 0x42, 0xCF, 
+0xEA, 0xE7, 0xEC, 0x14, 0xC4, 0x33, 0xA1, 0x46, 
+0x73, 0xF4, 0xE0, 0x04, 0x25, 0x6D, 0xFB, 0x3F, 
+0xE2, 0x4F, 0x80, 0x3B, 0x6E, 0x65, 0x1C, 0x22, 
+0x06, 0xC7, 0x12, 0x11, 0x97, 0x99, 0xE8, 0xF5, 
+0x18, 0xB0, 0x2E, 0x69, 0xE9, 0xA4, 0x2B, 0x4F, 
+0x99, 0x45, 0x55, 0x55, 0xEB, 0xE3, 0x6D, 0x02, 
+0x3A, 0x62, 0xCC, 0xB2, 0xD4, 0x01, 0xB8, 0x4F, 
+0x78, 0x10, 0xE7, 0xCE, 0x77, 0x5A, 0x26, 0x98, 
+0x7D, 0xDC, 0xC5, 0x2A, 0x79, 0x3E, 0x2F, 0x3D, 
+0x5B, 0x15, 0x9B, 0x07, 0x5A, 0x5E, 0x4D, 0x1B, 
+0xDA, 0x1A, 0x04, 0x8B, 0xDB, 0xA5, 0xBF, 0x82, 
+0xAA, 0xD1, 0x42, 0xAA, 0x75, 0xED, 0xB0, 0xB9, 
+0x4B, 0xE2, 0xFE, 0x79, 0xEA, 0x8D, 0xF9, 0x3C, 
+0xBD, 0x95, 0xCD, 0x2B, 0xA8, 0xEC, 0xC1, 0xC7, 
+0xD6, 0xCA, 0x29, 0x64, 0x41, 0x62, 0x27, 0x55, 
+0x0E, 0x7B, 0xED, 0x64, 0x14, 0x10, 0x11, 0x92, 
+0x30, 0x73, 0xCD, 0x24, 0xA5, 0xE2, 0xFB, 0x73, 
+0xBA, 0x68, 0x2D, 0x9E, 0x8B, 0x04, 0x55, 0x4C, 
+0x97, 0x7A, 0xE4, 0x79, 0xFA, 0x17, 0x15, 0x5A, 
+0x4D, 0x7F, 0x20, 0x66, 0x22, 0xBE, 0xA9, 0xBC, 
+0x45, 0x77, 0x38, 0xF7, 0xE8, 
+
+0x93, 0x3B, 0x5C, 0x64, 0x8A, 0x8A, 0xEB, 0xD0, 
+0x27, 0xEF, 0xC2, 0xEA, 0x47, 0x62, 0xE4, 0x1A, 
+0x7C, 0xF9, 0x6B, 0xAE, 0x10, 0x58, 0xB7, 0x6D, 
+0xD6, 0xA3, 0xF1, 0xF4, 0xD1, 0x89, 0x21, 0x05, 
+0xAC, 0xDE, 0x36, 0x97, 0x3D, 0x29, 0x75, 0x0C, 
+0xD6, 0xCF, 0x9C, 0x82, 0x52, 0x83, 0x78, 0x43, 
+0xA0, 0x73, 0x9F, 0x9F, 0x5F, 0x71, 0xDE, 0x35, 
+0xB5, 0x86, 0x2C, 0xD7, 0xFC, 0x0C, 0xEF, 0x7E, 
+0xD7, 0xEF, 0x91, 0x3F, 0x70, 0xD4, 0x6F, 0x1E, 
+0xBC, 0xF3, 0xF0, 0x6E, 0x3F, 0x4F, 0xD0, 0xAA, 
+0xC4, 0x70, 0x12, 0xE2, 0x2B, 0x68, 0x78, 0xC6, 
+0x4B, 0x3E, 0x62, 0x7A, 0x6F, 0x10, 0xF1, 0xF4, 
+0x66, 0xD9, 0x97, 0xCF, 0xC3, 0x10, 0x88, 0xA0, 
+0x4C, 0x8A, 0x84, 0x04, 0x70, 0x02, 0x7C, 0xDC, 
+0x4C, 0x8D, 0xD8, 0x3B, 0x48, 0x66, 0x68, 0x42, 
+0x69, 0x77, 0xE9, 0x6A, 0xBE, 0xB8, 0x40, 0x24, 
+0x03, 0x9B, 0x5F, 0x7B, 0x67, 0xBF, 0x01, 0x42, 
+0x47, 0xF0, 0x55, 0x64, 0x0D, 0x05, 0x3A, 0xA1, 
+0xC7, 0x5E, 0x07, 0xA2, 0x55, 0x4B, 0x83, 0xB4, 
+0x10, 0x53, 0xD2, 0x1D, 0x4E, 0xF2, 0x1B, 0x7B, 
+0xA5, 0xBD, 0x88, 0xB7, 0xDC, 0xDD, 0x0E, 0x18, 
+0xEA, 0xE8, 0x3D, 0xFE, 0x12, 0xC1, 
+
+0x8A, 0x7D, 0x84, 0x1E, 0xF4, 0xD4, 0xD9, 0x4F, 
+0xF0, 0xC8, 0x86, 0x52, 0x58, 0x06, 0x8D, 0x86, 
+0x85, 0x4C, 0x74, 0xB1, 0xA4, 0xC5, 0xAC, 0x92, 
+0x32, 0x76, 0xC0, 0x3D, 0x0B, 0x9C, 0xD6, 0xBE, 
+0x68, 0xD6, 0x20, 0x84, 0x2B, 0xD5, 0x96, 0x3A, 
+0x6E, 0x15, 0x76, 0x93, 0x1E, 0xEE, 0x21, 0x41, 
+0x80, 0xEC, 0x55, 0xFB, 0x43, 0xF3, 0xA7, 0x3E, 
+0x75, 0x2B, 0xC2, 0xFA, 0x55, 0x05, 0x68, 0xFB, 
+0x93, 0xD0, 0x2C, 0x76, 0xB0, 0x8C, 0xC9, 0x76, 
+0x29, 0x55, 0xD7, 0xEA, 0xF8, 0x4B, 0x13, 0x0A, 
+0x52, 0x2F, 0x6F, 0xF9, 0x78, 0xD8, 0xDA, 0x8F, 
+0x89, 0x3B, 0x72, 0x3B, 0x5B, 0xAC, 0x4B, 0x98, 
+0x87, 0xB2, 0x0F, 0x2D, 0x9A, 0xB5, 0x9F, 0x5A, 
+0x04, 0x47, 0x00, 0x0D, 0x19, 0x20, 0x56, 0xC0, 
+0x85, 0x80, 0x7F, 0xD1, 0x7E, 0x90, 0xEF, 0x6C, 
+0x54, 0x86, 0x02, 0x72, 0x66, 0x08, 0x80, 0x92, 
+0x64, 0x65, 0x7E, 0x11, 0xC9, 0x9B, 0x78, 0x9F, 
+0x39, 0xF7, 0xA2, 0x21, 0x63, 0x4D, 0x1A, 0x98, 
+0xAB, 0xF0, 0xA1, 0xF1, 0x30, 0x2C, 0xFC, 0xD8, 
+0x0D, 0xF1, 0x6A, 0xD1, 0xC9, 0x4D, 0x02, 0x11, 
+0xEC, 0x9E, 0xC6, 0xB7, 0x22, 0xB6, 0x6F, 0xDF, 
+0x6D, 0xF5, 0xC9, 0xF9, 0x8C, 0x40, 0xCB, 
+
+0x18, 0xFC, 0x6A, 0xD7, 0x9A, 0x54, 0xD6, 0x35, 
+0x35, 0xF4, 0xE6, 0x7E, 0xDA, 0xEE, 0x3D, 0xF1, 
+0x8D, 0xC9, 0x3F, 0xAD, 0x9B, 0xB3, 0xCC, 0x1D, 
+0x50, 0x9F, 0x1A, 0x9F, 0x30, 0xE9, 0x3D, 0xF2, 
+0xC4, 0x2D, 0x13, 0x8E, 0x5F, 0xE2, 0xEE, 0x35, 
+0x6D, 0x9B, 0xB0, 0x03, 0x92, 0xAD, 0x80, 0x0A, 
+0xF2, 0x96, 0xC0, 0xDC, 0x88, 0xD1, 0xDC, 0x99, 
+0x77, 0x86, 0x41, 0xC8, 0x82, 0xFB, 0x5B, 0x61, 
+0x4A, 0x0F, 0x3A, 0x67, 0x7B, 0x45, 0x3B, 0x84, 
+0x5D, 0x7D, 0xFB, 0xC0, 0xF3, 0x1A, 0x24, 0x32, 
+0x76, 0x00, 0x94, 0xB7, 0x35, 0xA4, 0xDB, 0x3F, 
+0xA8, 0x5B, 0x80, 0xEF, 0xA1, 0x48, 0xB1, 0x3A, 
+0xB1, 0xDA, 0x5E, 0x7B, 0xA5, 0x31, 0x02, 0x49, 
+0x2B, 0x9C, 0x4C, 0x8A, 0x98, 0x30, 0xCB, 0x96, 
+0x63, 0x3B, 0x4E, 0xFE, 0xCA, 0xB7, 0x4C, 0x77, 
+0x46, 0x36, 0x71, 0xE3, 0x36, 0xC7, 0x62, 0xBA, 
+0x7C, 0x4B, 0x49, 0x6B, 0xF9, 0x4A, 0xCA, 0xD6, 
+0x46, 0x1A, 0xAE, 0x6F, 0x9B, 0x9A, 0x52, 0x63, 
+0xFC, 0xC3, 0x5F, 0x40, 0xB7, 0xAF, 0x75, 0xC3, 
+0xCD, 0x16, 0xE5, 0x7E, 0xB5, 0x78, 0x15, 0xFA, 
+0x11, 0xB6, 0x33, 0x09, 0xE2, 0x6F, 0xA4, 0x10, 
+0x94, 0xFB, 0x0C, 0xC7, 0x4E, 0xB2, 0x7E, 0xE7, 
+
+0xC2, 0x5C, 0xCB, 0x6F, 0x01, 0xE1, 0xA5, 0xF7, 
+0x4D, 0x99, 0x2B, 0x89, 0x6E, 0xB3, 0x0C, 0x93, 
+0x0B, 0x29, 0x77, 0x1E, 0xD7, 0xBF, 0x74, 0xAD, 
+0x22, 0xEF, 0xD2, 0xAB, 0x28, 0x89, 0xBD, 0x1E, 
+0x85, 0x6C, 0xE7, 0x17, 0xAF, 0xF9, 0x6E, 0x37, 
+0x6A, 0xCA, 0x9C, 0x1B, 0xFE, 0x7F, 0x6E, 0x9D, 
+0x5D, 0x07, 0xA8, 0xC6, 0x6E, 0x08, 0xF3, 0xB1, 
+0x9A, 0x33, 0x37, 0x31, 0x36, 0x34, 0x52, 0x38, 
+0x9E, 0x44, 0xC9, 0xD2, 0x50, 0xB0, 0x4A, 0x45, 
+0x7A, 0x30, 0x33, 0x70, 0x6E, 0x6B, 0xA3, 0x1F, 
+0xBA, 0xE4, 0x79, 0xFD, 0x39, 0x45, 0xB7, 0x85, 
+0xA6, 0xD6, 0x90, 0x5A, 0xF1, 0xA5, 0xF5, 0x05, 
+0xEE, 0xDC, 0x9B, 0xDB, 0x49, 0xB0, 0x17, 0xD7, 
+0xAD, 0x57, 0x61, 0x18, 0xC6, 0x6B, 0x2E, 0x53, 
+0xA9, 0x22, 0x21, 0x83, 0x50, 0x84, 0x87, 0x5F, 
+0x0C, 0x13, 0x2C, 0xAD, 0x88, 0xD4, 0x55, 0xDF, 
+0x70, 0x51, 0x91, 0x27, 0xA3, 0x1F, 0x82, 0x03, 
+0x84, 0x41, 0x55, 0x75, 0xA1, 0x9B, 0x56, 0xEE, 
+0xC9, 0xB9, 0x9A, 0x66, 0xA6, 0xE2, 0x3F, 0x7F, 
+0x5D, 0x37, 0x50, 0x05, 0x2D, 0x29, 0x5F, 0xC9, 
+0x96, 0xA0, 0xC5, 0x28, 0xF2, 0xF3, 0xB8, 0xC9, 
+0xC9, 0x38, 0xB6, 0x4A, 0xBC, 0x57, 0x56, 0x72, 
+0x4E, 
+
+0xDD, 0xEF, 0xC3, 0x4F, 0xF8, 0x17, 0xD0, 0x8E, 
+0x36, 0x22, 0x4D, 0x9C, 0x1C, 0x23, 0xA5, 0x2E, 
+0x48, 0xA5, 0x54, 0x13, 0x0C, 0x3B, 0xC5, 0x5E, 
+0x0E, 0x0C, 0x62, 0x95, 0xB0, 0xD7, 0x77, 0xEA, 
+0xC7, 0x93, 0x41, 0x6A, 0x19, 0x91, 0xBE, 0x0A, 
+0x62, 0x56, 0x69, 0x4E, 0x83, 0x3A, 0xBC, 0x88, 
+0x4A, 0xEC, 0xE9, 0x37, 0x55, 0x2C, 0xE6, 0x6B, 
+0xF8, 0xFA, 0x38, 0x51, 0x20, 0xD2, 0xC9, 0x45, 
+0x1B, 0xE0, 0x5D, 0xA3, 0x86, 0x39, 0xD0, 0xBB, 
+0xB2, 0xE8, 0x78, 0xE9, 0xE4, 0x99, 0xFA, 0xE4, 
+0xB7, 0x79, 0x35, 0xE6, 0xAA, 0x44, 0xA3, 0x10, 
+0xE4, 0x5C, 0xCE, 0x06, 0x6E, 0x63, 0xA7, 0xCB, 
+0x5B, 0x08, 0xCD, 0xFA, 0xEA, 0xC3, 0xB5, 0xE5, 
+0x8C, 0x80, 0xBC, 0xD6, 0x1E, 0x15, 0xF2, 0xD5, 
+0x21, 0xA0, 0x3D, 0xE5, 0x5C, 0x1B, 0xA3, 0x1D, 
+0xEB, 0x2B, 0xA5, 0xF8, 0xF6, 0xCF, 0x26, 0x8E, 
+0xD2, 0xE5, 0x41, 0xA0, 0x26, 0xE7, 0xBD, 0x63, 
+0x87, 0x29, 0xD8, 0xCF, 0xBF, 0x47, 0xD0, 0x58, 
+0x21, 0xBE, 0x6D, 0xAE, 0x2A, 0x63, 0x92, 0x69, 
+0xD5, 0xC2, 0x41, 0x0A, 0x2E, 0xBD, 0x3D, 0x23, 
+0x20, 0x78, 0x89, 0x7B, 0x1F, 0xE3, 0xF6, 0x47, 
+0x09, 0xAC, 0x37, 0x45, 0x1D, 0x58, 0x38, 0xD0, 
+0x5C, 0x27, 
+
+0xC3, 0xDC, 0x67, 0x61, 0x8B, 0x42, 0x76, 0xAA, 
+0xFF, 0xA0, 0xB6, 0x74, 0x12, 0x75, 0x63, 0x27, 
+0x81, 0x8F, 0xDB, 0xC6, 0xBC, 0x3F, 0x00, 0xB3, 
+0xCA, 0xDD, 0x16, 0x4E, 0xA5, 0xA9, 0x50, 0x31, 
+0x9D, 0xF7, 0xCF, 0x2B, 0x8B, 0x69, 0x3A, 0xF6, 
+0xBE, 0xF5, 0xBD, 0xBC, 0xCB, 0x48, 0x6F, 0xD2, 
+0x53, 0x11, 0x66, 0xB3, 0x40, 0xDB, 0x37, 0xB5, 
+0x04, 0xF5, 0xA0, 0x0F, 0x5F, 0x00, 0xC3, 0xFA, 
+0x9F, 0x01, 0xF4, 0xA6, 0xD7, 0x95, 0xE2, 0xD0, 
+0x43, 0xA4, 0xA7, 0x4D, 0x08, 0x91, 0x67, 0xA4, 
+0x9A, 0xCC, 0x2B, 0x98, 0x98, 0x13, 0xE3, 0x8E, 
+0x9F, 0x93, 0x91, 0x00, 0xD5, 0xD1, 0x44, 0x88, 
+0x07, 0xDE, 0x1B, 0x00, 0x82, 0xFF, 0xC3, 0x96, 
+0x79, 0x6A, 0x78, 0x1E, 0x0F, 0x19, 0x84, 0xA9, 
+0x62, 0xF5, 0x08, 0x84, 0x3D, 0x33, 0x40, 0xD4, 
+0xE6, 0xAD, 0xAE, 0x07, 0xB7, 0x05, 0x3C, 0xE9, 
+0x78, 0x8B, 0x6D, 0x82, 0xBB, 0x4D, 0x4F, 0x04, 
+0x21, 0x5A, 0xA7, 0xFE, 0x4D, 0xAC, 0x23, 0xAB, 
+0x07, 0x13, 0x52, 0x7C, 0xD5, 0x4A, 0xD8, 0x1C, 
+0x1A, 0x76, 0x6B, 0x6F, 0xF0, 0xE9, 0xA2, 0x1F, 
+0x69, 0xEE, 0x84, 0x38, 0x19, 0x89, 0x55, 0x79, 
+0xD8, 0x01, 0xD2, 0x1C, 0x8F, 0x3C, 0x5D, 0xF0, 
+0xE7, 0x4D, 0x12, 
+
+0x11, 0x67, 0xAB, 0x0D, 0x53, 0x45, 0x58, 0x2B, 
+0x9B, 0xF1, 0x42, 0x02, 0x31, 0xEC, 0x85, 0xA3, 
+0x24, 0x5A, 0xB2, 0x3E, 0x7A, 0xAF, 0xD5, 0x37, 
+0x68, 0x0B, 0x96, 0xF5, 0x63, 0x76, 0xEA, 0xAD, 
+0xCB, 0x77, 0xF8, 0xA5, 0x2E, 0xD7, 0xC7, 0xE7, 
+0xB2, 0xC3, 0x17, 0x81, 0x43, 0x9D, 0xC0, 0x1D, 
+0x6F, 0xD9, 0xC2, 0x7C, 0xBB, 0x65, 0x92, 0x29, 
+0x88, 0x98, 0xA0, 0x86, 0xC9, 0x93, 0xC7, 0xF3, 
+0x1A, 0x5F, 0xCA, 0xD6, 0xE5, 0xEC, 0x8D, 0xBF, 
+0x44, 0xA4, 0x08, 0xEE, 0xDA, 0x33, 0xA6, 0x89, 
+0xFC, 0x2D, 0x4D, 0xA7, 0xAA, 0xD5, 0x97, 0x88, 
+0x82, 0x35, 0xED, 0xD7, 0xDE, 0x04, 0xF3, 0xE5, 
+0x28, 0x82, 0x1B, 0xC4, 0xD1, 0x51, 0x5E, 0x62, 
+0x01, 0xF4, 0x32, 0xD8, 0x38, 0x71, 0x52, 0xF9, 
+0x9B, 0x33, 0xC0, 0x8D, 0xE8, 0x84, 0xBA, 0x8D, 
+0xF0, 0x25, 0x8B, 0x56, 0xE9, 0x83, 0x8C, 0xCA, 
+0x56, 0x6C, 0xC0, 0x54, 0xDF, 0x0F, 0xE9, 0xB9, 
+0x6C, 0xDF, 0x39, 0x11, 0x92, 0x61, 0x36, 0x73, 
+0x86, 0xD6, 0x63, 0x4A, 0xD2, 0x4B, 0x01, 0x16, 
+0x88, 0x77, 0x18, 0x1C, 0x5F, 0x63, 0x3C, 0x4F, 
+0x67, 0x08, 0xAB, 0xCB, 0x6F, 0xF9, 0x6E, 0x78, 
+0x27, 0xA7, 0x8A, 0xE3, 0x6E, 0x7A, 0x21, 0x64, 
+0x10, 0x21, 0x6C, 0x7E, 
+
+0xD6, 0x11, 0x14, 0x52, 0x32, 0x06, 0x73, 0xA6, 
+0x38, 0xDB, 0xAE, 0xFB, 0x7D, 0x1F, 0x0D, 0xC3, 
+0xEB, 0xB7, 0x60, 0x8F, 0x7A, 0xA8, 0x4D, 0x5D, 
+0xF1, 0x88, 0xC1, 0x0B, 0xE6, 0xE7, 0xB5, 0x3E, 
+0x66, 0x8F, 0xD8, 0x10, 0x99, 0xAD, 0x4B, 0x9E, 
+0x5F, 0x83, 0xDA, 0xAC, 0x3C, 0xA4, 0xB9, 0x1D, 
+0x60, 0xCB, 0xB3, 0x03, 0x80, 0x38, 0xE6, 0x37, 
+0x69, 0x6D, 0x72, 0x95, 0x08, 0x21, 0x6A, 0x41, 
+0x89, 0x6F, 0x8F, 0xDF, 0xA7, 0x34, 0x81, 0x73, 
+0x00, 0xB0, 0x7E, 0xC4, 0xA1, 0x1D, 0xEA, 0xA2, 
+0x55, 0xFC, 0x11, 0x97, 0xEF, 0x74, 0xEE, 0x3D, 
+0x7B, 0x2D, 0x69, 0x9A, 0x04, 0x8E, 0x57, 0x5C, 
+0x38, 0xCF, 0x4E, 0xB2, 0xD4, 0x3B, 0xDB, 0x2F, 
+0x8D, 0x70, 0x48, 0x9D, 0x82, 0x7A, 0x25, 0xF0, 
+0x2E, 0xBE, 0x24, 0xEF, 0xCE, 0x3D, 0x62, 0x94, 
+0x40, 0xC6, 0xB6, 0x17, 0x5F, 0x8E, 0x16, 0x26, 
+0x26, 0x0A, 0xED, 0xA0, 0x50, 0x6F, 0xEA, 0x29, 
+0x23, 0xE6, 0xE8, 0x53, 0x91, 0x9C, 0x11, 0x5B, 
+0x74, 0x2D, 0xA6, 0x94, 0x1C, 0x3E, 0x0D, 0xFD, 
+0x96, 0x4F, 0x36, 0x45, 0xBD, 0xED, 0x54, 0x3E, 
+0x46, 0x56, 0xCF, 0x2A, 0xBC, 0x03, 0x8A, 0xD0, 
+0x19, 0x5C, 0x9C, 0xDC, 0xC7, 0x98, 0x3A, 0xB2, 
+0x0D, 0x76, 0xB2, 0x5C, 0x9C, 
+
+0x47, 0x93, 0x54, 0xCD, 0xBB, 0xB7, 0x75, 0xC6, 
+0x15, 0x5E, 0x5D, 0x93, 0x66, 0x11, 0x8F, 0x94, 
+0xB6, 0x02, 0x8C, 0x79, 0xEF, 0x6E, 0x47, 0x04, 
+0x00, 0x66, 0x15, 0x66, 0x4E, 0x2E, 0x2B, 0xD6, 
+0x7C, 0xD8, 0x8D, 0x05, 0xE6, 0xD8, 0xCB, 0x75, 
+0xDA, 0x16, 0xE9, 0xB8, 0x54, 0x77, 0xC3, 0x20, 
+0x08, 0x91, 0x5C, 0xD4, 0xC2, 0xCF, 0x48, 0xFB, 
+0xEE, 0xC1, 0x93, 0xC2, 0xFD, 0xD3, 0x7A, 0x04, 
+0x8E, 0xEF, 0xA4, 0xB8, 0x32, 0xE6, 0x62, 0x21, 
+0x54, 0xBD, 0x2E, 0x0D, 0x54, 0xEA, 0x9D, 0x7A, 
+0x57, 0x2B, 0x37, 0x18, 0x43, 0xA7, 0xA5, 0xE2, 
+0x67, 0xF3, 0x42, 0x48, 0x35, 0x2C, 0xA3, 0x78, 
+0x0D, 0xC0, 0x0B, 0x6D, 0xEB, 0xE9, 0xDC, 0xCA, 
+0x84, 0x90, 0x73, 0x66, 0xD9, 0x92, 0x31, 0xDA, 
+0x5A, 0x05, 0x63, 0x46, 0x76, 0x28, 0xEA, 0x7B, 
+0x44, 0x10, 0xBC, 0x06, 0x75, 0xBC, 0x6C, 0xD9, 
+0xD0, 0xF7, 0x1C, 0xEF, 0xFE, 0x90, 0x61, 0x8D, 
+0x44, 0x81, 0x52, 0x7B, 0xDC, 0x84, 0xD3, 0x17, 
+0x32, 0x98, 0xC0, 0x6A, 0x8F, 0x43, 0x3B, 0xB5, 
+0xA3, 0xDE, 0x8E, 0xE8, 0x9B, 0x8C, 0x82, 0x1C, 
+0xBF, 0x20, 0x4D, 0x2B, 0x16, 0x25, 0x24, 0xB1, 
+0xA9, 0x23, 0x65, 0x1A, 0x58, 0xAD, 0x0C, 0x90, 
+0x0B, 0x63, 0x1E, 0xA4, 0x9C, 0x7E, 
+
+0xF1, 0x2C, 0xF2, 0x76, 0x6E, 0xC1, 0xB3, 0x84, 
+0xA9, 0x5B, 0x56, 0x62, 0x09, 0xAF, 0x03, 0x24, 
+0xA7, 0x01, 0x6E, 0x23, 0x1B, 0xB5, 0x89, 0xDB, 
+0x4E, 0x97, 0x32, 0x3A, 0x59, 0xD3, 0xFE, 0x17, 
+0xDA, 0x26, 0xEE, 0x80, 0x77, 0x48, 0x80, 0x57, 
+0x64, 0x2A, 0xF9, 0x4D, 0x9C, 0x1C, 0x0D, 0x0F, 
+0x8A, 0x04, 0x57, 0x0A, 0x7E, 0x9D, 0x42, 0x0A, 
+0x33, 0xCA, 0x66, 0x92, 0x2E, 0x8F, 0x51, 0x8C, 
+0x92, 0xD3, 0x97, 0x7A, 0x38, 0x04, 0xB5, 0x77, 
+0xD9, 0xB7, 0x26, 0x16, 0xE2, 0x83, 0x0D, 0xF5, 
+0x06, 0x4E, 0x7B, 0xB0, 0xF7, 0x75, 0xD1, 0x14, 
+0xB5, 0x89, 0xA3, 0x89, 0x25, 0x48, 0xDE, 0x44, 
+0x15, 0xC1, 0x0C, 0x4E, 0x5E, 0xC4, 0xE2, 0x79, 
+0xFD, 0x48, 0x89, 0x48, 0xD6, 0x09, 0xD4, 0xA8, 
+0xD1, 0x08, 0x8D, 0xF2, 0x47, 0x58, 0x8F, 0xBC, 
+0x5B, 0xEB, 0x6A, 0xDF, 0xAF, 0xC0, 0x3A, 0x85, 
+0xF3, 0x29, 0xC7, 0x0C, 0x99, 0xE9, 0x18, 0x42, 
+0x1E, 0x1F, 0x7F, 0xE2, 0xD0, 0x33, 0xDD, 0x1D, 
+0x8C, 0x64, 0xB8, 0x12, 0x86, 0x95, 0x19, 0xF2, 
+0x43, 0x2D, 0x79, 0xF2, 0x61, 0xDA, 0x6B, 0xE5, 
+0x88, 0x27, 0x30, 0xA0, 0x57, 0x38, 0x4F, 0x68, 
+0x74, 0x64, 0x69, 0xBD, 0xC2, 0x87, 0xA8, 0x39, 
+0x7C, 0xC1, 0x1F, 0x4E, 0xF9, 0x79, 0x88, 
+
+0x0B, 0xAC, 0xE0, 0x78, 0xF6, 0x96, 0x78, 0x12, 
+0xB3, 0x68, 0x4C, 0x32, 0x40, 0xD8, 0x52, 0x36, 
+0x6D, 0xD1, 0xEB, 0x99, 0x2C, 0x3C, 0x5B, 0xE4, 
+0x7B, 0xEA, 0xB1, 0xC6, 0xCF, 0x7B, 0x7C, 0xBF, 
+0x6F, 0x4D, 0x0C, 0x05, 0x82, 0x5B, 0xE9, 0xA5, 
+0x1F, 0x5C, 0xAF, 0x7B, 0x97, 0xCD, 0xE6, 0xCC, 
+0x4B, 0x9D, 0xF0, 0x59, 0xB8, 0xD1, 0x2C, 0x02, 
+0xC7, 0x10, 0xA2, 0xA4, 0x1F, 0x8A, 0x01, 0x41, 
+0xBD, 0x4F, 0xBE, 0x03, 0x68, 0xE1, 0xB0, 0xD2, 
+0x18, 0xA3, 0x09, 0x55, 0x92, 0xA4, 0x03, 0xC7, 
+0xCA, 0x65, 0xE8, 0x79, 0xF1, 0x48, 0xC1, 0xF9, 
+0x68, 0xCB, 0x69, 0xFF, 0x05, 0xB3, 0xDE, 0xF4, 
+0x8C, 0x60, 0x35, 0x04, 0x9B, 0x89, 0x11, 0xE3, 
+0x1C, 0xBB, 0x24, 0x16, 0xEA, 0x78, 0x25, 0x37, 
+0x6D, 0x3F, 0xDD, 0xD1, 0xA0, 0xD4, 0xE0, 0x4B, 
+0xE3, 0xB3, 0x4A, 0xB5, 0xAF, 0x9E, 0x81, 0x9F, 
+0xFB, 0xE6, 0x49, 0x80, 0xEB, 0x4A, 0x0B, 0xCF, 
+0xDC, 0xD5, 0x2D, 0x65, 0xE6, 0x99, 0x3F, 0x9F, 
+0xDA, 0xAF, 0xA4, 0x14, 0xE2, 0x45, 0x70, 0x22, 
+0x87, 0x12, 0x6B, 0x27, 0x41, 0x68, 0xB7, 0x8A, 
+0xE2, 0x3D, 0x8B, 0x22, 0x9A, 0xCC, 0xDD, 0xBE, 
+0x71, 0x42, 0xC0, 0xC3, 0x58, 0x5C, 0xC3, 0x6F, 
+0x05, 0x20, 0xEE, 0x14, 0xB5, 0x48, 0x73, 0xDC, 
+
+0x1D, 0xEF, 0x1B, 0x6E, 0x3F, 0xC6, 0xC5, 0x20, 
+0x43, 0xF7, 0x33, 0x4D, 0x03, 0xE6, 0x75, 0x93, 
+0x58, 0x3B, 0x28, 0x5B, 0x10, 0x25, 0x48, 0x5B, 
+0xE5, 0x23, 0xCF, 0xAE, 0x85, 0x81, 0x73, 0xEA, 
+0x27, 0x8C, 0x49, 0x47, 0x97, 0xB4, 0xB6, 0x06, 
+0x27, 0x97, 0xDD, 0x59, 0xCB, 0x5D, 0x92, 0xDA, 
+0x04, 0x14, 0x29, 0xAD, 0xB3, 0xF7, 0x8B, 0xAE, 
+0x77, 0xB4, 0x78, 0x9A, 0x76, 0x84, 0xE0, 0xB7, 
+0x20, 0x9D, 0xBA, 0xFE, 0x96, 0x28, 0x7B, 0x47, 
+0x59, 0xC1, 0xDE, 0xE0, 0x7C, 0xDE, 0xFD, 0x59, 
+0x84, 0x16, 0xF8, 0xB3, 0xBA, 0xB8, 0x43, 0xC7, 
+0x29, 0xC9, 0xBF, 0x89, 0x85, 0xB1, 0x3E, 0x59, 
+0xD2, 0xA5, 0x6C, 0xA7, 0xBD, 0xB6, 0x9F, 0xE9, 
+0x6E, 0x3F, 0x3B, 0x3D, 0x31, 0xEA, 0xDB, 0x11, 
+0xC1, 0xE1, 0x64, 0x0E, 0xD7, 0x86, 0x71, 0x73, 
+0x8F, 0xA9, 0xEF, 0xBE, 0xAB, 0xEC, 0x6D, 0x7F, 
+0xB4, 0xA1, 0x85, 0x36, 0xDB, 0x3B, 0xA9, 0xE2, 
+0x7D, 0x82, 0xAE, 0xB5, 0xDD, 0x18, 0x28, 0x08, 
+0x2D, 0xBA, 0x1F, 0x06, 0x08, 0x90, 0x5F, 0x3E, 
+0xF5, 0xEF, 0x12, 0xF0, 0x69, 0xC8, 0x16, 0x08, 
+0x85, 0x8B, 0x9F, 0x0B, 0xA0, 0x6A, 0x87, 0x81, 
+0x97, 0xE9, 0x15, 0x47, 0x03, 0xE8, 0x6B, 0xCB, 
+0x1D, 0xAE, 0x15, 0x17, 0xDE, 0x63, 0xED, 0x46, 
+0x9B, 
+
+0x7C, 0xB7, 0x53, 0xA8, 0x54, 0x09, 0xB7, 0xFB, 
+0xB7, 0xF4, 0x4A, 0x44, 0x4D, 0x3B, 0x95, 0xF1, 
+0x33, 0x76, 0xB9, 0x41, 0x51, 0x0D, 0xF4, 0x98, 
+0xB0, 0x48, 0x9F, 0x80, 0xE6, 0x7E, 0x04, 0x54, 
+0x9F, 0xB8, 0x9E, 0x76, 0x49, 0x1C, 0x52, 0xD0, 
+0x27, 0x72, 0xEC, 0x80, 0x63, 0x0F, 0xD6, 0x0C, 
+0x2C, 0x33, 0x11, 0x98, 0xFF, 0x1E, 0xE1, 0xC0, 
+0xA5, 0x42, 0x70, 0x57, 0xAC, 0x2F, 0x63, 0x6D, 
+0x66, 0x69, 0x1C, 0x54, 0x9A, 0x5F, 0x84, 0xA8, 
+0xDB, 0xE5, 0xDA, 0x91, 0x73, 0x9B, 0xBC, 0x73, 
+0x2E, 0x24, 0x9C, 0x34, 0x15, 0xF4, 0x2F, 0xBE, 
+0x1E, 0xFE, 0x0A, 0xC7, 0x3B, 0x9B, 0x37, 0xED, 
+0x6E, 0x71, 0x72, 0xB8, 0xF2, 0x15, 0x75, 0xE6, 
+0x8E, 0x05, 0xAB, 0xBD, 0x75, 0xD8, 0x58, 0x37, 
+0xAB, 0x23, 0x9F, 0x35, 0xA5, 0xE9, 0x48, 0xDA, 
+0x3F, 0xD7, 0x7A, 0xE3, 0x53, 0x16, 0xCB, 0xF5, 
+0x25, 0x3F, 0x4A, 0xF1, 0x35, 0x65, 0xF5, 0xB0, 
+0xFE, 0x84, 0xD7, 0x96, 0xBA, 0x6A, 0x31, 0x0D, 
+0x78, 0x60, 0xC7, 0x6C, 0xA0, 0x87, 0x40, 0x74, 
+0x1D, 0xCD, 0xD0, 0x18, 0xD1, 0xAC, 0x1F, 0x85, 
+0xAA, 0x47, 0xD6, 0xF6, 0xA4, 0x97, 0xCD, 0x17, 
+0x30, 0x8F, 0xC2, 0x94, 0x42, 0x6D, 0xE9, 0x51, 
+0x58, 0x39, 0x69, 0xF8, 0x34, 0x47, 0x9B, 0x56, 
+0x1E, 0x95, 
+
+0xF6, 0x83, 0xFB, 0xD8, 0x53, 0x07, 0x2B, 0x35, 
+0xE4, 0x82, 0x2E, 0x0E, 0xCE, 0x4A, 0xF3, 0x3F, 
+0x8D, 0x76, 0x3E, 0xF3, 0xA6, 0xB3, 0xEB, 0x15, 
+0xB8, 0x0F, 0xCF, 0x16, 0x94, 0x4F, 0xFF, 0x6C, 
+0x13, 0x7F, 0xE1, 0x47, 0x14, 0x2C, 0x5A, 0x65, 
+0xA6, 0x12, 0xD1, 0xBA, 0x16, 0x04, 0x51, 0x30, 
+0x8E, 0xD9, 0xCF, 0x54, 0x5C, 0x4F, 0x3E, 0xA5, 
+0x40, 0x9B, 0x87, 0x99, 0xE8, 0xA4, 0xD7, 0xFB, 
+0xA0, 0x05, 0x77, 0x6C, 0x9C, 0x6F, 0xA8, 0x6C, 
+0x0A, 0x23, 0xD5, 0x03, 0x55, 0x1F, 0x2A, 0xC9, 
+0xAC, 0xE5, 0xA3, 0x87, 0xA6, 0xBE, 0x63, 0x2D, 
+0x42, 0x69, 0x1D, 0x05, 0x7D, 0xB1, 0x7E, 0xCC, 
+0xAE, 0xC0, 0xFB, 0x40, 0x5F, 0x15, 0xEF, 0x04, 
+0x79, 0x16, 0x95, 0xDD, 0x11, 0x09, 0x4D, 0x9B, 
+0x4A, 0xF1, 0x12, 0xD1, 0x76, 0x63, 0x75, 0xE8, 
+0x75, 0xD0, 0x9B, 0x3E, 0x7B, 0x51, 0x5D, 0xE6, 
+0x94, 0xD9, 0xDB, 0x71, 0x21, 0x1B, 0x64, 0xEA, 
+0xFB, 0x34, 0x2C, 0x32, 0x20, 0x2C, 0xEA, 0xF8, 
+0x4E, 0x9C, 0xDE, 0x6C, 0x63, 0xE8, 0x13, 0xA6, 
+0xE4, 0x59, 0x1A, 0x5D, 0xE5, 0xFF, 0xC2, 0x15, 
+0x66, 0x67, 0xE1, 0xB1, 0xFC, 0x19, 0x22, 0x9B, 
+0x86, 0xC6, 0x67, 0x1E, 0xC8, 0xFA, 0xAD, 0x48, 
+0xF1, 0xC3, 0x4F, 0xE3, 0xCF, 0xB4, 0x29, 0xB8, 
+0x21, 0xB9, 0x87, 
+
+0xBA, 0x6D, 0xF8, 0x59, 0x1B, 0xBF, 0x1D, 0x0A, 
+0x71, 0x15, 0xB0, 0xF8, 0xC8, 0x89, 0x6C, 0x03, 
+0xAA, 0x87, 0xFC, 0xD5, 0x10, 0x0C, 0xDC, 0x81, 
+0x41, 0x35, 0x45, 0xA1, 0x39, 0x94, 0x11, 0xE7, 
+0x1B, 0xA6, 0x37, 0xB8, 0x34, 0xE0, 0x3F, 0x04, 
+0x3B, 0x3E, 0xB0, 0x00, 0xC4, 0x08, 0xA9, 0xAB, 
+0xF7, 0x7C, 0x7A, 0xB8, 0xF7, 0x7D, 0x2A, 0x0F, 
+0xD4, 0x04, 0x79, 0xE8, 0x87, 0x8C, 0x51, 0x28, 
+0x99, 0xD3, 0x65, 0xA3, 0x67, 0x80, 0xBE, 0x6D, 
+0x6F, 0xAD, 0xF0, 0x71, 0xA9, 0xC9, 0x36, 0xC9, 
+0x02, 0xB1, 0x8A, 0x5A, 0x03, 0xC7, 0x9B, 0x67, 
+0xB6, 0x2C, 0x28, 0xA7, 0x0B, 0xA1, 0x5B, 0x41, 
+0xC8, 0x0E, 0xB5, 0xD3, 0x8F, 0xE0, 0x3B, 0x57, 
+0x8A, 0x86, 0xA8, 0xF6, 0x23, 0x5A, 0xC5, 0x63, 
+0x6A, 0x5A, 0x3A, 0x4A, 0xAC, 0x03, 0x38, 0x89, 
+0x46, 0x88, 0x98, 0x43, 0x41, 0x44, 0x59, 0x18, 
+0x0B, 0xDC, 0xDD, 0xDB, 0x9D, 0x10, 0x6A, 0x51, 
+0x95, 0xE9, 0xB0, 0xAA, 0x87, 0xF1, 0xDF, 0x56, 
+0xC7, 0x60, 0xF2, 0xC6, 0x7E, 0x95, 0xF6, 0x10, 
+0x86, 0x00, 0xAD, 0x71, 0xC9, 0xFC, 0x89, 0x09, 
+0x4B, 0xBF, 0x91, 0x61, 0xF4, 0x71, 0x5C, 0x44, 
+0xD0, 0x86, 0x2D, 0x9E, 0x8F, 0x03, 0xB2, 0x71, 
+0x8E, 0x2D, 0xBD, 0x61, 0xE5, 0xB0, 0x03, 0xF6, 
+0x5B, 0x73, 0x1E, 0x34, 
+
+0x70, 0x82, 0x71, 0x45, 0xB1, 0xF1, 0xBA, 0xD0, 
+0x85, 0x7A, 0x8C, 0x44, 0x11, 0x6D, 0xBE, 0x34, 
+0x4D, 0xD5, 0x9D, 0xF1, 0x09, 0x60, 0x0B, 0x8E, 
+0x3E, 0x51, 0x28, 0xF3, 0x84, 0x83, 0x5C, 0xBE, 
+0xAB, 0x8D, 0xBD, 0xFE, 0xD7, 0x44, 0x8A, 0x6B, 
+0xED, 0x13, 0xE8, 0x00, 0x97, 0x61, 0x3C, 0x11, 
+0x2C, 0x73, 0x79, 0x94, 0x56, 0x81, 0xE2, 0xE8, 
+0x98, 0xE2, 0x34, 0x39, 0xA4, 0xE5, 0x93, 0x10, 
+0x95, 0x4F, 0x60, 0xF9, 0x69, 0x6A, 0xA2, 0xF9, 
+0x6C, 0x6D, 0x87, 0xAF, 0x23, 0x25, 0xB8, 0x69, 
+0x28, 0x8C, 0x9F, 0xB8, 0x96, 0x15, 0x4A, 0xD0, 
+0xB5, 0xE5, 0x37, 0xD3, 0x49, 0x6B, 0xFE, 0x7F, 
+0x90, 0x98, 0xBA, 0x0D, 0xF7, 0x5A, 0x8C, 0x58, 
+0x14, 0x3F, 0x49, 0xC2, 0x21, 0xFC, 0xE1, 0x1B, 
+0x99, 0xF8, 0xFA, 0x39, 0xAC, 0xF3, 0xE6, 0x81, 
+0x73, 0x70, 0xF4, 0x45, 0x9C, 0x9F, 0x2C, 0x00, 
+0x05, 0x50, 0x7C, 0x6A, 0x14, 0x95, 0x76, 0x19, 
+0x60, 0xC1, 0xCD, 0x9D, 0x65, 0x51, 0x59, 0x7E, 
+0x8D, 0xFF, 0x12, 0x4C, 0x6F, 0xDB, 0x9A, 0xFC, 
+0xDC, 0x03, 0xE4, 0xD0, 0xE1, 0xB8, 0x4F, 0xEA, 
+0x8C, 0x24, 0x0C, 0xC1, 0x5A, 0x79, 0x05, 0x94, 
+0x87, 0xFA, 0x3A, 0x61, 0x59, 0xF3, 0x36, 0xD3, 
+0xA9, 0x19, 0xF7, 0x91, 0x66, 0xC0, 0xE4, 0x41, 
+0xC3, 0xFD, 0x8C, 0x90, 0x78, 
+
+0x89, 0x4D, 0x9F, 0xFB, 0xF2, 0x2F, 0x34, 0xBF, 
+0x7C, 0x0A, 0xE1, 0xAE, 0x92, 0x9A, 0x75, 0xB9, 
+0xB0, 0x54, 0xFC, 0xF0, 0x66, 0x52, 0xB5, 0xF8, 
+0xFB, 0x51, 0xBC, 0x96, 0x06, 0x96, 0xDE, 0x2E, 
+0x8A, 0x15, 0x24, 0x59, 0x28, 0xA9, 0x10, 0x07, 
+0x83, 0x6C, 0x7F, 0xCA, 0x7A, 0x65, 0x66, 0x6C, 
+0x02, 0x7C, 0x63, 0x6D, 0x4F, 0x93, 0xE7, 0x7E, 
+0x11, 0x2C, 0x39, 0xC4, 0xE9, 0x30, 0x4F, 0x2A, 
+0xBA, 0x61, 0x69, 0xD5, 0x06, 0x0D, 0x22, 0xCD, 
+0xE0, 0xE0, 0xC7, 0xBD, 0xF6, 0x67, 0x01, 0xF5, 
+0x03, 0x11, 0x7B, 0x42, 0x7B, 0x1C, 0x91, 0xF2, 
+0xEC, 0x60, 0xE3, 0xE5, 0x27, 0xC1, 0xE2, 0xB7, 
+0xE6, 0x61, 0x4D, 0x75, 0x59, 0x52, 0x13, 0x74, 
+0xAB, 0x1E, 0x17, 0x54, 0xBD, 0x5F, 0xD3, 0x4C, 
+0x47, 0xFF, 0x7A, 0xC6, 0x73, 0x19, 0xCE, 0x00, 
+0x07, 0x42, 0xA9, 0xB2, 0xDB, 0x9D, 0x11, 0xB0, 
+0xD9, 0x71, 0xFC, 0xB0, 0xD6, 0xCF, 0xFE, 0x72, 
+0x77, 0x5B, 0x85, 0xC6, 0x0A, 0x2F, 0x9F, 0x0A, 
+0x6B, 0x21, 0x76, 0xE9, 0xE3, 0x8F, 0xA3, 0x43, 
+0x29, 0x98, 0xC4, 0x21, 0x99, 0xF0, 0x42, 0xAE, 
+0x3C, 0xA1, 0x5D, 0x06, 0x4C, 0x1F, 0xEC, 0x36, 
+0xBF, 0x9D, 0x00, 0x15, 0xDE, 0x27, 0x61, 0x8E, 
+0xD1, 0x6B, 0x22, 0x34, 0x09, 0x6F, 0x61, 0xF4, 
+0x4B, 0x0A, 0x35, 0x03, 0xF1, 0x21, 
+
+0xA6, 0x29, 0xE5, 0xC7, 0x41, 0xDC, 0x83, 0x95, 
+0xE5, 0x61, 0xE0, 0xEC, 0xCA, 0xFD, 0x95, 0x7D, 
+0xAB, 0xB9, 0x40, 0x1B, 0xE3, 0x7D, 0x36, 0x84, 
+0x7C, 0xFA, 0xCF, 0xDA, 0xB8, 0x8D, 0x83, 0x57, 
+0x4D, 0x6A, 0x61, 0x0F, 0x17, 0x4C, 0xCC, 0xEF, 
+0xF2, 0xCC, 0xF6, 0x0D, 0x2F, 0xA6, 0xF1, 0x26, 
+0xCE, 0x32, 0x1C, 0x9D, 0x47, 0x13, 0x60, 0xD6, 
+0x7E, 0x22, 0x8A, 0x64, 0x2F, 0x85, 0x31, 0x1C, 
+0x65, 0x73, 0x13, 0xBC, 0x68, 0x6C, 0x1F, 0xBF, 
+0x14, 0x4A, 0x6C, 0x04, 0x7A, 0xE0, 0x1D, 0xDC, 
+0xFA, 0x11, 0x5C, 0x62, 0xB6, 0x73, 0xEE, 0x0A, 
+0x70, 0x23, 0x04, 0xC4, 0x90, 0x4D, 0x55, 0x95, 
+0x7C, 0x1F, 0x6A, 0xFA, 0xA5, 0xDE, 0x3B, 0x71, 
+0xAB, 0x5F, 0xF7, 0x87, 0x22, 0xE2, 0x82, 0xA7, 
+0x70, 0xB4, 0xF4, 0x31, 0x78, 0xCE, 0x95, 0x2A, 
+0xB1, 0xF7, 0xAD, 0x63, 0x1E, 0xEF, 0xFA, 0x9F, 
+0x22, 0x30, 0x0A, 0xFC, 0xED, 0x46, 0xE8, 0x08, 
+0x74, 0xEF, 0x25, 0x7E, 0xB0, 0xA2, 0x3E, 0x14, 
+0x61, 0xB0, 0xAA, 0xB7, 0x8C, 0xBF, 0x32, 0x42, 
+0xBD, 0x4C, 0x11, 0x46, 0x4B, 0xF8, 0xED, 0x3F, 
+0x0D, 0x5F, 0xA9, 0xEC, 0xB8, 0xE1, 0xCD, 0x53, 
+0x51, 0xFC, 0x12, 0x25, 0x86, 0xE3, 0x64, 0x48, 
+0x98, 0x00, 0x0D, 0xF9, 0xF6, 0xD2, 0xAE, 0xDB, 
+0x6B, 0x5E, 0xE1, 0x7B, 0xCC, 0xFF, 0x4E, 
+
+0xC6, 0x1E, 0xAC, 0xF7, 0x35, 0x20, 0xD8, 0x62, 
+0xFA, 0x6C, 0x86, 0x03, 0xA1, 0xA4, 0x6E, 0x8C, 
+0x3F, 0x51, 0x3A, 0x04, 0xB5, 0x28, 0x24, 0xEE, 
+0x4F, 0xAB, 0xC2, 0xB9, 0x95, 0xE7, 0x36, 0x4A, 
+0xF8, 0xCD, 0xAF, 0xF9, 0x5B, 0xBD, 0x73, 0x6D, 
+0x8F, 0xA4, 0xAD, 0xEC, 0x52, 0x68, 0x61, 0xC5, 
+0xC9, 0x37, 0x47, 0x07, 0xA6, 0xB5, 0x7A, 0x42, 
+0x9A, 0x5E, 0xE8, 0xE5, 0xBC, 0x82, 0xCB, 0x23, 
+0x6C, 0xBD, 0x2E, 0x98, 0x68, 0xB1, 0x55, 0x0E, 
+0x53, 0x45, 0x34, 0x2D, 0x47, 0x91, 0x7A, 0x4B, 
+0xED, 0x26, 0xC5, 0x07, 0x83, 0xAC, 0xAA, 0x10, 
+0x4D, 0xDB, 0x83, 0x08, 0x6C, 0x28, 0x4C, 0x31, 
+0x81, 0x24, 0xBA, 0x7A, 0x10, 0x84, 0x3F, 0x18, 
+0xF1, 0x19, 0x04, 0xB4, 0x98, 0x9A, 0x3D, 0x48, 
+0x72, 0x3C, 0x36, 0xE5, 0xB0, 0xF9, 0x28, 0x3E, 
+0xF0, 0xC7, 0xAC, 0x0A, 0x09, 0x60, 0x16, 0x97, 
+0x82, 0x33, 0x71, 0xF8, 0xB0, 0xD0, 0x44, 0x73, 
+0x24, 0x8C, 0x1D, 0xBD, 0x0F, 0x8C, 0x68, 0x55, 
+0x25, 0x9A, 0x9F, 0xB4, 0xA8, 0xF0, 0xD9, 0x6E, 
+0x9F, 0x9A, 0x36, 0x62, 0x6C, 0xE6, 0xC0, 0x18, 
+0x2F, 0x4F, 0xA3, 0x1D, 0xC2, 0xB0, 0x28, 0xAA, 
+0xB7, 0x03, 0xEE, 0x8A, 0x39, 0xAD, 0x9B, 0x66, 
+0x08, 0xF8, 0xDD, 0x6A, 0x0B, 0xF4, 0x29, 0xC0, 
+0xEB, 0xA6, 0xAB, 0xFB, 0x69, 0x60, 0x42, 0xC0, 
+
+0x66, 0x7B, 0x6C, 0x95, 0x46, 0xE1, 0x5A, 0x8E, 
+0x85, 0x61, 0x0C, 0xF5, 0x1C, 0x54, 0x81, 0xCC, 
+0x20, 0xD9, 0x89, 0x09, 0x16, 0x04, 0xDC, 0x4C, 
+0x91, 0x67, 0x03, 0x27, 0xAD, 0x86, 0xCA, 0x69, 
+0x78, 0xBE, 0x17, 0xA0, 0x82, 0x21, 0xAF, 0x02, 
+0xF3, 0xE2, 0x8F, 0xD7, 0x03, 0x2C, 0x5F, 0xFC, 
+0xD6, 0x66, 0x67, 0xC4, 0xD6, 0x32, 0x11, 0xB2, 
+0x06, 0x45, 0x16, 0xD9, 0x5B, 0x72, 0x8E, 0xF2, 
+0x74, 0x1D, 0x31, 0xF8, 0xF4, 0xCD, 0xD4, 0x59, 
+0x85, 0xBA, 0x08, 0x48, 0xC6, 0x58, 0x58, 0x12, 
+0x82, 0x1B, 0x18, 0x52, 0x79, 0x7E, 0xDA, 0x80, 
+0xB1, 0x57, 0x83, 0xB3, 0x06, 0x76, 0x8C, 0xA4, 
+0x5A, 0x97, 0xAA, 0x08, 0xC9, 0xA4, 0xCF, 0x73, 
+0x61, 0x4A, 0x3E, 0xF1, 0xCE, 0x6B, 0xE7, 0xF8, 
+0x65, 0x48, 0x3A, 0xF0, 0x8D, 0xAD, 0x76, 0x9B, 
+0x49, 0x99, 0x6C, 0x29, 0xC5, 0xB9, 0xB2, 0x29, 
+0x18, 0x2D, 0x09, 0x3E, 0x23, 0x08, 0xCB, 0x48, 
+0xC4, 0x70, 0x89, 0x04, 0x0B, 0x26, 0x74, 0xC0, 
+0xFC, 0x7E, 0xCF, 0x5D, 0xE8, 0xC2, 0xF9, 0x26, 
+0x6E, 0x4A, 0xA7, 0x5B, 0x0E, 0x0D, 0x2D, 0xA8, 
+0x49, 0x2C, 0x94, 0xEF, 0x1C, 0x24, 0x8A, 0x01, 
+0xDB, 0x54, 0x1F, 0xBC, 0xC2, 0xB1, 0xE4, 0x43, 
+0xF5, 0x14, 0xCF, 0x11, 0xD4, 0x43, 0x7A, 0xDC, 
+0xBD, 0x45, 0x3A, 0xE5, 0x28, 0xB0, 0x12, 0xE3, 
+0x7C, 
+
+0xB9, 0x0B, 0xC7, 0x89, 0x75, 0x7B, 0x4E, 0xB7, 
+0x76, 0x8F, 0x70, 0xB3, 0xA6, 0xF9, 0x52, 0xF0, 
+0x03, 0x6B, 0xB8, 0x0F, 0x4B, 0x90, 0x55, 0x14, 
+0x03, 0x7C, 0xAA, 0xAC, 0xA0, 0xBD, 0x2D, 0xC5, 
+0xE2, 0x4B, 0x87, 0xBC, 0x91, 0x7C, 0x89, 0xB7, 
+0x05, 0x1D, 0x17, 0x5C, 0x23, 0x70, 0x54, 0xCD, 
+0x6E, 0xCA, 0x11, 0xB5, 0xB5, 0xB4, 0xEA, 0x82, 
+0xB1, 0xB0, 0x2F, 0xA0, 0x66, 0x68, 0x20, 0x39, 
+0x67, 0xB0, 0xD4, 0x23, 0xB1, 0x22, 0x4C, 0x68, 
+0x33, 0x0E, 0xC6, 0x1E, 0x0B, 0x30, 0x3D, 0x90, 
+0x94, 0x4A, 0xA6, 0x07, 0xFF, 0xBD, 0xE9, 0x15, 
+0x12, 0x22, 0x66, 0x87, 0x57, 0xF3, 0x61, 0x58, 
+0x8B, 0xEC, 0xB2, 0x9E, 0x52, 0x6F, 0x51, 0xAA, 
+0xB8, 0x1E, 0x0B, 0x59, 0x14, 0xD7, 0x1D, 0x70, 
+0xF4, 0x82, 0x27, 0x93, 0x40, 0x68, 0x34, 0x7B, 
+0x7B, 0xC6, 0xDE, 0x65, 0x51, 0x5D, 0x3A, 0xC1, 
+0x96, 0xB4, 0x13, 0x4C, 0x06, 0x81, 0x11, 0xE0, 
+0xD9, 0x02, 0xDC, 0xB2, 0xD3, 0x2D, 0xAE, 0x6C, 
+0x17, 0xD5, 0x33, 0x33, 0x49, 0x2A, 0xA7, 0xAC, 
+0x08, 0x48, 0x7C, 0xFA, 0xD8, 0xFD, 0xA8, 0x0F, 
+0x03, 0x6E, 0x23, 0x47, 0xE1, 0x84, 0xC5, 0xA7, 
+0xEE, 0x65, 0xBD, 0xE4, 0x75, 0x13, 0xC7, 0xC0, 
+0x60, 0xC6, 0x2E, 0x4A, 0x8F, 0x61, 0x0D, 0x8D, 
+0x8E, 0x8B, 0x14, 0x77, 0xA1, 0x70, 0x08, 0xC3, 
+0xE3, 0xB6, 
+
+0x14, 0x10, 0x90, 0xC1, 0x4B, 0x62, 0x40, 0xEA, 
+0x5C, 0x97, 0x26, 0xE0, 0x52, 0x3C, 0x5B, 0x35, 
+0xBA, 0x3A, 0xE3, 0xD1, 0xE2, 0xA2, 0x58, 0xE4, 
+0xF2, 0x59, 0x78, 0x6A, 0x0B, 0x5F, 0x1F, 0x54, 
+0x37, 0x4B, 0x5B, 0xCB, 0x2D, 0x83, 0x0F, 0x71, 
+0x9C, 0xA4, 0x17, 0x1F, 0x58, 0x05, 0x5D, 0x5F, 
+0x59, 0xAF, 0x2D, 0x0D, 0x37, 0xF0, 0x9C, 0x6B, 
+0x2A, 0x37, 0x8C, 0x81, 0x42, 0x56, 0x46, 0xA9, 
+0xFF, 0x9E, 0x70, 0x59, 0x82, 0x07, 0xEC, 0x4D, 
+0x1E, 0xB9, 0xA8, 0x24, 0x76, 0xB4, 0x1A, 0x52, 
+0xED, 0xC8, 0x81, 0x4E, 0x32, 0x10, 0xEF, 0x6D, 
+0xA1, 0x15, 0x4E, 0x37, 0x91, 0xA4, 0x41, 0xA1, 
+0x14, 0x77, 0x4F, 0x73, 0xAC, 0x79, 0xCE, 0xA7, 
+0x3B, 0xAD, 0xBF, 0xC3, 0x8F, 0xD0, 0xF2, 0x7B, 
+0xBC, 0x53, 0x83, 0x52, 0x81, 0x8B, 0xF1, 0xF7, 
+0xB3, 0xF7, 0x0C, 0x97, 0x73, 0xC1, 0x14, 0x62, 
+0x6F, 0x42, 0x63, 0x64, 0x63, 0x55, 0x1C, 0x5F, 
+0xFE, 0x7A, 0x20, 0xFA, 0xE3, 0x1F, 0xA1, 0x31, 
+0x63, 0xB7, 0xFE, 0x94, 0xB4, 0x6B, 0x48, 0x1E, 
+0xD1, 0x39, 0xEC, 0x32, 0xDF, 0x02, 0x24, 0x99, 
+0xD1, 0x42, 0x23, 0x04, 0xF1, 0x01, 0x37, 0x24, 
+0x7D, 0x5E, 0x52, 0x1F, 0x68, 0x66, 0x85, 0x26, 
+0x13, 0x0D, 0xC3, 0x3B, 0x22, 0x52, 0x12, 0x39, 
+0xED, 0xEC, 0x09, 0x64, 0xF8, 0x2B, 0x4F, 0xEB, 
+0x20, 0x39, 0x58, 
+
+0x18, 0x6B, 0x48, 0xA0, 0xBD, 0x6C, 0x65, 0x39, 
+0x38, 0x48, 0x61, 0xAE, 0x5B, 0x20, 0x33, 0xB7, 
+0x59, 0xED, 0x59, 0xAE, 0x9D, 0x8A, 0x09, 0x2E, 
+0x61, 0x3F, 0xD4, 0x72, 0x13, 0x22, 0xEE, 0xE2, 
+0x4E, 0x6F, 0x6F, 0x99, 0x28, 0x39, 0x58, 0xC7, 
+0x3F, 0x34, 0xD4, 0x64, 0x3D, 0xBC, 0xBF, 0xFB, 
+0x34, 0x64, 0x52, 0x63, 0xBD, 0x9D, 0x2C, 0x72, 
+0xFC, 0xE9, 0x74, 0x4A, 0x99, 0xC1, 0x9F, 0xCA, 
+0xE7, 0xC6, 0x90, 0xA4, 0x42, 0x0C, 0x51, 0xC0, 
+0xD3, 0x38, 0xF3, 0xCE, 0x89, 0xF1, 0xE1, 0xBB, 
+0xAC, 0x5D, 0x60, 0x0A, 0x61, 0xEF, 0x2F, 0x8B, 
+0xC3, 0x32, 0xCB, 0x92, 0xEF, 0xFD, 0x2A, 0x40, 
+0x75, 0x6F, 0x24, 0x1C, 0x92, 0xFE, 0x3F, 0x1A, 
+0x24, 0x3D, 0xF4, 0x9B, 0xCC, 0x3D, 0x9E, 0x05, 
+0x46, 0x80, 0x8F, 0x45, 0x8A, 0xA8, 0xAF, 0x89, 
+0x81, 0xF1, 0x65, 0xFF, 0xC3, 0x63, 0x8A, 0x50, 
+0x12, 0x5E, 0xA2, 0xC3, 0xD8, 0xEA, 0x25, 0x8D, 
+0xB5, 0x00, 0xAF, 0x97, 0x1E, 0xDB, 0xD9, 0x01, 
+0x5B, 0x9E, 0x00, 0x98, 0xA7, 0x26, 0x25, 0xF2, 
+0xB7, 0xFE, 0x9D, 0x8F, 0x17, 0x83, 0x23, 0x5D, 
+0xE8, 0x62, 0x52, 0x6D, 0x7B, 0xB4, 0xBE, 0xA9, 
+0x90, 0x2E, 0x4A, 0x21, 0x66, 0xBF, 0xA0, 0x1D, 
+0x17, 0x89, 0xF4, 0xB1, 0xC5, 0xC0, 0x16, 0xB9, 
+0x24, 0xBF, 0x3F, 0xFC, 0x73, 0xF3, 0x96, 0x0D, 
+0xDA, 0x42, 0xDC, 0x7E, 
+
+0x58, 0x5F, 0x35, 0x15, 0x54, 0x6F, 0x61, 0xBD, 
+0x81, 0x90, 0x1E, 0x08, 0xEC, 0x9E, 0x09, 0x8B, 
+0xFE, 0x6B, 0xF9, 0xE8, 0xFB, 0xCA, 0xCD, 0xE1, 
+0x87, 0x05, 0x77, 0x04, 0xAC, 0x11, 0x68, 0x0B, 
+0xAB, 0xCE, 0xEC, 0x80, 0xC0, 0x6D, 0xC5, 0xEB, 
+0x50, 0xB0, 0xA7, 0x92, 0x87, 0x47, 0x1E, 0x98, 
+0x59, 0xE6, 0x49, 0x13, 0x24, 0x78, 0x46, 0x35, 
+0xF5, 0xAC, 0xBF, 0x5D, 0xCD, 0x5E, 0x9A, 0xEB, 
+0x9D, 0xA7, 0xE8, 0xD9, 0xC3, 0xB1, 0x64, 0x4B, 
+0x6C, 0x57, 0xB7, 0xA1, 0x57, 0xDC, 0x95, 0x29, 
+0xE9, 0xC3, 0x64, 0x2F, 0x78, 0x2E, 0x33, 0x0A, 
+0xDF, 0x4B, 0x07, 0xDE, 0xE6, 0x94, 0x4A, 0xF6, 
+0xB0, 0x54, 0x1B, 0xCD, 0x5A, 0xD4, 0x96, 0x9C, 
+0x1D, 0x80, 0xC9, 0xAD, 0x03, 0x62, 0x90, 0x00, 
+0x2B, 0x64, 0xD0, 0xFC, 0x36, 0x2F, 0x4F, 0xE9, 
+0x39, 0xC4, 0x7B, 0x1F, 0xE7, 0xE0, 0xE2, 0x6D, 
+0x99, 0x0A, 0x24, 0x27, 0xBE, 0x2E, 0x94, 0x7F, 
+0x6E, 0x03, 0x3E, 0xA3, 0x45, 0x74, 0xFE, 0x52, 
+0x27, 0x1B, 0x7B, 0xBE, 0xA2, 0x26, 0x69, 0x26, 
+0x46, 0x62, 0xE2, 0x11, 0xBF, 0xC9, 0xC0, 0x0B, 
+0x97, 0x35, 0x1E, 0xC5, 0x20, 0xE1, 0xD2, 0x54, 
+0x43, 0x7B, 0xD0, 0x0F, 0x1D, 0x86, 0x96, 0xD9, 
+0x9D, 0xDA, 0x0D, 0x41, 0x85, 0x3A, 0x75, 0x54, 
+0xD8, 0xEF, 0x5B, 0x9C, 0x09, 0x32, 0xF5, 0xA3, 
+0x32, 0x16, 0xEF, 0xAD, 0xB1, 
+
+0x99, 0xFB, 0xF8, 0x68, 0x49, 0x8C, 0xE7, 0xE9, 
+0xA7, 0x18, 0xCF, 0x0F, 0x0C, 0x47, 0xFA, 0x59, 
+0x5B, 0x6E, 0xDD, 0x04, 0x1B, 0x61, 0x08, 0xC4, 
+0x92, 0xB7, 0xAC, 0xB7, 0x16, 0x39, 0x93, 0x9E, 
+0xBD, 0xA7, 0xE4, 0x5D, 0x4B, 0xA4, 0xC4, 0xD3, 
+0x41, 0x9F, 0x87, 0x15, 0x92, 0xCA, 0x4A, 0xD3, 
+0x6B, 0x64, 0x24, 0x2D, 0x60, 0x20, 0xB7, 0xD3, 
+0x33, 0x6A, 0x4C, 0xD2, 0x17, 0xC2, 0x2C, 0x8B, 
+0x35, 0x8C, 0x4B, 0xE4, 0x4F, 0xA6, 0x27, 0x85, 
+0xDB, 0x99, 0xE1, 0x9E, 0xC6, 0xC7, 0x92, 0xF5, 
+0xF1, 0xCA, 0x30, 0xA9, 0xEE, 0xB1, 0xCA, 0xEF, 
+0x2B, 0x8A, 0x51, 0xDB, 0xB4, 0x89, 0x91, 0x61, 
+0x36, 0x8B, 0xB3, 0x9A, 0x99, 0x07, 0x26, 0xA2, 
+0x35, 0x76, 0x60, 0x43, 0xC4, 0xA9, 0x02, 0xBA, 
+0x89, 0xAA, 0x5A, 0xC7, 0x76, 0x7D, 0x9F, 0x91, 
+0x38, 0x06, 0x0C, 0xBA, 0x28, 0x58, 0x0B, 0xE0, 
+0x70, 0xBF, 0xD8, 0xAD, 0xDE, 0x51, 0xAE, 0x18, 
+0xED, 0xA2, 0xFF, 0x53, 0xAB, 0x95, 0xF1, 0xFF, 
+0x12, 0x65, 0x25, 0x1B, 0x10, 0x7A, 0x12, 0x81, 
+0x7B, 0xAE, 0xF9, 0xEC, 0x7D, 0x61, 0x6D, 0x77, 
+0xA2, 0x80, 0x02, 0xDC, 0x28, 0x48, 0xE8, 0x9A, 
+0x4B, 0xAF, 0x27, 0x3A, 0x7F, 0xAB, 0xDF, 0x91, 
+0x09, 0x54, 0x56, 0x5F, 0xE3, 0xA1, 0x33, 0xF1, 
+0xEB, 0x30, 0x8A, 0xE1, 0x24, 0x28, 0x4F, 0x55, 
+0x4A, 0x58, 0x1F, 0x18, 0x7B, 0xDF, 
+
+0xF3, 0xB4, 0x1B, 0x04, 0x51, 0xB6, 0xFA, 0xD8, 
+0xAA, 0x5C, 0x73, 0x1D, 0x9C, 0x65, 0x6F, 0x75, 
+0x7A, 0x6E, 0xCD, 0xCC, 0xF8, 0x62, 0xAC, 0xCD, 
+0x21, 0x60, 0x93, 0xF7, 0x7C, 0x59, 0x64, 0x90, 
+0xD4, 0x94, 0x56, 0x3A, 0x2B, 0x37, 0xF4, 0xA6, 
+0xF6, 0x2A, 0x87, 0x38, 0x2E, 0x3E, 0x52, 0xEF, 
+0x66, 0xE4, 0x10, 0x9B, 0x3A, 0x4F, 0xB7, 0x8F, 
+0x3E, 0xEA, 0x69, 0xC3, 0xED, 0x6F, 0x57, 0x97, 
+0x06, 0xC3, 0x00, 0x3C, 0xE2, 0x15, 0xC3, 0x77, 
+0x38, 0x95, 0x14, 0x9E, 0xA0, 0x11, 0xF1, 0xB9, 
+0x92, 0x86, 0xB0, 0xEB, 0x33, 0x9E, 0xB2, 0x67, 
+0x93, 0x0F, 0x46, 0xFA, 0x2B, 0x8E, 0xB1, 0x3F, 
+0xAA, 0xD7, 0x11, 0xCD, 0xF4, 0xF0, 0xC8, 0x78, 
+0xED, 0x3C, 0x70, 0xEB, 0x47, 0x74, 0x14, 0xED, 
+0xBF, 0xF8, 0x9A, 0x2E, 0x1B, 0x06, 0x49, 0x27, 
+0x51, 0xCC, 0xD6, 0xBA, 0x91, 0xE8, 0x2B, 0xF5, 
+0x4E, 0xC9, 0x03, 0x58, 0xD9, 0xEA, 0x9E, 0x4D, 
+0x47, 0xBD, 0xFB, 0x97, 0x81, 0xBA, 0xD2, 0xF2, 
+0x2E, 0x1F, 0x54, 0x58, 0x4D, 0x91, 0x35, 0xA8, 
+0x92, 0xE5, 0xC6, 0xF8, 0x1B, 0xF4, 0x75, 0x8B, 
+0xD6, 0x47, 0x93, 0xAD, 0xB2, 0x26, 0x19, 0x57, 
+0x20, 0x5E, 0xA3, 0x4E, 0x41, 0x06, 0xC2, 0xCF, 
+0xBF, 0x5A, 0x01, 0x8C, 0x28, 0xF0, 0xAE, 0x51, 
+0x24, 0x20, 0xF8, 0x6A, 0x48, 0x3F, 0x3D, 0x11, 
+0xC8, 0x79, 0x16, 0x09, 0xFC, 0xFD, 0x3C, 
+
+0x49, 0xF5, 0xCC, 0xDE, 0xC7, 0xA3, 0xED, 0xDD, 
+0xF5, 0xD4, 0x0E, 0x45, 0xB8, 0xA2, 0xBB, 0xE8, 
+0xEC, 0x75, 0x97, 0x4E, 0x08, 0x68, 0xA1, 0xD7, 
+0xDD, 0x1C, 0xCC, 0x18, 0x41, 0x24, 0xCE, 0x73, 
+0x13, 0x5A, 0x55, 0xCE, 0x3F, 0x74, 0x45, 0xCA, 
+0x26, 0x4B, 0x03, 0x42, 0x8B, 0x57, 0xC1, 0x0F, 
+0x10, 0xD7, 0x14, 0x23, 0x12, 0x27, 0x5C, 0x77, 
+0x7D, 0xD9, 0x0E, 0x11, 0xA9, 0x6D, 0x7A, 0x80, 
+0xD1, 0x77, 0x7F, 0xF5, 0xF9, 0x4F, 0xF6, 0xF5, 
+0xAA, 0x8B, 0x86, 0xAC, 0xBD, 0x7C, 0x1A, 0xC3, 
+0x72, 0x03, 0x6B, 0x98, 0xFB, 0x33, 0xE4, 0xA3, 
+0x6C, 0x13, 0x21, 0xB5, 0x86, 0x8D, 0xEB, 0x0D, 
+0xA1, 0xA2, 0x2A, 0xDA, 0x6A, 0x04, 0x36, 0x76, 
+0x8C, 0xB2, 0xDA, 0x17, 0x5E, 0x8A, 0x37, 0x31, 
+0x5E, 0xBD, 0x4D, 0x22, 0x1E, 0xD4, 0xE1, 0xDF, 
+0x53, 0x94, 0xF4, 0x04, 0x8A, 0xA7, 0x48, 0x1F, 
+0x3B, 0xF9, 0x72, 0x56, 0x52, 0xF4, 0x2B, 0x3B, 
+0xC8, 0x74, 0xEA, 0xDA, 0xEB, 0xD4, 0x40, 0x00, 
+0xA6, 0xCA, 0x29, 0xD0, 0xBF, 0x2F, 0xE1, 0xF0, 
+0x74, 0x8C, 0xC1, 0x54, 0xC9, 0xC6, 0x99, 0x3A, 
+0xA0, 0xAF, 0x5D, 0x68, 0x6C, 0xD6, 0x10, 0xF5, 
+0xAD, 0xC2, 0x18, 0xE3, 0xC0, 0xD5, 0x2C, 0xF4, 
+0xB9, 0xC2, 0x4A, 0xB2, 0xBF, 0x74, 0x63, 0xA5, 
+0x4F, 0xE1, 0x43, 0x4A, 0xEB, 0xA4, 0x88, 0xB3, 
+0x5A, 0x96, 0x72, 0x55, 0xB5, 0x38, 0x83, 0xD7, 
+
+0xD3, 0xAC, 0x64, 0x8B, 0x9E, 0xB0, 0x2E, 0x66, 
+0xD5, 0x52, 0x01, 0x6E, 0xDF, 0x1D, 0x95, 0x34, 
+0x28, 0xE8, 0x95, 0x64, 0xF4, 0x45, 0xCE, 0xFF, 
+0x2D, 0x6B, 0xFA, 0x2C, 0x3C, 0x62, 0xE9, 0xFD, 
+0x37, 0xDC, 0xD4, 0x43, 0x88, 0xA7, 0xCD, 0x53, 
+0x49, 0x8B, 0x9A, 0x33, 0x89, 0x49, 0xC1, 0x74, 
+0x7B, 0xFE, 0x2C, 0xA3, 0x1D, 0x1A, 0x1A, 0x9F, 
+0xF2, 0x99, 0x17, 0x71, 0x63, 0x5C, 0xB0, 0xDE, 
+0xCA, 0x0C, 0x5F, 0xC5, 0xDC, 0xA3, 0xFC, 0x24, 
+0xD4, 0x37, 0x95, 0x40, 0xBC, 0x12, 0xAE, 0x44, 
+0xFC, 0x48, 0xE6, 0xEE, 0x67, 0xC9, 0x43, 0x2A, 
+0x3E, 0xD6, 0x60, 0xAB, 0x68, 0xFF, 0x3B, 0xE1, 
+0x7D, 0xA2, 0xE5, 0xF7, 0x1B, 0x7F, 0x80, 0x5C, 
+0x2B, 0x8E, 0xE8, 0x62, 0x08, 0xF9, 0xA4, 0xB4, 
+0x80, 0x87, 0xCC, 0xED, 0x38, 0x05, 0x38, 0xDE, 
+0x37, 0x8F, 0x2C, 0xF8, 0xAE, 0x08, 0x58, 0x70, 
+0x2B, 0x09, 0x63, 0xC3, 0x0E, 0xF7, 0x11, 0x4C, 
+0x1B, 0x9E, 0x5A, 0x3D, 0xB0, 0xBC, 0x45, 0x2B, 
+0x41, 0x64, 0x00, 0x68, 0x59, 0x27, 0xC9, 0x91, 
+0x45, 0x1C, 0x40, 0xF9, 0x34, 0x3E, 0x1C, 0x2B, 
+0x95, 0x44, 0xA4, 0x6B, 0x45, 0xDC, 0xF8, 0x6A, 
+0x51, 0xF6, 0x8F, 0x39, 0xEB, 0xAD, 0xF5, 0x62, 
+0x3D, 0x9F, 0x78, 0xC5, 0xC4, 0xC7, 0x62, 0x8D, 
+0x22, 0xC9, 0x6F, 0x6D, 0x52, 0x1A, 0x25, 0x42, 
+0xCC, 0x8F, 0xB3, 0xC8, 0x29, 0xAB, 0x67, 0xD2, 
+0x5C, 
+
+0xB4, 0xFB, 0x00, 0x92, 0x2E, 0x5F, 0xF8, 0x05, 
+0x56, 0xC1, 0xBD, 0x44, 0x0E, 0xE6, 0x2A, 0x2B, 
+0x2B, 0xB3, 0x44, 0xF8, 0x1E, 0xB9, 0xFF, 0x26, 
+0x25, 0xE4, 0x97, 0x69, 0x83, 0xA6, 0x75, 0xE0, 
+0x32, 0x80, 0x53, 0x0E, 0xE1, 0x1C, 0x28, 0x87, 
+0x62, 0x1B, 0x39, 0xEB, 0x04, 0x48, 0xE4, 0xF4, 
+0xCB, 0x20, 0xAA, 0xEA, 0x64, 0xAC, 0x7F, 0x30, 
+0x5A, 0xCA, 0x9F, 0x6B, 0xA3, 0x89, 0xFA, 0x29, 
+0x20, 0x19, 0xE2, 0xBC, 0xF1, 0x5A, 0xC1, 0x81, 
+0x3A, 0xF8, 0x42, 0x5E, 0xD8, 0x24, 0x8D, 0xBE, 
+0x85, 0x9D, 0xFD, 0x6B, 0xA2, 0x19, 0xD6, 0xDC, 
+0x02, 0x16, 0x0B, 0xE1, 0x5A, 0x1A, 0x37, 0x18, 
+0x1F, 0xC7, 0x4C, 0x98, 0x6E, 0x53, 0x17, 0x58, 
+0x4D, 0x79, 0xAD, 0x89, 0xD5, 0x14, 0xE9, 0x71, 
+0x0A, 0x0F, 0x0D, 0xD6, 0x63, 0x26, 0x98, 0x46, 
+0x46, 0x39, 0xD1, 0xD8, 0x96, 0x1B, 0x90, 0x63, 
+0x60, 0x01, 0x1E, 0xE4, 0x09, 0x4F, 0x0B, 0x0A, 
+0xDE, 0x14, 0xCF, 0x9D, 0x18, 0xB3, 0xD5, 0x27, 
+0x08, 0x84, 0x93, 0x79, 0x77, 0x3B, 0x1C, 0x1E, 
+0x07, 0x04, 0xEB, 0xE5, 0x51, 0x64, 0x3D, 0xAB, 
+0x45, 0x16, 0x01, 0x69, 0x89, 0xE9, 0x27, 0xF2, 
+0x90, 0xF0, 0xC3, 0x31, 0x5A, 0x0F, 0x3E, 0xAE, 
+0x6C, 0x31, 0x8F, 0xD7, 0xDD, 0x30, 0x9E, 0xB8, 
+0x49, 0x14, 0xAA, 0xD4, 0x94, 0x9B, 0x25, 0x0B, 
+0x89, 0x0C, 0x21, 0xB6, 0x6F, 0x50, 0xBB, 0x2E, 
+0xD3, 0x8A, 
+
+0x0C, 0x32, 0x53, 0x3E, 0x82, 0x32, 0xAA, 0x7B, 
+0xDD, 0xAD, 0x11, 0x39, 0xEF, 0x0D, 0x24, 0xCE, 
+0xC9, 0x4B, 0x23, 0xFE, 0x0C, 0xB8, 0x0B, 0xBC, 
+0xEE, 0x34, 0x18, 0x3D, 0x27, 0xBC, 0x99, 0x65, 
+0x4E, 0x7D, 0xD3, 0x40, 0x67, 0x3F, 0x62, 0x65, 
+0x7B, 0x68, 0xD9, 0x3B, 0x5C, 0x14, 0x3E, 0x8D, 
+0x4B, 0xA2, 0x5C, 0x74, 0xAA, 0xAF, 0x95, 0xC4, 
+0x0D, 0xE9, 0xEA, 0x5E, 0x7E, 0xC0, 0xDE, 0x1F, 
+0xFF, 0x00, 0xD1, 0xB1, 0xC1, 0x5D, 0x64, 0xE1, 
+0x03, 0x13, 0x33, 0x80, 0x5B, 0xED, 0xFD, 0x19, 
+0x23, 0x3F, 0x0F, 0xE5, 0xDE, 0xE4, 0x8A, 0x25, 
+0x58, 0x37, 0xB5, 0xDC, 0x13, 0x43, 0x9B, 0x86, 
+0xE7, 0x5C, 0x80, 0xA5, 0xDA, 0x8E, 0xFE, 0xEC, 
+0xEE, 0x7E, 0x90, 0x00, 0x85, 0xF5, 0xB1, 0x9C, 
+0x91, 0x97, 0xC1, 0x83, 0xAA, 0x00, 0xDB, 0x0E, 
+0x06, 0x5F, 0xED, 0xDF, 0x45, 0xCC, 0xD8, 0xBE, 
+0x62, 0x3F, 0x54, 0xD2, 0xD6, 0x41, 0xDD, 0xE3, 
+0xC7, 0x73, 0x59, 0xFA, 0x1C, 0x2D, 0x0D, 0x49, 
+0x76, 0xAE, 0x5D, 0xC6, 0x67, 0x82, 0x13, 0xA8, 
+0xE9, 0x82, 0x3E, 0x13, 0x2D, 0x79, 0x65, 0x8C, 
+0xD8, 0x2A, 0x51, 0xBA, 0xBF, 0xDD, 0x7B, 0x6D, 
+0xFF, 0x60, 0x51, 0x80, 0x38, 0xF6, 0x8A, 0x40, 
+0xB7, 0xA1, 0x81, 0x1A, 0x56, 0x59, 0x3D, 0x8F, 
+0x1B, 0x65, 0x3F, 0x9C, 0x4D, 0x6C, 0xA3, 0x8A, 
+0xBF, 0xFE, 0x57, 0x76, 0xEA, 0x5B, 0x58, 0xA8, 
+0x40, 0x1B, 0x95, 
+
+0x8B, 0x84, 0xDA, 0xF9, 0x80, 0x05, 0x8A, 0xC1, 
+0x49, 0x6C, 0x46, 0x62, 0x23, 0xD7, 0xBF, 0xA2, 
+0x01, 0x5E, 0xFA, 0x08, 0xC3, 0x5C, 0x58, 0xFC, 
+0x5D, 0xE3, 0x22, 0x4C, 0x37, 0x0F, 0xF0, 0xDE, 
+0x60, 0x4D, 0x77, 0x32, 0xEC, 0xE4, 0x2F, 0x1A, 
+0x14, 0xE6, 0x71, 0xD2, 0x74, 0x48, 0x6D, 0xA9, 
+0xCB, 0xB6, 0x77, 0xA5, 0xE7, 0xA0, 0x2D, 0x18, 
+0xA2, 0xEA, 0x6F, 0xC0, 0xA5, 0xCA, 0x57, 0x73, 
+0xDF, 0x16, 0x15, 0x63, 0xE1, 0xFA, 0xD1, 0xA3, 
+0xAC, 0x09, 0x5A, 0x2F, 0xCE, 0x39, 0x0C, 0x40, 
+0x11, 0xE1, 0x08, 0xA2, 0x6A, 0xFF, 0x8E, 0x59, 
+0xC4, 0xC3, 0xCB, 0xD5, 0x78, 0x39, 0xAF, 0xAF, 
+0x73, 0xE6, 0xAD, 0xF6, 0x37, 0xC3, 0xF4, 0x94, 
+0x1E, 0x9F, 0x74, 0x26, 0x63, 0x3D, 0x47, 0xA6, 
+0x83, 0xC9, 0x9A, 0xA9, 0x0B, 0x40, 0xD6, 0x4B, 
+0xB4, 0x56, 0x8C, 0x4C, 0xFB, 0xFF, 0x6B, 0x94, 
+0x7F, 0x12, 0x92, 0x85, 0xD6, 0x35, 0xE0, 0x50, 
+0x5D, 0xAF, 0xEC, 0x9E, 0xE4, 0x61, 0x46, 0xC3, 
+0xAA, 0xB4, 0x89, 0x7E, 0x4E, 0x22, 0xC4, 0x9E, 
+0xA4, 0xD8, 0x60, 0xF1, 0x13, 0xAB, 0x2E, 0x6A, 
+0xCE, 0xA3, 0xC8, 0xB8, 0x04, 0x5A, 0xD7, 0xD5, 
+0x11, 0xE4, 0x0A, 0x3A, 0x29, 0x69, 0xD1, 0xF3, 
+0x30, 0xD7, 0x85, 0x86, 0x2B, 0xCD, 0xD1, 0x1D, 
+0x7C, 0xA8, 0xA0, 0x78, 0xF1, 0x35, 0xD3, 0x6E, 
+0x67, 0x5C, 0x42, 0x0A, 0x5E, 0x5B, 0xFC, 0x8D, 
+0x90, 0x16, 0xBB, 0xA8, 
+
+0xAD, 0x4F, 0x44, 0x75, 0x0B, 0x0C, 0x40, 0xF7, 
+0x7E, 0x45, 0x22, 0xD1, 0x5F, 0xD4, 0x60, 0x8F, 
+0xEC, 0x67, 0x6F, 0x17, 0x48, 0xF4, 0x3D, 0x49, 
+0x83, 0xB1, 0x18, 0x9A, 0x1D, 0x17, 0x77, 0x63, 
+0x34, 0xEC, 0x64, 0x65, 0xED, 0x98, 0xDD, 0x4C, 
+0xD4, 0xE7, 0x83, 0x5C, 0xF3, 0x83, 0xB5, 0x48, 
+0xA0, 0x6F, 0x11, 0x97, 0x13, 0x18, 0x75, 0xFC, 
+0x9A, 0xB9, 0xF3, 0x49, 0x90, 0x38, 0x74, 0x00, 
+0x2B, 0x68, 0xC6, 0xCC, 0xD5, 0xE6, 0x12, 0xC4, 
+0xDC, 0x1D, 0x19, 0x19, 0x6E, 0x1F, 0xC4, 0x72, 
+0xB6, 0xB8, 0x4C, 0x4B, 0x42, 0xBB, 0xE3, 0x98, 
+0x76, 0x58, 0x8D, 0x3F, 0xEA, 0x79, 0x7C, 0x80, 
+0xF0, 0x79, 0x6B, 0x2C, 0x60, 0xFE, 0x8A, 0x7A, 
+0x70, 0xBB, 0x3E, 0x53, 0x2C, 0xA5, 0x90, 0x7E, 
+0x53, 0x73, 0xBF, 0x19, 0x5F, 0x23, 0x2D, 0xE7, 
+0xE8, 0xCF, 0xA6, 0x18, 0x7B, 0x4D, 0x36, 0xCF, 
+0xA3, 0x12, 0x0C, 0xFE, 0x0A, 0xE1, 0x43, 0x77, 
+0x86, 0xBC, 0xB2, 0xFA, 0xFD, 0x15, 0x67, 0xB1, 
+0x52, 0x27, 0xF0, 0xB4, 0xA1, 0xA1, 0x46, 0x5C, 
+0x84, 0x30, 0x96, 0xBC, 0x95, 0x0A, 0x5F, 0xB4, 
+0x1D, 0xCF, 0x7D, 0x09, 0xB8, 0x22, 0xB0, 0x07, 
+0xA7, 0x07, 0xEB, 0xEB, 0x5F, 0x06, 0x6C, 0x79, 
+0x5C, 0xBB, 0xFF, 0x38, 0xCD, 0x70, 0x5F, 0x8B, 
+0x4F, 0x12, 0x88, 0xEC, 0x89, 0x1B, 0x14, 0xEE, 
+0x97, 0x61, 0x2E, 0x37, 0xB8, 0x81, 0xC6, 0xF0, 
+0xD3, 0xF8, 0xF3, 0xCC, 0xE6, 
+
+0x65, 0x39, 0x42, 0x9F, 0x88, 0xA1, 0xF4, 0x04, 
+0xC3, 0x02, 0x13, 0x37, 0x8A, 0xBB, 0x1B, 0x40, 
+0x3C, 0x64, 0xA9, 0x75, 0x44, 0x31, 0xD4, 0xD6, 
+0xA5, 0x25, 0x80, 0x18, 0x8B, 0xB1, 0x31, 0xF2, 
+0x1E, 0xE2, 0xD8, 0xDE, 0x1B, 0x87, 0xA9, 0x51, 
+0x6E, 0x4D, 0x85, 0xEA, 0xA7, 0xB1, 0x39, 0x07, 
+0xE7, 0x14, 0xE2, 0x26, 0xAB, 0xA6, 0xDD, 0xEC, 
+0x27, 0x89, 0x89, 0x82, 0x57, 0x16, 0x1B, 0xAF, 
+0x6D, 0x50, 0xD7, 0xF4, 0xB9, 0xF9, 0x64, 0x02, 
+0xB3, 0xF2, 0x46, 0xA4, 0x82, 0x9F, 0x4B, 0x25, 
+0x97, 0xF7, 0x24, 0xD2, 0xBE, 0xAD, 0x1C, 0xF5, 
+0x69, 0x26, 0x51, 0xCC, 0x3F, 0x46, 0x19, 0xCC, 
+0x05, 0x42, 0x58, 0xFA, 0xB4, 0xB6, 0x02, 0x61, 
+0x30, 0x56, 0x18, 0x13, 0x95, 0x17, 0x4F, 0x11, 
+0xEB, 0x95, 0x4A, 0x81, 0x2F, 0xCF, 0x05, 0xC7, 
+0x4A, 0xAF, 0x51, 0x31, 0x45, 0x76, 0x4B, 0x9B, 
+0x92, 0x3D, 0x85, 0x80, 0xA7, 0xE2, 0x11, 0x06, 
+0x15, 0xE3, 0xDF, 0x46, 0xA9, 0x00, 0x62, 0xFB, 
+0x95, 0x47, 0xD2, 0x39, 0x55, 0xE9, 0x20, 0xA5, 
+0xEB, 0x0E, 0xDB, 0x75, 0xE6, 0x0C, 0x71, 0x84, 
+0x10, 0x1C, 0x14, 0x96, 0x88, 0x2B, 0x6D, 0x30, 
+0xC9, 0xD8, 0xB0, 0xC5, 0x38, 0x9C, 0x0B, 0x0B, 
+0xBE, 0x46, 0x07, 0x4A, 0xC3, 0xA9, 0x3A, 0x91, 
+0x30, 0xC3, 0xF0, 0x57, 0xD0, 0x16, 0xFB, 0xC5, 
+0x3E, 0xA6, 0xA3, 0xA4, 0x17, 0x90, 0xB4, 0x88, 
+0x25, 0x17, 0xDD, 0xD2, 0xF0, 0xE7, 
+
+0x28, 0x3F, 0xD8, 0xAA, 0xDF, 0xD2, 0x57, 0xD2, 
+0x58, 0xC4, 0x0A, 0xBC, 0x0D, 0x25, 0xB9, 0x65, 
+0x7C, 0x46, 0x7A, 0xFC, 0x54, 0x28, 0x85, 0x09, 
+0x74, 0xA5, 0x26, 0x3F, 0x61, 0xE5, 0x38, 0x03, 
+0xE9, 0xB5, 0x95, 0x1F, 0x76, 0xF2, 0x1F, 0x74, 
+0x81, 0x5C, 0xF6, 0xB0, 0xE3, 0x5E, 0xA0, 0x8A, 
+0xBA, 0xC1, 0x34, 0xF9, 0x18, 0x49, 0xD5, 0x62, 
+0x6B, 0x7D, 0x99, 0xDE, 0xAA, 0x7A, 0x7A, 0x90, 
+0x57, 0x7B, 0xE0, 0x6A, 0x97, 0x75, 0x20, 0x4A, 
+0x02, 0x5D, 0xDD, 0x93, 0x93, 0x5B, 0x98, 0x56, 
+0xA8, 0x31, 0x30, 0x77, 0xC8, 0x16, 0x8D, 0x6E, 
+0x90, 0xE3, 0x45, 0x2A, 0x65, 0xD8, 0x56, 0x9D, 
+0x1F, 0xE5, 0xF6, 0xCA, 0x2E, 0x57, 0x13, 0x9A, 
+0x0E, 0x0B, 0x03, 0x1E, 0xD2, 0x59, 0xCA, 0x52, 
+0xFD, 0x6C, 0x1D, 0x74, 0x08, 0x29, 0xF9, 0xD8, 
+0x1F, 0xE3, 0x97, 0x8B, 0x25, 0x33, 0xDE, 0x1E, 
+0xE0, 0x71, 0x9E, 0xCE, 0xA9, 0x11, 0xBF, 0x34, 
+0x44, 0x57, 0x80, 0x9D, 0x4D, 0x83, 0xD9, 0x74, 
+0x94, 0x48, 0x14, 0xA9, 0x64, 0x34, 0x7A, 0xB0, 
+0xA9, 0xFB, 0xD6, 0x17, 0x34, 0x17, 0x6F, 0x56, 
+0x1D, 0x80, 0x48, 0xC1, 0xC8, 0x05, 0x70, 0x40, 
+0x5C, 0x94, 0x1F, 0xBD, 0x90, 0x41, 0x0E, 0x58, 
+0x64, 0x39, 0x70, 0xC0, 0xBD, 0x72, 0xD2, 0xE0, 
+0x6D, 0x3A, 0x4F, 0x04, 0xDA, 0xB6, 0x35, 0x8B, 
+0xF5, 0x69, 0x1D, 0xED, 0x5E, 0x41, 0x6D, 0x38, 
+0x6B, 0xED, 0xFB, 0x23, 0x9A, 0x20, 0xBB, 
+
+0xB8, 0xE6, 0x7A, 0xD7, 0xC8, 0x06, 0x7D, 0x6A, 
+0xF7, 0x61, 0xEB, 0x36, 0x79, 0x9F, 0xBE, 0x3B, 
+0xF7, 0x1C, 0x94, 0x98, 0x06, 0xD4, 0xDA, 0x23, 
+0x57, 0xD4, 0x3B, 0x06, 0x27, 0x6A, 0x1B, 0xED, 
+0x43, 0xEC, 0xC4, 0x2A, 0xCE, 0xB1, 0x42, 0x0D, 
+0x05, 0x88, 0xEA, 0x48, 0x45, 0x6A, 0xE2, 0xEB, 
+0x36, 0x4F, 0xC3, 0x26, 0x62, 0x8F, 0x81, 0xAA, 
+0x18, 0x5F, 0xAA, 0x45, 0xD7, 0x43, 0xD3, 0x52, 
+0xAD, 0x7B, 0x88, 0x57, 0xB9, 0x5D, 0xD9, 0x41, 
+0x01, 0x09, 0xD0, 0x8D, 0x8A, 0xF4, 0x1F, 0xD7, 
+0xB5, 0x79, 0xEF, 0xD3, 0xFF, 0x73, 0xAC, 0xD0, 
+0x65, 0x29, 0xC4, 0xC0, 0xFF, 0xE4, 0x5E, 0x25, 
+0xB1, 0xCD, 0x40, 0xC3, 0xDF, 0xC1, 0x9B, 0x40, 
+0xE8, 0xBD, 0xFE, 0xCE, 0x4D, 0x3C, 0x44, 0x1C, 
+0x52, 0xBB, 0x5C, 0x41, 0x0E, 0x04, 0xA7, 0x45, 
+0x17, 0x4E, 0x71, 0xF3, 0x8F, 0x9F, 0x55, 0xD6, 
+0xB4, 0x0A, 0x41, 0x45, 0xCB, 0x01, 0xF9, 0x2D, 
+0x29, 0x4C, 0xE7, 0xD7, 0x1F, 0xF9, 0x2B, 0x38, 
+0xC7, 0xAE, 0xC2, 0xCA, 0x54, 0xFA, 0x5D, 0x98, 
+0x12, 0xB3, 0xBE, 0x29, 0xD9, 0xE7, 0x86, 0x93, 
+0xDD, 0xF2, 0x2E, 0xF7, 0xCD, 0xD1, 0x62, 0xD5, 
+0x0E, 0xAC, 0xC7, 0x73, 0xC7, 0x9D, 0x5E, 0x51, 
+0xE4, 0xBB, 0x74, 0x0A, 0x79, 0x77, 0x60, 0x5B, 
+0x50, 0x5F, 0x53, 0xFB, 0x4E, 0x01, 0xC9, 0x0C, 
+0xD8, 0x33, 0x32, 0xB6, 0x73, 0xDD, 0xB6, 0x23, 
+0x87, 0xE6, 0x0B, 0x05, 0xE0, 0xED, 0x01, 0xB3, 
+
+0xB9, 0x97, 0x8E, 0x85, 0x4F, 0x13, 0xE3, 0xD9, 
+0x9F, 0x0E, 0xBE, 0x11, 0x89, 0x6A, 0xA4, 0xA9, 
+0xDB, 0x12, 0x4A, 0xF2, 0x1A, 0x21, 0x75, 0x6F, 
+0x86, 0xAB, 0x88, 0x3E, 0xA5, 0x27, 0x7D, 0x53, 
+0xFC, 0xD2, 0x3D, 0x41, 0xF3, 0x0E, 0xA4, 0x54, 
+0x69, 0x31, 0xAB, 0xCE, 0x8A, 0x77, 0x23, 0xC6, 
+0xF3, 0x5D, 0xB2, 0x7A, 0x2A, 0x0C, 0x1F, 0x78, 
+0x0E, 0x96, 0xF1, 0x3A, 0x09, 0xFB, 0x91, 0x10, 
+0x22, 0x5C, 0x6A, 0xBD, 0xBA, 0x86, 0x4A, 0xD9, 
+0xFE, 0x36, 0x29, 0x9A, 0xAC, 0xD1, 0x08, 0x74, 
+0x4F, 0x34, 0x8D, 0xBE, 0xC2, 0xFB, 0xC2, 0x05, 
+0x9D, 0x67, 0x56, 0xD5, 0x16, 0x0C, 0x01, 0xCC, 
+0x3D, 0xAE, 0x3A, 0x3A, 0x44, 0x27, 0x94, 0x6B, 
+0xE2, 0xF7, 0xF1, 0x5E, 0x98, 0xED, 0xF7, 0x36, 
+0x0F, 0xD4, 0x65, 0x44, 0x96, 0xA3, 0xB1, 0x01, 
+0x1D, 0xC9, 0x25, 0x09, 0x20, 0xE5, 0xA5, 0x14, 
+0x83, 0xF7, 0xFA, 0x34, 0x07, 0xEF, 0x82, 0xC1, 
+0x52, 0x09, 0x50, 0x6E, 0xE3, 0xB5, 0xE9, 0xF4, 
+0xE3, 0xC3, 0x35, 0x97, 0x60, 0xBD, 0xDD, 0x79, 
+0x7E, 0x7D, 0xFE, 0x9F, 0x42, 0x51, 0x70, 0xDD, 
+0x66, 0x58, 0x46, 0x7C, 0x20, 0x29, 0x88, 0x76, 
+0xF5, 0x25, 0x4B, 0xFA, 0x08, 0x59, 0xEF, 0xA4, 
+0x08, 0x7B, 0xBC, 0xBE, 0x0E, 0xC8, 0xDC, 0x80, 
+0x7A, 0x2E, 0x86, 0xCF, 0x00, 0xB4, 0x5B, 0x3E, 
+0x50, 0x79, 0xB1, 0x37, 0x2D, 0xC6, 0x39, 0x75, 
+0x00, 0x5B, 0x64, 0x62, 0xE4, 0x6C, 0x90, 0x3D, 
+0xE5, 
+
+0x2F, 0x98, 0x12, 0x2C, 0xBB, 0x49, 0xBC, 0x82, 
+0xFF, 0x54, 0x2D, 0x72, 0x3C, 0x8E, 0xAC, 0x87, 
+0x5B, 0xA6, 0x47, 0xE8, 0xAA, 0xBD, 0x91, 0x30, 
+0xC9, 0x2D, 0x1D, 0x1C, 0xB3, 0x80, 0xB9, 0x64, 
+0x8A, 0x42, 0xC6, 0xF0, 0xC4, 0x9B, 0xDA, 0x3C, 
+0x04, 0x2D, 0x45, 0x5B, 0xA5, 0x24, 0xF5, 0xDB, 
+0x0C, 0x7D, 0x98, 0xAF, 0x8A, 0xAD, 0xE3, 0x00, 
+0xBB, 0x8B, 0x3A, 0x3A, 0xA1, 0x60, 0x27, 0x5B, 
+0x96, 0xFE, 0x59, 0xB9, 0x1C, 0x01, 0xE8, 0x0A, 
+0x9E, 0x72, 0x56, 0x85, 0x8C, 0x19, 0xA8, 0x1D, 
+0x44, 0x26, 0x6F, 0x22, 0xF5, 0xED, 0x6E, 0x4D, 
+0x18, 0x23, 0x62, 0x30, 0x5B, 0x6C, 0xF0, 0xFF, 
+0xC8, 0x35, 0xE2, 0x9B, 0x41, 0xCE, 0xB2, 0x31, 
+0x7A, 0x57, 0xAC, 0xF0, 0x8A, 0x64, 0x75, 0x92, 
+0x36, 0x1E, 0x05, 0x72, 0xE1, 0x11, 0x24, 0xEA, 
+0x8C, 0x13, 0x6E, 0x0E, 0xBC, 0x10, 0xC6, 0x5E, 
+0x3B, 0x1E, 0x59, 0x96, 0x6C, 0x34, 0x19, 0x06, 
+0xF4, 0x8B, 0xA9, 0xA4, 0x7E, 0x5C, 0x65, 0xCD, 
+0x45, 0xA1, 0xBD, 0x33, 0x18, 0x1A, 0x2B, 0x4F, 
+0xB9, 0x46, 0x1A, 0x50, 0x27, 0x6C, 0x6E, 0x83, 
+0xDE, 0x52, 0x9C, 0x2A, 0xC8, 0x57, 0xA6, 0xF2, 
+0x25, 0xE9, 0x96, 0xFF, 0x1B, 0x42, 0x34, 0xEA, 
+0x8F, 0x05, 0xF7, 0x9F, 0xBC, 0x94, 0xB1, 0x40, 
+0x10, 0x72, 0xA3, 0x25, 0x55, 0x5A, 0x3B, 0x50, 
+0x6C, 0x59, 0x4C, 0x20, 0xEA, 0x6B, 0x7C, 0x3A, 
+0x77, 0x80, 0x89, 0x7A, 0x0A, 0x0C, 0x7F, 0x6F, 
+0x03, 0xBC, 
+
+0xDE, 0x2C, 0x94, 0x65, 0xE0, 0x1D, 0x8E, 0xDC, 
+0x8B, 0x45, 0xB8, 0x37, 0x2C, 0xCB, 0x5B, 0x8D, 
+0xEB, 0x9E, 0x2C, 0xBA, 0x93, 0x54, 0x08, 0x3C, 
+0x70, 0x12, 0x5B, 0x0C, 0x7E, 0x0C, 0x48, 0x52, 
+0x41, 0xE7, 0x0F, 0xE2, 0x8B, 0x6F, 0x28, 0xEB, 
+0x34, 0x8D, 0x91, 0xE8, 0xD7, 0xC3, 0xA6, 0x55, 
+0x4F, 0xA0, 0x29, 0x80, 0x1A, 0x15, 0x94, 0xC1, 
+0xE9, 0x98, 0xFC, 0xE8, 0xFC, 0x87, 0x54, 0x90, 
+0x25, 0xFA, 0x41, 0xD1, 0xEF, 0x8C, 0x5B, 0x78, 
+0x3A, 0x2E, 0x97, 0x8C, 0x47, 0xB9, 0x63, 0x17, 
+0x51, 0x95, 0x6C, 0x45, 0xB7, 0x1C, 0x0C, 0x16, 
+0x2C, 0x85, 0xDB, 0xC0, 0xA1, 0xD7, 0x4F, 0x0D, 
+0x6B, 0x1E, 0xDE, 0x20, 0x84, 0x00, 0x42, 0x73, 
+0x0B, 0x96, 0x27, 0x7C, 0x16, 0x18, 0x8D, 0x03, 
+0x75, 0x63, 0x7A, 0xA3, 0xD7, 0x7C, 0x23, 0xE4, 
+0x8D, 0x78, 0xE2, 0x20, 0x0C, 0xEB, 0x2B, 0x94, 
+0x2E, 0x45, 0x35, 0xBE, 0x50, 0x35, 0x8A, 0xC7, 
+0xBD, 0xD7, 0xD3, 0xEF, 0x4D, 0x56, 0x06, 0xAF, 
+0x58, 0x56, 0x73, 0x5D, 0x3C, 0x03, 0x17, 0xE1, 
+0x50, 0xDC, 0xF4, 0xF9, 0xDC, 0xD8, 0x09, 0xEC, 
+0xBE, 0x97, 0x0F, 0xBD, 0xD6, 0xD0, 0x9F, 0x8F, 
+0x2C, 0xFB, 0x58, 0xEE, 0xB7, 0x86, 0x07, 0xF2, 
+0x84, 0xD2, 0xDE, 0x65, 0x91, 0x7D, 0x5B, 0x4C, 
+0x94, 0x35, 0xC1, 0x7E, 0x41, 0x37, 0xCA, 0x88, 
+0x65, 0xDB, 0xEA, 0xB4, 0xA6, 0xEF, 0x8F, 0x03, 
+0x8A, 0x2B, 0x23, 0x42, 0xA5, 0xDE, 0x33, 0xB2, 
+0xD2, 0x14, 0x21, 
+
+0xF7, 0x64, 0xF2, 0x4F, 0x49, 0x30, 0xCD, 0x32, 
+0xAC, 0x8E, 0x97, 0x85, 0x3B, 0x6D, 0x2C, 0x3B, 
+0x51, 0xD9, 0x67, 0x3B, 0x0C, 0x4F, 0xCC, 0xEF, 
+0xF9, 0xE5, 0x55, 0xFF, 0xA9, 0xBA, 0x54, 0x21, 
+0xB0, 0x83, 0x94, 0xCC, 0xDC, 0x36, 0x2C, 0xD2, 
+0xED, 0xA5, 0x69, 0xBC, 0x4A, 0xAA, 0x13, 0x74, 
+0x5D, 0x93, 0xDF, 0xA5, 0x72, 0x1F, 0x09, 0x4C, 
+0x40, 0xFF, 0x8F, 0x89, 0xE8, 0xDF, 0x13, 0x08, 
+0x22, 0xDF, 0x01, 0x4A, 0xA4, 0x70, 0x48, 0xE5, 
+0xD7, 0xF9, 0xCB, 0x4B, 0x54, 0xE5, 0xB2, 0x29, 
+0xEC, 0xAC, 0xCE, 0x7A, 0xDA, 0x51, 0x83, 0x8A, 
+0xD7, 0x6D, 0x15, 0x47, 0x3D, 0x75, 0x81, 0x4B, 
+0x06, 0x77, 0x3F, 0xEA, 0xC5, 0x3A, 0xA4, 0x21, 
+0x2B, 0xED, 0x71, 0x49, 0x47, 0xFA, 0xD2, 0xC3, 
+0x75, 0x80, 0x31, 0x47, 0x62, 0x68, 0x2B, 0xBB, 
+0x43, 0xB3, 0x44, 0x8A, 0x31, 0xFB, 0xFC, 0x6A, 
+0x1A, 0x28, 0xB5, 0x6E, 0xF9, 0xCB, 0x5E, 0x1B, 
+0xC3, 0xF7, 0x1C, 0x06, 0xE6, 0x56, 0xA5, 0x1C, 
+0x44, 0x5C, 0x71, 0x2C, 0xF4, 0x99, 0xF6, 0x0A, 
+0x72, 0x8B, 0x37, 0xEB, 0x8A, 0xB3, 0xAE, 0x58, 
+0xB5, 0x7F, 0x1B, 0xC6, 0x98, 0x82, 0x6E, 0x3C, 
+0x43, 0xB1, 0xAE, 0xCE, 0x31, 0x1A, 0x08, 0xE0, 
+0x21, 0x0B, 0xD4, 0xB8, 0xA6, 0x3D, 0x38, 0x36, 
+0xB1, 0xC2, 0xFA, 0xBA, 0x06, 0xF5, 0x58, 0x6D, 
+0xA6, 0x25, 0x5D, 0xB8, 0x56, 0xBB, 0x8B, 0x56, 
+0xB7, 0x3B, 0x91, 0x33, 0x4C, 0x22, 0x64, 0xB7, 
+0xBA, 0x8D, 0x21, 0xF6, 
+
+0x58, 0x49, 0xAF, 0xD8, 0xFA, 0x47, 0x75, 0x27, 
+0xE7, 0xA9, 0x77, 0x13, 0x69, 0x6F, 0xA7, 0xC4, 
+0x9B, 0x31, 0x32, 0x62, 0x55, 0x0A, 0x89, 0x72, 
+0xCB, 0x93, 0x00, 0x4F, 0xA7, 0x7D, 0xD4, 0x7C, 
+0xCB, 0x17, 0x6A, 0x04, 0xFD, 0x42, 0x80, 0xAB, 
+0x31, 0x9F, 0x1E, 0x18, 0x49, 0xA0, 0x13, 0x4A, 
+0xA6, 0xF1, 0xAD, 0x5C, 0x14, 0x03, 0x46, 0xD7, 
+0xD0, 0x98, 0xE7, 0x59, 0x25, 0xE0, 0x22, 0xB1, 
+0xFC, 0x7B, 0x8E, 0xAB, 0x05, 0xDE, 0xA1, 0x0A, 
+0x65, 0x27, 0x4A, 0x07, 0xC4, 0x0C, 0x42, 0x34, 
+0x69, 0x0B, 0xD6, 0x3E, 0x29, 0x49, 0x31, 0xD5, 
+0x86, 0x0A, 0x85, 0x7A, 0x42, 0x8A, 0xAC, 0x41, 
+0x1F, 0xC7, 0x17, 0xB1, 0xDB, 0xAC, 0xC9, 0xCC, 
+0x55, 0xB2, 0x7D, 0xB6, 0xC8, 0x57, 0xBE, 0x0E, 
+0xA4, 0xAB, 0x32, 0x6A, 0x8A, 0xD4, 0x07, 0x04, 
+0x51, 0x4E, 0x18, 0x92, 0xCF, 0x66, 0xBD, 0xBC, 
+0xC2, 0x6D, 0x41, 0x08, 0x5F, 0xBC, 0x8F, 0xAB, 
+0x15, 0xA8, 0x0B, 0xF2, 0xD5, 0x62, 0xCE, 0x1A, 
+0x52, 0xA2, 0x11, 0xB6, 0x4C, 0xFD, 0x7B, 0x84, 
+0x1F, 0x73, 0x7E, 0xCC, 0xAD, 0x5F, 0x80, 0xF4, 
+0x2D, 0x0B, 0x70, 0x14, 0x28, 0xB4, 0x3D, 0x93, 
+0xFF, 0xEC, 0xA6, 0xA6, 0x6F, 0xF2, 0x84, 0xB0, 
+0xE6, 0x36, 0x17, 0xD4, 0x19, 0x82, 0x31, 0xCD, 
+0x97, 0x89, 0x91, 0x1A, 0x11, 0xEB, 0xBF, 0x33, 
+0x58, 0xD7, 0xA9, 0x75, 0xD7, 0x3C, 0x8D, 0xDB, 
+0xD8, 0xB4, 0x62, 0x0C, 0xD2, 0xE2, 0x68, 0x62, 
+0xE0, 0xD0, 0x4B, 0xB3, 0x95, 
+
+0x95, 0x9B, 0xD9, 0x1F, 0xDD, 0xCC, 0x19, 0xFB, 
+0xDC, 0x21, 0x04, 0x58, 0xDC, 0x63, 0xFC, 0xF3, 
+0xC7, 0x43, 0x9D, 0x3E, 0xE6, 0x12, 0xD9, 0x82, 
+0x18, 0x4F, 0xCB, 0x02, 0x38, 0xEF, 0xE6, 0xFD, 
+0xD5, 0x8F, 0x15, 0xE1, 0x48, 0xCF, 0xA0, 0xC3, 
+0xF3, 0x81, 0xD8, 0x09, 0xB6, 0x49, 0xB5, 0x6E, 
+0xD9, 0x80, 0x92, 0xFA, 0xA8, 0x4B, 0xAD, 0x9F, 
+0x06, 0xF2, 0xA3, 0x1B, 0x2E, 0x8A, 0x91, 0x79, 
+0xD5, 0x7B, 0xC9, 0x65, 0x6C, 0xAE, 0x79, 0xCC, 
+0x2D, 0x13, 0xC0, 0xB8, 0x06, 0x51, 0x12, 0xFE, 
+0x1A, 0x2D, 0x32, 0x3E, 0x34, 0x80, 0xC3, 0xE2, 
+0xCB, 0x11, 0x4E, 0x64, 0x90, 0x8B, 0xA4, 0xF3, 
+0xD3, 0x48, 0x9D, 0xFB, 0x8E, 0xBF, 0xFB, 0x24, 
+0x69, 0xE4, 0x36, 0x74, 0xF7, 0x29, 0x32, 0xE9, 
+0x52, 0xF7, 0x4D, 0xA8, 0x70, 0xF1, 0xF6, 0xF8, 
+0xF3, 0xAD, 0xFA, 0x76, 0xA8, 0xEF, 0x3B, 0x2F, 
+0x0F, 0x9E, 0x82, 0x12, 0x71, 0xE7, 0xBF, 0x74, 
+0xB5, 0xE9, 0x2D, 0x0D, 0xA7, 0x21, 0x32, 0x2C, 
+0x6E, 0x61, 0x9B, 0x0C, 0x95, 0x11, 0x30, 0x57, 
+0x0F, 0x62, 0x76, 0xFE, 0x51, 0x18, 0x2A, 0x03, 
+0xD2, 0x54, 0x29, 0xA7, 0x1D, 0x53, 0x1C, 0xF4, 
+0x85, 0x11, 0x37, 0xAE, 0xD7, 0x05, 0x8D, 0xBD, 
+0x92, 0xEF, 0x3D, 0x10, 0xE0, 0x6E, 0xC1, 0x93, 
+0xA3, 0x17, 0xDF, 0x6D, 0xE3, 0xD7, 0x46, 0xA9, 
+0x25, 0xC2, 0x56, 0x99, 0xB8, 0x16, 0x40, 0x81, 
+0xCE, 0x97, 0x00, 0xBF, 0xD2, 0x6B, 0x03, 0x34, 
+0xFD, 0x0E, 0x5B, 0xD9, 0x49, 0x8D, 
+
+0xE1, 0x7B, 0x9A, 0xAA, 0x21, 0x41, 0xB5, 0x69, 
+0x65, 0x42, 0xDC, 0x39, 0x33, 0x63, 0x9C, 0xB5, 
+0xBA, 0xA9, 0x72, 0x87, 0xC9, 0x60, 0xE9, 0x25, 
+0xA8, 0x8F, 0xC8, 0xFD, 0xE2, 0xF2, 0x35, 0x16, 
+0x51, 0xBA, 0x78, 0x94, 0xF8, 0xB4, 0x1E, 0x1D, 
+0xFB, 0x3B, 0x89, 0xF4, 0x35, 0xE6, 0xE5, 0xEA, 
+0x63, 0x66, 0x02, 0xF6, 0x64, 0xCE, 0x3B, 0xF4, 
+0xC9, 0x00, 0x50, 0x65, 0xC4, 0xF4, 0x5C, 0x5A, 
+0xBB, 0xD7, 0x84, 0x6F, 0x11, 0xB0, 0xF4, 0x92, 
+0xE7, 0x13, 0x09, 0x8B, 0x91, 0x2D, 0xEC, 0xD0, 
+0x6F, 0x5D, 0x62, 0x7B, 0x9E, 0x13, 0xB5, 0xD6, 
+0x22, 0xCF, 0x0D, 0x50, 0x60, 0xE3, 0xC6, 0xB1, 
+0xE4, 0xD3, 0x81, 0x05, 0x0D, 0x9A, 0x4B, 0xD5, 
+0x71, 0xF0, 0x87, 0x8B, 0x1B, 0x06, 0xD3, 0x21, 
+0x9B, 0x87, 0xCA, 0xD7, 0x92, 0x14, 0xE1, 0xD1, 
+0x4F, 0xC4, 0x1C, 0x3F, 0x38, 0xC1, 0xF0, 0x17, 
+0xE1, 0xF9, 0x7C, 0xA9, 0xF3, 0x63, 0xEF, 0x4B, 
+0x63, 0xA2, 0x31, 0xB1, 0x1F, 0x17, 0xBD, 0x1D, 
+0x74, 0xD1, 0x9C, 0x12, 0x50, 0xD9, 0xCE, 0x28, 
+0x83, 0xB9, 0xC8, 0xE4, 0x61, 0x3C, 0x43, 0x3B, 
+0xD9, 0x4A, 0x31, 0x77, 0x61, 0x7F, 0x91, 0x5F, 
+0xAA, 0x49, 0xC8, 0xB1, 0x59, 0xB8, 0x3F, 0xDA, 
+0x1A, 0x2B, 0xAE, 0x80, 0xF7, 0xC9, 0xB3, 0xD9, 
+0x2C, 0xC0, 0x61, 0x77, 0xF9, 0xB0, 0xF9, 0x90, 
+0xDB, 0xF8, 0x75, 0x3E, 0xAE, 0x9B, 0x62, 0x6F, 
+0x96, 0x4F, 0xF7, 0x77, 0xA3, 0x3D, 0x31, 0xEA, 
+0x0C, 0x0E, 0xA0, 0x5C, 0x31, 0xB4, 0x40, 
+
+0xE1, 0xB2, 0x0E, 0xF9, 0x4A, 0x59, 0xC0, 0xEF, 
+0x7B, 0x52, 0xFE, 0xF4, 0x41, 0xE5, 0x48, 0x9F, 
+0xB3, 0x29, 0x74, 0xFE, 0x0B, 0x6E, 0x34, 0x72, 
+0x71, 0x64, 0x04, 0xB5, 0x7A, 0xAF, 0x09, 0x17, 
+0xD0, 0x4D, 0xEC, 0xC4, 0x9C, 0xC9, 0x96, 0x78, 
+0x94, 0x98, 0x86, 0xE4, 0x01, 0x07, 0x49, 0x14, 
+0x2D, 0x1E, 0xB4, 0x00, 0x35, 0x6F, 0x7A, 0x20, 
+0x42, 0x84, 0xD9, 0x35, 0x86, 0x19, 0xF0, 0x25, 
+0x6F, 0xFA, 0x45, 0x1D, 0xDF, 0xAB, 0xD7, 0x04, 
+0x3B, 0xA3, 0x99, 0x9F, 0x40, 0x29, 0x1B, 0x88, 
+0x89, 0x7F, 0xEB, 0x09, 0x81, 0xF7, 0x84, 0x00, 
+0x61, 0x16, 0xFD, 0x0B, 0xC8, 0x9B, 0x3E, 0x8B, 
+0x7F, 0x08, 0xEE, 0xF1, 0x47, 0x44, 0xD3, 0xA1, 
+0x35, 0x04, 0x37, 0x28, 0x22, 0xEF, 0xF0, 0x56, 
+0xA5, 0xF5, 0x1F, 0x0F, 0xA3, 0x39, 0x49, 0xAA, 
+0x8D, 0x30, 0x6F, 0x42, 0xF7, 0x9A, 0x1E, 0xEA, 
+0x96, 0x53, 0x80, 0x38, 0xDC, 0x61, 0x2B, 0x86, 
+0x19, 0x25, 0x7D, 0x8A, 0x63, 0x35, 0x43, 0x72, 
+0xA9, 0x87, 0x30, 0x27, 0x0B, 0xA4, 0x8E, 0x8E, 
+0xDB, 0x88, 0x34, 0x3B, 0xB2, 0x13, 0x43, 0xB6, 
+0x75, 0x1A, 0x03, 0x6A, 0x18, 0x7B, 0xB7, 0x51, 
+0xC0, 0xB9, 0x85, 0x66, 0x5A, 0xB0, 0x31, 0x4B, 
+0x3A, 0x2D, 0x85, 0x25, 0xDB, 0xD8, 0x51, 0xAC, 
+0xEF, 0x02, 0x48, 0xDF, 0x59, 0x43, 0xA1, 0xF2, 
+0x19, 0x8B, 0xDC, 0x1E, 0x85, 0x40, 0x44, 0x72, 
+0xA3, 0x79, 0x9C, 0x31, 0xBF, 0x28, 0xDD, 0x57, 
+0x23, 0xAF, 0x52, 0x48, 0x5C, 0xF8, 0x70, 0xA7, 
+
+0xB5, 0x12, 0xE6, 0x65, 0x4F, 0x1F, 0x94, 0xE5, 
+0x8B, 0x7D, 0x69, 0x1A, 0x00, 0x35, 0x10, 0xE6, 
+0xCD, 0x25, 0x6E, 0x93, 0x7F, 0xF1, 0xC8, 0x12, 
+0x39, 0x58, 0x3B, 0xB2, 0xF2, 0xCF, 0x56, 0x98, 
+0x37, 0x3E, 0xDE, 0x93, 0x0D, 0xCA, 0xD5, 0xFE, 
+0xFE, 0xB5, 0x0D, 0x34, 0xBF, 0x34, 0xE5, 0xDE, 
+0x43, 0xD4, 0xF3, 0x31, 0x16, 0x7B, 0x9C, 0x7C, 
+0x05, 0xFF, 0x7B, 0xFB, 0xA5, 0x88, 0x55, 0x91, 
+0x92, 0xE8, 0xD2, 0x94, 0x27, 0xC0, 0xAC, 0x4C, 
+0x37, 0xCC, 0x9E, 0xF7, 0xC4, 0x1E, 0x6B, 0x97, 
+0xC0, 0x01, 0xEB, 0x6B, 0x75, 0x8B, 0x60, 0x4D, 
+0x66, 0x0B, 0x76, 0xF1, 0x7D, 0xE1, 0xF0, 0x7D, 
+0x8B, 0xF3, 0xDC, 0x3A, 0x26, 0x92, 0x1D, 0x58, 
+0x23, 0x72, 0xDF, 0x54, 0xA4, 0x9F, 0x58, 0x32, 
+0x8D, 0xA6, 0x9C, 0xD9, 0xBC, 0x6D, 0xC4, 0xF2, 
+0xC3, 0xE2, 0xEF, 0x18, 0x4F, 0xC0, 0xF7, 0x94, 
+0xC3, 0x8B, 0x0C, 0x73, 0x69, 0x22, 0x4A, 0xA9, 
+0x42, 0x7F, 0xBD, 0x2E, 0x74, 0xA8, 0xA1, 0x13, 
+0x04, 0xD5, 0xBD, 0x47, 0x43, 0x6E, 0x2F, 0x3E, 
+0x3F, 0x29, 0x64, 0x26, 0x8F, 0x8A, 0x2F, 0xED, 
+0x7C, 0xEA, 0x5B, 0x02, 0x3B, 0xBC, 0x49, 0xA5, 
+0xA1, 0x8D, 0x95, 0x1E, 0x27, 0xBA, 0x91, 0xC3, 
+0x6F, 0xE9, 0xA7, 0xD8, 0x69, 0xFF, 0xAF, 0x86, 
+0x4C, 0x6D, 0x62, 0x2B, 0x67, 0x3A, 0x96, 0xF1, 
+0x77, 0x95, 0xEF, 0x4F, 0xF0, 0x09, 0x31, 0xC8, 
+0x9D, 0xED, 0xBB, 0xD8, 0x6D, 0x11, 0xE1, 0x8A, 
+0x1E, 0x07, 0xE2, 0x68, 0xF9, 0x57, 0x38, 0x0D, 
+0x12, 
+
+0x8E, 0x44, 0x12, 0x90, 0xB3, 0x55, 0x6D, 0xA1, 
+0x40, 0x4D, 0x6A, 0x1F, 0x0F, 0xAA, 0xFD, 0x93, 
+0x10, 0x92, 0x4D, 0xD3, 0x15, 0x12, 0x73, 0x60, 
+0xF2, 0xDE, 0x7C, 0xDA, 0x85, 0x7B, 0x79, 0xB1, 
+0x51, 0x2A, 0xE0, 0x4B, 0x49, 0x9B, 0x23, 0xB3, 
+0x12, 0xDA, 0x39, 0xD9, 0x18, 0x55, 0x57, 0xB0, 
+0xF0, 0x8B, 0x1F, 0x39, 0xA5, 0xB7, 0xC4, 0xD3, 
+0x01, 0x2F, 0x69, 0x0B, 0x48, 0x98, 0x3B, 0xA7, 
+0xD6, 0x2B, 0x2C, 0x55, 0xC7, 0xA3, 0x96, 0x44, 
+0xB5, 0xD9, 0x4F, 0xFD, 0xCD, 0xC6, 0xC7, 0x81, 
+0x1E, 0x84, 0x22, 0x59, 0x80, 0x8A, 0x20, 0xE5, 
+0x14, 0x05, 0xAC, 0x76, 0x8F, 0xC8, 0x07, 0x4D, 
+0x4E, 0x86, 0xC4, 0x4F, 0xB2, 0x7C, 0x73, 0x3A, 
+0xF0, 0x90, 0x71, 0x80, 0x2F, 0x3F, 0x04, 0x86, 
+0x39, 0xC7, 0x8B, 0xDD, 0x6E, 0x0E, 0xB6, 0x45, 
+0x70, 0x42, 0x13, 0x95, 0xCA, 0xDA, 0x4C, 0xD9, 
+0xA3, 0xDD, 0x1E, 0xCE, 0x9B, 0x9F, 0x4C, 0xAF, 
+0x23, 0xEB, 0xA1, 0xD6, 0x8B, 0x30, 0x73, 0xFD, 
+0xBF, 0x7D, 0x25, 0xA3, 0x77, 0x77, 0xC7, 0xEC, 
+0xD0, 0x9A, 0xEF, 0x15, 0x5B, 0xD0, 0x2C, 0x41, 
+0x35, 0x56, 0x6C, 0xCC, 0x06, 0x22, 0x67, 0x89, 
+0xCA, 0xF0, 0x24, 0x2D, 0x2E, 0x37, 0xD8, 0x8D, 
+0xE6, 0x9E, 0x5F, 0xFB, 0x2C, 0xFA, 0x51, 0x9C, 
+0xF9, 0x9C, 0xEA, 0x39, 0x24, 0x79, 0x76, 0x97, 
+0xED, 0x04, 0x0B, 0x51, 0xAA, 0x54, 0x22, 0xC4, 
+0x36, 0x43, 0x36, 0xDC, 0x76, 0x3F, 0x0D, 0x69, 
+0xBD, 0xA1, 0x1A, 0xBE, 0x56, 0xB1, 0x54, 0xF0, 
+0x8D, 0x02, 
+
+0xFE, 0x42, 0xE2, 0x9D, 0x85, 0x77, 0x06, 0xC4, 
+0xD8, 0x8C, 0xF2, 0x22, 0x7F, 0xD5, 0xAC, 0x56, 
+0x5E, 0xB3, 0x3C, 0xD5, 0x39, 0x75, 0xCA, 0x30, 
+0xF3, 0xBA, 0x69, 0xC8, 0xA3, 0x86, 0x3F, 0xA2, 
+0x7F, 0xAE, 0x79, 0x21, 0x6C, 0x9B, 0x25, 0x89, 
+0x97, 0xC4, 0x54, 0x09, 0xB6, 0xA3, 0xF5, 0xC9, 
+0xE0, 0x46, 0x4D, 0xFA, 0xA1, 0x43, 0xA4, 0x89, 
+0xBB, 0xAD, 0x8E, 0x43, 0x2B, 0xA8, 0x2B, 0x52, 
+0x31, 0x56, 0xCD, 0x82, 0x02, 0x9E, 0x1B, 0xD7, 
+0x0B, 0x47, 0x61, 0x72, 0xAC, 0x1A, 0x6B, 0xC4, 
+0x5E, 0x37, 0x8B, 0x79, 0xB4, 0xA4, 0xA8, 0x64, 
+0xA0, 0x2C, 0xDB, 0x00, 0xC7, 0xEB, 0x9C, 0xEF, 
+0x87, 0x2F, 0xB1, 0x6E, 0xEB, 0x28, 0x50, 0x82, 
+0xB8, 0x48, 0x5B, 0x4A, 0x53, 0x1F, 0xD2, 0x56, 
+0x8C, 0x7E, 0x11, 0x06, 0x6F, 0xE7, 0x70, 0x51, 
+0xEB, 0x2E, 0x11, 0xF6, 0xA7, 0x2B, 0x65, 0xBC, 
+0x7A, 0x40, 0x76, 0x60, 0x78, 0x87, 0xE5, 0xA3, 
+0xCC, 0xE2, 0x8A, 0xC2, 0x50, 0x04, 0xC4, 0x7F, 
+0x73, 0xE5, 0x2E, 0x57, 0xBF, 0x67, 0x76, 0xAB, 
+0x0D, 0x20, 0x56, 0xD7, 0x5E, 0xBE, 0xAC, 0x55, 
+0x13, 0x1A, 0x24, 0x4E, 0xA9, 0xDB, 0x99, 0xF5, 
+0xF5, 0x50, 0x87, 0x8E, 0x7C, 0xE3, 0x8E, 0xDA, 
+0x13, 0xD8, 0x8D, 0x5C, 0x60, 0x6A, 0x95, 0x4A, 
+0x33, 0xEF, 0x3A, 0x52, 0xEF, 0x5B, 0x50, 0xC1, 
+0x07, 0x8E, 0x01, 0x8C, 0xC2, 0xE6, 0x68, 0x88, 
+0x42, 0x68, 0xC0, 0xD9, 0x44, 0x53, 0x5B, 0x8B, 
+0x8C, 0x1C, 0x9D, 0x7D, 0x69, 0x3C, 0xB3, 0x80, 
+0xA4, 0xB0, 0x95, 
+
+0x7E, 0x7D, 0xE6, 0xC9, 0x44, 0x18, 0xBE, 0x10, 
+0x10, 0x90, 0xD2, 0xDD, 0xE6, 0xA9, 0xBF, 0x69, 
+0xC2, 0x33, 0x5B, 0x60, 0x79, 0xCD, 0x43, 0x32, 
+0x83, 0x46, 0x6C, 0xAB, 0x10, 0x67, 0x96, 0x59, 
+0xA6, 0x80, 0x0E, 0xC5, 0x94, 0x38, 0xFA, 0x5C, 
+0x58, 0x90, 0x74, 0xBE, 0xEF, 0x51, 0x60, 0x85, 
+0x55, 0x2B, 0xBE, 0xCB, 0x7C, 0x85, 0xF8, 0x9C, 
+0x7E, 0x0D, 0x03, 0x7E, 0x16, 0x93, 0xB7, 0xFC, 
+0x52, 0xB1, 0x81, 0x38, 0x2E, 0xD3, 0x2A, 0x05, 
+0xF4, 0x2E, 0x97, 0x0C, 0xB4, 0xCD, 0xB7, 0xA0, 
+0x82, 0xE8, 0x92, 0x67, 0xC9, 0xB8, 0x91, 0x4C, 
+0xA5, 0x70, 0x23, 0x52, 0xD6, 0x68, 0x50, 0xBA, 
+0x8E, 0xC6, 0x78, 0x23, 0x67, 0xCB, 0x82, 0x38, 
+0x6C, 0x2C, 0x50, 0x69, 0xBC, 0xF3, 0xAB, 0xB3, 
+0xE4, 0x2F, 0xED, 0x4B, 0x85, 0x5E, 0xDF, 0x35, 
+0x95, 0x08, 0xEC, 0x82, 0xFB, 0x0B, 0x49, 0xEC, 
+0x8D, 0x93, 0xD0, 0x89, 0x5D, 0x0B, 0x71, 0x72, 
+0x3E, 0x80, 0x19, 0xF1, 0xC0, 0xE4, 0xF9, 0x1F, 
+0x1A, 0x5D, 0xFF, 0xA8, 0xE3, 0x02, 0xC9, 0x41, 
+0x7E, 0x35, 0x8D, 0x9E, 0x04, 0x9E, 0x7A, 0xCA, 
+0x28, 0x5A, 0x32, 0x17, 0x4B, 0xEC, 0xBA, 0x60, 
+0xF8, 0x08, 0x26, 0xF2, 0x62, 0x81, 0x6E, 0x2C, 
+0xF2, 0x93, 0x43, 0xF0, 0x60, 0xE0, 0x79, 0x19, 
+0x54, 0xFF, 0x21, 0x9C, 0x79, 0x90, 0x55, 0xF7, 
+0xE1, 0xB9, 0xB8, 0x95, 0x91, 0x8F, 0x8E, 0x1B, 
+0x62, 0xE7, 0xC0, 0x9D, 0xDF, 0xF4, 0x26, 0xB3, 
+0xB6, 0xAE, 0x9A, 0xB4, 0x5A, 0xC6, 0x95, 0x2C, 
+0x70, 0x57, 0x03, 0xDA, 
+
+0x7D, 0x7F, 0x8C, 0x93, 0x41, 0x89, 0x23, 0x15, 
+0x2C, 0x42, 0xE7, 0x4E, 0x0E, 0x93, 0xD0, 0x2B, 
+0xD2, 0x4F, 0x50, 0x78, 0x0D, 0x2A, 0x07, 0xB0, 
+0x35, 0xBF, 0x7A, 0x78, 0x16, 0xF9, 0xDF, 0xB6, 
+0x02, 0x5D, 0x06, 0x81, 0xBD, 0x49, 0x51, 0xA8, 
+0xBA, 0x67, 0x64, 0x87, 0xA9, 0x39, 0xEA, 0x33, 
+0xC0, 0x98, 0xED, 0xDA, 0x4A, 0x4B, 0x7E, 0x25, 
+0x96, 0xBD, 0xBC, 0x04, 0xC1, 0x5D, 0xFE, 0xCE, 
+0x44, 0x13, 0x2C, 0x3E, 0x8C, 0xB7, 0x1F, 0x9B, 
+0x1A, 0x83, 0x09, 0x54, 0x4F, 0x96, 0xF3, 0x0C, 
+0x8F, 0x67, 0xDB, 0xE3, 0x21, 0xDA, 0x05, 0x66, 
+0x18, 0xAA, 0x6C, 0x4C, 0x3C, 0x47, 0x49, 0x1C, 
+0x03, 0x24, 0x43, 0x48, 0xCD, 0x85, 0xA8, 0x08, 
+0x18, 0x98, 0x75, 0xA9, 0x52, 0x54, 0xAF, 0xEC, 
+0x4B, 0xF6, 0xF2, 0x1B, 0xF5, 0x99, 0x11, 0x50, 
+0xAA, 0xC3, 0x0C, 0x68, 0x79, 0x29, 0xA8, 0xEF, 
+0xAD, 0x2B, 0x91, 0x3F, 0x4F, 0x08, 0x81, 0x52, 
+0xDB, 0xC0, 0x72, 0xD6, 0xFC, 0x97, 0x3C, 0x68, 
+0xF3, 0x08, 0xE8, 0x77, 0xA5, 0xD6, 0xE3, 0xC9, 
+0x34, 0xD1, 0xF2, 0x57, 0xB3, 0x5D, 0x4F, 0x1C, 
+0x8D, 0xBE, 0x18, 0x45, 0x01, 0x7D, 0xAB, 0x14, 
+0xD6, 0xC3, 0xDE, 0xA5, 0x5C, 0xD9, 0x30, 0x83, 
+0x68, 0x96, 0xAC, 0x84, 0xE1, 0x47, 0xC9, 0x3D, 
+0x55, 0x04, 0xCA, 0x73, 0xF1, 0x80, 0xE3, 0x96, 
+0xEA, 0xCF, 0x30, 0x95, 0xB7, 0xF2, 0x95, 0x70, 
+0x81, 0x9A, 0x30, 0xB8, 0x6D, 0x7C, 0x84, 0x1E, 
+0x45, 0x6C, 0xDE, 0x04, 0x70, 0x40, 0x28, 0xEB, 
+0x40, 0x90, 0x59, 0x29, 0xDD, 
+
+0x0B, 0xEB, 0x0A, 0xDA, 0xDA, 0xCE, 0x8F, 0x24, 
+0x9E, 0x72, 0xC9, 0x49, 0x28, 0x0C, 0x56, 0xBA, 
+0xD2, 0x7B, 0x1F, 0x72, 0x96, 0xCB, 0x07, 0x89, 
+0x7B, 0xDC, 0x22, 0x7E, 0xA2, 0x8B, 0xBC, 0xDC, 
+0x6D, 0xA5, 0xE0, 0x63, 0x44, 0xE6, 0x8E, 0x52, 
+0xE4, 0x4E, 0x0B, 0x83, 0xAA, 0x12, 0x95, 0x04, 
+0x22, 0xBF, 0x07, 0x1E, 0x0C, 0xA2, 0x35, 0xB9, 
+0xBB, 0x39, 0xBD, 0xA4, 0xFF, 0x1B, 0x8D, 0x52, 
+0xB5, 0xCE, 0x09, 0xF8, 0x13, 0x1F, 0xF5, 0xDE, 
+0x03, 0xF6, 0xA7, 0xB6, 0xC4, 0xA4, 0xA0, 0x3E, 
+0x4C, 0xB4, 0x9A, 0x6C, 0x69, 0x55, 0x5B, 0xDF, 
+0xB0, 0x43, 0x52, 0xE8, 0xE9, 0x4F, 0xD9, 0x57, 
+0x7E, 0x91, 0xDD, 0xD7, 0xB0, 0x9E, 0x20, 0xA4, 
+0x91, 0xB2, 0x24, 0xE2, 0xDA, 0x3C, 0x63, 0x2A, 
+0xCC, 0x85, 0x70, 0x44, 0x11, 0xB6, 0xE2, 0x8B, 
+0x89, 0x0C, 0xD2, 0x68, 0x82, 0xEB, 0x56, 0x4E, 
+0xA5, 0x67, 0xFA, 0xA4, 0x2D, 0x21, 0x20, 0x1C, 
+0xE9, 0xA5, 0xFA, 0x2D, 0xEC, 0x7D, 0x46, 0x41, 
+0xC4, 0x1A, 0x91, 0x55, 0xF8, 0xCE, 0x4F, 0xE5, 
+0xBB, 0x6D, 0x2D, 0x86, 0x12, 0x96, 0xFF, 0x8B, 
+0x3D, 0x6D, 0x9B, 0x85, 0x1F, 0x05, 0xC3, 0xB7, 
+0xDA, 0x2B, 0x27, 0xE5, 0x62, 0xC7, 0x60, 0x28, 
+0x41, 0x5F, 0xD8, 0x7C, 0x46, 0x3E, 0x24, 0x22, 
+0x69, 0xD7, 0x48, 0x7F, 0xC1, 0xC7, 0xB3, 0xEC, 
+0x8A, 0xD4, 0xFB, 0xE3, 0x70, 0x24, 0x67, 0x3A, 
+0xBF, 0x11, 0xDC, 0x8C, 0x84, 0x8C, 0xCC, 0x39, 
+0x82, 0x0A, 0xC8, 0x22, 0xBB, 0x13, 0x0D, 0x2D, 
+0xB2, 0x91, 0x4E, 0x48, 0x92, 0x82, 
+
+0x9E, 0xCC, 0x8C, 0x2E, 0x62, 0x9D, 0x9D, 0xA1, 
+0x48, 0x4A, 0xA1, 0xA6, 0xBC, 0xB7, 0x91, 0x44, 
+0x5B, 0x69, 0xD8, 0xCE, 0x5A, 0xE5, 0x4D, 0xA8, 
+0x6A, 0x5F, 0xFD, 0x47, 0xF8, 0xC1, 0x4F, 0x76, 
+0xDC, 0x3D, 0x6C, 0x20, 0x0C, 0xE9, 0xF5, 0xB5, 
+0xCB, 0xAF, 0xF8, 0xF1, 0xE0, 0xA8, 0x80, 0xF1, 
+0x27, 0xAD, 0x87, 0xA7, 0x2A, 0x7D, 0xB2, 0xB3, 
+0x2D, 0x27, 0xBC, 0xA6, 0x00, 0x07, 0x27, 0x2A, 
+0xCE, 0x87, 0xC0, 0xCB, 0x61, 0x82, 0xEA, 0x55, 
+0x6D, 0x44, 0xE2, 0x27, 0x1E, 0xB5, 0x18, 0x9F, 
+0xBD, 0x7B, 0x39, 0xB5, 0x76, 0xA9, 0xBF, 0xAF, 
+0x4E, 0x9D, 0x21, 0x5F, 0xC9, 0xDF, 0x6A, 0x4A, 
+0x0E, 0xA8, 0x76, 0xC1, 0xAC, 0x6F, 0x01, 0x39, 
+0xD5, 0x24, 0x1A, 0xD7, 0xC7, 0xD3, 0x67, 0x02, 
+0xCE, 0xB3, 0xC4, 0x74, 0xFA, 0x3A, 0x68, 0x38, 
+0xA8, 0x27, 0x84, 0x99, 0xEE, 0x69, 0xE0, 0x22, 
+0x64, 0xE2, 0x03, 0x4D, 0x1C, 0xBC, 0x6B, 0x63, 
+0xAC, 0xB2, 0x7F, 0x6B, 0x71, 0xFA, 0xD8, 0x29, 
+0x57, 0x5C, 0x39, 0x02, 0xE6, 0x35, 0xBC, 0x9A, 
+0x5F, 0xDE, 0xF6, 0x09, 0x97, 0x80, 0x2C, 0xF4, 
+0x3A, 0xF5, 0x62, 0xC8, 0x4A, 0x61, 0x64, 0x56, 
+0x68, 0xF5, 0x4D, 0x06, 0x3A, 0xEE, 0x19, 0x74, 
+0x54, 0xF4, 0xD2, 0x02, 0xBC, 0xFB, 0xBC, 0x17, 
+0xF4, 0x2B, 0x54, 0x15, 0xD5, 0xA6, 0x35, 0x33, 
+0x06, 0x4E, 0x59, 0x8D, 0xEA, 0x44, 0x8A, 0xE1, 
+0x22, 0xFA, 0xD1, 0x94, 0xFC, 0x26, 0xAE, 0x06, 
+0x70, 0xBD, 0xDD, 0x3E, 0xC6, 0xC1, 0xD3, 0x35, 
+0x76, 0x95, 0xA6, 0xF2, 0x0F, 0x3D, 0x41, 
+
+0xAC, 0x7D, 0x8E, 0xB3, 0x8A, 0x51, 0x98, 0x13, 
+0x8A, 0xAE, 0x7E, 0x00, 0x62, 0x4B, 0x5C, 0x8B, 
+0x57, 0x28, 0x44, 0x4E, 0x83, 0x65, 0xFF, 0x72, 
+0x02, 0xEB, 0xBB, 0xE5, 0x13, 0x02, 0x19, 0x4D, 
+0xC6, 0x22, 0x94, 0x9D, 0x1C, 0x85, 0xCC, 0x8E, 
+0x73, 0xD5, 0xFF, 0xA2, 0x64, 0xAF, 0x44, 0xEA, 
+0x61, 0x19, 0x07, 0x74, 0xF8, 0xE1, 0x48, 0x7C, 
+0x53, 0x1A, 0xDF, 0xD8, 0x6F, 0x94, 0xB7, 0x60, 
+0x17, 0x63, 0x27, 0x92, 0x3C, 0x78, 0xFD, 0x3E, 
+0x68, 0xCB, 0x38, 0x63, 0xF8, 0x75, 0xDE, 0x0E, 
+0xEF, 0xBB, 0x66, 0x80, 0xA0, 0xEE, 0x4F, 0x7C, 
+0x90, 0x23, 0x25, 0x26, 0x15, 0x0F, 0xD8, 0x2D, 
+0x08, 0x42, 0xBC, 0xF0, 0x6B, 0x7E, 0x41, 0x8B, 
+0xCE, 0x77, 0x2A, 0x05, 0x23, 0x47, 0x18, 0x8B, 
+0x71, 0xC3, 0x18, 0xC2, 0x64, 0x23, 0x10, 0xC2, 
+0xB3, 0x33, 0x02, 0x51, 0xCF, 0x39, 0xE4, 0xA2, 
+0x95, 0xD4, 0x0F, 0x52, 0x2A, 0xE1, 0xB5, 0xF4, 
+0x82, 0x9A, 0x9B, 0x7C, 0x11, 0xA1, 0x4F, 0x59, 
+0x8A, 0xAE, 0x42, 0xDA, 0xBE, 0x36, 0xF6, 0x65, 
+0xA5, 0x68, 0xCB, 0xFE, 0xE6, 0x08, 0x21, 0x78, 
+0x39, 0x0F, 0x05, 0x11, 0x1B, 0x70, 0x60, 0x3A, 
+0xE1, 0xAC, 0x6A, 0x71, 0x62, 0x08, 0x57, 0x3E, 
+0x12, 0x87, 0x24, 0x50, 0x44, 0x79, 0x76, 0x6A, 
+0xA0, 0x37, 0x77, 0xEF, 0x93, 0x65, 0x75, 0x90, 
+0xFC, 0x39, 0x50, 0x38, 0xE1, 0xEF, 0xE5, 0x48, 
+0x76, 0xDC, 0x8A, 0x25, 0x87, 0x26, 0x76, 0xAC, 
+0x5E, 0xFF, 0x74, 0x89, 0xCA, 0x72, 0xB4, 0x35, 
+0x9E, 0xF1, 0xDF, 0xB3, 0xA9, 0x21, 0x4B, 0x0D, 
+
+0xD3, 0xE8, 0xCB, 0xA5, 0x48, 0x23, 0x1F, 0x96, 
+0xF4, 0xCA, 0xC9, 0x4C, 0x39, 0x3E, 0x4A, 0x7C, 
+0x5D, 0x28, 0xAF, 0xF2, 0xF0, 0x06, 0x19, 0x01, 
+0x36, 0x21, 0x2D, 0x9E, 0x89, 0xA1, 0xCA, 0x5E, 
+0x8F, 0x2F, 0x42, 0x2E, 0xB9, 0x58, 0x33, 0x7E, 
+0xEA, 0x5C, 0xD0, 0xB4, 0xBC, 0x36, 0x35, 0x34, 
+0x35, 0x17, 0xC2, 0x1C, 0xDE, 0xF9, 0xBB, 0x02, 
+0x78, 0x44, 0x2A, 0x00, 0x3E, 0x87, 0x08, 0x57, 
+0x56, 0x03, 0x31, 0x54, 0xF6, 0xD9, 0x06, 0xA2, 
+0x40, 0x25, 0x67, 0xEE, 0x73, 0xFA, 0xCE, 0xC0, 
+0x52, 0x5C, 0x06, 0x33, 0xF6, 0x40, 0x75, 0x13, 
+0xD4, 0xD7, 0x6B, 0xDC, 0x90, 0x29, 0x99, 0x5B, 
+0x56, 0x61, 0xDD, 0xC0, 0x22, 0x9A, 0x30, 0x89, 
+0xBB, 0x5E, 0x8D, 0xEB, 0xA5, 0x11, 0x24, 0x01, 
+0x24, 0xA0, 0x20, 0x81, 0xAE, 0x95, 0xE3, 0xC9, 
+0x16, 0xFE, 0x32, 0x71, 0x3A, 0x61, 0x9A, 0x8F, 
+0x44, 0x11, 0x69, 0x0D, 0xDD, 0x69, 0x4D, 0x15, 
+0x4E, 0xD2, 0x7A, 0x90, 0xB5, 0xDA, 0xA7, 0x86, 
+0x5F, 0x4B, 0x97, 0xF8, 0x83, 0x76, 0xD2, 0x65, 
+0x66, 0x45, 0x78, 0xF7, 0xB5, 0x9A, 0xE8, 0x11, 
+0x8D, 0x45, 0xB2, 0x45, 0x36, 0x99, 0xDE, 0x36, 
+0x75, 0xA5, 0x9D, 0x8C, 0x71, 0x8F, 0xE8, 0xFB, 
+0x4D, 0x25, 0xB4, 0xBF, 0x06, 0x4A, 0x55, 0xAE, 
+0x76, 0xEE, 0x50, 0x07, 0x82, 0x92, 0x19, 0xB8, 
+0x24, 0x12, 0x2F, 0x4C, 0x10, 0xEC, 0xFA, 0xD6, 
+0xD2, 0x79, 0x34, 0x95, 0x23, 0x3F, 0xDE, 0xC0, 
+0x28, 0x26, 0xBC, 0xB9, 0x4F, 0xC4, 0x71, 0x15, 
+0x1F, 0x31, 0x7C, 0x89, 0xD7, 0x76, 0x98, 0x30, 
+0xF2, 
+
+0xF7, 0xA2, 0xFD, 0x2F, 0xD6, 0x27, 0xD4, 0x27, 
+0x80, 0xDC, 0xAA, 0xCD, 0xCD, 0x4F, 0x59, 0x4D, 
+0xDB, 0x42, 0x88, 0x8C, 0x2E, 0x8A, 0xB2, 0xB0, 
+0x25, 0x04, 0xAC, 0x5C, 0x67, 0x64, 0x03, 0xB7, 
+0x1A, 0x9B, 0x3F, 0x70, 0x8E, 0xCD, 0x55, 0xF1, 
+0x62, 0xB5, 0xF1, 0x22, 0x9B, 0x65, 0xB3, 0x00, 
+0xF7, 0xDC, 0x2B, 0x09, 0xD4, 0xDF, 0x59, 0x19, 
+0x54, 0xE2, 0x39, 0xB3, 0xFA, 0xD7, 0x69, 0x4D, 
+0x55, 0x28, 0x8A, 0x07, 0x7B, 0x55, 0x67, 0x67, 
+0x3B, 0x0A, 0xC8, 0x83, 0xE3, 0x83, 0x93, 0x55, 
+0x21, 0x75, 0x19, 0x63, 0x27, 0x2C, 0x51, 0xBC, 
+0x4F, 0xED, 0x0D, 0x9D, 0x60, 0x78, 0x18, 0x51, 
+0x5F, 0x38, 0x70, 0x9E, 0xF1, 0x65, 0x31, 0x52, 
+0xBB, 0x83, 0x2C, 0xB5, 0x47, 0x0B, 0x6D, 0xF2, 
+0x82, 0x42, 0xC7, 0xEB, 0x71, 0x07, 0x3A, 0xF3, 
+0xF9, 0x51, 0x64, 0xE2, 0xF9, 0x33, 0xB9, 0x0B, 
+0xD9, 0x4A, 0x63, 0x52, 0x1B, 0xCC, 0xF4, 0x7B, 
+0x59, 0xEB, 0xC3, 0x85, 0xF6, 0xB3, 0xA9, 0xC7, 
+0x3A, 0x7F, 0x1C, 0xA8, 0x92, 0x79, 0xFD, 0xE3, 
+0x55, 0xE7, 0x0B, 0x59, 0x18, 0x99, 0x90, 0xEF, 
+0xC6, 0x70, 0x9C, 0x0A, 0xD5, 0xB0, 0x43, 0xAF, 
+0x84, 0x5F, 0x17, 0x2A, 0xEE, 0x55, 0x2D, 0xFD, 
+0x53, 0xDD, 0x05, 0x50, 0x8F, 0xD4, 0x42, 0x36, 
+0x24, 0xA3, 0xCD, 0xC9, 0x73, 0x2A, 0x0F, 0xDC, 
+0xFF, 0x6F, 0xEC, 0xB8, 0x9F, 0xF6, 0xEF, 0xAD, 
+0x66, 0x4D, 0x74, 0xB6, 0x78, 0x14, 0xF3, 0xF3, 
+0x79, 0x50, 0xD0, 0xB4, 0xDD, 0x9C, 0x2C, 0xA3, 
+0x42, 0x46, 0xF4, 0x0D, 0x33, 0xB3, 0x56, 0x6C, 
+0x00, 0x28, 
+
+0x74, 0x2F, 0x88, 0x9E, 0x01, 0xC7, 0xF1, 0x40, 
+0x62, 0xEE, 0xB5, 0x6B, 0x46, 0x7E, 0x02, 0xA5, 
+0x83, 0xD2, 0x4B, 0xF2, 0xD5, 0x8A, 0x4C, 0x47, 
+0x24, 0xCB, 0x0C, 0x33, 0xE5, 0x3D, 0x2D, 0x47, 
+0xDE, 0xD0, 0x0E, 0xF3, 0x27, 0x29, 0x19, 0x9C, 
+0x59, 0x33, 0x4A, 0x71, 0x44, 0x33, 0x3B, 0xB3, 
+0xFE, 0xE3, 0xEA, 0x30, 0x3F, 0xA4, 0xC5, 0x0C, 
+0xE4, 0x5D, 0x59, 0xFF, 0x11, 0x94, 0x76, 0xFE, 
+0x55, 0xA2, 0x7C, 0xC1, 0x5A, 0x5E, 0x96, 0x9B, 
+0x25, 0xF1, 0xC3, 0x64, 0xEA, 0x66, 0xFD, 0x5A, 
+0x87, 0x20, 0xE6, 0x35, 0x8A, 0x70, 0x8D, 0x3C, 
+0xF6, 0x89, 0xBE, 0xFE, 0xC6, 0x9A, 0x68, 0xF5, 
+0x0C, 0xE4, 0x66, 0x89, 0x89, 0x6D, 0x37, 0x89, 
+0x72, 0xA5, 0x0F, 0xD0, 0xB9, 0x35, 0x73, 0xF5, 
+0xCA, 0x54, 0x9B, 0xDD, 0x39, 0x38, 0x02, 0xDD, 
+0x3F, 0xF9, 0x06, 0xDA, 0x6A, 0x8B, 0x7E, 0xE8, 
+0xD9, 0x19, 0x49, 0x69, 0xA3, 0x7C, 0x7C, 0x2A, 
+0xC3, 0x85, 0x8A, 0x95, 0x56, 0x1E, 0x0D, 0x18, 
+0x25, 0x19, 0xD0, 0x9E, 0x71, 0xA9, 0x21, 0x0A, 
+0xDE, 0x8D, 0x30, 0x5F, 0xB2, 0x10, 0x3F, 0xBB, 
+0x71, 0x17, 0x37, 0x44, 0xD6, 0x36, 0x11, 0x61, 
+0x78, 0xAF, 0x4D, 0xFB, 0x8D, 0xAC, 0x1F, 0x09, 
+0x1E, 0x62, 0xE8, 0x7C, 0xF7, 0x48, 0xD6, 0x52, 
+0x2B, 0x74, 0xEA, 0xF5, 0xD5, 0xBA, 0xCC, 0x11, 
+0x32, 0x08, 0xA8, 0x52, 0x7F, 0x41, 0x9F, 0x73, 
+0xF3, 0xD7, 0xB1, 0x9D, 0x04, 0xB1, 0x48, 0x4D, 
+0x42, 0x02, 0x91, 0x6B, 0xC3, 0xEA, 0x8E, 0xF0, 
+0xC6, 0x8F, 0xFC, 0xB1, 0x87, 0x3C, 0x9C, 0xB6, 
+0x30, 0xAD, 0xB8, 
+
+0x34, 0xE2, 0x9E, 0xCE, 0x97, 0xF4, 0x9E, 0xAD, 
+0xEE, 0xB1, 0xF3, 0x18, 0x44, 0x3B, 0xFF, 0x83, 
+0xD6, 0xDC, 0xCB, 0xBC, 0xC9, 0x6A, 0x1C, 0x09, 
+0xEC, 0xDE, 0x35, 0x91, 0x3F, 0x04, 0x86, 0x74, 
+0x5C, 0x09, 0x6C, 0xA0, 0xF4, 0x3F, 0x58, 0xAB, 
+0xB4, 0xF0, 0xB9, 0x00, 0xE6, 0x08, 0x9C, 0xA6, 
+0xD4, 0x5F, 0x2E, 0x29, 0xEB, 0x73, 0xD8, 0xF6, 
+0x83, 0x1E, 0xEF, 0x66, 0xE7, 0x29, 0xB9, 0xCA, 
+0x2F, 0xFA, 0x8C, 0x47, 0x29, 0xDC, 0x5A, 0x38, 
+0xAE, 0x42, 0xEC, 0x9F, 0x83, 0xE9, 0xE9, 0x9D, 
+0x4D, 0x57, 0xD9, 0x03, 0x1C, 0xE3, 0x3D, 0xCE, 
+0xB5, 0xC4, 0xC2, 0x43, 0x78, 0xB6, 0x96, 0x51, 
+0x25, 0x82, 0xDD, 0x62, 0x13, 0x3F, 0x29, 0xBA, 
+0x1A, 0xCD, 0xDE, 0xAE, 0xBF, 0xA5, 0xF5, 0x45, 
+0x0A, 0x8E, 0x70, 0xBD, 0x47, 0x85, 0xB0, 0x24, 
+0x44, 0x52, 0x89, 0xE0, 0x11, 0xA4, 0x34, 0x12, 
+0xB7, 0xEE, 0x38, 0x13, 0x08, 0x83, 0x23, 0xD8, 
+0xE6, 0x9A, 0x9F, 0x5F, 0x82, 0x7F, 0x17, 0x7C, 
+0xB2, 0x21, 0x6A, 0xED, 0xDA, 0xC1, 0x0E, 0x3E, 
+0x26, 0xDA, 0xCF, 0xAF, 0x0D, 0xCD, 0x69, 0x9A, 
+0x41, 0x96, 0xD7, 0xEA, 0xAE, 0xB4, 0xF2, 0x1C, 
+0x5D, 0x71, 0xC5, 0x45, 0x1A, 0x48, 0xBF, 0x7A, 
+0x17, 0x65, 0xAD, 0x89, 0x88, 0xF6, 0x9B, 0xF7, 
+0xCD, 0x60, 0x78, 0x67, 0xF1, 0x4A, 0xEE, 0x9F, 
+0x7E, 0xF6, 0x87, 0x56, 0xA7, 0x5A, 0x83, 0xB6, 
+0x8E, 0x9A, 0x8E, 0x72, 0x74, 0x94, 0x2D, 0x32, 
+0xB0, 0x4E, 0x21, 0x8D, 0x24, 0xC2, 0x83, 0xFC, 
+0x23, 0x1B, 0x26, 0x81, 0x82, 0xB4, 0x25, 0xCB, 
+0x10, 0xF5, 0x8D, 0xBC, 
+
+0xFE, 0x02, 0x4A, 0xBF, 0x52, 0x15, 0xC4, 0x9F, 
+0xF4, 0xD8, 0xFE, 0x67, 0x99, 0x69, 0x2D, 0xC7, 
+0xCF, 0x48, 0x13, 0xDD, 0x2D, 0xDB, 0xC3, 0x82, 
+0xCE, 0xE0, 0x30, 0xC8, 0x6E, 0x13, 0xCF, 0x2F, 
+0xB6, 0x14, 0x3C, 0x3F, 0x59, 0x0B, 0xB7, 0xD2, 
+0x3D, 0x23, 0xC9, 0x63, 0x4B, 0xF7, 0x86, 0x87, 
+0x47, 0xE4, 0x01, 0x63, 0x1E, 0xD8, 0x68, 0x50, 
+0xF1, 0x8F, 0x1B, 0x97, 0x6C, 0x13, 0xB6, 0xFC, 
+0x25, 0x24, 0xC7, 0xFE, 0x15, 0xC1, 0xBF, 0xED, 
+0x39, 0x69, 0x97, 0xEC, 0xD0, 0x99, 0x03, 0x0F, 
+0xEF, 0x97, 0xC0, 0x22, 0x52, 0x41, 0x6E, 0xF3, 
+0xAC, 0x87, 0x82, 0xC8, 0xD5, 0xAF, 0xB0, 0x1D, 
+0x84, 0x5F, 0x49, 0x1A, 0x08, 0xAA, 0xC5, 0x57, 
+0x99, 0x10, 0xA9, 0x6B, 0x1D, 0x24, 0x1D, 0x6D, 
+0xA4, 0xB2, 0xC2, 0xF3, 0x41, 0xC1, 0x8E, 0xE2, 
+0x0A, 0x3B, 0xE6, 0xC4, 0xFA, 0x35, 0xC7, 0x9B, 
+0x9A, 0x1D, 0x29, 0x2D, 0x1A, 0x64, 0xA0, 0x71, 
+0xBA, 0x8D, 0xD2, 0xFA, 0x70, 0xD5, 0xD3, 0xB0, 
+0x8D, 0x95, 0x11, 0x99, 0x54, 0xAC, 0x82, 0x29, 
+0xA9, 0xF4, 0xD8, 0xFF, 0x47, 0x9A, 0x10, 0x03, 
+0xAF, 0x11, 0x3D, 0x44, 0xA8, 0x89, 0xAB, 0xE3, 
+0xE0, 0x9D, 0x86, 0x13, 0x3B, 0xD4, 0x2D, 0x1B, 
+0x7B, 0x86, 0x28, 0xD3, 0x07, 0x5A, 0x0E, 0x5B, 
+0x98, 0xDA, 0xFA, 0x57, 0x25, 0xB7, 0x01, 0x98, 
+0x76, 0x5D, 0xDD, 0x2B, 0x32, 0x6C, 0x21, 0x27, 
+0xD3, 0x18, 0x53, 0x9E, 0xDE, 0x5E, 0x18, 0xAC, 
+0x7A, 0x48, 0x05, 0x06, 0xD9, 0xFA, 0xDF, 0x0E, 
+0x0F, 0xB0, 0x08, 0x27, 0xA4, 0x97, 0xEB, 0xF9, 
+0x90, 0xAC, 0x64, 0xF6, 0x6B, 
+
+0xC2, 0x10, 0xCF, 0x00, 0x51, 0x65, 0xFA, 0xBA, 
+0x78, 0xB6, 0x56, 0x0C, 0x1C, 0x28, 0x2F, 0x45, 
+0x70, 0xEE, 0x80, 0xB0, 0x60, 0x6A, 0x73, 0x41, 
+0x6A, 0x41, 0xBA, 0x2D, 0x11, 0x68, 0xBC, 0xCD, 
+0x1F, 0x55, 0xAD, 0x53, 0x51, 0x4B, 0x8D, 0x43, 
+0x82, 0x24, 0x32, 0xBD, 0xC3, 0x27, 0x45, 0x5F, 
+0x3C, 0xC7, 0x5B, 0x94, 0xF6, 0x14, 0x1E, 0x26, 
+0xBF, 0x36, 0xA0, 0xC7, 0x1F, 0xD7, 0x03, 0xC1, 
+0x02, 0x6C, 0x38, 0xEF, 0xCA, 0x9C, 0xF8, 0xB1, 
+0x36, 0xDB, 0xE5, 0x95, 0xE5, 0xCD, 0x7F, 0xD2, 
+0x7E, 0xB6, 0xF5, 0xE9, 0x60, 0x5F, 0x97, 0x9A, 
+0xCB, 0x1F, 0xB9, 0xC8, 0xA3, 0x72, 0x32, 0xF2, 
+0x1E, 0x9B, 0xB2, 0x7C, 0xBF, 0x0C, 0x25, 0x6F, 
+0xF1, 0x68, 0x1C, 0x24, 0x6C, 0x44, 0x90, 0xB3, 
+0x1D, 0x56, 0xA4, 0x11, 0x3B, 0x26, 0x9E, 0xFE, 
+0x4D, 0x45, 0x73, 0x9B, 0x02, 0xA2, 0xB6, 0x1E, 
+0x57, 0x30, 0xD6, 0x8C, 0x56, 0x02, 0x90, 0x7A, 
+0x77, 0xFD, 0x83, 0x96, 0xAA, 0x62, 0xCA, 0x28, 
+0x7F, 0x17, 0x3F, 0xFD, 0x42, 0x42, 0xC4, 0x15, 
+0xD7, 0x16, 0x9B, 0x9E, 0x22, 0xBB, 0xC6, 0x3B, 
+0xDE, 0xAE, 0x94, 0xCE, 0x61, 0xD3, 0xB1, 0x01, 
+0x2E, 0xE3, 0x3D, 0x5C, 0xDD, 0x60, 0x1E, 0xF1, 
+0x0C, 0xD1, 0xF8, 0xC7, 0x9E, 0x1E, 0x18, 0x92, 
+0x9D, 0x5B, 0x3A, 0xDA, 0xE2, 0x1A, 0xA0, 0x90, 
+0x38, 0x67, 0x03, 0xE9, 0x45, 0x59, 0xDA, 0x3B, 
+0xC7, 0xF7, 0x40, 0x5D, 0xBF, 0x3F, 0xBF, 0xA9, 
+0xD3, 0x3C, 0x96, 0x89, 0x44, 0x1D, 0x7D, 0x57, 
+0x82, 0xB5, 0xBB, 0x84, 0xB4, 0x27, 0xFE, 0x49, 
+0x1C, 0xB0, 0xB5, 0xB8, 0x84, 0x9E, 
+
+0xE1, 0xCB, 0x16, 0x9B, 0xAA, 0x60, 0x7D, 0x9F, 
+0x0F, 0x31, 0x2C, 0xDC, 0x92, 0xED, 0xFE, 0xD0, 
+0x0D, 0xCA, 0x0B, 0xC4, 0x86, 0x5B, 0x4D, 0x29, 
+0x67, 0x8B, 0x79, 0x72, 0x91, 0x76, 0x98, 0xB7, 
+0xAE, 0x3C, 0xE0, 0xAD, 0x37, 0x1F, 0xE1, 0xE5, 
+0xB7, 0x86, 0x38, 0xF0, 0x5D, 0xE3, 0x7B, 0xC0, 
+0x63, 0x6C, 0x9C, 0xBA, 0x3F, 0xB0, 0x10, 0x25, 
+0x5D, 0x05, 0x42, 0xAB, 0x43, 0xBF, 0xC2, 0xB9, 
+0xD6, 0xA9, 0x65, 0xC4, 0x7A, 0x30, 0x1D, 0xD1, 
+0xF0, 0xA7, 0xB7, 0x7B, 0x09, 0x88, 0xBE, 0x1F, 
+0xE0, 0x76, 0x2C, 0x44, 0x25, 0x54, 0x89, 0xD4, 
+0xE3, 0xF5, 0x54, 0x16, 0xBD, 0x1E, 0x5E, 0xC0, 
+0x20, 0xAF, 0x9E, 0x5E, 0xCA, 0x54, 0x91, 0x9D, 
+0x1B, 0x6F, 0xBB, 0x1D, 0xE1, 0xEC, 0xE8, 0xDF, 
+0x17, 0xE7, 0x45, 0x62, 0x7E, 0x62, 0xD8, 0x87, 
+0xEB, 0x60, 0x16, 0x55, 0xB8, 0x4F, 0x12, 0x43, 
+0x81, 0x5D, 0xE5, 0xE3, 0x9B, 0x4E, 0x37, 0xA9, 
+0x97, 0x96, 0x09, 0x7D, 0x9E, 0xAA, 0x7A, 0xFF, 
+0xC3, 0xC2, 0xAF, 0x51, 0x78, 0x19, 0xE3, 0x54, 
+0x2A, 0x5B, 0xFD, 0xE4, 0xAE, 0xF1, 0x6A, 0x47, 
+0xC2, 0xFD, 0x5B, 0x6C, 0x3F, 0x87, 0xBE, 0xE4, 
+0x8A, 0x2C, 0x14, 0x37, 0x34, 0x16, 0x48, 0xF5, 
+0xCA, 0xDC, 0x8C, 0xFD, 0x04, 0x74, 0xFE, 0xF8, 
+0x75, 0xE5, 0x53, 0x86, 0x6D, 0xAA, 0x77, 0xF8, 
+0x14, 0x8C, 0x97, 0x67, 0x3E, 0x7C, 0x2F, 0x72, 
+0x9A, 0x64, 0xE3, 0xB1, 0xC7, 0xAD, 0xD0, 0xD0, 
+0xE9, 0x9F, 0x7D, 0xF3, 0x2D, 0x26, 0x79, 0xC9, 
+0x7B, 0x9E, 0x91, 0x21, 0x67, 0xEF, 0xFE, 0x2F, 
+0xC8, 0xD1, 0xC0, 0xD1, 0x0C, 0x86, 0x76, 
+
+0xD9, 0x16, 0x71, 0x68, 0x1C, 0x9B, 0x5B, 0xFF, 
+0x94, 0x5D, 0x1B, 0x6C, 0x78, 0xB2, 0xEA, 0x7F, 
+0x04, 0xB6, 0x89, 0x32, 0x18, 0xCE, 0xA9, 0xCC, 
+0xCC, 0xFD, 0x3D, 0x45, 0x61, 0x67, 0xA3, 0xDC, 
+0xF5, 0x82, 0x6A, 0x49, 0x02, 0xB2, 0xBC, 0x47, 
+0x1B, 0x57, 0xC4, 0x7C, 0x95, 0xCF, 0x9D, 0xF8, 
+0x39, 0xFE, 0x2D, 0xD1, 0xA4, 0x73, 0x83, 0xF0, 
+0xAB, 0x7C, 0xBA, 0x09, 0x54, 0x3A, 0x09, 0xC7, 
+0x94, 0x25, 0x98, 0x95, 0x2E, 0x8C, 0xD5, 0xFF, 
+0xBC, 0x6C, 0xD8, 0xF2, 0x4F, 0x41, 0x7B, 0x9C, 
+0x7F, 0x07, 0x2F, 0x01, 0x57, 0x01, 0x96, 0x5F, 
+0xDE, 0x4B, 0x69, 0x0D, 0x65, 0x4E, 0x1C, 0x9F, 
+0xD6, 0xDC, 0xF1, 0xD9, 0x1A, 0x12, 0xC1, 0x3E, 
+0x88, 0x91, 0xCC, 0xC4, 0x70, 0x61, 0xB9, 0x9A, 
+0xA8, 0x81, 0x86, 0xF4, 0x6D, 0x99, 0xDC, 0xA5, 
+0x00, 0x65, 0x92, 0xF8, 0x45, 0xE8, 0x76, 0x96, 
+0xF5, 0x08, 0x94, 0xC5, 0x2F, 0x5B, 0x33, 0x48, 
+0xAF, 0xDC, 0x6A, 0x08, 0x44, 0xEA, 0xF5, 0xC7, 
+0x45, 0x78, 0xC6, 0x12, 0x34, 0x07, 0x18, 0x9A, 
+0xE0, 0xAA, 0x63, 0x12, 0xC8, 0x4E, 0x8E, 0x74, 
+0x61, 0xFA, 0xD5, 0xB8, 0xF2, 0xA6, 0xD4, 0xFD, 
+0xA3, 0x7C, 0x7B, 0x48, 0x5A, 0xA4, 0x4A, 0x75, 
+0xAC, 0xF8, 0x7C, 0x0E, 0xB2, 0xA8, 0xD4, 0x43, 
+0x71, 0x54, 0x29, 0x7E, 0x92, 0x67, 0x36, 0xA8, 
+0x8E, 0xD6, 0x85, 0x7C, 0x9D, 0x94, 0xF2, 0x33, 
+0xDD, 0x04, 0x3F, 0x7A, 0xDF, 0x76, 0x21, 0x3D, 
+0x0C, 0x71, 0x2B, 0xB5, 0xBB, 0x00, 0x21, 0xC9, 
+0x39, 0x22, 0xC1, 0x12, 0xBE, 0xD5, 0xE1, 0xD6, 
+0xE3, 0xDD, 0xE3, 0x4F, 0xEA, 0x6B, 0xF1, 0xD9, 
+
+0xD9, 0x96, 0x5E, 0x93, 0x40, 0xB7, 0x8C, 0xEC, 
+0xB5, 0x5A, 0x18, 0x36, 0xA7, 0x82, 0xAE, 0x19, 
+0xF9, 0xB4, 0xC2, 0x1E, 0x4C, 0x12, 0xEF, 0x15, 
+0xD6, 0x97, 0x74, 0x7A, 0x6E, 0x26, 0x1A, 0x76, 
+0xF2, 0xED, 0xE0, 0x13, 0x07, 0x6C, 0x79, 0xB0, 
+0x8D, 0x2A, 0x34, 0x44, 0xF3, 0x6E, 0xF0, 0xCD, 
+0x96, 0x1A, 0x17, 0xF8, 0x3C, 0xC8, 0xFB, 0x61, 
+0xF3, 0xD2, 0x51, 0x14, 0x55, 0x58, 0xC5, 0x55, 
+0xB4, 0x2B, 0xE5, 0x7C, 0x8B, 0xF1, 0x2F, 0xA4, 
+0x34, 0x56, 0x2F, 0x50, 0x43, 0xEF, 0xB3, 0xC5, 
+0x25, 0x7E, 0x85, 0xA4, 0x0D, 0x72, 0x2B, 0x81, 
+0x8E, 0x7B, 0x73, 0x79, 0x30, 0xAF, 0x9C, 0x3B, 
+0x0C, 0xBC, 0xD0, 0x2B, 0xDC, 0xA7, 0x68, 0x1D, 
+0x9E, 0xFE, 0xA9, 0x48, 0xD0, 0xD0, 0xBB, 0xE6, 
+0x31, 0x7F, 0x39, 0xF9, 0xC9, 0x6F, 0x45, 0x31, 
+0x96, 0x87, 0x01, 0x75, 0x81, 0xCB, 0x90, 0x52, 
+0x5F, 0x83, 0x6A, 0xE9, 0xC8, 0x68, 0x41, 0xF5, 
+0x58, 0x8C, 0xE8, 0xFE, 0xCB, 0x51, 0xBD, 0x23, 
+0x54, 0xA9, 0x87, 0xDF, 0xA4, 0x4F, 0x1D, 0x69, 
+0x33, 0x6D, 0x40, 0xF0, 0x48, 0x6D, 0x3C, 0x8F, 
+0xD6, 0x0D, 0x42, 0x8D, 0x62, 0x8D, 0x19, 0x63, 
+0x26, 0xE4, 0xCF, 0x0C, 0xEF, 0xD3, 0xBC, 0xF8, 
+0x1A, 0xC2, 0xE7, 0x94, 0x6C, 0x19, 0x0C, 0xDC, 
+0x6E, 0x28, 0x2E, 0x21, 0x62, 0x85, 0xCD, 0x03, 
+0x8C, 0x2C, 0xA5, 0x95, 0x80, 0x77, 0xE1, 0xD9, 
+0x61, 0x8F, 0xE5, 0x1D, 0xFF, 0x55, 0x7D, 0x88, 
+0x74, 0x0F, 0x18, 0xAD, 0xEB, 0xDD, 0xC3, 0xFC, 
+0x55, 0xEB, 0xAB, 0x41, 0xB1, 0x1D, 0x48, 0xED, 
+0x5D, 0x61, 0xF3, 0x9C, 0x44, 0xB2, 0xCF, 0x4F, 
+0x0E, 
+
+0x56, 0x8F, 0x85, 0x83, 0xD6, 0xFE, 0x37, 0xD2, 
+0x4D, 0xC9, 0xC1, 0xBF, 0x86, 0x4C, 0xF7, 0x12, 
+0x2D, 0x1D, 0xA7, 0xD3, 0x54, 0x26, 0x9D, 0xBE, 
+0xEA, 0xB3, 0xA3, 0xFF, 0x5A, 0x29, 0xAC, 0x6F, 
+0xEC, 0x0E, 0x50, 0x3F, 0xBF, 0x9E, 0x92, 0x70, 
+0xA4, 0x95, 0x43, 0xEC, 0x2C, 0x19, 0x03, 0x3C, 
+0xB8, 0x15, 0x9E, 0x37, 0x03, 0x24, 0x4A, 0xD2, 
+0x24, 0xB0, 0xAD, 0xEC, 0x74, 0x57, 0x70, 0x20, 
+0x07, 0xDA, 0xFA, 0xD8, 0x31, 0xEE, 0xDE, 0xA9, 
+0x5C, 0x65, 0x11, 0x6F, 0xE0, 0x28, 0x5F, 0x2E, 
+0xA2, 0x8B, 0x1A, 0xEA, 0x30, 0xA3, 0xF1, 0x48, 
+0xCC, 0x1E, 0xB8, 0x84, 0x47, 0x96, 0x20, 0x82, 
+0xE9, 0x68, 0x3E, 0xF9, 0xD3, 0xD0, 0x66, 0x4B, 
+0x7A, 0x91, 0x29, 0x37, 0xC7, 0x1F, 0x9D, 0x26, 
+0x14, 0x53, 0x6A, 0x05, 0x2F, 0x68, 0xB0, 0x81, 
+0x34, 0xD4, 0x19, 0xF8, 0xE9, 0x25, 0x33, 0x49, 
+0x55, 0xC0, 0x10, 0x52, 0x0B, 0xD0, 0xC0, 0x62, 
+0x58, 0x4A, 0x6C, 0x21, 0x85, 0xD9, 0xB2, 0x62, 
+0x88, 0x4E, 0x01, 0xA3, 0x5C, 0x59, 0xC5, 0xE9, 
+0xDD, 0x77, 0x78, 0xDD, 0xEC, 0x11, 0xB1, 0xD4, 
+0x18, 0xC4, 0xEF, 0xCC, 0x37, 0xA5, 0x72, 0x08, 
+0xA2, 0x94, 0xA8, 0x4C, 0x6A, 0xB6, 0x69, 0x7A, 
+0x93, 0x28, 0x88, 0x14, 0x5D, 0x99, 0x28, 0x27, 
+0x1B, 0x4D, 0x89, 0x6B, 0xCD, 0x19, 0x53, 0xFA, 
+0x1E, 0x17, 0x44, 0x3F, 0x03, 0x32, 0xCF, 0xD3, 
+0x4F, 0x9B, 0xAB, 0x55, 0xAD, 0x59, 0xAE, 0xD0, 
+0x65, 0x35, 0x2E, 0x38, 0xBD, 0x97, 0xC7, 0xBA, 
+0xA6, 0x35, 0x83, 0x22, 0x40, 0xCE, 0x3B, 0x0C, 
+0x8F, 0x86, 0x5A, 0xCF, 0xD4, 0x28, 0xEA, 0xCA, 
+0x8C, 0xAF, 
+
+0x95, 0x20, 0x9F, 0xB1, 0x32, 0x9E, 0xDF, 0x89, 
+0xA4, 0xF2, 0xD8, 0x3F, 0xA4, 0xA5, 0xE2, 0x57, 
+0xCB, 0x2F, 0xE4, 0xD2, 0xAD, 0xD2, 0x36, 0x6F, 
+0x47, 0x73, 0xAC, 0xD8, 0x3E, 0xDD, 0x4F, 0x0D, 
+0x42, 0xA2, 0xE4, 0xBD, 0x29, 0x59, 0x4B, 0x0F, 
+0xD5, 0xAC, 0x66, 0xB3, 0xD5, 0x11, 0xF6, 0xE8, 
+0x23, 0x4E, 0x17, 0x6C, 0xD1, 0x3F, 0xEE, 0x14, 
+0x6C, 0xA7, 0xA2, 0x15, 0x9F, 0xE3, 0xF4, 0x5A, 
+0x7C, 0x0B, 0x6A, 0xA0, 0x76, 0xD5, 0xD2, 0x9D, 
+0x7A, 0xCD, 0x35, 0x8E, 0x8C, 0x2F, 0x51, 0xDE, 
+0x3C, 0x0C, 0xB8, 0x8D, 0x64, 0xA0, 0x01, 0x09, 
+0xC4, 0x7A, 0x0D, 0xFD, 0x99, 0x08, 0x73, 0x84, 
+0x83, 0x4E, 0xD4, 0xE3, 0x09, 0x26, 0x11, 0xDB, 
+0x9C, 0x8B, 0xEF, 0x37, 0xDF, 0x13, 0x68, 0xB6, 
+0xE4, 0xDB, 0xAC, 0x26, 0x0D, 0x68, 0x17, 0x3A, 
+0x27, 0x52, 0x2D, 0x48, 0x62, 0x54, 0x64, 0x19, 
+0x1F, 0xC3, 0xC5, 0x85, 0x22, 0x0E, 0x9C, 0x0F, 
+0x60, 0xE3, 0x18, 0xE1, 0xC8, 0x91, 0x3C, 0xA4, 
+0x1A, 0x67, 0x69, 0x1E, 0xD0, 0x1E, 0x4F, 0x98, 
+0x8F, 0x7C, 0x47, 0xE0, 0x36, 0xDF, 0x00, 0xC0, 
+0xED, 0x20, 0x12, 0x43, 0x08, 0xFD, 0xF7, 0x29, 
+0xFF, 0x4E, 0x34, 0x89, 0x19, 0x4E, 0x0B, 0xE6, 
+0x55, 0xBD, 0x8C, 0x4B, 0x16, 0x52, 0x8B, 0x64, 
+0x75, 0x41, 0x1F, 0x96, 0x83, 0x28, 0xBD, 0xE1, 
+0xE2, 0xF4, 0x03, 0xEC, 0xDE, 0x16, 0xDB, 0x97, 
+0x89, 0xCB, 0xE6, 0x3C, 0x57, 0x8D, 0xCD, 0x22, 
+0x11, 0x38, 0xD1, 0xED, 0x41, 0x7F, 0xCD, 0x42, 
+0x26, 0xFD, 0x2F, 0xE3, 0x10, 0xBF, 0x7B, 0xDA, 
+0x38, 0x35, 0xA8, 0x9A, 0x78, 0xC3, 0xF7, 0x1F, 
+0x15, 0x88, 0x1E, 
+
+0xA7, 0xC4, 0xED, 0x28, 0x28, 0x45, 0x3F, 0x32, 
+0xD2, 0xEF, 0xFE, 0x12, 0x4C, 0xF5, 0x33, 0xF4, 
+0x78, 0xF1, 0x2A, 0xE4, 0x2B, 0x1C, 0xCC, 0xD3, 
+0xB7, 0x3C, 0x8B, 0xE9, 0x1D, 0x27, 0x3A, 0x0E, 
+0x52, 0x3F, 0xA7, 0xBB, 0xF2, 0x53, 0x5E, 0x3F, 
+0x6C, 0x8C, 0x96, 0xC3, 0x19, 0x49, 0x0D, 0x90, 
+0x32, 0xBE, 0xBD, 0x48, 0xFE, 0x79, 0x7E, 0xF4, 
+0x90, 0x19, 0x37, 0x56, 0x0D, 0x39, 0x56, 0xC6, 
+0x9B, 0xBA, 0x40, 0xD4, 0xC4, 0x40, 0x69, 0x37, 
+0xC5, 0x63, 0x78, 0xC7, 0x44, 0xAB, 0x56, 0xB8, 
+0x88, 0x21, 0x5E, 0x6A, 0x30, 0xB7, 0xF5, 0x8B, 
+0x28, 0xA4, 0x8B, 0x06, 0xF4, 0x75, 0x73, 0xCC, 
+0xD7, 0x19, 0x5B, 0xA6, 0x1B, 0x6A, 0x3D, 0x5A, 
+0x65, 0xA1, 0x96, 0xE7, 0x75, 0xB1, 0x0C, 0x87, 
+0xDF, 0xB0, 0x13, 0x2F, 0xEE, 0x42, 0x7D, 0x12, 
+0x8D, 0xD9, 0x7F, 0x73, 0x99, 0x03, 0x36, 0x8E, 
+0x52, 0x37, 0x4D, 0x12, 0x41, 0x58, 0x70, 0x3A, 
+0xEF, 0xE6, 0x85, 0x7D, 0x1B, 0x0D, 0x12, 0x97, 
+0xB1, 0x1D, 0x7E, 0xB7, 0xD6, 0x67, 0xE7, 0xA8, 
+0x1A, 0x1D, 0x3C, 0x3B, 0x82, 0xAD, 0x14, 0x38, 
+0xBD, 0x03, 0xE3, 0x0C, 0x1C, 0xE6, 0x60, 0xEA, 
+0x28, 0xB1, 0xB2, 0xF0, 0xEF, 0xDA, 0xC9, 0xF2, 
+0x04, 0x1B, 0xB1, 0x27, 0x0E, 0xCB, 0xD9, 0x31, 
+0x11, 0xE6, 0x92, 0x15, 0x84, 0x27, 0xCB, 0x05, 
+0x3F, 0x56, 0x8E, 0xC6, 0x13, 0x3B, 0x5F, 0x93, 
+0x4C, 0x68, 0x2B, 0xFB, 0xF2, 0x6C, 0xED, 0x2C, 
+0x8E, 0x7B, 0x59, 0x99, 0xFA, 0x91, 0x38, 0x0E, 
+0x32, 0x67, 0xE5, 0x19, 0x89, 0x9D, 0xA8, 0x78, 
+0xD1, 0xFB, 0x3E, 0x87, 0x2E, 0x04, 0x99, 0x43, 
+0x6E, 0xB3, 0x8E, 0x8A, 
+
+0x2E, 0xC7, 0xFB, 0x44, 0xEA, 0xBB, 0xA5, 0xD2, 
+0x88, 0x72, 0xB4, 0xDD, 0x0F, 0xEA, 0x56, 0x8F, 
+0x83, 0x7D, 0x52, 0x8D, 0x4C, 0x22, 0x0E, 0x01, 
+0x19, 0xCF, 0x03, 0x7C, 0x19, 0xD0, 0x08, 0x67, 
+0x6F, 0x6A, 0xCF, 0xC0, 0x72, 0x90, 0x85, 0x6B, 
+0x8E, 0x9B, 0x04, 0xFA, 0xAD, 0xD8, 0xCD, 0x15, 
+0xD4, 0xD0, 0x53, 0xCF, 0xF7, 0xE8, 0x80, 0x51, 
+0x40, 0x1F, 0xB8, 0xC4, 0x22, 0xCC, 0xBE, 0x1F, 
+0x06, 0xB2, 0xAF, 0x31, 0xF6, 0x3C, 0xAB, 0x8D, 
+0x20, 0xAF, 0x6B, 0x3B, 0x1B, 0xFE, 0x12, 0xC1, 
+0xFC, 0x18, 0x77, 0x07, 0xA4, 0x15, 0x54, 0xBC, 
+0x2F, 0xF1, 0x30, 0x38, 0xC9, 0x05, 0x74, 0xF8, 
+0xBE, 0x0B, 0xF0, 0x3D, 0xAF, 0x85, 0xEE, 0xBA, 
+0xF4, 0x78, 0x93, 0x86, 0xA5, 0x94, 0x27, 0x04, 
+0xE4, 0x4A, 0x0C, 0x15, 0x9B, 0x4D, 0x04, 0x51, 
+0x3A, 0x61, 0x08, 0xCF, 0x13, 0x85, 0x17, 0x4C, 
+0x87, 0xFD, 0x30, 0x3F, 0x4D, 0x87, 0x79, 0xD3, 
+0x38, 0x8E, 0x8C, 0x17, 0x5B, 0x2E, 0xD1, 0x93, 
+0x5E, 0xAD, 0xD0, 0xED, 0x21, 0xA7, 0xF8, 0x6E, 
+0xA9, 0xF9, 0xCD, 0xEF, 0x56, 0x40, 0xC9, 0x0F, 
+0x26, 0x51, 0x93, 0x76, 0x55, 0xCA, 0xDB, 0x13, 
+0x0A, 0xD7, 0xDF, 0xD9, 0x41, 0x3D, 0x45, 0xA9, 
+0x52, 0x5A, 0xEC, 0x88, 0xA7, 0xF0, 0xF4, 0xF8, 
+0x85, 0x25, 0x17, 0x16, 0x33, 0xD4, 0xE5, 0xB1, 
+0xE5, 0xA2, 0xF8, 0x03, 0xEC, 0xE0, 0x08, 0xD6, 
+0xE0, 0xF7, 0x6F, 0xF6, 0x5C, 0x27, 0x0F, 0x15, 
+0x5E, 0x68, 0x08, 0xA4, 0xAE, 0x38, 0x3B, 0xBE, 
+0x72, 0x9A, 0x17, 0x79, 0x14, 0xAE, 0x97, 0x30, 
+0x03, 0x4C, 0x4D, 0x0A, 0x87, 0x16, 0xEC, 0x25, 
+0x14, 0x78, 0x65, 0xB1, 0x52, 
+
+0x44, 0xB2, 0x85, 0x01, 0x82, 0x00, 0xCC, 0xE2, 
+0xD4, 0xB2, 0x93, 0x20, 0x2F, 0x1B, 0x6E, 0xAA, 
+0x41, 0xAB, 0xB5, 0xC1, 0x70, 0x15, 0x93, 0x1B, 
+0x20, 0xFE, 0x15, 0xED, 0x72, 0xBA, 0x78, 0x14, 
+0xE8, 0xA2, 0x21, 0x14, 0x51, 0x86, 0x80, 0x5B, 
+0x98, 0xAB, 0x40, 0x21, 0x41, 0x05, 0x00, 0x42, 
+0x64, 0xE4, 0x2C, 0x8F, 0x0B, 0x13, 0x19, 0x64, 
+0xEA, 0x77, 0xF8, 0x12, 0x1F, 0xE3, 0x14, 0xAA, 
+0xFF, 0xEF, 0x58, 0x5A, 0xAC, 0xED, 0xB1, 0x0B, 
+0x75, 0xFE, 0x1F, 0xA9, 0xE6, 0x3B, 0xE1, 0xEB, 
+0xD6, 0xD7, 0x3F, 0x3D, 0x7B, 0x2E, 0x52, 0x5B, 
+0x4D, 0xD9, 0xB4, 0x1D, 0xB8, 0xD2, 0x38, 0xA5, 
+0xCE, 0xDE, 0xCE, 0x46, 0xA1, 0xD5, 0x29, 0x69, 
+0xD1, 0x5B, 0xA9, 0x3F, 0x01, 0x4A, 0x56, 0xFE, 
+0x94, 0xFA, 0xE6, 0xBA, 0x39, 0x2B, 0x82, 0xC4, 
+0x7C, 0x7C, 0x32, 0x3A, 0x13, 0x7D, 0x30, 0x4F, 
+0xE0, 0x95, 0x21, 0x43, 0x98, 0xA7, 0x32, 0xA1, 
+0x43, 0xFE, 0x0B, 0x34, 0xE1, 0x3D, 0xFC, 0x32, 
+0x0F, 0x80, 0x16, 0x5C, 0xE1, 0x52, 0xE8, 0x02, 
+0x1F, 0xCC, 0x7A, 0xAF, 0xF5, 0x25, 0x18, 0x91, 
+0xD6, 0x06, 0x26, 0x01, 0x56, 0xE7, 0x63, 0xF5, 
+0x1A, 0x5C, 0x11, 0x64, 0x39, 0x9C, 0x5E, 0xEC, 
+0x4B, 0x09, 0x26, 0x9B, 0x0A, 0x34, 0xF1, 0xF8, 
+0x65, 0x23, 0x6B, 0xFC, 0xC4, 0x0D, 0x58, 0xBF, 
+0x3E, 0x29, 0x65, 0x13, 0xE4, 0x77, 0xE0, 0xFB, 
+0x44, 0x6E, 0x6B, 0x0C, 0xB1, 0xC5, 0x65, 0x61, 
+0x32, 0x57, 0x49, 0x10, 0x1C, 0x3D, 0xF7, 0x01, 
+0x17, 0xDC, 0x5D, 0xBC, 0x21, 0x96, 0xB8, 0x58, 
+0x24, 0x16, 0xBB, 0xCA, 0x2B, 0x56, 0xF4, 0x25, 
+0xB4, 0x02, 0x8B, 0xFF, 0x40, 0x08, 
+
+0x09, 0xC8, 0x1E, 0xB1, 0xF8, 0x70, 0x8E, 0x77, 
+0xE9, 0x9E, 0x90, 0xC9, 0xA3, 0x69, 0x1F, 0x92, 
+0x08, 0xD1, 0xD3, 0x53, 0x13, 0xA3, 0x33, 0x21, 
+0xD9, 0x9A, 0x7A, 0x77, 0x90, 0xB2, 0xA2, 0x06, 
+0x40, 0xF7, 0x25, 0xBE, 0x71, 0xD1, 0x57, 0x8A, 
+0x35, 0x42, 0x04, 0x67, 0x8F, 0xAE, 0x7B, 0x40, 
+0x2B, 0x99, 0x1F, 0x3E, 0x6E, 0x01, 0x1D, 0xA0, 
+0xFD, 0xC9, 0x45, 0xC6, 0x65, 0x3E, 0x4B, 0x8B, 
+0x1E, 0x39, 0xB5, 0xCA, 0x55, 0x99, 0x91, 0xFB, 
+0x7A, 0xF7, 0x5C, 0x3C, 0xC6, 0x87, 0xB5, 0x69, 
+0x1D, 0x6C, 0xFB, 0xDC, 0xFF, 0x2A, 0x95, 0x99, 
+0xB1, 0x3B, 0x7F, 0x7D, 0x4B, 0x7A, 0xAD, 0xCB, 
+0x61, 0x72, 0x40, 0xE3, 0x8A, 0x02, 0x04, 0xF4, 
+0x49, 0x5E, 0xDC, 0x3F, 0x53, 0x54, 0x43, 0xB5, 
+0xAD, 0xD9, 0x54, 0xB0, 0xF9, 0x1A, 0x18, 0xB3, 
+0x80, 0x74, 0x68, 0xB5, 0xA0, 0x12, 0xC3, 0xE7, 
+0xBC, 0x07, 0x1B, 0xE7, 0x5F, 0xBB, 0xF0, 0x6F, 
+0xBE, 0x2D, 0xE0, 0x3C, 0xD8, 0x98, 0x1B, 0xCA, 
+0x8F, 0x92, 0xD5, 0x28, 0x0D, 0x39, 0xC8, 0xD7, 
+0xA8, 0x09, 0x5C, 0x3D, 0x4D, 0x66, 0x20, 0x63, 
+0xB4, 0x83, 0x93, 0xF9, 0x54, 0x41, 0xFB, 0xD9, 
+0x4A, 0xD2, 0xC1, 0xEF, 0xBD, 0x1D, 0xB2, 0x5A, 
+0xA3, 0x21, 0x7E, 0xF9, 0x1D, 0xB6, 0xDD, 0x19, 
+0x63, 0x12, 0x12, 0xEF, 0xDD, 0xA7, 0x9A, 0xF7, 
+0x3F, 0x87, 0x40, 0x6B, 0x6B, 0x8E, 0x47, 0xB4, 
+0xED, 0xE0, 0x1A, 0x0E, 0x04, 0x2E, 0xFB, 0x63, 
+0xF5, 0xC2, 0x7D, 0x57, 0x53, 0x2F, 0x80, 0xE4, 
+0x18, 0x0F, 0x7A, 0x2B, 0xC8, 0xC4, 0x16, 0xEF, 
+0xE2, 0xF2, 0x6F, 0x8F, 0x10, 0x83, 0x9E, 0xDC, 
+0x96, 0x79, 0x19, 0xA6, 0x9B, 0xC6, 0x11, 
+
+0xC6, 0x10, 0xEF, 0x8A, 0x50, 0x46, 0x68, 0xC6, 
+0x97, 0x40, 0xF0, 0x2C, 0x8F, 0x0B, 0x39, 0x5E, 
+0x37, 0xFB, 0x17, 0xA8, 0x82, 0xAA, 0xCB, 0xBE, 
+0x95, 0xDB, 0xC2, 0xA8, 0xE8, 0x1D, 0x7E, 0x13, 
+0xE1, 0x74, 0x43, 0xF9, 0xB1, 0xC1, 0xF2, 0xF6, 
+0x19, 0xFD, 0x7A, 0xD4, 0xCA, 0x8B, 0xFB, 0x6D, 
+0x39, 0x40, 0x1A, 0x88, 0xE0, 0x57, 0xD1, 0x01, 
+0x44, 0x92, 0xD6, 0x75, 0x41, 0x15, 0x17, 0xF1, 
+0xBE, 0x3C, 0x80, 0xDE, 0x32, 0xB1, 0xD6, 0x82, 
+0x45, 0x9A, 0x9D, 0xFF, 0x76, 0x57, 0x4F, 0x0C, 
+0x9B, 0x9E, 0xBB, 0xC6, 0x6E, 0xCD, 0x36, 0x2C, 
+0xBB, 0x6B, 0x6E, 0xA5, 0xE3, 0xF7, 0x85, 0x28, 
+0x52, 0x13, 0x00, 0x6F, 0x8E, 0x4E, 0x79, 0x27, 
+0xC6, 0xF2, 0xF7, 0x95, 0x21, 0x13, 0xB4, 0x63, 
+0xBC, 0x88, 0x83, 0x3F, 0xAB, 0xAA, 0xA6, 0xC2, 
+0xBD, 0xBB, 0x6C, 0x28, 0x53, 0xCC, 0x53, 0x6C, 
+0x60, 0x8B, 0xE4, 0x34, 0x6E, 0x3D, 0x6F, 0xF8, 
+0x0D, 0xAF, 0xE4, 0x94, 0xFE, 0x6F, 0x94, 0x39, 
+0x48, 0xF8, 0x5F, 0xBF, 0xE4, 0x61, 0xC2, 0xEF, 
+0x01, 0x9D, 0xDF, 0xCC, 0x02, 0xC5, 0x64, 0x4C, 
+0x0F, 0x8B, 0x08, 0xBF, 0x13, 0xD0, 0x3A, 0x2C, 
+0x63, 0xEB, 0x85, 0x7C, 0x22, 0x12, 0xBD, 0x3A, 
+0x61, 0x71, 0x59, 0x3D, 0x0D, 0x98, 0x95, 0xC4, 
+0xA6, 0x42, 0x07, 0xA7, 0x7E, 0xCE, 0x9F, 0xA4, 
+0x1C, 0xB5, 0xE9, 0xED, 0x83, 0xF5, 0xE7, 0x05, 
+0xCF, 0x7D, 0x4D, 0x39, 0xD5, 0xBC, 0xDB, 0xA6, 
+0xD7, 0x5A, 0xA5, 0xD6, 0xE7, 0xBE, 0xA5, 0x51, 
+0x4D, 0x48, 0x4F, 0x8C, 0x83, 0x8B, 0x43, 0x08, 
+0x53, 0xAB, 0x40, 0xD8, 0x05, 0x01, 0x2A, 0x39, 
+0x9B, 0x98, 0x6A, 0x26, 0x07, 0xEE, 0xE5, 0x8E, 
+
+0xB5, 0xEC, 0x55, 0x63, 0x7F, 0xED, 0xF9, 0x70, 
+0xB6, 0x55, 0x54, 0x97, 0x0F, 0xBE, 0xD5, 0xF8, 
+0xCE, 0x23, 0xCD, 0x9C, 0xFF, 0xAC, 0x3E, 0x12, 
+0x49, 0xA4, 0xCB, 0xE1, 0xFF, 0x30, 0xAF, 0xB6, 
+0x6A, 0x19, 0xAD, 0x35, 0x03, 0x4B, 0xAB, 0xF2, 
+0x6C, 0xEE, 0xC0, 0xF7, 0x5D, 0xF8, 0x66, 0x97, 
+0xDF, 0xD4, 0x59, 0x10, 0x43, 0x94, 0x79, 0x75, 
+0xCC, 0xDD, 0xA6, 0xB4, 0xF5, 0x7B, 0x68, 0x70, 
+0x99, 0x6E, 0x95, 0x93, 0xDE, 0x25, 0x33, 0xD0, 
+0x9E, 0x34, 0x0F, 0x4B, 0x29, 0xAF, 0x8B, 0xE5, 
+0x8E, 0xB1, 0x31, 0x90, 0x72, 0x82, 0x96, 0x21, 
+0xAE, 0xF7, 0x42, 0xE5, 0xCF, 0x3F, 0x20, 0x54, 
+0x89, 0x5E, 0x58, 0x5F, 0x42, 0x68, 0x11, 0xCF, 
+0x2B, 0xBC, 0xE5, 0x1D, 0x56, 0x1E, 0x6C, 0x91, 
+0xD9, 0xFC, 0x22, 0xEB, 0x65, 0x71, 0x72, 0x19, 
+0x20, 0xA4, 0x8C, 0x03, 0xDA, 0x4D, 0x22, 0x47, 
+0xC9, 0xBA, 0x2C, 0x4D, 0x1E, 0x0D, 0x8D, 0x41, 
+0xF0, 0xA1, 0x9C, 0x87, 0xD3, 0x50, 0x96, 0x9E, 
+0xC3, 0x0D, 0x36, 0xBA, 0x47, 0xBB, 0x36, 0x82, 
+0x3A, 0x47, 0x29, 0x24, 0x95, 0xD0, 0x8E, 0x6F, 
+0x49, 0x1A, 0x95, 0x15, 0x21, 0x22, 0x2E, 0x55, 
+0x96, 0xA0, 0x62, 0x5B, 0xCD, 0x76, 0x1C, 0x69, 
+0x6D, 0x18, 0x54, 0xDD, 0x12, 0xD5, 0x8A, 0x4D, 
+0xBA, 0x34, 0x41, 0xED, 0x62, 0x7D, 0xFB, 0xAB, 
+0x2F, 0x27, 0xD7, 0xD8, 0xA6, 0x01, 0x46, 0x6D, 
+0x3A, 0x65, 0x8E, 0x51, 0x24, 0x6D, 0x9C, 0xE0, 
+0xF0, 0xDC, 0x4E, 0xF6, 0x6F, 0x42, 0x5D, 0x32, 
+0xC7, 0xF5, 0xDA, 0x8A, 0x6D, 0x61, 0xFF, 0xB0, 
+0xD2, 0x2B, 0xE5, 0x28, 0xF2, 0x60, 0x4F, 0xB5, 
+0xB1, 0x21, 0x73, 0x93, 0xD7, 0xE0, 0xD5, 0xFA, 
+0x0A, 
+
+0x90, 0xAC, 0xCC, 0xF9, 0x0D, 0x74, 0xE7, 0x8D, 
+0x25, 0xF2, 0x9B, 0x5C, 0x29, 0x2F, 0x8E, 0x75, 
+0x3A, 0x59, 0x5F, 0x20, 0x4B, 0x28, 0x50, 0x8A, 
+0x06, 0x9A, 0x2D, 0xCC, 0xC7, 0xD8, 0xDD, 0xE3, 
+0x7D, 0x3F, 0xC9, 0x21, 0x57, 0x63, 0xF7, 0x1D, 
+0xF3, 0x42, 0xD6, 0x57, 0x64, 0x90, 0x5D, 0xFB, 
+0x50, 0x38, 0xB4, 0xCD, 0xB8, 0x3D, 0xBD, 0x10, 
+0xE9, 0xF1, 0xB2, 0xFF, 0x41, 0xB9, 0xFB, 0x6C, 
+0xE2, 0xAB, 0xFA, 0x5B, 0x0B, 0xE6, 0x42, 0x92, 
+0x09, 0x35, 0x8A, 0x57, 0x49, 0xFA, 0x85, 0x7C, 
+0x3F, 0xEE, 0x5D, 0x6F, 0xE0, 0x7C, 0xE3, 0xDC, 
+0x9A, 0x05, 0x2E, 0x38, 0x29, 0x8C, 0xBD, 0x42, 
+0x27, 0x33, 0xE9, 0x87, 0x8E, 0x44, 0x8A, 0x1E, 
+0x55, 0xBB, 0x32, 0x31, 0x37, 0x82, 0xE7, 0xFD, 
+0x96, 0x7B, 0x7C, 0x28, 0x93, 0x0E, 0xB5, 0x4B, 
+0x92, 0xFA, 0xE6, 0xB0, 0x9A, 0x6B, 0xBB, 0x4A, 
+0xFC, 0x80, 0x9B, 0xDD, 0x2F, 0x5E, 0x1C, 0x13, 
+0x68, 0xEB, 0x15, 0x6B, 0x53, 0x11, 0x46, 0xDE, 
+0xF7, 0x14, 0x3D, 0xC2, 0x31, 0xFB, 0xC4, 0x3C, 
+0x79, 0xD5, 0x8B, 0x4F, 0x73, 0xB2, 0x22, 0xBD, 
+0xE2, 0x67, 0xBC, 0x37, 0xFB, 0x87, 0xAB, 0x39, 
+0x6E, 0xBA, 0x07, 0xCB, 0x75, 0xE2, 0x8B, 0xCA, 
+0x1B, 0xB0, 0x18, 0x02, 0x47, 0x7E, 0x87, 0x89, 
+0x15, 0x32, 0x0C, 0xEE, 0x65, 0xF6, 0xD3, 0x92, 
+0x2F, 0x88, 0xD4, 0xDF, 0x4B, 0xFC, 0x5F, 0x59, 
+0x8F, 0xDF, 0xE7, 0x65, 0x6B, 0x99, 0x35, 0x0A, 
+0x3C, 0x9F, 0xD5, 0xF4, 0x80, 0xE4, 0x0E, 0x61, 
+0x22, 0x32, 0x41, 0x61, 0x7A, 0x77, 0xAD, 0xA6, 
+0x4C, 0x3F, 0x24, 0xBF, 0xB4, 0x00, 0x91, 0x8C, 
+0x47, 0x01, 0x03, 0x97, 0x23, 0xEE, 0x2B, 0xB2, 
+0x42, 0xA0, 
+
+0xF1, 0x6B, 0xAD, 0x0D, 0xF6, 0x3B, 0xE4, 0x84, 
+0x97, 0xAE, 0x06, 0xBE, 0x5F, 0x11, 0xB2, 0xB8, 
+0xF5, 0x9E, 0x48, 0xBF, 0x04, 0x94, 0xF5, 0x07, 
+0xF0, 0x9A, 0x1E, 0xA3, 0x78, 0x39, 0x0E, 0x8C, 
+0xF7, 0xFA, 0x66, 0xEC, 0x39, 0x17, 0x2A, 0xB3, 
+0xBA, 0x37, 0x90, 0x21, 0xFA, 0xAC, 0x36, 0x00, 
+0xE5, 0x68, 0x9F, 0x87, 0xAD, 0xBE, 0x29, 0x06, 
+0x7E, 0x17, 0xA8, 0x84, 0x0D, 0xC5, 0x85, 0xDB, 
+0xBB, 0x6A, 0x88, 0x97, 0x84, 0xD7, 0x13, 0x63, 
+0x32, 0xF2, 0x92, 0xEC, 0x13, 0xCF, 0xBC, 0xB0, 
+0x07, 0x75, 0x08, 0xD9, 0xC1, 0xAB, 0x93, 0xD6, 
+0x31, 0x56, 0x0C, 0x53, 0xE0, 0xD4, 0x30, 0x97, 
+0xAA, 0x14, 0x64, 0x6D, 0x20, 0x79, 0xD0, 0x5E, 
+0x6E, 0xBC, 0xF2, 0x18, 0xE1, 0xC4, 0x1F, 0x27, 
+0x0F, 0x22, 0x68, 0x80, 0x66, 0x28, 0x77, 0xA0, 
+0x18, 0xAF, 0x12, 0x80, 0x35, 0x3C, 0xE2, 0xF6, 
+0x7D, 0xC7, 0x7D, 0xE0, 0x64, 0x3B, 0x13, 0xFA, 
+0x07, 0x09, 0xE1, 0x38, 0xC8, 0x89, 0x0C, 0x0C, 
+0x1B, 0x9C, 0xA1, 0x99, 0xCF, 0xB5, 0xFA, 0xCD, 
+0xA5, 0xFC, 0xCC, 0x8C, 0x08, 0x24, 0xB3, 0x1A, 
+0x19, 0x97, 0x7E, 0x3B, 0xAE, 0xB4, 0x08, 0x9B, 
+0xFE, 0xDB, 0xEC, 0xFD, 0xE2, 0xE9, 0x6F, 0x1D, 
+0xC9, 0xB4, 0xDE, 0x7C, 0x4D, 0x02, 0x69, 0xBA, 
+0xE7, 0x9F, 0xAA, 0xAB, 0xE5, 0x80, 0x85, 0x7B, 
+0xB3, 0xAF, 0xAE, 0xE4, 0x42, 0x46, 0x46, 0xF3, 
+0x0A, 0x78, 0xEB, 0xE1, 0x58, 0x98, 0x53, 0x73, 
+0x90, 0x90, 0x70, 0xC7, 0x5D, 0xBE, 0x08, 0x8B, 
+0xB7, 0xD7, 0x91, 0x2F, 0xD7, 0x22, 0xDF, 0xAB, 
+0xB0, 0x48, 0x7B, 0x77, 0xFF, 0x2B, 0xD4, 0xB5, 
+0xB0, 0x24, 0x89, 0x85, 0xED, 0xFF, 0x88, 0x05, 
+0x17, 0x0E, 0x41, 
+
+0xCB, 0xD7, 0x22, 0x78, 0xA9, 0x3F, 0x75, 0xA8, 
+0x71, 0x44, 0xB4, 0xDD, 0x02, 0x2B, 0x23, 0x33, 
+0x26, 0x56, 0xB5, 0x37, 0x87, 0x74, 0x46, 0x36, 
+0x59, 0xE5, 0x1F, 0x4D, 0xA1, 0xA9, 0x7D, 0x98, 
+0x2A, 0xA7, 0x13, 0x99, 0xDF, 0xFB, 0x9C, 0x26, 
+0xA9, 0x24, 0xB6, 0x5A, 0x1F, 0x64, 0x53, 0x52, 
+0x63, 0x6C, 0x4B, 0xDF, 0xF1, 0x20, 0xA3, 0x9A, 
+0xF6, 0xDD, 0x33, 0x38, 0x63, 0x7F, 0xE0, 0x1E, 
+0x5F, 0x0E, 0x43, 0xF4, 0x0D, 0xBA, 0x72, 0x7F, 
+0xCD, 0xDC, 0x5A, 0xD4, 0x24, 0xEC, 0x80, 0xD3, 
+0x4A, 0x5C, 0x14, 0xAA, 0x85, 0xC8, 0xE1, 0xB2, 
+0x94, 0xE4, 0x9C, 0x1E, 0x46, 0xCA, 0x3C, 0x76, 
+0xEC, 0xE2, 0x25, 0x4B, 0x81, 0xDC, 0xEC, 0x16, 
+0xC3, 0x98, 0xC4, 0xF2, 0xB9, 0x6B, 0x88, 0x19, 
+0x4E, 0x1B, 0x46, 0x32, 0xC6, 0x60, 0x27, 0x6C, 
+0xB1, 0xC1, 0x85, 0xED, 0xEE, 0x61, 0x94, 0x89, 
+0x8F, 0xCD, 0x35, 0xA7, 0x52, 0xA2, 0x91, 0x0F, 
+0x58, 0xEF, 0xE7, 0x92, 0x63, 0xF5, 0xAB, 0xB5, 
+0xDE, 0xA4, 0x4F, 0x8A, 0x66, 0xA7, 0x01, 0x4B, 
+0x57, 0x9F, 0x3D, 0xC1, 0x90, 0x28, 0x0F, 0xC8, 
+0x3E, 0xFE, 0x31, 0x9C, 0xCC, 0xEF, 0xD2, 0x4A, 
+0xD2, 0xB7, 0xC8, 0x89, 0x78, 0xE7, 0x8D, 0x40, 
+0xC5, 0x1F, 0x06, 0xB7, 0xF0, 0xAB, 0xBE, 0xD8, 
+0xCB, 0xF6, 0x40, 0x99, 0x65, 0xD1, 0x34, 0x74, 
+0xFF, 0xC6, 0x4D, 0xC1, 0xA6, 0x69, 0xF6, 0x7E, 
+0x91, 0x53, 0xFD, 0x6C, 0xD5, 0x64, 0xF4, 0x54, 
+0x1B, 0x00, 0x1B, 0x54, 0xFF, 0xB0, 0xFE, 0x39, 
+0xE2, 0x1F, 0xB0, 0x26, 0xCB, 0xDC, 0xD6, 0xAA, 
+0xBF, 0x2D, 0xC1, 0x41, 0x38, 0xE0, 0xBA, 0xDA, 
+0xFE, 0xBA, 0xDF, 0x36, 0x39, 0x0F, 0x32, 0x31, 
+0x96, 0x63, 0x8F, 0x77, 
+
+0xEF, 0xA5, 0x1E, 0x9D, 0x46, 0xCA, 0x36, 0x25, 
+0xA1, 0x4A, 0x9D, 0xAD, 0xAC, 0x3E, 0xE9, 0x5B, 
+0x11, 0xCB, 0x40, 0x84, 0xCA, 0x3B, 0x39, 0xFD, 
+0x9F, 0xB9, 0x57, 0x62, 0x46, 0x23, 0x93, 0xAF, 
+0xDE, 0xFC, 0xB6, 0x0B, 0x54, 0x29, 0xD7, 0x64, 
+0x8B, 0x44, 0x91, 0xE2, 0x54, 0xEB, 0x68, 0x52, 
+0x10, 0xB6, 0x0A, 0xE8, 0x1E, 0x84, 0x84, 0xDC, 
+0x79, 0x5B, 0x91, 0x11, 0x9B, 0xEF, 0x83, 0x1D, 
+0x27, 0x80, 0x22, 0x34, 0x15, 0x20, 0x67, 0x76, 
+0x16, 0x32, 0x3C, 0x4D, 0x39, 0x9D, 0x63, 0x06, 
+0xB4, 0x2B, 0x64, 0x94, 0xBA, 0xE8, 0x4A, 0xE4, 
+0x5B, 0x51, 0x2C, 0xF0, 0x86, 0x2F, 0x7A, 0x0D, 
+0x69, 0x94, 0x75, 0x78, 0x89, 0x20, 0xB5, 0x86, 
+0xBD, 0xF0, 0xEB, 0x29, 0x2F, 0x79, 0x30, 0x17, 
+0x7D, 0x54, 0x46, 0xC3, 0x8A, 0xC9, 0x4C, 0x4A, 
+0x14, 0x34, 0x4C, 0xD5, 0x7E, 0x19, 0x82, 0x50, 
+0x80, 0x13, 0x8C, 0x6D, 0xDC, 0x6E, 0x75, 0x63, 
+0xA5, 0x67, 0x36, 0x4A, 0xEB, 0x8B, 0xDC, 0xBA, 
+0xC2, 0xDC, 0xE7, 0x16, 0x2F, 0xAD, 0xA1, 0x3D, 
+0xB7, 0xFD, 0xED, 0xA4, 0xFD, 0xFD, 0xE3, 0xA0, 
+0xF8, 0x22, 0xDB, 0xA7, 0x34, 0x99, 0xDA, 0xAB, 
+0x79, 0xF5, 0xB3, 0x6F, 0x90, 0x43, 0x01, 0x59, 
+0xE6, 0x2F, 0xEC, 0x44, 0x46, 0xA3, 0x0A, 0x15, 
+0x9C, 0xA0, 0x1C, 0x15, 0x00, 0x1D, 0x39, 0x50, 
+0x65, 0x42, 0x1C, 0x4D, 0x46, 0x99, 0xA2, 0xAA, 
+0xD5, 0x47, 0x82, 0x31, 0x12, 0xD8, 0xC3, 0xE1, 
+0x57, 0x6D, 0x94, 0xFC, 0x49, 0x34, 0xDD, 0x6F, 
+0xF5, 0x84, 0xEC, 0x9C, 0xE2, 0x72, 0x42, 0xED, 
+0xFB, 0x12, 0x17, 0x28, 0x70, 0x02, 0x38, 0xBD, 
+0x9A, 0x5D, 0x0E, 0x30, 0x5C, 0xD1, 0xA0, 0xCB, 
+0x39, 0x11, 0x52, 0x1B, 0x9E, 
+
+0x58, 0x2A, 0x1D, 0x0D, 0x5D, 0x6D, 0xB4, 0xAF, 
+0x12, 0x43, 0x01, 0x51, 0xDB, 0x47, 0x63, 0x3E, 
+0xEC, 0x83, 0x2F, 0x0A, 0x15, 0xE1, 0xB3, 0xFD, 
+0xAF, 0x54, 0xA5, 0x4F, 0x7D, 0x5B, 0x3B, 0xBF, 
+0xBD, 0x7F, 0x00, 0xD4, 0x57, 0x47, 0x97, 0x8F, 
+0x42, 0x9C, 0x44, 0x9E, 0x91, 0xBF, 0x5D, 0xA5, 
+0xCB, 0x18, 0x7F, 0xBF, 0x57, 0xBE, 0xA0, 0x15, 
+0x16, 0x2C, 0xED, 0x65, 0xD6, 0x4C, 0x1F, 0x61, 
+0x27, 0xB5, 0x38, 0xD4, 0xF5, 0x3F, 0xD3, 0xCD, 
+0x1C, 0xE5, 0xAC, 0x52, 0x4E, 0xD4, 0x90, 0x12, 
+0xA6, 0xB4, 0x7C, 0xAE, 0xA1, 0x26, 0x5B, 0x74, 
+0xD3, 0x03, 0x0F, 0x93, 0xAE, 0x37, 0xC2, 0xDF, 
+0x2C, 0x99, 0xF1, 0x4D, 0x0F, 0xCC, 0x60, 0x18, 
+0xEE, 0x0A, 0x50, 0x89, 0x4A, 0xCE, 0x27, 0xF4, 
+0xF7, 0xD1, 0x6F, 0x24, 0xC7, 0x74, 0x53, 0x70, 
+0x89, 0x89, 0x74, 0x26, 0x77, 0x82, 0x3D, 0x0F, 
+0x79, 0x05, 0xC6, 0xA6, 0xC4, 0x56, 0xA0, 0xAF, 
+0x01, 0x15, 0x28, 0x54, 0x18, 0xF2, 0x49, 0xF5, 
+0xF2, 0x9B, 0x7F, 0x9C, 0xC2, 0x16, 0xCD, 0x4B, 
+0xF5, 0xE4, 0xF1, 0x74, 0x54, 0x7A, 0x26, 0xEB, 
+0x4F, 0x3D, 0xC4, 0xEB, 0xA8, 0x90, 0x7C, 0xEB, 
+0xE7, 0x6B, 0x1F, 0x2D, 0x28, 0x96, 0xEA, 0x0A, 
+0xD6, 0x34, 0x33, 0x00, 0xD5, 0x2A, 0xAC, 0x10, 
+0xDF, 0xA8, 0x49, 0x25, 0x29, 0x6F, 0x9B, 0xC6, 
+0x51, 0x54, 0xCB, 0x45, 0x70, 0xF6, 0xB6, 0xBE, 
+0x05, 0x88, 0x23, 0x6C, 0x4B, 0x44, 0x27, 0x18, 
+0xEF, 0xA8, 0x17, 0x92, 0x22, 0x4E, 0x1D, 0xBA, 
+0xAD, 0x44, 0x15, 0x49, 0x61, 0x81, 0x38, 0x6A, 
+0xAB, 0xE9, 0x68, 0x33, 0x84, 0x9F, 0x17, 0x1E, 
+0xB3, 0x83, 0x20, 0x11, 0xE2, 0xEB, 0x7E, 0x49, 
+0x1B, 0xCD, 0x34, 0x96, 0xF2, 0xF1, 
+
+0x4E, 0x20, 0x4F, 0x6C, 0xF2, 0x2D, 0xB4, 0x81, 
+0x74, 0xFC, 0x9A, 0x4B, 0x80, 0x16, 0x94, 0x79, 
+0xBF, 0xF8, 0x26, 0xDC, 0x73, 0xCA, 0x77, 0x2A, 
+0x81, 0x85, 0xD7, 0xC5, 0x04, 0xB2, 0xE2, 0xA8, 
+0x26, 0xCD, 0x18, 0x2E, 0x54, 0x5D, 0x25, 0xDA, 
+0x58, 0xE8, 0x71, 0xCD, 0x77, 0x74, 0xBE, 0x85, 
+0xE8, 0xD7, 0x74, 0x60, 0x2E, 0x89, 0xEE, 0xBA, 
+0x73, 0xAE, 0x38, 0xF8, 0x17, 0x45, 0x29, 0xA0, 
+0x12, 0x9E, 0x3D, 0xE2, 0x43, 0x59, 0x5A, 0x59, 
+0xE4, 0x12, 0x17, 0x9E, 0x5A, 0x82, 0xAE, 0xD7, 
+0xA2, 0x3B, 0xEB, 0x35, 0x1D, 0xA7, 0x45, 0xCF, 
+0xA4, 0xCE, 0xD6, 0x31, 0x44, 0xF9, 0x98, 0xA6, 
+0x6E, 0x55, 0x4B, 0x0D, 0x80, 0x65, 0x25, 0xB5, 
+0xA6, 0x1C, 0x83, 0xF2, 0x2D, 0x30, 0x8E, 0x60, 
+0x69, 0x07, 0x08, 0x59, 0x5B, 0xBD, 0x32, 0x1B, 
+0x20, 0xBA, 0x08, 0x63, 0xB6, 0x4C, 0xD0, 0xF1, 
+0xF5, 0xBA, 0x40, 0xC0, 0x40, 0x53, 0xC1, 0xAF, 
+0xF6, 0xA8, 0x9F, 0xBF, 0xCE, 0xD4, 0x34, 0xCF, 
+0x57, 0xCD, 0x3B, 0x94, 0x8F, 0xEE, 0xBC, 0x01, 
+0x80, 0xBD, 0xFD, 0x64, 0x7E, 0x26, 0xE3, 0x8A, 
+0xFC, 0x38, 0xE9, 0x02, 0xE4, 0xE7, 0x4E, 0x34, 
+0x6B, 0xE7, 0xF8, 0x28, 0x60, 0x08, 0xF5, 0xB8, 
+0x07, 0x5B, 0xAC, 0x93, 0x66, 0xDF, 0x48, 0x17, 
+0x8C, 0xC0, 0x95, 0x79, 0xEB, 0xB4, 0xDB, 0xFD, 
+0x6F, 0x18, 0xB2, 0xE0, 0xA1, 0x4C, 0x43, 0xC7, 
+0x55, 0xD1, 0xC9, 0x02, 0xF4, 0x06, 0x22, 0x34, 
+0xF3, 0x24, 0x66, 0xB4, 0xFB, 0x65, 0x5C, 0x2C, 
+0x06, 0x9F, 0x47, 0xA0, 0x3D, 0xC7, 0x53, 0x2F, 
+0xAC, 0x95, 0x0F, 0xD9, 0xA3, 0x7D, 0xD6, 0xAD, 
+0xF0, 0x1F, 0x86, 0x54, 0x2C, 0x76, 0xAC, 0xDD, 
+0x20, 0xE5, 0xB2, 0xA2, 0xC5, 0x23, 0xCC, 
+
+0x16, 0xAD, 0x82, 0x2F, 0x1A, 0x6E, 0x72, 0x5E, 
+0xD7, 0x26, 0x58, 0x19, 0x04, 0x8E, 0x67, 0xF3, 
+0x78, 0x92, 0xBF, 0xFB, 0x04, 0xF7, 0xB7, 0xD8, 
+0xFE, 0x70, 0x22, 0xFD, 0x2E, 0x8C, 0x8D, 0x70, 
+0xF1, 0x82, 0xA0, 0x39, 0xB2, 0x02, 0x30, 0x6E, 
+0xF1, 0x67, 0xAD, 0xB0, 0xA8, 0x64, 0xB4, 0xFB, 
+0x12, 0xD6, 0x97, 0x70, 0xBF, 0x08, 0xB4, 0x69, 
+0x16, 0xD2, 0xE7, 0x49, 0xD1, 0xDC, 0x4F, 0xB9, 
+0xDE, 0xC1, 0x23, 0x2B, 0xBF, 0x90, 0xB1, 0xD0, 
+0xA3, 0x0E, 0xFE, 0x2A, 0x93, 0xFF, 0x5B, 0xBF, 
+0x8D, 0xCD, 0x06, 0xDE, 0x0F, 0x59, 0x86, 0x44, 
+0x04, 0x2B, 0xA6, 0x45, 0x64, 0xA1, 0x30, 0x63, 
+0xA9, 0x08, 0x83, 0x87, 0x7D, 0x03, 0xA2, 0x83, 
+0x7C, 0x0A, 0xA8, 0xE9, 0x9E, 0x55, 0xF6, 0xA4, 
+0xEA, 0x94, 0x70, 0x08, 0x40, 0xEE, 0x09, 0x71, 
+0xDB, 0xC6, 0xFE, 0x02, 0xAD, 0x49, 0x61, 0x56, 
+0x0C, 0xB6, 0x1D, 0xC1, 0x15, 0xFA, 0xE9, 0x8C, 
+0xC4, 0xC5, 0x86, 0x11, 0xE7, 0x16, 0x32, 0xE4, 
+0x6F, 0xD2, 0xFD, 0xF3, 0x45, 0x6D, 0x1E, 0x18, 
+0x01, 0x4A, 0x0A, 0x42, 0xB9, 0x61, 0x60, 0x11, 
+0xE2, 0x0A, 0x9F, 0x91, 0x81, 0x93, 0xE8, 0x0C, 
+0x08, 0x59, 0x85, 0x92, 0xB2, 0x42, 0x71, 0x38, 
+0xA2, 0xFF, 0x6E, 0x30, 0x2F, 0x37, 0x6D, 0xC4, 
+0xDE, 0xCB, 0x66, 0xA4, 0x47, 0x00, 0x11, 0x05, 
+0xF5, 0xB5, 0xF3, 0x0A, 0x8B, 0x6A, 0x42, 0xDC, 
+0xE1, 0x1D, 0x5E, 0xFF, 0xD4, 0x63, 0xDA, 0xC7, 
+0xB3, 0x23, 0xC1, 0x78, 0x0A, 0x9E, 0x90, 0xA4, 
+0x1A, 0x1D, 0xAE, 0x66, 0x30, 0x83, 0xB0, 0xA9, 
+0xC9, 0xA1, 0x8F, 0x52, 0xAB, 0x35, 0xEC, 0x0E, 
+0x51, 0x89, 0x8D, 0x6B, 0x58, 0x54, 0x14, 0x0E, 
+0x7B, 0x5C, 0x76, 0x29, 0xB1, 0x6A, 0xA9, 0x14, 
+
+0xA3, 0x27, 0xEB, 0x0D, 0xBB, 0xB7, 0x74, 0x39, 
+0x3A, 0x78, 0xBF, 0xEC, 0x52, 0x09, 0xCD, 0x7F, 
+0xD7, 0x97, 0xD3, 0x9A, 0xDE, 0x7A, 0xB6, 0x2A, 
+0xEB, 0x3F, 0x8D, 0xF4, 0x20, 0x7F, 0x1D, 0x49, 
+0x1A, 0x9B, 0x6F, 0xFD, 0x03, 0x91, 0x5E, 0x82, 
+0xB7, 0xAC, 0x8D, 0xBA, 0xC7, 0x9A, 0x83, 0x5D, 
+0xCA, 0x1E, 0x7F, 0x8B, 0x22, 0x32, 0x1C, 0x7A, 
+0x6C, 0x03, 0x21, 0x97, 0x73, 0xD1, 0x16, 0xD9, 
+0x23, 0x9E, 0x16, 0x73, 0x54, 0xBF, 0x07, 0x23, 
+0xF9, 0x17, 0x6B, 0xF7, 0x6D, 0x50, 0x9C, 0x79, 
+0x86, 0x0A, 0xF6, 0xBC, 0x41, 0xE9, 0x41, 0x54, 
+0x69, 0x07, 0x84, 0xD2, 0xEF, 0xDC, 0xFE, 0x47, 
+0x41, 0xD5, 0x55, 0x78, 0x58, 0x68, 0xFE, 0x60, 
+0xC0, 0x65, 0x56, 0xE2, 0x5D, 0x1F, 0x58, 0x59, 
+0xE5, 0xE2, 0x57, 0x8E, 0xFE, 0xE2, 0xA3, 0xB2, 
+0xE8, 0x72, 0x5F, 0x17, 0xEA, 0x52, 0x14, 0x46, 
+0xDB, 0x7A, 0x9B, 0x4C, 0xAD, 0xF0, 0x89, 0xEE, 
+0x08, 0x11, 0x5A, 0x6D, 0xA5, 0x18, 0xE1, 0xBF, 
+0x36, 0xF4, 0x4F, 0xC6, 0xBE, 0x8B, 0xB3, 0x37, 
+0xAF, 0xC8, 0x0A, 0x6A, 0x90, 0xFC, 0xF0, 0x25, 
+0xE1, 0xD6, 0x0A, 0x72, 0x21, 0x8E, 0x7B, 0xDA, 
+0x11, 0x93, 0x34, 0x57, 0xCF, 0xEF, 0x2D, 0x5C, 
+0xD9, 0xFE, 0xCF, 0x25, 0x3E, 0x76, 0x74, 0x6A, 
+0xB5, 0x57, 0xDD, 0x65, 0xBD, 0xA4, 0xA7, 0x2F, 
+0x2F, 0x97, 0x91, 0xA8, 0x83, 0x79, 0x7B, 0xE0, 
+0x2A, 0xCA, 0x19, 0x7F, 0x9C, 0x5D, 0xA1, 0x21, 
+0xFF, 0x49, 0xC1, 0x49, 0xD7, 0x7F, 0xF4, 0x57, 
+0x9B, 0xD1, 0x16, 0x80, 0x9A, 0xC5, 0xB7, 0xBD, 
+0xE8, 0x55, 0x0A, 0x91, 0x60, 0x92, 0x40, 0xAE, 
+0x70, 0x9E, 0x01, 0x81, 0xB4, 0x45, 0xBE, 0x6E, 
+0x84, 0xEA, 0x4E, 0xE4, 0xFF, 0x92, 0xA3, 0x01, 
+0xAD, 
+
+0xFF, 0xC9, 0x46, 0xD5, 0x86, 0xBA, 0x5B, 0x24, 
+0xC1, 0x72, 0x2D, 0xFF, 0xEF, 0xE1, 0xA8, 0x18, 
+0x20, 0x36, 0xE2, 0x57, 0x22, 0xD3, 0x83, 0xA1, 
+0xB6, 0x07, 0x69, 0x02, 0x40, 0xD1, 0x67, 0xF1, 
+0xEA, 0xA4, 0x86, 0x23, 0x19, 0xC4, 0x1A, 0x5E, 
+0x4B, 0x3D, 0xD1, 0x24, 0x96, 0x9B, 0x52, 0x9D, 
+0x21, 0xCB, 0x67, 0xB6, 0x51, 0x00, 0xE2, 0x00, 
+0xAA, 0xEA, 0xD9, 0x2B, 0xE5, 0x8D, 0xE5, 0x6C, 
+0xC3, 0x4E, 0x10, 0x76, 0x84, 0xF4, 0xFC, 0x14, 
+0x70, 0x1B, 0x99, 0x60, 0x57, 0xB7, 0xC3, 0xFB, 
+0x0A, 0x38, 0xD1, 0xB8, 0x10, 0xCE, 0xDD, 0xA1, 
+0x53, 0x10, 0xF0, 0x3B, 0xF6, 0x3B, 0xB9, 0xB1, 
+0x4D, 0x6C, 0x4A, 0x3B, 0x12, 0x2C, 0x2C, 0xB3, 
+0xEB, 0x5F, 0x7F, 0x9E, 0xFA, 0xEA, 0x2D, 0x1A, 
+0x8F, 0xE2, 0x2D, 0x5B, 0xEF, 0x83, 0x85, 0x6E, 
+0xE0, 0xA8, 0x48, 0x55, 0xC1, 0x6E, 0x5F, 0xD7, 
+0x3B, 0x06, 0x9F, 0x24, 0x2E, 0xF7, 0x39, 0x7E, 
+0xB1, 0xD4, 0xE7, 0x31, 0x57, 0x4C, 0x42, 0x5C, 
+0x9D, 0x49, 0xBC, 0xDE, 0x2B, 0xCF, 0x99, 0x41, 
+0xA1, 0xEA, 0x84, 0xE9, 0x2B, 0xFF, 0x2C, 0x67, 
+0x40, 0x1F, 0x28, 0x7A, 0x54, 0x46, 0x68, 0xD8, 
+0x2A, 0xB8, 0xC2, 0x0C, 0x81, 0x59, 0x29, 0x23, 
+0x07, 0x5F, 0xA2, 0x1A, 0x9B, 0x9E, 0x32, 0x7D, 
+0x6A, 0xB6, 0xEF, 0xF2, 0x91, 0xF2, 0x01, 0xEC, 
+0x4D, 0xD3, 0x84, 0x85, 0xF6, 0xC7, 0xAF, 0xB6, 
+0x95, 0xFC, 0xF9, 0x5E, 0x8C, 0xF3, 0x32, 0x22, 
+0x6E, 0x1B, 0xFD, 0x3D, 0x5A, 0x47, 0xE7, 0xB5, 
+0x6C, 0xB5, 0x85, 0xB1, 0xFA, 0x2A, 0x4B, 0x07, 
+0xB8, 0x23, 0x03, 0x4B, 0xC7, 0x26, 0x0F, 0x3F, 
+0xFD, 0xED, 0x75, 0x2D, 0x6C, 0xEB, 0x9E, 0x36, 
+0x8E, 0x35, 0xCA, 0x47, 0x4E, 0x06, 0x50, 0x7D, 
+0x60, 0x50, 
+
+0x8F, 0xD7, 0xEE, 0x0C, 0x3E, 0x0C, 0x94, 0x53, 
+0x80, 0x7E, 0x68, 0x5A, 0x95, 0xD1, 0x59, 0x9E, 
+0x94, 0x1A, 0x17, 0xEE, 0x27, 0xE3, 0x97, 0xA7, 
+0x76, 0xE4, 0xBB, 0xC7, 0xAD, 0x74, 0x6F, 0x62, 
+0xEC, 0x43, 0x49, 0xE4, 0x65, 0x4C, 0xC4, 0x88, 
+0x78, 0xC9, 0x6A, 0xD6, 0x5A, 0xEF, 0x14, 0x45, 
+0x85, 0x07, 0x0F, 0x08, 0x67, 0x22, 0x77, 0x19, 
+0x01, 0x3C, 0x22, 0x52, 0x8C, 0x1A, 0x13, 0x20, 
+0xE1, 0x91, 0x50, 0xAF, 0x03, 0xE8, 0x2F, 0xDC, 
+0xDF, 0x8D, 0x18, 0xA1, 0x04, 0x3A, 0xDB, 0x2D, 
+0x1A, 0x24, 0xDD, 0xC5, 0xFB, 0x8E, 0x38, 0x5F, 
+0x21, 0xFC, 0xDD, 0xE6, 0x23, 0xC7, 0x25, 0x8D, 
+0x2B, 0xA5, 0x59, 0xBA, 0x06, 0xC0, 0x8C, 0x1A, 
+0x96, 0xF3, 0xD5, 0x4C, 0x86, 0x90, 0xA2, 0x8F, 
+0x36, 0xED, 0xD3, 0xDA, 0x12, 0xD1, 0xF4, 0xF0, 
+0xF8, 0x19, 0xE6, 0x5A, 0xEE, 0x34, 0x1C, 0x5F, 
+0xA7, 0xA2, 0xC2, 0xB9, 0xBA, 0xF8, 0x8A, 0x0C, 
+0xA8, 0x3F, 0xCB, 0xAF, 0x15, 0xBF, 0x97, 0xDF, 
+0x11, 0xA1, 0x91, 0x81, 0x65, 0xA5, 0xED, 0x30, 
+0x21, 0xA5, 0x54, 0x09, 0xDB, 0xB6, 0xAE, 0x74, 
+0x29, 0x61, 0xB7, 0xCA, 0x91, 0x86, 0xD5, 0x9F, 
+0x7A, 0xA8, 0x33, 0xE5, 0xDA, 0x01, 0x18, 0x97, 
+0x88, 0x3F, 0xCA, 0xAC, 0x12, 0xE7, 0x5F, 0x69, 
+0x09, 0xD6, 0xBA, 0xA8, 0x06, 0x01, 0xA7, 0x81, 
+0x26, 0xF8, 0x63, 0xC8, 0xF7, 0x25, 0x44, 0x0E, 
+0x1A, 0x4F, 0xB0, 0xEC, 0x20, 0x54, 0xE3, 0x31, 
+0xF6, 0x83, 0xD9, 0x90, 0xFC, 0xE8, 0xF5, 0x64, 
+0xCE, 0x1A, 0xF4, 0xA2, 0xBE, 0x82, 0x72, 0x31, 
+0xE6, 0x81, 0xFA, 0xDB, 0xDE, 0x58, 0xB0, 0x3E, 
+0x71, 0xF2, 0xF4, 0x25, 0xCA, 0x8A, 0x3F, 0xE1, 
+0xC5, 0x6B, 0x74, 0x7D, 0x0B, 0xFF, 0x1B, 0x70, 
+0xE2, 0x6C, 0x49, 
+
+0x54, 0x02, 0x23, 0xF5, 0x2C, 0xF6, 0x9F, 0x4B, 
+0x43, 0xB8, 0xED, 0x37, 0x35, 0xA7, 0x60, 0x7A, 
+0x42, 0x4C, 0xD3, 0xA5, 0x5C, 0xD5, 0x1A, 0x3E, 
+0xA4, 0xBD, 0x6C, 0xCC, 0x95, 0xC7, 0xD2, 0x6A, 
+0x58, 0x42, 0x63, 0x29, 0xC9, 0x53, 0xDB, 0xB8, 
+0xD1, 0x05, 0xF3, 0xDD, 0x3C, 0x33, 0x0A, 0xFE, 
+0xD1, 0x37, 0xFD, 0xD1, 0xF5, 0x24, 0x7D, 0xA8, 
+0x81, 0x00, 0x56, 0x39, 0xF3, 0x3E, 0xCE, 0xB0, 
+0x80, 0x86, 0x76, 0xAD, 0x65, 0xA3, 0x92, 0x9E, 
+0x40, 0x6F, 0x7A, 0xD1, 0x8D, 0x0F, 0x50, 0xF7, 
+0x42, 0xFE, 0x27, 0x28, 0x2E, 0x37, 0x89, 0x40, 
+0xE4, 0xBA, 0xF2, 0x85, 0xF6, 0xFA, 0x3B, 0x61, 
+0x6B, 0xFA, 0x5F, 0x01, 0xC1, 0xB8, 0xE8, 0xF7, 
+0x8D, 0x19, 0xA9, 0xA0, 0xDF, 0xAE, 0xC4, 0x77, 
+0x55, 0x3A, 0xB8, 0xAA, 0x1A, 0x05, 0xB0, 0xB8, 
+0xA7, 0x8B, 0xC9, 0xB0, 0x40, 0xA2, 0x63, 0x02, 
+0xE9, 0x96, 0x78, 0xC4, 0xB1, 0xF8, 0x89, 0xAB, 
+0xE3, 0xB2, 0x1E, 0x4C, 0x79, 0x18, 0x1A, 0x0D, 
+0x4A, 0x24, 0x9A, 0xA8, 0x9E, 0x24, 0x9B, 0x0B, 
+0x0F, 0x4D, 0x83, 0x03, 0x3F, 0xDA, 0xB3, 0xFA, 
+0xC7, 0x35, 0x11, 0x32, 0x7C, 0x07, 0x87, 0x79, 
+0x35, 0xDA, 0x88, 0xB4, 0x6B, 0xBF, 0x77, 0x22, 
+0xFF, 0xE4, 0xD3, 0x9B, 0xE1, 0xFD, 0x6A, 0x8F, 
+0x70, 0x65, 0x21, 0x16, 0x29, 0xD9, 0x74, 0x42, 
+0xA0, 0x4E, 0x7E, 0xAC, 0x80, 0x83, 0x7C, 0xB8, 
+0xE3, 0x14, 0x18, 0x9A, 0x9C, 0x32, 0x5F, 0xA8, 
+0x99, 0xFD, 0xBE, 0x15, 0x54, 0x90, 0x4F, 0x4E, 
+0x21, 0xA6, 0xD1, 0x81, 0x17, 0x08, 0x39, 0x7A, 
+0x01, 0xD8, 0x95, 0xDD, 0x91, 0x24, 0x1E, 0xC2, 
+0x48, 0x99, 0x15, 0x38, 0xE7, 0x4C, 0x73, 0x47, 
+0x46, 0xB6, 0x5B, 0xBB, 0x7A, 0x02, 0xA4, 0x7B, 
+0x67, 0x3A, 0xDC, 0xC3, 
+
+0xA6, 0x0A, 0x3F, 0xE4, 0xDE, 0xA1, 0x50, 0xFD, 
+0x7F, 0x41, 0x6D, 0x77, 0x64, 0x48, 0x27, 0xCE, 
+0xD2, 0xD9, 0xE7, 0x41, 0x82, 0xB6, 0x8E, 0x49, 
+0x8D, 0x61, 0x77, 0x54, 0xFE, 0x76, 0x2A, 0x51, 
+0x70, 0x11, 0xAF, 0xF2, 0x06, 0x06, 0xA2, 0x0D, 
+0xB3, 0x74, 0xB5, 0xE2, 0xF3, 0x92, 0x57, 0x90, 
+0xC0, 0x62, 0x73, 0x46, 0x72, 0x1A, 0x94, 0xD3, 
+0x20, 0xAB, 0xC5, 0xED, 0x77, 0xF2, 0x1E, 0x25, 
+0xEB, 0x07, 0xA9, 0xE2, 0x08, 0x55, 0xF7, 0x49, 
+0x3A, 0xA1, 0xF7, 0x7E, 0x33, 0xA6, 0xE5, 0x48, 
+0x7E, 0xF3, 0xC4, 0x7A, 0x02, 0x0E, 0x05, 0x3A, 
+0x4C, 0x25, 0x7C, 0x7A, 0xF6, 0xA6, 0xB2, 0x41, 
+0x04, 0xFD, 0x11, 0xA6, 0x4B, 0x80, 0xD3, 0x8F, 
+0xC6, 0xF1, 0x36, 0x8D, 0xAC, 0x90, 0xAB, 0x3A, 
+0x17, 0xA4, 0x2C, 0x21, 0x76, 0xF5, 0x85, 0xF1, 
+0x6A, 0x8D, 0xC4, 0xB7, 0xE6, 0xD6, 0x7F, 0xF8, 
+0x26, 0xD1, 0x71, 0x6D, 0x40, 0x66, 0xEC, 0x7E, 
+0x05, 0x14, 0x2E, 0x20, 0x74, 0x61, 0xD6, 0xAF, 
+0x7E, 0x2D, 0x0E, 0x43, 0xC0, 0x55, 0xE3, 0x63, 
+0x6D, 0x6B, 0xA4, 0x9C, 0xD4, 0x83, 0xC8, 0xD3, 
+0x37, 0x82, 0x3C, 0x5E, 0xF5, 0x32, 0x66, 0x2B, 
+0xAE, 0x1B, 0xEE, 0xAF, 0x19, 0x6D, 0x17, 0x26, 
+0x1F, 0xF2, 0x93, 0xD0, 0x47, 0x31, 0x66, 0x6A, 
+0x10, 0x9F, 0x7F, 0xBC, 0x01, 0x94, 0x73, 0x13, 
+0x84, 0xB2, 0xEA, 0x47, 0x39, 0x14, 0x6C, 0x6E, 
+0xCF, 0x6E, 0x41, 0xF6, 0x22, 0x70, 0x33, 0xFF, 
+0x41, 0xFF, 0x1C, 0x21, 0xB5, 0x73, 0x61, 0x8B, 
+0xD2, 0xB6, 0x47, 0x3A, 0x70, 0xB1, 0xE3, 0x5B, 
+0x07, 0x45, 0x45, 0x9E, 0x95, 0x26, 0x14, 0xDA, 
+0x5F, 0xD8, 0x2C, 0x5A, 0xA0, 0x33, 0x2C, 0x1E, 
+0x01, 0x75, 0xC5, 0x04, 0x8B, 0xEF, 0x65, 0x3E, 
+0xAF, 0xCA, 0x35, 0x49, 0x34, 
+
+0xA9, 0x93, 0x63, 0x63, 0x4E, 0x78, 0x1E, 0x3E, 
+0x37, 0x78, 0x76, 0xC1, 0xD7, 0x95, 0xD0, 0x62, 
+0xC6, 0xDE, 0x7A, 0xD3, 0xD9, 0x89, 0x2D, 0xC2, 
+0xEC, 0x5E, 0x04, 0x85, 0x74, 0xD7, 0x4F, 0x43, 
+0xF3, 0x58, 0x6D, 0x56, 0x1A, 0xFC, 0x2C, 0xA0, 
+0x97, 0xE0, 0xAC, 0xF1, 0xDC, 0x1A, 0x4E, 0x12, 
+0x2A, 0xC5, 0xC9, 0x30, 0xE5, 0x89, 0x1B, 0x15, 
+0xB8, 0x07, 0xE5, 0xFE, 0x6B, 0xCE, 0xC3, 0x7F, 
+0x36, 0xB9, 0xCA, 0x23, 0x6F, 0x0B, 0xD6, 0x3E, 
+0x92, 0x3E, 0x21, 0x93, 0x79, 0xA4, 0xC7, 0x54, 
+0xA6, 0x58, 0x65, 0x6C, 0xA4, 0x0A, 0xE4, 0xC3, 
+0x0D, 0xB8, 0x66, 0xF9, 0x6F, 0x58, 0xEF, 0xC9, 
+0xD5, 0x79, 0x26, 0x09, 0xDF, 0xFA, 0xCD, 0xA7, 
+0xFC, 0x2E, 0x4F, 0x47, 0xA0, 0x45, 0x72, 0xEF, 
+0x33, 0x49, 0x87, 0x96, 0x02, 0x92, 0x02, 0x53, 
+0x1D, 0xB6, 0x6B, 0xB5, 0x86, 0x5A, 0x1F, 0x91, 
+0x10, 0xF0, 0x7D, 0xDB, 0x8A, 0xF1, 0x63, 0xC3, 
+0xFD, 0x7B, 0xE4, 0x69, 0x0F, 0x15, 0x88, 0x18, 
+0x0C, 0x70, 0x76, 0xB3, 0x9C, 0x53, 0x33, 0x9E, 
+0x19, 0x77, 0x9B, 0xA8, 0xC5, 0x51, 0x68, 0x4F, 
+0xDB, 0xF6, 0x9C, 0xFE, 0xD7, 0x48, 0x75, 0x5B, 
+0x32, 0x63, 0x61, 0xB7, 0xF4, 0x12, 0xCF, 0xFC, 
+0xF2, 0x8C, 0x98, 0xFC, 0x93, 0x28, 0xC0, 0x60, 
+0x80, 0x52, 0x39, 0x19, 0x54, 0x8B, 0xDB, 0x91, 
+0xFD, 0xCB, 0xC6, 0xF1, 0xAA, 0xED, 0x47, 0x2C, 
+0x52, 0x9A, 0xB7, 0xD5, 0x59, 0x46, 0x26, 0x1A, 
+0xA5, 0xF1, 0x05, 0x0D, 0x64, 0x8D, 0x82, 0xCE, 
+0x17, 0xC4, 0x19, 0x1F, 0x15, 0xCE, 0x0C, 0x80, 
+0x76, 0xBD, 0x8C, 0x55, 0x95, 0xB2, 0x0D, 0xE7, 
+0x4C, 0x47, 0x69, 0x43, 0x50, 0x9E, 0x7B, 0x53, 
+0x87, 0x20, 0x95, 0xCC, 0x2A, 0x5F, 0xCC, 0xDB, 
+0xE6, 0xE8, 0x9A, 0x44, 0xC5, 0x64, 
+
+0x84, 0x0E, 0x0D, 0x5C, 0xC2, 0x1E, 0x6A, 0x4D, 
+0xD1, 0xEE, 0x64, 0x23, 0x11, 0x9A, 0xCC, 0x67, 
+0x25, 0x37, 0x0F, 0xE9, 0x67, 0x9D, 0x99, 0x31, 
+0x5A, 0xD0, 0x22, 0x91, 0xF6, 0xF3, 0xB5, 0xAB, 
+0x32, 0x5E, 0x2A, 0x29, 0xE2, 0x5A, 0x04, 0x92, 
+0xCC, 0x72, 0x1C, 0x80, 0xCD, 0xD6, 0x37, 0xAE, 
+0xB9, 0x0D, 0x14, 0x86, 0x0C, 0x6E, 0xE9, 0xA4, 
+0x42, 0x28, 0xB7, 0xE2, 0xDA, 0xC9, 0x0A, 0x82, 
+0x1D, 0xD3, 0x58, 0x23, 0xB9, 0x53, 0x63, 0xA9, 
+0x40, 0xEE, 0xDD, 0x0E, 0xAB, 0x45, 0x3B, 0xEC, 
+0x2E, 0x7B, 0x74, 0x2B, 0xA9, 0xD5, 0x8B, 0xC4, 
+0xFB, 0x6D, 0xFF, 0x48, 0x94, 0x07, 0x91, 0xDE, 
+0x73, 0x07, 0x8C, 0x68, 0x56, 0xE1, 0x47, 0x6F, 
+0x55, 0x46, 0x6A, 0x3A, 0x21, 0xD2, 0xB9, 0x0E, 
+0x91, 0x63, 0xD0, 0xBE, 0x7A, 0x10, 0xDE, 0x52, 
+0x1E, 0x1B, 0x67, 0x69, 0x36, 0xCA, 0x7A, 0x69, 
+0x19, 0x11, 0x32, 0x23, 0x2C, 0x3B, 0x43, 0x0C, 
+0xDE, 0x3A, 0x7C, 0xA0, 0x50, 0x2F, 0x6E, 0xF3, 
+0xFB, 0x09, 0x6E, 0x1D, 0x31, 0xA8, 0xA8, 0xB9, 
+0xD9, 0xA7, 0xD3, 0xA7, 0x7D, 0x0B, 0x56, 0xFE, 
+0x12, 0xD9, 0x9C, 0x0F, 0x5B, 0xEC, 0xE3, 0x0D, 
+0x14, 0xFE, 0xFC, 0x65, 0x18, 0x1B, 0x3D, 0x1C, 
+0x42, 0x6A, 0x24, 0xB5, 0x8B, 0x19, 0xB2, 0xF3, 
+0xC2, 0x78, 0xD9, 0xE6, 0x00, 0x8B, 0xEE, 0xC0, 
+0x41, 0xA1, 0xC5, 0x9E, 0x29, 0x81, 0x8A, 0x84, 
+0x1A, 0x68, 0xDA, 0xC8, 0xC1, 0x90, 0x23, 0xF7, 
+0xA2, 0xAB, 0xB3, 0x19, 0x38, 0x51, 0xDA, 0xD1, 
+0x4C, 0x36, 0x40, 0xBE, 0xA2, 0xD4, 0xEA, 0x6A, 
+0x8D, 0x60, 0xD8, 0x27, 0x91, 0xF0, 0x0B, 0x38, 
+0x43, 0xD1, 0x59, 0x11, 0x15, 0x97, 0x73, 0x0D, 
+0x5D, 0x4E, 0xA2, 0x93, 0x8E, 0x15, 0x61, 0x8C, 
+0x2B, 0xB3, 0x12, 0xFC, 0x71, 0x53, 0x71, 
+
+0x8B, 0x1A, 0xAC, 0x5E, 0x58, 0x62, 0xE0, 0x99, 
+0x3A, 0xF6, 0x2B, 0x23, 0x95, 0x1E, 0xE0, 0xDB, 
+0x59, 0xEC, 0x1E, 0xE2, 0x28, 0xC5, 0xEE, 0x74, 
+0xE8, 0xC9, 0x2C, 0x3B, 0xA6, 0x8B, 0x2B, 0xDB, 
+0x1C, 0x7B, 0x9D, 0xAF, 0x95, 0xBD, 0xF2, 0x6D, 
+0xEE, 0xCA, 0xF7, 0x69, 0xA3, 0x61, 0x67, 0x92, 
+0xB0, 0x04, 0xAA, 0xB1, 0xE1, 0x15, 0x67, 0x80, 
+0xCC, 0xAD, 0xFD, 0x37, 0x51, 0x70, 0xEE, 0x15, 
+0x69, 0x01, 0x46, 0x18, 0x51, 0x01, 0xE3, 0x89, 
+0x72, 0x3D, 0xC0, 0xC7, 0x33, 0xB5, 0xF5, 0x5D, 
+0xC2, 0x39, 0xF4, 0xBC, 0x96, 0x28, 0x16, 0xFF, 
+0x33, 0x45, 0x29, 0xDD, 0x8A, 0x42, 0x5C, 0x84, 
+0x31, 0xB1, 0x67, 0xBD, 0x55, 0x40, 0x52, 0x60, 
+0x23, 0xE1, 0xA4, 0x18, 0xF8, 0xA5, 0x99, 0x21, 
+0x28, 0x49, 0xB6, 0xAC, 0x63, 0x97, 0x97, 0x0B, 
+0xCF, 0x1F, 0x74, 0xA8, 0x13, 0x71, 0xA2, 0x83, 
+0x80, 0xBA, 0x94, 0x65, 0x58, 0x17, 0xD7, 0x01, 
+0x3B, 0xBE, 0xDE, 0xAC, 0x82, 0x3E, 0x9C, 0xBD, 
+0xF1, 0xB4, 0x57, 0x62, 0xC4, 0x1E, 0x43, 0x5B, 
+0x14, 0xF6, 0x15, 0x47, 0x3D, 0x79, 0xA2, 0x50, 
+0x47, 0x6F, 0xC8, 0x7B, 0x04, 0xDA, 0x45, 0x3D, 
+0x5A, 0xCD, 0x40, 0x1B, 0x1B, 0xD6, 0x7C, 0xAC, 
+0xD2, 0xFF, 0x84, 0x20, 0x1E, 0xF6, 0xE7, 0xFE, 
+0xD9, 0x50, 0xB4, 0x93, 0x07, 0xDF, 0x0A, 0x39, 
+0xB1, 0xA7, 0x5F, 0xDF, 0x78, 0xA4, 0x9C, 0xFD, 
+0x47, 0x97, 0x22, 0x4F, 0x61, 0x9D, 0x63, 0x2D, 
+0xF0, 0x87, 0x70, 0xD5, 0xD0, 0xB3, 0xB5, 0x63, 
+0x88, 0x10, 0x30, 0xF2, 0x71, 0x19, 0x37, 0x0C, 
+0xAC, 0xC6, 0x89, 0x10, 0xDF, 0x99, 0x56, 0xCD, 
+0x11, 0x3F, 0x35, 0xCE, 0x60, 0x79, 0x7E, 0x07, 
+0x74, 0x0B, 0x51, 0xB9, 0x22, 0x82, 0x65, 0xF0, 
+0x70, 0x46, 0xDE, 0xDB, 0xF7, 0x7F, 0xB4, 0x64, 
+
+0xB4, 0xC9, 0x03, 0x96, 0x98, 0x27, 0xF8, 0xDA, 
+0xB5, 0x35, 0x8F, 0x88, 0x4F, 0x1D, 0x11, 0xBE, 
+0xA2, 0x84, 0x56, 0xE2, 0x3F, 0x39, 0xB3, 0x62, 
+0x5B, 0x84, 0x01, 0x71, 0xE8, 0x96, 0xFB, 0x74, 
+0xCA, 0xFC, 0xFC, 0x82, 0x66, 0x49, 0x36, 0xDE, 
+0x72, 0x15, 0x1C, 0x64, 0x0E, 0x3A, 0x2A, 0x0D, 
+0x7F, 0x48, 0x23, 0x50, 0x3E, 0xBB, 0x90, 0xFA, 
+0x09, 0xAD, 0x72, 0xD9, 0x07, 0x04, 0x02, 0xC3, 
+0x70, 0xF8, 0x3E, 0xC8, 0x5B, 0xDB, 0x85, 0x3C, 
+0xFF, 0x7D, 0xF9, 0x53, 0xF4, 0x67, 0x5A, 0x55, 
+0x14, 0x5C, 0xB0, 0x24, 0x94, 0x32, 0x9D, 0x71, 
+0x94, 0x1D, 0x37, 0xF1, 0x82, 0x26, 0xFD, 0x6F, 
+0xA9, 0x8A, 0xEE, 0x83, 0x12, 0xAD, 0xED, 0xDE, 
+0x94, 0x18, 0xAF, 0x90, 0xF9, 0xCC, 0x57, 0x70, 
+0x13, 0x91, 0xC7, 0xB5, 0x9F, 0xAD, 0x07, 0xD7, 
+0x8F, 0xA9, 0x13, 0x03, 0x9E, 0x9B, 0x7E, 0xFA, 
+0x20, 0x15, 0x1F, 0x9B, 0x6F, 0xA3, 0x63, 0xBE, 
+0xD0, 0x0E, 0x6E, 0x54, 0x60, 0xE2, 0x7D, 0x85, 
+0xAF, 0x3C, 0xCE, 0x8D, 0xC8, 0xD6, 0x61, 0x9F, 
+0x64, 0x98, 0x1A, 0xBF, 0xDA, 0x99, 0x51, 0x7D, 
+0x63, 0x3E, 0xA8, 0xF0, 0xBC, 0xF6, 0x6C, 0x2E, 
+0x1B, 0x53, 0xA1, 0x71, 0x8C, 0xF8, 0x77, 0xEE, 
+0x32, 0xB9, 0x36, 0xBC, 0xA1, 0x2F, 0x37, 0xF9, 
+0x68, 0x2F, 0x4E, 0x68, 0x82, 0x4B, 0x9F, 0x98, 
+0x49, 0x58, 0xD8, 0xB7, 0xF9, 0x51, 0xC9, 0x10, 
+0x11, 0xCC, 0xA4, 0x08, 0xC0, 0xEC, 0x56, 0x8D, 
+0x42, 0xFE, 0x56, 0xF2, 0x63, 0x5C, 0x09, 0x8E, 
+0xC4, 0x2E, 0xE4, 0x85, 0x3D, 0x94, 0x69, 0xD5, 
+0x41, 0x78, 0x26, 0xFE, 0x9C, 0x27, 0x3F, 0x8D, 
+0xB3, 0xE3, 0x07, 0xAA, 0xB8, 0xAA, 0xBB, 0xBF, 
+0x20, 0x6A, 0x4B, 0xDB, 0x0B, 0xBE, 0xD5, 0xD1, 
+0xE0, 0x61, 0x09, 0x4A, 0xC3, 0xF0, 0x29, 0xCC, 
+0x29, 
+
+0xA1, 0x79, 0x8C, 0x00, 0x55, 0x9F, 0x4C, 0xBD, 
+0x6F, 0xD2, 0xDB, 0xA9, 0x89, 0x95, 0x07, 0x46, 
+0x96, 0x62, 0x00, 0xF1, 0xFC, 0x6B, 0x80, 0x09, 
+0x9C, 0xAC, 0xFB, 0xED, 0xE2, 0xF3, 0xBC, 0x2D, 
+0xDD, 0x70, 0xB0, 0x44, 0x03, 0x2B, 0x7E, 0x10, 
+0x84, 0xAE, 0x9C, 0x1C, 0x3B, 0x9C, 0x83, 0xBF, 
+0x7D, 0x81, 0xC1, 0x29, 0x45, 0x43, 0xD3, 0xDB, 
+0x3F, 0x18, 0xC8, 0x0D, 0x7C, 0x4E, 0x5B, 0x4C, 
+0xA5, 0x56, 0xCD, 0xEC, 0xF6, 0x48, 0x1B, 0x6D, 
+0xC5, 0xCE, 0x90, 0x0C, 0x30, 0x2C, 0x0B, 0x3C, 
+0xF5, 0x73, 0x77, 0x07, 0x84, 0x2D, 0x5F, 0x40, 
+0x65, 0x8E, 0x5B, 0xB9, 0x06, 0x72, 0xDB, 0x23, 
+0x6F, 0xDE, 0x2F, 0xE5, 0x87, 0xA3, 0xFA, 0xB2, 
+0x51, 0xA8, 0xF5, 0xE5, 0xE7, 0xB5, 0xA8, 0xA2, 
+0x07, 0xD4, 0xE8, 0x7F, 0x45, 0x68, 0x88, 0x7F, 
+0xF7, 0x19, 0x3A, 0x17, 0x06, 0xC1, 0x3E, 0xD6, 
+0xE8, 0x1A, 0x2D, 0x5B, 0x63, 0x7D, 0xD4, 0x49, 
+0x42, 0x0B, 0x82, 0x6C, 0x8E, 0x12, 0x7D, 0x17, 
+0x3C, 0xA5, 0x4D, 0x16, 0x14, 0x97, 0x1F, 0xE7, 
+0x5E, 0x93, 0x3F, 0xE0, 0xAC, 0x9D, 0x5C, 0x92, 
+0x52, 0x7D, 0xEE, 0x28, 0xDB, 0x71, 0x79, 0x97, 
+0x2B, 0x8C, 0x11, 0x19, 0xD1, 0xA5, 0xD8, 0x85, 
+0xEB, 0x51, 0x61, 0xB8, 0x46, 0x37, 0xD6, 0x5B, 
+0x2E, 0x90, 0x3F, 0x7F, 0x1D, 0x72, 0x1E, 0xDC, 
+0xB4, 0x98, 0xBF, 0x5D, 0x70, 0xE6, 0x23, 0x08, 
+0xA9, 0x18, 0xD3, 0x1E, 0x07, 0x4E, 0x84, 0xBB, 
+0xAF, 0xA2, 0x2E, 0x7F, 0x7D, 0x3A, 0x96, 0x82, 
+0xC9, 0x59, 0x6F, 0x31, 0xE4, 0x95, 0xD6, 0xEE, 
+0xA6, 0xE3, 0x26, 0x39, 0x39, 0x97, 0x63, 0x0D, 
+0xCD, 0x42, 0xE4, 0xFB, 0x20, 0x7E, 0x4A, 0x2C, 
+0x22, 0x0A, 0x30, 0xF4, 0x79, 0x28, 0x47, 0x49, 
+0x1A, 0xD1, 0x0B, 0xB8, 0x1C, 0xE3, 0x23, 0xCE, 
+0xC8, 0x75, 
+
+0xA3, 0x5B, 0xF0, 0x57, 0xFC, 0x07, 0x3D, 0x7E, 
+0xED, 0xD8, 0x96, 0x04, 0x3E, 0xEF, 0x40, 0xF7, 
+0x81, 0x84, 0x99, 0x22, 0x8A, 0x63, 0x0C, 0x19, 
+0x6B, 0xFE, 0x20, 0x0B, 0xD9, 0xE1, 0xBF, 0x82, 
+0xB7, 0x21, 0x25, 0x95, 0x23, 0x1C, 0x9B, 0x11, 
+0x3B, 0xEA, 0xB0, 0x82, 0xDD, 0xD7, 0xE1, 0x6D, 
+0x47, 0xF5, 0x8D, 0x53, 0x74, 0xAE, 0xD4, 0x8B, 
+0x41, 0xD0, 0x1B, 0x06, 0xBC, 0x1C, 0x4D, 0x94, 
+0x90, 0xE1, 0xC3, 0xD6, 0x79, 0x10, 0x48, 0x12, 
+0x3E, 0x51, 0x7F, 0x14, 0x97, 0xBF, 0xF8, 0xBC, 
+0xBD, 0xE0, 0x45, 0x24, 0xEA, 0xB4, 0x0B, 0x49, 
+0xD1, 0x7A, 0x2F, 0x82, 0x40, 0x29, 0x07, 0x02, 
+0xD1, 0x6F, 0x57, 0x6C, 0x4B, 0xE6, 0xAE, 0xE6, 
+0x94, 0xB6, 0xF2, 0x94, 0xB8, 0xBD, 0x7B, 0x10, 
+0x55, 0x02, 0x1C, 0x41, 0xC6, 0x51, 0x52, 0xFD, 
+0x69, 0x61, 0xA2, 0x4D, 0xA4, 0xF3, 0x77, 0xAF, 
+0x02, 0xA1, 0x7E, 0xD0, 0xDB, 0x06, 0x5C, 0x22, 
+0x07, 0x3E, 0xB8, 0x6E, 0x80, 0x99, 0x88, 0xE7, 
+0xE1, 0x07, 0x0C, 0xAE, 0xA5, 0x67, 0x10, 0xD9, 
+0x41, 0x4E, 0xDB, 0xE3, 0x9F, 0x68, 0xFE, 0x4B, 
+0xC4, 0xE8, 0x2E, 0xE2, 0x88, 0x27, 0xDE, 0x59, 
+0x84, 0x5C, 0x64, 0x80, 0xF0, 0xB5, 0xA0, 0xB8, 
+0xE7, 0x0C, 0xC7, 0x9C, 0x27, 0x78, 0x51, 0x21, 
+0x85, 0x1C, 0xFE, 0x04, 0x99, 0x5A, 0x86, 0x2A, 
+0xEF, 0x92, 0xC2, 0x16, 0xB5, 0x19, 0xEF, 0xB5, 
+0x18, 0xCC, 0x9C, 0xFD, 0x53, 0xBD, 0x1E, 0x53, 
+0xD2, 0x19, 0xB0, 0x14, 0x3F, 0xC2, 0x8F, 0x27, 
+0x2A, 0xDD, 0x6C, 0xDE, 0x02, 0x68, 0x97, 0xB9, 
+0xF6, 0x45, 0x1F, 0x33, 0x48, 0x9A, 0x7F, 0x52, 
+0x59, 0x56, 0x0B, 0x85, 0x16, 0x09, 0x1B, 0xC1, 
+0x12, 0xDA, 0xDE, 0x39, 0x2F, 0x38, 0x60, 0x2F, 
+0x31, 0x7F, 0xE9, 0xC0, 0x97, 0xF2, 0x4F, 0x30, 
+0x26, 0xC0, 0x9E, 
+
+0xB7, 0x8D, 0x8F, 0x97, 0xE8, 0xA6, 0xFC, 0x2B, 
+0xD0, 0x0C, 0xB5, 0x08, 0xC7, 0x02, 0xBD, 0x45, 
+0x02, 0xA8, 0x87, 0x91, 0x16, 0xEC, 0xED, 0x44, 
+0xFE, 0xC1, 0x1C, 0x14, 0x3C, 0x32, 0x02, 0x38, 
+0x65, 0xF5, 0xC4, 0xAF, 0x20, 0xCC, 0x88, 0x27, 
+0x7A, 0x13, 0x0E, 0xCF, 0xD7, 0x83, 0xB3, 0x92, 
+0x68, 0x2D, 0x18, 0x8F, 0xBD, 0xB4, 0xE8, 0x42, 
+0xF3, 0x27, 0x1F, 0x4B, 0x31, 0xC9, 0xB2, 0x25, 
+0xB7, 0xF5, 0xAC, 0x77, 0x49, 0xF6, 0x54, 0x4F, 
+0xEA, 0x39, 0x03, 0xE1, 0x8C, 0x4B, 0xD7, 0xA3, 
+0x3C, 0xBC, 0xD3, 0x78, 0x74, 0x38, 0xAA, 0x49, 
+0x2C, 0x11, 0xB2, 0x53, 0x6A, 0x99, 0x41, 0xA4, 
+0xDB, 0xC8, 0xB8, 0xB8, 0x6C, 0xE3, 0x66, 0x09, 
+0xAC, 0xAE, 0xEA, 0xD7, 0xDD, 0xAC, 0xD6, 0x3E, 
+0x85, 0xEE, 0xD6, 0x9C, 0x98, 0x85, 0xE4, 0xB8, 
+0x0C, 0xB0, 0xA2, 0x02, 0x07, 0x42, 0xE6, 0x24, 
+0x07, 0x10, 0x0B, 0xBB, 0x92, 0xFB, 0xD9, 0xAC, 
+0x53, 0x55, 0xEB, 0xF0, 0x13, 0x4A, 0x15, 0x21, 
+0x0D, 0x14, 0x61, 0x0B, 0x6E, 0x5B, 0x86, 0xEB, 
+0x9D, 0x71, 0x57, 0x45, 0x48, 0x2F, 0x34, 0x6C, 
+0x34, 0x15, 0x10, 0xF8, 0x55, 0x5D, 0xE3, 0x82, 
+0xDB, 0x78, 0xE4, 0xCB, 0x1D, 0x72, 0x63, 0x3B, 
+0x39, 0x63, 0x6B, 0x24, 0xCC, 0xE1, 0x51, 0x57, 
+0xD8, 0xC6, 0xF9, 0xE5, 0xB9, 0xA9, 0xD7, 0x1A, 
+0xBE, 0xDC, 0x8C, 0xEB, 0x53, 0x79, 0x31, 0x01, 
+0xF0, 0x55, 0x9C, 0xFD, 0x2D, 0xE1, 0xFA, 0xD1, 
+0x60, 0x71, 0x31, 0x6E, 0x2C, 0xC5, 0x8E, 0xB9, 
+0x1B, 0x09, 0x04, 0x32, 0x14, 0x89, 0xF9, 0xF8, 
+0xD0, 0xB1, 0xFE, 0xA9, 0x2B, 0xA5, 0x6B, 0x4F, 
+0x86, 0xE7, 0x19, 0x6D, 0xEB, 0xE0, 0x7E, 0x82, 
+0x46, 0xA4, 0x69, 0x0C, 0x3C, 0xB3, 0x7F, 0x37, 
+0x6B, 0x20, 0x1C, 0x2F, 0xEB, 0xB5, 0xE7, 0x01, 
+0x08, 0x6C, 0xE3, 0xC2, 
+
+0x31, 0x4E, 0x90, 0x26, 0xB7, 0x8B, 0xE4, 0xAD, 
+0x28, 0x5A, 0xB1, 0x29, 0x37, 0x5E, 0x61, 0x1A, 
+0xD7, 0x0A, 0xF5, 0xEB, 0x0C, 0xC1, 0xD4, 0x4B, 
+0x77, 0x68, 0xA0, 0x84, 0xFC, 0xA2, 0x04, 0xD9, 
+0xC6, 0x67, 0xE0, 0x58, 0xED, 0x04, 0x9F, 0x37, 
+0x40, 0x01, 0xE6, 0x04, 0xC3, 0x4F, 0x88, 0x15, 
+0x60, 0x0F, 0x8B, 0x03, 0xA4, 0x6F, 0xE5, 0xE7, 
+0x04, 0x37, 0xBD, 0xEA, 0xCE, 0x75, 0x30, 0xFB, 
+0x3A, 0x37, 0x65, 0x3A, 0xD1, 0x72, 0xCB, 0xD7, 
+0x1F, 0x36, 0x55, 0xC1, 0x73, 0x58, 0x60, 0xAE, 
+0xFF, 0xE9, 0xD4, 0x26, 0x79, 0x8D, 0x6D, 0x9F, 
+0x4D, 0x71, 0x8E, 0xFE, 0xA1, 0x05, 0x86, 0x33, 
+0x21, 0x35, 0x81, 0xB7, 0xD6, 0x6B, 0x7A, 0xEC, 
+0xBD, 0x24, 0x26, 0x70, 0xD0, 0xBD, 0xC2, 0x60, 
+0x80, 0x21, 0x0C, 0x26, 0x4E, 0x02, 0x77, 0x95, 
+0x06, 0xFB, 0x87, 0x95, 0x6D, 0x38, 0xB2, 0xA0, 
+0x42, 0x7A, 0x61, 0xAA, 0x04, 0x09, 0x3E, 0x63, 
+0xC9, 0xE8, 0x97, 0x2B, 0x53, 0x92, 0x71, 0xC2, 
+0x1F, 0x8B, 0x0D, 0xAE, 0x23, 0xC1, 0xE5, 0xB7, 
+0x85, 0xCD, 0x9B, 0xC7, 0x2E, 0xE9, 0xE3, 0xD8, 
+0xA6, 0x74, 0xD2, 0xBB, 0x7F, 0x25, 0xD0, 0xA8, 
+0xEE, 0x0F, 0x07, 0xD1, 0x91, 0x77, 0x0B, 0x36, 
+0xCD, 0x3B, 0x5F, 0x3D, 0x83, 0x9B, 0xEB, 0x88, 
+0xE2, 0x7D, 0xC3, 0x74, 0xF4, 0x4F, 0x5D, 0x3D, 
+0xF2, 0x7F, 0x47, 0x34, 0xCB, 0x6E, 0xBD, 0x9F, 
+0x6F, 0x71, 0xBE, 0x55, 0xED, 0xA5, 0x61, 0x33, 
+0xC8, 0x44, 0x5B, 0x7D, 0x22, 0x80, 0x1A, 0xC4, 
+0x10, 0xF6, 0x8D, 0x86, 0xB9, 0x64, 0xE5, 0xA3, 
+0xD3, 0xCC, 0x6F, 0xF1, 0x9A, 0x23, 0x5E, 0x21, 
+0x78, 0xDD, 0x98, 0x85, 0x32, 0xAA, 0x26, 0xC6, 
+0x9B, 0x5A, 0x4D, 0xA2, 0x79, 0xDF, 0x15, 0x7F, 
+0x70, 0xDC, 0xD6, 0x98, 0x75, 0x43, 0x70, 0xA1, 
+0xBD, 0x45, 0x6D, 0xEF, 0xC3, 
+
+0x27, 0x43, 0x9F, 0x9C, 0x2F, 0x17, 0xE7, 0x67, 
+0xFC, 0x77, 0xB2, 0xD9, 0x51, 0x9C, 0xA5, 0x18, 
+0x10, 0x0F, 0x75, 0x97, 0x84, 0x78, 0x30, 0x31, 
+0x56, 0x19, 0xC6, 0x4E, 0x2D, 0xC4, 0xE0, 0x3A, 
+0x7A, 0x41, 0x5D, 0xC0, 0xE3, 0xE9, 0x93, 0xDF, 
+0xAE, 0x8E, 0x18, 0x52, 0xF0, 0x52, 0x05, 0x6C, 
+0x81, 0x6E, 0x3F, 0xF8, 0x46, 0x2E, 0xEE, 0xF0, 
+0x08, 0x60, 0x9F, 0xCF, 0x2E, 0xFF, 0xBC, 0xB3, 
+0x81, 0x2E, 0x5E, 0x34, 0x6E, 0x67, 0x7B, 0x12, 
+0x38, 0x0A, 0xE9, 0x0B, 0xA5, 0xBC, 0xC6, 0x14, 
+0x67, 0x06, 0x63, 0x40, 0x8F, 0x43, 0x77, 0x76, 
+0xC7, 0x1F, 0x5E, 0x37, 0x48, 0xE3, 0x28, 0x1C, 
+0xAF, 0xC5, 0x96, 0xAC, 0xB0, 0x5A, 0x25, 0xB1, 
+0x1A, 0x0E, 0x4C, 0x77, 0x27, 0x6A, 0x2E, 0x4C, 
+0xBE, 0x71, 0x3C, 0x50, 0x12, 0x0B, 0xC4, 0x70, 
+0xF6, 0x7F, 0x6A, 0xA6, 0x05, 0x43, 0xA5, 0xFC, 
+0x50, 0x21, 0xDE, 0xA2, 0xD0, 0xAA, 0x69, 0x73, 
+0x6C, 0x26, 0xB7, 0xB3, 0xCC, 0x2A, 0x5A, 0x10, 
+0xB9, 0x42, 0xA7, 0x81, 0x2D, 0x31, 0xDA, 0xD6, 
+0xA1, 0x1E, 0xDF, 0x75, 0xFF, 0x2A, 0x18, 0x85, 
+0xC6, 0xD3, 0x7A, 0xFA, 0xAE, 0x0D, 0x22, 0x11, 
+0xF8, 0xF7, 0x9E, 0x50, 0x39, 0x03, 0x8A, 0x07, 
+0xE0, 0xD1, 0x80, 0x3D, 0xD7, 0x50, 0xBA, 0x62, 
+0x9B, 0x55, 0x97, 0x1D, 0x1E, 0xED, 0xB6, 0x9C, 
+0x34, 0x4A, 0x53, 0x7A, 0xDE, 0xB4, 0x1C, 0xE2, 
+0x68, 0xFC, 0xD2, 0x04, 0xFB, 0x60, 0x35, 0x1B, 
+0x15, 0x20, 0x03, 0xC4, 0x33, 0x5E, 0x3E, 0xB4, 
+0x97, 0x2B, 0x2B, 0xF6, 0xE0, 0x0C, 0x5D, 0xD4, 
+0xDF, 0xE1, 0x34, 0xAC, 0xFB, 0xE3, 0x83, 0x69, 
+0xE4, 0x75, 0x43, 0x0C, 0xFE, 0x80, 0xF7, 0x34, 
+0x21, 0x94, 0xE7, 0xC3, 0xC6, 0x58, 0x5D, 0x91, 
+0xA1, 0x1E, 0xAF, 0xE0, 0x3B, 0xBB, 0x46, 0xD7, 
+0x7B, 0xA3, 0x06, 0x25, 0x89, 0x3D, 
+
+0xB5, 0x76, 0x8C, 0x77, 0x14, 0x14, 0x73, 0xEE, 
+0x11, 0xFC, 0xFF, 0xA1, 0xC6, 0x2F, 0x48, 0x14, 
+0x67, 0x59, 0xC8, 0x23, 0x35, 0xE8, 0xBD, 0xCB, 
+0x25, 0x68, 0x28, 0xF5, 0x5E, 0x50, 0x8B, 0xBC, 
+0x11, 0x95, 0xA0, 0x55, 0x41, 0xF2, 0x2E, 0x4D, 
+0xAD, 0x3A, 0x7A, 0x9D, 0xC1, 0xC3, 0xC8, 0x7C, 
+0xF5, 0x31, 0xE0, 0x72, 0x37, 0x8D, 0xCC, 0x7B, 
+0xFA, 0x16, 0x74, 0x57, 0xB9, 0x1B, 0x5E, 0x84, 
+0xC6, 0xCB, 0xCD, 0xB3, 0xF1, 0xD1, 0xE4, 0x2E, 
+0xB1, 0x21, 0xEF, 0xEF, 0x08, 0x94, 0x9B, 0xB1, 
+0x42, 0xDC, 0x62, 0x8F, 0x83, 0x86, 0xEA, 0xD1, 
+0x35, 0xAD, 0x60, 0x73, 0x75, 0x5A, 0xE4, 0x8F, 
+0x40, 0x0C, 0x60, 0x29, 0x4A, 0x8C, 0x5A, 0x40, 
+0xC9, 0xF4, 0x73, 0x29, 0x13, 0x1E, 0x51, 0xD0, 
+0xB2, 0xE4, 0x69, 0xFC, 0x5B, 0x5F, 0x81, 0xAF, 
+0x09, 0xF1, 0xD6, 0x9C, 0x0B, 0x5E, 0xA9, 0xC6, 
+0x47, 0x72, 0x74, 0xE0, 0x67, 0x64, 0x64, 0x09, 
+0xD9, 0x55, 0x99, 0x77, 0x5D, 0x24, 0x8E, 0x73, 
+0x85, 0x15, 0xDF, 0x22, 0xD7, 0x2E, 0x98, 0x88, 
+0x19, 0x2C, 0xA8, 0xAC, 0x65, 0xD3, 0xD8, 0xD9, 
+0x9E, 0x10, 0x17, 0x3F, 0x79, 0x0D, 0xC6, 0x88, 
+0x72, 0x02, 0xDA, 0x5C, 0x34, 0x75, 0x76, 0xB0, 
+0xEE, 0x7A, 0x0C, 0x13, 0x40, 0x50, 0xC1, 0x47, 
+0x5C, 0x92, 0xCE, 0x40, 0x9D, 0x9E, 0xFF, 0x7F, 
+0x12, 0x21, 0xCC, 0x9B, 0x3B, 0x1D, 0xA9, 0x13, 
+0xA3, 0x87, 0x21, 0x2B, 0x57, 0xFD, 0xA3, 0xD7, 
+0x3E, 0x0F, 0x84, 0xFF, 0x4A, 0x77, 0x8D, 0x06, 
+0xE0, 0xE2, 0xEF, 0xCF, 0x3B, 0xB7, 0xBD, 0x55, 
+0xD5, 0x8A, 0x5C, 0x16, 0x73, 0x19, 0xDF, 0x32, 
+0x5E, 0x40, 0x79, 0x9E, 0xA9, 0xFF, 0xF5, 0xD6, 
+0x72, 0xF3, 0x2A, 0xB0, 0xC6, 0x7F, 0x59, 0xA4, 
+0x12, 0x9F, 0x9A, 0xD7, 0x6A, 0x99, 0x1B, 0x20, 
+0x55, 0xC4, 0x55, 0x42, 0x93, 0xEA, 0xD7, 
+
+0x8D, 0x08, 0x9A, 0x7D, 0xD7, 0x6D, 0xA7, 0x95, 
+0x05, 0x8D, 0xF4, 0xF0, 0x30, 0x05, 0x3F, 0x36, 
+0xF7, 0x99, 0x44, 0x45, 0x04, 0x76, 0x6B, 0x26, 
+0x44, 0xC0, 0xFC, 0x0B, 0x23, 0x14, 0x02, 0x29, 
+0xC3, 0x43, 0xB3, 0xE4, 0x4B, 0xBE, 0xB4, 0xE6, 
+0x2F, 0x67, 0xF2, 0x96, 0x1E, 0xAB, 0x0F, 0xB6, 
+0x80, 0x1A, 0x0F, 0xB4, 0x49, 0x6B, 0x1B, 0x62, 
+0x33, 0xFA, 0x53, 0xC0, 0x57, 0xC9, 0x42, 0x8D, 
+0xA2, 0x19, 0x51, 0xA0, 0x23, 0xA6, 0x4F, 0x4A, 
+0x9B, 0x85, 0xE8, 0x2A, 0x7F, 0x41, 0xD1, 0x31, 
+0xC4, 0xA8, 0x3F, 0x25, 0xAE, 0xA8, 0x86, 0x8E, 
+0xE4, 0x84, 0xD2, 0xF0, 0xC7, 0x2C, 0xA8, 0xCC, 
+0xB9, 0xDB, 0x97, 0x12, 0x83, 0xF0, 0x22, 0x83, 
+0x9D, 0xC5, 0xD0, 0x30, 0xA4, 0x66, 0xFC, 0x51, 
+0x51, 0x22, 0x5F, 0x29, 0xE9, 0x16, 0x67, 0x63, 
+0x21, 0x5A, 0xE6, 0x08, 0x56, 0x3E, 0x4D, 0x4A, 
+0x41, 0x12, 0x05, 0x17, 0xBD, 0x7C, 0xE0, 0xEB, 
+0xCB, 0x08, 0xF3, 0xD3, 0xF9, 0x74, 0xAF, 0xF7, 
+0xDC, 0x6E, 0x6B, 0xA4, 0xD7, 0x97, 0x82, 0xEB, 
+0x94, 0x16, 0x96, 0xB6, 0xF5, 0x8F, 0x2D, 0xB6, 
+0xB0, 0x91, 0x91, 0x36, 0xCD, 0x6E, 0xED, 0x28, 
+0xD0, 0xA5, 0x1D, 0x0F, 0x50, 0x4A, 0x24, 0xBA, 
+0x59, 0x59, 0x94, 0x16, 0x7A, 0xC8, 0x4B, 0x09, 
+0x7D, 0x2E, 0x6E, 0x47, 0x9E, 0xDF, 0xEA, 0x7C, 
+0xD5, 0xDF, 0xF9, 0x35, 0xE9, 0x97, 0xE6, 0x2E, 
+0x34, 0xA4, 0x86, 0x50, 0x64, 0x37, 0x7D, 0x84, 
+0x57, 0x1E, 0x14, 0x10, 0x74, 0x83, 0x5A, 0x49, 
+0xAA, 0x13, 0xD3, 0x1F, 0x54, 0x45, 0x68, 0xD3, 
+0xE4, 0x7C, 0x21, 0xB5, 0x35, 0xEC, 0xE8, 0xBA, 
+0x4F, 0xBD, 0x3B, 0x16, 0xDD, 0x31, 0xE9, 0xFC, 
+0xEF, 0xA5, 0xBC, 0x2C, 0x26, 0x1A, 0xD7, 0x3E, 
+0xF7, 0xCA, 0xD9, 0x32, 0xCE, 0x1C, 0xB8, 0x7E, 
+0x2F, 0xB4, 0xE3, 0xE3, 0x65, 0x01, 0x5C, 0xF8, 
+
+0x62, 0x6B, 0x7F, 0x66, 0x65, 0x70, 0x56, 0x7A, 
+0x7D, 0x9E, 0x0B, 0xBE, 0xB5, 0x03, 0xAF, 0x93, 
+0x66, 0x7A, 0x95, 0x82, 0x08, 0x5E, 0xB6, 0xAF, 
+0xA9, 0xE3, 0x35, 0xF0, 0xEE, 0x11, 0xBB, 0xFE, 
+0xC9, 0x8F, 0x06, 0x9D, 0x8D, 0x18, 0x68, 0xB5, 
+0x76, 0x32, 0x14, 0xC3, 0xA2, 0xA7, 0xBD, 0xF0, 
+0xAD, 0x83, 0x59, 0x75, 0x48, 0x29, 0xC5, 0xC8, 
+0x51, 0x88, 0xE6, 0x63, 0xB2, 0xE6, 0xB0, 0xAA, 
+0x5D, 0x4B, 0x93, 0x37, 0x20, 0xBE, 0x96, 0x93, 
+0x00, 0x85, 0xE9, 0x71, 0x07, 0x38, 0xF4, 0xA9, 
+0xED, 0xA3, 0x06, 0xF4, 0xAA, 0x86, 0x35, 0x24, 
+0x10, 0x60, 0xE6, 0x48, 0x3A, 0xDD, 0x74, 0x8B, 
+0x9D, 0x7A, 0x91, 0x6C, 0xEF, 0x28, 0x3E, 0xB0, 
+0x1D, 0xF0, 0x1E, 0xB2, 0x30, 0xC6, 0xD2, 0x88, 
+0x89, 0xFB, 0xF5, 0x72, 0x31, 0x8D, 0xEC, 0x43, 
+0x63, 0xC2, 0xCA, 0x54, 0x56, 0x84, 0xF7, 0xCB, 
+0x2D, 0xAA, 0x1C, 0x0B, 0x46, 0x12, 0x95, 0x08, 
+0xFE, 0xB5, 0x3A, 0x2A, 0x0A, 0xC7, 0x23, 0x7C, 
+0x80, 0x2B, 0xCC, 0x3E, 0x0E, 0xAB, 0x9C, 0x51, 
+0xDF, 0xB6, 0x36, 0xAA, 0x11, 0x23, 0xE7, 0x10, 
+0x72, 0xF0, 0xD4, 0xC1, 0xCB, 0xB9, 0x3E, 0xF4, 
+0x01, 0x69, 0x83, 0xC7, 0xF2, 0xD9, 0x98, 0x3C, 
+0xA8, 0x41, 0x37, 0xC7, 0x2B, 0x85, 0x8A, 0xEE, 
+0x68, 0x74, 0x6B, 0xBB, 0x46, 0xA2, 0x72, 0xFC, 
+0xD4, 0x4B, 0x16, 0x64, 0x71, 0x9A, 0x53, 0x74, 
+0x25, 0x6B, 0xEF, 0xD7, 0x23, 0xC2, 0x49, 0x30, 
+0x05, 0x8F, 0x2F, 0x48, 0x70, 0x7E, 0x3E, 0x39, 
+0x6D, 0x0E, 0x08, 0xFC, 0xF3, 0xE3, 0x1F, 0xDC, 
+0x5F, 0x61, 0x7D, 0xF0, 0x89, 0xD1, 0x4B, 0x77, 
+0xF1, 0x11, 0xAF, 0x52, 0x39, 0xF4, 0x94, 0x71, 
+0x7D, 0x78, 0xAC, 0x28, 0x0B, 0x65, 0x9A, 0x64, 
+0x3F, 0x41, 0x76, 0x32, 0x59, 0xF8, 0xFA, 0x0A, 
+0xAA, 0xF2, 0xF7, 0x4D, 0x4A, 0xEA, 0x88, 0x06, 
+0xB1, 
+
+0x1C, 0xC3, 0x95, 0xAA, 0xBD, 0xC5, 0x7B, 0xAE, 
+0x7E, 0xFD, 0xE9, 0xB8, 0x02, 0x6C, 0x8A, 0x6F, 
+0x12, 0x7E, 0xF6, 0xCB, 0xA7, 0x7D, 0xD2, 0xAB, 
+0x9D, 0x9F, 0x9D, 0xC0, 0xE1, 0xD8, 0xFA, 0x7D, 
+0x52, 0x76, 0xB4, 0xBA, 0xD3, 0x78, 0x8A, 0x20, 
+0xCA, 0xA3, 0x41, 0xE2, 0x74, 0xC4, 0x08, 0xFE, 
+0x80, 0xCD, 0x86, 0x79, 0x7F, 0xF8, 0x87, 0x1F, 
+0x43, 0x54, 0xF6, 0x37, 0x9E, 0x00, 0x4A, 0x7B, 
+0x1C, 0xEB, 0xED, 0x8A, 0xB9, 0x25, 0x7A, 0x5B, 
+0x1F, 0x33, 0x27, 0x66, 0x93, 0x90, 0xB3, 0x2A, 
+0x67, 0x47, 0x6F, 0xFF, 0x7B, 0xDC, 0x76, 0x29, 
+0x59, 0x06, 0x76, 0x9D, 0xE6, 0x0B, 0x80, 0x91, 
+0xB4, 0x8E, 0x5D, 0xCD, 0x09, 0x86, 0xE8, 0x5A, 
+0x02, 0xAC, 0x54, 0xCF, 0x06, 0x8E, 0x40, 0xA2, 
+0x20, 0x9E, 0xA2, 0xD3, 0xCA, 0x32, 0x31, 0x64, 
+0xCA, 0x4F, 0xDC, 0xF2, 0xF2, 0xA8, 0x30, 0x37, 
+0x9E, 0x73, 0x82, 0x2B, 0xB6, 0x80, 0x72, 0xE7, 
+0x1D, 0x5E, 0x01, 0x32, 0xAC, 0x46, 0x97, 0x2E, 
+0x9A, 0x80, 0x38, 0xB0, 0xDB, 0x74, 0xDF, 0x7D, 
+0x36, 0xD8, 0x74, 0x3D, 0x56, 0x7D, 0xF0, 0xD4, 
+0x3A, 0xBD, 0xBE, 0xAC, 0xE7, 0x6B, 0x53, 0x4A, 
+0x8C, 0xF9, 0x27, 0xC8, 0xA6, 0xF3, 0xD7, 0x5A, 
+0x13, 0xFC, 0x4E, 0xC4, 0x12, 0xF7, 0x0F, 0x0E, 
+0xAF, 0x69, 0xEF, 0x61, 0x59, 0x5A, 0xBD, 0xA5, 
+0xC3, 0xC3, 0x08, 0x04, 0xD6, 0xA5, 0x45, 0xD8, 
+0x16, 0x8B, 0x35, 0x9F, 0x7B, 0x21, 0xA4, 0x35, 
+0xAB, 0x20, 0x54, 0x49, 0x53, 0x09, 0x99, 0x94, 
+0xC4, 0xA7, 0xBF, 0x49, 0x23, 0xF3, 0x7A, 0x1C, 
+0x8E, 0xC5, 0x0E, 0x88, 0xB4, 0xAD, 0x1D, 0x3B, 
+0x9A, 0xE4, 0x12, 0x82, 0xB1, 0xDC, 0xA3, 0xD6, 
+0x76, 0x21, 0x3A, 0xB4, 0x45, 0xE7, 0xF6, 0x2F, 
+0x0F, 0x94, 0xA7, 0xDD, 0x27, 0xE7, 0xDD, 0xC6, 
+0xAD, 0xC5, 0x61, 0xEC, 0xD8, 0x98, 0x3F, 0x07, 
+0xFD, 0x1A, 
+
+0xC6, 0xFB, 0x31, 0x2F, 0x0F, 0x48, 0x14, 0x40, 
+0x90, 0x9F, 0xAB, 0xF6, 0x6A, 0xDB, 0xB8, 0x3A, 
+0x5F, 0xCB, 0xA9, 0x19, 0x61, 0x90, 0x02, 0xC9, 
+0x9E, 0x03, 0xF6, 0xFB, 0xEE, 0xD2, 0x1D, 0x3D, 
+0xE0, 0x6E, 0xD2, 0x5E, 0x9C, 0xC9, 0x40, 0xE9, 
+0xF6, 0x48, 0xB8, 0x21, 0xF9, 0x63, 0xD2, 0x93, 
+0x61, 0xE7, 0xF8, 0xAD, 0x34, 0x72, 0xC3, 0xC6, 
+0x5D, 0x1C, 0x80, 0xFA, 0xC0, 0x8A, 0xBC, 0xF9, 
+0xC8, 0xED, 0xA1, 0x5C, 0x37, 0x10, 0x05, 0x92, 
+0x66, 0x24, 0xC4, 0x2E, 0xF0, 0x58, 0x8D, 0xAB, 
+0x3F, 0xDD, 0x7F, 0x1B, 0x6B, 0x66, 0x97, 0xB7, 
+0xE1, 0x40, 0x23, 0x35, 0xA3, 0x93, 0xB5, 0x0C, 
+0x4B, 0xAF, 0xB7, 0xFA, 0x07, 0x2F, 0xFB, 0x8C, 
+0x6D, 0x8C, 0x0A, 0x7A, 0xA8, 0x68, 0xED, 0x70, 
+0xD5, 0x79, 0x3F, 0xA7, 0x88, 0xB3, 0xD8, 0xB6, 
+0x46, 0xB9, 0xCB, 0xBF, 0x2D, 0xF4, 0x51, 0xF2, 
+0x89, 0x16, 0xBB, 0x53, 0xFB, 0xDC, 0xA2, 0x95, 
+0x50, 0x44, 0x26, 0xB5, 0x91, 0x06, 0xEC, 0x52, 
+0x2A, 0xE6, 0x6B, 0xC0, 0xC7, 0xD8, 0x7B, 0xBE, 
+0x29, 0x3C, 0x1E, 0x5E, 0xA6, 0xE7, 0xBA, 0xAC, 
+0xF7, 0xA2, 0x09, 0x6D, 0xFC, 0xCB, 0x2C, 0x30, 
+0x1D, 0xB1, 0x6F, 0x32, 0xE4, 0xA6, 0xA4, 0xA6, 
+0x18, 0xB0, 0x66, 0xD8, 0xB2, 0xBD, 0xDE, 0xE0, 
+0xE5, 0x7D, 0x9E, 0x4A, 0xF2, 0xCC, 0xCE, 0x5D, 
+0xAD, 0xDF, 0x88, 0xAD, 0x88, 0xB4, 0x10, 0x71, 
+0xD1, 0xBA, 0x6F, 0xA2, 0x79, 0x43, 0x46, 0x14, 
+0xFC, 0x51, 0x0C, 0x48, 0xAC, 0x2D, 0x8E, 0x92, 
+0xAF, 0x07, 0x63, 0x36, 0x29, 0x7C, 0x63, 0x0E, 
+0x13, 0x08, 0xEA, 0x0C, 0x7E, 0x79, 0xEB, 0x1B, 
+0x37, 0x3A, 0x37, 0x99, 0x54, 0xD4, 0xE1, 0x6F, 
+0x7D, 0x7E, 0x6D, 0xAC, 0x96, 0x5A, 0x44, 0x1F, 
+0x8B, 0x35, 0xF8, 0x6B, 0x40, 0x43, 0xE6, 0x71, 
+0xEF, 0x67, 0x0F, 0xFA, 0x4A, 0xF9, 0x49, 0x37, 
+0x6A, 0x63, 0xEA, 
+
+0x10, 0x7D, 0xFD, 0x92, 0x63, 0xF8, 0x14, 0x6F, 
+0x97, 0x62, 0x61, 0x07, 0xFF, 0x42, 0xF2, 0xEB, 
+0xC3, 0x67, 0x37, 0x08, 0x42, 0x3C, 0x74, 0xD4, 
+0x6F, 0xEB, 0x14, 0x0C, 0x18, 0x82, 0x0F, 0x05, 
+0x07, 0xD8, 0x16, 0x39, 0xB2, 0x55, 0xC8, 0xC7, 
+0x51, 0x1C, 0xDD, 0x0D, 0x74, 0xAB, 0xAD, 0x48, 
+0x06, 0x55, 0xE8, 0xFA, 0xB1, 0xFE, 0xA8, 0xB5, 
+0x38, 0x12, 0x4E, 0x64, 0xCD, 0x1B, 0x46, 0x73, 
+0x14, 0xEA, 0x09, 0x3D, 0xEE, 0x90, 0x0A, 0xC1, 
+0x21, 0xFC, 0x69, 0xB1, 0xBB, 0x74, 0xA8, 0xD7, 
+0x0C, 0x4E, 0xCF, 0x38, 0x6F, 0xC1, 0x45, 0xAC, 
+0x69, 0xF7, 0x45, 0xBD, 0x19, 0x5D, 0x9E, 0x0A, 
+0x0E, 0x48, 0x21, 0x77, 0x61, 0xD6, 0x06, 0xDD, 
+0x4C, 0x7A, 0x8D, 0xA7, 0xEA, 0xAB, 0x46, 0x57, 
+0x08, 0xAE, 0x0A, 0x79, 0xA6, 0xE8, 0x45, 0x30, 
+0x36, 0x5A, 0xEB, 0x90, 0x3C, 0x1F, 0x9A, 0x5F, 
+0xA4, 0x03, 0x2A, 0x51, 0xE2, 0x3E, 0x03, 0x6D, 
+0xD3, 0x33, 0xEF, 0x6A, 0x27, 0x5A, 0xB7, 0x2D, 
+0xC4, 0x13, 0x1E, 0xA9, 0x9F, 0xC7, 0x9A, 0x83, 
+0x4B, 0xDF, 0xC5, 0x73, 0xBB, 0xCD, 0x1E, 0x61, 
+0x43, 0x94, 0x1E, 0x1D, 0x3D, 0x96, 0x3A, 0x44, 
+0x95, 0xC5, 0x48, 0xB4, 0x65, 0xC6, 0xD0, 0xE4, 
+0x61, 0x0F, 0x92, 0xE7, 0x70, 0x1A, 0xDA, 0x17, 
+0x1C, 0x25, 0xDA, 0x35, 0xF0, 0x52, 0xF9, 0x12, 
+0xBF, 0xBE, 0x1B, 0x5A, 0x83, 0xE9, 0x88, 0xA7, 
+0x71, 0xFF, 0xF6, 0x7E, 0x77, 0x32, 0x09, 0x7F, 
+0x54, 0x79, 0x22, 0x1C, 0x79, 0x94, 0x55, 0x8F, 
+0xB4, 0x8E, 0xEF, 0x0C, 0xC6, 0x7B, 0x84, 0x16, 
+0x9D, 0x89, 0x09, 0x4F, 0xFA, 0xD4, 0x90, 0x67, 
+0x25, 0x35, 0x32, 0xC7, 0xAF, 0xA0, 0x56, 0x5F, 
+0x6D, 0xC7, 0x5A, 0x34, 0x40, 0xC2, 0x17, 0x4E, 
+0x5C, 0x39, 0x95, 0x5B, 0x6E, 0x4C, 0xA7, 0xFB, 
+0x49, 0x64, 0x58, 0x27, 0xD0, 0xC7, 0x95, 0xF2, 
+0x23, 0xC8, 0x25, 0xEF, 
+
+0x32, 0xB2, 0xE8, 0xF9, 0xF5, 0x42, 0x1F, 0x32, 
+0x04, 0xD0, 0x52, 0x49, 0xFB, 0xB6, 0x09, 0x98, 
+0xF1, 0x3E, 0xCF, 0x9D, 0x2B, 0x0C, 0x62, 0xA7, 
+0xC8, 0x82, 0xDC, 0x4C, 0x53, 0x4E, 0x10, 0x6C, 
+0xD5, 0x74, 0x1B, 0xA7, 0xAA, 0x78, 0xD9, 0x2D, 
+0x34, 0x3F, 0xD8, 0x57, 0xB1, 0xC2, 0x01, 0x66, 
+0xC8, 0x5A, 0x83, 0xED, 0x36, 0x47, 0xA1, 0x82, 
+0xEE, 0x62, 0xFB, 0x4A, 0xC3, 0x50, 0xB9, 0x84, 
+0x86, 0x5B, 0x65, 0xDF, 0x5D, 0xE3, 0xA7, 0x36, 
+0xD6, 0x98, 0x8D, 0x69, 0x7A, 0x6A, 0xE2, 0xB8, 
+0xEB, 0x47, 0x99, 0x76, 0xC3, 0x2A, 0x84, 0x1C, 
+0x4C, 0xBB, 0xFD, 0xD2, 0x14, 0xBC, 0x44, 0x1B, 
+0xF9, 0x7C, 0xFA, 0x89, 0x15, 0xC7, 0x12, 0x47, 
+0x64, 0x55, 0x4A, 0xBE, 0x52, 0xDF, 0xB0, 0x84, 
+0xEA, 0x02, 0xAE, 0xCD, 0xF3, 0xEB, 0x42, 0xD7, 
+0x38, 0x8F, 0x6C, 0x0D, 0xF2, 0xB1, 0x51, 0x5D, 
+0xFB, 0x87, 0xBC, 0xDF, 0xCF, 0x55, 0x86, 0xC3, 
+0x9B, 0x14, 0xBA, 0xD4, 0xBE, 0x3E, 0xF3, 0x89, 
+0x23, 0xB4, 0x1A, 0xAF, 0xC4, 0xD7, 0x88, 0xB5, 
+0xA7, 0xFF, 0x10, 0x1E, 0x2D, 0x17, 0x4C, 0x67, 
+0xEF, 0x23, 0x4F, 0xFC, 0x73, 0xB8, 0xE3, 0xFF, 
+0x83, 0x0C, 0x3E, 0x4C, 0x8C, 0x24, 0xBE, 0x7B, 
+0x23, 0xCE, 0x0D, 0x97, 0x05, 0x69, 0xF9, 0xC6, 
+0xB2, 0x52, 0xD2, 0xC7, 0x6A, 0x97, 0x12, 0x38, 
+0x25, 0xDB, 0x5E, 0x91, 0x64, 0x38, 0x0B, 0x37, 
+0x37, 0xEE, 0xA1, 0xE3, 0x7D, 0xD1, 0x07, 0xC9, 
+0x2D, 0x58, 0x66, 0xA7, 0x7F, 0x50, 0x90, 0xD7, 
+0x2B, 0x46, 0x57, 0x3A, 0xD2, 0xFD, 0x78, 0xE9, 
+0xA3, 0x16, 0x85, 0x13, 0xE3, 0xCC, 0xF7, 0xA9, 
+0xC8, 0xCC, 0xEB, 0x99, 0x16, 0xA0, 0xB0, 0x10, 
+0x32, 0xA2, 0x73, 0x94, 0xAE, 0xBC, 0x50, 0x26, 
+0xDC, 0x2A, 0xF7, 0xA0, 0x8B, 0x3B, 0xC2, 0xD3, 
+0xF3, 0xD0, 0x68, 0xCB, 0x1B, 0xA3, 0xFC, 0xCE, 
+0x1A, 0x4A, 0xAF, 0x70, 0xAF, 
+
+0x27, 0x93, 0x7A, 0xFD, 0x28, 0x9F, 0x33, 0xFC, 
+0x16, 0x09, 0xED, 0x05, 0xB0, 0x25, 0x71, 0xF6, 
+0x43, 0xEA, 0x27, 0xF8, 0x96, 0x4A, 0x0E, 0x99, 
+0x68, 0xFE, 0x8D, 0xA2, 0x66, 0x11, 0x11, 0xCE, 
+0xD9, 0x69, 0xD6, 0xD6, 0x7B, 0xC9, 0xE2, 0xD5, 
+0x98, 0x69, 0x58, 0x9A, 0x0E, 0x28, 0x68, 0xAF, 
+0xE7, 0x81, 0x78, 0xD2, 0x5C, 0xB1, 0x29, 0x8C, 
+0xDA, 0x9A, 0xDA, 0x7D, 0x1A, 0x57, 0x89, 0x2F, 
+0xA1, 0xB8, 0x25, 0xAA, 0x81, 0x75, 0xA4, 0x9E, 
+0xE2, 0x32, 0xDF, 0x08, 0x74, 0xFB, 0xE1, 0xF3, 
+0x41, 0xF6, 0xA6, 0xF6, 0x7B, 0x85, 0xA9, 0xA5, 
+0xED, 0xC9, 0xB7, 0x3C, 0x2F, 0x88, 0x20, 0xD0, 
+0x97, 0x32, 0x74, 0x54, 0xF6, 0x1B, 0x10, 0x44, 
+0x5E, 0xE7, 0x05, 0xDA, 0x90, 0x36, 0xC4, 0x1B, 
+0x1C, 0xBD, 0x65, 0x29, 0x79, 0xFC, 0x3F, 0xB7, 
+0x32, 0x5E, 0x82, 0x76, 0xD6, 0xF0, 0x01, 0x9C, 
+0xBE, 0xF2, 0x4B, 0xEC, 0x3A, 0x37, 0x60, 0x4E, 
+0x6E, 0xEC, 0x7A, 0x0D, 0x20, 0x1A, 0xB0, 0xF2, 
+0x86, 0xC3, 0x70, 0x1B, 0x31, 0x04, 0xAF, 0x46, 
+0x3D, 0x2A, 0x2E, 0x82, 0x98, 0x5A, 0x4E, 0x41, 
+0x96, 0x27, 0x16, 0xC4, 0x90, 0x2F, 0x15, 0x2E, 
+0x14, 0x3A, 0x2A, 0xD9, 0x91, 0xA7, 0x2E, 0xBA, 
+0x6D, 0xD8, 0xCF, 0xF1, 0x67, 0x74, 0x02, 0x4C, 
+0x67, 0x96, 0x34, 0xB4, 0xDF, 0xD9, 0xF5, 0xF4, 
+0xCD, 0x14, 0xC8, 0x35, 0xE6, 0xB7, 0xF2, 0x9C, 
+0x79, 0x3A, 0x00, 0x09, 0x5F, 0xA5, 0x5A, 0xD5, 
+0x5E, 0x7D, 0x8E, 0xBD, 0xE9, 0xDD, 0x80, 0x5F, 
+0x72, 0x76, 0x04, 0x6B, 0x1A, 0x3C, 0x33, 0x3F, 
+0x9A, 0xBD, 0x02, 0x0B, 0x80, 0x88, 0xAA, 0xAC, 
+0xAD, 0x96, 0x95, 0xA0, 0x90, 0x06, 0x40, 0xCB, 
+0x93, 0x74, 0x90, 0x20, 0xCD, 0x2A, 0xBC, 0x19, 
+0xDA, 0x6C, 0x4C, 0x89, 0x13, 0x46, 0xF9, 0x83, 
+0x95, 0x1E, 0xCF, 0xE9, 0xAC, 0x8A, 0x76, 0x40, 
+0xB4, 0x16, 0x51, 0x86, 0xDB, 0x1A, 
+
+0x4B, 0x29, 0x71, 0xAE, 0x67, 0xD6, 0x3A, 0x36, 
+0x55, 0xC0, 0x88, 0xF1, 0x74, 0xCF, 0x5F, 0xEF, 
+0x81, 0x1D, 0x91, 0x66, 0xE6, 0x92, 0x47, 0x83, 
+0xD7, 0x15, 0xC4, 0x8F, 0xE7, 0xF5, 0x34, 0xE9, 
+0xF6, 0x31, 0xDF, 0xE2, 0x62, 0x19, 0x3C, 0x4E, 
+0x5B, 0xBC, 0xA6, 0xA2, 0xDB, 0x0B, 0x3B, 0x12, 
+0x51, 0x47, 0x23, 0x08, 0x8B, 0xF6, 0xDB, 0xB7, 
+0xE4, 0xF4, 0xB1, 0xDA, 0xC7, 0x23, 0x96, 0x82, 
+0x4D, 0x91, 0xAF, 0x65, 0x90, 0x7F, 0x15, 0x50, 
+0x25, 0x61, 0x57, 0xE0, 0x18, 0x42, 0x4F, 0x20, 
+0x12, 0xA1, 0xC2, 0xF3, 0xD7, 0xB6, 0x2A, 0xDA, 
+0xBF, 0x10, 0x07, 0xDD, 0x71, 0x2F, 0x9F, 0xA4, 
+0x3B, 0x98, 0x3A, 0x06, 0x37, 0x5A, 0xD6, 0x06, 
+0x13, 0x42, 0x50, 0x73, 0x9F, 0x7E, 0x7E, 0x1C, 
+0xC9, 0x04, 0x0A, 0x69, 0x1F, 0x40, 0x4D, 0xCE, 
+0x72, 0x49, 0x2C, 0xD5, 0x0E, 0xA9, 0x40, 0x79, 
+0xBE, 0x85, 0x0D, 0x3F, 0xBE, 0x46, 0x64, 0x95, 
+0x1D, 0xB6, 0x34, 0x89, 0x34, 0x87, 0x2D, 0x42, 
+0x69, 0xF8, 0xAB, 0xB4, 0x74, 0x45, 0xE6, 0x94, 
+0x00, 0x0C, 0x5E, 0x37, 0x88, 0x7E, 0xA0, 0x0F, 
+0x44, 0x74, 0xD0, 0x73, 0x82, 0x41, 0xA5, 0x5D, 
+0x9F, 0x24, 0x38, 0x81, 0x00, 0xA7, 0x4C, 0x13, 
+0x78, 0x16, 0xA3, 0x2D, 0x37, 0x2A, 0x91, 0x4A, 
+0x77, 0x16, 0xC7, 0xDC, 0xE6, 0x3E, 0xFE, 0x39, 
+0xCC, 0x2F, 0x89, 0xAE, 0x46, 0x55, 0xE4, 0x59, 
+0x1C, 0x80, 0xA6, 0xB6, 0x19, 0x2D, 0xEE, 0xF7, 
+0x5D, 0xF0, 0x5E, 0x2A, 0x29, 0x37, 0x30, 0xBB, 
+0x3E, 0xCC, 0x63, 0xF2, 0xD6, 0x6D, 0x2C, 0x2B, 
+0x66, 0x7A, 0xE9, 0x10, 0x60, 0xAA, 0xC2, 0xFD, 
+0xB6, 0x76, 0x47, 0xF1, 0x78, 0x8D, 0x7C, 0x49, 
+0x62, 0xDF, 0x01, 0x25, 0xE9, 0xC4, 0x7D, 0xD4, 
+0x6D, 0x8E, 0x43, 0x3F, 0x46, 0x76, 0xDD, 0x29, 
+0xC6, 0x10, 0xB3, 0x84, 0x31, 0x1D, 0x8F, 0xAA, 
+0x3F, 0x31, 0xBD, 0x8A, 0xEB, 0x2D, 0x36, 
+
+0x58, 0x49, 0x34, 0xAF, 0xC2, 0xE4, 0x1C, 0xF7, 
+0x50, 0x74, 0xAC, 0xD8, 0xB0, 0xE2, 0x36, 0x15, 
+0x2C, 0x80, 0x6F, 0x7B, 0x98, 0x65, 0xF9, 0xEB, 
+0xC9, 0x3B, 0x1B, 0xFA, 0x3B, 0x88, 0xF2, 0x28, 
+0x7E, 0x01, 0x72, 0xC6, 0x0E, 0x89, 0xC5, 0x65, 
+0x72, 0x9D, 0x0E, 0x1B, 0x62, 0x5C, 0xA9, 0xD9, 
+0x59, 0x37, 0x7C, 0x89, 0x37, 0xB6, 0x9E, 0xD8, 
+0x9C, 0xB0, 0x3F, 0x05, 0xC8, 0x91, 0xE4, 0xBF, 
+0x40, 0xC7, 0x41, 0xA6, 0x01, 0x0A, 0xF6, 0xDA, 
+0xCB, 0x04, 0xED, 0x56, 0xEC, 0x7F, 0x97, 0x42, 
+0xB4, 0xA2, 0x58, 0x84, 0x5F, 0x29, 0x19, 0xD6, 
+0x1D, 0xAC, 0x32, 0x9A, 0x49, 0x2A, 0xCF, 0x35, 
+0x59, 0x8A, 0x24, 0xC5, 0xBD, 0x58, 0xBE, 0x48, 
+0xAC, 0xC0, 0x46, 0xD9, 0xC7, 0x7E, 0xE8, 0x5B, 
+0xE2, 0x2E, 0xD0, 0x86, 0xE3, 0x7B, 0x24, 0xB6, 
+0x43, 0xBE, 0x6E, 0x45, 0x85, 0xF3, 0x5E, 0x33, 
+0x65, 0xAA, 0x61, 0x3F, 0xF2, 0x4E, 0x54, 0x89, 
+0xEB, 0x8F, 0x4A, 0xEE, 0xAF, 0xBA, 0x72, 0x4A, 
+0xEC, 0xE2, 0xCD, 0x67, 0xD0, 0xC1, 0x64, 0xEC, 
+0xB8, 0x7C, 0xEB, 0xEF, 0xF2, 0x1F, 0x4C, 0x0F, 
+0xB4, 0xE5, 0x9F, 0x96, 0xA2, 0xEB, 0xEB, 0xE5, 
+0x4E, 0xAB, 0xAB, 0x57, 0xB3, 0x42, 0xBD, 0x9F, 
+0x72, 0xE7, 0x11, 0xF6, 0x45, 0x83, 0x01, 0xA2, 
+0x79, 0xC8, 0x5F, 0xBF, 0xB5, 0x3F, 0xBA, 0xF3, 
+0xFA, 0x12, 0x84, 0xA8, 0xC3, 0x33, 0xAB, 0x1E, 
+0x50, 0x8A, 0x6B, 0x40, 0xDB, 0xE8, 0x26, 0xAC, 
+0x82, 0x7A, 0x2A, 0x7B, 0xFE, 0xB5, 0x3D, 0xB2, 
+0xA6, 0xC1, 0xC7, 0xA6, 0x08, 0x6B, 0xDD, 0x24, 
+0x76, 0x6B, 0x96, 0x35, 0x60, 0x91, 0xA9, 0xE1, 
+0x02, 0xDE, 0xD3, 0x1F, 0x85, 0xF7, 0x8A, 0xF0, 
+0x0D, 0x4F, 0x45, 0x8C, 0xC4, 0xC0, 0xC2, 0x57, 
+0xB0, 0xAD, 0x37, 0x0F, 0x13, 0xA3, 0xA4, 0x48, 
+0xF4, 0x63, 0x6B, 0x0E, 0xC3, 0x5B, 0xD3, 0xB4, 
+0xE6, 0x70, 0x65, 0x8A, 0x21, 0x8E, 0x29, 0x1B, 
+
+0x63, 0x84, 0xE9, 0x96, 0xD7, 0x59, 0xD7, 0x5D, 
+0xB6, 0x7B, 0x23, 0x8A, 0xFA, 0x80, 0x18, 0x5C, 
+0x52, 0x64, 0x78, 0x7C, 0x7A, 0x8C, 0x41, 0xC0, 
+0xF3, 0xEC, 0x46, 0x09, 0x18, 0x13, 0x39, 0x90, 
+0x14, 0x4D, 0x29, 0x46, 0xFC, 0x0A, 0xA2, 0xA6, 
+0x18, 0xA8, 0xE3, 0xF8, 0x71, 0x54, 0x5D, 0x70, 
+0x19, 0x45, 0x9C, 0x6E, 0xEF, 0x17, 0x41, 0xB2, 
+0xD0, 0xEE, 0x01, 0xAC, 0x44, 0x15, 0x4B, 0x96, 
+0xDF, 0x7C, 0xF1, 0x9A, 0xB0, 0x76, 0x2E, 0x7D, 
+0xFA, 0x49, 0xA3, 0xDA, 0xC7, 0xD9, 0x94, 0xF8, 
+0x05, 0xCE, 0x84, 0xF2, 0xBC, 0xB7, 0x2A, 0x9C, 
+0x55, 0xF6, 0xD6, 0x1B, 0xCF, 0xF3, 0x11, 0x48, 
+0x47, 0x0B, 0xD5, 0x2F, 0x3D, 0xD6, 0xBD, 0x1F, 
+0xAA, 0x4B, 0xB2, 0xE5, 0x38, 0x6B, 0x83, 0xD3, 
+0x3E, 0xA6, 0x3C, 0x69, 0x3F, 0x0C, 0x2E, 0x82, 
+0xFD, 0xA6, 0x25, 0xBA, 0x9C, 0x26, 0x68, 0xCD, 
+0xEC, 0xA6, 0x05, 0x88, 0x6F, 0xA8, 0x9F, 0xD4, 
+0x64, 0x9B, 0xE2, 0x22, 0xD4, 0x0B, 0xBC, 0xEA, 
+0x67, 0x13, 0x8C, 0x32, 0xE8, 0xC3, 0x3F, 0xAD, 
+0x8A, 0x6B, 0xA7, 0xFA, 0x5C, 0xF5, 0xBE, 0x17, 
+0xEE, 0x3B, 0xF1, 0x6F, 0xD8, 0x02, 0x66, 0x3B, 
+0x6F, 0x8D, 0x54, 0x5B, 0xF3, 0x0A, 0xE1, 0x10, 
+0xEB, 0x88, 0xCE, 0x05, 0x34, 0x5A, 0x7E, 0xC0, 
+0x60, 0xB3, 0xC4, 0x41, 0x75, 0xEF, 0x23, 0x62, 
+0x80, 0x28, 0x21, 0x63, 0x5C, 0xF7, 0x10, 0x3C, 
+0x40, 0x3C, 0x95, 0xF5, 0x2D, 0x5B, 0xED, 0xB0, 
+0x7C, 0x62, 0x12, 0x99, 0x4C, 0x33, 0xE6, 0xD3, 
+0xC3, 0x42, 0x72, 0x65, 0xF3, 0x6C, 0x1B, 0xFE, 
+0x4F, 0x6A, 0x07, 0x71, 0x1D, 0x1E, 0x1E, 0x15, 
+0x0F, 0x5E, 0x90, 0x7B, 0xF0, 0x6B, 0x3B, 0xAD, 
+0x46, 0x54, 0xC9, 0x12, 0xE6, 0xBD, 0x11, 0x98, 
+0x7D, 0xFE, 0x0D, 0xDD, 0xEC, 0x7A, 0xBC, 0x92, 
+0x28, 0xF2, 0x5D, 0x9B, 0xD6, 0x11, 0x11, 0x15, 
+0x15, 0x2E, 0xD3, 0x68, 0xCC, 0xF7, 0xDF, 0xE4, 
+0x7F, 
+
+0x4F, 0xA0, 0xFC, 0x60, 0x05, 0xC3, 0x80, 0xD7, 
+0x84, 0x3D, 0x25, 0x82, 0x92, 0x35, 0x46, 0x8E, 
+0x16, 0xCC, 0x34, 0x95, 0xEA, 0x37, 0xF3, 0xA1, 
+0x64, 0xF4, 0x29, 0x4D, 0x4C, 0x05, 0x46, 0xD6, 
+0xD4, 0xC3, 0xC5, 0x58, 0x91, 0x1B, 0x10, 0x54, 
+0x4D, 0x0A, 0xF1, 0xAC, 0x04, 0xDB, 0x2B, 0x2D, 
+0x87, 0x07, 0xBE, 0x8C, 0x2C, 0x7C, 0x7D, 0xD7, 
+0xB6, 0x49, 0x66, 0x5E, 0x72, 0xB7, 0x1D, 0xD6, 
+0x77, 0xFD, 0x77, 0x06, 0x05, 0x79, 0xF4, 0xD2, 
+0x89, 0xFB, 0xA9, 0x35, 0xE0, 0x88, 0x3C, 0x34, 
+0xF0, 0xD1, 0x83, 0x22, 0xA2, 0x01, 0x3B, 0xBA, 
+0x56, 0x7D, 0xD5, 0xC4, 0xEA, 0xD2, 0x99, 0x60, 
+0x05, 0x3F, 0x46, 0xDD, 0xC1, 0x00, 0x07, 0x46, 
+0xCA, 0xAF, 0x9D, 0x0A, 0xBD, 0x49, 0x6F, 0x49, 
+0x6D, 0xE7, 0x1F, 0xC5, 0xDA, 0x3A, 0x0D, 0x9F, 
+0xEC, 0x41, 0x80, 0x87, 0xBA, 0x60, 0x9A, 0xB4, 
+0xC2, 0xA3, 0x51, 0x3A, 0x4D, 0x21, 0x03, 0x21, 
+0x2F, 0x8A, 0x5D, 0x3F, 0xC5, 0xFC, 0xB9, 0xFD, 
+0x4E, 0x5D, 0x7E, 0xC0, 0x5C, 0x4D, 0x8A, 0xE4, 
+0xD2, 0x2F, 0xB2, 0x1C, 0xF3, 0xF0, 0x44, 0x53, 
+0x22, 0x6D, 0xAF, 0xB8, 0x73, 0xAB, 0x0E, 0xBA, 
+0x24, 0x6C, 0x7F, 0xDC, 0x5B, 0x17, 0xE1, 0x62, 
+0xA2, 0x85, 0x4A, 0x25, 0xF0, 0xD5, 0x77, 0x74, 
+0x48, 0xED, 0x30, 0x43, 0x1B, 0xAB, 0xDB, 0x2B, 
+0x32, 0x55, 0x51, 0xCF, 0x37, 0x2C, 0xFD, 0xBC, 
+0xFE, 0x3C, 0x6F, 0x28, 0x2F, 0x19, 0xE4, 0xF7, 
+0xA0, 0x36, 0xA6, 0x3D, 0x29, 0xA4, 0x53, 0x88, 
+0x76, 0xE2, 0xAF, 0x7A, 0x0E, 0x35, 0x5D, 0x45, 
+0xC3, 0x07, 0x63, 0x31, 0x5D, 0x26, 0x3E, 0x01, 
+0xFB, 0xFE, 0xAC, 0xF8, 0xF1, 0xFF, 0x1C, 0x3A, 
+0x9A, 0xCF, 0x7F, 0x93, 0x25, 0xA8, 0x3D, 0x3E, 
+0x4E, 0xD9, 0xF1, 0xD5, 0xF0, 0x50, 0x67, 0x0F, 
+0xB3, 0xF1, 0x70, 0xF5, 0x1D, 0x54, 0x59, 0xF0, 
+0x9E, 0xC9, 0x53, 0xB8, 0x62, 0x7D, 0x55, 0xF6, 
+0x97, 0xDB, 
+
+0x98, 0x20, 0x62, 0x96, 0xDC, 0xEE, 0xB5, 0xDC, 
+0x4E, 0xCB, 0x83, 0xEA, 0x26, 0xA1, 0xA9, 0x4C, 
+0xDF, 0xD9, 0xAA, 0x58, 0x28, 0x25, 0x8C, 0x49, 
+0xDD, 0x5D, 0xA1, 0xFB, 0xB3, 0xA4, 0x50, 0x9C, 
+0xC8, 0x23, 0x89, 0xCC, 0xA3, 0xAE, 0x49, 0xFC, 
+0x6E, 0x31, 0x39, 0xFC, 0x98, 0x47, 0xAB, 0x5A, 
+0x4E, 0xD6, 0x5D, 0x56, 0x4F, 0xE3, 0xD0, 0x66, 
+0x2C, 0x33, 0x55, 0xD1, 0x4E, 0x07, 0xD9, 0xAF, 
+0xD0, 0x93, 0x1E, 0x2E, 0xC2, 0xD9, 0x47, 0x7B, 
+0xF6, 0xA0, 0x74, 0x84, 0x1E, 0x9F, 0x12, 0x9D, 
+0xAA, 0x78, 0x02, 0x6E, 0xED, 0xE7, 0x2E, 0xFD, 
+0xE3, 0x73, 0x4C, 0x85, 0x92, 0x14, 0x2D, 0xB2, 
+0xB4, 0xB0, 0xED, 0x33, 0x63, 0x85, 0x47, 0x9D, 
+0x11, 0xA8, 0x79, 0xE0, 0x7C, 0xFE, 0xB8, 0xC1, 
+0x3A, 0x1D, 0xCD, 0xC0, 0xEE, 0x54, 0xF5, 0xC0, 
+0xC2, 0x9B, 0x2A, 0x87, 0x86, 0x0B, 0x20, 0xE1, 
+0x0E, 0x16, 0xE5, 0x57, 0x21, 0xA5, 0x55, 0x60, 
+0x23, 0x28, 0x24, 0x6A, 0xD0, 0x79, 0x40, 0xAA, 
+0x23, 0xD2, 0x18, 0xC6, 0x4D, 0xC5, 0x2A, 0x39, 
+0xB1, 0xBD, 0xB4, 0xD8, 0xB6, 0x6D, 0x8E, 0xA6, 
+0x31, 0x1E, 0x2D, 0x15, 0x66, 0xD7, 0x05, 0x6E, 
+0x5B, 0x86, 0xB3, 0xC4, 0x55, 0xF3, 0x1D, 0x3D, 
+0x43, 0xF6, 0x15, 0x6D, 0xB4, 0x12, 0xB0, 0xE5, 
+0x36, 0xF6, 0x97, 0x23, 0x27, 0x05, 0x12, 0x8E, 
+0xC6, 0x73, 0xB2, 0xB1, 0x7A, 0x2A, 0xAF, 0x45, 
+0x06, 0x5E, 0x9F, 0x3B, 0x4C, 0x89, 0x00, 0x46, 
+0x34, 0x24, 0x7E, 0xE3, 0x8B, 0xA8, 0x1E, 0xAE, 
+0xDF, 0xB5, 0xA1, 0xC5, 0x45, 0x52, 0x6A, 0xF5, 
+0x40, 0x16, 0x7E, 0x33, 0xAA, 0x15, 0x0A, 0x71, 
+0x13, 0x7E, 0x20, 0x5C, 0xA7, 0x62, 0x39, 0xAF, 
+0xDA, 0x1F, 0x38, 0xB1, 0xDA, 0x39, 0xD7, 0xC8, 
+0x04, 0xF8, 0x5F, 0x75, 0x70, 0x3D, 0xF6, 0x70, 
+0x3C, 0xEC, 0x7A, 0xF6, 0x64, 0x33, 0xEC, 0x6C, 
+0xA2, 0x00, 0x19, 0x0D, 0x79, 0x97, 0x1C, 0x77, 
+0x8F, 0x0E, 0x7B, 
+
+0x2B, 0xFB, 0x27, 0xE9, 0x81, 0x8D, 0xBA, 0x9F, 
+0x79, 0xF8, 0xA4, 0x0B, 0x4B, 0x58, 0x9B, 0xBF, 
+0xF8, 0x26, 0x81, 0x7A, 0x1A, 0x9B, 0x41, 0xF4, 
+0x93, 0x6D, 0x7E, 0xAA, 0x6E, 0x7E, 0xE8, 0x41, 
+0x99, 0x6A, 0xB3, 0xE7, 0x00, 0x0F, 0x8F, 0x1F, 
+0x1D, 0x7B, 0x8D, 0x7A, 0x4B, 0x60, 0x9E, 0x65, 
+0xA2, 0x27, 0x80, 0x0D, 0xDB, 0x66, 0xB7, 0x6D, 
+0x4F, 0x2D, 0xAF, 0xAD, 0x2A, 0xE9, 0x7C, 0x14, 
+0x04, 0xC4, 0x66, 0xCC, 0x30, 0x67, 0xAB, 0xC1, 
+0x46, 0x3D, 0x52, 0xEF, 0xC6, 0xDF, 0xBD, 0x5A, 
+0xB5, 0xFB, 0xC0, 0x29, 0xCE, 0xD8, 0xC8, 0x30, 
+0xF3, 0xB1, 0x1F, 0xB3, 0xAD, 0x2A, 0x84, 0x40, 
+0x73, 0x07, 0x50, 0xE8, 0x78, 0x30, 0x5B, 0xAF, 
+0x18, 0xF9, 0x52, 0xB0, 0x89, 0x81, 0x66, 0xAA, 
+0x26, 0x89, 0x9A, 0x70, 0x8A, 0xF4, 0x5D, 0x91, 
+0x26, 0x3A, 0x8E, 0xAD, 0x4E, 0x61, 0x2F, 0x9E, 
+0x5E, 0x24, 0xB8, 0x99, 0xC5, 0xC0, 0x88, 0x9C, 
+0xCD, 0x76, 0x1C, 0x6A, 0x69, 0xE3, 0xC8, 0x20, 
+0x83, 0x90, 0x97, 0x94, 0xA7, 0x24, 0x67, 0x69, 
+0xC0, 0xE2, 0xDC, 0xC6, 0x2A, 0x44, 0x17, 0x1F, 
+0x2F, 0x33, 0xC5, 0xB5, 0x63, 0xD1, 0xD8, 0x7A, 
+0x11, 0xC1, 0x91, 0xB8, 0x81, 0x9F, 0x9A, 0x69, 
+0xBF, 0x77, 0x75, 0x21, 0xA0, 0x02, 0x19, 0xAB, 
+0xE7, 0xD8, 0x43, 0xFF, 0x6F, 0xAB, 0xD2, 0xF3, 
+0x07, 0x99, 0xE2, 0x0D, 0xC2, 0x47, 0x85, 0xCD, 
+0x5D, 0xAD, 0x7C, 0xA6, 0xAF, 0x25, 0x2B, 0xA4, 
+0x3C, 0x04, 0x25, 0x16, 0xEA, 0xCF, 0x8D, 0x29, 
+0x17, 0x62, 0x34, 0xDB, 0x9C, 0xB5, 0xE7, 0xB6, 
+0x0E, 0x8D, 0xE0, 0x57, 0x64, 0xE8, 0x54, 0xF0, 
+0x17, 0x92, 0xD3, 0x41, 0xC9, 0x96, 0x1C, 0xE1, 
+0xE7, 0x9B, 0x78, 0x31, 0xDD, 0x05, 0xC9, 0x73, 
+0xCC, 0x88, 0x12, 0x82, 0x04, 0xB5, 0xBC, 0x3A, 
+0xAA, 0xCE, 0xEA, 0x2E, 0xEB, 0xC6, 0x0B, 0xC7, 
+0xBF, 0x3F, 0xCC, 0xB1, 0xED, 0x4F, 0x8D, 0x8A, 
+0xFD, 0x21, 0xBF, 0x79, 
+
+0xF0, 0x0A, 0x97, 0xD5, 0x8F, 0xFE, 0x1E, 0x20, 
+0x19, 0x16, 0x43, 0xA9, 0x07, 0x71, 0xC6, 0xFA, 
+0x51, 0xF8, 0xD2, 0x4B, 0x11, 0x29, 0x5D, 0xE3, 
+0xD5, 0x40, 0xB7, 0x22, 0xA9, 0x85, 0x3C, 0xAB, 
+0xB7, 0x8C, 0x89, 0x7A, 0x32, 0x39, 0x26, 0x5D, 
+0x25, 0x1A, 0xFE, 0x89, 0xA4, 0x86, 0x33, 0x7C, 
+0x49, 0xC5, 0x49, 0xB2, 0xC1, 0x52, 0xF3, 0x01, 
+0x2A, 0x20, 0x84, 0xDF, 0xD3, 0x68, 0x7C, 0xF6, 
+0x74, 0x24, 0xD5, 0x6D, 0x35, 0xBB, 0x4E, 0xD3, 
+0x87, 0x29, 0xBF, 0xD4, 0x6F, 0xFA, 0x69, 0xAB, 
+0x59, 0xD4, 0xB3, 0xD5, 0x83, 0x72, 0xEC, 0x63, 
+0x17, 0x05, 0xFB, 0x3E, 0xE9, 0x44, 0x98, 0x4C, 
+0x01, 0x7D, 0xAD, 0xC8, 0xD2, 0xF6, 0xD7, 0x0D, 
+0x89, 0xD0, 0x41, 0xD2, 0x4B, 0x98, 0x25, 0xE2, 
+0xE9, 0x5F, 0x02, 0x5C, 0xAA, 0x09, 0x7F, 0x96, 
+0x50, 0x32, 0x05, 0xE2, 0x8F, 0xC9, 0xC2, 0x88, 
+0xBA, 0x7D, 0xC0, 0x1A, 0x6E, 0x1D, 0x18, 0x4E, 
+0x3A, 0x6E, 0x11, 0x76, 0xC9, 0xCA, 0x6F, 0xDA, 
+0xE6, 0xC2, 0x53, 0x1F, 0x3B, 0x64, 0xF2, 0xF8, 
+0x6A, 0xA3, 0x6A, 0x16, 0x01, 0x2F, 0x87, 0xB2, 
+0x6A, 0x7E, 0x9F, 0x9F, 0x61, 0x25, 0x58, 0x26, 
+0x72, 0x3A, 0x55, 0xD8, 0xBD, 0x99, 0xCC, 0xC5, 
+0x29, 0x2A, 0x6A, 0xA2, 0x1D, 0xAB, 0xD6, 0x80, 
+0x10, 0x3C, 0x51, 0xC2, 0x48, 0x10, 0xE9, 0xB3, 
+0x54, 0xA8, 0xA1, 0x61, 0xCC, 0x94, 0x34, 0x6A, 
+0xB8, 0x13, 0x8F, 0xEE, 0x53, 0x4F, 0x9D, 0x2D, 
+0x5A, 0x95, 0xDD, 0x85, 0x08, 0x70, 0xB3, 0xC5, 
+0x7F, 0xB6, 0xC7, 0x1C, 0x7A, 0x2B, 0x3D, 0x81, 
+0x5E, 0x38, 0x34, 0xBD, 0x3E, 0x13, 0x5A, 0xB5, 
+0x26, 0x25, 0xD0, 0xCB, 0x09, 0x8E, 0xBA, 0x55, 
+0x69, 0x17, 0x3D, 0x94, 0x14, 0x12, 0xA5, 0xAA, 
+0xF1, 0x69, 0xBD, 0x2D, 0x2B, 0xCE, 0x1D, 0xD7, 
+0x70, 0x3C, 0xAD, 0x85, 0x2C, 0x27, 0xEC, 0xF8, 
+0x3B, 0xF6, 0xD9, 0xC8, 0xAB, 0xB4, 0xE0, 0xE9, 
+0x26, 0x76, 0x93, 0x69, 0x8C, 
+
+0x27, 0x1C, 0x3C, 0x6E, 0xD1, 0x5E, 0xD4, 0xBC, 
+0x9B, 0x7C, 0xCC, 0x10, 0xAE, 0x39, 0x52, 0xF4, 
+0xB2, 0x8C, 0x4E, 0xAC, 0xF2, 0x32, 0x57, 0x0C, 
+0x39, 0x76, 0x68, 0x50, 0xFA, 0x37, 0x13, 0xB4, 
+0x01, 0xC7, 0xDB, 0x56, 0x5F, 0xD9, 0x59, 0xEF, 
+0xDA, 0x8F, 0x7A, 0x0E, 0xED, 0x23, 0x03, 0x7F, 
+0xBE, 0x95, 0x17, 0x92, 0x11, 0x88, 0xA5, 0x84, 
+0x54, 0x64, 0x1F, 0x7C, 0xFC, 0x19, 0x31, 0x33, 
+0x4B, 0xEE, 0x72, 0x94, 0x04, 0x56, 0xFD, 0x9A, 
+0xE6, 0xB8, 0x14, 0xF7, 0x02, 0xA3, 0xE2, 0x35, 
+0xB7, 0x59, 0x85, 0x9A, 0xCC, 0x20, 0x10, 0xAA, 
+0xB1, 0x4E, 0x71, 0xD5, 0xDA, 0x08, 0x6F, 0xF0, 
+0x1B, 0x64, 0xA2, 0xC8, 0x91, 0xFA, 0xC4, 0xF1, 
+0xC1, 0x0E, 0x83, 0x44, 0xE6, 0xB2, 0x26, 0x8E, 
+0xB3, 0x13, 0xCE, 0x47, 0xA3, 0x78, 0x19, 0x91, 
+0x6F, 0x7B, 0x8C, 0xD7, 0xC9, 0xB1, 0x7B, 0x75, 
+0x94, 0xEE, 0x3E, 0xFF, 0x1D, 0x09, 0x76, 0xD8, 
+0xE9, 0x19, 0xEF, 0xEC, 0xC2, 0x63, 0x8D, 0xE5, 
+0x21, 0xB0, 0x37, 0xA8, 0x74, 0xDA, 0x64, 0xBF, 
+0x48, 0xEF, 0x9D, 0x3E, 0x17, 0xC7, 0x6B, 0x22, 
+0x4B, 0xE6, 0xE1, 0x6D, 0xD6, 0x4A, 0x57, 0x02, 
+0x89, 0x06, 0x07, 0xCB, 0x9A, 0xCA, 0x0A, 0x7F, 
+0x93, 0x40, 0x45, 0x72, 0x94, 0x26, 0xAD, 0xEA, 
+0x5C, 0xCE, 0x2E, 0x3A, 0x60, 0x19, 0xED, 0x96, 
+0x8C, 0x99, 0x33, 0x68, 0x85, 0xB2, 0x32, 0xF2, 
+0x4C, 0xD0, 0x57, 0x23, 0x76, 0xE4, 0xCB, 0x2E, 
+0x30, 0xC0, 0xEB, 0x7D, 0x32, 0xB0, 0x5B, 0x4D, 
+0x41, 0xCA, 0x06, 0x04, 0x41, 0x71, 0x27, 0x40, 
+0x8F, 0xD7, 0x7F, 0x46, 0xB0, 0xDE, 0x42, 0x09, 
+0x5E, 0x85, 0xE9, 0xE2, 0x47, 0xFA, 0x8D, 0xF6, 
+0xAF, 0x2E, 0x41, 0xFC, 0x36, 0x13, 0xA6, 0xBC, 
+0x3E, 0x46, 0x4F, 0x8A, 0xCB, 0x92, 0xC6, 0xB7, 
+0xF9, 0xAA, 0x71, 0x44, 0xB1, 0x89, 0x6A, 0x9B, 
+0xDE, 0xD5, 0xE4, 0x02, 0x2F, 0x15, 0x91, 0x17, 
+0x37, 0xA6, 0xC9, 0xB8, 0xF3, 0x38, 
+
+0xB3, 0xE7, 0x6C, 0x27, 0x14, 0xE7, 0x4F, 0x01, 
+0x22, 0xA2, 0x15, 0x7B, 0xC8, 0x92, 0xBA, 0x62, 
+0x1C, 0xFE, 0xE2, 0x8F, 0xF8, 0xFF, 0x6A, 0x30, 
+0xAF, 0x84, 0xC2, 0x37, 0xFC, 0xB3, 0x1F, 0x92, 
+0x33, 0xD3, 0xFF, 0xCF, 0x2C, 0xBB, 0x75, 0x7F, 
+0x83, 0x2D, 0xD4, 0x8E, 0x22, 0x95, 0x68, 0x5A, 
+0xA0, 0xB6, 0x81, 0xFF, 0x72, 0x2C, 0x7B, 0xD6, 
+0x8B, 0x92, 0x61, 0xC0, 0x60, 0x3F, 0xAA, 0x3E, 
+0x68, 0xDF, 0x3D, 0x49, 0xC9, 0x3C, 0xD0, 0x0B, 
+0xA7, 0x8C, 0xAB, 0x1F, 0x17, 0xEB, 0x48, 0xCB, 
+0x6D, 0xE7, 0x02, 0x6C, 0xA8, 0x25, 0xDD, 0xF0, 
+0xC9, 0xA2, 0x71, 0xDE, 0xCE, 0xF4, 0xE1, 0xB7, 
+0x0B, 0xFB, 0x5C, 0x6E, 0x35, 0xA1, 0x62, 0x8F, 
+0x1E, 0x8C, 0xB5, 0x57, 0x6A, 0x58, 0xB0, 0xA1, 
+0xF1, 0x25, 0xEF, 0xF9, 0xE6, 0x83, 0x9F, 0x39, 
+0x42, 0x17, 0x9B, 0x67, 0x01, 0xA5, 0xC5, 0x22, 
+0x7F, 0x37, 0xE7, 0x39, 0x3D, 0x97, 0x00, 0xD0, 
+0xC9, 0x69, 0x83, 0x19, 0x69, 0x30, 0xC9, 0x59, 
+0x02, 0x35, 0x36, 0x63, 0x67, 0xD1, 0x84, 0x24, 
+0x40, 0x1E, 0x8A, 0x16, 0x6B, 0x10, 0x93, 0x44, 
+0xB9, 0x09, 0xD1, 0x74, 0x0D, 0x6D, 0x09, 0xB1, 
+0xBB, 0x68, 0x00, 0xEB, 0x71, 0xDC, 0xB8, 0x79, 
+0xDD, 0x00, 0x1D, 0x28, 0x4F, 0x98, 0x57, 0x85, 
+0x97, 0xDF, 0x0A, 0xBA, 0xF7, 0xCA, 0x6E, 0x08, 
+0xD4, 0x89, 0x6F, 0x8E, 0xBC, 0x2D, 0x82, 0x84, 
+0x7B, 0x3E, 0xB0, 0x0E, 0x34, 0x70, 0xEA, 0x28, 
+0xF3, 0xE4, 0x15, 0xD5, 0xE7, 0x1B, 0x0B, 0x46, 
+0x00, 0xE2, 0xBE, 0xF9, 0xFC, 0x9E, 0x9F, 0x17, 
+0x7C, 0xF2, 0x7F, 0x89, 0xDB, 0x71, 0xBC, 0x97, 
+0x9B, 0x2B, 0x03, 0xD8, 0x03, 0xFD, 0x61, 0xC6, 
+0x15, 0x04, 0x37, 0x76, 0x65, 0x9E, 0x6F, 0xB0, 
+0xF1, 0x9C, 0x20, 0x85, 0xCE, 0xDA, 0x53, 0x7B, 
+0x9F, 0x83, 0xD5, 0x53, 0x09, 0xFD, 0xCE, 0x06, 
+0x53, 0x64, 0x64, 0xA4, 0xE6, 0x56, 0x86, 0x00, 
+0xE3, 0x90, 0xA4, 0xDB, 0xE2, 0x12, 0xF9, 
+
+0xBB, 0xE1, 0xAD, 0xFD, 0xB5, 0x19, 0xB3, 0xC5, 
+0x6F, 0xAC, 0x40, 0x44, 0xBA, 0xF3, 0xFE, 0x40, 
+0x1A, 0x57, 0x22, 0xAF, 0x5C, 0x03, 0x2E, 0x23, 
+0xA6, 0xDB, 0x55, 0x7A, 0xDE, 0x68, 0xFE, 0x4C, 
+0xAC, 0x80, 0x8C, 0x3E, 0x0E, 0xE2, 0x84, 0x7C, 
+0x35, 0x05, 0x3F, 0xCB, 0x03, 0xF1, 0x78, 0x05, 
+0x48, 0x71, 0x0B, 0xB5, 0x9E, 0xF2, 0xE8, 0x0A, 
+0x58, 0xA7, 0x8C, 0xA3, 0x84, 0x1D, 0xF9, 0xE3, 
+0xBB, 0x71, 0x5D, 0x27, 0x44, 0x9F, 0x82, 0x1A, 
+0xF8, 0xBE, 0xDD, 0xEA, 0x6B, 0x36, 0xAE, 0xC4, 
+0xD3, 0x55, 0x27, 0xCC, 0x1B, 0x93, 0xD0, 0xE5, 
+0x02, 0x89, 0xBF, 0x03, 0xA3, 0x4F, 0xB4, 0xA7, 
+0x37, 0x4B, 0xD5, 0x35, 0xAE, 0x1D, 0x41, 0x84, 
+0xB7, 0x73, 0x04, 0x10, 0x50, 0x44, 0x97, 0xAE, 
+0x3A, 0xEF, 0x2B, 0x45, 0x7D, 0xFF, 0xF0, 0xEC, 
+0x46, 0xF4, 0x5F, 0xAC, 0xE7, 0x0C, 0x22, 0xBE, 
+0x3E, 0xEE, 0x99, 0x5E, 0xCB, 0x62, 0x9A, 0x20, 
+0xA8, 0xB0, 0x85, 0x1A, 0xF0, 0xD7, 0x0F, 0xC1, 
+0x04, 0x7B, 0x49, 0xB2, 0x82, 0x3F, 0xA2, 0x15, 
+0xBA, 0x22, 0xAC, 0xEA, 0x49, 0x4A, 0x2D, 0xCF, 
+0xE6, 0xB6, 0x0E, 0x1D, 0x38, 0xB7, 0x42, 0x9F, 
+0x83, 0xDA, 0xCC, 0x31, 0x72, 0x02, 0x63, 0xAC, 
+0x8A, 0x02, 0xDF, 0x15, 0xC8, 0x4C, 0x12, 0xB7, 
+0x79, 0x05, 0xEE, 0xF8, 0x46, 0xA3, 0xF0, 0x1B, 
+0xD6, 0x2F, 0xF2, 0x82, 0xC2, 0x43, 0xD1, 0x37, 
+0x27, 0x88, 0xB8, 0x24, 0xF9, 0x07, 0x68, 0x41, 
+0x9E, 0x1C, 0x0B, 0x5C, 0xC5, 0x50, 0x4B, 0xD1, 
+0xA4, 0xC6, 0xB0, 0xE7, 0xFB, 0x88, 0x2C, 0x55, 
+0xA5, 0x3E, 0x45, 0xF2, 0xA7, 0xC8, 0xD5, 0x0A, 
+0x0E, 0xAA, 0x3A, 0xC1, 0x77, 0xF6, 0x69, 0xC6, 
+0x31, 0xE7, 0x7B, 0xE2, 0x00, 0xEB, 0xA9, 0x10, 
+0x8F, 0x16, 0xEA, 0x70, 0x89, 0xA8, 0xBF, 0x57, 
+0xB8, 0xC3, 0x13, 0xF3, 0x40, 0x82, 0xBD, 0x02, 
+0x01, 0x0B, 0xDB, 0xEF, 0x1E, 0xB2, 0xC3, 0xC1, 
+0xC4, 0x06, 0xF9, 0xC5, 0xB7, 0xBF, 0xEA, 0x05, 
+
+0xDB, 0x2F, 0x88, 0xBC, 0xB1, 0xD1, 0x4F, 0x47, 
+0x90, 0xC6, 0x30, 0xB7, 0xDE, 0x32, 0x20, 0x9C, 
+0x8A, 0x52, 0xD3, 0x33, 0xC9, 0x34, 0xBA, 0x12, 
+0x59, 0xB2, 0x7A, 0xB7, 0x9A, 0x2B, 0x62, 0x1A, 
+0xEB, 0x11, 0xE4, 0xBF, 0x55, 0x5D, 0xE9, 0xB3, 
+0xC3, 0x64, 0x27, 0x8A, 0x24, 0xF2, 0x68, 0x1D, 
+0x44, 0x0F, 0xE5, 0x10, 0x89, 0xC3, 0xED, 0xEE, 
+0xEE, 0xA4, 0xDA, 0xD9, 0x96, 0x35, 0xFF, 0x06, 
+0xD7, 0x5E, 0x8E, 0x67, 0xB9, 0x1B, 0x03, 0x2C, 
+0xCB, 0x81, 0x0C, 0x40, 0x19, 0x5E, 0xAC, 0xC5, 
+0x9D, 0x5F, 0xF8, 0x8C, 0x49, 0x90, 0x39, 0x79, 
+0x2C, 0x90, 0xA9, 0x5A, 0x4C, 0x27, 0x69, 0xE6, 
+0x1C, 0x3B, 0x47, 0x9A, 0xC1, 0xD7, 0x85, 0x3E, 
+0x8B, 0x98, 0xDA, 0x38, 0xE2, 0x4C, 0x42, 0x51, 
+0xAF, 0xA9, 0x22, 0x43, 0x8A, 0xE2, 0x54, 0x33, 
+0x3A, 0xEF, 0x4F, 0x08, 0x19, 0x10, 0x85, 0x65, 
+0x68, 0x6D, 0x83, 0xF8, 0x08, 0x36, 0x74, 0xE4, 
+0x24, 0xD8, 0x45, 0x28, 0xA5, 0x2F, 0xA8, 0x3B, 
+0x91, 0xB5, 0x3A, 0xD5, 0x76, 0x8F, 0x11, 0xA4, 
+0x75, 0x89, 0x39, 0xCE, 0xB0, 0xAE, 0xA6, 0x92, 
+0xCE, 0x01, 0xC6, 0xAC, 0xDF, 0x3F, 0xD0, 0xEE, 
+0xDA, 0xD3, 0x5A, 0x2A, 0xED, 0x4D, 0xD0, 0x60, 
+0xA0, 0x9B, 0xAE, 0x2C, 0xEE, 0xFF, 0xB6, 0x00, 
+0x0A, 0x6B, 0x08, 0xD9, 0xBA, 0x40, 0xD4, 0xB0, 
+0x31, 0x48, 0x39, 0xBA, 0x0A, 0xCF, 0xE5, 0xFE, 
+0x69, 0xBC, 0x59, 0x42, 0xB5, 0x05, 0xEE, 0xCB, 
+0x58, 0x0D, 0xD9, 0xD8, 0xA6, 0x90, 0x36, 0x77, 
+0x41, 0xC8, 0x4C, 0x63, 0xF1, 0xDE, 0x41, 0x44, 
+0xBC, 0xEE, 0x72, 0xEA, 0xF4, 0x59, 0x0D, 0xCB, 
+0x04, 0x66, 0x4B, 0x97, 0x71, 0x74, 0x3F, 0x41, 
+0x5E, 0x8F, 0x25, 0x3B, 0x6B, 0x9E, 0x0C, 0x0E, 
+0x92, 0xB9, 0x2F, 0x2B, 0x09, 0xBC, 0xD8, 0xAF, 
+0x8E, 0xB4, 0xFE, 0x38, 0xA1, 0xE3, 0x66, 0xF7, 
+0xD2, 0x59, 0x3A, 0x04, 0x1B, 0x61, 0x80, 0xF5, 
+0x10, 0x49, 0xB1, 0xF2, 0x6E, 0x6A, 0xBF, 0xB1, 
+0x32, 
+
+0x5B, 0x29, 0xC6, 0x24, 0x6C, 0x92, 0xBB, 0x71, 
+0x16, 0xAE, 0xFA, 0x34, 0x66, 0x32, 0xEF, 0x16, 
+0x92, 0x8B, 0x20, 0x61, 0xC1, 0x82, 0x7C, 0xE2, 
+0x5F, 0xF1, 0x26, 0x81, 0x63, 0x5F, 0x72, 0xEE, 
+0xA1, 0x50, 0x0B, 0x61, 0xCE, 0x39, 0x24, 0x28, 
+0x3F, 0x82, 0x86, 0x37, 0x00, 0x46, 0x69, 0xBC, 
+0x0D, 0xB2, 0x8D, 0x9B, 0xFF, 0x7B, 0xE5, 0xD6, 
+0x15, 0xC3, 0xD2, 0xD9, 0x7A, 0x58, 0xE7, 0xDD, 
+0xE7, 0x46, 0x17, 0xC0, 0x7E, 0xFA, 0x9E, 0x2B, 
+0x11, 0x9F, 0x70, 0x67, 0xA8, 0xDA, 0x49, 0x50, 
+0xD7, 0x18, 0xD6, 0x2F, 0x45, 0x28, 0xA1, 0x4E, 
+0xC1, 0x62, 0xDA, 0x74, 0x81, 0x67, 0xDE, 0xEB, 
+0xD1, 0x3B, 0xD5, 0xC9, 0x03, 0x4F, 0x42, 0xEF, 
+0x28, 0x92, 0x65, 0xC7, 0x2E, 0xB0, 0xAC, 0x4D, 
+0x00, 0xE4, 0xC4, 0x0A, 0xA5, 0x23, 0xDB, 0x6E, 
+0x39, 0x6E, 0x84, 0x6F, 0x0F, 0x8D, 0x57, 0xF6, 
+0x65, 0x44, 0x99, 0xBD, 0xB6, 0xE1, 0x4B, 0x50, 
+0xF9, 0x28, 0xB6, 0x30, 0x35, 0xB8, 0xE2, 0x3F, 
+0x80, 0x55, 0x1E, 0x42, 0x2A, 0x92, 0xD6, 0x1C, 
+0xF5, 0xE0, 0x22, 0x2D, 0x6C, 0x8F, 0x47, 0x83, 
+0x84, 0x04, 0x62, 0x80, 0x51, 0x83, 0x1E, 0x45, 
+0x37, 0xFD, 0x39, 0xBD, 0x70, 0x11, 0x29, 0xD5, 
+0x78, 0xEE, 0x4C, 0x1E, 0xA3, 0x8F, 0x06, 0x81, 
+0x87, 0x5C, 0x47, 0xF1, 0x1A, 0xEC, 0xB0, 0xD6, 
+0x08, 0xE3, 0x38, 0x92, 0xF9, 0x5B, 0xA8, 0xF8, 
+0x2B, 0x1E, 0x75, 0x33, 0x2E, 0x79, 0x60, 0x4C, 
+0x32, 0x8D, 0x8D, 0x43, 0xFB, 0x0B, 0x66, 0x1B, 
+0xFB, 0xFB, 0x48, 0xD1, 0xD0, 0x4D, 0x8F, 0x09, 
+0xC5, 0x50, 0xAF, 0x2F, 0x87, 0x15, 0x2F, 0x90, 
+0x53, 0xE4, 0x92, 0x0C, 0x4E, 0x2A, 0x55, 0x31, 
+0xA8, 0x22, 0x0F, 0x8E, 0xFB, 0xA1, 0xFC, 0x10, 
+0x7A, 0x69, 0x16, 0x69, 0x75, 0xA1, 0xED, 0x7E, 
+0xD7, 0x45, 0xB9, 0xD6, 0x53, 0x2F, 0x69, 0xD6, 
+0xED, 0xFD, 0x9E, 0xDB, 0x25, 0x7B, 0x11, 0x18, 
+0x35, 0xC5, 0xD8, 0x38, 0x05, 0x04, 0x4D, 0x6D, 
+0x0E, 0xE4, 
+
+0x41, 0x44, 0x44, 0xE0, 0x5F, 0x8D, 0x50, 0x33, 
+0x79, 0xC0, 0x8D, 0x95, 0xDC, 0x80, 0xA5, 0xDE, 
+0x42, 0x8D, 0x09, 0x06, 0x2E, 0x5D, 0x33, 0x01, 
+0xD3, 0xC7, 0x02, 0xAD, 0x17, 0x6D, 0x83, 0x3E, 
+0xB7, 0xEA, 0x86, 0x86, 0xDF, 0x6D, 0xD6, 0x9F, 
+0xD8, 0x3F, 0xDF, 0x46, 0x29, 0xBC, 0x1C, 0x90, 
+0xA5, 0x54, 0x0D, 0x1D, 0x0E, 0x5F, 0xBF, 0x59, 
+0x41, 0x15, 0xAA, 0x0B, 0x9E, 0xAC, 0x82, 0x9F, 
+0x6A, 0x52, 0x35, 0x01, 0xD0, 0x6F, 0x3F, 0xA0, 
+0xAE, 0x12, 0x11, 0xFF, 0x13, 0x92, 0x94, 0x6C, 
+0xBF, 0x26, 0xBB, 0x1C, 0x73, 0xE2, 0x63, 0xA5, 
+0x15, 0x9A, 0x76, 0xEB, 0x6F, 0xB3, 0xEC, 0x4C, 
+0x70, 0x98, 0xE4, 0x42, 0x04, 0x69, 0xE9, 0x00, 
+0xE0, 0x8C, 0x17, 0x81, 0x42, 0x95, 0x93, 0x0C, 
+0xD4, 0x4F, 0x06, 0x45, 0x73, 0x4B, 0x22, 0xB0, 
+0xE9, 0x65, 0xA5, 0x16, 0x7D, 0xFE, 0x08, 0x13, 
+0x53, 0x6C, 0x2C, 0x03, 0x4E, 0x07, 0xC7, 0x7F, 
+0x7D, 0x13, 0x3A, 0x41, 0x2A, 0x0D, 0x92, 0x78, 
+0x79, 0x89, 0x09, 0x9E, 0x8E, 0x0B, 0x04, 0xE5, 
+0x34, 0xB7, 0x11, 0xAD, 0x88, 0xD9, 0x3C, 0x17, 
+0x35, 0x94, 0xA4, 0x5B, 0xA6, 0xA1, 0x39, 0x2C, 
+0x2F, 0x24, 0x0F, 0x74, 0x2B, 0x07, 0x10, 0xD8, 
+0xF0, 0x88, 0x30, 0x51, 0xE5, 0xFC, 0xF0, 0x89, 
+0x58, 0xFA, 0xC5, 0xCA, 0xE0, 0x38, 0x5A, 0xEA, 
+0x3F, 0xEB, 0xCF, 0x0C, 0x7A, 0x68, 0xB5, 0xD2, 
+0x80, 0x41, 0xA5, 0x7B, 0xAA, 0x53, 0x17, 0xBF, 
+0xCD, 0x95, 0xA8, 0x8B, 0x6C, 0x11, 0x18, 0xC8, 
+0x40, 0x61, 0xA6, 0x64, 0x82, 0x7D, 0xB7, 0xF9, 
+0xB4, 0x09, 0xC2, 0xD8, 0xAE, 0xFA, 0x95, 0x4A, 
+0xDD, 0xFD, 0x27, 0x66, 0xD6, 0xE3, 0xCA, 0x7D, 
+0xFA, 0xEE, 0x25, 0x26, 0x2B, 0x12, 0x51, 0x3E, 
+0x76, 0x41, 0x37, 0xFC, 0x01, 0x80, 0xCA, 0x41, 
+0x69, 0xC6, 0xA4, 0x3B, 0x63, 0x76, 0xFD, 0x29, 
+0x44, 0x1A, 0x3E, 0xD3, 0xDC, 0x62, 0xA8, 0xEF, 
+0xE8, 0xDC, 0x89, 0x27, 0x63, 0x44, 0x31, 0xB4, 
+0x70, 0x70, 0x83, 
+
+0x0C, 0x42, 0x95, 0x8A, 0x0F, 0x09, 0x4B, 0x42, 
+0xCE, 0x9C, 0x42, 0x77, 0x48, 0xBE, 0x2F, 0x61, 
+0x86, 0x25, 0xBC, 0x55, 0xD9, 0xF1, 0x8E, 0x2A, 
+0x0E, 0x11, 0x7C, 0xC1, 0xBD, 0x1D, 0x08, 0x54, 
+0x4E, 0x40, 0x64, 0x92, 0x52, 0xFB, 0x48, 0x34, 
+0x47, 0xD0, 0x36, 0x7C, 0x36, 0x7A, 0xCD, 0xFE, 
+0x6C, 0xF1, 0x47, 0x9D, 0x4E, 0x10, 0x0B, 0x50, 
+0xE6, 0x43, 0x8A, 0x08, 0x51, 0xA6, 0xAD, 0xD8, 
+0xF8, 0x23, 0x1A, 0xD7, 0xCE, 0x3E, 0x33, 0xA1, 
+0x28, 0x43, 0xE4, 0x41, 0xEA, 0x97, 0x91, 0x6D, 
+0x79, 0xD6, 0xBE, 0x97, 0x84, 0x81, 0xEA, 0x2C, 
+0xC2, 0x55, 0x1A, 0x93, 0xE4, 0x41, 0xC5, 0xCC, 
+0x20, 0x4A, 0x02, 0x60, 0xEB, 0x1A, 0x95, 0xE3, 
+0xE3, 0x09, 0x14, 0xA1, 0x55, 0x1F, 0x0C, 0xAA, 
+0x39, 0x63, 0xAD, 0x86, 0x63, 0x17, 0x3E, 0x1D, 
+0xCB, 0xC3, 0x69, 0x0C, 0xD6, 0x40, 0xAE, 0x84, 
+0x68, 0xD0, 0xF5, 0xDE, 0x03, 0x4B, 0x0E, 0xB3, 
+0xEF, 0x11, 0x6A, 0xF1, 0x81, 0xCB, 0x16, 0xCE, 
+0x56, 0x8C, 0x61, 0x27, 0xA2, 0x33, 0x9F, 0xF6, 
+0x7A, 0xB5, 0xEB, 0x00, 0x49, 0xF4, 0x5F, 0x5A, 
+0x1C, 0x98, 0x26, 0x69, 0x83, 0xA1, 0xCC, 0x3A, 
+0xB2, 0x40, 0x70, 0x97, 0x57, 0xB9, 0x33, 0x24, 
+0x5E, 0xC4, 0x14, 0x66, 0xC4, 0x55, 0x72, 0xC8, 
+0x1E, 0xCB, 0xF3, 0xC4, 0xBF, 0x9E, 0x88, 0x9B, 
+0x59, 0x43, 0x8A, 0x78, 0x71, 0xA7, 0xAA, 0xD5, 
+0x0B, 0x33, 0xED, 0x5E, 0xC9, 0x8F, 0x85, 0x8C, 
+0x36, 0x1C, 0xCF, 0x87, 0xD0, 0x26, 0xFD, 0x7C, 
+0x90, 0x19, 0x31, 0x22, 0xBB, 0x87, 0xFB, 0x34, 
+0xCB, 0x57, 0xDF, 0x72, 0x8D, 0xA1, 0x1E, 0xD6, 
+0x4E, 0xEF, 0xB1, 0x3C, 0x31, 0x0B, 0x11, 0x5F, 
+0x98, 0x45, 0x66, 0x87, 0x2C, 0x2B, 0xFF, 0xC3, 
+0x31, 0x51, 0xDB, 0x25, 0x79, 0x50, 0x2D, 0xE3, 
+0x76, 0x72, 0x63, 0xDB, 0x80, 0xD9, 0x65, 0xAA, 
+0xCB, 0x8E, 0x5F, 0x1A, 0xB1, 0xA4, 0xD2, 0x72, 
+0xA9, 0x8A, 0x32, 0x0B, 0x8E, 0xD5, 0xBB, 0x7B, 
+0xD6, 0xA9, 0xD7, 0xDD, 
+
+0x42, 0x27, 0xE9, 0xD0, 0xDE, 0xFA, 0xF7, 0xF5, 
+0x31, 0x50, 0x6C, 0x83, 0x10, 0xD3, 0x78, 0xF7, 
+0x8F, 0x5C, 0x10, 0x63, 0x3B, 0x5D, 0x8A, 0x5D, 
+0x6D, 0xF0, 0xD0, 0x5C, 0x05, 0xE0, 0xF7, 0x01, 
+0x39, 0x1C, 0xE6, 0x47, 0x59, 0x81, 0xF6, 0xD0, 
+0xFF, 0x21, 0x85, 0xC3, 0xE0, 0xE9, 0x35, 0x5A, 
+0xDF, 0x8D, 0xA9, 0xD2, 0xC7, 0xB6, 0x91, 0xE5, 
+0x83, 0xE3, 0xD6, 0xC9, 0x61, 0x63, 0x0A, 0x38, 
+0xA0, 0x47, 0x3F, 0xA8, 0xE2, 0xE8, 0xB0, 0xC3, 
+0xCB, 0x7E, 0xC1, 0xA1, 0x8B, 0xFD, 0x35, 0xDC, 
+0xC6, 0xF4, 0x46, 0x80, 0x44, 0xDA, 0x5B, 0x38, 
+0x28, 0xC6, 0x89, 0x72, 0xB7, 0xB1, 0xFC, 0xF9, 
+0x7F, 0x11, 0x31, 0x8C, 0x61, 0x17, 0x8D, 0x8D, 
+0x50, 0x9C, 0x27, 0x35, 0xB4, 0xCE, 0x84, 0xC7, 
+0xBC, 0xF8, 0x5B, 0xD7, 0x1A, 0xB9, 0xEF, 0xA3, 
+0xB4, 0xA8, 0x07, 0x7C, 0x81, 0x63, 0x36, 0xCA, 
+0x68, 0xB0, 0xEB, 0x30, 0x32, 0x31, 0x32, 0x2D, 
+0xAC, 0x50, 0x36, 0x4C, 0xE5, 0xDE, 0xBC, 0xD2, 
+0x6D, 0x1F, 0xBF, 0xE6, 0x17, 0x02, 0x32, 0x54, 
+0xFD, 0xD8, 0x65, 0x1E, 0x0C, 0x70, 0xAF, 0x4B, 
+0x15, 0x7F, 0x78, 0x8A, 0x0F, 0xAC, 0x42, 0x15, 
+0x7D, 0x49, 0x83, 0x51, 0xFE, 0x97, 0xB9, 0xE0, 
+0xAE, 0xF4, 0xB1, 0xFA, 0x14, 0x40, 0xDA, 0x4F, 
+0x68, 0xEB, 0x69, 0x3A, 0x6E, 0xBA, 0xB6, 0xCC, 
+0x24, 0xE8, 0x9C, 0x11, 0x0B, 0x80, 0x63, 0x27, 
+0xD0, 0xDB, 0x56, 0x93, 0x43, 0xC6, 0xF2, 0x9A, 
+0xB6, 0xF1, 0xEC, 0x87, 0xBE, 0x71, 0x29, 0x53, 
+0x65, 0x07, 0x5C, 0xD0, 0xD1, 0x3F, 0xAA, 0x1E, 
+0xBB, 0x71, 0xE5, 0xA9, 0x8C, 0x99, 0x22, 0xC2, 
+0x31, 0xDE, 0x49, 0x35, 0xFD, 0x96, 0x59, 0x38, 
+0x0D, 0xA3, 0x58, 0xA3, 0xE7, 0x5F, 0x7F, 0x7C, 
+0x5B, 0x9D, 0x35, 0x6A, 0x1B, 0x99, 0xA9, 0x6A, 
+0x50, 0xA0, 0xF4, 0x39, 0x75, 0xA6, 0x27, 0xA2, 
+0x07, 0x62, 0x95, 0x59, 0xF3, 0x68, 0x92, 0xA3, 
+0x21, 0xD6, 0x7B, 0x30, 0x54, 0x03, 0x60, 0x78, 
+0xE5, 0x18, 0x5F, 0xE5, 0x4C, 
+
+0x62, 0xF3, 0xE5, 0xB4, 0xEE, 0xA0, 0xF9, 0xD0, 
+0x0E, 0x3D, 0x07, 0x21, 0x4A, 0xA0, 0x60, 0x1D, 
+0x92, 0x4E, 0x06, 0x32, 0xB9, 0x69, 0x9B, 0xF0, 
+0x70, 0xFF, 0xE1, 0x41, 0xBD, 0xAE, 0x34, 0x7E, 
+0x92, 0x01, 0x73, 0x77, 0x61, 0x1A, 0x9A, 0x3F, 
+0x8A, 0x2F, 0x35, 0x74, 0x09, 0x17, 0x0A, 0x2A, 
+0xC4, 0x49, 0xAE, 0xAC, 0xDE, 0x25, 0x6F, 0xD0, 
+0xED, 0x17, 0xBE, 0x11, 0x26, 0x8F, 0xD7, 0xC1, 
+0xDB, 0x8B, 0x56, 0xF9, 0x3D, 0xBB, 0x92, 0xFF, 
+0x77, 0x5F, 0xD4, 0x3B, 0xF6, 0xEC, 0xB1, 0x9E, 
+0x84, 0x4D, 0x3C, 0x28, 0xFC, 0x9D, 0x65, 0x02, 
+0x02, 0x84, 0x18, 0x00, 0x7B, 0x4D, 0x7D, 0x34, 
+0xE4, 0xB2, 0x82, 0xBA, 0x84, 0x7F, 0x10, 0x08, 
+0x5E, 0x11, 0xBA, 0xBF, 0xE8, 0x7F, 0x82, 0x81, 
+0xBC, 0x31, 0xD8, 0xA7, 0x73, 0x7C, 0xBD, 0x49, 
+0xD9, 0xB5, 0xD5, 0xCB, 0x88, 0xA6, 0x52, 0xBD, 
+0xC3, 0xE0, 0x8C, 0x9C, 0x04, 0x28, 0x33, 0x6D, 
+0xF7, 0x20, 0xEA, 0x88, 0x71, 0x3B, 0x02, 0x84, 
+0x4D, 0xDA, 0xAD, 0x1E, 0xD7, 0x33, 0x9D, 0x63, 
+0x20, 0x3E, 0xF2, 0xE8, 0xE9, 0x62, 0xA5, 0x10, 
+0x35, 0xB3, 0x66, 0xFD, 0xCF, 0x41, 0xCB, 0x99, 
+0xE8, 0x49, 0x4A, 0xEF, 0x7F, 0x7D, 0x1E, 0x73, 
+0x36, 0x3F, 0x9B, 0x55, 0xFF, 0x70, 0xCE, 0xD1, 
+0x54, 0xB0, 0x1A, 0x44, 0xF8, 0x82, 0xC7, 0x09, 
+0x7D, 0x67, 0x1E, 0x6B, 0xCB, 0x93, 0xE2, 0xB2, 
+0x28, 0x64, 0x4E, 0xBD, 0x18, 0xA8, 0x8D, 0xBD, 
+0xA2, 0xA1, 0x3D, 0xF7, 0x2A, 0xAD, 0x2D, 0x45, 
+0xC3, 0xAB, 0xE2, 0x06, 0xE5, 0xBE, 0xC0, 0xFE, 
+0x02, 0xD0, 0x5C, 0xE2, 0x83, 0xE1, 0x15, 0x00, 
+0x70, 0xBE, 0xE8, 0x06, 0xA7, 0x78, 0xFC, 0x18, 
+0x3F, 0x97, 0xCB, 0xA9, 0xB5, 0x23, 0x8F, 0x96, 
+0x03, 0x24, 0x8F, 0x88, 0x3A, 0xAD, 0xF4, 0x23, 
+0x11, 0xDD, 0xBA, 0x6D, 0x4C, 0x5E, 0x5C, 0x49, 
+0x07, 0x38, 0x89, 0xD4, 0x7C, 0x78, 0xA6, 0x29, 
+0xDC, 0x1A, 0x01, 0xE7, 0x15, 0x04, 0x8E, 0x1F, 
+0x66, 0xDF, 0x11, 0xF8, 0xF9, 0xF7, 
+
+0xAD, 0x3F, 0xEF, 0xB0, 0x25, 0xA4, 0xE4, 0xA9, 
+0x32, 0x8D, 0x9D, 0x87, 0xF7, 0xE2, 0xBC, 0xC3, 
+0xAE, 0x79, 0xF6, 0xB5, 0xC6, 0x25, 0xC9, 0x9A, 
+0x25, 0x1E, 0xAA, 0x45, 0xCE, 0xF5, 0x89, 0xFA, 
+0x2D, 0x1F, 0x5D, 0x66, 0x12, 0xB5, 0x2A, 0xE3, 
+0x56, 0x44, 0xC6, 0x63, 0xFD, 0xD6, 0x10, 0x19, 
+0x56, 0xFF, 0x92, 0xFB, 0xAC, 0x5E, 0x2E, 0xA4, 
+0x6F, 0x4C, 0x95, 0x5A, 0x6E, 0x26, 0x08, 0x63, 
+0xA7, 0xF1, 0x8C, 0x98, 0x03, 0xAA, 0x6E, 0xD8, 
+0x84, 0xA6, 0x9F, 0xD8, 0xDA, 0x9B, 0x70, 0xC3, 
+0x86, 0xB2, 0x35, 0xA9, 0x4C, 0x4B, 0xA2, 0xAF, 
+0x92, 0x6B, 0x3A, 0x83, 0x50, 0x94, 0x5C, 0xA1, 
+0xB1, 0x81, 0x93, 0xF3, 0xC1, 0xFF, 0x89, 0x1C, 
+0x97, 0x82, 0x1F, 0xA4, 0xE9, 0x77, 0x57, 0xAA, 
+0xD6, 0xF3, 0x68, 0x96, 0xF3, 0xF5, 0x40, 0xBF, 
+0x1D, 0x0C, 0xA4, 0x8F, 0x2A, 0xAF, 0xBF, 0x03, 
+0xE5, 0x8F, 0xFE, 0x67, 0xFC, 0x3C, 0xC1, 0xE5, 
+0xAB, 0x73, 0x37, 0xC3, 0x38, 0x1F, 0x22, 0x47, 
+0xCC, 0x76, 0x16, 0x70, 0x05, 0xDD, 0xA7, 0x45, 
+0x92, 0xAC, 0x03, 0xE8, 0x69, 0x33, 0xE1, 0x95, 
+0xC4, 0x4B, 0xBB, 0xA2, 0xE9, 0x26, 0xF6, 0xB9, 
+0x45, 0x8B, 0xB5, 0x1C, 0x26, 0x9A, 0x00, 0x0D, 
+0x88, 0x08, 0xCA, 0x07, 0xFD, 0x72, 0xDF, 0xF0, 
+0xDF, 0xDD, 0x01, 0xF1, 0xD5, 0xBE, 0x4D, 0x18, 
+0x75, 0xB0, 0x8A, 0x9A, 0x70, 0x3F, 0x77, 0x0B, 
+0xF3, 0xB0, 0x2B, 0x2B, 0xE1, 0xD7, 0x36, 0xD0, 
+0xA5, 0xB5, 0xF5, 0x76, 0x5F, 0xDE, 0x81, 0x37, 
+0x64, 0x42, 0x8C, 0x35, 0xFF, 0x4F, 0x52, 0x17, 
+0x46, 0xD5, 0x62, 0xF1, 0x4E, 0x45, 0x8F, 0xF1, 
+0xF2, 0x6A, 0x64, 0x41, 0x31, 0x48, 0x00, 0xB6, 
+0xDD, 0xED, 0xAD, 0xDA, 0x0D, 0x55, 0xBD, 0x8A, 
+0xA3, 0xCC, 0xA0, 0x8C, 0xE9, 0xE6, 0xED, 0x08, 
+0x98, 0x1C, 0xD1, 0xDA, 0x22, 0x9E, 0xCD, 0x78, 
+0x2E, 0xBD, 0xC9, 0xE6, 0xB6, 0x6E, 0xF9, 0x2B, 
+0xBF, 0x13, 0x93, 0x7F, 0xD5, 0x3E, 0xB5, 0xF3, 
+0x1F, 0x31, 0xB6, 0x15, 0xB3, 0xF8, 0xB4, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,5478 @@
+package utils
+func getkat6444(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x06, 0xF4, 0x22, 0xA9, 0xFE, 0x9F, 0x22, 0xF7, 
+0x61, 0xDF, 0x8A, 0x20, 0xBE, 0x36, 0x06, 0x84, 
+0x02, 0x53, 0x8C, 0x41, 0x12, 0xAA, 0x9D, 0xF7, 
+0x5B, 0x4C, 0x88, 0x66, 0xF6, 0x6B, 0x5F, 0x44, 
+
+0x49, 0xAA, 0x98, 0xDA, 0xA9, 0x85, 0xE1, 0x29, 
+0x1F, 0x11, 0x4B, 0x9D, 0xC7, 0x2C, 0x14, 0xB5, 
+0x5A, 0x04, 0x42, 0xF6, 0xDB, 0x63, 0x06, 0x58, 
+0xB0, 0xC0, 0x48, 0xA6, 0x23, 0x6B, 0xEA, 0x9E, 
+0x0B, 
+
+0xED, 0x19, 0x88, 0x31, 0x44, 0xB3, 0x88, 0xB6, 
+0xA4, 0xFF, 0x13, 0x8E, 0x7A, 0xD4, 0x8A, 0x16, 
+0x7A, 0x74, 0xF8, 0x8C, 0x34, 0x2B, 0x95, 0x44, 
+0x1E, 0xB7, 0x49, 0xB4, 0xB4, 0xFC, 0xDF, 0xFE, 
+0x9F, 0x9E, 
+
+0x37, 0x36, 0x79, 0x58, 0xC3, 0xB6, 0xE8, 0xAE, 
+0xE1, 0xF5, 0x20, 0x2B, 0x97, 0x2B, 0x40, 0x30, 
+0xCC, 0x9D, 0xEE, 0x52, 0x45, 0x6A, 0x11, 0x7F, 
+0xC6, 0xAE, 0x8B, 0x02, 0x1E, 0x34, 0x9D, 0xB3, 
+0x36, 0x70, 0x4A, 
+
+0xB8, 0x38, 0x4F, 0xA8, 0x46, 0xF3, 0x61, 0xA7, 
+0xD6, 0x28, 0xAA, 0x99, 0x96, 0x2F, 0x61, 0xA5, 
+0xDE, 0xC3, 0xA6, 0xDD, 0x2D, 0x83, 0x46, 0x33, 
+0x9F, 0xD2, 0xBD, 0xAE, 0xA4, 0xEA, 0xCE, 0x00, 
+0x11, 0xE4, 0xE6, 0x7A, 
+
+0x2D, 0x4B, 0xDB, 0xE0, 0xAB, 0xC2, 0x02, 0x43, 
+0xFE, 0x4E, 0x04, 0xD7, 0xBE, 0x41, 0xEA, 0x34, 
+0xF1, 0xFE, 0xC2, 0xF7, 0xD0, 0x9B, 0x2D, 0x38, 
+0xC7, 0x02, 0x94, 0x54, 0x77, 0xD2, 0x1E, 0x07, 
+0xFD, 0x38, 0x33, 0x98, 0x4A, 
+
+0xA4, 0xC0, 0x0A, 0x86, 0x48, 0xE1, 0x78, 0x77, 
+0x2E, 0x97, 0xFC, 0x6A, 0xE4, 0x4D, 0xAA, 0x51, 
+0xE6, 0x6A, 0x1D, 0x9B, 0x6A, 0x38, 0x93, 0x35, 
+0x10, 0x5E, 0x7F, 0x69, 0x5A, 0x84, 0x9D, 0xCC, 
+0x40, 0x93, 0xAD, 0x78, 0x7C, 0xB7, 
+
+0x07, 0x9B, 0xE7, 0x22, 0x74, 0x87, 0x4F, 0xA0, 
+0x69, 0x1D, 0xEB, 0x5F, 0x66, 0x5E, 0x9B, 0x00, 
+0x59, 0xA3, 0x17, 0x4B, 0x43, 0xF8, 0x04, 0x1B, 
+0xE9, 0x42, 0xAD, 0x40, 0x9D, 0x86, 0x85, 0xE4, 
+0x06, 0x40, 0x9A, 0x4F, 0x31, 0x04, 0xA1, 
+
+0x7B, 0xF3, 0xB4, 0x67, 0x3E, 0x9F, 0x8C, 0xB2, 
+0xF5, 0xC1, 0x57, 0xF1, 0x04, 0x6D, 0xB2, 0x60, 
+0x6D, 0x1E, 0xEB, 0x81, 0x54, 0xAF, 0xB7, 0xA1, 
+0xBF, 0x93, 0x0B, 0xA9, 0xE1, 0x4E, 0x87, 0x1F, 
+0x05, 0x96, 0x2D, 0xDD, 0x0D, 0xA4, 0xA7, 0x1D, 
+
+0x56, 0x91, 0xE3, 0x4A, 0x4C, 0xDA, 0x0A, 0x18, 
+0x1D, 0x5A, 0x34, 0x6F, 0xA3, 0x85, 0xB3, 0xAE, 
+0xAF, 0xC9, 0x58, 0xBE, 0xE4, 0x69, 0xF5, 0x0D, 
+0x47, 0xCF, 0x9C, 0x56, 0xD5, 0xDF, 0xB4, 0xD3, 
+0x03, 0x86, 0x50, 0xF0, 0x8C, 0x1E, 0x61, 0x8C, 
+0x03, 
+
+0xBA, 0xFC, 0x2F, 0xEA, 0x8A, 0xED, 0x21, 0x22, 
+0x6C, 0x45, 0x76, 0x15, 0xFD, 0x29, 0x46, 0x08, 
+0x78, 0xA1, 0x4A, 0x60, 0xF0, 0x5A, 0x20, 0x88, 
+0xF3, 0xC8, 0x1B, 0x24, 0x6A, 0x15, 0x7D, 0xE1, 
+0x1A, 0xF0, 0xEF, 0x6C, 0x46, 0x3A, 0xF3, 0x37, 
+0x8F, 0x15, 
+
+0x32, 0x4F, 0xA5, 0x6C, 0x10, 0xD5, 0x33, 0x8F, 
+0x95, 0x40, 0xD0, 0x7A, 0x6F, 0x8C, 0x36, 0x37, 
+0x02, 0xD8, 0xD1, 0xE9, 0x19, 0x4A, 0xDE, 0x63, 
+0xED, 0x39, 0x1E, 0x2C, 0x7E, 0xAC, 0x06, 0x24, 
+0xAA, 0x89, 0xBC, 0xE7, 0x60, 0xA1, 0x0D, 0x96, 
+0xD0, 0xF4, 0xC7, 
+
+0xA3, 0x8C, 0xA7, 0x54, 0x30, 0x2D, 0x5F, 0x7E, 
+0xF4, 0xC5, 0xA4, 0x8D, 0x87, 0x24, 0xA4, 0x76, 
+0xCF, 0xC4, 0xD2, 0x57, 0xEC, 0xA1, 0x92, 0x5C, 
+0xFD, 0x69, 0xA7, 0x8E, 0xD5, 0xAB, 0x04, 0x57, 
+0x60, 0xDB, 0x3B, 0xBE, 0x10, 0x9B, 0x75, 0x8C, 
+0xCB, 0xD6, 0x7A, 0xF6, 
+
+0xF6, 0x32, 0xD8, 0xA4, 0xC4, 0x8C, 0x8C, 0xFD, 
+0x35, 0xF9, 0x8F, 0xCC, 0xA2, 0x54, 0x24, 0x17, 
+0x52, 0xEC, 0xFF, 0x97, 0x91, 0x0E, 0xAE, 0x1F, 
+0x04, 0x3D, 0xDB, 0x22, 0xB0, 0x30, 0xDA, 0xD5, 
+0x99, 0xE2, 0xED, 0x4E, 0x03, 0x2E, 0xF9, 0x56, 
+0xFF, 0xAD, 0x2E, 0x61, 0x19, 
+
+0xBC, 0xF8, 0x16, 0x81, 0x95, 0x67, 0xAA, 0x53, 
+0x34, 0x9C, 0xE5, 0xE0, 0x60, 0xA0, 0x29, 0xD1, 
+0xC2, 0xD3, 0x31, 0xC5, 0x04, 0xEF, 0x95, 0xC6, 
+0xE3, 0x6B, 0xF0, 0xCA, 0x51, 0x7D, 0x7C, 0x28, 
+0xD6, 0xC1, 0xAA, 0x3A, 0x05, 0xAA, 0xB5, 0xEB, 
+0xE2, 0x40, 0x04, 0x63, 0xDC, 0xA6, 
+
+0x5B, 0xFA, 0x49, 0xA9, 0xD3, 0x4B, 0x77, 0x14, 
+0x98, 0xF0, 0x28, 0x32, 0xC3, 0x5B, 0xC9, 0x81, 
+0xB9, 0xB2, 0xAA, 0xF0, 0x36, 0xE9, 0xFA, 0x8A, 
+0xA8, 0x8B, 0x2A, 0xB2, 0x6E, 0xBD, 0xA4, 0xEF, 
+0x02, 0x20, 0x51, 0xCC, 0x01, 0x55, 0xBD, 0x6A, 
+0xAB, 0x71, 0xDF, 0x95, 0x20, 0xE1, 0xC0, 
+
+0x00, 0x24, 0x1A, 0x1E, 0x27, 0xA4, 0x02, 0x9D, 
+0xAF, 0x2B, 0x3B, 0xD2, 0xD1, 0x53, 0xF9, 0x5B, 
+0x58, 0x3F, 0x4F, 0x0A, 0xCE, 0x68, 0xE9, 0x88, 
+0xE5, 0xB9, 0xF1, 0xC3, 0xBE, 0x3D, 0x77, 0xFD, 
+0xD7, 0xCA, 0xEC, 0x4B, 0xC7, 0x5A, 0xB8, 0xCD, 
+0xCF, 0xBC, 0x98, 0xCF, 0xAE, 0xEF, 0xF3, 0xE4, 
+
+0x8F, 0x83, 0x2E, 0x51, 0x6D, 0x36, 0xAA, 0x0B, 
+0x05, 0x28, 0xF0, 0x10, 0x12, 0x0D, 0x93, 0x96, 
+0x4B, 0x67, 0x81, 0x4F, 0x75, 0x32, 0x28, 0xFF, 
+0x0E, 0xED, 0xD3, 0x4F, 0xC7, 0x0B, 0x9B, 0xAF, 
+0xE9, 0x07, 0x3F, 0x8C, 0x02, 0x37, 0xC7, 0x09, 
+0x48, 0x8E, 0x0D, 0xE7, 0x07, 0x2F, 0x90, 0x21, 
+0xC9, 
+
+0x7D, 0x87, 0x92, 0x46, 0x92, 0x94, 0x46, 0x76, 
+0x6A, 0x81, 0xF9, 0x16, 0xDA, 0x1B, 0x8D, 0x0C, 
+0x0A, 0x42, 0x10, 0xC1, 0x97, 0x61, 0x65, 0x31, 
+0xAD, 0xB2, 0xFC, 0xCF, 0x73, 0xF4, 0xE7, 0xB7, 
+0x8A, 0x29, 0x34, 0xEC, 0x97, 0x84, 0x24, 0xD9, 
+0x5A, 0x91, 0xD4, 0xE0, 0xAA, 0xB5, 0x2C, 0x70, 
+0x37, 0x30, 
+
+0xE5, 0x49, 0x44, 0x63, 0xD9, 0x44, 0x49, 0xCA, 
+0x30, 0x4D, 0xB9, 0xE6, 0xC1, 0x7F, 0x58, 0x27, 
+0x38, 0xA5, 0xDC, 0x6A, 0x3A, 0xFB, 0x32, 0x2C, 
+0x50, 0xE6, 0xFB, 0x3B, 0xB6, 0xE7, 0x42, 0x50, 
+0xC5, 0x41, 0x75, 0xC3, 0x9A, 0xDF, 0xC3, 0x02, 
+0xBD, 0xA3, 0x33, 0xA7, 0xE1, 0xA8, 0x36, 0x2B, 
+0x0B, 0x9A, 0x2A, 
+
+0x0E, 0x47, 0x93, 0x4A, 0x03, 0x8B, 0x8B, 0xCC, 
+0xC5, 0x3C, 0xDB, 0xC4, 0x75, 0x99, 0x79, 0x28, 
+0x00, 0xB4, 0x69, 0x60, 0x04, 0x92, 0x33, 0x1B, 
+0x3B, 0x3F, 0x5F, 0x5E, 0x43, 0xBD, 0x88, 0x8C, 
+0xE8, 0x35, 0x2C, 0xAE, 0x24, 0x21, 0x92, 0x50, 
+0xDD, 0xB2, 0xAE, 0xF5, 0x1E, 0x98, 0x92, 0x73, 
+0x78, 0x14, 0x06, 0x6E, 
+
+0x36, 0x76, 0x5E, 0x6A, 0x12, 0xD5, 0xEF, 0x22, 
+0x49, 0xE0, 0x48, 0x4E, 0x38, 0xCA, 0x79, 0x04, 
+0xC6, 0xD7, 0x42, 0x45, 0x60, 0x59, 0xEA, 0xA1, 
+0x35, 0x5F, 0xA1, 0x17, 0xFA, 0x11, 0x44, 0x1C, 
+0xB8, 0x4F, 0xAF, 0x7F, 0x55, 0x09, 0xAB, 0x1E, 
+0xDD, 0x25, 0x1B, 0xD8, 0x99, 0x9B, 0xE6, 0x9C, 
+0x53, 0xA1, 0x25, 0xD4, 0x8E, 
+
+0xBC, 0xBC, 0x4D, 0x60, 0x74, 0x2A, 0xD4, 0x20, 
+0xF2, 0x38, 0xB2, 0x8F, 0xEC, 0xEE, 0x70, 0x69, 
+0x45, 0x39, 0xE5, 0x83, 0xD2, 0xF9, 0x55, 0x3A, 
+0x5F, 0x08, 0x3A, 0x10, 0x3B, 0xDE, 0x34, 0x59, 
+0x3D, 0x19, 0x1B, 0x5D, 0xF0, 0xAC, 0xCE, 0xA9, 
+0xE2, 0xC5, 0xFA, 0xC5, 0x84, 0x0A, 0xDF, 0x5F, 
+0xD8, 0xAE, 0xB9, 0xC5, 0x81, 0xDE, 
+
+0xEF, 0x8C, 0x89, 0x6D, 0x51, 0x56, 0x5A, 0x86, 
+0xC8, 0x6B, 0x8D, 0x3E, 0xBA, 0x40, 0x4D, 0x37, 
+0x33, 0x0A, 0xCD, 0x2F, 0x6E, 0xE8, 0x62, 0x3B, 
+0x58, 0xCE, 0x8E, 0xC6, 0x80, 0xC8, 0x1D, 0x06, 
+0x19, 0x20, 0x0C, 0x84, 0x56, 0x13, 0x57, 0x14, 
+0xCE, 0x39, 0x19, 0x18, 0xC5, 0xCD, 0x00, 0xE1, 
+0x17, 0x1E, 0xBA, 0x11, 0x7C, 0x85, 0xFC, 
+
+0xA5, 0xF2, 0x46, 0x37, 0x23, 0x38, 0xB9, 0x28, 
+0x60, 0x57, 0x29, 0x76, 0xCD, 0x0A, 0x19, 0x9D, 
+0x4F, 0x34, 0xE3, 0x8A, 0x93, 0x86, 0x70, 0x89, 
+0xF4, 0xD1, 0x8D, 0x0A, 0xCE, 0x12, 0xA7, 0xDA, 
+0x78, 0xF8, 0xFF, 0x93, 0x02, 0xC8, 0x14, 0x0B, 
+0x9C, 0xB7, 0x06, 0xCD, 0xEA, 0x2A, 0x5F, 0x4B, 
+0xB8, 0x3F, 0xED, 0xC6, 0xB9, 0x69, 0x5D, 0x48, 
+
+0x09, 0x8B, 0x71, 0xC2, 0x5A, 0x49, 0x1D, 0x39, 
+0x74, 0x0D, 0xED, 0xFE, 0x96, 0x6B, 0x3A, 0x4B, 
+0x9F, 0x3A, 0x4C, 0x76, 0x70, 0xE5, 0xE6, 0xD2, 
+0xAF, 0x80, 0xC6, 0x31, 0x35, 0xF9, 0xB4, 0x1D, 
+0xA6, 0xE1, 0xA1, 0x43, 0xFD, 0x84, 0xD0, 0x11, 
+0x71, 0x2D, 0x84, 0x07, 0x86, 0xC1, 0x83, 0x4B, 
+0x14, 0x68, 0xC1, 0x5E, 0xE4, 0xC3, 0x2F, 0xFF, 
+0xA7, 
+
+0x8B, 0x53, 0x7D, 0x6F, 0xED, 0x3C, 0xBC, 0xF5, 
+0xB0, 0x69, 0x6D, 0xBD, 0x1F, 0xBA, 0x01, 0x3F, 
+0x2B, 0x94, 0x81, 0x1B, 0x62, 0x7C, 0x0E, 0xF8, 
+0x81, 0x00, 0x11, 0x3F, 0x98, 0xEE, 0x55, 0x77, 
+0x6F, 0x8A, 0x1A, 0x40, 0x93, 0x14, 0xF3, 0x0F, 
+0xC0, 0xF7, 0xEF, 0xDB, 0xDF, 0xED, 0x7F, 0xB4, 
+0x54, 0x48, 0x1F, 0x21, 0x31, 0x8D, 0xC8, 0x2E, 
+0x87, 0x61, 
+
+0x55, 0x2A, 0x39, 0xB5, 0x80, 0x7B, 0xED, 0x3C, 
+0x43, 0x3D, 0x6B, 0xAE, 0x4A, 0xEC, 0x39, 0xC1, 
+0xC5, 0xD5, 0xBA, 0x9A, 0xD2, 0xAC, 0x6E, 0x89, 
+0x0D, 0x9D, 0x6A, 0xD5, 0x89, 0x9A, 0xBF, 0xE9, 
+0x82, 0xCE, 0xD2, 0x6E, 0x5A, 0x78, 0xDB, 0x27, 
+0x4F, 0xA7, 0xA4, 0xA4, 0xFF, 0x63, 0xF3, 0x8B, 
+0x0F, 0x09, 0xCD, 0x96, 0x3F, 0xBA, 0xD0, 0x71, 
+0x4C, 0xBC, 0xF1, 
+
+0xB2, 0x5D, 0xC0, 0xF8, 0xB7, 0x3C, 0xB7, 0xC4, 
+0x43, 0xD1, 0xA3, 0xAD, 0x3C, 0x94, 0xAC, 0xF3, 
+0xAF, 0x37, 0xE9, 0xF1, 0xFF, 0xE0, 0x28, 0x03, 
+0x7D, 0x68, 0x14, 0x84, 0x52, 0xFE, 0x28, 0x61, 
+0x4E, 0x62, 0xF3, 0xCE, 0xE5, 0x4B, 0x5A, 0x21, 
+0xCD, 0x39, 0xA1, 0x9E, 0xD5, 0x58, 0x7D, 0x70, 
+0x43, 0xE2, 0xAD, 0x67, 0xBC, 0x7C, 0xD1, 0x05, 
+0x63, 0x8F, 0x6C, 0x53, 
+
+0x62, 0x6D, 0xC7, 0x64, 0x27, 0xB6, 0x5F, 0xFE, 
+0xC8, 0x5E, 0x16, 0x63, 0x6E, 0xCF, 0x8B, 0xCE, 
+0xDC, 0x97, 0xC1, 0x1D, 0x21, 0x30, 0x15, 0xF3, 
+0xB5, 0xDE, 0x07, 0x00, 0xC8, 0x80, 0xB2, 0x39, 
+0xD1, 0x8E, 0xBF, 0x31, 0xAE, 0xFE, 0x48, 0x73, 
+0x34, 0x66, 0x9D, 0x48, 0xCF, 0x26, 0x38, 0x92, 
+0x6F, 0xDA, 0xFE, 0xEE, 0x8B, 0xDB, 0x03, 0x74, 
+0x3E, 0x8D, 0x38, 0x83, 0x7D, 
+
+0xC9, 0x41, 0xBD, 0x9D, 0x2E, 0x10, 0x23, 0x7B, 
+0x2B, 0x9F, 0x9A, 0x09, 0x3B, 0xE2, 0xD9, 0x09, 
+0x7C, 0xA5, 0x1C, 0x2F, 0x6F, 0x3F, 0xDD, 0xA0, 
+0xA8, 0xCB, 0x1A, 0x74, 0xD0, 0xCF, 0x9F, 0x1A, 
+0x12, 0x92, 0x07, 0xEC, 0xFF, 0x9E, 0xBD, 0x99, 
+0x0D, 0x93, 0xE3, 0xA1, 0x94, 0xF2, 0xA9, 0x6D, 
+0xA3, 0x42, 0x66, 0x1F, 0xB2, 0x7F, 0xD9, 0x49, 
+0x17, 0x49, 0x38, 0xF5, 0xCC, 0xC0, 
+
+0xBD, 0x3D, 0x93, 0xED, 0xAB, 0x52, 0xE7, 0x98, 
+0xF2, 0x92, 0xD6, 0x91, 0x07, 0x88, 0xBD, 0x39, 
+0x83, 0x95, 0x76, 0x96, 0x8C, 0x28, 0xCC, 0x48, 
+0xE5, 0x1F, 0x58, 0x09, 0x19, 0xD3, 0x79, 0x3E, 
+0x3B, 0xBE, 0x8D, 0x52, 0x81, 0x3A, 0x21, 0x4E, 
+0x13, 0xD3, 0xB6, 0x81, 0xFF, 0xB4, 0x25, 0xB5, 
+0x91, 0x28, 0xDD, 0x0A, 0xE0, 0xF9, 0x35, 0x8B, 
+0x38, 0x54, 0xEA, 0x22, 0xB0, 0xD0, 0x2F, 
+
+0x59, 0xA2, 0xDC, 0x2A, 0x78, 0x5C, 0x6F, 0xA4, 
+0x40, 0x2C, 0x04, 0xD3, 0xD4, 0x75, 0x22, 0x6C, 
+0x64, 0xB3, 0x6A, 0xD9, 0x7F, 0x40, 0x38, 0x03, 
+0xBA, 0x3C, 0x80, 0xFA, 0xEF, 0xA4, 0xBD, 0x63, 
+0x9A, 0x7E, 0x17, 0x21, 0x31, 0x0D, 0x95, 0xD5, 
+0x44, 0xCA, 0xBC, 0x5C, 0x34, 0x79, 0x41, 0x4A, 
+0x97, 0xD5, 0x2D, 0x9F, 0xF8, 0x93, 0x00, 0x25, 
+0xFA, 0xCD, 0xC6, 0x30, 0xB5, 0x8C, 0xB3, 0x32, 
+
+0x02, 0xEE, 0x7E, 0xDB, 0x01, 0xAB, 0x3F, 0x3A, 
+0x63, 0x0E, 0xDE, 0x2E, 0x57, 0x97, 0x9F, 0xAB, 
+0xC4, 0x68, 0xC4, 0xFC, 0xDE, 0xD6, 0xCE, 0x1B, 
+0xF2, 0xB5, 0xA7, 0xD6, 0xD8, 0xB7, 0xE4, 0x59, 
+0xC9, 0x74, 0xB4, 0x9C, 0xA5, 0x3D, 0xB4, 0x2D, 
+0x20, 0xB3, 0x02, 0x4F, 0x30, 0xB2, 0x14, 0x50, 
+0x89, 0xE1, 0x6D, 0x20, 0xFA, 0x1C, 0x20, 0xBE, 
+0x01, 0xA0, 0x36, 0xC7, 0xFB, 0x1C, 0xE5, 0xF5, 
+0x56, 
+
+0x02, 0x0E, 0x2E, 0xB5, 0xC4, 0x7B, 0xD3, 0x44, 
+0xDE, 0x09, 0x6F, 0x04, 0xEA, 0x06, 0x9C, 0x71, 
+0x7E, 0x60, 0x29, 0x06, 0x9A, 0xAD, 0xFE, 0x4A, 
+0x15, 0x7C, 0xB6, 0x41, 0xF1, 0x9E, 0xB6, 0x78, 
+0xF9, 0xCB, 0x54, 0x9D, 0x44, 0x0A, 0x66, 0xE2, 
+0x38, 0xCC, 0x5A, 0x76, 0xC2, 0x7D, 0x34, 0xAD, 
+0x05, 0x6F, 0x26, 0x74, 0xEC, 0x31, 0x26, 0xFB, 
+0x4B, 0xA8, 0xD5, 0xA0, 0xB8, 0xBF, 0x57, 0x41, 
+0xF1, 0x9A, 
+
+0x53, 0x11, 0x4C, 0x2B, 0x0F, 0x03, 0xB0, 0xC2, 
+0x29, 0xA4, 0xFA, 0x3E, 0x30, 0x81, 0x33, 0xFC, 
+0x3D, 0x80, 0x5B, 0xD8, 0xDF, 0x4A, 0x30, 0x9C, 
+0x7E, 0x6B, 0x0C, 0xF3, 0x36, 0xBF, 0xC1, 0xBF, 
+0xE2, 0x5E, 0x95, 0xF9, 0x26, 0xDC, 0xDE, 0xD4, 
+0x54, 0x10, 0x7B, 0x93, 0x2B, 0x24, 0x48, 0x71, 
+0xEC, 0xA8, 0x33, 0xCA, 0x7B, 0xC4, 0x09, 0x3C, 
+0x21, 0x86, 0xD1, 0x9F, 0xEC, 0xB9, 0xA0, 0x84, 
+0xFB, 0x3F, 0x58, 
+
+0x0D, 0x4B, 0xF7, 0x4D, 0xA4, 0xFB, 0x09, 0x97, 
+0x8D, 0xB7, 0xD0, 0x04, 0x65, 0x9E, 0xBA, 0x2F, 
+0x3D, 0xAA, 0xD6, 0x68, 0xF4, 0x86, 0x07, 0x58, 
+0xF8, 0xF1, 0xD4, 0x0D, 0xCC, 0x03, 0x3B, 0x11, 
+0x1A, 0xAF, 0x0E, 0x33, 0xD7, 0x23, 0x50, 0xB9, 
+0xB8, 0x52, 0x37, 0x31, 0xFA, 0x20, 0xB9, 0xFB, 
+0x1C, 0x71, 0xAD, 0x63, 0x22, 0xC1, 0x7D, 0x8B, 
+0xB8, 0xC6, 0x31, 0x3A, 0x60, 0xE8, 0xD6, 0xE8, 
+0xCF, 0xBC, 0xE0, 0x32, 
+
+0x91, 0x5C, 0xD7, 0x3D, 0x06, 0x14, 0x73, 0x98, 
+0xF1, 0xAD, 0x23, 0x9C, 0xDD, 0x29, 0xD4, 0x04, 
+0x93, 0x1B, 0x6E, 0xF0, 0x03, 0x49, 0x5D, 0xC8, 
+0x10, 0x37, 0xAD, 0xBB, 0xC5, 0xB5, 0xBD, 0x4D, 
+0x14, 0x38, 0xD2, 0x2C, 0x8E, 0x00, 0xD2, 0x32, 
+0x3B, 0x82, 0xEB, 0x5D, 0xF8, 0xC7, 0xF1, 0x64, 
+0x5B, 0xE2, 0xAA, 0xD1, 0xCF, 0x0E, 0xE4, 0xAD, 
+0x18, 0x27, 0x3B, 0x5C, 0xFD, 0x5B, 0xC2, 0xFE, 
+0xFB, 0x0B, 0x84, 0x72, 0x1F, 
+
+0xAF, 0xED, 0x9E, 0xE8, 0x8B, 0xB3, 0x9B, 0xD4, 
+0x1C, 0x3D, 0xA6, 0xEC, 0x06, 0x8C, 0x7B, 0xBB, 
+0xAB, 0x3F, 0xC2, 0x32, 0x73, 0xF1, 0xCE, 0xD5, 
+0x1E, 0xAF, 0x7A, 0x09, 0x74, 0xFC, 0x34, 0xC7, 
+0x94, 0x32, 0x6C, 0x6B, 0x89, 0x61, 0xE3, 0x3B, 
+0x3D, 0x84, 0x3C, 0xEC, 0x07, 0xC9, 0xA3, 0xDF, 
+0xD3, 0x23, 0xA1, 0xA7, 0x55, 0x34, 0x55, 0xF3, 
+0xCC, 0xA7, 0x0D, 0xEC, 0xB2, 0xC7, 0xCA, 0x6F, 
+0x1A, 0xE2, 0x21, 0xAA, 0x7A, 0x31, 
+
+0xD4, 0x26, 0x06, 0x40, 0x54, 0x20, 0x75, 0x71, 
+0xC9, 0x57, 0x5F, 0x1A, 0x5A, 0x64, 0x45, 0x72, 
+0xE3, 0x79, 0x9B, 0xBF, 0xBB, 0x54, 0x9A, 0x7A, 
+0x31, 0xAB, 0xF7, 0xED, 0xD2, 0xDC, 0x6D, 0xBF, 
+0x42, 0x12, 0xEE, 0xBF, 0xCC, 0x7C, 0xA8, 0x1E, 
+0x9D, 0x67, 0x52, 0x36, 0xFC, 0xDB, 0x1C, 0x5F, 
+0x88, 0xBF, 0x2E, 0xA7, 0xDC, 0x43, 0x33, 0x08, 
+0x54, 0x17, 0x62, 0x14, 0xCD, 0x16, 0xF2, 0x2E, 
+0xB2, 0xE1, 0x74, 0x8A, 0x03, 0x99, 0x8E, 
+
+0x22, 0xB1, 0xE8, 0x7E, 0x66, 0xA3, 0xC4, 0xF4, 
+0x88, 0x4E, 0x59, 0x71, 0xD8, 0x2E, 0xBE, 0xC9, 
+0x13, 0xB3, 0x03, 0x4F, 0xFC, 0x49, 0x93, 0x51, 
+0x2F, 0x85, 0xF2, 0x6F, 0x5F, 0xA2, 0x6C, 0x3D, 
+0xD1, 0xB4, 0xD4, 0x79, 0x1B, 0x06, 0xB2, 0x49, 
+0x08, 0x2D, 0x3C, 0x66, 0x26, 0x70, 0x18, 0xE9, 
+0x57, 0x53, 0x69, 0xF1, 0x56, 0x8E, 0xF0, 0xC6, 
+0x35, 0x7B, 0x4C, 0x76, 0x4A, 0x84, 0x94, 0x8D, 
+0x35, 0x96, 0xE7, 0x82, 0xDF, 0x83, 0x16, 0x7F, 
+
+0x94, 0x44, 0xB1, 0xEE, 0xE6, 0x25, 0x51, 0x8B, 
+0x13, 0x6B, 0xD9, 0xE6, 0xD4, 0xC6, 0xFA, 0x61, 
+0x4A, 0xDE, 0x66, 0xFA, 0xE0, 0xC3, 0x94, 0x69, 
+0x96, 0x0E, 0x89, 0xF5, 0x66, 0x2E, 0x6F, 0x5C, 
+0xEB, 0xEE, 0x33, 0x3E, 0xF4, 0x2F, 0x07, 0x5F, 
+0x4B, 0xDF, 0x9B, 0x45, 0xEC, 0x9E, 0xBA, 0x36, 
+0xC5, 0x7F, 0xE7, 0x16, 0xCF, 0xEC, 0xDF, 0x00, 
+0x8F, 0xEB, 0x18, 0xD2, 0x65, 0xF8, 0x22, 0x2B, 
+0xE0, 0x59, 0x1B, 0xD0, 0x59, 0x6C, 0x70, 0x3A, 
+0x36, 
+
+0xF2, 0x7B, 0x5E, 0xBD, 0x20, 0x88, 0x80, 0xCF, 
+0x62, 0xCA, 0x90, 0xEA, 0x0A, 0x7A, 0x3D, 0xF1, 
+0x40, 0x5B, 0x36, 0x7D, 0x33, 0x1A, 0x69, 0x3E, 
+0xCA, 0x7D, 0x66, 0x57, 0x8A, 0x60, 0x7B, 0xE4, 
+0xC5, 0x7C, 0xD7, 0x73, 0xCF, 0x30, 0x34, 0xB7, 
+0x08, 0x18, 0x43, 0xC1, 0xA5, 0x34, 0x40, 0x8F, 
+0xED, 0x32, 0xF7, 0x77, 0x69, 0x3F, 0xEC, 0x6E, 
+0x4F, 0x3C, 0xCC, 0x26, 0x85, 0xC1, 0xA0, 0x37, 
+0x19, 0xF9, 0xA3, 0x20, 0xD5, 0x50, 0x59, 0x03, 
+0x0E, 0x72, 
+
+0xA9, 0xC9, 0xC0, 0xDB, 0xFB, 0x33, 0x2B, 0xF8, 
+0xC7, 0x99, 0x60, 0x64, 0xDB, 0x99, 0xC1, 0x1F, 
+0x26, 0x5E, 0x9E, 0xB6, 0x7F, 0x38, 0xBA, 0x98, 
+0x12, 0x27, 0xCA, 0xA1, 0x96, 0x1D, 0xB4, 0xF7, 
+0x17, 0xD2, 0xAB, 0x61, 0x10, 0x81, 0x34, 0x35, 
+0xCF, 0x03, 0x84, 0x4A, 0x0F, 0x29, 0x23, 0x4D, 
+0x26, 0x87, 0xCB, 0x70, 0x5B, 0xBF, 0x0F, 0xD6, 
+0x0C, 0xC8, 0x9F, 0x52, 0x83, 0xB1, 0x4E, 0x72, 
+0xFB, 0x22, 0x9F, 0x1C, 0xC3, 0x19, 0x29, 0x10, 
+0x7B, 0xB0, 0xD4, 
+
+0xAB, 0x43, 0x81, 0x6F, 0xE1, 0x4D, 0x3D, 0x2D, 
+0x6B, 0xA3, 0xF8, 0xF5, 0x62, 0xE2, 0x6C, 0xB7, 
+0xF2, 0xC1, 0x0D, 0x26, 0x11, 0xC4, 0x0A, 0x3D, 
+0xD0, 0x03, 0xA4, 0xB9, 0xE5, 0x06, 0xDE, 0x36, 
+0x27, 0x3F, 0x94, 0xA8, 0x3B, 0x36, 0x48, 0x7D, 
+0xED, 0x53, 0x2E, 0x88, 0x68, 0xFB, 0xCC, 0x8C, 
+0xD0, 0x77, 0xD5, 0x74, 0x4F, 0xCA, 0x98, 0xB9, 
+0xE5, 0x02, 0x1B, 0x1D, 0xB8, 0x08, 0xDD, 0x06, 
+0x4F, 0xAA, 0xF4, 0xC3, 0xB1, 0xB6, 0x60, 0xFD, 
+0x7A, 0xD3, 0x12, 0x85, 
+
+0x5C, 0xAF, 0xF6, 0xEA, 0xCB, 0xFB, 0x92, 0x8D, 
+0xDA, 0x00, 0x42, 0xA4, 0x32, 0x8C, 0xF5, 0x53, 
+0x60, 0xC8, 0x14, 0x32, 0x5B, 0x92, 0x74, 0xD2, 
+0x14, 0x5E, 0x08, 0xAC, 0xC7, 0x01, 0x22, 0x86, 
+0xA4, 0xD0, 0xE6, 0x6B, 0x65, 0x28, 0x5B, 0x4E, 
+0x1E, 0xDF, 0x92, 0x47, 0x62, 0x7B, 0x36, 0x83, 
+0xA6, 0xD4, 0x4F, 0x2B, 0xB8, 0x8B, 0x6D, 0x48, 
+0x7A, 0x47, 0xFB, 0xDE, 0xDE, 0xFB, 0x92, 0xFB, 
+0x9C, 0x73, 0x13, 0xFA, 0xED, 0xF2, 0x46, 0x6B, 
+0x36, 0x49, 0x76, 0xCB, 0x73, 
+
+0xC9, 0x8F, 0x60, 0x57, 0x24, 0xCC, 0x80, 0xE3, 
+0xD4, 0x89, 0x05, 0x30, 0x1A, 0xD5, 0x5B, 0x7C, 
+0xAB, 0x7C, 0xA4, 0xA8, 0x81, 0x4A, 0x50, 0xB6, 
+0x01, 0x70, 0xC1, 0xBE, 0xCB, 0x85, 0x9C, 0xD6, 
+0xE5, 0x6A, 0x53, 0x2B, 0x3A, 0xF7, 0x39, 0x00, 
+0x4A, 0x65, 0x25, 0x81, 0x43, 0x62, 0xB3, 0x8E, 
+0x21, 0xBE, 0x5C, 0x8B, 0x8F, 0x4E, 0xE5, 0x5E, 
+0xDB, 0x91, 0x1F, 0x30, 0xF7, 0x78, 0xE1, 0xE4, 
+0x74, 0xA7, 0xAD, 0xB8, 0xDE, 0x34, 0x3A, 0xD9, 
+0xCB, 0xEE, 0xFD, 0x70, 0xD1, 0x5E, 
+
+0xC3, 0x2A, 0x8C, 0x57, 0xF5, 0x54, 0xD8, 0x82, 
+0x24, 0xF2, 0xEF, 0xEA, 0x81, 0x12, 0x1F, 0xC9, 
+0x2E, 0x37, 0xA5, 0x3F, 0x3C, 0xE3, 0x4C, 0x9D, 
+0x1D, 0x33, 0xA8, 0xDD, 0x68, 0x17, 0xAE, 0x26, 
+0xB5, 0xEE, 0x91, 0x8C, 0xC1, 0x52, 0x62, 0xC1, 
+0x3F, 0xDE, 0x11, 0x7F, 0xB1, 0x58, 0xE1, 0x22, 
+0xA6, 0xFA, 0x1B, 0x4E, 0xC7, 0x2C, 0x84, 0xA3, 
+0x14, 0x6F, 0x5E, 0x95, 0x03, 0xF9, 0xD1, 0x5A, 
+0x3B, 0x63, 0xD0, 0x7B, 0xFC, 0xE4, 0x90, 0xE3, 
+0x61, 0x77, 0x3B, 0x5F, 0x23, 0xB6, 0x91, 
+
+0xA1, 0x7B, 0xDB, 0x1B, 0xF4, 0x5F, 0x82, 0xF6, 
+0x2C, 0xC3, 0x82, 0xB2, 0xE3, 0x19, 0xB1, 0xB0, 
+0x49, 0xF9, 0x69, 0x26, 0x3D, 0xEF, 0xD5, 0x4A, 
+0x2A, 0x54, 0x8B, 0x13, 0x46, 0xB5, 0x3C, 0x9A, 
+0x8A, 0x4C, 0xA8, 0x27, 0x13, 0x20, 0xCE, 0x16, 
+0xEE, 0xCE, 0x9B, 0x83, 0xD6, 0x10, 0x12, 0x74, 
+0x5B, 0x89, 0xF9, 0x64, 0x0F, 0x6E, 0x57, 0x4E, 
+0x29, 0x41, 0xB1, 0xBE, 0x8B, 0x48, 0xCD, 0x67, 
+0x46, 0x58, 0xC2, 0xA9, 0x85, 0x7E, 0x7B, 0x7C, 
+0x96, 0x29, 0x5E, 0x3E, 0x6D, 0xE4, 0xC1, 0x6D, 
+
+0xC7, 0xCC, 0x74, 0x02, 0x98, 0x51, 0x94, 0x6D, 
+0x94, 0xAE, 0x9A, 0x02, 0xD0, 0xC0, 0x1F, 0xE0, 
+0x5D, 0x72, 0xB9, 0x9C, 0xFB, 0xDD, 0xA0, 0xDD, 
+0x9B, 0x0F, 0xB5, 0x31, 0x26, 0x42, 0x9E, 0x75, 
+0x37, 0xBA, 0x86, 0x52, 0xAE, 0x7F, 0x08, 0x63, 
+0x0E, 0x65, 0xD8, 0x69, 0x89, 0xE4, 0xD4, 0x5A, 
+0x52, 0x63, 0xE4, 0x20, 0x2C, 0x50, 0x91, 0x33, 
+0x99, 0xFC, 0xB2, 0x69, 0x45, 0x9B, 0x4E, 0xB0, 
+0xF3, 0xE9, 0x97, 0x1B, 0x4A, 0x73, 0x2F, 0x28, 
+0x36, 0x8E, 0x9E, 0xAE, 0x91, 0x78, 0xB6, 0x00, 
+0x0B, 
+
+0x3A, 0xEE, 0xF3, 0x81, 0x52, 0x50, 0xDA, 0xF5, 
+0xC8, 0xE9, 0xE3, 0x5F, 0xE6, 0x75, 0xB3, 0xE5, 
+0xB5, 0xED, 0x89, 0x74, 0x3A, 0x01, 0x0E, 0x91, 
+0x05, 0x64, 0x94, 0x09, 0x85, 0x21, 0x4F, 0xA0, 
+0x57, 0x7F, 0x0D, 0xCF, 0x60, 0xF7, 0x1D, 0x13, 
+0xB9, 0x3C, 0xE7, 0x49, 0x7E, 0x12, 0x9A, 0x1F, 
+0x39, 0x42, 0xC4, 0x00, 0xB8, 0xC7, 0x9A, 0xD1, 
+0xEC, 0x32, 0xB1, 0xEF, 0xD0, 0x83, 0x36, 0xD0, 
+0x9A, 0x05, 0xC7, 0xF1, 0x2B, 0x54, 0x20, 0x58, 
+0x26, 0x5C, 0x24, 0x81, 0x1D, 0x70, 0xB6, 0xEA, 
+0xFB, 0x56, 
+
+0x58, 0x89, 0xC1, 0x57, 0x10, 0x46, 0x9B, 0xC6, 
+0xE2, 0x79, 0xED, 0xC8, 0x06, 0x57, 0x1A, 0x1E, 
+0x3B, 0x03, 0x1A, 0x67, 0x3B, 0x9A, 0xB0, 0xB3, 
+0xCA, 0xEE, 0x1E, 0xC9, 0x7D, 0xAE, 0x4B, 0x86, 
+0xC8, 0xD5, 0xC9, 0x2D, 0x98, 0xA1, 0xB0, 0x84, 
+0xAE, 0x9E, 0xEF, 0xA0, 0x6E, 0x61, 0x3B, 0x9D, 
+0x20, 0x95, 0x71, 0x48, 0x51, 0xC4, 0x7E, 0xF4, 
+0x8B, 0xAB, 0x6A, 0x64, 0x36, 0x2C, 0x9B, 0x2D, 
+0xF0, 0x04, 0xF2, 0x3D, 0x89, 0xC1, 0x1E, 0x0F, 
+0x03, 0x32, 0x73, 0xA5, 0xBB, 0x79, 0x78, 0xDB, 
+0xEB, 0x54, 0x3F, 
+
+0xE2, 0xBE, 0xB3, 0x45, 0xDD, 0xF6, 0x95, 0x1B, 
+0x35, 0xEC, 0x3B, 0x27, 0x23, 0xB1, 0x33, 0xB0, 
+0x47, 0x07, 0x6A, 0x8A, 0x12, 0x0D, 0x00, 0xFB, 
+0xAA, 0x2E, 0x52, 0xD9, 0x1D, 0x1F, 0xB8, 0x24, 
+0x0A, 0x2F, 0x80, 0xE8, 0x61, 0x2C, 0x82, 0x08, 
+0xEB, 0x39, 0xF6, 0x60, 0xCE, 0xA2, 0x2A, 0xAC, 
+0xA5, 0x46, 0x0F, 0x4D, 0x40, 0x42, 0x7B, 0xBA, 
+0x2D, 0xA9, 0x69, 0xB3, 0xAA, 0xA7, 0x29, 0xF4, 
+0x08, 0x74, 0x1B, 0x06, 0x26, 0xA8, 0x56, 0x44, 
+0xA9, 0x4C, 0x78, 0x9C, 0xAD, 0x7F, 0x95, 0x0D, 
+0x2E, 0x46, 0xB4, 0x5C, 
+
+0xCA, 0x8F, 0xA5, 0x0E, 0xA1, 0x2E, 0x56, 0x90, 
+0x38, 0x6A, 0x26, 0x46, 0x0C, 0x9B, 0x08, 0x4C, 
+0xBB, 0x3F, 0x87, 0x90, 0xDA, 0x35, 0x8F, 0xE8, 
+0x8C, 0xA9, 0x46, 0x4B, 0xD0, 0xA0, 0xDE, 0xA0, 
+0xF0, 0x80, 0x0A, 0x71, 0x58, 0xE1, 0xE9, 0x0B, 
+0x44, 0x18, 0x43, 0x6E, 0x1B, 0xC1, 0x3A, 0x5A, 
+0x83, 0xAF, 0x43, 0xFE, 0x6E, 0x6D, 0x41, 0x44, 
+0x6F, 0x22, 0xDF, 0x3B, 0x7D, 0x89, 0x8D, 0xE0, 
+0xEC, 0xF0, 0x1C, 0xE7, 0x91, 0xD1, 0x90, 0xA5, 
+0x36, 0xCC, 0x95, 0x21, 0xD9, 0x2B, 0x77, 0x1E, 
+0xBD, 0x73, 0x1D, 0xC6, 0x90, 
+
+0x9D, 0x60, 0x23, 0x24, 0x1C, 0x83, 0xAB, 0x4A, 
+0x3B, 0xC5, 0x2C, 0x43, 0x75, 0x05, 0x87, 0xF2, 
+0x4E, 0x59, 0x6D, 0xF0, 0x8A, 0x52, 0xC4, 0x43, 
+0x5A, 0xDD, 0x2C, 0x8B, 0x48, 0xEA, 0x65, 0x10, 
+0x3A, 0x22, 0x62, 0xD5, 0x65, 0x65, 0x03, 0x17, 
+0x63, 0xEE, 0x66, 0xC1, 0x4C, 0xFD, 0x7B, 0xB1, 
+0x2F, 0x95, 0x2F, 0x27, 0x0C, 0xFD, 0x0B, 0xED, 
+0xB7, 0x6D, 0xAE, 0xEB, 0x4E, 0x50, 0xE7, 0x87, 
+0x63, 0x16, 0x1A, 0x1E, 0x1B, 0xB0, 0xC3, 0xA1, 
+0xB4, 0x62, 0x46, 0x4F, 0x70, 0x6D, 0xD7, 0xD2, 
+0x9B, 0x6A, 0x48, 0xFB, 0xDC, 0x30, 
+
+0x3E, 0x05, 0x36, 0xA5, 0x52, 0x00, 0x2F, 0xA1, 
+0x3D, 0x33, 0xFF, 0xA3, 0x87, 0xAE, 0xCD, 0xB0, 
+0x57, 0x60, 0xED, 0x0E, 0xFD, 0x56, 0xB5, 0x2E, 
+0xF9, 0x7E, 0x99, 0xA6, 0x6A, 0xEE, 0xB3, 0xBF, 
+0x73, 0xAC, 0x21, 0xDB, 0xBB, 0xDB, 0x1E, 0x92, 
+0xAD, 0xA0, 0x0B, 0x8A, 0x9F, 0xA0, 0x67, 0xD3, 
+0x13, 0x9D, 0x23, 0xF7, 0x17, 0x83, 0x8E, 0xFA, 
+0x3A, 0xD4, 0x3D, 0xE8, 0xC2, 0xC0, 0x46, 0xA5, 
+0x57, 0xFA, 0xA5, 0x79, 0xC8, 0xCC, 0x0B, 0x99, 
+0xBA, 0x44, 0x1B, 0x63, 0x07, 0x50, 0x67, 0x4E, 
+0xE1, 0x09, 0xD5, 0x70, 0x8B, 0x7E, 0xEF, 
+
+0x3A, 0xEC, 0x3F, 0xB6, 0xDA, 0xAD, 0x31, 0x77, 
+0xBD, 0xF3, 0x0C, 0x00, 0x33, 0xFD, 0x56, 0x24, 
+0xDB, 0xEC, 0xFD, 0xCD, 0x2C, 0x51, 0xDF, 0xB5, 
+0x07, 0x8B, 0x94, 0x00, 0xFB, 0xF4, 0x02, 0x4A, 
+0x5E, 0xAA, 0xE4, 0x4E, 0x9C, 0xCC, 0x4D, 0x1F, 
+0x77, 0x70, 0x75, 0xFA, 0xCA, 0x6C, 0xEC, 0x36, 
+0x70, 0xB8, 0xF2, 0xE9, 0x98, 0xAF, 0xFC, 0x67, 
+0xE5, 0xA7, 0x2A, 0x77, 0xDF, 0x62, 0xB0, 0xDA, 
+0x2C, 0x0F, 0x02, 0xA8, 0x8D, 0x1B, 0x57, 0x1B, 
+0x76, 0xD8, 0x3D, 0xFE, 0xB3, 0xAB, 0x20, 0xDD, 
+0x66, 0x9A, 0xEA, 0xAC, 0x03, 0x1D, 0x97, 0xDB, 
+
+0x5E, 0xBC, 0x86, 0xE1, 0x9B, 0xAD, 0xCD, 0x5F, 
+0xD2, 0x65, 0xE4, 0x6F, 0x72, 0x22, 0x6F, 0x27, 
+0xCC, 0x82, 0x4A, 0xB6, 0xCB, 0xBD, 0xA9, 0x79, 
+0x9E, 0x4C, 0x27, 0x0C, 0x53, 0x28, 0xDE, 0x4B, 
+0xA9, 0x89, 0x25, 0x2D, 0xD5, 0x33, 0xF8, 0x16, 
+0x95, 0x64, 0xF9, 0xA4, 0xD8, 0x62, 0x02, 0x23, 
+0x1D, 0xC2, 0xF7, 0x77, 0x30, 0x12, 0x96, 0x89, 
+0x80, 0x9F, 0xE0, 0x98, 0x36, 0x72, 0x92, 0xDF, 
+0x8E, 0x56, 0x03, 0xE8, 0x11, 0x07, 0xFF, 0x66, 
+0x06, 0x21, 0x17, 0x3E, 0x21, 0x73, 0xC2, 0xD4, 
+0x03, 0x9C, 0x0E, 0x17, 0x2C, 0x61, 0xF8, 0x96, 
+0xE0, 
+
+0x9E, 0x98, 0xD7, 0x5A, 0x81, 0xC9, 0xC8, 0x84, 
+0x78, 0xF5, 0x86, 0x02, 0x1F, 0x5A, 0xEB, 0x63, 
+0xEE, 0x67, 0x1F, 0x06, 0xF7, 0xBA, 0xD9, 0x7E, 
+0xD4, 0x3F, 0x80, 0x92, 0x0A, 0x71, 0xE1, 0xCD, 
+0x4D, 0xB0, 0x5D, 0x84, 0x9B, 0x86, 0xCE, 0xA6, 
+0x1E, 0x17, 0x88, 0xA1, 0x7D, 0xC1, 0x6A, 0x5D, 
+0x48, 0x30, 0x4C, 0xF6, 0x0C, 0x04, 0x2E, 0x4C, 
+0x6E, 0x89, 0xD3, 0x8B, 0x23, 0xB3, 0x2A, 0xDB, 
+0x93, 0xAD, 0xBB, 0x6A, 0x2E, 0xB6, 0x88, 0xA3, 
+0xBD, 0xF8, 0xC6, 0xCE, 0xBD, 0x0A, 0x97, 0x56, 
+0xD7, 0x9C, 0xA1, 0x8E, 0x38, 0x1D, 0x7A, 0x0B, 
+0x0F, 0x22, 
+
+0x32, 0xE7, 0x96, 0x8E, 0x1D, 0x8B, 0xE1, 0x21, 
+0x18, 0x79, 0x05, 0x71, 0xE3, 0x83, 0xF1, 0x1B, 
+0x27, 0x26, 0xE3, 0x15, 0x7E, 0xB5, 0x44, 0xB5, 
+0xE5, 0x2D, 0x5F, 0xD8, 0x8A, 0x4C, 0xF4, 0x83, 
+0x72, 0xFA, 0x8B, 0x89, 0xD6, 0xC6, 0x21, 0x9F, 
+0xDC, 0x9F, 0xEF, 0x78, 0x88, 0x27, 0xFE, 0xFD, 
+0x84, 0x7D, 0x3D, 0x0D, 0xFF, 0x73, 0xB8, 0xF6, 
+0xEE, 0x89, 0x16, 0xC2, 0xC0, 0x69, 0xCF, 0x18, 
+0x68, 0x34, 0x33, 0x63, 0x8C, 0x93, 0x23, 0x88, 
+0xCB, 0x27, 0xC8, 0x45, 0xDE, 0xD9, 0x8E, 0x11, 
+0x68, 0x03, 0x40, 0x0E, 0xB1, 0x39, 0x94, 0xAF, 
+0x8A, 0x09, 0x71, 
+
+0x6B, 0xDF, 0x9A, 0x99, 0x40, 0x0F, 0x6C, 0x23, 
+0xB1, 0x4E, 0x4E, 0x82, 0xAC, 0x10, 0x71, 0x3B, 
+0xD9, 0xC6, 0x67, 0xEB, 0x86, 0x45, 0xD8, 0x2E, 
+0x35, 0x37, 0xE6, 0x08, 0x25, 0x29, 0x6D, 0x68, 
+0x29, 0x68, 0x69, 0xA0, 0xCB, 0xED, 0x7D, 0xF7, 
+0x21, 0xEE, 0x09, 0xC7, 0x5F, 0xB1, 0xFD, 0xB1, 
+0x3B, 0xDF, 0x76, 0x77, 0xE7, 0x7F, 0x66, 0x81, 
+0x59, 0x2E, 0x0B, 0xC4, 0x8E, 0xA1, 0x09, 0xF0, 
+0x05, 0x3C, 0x83, 0x72, 0xC2, 0x26, 0xD8, 0x87, 
+0xDE, 0xFB, 0x0D, 0xE7, 0xAA, 0x8D, 0x82, 0x03, 
+0xDB, 0x23, 0x71, 0x82, 0x6E, 0x43, 0x6E, 0x5B, 
+0x46, 0x03, 0x92, 0x1B, 
+
+0x32, 0x49, 0x9B, 0x19, 0xA7, 0x18, 0xC2, 0x64, 
+0x79, 0x6A, 0xCC, 0x63, 0x20, 0x05, 0xBC, 0x4C, 
+0x86, 0x02, 0x64, 0x64, 0x32, 0x63, 0x80, 0x39, 
+0xFC, 0xD4, 0xC7, 0x83, 0x61, 0x94, 0x46, 0x87, 
+0x26, 0x6A, 0xEA, 0x18, 0xAE, 0x44, 0x09, 0xAE, 
+0xE8, 0x69, 0xBC, 0xDD, 0xE3, 0x43, 0xAA, 0x20, 
+0xB6, 0x8B, 0xF8, 0xBB, 0x48, 0x73, 0x79, 0xCA, 
+0x3D, 0xAA, 0x43, 0x9B, 0xD0, 0xD9, 0x93, 0x49, 
+0x26, 0x83, 0xA8, 0x02, 0x95, 0x49, 0x4B, 0x08, 
+0x75, 0xF3, 0x8D, 0xBD, 0xD8, 0x97, 0x04, 0xDE, 
+0x92, 0xB3, 0xBA, 0xA5, 0x0D, 0x35, 0xB2, 0x25, 
+0x9D, 0x84, 0xB5, 0xB9, 0xA3, 
+
+0x5E, 0xBA, 0x0F, 0x35, 0x20, 0x1F, 0x46, 0x5E, 
+0x65, 0xDC, 0xEE, 0xD1, 0xB6, 0x4F, 0x7B, 0xA6, 
+0xFE, 0x03, 0x85, 0xA9, 0x93, 0x6D, 0xEC, 0xBA, 
+0x75, 0x56, 0x42, 0x7D, 0x8A, 0x01, 0x1E, 0x66, 
+0xFB, 0xBB, 0x39, 0xDC, 0x4A, 0xFB, 0xA6, 0x87, 
+0x49, 0xA6, 0xCF, 0x7A, 0xA7, 0x9F, 0xFE, 0xC7, 
+0xE3, 0x2D, 0x46, 0xC7, 0xBD, 0x47, 0x44, 0x52, 
+0xBE, 0x12, 0xDA, 0x3E, 0x9A, 0x9B, 0x1C, 0xE4, 
+0x0A, 0x7F, 0x11, 0xA2, 0xC2, 0x9A, 0x8E, 0x9D, 
+0x77, 0x18, 0x1A, 0xF9, 0x97, 0x06, 0x12, 0xDD, 
+0x31, 0x82, 0xB2, 0x9B, 0xA6, 0x17, 0x8C, 0x30, 
+0xF1, 0xDB, 0x5D, 0x94, 0xF8, 0xE4, 
+
+0x16, 0x8A, 0xB7, 0x69, 0x98, 0xEF, 0xBB, 0x01, 
+0xBF, 0xF9, 0x6C, 0x4F, 0xE3, 0x0D, 0xE6, 0xF6, 
+0x30, 0x9C, 0x39, 0x11, 0x0B, 0x16, 0x4A, 0xBF, 
+0x96, 0x6C, 0x93, 0x37, 0x26, 0x54, 0x85, 0x1F, 
+0x4B, 0x82, 0xF1, 0xAC, 0x31, 0x4F, 0x6B, 0x1B, 
+0x6C, 0x83, 0x01, 0xAA, 0x3A, 0x6A, 0x49, 0x1C, 
+0x47, 0xFE, 0x18, 0x3E, 0x9F, 0xE3, 0x38, 0x62, 
+0xA7, 0x50, 0xD3, 0xEE, 0x25, 0x8F, 0x03, 0x47, 
+0xBD, 0xBC, 0x38, 0x0B, 0x2E, 0x7F, 0x7D, 0xCD, 
+0xAC, 0x99, 0x1E, 0x54, 0x26, 0xA5, 0x55, 0xE3, 
+0x75, 0xFB, 0x73, 0x20, 0xB5, 0x36, 0x34, 0x94, 
+0x86, 0xEE, 0x65, 0xCA, 0x1E, 0x8B, 0x4A, 
+
+0xED, 0xE7, 0x3F, 0x10, 0xEC, 0xE3, 0x4F, 0x6E, 
+0x31, 0xD9, 0x8A, 0x7A, 0x11, 0x6F, 0xCD, 0x48, 
+0x06, 0x84, 0x8A, 0xA9, 0x91, 0x09, 0x39, 0x6D, 
+0x02, 0x18, 0xF3, 0x5B, 0x95, 0x2B, 0x0C, 0x64, 
+0xBA, 0xD3, 0x9C, 0x24, 0xE0, 0xBF, 0x8A, 0xF6, 
+0x7C, 0x3E, 0x12, 0x9C, 0xC8, 0xB3, 0x44, 0x3F, 
+0xAF, 0x25, 0xAC, 0x8B, 0x0E, 0x54, 0xBC, 0x5B, 
+0xEE, 0x1B, 0x95, 0xFB, 0xAD, 0xA9, 0x4B, 0xF3, 
+0xF0, 0x04, 0x4A, 0x10, 0x2C, 0xA0, 0x99, 0xFF, 
+0xF3, 0xA5, 0x71, 0x73, 0xE8, 0xD5, 0x55, 0x46, 
+0x5E, 0x6E, 0x5F, 0x42, 0x45, 0x7D, 0x41, 0x12, 
+0x59, 0x4A, 0x1E, 0xF9, 0x98, 0x8C, 0x46, 0x0A, 
+
+0xAA, 0x4F, 0xD7, 0x3C, 0x43, 0x9A, 0xCB, 0x9B, 
+0x37, 0x08, 0xC0, 0xA8, 0xF1, 0x41, 0xEB, 0xA1, 
+0x51, 0xA0, 0x3E, 0x41, 0xC6, 0xF1, 0x83, 0x2E, 
+0xB6, 0x33, 0xFD, 0x59, 0x8B, 0xB1, 0xCD, 0x1D, 
+0x90, 0x2E, 0xC9, 0x34, 0x69, 0x9F, 0xF4, 0xA8, 
+0x95, 0xD4, 0xF4, 0x25, 0x05, 0x52, 0x1D, 0x93, 
+0x19, 0x3E, 0x9B, 0x52, 0x3B, 0xAA, 0xE6, 0x7F, 
+0x35, 0x2B, 0x43, 0xA3, 0xD4, 0xB8, 0x66, 0x17, 
+0x34, 0xDE, 0x0E, 0x0E, 0xA2, 0xF8, 0xBF, 0x01, 
+0x68, 0x6B, 0xCC, 0x53, 0x53, 0x07, 0x27, 0xA0, 
+0x84, 0xCB, 0x31, 0x41, 0x17, 0xB1, 0xF7, 0x41, 
+0x9D, 0xFA, 0x6F, 0x0E, 0xA5, 0x1F, 0x3B, 0xC5, 
+0xC5, 
+
+0x11, 0xDB, 0x13, 0x3F, 0x89, 0x2D, 0xF1, 0x79, 
+0x90, 0x44, 0x1F, 0x12, 0x7E, 0x5F, 0xF1, 0x4D, 
+0xA4, 0x7F, 0x4A, 0x72, 0x1D, 0x1D, 0x6B, 0xB3, 
+0x82, 0xF4, 0x10, 0xEC, 0x3C, 0xE9, 0xED, 0xE2, 
+0x41, 0x81, 0x5A, 0x0A, 0xEE, 0x64, 0x16, 0x9B, 
+0xA4, 0xC0, 0xAF, 0x67, 0x9C, 0x5F, 0xA4, 0xFC, 
+0x9E, 0x29, 0x84, 0xBE, 0x33, 0x76, 0x1D, 0x4E, 
+0x34, 0xB5, 0x27, 0xDC, 0x41, 0x0E, 0x87, 0xB8, 
+0x52, 0x7C, 0x15, 0xC3, 0x6F, 0xBD, 0x80, 0xBA, 
+0x4F, 0xFB, 0x32, 0x76, 0xA4, 0x4D, 0xF3, 0xA6, 
+0x38, 0x4A, 0x15, 0xE9, 0x89, 0xCB, 0x26, 0xB6, 
+0x0C, 0x73, 0xD8, 0x81, 0xE3, 0xD2, 0x87, 0x40, 
+0x1B, 0x36, 
+
+0xB7, 0x8C, 0x7C, 0x65, 0x81, 0x33, 0x9C, 0xD1, 
+0x6F, 0xFD, 0xBB, 0xF1, 0xFB, 0x4F, 0xAC, 0x96, 
+0x63, 0xBB, 0x97, 0x25, 0x6C, 0x70, 0xB4, 0x37, 
+0xE6, 0xC9, 0x1E, 0x10, 0xE1, 0xA2, 0x09, 0xBD, 
+0xE7, 0x28, 0x4A, 0x5E, 0x33, 0x6E, 0xE0, 0xCA, 
+0xC3, 0x6A, 0x16, 0xE6, 0x58, 0x8A, 0xCB, 0xBC, 
+0xB3, 0x23, 0xC8, 0x48, 0xF2, 0x31, 0xF7, 0x69, 
+0x74, 0xD8, 0xF8, 0xD9, 0x45, 0x84, 0x51, 0xA0, 
+0x5C, 0x51, 0x05, 0xC0, 0x13, 0x61, 0xFC, 0x0B, 
+0xD1, 0xF3, 0x2A, 0xA6, 0xC6, 0xC1, 0x79, 0x3B, 
+0x1C, 0x47, 0xC7, 0x17, 0x36, 0x05, 0xAC, 0x9D, 
+0x89, 0x0D, 0x80, 0x6F, 0xB9, 0x6F, 0xB0, 0x0C, 
+0x0A, 0xFC, 0x38, 
+
+0xBB, 0x70, 0xCD, 0x7F, 0xB2, 0x80, 0xB0, 0x09, 
+0x9D, 0x37, 0x3F, 0x5B, 0xF4, 0xE7, 0xFB, 0x67, 
+0x2E, 0xBB, 0xDB, 0x44, 0x8F, 0x00, 0xBE, 0xF0, 
+0x3C, 0x53, 0x8F, 0x7A, 0xAD, 0x21, 0x18, 0xF8, 
+0xDF, 0xB2, 0x9E, 0xA5, 0xE9, 0x55, 0xEC, 0x52, 
+0xF7, 0x38, 0x66, 0x73, 0xA6, 0x3A, 0x42, 0x79, 
+0xAA, 0x38, 0xEB, 0x0E, 0x3D, 0xF3, 0x40, 0xCF, 
+0x06, 0x15, 0x9F, 0xE0, 0x24, 0x89, 0x43, 0x89, 
+0x85, 0xB1, 0x17, 0x2D, 0xE2, 0xE1, 0x8F, 0xD1, 
+0xC0, 0x46, 0x65, 0x8A, 0xDB, 0x23, 0x7B, 0x6A, 
+0xE3, 0x56, 0xC9, 0xA9, 0x1D, 0x3B, 0x24, 0x1C, 
+0xC8, 0xD5, 0x47, 0x5D, 0x7E, 0x06, 0xFC, 0xCA, 
+0xEE, 0x92, 0x50, 0x13, 
+
+0x44, 0xC9, 0xD6, 0xF8, 0x6D, 0x63, 0x50, 0xF1, 
+0xC7, 0x4F, 0x2D, 0xEA, 0x10, 0x9D, 0x1A, 0x7A, 
+0xBE, 0x97, 0x85, 0xAA, 0x3C, 0xD0, 0xA7, 0x2A, 
+0xFA, 0x65, 0x06, 0xCD, 0x38, 0xA5, 0x9A, 0x89, 
+0xA1, 0x27, 0x6A, 0xF2, 0xD2, 0x05, 0x24, 0x78, 
+0x2C, 0xD2, 0xE0, 0x1C, 0x84, 0x0B, 0x6C, 0x77, 
+0x9E, 0x90, 0x30, 0x1C, 0x30, 0x1A, 0x69, 0x1D, 
+0x38, 0xD5, 0x0A, 0x43, 0xFD, 0xF0, 0x3C, 0x7A, 
+0xC9, 0xDB, 0x49, 0x89, 0x38, 0xB6, 0x75, 0xEB, 
+0x29, 0xF4, 0xA0, 0x76, 0x5F, 0xBA, 0xC3, 0xB4, 
+0x94, 0x63, 0x9F, 0x80, 0xF1, 0xE1, 0x67, 0x6F, 
+0x9D, 0x96, 0x3E, 0x81, 0x6A, 0x06, 0x63, 0xF8, 
+0x05, 0x64, 0xC2, 0x47, 0x35, 
+
+0x64, 0x8A, 0xF4, 0x6F, 0x3E, 0xE8, 0xA5, 0xC6, 
+0xFD, 0x35, 0xB4, 0x53, 0x6D, 0x67, 0xD7, 0x6B, 
+0x3C, 0x6F, 0x24, 0xA6, 0xFE, 0x5D, 0xD0, 0x43, 
+0x8E, 0xF8, 0x1A, 0x17, 0x98, 0x4C, 0x62, 0xF9, 
+0xC6, 0x06, 0x9C, 0xA0, 0x91, 0xC0, 0x4B, 0x8B, 
+0x23, 0xDC, 0x01, 0x6A, 0x31, 0x85, 0x35, 0x77, 
+0xE6, 0x1E, 0x9A, 0x63, 0x61, 0xC8, 0x47, 0xA4, 
+0xA6, 0x5B, 0x58, 0xDA, 0x5A, 0x05, 0x6A, 0xE9, 
+0xDC, 0xD8, 0x61, 0x0C, 0x20, 0x0C, 0x16, 0xC2, 
+0xFC, 0x95, 0x7D, 0xD6, 0xAF, 0x47, 0x29, 0x2D, 
+0x81, 0x66, 0xD5, 0x47, 0xE2, 0x5D, 0x82, 0x8E, 
+0x67, 0x1B, 0x88, 0xF0, 0xEE, 0x19, 0x86, 0xB6, 
+0x19, 0xAC, 0x57, 0x47, 0xCD, 0x79, 
+
+0x25, 0xBD, 0x48, 0x06, 0xE8, 0xE9, 0xF6, 0x12, 
+0xCC, 0x45, 0x9C, 0x48, 0xC1, 0x05, 0xF7, 0xE6, 
+0x78, 0xEF, 0x7D, 0x11, 0xE8, 0x59, 0xD6, 0x09, 
+0x6F, 0xCE, 0x47, 0x96, 0xCA, 0x59, 0x0C, 0xFD, 
+0xE0, 0x78, 0x2A, 0x07, 0xF1, 0x71, 0x03, 0x57, 
+0x3A, 0x15, 0x56, 0x3D, 0x00, 0x23, 0x10, 0x83, 
+0x25, 0xFE, 0xE8, 0x06, 0xDC, 0xF8, 0x2E, 0x0C, 
+0x56, 0x02, 0xE8, 0x89, 0x69, 0x04, 0x6F, 0xD7, 
+0x40, 0xAD, 0x44, 0x71, 0x00, 0x61, 0x1C, 0x32, 
+0x0F, 0x21, 0x08, 0x29, 0x03, 0x30, 0x21, 0xCF, 
+0xC9, 0x52, 0xF1, 0x64, 0x35, 0x29, 0x08, 0x8B, 
+0xE0, 0xC2, 0x1D, 0xA3, 0x89, 0xDD, 0x4D, 0x9F, 
+0xB6, 0x28, 0xAB, 0xB0, 0x09, 0x22, 0x40, 
+
+0x57, 0x69, 0x86, 0x06, 0x9F, 0x55, 0x5C, 0x1B, 
+0x37, 0x5F, 0x18, 0x90, 0x54, 0x87, 0xB0, 0x0C, 
+0xA9, 0xA7, 0x54, 0x80, 0xE5, 0xA5, 0x34, 0x74, 
+0xAE, 0xA7, 0x53, 0x11, 0xBB, 0x13, 0x28, 0x4C, 
+0xD2, 0xDE, 0xE5, 0xF7, 0x0A, 0x56, 0xB2, 0x66, 
+0x21, 0xAD, 0xE6, 0xBA, 0x1B, 0xAA, 0x72, 0xEC, 
+0x97, 0x18, 0xDB, 0xBD, 0xE9, 0x57, 0x44, 0xC0, 
+0xAD, 0xD9, 0xC2, 0x8E, 0x70, 0x83, 0x03, 0xD8, 
+0xF7, 0x3D, 0x4A, 0x13, 0x13, 0xC0, 0xC4, 0xD0, 
+0x8C, 0x9B, 0xB1, 0x2E, 0x98, 0x00, 0xA6, 0x0B, 
+0x91, 0xFE, 0x39, 0x51, 0x8E, 0xC0, 0x73, 0xDD, 
+0x14, 0x2E, 0x07, 0xC4, 0xD6, 0xB3, 0x9E, 0x76, 
+0x1B, 0x30, 0xFA, 0x99, 0x71, 0xD0, 0x2E, 0x7E, 
+
+0xC1, 0x94, 0x1B, 0x45, 0xA7, 0x1D, 0x02, 0x52, 
+0x3F, 0xBE, 0xEE, 0x79, 0xAD, 0xE3, 0xCA, 0x8D, 
+0x85, 0x7A, 0x2F, 0x77, 0xBE, 0xA1, 0x8E, 0x5F, 
+0x9C, 0xA1, 0x51, 0x0E, 0xE6, 0xE8, 0x71, 0x5E, 
+0x77, 0x61, 0x77, 0x79, 0x59, 0x54, 0xE0, 0xB3, 
+0x61, 0xB2, 0x62, 0xD7, 0x93, 0x6E, 0x9F, 0x62, 
+0x91, 0xAE, 0xFF, 0x2A, 0xE9, 0x28, 0xEA, 0x07, 
+0x63, 0xFA, 0xFB, 0x2C, 0x5F, 0x31, 0x9A, 0x4A, 
+0x70, 0x3A, 0x5D, 0xEB, 0xD9, 0xCE, 0x8A, 0x62, 
+0x46, 0x3E, 0x79, 0x72, 0x3B, 0x0C, 0x79, 0xE9, 
+0x65, 0xEC, 0x3C, 0x19, 0x38, 0x12, 0xF4, 0xE2, 
+0x4D, 0xE8, 0x77, 0xAA, 0x0B, 0xB9, 0xB1, 0xD3, 
+0xB7, 0x9E, 0x60, 0xBA, 0x18, 0x4D, 0x8A, 0x80, 
+0x89, 
+
+0xEB, 0xE7, 0x52, 0x05, 0xBE, 0xE1, 0xDF, 0x59, 
+0xB8, 0x24, 0xE2, 0xE6, 0x68, 0x15, 0x0F, 0x27, 
+0x3C, 0xDC, 0x2B, 0xD9, 0x1F, 0x98, 0xAB, 0x54, 
+0x86, 0x77, 0xC0, 0x22, 0x8F, 0xC8, 0xF7, 0x91, 
+0x75, 0x07, 0xFD, 0x25, 0x68, 0x2D, 0xA7, 0x67, 
+0xCC, 0x4D, 0x1D, 0xA6, 0x70, 0x75, 0x42, 0x15, 
+0x6B, 0xF2, 0x7C, 0x65, 0x4C, 0x68, 0x05, 0x61, 
+0xBD, 0xC8, 0xC4, 0x40, 0xF0, 0x2B, 0xC8, 0x69, 
+0x55, 0xF1, 0xD6, 0x76, 0x2D, 0xC7, 0x7E, 0x53, 
+0x75, 0x92, 0x41, 0x24, 0x09, 0x0B, 0x22, 0xE4, 
+0x96, 0x98, 0x7B, 0x11, 0x4C, 0x09, 0x14, 0x4A, 
+0x94, 0xCA, 0xB1, 0xC1, 0xA8, 0x9D, 0x05, 0x80, 
+0x97, 0xDC, 0xBC, 0x80, 0xDB, 0xEA, 0xE8, 0x17, 
+0x73, 0xEC, 
+
+0xCB, 0x29, 0x64, 0x08, 0x49, 0x59, 0x29, 0xAC, 
+0xA0, 0x53, 0x33, 0x43, 0x5A, 0x3D, 0x6B, 0x05, 
+0x2D, 0x42, 0x62, 0x7E, 0x9C, 0x52, 0xB0, 0x45, 
+0x38, 0x1B, 0x88, 0x4E, 0xA9, 0xB1, 0xA5, 0xAB, 
+0x94, 0x6A, 0xF1, 0x47, 0x09, 0x81, 0xCA, 0xA8, 
+0x39, 0x7D, 0x67, 0x44, 0x7F, 0xAE, 0x5C, 0x41, 
+0xA7, 0xDE, 0xBD, 0x0E, 0xE2, 0x72, 0xFA, 0x42, 
+0x1E, 0x93, 0x12, 0xD3, 0x8D, 0x60, 0x8E, 0xD6, 
+0x97, 0x47, 0x9C, 0x43, 0x30, 0x0B, 0x77, 0xFB, 
+0xD5, 0xAF, 0x46, 0xDF, 0xC7, 0x7B, 0x8A, 0x91, 
+0xC1, 0xF6, 0xE9, 0xF3, 0x23, 0x8D, 0xF0, 0x57, 
+0x76, 0xDB, 0x18, 0x7B, 0xAA, 0xD3, 0x46, 0x71, 
+0x32, 0x43, 0xAA, 0xB5, 0x45, 0x25, 0x22, 0xB6, 
+0xB4, 0xB1, 0x02, 
+
+0x88, 0x8E, 0x5C, 0x46, 0x3D, 0xB9, 0xDB, 0x13, 
+0xB6, 0x84, 0x36, 0xCA, 0xAE, 0xBB, 0xBF, 0x54, 
+0x68, 0xE8, 0x1C, 0xE2, 0x21, 0x89, 0x7B, 0xF1, 
+0xD4, 0x8D, 0x4C, 0x58, 0x19, 0x73, 0xFE, 0x14, 
+0x08, 0x02, 0x6C, 0xFA, 0xF0, 0x43, 0x3E, 0x33, 
+0xC4, 0xB1, 0x53, 0x42, 0x41, 0x38, 0x89, 0x7C, 
+0x65, 0x1E, 0x06, 0x64, 0xF9, 0xEB, 0x1B, 0x16, 
+0xE4, 0x1F, 0x48, 0x07, 0x2B, 0x5C, 0x58, 0xA9, 
+0x29, 0x88, 0x57, 0xE7, 0x18, 0x9E, 0x51, 0x73, 
+0xC1, 0x12, 0x8F, 0x84, 0xC0, 0x42, 0x01, 0x32, 
+0x7C, 0x16, 0x5B, 0xB4, 0x69, 0xEA, 0x70, 0x8B, 
+0x3D, 0xD9, 0xCD, 0x16, 0xDE, 0x77, 0xF9, 0x98, 
+0x3C, 0xB2, 0xB5, 0x38, 0xBE, 0x80, 0xDC, 0xCC, 
+0xD2, 0xC1, 0x10, 0x98, 
+
+0xD4, 0xAB, 0x9D, 0xE1, 0x7F, 0x8D, 0x5E, 0x5A, 
+0x2E, 0xC9, 0x8C, 0xFB, 0xC5, 0xBF, 0xB8, 0xEA, 
+0x2F, 0x54, 0x62, 0x85, 0xB5, 0x87, 0xEB, 0xDB, 
+0xEE, 0xBA, 0x29, 0xBA, 0x7E, 0x67, 0x80, 0x70, 
+0x82, 0x48, 0xAC, 0x98, 0x34, 0xAD, 0x6D, 0xD7, 
+0x7A, 0x53, 0x75, 0xFF, 0x56, 0x20, 0x5A, 0x1B, 
+0xD4, 0xBD, 0x03, 0x57, 0xEC, 0x7F, 0xE7, 0x15, 
+0xDD, 0xCA, 0x0B, 0x36, 0xB8, 0xD7, 0x4E, 0xB2, 
+0x97, 0xCB, 0xA6, 0x66, 0xCB, 0x26, 0xBF, 0xE8, 
+0x53, 0xF6, 0x9A, 0x7E, 0xA7, 0x4F, 0xE6, 0xBA, 
+0xFF, 0x6D, 0xD6, 0x0A, 0x93, 0xE2, 0x6E, 0x54, 
+0xA9, 0xD8, 0xF8, 0x06, 0xF1, 0x58, 0x39, 0x47, 
+0x09, 0x27, 0x11, 0x35, 0x55, 0x3C, 0x69, 0x25, 
+0x71, 0x06, 0x8D, 0x78, 0x0F, 
+
+0x01, 0x0F, 0x2F, 0xE8, 0x71, 0x7D, 0x21, 0xB5, 
+0xF9, 0x9C, 0x3F, 0x22, 0xC1, 0x00, 0x95, 0xBA, 
+0xEC, 0x4A, 0x27, 0xAC, 0x6B, 0x3E, 0x25, 0x97, 
+0x18, 0xC6, 0x31, 0x7A, 0x56, 0xC8, 0x6D, 0x31, 
+0x76, 0xAA, 0x16, 0x02, 0xDB, 0x18, 0xE1, 0x87, 
+0x42, 0xC3, 0xA6, 0x08, 0x8B, 0x4E, 0xEF, 0xFD, 
+0x56, 0xE0, 0xCD, 0xAE, 0x74, 0xDB, 0x0A, 0x75, 
+0x6A, 0x6F, 0xFE, 0xCA, 0x01, 0x9F, 0x51, 0x1A, 
+0xE6, 0x39, 0xF8, 0x75, 0x6B, 0x52, 0x08, 0x0F, 
+0xE1, 0x62, 0xEF, 0x5A, 0xFB, 0x78, 0x00, 0xB7, 
+0xF2, 0xA7, 0x56, 0x35, 0x95, 0xB1, 0xCB, 0x60, 
+0xA3, 0x65, 0xE7, 0x88, 0xB0, 0x57, 0x6C, 0x25, 
+0x95, 0x25, 0xFE, 0x89, 0x9F, 0x48, 0x95, 0xE8, 
+0x43, 0x48, 0x2B, 0x7B, 0x03, 0x37, 
+
+0x4A, 0xE2, 0xBC, 0x8E, 0xA6, 0x1E, 0xDF, 0xCD, 
+0x29, 0x62, 0xA6, 0x62, 0xE3, 0x24, 0x11, 0xE0, 
+0x6E, 0x7B, 0x48, 0x63, 0xED, 0x65, 0x2F, 0xF9, 
+0x4E, 0x85, 0x49, 0x8F, 0xF1, 0x90, 0x69, 0x67, 
+0x38, 0xBC, 0x51, 0xDB, 0x08, 0x7A, 0xAE, 0x60, 
+0x95, 0xBB, 0x77, 0x65, 0x6C, 0x54, 0xD1, 0x93, 
+0xA5, 0xA5, 0xCD, 0x5D, 0xC1, 0x10, 0x3A, 0x71, 
+0x86, 0xDE, 0x49, 0xFC, 0x8B, 0x91, 0xB6, 0xD9, 
+0x24, 0x35, 0x7A, 0x49, 0xC8, 0xE6, 0x23, 0x84, 
+0x61, 0xC7, 0x48, 0xDB, 0xF2, 0x1E, 0xA3, 0xF9, 
+0xC6, 0x0D, 0xCD, 0xAD, 0x89, 0x86, 0x11, 0x2F, 
+0x26, 0x30, 0xED, 0x03, 0x07, 0x62, 0x94, 0xFC, 
+0x56, 0xC9, 0x8F, 0xB5, 0x1B, 0x75, 0x8B, 0x3C, 
+0xBE, 0xCC, 0x8C, 0x7C, 0x7D, 0x63, 0xE7, 
+
+0xF5, 0x83, 0x26, 0xC2, 0xF8, 0xCA, 0x81, 0x44, 
+0x3C, 0xEC, 0x39, 0xBC, 0x8A, 0x8E, 0xFD, 0xEF, 
+0xD1, 0x22, 0xA8, 0x35, 0xE2, 0x96, 0xFF, 0xCA, 
+0x86, 0xBC, 0x18, 0xFF, 0xF0, 0x2B, 0x60, 0x36, 
+0x24, 0x13, 0x7D, 0x0A, 0xED, 0x1F, 0xD9, 0x2C, 
+0xCE, 0x90, 0x19, 0x5F, 0x19, 0x1A, 0xA5, 0x5E, 
+0x10, 0xB6, 0xFD, 0xAD, 0x3A, 0x33, 0xB2, 0xA1, 
+0x28, 0xB0, 0xB4, 0x8A, 0xD1, 0xFD, 0xD9, 0x7E, 
+0x0F, 0x4D, 0xC5, 0xD5, 0x6D, 0xC0, 0x4A, 0xAB, 
+0xF6, 0xD1, 0xEF, 0x14, 0xFF, 0xF3, 0x5B, 0xED, 
+0x71, 0xD1, 0x8B, 0x45, 0xEE, 0x26, 0xAB, 0xE0, 
+0xE9, 0x91, 0xA0, 0xF8, 0xE1, 0x3C, 0x7B, 0xCE, 
+0xF5, 0xCD, 0x23, 0x45, 0xBF, 0xF3, 0x07, 0xBE, 
+0x49, 0xE4, 0xC9, 0xE7, 0xB3, 0x54, 0x26, 0xB0, 
+
+0xD0, 0xFD, 0x08, 0x3C, 0x57, 0x76, 0xD4, 0x71, 
+0xC1, 0x52, 0x99, 0x19, 0xAA, 0xD5, 0xA3, 0x12, 
+0x70, 0xDA, 0x25, 0x6C, 0x9E, 0xC0, 0x8B, 0xC8, 
+0x12, 0xB7, 0xAB, 0xDC, 0x6D, 0xC8, 0x0A, 0x1F, 
+0x7A, 0x5D, 0x26, 0xA6, 0x17, 0xDF, 0x89, 0x0E, 
+0x49, 0x82, 0xF6, 0xE6, 0xFB, 0xEA, 0x99, 0xB3, 
+0x1B, 0xCC, 0x5B, 0xCB, 0xD3, 0x12, 0x44, 0x20, 
+0x25, 0x24, 0x4B, 0x52, 0xBF, 0x9E, 0xAA, 0x21, 
+0xFA, 0xD2, 0xF2, 0x66, 0x32, 0xA1, 0xB1, 0x34, 
+0x56, 0x80, 0x59, 0x42, 0x87, 0xB3, 0x86, 0x2D, 
+0x02, 0xAC, 0x53, 0x45, 0xEB, 0x63, 0xCB, 0xF8, 
+0x92, 0xF6, 0xB8, 0x3F, 0x30, 0x17, 0x38, 0xA1, 
+0x08, 0xB4, 0x43, 0x9A, 0x4A, 0xD3, 0x11, 0xF1, 
+0x8D, 0xFF, 0x95, 0x72, 0x00, 0xE0, 0x3D, 0xB2, 
+0xDC, 
+
+0xA2, 0x3E, 0xB4, 0x4C, 0x35, 0xCC, 0xE1, 0x90, 
+0x52, 0xC5, 0xF8, 0xA2, 0x7D, 0x58, 0x7A, 0x4E, 
+0x87, 0xBF, 0xAC, 0xE5, 0x69, 0x4D, 0x68, 0xA4, 
+0xD8, 0xDA, 0x4E, 0x81, 0x25, 0x56, 0x93, 0xF9, 
+0xBF, 0x78, 0x7A, 0xD2, 0xAC, 0x18, 0xAB, 0x77, 
+0x75, 0x51, 0xBE, 0x7D, 0xC1, 0x30, 0x9C, 0xE2, 
+0x56, 0xDD, 0x96, 0x47, 0x72, 0xC3, 0xC2, 0x67, 
+0xE4, 0x37, 0x81, 0xAE, 0x4E, 0x4F, 0x2F, 0xEE, 
+0x03, 0x14, 0x99, 0x70, 0x1C, 0x2D, 0x12, 0xE9, 
+0x04, 0x50, 0xAB, 0xEE, 0x82, 0x8A, 0x3B, 0x81, 
+0x9F, 0xAF, 0x38, 0x00, 0x49, 0x32, 0x17, 0x20, 
+0xF2, 0x37, 0xF3, 0xF8, 0xDD, 0x76, 0xC9, 0xC2, 
+0xB1, 0x34, 0xC9, 0x7C, 0xFB, 0xEF, 0x66, 0x1F, 
+0x81, 0x90, 0x85, 0xFE, 0x76, 0x5F, 0x7B, 0x0D, 
+0x7E, 0x04, 
+
+0x5C, 0x18, 0x7C, 0x0C, 0x1C, 0x35, 0x6B, 0xEB, 
+0x11, 0x99, 0x8C, 0x8E, 0x38, 0x7A, 0x5F, 0x48, 
+0xE8, 0x35, 0x5E, 0xCE, 0xF3, 0xBB, 0x91, 0x88, 
+0x00, 0x2D, 0x30, 0x33, 0x9C, 0xDD, 0xA3, 0xA8, 
+0xD0, 0x35, 0xD8, 0x41, 0x31, 0x3A, 0xF9, 0xD6, 
+0x69, 0x28, 0xE7, 0xC8, 0x8B, 0x2E, 0x0C, 0x06, 
+0xB5, 0x81, 0x04, 0x55, 0x3E, 0xA0, 0xAC, 0x8B, 
+0x79, 0xA3, 0x8F, 0x6D, 0x79, 0xF9, 0xE3, 0xF7, 
+0x51, 0xBB, 0x77, 0x1E, 0x12, 0xCE, 0x5D, 0x40, 
+0x32, 0xF7, 0x12, 0xD8, 0x9B, 0x4B, 0xC8, 0x10, 
+0xB0, 0xD7, 0xC3, 0x67, 0x7B, 0x6E, 0xB0, 0xF0, 
+0xEB, 0xE1, 0x41, 0xBC, 0x45, 0xA2, 0xF5, 0xC4, 
+0xCF, 0x09, 0xCB, 0x5E, 0x87, 0x86, 0xA1, 0x14, 
+0x9D, 0xB8, 0x62, 0xCC, 0xFF, 0x74, 0xB2, 0x3A, 
+0xD9, 0xA8, 0x8A, 
+
+0xEA, 0x1B, 0x67, 0xC6, 0x9E, 0x7A, 0x88, 0xDD, 
+0x40, 0x3B, 0xA6, 0xEF, 0x5D, 0x6C, 0x1B, 0x66, 
+0x5E, 0x57, 0xD4, 0xA5, 0x19, 0x5E, 0xA3, 0xFA, 
+0x21, 0x47, 0x49, 0x6B, 0x10, 0x62, 0x24, 0xBE, 
+0x65, 0xC7, 0x4C, 0xBE, 0x6A, 0x87, 0xDE, 0xB3, 
+0x20, 0x94, 0xFF, 0x6F, 0x21, 0x2D, 0xE7, 0xAA, 
+0x21, 0xDF, 0x49, 0x23, 0x73, 0x5D, 0xB1, 0x12, 
+0x84, 0x2F, 0xFB, 0xC2, 0x65, 0x29, 0xA2, 0xB3, 
+0xBA, 0xB5, 0x75, 0xCB, 0xEF, 0x5F, 0x51, 0xE8, 
+0xC0, 0x08, 0x58, 0x8B, 0x75, 0x0D, 0xFB, 0xCC, 
+0xF9, 0xD4, 0xDF, 0x12, 0xB0, 0x22, 0x6D, 0x9B, 
+0x8E, 0xB5, 0x08, 0x78, 0x27, 0x07, 0x61, 0x1D, 
+0xD2, 0xA4, 0xF1, 0x13, 0x97, 0x4C, 0x75, 0xF6, 
+0x2A, 0xAF, 0xBE, 0xF1, 0x02, 0x89, 0xE5, 0xE2, 
+0x88, 0x9D, 0x3C, 0x5A, 
+
+0xB5, 0xF7, 0x24, 0xF6, 0x84, 0xA3, 0x89, 0x20, 
+0xD8, 0x1D, 0xAF, 0x0F, 0x6B, 0xA7, 0x2A, 0x9B, 
+0x6C, 0xF6, 0x61, 0x38, 0xCB, 0x09, 0x8A, 0xD2, 
+0xA8, 0x75, 0x13, 0xB5, 0x07, 0x3C, 0xDD, 0x4F, 
+0xE5, 0x20, 0x4B, 0xE0, 0x58, 0xED, 0x9B, 0x38, 
+0x3B, 0x33, 0xB6, 0xF3, 0xFE, 0xD6, 0x80, 0x7D, 
+0x9C, 0x59, 0x10, 0x54, 0xE5, 0x4A, 0xD0, 0xB7, 
+0x66, 0x13, 0x78, 0x74, 0xFF, 0xC2, 0x89, 0x58, 
+0xB3, 0x2F, 0x68, 0xF2, 0xF7, 0x2B, 0x01, 0xF5, 
+0xC6, 0x3F, 0xD5, 0x78, 0xC3, 0x80, 0x0E, 0x30, 
+0x61, 0xA7, 0x5C, 0x09, 0xF9, 0x89, 0xBB, 0x33, 
+0x55, 0x5F, 0x34, 0x3C, 0xF9, 0x4B, 0x51, 0xD4, 
+0xE8, 0xF2, 0xEC, 0xE9, 0x2A, 0x9B, 0xB1, 0xC1, 
+0x6D, 0x81, 0x19, 0x61, 0x7B, 0xA8, 0x00, 0x85, 
+0x03, 0x26, 0x9A, 0xD9, 0x2F, 
+
+0x06, 0xB2, 0x67, 0xAE, 0x51, 0x80, 0x8D, 0xFD, 
+0x12, 0x6E, 0x09, 0xDB, 0x78, 0x72, 0xC6, 0xCF, 
+0xE0, 0x6A, 0x16, 0x71, 0xDC, 0x29, 0x3F, 0x96, 
+0xAF, 0xA6, 0x8B, 0xB1, 0x1E, 0xAA, 0x79, 0xC3, 
+0x35, 0x0F, 0x2D, 0x93, 0xDE, 0x45, 0x77, 0x63, 
+0x1F, 0x87, 0x57, 0x24, 0x83, 0x0A, 0x3C, 0xEC, 
+0x80, 0x1E, 0x1A, 0xB0, 0x16, 0x7E, 0x13, 0x29, 
+0x75, 0x06, 0x31, 0x06, 0xD9, 0x73, 0xD0, 0xE7, 
+0x33, 0xAA, 0xE0, 0xEB, 0x12, 0x57, 0x66, 0x86, 
+0x53, 0xEA, 0x9B, 0xCC, 0x9F, 0xED, 0x36, 0x07, 
+0x27, 0xFB, 0x71, 0x8F, 0x48, 0x0C, 0x7D, 0x9F, 
+0x8E, 0x1A, 0xAC, 0xCA, 0xAE, 0xD0, 0xFB, 0xE7, 
+0x7F, 0xFE, 0x9E, 0x94, 0x20, 0x49, 0xF4, 0xC6, 
+0x5F, 0x9F, 0x07, 0xE3, 0x7F, 0x80, 0x3C, 0x4F, 
+0x34, 0x40, 0xE0, 0x12, 0x3F, 0xCA, 
+
+0x1C, 0x90, 0xAF, 0x8E, 0x74, 0x0D, 0xF4, 0x66, 
+0x0C, 0x88, 0x3B, 0x22, 0x27, 0x13, 0xB5, 0xD0, 
+0x22, 0x46, 0xE1, 0x72, 0xAB, 0x34, 0xAB, 0xA4, 
+0xC5, 0xEE, 0xBE, 0x5B, 0x16, 0x44, 0x90, 0x17, 
+0x89, 0x3F, 0x74, 0x50, 0x87, 0x76, 0xF1, 0x3F, 
+0xEB, 0x1A, 0x3E, 0xFE, 0xA7, 0xB6, 0x41, 0x36, 
+0xB5, 0x38, 0xD2, 0x63, 0x54, 0xFF, 0xCE, 0xE2, 
+0xAC, 0x74, 0xCE, 0x9D, 0x88, 0x86, 0x6B, 0x1D, 
+0xC7, 0x72, 0x66, 0x34, 0xDE, 0x14, 0x00, 0xE8, 
+0x8F, 0xF0, 0xBB, 0x4A, 0x02, 0xE2, 0x0E, 0x3E, 
+0xFB, 0xCE, 0x0F, 0x43, 0x43, 0xE5, 0xC9, 0xD9, 
+0x71, 0x32, 0xAA, 0x39, 0xDF, 0x1D, 0x4B, 0xA0, 
+0xCD, 0x90, 0xAE, 0xBF, 0xE0, 0x70, 0x04, 0x48, 
+0x5C, 0x93, 0xB3, 0xCF, 0xFF, 0xB2, 0x1C, 0xFA, 
+0x71, 0x02, 0x01, 0x0F, 0xD7, 0xA6, 0x28, 
+
+0xC4, 0x6E, 0x61, 0x82, 0xF6, 0x51, 0xDE, 0xFC, 
+0xAA, 0xFF, 0x8F, 0xC8, 0x3A, 0xC7, 0x18, 0x26, 
+0xF9, 0x02, 0x8B, 0xB8, 0x29, 0xC0, 0x82, 0xC1, 
+0xEC, 0x74, 0x59, 0xB1, 0x47, 0xAC, 0xB8, 0x92, 
+0x21, 0xF9, 0xC4, 0xB9, 0x5C, 0x5A, 0xF8, 0xD0, 
+0x91, 0x76, 0xE3, 0xDD, 0xBC, 0xB7, 0x96, 0xAC, 
+0x0A, 0x56, 0x55, 0xBE, 0xD6, 0xB5, 0x78, 0xD8, 
+0x2A, 0xED, 0x58, 0x81, 0x25, 0x22, 0x65, 0xE3, 
+0x87, 0x1E, 0x0C, 0x28, 0xAB, 0x39, 0xB2, 0x69, 
+0x0C, 0xF2, 0x0B, 0x05, 0x70, 0x37, 0xD6, 0xB4, 
+0x98, 0xF7, 0xC2, 0xE5, 0x20, 0x85, 0x1A, 0x35, 
+0x61, 0xD6, 0x94, 0xCD, 0xDB, 0x7C, 0xF9, 0xD7, 
+0x94, 0xB9, 0xDA, 0x80, 0x68, 0x81, 0x4E, 0x51, 
+0x0F, 0xFB, 0x9C, 0xD0, 0xF3, 0xCE, 0xD3, 0x0B, 
+0xE7, 0x9F, 0xFA, 0xD8, 0xB9, 0xC9, 0xC8, 0x29, 
+
+0x67, 0x07, 0x59, 0x81, 0x28, 0x52, 0x0A, 0x08, 
+0x68, 0x11, 0x89, 0xCF, 0x46, 0x1A, 0x31, 0xFA, 
+0xBA, 0xA5, 0xF9, 0x70, 0x2F, 0x5A, 0x42, 0x26, 
+0x55, 0xA7, 0x55, 0xE0, 0xC2, 0x86, 0x1F, 0x0C, 
+0xD0, 0xA4, 0xFA, 0x26, 0x2C, 0xC8, 0xDB, 0x4F, 
+0xE7, 0xAD, 0xBB, 0x37, 0xC3, 0x3C, 0x03, 0x9B, 
+0x40, 0x40, 0x8B, 0x34, 0xD0, 0xC8, 0x9D, 0xFF, 
+0xE5, 0x90, 0xAA, 0x67, 0x4C, 0x78, 0xBA, 0x44, 
+0x79, 0xDB, 0xF1, 0x16, 0x9E, 0x3A, 0x7A, 0x80, 
+0x01, 0xA8, 0xE2, 0xDB, 0xAD, 0xD1, 0xAD, 0x01, 
+0x26, 0xB6, 0xB2, 0xCD, 0xDB, 0x46, 0xC2, 0xC7, 
+0xC6, 0x47, 0x62, 0x72, 0xC8, 0xC1, 0x69, 0x5B, 
+0x29, 0x3E, 0xBE, 0x5C, 0x5C, 0xDD, 0xED, 0xBD, 
+0x3C, 0x68, 0xEE, 0xC0, 0xAD, 0x31, 0x84, 0x61, 
+0x60, 0x51, 0x74, 0xC0, 0x8F, 0x0A, 0x93, 0x2C, 
+0x01, 
+
+0xF8, 0x63, 0x65, 0xFB, 0x5A, 0x73, 0xD4, 0x65, 
+0x5B, 0xD4, 0xB4, 0x8D, 0x1C, 0x71, 0xE1, 0x53, 
+0xD1, 0x21, 0x4F, 0x3F, 0x53, 0xD9, 0xDA, 0x21, 
+0x9C, 0x06, 0x58, 0x9E, 0x5B, 0x13, 0x15, 0xA0, 
+0x40, 0xEB, 0x83, 0x88, 0x7E, 0x5C, 0x31, 0x7C, 
+0x49, 0x95, 0x03, 0x8F, 0x66, 0x68, 0xD6, 0x79, 
+0x03, 0x7D, 0x8B, 0xD5, 0x9A, 0x58, 0xEB, 0xB6, 
+0xC0, 0xF0, 0xF5, 0xA8, 0x38, 0x52, 0x15, 0x4D, 
+0x7D, 0xE1, 0x93, 0x47, 0xEC, 0xB3, 0x2F, 0xE8, 
+0xB7, 0xC0, 0x0D, 0x8F, 0x18, 0x0B, 0x78, 0x4F, 
+0x73, 0x6E, 0x90, 0x47, 0x3C, 0xDD, 0x1E, 0x3E, 
+0x0B, 0x54, 0x65, 0xCF, 0x9F, 0xE6, 0x55, 0x7A, 
+0xD4, 0x64, 0xF6, 0x2B, 0xEC, 0x60, 0xA0, 0x8F, 
+0x4A, 0x64, 0xB3, 0x0A, 0x46, 0xF2, 0x33, 0x5C, 
+0x2C, 0x13, 0x3A, 0x1F, 0x24, 0x1F, 0xED, 0x75, 
+0xAA, 0x57, 
+
+0x8E, 0xA6, 0x31, 0x5F, 0xDA, 0x36, 0x40, 0x2C, 
+0x93, 0x83, 0x85, 0x72, 0x4E, 0x2E, 0xFF, 0x3B, 
+0x23, 0x2D, 0x5C, 0xF5, 0x5A, 0xEC, 0x0B, 0xFD, 
+0x63, 0xE0, 0xF2, 0xB7, 0xE7, 0x72, 0x1E, 0xA7, 
+0x59, 0x67, 0x16, 0x40, 0xDD, 0x7C, 0x8D, 0x3E, 
+0x84, 0xE9, 0xCA, 0x34, 0x01, 0xB4, 0x6D, 0x18, 
+0xB2, 0xD5, 0x2D, 0x38, 0x85, 0xF6, 0x01, 0xBA, 
+0x69, 0x9A, 0xF7, 0x09, 0xC2, 0x0C, 0x1C, 0xF9, 
+0x73, 0xD9, 0x5F, 0x98, 0x9E, 0x6A, 0xB2, 0x07, 
+0x9A, 0x8B, 0xE8, 0x59, 0xA3, 0x0F, 0x7F, 0x3B, 
+0x00, 0x29, 0x7D, 0x25, 0xFD, 0xD5, 0xA1, 0x0D, 
+0xA5, 0xE4, 0x30, 0x21, 0x5E, 0xCD, 0x28, 0x65, 
+0xFA, 0x23, 0xEB, 0xE3, 0x57, 0x01, 0xAC, 0x30, 
+0x10, 0xF3, 0x42, 0x51, 0x76, 0x65, 0x1F, 0x77, 
+0x00, 0xC8, 0xA5, 0xE3, 0xE4, 0x0D, 0x87, 0x97, 
+0x45, 0xAA, 0xEA, 
+
+0xAA, 0xBC, 0xA8, 0x6B, 0xD8, 0x6F, 0x52, 0x10, 
+0x15, 0x17, 0xD5, 0x5A, 0xEC, 0x66, 0xFD, 0x54, 
+0x8F, 0xDB, 0x89, 0x91, 0x32, 0xD3, 0xA5, 0x78, 
+0x9C, 0x40, 0x62, 0xC1, 0x8F, 0xA7, 0xD1, 0xDE, 
+0x96, 0x55, 0x75, 0x46, 0xE1, 0xA8, 0xC3, 0x30, 
+0xAE, 0x9A, 0xB4, 0x29, 0x3F, 0xF3, 0x83, 0xB8, 
+0x0F, 0xA0, 0xFA, 0xFA, 0xE4, 0x3D, 0x08, 0xD1, 
+0x8B, 0x68, 0xEA, 0x72, 0x66, 0xBE, 0x68, 0xE1, 
+0xA4, 0x40, 0x24, 0x4E, 0xEC, 0xDA, 0xDD, 0x7C, 
+0x11, 0x20, 0xBE, 0x0E, 0xE8, 0xEE, 0xEF, 0xE0, 
+0x5F, 0xA7, 0x4F, 0xF8, 0x73, 0x16, 0x83, 0x04, 
+0xE4, 0xFC, 0xBC, 0xE9, 0x61, 0xC8, 0x01, 0x35, 
+0x84, 0xD2, 0x82, 0x78, 0x5A, 0x0E, 0x96, 0x12, 
+0x32, 0x3A, 0xE8, 0x81, 0xE4, 0x27, 0xCB, 0x27, 
+0x04, 0x27, 0xDF, 0x4A, 0xB5, 0x89, 0xF0, 0xF4, 
+0x6C, 0xFE, 0x82, 0x43, 
+
+0xC8, 0xF3, 0xA1, 0xAD, 0xAA, 0x90, 0x23, 0x46, 
+0x96, 0x72, 0xC8, 0xE4, 0x5E, 0x03, 0x78, 0x00, 
+0x1B, 0x57, 0x0C, 0x6F, 0x7A, 0x3C, 0x6F, 0xF6, 
+0x11, 0xF2, 0x8A, 0xB2, 0x03, 0xD5, 0x7A, 0x28, 
+0x75, 0x2D, 0x93, 0x4B, 0xF5, 0x73, 0xD6, 0x6E, 
+0x81, 0xF3, 0x90, 0xFF, 0x38, 0xD6, 0x1A, 0x02, 
+0x86, 0x5B, 0x83, 0x1E, 0x8F, 0x2F, 0xDF, 0x69, 
+0xAE, 0xB5, 0xDE, 0x61, 0xDB, 0x0E, 0x36, 0x99, 
+0x03, 0xA4, 0x3F, 0x26, 0x8C, 0xEF, 0xB6, 0x7E, 
+0xBD, 0xD8, 0x12, 0x43, 0xB0, 0x3C, 0x62, 0x52, 
+0xCE, 0xB3, 0x9A, 0x30, 0xF5, 0x16, 0x22, 0xE0, 
+0x8F, 0x7E, 0xF5, 0x97, 0xA1, 0x49, 0xF6, 0x22, 
+0x87, 0x4F, 0x76, 0x75, 0xB2, 0x76, 0x2B, 0x35, 
+0xB6, 0xAE, 0x1F, 0xB8, 0x4C, 0x86, 0x9C, 0x79, 
+0xC3, 0x46, 0xC2, 0x5D, 0x2B, 0x1A, 0xF3, 0x32, 
+0x8B, 0x74, 0x53, 0xCE, 0xB5, 
+
+0x9C, 0x4D, 0xBB, 0xE3, 0x9E, 0x90, 0x4D, 0x20, 
+0xC6, 0x19, 0xFF, 0x80, 0x96, 0x79, 0x66, 0xC3, 
+0xE3, 0x76, 0x0C, 0x87, 0x7F, 0x5D, 0x5E, 0x28, 
+0xED, 0xB4, 0x03, 0x75, 0x00, 0x27, 0x60, 0x88, 
+0x15, 0x1A, 0xAE, 0x6E, 0x8C, 0x09, 0xA0, 0x50, 
+0x4A, 0x4F, 0x9F, 0xB1, 0x13, 0x51, 0xF3, 0x3C, 
+0xE5, 0xEE, 0x3E, 0xBE, 0x6E, 0xC3, 0x65, 0x8C, 
+0x18, 0x3A, 0x09, 0xBA, 0xCE, 0x85, 0xB7, 0xC6, 
+0x72, 0xB2, 0x1B, 0xFF, 0xC3, 0x51, 0x26, 0x5E, 
+0xAB, 0x5A, 0x34, 0xD2, 0xEE, 0xFB, 0x1B, 0xE6, 
+0x02, 0xDA, 0x2E, 0xAD, 0x85, 0x35, 0x73, 0x44, 
+0x6B, 0x58, 0x95, 0x03, 0x5D, 0xA7, 0x85, 0x1B, 
+0xE1, 0x50, 0x3D, 0xBF, 0x4D, 0xC9, 0xF6, 0xD6, 
+0xA3, 0x4D, 0xEA, 0xC0, 0x1A, 0x73, 0xFE, 0x25, 
+0x40, 0x0A, 0x32, 0xA4, 0x06, 0xFA, 0x3C, 0x30, 
+0xB9, 0xED, 0x83, 0x83, 0x2C, 0x2F, 
+
+0x17, 0xC8, 0x77, 0x0F, 0xBC, 0x4F, 0x55, 0x1E, 
+0x93, 0x7C, 0xA8, 0xFF, 0xDE, 0x88, 0x9C, 0x8C, 
+0xD6, 0x82, 0x18, 0xAB, 0x00, 0xD6, 0xC8, 0x80, 
+0xD1, 0xDB, 0x57, 0xB7, 0xB4, 0x04, 0x58, 0x51, 
+0x2D, 0x02, 0xD6, 0xCD, 0x3D, 0xC8, 0x80, 0xDF, 
+0x8B, 0x2D, 0x09, 0x57, 0x34, 0xF1, 0xDC, 0xA0, 
+0xBF, 0xE8, 0xF6, 0x55, 0x20, 0x64, 0x6B, 0x61, 
+0x0A, 0xDB, 0xC0, 0x3E, 0x08, 0x0A, 0x7B, 0x54, 
+0xCE, 0xAB, 0xE4, 0xF6, 0xFE, 0x74, 0xFC, 0xF6, 
+0x5E, 0xA4, 0xC4, 0x9A, 0x13, 0x3C, 0x6F, 0x3F, 
+0x68, 0x46, 0xDD, 0x62, 0xAE, 0x8A, 0x9E, 0x64, 
+0x0C, 0x44, 0x06, 0xDA, 0xED, 0xAF, 0x59, 0x97, 
+0x50, 0xCA, 0x53, 0x2C, 0xEF, 0x02, 0xC5, 0xE5, 
+0x4E, 0x0A, 0x71, 0xF6, 0x9A, 0x8D, 0x76, 0x60, 
+0xA0, 0x22, 0x94, 0xF7, 0x6C, 0xC4, 0xA0, 0xAC, 
+0x83, 0x30, 0x1C, 0xA1, 0x37, 0xF0, 0xA8, 
+
+0x63, 0xEA, 0x8B, 0xA5, 0xBD, 0x6B, 0x84, 0x01, 
+0xF0, 0x0C, 0x84, 0x82, 0x31, 0x68, 0xD0, 0x87, 
+0xA0, 0xAC, 0xA7, 0x1F, 0x72, 0x63, 0x64, 0x44, 
+0x8E, 0x44, 0xD7, 0x9E, 0x2D, 0x90, 0x23, 0xE7, 
+0x90, 0x5B, 0xB3, 0xA1, 0x4B, 0xBA, 0xB0, 0xF1, 
+0xE3, 0xCA, 0xA1, 0xD8, 0xFB, 0xC7, 0x45, 0x1C, 
+0x28, 0xC9, 0xD4, 0x69, 0x5E, 0x70, 0x43, 0xDD, 
+0xE4, 0xB0, 0x7B, 0x12, 0x76, 0x7E, 0xDF, 0x34, 
+0x7B, 0xEC, 0x17, 0x9F, 0xC8, 0xED, 0xBA, 0x58, 
+0xC5, 0xDB, 0xFE, 0xE1, 0x65, 0x6E, 0x19, 0x93, 
+0x34, 0x07, 0xF2, 0x0B, 0x8D, 0x59, 0x4B, 0xCA, 
+0x26, 0x5D, 0x3C, 0xD3, 0x79, 0xB5, 0xDE, 0x4E, 
+0xB6, 0xF2, 0xB7, 0x13, 0x6D, 0xF2, 0xAD, 0x3A, 
+0xC1, 0x20, 0x75, 0x4A, 0x54, 0x91, 0x86, 0x26, 
+0x3E, 0x9A, 0xB3, 0xAE, 0xFA, 0xFB, 0x35, 0xF6, 
+0xA9, 0x20, 0xE1, 0xAC, 0x95, 0x35, 0x71, 0x79, 
+
+0x54, 0x14, 0x68, 0xFB, 0xD7, 0x77, 0x8C, 0x23, 
+0x0E, 0xC6, 0xEC, 0x9B, 0x58, 0x38, 0x90, 0x4D, 
+0xF1, 0xB1, 0x3F, 0xB3, 0x13, 0x69, 0xD1, 0x7C, 
+0xCD, 0x6A, 0xEC, 0xA2, 0xC2, 0x70, 0x4D, 0x29, 
+0x3D, 0x3B, 0xF4, 0x4C, 0x47, 0x62, 0xA9, 0x8B, 
+0x78, 0x10, 0x3C, 0xA6, 0x18, 0xDF, 0x2D, 0x5F, 
+0xDF, 0x40, 0xB0, 0xE2, 0xB1, 0xA0, 0x30, 0x31, 
+0x8B, 0x1A, 0x40, 0x64, 0x49, 0x73, 0x9C, 0xC3, 
+0x4F, 0x3B, 0x5B, 0x2B, 0x5C, 0x30, 0x8D, 0xF8, 
+0xB0, 0xEA, 0x01, 0x8E, 0xEE, 0x7E, 0x20, 0xE0, 
+0x92, 0xB4, 0x96, 0x2C, 0x47, 0xD3, 0xC0, 0x43, 
+0x70, 0x6C, 0x70, 0xC2, 0xA8, 0xAF, 0xB6, 0xE8, 
+0x32, 0x37, 0x70, 0xEE, 0x65, 0xFA, 0x7E, 0xD3, 
+0x51, 0x15, 0x8E, 0x4F, 0x06, 0xB3, 0x86, 0xB8, 
+0x95, 0x7F, 0x4F, 0x41, 0x25, 0x79, 0x12, 0x01, 
+0xA6, 0x72, 0xF2, 0xFF, 0xDC, 0x92, 0x4E, 0x79, 
+0xEC, 
+
+0x3D, 0xC3, 0x0A, 0x36, 0x79, 0xE2, 0x7E, 0xF5, 
+0xF6, 0xCA, 0x15, 0x72, 0x22, 0xD1, 0x54, 0x92, 
+0x9F, 0x4C, 0x0C, 0xCB, 0x9E, 0xC2, 0xC7, 0xEF, 
+0xD4, 0xF8, 0xF6, 0xE6, 0x6B, 0xCA, 0x96, 0xA4, 
+0x59, 0x64, 0xB9, 0x1E, 0x4F, 0x24, 0x02, 0xE4, 
+0x8A, 0xD1, 0x9D, 0x9E, 0x7B, 0x61, 0xBF, 0x3B, 
+0x5F, 0xA0, 0x8B, 0xA2, 0x48, 0xF1, 0x01, 0x5D, 
+0x1C, 0x02, 0xC4, 0x31, 0x23, 0x5C, 0xD0, 0xA6, 
+0x9A, 0xFA, 0xBC, 0x0B, 0xFE, 0x1E, 0xFB, 0x0E, 
+0x58, 0x4B, 0x33, 0x76, 0xC4, 0x4A, 0x68, 0x2C, 
+0x61, 0xA6, 0x71, 0x34, 0x24, 0xC9, 0xB8, 0x84, 
+0x5B, 0x9A, 0xBC, 0xD6, 0x4F, 0x0C, 0xE5, 0xBA, 
+0x44, 0xFB, 0x7F, 0x1A, 0x96, 0x80, 0x8C, 0xDC, 
+0xD8, 0xB9, 0x0F, 0xFA, 0xE5, 0x94, 0xF4, 0x4A, 
+0x79, 0x99, 0x74, 0x1C, 0xC0, 0x74, 0x94, 0x92, 
+0x35, 0x3F, 0x16, 0x13, 0xFB, 0x20, 0xC4, 0x4F, 
+0xAC, 0xA9, 
+
+0xF3, 0x3C, 0xB0, 0xA3, 0xBC, 0xEC, 0x21, 0x3E, 
+0xCE, 0x8B, 0x9E, 0x21, 0xF3, 0x40, 0x83, 0x6B, 
+0x9F, 0x6B, 0x22, 0x79, 0xCC, 0xA7, 0x0C, 0x7F, 
+0xFF, 0xCC, 0xB7, 0xB1, 0xD8, 0xD0, 0xAF, 0x29, 
+0x20, 0xDE, 0x8E, 0x2D, 0xD5, 0x33, 0x85, 0x39, 
+0xA5, 0x80, 0x98, 0x30, 0xB2, 0x68, 0x1B, 0xF7, 
+0x05, 0x45, 0x61, 0xC0, 0x04, 0xCE, 0xCD, 0x80, 
+0x90, 0x5F, 0x79, 0x9E, 0x4E, 0xB6, 0xD0, 0xC6, 
+0x12, 0x39, 0x3C, 0xCE, 0x05, 0x1B, 0x47, 0xFD, 
+0x40, 0x9E, 0x47, 0x66, 0xAF, 0xB9, 0x93, 0xE0, 
+0x97, 0x3C, 0xE3, 0xAA, 0x83, 0xEA, 0xE6, 0x9E, 
+0x8A, 0x2B, 0x18, 0x5E, 0x06, 0x4E, 0xA4, 0x96, 
+0x6B, 0x2D, 0xA3, 0xFC, 0x8D, 0x99, 0x79, 0xE8, 
+0x02, 0x76, 0x67, 0x47, 0x42, 0xD0, 0xA2, 0xCB, 
+0x5B, 0x6F, 0x60, 0x6A, 0xD8, 0xA4, 0x3C, 0xA3, 
+0xFF, 0xF3, 0xEA, 0xF5, 0xDF, 0x27, 0x21, 0xD5, 
+0xC2, 0xFC, 0x12, 
+
+0x21, 0xB3, 0x90, 0x3D, 0xF0, 0x7C, 0x5B, 0x51, 
+0x46, 0x1F, 0xF8, 0x6B, 0x0E, 0x57, 0xC6, 0xBD, 
+0xBB, 0x12, 0xB5, 0x6D, 0xE5, 0x75, 0x2F, 0x33, 
+0x0C, 0x7C, 0x27, 0x60, 0xB8, 0xC6, 0x2D, 0x49, 
+0x91, 0x3E, 0x7E, 0x73, 0x72, 0x1C, 0xB5, 0x7B, 
+0xBB, 0x42, 0xA2, 0xA9, 0x06, 0xA5, 0xDF, 0x53, 
+0xF8, 0x06, 0xFA, 0x02, 0xBE, 0x76, 0xE5, 0x10, 
+0xAB, 0x8C, 0x24, 0x8F, 0x8D, 0x24, 0xD8, 0xE6, 
+0x69, 0x08, 0xCB, 0xD6, 0xC6, 0x4B, 0xFB, 0xB0, 
+0x4C, 0x2F, 0x3D, 0x06, 0xD4, 0xBC, 0xB0, 0x48, 
+0x61, 0x2B, 0xE8, 0xBB, 0x79, 0x47, 0xEE, 0x3A, 
+0xF9, 0xA8, 0xEA, 0xE8, 0xAD, 0xA4, 0xC2, 0xFF, 
+0x2E, 0x60, 0x99, 0x91, 0xAB, 0xBA, 0xDB, 0x4F, 
+0x42, 0x4F, 0xB6, 0x17, 0xAC, 0xFC, 0xFE, 0xBE, 
+0x8B, 0xE7, 0xE8, 0x5C, 0x89, 0x38, 0xDA, 0x50, 
+0x9A, 0xD4, 0x3C, 0x5C, 0xA8, 0x1C, 0xFA, 0xC4, 
+0x08, 0xEB, 0x5C, 0xE1, 
+
+0xED, 0x1D, 0xA5, 0x6F, 0xED, 0x03, 0xFD, 0x65, 
+0x77, 0xEE, 0x6D, 0x62, 0x98, 0x91, 0x63, 0x79, 
+0xB1, 0x79, 0xCF, 0xD9, 0x9F, 0x66, 0xBC, 0x83, 
+0xC7, 0x18, 0x6E, 0xD1, 0x8D, 0x32, 0x42, 0x07, 
+0x89, 0x20, 0xBD, 0xE0, 0x83, 0x30, 0xD4, 0x0C, 
+0xB1, 0x13, 0xC4, 0xAF, 0x32, 0xAE, 0xBC, 0xF8, 
+0x02, 0x69, 0x94, 0x75, 0xD4, 0xCD, 0x57, 0xB5, 
+0xC7, 0xBD, 0xAA, 0x25, 0x61, 0xD4, 0xEF, 0x02, 
+0x5C, 0x8F, 0x47, 0x89, 0xAF, 0x39, 0x40, 0x31, 
+0xE7, 0x6C, 0x88, 0xB9, 0xFF, 0xCE, 0x66, 0xE0, 
+0x40, 0xAC, 0xC6, 0xCF, 0xFA, 0x11, 0xE8, 0xE9, 
+0xDA, 0xAA, 0x54, 0xB1, 0xF0, 0x94, 0x0A, 0xAC, 
+0x86, 0x7B, 0x70, 0xBE, 0x95, 0xEA, 0x66, 0x78, 
+0x03, 0x9C, 0x26, 0x49, 0xA6, 0x4C, 0xB0, 0x2C, 
+0x8C, 0xCF, 0x5E, 0x42, 0x5C, 0x92, 0xF0, 0xB6, 
+0x88, 0xD3, 0x8E, 0x59, 0x5E, 0x98, 0xA0, 0xB7, 
+0xD1, 0xB4, 0x33, 0x9B, 0x5E, 
+
+0xF3, 0xA9, 0xE7, 0xE9, 0x21, 0x9F, 0x88, 0xEE, 
+0x56, 0xE4, 0xA8, 0xB9, 0xF0, 0xB0, 0xB1, 0xB8, 
+0x4C, 0xB8, 0x83, 0xC4, 0xB7, 0xC7, 0xB9, 0xF7, 
+0x72, 0x59, 0xB5, 0x2D, 0x64, 0x90, 0xEE, 0x61, 
+0x0E, 0xEE, 0xF8, 0x34, 0x1C, 0xE7, 0x40, 0x60, 
+0x83, 0xEA, 0x08, 0x7B, 0x6B, 0x86, 0x2D, 0x08, 
+0xD6, 0x57, 0x15, 0x57, 0x0D, 0x2D, 0x41, 0x9F, 
+0x5C, 0x4E, 0xEA, 0x60, 0xF8, 0x3E, 0x36, 0x60, 
+0xAA, 0x04, 0x18, 0xB8, 0x43, 0x5C, 0x6A, 0xF0, 
+0x80, 0x18, 0xE2, 0x8B, 0x3E, 0x1C, 0x70, 0xDA, 
+0xAF, 0x7C, 0xEC, 0xA8, 0xB2, 0x1D, 0x62, 0x33, 
+0x3B, 0xE4, 0x51, 0x7F, 0x4C, 0x31, 0x32, 0x7F, 
+0xC2, 0x6C, 0x5C, 0x1B, 0x54, 0xF3, 0x46, 0x00, 
+0xFE, 0xFE, 0xCB, 0xE0, 0xFF, 0x88, 0xBC, 0x57, 
+0xC4, 0x1F, 0xFD, 0x3E, 0xD7, 0xF8, 0x70, 0x42, 
+0x94, 0x97, 0x5F, 0xC0, 0xA6, 0x43, 0x70, 0xDE, 
+0x36, 0xBC, 0xE3, 0xC2, 0x04, 0x3E, 
+
+0x32, 0xEB, 0x03, 0xE3, 0xEC, 0x2C, 0x23, 0xB5, 
+0xEA, 0xE1, 0x7F, 0xDB, 0x6D, 0xA9, 0x58, 0x15, 
+0xE1, 0xA1, 0x04, 0x45, 0x3F, 0x32, 0xD6, 0xC9, 
+0x03, 0x90, 0xB9, 0xCB, 0xBE, 0x76, 0x60, 0x02, 
+0xB9, 0x8A, 0x4A, 0x4C, 0xB1, 0x06, 0xEA, 0x53, 
+0x4A, 0x70, 0xF8, 0x47, 0xCD, 0x61, 0x02, 0x68, 
+0x53, 0x90, 0xD1, 0xE7, 0x0E, 0xE6, 0x14, 0xC1, 
+0xB1, 0x27, 0x54, 0x1A, 0x03, 0x53, 0x49, 0x7A, 
+0x21, 0xCA, 0x8C, 0x38, 0xE7, 0xB1, 0x28, 0xC7, 
+0x2E, 0xE8, 0x1E, 0xE5, 0x61, 0xE1, 0x01, 0xDD, 
+0xCC, 0xA8, 0xBA, 0x50, 0x54, 0x85, 0x8E, 0x75, 
+0x57, 0xBD, 0x0D, 0xC5, 0x5C, 0x36, 0x7F, 0xEC, 
+0x48, 0xFD, 0x92, 0x22, 0x9D, 0x25, 0xA6, 0x16, 
+0x79, 0x16, 0xEE, 0x99, 0xBA, 0x77, 0xD6, 0xF1, 
+0x50, 0xE8, 0x39, 0x4F, 0x10, 0xA0, 0xAD, 0x8B, 
+0xDB, 0x24, 0x92, 0x37, 0x02, 0x5E, 0x39, 0x0B, 
+0xB3, 0x23, 0x24, 0xB4, 0x75, 0x41, 0x51, 
+
+0x6A, 0x4E, 0x84, 0xB6, 0x49, 0x38, 0x0F, 0x2C, 
+0x31, 0x3F, 0xDF, 0x1D, 0xB5, 0x24, 0xE0, 0x32, 
+0xDF, 0x9D, 0x47, 0x4C, 0x9D, 0x02, 0x87, 0xF8, 
+0x6D, 0x3C, 0x94, 0xBA, 0x01, 0xC6, 0x3D, 0x3F, 
+0xD9, 0xB2, 0x1B, 0xB1, 0xE5, 0x32, 0x97, 0x58, 
+0x4C, 0x26, 0x73, 0x68, 0x62, 0x35, 0xD6, 0xA5, 
+0xAD, 0x48, 0x27, 0x1C, 0xBF, 0x30, 0x54, 0xDE, 
+0x0A, 0x2C, 0x7D, 0x0E, 0x6B, 0x18, 0x09, 0xD3, 
+0xE8, 0x07, 0x0F, 0xC8, 0xB9, 0xED, 0x16, 0xAF, 
+0x54, 0x70, 0x5D, 0x77, 0xF7, 0x33, 0x25, 0x14, 
+0x89, 0x9B, 0x28, 0xAB, 0x38, 0xB4, 0x56, 0x5B, 
+0x3B, 0x95, 0x1E, 0x36, 0xB9, 0x2C, 0xF4, 0x68, 
+0x7C, 0xB1, 0xA4, 0x69, 0x2A, 0x3F, 0xAC, 0x7C, 
+0x04, 0x1F, 0x48, 0x62, 0x3C, 0xFF, 0x91, 0xD1, 
+0x6A, 0x5C, 0xA0, 0x9E, 0xF6, 0x84, 0x56, 0x23, 
+0x49, 0xA4, 0xC1, 0x5C, 0xD5, 0xAC, 0x91, 0x42, 
+0x0D, 0x0E, 0xA5, 0x78, 0xC4, 0xB0, 0x90, 0xB9, 
+
+0x94, 0xED, 0x3F, 0x16, 0x6A, 0x95, 0x25, 0x66, 
+0xB3, 0x37, 0x87, 0x29, 0x4B, 0xB8, 0x21, 0x11, 
+0xBE, 0xBD, 0x5F, 0xA1, 0x2C, 0xC1, 0x5B, 0x6F, 
+0xFD, 0x91, 0xB0, 0x28, 0x49, 0x54, 0x1E, 0xEA, 
+0x20, 0xB8, 0xC5, 0x1D, 0x5C, 0xEA, 0xE0, 0x67, 
+0xE3, 0xF5, 0x50, 0x75, 0x0D, 0xB7, 0x40, 0x00, 
+0xEC, 0x17, 0x8A, 0x46, 0xC0, 0xE5, 0x83, 0x3A, 
+0x2C, 0x32, 0xF0, 0x18, 0x76, 0x85, 0x84, 0x9F, 
+0x89, 0x45, 0x03, 0xEE, 0x53, 0x01, 0xAE, 0xEE, 
+0xAC, 0xB1, 0x5A, 0xE8, 0x65, 0x74, 0x62, 0x61, 
+0xD1, 0x1D, 0x41, 0xE2, 0xEE, 0x01, 0x48, 0xD5, 
+0xD5, 0xE5, 0xE0, 0x08, 0x59, 0xD7, 0xBF, 0xE2, 
+0xC1, 0x38, 0xA2, 0xE3, 0x47, 0xFC, 0xB1, 0x87, 
+0x5D, 0x93, 0x1E, 0x2E, 0x2F, 0x49, 0x7B, 0x50, 
+0x45, 0x61, 0xFD, 0x24, 0xA1, 0xBD, 0xE4, 0xE7, 
+0x8A, 0xEB, 0xB6, 0x7C, 0xF5, 0x45, 0x1D, 0x80, 
+0x92, 0x15, 0x71, 0x08, 0xFC, 0x4E, 0xF7, 0xFD, 
+0xA3, 
+
+0x0E, 0x19, 0x57, 0x31, 0x5F, 0x45, 0x32, 0x5A, 
+0x4E, 0x20, 0xF8, 0x94, 0x3B, 0x4F, 0x9A, 0x0B, 
+0x0B, 0xA0, 0x52, 0xA1, 0xF8, 0xF6, 0xA9, 0xED, 
+0x66, 0x87, 0xA1, 0x23, 0xCD, 0x1C, 0x1C, 0x4D, 
+0x4C, 0x06, 0xBE, 0xAC, 0x24, 0xB1, 0xE1, 0x42, 
+0x6F, 0xE1, 0x99, 0xE7, 0x63, 0xA3, 0x19, 0x67, 
+0xE3, 0xBC, 0x39, 0xF2, 0xAB, 0xE2, 0x0A, 0xDE, 
+0x38, 0x88, 0x11, 0xF3, 0x8D, 0xF3, 0x41, 0x6C, 
+0xDB, 0xEB, 0x4A, 0x69, 0xAF, 0x2B, 0xE3, 0x64, 
+0x0E, 0x18, 0x5A, 0x39, 0x0D, 0x53, 0xD4, 0xCE, 
+0x34, 0xB9, 0xFF, 0x19, 0x4F, 0xF9, 0xDB, 0x42, 
+0x79, 0xE9, 0x8C, 0x61, 0x43, 0x70, 0xA7, 0x3A, 
+0x3D, 0x21, 0x9A, 0x35, 0xD8, 0x3B, 0xF1, 0xFA, 
+0x64, 0xE9, 0x36, 0x10, 0xCE, 0xC1, 0x39, 0xFF, 
+0x40, 0x21, 0x81, 0xDC, 0x61, 0x39, 0xE9, 0xFA, 
+0x27, 0x74, 0xAF, 0x57, 0x37, 0x53, 0x25, 0xD0, 
+0x5D, 0x43, 0xE7, 0x9C, 0x85, 0x66, 0xD0, 0xD8, 
+0xF0, 0xF1, 
+
+0xFE, 0xE0, 0x8A, 0x58, 0xB6, 0x4B, 0xB0, 0x35, 
+0xA5, 0xF1, 0x86, 0x28, 0x88, 0xBD, 0x3B, 0x15, 
+0xFF, 0xF2, 0x28, 0x7F, 0x0B, 0xB4, 0xD4, 0x23, 
+0xBE, 0xA1, 0x47, 0xA1, 0x47, 0xED, 0x7E, 0x05, 
+0x8E, 0x55, 0xD8, 0x61, 0xD6, 0x2C, 0x31, 0x86, 
+0xA5, 0x87, 0x80, 0x1D, 0xD1, 0x64, 0x09, 0xB2, 
+0x9A, 0xDD, 0xBC, 0xC2, 0x81, 0x11, 0x09, 0x41, 
+0x33, 0xDF, 0xB7, 0xE9, 0xBF, 0x24, 0x0C, 0x4E, 
+0x9B, 0x4E, 0xB2, 0xBF, 0xB2, 0x57, 0x19, 0xC7, 
+0xD5, 0x5C, 0x24, 0xDC, 0x9F, 0xA5, 0x59, 0xAA, 
+0x04, 0x53, 0xFD, 0x3B, 0x93, 0xDC, 0x48, 0x95, 
+0x24, 0xC7, 0x6A, 0xA6, 0x48, 0x45, 0x27, 0xF9, 
+0x82, 0x39, 0x35, 0xC8, 0x8E, 0x6C, 0x33, 0x56, 
+0xCB, 0x71, 0x3E, 0x58, 0x26, 0xF6, 0xED, 0x69, 
+0x17, 0xEB, 0xBC, 0x50, 0xB5, 0xF5, 0x4F, 0x38, 
+0x3A, 0x08, 0x00, 0x8F, 0xE3, 0xF2, 0x42, 0x7A, 
+0x46, 0xF6, 0xCE, 0x6C, 0x6C, 0x29, 0x1A, 0x6E, 
+0x0C, 0x65, 0xAF, 
+
+0x37, 0x3F, 0x16, 0x90, 0x0E, 0x1A, 0x9B, 0x2F, 
+0xEA, 0xB5, 0x05, 0x2B, 0xFD, 0x2C, 0xFD, 0x44, 
+0x49, 0x69, 0xBE, 0x6A, 0x36, 0xA2, 0x72, 0x28, 
+0x5F, 0xAE, 0x87, 0x4B, 0xB2, 0xAE, 0xA0, 0x12, 
+0xAA, 0x56, 0xDF, 0x85, 0x68, 0xA5, 0x13, 0x19, 
+0xC2, 0x12, 0x24, 0x0D, 0xF5, 0xD8, 0xFC, 0x25, 
+0x3E, 0x9E, 0xAF, 0x06, 0xFB, 0xB1, 0x2E, 0xF1, 
+0xD6, 0x6D, 0xE0, 0x61, 0xEE, 0x6E, 0xE9, 0xE0, 
+0x9C, 0xB5, 0x6B, 0x3F, 0xD7, 0x3B, 0x5E, 0x94, 
+0xE9, 0x9B, 0x72, 0xD1, 0x7F, 0x90, 0x11, 0x69, 
+0x30, 0x10, 0x14, 0xB1, 0x4E, 0x41, 0xBA, 0xE9, 
+0x51, 0xE3, 0x14, 0x2E, 0x82, 0xEE, 0x92, 0x61, 
+0xF7, 0xEC, 0xA5, 0x3F, 0x3E, 0x3C, 0x07, 0xA8, 
+0x19, 0x97, 0x5F, 0xD7, 0xCE, 0xDD, 0xB2, 0x1A, 
+0xF1, 0x2B, 0xFC, 0x57, 0xDC, 0xBF, 0x6E, 0xDE, 
+0xAA, 0xEC, 0x60, 0xEA, 0xAB, 0x1C, 0x34, 0x1D, 
+0x3A, 0x65, 0x96, 0xEC, 0x3C, 0xB8, 0x6F, 0xD0, 
+0xAE, 0xF9, 0xD6, 0x91, 
+
+0x82, 0xFC, 0xD0, 0x86, 0xBB, 0x00, 0xC1, 0x0C, 
+0x30, 0x38, 0x33, 0x78, 0x95, 0x6E, 0xB8, 0x66, 
+0xB7, 0xD6, 0xDC, 0xF1, 0x5B, 0x37, 0x32, 0xA1, 
+0x4E, 0xFC, 0x08, 0x8B, 0x11, 0xB5, 0x35, 0x77, 
+0xE0, 0x0F, 0xE2, 0xE7, 0x25, 0xF7, 0x60, 0xDB, 
+0xB5, 0x61, 0xDF, 0x1E, 0x62, 0xB4, 0xAB, 0xC7, 
+0x80, 0xA3, 0x5D, 0x03, 0xC3, 0xF2, 0x2B, 0x2C, 
+0x80, 0x62, 0x1F, 0xF9, 0x4B, 0x85, 0xD4, 0x50, 
+0xA9, 0xC4, 0xEF, 0x11, 0x30, 0x35, 0xAB, 0xB4, 
+0x5B, 0x27, 0x04, 0x1F, 0x85, 0xB3, 0xE7, 0xE1, 
+0xA4, 0x15, 0x1B, 0x7D, 0xA6, 0x14, 0x00, 0xDC, 
+0x7F, 0xE0, 0x28, 0x89, 0x09, 0xD0, 0xCD, 0xE9, 
+0x93, 0x29, 0xA6, 0xA1, 0x5B, 0x04, 0xDC, 0xF6, 
+0xC4, 0xA0, 0xA3, 0xF4, 0x75, 0xD7, 0xF7, 0x19, 
+0xF4, 0xC6, 0x28, 0x75, 0x06, 0x8D, 0x10, 0x69, 
+0x6E, 0xAF, 0xA0, 0x06, 0x97, 0xC2, 0xB4, 0xEA, 
+0x18, 0xFE, 0x27, 0xA9, 0x6A, 0xF3, 0x78, 0xD9, 
+0xD6, 0xC7, 0xBA, 0x9D, 0x25, 
+
+0xF6, 0xDC, 0xC2, 0xAA, 0x9F, 0xB3, 0x7A, 0x04, 
+0xBB, 0x69, 0x1B, 0x66, 0x19, 0x13, 0xE4, 0x21, 
+0x5E, 0x40, 0xF7, 0xD8, 0xC6, 0x45, 0xF4, 0xAA, 
+0x51, 0xE4, 0x40, 0x96, 0x2A, 0x35, 0x24, 0xEE, 
+0x9D, 0xE8, 0x6E, 0x24, 0x29, 0x8E, 0x01, 0x83, 
+0x49, 0x8C, 0x1E, 0xA4, 0xBA, 0x57, 0x31, 0x09, 
+0x5B, 0x4E, 0x2F, 0x4C, 0xFB, 0xB7, 0xE7, 0xEE, 
+0xCB, 0x99, 0x7A, 0x27, 0x47, 0x7D, 0x92, 0xEC, 
+0x8A, 0x4A, 0xD8, 0x29, 0x62, 0xB7, 0xC8, 0x75, 
+0x3F, 0xC1, 0xB4, 0xD5, 0x9E, 0xA9, 0xDF, 0x12, 
+0x4C, 0x5F, 0x5B, 0xEF, 0xD5, 0xC3, 0xE2, 0x4C, 
+0xD5, 0xB0, 0x10, 0xA3, 0xA5, 0xED, 0x68, 0x41, 
+0xB5, 0x63, 0xBB, 0x73, 0x13, 0xFA, 0xC4, 0x53, 
+0x09, 0x73, 0x86, 0x25, 0xD5, 0x38, 0xFE, 0xF8, 
+0x61, 0xDF, 0xF6, 0xAF, 0xD6, 0xBB, 0x98, 0x10, 
+0x53, 0x05, 0x3A, 0xAA, 0x49, 0x8A, 0xB8, 0x50, 
+0x99, 0x2F, 0xBD, 0xAA, 0xE4, 0x16, 0x7F, 0x6F, 
+0x26, 0xF9, 0x55, 0xAD, 0xA6, 0x66, 
+
+0x93, 0xF3, 0x1F, 0xEA, 0x39, 0x94, 0x07, 0x50, 
+0x79, 0x84, 0x28, 0xC4, 0x7A, 0xC7, 0x20, 0x96, 
+0xA9, 0x23, 0x7B, 0xE9, 0x00, 0x3C, 0x12, 0xC2, 
+0xD5, 0x2B, 0xDF, 0xFE, 0x3A, 0x3A, 0xF6, 0x71, 
+0xF6, 0xE2, 0x40, 0x33, 0x89, 0x55, 0x79, 0xE8, 
+0xF9, 0x1A, 0x13, 0x1F, 0x3F, 0x12, 0x44, 0xB7, 
+0x46, 0x5F, 0xE2, 0xB2, 0x4D, 0x7E, 0x20, 0xEC, 
+0xD7, 0xC9, 0xA2, 0xCD, 0x51, 0x95, 0xD5, 0xBB, 
+0x90, 0x82, 0x32, 0x77, 0x3B, 0x61, 0x30, 0x12, 
+0xD9, 0x5B, 0x7B, 0xB0, 0xCF, 0xA5, 0x27, 0x49, 
+0xAA, 0x93, 0x80, 0x11, 0xAF, 0x84, 0x27, 0x24, 
+0xC0, 0x69, 0x15, 0x99, 0x1D, 0x48, 0x02, 0x96, 
+0xEC, 0x18, 0xBE, 0xB6, 0xD1, 0x33, 0xB0, 0x7A, 
+0xCF, 0xFA, 0xCB, 0x5B, 0x5C, 0x1F, 0xF1, 0xEE, 
+0x98, 0xFA, 0xD9, 0x45, 0x35, 0xCF, 0x95, 0xD2, 
+0x7F, 0x1B, 0x27, 0xF8, 0xB5, 0x32, 0x35, 0x06, 
+0x2C, 0x40, 0x2D, 0x02, 0xB7, 0xEF, 0xFB, 0xE3, 
+0xC7, 0xC3, 0x7E, 0x32, 0x13, 0xBE, 0x20, 
+
+0x07, 0x6D, 0x60, 0x0A, 0x21, 0x9A, 0x05, 0x80, 
+0x77, 0x98, 0xE8, 0x61, 0x5E, 0x2F, 0xB7, 0x99, 
+0x86, 0xAB, 0xFF, 0xD4, 0xE7, 0xB5, 0xAE, 0x98, 
+0x1B, 0xBF, 0x73, 0x1C, 0x5B, 0x4E, 0xDA, 0x0F, 
+0x8D, 0xCB, 0xA3, 0x16, 0x6B, 0xA1, 0x93, 0x89, 
+0x9F, 0x99, 0xF3, 0x0E, 0xFC, 0x50, 0x37, 0x11, 
+0x56, 0x04, 0xF4, 0x01, 0xD6, 0x30, 0x0F, 0xA4, 
+0x47, 0x06, 0x38, 0x9D, 0xAD, 0x7F, 0xB5, 0x24, 
+0xB8, 0x78, 0xD2, 0x30, 0xE9, 0x9F, 0x66, 0x22, 
+0xF5, 0x70, 0x12, 0xA5, 0xB9, 0xF0, 0x77, 0x25, 
+0x4A, 0x0B, 0xEB, 0x6C, 0x7A, 0x79, 0xDB, 0x2C, 
+0xD7, 0x49, 0x1F, 0x00, 0xCC, 0x9F, 0x54, 0xB8, 
+0xB5, 0x45, 0xF0, 0x81, 0x70, 0x4C, 0x6C, 0x6A, 
+0xEC, 0x96, 0x72, 0x67, 0x01, 0x75, 0x5D, 0xD5, 
+0xF8, 0x40, 0x26, 0xDC, 0x19, 0x86, 0x73, 0x7B, 
+0x97, 0x0A, 0x8B, 0xAE, 0xB8, 0x77, 0xA9, 0xC4, 
+0xA0, 0xF6, 0x1A, 0xC6, 0x84, 0x8F, 0xC1, 0x4E, 
+0x05, 0x83, 0xED, 0xFD, 0x55, 0xD0, 0x09, 0x33, 
+
+0xC6, 0x59, 0xFC, 0x58, 0xEA, 0xD5, 0x56, 0xD8, 
+0x9A, 0x22, 0xF3, 0x5E, 0x16, 0xF5, 0xAB, 0x06, 
+0x8E, 0xA5, 0x69, 0xC5, 0x85, 0xDF, 0xAF, 0x85, 
+0x4B, 0xE9, 0xD1, 0xEA, 0x09, 0xE4, 0x24, 0x3B, 
+0x3A, 0x9A, 0x4A, 0x21, 0xA2, 0xCF, 0x2F, 0x1D, 
+0x64, 0xFA, 0xE3, 0x39, 0x99, 0x1D, 0x3F, 0x8F, 
+0x0A, 0xF8, 0xB1, 0xAA, 0xCF, 0xCF, 0xFA, 0xDA, 
+0xC7, 0x83, 0xB9, 0x3C, 0x9A, 0x8C, 0x2B, 0x9F, 
+0x90, 0x57, 0x23, 0x6A, 0xDC, 0x19, 0xA6, 0x8D, 
+0x1E, 0x40, 0x3D, 0xAB, 0x48, 0x0E, 0xE7, 0xFA, 
+0x58, 0x74, 0x31, 0xE8, 0xFC, 0xF1, 0x04, 0xC9, 
+0xF8, 0xCC, 0x21, 0xC4, 0x57, 0x33, 0x9E, 0x4E, 
+0x0B, 0x88, 0x92, 0x41, 0x2C, 0x68, 0xD8, 0x7A, 
+0xA0, 0x92, 0x5D, 0x85, 0x55, 0x06, 0xAC, 0x04, 
+0xE5, 0x45, 0x0C, 0x53, 0x52, 0xDB, 0xEE, 0x8A, 
+0x5F, 0x11, 0xFB, 0xC0, 0xBF, 0x63, 0x1F, 0x24, 
+0xE6, 0x72, 0x2A, 0x12, 0x6A, 0x29, 0xB2, 0x7A, 
+0x44, 0x1F, 0x35, 0xC5, 0x1D, 0x03, 0x7B, 0x6C, 
+0xC1, 
+
+0x53, 0xE1, 0x62, 0xFC, 0x7A, 0x87, 0x3C, 0x60, 
+0x4E, 0x29, 0xDD, 0xC1, 0xCF, 0xCF, 0xE6, 0x82, 
+0x28, 0xEB, 0x35, 0xB3, 0xC0, 0x21, 0xBF, 0x7F, 
+0x93, 0x7F, 0x8A, 0xF1, 0x34, 0x16, 0x79, 0x24, 
+0xC0, 0x27, 0xD0, 0xA1, 0x89, 0x24, 0x82, 0x51, 
+0x84, 0xF2, 0xD2, 0x70, 0xA5, 0xE6, 0xDE, 0xA0, 
+0x68, 0xFC, 0xF5, 0x34, 0x56, 0x57, 0xF1, 0x36, 
+0x45, 0x7B, 0x95, 0x68, 0xAA, 0xB7, 0x44, 0x55, 
+0xDB, 0x45, 0xDC, 0x36, 0x10, 0x0D, 0x7B, 0xE7, 
+0xC2, 0xC3, 0xB3, 0x9A, 0x3D, 0x3B, 0x09, 0x06, 
+0xD3, 0x67, 0x62, 0xD3, 0x55, 0x33, 0xFC, 0x26, 
+0x78, 0xEB, 0x43, 0xF3, 0x1C, 0xE8, 0xF0, 0x7F, 
+0x32, 0x1A, 0xAE, 0xB8, 0xAD, 0xDD, 0x7C, 0x4A, 
+0x65, 0x0A, 0x8C, 0x77, 0xD3, 0xAF, 0xE9, 0x5F, 
+0xAD, 0x57, 0xAC, 0xAF, 0x98, 0xBD, 0x6A, 0xDF, 
+0x66, 0xAF, 0x75, 0xCF, 0xE5, 0x4C, 0x79, 0x8D, 
+0x8E, 0xED, 0x18, 0xB5, 0x00, 0x7D, 0x59, 0xE8, 
+0x3A, 0x1D, 0xF4, 0xC7, 0xF5, 0xE1, 0xA0, 0x1A, 
+0x82, 0x12, 
+
+0x73, 0x76, 0xD9, 0x03, 0x3E, 0xF7, 0x0D, 0xFF, 
+0x62, 0x60, 0x34, 0x43, 0x76, 0xB5, 0x36, 0xD3, 
+0x3D, 0xE1, 0x50, 0x82, 0x99, 0x79, 0xF3, 0xB6, 
+0x6E, 0x83, 0x03, 0x96, 0x67, 0xC0, 0xD2, 0xA2, 
+0xD2, 0x97, 0x12, 0x1E, 0x13, 0x88, 0xC7, 0xD4, 
+0xF0, 0xAB, 0xF2, 0x4C, 0xF1, 0xE1, 0xEB, 0x60, 
+0xFD, 0x46, 0xCD, 0xFB, 0xA8, 0xCF, 0xBF, 0xD3, 
+0x78, 0x38, 0x93, 0x34, 0xDA, 0x43, 0xB5, 0x8E, 
+0xFB, 0x7B, 0xCA, 0x95, 0xA5, 0xFA, 0xBB, 0x69, 
+0xEE, 0x26, 0x12, 0x10, 0x05, 0x61, 0x61, 0x74, 
+0x42, 0x6B, 0xF8, 0x57, 0x6F, 0x5A, 0x7D, 0x36, 
+0xA1, 0x26, 0xF9, 0x2B, 0xA0, 0xE8, 0xE5, 0x56, 
+0xF4, 0x9D, 0x74, 0x32, 0x5F, 0x04, 0xB2, 0xA3, 
+0xC4, 0xA2, 0x6C, 0x0A, 0x60, 0xCF, 0x7F, 0x22, 
+0xB5, 0x96, 0x9D, 0x45, 0x40, 0xA0, 0xDC, 0x5D, 
+0x34, 0x35, 0x76, 0x4D, 0x49, 0x27, 0xE7, 0xF1, 
+0x01, 0xEE, 0x1F, 0xE0, 0x6A, 0x77, 0x43, 0x45, 
+0x9B, 0xEA, 0x6F, 0x6A, 0x6D, 0xBA, 0x13, 0xD6, 
+0x06, 0xE5, 0xEF, 
+
+0x6E, 0x06, 0x64, 0x74, 0x07, 0x70, 0x96, 0x48, 
+0x95, 0xF5, 0x6E, 0x69, 0x93, 0x30, 0x3D, 0xB8, 
+0x2F, 0x33, 0x90, 0x16, 0x23, 0xAD, 0x3B, 0xBC, 
+0x80, 0x88, 0x6F, 0x01, 0x9C, 0x52, 0x1F, 0x21, 
+0xC1, 0x9C, 0xE9, 0x1D, 0x02, 0xD8, 0xFE, 0xA6, 
+0x89, 0x6E, 0x91, 0x8E, 0x71, 0x1B, 0x69, 0x8F, 
+0x7A, 0x48, 0x5C, 0x26, 0xF6, 0x7B, 0xB7, 0xC4, 
+0x6B, 0x40, 0x84, 0x85, 0xFA, 0x6A, 0xFB, 0x4A, 
+0x34, 0x05, 0xEF, 0xED, 0x77, 0x3A, 0xA7, 0x50, 
+0xAA, 0x95, 0x66, 0x00, 0x7F, 0xA7, 0x4F, 0xFF, 
+0x3F, 0xB2, 0xEF, 0xBA, 0x48, 0x0A, 0xAF, 0xB0, 
+0x4F, 0x7B, 0x76, 0x14, 0x07, 0x51, 0xC7, 0x3C, 
+0x41, 0xF1, 0x27, 0xF5, 0x8B, 0x55, 0x6F, 0xB6, 
+0x7B, 0xBE, 0xE0, 0x67, 0x65, 0x9B, 0xD8, 0xB0, 
+0xF6, 0x9C, 0xBA, 0x91, 0x17, 0xDB, 0x0D, 0x88, 
+0xBE, 0x55, 0xD8, 0xE1, 0x5D, 0x39, 0x26, 0x9C, 
+0x8F, 0xA9, 0x3F, 0xD6, 0x81, 0xF9, 0xE7, 0x47, 
+0xB7, 0xE3, 0x82, 0x16, 0x31, 0x77, 0x18, 0x94, 
+0xF4, 0x56, 0x41, 0xEB, 
+
+0x7B, 0x30, 0xC2, 0x4D, 0x57, 0x0A, 0x1B, 0x26, 
+0x81, 0x57, 0x02, 0xEC, 0xCC, 0x75, 0xEC, 0x43, 
+0xF8, 0xD1, 0xF9, 0x33, 0x28, 0xB4, 0xF2, 0x9D, 
+0x1A, 0xE9, 0xBF, 0xC9, 0x03, 0x10, 0x20, 0x6E, 
+0xC3, 0x9D, 0x53, 0x4F, 0x6C, 0x1A, 0x5C, 0xA1, 
+0x30, 0x73, 0xC3, 0x24, 0xA1, 0x17, 0xDE, 0x83, 
+0xB1, 0x13, 0xD8, 0x74, 0x24, 0x1C, 0xEF, 0x5C, 
+0xAB, 0xA5, 0x38, 0x1A, 0x5D, 0xFC, 0xC1, 0x30, 
+0xE0, 0xB7, 0xE8, 0xB7, 0xC3, 0x08, 0xB1, 0x48, 
+0x2A, 0xE9, 0xB1, 0x9D, 0xEB, 0xA3, 0xC6, 0xD0, 
+0x43, 0xA8, 0x28, 0x90, 0xE0, 0xAF, 0x57, 0xAA, 
+0xBE, 0xBA, 0x82, 0xC9, 0x0F, 0x32, 0x0B, 0x4B, 
+0xC0, 0x63, 0x8E, 0xA3, 0xDC, 0x48, 0x1D, 0x9A, 
+0xE7, 0xE7, 0x60, 0x52, 0x6E, 0xE0, 0x6F, 0x36, 
+0x84, 0x40, 0xA2, 0x90, 0xFE, 0x30, 0x8F, 0x73, 
+0x2C, 0x72, 0x8D, 0x49, 0xAC, 0x98, 0x81, 0x72, 
+0xB5, 0xBA, 0x7E, 0x35, 0xCC, 0x86, 0x4F, 0xA8, 
+0xCA, 0xB6, 0x1B, 0x50, 0x93, 0x4C, 0xE1, 0x56, 
+0xCE, 0xD8, 0xD8, 0x01, 0xAF, 
+
+0xD0, 0x13, 0xD9, 0x54, 0x43, 0x6B, 0x37, 0xE5, 
+0x3C, 0x48, 0xA7, 0x24, 0x30, 0x77, 0x15, 0xBB, 
+0xAA, 0xCC, 0x70, 0xED, 0xEB, 0x66, 0x91, 0x8F, 
+0xFF, 0x4C, 0x5B, 0xEE, 0x83, 0xDD, 0xF4, 0x68, 
+0x9B, 0x6D, 0x5C, 0x52, 0x40, 0x9A, 0xF8, 0xCE, 
+0x2E, 0xF2, 0xA8, 0xFC, 0xD2, 0x83, 0x37, 0x04, 
+0xDC, 0x49, 0x35, 0xDF, 0x0B, 0x2B, 0x23, 0x84, 
+0xC2, 0xCE, 0x6F, 0x23, 0x2E, 0x10, 0xF6, 0x4B, 
+0xDD, 0xF8, 0xB1, 0x69, 0xAA, 0x1A, 0xDB, 0x0C, 
+0x58, 0x7F, 0xF7, 0x5D, 0xDE, 0xB6, 0x0E, 0x3C, 
+0x77, 0x47, 0x09, 0x8B, 0xED, 0xDD, 0x0A, 0x22, 
+0x89, 0x03, 0xB5, 0x5E, 0x9A, 0xFD, 0x38, 0x02, 
+0x6C, 0xB5, 0x48, 0x69, 0x84, 0xDE, 0x37, 0x66, 
+0xC8, 0x9C, 0xC7, 0xEA, 0x93, 0x26, 0x6C, 0xE9, 
+0xCE, 0xFC, 0xA1, 0xD4, 0x04, 0xE3, 0x8F, 0x30, 
+0x25, 0xD5, 0x6C, 0xDB, 0xE3, 0x8C, 0x5F, 0x19, 
+0x58, 0x64, 0x6A, 0xBE, 0x6E, 0x05, 0xB0, 0xB2, 
+0x59, 0xA8, 0xA5, 0xB4, 0xAB, 0xDC, 0xD9, 0x76, 
+0x44, 0x44, 0xE2, 0x5B, 0x19, 0x52, 
+
+0x95, 0xB8, 0x34, 0x66, 0xB6, 0xE4, 0xB0, 0x12, 
+0xE0, 0xEB, 0xE9, 0x0D, 0xA3, 0x9F, 0x7D, 0xAF, 
+0xED, 0x88, 0x8F, 0xAB, 0xB9, 0xD7, 0x7C, 0xCC, 
+0x70, 0xB2, 0xBD, 0xD9, 0x50, 0xFF, 0x4F, 0x80, 
+0x0C, 0xC3, 0x2E, 0x6C, 0xED, 0xAB, 0xA0, 0x23, 
+0xD1, 0xBD, 0xC9, 0xB6, 0xFE, 0x2A, 0x34, 0x8D, 
+0x42, 0x03, 0xA0, 0xC3, 0xE2, 0x57, 0xEA, 0xBF, 
+0xE4, 0xDC, 0xF9, 0x92, 0xD7, 0x9D, 0x97, 0xFE, 
+0xEB, 0x48, 0x72, 0x0E, 0x21, 0x96, 0xF5, 0x58, 
+0x43, 0xAD, 0xD8, 0x43, 0xD0, 0xFB, 0x88, 0x78, 
+0x5A, 0x49, 0xD8, 0xB3, 0xA3, 0x7B, 0xB6, 0x68, 
+0xAA, 0x9A, 0x7F, 0x02, 0xF0, 0x55, 0x8C, 0xBF, 
+0xE4, 0xA5, 0x8B, 0x5D, 0x8C, 0x63, 0xEC, 0x80, 
+0xE6, 0x66, 0xF6, 0x62, 0x9D, 0xD2, 0xEB, 0x5C, 
+0xCC, 0xF5, 0x5B, 0xF0, 0x60, 0xA6, 0x8D, 0xAA, 
+0x62, 0x22, 0xBA, 0x1C, 0x32, 0x98, 0x41, 0x83, 
+0x02, 0x80, 0x36, 0xCA, 0xD7, 0xC0, 0x55, 0x9A, 
+0xAA, 0xFA, 0x89, 0x03, 0x5B, 0xD1, 0xC9, 0x59, 
+0x57, 0x57, 0x0F, 0x32, 0x31, 0xB9, 0x7E, 
+
+0x65, 0x7B, 0xE9, 0xAF, 0xCE, 0xFD, 0x3D, 0x96, 
+0x50, 0x9E, 0x47, 0x0C, 0x38, 0x42, 0x10, 0x06, 
+0x98, 0x89, 0x63, 0x1A, 0xDD, 0xF4, 0xF4, 0x49, 
+0xE8, 0xDE, 0x96, 0x73, 0x61, 0x89, 0xC2, 0x70, 
+0x5E, 0xB1, 0x22, 0xD2, 0x22, 0x6A, 0xAC, 0xF2, 
+0x03, 0x34, 0x33, 0x3C, 0xBD, 0x5A, 0xBB, 0x29, 
+0x15, 0xDF, 0xFA, 0x77, 0x2C, 0x4C, 0x2A, 0x66, 
+0x77, 0x09, 0x24, 0xAE, 0x1E, 0x8C, 0xCC, 0xA7, 
+0x37, 0x15, 0x5A, 0x31, 0xB5, 0x37, 0xC9, 0x9F, 
+0xC9, 0x83, 0x1A, 0x05, 0x4C, 0x09, 0x67, 0xC2, 
+0xA9, 0x26, 0x69, 0x2E, 0x50, 0xC1, 0xE5, 0x40, 
+0xD3, 0xD2, 0x85, 0xA0, 0xB5, 0x17, 0xF4, 0x8B, 
+0xF7, 0xB7, 0x48, 0x2D, 0x92, 0x59, 0x09, 0x8E, 
+0xE6, 0xBC, 0x2F, 0x3F, 0x63, 0xA3, 0x71, 0xE1, 
+0xAD, 0x08, 0x3F, 0x80, 0x80, 0x0C, 0xE1, 0xCD, 
+0x55, 0xE0, 0x43, 0x64, 0xBB, 0x58, 0x5C, 0x41, 
+0xFE, 0xFA, 0x41, 0xDC, 0x9D, 0x87, 0x13, 0xE6, 
+0x02, 0xEA, 0x90, 0xE8, 0x2F, 0x32, 0xBE, 0xF3, 
+0xD9, 0x4A, 0x2E, 0x9A, 0x90, 0x9F, 0x21, 0x07, 
+
+0x60, 0xE5, 0x6C, 0x94, 0x3D, 0x26, 0x0A, 0x43, 
+0x38, 0x12, 0x75, 0x94, 0xFA, 0xB9, 0x99, 0x0A, 
+0xAC, 0x1F, 0xD0, 0x37, 0xE1, 0x00, 0xE4, 0xF1, 
+0x3A, 0xC6, 0x81, 0x14, 0xC6, 0xFE, 0xA0, 0xCE, 
+0xC9, 0x65, 0xC0, 0xFF, 0xE0, 0xD5, 0x93, 0x69, 
+0xF2, 0x99, 0xB0, 0xC8, 0xD3, 0xFB, 0xF0, 0x07, 
+0x86, 0x74, 0x82, 0x67, 0x71, 0x95, 0xC3, 0x85, 
+0xA4, 0xD6, 0xB9, 0x52, 0xD1, 0xAD, 0x84, 0xF3, 
+0x31, 0x37, 0x4A, 0x37, 0x67, 0xE1, 0x95, 0x46, 
+0x4B, 0xD0, 0xD0, 0xA7, 0x09, 0x14, 0xE3, 0xA3, 
+0xEE, 0x21, 0x2F, 0x29, 0x75, 0xFD, 0xDF, 0xFA, 
+0xA2, 0xFA, 0xFD, 0x05, 0x0F, 0x61, 0x5E, 0x0B, 
+0xE0, 0x81, 0xF7, 0x08, 0x78, 0xD9, 0xBC, 0x3D, 
+0xFB, 0x18, 0x9C, 0x73, 0x1F, 0x66, 0x75, 0xE9, 
+0x08, 0xCF, 0x1A, 0x92, 0x08, 0xF2, 0x57, 0xB4, 
+0x1C, 0x30, 0x4D, 0x7E, 0x23, 0x76, 0x8B, 0xBE, 
+0x2F, 0xB1, 0x4D, 0x18, 0x5A, 0x87, 0x67, 0x71, 
+0x8A, 0x30, 0x5E, 0x63, 0x7A, 0x53, 0xEE, 0xA2, 
+0xBB, 0xB1, 0x6A, 0x34, 0xEE, 0x28, 0x1D, 0x07, 
+0xB2, 
+
+0x85, 0xBB, 0xF7, 0xD9, 0x5B, 0x92, 0x2B, 0x64, 
+0xE0, 0x49, 0x47, 0x91, 0xE5, 0xA1, 0x05, 0x5C, 
+0xD6, 0x1E, 0x67, 0x5A, 0x25, 0x33, 0xA3, 0xFF, 
+0x28, 0x43, 0xAE, 0x3A, 0x74, 0x68, 0xB6, 0x9E, 
+0x16, 0x05, 0xA6, 0x92, 0x9B, 0xD5, 0x2A, 0x02, 
+0x11, 0x0E, 0x87, 0x9C, 0x63, 0x64, 0x6D, 0x2B, 
+0x93, 0xC2, 0x45, 0x26, 0x70, 0xB9, 0x8B, 0x71, 
+0x65, 0x9C, 0x8D, 0x7C, 0xB2, 0xFF, 0xB5, 0x19, 
+0xE2, 0xDA, 0x35, 0xD1, 0xA9, 0x3F, 0x82, 0xBD, 
+0x99, 0x0E, 0x71, 0xE0, 0xB6, 0x3F, 0xDB, 0xAA, 
+0x03, 0x89, 0x97, 0x2F, 0x87, 0x76, 0x67, 0x81, 
+0xA9, 0x77, 0x58, 0x84, 0x0E, 0x59, 0x22, 0x20, 
+0x06, 0x7D, 0xD4, 0x57, 0x44, 0x78, 0xC5, 0xC7, 
+0x51, 0xA6, 0x79, 0x15, 0x49, 0x30, 0x38, 0x09, 
+0x01, 0x38, 0x2B, 0x16, 0xE3, 0x3E, 0xC4, 0x8B, 
+0xDC, 0xAF, 0xDD, 0x22, 0xB1, 0xDE, 0xE6, 0x62, 
+0xB9, 0x38, 0x20, 0xA9, 0xD0, 0x82, 0x6E, 0x51, 
+0xF4, 0x98, 0xDF, 0x33, 0x5F, 0x4B, 0xFA, 0xE6, 
+0x0C, 0x6C, 0xAB, 0x1E, 0x2C, 0xD6, 0x6B, 0xB3, 
+0xEA, 0x26, 
+
+0x48, 0x5D, 0x59, 0x3E, 0x13, 0x02, 0x1B, 0x05, 
+0x07, 0xE0, 0x25, 0x6E, 0x25, 0xB3, 0x72, 0xDD, 
+0x79, 0x54, 0x00, 0x57, 0xD4, 0x38, 0x6B, 0xF5, 
+0xCD, 0xBD, 0x0D, 0x89, 0x41, 0x17, 0x4A, 0x2D, 
+0x42, 0x7E, 0x34, 0xFC, 0x6F, 0x73, 0x4F, 0x02, 
+0x8C, 0x72, 0xEF, 0xCF, 0xB0, 0x5A, 0x17, 0x8B, 
+0x35, 0x2E, 0x64, 0xFC, 0x8F, 0xD6, 0x99, 0x36, 
+0x69, 0x98, 0xCC, 0x9D, 0x1A, 0x4F, 0x45, 0x4D, 
+0x74, 0xDE, 0x28, 0xFE, 0xE5, 0x1B, 0x3C, 0xEB, 
+0x31, 0xC1, 0xC6, 0xEA, 0xEA, 0x7A, 0x8F, 0x47, 
+0x38, 0x80, 0xAA, 0x6C, 0x9B, 0x5D, 0xA6, 0xCA, 
+0x02, 0x1A, 0x5E, 0xBB, 0x1F, 0xE4, 0xE9, 0xB5, 
+0x0E, 0x5C, 0xF4, 0x86, 0x6C, 0x35, 0x5F, 0x05, 
+0xF4, 0x06, 0x61, 0xBA, 0x4B, 0x8C, 0xFE, 0x5E, 
+0xBD, 0xFC, 0xB0, 0x27, 0xA8, 0x5B, 0x91, 0xB3, 
+0xE4, 0x85, 0xC3, 0xB7, 0xFE, 0xF4, 0x77, 0xF7, 
+0x61, 0xF5, 0x4C, 0x51, 0x68, 0x91, 0x18, 0xF2, 
+0x96, 0xD5, 0x2E, 0xA7, 0x93, 0x94, 0x1F, 0x3F, 
+0x0E, 0x83, 0xC0, 0x07, 0x41, 0xB1, 0x93, 0x86, 
+0xF4, 0xBD, 0x6D, 
+
+0x28, 0xAC, 0x5F, 0x50, 0x1E, 0xBD, 0xA1, 0x7B, 
+0x90, 0x29, 0xC2, 0x90, 0xDC, 0x54, 0xD0, 0x89, 
+0x69, 0x2E, 0x43, 0x9F, 0xB2, 0x2C, 0x47, 0x6D, 
+0x0C, 0x8E, 0x7A, 0x20, 0x9A, 0x82, 0x96, 0x98, 
+0x93, 0x00, 0x18, 0x1F, 0x5E, 0x61, 0x2C, 0x5A, 
+0xFE, 0x29, 0x65, 0x70, 0x8F, 0x11, 0x1C, 0x40, 
+0x4E, 0x39, 0x2A, 0x30, 0xDC, 0x7B, 0x3A, 0x1E, 
+0xF9, 0x6B, 0x87, 0x77, 0x29, 0x10, 0xF2, 0xE6, 
+0xA7, 0xE3, 0x19, 0x0B, 0xD5, 0xDF, 0xCA, 0xCF, 
+0x69, 0x96, 0x79, 0x7A, 0x81, 0x1C, 0x99, 0x99, 
+0x19, 0xA3, 0x9A, 0x7D, 0xB2, 0xE7, 0x6A, 0x5D, 
+0x27, 0x71, 0x8D, 0xE9, 0x12, 0xAE, 0xB3, 0xE7, 
+0x55, 0x1E, 0x4C, 0x38, 0x38, 0xB9, 0x6A, 0x50, 
+0x4A, 0x45, 0x7D, 0x8A, 0xD9, 0xD5, 0x46, 0x0C, 
+0xFB, 0x45, 0x29, 0x31, 0x4B, 0x40, 0x85, 0xFA, 
+0x39, 0xB0, 0xD0, 0x9E, 0x75, 0x49, 0xD0, 0xC6, 
+0xC2, 0xE2, 0x3F, 0x19, 0x69, 0xA0, 0x1E, 0x57, 
+0xCD, 0xB6, 0xB9, 0xF4, 0x82, 0x8B, 0xBB, 0xAB, 
+0x48, 0x94, 0x29, 0x69, 0x62, 0x3D, 0x1B, 0x87, 
+0xC0, 0xF2, 0xAC, 0x61, 
+
+0x7C, 0x54, 0xFC, 0x22, 0xD2, 0xF0, 0x5D, 0xEE, 
+0xAF, 0x44, 0xFE, 0x39, 0xE4, 0x0F, 0xB9, 0xD9, 
+0x34, 0xBE, 0x6B, 0x80, 0xAC, 0x2D, 0xEB, 0x83, 
+0xA2, 0x0F, 0x91, 0x4E, 0x7D, 0x90, 0x02, 0x1C, 
+0x47, 0x5B, 0x3C, 0x8F, 0xE0, 0xE2, 0x4D, 0x0F, 
+0x9A, 0x57, 0x25, 0x04, 0xFC, 0x25, 0xA4, 0x6D, 
+0x4D, 0x99, 0x4B, 0x96, 0x17, 0x35, 0x84, 0x87, 
+0x7B, 0x31, 0x6B, 0xBD, 0x0D, 0xD5, 0x0E, 0x08, 
+0x62, 0x88, 0x4E, 0x3D, 0x4E, 0xD8, 0x03, 0x3A, 
+0xF4, 0x15, 0x72, 0x17, 0x4E, 0x0D, 0x2C, 0x0A, 
+0xF4, 0x02, 0xA0, 0x83, 0x16, 0x53, 0xE3, 0xA7, 
+0x5D, 0x40, 0x90, 0x36, 0x5F, 0x9A, 0xEA, 0x45, 
+0x2C, 0x65, 0x8E, 0x84, 0xA0, 0xE6, 0x48, 0x87, 
+0xE6, 0x14, 0xE8, 0xB4, 0x60, 0x8E, 0x36, 0x78, 
+0xBC, 0x95, 0xBF, 0xA8, 0xB3, 0x3D, 0x67, 0xB0, 
+0x1F, 0xBD, 0x95, 0x84, 0xEE, 0x84, 0x51, 0x66, 
+0xE7, 0xB7, 0x62, 0x31, 0x3E, 0xEC, 0x34, 0x7F, 
+0x98, 0xA3, 0xAA, 0x15, 0x83, 0xD6, 0x81, 0x9A, 
+0xAC, 0xD7, 0xC7, 0x50, 0x84, 0x4A, 0xE0, 0x90, 
+0xD3, 0xA0, 0x01, 0xC5, 0x6E, 
+
+0x16, 0xAB, 0x46, 0x3A, 0x95, 0xD8, 0xE8, 0x75, 
+0x3E, 0x01, 0xC0, 0xF5, 0x7C, 0xCD, 0x41, 0x06, 
+0x15, 0xDF, 0xDC, 0x0D, 0x1B, 0xE3, 0xAB, 0x0E, 
+0x08, 0x0E, 0x79, 0x40, 0x40, 0xC2, 0x52, 0xB2, 
+0xAF, 0x8C, 0x9D, 0x68, 0x0A, 0x8C, 0x7D, 0xEC, 
+0x04, 0x1A, 0x50, 0x41, 0xB4, 0x8B, 0xAD, 0x1D, 
+0x9D, 0xBA, 0xA2, 0x67, 0x91, 0xF2, 0x5B, 0x28, 
+0xF8, 0x7E, 0xFB, 0xC7, 0xE1, 0x02, 0x97, 0x78, 
+0x07, 0xF5, 0xC6, 0xD2, 0x84, 0xBD, 0x60, 0xFD, 
+0xFA, 0x04, 0x37, 0xFB, 0x88, 0x39, 0x63, 0xA7, 
+0xE5, 0xFD, 0x44, 0xE8, 0xCF, 0x1F, 0x48, 0x86, 
+0x2C, 0xA8, 0x3C, 0xB1, 0xF3, 0x28, 0x94, 0xBE, 
+0x94, 0xA6, 0x80, 0x11, 0x81, 0x1D, 0x4B, 0xBF, 
+0x5F, 0x8C, 0x63, 0xEB, 0x71, 0xEB, 0xB5, 0xA4, 
+0x7F, 0x5D, 0xB5, 0xEC, 0xA9, 0x41, 0x0D, 0xBD, 
+0xB0, 0xC4, 0x55, 0xEB, 0xA9, 0x5D, 0x13, 0xA5, 
+0x01, 0x5A, 0x4B, 0x3A, 0x2C, 0xBC, 0xA4, 0xBB, 
+0xA5, 0xE1, 0xA4, 0x0D, 0x2B, 0x17, 0x56, 0x0F, 
+0x33, 0x8C, 0xB0, 0x23, 0x9A, 0xDB, 0xD4, 0x9F, 
+0x00, 0x6D, 0x45, 0x58, 0xD1, 0xF1, 
+
+0xEB, 0x5C, 0x85, 0xE8, 0x88, 0xBB, 0x7C, 0x6B, 
+0x1E, 0xDE, 0x42, 0x49, 0x8F, 0x31, 0x38, 0xD0, 
+0xC3, 0xD3, 0x93, 0xB4, 0x1C, 0x1B, 0x73, 0xF2, 
+0xB9, 0x8A, 0x82, 0xE4, 0xD3, 0xA2, 0x28, 0xA3, 
+0xB6, 0x9A, 0x9C, 0xD8, 0x62, 0x07, 0x77, 0x7E, 
+0xB2, 0xB7, 0x26, 0x22, 0x5E, 0xBA, 0xB4, 0x0C, 
+0xED, 0x1C, 0x8D, 0xD7, 0x96, 0xC8, 0x49, 0x7D, 
+0x44, 0xBA, 0x98, 0x7F, 0xB0, 0x5D, 0xC5, 0x3D, 
+0x4F, 0xD9, 0x8E, 0x4C, 0xE4, 0x28, 0x1C, 0x2E, 
+0xEA, 0xEF, 0xAF, 0xD1, 0x8B, 0x8A, 0x82, 0xEB, 
+0x12, 0x6D, 0xF5, 0xA7, 0x38, 0x7A, 0x36, 0x5A, 
+0x2A, 0x03, 0x9B, 0xDD, 0x33, 0xBF, 0x6E, 0x8F, 
+0xC1, 0x34, 0xA7, 0xBF, 0x92, 0xF1, 0xFA, 0x4B, 
+0x8B, 0x22, 0x59, 0x19, 0xB9, 0xB8, 0xBC, 0xEC, 
+0xD9, 0x46, 0x39, 0xC2, 0xCF, 0xE2, 0x3A, 0x9E, 
+0x22, 0x64, 0x1C, 0x8E, 0x1F, 0x1E, 0xF5, 0x2D, 
+0x69, 0x43, 0x22, 0x9D, 0x84, 0x58, 0xDA, 0xE7, 
+0x27, 0x91, 0x0F, 0x8F, 0x16, 0x1B, 0x3E, 0x50, 
+0x4A, 0x97, 0xC9, 0x8C, 0x87, 0xBF, 0xF3, 0x11, 
+0x6B, 0xD8, 0x8F, 0xBB, 0x26, 0x2F, 0xE8, 
+
+0x65, 0x2C, 0x0C, 0x46, 0x5A, 0x31, 0x54, 0x29, 
+0x09, 0xB6, 0xA0, 0x0E, 0xB2, 0x01, 0x67, 0xD4, 
+0x3F, 0x40, 0x5F, 0x8F, 0xEE, 0x15, 0x6C, 0x8C, 
+0x70, 0x6C, 0x38, 0x9A, 0x67, 0x63, 0xE1, 0x10, 
+0x56, 0x1E, 0xDE, 0x25, 0xB2, 0x2B, 0x99, 0x5D, 
+0xDF, 0x68, 0x0C, 0x00, 0x65, 0x17, 0x7C, 0xEE, 
+0x54, 0x16, 0xA2, 0x0A, 0x2D, 0x9D, 0xFC, 0x88, 
+0xAA, 0x00, 0xB9, 0x09, 0xAB, 0xD1, 0x28, 0xBF, 
+0x3C, 0x7F, 0xC7, 0xA7, 0xC9, 0x3C, 0x72, 0x58, 
+0x7F, 0xBF, 0x91, 0xFA, 0x71, 0x51, 0x4A, 0x34, 
+0x6B, 0x67, 0x15, 0x0D, 0xA9, 0x60, 0xC0, 0xFB, 
+0x51, 0x68, 0xD0, 0x38, 0xE5, 0xCA, 0x36, 0x6F, 
+0x88, 0x9B, 0x22, 0x35, 0xE4, 0xB5, 0xB6, 0x1C, 
+0xDD, 0x98, 0x80, 0x88, 0x09, 0x76, 0x2B, 0x9E, 
+0x01, 0x58, 0x03, 0x9E, 0xC1, 0xFF, 0x32, 0x2B, 
+0x3F, 0x91, 0x7E, 0xD5, 0xAA, 0x48, 0xCC, 0xAD, 
+0xE9, 0xB8, 0x84, 0x09, 0x82, 0x41, 0xAE, 0xDA, 
+0x6C, 0xC6, 0x10, 0xB8, 0xFA, 0x25, 0x7C, 0xB0, 
+0x53, 0x46, 0xB3, 0x94, 0x79, 0x90, 0x44, 0x52, 
+0xF5, 0x9C, 0xF5, 0x79, 0x96, 0x6D, 0xD4, 0xD7, 
+
+0xC5, 0x18, 0xCC, 0x6B, 0x38, 0xD0, 0x22, 0xF2, 
+0x83, 0xC9, 0x81, 0x43, 0x90, 0x7A, 0x06, 0x22, 
+0xA9, 0xDC, 0x2C, 0xB5, 0xE5, 0xBD, 0x1A, 0x46, 
+0x27, 0x2C, 0xDF, 0xA5, 0x78, 0x81, 0x6C, 0x2B, 
+0x81, 0x96, 0x3E, 0x60, 0x83, 0xCE, 0x72, 0x04, 
+0xDC, 0x6C, 0x1D, 0xEA, 0x52, 0x7C, 0x09, 0x8F, 
+0x32, 0xE0, 0xB2, 0x4F, 0x5C, 0xBC, 0x1D, 0x8A, 
+0xB1, 0x28, 0x26, 0xE5, 0x12, 0xDC, 0xC5, 0x62, 
+0x42, 0x12, 0x27, 0xF6, 0x76, 0xA5, 0x5E, 0x01, 
+0x02, 0x53, 0x23, 0xDF, 0xA0, 0x28, 0x5C, 0x29, 
+0xB6, 0x06, 0x30, 0xFA, 0xA4, 0x7E, 0x73, 0x02, 
+0x06, 0x17, 0x78, 0x96, 0x51, 0x53, 0x58, 0x13, 
+0xC6, 0x6F, 0xCA, 0x5D, 0x88, 0xA7, 0x94, 0x6C, 
+0x5C, 0xD2, 0x05, 0x0C, 0x82, 0x4D, 0xB7, 0x4B, 
+0x0D, 0x76, 0xC6, 0xDF, 0x3A, 0x13, 0x64, 0xC3, 
+0x79, 0xDB, 0x7A, 0xE3, 0x65, 0x1C, 0x05, 0x88, 
+0x64, 0xBB, 0x03, 0xC9, 0x6E, 0xBE, 0xE3, 0xEB, 
+0x92, 0x38, 0x7C, 0x1B, 0x2F, 0x42, 0xEB, 0xB4, 
+0x8D, 0x75, 0xFF, 0x03, 0x07, 0xC7, 0xA5, 0x7F, 
+0x16, 0x26, 0xE1, 0x8A, 0x58, 0xAC, 0xA0, 0x5E, 
+0x8E, 
+
+0x28, 0x2F, 0x70, 0x5E, 0x74, 0x5C, 0xB3, 0x3F, 
+0x1E, 0x14, 0x5E, 0x5C, 0xD7, 0x86, 0xE7, 0x72, 
+0xFE, 0xCA, 0x1F, 0xC0, 0x28, 0xFF, 0xDE, 0x35, 
+0x13, 0xA2, 0xBD, 0xBC, 0x1E, 0xBD, 0x31, 0x2E, 
+0x3E, 0xC2, 0x34, 0x46, 0x1C, 0xE3, 0xD5, 0x76, 
+0x8D, 0x85, 0xBE, 0x83, 0x47, 0x45, 0x96, 0x34, 
+0xBD, 0xA4, 0x0F, 0x98, 0x5A, 0x6B, 0xD8, 0xC3, 
+0x09, 0x29, 0x5B, 0x92, 0x70, 0x5B, 0x84, 0xBA, 
+0xE4, 0x05, 0x68, 0xB6, 0xF3, 0x3D, 0x98, 0x5B, 
+0xB2, 0xC6, 0x1C, 0x4A, 0xD9, 0x16, 0xD1, 0xA2, 
+0x87, 0x1E, 0xDE, 0x71, 0x8B, 0xA5, 0x9F, 0x98, 
+0x8E, 0x59, 0x10, 0x4D, 0x55, 0x31, 0xDC, 0x02, 
+0xB4, 0x0C, 0x7F, 0x94, 0xDD, 0x76, 0x5E, 0xB8, 
+0xEC, 0xC9, 0xE3, 0x4A, 0xA9, 0x43, 0x84, 0x85, 
+0xFB, 0x55, 0x11, 0x03, 0xCF, 0x65, 0x44, 0x05, 
+0x4F, 0x67, 0x08, 0xA1, 0xFA, 0xAF, 0x81, 0xF5, 
+0xEF, 0x70, 0x5C, 0xE8, 0x62, 0x59, 0x6F, 0xE1, 
+0xFC, 0xB9, 0x16, 0x3F, 0x92, 0xB3, 0xDA, 0xEA, 
+0xF5, 0x63, 0x58, 0xE0, 0xBF, 0xC8, 0x20, 0xB9, 
+0xA7, 0xAF, 0xA0, 0x53, 0xA2, 0xF6, 0xFF, 0xEF, 
+0xDE, 0x2D, 
+
+0x7C, 0x49, 0xDF, 0xB9, 0xA2, 0x28, 0x2A, 0xE2, 
+0x1B, 0x0B, 0x8A, 0x76, 0x44, 0x74, 0xAE, 0xCE, 
+0x6D, 0xB3, 0x34, 0xCD, 0x0A, 0x21, 0xFD, 0xF1, 
+0x64, 0xC1, 0x9E, 0x7B, 0xBE, 0x8E, 0xC2, 0xA5, 
+0xC5, 0xD6, 0x42, 0x21, 0xEE, 0x3A, 0xC3, 0x5A, 
+0x22, 0x1D, 0x35, 0xCD, 0x64, 0xB7, 0xFC, 0x66, 
+0x18, 0xA8, 0x49, 0xCC, 0x64, 0xCF, 0x91, 0x11, 
+0x4E, 0x8A, 0x8E, 0xB0, 0xCC, 0xFE, 0x51, 0xE2, 
+0x41, 0x6B, 0x4E, 0x8C, 0xA5, 0x63, 0x70, 0x65, 
+0xB1, 0xE9, 0x3A, 0xE1, 0x12, 0xDA, 0xBD, 0x8F, 
+0x0D, 0x6A, 0xDC, 0xA6, 0x1F, 0xBF, 0x79, 0x3E, 
+0x71, 0xEE, 0x92, 0xE3, 0xE2, 0x91, 0x87, 0x57, 
+0xC2, 0x58, 0x08, 0x47, 0xB0, 0x92, 0xFF, 0x19, 
+0xAA, 0x33, 0x0A, 0xAE, 0xB6, 0x74, 0x28, 0x59, 
+0xE0, 0xCB, 0x70, 0x5A, 0xAC, 0xC3, 0x7C, 0x07, 
+0xDE, 0x6D, 0x32, 0x74, 0x0D, 0x21, 0x33, 0x2F, 
+0x05, 0x31, 0x23, 0x85, 0xB9, 0x59, 0x8A, 0x06, 
+0x60, 0x65, 0x27, 0xF0, 0xD3, 0xEF, 0xFC, 0xC5, 
+0x77, 0xC9, 0x55, 0x1A, 0x31, 0x71, 0x48, 0xF6, 
+0xAA, 0x29, 0x5C, 0xD6, 0xF7, 0x02, 0xAE, 0x50, 
+0xE7, 0x10, 0x40, 
+
+0xF5, 0xFC, 0x39, 0x16, 0xD3, 0xF3, 0x08, 0xCB, 
+0xA7, 0xD9, 0x34, 0x13, 0x0D, 0xBA, 0x7C, 0x79, 
+0x84, 0x8D, 0x10, 0x48, 0x3C, 0xA2, 0x02, 0xDF, 
+0xC0, 0x63, 0x18, 0x25, 0x9D, 0x0B, 0x6A, 0xCD, 
+0xCF, 0x7B, 0x04, 0xDE, 0x43, 0xC0, 0xB5, 0xBA, 
+0xD2, 0x07, 0x63, 0x85, 0x72, 0x26, 0xCE, 0x46, 
+0x6C, 0x97, 0xA7, 0x22, 0x0C, 0xEF, 0x71, 0xBD, 
+0xFE, 0xC4, 0xB9, 0xE6, 0xAD, 0xF4, 0xD6, 0x4D, 
+0x53, 0x00, 0x95, 0x59, 0xEC, 0xA8, 0x97, 0xB2, 
+0xE0, 0x2D, 0x1D, 0xB5, 0x43, 0x24, 0x2C, 0xD3, 
+0x7C, 0x4D, 0x79, 0x59, 0x4F, 0x21, 0x92, 0xA6, 
+0x8F, 0xD1, 0x4E, 0x9D, 0x9B, 0x1B, 0x57, 0x31, 
+0xEB, 0xC2, 0xBD, 0x77, 0x08, 0x80, 0x07, 0x49, 
+0x9F, 0x12, 0x47, 0x92, 0xA7, 0xDD, 0x87, 0xE9, 
+0xC4, 0x6C, 0x73, 0x6C, 0x8C, 0x14, 0xA6, 0xFC, 
+0x33, 0x03, 0xE9, 0x02, 0x38, 0x91, 0xF4, 0x94, 
+0x79, 0x4C, 0xD5, 0x75, 0xD6, 0xE2, 0xE5, 0xE0, 
+0x1F, 0x88, 0xE1, 0x4F, 0x25, 0x31, 0xD8, 0xE8, 
+0x0B, 0x20, 0x0F, 0x29, 0x45, 0xCA, 0x17, 0x16, 
+0xE3, 0x28, 0xA3, 0x25, 0x28, 0x1C, 0x73, 0x43, 
+0xD2, 0x47, 0xCD, 0x83, 
+
+0xA3, 0x13, 0x9D, 0x93, 0x67, 0x35, 0x80, 0x62, 
+0x4E, 0x71, 0xF1, 0xE1, 0x16, 0x1E, 0x53, 0x58, 
+0x02, 0x4D, 0x3E, 0x99, 0xA1, 0xFA, 0xB6, 0x80, 
+0x8D, 0xAD, 0x9D, 0xAB, 0x81, 0x8F, 0xB3, 0x68, 
+0x7E, 0x95, 0x0F, 0x6E, 0xF5, 0x0C, 0x97, 0xF6, 
+0x38, 0xEB, 0xD4, 0x57, 0xFF, 0x07, 0x1E, 0x70, 
+0x7E, 0x72, 0x13, 0x4E, 0x52, 0x6C, 0x9C, 0x7B, 
+0x87, 0x2B, 0xB2, 0x86, 0xA6, 0x20, 0x68, 0xA2, 
+0x3F, 0x1C, 0xD2, 0x54, 0x06, 0xA8, 0xBC, 0xEB, 
+0xA2, 0xAE, 0xB0, 0x65, 0x24, 0x89, 0x7D, 0x49, 
+0x40, 0xD9, 0xB5, 0x8D, 0x64, 0x41, 0x89, 0x3B, 
+0xEF, 0x12, 0x87, 0xF5, 0xBB, 0xCE, 0x0D, 0x27, 
+0xFA, 0xB9, 0x03, 0xC8, 0xCC, 0x40, 0x1E, 0x19, 
+0xB8, 0x5F, 0x4D, 0x8E, 0xD7, 0xA7, 0xEE, 0x9A, 
+0x06, 0x25, 0xD0, 0x9B, 0xE3, 0xB1, 0x88, 0x99, 
+0x29, 0xB6, 0x7A, 0x56, 0x27, 0x95, 0x61, 0x2D, 
+0x36, 0x3C, 0xDE, 0xA2, 0xE4, 0x73, 0xAB, 0xF5, 
+0x9E, 0x0B, 0xEA, 0xA1, 0x92, 0xD2, 0x53, 0xA1, 
+0xCE, 0x6B, 0x5B, 0x16, 0x44, 0x11, 0x5F, 0xA4, 
+0x8C, 0x56, 0x56, 0xE3, 0xEA, 0xDF, 0x26, 0xEE, 
+0x2C, 0x00, 0x2B, 0x7A, 0xCB, 
+
+0x9C, 0x18, 0x49, 0x3B, 0x88, 0xD2, 0x9C, 0xB8, 
+0x4B, 0xAB, 0xD6, 0x37, 0x45, 0x63, 0x93, 0xE6, 
+0xA0, 0x1D, 0xD4, 0x64, 0x27, 0xCD, 0xA1, 0x82, 
+0x45, 0xFD, 0xFF, 0xFA, 0xF1, 0xA8, 0x37, 0x09, 
+0xC3, 0xD4, 0x2D, 0x0C, 0x1C, 0x4E, 0x09, 0x9F, 
+0x15, 0xBB, 0xA4, 0x54, 0x21, 0x8D, 0x45, 0x7F, 
+0x58, 0xA4, 0xBC, 0xEB, 0x58, 0x01, 0x66, 0x75, 
+0x81, 0xC2, 0x76, 0x2A, 0x25, 0x4A, 0x71, 0x72, 
+0xA3, 0xE7, 0xAE, 0xC5, 0x2A, 0x55, 0x5A, 0x7D, 
+0x0D, 0xEA, 0x9F, 0x29, 0x16, 0xA7, 0xFB, 0x3E, 
+0x83, 0x52, 0x51, 0x76, 0xE7, 0xCA, 0x3C, 0x00, 
+0x30, 0xCE, 0x7B, 0x45, 0x98, 0x5E, 0xF7, 0xA8, 
+0xDD, 0x16, 0xE7, 0x4C, 0xBF, 0xF9, 0x97, 0x82, 
+0x6A, 0xE6, 0x33, 0xB0, 0x70, 0x38, 0x34, 0x7A, 
+0x8F, 0x46, 0x8A, 0x39, 0xC8, 0xEE, 0x06, 0x2E, 
+0xDF, 0xB4, 0xA7, 0x24, 0x5D, 0x88, 0xEA, 0x04, 
+0xA9, 0xAB, 0x38, 0xBC, 0x96, 0x2F, 0x12, 0x99, 
+0x22, 0x50, 0x48, 0x3F, 0x0E, 0xB9, 0x7A, 0x90, 
+0x2B, 0x0A, 0x68, 0x2E, 0x89, 0xC7, 0xEA, 0xC8, 
+0xBC, 0x81, 0xA8, 0xE2, 0x4A, 0x5D, 0x3F, 0xE6, 
+0xAE, 0x1B, 0xB1, 0xE0, 0x2D, 0x51, 
+
+0x14, 0xB8, 0x2C, 0xF8, 0x4B, 0x42, 0x4A, 0x12, 
+0xC5, 0x01, 0x1A, 0x40, 0x62, 0xE5, 0xE6, 0x2D, 
+0x0E, 0xE4, 0x33, 0x49, 0xE1, 0x6E, 0x16, 0x4C, 
+0x84, 0x78, 0x97, 0xD5, 0x7D, 0xFE, 0xE9, 0x9B, 
+0x9F, 0xE8, 0xF3, 0x42, 0x26, 0x1E, 0x5F, 0x40, 
+0xB5, 0xC1, 0x6D, 0x96, 0x91, 0x63, 0xFE, 0x35, 
+0x95, 0xFC, 0x65, 0x91, 0x53, 0x26, 0x08, 0xC6, 
+0xFA, 0xC9, 0x83, 0x07, 0xBD, 0xB3, 0xBB, 0x7A, 
+0x9E, 0x70, 0x81, 0x4D, 0x2F, 0xE1, 0x99, 0x8F, 
+0xB2, 0xF3, 0x2C, 0xF3, 0xBB, 0xD9, 0x06, 0x22, 
+0x28, 0xF2, 0x82, 0x97, 0xE8, 0xF6, 0x10, 0x20, 
+0x6F, 0xE9, 0x7A, 0xC0, 0x53, 0x89, 0xEC, 0x9F, 
+0x94, 0x32, 0x3F, 0xF6, 0x9B, 0x06, 0x7D, 0x2E, 
+0x17, 0xD4, 0xE2, 0x5B, 0x89, 0x80, 0x77, 0x80, 
+0xF0, 0x76, 0x49, 0xFC, 0xF1, 0x7E, 0x7C, 0x92, 
+0x85, 0xEF, 0x14, 0xB9, 0x06, 0x58, 0xCF, 0x26, 
+0x62, 0xF7, 0x75, 0x29, 0x1A, 0x39, 0x7D, 0x4B, 
+0xD0, 0xF0, 0x8F, 0x6E, 0x20, 0xC7, 0xB9, 0x89, 
+0x9C, 0x27, 0xD3, 0xEF, 0xE0, 0xE3, 0x5B, 0xA1, 
+0x20, 0xFF, 0xF9, 0x16, 0x10, 0x14, 0x8C, 0xCA, 
+0xC5, 0xA3, 0x9F, 0xEF, 0xAA, 0x5C, 0x99, 
+
+0x6E, 0xAA, 0x15, 0x71, 0x08, 0x6A, 0xFA, 0x28, 
+0xF4, 0x36, 0xD0, 0x59, 0xAB, 0xD2, 0xC5, 0xB5, 
+0x56, 0xE7, 0x51, 0x21, 0x46, 0x99, 0x8A, 0x00, 
+0x59, 0x04, 0x07, 0x94, 0x27, 0xBA, 0x41, 0xCB, 
+0x33, 0x5E, 0x9B, 0xFC, 0x03, 0x50, 0x7A, 0x09, 
+0x17, 0x88, 0x6C, 0x82, 0xB1, 0x5F, 0x74, 0x7D, 
+0xD1, 0x6E, 0xA4, 0xDC, 0x22, 0xCB, 0x81, 0x45, 
+0x24, 0xA2, 0x26, 0x6F, 0x6F, 0x95, 0x16, 0xD0, 
+0xFC, 0x10, 0xC9, 0x2B, 0x27, 0xE1, 0x47, 0x47, 
+0x57, 0x60, 0x4A, 0xC9, 0x24, 0xC9, 0xEF, 0x35, 
+0x54, 0xC9, 0x76, 0xB1, 0x03, 0xEB, 0x72, 0x06, 
+0xB3, 0xDF, 0xA2, 0xCF, 0x9D, 0xEE, 0x0B, 0x1F, 
+0x2A, 0xC7, 0xFF, 0x70, 0xFA, 0x94, 0x2F, 0x62, 
+0xF8, 0xE3, 0x0F, 0x22, 0x7D, 0xB3, 0x79, 0x21, 
+0x8F, 0x56, 0xFB, 0x06, 0x61, 0xCA, 0xE1, 0x00, 
+0xCE, 0x75, 0x00, 0xB0, 0xF1, 0xBF, 0xBA, 0x5D, 
+0x94, 0x54, 0x6C, 0x81, 0x59, 0xAF, 0x59, 0x83, 
+0xAC, 0x60, 0xE9, 0x85, 0x95, 0xBE, 0x45, 0x45, 
+0xCA, 0x9B, 0xDF, 0x4C, 0x30, 0x40, 0x20, 0x15, 
+0x90, 0x4D, 0x96, 0xED, 0x61, 0x51, 0xEB, 0xB3, 
+0x17, 0xAB, 0x5C, 0xAC, 0x60, 0xAB, 0xFD, 0x86, 
+
+0x6F, 0x12, 0xF7, 0xF1, 0xEA, 0x18, 0x65, 0xE9, 
+0x64, 0x62, 0x54, 0xAE, 0x46, 0x4C, 0xB8, 0xEB, 
+0x9C, 0xAD, 0x31, 0xCB, 0x5D, 0x2E, 0x39, 0xEC, 
+0x2C, 0x2B, 0x8B, 0xA8, 0x67, 0xDC, 0x8C, 0x2E, 
+0x8F, 0x17, 0xE7, 0xD5, 0x7F, 0x90, 0x49, 0xBB, 
+0x4B, 0x6D, 0x1C, 0x13, 0x2A, 0xC9, 0x44, 0xAA, 
+0x37, 0x0C, 0xF0, 0xCB, 0xBF, 0x35, 0xB4, 0x14, 
+0xAF, 0x73, 0x49, 0xE4, 0x92, 0x98, 0x63, 0x92, 
+0x1B, 0xF5, 0x47, 0xBC, 0xA2, 0x9C, 0x51, 0x26, 
+0xAA, 0xB4, 0x57, 0xEF, 0x84, 0x87, 0x32, 0x12, 
+0xFA, 0xC7, 0xFC, 0x00, 0xF2, 0x04, 0x86, 0x44, 
+0xDB, 0x08, 0x16, 0x3B, 0xAC, 0x7F, 0xFD, 0x73, 
+0xF9, 0x40, 0x0B, 0x35, 0xC2, 0xF1, 0xA3, 0x47, 
+0x37, 0x9D, 0x66, 0xC9, 0xFC, 0x63, 0x22, 0xA9, 
+0x9A, 0x99, 0x13, 0x22, 0x9D, 0xE7, 0x1A, 0x54, 
+0x23, 0xE1, 0xA6, 0xD4, 0x0B, 0x19, 0x58, 0x2D, 
+0xE0, 0x34, 0x73, 0xC6, 0xC9, 0x3A, 0xFA, 0x1A, 
+0xCF, 0xAF, 0x9D, 0xF4, 0x6D, 0xF4, 0x75, 0x18, 
+0xE1, 0xFC, 0x41, 0x20, 0x13, 0x19, 0xBE, 0x8D, 
+0x5E, 0x52, 0x8F, 0xE9, 0x82, 0x63, 0x90, 0xFF, 
+0x00, 0x2C, 0x7D, 0x68, 0xC2, 0xCA, 0xF9, 0x7F, 
+0x50, 
+
+0xFC, 0xC2, 0xD9, 0xFB, 0x3E, 0x10, 0x81, 0x73, 
+0x3E, 0x0A, 0x73, 0xE7, 0x4F, 0x82, 0xE9, 0x4D, 
+0x7B, 0x99, 0xAE, 0xA2, 0xF8, 0xFF, 0xD1, 0x39, 
+0xED, 0x06, 0xDC, 0x4C, 0xF5, 0x5B, 0xFC, 0xC9, 
+0xA3, 0xD1, 0x5B, 0xC9, 0x30, 0xF5, 0xFF, 0x70, 
+0xA4, 0xAF, 0xE9, 0x08, 0xF2, 0xD4, 0x61, 0xF8, 
+0x8E, 0x46, 0x43, 0x62, 0x59, 0xF1, 0xF8, 0xEF, 
+0x3B, 0x98, 0x09, 0x88, 0x3B, 0x52, 0xD8, 0x24, 
+0x79, 0xD6, 0xD5, 0x9C, 0x4F, 0xE7, 0x84, 0x21, 
+0xAF, 0xE0, 0x67, 0xB3, 0xD9, 0xCE, 0x1B, 0x9E, 
+0xE8, 0x8A, 0x5A, 0xB6, 0xFE, 0xFA, 0xA7, 0xB7, 
+0x5E, 0xE8, 0xC1, 0x0D, 0x93, 0xE1, 0x08, 0x5A, 
+0x1B, 0x4D, 0xFB, 0x86, 0xE5, 0x05, 0xBE, 0xB7, 
+0x5C, 0x02, 0xDF, 0xA8, 0xCF, 0x0E, 0x2E, 0x35, 
+0x8C, 0xC6, 0x53, 0xFB, 0x81, 0xE2, 0x25, 0x6E, 
+0xC1, 0x49, 0xB2, 0x24, 0x15, 0x33, 0x79, 0x52, 
+0x78, 0x86, 0xF5, 0xF9, 0x4A, 0x63, 0x14, 0xF4, 
+0x9C, 0xB4, 0xF2, 0x39, 0x57, 0xE6, 0x71, 0x79, 
+0x2F, 0xE6, 0xC1, 0xB5, 0x2F, 0xCD, 0x9F, 0x94, 
+0x15, 0x7B, 0x02, 0x4D, 0x28, 0xA7, 0x79, 0xF1, 
+0xB7, 0x51, 0x0F, 0xFA, 0x71, 0x78, 0x45, 0xA3, 
+0xB5, 0x22, 
+
+0x3B, 0x4D, 0x28, 0xE5, 0x3F, 0xA0, 0x73, 0x97, 
+0xF4, 0xC6, 0x4E, 0x77, 0x3E, 0x40, 0x0A, 0x59, 
+0xB4, 0x64, 0xED, 0x03, 0x75, 0x8E, 0x5E, 0x97, 
+0xE0, 0x92, 0x9A, 0x0E, 0x86, 0x99, 0x4B, 0x69, 
+0xC5, 0x42, 0x79, 0xA3, 0x09, 0x3B, 0x2E, 0x67, 
+0xFC, 0xBB, 0x22, 0x99, 0x69, 0x62, 0xBF, 0xDF, 
+0x75, 0x6A, 0xA6, 0x42, 0x34, 0xC2, 0x3F, 0xC3, 
+0x6A, 0xE8, 0x1C, 0x4D, 0x75, 0x6C, 0x97, 0x27, 
+0xC5, 0x0C, 0x1D, 0x52, 0x1E, 0x0A, 0x06, 0x77, 
+0xB6, 0xDD, 0xE5, 0x1E, 0xDA, 0x57, 0xDE, 0x12, 
+0x50, 0x43, 0x1E, 0xA0, 0x51, 0x32, 0x6C, 0x92, 
+0x9C, 0xB5, 0x6E, 0xB0, 0x40, 0x21, 0x31, 0x76, 
+0xBC, 0x3F, 0x58, 0x3F, 0x7B, 0x23, 0x9A, 0x22, 
+0x06, 0xE3, 0x62, 0x43, 0xD0, 0xAF, 0xAF, 0x28, 
+0x50, 0x32, 0x3E, 0x4C, 0x53, 0xB6, 0xBC, 0x83, 
+0x7D, 0x0F, 0x64, 0xCC, 0x48, 0xA6, 0xB0, 0xD0, 
+0xEC, 0xFF, 0x29, 0xC8, 0x23, 0xD4, 0x5D, 0xB2, 
+0xE4, 0x60, 0x12, 0xF6, 0xD3, 0xAB, 0xE9, 0xDD, 
+0xAE, 0x33, 0x3D, 0xE5, 0xEB, 0x62, 0x39, 0x0C, 
+0x15, 0x71, 0x79, 0x7D, 0x70, 0xF2, 0x39, 0x3A, 
+0xE7, 0xEF, 0xBE, 0xD5, 0x27, 0x21, 0x54, 0x7F, 
+0x64, 0xB1, 0x2A, 
+
+0xE1, 0x6E, 0xE7, 0x45, 0xD5, 0x84, 0xDF, 0xF9, 
+0xF8, 0x82, 0x8D, 0x62, 0xDF, 0x9D, 0x7F, 0xF0, 
+0xD2, 0x48, 0x3C, 0x71, 0x30, 0xF8, 0x82, 0x02, 
+0x5F, 0x65, 0x0A, 0xBA, 0x2D, 0x77, 0x46, 0x87, 
+0x56, 0x9D, 0x03, 0x51, 0x8E, 0xDF, 0x09, 0xBE, 
+0xB9, 0xBB, 0x3C, 0x23, 0x8A, 0x48, 0x4B, 0x2A, 
+0x27, 0x5E, 0xD4, 0x25, 0x5E, 0xE5, 0x6E, 0x9F, 
+0x8C, 0xA0, 0xF0, 0x35, 0x1F, 0xC0, 0x59, 0x4B, 
+0x34, 0x47, 0x5E, 0x12, 0xA5, 0x76, 0x87, 0x35, 
+0x99, 0x34, 0x29, 0x01, 0x5D, 0x97, 0x35, 0x17, 
+0xE3, 0x38, 0x72, 0xC1, 0xA0, 0x11, 0xB9, 0xA9, 
+0x33, 0x1A, 0x19, 0xFD, 0x4F, 0x14, 0x3C, 0xDA, 
+0x6D, 0xFD, 0x84, 0x63, 0xA9, 0x5B, 0x37, 0x42, 
+0x66, 0x0C, 0x50, 0x8F, 0xAB, 0x69, 0x4A, 0x5D, 
+0xAF, 0x1B, 0x6A, 0xE0, 0x5A, 0x6F, 0x5D, 0x1E, 
+0x5D, 0x1E, 0xBE, 0x84, 0x13, 0xEA, 0xC1, 0x18, 
+0xE6, 0x63, 0x70, 0x46, 0x8D, 0xA7, 0x17, 0xB0, 
+0x9A, 0xA5, 0x10, 0x9B, 0x5D, 0xAF, 0xDF, 0x5C, 
+0x7F, 0x8A, 0xA4, 0x7E, 0xC4, 0xC7, 0xD3, 0x1D, 
+0x5B, 0xF3, 0x04, 0x1E, 0x8C, 0x20, 0x1F, 0xFC, 
+0xF2, 0x75, 0x5A, 0x6E, 0xE8, 0x79, 0xD4, 0xEF, 
+0x35, 0xD0, 0xDC, 0x3C, 
+
+0x6A, 0x4B, 0x09, 0x9A, 0x61, 0x77, 0x97, 0x2A, 
+0x97, 0xE9, 0x85, 0x78, 0x32, 0x28, 0x0F, 0x12, 
+0x2C, 0xE7, 0xED, 0x64, 0x07, 0xCC, 0x69, 0x6A, 
+0x05, 0xB4, 0xFA, 0x27, 0x0F, 0xCE, 0x8E, 0xF7, 
+0x75, 0x88, 0x05, 0x70, 0xB3, 0x7E, 0x8D, 0x38, 
+0x9F, 0x6E, 0xB7, 0x60, 0xB7, 0x9F, 0x31, 0xAD, 
+0x68, 0x85, 0x72, 0x32, 0xC1, 0x65, 0x1A, 0xD0, 
+0x1E, 0xB8, 0x46, 0x1B, 0xC9, 0x26, 0x82, 0x18, 
+0x51, 0xC8, 0x60, 0x2C, 0x90, 0x51, 0xC1, 0xA4, 
+0xF6, 0x17, 0xFC, 0xBB, 0x70, 0x57, 0xE4, 0x02, 
+0x0A, 0xD4, 0xF0, 0x26, 0x39, 0x90, 0xCB, 0xF5, 
+0xF5, 0x58, 0x5D, 0x25, 0xD5, 0x50, 0x2A, 0xCB, 
+0xF3, 0xEF, 0x6D, 0xA8, 0x87, 0x23, 0x1C, 0x51, 
+0x5E, 0x52, 0xCC, 0x59, 0x95, 0x76, 0xF2, 0x1B, 
+0x6F, 0xBA, 0xE7, 0xF8, 0xD3, 0xD9, 0xD0, 0x61, 
+0xFE, 0x17, 0x09, 0x35, 0x44, 0x2F, 0xDA, 0x5E, 
+0x4B, 0xB3, 0xF3, 0x81, 0xC8, 0x54, 0x59, 0x8B, 
+0xBB, 0x44, 0xED, 0x52, 0x91, 0x19, 0xF0, 0xB3, 
+0x0C, 0x63, 0x16, 0x9E, 0xD6, 0x88, 0xBD, 0xD8, 
+0x44, 0x99, 0x7B, 0x12, 0xFA, 0x8B, 0xB4, 0xEA, 
+0xD8, 0x18, 0xB4, 0x2A, 0x96, 0x72, 0xC2, 0x9A, 
+0x4E, 0xF3, 0x4A, 0x15, 0x03, 
+
+0xA4, 0x6D, 0xFF, 0x11, 0xB7, 0xB0, 0x1B, 0x8E, 
+0xF0, 0xBD, 0xB6, 0x8C, 0x21, 0x07, 0x38, 0xC7, 
+0x66, 0xEB, 0x0F, 0x02, 0xC4, 0xE2, 0xA0, 0x19, 
+0x48, 0xCB, 0x0B, 0x1E, 0x63, 0x0E, 0x6E, 0x97, 
+0xC8, 0x43, 0x3A, 0xC7, 0xB5, 0x90, 0xD4, 0x9D, 
+0xEB, 0x25, 0xA7, 0xCD, 0x3B, 0xA2, 0xDE, 0x27, 
+0x16, 0xAF, 0x4F, 0x07, 0x90, 0x16, 0xD4, 0x02, 
+0x01, 0xAD, 0x0C, 0x43, 0xB3, 0x92, 0x82, 0xDF, 
+0x98, 0xF0, 0x77, 0xF9, 0x40, 0x89, 0x29, 0x87, 
+0x8B, 0x51, 0xF3, 0x79, 0x00, 0x41, 0x3A, 0xA6, 
+0xFE, 0x81, 0x0C, 0x7A, 0xEC, 0x6E, 0x5A, 0xE5, 
+0xB4, 0x6D, 0x80, 0x38, 0x6E, 0xB6, 0x2F, 0xA9, 
+0x6F, 0xD4, 0x53, 0x8D, 0x5A, 0x03, 0x33, 0x25, 
+0x22, 0x84, 0xC5, 0xC6, 0x20, 0x57, 0x31, 0xEB, 
+0xDF, 0x56, 0xA2, 0xDF, 0xD9, 0xCC, 0xDD, 0xE5, 
+0x70, 0x60, 0x75, 0xE0, 0x76, 0x04, 0x72, 0x5B, 
+0xF6, 0x54, 0x07, 0x84, 0x07, 0x4A, 0xAD, 0x76, 
+0xA3, 0x19, 0x92, 0xAF, 0x02, 0x2D, 0xBE, 0xD8, 
+0x56, 0xD3, 0x8E, 0x0C, 0xA1, 0xD9, 0xC6, 0x3B, 
+0x36, 0x8E, 0x6E, 0x67, 0xC8, 0xED, 0x76, 0xF2, 
+0xBB, 0xB9, 0xD5, 0xD0, 0x45, 0xDD, 0x2B, 0xB0, 
+0xA7, 0xD2, 0x24, 0x0A, 0xA7, 0x98, 
+
+0xF7, 0x6D, 0xB8, 0x34, 0xBB, 0x08, 0xA0, 0x3A, 
+0x11, 0xB4, 0x89, 0xA9, 0x3C, 0x9D, 0x15, 0xDF, 
+0xA1, 0x5B, 0x41, 0x6C, 0x30, 0xF9, 0x6D, 0x50, 
+0x26, 0xA0, 0xFD, 0xF6, 0xB4, 0x1D, 0x99, 0xAB, 
+0xAB, 0xBB, 0x83, 0x01, 0x31, 0xFF, 0xBB, 0xC7, 
+0x8E, 0xBD, 0x3D, 0x0F, 0xFD, 0x8F, 0x7A, 0x96, 
+0x9F, 0x57, 0xAC, 0x14, 0x7F, 0x85, 0xCD, 0xBF, 
+0x79, 0xC0, 0xDF, 0xFF, 0x9B, 0x9E, 0x47, 0xC5, 
+0x03, 0x42, 0xFD, 0xE0, 0xC7, 0xA8, 0x00, 0xD2, 
+0x29, 0x47, 0x55, 0x11, 0x0F, 0x5B, 0x0F, 0xFD, 
+0x4D, 0xB5, 0xF1, 0x54, 0x0E, 0x54, 0xB0, 0x61, 
+0xA6, 0x73, 0xE2, 0x35, 0xC9, 0xC7, 0x81, 0xF8, 
+0x80, 0x41, 0x53, 0x08, 0x9F, 0x8C, 0x21, 0x10, 
+0xB7, 0xFF, 0xA1, 0xB6, 0x1C, 0x35, 0xA5, 0x11, 
+0x06, 0x20, 0x28, 0x30, 0xD0, 0xEE, 0x56, 0x33, 
+0x35, 0x50, 0x75, 0x52, 0xB6, 0x14, 0x48, 0x89, 
+0x61, 0xE2, 0x9F, 0x24, 0x39, 0x6B, 0x91, 0xE2, 
+0xAC, 0xE9, 0xC1, 0xCC, 0x21, 0xD0, 0x4D, 0xAD, 
+0xB1, 0x19, 0x81, 0x1C, 0x5E, 0xD9, 0x42, 0x83, 
+0x54, 0xAD, 0xFB, 0xC8, 0x36, 0xD8, 0x62, 0x86, 
+0x92, 0x92, 0x88, 0xE7, 0x9E, 0xFD, 0x2C, 0xEE, 
+0x99, 0xE3, 0xD8, 0x1A, 0xD0, 0x52, 0xBA, 
+
+0xAE, 0x79, 0x0F, 0xA0, 0x7C, 0x78, 0x72, 0x63, 
+0x5D, 0xC6, 0x36, 0x45, 0x42, 0x82, 0x74, 0x69, 
+0x95, 0xDD, 0x34, 0x2A, 0x11, 0x49, 0xC7, 0xE9, 
+0xA3, 0x48, 0x4C, 0x17, 0x80, 0xB1, 0xDF, 0x68, 
+0x6F, 0x06, 0x2D, 0xB1, 0xB7, 0xA9, 0x0C, 0xC9, 
+0x12, 0x71, 0xF0, 0xE7, 0x64, 0x7D, 0x3B, 0x62, 
+0xB2, 0xCE, 0xE0, 0x57, 0xCB, 0xD9, 0x7E, 0x2B, 
+0x58, 0xC1, 0xEE, 0xEA, 0x93, 0x14, 0xFB, 0x28, 
+0x20, 0xB9, 0x46, 0xB5, 0x57, 0xB1, 0xAF, 0x71, 
+0xA5, 0x46, 0x96, 0x0F, 0xC6, 0x72, 0x89, 0xF0, 
+0x69, 0xA3, 0x23, 0x5B, 0xA9, 0xB7, 0x58, 0xCC, 
+0x3B, 0xB4, 0x22, 0xFB, 0x4B, 0x0E, 0xBC, 0xF2, 
+0xE8, 0xB3, 0x7D, 0x15, 0xE9, 0x67, 0xE1, 0x1A, 
+0x55, 0xB2, 0xC3, 0x62, 0x22, 0x12, 0x9D, 0x53, 
+0x02, 0xE1, 0x16, 0x35, 0x3D, 0x30, 0x08, 0x2A, 
+0x53, 0x4D, 0x08, 0x7B, 0xB0, 0x7E, 0x82, 0x0D, 
+0x64, 0x67, 0xA0, 0x11, 0x22, 0xCC, 0x9F, 0xCE, 
+0x92, 0xD9, 0xC7, 0x8B, 0x75, 0xE8, 0xD6, 0x70, 
+0x48, 0x12, 0x7E, 0x08, 0xD5, 0x31, 0x96, 0x2A, 
+0x14, 0xCA, 0x83, 0x00, 0x86, 0x44, 0x1C, 0x24, 
+0xA3, 0x83, 0x78, 0x26, 0xC1, 0xEB, 0xD1, 0x7B, 
+0xF3, 0x8C, 0x7F, 0xE0, 0xC6, 0x96, 0x37, 0xCD, 
+
+0x76, 0x9A, 0xE6, 0x67, 0x35, 0x27, 0x01, 0xCB, 
+0x90, 0xE4, 0xB0, 0xD0, 0xD8, 0x2A, 0x48, 0x7C, 
+0xA5, 0xC4, 0x81, 0x27, 0xB7, 0xD2, 0x5C, 0xBF, 
+0x89, 0xA8, 0xFA, 0x24, 0xF7, 0x83, 0x67, 0xD3, 
+0x23, 0x78, 0x8A, 0x47, 0xA3, 0x32, 0x9D, 0xDF, 
+0x1B, 0x34, 0x85, 0xD0, 0x21, 0x0D, 0x7F, 0x87, 
+0xF0, 0xF1, 0x12, 0x71, 0x68, 0xDC, 0xD7, 0xEC, 
+0x16, 0xF9, 0xF7, 0x8D, 0x6E, 0x89, 0x69, 0x1A, 
+0xB5, 0x49, 0xA3, 0x38, 0x4B, 0xF9, 0xB7, 0x64, 
+0x11, 0xC2, 0x97, 0x8E, 0xE5, 0xDA, 0xCD, 0x59, 
+0xB7, 0xE3, 0x6E, 0x3A, 0x14, 0xB2, 0x4E, 0x3B, 
+0xB7, 0x8A, 0x37, 0x71, 0x3E, 0xAF, 0x19, 0x98, 
+0x67, 0xB5, 0x5A, 0x06, 0x12, 0x79, 0x94, 0x30, 
+0x0E, 0x30, 0xDD, 0x97, 0xE6, 0xD1, 0x85, 0x3D, 
+0x25, 0x99, 0x26, 0x39, 0x1F, 0x55, 0x66, 0x41, 
+0x8E, 0xA3, 0x46, 0xE8, 0xBF, 0xD0, 0xA2, 0x63, 
+0x49, 0xFA, 0x0E, 0xCE, 0x74, 0xF8, 0xA0, 0x22, 
+0xED, 0x4B, 0xDD, 0x75, 0xDF, 0xB9, 0x57, 0x73, 
+0x24, 0xD2, 0xC3, 0x50, 0x0F, 0x40, 0x22, 0xE0, 
+0x9E, 0x44, 0xD8, 0xCC, 0xBC, 0x99, 0x26, 0x34, 
+0x9B, 0xF9, 0x76, 0xEB, 0x39, 0x5F, 0x79, 0xEC, 
+0x20, 0x10, 0x53, 0xB4, 0x90, 0x80, 0x26, 0x78, 
+0xCD, 
+
+0x4D, 0xBA, 0x94, 0x47, 0x4D, 0x49, 0x45, 0x9D, 
+0x6E, 0xD5, 0xE8, 0x87, 0x83, 0x2E, 0x48, 0x18, 
+0x20, 0x3C, 0x34, 0x2C, 0xD3, 0x79, 0xFA, 0xBC, 
+0x6E, 0xFD, 0x98, 0x3E, 0xAA, 0x88, 0x1A, 0x99, 
+0x35, 0x6E, 0xF7, 0xB4, 0xA4, 0xAB, 0x88, 0x8B, 
+0xEF, 0x48, 0x5D, 0x9C, 0xE6, 0xB2, 0xD1, 0xE0, 
+0xFD, 0xBB, 0x34, 0x18, 0x19, 0x66, 0x29, 0x47, 
+0x08, 0x1A, 0xE5, 0xBD, 0xFE, 0x80, 0xB7, 0xDE, 
+0x28, 0x8B, 0x01, 0x4C, 0xC8, 0x5A, 0x96, 0x11, 
+0xCF, 0xAD, 0xCC, 0x08, 0xFB, 0x7F, 0x44, 0x28, 
+0xF1, 0x89, 0xB1, 0x16, 0x6B, 0xD5, 0x88, 0x35, 
+0xEA, 0xF8, 0xB1, 0x9C, 0xB1, 0x2E, 0x04, 0xE9, 
+0xF0, 0xB1, 0x81, 0x1B, 0x42, 0xE2, 0x71, 0xF9, 
+0x3F, 0xC7, 0x71, 0x62, 0x91, 0x8A, 0x83, 0x65, 
+0x8C, 0xC2, 0x93, 0xF8, 0xE5, 0x0A, 0x6A, 0xF2, 
+0xCB, 0xF4, 0x1E, 0x1E, 0xB8, 0x8C, 0x34, 0x61, 
+0xDF, 0xA6, 0x49, 0x7A, 0x0E, 0xF9, 0xBC, 0xCC, 
+0x43, 0x12, 0xAD, 0x3F, 0xBF, 0x21, 0x14, 0xD1, 
+0xE9, 0xCA, 0x11, 0xAB, 0x24, 0xF0, 0x46, 0x5D, 
+0x4C, 0xFE, 0xCF, 0xD4, 0x0E, 0x8D, 0x1B, 0xF7, 
+0x98, 0x95, 0x52, 0x1F, 0x92, 0xE5, 0x59, 0x2A, 
+0x76, 0x7D, 0xED, 0x0D, 0xAB, 0x7F, 0xC7, 0xEF, 
+0x73, 0x01, 
+
+0xF8, 0x7B, 0xD0, 0x63, 0x51, 0x85, 0xC4, 0x0A, 
+0x9F, 0x3B, 0xAB, 0xC6, 0x7B, 0x09, 0x2D, 0xBA, 
+0x3E, 0xF1, 0xA0, 0xA2, 0xA0, 0x18, 0xDB, 0xD4, 
+0x78, 0x9A, 0x17, 0xC1, 0x73, 0xCB, 0x11, 0xF5, 
+0xC2, 0x07, 0x03, 0xC0, 0x55, 0xCA, 0x81, 0x26, 
+0xDC, 0xFE, 0x65, 0x02, 0x0B, 0xF0, 0x32, 0x38, 
+0xF3, 0x45, 0x0F, 0x7E, 0x89, 0x5A, 0x33, 0xD1, 
+0xBA, 0x4B, 0x6D, 0x42, 0x06, 0x75, 0x91, 0xD3, 
+0x63, 0xDA, 0x57, 0x3A, 0x11, 0xA6, 0x1E, 0x50, 
+0xD7, 0xEF, 0x7B, 0x4B, 0x31, 0x9B, 0xBA, 0x21, 
+0xFF, 0xBB, 0x25, 0x09, 0x16, 0xB4, 0x81, 0xCD, 
+0x80, 0x52, 0xC9, 0x90, 0xCE, 0x93, 0x4D, 0xDF, 
+0xD6, 0x4F, 0x14, 0x7D, 0x7E, 0x33, 0xA3, 0x7D, 
+0x7A, 0x7E, 0xE1, 0x5F, 0x4F, 0x72, 0xF9, 0x4D, 
+0x77, 0x9E, 0x06, 0x0E, 0x98, 0xF8, 0x54, 0xE7, 
+0x92, 0xB8, 0x01, 0x5A, 0x23, 0x6A, 0x91, 0xED, 
+0xCE, 0x96, 0x6B, 0x41, 0xB8, 0xF4, 0x70, 0x31, 
+0xB7, 0x1B, 0xCC, 0xFA, 0xFD, 0x1D, 0x89, 0xB0, 
+0x53, 0x2B, 0x9D, 0xDC, 0x92, 0xCC, 0xA2, 0x13, 
+0x51, 0xF6, 0xA8, 0x0C, 0x1D, 0x6E, 0x5B, 0x5E, 
+0xBE, 0x2A, 0xC7, 0x40, 0xB2, 0x7C, 0xD2, 0x8C, 
+0x25, 0x33, 0x1F, 0x9F, 0xA1, 0x30, 0x5D, 0xA6, 
+0xF0, 0x97, 0xC3, 
+
+0x04, 0x70, 0x1F, 0xED, 0xD5, 0x86, 0x6A, 0xE0, 
+0xD5, 0xC1, 0x3E, 0x5A, 0x3E, 0xB7, 0xBD, 0x8B, 
+0xAF, 0x8C, 0xE5, 0x7A, 0x97, 0x01, 0x48, 0xA6, 
+0x24, 0x88, 0xC9, 0x08, 0xA9, 0x12, 0xB1, 0x34, 
+0x84, 0xB6, 0x20, 0x4B, 0xDF, 0x22, 0xF2, 0x92, 
+0xE2, 0x3F, 0x29, 0x3F, 0x31, 0x39, 0x68, 0x75, 
+0x72, 0xB7, 0x83, 0xCA, 0x9C, 0x8C, 0xCE, 0xF5, 
+0x84, 0x54, 0xA3, 0x51, 0xB0, 0x5C, 0xE1, 0x2A, 
+0x11, 0xFD, 0xE0, 0xB4, 0x46, 0x2E, 0x78, 0xB2, 
+0xB3, 0x06, 0x1F, 0xA7, 0x31, 0x9B, 0xC5, 0x99, 
+0x0E, 0xD3, 0x9C, 0x1F, 0xE9, 0x24, 0xEF, 0x12, 
+0xD7, 0x52, 0x1B, 0x02, 0x70, 0xEF, 0x9E, 0xB3, 
+0xE0, 0x57, 0x75, 0x26, 0xC9, 0x0C, 0xF7, 0x0C, 
+0xA1, 0xEE, 0x06, 0x7B, 0x1D, 0x4F, 0x77, 0xF4, 
+0x09, 0x9B, 0x10, 0x64, 0x8E, 0x17, 0x8A, 0x5C, 
+0x56, 0x61, 0x5F, 0x2C, 0x8C, 0x23, 0xFF, 0xA5, 
+0xCA, 0xE6, 0x3F, 0x78, 0x21, 0x3C, 0xBB, 0xA9, 
+0x76, 0xEA, 0x6B, 0x3C, 0xFC, 0x6E, 0xC3, 0x67, 
+0x76, 0xF6, 0x70, 0xC9, 0x52, 0xDF, 0x8A, 0xD0, 
+0x4F, 0xDD, 0xFA, 0x15, 0xB8, 0x57, 0xAC, 0x86, 
+0x2D, 0xAC, 0xD1, 0xCC, 0x9C, 0xA1, 0xB1, 0xEF, 
+0x0E, 0x5D, 0x6D, 0xB7, 0xED, 0x16, 0xCC, 0x8C, 
+0x44, 0x8C, 0x7A, 0x96, 
+
+0x92, 0x79, 0xBA, 0x91, 0x61, 0x6D, 0x27, 0x91, 
+0x9E, 0x71, 0xD3, 0x52, 0xC4, 0x65, 0x36, 0x35, 
+0xF9, 0xFD, 0x07, 0xDE, 0xF9, 0xE1, 0xB6, 0x6D, 
+0x78, 0x1D, 0x7F, 0x51, 0x22, 0x86, 0xFB, 0xD0, 
+0xAF, 0xD8, 0xEC, 0x20, 0x2E, 0x4A, 0x34, 0x95, 
+0x43, 0xFC, 0xFF, 0x18, 0x0E, 0x96, 0x85, 0x94, 
+0x85, 0xD4, 0x8F, 0x5A, 0xAE, 0x70, 0x92, 0x4C, 
+0x4D, 0xDC, 0xE1, 0xE1, 0x53, 0x06, 0x8A, 0x01, 
+0x69, 0x7B, 0xF4, 0x5F, 0xB3, 0x42, 0x57, 0xEC, 
+0x25, 0x3E, 0x6B, 0x8F, 0x96, 0xF5, 0xFB, 0x84, 
+0x96, 0xAE, 0x97, 0xF8, 0x1F, 0x45, 0xF1, 0xA3, 
+0x99, 0x7A, 0x4A, 0x35, 0x29, 0x5A, 0xFE, 0xEE, 
+0x53, 0xEA, 0x88, 0x85, 0xF7, 0xB6, 0xDF, 0x57, 
+0x13, 0x84, 0x91, 0x12, 0xA3, 0x2F, 0x36, 0x03, 
+0xB3, 0xDA, 0x70, 0x3F, 0x29, 0x8E, 0xC3, 0x30, 
+0x8F, 0x23, 0x0D, 0xED, 0x40, 0xD1, 0x2D, 0xD9, 
+0xB3, 0xC5, 0x06, 0x6B, 0xAA, 0x6E, 0x54, 0x3F, 
+0xCA, 0x6C, 0xCD, 0xA7, 0x9F, 0xF3, 0xB7, 0x87, 
+0xF9, 0xC5, 0xD0, 0x1E, 0xE3, 0xD8, 0xED, 0xFF, 
+0x11, 0x30, 0x1D, 0xC7, 0xF0, 0x3C, 0x91, 0x66, 
+0xE2, 0x36, 0x51, 0xA7, 0x1E, 0x02, 0x69, 0xA3, 
+0x0E, 0xBD, 0x6E, 0xFA, 0x66, 0x2D, 0x3B, 0x84, 
+0xF8, 0x6E, 0x66, 0x0E, 0x23, 
+
+0x38, 0x23, 0xE6, 0xB0, 0xCD, 0x1C, 0x7B, 0xF3, 
+0x3D, 0x1E, 0x25, 0x51, 0xB3, 0x23, 0x0D, 0x5E, 
+0x6C, 0xF4, 0x2F, 0x14, 0x3E, 0x6F, 0x37, 0x51, 
+0xC3, 0x21, 0xC4, 0x67, 0xA0, 0xFF, 0x0E, 0xF9, 
+0xF7, 0x0A, 0xEF, 0x8B, 0x20, 0x2A, 0x77, 0x64, 
+0x1F, 0xE0, 0xE3, 0xC2, 0x6D, 0x69, 0x15, 0x9F, 
+0xA3, 0xEC, 0x77, 0x45, 0xD5, 0xA6, 0xFC, 0xFD, 
+0x57, 0x77, 0x84, 0x6D, 0xEB, 0x93, 0x17, 0xF9, 
+0x61, 0x6A, 0x73, 0x7F, 0x03, 0xA9, 0xA3, 0x55, 
+0x44, 0xEA, 0x3F, 0x2A, 0xE9, 0x6A, 0x0C, 0xFE, 
+0xA3, 0x7B, 0x47, 0x5D, 0x4D, 0x9F, 0xE2, 0xA3, 
+0x9F, 0x5A, 0x38, 0x74, 0x5D, 0x6A, 0x0D, 0x57, 
+0xDD, 0xB2, 0xA8, 0x9D, 0x7C, 0x9E, 0xE6, 0x88, 
+0x61, 0xDB, 0xFD, 0x8D, 0x45, 0x86, 0x8D, 0x7C, 
+0x56, 0x25, 0x58, 0x47, 0xE9, 0x68, 0x59, 0x6D, 
+0x1B, 0x15, 0x5E, 0x6A, 0xE9, 0xC4, 0xD8, 0xCE, 
+0x47, 0x45, 0xA2, 0x1C, 0x23, 0x3C, 0x77, 0xC1, 
+0x42, 0xEE, 0x2A, 0x1F, 0xF5, 0x80, 0xB7, 0x1E, 
+0xAC, 0x79, 0xAF, 0xAB, 0xCF, 0x3F, 0x4E, 0x90, 
+0xB6, 0x36, 0x99, 0x4B, 0x1B, 0x59, 0x05, 0x49, 
+0xAA, 0x06, 0x5A, 0xA5, 0xDB, 0x09, 0x5D, 0x4A, 
+0xF9, 0x91, 0x5F, 0x40, 0xD5, 0xE6, 0xDE, 0x75, 
+0x15, 0x13, 0xD5, 0x42, 0x6B, 0xCB, 
+
+0x51, 0xBE, 0xE4, 0xE6, 0x14, 0x5A, 0x5C, 0x7D, 
+0xC9, 0x36, 0x00, 0x51, 0xFB, 0xC0, 0xEF, 0x6E, 
+0x59, 0xF4, 0x41, 0x64, 0xC0, 0x05, 0x3C, 0x05, 
+0x0D, 0x5E, 0xBB, 0x16, 0x93, 0xF5, 0x20, 0x4B, 
+0x42, 0x89, 0x6C, 0x28, 0xCD, 0x1F, 0x0A, 0x15, 
+0xEC, 0xF5, 0x2D, 0xCA, 0xB6, 0x05, 0x76, 0x7C, 
+0x7F, 0xD5, 0xDF, 0x71, 0xAB, 0xB9, 0x3A, 0x4F, 
+0xF7, 0x94, 0x07, 0x0A, 0x2D, 0xD8, 0x05, 0x78, 
+0x56, 0xEC, 0x36, 0xFC, 0xA4, 0x92, 0xB1, 0x87, 
+0x60, 0x93, 0x3A, 0x53, 0x25, 0xC6, 0x89, 0x49, 
+0x60, 0x96, 0x6D, 0xAF, 0x12, 0xF9, 0x30, 0x64, 
+0x6E, 0x5C, 0xF5, 0x9D, 0xDB, 0x7F, 0xCE, 0xB0, 
+0xB5, 0xDF, 0xD8, 0x42, 0x46, 0x9B, 0x7D, 0xCA, 
+0x60, 0x94, 0xB7, 0x8E, 0x6C, 0x95, 0x86, 0x19, 
+0x21, 0x85, 0xEE, 0xC5, 0x8F, 0x23, 0x0A, 0x7A, 
+0x7F, 0xCF, 0xA8, 0xB2, 0xAF, 0x3E, 0x90, 0x2C, 
+0xA9, 0x0B, 0x79, 0xBB, 0x1C, 0x9C, 0x4D, 0xD8, 
+0xF0, 0x9B, 0xBB, 0xCD, 0x91, 0xB2, 0x0C, 0xB4, 
+0x32, 0xCA, 0x4B, 0x7B, 0x21, 0xA2, 0xC2, 0xA4, 
+0x65, 0x28, 0xBA, 0x63, 0x98, 0x35, 0x61, 0x15, 
+0x1A, 0x5D, 0x0C, 0x58, 0xD4, 0x98, 0xAE, 0x83, 
+0x28, 0x70, 0x25, 0xBE, 0x78, 0xC7, 0xF3, 0x75, 
+0x88, 0xA2, 0x6A, 0x3F, 0x02, 0xC2, 0x9C, 
+
+0xDE, 0x11, 0x12, 0xF7, 0x0A, 0x3F, 0xF4, 0x70, 
+0x2E, 0xB5, 0x5A, 0xD1, 0x52, 0x7D, 0x55, 0xDF, 
+0x95, 0xD1, 0x6D, 0x0C, 0xF4, 0x82, 0x60, 0xB8, 
+0xB1, 0xDD, 0x8B, 0x8F, 0x27, 0xB8, 0x4B, 0xDD, 
+0x9D, 0x9E, 0xCF, 0x79, 0x5C, 0x74, 0x57, 0xFB, 
+0xA6, 0x1A, 0x87, 0x1F, 0xAA, 0x64, 0x9B, 0x12, 
+0xCE, 0xBF, 0xB5, 0xBC, 0x7E, 0x1C, 0x0E, 0xF3, 
+0x01, 0x6F, 0x50, 0x3C, 0x90, 0xE4, 0xF3, 0x46, 
+0x04, 0x35, 0x32, 0x03, 0x06, 0x02, 0xF2, 0x1A, 
+0x3A, 0x07, 0xAE, 0x1F, 0x60, 0xA0, 0x68, 0xF4, 
+0x89, 0x0C, 0x89, 0xEC, 0x48, 0xD4, 0xE1, 0xD9, 
+0x83, 0x53, 0xCC, 0xA9, 0xA5, 0xDD, 0xE5, 0xFF, 
+0x29, 0xE0, 0x5A, 0x57, 0x5F, 0x6B, 0xB6, 0x84, 
+0x3B, 0xE7, 0x7A, 0xCD, 0x5F, 0x07, 0x61, 0x5E, 
+0xCC, 0x1E, 0x8E, 0xE8, 0x2F, 0xE0, 0xDC, 0xF4, 
+0x6B, 0x63, 0x9B, 0x6A, 0xAE, 0x28, 0x8E, 0xF7, 
+0x98, 0x43, 0x9D, 0x63, 0x24, 0x3A, 0x12, 0x22, 
+0x61, 0x7A, 0x1F, 0xCA, 0x28, 0xC6, 0xF6, 0xC5, 
+0x7D, 0x65, 0x11, 0x4F, 0x3E, 0xF6, 0x31, 0x0F, 
+0x7D, 0x53, 0x69, 0x85, 0xCA, 0xBF, 0x45, 0x33, 
+0xCF, 0x98, 0x3E, 0xCB, 0xFB, 0x0C, 0x74, 0xAA, 
+0x4E, 0xAC, 0x5C, 0x26, 0x41, 0x0E, 0x81, 0x3C, 
+0xC4, 0x39, 0xF0, 0xC6, 0xC7, 0x10, 0x37, 0xE8, 
+
+0x73, 0x68, 0x02, 0x68, 0xDA, 0xDE, 0x52, 0x17, 
+0xA0, 0xF9, 0x31, 0x8B, 0x68, 0x4B, 0xD4, 0x72, 
+0xEB, 0x85, 0x5E, 0x60, 0x29, 0x48, 0x81, 0x58, 
+0x0C, 0xBC, 0x0D, 0xCA, 0x40, 0x3D, 0x44, 0x10, 
+0xD3, 0xDD, 0x5F, 0x45, 0x80, 0x9F, 0x7F, 0x18, 
+0xD1, 0x5E, 0xBB, 0xF5, 0x6D, 0xAB, 0x76, 0x8E, 
+0x38, 0x7C, 0xF8, 0x56, 0x95, 0xDF, 0x61, 0x15, 
+0x88, 0x61, 0xBF, 0x28, 0xD1, 0x71, 0x4A, 0xDA, 
+0x80, 0x4C, 0xAD, 0x0B, 0x24, 0x83, 0x72, 0xB5, 
+0x11, 0xF1, 0x29, 0x15, 0x32, 0xFF, 0x96, 0x6E, 
+0xEF, 0x63, 0x2A, 0x28, 0xC1, 0x99, 0xEC, 0x06, 
+0x40, 0x01, 0xA6, 0xC8, 0x5C, 0x67, 0xA8, 0xED, 
+0xED, 0xF6, 0x04, 0x47, 0xDF, 0xA0, 0x7C, 0xAA, 
+0xA1, 0xA0, 0x28, 0x20, 0xD0, 0xE5, 0x90, 0xF1, 
+0x44, 0xA9, 0xB1, 0x01, 0x83, 0xEC, 0x30, 0x7A, 
+0x90, 0x22, 0x0D, 0x00, 0xD5, 0x98, 0xC2, 0x21, 
+0x26, 0x44, 0x1D, 0x97, 0x64, 0xFD, 0x15, 0x21, 
+0x60, 0x61, 0xAE, 0x79, 0x22, 0xBD, 0xE7, 0xA7, 
+0x34, 0xF1, 0x54, 0x29, 0x66, 0x3E, 0x9D, 0xED, 
+0x62, 0xCF, 0x17, 0x47, 0xB3, 0xD4, 0x5A, 0x28, 
+0x9E, 0x43, 0xE8, 0x26, 0x34, 0x5C, 0x53, 0x04, 
+0x61, 0x82, 0x8A, 0x98, 0x74, 0x3D, 0xC5, 0xD9, 
+0x64, 0xE8, 0x94, 0x08, 0xBE, 0x53, 0x40, 0x3F, 
+0xE7, 
+
+0xC2, 0x91, 0xF0, 0xA7, 0x83, 0x73, 0x41, 0xD5, 
+0x3A, 0xC7, 0x36, 0x85, 0x5E, 0x21, 0x12, 0xD5, 
+0xE0, 0xC6, 0xB4, 0xDA, 0x74, 0x6B, 0xB3, 0xE7, 
+0x62, 0xD5, 0xB9, 0x80, 0x51, 0xF0, 0xF2, 0x45, 
+0x46, 0xBE, 0xC4, 0x2A, 0x7F, 0x09, 0x9B, 0x05, 
+0x26, 0x42, 0x0F, 0x47, 0x4B, 0xEA, 0xE0, 0x4F, 
+0x87, 0x6A, 0x1F, 0xB0, 0x03, 0x13, 0x80, 0xD8, 
+0xC8, 0xCB, 0xB4, 0xEE, 0xA1, 0x01, 0x18, 0x8A, 
+0xF3, 0x9D, 0x7B, 0x0F, 0x2B, 0xFF, 0xAC, 0x22, 
+0xF9, 0x13, 0xA5, 0x78, 0x41, 0x00, 0x70, 0xF0, 
+0xB0, 0x47, 0x28, 0xD7, 0x13, 0xDF, 0x94, 0x7E, 
+0x2D, 0xFD, 0xE2, 0x60, 0x79, 0x1E, 0x2E, 0x51, 
+0xA3, 0x57, 0x8A, 0xC7, 0x68, 0x3E, 0x59, 0x52, 
+0x56, 0xF0, 0x20, 0x1F, 0xCC, 0x76, 0x40, 0x73, 
+0x90, 0xEC, 0x17, 0x4A, 0x86, 0x68, 0x10, 0xA5, 
+0x92, 0x60, 0xC2, 0x74, 0x55, 0x2A, 0x1B, 0x34, 
+0xB4, 0x3F, 0xEB, 0xF1, 0xB0, 0x75, 0x4C, 0x79, 
+0x71, 0x66, 0x99, 0x8E, 0xF9, 0xCA, 0x00, 0x39, 
+0x5E, 0x66, 0xE7, 0xDF, 0x75, 0x6E, 0xE7, 0xED, 
+0xE3, 0x51, 0x4D, 0x1F, 0x57, 0xBD, 0x4C, 0x16, 
+0x8D, 0x17, 0x83, 0x50, 0xCB, 0x88, 0xDF, 0xE1, 
+0x13, 0xC2, 0xD7, 0x52, 0x78, 0x30, 0x40, 0x0B, 
+0x9D, 0xBE, 0x4C, 0x22, 0x68, 0xA8, 0x1F, 0xBB, 
+0x26, 0xE8, 
+
+0xD2, 0xC7, 0x6A, 0xEA, 0x75, 0x91, 0x35, 0x3E, 
+0xDB, 0xEE, 0xD0, 0x32, 0xC4, 0x35, 0x67, 0x40, 
+0x1B, 0x22, 0x57, 0xAD, 0xD3, 0xC2, 0x70, 0x6B, 
+0xBF, 0x8A, 0xE7, 0xBA, 0x3F, 0x92, 0x9C, 0xD1, 
+0xA3, 0x44, 0x5A, 0xF8, 0xDF, 0xC6, 0x71, 0x05, 
+0x10, 0x7E, 0x1E, 0x11, 0xB2, 0xA0, 0x06, 0xCC, 
+0xEE, 0xE9, 0xF0, 0xAB, 0xDE, 0xA6, 0x2E, 0x54, 
+0x7D, 0x22, 0x01, 0x37, 0x36, 0xDF, 0x59, 0xAE, 
+0xB8, 0x3A, 0x29, 0xBF, 0x76, 0x14, 0x6B, 0xB1, 
+0x2E, 0xF3, 0x2A, 0x17, 0x4C, 0x15, 0xF4, 0xBE, 
+0x2F, 0x53, 0x7D, 0x2A, 0x62, 0xD6, 0xC5, 0x5A, 
+0x39, 0xD3, 0x87, 0x4D, 0x89, 0x18, 0x1A, 0xB7, 
+0xE7, 0x32, 0x22, 0x22, 0x47, 0x60, 0x54, 0xD1, 
+0x9D, 0xBA, 0x14, 0x72, 0xBC, 0x26, 0x94, 0x04, 
+0xC2, 0xFA, 0xBE, 0x40, 0xBD, 0x9F, 0xB9, 0xCB, 
+0x71, 0x72, 0x73, 0xF8, 0x0B, 0xAB, 0x2C, 0xFF, 
+0x71, 0x59, 0x35, 0xEA, 0xF5, 0x43, 0x5E, 0x4D, 
+0x19, 0x65, 0xF4, 0x30, 0x58, 0xAB, 0xC6, 0xE7, 
+0xEE, 0x64, 0xCC, 0xA8, 0xC0, 0xEA, 0x2B, 0xE7, 
+0x53, 0x4D, 0x74, 0x9F, 0x01, 0xE5, 0xEE, 0x29, 
+0xF8, 0x00, 0xB6, 0x88, 0x8B, 0x24, 0x66, 0x58, 
+0x5C, 0xC5, 0xC7, 0xBC, 0x34, 0xF8, 0xAE, 0xC8, 
+0x35, 0xF6, 0xF7, 0x13, 0xAF, 0x29, 0x85, 0xBA, 
+0x66, 0x52, 0xDB, 
+
+0x73, 0x21, 0x10, 0x08, 0xB2, 0x9D, 0xBC, 0x9A, 
+0xE0, 0xD3, 0x04, 0xE3, 0xEE, 0xF1, 0xAF, 0x38, 
+0xD1, 0x72, 0x53, 0x37, 0xC6, 0xC8, 0x6C, 0xC4, 
+0xE6, 0xA3, 0x3B, 0x30, 0xD8, 0x8A, 0xFA, 0xFF, 
+0x9E, 0x28, 0x11, 0x44, 0x98, 0xCF, 0xA6, 0x1F, 
+0xEF, 0xDF, 0xB6, 0xC2, 0x6E, 0xD2, 0xBD, 0x8E, 
+0xF0, 0x93, 0x29, 0x83, 0x52, 0x8C, 0x63, 0x4C, 
+0xC6, 0x2B, 0x83, 0x79, 0x99, 0x3E, 0x2B, 0xE2, 
+0xB2, 0x3B, 0x37, 0x86, 0xAB, 0x99, 0xB5, 0xDA, 
+0x08, 0xB7, 0xCC, 0x23, 0x9B, 0x2E, 0x43, 0x02, 
+0x3E, 0x5D, 0xD2, 0x82, 0xB2, 0x8E, 0x6B, 0x3E, 
+0xE2, 0xD8, 0x9A, 0x83, 0x3C, 0xE0, 0xE4, 0xF8, 
+0x31, 0x3F, 0x11, 0xE6, 0x73, 0x25, 0x6D, 0x81, 
+0xE8, 0xB3, 0xEB, 0x74, 0x08, 0xA8, 0xF2, 0x32, 
+0x08, 0x05, 0xF7, 0x4F, 0xD3, 0x8D, 0xCA, 0x7B, 
+0xB6, 0x7E, 0xAC, 0xA4, 0x7F, 0x8B, 0x52, 0x97, 
+0xA9, 0x8C, 0x4F, 0x20, 0x85, 0x7C, 0x42, 0x98, 
+0x88, 0x06, 0xF4, 0x8F, 0x75, 0xC1, 0xCC, 0xAF, 
+0x99, 0x90, 0xF1, 0x71, 0xA6, 0x51, 0x0C, 0x71, 
+0x49, 0x69, 0x90, 0xDA, 0x78, 0x8C, 0xE7, 0x02, 
+0x5A, 0x67, 0x1B, 0xF2, 0xE5, 0xC9, 0x54, 0xD9, 
+0xEC, 0xCF, 0x37, 0x29, 0xB8, 0x22, 0x0E, 0x83, 
+0xC7, 0xF8, 0xF3, 0x3E, 0x11, 0x76, 0x50, 0xFC, 
+0xD3, 0xBC, 0xF5, 0xA7, 
+
+0x88, 0x64, 0xA0, 0xB1, 0xE1, 0x69, 0xF9, 0xD6, 
+0x2C, 0x3D, 0x98, 0xBB, 0x72, 0xC2, 0xC4, 0xB2, 
+0xD3, 0xB6, 0x63, 0x41, 0x96, 0x36, 0x8C, 0xD6, 
+0xEA, 0x3B, 0x0D, 0x92, 0x68, 0x7A, 0x3B, 0x8C, 
+0x94, 0xD3, 0x35, 0x84, 0xCA, 0x32, 0xE8, 0x2A, 
+0x4B, 0x30, 0x02, 0xE7, 0x75, 0x27, 0x22, 0xC8, 
+0x28, 0x06, 0x20, 0xA9, 0xB7, 0xEB, 0x8A, 0x13, 
+0xAA, 0x9F, 0xC8, 0xB6, 0x61, 0x5B, 0xD3, 0xBB, 
+0x1B, 0x0B, 0x4F, 0x66, 0xCC, 0x08, 0xB6, 0x5B, 
+0x44, 0x4E, 0x06, 0xC7, 0xF5, 0xC0, 0xF0, 0xA0, 
+0xD1, 0x32, 0x3A, 0xD1, 0xAC, 0xC9, 0x52, 0x6D, 
+0xA0, 0x90, 0x7C, 0xD1, 0x90, 0x7F, 0x53, 0xAD, 
+0xC1, 0x0C, 0xAA, 0xD5, 0xA4, 0x4C, 0x86, 0xC8, 
+0xB2, 0x5B, 0x08, 0x66, 0xA0, 0xF9, 0x1A, 0xEB, 
+0xAF, 0x96, 0x96, 0xF4, 0xEC, 0xEC, 0x23, 0x06, 
+0xD9, 0x65, 0xF4, 0x2B, 0xC1, 0x96, 0x3F, 0x3B, 
+0x8F, 0x5B, 0x51, 0xA4, 0x55, 0x89, 0x6A, 0x8F, 
+0x55, 0xDE, 0xCA, 0xD8, 0x16, 0x0C, 0x45, 0xB7, 
+0xC6, 0x8D, 0x64, 0x74, 0x0C, 0xA6, 0x12, 0xEF, 
+0x81, 0x19, 0x70, 0xBD, 0xA6, 0x64, 0x74, 0x70, 
+0xD3, 0xB1, 0x03, 0xBD, 0x55, 0x6A, 0xAE, 0xBE, 
+0xDE, 0xE3, 0x09, 0x0F, 0x90, 0x41, 0xCF, 0x26, 
+0x82, 0x88, 0x97, 0x1D, 0x98, 0xF2, 0x18, 0x87, 
+0xE9, 0xD9, 0x9B, 0x08, 0x1E, 
+
+0xDD, 0x0E, 0x2A, 0x0F, 0xF8, 0x16, 0x1F, 0x33, 
+0xC9, 0x8B, 0x87, 0x99, 0x48, 0x1E, 0x46, 0x77, 
+0xF2, 0xCD, 0x9E, 0x72, 0xFD, 0x65, 0x6E, 0xAF, 
+0x78, 0x8C, 0xD5, 0xB0, 0xD4, 0x7E, 0x37, 0x0B, 
+0x4D, 0x76, 0xA4, 0x74, 0xE8, 0x63, 0x2A, 0xAF, 
+0x49, 0x2E, 0x90, 0x95, 0x23, 0x9D, 0x14, 0x52, 
+0xE4, 0x0B, 0x7C, 0x81, 0xFC, 0x00, 0xE0, 0x23, 
+0xCA, 0x6B, 0xC1, 0x13, 0xB2, 0x9C, 0x51, 0xD0, 
+0x67, 0x67, 0x8C, 0x38, 0xB7, 0x43, 0x01, 0x5A, 
+0xB0, 0xAC, 0x7B, 0xD8, 0x2B, 0x4F, 0x12, 0x75, 
+0x25, 0xC1, 0xE2, 0x3F, 0x3B, 0xBC, 0xAC, 0x99, 
+0xD6, 0x57, 0xAC, 0x15, 0x8F, 0x08, 0x9C, 0x96, 
+0xFF, 0x84, 0xB8, 0x4A, 0xD4, 0x44, 0xB0, 0x93, 
+0xFF, 0xF4, 0xD7, 0x25, 0x27, 0x93, 0x55, 0x5B, 
+0x2A, 0x00, 0x3B, 0x70, 0xA7, 0x2B, 0xE5, 0x36, 
+0xA0, 0x75, 0xD1, 0x8C, 0x81, 0x61, 0x8C, 0xAE, 
+0x2B, 0xC6, 0x02, 0xED, 0xAF, 0xD7, 0x1C, 0x15, 
+0x69, 0xE0, 0xD8, 0x59, 0xA2, 0x0C, 0x16, 0x60, 
+0x50, 0xBF, 0x51, 0xE6, 0x62, 0xFC, 0x64, 0x1C, 
+0x9D, 0xCD, 0xEB, 0x25, 0xD6, 0x7D, 0x7F, 0xFA, 
+0xE5, 0xA8, 0x3D, 0x6B, 0x3A, 0x10, 0x0B, 0x06, 
+0xED, 0xF5, 0xB7, 0xE0, 0xA6, 0x41, 0x0C, 0x9B, 
+0xEE, 0xD1, 0x01, 0x5B, 0xC2, 0x61, 0x1F, 0xF3, 
+0x69, 0x34, 0x62, 0x0C, 0x4B, 0xA7, 
+
+0x5F, 0xC6, 0x27, 0x00, 0x33, 0x38, 0xB6, 0x19, 
+0xB5, 0xED, 0xC7, 0xAE, 0x9A, 0x5C, 0xA8, 0x12, 
+0xDE, 0xDA, 0x9E, 0x8F, 0x0D, 0x72, 0x6E, 0xEC, 
+0x9E, 0x5D, 0xBA, 0xBF, 0x62, 0xB5, 0x75, 0xC5, 
+0x8D, 0x98, 0x04, 0xCF, 0x8E, 0x60, 0xA4, 0x01, 
+0xF6, 0x16, 0x65, 0x2E, 0x40, 0x43, 0x51, 0x8D, 
+0x90, 0xC1, 0xC1, 0xA9, 0xE4, 0x6C, 0xD1, 0xBC, 
+0x99, 0xE8, 0x2B, 0xEE, 0xEB, 0xFA, 0x7F, 0xF8, 
+0x1E, 0xC5, 0x9E, 0x89, 0xE5, 0x96, 0x60, 0xC7, 
+0x04, 0xB4, 0xD4, 0x2E, 0xF0, 0x4F, 0xD5, 0xA3, 
+0xBF, 0x07, 0x1C, 0x2F, 0xF5, 0xC5, 0xCB, 0x78, 
+0xA7, 0xDE, 0x36, 0xA8, 0x10, 0xCC, 0xFE, 0x98, 
+0xF4, 0xE0, 0x9F, 0x9A, 0x13, 0xBE, 0xDD, 0x44, 
+0xA5, 0x8B, 0x6E, 0x48, 0xF1, 0xF2, 0xCD, 0x6B, 
+0xB4, 0x9F, 0x70, 0x8D, 0xC2, 0xE2, 0xA9, 0x14, 
+0x44, 0x47, 0xAE, 0xA6, 0x46, 0x99, 0xA8, 0x80, 
+0xBC, 0x8B, 0x21, 0x5C, 0xEF, 0x1E, 0x4D, 0xF5, 
+0xA7, 0x65, 0x97, 0x61, 0x9B, 0x84, 0x70, 0x9A, 
+0x6F, 0xC7, 0x56, 0x57, 0x14, 0xB3, 0xE3, 0xA3, 
+0x76, 0x92, 0x97, 0x4B, 0x4D, 0x47, 0x70, 0x74, 
+0xA3, 0x00, 0x8E, 0x03, 0xBC, 0x73, 0x57, 0xB6, 
+0xA0, 0x1C, 0x89, 0x13, 0x9D, 0x08, 0x66, 0x7C, 
+0xC9, 0x8E, 0x3C, 0x16, 0xE0, 0x47, 0xEA, 0x99, 
+0x66, 0x02, 0xB2, 0x78, 0xD0, 0x1A, 0xCF, 
+
+0xB7, 0x6A, 0x6E, 0x03, 0xCA, 0x59, 0x5F, 0xCA, 
+0xAB, 0xAC, 0x2A, 0xBF, 0x97, 0x76, 0xA3, 0x90, 
+0x83, 0x1D, 0xD7, 0x33, 0x4C, 0xB8, 0x07, 0x0E, 
+0x5E, 0x20, 0x8A, 0xA4, 0x39, 0x00, 0x02, 0x77, 
+0x91, 0x18, 0xEF, 0x59, 0x4D, 0xFD, 0xE6, 0x6D, 
+0xF9, 0xCA, 0xA5, 0x50, 0x3D, 0x79, 0x9A, 0x18, 
+0x9A, 0x12, 0x8D, 0x80, 0xDF, 0x2D, 0xDE, 0x42, 
+0x0D, 0x7A, 0x8C, 0xF8, 0x52, 0x52, 0xFB, 0x95, 
+0xE6, 0x95, 0x84, 0xCD, 0x06, 0xB1, 0xAE, 0x2F, 
+0x08, 0x45, 0x9E, 0x64, 0x24, 0x45, 0x06, 0xA9, 
+0x94, 0x81, 0xAA, 0x78, 0xBA, 0x8D, 0x2C, 0x00, 
+0x5A, 0x1E, 0x48, 0x9A, 0x8C, 0xDB, 0x82, 0xDB, 
+0x7F, 0x17, 0xF1, 0xBF, 0x49, 0x7B, 0x6B, 0xEB, 
+0xC4, 0xBA, 0xA2, 0x2D, 0x9E, 0xE1, 0xC5, 0xA6, 
+0xA3, 0x52, 0x1C, 0xAE, 0x0C, 0xA5, 0xE7, 0x5A, 
+0xD8, 0x4B, 0x16, 0x34, 0x0E, 0x08, 0x2F, 0x77, 
+0x5C, 0x9B, 0xA3, 0xF9, 0x5D, 0xE6, 0x29, 0x16, 
+0x30, 0x03, 0xBB, 0x0F, 0x1C, 0xD0, 0x75, 0x7A, 
+0xF3, 0x24, 0xF5, 0xFE, 0xE5, 0x39, 0x27, 0xAE, 
+0xE7, 0x2D, 0xE3, 0x00, 0x9C, 0x74, 0xBD, 0x28, 
+0x9C, 0x89, 0xD3, 0xD7, 0xD1, 0x43, 0xC2, 0x73, 
+0x24, 0x6F, 0xC6, 0x7B, 0xEC, 0x8D, 0x01, 0x40, 
+0x41, 0xA0, 0x87, 0xB4, 0x97, 0x70, 0x36, 0xF7, 
+0x0F, 0x99, 0x5B, 0x71, 0xE7, 0x9B, 0x6F, 0x5D, 
+
+0x77, 0x30, 0x9D, 0xC1, 0x80, 0x21, 0x7A, 0x00, 
+0x65, 0xCF, 0x6A, 0xDC, 0xA3, 0xD3, 0x83, 0xED, 
+0xCE, 0x4C, 0xD4, 0x4B, 0xCB, 0x71, 0x8B, 0xBA, 
+0x47, 0x24, 0x61, 0x7C, 0x08, 0xCC, 0xDF, 0x1B, 
+0xF3, 0xEC, 0x61, 0x0E, 0x16, 0x8A, 0x64, 0x32, 
+0x41, 0x21, 0x36, 0x68, 0xD9, 0xEB, 0x44, 0x79, 
+0x0C, 0x7B, 0xBC, 0x69, 0x49, 0xA0, 0x20, 0x1E, 
+0xE3, 0x2A, 0x6C, 0xC9, 0xCA, 0xD2, 0x25, 0x2A, 
+0x28, 0x5B, 0x11, 0xEC, 0xF8, 0xE7, 0xC0, 0x46, 
+0xF8, 0xC8, 0x0B, 0x13, 0x9C, 0x54, 0x52, 0xCD, 
+0x53, 0x31, 0x5D, 0xCB, 0x3D, 0xD0, 0x54, 0x4E, 
+0x8B, 0xA1, 0xF2, 0x52, 0x89, 0x48, 0x6D, 0xFB, 
+0xE0, 0x1F, 0x3B, 0xF8, 0xE9, 0x6F, 0xFF, 0x65, 
+0x05, 0xC2, 0x32, 0x0A, 0xF3, 0x8B, 0x38, 0x13, 
+0xEA, 0xF9, 0x36, 0x8B, 0x37, 0x44, 0x06, 0xF9, 
+0xE1, 0x58, 0x13, 0xC7, 0xC8, 0x6A, 0x72, 0xE1, 
+0x5B, 0x60, 0x5D, 0xEC, 0xB4, 0x35, 0x22, 0x65, 
+0x98, 0x7C, 0xFF, 0x0B, 0xB4, 0x24, 0x8B, 0xE6, 
+0xFC, 0x98, 0xDC, 0xEA, 0xC4, 0x44, 0xFF, 0x5F, 
+0x22, 0x53, 0x50, 0x8F, 0x00, 0xA4, 0x52, 0x9A, 
+0xA7, 0xDB, 0x5C, 0x98, 0x70, 0xEF, 0x67, 0x23, 
+0xAC, 0x30, 0xE8, 0x17, 0x47, 0xC7, 0x52, 0x4F, 
+0x7B, 0x93, 0xD0, 0xA3, 0x95, 0x99, 0x19, 0xC7, 
+0xF3, 0x81, 0xB7, 0xCA, 0x4C, 0x37, 0xB0, 0x36, 
+0xF3, 
+
+0x8D, 0xB9, 0x63, 0x9C, 0x5A, 0x92, 0xC8, 0x66, 
+0x06, 0x3A, 0x0B, 0x73, 0x31, 0xA4, 0xF4, 0x42, 
+0xE1, 0x77, 0xBB, 0xAF, 0xF1, 0x39, 0xE1, 0x38, 
+0x3C, 0xC3, 0xED, 0x29, 0x2E, 0x8B, 0xCA, 0x2C, 
+0x5C, 0x24, 0x16, 0x26, 0xD9, 0x93, 0x98, 0x74, 
+0x2B, 0x3E, 0x76, 0x0F, 0xE9, 0x17, 0x7C, 0x5F, 
+0x0D, 0xA1, 0x89, 0x73, 0x5D, 0xBD, 0x9F, 0x94, 
+0x21, 0xDF, 0xFE, 0x90, 0xEB, 0x35, 0x85, 0xA3, 
+0x63, 0x68, 0x02, 0xED, 0xB2, 0xCB, 0x37, 0x3A, 
+0xB9, 0x0E, 0x67, 0x19, 0xA3, 0xAC, 0xD6, 0x4C, 
+0x6D, 0x62, 0x13, 0x1A, 0xA1, 0xC8, 0x4E, 0x08, 
+0xF1, 0x5F, 0xC9, 0x14, 0x7E, 0xB6, 0xC8, 0x45, 
+0x0D, 0xB3, 0xEC, 0xA6, 0x23, 0x7B, 0x83, 0xD2, 
+0x7C, 0xCD, 0x86, 0x60, 0x87, 0xAF, 0x85, 0xA8, 
+0x80, 0xD0, 0xD2, 0xA4, 0xE6, 0x88, 0xB0, 0xC4, 
+0x3B, 0x97, 0x65, 0xE2, 0xF7, 0xE6, 0xFE, 0x68, 
+0x5E, 0x37, 0x9C, 0x24, 0x94, 0x31, 0x83, 0xF6, 
+0xA0, 0xBD, 0x84, 0xEE, 0xA3, 0x41, 0x49, 0x70, 
+0x93, 0xFE, 0xFF, 0xB3, 0xB2, 0x77, 0x07, 0x3D, 
+0x8A, 0xDB, 0x11, 0xB7, 0xF1, 0x1D, 0x15, 0x13, 
+0x2B, 0x4B, 0xC5, 0x7F, 0x5B, 0x65, 0x44, 0x3E, 
+0x84, 0x47, 0x8D, 0x13, 0xF9, 0x53, 0x29, 0xF1, 
+0x2C, 0x9C, 0x38, 0xEE, 0xED, 0x85, 0x8E, 0xC6, 
+0x58, 0x8C, 0xB0, 0x3C, 0x5D, 0xE0, 0x60, 0x3F, 
+0x15, 0xB6, 
+
+0xC0, 0x8E, 0x95, 0xCD, 0xD4, 0xB8, 0x4A, 0x76, 
+0x55, 0xC8, 0x09, 0xB6, 0x4E, 0x80, 0x06, 0xA0, 
+0xB3, 0x09, 0xE1, 0xA8, 0xA1, 0x81, 0x05, 0x9E, 
+0xCD, 0x7F, 0x54, 0xAD, 0xCE, 0xCA, 0x30, 0x8A, 
+0xDC, 0x07, 0xD5, 0xC9, 0x5D, 0x08, 0x9F, 0x7D, 
+0xBB, 0xA0, 0x92, 0xB5, 0xFF, 0xD0, 0x85, 0x82, 
+0x91, 0x12, 0x84, 0x94, 0xA1, 0x8F, 0x71, 0xE5, 
+0xDE, 0xD7, 0x64, 0xF0, 0x67, 0x19, 0xCC, 0x55, 
+0xCD, 0x88, 0x8A, 0x99, 0x69, 0x5F, 0x36, 0xCC, 
+0x1F, 0xC0, 0xE2, 0xD9, 0x23, 0xAE, 0x90, 0x00, 
+0x16, 0xAD, 0x7A, 0xC9, 0xAB, 0x3B, 0x12, 0x86, 
+0xB7, 0x25, 0x6D, 0xE4, 0xDE, 0x14, 0x98, 0x81, 
+0xCE, 0x8F, 0xAC, 0x61, 0xE6, 0x0D, 0x9D, 0x91, 
+0x64, 0x65, 0x88, 0xF3, 0xA2, 0x77, 0xD4, 0x83, 
+0xFE, 0xEE, 0xE8, 0x21, 0x63, 0x38, 0x7A, 0x2E, 
+0xBA, 0x3B, 0x4D, 0x10, 0x4F, 0x04, 0x3A, 0x9D, 
+0xF8, 0x99, 0xBC, 0xC2, 0x9A, 0x1E, 0x24, 0xC9, 
+0x32, 0x7D, 0xB7, 0xF7, 0x10, 0xC1, 0xD0, 0x60, 
+0xA9, 0xDF, 0x94, 0x09, 0xA7, 0x10, 0x43, 0xCE, 
+0x94, 0x50, 0x7D, 0x47, 0xCE, 0xBE, 0x17, 0xA4, 
+0x27, 0x7B, 0x50, 0x96, 0xF6, 0xEE, 0xC8, 0x96, 
+0xB3, 0xD6, 0xC5, 0xE1, 0xA3, 0xD4, 0xD9, 0x69, 
+0x94, 0x73, 0x50, 0x9A, 0x38, 0xE4, 0xA4, 0x50, 
+0x8E, 0xEB, 0x70, 0xBD, 0x30, 0x1A, 0x04, 0xE8, 
+0x00, 0xDE, 0xC9, 
+
+0x1C, 0xF3, 0x6E, 0xFD, 0x8D, 0x25, 0x6E, 0x50, 
+0xCC, 0x3F, 0x7D, 0x35, 0x3F, 0x2D, 0xEB, 0x64, 
+0x51, 0x30, 0xBC, 0xD6, 0xB7, 0xB7, 0xE5, 0x48, 
+0x18, 0x01, 0xCF, 0x90, 0x6E, 0x1D, 0xCD, 0x18, 
+0x22, 0x75, 0xAF, 0x86, 0x25, 0x7B, 0x2A, 0x39, 
+0xF3, 0xF3, 0x2A, 0xDD, 0x1A, 0xD5, 0xC6, 0xB9, 
+0x5B, 0xCB, 0x03, 0xAB, 0xB0, 0x06, 0xDE, 0xB1, 
+0xEC, 0x0D, 0x85, 0x1A, 0xAD, 0x06, 0x34, 0xC8, 
+0xED, 0x31, 0xFD, 0xB1, 0x84, 0x4C, 0x0E, 0xE7, 
+0x54, 0x93, 0xBC, 0xE8, 0x7B, 0x63, 0x47, 0x05, 
+0xAF, 0x46, 0xB2, 0xB8, 0x7F, 0x94, 0x7F, 0xAC, 
+0x59, 0x41, 0x90, 0x8F, 0x10, 0x11, 0x56, 0xB0, 
+0x54, 0x5B, 0xB3, 0x33, 0x72, 0xC2, 0x6D, 0xAC, 
+0x3A, 0xC0, 0x99, 0xEE, 0x72, 0x01, 0x46, 0x3D, 
+0x19, 0xBB, 0x61, 0xD3, 0x41, 0x58, 0xFD, 0x4A, 
+0x7C, 0x8E, 0xB0, 0xF0, 0x16, 0x84, 0x0E, 0x65, 
+0xFE, 0x43, 0x93, 0x4C, 0xDD, 0xE8, 0x25, 0xB5, 
+0x2F, 0x0F, 0x58, 0xEB, 0x43, 0xCE, 0xBE, 0xC5, 
+0x0A, 0xB9, 0xFA, 0x5B, 0xE9, 0x92, 0x5E, 0x13, 
+0x24, 0x55, 0xA2, 0xAF, 0x0F, 0xD4, 0x1C, 0x2E, 
+0x3A, 0x93, 0xF3, 0x91, 0x20, 0x17, 0x22, 0xA7, 
+0x5D, 0xE7, 0xC4, 0xE6, 0xC3, 0x63, 0x2C, 0xC1, 
+0x01, 0xE9, 0x91, 0x8C, 0xB6, 0x5E, 0x72, 0x2B, 
+0xC7, 0x6D, 0xC4, 0x19, 0xF4, 0x34, 0x04, 0xC5, 
+0xFF, 0x66, 0x92, 0xC8, 
+
+0xC3, 0x65, 0x34, 0xF1, 0x09, 0x34, 0x5E, 0xED, 
+0x6B, 0x18, 0xB7, 0x65, 0x81, 0x19, 0x8C, 0x7E, 
+0xC4, 0xB4, 0x04, 0x96, 0x29, 0x7F, 0xF8, 0xD3, 
+0x21, 0x66, 0xCC, 0x2A, 0xA9, 0xE1, 0xF1, 0x79, 
+0xA2, 0x8B, 0xD4, 0xD6, 0x89, 0xAE, 0xA0, 0xA9, 
+0xD3, 0x8A, 0x45, 0x6B, 0xE0, 0x7F, 0xED, 0xAB, 
+0x50, 0x7D, 0x93, 0x1E, 0x13, 0x94, 0xDE, 0x3C, 
+0xF2, 0x55, 0x2E, 0x6F, 0xBC, 0x24, 0x4A, 0xF6, 
+0x8C, 0xC5, 0x6D, 0x1D, 0xF2, 0xB2, 0x2E, 0x3E, 
+0x8C, 0x6A, 0x8C, 0x4A, 0xC5, 0xDD, 0xB1, 0x24, 
+0x5A, 0x54, 0x8B, 0xF4, 0x40, 0x59, 0x79, 0x19, 
+0xFD, 0xBC, 0x3A, 0x3E, 0x04, 0x03, 0xEF, 0x49, 
+0x26, 0x0E, 0xF9, 0x41, 0x3E, 0x75, 0x02, 0x83, 
+0xC5, 0xCF, 0xC1, 0x3C, 0x38, 0xC6, 0x06, 0x4A, 
+0x88, 0xE8, 0x3C, 0xFF, 0xAB, 0x98, 0x00, 0x83, 
+0x06, 0x3B, 0x40, 0x00, 0x18, 0x48, 0xF3, 0x00, 
+0xBB, 0x81, 0x16, 0x62, 0xFF, 0xC2, 0xCB, 0xF5, 
+0x15, 0xE1, 0x9D, 0xC0, 0x7D, 0xF0, 0x53, 0x9A, 
+0x12, 0x67, 0x82, 0xBB, 0x2A, 0xFC, 0x9B, 0x79, 
+0x00, 0xED, 0x1D, 0x4D, 0x10, 0x6F, 0xB6, 0x64, 
+0x46, 0xFB, 0x04, 0xFF, 0x9C, 0x9F, 0xEE, 0x38, 
+0x9E, 0x0F, 0xE2, 0xCC, 0x13, 0xBC, 0xDA, 0x83, 
+0x66, 0x51, 0xC9, 0xD1, 0x54, 0x45, 0xD8, 0xE0, 
+0xBE, 0xF0, 0xE1, 0x12, 0x65, 0x0E, 0xFF, 0x20, 
+0x8C, 0x15, 0xEC, 0x45, 0xA4, 
+
+0xA4, 0x7A, 0x93, 0x1E, 0x94, 0x66, 0x8E, 0x82, 
+0x8F, 0x75, 0xD0, 0x7B, 0x15, 0x77, 0xA9, 0x55, 
+0x51, 0x63, 0x5B, 0xDC, 0x42, 0x37, 0x1A, 0xB1, 
+0x9C, 0x0B, 0x68, 0xC1, 0x76, 0x31, 0x9C, 0xE1, 
+0x9B, 0x98, 0x00, 0xB2, 0x16, 0xEA, 0x61, 0x18, 
+0x95, 0x7A, 0xE5, 0x5A, 0xE1, 0xAC, 0x0B, 0x92, 
+0x87, 0xEC, 0x87, 0x4E, 0xA1, 0x1B, 0x39, 0xCF, 
+0x95, 0x43, 0xCA, 0x0F, 0x77, 0xBB, 0x6A, 0x60, 
+0x5C, 0x39, 0xAD, 0xC4, 0x6A, 0xFE, 0x1B, 0x89, 
+0xD2, 0x3D, 0x4C, 0x64, 0x78, 0xC0, 0xBE, 0x0E, 
+0x92, 0x14, 0xA5, 0x54, 0x2E, 0x2E, 0x32, 0x00, 
+0xA3, 0xBF, 0x9E, 0xDE, 0xF1, 0xF7, 0x59, 0xC2, 
+0x0A, 0x28, 0x0E, 0x2B, 0xC3, 0x9C, 0x7D, 0x39, 
+0x96, 0x1F, 0x11, 0x97, 0xF6, 0xEC, 0x5A, 0xC5, 
+0xCC, 0xC9, 0x66, 0x34, 0xE9, 0xE1, 0xD0, 0x4E, 
+0x32, 0xB0, 0xDA, 0x45, 0x52, 0xC4, 0x3C, 0xCD, 
+0x95, 0x15, 0x6E, 0xDA, 0xC2, 0x76, 0x68, 0xC1, 
+0x33, 0x37, 0x3C, 0xB0, 0x45, 0x69, 0xC0, 0x13, 
+0x0F, 0xE0, 0x15, 0x7A, 0xEC, 0xA6, 0x13, 0x19, 
+0xF2, 0x9F, 0xA5, 0x2D, 0xAF, 0x36, 0x8D, 0x43, 
+0xBD, 0xE1, 0x5C, 0x11, 0xB0, 0x2B, 0x44, 0x11, 
+0xBB, 0x5E, 0xC0, 0x3F, 0x5D, 0x4C, 0x57, 0x00, 
+0x97, 0xB6, 0x3D, 0xBA, 0x88, 0x4D, 0x44, 0xDF, 
+0x2E, 0x8A, 0x21, 0xD0, 0x07, 0x6A, 0x74, 0xEA, 
+0xFA, 0x84, 0x67, 0xAA, 0x09, 0x69, 
+
+0x21, 0xA7, 0x37, 0xF4, 0x86, 0x9B, 0x1F, 0x51, 
+0x9C, 0x6E, 0xD1, 0x3B, 0xEF, 0x11, 0x88, 0xEA, 
+0xCE, 0x61, 0xBE, 0x50, 0xE7, 0xC1, 0x77, 0x01, 
+0xE6, 0x79, 0x6D, 0x32, 0x1F, 0xFB, 0x5F, 0x77, 
+0xF8, 0x44, 0x2A, 0x96, 0xC0, 0x8D, 0x93, 0x16, 
+0x19, 0x52, 0x83, 0xBF, 0xED, 0x9F, 0xE6, 0x0C, 
+0x5B, 0xD4, 0x5C, 0xEA, 0x82, 0xAB, 0x57, 0x71, 
+0xAF, 0x67, 0x85, 0x1E, 0x64, 0xAF, 0x39, 0x21, 
+0xE1, 0x57, 0x97, 0x0B, 0x78, 0xAF, 0x86, 0xB4, 
+0x56, 0x19, 0x25, 0xF5, 0x72, 0x8C, 0xEB, 0x8B, 
+0xA8, 0xF0, 0xFE, 0x04, 0x95, 0x93, 0x01, 0x40, 
+0x14, 0x32, 0x8B, 0xD0, 0xCA, 0xAB, 0x0B, 0x20, 
+0x1E, 0x68, 0xA2, 0x9B, 0xD9, 0x8E, 0x85, 0xBA, 
+0x08, 0x8E, 0x53, 0x81, 0x06, 0xA2, 0x1D, 0x63, 
+0xEF, 0xE6, 0x40, 0x01, 0xCC, 0xF6, 0x82, 0x5A, 
+0x46, 0x67, 0x13, 0x0F, 0x37, 0xBC, 0x6F, 0xC0, 
+0x4C, 0xAB, 0xA7, 0x3A, 0x3B, 0x02, 0x26, 0xC6, 
+0xEA, 0xD1, 0xCB, 0xE4, 0x25, 0x80, 0x75, 0x58, 
+0x52, 0x7D, 0x4E, 0x70, 0x72, 0x9B, 0x6E, 0x1D, 
+0x33, 0x3F, 0xFD, 0xFE, 0x92, 0xF2, 0xD3, 0x15, 
+0x79, 0x98, 0xAF, 0x10, 0x5A, 0x0D, 0xAF, 0x36, 
+0x39, 0x14, 0x85, 0xDF, 0x01, 0xF2, 0xF7, 0x30, 
+0xEE, 0x76, 0xF9, 0xD6, 0x93, 0x26, 0x1F, 0xAF, 
+0x81, 0xF4, 0x20, 0x30, 0x5F, 0xF1, 0xD7, 0xA8, 
+0xDD, 0x3D, 0x6E, 0x71, 0x09, 0x3E, 0xFD, 
+
+0x66, 0x8E, 0x5C, 0x35, 0x42, 0x81, 0x61, 0x88, 
+0x97, 0x36, 0x3F, 0x08, 0xCF, 0xE7, 0xE4, 0x33, 
+0xE3, 0xC8, 0x18, 0x82, 0x2A, 0xB1, 0xCE, 0x00, 
+0xF7, 0x8D, 0xE1, 0x4A, 0x95, 0x64, 0x9A, 0xF3, 
+0x29, 0x19, 0x3F, 0xAD, 0xD5, 0xFD, 0x0D, 0xEE, 
+0x2E, 0x83, 0x9A, 0x44, 0xFA, 0x02, 0x79, 0x22, 
+0x9D, 0x81, 0x4D, 0x22, 0xF8, 0x17, 0x2C, 0x1C, 
+0xC7, 0xEC, 0xE4, 0x17, 0x96, 0x72, 0x6F, 0x0E, 
+0x0D, 0xE8, 0x20, 0x4D, 0x80, 0xAD, 0xAF, 0x2E, 
+0xC3, 0x96, 0x60, 0x9A, 0xF1, 0x8A, 0x9D, 0xF7, 
+0x0B, 0xCA, 0x5F, 0x60, 0x4B, 0xF8, 0x6E, 0x71, 
+0x08, 0x6A, 0x0A, 0xF3, 0x5D, 0x52, 0xA2, 0x0E, 
+0xE4, 0x0E, 0x11, 0xDF, 0xEA, 0x41, 0xB6, 0xB4, 
+0xA7, 0xA7, 0xE8, 0xE0, 0xAC, 0x93, 0x81, 0x12, 
+0xF4, 0x4A, 0x57, 0x04, 0xA0, 0x41, 0xB9, 0xA4, 
+0xB4, 0x56, 0x44, 0xAD, 0xB0, 0xEC, 0xBE, 0xA2, 
+0x11, 0x2B, 0xC7, 0x94, 0x76, 0x48, 0xCD, 0x38, 
+0x73, 0xB1, 0x7B, 0x71, 0xAD, 0x8B, 0xDE, 0x2D, 
+0xE1, 0x7B, 0x21, 0xF6, 0x36, 0x2F, 0x3C, 0x97, 
+0x36, 0x55, 0x67, 0xA0, 0x1E, 0xD7, 0x5C, 0x7F, 
+0x88, 0x69, 0x97, 0x32, 0xAB, 0xC7, 0x0C, 0x3A, 
+0x5F, 0x8C, 0xBA, 0x7F, 0x92, 0x50, 0xC9, 0xC7, 
+0x92, 0x20, 0x1E, 0x21, 0xD9, 0xC8, 0x01, 0x5A, 
+0xCF, 0x92, 0x98, 0xF8, 0x85, 0x30, 0xB9, 0xD7, 
+0x6A, 0x88, 0x2B, 0x96, 0xAC, 0x90, 0x8B, 0xA1, 
+
+0x05, 0x50, 0x49, 0xB3, 0x24, 0x7C, 0xDC, 0x25, 
+0x21, 0xB3, 0x05, 0x4B, 0x01, 0x12, 0xA4, 0x21, 
+0xF2, 0x14, 0x97, 0x8F, 0xE9, 0xFC, 0x6C, 0x4A, 
+0x7E, 0xDB, 0x4B, 0x95, 0x60, 0xAE, 0xE2, 0x23, 
+0x83, 0x79, 0x01, 0xD2, 0x02, 0xD7, 0x1E, 0xDA, 
+0x6F, 0x08, 0xC7, 0xA9, 0x45, 0xB1, 0x33, 0xE1, 
+0x50, 0x32, 0xB1, 0x93, 0x85, 0xDD, 0x63, 0x66, 
+0x96, 0xDC, 0x75, 0xEF, 0x90, 0x83, 0xF1, 0x88, 
+0x6A, 0x2C, 0x63, 0xBB, 0x70, 0x12, 0x53, 0x3F, 
+0x55, 0x16, 0xEC, 0xA7, 0x7E, 0xE5, 0xB1, 0x0B, 
+0xA5, 0xC3, 0x13, 0xBA, 0xE4, 0xD7, 0xD7, 0x15, 
+0x6A, 0x21, 0xF1, 0x96, 0xB1, 0xEC, 0x7C, 0x45, 
+0x99, 0x8F, 0x84, 0x82, 0xDA, 0xD5, 0x4B, 0x77, 
+0x02, 0x95, 0x8D, 0xCE, 0x6E, 0x3B, 0xFC, 0xFE, 
+0xA1, 0xCE, 0x1C, 0x83, 0xA4, 0x33, 0x99, 0x0F, 
+0xE6, 0xB6, 0x31, 0xDC, 0x7C, 0x5F, 0x37, 0xB6, 
+0x4A, 0xCF, 0x5A, 0x42, 0xBE, 0xDF, 0x13, 0x67, 
+0x07, 0xC9, 0x8B, 0xC6, 0x55, 0x46, 0x58, 0xD1, 
+0x1A, 0xDA, 0x1D, 0x80, 0x3D, 0x3A, 0x52, 0xFB, 
+0xC8, 0x78, 0x7D, 0x68, 0xBD, 0x53, 0xD4, 0x8F, 
+0x4A, 0x5D, 0x50, 0x72, 0xAE, 0xEE, 0x23, 0x43, 
+0x87, 0xE1, 0x2A, 0x33, 0x06, 0xEE, 0x25, 0xD1, 
+0xFA, 0x61, 0xF2, 0xCA, 0x5C, 0x8D, 0x50, 0x5B, 
+0xC3, 0x48, 0x57, 0x2D, 0xD5, 0x96, 0x8C, 0x20, 
+0x57, 0x2D, 0x73, 0x2C, 0xEC, 0x45, 0x6C, 0xA8, 
+0x6B, 
+
+0x9F, 0x8A, 0x26, 0xA7, 0x00, 0x82, 0xDD, 0xC1, 
+0x56, 0x41, 0x05, 0x69, 0x0A, 0x63, 0xF5, 0x5D, 
+0xAA, 0x20, 0xC0, 0xB6, 0x6A, 0x47, 0xC2, 0x52, 
+0xB2, 0x16, 0x0D, 0xF3, 0xA5, 0xCE, 0x46, 0xEA, 
+0x57, 0x06, 0x66, 0x95, 0xD6, 0x82, 0xEB, 0x6A, 
+0xC7, 0x2C, 0xBD, 0x66, 0x58, 0x01, 0x3F, 0x55, 
+0x0D, 0xB4, 0xF5, 0xFF, 0x5F, 0x47, 0xF2, 0xF4, 
+0x85, 0xD7, 0x30, 0xB6, 0x0F, 0xA7, 0x9D, 0x2E, 
+0x4E, 0x14, 0xC9, 0x32, 0x6A, 0x94, 0x7E, 0x7E, 
+0xF2, 0x87, 0x99, 0x14, 0x77, 0x34, 0x38, 0x26, 
+0x7E, 0xB9, 0x7B, 0xD0, 0x79, 0xFA, 0xA6, 0x7C, 
+0x9F, 0x7D, 0xE5, 0x39, 0xEB, 0xA7, 0xD9, 0xA7, 
+0xC9, 0x38, 0xF4, 0x8B, 0x0B, 0xE1, 0xF5, 0xA5, 
+0x29, 0x1A, 0xE2, 0xF1, 0xC5, 0x2C, 0xFF, 0x70, 
+0x1F, 0x5F, 0xEB, 0x82, 0xBF, 0x50, 0xE0, 0x5C, 
+0x5D, 0x3F, 0x51, 0x0F, 0x96, 0x2C, 0x46, 0xE1, 
+0xB5, 0x05, 0x9D, 0x18, 0x9F, 0x82, 0x21, 0x12, 
+0xE5, 0x9F, 0xE7, 0x21, 0x60, 0x82, 0x60, 0x9C, 
+0xAF, 0x33, 0xB3, 0x98, 0x6F, 0x7D, 0x0E, 0x92, 
+0xC3, 0x21, 0xDD, 0x89, 0xED, 0x45, 0x81, 0xDC, 
+0xA5, 0xEE, 0x89, 0x16, 0x5C, 0xF0, 0xB5, 0x0E, 
+0xF7, 0x4F, 0xE9, 0x64, 0x33, 0x92, 0xAE, 0x64, 
+0xD8, 0x7C, 0x2B, 0x6F, 0x84, 0xE0, 0xA2, 0xC1, 
+0x6F, 0x13, 0xAC, 0xAE, 0xFC, 0x19, 0xA4, 0xFF, 
+0xD0, 0x5E, 0x4F, 0x6C, 0x0B, 0x12, 0xC6, 0x75, 
+0x7E, 0x3B, 
+
+0x45, 0x43, 0x37, 0x65, 0x8C, 0x09, 0x49, 0xC2, 
+0x40, 0xB0, 0xF1, 0x03, 0x5F, 0x2A, 0x14, 0xAA, 
+0xDF, 0xC6, 0xCE, 0x5F, 0x8F, 0x30, 0x34, 0x8C, 
+0xDF, 0x5D, 0xA6, 0x70, 0x2C, 0x91, 0xED, 0xEF, 
+0xA8, 0x76, 0x29, 0xA5, 0xE8, 0x50, 0xE1, 0xE1, 
+0x88, 0xF8, 0xC1, 0xEE, 0x7E, 0x25, 0xE7, 0x88, 
+0xFA, 0xB9, 0x0D, 0x86, 0x17, 0x45, 0x50, 0xA3, 
+0x86, 0x1B, 0x14, 0x62, 0xC9, 0xF5, 0xE1, 0x17, 
+0x20, 0xC4, 0x11, 0x12, 0x6B, 0xB6, 0xAF, 0x8B, 
+0xD0, 0x1B, 0xB1, 0x81, 0x8F, 0xBB, 0x70, 0x21, 
+0x75, 0xF0, 0x2B, 0x02, 0xE7, 0x39, 0x27, 0xFE, 
+0x39, 0xC2, 0x3E, 0x71, 0x66, 0x43, 0x3B, 0x95, 
+0xAE, 0x50, 0x6E, 0xBA, 0x76, 0x76, 0x6C, 0xDC, 
+0x29, 0x41, 0xE4, 0x07, 0x75, 0x82, 0x39, 0x51, 
+0x16, 0xCD, 0xB5, 0x04, 0x66, 0xE0, 0xA1, 0x8D, 
+0x8D, 0xDD, 0x84, 0x77, 0x3C, 0xBB, 0xB9, 0x0A, 
+0x04, 0x51, 0x8A, 0x3A, 0x92, 0x68, 0x13, 0x99, 
+0x38, 0xDC, 0x16, 0x20, 0x50, 0x3E, 0x44, 0x04, 
+0xC6, 0x67, 0xB8, 0xE9, 0xED, 0xB9, 0xEC, 0x94, 
+0xC5, 0x33, 0x4D, 0x02, 0xE0, 0x10, 0x37, 0x61, 
+0xD4, 0x0F, 0x9B, 0xB1, 0x17, 0x10, 0xB2, 0x9C, 
+0xF3, 0x0D, 0xB8, 0x31, 0xF2, 0x27, 0xD4, 0x6E, 
+0x98, 0x0B, 0x06, 0x38, 0x9A, 0x0C, 0x09, 0x67, 
+0xD3, 0x51, 0xC6, 0x37, 0x83, 0xF0, 0xB7, 0xF4, 
+0xBE, 0xFD, 0x2D, 0xF0, 0x19, 0xDF, 0x91, 0x24, 
+0xA9, 0xFA, 0xCA, 
+
+0xAF, 0x52, 0xA2, 0xD2, 0xE1, 0x73, 0x5B, 0x12, 
+0x5E, 0x29, 0x35, 0x5D, 0xC0, 0xAA, 0x9B, 0x09, 
+0xC7, 0xE7, 0x4F, 0xA9, 0x76, 0x5B, 0x4B, 0x6B, 
+0xFD, 0x6D, 0xB9, 0xB5, 0x63, 0x8A, 0x75, 0x5C, 
+0x33, 0xD9, 0xF3, 0x59, 0x42, 0x75, 0xB3, 0xD5, 
+0xE6, 0x40, 0x05, 0x35, 0xB7, 0x47, 0x09, 0xC0, 
+0x8A, 0x74, 0x63, 0x74, 0xC8, 0x8A, 0xF5, 0xED, 
+0x9C, 0x1D, 0xDD, 0x52, 0x29, 0xFA, 0x19, 0xB1, 
+0xE7, 0xBD, 0xAA, 0x37, 0x0D, 0x44, 0x61, 0x87, 
+0x99, 0x3A, 0xFC, 0xD0, 0x4E, 0x2C, 0x1F, 0xEC, 
+0x82, 0xE8, 0xBE, 0xF9, 0x45, 0xD0, 0xEE, 0xBD, 
+0x7F, 0x4A, 0x40, 0xEC, 0x1F, 0x4E, 0x6A, 0x41, 
+0x96, 0xC5, 0x5D, 0x4C, 0xA7, 0x7D, 0x97, 0x17, 
+0x7F, 0x6C, 0x9E, 0x3E, 0x84, 0xF4, 0xA5, 0xAB, 
+0xE6, 0xE5, 0x39, 0x12, 0x9F, 0x92, 0x42, 0x3A, 
+0xB9, 0x65, 0xE6, 0xC6, 0x3A, 0x5A, 0x91, 0xAC, 
+0x7E, 0xF2, 0xD9, 0xDA, 0xBF, 0x05, 0x89, 0xD9, 
+0xF3, 0x93, 0x2C, 0xB1, 0x13, 0xAD, 0x4C, 0x01, 
+0x3E, 0x43, 0x94, 0x8F, 0x95, 0x74, 0x1E, 0xD9, 
+0x8C, 0x86, 0x20, 0xDD, 0xB8, 0xCC, 0x7F, 0xF8, 
+0x12, 0xC1, 0x9B, 0xD2, 0x84, 0x90, 0xBA, 0x79, 
+0xD4, 0xBA, 0x53, 0xDB, 0x2C, 0xEF, 0x37, 0xE3, 
+0x99, 0x2E, 0x09, 0x1E, 0x0B, 0xA9, 0xD9, 0xD2, 
+0xDF, 0xEC, 0x55, 0x1A, 0x66, 0x4C, 0x29, 0xA9, 
+0xD2, 0x34, 0x56, 0xEF, 0xBC, 0xB5, 0xF2, 0x42, 
+0x4B, 0x4B, 0x9D, 0x86, 
+
+0x1A, 0x05, 0x85, 0xB1, 0x20, 0x89, 0xCF, 0x85, 
+0x24, 0xF9, 0x1A, 0x65, 0x9E, 0x11, 0x04, 0x49, 
+0x96, 0x2D, 0xC3, 0xE4, 0x50, 0x37, 0xDE, 0xC9, 
+0xB3, 0xB6, 0x3A, 0xFA, 0xCE, 0x2A, 0x99, 0xA0, 
+0xA9, 0xAC, 0x3B, 0x3F, 0x9A, 0x69, 0x2D, 0x40, 
+0x4C, 0xE9, 0x08, 0x91, 0x4C, 0x25, 0xC7, 0x84, 
+0xBF, 0xBF, 0x18, 0x43, 0x90, 0x07, 0x8A, 0x1C, 
+0x9B, 0xD9, 0xD7, 0x5C, 0x6C, 0xEB, 0x33, 0xE1, 
+0x15, 0x15, 0x7D, 0x17, 0x5D, 0x0D, 0x4A, 0x83, 
+0xB6, 0x0C, 0xA2, 0xDB, 0xE4, 0x44, 0xE1, 0xC0, 
+0x45, 0x3F, 0xCD, 0xDF, 0xD1, 0x3B, 0xF9, 0xBF, 
+0x4C, 0xC9, 0xA5, 0xED, 0x89, 0x48, 0x51, 0x86, 
+0x86, 0x4C, 0x86, 0xCD, 0x3E, 0x81, 0xB5, 0x9F, 
+0xB5, 0xFA, 0x23, 0x8D, 0xB2, 0x51, 0xC1, 0x3F, 
+0x8A, 0xF5, 0x6A, 0xEC, 0x04, 0x0C, 0xE5, 0x95, 
+0xD9, 0xFA, 0x49, 0xA9, 0x86, 0x0E, 0x09, 0xA8, 
+0x19, 0x42, 0x7C, 0xAA, 0xFF, 0x71, 0x26, 0x77, 
+0x6F, 0xBC, 0xD6, 0x2E, 0x8B, 0x3D, 0x8D, 0xC3, 
+0xDD, 0x8F, 0xD6, 0x96, 0xEC, 0x98, 0xF1, 0x8E, 
+0x64, 0x90, 0x45, 0x14, 0x7A, 0x42, 0x31, 0xBA, 
+0xAD, 0x53, 0x35, 0xCA, 0xC1, 0xAD, 0x26, 0xBE, 
+0xEE, 0xBF, 0xD7, 0x05, 0x8D, 0x53, 0x05, 0x41, 
+0x62, 0xFB, 0x84, 0x4E, 0x48, 0xE6, 0xD0, 0x07, 
+0x10, 0x28, 0x88, 0xAD, 0x19, 0x6F, 0xEB, 0x7D, 
+0x53, 0x7B, 0x3D, 0xE9, 0xCC, 0x0B, 0xE5, 0xA1, 
+0x45, 0x71, 0x8C, 0xC1, 0xAA, 
+
+0x18, 0xB8, 0xA0, 0x86, 0xC3, 0x0E, 0x45, 0xE1, 
+0xF9, 0xE9, 0xB7, 0x1B, 0x9E, 0xC3, 0x2D, 0x07, 
+0x64, 0xAA, 0x0E, 0x7D, 0x76, 0x17, 0xC1, 0x14, 
+0xCC, 0xE3, 0xA8, 0xEC, 0xF1, 0x96, 0xFF, 0x62, 
+0xDF, 0x07, 0x23, 0x0D, 0x63, 0x95, 0xDB, 0x26, 
+0x53, 0xC0, 0x17, 0x24, 0xE2, 0x96, 0xB7, 0x45, 
+0xC1, 0xA1, 0xC5, 0x5C, 0x64, 0x02, 0x1B, 0xBB, 
+0xA8, 0xC4, 0x13, 0x1F, 0x7E, 0x0C, 0x08, 0xEF, 
+0x42, 0xEC, 0x33, 0x90, 0xEB, 0xED, 0xA4, 0x70, 
+0x4D, 0x9B, 0xD0, 0xF3, 0xF7, 0x91, 0xAF, 0xC4, 
+0x6F, 0x2D, 0xB3, 0xE7, 0x49, 0xB2, 0xF3, 0x64, 
+0x4F, 0x17, 0x56, 0x65, 0x52, 0x0D, 0x39, 0xB1, 
+0x06, 0x3D, 0xCC, 0x96, 0x85, 0xD1, 0x89, 0x86, 
+0xDD, 0xF5, 0xF3, 0xFF, 0x48, 0x86, 0x80, 0xB6, 
+0x8F, 0x18, 0x41, 0x95, 0x75, 0xEA, 0x40, 0x00, 
+0x4D, 0xC5, 0x7D, 0xE2, 0xF0, 0x06, 0x8C, 0x98, 
+0xEF, 0x87, 0x51, 0xF0, 0x20, 0xF1, 0x90, 0x75, 
+0x32, 0xCC, 0x96, 0x4F, 0x00, 0x3B, 0xAF, 0xD1, 
+0x2E, 0x37, 0x66, 0x8A, 0xCC, 0x96, 0x60, 0x73, 
+0x8D, 0xB0, 0xB8, 0x57, 0x1D, 0x72, 0xB7, 0x59, 
+0x24, 0x4E, 0x53, 0x87, 0x1F, 0xFD, 0x4C, 0xC8, 
+0x96, 0xB5, 0xE3, 0x41, 0x01, 0x03, 0x2A, 0x0A, 
+0x5E, 0xDC, 0xEA, 0x19, 0xD8, 0x1A, 0x41, 0xEC, 
+0xFA, 0x49, 0xD5, 0x77, 0x9E, 0x0E, 0x0A, 0x42, 
+0x23, 0xDE, 0xA3, 0xFF, 0x06, 0x73, 0x4D, 0x8B, 
+0x14, 0x16, 0x4D, 0x7A, 0xCD, 0x25, 
+
+0xA7, 0xB3, 0x8C, 0xE1, 0x88, 0x63, 0x65, 0xC3, 
+0xD9, 0x19, 0xDD, 0x49, 0x8F, 0xEC, 0x87, 0x85, 
+0x0A, 0x10, 0xF9, 0xBC, 0xBA, 0x19, 0x10, 0xCD, 
+0x6C, 0x83, 0x61, 0xC5, 0xA4, 0xE2, 0x03, 0xA6, 
+0xAF, 0x58, 0x02, 0x3E, 0x0D, 0xF8, 0x5A, 0x18, 
+0x73, 0x85, 0xCD, 0x4C, 0x31, 0x2F, 0xDD, 0xE0, 
+0xDF, 0x87, 0x73, 0xC0, 0x8E, 0x0C, 0x77, 0x06, 
+0xE6, 0x14, 0x2D, 0x1C, 0xC4, 0x12, 0x5B, 0x0E, 
+0x37, 0xEB, 0x20, 0x8E, 0x32, 0x68, 0x3E, 0xBA, 
+0x09, 0x06, 0x76, 0x67, 0x78, 0xEE, 0xAF, 0x45, 
+0xCD, 0x14, 0x29, 0x1F, 0x5A, 0x69, 0xE4, 0x8D, 
+0x32, 0xC9, 0x00, 0x72, 0xF6, 0x8A, 0xF3, 0x83, 
+0xFD, 0xB2, 0xA3, 0xDF, 0xDF, 0xDD, 0x05, 0x09, 
+0x35, 0xCE, 0xC5, 0xBC, 0x89, 0x42, 0x3A, 0x22, 
+0x7A, 0xBB, 0xE8, 0xE1, 0x9F, 0x10, 0xBC, 0x33, 
+0x71, 0x1C, 0xAE, 0x6A, 0xBA, 0x78, 0x19, 0x19, 
+0x44, 0x2F, 0xD5, 0x1B, 0x88, 0xA5, 0x06, 0x9D, 
+0x35, 0x55, 0x78, 0xDE, 0xF1, 0xA8, 0xB2, 0xED, 
+0xAA, 0x34, 0xC8, 0xC0, 0x5C, 0xA7, 0x41, 0x58, 
+0xED, 0x3C, 0xF0, 0xBD, 0x72, 0x92, 0x60, 0x8A, 
+0xAA, 0xF0, 0xDA, 0xD6, 0xF4, 0x6B, 0xEA, 0x2F, 
+0xF7, 0x00, 0xF6, 0xBD, 0x77, 0xAC, 0x0D, 0x26, 
+0xF3, 0x06, 0x14, 0xB9, 0xF5, 0xEE, 0xE9, 0x7B, 
+0x3B, 0x2E, 0x0E, 0x50, 0x14, 0xCF, 0xA8, 0x6A, 
+0x70, 0xCC, 0xBF, 0x36, 0xBB, 0xEE, 0x73, 0x4A, 
+0xD5, 0xA2, 0x0A, 0xA7, 0x29, 0x6B, 0x24, 
+
+0x3C, 0x16, 0x36, 0xF7, 0xBD, 0x37, 0x9E, 0x11, 
+0xCD, 0xA3, 0x19, 0x03, 0x4E, 0x28, 0xBA, 0x1A, 
+0x5B, 0x69, 0xD5, 0x1A, 0x86, 0x89, 0xB2, 0x62, 
+0x2B, 0xB4, 0x27, 0x6D, 0xC4, 0x5D, 0x92, 0xB4, 
+0xCE, 0x4C, 0x1B, 0x52, 0xB0, 0x08, 0x3A, 0x0B, 
+0x52, 0x8D, 0xFC, 0x8E, 0xF6, 0x2C, 0xB2, 0x8E, 
+0xFE, 0x63, 0xB7, 0x1D, 0x97, 0x63, 0xE4, 0x84, 
+0x56, 0xAD, 0x5C, 0x2B, 0x3A, 0x9F, 0xB3, 0xCE, 
+0x9D, 0xA2, 0x98, 0x75, 0xEF, 0x2B, 0xE1, 0x9D, 
+0xC4, 0x12, 0xAE, 0x4B, 0xC2, 0x62, 0x3D, 0x2C, 
+0x28, 0xE7, 0xF5, 0xAB, 0x5B, 0xD1, 0x8B, 0xA2, 
+0xF4, 0x41, 0x95, 0x8D, 0x4B, 0xC0, 0x98, 0x54, 
+0xE6, 0xDC, 0xE2, 0x15, 0x83, 0x21, 0x3A, 0xBC, 
+0x79, 0x00, 0xFD, 0x22, 0x81, 0x7D, 0xF2, 0xBA, 
+0x05, 0xAD, 0x48, 0x11, 0x40, 0x72, 0xE3, 0xC6, 
+0x23, 0x08, 0xE8, 0x0B, 0xF3, 0xC1, 0xDB, 0xAA, 
+0x39, 0x7E, 0xC7, 0x91, 0x9C, 0xFC, 0xB7, 0x82, 
+0x85, 0x94, 0x4A, 0xED, 0x2D, 0x60, 0x1A, 0x47, 
+0xA4, 0x4E, 0x97, 0x1C, 0x5D, 0xBC, 0x13, 0x7C, 
+0x22, 0x45, 0x0E, 0x5D, 0xF6, 0xD2, 0xD9, 0x24, 
+0xF7, 0x35, 0x13, 0x5E, 0xFB, 0x73, 0x97, 0x0F, 
+0x03, 0xA5, 0xB6, 0x97, 0x30, 0x47, 0x6F, 0x9D, 
+0xF4, 0x75, 0xB7, 0xD3, 0x71, 0xD6, 0x1A, 0x40, 
+0x93, 0x69, 0x5C, 0x30, 0x0C, 0xE6, 0x57, 0xC0, 
+0xD9, 0x5F, 0x84, 0x94, 0x1C, 0x6C, 0x74, 0xF9, 
+0x2A, 0x8A, 0x2D, 0x4C, 0x62, 0x96, 0x96, 0xBB, 
+
+0xBA, 0x50, 0x32, 0x6D, 0x6F, 0x84, 0x2D, 0x15, 
+0x34, 0x88, 0x88, 0xD9, 0x75, 0xBA, 0xBA, 0x6B, 
+0x7A, 0x96, 0xE8, 0x8A, 0x92, 0x9C, 0x09, 0x03, 
+0x30, 0x23, 0xC0, 0x6A, 0xFE, 0x6B, 0x5A, 0xBC, 
+0x6C, 0xDA, 0xA6, 0xF9, 0xA3, 0x18, 0xFE, 0x8F, 
+0x25, 0xD6, 0x90, 0xD7, 0xFA, 0xC4, 0x67, 0x78, 
+0xF3, 0x55, 0xA7, 0xC5, 0xA4, 0xA0, 0x42, 0x8A, 
+0x4F, 0x04, 0x2E, 0xE4, 0x1F, 0x8B, 0x45, 0xDC, 
+0xD5, 0xF6, 0xCD, 0x5D, 0xC0, 0xCA, 0x51, 0x94, 
+0x58, 0x20, 0xCE, 0x66, 0xF8, 0xD9, 0x33, 0x54, 
+0x8F, 0xE0, 0x23, 0xA5, 0x53, 0xC0, 0xD1, 0x6A, 
+0xD0, 0x71, 0xBE, 0x2B, 0xEC, 0xD0, 0x43, 0xC1, 
+0xB2, 0xBA, 0xBC, 0xEA, 0xF5, 0x1C, 0xFB, 0x78, 
+0xE8, 0x2A, 0xCB, 0x2E, 0xB6, 0xF5, 0x53, 0x0F, 
+0xD8, 0xDE, 0xAA, 0x33, 0x5E, 0x86, 0xE2, 0xB3, 
+0x69, 0x7B, 0x83, 0x07, 0x73, 0x2F, 0x30, 0x5D, 
+0x95, 0xC3, 0x49, 0xC8, 0x70, 0x6A, 0xC7, 0xEC, 
+0xED, 0xC1, 0x79, 0xF4, 0xD2, 0x95, 0xF7, 0x80, 
+0xE7, 0x21, 0x2C, 0x97, 0x09, 0x33, 0x5A, 0x1D, 
+0x93, 0x85, 0xA6, 0xB7, 0xB3, 0x4D, 0xE0, 0x9E, 
+0xEF, 0xA7, 0x27, 0x8F, 0x2C, 0xE2, 0x7B, 0x60, 
+0x5F, 0xA1, 0xF4, 0xD5, 0xC4, 0xE5, 0x8B, 0xA7, 
+0xA4, 0x74, 0x65, 0x91, 0x8F, 0x89, 0x19, 0xC9, 
+0x78, 0x98, 0x86, 0xCD, 0xFD, 0x7D, 0x41, 0x96, 
+0xC5, 0x3A, 0x65, 0x9B, 0x6D, 0x54, 0xB1, 0x01, 
+0x1F, 0xDC, 0x3C, 0x3C, 0x45, 0xDC, 0xC3, 0xEB, 
+0x91, 
+
+0x10, 0x2A, 0x06, 0xD0, 0x9E, 0x69, 0x11, 0xA4, 
+0xBE, 0xAE, 0x34, 0x67, 0xD1, 0xEA, 0x9F, 0x23, 
+0x5E, 0xF3, 0xA6, 0x7E, 0xD9, 0x24, 0x74, 0x51, 
+0xB1, 0x0F, 0xB9, 0xB1, 0x68, 0x31, 0xC9, 0x5D, 
+0xF6, 0xA0, 0x79, 0x69, 0xBC, 0x29, 0x9C, 0x36, 
+0xCD, 0x9D, 0xA5, 0x4C, 0xCB, 0x67, 0xE9, 0x46, 
+0xC5, 0x9F, 0xAD, 0x54, 0xB8, 0x18, 0x82, 0x42, 
+0x83, 0x5F, 0xF9, 0xB5, 0x65, 0x33, 0x9E, 0x31, 
+0x72, 0x0B, 0x4A, 0x1C, 0x6A, 0x7A, 0x64, 0x2F, 
+0xCC, 0x17, 0x63, 0x1B, 0xF0, 0x7E, 0xB9, 0x7D, 
+0x04, 0x2A, 0x0A, 0x59, 0xB4, 0xAE, 0x29, 0x20, 
+0x6C, 0x44, 0x76, 0xE5, 0x48, 0x2B, 0xB6, 0x76, 
+0xFB, 0xA4, 0x25, 0x04, 0xE0, 0x11, 0xBE, 0xDF, 
+0x20, 0x5A, 0xA8, 0x40, 0x7E, 0x89, 0x3A, 0xB7, 
+0x48, 0x66, 0xC7, 0x60, 0xB4, 0x15, 0x01, 0x34, 
+0xCF, 0xCC, 0xBB, 0x6E, 0x1F, 0xAC, 0xDF, 0x28, 
+0xE0, 0xFA, 0x41, 0x8D, 0x76, 0x60, 0xC0, 0xFA, 
+0x01, 0x6F, 0x77, 0x23, 0xDC, 0x16, 0x3C, 0x22, 
+0xAA, 0x87, 0x27, 0xA6, 0x25, 0x81, 0x9E, 0x8F, 
+0xE6, 0x70, 0xF8, 0x92, 0x4A, 0xAB, 0x14, 0xC8, 
+0x15, 0x71, 0xB9, 0x5A, 0xF0, 0xAB, 0xB4, 0x0C, 
+0x2A, 0xE9, 0xEC, 0x17, 0xA9, 0x81, 0x70, 0x21, 
+0x17, 0x49, 0x68, 0x84, 0x47, 0x4F, 0x5F, 0x4C, 
+0xB9, 0x0F, 0xCA, 0x8B, 0x1C, 0x51, 0xB4, 0xC4, 
+0x48, 0x42, 0x63, 0x5B, 0x80, 0x5E, 0xB7, 0x28, 
+0x3F, 0x17, 0xF1, 0x0C, 0xA1, 0x59, 0x3A, 0x9D, 
+0xF2, 0x03, 
+
+0xDE, 0x3B, 0xBC, 0x84, 0xEA, 0x1D, 0x63, 0xD6, 
+0x71, 0x69, 0x30, 0x2F, 0xE3, 0xE5, 0xAF, 0x30, 
+0x73, 0x09, 0x1F, 0x2F, 0x24, 0x6B, 0x2C, 0xF6, 
+0xCE, 0x11, 0xB5, 0xE7, 0x1C, 0x67, 0x23, 0x05, 
+0x39, 0x3E, 0x7E, 0xF9, 0x87, 0x4E, 0x37, 0x1F, 
+0xE8, 0xBC, 0x3D, 0xE3, 0xC7, 0xC2, 0xE6, 0x80, 
+0x39, 0xAA, 0x32, 0xFA, 0xA3, 0xA0, 0x02, 0xD7, 
+0x4D, 0x25, 0x29, 0x24, 0xAE, 0xDA, 0x94, 0x3A, 
+0x68, 0x46, 0xAB, 0x54, 0xD5, 0x5C, 0x87, 0xCF, 
+0x62, 0x64, 0x44, 0xAF, 0x94, 0xD1, 0xAD, 0x37, 
+0xE2, 0x53, 0xFA, 0xB5, 0x67, 0x6C, 0x6F, 0xA5, 
+0x9E, 0x34, 0xAB, 0xA7, 0x4F, 0x8D, 0xDA, 0x83, 
+0xE0, 0x58, 0x0C, 0x1D, 0xC5, 0x9F, 0x80, 0xB0, 
+0x21, 0x05, 0xDE, 0x18, 0x48, 0xD6, 0x82, 0xED, 
+0x9F, 0xAF, 0xD7, 0x25, 0xFD, 0x2B, 0xB4, 0xA9, 
+0xEE, 0x49, 0xB5, 0xC5, 0xD8, 0xE5, 0xE2, 0xB1, 
+0x9E, 0x36, 0xAF, 0xF3, 0x35, 0x44, 0xE8, 0x25, 
+0x6F, 0x3E, 0x90, 0x11, 0xFD, 0x26, 0xAF, 0x4B, 
+0x34, 0x52, 0xC8, 0x6E, 0xF3, 0x95, 0x81, 0xED, 
+0x73, 0x9D, 0xB8, 0xFC, 0x3C, 0xB1, 0x77, 0x2A, 
+0x42, 0x9F, 0x6E, 0x1B, 0x45, 0xDE, 0x0E, 0xE7, 
+0x42, 0xE8, 0x05, 0xDA, 0xB0, 0x85, 0x59, 0xF9, 
+0x2E, 0x07, 0xF2, 0x06, 0xE4, 0xA5, 0x79, 0x0B, 
+0x24, 0x22, 0x90, 0x56, 0x77, 0xFE, 0x32, 0x15, 
+0x8A, 0x4B, 0x53, 0x82, 0xB3, 0xCD, 0xBA, 0xC8, 
+0xD6, 0x8B, 0x8B, 0x94, 0xA0, 0x77, 0x3E, 0x68, 
+0xBF, 0xF8, 0x1B, 
+
+0x09, 0x11, 0x57, 0x9C, 0xA6, 0x3C, 0xF5, 0x99, 
+0xBE, 0xAC, 0x4D, 0xD0, 0xF0, 0xDA, 0x46, 0xBF, 
+0x88, 0xAD, 0xF2, 0xE5, 0x0B, 0x71, 0xFF, 0x2E, 
+0xAC, 0x0A, 0x02, 0x9B, 0x11, 0xAB, 0xF7, 0x38, 
+0x0B, 0x78, 0xF6, 0xED, 0x42, 0x99, 0x8E, 0x95, 
+0xBC, 0x2B, 0xE2, 0xD1, 0x57, 0xB0, 0x74, 0xD7, 
+0x1E, 0x8D, 0x23, 0xEF, 0xDA, 0x6A, 0x52, 0x17, 
+0xF0, 0x8C, 0xDF, 0xE5, 0xC2, 0xFF, 0x0F, 0x77, 
+0x8B, 0x3D, 0x47, 0xBB, 0x04, 0x53, 0xF6, 0xE6, 
+0xDD, 0xF2, 0xE7, 0x78, 0xB4, 0xE6, 0x6A, 0x2E, 
+0x5B, 0x10, 0x4E, 0x03, 0xF2, 0x10, 0x2C, 0x87, 
+0x8F, 0x5E, 0xC1, 0x8C, 0x4D, 0x11, 0xD4, 0x92, 
+0x45, 0x89, 0x3D, 0x97, 0x49, 0x80, 0x98, 0xCB, 
+0x6C, 0x4E, 0x76, 0x66, 0x42, 0x96, 0x1A, 0x61, 
+0x96, 0xBB, 0xCE, 0xAE, 0xF2, 0xC3, 0x7F, 0x51, 
+0xBA, 0xAC, 0x8C, 0xEF, 0xA4, 0x58, 0x96, 0xE9, 
+0x72, 0x12, 0xF2, 0x9A, 0x0E, 0x3B, 0x1D, 0x0E, 
+0xBA, 0xD4, 0xCB, 0xCB, 0x11, 0xDC, 0x9A, 0x26, 
+0xDD, 0x1B, 0x72, 0xC8, 0xD2, 0x26, 0x1E, 0x65, 
+0xE9, 0xD8, 0xDE, 0xAD, 0x60, 0x71, 0xDA, 0xD5, 
+0xFE, 0xB0, 0xAA, 0x4F, 0xF5, 0xED, 0x06, 0xF2, 
+0x15, 0x4E, 0x7D, 0x5F, 0x6E, 0x1E, 0x8D, 0x1B, 
+0x31, 0x64, 0xCC, 0xEC, 0xB2, 0xFC, 0x58, 0xEE, 
+0xE9, 0xF6, 0x1B, 0x23, 0xD7, 0x7F, 0xCF, 0xE2, 
+0x53, 0xC3, 0x37, 0xB7, 0x7A, 0x2C, 0xDB, 0xF1, 
+0xAD, 0x94, 0x1B, 0x5A, 0x2A, 0xA7, 0x55, 0x63, 
+0xB3, 0x26, 0x24, 0x37, 
+
+0x6C, 0xE7, 0x6C, 0x09, 0xA7, 0xBC, 0xFD, 0x9D, 
+0x61, 0x0A, 0x49, 0xF9, 0x0C, 0x92, 0xC7, 0x86, 
+0xBF, 0x1A, 0x7A, 0x55, 0xC8, 0xBC, 0xF8, 0x4E, 
+0x37, 0xD2, 0xD2, 0xAF, 0x00, 0xAF, 0xE7, 0xA6, 
+0xCA, 0x45, 0x5A, 0xA8, 0xAA, 0x90, 0x68, 0x9E, 
+0x1B, 0x8F, 0x11, 0x25, 0x32, 0xF1, 0x78, 0x32, 
+0x99, 0x10, 0x4F, 0x6C, 0xC1, 0x82, 0xDD, 0x10, 
+0xC3, 0x5E, 0x0A, 0x58, 0x78, 0x48, 0xEC, 0x92, 
+0xEB, 0xEF, 0x31, 0x4A, 0x08, 0x65, 0x2C, 0xB8, 
+0xEB, 0x15, 0x8B, 0xC6, 0x40, 0x52, 0x52, 0x93, 
+0x7F, 0xE5, 0x71, 0xD6, 0x0D, 0xD3, 0xEE, 0x97, 
+0xD0, 0xE6, 0x3B, 0xAE, 0x2F, 0xDF, 0xC8, 0x45, 
+0x7D, 0x33, 0x42, 0x45, 0xB3, 0xD5, 0x91, 0xA7, 
+0x6F, 0x41, 0x19, 0x49, 0x98, 0xAF, 0x4D, 0x8E, 
+0x6C, 0xC9, 0x5E, 0xF6, 0x93, 0x83, 0x01, 0xB8, 
+0x2D, 0x52, 0xF9, 0xCE, 0x9A, 0x19, 0x77, 0xE6, 
+0x66, 0xCF, 0xE0, 0x25, 0x93, 0x9C, 0x87, 0x60, 
+0x90, 0x3A, 0x83, 0x6A, 0x80, 0x24, 0x8F, 0xC9, 
+0x23, 0x97, 0xEA, 0x9B, 0x16, 0x0D, 0xF1, 0x55, 
+0x71, 0xF4, 0x13, 0xED, 0x7C, 0x28, 0x21, 0x2D, 
+0x40, 0xE2, 0xE7, 0x46, 0x88, 0x60, 0xD5, 0x72, 
+0xA6, 0xD3, 0xC0, 0x74, 0xD9, 0xA8, 0x7A, 0x1C, 
+0x10, 0xEF, 0xDA, 0x6A, 0x57, 0x29, 0x20, 0x1F, 
+0x97, 0x65, 0x9D, 0xDD, 0xD6, 0xE8, 0x6B, 0x7A, 
+0xD3, 0x8B, 0x2B, 0xFC, 0x11, 0x43, 0xC0, 0xFF, 
+0xE2, 0x0F, 0xE7, 0xA4, 0x2B, 0x3B, 0xAC, 0xE8, 
+0xF0, 0x17, 0x83, 0x1D, 0x1E, 
+
+0x78, 0x7F, 0x91, 0x45, 0x42, 0x31, 0xC2, 0x97, 
+0x90, 0x70, 0x97, 0x82, 0xE6, 0x5A, 0x06, 0xB4, 
+0x7F, 0x97, 0x61, 0xFD, 0xAC, 0x04, 0x3F, 0x47, 
+0xBB, 0xF9, 0x67, 0xAE, 0xC1, 0xE3, 0x31, 0xE5, 
+0xBF, 0x8D, 0x2A, 0x86, 0x13, 0x4A, 0xAA, 0x9C, 
+0x10, 0xDD, 0x53, 0x50, 0xDF, 0x02, 0x29, 0x6F, 
+0x36, 0x2D, 0x74, 0x21, 0x2C, 0xD8, 0x56, 0x78, 
+0x31, 0x94, 0x4D, 0x72, 0xAB, 0x15, 0xB4, 0xAB, 
+0xD8, 0x76, 0x1C, 0x92, 0x93, 0xA3, 0xB5, 0x7D, 
+0xA7, 0xE3, 0xF2, 0xA8, 0x5A, 0x20, 0x51, 0x63, 
+0xE8, 0x03, 0x8F, 0x31, 0x78, 0xE9, 0x2E, 0xA0, 
+0x42, 0xA5, 0x5F, 0x59, 0xD7, 0x3C, 0x7B, 0xC4, 
+0x1D, 0x13, 0xBC, 0xEA, 0x70, 0x76, 0x80, 0xB6, 
+0xC3, 0x80, 0x22, 0xAB, 0xEE, 0x18, 0x7E, 0xCC, 
+0x0C, 0x35, 0x6C, 0x5B, 0x51, 0x46, 0x08, 0x09, 
+0x60, 0x8E, 0x72, 0x66, 0x18, 0x1A, 0xF8, 0x7E, 
+0x94, 0x87, 0x0C, 0xA9, 0x53, 0x7A, 0x7F, 0x75, 
+0x54, 0xB7, 0x53, 0x49, 0xF6, 0x75, 0x3A, 0x93, 
+0xD5, 0x78, 0xFC, 0x71, 0x99, 0xF5, 0x76, 0xD4, 
+0xA7, 0xAC, 0x6A, 0xE0, 0xE1, 0xA1, 0xBA, 0xC2, 
+0x82, 0x26, 0x21, 0x00, 0x63, 0xEE, 0xAD, 0x4E, 
+0x74, 0x6C, 0x75, 0xD4, 0x51, 0x97, 0x37, 0xC9, 
+0xAF, 0xFF, 0x9B, 0x24, 0x2A, 0xE3, 0x9A, 0xBC, 
+0x9C, 0x92, 0xB3, 0x61, 0xE6, 0xA0, 0x25, 0xDC, 
+0x22, 0xD8, 0x40, 0x52, 0x03, 0x27, 0x03, 0x26, 
+0x76, 0xFC, 0xAA, 0xFC, 0x1D, 0x0B, 0x11, 0x02, 
+0x7F, 0xD2, 0xEC, 0xCC, 0x02, 0xB6, 
+
+0x0E, 0x5D, 0x5F, 0x2C, 0x8A, 0x5B, 0x8D, 0x5B, 
+0x19, 0x60, 0x9F, 0x5C, 0x53, 0x50, 0x12, 0xE0, 
+0x8F, 0x14, 0x74, 0xAC, 0x18, 0xC5, 0xAA, 0x48, 
+0x00, 0x14, 0x2E, 0xA5, 0x8E, 0x35, 0x7C, 0x2A, 
+0xEF, 0x13, 0x9A, 0xE8, 0xA6, 0xC2, 0xE1, 0xAE, 
+0x26, 0xAE, 0x55, 0x4F, 0xE9, 0x27, 0xCA, 0x92, 
+0xC4, 0x2D, 0x81, 0xB3, 0x09, 0x34, 0x2D, 0xE6, 
+0x7A, 0x55, 0x11, 0x28, 0x5A, 0x1E, 0x11, 0x54, 
+0x6C, 0xA6, 0x8E, 0xB7, 0xD7, 0xD2, 0x89, 0x73, 
+0x53, 0xF1, 0x35, 0x3C, 0x3D, 0x9F, 0x8A, 0xA9, 
+0x64, 0x62, 0xF4, 0x31, 0xF6, 0x96, 0x4A, 0x95, 
+0x34, 0xEC, 0x51, 0x36, 0xEB, 0x55, 0x8B, 0x0D, 
+0x1D, 0xD8, 0xC0, 0xB9, 0x08, 0x97, 0x30, 0xA2, 
+0xA6, 0x47, 0x19, 0xB9, 0xB8, 0xF2, 0xCF, 0x87, 
+0x66, 0xF1, 0xFC, 0x18, 0xD8, 0x17, 0xFB, 0x61, 
+0xEB, 0x29, 0xAE, 0x30, 0x58, 0x1C, 0x01, 0x55, 
+0x1B, 0x21, 0x4B, 0x8D, 0xC7, 0xF7, 0x59, 0x57, 
+0x0D, 0x84, 0xC3, 0xDC, 0xF7, 0x28, 0x17, 0x9D, 
+0x76, 0xB5, 0x02, 0x22, 0x8A, 0x16, 0x5A, 0x4B, 
+0x64, 0x6E, 0x67, 0xD1, 0xEA, 0xA6, 0x83, 0xC6, 
+0x38, 0xCF, 0xCB, 0x48, 0x5D, 0x92, 0xCC, 0x62, 
+0xBC, 0x89, 0xD7, 0xED, 0x39, 0xBD, 0x9C, 0x32, 
+0x44, 0x85, 0x40, 0x2B, 0x72, 0xB4, 0x52, 0xCA, 
+0x2F, 0xE1, 0xBE, 0x57, 0xA0, 0xC1, 0x10, 0xE3, 
+0x91, 0xEC, 0x85, 0x00, 0x35, 0x64, 0xDA, 0x94, 
+0x0E, 0xE8, 0x80, 0x99, 0xB1, 0x9D, 0x0A, 0xED, 
+0x0A, 0xB8, 0xC4, 0xBF, 0x9C, 0xCE, 0x59, 
+
+0x50, 0x3C, 0xA3, 0x87, 0xD2, 0x56, 0x59, 0xE7, 
+0xAD, 0x48, 0xE7, 0xA9, 0x06, 0x08, 0xB7, 0x16, 
+0x27, 0xCD, 0x51, 0x52, 0x09, 0x69, 0x9B, 0xD8, 
+0xD0, 0x1F, 0x6D, 0x15, 0x82, 0xE6, 0x75, 0x2A, 
+0x60, 0x87, 0xC5, 0xD6, 0xF3, 0x4D, 0xBC, 0x86, 
+0x7A, 0x0C, 0x73, 0x83, 0x52, 0xC4, 0x9D, 0x5D, 
+0xF7, 0x4B, 0x19, 0xB6, 0x98, 0xE9, 0x6B, 0x11, 
+0xA9, 0xE5, 0xC5, 0x5B, 0x1F, 0x84, 0x03, 0x00, 
+0x96, 0x0D, 0xE4, 0xF6, 0x67, 0x22, 0x5E, 0x00, 
+0x2F, 0xD5, 0x7C, 0xAE, 0xEC, 0xE1, 0x55, 0x3C, 
+0xF6, 0x79, 0x77, 0x31, 0x80, 0xD0, 0x89, 0x47, 
+0x67, 0xA8, 0x07, 0x83, 0x3B, 0x77, 0xA1, 0x35, 
+0xCF, 0x55, 0xD2, 0x61, 0x4D, 0xD0, 0x45, 0x91, 
+0xC6, 0x98, 0xD5, 0xE5, 0x0B, 0xFC, 0x49, 0x0B, 
+0xA7, 0x73, 0xE8, 0x41, 0x38, 0xD8, 0xDD, 0x29, 
+0x6E, 0x6B, 0x2B, 0x8C, 0xE4, 0xB4, 0xBE, 0x56, 
+0xC8, 0x85, 0xF5, 0xD3, 0x8E, 0x02, 0x93, 0x39, 
+0xA0, 0xFC, 0x98, 0xA0, 0x23, 0xD8, 0x2E, 0xCF, 
+0x27, 0x26, 0x7A, 0x39, 0x15, 0x3D, 0x2F, 0x27, 
+0xB3, 0xA4, 0x4A, 0xA6, 0xEF, 0x68, 0x26, 0xB3, 
+0xEF, 0xB7, 0xE4, 0xE9, 0x45, 0xDA, 0x97, 0xD6, 
+0x75, 0x11, 0xC1, 0xB5, 0x10, 0x07, 0xDE, 0xC1, 
+0x1F, 0xA8, 0x85, 0x10, 0x2C, 0x46, 0x24, 0xA4, 
+0xDF, 0xFE, 0xAA, 0x2A, 0xB9, 0xFF, 0x52, 0xB4, 
+0x51, 0xB3, 0xBD, 0xCA, 0xA2, 0x91, 0x06, 0xA3, 
+0x06, 0x88, 0x96, 0xC3, 0x0C, 0x44, 0xBF, 0x14, 
+0x40, 0xB1, 0x3A, 0x3E, 0x76, 0x2E, 0x2D, 0x24, 
+
+0x5C, 0x38, 0x35, 0x54, 0x76, 0x33, 0x1A, 0x11, 
+0x59, 0x88, 0x74, 0xE3, 0x3C, 0x8E, 0x72, 0xB3, 
+0xA6, 0xB5, 0x2E, 0xA4, 0xB7, 0xD4, 0x51, 0xDE, 
+0x8A, 0x50, 0x77, 0x6A, 0xFE, 0x4D, 0x81, 0x54, 
+0xE7, 0x20, 0xE1, 0x48, 0x90, 0x79, 0xA6, 0xBE, 
+0x3F, 0x96, 0xA0, 0x24, 0xAC, 0x2C, 0x40, 0xB7, 
+0x54, 0x2E, 0x74, 0x41, 0xBD, 0xDD, 0xEC, 0x40, 
+0x12, 0xDB, 0xA7, 0x6F, 0x1C, 0x04, 0x9B, 0x9E, 
+0x02, 0x42, 0x2F, 0x57, 0xF9, 0x2C, 0x21, 0x5D, 
+0x1C, 0xAC, 0x1A, 0xF9, 0x4E, 0x66, 0xC5, 0x38, 
+0x65, 0xBC, 0x20, 0x7B, 0xE8, 0x96, 0x54, 0xFE, 
+0xAB, 0x04, 0x2B, 0x54, 0x05, 0x7B, 0xED, 0x73, 
+0xA0, 0xBA, 0x66, 0xB3, 0x4C, 0x08, 0xD1, 0x78, 
+0xC1, 0x41, 0x96, 0x15, 0xE1, 0xEA, 0x2F, 0x25, 
+0x82, 0xC4, 0x8A, 0x99, 0x9D, 0x62, 0x79, 0x2E, 
+0x94, 0x33, 0x1A, 0x37, 0x2F, 0x1B, 0x87, 0x24, 
+0x13, 0xFB, 0xA2, 0xED, 0x00, 0x2A, 0xD1, 0xA8, 
+0x0B, 0x73, 0x8E, 0xD8, 0x0A, 0x95, 0x2E, 0xE6, 
+0xB6, 0x55, 0x8B, 0x99, 0x6F, 0x15, 0xDF, 0x9D, 
+0x22, 0x81, 0x33, 0x5E, 0x00, 0xE7, 0x42, 0xBC, 
+0xB5, 0xAC, 0x59, 0xA5, 0xEF, 0x68, 0x14, 0x65, 
+0x4D, 0x68, 0x22, 0xF1, 0x64, 0x10, 0x9E, 0x34, 
+0x9E, 0x66, 0x4E, 0x6C, 0xCC, 0x11, 0xEB, 0x15, 
+0x69, 0x62, 0x54, 0xA9, 0xDE, 0x0B, 0x53, 0xA0, 
+0x81, 0x84, 0xC1, 0x23, 0x30, 0xEB, 0xD3, 0x1A, 
+0x1B, 0xD3, 0xC3, 0xE1, 0x8C, 0xFD, 0x8B, 0x75, 
+0xB9, 0x23, 0x57, 0x67, 0x4C, 0xDE, 0x2C, 0x0A, 
+0x2C, 
+
+0x26, 0xFD, 0xF7, 0xE4, 0x14, 0x08, 0x9B, 0x2D, 
+0xD9, 0xAA, 0x66, 0x37, 0xCF, 0x70, 0xCC, 0xDD, 
+0x4D, 0x85, 0x33, 0x84, 0x7F, 0x91, 0x70, 0xA6, 
+0xC1, 0x80, 0xB5, 0xDB, 0x6C, 0x70, 0x78, 0xBB, 
+0x2E, 0xF7, 0x1B, 0xD3, 0x06, 0x25, 0xB0, 0x04, 
+0x01, 0x65, 0xC8, 0x4D, 0x0F, 0xBB, 0xBD, 0xD2, 
+0x15, 0x1C, 0x80, 0xC7, 0xE8, 0xDE, 0xDE, 0x68, 
+0xC9, 0x97, 0x82, 0x92, 0xDE, 0x12, 0x7C, 0x24, 
+0xE1, 0x0B, 0xD2, 0xEC, 0xF5, 0xA5, 0xC2, 0x80, 
+0x7D, 0x67, 0x33, 0x61, 0x58, 0x06, 0x0D, 0x4D, 
+0x97, 0x48, 0x10, 0xAD, 0xAA, 0x1D, 0x9D, 0xDA, 
+0xE0, 0xFC, 0xE6, 0xC1, 0x5F, 0x5A, 0x17, 0x5B, 
+0x64, 0x8C, 0x8D, 0x0D, 0xB0, 0x6E, 0x94, 0xBC, 
+0xD9, 0xE2, 0x77, 0xE3, 0x05, 0xD6, 0xCE, 0x99, 
+0x15, 0x70, 0x9E, 0xF1, 0xCD, 0x45, 0x78, 0x26, 
+0xD1, 0x0F, 0x47, 0xB7, 0xC6, 0x81, 0x63, 0x15, 
+0x37, 0x03, 0x97, 0xBC, 0x58, 0x70, 0x0F, 0x78, 
+0xAB, 0xD3, 0x58, 0xC2, 0x95, 0x2F, 0xED, 0xC3, 
+0x0B, 0x57, 0x3C, 0x40, 0x6E, 0xD1, 0xDE, 0x65, 
+0xDC, 0xEB, 0x54, 0xA6, 0x68, 0x57, 0x30, 0x5A, 
+0x5A, 0x62, 0xC6, 0x21, 0xA0, 0x14, 0x9C, 0x1F, 
+0xB9, 0x74, 0x24, 0xED, 0xBC, 0xEC, 0x40, 0x12, 
+0x9B, 0xCF, 0xE7, 0xEA, 0xA0, 0x9A, 0xDA, 0x28, 
+0xEC, 0xA7, 0xA3, 0x6A, 0xCA, 0x68, 0x6D, 0xAF, 
+0xAA, 0xE6, 0xCD, 0x23, 0x5B, 0x30, 0x73, 0xBA, 
+0x32, 0x31, 0xCC, 0x0B, 0xB7, 0xBB, 0xE1, 0x78, 
+0x42, 0x70, 0x92, 0xEE, 0x96, 0x08, 0x55, 0x85, 
+0xF5, 0xFC, 
+
+0x5F, 0x61, 0xA5, 0x65, 0x58, 0xE2, 0xAD, 0x90, 
+0x17, 0x39, 0xB6, 0xE3, 0x08, 0x3E, 0x9D, 0x90, 
+0x1F, 0x73, 0x82, 0x85, 0x4A, 0x86, 0xBB, 0x5F, 
+0x7D, 0x20, 0xCF, 0x04, 0x10, 0xFE, 0x79, 0xA5, 
+0x5D, 0x67, 0x80, 0x0D, 0x99, 0x1C, 0x59, 0xA5, 
+0x25, 0xCC, 0x5B, 0x1D, 0x2A, 0x45, 0xFE, 0xD8, 
+0xC7, 0x1D, 0xF6, 0xCE, 0x8C, 0x56, 0xB0, 0xA4, 
+0xC9, 0x2E, 0x64, 0xC3, 0x3E, 0x8D, 0xD8, 0xFF, 
+0xBB, 0x0C, 0x78, 0x93, 0x51, 0xED, 0x2B, 0x89, 
+0x0E, 0xB9, 0xFD, 0x2E, 0x07, 0xE5, 0x63, 0x5A, 
+0x56, 0x88, 0x00, 0x29, 0xEE, 0x48, 0x9B, 0x2C, 
+0xA0, 0x44, 0x21, 0xA2, 0xE0, 0xA4, 0xCC, 0x6A, 
+0x7B, 0x2A, 0x0D, 0x6A, 0x25, 0x44, 0x57, 0x51, 
+0x3C, 0x2F, 0x43, 0xA2, 0x3B, 0x2D, 0xB0, 0xA5, 
+0x54, 0x96, 0x9B, 0xCA, 0xFF, 0x9D, 0x7E, 0x3A, 
+0x0E, 0xCE, 0x8C, 0x51, 0x90, 0x16, 0x4D, 0xFA, 
+0x4F, 0x21, 0x3C, 0xBE, 0x2C, 0x2C, 0xDC, 0xA3, 
+0x50, 0xF0, 0x05, 0x1F, 0x42, 0x4F, 0xD6, 0xD0, 
+0x47, 0x50, 0x42, 0x7D, 0x8C, 0xE2, 0xF6, 0x79, 
+0xBA, 0xF8, 0x69, 0xB4, 0xE9, 0xD5, 0x03, 0x85, 
+0x11, 0xA7, 0x2A, 0xF2, 0xFB, 0x1F, 0x5B, 0xEA, 
+0xAC, 0x10, 0xFF, 0x60, 0x82, 0x42, 0xA9, 0xBA, 
+0xA1, 0x2C, 0x62, 0x9A, 0x87, 0x60, 0x7C, 0xA4, 
+0xF4, 0x40, 0x13, 0x4C, 0xE8, 0x9B, 0x97, 0x12, 
+0xC0, 0xA0, 0xFE, 0xAD, 0xBA, 0x0B, 0x86, 0x2E, 
+0x8B, 0x87, 0x7F, 0x67, 0x39, 0x37, 0xC9, 0x15, 
+0xAB, 0x52, 0x96, 0x07, 0x1B, 0x2E, 0xF0, 0x3D, 
+0xCF, 0x24, 0x8F, 
+
+0xC8, 0xEA, 0x72, 0x4D, 0xD3, 0x71, 0x13, 0xB2, 
+0x6F, 0x07, 0xD2, 0xE7, 0x94, 0xBA, 0xED, 0x0C, 
+0x41, 0xA3, 0xF1, 0x08, 0x33, 0xAA, 0x8F, 0x20, 
+0x5C, 0x3F, 0x68, 0xB5, 0x8A, 0xAC, 0x9A, 0xB1, 
+0xCC, 0xD1, 0x5D, 0xBC, 0xCB, 0xC1, 0xE4, 0x25, 
+0x3B, 0x02, 0xB6, 0x62, 0x11, 0x42, 0xD8, 0x6C, 
+0x4F, 0x8D, 0x40, 0xD1, 0x86, 0x87, 0x66, 0xB4, 
+0xD3, 0x2D, 0x0F, 0x7B, 0x47, 0x60, 0xF7, 0xFF, 
+0xD3, 0x52, 0x63, 0xC4, 0xD3, 0xC0, 0x28, 0xB7, 
+0xBD, 0x1D, 0x8D, 0xC9, 0xFB, 0xD2, 0x6D, 0x39, 
+0x31, 0xEB, 0x34, 0x55, 0x53, 0xA8, 0x39, 0x0E, 
+0xF6, 0xDF, 0xBE, 0x0B, 0x33, 0x61, 0x1F, 0x1B, 
+0xBF, 0xBC, 0xFB, 0xDB, 0x9E, 0x91, 0x94, 0x17, 
+0x61, 0x19, 0x85, 0x75, 0x32, 0x14, 0x6D, 0x72, 
+0xC4, 0x0B, 0xF4, 0x12, 0xE7, 0x6C, 0x79, 0x19, 
+0x2B, 0x8E, 0x09, 0x8D, 0x82, 0x8C, 0x52, 0x10, 
+0x6F, 0x1A, 0xDC, 0x41, 0x88, 0xCF, 0x2F, 0xF5, 
+0x49, 0xA9, 0x78, 0x46, 0xC9, 0x42, 0x86, 0x38, 
+0x93, 0xD5, 0xE7, 0xA0, 0x10, 0x14, 0xE4, 0x9F, 
+0xCB, 0x8E, 0x17, 0x0B, 0xD9, 0xE6, 0x97, 0xE4, 
+0x36, 0x33, 0x63, 0xB2, 0xFD, 0x45, 0x51, 0xA8, 
+0x6D, 0xA4, 0xA3, 0x61, 0xA3, 0x56, 0x84, 0x21, 
+0x68, 0x15, 0xE7, 0x00, 0x10, 0x1E, 0xE7, 0x2E, 
+0x4E, 0xFF, 0x9D, 0xAB, 0x5C, 0xB2, 0x5E, 0x4E, 
+0x50, 0xFB, 0xC6, 0xD8, 0x94, 0xE9, 0xCC, 0x0D, 
+0xFF, 0xD1, 0x07, 0xF8, 0x6E, 0x23, 0x28, 0x5B, 
+0x34, 0x55, 0x83, 0x58, 0xCB, 0x2F, 0xB3, 0x44, 
+0x8B, 0x7D, 0xC7, 0x32, 
+
+0xE4, 0x06, 0x6E, 0x79, 0x9C, 0x44, 0x08, 0x77, 
+0x20, 0xAD, 0x39, 0x24, 0x1B, 0x17, 0xE0, 0xE1, 
+0xB8, 0x0F, 0x9F, 0x87, 0xF2, 0x6E, 0x1B, 0x58, 
+0xCE, 0xAB, 0x32, 0x9C, 0x21, 0x47, 0x3E, 0xCF, 
+0xF2, 0x5C, 0xA3, 0x4F, 0x78, 0x32, 0x74, 0x95, 
+0xF0, 0x64, 0x03, 0xF8, 0xA5, 0xA4, 0x09, 0x89, 
+0xA1, 0x0E, 0x2D, 0x9C, 0x05, 0xA3, 0xFA, 0x92, 
+0xF3, 0x50, 0x23, 0xE2, 0xF2, 0x9C, 0xEC, 0x1C, 
+0xB9, 0xA6, 0x5B, 0x1F, 0x36, 0x3D, 0xE6, 0xD4, 
+0x5D, 0x0F, 0xC2, 0xA2, 0xA6, 0x5B, 0x88, 0xB2, 
+0xD8, 0xCB, 0xCA, 0xBF, 0x1E, 0xEB, 0xB2, 0x6B, 
+0x03, 0xF0, 0x71, 0x2A, 0xED, 0xD1, 0xEE, 0xB9, 
+0xF5, 0xCB, 0xC6, 0x69, 0x19, 0x64, 0x7B, 0xF8, 
+0xEA, 0x5C, 0x0E, 0x9C, 0xD1, 0xBF, 0x03, 0xAF, 
+0xE7, 0xE2, 0xB8, 0xEC, 0x56, 0xA8, 0x74, 0x70, 
+0x1D, 0xDD, 0x24, 0xFB, 0x00, 0xE9, 0x73, 0xF7, 
+0x27, 0x19, 0x42, 0x8F, 0x90, 0x8B, 0x3B, 0xE3, 
+0x23, 0x9B, 0x99, 0x04, 0xBF, 0xC0, 0x9E, 0x76, 
+0xB2, 0x1A, 0xC4, 0x7C, 0xA5, 0x62, 0x69, 0x11, 
+0x54, 0x97, 0xE4, 0xCD, 0xA8, 0xAF, 0x82, 0x40, 
+0x6C, 0x3E, 0x81, 0x10, 0x50, 0x1E, 0x08, 0xB8, 
+0x2F, 0x11, 0x92, 0xA3, 0x5D, 0x2D, 0x4B, 0x35, 
+0xC4, 0x39, 0xF7, 0xC3, 0x6A, 0xC3, 0x3C, 0x5B, 
+0x68, 0x23, 0xA8, 0x8C, 0x06, 0x36, 0x1C, 0xB7, 
+0x7B, 0x87, 0x84, 0xE4, 0x69, 0x2E, 0xB1, 0x75, 
+0x3E, 0x0C, 0x02, 0x99, 0x7F, 0xCB, 0xB6, 0x10, 
+0x63, 0xFE, 0x77, 0x6E, 0xFE, 0x44, 0x95, 0x71, 
+0x9E, 0x39, 0x9B, 0xCA, 0x3A, 
+
+0x62, 0x5F, 0x33, 0x78, 0x71, 0x46, 0xFF, 0x69, 
+0xE7, 0x15, 0x8E, 0x81, 0x84, 0x4D, 0x5F, 0x21, 
+0xF1, 0xED, 0x15, 0xFB, 0x55, 0x8F, 0x99, 0x86, 
+0x14, 0x98, 0x4D, 0x13, 0xA5, 0x65, 0xE6, 0xF8, 
+0xAD, 0x43, 0x3C, 0x41, 0xF7, 0x6C, 0xB6, 0xD1, 
+0xCA, 0x22, 0x54, 0xD6, 0x7D, 0x98, 0x97, 0x5E, 
+0x90, 0x82, 0xB4, 0x05, 0x98, 0x06, 0x66, 0xD0, 
+0x01, 0xCB, 0x44, 0xB7, 0xAB, 0xD6, 0xF2, 0xA9, 
+0xBF, 0xF4, 0xDA, 0x26, 0xB4, 0x4E, 0x77, 0x3C, 
+0x5E, 0xAB, 0x5F, 0x87, 0xB0, 0xB2, 0x1A, 0xA3, 
+0x4E, 0xF9, 0x7B, 0x3B, 0x54, 0xFB, 0x10, 0x4B, 
+0x4C, 0xFF, 0x13, 0x10, 0x3A, 0xB7, 0xDA, 0xBE, 
+0x3D, 0xA3, 0xBC, 0x80, 0x9A, 0x9F, 0x1E, 0x04, 
+0x83, 0x7E, 0xA7, 0xF2, 0x2D, 0x6C, 0xAB, 0x4F, 
+0x4A, 0x00, 0x2C, 0x5F, 0x37, 0xD2, 0x6F, 0xB9, 
+0xA2, 0xBD, 0x9E, 0x5C, 0xA6, 0x7A, 0xE3, 0x72, 
+0x1A, 0x4B, 0xE7, 0x9B, 0x38, 0x96, 0xBF, 0x7D, 
+0x91, 0x59, 0x81, 0x49, 0x00, 0x2F, 0x96, 0xA9, 
+0x73, 0xC2, 0x20, 0x17, 0x30, 0x0C, 0xB5, 0x09, 
+0xA2, 0xB7, 0x73, 0xC7, 0x77, 0xAA, 0xE0, 0xCE, 
+0xC1, 0x79, 0x26, 0x17, 0xE7, 0x36, 0x55, 0x66, 
+0x78, 0x52, 0xCD, 0x2C, 0x8D, 0x62, 0x74, 0x1C, 
+0xB9, 0x52, 0xCA, 0x3C, 0x92, 0xCF, 0x8E, 0x33, 
+0x99, 0xCB, 0x57, 0x5F, 0x6E, 0x0F, 0x15, 0x4A, 
+0x31, 0x1D, 0x4C, 0x61, 0xF3, 0x7A, 0xB5, 0x62, 
+0xD7, 0x08, 0xED, 0xB0, 0x08, 0x2B, 0xDC, 0x6A, 
+0x20, 0x65, 0x2D, 0xD9, 0xFE, 0x61, 0x05, 0xE3, 
+0x97, 0xF8, 0x6D, 0x0C, 0x2E, 0x8D, 
+
+0x64, 0xAB, 0xAC, 0x3A, 0x71, 0xB5, 0x14, 0x21, 
+0xDA, 0x03, 0x09, 0x7B, 0x7B, 0xB1, 0x59, 0xA7, 
+0x1F, 0x7E, 0xCD, 0xFF, 0xC4, 0xF0, 0xE8, 0xA4, 
+0x0C, 0xDD, 0xD9, 0x50, 0x5E, 0xF7, 0x70, 0x8A, 
+0x8E, 0xA8, 0xF1, 0x1C, 0x93, 0x13, 0xDC, 0x17, 
+0x22, 0xE3, 0xB9, 0x18, 0x6D, 0xCB, 0x57, 0x08, 
+0xAF, 0x6B, 0xC4, 0x06, 0x8B, 0x14, 0x58, 0x2F, 
+0x99, 0x1B, 0xD5, 0xED, 0x10, 0x82, 0xBA, 0x9E, 
+0x17, 0x56, 0xB5, 0xC7, 0xB7, 0x95, 0xFE, 0x0F, 
+0xC8, 0x32, 0x24, 0x4C, 0x6B, 0x8B, 0x73, 0x67, 
+0xC4, 0x07, 0x7F, 0x13, 0x3B, 0xA9, 0x57, 0x20, 
+0x42, 0x3C, 0x61, 0xFE, 0xAD, 0x5D, 0x8D, 0xEA, 
+0x35, 0x47, 0x78, 0x9F, 0xEE, 0x37, 0x96, 0x53, 
+0xAD, 0x09, 0x9E, 0xEB, 0x4D, 0x93, 0x50, 0xE3, 
+0xF2, 0xA8, 0x06, 0xAA, 0x6D, 0x2D, 0x4A, 0x93, 
+0x69, 0x75, 0x88, 0xD8, 0x4F, 0xB6, 0x7E, 0x17, 
+0x6F, 0x89, 0x15, 0xC1, 0xFF, 0xB8, 0x83, 0x90, 
+0xDD, 0xC0, 0x5C, 0x22, 0x57, 0x14, 0x70, 0x10, 
+0xBE, 0x66, 0xF2, 0x71, 0xE1, 0xED, 0x08, 0x8C, 
+0x04, 0xD4, 0xC1, 0x2C, 0xB5, 0x86, 0x69, 0x2F, 
+0x99, 0xEA, 0x44, 0xE1, 0x3D, 0x0C, 0xDA, 0xCD, 
+0x8C, 0x76, 0xD8, 0x21, 0x14, 0x6B, 0x32, 0xA0, 
+0xD9, 0x1A, 0x5A, 0x86, 0xE6, 0x39, 0x99, 0x22, 
+0x81, 0x45, 0x46, 0xEA, 0xC0, 0xA7, 0xCA, 0x8B, 
+0xB8, 0xF7, 0x9D, 0x15, 0xC5, 0x00, 0xA4, 0x7C, 
+0x64, 0x2A, 0x4C, 0x89, 0x04, 0x55, 0xB7, 0x6F, 
+0x93, 0xFD, 0x30, 0x21, 0x90, 0x7B, 0x40, 0xF2, 
+0xC3, 0x92, 0x5E, 0x38, 0xAF, 0xF2, 0xE7, 
+
+0x8A, 0xB8, 0xB3, 0xB5, 0x1D, 0x0A, 0x65, 0x6B, 
+0x22, 0x88, 0xA6, 0x52, 0xBC, 0x51, 0xBC, 0xD0, 
+0x60, 0xFF, 0x3E, 0x29, 0xCE, 0xEC, 0xA5, 0x30, 
+0xE5, 0x16, 0x1E, 0x8D, 0x15, 0x45, 0x71, 0xB5, 
+0x94, 0xA3, 0xD4, 0xCE, 0x4A, 0x4E, 0x67, 0x3A, 
+0x28, 0xD9, 0x66, 0x52, 0x84, 0xE4, 0x32, 0xC1, 
+0x60, 0xDE, 0x9A, 0xB6, 0x46, 0xFF, 0xC6, 0xC4, 
+0xE4, 0x03, 0x78, 0x03, 0xCA, 0x94, 0xCE, 0xDF, 
+0xA3, 0x36, 0x45, 0x5F, 0xBA, 0x3B, 0xE8, 0xDC, 
+0x94, 0x62, 0x2A, 0x39, 0xAF, 0x29, 0x29, 0x70, 
+0x9F, 0x43, 0x89, 0xE9, 0x9D, 0xAA, 0x6F, 0xFA, 
+0x5A, 0x4C, 0x1A, 0x6D, 0x2E, 0x8F, 0x22, 0xFA, 
+0x2E, 0x0A, 0x2B, 0xC4, 0x2C, 0xB2, 0x3E, 0x75, 
+0x2E, 0x0A, 0xD1, 0xEA, 0x39, 0x75, 0x27, 0xEB, 
+0xD1, 0xE9, 0x54, 0x4E, 0x50, 0xE5, 0xDD, 0x7C, 
+0xF0, 0x47, 0x2D, 0xF5, 0x16, 0x10, 0x4B, 0xAA, 
+0xDA, 0xEB, 0x51, 0xF7, 0xCC, 0x89, 0x94, 0x73, 
+0x3B, 0x2D, 0x01, 0x56, 0xA0, 0xF3, 0x42, 0x61, 
+0x6F, 0xC5, 0xEA, 0xB9, 0xA6, 0x84, 0x8A, 0x44, 
+0xBF, 0x6F, 0x40, 0xE0, 0x1E, 0xCB, 0x49, 0xFD, 
+0xCA, 0x4A, 0xFF, 0x84, 0x3A, 0x4E, 0xBA, 0xA4, 
+0xD1, 0x3F, 0xCC, 0x5F, 0x0B, 0x45, 0x71, 0xBA, 
+0xE0, 0x73, 0x19, 0x05, 0x9C, 0xBD, 0x82, 0x5E, 
+0xBC, 0xFA, 0x89, 0x12, 0xEA, 0xED, 0x06, 0xEF, 
+0xB4, 0x0B, 0xA5, 0x22, 0x82, 0xD1, 0x86, 0x7D, 
+0xBE, 0xF0, 0x4E, 0xDF, 0x9F, 0xDF, 0x02, 0x2F, 
+0x83, 0x69, 0x88, 0xCF, 0x1E, 0x62, 0xEC, 0x8D, 
+0xD0, 0xDF, 0x95, 0xCC, 0x49, 0x96, 0x58, 0xA2, 
+
+0x89, 0xD0, 0x65, 0x6B, 0x21, 0xE0, 0x44, 0x78, 
+0xDB, 0x9E, 0x09, 0x0E, 0xAC, 0x07, 0xE0, 0x75, 
+0x25, 0x17, 0x50, 0xBF, 0x9D, 0xA9, 0x79, 0x0C, 
+0xEC, 0x5F, 0x4D, 0xC8, 0xDE, 0xA2, 0xC7, 0x8F, 
+0x49, 0x04, 0x24, 0xC2, 0x32, 0x06, 0xA3, 0xC2, 
+0x68, 0x0E, 0xDC, 0x7B, 0xB1, 0x1E, 0xEF, 0xC5, 
+0x50, 0x01, 0xAD, 0x19, 0xA3, 0xFC, 0x21, 0x8D, 
+0x99, 0xB3, 0xD8, 0xBF, 0x0D, 0xD8, 0x6D, 0xB4, 
+0x63, 0x47, 0x2D, 0x85, 0xBA, 0x4E, 0x0B, 0xD8, 
+0x7D, 0x2E, 0xDF, 0x3E, 0x13, 0x65, 0xF2, 0xF6, 
+0x43, 0x2B, 0xDB, 0x30, 0xCE, 0x18, 0x43, 0xA2, 
+0x14, 0xC9, 0x6A, 0x5B, 0x91, 0x2B, 0x06, 0x8A, 
+0x93, 0x32, 0x1D, 0x20, 0x06, 0x89, 0x3A, 0x65, 
+0x08, 0xAC, 0x9B, 0x4D, 0x0B, 0xCF, 0x5C, 0x09, 
+0x99, 0x68, 0xEE, 0x5D, 0x2D, 0x1C, 0x10, 0xB8, 
+0xC2, 0x1B, 0x0C, 0x24, 0xF7, 0xEF, 0x8A, 0xA0, 
+0x7A, 0xB0, 0x7C, 0x21, 0xAE, 0x6F, 0x15, 0xC7, 
+0xF2, 0x99, 0x59, 0x63, 0x71, 0x29, 0x7F, 0x48, 
+0xA5, 0x02, 0xB7, 0x84, 0x78, 0xAB, 0x5B, 0x14, 
+0x12, 0x07, 0xCF, 0xC8, 0xBA, 0xE3, 0x1D, 0xF9, 
+0x6D, 0x50, 0xDB, 0x0E, 0x91, 0x6D, 0x40, 0x92, 
+0xFA, 0xDD, 0x80, 0x49, 0x4B, 0xC0, 0xDD, 0xCD, 
+0xA3, 0x72, 0xE3, 0x9E, 0x90, 0xE2, 0x20, 0x11, 
+0x88, 0x74, 0xEF, 0x26, 0x79, 0x93, 0x9E, 0x01, 
+0xC8, 0x6A, 0x31, 0x73, 0x6E, 0x51, 0xCF, 0xBA, 
+0x78, 0xF4, 0x08, 0x5D, 0xB0, 0xC8, 0xD9, 0x66, 
+0xC3, 0x25, 0xB5, 0x75, 0x82, 0xE3, 0xE8, 0x05, 
+0xB4, 0xC5, 0xCE, 0x03, 0x58, 0x9E, 0x08, 0x62, 
+0x80, 
+
+0x08, 0x6B, 0x53, 0xBB, 0x91, 0x07, 0x99, 0x62, 
+0xEE, 0x94, 0x9C, 0xC9, 0x1F, 0xA2, 0xB9, 0x53, 
+0xB1, 0xA6, 0x7A, 0xBD, 0x17, 0xFC, 0x2B, 0xFF, 
+0xF9, 0x44, 0x40, 0xC2, 0x40, 0x0F, 0xC9, 0x5B, 
+0x60, 0x2B, 0x15, 0x24, 0x66, 0xA3, 0xF1, 0x01, 
+0x16, 0x05, 0x12, 0x3E, 0x8F, 0xD8, 0x7F, 0x0D, 
+0xA1, 0x3D, 0xB1, 0x34, 0xBC, 0x6A, 0xA9, 0x74, 
+0xFA, 0xCF, 0x26, 0xF0, 0xF3, 0x83, 0xEE, 0x2F, 
+0x9A, 0x5C, 0x92, 0xCD, 0x5E, 0x71, 0xD5, 0x9B, 
+0x7F, 0xB9, 0x53, 0x5D, 0x4B, 0xEE, 0xAE, 0xED, 
+0x3C, 0xD8, 0xC0, 0xDF, 0xB7, 0xFA, 0x94, 0x31, 
+0x9A, 0x47, 0x1B, 0x2A, 0x8D, 0xF7, 0x3A, 0x68, 
+0x19, 0x80, 0x40, 0x90, 0x20, 0x81, 0x51, 0xAC, 
+0xB6, 0x6A, 0xB5, 0x35, 0x2E, 0xE6, 0xE7, 0xC4, 
+0xFD, 0x87, 0x15, 0x4F, 0x30, 0x6B, 0x5D, 0xA2, 
+0xBC, 0x58, 0xE2, 0x3D, 0x26, 0xC9, 0xA2, 0xC6, 
+0xAE, 0x19, 0xC9, 0xA5, 0xEE, 0x34, 0x1E, 0x6D, 
+0xA7, 0x5B, 0x12, 0x37, 0xEA, 0x7E, 0x44, 0xB0, 
+0x67, 0x1A, 0x19, 0x7D, 0xD1, 0x42, 0xB9, 0x5B, 
+0x79, 0x0A, 0xEA, 0x07, 0x51, 0xA1, 0xF6, 0x77, 
+0x87, 0xF2, 0xA2, 0xDD, 0x48, 0x61, 0x3D, 0xED, 
+0x2D, 0xA1, 0xF9, 0x35, 0xB5, 0x41, 0x87, 0x59, 
+0x0A, 0x9E, 0xD4, 0x34, 0x80, 0x88, 0xC5, 0x52, 
+0x1E, 0x0E, 0x0D, 0xB8, 0x78, 0x15, 0x94, 0xAF, 
+0xB4, 0x48, 0x5B, 0x7D, 0xC1, 0xA4, 0x1E, 0x70, 
+0xD8, 0x51, 0xBE, 0x9F, 0x51, 0x1E, 0x78, 0x72, 
+0xA7, 0x91, 0xA6, 0x9D, 0x54, 0x10, 0x33, 0x91, 
+0x9B, 0x90, 0x14, 0x2C, 0x11, 0x30, 0xED, 0xE8, 
+0x27, 0xBE, 
+
+0x6B, 0x05, 0x10, 0x02, 0x8A, 0x83, 0xE5, 0xF8, 
+0x2B, 0x49, 0xAB, 0x87, 0xE9, 0xE3, 0xD2, 0xC3, 
+0x62, 0xF6, 0x6F, 0x96, 0xBC, 0xE4, 0xC9, 0x49, 
+0xEB, 0x8B, 0x13, 0xA5, 0x4F, 0x45, 0xC1, 0x93, 
+0x12, 0x9B, 0x31, 0xAE, 0x5B, 0xCF, 0x1B, 0x3A, 
+0xCE, 0x3B, 0xA6, 0xF8, 0x13, 0x91, 0x8B, 0x62, 
+0x22, 0xA7, 0x3E, 0xEC, 0x13, 0x69, 0x46, 0x1F, 
+0xF9, 0x43, 0xF3, 0x2E, 0x7C, 0xD3, 0x34, 0x6A, 
+0xBA, 0x1F, 0xC5, 0x3F, 0x51, 0x29, 0x4C, 0x12, 
+0x2F, 0x1F, 0x27, 0xFC, 0xBE, 0x0E, 0x7D, 0x07, 
+0x3E, 0x47, 0xE6, 0xB8, 0xC4, 0xD5, 0xD5, 0x6B, 
+0xEC, 0xA5, 0xD5, 0xDD, 0x17, 0x02, 0x84, 0x6A, 
+0xAC, 0x40, 0x86, 0x54, 0x3E, 0x63, 0x83, 0xA9, 
+0xCA, 0x8E, 0xAE, 0xEB, 0xA9, 0xF8, 0x15, 0x4A, 
+0x40, 0xE1, 0x7F, 0x65, 0x0F, 0x7C, 0x68, 0x1E, 
+0x0D, 0xA3, 0x60, 0x3F, 0x2A, 0x4A, 0xF8, 0x26, 
+0x7D, 0x14, 0x81, 0x84, 0xFB, 0xA0, 0xE7, 0x3B, 
+0xE9, 0x1C, 0xDF, 0x02, 0xCD, 0x46, 0x5A, 0x54, 
+0x9C, 0xDD, 0x1F, 0x60, 0x36, 0xDD, 0xE6, 0xFE, 
+0x9E, 0x3C, 0x58, 0x0A, 0x95, 0x04, 0x82, 0x8F, 
+0x53, 0x4A, 0xA5, 0xF4, 0x5D, 0x2E, 0xDC, 0xAA, 
+0x80, 0x30, 0xAD, 0x30, 0xBE, 0x30, 0xFF, 0x95, 
+0xCD, 0x63, 0xBE, 0x12, 0xF3, 0x42, 0x37, 0xDC, 
+0x71, 0x8A, 0x08, 0xA8, 0xBE, 0x85, 0xF1, 0xA6, 
+0x50, 0x60, 0x65, 0xD9, 0x9A, 0x62, 0x1F, 0x4F, 
+0xEF, 0xC5, 0x47, 0x78, 0xE7, 0x7C, 0x5C, 0xF2, 
+0x0F, 0xC2, 0x27, 0x0E, 0xD0, 0x4E, 0x67, 0x81, 
+0xBE, 0x3D, 0xC1, 0xAC, 0x3F, 0x74, 0x9C, 0xC4, 
+0x45, 0xF5, 0x04, 
+
+0x12, 0xB8, 0x37, 0x41, 0xBB, 0xE1, 0x0A, 0xFF, 
+0x24, 0x88, 0x0E, 0x8A, 0xE5, 0x55, 0xB2, 0x71, 
+0x91, 0x95, 0x3E, 0x6F, 0x76, 0x7F, 0x14, 0x9F, 
+0xAF, 0xEF, 0x95, 0x52, 0x7B, 0xBC, 0xF6, 0x3F, 
+0xFA, 0x83, 0x08, 0x1D, 0xFC, 0xD8, 0xF9, 0x86, 
+0x5C, 0x88, 0x72, 0xEE, 0xD7, 0xF2, 0xAB, 0x46, 
+0x0C, 0x6D, 0x68, 0x5B, 0xDC, 0xE1, 0x00, 0xB6, 
+0x3F, 0xB4, 0x33, 0xA9, 0xA8, 0x87, 0x13, 0xAA, 
+0x33, 0x13, 0x73, 0xEA, 0x55, 0x31, 0x91, 0xE5, 
+0x66, 0x6E, 0x37, 0xD1, 0x87, 0x89, 0x2E, 0xE3, 
+0x35, 0xD3, 0xAD, 0x61, 0xB3, 0x9A, 0x57, 0x26, 
+0x43, 0x5F, 0x74, 0x86, 0x51, 0x0C, 0x6D, 0x54, 
+0xE4, 0x23, 0xEB, 0x9B, 0xF9, 0x44, 0x3E, 0x35, 
+0x07, 0x20, 0x2D, 0x4C, 0x49, 0x64, 0x00, 0xC2, 
+0xD3, 0xB8, 0x9A, 0xFF, 0x58, 0x07, 0x6C, 0x65, 
+0x42, 0xC2, 0x36, 0x43, 0xA3, 0x8E, 0x17, 0x19, 
+0x97, 0x3C, 0x3E, 0x93, 0x7D, 0x0F, 0xFE, 0x58, 
+0x7C, 0xC6, 0x54, 0x7C, 0x34, 0x6B, 0x28, 0x45, 
+0xCB, 0x8F, 0xE9, 0x9E, 0xF6, 0xDB, 0x26, 0x12, 
+0x70, 0x19, 0xB0, 0xDC, 0x9B, 0xD9, 0x90, 0xA8, 
+0x64, 0xA1, 0x6F, 0x7B, 0xBE, 0xD1, 0x04, 0x32, 
+0x11, 0xE4, 0x9F, 0xF5, 0x87, 0xCF, 0x2C, 0x75, 
+0xAF, 0x95, 0xEE, 0x9B, 0x76, 0x04, 0xA1, 0xE0, 
+0xD4, 0xD1, 0x2B, 0x2B, 0x06, 0xF6, 0x91, 0x22, 
+0x23, 0x20, 0x5C, 0x04, 0x84, 0xB4, 0x0B, 0x39, 
+0x1A, 0x83, 0x73, 0x76, 0x06, 0x65, 0xAF, 0x8F, 
+0xE4, 0x55, 0x79, 0x5E, 0xA6, 0x22, 0x19, 0x4E, 
+0x29, 0x3B, 0x0E, 0x31, 0x1D, 0x13, 0x2C, 0x51, 
+0x35, 0xBB, 0x7A, 0xBD, 
+
+0x8E, 0xC6, 0xEF, 0x7A, 0xA0, 0x05, 0x96, 0xC1, 
+0xE8, 0xB2, 0xD5, 0x52, 0xB7, 0x73, 0x19, 0x21, 
+0xD1, 0x8C, 0x6D, 0x58, 0x65, 0x81, 0x29, 0x4B, 
+0x1B, 0x4A, 0xA6, 0xC8, 0x11, 0x94, 0x60, 0x21, 
+0x42, 0xB2, 0x98, 0xE6, 0x1D, 0x13, 0x76, 0x16, 
+0xAF, 0xC1, 0x98, 0x0D, 0xD7, 0xD3, 0x42, 0x4D, 
+0x9A, 0xF3, 0x61, 0x95, 0xB1, 0x66, 0xF8, 0x62, 
+0x41, 0xC7, 0xF3, 0x41, 0xAA, 0x8F, 0x51, 0xDA, 
+0x26, 0xCD, 0x9A, 0x8E, 0xAB, 0x97, 0xC5, 0x69, 
+0xD6, 0x12, 0x30, 0x5F, 0xC0, 0x6B, 0xD0, 0x3B, 
+0x62, 0xB5, 0x28, 0x74, 0x2C, 0x9E, 0x96, 0x10, 
+0x2F, 0x8B, 0xCC, 0x8C, 0x49, 0x21, 0x15, 0xEB, 
+0x69, 0x89, 0x2C, 0xBF, 0xD7, 0xDA, 0xE9, 0x00, 
+0x40, 0x52, 0xC8, 0xBE, 0x89, 0xBD, 0x6F, 0x08, 
+0x37, 0x68, 0x8D, 0x32, 0x15, 0xBF, 0xF2, 0x01, 
+0x9F, 0x16, 0x14, 0x67, 0x5D, 0x1E, 0xE9, 0xCC, 
+0x00, 0x1C, 0xE3, 0x37, 0x64, 0xC3, 0x95, 0xDD, 
+0x3C, 0x5C, 0x29, 0x38, 0x6B, 0xA2, 0x6E, 0x8B, 
+0x45, 0xD5, 0x9E, 0x9A, 0x29, 0xC2, 0x2D, 0x91, 
+0x59, 0x91, 0x7A, 0x4F, 0xC5, 0x9A, 0xD8, 0x87, 
+0x68, 0xFA, 0x36, 0x90, 0xE4, 0x14, 0xA7, 0x4D, 
+0x8A, 0xA7, 0x1E, 0x05, 0xE4, 0x68, 0x9A, 0x6C, 
+0x60, 0x0C, 0xEE, 0x67, 0x95, 0x25, 0x5E, 0xB8, 
+0x89, 0x98, 0x01, 0x5F, 0x91, 0x6B, 0x99, 0x2C, 
+0xE5, 0x96, 0xF0, 0x5A, 0x29, 0x73, 0x31, 0x0B, 
+0xDE, 0x7D, 0xD5, 0xB6, 0x07, 0xB3, 0x14, 0x98, 
+0xB7, 0x84, 0x06, 0x57, 0x76, 0x49, 0x7E, 0xD8, 
+0x22, 0x3E, 0x1E, 0xFC, 0x56, 0x4F, 0xCE, 0x94, 
+0x64, 0x4C, 0x4F, 0xEB, 0x1C, 
+
+0xBD, 0x68, 0xD1, 0xCF, 0x54, 0xE8, 0xB2, 0xD0, 
+0x6D, 0x7B, 0x1B, 0xBF, 0xDA, 0xC6, 0x90, 0xC6, 
+0x5C, 0x49, 0x52, 0xED, 0xB3, 0x22, 0x77, 0x28, 
+0x89, 0xA8, 0x2D, 0x05, 0x85, 0x46, 0x8A, 0x24, 
+0xAA, 0xEC, 0x58, 0x7C, 0x84, 0xCE, 0x57, 0xC8, 
+0x5D, 0x47, 0x36, 0x3E, 0x64, 0x83, 0xF8, 0xDC, 
+0xD4, 0xDE, 0x7D, 0xEC, 0x95, 0x8E, 0x92, 0xB7, 
+0x69, 0x09, 0xB8, 0x2C, 0xC1, 0xBA, 0x24, 0x63, 
+0x0E, 0x14, 0x03, 0x29, 0x15, 0x3A, 0x17, 0x28, 
+0x89, 0x9D, 0xD5, 0xE2, 0x98, 0x52, 0x36, 0x6F, 
+0xAF, 0xB0, 0x0A, 0xCB, 0xC8, 0x35, 0x1B, 0xA3, 
+0xC7, 0xFD, 0x63, 0x7A, 0xE0, 0x2E, 0x1D, 0x1D, 
+0xEA, 0x0A, 0x56, 0xF4, 0xFD, 0xC2, 0x63, 0xB6, 
+0xF2, 0x88, 0xD7, 0x9C, 0x9A, 0xE6, 0x86, 0x16, 
+0x5C, 0x90, 0xAD, 0x37, 0x2F, 0x97, 0xB1, 0xAD, 
+0xDA, 0xB9, 0x46, 0xA4, 0xC0, 0xE2, 0x63, 0xDC, 
+0xC7, 0x5A, 0x70, 0x6F, 0x27, 0xA5, 0xAA, 0x21, 
+0x9A, 0x93, 0x88, 0x3E, 0x8D, 0xD0, 0xE0, 0x35, 
+0x85, 0x24, 0x8E, 0xEE, 0x93, 0x10, 0x67, 0x69, 
+0xD7, 0xB3, 0xCF, 0xFC, 0x72, 0xE4, 0xBC, 0xF9, 
+0xE1, 0xDC, 0x89, 0x69, 0x99, 0xD3, 0xDF, 0xED, 
+0x69, 0xE6, 0xB8, 0x4F, 0x5C, 0x11, 0xD8, 0xCF, 
+0x0E, 0xC5, 0x85, 0x28, 0xA6, 0x8A, 0xA7, 0xB3, 
+0x02, 0xB1, 0x13, 0xA5, 0x11, 0x32, 0x70, 0xD4, 
+0x73, 0x47, 0x70, 0x73, 0x35, 0xE1, 0x2D, 0xE9, 
+0x47, 0x08, 0xDA, 0x0E, 0xDE, 0xA9, 0xDB, 0xA7, 
+0x18, 0x1B, 0x28, 0xA7, 0x5D, 0xD4, 0xFC, 0xCA, 
+0x57, 0xAD, 0xB0, 0x08, 0x27, 0xC0, 0x08, 0x6E, 
+0x7D, 0xA1, 0xB9, 0x98, 0xDB, 0x69, 
+
+0xA2, 0xBB, 0x85, 0x4C, 0xFA, 0x2A, 0x0F, 0x72, 
+0x8B, 0x0F, 0x60, 0x71, 0xF7, 0x3A, 0x63, 0x6F, 
+0x9C, 0xF8, 0x67, 0x23, 0xF2, 0x60, 0x75, 0x33, 
+0xD4, 0xC7, 0xC8, 0x7E, 0x33, 0xB0, 0xA6, 0xC7, 
+0x32, 0xB4, 0x85, 0x3E, 0x42, 0x53, 0x8B, 0x73, 
+0xAD, 0x95, 0x44, 0x5A, 0x0E, 0x01, 0xED, 0xCF, 
+0xCE, 0x1A, 0x80, 0xA7, 0x65, 0xBA, 0x13, 0x98, 
+0x57, 0x99, 0xBB, 0x44, 0x20, 0x92, 0xB9, 0x2C, 
+0x7D, 0x14, 0xBD, 0xB6, 0xD0, 0x26, 0x7C, 0xB4, 
+0xBC, 0x97, 0x4E, 0x0B, 0x87, 0x66, 0xFB, 0x12, 
+0xAF, 0xA8, 0xC6, 0xA5, 0x70, 0x49, 0xCB, 0x95, 
+0x9E, 0xD5, 0xA6, 0xCD, 0x65, 0x77, 0x9C, 0x07, 
+0x0D, 0xF2, 0x42, 0xB5, 0x08, 0x64, 0x42, 0x72, 
+0xAD, 0x2B, 0xB6, 0x59, 0x7C, 0xA7, 0xE0, 0xDC, 
+0x70, 0x5B, 0x3F, 0x7C, 0xB6, 0xD5, 0xA4, 0xBD, 
+0xF9, 0x29, 0xAA, 0xC1, 0xF8, 0xF5, 0x23, 0xE2, 
+0x8B, 0x87, 0xEA, 0x54, 0xBD, 0xDB, 0xF1, 0xD9, 
+0x44, 0x9B, 0xF6, 0x91, 0xC4, 0x11, 0xA0, 0x2E, 
+0x14, 0x6E, 0x82, 0x56, 0x37, 0xD8, 0xC2, 0x4D, 
+0xBC, 0xB0, 0xD6, 0x43, 0x54, 0xDF, 0x03, 0xB8, 
+0xF0, 0xBD, 0xD4, 0x0C, 0x7F, 0x1E, 0x08, 0xB6, 
+0xF0, 0x70, 0x8F, 0x99, 0xF4, 0x3C, 0x8A, 0x7F, 
+0x06, 0xEF, 0x09, 0x0F, 0x85, 0x2E, 0x95, 0xED, 
+0x37, 0xC1, 0x59, 0x4C, 0x36, 0xF1, 0x22, 0xE4, 
+0x3A, 0x07, 0x4D, 0x0E, 0x3D, 0x17, 0x62, 0x16, 
+0x1D, 0x10, 0x11, 0x7C, 0xA0, 0x2E, 0xCB, 0x1F, 
+0x62, 0x55, 0x36, 0xCE, 0x23, 0x72, 0x38, 0x61, 
+0x4E, 0x33, 0x1C, 0x02, 0x6B, 0x1D, 0xAA, 0x8B, 
+0x84, 0xF3, 0xCD, 0x38, 0x3B, 0x67, 0xBB, 
+
+0x2C, 0xE5, 0xC3, 0x89, 0x21, 0x16, 0xB6, 0x76, 
+0x9C, 0x2B, 0xBA, 0x08, 0xB7, 0x28, 0x79, 0xE2, 
+0x81, 0x4B, 0x24, 0x36, 0xFA, 0x7E, 0x1D, 0x6B, 
+0x1D, 0x09, 0x17, 0x9F, 0xE7, 0x0D, 0x4D, 0x75, 
+0x24, 0x65, 0xB0, 0x1F, 0x29, 0x60, 0x99, 0x08, 
+0x47, 0xE4, 0x74, 0x1D, 0x1F, 0x75, 0xD0, 0xB9, 
+0x48, 0xFD, 0xE2, 0xA1, 0x01, 0xC2, 0xB2, 0xF1, 
+0xE5, 0xFA, 0xC2, 0xA9, 0xF6, 0xFF, 0xD7, 0xFB, 
+0xEE, 0x80, 0x94, 0x4C, 0xCE, 0x40, 0xDD, 0xB1, 
+0x03, 0xD6, 0xB6, 0x55, 0xDC, 0x5E, 0x1C, 0xB0, 
+0x1B, 0x76, 0x32, 0x8C, 0x7B, 0x31, 0x2C, 0x4E, 
+0xDB, 0xF2, 0x1E, 0xC1, 0xCC, 0xAC, 0xA7, 0x78, 
+0x2F, 0x5D, 0x4B, 0x07, 0x8B, 0xE4, 0x08, 0x77, 
+0xBE, 0x12, 0xA6, 0xE9, 0xAC, 0x8D, 0x67, 0xF4, 
+0xBF, 0xFE, 0x81, 0xC0, 0xA9, 0x36, 0xFC, 0x1D, 
+0x62, 0xE7, 0x61, 0x91, 0xD4, 0xF4, 0x41, 0x38, 
+0x3C, 0x52, 0x95, 0xD6, 0xA7, 0xE9, 0xEC, 0x38, 
+0xF5, 0x07, 0x41, 0x3B, 0x1D, 0x66, 0xAE, 0x6F, 
+0x5E, 0x1B, 0xA1, 0xE5, 0x90, 0xA0, 0x3C, 0x0A, 
+0xB4, 0xAB, 0x92, 0xF5, 0x30, 0xCC, 0xC5, 0x61, 
+0x3F, 0x19, 0xD0, 0x12, 0xB5, 0x91, 0x71, 0x4A, 
+0x0F, 0x07, 0xF7, 0x3B, 0x0B, 0xD8, 0x62, 0x27, 
+0x8B, 0xCC, 0x12, 0x0B, 0xC2, 0x67, 0x37, 0xA8, 
+0xAF, 0xA5, 0x80, 0x92, 0xFB, 0x0B, 0x8F, 0x92, 
+0xF3, 0x19, 0xA3, 0xCE, 0xD1, 0x85, 0x65, 0xF2, 
+0xAA, 0xF5, 0x55, 0xD6, 0x43, 0x49, 0x75, 0x0B, 
+0x33, 0x24, 0x15, 0xF7, 0x17, 0x87, 0xC8, 0x35, 
+0x05, 0xD4, 0x41, 0xA6, 0xE1, 0x2D, 0x40, 0x64, 
+0x1B, 0xC0, 0x43, 0x25, 0x54, 0x57, 0x85, 0x54, 
+
+0x46, 0x20, 0x80, 0xB8, 0x16, 0x2E, 0x1A, 0xCE, 
+0x7F, 0x4B, 0xC3, 0x68, 0x22, 0x97, 0x4E, 0x03, 
+0x91, 0x1B, 0xD8, 0x12, 0x10, 0x17, 0xB5, 0x7C, 
+0x0C, 0x03, 0xF5, 0x81, 0xA8, 0x73, 0x59, 0x84, 
+0x83, 0xB2, 0x20, 0x8E, 0xBB, 0x7F, 0x00, 0x9A, 
+0x53, 0x39, 0x88, 0xB7, 0x8D, 0xA9, 0x31, 0xD9, 
+0x8F, 0xBB, 0x23, 0x7F, 0xF0, 0xFB, 0x13, 0x71, 
+0xBE, 0xF0, 0xDD, 0xE8, 0x33, 0x8B, 0xB8, 0x9A, 
+0xCD, 0xD3, 0x94, 0x6A, 0x79, 0x3A, 0xAB, 0xE0, 
+0x8E, 0xA7, 0x47, 0xF8, 0x10, 0x90, 0x49, 0x73, 
+0x1A, 0xB1, 0x3C, 0x9F, 0x9B, 0x82, 0x6A, 0x4E, 
+0xC8, 0x13, 0x59, 0x3A, 0x1E, 0x62, 0x1E, 0x07, 
+0x4C, 0x78, 0x32, 0x49, 0xD0, 0x5A, 0x3D, 0xDD, 
+0x40, 0x2D, 0x5A, 0x5F, 0x71, 0xFC, 0x96, 0xF6, 
+0x44, 0xB2, 0xF2, 0xDC, 0x03, 0x1D, 0xB4, 0x8B, 
+0x22, 0x69, 0x2C, 0xEF, 0xFE, 0x4F, 0x2A, 0x24, 
+0x58, 0x62, 0x9E, 0xAE, 0xA9, 0x0E, 0x3C, 0xD7, 
+0xA7, 0xBE, 0x67, 0xCC, 0x76, 0x67, 0x48, 0x66, 
+0x30, 0xD5, 0xB9, 0x50, 0x22, 0x55, 0x39, 0x43, 
+0x07, 0x69, 0xDF, 0x4B, 0x59, 0x79, 0xD9, 0x75, 
+0xE0, 0xBF, 0xC5, 0x3C, 0x45, 0x68, 0x38, 0xE0, 
+0x93, 0x85, 0xD2, 0x5D, 0x5C, 0x21, 0x35, 0x9D, 
+0xD0, 0x27, 0x4E, 0x81, 0xB3, 0xBF, 0x8C, 0xA9, 
+0xD7, 0x90, 0xB2, 0xDC, 0x65, 0xCD, 0x57, 0x58, 
+0x9A, 0x59, 0x1E, 0x2C, 0xC3, 0xDB, 0xED, 0x41, 
+0x53, 0x30, 0xE6, 0xAD, 0x0A, 0x2D, 0x47, 0xB0, 
+0x93, 0x2F, 0x2B, 0x1F, 0x3E, 0xEB, 0xC4, 0xF4, 
+0x92, 0x8C, 0x77, 0x65, 0xD2, 0x0F, 0x80, 0xC7, 
+0xF0, 0x27, 0x0C, 0xE4, 0xDE, 0xAA, 0x52, 0x28, 
+0x0B, 
+
+0x2D, 0xD0, 0xB5, 0xA4, 0x69, 0xF3, 0xCC, 0x19, 
+0x0F, 0x0B, 0x59, 0xCF, 0x8D, 0x31, 0x90, 0x6B, 
+0x10, 0x6B, 0x78, 0xF9, 0xAF, 0x30, 0xE3, 0x2D, 
+0x2C, 0x9A, 0x1C, 0xCD, 0x7E, 0xC0, 0xF0, 0x16, 
+0xCD, 0xA4, 0x7B, 0x83, 0x51, 0x6E, 0xD4, 0xEA, 
+0xBB, 0x65, 0x53, 0x36, 0xA6, 0xFF, 0x01, 0x44, 
+0xB5, 0xBF, 0x3E, 0xEA, 0x03, 0xB5, 0xC9, 0x1A, 
+0xC4, 0x7A, 0xAF, 0x37, 0x6B, 0xBE, 0xAD, 0xFF, 
+0x1F, 0x3E, 0x5F, 0xC5, 0xFB, 0x9C, 0xBD, 0xE8, 
+0x17, 0xD0, 0x5D, 0x7E, 0x6B, 0xDD, 0xAD, 0x74, 
+0x21, 0xAD, 0x81, 0xCF, 0x54, 0x18, 0xB4, 0x96, 
+0x5D, 0x80, 0x53, 0x0F, 0x17, 0xF0, 0xDD, 0x59, 
+0x30, 0xC6, 0x81, 0x7F, 0xD8, 0x5E, 0x16, 0x50, 
+0xB6, 0x0A, 0xC1, 0x6D, 0x67, 0xD5, 0xA6, 0x75, 
+0x21, 0xB7, 0xBE, 0x6E, 0x1A, 0xF5, 0x2C, 0x66, 
+0x86, 0xB7, 0xCC, 0xA1, 0x5F, 0x48, 0xC1, 0x9C, 
+0xA7, 0xED, 0x64, 0xB5, 0x96, 0xCF, 0xEC, 0xC4, 
+0xB1, 0xD3, 0x91, 0x18, 0x7E, 0xAF, 0xB9, 0x78, 
+0x48, 0x6D, 0xF7, 0xD7, 0x22, 0x81, 0x45, 0xE8, 
+0x5C, 0xEC, 0xEC, 0x36, 0xA4, 0x0D, 0x3F, 0xA4, 
+0x61, 0x9D, 0x24, 0xA0, 0x11, 0xEC, 0x31, 0x7C, 
+0xC9, 0xAD, 0x76, 0x8D, 0xC4, 0x42, 0x62, 0x71, 
+0x7A, 0x6A, 0x62, 0x88, 0x5A, 0x5B, 0x72, 0xEA, 
+0x3B, 0xCC, 0x5A, 0x6D, 0x29, 0x9B, 0x01, 0x6B, 
+0xD0, 0x86, 0xB9, 0xA9, 0x1C, 0x46, 0x06, 0xB2, 
+0xE5, 0xD3, 0x8F, 0x19, 0x8F, 0x57, 0xB4, 0xE2, 
+0x65, 0x7D, 0x9F, 0x7C, 0xAB, 0x0A, 0x24, 0xCA, 
+0x6F, 0x06, 0xF7, 0xC4, 0x46, 0x06, 0x2A, 0xB9, 
+0x82, 0x04, 0xCD, 0xE0, 0x0A, 0x2D, 0xBF, 0xB1, 
+0xA1, 0x34, 
+
+0x00, 0x21, 0x50, 0x50, 0xA3, 0x62, 0x6B, 0x63, 
+0xB0, 0x53, 0x49, 0x44, 0xCE, 0xC7, 0x25, 0x76, 
+0x8C, 0x92, 0xE4, 0x43, 0x6C, 0xCD, 0x58, 0x2E, 
+0xA9, 0xD7, 0x7A, 0x67, 0x8A, 0x08, 0x73, 0xB6, 
+0x15, 0x33, 0x26, 0x8F, 0x06, 0xA3, 0x43, 0x9D, 
+0xF9, 0x32, 0x0D, 0x14, 0x3C, 0x4E, 0xE0, 0x79, 
+0x22, 0x81, 0x38, 0xB3, 0x95, 0x06, 0x92, 0x20, 
+0x85, 0xB4, 0x15, 0x15, 0x31, 0x04, 0x73, 0xD8, 
+0x13, 0x40, 0xB6, 0x6F, 0xC4, 0x0D, 0x23, 0x36, 
+0x9A, 0x03, 0x40, 0x10, 0x37, 0xAF, 0xB4, 0xC3, 
+0xA2, 0x20, 0xAE, 0x59, 0xC6, 0xC3, 0x8E, 0x25, 
+0x3A, 0xF1, 0xE8, 0x3D, 0x00, 0x6A, 0xB5, 0xF1, 
+0x24, 0x6C, 0x1F, 0x4A, 0x30, 0x4A, 0xA1, 0xCD, 
+0x4F, 0xC4, 0xA0, 0x18, 0xD8, 0x2A, 0x45, 0x7D, 
+0xFA, 0x7E, 0x89, 0x8A, 0x7C, 0xA2, 0x04, 0xB1, 
+0xBC, 0x6C, 0x3B, 0x7D, 0xA6, 0x19, 0x7A, 0xA2, 
+0x49, 0xEE, 0x14, 0xE4, 0xB1, 0xAF, 0xFE, 0x55, 
+0x70, 0xD4, 0xCC, 0x74, 0x10, 0x73, 0x38, 0x02, 
+0x33, 0xE9, 0x1D, 0xAD, 0xC0, 0x80, 0x04, 0x19, 
+0xD2, 0xCF, 0x5E, 0x0E, 0xC2, 0x38, 0xED, 0x51, 
+0xED, 0xEF, 0x94, 0x40, 0x78, 0x65, 0xC5, 0xD5, 
+0x77, 0xA6, 0x15, 0x0E, 0x17, 0xD1, 0x5E, 0x63, 
+0x0A, 0xBA, 0x60, 0xE9, 0x6F, 0xA7, 0x80, 0xE3, 
+0x9B, 0x6B, 0x20, 0xE8, 0x80, 0xE2, 0x6E, 0x20, 
+0x26, 0x30, 0x77, 0xD0, 0xEE, 0xEC, 0x27, 0x26, 
+0x69, 0x11, 0xBE, 0x46, 0x2D, 0x20, 0x6C, 0x05, 
+0x31, 0xCD, 0xD4, 0xAE, 0xD4, 0xFD, 0xC1, 0xD6, 
+0x00, 0xBE, 0xC3, 0x81, 0x9A, 0x70, 0x41, 0x0F, 
+0x22, 0x86, 0xDD, 0x96, 0x3C, 0x58, 0x50, 0xCB, 
+0x2F, 0x8D, 0x1A, 
+
+0x69, 0x27, 0xB4, 0x57, 0x00, 0xCA, 0xFD, 0x2C, 
+0x42, 0xC9, 0x58, 0xC1, 0xE3, 0xF8, 0x65, 0xEE, 
+0x97, 0x46, 0x68, 0x47, 0x79, 0x34, 0x04, 0xCA, 
+0x03, 0xA5, 0xB3, 0x89, 0xC5, 0x67, 0xE6, 0x25, 
+0x37, 0x80, 0xEB, 0x4E, 0x83, 0x32, 0xCA, 0xCF, 
+0x84, 0x66, 0x2A, 0x96, 0xF0, 0xF5, 0x42, 0x03, 
+0x0D, 0x67, 0xDE, 0xEF, 0x83, 0x9C, 0xF3, 0xA7, 
+0xAA, 0x47, 0x86, 0xA0, 0x62, 0xD3, 0xA4, 0x3C, 
+0x2E, 0x2F, 0x0B, 0xD3, 0x03, 0x44, 0x5E, 0xD1, 
+0xCB, 0xFE, 0x87, 0x51, 0xBA, 0xFE, 0xB2, 0xE0, 
+0x00, 0x3D, 0x8E, 0x56, 0x2F, 0x13, 0x23, 0x10, 
+0x02, 0x87, 0x43, 0xA3, 0x5A, 0x7E, 0xC7, 0x8A, 
+0x57, 0x6F, 0xBA, 0x9E, 0x73, 0x65, 0x98, 0x35, 
+0x97, 0x85, 0xE8, 0x5E, 0x92, 0x18, 0x20, 0x50, 
+0x74, 0xE3, 0x39, 0xED, 0x8D, 0x4B, 0x63, 0x64, 
+0x90, 0xE0, 0xDB, 0x7E, 0x9C, 0x82, 0x5F, 0x55, 
+0xAB, 0x0E, 0xCB, 0xE7, 0xEB, 0xDF, 0xDD, 0x0A, 
+0x7A, 0x38, 0xB5, 0x5D, 0xA6, 0x26, 0x57, 0xBD, 
+0x75, 0xE1, 0xA2, 0x96, 0x75, 0x36, 0x60, 0xEF, 
+0x4B, 0x5F, 0x34, 0x4C, 0x91, 0xB9, 0xD7, 0x6B, 
+0xD4, 0x7A, 0x35, 0xEE, 0xC4, 0xE1, 0xBB, 0x1F, 
+0xB6, 0x5C, 0xD4, 0x87, 0x1F, 0x9C, 0x85, 0x00, 
+0xC7, 0xC6, 0xF1, 0xBC, 0x88, 0xB5, 0x36, 0xA4, 
+0xCA, 0x70, 0xC8, 0x39, 0x55, 0x9F, 0xD5, 0xCB, 
+0x87, 0xC4, 0x91, 0x34, 0x34, 0x63, 0xB6, 0x8D, 
+0x0B, 0xD7, 0xF2, 0xB0, 0xE2, 0xB7, 0xC9, 0xBC, 
+0xA7, 0x2E, 0xF0, 0x72, 0x89, 0x01, 0x91, 0x99, 
+0xAC, 0xA6, 0x93, 0xFB, 0x06, 0xCE, 0xD4, 0xF4, 
+0x16, 0x3B, 0x15, 0x07, 0xA3, 0xBE, 0x83, 0x9C, 
+0xDC, 0xE4, 0x71, 0xB0, 
+
+0x99, 0xDE, 0x02, 0x3C, 0xB8, 0x71, 0xB4, 0xB8, 
+0x64, 0x98, 0xA8, 0x5F, 0x92, 0x6C, 0x90, 0xDF, 
+0x7B, 0x69, 0x30, 0x1E, 0x12, 0x00, 0x6B, 0xE0, 
+0x22, 0xCC, 0x16, 0xDE, 0xB1, 0xCC, 0x3C, 0xAF, 
+0x38, 0xF5, 0x31, 0xF9, 0x16, 0xAD, 0xBA, 0x7C, 
+0x99, 0xB7, 0x73, 0x58, 0xC0, 0x97, 0xD8, 0xF8, 
+0x12, 0x40, 0x6A, 0x8B, 0x68, 0x94, 0xD8, 0x18, 
+0x37, 0xB0, 0x9A, 0x00, 0x20, 0x0C, 0x36, 0x46, 
+0x57, 0x6B, 0x14, 0xAE, 0x14, 0x4F, 0x5F, 0x36, 
+0xE8, 0x61, 0xCD, 0x08, 0xBF, 0x85, 0xC6, 0x9E, 
+0x4A, 0x65, 0xC8, 0xA2, 0xFA, 0xBE, 0x78, 0x54, 
+0xBA, 0x37, 0x46, 0xBC, 0x4C, 0xC3, 0xAD, 0x22, 
+0xD8, 0xFB, 0x6F, 0xCE, 0x26, 0x30, 0x6A, 0x1C, 
+0x53, 0xB4, 0x88, 0x74, 0xDD, 0xB9, 0xA8, 0xBF, 
+0x3B, 0x10, 0x32, 0x82, 0x21, 0x4D, 0x9F, 0x04, 
+0xE9, 0x6C, 0x47, 0x78, 0x37, 0x30, 0x2B, 0x84, 
+0x28, 0xB4, 0x85, 0xCD, 0x18, 0x99, 0x0D, 0x64, 
+0x5A, 0x90, 0xFC, 0x8E, 0x31, 0x0B, 0x52, 0x24, 
+0x47, 0xD5, 0xD6, 0x3E, 0x6B, 0x7E, 0x3B, 0x58, 
+0x51, 0xA9, 0xF9, 0x52, 0x7E, 0x4F, 0xB5, 0x29, 
+0x46, 0xE8, 0x5C, 0x6E, 0x89, 0x27, 0xEC, 0x11, 
+0x8F, 0xF4, 0x13, 0xB6, 0x1E, 0x58, 0xAD, 0x3B, 
+0x03, 0xD9, 0x5F, 0x84, 0x0A, 0x17, 0xEE, 0xC6, 
+0xC7, 0xFF, 0x4F, 0xC1, 0x51, 0x09, 0xF0, 0x82, 
+0x75, 0xAF, 0xAE, 0x99, 0x44, 0x89, 0xCE, 0x93, 
+0x8E, 0x8F, 0x01, 0x68, 0xF1, 0x95, 0xB4, 0x9C, 
+0x7C, 0x4A, 0x36, 0xE5, 0xED, 0x92, 0xCB, 0x90, 
+0x71, 0xEA, 0xC6, 0x75, 0x19, 0xD1, 0x2D, 0x89, 
+0x65, 0x6D, 0xC3, 0x34, 0xBA, 0x78, 0xDA, 0xC2, 
+0xC3, 0x84, 0x75, 0x57, 0x73, 
+
+0x58, 0x29, 0x3F, 0x32, 0x07, 0x9A, 0x39, 0xB4, 
+0x8E, 0x2B, 0x92, 0x96, 0xCF, 0x62, 0xAD, 0x54, 
+0xA8, 0xFF, 0x36, 0x19, 0x34, 0xA2, 0xA3, 0x2A, 
+0x34, 0xD9, 0xD4, 0xDE, 0x90, 0xC5, 0x8C, 0x9F, 
+0x2D, 0xC3, 0xFB, 0x79, 0xCB, 0xCA, 0x28, 0xCA, 
+0x9A, 0xA6, 0x12, 0xF6, 0xF6, 0x75, 0x85, 0x55, 
+0x49, 0x67, 0x3A, 0x36, 0x34, 0xDF, 0x0F, 0x79, 
+0xCC, 0xAB, 0xAA, 0x2F, 0x3D, 0x1F, 0x1D, 0x19, 
+0xE4, 0x24, 0xEF, 0x39, 0xFD, 0xB2, 0x98, 0xC8, 
+0xC3, 0xE1, 0x11, 0x6E, 0x9C, 0x00, 0x50, 0x22, 
+0x15, 0x8A, 0x7A, 0x66, 0x6D, 0x63, 0x6D, 0xA1, 
+0x4F, 0x96, 0xB2, 0x5A, 0xFA, 0x45, 0xAC, 0x6E, 
+0x88, 0x6E, 0xCD, 0x0E, 0x54, 0x78, 0x26, 0x5A, 
+0x2B, 0x86, 0x3D, 0xDA, 0x18, 0x71, 0x95, 0xB8, 
+0xDB, 0xD2, 0x1D, 0x8D, 0x80, 0x08, 0x94, 0xB6, 
+0x37, 0xDC, 0x55, 0x11, 0x20, 0x34, 0xA0, 0x97, 
+0xCD, 0x0E, 0xE6, 0x23, 0xE7, 0x5E, 0xA6, 0xD0, 
+0x88, 0x8E, 0xD7, 0x6D, 0xB6, 0xAB, 0x62, 0xEB, 
+0x3B, 0x17, 0xD8, 0x1B, 0x93, 0x34, 0x22, 0xBF, 
+0x10, 0x99, 0x43, 0x9A, 0x94, 0xA6, 0xAA, 0x6B, 
+0x4D, 0x63, 0xFE, 0x80, 0xAB, 0x05, 0x03, 0xAE, 
+0x52, 0x65, 0xF3, 0x84, 0x56, 0xFE, 0xD5, 0x83, 
+0xD3, 0x58, 0x0D, 0x00, 0x4E, 0x9C, 0x2F, 0x9D, 
+0xED, 0xE5, 0xDF, 0xF4, 0xA5, 0xAF, 0x6C, 0x13, 
+0xF3, 0x0F, 0xE5, 0x2F, 0x5F, 0xF2, 0x90, 0x19, 
+0xF3, 0x96, 0xC1, 0xF2, 0x14, 0xC8, 0xB8, 0xF9, 
+0xF2, 0x5D, 0x44, 0xA4, 0x86, 0x20, 0x41, 0xC8, 
+0x19, 0x39, 0x51, 0x73, 0xCE, 0xA8, 0xCA, 0x3D, 
+0xC9, 0xA2, 0x93, 0xFC, 0xC9, 0x42, 0xBD, 0xA7, 
+0x02, 0x27, 0x47, 0x29, 0xB1, 0x6C, 
+
+0x63, 0xBB, 0x48, 0x82, 0xA3, 0xD7, 0x27, 0xAD, 
+0x2A, 0x6E, 0x75, 0x70, 0xAF, 0x12, 0xB8, 0xF0, 
+0x5D, 0x68, 0x4A, 0x4B, 0xDD, 0x1B, 0x30, 0xDC, 
+0xCA, 0xF5, 0xA1, 0xB1, 0xD5, 0x52, 0xDC, 0xB0, 
+0xBA, 0xB5, 0x70, 0x86, 0x8C, 0xBC, 0x7B, 0x1F, 
+0xBC, 0xB1, 0x68, 0x6F, 0x19, 0x65, 0xC5, 0x0F, 
+0xC9, 0xF5, 0xA4, 0x47, 0x0E, 0x7E, 0x8C, 0xBB, 
+0xFA, 0x11, 0x7D, 0xA5, 0x4E, 0x46, 0xBB, 0x9F, 
+0x89, 0x25, 0x58, 0xD0, 0x74, 0x1A, 0xA7, 0x9B, 
+0xA2, 0x29, 0x4B, 0x0D, 0x83, 0x99, 0x31, 0x04, 
+0x59, 0xEC, 0xE3, 0x6A, 0x9B, 0x78, 0x85, 0xBA, 
+0xD1, 0xC8, 0x2E, 0xDC, 0xC9, 0x8F, 0x39, 0x97, 
+0x96, 0xF9, 0x34, 0x93, 0x68, 0xEC, 0x00, 0xA6, 
+0xCA, 0x59, 0x1E, 0x96, 0x52, 0xD6, 0xAF, 0x62, 
+0x47, 0x53, 0x3D, 0x1F, 0x18, 0xCA, 0xCF, 0xE5, 
+0xA4, 0xA1, 0x86, 0x43, 0x5D, 0x77, 0xB4, 0x59, 
+0x3C, 0x4E, 0x60, 0xA8, 0x6C, 0x4C, 0x67, 0x80, 
+0xA3, 0xEF, 0x3C, 0x21, 0x30, 0x3A, 0xA2, 0xC0, 
+0xA9, 0x0B, 0xAF, 0x32, 0xB5, 0x43, 0x28, 0x6F, 
+0xF8, 0x6F, 0x70, 0x32, 0xB1, 0xC1, 0x45, 0xA9, 
+0xFF, 0xFD, 0x3A, 0x3A, 0x2C, 0xDA, 0x84, 0x8B, 
+0xAB, 0xFB, 0x5F, 0x03, 0xDB, 0x34, 0x8A, 0xDF, 
+0x72, 0x7D, 0xBD, 0x5D, 0x91, 0xC6, 0x15, 0xFD, 
+0x35, 0x3A, 0x57, 0xC3, 0x3E, 0x07, 0x1C, 0xA6, 
+0xBA, 0xFC, 0xC8, 0x29, 0x7E, 0x39, 0x93, 0xB6, 
+0x28, 0xFF, 0x50, 0xA1, 0x07, 0x3B, 0x5D, 0xB8, 
+0x99, 0x68, 0x59, 0xB0, 0xFB, 0x9F, 0xB5, 0xE9, 
+0x2E, 0xD0, 0x62, 0xF2, 0x3C, 0x1D, 0x4D, 0xB2, 
+0x1E, 0x1D, 0x08, 0x07, 0xB6, 0x35, 0xB5, 0xA5, 
+0x83, 0xFB, 0xD3, 0x23, 0x5E, 0xCA, 0xA9, 
+
+0xE0, 0x06, 0x14, 0xAC, 0x55, 0x18, 0xF6, 0x8D, 
+0x98, 0x84, 0x82, 0x66, 0xB8, 0x34, 0x97, 0xB7, 
+0xB2, 0x93, 0x54, 0x05, 0xA5, 0xB0, 0x5E, 0xB9, 
+0xE2, 0xB4, 0xCC, 0x32, 0x4A, 0x56, 0x56, 0x33, 
+0xDA, 0x08, 0x8B, 0x7A, 0xC9, 0x2E, 0x23, 0x40, 
+0xB8, 0xC1, 0x04, 0x93, 0xDF, 0x23, 0xBE, 0x43, 
+0x22, 0x2B, 0x3D, 0x08, 0x73, 0xD7, 0x8F, 0x86, 
+0x71, 0xA0, 0x7A, 0x68, 0x67, 0xE8, 0x84, 0x75, 
+0x41, 0xA2, 0x1F, 0xDA, 0xF5, 0x09, 0xCC, 0x2D, 
+0x56, 0x7E, 0x05, 0x61, 0x9E, 0x32, 0x33, 0xC9, 
+0x28, 0x67, 0xDA, 0xC1, 0x5C, 0xB6, 0xB6, 0x57, 
+0xA7, 0x67, 0x7F, 0xFF, 0x47, 0xBE, 0xB3, 0xBD, 
+0xDB, 0xE8, 0x2D, 0x60, 0x87, 0x6E, 0xA8, 0xAC, 
+0xD8, 0x95, 0xB6, 0xA7, 0x02, 0x92, 0x01, 0xC2, 
+0xBD, 0xF6, 0xE1, 0x4E, 0x66, 0x76, 0x30, 0x4B, 
+0x10, 0x7A, 0xF2, 0x78, 0x5A, 0x81, 0x15, 0xC3, 
+0x2E, 0x45, 0xAE, 0x8B, 0xE8, 0x7C, 0x91, 0xAE, 
+0x6C, 0xFE, 0x0B, 0xC0, 0x11, 0x15, 0xCF, 0x35, 
+0xA7, 0xFE, 0x23, 0xCD, 0x0E, 0x5A, 0xF9, 0xD1, 
+0xD7, 0x15, 0x2D, 0xC8, 0x59, 0x0B, 0xA5, 0xF4, 
+0x07, 0xC3, 0x23, 0x56, 0x90, 0x3B, 0x85, 0x1E, 
+0x86, 0xC0, 0x98, 0xA9, 0x6B, 0xF7, 0xA3, 0x8E, 
+0x48, 0x92, 0x5A, 0xF3, 0x6C, 0x4D, 0x81, 0x92, 
+0x0A, 0x46, 0x2B, 0x2B, 0x5B, 0x86, 0xF1, 0xCA, 
+0xA4, 0x63, 0x50, 0x24, 0xE1, 0x3D, 0x80, 0x13, 
+0x5B, 0x00, 0xE0, 0x2C, 0x57, 0xBE, 0x50, 0x85, 
+0x57, 0x18, 0x1F, 0x9D, 0xAE, 0x1A, 0x1A, 0x43, 
+0x44, 0x52, 0x83, 0x3C, 0x8B, 0xE8, 0x31, 0xB8, 
+0x7D, 0x53, 0x04, 0x3F, 0x39, 0x12, 0x30, 0x62, 
+0x78, 0x78, 0xA6, 0x1A, 0xAE, 0x8E, 0x9A, 0xC5, 
+
+0xB7, 0x37, 0x11, 0xE5, 0xDB, 0xED, 0xC2, 0xB2, 
+0xF8, 0xF2, 0x85, 0x36, 0xB1, 0x2C, 0xB5, 0xBE, 
+0x1F, 0x98, 0x77, 0x0D, 0x94, 0xF8, 0x9A, 0xBA, 
+0xD1, 0x7C, 0x17, 0x53, 0xF7, 0x7B, 0x18, 0x5D, 
+0x7F, 0x4F, 0xB1, 0xE9, 0x0D, 0x76, 0xAA, 0x85, 
+0xE7, 0xC6, 0xB2, 0x38, 0xCF, 0xF5, 0x8C, 0x5F, 
+0x15, 0x7B, 0x0E, 0x40, 0x40, 0xDA, 0xD9, 0xEE, 
+0xCC, 0x7D, 0x8A, 0x92, 0xFD, 0x7C, 0x64, 0xC5, 
+0xCE, 0x41, 0x54, 0xCF, 0x35, 0xEA, 0xDD, 0x94, 
+0x83, 0x75, 0x79, 0x99, 0x02, 0xCC, 0x0B, 0x0A, 
+0x30, 0x88, 0x34, 0xB4, 0x94, 0x33, 0x4D, 0xA5, 
+0x3E, 0x55, 0x54, 0xA3, 0xFE, 0xAC, 0x35, 0xAD, 
+0x37, 0x94, 0xCD, 0x5D, 0x13, 0x83, 0x8C, 0xE9, 
+0xF5, 0x81, 0x41, 0x85, 0xB4, 0xB7, 0x1A, 0xB3, 
+0x19, 0x6C, 0x0A, 0xF9, 0x52, 0xA4, 0x7A, 0x2C, 
+0x29, 0x56, 0x07, 0xC9, 0xB9, 0x90, 0x15, 0x5A, 
+0x83, 0x0D, 0x47, 0xAE, 0xF1, 0x0F, 0xED, 0x8F, 
+0x10, 0xA6, 0xC5, 0x95, 0x60, 0x6D, 0x8C, 0xD8, 
+0x79, 0x0C, 0x7C, 0x11, 0x57, 0x36, 0xED, 0x03, 
+0x2D, 0x79, 0xB4, 0xA9, 0x83, 0xE7, 0x6A, 0x9D, 
+0x3E, 0x9F, 0xD8, 0x08, 0x78, 0x63, 0xC2, 0x83, 
+0x8D, 0x64, 0xEA, 0x9D, 0x6F, 0x67, 0x3B, 0x80, 
+0x0B, 0xF8, 0xA7, 0x78, 0xE7, 0x82, 0x54, 0xB5, 
+0x8F, 0x63, 0xED, 0x39, 0x70, 0x8C, 0x09, 0x15, 
+0x2D, 0x20, 0xA5, 0xD1, 0xEA, 0xB3, 0x5B, 0x22, 
+0xB8, 0xBD, 0xF5, 0x1F, 0x36, 0x40, 0x6E, 0xC5, 
+0x07, 0xDA, 0xB3, 0xA7, 0x2D, 0x78, 0xDB, 0x51, 
+0x0F, 0xC0, 0xA5, 0x8E, 0x7A, 0x88, 0x12, 0x4A, 
+0x99, 0x85, 0xCA, 0x04, 0x0D, 0x47, 0x8C, 0xD2, 
+0xF0, 0xC7, 0x43, 0x72, 0x5B, 0x3D, 0xDE, 0xA6, 
+0x18, 
+
+0x3C, 0x29, 0xD1, 0xCC, 0x4D, 0x6F, 0xA1, 0x30, 
+0x6B, 0xAD, 0xFF, 0x0A, 0xED, 0x6E, 0x4E, 0x61, 
+0x21, 0xA3, 0xC7, 0x6A, 0x4D, 0xBE, 0x39, 0x19, 
+0x15, 0x4D, 0x4A, 0xFC, 0x43, 0x5C, 0x8E, 0x42, 
+0x3D, 0x46, 0x99, 0xC8, 0xA9, 0x97, 0xBA, 0x58, 
+0x84, 0xF0, 0x39, 0x3F, 0xEF, 0x0F, 0x1C, 0x8A, 
+0xA3, 0xFD, 0xDE, 0x06, 0xA1, 0x23, 0x51, 0xA6, 
+0xEC, 0xDF, 0x0A, 0xB2, 0xF8, 0x12, 0x3E, 0x2D, 
+0x9E, 0x1D, 0x86, 0x28, 0x12, 0xC0, 0x9D, 0x70, 
+0x94, 0x5E, 0x93, 0x85, 0xF8, 0x11, 0x66, 0x80, 
+0x9F, 0x60, 0xD8, 0xA1, 0x42, 0x52, 0x09, 0x45, 
+0x60, 0x49, 0xE0, 0x71, 0x70, 0xB6, 0xBB, 0x8D, 
+0xB7, 0x9A, 0x90, 0x83, 0x61, 0x84, 0xCA, 0xF7, 
+0x18, 0xE7, 0xA3, 0x7A, 0x29, 0x9E, 0xD2, 0x1D, 
+0x24, 0x0C, 0xFD, 0x0A, 0x33, 0x28, 0xEB, 0x72, 
+0x93, 0xAB, 0x23, 0x0D, 0x90, 0xB5, 0xDF, 0xFF, 
+0x63, 0x42, 0xAB, 0x46, 0x53, 0xF0, 0x03, 0xD1, 
+0x77, 0x30, 0x54, 0x81, 0x17, 0xFB, 0x68, 0x87, 
+0xC7, 0x1A, 0xB0, 0xC6, 0xB6, 0x41, 0x5D, 0x21, 
+0xA5, 0xDD, 0x49, 0x52, 0x4A, 0x88, 0xCF, 0xA7, 
+0x5A, 0xC1, 0x85, 0x5E, 0x19, 0x14, 0x48, 0x6F, 
+0xC7, 0x1D, 0x5E, 0x4D, 0x7A, 0xE1, 0xD9, 0x26, 
+0x85, 0x1A, 0x7B, 0xB3, 0xF0, 0x93, 0x8D, 0x63, 
+0x13, 0xD2, 0xBB, 0x05, 0xCE, 0xA0, 0x88, 0xFF, 
+0xE8, 0x5F, 0x7E, 0xC9, 0x36, 0x34, 0x46, 0xEB, 
+0xD7, 0x92, 0xAD, 0xD6, 0x2C, 0x61, 0x21, 0x08, 
+0x29, 0x13, 0xC2, 0xB5, 0xF9, 0xBC, 0xF6, 0xBE, 
+0xA2, 0x90, 0x19, 0xF4, 0x9C, 0x79, 0x5E, 0x8F, 
+0x4C, 0xCC, 0xD0, 0x5B, 0x0E, 0xD1, 0x1A, 0x94, 
+0x69, 0x18, 0x7F, 0x3F, 0x72, 0xA0, 0x21, 0x46, 
+0x4A, 0x54, 
+
+0x23, 0xBF, 0xF9, 0x4E, 0x57, 0x64, 0x4F, 0x7D, 
+0x61, 0x57, 0xBD, 0x07, 0x4C, 0x3C, 0xB2, 0x73, 
+0xF8, 0x9A, 0x8D, 0x52, 0x9B, 0x7E, 0x86, 0x43, 
+0x75, 0xF5, 0x8A, 0xD8, 0x5A, 0x34, 0x69, 0x56, 
+0xD1, 0x72, 0xF4, 0x9F, 0xA0, 0xFF, 0x97, 0x93, 
+0x11, 0x25, 0x8F, 0x68, 0x27, 0xA1, 0x43, 0x74, 
+0x81, 0x3E, 0xF9, 0xB4, 0xC4, 0xFC, 0xAB, 0x6E, 
+0xB7, 0x55, 0x47, 0x03, 0x18, 0xFD, 0xEE, 0xAD, 
+0x69, 0x96, 0x08, 0x29, 0x63, 0x45, 0x1E, 0x3E, 
+0x15, 0xDF, 0x79, 0x58, 0x8C, 0x8E, 0x1C, 0x27, 
+0xC4, 0xB3, 0xB9, 0x0C, 0xDB, 0x18, 0x5E, 0xED, 
+0xC6, 0x88, 0x80, 0x87, 0x10, 0xAE, 0x8B, 0x8D, 
+0xA9, 0xC9, 0x5A, 0x55, 0x0D, 0x2B, 0x74, 0xFE, 
+0x03, 0xC8, 0x68, 0x11, 0xA3, 0x38, 0xDD, 0x04, 
+0xE8, 0xEF, 0x17, 0xE4, 0x12, 0x5F, 0xC9, 0x75, 
+0xDA, 0x67, 0x46, 0xD3, 0x82, 0x90, 0xA0, 0xE3, 
+0x79, 0x10, 0x18, 0x44, 0xD0, 0x10, 0x2F, 0x8C, 
+0x55, 0xE4, 0x94, 0x0C, 0xA6, 0xFD, 0xE8, 0x39, 
+0x08, 0x15, 0xC9, 0x98, 0x39, 0xC6, 0xE0, 0xE7, 
+0x13, 0xFF, 0xB7, 0xC7, 0x2D, 0x1C, 0x59, 0x47, 
+0x1F, 0x17, 0x0A, 0xB4, 0xF7, 0xDF, 0xE4, 0x42, 
+0x10, 0xD6, 0x08, 0x08, 0x63, 0x85, 0x45, 0x6F, 
+0xB6, 0x37, 0x1B, 0x2B, 0xF9, 0xC2, 0xF9, 0xC2, 
+0x51, 0xF5, 0x66, 0x26, 0x5A, 0x24, 0xAE, 0xE0, 
+0xC6, 0x27, 0xC5, 0x4D, 0x9B, 0x3E, 0xF3, 0x8B, 
+0x13, 0x66, 0xC7, 0xE0, 0xB5, 0x97, 0x2F, 0x54, 
+0xDD, 0xFE, 0x96, 0x94, 0xB9, 0xEE, 0x50, 0x53, 
+0xB9, 0xCF, 0xDF, 0x39, 0xCF, 0x52, 0x4E, 0xF7, 
+0x8C, 0x5C, 0x10, 0xF7, 0x3F, 0x88, 0xEA, 0x9C, 
+0x00, 0xFB, 0xDB, 0xE6, 0x26, 0xD5, 0x5F, 0x48, 
+0xF3, 0xC8, 0x7D, 
+
+0xD4, 0xA5, 0xF1, 0x78, 0x4D, 0x43, 0xA3, 0xE0, 
+0x8F, 0x10, 0xA3, 0x45, 0x4F, 0xD9, 0x60, 0x15, 
+0x54, 0xDB, 0x7F, 0x1C, 0xF2, 0xBA, 0x6B, 0xF5, 
+0xE2, 0x4A, 0x91, 0x58, 0x0F, 0x99, 0x06, 0xA8, 
+0x4E, 0xC9, 0x5B, 0xD4, 0xD5, 0x94, 0x13, 0x17, 
+0x6C, 0xA8, 0x63, 0xFC, 0xF7, 0xB7, 0x75, 0x76, 
+0x03, 0x85, 0x80, 0xB8, 0xCC, 0x55, 0x1F, 0x78, 
+0x55, 0xB0, 0xA9, 0xD8, 0xAC, 0x29, 0xEE, 0xFD, 
+0xE4, 0x71, 0xCC, 0xA8, 0x75, 0x55, 0x8C, 0xB9, 
+0xCF, 0x7F, 0x25, 0x82, 0x13, 0x63, 0x7C, 0x59, 
+0x53, 0xB5, 0xC7, 0xAE, 0x03, 0x05, 0x3C, 0x59, 
+0x61, 0x0E, 0x8E, 0x83, 0x22, 0x46, 0x12, 0x3B, 
+0x64, 0x0B, 0x5C, 0xBC, 0x07, 0xEF, 0x19, 0xB2, 
+0xEC, 0xC2, 0x06, 0xB2, 0x37, 0xEB, 0xAB, 0xF1, 
+0x1F, 0xAD, 0x31, 0x43, 0x95, 0x76, 0xE9, 0x91, 
+0xCD, 0xAD, 0xC0, 0x11, 0x73, 0xF8, 0x46, 0xCF, 
+0xAC, 0xEB, 0xC2, 0x14, 0xEE, 0x85, 0x18, 0x48, 
+0x9F, 0xB6, 0xDC, 0x87, 0x7F, 0x45, 0x05, 0x5E, 
+0x23, 0xE3, 0xD6, 0x27, 0x2E, 0xEF, 0xC2, 0x18, 
+0x0A, 0x32, 0x47, 0x50, 0x2E, 0x71, 0xBA, 0x2F, 
+0x20, 0xAD, 0xCB, 0xE0, 0x7B, 0xCA, 0x8D, 0x4B, 
+0x6C, 0x9E, 0x93, 0xE1, 0xAE, 0xF5, 0xAF, 0xE2, 
+0x08, 0x6F, 0x93, 0xDE, 0x65, 0xF2, 0x5B, 0xF1, 
+0x04, 0xE5, 0x5C, 0xCC, 0x03, 0xB1, 0x17, 0x4A, 
+0x10, 0x92, 0x51, 0x7E, 0x4E, 0xC9, 0x1D, 0x5F, 
+0xF4, 0x98, 0x40, 0xB0, 0x84, 0xB5, 0x65, 0xAA, 
+0xA7, 0x41, 0x88, 0x68, 0x87, 0xA7, 0x75, 0xCF, 
+0xA2, 0x60, 0x38, 0x1A, 0x1C, 0xD6, 0x42, 0xB2, 
+0x08, 0xF3, 0xFE, 0x30, 0xD5, 0x91, 0x1F, 0xF3, 
+0x29, 0x57, 0xCF, 0xB6, 0x0F, 0x86, 0x0D, 0xEF, 
+0xD3, 0xD0, 0xA9, 0xAF, 
+
+0x11, 0x1D, 0xF5, 0x5A, 0x6D, 0x89, 0x04, 0x16, 
+0x05, 0x2B, 0x34, 0x1C, 0x6E, 0x6B, 0x6A, 0xB3, 
+0x92, 0x7D, 0x96, 0x61, 0xCD, 0x74, 0x3A, 0x21, 
+0x40, 0x67, 0xFE, 0x1F, 0xF8, 0x33, 0xA6, 0xB4, 
+0xD3, 0x1F, 0x48, 0x85, 0xB5, 0xB9, 0x15, 0x83, 
+0xB4, 0x19, 0xC0, 0x60, 0x05, 0x24, 0xB6, 0xB3, 
+0xDF, 0x98, 0xA0, 0xDD, 0x38, 0x7D, 0x8A, 0x95, 
+0xC9, 0xD4, 0x4E, 0xBA, 0xCA, 0x62, 0xF9, 0xA5, 
+0x7A, 0xD3, 0xC9, 0x27, 0x76, 0x04, 0xFB, 0x41, 
+0xA3, 0xCC, 0x0E, 0xCF, 0xAB, 0xA5, 0x7E, 0xD9, 
+0x25, 0x60, 0x36, 0x68, 0x53, 0x97, 0xB5, 0x5C, 
+0xF0, 0xDE, 0x5C, 0x28, 0x3F, 0x41, 0xFE, 0x40, 
+0x7F, 0x26, 0x8C, 0x50, 0x4F, 0x27, 0x72, 0x72, 
+0x8C, 0x65, 0x8D, 0x2C, 0x10, 0xFD, 0xEE, 0x8A, 
+0xB3, 0x1D, 0xC4, 0xB7, 0x91, 0x32, 0x96, 0x2B, 
+0x0E, 0xBF, 0x0B, 0xE4, 0x30, 0x44, 0x6C, 0xAD, 
+0xEB, 0x13, 0x21, 0x07, 0x13, 0xED, 0x9D, 0x67, 
+0x69, 0x39, 0x5D, 0xC1, 0xD6, 0xEA, 0xD1, 0xB3, 
+0xE3, 0x9E, 0xA2, 0x1F, 0xB0, 0xA9, 0xA5, 0xAF, 
+0x39, 0xBD, 0x69, 0xA6, 0x93, 0xBE, 0x12, 0xD0, 
+0x0A, 0x31, 0xEC, 0xE1, 0x7D, 0xC7, 0x54, 0x43, 
+0xDF, 0x25, 0x39, 0xDB, 0x9E, 0x53, 0xD0, 0xD7, 
+0x6D, 0x14, 0x50, 0xF1, 0xB2, 0x62, 0xBA, 0xBE, 
+0xFC, 0xC2, 0x9D, 0x63, 0x42, 0x4A, 0xC3, 0x21, 
+0x04, 0x25, 0x97, 0xF3, 0xA7, 0xD1, 0x4B, 0xF7, 
+0x4C, 0x4D, 0xF5, 0x10, 0xB9, 0xDA, 0x7E, 0x62, 
+0xA3, 0x77, 0x96, 0xB3, 0xCE, 0xCC, 0x79, 0x63, 
+0xEF, 0xFB, 0x74, 0x40, 0xE3, 0x54, 0x1F, 0x76, 
+0x7C, 0xA8, 0xBA, 0xA1, 0xD5, 0x62, 0x93, 0x73, 
+0x85, 0x9D, 0xB1, 0x43, 0x7A, 0xA2, 0xA1, 0xF6, 
+0xF5, 0x82, 0x03, 0x9A, 0x63, 
+
+0xF3, 0x5D, 0x4A, 0x30, 0xF4, 0x59, 0x5E, 0x30, 
+0x9D, 0x93, 0x93, 0x27, 0x01, 0xBB, 0x72, 0x02, 
+0x4B, 0x02, 0x3E, 0x58, 0xED, 0x86, 0xA5, 0x09, 
+0x51, 0xD9, 0xE0, 0x7F, 0x82, 0x37, 0x10, 0x4C, 
+0xD9, 0x66, 0xF2, 0x01, 0xDA, 0x44, 0x65, 0x6A, 
+0xBA, 0xA7, 0xD3, 0xBA, 0x95, 0xA0, 0xBA, 0xF7, 
+0x47, 0xB7, 0x13, 0x36, 0x36, 0x1D, 0x7B, 0x8A, 
+0x27, 0xC2, 0x1E, 0x29, 0xE7, 0x46, 0x50, 0x70, 
+0x88, 0xFD, 0xB6, 0xD9, 0xF6, 0x95, 0x58, 0x2B, 
+0x09, 0xE8, 0x04, 0x1A, 0x0B, 0x28, 0xD0, 0x12, 
+0x47, 0xDC, 0x03, 0x3A, 0x2B, 0x91, 0x5C, 0x15, 
+0x7E, 0x45, 0xE3, 0xAC, 0xD9, 0x19, 0x40, 0x1B, 
+0x44, 0x16, 0x7B, 0xBE, 0xD2, 0xB9, 0x4F, 0x60, 
+0x8E, 0x9C, 0x4C, 0x6C, 0xE9, 0x31, 0xF3, 0xA9, 
+0x0F, 0xE0, 0x0D, 0x95, 0xEB, 0x63, 0x75, 0x90, 
+0xC6, 0xA6, 0xF1, 0xCA, 0x7E, 0xBB, 0x15, 0x4C, 
+0xBD, 0x60, 0x25, 0xB3, 0x23, 0xC5, 0xF9, 0xF7, 
+0xBF, 0x17, 0x98, 0x60, 0xCD, 0x1B, 0x90, 0xFC, 
+0x74, 0x85, 0x67, 0xA4, 0x1E, 0xDB, 0x2A, 0x5B, 
+0xA4, 0x48, 0x79, 0xFF, 0x2E, 0x9F, 0x1D, 0x8E, 
+0xE0, 0xEA, 0xEA, 0xC8, 0xEC, 0x7D, 0x38, 0x61, 
+0x84, 0x8B, 0x3C, 0x0C, 0xC4, 0x85, 0x28, 0xAE, 
+0x64, 0x78, 0xA8, 0x2B, 0x57, 0xC6, 0x5E, 0xBD, 
+0x4F, 0x2C, 0xD3, 0x21, 0xC5, 0xD3, 0x63, 0x21, 
+0xDC, 0x83, 0x4A, 0x57, 0xD3, 0x6F, 0x0F, 0x8E, 
+0xF9, 0x81, 0x0D, 0x5E, 0x87, 0x4F, 0x92, 0x7C, 
+0xB9, 0xE3, 0xDC, 0x08, 0xC4, 0xF7, 0x0C, 0x5E, 
+0xAA, 0x76, 0x42, 0x4F, 0x5F, 0x73, 0x52, 0xAE, 
+0x26, 0xC6, 0x4C, 0xE5, 0x9E, 0x05, 0xF8, 0x05, 
+0xB0, 0xC3, 0x1B, 0xE9, 0xAE, 0x8C, 0x75, 0xB9, 
+0x8A, 0xAB, 0xF2, 0xBB, 0xE2, 0xA2, 
+
+0x53, 0x3C, 0xF0, 0x89, 0xC6, 0xEE, 0xAF, 0xA8, 
+0x4B, 0xB5, 0x59, 0x09, 0x71, 0x38, 0xEB, 0x5C, 
+0x4C, 0x99, 0xEE, 0x64, 0x9E, 0x84, 0xFA, 0x10, 
+0x2B, 0x02, 0x6F, 0x7B, 0x84, 0x86, 0xD9, 0x99, 
+0x30, 0x9E, 0x09, 0x46, 0xE7, 0x71, 0xAF, 0x43, 
+0x94, 0x4B, 0x8F, 0x28, 0x29, 0x7D, 0xBC, 0xA9, 
+0xCD, 0x41, 0xAC, 0xF5, 0xED, 0xA6, 0xDE, 0xA5, 
+0x0B, 0x5D, 0x4F, 0x3F, 0x52, 0xF6, 0x86, 0x98, 
+0x62, 0x9A, 0xCA, 0x20, 0xF0, 0x46, 0x6A, 0x72, 
+0x64, 0x65, 0xE2, 0x03, 0xCA, 0xAB, 0xB4, 0x0C, 
+0x0D, 0xB7, 0xAA, 0xEA, 0x17, 0xE2, 0x23, 0xEA, 
+0x96, 0xFF, 0x41, 0x60, 0x29, 0xC9, 0x18, 0x84, 
+0xF5, 0x69, 0x68, 0x4A, 0x00, 0x99, 0x45, 0xF8, 
+0x62, 0xC9, 0x48, 0xC2, 0x17, 0xF6, 0x0F, 0x41, 
+0xA5, 0x47, 0xBB, 0x50, 0xD5, 0x0F, 0x24, 0xAB, 
+0x92, 0x91, 0x78, 0xD2, 0xE4, 0x8B, 0x19, 0x32, 
+0x6C, 0xCC, 0xFF, 0x7F, 0xD9, 0x8F, 0x01, 0x92, 
+0xB2, 0xC9, 0x27, 0x6F, 0x39, 0x29, 0x01, 0xAF, 
+0xBB, 0x6F, 0xFE, 0xB3, 0x68, 0xF0, 0x23, 0x5A, 
+0x94, 0xEC, 0x3F, 0x7E, 0x90, 0x05, 0x61, 0x79, 
+0x3B, 0x65, 0x2F, 0x06, 0x09, 0xEF, 0x80, 0xA2, 
+0xC8, 0x94, 0xD2, 0x4E, 0x45, 0x64, 0x20, 0x5B, 
+0x5C, 0x99, 0x37, 0xCB, 0xF6, 0x5A, 0x37, 0xE2, 
+0xE2, 0x9A, 0x09, 0xC7, 0x40, 0x40, 0xE9, 0x70, 
+0x8C, 0x9F, 0x00, 0xE6, 0x3C, 0x95, 0xFA, 0xD0, 
+0xC7, 0x70, 0xC9, 0x21, 0x7A, 0x6A, 0x16, 0x7F, 
+0x21, 0xCD, 0x82, 0xB3, 0xAB, 0xCC, 0x24, 0x7E, 
+0x18, 0x71, 0x9F, 0x20, 0xB0, 0xC5, 0xB5, 0x54, 
+0xF9, 0xA5, 0x04, 0x60, 0xB3, 0x53, 0x20, 0xBA, 
+0x22, 0x5F, 0x03, 0x93, 0xE3, 0xE6, 0x97, 0x2A, 
+0x41, 0xD2, 0x3A, 0x99, 0xD5, 0x41, 0xFF, 
+
+0x56, 0x5E, 0xDE, 0x6F, 0x53, 0xB7, 0xBE, 0xFE, 
+0x95, 0x3C, 0xFE, 0xA8, 0x18, 0xC2, 0xE0, 0x0C, 
+0xD7, 0x2D, 0xD0, 0xEC, 0x74, 0x62, 0x6B, 0x95, 
+0x42, 0xA8, 0xF9, 0xD9, 0xCB, 0xED, 0xEC, 0xB0, 
+0x7F, 0x64, 0x66, 0x08, 0xBB, 0xBA, 0x16, 0x56, 
+0xD2, 0xAA, 0x10, 0xC7, 0xFB, 0x63, 0xD7, 0x14, 
+0x85, 0xAE, 0x93, 0x26, 0xAF, 0xDF, 0x42, 0x1F, 
+0x1B, 0x94, 0xA8, 0xCE, 0xB0, 0xB7, 0xF8, 0x53, 
+0xAA, 0x5E, 0x48, 0x89, 0x35, 0x2B, 0x16, 0xED, 
+0x81, 0x84, 0x5E, 0xBF, 0x02, 0xE0, 0x62, 0xA9, 
+0x86, 0x40, 0x67, 0x75, 0xB0, 0x6A, 0xAA, 0x23, 
+0xEF, 0x34, 0xCF, 0x02, 0x60, 0x8C, 0x42, 0xE9, 
+0x9A, 0x1D, 0x0D, 0xEB, 0x38, 0x36, 0x66, 0xB6, 
+0xD8, 0x20, 0x4A, 0x11, 0x59, 0xB2, 0x05, 0x29, 
+0xAC, 0x98, 0x1A, 0xC6, 0xA7, 0x41, 0xFE, 0x90, 
+0x88, 0x7C, 0x2D, 0x63, 0x27, 0xD2, 0x18, 0x71, 
+0xD4, 0x4B, 0x30, 0xC6, 0x53, 0x76, 0xCD, 0x4B, 
+0x1D, 0x38, 0x1C, 0x41, 0x55, 0xF3, 0xA8, 0x9C, 
+0x30, 0x6A, 0x90, 0x90, 0x42, 0xB5, 0x86, 0x0B, 
+0xE4, 0xCD, 0x99, 0x60, 0x69, 0x6F, 0xEE, 0xCB, 
+0x52, 0xD3, 0xA0, 0x75, 0x54, 0x9D, 0x19, 0x6E, 
+0x35, 0x1A, 0x7C, 0x08, 0xE6, 0x53, 0x07, 0x60, 
+0xFB, 0xC4, 0xC8, 0x4F, 0x09, 0xC0, 0xA5, 0xFD, 
+0x88, 0x76, 0x11, 0x2D, 0xCD, 0xBA, 0x35, 0x18, 
+0x5D, 0x42, 0x97, 0x27, 0xF2, 0x82, 0x77, 0xE0, 
+0xD8, 0x4C, 0xC2, 0xCE, 0x65, 0x62, 0x96, 0xD6, 
+0xC9, 0xFA, 0x55, 0x6B, 0xB6, 0x91, 0x4E, 0x23, 
+0x23, 0x21, 0x95, 0x39, 0x3C, 0xEB, 0x8A, 0x05, 
+0x72, 0xBE, 0xCE, 0x90, 0xCC, 0x8C, 0xB6, 0xEB, 
+0xEE, 0x18, 0x9D, 0x1D, 0x02, 0xF9, 0x4E, 0xE4, 
+0x9E, 0x0D, 0xE8, 0x3B, 0x9C, 0xCF, 0xC4, 0xF0, 
+
+0x16, 0xFC, 0x59, 0x14, 0x90, 0x20, 0xCE, 0x90, 
+0x66, 0x93, 0x3C, 0x52, 0xD9, 0x4F, 0x6E, 0x8D, 
+0x34, 0x6F, 0xCD, 0x51, 0xD1, 0xE3, 0xCE, 0x94, 
+0x2F, 0xE8, 0x36, 0x6E, 0x7E, 0xFB, 0x32, 0xFE, 
+0x6E, 0x1B, 0x78, 0xD3, 0xC9, 0x2B, 0x5E, 0xC8, 
+0xF9, 0x38, 0xE4, 0x43, 0x38, 0x66, 0xCB, 0x42, 
+0x8C, 0x3B, 0x2F, 0x25, 0xD0, 0x74, 0xD9, 0x80, 
+0x50, 0x85, 0xFA, 0x2C, 0x4B, 0x96, 0x0B, 0x30, 
+0x6F, 0xAF, 0xA2, 0x61, 0x47, 0x57, 0xBE, 0x9C, 
+0x79, 0x73, 0x1D, 0xA6, 0x58, 0x3E, 0x1D, 0xE1, 
+0x8B, 0x92, 0xA7, 0x87, 0x61, 0xA4, 0xA9, 0x74, 
+0xEF, 0xCA, 0xBD, 0x28, 0x40, 0xC3, 0xD8, 0x15, 
+0x78, 0x2D, 0xC9, 0x10, 0xF3, 0x88, 0xAE, 0xFD, 
+0x7F, 0xF5, 0xCC, 0xF1, 0xC0, 0xE2, 0x92, 0xA4, 
+0x6E, 0x35, 0x20, 0x79, 0x66, 0xD1, 0xB2, 0x2D, 
+0x96, 0xA7, 0x32, 0x24, 0xBC, 0x50, 0x06, 0xF5, 
+0x58, 0xA2, 0x86, 0x97, 0xAB, 0x43, 0xBB, 0x84, 
+0x8B, 0x37, 0x0D, 0x87, 0x9B, 0x56, 0x88, 0x82, 
+0x69, 0x44, 0xDA, 0x71, 0xFF, 0x79, 0xCB, 0x4D, 
+0x4F, 0x0A, 0xD4, 0x99, 0x66, 0xE2, 0x76, 0xF9, 
+0x78, 0x68, 0xBB, 0x4F, 0xB2, 0x7E, 0x87, 0x50, 
+0x36, 0x28, 0xDE, 0x22, 0xDC, 0xEF, 0xF9, 0x73, 
+0x35, 0x71, 0x2B, 0xE6, 0xA5, 0x17, 0x8A, 0x72, 
+0x12, 0x32, 0xE9, 0xED, 0x8D, 0xC3, 0x65, 0x62, 
+0xBE, 0xA5, 0x08, 0xE5, 0x8E, 0xEE, 0x6C, 0x53, 
+0x68, 0x1B, 0x21, 0x2E, 0x27, 0xD7, 0xE8, 0x26, 
+0xC6, 0x1C, 0x6D, 0x06, 0x2D, 0x01, 0x7D, 0xEC, 
+0x5C, 0xAC, 0xE7, 0x2D, 0x8C, 0x78, 0x73, 0x11, 
+0x21, 0xD0, 0x90, 0xDD, 0x8D, 0x1D, 0x14, 0x85, 
+0xF8, 0xD5, 0xEB, 0xDF, 0x95, 0x77, 0xE6, 0x2F, 
+0xD4, 0x92, 0x9A, 0x2C, 0xDC, 0xCF, 0xEB, 0xFB, 
+0xFC, 
+
+0x4E, 0xAE, 0x71, 0x12, 0xC9, 0xEF, 0xE5, 0xC9, 
+0xC7, 0x42, 0x10, 0xF9, 0x37, 0xA1, 0x83, 0x3B, 
+0x67, 0x99, 0xF7, 0x1A, 0x25, 0x4A, 0xE2, 0x3D, 
+0x79, 0x5A, 0x16, 0x8C, 0xDB, 0xC6, 0x70, 0xCB, 
+0xA9, 0x0A, 0x5D, 0x77, 0x3F, 0xF9, 0xA9, 0x3D, 
+0x3B, 0xE3, 0xC0, 0x7C, 0xF2, 0xBC, 0x04, 0x1F, 
+0xAF, 0x05, 0xA7, 0x2E, 0x1D, 0xFF, 0x88, 0x42, 
+0x37, 0x39, 0x49, 0x37, 0x36, 0x3C, 0x9C, 0x50, 
+0xB9, 0x95, 0x30, 0x04, 0x75, 0x7F, 0x19, 0xDF, 
+0x8F, 0xDE, 0xB5, 0xF2, 0xFC, 0xC6, 0x1E, 0x73, 
+0xA1, 0x4D, 0x07, 0xB1, 0x46, 0xD0, 0xC5, 0x2A, 
+0x95, 0xD7, 0xD1, 0x74, 0x62, 0xC0, 0x43, 0x95, 
+0xC3, 0x1F, 0x7F, 0x51, 0x4D, 0x9D, 0x9E, 0x60, 
+0x0D, 0x49, 0xD5, 0xD6, 0x81, 0xC1, 0x1A, 0xFF, 
+0x74, 0x07, 0xCA, 0x77, 0xE2, 0x95, 0x7E, 0xB5, 
+0xB5, 0x81, 0xD7, 0x29, 0x43, 0x0E, 0x0E, 0x61, 
+0xA0, 0xF4, 0xB7, 0xB8, 0x5A, 0x7A, 0xB7, 0xA1, 
+0xC1, 0x03, 0xC8, 0x54, 0x68, 0xCD, 0x70, 0x5A, 
+0xC2, 0xD3, 0x4A, 0x6A, 0x55, 0xDE, 0x2E, 0xD8, 
+0x60, 0x52, 0xAD, 0xAF, 0x13, 0x29, 0x41, 0x9D, 
+0x01, 0xA5, 0x43, 0xF1, 0xC6, 0x57, 0x65, 0x72, 
+0x5B, 0xDD, 0x59, 0xE7, 0x12, 0xD2, 0x27, 0xD7, 
+0x7D, 0x18, 0x85, 0x2B, 0xF4, 0x88, 0x4A, 0x2F, 
+0x8D, 0xC0, 0x82, 0xF2, 0x1F, 0xA6, 0x95, 0xE8, 
+0x75, 0xA5, 0xE3, 0x34, 0x20, 0xC2, 0x0C, 0xDE, 
+0x14, 0x4B, 0x6B, 0xA3, 0x9B, 0xAA, 0xD5, 0x3D, 
+0x54, 0x5E, 0x4C, 0x12, 0x8A, 0xB3, 0x6F, 0x18, 
+0xD4, 0xAB, 0x83, 0xA2, 0xB7, 0xA2, 0x92, 0xCC, 
+0xCD, 0x25, 0x52, 0xAB, 0xA1, 0x1E, 0x7D, 0x4B, 
+0x4B, 0x29, 0x51, 0xB9, 0x84, 0x2A, 0xB8, 0xE2, 
+0x9D, 0x09, 0x25, 0x1F, 0x77, 0x29, 0xBE, 0x40, 
+0x7A, 0x04, 
+
+0xC4, 0x90, 0xBC, 0x9E, 0xE4, 0x8B, 0x20, 0x4C, 
+0xBD, 0x8B, 0xDC, 0x28, 0xBE, 0x57, 0x61, 0xE4, 
+0x73, 0x9D, 0xFA, 0x08, 0x54, 0x4E, 0x59, 0x67, 
+0xD8, 0xC4, 0x13, 0x2E, 0x8E, 0x51, 0x59, 0x17, 
+0x42, 0x7D, 0xD5, 0x26, 0x68, 0xB7, 0x57, 0xEF, 
+0xB2, 0xA8, 0x09, 0x72, 0x20, 0xFC, 0xC0, 0x14, 
+0x22, 0xB6, 0x26, 0xB6, 0xBF, 0x88, 0xDF, 0x8F, 
+0xEF, 0xE0, 0x6F, 0x5C, 0xCB, 0x38, 0x0D, 0x33, 
+0xA4, 0x87, 0xBF, 0x9B, 0x0A, 0xC5, 0xC3, 0x77, 
+0x4C, 0x7D, 0xF4, 0x0D, 0xB4, 0x34, 0xEF, 0x75, 
+0xBD, 0x1D, 0xB9, 0x6A, 0xF6, 0x9E, 0xB9, 0x68, 
+0xE6, 0xCD, 0x53, 0x2D, 0x06, 0x7D, 0x75, 0x7F, 
+0xEA, 0xE6, 0xBB, 0x5D, 0xCC, 0xFB, 0x63, 0x6B, 
+0xBA, 0xE4, 0x4B, 0xF0, 0xCD, 0x55, 0xA2, 0x5D, 
+0x80, 0xDD, 0xDF, 0x4C, 0xBA, 0x13, 0xA1, 0xE5, 
+0xB3, 0xED, 0x06, 0xC7, 0x83, 0xBA, 0xE0, 0x16, 
+0x2E, 0x27, 0x5E, 0x14, 0x6E, 0x74, 0x3F, 0xF9, 
+0x1B, 0x1A, 0x1C, 0x3C, 0x60, 0x9F, 0xB5, 0xB6, 
+0x9C, 0x2D, 0x91, 0xC5, 0x09, 0x38, 0x1C, 0x79, 
+0x21, 0xED, 0x36, 0x68, 0x1A, 0xB0, 0xCC, 0xB5, 
+0x45, 0xB5, 0xE2, 0xB6, 0x8E, 0x3C, 0x0C, 0x69, 
+0x77, 0x53, 0x37, 0x26, 0xEE, 0x71, 0x19, 0xD6, 
+0x46, 0xC0, 0x90, 0x7E, 0x84, 0x62, 0x61, 0x40, 
+0x32, 0x52, 0xAD, 0x29, 0x4D, 0x6F, 0x85, 0x02, 
+0x46, 0xAC, 0x41, 0x0F, 0x75, 0x95, 0x5C, 0xBE, 
+0x7D, 0x4A, 0x09, 0x43, 0x03, 0xB8, 0x6C, 0xFA, 
+0x91, 0x14, 0xD7, 0x4A, 0xA4, 0x79, 0x75, 0xB3, 
+0x5E, 0xB7, 0x88, 0x29, 0xB2, 0xEC, 0x3B, 0x49, 
+0xC8, 0x70, 0x71, 0x8F, 0x7D, 0xCA, 0xF4, 0xDC, 
+0x92, 0xE9, 0x8E, 0xA6, 0x40, 0xAC, 0xB6, 0xE8, 
+0xAD, 0x67, 0x94, 0x4B, 0x25, 0x19, 0xA3, 0x93, 
+0xA8, 0x34, 0xC5, 
+
+0xCF, 0xDD, 0x56, 0xE6, 0x57, 0x2A, 0x3D, 0xBC, 
+0xCD, 0x9A, 0x87, 0x11, 0x4E, 0x38, 0xBC, 0xA3, 
+0x1F, 0x3E, 0x01, 0x5D, 0x33, 0x53, 0x91, 0x97, 
+0x94, 0xFC, 0xC9, 0x5B, 0xEB, 0xD7, 0x21, 0xDE, 
+0x0B, 0x0C, 0x0A, 0xA6, 0xD3, 0x38, 0xC1, 0xC1, 
+0xC0, 0x3E, 0xAE, 0xAB, 0xC1, 0x29, 0xA4, 0x1F, 
+0x22, 0x69, 0xD7, 0x84, 0x4B, 0xE5, 0xDD, 0x96, 
+0xFE, 0x05, 0x69, 0x0C, 0x61, 0xFC, 0xA9, 0x20, 
+0xB7, 0x71, 0x23, 0x52, 0xD8, 0x6B, 0xE7, 0xC7, 
+0xD9, 0xAA, 0x09, 0xA5, 0xEE, 0x11, 0x76, 0x6A, 
+0x50, 0x60, 0x72, 0xAB, 0x0D, 0xC4, 0xC4, 0x77, 
+0x0C, 0x8F, 0x61, 0xFF, 0x05, 0x04, 0x18, 0x19, 
+0xD1, 0xBE, 0xB0, 0xBC, 0x8A, 0x32, 0x9C, 0x17, 
+0xF0, 0x20, 0x09, 0xEA, 0xA2, 0xB3, 0x0B, 0x16, 
+0x6C, 0x64, 0x77, 0x8F, 0x38, 0x60, 0x08, 0xF3, 
+0x54, 0xF4, 0x22, 0xB9, 0x0A, 0xBF, 0x10, 0xC0, 
+0x55, 0x9A, 0x12, 0x6B, 0x4A, 0x16, 0x83, 0x44, 
+0x58, 0xB8, 0x32, 0xE9, 0x60, 0x62, 0x40, 0xAA, 
+0x3A, 0xA9, 0xD8, 0x50, 0x1B, 0xB0, 0xAF, 0x34, 
+0x2D, 0xE2, 0x8A, 0xB7, 0xB0, 0x75, 0xAD, 0x70, 
+0x7A, 0x72, 0xD4, 0xBB, 0xB5, 0x5A, 0x30, 0xD0, 
+0x3C, 0x09, 0x50, 0xA7, 0x10, 0x4D, 0xF4, 0x55, 
+0xA2, 0x53, 0x4F, 0xDC, 0xB2, 0xDD, 0x7B, 0x1A, 
+0xEF, 0xC5, 0xDD, 0x58, 0xAF, 0x94, 0x6F, 0x06, 
+0xFF, 0x74, 0x37, 0x88, 0xFA, 0xEC, 0xC5, 0x28, 
+0x71, 0x4E, 0x4E, 0xFD, 0xCA, 0xD0, 0x31, 0xAF, 
+0x4F, 0x2A, 0x66, 0xAB, 0xA2, 0x1B, 0x4B, 0xB1, 
+0x80, 0xE7, 0x56, 0xEC, 0xD3, 0xDF, 0xE9, 0x02, 
+0xB2, 0x18, 0x22, 0x63, 0x98, 0x8A, 0xC0, 0x6B, 
+0xBB, 0x2B, 0x52, 0x17, 0xEE, 0xB7, 0x58, 0xBB, 
+0x76, 0xD6, 0xBF, 0x3A, 0xBF, 0xC1, 0x0F, 0x92, 
+0x41, 0xE5, 0xDD, 0x36, 
+
+0x29, 0x12, 0x43, 0x5E, 0xC3, 0x33, 0xB4, 0x46, 
+0x0D, 0x1C, 0x20, 0x8D, 0xD3, 0x78, 0xB5, 0xE4, 
+0x23, 0xF9, 0xA2, 0x40, 0x74, 0xA9, 0xEB, 0x2A, 
+0xB3, 0x56, 0x5A, 0xC7, 0x4F, 0xD4, 0xAB, 0xC2, 
+0x00, 0xE9, 0x67, 0x42, 0x50, 0xBD, 0x07, 0xA6, 
+0xD4, 0xC7, 0xF9, 0x2B, 0xD7, 0xAE, 0xDA, 0x48, 
+0xB1, 0x9C, 0x30, 0x66, 0x72, 0xF5, 0x5D, 0x42, 
+0xC6, 0x82, 0xDC, 0xE5, 0xCB, 0x83, 0x80, 0x32, 
+0xE0, 0x1A, 0x5B, 0xD2, 0xE3, 0xD1, 0x4C, 0x82, 
+0x65, 0x60, 0x94, 0x09, 0xA8, 0x4E, 0xA4, 0xE3, 
+0x0B, 0x57, 0x87, 0x8E, 0xF3, 0x55, 0x99, 0x7B, 
+0xDC, 0xA9, 0x5D, 0xFA, 0x3A, 0x00, 0xB7, 0xB9, 
+0x6E, 0xF4, 0xE4, 0x25, 0x62, 0x12, 0x9D, 0x75, 
+0x5E, 0xE1, 0xF8, 0x37, 0x13, 0x1D, 0xF5, 0x0D, 
+0x51, 0x68, 0x10, 0xE0, 0xD6, 0x10, 0xF6, 0xAC, 
+0x84, 0xC7, 0x8D, 0x7A, 0xF1, 0x14, 0x27, 0x96, 
+0x81, 0xE6, 0x4F, 0x42, 0x70, 0x82, 0x8B, 0x24, 
+0x55, 0xE5, 0xB0, 0x1E, 0xCA, 0xE4, 0xCE, 0x82, 
+0x9F, 0x14, 0xB6, 0xB0, 0x70, 0x1F, 0x6C, 0x92, 
+0x20, 0xF8, 0xD5, 0x80, 0xBC, 0x71, 0x72, 0x60, 
+0x00, 0xF8, 0xFF, 0xB4, 0xA7, 0xCD, 0x24, 0x43, 
+0x98, 0x7B, 0x26, 0xD5, 0x85, 0x1A, 0x9E, 0x27, 
+0x8A, 0x6E, 0x19, 0x94, 0x68, 0x46, 0xC5, 0xF5, 
+0x1A, 0xBC, 0x6B, 0xA5, 0x4C, 0x6C, 0x50, 0xBB, 
+0x5F, 0x91, 0x97, 0x6C, 0xA6, 0xB6, 0x1E, 0xCC, 
+0x6E, 0x87, 0xDA, 0x39, 0xD6, 0x4A, 0x76, 0x5F, 
+0xD3, 0x15, 0x89, 0x66, 0xAF, 0xD5, 0xA5, 0x5C, 
+0x7C, 0x84, 0xE6, 0x7B, 0x9C, 0x7C, 0x51, 0xAD, 
+0xBE, 0x0A, 0x77, 0x12, 0x60, 0x3A, 0x1E, 0x25, 
+0xEF, 0xB1, 0xD9, 0xBE, 0x71, 0xD7, 0x59, 0x11, 
+0x42, 0x25, 0x75, 0xDE, 0x83, 0x06, 0xF1, 0x73, 
+0x8A, 0xCD, 0x49, 0x39, 0xDB, 
+
+0x8A, 0x32, 0x00, 0xA0, 0xB1, 0x28, 0x26, 0x87, 
+0x40, 0x77, 0x30, 0x92, 0xF1, 0x85, 0xA1, 0xFB, 
+0x02, 0x05, 0x29, 0x87, 0x65, 0x43, 0xA4, 0x58, 
+0xB6, 0x9D, 0x27, 0x64, 0x28, 0x38, 0x67, 0xEA, 
+0xA7, 0x71, 0x80, 0xDB, 0xAD, 0xF0, 0x9A, 0xA2, 
+0x65, 0xF7, 0x8C, 0xF8, 0xEF, 0xF6, 0x55, 0xCC, 
+0x3B, 0xE6, 0x71, 0x3C, 0xE6, 0xF6, 0x84, 0xE6, 
+0x49, 0x03, 0x63, 0x4A, 0x3F, 0xDA, 0x18, 0x5D, 
+0x97, 0x94, 0x62, 0xEA, 0x24, 0x32, 0xBE, 0xF7, 
+0x2A, 0x18, 0x0C, 0x53, 0x6B, 0xA0, 0x27, 0xD9, 
+0x1D, 0xE3, 0xE2, 0x94, 0xE3, 0xD2, 0x1E, 0x6A, 
+0x2D, 0xD2, 0x5A, 0x43, 0x56, 0xBB, 0xA0, 0xC5, 
+0xC9, 0xE4, 0x9F, 0xA6, 0xB6, 0x73, 0x39, 0x6F, 
+0x00, 0x29, 0x18, 0x8D, 0x30, 0x37, 0xB2, 0x5E, 
+0x92, 0x6F, 0xCF, 0x9D, 0x54, 0x09, 0xDB, 0x57, 
+0xD2, 0x98, 0xBD, 0xD6, 0x1D, 0x35, 0xD8, 0x40, 
+0x6A, 0xBE, 0x9E, 0xE0, 0x20, 0xD7, 0xC5, 0xEB, 
+0xCF, 0xD7, 0xFE, 0x14, 0x6E, 0x19, 0xCA, 0x24, 
+0xA5, 0x15, 0x84, 0xEA, 0x8B, 0x9A, 0x60, 0xC1, 
+0x00, 0xAF, 0x79, 0xBD, 0x59, 0x3B, 0x8C, 0x1A, 
+0xF2, 0x12, 0x04, 0xC6, 0x0D, 0x58, 0x6B, 0xDB, 
+0x35, 0x62, 0x97, 0xB1, 0xFB, 0x99, 0xCB, 0x6B, 
+0x69, 0xB7, 0x37, 0x0A, 0x97, 0x43, 0x35, 0x57, 
+0x6D, 0xA6, 0x70, 0x69, 0xB0, 0x2B, 0x4D, 0xD5, 
+0x79, 0x76, 0x40, 0x20, 0xCE, 0x73, 0x31, 0xE5, 
+0x4A, 0x73, 0xDE, 0xF4, 0xE2, 0x14, 0x71, 0xC7, 
+0x48, 0x2E, 0x73, 0x3D, 0xA8, 0xEE, 0xE5, 0xE4, 
+0x34, 0x11, 0xD8, 0xB1, 0x7B, 0xC4, 0x2C, 0x3C, 
+0xDD, 0x90, 0x08, 0x8C, 0xD7, 0x06, 0xB0, 0x5E, 
+0x66, 0x7E, 0x7C, 0x09, 0x66, 0xB3, 0x58, 0x9C, 
+0xF0, 0xCE, 0x68, 0x92, 0x4B, 0xF2, 0xD3, 0xC0, 
+0x45, 0xB5, 0x8C, 0xFD, 0x64, 0xBE, 
+
+0x72, 0x89, 0x25, 0xBE, 0x4B, 0xF8, 0x70, 0xDA, 
+0x9D, 0x89, 0xC9, 0x72, 0xBE, 0xC1, 0x32, 0x73, 
+0xF5, 0x07, 0xDE, 0x4C, 0xB5, 0x34, 0xE8, 0x0D, 
+0x18, 0x00, 0x20, 0x6F, 0xDF, 0x70, 0xF3, 0xF8, 
+0x8C, 0x0E, 0xA6, 0x15, 0xFA, 0x16, 0x54, 0x2B, 
+0xC9, 0x8D, 0xA7, 0xA7, 0x2F, 0x1B, 0x2F, 0x8D, 
+0xBA, 0x26, 0xE2, 0x0A, 0x2F, 0xAF, 0x27, 0xBC, 
+0xDB, 0x07, 0x01, 0x64, 0x7A, 0xD5, 0xAD, 0x7C, 
+0x26, 0x0F, 0x5A, 0x38, 0xDE, 0x1E, 0x68, 0x4A, 
+0xA4, 0xFA, 0xC4, 0x0A, 0x7E, 0x27, 0x02, 0xA2, 
+0x9E, 0xF3, 0x35, 0x53, 0xB4, 0x10, 0x15, 0x5F, 
+0x5F, 0x4D, 0xC1, 0xFD, 0x71, 0x70, 0x34, 0xE2, 
+0x80, 0xC7, 0x57, 0xAC, 0xF0, 0xF9, 0x27, 0x97, 
+0x37, 0xD0, 0x65, 0x79, 0x9D, 0xD2, 0xE6, 0x3D, 
+0xCD, 0xCC, 0x8B, 0x8D, 0xC9, 0xDC, 0x45, 0xA9, 
+0xAC, 0xB3, 0xE6, 0x6E, 0xC9, 0x13, 0x6C, 0x67, 
+0x30, 0x30, 0x22, 0x9C, 0xB6, 0xCB, 0x40, 0x08, 
+0xCD, 0x3F, 0xA4, 0xB6, 0x9C, 0x5A, 0x7B, 0x97, 
+0x9A, 0x1C, 0x8E, 0xED, 0x4E, 0xF9, 0xAA, 0xB8, 
+0x52, 0x63, 0x2E, 0xA7, 0xBB, 0x3D, 0x75, 0xA9, 
+0xC5, 0xAC, 0x80, 0xEA, 0x83, 0x9E, 0x81, 0x7A, 
+0x53, 0x5F, 0x5B, 0xF4, 0xF8, 0xDB, 0x61, 0xFD, 
+0xC8, 0x5A, 0x42, 0x93, 0x57, 0x03, 0x31, 0xFC, 
+0xB7, 0x6D, 0x2D, 0x99, 0x71, 0xAB, 0xC3, 0x0B, 
+0xD5, 0x3F, 0x2E, 0x36, 0x60, 0x37, 0x76, 0x54, 
+0xBA, 0x58, 0x3F, 0x29, 0xE2, 0xD7, 0xE1, 0xC3, 
+0xD0, 0xF6, 0xD7, 0x89, 0xBE, 0x5C, 0xE1, 0x75, 
+0xD2, 0xEC, 0x8B, 0x28, 0x57, 0x44, 0x58, 0x8A, 
+0x50, 0xF6, 0x68, 0x40, 0x4A, 0x39, 0x81, 0x43, 
+0xD3, 0x6B, 0xB8, 0xBC, 0xB4, 0xA6, 0xF0, 0x99, 
+0x83, 0x6C, 0x88, 0x23, 0x65, 0x54, 0x5A, 0xBB, 
+0x7E, 0x34, 0x60, 0x07, 0x9C, 0xFB, 0x41, 
+
+0x2C, 0xD4, 0x06, 0xA2, 0x3D, 0x88, 0x38, 0x34, 
+0xE3, 0x0D, 0x5B, 0x7A, 0x29, 0x55, 0x16, 0x97, 
+0x8B, 0xC7, 0x10, 0x11, 0x17, 0xB5, 0x34, 0xD9, 
+0x85, 0xA9, 0x9E, 0xFA, 0xA6, 0x70, 0x44, 0x0A, 
+0x7B, 0x8A, 0xB9, 0x19, 0x04, 0xE7, 0x03, 0x12, 
+0xBF, 0xE6, 0x5D, 0xFB, 0xFC, 0x42, 0x70, 0x20, 
+0x50, 0x62, 0x36, 0x21, 0x79, 0xC0, 0x79, 0x7C, 
+0x0B, 0x58, 0x25, 0x59, 0x49, 0xA6, 0xAE, 0x93, 
+0x3A, 0x21, 0x88, 0x2E, 0xAB, 0x8D, 0xE8, 0x2C, 
+0xFC, 0x8C, 0x69, 0x8E, 0xAC, 0x15, 0xCE, 0x6D, 
+0x86, 0xD7, 0x77, 0x82, 0xAB, 0x54, 0xB3, 0xE0, 
+0xBD, 0x0B, 0x63, 0x49, 0xEE, 0x70, 0x27, 0xC9, 
+0xAB, 0xEA, 0x85, 0x72, 0x90, 0x43, 0xAF, 0x20, 
+0xB4, 0x86, 0xCB, 0x49, 0xB8, 0xB1, 0xD8, 0x5B, 
+0x8F, 0x02, 0x6D, 0x32, 0x2F, 0x03, 0x9F, 0xE9, 
+0x84, 0x6B, 0x39, 0xD5, 0x8A, 0x63, 0xEC, 0xE0, 
+0xAD, 0x5B, 0x9B, 0x5F, 0x20, 0xB5, 0x26, 0x90, 
+0x30, 0xF5, 0x77, 0x3B, 0x74, 0x32, 0x1F, 0x19, 
+0x1B, 0x82, 0xE9, 0x7D, 0xD1, 0x70, 0xC0, 0xF3, 
+0x37, 0x92, 0xBC, 0x82, 0x68, 0xC2, 0xD3, 0x11, 
+0xA3, 0x7A, 0x0D, 0x1C, 0x03, 0x88, 0x51, 0x96, 
+0x1D, 0x87, 0x7B, 0x86, 0x10, 0x14, 0x50, 0x5C, 
+0x7B, 0x4A, 0x70, 0x5E, 0x2B, 0xE4, 0xD0, 0x9C, 
+0x7B, 0x05, 0x10, 0x6A, 0xB7, 0x15, 0x7B, 0x3D, 
+0x9E, 0x50, 0x94, 0xF3, 0xB0, 0x98, 0x9B, 0xA6, 
+0x84, 0xA6, 0x4B, 0xFB, 0x5A, 0xBE, 0x34, 0xD6, 
+0x5D, 0x43, 0x4D, 0x66, 0xBD, 0xBB, 0xCB, 0x88, 
+0x60, 0xD2, 0x03, 0x72, 0x59, 0xCF, 0xE2, 0x8A, 
+0xFD, 0x41, 0xBD, 0xE7, 0x75, 0x6C, 0xF9, 0x48, 
+0x2E, 0x73, 0xCE, 0x9D, 0x1B, 0xCE, 0xC5, 0xBB, 
+0x95, 0x61, 0x52, 0xAD, 0xAB, 0x30, 0xB1, 0x26, 
+0xDA, 0x57, 0x99, 0x8F, 0xE1, 0x83, 0xC6, 0x20, 
+
+0xC0, 0x56, 0x8A, 0xAE, 0x6F, 0x36, 0x55, 0xF2, 
+0xE8, 0x1C, 0x5A, 0x98, 0x84, 0x5D, 0x3B, 0x5F, 
+0xC3, 0xB0, 0x44, 0xBD, 0x31, 0x7A, 0x0D, 0xC3, 
+0x59, 0x76, 0x16, 0x39, 0xDE, 0xFC, 0x2B, 0x94, 
+0x9D, 0x83, 0x8D, 0xA6, 0xCF, 0x49, 0x5F, 0x51, 
+0x16, 0x6B, 0xE7, 0x42, 0x06, 0xC5, 0xA1, 0x37, 
+0x1F, 0xA3, 0xCC, 0x2B, 0x13, 0x98, 0x34, 0x25, 
+0x92, 0xCD, 0x72, 0xA2, 0x88, 0x09, 0x7C, 0xCF, 
+0x13, 0x27, 0x9B, 0xB9, 0x97, 0xA8, 0x43, 0xBC, 
+0x06, 0xF8, 0xB3, 0x29, 0xB3, 0x5B, 0xCE, 0x5A, 
+0xAA, 0x9C, 0xD7, 0xAE, 0xA8, 0x0D, 0x43, 0x9C, 
+0x2C, 0x73, 0x7B, 0x56, 0x3F, 0x72, 0x50, 0x69, 
+0x2A, 0x0A, 0x5B, 0xB1, 0x1B, 0xD0, 0xC7, 0x94, 
+0xBF, 0x72, 0x89, 0x39, 0x82, 0xA4, 0xB4, 0x4E, 
+0xC2, 0x14, 0x02, 0xEE, 0x27, 0xBE, 0xCE, 0xD6, 
+0xBC, 0xEF, 0xFB, 0x9F, 0x7F, 0x33, 0x2D, 0x72, 
+0x62, 0x12, 0x4F, 0xEA, 0x83, 0xA4, 0x0E, 0xE3, 
+0xA8, 0x60, 0xD3, 0xD7, 0x0C, 0x1D, 0x4D, 0xDE, 
+0xB0, 0x5F, 0x04, 0xFB, 0x38, 0xDF, 0x71, 0x9F, 
+0x82, 0xC8, 0xD0, 0xBE, 0xAF, 0x2F, 0x2F, 0x81, 
+0x10, 0x6D, 0x63, 0x99, 0x2A, 0xCF, 0xF8, 0xDC, 
+0x92, 0xAA, 0x38, 0xB6, 0x3C, 0x9F, 0x7D, 0x5B, 
+0x0A, 0x38, 0x60, 0xE4, 0xAA, 0x3A, 0xB7, 0x92, 
+0xFC, 0x56, 0xD5, 0x81, 0x54, 0xD2, 0xAA, 0x54, 
+0x8C, 0x1C, 0x42, 0x70, 0xB2, 0xFB, 0x7A, 0x93, 
+0x9D, 0xC9, 0x2E, 0x4A, 0x65, 0x3B, 0x5B, 0xB4, 
+0x1F, 0x8A, 0x91, 0x0D, 0xB6, 0x98, 0xA6, 0xC7, 
+0x88, 0x65, 0x9C, 0x80, 0xC0, 0x3D, 0xA5, 0x1B, 
+0xBD, 0xF5, 0x25, 0xF5, 0xC9, 0x18, 0x59, 0x4A, 
+0xD4, 0x4E, 0x72, 0x99, 0x14, 0x13, 0x80, 0x85, 
+0x6C, 0xA3, 0x7C, 0xD9, 0x1E, 0x8E, 0xC4, 0x44, 
+0x73, 0x84, 0x51, 0x43, 0x62, 0x66, 0x89, 0x95, 
+0xED, 
+
+0xA3, 0xB6, 0x5F, 0x15, 0x73, 0x4D, 0xA6, 0x7F, 
+0xBB, 0xCB, 0x05, 0xA8, 0xB0, 0x63, 0x07, 0xE3, 
+0xF5, 0xCE, 0xE0, 0x7F, 0xA5, 0xA0, 0x29, 0xC7, 
+0x73, 0x0E, 0xAC, 0xE1, 0x2B, 0x96, 0x7A, 0x37, 
+0xE7, 0xA4, 0x0C, 0x29, 0x1B, 0x0D, 0xDD, 0x7F, 
+0x03, 0xD6, 0x2E, 0xF8, 0x58, 0x40, 0xB4, 0x8F, 
+0xDB, 0x86, 0x87, 0x83, 0x96, 0xB4, 0x17, 0x9E, 
+0xA6, 0x08, 0x65, 0x8B, 0xB8, 0x62, 0xA1, 0xF9, 
+0x1F, 0x0E, 0x8E, 0xB0, 0x1A, 0x5D, 0xFE, 0x18, 
+0xFC, 0x07, 0x94, 0xE8, 0x3A, 0x37, 0x03, 0x6F, 
+0xA2, 0x02, 0x85, 0x69, 0xE3, 0x64, 0x81, 0x7D, 
+0x52, 0xFC, 0x97, 0x26, 0xD2, 0x7C, 0xE6, 0xB2, 
+0x64, 0x3B, 0x9C, 0x7C, 0xD4, 0xE9, 0x51, 0x54, 
+0xA7, 0x90, 0x65, 0xCA, 0x5F, 0xFF, 0xC4, 0x34, 
+0x7F, 0x8C, 0xCC, 0x8F, 0x7B, 0x41, 0xA1, 0x2E, 
+0x41, 0x3D, 0x51, 0x18, 0xC4, 0x39, 0xC4, 0x17, 
+0xCD, 0x12, 0xCF, 0xA1, 0xDE, 0xC5, 0x5D, 0x39, 
+0xBD, 0x2E, 0xFA, 0x90, 0x70, 0x67, 0xE7, 0xF8, 
+0xBA, 0x1F, 0x3C, 0x7C, 0x10, 0xDB, 0xD7, 0xD2, 
+0x60, 0x78, 0xE6, 0x2B, 0xA7, 0x1A, 0x89, 0x5C, 
+0xA8, 0x0C, 0x96, 0xE6, 0xB1, 0x7E, 0xEF, 0x47, 
+0xAE, 0x3F, 0x1C, 0x19, 0x1D, 0x9F, 0x9D, 0x40, 
+0xE0, 0xD6, 0x59, 0x98, 0xFF, 0x7D, 0x4D, 0x4C, 
+0x72, 0xF9, 0xCC, 0x9E, 0xCE, 0x31, 0xD0, 0xCA, 
+0x47, 0xE5, 0x61, 0x8E, 0xE9, 0xFD, 0xC5, 0x57, 
+0x92, 0x8F, 0x71, 0x87, 0x79, 0x67, 0xF1, 0x07, 
+0xF7, 0x7A, 0x78, 0xDC, 0x31, 0xF1, 0x49, 0xE6, 
+0x29, 0x33, 0x79, 0x73, 0x8B, 0x2C, 0x52, 0x8F, 
+0xB5, 0xD5, 0x29, 0xD3, 0xD5, 0x84, 0x0B, 0x8D, 
+0x05, 0x35, 0x4A, 0x96, 0x28, 0x7C, 0x6C, 0x70, 
+0x5E, 0x2E, 0x59, 0x64, 0xDE, 0xD8, 0x07, 0x4E, 
+0x5E, 0x81, 0x7C, 0x5B, 0xDD, 0x35, 0x76, 0xCE, 
+0x98, 0x06, 
+
+0x21, 0xA6, 0x5A, 0x26, 0x5B, 0x5D, 0x19, 0x80, 
+0xF1, 0x43, 0x25, 0x8F, 0x18, 0xDD, 0x7E, 0x22, 
+0x3A, 0x47, 0x81, 0x21, 0x80, 0xFD, 0x67, 0xD0, 
+0x22, 0x45, 0x24, 0xD9, 0xB6, 0xED, 0x73, 0x4A, 
+0xDA, 0xF1, 0x6A, 0xEF, 0xF3, 0x03, 0x8F, 0xDB, 
+0x1B, 0xD1, 0x67, 0x38, 0xA2, 0x2E, 0xAE, 0x38, 
+0x93, 0xDC, 0x63, 0x0A, 0x1B, 0xFD, 0x7B, 0x14, 
+0x35, 0xB8, 0x1B, 0x1C, 0x2D, 0x0C, 0xA5, 0x99, 
+0x53, 0x3A, 0xC8, 0x7B, 0xD5, 0xAD, 0x43, 0x72, 
+0x9E, 0xFE, 0x1F, 0x2A, 0x97, 0x25, 0x18, 0xAE, 
+0xAB, 0x8A, 0x8A, 0x62, 0x20, 0x06, 0x43, 0xF8, 
+0x13, 0xD9, 0x56, 0x0A, 0x0E, 0xEE, 0x3E, 0x01, 
+0x44, 0x1E, 0x93, 0x42, 0x20, 0x69, 0x10, 0x39, 
+0x99, 0xD4, 0x4C, 0x6E, 0xC0, 0x6B, 0x08, 0xF5, 
+0x35, 0x93, 0x6D, 0x82, 0x7E, 0xE3, 0x4A, 0xFC, 
+0x41, 0x00, 0xAA, 0xAE, 0x99, 0x09, 0x86, 0x7A, 
+0x81, 0x45, 0xC6, 0x2D, 0x3E, 0xB0, 0x2B, 0x58, 
+0xB4, 0x1F, 0x2B, 0xEC, 0x7D, 0x5A, 0x8C, 0xD6, 
+0x35, 0x2F, 0xA1, 0x11, 0xC3, 0xF1, 0xAA, 0x07, 
+0x78, 0xE6, 0xF5, 0xC3, 0x48, 0xAB, 0x12, 0x0E, 
+0x71, 0x5E, 0xD8, 0x0F, 0x39, 0x39, 0x96, 0x1F, 
+0x9B, 0x3E, 0x48, 0x47, 0x27, 0x72, 0x93, 0x02, 
+0x98, 0xE9, 0xB6, 0xDB, 0xA2, 0x1F, 0xFE, 0xEB, 
+0xBA, 0xAF, 0xD8, 0xCC, 0x62, 0xAB, 0x3A, 0x00, 
+0x7B, 0xDE, 0xA8, 0x76, 0xAA, 0x55, 0xBC, 0xD3, 
+0x79, 0x98, 0x89, 0x32, 0x61, 0xB5, 0x04, 0x43, 
+0xFB, 0x83, 0x91, 0x27, 0x33, 0xBF, 0xC6, 0x81, 
+0x2B, 0x43, 0x63, 0x5D, 0x06, 0xD6, 0xAF, 0xED, 
+0x64, 0x51, 0x6F, 0x24, 0xBD, 0x12, 0x88, 0x18, 
+0x8A, 0xB3, 0x85, 0x4D, 0xB9, 0x08, 0x53, 0x76, 
+0xFA, 0x54, 0x60, 0x9F, 0x1E, 0xA8, 0x4A, 0x8C, 
+0x88, 0x91, 0xA2, 0x5B, 0xD0, 0x9A, 0xF0, 0xEA, 
+0xCC, 0x10, 0xA3, 
+
+0x6C, 0x37, 0xDD, 0x74, 0xC3, 0xC3, 0xA7, 0xD9, 
+0xBC, 0x86, 0x22, 0x88, 0xBC, 0x73, 0x54, 0xB9, 
+0xF4, 0x76, 0x5E, 0xBD, 0xF7, 0x01, 0xA9, 0x0B, 
+0x3C, 0x08, 0xBA, 0xD8, 0x20, 0xDB, 0x32, 0x21, 
+0xF9, 0x4B, 0xC0, 0x3F, 0x2E, 0xAE, 0xDD, 0xAC, 
+0xD7, 0x64, 0xC3, 0x70, 0x87, 0xDC, 0xC1, 0x17, 
+0x05, 0x98, 0x85, 0xA6, 0xC7, 0x25, 0xD6, 0x3D, 
+0x90, 0x6F, 0xAF, 0x4B, 0x71, 0x7C, 0x4C, 0xBF, 
+0x35, 0x57, 0xA4, 0x0B, 0x64, 0x6A, 0x4B, 0x17, 
+0x0F, 0x52, 0x6A, 0x27, 0xEE, 0xEA, 0x28, 0x5F, 
+0xBA, 0xBA, 0xE7, 0x26, 0x79, 0xEC, 0x81, 0xE7, 
+0x9C, 0x0F, 0x96, 0x0B, 0x85, 0x41, 0x7A, 0x7F, 
+0xE1, 0xC9, 0x80, 0xB2, 0x16, 0x26, 0xD1, 0x0D, 
+0x26, 0x28, 0x9E, 0xF3, 0x1B, 0xFD, 0x68, 0x03, 
+0x41, 0x5B, 0x0C, 0xAF, 0x61, 0x09, 0xD7, 0x5B, 
+0x04, 0x64, 0x57, 0x97, 0x54, 0x51, 0x8E, 0x59, 
+0x3C, 0x31, 0x3B, 0x03, 0xAF, 0xDD, 0xA7, 0xF3, 
+0x1C, 0xAC, 0xA1, 0xDC, 0x9B, 0x33, 0x2F, 0x2E, 
+0x9D, 0x90, 0xBC, 0x38, 0xBE, 0x53, 0xCF, 0x71, 
+0xF1, 0x15, 0x3B, 0x95, 0xF0, 0xBE, 0x9B, 0xED, 
+0x11, 0xC6, 0x61, 0xDB, 0x5D, 0x77, 0xA0, 0x0D, 
+0x01, 0xF5, 0xF1, 0x46, 0xBD, 0x60, 0xDA, 0x5F, 
+0x56, 0x42, 0x9C, 0xF3, 0xE8, 0xAD, 0xBE, 0x9A, 
+0x50, 0x9D, 0x55, 0x1D, 0xDA, 0x62, 0x56, 0xA7, 
+0xBF, 0xCA, 0x86, 0x67, 0xB1, 0xCA, 0xBB, 0x4E, 
+0x23, 0x5C, 0x97, 0x2E, 0x8D, 0xDF, 0xF5, 0x56, 
+0xBB, 0x95, 0x8A, 0x52, 0x12, 0x26, 0x69, 0xDC, 
+0x5B, 0x70, 0x92, 0x5B, 0xD4, 0xF8, 0xB4, 0xAC, 
+0x49, 0xA2, 0x5F, 0x58, 0xA3, 0x9D, 0x91, 0x42, 
+0x0C, 0x07, 0x2D, 0xA1, 0x1B, 0xEC, 0x84, 0x7B, 
+0x02, 0x44, 0x4D, 0xB5, 0x4C, 0x78, 0xD9, 0x58, 
+0x6A, 0x61, 0xEA, 0x46, 0x26, 0x0D, 0x12, 0x23, 
+0xE0, 0xB7, 0x05, 0xE5, 
+
+0xBE, 0xDB, 0x00, 0x96, 0xD2, 0x92, 0x5D, 0x8C, 
+0x31, 0xC5, 0x7E, 0x27, 0xED, 0x86, 0x9C, 0xBA, 
+0x23, 0x1E, 0x11, 0xB5, 0x9E, 0x82, 0x26, 0x45, 
+0x9E, 0x13, 0xB0, 0xEC, 0xB5, 0x35, 0xED, 0x8C, 
+0x75, 0x28, 0x7F, 0x91, 0xB7, 0x89, 0x52, 0xF3, 
+0x07, 0x96, 0xF0, 0xD8, 0x3F, 0x9B, 0xCA, 0x09, 
+0x35, 0x45, 0x61, 0x97, 0x5F, 0x06, 0xEC, 0xB7, 
+0x08, 0x7B, 0x40, 0xB7, 0x4D, 0xE3, 0x2D, 0xEE, 
+0x37, 0xB5, 0x87, 0x0C, 0x63, 0xFF, 0x51, 0x62, 
+0x6B, 0xEB, 0xC9, 0x90, 0x99, 0xAB, 0x42, 0xC8, 
+0xB0, 0x3D, 0xD0, 0xEC, 0xDA, 0xBA, 0x26, 0xD4, 
+0x9A, 0xF2, 0x28, 0xAB, 0xAD, 0x1B, 0x23, 0xED, 
+0x13, 0x75, 0xF4, 0x19, 0x73, 0x6D, 0xD9, 0x72, 
+0x86, 0x1E, 0xBB, 0xA5, 0x83, 0x52, 0x60, 0x19, 
+0xE7, 0x0E, 0x36, 0x5A, 0x7E, 0x6F, 0x9F, 0xAF, 
+0xD5, 0x91, 0x90, 0x6C, 0x26, 0x8B, 0xAB, 0x83, 
+0x9D, 0xCB, 0x51, 0x4A, 0x93, 0x38, 0xA6, 0xC0, 
+0x7E, 0xCA, 0x95, 0x99, 0x24, 0xBA, 0x36, 0x24, 
+0x98, 0x41, 0xAF, 0xC2, 0x95, 0x8A, 0x8C, 0x4A, 
+0xC9, 0xBC, 0xE5, 0xB4, 0x4E, 0x5D, 0x51, 0xBD, 
+0xCA, 0xA3, 0x81, 0xF9, 0x14, 0x0C, 0x66, 0x0C, 
+0x7C, 0xA2, 0x3B, 0x72, 0x11, 0x83, 0xBA, 0x8C, 
+0x3E, 0x3B, 0xB3, 0xC0, 0xB7, 0x24, 0x96, 0x48, 
+0x45, 0x6F, 0x4A, 0xFB, 0xFB, 0x24, 0xA9, 0xFE, 
+0xE0, 0x92, 0xAD, 0x92, 0xF3, 0xA1, 0x2F, 0xA3, 
+0xB6, 0xD0, 0x55, 0xED, 0x3F, 0x6C, 0x55, 0x9F, 
+0x7D, 0xED, 0x93, 0x59, 0x84, 0x11, 0x9B, 0x40, 
+0x59, 0x05, 0x1D, 0xC7, 0x96, 0x50, 0x3B, 0x29, 
+0x3F, 0x8A, 0xF0, 0xEE, 0x37, 0xBD, 0x02, 0x98, 
+0x99, 0xF1, 0xB4, 0xF9, 0x61, 0xD4, 0x1D, 0xF2, 
+0x5A, 0xB0, 0xD9, 0xEC, 0x9B, 0x93, 0x73, 0xBC, 
+0x3B, 0x02, 0x65, 0xB1, 0xAB, 0x2D, 0x90, 0xE2, 
+0x98, 0x15, 0xAA, 0x40, 0x21, 
+
+0x4E, 0xA7, 0xBE, 0x9F, 0xBD, 0x88, 0x21, 0x31, 
+0xAA, 0x01, 0xA7, 0x28, 0x97, 0x7F, 0x7F, 0x8E, 
+0xAC, 0xE9, 0xCB, 0x66, 0xA1, 0xC8, 0xE4, 0x6B, 
+0xF1, 0x05, 0xEC, 0x92, 0x84, 0xA8, 0x58, 0x80, 
+0x9E, 0xA7, 0xAF, 0x66, 0xFB, 0xF3, 0xC2, 0xA1, 
+0x36, 0x2E, 0xCC, 0x09, 0xE2, 0x01, 0x05, 0xAF, 
+0x2E, 0x7B, 0x89, 0xDD, 0x0F, 0x09, 0x09, 0x5D, 
+0x6D, 0x87, 0x82, 0x1E, 0x9B, 0x22, 0x06, 0x5D, 
+0x18, 0x74, 0x9A, 0x94, 0x12, 0x2E, 0x9B, 0x9D, 
+0x57, 0x0F, 0x6D, 0x7D, 0x7E, 0x0F, 0x8E, 0xAC, 
+0x12, 0xCE, 0x28, 0x70, 0x9D, 0x62, 0xAD, 0xCF, 
+0xC7, 0xEE, 0x0F, 0xD3, 0xBC, 0x6D, 0xF5, 0x5F, 
+0xF1, 0xCC, 0xC0, 0x8E, 0x94, 0x1E, 0x76, 0x29, 
+0x37, 0xA8, 0x8C, 0x05, 0x81, 0x94, 0xAD, 0xF7, 
+0xB5, 0x74, 0x05, 0xB3, 0xBD, 0x32, 0xBA, 0x64, 
+0xEE, 0x0C, 0x04, 0x97, 0x2C, 0xCB, 0x65, 0x68, 
+0x33, 0x40, 0xAC, 0xD0, 0x9B, 0xD2, 0x31, 0x57, 
+0x3F, 0x29, 0x56, 0xA5, 0x23, 0xBB, 0xE1, 0x7A, 
+0x14, 0xA9, 0x06, 0x37, 0x57, 0x94, 0x8F, 0x22, 
+0xBB, 0x41, 0x61, 0x71, 0xB9, 0xCB, 0xFD, 0x19, 
+0xCD, 0x8E, 0xD5, 0x11, 0x61, 0xDF, 0xCD, 0xEC, 
+0x8D, 0x6C, 0x6D, 0xDF, 0xDA, 0xFB, 0x6C, 0x75, 
+0x61, 0x53, 0xE2, 0x8B, 0x96, 0x09, 0x97, 0xD8, 
+0xF0, 0x62, 0x37, 0x03, 0x9F, 0x4A, 0x59, 0x6D, 
+0x8F, 0x1B, 0x38, 0x93, 0x2B, 0x03, 0x0C, 0x3B, 
+0x79, 0x49, 0x3C, 0x6F, 0x70, 0x3B, 0xCF, 0x58, 
+0xDA, 0x99, 0xD2, 0x1E, 0x9E, 0x17, 0xE9, 0xFB, 
+0x47, 0x77, 0x7F, 0x08, 0x7C, 0x17, 0x9D, 0x36, 
+0xB2, 0x30, 0xC1, 0x9A, 0x4B, 0x49, 0x1F, 0x95, 
+0x0B, 0x3F, 0xEB, 0x37, 0x09, 0x9A, 0x2B, 0xC9, 
+0x43, 0x80, 0x09, 0x57, 0xA4, 0xF2, 0x76, 0xF0, 
+0xFC, 0xA1, 0x79, 0x8A, 0x1C, 0xE6, 0xFB, 0xAE, 
+0x7F, 0x9A, 0xA2, 0x07, 0x89, 0xF1, 
+
+0xB7, 0xA0, 0xCA, 0xC8, 0x9A, 0x63, 0x88, 0x1E, 
+0x12, 0x36, 0xDD, 0x88, 0xEB, 0xA1, 0xA5, 0xAC, 
+0x94, 0xD7, 0xAA, 0x3E, 0x36, 0x4C, 0x49, 0x72, 
+0x7B, 0x39, 0xC0, 0xB5, 0xB9, 0x23, 0xFA, 0x77, 
+0xEF, 0xB9, 0x9B, 0x52, 0x16, 0xC5, 0x00, 0x66, 
+0x84, 0x45, 0x0F, 0x98, 0xFB, 0xD0, 0xB5, 0x6C, 
+0x9A, 0xE4, 0x60, 0x6A, 0x3F, 0x0B, 0xDB, 0xFA, 
+0x9A, 0x60, 0x7F, 0x04, 0xE0, 0x23, 0x63, 0x47, 
+0x70, 0x6B, 0x47, 0xC7, 0x44, 0x97, 0x6E, 0x68, 
+0x8B, 0xA3, 0xFA, 0xA4, 0x3D, 0xD6, 0x8C, 0xC9, 
+0x1A, 0x36, 0x44, 0xA4, 0x63, 0x56, 0x84, 0xA3, 
+0x8E, 0xA3, 0xF6, 0xB5, 0xB5, 0x88, 0x07, 0x3A, 
+0x9A, 0x7D, 0xB2, 0xB6, 0x88, 0xCE, 0x2C, 0x48, 
+0x2F, 0x8E, 0xFD, 0x9F, 0x49, 0x09, 0xFA, 0x98, 
+0x0B, 0xF8, 0x71, 0x0B, 0xA9, 0x7A, 0xA0, 0x00, 
+0x63, 0x79, 0xC1, 0x53, 0xFE, 0x6E, 0xF9, 0xAD, 
+0x85, 0xCC, 0x0F, 0x22, 0x2B, 0x6C, 0x26, 0xE4, 
+0x46, 0x98, 0x40, 0xFD, 0xF7, 0x9B, 0xFF, 0xE9, 
+0x01, 0xB0, 0x8A, 0x9A, 0xD5, 0xE9, 0x65, 0xB9, 
+0x19, 0xA1, 0x08, 0x04, 0xCD, 0x63, 0x6C, 0x47, 
+0x9A, 0xC1, 0xFB, 0xFB, 0x73, 0x2F, 0xB6, 0xC4, 
+0x30, 0xFA, 0xD2, 0xD9, 0x24, 0xA8, 0x2A, 0x70, 
+0xC0, 0xED, 0xB1, 0x08, 0x75, 0xA2, 0xDA, 0xB7, 
+0xB4, 0x48, 0x34, 0x30, 0x17, 0x77, 0x3A, 0xCF, 
+0x14, 0xF3, 0xD1, 0xA3, 0xAF, 0x5F, 0xBD, 0x93, 
+0x66, 0x60, 0x23, 0xC2, 0x5C, 0xD7, 0x6B, 0x14, 
+0x1D, 0x74, 0x0F, 0x15, 0xE3, 0x5B, 0x8F, 0x36, 
+0xD3, 0x44, 0x1C, 0x1A, 0x2D, 0xB2, 0x86, 0x64, 
+0xF4, 0x64, 0x18, 0xFB, 0x82, 0xDB, 0x2B, 0xA4, 
+0x56, 0x89, 0x81, 0x86, 0x36, 0x36, 0x99, 0x20, 
+0x0E, 0x3D, 0xAD, 0x5B, 0xD2, 0x60, 0x32, 0x26, 
+0x48, 0x3B, 0x70, 0xED, 0xC1, 0x78, 0x7D, 0xA6, 
+0x89, 0xBF, 0xC5, 0x5A, 0x1E, 0x47, 0x10, 
+
+0x9D, 0xD7, 0x5C, 0x4B, 0x06, 0xE4, 0x53, 0x20, 
+0x47, 0x6F, 0xD8, 0x3E, 0xBB, 0xB9, 0x99, 0xCA, 
+0x02, 0x24, 0x92, 0x4C, 0x5D, 0x81, 0xBE, 0x32, 
+0x63, 0xF7, 0x4B, 0x2E, 0x12, 0x73, 0x41, 0x59, 
+0x4A, 0xB8, 0x11, 0xAE, 0xB9, 0x93, 0x7F, 0xC0, 
+0x8C, 0xDA, 0x12, 0x22, 0xCD, 0xE0, 0x27, 0xFC, 
+0xC1, 0xD0, 0x20, 0xA1, 0x8E, 0x99, 0x3E, 0x7B, 
+0xE9, 0xDE, 0xC2, 0x37, 0xF6, 0x3C, 0x68, 0x5D, 
+0xE1, 0x2D, 0x11, 0x12, 0x30, 0xAB, 0xFC, 0x35, 
+0xB9, 0x64, 0x91, 0xAF, 0x8E, 0x2C, 0x47, 0xB3, 
+0xC8, 0x15, 0x60, 0xF7, 0x87, 0x87, 0x15, 0x1F, 
+0x47, 0xC5, 0x79, 0x03, 0xD1, 0x25, 0x3E, 0xAB, 
+0x73, 0xA3, 0xBE, 0x8F, 0x0A, 0x1A, 0x02, 0x8B, 
+0x2B, 0x51, 0x7D, 0x1A, 0x96, 0xB7, 0xE4, 0xCE, 
+0x0C, 0xE0, 0x98, 0x6F, 0xA2, 0x00, 0x0E, 0x6E, 
+0x14, 0x22, 0xC3, 0xBB, 0x9F, 0xED, 0x8A, 0x18, 
+0x2A, 0x80, 0x62, 0x5B, 0x44, 0xD4, 0x62, 0x81, 
+0x05, 0xC6, 0xC6, 0xCD, 0x53, 0xA6, 0xCF, 0x41, 
+0x6B, 0xE8, 0xF8, 0x78, 0xEF, 0xD3, 0xF1, 0xA1, 
+0xD3, 0x95, 0x19, 0xD6, 0xBC, 0x82, 0x2E, 0x80, 
+0x4D, 0x1C, 0xA2, 0x9B, 0x52, 0x4B, 0x4B, 0xEF, 
+0x2A, 0x86, 0x3C, 0x25, 0x2E, 0x36, 0xBF, 0x25, 
+0x84, 0xD0, 0x15, 0xBB, 0xCC, 0xBA, 0x0F, 0xD7, 
+0x4A, 0x46, 0xFF, 0x7B, 0xA2, 0xFE, 0x3D, 0x66, 
+0x63, 0x25, 0xBD, 0x98, 0x90, 0x34, 0x15, 0x53, 
+0xFD, 0x1E, 0x69, 0x05, 0xDE, 0x34, 0x99, 0x80, 
+0xDD, 0x1A, 0x66, 0xF1, 0x4B, 0x11, 0x7C, 0xE3, 
+0xE2, 0x10, 0x0E, 0x84, 0xEA, 0xD4, 0x60, 0x5F, 
+0x94, 0xF8, 0x27, 0x8A, 0x78, 0x88, 0x96, 0x2E, 
+0xC3, 0x13, 0x50, 0xA7, 0xBF, 0xE5, 0x61, 0x2A, 
+0x13, 0xA3, 0xDD, 0xBB, 0x87, 0x10, 0x16, 0xB5, 
+0x23, 0xEF, 0x1D, 0xD1, 0x26, 0x69, 0xE1, 0xFB, 
+0x53, 0xD0, 0x7D, 0x80, 0xF6, 0x6D, 0xCA, 0xF0, 
+
+0x4A, 0xC9, 0x25, 0xC4, 0x5C, 0x38, 0x4E, 0xE1, 
+0x2C, 0xE7, 0xD0, 0x28, 0xA4, 0x19, 0x58, 0xE4, 
+0xB5, 0xB3, 0xCD, 0xF5, 0xB4, 0x3E, 0xAD, 0x32, 
+0x6D, 0x0C, 0x69, 0xE1, 0xD4, 0x19, 0x66, 0x1B, 
+0x9B, 0x9E, 0x5F, 0x58, 0x66, 0x60, 0x11, 0xA4, 
+0x71, 0x30, 0xF5, 0xD8, 0x27, 0x2B, 0x0E, 0xDB, 
+0xC4, 0xAD, 0x44, 0xBE, 0x95, 0x94, 0x18, 0xDB, 
+0x9C, 0xB5, 0x63, 0x2B, 0x06, 0xEE, 0x5A, 0xCA, 
+0x83, 0xCF, 0x72, 0xFA, 0xB2, 0x3B, 0x7E, 0x4D, 
+0xAE, 0x42, 0x6F, 0xDD, 0x5C, 0x44, 0x8A, 0x39, 
+0xB4, 0x1D, 0x15, 0xC8, 0xA3, 0xBD, 0xD7, 0x46, 
+0xCE, 0x34, 0x05, 0xB1, 0x56, 0xAB, 0x0F, 0x3C, 
+0xA4, 0xD7, 0x9A, 0xC4, 0x01, 0x23, 0x11, 0x3E, 
+0x01, 0x76, 0x0E, 0x59, 0x7E, 0xB1, 0x2B, 0x7D, 
+0x03, 0x03, 0xE1, 0x1F, 0xA4, 0x15, 0x71, 0x22, 
+0x6E, 0x61, 0x8F, 0x49, 0x66, 0x81, 0xB7, 0x15, 
+0xA0, 0x12, 0xBB, 0x25, 0x2D, 0x43, 0xBD, 0x41, 
+0xB7, 0x33, 0x43, 0xE6, 0x64, 0xE5, 0xEA, 0x2A, 
+0x1C, 0xB3, 0x68, 0x7D, 0x80, 0x88, 0x63, 0xA0, 
+0x25, 0xEB, 0xF7, 0xA2, 0x19, 0xFD, 0xCF, 0x6D, 
+0x22, 0x1F, 0xE4, 0x72, 0x46, 0xFB, 0x6A, 0x26, 
+0xAD, 0x52, 0x00, 0x3B, 0x59, 0x27, 0xF2, 0x5E, 
+0xF9, 0xF0, 0xAF, 0x70, 0xC3, 0x58, 0x30, 0x9E, 
+0x78, 0xAF, 0x51, 0xEA, 0x84, 0x58, 0xFB, 0x5E, 
+0x69, 0xAD, 0xD7, 0xFE, 0xC7, 0xF2, 0x7E, 0xEC, 
+0x82, 0x46, 0xAF, 0x65, 0x1E, 0x6B, 0xBF, 0x21, 
+0x6C, 0x8B, 0xB2, 0xBC, 0xC4, 0xAE, 0x91, 0xCC, 
+0xEB, 0x21, 0x7A, 0xF0, 0xC1, 0xAC, 0x70, 0x45, 
+0xF0, 0x12, 0x30, 0x22, 0x8B, 0xC5, 0xFC, 0x3A, 
+0xBE, 0x70, 0xC5, 0xAE, 0x02, 0x2B, 0xE4, 0x8A, 
+0x45, 0x02, 0xB7, 0xDF, 0x1C, 0xCE, 0xF0, 0x05, 
+0xA8, 0x14, 0x4D, 0x04, 0x49, 0x5E, 0x02, 0xFB, 
+0x2B, 0xD2, 0x0B, 0x49, 0x2B, 0x17, 0xEC, 0xB1, 
+0x5D, 
+
+0x82, 0x81, 0xB6, 0x6A, 0xF5, 0x45, 0x68, 0x1D, 
+0xE6, 0x5D, 0x10, 0xC4, 0xB1, 0xCB, 0xFC, 0x7B, 
+0xE7, 0xBB, 0xAC, 0x76, 0x38, 0xCF, 0x0D, 0x4F, 
+0x04, 0x94, 0x56, 0x0D, 0xDC, 0xAC, 0xB9, 0xE6, 
+0xB7, 0xC6, 0xB4, 0x79, 0xDE, 0x6A, 0x90, 0xDC, 
+0xB7, 0x33, 0x85, 0x17, 0xB6, 0xD7, 0x7D, 0xE2, 
+0xCE, 0xE1, 0x00, 0xE5, 0x2E, 0xBB, 0x42, 0x03, 
+0x30, 0x28, 0x17, 0xD0, 0x56, 0xFF, 0x5A, 0x0D, 
+0xF3, 0x02, 0xB3, 0x74, 0x1B, 0x88, 0x02, 0xDA, 
+0x4A, 0x4C, 0xED, 0xB8, 0x05, 0x43, 0x55, 0x94, 
+0x20, 0x9C, 0xAA, 0xC3, 0xA9, 0x0F, 0x91, 0xEF, 
+0x04, 0x32, 0xED, 0x25, 0xB2, 0x20, 0xF8, 0xA9, 
+0x99, 0xC7, 0xBD, 0xEC, 0x4F, 0xA8, 0xD6, 0xD3, 
+0xA4, 0xD7, 0xE8, 0xF5, 0x45, 0x1B, 0xE8, 0x6A, 
+0xAF, 0x4C, 0xF4, 0xB3, 0xF9, 0x23, 0x82, 0x01, 
+0x7F, 0x43, 0xFB, 0xE2, 0x63, 0x54, 0x4B, 0x93, 
+0x69, 0xED, 0x85, 0x69, 0xC1, 0x48, 0xA5, 0x3C, 
+0x28, 0x54, 0x9A, 0xE0, 0x5B, 0x12, 0x0F, 0x9A, 
+0x7B, 0xDF, 0x37, 0x99, 0x10, 0xE4, 0xF9, 0xCB, 
+0x5E, 0x9F, 0x38, 0x4E, 0x82, 0x91, 0x4B, 0xD9, 
+0x7B, 0x54, 0x39, 0xCE, 0xD9, 0x89, 0x85, 0x14, 
+0x00, 0xF7, 0xAE, 0x5B, 0xDC, 0xD9, 0xEE, 0xB5, 
+0x00, 0xAA, 0x86, 0x4C, 0xF9, 0x03, 0x1A, 0xD1, 
+0x7B, 0xDD, 0xF1, 0xB1, 0x27, 0x3A, 0x9D, 0xAD, 
+0xA5, 0xB4, 0x2A, 0x9C, 0x74, 0x4E, 0x0E, 0xC0, 
+0xC6, 0xA2, 0xB2, 0x27, 0x06, 0x14, 0x8F, 0x4F, 
+0x27, 0xCC, 0x19, 0x88, 0x80, 0xE3, 0x99, 0x22, 
+0x17, 0xDA, 0x78, 0x71, 0x38, 0x98, 0x30, 0xD4, 
+0xF5, 0x06, 0x0B, 0xEF, 0xC9, 0x01, 0x67, 0x49, 
+0x76, 0x6C, 0x11, 0x89, 0xC3, 0x0E, 0x78, 0x5A, 
+0x01, 0x6D, 0x16, 0xC2, 0xAC, 0xA4, 0xBF, 0x70, 
+0xFE, 0xCD, 0x5E, 0xDF, 0x74, 0xF3, 0xB5, 0xB2, 
+0x88, 0xA0, 0x26, 0x35, 0x14, 0x35, 0x90, 0x37, 
+0xA2, 0x63, 
+
+0xF4, 0xD4, 0x27, 0x4A, 0x9A, 0xDD, 0x69, 0x10, 
+0x23, 0xF8, 0x3A, 0xC4, 0x86, 0x7F, 0x93, 0xCE, 
+0x9A, 0x13, 0x7E, 0x18, 0x87, 0x3A, 0x2A, 0xB7, 
+0xAA, 0x9D, 0x39, 0x55, 0xD7, 0xF6, 0xE8, 0x15, 
+0x08, 0x17, 0xD2, 0xF6, 0xFC, 0x62, 0x4F, 0xD7, 
+0xA3, 0x08, 0xDE, 0x8D, 0x9C, 0xE6, 0xA8, 0x81, 
+0x37, 0xE2, 0x4B, 0xE3, 0x88, 0x27, 0x4B, 0x5E, 
+0x4E, 0x98, 0x4A, 0xA1, 0x94, 0x46, 0x33, 0x61, 
+0x94, 0xA9, 0x30, 0x55, 0x2D, 0xC3, 0x4C, 0x4F, 
+0xC3, 0xA2, 0xD0, 0x15, 0x20, 0x50, 0xBA, 0xBC, 
+0x6E, 0x2F, 0x47, 0xE5, 0x0B, 0xF3, 0x89, 0xA0, 
+0xE1, 0x78, 0xC8, 0x4A, 0x35, 0x90, 0x1C, 0xD3, 
+0x2F, 0x4B, 0x30, 0x28, 0x7B, 0x98, 0x38, 0xE2, 
+0x54, 0xD4, 0x41, 0x95, 0x53, 0x32, 0x62, 0xE1, 
+0xE5, 0xA2, 0x14, 0xE5, 0xCB, 0xE1, 0xD4, 0x21, 
+0x51, 0xA3, 0xDD, 0xEA, 0xA5, 0x18, 0x61, 0xA6, 
+0x0B, 0x1B, 0x39, 0xEE, 0x21, 0x26, 0x1B, 0x8A, 
+0x35, 0xEC, 0x5C, 0x30, 0x7F, 0x3D, 0x4C, 0x77, 
+0x0E, 0x55, 0xD5, 0x8B, 0x53, 0x72, 0xF0, 0x54, 
+0xA3, 0xBD, 0x52, 0x35, 0x65, 0x21, 0xCB, 0x96, 
+0x94, 0xB6, 0x65, 0xC6, 0x85, 0x3E, 0x74, 0x20, 
+0x83, 0x6D, 0x1B, 0x79, 0xAD, 0xFA, 0x16, 0x3B, 
+0x20, 0xF8, 0xA5, 0x79, 0x04, 0xB7, 0xE2, 0xA8, 
+0x30, 0x06, 0x03, 0x47, 0x4D, 0x44, 0xF6, 0xC9, 
+0xFF, 0xF8, 0xA1, 0xD1, 0x2D, 0x69, 0xD6, 0x20, 
+0x78, 0xEA, 0x32, 0xAE, 0x42, 0x85, 0x4D, 0xF4, 
+0x84, 0x60, 0x1B, 0x91, 0xC7, 0x74, 0xEF, 0xF7, 
+0x21, 0x34, 0xA8, 0x86, 0xF3, 0x50, 0x9F, 0x10, 
+0xA2, 0x23, 0x10, 0x35, 0x0B, 0x37, 0xAE, 0x2E, 
+0xCE, 0x31, 0xF1, 0xED, 0x48, 0xFD, 0xC8, 0x89, 
+0xB2, 0x4C, 0x69, 0x22, 0xB0, 0x2D, 0x39, 0xF3, 
+0x87, 0x81, 0x4E, 0x55, 0x53, 0x75, 0x3E, 0xDF, 
+0x10, 0x46, 0x54, 0x9B, 0x10, 0xDF, 0x00, 0xAD, 
+0xE6, 0xA6, 0x2C, 
+
+0x8D, 0x9F, 0xBE, 0xA3, 0x45, 0x4B, 0xAF, 0x4B, 
+0x5C, 0xCC, 0xEA, 0xD0, 0xAB, 0xB0, 0xA0, 0xDD, 
+0xB4, 0xA2, 0x23, 0x38, 0x00, 0x67, 0x71, 0x58, 
+0xCF, 0xB9, 0x31, 0xE3, 0x92, 0xF6, 0xA3, 0xD1, 
+0x92, 0x99, 0xE5, 0x6B, 0x8C, 0xE7, 0x66, 0x38, 
+0xFE, 0xA8, 0x15, 0x46, 0x74, 0xF3, 0x57, 0xD6, 
+0xE8, 0xC3, 0x88, 0x2D, 0x18, 0xFE, 0x4D, 0x76, 
+0x2A, 0x17, 0x8A, 0xE5, 0xB5, 0x0E, 0x62, 0xA0, 
+0xCB, 0xE9, 0x2B, 0x87, 0x2A, 0xDE, 0xC9, 0xAD, 
+0x92, 0x80, 0x65, 0x39, 0xB9, 0x08, 0x11, 0xEB, 
+0xF0, 0x72, 0xE9, 0x01, 0xCD, 0x29, 0x64, 0x87, 
+0x90, 0x1C, 0x37, 0x3C, 0x88, 0x8C, 0xB1, 0xF3, 
+0xBE, 0x20, 0x61, 0x91, 0xC8, 0x55, 0x50, 0xF2, 
+0xF7, 0xC7, 0xC3, 0xF8, 0xB8, 0x0A, 0x96, 0x2C, 
+0x26, 0x18, 0x4E, 0x6E, 0x1E, 0x58, 0x10, 0xBD, 
+0xF1, 0xFA, 0x5E, 0xD7, 0xC6, 0x28, 0x2E, 0xB4, 
+0x39, 0x6C, 0xCD, 0xD5, 0x5E, 0xD1, 0xEC, 0x02, 
+0x4E, 0x86, 0x54, 0x30, 0xB3, 0xCC, 0x44, 0x56, 
+0xAD, 0xD3, 0xB2, 0xB8, 0x5B, 0xD8, 0x3F, 0x37, 
+0x13, 0x4F, 0x36, 0xC0, 0xAD, 0xC3, 0x5D, 0xF8, 
+0x70, 0xAC, 0xDB, 0x83, 0x61, 0x6F, 0x44, 0xB5, 
+0xFB, 0x41, 0xF1, 0x3D, 0x42, 0xA1, 0x40, 0xF3, 
+0x69, 0x17, 0x72, 0x8A, 0x15, 0x1C, 0x1D, 0x5C, 
+0x12, 0xC6, 0x17, 0xD3, 0x50, 0x05, 0xE6, 0x94, 
+0x47, 0x7D, 0x0A, 0xA7, 0xF7, 0x87, 0x93, 0x64, 
+0x25, 0x07, 0x93, 0x6A, 0xB7, 0x49, 0x00, 0xAC, 
+0xAA, 0xBF, 0xE5, 0xC4, 0xD7, 0xFA, 0xB6, 0x22, 
+0x15, 0x95, 0x9C, 0xA3, 0xD8, 0xAF, 0xD2, 0x23, 
+0x31, 0x39, 0x6F, 0x42, 0xA4, 0xDB, 0x07, 0x7A, 
+0x9C, 0x64, 0x57, 0xFD, 0x8F, 0x65, 0x36, 0xBE, 
+0x9E, 0x65, 0x40, 0x7F, 0xB3, 0xB9, 0x71, 0xC9, 
+0x26, 0x8C, 0x69, 0xBA, 0x24, 0x6D, 0xA2, 0x35, 
+0x8A, 0xFC, 0xD0, 0xB0, 0xD5, 0x70, 0x98, 0x80, 
+0xBC, 0x04, 0xB8, 0x0F, 
+
+0x26, 0xED, 0xBD, 0xC5, 0xC8, 0x11, 0xA8, 0xF9, 
+0x42, 0x46, 0xF0, 0x9A, 0xCE, 0xE5, 0x62, 0xFD, 
+0x1F, 0xCE, 0x09, 0x81, 0xDF, 0x0A, 0x59, 0x1E, 
+0x1B, 0xDB, 0xE1, 0x16, 0xC3, 0xA2, 0x96, 0x5C, 
+0x62, 0x30, 0x22, 0x38, 0xF0, 0x91, 0x7A, 0x5B, 
+0x53, 0x21, 0x23, 0x2A, 0xC6, 0xFC, 0x84, 0x4F, 
+0xE4, 0xA8, 0x53, 0xEB, 0x87, 0xB1, 0xEE, 0x13, 
+0x1A, 0x2F, 0xB4, 0xAA, 0xAA, 0x0D, 0x7F, 0x00, 
+0x2A, 0x00, 0x6A, 0x58, 0xE7, 0xCB, 0x14, 0x2C, 
+0x99, 0x8E, 0xEA, 0x1D, 0xD8, 0x10, 0xEB, 0xE6, 
+0x24, 0xCB, 0xD6, 0x12, 0x1F, 0x5D, 0x1E, 0x90, 
+0xF1, 0x9E, 0x7B, 0x56, 0x15, 0x0D, 0xD1, 0xCA, 
+0x2F, 0xD9, 0x83, 0x57, 0x72, 0x11, 0x7B, 0x9A, 
+0x16, 0x85, 0x41, 0x0C, 0x55, 0x72, 0x12, 0xE9, 
+0xEC, 0x49, 0xD2, 0x68, 0xE9, 0x22, 0xFD, 0xA8, 
+0x1D, 0x08, 0xBB, 0xDD, 0x9B, 0xC0, 0xB7, 0xA4, 
+0xEB, 0x5D, 0x7D, 0x6C, 0xB1, 0x36, 0xB0, 0x07, 
+0x49, 0xBD, 0xC9, 0x49, 0x7D, 0xFA, 0xD8, 0xDE, 
+0x14, 0xAA, 0x0F, 0x8E, 0x22, 0xE6, 0x20, 0xE0, 
+0x31, 0xB9, 0x4A, 0xD3, 0x2D, 0x4F, 0x0E, 0xB9, 
+0xE7, 0xE3, 0x69, 0x0C, 0x7A, 0xAE, 0xA2, 0xB7, 
+0x16, 0xB7, 0xEA, 0x6D, 0xCA, 0xE5, 0x28, 0xB4, 
+0x12, 0xC0, 0x77, 0xD0, 0x93, 0x3C, 0x0E, 0xFB, 
+0xBC, 0xB1, 0x86, 0xB8, 0xBE, 0x93, 0x51, 0x82, 
+0xC6, 0x9D, 0x67, 0xC4, 0xDD, 0x21, 0xE1, 0xD3, 
+0xB6, 0x68, 0xE9, 0x9F, 0x32, 0xA6, 0x10, 0xF9, 
+0xBF, 0xDB, 0x1E, 0x45, 0xD4, 0xFD, 0xC2, 0x80, 
+0x12, 0x0C, 0xF8, 0x21, 0x88, 0x8D, 0xDE, 0x42, 
+0x76, 0xAA, 0xC0, 0xFB, 0xCD, 0xC9, 0x3F, 0x70, 
+0x97, 0xCC, 0x06, 0x48, 0xF8, 0xDF, 0x63, 0x40, 
+0x9E, 0x99, 0xC6, 0x0D, 0xC9, 0x5A, 0x23, 0x02, 
+0xE7, 0xDB, 0xBD, 0x40, 0xE2, 0xF0, 0xEC, 0x07, 
+0xA5, 0x37, 0x26, 0x1E, 0x36, 0xBD, 0x62, 0xDB, 
+0x9C, 0xE6, 0x8F, 0x23, 0x7A, 
+
+0x3C, 0xBF, 0x72, 0x60, 0xD4, 0x52, 0xA5, 0x89, 
+0x0F, 0xFA, 0xA7, 0xA8, 0x63, 0x11, 0x85, 0x0D, 
+0xB4, 0x44, 0x09, 0x0F, 0x49, 0x4A, 0x0E, 0x8E, 
+0xF4, 0x77, 0xD3, 0xF5, 0x42, 0x9E, 0xA7, 0x99, 
+0x61, 0x05, 0x7A, 0x7F, 0x66, 0xAA, 0x2E, 0xF7, 
+0x31, 0xF5, 0x26, 0xAC, 0xBA, 0x5C, 0xFB, 0xF5, 
+0x22, 0x3F, 0x0F, 0x03, 0xE9, 0xBD, 0x4C, 0xDC, 
+0x43, 0x27, 0x9C, 0xE1, 0x50, 0x8B, 0x45, 0x65, 
+0x3D, 0x8A, 0xA1, 0x7B, 0xF8, 0x53, 0x47, 0x57, 
+0xE5, 0x52, 0x43, 0x36, 0x85, 0x6F, 0x8C, 0x7F, 
+0xB7, 0xC8, 0x02, 0xDF, 0x55, 0x05, 0x73, 0x35, 
+0x82, 0x51, 0x9A, 0xF9, 0x9C, 0x80, 0xF2, 0xA2, 
+0xEF, 0x63, 0x50, 0x79, 0xED, 0x0E, 0x25, 0x76, 
+0x51, 0xEB, 0xFE, 0xAD, 0x6B, 0x40, 0xF3, 0xAC, 
+0x84, 0xF8, 0xF1, 0xFB, 0x8E, 0xB7, 0x2C, 0x07, 
+0xEF, 0x82, 0x0E, 0x06, 0x2F, 0x5A, 0x43, 0x42, 
+0x84, 0x9B, 0x62, 0x80, 0x6D, 0x5C, 0x83, 0x13, 
+0x98, 0xBF, 0x0A, 0xB1, 0x2F, 0xA9, 0x97, 0x94, 
+0xFA, 0x0B, 0xDB, 0x82, 0xAA, 0xBD, 0x13, 0xF8, 
+0xEB, 0xC5, 0x0D, 0xF1, 0x76, 0xA0, 0xED, 0xEF, 
+0x44, 0xFA, 0x50, 0x86, 0x60, 0xCB, 0x80, 0x93, 
+0x38, 0xE9, 0xE8, 0xC5, 0x0F, 0xFC, 0xA4, 0xE3, 
+0xE8, 0xE6, 0x43, 0xBD, 0xDA, 0x95, 0x6A, 0x22, 
+0x8B, 0xE2, 0x99, 0x3A, 0xB9, 0x22, 0xBD, 0x24, 
+0x01, 0xB9, 0x3B, 0x0C, 0x52, 0x72, 0xEA, 0x59, 
+0x4E, 0xA3, 0x7A, 0x62, 0x4E, 0x90, 0xF7, 0xD5, 
+0x81, 0x48, 0x79, 0x87, 0x32, 0xE7, 0x78, 0x2C, 
+0x30, 0xC8, 0x57, 0x1F, 0x4F, 0xDE, 0x9B, 0x5A, 
+0xFC, 0x94, 0x86, 0xE1, 0x79, 0xB7, 0x00, 0xF2, 
+0x1B, 0x48, 0xEB, 0xE5, 0xA3, 0x9C, 0x9A, 0xAB, 
+0xF0, 0xA7, 0x61, 0xE5, 0x93, 0x43, 0xBB, 0x1F, 
+0xB5, 0xAC, 0xB0, 0x69, 0x33, 0xCF, 0xBA, 0x79, 
+0xEC, 0x9A, 0x97, 0x7F, 0x4D, 0x68, 0x94, 0x8E, 
+0x51, 0xFE, 0xAD, 0x96, 0x93, 0xF7, 
+
+0xB6, 0x42, 0xA9, 0xC3, 0xD2, 0xFE, 0x8E, 0xA5, 
+0x66, 0x6B, 0x6C, 0x18, 0x61, 0x5D, 0x96, 0xD6, 
+0xC6, 0x93, 0x06, 0xDA, 0x0A, 0x80, 0x83, 0x8D, 
+0x67, 0x1F, 0x2C, 0x98, 0xA9, 0x9F, 0xDB, 0xB5, 
+0xBE, 0x78, 0xD3, 0x10, 0x67, 0x63, 0x52, 0x61, 
+0x5A, 0x63, 0x1E, 0xBF, 0xBB, 0x93, 0x09, 0x95, 
+0x9F, 0xDE, 0x54, 0x63, 0x39, 0xCF, 0x3D, 0xA2, 
+0x32, 0x2F, 0x57, 0x54, 0xB6, 0xAE, 0x60, 0xC4, 
+0x83, 0xA1, 0xF0, 0x62, 0x3C, 0xA4, 0x8B, 0x01, 
+0x47, 0x88, 0x9D, 0x10, 0x77, 0x2E, 0x28, 0x2B, 
+0xF3, 0x4C, 0x4C, 0xA2, 0x89, 0x85, 0xBF, 0x74, 
+0x49, 0x7E, 0x7A, 0x21, 0x13, 0x4B, 0xB9, 0xFB, 
+0x03, 0xBF, 0x0B, 0x4F, 0x6A, 0x10, 0x87, 0x27, 
+0x2B, 0x42, 0xA7, 0x5C, 0x80, 0x57, 0x16, 0xC5, 
+0xA5, 0xD5, 0xD7, 0xF3, 0xFF, 0xE3, 0x60, 0xEC, 
+0x59, 0xF9, 0x37, 0x7F, 0x8F, 0x60, 0x90, 0x47, 
+0x6D, 0x67, 0x4C, 0x75, 0x73, 0x44, 0xFF, 0xF4, 
+0xC1, 0x79, 0x41, 0x7A, 0xF0, 0xE4, 0x13, 0x01, 
+0x43, 0xAB, 0x97, 0x61, 0x52, 0xFB, 0x49, 0xCD, 
+0xF1, 0x80, 0x9E, 0x33, 0x6B, 0x73, 0x73, 0xC9, 
+0x92, 0x19, 0x6E, 0x99, 0xEC, 0x38, 0xBE, 0x52, 
+0xC6, 0x2F, 0x55, 0xB6, 0x6B, 0xE7, 0x97, 0x6D, 
+0x85, 0xF8, 0xD2, 0xE7, 0x5C, 0x2B, 0xAE, 0x04, 
+0x0B, 0xF8, 0x1B, 0x08, 0x26, 0xBD, 0xF2, 0x3A, 
+0x94, 0xEB, 0x24, 0xF9, 0xBC, 0x35, 0xE3, 0xAA, 
+0xDA, 0xC4, 0x1A, 0x21, 0x35, 0x8D, 0x87, 0x02, 
+0x89, 0xA3, 0x98, 0x4E, 0x0E, 0x4F, 0xC0, 0x99, 
+0x9C, 0xB7, 0x3E, 0x9C, 0xB2, 0x73, 0xEE, 0x21, 
+0xDC, 0x06, 0xE9, 0xE0, 0xD6, 0x6D, 0x14, 0x29, 
+0x40, 0x4C, 0x54, 0xFE, 0x92, 0x46, 0x23, 0xDF, 
+0xC5, 0x68, 0x63, 0x30, 0x3B, 0x1C, 0x31, 0x44, 
+0xD0, 0x21, 0xEE, 0x8D, 0x86, 0x19, 0x36, 0xDD, 
+0xA1, 0xA4, 0x7D, 0xD3, 0x6F, 0xFE, 0x53, 0xD0, 
+0x0E, 0xFE, 0xE5, 0x79, 0x07, 0x92, 0x2C, 
+
+0x34, 0x5F, 0xE2, 0x4E, 0xF8, 0xB3, 0x8B, 0x91, 
+0x7B, 0x04, 0xED, 0x7C, 0xA4, 0x2E, 0x4B, 0xC7, 
+0xD8, 0x9D, 0x95, 0x7A, 0x2C, 0xA8, 0xD4, 0x32, 
+0x7B, 0x3F, 0xE7, 0x31, 0xD6, 0xB4, 0xEE, 0x71, 
+0x31, 0x12, 0xF4, 0xA3, 0xDB, 0xE0, 0x65, 0x21, 
+0xFC, 0xDB, 0x28, 0x07, 0x32, 0x5C, 0x38, 0x5B, 
+0xB9, 0x54, 0xF7, 0x7B, 0xBC, 0xA1, 0x30, 0xC0, 
+0x24, 0x80, 0xE8, 0xED, 0x42, 0x9C, 0x20, 0xD0, 
+0x65, 0xB6, 0x47, 0xAB, 0xC6, 0x7B, 0x6B, 0xDD, 
+0x79, 0x18, 0x00, 0x88, 0x4D, 0xD2, 0xEE, 0xEC, 
+0x98, 0xB1, 0xDE, 0xF2, 0x7A, 0x61, 0x55, 0x39, 
+0x1F, 0xD3, 0xB1, 0xDA, 0xD8, 0x4B, 0xCF, 0xD2, 
+0x2D, 0x10, 0x3E, 0x5C, 0xE8, 0x58, 0x19, 0xDC, 
+0xB6, 0xDD, 0x38, 0xB9, 0xC1, 0xA1, 0xE6, 0xF9, 
+0x1B, 0xF8, 0x50, 0x25, 0x1E, 0xA5, 0x68, 0x35, 
+0xFD, 0xFC, 0xD2, 0x71, 0x9F, 0x15, 0xEB, 0xD3, 
+0x96, 0xC4, 0xE0, 0x90, 0x76, 0xD3, 0x3A, 0x29, 
+0x67, 0xBB, 0x88, 0x04, 0xFB, 0xA1, 0x50, 0x96, 
+0xC9, 0x08, 0x9B, 0x55, 0x18, 0x77, 0x2D, 0x73, 
+0xD6, 0x3D, 0x73, 0x73, 0x6F, 0x00, 0x6E, 0xA1, 
+0x2B, 0xB0, 0xCD, 0x51, 0xBE, 0xAE, 0xC5, 0x60, 
+0xC2, 0x76, 0x4C, 0x65, 0x25, 0xE9, 0xF6, 0x2A, 
+0x1E, 0xF3, 0xAF, 0x2C, 0xE9, 0xCE, 0x1D, 0x6C, 
+0xA3, 0x46, 0x63, 0x8D, 0x8F, 0xE2, 0xED, 0x78, 
+0x36, 0x0E, 0x26, 0x73, 0x46, 0xDD, 0x18, 0x3C, 
+0xE7, 0x84, 0x25, 0x0E, 0x67, 0xFC, 0x04, 0x23, 
+0x26, 0x2D, 0xE6, 0xF2, 0x7C, 0xEF, 0xBC, 0xEE, 
+0x29, 0x18, 0x46, 0x04, 0x90, 0x84, 0xE0, 0x63, 
+0x47, 0x63, 0x4A, 0x85, 0x77, 0xAE, 0x02, 0x2E, 
+0x61, 0xFB, 0xD9, 0x3D, 0x16, 0x8B, 0x14, 0x9F, 
+0x0D, 0xA1, 0x38, 0x66, 0x0F, 0xDE, 0x5D, 0xAA, 
+0x0A, 0x6A, 0x92, 0x3E, 0x9C, 0x5C, 0x0A, 0xB6, 
+0xCB, 0x8D, 0xE5, 0xC7, 0x24, 0x62, 0x40, 0x37, 
+0x24, 0xA8, 0x0F, 0x49, 0xDB, 0xA4, 0x8F, 0x05, 
+
+0xB6, 0x45, 0x07, 0xC6, 0x54, 0x90, 0x4C, 0xF5, 
+0x30, 0x3B, 0xE1, 0x6E, 0x9D, 0xE4, 0x99, 0x3B, 
+0x3F, 0x4B, 0xB2, 0xC8, 0x49, 0xC5, 0xC4, 0x94, 
+0xA2, 0x88, 0x1C, 0x7C, 0x7F, 0x10, 0x6B, 0x74, 
+0x5E, 0xA5, 0xAF, 0xE5, 0x57, 0x2F, 0x7D, 0x8F, 
+0x3B, 0x14, 0x04, 0x0E, 0xFB, 0x3E, 0xE3, 0x89, 
+0x76, 0x8B, 0xFC, 0xE2, 0x09, 0x9E, 0xC2, 0xE6, 
+0xB8, 0xDA, 0xB5, 0x5C, 0x35, 0x4A, 0xB2, 0xC1, 
+0x9D, 0xF3, 0xCD, 0xB5, 0x79, 0x71, 0x35, 0x22, 
+0x5F, 0x03, 0x5A, 0x7E, 0xE2, 0x2F, 0xE0, 0x59, 
+0xE6, 0x15, 0x86, 0x9A, 0x8B, 0x77, 0xA2, 0x50, 
+0xA9, 0xAD, 0x4B, 0xE8, 0x1D, 0x51, 0x97, 0xE2, 
+0xB3, 0xA0, 0xC6, 0xD5, 0xEA, 0xC3, 0x59, 0x9B, 
+0xBD, 0x5A, 0x8F, 0x96, 0xE1, 0x98, 0x8B, 0x7A, 
+0x55, 0xDE, 0xB9, 0x63, 0x96, 0xC6, 0x06, 0x03, 
+0x5B, 0x1D, 0x53, 0x9A, 0x3E, 0xB1, 0x4B, 0xBC, 
+0x82, 0xA8, 0x24, 0x0D, 0x19, 0x93, 0xE8, 0x58, 
+0x9C, 0xF7, 0xBA, 0xEE, 0xAF, 0x9B, 0xB6, 0x18, 
+0xA3, 0x98, 0x31, 0xEA, 0x2C, 0x74, 0xE0, 0xCA, 
+0x5A, 0x5E, 0x54, 0xD1, 0x14, 0xA7, 0x2C, 0xCB, 
+0xC7, 0xA8, 0x68, 0xD7, 0x55, 0x91, 0x38, 0xEA, 
+0x9D, 0x92, 0x5A, 0x3B, 0x94, 0x33, 0x27, 0x30, 
+0x6A, 0xB1, 0x86, 0x94, 0x77, 0x1F, 0x08, 0x9C, 
+0x73, 0x32, 0x92, 0x5F, 0xA9, 0x2F, 0x84, 0xB0, 
+0x71, 0x68, 0x69, 0xEF, 0x52, 0x46, 0x9B, 0x16, 
+0x1E, 0xEE, 0xDA, 0x72, 0xFF, 0x2F, 0xB1, 0xAD, 
+0x72, 0x2F, 0x3E, 0x85, 0x34, 0x67, 0x6E, 0x02, 
+0x1D, 0x9A, 0xEF, 0x5E, 0xE4, 0x99, 0x48, 0xD1, 
+0xE6, 0x4E, 0xE3, 0x45, 0xD9, 0x75, 0xAB, 0x85, 
+0x69, 0x67, 0xC7, 0x73, 0x40, 0x21, 0x22, 0xEF, 
+0x76, 0x9E, 0x30, 0xB0, 0x9E, 0x3C, 0xEA, 0xEF, 
+0xC6, 0x63, 0x45, 0x85, 0x8B, 0x5D, 0xFF, 0x6E, 
+0xB2, 0x80, 0x16, 0x15, 0xDA, 0x35, 0xF1, 0x7B, 
+0x32, 0xF3, 0x70, 0xB9, 0x17, 0x41, 0x1F, 0x1D, 
+0x4C, 
+
+0x5E, 0xEF, 0x45, 0x0A, 0x23, 0xAD, 0x8B, 0x37, 
+0xA2, 0x71, 0x71, 0xB9, 0x36, 0x55, 0xCD, 0xD2, 
+0xE3, 0xF1, 0x0C, 0x5B, 0xA0, 0x5F, 0x49, 0x3B, 
+0x20, 0xD8, 0xA6, 0xDC, 0xDA, 0xC6, 0xAE, 0x63, 
+0xB1, 0x4B, 0x0B, 0xE5, 0x08, 0xD3, 0x74, 0xD7, 
+0xF1, 0x1F, 0xAD, 0x7F, 0x6C, 0xEC, 0xD2, 0x7B, 
+0xCE, 0x52, 0xE3, 0x64, 0x99, 0xCC, 0x48, 0x3A, 
+0x7E, 0xF1, 0xD3, 0xA8, 0x79, 0x3C, 0x14, 0xF4, 
+0xD4, 0xC1, 0x71, 0x68, 0x0A, 0xBF, 0xD4, 0x4D, 
+0xE8, 0x74, 0x3A, 0x9A, 0xCF, 0x41, 0xCA, 0x1A, 
+0xD6, 0x45, 0x58, 0xAF, 0xC1, 0xEE, 0x6B, 0x97, 
+0xE5, 0xB6, 0xAF, 0x52, 0xF3, 0xE0, 0xF6, 0x18, 
+0xBF, 0x24, 0x5D, 0x19, 0x90, 0x3E, 0x9D, 0xA2, 
+0x14, 0x44, 0x47, 0xB7, 0xCA, 0x3C, 0x1B, 0x97, 
+0xD2, 0xE1, 0x32, 0xAB, 0x53, 0x4E, 0x71, 0x3F, 
+0x92, 0x8A, 0xD9, 0x96, 0x7E, 0x0F, 0xFF, 0x15, 
+0xA6, 0xF7, 0x9A, 0x32, 0x48, 0x87, 0x89, 0x37, 
+0x07, 0xC9, 0x89, 0x3E, 0xBA, 0x2D, 0x3C, 0x3E, 
+0x39, 0xEF, 0xD0, 0xCC, 0xB4, 0xC1, 0x70, 0x20, 
+0x08, 0xF9, 0x95, 0xF1, 0x9F, 0x3E, 0x0A, 0x66, 
+0xAB, 0x72, 0x7D, 0xA4, 0x11, 0x4F, 0x85, 0x69, 
+0x74, 0x31, 0xEC, 0xFD, 0x7F, 0x63, 0x3E, 0x18, 
+0xBE, 0x45, 0x50, 0xCA, 0x5E, 0x38, 0x44, 0xAB, 
+0xCA, 0xAF, 0xEC, 0x0D, 0xAB, 0x4E, 0x20, 0xFD, 
+0x1F, 0x65, 0x90, 0xF8, 0xF5, 0xE8, 0x16, 0x8A, 
+0x64, 0x06, 0x09, 0xCD, 0x34, 0x63, 0x94, 0xB7, 
+0x91, 0x21, 0x17, 0x3D, 0x42, 0xC6, 0xF6, 0xFC, 
+0x00, 0x10, 0x42, 0x20, 0x06, 0x86, 0x35, 0xFE, 
+0x7D, 0xAC, 0xEB, 0x87, 0x51, 0x3D, 0x78, 0xE2, 
+0x47, 0x64, 0xF7, 0x81, 0xA6, 0x7A, 0x14, 0xFB, 
+0xFB, 0x87, 0xCA, 0x6F, 0xB0, 0x0B, 0xDD, 0x2C, 
+0x2C, 0x01, 0xA2, 0x7F, 0xBD, 0xF2, 0xCD, 0xF9, 
+0x1B, 0xBB, 0x20, 0x61, 0xBF, 0xB7, 0xCC, 0x15, 
+0x0E, 0x58, 0x24, 0x26, 0xF7, 0xFE, 0xCA, 0xCA, 
+0xDB, 0xE5, 
+
+0xAD, 0x97, 0x72, 0x57, 0x4E, 0x6D, 0xD9, 0xE9, 
+0xDD, 0x58, 0x0C, 0x88, 0x69, 0xD5, 0xFE, 0x5D, 
+0x99, 0xBF, 0x92, 0x36, 0x7D, 0x01, 0x56, 0x2B, 
+0xA5, 0x7B, 0x60, 0xFC, 0x5B, 0x5C, 0x67, 0x37, 
+0x79, 0x59, 0x4D, 0x03, 0x2A, 0x2D, 0x06, 0x54, 
+0x7F, 0xF0, 0x0F, 0xB6, 0x11, 0xEC, 0x97, 0x6F, 
+0xF0, 0xF0, 0xA8, 0x98, 0x89, 0x51, 0x8A, 0x32, 
+0x53, 0x85, 0x4E, 0xCE, 0xAD, 0x63, 0x9C, 0x01, 
+0xD3, 0x03, 0xDE, 0xF0, 0x63, 0x6F, 0x35, 0xE5, 
+0xBA, 0x1D, 0x97, 0x41, 0x22, 0xDE, 0x7A, 0x64, 
+0x5A, 0x6A, 0x54, 0x5E, 0x5C, 0x50, 0x30, 0xE6, 
+0x6B, 0xB0, 0x4F, 0x07, 0x99, 0xAE, 0x5C, 0xE8, 
+0x19, 0xAA, 0xEF, 0x3D, 0xFE, 0xF0, 0xD5, 0x06, 
+0x6E, 0x67, 0x6A, 0x2A, 0x1F, 0xC4, 0x9C, 0x37, 
+0xA0, 0x83, 0x2C, 0x86, 0x9E, 0x75, 0x59, 0x10, 
+0x8E, 0x6B, 0xDC, 0xC0, 0x62, 0x74, 0xEA, 0xC0, 
+0x5F, 0x9E, 0x24, 0x86, 0xC9, 0xD1, 0x3C, 0xF2, 
+0x68, 0xB5, 0x8E, 0x3B, 0x1C, 0x1C, 0xD1, 0x6C, 
+0x4F, 0x0A, 0x67, 0x7F, 0x8F, 0x85, 0x95, 0x8C, 
+0x84, 0x53, 0x72, 0x52, 0x24, 0x83, 0x0C, 0x45, 
+0xF0, 0xC3, 0x52, 0x99, 0x41, 0xC5, 0x27, 0x5C, 
+0x91, 0x7E, 0x18, 0x92, 0xD4, 0x0F, 0xCC, 0xD5, 
+0x7B, 0x90, 0x77, 0x11, 0xC0, 0xBC, 0xE9, 0x9E, 
+0x0B, 0xF1, 0x09, 0x28, 0x12, 0x48, 0xD6, 0xC4, 
+0x55, 0x6C, 0x12, 0x63, 0xA3, 0x80, 0x2F, 0x99, 
+0x77, 0xBE, 0xA2, 0x00, 0xB0, 0x12, 0x80, 0x3C, 
+0xC7, 0x3F, 0xEF, 0xE6, 0x7E, 0x06, 0x08, 0x8B, 
+0xE5, 0xC4, 0xAA, 0xEA, 0xF1, 0x47, 0x7F, 0x85, 
+0x7F, 0xF8, 0x00, 0xB7, 0x10, 0x58, 0xD3, 0x5E, 
+0x85, 0xDA, 0xFA, 0x60, 0x8B, 0x2F, 0xCC, 0xE1, 
+0xFC, 0xCF, 0x21, 0x9A, 0xC7, 0x98, 0x8F, 0x76, 
+0x9C, 0xAE, 0x5D, 0xF3, 0xDA, 0x7E, 0x73, 0x76, 
+0x63, 0x19, 0x11, 0x69, 0x6A, 0x5F, 0xA2, 0x7E, 
+0x2E, 0xC1, 0x5A, 0x4A, 0xC7, 0xFA, 0x1C, 0x8E, 
+0xC3, 0xA8, 0x42, 
+
+0x87, 0x37, 0x94, 0x52, 0x4E, 0xD1, 0xFD, 0x80, 
+0xA8, 0x49, 0x3B, 0x31, 0xDA, 0xED, 0x50, 0x55, 
+0x03, 0xEB, 0x82, 0xB6, 0x4B, 0x37, 0xBD, 0xCE, 
+0xFF, 0x1D, 0x7B, 0x4D, 0xB0, 0xB0, 0xF2, 0x93, 
+0xE1, 0x3C, 0x43, 0xE3, 0x4E, 0x15, 0xFD, 0x89, 
+0x1D, 0x33, 0x07, 0x88, 0xFF, 0xF7, 0xCD, 0x3C, 
+0x9E, 0x91, 0x49, 0x2C, 0xE8, 0xFA, 0xD6, 0x97, 
+0x4C, 0x19, 0xB5, 0x3F, 0xBF, 0x6D, 0xD7, 0x3B, 
+0x54, 0xA5, 0x2E, 0xEF, 0x0A, 0x0F, 0xEA, 0x9B, 
+0x2E, 0xAF, 0x84, 0x7E, 0xC3, 0xB5, 0xFC, 0x16, 
+0x50, 0x03, 0x52, 0x50, 0x2D, 0x72, 0x99, 0xE4, 
+0xD4, 0xE1, 0x68, 0x03, 0x59, 0xE2, 0x19, 0x8F, 
+0xF0, 0xD8, 0xC0, 0xAF, 0x83, 0x1C, 0xD6, 0x56, 
+0xC8, 0x89, 0xB9, 0xA0, 0x74, 0x39, 0xF8, 0xA9, 
+0x65, 0x9C, 0x11, 0x3F, 0x76, 0x12, 0xD4, 0x20, 
+0xE2, 0x86, 0x03, 0xAA, 0x5C, 0x45, 0x53, 0x10, 
+0x4F, 0xBA, 0xC8, 0x17, 0x67, 0xA7, 0xB9, 0x3C, 
+0x1E, 0x1D, 0x0A, 0xD2, 0x56, 0x1C, 0x24, 0x06, 
+0xB5, 0x4C, 0x64, 0xDD, 0xE1, 0xF5, 0xEE, 0x98, 
+0xFE, 0x92, 0xE7, 0x0F, 0xE5, 0xE0, 0x1F, 0xE5, 
+0x60, 0x6C, 0xB8, 0x87, 0xF6, 0x41, 0xBA, 0x2A, 
+0x1C, 0xD8, 0x16, 0x6D, 0x7D, 0x32, 0x8A, 0x00, 
+0x3E, 0xC6, 0xB2, 0x2D, 0x90, 0xAB, 0x45, 0xBC, 
+0x97, 0x79, 0x1E, 0x0A, 0x02, 0x52, 0x5C, 0x02, 
+0x1F, 0x2B, 0xBE, 0xA1, 0x4B, 0x3B, 0x0F, 0xF9, 
+0x84, 0x61, 0x2D, 0x96, 0xC1, 0xF9, 0x60, 0x3A, 
+0xF3, 0x6F, 0x59, 0x0A, 0xCC, 0x49, 0xD5, 0x75, 
+0x8C, 0x66, 0xF4, 0xCB, 0x70, 0xDF, 0xC5, 0x49, 
+0x6B, 0x51, 0xDE, 0xEA, 0xA6, 0xE2, 0xF7, 0x2E, 
+0xA1, 0xE4, 0xE7, 0x7C, 0xAC, 0x45, 0x2A, 0x2E, 
+0x80, 0x27, 0x0F, 0x9D, 0x0D, 0x83, 0x52, 0xC8, 
+0x06, 0x29, 0xC5, 0xFB, 0xF7, 0x92, 0x70, 0x2F, 
+0xA4, 0xAB, 0x51, 0xAE, 0x0F, 0x45, 0x13, 0x1B, 
+0x03, 0x94, 0x82, 0x5B, 0x6D, 0xCD, 0x7F, 0xC6, 
+0xB2, 0x42, 0x39, 0xFA, 
+
+0x58, 0x86, 0x09, 0xA0, 0x60, 0x43, 0x57, 0x96, 
+0x40, 0x14, 0x3E, 0xDE, 0x4F, 0xA8, 0x8A, 0x33, 
+0x6F, 0x0D, 0x77, 0xE6, 0xCD, 0x45, 0x84, 0xBE, 
+0x38, 0x4A, 0xDF, 0xC7, 0x17, 0x96, 0x02, 0x0C, 
+0xAC, 0xDB, 0xCC, 0x90, 0xCC, 0x00, 0xD8, 0xE7, 
+0x4B, 0x1F, 0xAE, 0xA7, 0x26, 0xFF, 0x99, 0x78, 
+0x22, 0xA3, 0xB7, 0xDC, 0x5D, 0x3C, 0x47, 0x70, 
+0x6B, 0x97, 0xB2, 0x2A, 0x3B, 0xF8, 0x67, 0x6B, 
+0x7E, 0x76, 0x86, 0xAE, 0x37, 0xCC, 0xA7, 0x35, 
+0xC3, 0x63, 0xE0, 0xA6, 0xFD, 0x7F, 0xC6, 0x61, 
+0x72, 0x58, 0x26, 0xF4, 0xA4, 0x39, 0x7C, 0x2D, 
+0x20, 0xC6, 0x2D, 0x47, 0x58, 0x23, 0x64, 0xF1, 
+0xA3, 0x5E, 0xD2, 0x89, 0xC8, 0xBE, 0x0B, 0xC7, 
+0x34, 0x1F, 0xA2, 0x6E, 0xAC, 0x2A, 0x2A, 0xF5, 
+0xA1, 0xD0, 0x1A, 0x43, 0xF6, 0x7D, 0xB8, 0x57, 
+0xF1, 0xD2, 0xFD, 0x6A, 0xD3, 0x19, 0xA9, 0xFC, 
+0x87, 0xBC, 0xB8, 0x84, 0x68, 0xA2, 0x41, 0x9D, 
+0xDC, 0xFE, 0x97, 0xEE, 0x09, 0x71, 0x44, 0x0A, 
+0x91, 0x6B, 0xF6, 0x33, 0xE6, 0x46, 0xF1, 0x15, 
+0xA0, 0x19, 0x9F, 0xB2, 0x5F, 0x4F, 0xDB, 0x61, 
+0x74, 0x82, 0x5C, 0x63, 0xEF, 0xA0, 0x21, 0xC7, 
+0xD5, 0xAA, 0x28, 0xC1, 0x20, 0xB5, 0xF9, 0x62, 
+0x48, 0xD1, 0xFB, 0x0E, 0xB2, 0x4D, 0x05, 0xF0, 
+0xD9, 0x58, 0xAF, 0x8E, 0x43, 0xED, 0x3D, 0xA4, 
+0xEA, 0x98, 0x7E, 0x2F, 0x3B, 0xAF, 0xE1, 0xDB, 
+0x52, 0xDF, 0xBC, 0xE0, 0x5F, 0x8B, 0x21, 0x93, 
+0xCF, 0x48, 0xA0, 0x74, 0x69, 0x41, 0x81, 0x4A, 
+0xD2, 0x76, 0xDF, 0x88, 0xDA, 0x65, 0xB0, 0xDA, 
+0x82, 0x44, 0x4F, 0xB6, 0xA7, 0x5B, 0xAF, 0x24, 
+0x68, 0x6A, 0xF0, 0x8F, 0x33, 0xB5, 0x93, 0xDF, 
+0xC5, 0xA2, 0x21, 0xD7, 0x66, 0x1F, 0x55, 0xA9, 
+0x1A, 0x92, 0x71, 0x1B, 0xCA, 0x5B, 0xC0, 0xAD, 
+0xE6, 0xBF, 0xC9, 0x4F, 0x62, 0xD8, 0xA5, 0x25, 
+0xA8, 0xDF, 0x16, 0x10, 0xDB, 0xA3, 0x79, 0xD9, 
+0x33, 0x0E, 0xB2, 0xE0, 0xA7, 
+
+0xC4, 0x36, 0x06, 0xD4, 0x28, 0xFB, 0x43, 0xC2, 
+0x7F, 0xBE, 0x8F, 0x15, 0x81, 0xA6, 0x70, 0x9F, 
+0x94, 0x93, 0x73, 0xF9, 0xDB, 0xD5, 0x53, 0xD8, 
+0xBE, 0x5B, 0xE2, 0xFF, 0x03, 0x1B, 0x56, 0x68, 
+0x11, 0xA8, 0x06, 0x09, 0xF3, 0x02, 0x30, 0x02, 
+0xB4, 0xAE, 0x6E, 0x2B, 0x59, 0x0B, 0xA1, 0xB7, 
+0x0D, 0x04, 0x3E, 0x0D, 0x99, 0x09, 0xDE, 0x62, 
+0x49, 0x4A, 0x0B, 0xFB, 0xF5, 0x08, 0x03, 0xD7, 
+0x5E, 0xC2, 0x4C, 0xCE, 0x1A, 0x0F, 0xEC, 0x83, 
+0xC8, 0xA8, 0x39, 0x31, 0x53, 0x75, 0xEB, 0x93, 
+0x05, 0xCA, 0xDD, 0xAE, 0xD8, 0x8A, 0xDA, 0xC5, 
+0x47, 0xB4, 0x7B, 0xB3, 0x74, 0xEC, 0xD3, 0xBF, 
+0x41, 0x90, 0x7F, 0xCD, 0xE8, 0x98, 0x69, 0xBC, 
+0x56, 0xF8, 0x97, 0x9F, 0xFA, 0x1C, 0x83, 0x1E, 
+0x70, 0x0C, 0x54, 0x2D, 0x19, 0x8C, 0x6C, 0x94, 
+0x40, 0xF9, 0xA2, 0x8A, 0xED, 0x48, 0xD6, 0x76, 
+0x02, 0x70, 0xDC, 0x14, 0x2D, 0xE2, 0x9F, 0x54, 
+0xEA, 0xDC, 0xE6, 0x97, 0x82, 0x0B, 0x95, 0xCF, 
+0xBA, 0x17, 0xD2, 0x88, 0x14, 0x11, 0x63, 0x44, 
+0xF4, 0xD6, 0x3A, 0x44, 0xDD, 0xFB, 0x2F, 0xDB, 
+0x91, 0x4F, 0xBF, 0xA8, 0x82, 0x10, 0xFB, 0x90, 
+0x31, 0xBD, 0x00, 0xE7, 0x3D, 0xC6, 0x20, 0xB0, 
+0xD1, 0x72, 0x2C, 0x28, 0xC3, 0x94, 0xFD, 0xD2, 
+0x4A, 0xB8, 0x60, 0x4B, 0x5E, 0xF6, 0xF6, 0xAE, 
+0xF6, 0x31, 0xE6, 0x10, 0xA3, 0x70, 0xA7, 0xB6, 
+0x50, 0x33, 0xEA, 0x61, 0x0E, 0x4E, 0xBE, 0xBD, 
+0x26, 0xAD, 0xE6, 0x19, 0xAC, 0xF9, 0xFC, 0x13, 
+0xA2, 0xEA, 0x79, 0x00, 0xD9, 0x74, 0x4A, 0x67, 
+0x3D, 0x3B, 0x5E, 0x01, 0x25, 0xDA, 0x3A, 0x9C, 
+0xD9, 0x95, 0x1F, 0x26, 0xF5, 0x69, 0xE6, 0xAD, 
+0x33, 0x6C, 0xA2, 0xA3, 0xAE, 0x74, 0x3C, 0x89, 
+0x0D, 0x9F, 0x1C, 0x40, 0x60, 0x85, 0x76, 0xC7, 
+0xE8, 0x61, 0xF6, 0xA9, 0x75, 0xF7, 0x0F, 0xD4, 
+0x72, 0xEC, 0xC2, 0x04, 0x1E, 0xFF, 0x8A, 0xBC, 
+0xBD, 0x68, 0x47, 0xED, 0xA5, 0xD5, 
+
+0x20, 0x19, 0xC8, 0x50, 0x81, 0x9A, 0xB4, 0xEA, 
+0x1E, 0x89, 0x21, 0x05, 0xCC, 0x3F, 0xE3, 0xCE, 
+0x0B, 0x82, 0x5A, 0xC7, 0x93, 0x40, 0xE0, 0xF7, 
+0x33, 0x2B, 0x1E, 0xA3, 0xAE, 0x23, 0x1E, 0xF2, 
+0xE6, 0x0D, 0x1F, 0x92, 0x15, 0xCC, 0xC2, 0x1D, 
+0xBC, 0xF0, 0xBD, 0xF0, 0x1E, 0x8D, 0x88, 0x22, 
+0xD2, 0x7B, 0x3B, 0xDA, 0x49, 0x98, 0x46, 0x75, 
+0x80, 0x6F, 0xEF, 0x64, 0xCB, 0xA8, 0xFA, 0xDF, 
+0x07, 0x29, 0x78, 0x81, 0x06, 0x73, 0x2B, 0xA4, 
+0xEA, 0x91, 0x18, 0xA0, 0x85, 0x99, 0x1C, 0x1D, 
+0xA0, 0x68, 0x46, 0xCD, 0x60, 0xF2, 0x97, 0x9C, 
+0xB2, 0x39, 0xE4, 0xF7, 0xDA, 0x22, 0xB3, 0xBD, 
+0x63, 0xDC, 0xD6, 0x3D, 0xC3, 0x30, 0x2F, 0x5C, 
+0x01, 0x55, 0xF7, 0x28, 0x6C, 0xDB, 0x01, 0x11, 
+0xB4, 0x35, 0xFC, 0xE9, 0xCF, 0xBA, 0x7A, 0x2F, 
+0x5D, 0xF5, 0x19, 0x7B, 0x27, 0xF0, 0x35, 0xAB, 
+0xC3, 0x07, 0xF2, 0xA3, 0x0B, 0x69, 0x44, 0x0E, 
+0xBF, 0xA7, 0x2A, 0x32, 0xBD, 0x1A, 0x4C, 0x08, 
+0x8E, 0x8C, 0x19, 0x66, 0x26, 0x50, 0x07, 0x02, 
+0x95, 0xED, 0x40, 0x48, 0xEA, 0xBD, 0x6D, 0x07, 
+0xB4, 0x05, 0x46, 0x0F, 0x6C, 0x8D, 0x96, 0xA3, 
+0x03, 0x67, 0x3A, 0x8A, 0x18, 0xD3, 0xE3, 0x34, 
+0xE5, 0x26, 0x82, 0x4A, 0x85, 0x7B, 0xED, 0x76, 
+0xC4, 0x38, 0x0C, 0xA7, 0xB8, 0x01, 0xA4, 0x62, 
+0xDF, 0x1F, 0x0A, 0x75, 0x01, 0x22, 0x48, 0x85, 
+0x11, 0x5C, 0x48, 0x64, 0x0B, 0x97, 0xB2, 0x34, 
+0x8F, 0xBF, 0x63, 0x51, 0xE2, 0x0A, 0x23, 0x85, 
+0x2F, 0xFE, 0x19, 0x02, 0xDF, 0x75, 0x47, 0x0C, 
+0x0B, 0xFC, 0x37, 0xF2, 0x76, 0x14, 0x30, 0x4A, 
+0x41, 0x9B, 0x26, 0xC9, 0x20, 0x3A, 0xAC, 0x46, 
+0x78, 0x0F, 0xE0, 0x84, 0x30, 0x33, 0x75, 0xAD, 
+0xF5, 0x23, 0x60, 0xC2, 0xB1, 0xD0, 0xAC, 0x02, 
+0xB1, 0xEF, 0xE6, 0xAF, 0x86, 0x2B, 0x4C, 0xBB, 
+0x6D, 0xAA, 0xA0, 0x7E, 0x8A, 0x55, 0x25, 0xC1, 
+0xD7, 0xB8, 0x67, 0x57, 0xC6, 0xBC, 0x88, 
+
+0x7C, 0x95, 0x6E, 0x6D, 0xF5, 0xA1, 0xB5, 0x64, 
+0x93, 0xB1, 0xD4, 0x7C, 0x27, 0x6C, 0x32, 0x30, 
+0xB9, 0x7E, 0x86, 0x7F, 0xAD, 0xCE, 0xF6, 0xFC, 
+0x59, 0x8F, 0xDF, 0x61, 0xE0, 0xD6, 0x16, 0x52, 
+0x0C, 0x7A, 0x2A, 0xDE, 0xAB, 0xE3, 0x54, 0x16, 
+0x7E, 0x5C, 0xCA, 0xF2, 0xCD, 0x9F, 0xE2, 0x3B, 
+0x0D, 0xED, 0x26, 0x5A, 0x6B, 0x6A, 0x87, 0x66, 
+0x30, 0x3C, 0x80, 0xDB, 0x46, 0x28, 0x8D, 0xC1, 
+0xEB, 0xB2, 0x43, 0x4E, 0x96, 0x94, 0x94, 0x3D, 
+0x32, 0x5C, 0x2F, 0x5A, 0x64, 0x48, 0x52, 0xF3, 
+0x6D, 0xB3, 0x4A, 0x61, 0x65, 0x10, 0x65, 0xC1, 
+0x55, 0x85, 0x55, 0x1F, 0xEB, 0xF6, 0xAC, 0x8B, 
+0xA4, 0xE9, 0x21, 0x5C, 0x1D, 0xA8, 0x86, 0xE8, 
+0xF7, 0x50, 0x4C, 0xF6, 0xED, 0xCB, 0x71, 0xD3, 
+0x9E, 0x5C, 0x74, 0x23, 0xA8, 0x6B, 0x69, 0x37, 
+0x54, 0xBC, 0x9C, 0xD9, 0xBA, 0xCE, 0x54, 0x18, 
+0xA3, 0x7C, 0x7D, 0x03, 0xEE, 0xC9, 0x01, 0x60, 
+0xF1, 0xB5, 0x45, 0x76, 0x1F, 0xE8, 0x75, 0x3D, 
+0x16, 0xC5, 0xD2, 0x74, 0x21, 0x67, 0x09, 0xBC, 
+0xA8, 0x51, 0x2F, 0x87, 0xF8, 0xCD, 0x1B, 0x27, 
+0xB1, 0x88, 0x3F, 0xAF, 0xCF, 0x28, 0xA5, 0xC3, 
+0x3F, 0xFB, 0xE9, 0xCF, 0x3D, 0x35, 0x78, 0x90, 
+0x17, 0xC1, 0x7A, 0xAE, 0xE7, 0x23, 0xFA, 0x57, 
+0xB8, 0x44, 0x46, 0x55, 0x74, 0xD7, 0x0A, 0x11, 
+0x44, 0x7F, 0x8B, 0xE4, 0xA7, 0x66, 0x17, 0xE0, 
+0xF8, 0x38, 0xF5, 0x1C, 0xF9, 0xB4, 0x4D, 0x30, 
+0x04, 0x3C, 0x69, 0x63, 0xD9, 0x2C, 0xCF, 0x3C, 
+0x67, 0x49, 0x6E, 0xE6, 0x2F, 0x0F, 0xE1, 0x6A, 
+0xFB, 0x4D, 0x00, 0xBF, 0x64, 0x76, 0xC7, 0xFC, 
+0x25, 0x66, 0x3A, 0xD6, 0x71, 0x7D, 0x21, 0x99, 
+0xCC, 0xFE, 0xE1, 0x04, 0x68, 0x99, 0xFA, 0x00, 
+0xC0, 0x0C, 0xDD, 0xB8, 0x92, 0x0C, 0x94, 0x7E, 
+0x56, 0x8D, 0x06, 0x55, 0x97, 0x94, 0x4B, 0xA8, 
+0xC0, 0x1D, 0x7A, 0x5B, 0x61, 0xD6, 0x8E, 0xC0, 
+0x8C, 0x41, 0x36, 0x01, 0x7E, 0x25, 0xB2, 0x56, 
+
+0x24, 0x40, 0x47, 0x87, 0x1B, 0xF4, 0xFD, 0x1C, 
+0xE5, 0x23, 0x91, 0x43, 0xC3, 0x9E, 0x85, 0x89, 
+0x74, 0x21, 0xB6, 0x61, 0x3A, 0x32, 0x3A, 0xAF, 
+0x72, 0x44, 0xC1, 0xF9, 0x41, 0x16, 0xE3, 0x1C, 
+0x86, 0xC8, 0xAA, 0x17, 0xF5, 0x44, 0x69, 0x18, 
+0x3A, 0x59, 0x7C, 0x35, 0x47, 0x87, 0x5B, 0x6F, 
+0x1A, 0x33, 0xA8, 0xF0, 0x29, 0x49, 0x47, 0x52, 
+0x22, 0xE8, 0x52, 0xE1, 0xF2, 0xCA, 0xD1, 0xE9, 
+0xA6, 0x56, 0xCE, 0x5A, 0x87, 0xD4, 0xEA, 0x65, 
+0x96, 0xCD, 0xC8, 0x69, 0x45, 0xEB, 0xB1, 0x0E, 
+0x1C, 0xC5, 0xE7, 0xDA, 0xF8, 0x0A, 0x8C, 0x2D, 
+0x6E, 0xA9, 0x60, 0x3B, 0x1B, 0xDD, 0x21, 0x33, 
+0xA7, 0xD3, 0x2B, 0xD5, 0x3C, 0xC7, 0xC4, 0x2E, 
+0x53, 0xDF, 0xCF, 0x5A, 0x73, 0xFB, 0x77, 0x12, 
+0x6B, 0xB4, 0x29, 0x0C, 0x0E, 0xEC, 0x74, 0x4F, 
+0xA2, 0x94, 0x8E, 0x7D, 0x49, 0xAC, 0xD8, 0xCC, 
+0x4E, 0x5A, 0x49, 0x65, 0xC9, 0xAE, 0xCC, 0x50, 
+0x6C, 0xE0, 0xAE, 0xC6, 0x80, 0x94, 0x6A, 0x42, 
+0x57, 0x2E, 0xFE, 0x00, 0x99, 0xB8, 0xD2, 0x63, 
+0xC8, 0x20, 0x31, 0xF4, 0x35, 0x67, 0x18, 0xC9, 
+0x00, 0x4A, 0xA8, 0x35, 0x92, 0xB1, 0x83, 0x13, 
+0x13, 0x9E, 0x19, 0x55, 0x53, 0x56, 0x76, 0x92, 
+0xE0, 0xD4, 0xB9, 0x06, 0x06, 0x78, 0x20, 0x7A, 
+0x05, 0x55, 0xD7, 0xEB, 0x66, 0x7E, 0xB3, 0x8C, 
+0x18, 0x3A, 0xC2, 0xB3, 0xE3, 0xA8, 0x7D, 0x9E, 
+0x24, 0x65, 0x33, 0x66, 0xFE, 0x17, 0x19, 0x05, 
+0xD7, 0x7A, 0x9D, 0xB7, 0xC5, 0x89, 0xA2, 0x8A, 
+0x01, 0xA2, 0xB4, 0x0E, 0x6B, 0x07, 0x95, 0x35, 
+0x90, 0x38, 0xBF, 0x86, 0x70, 0x01, 0xEB, 0x13, 
+0x95, 0x42, 0xF7, 0xD5, 0xC7, 0x45, 0x09, 0xF2, 
+0x28, 0x62, 0x41, 0x67, 0x8D, 0x1A, 0xD2, 0xB9, 
+0xC2, 0xC6, 0x8D, 0xA3, 0xF3, 0x5A, 0x2F, 0xFA, 
+0x87, 0x2E, 0x81, 0x95, 0x37, 0x73, 0xD8, 0x1C, 
+0xDB, 0xB9, 0x43, 0x1D, 0x8E, 0x6E, 0x51, 0xF3, 
+0xF5, 0x45, 0x31, 0xBA, 0xF8, 0x78, 0x2E, 0x40, 
+0x96, 
+
+0x69, 0xE5, 0x00, 0xC9, 0x40, 0xB6, 0xC0, 0x75, 
+0x70, 0xA0, 0x8C, 0x52, 0xD4, 0x18, 0x48, 0x7B, 
+0x53, 0xA5, 0x08, 0x1B, 0xA9, 0x38, 0xC2, 0xFE, 
+0x04, 0x6B, 0x75, 0x4C, 0xFF, 0x6B, 0x64, 0x00, 
+0xA0, 0x69, 0x54, 0x58, 0x44, 0x0E, 0xF7, 0x8A, 
+0x38, 0x48, 0x0D, 0x6C, 0x53, 0xF3, 0x91, 0xF3, 
+0x4C, 0x14, 0xDC, 0xC6, 0xBF, 0x6E, 0x78, 0x74, 
+0xE0, 0xC3, 0x19, 0x72, 0xE2, 0x82, 0x8D, 0x14, 
+0x89, 0x6E, 0xF8, 0x69, 0xF0, 0x99, 0x39, 0x4A, 
+0xD1, 0xEE, 0xBF, 0x49, 0xB1, 0xD9, 0x44, 0x4E, 
+0x2F, 0x29, 0x23, 0xBB, 0x1A, 0x48, 0xC0, 0xE2, 
+0xB6, 0x29, 0xC3, 0x6A, 0x93, 0x1B, 0xB5, 0x80, 
+0x1B, 0x81, 0x36, 0xA0, 0xED, 0xCD, 0x85, 0xFE, 
+0x90, 0x95, 0x4A, 0x56, 0x00, 0x59, 0xEA, 0xC8, 
+0xD1, 0x44, 0x38, 0x75, 0xD2, 0xFC, 0xD6, 0xE3, 
+0x66, 0x07, 0xFF, 0xFB, 0x8F, 0x15, 0x55, 0xEE, 
+0x33, 0x1B, 0x42, 0xB6, 0xA2, 0x73, 0xFE, 0x67, 
+0xC8, 0x8D, 0x44, 0x4F, 0xA3, 0x67, 0xED, 0x1C, 
+0xFF, 0xBB, 0xA0, 0x75, 0x6B, 0x4B, 0x9A, 0x5F, 
+0x1D, 0x75, 0x9B, 0x96, 0xEC, 0xA0, 0xD5, 0x44, 
+0x65, 0x14, 0x4F, 0xC5, 0xC4, 0x3F, 0x5A, 0xEE, 
+0xFB, 0x84, 0x3C, 0x09, 0xBA, 0xA7, 0x92, 0x35, 
+0x7B, 0xFD, 0xF3, 0x66, 0x7B, 0x3D, 0xA3, 0x82, 
+0x07, 0x75, 0xD9, 0xC7, 0x0B, 0x0A, 0x2D, 0x84, 
+0x7E, 0x89, 0xCB, 0xB6, 0xED, 0x08, 0x0C, 0x04, 
+0x1E, 0x79, 0xAB, 0xF7, 0xC8, 0xBF, 0xAF, 0x77, 
+0x0A, 0x7C, 0x56, 0x6A, 0x79, 0x5C, 0x21, 0x8C, 
+0xC4, 0xAD, 0x6F, 0x01, 0x8A, 0x7A, 0x31, 0xC7, 
+0x3D, 0x8D, 0x65, 0xE1, 0x29, 0xB3, 0x5D, 0xA5, 
+0xD9, 0x47, 0xEA, 0x85, 0x1C, 0x70, 0x96, 0xFB, 
+0x26, 0x43, 0x52, 0x05, 0x06, 0x75, 0xF1, 0x83, 
+0xA9, 0x6A, 0xAB, 0x8B, 0x4C, 0xAC, 0xE0, 0x15, 
+0x72, 0xE7, 0x23, 0xCE, 0x14, 0x1C, 0x45, 0xA8, 
+0xF4, 0x4B, 0x33, 0xCC, 0xFE, 0xCB, 0x7E, 0x20, 
+0xFD, 0x44, 0x9A, 0x02, 0x75, 0xE2, 0x86, 0xA8, 
+0x95, 0xDB, 
+
+0xA1, 0xE8, 0x1A, 0x0F, 0xDD, 0x02, 0x66, 0x96, 
+0xEA, 0xE3, 0xA7, 0xEF, 0xB5, 0x6B, 0xEA, 0x70, 
+0xB3, 0xE1, 0xDC, 0x00, 0x19, 0xC7, 0xB7, 0x8F, 
+0x71, 0xD4, 0x70, 0x5D, 0x3F, 0xAA, 0xFC, 0x56, 
+0x82, 0x0F, 0x62, 0xB5, 0x92, 0x47, 0x75, 0x9F, 
+0x59, 0x76, 0x79, 0xD4, 0x99, 0x5E, 0x3D, 0xE9, 
+0x72, 0x3F, 0x2A, 0x76, 0xBA, 0x54, 0x1C, 0xD6, 
+0x1D, 0xC1, 0xB7, 0xC8, 0x1D, 0xEA, 0xB4, 0xD9, 
+0xEE, 0x52, 0xBD, 0x7B, 0xBA, 0x12, 0x4E, 0x1D, 
+0xFC, 0xE8, 0xA9, 0xB7, 0x97, 0xE8, 0xFA, 0x92, 
+0x97, 0x0C, 0xC8, 0x30, 0x4D, 0x23, 0xC5, 0xA8, 
+0xCE, 0xA7, 0x94, 0x62, 0x05, 0x50, 0x83, 0x39, 
+0x8B, 0xEB, 0xFE, 0x34, 0xAA, 0xA7, 0xB8, 0x07, 
+0xBF, 0x19, 0xEC, 0x80, 0xB4, 0x25, 0xCF, 0xA5, 
+0x5A, 0x8D, 0x33, 0xCA, 0xD9, 0x9F, 0x0B, 0xB7, 
+0x35, 0xF0, 0x1A, 0x5F, 0x8F, 0x10, 0xF6, 0x90, 
+0x87, 0x73, 0xAC, 0xA3, 0xE3, 0x5B, 0x7A, 0x6F, 
+0x21, 0x98, 0x16, 0x3B, 0x2A, 0x09, 0xE3, 0xEF, 
+0x3A, 0x61, 0x5E, 0x36, 0x4D, 0x58, 0x99, 0xE2, 
+0x6C, 0x94, 0xD8, 0xFD, 0x4E, 0x0D, 0x4C, 0x18, 
+0x91, 0x26, 0xD0, 0x41, 0x07, 0x38, 0x6C, 0x36, 
+0xE1, 0xCE, 0x2A, 0xB5, 0x99, 0x0D, 0x8E, 0x7E, 
+0x1C, 0x85, 0x82, 0x71, 0x62, 0xA5, 0x06, 0x0E, 
+0x44, 0x7D, 0x63, 0x77, 0xA9, 0x1F, 0x74, 0x22, 
+0x40, 0x3A, 0x72, 0xD9, 0xC8, 0x2E, 0x2F, 0xC8, 
+0xE0, 0xE3, 0x1B, 0x2B, 0x18, 0x7B, 0x2D, 0xD8, 
+0x7D, 0x3D, 0x18, 0x8E, 0xF8, 0x05, 0xCF, 0xF6, 
+0x24, 0x4F, 0xCE, 0x66, 0xF6, 0x28, 0x8A, 0xAA, 
+0x27, 0x5C, 0x84, 0x86, 0x3B, 0xF7, 0xDD, 0xF7, 
+0xB8, 0x4A, 0x14, 0x64, 0xE6, 0xB4, 0x6D, 0x25, 
+0xB2, 0xC9, 0x50, 0x91, 0x24, 0x51, 0xD0, 0x92, 
+0x98, 0x9B, 0xB9, 0xB5, 0x61, 0x43, 0xD3, 0xBA, 
+0x0A, 0x16, 0xF9, 0xF6, 0x99, 0x6B, 0x26, 0x0A, 
+0x5C, 0x13, 0x89, 0x3A, 0x85, 0x3D, 0x72, 0x54, 
+0x96, 0xDC, 0x17, 0xCA, 0xB0, 0x1E, 0x31, 0xE2, 
+0x47, 0xC4, 0x84, 
+
+0xEE, 0x2F, 0x70, 0xAD, 0x8C, 0x4B, 0xB5, 0xE2, 
+0x1A, 0x90, 0xE0, 0x69, 0x8D, 0x65, 0x79, 0xF2, 
+0x94, 0xB4, 0x7E, 0xCE, 0x9B, 0x44, 0xEA, 0xC9, 
+0xDD, 0x32, 0xA4, 0x75, 0xF3, 0x90, 0xA7, 0xA9, 
+0xB0, 0xA2, 0x89, 0x0F, 0x1B, 0x21, 0xE1, 0x28, 
+0x1B, 0x84, 0xF0, 0x2E, 0x5C, 0x46, 0x20, 0xAD, 
+0xEB, 0xE3, 0x68, 0x2B, 0x11, 0x0A, 0x1B, 0xF8, 
+0xF8, 0x66, 0x63, 0xF3, 0x4F, 0x7E, 0xF6, 0x53, 
+0x28, 0x75, 0xBA, 0xA0, 0xE6, 0x39, 0x22, 0x3E, 
+0x3D, 0x05, 0x38, 0x26, 0x64, 0x84, 0x30, 0x9D, 
+0x92, 0xEC, 0x79, 0xA0, 0xAE, 0x74, 0xD4, 0x7D, 
+0xA7, 0x36, 0x01, 0xC6, 0x79, 0x8A, 0x67, 0xA9, 
+0x90, 0xC3, 0x07, 0x86, 0x42, 0x70, 0x67, 0x70, 
+0xED, 0x5D, 0xD1, 0x81, 0xD9, 0x86, 0x56, 0x9C, 
+0x48, 0x80, 0xE4, 0xD3, 0xB5, 0x67, 0xAF, 0x4C, 
+0x9E, 0x70, 0xF0, 0x5E, 0x2C, 0x9B, 0x2A, 0xBB, 
+0xED, 0x35, 0x10, 0xBD, 0x22, 0x32, 0x8B, 0xB8, 
+0x4D, 0x2B, 0x96, 0x9B, 0xD1, 0xA6, 0x0A, 0x4B, 
+0xE0, 0x07, 0x16, 0xEB, 0x11, 0x47, 0x06, 0x21, 
+0x59, 0xA1, 0xD4, 0xB8, 0x0F, 0xB5, 0xA0, 0x95, 
+0x7D, 0xA8, 0x6D, 0x50, 0x56, 0xF0, 0x60, 0xE4, 
+0x01, 0x12, 0x71, 0x92, 0x46, 0x6A, 0x04, 0xEA, 
+0x47, 0x2D, 0x6D, 0x7A, 0x6B, 0xC9, 0x5E, 0xDF, 
+0x31, 0xF1, 0xA1, 0x7B, 0xD1, 0xB6, 0x5D, 0x91, 
+0xAB, 0x9B, 0x69, 0x20, 0x60, 0xA8, 0x27, 0x3D, 
+0x40, 0x66, 0x07, 0xC1, 0x5D, 0x56, 0xF2, 0x51, 
+0x43, 0x81, 0x7F, 0x1B, 0xA3, 0x34, 0xD6, 0x4A, 
+0xF5, 0xBD, 0xFE, 0x8E, 0xF6, 0x87, 0x5F, 0x67, 
+0x79, 0x28, 0x1C, 0xDF, 0xDB, 0x1C, 0xA7, 0x38, 
+0xCD, 0xB2, 0x67, 0xB2, 0x82, 0xB6, 0x35, 0x5F, 
+0xEA, 0xE9, 0xED, 0xBB, 0x7D, 0x20, 0x37, 0xFC, 
+0xA3, 0x70, 0x41, 0xEF, 0xBF, 0x3C, 0xC2, 0xC2, 
+0xB2, 0x44, 0x0D, 0x6A, 0xFE, 0x8C, 0xD1, 0xD5, 
+0x6C, 0xDF, 0x88, 0x1C, 0x8C, 0x06, 0x3E, 0x89, 
+0x41, 0x3A, 0x1C, 0x8E, 0xF2, 0x8C, 0x1D, 0x95, 
+0xC2, 0x0D, 0x72, 0x61, 
+
+0xC6, 0xC8, 0x89, 0x54, 0x24, 0x1D, 0xED, 0x57, 
+0xD4, 0xAA, 0x5A, 0x2E, 0xAA, 0x4A, 0xF1, 0xB0, 
+0x92, 0x8D, 0x39, 0xB6, 0x81, 0x0D, 0x2F, 0x97, 
+0x8B, 0x5A, 0xDE, 0xBE, 0x4A, 0x7C, 0x9A, 0x0A, 
+0x07, 0xAF, 0xA8, 0x1C, 0x75, 0xCC, 0x9D, 0x1F, 
+0x48, 0x9E, 0xDC, 0xB1, 0x72, 0x34, 0xD8, 0x43, 
+0x68, 0x20, 0x03, 0x75, 0xA9, 0xED, 0xAA, 0x56, 
+0x48, 0xB3, 0x62, 0x22, 0xC3, 0x82, 0x46, 0x82, 
+0xAE, 0x9C, 0x26, 0x74, 0xBB, 0x33, 0xC3, 0x91, 
+0x84, 0x2C, 0xAC, 0xB2, 0x4C, 0x26, 0xE6, 0x1E, 
+0x75, 0xE8, 0x01, 0x2D, 0x9C, 0xF5, 0xE4, 0xB1, 
+0x73, 0xC0, 0x98, 0xC5, 0x61, 0xAD, 0x2C, 0xB2, 
+0xC3, 0xD5, 0xA9, 0x87, 0x0A, 0x12, 0x99, 0xA9, 
+0x7D, 0x5F, 0xA7, 0xBF, 0x61, 0x65, 0xE7, 0x37, 
+0x01, 0xD7, 0xF4, 0xFC, 0xA0, 0x7B, 0x2A, 0x5E, 
+0x3F, 0x3A, 0x7B, 0x00, 0x14, 0x17, 0x18, 0x5A, 
+0xD9, 0x5E, 0x24, 0xE6, 0xBB, 0x57, 0x37, 0x4F, 
+0x6D, 0x0B, 0x67, 0x28, 0x08, 0xAB, 0x9D, 0x8D, 
+0x0A, 0xD6, 0x4F, 0x8D, 0x2C, 0x5D, 0x21, 0xCC, 
+0x95, 0x23, 0xBE, 0x38, 0xB9, 0x63, 0x6E, 0x75, 
+0x52, 0xAD, 0x53, 0xB2, 0xEA, 0x5A, 0xAC, 0x1A, 
+0xD4, 0xE8, 0xB3, 0x33, 0xE6, 0x50, 0x2F, 0xD6, 
+0x00, 0xE0, 0xDA, 0x51, 0xEB, 0x46, 0x93, 0xE7, 
+0xE0, 0xBE, 0xC2, 0x20, 0x0F, 0xDE, 0x14, 0xBA, 
+0x0F, 0x61, 0x9A, 0xEC, 0xE5, 0x28, 0x5A, 0x48, 
+0x37, 0x95, 0x44, 0xCD, 0xB7, 0xAD, 0x8D, 0x24, 
+0x4A, 0x71, 0x0A, 0xE7, 0xF5, 0x99, 0xDA, 0x1D, 
+0xF3, 0xEF, 0xD5, 0xAA, 0xF9, 0x97, 0x05, 0xD1, 
+0x05, 0x70, 0xE8, 0xD1, 0xBC, 0x04, 0x4E, 0x09, 
+0xDC, 0xC4, 0x6B, 0xF6, 0x1C, 0x72, 0xF9, 0x34, 
+0xB3, 0x42, 0xAD, 0xC3, 0x5D, 0xC8, 0x64, 0xFE, 
+0x0B, 0x52, 0xC1, 0x98, 0xE0, 0x6B, 0x41, 0x0B, 
+0x4D, 0x75, 0xD0, 0x00, 0x39, 0x3D, 0x04, 0x70, 
+0x59, 0xAD, 0x31, 0x2E, 0xDD, 0x23, 0x64, 0x2E, 
+0x4B, 0xB2, 0x5D, 0x87, 0xCD, 0x13, 0xAD, 0xD0, 
+0x20, 0xF7, 0x38, 0x0C, 0x54, 
+
+0xDF, 0xB6, 0x48, 0xD5, 0xC0, 0xD5, 0x47, 0x73, 
+0x47, 0x92, 0x39, 0xEC, 0xBE, 0x59, 0x93, 0x0C, 
+0x3A, 0x79, 0x79, 0x73, 0x87, 0x21, 0x2C, 0x66, 
+0x75, 0x68, 0xB4, 0x84, 0x33, 0x96, 0x47, 0xE0, 
+0x3A, 0x51, 0xA6, 0x87, 0x26, 0x61, 0x87, 0x84, 
+0xF9, 0x32, 0x3C, 0x44, 0x12, 0x04, 0x85, 0x17, 
+0xE6, 0x7C, 0xA5, 0x1E, 0xDC, 0xB9, 0x69, 0x03, 
+0x7F, 0x40, 0xF7, 0xEC, 0x4B, 0x6D, 0xFE, 0x52, 
+0x32, 0x70, 0xB7, 0x47, 0x38, 0x4E, 0xDF, 0x82, 
+0xEC, 0x7E, 0x8D, 0x86, 0xFA, 0x22, 0xE6, 0x65, 
+0x74, 0xEE, 0x82, 0x54, 0x2D, 0xEE, 0xA3, 0x97, 
+0xAC, 0x63, 0x23, 0xEE, 0xE0, 0x3C, 0x01, 0x86, 
+0x9D, 0xD7, 0xAD, 0x64, 0x02, 0xB1, 0x14, 0xC9, 
+0x97, 0x85, 0x11, 0xEF, 0x67, 0x99, 0xA6, 0x5E, 
+0x78, 0xF7, 0xF4, 0xF6, 0x46, 0x97, 0x1E, 0x5F, 
+0xF1, 0x2F, 0x68, 0xAB, 0xD2, 0x05, 0x70, 0xC3, 
+0x89, 0x00, 0x89, 0x59, 0x45, 0xE5, 0xDA, 0xF6, 
+0xA9, 0xD3, 0x5D, 0xE8, 0xDD, 0x7E, 0x25, 0xCF, 
+0x5C, 0xEA, 0x51, 0x7D, 0x60, 0x39, 0xE1, 0xD6, 
+0x4F, 0x1A, 0x07, 0xC5, 0xC6, 0x82, 0x74, 0xEA, 
+0x7A, 0x76, 0x76, 0x00, 0xAF, 0x6F, 0x31, 0xF3, 
+0x70, 0x14, 0x34, 0x25, 0xA8, 0xFD, 0x63, 0xD5, 
+0x66, 0xEF, 0x8F, 0x0F, 0x01, 0xAB, 0x57, 0x92, 
+0x4A, 0x2C, 0xB3, 0x80, 0x2D, 0xA0, 0x3E, 0x64, 
+0x05, 0x85, 0xB0, 0x43, 0x5C, 0x3E, 0x51, 0xC6, 
+0xCA, 0xA9, 0xE1, 0xEE, 0x36, 0x11, 0x37, 0x59, 
+0x72, 0x83, 0x18, 0x5E, 0x61, 0x0A, 0x62, 0x96, 
+0xA6, 0x6C, 0x5E, 0x61, 0x19, 0x14, 0xF4, 0xD8, 
+0xC9, 0xFC, 0x3C, 0x5B, 0x41, 0xDE, 0xD8, 0x98, 
+0x56, 0x22, 0xE5, 0x8E, 0x28, 0x7D, 0xB7, 0xCE, 
+0xF7, 0x9E, 0xE6, 0x60, 0xCA, 0x28, 0x0D, 0xF2, 
+0x13, 0x9E, 0xAE, 0xB9, 0xF3, 0x07, 0xAF, 0x0E, 
+0xCB, 0x79, 0x52, 0x2B, 0x79, 0x09, 0xE2, 0xFF, 
+0x5E, 0x02, 0xC9, 0x72, 0xDD, 0xF2, 0x69, 0xEE, 
+0x0B, 0xDA, 0x87, 0x2F, 0x9C, 0xB8, 0xA3, 0x2D, 
+0x35, 0x41, 0xFA, 0x66, 0x33, 0xFF, 
+
+0xC1, 0xCE, 0x69, 0x6D, 0xC2, 0x99, 0x3E, 0xED, 
+0x5D, 0x00, 0xC6, 0x3D, 0x98, 0x23, 0x2D, 0x4E, 
+0x37, 0xCB, 0xF4, 0x8D, 0xD3, 0x72, 0x3F, 0xAF, 
+0x35, 0xB0, 0x1A, 0xF2, 0x15, 0xFD, 0x6D, 0xD9, 
+0x31, 0x3B, 0x94, 0xAB, 0x6E, 0x53, 0xEC, 0x43, 
+0x93, 0xC1, 0xF1, 0x86, 0x3A, 0xA2, 0x71, 0x51, 
+0xB9, 0xA1, 0x6D, 0x8E, 0x69, 0xAE, 0x1C, 0xE0, 
+0x44, 0x1C, 0x11, 0x81, 0x5B, 0x9C, 0xE7, 0x7F, 
+0x6C, 0x38, 0x67, 0xC0, 0x53, 0xD3, 0x11, 0xFA, 
+0x8D, 0x2F, 0xB9, 0x91, 0x40, 0x67, 0x48, 0x96, 
+0xF5, 0x4D, 0x64, 0x43, 0x1F, 0x32, 0x38, 0x85, 
+0x4B, 0x20, 0x4D, 0x87, 0x9E, 0x2F, 0xB3, 0xE2, 
+0xC2, 0xE0, 0x40, 0xCB, 0xCD, 0x40, 0xDD, 0xDB, 
+0xC3, 0x67, 0x6A, 0xD0, 0x8D, 0x88, 0x72, 0xC7, 
+0x06, 0x81, 0xF3, 0x1A, 0x02, 0xEF, 0x12, 0xEF, 
+0xE8, 0x59, 0xDA, 0xBC, 0xD9, 0x6E, 0xC6, 0x1D, 
+0x10, 0xF0, 0x34, 0xA2, 0xF8, 0xB9, 0xA7, 0xDA, 
+0xB9, 0x03, 0xC8, 0x82, 0xED, 0x90, 0xE7, 0x64, 
+0x39, 0x8B, 0x12, 0xE2, 0x20, 0xF2, 0xD7, 0x58, 
+0xF1, 0x08, 0xBF, 0x46, 0xF1, 0x65, 0x91, 0x38, 
+0x00, 0x12, 0xE8, 0x97, 0xFB, 0xD4, 0xC2, 0x4E, 
+0x78, 0x0E, 0x95, 0x4A, 0x22, 0xAB, 0x70, 0x19, 
+0x77, 0x45, 0xB7, 0xFB, 0x63, 0xFB, 0x4B, 0x54, 
+0xB4, 0x33, 0x76, 0xEB, 0xCA, 0x87, 0x8C, 0x92, 
+0x36, 0xB0, 0xC0, 0x90, 0x89, 0x55, 0x60, 0x6C, 
+0x8D, 0xA4, 0x62, 0xC7, 0x18, 0xAF, 0x8B, 0x8F, 
+0xF5, 0xD4, 0x00, 0xFF, 0x21, 0x59, 0x56, 0xFA, 
+0x99, 0xB0, 0x55, 0x04, 0xCE, 0x86, 0xF9, 0xDA, 
+0x7B, 0x1C, 0xC2, 0x17, 0xC0, 0x58, 0x2F, 0xFC, 
+0xC8, 0x99, 0xA9, 0xD4, 0xF7, 0xB7, 0x72, 0xE5, 
+0x2C, 0x12, 0x0E, 0xEE, 0xE8, 0x95, 0x6A, 0xB3, 
+0x41, 0x9B, 0x7C, 0xF8, 0x65, 0xE9, 0x31, 0xD1, 
+0xB9, 0x2C, 0x79, 0xDD, 0x5E, 0x06, 0x91, 0xA0, 
+0x01, 0xE0, 0xA5, 0x6D, 0xFB, 0x93, 0x78, 0xD1, 
+0xE5, 0xF2, 0x3F, 0xF4, 0x95, 0x3A, 0x14, 0x6B, 
+0x0F, 0x1F, 0x1A, 0xBB, 0xBE, 0x6B, 0x09, 
+
+}
+return kat[i:j]
+}
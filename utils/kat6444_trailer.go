@@ -0,0 +1,2841 @@
+package utils
+func getkat6444_trailer(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x69, 0x16, 0x85, 0x65, 0x95, 0xEB, 0x66, 0x06, 
+0xE4, 0x6F, 0x1F, 0xE4, 0x81, 0x6A, 0x65, 0xC7, 
+0x7A, 0x19, 0xD6, 0xC7, 0x08, 0x79, 0x4E, 0x18, 
+0x24, 0x4C, 0x94, 0xE7, 0x78, 0x0F, 0xE5, 0x88, 
+
+0xDF, 0x62, 0x7E, 0xAC, 0x13, 0x83, 0xBA, 0x6C, 
+0x07, 0x42, 0x89, 0xFF, 0xAD, 0xFF, 0x99, 0x10, 
+0x9F, 0xD6, 0x7D, 0x27, 0x29, 0x23, 0x6F, 0x00, 
+0x5E, 0xBD, 0x6B, 0x8A, 0xB9, 0x23, 0xB8, 0x81, 
+0xB2, 
+
+0xC6, 0xC8, 0xE4, 0x3C, 0xE4, 0x4F, 0xBA, 0x2F, 
+0xE0, 0xF9, 0xB6, 0x1D, 0xBB, 0xA5, 0x79, 0x15, 
+0xED, 0x54, 0x12, 0xC3, 0xF3, 0x35, 0x2B, 0x23, 
+0xD8, 0xAE, 0x15, 0x92, 0xBB, 0x7B, 0xA5, 0x04, 
+0xC3, 0xF2, 
+
+0xEE, 0x2F, 0x70, 0x7C, 0x49, 0x69, 0x02, 0xBA, 
+0x12, 0x16, 0xCE, 0xA1, 0x41, 0xAD, 0xE5, 0x51, 
+0xE9, 0x1C, 0x51, 0x06, 0x4F, 0x89, 0xDB, 0x19, 
+0x6F, 0xB2, 0xD6, 0xC8, 0x99, 0x1C, 0xDA, 0xF3, 
+0x04, 0x0B, 0xB5, 
+
+0xA1, 0xE8, 0x1A, 0x0F, 0x4D, 0x12, 0xE3, 0x6A, 
+0xF8, 0x9F, 0xA3, 0x5C, 0xC5, 0xD0, 0xB8, 0x6F, 
+0x69, 0x6E, 0x58, 0x12, 0xEC, 0x92, 0x7B, 0xF7, 
+0x08, 0x13, 0xF1, 0x71, 0xD5, 0x8D, 0x1E, 0x05, 
+0x1E, 0xD2, 0xC5, 0xD0, 
+
+0x69, 0xE5, 0x00, 0xC9, 0x40, 0x33, 0x8B, 0xE3, 
+0x6F, 0x68, 0x22, 0x3D, 0x10, 0x54, 0x59, 0x5A, 
+0x97, 0x8E, 0x01, 0x88, 0x66, 0xD9, 0x32, 0xA1, 
+0xE4, 0x2D, 0x32, 0x23, 0x8F, 0xE6, 0x57, 0x55, 
+0x08, 0x3B, 0x78, 0x9B, 0x95, 
+
+0x24, 0x40, 0x47, 0x87, 0x1B, 0xF4, 0x56, 0x18, 
+0x7B, 0x97, 0xC7, 0x1A, 0x6F, 0x2A, 0xAB, 0xD6, 
+0x3F, 0xA5, 0x68, 0x85, 0x0A, 0xDC, 0x71, 0x5C, 
+0x6A, 0xBC, 0xDF, 0xBF, 0xC7, 0x48, 0xDA, 0x28, 
+0x1D, 0xF2, 0x60, 0x88, 0x18, 0x7A, 
+
+0x7C, 0x95, 0x6E, 0x6D, 0xF5, 0xA1, 0xB5, 0xDF, 
+0xF9, 0x2F, 0xD6, 0xC7, 0xAE, 0xF0, 0x8E, 0x54, 
+0x04, 0xB1, 0xEE, 0x21, 0xAF, 0xD3, 0x79, 0xEF, 
+0xF6, 0x55, 0xFF, 0xC6, 0x30, 0x4A, 0x83, 0x04, 
+0xA6, 0x8E, 0x0E, 0x50, 0xAF, 0x6E, 0xD7, 
+
+0x20, 0x19, 0xC8, 0x50, 0x81, 0x9A, 0xB4, 0xEA, 
+0xD0, 0x62, 0x02, 0x07, 0x43, 0x34, 0xAB, 0x4A, 
+0x95, 0xFF, 0x45, 0xE6, 0x93, 0xB9, 0x7D, 0xEB, 
+0x93, 0x9E, 0xB3, 0xA6, 0x4C, 0x24, 0x20, 0x95, 
+0x5E, 0x13, 0x4B, 0xD8, 0x76, 0x52, 0x37, 0x66, 
+
+0xC4, 0x36, 0x06, 0xD4, 0x28, 0xFB, 0x43, 0xC2, 
+0x7F, 0x42, 0x57, 0x01, 0xA9, 0x56, 0x09, 0x81, 
+0x3D, 0xA0, 0xFB, 0x86, 0x9B, 0x05, 0x93, 0x3A, 
+0xA5, 0xEB, 0x21, 0x9C, 0x04, 0xEF, 0x6B, 0xF4, 
+0x95, 0x95, 0x70, 0xBB, 0xB6, 0x16, 0xBF, 0xDE, 
+0x89, 
+
+0x58, 0x86, 0x09, 0xA0, 0x60, 0x43, 0x57, 0x96, 
+0x40, 0x14, 0xD7, 0x21, 0x95, 0x29, 0x8E, 0x7B, 
+0x18, 0xB8, 0x18, 0x38, 0xC0, 0xB1, 0xAF, 0x02, 
+0x1A, 0x53, 0x02, 0xE5, 0xD3, 0x98, 0x2A, 0x0A, 
+0x53, 0x14, 0x73, 0x97, 0xDA, 0xF7, 0x94, 0x8E, 
+0x25, 0xA1, 
+
+0x87, 0x37, 0x94, 0x52, 0x4E, 0xD1, 0xFD, 0x80, 
+0xA8, 0x49, 0x3B, 0x33, 0xE9, 0x2A, 0x69, 0xCE, 
+0xDE, 0xB2, 0x53, 0x62, 0x5D, 0xDA, 0x25, 0x1C, 
+0x98, 0x93, 0xA7, 0x05, 0x90, 0x7D, 0x5F, 0xB9, 
+0xCE, 0x54, 0xB0, 0x46, 0x01, 0x9D, 0xC3, 0x31, 
+0x72, 0x51, 0x0D, 
+
+0xAD, 0x97, 0x72, 0x57, 0x4E, 0x6D, 0xD9, 0xE9, 
+0xDD, 0x58, 0x0C, 0x88, 0x94, 0x20, 0xE8, 0xE8, 
+0xC6, 0xE1, 0x54, 0xBD, 0xB9, 0x9A, 0x4C, 0x80, 
+0xDC, 0x56, 0xFF, 0xFF, 0x76, 0x32, 0xA9, 0x3E, 
+0xB3, 0x4D, 0xCB, 0x5A, 0xD6, 0x47, 0xF8, 0x15, 
+0x5A, 0x78, 0x61, 0x44, 
+
+0x5E, 0xEF, 0x45, 0x0A, 0x23, 0xAD, 0x8B, 0x37, 
+0xA2, 0x71, 0x71, 0xB9, 0x36, 0x90, 0x54, 0x68, 
+0xBF, 0x82, 0xC9, 0x0B, 0xCB, 0x7F, 0xFD, 0x7F, 
+0xFF, 0xF1, 0xD3, 0xAF, 0x36, 0x7C, 0xDE, 0x5E, 
+0x0F, 0xD6, 0x0E, 0xE3, 0x5D, 0x06, 0x14, 0xEE, 
+0x7D, 0x5B, 0xCC, 0x6C, 0xE9, 
+
+0xB6, 0x45, 0x07, 0xC6, 0x54, 0x90, 0x4C, 0xF5, 
+0x30, 0x3B, 0xE1, 0x6E, 0x9D, 0xE4, 0x81, 0x4F, 
+0x55, 0x54, 0x62, 0x3B, 0xB2, 0xE7, 0x05, 0x60, 
+0x1B, 0x98, 0xB4, 0x9D, 0x82, 0xE9, 0x35, 0x69, 
+0x04, 0x1E, 0x4F, 0x72, 0xAB, 0x8A, 0x18, 0x5A, 
+0x9D, 0xA7, 0xC2, 0x3B, 0x3A, 0x56, 
+
+0x34, 0x5F, 0xE2, 0x4E, 0xF8, 0xB3, 0x8B, 0x91, 
+0x7B, 0x04, 0xED, 0x7C, 0xA4, 0x2E, 0x4B, 0x6E, 
+0x0E, 0x40, 0x02, 0xF2, 0x52, 0x84, 0x50, 0xAD, 
+0x2F, 0xCA, 0x52, 0xD2, 0x24, 0x37, 0x75, 0x4C, 
+0x8E, 0x02, 0xDA, 0x55, 0x01, 0xD5, 0x5D, 0xF2, 
+0xDC, 0x6F, 0x6A, 0x7B, 0x82, 0x80, 0x4E, 
+
+0xB6, 0x42, 0xA9, 0xC3, 0xD2, 0xFE, 0x8E, 0xA5, 
+0x66, 0x6B, 0x6C, 0x18, 0x61, 0x5D, 0x96, 0xD6, 
+0xBC, 0x2A, 0xB3, 0x94, 0x72, 0xD7, 0xD4, 0xFE, 
+0x36, 0xF2, 0xCA, 0xEB, 0x8D, 0xC8, 0xE7, 0xA1, 
+0xC7, 0x8E, 0x56, 0x36, 0x13, 0xF8, 0xDF, 0x66, 
+0x3A, 0x64, 0x0A, 0x36, 0x21, 0x71, 0x1F, 0x8D, 
+
+0x3C, 0xBF, 0x72, 0x60, 0xD4, 0x52, 0xA5, 0x89, 
+0x0F, 0xFA, 0xA7, 0xA8, 0x63, 0x11, 0x85, 0x0D, 
+0xB4, 0xF3, 0x25, 0x97, 0xAE, 0x4F, 0x51, 0x46, 
+0x0B, 0x35, 0xC0, 0x80, 0x77, 0xE8, 0xC1, 0x8C, 
+0xA2, 0xE1, 0x58, 0x7D, 0x2A, 0x29, 0x91, 0x14, 
+0xA9, 0xC3, 0xB5, 0x6E, 0xAD, 0xA5, 0x10, 0x2A, 
+0x82, 
+
+0x26, 0xED, 0xBD, 0xC5, 0xC8, 0x11, 0xA8, 0xF9, 
+0x42, 0x46, 0xF0, 0x9A, 0xCE, 0xE5, 0x62, 0xFD, 
+0x1F, 0xCE, 0x38, 0x39, 0x71, 0x10, 0x2B, 0x1F, 
+0xA4, 0x34, 0xAE, 0xF7, 0xB3, 0x88, 0x49, 0x3B, 
+0x16, 0xEE, 0xDD, 0x99, 0x77, 0xB5, 0x13, 0x20, 
+0x60, 0xFA, 0xFB, 0x3B, 0x19, 0x7F, 0x6D, 0x24, 
+0xA0, 0x56, 
+
+0x8D, 0x9F, 0xBE, 0xA3, 0x45, 0x4B, 0xAF, 0x4B, 
+0x5C, 0xCC, 0xEA, 0xD0, 0xAB, 0xB0, 0xA0, 0xDD, 
+0xB4, 0xA2, 0x23, 0xD9, 0x76, 0x31, 0x71, 0x3D, 
+0x43, 0x79, 0x6B, 0xF1, 0x4E, 0x87, 0xAD, 0x83, 
+0x82, 0x5D, 0x68, 0x44, 0xDD, 0x43, 0x3C, 0xF5, 
+0x63, 0xBB, 0xD2, 0x93, 0x19, 0xD0, 0x43, 0xA6, 
+0x80, 0x26, 0xFF, 
+
+0xF4, 0xD4, 0x27, 0x4A, 0x9A, 0xDD, 0x69, 0x10, 
+0x23, 0xF8, 0x3A, 0xC4, 0x86, 0x7F, 0x93, 0xCE, 
+0x9A, 0x13, 0x7E, 0x18, 0x66, 0xF7, 0x07, 0x3C, 
+0x3F, 0x85, 0x3F, 0xFA, 0x4A, 0x31, 0x8F, 0x19, 
+0xD0, 0x72, 0xAF, 0x7F, 0x1C, 0xAC, 0x5D, 0x02, 
+0x33, 0x20, 0xDD, 0x0E, 0xDB, 0x60, 0xF2, 0x5B, 
+0x94, 0x87, 0x0F, 0x1D, 
+
+0x82, 0x81, 0xB6, 0x6A, 0xF5, 0x45, 0x68, 0x1D, 
+0xE6, 0x5D, 0x10, 0xC4, 0xB1, 0xCB, 0xFC, 0x7B, 
+0xE7, 0xBB, 0xAC, 0x76, 0x38, 0x1E, 0x00, 0xB1, 
+0x63, 0x6A, 0x9A, 0x77, 0x71, 0x8F, 0x3E, 0x62, 
+0x9D, 0xBB, 0x18, 0x55, 0xCB, 0x18, 0xD1, 0x97, 
+0xE2, 0x58, 0x5F, 0x88, 0x07, 0x8D, 0xF4, 0xD1, 
+0x86, 0x9E, 0x7E, 0x61, 0x3C, 
+
+0x4A, 0xC9, 0x25, 0xC4, 0x5C, 0x38, 0x4E, 0xE1, 
+0x2C, 0xE7, 0xD0, 0x28, 0xA4, 0x19, 0x58, 0xE4, 
+0xB5, 0xB3, 0xCD, 0xF5, 0xB4, 0x3E, 0xDE, 0xCB, 
+0xC8, 0xFE, 0x2E, 0x88, 0x93, 0xD5, 0xA1, 0x13, 
+0x56, 0xC7, 0x37, 0x57, 0x4A, 0xDC, 0xCF, 0x53, 
+0x29, 0x3E, 0x5F, 0x74, 0xDD, 0x76, 0x80, 0x0D, 
+0x87, 0xA3, 0xB5, 0x9E, 0x76, 0x93, 
+
+0x9D, 0xD7, 0x5C, 0x4B, 0x06, 0xE4, 0x53, 0x20, 
+0x47, 0x6F, 0xD8, 0x3E, 0xBB, 0xB9, 0x99, 0xCA, 
+0x02, 0x24, 0x92, 0x4C, 0x5D, 0x81, 0xBE, 0x39, 
+0x93, 0x23, 0x41, 0x9C, 0x52, 0x31, 0xEA, 0x2E, 
+0x16, 0xE0, 0xEE, 0x2E, 0x29, 0x16, 0xCA, 0x22, 
+0x9C, 0xD5, 0x0A, 0xA9, 0x4D, 0x6B, 0x78, 0x59, 
+0x53, 0xF1, 0xB6, 0x16, 0x0B, 0xBB, 0x82, 
+
+0xB7, 0xA0, 0xCA, 0xC8, 0x9A, 0x63, 0x88, 0x1E, 
+0x12, 0x36, 0xDD, 0x88, 0xEB, 0xA1, 0xA5, 0xAC, 
+0x94, 0xD7, 0xAA, 0x3E, 0x36, 0x4C, 0x49, 0x72, 
+0x02, 0x91, 0x33, 0x39, 0x7A, 0xC2, 0x34, 0xF2, 
+0x4F, 0x27, 0xD1, 0x4C, 0x7A, 0x06, 0xCF, 0x18, 
+0xCA, 0x53, 0x70, 0x28, 0xFB, 0xD9, 0x86, 0xD6, 
+0xBE, 0xC3, 0xDC, 0x54, 0x21, 0x95, 0xFB, 0x43, 
+
+0x4E, 0xA7, 0xBE, 0x9F, 0xBD, 0x88, 0x21, 0x31, 
+0xAA, 0x01, 0xA7, 0x28, 0x97, 0x7F, 0x7F, 0x8E, 
+0xAC, 0xE9, 0xCB, 0x66, 0xA1, 0xC8, 0xE4, 0x6B, 
+0xF1, 0xF4, 0x18, 0xB8, 0x8A, 0x82, 0x09, 0x79, 
+0x97, 0x1C, 0x01, 0x88, 0xD8, 0x27, 0xEB, 0x5A, 
+0xBC, 0x18, 0xE2, 0x7A, 0x53, 0x8A, 0xB0, 0x15, 
+0x69, 0xEC, 0x15, 0xCA, 0xF5, 0x9C, 0x9D, 0xA3, 
+0x35, 
+
+0xBE, 0xDB, 0x00, 0x96, 0xD2, 0x92, 0x5D, 0x8C, 
+0x31, 0xC5, 0x7E, 0x27, 0xED, 0x86, 0x9C, 0xBA, 
+0x23, 0x1E, 0x11, 0xB5, 0x9E, 0x82, 0x26, 0x45, 
+0x9E, 0x13, 0x5D, 0x12, 0x17, 0xFD, 0xCF, 0x11, 
+0xE0, 0x90, 0x32, 0xFA, 0x6C, 0x6D, 0x86, 0x12, 
+0xF0, 0xB9, 0x23, 0x7D, 0xF2, 0xB2, 0x51, 0x49, 
+0xA7, 0x22, 0x14, 0x70, 0x40, 0xB0, 0x62, 0x81, 
+0xC5, 0x72, 
+
+0x6C, 0x37, 0xDD, 0x74, 0xC3, 0xC3, 0xA7, 0xD9, 
+0xBC, 0x86, 0x22, 0x88, 0xBC, 0x73, 0x54, 0xB9, 
+0xF4, 0x76, 0x5E, 0xBD, 0xF7, 0x01, 0xA9, 0x0B, 
+0x3C, 0x08, 0xBA, 0x07, 0xF0, 0xE7, 0x59, 0x68, 
+0xD2, 0xC8, 0x8C, 0x54, 0x25, 0xF8, 0xCC, 0x90, 
+0xA9, 0x28, 0xD4, 0xAB, 0xB3, 0x13, 0x6A, 0x97, 
+0x1C, 0xFD, 0x95, 0x59, 0x5C, 0xDC, 0x80, 0x04, 
+0x70, 0xE8, 0xE1, 
+
+0x21, 0xA6, 0x5A, 0x26, 0x5B, 0x5D, 0x19, 0x80, 
+0xF1, 0x43, 0x25, 0x8F, 0x18, 0xDD, 0x7E, 0x22, 
+0x3A, 0x47, 0x81, 0x21, 0x80, 0xFD, 0x67, 0xD0, 
+0x22, 0x45, 0x24, 0xD9, 0xDD, 0x43, 0x0A, 0xB5, 
+0x5F, 0x9F, 0xCE, 0x2F, 0xB8, 0x5B, 0xA5, 0xE2, 
+0xEC, 0x0E, 0x4E, 0x7D, 0xF9, 0xDF, 0x34, 0x59, 
+0x05, 0xDC, 0xFD, 0x8A, 0xD1, 0x95, 0x79, 0x0B, 
+0x29, 0x33, 0x18, 0x90, 
+
+0xA3, 0xB6, 0x5F, 0x15, 0x73, 0x4D, 0xA6, 0x7F, 
+0xBB, 0xCB, 0x05, 0xA8, 0xB0, 0x63, 0x07, 0xE3, 
+0xF5, 0xCE, 0xE0, 0x7F, 0xA5, 0xA0, 0x29, 0xC7, 
+0x73, 0x0E, 0xAC, 0xE1, 0x2B, 0x2E, 0xD0, 0x65, 
+0x1B, 0x39, 0x50, 0xD5, 0x10, 0xC9, 0x20, 0xBF, 
+0x3C, 0x80, 0x4B, 0x64, 0x66, 0x07, 0x38, 0xF4, 
+0xDE, 0xF2, 0x9B, 0x3B, 0xE5, 0x5C, 0xED, 0x16, 
+0x61, 0x81, 0x75, 0xC2, 0x15, 
+
+0xC0, 0x56, 0x8A, 0xAE, 0x6F, 0x36, 0x55, 0xF2, 
+0xE8, 0x1C, 0x5A, 0x98, 0x84, 0x5D, 0x3B, 0x5F, 
+0xC3, 0xB0, 0x44, 0xBD, 0x31, 0x7A, 0x0D, 0xC3, 
+0x59, 0x76, 0x16, 0x39, 0xDE, 0xFC, 0x76, 0xBB, 
+0x8D, 0x59, 0x41, 0xFE, 0x6E, 0xA9, 0xCD, 0xB1, 
+0x7A, 0xF1, 0xE4, 0x14, 0x8A, 0x62, 0x84, 0xC4, 
+0x1A, 0xB8, 0xF5, 0x55, 0x02, 0xF2, 0x81, 0x23, 
+0x68, 0x82, 0x31, 0xA6, 0x51, 0xCE, 
+
+0x2C, 0xD4, 0x06, 0xA2, 0x3D, 0x88, 0x38, 0x34, 
+0xE3, 0x0D, 0x5B, 0x7A, 0x29, 0x55, 0x16, 0x97, 
+0x8B, 0xC7, 0x10, 0x11, 0x17, 0xB5, 0x34, 0xD9, 
+0x85, 0xA9, 0x9E, 0xFA, 0xA6, 0x70, 0x44, 0xBF, 
+0xFC, 0x57, 0x33, 0x3F, 0xDA, 0x4F, 0xF4, 0xDE, 
+0xF6, 0x3B, 0x05, 0xFC, 0x83, 0x61, 0x53, 0xEE, 
+0x85, 0xD8, 0x1C, 0xB0, 0x8C, 0x84, 0x02, 0x77, 
+0x0D, 0x1F, 0x13, 0x00, 0x03, 0x73, 0xDF, 
+
+0x72, 0x89, 0x25, 0xBE, 0x4B, 0xF8, 0x70, 0xDA, 
+0x9D, 0x89, 0xC9, 0x72, 0xBE, 0xC1, 0x32, 0x73, 
+0xF5, 0x07, 0xDE, 0x4C, 0xB5, 0x34, 0xE8, 0x0D, 
+0x18, 0x00, 0x20, 0x6F, 0xDF, 0x70, 0xF3, 0xF8, 
+0xB4, 0x40, 0x99, 0x66, 0x49, 0xA8, 0xC3, 0x27, 
+0x05, 0xF1, 0xF2, 0x3F, 0xED, 0xCD, 0xD9, 0x05, 
+0x76, 0x25, 0x95, 0xC0, 0xBE, 0x18, 0x2C, 0x22, 
+0x23, 0xB7, 0x43, 0x11, 0xD4, 0xED, 0x08, 0xE1, 
+
+0x8A, 0x32, 0x00, 0xA0, 0xB1, 0x28, 0x26, 0x87, 
+0x40, 0x77, 0x30, 0x92, 0xF1, 0x85, 0xA1, 0xFB, 
+0x02, 0x05, 0x29, 0x87, 0x65, 0x43, 0xA4, 0x58, 
+0xB6, 0x9D, 0x27, 0x64, 0x28, 0x38, 0x67, 0xEA, 
+0xA7, 0x94, 0x3E, 0x0A, 0x6E, 0xB3, 0x09, 0x69, 
+0xBE, 0x74, 0x92, 0x6E, 0x6F, 0x61, 0xC5, 0x8C, 
+0x96, 0x48, 0xB6, 0x53, 0x34, 0x3A, 0x59, 0x76, 
+0x09, 0x9E, 0x06, 0x11, 0x8C, 0xAA, 0x5D, 0xD1, 
+0xB6, 
+
+0x29, 0x12, 0x43, 0x5E, 0xC3, 0x33, 0xB4, 0x46, 
+0x0D, 0x1C, 0x20, 0x8D, 0xD3, 0x78, 0xB5, 0xE4, 
+0x23, 0xF9, 0xA2, 0x40, 0x74, 0xA9, 0xEB, 0x2A, 
+0xB3, 0x56, 0x5A, 0xC7, 0x4F, 0xD4, 0xAB, 0xC2, 
+0x00, 0xE9, 0x6A, 0x84, 0x24, 0x83, 0x54, 0xDD, 
+0xEB, 0xE7, 0x27, 0x0B, 0x4F, 0xAF, 0x47, 0xB6, 
+0x7C, 0xCB, 0x9D, 0x70, 0xD5, 0xEA, 0x72, 0x0D, 
+0x41, 0x62, 0x31, 0x3A, 0x97, 0xE1, 0x03, 0x3F, 
+0xAE, 0x53, 
+
+0xCF, 0xDD, 0x56, 0xE6, 0x57, 0x2A, 0x3D, 0xBC, 
+0xCD, 0x9A, 0x87, 0x11, 0x4E, 0x38, 0xBC, 0xA3, 
+0x1F, 0x3E, 0x01, 0x5D, 0x33, 0x53, 0x91, 0x97, 
+0x94, 0xFC, 0xC9, 0x5B, 0xEB, 0xD7, 0x21, 0xDE, 
+0x0B, 0x0C, 0x0A, 0x72, 0x11, 0xCA, 0x0E, 0x32, 
+0x2D, 0xAD, 0x2F, 0xF5, 0x88, 0x03, 0xBF, 0x70, 
+0xB9, 0xBB, 0x71, 0x1F, 0xF5, 0xB2, 0x0E, 0x62, 
+0x07, 0xAF, 0xEA, 0x5A, 0x69, 0x43, 0x72, 0x0B, 
+0xE6, 0xEE, 0x1E, 
+
+0xC4, 0x90, 0xBC, 0x9E, 0xE4, 0x8B, 0x20, 0x4C, 
+0xBD, 0x8B, 0xDC, 0x28, 0xBE, 0x57, 0x61, 0xE4, 
+0x73, 0x9D, 0xFA, 0x08, 0x54, 0x4E, 0x59, 0x67, 
+0xD8, 0xC4, 0x13, 0x2E, 0x8E, 0x51, 0x59, 0x17, 
+0x42, 0x7D, 0xD5, 0x26, 0x42, 0x5D, 0xC3, 0x85, 
+0x7D, 0xC8, 0x91, 0x2E, 0x02, 0x27, 0x75, 0xE8, 
+0x92, 0xD9, 0xA8, 0xBC, 0xC1, 0xC9, 0xA8, 0x39, 
+0x6D, 0x9E, 0xC9, 0x2E, 0xD2, 0xDE, 0xFA, 0xA2, 
+0x30, 0xCF, 0x50, 0x57, 
+
+0x4E, 0xAE, 0x71, 0x12, 0xC9, 0xEF, 0xE5, 0xC9, 
+0xC7, 0x42, 0x10, 0xF9, 0x37, 0xA1, 0x83, 0x3B, 
+0x67, 0x99, 0xF7, 0x1A, 0x25, 0x4A, 0xE2, 0x3D, 
+0x79, 0x5A, 0x16, 0x8C, 0xDB, 0xC6, 0x70, 0xCB, 
+0xA9, 0x0A, 0x5D, 0x77, 0x3F, 0x34, 0x6B, 0x25, 
+0x8B, 0x90, 0xE7, 0xF0, 0xCB, 0xB7, 0x20, 0x0F, 
+0xE6, 0xDA, 0xB0, 0x16, 0x84, 0x07, 0x89, 0x64, 
+0x82, 0x99, 0xEC, 0xAF, 0xF0, 0x46, 0x46, 0x16, 
+0x65, 0x89, 0x2C, 0xE6, 0x72, 
+
+0x16, 0xFC, 0x59, 0x14, 0x90, 0x20, 0xCE, 0x90, 
+0x66, 0x93, 0x3C, 0x52, 0xD9, 0x4F, 0x6E, 0x8D, 
+0x34, 0x6F, 0xCD, 0x51, 0xD1, 0xE3, 0xCE, 0x94, 
+0x2F, 0xE8, 0x36, 0x6E, 0x7E, 0xFB, 0x32, 0xFE, 
+0x6E, 0x1B, 0x78, 0xD3, 0xC9, 0x2B, 0x0D, 0x8E, 
+0xFA, 0x9C, 0x1D, 0x47, 0xF6, 0x1E, 0x6C, 0x92, 
+0x55, 0xFF, 0x3A, 0x64, 0xFF, 0x73, 0x99, 0x9F, 
+0x82, 0x05, 0x0A, 0xEA, 0xFA, 0xFA, 0x1F, 0x55, 
+0x2D, 0xCE, 0xBD, 0x16, 0xA6, 0xA8, 
+
+0x56, 0x5E, 0xDE, 0x6F, 0x53, 0xB7, 0xBE, 0xFE, 
+0x95, 0x3C, 0xFE, 0xA8, 0x18, 0xC2, 0xE0, 0x0C, 
+0xD7, 0x2D, 0xD0, 0xEC, 0x74, 0x62, 0x6B, 0x95, 
+0x42, 0xA8, 0xF9, 0xD9, 0xCB, 0xED, 0xEC, 0xB0, 
+0x7F, 0x64, 0x66, 0x08, 0xBB, 0xBA, 0x16, 0x31, 
+0xA2, 0x9B, 0xB3, 0x47, 0x71, 0x49, 0xB8, 0x06, 
+0x54, 0x63, 0x61, 0x41, 0xD7, 0xCA, 0x89, 0x28, 
+0x59, 0xD6, 0x29, 0xC7, 0x8D, 0x92, 0xE4, 0x73, 
+0xB6, 0xBD, 0xFB, 0x4A, 0x2F, 0x4B, 0xF2, 
+
+0x53, 0x3C, 0xF0, 0x89, 0xC6, 0xEE, 0xAF, 0xA8, 
+0x4B, 0xB5, 0x59, 0x09, 0x71, 0x38, 0xEB, 0x5C, 
+0x4C, 0x99, 0xEE, 0x64, 0x9E, 0x84, 0xFA, 0x10, 
+0x2B, 0x02, 0x6F, 0x7B, 0x84, 0x86, 0xD9, 0x99, 
+0x30, 0x9E, 0x09, 0x46, 0xE7, 0x71, 0xAF, 0x43, 
+0x87, 0xF1, 0x01, 0x27, 0x72, 0x00, 0x95, 0xB4, 
+0x86, 0x7E, 0xA4, 0x03, 0x80, 0x84, 0xD3, 0x2F, 
+0xC0, 0x24, 0x31, 0x58, 0x74, 0xD4, 0xAA, 0x06, 
+0xBA, 0x68, 0xF7, 0x1F, 0x5B, 0xC0, 0x11, 0xF6, 
+
+0xF3, 0x5D, 0x4A, 0x30, 0xF4, 0x59, 0x5E, 0x30, 
+0x9D, 0x93, 0x93, 0x27, 0x01, 0xBB, 0x72, 0x02, 
+0x4B, 0x02, 0x3E, 0x58, 0xED, 0x86, 0xA5, 0x09, 
+0x51, 0xD9, 0xE0, 0x7F, 0x82, 0x37, 0x10, 0x4C, 
+0xD9, 0x66, 0xF2, 0x01, 0xDA, 0x44, 0x65, 0x6A, 
+0xBA, 0xBA, 0xBD, 0xD6, 0x28, 0xA2, 0x37, 0x7A, 
+0x0D, 0xE8, 0x6C, 0x93, 0x92, 0xF7, 0xDC, 0xF5, 
+0xF5, 0xE0, 0xCF, 0xE5, 0x76, 0x41, 0xA6, 0x1A, 
+0x21, 0xEF, 0x17, 0xEE, 0xD1, 0x4A, 0xDF, 0x7A, 
+0xD5, 
+
+0x11, 0x1D, 0xF5, 0x5A, 0x6D, 0x89, 0x04, 0x16, 
+0x05, 0x2B, 0x34, 0x1C, 0x6E, 0x6B, 0x6A, 0xB3, 
+0x92, 0x7D, 0x96, 0x61, 0xCD, 0x74, 0x3A, 0x21, 
+0x40, 0x67, 0xFE, 0x1F, 0xF8, 0x33, 0xA6, 0xB4, 
+0xD3, 0x1F, 0x48, 0x85, 0xB5, 0xB9, 0x15, 0x83, 
+0xB4, 0x19, 0x0B, 0x65, 0xBD, 0xBC, 0x01, 0x86, 
+0x69, 0x1E, 0x3C, 0xEA, 0x83, 0x3D, 0xF3, 0xD8, 
+0x55, 0x45, 0xF2, 0x40, 0xAC, 0xC3, 0x79, 0x22, 
+0x03, 0xCA, 0x70, 0x73, 0x9D, 0xC6, 0xD9, 0x36, 
+0x3D, 0x56, 
+
+0xD4, 0xA5, 0xF1, 0x78, 0x4D, 0x43, 0xA3, 0xE0, 
+0x8F, 0x10, 0xA3, 0x45, 0x4F, 0xD9, 0x60, 0x15, 
+0x54, 0xDB, 0x7F, 0x1C, 0xF2, 0xBA, 0x6B, 0xF5, 
+0xE2, 0x4A, 0x91, 0x58, 0x0F, 0x99, 0x06, 0xA8, 
+0x4E, 0xC9, 0x5B, 0xD4, 0xD5, 0x94, 0x13, 0x17, 
+0x6C, 0xA8, 0x63, 0x58, 0xBF, 0x0C, 0x8F, 0x96, 
+0xD5, 0x48, 0x32, 0x80, 0x97, 0xA8, 0x50, 0xB6, 
+0xF5, 0x08, 0x35, 0x3A, 0xFB, 0x80, 0x92, 0xB1, 
+0xFF, 0x2C, 0x18, 0x39, 0xB6, 0xC1, 0x11, 0x4C, 
+0xB2, 0x2E, 0x35, 
+
+0x23, 0xBF, 0xF9, 0x4E, 0x57, 0x64, 0x4F, 0x7D, 
+0x61, 0x57, 0xBD, 0x07, 0x4C, 0x3C, 0xB2, 0x73, 
+0xF8, 0x9A, 0x8D, 0x52, 0x9B, 0x7E, 0x86, 0x43, 
+0x75, 0xF5, 0x8A, 0xD8, 0x5A, 0x34, 0x69, 0x56, 
+0xD1, 0x72, 0xF4, 0x9F, 0xA0, 0xFF, 0x97, 0x93, 
+0x11, 0x25, 0x8F, 0x68, 0x9A, 0xBC, 0xCC, 0x6B, 
+0x9B, 0x64, 0xD3, 0xD3, 0x80, 0x12, 0xDF, 0x90, 
+0xFE, 0xAD, 0xC9, 0x43, 0x3C, 0x7A, 0xB2, 0xE3, 
+0x74, 0x71, 0xA9, 0xCC, 0x88, 0x31, 0x29, 0x0D, 
+0x38, 0xD0, 0x2A, 0xBB, 
+
+0x3C, 0x29, 0xD1, 0xCC, 0x4D, 0x6F, 0xA1, 0x30, 
+0x6B, 0xAD, 0xFF, 0x0A, 0xED, 0x6E, 0x4E, 0x61, 
+0x21, 0xA3, 0xC7, 0x6A, 0x4D, 0xBE, 0x39, 0x19, 
+0x15, 0x4D, 0x4A, 0xFC, 0x43, 0x5C, 0x8E, 0x42, 
+0x3D, 0x46, 0x99, 0xC8, 0xA9, 0x97, 0xBA, 0x58, 
+0x84, 0xF0, 0x39, 0x3F, 0xEF, 0x26, 0xF9, 0x24, 
+0x7A, 0x66, 0x63, 0xD9, 0x4A, 0x66, 0x39, 0x29, 
+0x3A, 0x04, 0x93, 0xEA, 0xE1, 0x42, 0x64, 0x00, 
+0xBF, 0xCE, 0x04, 0xD8, 0xAA, 0x44, 0xBA, 0x91, 
+0x34, 0xD9, 0x32, 0x8D, 0x63, 
+
+0xB7, 0x37, 0x11, 0xE5, 0xDB, 0xED, 0xC2, 0xB2, 
+0xF8, 0xF2, 0x85, 0x36, 0xB1, 0x2C, 0xB5, 0xBE, 
+0x1F, 0x98, 0x77, 0x0D, 0x94, 0xF8, 0x9A, 0xBA, 
+0xD1, 0x7C, 0x17, 0x53, 0xF7, 0x7B, 0x18, 0x5D, 
+0x7F, 0x4F, 0xB1, 0xE9, 0x0D, 0x76, 0xAA, 0x85, 
+0xE7, 0xC6, 0xB2, 0x38, 0xCF, 0xF5, 0x7F, 0xEB, 
+0x9C, 0xA1, 0x78, 0xC4, 0xBF, 0xAC, 0x11, 0x04, 
+0xB9, 0x45, 0xB8, 0xF4, 0xB4, 0x9F, 0x6D, 0xA9, 
+0x4A, 0xC8, 0x8F, 0x94, 0xC4, 0xF8, 0x4E, 0x1C, 
+0x48, 0x0D, 0x17, 0xB0, 0x6B, 0xE7, 
+
+0xE0, 0x06, 0x14, 0xAC, 0x55, 0x18, 0xF6, 0x8D, 
+0x98, 0x84, 0x82, 0x66, 0xB8, 0x34, 0x97, 0xB7, 
+0xB2, 0x93, 0x54, 0x05, 0xA5, 0xB0, 0x5E, 0xB9, 
+0xE2, 0xB4, 0xCC, 0x32, 0x4A, 0x56, 0x56, 0x33, 
+0xDA, 0x08, 0x8B, 0x7A, 0xC9, 0x2E, 0x23, 0x40, 
+0xB8, 0xC1, 0x04, 0x93, 0xDF, 0x23, 0xBE, 0x97, 
+0xB4, 0xA2, 0x6C, 0x1B, 0xB7, 0x0C, 0xA7, 0x03, 
+0x4B, 0xF7, 0x5A, 0xDA, 0x02, 0xE5, 0xD9, 0x55, 
+0xFD, 0xD6, 0xF1, 0x31, 0x32, 0xD6, 0x8D, 0x74, 
+0x10, 0xE9, 0xF1, 0xA2, 0xA8, 0x1B, 0x0E, 
+
+0x63, 0xBB, 0x48, 0x82, 0xA3, 0xD7, 0x27, 0xAD, 
+0x2A, 0x6E, 0x75, 0x70, 0xAF, 0x12, 0xB8, 0xF0, 
+0x5D, 0x68, 0x4A, 0x4B, 0xDD, 0x1B, 0x30, 0xDC, 
+0xCA, 0xF5, 0xA1, 0xB1, 0xD5, 0x52, 0xDC, 0xB0, 
+0xBA, 0xB5, 0x70, 0x86, 0x8C, 0xBC, 0x7B, 0x1F, 
+0xBC, 0xB1, 0x68, 0x6F, 0x19, 0x65, 0xC5, 0x0F, 
+0xCF, 0xA9, 0x1D, 0x14, 0x22, 0xF5, 0x4E, 0xEC, 
+0x48, 0x39, 0x91, 0x00, 0xAF, 0x14, 0x05, 0x64, 
+0x0C, 0x8E, 0x62, 0xFF, 0x4E, 0xF9, 0xDD, 0x4A, 
+0x58, 0x9E, 0x5A, 0xFE, 0xC0, 0xA6, 0xB5, 0xA6, 
+
+0x58, 0x29, 0x3F, 0x32, 0x07, 0x9A, 0x39, 0xB4, 
+0x8E, 0x2B, 0x92, 0x96, 0xCF, 0x62, 0xAD, 0x54, 
+0xA8, 0xFF, 0x36, 0x19, 0x34, 0xA2, 0xA3, 0x2A, 
+0x34, 0xD9, 0xD4, 0xDE, 0x90, 0xC5, 0x8C, 0x9F, 
+0x2D, 0xC3, 0xFB, 0x79, 0xCB, 0xCA, 0x28, 0xCA, 
+0x9A, 0xA6, 0x12, 0xF6, 0xF6, 0x75, 0x85, 0x55, 
+0x49, 0xBC, 0x9B, 0xC1, 0xAB, 0x85, 0x8D, 0x28, 
+0x0D, 0xFB, 0xC7, 0x21, 0x92, 0xF3, 0x00, 0x04, 
+0x4E, 0xD9, 0x58, 0x9D, 0x89, 0xE1, 0xDD, 0x32, 
+0x4C, 0xA9, 0xF5, 0xA2, 0xB0, 0x8F, 0x03, 0xC1, 
+0x8F, 
+
+0x99, 0xDE, 0x02, 0x3C, 0xB8, 0x71, 0xB4, 0xB8, 
+0x64, 0x98, 0xA8, 0x5F, 0x92, 0x6C, 0x90, 0xDF, 
+0x7B, 0x69, 0x30, 0x1E, 0x12, 0x00, 0x6B, 0xE0, 
+0x22, 0xCC, 0x16, 0xDE, 0xB1, 0xCC, 0x3C, 0xAF, 
+0x38, 0xF5, 0x31, 0xF9, 0x16, 0xAD, 0xBA, 0x7C, 
+0x99, 0xB7, 0x73, 0x58, 0xC0, 0x97, 0xD8, 0xF8, 
+0x12, 0x40, 0x80, 0xD6, 0x58, 0x24, 0x16, 0x04, 
+0x83, 0xDD, 0x89, 0x1E, 0x0D, 0x2A, 0x04, 0x74, 
+0xD9, 0x4A, 0x14, 0x6A, 0x36, 0x6D, 0x4E, 0x23, 
+0xEE, 0x40, 0x22, 0xDC, 0xBC, 0x79, 0xCA, 0xFF, 
+0xD3, 0x99, 
+
+0x69, 0x27, 0xB4, 0x57, 0x00, 0xCA, 0xFD, 0x2C, 
+0x42, 0xC9, 0x58, 0xC1, 0xE3, 0xF8, 0x65, 0xEE, 
+0x97, 0x46, 0x68, 0x47, 0x79, 0x34, 0x04, 0xCA, 
+0x03, 0xA5, 0xB3, 0x89, 0xC5, 0x67, 0xE6, 0x25, 
+0x37, 0x80, 0xEB, 0x4E, 0x83, 0x32, 0xCA, 0xCF, 
+0x84, 0x66, 0x2A, 0x96, 0xF0, 0xF5, 0x42, 0x03, 
+0x0D, 0x67, 0xDE, 0x97, 0x36, 0x95, 0x4C, 0x58, 
+0xDD, 0xF9, 0x0E, 0x38, 0xD0, 0x05, 0x7E, 0x95, 
+0xE3, 0x62, 0x1B, 0x07, 0xAC, 0x29, 0x07, 0x33, 
+0x55, 0x13, 0x6F, 0xF6, 0xF1, 0xDF, 0x50, 0x82, 
+0x24, 0x7B, 0x0F, 
+
+0x00, 0x21, 0x50, 0x50, 0xA3, 0x62, 0x6B, 0x63, 
+0xB0, 0x53, 0x49, 0x44, 0xCE, 0xC7, 0x25, 0x76, 
+0x8C, 0x92, 0xE4, 0x43, 0x6C, 0xCD, 0x58, 0x2E, 
+0xA9, 0xD7, 0x7A, 0x67, 0x8A, 0x08, 0x73, 0xB6, 
+0x15, 0x33, 0x26, 0x8F, 0x06, 0xA3, 0x43, 0x9D, 
+0xF9, 0x32, 0x0D, 0x14, 0x3C, 0x4E, 0xE0, 0x79, 
+0x22, 0x81, 0x38, 0xB3, 0x40, 0x96, 0x1C, 0x26, 
+0x28, 0x8E, 0xF6, 0x50, 0x2F, 0xC6, 0x3D, 0x15, 
+0x78, 0x63, 0x41, 0xF5, 0xCE, 0x92, 0x3B, 0x87, 
+0x66, 0x55, 0xC4, 0x24, 0xB3, 0xE3, 0x5A, 0x30, 
+0x46, 0xBF, 0x28, 0x73, 
+
+0x2D, 0xD0, 0xB5, 0xA4, 0x69, 0xF3, 0xCC, 0x19, 
+0x0F, 0x0B, 0x59, 0xCF, 0x8D, 0x31, 0x90, 0x6B, 
+0x10, 0x6B, 0x78, 0xF9, 0xAF, 0x30, 0xE3, 0x2D, 
+0x2C, 0x9A, 0x1C, 0xCD, 0x7E, 0xC0, 0xF0, 0x16, 
+0xCD, 0xA4, 0x7B, 0x83, 0x51, 0x6E, 0xD4, 0xEA, 
+0xBB, 0x65, 0x53, 0x36, 0xA6, 0xFF, 0x01, 0x44, 
+0xB5, 0xBF, 0x3E, 0xEA, 0x03, 0xB5, 0xEC, 0xF0, 
+0x25, 0xC1, 0x45, 0xD7, 0xD4, 0x65, 0x12, 0xCD, 
+0x0D, 0xA9, 0xB4, 0x21, 0xFB, 0xFB, 0x8B, 0x1A, 
+0x83, 0xE8, 0xB8, 0xCB, 0xED, 0xE9, 0x2D, 0x6A, 
+0xC9, 0xE9, 0xF8, 0x1E, 0x5B, 
+
+0x46, 0x20, 0x80, 0xB8, 0x16, 0x2E, 0x1A, 0xCE, 
+0x7F, 0x4B, 0xC3, 0x68, 0x22, 0x97, 0x4E, 0x03, 
+0x91, 0x1B, 0xD8, 0x12, 0x10, 0x17, 0xB5, 0x7C, 
+0x0C, 0x03, 0xF5, 0x81, 0xA8, 0x73, 0x59, 0x84, 
+0x83, 0xB2, 0x20, 0x8E, 0xBB, 0x7F, 0x00, 0x9A, 
+0x53, 0x39, 0x88, 0xB7, 0x8D, 0xA9, 0x31, 0xD9, 
+0x8F, 0xBB, 0x23, 0x7F, 0xF0, 0xFB, 0x54, 0x40, 
+0x3D, 0x97, 0x81, 0x43, 0xE0, 0x5B, 0x65, 0x6C, 
+0x81, 0x62, 0xFD, 0xF5, 0x8C, 0x40, 0x63, 0x88, 
+0x86, 0x89, 0x0B, 0x76, 0x3C, 0xED, 0xA3, 0x65, 
+0xA2, 0x3D, 0x92, 0x5E, 0x7B, 0xAB, 
+
+0x2C, 0xE5, 0xC3, 0x89, 0x21, 0x16, 0xB6, 0x76, 
+0x9C, 0x2B, 0xBA, 0x08, 0xB7, 0x28, 0x79, 0xE2, 
+0x81, 0x4B, 0x24, 0x36, 0xFA, 0x7E, 0x1D, 0x6B, 
+0x1D, 0x09, 0x17, 0x9F, 0xE7, 0x0D, 0x4D, 0x75, 
+0x24, 0x65, 0xB0, 0x1F, 0x29, 0x60, 0x99, 0x08, 
+0x47, 0xE4, 0x74, 0x1D, 0x1F, 0x75, 0xD0, 0xB9, 
+0x48, 0xFD, 0xE2, 0xA1, 0x01, 0xC2, 0xB2, 0x8D, 
+0xE5, 0x79, 0x59, 0x2B, 0x1E, 0x4F, 0x16, 0xED, 
+0x5C, 0xD9, 0x87, 0xEA, 0xE9, 0xF9, 0x81, 0xA3, 
+0x0B, 0x8C, 0x02, 0xE7, 0xAE, 0xEF, 0xCE, 0x59, 
+0x79, 0x00, 0x3D, 0x95, 0x50, 0x46, 0xC8, 
+
+0xA2, 0xBB, 0x85, 0x4C, 0xFA, 0x2A, 0x0F, 0x72, 
+0x8B, 0x0F, 0x60, 0x71, 0xF7, 0x3A, 0x63, 0x6F, 
+0x9C, 0xF8, 0x67, 0x23, 0xF2, 0x60, 0x75, 0x33, 
+0xD4, 0xC7, 0xC8, 0x7E, 0x33, 0xB0, 0xA6, 0xC7, 
+0x32, 0xB4, 0x85, 0x3E, 0x42, 0x53, 0x8B, 0x73, 
+0xAD, 0x95, 0x44, 0x5A, 0x0E, 0x01, 0xED, 0xCF, 
+0xCE, 0x1A, 0x80, 0xA7, 0x65, 0xBA, 0x13, 0x98, 
+0xDE, 0xD3, 0x57, 0x05, 0x98, 0x95, 0x02, 0xB9, 
+0xAA, 0x1E, 0xB1, 0xCB, 0xB0, 0x4C, 0x20, 0xCA, 
+0xA9, 0xE1, 0x1F, 0x95, 0x60, 0x21, 0x66, 0x33, 
+0x9D, 0xC5, 0x8F, 0x0A, 0xA8, 0xCB, 0x23, 0xA1, 
+
+0xBD, 0x68, 0xD1, 0xCF, 0x54, 0xE8, 0xB2, 0xD0, 
+0x6D, 0x7B, 0x1B, 0xBF, 0xDA, 0xC6, 0x90, 0xC6, 
+0x5C, 0x49, 0x52, 0xED, 0xB3, 0x22, 0x77, 0x28, 
+0x89, 0xA8, 0x2D, 0x05, 0x85, 0x46, 0x8A, 0x24, 
+0xAA, 0xEC, 0x58, 0x7C, 0x84, 0xCE, 0x57, 0xC8, 
+0x5D, 0x47, 0x36, 0x3E, 0x64, 0x83, 0xF8, 0xDC, 
+0xD4, 0xDE, 0x7D, 0xEC, 0x95, 0x8E, 0x92, 0xB7, 
+0x69, 0xA6, 0xC3, 0xE0, 0xF6, 0x5C, 0x21, 0x5F, 
+0xB1, 0x9C, 0xDC, 0x28, 0x4A, 0x35, 0xE9, 0xCB, 
+0x04, 0xD7, 0x18, 0xE6, 0xF5, 0x31, 0xF2, 0x6C, 
+0xB3, 0x66, 0x47, 0xA9, 0xD3, 0xD4, 0x36, 0x91, 
+0xC4, 
+
+0x8E, 0xC6, 0xEF, 0x7A, 0xA0, 0x05, 0x96, 0xC1, 
+0xE8, 0xB2, 0xD5, 0x52, 0xB7, 0x73, 0x19, 0x21, 
+0xD1, 0x8C, 0x6D, 0x58, 0x65, 0x81, 0x29, 0x4B, 
+0x1B, 0x4A, 0xA6, 0xC8, 0x11, 0x94, 0x60, 0x21, 
+0x42, 0xB2, 0x98, 0xE6, 0x1D, 0x13, 0x76, 0x16, 
+0xAF, 0xC1, 0x98, 0x0D, 0xD7, 0xD3, 0x42, 0x4D, 
+0x9A, 0xF3, 0x61, 0x95, 0xB1, 0x66, 0xF8, 0x62, 
+0x41, 0xC7, 0x2B, 0x78, 0xDB, 0xF1, 0xE4, 0x1D, 
+0x99, 0x7A, 0xEC, 0x2F, 0x0E, 0x9B, 0xC5, 0x0D, 
+0x0B, 0xCF, 0x9B, 0xDA, 0x05, 0x84, 0xAF, 0xEE, 
+0xEF, 0x17, 0xBE, 0x77, 0x7B, 0xEC, 0x27, 0x78, 
+0x6E, 0xC3, 
+
+0x12, 0xB8, 0x37, 0x41, 0xBB, 0xE1, 0x0A, 0xFF, 
+0x24, 0x88, 0x0E, 0x8A, 0xE5, 0x55, 0xB2, 0x71, 
+0x91, 0x95, 0x3E, 0x6F, 0x76, 0x7F, 0x14, 0x9F, 
+0xAF, 0xEF, 0x95, 0x52, 0x7B, 0xBC, 0xF6, 0x3F, 
+0xFA, 0x83, 0x08, 0x1D, 0xFC, 0xD8, 0xF9, 0x86, 
+0x5C, 0x88, 0x72, 0xEE, 0xD7, 0xF2, 0xAB, 0x46, 
+0x0C, 0x6D, 0x68, 0x5B, 0xDC, 0xE1, 0x00, 0xB6, 
+0x3F, 0xB4, 0x33, 0x2D, 0x05, 0xA3, 0x39, 0x99, 
+0xA7, 0x87, 0xBA, 0x5B, 0x95, 0xFE, 0x5E, 0x0A, 
+0xB6, 0x65, 0xF4, 0x83, 0xFC, 0x8E, 0x03, 0xBD, 
+0xB7, 0x8B, 0xC1, 0x94, 0xF6, 0x32, 0xE1, 0x89, 
+0xD0, 0x41, 0x04, 
+
+0x6B, 0x05, 0x10, 0x02, 0x8A, 0x83, 0xE5, 0xF8, 
+0x2B, 0x49, 0xAB, 0x87, 0xE9, 0xE3, 0xD2, 0xC3, 
+0x62, 0xF6, 0x6F, 0x96, 0xBC, 0xE4, 0xC9, 0x49, 
+0xEB, 0x8B, 0x13, 0xA5, 0x4F, 0x45, 0xC1, 0x93, 
+0x12, 0x9B, 0x31, 0xAE, 0x5B, 0xCF, 0x1B, 0x3A, 
+0xCE, 0x3B, 0xA6, 0xF8, 0x13, 0x91, 0x8B, 0x62, 
+0x22, 0xA7, 0x3E, 0xEC, 0x13, 0x69, 0x46, 0x1F, 
+0xF9, 0x43, 0xF3, 0x2E, 0xDA, 0x6A, 0x26, 0x35, 
+0xB7, 0xEF, 0x42, 0x49, 0x1E, 0x5B, 0xF8, 0x3B, 
+0xE7, 0xDF, 0x6B, 0x5A, 0xD6, 0x49, 0xFE, 0x3E, 
+0x46, 0x9C, 0x69, 0xA9, 0x1C, 0x12, 0xD2, 0x39, 
+0xC5, 0x9D, 0xAE, 0xF3, 
+
+0x08, 0x6B, 0x53, 0xBB, 0x91, 0x07, 0x99, 0x62, 
+0xEE, 0x94, 0x9C, 0xC9, 0x1F, 0xA2, 0xB9, 0x53, 
+0xB1, 0xA6, 0x7A, 0xBD, 0x17, 0xFC, 0x2B, 0xFF, 
+0xF9, 0x44, 0x40, 0xC2, 0x40, 0x0F, 0xC9, 0x5B, 
+0x60, 0x2B, 0x15, 0x24, 0x66, 0xA3, 0xF1, 0x01, 
+0x16, 0x05, 0x12, 0x3E, 0x8F, 0xD8, 0x7F, 0x0D, 
+0xA1, 0x3D, 0xB1, 0x34, 0xBC, 0x6A, 0xA9, 0x74, 
+0xFA, 0xCF, 0x26, 0xF0, 0xF3, 0xB7, 0xD7, 0xA9, 
+0x24, 0x4A, 0x95, 0x92, 0x1F, 0xC2, 0x56, 0xB3, 
+0x2C, 0x5B, 0xA7, 0x26, 0xE9, 0x08, 0x73, 0xE8, 
+0xFA, 0xC3, 0x88, 0xA6, 0x3F, 0x1A, 0xB9, 0x38, 
+0x5F, 0x33, 0xBF, 0x3E, 0x18, 
+
+0x89, 0xD0, 0x65, 0x6B, 0x21, 0xE0, 0x44, 0x78, 
+0xDB, 0x9E, 0x09, 0x0E, 0xAC, 0x07, 0xE0, 0x75, 
+0x25, 0x17, 0x50, 0xBF, 0x9D, 0xA9, 0x79, 0x0C, 
+0xEC, 0x5F, 0x4D, 0xC8, 0xDE, 0xA2, 0xC7, 0x8F, 
+0x49, 0x04, 0x24, 0xC2, 0x32, 0x06, 0xA3, 0xC2, 
+0x68, 0x0E, 0xDC, 0x7B, 0xB1, 0x1E, 0xEF, 0xC5, 
+0x50, 0x01, 0xAD, 0x19, 0xA3, 0xFC, 0x21, 0x8D, 
+0x99, 0xB3, 0xD8, 0xBF, 0x0D, 0xD8, 0xD8, 0xEB, 
+0x53, 0x21, 0xD7, 0x00, 0x87, 0x84, 0xEC, 0xDA, 
+0x3A, 0x23, 0xC9, 0x56, 0xBD, 0x33, 0x32, 0x8A, 
+0xD3, 0xDF, 0xFA, 0x2E, 0xCC, 0x2E, 0xB7, 0xEB, 
+0x11, 0x1A, 0x54, 0x5F, 0x2F, 0x7F, 
+
+0x8A, 0xB8, 0xB3, 0xB5, 0x1D, 0x0A, 0x65, 0x6B, 
+0x22, 0x88, 0xA6, 0x52, 0xBC, 0x51, 0xBC, 0xD0, 
+0x60, 0xFF, 0x3E, 0x29, 0xCE, 0xEC, 0xA5, 0x30, 
+0xE5, 0x16, 0x1E, 0x8D, 0x15, 0x45, 0x71, 0xB5, 
+0x94, 0xA3, 0xD4, 0xCE, 0x4A, 0x4E, 0x67, 0x3A, 
+0x28, 0xD9, 0x66, 0x52, 0x84, 0xE4, 0x32, 0xC1, 
+0x60, 0xDE, 0x9A, 0xB6, 0x46, 0xFF, 0xC6, 0xC4, 
+0xE4, 0x03, 0x78, 0x03, 0xCA, 0x94, 0xCE, 0x12, 
+0x18, 0x29, 0xF6, 0xD6, 0x5F, 0xC7, 0x5C, 0xAC, 
+0x44, 0x08, 0x3A, 0xCE, 0x58, 0xC6, 0x36, 0x02, 
+0x7E, 0xF7, 0x83, 0x4E, 0x7C, 0x5D, 0x4F, 0xD4, 
+0x45, 0xF7, 0x3F, 0x3B, 0x67, 0x36, 0x25, 
+
+0x64, 0xAB, 0xAC, 0x3A, 0x71, 0xB5, 0x14, 0x21, 
+0xDA, 0x03, 0x09, 0x7B, 0x7B, 0xB1, 0x59, 0xA7, 
+0x1F, 0x7E, 0xCD, 0xFF, 0xC4, 0xF0, 0xE8, 0xA4, 
+0x0C, 0xDD, 0xD9, 0x50, 0x5E, 0xF7, 0x70, 0x8A, 
+0x8E, 0xA8, 0xF1, 0x1C, 0x93, 0x13, 0xDC, 0x17, 
+0x22, 0xE3, 0xB9, 0x18, 0x6D, 0xCB, 0x57, 0x08, 
+0xAF, 0x6B, 0xC4, 0x06, 0x8B, 0x14, 0x58, 0x2F, 
+0x99, 0x1B, 0xD5, 0xED, 0x10, 0x82, 0xBA, 0x9E, 
+0x86, 0x66, 0x2B, 0x03, 0x14, 0x71, 0x99, 0x15, 
+0xA4, 0x7C, 0xD7, 0x93, 0x2D, 0x78, 0xB4, 0x12, 
+0x04, 0x6C, 0x69, 0xE5, 0xFB, 0x0D, 0xEB, 0x96, 
+0xF4, 0x00, 0x92, 0x75, 0x8D, 0xB2, 0xB4, 0x18, 
+
+0x62, 0x5F, 0x33, 0x78, 0x71, 0x46, 0xFF, 0x69, 
+0xE7, 0x15, 0x8E, 0x81, 0x84, 0x4D, 0x5F, 0x21, 
+0xF1, 0xED, 0x15, 0xFB, 0x55, 0x8F, 0x99, 0x86, 
+0x14, 0x98, 0x4D, 0x13, 0xA5, 0x65, 0xE6, 0xF8, 
+0xAD, 0x43, 0x3C, 0x41, 0xF7, 0x6C, 0xB6, 0xD1, 
+0xCA, 0x22, 0x54, 0xD6, 0x7D, 0x98, 0x97, 0x5E, 
+0x90, 0x82, 0xB4, 0x05, 0x98, 0x06, 0x66, 0xD0, 
+0x01, 0xCB, 0x44, 0xB7, 0xAB, 0xD6, 0xF2, 0xA9, 
+0xBF, 0x77, 0x49, 0x17, 0x2B, 0x62, 0x68, 0x10, 
+0x26, 0x0C, 0x3F, 0x42, 0xC6, 0x4F, 0x1A, 0x40, 
+0xAC, 0xC2, 0x03, 0xA5, 0xA6, 0x20, 0x12, 0x7F, 
+0x42, 0x74, 0x7A, 0xE3, 0x93, 0x2E, 0x79, 0x13, 
+0x74, 
+
+0xE4, 0x06, 0x6E, 0x79, 0x9C, 0x44, 0x08, 0x77, 
+0x20, 0xAD, 0x39, 0x24, 0x1B, 0x17, 0xE0, 0xE1, 
+0xB8, 0x0F, 0x9F, 0x87, 0xF2, 0x6E, 0x1B, 0x58, 
+0xCE, 0xAB, 0x32, 0x9C, 0x21, 0x47, 0x3E, 0xCF, 
+0xF2, 0x5C, 0xA3, 0x4F, 0x78, 0x32, 0x74, 0x95, 
+0xF0, 0x64, 0x03, 0xF8, 0xA5, 0xA4, 0x09, 0x89, 
+0xA1, 0x0E, 0x2D, 0x9C, 0x05, 0xA3, 0xFA, 0x92, 
+0xF3, 0x50, 0x23, 0xE2, 0xF2, 0x9C, 0xEC, 0x1C, 
+0xB9, 0xA6, 0x81, 0xA0, 0xB1, 0xC6, 0xE5, 0x62, 
+0x18, 0xB2, 0x63, 0x33, 0xD7, 0x43, 0x33, 0x23, 
+0xE0, 0x31, 0xD1, 0x33, 0x39, 0xFF, 0x2F, 0x89, 
+0x1E, 0x50, 0xF1, 0xC5, 0xEF, 0x1C, 0xD7, 0x52, 
+0xC6, 0xF3, 
+
+0xC8, 0xEA, 0x72, 0x4D, 0xD3, 0x71, 0x13, 0xB2, 
+0x6F, 0x07, 0xD2, 0xE7, 0x94, 0xBA, 0xED, 0x0C, 
+0x41, 0xA3, 0xF1, 0x08, 0x33, 0xAA, 0x8F, 0x20, 
+0x5C, 0x3F, 0x68, 0xB5, 0x8A, 0xAC, 0x9A, 0xB1, 
+0xCC, 0xD1, 0x5D, 0xBC, 0xCB, 0xC1, 0xE4, 0x25, 
+0x3B, 0x02, 0xB6, 0x62, 0x11, 0x42, 0xD8, 0x6C, 
+0x4F, 0x8D, 0x40, 0xD1, 0x86, 0x87, 0x66, 0xB4, 
+0xD3, 0x2D, 0x0F, 0x7B, 0x47, 0x60, 0xF7, 0xFF, 
+0xD3, 0x52, 0x63, 0x99, 0x91, 0xDE, 0x45, 0x96, 
+0xD4, 0x00, 0x8D, 0xD7, 0x32, 0xB1, 0x5F, 0x5E, 
+0x37, 0x66, 0x1B, 0xB2, 0xAC, 0x5A, 0xBA, 0x8C, 
+0xE7, 0x96, 0x3B, 0xD1, 0x30, 0x8D, 0x31, 0xE3, 
+0xA9, 0x25, 0x18, 
+
+0x5F, 0x61, 0xA5, 0x65, 0x58, 0xE2, 0xAD, 0x90, 
+0x17, 0x39, 0xB6, 0xE3, 0x08, 0x3E, 0x9D, 0x90, 
+0x1F, 0x73, 0x82, 0x85, 0x4A, 0x86, 0xBB, 0x5F, 
+0x7D, 0x20, 0xCF, 0x04, 0x10, 0xFE, 0x79, 0xA5, 
+0x5D, 0x67, 0x80, 0x0D, 0x99, 0x1C, 0x59, 0xA5, 
+0x25, 0xCC, 0x5B, 0x1D, 0x2A, 0x45, 0xFE, 0xD8, 
+0xC7, 0x1D, 0xF6, 0xCE, 0x8C, 0x56, 0xB0, 0xA4, 
+0xC9, 0x2E, 0x64, 0xC3, 0x3E, 0x8D, 0xD8, 0xFF, 
+0xBB, 0x0C, 0x78, 0x93, 0xC9, 0xF0, 0x1D, 0x42, 
+0x07, 0x3C, 0x95, 0x9F, 0xE2, 0x89, 0xA1, 0xC8, 
+0x28, 0xF0, 0xFF, 0x0A, 0xDB, 0xCC, 0x1E, 0xBD, 
+0xBE, 0x98, 0xD0, 0xE2, 0xD9, 0xB0, 0x17, 0x4A, 
+0xB5, 0xDB, 0x01, 0x56, 
+
+0x26, 0xFD, 0xF7, 0xE4, 0x14, 0x08, 0x9B, 0x2D, 
+0xD9, 0xAA, 0x66, 0x37, 0xCF, 0x70, 0xCC, 0xDD, 
+0x4D, 0x85, 0x33, 0x84, 0x7F, 0x91, 0x70, 0xA6, 
+0xC1, 0x80, 0xB5, 0xDB, 0x6C, 0x70, 0x78, 0xBB, 
+0x2E, 0xF7, 0x1B, 0xD3, 0x06, 0x25, 0xB0, 0x04, 
+0x01, 0x65, 0xC8, 0x4D, 0x0F, 0xBB, 0xBD, 0xD2, 
+0x15, 0x1C, 0x80, 0xC7, 0xE8, 0xDE, 0xDE, 0x68, 
+0xC9, 0x97, 0x82, 0x92, 0xDE, 0x12, 0x7C, 0x24, 
+0xE1, 0x0B, 0xD2, 0xEC, 0xF5, 0x9F, 0xFD, 0xDC, 
+0x25, 0x9F, 0xD7, 0x81, 0xE3, 0xF0, 0x94, 0x10, 
+0x74, 0x63, 0xAB, 0xF8, 0xF4, 0x21, 0x44, 0xD9, 
+0xA8, 0xCB, 0x31, 0xEE, 0xC1, 0x85, 0x75, 0x6F, 
+0x5E, 0x88, 0x98, 0x60, 0xA6, 
+
+0x5C, 0x38, 0x35, 0x54, 0x76, 0x33, 0x1A, 0x11, 
+0x59, 0x88, 0x74, 0xE3, 0x3C, 0x8E, 0x72, 0xB3, 
+0xA6, 0xB5, 0x2E, 0xA4, 0xB7, 0xD4, 0x51, 0xDE, 
+0x8A, 0x50, 0x77, 0x6A, 0xFE, 0x4D, 0x81, 0x54, 
+0xE7, 0x20, 0xE1, 0x48, 0x90, 0x79, 0xA6, 0xBE, 
+0x3F, 0x96, 0xA0, 0x24, 0xAC, 0x2C, 0x40, 0xB7, 
+0x54, 0x2E, 0x74, 0x41, 0xBD, 0xDD, 0xEC, 0x40, 
+0x12, 0xDB, 0xA7, 0x6F, 0x1C, 0x04, 0x9B, 0x9E, 
+0x02, 0x42, 0x2F, 0x57, 0xF9, 0x2C, 0xB9, 0x24, 
+0x5A, 0x4B, 0x03, 0xFF, 0x58, 0x55, 0xE6, 0x19, 
+0x3A, 0xC8, 0xD3, 0xAF, 0xF9, 0x90, 0x15, 0x6D, 
+0x3C, 0x96, 0xD9, 0x8B, 0x0B, 0x49, 0x8B, 0x33, 
+0x93, 0x41, 0xB8, 0x93, 0x7F, 0xFF, 
+
+0x50, 0x3C, 0xA3, 0x87, 0xD2, 0x56, 0x59, 0xE7, 
+0xAD, 0x48, 0xE7, 0xA9, 0x06, 0x08, 0xB7, 0x16, 
+0x27, 0xCD, 0x51, 0x52, 0x09, 0x69, 0x9B, 0xD8, 
+0xD0, 0x1F, 0x6D, 0x15, 0x82, 0xE6, 0x75, 0x2A, 
+0x60, 0x87, 0xC5, 0xD6, 0xF3, 0x4D, 0xBC, 0x86, 
+0x7A, 0x0C, 0x73, 0x83, 0x52, 0xC4, 0x9D, 0x5D, 
+0xF7, 0x4B, 0x19, 0xB6, 0x98, 0xE9, 0x6B, 0x11, 
+0xA9, 0xE5, 0xC5, 0x5B, 0x1F, 0x84, 0x03, 0x00, 
+0x96, 0x0D, 0xE4, 0xF6, 0x67, 0x22, 0x5E, 0x36, 
+0xFF, 0x02, 0x3D, 0x8E, 0x22, 0x30, 0xDD, 0x6C, 
+0x00, 0x86, 0x01, 0xF7, 0x5B, 0x8E, 0xDE, 0xEE, 
+0x11, 0xB3, 0x21, 0x1E, 0x23, 0x9B, 0x1A, 0x3A, 
+0x96, 0xC4, 0x47, 0xCE, 0x33, 0x4A, 0x32, 
+
+0x0E, 0x5D, 0x5F, 0x2C, 0x8A, 0x5B, 0x8D, 0x5B, 
+0x19, 0x60, 0x9F, 0x5C, 0x53, 0x50, 0x12, 0xE0, 
+0x8F, 0x14, 0x74, 0xAC, 0x18, 0xC5, 0xAA, 0x48, 
+0x00, 0x14, 0x2E, 0xA5, 0x8E, 0x35, 0x7C, 0x2A, 
+0xEF, 0x13, 0x9A, 0xE8, 0xA6, 0xC2, 0xE1, 0xAE, 
+0x26, 0xAE, 0x55, 0x4F, 0xE9, 0x27, 0xCA, 0x92, 
+0xC4, 0x2D, 0x81, 0xB3, 0x09, 0x34, 0x2D, 0xE6, 
+0x7A, 0x55, 0x11, 0x28, 0x5A, 0x1E, 0x11, 0x54, 
+0x6C, 0xA6, 0x8E, 0xB7, 0xD7, 0xD2, 0x89, 0x73, 
+0xC8, 0x36, 0x67, 0x05, 0xDD, 0x5A, 0xEC, 0x1B, 
+0xAC, 0x5A, 0x58, 0xE2, 0x21, 0xD4, 0xE0, 0xEC, 
+0xDB, 0xCF, 0xBF, 0x09, 0xB7, 0x7B, 0xA6, 0x42, 
+0x0B, 0x87, 0x59, 0x40, 0xE2, 0x55, 0xFB, 0xBE, 
+
+0x78, 0x7F, 0x91, 0x45, 0x42, 0x31, 0xC2, 0x97, 
+0x90, 0x70, 0x97, 0x82, 0xE6, 0x5A, 0x06, 0xB4, 
+0x7F, 0x97, 0x61, 0xFD, 0xAC, 0x04, 0x3F, 0x47, 
+0xBB, 0xF9, 0x67, 0xAE, 0xC1, 0xE3, 0x31, 0xE5, 
+0xBF, 0x8D, 0x2A, 0x86, 0x13, 0x4A, 0xAA, 0x9C, 
+0x10, 0xDD, 0x53, 0x50, 0xDF, 0x02, 0x29, 0x6F, 
+0x36, 0x2D, 0x74, 0x21, 0x2C, 0xD8, 0x56, 0x78, 
+0x31, 0x94, 0x4D, 0x72, 0xAB, 0x15, 0xB4, 0xAB, 
+0xD8, 0x76, 0x1C, 0x92, 0x93, 0xA3, 0xB5, 0x7D, 
+0xA7, 0x07, 0x02, 0xC7, 0xB1, 0x35, 0x06, 0xE6, 
+0x77, 0xEB, 0xAF, 0x1F, 0xEC, 0x79, 0xCF, 0x67, 
+0x99, 0x6E, 0xED, 0xA0, 0x79, 0x4A, 0x0D, 0x8E, 
+0x9A, 0x37, 0x84, 0x0B, 0xBD, 0x21, 0xFE, 0x3A, 
+0x01, 
+
+0x6C, 0xE7, 0x6C, 0x09, 0xA7, 0xBC, 0xFD, 0x9D, 
+0x61, 0x0A, 0x49, 0xF9, 0x0C, 0x92, 0xC7, 0x86, 
+0xBF, 0x1A, 0x7A, 0x55, 0xC8, 0xBC, 0xF8, 0x4E, 
+0x37, 0xD2, 0xD2, 0xAF, 0x00, 0xAF, 0xE7, 0xA6, 
+0xCA, 0x45, 0x5A, 0xA8, 0xAA, 0x90, 0x68, 0x9E, 
+0x1B, 0x8F, 0x11, 0x25, 0x32, 0xF1, 0x78, 0x32, 
+0x99, 0x10, 0x4F, 0x6C, 0xC1, 0x82, 0xDD, 0x10, 
+0xC3, 0x5E, 0x0A, 0x58, 0x78, 0x48, 0xEC, 0x92, 
+0xEB, 0xEF, 0x31, 0x4A, 0x08, 0x65, 0x2C, 0xB8, 
+0xEB, 0x15, 0x70, 0xB3, 0x8A, 0xD3, 0x95, 0x58, 
+0x73, 0x97, 0xAA, 0x5E, 0xD9, 0x46, 0xC2, 0x40, 
+0x0F, 0x86, 0x1A, 0x57, 0x0D, 0x67, 0xA6, 0x1D, 
+0xAB, 0xF2, 0x99, 0x32, 0x3F, 0xF7, 0xFF, 0x6C, 
+0x17, 0x8A, 
+
+0x09, 0x11, 0x57, 0x9C, 0xA6, 0x3C, 0xF5, 0x99, 
+0xBE, 0xAC, 0x4D, 0xD0, 0xF0, 0xDA, 0x46, 0xBF, 
+0x88, 0xAD, 0xF2, 0xE5, 0x0B, 0x71, 0xFF, 0x2E, 
+0xAC, 0x0A, 0x02, 0x9B, 0x11, 0xAB, 0xF7, 0x38, 
+0x0B, 0x78, 0xF6, 0xED, 0x42, 0x99, 0x8E, 0x95, 
+0xBC, 0x2B, 0xE2, 0xD1, 0x57, 0xB0, 0x74, 0xD7, 
+0x1E, 0x8D, 0x23, 0xEF, 0xDA, 0x6A, 0x52, 0x17, 
+0xF0, 0x8C, 0xDF, 0xE5, 0xC2, 0xFF, 0x0F, 0x77, 
+0x8B, 0x3D, 0x47, 0xBB, 0x04, 0x53, 0xF6, 0xE6, 
+0xDD, 0xF2, 0xE7, 0x53, 0x4B, 0x6C, 0xA7, 0xAC, 
+0xB0, 0xCB, 0x38, 0xAA, 0x1A, 0x4B, 0xB8, 0x76, 
+0x8F, 0xC6, 0x1D, 0xF7, 0x28, 0xA4, 0xEB, 0xDC, 
+0xB5, 0x77, 0x2F, 0xC0, 0x0D, 0x4C, 0xFA, 0xBA, 
+0x07, 0xA3, 0x89, 
+
+0xDE, 0x3B, 0xBC, 0x84, 0xEA, 0x1D, 0x63, 0xD6, 
+0x71, 0x69, 0x30, 0x2F, 0xE3, 0xE5, 0xAF, 0x30, 
+0x73, 0x09, 0x1F, 0x2F, 0x24, 0x6B, 0x2C, 0xF6, 
+0xCE, 0x11, 0xB5, 0xE7, 0x1C, 0x67, 0x23, 0x05, 
+0x39, 0x3E, 0x7E, 0xF9, 0x87, 0x4E, 0x37, 0x1F, 
+0xE8, 0xBC, 0x3D, 0xE3, 0xC7, 0xC2, 0xE6, 0x80, 
+0x39, 0xAA, 0x32, 0xFA, 0xA3, 0xA0, 0x02, 0xD7, 
+0x4D, 0x25, 0x29, 0x24, 0xAE, 0xDA, 0x94, 0x3A, 
+0x68, 0x46, 0xAB, 0x54, 0xD5, 0x5C, 0x87, 0xCF, 
+0x62, 0x64, 0x44, 0xAF, 0x99, 0x75, 0x44, 0x9B, 
+0xA8, 0x03, 0xD3, 0x05, 0x2A, 0xDE, 0xD4, 0x2F, 
+0xEF, 0xE8, 0xCE, 0xDB, 0x20, 0x98, 0xCA, 0x97, 
+0x08, 0xC3, 0xCE, 0x78, 0xCE, 0x81, 0x9B, 0xF7, 
+0x48, 0x4F, 0xC8, 0x61, 
+
+0x10, 0x2A, 0x06, 0xD0, 0x9E, 0x69, 0x11, 0xA4, 
+0xBE, 0xAE, 0x34, 0x67, 0xD1, 0xEA, 0x9F, 0x23, 
+0x5E, 0xF3, 0xA6, 0x7E, 0xD9, 0x24, 0x74, 0x51, 
+0xB1, 0x0F, 0xB9, 0xB1, 0x68, 0x31, 0xC9, 0x5D, 
+0xF6, 0xA0, 0x79, 0x69, 0xBC, 0x29, 0x9C, 0x36, 
+0xCD, 0x9D, 0xA5, 0x4C, 0xCB, 0x67, 0xE9, 0x46, 
+0xC5, 0x9F, 0xAD, 0x54, 0xB8, 0x18, 0x82, 0x42, 
+0x83, 0x5F, 0xF9, 0xB5, 0x65, 0x33, 0x9E, 0x31, 
+0x72, 0x0B, 0x4A, 0x1C, 0x6A, 0x7A, 0x64, 0x2F, 
+0xCC, 0x17, 0x63, 0x1B, 0xF0, 0x46, 0x07, 0xB5, 
+0x43, 0xA0, 0x44, 0x6D, 0xAD, 0xF5, 0x22, 0x3C, 
+0xC4, 0x21, 0x53, 0x66, 0x67, 0xB3, 0xCF, 0x63, 
+0x91, 0x73, 0xA0, 0xBF, 0x77, 0x08, 0x62, 0x17, 
+0xB0, 0xA7, 0xC8, 0xCF, 0x0C, 
+
+0xBA, 0x50, 0x32, 0x6D, 0x6F, 0x84, 0x2D, 0x15, 
+0x34, 0x88, 0x88, 0xD9, 0x75, 0xBA, 0xBA, 0x6B, 
+0x7A, 0x96, 0xE8, 0x8A, 0x92, 0x9C, 0x09, 0x03, 
+0x30, 0x23, 0xC0, 0x6A, 0xFE, 0x6B, 0x5A, 0xBC, 
+0x6C, 0xDA, 0xA6, 0xF9, 0xA3, 0x18, 0xFE, 0x8F, 
+0x25, 0xD6, 0x90, 0xD7, 0xFA, 0xC4, 0x67, 0x78, 
+0xF3, 0x55, 0xA7, 0xC5, 0xA4, 0xA0, 0x42, 0x8A, 
+0x4F, 0x04, 0x2E, 0xE4, 0x1F, 0x8B, 0x45, 0xDC, 
+0xD5, 0xF6, 0xCD, 0x5D, 0xC0, 0xCA, 0x51, 0x94, 
+0x58, 0x20, 0xCE, 0x66, 0xF8, 0xD9, 0x2D, 0x4A, 
+0xFA, 0xC0, 0x22, 0xCD, 0xBB, 0xB6, 0xD6, 0x52, 
+0xE8, 0x3C, 0x96, 0x3D, 0xD7, 0x97, 0xFE, 0xF5, 
+0x24, 0x7E, 0x40, 0x56, 0x09, 0x81, 0x86, 0x1F, 
+0xF7, 0x70, 0xB4, 0xEF, 0x15, 0xEC, 
+
+0x3C, 0x16, 0x36, 0xF7, 0xBD, 0x37, 0x9E, 0x11, 
+0xCD, 0xA3, 0x19, 0x03, 0x4E, 0x28, 0xBA, 0x1A, 
+0x5B, 0x69, 0xD5, 0x1A, 0x86, 0x89, 0xB2, 0x62, 
+0x2B, 0xB4, 0x27, 0x6D, 0xC4, 0x5D, 0x92, 0xB4, 
+0xCE, 0x4C, 0x1B, 0x52, 0xB0, 0x08, 0x3A, 0x0B, 
+0x52, 0x8D, 0xFC, 0x8E, 0xF6, 0x2C, 0xB2, 0x8E, 
+0xFE, 0x63, 0xB7, 0x1D, 0x97, 0x63, 0xE4, 0x84, 
+0x56, 0xAD, 0x5C, 0x2B, 0x3A, 0x9F, 0xB3, 0xCE, 
+0x9D, 0xA2, 0x98, 0x75, 0xEF, 0x2B, 0xE1, 0x9D, 
+0xC4, 0x12, 0xAE, 0x4B, 0xC2, 0x62, 0x3D, 0x6B, 
+0x7A, 0xBB, 0x1D, 0xAD, 0x5F, 0x3F, 0xF3, 0xF1, 
+0x4D, 0x79, 0xB9, 0x61, 0x83, 0xEE, 0x28, 0x94, 
+0xB1, 0x35, 0x8A, 0x53, 0x45, 0x54, 0x0D, 0xA6, 
+0x4F, 0x29, 0x26, 0x71, 0x03, 0x4E, 0x51, 
+
+0xA7, 0xB3, 0x8C, 0xE1, 0x88, 0x63, 0x65, 0xC3, 
+0xD9, 0x19, 0xDD, 0x49, 0x8F, 0xEC, 0x87, 0x85, 
+0x0A, 0x10, 0xF9, 0xBC, 0xBA, 0x19, 0x10, 0xCD, 
+0x6C, 0x83, 0x61, 0xC5, 0xA4, 0xE2, 0x03, 0xA6, 
+0xAF, 0x58, 0x02, 0x3E, 0x0D, 0xF8, 0x5A, 0x18, 
+0x73, 0x85, 0xCD, 0x4C, 0x31, 0x2F, 0xDD, 0xE0, 
+0xDF, 0x87, 0x73, 0xC0, 0x8E, 0x0C, 0x77, 0x06, 
+0xE6, 0x14, 0x2D, 0x1C, 0xC4, 0x12, 0x5B, 0x0E, 
+0x37, 0xEB, 0x20, 0x8E, 0x32, 0x68, 0x3E, 0xBA, 
+0x09, 0x06, 0x76, 0x67, 0x78, 0xEE, 0xAF, 0x45, 
+0xC8, 0xAF, 0xE0, 0x4D, 0x25, 0x67, 0x60, 0x5D, 
+0x21, 0x6E, 0xD7, 0x53, 0x31, 0xC0, 0x31, 0x9B, 
+0xDA, 0x1C, 0x6A, 0x00, 0xAF, 0x56, 0x6D, 0x0C, 
+0xED, 0x7E, 0x26, 0x5B, 0x8D, 0x84, 0x05, 0x1D, 
+
+0x18, 0xB8, 0xA0, 0x86, 0xC3, 0x0E, 0x45, 0xE1, 
+0xF9, 0xE9, 0xB7, 0x1B, 0x9E, 0xC3, 0x2D, 0x07, 
+0x64, 0xAA, 0x0E, 0x7D, 0x76, 0x17, 0xC1, 0x14, 
+0xCC, 0xE3, 0xA8, 0xEC, 0xF1, 0x96, 0xFF, 0x62, 
+0xDF, 0x07, 0x23, 0x0D, 0x63, 0x95, 0xDB, 0x26, 
+0x53, 0xC0, 0x17, 0x24, 0xE2, 0x96, 0xB7, 0x45, 
+0xC1, 0xA1, 0xC5, 0x5C, 0x64, 0x02, 0x1B, 0xBB, 
+0xA8, 0xC4, 0x13, 0x1F, 0x7E, 0x0C, 0x08, 0xEF, 
+0x42, 0xEC, 0x33, 0x90, 0xEB, 0xED, 0xA4, 0x70, 
+0x4D, 0x9B, 0xD0, 0xF3, 0xF7, 0x91, 0xAF, 0xC4, 
+0x6F, 0x6E, 0x3D, 0x29, 0x0A, 0x61, 0x3F, 0x7F, 
+0x0A, 0x07, 0x5A, 0x80, 0x7A, 0x2F, 0x00, 0x1E, 
+0x6F, 0x31, 0xF3, 0xA5, 0x1F, 0x7B, 0xC2, 0x73, 
+0x2C, 0xC0, 0x12, 0xF6, 0x3C, 0x9E, 0xA7, 0x4D, 
+0x01, 
+
+0x1A, 0x05, 0x85, 0xB1, 0x20, 0x89, 0xCF, 0x85, 
+0x24, 0xF9, 0x1A, 0x65, 0x9E, 0x11, 0x04, 0x49, 
+0x96, 0x2D, 0xC3, 0xE4, 0x50, 0x37, 0xDE, 0xC9, 
+0xB3, 0xB6, 0x3A, 0xFA, 0xCE, 0x2A, 0x99, 0xA0, 
+0xA9, 0xAC, 0x3B, 0x3F, 0x9A, 0x69, 0x2D, 0x40, 
+0x4C, 0xE9, 0x08, 0x91, 0x4C, 0x25, 0xC7, 0x84, 
+0xBF, 0xBF, 0x18, 0x43, 0x90, 0x07, 0x8A, 0x1C, 
+0x9B, 0xD9, 0xD7, 0x5C, 0x6C, 0xEB, 0x33, 0xE1, 
+0x15, 0x15, 0x7D, 0x17, 0x5D, 0x0D, 0x4A, 0x83, 
+0xB6, 0x0C, 0xA2, 0xDB, 0xE4, 0x44, 0xE1, 0xC0, 
+0x45, 0x3F, 0x05, 0xFD, 0x44, 0xE2, 0xBF, 0xA9, 
+0xAD, 0xC5, 0xA2, 0x46, 0xB9, 0x37, 0x63, 0x74, 
+0xFB, 0x9D, 0xBF, 0xB6, 0xAF, 0xF4, 0xF9, 0x17, 
+0x4B, 0x96, 0x67, 0x03, 0x8F, 0x1A, 0xCE, 0x44, 
+0xA2, 0xA8, 
+
+0xAF, 0x52, 0xA2, 0xD2, 0xE1, 0x73, 0x5B, 0x12, 
+0x5E, 0x29, 0x35, 0x5D, 0xC0, 0xAA, 0x9B, 0x09, 
+0xC7, 0xE7, 0x4F, 0xA9, 0x76, 0x5B, 0x4B, 0x6B, 
+0xFD, 0x6D, 0xB9, 0xB5, 0x63, 0x8A, 0x75, 0x5C, 
+0x33, 0xD9, 0xF3, 0x59, 0x42, 0x75, 0xB3, 0xD5, 
+0xE6, 0x40, 0x05, 0x35, 0xB7, 0x47, 0x09, 0xC0, 
+0x8A, 0x74, 0x63, 0x74, 0xC8, 0x8A, 0xF5, 0xED, 
+0x9C, 0x1D, 0xDD, 0x52, 0x29, 0xFA, 0x19, 0xB1, 
+0xE7, 0xBD, 0xAA, 0x37, 0x0D, 0x44, 0x61, 0x87, 
+0x99, 0x3A, 0xFC, 0xD0, 0x4E, 0x2C, 0x1F, 0xEC, 
+0x82, 0xE8, 0xBE, 0x16, 0xE0, 0xD2, 0x29, 0x71, 
+0x79, 0x59, 0xE8, 0x63, 0x43, 0xAC, 0x65, 0xAF, 
+0x46, 0xE3, 0x41, 0x40, 0x25, 0x68, 0x1E, 0xC0, 
+0xE0, 0x80, 0xC7, 0xFE, 0x5F, 0xFC, 0xD0, 0x97, 
+0xBC, 0x76, 0x83, 
+
+0x45, 0x43, 0x37, 0x65, 0x8C, 0x09, 0x49, 0xC2, 
+0x40, 0xB0, 0xF1, 0x03, 0x5F, 0x2A, 0x14, 0xAA, 
+0xDF, 0xC6, 0xCE, 0x5F, 0x8F, 0x30, 0x34, 0x8C, 
+0xDF, 0x5D, 0xA6, 0x70, 0x2C, 0x91, 0xED, 0xEF, 
+0xA8, 0x76, 0x29, 0xA5, 0xE8, 0x50, 0xE1, 0xE1, 
+0x88, 0xF8, 0xC1, 0xEE, 0x7E, 0x25, 0xE7, 0x88, 
+0xFA, 0xB9, 0x0D, 0x86, 0x17, 0x45, 0x50, 0xA3, 
+0x86, 0x1B, 0x14, 0x62, 0xC9, 0xF5, 0xE1, 0x17, 
+0x20, 0xC4, 0x11, 0x12, 0x6B, 0xB6, 0xAF, 0x8B, 
+0xD0, 0x1B, 0xB1, 0x81, 0x8F, 0xBB, 0x70, 0x21, 
+0x75, 0xF0, 0x2B, 0x02, 0xF5, 0x9D, 0x76, 0x86, 
+0x9C, 0x59, 0x40, 0x47, 0x68, 0x16, 0xFE, 0xAD, 
+0xE7, 0xF4, 0x43, 0xAF, 0x30, 0x26, 0x0D, 0x84, 
+0x6F, 0x8F, 0x85, 0x80, 0xE3, 0x0F, 0x64, 0xB3, 
+0x85, 0x12, 0x9D, 0xB5, 
+
+0x9F, 0x8A, 0x26, 0xA7, 0x00, 0x82, 0xDD, 0xC1, 
+0x56, 0x41, 0x05, 0x69, 0x0A, 0x63, 0xF5, 0x5D, 
+0xAA, 0x20, 0xC0, 0xB6, 0x6A, 0x47, 0xC2, 0x52, 
+0xB2, 0x16, 0x0D, 0xF3, 0xA5, 0xCE, 0x46, 0xEA, 
+0x57, 0x06, 0x66, 0x95, 0xD6, 0x82, 0xEB, 0x6A, 
+0xC7, 0x2C, 0xBD, 0x66, 0x58, 0x01, 0x3F, 0x55, 
+0x0D, 0xB4, 0xF5, 0xFF, 0x5F, 0x47, 0xF2, 0xF4, 
+0x85, 0xD7, 0x30, 0xB6, 0x0F, 0xA7, 0x9D, 0x2E, 
+0x4E, 0x14, 0xC9, 0x32, 0x6A, 0x94, 0x7E, 0x7E, 
+0xF2, 0x87, 0x99, 0x14, 0x77, 0x34, 0x38, 0x26, 
+0x7E, 0xB9, 0x7B, 0xD0, 0x79, 0x98, 0x50, 0xD8, 
+0x5C, 0xB9, 0x11, 0x2D, 0x79, 0x38, 0x1F, 0x3C, 
+0x20, 0x91, 0xA8, 0x40, 0xCC, 0xA2, 0xAE, 0xDA, 
+0x81, 0x9E, 0x50, 0xF2, 0xC9, 0x4A, 0x29, 0x75, 
+0xFA, 0x6F, 0xB8, 0xDF, 0xD0, 
+
+0x05, 0x50, 0x49, 0xB3, 0x24, 0x7C, 0xDC, 0x25, 
+0x21, 0xB3, 0x05, 0x4B, 0x01, 0x12, 0xA4, 0x21, 
+0xF2, 0x14, 0x97, 0x8F, 0xE9, 0xFC, 0x6C, 0x4A, 
+0x7E, 0xDB, 0x4B, 0x95, 0x60, 0xAE, 0xE2, 0x23, 
+0x83, 0x79, 0x01, 0xD2, 0x02, 0xD7, 0x1E, 0xDA, 
+0x6F, 0x08, 0xC7, 0xA9, 0x45, 0xB1, 0x33, 0xE1, 
+0x50, 0x32, 0xB1, 0x93, 0x85, 0xDD, 0x63, 0x66, 
+0x96, 0xDC, 0x75, 0xEF, 0x90, 0x83, 0xF1, 0x88, 
+0x6A, 0x2C, 0x63, 0xBB, 0x70, 0x12, 0x53, 0x3F, 
+0x55, 0x16, 0xEC, 0xA7, 0x7E, 0xE5, 0xB1, 0x0B, 
+0xA5, 0xC3, 0x13, 0xBA, 0xE4, 0xD7, 0xE0, 0x1D, 
+0xD8, 0x52, 0xD1, 0xB0, 0xE9, 0xF6, 0x4D, 0x9A, 
+0x21, 0x4F, 0xB7, 0xB8, 0x61, 0x74, 0x1E, 0x4A, 
+0x31, 0x60, 0xEE, 0xE9, 0x46, 0x35, 0xE8, 0x11, 
+0x6C, 0x0E, 0x44, 0xBB, 0xD8, 0x40, 
+
+0x66, 0x8E, 0x5C, 0x35, 0x42, 0x81, 0x61, 0x88, 
+0x97, 0x36, 0x3F, 0x08, 0xCF, 0xE7, 0xE4, 0x33, 
+0xE3, 0xC8, 0x18, 0x82, 0x2A, 0xB1, 0xCE, 0x00, 
+0xF7, 0x8D, 0xE1, 0x4A, 0x95, 0x64, 0x9A, 0xF3, 
+0x29, 0x19, 0x3F, 0xAD, 0xD5, 0xFD, 0x0D, 0xEE, 
+0x2E, 0x83, 0x9A, 0x44, 0xFA, 0x02, 0x79, 0x22, 
+0x9D, 0x81, 0x4D, 0x22, 0xF8, 0x17, 0x2C, 0x1C, 
+0xC7, 0xEC, 0xE4, 0x17, 0x96, 0x72, 0x6F, 0x0E, 
+0x0D, 0xE8, 0x20, 0x4D, 0x80, 0xAD, 0xAF, 0x2E, 
+0xC3, 0x96, 0x60, 0x9A, 0xF1, 0x8A, 0x9D, 0xF7, 
+0x0B, 0xCA, 0x5F, 0x60, 0x4B, 0xF8, 0x6E, 0xB2, 
+0xE6, 0x5C, 0x82, 0x1B, 0xD8, 0x30, 0xEA, 0xE3, 
+0xBA, 0x8D, 0xB0, 0x36, 0x18, 0x78, 0x15, 0x4C, 
+0xEB, 0x0D, 0x08, 0xF0, 0xDC, 0x0C, 0xDA, 0x1B, 
+0xD4, 0x45, 0x3C, 0x94, 0xE9, 0x20, 0xFF, 
+
+0x21, 0xA7, 0x37, 0xF4, 0x86, 0x9B, 0x1F, 0x51, 
+0x9C, 0x6E, 0xD1, 0x3B, 0xEF, 0x11, 0x88, 0xEA, 
+0xCE, 0x61, 0xBE, 0x50, 0xE7, 0xC1, 0x77, 0x01, 
+0xE6, 0x79, 0x6D, 0x32, 0x1F, 0xFB, 0x5F, 0x77, 
+0xF8, 0x44, 0x2A, 0x96, 0xC0, 0x8D, 0x93, 0x16, 
+0x19, 0x52, 0x83, 0xBF, 0xED, 0x9F, 0xE6, 0x0C, 
+0x5B, 0xD4, 0x5C, 0xEA, 0x82, 0xAB, 0x57, 0x71, 
+0xAF, 0x67, 0x85, 0x1E, 0x64, 0xAF, 0x39, 0x21, 
+0xE1, 0x57, 0x97, 0x0B, 0x78, 0xAF, 0x86, 0xB4, 
+0x56, 0x19, 0x25, 0xF5, 0x72, 0x8C, 0xEB, 0x8B, 
+0xA8, 0xF0, 0xFE, 0x04, 0x95, 0x93, 0x01, 0x40, 
+0x11, 0x3A, 0xB3, 0x42, 0x88, 0x2C, 0x7D, 0x7C, 
+0x45, 0x5C, 0x42, 0x26, 0x1F, 0x9F, 0x9B, 0x19, 
+0xE1, 0x18, 0x61, 0x20, 0x0E, 0x81, 0x92, 0x5C, 
+0xB2, 0x57, 0xC3, 0xAF, 0xA8, 0x31, 0xA2, 0x01, 
+
+0xA4, 0x7A, 0x93, 0x1E, 0x94, 0x66, 0x8E, 0x82, 
+0x8F, 0x75, 0xD0, 0x7B, 0x15, 0x77, 0xA9, 0x55, 
+0x51, 0x63, 0x5B, 0xDC, 0x42, 0x37, 0x1A, 0xB1, 
+0x9C, 0x0B, 0x68, 0xC1, 0x76, 0x31, 0x9C, 0xE1, 
+0x9B, 0x98, 0x00, 0xB2, 0x16, 0xEA, 0x61, 0x18, 
+0x95, 0x7A, 0xE5, 0x5A, 0xE1, 0xAC, 0x0B, 0x92, 
+0x87, 0xEC, 0x87, 0x4E, 0xA1, 0x1B, 0x39, 0xCF, 
+0x95, 0x43, 0xCA, 0x0F, 0x77, 0xBB, 0x6A, 0x60, 
+0x5C, 0x39, 0xAD, 0xC4, 0x6A, 0xFE, 0x1B, 0x89, 
+0xD2, 0x3D, 0x4C, 0x64, 0x78, 0xC0, 0xBE, 0x0E, 
+0x92, 0x14, 0xA5, 0x54, 0x2E, 0x2E, 0x32, 0x00, 
+0xA3, 0xAA, 0x0E, 0x61, 0xD4, 0x46, 0xAC, 0xF4, 
+0x2F, 0x81, 0x26, 0x3A, 0x31, 0xFA, 0xF6, 0xA7, 
+0xCD, 0x0E, 0xEF, 0x76, 0xC2, 0xBB, 0x40, 0xBB, 
+0x36, 0x6D, 0x9B, 0xA7, 0x69, 0x79, 0x95, 0x6F, 
+0x04, 
+
+0xC3, 0x65, 0x34, 0xF1, 0x09, 0x34, 0x5E, 0xED, 
+0x6B, 0x18, 0xB7, 0x65, 0x81, 0x19, 0x8C, 0x7E, 
+0xC4, 0xB4, 0x04, 0x96, 0x29, 0x7F, 0xF8, 0xD3, 
+0x21, 0x66, 0xCC, 0x2A, 0xA9, 0xE1, 0xF1, 0x79, 
+0xA2, 0x8B, 0xD4, 0xD6, 0x89, 0xAE, 0xA0, 0xA9, 
+0xD3, 0x8A, 0x45, 0x6B, 0xE0, 0x7F, 0xED, 0xAB, 
+0x50, 0x7D, 0x93, 0x1E, 0x13, 0x94, 0xDE, 0x3C, 
+0xF2, 0x55, 0x2E, 0x6F, 0xBC, 0x24, 0x4A, 0xF6, 
+0x8C, 0xC5, 0x6D, 0x1D, 0xF2, 0xB2, 0x2E, 0x3E, 
+0x8C, 0x6A, 0x8C, 0x4A, 0xC5, 0xDD, 0xB1, 0x24, 
+0x5A, 0x54, 0x8B, 0xF4, 0x40, 0x59, 0x79, 0x19, 
+0xFD, 0xBC, 0x09, 0x53, 0xCF, 0x41, 0x59, 0xD4, 
+0x8A, 0x49, 0x18, 0x9A, 0xB4, 0x19, 0x39, 0x67, 
+0xCD, 0x06, 0x1D, 0xBF, 0xDE, 0x1C, 0x12, 0x0C, 
+0x60, 0x67, 0x10, 0x2E, 0x9D, 0xA1, 0x24, 0xF0, 
+0x7E, 0xD4, 
+
+0x1C, 0xF3, 0x6E, 0xFD, 0x8D, 0x25, 0x6E, 0x50, 
+0xCC, 0x3F, 0x7D, 0x35, 0x3F, 0x2D, 0xEB, 0x64, 
+0x51, 0x30, 0xBC, 0xD6, 0xB7, 0xB7, 0xE5, 0x48, 
+0x18, 0x01, 0xCF, 0x90, 0x6E, 0x1D, 0xCD, 0x18, 
+0x22, 0x75, 0xAF, 0x86, 0x25, 0x7B, 0x2A, 0x39, 
+0xF3, 0xF3, 0x2A, 0xDD, 0x1A, 0xD5, 0xC6, 0xB9, 
+0x5B, 0xCB, 0x03, 0xAB, 0xB0, 0x06, 0xDE, 0xB1, 
+0xEC, 0x0D, 0x85, 0x1A, 0xAD, 0x06, 0x34, 0xC8, 
+0xED, 0x31, 0xFD, 0xB1, 0x84, 0x4C, 0x0E, 0xE7, 
+0x54, 0x93, 0xBC, 0xE8, 0x7B, 0x63, 0x47, 0x05, 
+0xAF, 0x46, 0xB2, 0xB8, 0x7F, 0x94, 0x7F, 0xAC, 
+0x59, 0x41, 0x90, 0x09, 0xA7, 0xF1, 0x5F, 0x44, 
+0x06, 0x58, 0x33, 0x20, 0xD4, 0x15, 0xF9, 0x6B, 
+0x87, 0x09, 0x2F, 0x79, 0xE3, 0x5B, 0x36, 0x5F, 
+0x30, 0x04, 0xDE, 0x89, 0x07, 0x38, 0x13, 0x5C, 
+0x0C, 0x0A, 0x83, 
+
+0xC0, 0x8E, 0x95, 0xCD, 0xD4, 0xB8, 0x4A, 0x76, 
+0x55, 0xC8, 0x09, 0xB6, 0x4E, 0x80, 0x06, 0xA0, 
+0xB3, 0x09, 0xE1, 0xA8, 0xA1, 0x81, 0x05, 0x9E, 
+0xCD, 0x7F, 0x54, 0xAD, 0xCE, 0xCA, 0x30, 0x8A, 
+0xDC, 0x07, 0xD5, 0xC9, 0x5D, 0x08, 0x9F, 0x7D, 
+0xBB, 0xA0, 0x92, 0xB5, 0xFF, 0xD0, 0x85, 0x82, 
+0x91, 0x12, 0x84, 0x94, 0xA1, 0x8F, 0x71, 0xE5, 
+0xDE, 0xD7, 0x64, 0xF0, 0x67, 0x19, 0xCC, 0x55, 
+0xCD, 0x88, 0x8A, 0x99, 0x69, 0x5F, 0x36, 0xCC, 
+0x1F, 0xC0, 0xE2, 0xD9, 0x23, 0xAE, 0x90, 0x00, 
+0x16, 0xAD, 0x7A, 0xC9, 0xAB, 0x3B, 0x12, 0x86, 
+0xB7, 0x25, 0x6D, 0xE4, 0x60, 0x44, 0x78, 0x9C, 
+0x6D, 0x47, 0x9D, 0x79, 0x75, 0x14, 0x5D, 0x30, 
+0xAC, 0x23, 0x85, 0xEE, 0x17, 0x4D, 0x6B, 0xDD, 
+0x9B, 0xD3, 0xD4, 0x83, 0xD1, 0xF3, 0xFB, 0x60, 
+0x36, 0x31, 0x5E, 0x02, 
+
+0x8D, 0xB9, 0x63, 0x9C, 0x5A, 0x92, 0xC8, 0x66, 
+0x06, 0x3A, 0x0B, 0x73, 0x31, 0xA4, 0xF4, 0x42, 
+0xE1, 0x77, 0xBB, 0xAF, 0xF1, 0x39, 0xE1, 0x38, 
+0x3C, 0xC3, 0xED, 0x29, 0x2E, 0x8B, 0xCA, 0x2C, 
+0x5C, 0x24, 0x16, 0x26, 0xD9, 0x93, 0x98, 0x74, 
+0x2B, 0x3E, 0x76, 0x0F, 0xE9, 0x17, 0x7C, 0x5F, 
+0x0D, 0xA1, 0x89, 0x73, 0x5D, 0xBD, 0x9F, 0x94, 
+0x21, 0xDF, 0xFE, 0x90, 0xEB, 0x35, 0x85, 0xA3, 
+0x63, 0x68, 0x02, 0xED, 0xB2, 0xCB, 0x37, 0x3A, 
+0xB9, 0x0E, 0x67, 0x19, 0xA3, 0xAC, 0xD6, 0x4C, 
+0x6D, 0x62, 0x13, 0x1A, 0xA1, 0xC8, 0x4E, 0x08, 
+0xF1, 0x5F, 0xC9, 0x14, 0x7E, 0xBF, 0x81, 0xF9, 
+0xF0, 0x94, 0x4B, 0x7F, 0x89, 0x39, 0x3F, 0xCD, 
+0xC2, 0x6F, 0x46, 0xA5, 0x34, 0xBC, 0x10, 0x6F, 
+0xBF, 0x81, 0xEC, 0x12, 0xC5, 0x91, 0xE3, 0x04, 
+0x68, 0x85, 0x39, 0x0B, 0x75, 
+
+0x77, 0x30, 0x9D, 0xC1, 0x80, 0x21, 0x7A, 0x00, 
+0x65, 0xCF, 0x6A, 0xDC, 0xA3, 0xD3, 0x83, 0xED, 
+0xCE, 0x4C, 0xD4, 0x4B, 0xCB, 0x71, 0x8B, 0xBA, 
+0x47, 0x24, 0x61, 0x7C, 0x08, 0xCC, 0xDF, 0x1B, 
+0xF3, 0xEC, 0x61, 0x0E, 0x16, 0x8A, 0x64, 0x32, 
+0x41, 0x21, 0x36, 0x68, 0xD9, 0xEB, 0x44, 0x79, 
+0x0C, 0x7B, 0xBC, 0x69, 0x49, 0xA0, 0x20, 0x1E, 
+0xE3, 0x2A, 0x6C, 0xC9, 0xCA, 0xD2, 0x25, 0x2A, 
+0x28, 0x5B, 0x11, 0xEC, 0xF8, 0xE7, 0xC0, 0x46, 
+0xF8, 0xC8, 0x0B, 0x13, 0x9C, 0x54, 0x52, 0xCD, 
+0x53, 0x31, 0x5D, 0xCB, 0x3D, 0xD0, 0x54, 0x4E, 
+0x8B, 0xA1, 0xF2, 0x52, 0x89, 0x48, 0x28, 0x10, 
+0x47, 0x5B, 0x1B, 0x43, 0x2A, 0x46, 0x80, 0x6C, 
+0xB9, 0x49, 0x7B, 0xE1, 0xE5, 0x03, 0x4A, 0xE7, 
+0x21, 0xBC, 0xE2, 0x13, 0x95, 0x87, 0x47, 0x2A, 
+0xC2, 0xBC, 0xEC, 0x9F, 0x75, 0x8D, 
+
+0xB7, 0x6A, 0x6E, 0x03, 0xCA, 0x59, 0x5F, 0xCA, 
+0xAB, 0xAC, 0x2A, 0xBF, 0x97, 0x76, 0xA3, 0x90, 
+0x83, 0x1D, 0xD7, 0x33, 0x4C, 0xB8, 0x07, 0x0E, 
+0x5E, 0x20, 0x8A, 0xA4, 0x39, 0x00, 0x02, 0x77, 
+0x91, 0x18, 0xEF, 0x59, 0x4D, 0xFD, 0xE6, 0x6D, 
+0xF9, 0xCA, 0xA5, 0x50, 0x3D, 0x79, 0x9A, 0x18, 
+0x9A, 0x12, 0x8D, 0x80, 0xDF, 0x2D, 0xDE, 0x42, 
+0x0D, 0x7A, 0x8C, 0xF8, 0x52, 0x52, 0xFB, 0x95, 
+0xE6, 0x95, 0x84, 0xCD, 0x06, 0xB1, 0xAE, 0x2F, 
+0x08, 0x45, 0x9E, 0x64, 0x24, 0x45, 0x06, 0xA9, 
+0x94, 0x81, 0xAA, 0x78, 0xBA, 0x8D, 0x2C, 0x00, 
+0x5A, 0x1E, 0x48, 0x9A, 0x8C, 0xDB, 0x82, 0xF7, 
+0x87, 0x76, 0x97, 0x20, 0x11, 0x76, 0xE1, 0xCF, 
+0xD6, 0x67, 0x8B, 0x5C, 0xC3, 0x7B, 0x37, 0xB9, 
+0x5B, 0x02, 0x0D, 0xEE, 0xB2, 0xD5, 0x78, 0xBC, 
+0x0B, 0x92, 0x82, 0xED, 0xC9, 0xBF, 0x88, 
+
+0x5F, 0xC6, 0x27, 0x00, 0x33, 0x38, 0xB6, 0x19, 
+0xB5, 0xED, 0xC7, 0xAE, 0x9A, 0x5C, 0xA8, 0x12, 
+0xDE, 0xDA, 0x9E, 0x8F, 0x0D, 0x72, 0x6E, 0xEC, 
+0x9E, 0x5D, 0xBA, 0xBF, 0x62, 0xB5, 0x75, 0xC5, 
+0x8D, 0x98, 0x04, 0xCF, 0x8E, 0x60, 0xA4, 0x01, 
+0xF6, 0x16, 0x65, 0x2E, 0x40, 0x43, 0x51, 0x8D, 
+0x90, 0xC1, 0xC1, 0xA9, 0xE4, 0x6C, 0xD1, 0xBC, 
+0x99, 0xE8, 0x2B, 0xEE, 0xEB, 0xFA, 0x7F, 0xF8, 
+0x1E, 0xC5, 0x9E, 0x89, 0xE5, 0x96, 0x60, 0xC7, 
+0x04, 0xB4, 0xD4, 0x2E, 0xF0, 0x4F, 0xD5, 0xA3, 
+0xBF, 0x07, 0x1C, 0x2F, 0xF5, 0xC5, 0xCB, 0x78, 
+0xA7, 0xDE, 0x36, 0xA8, 0x10, 0xCC, 0xFE, 0x98, 
+0x16, 0xE7, 0x82, 0xAA, 0xAA, 0x62, 0x7D, 0x26, 
+0xFE, 0x6D, 0xC5, 0x06, 0xC6, 0xC9, 0x0F, 0x66, 
+0xA1, 0xA4, 0x20, 0xA8, 0xA0, 0x98, 0xC2, 0x0E, 
+0x43, 0x1E, 0x0B, 0xD0, 0x7D, 0x6F, 0xFE, 0xCC, 
+
+0xDD, 0x0E, 0x2A, 0x0F, 0xF8, 0x16, 0x1F, 0x33, 
+0xC9, 0x8B, 0x87, 0x99, 0x48, 0x1E, 0x46, 0x77, 
+0xF2, 0xCD, 0x9E, 0x72, 0xFD, 0x65, 0x6E, 0xAF, 
+0x78, 0x8C, 0xD5, 0xB0, 0xD4, 0x7E, 0x37, 0x0B, 
+0x4D, 0x76, 0xA4, 0x74, 0xE8, 0x63, 0x2A, 0xAF, 
+0x49, 0x2E, 0x90, 0x95, 0x23, 0x9D, 0x14, 0x52, 
+0xE4, 0x0B, 0x7C, 0x81, 0xFC, 0x00, 0xE0, 0x23, 
+0xCA, 0x6B, 0xC1, 0x13, 0xB2, 0x9C, 0x51, 0xD0, 
+0x67, 0x67, 0x8C, 0x38, 0xB7, 0x43, 0x01, 0x5A, 
+0xB0, 0xAC, 0x7B, 0xD8, 0x2B, 0x4F, 0x12, 0x75, 
+0x25, 0xC1, 0xE2, 0x3F, 0x3B, 0xBC, 0xAC, 0x99, 
+0xD6, 0x57, 0xAC, 0x15, 0x8F, 0x08, 0x9C, 0x96, 
+0xFF, 0x1B, 0xC0, 0x42, 0x7E, 0xD7, 0xC5, 0x30, 
+0x07, 0x2C, 0x8D, 0x08, 0x73, 0xFE, 0x30, 0x1C, 
+0x41, 0x25, 0x22, 0x93, 0xE4, 0x3A, 0xDC, 0x78, 
+0x47, 0xA3, 0x76, 0xC4, 0x52, 0xB2, 0x9F, 0x13, 
+0x16, 
+
+0x88, 0x64, 0xA0, 0xB1, 0xE1, 0x69, 0xF9, 0xD6, 
+0x2C, 0x3D, 0x98, 0xBB, 0x72, 0xC2, 0xC4, 0xB2, 
+0xD3, 0xB6, 0x63, 0x41, 0x96, 0x36, 0x8C, 0xD6, 
+0xEA, 0x3B, 0x0D, 0x92, 0x68, 0x7A, 0x3B, 0x8C, 
+0x94, 0xD3, 0x35, 0x84, 0xCA, 0x32, 0xE8, 0x2A, 
+0x4B, 0x30, 0x02, 0xE7, 0x75, 0x27, 0x22, 0xC8, 
+0x28, 0x06, 0x20, 0xA9, 0xB7, 0xEB, 0x8A, 0x13, 
+0xAA, 0x9F, 0xC8, 0xB6, 0x61, 0x5B, 0xD3, 0xBB, 
+0x1B, 0x0B, 0x4F, 0x66, 0xCC, 0x08, 0xB6, 0x5B, 
+0x44, 0x4E, 0x06, 0xC7, 0xF5, 0xC0, 0xF0, 0xA0, 
+0xD1, 0x32, 0x3A, 0xD1, 0xAC, 0xC9, 0x52, 0x6D, 
+0xA0, 0x90, 0x7C, 0xD1, 0x90, 0x7F, 0x53, 0xAD, 
+0xC1, 0x0C, 0x74, 0xB8, 0xBF, 0x90, 0x7D, 0xFC, 
+0x4A, 0x90, 0x41, 0x24, 0x71, 0x62, 0x3B, 0xB8, 
+0x69, 0x0D, 0xA5, 0x06, 0x44, 0xA0, 0xF8, 0x9F, 
+0xDB, 0xD5, 0x3A, 0x3E, 0x8C, 0x23, 0x83, 0x3A, 
+0xEE, 0xCA, 
+
+0x73, 0x21, 0x10, 0x08, 0xB2, 0x9D, 0xBC, 0x9A, 
+0xE0, 0xD3, 0x04, 0xE3, 0xEE, 0xF1, 0xAF, 0x38, 
+0xD1, 0x72, 0x53, 0x37, 0xC6, 0xC8, 0x6C, 0xC4, 
+0xE6, 0xA3, 0x3B, 0x30, 0xD8, 0x8A, 0xFA, 0xFF, 
+0x9E, 0x28, 0x11, 0x44, 0x98, 0xCF, 0xA6, 0x1F, 
+0xEF, 0xDF, 0xB6, 0xC2, 0x6E, 0xD2, 0xBD, 0x8E, 
+0xF0, 0x93, 0x29, 0x83, 0x52, 0x8C, 0x63, 0x4C, 
+0xC6, 0x2B, 0x83, 0x79, 0x99, 0x3E, 0x2B, 0xE2, 
+0xB2, 0x3B, 0x37, 0x86, 0xAB, 0x99, 0xB5, 0xDA, 
+0x08, 0xB7, 0xCC, 0x23, 0x9B, 0x2E, 0x43, 0x02, 
+0x3E, 0x5D, 0xD2, 0x82, 0xB2, 0x8E, 0x6B, 0x3E, 
+0xE2, 0xD8, 0x9A, 0x83, 0x3C, 0xE0, 0xE4, 0xF8, 
+0x31, 0x3F, 0x11, 0x06, 0xF2, 0xDA, 0xCB, 0xB9, 
+0x1F, 0x56, 0x7D, 0xCF, 0x5C, 0x3B, 0x77, 0x50, 
+0xA9, 0x10, 0xA0, 0x30, 0x68, 0x94, 0xA8, 0x70, 
+0x29, 0x30, 0x40, 0x2C, 0xD7, 0x54, 0xB6, 0x58, 
+0xED, 0x45, 0xF7, 
+
+0xD2, 0xC7, 0x6A, 0xEA, 0x75, 0x91, 0x35, 0x3E, 
+0xDB, 0xEE, 0xD0, 0x32, 0xC4, 0x35, 0x67, 0x40, 
+0x1B, 0x22, 0x57, 0xAD, 0xD3, 0xC2, 0x70, 0x6B, 
+0xBF, 0x8A, 0xE7, 0xBA, 0x3F, 0x92, 0x9C, 0xD1, 
+0xA3, 0x44, 0x5A, 0xF8, 0xDF, 0xC6, 0x71, 0x05, 
+0x10, 0x7E, 0x1E, 0x11, 0xB2, 0xA0, 0x06, 0xCC, 
+0xEE, 0xE9, 0xF0, 0xAB, 0xDE, 0xA6, 0x2E, 0x54, 
+0x7D, 0x22, 0x01, 0x37, 0x36, 0xDF, 0x59, 0xAE, 
+0xB8, 0x3A, 0x29, 0xBF, 0x76, 0x14, 0x6B, 0xB1, 
+0x2E, 0xF3, 0x2A, 0x17, 0x4C, 0x15, 0xF4, 0xBE, 
+0x2F, 0x53, 0x7D, 0x2A, 0x62, 0xD6, 0xC5, 0x5A, 
+0x39, 0xD3, 0x87, 0x4D, 0x89, 0x18, 0x1A, 0xB7, 
+0xE7, 0x32, 0x22, 0x22, 0xCE, 0x0D, 0xCD, 0x9B, 
+0x42, 0xD5, 0xC1, 0x28, 0x55, 0x7E, 0x82, 0x74, 
+0x93, 0xF4, 0x48, 0xCD, 0x63, 0x74, 0xCD, 0x96, 
+0x8E, 0xE2, 0x74, 0xFC, 0x25, 0xCB, 0xE2, 0x1E, 
+0xF3, 0xD7, 0x61, 0x80, 
+
+0x1C, 0x36, 0x51, 0xF6, 0x39, 0xED, 0x17, 0xA4, 
+0xEF, 0xB6, 0x2D, 0xC8, 0x61, 0x97, 0x79, 0x20, 
+0x6D, 0x7D, 0xF1, 0x20, 0xFA, 0x7F, 0xA7, 0x78, 
+0x1A, 0xA5, 0x45, 0x0E, 0x31, 0xC4, 0xB3, 0x54, 
+
+0x73, 0x90, 0xB3, 0xFA, 0xF0, 0x26, 0xAB, 0xA8, 
+0xB3, 0xBE, 0x81, 0x4A, 0xFA, 0xC3, 0xEC, 0x17, 
+0xA8, 0xEB, 0x28, 0x84, 0x9C, 0x95, 0x9F, 0x85, 
+0x7C, 0xBD, 0x52, 0x01, 0x86, 0x8B, 0x84, 0x7C, 
+0x55, 
+
+0xDE, 0x11, 0x4F, 0x7E, 0x9C, 0xD2, 0x30, 0x86, 
+0x0D, 0x21, 0x93, 0x29, 0x01, 0xD0, 0xDE, 0x46, 
+0x07, 0x51, 0xC3, 0x82, 0x8F, 0x0A, 0x74, 0x1F, 
+0x6B, 0x1A, 0x62, 0x27, 0xE7, 0x37, 0x76, 0xA8, 
+0xAC, 0x15, 
+
+0x51, 0xBE, 0xE4, 0xDE, 0xCE, 0xEE, 0x30, 0xDC, 
+0xFA, 0x0B, 0x3F, 0x93, 0xB6, 0x1A, 0x7B, 0x49, 
+0xBA, 0xB2, 0xA5, 0xEA, 0x39, 0xF3, 0x9F, 0x9E, 
+0x96, 0x70, 0xFE, 0xFF, 0x0C, 0x1C, 0x25, 0x47, 
+0x97, 0xE7, 0x60, 
+
+0x38, 0x23, 0xE6, 0xB0, 0xA9, 0x94, 0x02, 0xC7, 
+0x6C, 0xDF, 0xE0, 0xBA, 0x74, 0x71, 0x8C, 0x81, 
+0x89, 0x89, 0x16, 0xA5, 0x7A, 0x79, 0xB6, 0xF0, 
+0x2F, 0xFA, 0xF5, 0xD5, 0xB9, 0x0E, 0xE0, 0x55, 
+0x2F, 0xAA, 0xFE, 0xA2, 
+
+0x92, 0x79, 0xBA, 0x91, 0x61, 0xFD, 0x4D, 0x07, 
+0x93, 0xE0, 0x92, 0x42, 0x04, 0xD1, 0x72, 0xC5, 
+0x39, 0x56, 0x97, 0xF4, 0xFF, 0xC9, 0x2C, 0xB6, 
+0x56, 0x54, 0xBC, 0xB6, 0x59, 0xD2, 0xC7, 0x03, 
+0x39, 0xB4, 0xF9, 0xF0, 0x58, 
+
+0x04, 0x70, 0x1F, 0xED, 0xD5, 0x86, 0x14, 0x69, 
+0x41, 0xB3, 0x0C, 0xAB, 0x67, 0x0A, 0x53, 0x22, 
+0xFD, 0x66, 0xB9, 0x17, 0x46, 0x35, 0xB1, 0x47, 
+0xEF, 0x50, 0xCE, 0xA9, 0xBF, 0x8D, 0x34, 0xB0, 
+0x18, 0x9B, 0xB5, 0xF9, 0xDA, 0xCF, 
+
+0xF8, 0x7B, 0xD0, 0x63, 0x51, 0x85, 0xC4, 0x33, 
+0xB7, 0x79, 0xA8, 0x03, 0x45, 0x2F, 0xA2, 0x25, 
+0x04, 0xBE, 0x84, 0xD8, 0xDC, 0x43, 0xF2, 0xE1, 
+0x48, 0xD4, 0x4C, 0x39, 0x9B, 0xF2, 0xF4, 0xD6, 
+0x7C, 0xB9, 0x5D, 0xB5, 0x80, 0x89, 0x6F, 
+
+0x4D, 0xBA, 0x94, 0x47, 0x4D, 0x49, 0x45, 0x9D, 
+0x59, 0xC3, 0x73, 0x35, 0x34, 0x53, 0x1F, 0x7C, 
+0x71, 0x1B, 0x6F, 0x84, 0x15, 0x3A, 0xE5, 0xC2, 
+0x2B, 0x5E, 0xB6, 0x34, 0x9D, 0x74, 0xB3, 0xDB, 
+0xDA, 0x9F, 0x56, 0xA3, 0x48, 0xBD, 0x96, 0x4D, 
+
+0x76, 0x9A, 0xE6, 0x67, 0x35, 0x27, 0x01, 0xCB, 
+0x90, 0xC0, 0x20, 0xE3, 0x44, 0x74, 0x6C, 0x30, 
+0xD3, 0x94, 0xB0, 0x42, 0xB0, 0xC8, 0xB2, 0xD8, 
+0x88, 0x43, 0x70, 0x37, 0x4B, 0x02, 0xD2, 0x3D, 
+0x67, 0x9F, 0x5C, 0xEC, 0xF9, 0xB7, 0xF7, 0xCC, 
+0x8E, 
+
+0xAE, 0x79, 0x0F, 0xA0, 0x7C, 0x78, 0x72, 0x63, 
+0x5D, 0xC6, 0xD6, 0xE0, 0x8A, 0x5B, 0x7E, 0x7B, 
+0x70, 0xED, 0x2C, 0x6A, 0xA1, 0xF8, 0xA5, 0xD5, 
+0xA9, 0xD3, 0xD5, 0x53, 0x32, 0x94, 0xC3, 0xB6, 
+0x11, 0x33, 0x39, 0x21, 0x37, 0xC1, 0x57, 0xBC, 
+0x34, 0x29, 
+
+0xF7, 0x6D, 0xB8, 0x34, 0xBB, 0x08, 0xA0, 0x3A, 
+0x11, 0xB4, 0x89, 0x20, 0xC4, 0x99, 0xBC, 0xDE, 
+0xE0, 0xFA, 0x86, 0x15, 0x15, 0xF2, 0x38, 0x13, 
+0xC7, 0x2E, 0xF2, 0x6B, 0xEF, 0x3D, 0x33, 0xB8, 
+0x91, 0x35, 0x22, 0x3E, 0xA0, 0x48, 0x0A, 0x64, 
+0xEB, 0x81, 0x1D, 
+
+0xA4, 0x6D, 0xFF, 0x11, 0xB7, 0xB0, 0x1B, 0x8E, 
+0xF0, 0xBD, 0xB6, 0x8C, 0xFA, 0xC8, 0x6C, 0xD6, 
+0x22, 0x22, 0xFC, 0x70, 0xD6, 0xD3, 0x45, 0x48, 
+0x99, 0x03, 0xB7, 0xCC, 0x71, 0xB4, 0xC7, 0x62, 
+0xDA, 0xAA, 0xD9, 0x4B, 0x8A, 0xFB, 0x28, 0xCB, 
+0xC4, 0xDF, 0x0F, 0x49, 
+
+0x6A, 0x4B, 0x09, 0x9A, 0x61, 0x77, 0x97, 0x2A, 
+0x97, 0xE9, 0x85, 0x78, 0x32, 0x59, 0xCF, 0x22, 
+0x2B, 0x43, 0x9F, 0x94, 0xBC, 0x7B, 0xB5, 0x94, 
+0x11, 0x36, 0x8D, 0x51, 0xA2, 0x45, 0x00, 0x7B, 
+0x27, 0x1F, 0xCF, 0x53, 0xC1, 0x6A, 0x8E, 0x21, 
+0xE8, 0x6D, 0xAB, 0x2D, 0x83, 
+
+0xE1, 0x6E, 0xE7, 0x45, 0xD5, 0x84, 0xDF, 0xF9, 
+0xF8, 0x82, 0x8D, 0x62, 0xDF, 0x9D, 0x1E, 0xE1, 
+0x60, 0xC2, 0x21, 0x27, 0x78, 0xF4, 0xC6, 0xFA, 
+0x48, 0x05, 0xD7, 0x33, 0x1E, 0xA8, 0x05, 0x27, 
+0x8B, 0x35, 0xEA, 0x22, 0x9F, 0x75, 0x18, 0x1D, 
+0x96, 0xDE, 0x9E, 0xED, 0x5C, 0x4B, 
+
+0x3B, 0x4D, 0x28, 0xE5, 0x3F, 0xA0, 0x73, 0x97, 
+0xF4, 0xC6, 0x4E, 0x77, 0x3E, 0x40, 0x0A, 0x04, 
+0xD6, 0x52, 0x0E, 0xC1, 0x4F, 0x15, 0x60, 0x40, 
+0x5C, 0x33, 0x48, 0xDC, 0x18, 0xB7, 0x51, 0x36, 
+0x62, 0xA7, 0x4D, 0x33, 0x41, 0xD4, 0xAE, 0xFC, 
+0xD4, 0x1F, 0x58, 0xA7, 0x37, 0xCE, 0x3A, 
+
+0xFC, 0xC2, 0xD9, 0xFB, 0x3E, 0x10, 0x81, 0x73, 
+0x3E, 0x0A, 0x73, 0xE7, 0x4F, 0x82, 0xE9, 0x4D, 
+0xB3, 0xAD, 0x98, 0x22, 0x96, 0xCE, 0xCD, 0xF2, 
+0x2A, 0x9E, 0x08, 0xC1, 0x32, 0x3F, 0xCE, 0x4B, 
+0x8F, 0xEC, 0x23, 0xAE, 0xED, 0x4C, 0x94, 0x31, 
+0xB8, 0x13, 0xF0, 0xD2, 0xC2, 0xAE, 0x76, 0xEE, 
+
+0x6F, 0x12, 0xF7, 0xF1, 0xEA, 0x18, 0x65, 0xE9, 
+0x64, 0x62, 0x54, 0xAE, 0x46, 0x4C, 0xB8, 0xEB, 
+0x9C, 0x76, 0x91, 0xEB, 0x34, 0x49, 0x62, 0xF0, 
+0x32, 0x20, 0x59, 0xBD, 0xE3, 0xBF, 0x84, 0xAA, 
+0xF6, 0x87, 0x23, 0xC1, 0xC2, 0xAA, 0xBF, 0xF7, 
+0xD2, 0x33, 0xC0, 0x91, 0x70, 0xAA, 0x7B, 0x91, 
+0x56, 
+
+0x6E, 0xAA, 0x15, 0x71, 0x08, 0x6A, 0xFA, 0x28, 
+0xF4, 0x36, 0xD0, 0x59, 0xAB, 0xD2, 0xC5, 0xB5, 
+0x56, 0xE7, 0x3D, 0xEA, 0x99, 0x28, 0xD9, 0xE2, 
+0x07, 0x08, 0x98, 0x1D, 0xA3, 0xA1, 0x3C, 0xDD, 
+0xAB, 0x7A, 0x61, 0x1B, 0x9B, 0x58, 0xCA, 0x03, 
+0xD6, 0xD5, 0xC3, 0x2B, 0x5F, 0x59, 0xD6, 0xBB, 
+0xA0, 0x59, 
+
+0x14, 0xB8, 0x2C, 0xF8, 0x4B, 0x42, 0x4A, 0x12, 
+0xC5, 0x01, 0x1A, 0x40, 0x62, 0xE5, 0xE6, 0x2D, 
+0x0E, 0xE4, 0x33, 0x03, 0xA9, 0xC8, 0x0C, 0x17, 
+0x88, 0x38, 0x2B, 0xFE, 0x81, 0x22, 0x32, 0x09, 
+0xCC, 0x64, 0x6B, 0x8B, 0x0A, 0xE2, 0x32, 0x00, 
+0xAE, 0x75, 0x73, 0xEE, 0x67, 0x3E, 0x07, 0x3B, 
+0x60, 0x78, 0x59, 
+
+0x9C, 0x18, 0x49, 0x3B, 0x88, 0xD2, 0x9C, 0xB8, 
+0x4B, 0xAB, 0xD6, 0x37, 0x45, 0x63, 0x93, 0xE6, 
+0xA0, 0x1D, 0xD4, 0x64, 0x24, 0xF9, 0xD2, 0xC0, 
+0xB2, 0xF9, 0xA4, 0x19, 0x1F, 0x4B, 0xFF, 0xB5, 
+0x61, 0x98, 0xFC, 0x77, 0xF4, 0xC9, 0x66, 0xC0, 
+0x34, 0x0A, 0xC6, 0xBF, 0xCA, 0xDE, 0x60, 0xA8, 
+0xD9, 0xC2, 0x9E, 0xE0, 
+
+0xA3, 0x13, 0x9D, 0x93, 0x67, 0x35, 0x80, 0x62, 
+0x4E, 0x71, 0xF1, 0xE1, 0x16, 0x1E, 0x53, 0x58, 
+0x02, 0x4D, 0x3E, 0x99, 0xA1, 0x9B, 0xD2, 0x8F, 
+0x38, 0x21, 0x42, 0xD0, 0x34, 0xFC, 0x0D, 0x4A, 
+0xAB, 0xD2, 0x1D, 0x11, 0x74, 0x2E, 0xF9, 0x66, 
+0xCF, 0x74, 0x0B, 0x4F, 0x4F, 0xC4, 0x6A, 0x1D, 
+0x33, 0xBE, 0x70, 0xDC, 0xD6, 
+
+0xF5, 0xFC, 0x39, 0x16, 0xD3, 0xF3, 0x08, 0xCB, 
+0xA7, 0xD9, 0x34, 0x13, 0x0D, 0xBA, 0x7C, 0x79, 
+0x84, 0x8D, 0x10, 0x48, 0x3C, 0xA2, 0xE0, 0x78, 
+0x38, 0x38, 0x19, 0x71, 0x0D, 0x81, 0x28, 0x39, 
+0x26, 0x2F, 0x2E, 0xF3, 0x47, 0xAA, 0x1D, 0x60, 
+0x44, 0xC1, 0xBA, 0x20, 0x56, 0x45, 0x7A, 0x24, 
+0x38, 0xC3, 0x06, 0xC8, 0x84, 0xDF, 
+
+0x7C, 0x49, 0xDF, 0xB9, 0xA2, 0x28, 0x2A, 0xE2, 
+0x1B, 0x0B, 0x8A, 0x76, 0x44, 0x74, 0xAE, 0xCE, 
+0x6D, 0xB3, 0x34, 0xCD, 0x0A, 0x21, 0xFD, 0x60, 
+0x62, 0xC2, 0xAF, 0x77, 0xE9, 0xEA, 0xD6, 0x9C, 
+0xB7, 0x80, 0x98, 0x8A, 0xB8, 0xEF, 0x87, 0xF1, 
+0xFD, 0x4E, 0x9C, 0xDA, 0x54, 0x5D, 0xB8, 0x4E, 
+0xC6, 0x7A, 0x49, 0xED, 0x96, 0xE1, 0x58, 
+
+0x28, 0x2F, 0x70, 0x5E, 0x74, 0x5C, 0xB3, 0x3F, 
+0x1E, 0x14, 0x5E, 0x5C, 0xD7, 0x86, 0xE7, 0x72, 
+0xFE, 0xCA, 0x1F, 0xC0, 0x28, 0xFF, 0xDE, 0x35, 
+0xA5, 0x59, 0xEE, 0xE8, 0x62, 0x47, 0xC6, 0xD0, 
+0xC4, 0xF5, 0x6B, 0xEE, 0xC7, 0x73, 0xC4, 0x91, 
+0xD8, 0xED, 0x3E, 0x97, 0x65, 0xA2, 0xB3, 0xD5, 
+0x3E, 0xA3, 0xBF, 0xCE, 0xBE, 0xDE, 0x3A, 0x78, 
+
+0xC5, 0x18, 0xCC, 0x6B, 0x38, 0xD0, 0x22, 0xF2, 
+0x83, 0xC9, 0x81, 0x43, 0x90, 0x7A, 0x06, 0x22, 
+0xA9, 0xDC, 0x2C, 0xB5, 0xE5, 0xBD, 0x1A, 0x46, 
+0x27, 0x7B, 0xF2, 0x11, 0x0C, 0xD8, 0x4F, 0xBD, 
+0xFA, 0xA2, 0x8A, 0xBE, 0xC9, 0x2D, 0xAD, 0x89, 
+0x60, 0x5A, 0x56, 0xAB, 0x57, 0xC4, 0x70, 0x1C, 
+0x9D, 0x95, 0x36, 0x02, 0x50, 0xE8, 0x9D, 0x94, 
+0x1E, 
+
+0x65, 0x2C, 0x0C, 0x46, 0x5A, 0x31, 0x54, 0x29, 
+0x09, 0xB6, 0xA0, 0x0E, 0xB2, 0x01, 0x67, 0xD4, 
+0x3F, 0x40, 0x5F, 0x8F, 0xEE, 0x15, 0x6C, 0x8C, 
+0x70, 0x6C, 0xD2, 0x4A, 0x2E, 0xC1, 0xC0, 0xED, 
+0x63, 0x91, 0xCF, 0x1D, 0xFD, 0x29, 0x69, 0xFA, 
+0x06, 0x7C, 0xA1, 0x23, 0xB7, 0x96, 0xE9, 0x2E, 
+0xC5, 0x46, 0xB5, 0xCF, 0xE9, 0xCC, 0x6A, 0x29, 
+0x08, 0xB3, 
+
+0xEB, 0x5C, 0x85, 0xE8, 0x88, 0xBB, 0x7C, 0x6B, 
+0x1E, 0xDE, 0x42, 0x49, 0x8F, 0x31, 0x38, 0xD0, 
+0xC3, 0xD3, 0x93, 0xB4, 0x1C, 0x1B, 0x73, 0xF2, 
+0xB9, 0x8A, 0x82, 0xAE, 0x50, 0x13, 0x28, 0x54, 
+0xCF, 0x6E, 0x72, 0x34, 0x34, 0x5C, 0x59, 0x64, 
+0x5F, 0x26, 0x82, 0x61, 0xE9, 0x28, 0x54, 0x49, 
+0xB4, 0x84, 0x12, 0x58, 0x4C, 0xB2, 0x1C, 0x6F, 
+0x07, 0x2E, 0x7F, 
+
+0x16, 0xAB, 0x46, 0x3A, 0x95, 0xD8, 0xE8, 0x75, 
+0x3E, 0x01, 0xC0, 0xF5, 0x7C, 0xCD, 0x41, 0x06, 
+0x15, 0xDF, 0xDC, 0x0D, 0x1B, 0xE3, 0xAB, 0x0E, 
+0x08, 0x0E, 0x79, 0x40, 0xDA, 0x0D, 0x4A, 0xF1, 
+0xBD, 0x8B, 0xDA, 0x96, 0xB5, 0x7F, 0xDF, 0x6A, 
+0xDB, 0x83, 0x77, 0x8B, 0x1A, 0x89, 0xB3, 0x36, 
+0x4E, 0x15, 0x9E, 0x8F, 0x5D, 0x2A, 0x3F, 0xEB, 
+0xE0, 0x3C, 0x3C, 0x55, 
+
+0x7C, 0x54, 0xFC, 0x22, 0xD2, 0xF0, 0x5D, 0xEE, 
+0xAF, 0x44, 0xFE, 0x39, 0xE4, 0x0F, 0xB9, 0xD9, 
+0x34, 0xBE, 0x6B, 0x80, 0xAC, 0x2D, 0xEB, 0x83, 
+0xA2, 0x0F, 0x91, 0x4E, 0x7D, 0x3C, 0x84, 0x89, 
+0xD6, 0x3C, 0xD1, 0x54, 0xFE, 0x48, 0xA3, 0x6B, 
+0x9A, 0x30, 0x7B, 0xBF, 0x91, 0x8F, 0xD3, 0xC2, 
+0xA4, 0x94, 0x05, 0xB1, 0x31, 0xA6, 0x6E, 0x07, 
+0x62, 0x3E, 0xB8, 0x27, 0xDB, 
+
+0x28, 0xAC, 0x5F, 0x50, 0x1E, 0xBD, 0xA1, 0x7B, 
+0x90, 0x29, 0xC2, 0x90, 0xDC, 0x54, 0xD0, 0x89, 
+0x69, 0x2E, 0x43, 0x9F, 0xB2, 0x2C, 0x47, 0x6D, 
+0x0C, 0x8E, 0x7A, 0x20, 0x9A, 0x82, 0x20, 0xBB, 
+0x7E, 0x37, 0x98, 0x19, 0x96, 0xB5, 0xB3, 0xA9, 
+0xFA, 0x1B, 0x44, 0x9A, 0x3A, 0xC1, 0xD2, 0x70, 
+0xD3, 0x7E, 0xB1, 0xD0, 0x00, 0xF9, 0xF6, 0xE5, 
+0xF4, 0x5C, 0xDF, 0xA6, 0xFC, 0xDD, 
+
+0x48, 0x5D, 0x59, 0x3E, 0x13, 0x02, 0x1B, 0x05, 
+0x07, 0xE0, 0x25, 0x6E, 0x25, 0xB3, 0x72, 0xDD, 
+0x79, 0x54, 0x00, 0x57, 0xD4, 0x38, 0x6B, 0xF5, 
+0xCD, 0xBD, 0x0D, 0x89, 0x41, 0x17, 0x4A, 0x7A, 
+0x60, 0x4A, 0x29, 0xE9, 0x0D, 0xC2, 0xCA, 0xB8, 
+0x94, 0x63, 0x33, 0x5A, 0xEA, 0x85, 0x0C, 0x01, 
+0xE8, 0x47, 0xA0, 0x81, 0xBF, 0x2C, 0x01, 0x5A, 
+0xF0, 0xEF, 0x30, 0x91, 0xA6, 0x2C, 0x83, 
+
+0x85, 0xBB, 0xF7, 0xD9, 0x5B, 0x92, 0x2B, 0x64, 
+0xE0, 0x49, 0x47, 0x91, 0xE5, 0xA1, 0x05, 0x5C, 
+0xD6, 0x1E, 0x67, 0x5A, 0x25, 0x33, 0xA3, 0xFF, 
+0x28, 0x43, 0xAE, 0x3A, 0x74, 0x68, 0xB6, 0x9E, 
+0xD2, 0x90, 0xC7, 0xEB, 0x12, 0xB2, 0xDD, 0xC8, 
+0xC5, 0x61, 0xE4, 0x49, 0xE3, 0xF7, 0x2F, 0x91, 
+0x03, 0xEA, 0xA0, 0x08, 0xEC, 0x95, 0x10, 0x7F, 
+0x38, 0x8B, 0x61, 0x15, 0x45, 0x24, 0x2C, 0x41, 
+
+0x60, 0xE5, 0x6C, 0x94, 0x3D, 0x26, 0x0A, 0x43, 
+0x38, 0x12, 0x75, 0x94, 0xFA, 0xB9, 0x99, 0x0A, 
+0xAC, 0x1F, 0xD0, 0x37, 0xE1, 0x00, 0xE4, 0xF1, 
+0x3A, 0xC6, 0x81, 0x14, 0xC6, 0xFE, 0xA0, 0xCE, 
+0xC9, 0xBA, 0x0C, 0x7F, 0xDA, 0x81, 0xE0, 0xC1, 
+0x49, 0x25, 0x01, 0x2F, 0x31, 0xAD, 0xED, 0xA2, 
+0xD4, 0xFD, 0x9A, 0x2B, 0x3B, 0xF9, 0xA3, 0x6A, 
+0x7E, 0x91, 0xD8, 0x31, 0x69, 0xC9, 0x5C, 0xDB, 
+0xBF, 
+
+0x65, 0x7B, 0xE9, 0xAF, 0xCE, 0xFD, 0x3D, 0x96, 
+0x50, 0x9E, 0x47, 0x0C, 0x38, 0x42, 0x10, 0x06, 
+0x98, 0x89, 0x63, 0x1A, 0xDD, 0xF4, 0xF4, 0x49, 
+0xE8, 0xDE, 0x96, 0x73, 0x61, 0x89, 0xC2, 0x70, 
+0x5E, 0xB1, 0x6E, 0xFE, 0x69, 0x1A, 0xCF, 0x5C, 
+0x9B, 0x47, 0x48, 0x75, 0xE7, 0x76, 0xA8, 0x5A, 
+0x42, 0x4D, 0x8D, 0x96, 0xC5, 0xAF, 0xD3, 0x55, 
+0x23, 0xBE, 0x24, 0x73, 0x68, 0xFB, 0xBE, 0x92, 
+0x1E, 0xBD, 
+
+0x95, 0xB8, 0x34, 0x66, 0xB6, 0xE4, 0xB0, 0x12, 
+0xE0, 0xEB, 0xE9, 0x0D, 0xA3, 0x9F, 0x7D, 0xAF, 
+0xED, 0x88, 0x8F, 0xAB, 0xB9, 0xD7, 0x7C, 0xCC, 
+0x70, 0xB2, 0xBD, 0xD9, 0x50, 0xFF, 0x4F, 0x80, 
+0x0C, 0xC3, 0x2E, 0x84, 0x0C, 0x11, 0xAD, 0x13, 
+0xBC, 0xCA, 0x71, 0x08, 0xB9, 0xF7, 0x54, 0x9B, 
+0x56, 0x81, 0xD1, 0x65, 0xE1, 0xAB, 0xF4, 0x94, 
+0x7D, 0xA3, 0x5E, 0x0E, 0x69, 0x63, 0xBB, 0xCA, 
+0xC5, 0x5A, 0xD1, 
+
+0xD0, 0x13, 0xD9, 0x54, 0x43, 0x6B, 0x37, 0xE5, 
+0x3C, 0x48, 0xA7, 0x24, 0x30, 0x77, 0x15, 0xBB, 
+0xAA, 0xCC, 0x70, 0xED, 0xEB, 0x66, 0x91, 0x8F, 
+0xFF, 0x4C, 0x5B, 0xEE, 0x83, 0xDD, 0xF4, 0x68, 
+0x9B, 0x6D, 0x5C, 0x52, 0x62, 0x02, 0x7C, 0x15, 
+0x65, 0x3F, 0x2B, 0x1F, 0xD6, 0x8B, 0xF9, 0x1B, 
+0x52, 0x58, 0x01, 0xA0, 0x79, 0xEE, 0xE7, 0xFC, 
+0x4A, 0x9C, 0x31, 0xB9, 0xA1, 0xCD, 0x82, 0x43, 
+0x27, 0x69, 0x37, 0xC3, 
+
+0x7B, 0x30, 0xC2, 0x4D, 0x57, 0x0A, 0x1B, 0x26, 
+0x81, 0x57, 0x02, 0xEC, 0xCC, 0x75, 0xEC, 0x43, 
+0xF8, 0xD1, 0xF9, 0x33, 0x28, 0xB4, 0xF2, 0x9D, 
+0x1A, 0xE9, 0xBF, 0xC9, 0x03, 0x10, 0x20, 0x6E, 
+0xC3, 0x9D, 0x53, 0x4F, 0x6C, 0x25, 0xCF, 0x7F, 
+0xF6, 0x74, 0xB9, 0xDA, 0xE8, 0x65, 0x97, 0x1B, 
+0xDA, 0x7D, 0xB5, 0x5A, 0xDC, 0x3B, 0x4A, 0x5D, 
+0x94, 0x26, 0xE1, 0x6A, 0x9B, 0xF5, 0x4B, 0x90, 
+0x23, 0xD9, 0xB8, 0x18, 0x4A, 
+
+0x6E, 0x06, 0x64, 0x74, 0x07, 0x70, 0x96, 0x48, 
+0x95, 0xF5, 0x6E, 0x69, 0x93, 0x30, 0x3D, 0xB8, 
+0x2F, 0x33, 0x90, 0x16, 0x23, 0xAD, 0x3B, 0xBC, 
+0x80, 0x88, 0x6F, 0x01, 0x9C, 0x52, 0x1F, 0x21, 
+0xC1, 0x9C, 0xE9, 0x1D, 0x02, 0xD8, 0xE8, 0xE1, 
+0x75, 0xE7, 0x7B, 0x83, 0x6B, 0x3C, 0x40, 0xC4, 
+0xF1, 0x48, 0x11, 0x94, 0xFC, 0x49, 0x99, 0x36, 
+0x47, 0x28, 0x1E, 0x97, 0x1F, 0x01, 0xE4, 0x62, 
+0xA8, 0xCA, 0xD1, 0x84, 0xEF, 0xEE, 
+
+0x73, 0x76, 0xD9, 0x03, 0x3E, 0xF7, 0x0D, 0xFF, 
+0x62, 0x60, 0x34, 0x43, 0x76, 0xB5, 0x36, 0xD3, 
+0x3D, 0xE1, 0x50, 0x82, 0x99, 0x79, 0xF3, 0xB6, 
+0x6E, 0x83, 0x03, 0x96, 0x67, 0xC0, 0xD2, 0xA2, 
+0xD2, 0x97, 0x12, 0x1E, 0x13, 0x88, 0xC7, 0x6A, 
+0x97, 0x20, 0x8A, 0x2E, 0xE6, 0x7F, 0x17, 0x5F, 
+0x37, 0xF8, 0x9D, 0x8E, 0xE0, 0x3D, 0xBE, 0x5C, 
+0xAC, 0x3A, 0x84, 0xD9, 0x69, 0x7F, 0xF6, 0x8D, 
+0xFE, 0xAF, 0xFB, 0x82, 0x48, 0x90, 0xBD, 
+
+0x53, 0xE1, 0x62, 0xFC, 0x7A, 0x87, 0x3C, 0x60, 
+0x4E, 0x29, 0xDD, 0xC1, 0xCF, 0xCF, 0xE6, 0x82, 
+0x28, 0xEB, 0x35, 0xB3, 0xC0, 0x21, 0xBF, 0x7F, 
+0x93, 0x7F, 0x8A, 0xF1, 0x34, 0x16, 0x79, 0x24, 
+0xC0, 0x27, 0xD0, 0xA1, 0x89, 0x24, 0x82, 0x51, 
+0x02, 0x68, 0x49, 0xD5, 0xDE, 0x4E, 0x8D, 0xB6, 
+0xC2, 0x4A, 0x48, 0xF4, 0xC3, 0x0F, 0x9C, 0x5F, 
+0x00, 0xCC, 0x1C, 0xF3, 0x9A, 0xDB, 0x66, 0x52, 
+0x71, 0xE6, 0xE5, 0x15, 0x51, 0xB5, 0x0B, 0xC0, 
+
+0xC6, 0x59, 0xFC, 0x58, 0xEA, 0xD5, 0x56, 0xD8, 
+0x9A, 0x22, 0xF3, 0x5E, 0x16, 0xF5, 0xAB, 0x06, 
+0x8E, 0xA5, 0x69, 0xC5, 0x85, 0xDF, 0xAF, 0x85, 
+0x4B, 0xE9, 0xD1, 0xEA, 0x09, 0xE4, 0x24, 0x3B, 
+0x3A, 0x9A, 0x4A, 0x21, 0xA2, 0xCF, 0x2F, 0x1D, 
+0x64, 0x08, 0xB9, 0x39, 0x0D, 0x66, 0xF9, 0xB8, 
+0xDC, 0x32, 0x62, 0x56, 0xC9, 0xB4, 0xAB, 0x28, 
+0x23, 0xC6, 0x8B, 0x85, 0xF4, 0x64, 0xCB, 0x8B, 
+0x14, 0x89, 0x78, 0xA5, 0x6F, 0x4A, 0xC6, 0x71, 
+0x3A, 
+
+0x07, 0x6D, 0x60, 0x0A, 0x21, 0x9A, 0x05, 0x80, 
+0x77, 0x98, 0xE8, 0x61, 0x5E, 0x2F, 0xB7, 0x99, 
+0x86, 0xAB, 0xFF, 0xD4, 0xE7, 0xB5, 0xAE, 0x98, 
+0x1B, 0xBF, 0x73, 0x1C, 0x5B, 0x4E, 0xDA, 0x0F, 
+0x8D, 0xCB, 0xA3, 0x16, 0x6B, 0xA1, 0x93, 0x89, 
+0x9F, 0x99, 0x6B, 0xAA, 0xF3, 0x44, 0x55, 0xD6, 
+0xFF, 0xF3, 0x38, 0x8A, 0xD4, 0x17, 0x5E, 0x52, 
+0x68, 0x40, 0xE9, 0x02, 0xC1, 0x2B, 0x2D, 0x38, 
+0x78, 0xEB, 0xF2, 0xD4, 0xBC, 0xF6, 0xC7, 0xA6, 
+0xFB, 0xC6, 
+
+0x93, 0xF3, 0x1F, 0xEA, 0x39, 0x94, 0x07, 0x50, 
+0x79, 0x84, 0x28, 0xC4, 0x7A, 0xC7, 0x20, 0x96, 
+0xA9, 0x23, 0x7B, 0xE9, 0x00, 0x3C, 0x12, 0xC2, 
+0xD5, 0x2B, 0xDF, 0xFE, 0x3A, 0x3A, 0xF6, 0x71, 
+0xF6, 0xE2, 0x40, 0x33, 0x89, 0x55, 0x79, 0xE8, 
+0xF9, 0x1A, 0x13, 0x14, 0x21, 0x8A, 0x9F, 0xDE, 
+0x07, 0xF4, 0x23, 0x98, 0x31, 0xF3, 0x24, 0x5A, 
+0x50, 0x27, 0xD5, 0x86, 0xA2, 0x4B, 0x61, 0x87, 
+0x73, 0x18, 0xBF, 0x8F, 0x77, 0x0B, 0x5B, 0x6E, 
+0xE1, 0x08, 0xBE, 
+
+0xF6, 0xDC, 0xC2, 0xAA, 0x9F, 0xB3, 0x7A, 0x04, 
+0xBB, 0x69, 0x1B, 0x66, 0x19, 0x13, 0xE4, 0x21, 
+0x5E, 0x40, 0xF7, 0xD8, 0xC6, 0x45, 0xF4, 0xAA, 
+0x51, 0xE4, 0x40, 0x96, 0x2A, 0x35, 0x24, 0xEE, 
+0x9D, 0xE8, 0x6E, 0x24, 0x29, 0x8E, 0x01, 0x83, 
+0x49, 0x8C, 0x1E, 0xA4, 0x71, 0x81, 0xB9, 0x03, 
+0x81, 0x63, 0x4E, 0x6E, 0xD7, 0xF3, 0x8A, 0xCE, 
+0x39, 0x68, 0xFF, 0xDF, 0xC3, 0x0A, 0x92, 0x2C, 
+0x45, 0xB3, 0x35, 0x4C, 0xC0, 0x8A, 0xCF, 0xF6, 
+0xF2, 0xC7, 0xC7, 0xE0, 
+
+0x82, 0xFC, 0xD0, 0x86, 0xBB, 0x00, 0xC1, 0x0C, 
+0x30, 0x38, 0x33, 0x78, 0x95, 0x6E, 0xB8, 0x66, 
+0xB7, 0xD6, 0xDC, 0xF1, 0x5B, 0x37, 0x32, 0xA1, 
+0x4E, 0xFC, 0x08, 0x8B, 0x11, 0xB5, 0x35, 0x77, 
+0xE0, 0x0F, 0xE2, 0xE7, 0x25, 0xF7, 0x60, 0xDB, 
+0xB5, 0x61, 0xDF, 0x1E, 0x62, 0xEF, 0x9C, 0x99, 
+0xD6, 0x04, 0x83, 0xDA, 0x0A, 0xEC, 0x84, 0x32, 
+0x5C, 0xE6, 0xFE, 0x1D, 0xD9, 0x81, 0xDC, 0x2D, 
+0x7F, 0xBA, 0x18, 0xA6, 0x16, 0x61, 0x2A, 0x9A, 
+0x20, 0xFD, 0x09, 0x2D, 0x4D, 
+
+0x37, 0x3F, 0x16, 0x90, 0x0E, 0x1A, 0x9B, 0x2F, 
+0xEA, 0xB5, 0x05, 0x2B, 0xFD, 0x2C, 0xFD, 0x44, 
+0x49, 0x69, 0xBE, 0x6A, 0x36, 0xA2, 0x72, 0x28, 
+0x5F, 0xAE, 0x87, 0x4B, 0xB2, 0xAE, 0xA0, 0x12, 
+0xAA, 0x56, 0xDF, 0x85, 0x68, 0xA5, 0x13, 0x19, 
+0xC2, 0x12, 0x24, 0x0D, 0xF5, 0xD8, 0x7D, 0xFC, 
+0x1B, 0x69, 0xBE, 0x16, 0x95, 0x78, 0x41, 0xFF, 
+0x99, 0x03, 0x56, 0x53, 0xD1, 0x7A, 0x01, 0xEF, 
+0xDA, 0xDD, 0xDC, 0xC1, 0x8F, 0x03, 0xC6, 0xB3, 
+0x82, 0xFF, 0x7A, 0x4E, 0x90, 0xBD, 
+
+0xFE, 0xE0, 0x8A, 0x58, 0xB6, 0x4B, 0xB0, 0x35, 
+0xA5, 0xF1, 0x86, 0x28, 0x88, 0xBD, 0x3B, 0x15, 
+0xFF, 0xF2, 0x28, 0x7F, 0x0B, 0xB4, 0xD4, 0x23, 
+0xBE, 0xA1, 0x47, 0xA1, 0x47, 0xED, 0x7E, 0x05, 
+0x8E, 0x55, 0xD8, 0x61, 0xD6, 0x2C, 0x31, 0x86, 
+0xA5, 0x87, 0x80, 0x1D, 0xD1, 0x64, 0x09, 0xBF, 
+0x8A, 0x0C, 0x88, 0x4E, 0x65, 0x9F, 0x74, 0x3D, 
+0x49, 0xA3, 0x62, 0x5F, 0x8F, 0x08, 0x56, 0x62, 
+0x98, 0x8C, 0x5E, 0xE3, 0x72, 0xFF, 0x05, 0xF6, 
+0x37, 0xD0, 0xD7, 0x01, 0x49, 0xA0, 0xB3, 
+
+0x0E, 0x19, 0x57, 0x31, 0x5F, 0x45, 0x32, 0x5A, 
+0x4E, 0x20, 0xF8, 0x94, 0x3B, 0x4F, 0x9A, 0x0B, 
+0x0B, 0xA0, 0x52, 0xA1, 0xF8, 0xF6, 0xA9, 0xED, 
+0x66, 0x87, 0xA1, 0x23, 0xCD, 0x1C, 0x1C, 0x4D, 
+0x4C, 0x06, 0xBE, 0xAC, 0x24, 0xB1, 0xE1, 0x42, 
+0x6F, 0xE1, 0x99, 0xE7, 0x63, 0xA3, 0x19, 0x67, 
+0xAB, 0x3F, 0xC0, 0xE1, 0x0D, 0xC2, 0x7A, 0x5B, 
+0xE3, 0xB3, 0xF9, 0xEF, 0x12, 0xEE, 0x51, 0x47, 
+0x38, 0x96, 0x5C, 0xD0, 0x69, 0x27, 0x85, 0x19, 
+0x08, 0x7F, 0x23, 0x75, 0x08, 0x43, 0x55, 0x65, 
+
+0x94, 0xED, 0x3F, 0x16, 0x6A, 0x95, 0x25, 0x66, 
+0xB3, 0x37, 0x87, 0x29, 0x4B, 0xB8, 0x21, 0x11, 
+0xBE, 0xBD, 0x5F, 0xA1, 0x2C, 0xC1, 0x5B, 0x6F, 
+0xFD, 0x91, 0xB0, 0x28, 0x49, 0x54, 0x1E, 0xEA, 
+0x20, 0xB8, 0xC5, 0x1D, 0x5C, 0xEA, 0xE0, 0x67, 
+0xE3, 0xF5, 0x50, 0x75, 0x0D, 0xB7, 0x40, 0x00, 
+0xEC, 0x5E, 0x1F, 0x65, 0x91, 0x8E, 0x88, 0x67, 
+0x73, 0xF1, 0x91, 0x15, 0xB1, 0x2B, 0xE4, 0x20, 
+0x60, 0xFE, 0xAC, 0xF6, 0x19, 0x61, 0x29, 0xC7, 
+0xC6, 0xC2, 0xFF, 0x3B, 0x16, 0x86, 0x28, 0x12, 
+0x65, 
+
+0x6A, 0x4E, 0x84, 0xB6, 0x49, 0x38, 0x0F, 0x2C, 
+0x31, 0x3F, 0xDF, 0x1D, 0xB5, 0x24, 0xE0, 0x32, 
+0xDF, 0x9D, 0x47, 0x4C, 0x9D, 0x02, 0x87, 0xF8, 
+0x6D, 0x3C, 0x94, 0xBA, 0x01, 0xC6, 0x3D, 0x3F, 
+0xD9, 0xB2, 0x1B, 0xB1, 0xE5, 0x32, 0x97, 0x58, 
+0x4C, 0x26, 0x73, 0x68, 0x62, 0x35, 0xD6, 0xA5, 
+0xAD, 0x48, 0x1C, 0x87, 0xD6, 0x66, 0x9F, 0xD4, 
+0xB1, 0xBF, 0xFE, 0xC1, 0x05, 0xE1, 0x78, 0x98, 
+0x71, 0x6B, 0x8E, 0xC5, 0x10, 0xA0, 0xCC, 0xFB, 
+0xA6, 0xF6, 0xEC, 0x31, 0x96, 0xD5, 0x3E, 0x5E, 
+0x84, 0x9E, 
+
+0x32, 0xEB, 0x03, 0xE3, 0xEC, 0x2C, 0x23, 0xB5, 
+0xEA, 0xE1, 0x7F, 0xDB, 0x6D, 0xA9, 0x58, 0x15, 
+0xE1, 0xA1, 0x04, 0x45, 0x3F, 0x32, 0xD6, 0xC9, 
+0x03, 0x90, 0xB9, 0xCB, 0xBE, 0x76, 0x60, 0x02, 
+0xB9, 0x8A, 0x4A, 0x4C, 0xB1, 0x06, 0xEA, 0x53, 
+0x4A, 0x70, 0xF8, 0x47, 0xCD, 0x61, 0x02, 0x68, 
+0x53, 0x90, 0xD1, 0xBD, 0x89, 0x18, 0x7F, 0xDB, 
+0x69, 0xC0, 0x0E, 0x20, 0xAF, 0xA4, 0xF3, 0x9B, 
+0xC5, 0xB5, 0x11, 0x91, 0xB6, 0xA8, 0x02, 0x59, 
+0x3C, 0x30, 0xA6, 0xF2, 0x3B, 0x81, 0x89, 0x4C, 
+0x6A, 0xE0, 0xDD, 
+
+0xF3, 0xA9, 0xE7, 0xE9, 0x21, 0x9F, 0x88, 0xEE, 
+0x56, 0xE4, 0xA8, 0xB9, 0xF0, 0xB0, 0xB1, 0xB8, 
+0x4C, 0xB8, 0x83, 0xC4, 0xB7, 0xC7, 0xB9, 0xF7, 
+0x72, 0x59, 0xB5, 0x2D, 0x64, 0x90, 0xEE, 0x61, 
+0x0E, 0xEE, 0xF8, 0x34, 0x1C, 0xE7, 0x40, 0x60, 
+0x83, 0xEA, 0x08, 0x7B, 0x6B, 0x86, 0x2D, 0x08, 
+0xD6, 0x57, 0x15, 0x57, 0xAF, 0x9F, 0x53, 0xE1, 
+0xE1, 0x8F, 0xC0, 0x15, 0xE5, 0x0E, 0x83, 0x1E, 
+0x7A, 0x86, 0x27, 0x09, 0x9D, 0x9A, 0xC2, 0x4D, 
+0xD2, 0x2C, 0x56, 0x7B, 0x43, 0x5A, 0x25, 0x04, 
+0x92, 0xF7, 0x9A, 0x87, 
+
+0xED, 0x1D, 0xA5, 0x6F, 0xED, 0x03, 0xFD, 0x65, 
+0x77, 0xEE, 0x6D, 0x62, 0x98, 0x91, 0x63, 0x79, 
+0xB1, 0x79, 0xCF, 0xD9, 0x9F, 0x66, 0xBC, 0x83, 
+0xC7, 0x18, 0x6E, 0xD1, 0x8D, 0x32, 0x42, 0x07, 
+0x89, 0x20, 0xBD, 0xE0, 0x83, 0x30, 0xD4, 0x0C, 
+0xB1, 0x13, 0xC4, 0xAF, 0x32, 0xAE, 0xBC, 0xF8, 
+0x02, 0x69, 0x94, 0x75, 0xD4, 0xDD, 0x6B, 0x81, 
+0x52, 0xE7, 0xB2, 0x39, 0x34, 0x43, 0x64, 0xAA, 
+0x3A, 0x81, 0xBA, 0x2A, 0xBC, 0x03, 0x98, 0x60, 
+0xD5, 0xF2, 0x00, 0xAC, 0x1C, 0xE2, 0x0E, 0xB9, 
+0xAA, 0x0D, 0xE9, 0x98, 0x64, 
+
+0x21, 0xB3, 0x90, 0x3D, 0xF0, 0x7C, 0x5B, 0x51, 
+0x46, 0x1F, 0xF8, 0x6B, 0x0E, 0x57, 0xC6, 0xBD, 
+0xBB, 0x12, 0xB5, 0x6D, 0xE5, 0x75, 0x2F, 0x33, 
+0x0C, 0x7C, 0x27, 0x60, 0xB8, 0xC6, 0x2D, 0x49, 
+0x91, 0x3E, 0x7E, 0x73, 0x72, 0x1C, 0xB5, 0x7B, 
+0xBB, 0x42, 0xA2, 0xA9, 0x06, 0xA5, 0xDF, 0x53, 
+0xF8, 0x06, 0xFA, 0x02, 0xBE, 0x76, 0x32, 0x84, 
+0x15, 0x7E, 0x1D, 0x54, 0x19, 0x99, 0x7E, 0x09, 
+0x1B, 0x9B, 0x43, 0x4D, 0xF3, 0x8C, 0xA4, 0x28, 
+0x09, 0xE9, 0xB6, 0x70, 0xAF, 0x5F, 0xD6, 0x5D, 
+0xFD, 0xE8, 0xE9, 0xDF, 0x5F, 0xE2, 
+
+0xF3, 0x3C, 0xB0, 0xA3, 0xBC, 0xEC, 0x21, 0x3E, 
+0xCE, 0x8B, 0x9E, 0x21, 0xF3, 0x40, 0x83, 0x6B, 
+0x9F, 0x6B, 0x22, 0x79, 0xCC, 0xA7, 0x0C, 0x7F, 
+0xFF, 0xCC, 0xB7, 0xB1, 0xD8, 0xD0, 0xAF, 0x29, 
+0x20, 0xDE, 0x8E, 0x2D, 0xD5, 0x33, 0x85, 0x39, 
+0xA5, 0x80, 0x98, 0x30, 0xB2, 0x68, 0x1B, 0xF7, 
+0x05, 0x45, 0x61, 0xC0, 0x04, 0xCE, 0xCD, 0xF4, 
+0x22, 0xA5, 0xD6, 0x2B, 0x36, 0x0D, 0x9D, 0xED, 
+0x84, 0x9B, 0x2E, 0x4B, 0x89, 0xA5, 0x48, 0x7B, 
+0xBE, 0xF5, 0x08, 0x51, 0x72, 0xC2, 0x30, 0x22, 
+0x86, 0x2F, 0xD2, 0xD2, 0xF9, 0x24, 0xFA, 
+
+0x3D, 0xC3, 0x0A, 0x36, 0x79, 0xE2, 0x7E, 0xF5, 
+0xF6, 0xCA, 0x15, 0x72, 0x22, 0xD1, 0x54, 0x92, 
+0x9F, 0x4C, 0x0C, 0xCB, 0x9E, 0xC2, 0xC7, 0xEF, 
+0xD4, 0xF8, 0xF6, 0xE6, 0x6B, 0xCA, 0x96, 0xA4, 
+0x59, 0x64, 0xB9, 0x1E, 0x4F, 0x24, 0x02, 0xE4, 
+0x8A, 0xD1, 0x9D, 0x9E, 0x7B, 0x61, 0xBF, 0x3B, 
+0x5F, 0xA0, 0x8B, 0xA2, 0x48, 0xF1, 0x01, 0x5D, 
+0x6A, 0x35, 0x5B, 0x58, 0x4D, 0x19, 0xEF, 0x2D, 
+0x35, 0xC7, 0x58, 0x45, 0x63, 0x20, 0xA5, 0xB4, 
+0xBF, 0xA2, 0x89, 0x59, 0xF4, 0x6D, 0x5E, 0x98, 
+0xBC, 0xC3, 0xE8, 0xAE, 0x65, 0x1E, 0xE2, 0xAA, 
+
+0x54, 0x14, 0x68, 0xFB, 0xD7, 0x77, 0x8C, 0x23, 
+0x0E, 0xC6, 0xEC, 0x9B, 0x58, 0x38, 0x90, 0x4D, 
+0xF1, 0xB1, 0x3F, 0xB3, 0x13, 0x69, 0xD1, 0x7C, 
+0xCD, 0x6A, 0xEC, 0xA2, 0xC2, 0x70, 0x4D, 0x29, 
+0x3D, 0x3B, 0xF4, 0x4C, 0x47, 0x62, 0xA9, 0x8B, 
+0x78, 0x10, 0x3C, 0xA6, 0x18, 0xDF, 0x2D, 0x5F, 
+0xDF, 0x40, 0xB0, 0xE2, 0xB1, 0xA0, 0x30, 0x31, 
+0x8B, 0x75, 0x55, 0xEF, 0xE7, 0xA9, 0xE9, 0x57, 
+0x57, 0x48, 0x77, 0xE2, 0x5A, 0x09, 0x9A, 0x8D, 
+0x1C, 0x37, 0x45, 0x9C, 0x35, 0x53, 0xA7, 0x11, 
+0x5A, 0x61, 0x2C, 0x01, 0x98, 0xF6, 0xA3, 0x3E, 
+0x87, 
+
+0x63, 0xEA, 0x8B, 0xA5, 0xBD, 0x6B, 0x84, 0x01, 
+0xF0, 0x0C, 0x84, 0x82, 0x31, 0x68, 0xD0, 0x87, 
+0xA0, 0xAC, 0xA7, 0x1F, 0x72, 0x63, 0x64, 0x44, 
+0x8E, 0x44, 0xD7, 0x9E, 0x2D, 0x90, 0x23, 0xE7, 
+0x90, 0x5B, 0xB3, 0xA1, 0x4B, 0xBA, 0xB0, 0xF1, 
+0xE3, 0xCA, 0xA1, 0xD8, 0xFB, 0xC7, 0x45, 0x1C, 
+0x28, 0xC9, 0xD4, 0x69, 0x5E, 0x70, 0x43, 0xDD, 
+0xE4, 0xB0, 0x70, 0x2D, 0xD6, 0x45, 0x8E, 0x71, 
+0xA8, 0xC9, 0xB3, 0x98, 0x33, 0x47, 0xCE, 0x05, 
+0xD6, 0xDF, 0x9B, 0x61, 0xCD, 0x19, 0x98, 0x84, 
+0xF7, 0xAF, 0x07, 0x67, 0x2B, 0x56, 0x7A, 0x27, 
+0xF8, 0xD5, 
+
+0x17, 0xC8, 0x77, 0x0F, 0xBC, 0x4F, 0x55, 0x1E, 
+0x93, 0x7C, 0xA8, 0xFF, 0xDE, 0x88, 0x9C, 0x8C, 
+0xD6, 0x82, 0x18, 0xAB, 0x00, 0xD6, 0xC8, 0x80, 
+0xD1, 0xDB, 0x57, 0xB7, 0xB4, 0x04, 0x58, 0x51, 
+0x2D, 0x02, 0xD6, 0xCD, 0x3D, 0xC8, 0x80, 0xDF, 
+0x8B, 0x2D, 0x09, 0x57, 0x34, 0xF1, 0xDC, 0xA0, 
+0xBF, 0xE8, 0xF6, 0x55, 0x20, 0x64, 0x6B, 0x61, 
+0x0A, 0xDB, 0xC0, 0x3B, 0x3E, 0x4E, 0xF1, 0xCD, 
+0x3F, 0x16, 0x60, 0x01, 0xE9, 0xBC, 0xB7, 0x16, 
+0xB0, 0x8B, 0x76, 0x40, 0xC3, 0xC8, 0xE9, 0xA9, 
+0xD1, 0x52, 0xFE, 0xCC, 0x7A, 0x35, 0xDB, 0xEE, 
+0xB7, 0xA8, 0x3E, 
+
+0x9C, 0x4D, 0xBB, 0xE3, 0x9E, 0x90, 0x4D, 0x20, 
+0xC6, 0x19, 0xFF, 0x80, 0x96, 0x79, 0x66, 0xC3, 
+0xE3, 0x76, 0x0C, 0x87, 0x7F, 0x5D, 0x5E, 0x28, 
+0xED, 0xB4, 0x03, 0x75, 0x00, 0x27, 0x60, 0x88, 
+0x15, 0x1A, 0xAE, 0x6E, 0x8C, 0x09, 0xA0, 0x50, 
+0x4A, 0x4F, 0x9F, 0xB1, 0x13, 0x51, 0xF3, 0x3C, 
+0xE5, 0xEE, 0x3E, 0xBE, 0x6E, 0xC3, 0x65, 0x8C, 
+0x18, 0x3A, 0x09, 0xBA, 0x5C, 0x50, 0x1A, 0x3C, 
+0x19, 0x7D, 0xF1, 0x6F, 0x56, 0xAB, 0x5A, 0xBE, 
+0x41, 0xD9, 0x47, 0xD2, 0x5B, 0x06, 0x9F, 0xC3, 
+0xEE, 0xD8, 0x3B, 0xC9, 0x02, 0x91, 0xB3, 0x55, 
+0x32, 0x94, 0xCB, 0x7C, 
+
+0xC8, 0xF3, 0xA1, 0xAD, 0xAA, 0x90, 0x23, 0x46, 
+0x96, 0x72, 0xC8, 0xE4, 0x5E, 0x03, 0x78, 0x00, 
+0x1B, 0x57, 0x0C, 0x6F, 0x7A, 0x3C, 0x6F, 0xF6, 
+0x11, 0xF2, 0x8A, 0xB2, 0x03, 0xD5, 0x7A, 0x28, 
+0x75, 0x2D, 0x93, 0x4B, 0xF5, 0x73, 0xD6, 0x6E, 
+0x81, 0xF3, 0x90, 0xFF, 0x38, 0xD6, 0x1A, 0x02, 
+0x86, 0x5B, 0x83, 0x1E, 0x8F, 0x2F, 0xDF, 0x69, 
+0xAE, 0xB5, 0xDE, 0x61, 0xDB, 0x0B, 0x12, 0xD8, 
+0x3E, 0x5D, 0x1F, 0xDF, 0x2C, 0x09, 0xB2, 0xE0, 
+0x4C, 0xC6, 0x3D, 0xCA, 0x93, 0x0F, 0xD5, 0xBC, 
+0x45, 0x76, 0xCD, 0xF6, 0xF1, 0xB6, 0x48, 0x45, 
+0x44, 0x53, 0xCB, 0xC7, 0xDB, 
+
+0xAA, 0xBC, 0xA8, 0x6B, 0xD8, 0x6F, 0x52, 0x10, 
+0x15, 0x17, 0xD5, 0x5A, 0xEC, 0x66, 0xFD, 0x54, 
+0x8F, 0xDB, 0x89, 0x91, 0x32, 0xD3, 0xA5, 0x78, 
+0x9C, 0x40, 0x62, 0xC1, 0x8F, 0xA7, 0xD1, 0xDE, 
+0x96, 0x55, 0x75, 0x46, 0xE1, 0xA8, 0xC3, 0x30, 
+0xAE, 0x9A, 0xB4, 0x29, 0x3F, 0xF3, 0x83, 0xB8, 
+0x0F, 0xA0, 0xFA, 0xFA, 0xE4, 0x3D, 0x08, 0xD1, 
+0x8B, 0x68, 0xEA, 0x72, 0x66, 0xBE, 0x74, 0x91, 
+0x61, 0x3E, 0xCC, 0xFA, 0x37, 0xEF, 0x00, 0xB6, 
+0x58, 0x1B, 0xC0, 0x80, 0xB5, 0x2E, 0x38, 0xFB, 
+0xC2, 0xFE, 0xD0, 0xA0, 0x66, 0x4D, 0xEF, 0xA9, 
+0x48, 0x01, 0x35, 0x2E, 0x7F, 0x6B, 
+
+0x8E, 0xA6, 0x31, 0x5F, 0xDA, 0x36, 0x40, 0x2C, 
+0x93, 0x83, 0x85, 0x72, 0x4E, 0x2E, 0xFF, 0x3B, 
+0x23, 0x2D, 0x5C, 0xF5, 0x5A, 0xEC, 0x0B, 0xFD, 
+0x63, 0xE0, 0xF2, 0xB7, 0xE7, 0x72, 0x1E, 0xA7, 
+0x59, 0x67, 0x16, 0x40, 0xDD, 0x7C, 0x8D, 0x3E, 
+0x84, 0xE9, 0xCA, 0x34, 0x01, 0xB4, 0x6D, 0x18, 
+0xB2, 0xD5, 0x2D, 0x38, 0x85, 0xF6, 0x01, 0xBA, 
+0x69, 0x9A, 0xF7, 0x09, 0xC2, 0x0C, 0x1C, 0x89, 
+0x8D, 0xA1, 0xF9, 0x08, 0xE7, 0x65, 0x5A, 0x64, 
+0x4B, 0xB9, 0x83, 0xDE, 0x3E, 0x62, 0x28, 0xAD, 
+0x62, 0x39, 0xB9, 0xE4, 0x76, 0xAD, 0xAE, 0xF4, 
+0xB1, 0x87, 0x46, 0x00, 0x99, 0x94, 0x77, 
+
+0xF8, 0x63, 0x65, 0xFB, 0x5A, 0x73, 0xD4, 0x65, 
+0x5B, 0xD4, 0xB4, 0x8D, 0x1C, 0x71, 0xE1, 0x53, 
+0xD1, 0x21, 0x4F, 0x3F, 0x53, 0xD9, 0xDA, 0x21, 
+0x9C, 0x06, 0x58, 0x9E, 0x5B, 0x13, 0x15, 0xA0, 
+0x40, 0xEB, 0x83, 0x88, 0x7E, 0x5C, 0x31, 0x7C, 
+0x49, 0x95, 0x03, 0x8F, 0x66, 0x68, 0xD6, 0x79, 
+0x03, 0x7D, 0x8B, 0xD5, 0x9A, 0x58, 0xEB, 0xB6, 
+0xC0, 0xF0, 0xF5, 0xA8, 0x38, 0x52, 0x15, 0x4D, 
+0x22, 0xD3, 0x4F, 0x0C, 0x5A, 0xE3, 0x32, 0xD7, 
+0x10, 0xCA, 0x6F, 0x1E, 0x21, 0x63, 0x89, 0x52, 
+0xA4, 0x86, 0x63, 0xFD, 0x4E, 0xAA, 0x88, 0x67, 
+0xB7, 0xEA, 0x0A, 0x25, 0x35, 0x26, 0x94, 0x7A, 
+
+0x67, 0x07, 0x59, 0x81, 0x28, 0x52, 0x0A, 0x08, 
+0x68, 0x11, 0x89, 0xCF, 0x46, 0x1A, 0x31, 0xFA, 
+0xBA, 0xA5, 0xF9, 0x70, 0x2F, 0x5A, 0x42, 0x26, 
+0x55, 0xA7, 0x55, 0xE0, 0xC2, 0x86, 0x1F, 0x0C, 
+0xD0, 0xA4, 0xFA, 0x26, 0x2C, 0xC8, 0xDB, 0x4F, 
+0xE7, 0xAD, 0xBB, 0x37, 0xC3, 0x3C, 0x03, 0x9B, 
+0x40, 0x40, 0x8B, 0x34, 0xD0, 0xC8, 0x9D, 0xFF, 
+0xE5, 0x90, 0xAA, 0x67, 0x4C, 0x78, 0xBA, 0x44, 
+0x79, 0x46, 0xA3, 0xDB, 0xE7, 0xC0, 0xB6, 0x24, 
+0x95, 0xC9, 0xB1, 0x1B, 0x00, 0x16, 0xE4, 0x48, 
+0xF9, 0x6E, 0xF3, 0xE1, 0x30, 0x9C, 0xA8, 0xDF, 
+0x0A, 0xCB, 0x54, 0xC8, 0x94, 0xB0, 0xE4, 0xCD, 
+0x8D, 
+
+0xC4, 0x6E, 0x61, 0x82, 0xF6, 0x51, 0xDE, 0xFC, 
+0xAA, 0xFF, 0x8F, 0xC8, 0x3A, 0xC7, 0x18, 0x26, 
+0xF9, 0x02, 0x8B, 0xB8, 0x29, 0xC0, 0x82, 0xC1, 
+0xEC, 0x74, 0x59, 0xB1, 0x47, 0xAC, 0xB8, 0x92, 
+0x21, 0xF9, 0xC4, 0xB9, 0x5C, 0x5A, 0xF8, 0xD0, 
+0x91, 0x76, 0xE3, 0xDD, 0xBC, 0xB7, 0x96, 0xAC, 
+0x0A, 0x56, 0x55, 0xBE, 0xD6, 0xB5, 0x78, 0xD8, 
+0x2A, 0xED, 0x58, 0x81, 0x25, 0x22, 0x65, 0xE3, 
+0x87, 0x1E, 0x2E, 0xD0, 0x6A, 0xC4, 0x56, 0xDE, 
+0x9A, 0x9E, 0x7E, 0x12, 0x06, 0xD8, 0x12, 0x98, 
+0xCA, 0x9A, 0xD8, 0x2C, 0x0C, 0xB9, 0xDB, 0x96, 
+0x32, 0xCF, 0x73, 0xED, 0x65, 0xB7, 0x32, 0xA4, 
+0xC9, 0x3B, 
+
+0x1C, 0x90, 0xAF, 0x8E, 0x74, 0x0D, 0xF4, 0x66, 
+0x0C, 0x88, 0x3B, 0x22, 0x27, 0x13, 0xB5, 0xD0, 
+0x22, 0x46, 0xE1, 0x72, 0xAB, 0x34, 0xAB, 0xA4, 
+0xC5, 0xEE, 0xBE, 0x5B, 0x16, 0x44, 0x90, 0x17, 
+0x89, 0x3F, 0x74, 0x50, 0x87, 0x76, 0xF1, 0x3F, 
+0xEB, 0x1A, 0x3E, 0xFE, 0xA7, 0xB6, 0x41, 0x36, 
+0xB5, 0x38, 0xD2, 0x63, 0x54, 0xFF, 0xCE, 0xE2, 
+0xAC, 0x74, 0xCE, 0x9D, 0x88, 0x86, 0x6B, 0x1D, 
+0xC7, 0x72, 0x66, 0x31, 0x7A, 0xA9, 0x60, 0x92, 
+0x9F, 0xF1, 0x39, 0x0E, 0xDE, 0x96, 0x13, 0x57, 
+0xE1, 0x68, 0x40, 0xCA, 0xF7, 0x29, 0xF5, 0xFD, 
+0x5E, 0xF8, 0x5E, 0x44, 0xF2, 0xC1, 0x1D, 0xE5, 
+0x23, 0xB5, 0x5A, 
+
+0x06, 0xB2, 0x67, 0xAE, 0x51, 0x80, 0x8D, 0xFD, 
+0x12, 0x6E, 0x09, 0xDB, 0x78, 0x72, 0xC6, 0xCF, 
+0xE0, 0x6A, 0x16, 0x71, 0xDC, 0x29, 0x3F, 0x96, 
+0xAF, 0xA6, 0x8B, 0xB1, 0x1E, 0xAA, 0x79, 0xC3, 
+0x35, 0x0F, 0x2D, 0x93, 0xDE, 0x45, 0x77, 0x63, 
+0x1F, 0x87, 0x57, 0x24, 0x83, 0x0A, 0x3C, 0xEC, 
+0x80, 0x1E, 0x1A, 0xB0, 0x16, 0x7E, 0x13, 0x29, 
+0x75, 0x06, 0x31, 0x06, 0xD9, 0x73, 0xD0, 0xE7, 
+0x33, 0xAA, 0xE0, 0xEB, 0xD6, 0xE4, 0xFA, 0x00, 
+0x05, 0x35, 0xF6, 0x13, 0xF3, 0xEE, 0x75, 0xBD, 
+0xC8, 0x8E, 0x25, 0xDD, 0x9D, 0xE8, 0x78, 0x59, 
+0x41, 0x2E, 0xAB, 0x35, 0xBF, 0x3F, 0xC2, 0xAB, 
+0xD0, 0x71, 0x4D, 0x74, 
+
+0xB5, 0xF7, 0x24, 0xF6, 0x84, 0xA3, 0x89, 0x20, 
+0xD8, 0x1D, 0xAF, 0x0F, 0x6B, 0xA7, 0x2A, 0x9B, 
+0x6C, 0xF6, 0x61, 0x38, 0xCB, 0x09, 0x8A, 0xD2, 
+0xA8, 0x75, 0x13, 0xB5, 0x07, 0x3C, 0xDD, 0x4F, 
+0xE5, 0x20, 0x4B, 0xE0, 0x58, 0xED, 0x9B, 0x38, 
+0x3B, 0x33, 0xB6, 0xF3, 0xFE, 0xD6, 0x80, 0x7D, 
+0x9C, 0x59, 0x10, 0x54, 0xE5, 0x4A, 0xD0, 0xB7, 
+0x66, 0x13, 0x78, 0x74, 0xFF, 0xC2, 0x89, 0x58, 
+0xB3, 0x2F, 0x68, 0xF2, 0xF7, 0xAA, 0xA3, 0x92, 
+0xFF, 0xCB, 0x0D, 0xB3, 0xC1, 0x07, 0x6B, 0xC0, 
+0xEA, 0x20, 0x5A, 0x88, 0x88, 0x91, 0xCD, 0xA4, 
+0xCC, 0x6C, 0xF3, 0x2B, 0x63, 0xE1, 0xF0, 0x88, 
+0x5D, 0x25, 0x70, 0x3F, 0xDB, 
+
+0xEA, 0x1B, 0x67, 0xC6, 0x9E, 0x7A, 0x88, 0xDD, 
+0x40, 0x3B, 0xA6, 0xEF, 0x5D, 0x6C, 0x1B, 0x66, 
+0x5E, 0x57, 0xD4, 0xA5, 0x19, 0x5E, 0xA3, 0xFA, 
+0x21, 0x47, 0x49, 0x6B, 0x10, 0x62, 0x24, 0xBE, 
+0x65, 0xC7, 0x4C, 0xBE, 0x6A, 0x87, 0xDE, 0xB3, 
+0x20, 0x94, 0xFF, 0x6F, 0x21, 0x2D, 0xE7, 0xAA, 
+0x21, 0xDF, 0x49, 0x23, 0x73, 0x5D, 0xB1, 0x12, 
+0x84, 0x2F, 0xFB, 0xC2, 0x65, 0x29, 0xA2, 0xB3, 
+0xBA, 0xB5, 0x75, 0xCB, 0xEF, 0x5F, 0xC3, 0xD6, 
+0xE7, 0x84, 0x7F, 0xE0, 0x94, 0x49, 0xA2, 0x36, 
+0x2F, 0x9B, 0x2B, 0xD6, 0x38, 0x0E, 0x7C, 0x55, 
+0xA3, 0xDC, 0xC3, 0x4B, 0x5B, 0xFD, 0x7C, 0xFB, 
+0x30, 0x0F, 0x0E, 0x96, 0x78, 0x63, 
+
+0x5C, 0x18, 0x7C, 0x0C, 0x1C, 0x35, 0x6B, 0xEB, 
+0x11, 0x99, 0x8C, 0x8E, 0x38, 0x7A, 0x5F, 0x48, 
+0xE8, 0x35, 0x5E, 0xCE, 0xF3, 0xBB, 0x91, 0x88, 
+0x00, 0x2D, 0x30, 0x33, 0x9C, 0xDD, 0xA3, 0xA8, 
+0xD0, 0x35, 0xD8, 0x41, 0x31, 0x3A, 0xF9, 0xD6, 
+0x69, 0x28, 0xE7, 0xC8, 0x8B, 0x2E, 0x0C, 0x06, 
+0xB5, 0x81, 0x04, 0x55, 0x3E, 0xA0, 0xAC, 0x8B, 
+0x79, 0xA3, 0x8F, 0x6D, 0x79, 0xF9, 0xE3, 0xF7, 
+0x51, 0xBB, 0x77, 0x1E, 0x12, 0xCE, 0x5D, 0x6D, 
+0x07, 0x96, 0x06, 0xB2, 0x00, 0xFF, 0xDE, 0x62, 
+0xFA, 0x25, 0x3E, 0xFB, 0xF3, 0x20, 0xB2, 0x87, 
+0x53, 0xAC, 0x63, 0x8F, 0xBC, 0xCA, 0x5A, 0x6C, 
+0xE1, 0xA3, 0xA4, 0x1D, 0x57, 0x6F, 0xB3, 
+
+0xA2, 0x3E, 0xB4, 0x4C, 0x35, 0xCC, 0xE1, 0x90, 
+0x52, 0xC5, 0xF8, 0xA2, 0x7D, 0x58, 0x7A, 0x4E, 
+0x87, 0xBF, 0xAC, 0xE5, 0x69, 0x4D, 0x68, 0xA4, 
+0xD8, 0xDA, 0x4E, 0x81, 0x25, 0x56, 0x93, 0xF9, 
+0xBF, 0x78, 0x7A, 0xD2, 0xAC, 0x18, 0xAB, 0x77, 
+0x75, 0x51, 0xBE, 0x7D, 0xC1, 0x30, 0x9C, 0xE2, 
+0x56, 0xDD, 0x96, 0x47, 0x72, 0xC3, 0xC2, 0x67, 
+0xE4, 0x37, 0x81, 0xAE, 0x4E, 0x4F, 0x2F, 0xEE, 
+0x03, 0x14, 0x99, 0x70, 0x1C, 0x2D, 0x12, 0xE9, 
+0x02, 0x79, 0xF7, 0x12, 0xC0, 0x9B, 0xB7, 0xD2, 
+0x8E, 0x05, 0xDE, 0x97, 0x56, 0xFE, 0x3F, 0xAF, 
+0x7E, 0x1D, 0xBF, 0x0D, 0xDE, 0xF6, 0x9D, 0xD5, 
+0x4F, 0xC4, 0x77, 0x8E, 0x45, 0xFC, 0x98, 0xA0, 
+
+0xD0, 0xFD, 0x08, 0x3C, 0x57, 0x76, 0xD4, 0x71, 
+0xC1, 0x52, 0x99, 0x19, 0xAA, 0xD5, 0xA3, 0x12, 
+0x70, 0xDA, 0x25, 0x6C, 0x9E, 0xC0, 0x8B, 0xC8, 
+0x12, 0xB7, 0xAB, 0xDC, 0x6D, 0xC8, 0x0A, 0x1F, 
+0x7A, 0x5D, 0x26, 0xA6, 0x17, 0xDF, 0x89, 0x0E, 
+0x49, 0x82, 0xF6, 0xE6, 0xFB, 0xEA, 0x99, 0xB3, 
+0x1B, 0xCC, 0x5B, 0xCB, 0xD3, 0x12, 0x44, 0x20, 
+0x25, 0x24, 0x4B, 0x52, 0xBF, 0x9E, 0xAA, 0x21, 
+0xFA, 0xD2, 0xF2, 0x66, 0x32, 0xA1, 0xB1, 0x34, 
+0x56, 0xB4, 0x9C, 0xF4, 0xB1, 0x7D, 0xC8, 0xB3, 
+0x1E, 0x18, 0xF1, 0xAF, 0x26, 0x15, 0xDE, 0xCA, 
+0x97, 0xFA, 0x14, 0x28, 0x92, 0xAC, 0x42, 0x4B, 
+0x69, 0x22, 0x69, 0x74, 0x19, 0x27, 0x68, 0xE4, 
+0xCF, 
+
+0xF5, 0x83, 0x26, 0xC2, 0xF8, 0xCA, 0x81, 0x44, 
+0x3C, 0xEC, 0x39, 0xBC, 0x8A, 0x8E, 0xFD, 0xEF, 
+0xD1, 0x22, 0xA8, 0x35, 0xE2, 0x96, 0xFF, 0xCA, 
+0x86, 0xBC, 0x18, 0xFF, 0xF0, 0x2B, 0x60, 0x36, 
+0x24, 0x13, 0x7D, 0x0A, 0xED, 0x1F, 0xD9, 0x2C, 
+0xCE, 0x90, 0x19, 0x5F, 0x19, 0x1A, 0xA5, 0x5E, 
+0x10, 0xB6, 0xFD, 0xAD, 0x3A, 0x33, 0xB2, 0xA1, 
+0x28, 0xB0, 0xB4, 0x8A, 0xD1, 0xFD, 0xD9, 0x7E, 
+0x0F, 0x4D, 0xC5, 0xD5, 0x6D, 0xC0, 0x4A, 0xAB, 
+0xF6, 0xD1, 0x4F, 0xCE, 0x8C, 0xA7, 0x37, 0x40, 
+0x42, 0x9E, 0x23, 0xB5, 0x15, 0x9C, 0x4D, 0x3F, 
+0x3F, 0xF2, 0x9A, 0xD1, 0xE6, 0x5B, 0x10, 0xEC, 
+0xFD, 0x14, 0xD6, 0x2A, 0x8A, 0x00, 0xC1, 0x16, 
+0x01, 0xFE, 
+
+0x4A, 0xE2, 0xBC, 0x8E, 0xA6, 0x1E, 0xDF, 0xCD, 
+0x29, 0x62, 0xA6, 0x62, 0xE3, 0x24, 0x11, 0xE0, 
+0x6E, 0x7B, 0x48, 0x63, 0xED, 0x65, 0x2F, 0xF9, 
+0x4E, 0x85, 0x49, 0x8F, 0xF1, 0x90, 0x69, 0x67, 
+0x38, 0xBC, 0x51, 0xDB, 0x08, 0x7A, 0xAE, 0x60, 
+0x95, 0xBB, 0x77, 0x65, 0x6C, 0x54, 0xD1, 0x93, 
+0xA5, 0xA5, 0xCD, 0x5D, 0xC1, 0x10, 0x3A, 0x71, 
+0x86, 0xDE, 0x49, 0xFC, 0x8B, 0x91, 0xB6, 0xD9, 
+0x24, 0x35, 0x7A, 0x49, 0xC8, 0xE6, 0x23, 0x84, 
+0x61, 0xC7, 0x48, 0xE2, 0x0D, 0x48, 0x08, 0xE4, 
+0x0B, 0xC9, 0x2A, 0x81, 0x4A, 0xB4, 0xD1, 0x50, 
+0x96, 0x88, 0xAF, 0x26, 0xA0, 0x77, 0x52, 0x4C, 
+0x5B, 0x41, 0xC3, 0x51, 0x7D, 0xF5, 0x89, 0xF4, 
+0xC4, 0xB1, 0x75, 
+
+0x01, 0x0F, 0x2F, 0xE8, 0x71, 0x7D, 0x21, 0xB5, 
+0xF9, 0x9C, 0x3F, 0x22, 0xC1, 0x00, 0x95, 0xBA, 
+0xEC, 0x4A, 0x27, 0xAC, 0x6B, 0x3E, 0x25, 0x97, 
+0x18, 0xC6, 0x31, 0x7A, 0x56, 0xC8, 0x6D, 0x31, 
+0x76, 0xAA, 0x16, 0x02, 0xDB, 0x18, 0xE1, 0x87, 
+0x42, 0xC3, 0xA6, 0x08, 0x8B, 0x4E, 0xEF, 0xFD, 
+0x56, 0xE0, 0xCD, 0xAE, 0x74, 0xDB, 0x0A, 0x75, 
+0x6A, 0x6F, 0xFE, 0xCA, 0x01, 0x9F, 0x51, 0x1A, 
+0xE6, 0x39, 0xF8, 0x75, 0x6B, 0x52, 0x08, 0x0F, 
+0xE1, 0x62, 0xEF, 0x5A, 0xC6, 0x38, 0xC4, 0x9D, 
+0x1B, 0x4F, 0x81, 0xE6, 0x00, 0x12, 0xFF, 0xBB, 
+0x01, 0xEB, 0x75, 0x3A, 0xB1, 0xE1, 0x5D, 0xB8, 
+0x82, 0xE3, 0xBA, 0xC3, 0xEC, 0xF9, 0x86, 0x0D, 
+0x52, 0x68, 0xF7, 0x63, 
+
+0xD4, 0xAB, 0x9D, 0xE1, 0x7F, 0x8D, 0x5E, 0x5A, 
+0x2E, 0xC9, 0x8C, 0xFB, 0xC5, 0xBF, 0xB8, 0xEA, 
+0x2F, 0x54, 0x62, 0x85, 0xB5, 0x87, 0xEB, 0xDB, 
+0xEE, 0xBA, 0x29, 0xBA, 0x7E, 0x67, 0x80, 0x70, 
+0x82, 0x48, 0xAC, 0x98, 0x34, 0xAD, 0x6D, 0xD7, 
+0x7A, 0x53, 0x75, 0xFF, 0x56, 0x20, 0x5A, 0x1B, 
+0xD4, 0xBD, 0x03, 0x57, 0xEC, 0x7F, 0xE7, 0x15, 
+0xDD, 0xCA, 0x0B, 0x36, 0xB8, 0xD7, 0x4E, 0xB2, 
+0x97, 0xCB, 0xA6, 0x66, 0xCB, 0x26, 0xBF, 0xE8, 
+0x53, 0xF6, 0x9A, 0x7E, 0xA7, 0x34, 0xD8, 0xAA, 
+0xFE, 0x0A, 0xB2, 0x93, 0xA3, 0x3D, 0xBD, 0xC7, 
+0x23, 0x74, 0x3D, 0x87, 0x59, 0x51, 0xE6, 0xDE, 
+0xBF, 0x5A, 0x24, 0x09, 0x68, 0x16, 0x05, 0xFA, 
+0x12, 0xA3, 0x5E, 0x84, 0x1E, 
+
+0x88, 0x8E, 0x5C, 0x46, 0x3D, 0xB9, 0xDB, 0x13, 
+0xB6, 0x84, 0x36, 0xCA, 0xAE, 0xBB, 0xBF, 0x54, 
+0x68, 0xE8, 0x1C, 0xE2, 0x21, 0x89, 0x7B, 0xF1, 
+0xD4, 0x8D, 0x4C, 0x58, 0x19, 0x73, 0xFE, 0x14, 
+0x08, 0x02, 0x6C, 0xFA, 0xF0, 0x43, 0x3E, 0x33, 
+0xC4, 0xB1, 0x53, 0x42, 0x41, 0x38, 0x89, 0x7C, 
+0x65, 0x1E, 0x06, 0x64, 0xF9, 0xEB, 0x1B, 0x16, 
+0xE4, 0x1F, 0x48, 0x07, 0x2B, 0x5C, 0x58, 0xA9, 
+0x29, 0x88, 0x57, 0xE7, 0x18, 0x9E, 0x51, 0x73, 
+0xC1, 0x12, 0x8F, 0x84, 0x53, 0xA5, 0x0F, 0xD3, 
+0xBC, 0x4B, 0x49, 0x2F, 0x39, 0xDB, 0xB5, 0x61, 
+0x99, 0xA2, 0xEB, 0xF1, 0x2D, 0x24, 0x8F, 0x79, 
+0x37, 0x21, 0xE4, 0xE2, 0xAB, 0xC4, 0x16, 0xFB, 
+0x1E, 0x04, 0x38, 0x8A, 0x79, 0x36, 
+
+0xCB, 0x29, 0x64, 0x08, 0x49, 0x59, 0x29, 0xAC, 
+0xA0, 0x53, 0x33, 0x43, 0x5A, 0x3D, 0x6B, 0x05, 
+0x2D, 0x42, 0x62, 0x7E, 0x9C, 0x52, 0xB0, 0x45, 
+0x38, 0x1B, 0x88, 0x4E, 0xA9, 0xB1, 0xA5, 0xAB, 
+0x94, 0x6A, 0xF1, 0x47, 0x09, 0x81, 0xCA, 0xA8, 
+0x39, 0x7D, 0x67, 0x44, 0x7F, 0xAE, 0x5C, 0x41, 
+0xA7, 0xDE, 0xBD, 0x0E, 0xE2, 0x72, 0xFA, 0x42, 
+0x1E, 0x93, 0x12, 0xD3, 0x8D, 0x60, 0x8E, 0xD6, 
+0x97, 0x47, 0x9C, 0x43, 0x30, 0x0B, 0x77, 0xFB, 
+0xD5, 0xAF, 0x46, 0x83, 0x0E, 0x3C, 0x06, 0x81, 
+0xD3, 0xED, 0x5E, 0xD5, 0x1C, 0x16, 0x58, 0xE4, 
+0x21, 0xD0, 0x9F, 0x50, 0x07, 0x1E, 0x06, 0x95, 
+0x06, 0xEC, 0xA5, 0x46, 0x2D, 0x0F, 0x80, 0xF2, 
+0xC9, 0xD7, 0x71, 0x7F, 0xF3, 0xB0, 0x32, 
+
+0xEB, 0xE7, 0x52, 0x05, 0xBE, 0xE1, 0xDF, 0x59, 
+0xB8, 0x24, 0xE2, 0xE6, 0x68, 0x15, 0x0F, 0x27, 
+0x3C, 0xDC, 0x2B, 0xD9, 0x1F, 0x98, 0xAB, 0x54, 
+0x86, 0x77, 0xC0, 0x22, 0x8F, 0xC8, 0xF7, 0x91, 
+0x75, 0x07, 0xFD, 0x25, 0x68, 0x2D, 0xA7, 0x67, 
+0xCC, 0x4D, 0x1D, 0xA6, 0x70, 0x75, 0x42, 0x15, 
+0x6B, 0xF2, 0x7C, 0x65, 0x4C, 0x68, 0x05, 0x61, 
+0xBD, 0xC8, 0xC4, 0x40, 0xF0, 0x2B, 0xC8, 0x69, 
+0x55, 0xF1, 0xD6, 0x76, 0x2D, 0xC7, 0x7E, 0x53, 
+0x75, 0x92, 0xDC, 0x0A, 0x66, 0x9A, 0x0B, 0x0C, 
+0x5F, 0x40, 0xD4, 0x60, 0x24, 0xD9, 0x22, 0x79, 
+0x0C, 0x38, 0x0F, 0xF3, 0x7C, 0xAE, 0x04, 0x44, 
+0xF7, 0xBE, 0xF1, 0xDA, 0x3D, 0x20, 0x8B, 0x42, 
+0x35, 0x40, 0x8E, 0x85, 0x74, 0x75, 0xC3, 0x24, 
+
+0xC1, 0x94, 0x1B, 0x45, 0xA7, 0x1D, 0x02, 0x52, 
+0x3F, 0xBE, 0xEE, 0x79, 0xAD, 0xE3, 0xCA, 0x8D, 
+0x85, 0x7A, 0x2F, 0x77, 0xBE, 0xA1, 0x8E, 0x5F, 
+0x9C, 0xA1, 0x51, 0x0E, 0xE6, 0xE8, 0x71, 0x5E, 
+0x77, 0x61, 0x77, 0x79, 0x59, 0x54, 0xE0, 0xB3, 
+0x61, 0xB2, 0x62, 0xD7, 0x93, 0x6E, 0x9F, 0x62, 
+0x91, 0xAE, 0xFF, 0x2A, 0xE9, 0x28, 0xEA, 0x07, 
+0x63, 0xFA, 0xFB, 0x2C, 0x5F, 0x31, 0x9A, 0x4A, 
+0x70, 0x3A, 0x5D, 0xEB, 0xD9, 0xCE, 0x8A, 0x62, 
+0x46, 0xC7, 0xB3, 0x4E, 0x18, 0x77, 0x61, 0x19, 
+0x58, 0xF3, 0x28, 0xF7, 0x51, 0x43, 0x2F, 0x5D, 
+0x50, 0x62, 0xBE, 0x9D, 0xA8, 0x07, 0xAE, 0x26, 
+0xF9, 0x0D, 0x8D, 0x0B, 0x8D, 0x90, 0x73, 0x96, 
+0xAE, 0x8F, 0x6E, 0xC1, 0x09, 0xC4, 0x0B, 0xBF, 
+0x6C, 
+
+0x57, 0x69, 0x86, 0x06, 0x9F, 0x55, 0x5C, 0x1B, 
+0x37, 0x5F, 0x18, 0x90, 0x54, 0x87, 0xB0, 0x0C, 
+0xA9, 0xA7, 0x54, 0x80, 0xE5, 0xA5, 0x34, 0x74, 
+0xAE, 0xA7, 0x53, 0x11, 0xBB, 0x13, 0x28, 0x4C, 
+0xD2, 0xDE, 0xE5, 0xF7, 0x0A, 0x56, 0xB2, 0x66, 
+0x21, 0xAD, 0xE6, 0xBA, 0x1B, 0xAA, 0x72, 0xEC, 
+0x97, 0x18, 0xDB, 0xBD, 0xE9, 0x57, 0x44, 0xC0, 
+0xAD, 0xD9, 0xC2, 0x8E, 0x70, 0x83, 0x03, 0xD8, 
+0xF7, 0x3D, 0x4A, 0x13, 0x13, 0xC0, 0xC4, 0xD0, 
+0x5C, 0xA2, 0xB7, 0x51, 0x4E, 0x68, 0x9A, 0xD4, 
+0x8B, 0xC3, 0x4D, 0x7A, 0xF0, 0x28, 0xBC, 0xC0, 
+0x51, 0xCB, 0xA2, 0xE3, 0x50, 0xBF, 0xA2, 0xC9, 
+0x3F, 0xAB, 0x05, 0x86, 0xF1, 0x45, 0x6B, 0x3C, 
+0x28, 0xC7, 0x56, 0xA6, 0x98, 0x77, 0xF0, 0xC6, 
+0xDB, 0xE7, 
+
+0x25, 0xBD, 0x48, 0x06, 0xE8, 0xE9, 0xF6, 0x12, 
+0xCC, 0x45, 0x9C, 0x48, 0xC1, 0x05, 0xF7, 0xE6, 
+0x78, 0xEF, 0x7D, 0x11, 0xE8, 0x59, 0xD6, 0x09, 
+0x6F, 0xCE, 0x47, 0x96, 0xCA, 0x59, 0x0C, 0xFD, 
+0xE0, 0x78, 0x2A, 0x07, 0xF1, 0x71, 0x03, 0x57, 
+0x3A, 0x15, 0x56, 0x3D, 0x00, 0x23, 0x10, 0x83, 
+0x25, 0xFE, 0xE8, 0x06, 0xDC, 0xF8, 0x2E, 0x0C, 
+0x56, 0x02, 0xE8, 0x89, 0x69, 0x04, 0x6F, 0xD7, 
+0x40, 0xAD, 0x44, 0x71, 0x00, 0x61, 0x1C, 0x83, 
+0x6A, 0x3E, 0xE5, 0xF2, 0x16, 0xC0, 0x6D, 0x06, 
+0xE7, 0xE0, 0xF0, 0x35, 0x83, 0xD4, 0x75, 0x74, 
+0xBA, 0x12, 0x44, 0x88, 0x01, 0x87, 0xB2, 0xF8, 
+0x7C, 0x34, 0xD1, 0xF0, 0x01, 0x10, 0x15, 0x3F, 
+0xD2, 0xFB, 0x49, 0x30, 0xEE, 0x25, 0x1F, 0xBE, 
+0x91, 0xE5, 0x5F, 
+
+0x64, 0x8A, 0xF4, 0x6F, 0x3E, 0xE8, 0xA5, 0xC6, 
+0xFD, 0x35, 0xB4, 0x53, 0x6D, 0x67, 0xD7, 0x6B, 
+0x3C, 0x6F, 0x24, 0xA6, 0xFE, 0x5D, 0xD0, 0x43, 
+0x8E, 0xF8, 0x1A, 0x17, 0x98, 0x4C, 0x62, 0xF9, 
+0xC6, 0x06, 0x9C, 0xA0, 0x91, 0xC0, 0x4B, 0x8B, 
+0x23, 0xDC, 0x01, 0x6A, 0x31, 0x85, 0x35, 0x77, 
+0xE6, 0x1E, 0x9A, 0x63, 0x61, 0xC8, 0x47, 0xA4, 
+0xA6, 0x5B, 0x58, 0xDA, 0x5A, 0x05, 0x6A, 0xE9, 
+0xDC, 0xD8, 0x61, 0x0C, 0x20, 0x0C, 0xDB, 0xDC, 
+0x9E, 0x31, 0x38, 0x09, 0x1E, 0x6D, 0xE6, 0xB8, 
+0x9C, 0xA2, 0xFE, 0x51, 0x12, 0xE3, 0x3E, 0xA3, 
+0xE4, 0x2C, 0xC7, 0x2D, 0xCA, 0xD4, 0xF0, 0x45, 
+0x47, 0xAD, 0x92, 0xB0, 0xF6, 0x2D, 0x99, 0xA8, 
+0x97, 0x1B, 0xF1, 0x90, 0x94, 0x2A, 0xFA, 0xFC, 
+0xB9, 0x7E, 0x98, 0xBC, 
+
+0x44, 0xC9, 0xD6, 0xF8, 0x6D, 0x63, 0x50, 0xF1, 
+0xC7, 0x4F, 0x2D, 0xEA, 0x10, 0x9D, 0x1A, 0x7A, 
+0xBE, 0x97, 0x85, 0xAA, 0x3C, 0xD0, 0xA7, 0x2A, 
+0xFA, 0x65, 0x06, 0xCD, 0x38, 0xA5, 0x9A, 0x89, 
+0xA1, 0x27, 0x6A, 0xF2, 0xD2, 0x05, 0x24, 0x78, 
+0x2C, 0xD2, 0xE0, 0x1C, 0x84, 0x0B, 0x6C, 0x77, 
+0x9E, 0x90, 0x30, 0x1C, 0x30, 0x1A, 0x69, 0x1D, 
+0x38, 0xD5, 0x0A, 0x43, 0xFD, 0xF0, 0x3C, 0x7A, 
+0xC9, 0xDB, 0x49, 0x89, 0x38, 0xE8, 0x2C, 0xC6, 
+0x90, 0x84, 0xD0, 0xAB, 0x40, 0xDD, 0xC9, 0x36, 
+0x55, 0xF5, 0xB9, 0xB3, 0x42, 0x2E, 0xC7, 0xD3, 
+0x79, 0x3F, 0xBF, 0x21, 0x9A, 0x76, 0x0B, 0x85, 
+0xD0, 0x45, 0x48, 0xEA, 0xDF, 0xBF, 0x62, 0xAD, 
+0x0A, 0x40, 0xE9, 0xD1, 0x01, 0xFF, 0xD2, 0xD9, 
+0x8B, 0xA6, 0x63, 0x17, 0xF9, 
+
+0xBB, 0x70, 0xCD, 0x7F, 0xB2, 0x80, 0xB0, 0x09, 
+0x9D, 0x37, 0x3F, 0x5B, 0xF4, 0xE7, 0xFB, 0x67, 
+0x2E, 0xBB, 0xDB, 0x44, 0x8F, 0x00, 0xBE, 0xF0, 
+0x3C, 0x53, 0x8F, 0x7A, 0xAD, 0x21, 0x18, 0xF8, 
+0xDF, 0xB2, 0x9E, 0xA5, 0xE9, 0x55, 0xEC, 0x52, 
+0xF7, 0x38, 0x66, 0x73, 0xA6, 0x3A, 0x42, 0x79, 
+0xAA, 0x38, 0xEB, 0x0E, 0x3D, 0xF3, 0x40, 0xCF, 
+0x06, 0x15, 0x9F, 0xE0, 0x24, 0x89, 0x43, 0x89, 
+0x85, 0xB1, 0x17, 0x2D, 0x19, 0xF7, 0x80, 0x1E, 
+0x54, 0x09, 0x5B, 0x8B, 0x21, 0x53, 0xD8, 0x3E, 
+0xA2, 0xDA, 0xE9, 0x4B, 0x8F, 0x6D, 0x52, 0x67, 
+0xFB, 0x4E, 0x71, 0x7B, 0xAE, 0xF9, 0x9E, 0xD6, 
+0xCC, 0x75, 0x60, 0x37, 0xBF, 0x2E, 0x88, 0x7D, 
+0xAA, 0x86, 0x4B, 0x1B, 0x01, 0xBF, 0xB9, 0xE5, 
+0xC6, 0xD5, 0xFB, 0x5E, 0xB7, 0x1F, 
+
+0xB7, 0x8C, 0x7C, 0x65, 0x81, 0x33, 0x9C, 0xD1, 
+0x6F, 0xFD, 0xBB, 0xF1, 0xFB, 0x4F, 0xAC, 0x96, 
+0x63, 0xBB, 0x97, 0x25, 0x6C, 0x70, 0xB4, 0x37, 
+0xE6, 0xC9, 0x1E, 0x10, 0xE1, 0xA2, 0x09, 0xBD, 
+0xE7, 0x28, 0x4A, 0x5E, 0x33, 0x6E, 0xE0, 0xCA, 
+0xC3, 0x6A, 0x16, 0xE6, 0x58, 0x8A, 0xCB, 0xBC, 
+0xB3, 0x23, 0xC8, 0x48, 0xF2, 0x31, 0xF7, 0x69, 
+0x74, 0xD8, 0xF8, 0xD9, 0x45, 0x84, 0x51, 0xA0, 
+0x5C, 0x51, 0x05, 0xD6, 0xB6, 0x21, 0x62, 0xBF, 
+0x8E, 0x15, 0x2A, 0xBB, 0x23, 0x96, 0xA0, 0xB2, 
+0x1A, 0x41, 0xCA, 0xFF, 0xF6, 0x5A, 0xF9, 0x66, 
+0x2F, 0x03, 0xA1, 0x5A, 0xF7, 0x48, 0x41, 0x3E, 
+0xC7, 0x52, 0xB2, 0x1C, 0xA3, 0x2D, 0x3C, 0xB7, 
+0xA7, 0x7F, 0xA4, 0xF1, 0x53, 0xD3, 0xB1, 0xD1, 
+0xC9, 0x44, 0xE0, 0xC4, 0xE8, 0x33, 0x6A, 
+
+0x11, 0xDB, 0x13, 0x3F, 0x89, 0x2D, 0xF1, 0x79, 
+0x90, 0x44, 0x1F, 0x12, 0x7E, 0x5F, 0xF1, 0x4D, 
+0xA4, 0x7F, 0x4A, 0x72, 0x1D, 0x1D, 0x6B, 0xB3, 
+0x82, 0xF4, 0x10, 0xEC, 0x3C, 0xE9, 0xED, 0xE2, 
+0x41, 0x81, 0x5A, 0x0A, 0xEE, 0x64, 0x16, 0x9B, 
+0xA4, 0xC0, 0xAF, 0x67, 0x9C, 0x5F, 0xA4, 0xFC, 
+0x9E, 0x29, 0x84, 0xBE, 0x33, 0x76, 0x1D, 0x4E, 
+0x34, 0xB5, 0x27, 0xDC, 0x41, 0x0E, 0x87, 0xB8, 
+0x52, 0x7C, 0xA2, 0xD3, 0xBA, 0x31, 0x0F, 0xD9, 
+0x36, 0xC9, 0x77, 0x85, 0xAF, 0x61, 0xF0, 0xD5, 
+0xD7, 0xC1, 0x31, 0xF6, 0x8D, 0x6B, 0x55, 0xE9, 
+0x98, 0x5B, 0x6A, 0x97, 0xEF, 0x1A, 0xBB, 0xAE, 
+0x1A, 0x87, 0x81, 0x24, 0xAE, 0xC6, 0x16, 0x82, 
+0xFF, 0xD8, 0x9F, 0x1E, 0x54, 0x88, 0x2A, 0x6C, 
+0x25, 0x7C, 0x09, 0xDC, 0x1D, 0xDE, 0xDD, 0xE8, 
+
+0xAA, 0x4F, 0xD7, 0x3C, 0x43, 0x9A, 0xCB, 0x9B, 
+0x37, 0x08, 0xC0, 0xA8, 0xF1, 0x41, 0xEB, 0xA1, 
+0x51, 0xA0, 0x3E, 0x41, 0xC6, 0xF1, 0x83, 0x2E, 
+0xB6, 0x33, 0xFD, 0x59, 0x8B, 0xB1, 0xCD, 0x1D, 
+0x90, 0x2E, 0xC9, 0x34, 0x69, 0x9F, 0xF4, 0xA8, 
+0x95, 0xD4, 0xF4, 0x25, 0x05, 0x52, 0x1D, 0x93, 
+0x19, 0x3E, 0x9B, 0x52, 0x3B, 0xAA, 0xE6, 0x7F, 
+0x35, 0x2B, 0x43, 0xA3, 0xD4, 0xB8, 0x66, 0x17, 
+0x34, 0x0A, 0xFD, 0x26, 0x20, 0xE1, 0xEC, 0x9D, 
+0xB5, 0x29, 0x31, 0x52, 0x5D, 0x29, 0x1D, 0x25, 
+0x01, 0x46, 0x06, 0x2B, 0xC1, 0x6F, 0x20, 0x1F, 
+0x5D, 0x7E, 0xBA, 0xEA, 0xDF, 0xCB, 0xF7, 0xCE, 
+0x26, 0x13, 0xE2, 0x8B, 0x93, 0x4B, 0xB2, 0xDB, 
+0x97, 0x72, 0xAD, 0x90, 0x51, 0x4E, 0xC4, 0x3A, 
+0xB7, 0xAD, 0x00, 0xE8, 0xFA, 0x60, 0x4E, 0x8F, 
+0xB5, 
+
+0xED, 0xE7, 0x3F, 0x10, 0xEC, 0xE3, 0x4F, 0x6E, 
+0x31, 0xD9, 0x8A, 0x7A, 0x11, 0x6F, 0xCD, 0x48, 
+0x06, 0x84, 0x8A, 0xA9, 0x91, 0x09, 0x39, 0x6D, 
+0x02, 0x18, 0xF3, 0x5B, 0x95, 0x2B, 0x0C, 0x64, 
+0xBA, 0xD3, 0x9C, 0x24, 0xE0, 0xBF, 0x8A, 0xF6, 
+0x7C, 0x3E, 0x12, 0x9C, 0xC8, 0xB3, 0x44, 0x3F, 
+0xAF, 0x25, 0xAC, 0x8B, 0x0E, 0x54, 0xBC, 0x5B, 
+0xEE, 0x1B, 0x95, 0xFB, 0xAD, 0xA9, 0x4B, 0xF3, 
+0x1F, 0xB4, 0xC1, 0x2A, 0x99, 0x1B, 0x71, 0x4B, 
+0xC2, 0xE6, 0xDE, 0x82, 0xB5, 0x25, 0xA7, 0x7A, 
+0x13, 0x71, 0xDB, 0x9B, 0x22, 0x83, 0x3A, 0xD9, 
+0xD2, 0xA1, 0x2F, 0x16, 0x43, 0x27, 0xA4, 0x78, 
+0x4E, 0xA9, 0xED, 0xFE, 0x40, 0xD8, 0xAC, 0xB8, 
+0x0C, 0x46, 0x18, 0x0B, 0xB4, 0xD7, 0xA5, 0x9D, 
+0x10, 0xBA, 0x4F, 0x3F, 0x7F, 0x61, 0x49, 0x23, 
+0x9F, 0xB4, 
+
+0x16, 0x8A, 0xB7, 0x69, 0x98, 0xEF, 0xBB, 0x01, 
+0xBF, 0xF9, 0x6C, 0x4F, 0xE3, 0x0D, 0xE6, 0xF6, 
+0x30, 0x9C, 0x39, 0x11, 0x0B, 0x16, 0x4A, 0xBF, 
+0x96, 0x6C, 0x93, 0x37, 0x26, 0x54, 0x85, 0x1F, 
+0x4B, 0x82, 0xF1, 0xAC, 0x31, 0x4F, 0x6B, 0x1B, 
+0x6C, 0x83, 0x01, 0xAA, 0x3A, 0x6A, 0x49, 0x1C, 
+0x47, 0xFE, 0x18, 0x3E, 0x9F, 0xE3, 0x38, 0x62, 
+0xA7, 0x50, 0xD3, 0xEE, 0x25, 0x8F, 0x03, 0x28, 
+0x3C, 0xCF, 0x6D, 0x9C, 0x3C, 0xF0, 0x68, 0xDB, 
+0x56, 0xB7, 0x4D, 0x69, 0xA0, 0x09, 0x16, 0x03, 
+0xB1, 0xA1, 0x87, 0x0E, 0x84, 0xDC, 0xEC, 0x09, 
+0x15, 0x1F, 0xEB, 0x27, 0x1C, 0xDD, 0x30, 0x43, 
+0x3A, 0x19, 0x9C, 0xD7, 0xB0, 0x77, 0x3D, 0x2A, 
+0x9D, 0xB5, 0x9C, 0x5E, 0x44, 0xD5, 0x1D, 0x89, 
+0x28, 0x88, 0xAD, 0xAA, 0xA8, 0xAE, 0x80, 0xAF, 
+0x22, 0x79, 0x9C, 
+
+0x5E, 0xBA, 0x0F, 0x35, 0x20, 0x1F, 0x46, 0x5E, 
+0x65, 0xDC, 0xEE, 0xD1, 0xB6, 0x4F, 0x7B, 0xA6, 
+0xFE, 0x03, 0x85, 0xA9, 0x93, 0x6D, 0xEC, 0xBA, 
+0x75, 0x56, 0x42, 0x7D, 0x8A, 0x01, 0x1E, 0x66, 
+0xFB, 0xBB, 0x39, 0xDC, 0x4A, 0xFB, 0xA6, 0x87, 
+0x49, 0xA6, 0xCF, 0x7A, 0xA7, 0x9F, 0xFE, 0xC7, 
+0xE3, 0x2D, 0x46, 0xC7, 0xBD, 0x47, 0x44, 0x52, 
+0xBE, 0x12, 0xDA, 0x3E, 0x9A, 0x9B, 0xCC, 0xDF, 
+0x95, 0x49, 0x7F, 0x4D, 0x76, 0xB6, 0x74, 0x6D, 
+0x13, 0x6A, 0x90, 0x0B, 0x06, 0xF9, 0x74, 0x2B, 
+0x7E, 0x4C, 0x60, 0x56, 0x2B, 0x76, 0x87, 0x4E, 
+0xE5, 0x92, 0xFB, 0x18, 0xD6, 0x99, 0x75, 0x1C, 
+0x0F, 0xF0, 0x41, 0x7E, 0xA5, 0x4B, 0x5C, 0xB5, 
+0xB3, 0xEB, 0x62, 0x61, 0x97, 0xD8, 0x0A, 0x65, 
+0x0D, 0xBC, 0xF8, 0x60, 0x25, 0xFF, 0x7A, 0xC7, 
+0xC4, 0x48, 0x8B, 0x1B, 
+
+0x32, 0x49, 0x9B, 0x19, 0xA7, 0x18, 0xC2, 0x64, 
+0x79, 0x6A, 0xCC, 0x63, 0x20, 0x05, 0xBC, 0x4C, 
+0x86, 0x02, 0x64, 0x64, 0x32, 0x63, 0x80, 0x39, 
+0xFC, 0xD4, 0xC7, 0x83, 0x61, 0x94, 0x46, 0x87, 
+0x26, 0x6A, 0xEA, 0x18, 0xAE, 0x44, 0x09, 0xAE, 
+0xE8, 0x69, 0xBC, 0xDD, 0xE3, 0x43, 0xAA, 0x20, 
+0xB6, 0x8B, 0xF8, 0xBB, 0x48, 0x73, 0x79, 0xCA, 
+0x3D, 0xAA, 0x43, 0x9B, 0xD0, 0x8C, 0x03, 0x5F, 
+0x2F, 0xD9, 0x5C, 0x78, 0x22, 0x45, 0x29, 0x43, 
+0x9C, 0xAE, 0x70, 0x8B, 0x29, 0x55, 0x65, 0x61, 
+0x6C, 0xBA, 0xDB, 0xE4, 0x0E, 0xA5, 0x40, 0xEA, 
+0xD8, 0xAE, 0x66, 0xF9, 0x65, 0x7C, 0xF4, 0xE2, 
+0x32, 0x66, 0xB3, 0x3F, 0x1C, 0xED, 0xD1, 0xA2, 
+0x9C, 0x81, 0xAD, 0xE3, 0x79, 0x09, 0x46, 0xB8, 
+0xFC, 0xAD, 0x86, 0x7C, 0x03, 0xAD, 0x29, 0xD3, 
+0x17, 0x47, 0x59, 0xCD, 0xC7, 
+
+0x6B, 0xDF, 0x9A, 0x99, 0x40, 0x0F, 0x6C, 0x23, 
+0xB1, 0x4E, 0x4E, 0x82, 0xAC, 0x10, 0x71, 0x3B, 
+0xD9, 0xC6, 0x67, 0xEB, 0x86, 0x45, 0xD8, 0x2E, 
+0x35, 0x37, 0xE6, 0x08, 0x25, 0x29, 0x6D, 0x68, 
+0x29, 0x68, 0x69, 0xA0, 0xCB, 0xED, 0x7D, 0xF7, 
+0x21, 0xEE, 0x09, 0xC7, 0x5F, 0xB1, 0xFD, 0xB1, 
+0x3B, 0xDF, 0x76, 0x77, 0xE7, 0x7F, 0x66, 0x81, 
+0x59, 0x2E, 0x0B, 0xC4, 0x3E, 0xB4, 0x3B, 0x2C, 
+0x51, 0x83, 0xD8, 0x28, 0xE1, 0x7C, 0xF7, 0x77, 
+0xD3, 0xEA, 0x36, 0x46, 0x3B, 0x13, 0x98, 0xF6, 
+0x70, 0x41, 0x15, 0x3E, 0x01, 0x24, 0x01, 0x35, 
+0x50, 0x0C, 0x10, 0x2C, 0x37, 0x5B, 0x48, 0x9D, 
+0x83, 0x2C, 0x3C, 0x82, 0x38, 0xDC, 0x45, 0x97, 
+0x21, 0x0F, 0x8B, 0xD4, 0x3F, 0x99, 0xB7, 0x87, 
+0x50, 0x6C, 0xCD, 0x0C, 0xEC, 0x61, 0xB8, 0x38, 
+0xC2, 0x88, 0x01, 0x52, 0xCF, 0x70, 
+
+0x32, 0xE7, 0x96, 0x8E, 0x1D, 0x8B, 0xE1, 0x21, 
+0x18, 0x79, 0x05, 0x71, 0xE3, 0x83, 0xF1, 0x1B, 
+0x27, 0x26, 0xE3, 0x15, 0x7E, 0xB5, 0x44, 0xB5, 
+0xE5, 0x2D, 0x5F, 0xD8, 0x8A, 0x4C, 0xF4, 0x83, 
+0x72, 0xFA, 0x8B, 0x89, 0xD6, 0xC6, 0x21, 0x9F, 
+0xDC, 0x9F, 0xEF, 0x78, 0x88, 0x27, 0xFE, 0xFD, 
+0x84, 0x7D, 0x3D, 0x0D, 0xFF, 0x73, 0xB8, 0xF6, 
+0xEE, 0x89, 0x16, 0xAA, 0xF2, 0x9C, 0x32, 0x7D, 
+0x6C, 0xFE, 0x70, 0x3E, 0x22, 0xC1, 0x8F, 0x28, 
+0x93, 0xBC, 0xE4, 0x68, 0x93, 0x64, 0x61, 0x2E, 
+0x41, 0x61, 0x3F, 0x86, 0x03, 0x9F, 0x33, 0xA5, 
+0xC8, 0x10, 0x33, 0x51, 0x6C, 0x8D, 0x98, 0xE7, 
+0x48, 0x07, 0xBB, 0x40, 0xB2, 0x66, 0xF8, 0x5A, 
+0xC5, 0x6C, 0x39, 0xD0, 0x5C, 0x44, 0x39, 0x0D, 
+0x24, 0xA3, 0x26, 0xF7, 0x88, 0xE8, 0x45, 0x6B, 
+0xE4, 0x50, 0x63, 0x7A, 0xE9, 0x0F, 0x60, 
+
+0x9E, 0x98, 0xD7, 0x5A, 0x81, 0xC9, 0xC8, 0x84, 
+0x78, 0xF5, 0x86, 0x02, 0x1F, 0x5A, 0xEB, 0x63, 
+0xEE, 0x67, 0x1F, 0x06, 0xF7, 0xBA, 0xD9, 0x7E, 
+0xD4, 0x3F, 0x80, 0x92, 0x0A, 0x71, 0xE1, 0xCD, 
+0x4D, 0xB0, 0x5D, 0x84, 0x9B, 0x86, 0xCE, 0xA6, 
+0x1E, 0x17, 0x88, 0xA1, 0x7D, 0xC1, 0x6A, 0x5D, 
+0x48, 0x30, 0x4C, 0xF6, 0x0C, 0x04, 0x2E, 0x4C, 
+0x6E, 0x89, 0x2B, 0x5E, 0xEB, 0x1E, 0x3E, 0xF5, 
+0xC3, 0x8A, 0xF0, 0x5A, 0x95, 0x8E, 0x16, 0x72, 
+0x71, 0xB1, 0x5C, 0xCB, 0x02, 0x94, 0x2C, 0x9A, 
+0x6A, 0x49, 0x8D, 0x08, 0x13, 0xEC, 0xA7, 0x87, 
+0x7F, 0x94, 0x1A, 0x80, 0x73, 0xC0, 0x34, 0xC1, 
+0x2D, 0xCC, 0xCC, 0xBF, 0x06, 0xEE, 0x20, 0x91, 
+0x08, 0x16, 0xBE, 0xA9, 0xF1, 0x66, 0x0B, 0x94, 
+0x4F, 0x78, 0xFD, 0xAF, 0x2B, 0xE4, 0x4E, 0x2B, 
+0xD4, 0x26, 0xE2, 0x35, 0x7B, 0x2F, 0xA4, 0x63, 
+
+0x5E, 0xBC, 0x86, 0xE1, 0x9B, 0xAD, 0xCD, 0x5F, 
+0xD2, 0x65, 0xE4, 0x6F, 0x72, 0x22, 0x6F, 0x27, 
+0xCC, 0x82, 0x4A, 0xB6, 0xCB, 0xBD, 0xA9, 0x79, 
+0x9E, 0x4C, 0x27, 0x0C, 0x53, 0x28, 0xDE, 0x4B, 
+0xA9, 0x89, 0x25, 0x2D, 0xD5, 0x33, 0xF8, 0x16, 
+0x95, 0x64, 0xF9, 0xA4, 0xD8, 0x62, 0x02, 0x23, 
+0x1D, 0xC2, 0xF7, 0x77, 0x30, 0x12, 0x96, 0x89, 
+0x80, 0xBF, 0x50, 0xBC, 0xDD, 0xFE, 0xAE, 0x9F, 
+0xCE, 0x6D, 0x52, 0x54, 0x6E, 0xB1, 0x61, 0x3F, 
+0x4A, 0x5A, 0xCE, 0x87, 0x88, 0x14, 0x6D, 0x74, 
+0x2C, 0x3D, 0xEE, 0x22, 0x4B, 0xC3, 0x2A, 0x43, 
+0xC2, 0x23, 0x6E, 0x86, 0x3B, 0xC4, 0xFF, 0xEF, 
+0xB2, 0x08, 0x34, 0x5D, 0x3A, 0xC9, 0x6C, 0x76, 
+0xE1, 0x70, 0xE1, 0xF2, 0x53, 0xF5, 0xA2, 0x5D, 
+0x59, 0xBC, 0xE1, 0x16, 0x19, 0x70, 0x2E, 0xDD, 
+0x04, 0x53, 0x59, 0xC0, 0xE7, 0xB6, 0xAC, 0x61, 
+0xB3, 
+
+0x3A, 0xEC, 0x3F, 0xB6, 0xDA, 0xAD, 0x31, 0x77, 
+0xBD, 0xF3, 0x0C, 0x00, 0x33, 0xFD, 0x56, 0x24, 
+0xDB, 0xEC, 0xFD, 0xCD, 0x2C, 0x51, 0xDF, 0xB5, 
+0x07, 0x8B, 0x94, 0x00, 0xFB, 0xF4, 0x02, 0x4A, 
+0x5E, 0xAA, 0xE4, 0x4E, 0x9C, 0xCC, 0x4D, 0x1F, 
+0x77, 0x70, 0x75, 0xFA, 0xCA, 0x6C, 0xEC, 0x36, 
+0x70, 0xB8, 0xF2, 0xE9, 0x98, 0xAF, 0xFC, 0x67, 
+0xAE, 0x08, 0x53, 0x57, 0xF5, 0x27, 0xF6, 0xCF, 
+0xFF, 0x3D, 0x25, 0x9B, 0xFC, 0x61, 0xE7, 0xDC, 
+0x44, 0x12, 0x6C, 0xD1, 0x74, 0x89, 0x9A, 0xD6, 
+0xD3, 0x01, 0xED, 0xA5, 0x96, 0xC4, 0xE1, 0x1E, 
+0x51, 0x38, 0x85, 0x67, 0xFB, 0x85, 0x09, 0x0E, 
+0xA1, 0x64, 0x1E, 0x9E, 0xFC, 0xBE, 0xDF, 0x5F, 
+0xA0, 0xD7, 0x08, 0xEB, 0x23, 0xB8, 0x9B, 0x13, 
+0xE9, 0xF4, 0x29, 0xCC, 0xCC, 0xE0, 0xDA, 0xD9, 
+0x7A, 0x6B, 0x1F, 0xF2, 0x31, 0x3E, 0xC0, 0x65, 
+0xBC, 0xCE, 
+
+0x3E, 0x05, 0x36, 0xA5, 0x52, 0x00, 0x2F, 0xA1, 
+0x3D, 0x33, 0xFF, 0xA3, 0x87, 0xAE, 0xCD, 0xB0, 
+0x57, 0x60, 0xED, 0x0E, 0xFD, 0x56, 0xB5, 0x2E, 
+0xF9, 0x7E, 0x99, 0xA6, 0x6A, 0xEE, 0xB3, 0xBF, 
+0x73, 0xAC, 0x21, 0xDB, 0xBB, 0xDB, 0x1E, 0x92, 
+0xAD, 0xA0, 0x0B, 0x8A, 0x9F, 0xA0, 0x67, 0xD3, 
+0x13, 0x9D, 0x23, 0xF7, 0x17, 0x83, 0x8E, 0x61, 
+0xCF, 0x96, 0x8D, 0x01, 0x21, 0xAE, 0x38, 0x07, 
+0xBD, 0x78, 0x50, 0x49, 0xDE, 0x70, 0x97, 0x09, 
+0xA5, 0xB5, 0x24, 0xBB, 0x24, 0x9B, 0x91, 0x4B, 
+0x6E, 0x9E, 0xF1, 0xD5, 0xE2, 0x11, 0xE9, 0xAD, 
+0x1B, 0xD7, 0x8C, 0xFF, 0xA3, 0x19, 0xFF, 0xAF, 
+0x72, 0x58, 0x5D, 0x5C, 0x96, 0x92, 0x88, 0x3F, 
+0xD6, 0xDF, 0x6E, 0x45, 0x1E, 0x82, 0x14, 0x6D, 
+0x5F, 0x5B, 0x2F, 0xA5, 0x61, 0xE0, 0x49, 0xCD, 
+0xA6, 0xC7, 0xE5, 0x1C, 0x84, 0xB2, 0x0F, 0xEF, 
+0xFC, 0xAA, 0x35, 
+
+0x9D, 0x60, 0x23, 0x24, 0x1C, 0x83, 0xAB, 0x4A, 
+0x3B, 0xC5, 0x2C, 0x43, 0x75, 0x05, 0x87, 0xF2, 
+0x4E, 0x59, 0x6D, 0xF0, 0x8A, 0x52, 0xC4, 0x43, 
+0x5A, 0xDD, 0x2C, 0x8B, 0x48, 0xEA, 0x65, 0x10, 
+0x3A, 0x22, 0x62, 0xD5, 0x65, 0x65, 0x03, 0x17, 
+0x63, 0xEE, 0x66, 0xC1, 0x4C, 0xFD, 0x7B, 0xB1, 
+0x2F, 0x95, 0x2F, 0x27, 0x0C, 0xFD, 0x4B, 0x4A, 
+0x2F, 0xD5, 0x3A, 0xB4, 0x0B, 0x30, 0xD9, 0x13, 
+0xE8, 0xB0, 0x77, 0xA2, 0xD7, 0xD0, 0x17, 0x52, 
+0x17, 0xB7, 0x16, 0x29, 0x23, 0xE7, 0xE6, 0x9C, 
+0x5D, 0x51, 0xA6, 0x70, 0x86, 0x0D, 0x1F, 0xC7, 
+0xCD, 0x95, 0xE2, 0xED, 0x6D, 0xA3, 0x5F, 0x66, 
+0x86, 0xFB, 0x3C, 0x34, 0x60, 0xEF, 0xB4, 0xDB, 
+0x2C, 0x45, 0x71, 0x4B, 0x90, 0xB8, 0x6A, 0x32, 
+0x31, 0xE7, 0xDE, 0x7A, 0x13, 0xCD, 0x22, 0x64, 
+0x76, 0x23, 0xD3, 0xA6, 0xE6, 0x4F, 0x6D, 0x87, 
+0x74, 0x8B, 0xE6, 0x57, 
+
+0x4A, 0x5B, 0xF3, 0xFC, 0x6B, 0x24, 0xB5, 0xEB, 
+0x08, 0x7C, 0x22, 0x92, 0xC5, 0x76, 0x3A, 0x29, 
+0xEA, 0xEA, 0xDB, 0xFD, 0x6C, 0x30, 0xD0, 0x08, 
+0xE3, 0x5F, 0x3F, 0x05, 0x1A, 0xA0, 0x92, 0x63, 
+
+0xE2, 0x90, 0x56, 0x72, 0x4A, 0x98, 0x86, 0x71, 
+0x9F, 0x47, 0x92, 0xBD, 0xCF, 0x15, 0x0A, 0xAE, 
+0x78, 0xF8, 0xDF, 0x0C, 0xC5, 0x13, 0x42, 0xC0, 
+0x6C, 0xCA, 0x44, 0x3A, 0x58, 0x3F, 0x24, 0x9E, 
+0xDB, 
+
+0x58, 0x89, 0x8E, 0x98, 0x13, 0x2E, 0x31, 0x94, 
+0xD9, 0x79, 0x11, 0x75, 0x83, 0xD4, 0x1E, 0x0F, 
+0xDD, 0x7C, 0x43, 0x5D, 0x42, 0x94, 0x3D, 0xB8, 
+0x02, 0x3D, 0x5A, 0xAD, 0x9D, 0x39, 0x7C, 0xA7, 
+0xDF, 0x0C, 
+
+0x3A, 0xEE, 0xF3, 0xC5, 0xB6, 0x73, 0xAD, 0x8F, 
+0x38, 0x3C, 0x15, 0x2B, 0x2E, 0x0B, 0x42, 0xC7, 
+0x5E, 0x90, 0x2A, 0x02, 0x9E, 0x48, 0x82, 0xD3, 
+0x5F, 0x15, 0xD0, 0x04, 0xED, 0x56, 0x50, 0x47, 
+0x48, 0x76, 0x2E, 
+
+0xC7, 0xCC, 0x74, 0x02, 0xE6, 0x27, 0x17, 0x87, 
+0x81, 0xF9, 0x2E, 0x22, 0xE7, 0x59, 0xA3, 0x07, 
+0xBC, 0xC0, 0xB6, 0xBA, 0x2F, 0x8C, 0x59, 0xD3, 
+0x7A, 0xF7, 0x4E, 0x0B, 0x19, 0x61, 0x12, 0x07, 
+0xE9, 0xF6, 0x4C, 0x0B, 
+
+0xA1, 0x7B, 0xDB, 0x1B, 0xF4, 0xD6, 0xE8, 0x5D, 
+0x9E, 0x16, 0xC8, 0xDF, 0x0B, 0x23, 0x19, 0x87, 
+0xA9, 0x20, 0x26, 0x06, 0x1B, 0xD7, 0x1D, 0x94, 
+0x46, 0xD9, 0x4B, 0x96, 0x2D, 0x06, 0xEA, 0xB9, 
+0x92, 0xE1, 0x02, 0x1B, 0x10, 
+
+0xC3, 0x2A, 0x8C, 0x57, 0xF5, 0x54, 0xF7, 0xE9, 
+0x23, 0x2D, 0x23, 0x14, 0x63, 0x46, 0x94, 0x7F, 
+0xA1, 0x25, 0x88, 0x52, 0x4D, 0x49, 0xF2, 0xC9, 
+0x75, 0x12, 0x82, 0xD6, 0x35, 0x28, 0x26, 0xF3, 
+0xC0, 0x9E, 0xAB, 0x9B, 0x05, 0xDD, 
+
+0xC9, 0x8F, 0x60, 0x57, 0x24, 0xCC, 0x80, 0xAF, 
+0xD2, 0x61, 0x69, 0xF3, 0xB3, 0xFF, 0x84, 0x60, 
+0xC3, 0x6D, 0x10, 0x02, 0xE9, 0x8C, 0xAE, 0x2C, 
+0x95, 0x34, 0x4E, 0xBF, 0x82, 0x6E, 0x34, 0x9C, 
+0x9B, 0x48, 0xDA, 0x3B, 0xFB, 0xE1, 0x74, 
+
+0x5C, 0xAF, 0xF6, 0xEA, 0xCB, 0xFB, 0x92, 0x8D, 
+0x55, 0x3B, 0x28, 0x0F, 0x4C, 0x83, 0x28, 0x77, 
+0x05, 0xAA, 0x0E, 0x81, 0x70, 0xAC, 0x7F, 0xB6, 
+0xF2, 0x16, 0x1D, 0xE2, 0xF8, 0x9A, 0x76, 0x99, 
+0x6C, 0x5A, 0x92, 0xF5, 0xC6, 0x5C, 0xF3, 0xD6, 
+
+0xAB, 0x43, 0x81, 0x6F, 0xE1, 0x4D, 0x3D, 0x2D, 
+0x6B, 0x61, 0xD9, 0x01, 0xFF, 0x90, 0xB1, 0x1C, 
+0x70, 0x95, 0x96, 0x9C, 0xF7, 0x3F, 0x69, 0x3E, 
+0x5C, 0xD2, 0xC0, 0xDA, 0x16, 0xD7, 0x38, 0xA1, 
+0xA7, 0x23, 0x9A, 0x86, 0x5F, 0xB0, 0x18, 0xAA, 
+0x9F, 
+
+0xA9, 0xC9, 0xC0, 0xDB, 0xFB, 0x33, 0x2B, 0xF8, 
+0xC7, 0x99, 0x7B, 0xE8, 0x4C, 0x4E, 0x21, 0xF5, 
+0x87, 0x31, 0x85, 0x4A, 0xDE, 0x8D, 0xA1, 0xAD, 
+0x53, 0xE1, 0xBB, 0x5B, 0x59, 0xA3, 0x9B, 0xB3, 
+0xF6, 0x41, 0x7F, 0x1A, 0xE2, 0x61, 0x99, 0x00, 
+0x69, 0xFA, 
+
+0xF2, 0x7B, 0x5E, 0xBD, 0x20, 0x88, 0x80, 0xCF, 
+0x62, 0xCA, 0x90, 0x85, 0xDF, 0x40, 0xAE, 0x81, 
+0x2E, 0x91, 0xA1, 0x1F, 0xD4, 0xF6, 0x41, 0x14, 
+0xE6, 0x0B, 0x04, 0x4E, 0xCB, 0x41, 0x6B, 0x2B, 
+0x74, 0xA3, 0xE7, 0x76, 0x37, 0x5C, 0x70, 0x6E, 
+0x10, 0xF0, 0x31, 
+
+0x94, 0x44, 0xB1, 0xEE, 0xE6, 0x25, 0x51, 0x8B, 
+0x13, 0x6B, 0xD9, 0xE6, 0xE4, 0x1F, 0x33, 0xF2, 
+0x80, 0xCE, 0x22, 0xC8, 0xB3, 0x3C, 0x5A, 0x17, 
+0x3A, 0x0D, 0x7D, 0x0C, 0x03, 0xC3, 0xBD, 0xD0, 
+0xC2, 0x7C, 0x18, 0xCB, 0x1A, 0x80, 0x68, 0xFE, 
+0x04, 0xA7, 0xB7, 0x6E, 
+
+0x22, 0xB1, 0xE8, 0x7E, 0x66, 0xA3, 0xC4, 0xF4, 
+0x88, 0x4E, 0x59, 0x71, 0xD8, 0x15, 0x78, 0x03, 
+0x6E, 0x46, 0x98, 0x71, 0x19, 0x03, 0xD0, 0xD3, 
+0xF5, 0x2D, 0x33, 0xB4, 0x66, 0xF8, 0x4D, 0x8F, 
+0x2A, 0x96, 0xDA, 0xEF, 0x9D, 0x7C, 0x11, 0xCF, 
+0x7D, 0x22, 0xBD, 0xC9, 0xCD, 
+
+0xD4, 0x26, 0x06, 0x40, 0x54, 0x20, 0x75, 0x71, 
+0xC9, 0x57, 0x5F, 0x1A, 0x5A, 0x64, 0xA0, 0x13, 
+0x28, 0xBE, 0xF2, 0x9F, 0x19, 0xEE, 0xFE, 0xDE, 
+0x91, 0x50, 0x8D, 0xA2, 0xA1, 0xFE, 0xB2, 0x74, 
+0xB5, 0xDB, 0x14, 0x0C, 0xEC, 0x8F, 0x5E, 0xC1, 
+0x8E, 0x9C, 0x24, 0x47, 0x33, 0xE9, 
+
+0xAF, 0xED, 0x9E, 0xE8, 0x8B, 0xB3, 0x9B, 0xD4, 
+0x1C, 0x3D, 0xA6, 0xEC, 0x06, 0x8C, 0x7B, 0xCC, 
+0x54, 0x41, 0xD8, 0xC4, 0x85, 0x36, 0xF2, 0xF0, 
+0x1C, 0x8D, 0x72, 0xE0, 0x9B, 0xF6, 0xDF, 0x87, 
+0xF3, 0x94, 0x3A, 0xC6, 0xD2, 0xAC, 0x75, 0x06, 
+0x91, 0xDE, 0x65, 0xC8, 0x66, 0x42, 0xAC, 
+
+0x91, 0x5C, 0xD7, 0x3D, 0x06, 0x14, 0x73, 0x98, 
+0xF1, 0xAD, 0x23, 0x9C, 0xDD, 0x29, 0xD4, 0x04, 
+0x4D, 0xC8, 0x5B, 0x7F, 0x1B, 0x05, 0xBA, 0x9D, 
+0x02, 0xCE, 0x40, 0x97, 0x57, 0xC7, 0xE1, 0xB6, 
+0xF0, 0xA9, 0xFC, 0x36, 0x60, 0xBA, 0x21, 0x8E, 
+0x97, 0xB2, 0x86, 0x86, 0x21, 0x2A, 0x15, 0x83, 
+
+0x0D, 0x4B, 0xF7, 0x4D, 0xA4, 0xFB, 0x09, 0x97, 
+0x8D, 0xB7, 0xD0, 0x04, 0x65, 0x9E, 0xBA, 0x2F, 
+0x3D, 0x00, 0x56, 0x6F, 0x08, 0xDA, 0x40, 0x0D, 
+0x2E, 0xF3, 0x57, 0xA4, 0x74, 0x89, 0x03, 0x21, 
+0xD4, 0xE4, 0xFA, 0x64, 0x82, 0x5D, 0x1C, 0x76, 
+0xAC, 0x89, 0x1F, 0x71, 0x0F, 0xD8, 0x04, 0x4E, 
+0xB6, 
+
+0x53, 0x11, 0x4C, 0x2B, 0x0F, 0x03, 0xB0, 0xC2, 
+0x29, 0xA4, 0xFA, 0x3E, 0x30, 0x81, 0x33, 0xFC, 
+0x3D, 0x80, 0x77, 0xE9, 0xFC, 0xC0, 0x4D, 0xB0, 
+0x9F, 0x37, 0xEB, 0x3C, 0xF1, 0xA6, 0x07, 0x9F, 
+0xFE, 0x5B, 0x4A, 0x70, 0x7E, 0xC7, 0xBD, 0x8F, 
+0xA8, 0x4E, 0xE5, 0x88, 0x3F, 0xD2, 0x20, 0x09, 
+0x50, 0xAC, 
+
+0x02, 0x0E, 0x2E, 0xB5, 0xC4, 0x7B, 0xD3, 0x44, 
+0xDE, 0x09, 0x6F, 0x04, 0xEA, 0x06, 0x9C, 0x71, 
+0x7E, 0x60, 0x29, 0x39, 0x08, 0x0A, 0x84, 0x7A, 
+0x13, 0xC5, 0x03, 0xA0, 0xA5, 0x73, 0x5A, 0xEF, 
+0xCB, 0x62, 0x45, 0xF9, 0x6D, 0xE9, 0x5A, 0xEF, 
+0x27, 0x4C, 0x07, 0x2E, 0x74, 0x80, 0xE1, 0x01, 
+0x16, 0x42, 0x02, 
+
+0x02, 0xEE, 0x7E, 0xDB, 0x01, 0xAB, 0x3F, 0x3A, 
+0x63, 0x0E, 0xDE, 0x2E, 0x57, 0x97, 0x9F, 0xAB, 
+0xC4, 0x68, 0xC4, 0xFC, 0xFC, 0x66, 0x98, 0xB4, 
+0x7A, 0x8F, 0x61, 0x5F, 0x9C, 0x41, 0x4F, 0x0C, 
+0xE1, 0x0D, 0x82, 0x11, 0x86, 0xC7, 0x56, 0x96, 
+0xE0, 0x7B, 0x2A, 0x33, 0x0B, 0x3F, 0xFC, 0x21, 
+0xD3, 0x95, 0x5B, 0xC4, 
+
+0x59, 0xA2, 0xDC, 0x2A, 0x78, 0x5C, 0x6F, 0xA4, 
+0x40, 0x2C, 0x04, 0xD3, 0xD4, 0x75, 0x22, 0x6C, 
+0x64, 0xB3, 0x6A, 0xD9, 0x7F, 0x28, 0x49, 0x87, 
+0x0D, 0x2F, 0xA7, 0x11, 0x44, 0xDC, 0x89, 0x88, 
+0x1B, 0x9F, 0xEB, 0x9E, 0x09, 0x83, 0x0A, 0x2C, 
+0x7E, 0x53, 0x05, 0x20, 0x65, 0x56, 0x5C, 0xBB, 
+0xF9, 0x70, 0xDE, 0x43, 0xB9, 
+
+0xBD, 0x3D, 0x93, 0xED, 0xAB, 0x52, 0xE7, 0x98, 
+0xF2, 0x92, 0xD6, 0x91, 0x07, 0x88, 0xBD, 0x39, 
+0x83, 0x95, 0x76, 0x96, 0x8C, 0x28, 0x80, 0x83, 
+0x3E, 0x7C, 0x5A, 0x98, 0xEC, 0xD3, 0x20, 0x33, 
+0x8B, 0x44, 0x9F, 0x9A, 0x9C, 0x11, 0xAD, 0x1A, 
+0x45, 0x51, 0x48, 0xF6, 0xF9, 0xED, 0xEB, 0x5C, 
+0x0F, 0xE5, 0x56, 0x0F, 0x44, 0x82, 
+
+0xC9, 0x41, 0xBD, 0x9D, 0x2E, 0x10, 0x23, 0x7B, 
+0x2B, 0x9F, 0x9A, 0x09, 0x3B, 0xE2, 0xD9, 0x09, 
+0x7C, 0xA5, 0x1C, 0x2F, 0x6F, 0x3F, 0xDD, 0xBB, 
+0x84, 0xBA, 0xC2, 0xBA, 0x65, 0x73, 0x50, 0x7C, 
+0xD5, 0xD8, 0x70, 0x01, 0x90, 0x27, 0xBE, 0xE2, 
+0x52, 0xCE, 0xFB, 0x49, 0x9C, 0x81, 0xFF, 0x2B, 
+0x62, 0x73, 0xA9, 0xC0, 0xD8, 0x5C, 0xD6, 
+
+0x62, 0x6D, 0xC7, 0x64, 0x27, 0xB6, 0x5F, 0xFE, 
+0xC8, 0x5E, 0x16, 0x63, 0x6E, 0xCF, 0x8B, 0xCE, 
+0xDC, 0x97, 0xC1, 0x1D, 0x21, 0x30, 0x15, 0xF3, 
+0xE2, 0x50, 0x81, 0x2B, 0xEE, 0xAF, 0x3D, 0xFB, 
+0x28, 0x93, 0xF1, 0xDE, 0x3A, 0x48, 0xDF, 0x2B, 
+0xDF, 0x28, 0x58, 0xC8, 0xF3, 0x2B, 0xF1, 0x15, 
+0xAE, 0x51, 0x48, 0x84, 0xAE, 0xAD, 0x0B, 0x91, 
+
+0xB2, 0x5D, 0xC0, 0xF8, 0xB7, 0x3C, 0xB7, 0xC4, 
+0x43, 0xD1, 0xA3, 0xAD, 0x3C, 0x94, 0xAC, 0xF3, 
+0xAF, 0x37, 0xE9, 0xF1, 0xFF, 0xE0, 0x28, 0x03, 
+0x7D, 0xB0, 0x5F, 0xA4, 0xD1, 0x5A, 0x56, 0xBD, 
+0x7F, 0xF2, 0xCF, 0xA5, 0x15, 0x22, 0x44, 0xD7, 
+0x66, 0xCA, 0x5E, 0x23, 0x2F, 0xEB, 0x52, 0x26, 
+0x6C, 0x04, 0x23, 0x4B, 0xEE, 0x8D, 0x9A, 0xCC, 
+0x2C, 
+
+0x55, 0x2A, 0x39, 0xB5, 0x80, 0x7B, 0xED, 0x3C, 
+0x43, 0x3D, 0x6B, 0xAE, 0x4A, 0xEC, 0x39, 0xC1, 
+0xC5, 0xD5, 0xBA, 0x9A, 0xD2, 0xAC, 0x6E, 0x89, 
+0x0D, 0x9D, 0x48, 0x41, 0xEF, 0x5B, 0x56, 0xF8, 
+0x6B, 0x37, 0x7D, 0x85, 0x38, 0x26, 0xA8, 0x3D, 
+0xDE, 0x43, 0x07, 0x45, 0x93, 0x7F, 0xE8, 0x58, 
+0xEF, 0x97, 0x6E, 0x6B, 0x1C, 0x7D, 0x02, 0xED, 
+0x7D, 0x90, 
+
+0x8B, 0x53, 0x7D, 0x6F, 0xED, 0x3C, 0xBC, 0xF5, 
+0xB0, 0x69, 0x6D, 0xBD, 0x1F, 0xBA, 0x01, 0x3F, 
+0x2B, 0x94, 0x81, 0x1B, 0x62, 0x7C, 0x0E, 0xF8, 
+0x81, 0x00, 0xC3, 0xA1, 0x75, 0x5E, 0x35, 0xF8, 
+0xDE, 0x4C, 0x2D, 0xF2, 0x3E, 0x7F, 0xA4, 0x30, 
+0x6E, 0xE1, 0xA6, 0x30, 0x0E, 0x6F, 0x9B, 0xFD, 
+0xA4, 0x98, 0x4A, 0x4E, 0x2C, 0x73, 0xC4, 0xB9, 
+0x0B, 0x21, 0xD4, 
+
+0x09, 0x8B, 0x71, 0xC2, 0x5A, 0x49, 0x1D, 0x39, 
+0x74, 0x0D, 0xED, 0xFE, 0x96, 0x6B, 0x3A, 0x4B, 
+0x9F, 0x3A, 0x4C, 0x76, 0x70, 0xE5, 0xE6, 0xD2, 
+0xAF, 0x61, 0x9F, 0x54, 0xCD, 0xDE, 0xB4, 0x21, 
+0xA3, 0xE8, 0x91, 0x37, 0xAC, 0x52, 0x8B, 0xB9, 
+0x87, 0x64, 0x33, 0xF0, 0xD4, 0x92, 0xBD, 0xE8, 
+0x24, 0xB0, 0x32, 0x2F, 0x02, 0xEF, 0x31, 0xA5, 
+0xC1, 0x9E, 0x2A, 0x74, 
+
+0xA5, 0xF2, 0x46, 0x37, 0x23, 0x38, 0xB9, 0x28, 
+0x60, 0x57, 0x29, 0x76, 0xCD, 0x0A, 0x19, 0x9D, 
+0x4F, 0x34, 0xE3, 0x8A, 0x93, 0x86, 0x70, 0x89, 
+0x4A, 0x1C, 0xE4, 0x62, 0x3C, 0x6A, 0x18, 0x84, 
+0xCD, 0x36, 0xCD, 0x32, 0x5F, 0x2C, 0x0A, 0x8C, 
+0xDC, 0x89, 0x02, 0xB9, 0xB4, 0x26, 0xF4, 0x83, 
+0x1F, 0xC9, 0xC4, 0xF1, 0xC9, 0xF1, 0x76, 0x15, 
+0x36, 0x31, 0x68, 0xB4, 0xDF, 
+
+0xEF, 0x8C, 0x89, 0x6D, 0x51, 0x56, 0x5A, 0x86, 
+0xC8, 0x6B, 0x8D, 0x3E, 0xBA, 0x40, 0x4D, 0x37, 
+0x33, 0x0A, 0xCD, 0x2F, 0x6E, 0xE8, 0x62, 0x5D, 
+0x0C, 0x9C, 0x4F, 0x07, 0xCC, 0x2F, 0x8A, 0xC9, 
+0x6D, 0xDF, 0x05, 0xB4, 0xB8, 0xCC, 0x9D, 0xB8, 
+0x0A, 0x44, 0x6E, 0x7B, 0x96, 0xFC, 0x81, 0x8F, 
+0xE5, 0x3E, 0x6E, 0xA0, 0x8E, 0x4A, 0xAF, 0xD1, 
+0xE8, 0x87, 0x4A, 0xB1, 0xEC, 0x0C, 
+
+0xBC, 0xBC, 0x4D, 0x60, 0x74, 0x2A, 0xD4, 0x20, 
+0xF2, 0x38, 0xB2, 0x8F, 0xEC, 0xEE, 0x70, 0x69, 
+0x45, 0x39, 0xE5, 0x83, 0xD2, 0xF9, 0x2E, 0xCC, 
+0xFA, 0x54, 0x28, 0x2B, 0xB8, 0x26, 0x8D, 0xB4, 
+0x99, 0x87, 0x9B, 0xB6, 0x78, 0x2A, 0xD6, 0xB9, 
+0xD0, 0x45, 0xC9, 0x05, 0x82, 0x14, 0xC3, 0x9E, 
+0x61, 0x5D, 0xE6, 0xB9, 0x90, 0x87, 0xCD, 0x05, 
+0x1F, 0x81, 0x69, 0xCF, 0xEB, 0x13, 0xAD, 
+
+0x36, 0x76, 0x5E, 0x6A, 0x12, 0xD5, 0xEF, 0x22, 
+0x49, 0xE0, 0x48, 0x4E, 0x38, 0xCA, 0x79, 0x04, 
+0xC6, 0xD7, 0x42, 0x45, 0x60, 0x72, 0x61, 0x5A, 
+0x9D, 0x36, 0x1A, 0x52, 0x4F, 0x29, 0xF9, 0x73, 
+0x78, 0xE9, 0x13, 0x02, 0x8B, 0x8F, 0x2F, 0xD6, 
+0xC0, 0xF9, 0x48, 0xEC, 0xD7, 0x5A, 0xE9, 0x7F, 
+0x2D, 0x94, 0xD8, 0x68, 0xB6, 0x62, 0xBB, 0xE8, 
+0x77, 0x26, 0x96, 0x8B, 0xEA, 0x17, 0x73, 0x46, 
+
+0x0E, 0x47, 0x93, 0x4A, 0x03, 0x8B, 0x8B, 0xCC, 
+0xC5, 0x3C, 0xDB, 0xC4, 0x75, 0x99, 0x79, 0x28, 
+0x00, 0xB4, 0x69, 0x60, 0x78, 0xFE, 0x58, 0x9F, 
+0x4F, 0xDA, 0x9A, 0xDC, 0x3D, 0xD6, 0x5D, 0x90, 
+0x97, 0x8F, 0x20, 0xE5, 0xFB, 0x1A, 0xFC, 0x54, 
+0xCC, 0x9E, 0x5D, 0x1C, 0xF4, 0xB1, 0xE2, 0x58, 
+0x69, 0x08, 0x76, 0x7D, 0x23, 0xFE, 0xC2, 0xB6, 
+0x9A, 0xE9, 0xBE, 0xEC, 0xC4, 0xEE, 0x69, 0x0B, 
+0x69, 
+
+0xE5, 0x49, 0x44, 0x63, 0xD9, 0x44, 0x49, 0xCA, 
+0x30, 0x4D, 0xB9, 0xE6, 0xC1, 0x7F, 0x58, 0x27, 
+0x38, 0xA5, 0xDC, 0x04, 0xDF, 0xED, 0x7D, 0xD1, 
+0xAF, 0x65, 0xAB, 0x15, 0xA7, 0xE4, 0x45, 0xB9, 
+0x01, 0x14, 0x93, 0x22, 0xD7, 0x1C, 0x2C, 0xDA, 
+0x59, 0x22, 0x10, 0xCA, 0xEF, 0x85, 0x0B, 0x8B, 
+0xC2, 0x6F, 0x9D, 0xA2, 0xE9, 0xAE, 0x7A, 0xC8, 
+0x24, 0x9C, 0x90, 0xDF, 0x83, 0xA4, 0x27, 0x45, 
+0xE1, 0x9C, 
+
+0x7D, 0x87, 0x92, 0x46, 0x92, 0x94, 0x46, 0x76, 
+0x6A, 0x81, 0xF9, 0x16, 0xDA, 0x1B, 0x8D, 0x0C, 
+0x0A, 0x42, 0x5A, 0x20, 0xEA, 0x7B, 0x03, 0x53, 
+0x75, 0x15, 0x8A, 0x89, 0x46, 0xBE, 0xED, 0x35, 
+0xF2, 0x9C, 0x82, 0x47, 0xA1, 0xFF, 0xF7, 0xDC, 
+0x89, 0x50, 0x8C, 0xBB, 0x98, 0xCA, 0x88, 0x08, 
+0xCD, 0x2B, 0x78, 0xEF, 0xF5, 0x2C, 0x69, 0x23, 
+0x70, 0x98, 0xB6, 0xAD, 0xB7, 0xEB, 0xB3, 0x97, 
+0xC7, 0x68, 0xC2, 
+
+0x8F, 0x83, 0x2E, 0x51, 0x6D, 0x36, 0xAA, 0x0B, 
+0x05, 0x28, 0xF0, 0x10, 0x12, 0x0D, 0x93, 0x96, 
+0x4B, 0x7B, 0x1C, 0xC1, 0xE5, 0xEC, 0x8C, 0xCA, 
+0x34, 0xA2, 0x48, 0x15, 0x41, 0x4F, 0x67, 0x78, 
+0xFF, 0x3F, 0x22, 0x62, 0x1C, 0x5A, 0xC1, 0x9E, 
+0xF5, 0xFD, 0xF9, 0x72, 0x67, 0x2E, 0x86, 0x23, 
+0xFD, 0xB8, 0x6C, 0x44, 0xBA, 0xAB, 0xE2, 0x66, 
+0x63, 0x71, 0x1D, 0xF0, 0xA8, 0x30, 0x94, 0xA1, 
+0x0C, 0xB6, 0x59, 0x44, 
+
+0x00, 0x24, 0x1A, 0x1E, 0x27, 0xA4, 0x02, 0x9D, 
+0xAF, 0x2B, 0x3B, 0xD2, 0xD1, 0x53, 0xF9, 0x5B, 
+0xFD, 0xED, 0x00, 0xFC, 0x92, 0x9E, 0xE3, 0x37, 
+0x58, 0x37, 0x85, 0x49, 0xBB, 0x41, 0x68, 0x05, 
+0x33, 0x94, 0x4E, 0x56, 0x04, 0x74, 0xF0, 0x21, 
+0x13, 0x9D, 0x6C, 0xE6, 0x38, 0x49, 0x75, 0x78, 
+0x51, 0xB2, 0xD2, 0xCC, 0x1A, 0x9E, 0xBE, 0x42, 
+0xDC, 0x0B, 0xAB, 0xE7, 0x64, 0xBB, 0x35, 0x6C, 
+0xAF, 0xED, 0xEF, 0x59, 0x8E, 
+
+0x5B, 0xFA, 0x49, 0xA9, 0xD3, 0x4B, 0x77, 0x14, 
+0x98, 0xF0, 0x28, 0x32, 0xC3, 0x5B, 0xC9, 0x97, 
+0x2B, 0x6D, 0xAA, 0x1B, 0x62, 0xB5, 0x1E, 0xD1, 
+0x30, 0x9A, 0xA9, 0x25, 0x30, 0x0A, 0x9D, 0xEB, 
+0x68, 0x0A, 0x57, 0xB0, 0x98, 0xBC, 0x0F, 0xAF, 
+0x4F, 0x81, 0x78, 0xFF, 0x71, 0x86, 0x9D, 0x5F, 
+0xF1, 0xF3, 0x6E, 0x0E, 0x1F, 0x30, 0x64, 0xFD, 
+0x96, 0xF8, 0x07, 0x6F, 0x21, 0xA8, 0x9C, 0x65, 
+0x94, 0x91, 0x9F, 0x5E, 0x7E, 0x02, 
+
+0xBC, 0xF8, 0x16, 0x81, 0x95, 0x67, 0xAA, 0x53, 
+0x34, 0x9C, 0xE5, 0xE0, 0x60, 0xA0, 0x9E, 0x40, 
+0x5B, 0x36, 0xBF, 0x26, 0x16, 0x82, 0x5E, 0x58, 
+0x17, 0xD2, 0x93, 0xC3, 0xC4, 0xE1, 0x2A, 0x3F, 
+0xFB, 0x1C, 0x3F, 0x85, 0xAD, 0xB4, 0xF6, 0xE0, 
+0xD1, 0x63, 0xEF, 0x98, 0x3B, 0x09, 0xD2, 0x5F, 
+0xB4, 0xFF, 0x46, 0xD2, 0x95, 0x04, 0xBF, 0x06, 
+0x83, 0xE9, 0xC9, 0x09, 0xFB, 0xBA, 0x1B, 0x8F, 
+0x01, 0x16, 0x8A, 0x56, 0x71, 0x78, 0x0C, 
+
+0xF6, 0x32, 0xD8, 0xA4, 0xC4, 0x8C, 0x8C, 0xFD, 
+0x35, 0xF9, 0x8F, 0xCC, 0xA2, 0xE7, 0x3C, 0x68, 
+0xBE, 0x72, 0x15, 0xAB, 0x7F, 0x7E, 0x7D, 0x89, 
+0x47, 0x38, 0xAF, 0x66, 0xCA, 0xB8, 0x2A, 0xBC, 
+0x60, 0x23, 0xB0, 0xE7, 0x9C, 0xB1, 0x40, 0xC8, 
+0x52, 0x0E, 0x70, 0x12, 0xFD, 0xF9, 0xD4, 0xBD, 
+0xC3, 0xB0, 0x3A, 0xDC, 0x03, 0x25, 0x0B, 0xB0, 
+0xD5, 0x2A, 0x92, 0xE3, 0x13, 0x9A, 0x96, 0x47, 
+0xAC, 0x33, 0xA7, 0xFD, 0xCD, 0xBA, 0xAC, 0x55, 
+
+0xA3, 0x8C, 0xA7, 0x54, 0x30, 0x2D, 0x5F, 0x7E, 
+0xF4, 0xC5, 0xA4, 0x8D, 0x38, 0xA0, 0x29, 0xFB, 
+0x96, 0x12, 0xD8, 0xD7, 0xD2, 0x1D, 0x5B, 0x2A, 
+0xE6, 0xB8, 0xE0, 0x7A, 0x19, 0x37, 0x1C, 0xE2, 
+0x00, 0x74, 0xFB, 0x11, 0x8F, 0x63, 0x66, 0x73, 
+0x2D, 0x8A, 0x5D, 0x9C, 0x24, 0x29, 0x35, 0x8E, 
+0xEF, 0x5E, 0x12, 0xEF, 0x31, 0xEC, 0xB7, 0xC4, 
+0x81, 0xD3, 0x4A, 0x15, 0x20, 0xD4, 0x28, 0x61, 
+0xD1, 0xFD, 0xF8, 0xA7, 0x30, 0x64, 0xCF, 0x8A, 
+0x9F, 
+
+0x32, 0x4F, 0xA5, 0x6C, 0x10, 0xD5, 0x33, 0x8F, 
+0x95, 0x40, 0xD0, 0xB9, 0x3D, 0x6F, 0x9B, 0x7C, 
+0x56, 0x68, 0x4F, 0x8F, 0xD4, 0xE0, 0x7E, 0x8C, 
+0x86, 0x1C, 0x9A, 0xB0, 0x63, 0xF3, 0xF7, 0x5E, 
+0x9D, 0xD9, 0x0B, 0x0E, 0x74, 0xB8, 0x56, 0x3C, 
+0x5A, 0x6A, 0x87, 0xB1, 0xF1, 0xA4, 0x6C, 0x5A, 
+0x13, 0xDC, 0xAB, 0x87, 0xC6, 0x3E, 0x42, 0x9A, 
+0x95, 0x47, 0xF4, 0xF1, 0x52, 0x54, 0x51, 0xC2, 
+0x57, 0xB3, 0xC9, 0x0A, 0xA6, 0x21, 0xFF, 0x1F, 
+0x74, 0xB3, 
+
+0xBA, 0xFC, 0x2F, 0xEA, 0x8A, 0xED, 0x21, 0x22, 
+0x6C, 0x45, 0x0D, 0x84, 0x0E, 0x28, 0x03, 0x1F, 
+0x16, 0xE3, 0x95, 0xB0, 0xE8, 0xC7, 0x7B, 0xCD, 
+0xC6, 0xC4, 0x41, 0x41, 0xD7, 0x47, 0xD1, 0x88, 
+0xE1, 0x07, 0x32, 0x74, 0xC1, 0x86, 0x16, 0xB3, 
+0xF3, 0xC3, 0xDF, 0xE9, 0xED, 0xE5, 0xEA, 0x52, 
+0x0D, 0xFE, 0xBC, 0xDD, 0xB5, 0x97, 0xC3, 0x48, 
+0x98, 0xA1, 0xEF, 0x13, 0x3E, 0x58, 0x06, 0xE0, 
+0xBD, 0x3E, 0x35, 0xF8, 0x5D, 0x7D, 0x5D, 0x08, 
+0x76, 0x33, 0x18, 
+
+0x56, 0x91, 0xE3, 0x4A, 0x4C, 0xDA, 0x0A, 0x18, 
+0x1D, 0x81, 0xED, 0x1E, 0x75, 0xD2, 0x15, 0xD2, 
+0xB8, 0x08, 0x16, 0xF8, 0x69, 0xE0, 0x6B, 0x06, 
+0x7A, 0x90, 0x92, 0xCC, 0x88, 0xDA, 0x9D, 0x72, 
+0xED, 0x26, 0x63, 0xBB, 0x4C, 0xEA, 0xAC, 0xC9, 
+0xDB, 0x89, 0x1F, 0xE1, 0xDA, 0xA8, 0xB6, 0xF0, 
+0x29, 0x1A, 0x0E, 0x1F, 0x21, 0x93, 0xDF, 0xEE, 
+0x95, 0xB1, 0xB1, 0xE7, 0x3C, 0x77, 0x08, 0xB9, 
+0x56, 0xB1, 0xFC, 0x83, 0x51, 0x3A, 0x34, 0xC5, 
+0x19, 0x79, 0xF1, 0x9A, 
+
+0x7B, 0xF3, 0xB4, 0x67, 0x3E, 0x9F, 0x8C, 0xB2, 
+0x19, 0x8B, 0xBD, 0xBE, 0x9A, 0x72, 0x8D, 0x70, 
+0x67, 0xE3, 0xB2, 0xAA, 0xE8, 0x9F, 0x7F, 0x0D, 
+0xC5, 0xAB, 0xB6, 0x60, 0xD4, 0xD6, 0x91, 0x78, 
+0x7D, 0x60, 0xC7, 0x9C, 0x33, 0x84, 0x00, 0x7C, 
+0x82, 0xA6, 0xC7, 0x06, 0x67, 0xB9, 0x07, 0xFD, 
+0xF7, 0x07, 0xC7, 0x88, 0xD6, 0xE2, 0xDE, 0x70, 
+0xF8, 0x96, 0x40, 0x64, 0x2B, 0x9F, 0x3E, 0x4B, 
+0x07, 0xE4, 0x88, 0xA0, 0x77, 0x0A, 0x41, 0x07, 
+0x6F, 0x42, 0x54, 0xC6, 0xED, 
+
+0x07, 0x9B, 0xE7, 0x22, 0x74, 0x87, 0x4F, 0xF0, 
+0xE9, 0xDF, 0xFF, 0x45, 0x31, 0xB7, 0x09, 0x95, 
+0xA5, 0x6A, 0xFD, 0x1E, 0xCC, 0xA7, 0x0F, 0xF5, 
+0x9A, 0xBD, 0x4A, 0x04, 0xA3, 0x62, 0x10, 0xB6, 
+0xCC, 0x09, 0x50, 0xCB, 0x51, 0xC3, 0xFA, 0xA8, 
+0x16, 0x85, 0x57, 0x3B, 0xB7, 0x82, 0x97, 0xD8, 
+0x1F, 0x78, 0xFB, 0xB1, 0x4F, 0x63, 0xDE, 0x3B, 
+0xD0, 0xA0, 0xDB, 0x28, 0xC9, 0x39, 0x26, 0x87, 
+0x37, 0xBA, 0xA5, 0x91, 0xE5, 0xB3, 0xF9, 0x5F, 
+0x74, 0x05, 0xCA, 0x38, 0x41, 0x04, 
+
+0xA4, 0xC0, 0x0A, 0x86, 0x48, 0xE1, 0x88, 0x37, 
+0x80, 0x4E, 0xD0, 0x0A, 0x88, 0x05, 0xE8, 0x95, 
+0xCF, 0x46, 0x64, 0xF0, 0x6F, 0x04, 0xD9, 0x46, 
+0x4E, 0xB0, 0x67, 0xE9, 0x1C, 0x76, 0x15, 0x8A, 
+0xAC, 0x14, 0xD8, 0x2B, 0xBC, 0xFC, 0xE1, 0x01, 
+0x17, 0xEE, 0x7A, 0x26, 0xA1, 0x15, 0x8A, 0x7A, 
+0x64, 0x33, 0xCD, 0x7A, 0xD3, 0xD5, 0x4B, 0x3B, 
+0x36, 0x39, 0xEC, 0xD9, 0xAF, 0x86, 0xA2, 0x18, 
+0x39, 0xD0, 0xAD, 0x7C, 0xDE, 0xF5, 0x5D, 0x2B, 
+0x34, 0x37, 0xF5, 0xD5, 0x2E, 0x38, 0x3C, 
+
+0x2D, 0x4B, 0xDB, 0xE0, 0xAB, 0xB8, 0xF2, 0x77, 
+0xF1, 0x01, 0xDD, 0x25, 0xC6, 0x9C, 0x00, 0xB8, 
+0xFC, 0x82, 0xCC, 0x84, 0x88, 0xA5, 0x41, 0x30, 
+0x2C, 0xD9, 0x89, 0x17, 0x01, 0x09, 0x91, 0x24, 
+0x46, 0xA9, 0x82, 0x9A, 0x1C, 0x73, 0xD8, 0x5E, 
+0x0F, 0xEC, 0x76, 0x9D, 0x5E, 0x29, 0x17, 0xF9, 
+0x84, 0x7D, 0xE5, 0x78, 0x91, 0x96, 0x11, 0x0E, 
+0x67, 0x44, 0x11, 0x2B, 0xF0, 0x52, 0xC5, 0x7D, 
+0x9D, 0x57, 0xE4, 0x21, 0x55, 0x35, 0x3D, 0xB4, 
+0xC4, 0xDF, 0x56, 0x7F, 0x74, 0x93, 0x80, 0x6A, 
+
+0xB8, 0x38, 0x4F, 0xA8, 0x00, 0x01, 0x40, 0x99, 
+0x7A, 0x05, 0x5D, 0xCE, 0x73, 0xD1, 0x84, 0x03, 
+0xF2, 0xAE, 0x9F, 0xCB, 0x95, 0xC0, 0x30, 0x36, 
+0x0B, 0x39, 0xCA, 0x9B, 0xCF, 0xF1, 0xC3, 0x78, 
+0xE8, 0x4D, 0xE3, 0x2C, 0x3E, 0x11, 0xCE, 0x51, 
+0xE0, 0xD7, 0x13, 0xE9, 0x54, 0xE7, 0xC4, 0x7F, 
+0xE8, 0xE0, 0x67, 0xE7, 0x55, 0x1C, 0xC1, 0x06, 
+0xEE, 0x5D, 0x50, 0x9C, 0x3B, 0x08, 0x9D, 0x4E, 
+0x35, 0x58, 0xC3, 0xC6, 0x15, 0x11, 0x59, 0x60, 
+0xCF, 0x42, 0x34, 0x1B, 0x07, 0xB0, 0x6F, 0xD9, 
+0x11, 
+
+0x37, 0x36, 0x79, 0x51, 0x17, 0xB6, 0x8E, 0x31, 
+0xDA, 0xDD, 0x47, 0xA2, 0x70, 0xC4, 0xEC, 0xE2, 
+0x62, 0x63, 0x00, 0xA6, 0xDF, 0x4E, 0x62, 0x12, 
+0xDC, 0x8F, 0xDC, 0xF2, 0x7C, 0x43, 0x5A, 0xC0, 
+0xD2, 0x4A, 0x02, 0xFE, 0xD8, 0xF3, 0x8C, 0x60, 
+0xED, 0x0D, 0xD3, 0x2F, 0xB0, 0x48, 0x2E, 0xDB, 
+0xAC, 0xB4, 0x96, 0x32, 0x50, 0x6D, 0x66, 0x56, 
+0x49, 0xA4, 0x25, 0xD6, 0x45, 0x39, 0xD5, 0xCC, 
+0x7E, 0xE6, 0xD6, 0x36, 0x6F, 0x3D, 0x9C, 0x7F, 
+0x39, 0xBE, 0x11, 0xAC, 0x10, 0x10, 0xBA, 0xB1, 
+0x13, 0x47, 
+
+0xED, 0x19, 0x89, 0x7D, 0x50, 0x22, 0xDA, 0x07, 
+0x46, 0x00, 0x21, 0x9B, 0x82, 0xE0, 0x0C, 0x72, 
+0xF4, 0xCA, 0xDE, 0xE9, 0xEB, 0x90, 0xD1, 0x8F, 
+0xA1, 0x44, 0x48, 0x6A, 0xCD, 0xBF, 0x4D, 0x33, 
+0x1F, 0x37, 0x08, 0xFB, 0x09, 0xA3, 0x18, 0x76, 
+0x15, 0x03, 0xBD, 0xBA, 0xED, 0xDC, 0x1B, 0xC8, 
+0x9D, 0x85, 0xA3, 0x60, 0xF5, 0x61, 0x1D, 0x2B, 
+0x44, 0x3D, 0xEF, 0x28, 0x9E, 0xEE, 0x77, 0x4B, 
+0x78, 0x11, 0xB3, 0xF2, 0xC9, 0x46, 0x8F, 0xD3, 
+0xF2, 0x85, 0x47, 0x2E, 0xB8, 0xCC, 0xA2, 0x7F, 
+0x60, 0xD7, 0xE9, 
+
+0x49, 0x41, 0x72, 0x8D, 0x14, 0x84, 0xBC, 0x60, 
+0x8F, 0xF9, 0x4C, 0xB6, 0xAD, 0xEF, 0x98, 0x25, 
+0x63, 0xEE, 0xC9, 0xC5, 0x61, 0x2F, 0x06, 0x14, 
+0xC8, 0x41, 0xE8, 0xD0, 0x9B, 0x84, 0x0B, 0xF2, 
+0x71, 0x67, 0xE1, 0xA8, 0x78, 0x61, 0x6F, 0x1A, 
+0x3F, 0xEE, 0x6B, 0x3A, 0xC5, 0xAD, 0x6E, 0x5F, 
+0x3D, 0xD2, 0xD2, 0xBB, 0x52, 0x0C, 0x89, 0x79, 
+0x05, 0xEF, 0x7A, 0xC1, 0xF4, 0x97, 0x66, 0x58, 
+0x2F, 0xC3, 0x8A, 0x8D, 0xBC, 0x12, 0xCD, 0xEE, 
+0x90, 0xF1, 0x9D, 0xDF, 0x11, 0x1E, 0xB0, 0x94, 
+0x88, 0x56, 0x5E, 0x62, 
+
+0x4D, 0x7B, 0x88, 0x87, 0x93, 0xC8, 0x83, 0xFA, 
+0x79, 0x8A, 0xFF, 0x8D, 0xF0, 0x4A, 0xC6, 0xB9, 
+0xFF, 0x87, 0x35, 0x30, 0xC7, 0x55, 0x8F, 0xB2, 
+0xDE, 0x4D, 0xC7, 0x89, 0x86, 0xDF, 0x9E, 0x16, 
+0xB6, 0xCF, 0xF8, 0x1C, 0x35, 0x53, 0x6E, 0xFC, 
+0xA4, 0x2B, 0xE4, 0x54, 0x7E, 0x25, 0x03, 0xA3, 
+0x65, 0xBC, 0x7E, 0x2C, 0x79, 0xFE, 0x6C, 0xBD, 
+0x52, 0xF8, 0x1D, 0x1F, 0xAD, 0x06, 0x29, 0xA5, 
+0xAC, 0xE8, 0xAE, 0x2A, 0x43, 0xBD, 0xCC, 0xE9, 
+0xDB, 0xB6, 0x34, 0x5B, 0x81, 0x6C, 0x6F, 0x77, 
+0xF6, 0x32, 0xDA, 0xF9, 0x56, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,5478 @@
+package utils
+func getkat6444_v30(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xE0, 0x2C, 0xF8, 0x79, 0x27, 0xEE, 0x47, 0xB3, 
+0x00, 0x0B, 0xE1, 0xED, 0xF3, 0xCF, 0x9E, 0x99, 
+0xA7, 0x73, 0xEB, 0x23, 0x71, 0xB7, 0xB4, 0x0A, 
+0xF4, 0xDF, 0xFE, 0x5F, 0x0D, 0x67, 0x73, 0x86, 
+
+0x85, 0xF6, 0xB1, 0xA2, 0xFD, 0x35, 0xDE, 0xD5, 
+0xFD, 0x69, 0xD2, 0x07, 0x92, 0x39, 0x2B, 0x47, 
+0x2F, 0x31, 0xD7, 0xC2, 0x70, 0x74, 0x60, 0x36, 
+0x2A, 0x3D, 0x2B, 0x43, 0xA4, 0xB8, 0xE2, 0x84, 
+0x80, 
+
+0x7A, 0xF0, 0x8D, 0xF2, 0x19, 0x9C, 0xBD, 0x27, 
+0xF6, 0x8D, 0xD7, 0xDF, 0x31, 0xCD, 0xD9, 0x87, 
+0xE8, 0x5F, 0x14, 0x03, 0x11, 0xB6, 0x22, 0x72, 
+0xED, 0xE9, 0x0F, 0x8F, 0x55, 0x87, 0x46, 0x49, 
+0xBE, 0x55, 
+
+0x5C, 0x49, 0x49, 0x8C, 0xA5, 0x89, 0x5D, 0xFB, 
+0xE7, 0xD5, 0xD5, 0xEF, 0x36, 0x3F, 0x5D, 0x68, 
+0x3A, 0xF2, 0x22, 0x81, 0xC2, 0x8A, 0x63, 0x91, 
+0x0D, 0xA7, 0x3C, 0xF0, 0x18, 0x53, 0x13, 0x39, 
+0x7C, 0xB3, 0xE9, 
+
+0xA0, 0x71, 0xCF, 0xFA, 0x72, 0x51, 0xEB, 0xE0, 
+0xE0, 0x66, 0x7F, 0xB9, 0xE6, 0x05, 0x8A, 0x89, 
+0xFF, 0xDD, 0x2E, 0xBF, 0xF3, 0x96, 0x45, 0x52, 
+0x99, 0xA1, 0xC9, 0xF2, 0x59, 0x44, 0x01, 0xC3, 
+0x41, 0xCA, 0xBC, 0x7E, 
+
+0x6E, 0xC7, 0x3E, 0xB3, 0xE9, 0x2F, 0xF9, 0x52, 
+0xF0, 0x8D, 0x89, 0x68, 0xFC, 0x61, 0x30, 0x66, 
+0x93, 0x82, 0x93, 0xBF, 0xA6, 0xF7, 0x49, 0x00, 
+0x3A, 0xD0, 0xEE, 0x62, 0x53, 0x27, 0xE8, 0x87, 
+0x2B, 0x6B, 0x0C, 0x94, 0x3E, 
+
+0x48, 0xE4, 0xA5, 0x8D, 0x80, 0x54, 0x7D, 0x5D, 
+0xFE, 0xD1, 0xD0, 0xF7, 0x7C, 0x4E, 0xEB, 0x32, 
+0x27, 0x2C, 0x1E, 0xEE, 0x21, 0x53, 0x04, 0x3B, 
+0x22, 0x3C, 0x61, 0xD0, 0xE8, 0xC2, 0x4E, 0xF9, 
+0xAA, 0xC3, 0xDA, 0x87, 0x1C, 0xC7, 
+
+0xE1, 0x4A, 0xB9, 0x63, 0x64, 0x8B, 0xD0, 0xA2, 
+0x0E, 0x62, 0x7B, 0x56, 0x95, 0xA8, 0x74, 0x82, 
+0xB3, 0x38, 0xE3, 0x92, 0x6C, 0x64, 0x28, 0x73, 
+0xEF, 0x2A, 0xE1, 0xFD, 0x7F, 0x3D, 0x53, 0xE5, 
+0xD1, 0x74, 0x5D, 0x9A, 0x01, 0x68, 0x81, 
+
+0x6C, 0x9F, 0xF8, 0xA6, 0x35, 0xE4, 0x2B, 0xC0, 
+0x27, 0xCD, 0x0B, 0xAA, 0xA5, 0x62, 0xAB, 0xE9, 
+0x96, 0xC9, 0xB7, 0x81, 0xE0, 0xED, 0x3A, 0xB9, 
+0xCF, 0x9E, 0x5C, 0x14, 0x0F, 0x76, 0xC6, 0x48, 
+0x58, 0x0F, 0xF5, 0xE9, 0xF9, 0xAC, 0xAA, 0x2F, 
+
+0x64, 0xEA, 0x87, 0x2A, 0x73, 0xA5, 0xB6, 0x71, 
+0x34, 0x55, 0x4E, 0xDA, 0x45, 0x6A, 0x56, 0x7C, 
+0x6D, 0x7F, 0x4F, 0xF7, 0xFF, 0x5D, 0x94, 0x12, 
+0x50, 0x7C, 0x00, 0x28, 0x68, 0xB7, 0x47, 0x6F, 
+0x8E, 0x53, 0x07, 0x47, 0xC0, 0x60, 0x78, 0x75, 
+0x27, 
+
+0xD6, 0xCB, 0x36, 0x65, 0x7E, 0xF6, 0x2D, 0xD4, 
+0xBA, 0xD1, 0x8D, 0x00, 0x27, 0xC2, 0x9B, 0xCD, 
+0x76, 0xB6, 0xD1, 0xA1, 0xFD, 0x4C, 0xDE, 0x56, 
+0xA0, 0x56, 0xA3, 0x1A, 0x4B, 0x4D, 0xB3, 0x4B, 
+0x30, 0xF3, 0xFA, 0xFE, 0xF9, 0xE7, 0x5A, 0x66, 
+0xF7, 0x63, 
+
+0x95, 0x6F, 0x8F, 0xEE, 0xB2, 0x95, 0xC4, 0x26, 
+0xD7, 0xEA, 0x3E, 0x3A, 0x5E, 0x68, 0x27, 0x86, 
+0x28, 0xD7, 0x18, 0xD2, 0xC6, 0x57, 0xDD, 0x04, 
+0x63, 0x61, 0x2D, 0xBC, 0x94, 0x3E, 0x42, 0x58, 
+0x7D, 0x75, 0xE7, 0x8D, 0xE3, 0xFD, 0x9A, 0x4C, 
+0xE3, 0xB1, 0x45, 
+
+0xD8, 0xF6, 0x5E, 0x9A, 0x6E, 0x7F, 0xC3, 0x19, 
+0x5D, 0x4A, 0xC7, 0xBD, 0xDE, 0x51, 0x09, 0xBD, 
+0xA6, 0x3F, 0x2E, 0x37, 0xC6, 0xD7, 0x64, 0x4F, 
+0xEC, 0x67, 0x66, 0xAB, 0x5E, 0xAA, 0x61, 0x39, 
+0x9A, 0x68, 0xCD, 0x71, 0xD9, 0xEF, 0x1F, 0x85, 
+0x65, 0xB4, 0xC8, 0xC1, 
+
+0xFE, 0xC7, 0x48, 0x84, 0xE0, 0x16, 0x61, 0x98, 
+0xE4, 0x88, 0x6B, 0xED, 0x4C, 0xCE, 0x35, 0xD8, 
+0xC6, 0x68, 0x1A, 0xE0, 0x7E, 0x5E, 0x19, 0x11, 
+0x46, 0xC5, 0xC7, 0x62, 0xB2, 0x11, 0x8A, 0x18, 
+0xF5, 0x21, 0x64, 0x81, 0x53, 0xDF, 0xCD, 0xF6, 
+0xE6, 0x2E, 0x76, 0xA1, 0x0B, 
+
+0xF8, 0x6D, 0x28, 0xE8, 0x5B, 0x99, 0x56, 0xEE, 
+0x97, 0x5A, 0x6F, 0x8B, 0x00, 0x8C, 0xAC, 0x1B, 
+0x33, 0x63, 0x6B, 0xDF, 0x7A, 0xE1, 0x2C, 0x77, 
+0x39, 0xCE, 0xB2, 0x84, 0x70, 0x7A, 0xC1, 0x2B, 
+0x3A, 0xBB, 0xF4, 0x48, 0x52, 0x84, 0xB8, 0xD0, 
+0xEF, 0xD6, 0x56, 0xD7, 0xA1, 0x98, 
+
+0x35, 0xA1, 0xED, 0x51, 0x53, 0x77, 0x3F, 0xA1, 
+0xA6, 0x50, 0xCA, 0xB6, 0x85, 0x06, 0xB2, 0x21, 
+0x7F, 0xBB, 0x1A, 0xBE, 0xF6, 0xDE, 0x0F, 0xD9, 
+0xEC, 0x0D, 0x9C, 0x0A, 0x66, 0x5A, 0x8B, 0x04, 
+0x3B, 0x7A, 0x03, 0xBD, 0x22, 0x58, 0x34, 0xED, 
+0xCB, 0xB5, 0x8D, 0xFA, 0x4B, 0xBD, 0xEE, 
+
+0x70, 0x97, 0x29, 0x75, 0x03, 0x50, 0x5D, 0x4F, 
+0x9E, 0x4E, 0xC7, 0x87, 0x4F, 0xA9, 0x56, 0xD2, 
+0x68, 0x1E, 0xBD, 0xE9, 0x74, 0x6D, 0xFE, 0xF8, 
+0x13, 0xD2, 0x44, 0x14, 0x47, 0x9C, 0x43, 0xEF, 
+0xD9, 0x44, 0xAC, 0x1D, 0xE8, 0x27, 0xFB, 0xE6, 
+0x8D, 0xD3, 0x4B, 0x5F, 0x98, 0x32, 0x82, 0xCE, 
+
+0xFC, 0x79, 0x7B, 0x40, 0xF4, 0xE5, 0x20, 0xF0, 
+0x9D, 0xB5, 0x69, 0x12, 0x6B, 0x5E, 0x2C, 0x42, 
+0xDF, 0xA0, 0x0D, 0xC0, 0x2E, 0x5F, 0x64, 0x11, 
+0x05, 0x54, 0x25, 0x3A, 0x51, 0xB8, 0x3F, 0xD7, 
+0xD7, 0x24, 0x38, 0x0A, 0x4E, 0x77, 0xD1, 0x64, 
+0x13, 0xBB, 0x4A, 0xE3, 0x82, 0x49, 0x16, 0xC8, 
+0x80, 
+
+0x61, 0x60, 0x2E, 0x26, 0xBE, 0x81, 0xA6, 0x8D, 
+0x52, 0x35, 0xCB, 0x07, 0x6E, 0xD3, 0x5E, 0xC2, 
+0x25, 0xDE, 0x52, 0xBA, 0x85, 0x62, 0x5E, 0xD7, 
+0xFF, 0xB5, 0x07, 0x49, 0x1F, 0x60, 0x23, 0x0F, 
+0xE6, 0x83, 0x09, 0xD6, 0xE9, 0xFE, 0xF8, 0x99, 
+0x39, 0x17, 0x0C, 0xF7, 0x7C, 0xAE, 0x5F, 0xA4, 
+0x83, 0x06, 
+
+0xEB, 0x88, 0xB9, 0x6F, 0x23, 0x17, 0x02, 0x88, 
+0xA0, 0x70, 0x75, 0xBD, 0xD1, 0x91, 0xF5, 0x98, 
+0x5B, 0x49, 0x0F, 0xA0, 0x5B, 0x16, 0x3F, 0x41, 
+0x32, 0xB2, 0x42, 0xEF, 0xF7, 0xE0, 0x63, 0x0B, 
+0xBE, 0xE3, 0x09, 0x86, 0xEE, 0xAC, 0xC3, 0xE3, 
+0x1F, 0x43, 0x72, 0x85, 0xB3, 0xB2, 0x97, 0x35, 
+0x89, 0xB7, 0x37, 
+
+0xE9, 0xB1, 0x48, 0x63, 0x4B, 0xA2, 0x73, 0x1D, 
+0x76, 0x97, 0xD0, 0xA3, 0x05, 0x48, 0xA3, 0x75, 
+0x2A, 0x50, 0x68, 0x77, 0xFB, 0x0B, 0x96, 0x20, 
+0x0E, 0x04, 0xAC, 0xE8, 0x7C, 0x14, 0x79, 0xF7, 
+0xDD, 0xC7, 0x13, 0xF7, 0xEA, 0xB5, 0x5E, 0xF4, 
+0x1A, 0xEC, 0xA1, 0xE6, 0x66, 0x0E, 0x72, 0xB1, 
+0x73, 0xA2, 0x53, 0x82, 
+
+0xB8, 0x00, 0x7F, 0xFA, 0xA9, 0x24, 0xC5, 0x94, 
+0xAC, 0x12, 0x1B, 0xF6, 0xA2, 0x5B, 0xDF, 0xBA, 
+0x67, 0xF6, 0xC5, 0x17, 0x06, 0x16, 0x22, 0x46, 
+0xE1, 0x45, 0xAB, 0xDF, 0x72, 0xC8, 0xA2, 0xAB, 
+0x26, 0xEF, 0x9E, 0x71, 0xF5, 0xD2, 0xCC, 0x02, 
+0xCE, 0x13, 0xA1, 0x78, 0x36, 0xB2, 0xD6, 0x69, 
+0x2A, 0x04, 0x3D, 0x04, 0xED, 
+
+0xF2, 0xE0, 0x3B, 0x38, 0xAB, 0x6D, 0xEC, 0x2B, 
+0x19, 0x31, 0x8A, 0x66, 0xCF, 0x4E, 0x21, 0x6C, 
+0x88, 0x54, 0x1C, 0xE0, 0x8F, 0x39, 0xC4, 0xCF, 
+0x20, 0x25, 0x8D, 0xF3, 0x02, 0x6A, 0x42, 0xD8, 
+0x78, 0x8A, 0x6E, 0x34, 0x1C, 0x65, 0x45, 0x0E, 
+0x61, 0x50, 0x0B, 0xF7, 0xF0, 0xCD, 0xC4, 0xCA, 
+0xA6, 0xA9, 0x9A, 0x52, 0x6B, 0xE6, 
+
+0x34, 0xC2, 0xEC, 0x46, 0xB4, 0x27, 0x96, 0x6F, 
+0xD8, 0xFE, 0x42, 0xBB, 0x8F, 0x31, 0x96, 0xB2, 
+0xED, 0xE5, 0x99, 0x92, 0x9D, 0x30, 0xA0, 0x5D, 
+0xFD, 0x48, 0x26, 0xB3, 0xEA, 0xC6, 0xEA, 0x2C, 
+0x0D, 0x66, 0x7B, 0x43, 0xEE, 0x33, 0x01, 0xC6, 
+0xB3, 0xD8, 0x18, 0x6B, 0xEA, 0xFA, 0x78, 0xD0, 
+0x0B, 0x18, 0xBF, 0xFF, 0x13, 0xCF, 0xFA, 
+
+0x35, 0x1C, 0x01, 0x21, 0xBD, 0x11, 0xF9, 0xD7, 
+0x14, 0xE2, 0x4E, 0xC1, 0x27, 0xA1, 0x0F, 0xE3, 
+0x76, 0xFC, 0x7A, 0xF9, 0x95, 0x43, 0x47, 0x53, 
+0x28, 0x44, 0x9F, 0x69, 0x3B, 0x28, 0xE4, 0x19, 
+0x08, 0x79, 0x8C, 0x2F, 0xE5, 0x60, 0x29, 0x67, 
+0x62, 0xC1, 0x70, 0xE4, 0xF4, 0xF4, 0x8B, 0xDA, 
+0x5A, 0x40, 0x98, 0x9F, 0xDB, 0x60, 0x2B, 0x73, 
+
+0x6C, 0x8F, 0xD8, 0xAF, 0x13, 0x42, 0x03, 0x30, 
+0xA2, 0x01, 0x7D, 0xC7, 0x13, 0xB0, 0x62, 0xD6, 
+0x85, 0x6F, 0x6E, 0x96, 0xFF, 0x19, 0xDA, 0x3F, 
+0xD8, 0x1F, 0xBF, 0x07, 0x65, 0x99, 0xCA, 0x0F, 
+0x29, 0x5E, 0x03, 0x02, 0x18, 0x73, 0x8D, 0x29, 
+0xCA, 0xFA, 0x58, 0x01, 0x08, 0x05, 0x04, 0xB7, 
+0x7D, 0xA0, 0xAB, 0xDE, 0x83, 0x72, 0x34, 0x64, 
+0xFD, 
+
+0xD4, 0xD3, 0xD2, 0xD4, 0x35, 0xD1, 0x2A, 0xAE, 
+0x51, 0xE9, 0x3B, 0x1F, 0x0F, 0xD3, 0xA5, 0xD8, 
+0xF2, 0x27, 0x87, 0x9D, 0xEC, 0x23, 0x58, 0x51, 
+0x4B, 0xAE, 0xCE, 0xE9, 0x51, 0x89, 0x87, 0x98, 
+0x9B, 0xEE, 0x35, 0xA7, 0x95, 0x2B, 0x99, 0xFB, 
+0xD9, 0xF4, 0x71, 0xBD, 0x7D, 0x4F, 0x5A, 0xAA, 
+0x07, 0x26, 0x44, 0xF4, 0x93, 0x44, 0x8A, 0xF7, 
+0x71, 0x58, 
+
+0x83, 0xDA, 0xC0, 0xBB, 0x07, 0xC5, 0x8E, 0xA2, 
+0x3A, 0x0A, 0xDF, 0x04, 0x9B, 0x0D, 0x92, 0xC7, 
+0x92, 0xC9, 0x27, 0xE0, 0x81, 0x84, 0x60, 0x7C, 
+0x39, 0x11, 0x17, 0x84, 0xE2, 0x44, 0x7B, 0x56, 
+0xCC, 0x80, 0xD6, 0xF1, 0xEE, 0xC3, 0x2A, 0x94, 
+0x6F, 0x85, 0x97, 0x1F, 0x8E, 0x86, 0xA9, 0xC0, 
+0x1E, 0xA9, 0xF6, 0x2D, 0xF5, 0x3A, 0xEB, 0xB4, 
+0xE4, 0xC2, 0x82, 
+
+0x1E, 0x15, 0x53, 0xE6, 0x16, 0xC0, 0x76, 0x18, 
+0xA7, 0x54, 0x65, 0x4A, 0xCD, 0xAA, 0x4E, 0xDC, 
+0x50, 0x78, 0x24, 0xD7, 0xAB, 0x88, 0xED, 0x96, 
+0xB1, 0x0E, 0x91, 0x6D, 0x59, 0x56, 0x80, 0xE3, 
+0xB2, 0x69, 0xF3, 0x95, 0x03, 0x6F, 0x2B, 0x35, 
+0xA1, 0x8B, 0x91, 0xCF, 0x9F, 0x06, 0x0C, 0xE1, 
+0x0A, 0x54, 0x9E, 0xCF, 0x38, 0xFF, 0x87, 0xD4, 
+0x49, 0x92, 0x37, 0xBD, 
+
+0x9B, 0xDC, 0xD8, 0xA9, 0xC4, 0xCC, 0x56, 0xEC, 
+0xAE, 0x1C, 0xB4, 0x56, 0x1B, 0xCE, 0xF4, 0x73, 
+0xD4, 0x48, 0x1D, 0x3B, 0x69, 0xF4, 0x56, 0x04, 
+0x53, 0xCC, 0x2F, 0x48, 0x58, 0x41, 0x4C, 0xD0, 
+0xE1, 0x2D, 0x7B, 0xD8, 0xBB, 0x9C, 0x24, 0xC6, 
+0x00, 0x77, 0x94, 0xE2, 0xA6, 0x0F, 0x6A, 0x6E, 
+0x6A, 0x35, 0x7F, 0xCE, 0x0F, 0x3B, 0x0D, 0xC5, 
+0x3D, 0x10, 0xFC, 0x8C, 0x8D, 
+
+0x06, 0x02, 0x27, 0xD6, 0x04, 0xB3, 0x50, 0x95, 
+0x4B, 0x1C, 0x70, 0x0F, 0x9C, 0x8D, 0x11, 0xEE, 
+0x8B, 0x64, 0x40, 0xD5, 0x7D, 0x23, 0x3F, 0x28, 
+0xE5, 0xE1, 0x1A, 0xAD, 0x81, 0x31, 0xFD, 0xD2, 
+0xE8, 0xA7, 0x7E, 0xB8, 0xC4, 0xB3, 0x12, 0xF6, 
+0x0D, 0xF0, 0x73, 0xBB, 0xF6, 0x1B, 0xF1, 0x88, 
+0x58, 0xD8, 0x20, 0x38, 0x50, 0x20, 0x49, 0xCC, 
+0x23, 0x69, 0x12, 0x36, 0xB0, 0x23, 
+
+0xF8, 0xAB, 0x9D, 0x76, 0x7C, 0x9A, 0xDD, 0xE5, 
+0xF1, 0x9A, 0xFA, 0x4D, 0x3F, 0xF2, 0x21, 0xE6, 
+0x5E, 0xE9, 0x73, 0x85, 0x5D, 0x99, 0xD5, 0x51, 
+0x6B, 0x4B, 0xF1, 0xE0, 0x22, 0x82, 0x30, 0xF0, 
+0xE9, 0x4C, 0x7B, 0xBF, 0xD6, 0xA1, 0x1A, 0x0D, 
+0x31, 0xA4, 0x60, 0xB0, 0x7B, 0x5C, 0x28, 0xE1, 
+0x98, 0xE5, 0x06, 0x61, 0x36, 0x41, 0x32, 0xEE, 
+0xE7, 0x02, 0x2B, 0x6C, 0x41, 0x73, 0x41, 
+
+0x15, 0x6C, 0xE5, 0x07, 0xA8, 0xFE, 0x01, 0x14, 
+0x62, 0x5E, 0x76, 0x3D, 0x44, 0xC2, 0xB8, 0x51, 
+0x34, 0x5B, 0x0C, 0xFC, 0x23, 0x83, 0x35, 0xBA, 
+0x3C, 0x76, 0xC1, 0x22, 0x87, 0xAA, 0x53, 0x8F, 
+0x6D, 0x68, 0xBD, 0xFC, 0xBF, 0xF4, 0xEE, 0x31, 
+0xE3, 0x5F, 0xFE, 0x90, 0xE1, 0x60, 0xF1, 0x58, 
+0xD1, 0xE7, 0x19, 0x30, 0x1D, 0x18, 0xD1, 0x91, 
+0x11, 0xCD, 0xB7, 0x3C, 0xE5, 0xF9, 0x53, 0xEC, 
+
+0x25, 0xDC, 0xF7, 0x63, 0x08, 0x9D, 0x08, 0x1F, 
+0x11, 0xC0, 0xDE, 0x19, 0x77, 0x94, 0x24, 0xE3, 
+0x8A, 0xF9, 0x8D, 0x45, 0x38, 0xAB, 0xA6, 0x24, 
+0xC6, 0x23, 0xDB, 0x4D, 0x3E, 0x77, 0xFF, 0x72, 
+0x1F, 0xCA, 0x8E, 0x54, 0xAB, 0x77, 0x8D, 0xEF, 
+0x92, 0x82, 0x41, 0x42, 0xEB, 0xD3, 0x74, 0x6D, 
+0x54, 0x1E, 0x21, 0xA2, 0x49, 0x1A, 0xEC, 0x27, 
+0x19, 0xAE, 0xF5, 0xB1, 0x90, 0x84, 0x7C, 0x18, 
+0xCD, 
+
+0x41, 0x05, 0x2F, 0x4F, 0x2B, 0x1C, 0x07, 0x42, 
+0x68, 0x47, 0x34, 0xF1, 0xF1, 0xAE, 0xBF, 0xDD, 
+0xB8, 0xF3, 0x9C, 0xB9, 0x88, 0xE5, 0x0A, 0x0E, 
+0x63, 0x1A, 0x27, 0xC8, 0xCF, 0xBA, 0x73, 0x82, 
+0x6F, 0x54, 0x59, 0x04, 0xE5, 0x75, 0xB6, 0x27, 
+0x87, 0x1D, 0x77, 0xD7, 0xE2, 0xAC, 0x98, 0x4F, 
+0x45, 0x47, 0x21, 0xFD, 0xC7, 0x42, 0x8F, 0x88, 
+0x9E, 0xAF, 0xB9, 0x4E, 0x42, 0xD9, 0x24, 0xD4, 
+0x30, 0xD7, 
+
+0xF9, 0xBD, 0x86, 0x04, 0xBE, 0xC8, 0xA3, 0x30, 
+0x18, 0x45, 0x4E, 0xD1, 0x0A, 0xF0, 0xB6, 0x03, 
+0x03, 0x2F, 0xA3, 0x7B, 0x3E, 0xFE, 0xBA, 0xAD, 
+0xAD, 0xB4, 0x45, 0x35, 0x41, 0x8B, 0x99, 0x4B, 
+0xA8, 0x18, 0x5F, 0x65, 0x82, 0x6C, 0x5F, 0x98, 
+0x49, 0x38, 0xB8, 0xCB, 0xD8, 0x49, 0x83, 0xEF, 
+0x66, 0x55, 0xE9, 0xB2, 0x35, 0x40, 0xF0, 0x87, 
+0xB5, 0x0F, 0xF3, 0xF3, 0xDA, 0xC7, 0x9F, 0xD7, 
+0x99, 0xE0, 0xED, 
+
+0xC2, 0xE6, 0x0F, 0x98, 0x38, 0xFF, 0x53, 0x54, 
+0x01, 0xFD, 0x05, 0x18, 0x70, 0x67, 0x93, 0xA4, 
+0x47, 0xED, 0x96, 0x8E, 0xC8, 0xFC, 0x60, 0x87, 
+0xF9, 0xB2, 0x5B, 0x03, 0x8F, 0x29, 0x8F, 0x5F, 
+0xD2, 0x19, 0x85, 0x44, 0xF9, 0xFE, 0xB8, 0x46, 
+0x34, 0xA3, 0x48, 0x69, 0x14, 0x7E, 0x1E, 0x1E, 
+0x2B, 0xB1, 0x1E, 0x21, 0x04, 0xE4, 0x9B, 0xD3, 
+0x00, 0xB8, 0xA3, 0xD4, 0x8B, 0xAA, 0x19, 0x27, 
+0x3B, 0x76, 0x5D, 0x31, 
+
+0x9B, 0x4C, 0xFB, 0x27, 0x22, 0x05, 0x24, 0xF9, 
+0x81, 0xAF, 0xE0, 0x8D, 0x8B, 0x87, 0x55, 0x19, 
+0x18, 0x01, 0xD4, 0xEA, 0x70, 0x52, 0x4E, 0xF0, 
+0x9B, 0xA5, 0xA3, 0x87, 0xC6, 0x46, 0xD1, 0xE9, 
+0x44, 0x0E, 0x98, 0x93, 0x75, 0x8D, 0xDC, 0x82, 
+0x9B, 0xBE, 0x08, 0x31, 0xBA, 0x7D, 0x07, 0x9F, 
+0xE2, 0x50, 0x43, 0xE1, 0x73, 0x26, 0xC6, 0xE5, 
+0xDA, 0x0A, 0xE4, 0x47, 0x62, 0x86, 0xB3, 0x40, 
+0x32, 0x83, 0x3E, 0x4C, 0xB7, 
+
+0x19, 0xE4, 0x7B, 0x97, 0x56, 0x17, 0x87, 0x67, 
+0xE6, 0xAF, 0xA6, 0x93, 0x8E, 0xC3, 0xD2, 0x60, 
+0x37, 0x47, 0x34, 0x1D, 0x6A, 0x81, 0x7E, 0x7D, 
+0x39, 0x8D, 0x4E, 0xB2, 0x85, 0xBE, 0x99, 0xCE, 
+0x4F, 0xB3, 0x18, 0xFA, 0x3E, 0xC9, 0x4F, 0x41, 
+0x35, 0x8F, 0x8D, 0x4F, 0xA7, 0x87, 0xAA, 0x0E, 
+0xF6, 0xA2, 0xD8, 0x6E, 0x30, 0x90, 0x56, 0xEA, 
+0xA1, 0xDA, 0xB3, 0xDD, 0xAF, 0xC4, 0x13, 0x5F, 
+0x25, 0x47, 0xAA, 0x0B, 0x5A, 0x94, 
+
+0x57, 0xAE, 0x1D, 0x1B, 0x2E, 0x8E, 0xE4, 0x31, 
+0xE8, 0x0F, 0x0D, 0x7F, 0x9F, 0x9B, 0xFA, 0x80, 
+0x22, 0x22, 0xB4, 0xA4, 0x87, 0x56, 0x81, 0xA5, 
+0x91, 0xF9, 0x7C, 0xB4, 0x5B, 0xED, 0x56, 0xC8, 
+0x9E, 0x56, 0x8F, 0x1E, 0x20, 0xD5, 0xAC, 0xC4, 
+0x93, 0xA8, 0xF2, 0x87, 0x65, 0x5F, 0x9E, 0x72, 
+0x97, 0x40, 0xA9, 0xDD, 0x2A, 0x78, 0xA0, 0x73, 
+0x5E, 0xE5, 0x5D, 0x4E, 0xB0, 0x24, 0x89, 0x67, 
+0xC5, 0xD1, 0xE0, 0xB6, 0xAC, 0x27, 0x40, 
+
+0xAD, 0x4A, 0xA4, 0x3B, 0xD8, 0x4A, 0xF2, 0x21, 
+0x12, 0x5C, 0x26, 0xD1, 0xA5, 0x26, 0x2D, 0x36, 
+0x7E, 0xE4, 0xB1, 0x7A, 0x5D, 0x09, 0xC6, 0xAF, 
+0x83, 0xD4, 0x34, 0xE6, 0x75, 0x48, 0x14, 0x6F, 
+0x4F, 0x64, 0x6F, 0x5B, 0x8C, 0xBD, 0x50, 0x0A, 
+0xBD, 0xF5, 0x72, 0x79, 0xA0, 0x25, 0x19, 0x21, 
+0x8F, 0xBA, 0x30, 0x70, 0x26, 0x9B, 0x44, 0x78, 
+0x03, 0x46, 0x02, 0x24, 0xA0, 0x96, 0x05, 0x66, 
+0x70, 0x0E, 0xC0, 0xCB, 0x1A, 0x39, 0x21, 0xDE, 
+
+0x8C, 0x02, 0x42, 0x0C, 0x87, 0x69, 0xBB, 0xD9, 
+0xCC, 0x61, 0x29, 0x0B, 0x40, 0xBD, 0x0E, 0x71, 
+0x44, 0x31, 0xCA, 0xD5, 0xDB, 0xA9, 0xF3, 0xE1, 
+0xF6, 0xAA, 0x57, 0x15, 0xCF, 0x8F, 0x52, 0xB7, 
+0x68, 0x16, 0x2B, 0x33, 0x4F, 0x01, 0xD7, 0xC7, 
+0x09, 0xD9, 0xB9, 0xA2, 0xA5, 0x90, 0xFA, 0xF3, 
+0x8D, 0xF0, 0x3D, 0x5F, 0xAB, 0x3F, 0x8D, 0x5F, 
+0xF9, 0xB4, 0x49, 0x32, 0xC8, 0xAD, 0xFD, 0x5F, 
+0x69, 0xE7, 0xB8, 0x61, 0x0C, 0xA2, 0x7B, 0x03, 
+0xFC, 
+
+0x1E, 0x47, 0x67, 0x1A, 0x76, 0xCB, 0x0C, 0x16, 
+0xE5, 0x40, 0xB3, 0x36, 0x22, 0x34, 0xC6, 0x88, 
+0xD3, 0xDA, 0x0E, 0xFC, 0xFF, 0x59, 0xEE, 0x27, 
+0x67, 0x89, 0xF7, 0x61, 0x53, 0xB6, 0x2B, 0xC1, 
+0xF3, 0xA3, 0x1B, 0x18, 0x9A, 0x23, 0x4E, 0xCC, 
+0xCB, 0xE8, 0xD1, 0xB1, 0x4B, 0xF5, 0x48, 0xD6, 
+0xAD, 0x90, 0xF7, 0x67, 0x8F, 0x7E, 0x91, 0x82, 
+0x36, 0x94, 0x94, 0x46, 0xC1, 0xFA, 0xA5, 0x8A, 
+0x38, 0xAD, 0x1D, 0x41, 0x9E, 0x5A, 0x9C, 0x66, 
+0xEB, 0xC6, 
+
+0xFC, 0x39, 0x53, 0x99, 0x21, 0xD9, 0xFF, 0xD3, 
+0xB0, 0xF6, 0x80, 0x0B, 0x94, 0xEC, 0xE5, 0x66, 
+0x9C, 0x5C, 0x9C, 0x96, 0x1F, 0xB0, 0x89, 0xA6, 
+0x94, 0x70, 0xE1, 0x67, 0xB8, 0xAF, 0x16, 0x64, 
+0x3A, 0x5B, 0x73, 0x16, 0xDD, 0x80, 0x1F, 0xF4, 
+0x8C, 0x27, 0x76, 0x5E, 0x9E, 0x3F, 0xCA, 0xC0, 
+0x5F, 0x19, 0x82, 0x2C, 0x55, 0x73, 0x58, 0x79, 
+0xF4, 0xC1, 0x63, 0x06, 0x7A, 0x06, 0xCF, 0x33, 
+0xD2, 0x54, 0xF1, 0x20, 0x46, 0xDE, 0x6B, 0x8C, 
+0x73, 0xD9, 0x51, 
+
+0x6D, 0x19, 0xCF, 0x90, 0xF9, 0x47, 0x25, 0x1F, 
+0xC3, 0xDA, 0xD9, 0x5F, 0x0A, 0xA9, 0x60, 0xE4, 
+0xA2, 0xC0, 0x9E, 0x13, 0xF8, 0xE3, 0xC3, 0xB3, 
+0xF4, 0xE9, 0x06, 0x3A, 0xD9, 0xA3, 0xA7, 0xCC, 
+0x56, 0x0E, 0x3B, 0x9F, 0x3A, 0x2A, 0x9F, 0xC1, 
+0xC9, 0x73, 0x04, 0x30, 0x04, 0xD1, 0x74, 0xF3, 
+0xD7, 0x1B, 0xDF, 0x40, 0x66, 0x6B, 0x4F, 0x35, 
+0xE6, 0x3C, 0x71, 0x55, 0x90, 0x2F, 0xD8, 0x14, 
+0x46, 0x66, 0x7C, 0xF3, 0xB7, 0xB0, 0x9F, 0x6D, 
+0x54, 0x77, 0xF6, 0xF8, 
+
+0xD0, 0x0A, 0xFF, 0x48, 0xF8, 0x5B, 0x3F, 0x93, 
+0x59, 0xFC, 0x02, 0x38, 0x83, 0x4F, 0x40, 0xE8, 
+0xA2, 0x31, 0x6B, 0x02, 0x7E, 0x14, 0x80, 0x9C, 
+0xB1, 0x7C, 0xEA, 0x6B, 0x2E, 0x48, 0x0E, 0x27, 
+0xFF, 0xC5, 0x25, 0x52, 0xD6, 0xBC, 0x03, 0xC6, 
+0xF8, 0xB6, 0xEC, 0x0B, 0x69, 0x6B, 0x7A, 0xA1, 
+0x64, 0x63, 0x1C, 0xC5, 0x20, 0x36, 0xE6, 0x56, 
+0x62, 0x40, 0xD8, 0x53, 0x7D, 0x38, 0x1E, 0xDF, 
+0x47, 0x1B, 0xC7, 0xE0, 0xC5, 0xC2, 0x12, 0xAE, 
+0x5E, 0x61, 0x1B, 0x91, 0x54, 
+
+0xB1, 0xF0, 0xCB, 0x4A, 0x0C, 0xE7, 0x57, 0xE2, 
+0xB2, 0x27, 0x40, 0x92, 0x67, 0xAE, 0x4B, 0xA7, 
+0x11, 0x81, 0x53, 0x66, 0xEB, 0x9F, 0x9D, 0x63, 
+0x16, 0xDA, 0x00, 0x47, 0x76, 0x10, 0x1A, 0x69, 
+0x25, 0x83, 0x3B, 0x9D, 0x5C, 0xB5, 0xFC, 0xD2, 
+0x20, 0xE5, 0x3A, 0x8B, 0x9C, 0x8F, 0x9C, 0x5E, 
+0x74, 0x75, 0xA1, 0x39, 0x02, 0x88, 0xE1, 0x23, 
+0x82, 0xFF, 0x55, 0x09, 0x92, 0x8A, 0xBA, 0x3F, 
+0x81, 0x2B, 0x74, 0x84, 0x9C, 0xDA, 0xDF, 0xDC, 
+0x67, 0x58, 0x2A, 0x4E, 0x6E, 0x4D, 
+
+0xBD, 0xE3, 0xC7, 0x0C, 0x2C, 0x49, 0x4C, 0x33, 
+0xE7, 0xA0, 0x47, 0x4F, 0xD0, 0xC5, 0x15, 0x8A, 
+0xDF, 0xFD, 0x25, 0x34, 0x1F, 0x7C, 0xF0, 0x87, 
+0x9F, 0xF4, 0x41, 0x2A, 0x06, 0x5F, 0xF3, 0xF1, 
+0x89, 0x21, 0xEC, 0x63, 0x85, 0x1E, 0x43, 0xBD, 
+0x44, 0x31, 0x7D, 0x39, 0xBF, 0x8C, 0xEB, 0x04, 
+0x07, 0x47, 0xCC, 0x07, 0xB0, 0x8E, 0x7C, 0x43, 
+0xEF, 0x1E, 0x91, 0x17, 0x6A, 0x70, 0xB9, 0x30, 
+0x98, 0xBC, 0x55, 0x13, 0x9F, 0x6F, 0x05, 0x3B, 
+0xAC, 0x0F, 0x32, 0xF4, 0x77, 0xAD, 0xB0, 
+
+0x66, 0x4A, 0xB6, 0x65, 0xFC, 0xFC, 0xB9, 0x62, 
+0xAF, 0x42, 0xAF, 0x39, 0x7D, 0x3C, 0x7F, 0xC0, 
+0xAD, 0xA2, 0x9D, 0xCB, 0xAD, 0xD9, 0x9E, 0x6D, 
+0xEF, 0x87, 0xB3, 0xC7, 0x4A, 0x67, 0xCE, 0x21, 
+0x50, 0x41, 0xA7, 0x70, 0xE4, 0x78, 0xB0, 0x11, 
+0x1B, 0xE9, 0xDE, 0x37, 0x93, 0x96, 0x53, 0xD9, 
+0x86, 0x85, 0xE0, 0xB7, 0x20, 0xAB, 0xB3, 0xB7, 
+0x95, 0x92, 0x2B, 0x4B, 0x22, 0x99, 0xED, 0x5F, 
+0xA7, 0x61, 0x4B, 0xC2, 0x67, 0x65, 0xCA, 0xAD, 
+0x15, 0x22, 0x2B, 0x1F, 0x56, 0x41, 0xBD, 0x3A, 
+
+0xBC, 0x24, 0x1C, 0x0A, 0x46, 0xBF, 0x8B, 0x52, 
+0x2C, 0x8A, 0x3C, 0x43, 0xE5, 0x8D, 0xEA, 0xF8, 
+0x8F, 0x3E, 0xE7, 0xD2, 0x13, 0xCD, 0x1E, 0x6E, 
+0xB3, 0x33, 0x72, 0x1E, 0x9B, 0xFF, 0xD5, 0x6E, 
+0x33, 0x19, 0xF8, 0x01, 0xE4, 0xF8, 0x0F, 0xF0, 
+0x07, 0x8F, 0x42, 0x61, 0x88, 0x84, 0x64, 0x8F, 
+0xBF, 0x4D, 0x55, 0x01, 0xDF, 0x78, 0xFF, 0xBC, 
+0x03, 0x0E, 0xC4, 0xF0, 0x99, 0x79, 0xC5, 0x92, 
+0xF1, 0xF1, 0x78, 0x63, 0xC2, 0x86, 0x41, 0x67, 
+0xB8, 0xD3, 0xB4, 0xB2, 0x98, 0xAE, 0xC3, 0x45, 
+0xA5, 
+
+0xFB, 0x6B, 0x87, 0xA9, 0x28, 0x59, 0xB8, 0x52, 
+0x35, 0x5B, 0xF0, 0x92, 0x8A, 0xC2, 0x8B, 0xD2, 
+0xE5, 0xAF, 0x7B, 0xD2, 0xB0, 0xC0, 0x87, 0x83, 
+0x44, 0xDD, 0xC8, 0x81, 0x91, 0x01, 0x0D, 0x2A, 
+0xB6, 0xFE, 0x01, 0xB9, 0x65, 0x94, 0x1E, 0x8C, 
+0xE1, 0x02, 0x70, 0x1C, 0x5B, 0x31, 0x9E, 0x80, 
+0x30, 0xA1, 0xF1, 0x6B, 0x66, 0xD0, 0xC5, 0xD8, 
+0x5D, 0x8C, 0x71, 0x67, 0x6E, 0xD6, 0xE7, 0x76, 
+0x06, 0x49, 0x4C, 0x2A, 0xEE, 0xA6, 0xCB, 0xCB, 
+0x5B, 0xE2, 0xB1, 0x2D, 0xC6, 0x84, 0x69, 0x12, 
+0x17, 0x35, 
+
+0xFE, 0x51, 0xDF, 0x4D, 0x30, 0xB1, 0xC2, 0xF8, 
+0x58, 0xC1, 0x6D, 0xE7, 0x53, 0x4C, 0x6C, 0x41, 
+0xC5, 0xB0, 0xBE, 0xEC, 0x9A, 0x99, 0xD8, 0xCC, 
+0xE4, 0xBA, 0xC5, 0xAD, 0xCB, 0x4D, 0x2E, 0xE8, 
+0x1D, 0xFB, 0x96, 0x24, 0xDF, 0x20, 0x2F, 0xCD, 
+0xE5, 0x20, 0x2E, 0x7B, 0xC0, 0x7E, 0xB3, 0x7F, 
+0xC3, 0x37, 0x65, 0xD1, 0x0B, 0x1E, 0xE2, 0xD7, 
+0x68, 0x87, 0x50, 0xA2, 0xB3, 0xB2, 0xC3, 0x41, 
+0xF7, 0x61, 0x43, 0x69, 0x84, 0xAB, 0x24, 0x6C, 
+0xDD, 0xD2, 0x82, 0x3D, 0x33, 0x6A, 0x72, 0x48, 
+0x08, 0x19, 0x85, 
+
+0xC0, 0xDE, 0xA6, 0xAD, 0x17, 0xC7, 0xF0, 0x0D, 
+0xEB, 0x51, 0x6B, 0x6D, 0x22, 0x07, 0x3F, 0x58, 
+0xA8, 0x2D, 0x92, 0x14, 0xE4, 0xAE, 0x65, 0x24, 
+0x40, 0x07, 0x8E, 0x23, 0xFC, 0x6D, 0x4A, 0xAF, 
+0x56, 0x47, 0xAF, 0xA2, 0x8B, 0x56, 0x41, 0xA5, 
+0x8D, 0x76, 0x6D, 0x17, 0xC9, 0xCC, 0xE8, 0x01, 
+0x3C, 0x44, 0x8C, 0x32, 0x9D, 0xF6, 0xC6, 0x98, 
+0x9B, 0x37, 0xA8, 0xC9, 0x82, 0x67, 0x97, 0xB5, 
+0xAB, 0xC5, 0xDF, 0x78, 0xD0, 0xB4, 0x58, 0x30, 
+0x90, 0xD8, 0x84, 0x5A, 0x85, 0xC1, 0x79, 0xA9, 
+0xE4, 0x61, 0xFC, 0x6C, 
+
+0xFE, 0x3B, 0xA3, 0xA4, 0xF3, 0x00, 0x95, 0xFF, 
+0x2E, 0x27, 0xE1, 0xE1, 0x63, 0xFE, 0xB2, 0xFB, 
+0x76, 0x5F, 0x56, 0xB7, 0xE0, 0x07, 0xA5, 0xAA, 
+0x63, 0x9A, 0x1C, 0x59, 0x7A, 0x35, 0xDE, 0xB3, 
+0x09, 0x74, 0xC9, 0x5A, 0x57, 0x88, 0x47, 0xAE, 
+0xA4, 0x70, 0x11, 0xBD, 0x3F, 0xE0, 0x13, 0xCD, 
+0x82, 0x5E, 0x55, 0xBE, 0xA4, 0x3E, 0x49, 0xDF, 
+0x1C, 0xDB, 0x0D, 0xCB, 0xBE, 0x25, 0x27, 0x00, 
+0xF5, 0xC9, 0xEE, 0x82, 0x9F, 0x97, 0x93, 0x02, 
+0x24, 0xEA, 0x8E, 0xF6, 0x55, 0x17, 0x1B, 0x69, 
+0x20, 0x26, 0x56, 0x28, 0xC6, 
+
+0xF8, 0xF7, 0x3B, 0xF6, 0xE2, 0x7F, 0x36, 0x4F, 
+0xFE, 0x84, 0xFA, 0xD6, 0xFD, 0x1C, 0x2C, 0x1D, 
+0x99, 0x1B, 0xF9, 0x65, 0xCA, 0x85, 0x10, 0x2F, 
+0xE5, 0xEA, 0xE9, 0xC0, 0xFE, 0x7E, 0xA9, 0x30, 
+0xD0, 0xE3, 0x54, 0x60, 0xEF, 0x65, 0x3A, 0x0E, 
+0x4F, 0xA6, 0xF9, 0x29, 0xF0, 0x12, 0x3A, 0x39, 
+0xDA, 0x90, 0x23, 0x6D, 0x66, 0x95, 0x6C, 0xE3, 
+0xED, 0x88, 0xDF, 0xC7, 0x9B, 0x96, 0x54, 0x8A, 
+0x4E, 0xA5, 0x0B, 0x99, 0xF6, 0xF0, 0x9C, 0x7F, 
+0x6B, 0x7D, 0xB7, 0x5B, 0x15, 0x05, 0xD7, 0xF9, 
+0x84, 0x3F, 0xA4, 0xC4, 0xC4, 0x1D, 
+
+0x7C, 0x12, 0xAB, 0x24, 0x43, 0x33, 0x68, 0xBE, 
+0x05, 0x31, 0xA1, 0x39, 0x87, 0x5D, 0xBE, 0x12, 
+0x25, 0x76, 0x42, 0x1A, 0x94, 0xF7, 0xED, 0x0A, 
+0xE8, 0x84, 0xC2, 0x0F, 0xCC, 0x90, 0xB8, 0x93, 
+0xD7, 0xF1, 0xD6, 0x3C, 0xBC, 0xE7, 0xDC, 0x27, 
+0xD6, 0x5B, 0x56, 0x6A, 0xFF, 0xA7, 0xA0, 0x7C, 
+0xCD, 0x74, 0xB6, 0xA5, 0x9F, 0xC0, 0xD2, 0x5D, 
+0xFB, 0x0D, 0xE7, 0x3D, 0x45, 0xB3, 0x2A, 0x49, 
+0xDF, 0x34, 0x71, 0x07, 0x79, 0x33, 0x04, 0x97, 
+0x2C, 0xB8, 0xC4, 0x8A, 0x21, 0x98, 0x81, 0xD2, 
+0x52, 0xA9, 0x56, 0xBD, 0x3F, 0x70, 0x91, 
+
+0x93, 0xFB, 0xEC, 0x8E, 0x67, 0x64, 0x56, 0x7A, 
+0x5E, 0x97, 0x15, 0xCB, 0x6A, 0x86, 0xD8, 0xDA, 
+0xC3, 0x6E, 0xF9, 0xD3, 0xD7, 0xF7, 0x9A, 0xC8, 
+0x4F, 0xAF, 0xB6, 0xEC, 0x12, 0x2B, 0x7F, 0x1D, 
+0x0D, 0x11, 0xE5, 0x09, 0x6C, 0xE9, 0x12, 0x19, 
+0xD0, 0x7D, 0x08, 0x96, 0x8E, 0xEC, 0x94, 0x29, 
+0x44, 0xE0, 0x2E, 0x36, 0x95, 0x45, 0xED, 0x05, 
+0x50, 0x9B, 0x42, 0x33, 0x9C, 0x98, 0x75, 0xAD, 
+0x6B, 0x35, 0xD3, 0xE1, 0x9F, 0xB7, 0x73, 0x7D, 
+0x89, 0x12, 0x68, 0x66, 0xD8, 0x9D, 0x9F, 0x3D, 
+0x32, 0x3C, 0xBE, 0x38, 0x66, 0x86, 0x3B, 0xDF, 
+
+0xB3, 0x4F, 0xE6, 0x14, 0x87, 0x0C, 0xD3, 0xCD, 
+0x24, 0x42, 0x1B, 0x44, 0x41, 0x48, 0xC1, 0xF8, 
+0x1C, 0xA5, 0xD4, 0x15, 0x6A, 0x66, 0x5B, 0x13, 
+0xBB, 0x2F, 0x06, 0x36, 0x92, 0xBE, 0x8C, 0x4B, 
+0xE7, 0x03, 0xDC, 0xF4, 0x4D, 0xBB, 0xCA, 0x54, 
+0xA4, 0xCC, 0x57, 0xB6, 0x22, 0xC9, 0x75, 0x48, 
+0xFA, 0x9B, 0xBE, 0x8D, 0x28, 0xA1, 0x3A, 0x55, 
+0x9F, 0xE4, 0x57, 0xC3, 0x7D, 0x07, 0x32, 0xE9, 
+0x21, 0x9B, 0x1D, 0xF1, 0x09, 0xF7, 0x70, 0x1D, 
+0x1C, 0x94, 0x5C, 0x21, 0x41, 0x2A, 0x75, 0xAF, 
+0x95, 0x59, 0x78, 0xA5, 0xF9, 0xB4, 0xF0, 0x69, 
+0xC2, 
+
+0x91, 0x05, 0x33, 0x85, 0x75, 0xA4, 0x8E, 0xA9, 
+0x27, 0x21, 0xCE, 0x1B, 0x5A, 0xAD, 0x6B, 0x7E, 
+0xC4, 0xA9, 0x65, 0x1B, 0x07, 0x21, 0xA6, 0x15, 
+0x17, 0x40, 0x2E, 0x17, 0xB5, 0xAC, 0xE9, 0xDC, 
+0x71, 0x5E, 0x83, 0xFC, 0x2B, 0xEC, 0xAF, 0xBD, 
+0xA8, 0x4D, 0x5C, 0x7A, 0xB9, 0xF2, 0x1E, 0x90, 
+0x10, 0xB6, 0x76, 0x77, 0x02, 0xB7, 0x1F, 0x15, 
+0xB4, 0xA9, 0x29, 0x86, 0x34, 0x42, 0x38, 0x2B, 
+0x35, 0x92, 0xA0, 0x3A, 0x76, 0x06, 0x1E, 0xA4, 
+0x69, 0xF9, 0xC0, 0xFD, 0x61, 0x37, 0xDD, 0x9A, 
+0x37, 0xBF, 0xB4, 0x26, 0x1D, 0xBE, 0x2E, 0xC5, 
+0x90, 0x77, 
+
+0xAC, 0xF6, 0xEB, 0x09, 0x8F, 0x8F, 0x41, 0x7D, 
+0x5B, 0xC6, 0x76, 0xB3, 0xBE, 0xCD, 0x18, 0x85, 
+0x23, 0x4E, 0xF0, 0xA7, 0x5F, 0x60, 0xED, 0x10, 
+0x0D, 0x36, 0x26, 0x2E, 0xF4, 0x11, 0xDA, 0x95, 
+0x76, 0x3B, 0x04, 0xF3, 0x73, 0xA1, 0xD9, 0xCE, 
+0x97, 0xBD, 0x10, 0xD9, 0x5D, 0x91, 0x4D, 0x13, 
+0x2A, 0x4A, 0x74, 0xF5, 0x6D, 0xE3, 0xF9, 0xBD, 
+0xBA, 0x33, 0x48, 0xF6, 0x98, 0xB2, 0x41, 0x1E, 
+0x05, 0xEF, 0xF9, 0xD2, 0xC2, 0x8C, 0x30, 0x7B, 
+0x75, 0xE4, 0x12, 0x77, 0x78, 0x9D, 0x72, 0x08, 
+0xDD, 0x86, 0xFE, 0x40, 0xB2, 0xE0, 0x65, 0x4B, 
+0x58, 0x4F, 0xFD, 
+
+0xC1, 0x0C, 0xDE, 0x47, 0x12, 0x1D, 0x85, 0x2E, 
+0x06, 0x1D, 0x82, 0xD8, 0xAE, 0x71, 0x4D, 0x11, 
+0x76, 0x35, 0xA4, 0x43, 0x62, 0x77, 0x3F, 0x1C, 
+0xD5, 0xDE, 0x22, 0x4E, 0x93, 0x06, 0x5A, 0xAE, 
+0x3C, 0x4A, 0x3D, 0x63, 0x45, 0xD8, 0x72, 0xB4, 
+0xA0, 0x55, 0x5A, 0x69, 0x78, 0x27, 0x10, 0xDE, 
+0xE7, 0x0E, 0x93, 0x1B, 0xB0, 0xB0, 0x30, 0xB0, 
+0x47, 0x14, 0x57, 0xD6, 0x5E, 0xA2, 0xED, 0x30, 
+0x8C, 0x6E, 0xDD, 0x8B, 0xC1, 0x2F, 0x2D, 0x59, 
+0x06, 0xF8, 0x2B, 0x97, 0x59, 0xA3, 0x2C, 0x65, 
+0xDF, 0xFC, 0xF4, 0xE9, 0xD2, 0xAA, 0x3D, 0x1E, 
+0x49, 0x05, 0xA7, 0xDB, 
+
+0x2F, 0xB8, 0xA3, 0x2C, 0xBC, 0xBA, 0x17, 0x3D, 
+0xFD, 0x34, 0xD2, 0xCE, 0xBA, 0x6E, 0x9C, 0x89, 
+0x17, 0x04, 0x22, 0x53, 0x1E, 0x64, 0xC7, 0xCB, 
+0x95, 0xD3, 0x1C, 0xA2, 0xD3, 0x3A, 0xBD, 0x5A, 
+0x13, 0xA9, 0x0B, 0xEC, 0xDF, 0x15, 0xB3, 0x07, 
+0x6A, 0x7D, 0x76, 0x90, 0xEE, 0x41, 0x14, 0x02, 
+0x30, 0xA9, 0xEB, 0xCB, 0x24, 0x40, 0xE7, 0xC2, 
+0xFB, 0x1A, 0xC0, 0x57, 0x9E, 0x43, 0x78, 0x0C, 
+0x09, 0xD1, 0xAA, 0x4D, 0x05, 0xAD, 0xF5, 0x21, 
+0x77, 0xFB, 0x23, 0x3F, 0xC7, 0x7A, 0x9A, 0xF8, 
+0x2D, 0x7F, 0x3B, 0x1C, 0xE8, 0xA4, 0xCC, 0x34, 
+0x23, 0xB3, 0x81, 0x48, 0x43, 
+
+0xDE, 0x05, 0x6A, 0xEE, 0xB3, 0xCB, 0xED, 0x42, 
+0x22, 0xEB, 0x2E, 0x64, 0x5C, 0xB4, 0xB7, 0x91, 
+0x3D, 0x36, 0x0D, 0xD1, 0xCF, 0xD1, 0x21, 0x65, 
+0x67, 0xE2, 0xD8, 0xDB, 0x94, 0x1B, 0x77, 0x71, 
+0xC0, 0x4D, 0x16, 0x91, 0x2F, 0xF5, 0x19, 0x04, 
+0xDE, 0xC5, 0x95, 0xAC, 0x70, 0xAD, 0xD4, 0xB1, 
+0xB3, 0x88, 0xF9, 0xF4, 0xB3, 0x3C, 0xDA, 0x26, 
+0x14, 0x11, 0x67, 0x6A, 0x65, 0x2E, 0x3F, 0xE5, 
+0x0C, 0x74, 0x8B, 0x37, 0x24, 0xA4, 0x3B, 0x5C, 
+0xE3, 0xE9, 0xDD, 0xA5, 0x97, 0x1E, 0xAE, 0x63, 
+0x48, 0x0D, 0x06, 0x08, 0xFF, 0x8F, 0x0A, 0xB4, 
+0xD5, 0xFA, 0xC6, 0xC8, 0x49, 0x54, 
+
+0x68, 0x0E, 0xC9, 0x16, 0x9A, 0xC2, 0x3E, 0xEF, 
+0x7A, 0xCA, 0x3C, 0x1E, 0xF5, 0x39, 0xDA, 0xCF, 
+0xB6, 0x96, 0x76, 0xB7, 0xE1, 0x70, 0x7F, 0xB3, 
+0x64, 0x1F, 0x98, 0x9E, 0x30, 0x4B, 0x37, 0xB4, 
+0x24, 0xA0, 0xD7, 0x23, 0x0A, 0x80, 0x82, 0xA8, 
+0xC8, 0x44, 0xB5, 0x64, 0x2E, 0x0A, 0xAA, 0x66, 
+0x61, 0x35, 0x78, 0x85, 0x4A, 0xC8, 0x0F, 0xC8, 
+0xEA, 0xA8, 0x80, 0xD9, 0xCA, 0x7D, 0xDC, 0x3D, 
+0x24, 0x37, 0x40, 0x17, 0x5D, 0x9B, 0x81, 0x3A, 
+0x5E, 0x2C, 0x0B, 0xB3, 0x18, 0xFB, 0xA4, 0x02, 
+0xB2, 0x3E, 0x1C, 0x13, 0xB7, 0xFE, 0x97, 0xC3, 
+0x89, 0xC8, 0x57, 0x6D, 0x78, 0xA8, 0xD5, 
+
+0x9F, 0xD1, 0x3A, 0x1E, 0x83, 0x9A, 0xB9, 0xEE, 
+0x07, 0xE7, 0xBE, 0x7F, 0x21, 0xE4, 0xB8, 0xF2, 
+0x6A, 0x36, 0x01, 0x3A, 0x7A, 0x88, 0x45, 0xA6, 
+0x0B, 0x1E, 0x61, 0x70, 0xBE, 0x1B, 0x0F, 0x07, 
+0xCA, 0x87, 0x0B, 0xD5, 0xE5, 0x16, 0x8E, 0x7B, 
+0xDE, 0xB9, 0xCD, 0x81, 0xBD, 0x3D, 0x8E, 0x44, 
+0x7F, 0xC7, 0x95, 0x40, 0x1D, 0x44, 0xE5, 0x66, 
+0x45, 0x0E, 0xC3, 0xF2, 0x88, 0x71, 0x95, 0x0F, 
+0xC4, 0xD6, 0x68, 0xD9, 0x36, 0xD5, 0x77, 0x26, 
+0xD4, 0x70, 0x81, 0x2A, 0xA4, 0xE9, 0xFE, 0x32, 
+0x00, 0x21, 0xDB, 0xFE, 0x2D, 0xF2, 0x3A, 0x2A, 
+0x42, 0xD9, 0xC1, 0xDD, 0xAD, 0x8E, 0x24, 0xCA, 
+
+0xB5, 0x21, 0xF2, 0xF6, 0x9A, 0xCE, 0xDE, 0xC0, 
+0x5B, 0xB0, 0x7A, 0xDA, 0x3A, 0x44, 0x35, 0x8E, 
+0xBF, 0xF8, 0x97, 0x72, 0x3C, 0x28, 0xBD, 0x99, 
+0x5A, 0x5E, 0xDE, 0x1C, 0x66, 0x82, 0x5D, 0x2A, 
+0xBE, 0xB1, 0xD7, 0xA5, 0xE2, 0x58, 0xF8, 0x39, 
+0xD8, 0xC1, 0x3D, 0x06, 0x82, 0xE4, 0x74, 0xBD, 
+0xAB, 0xB6, 0x4F, 0xEA, 0x2F, 0xFA, 0x47, 0x43, 
+0x36, 0x37, 0x31, 0x75, 0x16, 0xFF, 0x35, 0x99, 
+0x73, 0x0B, 0xE2, 0x67, 0x40, 0xC6, 0x3E, 0x06, 
+0x9B, 0x68, 0xE7, 0x28, 0xF4, 0xDE, 0x5F, 0x7B, 
+0xCC, 0x2A, 0x0F, 0xC4, 0xD5, 0xC8, 0x89, 0x07, 
+0xAA, 0x27, 0xAE, 0xD4, 0x6B, 0xEB, 0xF6, 0x9D, 
+0xB6, 
+
+0x78, 0x06, 0x36, 0x15, 0x0D, 0x21, 0xEC, 0xA9, 
+0x41, 0x7B, 0xD3, 0xE6, 0x4C, 0xC8, 0x8E, 0x0E, 
+0x5A, 0xE1, 0x42, 0xE3, 0x41, 0x5C, 0x0D, 0x31, 
+0x78, 0x8D, 0x13, 0x5A, 0x24, 0x79, 0x5D, 0x68, 
+0x1A, 0x0A, 0x62, 0x02, 0x70, 0x3A, 0xEB, 0xDB, 
+0xC3, 0xF2, 0xD6, 0x0B, 0x6F, 0x36, 0x5B, 0xFD, 
+0x02, 0xF5, 0x7B, 0x42, 0x9F, 0xE5, 0x8A, 0x08, 
+0xA6, 0x4B, 0x64, 0x1E, 0xE2, 0x02, 0xDD, 0x30, 
+0x16, 0x8D, 0x2D, 0x4A, 0xD0, 0xA2, 0x83, 0xF5, 
+0x65, 0xA7, 0x0A, 0x86, 0x2C, 0x35, 0x44, 0x3D, 
+0xC7, 0x74, 0x56, 0x5B, 0x26, 0x39, 0xB8, 0xBF, 
+0x07, 0x6B, 0xE2, 0xC0, 0x29, 0xF5, 0x0E, 0x21, 
+0x39, 0x88, 
+
+0x7F, 0x1B, 0x2F, 0xE2, 0x66, 0x7E, 0xBC, 0x45, 
+0x2D, 0x6A, 0xF2, 0xF7, 0xC3, 0x06, 0xC7, 0x6F, 
+0xA5, 0x35, 0xDE, 0x73, 0x26, 0xC3, 0x54, 0x6E, 
+0x16, 0x9D, 0x0E, 0x4C, 0x30, 0x9F, 0xFC, 0x68, 
+0x10, 0x82, 0x80, 0xCD, 0x69, 0x80, 0x24, 0x96, 
+0xC6, 0x84, 0x0F, 0x34, 0x7A, 0x54, 0x51, 0x22, 
+0x11, 0x8F, 0x75, 0x65, 0x5F, 0x87, 0x1C, 0xC8, 
+0x3F, 0x1E, 0x2D, 0xB8, 0x30, 0xA3, 0xE1, 0x7D, 
+0x35, 0x12, 0xAF, 0x78, 0x03, 0x9C, 0x75, 0xA5, 
+0x5B, 0x37, 0x3D, 0x39, 0xCB, 0x7B, 0x4B, 0xEF, 
+0x11, 0x81, 0x74, 0xBB, 0x3E, 0x2D, 0xE5, 0x64, 
+0xF6, 0xBF, 0xAA, 0x04, 0x48, 0xC5, 0x14, 0x5B, 
+0xB8, 0xB1, 0x63, 
+
+0xEA, 0xA8, 0xEE, 0xBC, 0xA2, 0x36, 0xFF, 0xE0, 
+0x4F, 0xCA, 0xFA, 0x89, 0x24, 0x17, 0x02, 0x16, 
+0xDB, 0x80, 0xC4, 0xB9, 0x21, 0xDA, 0x0F, 0x73, 
+0xF8, 0x28, 0x93, 0xEF, 0x0C, 0x00, 0x95, 0x3A, 
+0x3F, 0x6E, 0x1A, 0x6D, 0x6F, 0xDD, 0xBC, 0x70, 
+0xEF, 0x7F, 0x34, 0x04, 0x76, 0x7B, 0x42, 0x0B, 
+0x4E, 0x66, 0xCC, 0x41, 0xE2, 0xC5, 0xF9, 0xE0, 
+0xF4, 0x1D, 0xB5, 0x59, 0x5F, 0x5B, 0x15, 0xC1, 
+0x22, 0x43, 0xCD, 0x01, 0x63, 0x19, 0x95, 0x7B, 
+0x26, 0xA7, 0x6B, 0xB5, 0x50, 0x9E, 0xD3, 0x32, 
+0xDE, 0x4A, 0x54, 0x8D, 0x63, 0x29, 0xC4, 0xE1, 
+0x80, 0x3A, 0xD2, 0x7A, 0x97, 0xB3, 0x50, 0x66, 
+0x76, 0x9F, 0x09, 0x8E, 
+
+0xF4, 0xA5, 0x13, 0x47, 0x6F, 0xD2, 0x3A, 0xA4, 
+0xAE, 0x91, 0x69, 0xDC, 0x50, 0xF9, 0xD3, 0x11, 
+0x89, 0x0D, 0x2C, 0x99, 0xA5, 0x5F, 0xC3, 0x02, 
+0x79, 0xFA, 0xDF, 0x82, 0x4F, 0x83, 0x7A, 0x04, 
+0xE4, 0x81, 0x12, 0x78, 0x69, 0x08, 0xF9, 0xD2, 
+0xD7, 0x86, 0xDF, 0x15, 0x96, 0xF2, 0x76, 0xFD, 
+0x6C, 0x89, 0x0D, 0xFC, 0x74, 0x12, 0x24, 0x63, 
+0xF1, 0x54, 0x75, 0x95, 0x2E, 0xDF, 0x85, 0xE6, 
+0xCE, 0xB7, 0xFE, 0xA8, 0x6D, 0x85, 0xAA, 0x3B, 
+0x6B, 0x61, 0x3E, 0x96, 0x69, 0x57, 0xB2, 0x31, 
+0x08, 0x7E, 0xF9, 0xC7, 0x4F, 0x2C, 0xF7, 0x76, 
+0x52, 0x11, 0xD0, 0x48, 0x75, 0x85, 0x38, 0x49, 
+0xF1, 0x63, 0xFB, 0x70, 0xDC, 
+
+0x82, 0xA5, 0x1C, 0x3A, 0xE9, 0xB2, 0xE7, 0x0F, 
+0x17, 0xA9, 0xA9, 0xFE, 0x55, 0xC9, 0x2C, 0xD9, 
+0x56, 0x62, 0xC9, 0x98, 0x76, 0x1A, 0x35, 0x18, 
+0x3C, 0xC4, 0xC9, 0xA1, 0x2E, 0x36, 0x76, 0x55, 
+0xCF, 0xA5, 0xC6, 0x72, 0x88, 0xA1, 0x65, 0x2C, 
+0x0C, 0x4C, 0x0F, 0xC2, 0xF1, 0xFE, 0x1E, 0xEC, 
+0x04, 0x75, 0x18, 0xDA, 0xEC, 0x42, 0x32, 0x7A, 
+0x55, 0x70, 0xE6, 0x3D, 0x58, 0x62, 0x6B, 0x7D, 
+0xE5, 0x74, 0x13, 0x5D, 0xE9, 0xFA, 0x6D, 0xC3, 
+0x97, 0x93, 0xD8, 0xB2, 0xA3, 0xA0, 0x17, 0x7D, 
+0x04, 0x0A, 0x0F, 0x75, 0x9C, 0x4C, 0x58, 0xD5, 
+0xED, 0x07, 0x8E, 0x19, 0x43, 0x09, 0xDB, 0x2B, 
+0xEF, 0xE7, 0xD8, 0x29, 0x78, 0x89, 
+
+0xC3, 0x7D, 0xE3, 0xF5, 0x92, 0xDC, 0x47, 0x30, 
+0x53, 0xDF, 0x9C, 0x67, 0x2A, 0x69, 0xE7, 0xF6, 
+0xB1, 0xA1, 0xF6, 0x0D, 0x67, 0x38, 0xBB, 0xE8, 
+0xE4, 0x67, 0xD2, 0xFA, 0xCD, 0x9D, 0xFA, 0x4B, 
+0xAB, 0x9D, 0xDA, 0x82, 0x21, 0x2E, 0x14, 0xDE, 
+0x2A, 0x77, 0xC2, 0x22, 0x88, 0x9B, 0x0D, 0x64, 
+0xE3, 0xEC, 0xAC, 0xA2, 0x3B, 0x0E, 0xA7, 0xCA, 
+0xB2, 0x0F, 0xB8, 0x1D, 0x80, 0x25, 0x76, 0x06, 
+0x62, 0xFC, 0x4F, 0xCB, 0x75, 0xB3, 0xCB, 0xC8, 
+0x96, 0x96, 0xBC, 0x8C, 0x80, 0x2C, 0xF8, 0xBF, 
+0x12, 0xF0, 0x92, 0xC5, 0xB3, 0xA2, 0xD8, 0x04, 
+0x1B, 0x3A, 0x81, 0xE4, 0xDA, 0x36, 0x18, 0x84, 
+0xE3, 0x18, 0x83, 0x3D, 0x3D, 0x7E, 0x72, 
+
+0x60, 0xB9, 0x61, 0x5D, 0xD7, 0x3F, 0xA7, 0x8B, 
+0x17, 0xA0, 0x1D, 0xFD, 0xEC, 0xBF, 0xEB, 0x6D, 
+0x39, 0x86, 0xF5, 0x18, 0x06, 0x17, 0x3E, 0xCC, 
+0xE5, 0x3C, 0x61, 0xB9, 0x67, 0xD7, 0xF0, 0x42, 
+0xA6, 0xFF, 0xCE, 0x75, 0x5B, 0x13, 0x4D, 0xB5, 
+0xA2, 0xCC, 0xE9, 0x67, 0xBC, 0x16, 0x18, 0x0A, 
+0x40, 0xA2, 0xAE, 0xF9, 0x1A, 0x02, 0x7A, 0xE4, 
+0x5D, 0xB9, 0x8C, 0x3F, 0x3F, 0xD5, 0x90, 0xD2, 
+0x66, 0x38, 0x37, 0x44, 0x68, 0xBF, 0xC6, 0x02, 
+0xB8, 0x38, 0x50, 0xCF, 0xEB, 0x4D, 0x90, 0x9B, 
+0x6A, 0xA5, 0x4F, 0xEC, 0xF6, 0xF8, 0x9F, 0x9E, 
+0x81, 0x3D, 0x9F, 0xB7, 0xB6, 0xF7, 0x02, 0x60, 
+0xAC, 0x9C, 0xEF, 0x3B, 0x82, 0xB1, 0xEB, 0xB4, 
+
+0xAA, 0x25, 0x11, 0x55, 0x8B, 0x44, 0xC6, 0x68, 
+0x6F, 0x10, 0x84, 0x86, 0x49, 0x21, 0xD3, 0xA1, 
+0x98, 0x65, 0x6D, 0x51, 0x4C, 0x6C, 0x02, 0x53, 
+0xF6, 0xF0, 0xDC, 0x79, 0xA8, 0xA1, 0x22, 0x49, 
+0x7F, 0x21, 0x84, 0x21, 0x12, 0x26, 0x78, 0x33, 
+0xCC, 0x61, 0x7F, 0xB0, 0x37, 0x6F, 0xDB, 0x5F, 
+0x8B, 0xB2, 0x32, 0x2D, 0xC6, 0x17, 0x49, 0xED, 
+0x45, 0x41, 0x9A, 0x1C, 0xF7, 0x5B, 0x8F, 0xFE, 
+0x15, 0xE5, 0x56, 0xF0, 0x52, 0xB2, 0x81, 0x87, 
+0x65, 0xE7, 0xDA, 0x3E, 0x21, 0xE8, 0x1E, 0x3F, 
+0xB8, 0x31, 0xCF, 0x75, 0x30, 0x56, 0xBE, 0xBC, 
+0xD6, 0xC3, 0x93, 0xDB, 0xF0, 0xFC, 0x68, 0xF6, 
+0xCA, 0x88, 0x93, 0x57, 0x9C, 0xFF, 0x57, 0xB8, 
+0x97, 
+
+0x66, 0xCD, 0x83, 0xD7, 0xCD, 0xD6, 0xA0, 0x7F, 
+0x33, 0xC9, 0x0F, 0x9D, 0x78, 0xE4, 0x68, 0x93, 
+0x6D, 0x09, 0x50, 0x00, 0xED, 0xFB, 0xD6, 0x8F, 
+0x4D, 0x96, 0x1F, 0x44, 0xA3, 0xA6, 0x8C, 0x54, 
+0xE1, 0xAA, 0x66, 0x3B, 0xB7, 0xBB, 0xD4, 0x56, 
+0x19, 0x9A, 0x24, 0xBB, 0x84, 0x75, 0x8C, 0xAB, 
+0xF6, 0x9C, 0x71, 0xDC, 0xDF, 0xD8, 0xF3, 0x3B, 
+0x07, 0x11, 0xEA, 0x6F, 0x06, 0xE4, 0x60, 0x60, 
+0x01, 0x0B, 0xB3, 0x68, 0xE6, 0x52, 0x69, 0xAA, 
+0x86, 0xF3, 0x4E, 0xC9, 0x83, 0x2C, 0xD2, 0xC8, 
+0xB7, 0xBE, 0xBF, 0x36, 0xD4, 0xE1, 0x2D, 0xE7, 
+0x3E, 0x53, 0x40, 0xCF, 0xF2, 0x73, 0xAE, 0x00, 
+0x71, 0x23, 0x75, 0xE7, 0xEE, 0xFA, 0xC4, 0x75, 
+0xE7, 0x41, 
+
+0x77, 0xF3, 0xF4, 0xFD, 0xF3, 0x6E, 0xEE, 0xF7, 
+0x73, 0xB9, 0x61, 0x6F, 0xBA, 0xCE, 0x19, 0xDF, 
+0x1E, 0x39, 0x2D, 0x63, 0xE4, 0xF0, 0x08, 0xA4, 
+0x4C, 0xA5, 0x21, 0xC7, 0x6A, 0xCF, 0x35, 0xD6, 
+0x68, 0x24, 0x42, 0x8E, 0x8E, 0x11, 0x7F, 0x3F, 
+0xA8, 0xC9, 0xD5, 0x49, 0xCD, 0xA8, 0x04, 0x83, 
+0x31, 0xEC, 0x47, 0x7C, 0xCA, 0xC3, 0x4F, 0x87, 
+0x58, 0xD9, 0x9C, 0xE5, 0x31, 0x31, 0x05, 0xF8, 
+0xF3, 0xF0, 0x6F, 0xFF, 0xCE, 0x01, 0x8B, 0x60, 
+0xC8, 0xE1, 0xA5, 0x72, 0x77, 0xD1, 0x62, 0x3F, 
+0x8E, 0x62, 0x84, 0x3A, 0x2B, 0xA5, 0x4F, 0x18, 
+0x55, 0x1F, 0xDB, 0xD6, 0x51, 0x2A, 0xC1, 0x70, 
+0x1D, 0xBF, 0x1F, 0x30, 0x93, 0x14, 0xF8, 0x75, 
+0x4B, 0x22, 0x01, 
+
+0xA7, 0x07, 0x1F, 0x54, 0x39, 0x8C, 0xD3, 0x36, 
+0xD6, 0x03, 0x4B, 0xDF, 0x01, 0xEF, 0x02, 0x32, 
+0x5F, 0x30, 0x88, 0xBF, 0xD5, 0x75, 0xD0, 0xAB, 
+0x80, 0xED, 0x3B, 0x06, 0xBE, 0x87, 0xB6, 0xA1, 
+0x57, 0x17, 0x2E, 0x9B, 0xA1, 0xAD, 0x4C, 0x6C, 
+0x37, 0xEC, 0x0A, 0xA9, 0x38, 0x24, 0x1B, 0x68, 
+0x70, 0x39, 0x50, 0x29, 0x20, 0x82, 0x3F, 0xE4, 
+0x50, 0xFA, 0xF5, 0x47, 0x64, 0xE0, 0x4B, 0xA5, 
+0x4E, 0x9B, 0x63, 0x9C, 0x4C, 0x28, 0x58, 0x15, 
+0x1D, 0xF8, 0xA7, 0x34, 0xFB, 0xD1, 0xF4, 0x99, 
+0xC4, 0xC3, 0xDB, 0xF3, 0x08, 0x5C, 0x66, 0xFD, 
+0xAD, 0xDA, 0x82, 0x4B, 0x08, 0xA5, 0xF6, 0x55, 
+0xBD, 0x4B, 0x1D, 0xA2, 0x5C, 0x65, 0x62, 0xE1, 
+0xA1, 0x39, 0x87, 0x9B, 
+
+0xF0, 0xDB, 0x1E, 0x0F, 0x55, 0x42, 0xB3, 0x75, 
+0x98, 0xE6, 0x63, 0x69, 0x16, 0x7F, 0xF7, 0x84, 
+0x22, 0x47, 0xC8, 0x3F, 0x00, 0x45, 0x8A, 0xAA, 
+0x4A, 0x97, 0x2E, 0xB4, 0xA9, 0x1A, 0x5F, 0xE9, 
+0x9A, 0x4E, 0x64, 0x23, 0x42, 0xC0, 0x3C, 0x64, 
+0x87, 0x59, 0x36, 0xFC, 0xEF, 0x44, 0x3A, 0xB9, 
+0xAF, 0x87, 0xD8, 0x48, 0x72, 0x92, 0x42, 0x2B, 
+0xA0, 0x3E, 0x6F, 0xF2, 0xBE, 0xEF, 0x0A, 0x29, 
+0xAF, 0x34, 0x12, 0x23, 0x96, 0xC4, 0xEC, 0x4E, 
+0x01, 0x52, 0x6F, 0x75, 0xBF, 0xCC, 0x8C, 0x41, 
+0xB0, 0xB5, 0x80, 0xC3, 0x3A, 0xFA, 0x1A, 0xAD, 
+0x62, 0x1D, 0x0B, 0x19, 0xF6, 0xE2, 0x42, 0x4F, 
+0xA9, 0xA0, 0x69, 0xF1, 0xB7, 0x8F, 0x86, 0xEF, 
+0x85, 0xE6, 0x52, 0x63, 0x34, 
+
+0x33, 0x61, 0xC7, 0x49, 0x56, 0xC7, 0x53, 0xE1, 
+0x6B, 0xA0, 0x84, 0x02, 0xAD, 0x88, 0x83, 0xB6, 
+0x26, 0xDD, 0xA5, 0x32, 0xB9, 0xC4, 0xC7, 0x57, 
+0xAD, 0xC1, 0x75, 0x10, 0x66, 0xDE, 0x9C, 0xE5, 
+0x6A, 0xA5, 0xE4, 0x53, 0xD9, 0x0D, 0x12, 0x8C, 
+0x13, 0xEC, 0x33, 0x81, 0xBF, 0x46, 0x08, 0xF1, 
+0x44, 0xA9, 0xE9, 0x96, 0xE8, 0x56, 0x16, 0x0C, 
+0x1E, 0xB7, 0x63, 0x8A, 0x0F, 0x20, 0x45, 0x0F, 
+0x8B, 0x35, 0x20, 0x31, 0xAA, 0xE8, 0x47, 0xB6, 
+0xDE, 0xB9, 0x4D, 0x97, 0x7E, 0x72, 0x84, 0xBE, 
+0x2E, 0x63, 0x2C, 0xB3, 0x32, 0xA9, 0x9F, 0x79, 
+0x83, 0xD2, 0xE0, 0xA3, 0xC5, 0x61, 0x73, 0xA6, 
+0x27, 0x9A, 0x0E, 0x4B, 0xFC, 0xB3, 0x8C, 0x14, 
+0x58, 0x35, 0x6E, 0x83, 0x61, 0x75, 
+
+0x4F, 0x63, 0xF6, 0x70, 0x2A, 0xCF, 0x69, 0xE6, 
+0xE7, 0x11, 0x51, 0x0E, 0x14, 0x0A, 0x6D, 0xFE, 
+0xD3, 0x5A, 0x4C, 0xC9, 0xA3, 0xA2, 0x12, 0x36, 
+0xD1, 0x22, 0x5D, 0xDB, 0xED, 0x0C, 0xDD, 0x1F, 
+0xE5, 0x9B, 0x92, 0xD6, 0xE1, 0xF0, 0xA5, 0x0F, 
+0xDF, 0x44, 0xF2, 0xD5, 0x2C, 0x08, 0x94, 0x58, 
+0x35, 0xAF, 0x53, 0x3C, 0x53, 0x75, 0xDF, 0x4D, 
+0x00, 0x14, 0x41, 0xED, 0xCD, 0xC2, 0x09, 0xAD, 
+0x8B, 0x13, 0xCB, 0x76, 0xE2, 0x9A, 0x00, 0x5E, 
+0x23, 0x9E, 0x6C, 0x57, 0x59, 0xDF, 0x70, 0x28, 
+0x1C, 0xD2, 0x4A, 0xB3, 0x7E, 0x42, 0x88, 0x68, 
+0x1B, 0xF0, 0x64, 0x9E, 0xDB, 0x5A, 0xC6, 0x0B, 
+0xA4, 0x4F, 0x96, 0x2E, 0x49, 0x32, 0x58, 0x7F, 
+0x71, 0x3A, 0x0B, 0x6C, 0x4D, 0x65, 0x47, 
+
+0x3C, 0x47, 0x68, 0x28, 0xAB, 0xB4, 0x10, 0x4F, 
+0x3D, 0xCB, 0x8A, 0x5E, 0x6A, 0xD8, 0x0A, 0xD1, 
+0xE3, 0xBD, 0xB0, 0x42, 0xAE, 0xA0, 0x14, 0xA0, 
+0x38, 0xBE, 0x0B, 0x2D, 0x34, 0x1C, 0x99, 0x8E, 
+0xDD, 0x24, 0xCA, 0x23, 0x68, 0x40, 0xA6, 0xD6, 
+0xC6, 0x61, 0xFF, 0x67, 0x27, 0xAA, 0xCC, 0xEC, 
+0xBE, 0xCB, 0xC1, 0x91, 0xC3, 0xA0, 0x61, 0xD6, 
+0x8B, 0x3B, 0xFF, 0x83, 0xBB, 0xA8, 0x45, 0x1E, 
+0xFD, 0x32, 0xDF, 0xF8, 0xF8, 0x17, 0x1D, 0x34, 
+0x7A, 0xDF, 0x46, 0x9F, 0xB7, 0xEA, 0x93, 0x48, 
+0x8E, 0xC3, 0x1B, 0x7D, 0x22, 0xC8, 0xD9, 0x7F, 
+0x1B, 0x9A, 0x0E, 0x68, 0x2C, 0x13, 0xE6, 0x56, 
+0x55, 0x12, 0x35, 0x8C, 0x28, 0xF5, 0xC9, 0x9F, 
+0x45, 0x6A, 0xAC, 0xAE, 0x8C, 0x88, 0x6F, 0x99, 
+
+0xB1, 0xC7, 0x0D, 0x71, 0xC2, 0x0A, 0x8C, 0x6C, 
+0x9F, 0xE1, 0xE3, 0xA5, 0xB3, 0x2D, 0x30, 0x5A, 
+0xF1, 0x2F, 0x93, 0x3D, 0x44, 0x59, 0xC1, 0x39, 
+0xE6, 0x26, 0x2C, 0x50, 0xF9, 0x53, 0x6C, 0xBD, 
+0xB7, 0xD3, 0x1E, 0xB0, 0xA2, 0x31, 0x00, 0x26, 
+0x14, 0x12, 0xEB, 0x11, 0x3B, 0x65, 0xB7, 0xD0, 
+0x15, 0xB4, 0x57, 0x0F, 0x16, 0xB4, 0x61, 0xAB, 
+0x7B, 0xAF, 0x4D, 0x15, 0xFA, 0xD4, 0x14, 0x58, 
+0x68, 0x6B, 0xB1, 0x12, 0x6B, 0xD9, 0x2C, 0x8F, 
+0x93, 0xC7, 0x3C, 0x2B, 0x44, 0xA4, 0x3B, 0x07, 
+0xB4, 0xA1, 0x94, 0x72, 0xC3, 0x9F, 0xCA, 0x0B, 
+0xA3, 0x1F, 0xD4, 0x84, 0xA3, 0x26, 0xF0, 0xDB, 
+0xE0, 0xF8, 0x8C, 0xAB, 0xEE, 0x84, 0x96, 0x48, 
+0x21, 0xFF, 0xEE, 0x47, 0xCD, 0xA5, 0x00, 0x38, 
+0xD5, 
+
+0x43, 0xF3, 0x9F, 0x23, 0x8C, 0xB1, 0x47, 0x3E, 
+0x64, 0xD3, 0xD9, 0x25, 0x5D, 0xBF, 0xEC, 0x64, 
+0xEF, 0x34, 0xCB, 0xEA, 0xE7, 0x55, 0x67, 0x1E, 
+0x3E, 0xF5, 0x02, 0xFF, 0x68, 0xA8, 0x35, 0x83, 
+0xAE, 0x3C, 0x11, 0x4D, 0x8D, 0x40, 0xCE, 0xF2, 
+0x73, 0x9E, 0xB8, 0x15, 0x8E, 0xB7, 0x9F, 0x43, 
+0x96, 0x63, 0xA6, 0x0B, 0x0F, 0x50, 0xCD, 0x0E, 
+0x51, 0x8D, 0xD6, 0xE8, 0x49, 0x90, 0x05, 0x99, 
+0xAB, 0x0F, 0x73, 0xCE, 0x03, 0x96, 0x35, 0x0B, 
+0x52, 0x0D, 0xE3, 0x09, 0x0B, 0x6E, 0x50, 0x93, 
+0xDC, 0x0B, 0xAC, 0xA8, 0x28, 0x7B, 0x14, 0x15, 
+0x22, 0x96, 0xB6, 0x27, 0x77, 0x12, 0x41, 0x74, 
+0xD6, 0x6D, 0xA2, 0x4F, 0xA0, 0x6B, 0x1D, 0x45, 
+0xEB, 0x0F, 0x20, 0xE9, 0xE3, 0x90, 0xB6, 0x20, 
+0x0A, 0xD2, 
+
+0x66, 0xEB, 0x32, 0xCD, 0x3F, 0x72, 0xF6, 0xDF, 
+0x74, 0xD8, 0xCC, 0xBF, 0x46, 0xB4, 0xB5, 0x61, 
+0x2A, 0xB5, 0x7C, 0x96, 0xC3, 0xD4, 0x21, 0x3D, 
+0xC9, 0x32, 0x05, 0xE6, 0xDD, 0x62, 0xB9, 0xB2, 
+0x6D, 0x0E, 0x1A, 0xE6, 0x7B, 0xB3, 0x7E, 0xAD, 
+0x3F, 0x51, 0x28, 0x03, 0xA7, 0x75, 0x7B, 0x7A, 
+0x64, 0x26, 0x83, 0xD9, 0xA0, 0x80, 0x81, 0x4A, 
+0x0D, 0x28, 0x00, 0xD4, 0x26, 0xFA, 0xB9, 0xBD, 
+0x18, 0x2C, 0xDF, 0x4A, 0xE0, 0xEC, 0x7A, 0xB9, 
+0xA0, 0xEF, 0xD4, 0xDD, 0x93, 0x56, 0xFB, 0x92, 
+0x44, 0x43, 0x88, 0x31, 0x8D, 0x3B, 0x8E, 0x86, 
+0x13, 0x2F, 0xA7, 0xA2, 0x43, 0xF4, 0x6E, 0xA8, 
+0xF8, 0xAD, 0x06, 0xB3, 0xF4, 0xD8, 0x6C, 0x88, 
+0x91, 0x31, 0x57, 0x4D, 0xBD, 0xDF, 0xA3, 0x99, 
+0x15, 0xC0, 0xAC, 
+
+0xB0, 0xC5, 0x2B, 0x4F, 0xDC, 0x35, 0x9E, 0x4D, 
+0x07, 0x82, 0xE6, 0x77, 0xA7, 0xF1, 0x2D, 0xE5, 
+0x3A, 0xB6, 0x3B, 0xF5, 0x11, 0x68, 0xED, 0xF2, 
+0xFC, 0x68, 0xA1, 0x97, 0x1E, 0x61, 0x1C, 0x18, 
+0xAF, 0x45, 0x98, 0x52, 0x4F, 0x8F, 0x13, 0x81, 
+0xD9, 0x4D, 0x92, 0x31, 0x19, 0x96, 0x2E, 0x17, 
+0xCA, 0x6D, 0x9D, 0x4D, 0x22, 0x3E, 0xE1, 0x66, 
+0xD6, 0xCF, 0x90, 0x0E, 0xE9, 0x63, 0x73, 0x18, 
+0xC7, 0x1D, 0x5E, 0xCC, 0xE3, 0x0D, 0xED, 0x92, 
+0xE5, 0xDE, 0x77, 0x1D, 0x19, 0xD3, 0x2A, 0xD7, 
+0x8C, 0x86, 0x62, 0x48, 0x38, 0x61, 0x9D, 0x12, 
+0x6E, 0xAD, 0x7A, 0x9C, 0xCD, 0x3B, 0x7E, 0x0B, 
+0x8C, 0xF6, 0x16, 0x73, 0xF7, 0x44, 0xE0, 0xD0, 
+0xD3, 0x58, 0x2B, 0x79, 0xDA, 0xF2, 0x02, 0x7B, 
+0x5A, 0xB7, 0x5C, 0xA0, 
+
+0xB7, 0x88, 0x44, 0xCF, 0x65, 0xC9, 0x76, 0x54, 
+0x6F, 0x51, 0x19, 0x5E, 0x00, 0x64, 0x0F, 0x0F, 
+0xE7, 0x4F, 0xAC, 0x74, 0xE6, 0x41, 0x19, 0xAF, 
+0x4B, 0xA2, 0xCD, 0x8A, 0x58, 0x4E, 0xD8, 0x3C, 
+0x1B, 0xD6, 0x16, 0x6C, 0x7F, 0xC5, 0x22, 0x25, 
+0x2C, 0x6A, 0x4C, 0x5B, 0x50, 0x9F, 0xAD, 0x92, 
+0xA5, 0x3E, 0x8E, 0xD4, 0x4A, 0xAD, 0x3F, 0x69, 
+0xD1, 0x44, 0x4F, 0x38, 0x01, 0xE3, 0x73, 0x9D, 
+0xC3, 0x12, 0xFF, 0xA3, 0xE5, 0x90, 0x33, 0xB1, 
+0x55, 0x1A, 0xAB, 0x95, 0x6E, 0xDF, 0x69, 0x3D, 
+0xE8, 0x38, 0xB4, 0x33, 0xE4, 0x06, 0xB9, 0x84, 
+0xB2, 0x74, 0xB1, 0x22, 0x83, 0x30, 0x9C, 0x6F, 
+0xD8, 0x30, 0x60, 0x29, 0x26, 0xEB, 0x65, 0x0A, 
+0x98, 0x13, 0x32, 0x73, 0xF1, 0xBF, 0x19, 0x3E, 
+0xAA, 0xE8, 0x33, 0x25, 0x61, 
+
+0xF0, 0xD2, 0x31, 0x54, 0xB8, 0x16, 0x3F, 0x37, 
+0xD9, 0x67, 0x88, 0x18, 0x2B, 0x2B, 0xB4, 0xB9, 
+0x87, 0x4F, 0x02, 0x5A, 0x61, 0xF1, 0xAB, 0x87, 
+0x39, 0xDE, 0x3C, 0xFB, 0x6C, 0x55, 0xCE, 0x1B, 
+0xEE, 0x99, 0x99, 0x58, 0xA6, 0xDF, 0x6D, 0x7D, 
+0xB9, 0x97, 0xD4, 0xB9, 0x8F, 0xE9, 0xD0, 0x8D, 
+0xCB, 0x60, 0x0A, 0x4B, 0x97, 0x2D, 0x1F, 0x8A, 
+0x58, 0x28, 0xFE, 0x00, 0x63, 0x0C, 0x40, 0xD5, 
+0x08, 0x4C, 0x3A, 0xE0, 0xE0, 0x92, 0x5E, 0xA4, 
+0x33, 0xB7, 0x2C, 0xC9, 0x13, 0xB2, 0xA5, 0xA3, 
+0x50, 0x70, 0x26, 0x27, 0xE4, 0xCE, 0x8A, 0x55, 
+0xE7, 0x4D, 0x36, 0x79, 0x79, 0x0E, 0xB0, 0x10, 
+0xFD, 0x85, 0x1D, 0x12, 0x5F, 0xBA, 0x51, 0xB0, 
+0x62, 0xDB, 0x30, 0x3B, 0xD7, 0x3B, 0x0B, 0xAE, 
+0x9E, 0xFC, 0x9E, 0xC3, 0x57, 0xBC, 
+
+0xE9, 0x9F, 0x65, 0xC5, 0xDB, 0x03, 0x1A, 0xF3, 
+0x9C, 0xC8, 0x6E, 0xAA, 0x9C, 0x96, 0xAA, 0xD9, 
+0xAC, 0xED, 0xA8, 0xA2, 0x47, 0xF3, 0x41, 0x91, 
+0x86, 0x9F, 0x37, 0xFF, 0xF9, 0x40, 0x4B, 0xD3, 
+0x58, 0x6C, 0x86, 0xC1, 0xBE, 0xE3, 0xF4, 0x48, 
+0xAE, 0x20, 0x7A, 0x39, 0x7A, 0x62, 0x3B, 0x1F, 
+0x0C, 0xC9, 0x19, 0x47, 0x6E, 0x38, 0xA0, 0x77, 
+0x65, 0xAC, 0x89, 0xEC, 0xB5, 0xFB, 0xF6, 0xBF, 
+0x72, 0xFE, 0xCB, 0xBB, 0x49, 0x43, 0x43, 0x14, 
+0xC9, 0x79, 0x55, 0xA4, 0xBC, 0xBA, 0xD3, 0xE5, 
+0x50, 0x3D, 0x26, 0xAB, 0x13, 0xE5, 0xE2, 0xC2, 
+0x08, 0x7A, 0xE8, 0x7B, 0x6F, 0x4B, 0x48, 0x91, 
+0x9D, 0xEA, 0xF4, 0x59, 0x3B, 0xC0, 0x9A, 0x5D, 
+0x59, 0x5A, 0x22, 0xDD, 0x55, 0xA4, 0x81, 0x5F, 
+0x77, 0xDD, 0x02, 0x56, 0xF6, 0xE1, 0x21, 
+
+0xC6, 0xE6, 0x10, 0x3E, 0x05, 0xF5, 0xF4, 0x25, 
+0x8A, 0x6D, 0xE8, 0xE4, 0x7E, 0xA3, 0x34, 0xC4, 
+0x12, 0xEF, 0xF0, 0x5F, 0xD0, 0x9F, 0xD4, 0xA5, 
+0xD3, 0xB1, 0xFD, 0x3C, 0x7C, 0x93, 0x87, 0xB6, 
+0x76, 0x3F, 0x07, 0xC4, 0x1C, 0x4F, 0x59, 0x8E, 
+0x32, 0x7D, 0x17, 0xF0, 0x47, 0x0B, 0xF7, 0xB0, 
+0xD5, 0x2F, 0xEE, 0x66, 0x4D, 0xA4, 0x9E, 0xCB, 
+0x04, 0x35, 0x86, 0xEB, 0x22, 0x15, 0x50, 0xA4, 
+0x4A, 0xBF, 0x01, 0xE8, 0xF7, 0x39, 0x9B, 0x84, 
+0xDA, 0x75, 0x5E, 0x4E, 0x5E, 0x37, 0x3B, 0xEA, 
+0x39, 0x61, 0x69, 0xB4, 0xB8, 0x73, 0x2D, 0xC8, 
+0xDC, 0x46, 0x24, 0xC6, 0x3A, 0xA0, 0xE7, 0x98, 
+0x4E, 0x17, 0xA7, 0x80, 0x4C, 0xAD, 0x4E, 0x93, 
+0x83, 0x6A, 0x58, 0x8B, 0x04, 0xA5, 0xBB, 0x7D, 
+0xA5, 0xC8, 0x46, 0x82, 0x6F, 0xEE, 0x56, 0x9D, 
+
+0xBE, 0x3F, 0xF0, 0x69, 0x0E, 0x95, 0xD9, 0x89, 
+0xA2, 0xE9, 0x78, 0xAF, 0x77, 0x00, 0x4A, 0xBD, 
+0x52, 0xED, 0x20, 0x6E, 0xE1, 0x7D, 0x3E, 0xF1, 
+0xFC, 0xE2, 0x2F, 0x32, 0x22, 0xDC, 0x71, 0x61, 
+0xCD, 0x25, 0xEC, 0x8C, 0xED, 0x4A, 0xEB, 0x67, 
+0x91, 0x9E, 0x0C, 0x14, 0xEE, 0x44, 0x4F, 0xBC, 
+0xEA, 0x95, 0x5F, 0x66, 0x1E, 0xDA, 0xCA, 0xBE, 
+0x6D, 0x83, 0xFC, 0x5F, 0xF3, 0x31, 0x2B, 0xF4, 
+0x0A, 0x10, 0x4E, 0x8C, 0xB2, 0xC0, 0x06, 0x80, 
+0x3D, 0x1D, 0xB7, 0xB9, 0x2F, 0xAD, 0x4D, 0xF0, 
+0x70, 0xBA, 0xD4, 0x96, 0xE6, 0x46, 0xFC, 0xF0, 
+0x01, 0x75, 0xB5, 0x7F, 0xD0, 0xA6, 0x78, 0xC5, 
+0x50, 0xEE, 0xC5, 0xDE, 0x34, 0xAA, 0xA2, 0xDF, 
+0xAB, 0x03, 0x00, 0x4F, 0x05, 0xEB, 0xEF, 0x4E, 
+0x83, 0x97, 0x7D, 0x38, 0x6B, 0x26, 0xCB, 0x95, 
+0x53, 
+
+0xAE, 0xB3, 0xEB, 0x49, 0xE5, 0x31, 0xED, 0xB2, 
+0xA4, 0x55, 0x62, 0x4A, 0xCD, 0xBF, 0xB3, 0xDD, 
+0x8D, 0xD7, 0xC3, 0x3D, 0x88, 0xF9, 0x79, 0x2C, 
+0x10, 0x4D, 0xBC, 0xDB, 0x35, 0x69, 0xB8, 0xE8, 
+0xEA, 0x44, 0x6E, 0xCF, 0xD8, 0xB2, 0x94, 0x47, 
+0x83, 0x70, 0x6D, 0x47, 0x9A, 0xA4, 0xDD, 0x65, 
+0xE3, 0xD2, 0xD7, 0xF8, 0x31, 0x2A, 0xC1, 0x87, 
+0xF9, 0xAF, 0x37, 0x2D, 0x8A, 0x6D, 0x6B, 0xED, 
+0x52, 0x32, 0xF3, 0xDB, 0x75, 0x2A, 0x8D, 0x40, 
+0x8A, 0x61, 0xCE, 0x9C, 0xCB, 0x2A, 0x0C, 0x8C, 
+0xEF, 0xCB, 0x9C, 0x17, 0x01, 0xB7, 0xCB, 0xEF, 
+0x4C, 0x98, 0x5E, 0x8A, 0x0F, 0xAC, 0x8B, 0x59, 
+0xD3, 0x7A, 0x0F, 0x3A, 0xD0, 0xC1, 0xA7, 0x8F, 
+0xC4, 0x0C, 0x75, 0xDF, 0xD8, 0xA7, 0x4D, 0xE7, 
+0x2B, 0xFF, 0x8E, 0x53, 0xC8, 0x95, 0x9B, 0x04, 
+0xBB, 0x41, 
+
+0xFB, 0xAD, 0x09, 0x62, 0x93, 0x21, 0xD0, 0x23, 
+0xCC, 0xCB, 0x58, 0xBE, 0x34, 0xAF, 0xC5, 0xCC, 
+0x00, 0xE1, 0xA4, 0x22, 0x28, 0x47, 0x6E, 0xB7, 
+0x49, 0x9E, 0x7C, 0x18, 0x1C, 0x36, 0x8C, 0xE6, 
+0x46, 0xE9, 0x63, 0xCD, 0xAA, 0x09, 0x54, 0x28, 
+0xA7, 0xC1, 0xFF, 0xA1, 0xFE, 0x34, 0x1B, 0xE8, 
+0x5B, 0xC7, 0xC7, 0x5D, 0xF3, 0x88, 0x68, 0x45, 
+0x2B, 0xEC, 0xBE, 0xE9, 0x09, 0xF7, 0xB4, 0xD9, 
+0xBA, 0xE1, 0xD3, 0xDE, 0x91, 0x9D, 0x1F, 0xC8, 
+0xAF, 0xE6, 0x4E, 0x19, 0x92, 0xCB, 0x10, 0x97, 
+0xD4, 0xA0, 0x02, 0x5D, 0xA9, 0x7F, 0xAB, 0xF2, 
+0xFF, 0x99, 0x80, 0x2C, 0x2A, 0x3C, 0x0B, 0x8C, 
+0x44, 0xC4, 0xED, 0xA3, 0x59, 0x7A, 0xC5, 0x70, 
+0xB8, 0x25, 0x43, 0x2C, 0x8E, 0x7C, 0x70, 0xAA, 
+0xEF, 0x46, 0x45, 0xD8, 0x67, 0x30, 0x7D, 0x66, 
+0x1A, 0xC6, 0x89, 
+
+0xAD, 0x86, 0x8E, 0x8B, 0xA7, 0x09, 0x32, 0xBA, 
+0x5F, 0x2E, 0xD7, 0x16, 0x77, 0xF6, 0xFF, 0x6F, 
+0xCF, 0x47, 0x22, 0xC8, 0x12, 0xB1, 0xF3, 0x7E, 
+0x62, 0x90, 0xE3, 0x71, 0xCB, 0x39, 0xDB, 0x50, 
+0xE5, 0xE0, 0x63, 0xAE, 0xC7, 0x85, 0x59, 0xD7, 
+0x43, 0xB2, 0xBA, 0x2A, 0xF1, 0x95, 0xC4, 0x38, 
+0x08, 0xE4, 0x7C, 0x58, 0x99, 0xA6, 0x6E, 0xEA, 
+0xEF, 0x7C, 0x12, 0x14, 0x20, 0x23, 0xEE, 0x50, 
+0xF5, 0xD9, 0x58, 0x5C, 0x57, 0xDD, 0x17, 0x44, 
+0x20, 0x71, 0x46, 0xE6, 0x74, 0x51, 0x07, 0xF9, 
+0x42, 0x33, 0xEF, 0xB6, 0xFF, 0x13, 0xAC, 0x33, 
+0xA7, 0x08, 0x9C, 0xEE, 0x7F, 0x79, 0x8C, 0xA6, 
+0x2B, 0xFE, 0x15, 0xFC, 0x6D, 0xCC, 0x0C, 0x34, 
+0x48, 0xD6, 0x5C, 0x8A, 0x7B, 0x50, 0xCA, 0x0F, 
+0xDD, 0x42, 0x79, 0x71, 0xA4, 0x3B, 0x30, 0x96, 
+0x18, 0x4B, 0x93, 0xF4, 
+
+0x5E, 0x87, 0x10, 0xE9, 0xD8, 0x17, 0xE8, 0x32, 
+0xD5, 0xBA, 0x34, 0x16, 0x54, 0xF3, 0x34, 0x45, 
+0x22, 0xCC, 0x51, 0x4A, 0x1D, 0x39, 0xE0, 0x02, 
+0xF6, 0x47, 0x1E, 0x51, 0x6C, 0x87, 0xCB, 0x22, 
+0x98, 0xD9, 0xB8, 0xDA, 0xA2, 0x99, 0xB6, 0xFB, 
+0x89, 0xA9, 0x65, 0xCE, 0xC1, 0x8B, 0x07, 0x48, 
+0x80, 0x1B, 0x78, 0x11, 0x9F, 0x2C, 0xED, 0x80, 
+0x11, 0xA7, 0x5D, 0x3D, 0x00, 0x26, 0xD6, 0x01, 
+0x13, 0x9E, 0x59, 0x1A, 0x02, 0x52, 0x5C, 0x06, 
+0x43, 0x00, 0xFE, 0x1C, 0x3B, 0x9A, 0x01, 0xAE, 
+0xF3, 0x22, 0x9E, 0xBF, 0x99, 0x53, 0x4E, 0x21, 
+0x40, 0x54, 0x4A, 0xBE, 0x38, 0x8B, 0x17, 0x32, 
+0x27, 0x7E, 0x16, 0x0E, 0x68, 0x9A, 0x25, 0xA8, 
+0xF8, 0xA7, 0xBA, 0xFF, 0xE2, 0x13, 0x80, 0x40, 
+0xA9, 0x2F, 0x09, 0xBD, 0xD9, 0xBC, 0x2F, 0xB2, 
+0x95, 0xE1, 0xE7, 0x18, 0x53, 
+
+0x2C, 0xFA, 0x9A, 0x3E, 0xF2, 0xEA, 0x29, 0x3F, 
+0x78, 0xAF, 0x27, 0x79, 0x95, 0x8E, 0xD4, 0x31, 
+0x8A, 0xEE, 0xCA, 0xBB, 0x0C, 0x5E, 0xB1, 0xD3, 
+0x33, 0x7B, 0xCB, 0xA0, 0xB9, 0xD2, 0x81, 0xBC, 
+0x17, 0x08, 0x92, 0xDE, 0x6F, 0xC1, 0xBF, 0x71, 
+0x76, 0xC9, 0x6F, 0x4A, 0xE2, 0xBC, 0xE2, 0x8F, 
+0x4A, 0x34, 0xEB, 0xAA, 0xF5, 0x09, 0x4E, 0x7C, 
+0xF3, 0xB1, 0x16, 0x82, 0x69, 0xD8, 0x1D, 0xB3, 
+0x31, 0xD2, 0xCF, 0x96, 0x2B, 0xF1, 0x9C, 0x9B, 
+0xBF, 0x52, 0xA5, 0x11, 0x92, 0x86, 0x14, 0x53, 
+0x82, 0x21, 0xC1, 0x5B, 0x0D, 0xDE, 0x21, 0x6D, 
+0xE7, 0x3F, 0x57, 0xF9, 0xF1, 0x7F, 0x28, 0x0A, 
+0x2F, 0xA7, 0x57, 0xF0, 0x5C, 0x33, 0x22, 0xCC, 
+0xF9, 0x16, 0x94, 0x18, 0xE2, 0x99, 0x82, 0xB5, 
+0x87, 0xF0, 0x75, 0xBA, 0xBB, 0x82, 0xC8, 0x4E, 
+0x48, 0x14, 0xF2, 0x6E, 0x98, 0xD3, 
+
+0x8F, 0x90, 0xC2, 0x82, 0xD2, 0xF3, 0x11, 0x9B, 
+0xFD, 0x1A, 0x26, 0x50, 0x40, 0x99, 0xB3, 0x13, 
+0xFC, 0x27, 0x24, 0xE6, 0x65, 0x36, 0x07, 0x28, 
+0x63, 0xEE, 0x15, 0x3E, 0x27, 0x7D, 0x82, 0x0A, 
+0x23, 0xC0, 0x0F, 0xAE, 0x94, 0xB0, 0x88, 0x67, 
+0x65, 0x06, 0x5D, 0xD5, 0xF5, 0x46, 0xFA, 0xE9, 
+0x73, 0xE7, 0x2F, 0xA0, 0x0B, 0x57, 0x06, 0x1F, 
+0x75, 0x12, 0x00, 0x9A, 0x24, 0xA3, 0x88, 0x8A, 
+0xB5, 0x97, 0x7E, 0xBE, 0xE3, 0x2B, 0xAA, 0x2C, 
+0xD9, 0x81, 0xB5, 0x18, 0x47, 0x87, 0xCF, 0x1F, 
+0xBF, 0x74, 0x2C, 0x79, 0xFF, 0xE5, 0x5F, 0xB9, 
+0x20, 0xBF, 0x89, 0xA6, 0x0A, 0xF7, 0x81, 0x09, 
+0x2B, 0x26, 0xE1, 0xB1, 0xC4, 0xB6, 0xD5, 0xE6, 
+0x2D, 0x3C, 0xD7, 0x7E, 0x59, 0x84, 0xE6, 0xDF, 
+0xCA, 0x63, 0xA0, 0x53, 0x4E, 0xD7, 0x12, 0xD9, 
+0x13, 0x3F, 0x2E, 0x8C, 0x82, 0x62, 0xE3, 
+
+0x00, 0x8D, 0x7A, 0x12, 0x2C, 0x9C, 0xA3, 0x22, 
+0xAD, 0x61, 0xF3, 0x93, 0xA0, 0x3F, 0x14, 0xC5, 
+0x34, 0x2E, 0xF4, 0x17, 0x1D, 0x03, 0x75, 0xEA, 
+0x85, 0xB4, 0x90, 0xA5, 0x23, 0x62, 0x91, 0xA3, 
+0x7F, 0x98, 0x1D, 0x36, 0x0A, 0x86, 0x51, 0xA1, 
+0x20, 0x72, 0xA3, 0x0C, 0x2A, 0xD3, 0x3B, 0x1A, 
+0x6C, 0xD0, 0x6B, 0x4B, 0x49, 0x7A, 0x68, 0x58, 
+0x9C, 0xB8, 0xB2, 0xD5, 0x1F, 0xFF, 0x3F, 0x47, 
+0xD2, 0xCD, 0x4C, 0xF7, 0x4F, 0x07, 0xEE, 0x7A, 
+0x8D, 0x0B, 0xF5, 0x38, 0x7C, 0x27, 0xA4, 0x36, 
+0xFD, 0xF5, 0x01, 0xBA, 0x60, 0x81, 0xBE, 0x99, 
+0x1D, 0x85, 0x71, 0x13, 0x61, 0xB8, 0xDB, 0x23, 
+0x72, 0x2B, 0x25, 0xF9, 0xEA, 0x10, 0xE6, 0xA0, 
+0x79, 0x1B, 0xA4, 0xA2, 0x3B, 0x69, 0x8A, 0xB6, 
+0x51, 0xC3, 0x3F, 0x1F, 0x91, 0x24, 0x2A, 0xDF, 
+0x77, 0x66, 0xEC, 0x2D, 0x40, 0xA8, 0x5E, 0xC3, 
+
+0xB2, 0x8F, 0x0D, 0x33, 0xA5, 0xEB, 0x01, 0xFE, 
+0x9E, 0x3C, 0x3D, 0xD3, 0x60, 0x4D, 0x36, 0xF1, 
+0x1B, 0xA0, 0x8E, 0x61, 0x75, 0x10, 0x8E, 0xB1, 
+0xC1, 0x28, 0x7E, 0xE9, 0x8D, 0x11, 0x25, 0x66, 
+0xF3, 0xDB, 0x7E, 0x05, 0x2A, 0x90, 0xEB, 0xDB, 
+0x59, 0x30, 0x63, 0x5B, 0xDA, 0xE5, 0x44, 0xA8, 
+0x09, 0x21, 0xF1, 0x52, 0x01, 0xDF, 0x71, 0x9C, 
+0x5C, 0xAD, 0xAD, 0x4D, 0x61, 0x9C, 0x4F, 0xC3, 
+0x1E, 0x80, 0xF2, 0xAB, 0xE3, 0x90, 0x2B, 0x7F, 
+0x50, 0x50, 0xAE, 0xD5, 0xB9, 0x28, 0x54, 0x9A, 
+0x24, 0x4B, 0xF5, 0x89, 0x8E, 0x3C, 0x4A, 0xFC, 
+0xB0, 0x7D, 0xCC, 0x35, 0x2C, 0x68, 0xE3, 0xA0, 
+0xC7, 0x11, 0xEA, 0xE1, 0x1B, 0xD6, 0x12, 0x9A, 
+0xE4, 0x84, 0xF5, 0x59, 0xA8, 0x67, 0x62, 0x24, 
+0x55, 0xA2, 0x1D, 0x99, 0x02, 0x66, 0xEC, 0x1B, 
+0xB5, 0x17, 0xE9, 0xFB, 0x98, 0x02, 0x6A, 0x01, 
+0xE0, 
+
+0x71, 0x47, 0x6C, 0xA9, 0xFC, 0x5C, 0xD9, 0x6E, 
+0x74, 0xAF, 0x5F, 0xA0, 0xB0, 0x3E, 0xB6, 0xB4, 
+0x98, 0xE7, 0xD5, 0x01, 0x97, 0xB3, 0x3F, 0x63, 
+0xA9, 0x12, 0x1B, 0xEB, 0xD5, 0xB0, 0x3A, 0xB6, 
+0x25, 0x85, 0xA9, 0x18, 0x6F, 0x2F, 0xD8, 0x59, 
+0x33, 0x8D, 0xD0, 0x6F, 0xD0, 0x0B, 0xF0, 0xAD, 
+0x6B, 0x8C, 0xBD, 0xAA, 0xA2, 0xEF, 0xC2, 0x8C, 
+0xA5, 0xE4, 0x55, 0x54, 0x4C, 0x92, 0xF3, 0xF8, 
+0x8E, 0xE0, 0x30, 0x81, 0x7A, 0x04, 0x1C, 0x7F, 
+0xCD, 0xDF, 0xDB, 0x7B, 0x2F, 0x81, 0x42, 0x97, 
+0x9B, 0x6B, 0x20, 0xB0, 0x94, 0xD7, 0x17, 0xC8, 
+0x5A, 0x66, 0x09, 0x2A, 0x96, 0xE4, 0xB0, 0x18, 
+0xE1, 0x68, 0xBB, 0xA2, 0xC2, 0xB3, 0xDE, 0x3E, 
+0x0B, 0xC7, 0x84, 0x94, 0x9E, 0xC0, 0xA3, 0x92, 
+0x27, 0xA6, 0x01, 0x05, 0xD5, 0x4C, 0xA4, 0xD0, 
+0xBC, 0xB1, 0x82, 0x0C, 0x8D, 0xAC, 0xFE, 0xC0, 
+0xC1, 0xB5, 
+
+0x85, 0x83, 0xD3, 0xAE, 0x36, 0xBE, 0x03, 0xA4, 
+0x5A, 0x6E, 0x32, 0x8D, 0x4D, 0xBF, 0x14, 0x2E, 
+0xB9, 0xD0, 0x0D, 0x6F, 0xBF, 0x20, 0xB7, 0x1D, 
+0x1C, 0x6B, 0x69, 0x8D, 0x3F, 0x9A, 0xF9, 0x71, 
+0x77, 0x92, 0x5F, 0xDD, 0x96, 0xA7, 0x02, 0x25, 
+0xA5, 0xC8, 0x09, 0xED, 0x93, 0xA5, 0x78, 0x8B, 
+0xE8, 0x59, 0xD4, 0x7F, 0x7C, 0xB8, 0x44, 0x5E, 
+0x96, 0x10, 0x7A, 0x71, 0xAF, 0xDD, 0x79, 0xC9, 
+0x6F, 0xA0, 0xFE, 0xAD, 0x40, 0xC6, 0x71, 0x00, 
+0xDB, 0xE6, 0x63, 0xD8, 0x9C, 0x7B, 0x57, 0xCC, 
+0x3F, 0x2B, 0x1B, 0x97, 0x63, 0x21, 0x72, 0xCA, 
+0x12, 0x9E, 0xB2, 0xF8, 0x82, 0xC9, 0xCC, 0xD0, 
+0xD0, 0x63, 0x38, 0xEB, 0xB0, 0x6C, 0xC0, 0x21, 
+0x23, 0x67, 0x0E, 0xA2, 0xE6, 0xD3, 0x40, 0x56, 
+0xB0, 0xB3, 0x1E, 0xCA, 0x38, 0xA0, 0xEE, 0x7D, 
+0x88, 0x8E, 0x76, 0x19, 0x7C, 0x45, 0x96, 0xC1, 
+0xAE, 0xAD, 0x49, 
+
+0x75, 0x72, 0x73, 0xCE, 0x3F, 0xC3, 0x56, 0x3B, 
+0xF9, 0xA5, 0xD1, 0x1D, 0xB3, 0x79, 0xD9, 0xAD, 
+0xDA, 0x98, 0x2A, 0x7C, 0x76, 0xAF, 0xCF, 0x3B, 
+0x8F, 0xF8, 0x6A, 0x17, 0x81, 0xB3, 0xA1, 0x02, 
+0x48, 0x95, 0xB6, 0x34, 0x94, 0xE4, 0x5E, 0xFD, 
+0x30, 0xD6, 0x34, 0xD9, 0x2E, 0x01, 0x49, 0x58, 
+0x56, 0x0B, 0x36, 0x28, 0x86, 0x6A, 0xF2, 0x71, 
+0x58, 0x8D, 0x00, 0xE7, 0x85, 0xDE, 0x90, 0xFA, 
+0x4C, 0x8A, 0xE4, 0x76, 0xB5, 0xE5, 0x4B, 0xEA, 
+0x06, 0x62, 0x2B, 0xF8, 0xA5, 0x12, 0x57, 0x8E, 
+0xD5, 0x2D, 0x95, 0x86, 0x3F, 0xF8, 0xA5, 0xF8, 
+0xC4, 0xEB, 0xEC, 0x17, 0xFF, 0xC5, 0x75, 0x00, 
+0x4E, 0x48, 0x47, 0x37, 0xBF, 0x58, 0x83, 0x0E, 
+0xD5, 0xAB, 0xDB, 0x59, 0xD1, 0x7E, 0xDB, 0x74, 
+0xEF, 0xAF, 0x6D, 0x57, 0xC9, 0x2E, 0xD9, 0x7D, 
+0xFA, 0x10, 0x60, 0x64, 0x32, 0x88, 0x13, 0xF5, 
+0x6A, 0x59, 0x42, 0xBF, 
+
+0x03, 0x65, 0xBB, 0x25, 0xAD, 0xFF, 0x25, 0x23, 
+0x24, 0x84, 0x87, 0xC8, 0xBD, 0xC6, 0x44, 0x61, 
+0x82, 0xCE, 0xCB, 0xD0, 0x19, 0x8E, 0x61, 0x72, 
+0xCC, 0x9A, 0x03, 0x5A, 0x64, 0x54, 0xCD, 0x0C, 
+0xA0, 0xE6, 0x5A, 0x7B, 0x87, 0xBE, 0x6F, 0xBA, 
+0xC5, 0xDA, 0x36, 0xDC, 0x94, 0x59, 0x60, 0x7D, 
+0x82, 0x17, 0xA7, 0x99, 0x87, 0xCB, 0xCD, 0x70, 
+0xDE, 0xC3, 0x98, 0x83, 0x8A, 0x6B, 0x01, 0x59, 
+0xF2, 0xAA, 0x57, 0xED, 0xF7, 0xD5, 0xDD, 0x3D, 
+0x55, 0x5C, 0x89, 0x3A, 0x04, 0xD1, 0xFB, 0xB3, 
+0x9B, 0xF2, 0xAE, 0x97, 0x3F, 0xC2, 0x65, 0x4D, 
+0x8D, 0xB3, 0xC3, 0x2D, 0xDE, 0x56, 0x69, 0x65, 
+0x5E, 0xE9, 0xD4, 0x95, 0x3A, 0x48, 0xDC, 0x77, 
+0x52, 0x5B, 0xB7, 0x52, 0x41, 0x02, 0x0F, 0xC0, 
+0xE3, 0xF0, 0x8C, 0x7D, 0xDA, 0xB5, 0xF9, 0x98, 
+0x31, 0x7D, 0x0C, 0x40, 0x60, 0xFC, 0x5B, 0x6E, 
+0xC2, 0x31, 0xB8, 0x00, 0x4C, 
+
+0xB5, 0x47, 0xD5, 0x99, 0xBD, 0xA6, 0x55, 0x51, 
+0x25, 0x2D, 0xCF, 0x53, 0x92, 0xE6, 0x20, 0xB6, 
+0x71, 0x57, 0xC5, 0xE3, 0xDB, 0xF9, 0x82, 0x9E, 
+0x16, 0x94, 0x16, 0x03, 0x9B, 0xE8, 0x08, 0x50, 
+0xCA, 0x79, 0x23, 0xD6, 0xC5, 0x0E, 0x13, 0x56, 
+0xF2, 0x87, 0xCB, 0xB5, 0xDF, 0x0D, 0x3D, 0xE2, 
+0x5E, 0x46, 0xF6, 0x6E, 0x05, 0x58, 0xC9, 0xD0, 
+0x89, 0xBF, 0x81, 0x2E, 0x62, 0x45, 0xD0, 0x3A, 
+0x92, 0xC1, 0xEE, 0x4B, 0x2D, 0x06, 0xD1, 0xEC, 
+0xEE, 0xC3, 0x27, 0x65, 0x70, 0x63, 0xCB, 0xBA, 
+0x93, 0x65, 0xC0, 0x39, 0xFE, 0x4C, 0xDB, 0x1F, 
+0x61, 0x3E, 0xE1, 0x60, 0x3C, 0xD6, 0xD3, 0x84, 
+0x88, 0x50, 0x8A, 0xF3, 0x27, 0x60, 0x4F, 0xC6, 
+0x8B, 0x21, 0x2E, 0x31, 0x6E, 0x49, 0xA3, 0xFC, 
+0x3E, 0xE2, 0x21, 0xD9, 0xA6, 0x98, 0x31, 0xD3, 
+0x8B, 0xAF, 0x49, 0x40, 0xC0, 0x35, 0xB1, 0x50, 
+0x6A, 0x2C, 0x78, 0xCF, 0x86, 0x2F, 
+
+0x5F, 0xED, 0x26, 0x8D, 0x5B, 0xF9, 0x95, 0xFC, 
+0x7C, 0x35, 0xAF, 0x76, 0x57, 0x3C, 0xA3, 0x9D, 
+0x02, 0x67, 0x6A, 0xBA, 0xCE, 0xB4, 0x30, 0x73, 
+0xDB, 0x41, 0xB5, 0xB7, 0xD2, 0x31, 0x59, 0x9A, 
+0xC5, 0x71, 0xCA, 0xF4, 0x7A, 0x04, 0x28, 0x76, 
+0xAA, 0x4F, 0x41, 0x69, 0xFD, 0x64, 0xA8, 0x3E, 
+0xFD, 0xC2, 0x35, 0xFC, 0x8D, 0xA8, 0x3C, 0x7A, 
+0x4D, 0x9C, 0xA4, 0xA0, 0x11, 0x41, 0xC5, 0x29, 
+0x65, 0xE0, 0x56, 0x91, 0x55, 0xEC, 0xBE, 0xDA, 
+0x1C, 0x2A, 0x27, 0x63, 0xC8, 0xF7, 0x9A, 0x7E, 
+0x4E, 0xC9, 0x5F, 0x75, 0x83, 0x9D, 0xFA, 0x7D, 
+0xDE, 0xC5, 0xDB, 0x88, 0x49, 0x3F, 0xC2, 0x27, 
+0x14, 0x59, 0xB5, 0x02, 0xC1, 0x22, 0x3E, 0xAF, 
+0x86, 0xEC, 0x8F, 0xB6, 0x13, 0xBF, 0xE6, 0xD8, 
+0x02, 0x84, 0xEA, 0x4A, 0x6E, 0x33, 0x10, 0x41, 
+0x0F, 0xC5, 0xD3, 0xEC, 0x97, 0x9F, 0x90, 0x45, 
+0x3F, 0x3F, 0x5E, 0x7A, 0x61, 0x77, 0x22, 
+
+0x66, 0xD9, 0x6A, 0x3F, 0x1E, 0xA5, 0x9A, 0x78, 
+0xDE, 0x44, 0x71, 0xB5, 0xFF, 0x38, 0x58, 0xEB, 
+0x23, 0xA6, 0xB7, 0xD0, 0x42, 0x3D, 0x82, 0x02, 
+0x1D, 0x32, 0x77, 0xE3, 0x55, 0x19, 0xD4, 0x0B, 
+0xFE, 0x7A, 0x6E, 0x59, 0x9C, 0xAB, 0x4E, 0x81, 
+0x1B, 0x7C, 0x8B, 0xD2, 0x03, 0x45, 0xDA, 0x63, 
+0xE5, 0x43, 0x12, 0x74, 0xFB, 0xA8, 0x25, 0x76, 
+0x0F, 0x35, 0x5C, 0x46, 0x73, 0xE8, 0x13, 0x77, 
+0xC1, 0x5D, 0x42, 0x9D, 0xD3, 0x8C, 0x70, 0x72, 
+0x35, 0xAE, 0xE0, 0x4E, 0xE1, 0xBC, 0x9B, 0xF8, 
+0x78, 0x65, 0x97, 0xC8, 0x09, 0x2F, 0xA5, 0xC0, 
+0xAF, 0xBA, 0xA4, 0x74, 0x33, 0x24, 0x28, 0x71, 
+0xD9, 0x1E, 0x0E, 0x76, 0xF3, 0xB1, 0xCD, 0xBD, 
+0xE3, 0xCB, 0x24, 0xE3, 0x4A, 0x46, 0xF7, 0x5A, 
+0x6A, 0x95, 0x92, 0xBB, 0xE0, 0xB4, 0x65, 0xCD, 
+0x3B, 0xD3, 0xB1, 0xD4, 0x6D, 0x95, 0xF4, 0x3F, 
+0x6B, 0xCD, 0x26, 0x1F, 0x3A, 0x5C, 0x38, 0x29, 
+
+0x81, 0xF2, 0x4D, 0x23, 0xA1, 0x78, 0x68, 0x24, 
+0x26, 0x26, 0x5E, 0x75, 0x84, 0x33, 0xC2, 0xF2, 
+0x80, 0xAF, 0x34, 0x8F, 0x86, 0x8E, 0x43, 0x3E, 
+0x13, 0xC7, 0xCD, 0x99, 0x92, 0x2C, 0x21, 0x29, 
+0xF5, 0xDC, 0x07, 0xC4, 0xBF, 0xD6, 0x4F, 0xB5, 
+0x21, 0xA5, 0x9A, 0xF1, 0xCD, 0xC0, 0x9C, 0x77, 
+0xC8, 0x96, 0x14, 0xB7, 0xBF, 0x58, 0x81, 0x8F, 
+0x87, 0xC2, 0x38, 0x78, 0xA3, 0x45, 0x81, 0xFE, 
+0xDA, 0xAF, 0x09, 0x85, 0xA2, 0xA5, 0x79, 0xFF, 
+0x8F, 0x00, 0x8A, 0x00, 0x36, 0x32, 0xF0, 0x48, 
+0x5C, 0x89, 0x98, 0x75, 0x32, 0x63, 0x64, 0xAC, 
+0x2B, 0xB0, 0x1E, 0xE3, 0xB4, 0x3E, 0x0F, 0x35, 
+0xF3, 0xC2, 0x9A, 0x90, 0xDB, 0xF5, 0x1F, 0xB1, 
+0x51, 0x7D, 0xF8, 0x5C, 0x13, 0x10, 0xBE, 0xD4, 
+0x94, 0x0B, 0xA6, 0xD3, 0xE8, 0x95, 0xAD, 0x0C, 
+0x0E, 0xF5, 0xD3, 0x9B, 0x5A, 0x20, 0x97, 0x86, 
+0x6E, 0xA8, 0x56, 0x16, 0x97, 0x16, 0xE6, 0x1E, 
+0x64, 
+
+0xEC, 0x23, 0x8F, 0x8D, 0xDC, 0xCB, 0xE9, 0xCF, 
+0x35, 0x9C, 0x08, 0xB7, 0x54, 0xF4, 0xAD, 0x34, 
+0xD0, 0x35, 0x9A, 0x29, 0x51, 0xB3, 0x97, 0x2C, 
+0xC5, 0x6B, 0xA2, 0xDB, 0x17, 0xB9, 0x3E, 0x85, 
+0x12, 0xF6, 0x12, 0xDB, 0xD5, 0x59, 0xFF, 0xDF, 
+0x4A, 0xA9, 0xC6, 0x06, 0x7F, 0x27, 0xFE, 0x96, 
+0x27, 0x48, 0xCA, 0x68, 0x3A, 0xD2, 0x52, 0xAF, 
+0x9A, 0x21, 0x0C, 0x28, 0xFC, 0x51, 0xD5, 0x21, 
+0x6E, 0x75, 0x10, 0xD1, 0x8A, 0xAF, 0x9A, 0x1B, 
+0x56, 0x82, 0xCE, 0x89, 0x7B, 0xFF, 0xDB, 0x52, 
+0xF2, 0xD9, 0x12, 0x40, 0x07, 0xBD, 0xA7, 0x92, 
+0xB6, 0xE3, 0x8B, 0x1F, 0x3C, 0x3B, 0x46, 0x0F, 
+0xED, 0xE6, 0x17, 0x37, 0xA7, 0xC2, 0x17, 0xEA, 
+0x29, 0x6E, 0x89, 0x60, 0x43, 0x65, 0x22, 0xAB, 
+0xDA, 0xBF, 0x64, 0xB6, 0xA4, 0x96, 0xE5, 0x09, 
+0xD6, 0x5F, 0xBE, 0x7C, 0xDB, 0xE9, 0xE0, 0x40, 
+0x92, 0x29, 0x14, 0x00, 0x86, 0x48, 0x06, 0x04, 
+0x22, 0xDE, 
+
+0x27, 0xDA, 0x23, 0x0A, 0xCD, 0x5A, 0x51, 0xDE, 
+0x85, 0x4E, 0x1A, 0xC3, 0x07, 0x6C, 0x88, 0x49, 
+0x0F, 0xFF, 0xC0, 0x77, 0xC7, 0xA4, 0x03, 0x66, 
+0xB5, 0xE2, 0x0F, 0x3D, 0xF9, 0x26, 0xDC, 0xF5, 
+0xBB, 0x55, 0x8C, 0x63, 0x4C, 0xF8, 0x29, 0x97, 
+0xFF, 0xB1, 0x9D, 0xF2, 0xF3, 0xC2, 0x08, 0x88, 
+0x34, 0x36, 0xE0, 0x9D, 0x55, 0xAA, 0x4C, 0x0B, 
+0x23, 0x3A, 0x07, 0x2D, 0xCA, 0xD5, 0x74, 0x6D, 
+0xFD, 0xAD, 0xA4, 0x6D, 0x33, 0x7C, 0xA1, 0x46, 
+0x92, 0x8F, 0x47, 0xF2, 0x1C, 0xE7, 0xCF, 0xCA, 
+0x0E, 0x38, 0x57, 0xE6, 0x51, 0x9F, 0x81, 0xFF, 
+0x7A, 0x45, 0x8F, 0x45, 0x4F, 0x47, 0x7B, 0x71, 
+0xF0, 0xCF, 0xB7, 0x7F, 0xA8, 0x3F, 0xDE, 0x75, 
+0x95, 0x68, 0xA6, 0xA5, 0x65, 0x46, 0x1A, 0x6C, 
+0x69, 0xE5, 0x1A, 0xF2, 0x99, 0x55, 0x6A, 0x20, 
+0xE9, 0x73, 0x40, 0x1D, 0xDA, 0x26, 0x86, 0xC4, 
+0xD0, 0x19, 0xB5, 0x27, 0x52, 0x47, 0x0A, 0x18, 
+0xE2, 0xC0, 0xE6, 
+
+0xDE, 0x79, 0xDE, 0x4E, 0x26, 0x41, 0x65, 0x80, 
+0x7C, 0x9E, 0xBA, 0xAD, 0x0E, 0x37, 0xFD, 0xFB, 
+0xCC, 0x18, 0x5F, 0x89, 0xFD, 0x60, 0x26, 0x0C, 
+0xF6, 0x77, 0xA8, 0x25, 0x07, 0x25, 0x46, 0x43, 
+0xD5, 0xDC, 0x73, 0x21, 0x8B, 0xA1, 0x96, 0x01, 
+0xB0, 0xF5, 0xD1, 0x4D, 0x66, 0x64, 0xA8, 0x0B, 
+0xA0, 0xBB, 0x3D, 0xCA, 0x90, 0xCF, 0x4E, 0xD9, 
+0x93, 0x72, 0x77, 0xEE, 0xA3, 0xB3, 0xC6, 0xC3, 
+0x50, 0x7E, 0xB4, 0xDB, 0x75, 0x0E, 0x02, 0xBD, 
+0x50, 0x86, 0x61, 0x45, 0xAC, 0xF6, 0xD6, 0xD8, 
+0x7C, 0xEA, 0x54, 0xCE, 0x83, 0xA2, 0xF0, 0xF1, 
+0xDE, 0x61, 0x17, 0x75, 0xA3, 0xFD, 0xEA, 0xD0, 
+0xAC, 0x3D, 0x97, 0x45, 0x9F, 0x7A, 0xB0, 0x92, 
+0xDF, 0xB1, 0x1A, 0xF7, 0xF0, 0xDE, 0xFD, 0x44, 
+0x5E, 0x38, 0x67, 0xAB, 0x06, 0xF9, 0x3B, 0x2A, 
+0x4B, 0x1A, 0x1C, 0xB3, 0x6C, 0x06, 0x27, 0xD7, 
+0xDF, 0x2C, 0xFD, 0x9A, 0x9B, 0x06, 0x82, 0x07, 
+0x4A, 0x77, 0x1A, 0xB6, 
+
+0xBA, 0x65, 0xB9, 0x44, 0x15, 0x31, 0xCB, 0xB3, 
+0xED, 0x68, 0x2E, 0x06, 0x4E, 0x34, 0x64, 0x05, 
+0xD0, 0x7A, 0xCE, 0xF7, 0x4B, 0x62, 0x63, 0xB5, 
+0x09, 0x46, 0xBE, 0xAE, 0xA0, 0x83, 0x67, 0x24, 
+0xA9, 0xE1, 0x6A, 0x7E, 0xD0, 0x4D, 0xD3, 0x1D, 
+0x61, 0xAE, 0x4D, 0x69, 0xB9, 0x5D, 0x32, 0xFE, 
+0x02, 0x41, 0x23, 0x03, 0xF5, 0x5A, 0x92, 0xF3, 
+0x5D, 0xE2, 0x19, 0x2E, 0x49, 0x62, 0x19, 0xAB, 
+0xA4, 0x1F, 0x23, 0x1F, 0x1B, 0x54, 0xAD, 0x0D, 
+0x3D, 0xA1, 0xA9, 0x98, 0xD5, 0x09, 0x43, 0x97, 
+0x47, 0xB9, 0xB7, 0x77, 0x29, 0x58, 0x73, 0x4B, 
+0x2E, 0x59, 0x23, 0x2C, 0x39, 0x5F, 0x29, 0x8E, 
+0xA3, 0xD3, 0xE1, 0xFF, 0x61, 0x9D, 0x0A, 0x17, 
+0x23, 0x40, 0x94, 0x6F, 0x8E, 0xFB, 0x63, 0xB3, 
+0x7A, 0x93, 0x2D, 0x2B, 0xFD, 0x25, 0x37, 0xF0, 
+0x39, 0x5F, 0xFA, 0x8F, 0xA9, 0xDE, 0x7A, 0x95, 
+0x32, 0xA9, 0x1C, 0x8B, 0x96, 0x93, 0xBC, 0x9B, 
+0x79, 0x53, 0x4F, 0xB2, 0xE6, 
+
+0xD0, 0x6A, 0xCE, 0xBA, 0x60, 0x4C, 0x1F, 0x41, 
+0xD9, 0x1C, 0x24, 0x43, 0x24, 0xC1, 0xFD, 0x49, 
+0x22, 0x9A, 0xF9, 0xFF, 0xC6, 0x05, 0xDA, 0xF2, 
+0x21, 0xED, 0xC1, 0x17, 0xE2, 0xDD, 0x8D, 0xB8, 
+0x4E, 0x1F, 0x79, 0xA0, 0xA6, 0xD1, 0x5C, 0xC7, 
+0xBD, 0x09, 0x13, 0xC2, 0x91, 0xDE, 0x71, 0x48, 
+0xFC, 0xC9, 0xCB, 0x4D, 0xDF, 0x44, 0x49, 0xA6, 
+0x41, 0x61, 0xDA, 0xC7, 0x2C, 0x98, 0x6C, 0x07, 
+0x3B, 0xF1, 0x95, 0xEC, 0x20, 0x32, 0xEB, 0x4C, 
+0x8C, 0xA5, 0xBE, 0x5C, 0x46, 0x6C, 0xF2, 0xC5, 
+0xB2, 0x29, 0x72, 0xD1, 0x98, 0xD5, 0x49, 0x18, 
+0xD3, 0x40, 0x23, 0xB0, 0x37, 0x28, 0xA1, 0x88, 
+0x50, 0x70, 0x44, 0x1C, 0x98, 0xCB, 0x09, 0x59, 
+0xC7, 0x2E, 0xA1, 0x13, 0x9D, 0x11, 0xF0, 0x25, 
+0x08, 0xB7, 0xF1, 0x83, 0x55, 0x12, 0x73, 0xD6, 
+0xDE, 0x0D, 0xC4, 0x45, 0x50, 0x1A, 0x90, 0x5F, 
+0xB9, 0x85, 0xDC, 0x46, 0xD1, 0xEC, 0x18, 0xE6, 
+0xDA, 0xB8, 0xDE, 0xDC, 0x2C, 0xA4, 
+
+0x15, 0xB1, 0x3D, 0xF3, 0x0D, 0x73, 0xD0, 0xCD, 
+0x9B, 0x5B, 0x93, 0x74, 0xD8, 0x13, 0xF5, 0xD6, 
+0xB8, 0xCE, 0x63, 0x02, 0xEB, 0xED, 0xD8, 0x07, 
+0x44, 0x8F, 0x74, 0x61, 0xD7, 0xF6, 0x61, 0x48, 
+0xF7, 0xCE, 0x04, 0x99, 0xD9, 0x14, 0xE4, 0xE1, 
+0xB9, 0xCA, 0x91, 0xA6, 0x30, 0xB4, 0x4A, 0x61, 
+0x0F, 0x6F, 0x1E, 0x8D, 0x14, 0x38, 0xE7, 0xD4, 
+0x31, 0xB4, 0x96, 0x97, 0xBE, 0x9D, 0x38, 0x9E, 
+0x74, 0xFE, 0x9B, 0x99, 0x00, 0x33, 0x59, 0x12, 
+0x72, 0x1C, 0xE9, 0x9B, 0x0E, 0xD5, 0x8A, 0xC9, 
+0x65, 0xDA, 0x47, 0x33, 0x9D, 0xEC, 0x48, 0xF7, 
+0xDC, 0x9D, 0xB7, 0xF7, 0xAF, 0xC2, 0x6A, 0xB7, 
+0xC0, 0xD4, 0xA7, 0x22, 0x04, 0xEA, 0x25, 0x16, 
+0x22, 0x64, 0x0C, 0x66, 0x45, 0x11, 0x40, 0x9C, 
+0x92, 0xC8, 0x51, 0xAA, 0xC7, 0x15, 0x62, 0x3B, 
+0x43, 0x12, 0x67, 0xE8, 0x1F, 0x4B, 0xB2, 0x4A, 
+0x5E, 0x51, 0x8B, 0x59, 0x70, 0x4D, 0xD8, 0x28, 
+0x1C, 0x11, 0x3A, 0x50, 0xA9, 0xF1, 0x1A, 
+
+0x20, 0xF4, 0x8D, 0x4E, 0x76, 0x2A, 0x21, 0xBA, 
+0x58, 0x55, 0xF8, 0x8B, 0xEB, 0x42, 0xAB, 0x3F, 
+0xB3, 0x7A, 0x1E, 0x34, 0xCD, 0xA5, 0xB6, 0x3D, 
+0x59, 0xF8, 0x0E, 0x1D, 0x39, 0x34, 0xDE, 0x9B, 
+0x43, 0xAE, 0xC4, 0xEC, 0x4F, 0x09, 0x0B, 0xEF, 
+0x11, 0xDD, 0x96, 0x0F, 0x00, 0x00, 0x61, 0x20, 
+0x51, 0xF1, 0xAB, 0x11, 0x20, 0x6E, 0xC6, 0x3D, 
+0xCC, 0xAD, 0xAA, 0x6E, 0x41, 0x50, 0x2A, 0x42, 
+0xF5, 0xD0, 0x7A, 0xB6, 0x82, 0x99, 0x61, 0x0C, 
+0x8E, 0x78, 0x65, 0x52, 0x97, 0x7F, 0x90, 0x26, 
+0x15, 0x7F, 0x3E, 0x28, 0x83, 0xB0, 0x91, 0x96, 
+0xAD, 0x45, 0xCA, 0x01, 0x8D, 0x7F, 0x06, 0xD3, 
+0xDB, 0x9F, 0x98, 0xE3, 0x47, 0xC7, 0x9B, 0x08, 
+0xFF, 0x7D, 0xCA, 0x81, 0x58, 0x31, 0xE4, 0x5F, 
+0xA3, 0x46, 0x61, 0x5A, 0x77, 0xD4, 0x01, 0xFA, 
+0x92, 0x85, 0x07, 0x68, 0x87, 0x74, 0xBF, 0x8A, 
+0x22, 0xAF, 0xB4, 0x2E, 0x96, 0xC9, 0x4F, 0x55, 
+0x4B, 0x3E, 0x3B, 0x6C, 0x6E, 0xC1, 0x68, 0xFF, 
+
+0xE8, 0x46, 0xD0, 0x44, 0x1A, 0xA5, 0x84, 0x12, 
+0x6D, 0x15, 0x1A, 0xCF, 0xAE, 0x5A, 0x5B, 0xAE, 
+0x12, 0x44, 0x67, 0xE6, 0xCE, 0xD1, 0xCB, 0xC1, 
+0xEA, 0xF4, 0xFD, 0xB6, 0x20, 0x90, 0x4B, 0x30, 
+0x90, 0xDD, 0x83, 0xFA, 0xDE, 0x43, 0xC7, 0xB6, 
+0x9C, 0x2D, 0x64, 0xD3, 0x4E, 0x0A, 0x63, 0xF7, 
+0xBC, 0x6A, 0xC8, 0x61, 0xEA, 0xCD, 0x2C, 0x37, 
+0xE5, 0x87, 0xF3, 0xDC, 0x36, 0x5A, 0x31, 0x43, 
+0x90, 0x73, 0x0B, 0x53, 0x5A, 0x77, 0x06, 0x77, 
+0xAA, 0x91, 0xDE, 0x1C, 0x60, 0xD1, 0x77, 0xB0, 
+0x70, 0xC0, 0x8C, 0xCF, 0x3A, 0x63, 0xA1, 0x46, 
+0x96, 0x41, 0xFB, 0x85, 0xB5, 0x41, 0x41, 0x51, 
+0x05, 0x83, 0xCC, 0x86, 0xE5, 0xB0, 0x83, 0xEA, 
+0xED, 0x46, 0x18, 0x07, 0xAF, 0x41, 0xD2, 0x6E, 
+0x32, 0xA6, 0x69, 0xD3, 0x20, 0xEF, 0x20, 0xD7, 
+0x46, 0xE0, 0x59, 0x94, 0xC7, 0xE3, 0x57, 0x09, 
+0x30, 0x6B, 0x58, 0xC4, 0x1A, 0x22, 0xDE, 0x8A, 
+0xB6, 0xBE, 0x92, 0x4A, 0x71, 0xB9, 0xF4, 0x41, 
+0x05, 
+
+0xB0, 0xFA, 0x3E, 0x3A, 0x20, 0x09, 0x84, 0x9F, 
+0xEB, 0x09, 0x1A, 0x41, 0x82, 0x08, 0x05, 0x76, 
+0x69, 0x84, 0xE1, 0x2C, 0xA2, 0xAF, 0xDA, 0xA6, 
+0xC3, 0xB9, 0x51, 0x9E, 0x8A, 0xCF, 0xE4, 0x9C, 
+0x83, 0xF8, 0x72, 0x00, 0x59, 0x87, 0x26, 0x99, 
+0x0C, 0x17, 0x66, 0xD9, 0x69, 0x9C, 0x2E, 0x67, 
+0xC6, 0xD8, 0xB2, 0x27, 0x03, 0x7B, 0x47, 0xF5, 
+0xAE, 0x1C, 0xD9, 0xCD, 0xF5, 0x40, 0x19, 0x8A, 
+0x35, 0x00, 0xBE, 0x21, 0xE9, 0x72, 0xE7, 0xC9, 
+0xBD, 0xB7, 0x6F, 0x43, 0xDB, 0x24, 0xBE, 0xFF, 
+0x75, 0xDA, 0xC0, 0xF1, 0x2B, 0x15, 0xDA, 0xF0, 
+0xE7, 0x69, 0xBB, 0x19, 0x85, 0x7F, 0x08, 0x11, 
+0xC1, 0x36, 0xEE, 0x6F, 0xEF, 0x92, 0xB9, 0x24, 
+0x0E, 0xE1, 0xAE, 0xD2, 0xF3, 0x33, 0x6A, 0x8D, 
+0x8C, 0xE1, 0xB6, 0x12, 0x17, 0x6F, 0x61, 0xD3, 
+0x7F, 0x15, 0x91, 0xD6, 0x57, 0xD3, 0x8E, 0x2D, 
+0xDF, 0xD9, 0x0D, 0x5B, 0xB6, 0xC8, 0xDC, 0xC5, 
+0x9E, 0xD5, 0xEF, 0x59, 0x47, 0x95, 0x70, 0xF4, 
+0xEF, 0xA0, 
+
+0xF8, 0x64, 0xBA, 0xAD, 0x07, 0x60, 0x69, 0x43, 
+0x7C, 0x11, 0xC7, 0x46, 0x03, 0xD1, 0x6D, 0xFD, 
+0x64, 0x0B, 0xED, 0xD0, 0x71, 0x94, 0x63, 0x95, 
+0x6D, 0xB6, 0x5B, 0xF5, 0x11, 0x0C, 0xD5, 0xEC, 
+0x84, 0xF6, 0xA6, 0x41, 0xEE, 0x9F, 0x58, 0x0B, 
+0x8F, 0x07, 0xF4, 0x5A, 0x2B, 0x27, 0x5E, 0x27, 
+0x21, 0x0E, 0x16, 0xB0, 0xD1, 0xE0, 0x6E, 0x86, 
+0xE4, 0xAB, 0x9C, 0x6D, 0x72, 0xC6, 0xBF, 0x00, 
+0x1E, 0x2E, 0x87, 0x3E, 0x99, 0x3E, 0x43, 0x32, 
+0x6C, 0x39, 0x17, 0xA7, 0x1D, 0x14, 0x85, 0xC5, 
+0x16, 0x96, 0x43, 0x88, 0x9E, 0x91, 0x6A, 0x7C, 
+0xA3, 0xB6, 0x49, 0x58, 0x4F, 0x47, 0xFF, 0x0E, 
+0x11, 0x18, 0xF5, 0xB5, 0x43, 0xCE, 0xFB, 0x04, 
+0x08, 0x19, 0xBD, 0x9F, 0x8B, 0x53, 0x97, 0x18, 
+0x80, 0x7A, 0x8E, 0x2C, 0xFE, 0x7C, 0x01, 0xC4, 
+0xD2, 0x25, 0x32, 0x5C, 0xD0, 0x90, 0x0A, 0x0E, 
+0x57, 0x4F, 0xD9, 0xAF, 0x2A, 0xDD, 0x35, 0x18, 
+0x4F, 0x95, 0x6C, 0x74, 0x55, 0x9F, 0x34, 0x3E, 
+0x1C, 0x78, 0xFF, 
+
+0x83, 0xCA, 0xDF, 0x1A, 0x7C, 0xF8, 0xDD, 0xB8, 
+0xBF, 0x59, 0xE7, 0xC7, 0x48, 0xFF, 0xFA, 0x02, 
+0x98, 0x4B, 0x1C, 0x1C, 0xD3, 0x6A, 0xEB, 0xEC, 
+0xBC, 0x2E, 0x0A, 0x6F, 0x3C, 0x0C, 0xF1, 0xC7, 
+0x9A, 0x74, 0xF7, 0xC9, 0x4B, 0xA4, 0xFA, 0x81, 
+0x7A, 0xC0, 0xAF, 0xB2, 0x1B, 0x46, 0xDB, 0xEF, 
+0xD5, 0x14, 0x61, 0xCC, 0xB2, 0x77, 0xF8, 0xAE, 
+0x47, 0xA7, 0x20, 0x20, 0xA2, 0x4F, 0x47, 0x71, 
+0xB6, 0x16, 0x21, 0xF4, 0xDA, 0xB6, 0x70, 0x5B, 
+0x3A, 0xEF, 0x38, 0x43, 0x09, 0x49, 0x1D, 0x38, 
+0x25, 0x7A, 0x1A, 0xDF, 0xC1, 0xF6, 0xE9, 0x7B, 
+0xD7, 0x00, 0x89, 0x6D, 0xD3, 0x0A, 0xD6, 0xAE, 
+0x05, 0x34, 0x20, 0x39, 0x14, 0x5A, 0x77, 0x6C, 
+0xA3, 0x31, 0xA3, 0xF3, 0xBE, 0xC1, 0x23, 0x41, 
+0x7B, 0xFC, 0x10, 0xCF, 0x1E, 0xB5, 0x17, 0xB6, 
+0x21, 0x3A, 0xEB, 0x2A, 0xA1, 0xE5, 0x58, 0x30, 
+0xD1, 0xCE, 0x38, 0x5F, 0xDE, 0xC5, 0x05, 0x36, 
+0x00, 0xAF, 0x7D, 0x62, 0xB3, 0xC7, 0x65, 0xA4, 
+0xD1, 0x4E, 0x1F, 0xC1, 
+
+0x6D, 0xC2, 0xAB, 0x28, 0xC6, 0x5F, 0xF4, 0x7B, 
+0x00, 0x1B, 0x81, 0x46, 0xB4, 0x70, 0x15, 0x00, 
+0x7E, 0xFE, 0x3A, 0xEA, 0x36, 0xA6, 0xC6, 0xFD, 
+0xC1, 0xE1, 0xBD, 0x50, 0x65, 0xDD, 0xFC, 0x69, 
+0x25, 0xB6, 0xDE, 0x37, 0x03, 0xAF, 0x39, 0xA4, 
+0x4C, 0x19, 0x26, 0x47, 0x7F, 0x02, 0xD7, 0xAF, 
+0x4F, 0x80, 0x21, 0x40, 0xA6, 0x49, 0x2D, 0xD5, 
+0x1F, 0xB6, 0x4D, 0x77, 0x68, 0x09, 0x23, 0xC4, 
+0x9B, 0xCF, 0x8E, 0x2C, 0x20, 0x92, 0x94, 0x6B, 
+0x1D, 0xBA, 0x49, 0xDA, 0x74, 0x09, 0xAD, 0x16, 
+0xB0, 0x9A, 0x58, 0x1C, 0xC6, 0x94, 0xCB, 0xD9, 
+0xF6, 0x8F, 0xA7, 0x6B, 0xB4, 0x57, 0xDD, 0xCB, 
+0x0A, 0x84, 0xF6, 0xBA, 0xFF, 0x56, 0x9A, 0xBB, 
+0x0F, 0x1A, 0x53, 0xA4, 0x4C, 0x38, 0xE9, 0x7D, 
+0xED, 0xAC, 0xCE, 0xDD, 0xBC, 0xD3, 0x44, 0xF8, 
+0xF9, 0x5E, 0x86, 0x78, 0x45, 0x61, 0x55, 0x02, 
+0x54, 0xFB, 0x90, 0xFC, 0x6E, 0x34, 0x03, 0xAC, 
+0xBD, 0x42, 0x96, 0xEA, 0x94, 0x9F, 0x8D, 0xAD, 
+0x4B, 0xBA, 0x89, 0x6F, 0xC3, 
+
+0x42, 0x77, 0x83, 0xAD, 0xC3, 0x2A, 0x55, 0x49, 
+0xA3, 0xC3, 0xA5, 0xE5, 0x8A, 0x18, 0xD2, 0x3E, 
+0x26, 0x30, 0xB9, 0xEA, 0x6F, 0x66, 0x33, 0x94, 
+0x61, 0xAB, 0x01, 0x4C, 0x63, 0x9A, 0x9B, 0x79, 
+0x98, 0xD8, 0x47, 0xF0, 0x5F, 0x14, 0x38, 0x64, 
+0x15, 0x29, 0x7A, 0x52, 0x8D, 0x0E, 0x50, 0xAE, 
+0xF4, 0x0C, 0xF6, 0xFF, 0xC4, 0x8F, 0x6D, 0x05, 
+0x0B, 0x5D, 0x77, 0x80, 0x7A, 0xD2, 0xE9, 0x8D, 
+0x70, 0x53, 0x40, 0xF2, 0x84, 0xC8, 0x6E, 0x19, 
+0x49, 0x4D, 0x18, 0xD0, 0x35, 0x3B, 0x23, 0xD0, 
+0x22, 0x87, 0x37, 0x56, 0x0A, 0x4C, 0xA8, 0xAC, 
+0x96, 0x17, 0x22, 0xBD, 0x93, 0x83, 0xAB, 0x4E, 
+0x53, 0xEC, 0x64, 0x5E, 0x76, 0x9D, 0xB9, 0xC0, 
+0x13, 0x99, 0x2C, 0xFC, 0x79, 0x41, 0x68, 0x3B, 
+0xEC, 0x8B, 0x59, 0x8F, 0x33, 0x18, 0x52, 0xDC, 
+0xC1, 0xCE, 0x9A, 0x45, 0xD5, 0xAD, 0x00, 0x77, 
+0xE4, 0x1E, 0xB0, 0x02, 0xFF, 0x45, 0xF8, 0xE1, 
+0x16, 0xB4, 0x25, 0x09, 0xE1, 0xB1, 0x33, 0x71, 
+0xBD, 0x05, 0x6D, 0xE3, 0x9B, 0x8A, 
+
+0xA2, 0x51, 0xCC, 0x40, 0x99, 0x5B, 0xC2, 0x0B, 
+0x6B, 0x11, 0x42, 0xBD, 0x6A, 0x56, 0x28, 0x4A, 
+0xFA, 0xB5, 0xD2, 0xC6, 0x49, 0xAC, 0xF1, 0x7B, 
+0xEF, 0x4C, 0x0B, 0x37, 0xFB, 0x98, 0x8F, 0xFF, 
+0x02, 0xF2, 0x54, 0x9D, 0x4D, 0x8F, 0x80, 0xCC, 
+0xA6, 0xB6, 0xD5, 0x1A, 0xAA, 0xA2, 0x10, 0xFE, 
+0x85, 0x34, 0x76, 0x0C, 0x45, 0x9A, 0x79, 0xF2, 
+0xF0, 0x49, 0xC4, 0x72, 0xFF, 0xDB, 0xE8, 0x99, 
+0xCF, 0x9E, 0x70, 0xF9, 0x4D, 0x81, 0x4E, 0xB4, 
+0x63, 0x25, 0x92, 0x2C, 0xDC, 0x69, 0x79, 0x6F, 
+0x25, 0xA1, 0x6B, 0x88, 0x59, 0x46, 0xBB, 0x14, 
+0xFD, 0xBA, 0x86, 0x30, 0x75, 0x14, 0xAC, 0xD6, 
+0xCF, 0xA8, 0x57, 0x66, 0x50, 0xBD, 0xBE, 0xDD, 
+0xBF, 0x1F, 0xE7, 0x83, 0xC5, 0xFA, 0x45, 0x17, 
+0x83, 0xEF, 0xBC, 0xC7, 0x07, 0xFC, 0xCD, 0x46, 
+0x0F, 0xCF, 0x63, 0xC6, 0x8D, 0x2D, 0x18, 0x47, 
+0x56, 0x91, 0x55, 0xF2, 0xB2, 0x23, 0x2B, 0x33, 
+0x67, 0x6C, 0xD2, 0xA2, 0x5B, 0xC0, 0x69, 0xD7, 
+0x95, 0x8D, 0x41, 0x3E, 0x0C, 0x4E, 0xCA, 
+
+0x85, 0x66, 0x92, 0x1A, 0xCE, 0x0E, 0xA1, 0x5E, 
+0xFE, 0x8F, 0x4F, 0xC3, 0xD2, 0x12, 0xAF, 0x40, 
+0xE9, 0x35, 0x30, 0xB6, 0x85, 0x0A, 0x7B, 0xDC, 
+0x57, 0x86, 0x49, 0x9B, 0x2A, 0x6A, 0x7D, 0xBC, 
+0x34, 0x23, 0x95, 0xF4, 0x6A, 0xA9, 0x97, 0xE0, 
+0xE4, 0x2E, 0x03, 0x9B, 0x84, 0xE6, 0x31, 0x43, 
+0x6B, 0xE8, 0xBC, 0x72, 0xA0, 0xDF, 0x4B, 0xDE, 
+0xA6, 0xAC, 0x37, 0x92, 0x96, 0x26, 0x48, 0xB4, 
+0xE1, 0xE8, 0xDD, 0x36, 0x68, 0x7F, 0xED, 0xFA, 
+0xB6, 0x28, 0xE8, 0x85, 0xBE, 0x34, 0xA7, 0x32, 
+0x37, 0xB1, 0x8C, 0x36, 0x41, 0xBD, 0xC0, 0xC6, 
+0x7B, 0x1E, 0x32, 0x3C, 0x96, 0xFA, 0x6E, 0x27, 
+0xEE, 0xE8, 0x49, 0x1D, 0xCF, 0x6B, 0x90, 0x7B, 
+0x38, 0xFC, 0xCC, 0xC0, 0xBB, 0xC7, 0x8A, 0xB0, 
+0x78, 0x42, 0x7A, 0x14, 0xF2, 0x0C, 0xC9, 0x82, 
+0x3C, 0xE9, 0xA5, 0x22, 0x1C, 0x31, 0x41, 0xB9, 
+0x2D, 0x1E, 0x94, 0x8E, 0x2F, 0x18, 0x85, 0x0D, 
+0x6F, 0x49, 0x1A, 0x1E, 0x15, 0x85, 0xCE, 0x6B, 
+0xA0, 0xDB, 0xB9, 0x50, 0x64, 0xB9, 0x4B, 0xE0, 
+
+0x6C, 0xEE, 0x5F, 0x5D, 0x1A, 0xAD, 0xF4, 0xAE, 
+0x4E, 0x04, 0xF0, 0x9F, 0xA6, 0x4C, 0xC7, 0x0F, 
+0x75, 0xAF, 0xB3, 0x4A, 0x23, 0xD0, 0xF2, 0x37, 
+0xBE, 0x4D, 0x83, 0x40, 0x91, 0xCE, 0xF4, 0xC9, 
+0xB2, 0xDE, 0x4B, 0x90, 0x0F, 0x1C, 0x08, 0x61, 
+0x17, 0x39, 0xCE, 0x91, 0x76, 0xD8, 0x19, 0x09, 
+0xDC, 0xFA, 0xC2, 0xFA, 0x44, 0x95, 0xEE, 0x6B, 
+0xDF, 0x62, 0x7D, 0x2A, 0x19, 0x0E, 0x13, 0x67, 
+0x24, 0x6A, 0x79, 0x5A, 0x2E, 0x74, 0x15, 0x01, 
+0x3D, 0x37, 0xDF, 0x70, 0x15, 0x94, 0xD2, 0x73, 
+0xDE, 0x83, 0x07, 0x4E, 0x56, 0xC4, 0x70, 0xA1, 
+0x18, 0x8D, 0xE9, 0xB6, 0x48, 0xCB, 0x73, 0x6E, 
+0x6E, 0xAF, 0xFB, 0x22, 0xEF, 0x25, 0x11, 0x3E, 
+0xB6, 0xB8, 0x6D, 0x22, 0x77, 0xA6, 0x2B, 0x25, 
+0xE9, 0x9B, 0xB1, 0x48, 0xD0, 0x45, 0xAA, 0x61, 
+0x11, 0x3A, 0x6C, 0xD9, 0xC1, 0x3A, 0xBE, 0x20, 
+0xCD, 0x9D, 0x22, 0x6E, 0x7E, 0xC2, 0x39, 0x0A, 
+0x47, 0x97, 0x83, 0x23, 0xEB, 0x49, 0xF2, 0x13, 
+0xAE, 0x45, 0xC9, 0x28, 0xD4, 0x33, 0xB9, 0x21, 
+0xD2, 
+
+0xF3, 0xAC, 0x52, 0xAB, 0xCA, 0x24, 0xA6, 0x91, 
+0x93, 0xA4, 0x45, 0x07, 0x2B, 0x4B, 0x5F, 0x32, 
+0x50, 0xA5, 0x21, 0x2F, 0xA1, 0x05, 0xE3, 0xED, 
+0x4B, 0x8C, 0xD4, 0x47, 0x5B, 0xED, 0x4E, 0xC5, 
+0xE8, 0x89, 0xDF, 0xF5, 0xC0, 0x02, 0xB5, 0xBC, 
+0xA3, 0x43, 0x37, 0xE5, 0x00, 0xC7, 0xA1, 0xDE, 
+0xBE, 0x0C, 0xEF, 0x02, 0x44, 0x68, 0x66, 0x9E, 
+0x67, 0x6E, 0x5F, 0xC6, 0x15, 0x66, 0x62, 0x58, 
+0x0D, 0x65, 0xBA, 0x86, 0xF5, 0x4C, 0x7D, 0x78, 
+0xF0, 0x95, 0x5B, 0x62, 0xFE, 0xFE, 0x74, 0xBF, 
+0xF6, 0xFE, 0x2B, 0x17, 0xFD, 0xAF, 0xA4, 0xD9, 
+0xA0, 0x9F, 0x79, 0xE0, 0xEC, 0xB8, 0xCC, 0xB8, 
+0x5E, 0xBE, 0x16, 0x0E, 0xE3, 0x9F, 0x58, 0x5B, 
+0x53, 0xDF, 0xFE, 0xCA, 0xEA, 0x96, 0xDF, 0x48, 
+0x2F, 0xDA, 0xD7, 0x6D, 0xEC, 0xA6, 0xFB, 0xED, 
+0x1C, 0x31, 0xBB, 0xD0, 0x01, 0xCE, 0x18, 0xFA, 
+0x2B, 0x81, 0xAB, 0xB3, 0x9E, 0x9F, 0xAC, 0x8C, 
+0xB3, 0x2D, 0x9E, 0x78, 0xDB, 0xDD, 0x1E, 0xEC, 
+0xC4, 0xF1, 0xD6, 0x38, 0x27, 0xA3, 0x1C, 0xB6, 
+0xB8, 0x25, 
+
+0x6C, 0x10, 0x7C, 0xD0, 0x2E, 0x13, 0x98, 0xBC, 
+0xBC, 0x59, 0x2E, 0xAE, 0xEF, 0xCF, 0x1F, 0xCD, 
+0x07, 0x8D, 0x18, 0xB7, 0x3C, 0x5D, 0x7B, 0x18, 
+0x57, 0x1C, 0xE5, 0x89, 0x3D, 0xEA, 0x41, 0xAA, 
+0x2E, 0xBA, 0x1B, 0x69, 0x9E, 0xD9, 0xD0, 0x00, 
+0xF1, 0x19, 0xD9, 0x48, 0x41, 0xB9, 0x1C, 0x93, 
+0xF3, 0xAC, 0x3C, 0xCE, 0x20, 0x88, 0xDB, 0x22, 
+0xCE, 0x4A, 0x6B, 0x51, 0x4F, 0x63, 0x43, 0x53, 
+0xEA, 0x6A, 0x41, 0xC0, 0x57, 0xD6, 0x1F, 0x3E, 
+0xB3, 0x13, 0xAE, 0xA2, 0xD0, 0xC4, 0x47, 0xB3, 
+0x8A, 0x61, 0x60, 0x3F, 0x90, 0xFB, 0x39, 0x85, 
+0x9D, 0x0E, 0xD5, 0xDB, 0x4A, 0x82, 0x1E, 0xE1, 
+0xF3, 0x46, 0x66, 0x89, 0xEE, 0x2F, 0x30, 0x8A, 
+0x7B, 0x6A, 0x43, 0xE9, 0x77, 0x2F, 0x4D, 0xAA, 
+0x5F, 0x14, 0xC5, 0xD2, 0xD6, 0x61, 0xAF, 0x5A, 
+0xEA, 0x8F, 0x07, 0xDD, 0x2A, 0x92, 0xFC, 0xCB, 
+0x69, 0x8C, 0xC5, 0xD6, 0x8F, 0xF9, 0xFF, 0x02, 
+0xD4, 0x16, 0xE4, 0x3E, 0xC1, 0xB7, 0xC3, 0xA4, 
+0x7A, 0x5A, 0x8F, 0xDD, 0x1A, 0x71, 0xF0, 0x87, 
+0x00, 0x06, 0xEC, 
+
+0x58, 0xAC, 0x9E, 0x82, 0x63, 0x3D, 0x13, 0xD8, 
+0x0F, 0x9D, 0x61, 0x1F, 0xB6, 0x63, 0xAB, 0x16, 
+0x9D, 0x87, 0x7B, 0xC9, 0x01, 0x6B, 0x7B, 0x68, 
+0xA1, 0x69, 0xCF, 0x76, 0xE9, 0x2A, 0x07, 0x1D, 
+0x6E, 0x7E, 0x6F, 0xB4, 0x99, 0xDA, 0x49, 0xA5, 
+0x0C, 0xD6, 0x0D, 0x22, 0xE9, 0x88, 0xD4, 0x2E, 
+0xEC, 0x08, 0x81, 0x06, 0x2C, 0x07, 0xE9, 0x46, 
+0x11, 0x34, 0x35, 0xD6, 0x88, 0xA7, 0xEC, 0x6B, 
+0x22, 0x8C, 0x67, 0xE4, 0x7E, 0xEE, 0x2C, 0xD3, 
+0x4B, 0xBF, 0x92, 0xDA, 0xD5, 0xE9, 0xF9, 0x09, 
+0x7E, 0x93, 0xC6, 0xEC, 0xC4, 0x0F, 0x80, 0xAD, 
+0x47, 0x19, 0xD3, 0x98, 0x57, 0x9F, 0x10, 0xF8, 
+0x5F, 0x16, 0x88, 0xA8, 0x8B, 0x8B, 0x78, 0xA6, 
+0xC6, 0x22, 0xD1, 0x27, 0xF9, 0xDF, 0xCC, 0x7A, 
+0x1F, 0x99, 0x1E, 0x90, 0xEE, 0x2A, 0xAB, 0x0D, 
+0x9C, 0x7F, 0x6C, 0x1E, 0xEB, 0xD1, 0x03, 0xD9, 
+0xE8, 0xBA, 0x31, 0x7B, 0xAB, 0xFE, 0xC2, 0xDA, 
+0xFE, 0x36, 0x7A, 0x40, 0x05, 0x18, 0xA0, 0x62, 
+0xAE, 0xBC, 0xF4, 0x4E, 0x27, 0xB7, 0x0D, 0x6F, 
+0xC0, 0x6E, 0x17, 0x7C, 
+
+0xE0, 0x35, 0x90, 0x36, 0xA4, 0xAA, 0x04, 0x9B, 
+0xB4, 0xFC, 0x54, 0x50, 0xBD, 0x46, 0xEB, 0x58, 
+0xC5, 0x24, 0x31, 0xA5, 0xDB, 0x6C, 0x56, 0xC9, 
+0xD0, 0x22, 0x68, 0xEC, 0x1B, 0xBF, 0xD3, 0x64, 
+0xE0, 0x87, 0x08, 0xA5, 0x32, 0x2E, 0x30, 0xBE, 
+0x7D, 0x08, 0xCE, 0xD6, 0xCC, 0x14, 0x39, 0x04, 
+0xC1, 0xE6, 0x02, 0x98, 0x86, 0x18, 0xAB, 0x39, 
+0x31, 0x6F, 0x8B, 0xA2, 0x00, 0x37, 0x1A, 0x76, 
+0xEC, 0xC9, 0x0B, 0x35, 0x9D, 0x34, 0x93, 0xBA, 
+0xE6, 0x6B, 0x68, 0x8D, 0xFB, 0xBA, 0x33, 0xB0, 
+0x99, 0xB7, 0x05, 0x98, 0xDF, 0x74, 0x72, 0xC7, 
+0x93, 0x8A, 0xBD, 0x6A, 0x54, 0x8D, 0xD3, 0x91, 
+0xBF, 0x94, 0xDA, 0xDC, 0x73, 0x9D, 0x11, 0x17, 
+0x57, 0xC9, 0xFC, 0x73, 0xBE, 0x32, 0xC4, 0x46, 
+0xE5, 0xD4, 0x7B, 0x47, 0x13, 0x82, 0x87, 0x67, 
+0x26, 0xD1, 0xCC, 0x87, 0xF5, 0x52, 0xB6, 0x57, 
+0x7B, 0xCA, 0x1D, 0xE7, 0x75, 0xF4, 0x3F, 0x43, 
+0xF2, 0xAC, 0xB2, 0x62, 0xE7, 0xA5, 0x7B, 0xB9, 
+0x07, 0x14, 0xC1, 0xB8, 0xAC, 0x02, 0xB5, 0x8C, 
+0xDB, 0x30, 0xDE, 0x8F, 0xED, 
+
+0x56, 0x3A, 0x79, 0x7E, 0x3D, 0x9E, 0x1A, 0xE3, 
+0x5F, 0x6F, 0x35, 0x6C, 0x24, 0x27, 0x09, 0xDD, 
+0x33, 0x4E, 0x8C, 0xF9, 0x22, 0xDC, 0xAD, 0x50, 
+0xAD, 0xF7, 0x5A, 0xFB, 0x2C, 0x3E, 0xE5, 0x48, 
+0x79, 0xF2, 0x6B, 0xF3, 0x0A, 0xB1, 0x22, 0x38, 
+0x02, 0xEE, 0xCD, 0xA4, 0x7A, 0xCC, 0x90, 0x61, 
+0x7C, 0x72, 0x85, 0x7D, 0x25, 0x93, 0x60, 0xAF, 
+0xAF, 0x57, 0xCF, 0xFF, 0xB3, 0xEE, 0x1B, 0xC1, 
+0x98, 0x31, 0xEA, 0x49, 0xC0, 0xED, 0x3D, 0x5B, 
+0xBF, 0x0D, 0x46, 0x53, 0x6B, 0xBA, 0xE9, 0xE8, 
+0x75, 0x03, 0x6E, 0x0D, 0x53, 0x3F, 0x2A, 0xCC, 
+0x2E, 0xF1, 0x49, 0xCA, 0x8D, 0x59, 0x91, 0x1A, 
+0x2F, 0x48, 0xCE, 0x41, 0x41, 0xE7, 0xB2, 0x4B, 
+0x32, 0xAD, 0xC6, 0xCF, 0xBA, 0xFF, 0x42, 0x8D, 
+0xE4, 0x60, 0x09, 0xC8, 0x95, 0x90, 0x35, 0xFD, 
+0x50, 0x23, 0x09, 0x2B, 0x77, 0x3E, 0xCD, 0x90, 
+0xB8, 0xC2, 0x8F, 0xEE, 0x8F, 0xBC, 0x71, 0xE0, 
+0x93, 0x05, 0x8F, 0x01, 0x16, 0x11, 0x3C, 0xCD, 
+0x16, 0xB9, 0xFA, 0xFF, 0x22, 0x93, 0xC0, 0x6C, 
+0xFA, 0xEA, 0xF8, 0x41, 0xED, 0x2E, 
+
+0x8F, 0x68, 0x2B, 0x2C, 0xB5, 0x3A, 0x43, 0x3E, 
+0x7D, 0x7F, 0xDC, 0xF9, 0xD6, 0x0C, 0xBB, 0x50, 
+0xD3, 0x8E, 0xE8, 0x91, 0x71, 0x0E, 0xA2, 0x59, 
+0x58, 0x72, 0x05, 0x45, 0x0D, 0xB2, 0xDD, 0x04, 
+0xA8, 0xA3, 0x75, 0x2D, 0xFF, 0xDD, 0x8C, 0xDC, 
+0x05, 0x65, 0x9C, 0xA3, 0x8C, 0xA4, 0xD6, 0x88, 
+0x68, 0x99, 0x24, 0xA4, 0x70, 0x2E, 0xAD, 0xCB, 
+0x71, 0x24, 0x09, 0x61, 0xC0, 0x90, 0xA0, 0xCC, 
+0x44, 0x0C, 0x20, 0x7D, 0x82, 0x94, 0x9A, 0xCD, 
+0x12, 0xF6, 0x24, 0xF8, 0xFD, 0xFC, 0x5A, 0xF5, 
+0x4D, 0xEC, 0xBD, 0xE8, 0x9E, 0xA3, 0x93, 0xA7, 
+0xA5, 0x70, 0x74, 0x7F, 0xDE, 0x66, 0x3D, 0xA3, 
+0xB0, 0x0E, 0x8F, 0x1E, 0x6A, 0x34, 0x80, 0x7C, 
+0xF0, 0x09, 0xB6, 0x29, 0xF5, 0x00, 0xBF, 0x5E, 
+0x82, 0x99, 0xBB, 0x14, 0xD6, 0x7D, 0xEF, 0xA1, 
+0x4C, 0xEA, 0xBF, 0xEE, 0x7F, 0x7B, 0xD4, 0x68, 
+0xBA, 0x47, 0xFE, 0x04, 0xFA, 0x36, 0xDD, 0x10, 
+0x62, 0x6C, 0x5F, 0x86, 0x33, 0x46, 0x5E, 0x89, 
+0x72, 0x4E, 0xBF, 0xBB, 0x4E, 0xB9, 0xF1, 0x58, 
+0xAE, 0x75, 0x28, 0xAF, 0xC0, 0x36, 0x19, 
+
+0x73, 0x98, 0x75, 0x73, 0xF6, 0x7A, 0xD3, 0x0B, 
+0x97, 0x62, 0x41, 0x4D, 0x95, 0x3A, 0xA8, 0xCF, 
+0x79, 0x57, 0x22, 0xEA, 0xB1, 0x23, 0xD7, 0x5D, 
+0xE2, 0x2C, 0x0D, 0x00, 0x59, 0x8C, 0xA1, 0x7F, 
+0x10, 0x3D, 0x99, 0xAF, 0x64, 0x67, 0x15, 0xA1, 
+0x94, 0x8D, 0x01, 0xA9, 0x72, 0xFD, 0xFC, 0xFF, 
+0x3B, 0x78, 0x27, 0x59, 0x88, 0x2E, 0xFC, 0x30, 
+0xFF, 0x8D, 0x53, 0x0A, 0x72, 0x01, 0x0E, 0x4C, 
+0x92, 0x3B, 0x91, 0x8B, 0xA0, 0x28, 0xBA, 0x62, 
+0x3C, 0xB7, 0x60, 0x78, 0x5E, 0x1D, 0x36, 0xB0, 
+0x84, 0x5E, 0x54, 0x54, 0x3E, 0x66, 0x2F, 0x7D, 
+0xA8, 0x27, 0x26, 0x5C, 0xD1, 0x35, 0x70, 0xC1, 
+0xC9, 0xCA, 0x0B, 0xC7, 0x1F, 0x58, 0xAF, 0x22, 
+0x67, 0x12, 0x72, 0xFF, 0x3E, 0xA1, 0x8B, 0x54, 
+0x9A, 0xFB, 0xC7, 0x99, 0x5A, 0xAD, 0x42, 0x17, 
+0x84, 0x18, 0xAE, 0x54, 0x2F, 0x5C, 0x6B, 0x28, 
+0x71, 0x1A, 0x25, 0xE4, 0xD4, 0x23, 0x62, 0xC6, 
+0x7D, 0x45, 0xBE, 0xF6, 0xD9, 0xFE, 0x96, 0x33, 
+0x21, 0x1C, 0x36, 0x3C, 0xF7, 0xE9, 0x2B, 0xB7, 
+0x67, 0x6B, 0x4D, 0xCF, 0x9B, 0x0C, 0xA1, 0x23, 
+
+0xB4, 0x37, 0x46, 0x55, 0x83, 0x74, 0x98, 0xA3, 
+0x69, 0xCA, 0x09, 0xAE, 0xDC, 0xB8, 0x58, 0xA3, 
+0xEF, 0x7B, 0x89, 0x46, 0xBA, 0x7C, 0xB4, 0x91, 
+0xDC, 0x22, 0x58, 0xE1, 0xB1, 0xCE, 0xDD, 0xAA, 
+0xD7, 0x50, 0x20, 0x4A, 0x58, 0x51, 0xAB, 0xE5, 
+0x27, 0x24, 0xA6, 0x6E, 0x36, 0x8E, 0x6C, 0xF4, 
+0xDC, 0x22, 0xD0, 0x57, 0xEB, 0xEE, 0xA1, 0xDB, 
+0x69, 0xB4, 0x79, 0x64, 0xFB, 0xC6, 0x72, 0x9D, 
+0x8C, 0xA7, 0x90, 0x68, 0xE9, 0x68, 0x30, 0x0A, 
+0xDB, 0x58, 0xD1, 0x35, 0xF3, 0x37, 0xC3, 0xC2, 
+0x9C, 0xCD, 0x27, 0x38, 0xA2, 0xF7, 0x20, 0xE5, 
+0xDB, 0xD1, 0x93, 0xE9, 0x02, 0xC4, 0x4A, 0x9D, 
+0xF8, 0x54, 0xBB, 0x8F, 0xF8, 0x19, 0x82, 0xFD, 
+0x2A, 0x47, 0x8D, 0x08, 0x16, 0x3E, 0xFB, 0xEF, 
+0x69, 0x75, 0x6F, 0x2F, 0x3B, 0x8E, 0xEF, 0x9A, 
+0x82, 0xC6, 0x49, 0x0A, 0x76, 0x83, 0xF3, 0xBC, 
+0x08, 0x1C, 0x23, 0x6C, 0x83, 0x8F, 0x22, 0x9E, 
+0x30, 0xE5, 0x91, 0x0F, 0x81, 0x4A, 0x21, 0x89, 
+0x61, 0xCC, 0x3D, 0x4E, 0xC1, 0xB3, 0x96, 0x78, 
+0x8D, 0xB7, 0xD3, 0xB2, 0x22, 0x46, 0xD2, 0x6F, 
+0x46, 
+
+0x27, 0xD3, 0x68, 0xD2, 0xE1, 0x25, 0xB8, 0x73, 
+0x42, 0xA2, 0x43, 0xBE, 0x87, 0x93, 0x07, 0x3F, 
+0x2A, 0x8D, 0x0E, 0x72, 0xD9, 0x53, 0xC1, 0xC8, 
+0xE6, 0x89, 0x60, 0xC6, 0xA8, 0x45, 0xC5, 0x86, 
+0x43, 0x2C, 0x5B, 0x12, 0xE4, 0x46, 0x70, 0x19, 
+0x53, 0x7C, 0x90, 0x2A, 0xF2, 0xA6, 0x2A, 0x9C, 
+0xAC, 0x06, 0x96, 0xC5, 0xCA, 0xE7, 0x0C, 0x5D, 
+0xCF, 0x52, 0xB7, 0x4A, 0xA4, 0x97, 0xFD, 0xFF, 
+0x02, 0x05, 0x55, 0x5A, 0x6D, 0xC6, 0xF4, 0x00, 
+0xAD, 0x9F, 0xD9, 0xFD, 0x85, 0x3F, 0x9C, 0x24, 
+0x01, 0xC7, 0x7E, 0xE3, 0xE5, 0xBC, 0x16, 0x54, 
+0x9C, 0x7F, 0x6C, 0x29, 0x1E, 0x64, 0x3A, 0x7F, 
+0xC3, 0x9F, 0x39, 0xC5, 0x0B, 0x79, 0xE8, 0xFC, 
+0xF4, 0xE2, 0x05, 0x23, 0xB0, 0x9E, 0xB6, 0x4A, 
+0xF6, 0x7D, 0x91, 0x4F, 0x16, 0x33, 0x9A, 0x71, 
+0xC0, 0x87, 0x7F, 0xD9, 0xA2, 0xD0, 0xCD, 0xB1, 
+0xB3, 0xD6, 0xFF, 0x75, 0xDC, 0x10, 0x6E, 0x5E, 
+0xA3, 0xD5, 0xA3, 0x06, 0xE0, 0x25, 0xE5, 0xB8, 
+0x9B, 0x06, 0x9E, 0x6C, 0x58, 0x1C, 0x03, 0xCB, 
+0x16, 0xEE, 0xBC, 0x7C, 0xA7, 0x08, 0x30, 0x56, 
+0xF6, 0x8A, 
+
+0x5B, 0xE7, 0x4F, 0x8E, 0x42, 0x99, 0x49, 0xF4, 
+0x65, 0x2A, 0x56, 0xCF, 0xD5, 0x98, 0xB1, 0x75, 
+0xF7, 0x5B, 0x77, 0x8E, 0xD3, 0x40, 0x1E, 0x6E, 
+0xEF, 0xD2, 0x8E, 0xB2, 0xE7, 0xC2, 0x08, 0x90, 
+0xCC, 0x94, 0xBD, 0x08, 0xAE, 0x00, 0xF7, 0xA6, 
+0xD1, 0x9B, 0x4F, 0xD8, 0xA9, 0xA6, 0x5B, 0x58, 
+0xA3, 0x71, 0x9F, 0xB6, 0xC3, 0xAE, 0x3B, 0x6E, 
+0xE2, 0x1B, 0x59, 0xFC, 0x9D, 0x24, 0x4E, 0x32, 
+0xB3, 0xCF, 0x25, 0x95, 0xC5, 0xFC, 0xDE, 0x10, 
+0x6A, 0x8C, 0xCF, 0x20, 0x1D, 0x65, 0xED, 0xC5, 
+0xAE, 0xF7, 0x35, 0xA8, 0x17, 0x70, 0x1D, 0x49, 
+0xDD, 0x1E, 0x91, 0xED, 0x09, 0xA7, 0x11, 0x58, 
+0x33, 0xF2, 0x06, 0x76, 0xDE, 0x71, 0xDF, 0x3C, 
+0xC4, 0xB5, 0x81, 0x01, 0xF4, 0xA0, 0x73, 0x78, 
+0x16, 0x7C, 0xC1, 0xD4, 0xE5, 0x19, 0x33, 0x75, 
+0xA6, 0x8B, 0x14, 0xC6, 0x21, 0x73, 0x67, 0x7A, 
+0x39, 0xC9, 0x6C, 0xC4, 0xE7, 0xF7, 0x14, 0x93, 
+0x53, 0xFA, 0x2B, 0x35, 0x55, 0xB7, 0x67, 0x3A, 
+0xDB, 0xDA, 0x9A, 0x3F, 0xD7, 0xD3, 0x1F, 0x1B, 
+0xBA, 0xD7, 0xBC, 0xC9, 0xEE, 0x8F, 0x55, 0x9D, 
+0xE1, 0x6F, 0x70, 
+
+0x41, 0xF7, 0xA4, 0x16, 0x81, 0x0F, 0xCE, 0x0F, 
+0xB5, 0x7F, 0x50, 0xD3, 0xE8, 0x79, 0x32, 0x45, 
+0xE0, 0xF7, 0xD5, 0x9B, 0x56, 0x5A, 0x38, 0xE0, 
+0x28, 0x36, 0xA9, 0x9B, 0x46, 0x08, 0xFA, 0x41, 
+0x1B, 0x2D, 0xF3, 0xAA, 0x6C, 0xA7, 0xC7, 0x74, 
+0xCA, 0x6F, 0x4B, 0xEA, 0xDE, 0x4C, 0x90, 0x8D, 
+0x5E, 0xBA, 0xBB, 0xF1, 0xFF, 0x95, 0x18, 0x3C, 
+0x7E, 0x24, 0xE3, 0x99, 0xA8, 0xBA, 0x93, 0xE3, 
+0x46, 0xA9, 0x3D, 0xC4, 0x0C, 0xA8, 0x9A, 0x43, 
+0x6F, 0x68, 0x95, 0xA2, 0x44, 0xFD, 0x56, 0x32, 
+0xD3, 0x85, 0x2F, 0x1C, 0xEC, 0x3C, 0xB4, 0xC1, 
+0x0B, 0x53, 0x72, 0xF9, 0x31, 0x71, 0x01, 0x78, 
+0xCB, 0xA2, 0xB6, 0xA5, 0xD1, 0x2E, 0x9D, 0x31, 
+0x1F, 0x52, 0x04, 0x11, 0x3A, 0xD6, 0x3C, 0xA9, 
+0x72, 0x4F, 0x39, 0xB7, 0xBD, 0x6E, 0xC3, 0x08, 
+0x9B, 0x22, 0xC0, 0x48, 0x78, 0x8C, 0x03, 0xE3, 
+0x66, 0x98, 0x8F, 0x0C, 0x6F, 0xD2, 0xA0, 0x22, 
+0x26, 0x8A, 0x15, 0x8C, 0x53, 0x27, 0x29, 0x01, 
+0x26, 0xC4, 0x43, 0x29, 0x0D, 0x8A, 0x40, 0x8F, 
+0x79, 0x41, 0x36, 0x47, 0xE5, 0x04, 0x86, 0x20, 
+0xDA, 0xDD, 0x80, 0x22, 
+
+0x25, 0x8D, 0x85, 0x7F, 0x63, 0xE3, 0xAE, 0xA6, 
+0x58, 0x13, 0x9E, 0x11, 0x8F, 0x9B, 0x67, 0x26, 
+0xDE, 0xAB, 0x31, 0xBA, 0x79, 0xC1, 0x6A, 0xB9, 
+0x9C, 0xDC, 0x1C, 0x9D, 0x39, 0xB6, 0xF7, 0xC5, 
+0xD8, 0x60, 0x77, 0xAE, 0x0B, 0xBE, 0x5F, 0x0E, 
+0x1B, 0x0B, 0xB6, 0xF0, 0x3A, 0xDE, 0x92, 0x29, 
+0x49, 0x95, 0xD3, 0x48, 0x51, 0x77, 0x68, 0xC9, 
+0x7E, 0x59, 0xDA, 0xB5, 0x74, 0x3A, 0xE0, 0x29, 
+0x72, 0x1D, 0x8D, 0x48, 0x00, 0xAC, 0x0E, 0x80, 
+0x0E, 0xCF, 0xB2, 0xDF, 0x3E, 0x1E, 0xBC, 0x03, 
+0xF7, 0x17, 0xAB, 0xB3, 0x7F, 0x39, 0x98, 0x48, 
+0x7F, 0xF6, 0x4A, 0x1E, 0x64, 0x07, 0x99, 0x15, 
+0x19, 0xF5, 0xDE, 0x82, 0xCE, 0x8C, 0xEA, 0x63, 
+0x82, 0x71, 0x8A, 0xFC, 0xC6, 0x43, 0x43, 0x89, 
+0x32, 0x05, 0xD1, 0x38, 0x1B, 0x1A, 0xAE, 0x12, 
+0x19, 0x3B, 0x1F, 0x0D, 0x50, 0x5F, 0xF7, 0x63, 
+0x28, 0x78, 0x6F, 0xE1, 0xAA, 0x18, 0x52, 0xA8, 
+0xA1, 0xE9, 0xC0, 0xD4, 0xC4, 0x58, 0x78, 0xFF, 
+0xA1, 0xB9, 0xA3, 0x3B, 0x08, 0x8F, 0xFF, 0x1A, 
+0x38, 0x74, 0x11, 0x10, 0x8A, 0x5F, 0xA8, 0x1E, 
+0x2A, 0xB2, 0x06, 0xED, 0x8D, 
+
+0x73, 0xBC, 0xCA, 0x86, 0x25, 0x42, 0xB5, 0x63, 
+0x43, 0xEB, 0x07, 0xCB, 0x39, 0x50, 0x92, 0x1B, 
+0xE2, 0x7A, 0x8F, 0x7A, 0x64, 0x25, 0x7C, 0x49, 
+0x72, 0x2E, 0x83, 0x38, 0xAD, 0x82, 0x52, 0x84, 
+0x6F, 0x4C, 0xC2, 0x3E, 0x62, 0xF4, 0x34, 0x0A, 
+0x78, 0x4E, 0x0F, 0xED, 0xBB, 0x3C, 0xA0, 0x1F, 
+0x33, 0x5D, 0x32, 0x22, 0xD8, 0xEC, 0x29, 0x26, 
+0xB1, 0x77, 0x23, 0x30, 0x2F, 0xB7, 0x0B, 0xCA, 
+0xEF, 0x02, 0xA3, 0x7F, 0x11, 0xC0, 0xA1, 0x77, 
+0xDE, 0xC4, 0x4A, 0xA5, 0xD9, 0x5D, 0x94, 0x23, 
+0x8B, 0xE6, 0x0C, 0x01, 0x31, 0x56, 0x2B, 0x1E, 
+0x22, 0x36, 0x8D, 0x6D, 0x7C, 0x75, 0x9F, 0xDA, 
+0x72, 0x33, 0x25, 0x1D, 0x76, 0x7D, 0x3F, 0x71, 
+0x69, 0x7E, 0xCF, 0x72, 0x6C, 0x92, 0xCD, 0xFA, 
+0xBD, 0x50, 0x51, 0x37, 0x32, 0xE1, 0xE9, 0x45, 
+0xF6, 0x53, 0xB4, 0x92, 0x56, 0x24, 0x9F, 0xE5, 
+0x63, 0x81, 0x72, 0xAF, 0x7B, 0xBC, 0xD3, 0xE5, 
+0xC2, 0x78, 0xEC, 0x79, 0xE6, 0xDB, 0x0D, 0x5E, 
+0xED, 0x3F, 0xF0, 0x4E, 0x0B, 0xE2, 0xD1, 0x09, 
+0xAD, 0xB9, 0xF7, 0xAF, 0x88, 0x05, 0x50, 0xF7, 
+0x5C, 0x00, 0xF7, 0xBD, 0xE0, 0x39, 
+
+0x3F, 0x52, 0xB4, 0xAB, 0x28, 0x26, 0x13, 0x5E, 
+0x35, 0xC9, 0x64, 0x52, 0xE0, 0x62, 0x27, 0x2D, 
+0x9E, 0xA6, 0x4C, 0x13, 0xDE, 0xB7, 0x60, 0x1D, 
+0x61, 0xE7, 0x02, 0x5C, 0x11, 0xE6, 0x52, 0xB9, 
+0x53, 0xD6, 0x6B, 0x85, 0x98, 0x5F, 0xBF, 0xD2, 
+0x99, 0xEC, 0x84, 0xB1, 0x1C, 0x29, 0xB3, 0x0E, 
+0xC8, 0xEA, 0xFD, 0xBF, 0x50, 0x88, 0x90, 0x9C, 
+0x81, 0x42, 0x47, 0xAF, 0x22, 0x52, 0x8D, 0x11, 
+0x53, 0xA1, 0xBB, 0x98, 0x06, 0xEC, 0x08, 0xD5, 
+0x01, 0x5C, 0x8D, 0xE7, 0x76, 0x24, 0x63, 0x80, 
+0x8E, 0x6F, 0x9A, 0x1B, 0x4C, 0x1B, 0xA4, 0xB1, 
+0xF7, 0x95, 0xAA, 0xCB, 0x77, 0x21, 0x42, 0xBD, 
+0x92, 0x38, 0x78, 0x67, 0x27, 0x68, 0xD5, 0xCD, 
+0x99, 0x70, 0xA0, 0x4D, 0xDF, 0x06, 0x9D, 0xA0, 
+0x92, 0xE7, 0x56, 0x2E, 0x5F, 0x66, 0xBE, 0xAE, 
+0x04, 0xB1, 0x4D, 0x0F, 0x42, 0xB9, 0xA1, 0x9D, 
+0xAD, 0x50, 0x45, 0xEC, 0xB3, 0x70, 0x1E, 0x36, 
+0x1C, 0x77, 0xBB, 0x68, 0x1D, 0x7F, 0xAF, 0xBF, 
+0x82, 0xD9, 0xFC, 0x97, 0xA9, 0xCB, 0x77, 0x4C, 
+0x06, 0x13, 0x29, 0x74, 0xE0, 0xBB, 0xCD, 0xFB, 
+0x97, 0x8C, 0x17, 0xE2, 0x66, 0x6D, 0x0A, 
+
+0x93, 0x38, 0xD0, 0xFA, 0x42, 0x1B, 0x69, 0x05, 
+0x5C, 0x3D, 0xA4, 0x34, 0xE1, 0x13, 0x5D, 0xCA, 
+0xED, 0x41, 0x6F, 0xB9, 0x6F, 0x35, 0x7A, 0x47, 
+0x8B, 0xC8, 0x5E, 0x62, 0x9B, 0xDC, 0x21, 0x87, 
+0xD8, 0x6C, 0x2C, 0x3B, 0xCD, 0x61, 0xF9, 0x08, 
+0xDE, 0x30, 0x18, 0x32, 0xE8, 0xB8, 0x81, 0x56, 
+0xA5, 0x30, 0x52, 0x1E, 0x44, 0x06, 0xCA, 0xB6, 
+0x9B, 0xC9, 0x4E, 0x1D, 0x16, 0x16, 0x0E, 0x79, 
+0xBC, 0x3F, 0x96, 0x51, 0xCB, 0x69, 0x55, 0x54, 
+0xEE, 0x38, 0x06, 0xC7, 0xC3, 0xAF, 0xDE, 0xA4, 
+0x01, 0xE3, 0x4B, 0x08, 0x4B, 0x42, 0x3D, 0x98, 
+0xB5, 0x23, 0xF6, 0x35, 0x3D, 0xC7, 0x57, 0x31, 
+0x6A, 0xCF, 0x82, 0xA7, 0xC6, 0x28, 0xF3, 0x16, 
+0x31, 0x92, 0x2E, 0xB3, 0xEC, 0x83, 0x95, 0xA6, 
+0x95, 0xB3, 0xF7, 0xF5, 0x39, 0x0B, 0x8C, 0xB4, 
+0xB3, 0x3F, 0x7B, 0x16, 0xF4, 0x42, 0xB2, 0xA5, 
+0x3F, 0xF4, 0xBF, 0xDF, 0x3B, 0x40, 0x52, 0xEA, 
+0x69, 0x05, 0x02, 0x06, 0xAA, 0xF6, 0x3B, 0x98, 
+0xC0, 0x4D, 0xF4, 0xC6, 0x03, 0x92, 0x4F, 0x25, 
+0xDF, 0x1C, 0x2A, 0x37, 0xC4, 0x82, 0x9F, 0x62, 
+0xE5, 0xD2, 0xF2, 0x24, 0x7C, 0x0F, 0xD2, 0xBB, 
+
+0xDA, 0x50, 0x96, 0x40, 0x5A, 0xB4, 0x58, 0x9A, 
+0x70, 0x85, 0xBF, 0x62, 0xA8, 0x59, 0xF3, 0x89, 
+0x72, 0x9F, 0x5E, 0x58, 0xD1, 0x61, 0xAC, 0x32, 
+0x66, 0x9C, 0xCB, 0x6A, 0xD5, 0x93, 0xFB, 0x58, 
+0x11, 0x88, 0x57, 0x69, 0x0B, 0x1A, 0xE8, 0x95, 
+0x23, 0x14, 0x0F, 0xBC, 0x47, 0xAE, 0x17, 0x33, 
+0x8B, 0x36, 0x99, 0x35, 0x64, 0x50, 0x1E, 0xD1, 
+0x1A, 0x07, 0xD9, 0xE6, 0x27, 0x14, 0xE9, 0x0C, 
+0x4B, 0xB0, 0x4D, 0x64, 0x8F, 0x7B, 0x50, 0xD4, 
+0x64, 0xA6, 0x12, 0x59, 0xB0, 0x5B, 0x56, 0x0C, 
+0x6E, 0xEA, 0x7F, 0xD9, 0xA4, 0x12, 0x32, 0x60, 
+0x5A, 0x17, 0xFD, 0x0A, 0xE3, 0x6A, 0xE0, 0xAF, 
+0x5E, 0xBB, 0x26, 0xED, 0x2B, 0x7C, 0x56, 0x74, 
+0x7A, 0x96, 0x6E, 0x41, 0xF8, 0x46, 0xC4, 0xCA, 
+0x46, 0x8B, 0x64, 0xD0, 0x8F, 0x9D, 0x6A, 0x85, 
+0xF2, 0x23, 0xDF, 0x04, 0xB2, 0x65, 0x06, 0xFE, 
+0xB4, 0x34, 0x47, 0xD6, 0x18, 0x2C, 0xC2, 0x3B, 
+0x67, 0xA6, 0xEB, 0xA9, 0x83, 0x94, 0x54, 0xC9, 
+0x52, 0xDB, 0x9A, 0x6D, 0xD1, 0xEA, 0x77, 0xDD, 
+0x68, 0x7A, 0xB1, 0x2A, 0xAB, 0xDF, 0x3E, 0x54, 
+0x64, 0xCD, 0xE8, 0xDC, 0x9E, 0xD3, 0x0D, 0xF8, 
+0x3C, 
+
+0xA0, 0x14, 0x08, 0x32, 0xE1, 0x12, 0xCF, 0xCE, 
+0x05, 0xCF, 0x02, 0x71, 0xDB, 0x92, 0x2A, 0x7D, 
+0xCE, 0xD6, 0xD5, 0xC8, 0x04, 0x70, 0x86, 0xED, 
+0x43, 0x63, 0x6C, 0x6E, 0x68, 0x14, 0xD0, 0xB0, 
+0xD1, 0x18, 0x3A, 0x92, 0x4F, 0xEC, 0xA3, 0xDF, 
+0x31, 0x8C, 0xCD, 0xD1, 0x1E, 0x3F, 0xD7, 0xE3, 
+0x43, 0x95, 0xE2, 0xEA, 0x99, 0x24, 0xD0, 0x1F, 
+0xEC, 0x96, 0xEF, 0xC2, 0x9B, 0xE6, 0x9A, 0xC3, 
+0x9D, 0x88, 0x6B, 0x7A, 0x85, 0xE7, 0x06, 0xAA, 
+0xD8, 0x6B, 0x36, 0x71, 0x84, 0xA7, 0xAC, 0x86, 
+0x0B, 0x1C, 0xD1, 0xB6, 0x0C, 0x4E, 0x40, 0xA3, 
+0xE0, 0x18, 0xB0, 0x47, 0x19, 0xD4, 0x2E, 0x5F, 
+0xF2, 0x75, 0x36, 0x9E, 0x18, 0x2A, 0x17, 0x20, 
+0xCF, 0x00, 0x67, 0x91, 0x79, 0x70, 0x4D, 0x63, 
+0xFD, 0x8E, 0xBB, 0x74, 0x9B, 0xDF, 0x96, 0x24, 
+0x47, 0x14, 0xBE, 0x53, 0x43, 0xD1, 0x81, 0xCC, 
+0x89, 0x2B, 0xAA, 0x0C, 0xCD, 0x0E, 0x27, 0x46, 
+0xB5, 0x6E, 0xA2, 0x7A, 0xEC, 0xD4, 0xE9, 0xFA, 
+0x1D, 0x15, 0x63, 0x0A, 0xCE, 0xBF, 0xE5, 0x16, 
+0xE3, 0x27, 0x22, 0xC6, 0x96, 0xBA, 0xD3, 0x01, 
+0x70, 0x99, 0xBF, 0x0C, 0x9A, 0xBB, 0x7E, 0x28, 
+0x37, 0xE1, 
+
+0xF7, 0x37, 0x87, 0x9D, 0x3B, 0x2D, 0xAA, 0x0B, 
+0x28, 0x0B, 0x8A, 0xC3, 0x00, 0xB0, 0xF1, 0x88, 
+0x19, 0xF8, 0x42, 0x1D, 0x50, 0x13, 0x7F, 0x8D, 
+0x2B, 0xF3, 0xCC, 0xA0, 0x9F, 0xC4, 0x29, 0xF5, 
+0xF3, 0x4A, 0xCE, 0x55, 0x61, 0x4D, 0x1D, 0x08, 
+0xFA, 0xD7, 0x12, 0xE2, 0xF2, 0x7C, 0x7C, 0x9D, 
+0x84, 0xFD, 0x53, 0x8F, 0x37, 0x0D, 0xF4, 0x54, 
+0x98, 0xFC, 0x85, 0x77, 0x97, 0xE2, 0xB4, 0x0E, 
+0xD8, 0x9C, 0x9E, 0x06, 0xC1, 0xFF, 0xD4, 0x28, 
+0x72, 0x74, 0x38, 0xBB, 0xF2, 0xA9, 0xC7, 0xE8, 
+0x41, 0x05, 0xFD, 0x90, 0x22, 0x48, 0x74, 0x09, 
+0xE7, 0x8B, 0x96, 0x95, 0xBD, 0xAC, 0xF3, 0x80, 
+0xB6, 0x02, 0x63, 0x30, 0x99, 0xE1, 0x02, 0x22, 
+0x6A, 0x1F, 0x8A, 0xE6, 0x2A, 0x06, 0x98, 0xC3, 
+0x4E, 0xDD, 0x28, 0x2C, 0xBE, 0xB8, 0x08, 0xA6, 
+0xB2, 0x33, 0x82, 0x61, 0x05, 0x37, 0xB1, 0x0C, 
+0x03, 0xC7, 0x77, 0x90, 0x5B, 0x79, 0xC2, 0x80, 
+0x1A, 0x13, 0x57, 0xBB, 0x8F, 0x26, 0x62, 0x96, 
+0xAD, 0xE2, 0x18, 0x24, 0xEB, 0x05, 0x01, 0x41, 
+0x37, 0x77, 0xEC, 0xDA, 0xC7, 0x4F, 0x58, 0x84, 
+0x56, 0x8E, 0x69, 0x03, 0x50, 0xCB, 0xB4, 0xD4, 
+0xD0, 0x45, 0x69, 
+
+0x73, 0x0B, 0x75, 0x9B, 0x6F, 0xD8, 0xF1, 0xA2, 
+0x62, 0xAC, 0x7C, 0x70, 0x83, 0x74, 0xE9, 0x65, 
+0xBE, 0xBA, 0xC6, 0x37, 0x35, 0xE8, 0x66, 0xB8, 
+0x4A, 0xA0, 0x88, 0x23, 0xEE, 0x5C, 0x9B, 0x52, 
+0xED, 0x6A, 0xE7, 0xBE, 0x68, 0x5A, 0xC6, 0x7E, 
+0x3C, 0x65, 0xE3, 0xB1, 0x3F, 0x05, 0x6B, 0xEE, 
+0x3A, 0xEA, 0x4B, 0x54, 0x06, 0xAD, 0x5A, 0xA2, 
+0xBD, 0xFA, 0x6C, 0x29, 0x1A, 0x35, 0xEF, 0x1F, 
+0x40, 0xA2, 0x75, 0x2C, 0x4F, 0x46, 0x1B, 0x1D, 
+0x24, 0xBD, 0x4B, 0x32, 0x09, 0x0C, 0x67, 0x28, 
+0xCB, 0xF5, 0x05, 0x78, 0x56, 0xDA, 0xDC, 0x8C, 
+0x6A, 0x8B, 0x30, 0x96, 0xC0, 0xAC, 0xAA, 0x5F, 
+0x48, 0x55, 0x97, 0x8E, 0x51, 0xC8, 0x19, 0x1F, 
+0xA8, 0x83, 0xCB, 0x9A, 0xE4, 0x8E, 0x08, 0x8B, 
+0x76, 0xEB, 0x25, 0x85, 0xAB, 0x6B, 0x28, 0xBA, 
+0x7E, 0xAD, 0xAB, 0x91, 0xAF, 0x70, 0xCE, 0xEF, 
+0x49, 0xC5, 0x77, 0x7D, 0x86, 0xF9, 0xB0, 0xD7, 
+0xFB, 0xCB, 0x97, 0x32, 0x93, 0xFC, 0x00, 0x6C, 
+0x5E, 0x57, 0x9F, 0xAA, 0x0E, 0xE3, 0xA5, 0xCF, 
+0x66, 0x41, 0x94, 0x73, 0x99, 0x98, 0xE0, 0x23, 
+0x4F, 0xEF, 0x71, 0x77, 0xC4, 0xE4, 0xB0, 0x67, 
+0x7D, 0xD3, 0xC2, 0xA6, 
+
+0x7F, 0x06, 0x7C, 0x1B, 0x85, 0x0C, 0x95, 0x98, 
+0x35, 0xDC, 0xA5, 0xED, 0xF8, 0x3B, 0xB9, 0xE8, 
+0x05, 0xA2, 0xA6, 0xCD, 0x94, 0xEB, 0xE0, 0x03, 
+0x3D, 0x08, 0xFB, 0x39, 0x76, 0xA9, 0xFA, 0xD9, 
+0xD6, 0x4C, 0xA3, 0x42, 0x56, 0x78, 0x60, 0x3E, 
+0xCC, 0x46, 0xCE, 0x9B, 0x05, 0xBC, 0xD8, 0xE7, 
+0x13, 0xB4, 0xF7, 0xA9, 0x6C, 0xB0, 0xD7, 0x01, 
+0xFA, 0x75, 0x0B, 0x2B, 0xEE, 0x3B, 0x84, 0xA5, 
+0x24, 0x8D, 0x75, 0xAF, 0x93, 0x73, 0x2B, 0x6F, 
+0x7A, 0x86, 0x7C, 0xA5, 0x64, 0x47, 0x62, 0xA9, 
+0xE3, 0x13, 0xC9, 0xAC, 0x3E, 0x8C, 0xEC, 0x10, 
+0x1B, 0xDF, 0x7D, 0xC0, 0x98, 0x97, 0xEA, 0xCA, 
+0x80, 0xAB, 0xE8, 0x7E, 0x11, 0x73, 0x57, 0xF1, 
+0x39, 0xA6, 0x9B, 0x91, 0x89, 0x56, 0x7A, 0x5A, 
+0xAB, 0xF2, 0xF2, 0xFE, 0x25, 0x6F, 0xEE, 0xC2, 
+0x45, 0x3A, 0x6D, 0xE4, 0xCF, 0x07, 0x16, 0x0D, 
+0xAD, 0xB2, 0xA6, 0xE8, 0xF9, 0x6D, 0xE5, 0x51, 
+0x57, 0xC4, 0x6A, 0xBB, 0x70, 0xA1, 0x83, 0xAC, 
+0x51, 0x01, 0x85, 0xCF, 0x7B, 0x18, 0xE4, 0x71, 
+0x26, 0xB7, 0x06, 0x3E, 0x5D, 0xEB, 0xAF, 0x46, 
+0x19, 0x7F, 0x6E, 0xCB, 0x4F, 0xBB, 0x48, 0x35, 
+0x86, 0x01, 0x1A, 0x85, 0xDF, 
+
+0x8A, 0x41, 0x58, 0xEF, 0x13, 0xEC, 0x7A, 0x9A, 
+0x28, 0xAD, 0x35, 0x2E, 0x85, 0x99, 0x33, 0x3C, 
+0x47, 0xA7, 0x00, 0x68, 0xFD, 0x75, 0xF7, 0xB2, 
+0x66, 0x04, 0x19, 0x37, 0x0C, 0xE5, 0xD5, 0xF3, 
+0x1C, 0x62, 0xCC, 0x25, 0x20, 0x9E, 0xB6, 0x5F, 
+0xE0, 0x22, 0xB5, 0xD4, 0x70, 0xD8, 0xCF, 0xB6, 
+0x2D, 0xA5, 0x49, 0x93, 0xF1, 0x96, 0x63, 0xE5, 
+0xBF, 0x44, 0x31, 0x19, 0xCD, 0xD6, 0x54, 0x8F, 
+0x53, 0x35, 0xA7, 0x60, 0x3C, 0xEB, 0xA4, 0x04, 
+0x90, 0xAE, 0x6D, 0x9C, 0xEF, 0xCB, 0x96, 0x99, 
+0xC3, 0x40, 0x50, 0xBE, 0xF3, 0xDB, 0x61, 0x15, 
+0xF6, 0xD4, 0xEF, 0x57, 0x0D, 0x0A, 0x0B, 0x94, 
+0xB9, 0x93, 0x24, 0xEB, 0xE1, 0x79, 0xC4, 0x0A, 
+0x63, 0x46, 0xA3, 0xC3, 0xEF, 0x30, 0x99, 0x18, 
+0x7A, 0x08, 0x72, 0x22, 0x78, 0x6B, 0x18, 0x8E, 
+0xC0, 0x6E, 0x52, 0x29, 0x9B, 0xDA, 0x34, 0xC3, 
+0x22, 0x39, 0xA1, 0x30, 0x86, 0xDA, 0x2B, 0x03, 
+0x3E, 0x96, 0x2A, 0x8B, 0xA1, 0x80, 0x28, 0xD7, 
+0xD9, 0xE2, 0x35, 0xBC, 0x38, 0x3E, 0x88, 0x5C, 
+0x9D, 0xC7, 0x8B, 0x53, 0x73, 0x88, 0xDB, 0x48, 
+0x8A, 0xA4, 0x92, 0x03, 0x57, 0x10, 0xE3, 0x3D, 
+0x82, 0x6F, 0xA1, 0x60, 0xD7, 0x5B, 
+
+0x8C, 0xC1, 0xBD, 0x91, 0x05, 0x2C, 0x39, 0x09, 
+0x99, 0x97, 0xCC, 0xE2, 0x61, 0x0F, 0xBF, 0x16, 
+0xB3, 0x67, 0xE6, 0xA8, 0x74, 0x13, 0xAE, 0x2E, 
+0xD1, 0xD6, 0x50, 0xF2, 0x7A, 0x69, 0x63, 0x81, 
+0x9E, 0xE0, 0x1B, 0x79, 0x26, 0x36, 0x1F, 0x32, 
+0x69, 0xD9, 0xA7, 0x9F, 0x89, 0x38, 0xA0, 0x4F, 
+0xEC, 0x57, 0xCA, 0x3F, 0xEA, 0xEE, 0xFB, 0x61, 
+0x89, 0x9F, 0x37, 0xB1, 0x8A, 0x6B, 0x46, 0x65, 
+0xC5, 0xB3, 0x36, 0x13, 0x22, 0x4C, 0xCC, 0x86, 
+0xDD, 0x8C, 0x6C, 0xB7, 0x5C, 0x58, 0xEE, 0x13, 
+0xB1, 0x0E, 0xE7, 0x58, 0x1C, 0x85, 0xEF, 0xE4, 
+0xEB, 0xF1, 0x25, 0x72, 0xEB, 0xE6, 0x39, 0x55, 
+0x32, 0xDA, 0xE3, 0xE9, 0x08, 0xFA, 0x97, 0xC8, 
+0x73, 0xFE, 0x58, 0x8F, 0xDD, 0x51, 0xF7, 0xF0, 
+0xB8, 0x4E, 0x05, 0xEA, 0x78, 0xAC, 0xD4, 0x6A, 
+0xA0, 0x55, 0x39, 0xAC, 0x77, 0xAA, 0x1B, 0xD5, 
+0x01, 0x04, 0xB5, 0x46, 0x1B, 0x8B, 0x0C, 0x84, 
+0x5C, 0x7C, 0x88, 0x71, 0xCF, 0xC8, 0x90, 0xAF, 
+0x35, 0x9F, 0x4C, 0x65, 0xB6, 0x77, 0x80, 0x69, 
+0x23, 0x1D, 0xB8, 0x43, 0x58, 0x68, 0x15, 0x04, 
+0x35, 0x70, 0xD6, 0xEE, 0xC1, 0x44, 0x6E, 0xEC, 
+0x3A, 0xDD, 0x58, 0x3C, 0x5C, 0xAC, 0x94, 
+
+0xB3, 0x09, 0xC9, 0xB0, 0x61, 0xFB, 0x53, 0x45, 
+0x67, 0x7C, 0xA3, 0x82, 0x32, 0xF9, 0xAD, 0x7F, 
+0x71, 0xC3, 0xA5, 0x8E, 0x12, 0x13, 0xFF, 0x58, 
+0x24, 0xB4, 0xCA, 0xD6, 0x3F, 0x44, 0x1D, 0xEC, 
+0x1F, 0x14, 0xFF, 0xC2, 0x65, 0xAE, 0x20, 0xBA, 
+0xA0, 0xA0, 0x55, 0xD5, 0x69, 0x80, 0xB5, 0x2E, 
+0x71, 0xA9, 0x46, 0x27, 0x37, 0x16, 0x03, 0x44, 
+0xDA, 0xDD, 0xDF, 0xC2, 0x9C, 0x77, 0x53, 0xE8, 
+0x9A, 0x72, 0x57, 0xE7, 0x86, 0xB6, 0x02, 0xFC, 
+0xA0, 0xC8, 0x31, 0xA5, 0x83, 0xC4, 0x67, 0x24, 
+0x27, 0x04, 0xA6, 0x99, 0x16, 0x1D, 0x77, 0x87, 
+0x61, 0xA4, 0xBD, 0x93, 0x65, 0xBD, 0x60, 0xBC, 
+0x5D, 0xDC, 0x7E, 0xF0, 0x0B, 0x9B, 0x53, 0x9D, 
+0x85, 0x96, 0x9B, 0x8C, 0x49, 0x4E, 0xE2, 0x6E, 
+0x03, 0x28, 0x1E, 0x75, 0x94, 0xCE, 0xD5, 0xC8, 
+0x4A, 0x61, 0xE8, 0x57, 0x06, 0x47, 0xAC, 0x8B, 
+0x95, 0x57, 0x53, 0xFA, 0xE1, 0xAA, 0xDA, 0x9D, 
+0xB3, 0xA2, 0xE5, 0x11, 0x6E, 0x1F, 0x41, 0xD0, 
+0x02, 0x84, 0x2E, 0x3B, 0x76, 0xF4, 0xDB, 0x76, 
+0xF1, 0x9C, 0xD3, 0x43, 0x25, 0xA2, 0xAF, 0xE4, 
+0x47, 0x1B, 0x29, 0x0D, 0xE4, 0xC6, 0x2B, 0xD4, 
+0xA8, 0x24, 0x84, 0xC3, 0x54, 0x78, 0x71, 0x5A, 
+
+0x0B, 0xC3, 0xE8, 0x89, 0x1E, 0x3F, 0xF9, 0xB6, 
+0x8C, 0xAC, 0xD8, 0x44, 0x9F, 0x39, 0xCC, 0xB5, 
+0xDA, 0x56, 0x56, 0x1F, 0xBE, 0x64, 0xC0, 0x54, 
+0x68, 0xA2, 0xCB, 0xB2, 0x78, 0x88, 0x22, 0x32, 
+0x22, 0x9D, 0x26, 0x13, 0x2C, 0xA7, 0x70, 0x2C, 
+0x5C, 0xA5, 0xD0, 0x7D, 0xD7, 0x82, 0xCB, 0x52, 
+0xE9, 0x27, 0x7B, 0x95, 0x34, 0x83, 0xAF, 0xDB, 
+0x5C, 0xE2, 0x4F, 0x3D, 0xCE, 0xA9, 0x51, 0xAF, 
+0x32, 0xD3, 0x10, 0x41, 0x17, 0x0A, 0x04, 0xA5, 
+0x17, 0xD2, 0x20, 0x9F, 0x39, 0x28, 0x94, 0x08, 
+0xE7, 0xF5, 0x91, 0xE6, 0xD6, 0x23, 0xAC, 0x07, 
+0xEC, 0xA2, 0x1C, 0x64, 0xA5, 0xF3, 0x5F, 0x73, 
+0x5D, 0x5C, 0x48, 0x95, 0xEF, 0x15, 0xA4, 0xFE, 
+0x02, 0xE8, 0xEA, 0xDE, 0x26, 0x2B, 0xAB, 0x9E, 
+0xBC, 0x4D, 0x3A, 0x80, 0x49, 0x82, 0x7E, 0x33, 
+0x81, 0xCE, 0xC0, 0xD8, 0x58, 0x02, 0x04, 0xC4, 
+0xE0, 0xF8, 0xC7, 0x69, 0xA5, 0x9E, 0x27, 0x94, 
+0xBD, 0x03, 0x24, 0x4C, 0xAF, 0x12, 0x47, 0x8F, 
+0x04, 0x3C, 0x44, 0x92, 0x2F, 0xD1, 0x59, 0x4E, 
+0x80, 0x84, 0x9F, 0x90, 0x55, 0xBC, 0xDB, 0x09, 
+0x8E, 0x12, 0x48, 0x08, 0xBC, 0xEA, 0x76, 0xBA, 
+0xB3, 0x3D, 0xE1, 0x26, 0xA9, 0x74, 0x2E, 0xB5, 
+0x43, 
+
+0x71, 0xA3, 0xB8, 0xCA, 0xA0, 0x3C, 0xCA, 0xB6, 
+0xF9, 0x52, 0x3E, 0x2B, 0x69, 0xE2, 0x9B, 0x34, 
+0x59, 0xEE, 0xA5, 0x12, 0xD7, 0x2F, 0xA7, 0x1A, 
+0xBC, 0xC5, 0xBC, 0xD8, 0xD5, 0xF6, 0xE3, 0xB5, 
+0x32, 0x18, 0x2A, 0x09, 0x1C, 0xB3, 0xC0, 0xD1, 
+0xA4, 0x5F, 0xA4, 0x88, 0xB8, 0x88, 0x7C, 0x44, 
+0x09, 0xC2, 0x30, 0x71, 0xF3, 0x02, 0x1E, 0x3C, 
+0x1A, 0x35, 0xC4, 0xBC, 0xA9, 0x2F, 0x89, 0xC5, 
+0xF4, 0x1E, 0x01, 0x92, 0xC5, 0xD8, 0x20, 0xD6, 
+0xEF, 0x65, 0x6B, 0x99, 0xDF, 0x32, 0x11, 0x9F, 
+0x3A, 0x5B, 0xDD, 0x60, 0xED, 0x63, 0x7C, 0xFA, 
+0xA9, 0xB1, 0x2D, 0xFF, 0x78, 0xC7, 0xDC, 0x99, 
+0x1D, 0xE2, 0xF9, 0x49, 0x62, 0xA9, 0xFA, 0xDD, 
+0x20, 0x2A, 0x74, 0x38, 0x00, 0xB4, 0xD1, 0x8F, 
+0x1B, 0xA2, 0x99, 0x3E, 0xEC, 0xE4, 0xD5, 0x3A, 
+0x4D, 0xC6, 0x0B, 0xCE, 0xFD, 0x22, 0x92, 0x67, 
+0x35, 0x04, 0x84, 0x30, 0x82, 0x8B, 0xA2, 0xE2, 
+0x40, 0xA9, 0x4F, 0xBE, 0xC3, 0xF3, 0x0D, 0x2B, 
+0x52, 0x0B, 0xB2, 0x90, 0x89, 0x45, 0x47, 0xF2, 
+0x77, 0x77, 0x80, 0xE7, 0x51, 0xF3, 0x98, 0xEE, 
+0x0E, 0xF8, 0xBD, 0xA8, 0xFD, 0xDB, 0x00, 0xF8, 
+0xCF, 0x5F, 0x3D, 0x62, 0x39, 0x9E, 0xED, 0x42, 
+0xBF, 0xAD, 
+
+0x92, 0xB1, 0x68, 0x15, 0x30, 0xEF, 0xA0, 0x02, 
+0x48, 0x36, 0x52, 0x4F, 0x2A, 0x65, 0x8A, 0x92, 
+0x07, 0x30, 0xF6, 0x5F, 0x3E, 0xE3, 0xD9, 0x88, 
+0x0E, 0x67, 0xB4, 0x5B, 0xCA, 0x64, 0xA1, 0x31, 
+0x46, 0x91, 0x5E, 0x2C, 0x0D, 0xEF, 0x92, 0x07, 
+0xEA, 0xD9, 0xDD, 0x39, 0xAF, 0x12, 0x91, 0x2E, 
+0x14, 0xF2, 0xC8, 0x1F, 0x3C, 0x1B, 0x73, 0x1A, 
+0x47, 0xCF, 0xFE, 0x06, 0xA2, 0xB1, 0x5F, 0x34, 
+0x00, 0x42, 0x2C, 0x27, 0x98, 0xE6, 0xE8, 0xCE, 
+0xB1, 0x3B, 0xD2, 0xB5, 0xBC, 0x6A, 0x07, 0x3F, 
+0xFF, 0x56, 0xA6, 0x1B, 0x00, 0xCC, 0x9A, 0x99, 
+0x06, 0x37, 0xCD, 0xB2, 0x2F, 0x9B, 0x8E, 0xCD, 
+0xFA, 0xDB, 0x88, 0x62, 0xFB, 0x8E, 0x28, 0xEB, 
+0xCB, 0x85, 0x8D, 0x47, 0x47, 0xCC, 0x9F, 0xA8, 
+0xFD, 0x5F, 0x10, 0x4B, 0x12, 0x39, 0xC1, 0x8E, 
+0x59, 0x97, 0x52, 0xE7, 0xE8, 0xF6, 0xBF, 0x4C, 
+0x9C, 0xBB, 0x33, 0x2C, 0x6F, 0xD1, 0x70, 0x88, 
+0x4F, 0x1A, 0x07, 0x37, 0xC7, 0xDE, 0xA4, 0x88, 
+0x08, 0xF8, 0x92, 0xDE, 0xD6, 0xD3, 0x82, 0x00, 
+0x00, 0x79, 0x0D, 0x1F, 0xEC, 0x83, 0xB1, 0x63, 
+0xFA, 0x61, 0xE6, 0xCE, 0xE2, 0xA0, 0xE0, 0x6C, 
+0xF4, 0x42, 0x51, 0x62, 0x39, 0xFE, 0x54, 0x33, 
+0x0E, 0x50, 0x24, 
+
+0x5B, 0x04, 0x51, 0xAF, 0x7D, 0x75, 0x03, 0xFB, 
+0x36, 0x6B, 0x86, 0xAC, 0x54, 0x5B, 0x8A, 0x36, 
+0x6F, 0x0C, 0xB9, 0x11, 0x02, 0x62, 0xAE, 0x7F, 
+0xD4, 0x4B, 0xF7, 0x87, 0x8B, 0x2F, 0xF4, 0xB5, 
+0x21, 0xBF, 0x1D, 0xF7, 0xA5, 0x32, 0xC0, 0xE9, 
+0xFA, 0x11, 0x4D, 0xF7, 0x18, 0x9C, 0x9D, 0x54, 
+0xEA, 0xEA, 0x5C, 0xA6, 0xA9, 0x80, 0x31, 0xF1, 
+0xF2, 0x54, 0x92, 0xCB, 0xF0, 0xA4, 0xD5, 0xA7, 
+0x1F, 0xB1, 0x5B, 0xE1, 0x3C, 0x72, 0xEA, 0x6A, 
+0x27, 0x04, 0x0C, 0xA7, 0x63, 0x38, 0xA0, 0xB0, 
+0xA6, 0x40, 0x13, 0x58, 0xA3, 0x27, 0x91, 0x87, 
+0x9E, 0x31, 0x7A, 0x57, 0x69, 0x2A, 0x4D, 0x4B, 
+0xC1, 0xFA, 0x0D, 0xAC, 0x81, 0x75, 0x5C, 0x14, 
+0x67, 0x5F, 0x1C, 0xD2, 0xE2, 0xE2, 0xEC, 0x73, 
+0x99, 0x66, 0x50, 0xBB, 0x07, 0x39, 0x34, 0x0C, 
+0x9B, 0xF1, 0xC7, 0x75, 0x78, 0xCE, 0x89, 0xA3, 
+0x93, 0x33, 0x1B, 0x26, 0xDC, 0xDB, 0x81, 0x19, 
+0x3A, 0xB7, 0x1F, 0x22, 0xE8, 0x32, 0x84, 0xE0, 
+0x43, 0x14, 0xA2, 0x89, 0xC7, 0xE2, 0x3F, 0x8F, 
+0x97, 0x64, 0x69, 0xE9, 0x25, 0x30, 0xDB, 0x08, 
+0x77, 0xB8, 0x9A, 0x3B, 0x5F, 0xCF, 0x4C, 0x74, 
+0x3D, 0xB3, 0xC2, 0xC8, 0x8F, 0x26, 0xE3, 0x9E, 
+0x5E, 0x72, 0x56, 0x00, 
+
+0xD1, 0x0A, 0x91, 0xF7, 0xA7, 0x4C, 0x76, 0x48, 
+0x2A, 0x3D, 0xF6, 0xF5, 0x52, 0x26, 0xB2, 0x51, 
+0x57, 0x04, 0x97, 0x8F, 0xC7, 0xAC, 0x8A, 0xA0, 
+0xFB, 0x2E, 0x1D, 0x15, 0x16, 0xFD, 0xF9, 0xEF, 
+0xB4, 0x89, 0x50, 0xED, 0x17, 0xD6, 0x08, 0x89, 
+0xD1, 0x7A, 0xDB, 0x08, 0x93, 0x79, 0xAB, 0x2A, 
+0x47, 0x83, 0x07, 0xDD, 0xCA, 0x56, 0xE0, 0xE6, 
+0x3B, 0x16, 0xC2, 0x1E, 0x9E, 0xB4, 0xF8, 0xAD, 
+0x0C, 0x44, 0x9C, 0xCA, 0xD0, 0xEA, 0xB8, 0x15, 
+0x7D, 0x74, 0x80, 0xAF, 0xF5, 0x2F, 0x08, 0xDD, 
+0xEF, 0x70, 0x27, 0x38, 0x5D, 0x87, 0x82, 0x98, 
+0x13, 0xDD, 0x31, 0xC2, 0xCE, 0x4D, 0xBE, 0x02, 
+0x55, 0x6B, 0x14, 0x8C, 0xC7, 0x94, 0x4D, 0x55, 
+0xA6, 0xF7, 0x23, 0xD1, 0x9B, 0xB7, 0x9F, 0xFE, 
+0xFC, 0x8B, 0x47, 0x96, 0xB1, 0x57, 0x73, 0x1A, 
+0xDC, 0xAB, 0x58, 0xB3, 0x92, 0xE3, 0xB5, 0xBE, 
+0x74, 0x49, 0xEE, 0x6C, 0xDA, 0x22, 0x7A, 0x2D, 
+0x21, 0x1B, 0x9E, 0xC6, 0xBD, 0x4B, 0xF4, 0x03, 
+0x3B, 0x95, 0xCF, 0x1B, 0xFE, 0x66, 0xC3, 0x73, 
+0xD5, 0x60, 0xAF, 0x17, 0x9F, 0x4E, 0x63, 0x0A, 
+0x49, 0x5F, 0xE0, 0x48, 0xD9, 0xB0, 0xB6, 0xB0, 
+0x82, 0xE0, 0xB8, 0xC3, 0xE3, 0xB4, 0x67, 0x59, 
+0x53, 0x34, 0x5E, 0x8E, 0x31, 
+
+0x4B, 0x79, 0x92, 0x24, 0x6F, 0x60, 0x05, 0x77, 
+0x7B, 0x90, 0xC0, 0xBA, 0x48, 0xBB, 0xB4, 0xAF, 
+0x37, 0xB0, 0xA7, 0x75, 0x5C, 0xED, 0xE4, 0xBB, 
+0xF3, 0x1E, 0x61, 0xD1, 0x52, 0x28, 0x65, 0x50, 
+0xF7, 0xE2, 0x02, 0x41, 0x56, 0xB2, 0x58, 0xFA, 
+0x32, 0x34, 0x98, 0x3A, 0x37, 0xFC, 0x11, 0x72, 
+0x57, 0x88, 0xC1, 0x96, 0x7E, 0x18, 0x7F, 0xA9, 
+0xB9, 0x0C, 0x19, 0xE3, 0xE4, 0x59, 0x77, 0x58, 
+0x08, 0x23, 0xBB, 0x1F, 0x44, 0xEC, 0x68, 0xFA, 
+0xD0, 0x75, 0x0D, 0xAB, 0xF0, 0x76, 0x2E, 0x8A, 
+0x47, 0x12, 0x1C, 0xC9, 0x05, 0xC3, 0xDF, 0x6C, 
+0xD1, 0xD2, 0x5B, 0x6F, 0x28, 0x34, 0x3F, 0x25, 
+0xFC, 0x97, 0xA1, 0x12, 0x31, 0xCE, 0x35, 0x4A, 
+0xE7, 0x2F, 0xCF, 0x4D, 0x23, 0x32, 0xF9, 0x8D, 
+0x44, 0xDB, 0x77, 0xA4, 0xE7, 0x78, 0xF0, 0xF4, 
+0x78, 0xE9, 0xE3, 0x3D, 0xAE, 0x82, 0x62, 0x20, 
+0x9A, 0xEF, 0x8B, 0x6F, 0x2D, 0x79, 0x8F, 0x21, 
+0x00, 0x3F, 0xFB, 0x81, 0x14, 0xC0, 0x0C, 0x33, 
+0x3E, 0x03, 0x8A, 0x09, 0x1A, 0xF5, 0xAD, 0xC7, 
+0x3F, 0x34, 0xBB, 0xDA, 0xA7, 0x31, 0x33, 0xC6, 
+0xFE, 0x00, 0x6A, 0x05, 0x63, 0x3A, 0x3E, 0x2E, 
+0x7E, 0x2E, 0x73, 0x5A, 0x70, 0xE5, 0x74, 0x58, 
+0x0B, 0x4A, 0xAD, 0xEA, 0xDB, 0xE9, 
+
+0x45, 0x07, 0xFA, 0xE0, 0x67, 0x45, 0x4D, 0xE4, 
+0x61, 0x27, 0x8C, 0x76, 0x1E, 0x63, 0x7D, 0x2B, 
+0x2D, 0xCE, 0xC4, 0x21, 0x9F, 0x8F, 0x31, 0x78, 
+0x0B, 0x4E, 0x7D, 0xC0, 0xA5, 0x23, 0xF6, 0x99, 
+0x10, 0xE6, 0x17, 0x1F, 0xA0, 0x6B, 0xB3, 0xBC, 
+0x70, 0x5C, 0x22, 0x92, 0x81, 0xBF, 0x6C, 0xE9, 
+0x79, 0xF2, 0x37, 0x4F, 0x74, 0x67, 0x89, 0xD6, 
+0xC3, 0x3B, 0xD4, 0x49, 0x6F, 0xCA, 0x77, 0x02, 
+0xFE, 0xD6, 0x76, 0x35, 0xBF, 0xE5, 0xF0, 0xAA, 
+0x78, 0x31, 0x04, 0x0C, 0x07, 0x20, 0x18, 0x9B, 
+0xF1, 0xA7, 0x6F, 0x99, 0xEE, 0x74, 0x29, 0xA3, 
+0x76, 0x6C, 0xD8, 0xD7, 0x7B, 0xE1, 0xF6, 0x5A, 
+0x4A, 0xA5, 0xE8, 0x03, 0xE3, 0x20, 0x41, 0xA4, 
+0x52, 0xEF, 0x77, 0xF1, 0x24, 0xAE, 0x83, 0x3C, 
+0xE3, 0x7D, 0x5E, 0x5E, 0xD6, 0x53, 0x07, 0xCD, 
+0x86, 0x5A, 0x6B, 0x7A, 0xF6, 0xAF, 0x54, 0xA8, 
+0x3C, 0xBB, 0xF2, 0xE4, 0x10, 0xF9, 0x64, 0xFE, 
+0x59, 0xED, 0xC0, 0xF4, 0x1B, 0x57, 0x09, 0xD6, 
+0x79, 0xB2, 0x99, 0x9D, 0xF9, 0xE8, 0x1B, 0x46, 
+0x08, 0x82, 0xE0, 0xEC, 0x8B, 0x67, 0x27, 0xE0, 
+0xE0, 0x0B, 0x07, 0xE6, 0x52, 0xB2, 0x24, 0xA1, 
+0x88, 0xA0, 0xFF, 0x34, 0xC0, 0x14, 0x45, 0xF5, 
+0x36, 0x7E, 0x8E, 0x62, 0xC7, 0xCE, 0xA9, 
+
+0x68, 0xA4, 0xAF, 0xC4, 0x1D, 0x7B, 0xF1, 0xBE, 
+0x0E, 0xFC, 0xBD, 0xBE, 0x02, 0x85, 0x9C, 0x30, 
+0x8B, 0x54, 0xC0, 0x6C, 0x61, 0xA9, 0x52, 0xAA, 
+0xC3, 0x06, 0x0B, 0x5F, 0xE5, 0x62, 0xDE, 0x9E, 
+0xDD, 0x4B, 0x27, 0x11, 0xFD, 0x18, 0x37, 0x0D, 
+0xFD, 0x05, 0x29, 0x09, 0x83, 0x17, 0x4A, 0x94, 
+0xB3, 0x58, 0x89, 0x1B, 0x04, 0x00, 0x17, 0xFB, 
+0x27, 0x46, 0x89, 0x05, 0xE9, 0xB3, 0xA4, 0x20, 
+0xFA, 0xB8, 0xBE, 0xD4, 0x9F, 0xD9, 0xD2, 0x84, 
+0xAA, 0xEB, 0xD3, 0xCF, 0x53, 0xE0, 0x38, 0xFC, 
+0x5C, 0xE1, 0xC7, 0xB4, 0x06, 0x8B, 0xB7, 0x11, 
+0xEA, 0x02, 0x23, 0x53, 0x4D, 0xCE, 0x44, 0xDD, 
+0x15, 0x48, 0xE0, 0x15, 0x52, 0xEC, 0x02, 0x35, 
+0x3D, 0x89, 0x2B, 0x04, 0x49, 0xB4, 0x44, 0x26, 
+0x2A, 0xA6, 0x23, 0x7D, 0x25, 0x79, 0x96, 0xC6, 
+0x28, 0x60, 0xF0, 0x92, 0xA7, 0x32, 0x72, 0xD6, 
+0xA6, 0x39, 0xBD, 0x21, 0xB3, 0x23, 0xE0, 0xE2, 
+0x60, 0x3C, 0x01, 0xE9, 0xCD, 0xCD, 0x9D, 0x32, 
+0x9D, 0xCE, 0xC1, 0x01, 0xBD, 0x74, 0xE7, 0xF2, 
+0xFD, 0x54, 0xE8, 0x82, 0xEE, 0xB7, 0xB1, 0x43, 
+0x1B, 0x53, 0xA8, 0x37, 0x0F, 0x47, 0x44, 0x00, 
+0x15, 0x39, 0xFB, 0x07, 0x7E, 0x00, 0x1E, 0x78, 
+0x8E, 0x3E, 0x5B, 0xC0, 0x17, 0x77, 0x67, 0xE3, 
+
+0x91, 0x77, 0x0B, 0x5F, 0xAB, 0xDA, 0xCD, 0x22, 
+0x82, 0x84, 0x83, 0x98, 0xA9, 0x70, 0x19, 0x81, 
+0xAC, 0x09, 0xEB, 0x43, 0xDA, 0xDD, 0x82, 0x5F, 
+0x00, 0xDE, 0x4F, 0x72, 0xA7, 0xE3, 0x10, 0x20, 
+0x69, 0xF4, 0x21, 0xA1, 0x98, 0xF9, 0x5C, 0x09, 
+0x95, 0x01, 0xEC, 0xF8, 0x4B, 0x96, 0xB9, 0xF1, 
+0xF8, 0x71, 0x6A, 0x17, 0xD0, 0x25, 0x18, 0x9C, 
+0x36, 0x1D, 0xAD, 0x25, 0x1E, 0x74, 0xF2, 0x74, 
+0x1D, 0xEA, 0x8D, 0xA9, 0xE9, 0xEE, 0x84, 0x36, 
+0x26, 0x56, 0xCC, 0xD8, 0xC7, 0xB9, 0xD0, 0xE7, 
+0xC1, 0x79, 0x03, 0xD8, 0x15, 0x2F, 0x8A, 0xA5, 
+0xAA, 0x29, 0xDD, 0x93, 0x69, 0x17, 0x48, 0x2C, 
+0xEB, 0xC0, 0xFE, 0x62, 0x04, 0x6E, 0x34, 0x87, 
+0xF0, 0x30, 0x85, 0xED, 0xA0, 0x06, 0x0F, 0x46, 
+0x81, 0x88, 0x5D, 0x2B, 0x88, 0x65, 0xC6, 0x3A, 
+0x64, 0xBA, 0xEE, 0x3B, 0x35, 0xAD, 0x19, 0xE3, 
+0xFC, 0xD2, 0xE1, 0xA3, 0xF8, 0xCE, 0x7A, 0x41, 
+0x0E, 0x7A, 0x3F, 0x33, 0xB1, 0xC7, 0x95, 0x4F, 
+0x2A, 0xC5, 0xE6, 0xC7, 0x58, 0xF5, 0x08, 0x8B, 
+0x97, 0x10, 0x38, 0x62, 0x0A, 0xDD, 0x5C, 0x45, 
+0xC9, 0x5E, 0x81, 0x25, 0x3C, 0x5A, 0xBB, 0x33, 
+0x6A, 0x78, 0xC3, 0xF3, 0xEE, 0x17, 0x15, 0x6D, 
+0x3C, 0x79, 0x25, 0x0C, 0xCF, 0xCC, 0x7B, 0xB8, 
+0xFA, 
+
+0x68, 0x07, 0xD6, 0xD6, 0xD3, 0x22, 0xE6, 0x8A, 
+0x08, 0xAD, 0xBD, 0x63, 0x0C, 0x85, 0xD7, 0x40, 
+0x1F, 0x19, 0x68, 0x42, 0x0F, 0xAB, 0x59, 0x46, 
+0x67, 0x93, 0xFC, 0x4D, 0x8F, 0x44, 0x73, 0x90, 
+0xBB, 0xAF, 0x91, 0x6F, 0x54, 0x8E, 0x0B, 0xF8, 
+0x54, 0x7A, 0x59, 0x98, 0x9A, 0xD3, 0x67, 0x6E, 
+0xC8, 0x59, 0x7C, 0x95, 0x0B, 0x75, 0x64, 0x1F, 
+0xC9, 0x79, 0x6D, 0xFF, 0x74, 0xC7, 0xFE, 0x8D, 
+0x4F, 0x22, 0xD8, 0x3A, 0xFA, 0xD0, 0x6A, 0x45, 
+0x5A, 0x25, 0x69, 0x77, 0xCD, 0x93, 0xB6, 0x21, 
+0x8D, 0x6E, 0xD7, 0xAF, 0x16, 0xF5, 0xCF, 0x32, 
+0x09, 0xB5, 0xFA, 0x11, 0x32, 0x91, 0x57, 0x9A, 
+0x80, 0xC0, 0x37, 0x6E, 0x0D, 0x48, 0xF6, 0x2D, 
+0x12, 0x49, 0xC4, 0x7F, 0xCB, 0x36, 0x0C, 0x30, 
+0x92, 0x2F, 0x69, 0x0C, 0xF9, 0x3C, 0x07, 0xCF, 
+0x56, 0x29, 0xB2, 0xC6, 0x0C, 0x3C, 0x2E, 0x1B, 
+0x38, 0x16, 0xB7, 0x0B, 0x94, 0xB8, 0xAB, 0x95, 
+0x53, 0x04, 0xFF, 0x4E, 0xE5, 0x20, 0x48, 0x1E, 
+0xDB, 0x1E, 0xAC, 0x85, 0x3F, 0xA8, 0x75, 0x63, 
+0x5D, 0xBC, 0x6B, 0x25, 0x3E, 0xF7, 0x3F, 0x14, 
+0x21, 0xF6, 0x6B, 0xFF, 0xB4, 0x23, 0x6F, 0xFB, 
+0x9E, 0xD4, 0xC8, 0xDF, 0x37, 0xFD, 0x6C, 0x3B, 
+0x02, 0xC4, 0x04, 0x6C, 0xD2, 0xDE, 0x60, 0x22, 
+0xBE, 0x16, 
+
+0xB9, 0xD1, 0xCD, 0x6E, 0x3F, 0xF5, 0xB6, 0x53, 
+0x9B, 0x01, 0xFA, 0x50, 0x6D, 0x2E, 0xB5, 0x2D, 
+0x8E, 0xEF, 0x41, 0xE3, 0x57, 0xB7, 0xDD, 0x0E, 
+0x1A, 0x4B, 0xB2, 0xDD, 0xF3, 0x46, 0xD9, 0x3E, 
+0xDB, 0x59, 0x37, 0x2A, 0x73, 0x2F, 0x14, 0xC7, 
+0x85, 0xE1, 0x11, 0xDC, 0x0D, 0x1A, 0x66, 0x25, 
+0x62, 0x86, 0xCB, 0x8D, 0x6B, 0x25, 0x30, 0xFA, 
+0x2C, 0x8A, 0xE6, 0xE1, 0xC7, 0x75, 0x60, 0xB7, 
+0x7B, 0xCB, 0x14, 0x69, 0x0A, 0xC3, 0xBC, 0x79, 
+0xBE, 0x5E, 0x26, 0xD7, 0x87, 0x34, 0x90, 0x00, 
+0xB0, 0xB2, 0x0E, 0xBD, 0x79, 0x15, 0xDB, 0xB6, 
+0xCE, 0xDD, 0x9B, 0x91, 0x1D, 0x4F, 0x1D, 0xB5, 
+0xA5, 0xB0, 0x2E, 0x86, 0xC5, 0xED, 0x21, 0x64, 
+0x7F, 0x08, 0x02, 0xAC, 0x97, 0x5E, 0x2B, 0x17, 
+0xBA, 0xC4, 0x3E, 0xE1, 0x84, 0x1F, 0xF0, 0x0A, 
+0x1A, 0x0E, 0x58, 0xF9, 0x85, 0xC3, 0x56, 0x5B, 
+0x9E, 0x87, 0xEA, 0x1E, 0xA7, 0x1A, 0x80, 0x0E, 
+0xA7, 0xF6, 0x56, 0x16, 0x1F, 0x19, 0xB0, 0x09, 
+0x63, 0xEA, 0x25, 0x86, 0xF8, 0xDB, 0xA6, 0x58, 
+0x99, 0x53, 0xA9, 0x01, 0xFC, 0x6B, 0x2E, 0xDC, 
+0x55, 0xF4, 0x0A, 0xF5, 0xFE, 0xD8, 0xC9, 0x2B, 
+0x55, 0xB5, 0xD9, 0xD4, 0xB1, 0x71, 0x79, 0xA7, 
+0x95, 0xED, 0x3B, 0xA1, 0x29, 0xB6, 0xAC, 0x7F, 
+0xDF, 0xF3, 0x57, 
+
+0x2D, 0x90, 0xAD, 0x64, 0x6B, 0xBA, 0xFC, 0xC4, 
+0x25, 0xD1, 0x32, 0x39, 0x84, 0x2D, 0xBD, 0x13, 
+0xBB, 0xC5, 0xE9, 0x60, 0x8A, 0x22, 0xFC, 0xCD, 
+0x28, 0x24, 0xE5, 0xFE, 0xFE, 0x4E, 0xAD, 0xB3, 
+0x12, 0x5C, 0xE6, 0x1F, 0xAA, 0xF2, 0x71, 0x70, 
+0x8D, 0x03, 0x09, 0x50, 0x92, 0x71, 0x85, 0x8A, 
+0x2F, 0x25, 0x93, 0x0B, 0x2C, 0xA8, 0x95, 0xC7, 
+0xBD, 0x17, 0x92, 0xE7, 0x32, 0x21, 0xED, 0x04, 
+0x77, 0x68, 0xFA, 0x80, 0xCF, 0xEC, 0x9D, 0x9D, 
+0xF9, 0x8C, 0x29, 0xBA, 0x35, 0x22, 0xB8, 0x87, 
+0x29, 0xF8, 0x9D, 0x45, 0xE2, 0x48, 0x46, 0x55, 
+0x66, 0x22, 0x97, 0x46, 0x96, 0x5A, 0xBB, 0x05, 
+0x1A, 0xAC, 0x8D, 0xF9, 0x4C, 0x2B, 0x6A, 0x79, 
+0x3C, 0x40, 0xB3, 0x09, 0x30, 0xFD, 0x7A, 0x83, 
+0xF4, 0x51, 0xB3, 0x50, 0x98, 0x56, 0xA3, 0x94, 
+0x0C, 0x91, 0x6B, 0x9A, 0xC3, 0xB0, 0x69, 0xD8, 
+0x2C, 0x93, 0x0F, 0x79, 0xB0, 0x37, 0x9F, 0xF1, 
+0x6C, 0xC2, 0x87, 0x9E, 0x69, 0x7F, 0xBA, 0x7B, 
+0xB6, 0xB0, 0xEC, 0xD2, 0xDD, 0x15, 0xFC, 0x1C, 
+0x55, 0xDD, 0xF9, 0xD2, 0x5F, 0x93, 0x3C, 0xC7, 
+0xB7, 0x77, 0x2E, 0x48, 0xF1, 0x70, 0x15, 0x1C, 
+0xA5, 0x13, 0x69, 0x1C, 0x17, 0x51, 0xD9, 0xAF, 
+0x99, 0x6C, 0xF2, 0xF9, 0xE8, 0xD6, 0x27, 0x7B, 
+0xDC, 0xA5, 0xD0, 0x62, 
+
+0x1D, 0x42, 0xF0, 0x24, 0x6A, 0x89, 0x70, 0xB4, 
+0x77, 0xAA, 0x84, 0x37, 0x96, 0xCA, 0xA5, 0x83, 
+0xF1, 0x37, 0x0C, 0xE2, 0x08, 0x83, 0xCD, 0x1F, 
+0x02, 0xC6, 0x05, 0xA5, 0x96, 0xB7, 0x02, 0xCF, 
+0x1C, 0x2A, 0x43, 0xD2, 0x3F, 0x5F, 0xE4, 0x3E, 
+0x11, 0x99, 0xA2, 0xB3, 0xBF, 0x16, 0x53, 0x52, 
+0x6A, 0xB0, 0x33, 0xD7, 0xC5, 0xB5, 0x21, 0xB4, 
+0x58, 0x9C, 0x74, 0xBE, 0xAC, 0xA4, 0xAA, 0xEA, 
+0xE1, 0xDA, 0xD5, 0x52, 0xCC, 0xBB, 0x32, 0x05, 
+0xAB, 0xF9, 0x7F, 0xFF, 0xC2, 0xD5, 0x8B, 0x2D, 
+0x42, 0xA6, 0x69, 0x8D, 0x62, 0x21, 0x3A, 0xEF, 
+0xE8, 0x79, 0xC5, 0xC0, 0x68, 0x4E, 0xD7, 0xF6, 
+0x61, 0xDF, 0x94, 0x9F, 0x75, 0x2B, 0x30, 0x80, 
+0xF7, 0x7A, 0xE6, 0x59, 0x69, 0x93, 0x3D, 0x7E, 
+0x38, 0x46, 0x26, 0x2C, 0xD6, 0x68, 0xDE, 0xDE, 
+0x0F, 0x0C, 0xED, 0xBF, 0x6A, 0xE5, 0xC2, 0x49, 
+0xC5, 0x34, 0x52, 0xB6, 0x69, 0x81, 0x9B, 0x38, 
+0x92, 0xD0, 0x8D, 0x17, 0x50, 0x8A, 0x76, 0x7A, 
+0x24, 0x66, 0x3C, 0x9F, 0x1B, 0xFA, 0xCF, 0x18, 
+0x4B, 0xBB, 0xF6, 0xD4, 0x27, 0x43, 0xD4, 0x03, 
+0xD8, 0x5E, 0x4B, 0x3A, 0x74, 0xB7, 0xB0, 0xCA, 
+0x72, 0x51, 0x26, 0x8E, 0xCE, 0xCC, 0x6B, 0xBC, 
+0x37, 0x36, 0x18, 0x55, 0x83, 0xFE, 0xDD, 0x9F, 
+0x9A, 0x71, 0xA1, 0xC6, 0xE1, 
+
+0xB2, 0x1A, 0xDA, 0xA4, 0x04, 0x10, 0xA7, 0x68, 
+0xE3, 0x47, 0x53, 0x2C, 0x0B, 0xA0, 0x6E, 0xE5, 
+0x11, 0xF3, 0x95, 0x65, 0x43, 0xC4, 0x23, 0x1C, 
+0xD7, 0x90, 0x8F, 0xD2, 0x09, 0xC0, 0x46, 0x4C, 
+0x07, 0x96, 0x3D, 0x7B, 0xB9, 0x72, 0x86, 0x98, 
+0x4C, 0x93, 0x17, 0xBF, 0x55, 0x05, 0x1D, 0x93, 
+0x6A, 0xB6, 0x96, 0x55, 0x0C, 0x2D, 0x47, 0x78, 
+0xBA, 0xC6, 0xE9, 0xE5, 0xB0, 0x92, 0x90, 0xE1, 
+0x58, 0x26, 0xA2, 0x76, 0x0D, 0xB7, 0x8D, 0xAF, 
+0x4B, 0xED, 0x8D, 0xF6, 0xEC, 0x07, 0x6D, 0x51, 
+0xB7, 0x82, 0x47, 0x72, 0x2C, 0xE5, 0x81, 0xF4, 
+0xD4, 0x1D, 0xF6, 0x09, 0x5A, 0x30, 0x56, 0x83, 
+0x84, 0xC0, 0x9B, 0xE3, 0x94, 0x53, 0xEF, 0xD0, 
+0xF2, 0xD8, 0xFD, 0x4E, 0x8C, 0x05, 0x33, 0x6F, 
+0x9B, 0x12, 0xF6, 0x19, 0xFD, 0x05, 0xF1, 0x6B, 
+0x02, 0x36, 0xBB, 0x77, 0x3A, 0x74, 0x0A, 0x05, 
+0xB1, 0x4F, 0xBC, 0x52, 0x78, 0xAD, 0xC4, 0xF6, 
+0x43, 0x07, 0x28, 0x38, 0x4C, 0xA4, 0x25, 0x9A, 
+0x25, 0x22, 0x99, 0xC1, 0x71, 0x2C, 0x59, 0x94, 
+0xB8, 0xCF, 0x40, 0x40, 0x98, 0x89, 0x1B, 0x6B, 
+0xA4, 0x3F, 0x77, 0x5F, 0xFB, 0xED, 0x84, 0x4B, 
+0x80, 0xAD, 0xC4, 0x9D, 0xA2, 0xA5, 0xDE, 0xE4, 
+0xE5, 0x63, 0xCD, 0xB6, 0x64, 0xAC, 0x53, 0x06, 
+0x91, 0xE4, 0xE9, 0xCD, 0x9C, 0x7A, 
+
+0x82, 0x8C, 0xCC, 0xF5, 0xE7, 0x5F, 0x9F, 0xC1, 
+0x64, 0x6B, 0xA1, 0x2C, 0x7E, 0x5D, 0x55, 0xAF, 
+0x9A, 0xD9, 0x6B, 0xAE, 0x24, 0x5B, 0x7A, 0x0D, 
+0x9E, 0x5A, 0xDF, 0x32, 0xA4, 0x45, 0xE9, 0xB4, 
+0xF1, 0x0E, 0x30, 0xD3, 0x33, 0x42, 0xE1, 0xA4, 
+0x25, 0xCE, 0x48, 0xCB, 0x75, 0x52, 0xF5, 0x1F, 
+0x02, 0x2E, 0xE9, 0x8C, 0xF0, 0xC2, 0x6A, 0xF4, 
+0xBB, 0xD6, 0x93, 0x82, 0xD6, 0xFE, 0xAF, 0x54, 
+0x14, 0x98, 0x2F, 0xBF, 0xAB, 0xD1, 0x99, 0xB2, 
+0xE5, 0xFE, 0x27, 0x39, 0x2F, 0xEE, 0x7F, 0x6A, 
+0xE0, 0x03, 0x5C, 0xF3, 0x48, 0x2C, 0xF4, 0xDE, 
+0x30, 0x4E, 0xE5, 0x75, 0x19, 0x12, 0xE9, 0xC6, 
+0xC8, 0x5F, 0x38, 0xE7, 0x35, 0x1F, 0x01, 0x65, 
+0x58, 0xDD, 0x6D, 0x8B, 0x9B, 0x73, 0x9D, 0x3C, 
+0xCE, 0x35, 0x5D, 0x07, 0xBF, 0x8C, 0x1C, 0x63, 
+0xFD, 0xF3, 0x00, 0x24, 0x36, 0xBA, 0x1B, 0xBA, 
+0xE7, 0xF6, 0xC3, 0xCD, 0x9B, 0xD1, 0xAE, 0x87, 
+0x7C, 0x8F, 0xDE, 0x44, 0x76, 0x37, 0xC7, 0xA7, 
+0xEA, 0xFD, 0x02, 0xDB, 0x9E, 0x09, 0xB9, 0xB1, 
+0xAE, 0xBD, 0x99, 0xF6, 0xAB, 0xC1, 0xCA, 0xEA, 
+0xE6, 0x27, 0xAA, 0xFA, 0xF1, 0x6F, 0x53, 0x2A, 
+0x93, 0x0D, 0x97, 0x9A, 0xDE, 0xF2, 0x6F, 0x56, 
+0x06, 0xAA, 0x07, 0x1A, 0x62, 0x92, 0x28, 0x87, 
+0x0C, 0x01, 0xB8, 0x7B, 0xE8, 0x2D, 0xA9, 
+
+0x87, 0x68, 0x84, 0x01, 0x0F, 0x0F, 0xFB, 0xAD, 
+0xB7, 0xAC, 0x48, 0xF6, 0xA0, 0x46, 0xF9, 0x74, 
+0x94, 0xF5, 0x7D, 0x60, 0xBD, 0x7F, 0xAC, 0x21, 
+0xFC, 0x45, 0x15, 0xDA, 0xFF, 0xD6, 0x94, 0x94, 
+0x1D, 0xB2, 0xC3, 0x2F, 0x86, 0x00, 0x29, 0x4B, 
+0xC2, 0x45, 0x14, 0x33, 0xBF, 0xA9, 0x02, 0x0F, 
+0x0C, 0x0C, 0x3D, 0x51, 0xE1, 0xF0, 0x55, 0xE5, 
+0xC8, 0x1D, 0x94, 0x33, 0x79, 0x6E, 0x34, 0x77, 
+0x23, 0xE0, 0xF3, 0x8D, 0x03, 0x6A, 0xE2, 0x42, 
+0x42, 0xD6, 0x10, 0x6B, 0xC5, 0x22, 0xC7, 0x51, 
+0xBF, 0x55, 0xDD, 0x7C, 0x89, 0xEE, 0x16, 0x5C, 
+0xFE, 0x72, 0x21, 0x0A, 0x8D, 0x19, 0xC5, 0x8B, 
+0xD3, 0x5F, 0xFB, 0xC0, 0x60, 0x2D, 0xAC, 0x2E, 
+0xB0, 0xF0, 0x9C, 0x42, 0x87, 0x64, 0xE8, 0x4A, 
+0x2A, 0xDD, 0x6C, 0xD6, 0x59, 0x8D, 0xE6, 0x19, 
+0xFB, 0x16, 0x86, 0x52, 0x44, 0x32, 0xF4, 0x7A, 
+0xEB, 0x49, 0x6C, 0xA9, 0x83, 0xF8, 0x5E, 0x6A, 
+0x45, 0x94, 0x3C, 0x3E, 0x06, 0xB9, 0x3B, 0x27, 
+0x56, 0x8E, 0xC2, 0xFC, 0x1B, 0x01, 0xF9, 0xA2, 
+0xE5, 0x42, 0x34, 0xC4, 0xE0, 0x45, 0xB5, 0xE0, 
+0xC6, 0x0D, 0x87, 0xEE, 0x22, 0xCD, 0x23, 0x29, 
+0xE9, 0x9B, 0xF2, 0x72, 0x09, 0x8F, 0x1B, 0xCC, 
+0x99, 0x62, 0xA2, 0xB9, 0xCD, 0x92, 0x13, 0xFE, 
+0x07, 0xEC, 0x53, 0x28, 0x65, 0xA9, 0x54, 0xCB, 
+
+0xF9, 0xED, 0xFB, 0x79, 0x03, 0xDF, 0x51, 0x56, 
+0xDA, 0xD4, 0x2A, 0x58, 0x58, 0xC8, 0x51, 0xCF, 
+0x19, 0xAC, 0x58, 0x1F, 0xA9, 0x80, 0xDD, 0xCC, 
+0x3B, 0xD2, 0x30, 0x50, 0xB6, 0xC6, 0xEB, 0x21, 
+0xAA, 0x4A, 0x91, 0xD0, 0xCC, 0x9B, 0x1B, 0x22, 
+0xBB, 0x8F, 0xAF, 0x71, 0x94, 0xB5, 0x72, 0xBA, 
+0x74, 0x44, 0x22, 0x98, 0x67, 0xF5, 0x24, 0xA7, 
+0x91, 0x9C, 0xB8, 0x12, 0xF8, 0x39, 0x9D, 0xE5, 
+0x11, 0xE1, 0x70, 0x3E, 0x1F, 0x06, 0x70, 0xBB, 
+0xD1, 0xAA, 0xD9, 0x81, 0xF1, 0x22, 0x0E, 0x73, 
+0x2E, 0xA5, 0x06, 0xED, 0x56, 0xD0, 0xE9, 0xD0, 
+0x69, 0x35, 0x8D, 0x39, 0x9F, 0x68, 0x03, 0xF1, 
+0x7F, 0xA3, 0x92, 0x63, 0xE4, 0xEE, 0xF3, 0x4F, 
+0xB5, 0x2A, 0xE0, 0xA6, 0x47, 0x3A, 0xA1, 0x63, 
+0xE7, 0x95, 0x57, 0x6D, 0x6B, 0x84, 0xA2, 0x8E, 
+0x3E, 0x13, 0x19, 0x6C, 0x49, 0xCB, 0x8C, 0x24, 
+0x9A, 0x65, 0x68, 0xD7, 0x33, 0x76, 0xAB, 0x38, 
+0xAE, 0xA6, 0x81, 0xE6, 0x5E, 0x91, 0x4D, 0xBE, 
+0x58, 0xE8, 0xDB, 0xC9, 0xB3, 0x87, 0x35, 0x6F, 
+0xE9, 0xAC, 0x48, 0x57, 0xBF, 0x6D, 0x0D, 0x5A, 
+0x56, 0x85, 0x7C, 0xBC, 0x4E, 0x66, 0x7E, 0x57, 
+0xC8, 0x4A, 0xDC, 0x09, 0x73, 0x84, 0x3E, 0x2C, 
+0x32, 0x25, 0x55, 0x5F, 0x57, 0xC9, 0xCE, 0xC1, 
+0x12, 0x3B, 0x3A, 0x49, 0xFC, 0x6B, 0xDD, 0xF9, 
+0xE5, 
+
+0xBB, 0x05, 0x6C, 0x20, 0xF6, 0x54, 0xFE, 0xE4, 
+0x34, 0xE6, 0x1A, 0xDC, 0x91, 0xFF, 0x6C, 0x18, 
+0x5D, 0x35, 0x74, 0xC1, 0x7F, 0xA2, 0xAC, 0xFE, 
+0x80, 0x06, 0x0E, 0xC1, 0xEF, 0x9E, 0x80, 0xA9, 
+0xDC, 0x93, 0x00, 0x0B, 0x88, 0x56, 0x99, 0x53, 
+0x23, 0x54, 0x6D, 0xC3, 0x24, 0xB0, 0x32, 0xDC, 
+0xF0, 0x38, 0x90, 0x24, 0xE0, 0x50, 0x15, 0x54, 
+0xB2, 0x61, 0x52, 0x34, 0x19, 0x46, 0xD8, 0x7B, 
+0x98, 0xE5, 0x51, 0xDB, 0x4C, 0x68, 0x3F, 0x61, 
+0x02, 0x72, 0x51, 0x25, 0xAC, 0x5C, 0x77, 0xDF, 
+0xA5, 0x2D, 0x4D, 0x7B, 0x24, 0x63, 0x96, 0x11, 
+0x91, 0x24, 0x67, 0xF3, 0x17, 0xEC, 0x3B, 0x7C, 
+0xCE, 0xB5, 0xF5, 0xF1, 0xCC, 0x86, 0x49, 0x39, 
+0x23, 0x52, 0xAA, 0xB8, 0x0C, 0xF4, 0x16, 0xC5, 
+0x39, 0xAF, 0xA8, 0xFA, 0xBA, 0xB3, 0x5C, 0x5E, 
+0xEF, 0x75, 0x08, 0x30, 0xF9, 0x03, 0xDC, 0xBE, 
+0x91, 0x6F, 0x10, 0x26, 0xEC, 0x7E, 0xE6, 0xC9, 
+0xEE, 0x64, 0x96, 0x5F, 0xF3, 0x50, 0x56, 0x8C, 
+0xAD, 0x8B, 0x88, 0xC5, 0xCF, 0xA0, 0xDA, 0x4E, 
+0xBB, 0x08, 0x09, 0xCE, 0xBD, 0xAA, 0x1B, 0xBA, 
+0xDB, 0x19, 0x7A, 0x15, 0xE8, 0x65, 0xDC, 0x8C, 
+0x4F, 0x01, 0xBC, 0x8C, 0x00, 0x34, 0xBF, 0x22, 
+0x2E, 0xB7, 0x7A, 0xEB, 0x3B, 0x96, 0x04, 0x01, 
+0x4A, 0x1C, 0xFF, 0x7E, 0x43, 0x10, 0xEA, 0x85, 
+0x19, 0x83, 
+
+0xFB, 0x7D, 0xB3, 0x6A, 0xEE, 0x23, 0x79, 0xE9, 
+0x35, 0x72, 0xD4, 0xCB, 0xD2, 0x29, 0xDE, 0x2D, 
+0xD6, 0x85, 0xCC, 0x4A, 0x19, 0xDB, 0xC6, 0x9D, 
+0x34, 0x88, 0x00, 0x9D, 0xE5, 0x2F, 0x35, 0x85, 
+0xD1, 0x63, 0xD4, 0xC3, 0x1D, 0x74, 0x89, 0x54, 
+0xCA, 0xB4, 0x96, 0x5A, 0x47, 0x6A, 0xC5, 0x54, 
+0x02, 0xE0, 0xAF, 0x3A, 0xE6, 0x99, 0x40, 0x28, 
+0x3F, 0x93, 0x2D, 0xD5, 0x02, 0xD5, 0xA3, 0x7F, 
+0x8A, 0xBA, 0xA4, 0x39, 0x2D, 0xFA, 0x8F, 0x45, 
+0xCB, 0x2B, 0xEB, 0xE6, 0x83, 0xF0, 0xEE, 0xAE, 
+0x9B, 0x8A, 0x60, 0x42, 0x40, 0x79, 0x5C, 0x2D, 
+0x21, 0x6B, 0x46, 0x5D, 0x66, 0x28, 0xBA, 0x79, 
+0x0D, 0x4C, 0x4E, 0x35, 0x6A, 0x6B, 0x72, 0xED, 
+0x50, 0x68, 0x68, 0x2B, 0x49, 0x47, 0x79, 0xC4, 
+0xA7, 0x54, 0xCE, 0x3C, 0x84, 0x5A, 0x69, 0xCA, 
+0xDD, 0x7E, 0x63, 0x5C, 0x99, 0x29, 0xD9, 0x15, 
+0x31, 0x08, 0xE4, 0x91, 0xF6, 0x54, 0xAD, 0xF9, 
+0x4C, 0xBD, 0x5A, 0x26, 0xAC, 0x9F, 0xFF, 0xDD, 
+0x7E, 0xB8, 0x0F, 0xF9, 0xA1, 0xB0, 0xAD, 0xF5, 
+0xE2, 0xAD, 0xBF, 0x5D, 0x62, 0x10, 0x69, 0x4D, 
+0x42, 0x99, 0xD9, 0x50, 0x51, 0x0B, 0xC3, 0xBB, 
+0xFB, 0xDA, 0x1D, 0x2E, 0x7E, 0xBB, 0x8B, 0x84, 
+0x25, 0xEF, 0x7F, 0x94, 0x8B, 0xBD, 0xF0, 0x94, 
+0xCF, 0x92, 0xF6, 0x40, 0xEF, 0x03, 0x20, 0x64, 
+0x8A, 0x88, 0x9C, 
+
+0x1A, 0xBB, 0x32, 0x62, 0xA1, 0xCD, 0x95, 0x04, 
+0x93, 0xA9, 0x28, 0xAC, 0x78, 0x48, 0xDD, 0xA4, 
+0x6D, 0xD0, 0x2A, 0x78, 0xCE, 0x70, 0xD5, 0x50, 
+0xAF, 0x5D, 0x3C, 0x98, 0x4A, 0x60, 0x38, 0xB5, 
+0xC5, 0x88, 0x1B, 0xBE, 0x3F, 0x9D, 0x8E, 0x27, 
+0x29, 0x3C, 0x63, 0xF8, 0xA6, 0x00, 0x77, 0xBE, 
+0x8B, 0x63, 0xD8, 0x93, 0xB7, 0x30, 0xD3, 0x63, 
+0x94, 0x34, 0x3A, 0x80, 0xFF, 0xE0, 0xB7, 0xCA, 
+0x57, 0x82, 0x99, 0x2A, 0xF1, 0xCE, 0xFC, 0xF6, 
+0x80, 0xE3, 0x7B, 0xBC, 0x6C, 0x0A, 0x63, 0xE4, 
+0x08, 0x5E, 0x25, 0x53, 0x60, 0xF2, 0xDD, 0x48, 
+0xBE, 0x34, 0x00, 0x59, 0x40, 0x7D, 0x43, 0xD4, 
+0xEF, 0x1C, 0xDF, 0x41, 0x79, 0x92, 0x27, 0x11, 
+0x7B, 0xF4, 0x83, 0xD0, 0x40, 0x71, 0x3F, 0x92, 
+0x1D, 0xD9, 0x18, 0x38, 0x59, 0xCE, 0x94, 0xEB, 
+0x64, 0x45, 0x9A, 0xEB, 0xE8, 0xB8, 0x58, 0x99, 
+0xC7, 0xF8, 0xC0, 0xA0, 0x91, 0xB2, 0x29, 0x73, 
+0xFC, 0x1B, 0x2B, 0x8B, 0x47, 0xB5, 0xB3, 0xC2, 
+0xF9, 0x40, 0xB1, 0x8D, 0x8F, 0x88, 0x9D, 0x0C, 
+0x4B, 0x91, 0xDE, 0xA2, 0x32, 0xFF, 0xDD, 0x21, 
+0xFF, 0x56, 0x9A, 0x74, 0x65, 0x89, 0x2D, 0xF2, 
+0xF0, 0x6A, 0x18, 0xC7, 0xCA, 0x9F, 0x4C, 0x5B, 
+0x5B, 0x32, 0xED, 0x15, 0x2C, 0xD8, 0x62, 0x40, 
+0xDD, 0xFB, 0x20, 0xE2, 0xCF, 0x0D, 0x05, 0x06, 
+0xA6, 0xAC, 0x63, 0x8C, 
+
+0xE0, 0x12, 0x54, 0x30, 0xD3, 0x35, 0xD8, 0xA1, 
+0x99, 0xCE, 0xA5, 0x1D, 0x11, 0x58, 0x3F, 0xCA, 
+0xE3, 0x06, 0xD0, 0x5B, 0x8F, 0x78, 0xC1, 0xC9, 
+0x52, 0xA6, 0x08, 0xF0, 0x72, 0x08, 0x1F, 0x4E, 
+0x0D, 0x76, 0x87, 0x2B, 0xE6, 0xED, 0x07, 0xB8, 
+0x06, 0x44, 0xC1, 0x1D, 0x2E, 0x2A, 0x41, 0xDC, 
+0x10, 0xDA, 0xFD, 0xCC, 0x1C, 0x9C, 0xF9, 0xA9, 
+0xF7, 0xC6, 0x0F, 0xAE, 0x25, 0xFC, 0x61, 0x47, 
+0x5E, 0x54, 0xDD, 0x43, 0x4B, 0x8D, 0x85, 0x8A, 
+0x49, 0x51, 0x28, 0x62, 0xE6, 0x21, 0x04, 0x8B, 
+0x97, 0xCC, 0xBE, 0x9B, 0x56, 0x07, 0x26, 0xDE, 
+0xB4, 0xE6, 0x8E, 0x00, 0xC4, 0x7B, 0x1F, 0x90, 
+0x5A, 0xFA, 0xD3, 0x64, 0x71, 0x4F, 0xB3, 0xB7, 
+0x72, 0xED, 0xC9, 0x56, 0x8A, 0x88, 0xA0, 0xAC, 
+0xE3, 0xF4, 0xB1, 0xBB, 0x5C, 0x52, 0x01, 0x47, 
+0x82, 0x39, 0x18, 0xF4, 0xAC, 0x51, 0xC7, 0x97, 
+0x8A, 0xFE, 0x68, 0x99, 0xC7, 0xA2, 0xCC, 0x20, 
+0xEF, 0x66, 0xFE, 0x8E, 0x09, 0xFD, 0x6F, 0x09, 
+0x9F, 0xE4, 0x2E, 0x93, 0xDA, 0x1E, 0xD8, 0x4D, 
+0xC5, 0x3B, 0x60, 0x93, 0xB3, 0x9C, 0xF8, 0xF0, 
+0xF6, 0x35, 0xAD, 0xCE, 0x02, 0x0D, 0xC9, 0xDD, 
+0xE5, 0x4D, 0x4C, 0x05, 0x24, 0xA9, 0xE2, 0x4F, 
+0xD3, 0xBE, 0xC4, 0xD1, 0xFB, 0xAD, 0x88, 0xA8, 
+0xFD, 0x02, 0xC5, 0xF6, 0xF0, 0xE1, 0xA1, 0x07, 
+0xFE, 0x61, 0x51, 0xCA, 0xE8, 
+
+0xD1, 0x59, 0xCF, 0x72, 0x33, 0xC0, 0x53, 0x99, 
+0x8C, 0x1A, 0xD4, 0x81, 0x2B, 0x45, 0x8C, 0xEF, 
+0x1E, 0x1B, 0x3B, 0xA7, 0x46, 0x7A, 0x32, 0xBF, 
+0xC8, 0xFE, 0xF0, 0xB1, 0x1B, 0x12, 0x7E, 0xDC, 
+0x7D, 0xFC, 0x0C, 0x54, 0x76, 0x99, 0xDA, 0xB2, 
+0xEF, 0x2D, 0xF2, 0xCD, 0x91, 0x2C, 0xBE, 0xFC, 
+0x11, 0x47, 0x55, 0x18, 0x2C, 0x43, 0x06, 0xF5, 
+0x8A, 0x00, 0x0F, 0xA9, 0xA3, 0xC5, 0x8F, 0xEE, 
+0x78, 0x90, 0xE0, 0x74, 0xE4, 0x88, 0xC4, 0x09, 
+0xB5, 0x45, 0x85, 0xC0, 0xC0, 0x9F, 0xFD, 0x01, 
+0x18, 0x34, 0x14, 0x7B, 0x73, 0x97, 0xE1, 0x43, 
+0x75, 0x71, 0xFC, 0xF3, 0x1C, 0x7D, 0x44, 0x1F, 
+0x87, 0x84, 0x7F, 0x77, 0x55, 0x27, 0xC8, 0xC4, 
+0x81, 0x62, 0x79, 0xA6, 0x12, 0x94, 0x88, 0x15, 
+0xE9, 0x87, 0xF1, 0x0A, 0x77, 0x13, 0x9C, 0xF3, 
+0x02, 0x78, 0xC1, 0xB9, 0x01, 0x0C, 0x44, 0x88, 
+0xB8, 0x5E, 0xFD, 0xFE, 0xD2, 0xC6, 0xA2, 0x1F, 
+0xF4, 0x91, 0x66, 0x19, 0xA6, 0x1F, 0x15, 0x1F, 
+0x1B, 0xF5, 0x69, 0x17, 0x16, 0x2B, 0x01, 0x1E, 
+0x93, 0x9F, 0x63, 0xD5, 0x9F, 0xB9, 0xAC, 0x34, 
+0x17, 0x8F, 0x30, 0x18, 0x2D, 0x01, 0x48, 0x54, 
+0x9A, 0x96, 0x1D, 0x3A, 0xEA, 0xE7, 0x9A, 0x54, 
+0x00, 0x2E, 0x1B, 0xB1, 0x18, 0xE2, 0x5A, 0xCE, 
+0x16, 0x36, 0xC7, 0x1D, 0x70, 0x4F, 0x34, 0x98, 
+0x73, 0x86, 0x1D, 0xC8, 0x46, 0xE4, 
+
+0x5E, 0x1C, 0x27, 0xA0, 0x41, 0xC4, 0xA4, 0xA1, 
+0x7C, 0x7B, 0xAE, 0xDC, 0x5E, 0x61, 0xC2, 0xC1, 
+0xC4, 0xED, 0xE7, 0xA6, 0x25, 0xD3, 0x69, 0x78, 
+0x3C, 0xA9, 0xCB, 0xE1, 0x84, 0x04, 0xB7, 0x75, 
+0x4B, 0x7E, 0xB8, 0x4C, 0x11, 0xCE, 0xBE, 0x8D, 
+0xAE, 0x4F, 0x58, 0x93, 0xF3, 0x0E, 0x56, 0x08, 
+0xF2, 0x96, 0x30, 0xF8, 0x0B, 0xA1, 0x07, 0x87, 
+0xED, 0x44, 0x47, 0x2B, 0x6F, 0x9E, 0xA2, 0xDC, 
+0x88, 0xFC, 0xE7, 0x02, 0x59, 0xE8, 0x52, 0xD6, 
+0x79, 0x7A, 0x15, 0xD5, 0xC5, 0xE7, 0xC9, 0x5B, 
+0x2B, 0x5A, 0x2F, 0x0E, 0x0E, 0x3C, 0xB7, 0x90, 
+0x60, 0x53, 0x2E, 0xDD, 0x17, 0x6F, 0xE7, 0xB8, 
+0xDD, 0x70, 0x52, 0x8D, 0x3E, 0x71, 0xD1, 0xE8, 
+0xEF, 0xD8, 0xED, 0x3A, 0x17, 0x05, 0xCE, 0xCE, 
+0x0D, 0x0A, 0x5C, 0x58, 0x9B, 0x08, 0xF0, 0xF8, 
+0xA3, 0xE7, 0x03, 0xCE, 0xE8, 0x8C, 0xB8, 0x53, 
+0xE8, 0x68, 0x8C, 0xA7, 0xAF, 0x98, 0xBA, 0x2A, 
+0x3A, 0x7D, 0xDD, 0xC6, 0xB4, 0x3F, 0x0B, 0x65, 
+0x3A, 0x62, 0x8B, 0x5C, 0x52, 0xD0, 0xDB, 0x8E, 
+0xB6, 0xCF, 0x36, 0x3E, 0xA0, 0x17, 0x16, 0x27, 
+0xC0, 0x3C, 0x89, 0x36, 0xB2, 0x40, 0x13, 0x99, 
+0x0C, 0xF7, 0x85, 0xBC, 0x93, 0x71, 0x32, 0x32, 
+0x21, 0x5E, 0xF4, 0xB7, 0x6F, 0x1F, 0x8F, 0xEB, 
+0xE3, 0xB0, 0xD9, 0x26, 0x06, 0xFA, 0x19, 0x44, 
+0xA1, 0x4F, 0x5A, 0x64, 0xDE, 0x3E, 0xCC, 
+
+0xA7, 0xE1, 0xC0, 0x98, 0xBA, 0x82, 0xC8, 0x19, 
+0x9B, 0x0A, 0x4B, 0xCA, 0xCF, 0x45, 0x96, 0x28, 
+0x47, 0x75, 0xA2, 0x63, 0xB6, 0xF3, 0x84, 0x60, 
+0x2D, 0x19, 0xBD, 0x65, 0x06, 0x47, 0xDD, 0x60, 
+0x33, 0xB3, 0xCA, 0x92, 0x56, 0xB8, 0xAE, 0x3C, 
+0x86, 0xC6, 0xF5, 0x1D, 0xBB, 0xF8, 0x4E, 0xEF, 
+0xBA, 0x4A, 0x01, 0x97, 0xCD, 0xAE, 0xBF, 0x64, 
+0x31, 0x51, 0x45, 0xEF, 0x60, 0x55, 0x65, 0x6F, 
+0x1E, 0x6B, 0xC8, 0xC5, 0x9D, 0x69, 0x59, 0xE1, 
+0xF9, 0x3E, 0x37, 0xDC, 0x1B, 0x49, 0xA0, 0x23, 
+0xD1, 0x5A, 0xBD, 0xD0, 0xA4, 0x44, 0x65, 0xF0, 
+0xCF, 0xAE, 0x15, 0x04, 0xD9, 0x59, 0xE7, 0xC7, 
+0x23, 0xA2, 0x08, 0x0A, 0xD1, 0xCF, 0xFA, 0x74, 
+0x98, 0x28, 0x30, 0x6A, 0x75, 0x62, 0x4F, 0x1C, 
+0xCC, 0xD9, 0xAB, 0x81, 0x50, 0x66, 0x14, 0xD4, 
+0xAB, 0xD8, 0x02, 0x08, 0x8F, 0x60, 0x86, 0x23, 
+0x06, 0x81, 0x8A, 0xDB, 0x69, 0xD6, 0xE1, 0x34, 
+0x17, 0xE8, 0xF9, 0x3B, 0x79, 0x3F, 0xF7, 0x5E, 
+0xCB, 0x36, 0xFD, 0x0D, 0xDA, 0x7B, 0xB8, 0x31, 
+0xCB, 0x57, 0x77, 0xA1, 0x31, 0x07, 0xF4, 0x7B, 
+0xA5, 0x35, 0x9B, 0x88, 0xBA, 0xB1, 0x22, 0x8A, 
+0x30, 0x26, 0x76, 0xD6, 0x59, 0x18, 0xE5, 0x75, 
+0xA1, 0xD3, 0x31, 0x0A, 0x98, 0xC8, 0x30, 0xDA, 
+0xDC, 0x10, 0x2D, 0x03, 0x96, 0x48, 0x0F, 0x31, 
+0xF0, 0xEA, 0x20, 0x8E, 0xDB, 0x77, 0x64, 0x48, 
+
+0xF6, 0x5A, 0xD5, 0x9E, 0xE7, 0x2D, 0xC4, 0xD6, 
+0x2A, 0x03, 0x1D, 0xCF, 0x34, 0xD7, 0xDB, 0x1B, 
+0xFB, 0x11, 0x7E, 0x7C, 0x03, 0x4B, 0x32, 0x94, 
+0x95, 0x3D, 0xAA, 0xB8, 0xE3, 0xE4, 0xD3, 0x1B, 
+0xBD, 0x8C, 0x47, 0x3D, 0x59, 0xBB, 0x62, 0xCC, 
+0xF5, 0xEC, 0x65, 0xB9, 0xE0, 0xA5, 0xF8, 0x16, 
+0x49, 0xB5, 0xA8, 0xEB, 0x7C, 0x7E, 0x3F, 0x29, 
+0x4E, 0xC6, 0xDE, 0x56, 0xE6, 0x61, 0x7D, 0xD3, 
+0x72, 0xC6, 0xC4, 0xED, 0xA3, 0xBB, 0x3A, 0xA7, 
+0xE6, 0x78, 0xCE, 0xE9, 0x83, 0x13, 0x07, 0x1D, 
+0x37, 0xF3, 0xA0, 0x57, 0xB8, 0xC3, 0xE6, 0x03, 
+0x4B, 0x9D, 0xD2, 0x95, 0xD6, 0x19, 0x14, 0xFA, 
+0xDB, 0xDC, 0xD8, 0x5D, 0x08, 0x2E, 0x8A, 0x63, 
+0x76, 0x28, 0xA3, 0x71, 0x84, 0xCD, 0x01, 0x9C, 
+0x8B, 0xFB, 0xDA, 0xD0, 0x19, 0x76, 0x3C, 0x90, 
+0x28, 0x01, 0x39, 0x75, 0x78, 0xAB, 0x68, 0x63, 
+0x8A, 0x11, 0x6F, 0x13, 0xBD, 0x23, 0x57, 0x65, 
+0x3B, 0x33, 0xDC, 0xA1, 0x0B, 0x92, 0x17, 0x90, 
+0x8A, 0xD2, 0x4C, 0x9F, 0xA8, 0x55, 0x5A, 0x28, 
+0xA4, 0x87, 0xC4, 0xA1, 0xA1, 0xB9, 0x27, 0x74, 
+0x5B, 0xCE, 0x10, 0x3F, 0x80, 0x36, 0x92, 0x39, 
+0x49, 0x32, 0xC3, 0x45, 0x77, 0xB0, 0x3D, 0xC5, 
+0x30, 0x55, 0x89, 0x2A, 0x33, 0x38, 0x0E, 0x1B, 
+0x0E, 0x30, 0xC1, 0x0F, 0x62, 0x98, 0x1A, 0x8E, 
+0x43, 0xB5, 0x63, 0xC0, 0x27, 0xB1, 0x8A, 0x8C, 
+0x4D, 
+
+0xCC, 0xC3, 0xA1, 0x94, 0x2B, 0x64, 0xA6, 0x1C, 
+0x8B, 0x89, 0x43, 0x1F, 0x1A, 0x64, 0xC7, 0x2B, 
+0x7B, 0xEE, 0xD9, 0x07, 0xB8, 0xF9, 0x71, 0x58, 
+0x22, 0xF4, 0xC8, 0x98, 0x59, 0x4E, 0x55, 0xB0, 
+0xAB, 0x84, 0xBF, 0x47, 0x94, 0x60, 0x58, 0xC2, 
+0xF5, 0xB0, 0xC0, 0x69, 0xDA, 0x4B, 0xFA, 0x71, 
+0x23, 0x85, 0xBD, 0x22, 0x8B, 0xAB, 0xF0, 0x3E, 
+0x9D, 0xE2, 0x15, 0x44, 0xCF, 0xFE, 0x32, 0x67, 
+0x3B, 0x06, 0xBD, 0xBC, 0xF9, 0x5C, 0x42, 0x2A, 
+0x8E, 0x97, 0xD6, 0x80, 0xC7, 0x36, 0x5A, 0xC8, 
+0xF1, 0x4C, 0x3E, 0xE9, 0x6C, 0x78, 0xFF, 0x78, 
+0x4E, 0x77, 0xCA, 0xB2, 0x3B, 0xCA, 0x4D, 0x61, 
+0x74, 0x02, 0xFB, 0xDD, 0xB3, 0x52, 0x72, 0xF4, 
+0x33, 0x45, 0x66, 0xDF, 0x0B, 0x94, 0xD4, 0x33, 
+0x59, 0x02, 0x61, 0xE4, 0x4B, 0x14, 0xB6, 0xFA, 
+0xC0, 0x96, 0x4B, 0x7D, 0x3A, 0x19, 0x3E, 0xB1, 
+0xCD, 0xCA, 0xDA, 0x20, 0x53, 0x7A, 0xF5, 0xB2, 
+0x09, 0x28, 0x91, 0x6E, 0xC3, 0x5A, 0x36, 0xEB, 
+0xC0, 0x42, 0x16, 0x0F, 0x08, 0x07, 0x40, 0xC8, 
+0xF8, 0xD2, 0x13, 0x16, 0xF5, 0x42, 0x7D, 0x4A, 
+0xF7, 0x31, 0xFD, 0x16, 0x82, 0x78, 0xB0, 0xF5, 
+0x7D, 0xF3, 0xC5, 0x10, 0x26, 0x2D, 0xED, 0x3D, 
+0x2D, 0x4C, 0x2F, 0xA9, 0xBF, 0x9B, 0x86, 0x54, 
+0xEA, 0x28, 0xE5, 0xFF, 0xBB, 0x4F, 0x38, 0x87, 
+0xEA, 0x2D, 0x1F, 0x27, 0x9E, 0xDE, 0x22, 0xB7, 
+0x10, 0x5E, 
+
+0x71, 0xC2, 0xB4, 0xCB, 0x81, 0x0E, 0x2C, 0x06, 
+0x81, 0xFB, 0x06, 0xBF, 0x47, 0xD3, 0x7C, 0x5C, 
+0x57, 0xFA, 0x94, 0x26, 0x26, 0x4B, 0xB5, 0x4C, 
+0xC1, 0x4B, 0xA4, 0x33, 0xD9, 0x91, 0xF6, 0x95, 
+0x50, 0xBE, 0x46, 0x59, 0xE3, 0x0C, 0xEC, 0x47, 
+0xB0, 0xD7, 0x56, 0x4D, 0xF6, 0xBE, 0xE4, 0xF1, 
+0x81, 0xFF, 0xCB, 0x90, 0x26, 0xCF, 0x12, 0x42, 
+0x3B, 0x81, 0x04, 0x22, 0x0F, 0x42, 0x06, 0xBA, 
+0x6B, 0x9C, 0xC0, 0xE0, 0x29, 0xE2, 0x26, 0xEF, 
+0xC5, 0xCB, 0xFF, 0xB5, 0x55, 0x97, 0xEB, 0x81, 
+0x97, 0x88, 0xDA, 0x02, 0x13, 0xA7, 0xFA, 0x36, 
+0x9D, 0xDD, 0x7C, 0x38, 0xEE, 0xE9, 0xD7, 0xC9, 
+0xD1, 0x80, 0xA9, 0x52, 0xD4, 0xF6, 0x5C, 0x1E, 
+0xEA, 0x29, 0xD8, 0x2E, 0x0F, 0x5E, 0x68, 0x2F, 
+0x93, 0xDF, 0x9B, 0xE1, 0x03, 0xAC, 0x1E, 0x01, 
+0xF8, 0x18, 0x80, 0x7F, 0x69, 0x69, 0x2A, 0x43, 
+0xD0, 0x9D, 0x9F, 0xC3, 0x13, 0xC3, 0x6D, 0xFA, 
+0x8A, 0x48, 0x9A, 0xBF, 0x2C, 0x03, 0x49, 0x68, 
+0x35, 0x4D, 0x64, 0xEA, 0x83, 0x4A, 0x37, 0x01, 
+0xC6, 0xCC, 0x93, 0x48, 0x90, 0x05, 0x89, 0x61, 
+0x1B, 0x94, 0x78, 0x87, 0x82, 0x9C, 0xF3, 0xB3, 
+0x35, 0xB6, 0x86, 0xEB, 0xB4, 0x8B, 0x87, 0x67, 
+0xE1, 0xA1, 0x7A, 0xB3, 0xA3, 0x2E, 0xEF, 0xA6, 
+0x8D, 0x77, 0x79, 0x5C, 0x81, 0x6E, 0x2E, 0x19, 
+0xF7, 0x60, 0x16, 0xE9, 0x43, 0x44, 0x0D, 0x48, 
+0x33, 0xD5, 0x86, 
+
+0x06, 0x70, 0xE0, 0xC9, 0x58, 0xAE, 0x4A, 0xD1, 
+0x42, 0x62, 0x75, 0xE2, 0x31, 0x2E, 0x7E, 0x54, 
+0x36, 0x6F, 0xC2, 0x97, 0xF2, 0xFB, 0x41, 0x3E, 
+0x25, 0x35, 0x7C, 0xD4, 0xC4, 0x14, 0xC8, 0xA1, 
+0x43, 0x3B, 0x40, 0x39, 0xF0, 0x5D, 0x2C, 0x4A, 
+0x43, 0x25, 0x4D, 0x0F, 0xD7, 0x59, 0x84, 0x5E, 
+0x7F, 0xF1, 0x47, 0x14, 0xE8, 0x4E, 0x1E, 0x69, 
+0x06, 0x1C, 0x67, 0xA3, 0x20, 0x0A, 0x81, 0x96, 
+0x35, 0xF6, 0x39, 0xE9, 0x91, 0xE9, 0xA8, 0x08, 
+0xEF, 0x12, 0xFC, 0xDD, 0x15, 0xD3, 0xB4, 0x32, 
+0xCD, 0x76, 0x1B, 0x6A, 0x1D, 0xD6, 0x09, 0x50, 
+0xBC, 0x73, 0x70, 0xC6, 0x17, 0xD0, 0xF0, 0xAE, 
+0x60, 0xA0, 0xD7, 0x9D, 0xBA, 0xFE, 0xFB, 0xDA, 
+0x19, 0x6F, 0xE3, 0xCE, 0x8E, 0xFC, 0xBB, 0x5B, 
+0xFD, 0x59, 0xAF, 0xF4, 0xD2, 0x11, 0xB1, 0x17, 
+0xB3, 0x0F, 0x2F, 0x24, 0x26, 0x1F, 0x91, 0x3C, 
+0x41, 0xD1, 0x72, 0xC5, 0x52, 0xE1, 0x61, 0x5D, 
+0x17, 0x3C, 0xFF, 0x8E, 0x4C, 0x27, 0xF5, 0x16, 
+0x40, 0x56, 0xB7, 0xB5, 0x0C, 0x8F, 0xE3, 0x19, 
+0xBE, 0x32, 0x27, 0x9D, 0x72, 0xE5, 0x88, 0x75, 
+0x2A, 0xBA, 0x87, 0x5B, 0x7E, 0x5E, 0x5A, 0x23, 
+0x5B, 0x68, 0xAB, 0x86, 0xA0, 0x2A, 0x97, 0xBA, 
+0x40, 0xF5, 0x9E, 0x26, 0x71, 0x6A, 0x3D, 0x99, 
+0x57, 0xCF, 0x18, 0xB8, 0x23, 0x09, 0xAF, 0x79, 
+0xBA, 0x78, 0x92, 0x4D, 0x4B, 0xC4, 0x93, 0x3D, 
+0x4B, 0x4F, 0x53, 0x78, 
+
+0x8F, 0xC5, 0xE1, 0xC0, 0xF6, 0x61, 0x26, 0x90, 
+0x6B, 0x53, 0xEA, 0xB3, 0xCA, 0xDA, 0xD9, 0x61, 
+0xCC, 0xC1, 0x6F, 0x34, 0xC8, 0x74, 0xAD, 0x57, 
+0x80, 0x7E, 0x95, 0x4E, 0xF4, 0xEC, 0x42, 0x68, 
+0x9E, 0x76, 0x08, 0x85, 0x2D, 0xBA, 0xE3, 0xF4, 
+0xB9, 0x26, 0x3A, 0x76, 0x30, 0xF5, 0x72, 0xB7, 
+0x89, 0x63, 0xC7, 0x7C, 0x96, 0x92, 0x95, 0xF0, 
+0x4D, 0x40, 0x54, 0xFF, 0x1B, 0xAC, 0x86, 0x99, 
+0x88, 0x73, 0x29, 0xD3, 0x1E, 0x1F, 0x08, 0x9A, 
+0x08, 0x02, 0xF4, 0x68, 0x36, 0xD7, 0x1F, 0x70, 
+0x1A, 0xE3, 0x04, 0x98, 0x7C, 0x89, 0xF1, 0xD2, 
+0xEF, 0x01, 0x87, 0xC1, 0x57, 0xC5, 0x1F, 0x63, 
+0x57, 0xDE, 0xB1, 0xC0, 0xD0, 0x57, 0x81, 0xDE, 
+0xD2, 0x05, 0xE1, 0x05, 0x5C, 0xF8, 0x4A, 0x45, 
+0x44, 0x86, 0x5E, 0xE3, 0xF9, 0x51, 0x76, 0x36, 
+0xB6, 0x31, 0xE2, 0xD4, 0xBC, 0xDA, 0x1F, 0xDC, 
+0x9C, 0x52, 0xBD, 0x72, 0x62, 0x8B, 0x4B, 0xCC, 
+0xB9, 0xA7, 0x0C, 0x37, 0xFA, 0x2E, 0xBD, 0x2A, 
+0xDD, 0xDE, 0x32, 0x28, 0x08, 0x93, 0xC7, 0x7A, 
+0x0D, 0x42, 0x06, 0xB0, 0xF4, 0xA1, 0xB6, 0xDA, 
+0x10, 0x06, 0xC6, 0x76, 0x86, 0x4E, 0x7C, 0x42, 
+0xAB, 0xEE, 0xDE, 0x4D, 0x4C, 0xBB, 0xA3, 0x39, 
+0x62, 0xA7, 0x1A, 0x61, 0xD3, 0x03, 0xE2, 0x4B, 
+0xBF, 0x21, 0x8F, 0x6C, 0xAC, 0xA6, 0x31, 0xDA, 
+0x93, 0x29, 0x94, 0xDA, 0x5F, 0x78, 0xB8, 0xB0, 
+0xDC, 0xB1, 0xCB, 0x67, 0xDE, 
+
+0x9B, 0x9E, 0xFF, 0x1F, 0xED, 0x2F, 0xFE, 0x30, 
+0xFE, 0x0B, 0x1F, 0x2A, 0x60, 0xCC, 0x10, 0xB5, 
+0x8C, 0xAB, 0x06, 0xE8, 0x32, 0x25, 0xB3, 0xF8, 
+0x9E, 0xEC, 0xBE, 0xE4, 0xCA, 0x24, 0xDB, 0x7A, 
+0xA6, 0x43, 0x1D, 0x49, 0x72, 0x32, 0x07, 0x46, 
+0xEA, 0xE5, 0xCB, 0x89, 0xCD, 0xB4, 0xFA, 0x9D, 
+0x4B, 0xE0, 0xAF, 0x9E, 0xB2, 0xEA, 0x4E, 0x12, 
+0xF0, 0xB2, 0x10, 0x17, 0xED, 0xD1, 0x9D, 0xB3, 
+0xCD, 0xD7, 0xEF, 0x41, 0xBC, 0x97, 0x89, 0x26, 
+0xFD, 0xD9, 0x5E, 0x89, 0x12, 0x1B, 0xDC, 0x7B, 
+0x34, 0xCF, 0xCE, 0x4D, 0x27, 0xC7, 0x0A, 0xA2, 
+0x81, 0x55, 0x68, 0xB3, 0xD1, 0x5A, 0x2F, 0x74, 
+0x41, 0x47, 0xE7, 0x97, 0xFB, 0xBA, 0xE8, 0x0C, 
+0xBC, 0x3C, 0xAE, 0xA9, 0x94, 0xA1, 0x2D, 0xFC, 
+0x14, 0x43, 0xEB, 0xB1, 0xDF, 0x86, 0xE5, 0xD5, 
+0x9D, 0x23, 0x31, 0x4F, 0xD6, 0x93, 0x80, 0x34, 
+0x14, 0xE1, 0x4C, 0x2D, 0x20, 0xC4, 0x17, 0x44, 
+0x24, 0xC6, 0xA3, 0x6E, 0xCA, 0xCF, 0x40, 0xF3, 
+0x29, 0x14, 0x4C, 0xCD, 0xE7, 0x06, 0x4E, 0xCD, 
+0xC5, 0xD4, 0x70, 0x4E, 0x3E, 0xD9, 0x74, 0x37, 
+0x48, 0xF3, 0xE1, 0xDD, 0x12, 0x41, 0x5E, 0xBE, 
+0x20, 0x7A, 0x6D, 0xD2, 0x21, 0x0A, 0x1D, 0xF1, 
+0x78, 0x80, 0x28, 0xE0, 0x46, 0x28, 0x2B, 0xCD, 
+0x58, 0xD8, 0x88, 0x39, 0xBD, 0x10, 0x9C, 0xBF, 
+0x08, 0x46, 0xE2, 0x1B, 0x67, 0x3A, 0x7B, 0xED, 
+0x43, 0x8E, 0xA0, 0x9B, 0xAE, 0x2F, 
+
+0xA3, 0xAA, 0x3F, 0xE5, 0x29, 0xE9, 0x75, 0x7E, 
+0x93, 0x8F, 0xD5, 0x38, 0x51, 0xA3, 0x0B, 0xA2, 
+0xBA, 0xB5, 0xCD, 0x8E, 0x9B, 0x22, 0x1D, 0xA6, 
+0xBA, 0x41, 0xF2, 0x43, 0x00, 0xA6, 0xD0, 0xDD, 
+0x4A, 0x6C, 0xBB, 0x7D, 0xEE, 0x84, 0x9D, 0x29, 
+0xF5, 0x47, 0x02, 0x3B, 0xEC, 0xD6, 0xEF, 0x9A, 
+0xA6, 0x46, 0x0B, 0xFA, 0xDB, 0x82, 0x5E, 0xCF, 
+0x32, 0x8B, 0x70, 0x9E, 0x2C, 0x40, 0x13, 0x0D, 
+0xF3, 0x2E, 0xA4, 0x80, 0x15, 0xD9, 0x19, 0xDC, 
+0x45, 0x5D, 0x82, 0x8C, 0xB7, 0x6A, 0x36, 0xD7, 
+0xEA, 0x90, 0x07, 0x9E, 0xAB, 0x2E, 0x42, 0x0E, 
+0xD0, 0x30, 0x94, 0xD5, 0x85, 0x2D, 0x51, 0x72, 
+0xFC, 0x11, 0xAB, 0x6A, 0x8B, 0x10, 0x28, 0x77, 
+0x15, 0xAC, 0x94, 0xD5, 0x4A, 0x63, 0xC0, 0x1F, 
+0x5A, 0x5A, 0x86, 0xED, 0xF0, 0x2A, 0x44, 0x71, 
+0xEB, 0x99, 0x4B, 0xD4, 0x2E, 0x49, 0x35, 0x93, 
+0x2C, 0xEF, 0x4D, 0xFF, 0xD1, 0x9B, 0x5F, 0x75, 
+0x56, 0x55, 0xC9, 0xF4, 0xC8, 0x50, 0xD4, 0xCD, 
+0x68, 0x31, 0xC9, 0x02, 0xED, 0xB1, 0x0F, 0x3B, 
+0x90, 0x44, 0x9E, 0x46, 0x89, 0x67, 0x2A, 0xFF, 
+0x45, 0x53, 0x0D, 0xA2, 0x16, 0xC8, 0xDD, 0x23, 
+0xA5, 0x03, 0x08, 0xF5, 0x61, 0x88, 0x2E, 0x08, 
+0x13, 0xCD, 0x1C, 0xF3, 0x53, 0x35, 0x65, 0xA4, 
+0xF4, 0xD4, 0x18, 0xE6, 0x7A, 0x55, 0x8B, 0x02, 
+0x4E, 0xBC, 0xB7, 0x71, 0xB8, 0xA7, 0x4A, 0x6F, 
+0x23, 0xA0, 0xB8, 0xA9, 0xD5, 0x1C, 0x3D, 
+
+0x0E, 0x2F, 0xFC, 0x3F, 0xFE, 0xD8, 0x1D, 0x3A, 
+0x51, 0x68, 0x16, 0x51, 0x15, 0xC1, 0x21, 0xC2, 
+0xAB, 0xEB, 0x2B, 0xBE, 0x73, 0x10, 0x72, 0x95, 
+0x0F, 0xFC, 0x5D, 0x95, 0x88, 0x2A, 0xA9, 0x7E, 
+0x1D, 0xCA, 0xFD, 0xEF, 0x2A, 0xEB, 0xE9, 0x37, 
+0xA2, 0x9D, 0x28, 0x67, 0xA4, 0x9F, 0xB5, 0xB4, 
+0x89, 0x0A, 0xDB, 0x9C, 0xD9, 0x24, 0xBA, 0xF6, 
+0x16, 0xF5, 0x50, 0x5B, 0x1A, 0xDA, 0x9C, 0xF2, 
+0x04, 0xF9, 0xF0, 0xE9, 0x0E, 0x13, 0xC8, 0x75, 
+0x94, 0xEB, 0x93, 0x1D, 0xA1, 0x0F, 0xBC, 0x4B, 
+0x38, 0x3D, 0xCE, 0x92, 0x45, 0xDF, 0x00, 0x8F, 
+0x78, 0x53, 0x60, 0xBA, 0x17, 0x83, 0xC0, 0x3F, 
+0xDA, 0x05, 0x39, 0x3E, 0x9A, 0x29, 0x72, 0x4B, 
+0x8D, 0xCD, 0x25, 0x12, 0x98, 0xA2, 0x56, 0x86, 
+0x08, 0x5F, 0x62, 0x0D, 0x42, 0x24, 0x67, 0x83, 
+0x15, 0x5D, 0x1A, 0x1E, 0xBA, 0x51, 0x95, 0xA4, 
+0xB8, 0x65, 0x49, 0xCF, 0x02, 0x06, 0x0F, 0xE2, 
+0x2C, 0x32, 0x92, 0x59, 0x7B, 0x6A, 0xC1, 0x0A, 
+0x0A, 0xAD, 0xAB, 0xFA, 0xCF, 0xC8, 0x1D, 0x46, 
+0xC2, 0xC9, 0x00, 0xA1, 0xED, 0xE2, 0x4D, 0x3B, 
+0xAD, 0xF4, 0x3B, 0x07, 0xBE, 0xB0, 0x23, 0x4F, 
+0x66, 0x56, 0x42, 0xD6, 0xA8, 0x51, 0xD8, 0xCD, 
+0xB4, 0x39, 0x28, 0x0A, 0x6D, 0xA7, 0xEF, 0x6F, 
+0x30, 0x64, 0xEA, 0xD6, 0xA5, 0x29, 0x80, 0x0E, 
+0x92, 0x89, 0xB7, 0xA3, 0x42, 0xA9, 0xCC, 0xF6, 
+0x87, 0xC2, 0x85, 0x47, 0xD4, 0xD9, 0xC2, 0x83, 
+
+0x17, 0xD5, 0x14, 0xE3, 0x71, 0x6B, 0x95, 0x63, 
+0x45, 0x47, 0x0E, 0xCA, 0xA0, 0x65, 0x06, 0xCC, 
+0x28, 0xA2, 0x09, 0x68, 0x5C, 0x91, 0x60, 0x16, 
+0xBC, 0xB0, 0x82, 0x4E, 0x6B, 0xAD, 0x0E, 0x07, 
+0xE7, 0xF4, 0x6F, 0x0E, 0x4D, 0xBE, 0x63, 0xAC, 
+0xB8, 0x0E, 0x45, 0x6F, 0xEC, 0x89, 0x81, 0xD0, 
+0x55, 0x8D, 0x7A, 0x1C, 0xBA, 0x2D, 0x4E, 0x48, 
+0xFA, 0x78, 0x5E, 0x94, 0xF0, 0x61, 0xB2, 0xDD, 
+0x43, 0xF9, 0xCA, 0xBF, 0x07, 0x25, 0x80, 0x17, 
+0x0B, 0xF1, 0x70, 0x1B, 0xAF, 0x0D, 0x15, 0x94, 
+0xEB, 0x2D, 0xE4, 0x8D, 0x65, 0x91, 0x59, 0x12, 
+0x77, 0xB7, 0xE0, 0xBC, 0xD7, 0xD2, 0x59, 0x8A, 
+0xD9, 0xF5, 0xE4, 0x20, 0x30, 0x75, 0xFB, 0xA7, 
+0x39, 0xC8, 0x95, 0xB9, 0xAE, 0x7A, 0xE5, 0xC8, 
+0xCD, 0xCC, 0xF5, 0xBA, 0x38, 0x0C, 0xB4, 0x08, 
+0x1C, 0x8B, 0xD7, 0x14, 0x3A, 0x57, 0xE4, 0xDD, 
+0x41, 0xD3, 0x89, 0x10, 0xC5, 0x1F, 0x74, 0xDF, 
+0x5F, 0xB5, 0x42, 0x41, 0x1A, 0xF7, 0xFE, 0xEA, 
+0xDD, 0xF1, 0x46, 0x95, 0x22, 0xA6, 0xC5, 0xD5, 
+0x60, 0x70, 0x66, 0x9F, 0x2F, 0x81, 0xF7, 0x27, 
+0x2E, 0x42, 0xAD, 0xCA, 0x9F, 0xB5, 0x45, 0xFB, 
+0xC2, 0x79, 0xF0, 0x5B, 0xBD, 0xB0, 0x65, 0x15, 
+0xE9, 0x6C, 0xCD, 0xEA, 0x6D, 0xF6, 0xF3, 0x5E, 
+0xBE, 0xA2, 0x4A, 0x2C, 0xDA, 0xD5, 0xF9, 0x7F, 
+0xBB, 0x36, 0x27, 0xD8, 0x36, 0xC2, 0x4A, 0x62, 
+0x5A, 0x1A, 0xAF, 0x58, 0x44, 0x01, 0x57, 0xA7, 
+0xC9, 
+
+0x47, 0x65, 0xEC, 0x24, 0x57, 0xC5, 0x74, 0xE1, 
+0x26, 0x5E, 0xC1, 0x56, 0x9A, 0xA5, 0xC3, 0x40, 
+0xA8, 0x0F, 0x33, 0x73, 0xBC, 0x98, 0x3B, 0x94, 
+0x75, 0x9A, 0xF4, 0xBC, 0xF0, 0xC0, 0xB7, 0x93, 
+0x39, 0xD2, 0xEE, 0x58, 0xC6, 0xCA, 0x0B, 0x0B, 
+0x65, 0x2B, 0x35, 0xF3, 0x7E, 0x9C, 0x9A, 0xD8, 
+0x09, 0x87, 0x64, 0x74, 0x1C, 0xE0, 0xE6, 0x02, 
+0xE1, 0x63, 0x8B, 0x15, 0xC0, 0x08, 0x74, 0xC5, 
+0xDC, 0x19, 0x9C, 0x67, 0xF1, 0xC6, 0xEA, 0x48, 
+0x9A, 0xC4, 0x81, 0xB3, 0xDE, 0x8F, 0x7D, 0x25, 
+0x3A, 0x1D, 0x75, 0xD8, 0x9F, 0xF1, 0x65, 0x13, 
+0xAD, 0xFC, 0x66, 0x2C, 0x33, 0x08, 0x84, 0x32, 
+0x7C, 0x98, 0x55, 0xFB, 0x05, 0x56, 0x54, 0xE9, 
+0x76, 0xC6, 0x45, 0x48, 0xB0, 0x8D, 0x89, 0xD9, 
+0xB7, 0xC5, 0x29, 0xEF, 0xAE, 0x7A, 0x71, 0x8F, 
+0xD8, 0xDB, 0xF7, 0xAF, 0x24, 0x1D, 0x2B, 0xE6, 
+0x02, 0x84, 0x8A, 0x10, 0x42, 0x28, 0xD3, 0x79, 
+0xF1, 0x05, 0x34, 0x82, 0x7A, 0x1B, 0xF5, 0x3B, 
+0x66, 0x86, 0xB7, 0x3C, 0x27, 0x55, 0xA7, 0x26, 
+0x4B, 0x25, 0x01, 0x0A, 0x77, 0x45, 0xEE, 0x78, 
+0x3D, 0xAD, 0x56, 0x13, 0xB8, 0x4A, 0x4E, 0x89, 
+0xBE, 0xD8, 0x35, 0xCC, 0xC1, 0x34, 0x69, 0x38, 
+0xA8, 0xBD, 0xE4, 0x73, 0x05, 0xDD, 0x4D, 0x14, 
+0xB4, 0x4A, 0x9E, 0x0D, 0x0B, 0xA9, 0x8F, 0xA7, 
+0xFB, 0xAD, 0x04, 0x96, 0xCC, 0x69, 0x12, 0x3F, 
+0xC5, 0xE4, 0x40, 0xB2, 0x5A, 0x88, 0x84, 0x81, 
+0x23, 0x76, 
+
+0x22, 0x6B, 0x41, 0x72, 0xB3, 0xAA, 0x0C, 0xED, 
+0x04, 0xC5, 0x1C, 0x18, 0x60, 0x6E, 0x81, 0x5D, 
+0xD5, 0xA4, 0x66, 0x67, 0xF6, 0xA5, 0x6B, 0xA5, 
+0xFF, 0x01, 0x93, 0x22, 0x75, 0x62, 0x80, 0x6E, 
+0x0D, 0x78, 0xA8, 0xD8, 0x6E, 0xDB, 0xAA, 0x2D, 
+0x55, 0x96, 0xD1, 0x62, 0x17, 0x0C, 0x9D, 0xDE, 
+0x8B, 0x1B, 0x02, 0xFA, 0xF0, 0xFB, 0xCC, 0x80, 
+0x79, 0x6E, 0x68, 0x35, 0xFF, 0x7F, 0xCF, 0x84, 
+0x12, 0xC9, 0x67, 0x5E, 0xC1, 0xEE, 0x36, 0x23, 
+0xD5, 0xCD, 0x14, 0x86, 0xC9, 0xE8, 0x6E, 0x7C, 
+0x56, 0x55, 0x7A, 0xF9, 0x3A, 0xFC, 0x99, 0xE0, 
+0xEE, 0x62, 0xFE, 0x48, 0xEC, 0x43, 0x3A, 0x77, 
+0x22, 0x27, 0x03, 0x80, 0x86, 0xC1, 0x27, 0x60, 
+0x7F, 0x89, 0xD4, 0x65, 0x9B, 0x50, 0x9D, 0x32, 
+0x17, 0xE2, 0x8E, 0x1E, 0xE7, 0x4C, 0xA2, 0x4F, 
+0xE2, 0xCD, 0xEB, 0xFE, 0x18, 0x8F, 0x3A, 0xB1, 
+0x94, 0x34, 0x07, 0x3D, 0x37, 0xB0, 0x45, 0x3E, 
+0x9A, 0xAF, 0xBC, 0xC2, 0xCB, 0x89, 0x78, 0xFB, 
+0x83, 0x51, 0xA6, 0xBE, 0xF7, 0x69, 0x18, 0x38, 
+0x79, 0xF4, 0x22, 0xDE, 0xD1, 0x60, 0x8C, 0x18, 
+0x52, 0xD5, 0x4E, 0x65, 0xC0, 0x0A, 0xEC, 0xD4, 
+0x1B, 0x34, 0xD1, 0x80, 0x40, 0xB3, 0x90, 0x8D, 
+0xF0, 0x41, 0xAA, 0x85, 0x31, 0x54, 0x9F, 0x10, 
+0xF3, 0x17, 0x4A, 0x8B, 0xFC, 0x3D, 0xA5, 0xD1, 
+0x4D, 0xC2, 0x18, 0x3E, 0xCC, 0x99, 0x74, 0x54, 
+0x85, 0x1D, 0xC1, 0x8D, 0xD4, 0xBF, 0x8D, 0x79, 
+0x2D, 0x73, 0x50, 
+
+0x83, 0x93, 0x7C, 0xD4, 0x20, 0x10, 0x01, 0x6B, 
+0x16, 0x27, 0xCD, 0x26, 0x1F, 0x02, 0x78, 0xA0, 
+0x51, 0x71, 0x94, 0x84, 0x65, 0x69, 0x4A, 0x59, 
+0x97, 0x29, 0xA4, 0x33, 0xC5, 0x5E, 0x24, 0xDB, 
+0x2F, 0xF1, 0x48, 0x52, 0xC7, 0x84, 0xB6, 0x5E, 
+0x93, 0x44, 0x6B, 0x83, 0x89, 0xB7, 0xED, 0x06, 
+0x05, 0x01, 0xF3, 0x72, 0xD9, 0xAF, 0x8A, 0xBE, 
+0xE1, 0x3B, 0xE4, 0x4F, 0x1D, 0x93, 0xC3, 0x90, 
+0x10, 0x8C, 0x27, 0xDC, 0xB8, 0xFE, 0x1C, 0x79, 
+0x07, 0xEE, 0x4A, 0x83, 0xAE, 0x1F, 0xA2, 0x2E, 
+0x2C, 0xF7, 0xE3, 0xA7, 0xE6, 0x4C, 0xD7, 0x39, 
+0x2C, 0xB1, 0xB0, 0xD9, 0xFE, 0x0C, 0x6A, 0xE4, 
+0xF3, 0xFB, 0xD2, 0xE2, 0x09, 0x78, 0x66, 0x84, 
+0xDB, 0xDB, 0xB5, 0x24, 0x9D, 0x9E, 0xAE, 0xAF, 
+0xE2, 0xE8, 0x29, 0x5D, 0x66, 0x8B, 0x9A, 0x23, 
+0x89, 0xDA, 0x30, 0xB0, 0xCD, 0x5D, 0x93, 0xDE, 
+0xB1, 0xAB, 0x5D, 0x29, 0xEF, 0x37, 0x76, 0x2C, 
+0x46, 0xFA, 0xBF, 0xB4, 0x98, 0xAF, 0xB4, 0xC5, 
+0x55, 0x81, 0xB9, 0xF2, 0xC8, 0x92, 0x51, 0x75, 
+0x8A, 0xDA, 0xAA, 0x43, 0x22, 0xC5, 0xCE, 0x6F, 
+0xAA, 0xE4, 0xA2, 0xFB, 0xD0, 0xF8, 0x7D, 0x0C, 
+0x7D, 0x5E, 0x7F, 0x5E, 0x43, 0xE3, 0x9C, 0xC1, 
+0x9A, 0xED, 0x65, 0xDE, 0x4F, 0xBD, 0xE2, 0xEA, 
+0xD0, 0x0B, 0x54, 0x41, 0x6D, 0x6B, 0x06, 0x0B, 
+0xFF, 0x53, 0xAA, 0xCF, 0x11, 0xE0, 0x58, 0x6A, 
+0xE3, 0x63, 0x83, 0xE6, 0x4D, 0xB5, 0x89, 0x2D, 
+0x28, 0x8D, 0xAF, 0x84, 
+
+0x9D, 0x5F, 0x01, 0xE7, 0x41, 0x19, 0x9E, 0x35, 
+0x76, 0xC8, 0x09, 0xD9, 0xC1, 0xD3, 0x3D, 0x92, 
+0xFA, 0x4A, 0xBB, 0xDA, 0x2E, 0x33, 0x72, 0xF3, 
+0xD4, 0x65, 0xB6, 0x02, 0x64, 0xC4, 0x20, 0xF8, 
+0xEA, 0x77, 0x41, 0x7C, 0x8A, 0xEA, 0x51, 0xED, 
+0xCD, 0x10, 0xA1, 0xC8, 0x50, 0xA5, 0x49, 0x94, 
+0x67, 0x3F, 0x01, 0x8F, 0x01, 0x65, 0x47, 0xF0, 
+0xE0, 0x4A, 0x39, 0xD8, 0x8F, 0x12, 0x3F, 0x70, 
+0x25, 0xDE, 0x4D, 0xEE, 0xF7, 0x26, 0xCD, 0x34, 
+0x2C, 0xB4, 0xE6, 0x70, 0x25, 0xA6, 0xF8, 0x63, 
+0x01, 0xD5, 0x29, 0xF9, 0x95, 0xF6, 0x42, 0x91, 
+0xFB, 0x88, 0x6C, 0xE8, 0x30, 0xBC, 0x41, 0x1E, 
+0xB9, 0xE9, 0x85, 0x41, 0x15, 0x73, 0x60, 0xB1, 
+0x3B, 0x32, 0x72, 0x21, 0xC6, 0x5C, 0x52, 0x59, 
+0x16, 0xB7, 0x1D, 0xB1, 0x47, 0x8F, 0xDE, 0xBD, 
+0x2E, 0xFE, 0x6A, 0x39, 0x37, 0x5A, 0x33, 0xE8, 
+0x9B, 0x2E, 0xCB, 0x14, 0x9C, 0xE3, 0x16, 0x5C, 
+0xD1, 0x66, 0x74, 0xD5, 0x18, 0x42, 0x9A, 0x10, 
+0x90, 0xDD, 0xA7, 0x09, 0x04, 0x7D, 0xA9, 0xD4, 
+0x16, 0x45, 0xFC, 0x42, 0xD2, 0xB7, 0x87, 0x83, 
+0x7B, 0xBB, 0x66, 0x0C, 0xD9, 0xC3, 0xD6, 0x32, 
+0x69, 0xB2, 0x65, 0x5D, 0xA7, 0x8B, 0x93, 0xC7, 
+0xDC, 0xD1, 0x9D, 0xB4, 0xDC, 0x62, 0xFC, 0xCD, 
+0x0A, 0xBC, 0xC1, 0x9E, 0x4C, 0x2C, 0x1C, 0x6A, 
+0xFC, 0xD0, 0x27, 0x23, 0xC8, 0x2B, 0x0F, 0x98, 
+0x83, 0xCD, 0x45, 0xC0, 0x50, 0xBF, 0x41, 0xA8, 
+0x59, 0xA1, 0x93, 0x05, 0x21, 
+
+0xE0, 0x2D, 0x52, 0x9B, 0xAC, 0xA3, 0x78, 0xF4, 
+0x8D, 0x8D, 0xCA, 0xC1, 0xCF, 0xA1, 0x0F, 0xE0, 
+0x44, 0x22, 0x8C, 0x8E, 0xB5, 0xBB, 0xBB, 0xBF, 
+0x20, 0xA0, 0xCE, 0xE1, 0x00, 0xD7, 0xD3, 0x25, 
+0x21, 0xFE, 0x6F, 0x09, 0x1D, 0x1A, 0x8A, 0xA1, 
+0xEE, 0x85, 0xD1, 0x1F, 0xF5, 0xFF, 0xB5, 0x37, 
+0x46, 0xA4, 0x17, 0xDE, 0xA0, 0xAA, 0xA6, 0x34, 
+0x4D, 0x42, 0x07, 0xD9, 0x76, 0x38, 0xB5, 0x7C, 
+0xFA, 0xA4, 0x1B, 0x6A, 0xBC, 0xAA, 0x9F, 0x8B, 
+0x64, 0x2B, 0x4F, 0x45, 0x84, 0x16, 0x90, 0xDC, 
+0xA6, 0x9A, 0x8D, 0x79, 0x2B, 0x80, 0x6A, 0x0D, 
+0x2D, 0xB1, 0xCF, 0x33, 0x43, 0xDC, 0x6B, 0xBD, 
+0x55, 0xA7, 0x64, 0x81, 0x35, 0x40, 0xBC, 0x3A, 
+0x66, 0x04, 0x00, 0x27, 0x18, 0xDD, 0x9B, 0xA2, 
+0x65, 0x09, 0x0C, 0x08, 0x2E, 0x13, 0xA8, 0x02, 
+0x57, 0x08, 0x0E, 0x82, 0x2C, 0x73, 0xE7, 0xB0, 
+0x02, 0xFD, 0x1F, 0xD0, 0x98, 0x03, 0x8B, 0x70, 
+0x87, 0x55, 0x73, 0x57, 0xA5, 0x10, 0xFD, 0xF8, 
+0xCE, 0x39, 0xC8, 0x6F, 0xA1, 0x20, 0xF9, 0x87, 
+0x9B, 0x3A, 0x75, 0x26, 0xFC, 0x22, 0x53, 0xF5, 
+0xA2, 0xBC, 0x79, 0x8E, 0x46, 0xDE, 0x14, 0xCB, 
+0xB7, 0x07, 0x35, 0xFB, 0x42, 0xAD, 0xC3, 0x4D, 
+0xAE, 0xE0, 0x15, 0x77, 0x5E, 0x9D, 0xB3, 0x13, 
+0xD1, 0xDA, 0x94, 0x0C, 0xD1, 0x92, 0x66, 0x6F, 
+0x8A, 0x68, 0x7E, 0xA8, 0xE9, 0xB4, 0xD7, 0x3A, 
+0x82, 0x90, 0x1E, 0xDC, 0x4B, 0x06, 0x2E, 0x9E, 
+0x3F, 0x7A, 0x7E, 0x67, 0xEF, 0xE1, 
+
+0x28, 0x60, 0xB2, 0xA6, 0x6E, 0x4F, 0xF8, 0x13, 
+0xC3, 0xFF, 0xE5, 0x0E, 0xF0, 0x2C, 0x4A, 0x6A, 
+0xC3, 0xE4, 0x9D, 0x62, 0xE4, 0x4B, 0x76, 0x4A, 
+0x0D, 0x8F, 0x58, 0x19, 0xDF, 0x90, 0xE2, 0x68, 
+0x34, 0xE3, 0x01, 0x17, 0xA9, 0x2B, 0xC9, 0x31, 
+0xEB, 0x0A, 0x89, 0xB9, 0xF9, 0x20, 0x7A, 0xDD, 
+0xFC, 0x5B, 0x73, 0x57, 0xFF, 0xEA, 0xA0, 0x57, 
+0x4F, 0x28, 0x79, 0x0B, 0xFF, 0xCA, 0x45, 0xB9, 
+0xAA, 0xEB, 0x01, 0x3E, 0x9E, 0xF9, 0x2C, 0xC9, 
+0x96, 0x8A, 0xF9, 0x3A, 0x1A, 0x61, 0x70, 0xD4, 
+0x1D, 0xD3, 0xC9, 0xB0, 0x6C, 0x88, 0x96, 0x4F, 
+0xC6, 0xF1, 0xD1, 0x02, 0x16, 0x59, 0xCC, 0xCB, 
+0xC6, 0xCD, 0xD2, 0xF5, 0xEB, 0x39, 0x30, 0xC1, 
+0xF1, 0xC6, 0x5E, 0x7F, 0xE6, 0xA8, 0x85, 0x9B, 
+0x64, 0x78, 0x9D, 0xFA, 0x6B, 0x7C, 0x48, 0x50, 
+0x76, 0xBC, 0x91, 0xE2, 0x97, 0x16, 0x47, 0x14, 
+0x41, 0x81, 0xFB, 0xA7, 0x33, 0x15, 0x54, 0x50, 
+0x1F, 0x50, 0x1E, 0x92, 0xEE, 0x2C, 0xD4, 0xB8, 
+0xC1, 0xBA, 0xFE, 0x50, 0xF0, 0x4F, 0x07, 0xFC, 
+0xC2, 0x8E, 0xD7, 0xDA, 0x04, 0xF7, 0x9F, 0x7E, 
+0x61, 0x35, 0xA6, 0x70, 0xEE, 0xC4, 0x53, 0x16, 
+0x1C, 0x54, 0x64, 0x32, 0xC7, 0xDC, 0xE2, 0x0B, 
+0x7A, 0x6E, 0x08, 0xFB, 0x25, 0x4E, 0xA4, 0x49, 
+0xE2, 0x05, 0xA8, 0x4B, 0x9F, 0xF4, 0xF3, 0x26, 
+0xB7, 0x68, 0xE5, 0x3F, 0xB5, 0xB2, 0xCD, 0x09, 
+0x03, 0x37, 0x9C, 0xCE, 0x2D, 0x8A, 0x24, 0xF3, 
+0x43, 0xA2, 0xA4, 0xBC, 0x3C, 0x4E, 0x9F, 
+
+0x0F, 0x7E, 0x37, 0xD7, 0xD1, 0xFA, 0x4A, 0x0F, 
+0xF4, 0x1B, 0x46, 0xCC, 0x78, 0xBC, 0x3C, 0x7D, 
+0xFF, 0x77, 0x62, 0x18, 0x21, 0xB8, 0xEA, 0x82, 
+0x8F, 0xF0, 0x4C, 0xFB, 0x52, 0x83, 0x37, 0x80, 
+0xE9, 0x0F, 0x12, 0x82, 0x7C, 0x1B, 0x9B, 0x9B, 
+0xBE, 0x49, 0x07, 0x59, 0x1E, 0x15, 0xD2, 0xA4, 
+0x8C, 0x3F, 0x81, 0x76, 0x96, 0x38, 0x05, 0x56, 
+0x9B, 0x8A, 0xC1, 0x60, 0x62, 0xB0, 0x46, 0xAE, 
+0xE4, 0x08, 0x42, 0x60, 0x74, 0xE9, 0x64, 0xED, 
+0x47, 0x6E, 0xF4, 0xD8, 0x57, 0x25, 0x45, 0x96, 
+0xF6, 0x1C, 0x60, 0x26, 0xF4, 0xB7, 0xD8, 0x30, 
+0x93, 0x47, 0x03, 0x13, 0x3C, 0xE0, 0x7D, 0x96, 
+0xA8, 0xF5, 0xBA, 0x42, 0xF5, 0x6A, 0x16, 0x0D, 
+0x91, 0x1D, 0xAC, 0x57, 0xD1, 0x94, 0x8F, 0xCC, 
+0xDC, 0xB1, 0x17, 0x02, 0x94, 0x68, 0x17, 0x59, 
+0xCC, 0x8C, 0x97, 0x15, 0x51, 0x84, 0x41, 0xD3, 
+0xE9, 0xA9, 0xD6, 0x13, 0xB9, 0x39, 0xF5, 0x64, 
+0x89, 0xD1, 0x5C, 0x96, 0xFC, 0xBD, 0x3D, 0x73, 
+0x2D, 0x5E, 0x23, 0x34, 0x4A, 0x0E, 0xC5, 0xBE, 
+0xF8, 0x48, 0x0B, 0x78, 0x7B, 0xF3, 0xAE, 0x2C, 
+0x25, 0x5F, 0xF0, 0x92, 0x80, 0xC7, 0x30, 0xFE, 
+0xE4, 0x66, 0x6E, 0x35, 0xE6, 0x62, 0x46, 0x45, 
+0x64, 0x35, 0x31, 0x7F, 0x55, 0x21, 0x88, 0x73, 
+0x7C, 0x20, 0x7B, 0x96, 0xEF, 0x8E, 0xBB, 0xC6, 
+0x10, 0x4C, 0x41, 0xFF, 0x62, 0x96, 0xEE, 0xB5, 
+0x88, 0x7D, 0x33, 0xD0, 0xDA, 0xDE, 0x23, 0xB5, 
+0xE1, 0x7A, 0x7C, 0xAC, 0x98, 0xE7, 0xB0, 0xBB, 
+
+0xD4, 0x8A, 0x00, 0xD5, 0x1D, 0xD6, 0x4A, 0xC0, 
+0x9A, 0x87, 0x8A, 0x05, 0xEC, 0x91, 0x5F, 0x1E, 
+0x7D, 0xD5, 0x5F, 0x09, 0xEE, 0x5B, 0x8E, 0x39, 
+0xA7, 0x97, 0x50, 0x0B, 0xC9, 0x97, 0x1B, 0x10, 
+0x14, 0x7A, 0xBB, 0x50, 0xFE, 0xB3, 0x6E, 0x56, 
+0x79, 0x38, 0xF7, 0xC0, 0x07, 0x4E, 0x65, 0x64, 
+0x91, 0x24, 0x83, 0xB6, 0xF9, 0x61, 0x62, 0xDD, 
+0x18, 0x43, 0x29, 0xEC, 0x7A, 0x78, 0xB8, 0x7B, 
+0xF0, 0xFB, 0x07, 0x20, 0x82, 0x05, 0x33, 0xF9, 
+0x97, 0x39, 0x45, 0x04, 0x41, 0x18, 0xAD, 0xFD, 
+0x9B, 0xEA, 0x33, 0x5F, 0xD2, 0x46, 0xE2, 0xEE, 
+0x36, 0x14, 0x60, 0x73, 0xFA, 0x57, 0x81, 0xD1, 
+0x85, 0xE7, 0xC1, 0x1C, 0xF3, 0xDD, 0x8D, 0x0C, 
+0x64, 0x92, 0x0C, 0xE1, 0xA5, 0x52, 0x44, 0x2A, 
+0x47, 0x5B, 0xCF, 0x11, 0x88, 0xB0, 0xE1, 0xE8, 
+0xD9, 0x1C, 0x48, 0x6C, 0x1D, 0xB7, 0x2D, 0xC9, 
+0xF2, 0x07, 0x95, 0x66, 0xB0, 0xEE, 0xFD, 0xBC, 
+0x43, 0x5D, 0xA7, 0xE3, 0x6C, 0x07, 0xD2, 0x66, 
+0x67, 0x7F, 0x62, 0xD6, 0xE0, 0xC5, 0x0A, 0x43, 
+0xB0, 0x0B, 0x25, 0x1E, 0x05, 0x0A, 0x77, 0x4E, 
+0x7B, 0x99, 0x94, 0xF6, 0x54, 0x17, 0x8C, 0xBA, 
+0x0C, 0xEC, 0x30, 0xA8, 0xA9, 0x78, 0xAF, 0x56, 
+0x6C, 0x14, 0xD7, 0x5A, 0xF7, 0x4C, 0x88, 0x8A, 
+0x6E, 0x9A, 0xBD, 0x7C, 0x13, 0x2B, 0x51, 0xD8, 
+0x9A, 0xB3, 0x42, 0xDF, 0xB6, 0xDE, 0x59, 0xFD, 
+0xB2, 0x01, 0xFD, 0x73, 0x67, 0x7A, 0xD2, 0x56, 
+0x8C, 0x37, 0x8E, 0x91, 0xD7, 0x01, 0x09, 0x5B, 
+0xA4, 
+
+0x5F, 0xB3, 0x3A, 0x3E, 0x30, 0x12, 0x3F, 0x2A, 
+0xB1, 0x4D, 0x09, 0x2D, 0x27, 0x0D, 0xC7, 0xBC, 
+0x28, 0x29, 0x16, 0x73, 0xB6, 0x9E, 0xE1, 0xAC, 
+0x40, 0xA8, 0x19, 0xE5, 0x9D, 0x6A, 0x95, 0xC2, 
+0xAD, 0x02, 0x5D, 0x8A, 0xC4, 0x51, 0x9F, 0x68, 
+0x73, 0xC6, 0x49, 0x29, 0xBA, 0x6C, 0x47, 0xD6, 
+0xE9, 0x9C, 0xAE, 0x15, 0x1A, 0xDB, 0x10, 0x74, 
+0xBA, 0x49, 0x71, 0x83, 0x17, 0xD5, 0xD2, 0xE6, 
+0x44, 0xBE, 0x73, 0x00, 0x8F, 0xF7, 0x6E, 0x93, 
+0xE2, 0x14, 0xFD, 0xBE, 0x11, 0x50, 0x25, 0x35, 
+0x79, 0x47, 0x1C, 0x56, 0x55, 0xBE, 0x5A, 0x27, 
+0x54, 0xE7, 0xFC, 0x30, 0x8B, 0xFD, 0xCB, 0x5E, 
+0xF3, 0xC9, 0x44, 0x55, 0xE8, 0x49, 0x3A, 0xAE, 
+0xEE, 0x5C, 0xCE, 0x88, 0x05, 0xEF, 0x0C, 0x71, 
+0x71, 0x0A, 0x13, 0xD3, 0x26, 0xB9, 0x2F, 0x62, 
+0x05, 0x43, 0xDF, 0x69, 0xEA, 0x6B, 0xF9, 0xFB, 
+0x05, 0x87, 0x9F, 0x5E, 0x93, 0x7B, 0x26, 0x06, 
+0xF3, 0xEB, 0x3B, 0x8B, 0x70, 0xE9, 0xA5, 0x87, 
+0xFF, 0xD4, 0x31, 0x02, 0x0E, 0x43, 0x16, 0x6E, 
+0x47, 0x42, 0xA8, 0x93, 0xE2, 0xF7, 0xDA, 0xE7, 
+0xC1, 0x9A, 0xC7, 0xD6, 0xF2, 0x62, 0xCD, 0x18, 
+0x86, 0x92, 0x81, 0xE4, 0x51, 0xAF, 0xCF, 0x43, 
+0x06, 0x94, 0xEE, 0x39, 0x4C, 0xAF, 0x30, 0x88, 
+0xDC, 0xA6, 0x35, 0x9B, 0x7D, 0xFF, 0x2A, 0x8A, 
+0x23, 0xC2, 0x8C, 0xD6, 0x2C, 0x81, 0x00, 0x7F, 
+0x62, 0x0F, 0xF1, 0x8F, 0xAF, 0x51, 0x65, 0xD3, 
+0x53, 0xBA, 0x67, 0x06, 0xAE, 0x4B, 0xBC, 0x60, 
+0x96, 0x8B, 
+
+0xA6, 0xBD, 0xD4, 0x5E, 0xB9, 0x28, 0xA0, 0x23, 
+0xF5, 0x6E, 0x63, 0xA7, 0x9B, 0x74, 0xDF, 0xFD, 
+0x39, 0x2A, 0xA7, 0x9C, 0x7A, 0x8E, 0x1D, 0x3D, 
+0xB4, 0x0F, 0xAF, 0xF3, 0xBD, 0xDF, 0x61, 0xFA, 
+0xBE, 0xF1, 0x2C, 0xC4, 0x33, 0x69, 0x6E, 0x75, 
+0xF4, 0x54, 0x3F, 0x72, 0x97, 0xE9, 0xCD, 0xF7, 
+0x6A, 0xAE, 0x41, 0x26, 0x08, 0x7F, 0xCC, 0x80, 
+0x6E, 0xB1, 0x39, 0xA7, 0xF9, 0x15, 0x10, 0x3A, 
+0xBE, 0x14, 0xC3, 0x3D, 0x35, 0xDF, 0x20, 0x05, 
+0x95, 0x02, 0xD5, 0x47, 0xC5, 0xBA, 0x26, 0x3D, 
+0xA2, 0x99, 0x06, 0x5D, 0x73, 0x93, 0x88, 0xA2, 
+0x57, 0x9D, 0xCD, 0xB2, 0x7D, 0x1C, 0x25, 0xBF, 
+0x8D, 0x13, 0xA3, 0xC3, 0xE2, 0x00, 0x78, 0x17, 
+0x2A, 0xD8, 0x32, 0xD8, 0x22, 0x63, 0xFC, 0x76, 
+0x41, 0xDE, 0xE4, 0x78, 0x90, 0x54, 0x17, 0x85, 
+0xE7, 0x4A, 0x89, 0xF7, 0xA7, 0x58, 0x6A, 0x7A, 
+0xFF, 0x2F, 0x3A, 0x91, 0x2B, 0xA4, 0x99, 0xA2, 
+0x62, 0xC7, 0xC4, 0x62, 0xEF, 0xD3, 0x65, 0xC6, 
+0xCC, 0x91, 0xD6, 0x05, 0xE2, 0x61, 0x50, 0x0E, 
+0xC7, 0x1B, 0xD8, 0xAF, 0xF6, 0x00, 0x8F, 0x18, 
+0x6E, 0x24, 0xC7, 0x81, 0x15, 0x5E, 0x81, 0x05, 
+0x42, 0xB9, 0x95, 0xF8, 0x7F, 0xD6, 0x38, 0x79, 
+0x2E, 0xD3, 0xE5, 0xEC, 0x85, 0xB4, 0xB8, 0x11, 
+0x8D, 0xA9, 0x44, 0x7C, 0xEF, 0x5A, 0xA3, 0x57, 
+0xA5, 0x0E, 0x85, 0xC6, 0xDB, 0x78, 0x17, 0x04, 
+0xC6, 0xB3, 0x22, 0x7A, 0x4D, 0x01, 0xF0, 0xA0, 
+0x5F, 0xC8, 0x79, 0x41, 0xCC, 0x22, 0x7B, 0x02, 
+0xAF, 0x28, 0xAB, 
+
+0xD3, 0xB7, 0x22, 0x84, 0xC0, 0x01, 0x61, 0x79, 
+0x54, 0x22, 0x20, 0x4B, 0xD6, 0x67, 0x6B, 0xEA, 
+0xA6, 0xAB, 0x6A, 0xC6, 0x22, 0x26, 0x83, 0x7B, 
+0xD4, 0xB8, 0x0B, 0xA0, 0xD6, 0xC0, 0xE0, 0x7E, 
+0x07, 0xE9, 0xFE, 0xDB, 0x13, 0xCE, 0x88, 0x80, 
+0x55, 0x79, 0x4B, 0x1D, 0xFA, 0xF0, 0x30, 0x28, 
+0xA4, 0xE4, 0xEB, 0x46, 0x57, 0x7C, 0xDA, 0x54, 
+0xF6, 0xC5, 0x5A, 0x04, 0x13, 0x15, 0x37, 0x89, 
+0xB4, 0x09, 0xF5, 0x58, 0x7A, 0x98, 0x52, 0xA4, 
+0x70, 0x02, 0x29, 0x06, 0x4F, 0x75, 0x20, 0x52, 
+0xDF, 0x66, 0x7B, 0xE3, 0x25, 0xD8, 0x0E, 0x01, 
+0xF6, 0x72, 0xD9, 0x63, 0x52, 0x26, 0x3F, 0x74, 
+0x2C, 0x88, 0x33, 0xF3, 0x10, 0x51, 0x03, 0x94, 
+0xE5, 0xBC, 0xA0, 0x2B, 0xF7, 0xD4, 0x4F, 0xF6, 
+0x26, 0x10, 0x19, 0x79, 0xFB, 0x17, 0x52, 0x0D, 
+0xD5, 0x81, 0xDE, 0xB6, 0x24, 0x42, 0x88, 0xF9, 
+0x96, 0x9E, 0x87, 0x54, 0x5C, 0x86, 0xF1, 0x1A, 
+0x78, 0x3A, 0xFF, 0xBA, 0xC2, 0x21, 0x86, 0x25, 
+0x45, 0xE9, 0x0D, 0x6E, 0xF6, 0xBC, 0xB6, 0xC6, 
+0x81, 0x5B, 0x51, 0x3E, 0xC2, 0xE0, 0x8C, 0x5F, 
+0xD3, 0x27, 0x07, 0x4E, 0x8A, 0x1F, 0x01, 0xE5, 
+0x3A, 0x4C, 0xEF, 0x22, 0x22, 0xB3, 0x79, 0x3D, 
+0xA9, 0x13, 0xC2, 0x66, 0xC7, 0x4F, 0xD0, 0x67, 
+0xCB, 0x80, 0x82, 0x13, 0xE9, 0x2A, 0x7E, 0x78, 
+0xB6, 0x30, 0xAF, 0x27, 0xCB, 0x28, 0xF2, 0x8D, 
+0x71, 0x3B, 0xF1, 0x9D, 0x20, 0x83, 0x96, 0xC7, 
+0x81, 0x15, 0x80, 0x37, 0x9A, 0x29, 0xBC, 0x0D, 
+0x50, 0x5F, 0x1A, 0x26, 
+
+0xB7, 0xDC, 0xBC, 0xC1, 0xF8, 0x2E, 0x9D, 0xD1, 
+0x35, 0xC8, 0xFD, 0xD7, 0xCD, 0x63, 0xD3, 0xA0, 
+0x4D, 0xC6, 0xC6, 0xDA, 0x10, 0x8E, 0x16, 0x7C, 
+0x17, 0xCB, 0x24, 0xBB, 0x71, 0xA1, 0xC9, 0x11, 
+0x32, 0x63, 0xA4, 0x39, 0x62, 0xEF, 0xB7, 0x25, 
+0x4E, 0xCE, 0xCA, 0x62, 0xD1, 0x6B, 0x2A, 0x12, 
+0x37, 0xD8, 0xE2, 0xF6, 0xCE, 0x28, 0x70, 0x71, 
+0x19, 0x00, 0x12, 0x9A, 0xFD, 0x8E, 0xC0, 0x5A, 
+0xB2, 0x31, 0xB2, 0x58, 0x0B, 0x37, 0x28, 0x25, 
+0x27, 0x5F, 0xB4, 0x73, 0x91, 0x1D, 0x60, 0x66, 
+0xB0, 0x2D, 0xC4, 0x0E, 0x33, 0x02, 0x57, 0x9A, 
+0x45, 0x46, 0x18, 0xC2, 0x39, 0x64, 0x05, 0x0B, 
+0xD1, 0x85, 0xC4, 0x3C, 0x27, 0xF0, 0x1B, 0x42, 
+0x63, 0x5C, 0x7A, 0xD7, 0x12, 0xD5, 0xB8, 0xC1, 
+0x02, 0xE0, 0xC6, 0xED, 0x4F, 0x34, 0x17, 0xEE, 
+0x6E, 0x9A, 0x82, 0xB0, 0x71, 0x8F, 0x4B, 0xAA, 
+0xB9, 0x24, 0x95, 0x27, 0x32, 0xA2, 0xF4, 0xCC, 
+0x05, 0xAA, 0xBE, 0x2D, 0xD0, 0x8A, 0x49, 0x0A, 
+0x3A, 0x4B, 0x0F, 0x3E, 0x5D, 0x46, 0x21, 0xB8, 
+0x53, 0xFA, 0x22, 0x56, 0xFD, 0x41, 0xE9, 0x99, 
+0x9F, 0xEB, 0x29, 0xAC, 0xBA, 0xCE, 0x38, 0x7F, 
+0x00, 0x63, 0xF1, 0x42, 0x6C, 0xDD, 0xD5, 0xBB, 
+0x84, 0x12, 0x92, 0x08, 0x89, 0x01, 0x8F, 0x00, 
+0x0B, 0x8C, 0x2D, 0xB2, 0x3C, 0x4F, 0x12, 0xE7, 
+0x2A, 0x77, 0xAE, 0x43, 0xA7, 0x2E, 0x62, 0xBF, 
+0x29, 0x6F, 0x4A, 0xE2, 0x7A, 0xDE, 0x5B, 0x67, 
+0x71, 0x66, 0x31, 0x5F, 0x0E, 0x78, 0x81, 0xE8, 
+0xEB, 0x61, 0x34, 0x92, 0x87, 
+
+0x1D, 0x18, 0xAE, 0xCC, 0xE2, 0x16, 0x87, 0xE0, 
+0xE5, 0xF3, 0x52, 0x2B, 0x5B, 0xA4, 0xA3, 0x72, 
+0xD8, 0x15, 0x8C, 0xAF, 0x66, 0x52, 0x5D, 0x82, 
+0x6F, 0xFB, 0x3E, 0x37, 0x6C, 0x0C, 0xD8, 0x2F, 
+0x3C, 0x3E, 0x32, 0x58, 0xFA, 0xED, 0x9B, 0xD3, 
+0xC5, 0xC4, 0x29, 0x4D, 0xF9, 0x8D, 0xD6, 0xB8, 
+0x98, 0x33, 0xD2, 0x12, 0x2B, 0xD0, 0x82, 0x57, 
+0x22, 0x05, 0x76, 0x3E, 0xF8, 0x19, 0xFB, 0x06, 
+0x0E, 0x78, 0x70, 0x89, 0x22, 0xDA, 0x98, 0x10, 
+0x2F, 0xE3, 0x02, 0x00, 0x2C, 0xF0, 0xF8, 0xB9, 
+0xF2, 0x67, 0x77, 0x81, 0x20, 0x18, 0xB3, 0xB4, 
+0xC5, 0x57, 0x4B, 0x86, 0xFF, 0x99, 0x47, 0x39, 
+0xE8, 0x5E, 0xE1, 0x8E, 0xC6, 0xD4, 0xB6, 0x64, 
+0x43, 0xBD, 0x6C, 0x69, 0x9C, 0xFE, 0xD6, 0x45, 
+0xAE, 0x39, 0x92, 0x19, 0x13, 0xD6, 0xF6, 0x3C, 
+0xAC, 0x04, 0xB2, 0x26, 0xE3, 0x51, 0x12, 0x3B, 
+0xDE, 0xE4, 0xC9, 0x37, 0xF7, 0xAB, 0xEC, 0xFE, 
+0xD8, 0x3F, 0xD7, 0x4A, 0xE5, 0xF8, 0x58, 0xC0, 
+0xBB, 0x2E, 0x01, 0xF2, 0xE8, 0x84, 0x4C, 0xFE, 
+0x1C, 0x38, 0xA7, 0xED, 0x02, 0x25, 0x63, 0x01, 
+0xD2, 0x09, 0xFB, 0x4A, 0x4B, 0x99, 0xA7, 0x5B, 
+0xF7, 0xCD, 0x5F, 0x1E, 0x3D, 0x0B, 0x12, 0xB4, 
+0x5F, 0xC0, 0xCE, 0x62, 0x8E, 0xE1, 0x06, 0x58, 
+0x18, 0xF3, 0xE7, 0x0B, 0xDF, 0x42, 0x80, 0x0B, 
+0x54, 0xEF, 0x65, 0x47, 0x82, 0xA6, 0x24, 0x9C, 
+0xDC, 0x55, 0xC8, 0xDE, 0x1B, 0x78, 0x9D, 0x37, 
+0x30, 0x2C, 0x3F, 0x95, 0x9B, 0x56, 0xDA, 0xA3, 
+0x93, 0xF3, 0x4D, 0x18, 0x10, 0xCF, 
+
+0x38, 0xF3, 0x98, 0xD9, 0xBA, 0xE4, 0xDA, 0x4D, 
+0x82, 0xE8, 0xA2, 0x4E, 0xB5, 0x65, 0x42, 0x3A, 
+0x8B, 0x37, 0x22, 0xCE, 0x91, 0xE0, 0x0F, 0x82, 
+0x5E, 0x9C, 0xC5, 0x09, 0xD2, 0x44, 0xCA, 0x46, 
+0xCD, 0xEB, 0xDD, 0xF7, 0x8E, 0xDC, 0xAE, 0xDD, 
+0x97, 0x0E, 0x12, 0x81, 0x6B, 0x87, 0xC3, 0x6B, 
+0xED, 0x49, 0x69, 0xC3, 0x9C, 0xFA, 0xA0, 0x45, 
+0x1E, 0xD1, 0x1D, 0x2F, 0x2E, 0xEE, 0x6C, 0x70, 
+0x9C, 0x9E, 0x62, 0x79, 0x1C, 0x39, 0x22, 0x5D, 
+0x8B, 0x9F, 0xB3, 0xE3, 0x89, 0x65, 0x51, 0x40, 
+0x00, 0xF4, 0x7A, 0x5C, 0x66, 0x67, 0x21, 0x58, 
+0x0A, 0x0D, 0xC6, 0x23, 0x54, 0xE5, 0xC6, 0xD7, 
+0x06, 0xCE, 0x47, 0xBB, 0xDC, 0xF3, 0xB3, 0x9F, 
+0x83, 0x87, 0xD4, 0xE0, 0x6F, 0x79, 0x4A, 0xF2, 
+0xA1, 0xD3, 0xE0, 0xF8, 0x02, 0x8C, 0x97, 0x86, 
+0x37, 0x9A, 0x5B, 0x0E, 0xDE, 0x1F, 0xC2, 0xE7, 
+0xF9, 0x79, 0xE6, 0xEF, 0x0B, 0x7B, 0x44, 0x26, 
+0x51, 0xA8, 0x71, 0xEB, 0x47, 0x94, 0x5A, 0x3C, 
+0x89, 0x5B, 0xEF, 0xFD, 0xC2, 0xEB, 0xA0, 0xE7, 
+0xEC, 0xE5, 0x74, 0xAE, 0xE3, 0x8B, 0x8F, 0x40, 
+0xF6, 0xA9, 0x24, 0xE4, 0xED, 0x38, 0x4C, 0x83, 
+0x28, 0xB5, 0x19, 0xE0, 0xCE, 0xAD, 0xC1, 0x40, 
+0xF3, 0x16, 0xC4, 0xF4, 0x1A, 0xAF, 0x30, 0xD5, 
+0xB2, 0xF8, 0xAE, 0x28, 0x3C, 0x9B, 0xF4, 0xF6, 
+0xF4, 0x38, 0x27, 0x6C, 0x39, 0x46, 0x72, 0xB3, 
+0xAC, 0x25, 0x5D, 0xF0, 0x1D, 0x74, 0x83, 0xC6, 
+0x19, 0x2B, 0x29, 0xDE, 0x75, 0x9E, 0xA3, 0x19, 
+0x14, 0x7B, 0xE7, 0x43, 0xF0, 0xF3, 0xC6, 
+
+0xC4, 0x38, 0x66, 0xD1, 0xDA, 0x42, 0x3F, 0x89, 
+0xCD, 0x37, 0x92, 0x3B, 0xDA, 0xAF, 0xB4, 0x5A, 
+0xC2, 0x23, 0x14, 0xF4, 0x3D, 0x2C, 0x27, 0x19, 
+0xA1, 0x3F, 0xA4, 0x89, 0xD3, 0x2C, 0x07, 0x1E, 
+0x74, 0x38, 0x81, 0x31, 0x75, 0x32, 0x8A, 0x7F, 
+0x1E, 0x56, 0x71, 0xF2, 0x5D, 0x87, 0x99, 0x4D, 
+0x98, 0x6F, 0xCE, 0x8B, 0x97, 0x43, 0xC4, 0x82, 
+0x06, 0x3D, 0x4E, 0x8A, 0xC1, 0xBF, 0x84, 0x5A, 
+0xEA, 0xAA, 0x3A, 0x8F, 0xCC, 0x7A, 0x6A, 0xFC, 
+0xE4, 0x8F, 0x92, 0x9F, 0x83, 0x7D, 0xB2, 0x9D, 
+0xC2, 0x18, 0x65, 0xE9, 0xFA, 0x60, 0x2D, 0x4B, 
+0x04, 0x23, 0xCD, 0x41, 0x33, 0x64, 0xD7, 0x90, 
+0x28, 0xA0, 0xAD, 0xE1, 0xF1, 0x3A, 0xE5, 0x72, 
+0x57, 0xE8, 0x80, 0xE5, 0xE1, 0x3C, 0x1A, 0xD0, 
+0x60, 0x63, 0xDA, 0x1A, 0xFD, 0x70, 0x55, 0xB5, 
+0xC8, 0xF2, 0x00, 0x38, 0xBF, 0xDA, 0x87, 0xFF, 
+0x24, 0xB2, 0xFA, 0x16, 0x80, 0x1A, 0xA9, 0x9B, 
+0xB4, 0x4A, 0xA6, 0x60, 0xBF, 0xE6, 0xBD, 0xE5, 
+0x29, 0xCE, 0x2B, 0xC4, 0x51, 0x1B, 0x24, 0x1F, 
+0x28, 0x57, 0xB1, 0xB2, 0xB2, 0x76, 0xEF, 0x83, 
+0x3E, 0xAB, 0x8E, 0x36, 0xAA, 0xF1, 0x55, 0xDD, 
+0x5F, 0xBC, 0x2E, 0x57, 0x50, 0x94, 0x41, 0xE5, 
+0x76, 0x8F, 0x0C, 0x41, 0x4C, 0xF5, 0x56, 0x67, 
+0xCF, 0x7A, 0x05, 0xDC, 0x31, 0x25, 0x4D, 0xFD, 
+0x98, 0xA5, 0xF9, 0xDD, 0x0F, 0xB7, 0xCF, 0x3F, 
+0x91, 0x8E, 0xC9, 0x50, 0xB2, 0xBE, 0xD3, 0x7F, 
+0xBA, 0x1A, 0x40, 0x00, 0x55, 0x2A, 0x9C, 0x06, 
+0x82, 0x04, 0x0C, 0x9A, 0xF9, 0x83, 0x2E, 0x3D, 
+
+0xE6, 0x13, 0xEE, 0x84, 0xF6, 0xB4, 0x9B, 0x39, 
+0x84, 0x24, 0x9E, 0x58, 0x17, 0xA6, 0xD3, 0x1E, 
+0x89, 0xA4, 0x0C, 0x13, 0x22, 0xEE, 0xC2, 0xEF, 
+0x4B, 0x8A, 0x67, 0x4D, 0x3C, 0xA3, 0x5E, 0xE1, 
+0xAF, 0x60, 0x75, 0x96, 0x19, 0x61, 0xB3, 0xE9, 
+0x06, 0x81, 0xF7, 0xE8, 0xAE, 0x65, 0xAD, 0xB8, 
+0xFB, 0x72, 0xA9, 0xDD, 0xE8, 0xC1, 0xFA, 0x5C, 
+0x25, 0x63, 0x2F, 0x20, 0xD2, 0x02, 0x85, 0xA1, 
+0xA5, 0x5E, 0x5D, 0x53, 0x15, 0x6F, 0x46, 0xC5, 
+0x1D, 0xBB, 0x54, 0x24, 0xF7, 0xE0, 0xF7, 0x77, 
+0xAB, 0xFE, 0x5B, 0xEB, 0x9F, 0x33, 0x42, 0x13, 
+0x3D, 0xFB, 0x2B, 0x2D, 0x43, 0x37, 0xF4, 0xD1, 
+0x30, 0x10, 0x22, 0x1C, 0x29, 0xFC, 0x4D, 0x1E, 
+0xC1, 0x1C, 0x6F, 0x9C, 0xE8, 0x84, 0xA0, 0x13, 
+0xD3, 0x92, 0x70, 0xB0, 0x11, 0x09, 0x5D, 0x6C, 
+0x5F, 0x47, 0x12, 0x7D, 0xC9, 0x5E, 0x6B, 0x94, 
+0xB2, 0x98, 0x41, 0xDA, 0x15, 0x72, 0xB6, 0xDA, 
+0x55, 0xB6, 0x39, 0x64, 0xF6, 0xC1, 0xB7, 0x69, 
+0x08, 0x98, 0xF9, 0xFF, 0xCD, 0x9B, 0xBE, 0xC5, 
+0x9A, 0xC1, 0xFD, 0x24, 0x9C, 0xB9, 0x7A, 0xB1, 
+0x3A, 0x28, 0x43, 0x3C, 0x76, 0x50, 0x04, 0xD8, 
+0xE4, 0xD1, 0xDE, 0x2D, 0x4D, 0x93, 0x4F, 0x25, 
+0x70, 0x6B, 0x61, 0x70, 0x76, 0x13, 0x79, 0xDA, 
+0xB9, 0x19, 0xE4, 0x22, 0xD3, 0xF0, 0xCA, 0xAE, 
+0xC5, 0xD2, 0xFF, 0xE3, 0xDE, 0xC4, 0x78, 0x86, 
+0x5F, 0x29, 0x6F, 0x84, 0xC6, 0x39, 0x44, 0x32, 
+0xCC, 0x0E, 0x9F, 0x96, 0x0B, 0xC5, 0x90, 0x55, 
+0xB6, 0x88, 0x93, 0x3D, 0x5B, 0xF2, 0x23, 0xE4, 
+0x62, 
+
+0x0F, 0x51, 0x4A, 0x0F, 0x79, 0xA0, 0x95, 0x0F, 
+0xE8, 0x02, 0xA0, 0x43, 0x5F, 0xE6, 0x2E, 0x9A, 
+0x6D, 0x0D, 0x29, 0xC6, 0x93, 0x1C, 0x07, 0x8C, 
+0x2B, 0x43, 0xFB, 0x0E, 0x12, 0xBD, 0x15, 0xF0, 
+0x58, 0x4A, 0x8D, 0x92, 0xE5, 0xF3, 0xDD, 0x61, 
+0x37, 0x7A, 0x17, 0x0B, 0xF3, 0x84, 0x9E, 0xA4, 
+0x39, 0x08, 0x1A, 0xC8, 0x7F, 0x95, 0x06, 0x53, 
+0x3F, 0xE4, 0xBE, 0xAC, 0x2E, 0x08, 0x3F, 0x76, 
+0x7E, 0x65, 0x24, 0x4B, 0xFF, 0x69, 0x62, 0x89, 
+0x65, 0xEA, 0xF6, 0x69, 0xE5, 0xD9, 0xE5, 0xFC, 
+0x54, 0x1B, 0xD8, 0x17, 0xBA, 0x5F, 0xF8, 0x61, 
+0x71, 0x97, 0x95, 0xA2, 0xC4, 0xF3, 0x6C, 0x48, 
+0x8B, 0x9C, 0x55, 0x2E, 0xC7, 0x59, 0xB1, 0x9E, 
+0xA6, 0x26, 0xB5, 0x30, 0x88, 0x57, 0xEE, 0xD1, 
+0x90, 0x3B, 0x58, 0x12, 0xF9, 0x11, 0xE6, 0x83, 
+0x57, 0x2B, 0x30, 0xDA, 0xED, 0x3B, 0x17, 0x38, 
+0xE2, 0xA9, 0xD2, 0x9A, 0x18, 0x0E, 0xCA, 0xFE, 
+0xE0, 0x0B, 0xA5, 0xAC, 0x0C, 0x8D, 0xD4, 0x37, 
+0x59, 0x3C, 0x11, 0x39, 0x9C, 0x3F, 0x44, 0xE4, 
+0x6F, 0x8D, 0xF5, 0x83, 0x4F, 0x26, 0xB9, 0x7A, 
+0x74, 0x96, 0x29, 0x08, 0x59, 0x7B, 0x4F, 0x53, 
+0xC3, 0x20, 0x5F, 0x76, 0xA0, 0x63, 0xF3, 0x88, 
+0x43, 0x09, 0xC1, 0x36, 0x4B, 0x76, 0x36, 0x73, 
+0x07, 0x32, 0xC0, 0xCE, 0x89, 0xE2, 0xF6, 0xF8, 
+0x96, 0x3A, 0xD4, 0xDC, 0x4D, 0x46, 0x43, 0xF5, 
+0x92, 0xA5, 0x41, 0xDC, 0x8B, 0xE4, 0x57, 0x42, 
+0xA9, 0x81, 0xE6, 0x0E, 0x3D, 0x01, 0x98, 0xF7, 
+0x76, 0xF8, 0x75, 0xF8, 0xF8, 0x65, 0x59, 0xD1, 
+0x4E, 0xF9, 
+
+0x87, 0x1F, 0xDE, 0xC7, 0xE1, 0x12, 0xBD, 0xFC, 
+0x37, 0x1E, 0x01, 0x99, 0xFE, 0xFC, 0xF5, 0x34, 
+0x5F, 0xE7, 0x52, 0x27, 0x5F, 0xA5, 0x16, 0x97, 
+0x27, 0x82, 0x51, 0x1D, 0xD0, 0x7B, 0x09, 0xFC, 
+0x7D, 0x3A, 0x38, 0x5E, 0xBA, 0x81, 0xB9, 0xD5, 
+0xAD, 0x6B, 0xCE, 0x32, 0x7B, 0xEA, 0x51, 0xBC, 
+0x33, 0x0D, 0xC5, 0xF8, 0x63, 0x08, 0x94, 0xED, 
+0x8A, 0x1D, 0xAA, 0xAE, 0x16, 0x32, 0x75, 0xA1, 
+0xAE, 0x41, 0xD8, 0x8C, 0x31, 0xEE, 0x03, 0xCF, 
+0x5A, 0x8D, 0x82, 0x8F, 0x2B, 0xE4, 0xBB, 0x78, 
+0x47, 0x5A, 0xE6, 0xDD, 0xCA, 0xEE, 0x60, 0x79, 
+0x60, 0xB7, 0x6E, 0x78, 0x2D, 0xE7, 0xDB, 0x0B, 
+0xE6, 0xE1, 0xED, 0x92, 0xDC, 0xA1, 0x88, 0x85, 
+0xCB, 0x25, 0x81, 0xDB, 0x72, 0xCA, 0x1D, 0x7A, 
+0xE2, 0x75, 0x37, 0x94, 0x8E, 0xD4, 0x56, 0xBD, 
+0x8B, 0x26, 0xAA, 0xF8, 0x4A, 0xC3, 0x46, 0x9D, 
+0x6C, 0x6B, 0x5F, 0x04, 0x8B, 0x27, 0xB5, 0x47, 
+0x36, 0xE7, 0x9D, 0x2F, 0x3C, 0xA4, 0x2C, 0xA7, 
+0xE5, 0x1E, 0xE8, 0x29, 0xBB, 0x04, 0x05, 0x33, 
+0x3E, 0x5F, 0x76, 0xE5, 0x12, 0xA0, 0x56, 0x0C, 
+0x15, 0x20, 0x14, 0x4C, 0x81, 0x4B, 0x8A, 0xB1, 
+0x2C, 0x43, 0x1A, 0x27, 0x60, 0x52, 0x21, 0xA5, 
+0x8F, 0xF2, 0x17, 0x5A, 0xE4, 0xE3, 0xB2, 0x2F, 
+0x6D, 0x37, 0x18, 0xFA, 0xDA, 0x24, 0xFF, 0x54, 
+0x81, 0x96, 0x57, 0x39, 0x1D, 0x5C, 0xBA, 0x6F, 
+0xC4, 0x3E, 0xB4, 0x95, 0x47, 0x86, 0x03, 0x79, 
+0x1C, 0xA1, 0xB6, 0xB3, 0xD0, 0x29, 0x38, 0x85, 
+0x70, 0xB5, 0x18, 0x36, 0x01, 0x7C, 0x1B, 0x60, 
+0x43, 0x6C, 0x3F, 
+
+0xB8, 0x23, 0x3A, 0xEB, 0xAA, 0xB0, 0xB1, 0xFA, 
+0xEE, 0x6F, 0x0B, 0xFF, 0xDD, 0x79, 0x9B, 0x3C, 
+0xF7, 0x2F, 0xD5, 0x5F, 0xDD, 0x22, 0x81, 0xC7, 
+0xA3, 0x09, 0x7F, 0x1F, 0x2F, 0x15, 0x2A, 0x56, 
+0x64, 0x00, 0x40, 0x7D, 0x14, 0x48, 0x09, 0x27, 
+0xD2, 0xDD, 0xF8, 0xFF, 0xE6, 0x00, 0x4E, 0x33, 
+0x59, 0x02, 0xD2, 0x7D, 0xE7, 0xF9, 0xDB, 0x9B, 
+0x63, 0xA5, 0xD0, 0x9F, 0xAD, 0xA7, 0x70, 0xF1, 
+0xE9, 0xF7, 0x84, 0xF3, 0xF5, 0x5D, 0xB3, 0x79, 
+0x47, 0xFD, 0xD9, 0x66, 0x9B, 0xCB, 0x9E, 0x7F, 
+0x51, 0xDE, 0xE8, 0x85, 0xA8, 0x3A, 0x89, 0x36, 
+0x45, 0xD2, 0x2C, 0x0D, 0x7A, 0x2A, 0xDA, 0xF9, 
+0xAA, 0x5E, 0x61, 0x68, 0x0A, 0x05, 0xF6, 0xEC, 
+0x1F, 0xE5, 0x2B, 0x68, 0xB2, 0xC0, 0xEE, 0xDF, 
+0x7F, 0x8B, 0x39, 0x89, 0x6A, 0xB4, 0x88, 0xCD, 
+0x8E, 0x06, 0xFA, 0xC6, 0x08, 0xD4, 0x74, 0x5C, 
+0xAA, 0x23, 0x0F, 0x9B, 0x9D, 0xE5, 0x25, 0x7A, 
+0x1B, 0xEF, 0x79, 0x97, 0x15, 0x26, 0x89, 0xD8, 
+0xB6, 0x4E, 0x11, 0x79, 0x94, 0x17, 0x7D, 0x6D, 
+0x47, 0xAB, 0xAE, 0x72, 0xE1, 0xE4, 0xAF, 0x4C, 
+0x5E, 0xF6, 0xA7, 0x81, 0xCF, 0x86, 0x22, 0x96, 
+0xD5, 0x86, 0xC3, 0x10, 0x8A, 0xD5, 0x45, 0xCF, 
+0x65, 0xB5, 0xB7, 0x73, 0x15, 0x11, 0x49, 0xEA, 
+0xE5, 0x78, 0xA2, 0x1A, 0xDB, 0x1F, 0x82, 0x33, 
+0x62, 0x33, 0xAC, 0xAA, 0xD4, 0x38, 0x88, 0x8C, 
+0x8D, 0xB8, 0xBE, 0x0C, 0xC1, 0x59, 0x24, 0x40, 
+0xA3, 0xEF, 0x5C, 0x13, 0xFC, 0x2C, 0x69, 0x78, 
+0x37, 0xFD, 0xB7, 0x88, 0xDA, 0x49, 0xBC, 0x02, 
+0xA2, 0x10, 0x8D, 0x24, 
+
+0x3C, 0x70, 0xBD, 0xB2, 0xF0, 0x7F, 0x4F, 0x8D, 
+0x04, 0x17, 0xC6, 0xF4, 0xC5, 0x67, 0xFC, 0x1D, 
+0x85, 0x7A, 0xF8, 0x83, 0xF4, 0xF5, 0xC2, 0xA3, 
+0xCD, 0x46, 0xAB, 0x1A, 0x61, 0x46, 0x10, 0x3F, 
+0x19, 0x1C, 0xA4, 0x7D, 0xEF, 0x11, 0x9C, 0x38, 
+0x6B, 0x2E, 0x4D, 0xFA, 0xF3, 0x2B, 0x1E, 0x8D, 
+0x1F, 0x5F, 0xCB, 0xA4, 0xDA, 0x5A, 0x4C, 0x65, 
+0xA0, 0xC4, 0x90, 0xD7, 0xFB, 0xC0, 0x26, 0xE6, 
+0x14, 0x7A, 0xE4, 0xB1, 0x25, 0xEC, 0x07, 0x59, 
+0x2D, 0xA3, 0x9F, 0x19, 0xD0, 0xBF, 0x5B, 0x1C, 
+0x44, 0x9D, 0xBA, 0xD7, 0x53, 0x30, 0x0A, 0x04, 
+0x3A, 0xF6, 0x3E, 0xFE, 0xA8, 0x5E, 0xBE, 0xB8, 
+0xD5, 0x6F, 0x09, 0x57, 0xB7, 0x25, 0xDF, 0x69, 
+0x5A, 0x1F, 0x51, 0xDC, 0x79, 0x81, 0xA1, 0xB7, 
+0x0E, 0x18, 0x42, 0x00, 0xF5, 0x41, 0x81, 0xEA, 
+0x1E, 0x8F, 0x82, 0xF6, 0xCE, 0x35, 0x85, 0x53, 
+0xD1, 0xD7, 0x08, 0x7B, 0xEE, 0xDA, 0x35, 0x31, 
+0x45, 0x21, 0xF6, 0xC0, 0xFC, 0x0B, 0xA4, 0x9C, 
+0x6D, 0xDB, 0xC0, 0xE2, 0xEF, 0x76, 0xEC, 0x2F, 
+0xF3, 0xE0, 0x96, 0x75, 0x57, 0xA9, 0xCD, 0x31, 
+0x89, 0x73, 0x41, 0x36, 0x45, 0xC5, 0x16, 0x2F, 
+0xB0, 0xD1, 0xBF, 0x80, 0x73, 0x7B, 0x90, 0xA0, 
+0xA5, 0xA9, 0xAB, 0x60, 0xD8, 0x5A, 0xDC, 0x37, 
+0x63, 0xBC, 0xCC, 0x54, 0x3A, 0x23, 0xD3, 0x65, 
+0xED, 0xF5, 0x04, 0xF7, 0x66, 0xAB, 0x63, 0x6D, 
+0x5D, 0xDE, 0x79, 0x51, 0x57, 0x0E, 0xC0, 0x86, 
+0x35, 0xF4, 0x50, 0xFF, 0x39, 0x96, 0xF1, 0x42, 
+0x71, 0xF5, 0x32, 0xA5, 0xA6, 0x37, 0x29, 0x97, 
+0x07, 0xBD, 0x91, 0xC2, 0xDA, 
+
+0xA7, 0x2C, 0xDA, 0xD4, 0xC6, 0xC1, 0xE8, 0x6A, 
+0xF1, 0xD9, 0x7E, 0x9B, 0xEA, 0x93, 0x90, 0x14, 
+0xFE, 0x47, 0x9F, 0x78, 0x68, 0xEA, 0x35, 0x87, 
+0xAD, 0x6F, 0x92, 0x79, 0xB8, 0xB3, 0x9C, 0x52, 
+0x27, 0x72, 0x24, 0xCC, 0xCA, 0x7B, 0xFE, 0x0E, 
+0x24, 0x3E, 0x6A, 0xE6, 0x9A, 0xF2, 0x8E, 0xE9, 
+0x69, 0xD6, 0x04, 0xE6, 0xD2, 0x65, 0x42, 0xAB, 
+0xEB, 0x15, 0x42, 0x12, 0x98, 0x58, 0x69, 0x94, 
+0x77, 0xA3, 0xD4, 0x0D, 0xCF, 0xFC, 0x4F, 0x0C, 
+0xE3, 0x93, 0x78, 0x63, 0x3E, 0x6E, 0x32, 0x97, 
+0x1B, 0x15, 0xD5, 0x76, 0x05, 0x0B, 0x2D, 0x9D, 
+0x98, 0xCE, 0xBB, 0x4F, 0x22, 0x7D, 0xFE, 0x96, 
+0x41, 0xB8, 0xC4, 0xB7, 0x2C, 0xD7, 0x2A, 0x33, 
+0xC2, 0x8C, 0x07, 0xDE, 0x0F, 0x11, 0x95, 0x06, 
+0x57, 0x26, 0x3C, 0xD8, 0xA0, 0xAD, 0x78, 0x8B, 
+0x26, 0xF2, 0x4A, 0x93, 0x37, 0x28, 0x85, 0x52, 
+0x27, 0x43, 0xD3, 0x1E, 0x6E, 0x8F, 0x33, 0xA5, 
+0xF7, 0x57, 0xED, 0x52, 0xBF, 0xF0, 0xDF, 0x3E, 
+0xF7, 0x60, 0x66, 0x4D, 0x74, 0x3F, 0x0C, 0x25, 
+0x3F, 0x2A, 0x0A, 0xB8, 0xD2, 0xCD, 0xD3, 0x7E, 
+0xAE, 0x9B, 0x81, 0xBE, 0xF9, 0x8E, 0xE9, 0xF6, 
+0xAE, 0x00, 0x33, 0xAB, 0x25, 0x9D, 0x05, 0x46, 
+0x75, 0x5E, 0x54, 0x6B, 0xAB, 0xE7, 0x55, 0xF5, 
+0xFF, 0xDE, 0x71, 0xFC, 0xEA, 0x5F, 0xF1, 0xB0, 
+0x50, 0x50, 0xAB, 0x27, 0x84, 0x0F, 0x6A, 0xCB, 
+0x2D, 0x1D, 0xBB, 0x3F, 0xCC, 0x1B, 0x75, 0x51, 
+0x15, 0x31, 0xE8, 0x29, 0xBE, 0x64, 0x23, 0xB1, 
+0x74, 0x07, 0x20, 0x0F, 0x2F, 0x32, 0x47, 0xE9, 
+0xCD, 0x10, 0xFB, 0xFF, 0x55, 0x7C, 
+
+0x24, 0x91, 0x00, 0xBB, 0xC4, 0x0E, 0xB1, 0x78, 
+0x38, 0xAA, 0x58, 0x74, 0x26, 0xF9, 0x0D, 0x3B, 
+0xB9, 0x9E, 0xB3, 0xD0, 0x75, 0x93, 0x86, 0x22, 
+0xE6, 0xE0, 0x3B, 0x75, 0x45, 0x52, 0x26, 0xE1, 
+0xCF, 0xC7, 0xD6, 0x2F, 0x70, 0x3B, 0xE5, 0xBA, 
+0x7F, 0xD0, 0xBE, 0x4D, 0x7B, 0xE8, 0xFD, 0x7B, 
+0xC8, 0x7F, 0x98, 0x37, 0xFD, 0xED, 0x8A, 0xA4, 
+0x9B, 0x4B, 0x1C, 0x3B, 0xD1, 0xCC, 0xDE, 0xFB, 
+0x59, 0x86, 0x8D, 0xC3, 0xF6, 0xE5, 0x47, 0x81, 
+0xA8, 0x48, 0xB1, 0xE0, 0x1A, 0x91, 0xD7, 0x55, 
+0xA4, 0x2D, 0xCA, 0x5B, 0x3A, 0x43, 0x20, 0x9F, 
+0xC3, 0x53, 0x61, 0x02, 0xD7, 0x78, 0x94, 0xE6, 
+0x19, 0xC2, 0x45, 0x8B, 0xD2, 0x0E, 0x8D, 0x58, 
+0x05, 0x83, 0x93, 0xDD, 0x80, 0xFC, 0x71, 0xED, 
+0x26, 0xCE, 0xB6, 0xA0, 0xE5, 0x9B, 0xDF, 0x38, 
+0x74, 0xAE, 0x5C, 0x74, 0x3F, 0xAF, 0xFA, 0xFA, 
+0x98, 0x0A, 0xD9, 0xA0, 0x2C, 0x9B, 0x63, 0xD3, 
+0xC5, 0x38, 0xE4, 0x9C, 0x64, 0xA8, 0x69, 0x16, 
+0xDF, 0xEC, 0x9B, 0x39, 0x6D, 0x85, 0xA3, 0xFC, 
+0x3D, 0x11, 0xF4, 0x07, 0x43, 0x0D, 0x52, 0x3E, 
+0xDE, 0x3D, 0x6C, 0x21, 0xD5, 0x05, 0x58, 0xA0, 
+0x0E, 0x98, 0xA1, 0x1C, 0xBF, 0x8F, 0xBB, 0x12, 
+0x9C, 0x58, 0xB5, 0x83, 0xB3, 0x2C, 0x5F, 0xEC, 
+0x51, 0x56, 0xCE, 0x4C, 0x32, 0x32, 0x88, 0xF5, 
+0x14, 0x8B, 0x03, 0x03, 0x54, 0xE1, 0x00, 0x4C, 
+0xD3, 0xF4, 0x6D, 0x5D, 0x81, 0x2E, 0xC5, 0x7A, 
+0x94, 0xDA, 0x97, 0x21, 0x2B, 0x1E, 0xB9, 0xA3, 
+0xE1, 0x12, 0xA3, 0xA3, 0x07, 0x5A, 0x1C, 0x00, 
+0x9C, 0x49, 0xD6, 0x45, 0x17, 0xAF, 0xE7, 
+
+0x44, 0x04, 0xE5, 0x30, 0x20, 0xED, 0x20, 0x6E, 
+0x9E, 0x51, 0x1A, 0xCE, 0x3E, 0x96, 0xF4, 0xF9, 
+0xC2, 0x95, 0x08, 0xDD, 0xBA, 0x32, 0x85, 0x53, 
+0xF0, 0x0F, 0x44, 0xAB, 0x4F, 0x0D, 0xC0, 0x6A, 
+0xBB, 0x0B, 0xDF, 0xC7, 0x75, 0xCE, 0xBD, 0x0F, 
+0xA7, 0xCE, 0xB2, 0xBB, 0x08, 0x42, 0xA4, 0x99, 
+0xA2, 0x9F, 0x03, 0x33, 0x0E, 0xDD, 0xCA, 0x72, 
+0x79, 0xB4, 0xB3, 0x88, 0x29, 0xE6, 0x9F, 0xE7, 
+0x54, 0x7E, 0x49, 0xD4, 0x17, 0x07, 0x4C, 0x58, 
+0x1F, 0x50, 0xC6, 0xF4, 0xEF, 0x2E, 0xC1, 0x5C, 
+0x60, 0xF5, 0x93, 0xD9, 0xF8, 0xF9, 0x0C, 0x85, 
+0x97, 0x9D, 0x9D, 0x80, 0xBC, 0x38, 0x98, 0x35, 
+0x5B, 0xCB, 0x1D, 0xA5, 0x18, 0x6C, 0xFB, 0xEF, 
+0x71, 0x69, 0x73, 0x35, 0x4C, 0x63, 0x6A, 0x95, 
+0x2A, 0x8E, 0x9D, 0xAC, 0xCC, 0x78, 0xC1, 0xEF, 
+0x04, 0x0F, 0xFC, 0x80, 0x8E, 0xD7, 0x92, 0x42, 
+0x21, 0xEE, 0xF1, 0xF5, 0x53, 0x8C, 0x52, 0x17, 
+0x27, 0x67, 0x9E, 0x5B, 0x4D, 0xF6, 0x3E, 0xD5, 
+0xD1, 0x65, 0xDD, 0x3A, 0x73, 0x99, 0xF6, 0x36, 
+0x58, 0xDC, 0x69, 0xCC, 0x7E, 0x90, 0xB6, 0xAA, 
+0x8E, 0xC8, 0xB8, 0x39, 0x1B, 0xF0, 0x99, 0x41, 
+0x96, 0xCA, 0x30, 0xB2, 0x5D, 0x8E, 0xB1, 0x3E, 
+0xEF, 0xFE, 0x12, 0x19, 0xBB, 0x64, 0x0E, 0x32, 
+0xDA, 0x09, 0x37, 0x72, 0xE0, 0x34, 0xA6, 0x98, 
+0xE7, 0x4F, 0x82, 0x6F, 0x1D, 0x85, 0x16, 0xCD, 
+0xA2, 0x77, 0xB7, 0xB4, 0x8B, 0x06, 0x5F, 0xDE, 
+0x58, 0x34, 0xEE, 0x74, 0x12, 0xAD, 0x2B, 0xE2, 
+0xFE, 0x88, 0xBF, 0x57, 0xD9, 0xF8, 0x51, 0x0F, 
+0xC9, 0x97, 0x5F, 0x6F, 0xE3, 0x44, 0x17, 0x6B, 
+
+0xFB, 0x5A, 0xFE, 0x40, 0xCC, 0x1C, 0x44, 0xC5, 
+0x8E, 0x8F, 0x26, 0xDB, 0x56, 0xE8, 0xF7, 0xE3, 
+0xF5, 0xBC, 0x76, 0xEF, 0x73, 0xDA, 0xF6, 0x1B, 
+0xCC, 0xD4, 0x04, 0x8D, 0x07, 0x1E, 0x85, 0x7E, 
+0x39, 0x61, 0xB6, 0xCD, 0x2E, 0x03, 0x5D, 0xED, 
+0x33, 0xBB, 0x09, 0xE0, 0x09, 0xD3, 0x1C, 0x23, 
+0x1E, 0xD5, 0x29, 0xB8, 0xA5, 0x4F, 0x1A, 0x8E, 
+0x3F, 0x66, 0x37, 0x51, 0x2A, 0x6C, 0x2C, 0x05, 
+0xAB, 0x45, 0xC1, 0xF2, 0x04, 0x2B, 0x8C, 0xA2, 
+0xE0, 0xD9, 0x83, 0xFA, 0xA8, 0xEE, 0xF1, 0x58, 
+0x39, 0x34, 0x05, 0xC2, 0xA2, 0x65, 0x11, 0x25, 
+0x7D, 0x65, 0x77, 0x4B, 0x93, 0x3D, 0x27, 0x2F, 
+0x50, 0x08, 0x4E, 0x00, 0x3C, 0x3F, 0xDD, 0xCA, 
+0x60, 0x22, 0x30, 0x5F, 0x89, 0xB0, 0x72, 0xB3, 
+0xA7, 0x5D, 0x8B, 0x09, 0xC2, 0x9B, 0x84, 0xB4, 
+0xA2, 0xBE, 0x80, 0x20, 0x66, 0xBA, 0x2E, 0x62, 
+0x12, 0xF5, 0xBE, 0xF5, 0xCE, 0x2D, 0xD2, 0x39, 
+0x46, 0xB5, 0xE6, 0xF0, 0x1F, 0x4B, 0xF9, 0xDC, 
+0xB4, 0x38, 0xF7, 0x49, 0xBF, 0x98, 0x5F, 0x46, 
+0xFE, 0xC5, 0x71, 0xA3, 0xF6, 0x63, 0x03, 0xF6, 
+0x82, 0x5F, 0xBE, 0xFF, 0x24, 0x74, 0xA2, 0x84, 
+0x65, 0x42, 0x1E, 0x28, 0xEC, 0xDB, 0x14, 0xE2, 
+0xA8, 0xF8, 0xE3, 0xD4, 0x0F, 0x1F, 0x64, 0xA4, 
+0x88, 0x9B, 0xDB, 0x7A, 0x1B, 0x2C, 0x82, 0x1D, 
+0x7C, 0x4D, 0x33, 0x76, 0xF4, 0x97, 0xBD, 0x67, 
+0xB4, 0x39, 0x00, 0x51, 0x06, 0xA3, 0x40, 0x7F, 
+0xA1, 0xC9, 0x1C, 0x74, 0xFB, 0xD4, 0xD1, 0x9E, 
+0xE5, 0x84, 0x98, 0xF4, 0xC1, 0xE3, 0x90, 0xBC, 
+0x9F, 0x9D, 0x15, 0x03, 0xB2, 0x34, 0xB7, 0x7D, 
+0xE0, 
+
+0xAE, 0xF5, 0x45, 0xDD, 0x54, 0x7E, 0xA5, 0xF3, 
+0x5A, 0x25, 0x0D, 0x9A, 0x95, 0x12, 0xB1, 0x77, 
+0xB8, 0xF8, 0x6B, 0xDC, 0x43, 0x95, 0xA3, 0xD9, 
+0xF8, 0x45, 0x8B, 0xA8, 0x43, 0x25, 0x77, 0xC4, 
+0xB0, 0xB7, 0x0C, 0x48, 0x84, 0xF5, 0x15, 0xBB, 
+0x4E, 0xD8, 0x51, 0x3D, 0x92, 0x20, 0x24, 0x9A, 
+0xF1, 0xEB, 0x99, 0x7E, 0xC6, 0x72, 0x12, 0x2B, 
+0x0B, 0x23, 0xE1, 0xAF, 0x19, 0xA5, 0x05, 0x36, 
+0xC3, 0x76, 0x91, 0x1F, 0xB8, 0x81, 0xA6, 0x09, 
+0xB5, 0x39, 0x98, 0xC0, 0xAC, 0x54, 0x3B, 0x00, 
+0x61, 0x89, 0x21, 0xB7, 0xA4, 0xE9, 0x15, 0xC2, 
+0xC2, 0x8B, 0xDE, 0x07, 0xF5, 0x7F, 0xB4, 0xC3, 
+0xF0, 0x7B, 0x55, 0x04, 0xEE, 0x09, 0x27, 0x77, 
+0x0D, 0x3F, 0x3B, 0x97, 0xD0, 0x12, 0x46, 0x01, 
+0xBF, 0xA5, 0xD6, 0xBD, 0x4A, 0xA6, 0xD0, 0xBB, 
+0xF3, 0x86, 0x14, 0x97, 0x7A, 0x8D, 0x5D, 0xCE, 
+0x5F, 0xA3, 0xB7, 0x98, 0x6D, 0x89, 0xC1, 0x67, 
+0x92, 0xDF, 0xF8, 0x1F, 0x88, 0x76, 0x63, 0x20, 
+0xC1, 0xA3, 0x8E, 0x29, 0x98, 0xB8, 0x3D, 0xC1, 
+0x2D, 0x83, 0x17, 0xAB, 0x1F, 0xC7, 0x66, 0xF4, 
+0x69, 0xFF, 0x30, 0x1E, 0xAB, 0x58, 0xE4, 0x66, 
+0xD9, 0xCD, 0x23, 0x73, 0xF8, 0x69, 0x3D, 0x1B, 
+0x5D, 0xA3, 0xA5, 0xD6, 0xBF, 0x0A, 0x32, 0xAF, 
+0xC2, 0x73, 0x0E, 0x6C, 0x98, 0xB0, 0xBC, 0xB1, 
+0x83, 0xD9, 0xFE, 0x2B, 0x71, 0x48, 0xB9, 0xC8, 
+0xA0, 0x64, 0xD4, 0xB6, 0x8B, 0xB5, 0x6A, 0xDE, 
+0x3B, 0x4B, 0x60, 0x0D, 0x22, 0x02, 0x6A, 0x9C, 
+0xB7, 0x00, 0xEE, 0xAF, 0xAC, 0x87, 0x2C, 0x2A, 
+0x2A, 0x56, 0x2B, 0xED, 0xCD, 0x63, 0xDB, 0x28, 
+0xCE, 0x09, 
+
+0x00, 0xF4, 0x71, 0xA5, 0x56, 0x70, 0x85, 0xF0, 
+0x61, 0x5F, 0xA2, 0x18, 0xF7, 0x4A, 0x36, 0xC9, 
+0x04, 0x40, 0x1B, 0x5E, 0x19, 0x60, 0xEB, 0x85, 
+0x40, 0xFE, 0x2D, 0xDE, 0xE3, 0x35, 0x0D, 0xCA, 
+0x6E, 0x25, 0x6E, 0x49, 0xDD, 0x45, 0x3A, 0x6E, 
+0x8A, 0x0F, 0x09, 0x0C, 0x53, 0xFF, 0x6C, 0x92, 
+0xCA, 0xBF, 0xC1, 0xF2, 0xCA, 0x24, 0xC7, 0xC0, 
+0x32, 0x50, 0x3F, 0x39, 0xFF, 0x3A, 0xAE, 0x0F, 
+0xD7, 0xA5, 0xD9, 0x36, 0xE7, 0x0C, 0x83, 0xD2, 
+0x08, 0x2E, 0xF2, 0x30, 0x1C, 0xF9, 0x34, 0xE8, 
+0x34, 0x35, 0xBF, 0x7F, 0x4F, 0x22, 0x52, 0xC1, 
+0x30, 0xFC, 0xD2, 0x92, 0xAE, 0x6F, 0x2E, 0x3B, 
+0xDA, 0x10, 0x13, 0xC8, 0xC4, 0xE1, 0xD4, 0x54, 
+0xBF, 0x47, 0x62, 0x4F, 0xF3, 0x56, 0x38, 0x3B, 
+0xFD, 0xDD, 0x2D, 0xB9, 0x55, 0x87, 0x16, 0xA9, 
+0x29, 0x2E, 0x5C, 0xBB, 0x09, 0x70, 0x7A, 0x36, 
+0xA4, 0x1B, 0x38, 0x65, 0x34, 0xF3, 0x12, 0x6F, 
+0xE2, 0x90, 0x9D, 0x6F, 0x7A, 0x78, 0x61, 0x96, 
+0x2C, 0x3A, 0x68, 0x68, 0x39, 0x42, 0x1D, 0x3B, 
+0x1D, 0x8C, 0x2A, 0xBA, 0x0C, 0xF4, 0x71, 0xB4, 
+0x32, 0x5B, 0x8F, 0x5C, 0x5F, 0x1B, 0x3A, 0x69, 
+0x2D, 0x1E, 0x99, 0x0F, 0x4C, 0x1E, 0x33, 0xB4, 
+0x61, 0xDD, 0x94, 0x0C, 0x02, 0x16, 0xBD, 0x87, 
+0x45, 0xBC, 0x8A, 0xB2, 0xF6, 0x3B, 0xBA, 0xB0, 
+0xFB, 0xEF, 0x15, 0x86, 0xDF, 0xFB, 0x86, 0x37, 
+0xF9, 0x70, 0x66, 0x64, 0xF9, 0x6C, 0xCA, 0xA8, 
+0x50, 0xDB, 0xD4, 0x49, 0x50, 0xBC, 0x8E, 0x5A, 
+0x49, 0x14, 0xB5, 0xEF, 0x0D, 0xC6, 0x3B, 0xB1, 
+0x10, 0xE5, 0x3C, 0xE6, 0x6A, 0x58, 0x24, 0x89, 
+0x4B, 0xD5, 0x28, 
+
+0x94, 0x93, 0x38, 0x7C, 0xB8, 0x98, 0x45, 0x7E, 
+0xF6, 0x13, 0x03, 0xA3, 0xE4, 0x45, 0x41, 0xA6, 
+0x38, 0xE3, 0x0E, 0x2F, 0x22, 0x54, 0x9A, 0x1B, 
+0xF7, 0x7D, 0x6B, 0xD8, 0xCC, 0x56, 0x98, 0xE4, 
+0xD0, 0xAD, 0x28, 0xE1, 0xC3, 0x9A, 0xF4, 0x01, 
+0xD5, 0x4A, 0x73, 0x2A, 0xB5, 0x47, 0x06, 0x1C, 
+0x62, 0xDF, 0x4E, 0x0E, 0x70, 0x37, 0xD9, 0xDE, 
+0x98, 0xBB, 0x76, 0x57, 0x1A, 0xC5, 0x2A, 0xE1, 
+0xB1, 0x58, 0x4A, 0xC1, 0x64, 0xEB, 0xBE, 0x3D, 
+0xB1, 0xFD, 0xBB, 0x95, 0xF9, 0x62, 0xB2, 0xF9, 
+0xA2, 0x7B, 0xCA, 0x01, 0x3F, 0xB3, 0x2F, 0x1E, 
+0x21, 0x66, 0xC3, 0xE4, 0x76, 0xF4, 0x71, 0xA8, 
+0x3C, 0x97, 0xAB, 0x99, 0xFD, 0xC8, 0xB0, 0xE1, 
+0xE7, 0xFB, 0xEF, 0x86, 0x33, 0x9E, 0x0E, 0xA5, 
+0x3C, 0x72, 0x8D, 0x46, 0xDF, 0x52, 0x21, 0xC5, 
+0x91, 0xE0, 0x07, 0x7E, 0x70, 0xEE, 0x55, 0xD7, 
+0x2F, 0xE8, 0x92, 0x9D, 0x5C, 0x66, 0x23, 0x3B, 
+0xBA, 0x2C, 0xDD, 0xD0, 0xE5, 0xE9, 0x0F, 0x1B, 
+0x0A, 0xF8, 0xA4, 0x4D, 0xCA, 0x1F, 0xB1, 0xB0, 
+0x9F, 0x77, 0x95, 0xA2, 0x96, 0xF7, 0xAD, 0x8B, 
+0xDF, 0x94, 0x5E, 0x57, 0xA7, 0x04, 0xC1, 0x9D, 
+0x47, 0x74, 0x19, 0x47, 0x38, 0x47, 0xD8, 0x07, 
+0x5B, 0xF4, 0x16, 0x72, 0xDA, 0x03, 0xE9, 0xEB, 
+0xCC, 0xAE, 0xCB, 0x2A, 0x4E, 0xB8, 0x1D, 0x0C, 
+0xD2, 0x10, 0x22, 0xAB, 0x67, 0xA2, 0x23, 0xB2, 
+0xDA, 0x14, 0x7F, 0x2A, 0x1C, 0xD0, 0xCE, 0xB9, 
+0x4F, 0x83, 0xFA, 0xF4, 0x52, 0xB8, 0xC3, 0xDF, 
+0x11, 0x1A, 0x35, 0xBA, 0x29, 0x97, 0x6A, 0xE4, 
+0x90, 0x50, 0x44, 0xD5, 0x75, 0xDA, 0x64, 0x93, 
+0xC6, 0x97, 0xC2, 0x97, 
+
+0x12, 0xF1, 0xB7, 0x8A, 0x50, 0x89, 0xED, 0x6E, 
+0xC3, 0x6F, 0x18, 0xE1, 0xDF, 0x65, 0x19, 0x7B, 
+0x2B, 0x53, 0x30, 0x5E, 0xF8, 0xD4, 0x82, 0x54, 
+0xC6, 0xA8, 0x47, 0xA4, 0x6E, 0xBE, 0xA9, 0x81, 
+0xF4, 0x29, 0x75, 0x2F, 0x0E, 0x15, 0xAA, 0xA7, 
+0x75, 0x30, 0x48, 0x1D, 0xFB, 0x14, 0x58, 0x63, 
+0xAB, 0xA9, 0xBD, 0xA7, 0xFF, 0xB5, 0x5F, 0xA5, 
+0x48, 0x9A, 0xDE, 0xE3, 0xBA, 0x24, 0x9D, 0xD1, 
+0xB7, 0xEE, 0xDF, 0x91, 0x68, 0x2F, 0xB7, 0xBB, 
+0x6A, 0xFE, 0x11, 0x81, 0xAB, 0xE5, 0x6A, 0xD3, 
+0x9A, 0xBE, 0xE2, 0xD0, 0x2E, 0x2A, 0x11, 0x6E, 
+0xE3, 0x9C, 0x19, 0xAD, 0x0E, 0x59, 0x62, 0xBC, 
+0xB3, 0xD9, 0x2D, 0xE6, 0xBE, 0x6B, 0xA4, 0xFD, 
+0xA3, 0x8C, 0x57, 0xED, 0x5E, 0x16, 0x4F, 0x29, 
+0xC5, 0x2A, 0xE9, 0x60, 0xB2, 0x4C, 0xA4, 0xC8, 
+0xD9, 0xAF, 0x34, 0x91, 0xD9, 0x54, 0xD8, 0xC8, 
+0x08, 0x52, 0xFF, 0x0C, 0x5D, 0x5A, 0x0A, 0x71, 
+0x54, 0x93, 0x5D, 0x7F, 0x90, 0x02, 0xDD, 0xAE, 
+0x65, 0x90, 0x15, 0xDC, 0x85, 0x1F, 0x35, 0x24, 
+0xFC, 0x59, 0x2C, 0x2A, 0x8A, 0x08, 0xC2, 0xD2, 
+0x63, 0x30, 0xF4, 0x57, 0x80, 0xBC, 0xFF, 0x18, 
+0x9E, 0xCF, 0xAD, 0xC5, 0x11, 0x05, 0x6E, 0x71, 
+0x4F, 0xC8, 0xD0, 0x76, 0x5C, 0xFC, 0x0F, 0x0D, 
+0xC2, 0xFC, 0x1B, 0x5B, 0xA9, 0xDB, 0x61, 0xFC, 
+0xAF, 0x38, 0x41, 0x3E, 0x84, 0xB2, 0xE2, 0xD9, 
+0xB6, 0xEB, 0x10, 0xE3, 0xD6, 0x00, 0xBB, 0x67, 
+0xC3, 0x5F, 0xD9, 0x7D, 0x94, 0x27, 0xC3, 0x3D, 
+0x6E, 0xFA, 0x1B, 0xCC, 0xD7, 0x1A, 0xEC, 0xE1, 
+0x50, 0x40, 0x7E, 0x6E, 0x6E, 0x64, 0xB3, 0xE9, 
+0x4B, 0x02, 0x09, 0xC7, 0x84, 
+
+0x2D, 0x5C, 0x2E, 0xF4, 0xB9, 0x33, 0x32, 0xEF, 
+0x20, 0x4E, 0xD3, 0x4A, 0xB5, 0x98, 0x29, 0xB7, 
+0xC6, 0x9F, 0xCC, 0xA8, 0x9B, 0x90, 0x1A, 0xD3, 
+0x2B, 0xAC, 0x5A, 0x63, 0x48, 0x8E, 0xC4, 0xDF, 
+0x2E, 0x36, 0xE3, 0x9F, 0xD0, 0xB5, 0x97, 0xA0, 
+0xAB, 0xE1, 0xD5, 0xA5, 0x9C, 0xA5, 0x3D, 0xF6, 
+0xB9, 0x03, 0xDA, 0x56, 0x2D, 0x12, 0x1D, 0x83, 
+0x73, 0x38, 0x49, 0xDF, 0x7F, 0x49, 0x8D, 0x18, 
+0xC4, 0x09, 0x73, 0x0D, 0x29, 0x79, 0xC7, 0x5E, 
+0x8F, 0xC3, 0x4D, 0x97, 0x33, 0x17, 0x24, 0x3D, 
+0x27, 0xBC, 0xB9, 0x79, 0x2D, 0xC6, 0x90, 0x09, 
+0x7F, 0x92, 0x8F, 0x1A, 0xC9, 0xBE, 0xE0, 0x76, 
+0x6B, 0x48, 0x17, 0x5F, 0x24, 0x87, 0xDA, 0x6F, 
+0x84, 0x83, 0xC9, 0x2F, 0x7E, 0x71, 0x78, 0x97, 
+0x05, 0x20, 0x4D, 0xDC, 0xE9, 0x19, 0x70, 0xD8, 
+0xD4, 0xB0, 0xDA, 0x08, 0xE1, 0x8D, 0x44, 0x16, 
+0x08, 0xB8, 0x6D, 0xC5, 0xF7, 0xA0, 0x4B, 0x1E, 
+0x28, 0x61, 0x66, 0x76, 0xB1, 0xEE, 0xC7, 0x7A, 
+0x13, 0x41, 0x0C, 0xFE, 0xB6, 0x85, 0xCE, 0x9D, 
+0x87, 0x0C, 0xE9, 0x93, 0x89, 0x94, 0xDB, 0xCF, 
+0xD0, 0x9F, 0x16, 0x10, 0x41, 0xD7, 0xFD, 0xAC, 
+0x79, 0x65, 0xD4, 0x11, 0xDE, 0xD4, 0x5F, 0xA1, 
+0xCF, 0x19, 0x72, 0x7A, 0x67, 0x2F, 0xF2, 0xAD, 
+0xE8, 0x03, 0x71, 0x55, 0x48, 0x4E, 0x01, 0xEB, 
+0x74, 0xA9, 0xFE, 0x52, 0xD7, 0xAA, 0x71, 0xEA, 
+0xD7, 0xDB, 0x26, 0x98, 0xCA, 0xC4, 0x4F, 0x1A, 
+0x9D, 0x68, 0x79, 0xA3, 0xA5, 0x84, 0xB9, 0x47, 
+0x79, 0xAD, 0x09, 0xA0, 0xE3, 0x9A, 0x17, 0xE2, 
+0xA4, 0x8A, 0x6A, 0x40, 0x4B, 0x7D, 0x9D, 0x32, 
+0x5B, 0x09, 0x4E, 0x0D, 0x8D, 0x5F, 
+
+0x40, 0x0C, 0xFA, 0xFB, 0x19, 0xD7, 0x35, 0xF4, 
+0xF6, 0xF2, 0xC6, 0x1F, 0x44, 0x7C, 0xA3, 0xED, 
+0x65, 0xFE, 0x67, 0x7E, 0x01, 0xA4, 0x37, 0x28, 
+0x97, 0xA1, 0xEC, 0x2D, 0x51, 0x78, 0x7D, 0xCE, 
+0x65, 0xED, 0xDA, 0xD4, 0x30, 0x76, 0xA1, 0xF9, 
+0xD4, 0x2D, 0x85, 0x43, 0x0E, 0x1B, 0x54, 0x7A, 
+0x99, 0xA2, 0xCA, 0xE9, 0x7B, 0x2E, 0x8D, 0x03, 
+0x09, 0x7B, 0xC0, 0x70, 0x36, 0x62, 0x38, 0xB1, 
+0x42, 0x90, 0xBF, 0xB6, 0xDB, 0x96, 0xBF, 0xBE, 
+0x5C, 0x27, 0x3A, 0x00, 0x4E, 0xB8, 0x9F, 0x99, 
+0xD9, 0x59, 0x4E, 0x48, 0x18, 0x62, 0xBA, 0x2B, 
+0xD4, 0xD3, 0xB4, 0x90, 0xE4, 0x80, 0x65, 0xCE, 
+0xEB, 0x38, 0x06, 0x52, 0xBF, 0x9E, 0x9A, 0x39, 
+0xC7, 0x9C, 0x84, 0xA6, 0x98, 0xB6, 0xF4, 0xED, 
+0x33, 0x05, 0x9B, 0x10, 0xA7, 0x4C, 0xA2, 0x35, 
+0x67, 0x78, 0x6F, 0x03, 0xE6, 0x47, 0x41, 0x4E, 
+0x33, 0xF1, 0x3D, 0xC6, 0xE6, 0x89, 0x49, 0x29, 
+0x44, 0xB5, 0x73, 0x71, 0x51, 0xE6, 0x80, 0x28, 
+0x61, 0x8A, 0xE8, 0xAB, 0x8D, 0xC1, 0x15, 0xBB, 
+0x87, 0x28, 0xC8, 0x83, 0x19, 0x4F, 0x70, 0xCA, 
+0x28, 0x26, 0xF8, 0x9E, 0x82, 0xAB, 0x5A, 0xB6, 
+0x11, 0xA2, 0x3B, 0xDB, 0x5D, 0xCF, 0x0A, 0xE4, 
+0x5C, 0x77, 0x79, 0xA9, 0xCD, 0xB6, 0x99, 0x15, 
+0x3D, 0x4C, 0x65, 0xF2, 0x21, 0x6F, 0x81, 0x31, 
+0x30, 0x79, 0xB5, 0x5D, 0x89, 0x48, 0x69, 0xA9, 
+0xC5, 0xD3, 0x3A, 0x3E, 0xEA, 0xFD, 0x03, 0xF8, 
+0x95, 0x23, 0x9E, 0xDE, 0xDB, 0xB0, 0x00, 0x3E, 
+0xB4, 0x55, 0xF8, 0x4B, 0x41, 0xF4, 0x26, 0x0E, 
+0x51, 0x4D, 0x8D, 0x63, 0x88, 0xD6, 0x4D, 0xFC, 
+0x06, 0xA9, 0xE2, 0x94, 0xF8, 0x86, 0x9E, 
+
+0x0F, 0x35, 0x0A, 0xD9, 0xAA, 0xF2, 0xCB, 0x8D, 
+0x16, 0xEC, 0x3E, 0xDB, 0x16, 0x25, 0x72, 0xF6, 
+0x97, 0xB7, 0x5D, 0x24, 0x9C, 0xCF, 0x9F, 0x64, 
+0xFB, 0x8D, 0x4C, 0x7D, 0x5E, 0xFD, 0x61, 0xD1, 
+0x9E, 0x19, 0x6E, 0xB7, 0x60, 0x1D, 0x66, 0x25, 
+0x1E, 0xBC, 0x8A, 0x85, 0xF9, 0x06, 0xC3, 0xF7, 
+0x4F, 0x30, 0x69, 0x54, 0x8D, 0xEA, 0xA0, 0xB6, 
+0x1E, 0x15, 0x33, 0x27, 0x2C, 0x39, 0x67, 0x0A, 
+0x58, 0x23, 0xC6, 0x4F, 0x78, 0x19, 0x2F, 0x68, 
+0x66, 0x9E, 0x79, 0x3A, 0xF1, 0x44, 0x56, 0x1C, 
+0x17, 0xAF, 0x86, 0xF2, 0x5A, 0x07, 0x20, 0xB3, 
+0xD4, 0x5D, 0xA1, 0x88, 0x6C, 0x71, 0x82, 0xFF, 
+0xC5, 0x4A, 0xCC, 0xFD, 0xD9, 0xC5, 0x64, 0x0B, 
+0x34, 0xB8, 0x4E, 0xE4, 0x3A, 0x28, 0xFA, 0x07, 
+0x48, 0x06, 0x51, 0xD8, 0x81, 0x49, 0x7F, 0x6B, 
+0x01, 0xB9, 0xDC, 0xC8, 0x33, 0x2A, 0x3E, 0xB6, 
+0xB1, 0xB8, 0xA1, 0x85, 0xFB, 0x7A, 0x02, 0x21, 
+0x8D, 0xA4, 0xA2, 0x29, 0x46, 0x0F, 0xBD, 0x56, 
+0xA9, 0x1A, 0x7A, 0x8A, 0x9D, 0x4C, 0x71, 0x5F, 
+0x54, 0x1D, 0x72, 0xAC, 0xBF, 0x80, 0xD2, 0xF4, 
+0x15, 0xC3, 0x52, 0x32, 0x29, 0xBE, 0x18, 0x76, 
+0xCB, 0xF1, 0x44, 0xEB, 0xBC, 0xB7, 0x75, 0x71, 
+0x21, 0xB6, 0xE0, 0x48, 0x07, 0xB7, 0xBD, 0x45, 
+0xAF, 0x6D, 0x4C, 0x64, 0x68, 0x94, 0x29, 0xF8, 
+0x25, 0xCF, 0x11, 0xFC, 0x8B, 0xAB, 0x45, 0x1A, 
+0xE3, 0x34, 0xAB, 0x79, 0xBE, 0xF1, 0x8A, 0x74, 
+0x1A, 0x21, 0xD1, 0xC7, 0x74, 0xE3, 0xC8, 0x92, 
+0xF6, 0xDD, 0xC6, 0xB4, 0xC5, 0xCE, 0x17, 0xA8, 
+0x57, 0x09, 0xB7, 0x21, 0xC2, 0xFF, 0x88, 0x2D, 
+0x12, 0x0E, 0xFC, 0x15, 0xE4, 0x6D, 0xD1, 0x0E, 
+
+0xFC, 0xE8, 0xEB, 0x2F, 0xC9, 0x3B, 0x09, 0x3B, 
+0xF7, 0x1B, 0x14, 0x17, 0x29, 0x2C, 0x88, 0x5E, 
+0xF8, 0xF2, 0x81, 0x16, 0xA8, 0x66, 0x81, 0x44, 
+0xAA, 0x07, 0x1B, 0x30, 0x17, 0x02, 0x30, 0xB8, 
+0x1B, 0x3D, 0x17, 0x51, 0x0D, 0x6A, 0xDF, 0x09, 
+0xE8, 0x1E, 0xC4, 0x5A, 0x59, 0x9D, 0x70, 0x3E, 
+0xE6, 0xC2, 0x52, 0xBE, 0x57, 0xE5, 0x7E, 0x26, 
+0xD2, 0x00, 0x9A, 0x2F, 0x0C, 0x97, 0x52, 0xAD, 
+0x35, 0x17, 0x7A, 0xFC, 0x6B, 0xE5, 0xBD, 0xAD, 
+0xD0, 0x37, 0x27, 0x98, 0x53, 0x6D, 0xB4, 0xDB, 
+0x67, 0xCF, 0x0A, 0x48, 0xFA, 0x30, 0x40, 0x67, 
+0xA0, 0x51, 0x70, 0x29, 0x60, 0x92, 0xA1, 0x6F, 
+0x85, 0x22, 0xCE, 0xEE, 0x2C, 0x26, 0x06, 0x41, 
+0xF2, 0xF8, 0x6E, 0x1F, 0x61, 0xB9, 0x94, 0xCA, 
+0x78, 0x70, 0xB6, 0xA5, 0xB9, 0x77, 0x27, 0x4D, 
+0xC7, 0x8D, 0xBB, 0xAC, 0x6E, 0x19, 0xBF, 0xAE, 
+0xAA, 0x39, 0x4C, 0xD6, 0x8A, 0xE9, 0x4C, 0xCD, 
+0xF8, 0x7D, 0x1E, 0xEF, 0x10, 0x1A, 0x74, 0xEC, 
+0x54, 0xAF, 0xB7, 0x2F, 0x1C, 0xD0, 0x9C, 0x0C, 
+0xC5, 0x57, 0xAA, 0xBE, 0x49, 0xE6, 0xCA, 0x18, 
+0x3A, 0xE6, 0xF9, 0x8F, 0x54, 0x86, 0x5B, 0x87, 
+0xD5, 0xCA, 0xD8, 0xBB, 0x80, 0x2B, 0xA4, 0xFA, 
+0x69, 0x84, 0x15, 0x9D, 0xF6, 0x3A, 0x11, 0xA0, 
+0xB1, 0xB0, 0x8C, 0x49, 0xEA, 0x7D, 0x0A, 0xB8, 
+0xE7, 0xEE, 0xEB, 0x77, 0x30, 0x9D, 0x23, 0xC2, 
+0x4C, 0xAE, 0x5D, 0x18, 0xE8, 0xA8, 0xC3, 0x5D, 
+0x49, 0x13, 0x2F, 0x85, 0xE5, 0xFB, 0xB6, 0xF4, 
+0xA4, 0xA6, 0xE0, 0xDE, 0x1E, 0x7F, 0x13, 0xBB, 
+0x02, 0x8A, 0x4A, 0x62, 0xD3, 0xC5, 0x95, 0x21, 
+0xF3, 0xE4, 0x3A, 0xA7, 0xC9, 0xC8, 0x04, 0xD1, 
+0x9C, 
+
+0x6F, 0x61, 0x99, 0x90, 0x1A, 0x22, 0xEA, 0x34, 
+0x66, 0x38, 0x93, 0x55, 0x53, 0xF8, 0x8D, 0x61, 
+0xCD, 0x57, 0x6E, 0x98, 0x73, 0xA9, 0x43, 0x5C, 
+0x84, 0x73, 0x04, 0xDD, 0x4A, 0x08, 0xAB, 0x12, 
+0x62, 0xF1, 0xF5, 0xE9, 0x5C, 0x04, 0x20, 0xA9, 
+0xB6, 0xBF, 0xA0, 0x2F, 0x98, 0x79, 0x1B, 0x69, 
+0xD0, 0x17, 0x43, 0xEA, 0x8F, 0x60, 0x66, 0xFB, 
+0x6F, 0x7F, 0x51, 0xD7, 0xE0, 0x1E, 0xF1, 0xC1, 
+0x0B, 0x01, 0xF9, 0x1D, 0x60, 0x16, 0x2C, 0xFE, 
+0xA3, 0x9C, 0x6D, 0x69, 0xAC, 0x32, 0xE3, 0x08, 
+0xA7, 0x41, 0x9B, 0xB9, 0x35, 0x67, 0x28, 0x21, 
+0x47, 0xD3, 0xFE, 0xD5, 0x70, 0x70, 0x4A, 0x21, 
+0x9F, 0x26, 0x3A, 0xE5, 0x1D, 0x52, 0xF5, 0x8B, 
+0x4E, 0x84, 0x8C, 0x83, 0x84, 0x5F, 0x8C, 0xD5, 
+0xBB, 0x77, 0x30, 0xBC, 0x1F, 0xDE, 0x1E, 0x57, 
+0xD6, 0xCF, 0xCB, 0xC0, 0x00, 0x77, 0x33, 0xF6, 
+0x73, 0xCF, 0x76, 0x01, 0xD1, 0xAB, 0xE5, 0xB2, 
+0x53, 0x08, 0x25, 0xD8, 0xBC, 0xAA, 0x68, 0x60, 
+0x72, 0x46, 0xB0, 0x30, 0xF0, 0x14, 0x72, 0x1E, 
+0xC8, 0x1B, 0xE5, 0xBC, 0xA4, 0x6F, 0x44, 0xD0, 
+0xF9, 0x13, 0xCD, 0xC1, 0x1E, 0x87, 0x52, 0x7B, 
+0x59, 0x5C, 0xF4, 0xE0, 0xCE, 0x61, 0x20, 0x6E, 
+0xAC, 0xE5, 0xFE, 0xC2, 0x13, 0xCD, 0xB9, 0x33, 
+0x5F, 0xC8, 0x2A, 0xA9, 0x14, 0xFE, 0xC6, 0x2C, 
+0x39, 0xF4, 0x67, 0xDA, 0x75, 0x53, 0x41, 0x57, 
+0xDF, 0x7F, 0xF5, 0xF3, 0x2B, 0x13, 0xF9, 0xA2, 
+0x72, 0x04, 0xAB, 0x94, 0x71, 0xDE, 0x00, 0x46, 
+0xCF, 0x3D, 0x3F, 0x9A, 0xE2, 0x9B, 0x70, 0x1F, 
+0x2E, 0xBA, 0x23, 0x1F, 0x64, 0x66, 0x11, 0x3A, 
+0x35, 0x34, 0xC6, 0x0C, 0x6C, 0x34, 0x5E, 0x40, 
+0xEB, 0x6C, 
+
+0xF4, 0x36, 0xBE, 0xDE, 0x77, 0xA0, 0x5B, 0xE3, 
+0xB5, 0xB8, 0x20, 0x74, 0x73, 0xB7, 0x1A, 0x3B, 
+0xFF, 0x26, 0xAD, 0x6B, 0x66, 0x51, 0xE8, 0x84, 
+0xE8, 0xD6, 0xD1, 0x0A, 0x1E, 0xE8, 0x65, 0xE8, 
+0x5B, 0xB6, 0x74, 0x48, 0x07, 0xEC, 0xA4, 0xB1, 
+0x6B, 0x0A, 0x84, 0x8A, 0xBC, 0x10, 0xF1, 0x87, 
+0x73, 0x68, 0x75, 0xF6, 0xB4, 0xB4, 0x72, 0xF1, 
+0x2F, 0x89, 0x97, 0xFD, 0xEE, 0x93, 0xB4, 0xFC, 
+0xF2, 0x4C, 0x4C, 0xAF, 0xDE, 0x8D, 0x86, 0x5A, 
+0xBE, 0x08, 0x22, 0xA2, 0x36, 0xD2, 0x6F, 0x7E, 
+0x17, 0xD0, 0x32, 0x56, 0x7C, 0x71, 0xFB, 0x45, 
+0xEB, 0x62, 0x66, 0x30, 0x5C, 0x9C, 0x6C, 0xEB, 
+0x46, 0x94, 0xE7, 0xF2, 0xC4, 0x06, 0x29, 0x7F, 
+0x51, 0x06, 0xFC, 0xB2, 0x40, 0x35, 0x21, 0x71, 
+0xD0, 0xB7, 0x9C, 0x95, 0xFF, 0x35, 0xA2, 0x0E, 
+0x2D, 0x90, 0x86, 0x24, 0x94, 0x11, 0x57, 0xB7, 
+0x23, 0x2C, 0xF3, 0xC8, 0xED, 0xA7, 0x23, 0x2F, 
+0x6E, 0x50, 0x95, 0x2B, 0x54, 0xE0, 0x10, 0x34, 
+0x85, 0x79, 0x32, 0x4A, 0xED, 0x41, 0xCB, 0xEB, 
+0x24, 0x1B, 0xB0, 0x18, 0x87, 0xB2, 0x1A, 0x0D, 
+0x73, 0xE7, 0x21, 0xCA, 0x84, 0x14, 0x92, 0x58, 
+0xF5, 0xCA, 0x09, 0xDB, 0x12, 0xA8, 0x02, 0x9A, 
+0x3F, 0x2E, 0xFA, 0x34, 0x82, 0x01, 0x83, 0x13, 
+0xED, 0x97, 0x41, 0xE7, 0xBC, 0x75, 0x99, 0x90, 
+0x27, 0x52, 0xD2, 0x61, 0xC0, 0x8E, 0x41, 0x79, 
+0x14, 0x66, 0x5A, 0x18, 0xC6, 0x47, 0x5E, 0x6A, 
+0x20, 0x1C, 0x48, 0x2E, 0x3B, 0x0B, 0x03, 0xCD, 
+0xB8, 0x60, 0x47, 0xB1, 0xEC, 0x00, 0xA9, 0xF2, 
+0x90, 0x77, 0x95, 0xC6, 0x16, 0xC9, 0xF5, 0xF4, 
+0xCA, 0x76, 0x54, 0x9B, 0x2E, 0xB7, 0x10, 0x37, 
+0x99, 0xB9, 0xE4, 
+
+0xD0, 0x40, 0x49, 0x88, 0x9B, 0x9D, 0x41, 0xCD, 
+0x79, 0x11, 0x2B, 0x02, 0x67, 0x02, 0x6A, 0x38, 
+0x1A, 0x53, 0x79, 0xB2, 0xB3, 0xB7, 0x72, 0x44, 
+0x2F, 0x44, 0xF8, 0x77, 0xC9, 0xEA, 0x96, 0x43, 
+0x0F, 0x84, 0x5F, 0x66, 0xB9, 0xC7, 0x66, 0xF3, 
+0x3C, 0xA6, 0xE5, 0xB6, 0x52, 0x20, 0x14, 0x9E, 
+0xA0, 0x9B, 0x95, 0xF7, 0x05, 0xD0, 0xC4, 0x61, 
+0xF0, 0x3A, 0x6D, 0x05, 0x1C, 0x75, 0x7F, 0x03, 
+0xA9, 0x35, 0xA8, 0x87, 0x6C, 0x79, 0x53, 0x0C, 
+0xED, 0x3E, 0xE0, 0xE9, 0x06, 0x22, 0x59, 0x0A, 
+0xFD, 0x90, 0x71, 0x30, 0x19, 0x53, 0x77, 0x3E, 
+0x3E, 0x3A, 0xF8, 0xBD, 0xED, 0xD8, 0x3E, 0x0C, 
+0x9F, 0x92, 0x3F, 0xC4, 0x23, 0x09, 0x27, 0x46, 
+0x69, 0x63, 0x8B, 0xCB, 0xD1, 0x2B, 0xF4, 0xDE, 
+0x06, 0xA0, 0x6F, 0x00, 0xBB, 0xCF, 0xED, 0x93, 
+0xEB, 0xF8, 0x09, 0x4C, 0xBD, 0xA8, 0x83, 0xCF, 
+0x31, 0xD7, 0xDA, 0x7C, 0xC8, 0x14, 0x12, 0x31, 
+0x46, 0x00, 0x44, 0x95, 0x83, 0xED, 0x6D, 0x6D, 
+0x2D, 0x9C, 0x33, 0xEA, 0x61, 0x84, 0xB4, 0x96, 
+0x24, 0xB9, 0xAC, 0xE9, 0x1E, 0xED, 0x14, 0x1F, 
+0x31, 0x88, 0x05, 0x64, 0x63, 0xA1, 0x08, 0x4C, 
+0xA8, 0x5B, 0xB2, 0x73, 0xBC, 0x8A, 0xB9, 0x79, 
+0x13, 0xD5, 0xA9, 0xF0, 0x04, 0x8E, 0x69, 0x9F, 
+0xAB, 0x73, 0xB2, 0x8D, 0xB1, 0xC3, 0xC4, 0xBD, 
+0x49, 0x10, 0xE7, 0x61, 0xCA, 0xF7, 0xC4, 0x38, 
+0x77, 0x2B, 0x89, 0x7B, 0xF9, 0x40, 0xCF, 0xBA, 
+0xCE, 0xF4, 0x08, 0x57, 0xE2, 0xCF, 0x1B, 0x64, 
+0x6E, 0x42, 0xE1, 0x9C, 0xC2, 0x9C, 0x83, 0xB4, 
+0x91, 0x84, 0xF9, 0xF6, 0x05, 0x66, 0x38, 0x2B, 
+0xFE, 0x08, 0x08, 0x2D, 0x4D, 0x91, 0x49, 0x70, 
+0x13, 0xF9, 0x6E, 0xCF, 
+
+0xB2, 0x19, 0x03, 0x56, 0x21, 0xA6, 0x5B, 0x0D, 
+0xF7, 0x94, 0xFF, 0xF6, 0xF1, 0x80, 0xFA, 0xB7, 
+0x7E, 0x2C, 0x8E, 0x8B, 0x4E, 0xC4, 0x16, 0xBA, 
+0x47, 0x77, 0x34, 0x9A, 0x46, 0x8C, 0x6B, 0x59, 
+0x21, 0x63, 0x55, 0xFB, 0x3F, 0x61, 0xDA, 0x9F, 
+0x30, 0xD8, 0xFA, 0xEB, 0x2B, 0xD6, 0x3F, 0xE8, 
+0x50, 0x4B, 0xAF, 0xE9, 0xC5, 0x90, 0x3E, 0xEA, 
+0xB4, 0x74, 0xB3, 0xBF, 0x78, 0xDD, 0xE9, 0x1E, 
+0x9E, 0xD4, 0x44, 0xEE, 0x83, 0x8C, 0xA4, 0xBB, 
+0x64, 0xA6, 0x4C, 0x0F, 0xAC, 0xD6, 0xC3, 0xD6, 
+0x27, 0xD8, 0x69, 0x0A, 0xEF, 0x65, 0x03, 0x46, 
+0xD6, 0xDC, 0xCF, 0xAF, 0x3E, 0x3A, 0xE3, 0x5B, 
+0xEB, 0x61, 0x5E, 0xDB, 0xCF, 0x11, 0xF6, 0x60, 
+0xF2, 0xCA, 0xAB, 0x49, 0x62, 0x91, 0xE5, 0x5C, 
+0x29, 0x4F, 0xAE, 0xF0, 0x71, 0x43, 0x22, 0x79, 
+0x4B, 0x3F, 0xD8, 0x9A, 0xAF, 0x50, 0x46, 0xD9, 
+0x7C, 0x93, 0x8A, 0x94, 0xC0, 0x73, 0x97, 0x13, 
+0xF7, 0xD0, 0x28, 0xAF, 0x74, 0x18, 0x69, 0xD4, 
+0x9D, 0x0F, 0xAF, 0xBA, 0x5F, 0x26, 0xF0, 0x92, 
+0x63, 0x92, 0xE6, 0xD5, 0x73, 0xDF, 0xAC, 0xE6, 
+0xE9, 0x5B, 0x4D, 0xEE, 0x81, 0xEE, 0x5C, 0xDD, 
+0x39, 0x73, 0xFB, 0xBA, 0x5E, 0xF8, 0x6B, 0x90, 
+0xAE, 0x45, 0xDC, 0x78, 0xDC, 0x79, 0x44, 0x5B, 
+0xC6, 0x09, 0xD8, 0x64, 0xFD, 0x5F, 0x9B, 0x6A, 
+0x5B, 0xB3, 0x47, 0xF6, 0xB4, 0x04, 0x06, 0x36, 
+0x34, 0x76, 0xBE, 0x30, 0x27, 0x9E, 0x3E, 0x2F, 
+0x8B, 0xE9, 0x75, 0x10, 0x20, 0xBE, 0xA7, 0xE9, 
+0x38, 0x3C, 0x2C, 0x37, 0xBC, 0xE6, 0x66, 0x40, 
+0x70, 0x7D, 0x2D, 0x09, 0x48, 0x3D, 0xED, 0x41, 
+0x5B, 0x17, 0x22, 0xE8, 0x88, 0x5D, 0xE8, 0x60, 
+0x13, 0x36, 0x99, 0x50, 0x30, 
+
+0xE9, 0x3A, 0x68, 0x92, 0xD7, 0xED, 0xDC, 0xDF, 
+0xBB, 0x65, 0x16, 0x51, 0x63, 0x04, 0xD2, 0x8E, 
+0x32, 0xF4, 0x21, 0xA7, 0x50, 0xE3, 0x1B, 0x40, 
+0x59, 0x60, 0x64, 0x2C, 0x4D, 0xBA, 0x48, 0x4A, 
+0x39, 0xCA, 0xFB, 0x00, 0xAB, 0xF5, 0x09, 0x29, 
+0x9A, 0x69, 0x94, 0xE9, 0x0C, 0x6C, 0xDF, 0x96, 
+0x92, 0x8A, 0x3A, 0xC8, 0xA7, 0xD3, 0x44, 0x50, 
+0xF5, 0x8C, 0x12, 0xF6, 0x7F, 0x74, 0xE2, 0x71, 
+0x7A, 0x77, 0x5C, 0xA1, 0xE1, 0xE5, 0x42, 0x6E, 
+0xE1, 0x89, 0x61, 0x90, 0x3F, 0xC5, 0x10, 0x86, 
+0x15, 0xC9, 0xD9, 0xE4, 0xA1, 0xFE, 0xB1, 0xA8, 
+0xDC, 0x47, 0x90, 0x65, 0x76, 0xB0, 0x66, 0x3F, 
+0xDF, 0xF8, 0xE6, 0x79, 0x03, 0x15, 0x31, 0x34, 
+0xBB, 0x8E, 0xD8, 0xAA, 0xF8, 0x1C, 0x91, 0x59, 
+0xCC, 0x33, 0x2E, 0x22, 0xA9, 0xF6, 0xBF, 0x98, 
+0xBC, 0xE3, 0xDC, 0x31, 0x4A, 0xF9, 0x34, 0x26, 
+0xBF, 0x90, 0xF6, 0xB8, 0x2E, 0xD3, 0xFF, 0xB1, 
+0x72, 0xE4, 0x32, 0x6F, 0x92, 0xE7, 0x3C, 0xF5, 
+0x89, 0x80, 0x3B, 0x31, 0x81, 0xD0, 0xE2, 0x77, 
+0x86, 0x51, 0x6D, 0xCB, 0xAB, 0x28, 0x2C, 0x16, 
+0xE4, 0x6F, 0xDE, 0x31, 0x76, 0x17, 0x5C, 0x46, 
+0xC6, 0x8F, 0x40, 0x07, 0x9B, 0xE9, 0xFA, 0xA6, 
+0x36, 0xFA, 0xC5, 0xD7, 0x01, 0x21, 0xF5, 0xC9, 
+0xC9, 0x48, 0xC4, 0xB9, 0xAC, 0x29, 0xB6, 0x47, 
+0x5F, 0x00, 0x2C, 0x3C, 0x45, 0xAE, 0x69, 0x1A, 
+0x5F, 0xEF, 0x41, 0x62, 0x1C, 0x72, 0x86, 0x51, 
+0xD8, 0x8C, 0x09, 0x5D, 0x01, 0x7D, 0xA5, 0x36, 
+0x3A, 0x61, 0x86, 0xC0, 0xD0, 0xA7, 0xAA, 0xE6, 
+0x25, 0x1C, 0x55, 0x8C, 0x23, 0x2F, 0x4C, 0x80, 
+0x0D, 0x36, 0xDC, 0x4E, 0xF4, 0xCA, 0x8F, 0x52, 
+0x34, 0x85, 0x50, 0x70, 0x85, 0x0D, 
+
+0x77, 0x0F, 0x8D, 0xC2, 0xDC, 0x72, 0xF9, 0x9E, 
+0x83, 0x45, 0x69, 0x1E, 0x14, 0x5C, 0xF3, 0xA5, 
+0x51, 0xCC, 0xE7, 0x3F, 0xBE, 0x52, 0x1B, 0xC4, 
+0x54, 0xF3, 0xC8, 0x65, 0xE8, 0x1C, 0x9F, 0x15, 
+0x04, 0xC4, 0xA5, 0x7E, 0x95, 0xB0, 0xEF, 0x62, 
+0xAB, 0x24, 0xC8, 0x56, 0x0C, 0xF1, 0xC5, 0x54, 
+0x19, 0x9D, 0x1A, 0xD2, 0xAA, 0xE4, 0x6B, 0xB8, 
+0x69, 0x11, 0xE7, 0x71, 0xF9, 0xC4, 0xF9, 0xF3, 
+0xA7, 0x34, 0x2E, 0xC2, 0x81, 0xBC, 0x5B, 0x7B, 
+0xF9, 0xEC, 0x2F, 0x8E, 0x73, 0x5A, 0x72, 0xA3, 
+0xAE, 0x75, 0x88, 0x20, 0x8B, 0x70, 0xAC, 0xFD, 
+0x9A, 0x04, 0x25, 0x71, 0xDF, 0xAA, 0x40, 0x39, 
+0xB7, 0x64, 0xBB, 0xC9, 0xFC, 0x55, 0x84, 0x4D, 
+0xF2, 0x5E, 0x82, 0xED, 0xCE, 0x38, 0x87, 0x02, 
+0xF1, 0x4F, 0x36, 0x65, 0x60, 0x7E, 0xE7, 0xB7, 
+0x12, 0xE5, 0xFE, 0x83, 0xB2, 0x88, 0x98, 0x3D, 
+0x7E, 0xC5, 0x9E, 0xB7, 0x8F, 0x39, 0x83, 0xB2, 
+0x8A, 0xD5, 0xCB, 0xF4, 0x36, 0x5D, 0xBF, 0x89, 
+0xC1, 0xF7, 0xA3, 0x41, 0xC6, 0x2D, 0xA8, 0x98, 
+0x16, 0xB8, 0x48, 0x36, 0x27, 0x72, 0xE3, 0xEB, 
+0xBC, 0x1F, 0xC6, 0x52, 0x86, 0x32, 0xF6, 0x6C, 
+0x67, 0xB2, 0xD0, 0x4C, 0x7A, 0x43, 0x35, 0xC7, 
+0x52, 0xE1, 0x96, 0x1D, 0xB5, 0xA0, 0x62, 0x7B, 
+0x33, 0xF1, 0xD1, 0xC7, 0x60, 0x65, 0x1C, 0x57, 
+0x56, 0xEA, 0xC0, 0x36, 0x2B, 0xA8, 0xD7, 0x89, 
+0x49, 0x72, 0xE0, 0xBD, 0x00, 0xF6, 0x82, 0x00, 
+0x77, 0xFD, 0x06, 0xFF, 0xE9, 0x79, 0xA7, 0xC7, 
+0x60, 0x84, 0xA7, 0x54, 0x1D, 0x83, 0xEB, 0x4A, 
+0x3C, 0x1A, 0xD7, 0xF4, 0xD9, 0xB4, 0xB8, 0x25, 
+0x86, 0x5A, 0xFA, 0xA0, 0x09, 0x33, 0xD4, 0xB3, 
+0x4D, 0x65, 0xDC, 0x84, 0x65, 0x11, 0x9A, 
+
+0xB9, 0xE4, 0x5B, 0x30, 0xE0, 0x79, 0xBC, 0x8A, 
+0x98, 0x1B, 0x53, 0xD8, 0xF1, 0xA7, 0xB5, 0x40, 
+0xDD, 0x81, 0x1B, 0xA8, 0x70, 0x43, 0x42, 0xCD, 
+0x0F, 0xA8, 0xC2, 0xA0, 0xB7, 0xD9, 0x6D, 0x20, 
+0xFE, 0x44, 0x6F, 0x6C, 0x11, 0x2B, 0x86, 0x54, 
+0x15, 0xA4, 0x3D, 0xE2, 0x8E, 0xAD, 0xE3, 0x94, 
+0x83, 0x1B, 0x38, 0x59, 0x35, 0x5F, 0xED, 0x8F, 
+0x26, 0xAF, 0x23, 0xF3, 0x3F, 0xBF, 0x6A, 0x16, 
+0x4B, 0x7C, 0xB0, 0x22, 0xC5, 0x17, 0x5E, 0xD8, 
+0xD7, 0x71, 0xA3, 0xE8, 0xAE, 0x13, 0x59, 0xE5, 
+0x6A, 0xB5, 0x6F, 0xDF, 0x49, 0x56, 0xA8, 0xCE, 
+0x1F, 0x97, 0x43, 0xC1, 0x1F, 0xE9, 0x7B, 0xF0, 
+0xBF, 0x82, 0x42, 0xF3, 0x0D, 0x2D, 0x56, 0xEE, 
+0x1C, 0xEE, 0x0E, 0xDB, 0x9C, 0xB5, 0x91, 0xCE, 
+0xAE, 0x23, 0x2F, 0x2B, 0x31, 0x35, 0xD1, 0x3C, 
+0x46, 0x05, 0x78, 0xDD, 0xC1, 0xA4, 0xE6, 0x5A, 
+0x36, 0xF6, 0x4A, 0xC9, 0x36, 0x4C, 0xB8, 0x8E, 
+0x8C, 0xCA, 0x0C, 0xB9, 0x7B, 0xD9, 0x8D, 0x14, 
+0x06, 0x30, 0x99, 0x67, 0x6C, 0xBA, 0x7F, 0x6F, 
+0xA1, 0xF8, 0x12, 0xE3, 0xEB, 0x19, 0x3D, 0xB5, 
+0x03, 0x64, 0xD9, 0xF3, 0xB0, 0xE6, 0x3D, 0x44, 
+0xAC, 0xA9, 0xCB, 0x85, 0x40, 0x62, 0xFE, 0x6D, 
+0x58, 0xF7, 0xC2, 0x7B, 0x79, 0x2D, 0x0D, 0x24, 
+0xA2, 0x2D, 0xD0, 0xA5, 0x08, 0x29, 0xB4, 0xD5, 
+0x3D, 0xEC, 0x2C, 0xEA, 0xD0, 0x70, 0x8F, 0xB2, 
+0x9C, 0xB2, 0x77, 0x40, 0xDA, 0xA2, 0x3D, 0x55, 
+0x6D, 0x67, 0x65, 0xB7, 0xA5, 0x9D, 0x03, 0x58, 
+0x64, 0x13, 0x33, 0x03, 0x60, 0xC5, 0x0A, 0x76, 
+0xEE, 0x90, 0x05, 0x18, 0x2B, 0x59, 0x8E, 0xC8, 
+0x27, 0x1C, 0xB8, 0xE7, 0xB7, 0x8B, 0x6A, 0xA2, 
+0xF2, 0xED, 0x76, 0x6D, 0xA3, 0xFB, 0xB7, 0xFD, 
+
+0x8B, 0xF6, 0x79, 0x89, 0x2C, 0x06, 0xD5, 0x9D, 
+0x7C, 0x1F, 0x94, 0x1E, 0x45, 0x70, 0xF2, 0x8D, 
+0x40, 0xB9, 0xBC, 0x21, 0x69, 0x9F, 0xCD, 0xA4, 
+0x7F, 0x8F, 0x6D, 0xCB, 0x6E, 0xBB, 0x60, 0x4F, 
+0x38, 0x0B, 0xD1, 0xED, 0x4A, 0x0A, 0xD7, 0x3A, 
+0xC1, 0x38, 0x6C, 0xD7, 0x83, 0xA9, 0xEA, 0xC6, 
+0x82, 0x7D, 0x2B, 0xDC, 0x4B, 0xDD, 0x0D, 0xD4, 
+0x9B, 0xF0, 0x69, 0x1A, 0xA4, 0x1B, 0x15, 0xA6, 
+0xCB, 0x33, 0xFC, 0x6B, 0x81, 0xFC, 0xED, 0xD3, 
+0x6E, 0x31, 0x3F, 0x76, 0x5C, 0x3D, 0x33, 0x54, 
+0xFA, 0x22, 0x3A, 0x0D, 0x00, 0x7C, 0x87, 0x3A, 
+0x87, 0xC8, 0x20, 0xB3, 0x58, 0x33, 0x6D, 0x95, 
+0xDF, 0xF5, 0xDD, 0xAD, 0xC3, 0x2C, 0x86, 0xF2, 
+0x05, 0xC8, 0x5B, 0x2B, 0x08, 0x43, 0x91, 0xA4, 
+0x37, 0x21, 0x9A, 0xEC, 0xFA, 0xCB, 0xE2, 0x51, 
+0xBB, 0x5D, 0xCE, 0x1F, 0x0B, 0xBB, 0x29, 0xCC, 
+0x86, 0xB9, 0xEB, 0x62, 0x43, 0xCD, 0xBE, 0xC2, 
+0x49, 0xD7, 0x72, 0xAF, 0x7A, 0xE6, 0x33, 0x57, 
+0x69, 0xF8, 0x60, 0x43, 0x85, 0x53, 0xD5, 0x6D, 
+0xC7, 0xD4, 0xDB, 0x55, 0x32, 0xB6, 0xBB, 0x39, 
+0x17, 0xD0, 0xB1, 0xF9, 0x4B, 0x9C, 0x63, 0x61, 
+0x0A, 0x68, 0xF8, 0x34, 0x2D, 0x3A, 0xF2, 0x00, 
+0xC8, 0x65, 0xBA, 0x94, 0x66, 0x7C, 0x95, 0x87, 
+0x77, 0x82, 0x4E, 0x1A, 0x45, 0xC2, 0x58, 0x7E, 
+0x6E, 0x37, 0x69, 0x87, 0xB3, 0xCC, 0x94, 0x46, 
+0xBC, 0x28, 0x2D, 0xA8, 0xE0, 0x92, 0x52, 0xF9, 
+0x04, 0xD7, 0x53, 0xC9, 0xC1, 0xC8, 0x10, 0x4E, 
+0x15, 0x7C, 0x2B, 0xFD, 0xA2, 0x1D, 0x97, 0x20, 
+0x47, 0x3D, 0x7D, 0x15, 0x79, 0x77, 0xFC, 0xC5, 
+0xCE, 0xAB, 0x3B, 0x37, 0x77, 0x7D, 0xA5, 0xE9, 
+0xAE, 0x74, 0x62, 0xE9, 0x4F, 0xB0, 0x08, 0x7D, 
+0xAB, 
+
+0x1A, 0x87, 0xF8, 0x81, 0x5C, 0x6A, 0x88, 0xE2, 
+0x03, 0x54, 0x6A, 0x0E, 0xBC, 0xA8, 0x64, 0x59, 
+0x5A, 0x27, 0xE1, 0x4C, 0x40, 0x64, 0xD1, 0xFA, 
+0x71, 0x13, 0x13, 0xBF, 0xFC, 0xCC, 0xA9, 0x57, 
+0x87, 0x73, 0xBB, 0x30, 0x86, 0x0D, 0xA4, 0x2E, 
+0xC4, 0x3A, 0xD3, 0x97, 0x66, 0xA6, 0x07, 0xE5, 
+0xDE, 0xB2, 0xB9, 0x26, 0xAD, 0x72, 0x8C, 0x08, 
+0xD9, 0xCB, 0xC5, 0x18, 0xFF, 0x00, 0x6B, 0x1E, 
+0xBD, 0x08, 0x92, 0xFA, 0xF6, 0x73, 0x2D, 0x24, 
+0x0A, 0xD9, 0x19, 0xD4, 0x3C, 0x43, 0x8E, 0x2C, 
+0x3A, 0x15, 0x2D, 0x10, 0xF0, 0xB6, 0x6C, 0xC6, 
+0xD3, 0xCA, 0xE9, 0xB7, 0x05, 0xEA, 0x68, 0x35, 
+0x22, 0x8B, 0x30, 0xAF, 0xE7, 0x6F, 0x59, 0xD0, 
+0xA5, 0x03, 0x4F, 0xA3, 0x28, 0xF8, 0xBB, 0x3F, 
+0xBB, 0xF4, 0xD9, 0xED, 0x03, 0x18, 0x42, 0x91, 
+0x6A, 0xCD, 0xC7, 0x87, 0xBE, 0x7E, 0x09, 0xE4, 
+0xB6, 0x1D, 0xC3, 0xB8, 0x73, 0xA8, 0x4A, 0x44, 
+0x7D, 0xC5, 0x6B, 0x59, 0xB6, 0xC3, 0x41, 0x30, 
+0xE8, 0x02, 0xCE, 0x2B, 0x2D, 0x67, 0x79, 0x1F, 
+0x8D, 0x0A, 0x62, 0xB9, 0xC8, 0xFC, 0x2A, 0xB4, 
+0x20, 0x4B, 0xCD, 0xF5, 0x50, 0xE8, 0x5E, 0x1A, 
+0x7E, 0x39, 0x9D, 0x6C, 0xDE, 0xCC, 0xFA, 0xCD, 
+0x70, 0xCE, 0x86, 0x0B, 0xAD, 0xD1, 0x40, 0xA3, 
+0x5D, 0xF6, 0xDD, 0x59, 0x3F, 0x87, 0xC2, 0x2F, 
+0x78, 0x11, 0xD2, 0xF9, 0x67, 0xB0, 0x2F, 0x17, 
+0x5D, 0x47, 0x1F, 0xF1, 0x4F, 0x60, 0x79, 0x42, 
+0x6A, 0xC1, 0xAD, 0x19, 0x53, 0xE5, 0xAC, 0xD0, 
+0xA2, 0x90, 0xE0, 0xC3, 0x47, 0x7D, 0xA3, 0x9A, 
+0xCB, 0xCC, 0x19, 0x6E, 0xC0, 0x68, 0x4C, 0xD4, 
+0x13, 0x8A, 0x29, 0x65, 0xAA, 0x9F, 0xEA, 0xE2, 
+0x68, 0x89, 0x76, 0xF2, 0xDD, 0xBD, 0x4C, 0x2C, 
+0x9D, 0xB8, 
+
+0x0A, 0x3D, 0xFB, 0x9D, 0xB8, 0xF8, 0xCE, 0xFC, 
+0x65, 0xC1, 0x92, 0x53, 0x65, 0x6F, 0x07, 0xE3, 
+0x25, 0x2C, 0x4D, 0x1B, 0x7D, 0x80, 0xDB, 0x86, 
+0x57, 0xDB, 0xC1, 0xB1, 0xE1, 0x87, 0x9A, 0x28, 
+0xEC, 0x4C, 0xDC, 0x18, 0xE7, 0xA4, 0x87, 0xA9, 
+0xE8, 0xD9, 0xA5, 0x02, 0xE9, 0x12, 0x13, 0x54, 
+0xDF, 0x58, 0xC1, 0xFA, 0xD9, 0xF2, 0xD1, 0x65, 
+0x5D, 0x76, 0xB4, 0x69, 0xD3, 0x8F, 0x64, 0x9E, 
+0xD5, 0xC8, 0x80, 0xDD, 0x41, 0xBA, 0x93, 0x5D, 
+0xFA, 0xD8, 0x5E, 0x26, 0x22, 0xDF, 0x36, 0xCD, 
+0x59, 0xE8, 0xF8, 0xE3, 0x3B, 0x19, 0x46, 0x04, 
+0x1F, 0x6C, 0x6B, 0xAC, 0xCB, 0xF4, 0xB5, 0x4A, 
+0x9E, 0xD8, 0xBD, 0x54, 0x10, 0x7C, 0x44, 0x96, 
+0xB9, 0xE0, 0xF3, 0x40, 0x64, 0x2E, 0x12, 0x13, 
+0xA0, 0x95, 0x1B, 0x8F, 0x56, 0x54, 0x2D, 0xF5, 
+0xF3, 0x61, 0xCF, 0xF9, 0x82, 0x4E, 0x4C, 0x00, 
+0xE8, 0xA1, 0xEF, 0x86, 0x22, 0x12, 0x5B, 0xEA, 
+0x43, 0x51, 0x96, 0xB0, 0x59, 0x6A, 0xBB, 0xD9, 
+0x56, 0x2F, 0xD0, 0x3A, 0x5D, 0x68, 0xFF, 0x04, 
+0x77, 0x81, 0xBC, 0x11, 0xCA, 0x32, 0x6D, 0x3E, 
+0x81, 0xE7, 0xCB, 0xDA, 0x61, 0xB9, 0x54, 0x49, 
+0x14, 0x07, 0xE7, 0x39, 0xAF, 0x29, 0x25, 0xDD, 
+0x13, 0x61, 0xAF, 0xAC, 0xEF, 0xF8, 0xC0, 0x34, 
+0xCB, 0x08, 0xF1, 0xC6, 0x60, 0x97, 0x58, 0xCD, 
+0x75, 0xC3, 0xFD, 0x88, 0x9C, 0x51, 0x4A, 0x15, 
+0x30, 0x0F, 0xD6, 0x01, 0x90, 0xAD, 0x0B, 0x85, 
+0x28, 0x45, 0x35, 0xD6, 0x2E, 0xC3, 0x0D, 0xFB, 
+0x89, 0xAC, 0x37, 0x7E, 0xBE, 0x6B, 0x60, 0x7C, 
+0x18, 0xE8, 0xEF, 0xA4, 0xBE, 0x68, 0x81, 0x08, 
+0xC5, 0x55, 0xC5, 0x5F, 0x40, 0x0B, 0x83, 0x33, 
+0x07, 0x06, 0x85, 0x2C, 0x0C, 0x0F, 0xD1, 0x0A, 
+0x25, 0x27, 0xF7, 
+
+0xD5, 0xB7, 0xC2, 0x1E, 0x0F, 0x20, 0x8D, 0x39, 
+0xFC, 0xF3, 0x8C, 0xC3, 0x79, 0x77, 0x89, 0x6E, 
+0x77, 0x11, 0x64, 0xB2, 0x8E, 0x07, 0xC1, 0x06, 
+0x2B, 0x7F, 0x79, 0xCF, 0xD8, 0x57, 0x9A, 0x15, 
+0x03, 0x53, 0x4B, 0x56, 0xEE, 0xB2, 0xF6, 0x37, 
+0x30, 0x62, 0x55, 0xF4, 0x7E, 0x06, 0xC2, 0x9D, 
+0x54, 0x1B, 0x50, 0xB0, 0x52, 0xA0, 0x6E, 0x15, 
+0x5A, 0x26, 0x4E, 0xE7, 0xF8, 0x18, 0x6C, 0xFA, 
+0xB0, 0xF2, 0x2A, 0x9C, 0x52, 0xAD, 0x2C, 0x69, 
+0xE1, 0x08, 0x31, 0xAE, 0xCA, 0x9E, 0x50, 0x7E, 
+0x5C, 0x21, 0xFA, 0x26, 0x18, 0x67, 0xA8, 0xEE, 
+0x95, 0x99, 0xB5, 0x24, 0x1B, 0xB4, 0x0D, 0x39, 
+0x47, 0x93, 0x37, 0xF8, 0x2A, 0xB2, 0x5C, 0x65, 
+0xC9, 0xE1, 0x3C, 0x0B, 0xC7, 0x13, 0xE7, 0x7C, 
+0x8B, 0x90, 0x5C, 0x54, 0x30, 0xB7, 0xDC, 0x64, 
+0x31, 0x74, 0x76, 0x85, 0x31, 0x9F, 0x82, 0x4D, 
+0x07, 0xB7, 0x1D, 0xAF, 0x4C, 0xA2, 0x98, 0x23, 
+0xFA, 0xF6, 0xEF, 0xFF, 0x3B, 0x6A, 0x08, 0xC8, 
+0x84, 0x49, 0xB1, 0xED, 0x7E, 0x13, 0xDC, 0x35, 
+0xE5, 0xEF, 0x94, 0x5A, 0xFE, 0x3A, 0x4C, 0x92, 
+0xBD, 0x65, 0x90, 0xBD, 0x5F, 0x47, 0xF6, 0xF0, 
+0x6F, 0xA4, 0xAA, 0x2F, 0xCE, 0xAC, 0x0E, 0xF1, 
+0xDE, 0x0A, 0x28, 0xFD, 0x99, 0x45, 0x54, 0xF6, 
+0x5D, 0x76, 0x09, 0x03, 0x83, 0xAE, 0x04, 0x4F, 
+0xF4, 0x28, 0xAC, 0x43, 0x74, 0x3C, 0xE4, 0x30, 
+0xE3, 0x67, 0xEE, 0x28, 0x7B, 0xD2, 0xF2, 0xFD, 
+0xB8, 0x0E, 0x8C, 0x7B, 0x8A, 0xDD, 0x22, 0x36, 
+0x47, 0x78, 0x60, 0x2A, 0xC9, 0x54, 0xEF, 0x69, 
+0xAE, 0xF0, 0x20, 0x15, 0x94, 0xD3, 0x5E, 0x19, 
+0x06, 0xD5, 0xBF, 0x4C, 0xB0, 0x9E, 0x7A, 0x9A, 
+0xC3, 0x4E, 0xF8, 0x3C, 0x9C, 0x29, 0x10, 0xEE, 
+0xE9, 0x42, 0x2C, 0x30, 
+
+0x9F, 0x63, 0x0D, 0xF0, 0x5F, 0x42, 0xDB, 0x67, 
+0x8D, 0xD2, 0x72, 0xDD, 0x48, 0xB6, 0xD2, 0x62, 
+0xC8, 0x9C, 0x2D, 0xCF, 0x6C, 0x51, 0xED, 0xBC, 
+0x56, 0x48, 0x50, 0x0F, 0x9B, 0x7E, 0x7A, 0x2C, 
+0x81, 0xC1, 0xE6, 0x6F, 0x58, 0x1F, 0xAB, 0x51, 
+0xB4, 0x45, 0x7F, 0x8A, 0xA0, 0x83, 0x63, 0x56, 
+0x93, 0xF7, 0x9F, 0x9F, 0x84, 0xE3, 0xEF, 0x77, 
+0xC6, 0x42, 0x73, 0xEC, 0x6A, 0x4F, 0x21, 0xC0, 
+0x07, 0x4A, 0x82, 0x06, 0x8D, 0xE7, 0xA1, 0xD5, 
+0x94, 0x8C, 0x84, 0xAB, 0x00, 0xDA, 0xA2, 0x47, 
+0x3F, 0x0F, 0x63, 0xC6, 0xAE, 0x01, 0x82, 0x27, 
+0x76, 0xC1, 0x74, 0xB0, 0x82, 0xE9, 0x8F, 0xBC, 
+0x80, 0x09, 0xE6, 0x6F, 0xF7, 0x8C, 0x2C, 0x55, 
+0x04, 0xCE, 0x3B, 0x10, 0x82, 0x12, 0x3A, 0x31, 
+0x22, 0xE8, 0x8E, 0x51, 0x38, 0xA6, 0xA0, 0x0F, 
+0xB8, 0xA9, 0x3D, 0x56, 0xFD, 0xE3, 0x5C, 0x42, 
+0x52, 0xA6, 0xC1, 0xDC, 0x7A, 0x27, 0xD0, 0xB0, 
+0x3C, 0x37, 0x4D, 0xBD, 0xB2, 0x53, 0xD2, 0xFB, 
+0x32, 0x76, 0x5D, 0x60, 0x8C, 0x15, 0x0E, 0xB5, 
+0xD4, 0x41, 0x3A, 0x2D, 0xF9, 0x25, 0xAF, 0x9F, 
+0x2C, 0xAB, 0x83, 0xDF, 0xD8, 0x81, 0xC9, 0x20, 
+0x3D, 0x42, 0xAC, 0x6B, 0xD5, 0xCD, 0xAE, 0x5A, 
+0x18, 0x2A, 0x2E, 0x04, 0x2C, 0x07, 0x3D, 0x26, 
+0x4D, 0x1B, 0xB6, 0x79, 0xAE, 0x46, 0xCC, 0xDA, 
+0x3D, 0xE6, 0x77, 0x83, 0x90, 0x89, 0xFA, 0x64, 
+0x71, 0x20, 0xDC, 0x4B, 0x12, 0xD5, 0xBB, 0xD9, 
+0xCF, 0xCC, 0xD1, 0xEE, 0xDA, 0x35, 0xA7, 0x17, 
+0x88, 0x8D, 0x2D, 0xAD, 0xB3, 0xEC, 0x40, 0x6C, 
+0xD4, 0x39, 0xA5, 0xDE, 0x6E, 0xDF, 0x82, 0xAF, 
+0x11, 0x33, 0x33, 0x15, 0x6E, 0xC6, 0x79, 0xBE, 
+0x75, 0xE3, 0x58, 0x6D, 0x24, 0x8E, 0x56, 0xA0, 
+0x4D, 0x38, 0x81, 0x78, 0x62, 
+
+0x39, 0xC0, 0xDA, 0x6D, 0xD1, 0x12, 0xA6, 0x03, 
+0x53, 0x52, 0x3A, 0x25, 0xFB, 0xC3, 0xC6, 0x45, 
+0x75, 0x9F, 0xFA, 0x0F, 0x87, 0x01, 0x4C, 0x09, 
+0xE9, 0x73, 0x1F, 0x2C, 0x80, 0x45, 0xB2, 0x74, 
+0x0C, 0xEE, 0xCD, 0xEF, 0xD0, 0x5B, 0x6B, 0xC7, 
+0xE9, 0x7A, 0x83, 0x91, 0x91, 0xD3, 0x20, 0xBD, 
+0x39, 0xFB, 0xAC, 0xE5, 0x73, 0x46, 0xEC, 0x29, 
+0x40, 0x29, 0x01, 0x0F, 0xA2, 0x74, 0xAF, 0xBE, 
+0x5F, 0x2C, 0x4E, 0xA4, 0xD6, 0x94, 0xE9, 0xC8, 
+0x46, 0xA0, 0xD4, 0x29, 0x16, 0x8D, 0x5A, 0x7D, 
+0xEF, 0x0E, 0x5F, 0xF0, 0x69, 0x54, 0xB6, 0xF4, 
+0xAC, 0xFC, 0x44, 0x48, 0xDB, 0xD3, 0xEB, 0x71, 
+0x27, 0x70, 0x65, 0xF7, 0x5C, 0x8B, 0x8C, 0x33, 
+0xBB, 0x3D, 0xAE, 0xA1, 0xBF, 0x38, 0xC4, 0x77, 
+0x3A, 0xC7, 0x61, 0xC8, 0x04, 0xB8, 0x8F, 0x45, 
+0xAF, 0x85, 0xD1, 0x0A, 0x58, 0xD2, 0x19, 0x52, 
+0x9F, 0x2D, 0x63, 0xC6, 0xA8, 0x61, 0xE4, 0xA1, 
+0x63, 0x91, 0xC3, 0x53, 0xF2, 0x15, 0x33, 0x13, 
+0x82, 0xDE, 0xF3, 0x6B, 0x41, 0x88, 0x3D, 0x8F, 
+0x23, 0xB7, 0x21, 0x59, 0x2D, 0xD5, 0xAC, 0x2F, 
+0xD3, 0xDC, 0xC5, 0xC6, 0xFC, 0x64, 0xC6, 0xA8, 
+0x11, 0xFD, 0x5F, 0xF8, 0x69, 0xF8, 0xC0, 0x55, 
+0xA1, 0xB1, 0x35, 0xDD, 0x63, 0x78, 0x36, 0x7A, 
+0xDA, 0xCF, 0x69, 0x7A, 0x82, 0xA9, 0xE7, 0x5D, 
+0x70, 0xBD, 0x86, 0x32, 0xA4, 0x55, 0xE8, 0xE7, 
+0x60, 0x4D, 0x82, 0x31, 0x0E, 0x03, 0x84, 0x39, 
+0x35, 0x8B, 0x57, 0x29, 0x87, 0xBE, 0xAC, 0xF4, 
+0x31, 0xA0, 0x71, 0xD5, 0xFF, 0x05, 0x5D, 0x5F, 
+0x1C, 0x71, 0xA9, 0x8E, 0x7F, 0x74, 0x9A, 0xDF, 
+0x10, 0xFC, 0x6C, 0x11, 0x22, 0xED, 0x45, 0x99, 
+0x27, 0xA2, 0xBE, 0x98, 0x4C, 0x01, 0x7C, 0xF2, 
+0x0D, 0xC3, 0x0B, 0xC5, 0x18, 0xBE, 
+
+0x1A, 0xD5, 0xD3, 0x12, 0xDD, 0xF0, 0x7E, 0x33, 
+0xB3, 0x20, 0x81, 0x31, 0x80, 0x94, 0xE4, 0x0C, 
+0xBB, 0x9E, 0x91, 0x70, 0xC0, 0x75, 0x54, 0xA1, 
+0x95, 0xC2, 0x41, 0xFA, 0xC3, 0xAD, 0x3F, 0x09, 
+0x10, 0xD2, 0x86, 0x5A, 0x38, 0x89, 0x76, 0xB3, 
+0xD9, 0xD6, 0x34, 0x69, 0xD2, 0xA0, 0xF7, 0x19, 
+0xD5, 0xA6, 0x07, 0x29, 0xB6, 0xB2, 0xB6, 0x62, 
+0xDE, 0x95, 0x62, 0xB9, 0x2D, 0x7E, 0xC5, 0x33, 
+0x63, 0x67, 0x74, 0x4F, 0xBC, 0xD6, 0x21, 0x4B, 
+0x72, 0xA0, 0x0E, 0xCE, 0x8F, 0x36, 0x9E, 0x52, 
+0x7C, 0x07, 0x54, 0xA3, 0xDB, 0x8B, 0xF2, 0x50, 
+0x7B, 0xDC, 0x49, 0x1D, 0x8B, 0x5C, 0x3D, 0xA7, 
+0x51, 0xB3, 0x3B, 0x5F, 0xF5, 0xC4, 0xAE, 0x95, 
+0xB5, 0x5D, 0xBD, 0xE6, 0x5F, 0xF3, 0x23, 0x10, 
+0xF0, 0xEA, 0xCF, 0x97, 0x5B, 0x4B, 0xC9, 0x2E, 
+0x1E, 0x76, 0xC4, 0xEE, 0xD8, 0x56, 0xB9, 0x41, 
+0x2E, 0xA2, 0xB8, 0xC3, 0xDB, 0x5E, 0x3A, 0x2A, 
+0x44, 0x5A, 0xE6, 0x56, 0x9A, 0x71, 0xF4, 0x16, 
+0xDF, 0x6F, 0xD1, 0xD1, 0x93, 0xB2, 0xC6, 0xEE, 
+0x75, 0xBB, 0x33, 0x34, 0x11, 0x92, 0x5E, 0x79, 
+0x6F, 0x07, 0x99, 0x90, 0x0D, 0x85, 0x06, 0xB8, 
+0x05, 0x60, 0x49, 0x44, 0x4B, 0x3B, 0xE0, 0xFF, 
+0xB4, 0x64, 0x74, 0x07, 0x91, 0x9D, 0xF1, 0x78, 
+0xE0, 0xE6, 0x11, 0x40, 0x7A, 0x3B, 0x6A, 0x9A, 
+0xFC, 0x14, 0x75, 0x80, 0xDC, 0x3C, 0x8F, 0xC6, 
+0xAD, 0x48, 0x3B, 0xAF, 0x77, 0x0C, 0x86, 0x21, 
+0x8B, 0x1D, 0x95, 0x7E, 0x67, 0xC0, 0xDD, 0xC5, 
+0x28, 0x85, 0x88, 0x91, 0x4D, 0xD6, 0x3E, 0x7A, 
+0x1E, 0xEC, 0xCC, 0xBE, 0x30, 0xAC, 0xFB, 0x0D, 
+0xA7, 0xAA, 0xB6, 0x5A, 0xEE, 0x57, 0x29, 0x3E, 
+0xB7, 0x71, 0x00, 0x54, 0x87, 0x1A, 0x26, 0x12, 
+0x77, 0xE5, 0xDD, 0xDF, 0x78, 0xDC, 0x2D, 
+
+0xCA, 0x97, 0x1B, 0xF3, 0xC0, 0xF2, 0x43, 0xAB, 
+0x20, 0x80, 0xCD, 0x30, 0x26, 0x8B, 0xA2, 0x97, 
+0xD7, 0x9F, 0xCF, 0x31, 0xED, 0x4C, 0x01, 0x65, 
+0x8A, 0xB1, 0x5B, 0x1B, 0x13, 0xF3, 0x6B, 0xA9, 
+0xE9, 0x53, 0xAF, 0xFD, 0x5D, 0xA8, 0x6E, 0xA7, 
+0x98, 0x22, 0x06, 0x5C, 0x80, 0x4D, 0x36, 0xB4, 
+0xC8, 0xE4, 0xA1, 0x21, 0x55, 0xF1, 0x3D, 0x5D, 
+0x9F, 0xF8, 0xE3, 0xB2, 0xD7, 0x37, 0x99, 0x59, 
+0x35, 0xF5, 0x8E, 0xFB, 0x7A, 0xE3, 0xCA, 0xE9, 
+0x73, 0x1E, 0x89, 0x33, 0xD6, 0xDE, 0xB5, 0x82, 
+0x15, 0x92, 0x66, 0x98, 0xD6, 0x4D, 0x03, 0x0B, 
+0x54, 0xD4, 0xFD, 0xB5, 0x62, 0x5C, 0x95, 0xDA, 
+0x0B, 0xC9, 0x9F, 0xEC, 0x48, 0xAE, 0x6F, 0xA6, 
+0x1A, 0xB8, 0xD6, 0xFC, 0x84, 0xF5, 0xC6, 0xC6, 
+0x43, 0xCF, 0x8F, 0x5F, 0x6D, 0xD6, 0x2E, 0xE4, 
+0xD1, 0xF1, 0x53, 0xFE, 0x01, 0x30, 0x10, 0x45, 
+0x99, 0x9C, 0x5B, 0xD9, 0x61, 0xCE, 0xBE, 0xC5, 
+0xF2, 0x3C, 0x38, 0x74, 0xBD, 0x83, 0xBA, 0x4E, 
+0x49, 0x0F, 0x1E, 0x06, 0x61, 0x4A, 0x3D, 0x8E, 
+0x94, 0x9E, 0x15, 0xED, 0x92, 0xE3, 0xA2, 0xCE, 
+0x48, 0x40, 0x3C, 0xC3, 0x55, 0x94, 0x27, 0xB0, 
+0x2D, 0x02, 0x3E, 0xB0, 0x45, 0xDD, 0x88, 0xF9, 
+0x43, 0xE9, 0x46, 0x58, 0x81, 0xD4, 0xFF, 0x0A, 
+0x8B, 0xAB, 0x28, 0xB4, 0x58, 0x66, 0xA8, 0xA2, 
+0xB0, 0xFA, 0x21, 0x0C, 0x02, 0x02, 0x9F, 0xFB, 
+0xFC, 0x13, 0x25, 0x50, 0xC0, 0xAA, 0xE7, 0xC4, 
+0x74, 0x73, 0x59, 0xD2, 0x21, 0xB8, 0x59, 0x08, 
+0xA4, 0x9F, 0x58, 0x3F, 0x33, 0x25, 0x95, 0x05, 
+0x73, 0x17, 0x0F, 0x0D, 0x45, 0x92, 0xAD, 0x99, 
+0x8A, 0x80, 0x91, 0x68, 0x8E, 0x64, 0x8C, 0x1F, 
+0xE9, 0x76, 0x13, 0x3B, 0x7D, 0x6A, 0x7C, 0x5E, 
+0x19, 0x69, 0x5C, 0xC2, 0x86, 0x56, 0x2F, 0xFC, 
+
+0xBF, 0x7E, 0x10, 0xA5, 0x91, 0xE8, 0x0B, 0x41, 
+0x48, 0x06, 0x30, 0x2C, 0x24, 0x3E, 0x34, 0x71, 
+0x0C, 0x4A, 0x81, 0xD7, 0x8B, 0x07, 0x6C, 0x87, 
+0x2F, 0x08, 0xE2, 0x50, 0xB3, 0x0A, 0x41, 0xEC, 
+0x63, 0x3E, 0x58, 0x70, 0x9C, 0x88, 0x90, 0x4F, 
+0xED, 0xF2, 0x3C, 0xBA, 0x31, 0x05, 0xE0, 0x6D, 
+0x0B, 0xCF, 0x1A, 0x53, 0x1E, 0x1D, 0x1F, 0x12, 
+0x63, 0x39, 0x38, 0x28, 0x93, 0x5A, 0xBC, 0xD5, 
+0x73, 0x96, 0x77, 0xDA, 0xBB, 0x6F, 0xD7, 0xB1, 
+0x60, 0x32, 0x64, 0xE0, 0x14, 0xA7, 0xEF, 0xD3, 
+0x94, 0x4E, 0x36, 0xAF, 0x15, 0x4B, 0xB5, 0x5B, 
+0x6C, 0x7F, 0x1D, 0xBB, 0x03, 0x93, 0x1D, 0xDB, 
+0xD9, 0x94, 0x67, 0x41, 0x3C, 0x38, 0xA1, 0x4F, 
+0xD9, 0x9F, 0xE1, 0x28, 0xD3, 0x95, 0x19, 0xA3, 
+0xFE, 0x57, 0x48, 0x3D, 0xA0, 0xBC, 0xCC, 0x97, 
+0xAD, 0x71, 0xE4, 0x93, 0x1F, 0xCA, 0xD4, 0x79, 
+0xD0, 0x37, 0x37, 0x6B, 0x57, 0xED, 0x45, 0x35, 
+0x83, 0xDA, 0x2C, 0xD8, 0x00, 0x4B, 0x2E, 0x80, 
+0x64, 0x29, 0x21, 0xC0, 0x9A, 0x1B, 0x83, 0x0B, 
+0xEB, 0x79, 0xE6, 0x32, 0xB2, 0x5B, 0x07, 0xA0, 
+0x4D, 0x9A, 0x52, 0xF5, 0x13, 0xFB, 0x48, 0x53, 
+0xF0, 0x77, 0x28, 0x26, 0x65, 0x1E, 0xB8, 0xBD, 
+0x69, 0x3E, 0x64, 0x83, 0x7D, 0x9C, 0xB3, 0xCB, 
+0x6C, 0x61, 0xD3, 0x19, 0x00, 0x08, 0x8C, 0xA5, 
+0x6F, 0x40, 0x4B, 0xD6, 0x03, 0x94, 0xEB, 0x64, 
+0x43, 0x3A, 0xD7, 0xB2, 0x92, 0x05, 0xA2, 0x12, 
+0x26, 0x5C, 0x51, 0x58, 0x40, 0xFA, 0x58, 0x02, 
+0x54, 0x74, 0xE8, 0xA4, 0x62, 0xE5, 0x54, 0xC8, 
+0xE4, 0x44, 0x1F, 0xDA, 0xC0, 0xDA, 0xEF, 0x42, 
+0xD0, 0x3D, 0xD5, 0x07, 0x35, 0x97, 0x0F, 0x30, 
+0xD1, 0xB7, 0xBC, 0xD2, 0xD4, 0xE0, 0x4C, 0x81, 
+0xAC, 0xF8, 0x75, 0xE4, 0x26, 0xBB, 0x6C, 0xBA, 
+0x37, 
+
+0x00, 0x75, 0xB1, 0xB7, 0x1C, 0xEB, 0x66, 0x4F, 
+0x44, 0x2A, 0xDF, 0xC6, 0x7C, 0xEF, 0x6A, 0xE4, 
+0xAA, 0x26, 0xD5, 0x08, 0xDF, 0x69, 0xF7, 0x23, 
+0x50, 0x6D, 0x74, 0x8A, 0xD4, 0xDA, 0xBD, 0x4F, 
+0x83, 0x85, 0xD8, 0x9B, 0xCE, 0x0C, 0xCC, 0xCE, 
+0xFC, 0xF5, 0x55, 0xEC, 0xAB, 0x5F, 0x96, 0xEB, 
+0x01, 0x42, 0x39, 0x8D, 0xD7, 0x77, 0x66, 0x4B, 
+0x79, 0xA5, 0x63, 0xC4, 0x44, 0x29, 0xE3, 0x5C, 
+0xFC, 0xF5, 0x83, 0x19, 0xC2, 0x6B, 0xAC, 0x88, 
+0x6E, 0x7A, 0xC5, 0xC8, 0xAB, 0xA5, 0x88, 0xFA, 
+0x34, 0x09, 0xA3, 0x1E, 0x99, 0x27, 0x1B, 0x55, 
+0xE7, 0xA5, 0x49, 0xFA, 0x60, 0x68, 0xAB, 0xEE, 
+0x10, 0xC6, 0x5F, 0x1D, 0xD8, 0xAE, 0x0B, 0x28, 
+0x03, 0xA4, 0x32, 0x28, 0x09, 0x7C, 0x74, 0x6F, 
+0xC7, 0x0E, 0x3E, 0x91, 0xE6, 0x7F, 0xEA, 0x85, 
+0x4A, 0x66, 0x3D, 0x3E, 0x4B, 0xDA, 0x2B, 0xDE, 
+0x48, 0x6D, 0xEA, 0x1C, 0xA3, 0x0A, 0x47, 0xAA, 
+0xC4, 0x38, 0xD8, 0x81, 0x22, 0x88, 0xDC, 0xB6, 
+0xB4, 0x95, 0x06, 0x9C, 0xFD, 0x39, 0xB7, 0xAC, 
+0x2F, 0x36, 0x7A, 0xEC, 0x38, 0x54, 0x33, 0xD6, 
+0xCB, 0x4A, 0x7A, 0x0C, 0x0D, 0x8A, 0x0F, 0x12, 
+0xEE, 0xE9, 0x70, 0x21, 0x83, 0x42, 0x9A, 0xEC, 
+0xC6, 0x44, 0x50, 0xEC, 0x8D, 0xDB, 0x35, 0x24, 
+0x6C, 0x89, 0x55, 0xD5, 0x79, 0x63, 0x08, 0xF6, 
+0xCB, 0x44, 0x48, 0x4A, 0x2B, 0x2F, 0x63, 0x35, 
+0xF5, 0xCA, 0xDF, 0x6B, 0x55, 0x94, 0xD3, 0xD2, 
+0x71, 0xB4, 0x2A, 0x8A, 0x7C, 0xD7, 0xD1, 0xB4, 
+0xD9, 0xAE, 0xFF, 0xD8, 0x30, 0xDA, 0x66, 0xD0, 
+0x79, 0xE7, 0xCD, 0x7E, 0x2F, 0xA3, 0xD2, 0x19, 
+0x72, 0x9B, 0xC9, 0xF2, 0x39, 0x35, 0xAF, 0xDC, 
+0x6B, 0xA4, 0xBC, 0xD4, 0x33, 0x81, 0xE5, 0x87, 
+0x40, 0x99, 0x82, 0x6C, 0xC8, 0x90, 0x43, 0x80, 
+0xFA, 0x61, 
+
+0xF4, 0x62, 0xEC, 0xD0, 0x04, 0xDC, 0xD6, 0xA9, 
+0x8E, 0xAC, 0xEE, 0x85, 0xF8, 0xEB, 0xD8, 0x5E, 
+0x43, 0x43, 0x10, 0x23, 0x09, 0xA7, 0xDC, 0x7C, 
+0x90, 0x5F, 0x3E, 0xEB, 0xA6, 0x85, 0x70, 0xEF, 
+0x52, 0xEA, 0x63, 0xD3, 0x46, 0x10, 0xA9, 0x59, 
+0x8B, 0xF9, 0xBC, 0xDB, 0x16, 0x72, 0x58, 0x8E, 
+0xC7, 0x36, 0xFD, 0x94, 0xE2, 0xCF, 0x9B, 0x65, 
+0x45, 0xE6, 0xC8, 0x39, 0xC7, 0xB4, 0x05, 0xAF, 
+0xF2, 0xCA, 0xF5, 0xD4, 0xCE, 0x6F, 0x51, 0x9E, 
+0xE0, 0x28, 0x0B, 0x18, 0x68, 0x9A, 0xAB, 0xA3, 
+0xC5, 0x1B, 0x9E, 0x46, 0x30, 0xBB, 0xAD, 0x29, 
+0x2B, 0x18, 0x03, 0x6E, 0xA1, 0xC2, 0xBA, 0x52, 
+0x19, 0x4B, 0xA9, 0xF8, 0xDB, 0x30, 0x1E, 0x19, 
+0xB5, 0x26, 0x81, 0x5F, 0xD1, 0x30, 0x6E, 0x6D, 
+0x3A, 0xF7, 0xDB, 0x23, 0xB3, 0x2A, 0xBB, 0x96, 
+0xAA, 0xBF, 0xB9, 0xED, 0xFE, 0x97, 0x20, 0x0C, 
+0x93, 0xC4, 0xF5, 0x3A, 0x73, 0x8A, 0x2E, 0x21, 
+0xFC, 0x4C, 0x08, 0xE7, 0x0F, 0x9D, 0xC2, 0x1D, 
+0xA2, 0x88, 0x09, 0xCA, 0xB7, 0x20, 0x4C, 0xDA, 
+0xDA, 0xC5, 0x86, 0xA8, 0xB1, 0xA8, 0xE8, 0x44, 
+0x00, 0x5B, 0x88, 0xF0, 0x12, 0xF5, 0xF5, 0x88, 
+0xE7, 0xDD, 0xC4, 0x6B, 0x27, 0x81, 0x59, 0x9C, 
+0x22, 0xC4, 0xA4, 0xD6, 0xF9, 0x2C, 0x09, 0xC0, 
+0xA0, 0xE5, 0x0D, 0x97, 0x14, 0xDB, 0x1F, 0x77, 
+0xB0, 0x6F, 0x2C, 0xFE, 0xC9, 0x72, 0x50, 0xED, 
+0xF8, 0x42, 0x86, 0x6D, 0xC1, 0xF6, 0x9F, 0x58, 
+0x0E, 0x36, 0x99, 0xD6, 0x58, 0x13, 0xD3, 0xF1, 
+0xCD, 0xDD, 0xA5, 0xA0, 0x79, 0x85, 0x6E, 0xCA, 
+0x38, 0xB2, 0xCF, 0xF8, 0xA0, 0x86, 0x69, 0xD7, 
+0xA0, 0xAC, 0xE0, 0x3D, 0x99, 0x7C, 0x8D, 0xA4, 
+0x97, 0xAD, 0x3F, 0xAE, 0xC7, 0x4C, 0xEC, 0x0A, 
+0x03, 0x25, 0xA0, 0x62, 0x36, 0xBE, 0xA2, 0xEA, 
+0x52, 0x0C, 0x34, 
+
+0xF7, 0xCE, 0x60, 0x16, 0x9E, 0x75, 0xEA, 0xD8, 
+0x61, 0xFC, 0xA2, 0x49, 0xF8, 0xCF, 0x20, 0xE6, 
+0xE4, 0x85, 0xCD, 0x3E, 0x01, 0xFD, 0xCE, 0xFB, 
+0x0A, 0xDE, 0x7E, 0x23, 0xB0, 0xDD, 0xD9, 0x89, 
+0x1C, 0xA7, 0xD4, 0x10, 0xD1, 0x77, 0xFF, 0x84, 
+0x81, 0x60, 0x5B, 0xB3, 0x40, 0x0C, 0xA1, 0xC8, 
+0x99, 0x38, 0x3A, 0x22, 0x37, 0x70, 0xFF, 0xCC, 
+0xB1, 0xDD, 0xC7, 0x6E, 0x66, 0x49, 0x33, 0x59, 
+0x9F, 0x43, 0x8C, 0x4D, 0x74, 0xB3, 0x05, 0x19, 
+0xB6, 0x9E, 0x9E, 0x09, 0xD0, 0x7C, 0x8A, 0x1F, 
+0xB0, 0x46, 0xE1, 0x6D, 0x8E, 0xD6, 0xCB, 0x84, 
+0x0A, 0x3A, 0xA7, 0xF4, 0x2C, 0xC4, 0xB4, 0xBC, 
+0x08, 0x43, 0xD8, 0x8A, 0xB3, 0xBC, 0x20, 0xED, 
+0xEC, 0x12, 0x4A, 0x09, 0xE0, 0xDE, 0xA7, 0x27, 
+0x52, 0xC5, 0x22, 0xC6, 0x03, 0xE2, 0x48, 0xAE, 
+0x98, 0x90, 0x0B, 0xF1, 0x83, 0xBF, 0x9E, 0xCD, 
+0xBC, 0xC2, 0xD4, 0x84, 0x8F, 0xBE, 0xA9, 0x7F, 
+0x04, 0xBC, 0x87, 0x73, 0xC2, 0xAC, 0x0D, 0x65, 
+0x64, 0xE5, 0x86, 0x45, 0xA9, 0x2D, 0x03, 0x69, 
+0xA1, 0x08, 0x23, 0x9F, 0xA7, 0x7E, 0x3C, 0x82, 
+0x29, 0x3A, 0x0B, 0x8C, 0x65, 0xC0, 0xEE, 0xC8, 
+0x64, 0x19, 0xAD, 0xED, 0x81, 0x4B, 0x40, 0x91, 
+0x6E, 0x92, 0xA8, 0x51, 0x36, 0xEA, 0xD2, 0x25, 
+0x35, 0x50, 0x02, 0x80, 0x90, 0xFA, 0x08, 0x05, 
+0x1F, 0x0A, 0xCE, 0x42, 0x9C, 0xB0, 0x31, 0xCA, 
+0xA1, 0xE6, 0x7F, 0x5E, 0x0B, 0x76, 0x3F, 0x9F, 
+0x32, 0x30, 0xDE, 0xB3, 0x7F, 0xBF, 0x31, 0xE3, 
+0x59, 0xBA, 0x4D, 0x2E, 0x58, 0x29, 0xA8, 0x9A, 
+0xA6, 0x1D, 0x56, 0xC2, 0xDC, 0x8A, 0xDF, 0x72, 
+0xAF, 0xEC, 0x9E, 0xB9, 0xF7, 0x43, 0x1B, 0xB6, 
+0xD3, 0x43, 0x0B, 0x7F, 0x78, 0x0B, 0x64, 0x3E, 
+0x54, 0x2D, 0x93, 0x9B, 0x11, 0xFC, 0x82, 0x72, 
+0x43, 0xD0, 0x44, 0xB4, 
+
+0x2C, 0x9A, 0xB3, 0xD9, 0x16, 0xD6, 0x2A, 0x0A, 
+0x8D, 0xFC, 0x97, 0x32, 0xFC, 0x15, 0x90, 0x14, 
+0x01, 0xAF, 0x0C, 0x85, 0xF5, 0x59, 0x7E, 0x51, 
+0x51, 0x8A, 0x80, 0x0E, 0x88, 0x90, 0x98, 0x7C, 
+0xF6, 0xD4, 0xDF, 0xFC, 0x11, 0xF7, 0x81, 0x83, 
+0xFE, 0x96, 0xB2, 0xE9, 0xE8, 0x26, 0xFD, 0x18, 
+0xE3, 0x43, 0x25, 0x47, 0x7C, 0xF9, 0xD0, 0xB2, 
+0x0C, 0xD3, 0x80, 0x86, 0x2A, 0x16, 0xC8, 0xD1, 
+0x4D, 0x40, 0xAF, 0xB3, 0xA6, 0xC8, 0xF5, 0x8F, 
+0xC1, 0x74, 0x71, 0x93, 0x71, 0xFB, 0xA8, 0x42, 
+0x09, 0x29, 0x6B, 0xA4, 0x20, 0xD7, 0x71, 0x0A, 
+0xEB, 0xA3, 0x54, 0x09, 0x4C, 0x8D, 0xE7, 0xE4, 
+0x9D, 0xBA, 0x38, 0xBE, 0x45, 0xEC, 0xFB, 0x2E, 
+0x77, 0x20, 0xF2, 0xA4, 0x38, 0x9F, 0xCD, 0xCE, 
+0x51, 0xAE, 0xC0, 0x78, 0x41, 0x33, 0xED, 0x20, 
+0x8F, 0xB0, 0x94, 0x11, 0x63, 0xF7, 0x7F, 0xB1, 
+0x4B, 0x66, 0x8E, 0x60, 0x59, 0xC2, 0xFE, 0x99, 
+0x69, 0x7E, 0x9B, 0x51, 0x9B, 0x31, 0x04, 0x9C, 
+0x0E, 0x3E, 0xC5, 0x1F, 0xC1, 0x00, 0xA2, 0xDF, 
+0x5F, 0xF7, 0x89, 0xCD, 0xE0, 0x86, 0xA4, 0xC8, 
+0xA9, 0x1D, 0xD5, 0x60, 0x46, 0x20, 0xF0, 0x47, 
+0x10, 0x3F, 0xE2, 0x9A, 0x74, 0xB9, 0xE9, 0x7E, 
+0xD8, 0xB8, 0x65, 0xE1, 0x96, 0x41, 0x72, 0xC4, 
+0x1E, 0x78, 0x33, 0xC8, 0xCE, 0x6C, 0x6C, 0xD4, 
+0x7F, 0xB2, 0x21, 0xF7, 0xAE, 0x02, 0x87, 0x9E, 
+0xCD, 0xCD, 0xF6, 0xAC, 0x23, 0x73, 0xDB, 0x58, 
+0xA1, 0x7A, 0x3A, 0xCB, 0x73, 0xA7, 0xC1, 0x38, 
+0xB9, 0x5D, 0xB7, 0x28, 0x63, 0xCC, 0x2D, 0x83, 
+0x45, 0x16, 0x61, 0x19, 0x17, 0x3B, 0xB3, 0xAA, 
+0xDC, 0xC7, 0xFD, 0xDA, 0x4C, 0xA5, 0x6B, 0x89, 
+0xD2, 0x1C, 0xA9, 0x03, 0x91, 0x46, 0x62, 0x90, 
+0xA1, 0x84, 0xE7, 0x32, 0x45, 0xF3, 0xD7, 0x4F, 
+0x4C, 0x8A, 0xF0, 0x3E, 0x7A, 
+
+0x22, 0xE6, 0xEA, 0x1A, 0x3C, 0x64, 0x57, 0x63, 
+0x36, 0xF8, 0x78, 0xA3, 0xC9, 0x9C, 0x69, 0x2B, 
+0x80, 0xFE, 0x02, 0x30, 0xC2, 0x6E, 0x7F, 0x74, 
+0x5B, 0xF2, 0xCE, 0xC0, 0x38, 0x2B, 0x42, 0x68, 
+0x2D, 0xFC, 0x68, 0x82, 0xC7, 0x09, 0xEB, 0x4F, 
+0x47, 0xBB, 0xB3, 0x73, 0x06, 0x64, 0x28, 0x4D, 
+0xAB, 0x87, 0x46, 0xCB, 0x26, 0xF7, 0x64, 0xFC, 
+0xB4, 0xC9, 0xC7, 0x2A, 0x3D, 0x74, 0xC4, 0xB2, 
+0xDC, 0x57, 0x13, 0x52, 0x8F, 0xBD, 0xE6, 0xD9, 
+0xCD, 0x90, 0xB1, 0x4C, 0x02, 0xFA, 0xEC, 0x49, 
+0xE7, 0x7C, 0xC3, 0xC1, 0x40, 0xB0, 0x68, 0x6C, 
+0x43, 0x2D, 0xBC, 0x04, 0x29, 0xBB, 0xCC, 0x0A, 
+0xDC, 0x92, 0xCB, 0xF4, 0x5A, 0xE3, 0xCC, 0xDA, 
+0xA8, 0x31, 0xB3, 0x70, 0x7A, 0xD1, 0xE3, 0x0C, 
+0x7C, 0x49, 0x4B, 0xEB, 0xEE, 0x10, 0x48, 0x81, 
+0xF6, 0x19, 0xA4, 0x27, 0x00, 0xCA, 0xA8, 0x19, 
+0x74, 0xD2, 0xEC, 0xE0, 0xB0, 0x81, 0xB9, 0xBA, 
+0x0B, 0xAE, 0xEF, 0xCD, 0x9F, 0x61, 0x5C, 0x39, 
+0x4C, 0x59, 0xB4, 0xF1, 0xF5, 0x25, 0xE4, 0x55, 
+0xA4, 0x2D, 0x22, 0xC5, 0x20, 0x77, 0xF7, 0xE4, 
+0x55, 0xB2, 0x52, 0xCE, 0xF6, 0x96, 0xCB, 0x45, 
+0x17, 0xC0, 0x9E, 0xEA, 0xDE, 0x70, 0x77, 0xE7, 
+0x93, 0x61, 0x50, 0xA8, 0xB3, 0x8F, 0x97, 0xA5, 
+0xA5, 0x68, 0x77, 0x2F, 0xCD, 0x24, 0x1E, 0x27, 
+0x69, 0xB1, 0x31, 0x94, 0xE9, 0x63, 0x30, 0x09, 
+0xB3, 0x1C, 0xA7, 0x58, 0x18, 0x3F, 0xE1, 0xA0, 
+0x8B, 0x7F, 0x7C, 0x88, 0x10, 0xDD, 0x37, 0xD8, 
+0x49, 0xA8, 0xD4, 0x93, 0x46, 0xBD, 0xDD, 0x28, 
+0x9F, 0xE6, 0x71, 0x75, 0x62, 0x33, 0x23, 0x16, 
+0x80, 0x37, 0x78, 0x74, 0x31, 0x28, 0xD8, 0x58, 
+0xA1, 0x4F, 0x33, 0x6F, 0x00, 0x74, 0x14, 0xE2, 
+0xCE, 0x32, 0x8A, 0x30, 0x63, 0xF6, 0xC7, 0xDD, 
+0xF3, 0x47, 0x2B, 0x01, 0xA2, 0x3E, 
+
+0x7F, 0x96, 0x9C, 0xFB, 0x14, 0x68, 0xE9, 0x5D, 
+0xF7, 0x38, 0xB9, 0x84, 0xAF, 0xA9, 0xF1, 0xB1, 
+0x60, 0x5E, 0xE6, 0x27, 0x2E, 0x99, 0x51, 0x3E, 
+0xEA, 0x1B, 0xDB, 0x07, 0xBB, 0x8B, 0x8D, 0xD6, 
+0x22, 0x64, 0x91, 0xB6, 0x43, 0x0A, 0x98, 0xCC, 
+0xB0, 0x70, 0x9C, 0x0F, 0x3D, 0xC8, 0x20, 0x13, 
+0x77, 0x93, 0x08, 0x80, 0x8E, 0xB9, 0xB9, 0xA0, 
+0xFE, 0x01, 0x0F, 0x63, 0x10, 0x8F, 0x59, 0xE0, 
+0x6F, 0x19, 0x40, 0x2F, 0xF2, 0x9D, 0xDA, 0x64, 
+0xF1, 0x62, 0x5E, 0xF0, 0xCF, 0x61, 0x2E, 0x43, 
+0xBC, 0x63, 0x8C, 0xBE, 0xDD, 0xA3, 0x8E, 0xC2, 
+0x6E, 0xE6, 0x53, 0x23, 0x67, 0xF9, 0xB1, 0x8B, 
+0xF7, 0xC8, 0x9C, 0x7F, 0xE5, 0xC1, 0x4C, 0x3F, 
+0xB4, 0x5E, 0xAA, 0xBD, 0xA6, 0x5A, 0xA9, 0xB4, 
+0x7D, 0x6F, 0x01, 0xBE, 0x0D, 0x59, 0x29, 0xAF, 
+0x27, 0x16, 0x6A, 0x66, 0xD0, 0xAB, 0xCC, 0x29, 
+0xDC, 0xCF, 0x0D, 0x5D, 0x28, 0x9B, 0xFB, 0xA0, 
+0xD1, 0xD8, 0x46, 0x57, 0x09, 0x96, 0xA1, 0x78, 
+0xB8, 0xFF, 0x9D, 0xDC, 0x52, 0x27, 0x88, 0xCA, 
+0x3C, 0x58, 0x3F, 0xAB, 0xF8, 0x89, 0x48, 0x46, 
+0x4C, 0xA5, 0x93, 0xA4, 0xA9, 0xE2, 0xD9, 0xED, 
+0x21, 0xDA, 0x2B, 0xE5, 0x23, 0xEE, 0x2E, 0x6A, 
+0x9A, 0x04, 0xA6, 0x39, 0x53, 0xB9, 0xA8, 0xA8, 
+0xE4, 0xCB, 0x09, 0x65, 0x08, 0x4E, 0xD3, 0x55, 
+0x70, 0x8A, 0x4D, 0xB7, 0x44, 0xC8, 0x7E, 0xA2, 
+0x3B, 0xC5, 0xDA, 0xB3, 0x3A, 0xBB, 0x9D, 0xCE, 
+0xA7, 0xE5, 0x0B, 0xE4, 0xD1, 0xFD, 0x39, 0xB5, 
+0x23, 0xF8, 0x1B, 0xF7, 0x1D, 0x9E, 0x12, 0x99, 
+0xEC, 0x1D, 0x84, 0xC9, 0xD6, 0x8A, 0x1A, 0xF5, 
+0x27, 0x93, 0x45, 0x82, 0x9E, 0x07, 0x00, 0xB3, 
+0x5A, 0x08, 0xBE, 0x6E, 0xFB, 0x4B, 0x0F, 0x30, 
+0x76, 0xDC, 0xF5, 0xCF, 0x7C, 0xB6, 0x10, 0x12, 
+0x18, 0x9F, 0x09, 0xFB, 0x88, 0x52, 0x3A, 
+
+0x24, 0x67, 0x5A, 0x8F, 0x8E, 0x4B, 0xC6, 0x0C, 
+0x6B, 0x95, 0x5D, 0x02, 0x15, 0xF2, 0xD2, 0x5E, 
+0x66, 0xDA, 0x61, 0x6C, 0xE8, 0xAF, 0xC3, 0xE8, 
+0xC3, 0xCC, 0x0D, 0xE8, 0x3D, 0x5E, 0x22, 0xBF, 
+0x74, 0x7F, 0x5C, 0x94, 0x7B, 0xCE, 0x98, 0xF5, 
+0x59, 0x02, 0x87, 0xD6, 0xE5, 0x51, 0x79, 0x4E, 
+0xA1, 0xD1, 0x4E, 0xE7, 0xB7, 0x42, 0xCB, 0x1D, 
+0x15, 0x4A, 0xA2, 0x80, 0x66, 0xC5, 0x7D, 0xE8, 
+0x79, 0x0D, 0xEF, 0xFC, 0xEB, 0x7C, 0xAB, 0x9B, 
+0xCD, 0x7C, 0xB9, 0xEC, 0xFD, 0x77, 0xC8, 0x67, 
+0xCA, 0xB3, 0x6E, 0xFE, 0xC4, 0x61, 0x3D, 0x55, 
+0x6E, 0x83, 0x51, 0x8F, 0x2A, 0x55, 0x35, 0x94, 
+0xF6, 0x21, 0x7D, 0xE7, 0x15, 0xC7, 0x9A, 0xF4, 
+0xAD, 0x13, 0x16, 0x65, 0x32, 0xE8, 0x73, 0x93, 
+0x6A, 0xCC, 0x39, 0x87, 0xD2, 0x7F, 0xFF, 0xF5, 
+0xBD, 0x28, 0x9F, 0x6B, 0xC5, 0xA9, 0xBF, 0xCB, 
+0x35, 0x62, 0xF6, 0x0F, 0x4E, 0xFF, 0xF3, 0xD2, 
+0x5B, 0xDE, 0x4F, 0x57, 0x67, 0x36, 0xD7, 0x75, 
+0xB8, 0x52, 0x55, 0x83, 0xFC, 0x0D, 0x70, 0x45, 
+0x35, 0xE1, 0x51, 0x6D, 0x3E, 0x81, 0x15, 0x81, 
+0x40, 0x3A, 0xC0, 0x68, 0x6C, 0x79, 0x07, 0xA9, 
+0x98, 0xF3, 0x34, 0x8F, 0x23, 0x56, 0x6F, 0xF7, 
+0x6E, 0x7C, 0xE1, 0xDB, 0x83, 0xD7, 0xF4, 0xCC, 
+0xE6, 0x03, 0x88, 0x7C, 0xCA, 0x2D, 0x7D, 0xFB, 
+0xCF, 0xBD, 0x06, 0xE2, 0x5B, 0x4B, 0x33, 0x9C, 
+0xBA, 0x84, 0x2F, 0xC2, 0x84, 0xF5, 0xEF, 0xA5, 
+0xDB, 0xE5, 0x47, 0x7A, 0x4A, 0x14, 0xD8, 0xB3, 
+0xDC, 0x1D, 0x14, 0x8A, 0x0F, 0x44, 0xAB, 0xCD, 
+0xB1, 0x1A, 0xC9, 0x26, 0xF8, 0x3D, 0x5C, 0x3E, 
+0x7C, 0x1B, 0xE6, 0xB3, 0x27, 0xCF, 0x08, 0x0C, 
+0x92, 0xB7, 0x49, 0x82, 0xE5, 0x57, 0xF0, 0x93, 
+0xEF, 0xE4, 0x5B, 0x50, 0x0A, 0x9B, 0x16, 0xB9, 
+0xAC, 0x53, 0xF3, 0x0F, 0x81, 0x63, 0x73, 0x68, 
+
+0xFA, 0x04, 0x8A, 0x3A, 0x9C, 0xEA, 0x9A, 0xF8, 
+0x09, 0x4D, 0x2B, 0x6D, 0xE0, 0xE7, 0x8E, 0x8D, 
+0x8D, 0x2F, 0xF9, 0x8A, 0x39, 0xDE, 0x1E, 0xAE, 
+0x0F, 0x79, 0x3C, 0xDA, 0x77, 0xCB, 0x59, 0xDD, 
+0x64, 0xD7, 0xB7, 0xE0, 0x38, 0x8D, 0x73, 0xF6, 
+0x2D, 0x1F, 0x4F, 0x2E, 0xBE, 0x6D, 0x17, 0x69, 
+0x35, 0x12, 0x87, 0x08, 0x57, 0x20, 0x62, 0xAF, 
+0x2E, 0x62, 0x0C, 0x76, 0x04, 0x95, 0x80, 0xB9, 
+0xA0, 0x14, 0xA1, 0x51, 0xCE, 0x60, 0x35, 0x69, 
+0xA8, 0x95, 0x5A, 0x36, 0x25, 0x59, 0x98, 0xE8, 
+0x3D, 0xDF, 0x93, 0xB2, 0x98, 0x78, 0x87, 0x5E, 
+0x3B, 0xEA, 0x84, 0xC6, 0x83, 0x97, 0xCB, 0x11, 
+0xF3, 0x39, 0x3C, 0x89, 0xBD, 0xD2, 0x14, 0xBD, 
+0x43, 0x5C, 0x82, 0x4F, 0x3D, 0x3C, 0x11, 0xA4, 
+0xD2, 0x59, 0xF5, 0x42, 0xFD, 0x6F, 0xCD, 0x4D, 
+0xA1, 0xF3, 0x20, 0x69, 0x5F, 0x5F, 0xE7, 0xB2, 
+0x49, 0xDB, 0xF3, 0xAF, 0xD7, 0xC9, 0xC6, 0xAB, 
+0xDF, 0x86, 0x25, 0x11, 0x83, 0x38, 0x57, 0xAF, 
+0xB1, 0x15, 0xE9, 0xC7, 0x03, 0xE7, 0x8A, 0x52, 
+0x43, 0x6A, 0xD4, 0x50, 0x13, 0xB8, 0xB2, 0x8A, 
+0x9E, 0x3D, 0xAA, 0xEF, 0xB5, 0xA8, 0x46, 0x7F, 
+0xC9, 0x7A, 0xF4, 0x25, 0x2C, 0x41, 0x25, 0x8C, 
+0xEC, 0x43, 0x14, 0xFE, 0x5B, 0xB8, 0x2D, 0x88, 
+0xD6, 0x21, 0x56, 0x12, 0xF8, 0x68, 0x22, 0x97, 
+0xF0, 0xCB, 0x33, 0x63, 0x05, 0x9E, 0xD9, 0x5F, 
+0x36, 0x8D, 0x17, 0x0D, 0xD2, 0xCE, 0x57, 0x7D, 
+0x3A, 0xE7, 0x0B, 0x65, 0x27, 0x93, 0xF8, 0xD9, 
+0x8A, 0xF4, 0xCB, 0xB8, 0x95, 0x26, 0x95, 0x68, 
+0x45, 0x7F, 0xA7, 0x93, 0x3C, 0x3A, 0x33, 0x85, 
+0xEA, 0x85, 0xA9, 0x81, 0xA3, 0x79, 0x19, 0xF2, 
+0x27, 0x21, 0x6A, 0x40, 0x00, 0x2A, 0x55, 0xB1, 
+0x2D, 0xDC, 0x31, 0x04, 0x19, 0x0D, 0xD9, 0x6F, 
+0xB7, 0xB7, 0xB1, 0xCF, 0x7E, 0x44, 0x72, 0xC5, 
+0x10, 
+
+0x8D, 0x16, 0x10, 0x07, 0x15, 0x94, 0x6E, 0x45, 
+0x8D, 0xED, 0x44, 0x63, 0x07, 0xF9, 0xD7, 0x53, 
+0xA8, 0x88, 0x91, 0x72, 0x57, 0xD1, 0xCB, 0x6D, 
+0x7C, 0x8D, 0x94, 0xC5, 0xD0, 0x08, 0xFF, 0x32, 
+0xC6, 0xB2, 0x91, 0x75, 0x48, 0xA3, 0xF1, 0xDE, 
+0x82, 0x02, 0x63, 0x2D, 0x9E, 0x1D, 0x5A, 0x48, 
+0xA5, 0x3B, 0xD2, 0xF1, 0x72, 0x31, 0x06, 0x1B, 
+0x9A, 0x3A, 0x5A, 0xB1, 0x49, 0x72, 0x14, 0x11, 
+0xA1, 0x54, 0x17, 0x48, 0xFD, 0xA4, 0xE1, 0x3E, 
+0xCA, 0x74, 0xF5, 0x69, 0x2B, 0x2B, 0x47, 0xE9, 
+0x08, 0xB1, 0xA9, 0x73, 0x9D, 0xDD, 0xAD, 0x81, 
+0x77, 0x7E, 0x01, 0xCD, 0x01, 0x24, 0xD5, 0xBF, 
+0x10, 0x11, 0xAD, 0x3C, 0x6C, 0x14, 0x60, 0x28, 
+0x88, 0x32, 0x67, 0x16, 0x0A, 0x8E, 0x25, 0x76, 
+0xED, 0x14, 0x2F, 0x39, 0x2A, 0x76, 0x0F, 0x94, 
+0x3D, 0xFE, 0xC6, 0x2A, 0xCF, 0x0A, 0x93, 0xF6, 
+0xB8, 0x92, 0xC7, 0xD0, 0xEF, 0x32, 0x7B, 0x34, 
+0xB4, 0xF7, 0x78, 0x62, 0xB6, 0x82, 0x8C, 0x6B, 
+0xA8, 0xC7, 0x13, 0xBE, 0x33, 0xA7, 0x6A, 0xE1, 
+0xF7, 0xD0, 0xC0, 0xC2, 0x2F, 0xD7, 0x2B, 0xF7, 
+0xFF, 0x7D, 0xF2, 0xFC, 0x31, 0x62, 0xBB, 0x1D, 
+0x77, 0x1A, 0x08, 0xA2, 0xB2, 0x4A, 0x96, 0x64, 
+0x49, 0x3B, 0x7C, 0xF3, 0x56, 0xB9, 0x77, 0xC9, 
+0xB9, 0x78, 0xD6, 0x48, 0xDA, 0x91, 0x25, 0xA9, 
+0xBC, 0x83, 0xF4, 0x84, 0xAE, 0x29, 0x6F, 0x21, 
+0x7B, 0xFC, 0x43, 0xA0, 0x75, 0x10, 0xB5, 0x3C, 
+0xAA, 0x98, 0xE5, 0x8E, 0xE9, 0x04, 0xC5, 0x2D, 
+0xA8, 0x72, 0xCA, 0xFC, 0x48, 0x7D, 0x94, 0x0B, 
+0x22, 0x76, 0xB8, 0x8E, 0xB2, 0x2A, 0x3D, 0xC1, 
+0xD8, 0x65, 0xFC, 0x22, 0xC3, 0x43, 0xB2, 0xA2, 
+0x44, 0x16, 0xC6, 0x7F, 0xD0, 0x4F, 0xDF, 0xA9, 
+0xCF, 0x95, 0xF7, 0xC6, 0x85, 0x98, 0x01, 0xA8, 
+0xC7, 0x5A, 0x23, 0x3C, 0x2D, 0xDE, 0x28, 0x7B, 
+0x02, 0x70, 
+
+0x9D, 0xBE, 0x74, 0x3A, 0x44, 0x40, 0xF8, 0x1A, 
+0xE4, 0xE3, 0x53, 0xA6, 0xC8, 0x0C, 0xB9, 0x1E, 
+0x7E, 0xB7, 0xFF, 0x73, 0xAA, 0x51, 0x5D, 0x92, 
+0x63, 0x76, 0xB7, 0xAD, 0xC3, 0x93, 0x4B, 0x17, 
+0x43, 0xF7, 0xB5, 0x37, 0x81, 0x3E, 0x35, 0x42, 
+0xBE, 0xF3, 0x86, 0x49, 0x6E, 0xEF, 0x85, 0xC1, 
+0xFD, 0x65, 0xC1, 0xA4, 0x8F, 0xBA, 0x78, 0xF7, 
+0x85, 0xB0, 0xB8, 0xFC, 0x09, 0x5B, 0xD4, 0x42, 
+0x1E, 0xF8, 0x74, 0x03, 0x53, 0xE1, 0x3F, 0x1E, 
+0x87, 0x23, 0xE0, 0x65, 0x17, 0x18, 0xD6, 0x21, 
+0xD2, 0x18, 0x62, 0x97, 0x8F, 0xCE, 0x8F, 0x1F, 
+0x67, 0x58, 0x60, 0x8A, 0xB1, 0x3D, 0x82, 0x5C, 
+0xAC, 0x56, 0xD9, 0x4C, 0xC2, 0x33, 0x81, 0xD3, 
+0x6E, 0xB8, 0x78, 0x93, 0x13, 0x44, 0xE7, 0x14, 
+0x38, 0xB5, 0x4A, 0xBF, 0x7A, 0x58, 0x0B, 0x1B, 
+0x58, 0xBD, 0x92, 0x23, 0xAB, 0x4C, 0x80, 0x57, 
+0x1F, 0xBD, 0x2B, 0x7B, 0xEF, 0x0A, 0x1B, 0xD1, 
+0x9C, 0x7D, 0xDA, 0xD8, 0x0B, 0x63, 0xE1, 0x88, 
+0x0A, 0xE7, 0x0E, 0xB4, 0xA2, 0x3C, 0x3C, 0xCC, 
+0xC2, 0x26, 0x7C, 0xB6, 0x87, 0x37, 0xDB, 0xB0, 
+0xB9, 0x72, 0xEF, 0xE4, 0x91, 0x7A, 0xFB, 0x42, 
+0x41, 0x3C, 0x4B, 0x6D, 0xCD, 0x31, 0x39, 0xA3, 
+0x4F, 0x2A, 0xC2, 0x04, 0x75, 0x3D, 0x07, 0x05, 
+0x06, 0x92, 0xF1, 0x18, 0xFB, 0x78, 0x61, 0x37, 
+0x50, 0xBD, 0x6D, 0xC1, 0x15, 0xE7, 0xD2, 0x1E, 
+0x2C, 0xCD, 0x41, 0xCF, 0xD3, 0x30, 0x48, 0x03, 
+0x14, 0x41, 0xBE, 0x38, 0xB3, 0xDE, 0xA3, 0x62, 
+0x18, 0x74, 0xC3, 0xDF, 0xA7, 0xFB, 0xDA, 0x43, 
+0x53, 0x24, 0xB7, 0x5C, 0x34, 0x10, 0xBA, 0xA8, 
+0xF0, 0x6F, 0x3A, 0x6C, 0xC9, 0x43, 0x49, 0xC2, 
+0x9F, 0xC6, 0x8B, 0x06, 0xD1, 0x5B, 0x7D, 0x81, 
+0xED, 0xCD, 0xA9, 0xF2, 0x3D, 0x29, 0x1C, 0x72, 
+0xA3, 0xA0, 0xCB, 0xD1, 0x37, 0xAF, 0xC5, 0x73, 
+0x68, 0x10, 0xD3, 
+
+0x47, 0xB6, 0x58, 0x6D, 0xEE, 0xD8, 0x6C, 0x35, 
+0x32, 0x3B, 0x22, 0x1A, 0x03, 0xC6, 0xC0, 0xC5, 
+0x78, 0xAB, 0xAB, 0x32, 0x6C, 0x07, 0x78, 0x16, 
+0x88, 0x93, 0xFF, 0xF7, 0xC8, 0x7B, 0xE2, 0xDA, 
+0x59, 0x36, 0x19, 0x8B, 0x1F, 0x9D, 0x70, 0x79, 
+0x36, 0xB0, 0x15, 0x18, 0xDC, 0x53, 0x50, 0x26, 
+0x0D, 0x60, 0x8D, 0xB6, 0x05, 0x51, 0xEA, 0x03, 
+0x73, 0x49, 0x06, 0x62, 0x80, 0x98, 0xF9, 0x81, 
+0x4C, 0xA0, 0xBC, 0xAE, 0x3E, 0xC5, 0x7D, 0xD4, 
+0x54, 0x26, 0x74, 0x17, 0x38, 0x5B, 0xF3, 0x15, 
+0x1A, 0x6A, 0x58, 0x98, 0x12, 0x52, 0xBF, 0xEC, 
+0xA4, 0x48, 0xDE, 0xBB, 0x00, 0xC4, 0x3E, 0xD3, 
+0xA8, 0x2B, 0x6E, 0x77, 0xAA, 0x9F, 0xA7, 0x7D, 
+0x06, 0x1C, 0xD0, 0x0C, 0x7B, 0x6B, 0xAC, 0xFD, 
+0x68, 0x37, 0x4B, 0x11, 0x7B, 0x54, 0x6F, 0xA3, 
+0x7C, 0x6F, 0x06, 0xAF, 0x73, 0x83, 0x39, 0x12, 
+0x11, 0x40, 0x66, 0x60, 0x5B, 0xF2, 0x73, 0x3F, 
+0x02, 0xF4, 0x1D, 0x98, 0x71, 0x61, 0x36, 0xAB, 
+0x35, 0x04, 0x8B, 0xB7, 0x61, 0x02, 0x17, 0x7E, 
+0x1F, 0x85, 0xDC, 0x0F, 0x6B, 0x52, 0xA1, 0x0E, 
+0xF0, 0x10, 0xF8, 0xA2, 0x87, 0x4B, 0xE9, 0x2F, 
+0x01, 0x6E, 0xF5, 0x3E, 0x49, 0xA2, 0xE2, 0xD9, 
+0x00, 0x9E, 0x55, 0x6C, 0xBB, 0xBD, 0x9C, 0xEA, 
+0x6A, 0x65, 0x7B, 0x74, 0xB9, 0xA4, 0x03, 0x66, 
+0x15, 0x6A, 0xC2, 0xC5, 0x62, 0x15, 0x21, 0x77, 
+0x7B, 0xA1, 0xAD, 0xE0, 0xBD, 0x25, 0xA5, 0xBD, 
+0xB2, 0x8F, 0x8A, 0x0F, 0x69, 0x75, 0xFF, 0xEF, 
+0x54, 0x0D, 0xFC, 0xD9, 0x07, 0x0F, 0x2B, 0xC1, 
+0x84, 0x14, 0x45, 0xF1, 0xBF, 0x70, 0x7A, 0x69, 
+0xE8, 0xA5, 0x9C, 0x1B, 0x10, 0xF2, 0x44, 0xC6, 
+0x80, 0x4F, 0x5D, 0xF5, 0x90, 0x29, 0xEF, 0x78, 
+0xE1, 0x4E, 0x00, 0xBF, 0xA2, 0xFE, 0x6A, 0x6C, 
+0xBB, 0x59, 0xBA, 0xAE, 0xF9, 0x23, 0x43, 0xA3, 
+0x80, 0x34, 0x12, 0x97, 
+
+0xB1, 0x12, 0x16, 0x1C, 0xC8, 0x9E, 0x49, 0x0D, 
+0x80, 0x17, 0x2B, 0x95, 0x18, 0x63, 0xBB, 0x93, 
+0xA1, 0xFB, 0xE1, 0xBE, 0x3F, 0xF4, 0xDB, 0xFD, 
+0xA5, 0x26, 0xBA, 0x96, 0x2D, 0x9C, 0x10, 0x8C, 
+0x28, 0x44, 0x91, 0xD7, 0x5A, 0x96, 0xC7, 0x15, 
+0xEB, 0x8F, 0xEA, 0x24, 0x3A, 0x70, 0x3E, 0x11, 
+0x34, 0x1E, 0x51, 0x6F, 0x82, 0xA0, 0xEB, 0x2B, 
+0xBD, 0x5C, 0x70, 0x49, 0x40, 0xC6, 0x8F, 0x70, 
+0xF0, 0x47, 0x87, 0x0A, 0xD2, 0xD1, 0xDA, 0x4A, 
+0x78, 0xDD, 0x69, 0x77, 0xB3, 0x38, 0x0F, 0x87, 
+0xB5, 0x45, 0x0F, 0x2D, 0x1A, 0xBD, 0xA2, 0xF6, 
+0xCC, 0xFD, 0x5D, 0xB3, 0x84, 0x7A, 0x99, 0x78, 
+0xD2, 0x89, 0xA7, 0x5C, 0x34, 0x5A, 0x55, 0x0F, 
+0xBA, 0xFD, 0x84, 0xC0, 0x77, 0x36, 0x5F, 0xE4, 
+0x0B, 0xF6, 0x39, 0xE3, 0xEF, 0xA5, 0xAA, 0x56, 
+0xE1, 0xF5, 0x6A, 0xCD, 0x12, 0x95, 0xB5, 0xFB, 
+0x81, 0xD2, 0x52, 0x74, 0x1A, 0x63, 0xD3, 0xAF, 
+0x16, 0x7A, 0x71, 0xA0, 0x76, 0xF2, 0x18, 0x65, 
+0xBE, 0xF7, 0xED, 0x4C, 0xF5, 0x81, 0xED, 0x7B, 
+0x76, 0x8D, 0xDB, 0x34, 0x7A, 0x05, 0x56, 0x49, 
+0xA9, 0x24, 0x34, 0xFA, 0x19, 0x18, 0x16, 0x1F, 
+0x6E, 0x76, 0xF8, 0x2C, 0xDD, 0x4C, 0x33, 0x03, 
+0xBB, 0x27, 0x98, 0xCF, 0xA0, 0xCA, 0xD0, 0x9D, 
+0xDF, 0xE6, 0xFD, 0x46, 0x03, 0x7F, 0x93, 0x00, 
+0xD5, 0x77, 0x2C, 0x94, 0xF5, 0xE9, 0x69, 0x65, 
+0x1B, 0x5C, 0x7A, 0x1C, 0x75, 0xBF, 0xBF, 0x60, 
+0x7B, 0x5C, 0xB5, 0x34, 0x81, 0x39, 0xDF, 0x4A, 
+0xB8, 0x52, 0x62, 0x02, 0xCD, 0xBE, 0x99, 0xF7, 
+0x8C, 0x78, 0x88, 0x42, 0xBF, 0x45, 0xC5, 0x7C, 
+0xFE, 0x60, 0x32, 0x65, 0x2D, 0x73, 0xED, 0xD8, 
+0xCC, 0x81, 0xBD, 0x62, 0xD2, 0x45, 0x76, 0x4D, 
+0xFE, 0x17, 0x5F, 0x42, 0x00, 0x44, 0x08, 0x13, 
+0x63, 0x00, 0x73, 0xB5, 0xC0, 0x54, 0x26, 0xC1, 
+0x66, 0xD0, 0x2C, 0x46, 0x81, 
+
+0xCA, 0x46, 0xB1, 0x9B, 0xE4, 0xB9, 0x0C, 0xFD, 
+0xDE, 0xF6, 0xDB, 0x6D, 0x69, 0x45, 0x3A, 0x65, 
+0x49, 0x1A, 0x8E, 0xDB, 0x0E, 0xFD, 0x99, 0xAB, 
+0xED, 0x4F, 0x7B, 0xF3, 0x1D, 0x80, 0x71, 0x5B, 
+0xE9, 0x08, 0x20, 0x66, 0xDC, 0xC0, 0x3C, 0xB4, 
+0x39, 0x36, 0xB9, 0x41, 0xAE, 0x0D, 0x70, 0x82, 
+0xE8, 0x0F, 0xC1, 0xEC, 0xBB, 0x86, 0xFC, 0xE5, 
+0x8D, 0x7F, 0xAF, 0x9B, 0x0D, 0xED, 0x90, 0xEA, 
+0x9E, 0x88, 0xF0, 0xF5, 0x33, 0x74, 0x4B, 0xF3, 
+0xEA, 0x4B, 0xB1, 0x3A, 0x16, 0xA8, 0x30, 0x6F, 
+0x7C, 0x23, 0xC3, 0x2C, 0xDD, 0x6A, 0xFB, 0x45, 
+0xB3, 0xC2, 0x6A, 0xD9, 0x3B, 0xDC, 0x4E, 0xB0, 
+0x58, 0x60, 0xA5, 0x23, 0xB9, 0xC5, 0xCB, 0x13, 
+0xC2, 0xB8, 0x6F, 0x22, 0xA6, 0xE1, 0x8F, 0xC0, 
+0xC3, 0x99, 0x23, 0x41, 0xE5, 0x4C, 0x67, 0x0F, 
+0x92, 0xA6, 0x51, 0xBD, 0x5C, 0x83, 0x15, 0x87, 
+0xDC, 0x3B, 0x78, 0x00, 0x3C, 0xB3, 0xE9, 0x13, 
+0xAF, 0x15, 0xE1, 0x20, 0x5A, 0x5C, 0xE2, 0x01, 
+0xC2, 0x06, 0x8A, 0x68, 0xFC, 0xDC, 0x31, 0x46, 
+0x53, 0x5A, 0xE3, 0x05, 0x07, 0x8D, 0xE9, 0x77, 
+0x67, 0xBC, 0xB4, 0x0C, 0x27, 0x71, 0x73, 0x4C, 
+0x6B, 0x5B, 0xC1, 0xE0, 0xC6, 0x52, 0x39, 0x6C, 
+0x28, 0x04, 0xC7, 0x0E, 0xA7, 0xDF, 0x20, 0x8E, 
+0xB2, 0x2E, 0x03, 0x79, 0x0E, 0x86, 0xF7, 0x2D, 
+0xD3, 0x1D, 0x98, 0x9D, 0x76, 0x79, 0xC0, 0xCA, 
+0xB8, 0x2E, 0xBE, 0xF2, 0x2F, 0xC1, 0x27, 0x62, 
+0x1C, 0x41, 0x4D, 0xA6, 0x2A, 0x51, 0xB4, 0x3A, 
+0xEE, 0x64, 0x9A, 0xE8, 0xDC, 0x14, 0xEF, 0x9F, 
+0x36, 0xF6, 0x8C, 0xBB, 0xF9, 0xD2, 0x96, 0x38, 
+0x78, 0xC8, 0xD2, 0x06, 0xC9, 0x8C, 0xEE, 0x53, 
+0xC6, 0x10, 0x29, 0x43, 0x7B, 0xBA, 0xA1, 0xFC, 
+0x6C, 0x08, 0xEA, 0x24, 0x79, 0xED, 0x19, 0x71, 
+0x4A, 0x33, 0xE2, 0x74, 0x20, 0xA2, 0x67, 0x4D, 
+0x13, 0x03, 0x47, 0xFD, 0x69, 0xEE, 
+
+0x06, 0xF6, 0xF7, 0x4E, 0x2D, 0xE9, 0x93, 0x0B, 
+0x9E, 0xE7, 0xCF, 0x50, 0x59, 0x8E, 0x73, 0xD0, 
+0xCB, 0x06, 0x61, 0x10, 0xDE, 0xCA, 0xED, 0x13, 
+0xFC, 0x50, 0x93, 0x8B, 0x92, 0x96, 0xCB, 0x47, 
+0xBE, 0xB5, 0x4D, 0xDD, 0xB5, 0x56, 0xAB, 0x31, 
+0x4A, 0xF7, 0x54, 0x23, 0x1A, 0xD1, 0xDC, 0xC6, 
+0x69, 0x0E, 0xBB, 0x34, 0x3E, 0xE2, 0x0C, 0x4F, 
+0x90, 0x0F, 0x8A, 0x6D, 0xF0, 0x88, 0x9D, 0xF5, 
+0xFD, 0xBB, 0xEC, 0x32, 0xDA, 0xF3, 0xAA, 0x52, 
+0xEE, 0x64, 0x6B, 0x25, 0x2E, 0xF1, 0x6A, 0x50, 
+0x56, 0x34, 0x5F, 0xB5, 0x87, 0x05, 0x58, 0x81, 
+0x10, 0x5F, 0x6D, 0x90, 0x23, 0xA7, 0xC8, 0x7B, 
+0x48, 0xE1, 0x62, 0xF9, 0x2E, 0x43, 0x43, 0x3F, 
+0xBA, 0x4A, 0x37, 0xC6, 0xC0, 0x0C, 0x6A, 0xF9, 
+0x37, 0x76, 0x1D, 0x37, 0x6D, 0xF7, 0xA7, 0xE2, 
+0xDA, 0x39, 0x12, 0x88, 0x0A, 0xFD, 0x71, 0xBB, 
+0x82, 0x03, 0x76, 0x41, 0x7D, 0xBE, 0x1A, 0x91, 
+0xD5, 0xB1, 0x9C, 0x35, 0xD9, 0x65, 0x8C, 0xDA, 
+0x3D, 0x3A, 0x43, 0x34, 0x08, 0x40, 0x7C, 0x41, 
+0x1F, 0x9F, 0xA5, 0x32, 0x8C, 0x9C, 0x37, 0x2D, 
+0xC4, 0x30, 0x85, 0x8E, 0xB0, 0xEA, 0x58, 0x00, 
+0x12, 0x56, 0x3D, 0x89, 0x7B, 0x09, 0xC8, 0x87, 
+0x90, 0xA2, 0xC1, 0xB8, 0x8F, 0xEA, 0xBA, 0x59, 
+0x68, 0x0C, 0x29, 0xE2, 0xD9, 0x64, 0xFC, 0x5E, 
+0x3B, 0x7A, 0x41, 0x5D, 0xEC, 0xCE, 0x13, 0x76, 
+0x30, 0xF2, 0xA0, 0xF5, 0xF8, 0x95, 0x4B, 0xF4, 
+0x3B, 0xEC, 0xF7, 0x22, 0x1A, 0xEE, 0xA6, 0xF5, 
+0xFF, 0x30, 0x72, 0x31, 0x10, 0x41, 0x1D, 0x36, 
+0xF4, 0x57, 0x44, 0xA7, 0xFA, 0xDB, 0x82, 0x71, 
+0x35, 0x63, 0x9B, 0x0D, 0x99, 0x16, 0xE9, 0x2F, 
+0x6C, 0xF7, 0x1A, 0x69, 0x33, 0xDB, 0x89, 0x9C, 
+0x5D, 0xE3, 0xF0, 0x1D, 0x20, 0xFF, 0xA8, 0x64, 
+0xB6, 0x84, 0xAC, 0x5E, 0xC3, 0x69, 0x6D, 0xE6, 
+0xAA, 0x16, 0x63, 0x03, 0x3E, 0xD7, 0x7F, 
+
+0x82, 0x3B, 0xD4, 0x46, 0xD2, 0x7C, 0x26, 0xAB, 
+0x61, 0x91, 0x18, 0xE4, 0x96, 0x68, 0x2D, 0x79, 
+0x5A, 0xAC, 0x26, 0x22, 0x6E, 0x95, 0x30, 0x13, 
+0xCC, 0xB9, 0xA9, 0x41, 0xC1, 0xCE, 0x11, 0x12, 
+0xEA, 0x4C, 0x3A, 0x51, 0x6A, 0xDD, 0xE3, 0x1A, 
+0x95, 0xDD, 0x7D, 0x9F, 0xE9, 0x88, 0xF7, 0x01, 
+0xAB, 0xEA, 0xCB, 0xB3, 0xCA, 0x6C, 0x7E, 0xA2, 
+0x7C, 0xAF, 0xED, 0xC5, 0x51, 0x53, 0x35, 0x99, 
+0x5D, 0xF9, 0x11, 0x81, 0xA7, 0x53, 0xED, 0xCD, 
+0xF5, 0xDF, 0xB6, 0xF8, 0xD8, 0x93, 0xA1, 0x42, 
+0xCC, 0xCF, 0x75, 0xAC, 0x3C, 0x45, 0xCA, 0x9B, 
+0xDE, 0x53, 0x65, 0x01, 0x03, 0x01, 0xE5, 0x21, 
+0xAE, 0x77, 0x42, 0x8D, 0xD8, 0x32, 0x06, 0x68, 
+0xFB, 0x80, 0x82, 0x2E, 0x67, 0x3B, 0x79, 0xB2, 
+0x83, 0x93, 0x83, 0x89, 0xB8, 0x56, 0x74, 0xD7, 
+0xB4, 0xEE, 0x42, 0x1A, 0x86, 0x4C, 0x09, 0x4F, 
+0xF5, 0x81, 0xFA, 0xE6, 0x15, 0xC8, 0xC1, 0xA2, 
+0x22, 0x31, 0xB7, 0x75, 0x85, 0xDA, 0xB3, 0x97, 
+0x47, 0xC2, 0x58, 0xA9, 0xC3, 0x4D, 0x99, 0x5C, 
+0xA2, 0xB2, 0x97, 0x4D, 0x33, 0x5A, 0xAF, 0x8C, 
+0x05, 0xED, 0xAD, 0x12, 0x75, 0x42, 0x3E, 0x29, 
+0x6D, 0x4C, 0xF0, 0xD2, 0x59, 0x3F, 0x07, 0xB1, 
+0x2A, 0x8D, 0x22, 0x12, 0x62, 0xB9, 0x41, 0x4A, 
+0xF5, 0x09, 0x67, 0x61, 0x80, 0xB8, 0x32, 0xE0, 
+0x37, 0x0A, 0xA5, 0xF1, 0x90, 0xCE, 0xF2, 0x0F, 
+0x35, 0x10, 0xA1, 0x08, 0x8B, 0xE6, 0x58, 0x4F, 
+0xFE, 0x8B, 0xE6, 0xDF, 0x2B, 0x76, 0xCD, 0x11, 
+0x2D, 0x81, 0xE9, 0x65, 0x60, 0xB8, 0xB0, 0x6C, 
+0x8B, 0xA4, 0x6E, 0xBC, 0x82, 0x68, 0xDB, 0x0C, 
+0xBD, 0xC4, 0xED, 0x67, 0x84, 0xBC, 0xEE, 0x3C, 
+0x9E, 0x53, 0x97, 0xFA, 0xB1, 0x27, 0x86, 0x03, 
+0xC7, 0xD6, 0xFE, 0x9A, 0x88, 0x43, 0xC2, 0x2A, 
+0x26, 0xDC, 0x49, 0x21, 0x33, 0xFA, 0xCC, 0x95, 
+0x0C, 0x64, 0x71, 0x51, 0x91, 0xC3, 0xE2, 0x2E, 
+
+0xB4, 0x87, 0xF1, 0x67, 0x6C, 0x12, 0x86, 0x9A, 
+0xCF, 0xC9, 0x11, 0x79, 0x26, 0x15, 0xA4, 0x0F, 
+0x40, 0xF7, 0x9F, 0x11, 0x25, 0xE2, 0xF3, 0xB3, 
+0x70, 0xEA, 0x4F, 0x44, 0xB9, 0xAC, 0xAF, 0x26, 
+0xF4, 0xEF, 0xD2, 0x5F, 0xC1, 0x11, 0x66, 0xF6, 
+0xCD, 0x03, 0xB1, 0xD0, 0x8B, 0x46, 0xE0, 0xF8, 
+0x24, 0x2A, 0x62, 0x72, 0x65, 0xAC, 0xF0, 0x2A, 
+0x32, 0x32, 0x36, 0x4D, 0x7E, 0xC2, 0x1B, 0x58, 
+0xB0, 0x0C, 0x26, 0x7C, 0xE1, 0xE3, 0x5D, 0x1C, 
+0x36, 0x79, 0x1A, 0x1D, 0x35, 0x0D, 0xEE, 0xBD, 
+0x48, 0xB7, 0xA1, 0xF2, 0x1D, 0xDB, 0x3C, 0xC0, 
+0x5E, 0x50, 0xDA, 0xD9, 0x1A, 0xDE, 0xF3, 0xA3, 
+0x95, 0x9E, 0x4B, 0xEB, 0x04, 0xED, 0xB9, 0x4E, 
+0x05, 0x6C, 0x34, 0x98, 0xD5, 0x24, 0xFC, 0x0C, 
+0x11, 0x46, 0x3A, 0x97, 0x36, 0x53, 0x66, 0xC8, 
+0xAD, 0x0E, 0xEA, 0x10, 0x43, 0x98, 0x91, 0x27, 
+0xCB, 0x5B, 0x8D, 0x99, 0xB8, 0x31, 0xE3, 0x43, 
+0xCC, 0xBD, 0xA5, 0x5C, 0x24, 0xB0, 0x20, 0x26, 
+0x0E, 0x3C, 0x09, 0x2D, 0x66, 0x2F, 0xC5, 0xB7, 
+0xBD, 0xA3, 0x8B, 0x32, 0x7E, 0x24, 0xAB, 0x91, 
+0x3E, 0xFB, 0x8F, 0x44, 0xCB, 0x56, 0x07, 0x3F, 
+0x76, 0x72, 0xAF, 0x38, 0x64, 0xE9, 0x23, 0x7D, 
+0xFC, 0x2A, 0x99, 0x51, 0x89, 0x25, 0xF7, 0xA1, 
+0x81, 0x83, 0x1B, 0x7F, 0x20, 0x45, 0x63, 0xF1, 
+0x4E, 0x9E, 0x4C, 0x20, 0xFA, 0x53, 0xC2, 0x86, 
+0x17, 0xB2, 0x2D, 0xEA, 0xD5, 0x50, 0xCD, 0xF6, 
+0x35, 0x29, 0x07, 0x18, 0xDD, 0xA1, 0xB3, 0xC2, 
+0xE3, 0x81, 0x03, 0xD7, 0x51, 0x10, 0xF2, 0xB8, 
+0x1D, 0x9F, 0x5A, 0xEE, 0x45, 0x29, 0xE4, 0x36, 
+0xB7, 0x85, 0xE4, 0xF1, 0x9B, 0x7F, 0xF1, 0x8F, 
+0xEC, 0x63, 0xD2, 0x25, 0xA2, 0x4B, 0xF0, 0x10, 
+0xF5, 0x9D, 0x8E, 0x59, 0x66, 0x41, 0xE5, 0xD8, 
+0xE0, 0xDB, 0x1F, 0x86, 0xBE, 0xBE, 0xE2, 0xC4, 
+0xC9, 0x15, 0x51, 0xA5, 0x18, 0x07, 0xCC, 0x7C, 
+0x35, 
+
+0x8B, 0xF4, 0x4D, 0x23, 0x14, 0xBC, 0x7E, 0x00, 
+0x5C, 0x9C, 0xAB, 0x9A, 0x8E, 0x23, 0x38, 0x7E, 
+0xF9, 0xA2, 0x69, 0xDE, 0xE6, 0x52, 0xA6, 0xA3, 
+0xDF, 0x9D, 0xD3, 0x26, 0x4B, 0x12, 0xA0, 0xC8, 
+0x88, 0xC5, 0x80, 0xC1, 0xE3, 0xD6, 0x6E, 0x59, 
+0x79, 0x07, 0xCE, 0x9C, 0x87, 0x2B, 0x7F, 0x84, 
+0x93, 0x5D, 0x78, 0x55, 0x4A, 0xE5, 0x0C, 0xC7, 
+0x44, 0xD7, 0x24, 0x33, 0x56, 0xE8, 0xF4, 0xBA, 
+0x63, 0x53, 0x1E, 0x08, 0xC7, 0x32, 0x51, 0x62, 
+0xDC, 0x35, 0x01, 0xD2, 0xA2, 0x8A, 0xC5, 0x1E, 
+0x36, 0xC6, 0xF3, 0xBE, 0x30, 0x97, 0x5E, 0xDC, 
+0x3A, 0x7F, 0xD8, 0x86, 0x33, 0xEB, 0x53, 0x93, 
+0x50, 0x55, 0x31, 0x39, 0x4C, 0xCF, 0x84, 0x02, 
+0xD6, 0x89, 0x95, 0x37, 0x8A, 0x75, 0x09, 0x0C, 
+0x6C, 0x22, 0xCB, 0x59, 0xDF, 0x75, 0xC0, 0x33, 
+0x6D, 0x92, 0x82, 0xC3, 0xB9, 0x00, 0xA5, 0x22, 
+0xE3, 0x1D, 0xF4, 0x54, 0xBB, 0x01, 0xA0, 0x09, 
+0x42, 0x68, 0xDA, 0xE8, 0x20, 0x35, 0x03, 0x9F, 
+0xF6, 0xF3, 0xD6, 0x8A, 0x20, 0xF8, 0x83, 0x78, 
+0xED, 0xAF, 0x6C, 0x5D, 0xFE, 0x5D, 0x3A, 0x46, 
+0xFC, 0x1A, 0x3D, 0x98, 0xAE, 0x29, 0xCD, 0xD4, 
+0xB9, 0xCD, 0x23, 0x2B, 0xDB, 0x11, 0x39, 0x5B, 
+0xDD, 0x89, 0x10, 0x0A, 0x28, 0x4E, 0x9B, 0xDF, 
+0x3D, 0xCA, 0xFB, 0x7F, 0x50, 0x47, 0xBB, 0x06, 
+0xE6, 0x3F, 0xF2, 0xDC, 0xE4, 0x8C, 0xF7, 0xBE, 
+0xEA, 0x47, 0x64, 0xF0, 0x40, 0xE2, 0x2C, 0xE5, 
+0xCE, 0x81, 0xBE, 0xF5, 0x14, 0x09, 0x66, 0x4D, 
+0x7F, 0x2D, 0x63, 0x27, 0xC3, 0xDC, 0xCB, 0xDC, 
+0x15, 0x7E, 0xB2, 0x1F, 0xC5, 0xBE, 0xAD, 0x61, 
+0x52, 0x7B, 0x99, 0xAB, 0x63, 0xAE, 0x0B, 0xAC, 
+0x4F, 0x96, 0xEF, 0x7B, 0x0F, 0xBC, 0xBA, 0xB9, 
+0x6E, 0x0D, 0x65, 0x06, 0x48, 0x4E, 0xC3, 0xC3, 
+0x5E, 0x8F, 0x9C, 0xCF, 0x66, 0xFB, 0x1C, 0xC6, 
+0x97, 0x04, 0xEC, 0x6C, 0x38, 0x30, 0xE0, 0x40, 
+0x7E, 0x2C, 
+
+0x65, 0x25, 0xCE, 0xA0, 0x5A, 0xB5, 0xE3, 0x80, 
+0x25, 0x12, 0x82, 0x01, 0xE0, 0xDC, 0x66, 0xA6, 
+0x7A, 0x32, 0x4E, 0xB3, 0x36, 0x1C, 0x8C, 0x79, 
+0x40, 0x50, 0x19, 0x71, 0xAF, 0x14, 0x4D, 0x18, 
+0xC8, 0x34, 0x8F, 0xA4, 0xA4, 0x1D, 0xDE, 0x40, 
+0xA2, 0xB0, 0x81, 0xF3, 0xFF, 0x9A, 0xB9, 0x18, 
+0xC3, 0x82, 0x07, 0x92, 0x05, 0xA7, 0xAE, 0x6C, 
+0xFD, 0xDC, 0xDA, 0x4E, 0xD6, 0x87, 0xE5, 0xE4, 
+0xC6, 0x85, 0x43, 0xD7, 0x95, 0x92, 0xED, 0x89, 
+0x01, 0xFD, 0x8D, 0x22, 0x18, 0x78, 0x8E, 0xFE, 
+0x87, 0x94, 0x14, 0xA2, 0x33, 0x91, 0xB4, 0x70, 
+0x7A, 0x6C, 0x5E, 0x1A, 0x35, 0xC2, 0x90, 0x11, 
+0x71, 0x2D, 0x7B, 0xA0, 0x82, 0x2F, 0xD3, 0xBC, 
+0x1A, 0x87, 0x4C, 0xF8, 0x82, 0x34, 0xE8, 0xA3, 
+0x8D, 0x59, 0x1F, 0xBD, 0x04, 0x74, 0x19, 0x2B, 
+0xEC, 0x8F, 0x9D, 0x6A, 0xFD, 0x1E, 0x73, 0x00, 
+0xBD, 0xB4, 0x59, 0xF2, 0x8A, 0x70, 0xC8, 0xB6, 
+0xF4, 0x80, 0xD0, 0xE1, 0x3A, 0x3D, 0x94, 0xC5, 
+0x48, 0x97, 0x43, 0x06, 0x49, 0x83, 0x9F, 0x90, 
+0x29, 0xC6, 0xA9, 0x6A, 0xBA, 0xE4, 0x03, 0x32, 
+0x22, 0x25, 0xE1, 0x7E, 0x76, 0xD1, 0x7D, 0xC3, 
+0x9C, 0x6A, 0x54, 0xAB, 0xC7, 0x70, 0x1F, 0x50, 
+0x39, 0xFC, 0xDE, 0xCE, 0x59, 0xF8, 0x88, 0xE1, 
+0xCC, 0x63, 0x3D, 0x6F, 0x86, 0x28, 0x4E, 0xD2, 
+0xC5, 0xE0, 0xD9, 0x7C, 0xA3, 0x5D, 0x91, 0x13, 
+0xF5, 0x7D, 0xB1, 0xE9, 0xF9, 0x0D, 0x4F, 0x71, 
+0xC1, 0xF5, 0xB0, 0x7F, 0xF2, 0xEE, 0xA1, 0xD7, 
+0xE6, 0x02, 0x60, 0xBF, 0x25, 0x33, 0xB4, 0x91, 
+0xB5, 0xAF, 0xAA, 0xC1, 0x66, 0x30, 0x36, 0xA7, 
+0x12, 0xC2, 0x00, 0xC1, 0xE6, 0xD1, 0xBD, 0xE8, 
+0xBD, 0x3E, 0x79, 0xD0, 0x2A, 0xFF, 0x3B, 0x64, 
+0xC3, 0xF2, 0x59, 0x3D, 0x5E, 0x0C, 0x22, 0xFD, 
+0x05, 0xD5, 0xDA, 0x08, 0xB9, 0x25, 0xCB, 0x5C, 
+0x59, 0x5C, 0x31, 0x30, 0x9F, 0x35, 0x73, 0x09, 
+0x89, 0x48, 0x7E, 
+
+0x63, 0x1D, 0xF5, 0x59, 0xFA, 0xC2, 0x9C, 0x38, 
+0xA2, 0x9F, 0x12, 0x73, 0x60, 0xA4, 0xD9, 0x63, 
+0x2A, 0xAB, 0x0C, 0xC2, 0x92, 0x95, 0x7E, 0xBB, 
+0x9F, 0x14, 0xA0, 0xE2, 0xCF, 0x64, 0x19, 0xFA, 
+0xF2, 0x98, 0xD3, 0xB3, 0xC0, 0x52, 0xCB, 0x25, 
+0x44, 0xF4, 0xB5, 0x8D, 0x46, 0xE5, 0x85, 0x26, 
+0xF9, 0xBB, 0x43, 0x71, 0x1B, 0xD0, 0xC8, 0x8A, 
+0xDB, 0xEE, 0xED, 0x71, 0xFF, 0x56, 0x07, 0x60, 
+0x53, 0x6D, 0x89, 0x60, 0x24, 0xF1, 0xC9, 0x59, 
+0x21, 0x1E, 0xC6, 0x0A, 0xB4, 0x2E, 0x32, 0x6C, 
+0x4B, 0x50, 0x46, 0x9A, 0xE6, 0xC9, 0x0A, 0x18, 
+0x78, 0xDF, 0xD9, 0x9B, 0x4C, 0x36, 0xEB, 0xE0, 
+0xB1, 0x14, 0x7C, 0x5B, 0x59, 0x5A, 0x8A, 0xE8, 
+0xEA, 0x0C, 0x40, 0xAC, 0x8D, 0x3F, 0x92, 0x08, 
+0x56, 0x15, 0x92, 0x48, 0x69, 0x6F, 0x81, 0x31, 
+0x52, 0x28, 0x53, 0xB4, 0x62, 0xC4, 0x24, 0xC2, 
+0xF1, 0xD6, 0x77, 0x0E, 0xBF, 0xBC, 0x27, 0xB6, 
+0x58, 0x1D, 0x37, 0xB2, 0xF5, 0x4B, 0x1A, 0xDA, 
+0xC8, 0x3E, 0xA5, 0x6C, 0xCC, 0x97, 0x40, 0x56, 
+0x75, 0xC6, 0x6F, 0x13, 0xD2, 0x24, 0x6B, 0xB0, 
+0xE6, 0xE2, 0x51, 0xD4, 0x75, 0x21, 0xAD, 0x48, 
+0x37, 0xAE, 0xD8, 0xB7, 0x52, 0x1B, 0xAA, 0x04, 
+0xD9, 0x90, 0xBB, 0x82, 0x19, 0xD7, 0xD3, 0xEA, 
+0xDD, 0x2C, 0x58, 0x52, 0x35, 0xC5, 0x7B, 0xA4, 
+0xA9, 0x62, 0xE7, 0xCA, 0x60, 0xD0, 0xB9, 0xB5, 
+0xE1, 0xFD, 0x3E, 0xAD, 0x76, 0xCC, 0x0C, 0x00, 
+0x9C, 0x79, 0xEB, 0x2B, 0x5E, 0x18, 0x42, 0xB6, 
+0x79, 0xF4, 0xD8, 0xDF, 0x2C, 0xE6, 0x55, 0xE5, 
+0x8E, 0xBD, 0xBE, 0x32, 0xA7, 0xC4, 0x88, 0x1D, 
+0x6E, 0x38, 0xD3, 0xC2, 0xA5, 0x42, 0x29, 0x3B, 
+0x2C, 0xCD, 0x78, 0xC8, 0xE4, 0x74, 0x42, 0xB2, 
+0x7F, 0x8D, 0x44, 0xF7, 0x4A, 0x55, 0xBA, 0xF1, 
+0xC7, 0x68, 0x9F, 0x4E, 0x77, 0x91, 0x02, 0xE1, 
+0x42, 0xEC, 0xAE, 0x4F, 0x80, 0xE2, 0xDC, 0xCD, 
+0x49, 0x33, 0x20, 0x18, 
+
+0x72, 0x2B, 0x29, 0xFC, 0xBC, 0x88, 0x51, 0xA6, 
+0x6B, 0x44, 0xF0, 0xF3, 0x11, 0x05, 0x4A, 0xEE, 
+0xB1, 0x60, 0x64, 0xD3, 0x40, 0x5F, 0x50, 0x71, 
+0xCD, 0x6C, 0xF4, 0x7A, 0x7F, 0x50, 0x26, 0x84, 
+0x7D, 0xD3, 0xA8, 0x6E, 0x3D, 0xAD, 0x62, 0x4D, 
+0x4A, 0x42, 0x7B, 0x21, 0xFF, 0xF9, 0x8B, 0x10, 
+0x0D, 0x80, 0x91, 0x8D, 0xE6, 0x01, 0x69, 0x87, 
+0x79, 0x4D, 0x35, 0x4D, 0x73, 0x7E, 0x38, 0x3A, 
+0xB6, 0x04, 0x00, 0x6D, 0x1D, 0xEA, 0x6E, 0x55, 
+0x9F, 0xD6, 0x7A, 0x7F, 0xC0, 0x3C, 0x48, 0xF2, 
+0xD4, 0xBA, 0xE0, 0x04, 0x83, 0xDC, 0xF3, 0x42, 
+0x0A, 0x96, 0x53, 0x9E, 0x5A, 0x78, 0x03, 0x30, 
+0x66, 0x63, 0x38, 0x4B, 0x93, 0xC5, 0x08, 0x97, 
+0x0C, 0x1E, 0xD8, 0x60, 0x78, 0xBE, 0x10, 0xCD, 
+0x6E, 0x8B, 0x4E, 0x4C, 0xB1, 0xC7, 0x52, 0xA8, 
+0x9F, 0xD7, 0x3C, 0x77, 0xA3, 0x77, 0xC2, 0x8D, 
+0xE4, 0x4C, 0xBB, 0x1E, 0xCA, 0xE7, 0x98, 0x6E, 
+0xD3, 0xA4, 0xA2, 0x1F, 0x07, 0xAE, 0x2E, 0xA1, 
+0xED, 0x80, 0x53, 0x15, 0xE3, 0x29, 0x97, 0x82, 
+0xF5, 0x85, 0x0B, 0x31, 0xE4, 0xFC, 0x93, 0x4F, 
+0x0A, 0x7F, 0x66, 0xFB, 0x9A, 0xD1, 0xE0, 0x76, 
+0xEB, 0xF1, 0xAA, 0x03, 0x28, 0x34, 0xF3, 0x8B, 
+0xE0, 0xED, 0x3D, 0x7B, 0xC4, 0x3B, 0xBD, 0x65, 
+0x4B, 0x4A, 0xDD, 0xA8, 0x22, 0x28, 0x88, 0xDA, 
+0x7D, 0x18, 0xCE, 0x5F, 0xB1, 0xFB, 0x63, 0xFE, 
+0xF3, 0x4D, 0xFB, 0xA8, 0x23, 0x67, 0x11, 0x84, 
+0x22, 0x2F, 0x4E, 0xEA, 0x3D, 0xE2, 0xEC, 0x5E, 
+0xE7, 0xB6, 0x9C, 0x8E, 0x78, 0xBE, 0x4F, 0x30, 
+0x75, 0x5A, 0x0D, 0xE9, 0xDB, 0x13, 0xEF, 0x61, 
+0x38, 0x47, 0x6F, 0xF4, 0xE9, 0x1C, 0x89, 0x36, 
+0x77, 0x2F, 0x0E, 0xAF, 0x9D, 0x39, 0x59, 0xF3, 
+0xCD, 0x14, 0xCB, 0xF0, 0xA8, 0xFD, 0x7A, 0x99, 
+0xBF, 0x4F, 0xE6, 0x85, 0x9A, 0x54, 0xDD, 0x3B, 
+0xCF, 0x28, 0xE8, 0x2E, 0x5D, 0x04, 0xC7, 0xC8, 
+0xDD, 0x75, 0xE7, 0x1E, 0x4B, 
+
+0x47, 0x60, 0xAE, 0x40, 0x00, 0x99, 0xE8, 0x73, 
+0x81, 0x81, 0x70, 0xD5, 0xD8, 0x98, 0xF0, 0xAD, 
+0xCF, 0xFC, 0xC8, 0x53, 0xB8, 0xB9, 0x9B, 0x45, 
+0xFA, 0xC0, 0x91, 0x95, 0x71, 0x15, 0x38, 0x5E, 
+0x19, 0x62, 0xC9, 0x90, 0x51, 0x81, 0x27, 0xAA, 
+0x1D, 0x2C, 0x42, 0x5E, 0x39, 0x28, 0x42, 0x00, 
+0xB3, 0x81, 0x6B, 0x8A, 0xB8, 0xC4, 0xF8, 0x16, 
+0x75, 0xBC, 0x4D, 0x2D, 0xA4, 0x20, 0xCA, 0x7C, 
+0x92, 0xE6, 0xBE, 0x36, 0x59, 0xCF, 0x90, 0xE5, 
+0x53, 0x15, 0x3E, 0x02, 0xC4, 0xA8, 0xFB, 0x8F, 
+0x86, 0x84, 0x24, 0xD3, 0xD9, 0xA8, 0xF2, 0xAD, 
+0xDF, 0xB4, 0x86, 0x75, 0xBC, 0xD1, 0x2A, 0x59, 
+0x4B, 0x4F, 0xE7, 0xF3, 0x8D, 0xA3, 0xA5, 0xC4, 
+0xDC, 0x9E, 0x05, 0x7B, 0x4A, 0xB3, 0x45, 0x9E, 
+0xF2, 0x39, 0x22, 0xC5, 0x0C, 0x4F, 0x0E, 0xAE, 
+0xC8, 0x7D, 0x56, 0x19, 0x54, 0x40, 0x5A, 0x4D, 
+0x77, 0x55, 0x20, 0x39, 0xEC, 0x8C, 0x2F, 0xCD, 
+0x61, 0x1E, 0x13, 0xAD, 0x7A, 0xE6, 0x47, 0x89, 
+0x23, 0x22, 0xDA, 0x6C, 0x3A, 0x8D, 0xE0, 0x55, 
+0xBE, 0xC8, 0x2D, 0x65, 0xCD, 0xEA, 0x05, 0xF5, 
+0x83, 0x0D, 0x8A, 0x81, 0x92, 0x3C, 0xD2, 0xE9, 
+0x87, 0xFF, 0x94, 0x8E, 0x71, 0x1F, 0x89, 0x50, 
+0x36, 0x2C, 0x06, 0x8B, 0xFA, 0x4A, 0x2E, 0x9B, 
+0xBE, 0x14, 0xBE, 0x81, 0x8A, 0x02, 0x03, 0xC0, 
+0x0A, 0xBA, 0x19, 0x87, 0x78, 0x9A, 0x1B, 0x0A, 
+0x4F, 0x37, 0xD9, 0x20, 0x58, 0xA8, 0x4B, 0x00, 
+0xAC, 0x28, 0x53, 0x13, 0xDC, 0xCC, 0x8F, 0xFA, 
+0x2A, 0xAD, 0x99, 0xA8, 0x4E, 0x17, 0x30, 0xD9, 
+0xA3, 0x79, 0x99, 0x59, 0x42, 0xE3, 0xC6, 0x82, 
+0x5B, 0x19, 0x6E, 0x68, 0xEC, 0xDD, 0xA9, 0x8E, 
+0xAC, 0x6F, 0xB8, 0x22, 0x80, 0xD7, 0xF5, 0x7E, 
+0x16, 0xB1, 0x16, 0xEB, 0xFB, 0x9D, 0x26, 0x86, 
+0x7D, 0x8B, 0x18, 0x6C, 0xA9, 0xB4, 0x35, 0x93, 
+0x2C, 0x3B, 0xD1, 0x7B, 0x1B, 0x44, 0x91, 0xFF, 
+0x82, 0x7F, 0xFB, 0x48, 0xC7, 0x68, 
+
+0x09, 0xBB, 0x43, 0xE8, 0xF2, 0x54, 0xC7, 0xE7, 
+0xFF, 0xE5, 0x88, 0xF2, 0xE9, 0xD0, 0xC2, 0x1E, 
+0x1C, 0x95, 0x68, 0x3D, 0xAA, 0x6F, 0x5E, 0x4E, 
+0x04, 0x85, 0x31, 0x5A, 0x23, 0x9C, 0x0A, 0x09, 
+0x3F, 0xB1, 0x12, 0x01, 0x3E, 0xF0, 0xB5, 0x6D, 
+0x00, 0xA7, 0x97, 0x95, 0x30, 0x00, 0xE4, 0xD9, 
+0x1E, 0xA8, 0x66, 0xBD, 0x3E, 0x7B, 0xB6, 0x3B, 
+0xF8, 0x1B, 0x55, 0x55, 0x2B, 0x0B, 0x56, 0xB2, 
+0xA4, 0xA0, 0x07, 0x8E, 0xB9, 0xC7, 0xF7, 0xFD, 
+0x8B, 0x77, 0x27, 0x9E, 0x65, 0xB9, 0x78, 0x0E, 
+0xE1, 0xE3, 0x2C, 0xDA, 0xD1, 0xE0, 0xD8, 0x7A, 
+0xAC, 0xB2, 0xAB, 0x9C, 0x8E, 0x53, 0xBE, 0x66, 
+0x47, 0x96, 0x87, 0x87, 0xAA, 0x0D, 0xCE, 0x47, 
+0x23, 0x1A, 0x40, 0x3A, 0x0A, 0x2C, 0x15, 0x63, 
+0x0E, 0xE6, 0xA4, 0xE2, 0x00, 0x9D, 0x7D, 0x75, 
+0x4A, 0xE6, 0x60, 0x40, 0x9A, 0xDD, 0x92, 0xE3, 
+0xE6, 0x2A, 0x45, 0x9D, 0x89, 0xAA, 0x75, 0x8B, 
+0x42, 0x42, 0x88, 0x4E, 0x1A, 0x89, 0x40, 0x25, 
+0xF1, 0xB2, 0x78, 0x00, 0xB8, 0x7D, 0x6B, 0x85, 
+0x63, 0x00, 0x6A, 0x36, 0xD4, 0x70, 0x6A, 0x12, 
+0xEB, 0xF1, 0xA3, 0xFA, 0x84, 0xD7, 0xF9, 0xEF, 
+0xD3, 0x3F, 0xD0, 0xF7, 0xD2, 0xA9, 0xB3, 0x02, 
+0x0C, 0x49, 0x25, 0x48, 0x05, 0x43, 0x34, 0xE0, 
+0x4B, 0xAD, 0x0E, 0x24, 0x51, 0xFD, 0x22, 0xCA, 
+0xE5, 0xE2, 0x51, 0xFA, 0x60, 0x8D, 0x7E, 0xE3, 
+0xB0, 0x0A, 0x0F, 0xA9, 0x97, 0xE1, 0x0C, 0x45, 
+0xD7, 0xAA, 0x53, 0xF6, 0x06, 0x1F, 0x13, 0x4D, 
+0x37, 0x33, 0x9D, 0x1A, 0x54, 0x85, 0x53, 0xA1, 
+0x42, 0x79, 0x88, 0x78, 0x58, 0x47, 0xF1, 0xA7, 
+0x4D, 0x7E, 0xF2, 0x57, 0xC0, 0xE3, 0x3C, 0xE4, 
+0x6D, 0x47, 0x03, 0x00, 0x9F, 0x05, 0x98, 0xC4, 
+0x45, 0x95, 0x6B, 0x35, 0x95, 0xB1, 0x18, 0xA7, 
+0xDA, 0x55, 0x19, 0x31, 0x2A, 0x42, 0xA3, 0x57, 
+0xE1, 0xC0, 0x6B, 0xC2, 0xB1, 0xC7, 0x8E, 0xD2, 
+0x1A, 0xC5, 0xD8, 0x91, 0x7A, 0x2E, 0x89, 
+
+0xD3, 0x74, 0xA1, 0x0E, 0xDE, 0x75, 0x0E, 0x26, 
+0x7D, 0x87, 0xDE, 0x7B, 0x06, 0x30, 0x1F, 0x3E, 
+0xAA, 0x08, 0x5B, 0xEE, 0x91, 0x95, 0x63, 0x38, 
+0x60, 0x0A, 0x3A, 0x2D, 0xF7, 0x56, 0xCD, 0xBD, 
+0xDC, 0xBA, 0x87, 0xE7, 0x8A, 0xA0, 0xBD, 0xF4, 
+0xFE, 0x6B, 0x5D, 0xCE, 0x1E, 0x3D, 0x9D, 0x51, 
+0xC6, 0x5E, 0x24, 0x3F, 0x83, 0x72, 0x5A, 0x26, 
+0xA2, 0xB5, 0x68, 0x42, 0x24, 0x9A, 0xEC, 0x70, 
+0x39, 0x9C, 0x35, 0x6C, 0xD3, 0xB4, 0x9F, 0x46, 
+0x77, 0x73, 0xA3, 0x30, 0x18, 0x25, 0xF5, 0x7C, 
+0x62, 0xC5, 0x0D, 0x8E, 0x2F, 0x40, 0xA7, 0xD0, 
+0xA2, 0xBB, 0xF9, 0x4D, 0x89, 0x45, 0x94, 0xE1, 
+0xC4, 0x25, 0x73, 0x04, 0x37, 0x50, 0xAD, 0x30, 
+0x85, 0x3C, 0x3F, 0x32, 0x04, 0xC6, 0xD4, 0x39, 
+0x42, 0xA5, 0x0D, 0x95, 0xA9, 0x2C, 0x0C, 0x5D, 
+0x7A, 0xD4, 0x99, 0x68, 0x9B, 0x18, 0xC4, 0x7A, 
+0x3A, 0xDC, 0x95, 0xFD, 0x40, 0x32, 0xCA, 0xED, 
+0xE3, 0x3F, 0xBF, 0x9A, 0x5C, 0x98, 0x69, 0xF7, 
+0x34, 0x3B, 0xE8, 0xC3, 0x18, 0xA5, 0x1B, 0xC6, 
+0x67, 0x1C, 0x6F, 0x0D, 0x4E, 0x93, 0x11, 0x18, 
+0xB7, 0xFF, 0x7D, 0x42, 0x08, 0x8C, 0x08, 0x1F, 
+0x9C, 0x38, 0x5A, 0x24, 0xF8, 0x5C, 0x5E, 0x7F, 
+0xC7, 0x27, 0x93, 0xF1, 0x60, 0x1E, 0xFC, 0x4F, 
+0xC6, 0x9B, 0x8A, 0xF9, 0x8A, 0x47, 0x9F, 0x4B, 
+0xC8, 0x44, 0x17, 0x8B, 0xC3, 0x52, 0x24, 0xBC, 
+0x7F, 0x58, 0x2E, 0x94, 0xF9, 0x04, 0x77, 0x83, 
+0x9F, 0x88, 0x21, 0x1E, 0x02, 0x74, 0x68, 0x48, 
+0xEE, 0x2E, 0x84, 0x84, 0x94, 0xF4, 0xFA, 0xFD, 
+0xFB, 0xB4, 0x67, 0x3A, 0xEB, 0x6A, 0x7D, 0x74, 
+0x18, 0xF9, 0x18, 0xF2, 0xF6, 0xB9, 0x42, 0xAD, 
+0x60, 0xD6, 0x69, 0xBD, 0x1F, 0xC9, 0x5F, 0x5B, 
+0x9B, 0x8C, 0xE2, 0xEB, 0x77, 0x01, 0x2A, 0x2E, 
+0x77, 0x40, 0x20, 0x21, 0xBB, 0x45, 0xD2, 0x1A, 
+0xBB, 0x78, 0x71, 0x95, 0xA7, 0x30, 0x81, 0x89, 
+0xE7, 0x24, 0x70, 0x81, 0xCA, 0x05, 0x06, 0x50, 
+
+0xBC, 0x79, 0xE1, 0x62, 0x4E, 0xC5, 0x43, 0xF9, 
+0x73, 0x64, 0x5F, 0xB9, 0xA9, 0x78, 0xED, 0xA0, 
+0x91, 0x0F, 0x3D, 0x16, 0xCA, 0xC3, 0x18, 0xB6, 
+0x61, 0x9C, 0xE4, 0xA2, 0x7E, 0x7F, 0xF9, 0x57, 
+0xE4, 0x12, 0xB3, 0x0A, 0x4C, 0x6F, 0x39, 0x43, 
+0xC8, 0xC6, 0x7B, 0x03, 0x72, 0x36, 0x77, 0xFF, 
+0x20, 0x12, 0xA1, 0x32, 0x6F, 0xEA, 0xA5, 0x11, 
+0xC6, 0xAF, 0xD4, 0x2C, 0x57, 0x11, 0xEA, 0xFD, 
+0xD6, 0x32, 0xB5, 0x42, 0xA0, 0x11, 0x69, 0xFB, 
+0x3F, 0x0C, 0x5E, 0x0C, 0x02, 0x77, 0xAA, 0xA7, 
+0x3E, 0x55, 0x2E, 0xBA, 0x77, 0x85, 0x93, 0xA0, 
+0x33, 0x6D, 0x35, 0xCE, 0xC4, 0x4D, 0x34, 0xDF, 
+0xD2, 0xC5, 0xE7, 0xE9, 0x49, 0xA1, 0x0F, 0x10, 
+0x0B, 0xED, 0xA7, 0x3A, 0xE1, 0xD7, 0x19, 0x73, 
+0x9E, 0xCA, 0xB7, 0x20, 0x85, 0x2F, 0xE0, 0xF8, 
+0x0C, 0x09, 0x79, 0x3A, 0x23, 0x3E, 0xDC, 0xF1, 
+0x9B, 0xBE, 0x80, 0x63, 0xB8, 0x51, 0x2A, 0x2A, 
+0x06, 0x2A, 0x20, 0x36, 0x7D, 0x5A, 0xFD, 0x5D, 
+0x2B, 0xFD, 0xB3, 0xBF, 0x02, 0x44, 0x39, 0x5A, 
+0x0C, 0xCA, 0xF3, 0xF0, 0xAA, 0x75, 0x76, 0x32, 
+0xD9, 0x48, 0xEC, 0xDB, 0xCA, 0xB0, 0xC5, 0x2C, 
+0x6D, 0xB7, 0x68, 0x04, 0xFD, 0xD3, 0x3D, 0x9B, 
+0x00, 0x7A, 0x13, 0x76, 0x46, 0x59, 0xA5, 0x6B, 
+0xC2, 0x9F, 0x36, 0xA0, 0x13, 0x76, 0xC0, 0x21, 
+0xB2, 0x60, 0x91, 0xDD, 0x06, 0xF9, 0x12, 0x37, 
+0x81, 0xD3, 0xE0, 0x2A, 0x85, 0x7A, 0xAF, 0xE7, 
+0x4C, 0xF9, 0x6E, 0x8A, 0x01, 0xBB, 0xD4, 0xAF, 
+0x63, 0x87, 0x7C, 0xD8, 0x93, 0x6F, 0x98, 0x2D, 
+0x84, 0x2D, 0xC9, 0x62, 0xB1, 0x56, 0x2F, 0x36, 
+0xE1, 0xBE, 0xF4, 0x5C, 0x02, 0x1F, 0xCC, 0xB4, 
+0x1B, 0x7A, 0x8D, 0xB5, 0xD5, 0x31, 0xDA, 0x0E, 
+0xA0, 0x2F, 0xB3, 0x99, 0x5F, 0xAE, 0xDA, 0x25, 
+0xD0, 0x89, 0x28, 0x1F, 0xD0, 0xB4, 0xC6, 0x64, 
+0x26, 0x5D, 0xC1, 0xFE, 0x5F, 0x2C, 0x92, 0xA6, 
+0x8F, 0xE0, 0xBC, 0x42, 0x90, 0x51, 0xF8, 0x09, 
+0xAF, 
+
+0x3C, 0xE2, 0x78, 0xB6, 0xC1, 0xA3, 0xEE, 0x8B, 
+0x41, 0x39, 0x05, 0xC8, 0x4A, 0xDB, 0xCF, 0xB4, 
+0xCF, 0x62, 0x72, 0xC3, 0x9E, 0x59, 0xF8, 0x6F, 
+0x22, 0x92, 0x0E, 0xE5, 0xC5, 0xF5, 0x79, 0x49, 
+0xD7, 0x91, 0x1F, 0x91, 0x1B, 0x95, 0x1A, 0xE6, 
+0x09, 0x8E, 0xE0, 0x9D, 0x3F, 0xCC, 0x56, 0xD9, 
+0x07, 0x90, 0x9B, 0x7B, 0x57, 0x30, 0x15, 0xEC, 
+0x57, 0xB2, 0x30, 0xD1, 0x4E, 0x6B, 0x91, 0x7A, 
+0x43, 0x45, 0x7F, 0x50, 0x08, 0xE3, 0x39, 0x33, 
+0xCD, 0x2A, 0x81, 0xD7, 0x4F, 0x22, 0x3A, 0xC0, 
+0x75, 0xB3, 0x46, 0x53, 0xEB, 0xB0, 0xA6, 0x15, 
+0xCB, 0xB6, 0x93, 0xE1, 0x69, 0xE6, 0xB7, 0x58, 
+0x97, 0x08, 0xDA, 0x11, 0x67, 0x20, 0xAF, 0xAD, 
+0x0B, 0x34, 0xE6, 0x4A, 0xB9, 0x48, 0x09, 0x8D, 
+0xFB, 0xA3, 0xDE, 0xB8, 0x55, 0xF8, 0x70, 0x2E, 
+0x58, 0x1F, 0xB9, 0x5D, 0xC9, 0xCC, 0x67, 0x55, 
+0x04, 0x7F, 0x28, 0x7A, 0xB5, 0x16, 0xFB, 0x60, 
+0xF4, 0xC3, 0xA3, 0x8D, 0x3A, 0x8E, 0xB1, 0xF9, 
+0xBB, 0xD4, 0x29, 0xD4, 0xDE, 0x1B, 0xFD, 0x6C, 
+0xF6, 0x24, 0xB6, 0xFB, 0xC2, 0x3D, 0x44, 0x25, 
+0xF0, 0x65, 0xF2, 0x51, 0xA8, 0x7F, 0x34, 0x82, 
+0xE2, 0xCE, 0xDD, 0x30, 0x6E, 0x51, 0xB3, 0x7A, 
+0xFC, 0x23, 0x17, 0xE2, 0xDF, 0x71, 0xD0, 0xCC, 
+0x07, 0xE1, 0x82, 0x11, 0x91, 0xAD, 0x8F, 0xB7, 
+0x64, 0x0D, 0xF3, 0x55, 0x9B, 0x1D, 0x78, 0xA5, 
+0x71, 0xF6, 0x93, 0x06, 0x70, 0x74, 0x20, 0x49, 
+0xFE, 0x0B, 0x57, 0xB5, 0xB5, 0xEE, 0x00, 0x93, 
+0x45, 0x4B, 0x2F, 0x2E, 0x8F, 0x17, 0x71, 0xF6, 
+0xA4, 0xD4, 0x54, 0x6D, 0xF9, 0x07, 0x44, 0xFF, 
+0x55, 0x8D, 0x8B, 0x1F, 0x6B, 0x21, 0xF0, 0x30, 
+0x54, 0xF5, 0xA3, 0xDA, 0xA7, 0xA2, 0xDD, 0xE6, 
+0x43, 0x1B, 0x29, 0xCA, 0x83, 0x18, 0x99, 0x95, 
+0xE0, 0xD4, 0x31, 0xA4, 0xE6, 0xED, 0x7D, 0x59, 
+0x72, 0xBA, 0xB4, 0x54, 0xFF, 0xAD, 0xAE, 0x34, 
+0xA9, 0x10, 0xCF, 0x99, 0x22, 0x0A, 0x6D, 0x23, 
+0x77, 0x2D, 
+
+0x64, 0x3B, 0xFB, 0xB8, 0x43, 0x9E, 0x4E, 0x44, 
+0xBC, 0x15, 0x04, 0xEC, 0x8E, 0x82, 0x11, 0xB4, 
+0xDB, 0x9C, 0x13, 0x5C, 0xEC, 0xE5, 0x42, 0x7B, 
+0x90, 0x1E, 0x03, 0xEA, 0x24, 0x6B, 0x09, 0xB3, 
+0x96, 0xDB, 0x89, 0xA5, 0x2B, 0x53, 0xFA, 0xD4, 
+0xD8, 0xBB, 0x80, 0x61, 0x69, 0x67, 0x47, 0x65, 
+0xA2, 0xCF, 0xA1, 0x8B, 0x56, 0xC4, 0x36, 0x70, 
+0xE7, 0xD3, 0x76, 0xD9, 0xDE, 0x88, 0xEB, 0x32, 
+0x4F, 0xB1, 0x41, 0x02, 0xED, 0x7C, 0x05, 0xDD, 
+0x46, 0xED, 0xB1, 0xF7, 0x2C, 0xF9, 0x8F, 0x4D, 
+0xDF, 0x5A, 0xB7, 0x42, 0xCD, 0x67, 0x19, 0x19, 
+0x0C, 0x59, 0x73, 0x2E, 0xF2, 0x8E, 0xCF, 0x90, 
+0x1D, 0x6E, 0xFE, 0xF2, 0x1F, 0x91, 0xFF, 0x72, 
+0x92, 0xE9, 0xBF, 0x57, 0x36, 0x86, 0x94, 0xBF, 
+0x97, 0x28, 0xAF, 0x40, 0x47, 0xE7, 0x1D, 0x51, 
+0xCA, 0xCE, 0x69, 0xCB, 0xD8, 0x85, 0xE4, 0x8C, 
+0x19, 0x36, 0x97, 0x04, 0xF0, 0xC5, 0x08, 0x7E, 
+0x6E, 0x83, 0xC9, 0xC0, 0x6A, 0x78, 0xDC, 0xCA, 
+0x4F, 0x95, 0x96, 0xA6, 0xC0, 0x19, 0xB9, 0xDD, 
+0x41, 0x4C, 0x78, 0x66, 0x06, 0x8A, 0xF3, 0xC8, 
+0xCC, 0x18, 0xA2, 0x9F, 0xE4, 0xE8, 0xFC, 0x12, 
+0xBA, 0x66, 0x2C, 0x3E, 0xA0, 0x08, 0x1C, 0x64, 
+0x61, 0xC2, 0x53, 0x86, 0x34, 0xA9, 0x59, 0x09, 
+0x2F, 0x94, 0x5E, 0xCC, 0x4D, 0x8B, 0xB9, 0x0A, 
+0xE1, 0xFC, 0x38, 0x1A, 0x6F, 0x2E, 0xDF, 0x96, 
+0xD7, 0xC8, 0xF4, 0x2E, 0xDA, 0xF1, 0xB6, 0xB2, 
+0xF3, 0x22, 0x0A, 0x1D, 0x35, 0x7B, 0x6F, 0xE7, 
+0x51, 0xF4, 0x78, 0x19, 0xF4, 0x86, 0x67, 0x26, 
+0xB8, 0x47, 0xB2, 0x61, 0x47, 0xD0, 0x1B, 0xAE, 
+0xAC, 0x8C, 0x1A, 0xA5, 0x01, 0x16, 0x13, 0xF5, 
+0xE9, 0x8E, 0x57, 0x72, 0x53, 0xC0, 0x81, 0x3E, 
+0xCB, 0x75, 0xBF, 0xFF, 0x9D, 0x38, 0x61, 0x1B, 
+0xE8, 0xF8, 0x11, 0xB6, 0x40, 0xF0, 0xBF, 0x17, 
+0x45, 0xE3, 0x8D, 0x2B, 0x1F, 0x17, 0x25, 0x98, 
+0x23, 0x79, 0xE5, 0xDE, 0x87, 0x70, 0xEB, 0x4A, 
+0x39, 0xA3, 0x23, 
+
+0xC0, 0x79, 0x82, 0x96, 0x58, 0xA1, 0x08, 0xF8, 
+0x92, 0x84, 0x46, 0x53, 0xA9, 0x1C, 0x5B, 0x23, 
+0x87, 0x1F, 0xDB, 0xCA, 0xA0, 0x13, 0xFE, 0xAC, 
+0x9A, 0x35, 0x30, 0x27, 0xB9, 0xFF, 0xB1, 0x92, 
+0xB7, 0xD8, 0xAF, 0xE8, 0xF5, 0x23, 0xA2, 0x6C, 
+0x73, 0x2D, 0x2E, 0x6F, 0xA0, 0xF3, 0x4A, 0x97, 
+0x01, 0x47, 0x8A, 0x86, 0x7F, 0x4C, 0x4C, 0xA8, 
+0xCD, 0x8F, 0x1B, 0x65, 0x92, 0x2A, 0x2A, 0xD5, 
+0xE7, 0x57, 0x5E, 0x6F, 0x94, 0x02, 0x4A, 0x03, 
+0xFC, 0x53, 0x74, 0xF9, 0xD8, 0xB3, 0x1C, 0x40, 
+0xB4, 0x4C, 0x60, 0xFF, 0x90, 0xAE, 0x73, 0xF4, 
+0xCD, 0xBE, 0x83, 0x73, 0x65, 0x1A, 0x90, 0x2E, 
+0x49, 0x8F, 0x32, 0x9C, 0x10, 0x09, 0x92, 0x27, 
+0xEA, 0x52, 0xD3, 0xAC, 0x8E, 0x98, 0x93, 0x7C, 
+0x79, 0xDD, 0x89, 0x63, 0xC8, 0x04, 0x75, 0xA6, 
+0x4F, 0xCC, 0x83, 0x17, 0x72, 0x66, 0x60, 0xFB, 
+0x8B, 0x75, 0x84, 0x47, 0xE9, 0x0B, 0xB0, 0x4F, 
+0xFE, 0xC8, 0x19, 0x74, 0x94, 0x0C, 0x65, 0xEA, 
+0xB3, 0x79, 0x92, 0x5E, 0x72, 0x80, 0x89, 0x53, 
+0xB4, 0x49, 0x04, 0x2E, 0xD2, 0x86, 0x3F, 0x23, 
+0x68, 0x79, 0x15, 0x0D, 0xD6, 0xAB, 0x94, 0x0F, 
+0x8C, 0x03, 0x5D, 0xB7, 0x01, 0x56, 0xB3, 0x99, 
+0x5D, 0x5A, 0x67, 0x2D, 0x7D, 0x35, 0x0F, 0x6B, 
+0xC6, 0x5C, 0xCE, 0x34, 0xE6, 0xD1, 0x0D, 0x47, 
+0x3D, 0xF6, 0xE3, 0xCE, 0x6A, 0xC9, 0xCF, 0x56, 
+0x59, 0xD0, 0x7A, 0x99, 0x47, 0x95, 0x6F, 0x45, 
+0x38, 0xED, 0x6A, 0x4A, 0x28, 0x7E, 0x6B, 0xE4, 
+0xB6, 0x6F, 0x41, 0x0A, 0x2D, 0x02, 0x5B, 0xCF, 
+0xA5, 0x39, 0x81, 0xD3, 0x83, 0x19, 0xE8, 0xF2, 
+0xE7, 0x97, 0x37, 0xDD, 0x49, 0x3B, 0x46, 0xEF, 
+0x7C, 0xC5, 0x3E, 0x04, 0xE1, 0xA4, 0x76, 0x44, 
+0x4A, 0x25, 0x20, 0xFE, 0xAD, 0x6E, 0x97, 0x12, 
+0x84, 0x07, 0x03, 0xB3, 0xD3, 0xF7, 0xC4, 0x6A, 
+0xAA, 0xE1, 0xA5, 0x47, 0x35, 0xB4, 0x4A, 0xC9, 
+0x9F, 0x90, 0x54, 0x07, 0xD2, 0x57, 0x05, 0x87, 
+0x43, 0xD0, 0x04, 0x12, 
+
+0x06, 0xFE, 0x91, 0x1C, 0xAA, 0x7F, 0x3F, 0x5B, 
+0x42, 0xD4, 0x11, 0xEF, 0x0C, 0xD3, 0x3F, 0x72, 
+0x5C, 0xE6, 0x56, 0xD8, 0xEC, 0xD1, 0xF7, 0xBF, 
+0xF8, 0x74, 0x2D, 0x5E, 0x85, 0xC0, 0x2B, 0x94, 
+0x71, 0x1C, 0x20, 0x9C, 0xCB, 0x52, 0xF2, 0x49, 
+0x2B, 0xE8, 0x36, 0x54, 0x8C, 0xFD, 0x07, 0x96, 
+0x5C, 0xC5, 0x67, 0x26, 0xAD, 0x84, 0x89, 0xF3, 
+0x15, 0x87, 0x18, 0xB9, 0xA5, 0x6D, 0xDF, 0x5C, 
+0x52, 0xCC, 0xAB, 0xE8, 0xF8, 0x6E, 0x27, 0x58, 
+0x6E, 0xFA, 0x57, 0x93, 0x51, 0x46, 0x1E, 0x5C, 
+0x38, 0xCD, 0xE3, 0x59, 0x02, 0x09, 0x98, 0x6B, 
+0x1B, 0xDA, 0x6F, 0x6A, 0x2B, 0xC4, 0x4C, 0xB9, 
+0x4A, 0xAF, 0xFB, 0xB2, 0x9A, 0x70, 0x9F, 0xA1, 
+0x8D, 0xD8, 0xA8, 0x5E, 0x43, 0xB1, 0x55, 0x7A, 
+0xE0, 0x94, 0xF1, 0x8A, 0xBB, 0x80, 0x7C, 0x11, 
+0xAF, 0x00, 0x4E, 0x43, 0x07, 0x46, 0xA8, 0x09, 
+0x0C, 0x89, 0xE6, 0x22, 0xF2, 0xE2, 0x59, 0x2D, 
+0x3A, 0xB8, 0x96, 0xA0, 0xD1, 0xBE, 0x3D, 0x26, 
+0x29, 0xD5, 0xC0, 0xCF, 0x72, 0xE9, 0x19, 0xB6, 
+0xE1, 0x3C, 0x2C, 0x8E, 0x61, 0x30, 0x0C, 0x0F, 
+0xD2, 0xB3, 0xCA, 0xAA, 0x18, 0x14, 0x43, 0xF5, 
+0x4D, 0xF5, 0x1D, 0x7E, 0xB6, 0x5F, 0x7B, 0x45, 
+0x8C, 0x00, 0xE8, 0x07, 0xDC, 0x65, 0x68, 0x72, 
+0x5C, 0x74, 0x21, 0xEF, 0xCB, 0x3D, 0xC4, 0x99, 
+0x3F, 0x01, 0xC3, 0xE4, 0xE5, 0x97, 0xAC, 0x1C, 
+0x99, 0x50, 0x85, 0x74, 0x79, 0xCC, 0x6A, 0xC5, 
+0xAC, 0x61, 0x7D, 0x4F, 0xCF, 0x58, 0x02, 0x37, 
+0xC0, 0x85, 0xDD, 0xD0, 0x45, 0xE0, 0x88, 0xD1, 
+0x60, 0xDF, 0xB6, 0x50, 0x56, 0x3E, 0x92, 0x3E, 
+0x1A, 0x35, 0x4B, 0x12, 0xB9, 0xCF, 0xA0, 0xB4, 
+0x7D, 0xD5, 0x36, 0x30, 0x7C, 0x2D, 0xEA, 0x87, 
+0x68, 0x72, 0xE1, 0xB2, 0xC5, 0xE7, 0xD1, 0xCA, 
+0xF6, 0xD4, 0xF6, 0x72, 0x1D, 0x34, 0xEC, 0xF3, 
+0x9D, 0xC6, 0xA4, 0xD8, 0x19, 0x3B, 0x77, 0x2D, 
+0x2C, 0xD1, 0xDD, 0xE7, 0x57, 0x6A, 0x1C, 0x93, 
+0x0F, 0xB7, 0x59, 0x15, 0xB7, 
+
+0xAA, 0x07, 0x9E, 0x09, 0x5C, 0xC8, 0xAD, 0x49, 
+0x9E, 0xC0, 0x56, 0x9A, 0xCE, 0x97, 0x81, 0x9B, 
+0x8C, 0x7D, 0x12, 0xDC, 0x5A, 0xCB, 0xB5, 0x04, 
+0x5B, 0x78, 0x22, 0xE8, 0x7D, 0xC7, 0xB7, 0x27, 
+0xAB, 0xBF, 0x46, 0xB0, 0xDF, 0x10, 0xE1, 0xEB, 
+0x7C, 0xB9, 0xD6, 0xC5, 0x1C, 0x4A, 0x70, 0xB7, 
+0x3A, 0xF8, 0xC9, 0x51, 0xCE, 0x73, 0xB9, 0x32, 
+0x53, 0x4B, 0x32, 0xBC, 0x74, 0x3E, 0x88, 0x7D, 
+0x63, 0x1B, 0x90, 0xAA, 0x76, 0x75, 0x76, 0xD4, 
+0xB9, 0xAE, 0xD1, 0xA5, 0x10, 0x2B, 0x93, 0xC6, 
+0xAB, 0x32, 0x0A, 0x49, 0xAF, 0x95, 0xA7, 0xC7, 
+0x46, 0x4E, 0x68, 0x51, 0xAE, 0x49, 0x26, 0xFD, 
+0x99, 0x9D, 0x38, 0x1C, 0xB3, 0x4C, 0x50, 0xB2, 
+0xE9, 0xA2, 0x8D, 0xE2, 0xD9, 0xC1, 0xA0, 0xEF, 
+0xC3, 0xCB, 0x4F, 0x8D, 0x73, 0xF0, 0x7F, 0x22, 
+0x0C, 0x7A, 0x38, 0x27, 0xD0, 0x7E, 0xC9, 0xFF, 
+0xF7, 0xE2, 0xE6, 0x7D, 0x07, 0x16, 0xE4, 0xC0, 
+0xF9, 0x51, 0x86, 0x35, 0x50, 0x69, 0x13, 0x7A, 
+0x3B, 0xF1, 0xD5, 0x9F, 0x11, 0xE6, 0xE1, 0x8B, 
+0xDC, 0x50, 0xA5, 0x00, 0x04, 0xD6, 0x81, 0x5A, 
+0x59, 0x13, 0xC6, 0x48, 0x12, 0x35, 0x1C, 0xD5, 
+0xB0, 0x90, 0xD6, 0x9C, 0x21, 0x31, 0xD1, 0x18, 
+0xB6, 0x3A, 0x0E, 0xB6, 0xE5, 0x2F, 0x82, 0x57, 
+0x96, 0x1F, 0xEB, 0xA1, 0x3A, 0xA9, 0xF5, 0xE8, 
+0x95, 0x93, 0x59, 0x3A, 0x48, 0xDF, 0x5A, 0xB1, 
+0x80, 0xCA, 0xE5, 0x4F, 0x9B, 0xFC, 0xBF, 0xAF, 
+0xA2, 0x88, 0xA5, 0x95, 0x09, 0xB5, 0x91, 0x3F, 
+0xE0, 0xDD, 0x39, 0x1A, 0x3C, 0x4D, 0xB1, 0xDC, 
+0xD5, 0xAF, 0x10, 0x77, 0x9D, 0x9A, 0xB8, 0xCA, 
+0x74, 0xAB, 0xF7, 0xDF, 0x82, 0x7B, 0x67, 0xEF, 
+0x85, 0xFE, 0x48, 0x44, 0x16, 0x63, 0x48, 0xBD, 
+0x88, 0x6C, 0xB0, 0x01, 0xD0, 0x48, 0xC5, 0x9A, 
+0xF9, 0xD1, 0xC1, 0xF4, 0xF0, 0x75, 0xE3, 0x8A, 
+0x8A, 0x1A, 0xB3, 0x07, 0x7E, 0xCC, 0x9D, 0x55, 
+0x76, 0x9D, 0x80, 0x8A, 0xFD, 0x8C, 0xAD, 0x1E, 
+0xA2, 0x87, 0x88, 0x8A, 0xD6, 0x92, 
+
+0xBE, 0x0F, 0xE8, 0xB1, 0x26, 0xF7, 0x64, 0x29, 
+0x77, 0x05, 0xCA, 0xF5, 0x58, 0xA1, 0x10, 0x2B, 
+0x65, 0x25, 0x1B, 0x69, 0x2F, 0x89, 0x56, 0x1E, 
+0x06, 0x2D, 0x47, 0xC5, 0x90, 0x11, 0x0A, 0xBD, 
+0xC4, 0xE7, 0x78, 0xDF, 0x73, 0x36, 0x0D, 0x23, 
+0x3B, 0x2F, 0x1E, 0xC6, 0x20, 0x7B, 0x1F, 0xC3, 
+0x9B, 0xB4, 0x2E, 0xDB, 0x97, 0xA4, 0xBE, 0x8E, 
+0x98, 0x35, 0xD8, 0xA1, 0x71, 0xDD, 0x4D, 0x0D, 
+0x13, 0x11, 0xA1, 0xC5, 0xF6, 0x9C, 0xAB, 0xF7, 
+0xDD, 0x0F, 0x2E, 0xAF, 0x02, 0x49, 0xBB, 0x75, 
+0xEA, 0x6C, 0x1E, 0x7B, 0xE9, 0xD5, 0x44, 0x25, 
+0x91, 0x5A, 0x53, 0x12, 0x2A, 0xBC, 0x3F, 0x2E, 
+0x21, 0x43, 0x00, 0x50, 0x22, 0xD4, 0x13, 0xD6, 
+0xAA, 0xD2, 0x12, 0x91, 0xEF, 0x77, 0xD1, 0x9E, 
+0xB2, 0xE4, 0x8E, 0x6B, 0x93, 0xAC, 0xE6, 0x0D, 
+0xBA, 0x50, 0x14, 0x8E, 0xB0, 0x35, 0x82, 0xFF, 
+0xB7, 0x87, 0x99, 0x01, 0x6B, 0xC1, 0xB4, 0xA8, 
+0x0C, 0xAA, 0xCB, 0x2B, 0xE5, 0xEF, 0x50, 0x3F, 
+0xBE, 0xB4, 0x3B, 0x03, 0x1B, 0xE1, 0xFB, 0xC3, 
+0xD1, 0xDF, 0xE7, 0x35, 0xAC, 0x12, 0x20, 0x0A, 
+0x26, 0x25, 0x48, 0x32, 0x24, 0xF3, 0x94, 0x93, 
+0xFF, 0x71, 0x61, 0xF8, 0xA0, 0x1A, 0x94, 0xA6, 
+0xFF, 0x65, 0x72, 0xF2, 0xE6, 0xBB, 0x0F, 0xDB, 
+0x5A, 0xD1, 0x4A, 0x07, 0xD2, 0xC2, 0x62, 0x1B, 
+0x33, 0xE7, 0x41, 0x9E, 0x9A, 0x55, 0xF6, 0x99, 
+0x7B, 0x48, 0x9F, 0x1C, 0xDD, 0xCE, 0x00, 0xF4, 
+0xB9, 0x7B, 0xD1, 0x59, 0x1A, 0xC6, 0xC4, 0xEB, 
+0x65, 0x0D, 0x24, 0x58, 0xE7, 0x47, 0x20, 0xD7, 
+0x15, 0x17, 0xFD, 0xEC, 0x8D, 0xF3, 0xB1, 0xBD, 
+0x67, 0x13, 0xAB, 0xEE, 0x5F, 0xDF, 0xB0, 0xE1, 
+0x49, 0xEA, 0x42, 0x5C, 0x41, 0x65, 0xA2, 0xFC, 
+0x1D, 0x15, 0xE3, 0x0D, 0x67, 0xBE, 0xC5, 0x17, 
+0x89, 0x22, 0x05, 0x21, 0xF7, 0x87, 0xDA, 0xF2, 
+0xF4, 0x61, 0x6F, 0xD9, 0xC6, 0x7A, 0x3D, 0x6C, 
+0xBE, 0x3C, 0x3E, 0xAC, 0xF8, 0x58, 0x6D, 0xD5, 
+0xAD, 0x98, 0xFF, 0xDB, 0x83, 0x0F, 0xD1, 
+
+}
+return kat[i:j]
+}
@@ -0,0 +1,2841 @@
+package utils
+func getkat6444_v30_trailer(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xBD, 0xD4, 0x7E, 0x43, 0x80, 0x76, 0xCE, 0x15, 
+0x97, 0x4D, 0xDB, 0x3B, 0x26, 0x83, 0xDD, 0x6B, 
+0x50, 0x46, 0xFF, 0x43, 0x65, 0xF6, 0x02, 0xD2, 
+0x59, 0x4A, 0x25, 0xAE, 0x59, 0x35, 0xDD, 0x18, 
+
+0xAA, 0xA0, 0x97, 0xF0, 0x09, 0x5B, 0xE7, 0x77, 
+0xAD, 0x73, 0x92, 0x5D, 0x0A, 0x48, 0xCB, 0x24, 
+0xF9, 0xB3, 0x0F, 0x83, 0x32, 0x6C, 0xC4, 0x44, 
+0xE6, 0x94, 0xF0, 0x60, 0x15, 0x8E, 0xEC, 0xB4, 
+0xF8, 
+
+0x06, 0xFE, 0x41, 0x0B, 0x86, 0x30, 0x11, 0x17, 
+0xC5, 0x43, 0x91, 0x2A, 0x93, 0xDC, 0x3A, 0x4A, 
+0xCD, 0xE0, 0x6D, 0x90, 0x68, 0xD7, 0x30, 0x2E, 
+0xD1, 0x02, 0x02, 0x5B, 0x3E, 0xCD, 0x59, 0x6A, 
+0x59, 0x1D, 
+
+0xC0, 0x79, 0x82, 0x75, 0xEA, 0x2A, 0x81, 0x1D, 
+0x2E, 0xC0, 0x3D, 0x7F, 0x7B, 0x06, 0xAE, 0x2B, 
+0x2F, 0x4B, 0x11, 0x9E, 0x88, 0xFD, 0xA1, 0xAB, 
+0x17, 0x9B, 0xEB, 0xF2, 0x14, 0xF8, 0x2B, 0xD9, 
+0xCF, 0xBE, 0xAB, 
+
+0x64, 0x3B, 0xFB, 0xB8, 0xC8, 0xAD, 0x97, 0xFF, 
+0xD2, 0x12, 0xF1, 0x54, 0xDC, 0x2A, 0x15, 0x98, 
+0xAA, 0x0D, 0x27, 0x28, 0x61, 0x0A, 0x75, 0xAF, 
+0x57, 0x94, 0xC3, 0xCE, 0x37, 0x64, 0xA1, 0x6B, 
+0x9D, 0xE5, 0x21, 0x15, 
+
+0x3C, 0xE2, 0x78, 0xB6, 0xC1, 0x6B, 0x86, 0x46, 
+0x5D, 0x90, 0x88, 0x3B, 0x09, 0x1F, 0x73, 0xCD, 
+0x64, 0x17, 0x14, 0x64, 0x3C, 0x16, 0xCB, 0x92, 
+0xAE, 0xD7, 0x53, 0xC3, 0x6E, 0x97, 0x20, 0x77, 
+0xD5, 0xDA, 0xE7, 0x0F, 0xFE, 
+
+0xBC, 0x79, 0xE1, 0x62, 0x4E, 0xC5, 0xF3, 0xD3, 
+0xF4, 0xA8, 0xCF, 0x83, 0x31, 0x9F, 0xAE, 0x5D, 
+0xE0, 0x3F, 0x02, 0x25, 0xD6, 0xE6, 0xD8, 0xDC, 
+0xD9, 0x6B, 0x8B, 0x99, 0x1B, 0x74, 0xB8, 0xED, 
+0x73, 0x75, 0x62, 0x8F, 0x95, 0x21, 
+
+0xD3, 0x74, 0xA1, 0x0E, 0xDE, 0x75, 0x0E, 0x83, 
+0x07, 0x56, 0x67, 0xF3, 0x11, 0xB2, 0xD4, 0x79, 
+0x0E, 0xCD, 0xEC, 0x3A, 0x78, 0xE0, 0xE6, 0x4E, 
+0x48, 0xE4, 0x6D, 0x30, 0x46, 0x02, 0x5E, 0x5E, 
+0x1B, 0x86, 0x65, 0x6F, 0x39, 0xF4, 0x44, 
+
+0x09, 0xBB, 0x43, 0xE8, 0xF2, 0x54, 0xC7, 0xE7, 
+0x77, 0xF0, 0x68, 0x60, 0xEB, 0xEA, 0xB7, 0xBE, 
+0x7B, 0x3F, 0x6E, 0x7E, 0xAD, 0xD1, 0xFA, 0x4E, 
+0x40, 0x8E, 0x3A, 0xBF, 0xE3, 0xB3, 0x8F, 0xD6, 
+0x0C, 0x79, 0x69, 0xF6, 0x3A, 0x13, 0x30, 0x31, 
+
+0x47, 0x60, 0xAE, 0x40, 0x00, 0x99, 0xE8, 0x73, 
+0x81, 0x78, 0x7F, 0xB7, 0x49, 0xD0, 0x64, 0x1E, 
+0x57, 0x5A, 0x76, 0xED, 0x68, 0x9A, 0xF3, 0x1D, 
+0xB4, 0xF7, 0x68, 0x83, 0xC4, 0xB5, 0x11, 0xBB, 
+0xF2, 0xFF, 0xFA, 0xCC, 0xF7, 0x4E, 0x6D, 0x2C, 
+0x64, 
+
+0x72, 0x2B, 0x29, 0xFC, 0xBC, 0x88, 0x51, 0xA6, 
+0x6B, 0x44, 0x8E, 0x62, 0x24, 0x0A, 0xC0, 0xD2, 
+0x35, 0x34, 0x16, 0x6C, 0x4C, 0x5B, 0xA1, 0x31, 
+0xC4, 0xFF, 0xF3, 0x50, 0x42, 0xF0, 0x7D, 0xC9, 
+0x3E, 0x91, 0x0E, 0x52, 0xD9, 0xD2, 0xBC, 0x5F, 
+0xBD, 0xD8, 
+
+0x63, 0x1D, 0xF5, 0x59, 0xFA, 0xC2, 0x9C, 0x38, 
+0xA2, 0x9F, 0x12, 0xD2, 0x54, 0x89, 0xF3, 0x33, 
+0x76, 0x8F, 0x3C, 0xE9, 0xB2, 0x23, 0x9C, 0x52, 
+0x33, 0x9A, 0x4B, 0x0F, 0xD9, 0x2D, 0xF8, 0x47, 
+0xCF, 0xE6, 0xAB, 0x33, 0x36, 0x92, 0x24, 0x54, 
+0xDA, 0xB4, 0x85, 
+
+0x65, 0x25, 0xCE, 0xA0, 0x5A, 0xB5, 0xE3, 0x80, 
+0x25, 0x12, 0x82, 0x01, 0x05, 0x12, 0x2F, 0x07, 
+0x31, 0x7C, 0x8B, 0xFA, 0x5A, 0x81, 0x2D, 0x36, 
+0x8D, 0x20, 0xF6, 0xF2, 0x35, 0xDA, 0xBC, 0x06, 
+0x13, 0xEE, 0xF7, 0x0F, 0xCC, 0x7B, 0x8D, 0x71, 
+0x20, 0xB3, 0xBD, 0xFA, 
+
+0x8B, 0xF4, 0x4D, 0x23, 0x14, 0xBC, 0x7E, 0x00, 
+0x5C, 0x9C, 0xAB, 0x9A, 0x8E, 0x4E, 0x3B, 0x89, 
+0x12, 0x5F, 0x2F, 0x0D, 0x0D, 0xC2, 0x45, 0x65, 
+0xBE, 0x7E, 0xD7, 0xE4, 0x5C, 0x80, 0xAE, 0x08, 
+0xD2, 0x60, 0x91, 0xE8, 0x34, 0x27, 0x11, 0xC8, 
+0x64, 0x44, 0x85, 0x26, 0xC9, 
+
+0xB4, 0x87, 0xF1, 0x67, 0x6C, 0x12, 0x86, 0x9A, 
+0xCF, 0xC9, 0x11, 0x79, 0x26, 0x15, 0x7A, 0xAE, 
+0x3B, 0x0A, 0xFD, 0x4E, 0x3E, 0xD9, 0xB0, 0x29, 
+0x20, 0x04, 0x87, 0x41, 0xFC, 0x95, 0x64, 0xDC, 
+0xCC, 0xF4, 0x4A, 0x23, 0xD8, 0x64, 0xC0, 0x42, 
+0x53, 0x17, 0xBF, 0xF9, 0x7B, 0xFC, 
+
+0x82, 0x3B, 0xD4, 0x46, 0xD2, 0x7C, 0x26, 0xAB, 
+0x61, 0x91, 0x18, 0xE4, 0x96, 0x68, 0x2D, 0x8A, 
+0x11, 0xE1, 0xAF, 0xF5, 0x31, 0xAC, 0xB0, 0xA7, 
+0x52, 0xFD, 0x69, 0xA4, 0xE3, 0xBC, 0x02, 0x13, 
+0x61, 0x3C, 0x8E, 0x3E, 0x90, 0xD5, 0xFD, 0xC6, 
+0xB2, 0x45, 0xBB, 0x33, 0x51, 0x59, 0xAE, 
+
+0x06, 0xF6, 0xF7, 0x4E, 0x2D, 0xE9, 0x93, 0x0B, 
+0x9E, 0xE7, 0xCF, 0x50, 0x59, 0x8E, 0x73, 0xD0, 
+0x75, 0xC1, 0x95, 0x60, 0xD8, 0x2C, 0xB1, 0x5D, 
+0x94, 0x12, 0x0D, 0x43, 0x0C, 0x1C, 0x63, 0xA9, 
+0xDD, 0x2F, 0x91, 0x34, 0x18, 0x34, 0x15, 0xFA, 
+0x78, 0x50, 0x89, 0xE9, 0xFD, 0x67, 0x72, 0x10, 
+
+0xCA, 0x46, 0xB1, 0x9B, 0xE4, 0xB9, 0x0C, 0xFD, 
+0xDE, 0xF6, 0xDB, 0x6D, 0x69, 0x45, 0x3A, 0x65, 
+0x49, 0x8A, 0x65, 0xD4, 0xC1, 0xC0, 0xD9, 0x48, 
+0xCF, 0x90, 0xE0, 0x8C, 0x2A, 0xD3, 0x71, 0x1A, 
+0x1D, 0xF2, 0x03, 0x66, 0x55, 0xD3, 0xD5, 0x95, 
+0xD4, 0x42, 0xBB, 0xC2, 0xB7, 0x2D, 0x4D, 0x3A, 
+0x1D, 
+
+0xB1, 0x12, 0x16, 0x1C, 0xC8, 0x9E, 0x49, 0x0D, 
+0x80, 0x17, 0x2B, 0x95, 0x18, 0x63, 0xBB, 0x93, 
+0xA1, 0xFB, 0x46, 0x27, 0x84, 0xC9, 0x17, 0xA2, 
+0xE5, 0x09, 0x6E, 0x43, 0xBD, 0xF5, 0xCB, 0xC4, 
+0xCB, 0x5A, 0xA5, 0xD6, 0x82, 0xC3, 0x78, 0x48, 
+0x54, 0x81, 0x67, 0xD2, 0x03, 0x41, 0x06, 0x04, 
+0x33, 0x19, 
+
+0x47, 0xB6, 0x58, 0x6D, 0xEE, 0xD8, 0x6C, 0x35, 
+0x32, 0x3B, 0x22, 0x1A, 0x03, 0xC6, 0xC0, 0xC5, 
+0x78, 0xAB, 0xAB, 0x05, 0x9E, 0x83, 0x11, 0xF0, 
+0x88, 0x85, 0x0C, 0x42, 0x80, 0x0E, 0x35, 0xA4, 
+0x66, 0xBA, 0x55, 0xEB, 0x68, 0xBD, 0xB6, 0x74, 
+0x06, 0x98, 0x57, 0xAB, 0xC9, 0x66, 0x79, 0xEE, 
+0x25, 0x60, 0x91, 
+
+0x9D, 0xBE, 0x74, 0x3A, 0x44, 0x40, 0xF8, 0x1A, 
+0xE4, 0xE3, 0x53, 0xA6, 0xC8, 0x0C, 0xB9, 0x1E, 
+0x7E, 0xB7, 0xFF, 0x73, 0xE3, 0xFD, 0x15, 0x49, 
+0x0C, 0x3D, 0x21, 0x59, 0xF9, 0x0F, 0xE5, 0x7F, 
+0xA6, 0x7C, 0x41, 0xFF, 0x85, 0x0F, 0xBD, 0x67, 
+0x29, 0xAA, 0x11, 0x90, 0xAD, 0x43, 0x3B, 0x80, 
+0xB7, 0x9C, 0xB0, 0x9B, 
+
+0x8D, 0x16, 0x10, 0x07, 0x15, 0x94, 0x6E, 0x45, 
+0x8D, 0xED, 0x44, 0x63, 0x07, 0xF9, 0xD7, 0x53, 
+0xA8, 0x88, 0x91, 0x72, 0x57, 0xE7, 0x80, 0x0D, 
+0x20, 0x7B, 0xE3, 0xFB, 0x30, 0x64, 0xB5, 0xB4, 
+0xA4, 0x0D, 0xA6, 0x58, 0xA4, 0x7B, 0xB3, 0x88, 
+0x1A, 0x6B, 0x37, 0x65, 0xB2, 0xD1, 0x79, 0xF3, 
+0x5C, 0xDE, 0x83, 0x56, 0xE4, 
+
+0xFA, 0x04, 0x8A, 0x3A, 0x9C, 0xEA, 0x9A, 0xF8, 
+0x09, 0x4D, 0x2B, 0x6D, 0xE0, 0xE7, 0x8E, 0x8D, 
+0x8D, 0x2F, 0xF9, 0x8A, 0x39, 0xDE, 0x7E, 0xBE, 
+0x08, 0x2C, 0x95, 0xCE, 0xFA, 0x89, 0x50, 0x6A, 
+0x01, 0xA1, 0x01, 0x75, 0xD7, 0x12, 0x5E, 0xC6, 
+0x67, 0xA7, 0x6C, 0x54, 0x67, 0xDE, 0x28, 0x31, 
+0xFA, 0x48, 0xAA, 0x1D, 0x6D, 0xEF, 
+
+0x24, 0x67, 0x5A, 0x8F, 0x8E, 0x4B, 0xC6, 0x0C, 
+0x6B, 0x95, 0x5D, 0x02, 0x15, 0xF2, 0xD2, 0x5E, 
+0x66, 0xDA, 0x61, 0x6C, 0xE8, 0xAF, 0xC3, 0x78, 
+0x42, 0x06, 0xAE, 0xB1, 0x64, 0xEC, 0x95, 0xD0, 
+0x53, 0x84, 0x73, 0xEF, 0x31, 0xFC, 0x49, 0x03, 
+0x2C, 0x56, 0xE6, 0xCB, 0xBC, 0xD2, 0x82, 0x1E, 
+0xAF, 0x31, 0x94, 0xD4, 0x97, 0x1A, 0x26, 
+
+0x7F, 0x96, 0x9C, 0xFB, 0x14, 0x68, 0xE9, 0x5D, 
+0xF7, 0x38, 0xB9, 0x84, 0xAF, 0xA9, 0xF1, 0xB1, 
+0x60, 0x5E, 0xE6, 0x27, 0x2E, 0x99, 0x51, 0x3E, 
+0x0C, 0x90, 0x26, 0xD0, 0x91, 0x2C, 0xA1, 0x45, 
+0x44, 0x93, 0x3F, 0xF0, 0x79, 0x6D, 0xD4, 0x7F, 
+0x7D, 0xEE, 0x03, 0xBD, 0x6B, 0x74, 0x9A, 0x54, 
+0x63, 0xA8, 0x97, 0x51, 0x8D, 0xDA, 0x4F, 0x86, 
+
+0x22, 0xE6, 0xEA, 0x1A, 0x3C, 0x64, 0x57, 0x63, 
+0x36, 0xF8, 0x78, 0xA3, 0xC9, 0x9C, 0x69, 0x2B, 
+0x80, 0xFE, 0x02, 0x30, 0xC2, 0x6E, 0x7F, 0x74, 
+0x5B, 0xDF, 0xB6, 0xBE, 0xB3, 0x26, 0x56, 0xD3, 
+0xA2, 0x55, 0x54, 0x8C, 0xB0, 0xE5, 0x00, 0x5B, 
+0x58, 0x69, 0xED, 0xA8, 0x1E, 0xC3, 0x40, 0xBB, 
+0xFE, 0x2E, 0x66, 0x4E, 0xE1, 0xDA, 0xE5, 0xF6, 
+0x1B, 
+
+0x2C, 0x9A, 0xB3, 0xD9, 0x16, 0xD6, 0x2A, 0x0A, 
+0x8D, 0xFC, 0x97, 0x32, 0xFC, 0x15, 0x90, 0x14, 
+0x01, 0xAF, 0x0C, 0x85, 0xF5, 0x59, 0x7E, 0x51, 
+0x51, 0x8A, 0xEA, 0xDA, 0x6A, 0x9B, 0x49, 0x05, 
+0x97, 0xE7, 0x23, 0x62, 0x84, 0xDA, 0x26, 0xEC, 
+0x0E, 0x42, 0xB0, 0x12, 0xE2, 0x78, 0x0D, 0xCD, 
+0x38, 0x44, 0xE3, 0x90, 0x42, 0xA3, 0x65, 0x1E, 
+0x3C, 0xD2, 
+
+0xF7, 0xCE, 0x60, 0x16, 0x9E, 0x75, 0xEA, 0xD8, 
+0x61, 0xFC, 0xA2, 0x49, 0xF8, 0xCF, 0x20, 0xE6, 
+0xE4, 0x85, 0xCD, 0x3E, 0x01, 0xFD, 0xCE, 0xFB, 
+0x0A, 0xDE, 0x7E, 0xBB, 0xB5, 0x44, 0x2F, 0x25, 
+0x9E, 0xEE, 0xCB, 0x52, 0x95, 0x67, 0x88, 0xD4, 
+0x1D, 0x66, 0xAC, 0xAF, 0x6F, 0xB2, 0x75, 0xCA, 
+0x26, 0xA1, 0xF5, 0x20, 0x8D, 0x54, 0xA1, 0x37, 
+0x41, 0x68, 0x17, 
+
+0xF4, 0x62, 0xEC, 0xD0, 0x04, 0xDC, 0xD6, 0xA9, 
+0x8E, 0xAC, 0xEE, 0x85, 0xF8, 0xEB, 0xD8, 0x5E, 
+0x43, 0x43, 0x10, 0x23, 0x09, 0xA7, 0xDC, 0x7C, 
+0x90, 0x5F, 0x3E, 0xEB, 0xE6, 0xF3, 0xB3, 0xB3, 
+0xC6, 0x60, 0x99, 0xB8, 0x33, 0x49, 0xE1, 0xF6, 
+0x2A, 0x88, 0x04, 0x78, 0xF9, 0x5D, 0xA4, 0xC5, 
+0xE6, 0x80, 0x58, 0x7B, 0x22, 0x7A, 0x03, 0x89, 
+0xE3, 0xBA, 0x72, 0x3C, 
+
+0x00, 0x75, 0xB1, 0xB7, 0x1C, 0xEB, 0x66, 0x4F, 
+0x44, 0x2A, 0xDF, 0xC6, 0x7C, 0xEF, 0x6A, 0xE4, 
+0xAA, 0x26, 0xD5, 0x08, 0xDF, 0x69, 0xF7, 0x23, 
+0x50, 0x6D, 0x74, 0x8A, 0xD4, 0x06, 0x14, 0xEA, 
+0x05, 0x40, 0x2A, 0x75, 0x69, 0xCC, 0x53, 0x32, 
+0x49, 0x96, 0x9C, 0x3A, 0x86, 0xC4, 0xB2, 0x9C, 
+0x55, 0xC4, 0x7D, 0xF1, 0xB1, 0x8A, 0x60, 0xA4, 
+0xCE, 0x80, 0xB3, 0x25, 0xB5, 
+
+0xBF, 0x7E, 0x10, 0xA5, 0x91, 0xE8, 0x0B, 0x41, 
+0x48, 0x06, 0x30, 0x2C, 0x24, 0x3E, 0x34, 0x71, 
+0x0C, 0x4A, 0x81, 0xD7, 0x8B, 0x07, 0x6C, 0x87, 
+0x2F, 0x08, 0xE2, 0x50, 0xB3, 0x0A, 0xDE, 0xFC, 
+0x32, 0x8F, 0xDC, 0xBE, 0x41, 0x69, 0x03, 0x78, 
+0x7A, 0x79, 0x07, 0xD6, 0x8F, 0x4B, 0xC6, 0x2A, 
+0x4A, 0xAC, 0x88, 0xE8, 0x2F, 0x45, 0xDD, 0x69, 
+0x12, 0x6E, 0xD6, 0x19, 0xE6, 0x72, 
+
+0xCA, 0x97, 0x1B, 0xF3, 0xC0, 0xF2, 0x43, 0xAB, 
+0x20, 0x80, 0xCD, 0x30, 0x26, 0x8B, 0xA2, 0x97, 
+0xD7, 0x9F, 0xCF, 0x31, 0xED, 0x4C, 0x01, 0x65, 
+0x8A, 0xB1, 0x5B, 0x1B, 0x13, 0xF3, 0x6B, 0x01, 
+0xD0, 0xD1, 0x62, 0x21, 0xCC, 0xB4, 0x72, 0x90, 
+0x86, 0x58, 0x93, 0xCE, 0xDD, 0xE1, 0x43, 0x9D, 
+0xB0, 0xEE, 0x94, 0x46, 0x97, 0xB1, 0x61, 0x6D, 
+0x39, 0xC1, 0x73, 0x29, 0x7B, 0x7F, 0xA1, 
+
+0x1A, 0xD5, 0xD3, 0x12, 0xDD, 0xF0, 0x7E, 0x33, 
+0xB3, 0x20, 0x81, 0x31, 0x80, 0x94, 0xE4, 0x0C, 
+0xBB, 0x9E, 0x91, 0x70, 0xC0, 0x75, 0x54, 0xA1, 
+0x95, 0xC2, 0x41, 0xFA, 0xC3, 0xAD, 0x3F, 0x09, 
+0x40, 0x53, 0x02, 0x04, 0xA9, 0x96, 0x76, 0xF3, 
+0xCE, 0x36, 0x47, 0xEC, 0x55, 0x6E, 0x00, 0x98, 
+0xD3, 0x5A, 0xAA, 0xA7, 0xC7, 0xDF, 0xCB, 0xC7, 
+0x5F, 0x93, 0x2A, 0x99, 0xF8, 0x74, 0x2F, 0x75, 
+
+0x39, 0xC0, 0xDA, 0x6D, 0xD1, 0x12, 0xA6, 0x03, 
+0x53, 0x52, 0x3A, 0x25, 0xFB, 0xC3, 0xC6, 0x45, 
+0x75, 0x9F, 0xFA, 0x0F, 0x87, 0x01, 0x4C, 0x09, 
+0xE9, 0x73, 0x1F, 0x2C, 0x80, 0x45, 0xB2, 0x74, 
+0x0C, 0x1E, 0x4A, 0xD9, 0xDD, 0x03, 0x98, 0x5E, 
+0xEB, 0x7F, 0xAE, 0xC5, 0x82, 0xEA, 0xC9, 0x56, 
+0x4A, 0x59, 0xC7, 0xD0, 0x76, 0xEA, 0x3E, 0x06, 
+0x9E, 0xAB, 0x84, 0xEC, 0x16, 0x7E, 0x7C, 0x89, 
+0x6F, 
+
+0x9F, 0x63, 0x0D, 0xF0, 0x5F, 0x42, 0xDB, 0x67, 
+0x8D, 0xD2, 0x72, 0xDD, 0x48, 0xB6, 0xD2, 0x62, 
+0xC8, 0x9C, 0x2D, 0xCF, 0x6C, 0x51, 0xED, 0xBC, 
+0x56, 0x48, 0x50, 0x0F, 0x9B, 0x7E, 0x7A, 0x2C, 
+0x81, 0xC1, 0xFA, 0x71, 0x59, 0x96, 0xF2, 0x0D, 
+0xFD, 0xAA, 0x23, 0x45, 0x24, 0x1D, 0xEA, 0xB1, 
+0x08, 0x05, 0x21, 0x21, 0xC1, 0x5C, 0x74, 0xEC, 
+0x5F, 0xDF, 0x6F, 0x41, 0x29, 0x0F, 0xB2, 0x61, 
+0x89, 0xBE, 
+
+0xD5, 0xB7, 0xC2, 0x1E, 0x0F, 0x20, 0x8D, 0x39, 
+0xFC, 0xF3, 0x8C, 0xC3, 0x79, 0x77, 0x89, 0x6E, 
+0x77, 0x11, 0x64, 0xB2, 0x8E, 0x07, 0xC1, 0x06, 
+0x2B, 0x7F, 0x79, 0xCF, 0xD8, 0x57, 0x9A, 0x15, 
+0x03, 0x53, 0x4B, 0xA0, 0x1E, 0x35, 0xD6, 0xBD, 
+0x4F, 0xE5, 0xF8, 0x73, 0x08, 0xA9, 0xCC, 0x6F, 
+0xF5, 0x5B, 0xF6, 0x76, 0x00, 0x29, 0x35, 0x00, 
+0x55, 0x28, 0x00, 0x16, 0xF6, 0x2A, 0x6E, 0x5B, 
+0xB6, 0xAE, 0x71, 
+
+0x0A, 0x3D, 0xFB, 0x9D, 0xB8, 0xF8, 0xCE, 0xFC, 
+0x65, 0xC1, 0x92, 0x53, 0x65, 0x6F, 0x07, 0xE3, 
+0x25, 0x2C, 0x4D, 0x1B, 0x7D, 0x80, 0xDB, 0x86, 
+0x57, 0xDB, 0xC1, 0xB1, 0xE1, 0x87, 0x9A, 0x28, 
+0xEC, 0x4C, 0xDC, 0x18, 0xF1, 0xC2, 0x43, 0x3C, 
+0xCA, 0x1C, 0x4F, 0x2A, 0xF9, 0xA3, 0x46, 0x5A, 
+0x2A, 0xE1, 0xB0, 0xC8, 0x1A, 0xB8, 0xE7, 0x97, 
+0xEB, 0x26, 0x9E, 0x8B, 0x6B, 0x56, 0xF2, 0x24, 
+0x56, 0x7E, 0x20, 0x64, 
+
+0x1A, 0x87, 0xF8, 0x81, 0x5C, 0x6A, 0x88, 0xE2, 
+0x03, 0x54, 0x6A, 0x0E, 0xBC, 0xA8, 0x64, 0x59, 
+0x5A, 0x27, 0xE1, 0x4C, 0x40, 0x64, 0xD1, 0xFA, 
+0x71, 0x13, 0x13, 0xBF, 0xFC, 0xCC, 0xA9, 0x57, 
+0x87, 0x73, 0xBB, 0x30, 0x86, 0x56, 0x1B, 0x76, 
+0xB9, 0x04, 0x69, 0xEB, 0xD1, 0x87, 0xA3, 0x65, 
+0x1A, 0xBD, 0x9D, 0x14, 0x3C, 0x6A, 0x70, 0x1A, 
+0x1D, 0xAE, 0xB2, 0x71, 0xB6, 0x2D, 0xE0, 0x09, 
+0xE3, 0x66, 0x6A, 0xDD, 0x89, 
+
+0x8B, 0xF6, 0x79, 0x89, 0x2C, 0x06, 0xD5, 0x9D, 
+0x7C, 0x1F, 0x94, 0x1E, 0x45, 0x70, 0xF2, 0x8D, 
+0x40, 0xB9, 0xBC, 0x21, 0x69, 0x9F, 0xCD, 0xA4, 
+0x7F, 0x8F, 0x6D, 0xCB, 0x6E, 0xBB, 0x60, 0x4F, 
+0x38, 0x0B, 0xD1, 0xED, 0x4A, 0x0A, 0x6C, 0x26, 
+0x92, 0x8E, 0xF9, 0x9C, 0x15, 0x91, 0x15, 0x0E, 
+0x6C, 0xF1, 0x06, 0x91, 0x08, 0x19, 0xF6, 0x7A, 
+0x11, 0x6A, 0x45, 0x78, 0xD8, 0x06, 0x7F, 0xA2, 
+0x2C, 0x46, 0x04, 0x6A, 0x8A, 0x11, 
+
+0xB9, 0xE4, 0x5B, 0x30, 0xE0, 0x79, 0xBC, 0x8A, 
+0x98, 0x1B, 0x53, 0xD8, 0xF1, 0xA7, 0xB5, 0x40, 
+0xDD, 0x81, 0x1B, 0xA8, 0x70, 0x43, 0x42, 0xCD, 
+0x0F, 0xA8, 0xC2, 0xA0, 0xB7, 0xD9, 0x6D, 0x20, 
+0xFE, 0x44, 0x6F, 0x6C, 0x11, 0x2B, 0x86, 0x8A, 
+0xA4, 0xC6, 0x71, 0xD5, 0xCC, 0x86, 0x79, 0x2D, 
+0xCA, 0x89, 0x5A, 0xDC, 0x1E, 0x8F, 0x71, 0xEA, 
+0x98, 0x64, 0x31, 0xFC, 0x35, 0xAF, 0x98, 0xA0, 
+0x83, 0x44, 0x45, 0xC0, 0x5B, 0xC6, 0xFC, 
+
+0x77, 0x0F, 0x8D, 0xC2, 0xDC, 0x72, 0xF9, 0x9E, 
+0x83, 0x45, 0x69, 0x1E, 0x14, 0x5C, 0xF3, 0xA5, 
+0x51, 0xCC, 0xE7, 0x3F, 0xBE, 0x52, 0x1B, 0xC4, 
+0x54, 0xF3, 0xC8, 0x65, 0xE8, 0x1C, 0x9F, 0x15, 
+0x04, 0xC4, 0xA5, 0x7E, 0x95, 0xB0, 0xEF, 0x62, 
+0x3E, 0x73, 0x81, 0xAF, 0x17, 0xA8, 0xC9, 0x88, 
+0xDB, 0x5A, 0x3B, 0xC8, 0xEB, 0x0E, 0x8B, 0x65, 
+0xF9, 0x61, 0xA4, 0xE2, 0x4E, 0x7A, 0x19, 0x09, 
+0x86, 0xD0, 0x23, 0x2A, 0xEA, 0xE9, 0x69, 0x33, 
+
+0xE9, 0x3A, 0x68, 0x92, 0xD7, 0xED, 0xDC, 0xDF, 
+0xBB, 0x65, 0x16, 0x51, 0x63, 0x04, 0xD2, 0x8E, 
+0x32, 0xF4, 0x21, 0xA7, 0x50, 0xE3, 0x1B, 0x40, 
+0x59, 0x60, 0x64, 0x2C, 0x4D, 0xBA, 0x48, 0x4A, 
+0x39, 0xCA, 0xFB, 0x00, 0xAB, 0xF5, 0x09, 0x29, 
+0x9A, 0x09, 0x20, 0xE8, 0x73, 0xF3, 0x38, 0x04, 
+0x3E, 0x17, 0x9B, 0x51, 0xCB, 0xF0, 0x29, 0xE4, 
+0x03, 0xEB, 0x36, 0xB6, 0x49, 0xE9, 0xA6, 0x58, 
+0xD1, 0x7C, 0xA3, 0xCB, 0xBE, 0x49, 0xAD, 0x34, 
+0xA0, 
+
+0xB2, 0x19, 0x03, 0x56, 0x21, 0xA6, 0x5B, 0x0D, 
+0xF7, 0x94, 0xFF, 0xF6, 0xF1, 0x80, 0xFA, 0xB7, 
+0x7E, 0x2C, 0x8E, 0x8B, 0x4E, 0xC4, 0x16, 0xBA, 
+0x47, 0x77, 0x34, 0x9A, 0x46, 0x8C, 0x6B, 0x59, 
+0x21, 0x63, 0x55, 0xFB, 0x3F, 0x61, 0xDA, 0x9F, 
+0x30, 0xD8, 0xE8, 0x27, 0xFC, 0x93, 0x60, 0x98, 
+0x77, 0x2B, 0xE6, 0x63, 0x5C, 0xCA, 0x3F, 0x92, 
+0x6D, 0xA1, 0xCB, 0x58, 0x1E, 0x1D, 0x43, 0xFA, 
+0xFE, 0x32, 0x57, 0x38, 0x7D, 0x8B, 0xD2, 0xBF, 
+0x2E, 0x35, 
+
+0xD0, 0x40, 0x49, 0x88, 0x9B, 0x9D, 0x41, 0xCD, 
+0x79, 0x11, 0x2B, 0x02, 0x67, 0x02, 0x6A, 0x38, 
+0x1A, 0x53, 0x79, 0xB2, 0xB3, 0xB7, 0x72, 0x44, 
+0x2F, 0x44, 0xF8, 0x77, 0xC9, 0xEA, 0x96, 0x43, 
+0x0F, 0x84, 0x5F, 0x66, 0xB9, 0xC7, 0x66, 0xF3, 
+0x3C, 0xA6, 0xE5, 0x97, 0xA2, 0x82, 0x3B, 0x83, 
+0xA0, 0x1E, 0x5D, 0x39, 0x33, 0xDC, 0xCC, 0x77, 
+0xC0, 0xBC, 0x74, 0x5B, 0xFF, 0x3C, 0x29, 0x4A, 
+0x55, 0x21, 0x55, 0x62, 0x6B, 0xBE, 0x3E, 0x31, 
+0x29, 0xED, 0xC4, 
+
+0xF4, 0x36, 0xBE, 0xDE, 0x77, 0xA0, 0x5B, 0xE3, 
+0xB5, 0xB8, 0x20, 0x74, 0x73, 0xB7, 0x1A, 0x3B, 
+0xFF, 0x26, 0xAD, 0x6B, 0x66, 0x51, 0xE8, 0x84, 
+0xE8, 0xD6, 0xD1, 0x0A, 0x1E, 0xE8, 0x65, 0xE8, 
+0x5B, 0xB6, 0x74, 0x48, 0x07, 0xEC, 0xA4, 0xB1, 
+0x6B, 0x0A, 0x84, 0x8A, 0xC0, 0xC3, 0xA4, 0xAE, 
+0xA6, 0xEF, 0x09, 0x04, 0x8D, 0x32, 0xEF, 0x50, 
+0x9D, 0x72, 0x0C, 0x0E, 0x7B, 0x95, 0x5E, 0x1E, 
+0x53, 0xDF, 0x41, 0xA1, 0x05, 0x98, 0xA1, 0x99, 
+0xEA, 0x2E, 0x7A, 0xEE, 
+
+0x6F, 0x61, 0x99, 0x90, 0x1A, 0x22, 0xEA, 0x34, 
+0x66, 0x38, 0x93, 0x55, 0x53, 0xF8, 0x8D, 0x61, 
+0xCD, 0x57, 0x6E, 0x98, 0x73, 0xA9, 0x43, 0x5C, 
+0x84, 0x73, 0x04, 0xDD, 0x4A, 0x08, 0xAB, 0x12, 
+0x62, 0xF1, 0xF5, 0xE9, 0x5C, 0x04, 0x20, 0xA9, 
+0xB6, 0xBF, 0xA0, 0x2F, 0x98, 0x48, 0xE7, 0x3D, 
+0x39, 0xE4, 0x25, 0x12, 0x89, 0x56, 0xC4, 0xE7, 
+0xD9, 0x4A, 0x0A, 0x36, 0x34, 0x14, 0x48, 0x84, 
+0x39, 0xC7, 0x5D, 0x67, 0x19, 0x92, 0x8B, 0x6D, 
+0x5B, 0xA4, 0x59, 0x4A, 0x92, 
+
+0xFC, 0xE8, 0xEB, 0x2F, 0xC9, 0x3B, 0x09, 0x3B, 
+0xF7, 0x1B, 0x14, 0x17, 0x29, 0x2C, 0x88, 0x5E, 
+0xF8, 0xF2, 0x81, 0x16, 0xA8, 0x66, 0x81, 0x44, 
+0xAA, 0x07, 0x1B, 0x30, 0x17, 0x02, 0x30, 0xB8, 
+0x1B, 0x3D, 0x17, 0x51, 0x0D, 0x6A, 0xDF, 0x09, 
+0xE8, 0x1E, 0xC4, 0x5A, 0x59, 0x9D, 0x4D, 0xCE, 
+0xFD, 0x3B, 0x83, 0xCF, 0x3C, 0x82, 0x81, 0xE5, 
+0x7E, 0xAB, 0x0B, 0xA7, 0x61, 0x2D, 0xD1, 0xDC, 
+0x52, 0xF3, 0x90, 0xA9, 0x5C, 0xAC, 0x88, 0x9A, 
+0x42, 0xA0, 0x0A, 0xA4, 0xBA, 0xF2, 
+
+0x0F, 0x35, 0x0A, 0xD9, 0xAA, 0xF2, 0xCB, 0x8D, 
+0x16, 0xEC, 0x3E, 0xDB, 0x16, 0x25, 0x72, 0xF6, 
+0x97, 0xB7, 0x5D, 0x24, 0x9C, 0xCF, 0x9F, 0x64, 
+0xFB, 0x8D, 0x4C, 0x7D, 0x5E, 0xFD, 0x61, 0xD1, 
+0x9E, 0x19, 0x6E, 0xB7, 0x60, 0x1D, 0x66, 0x25, 
+0x1E, 0xBC, 0x8A, 0x85, 0xF9, 0x06, 0xC3, 0x50, 
+0x40, 0xD7, 0x3F, 0x73, 0x4D, 0x5F, 0x34, 0x71, 
+0xB3, 0x41, 0x1E, 0x12, 0xCF, 0x55, 0xEA, 0x0E, 
+0x4A, 0x94, 0xF7, 0xB9, 0x40, 0xAA, 0xD0, 0xA2, 
+0x0D, 0x3E, 0xEB, 0xD8, 0xF8, 0x12, 0x5D, 
+
+0x40, 0x0C, 0xFA, 0xFB, 0x19, 0xD7, 0x35, 0xF4, 
+0xF6, 0xF2, 0xC6, 0x1F, 0x44, 0x7C, 0xA3, 0xED, 
+0x65, 0xFE, 0x67, 0x7E, 0x01, 0xA4, 0x37, 0x28, 
+0x97, 0xA1, 0xEC, 0x2D, 0x51, 0x78, 0x7D, 0xCE, 
+0x65, 0xED, 0xDA, 0xD4, 0x30, 0x76, 0xA1, 0xF9, 
+0xD4, 0x2D, 0x85, 0x43, 0x0E, 0x1B, 0x54, 0x7A, 
+0xCC, 0x41, 0xC2, 0xDE, 0x15, 0x86, 0x00, 0x19, 
+0xB5, 0x41, 0x73, 0x7D, 0x74, 0xD5, 0x0D, 0xAC, 
+0xF4, 0x74, 0x57, 0xE5, 0x08, 0xF3, 0xDA, 0xC8, 
+0x63, 0xBD, 0xCB, 0x2E, 0xC8, 0x04, 0x94, 0xAF, 
+
+0x2D, 0x5C, 0x2E, 0xF4, 0xB9, 0x33, 0x32, 0xEF, 
+0x20, 0x4E, 0xD3, 0x4A, 0xB5, 0x98, 0x29, 0xB7, 
+0xC6, 0x9F, 0xCC, 0xA8, 0x9B, 0x90, 0x1A, 0xD3, 
+0x2B, 0xAC, 0x5A, 0x63, 0x48, 0x8E, 0xC4, 0xDF, 
+0x2E, 0x36, 0xE3, 0x9F, 0xD0, 0xB5, 0x97, 0xA0, 
+0xAB, 0xE1, 0xD5, 0xA5, 0x9C, 0xA5, 0x3D, 0xF6, 
+0xB9, 0xE0, 0xCB, 0xBE, 0x99, 0x63, 0x3F, 0x47, 
+0x4B, 0x0F, 0xAB, 0x3C, 0x8F, 0x91, 0x08, 0xDF, 
+0xAA, 0x3E, 0x1B, 0xB9, 0x82, 0x4B, 0xAC, 0x49, 
+0x2E, 0x3E, 0x28, 0x94, 0x57, 0xCB, 0x6C, 0x5B, 
+0x91, 
+
+0x12, 0xF1, 0xB7, 0x8A, 0x50, 0x89, 0xED, 0x6E, 
+0xC3, 0x6F, 0x18, 0xE1, 0xDF, 0x65, 0x19, 0x7B, 
+0x2B, 0x53, 0x30, 0x5E, 0xF8, 0xD4, 0x82, 0x54, 
+0xC6, 0xA8, 0x47, 0xA4, 0x6E, 0xBE, 0xA9, 0x81, 
+0xF4, 0x29, 0x75, 0x2F, 0x0E, 0x15, 0xAA, 0xA7, 
+0x75, 0x30, 0x48, 0x1D, 0xFB, 0x14, 0x58, 0x63, 
+0xAB, 0xA9, 0x5B, 0xFF, 0x25, 0xB9, 0x26, 0x42, 
+0x6A, 0x1A, 0xCF, 0x82, 0xB8, 0x5E, 0x24, 0x49, 
+0x43, 0xAB, 0xD4, 0x97, 0x54, 0xF8, 0xEA, 0x4A, 
+0x61, 0xE8, 0x37, 0x01, 0xE0, 0x98, 0xAB, 0xB2, 
+0x89, 0xFA, 
+
+0x94, 0x93, 0x38, 0x7C, 0xB8, 0x98, 0x45, 0x7E, 
+0xF6, 0x13, 0x03, 0xA3, 0xE4, 0x45, 0x41, 0xA6, 
+0x38, 0xE3, 0x0E, 0x2F, 0x22, 0x54, 0x9A, 0x1B, 
+0xF7, 0x7D, 0x6B, 0xD8, 0xCC, 0x56, 0x98, 0xE4, 
+0xD0, 0xAD, 0x28, 0xE1, 0xC3, 0x9A, 0xF4, 0x01, 
+0xD5, 0x4A, 0x73, 0x2A, 0xB5, 0x47, 0x06, 0x1C, 
+0x62, 0xDF, 0x4E, 0xF7, 0x80, 0x7E, 0x41, 0xDE, 
+0xB6, 0x3C, 0xA3, 0x27, 0xCF, 0xBB, 0x38, 0xA1, 
+0x25, 0x53, 0x05, 0x80, 0x11, 0xD6, 0x67, 0xFF, 
+0xB0, 0x02, 0xB0, 0xFC, 0x46, 0x2E, 0x9A, 0xBE, 
+0x4A, 0xB3, 0x2B, 
+
+0x00, 0xF4, 0x71, 0xA5, 0x56, 0x70, 0x85, 0xF0, 
+0x61, 0x5F, 0xA2, 0x18, 0xF7, 0x4A, 0x36, 0xC9, 
+0x04, 0x40, 0x1B, 0x5E, 0x19, 0x60, 0xEB, 0x85, 
+0x40, 0xFE, 0x2D, 0xDE, 0xE3, 0x35, 0x0D, 0xCA, 
+0x6E, 0x25, 0x6E, 0x49, 0xDD, 0x45, 0x3A, 0x6E, 
+0x8A, 0x0F, 0x09, 0x0C, 0x53, 0xFF, 0x6C, 0x92, 
+0xCA, 0xBF, 0xC1, 0xF2, 0x6F, 0x79, 0x38, 0xEA, 
+0x75, 0x5B, 0xF3, 0xE3, 0x32, 0xDE, 0xCD, 0x12, 
+0x97, 0x0A, 0x82, 0x45, 0xB7, 0xF5, 0x90, 0x00, 
+0x86, 0x2D, 0xF6, 0xED, 0xC1, 0xE0, 0xE1, 0x01, 
+0xD8, 0x83, 0x68, 0x67, 
+
+0xAE, 0xF5, 0x45, 0xDD, 0x54, 0x7E, 0xA5, 0xF3, 
+0x5A, 0x25, 0x0D, 0x9A, 0x95, 0x12, 0xB1, 0x77, 
+0xB8, 0xF8, 0x6B, 0xDC, 0x43, 0x95, 0xA3, 0xD9, 
+0xF8, 0x45, 0x8B, 0xA8, 0x43, 0x25, 0x77, 0xC4, 
+0xB0, 0xB7, 0x0C, 0x48, 0x84, 0xF5, 0x15, 0xBB, 
+0x4E, 0xD8, 0x51, 0x3D, 0x92, 0x20, 0x24, 0x9A, 
+0xF1, 0xEB, 0x99, 0x7E, 0xC6, 0x5C, 0x16, 0xD9, 
+0x85, 0x8A, 0xC0, 0x4E, 0xED, 0x95, 0xE1, 0x5D, 
+0xF2, 0x68, 0x34, 0x98, 0x63, 0x75, 0x36, 0x0B, 
+0x64, 0xFF, 0x63, 0xD2, 0x2B, 0x2B, 0x25, 0x11, 
+0xE3, 0x61, 0xC0, 0xD7, 0x96, 
+
+0xFB, 0x5A, 0xFE, 0x40, 0xCC, 0x1C, 0x44, 0xC5, 
+0x8E, 0x8F, 0x26, 0xDB, 0x56, 0xE8, 0xF7, 0xE3, 
+0xF5, 0xBC, 0x76, 0xEF, 0x73, 0xDA, 0xF6, 0x1B, 
+0xCC, 0xD4, 0x04, 0x8D, 0x07, 0x1E, 0x85, 0x7E, 
+0x39, 0x61, 0xB6, 0xCD, 0x2E, 0x03, 0x5D, 0xED, 
+0x33, 0xBB, 0x09, 0xE0, 0x09, 0xD3, 0x1C, 0x23, 
+0x1E, 0xD5, 0x29, 0xB8, 0xA5, 0x4F, 0x81, 0x96, 
+0xDA, 0xFB, 0x57, 0x4F, 0x3B, 0xF0, 0x63, 0xDC, 
+0xB2, 0x13, 0xBE, 0xD0, 0x72, 0x18, 0xF5, 0x95, 
+0x3B, 0xE6, 0x6F, 0x36, 0xA4, 0x64, 0x5C, 0x9E, 
+0x23, 0xB7, 0xE8, 0x0A, 0x87, 0x41, 
+
+0x44, 0x04, 0xE5, 0x30, 0x20, 0xED, 0x20, 0x6E, 
+0x9E, 0x51, 0x1A, 0xCE, 0x3E, 0x96, 0xF4, 0xF9, 
+0xC2, 0x95, 0x08, 0xDD, 0xBA, 0x32, 0x85, 0x53, 
+0xF0, 0x0F, 0x44, 0xAB, 0x4F, 0x0D, 0xC0, 0x6A, 
+0xBB, 0x0B, 0xDF, 0xC7, 0x75, 0xCE, 0xBD, 0x0F, 
+0xA7, 0xCE, 0xB2, 0xBB, 0x08, 0x42, 0xA4, 0x99, 
+0xA2, 0x9F, 0x03, 0x33, 0x0E, 0xDD, 0xCA, 0xF5, 
+0x26, 0x19, 0x3B, 0x77, 0x86, 0x3F, 0x33, 0x95, 
+0xC2, 0x54, 0x27, 0xB1, 0xB3, 0x45, 0xF0, 0x1B, 
+0x12, 0x1C, 0x11, 0x96, 0x7C, 0x2E, 0xA9, 0xE5, 
+0xB4, 0x9E, 0xEC, 0xE1, 0x9A, 0xA8, 0xBE, 
+
+0x24, 0x91, 0x00, 0xBB, 0xC4, 0x0E, 0xB1, 0x78, 
+0x38, 0xAA, 0x58, 0x74, 0x26, 0xF9, 0x0D, 0x3B, 
+0xB9, 0x9E, 0xB3, 0xD0, 0x75, 0x93, 0x86, 0x22, 
+0xE6, 0xE0, 0x3B, 0x75, 0x45, 0x52, 0x26, 0xE1, 
+0xCF, 0xC7, 0xD6, 0x2F, 0x70, 0x3B, 0xE5, 0xBA, 
+0x7F, 0xD0, 0xBE, 0x4D, 0x7B, 0xE8, 0xFD, 0x7B, 
+0xC8, 0x7F, 0x98, 0x37, 0xFD, 0xED, 0x8A, 0xA4, 
+0xB1, 0x77, 0xA8, 0x7B, 0x1C, 0xE3, 0x80, 0x98, 
+0xEE, 0x43, 0x74, 0x09, 0xA8, 0x71, 0x70, 0x39, 
+0x22, 0x2C, 0x35, 0x43, 0xD0, 0x54, 0x0E, 0x68, 
+0xC4, 0x3F, 0x6C, 0x92, 0xBF, 0xE0, 0x9F, 0xF2, 
+
+0xA7, 0x2C, 0xDA, 0xD4, 0xC6, 0xC1, 0xE8, 0x6A, 
+0xF1, 0xD9, 0x7E, 0x9B, 0xEA, 0x93, 0x90, 0x14, 
+0xFE, 0x47, 0x9F, 0x78, 0x68, 0xEA, 0x35, 0x87, 
+0xAD, 0x6F, 0x92, 0x79, 0xB8, 0xB3, 0x9C, 0x52, 
+0x27, 0x72, 0x24, 0xCC, 0xCA, 0x7B, 0xFE, 0x0E, 
+0x24, 0x3E, 0x6A, 0xE6, 0x9A, 0xF2, 0x8E, 0xE9, 
+0x69, 0xD6, 0x04, 0xE6, 0xD2, 0x65, 0x42, 0xAB, 
+0xEB, 0x48, 0xB9, 0x85, 0x99, 0xFD, 0x19, 0x0C, 
+0x98, 0x1C, 0xA7, 0xF9, 0x6D, 0xEE, 0x83, 0x40, 
+0xE4, 0x2F, 0x32, 0xFC, 0x04, 0x01, 0x32, 0xC2, 
+0x5C, 0x0B, 0x68, 0x1B, 0x0F, 0x3A, 0x75, 0x2E, 
+0x34, 
+
+0x3C, 0x70, 0xBD, 0xB2, 0xF0, 0x7F, 0x4F, 0x8D, 
+0x04, 0x17, 0xC6, 0xF4, 0xC5, 0x67, 0xFC, 0x1D, 
+0x85, 0x7A, 0xF8, 0x83, 0xF4, 0xF5, 0xC2, 0xA3, 
+0xCD, 0x46, 0xAB, 0x1A, 0x61, 0x46, 0x10, 0x3F, 
+0x19, 0x1C, 0xA4, 0x7D, 0xEF, 0x11, 0x9C, 0x38, 
+0x6B, 0x2E, 0x4D, 0xFA, 0xF3, 0x2B, 0x1E, 0x8D, 
+0x1F, 0x5F, 0xCB, 0xA4, 0xDA, 0x5A, 0x4C, 0x65, 
+0xA0, 0xC4, 0xB0, 0xB8, 0x80, 0xD2, 0x17, 0x4B, 
+0x3E, 0x9D, 0xBD, 0xFC, 0x6D, 0xF8, 0x23, 0xAB, 
+0x2D, 0x07, 0x47, 0xD1, 0x4B, 0xCA, 0x06, 0x32, 
+0xDE, 0x8E, 0x6C, 0x68, 0x6D, 0xEA, 0x21, 0x9F, 
+0xF0, 0xEF, 
+
+0xB8, 0x23, 0x3A, 0xEB, 0xAA, 0xB0, 0xB1, 0xFA, 
+0xEE, 0x6F, 0x0B, 0xFF, 0xDD, 0x79, 0x9B, 0x3C, 
+0xF7, 0x2F, 0xD5, 0x5F, 0xDD, 0x22, 0x81, 0xC7, 
+0xA3, 0x09, 0x7F, 0x1F, 0x2F, 0x15, 0x2A, 0x56, 
+0x64, 0x00, 0x40, 0x7D, 0x14, 0x48, 0x09, 0x27, 
+0xD2, 0xDD, 0xF8, 0xFF, 0xE6, 0x00, 0x4E, 0x33, 
+0x59, 0x02, 0xD2, 0x7D, 0xE7, 0xF9, 0xDB, 0x9B, 
+0x63, 0xA5, 0xD0, 0xE9, 0x02, 0x45, 0xAF, 0xF7, 
+0xBF, 0x9C, 0xFA, 0xB8, 0xD3, 0x78, 0xC9, 0xD7, 
+0xA8, 0x85, 0x76, 0xC0, 0x74, 0x1E, 0x30, 0x7D, 
+0x71, 0x2C, 0x3D, 0x3E, 0xDB, 0xAC, 0x4C, 0x53, 
+0x9D, 0x23, 0x2D, 
+
+0x87, 0x1F, 0xDE, 0xC7, 0xE1, 0x12, 0xBD, 0xFC, 
+0x37, 0x1E, 0x01, 0x99, 0xFE, 0xFC, 0xF5, 0x34, 
+0x5F, 0xE7, 0x52, 0x27, 0x5F, 0xA5, 0x16, 0x97, 
+0x27, 0x82, 0x51, 0x1D, 0xD0, 0x7B, 0x09, 0xFC, 
+0x7D, 0x3A, 0x38, 0x5E, 0xBA, 0x81, 0xB9, 0xD5, 
+0xAD, 0x6B, 0xCE, 0x32, 0x7B, 0xEA, 0x51, 0xBC, 
+0x33, 0x0D, 0xC5, 0xF8, 0x63, 0x08, 0x94, 0xED, 
+0x8A, 0x1D, 0xAA, 0xAE, 0xB0, 0xCE, 0x4E, 0x76, 
+0xB0, 0x7F, 0x48, 0x88, 0xDE, 0xE7, 0x74, 0x4E, 
+0xE5, 0xAD, 0x7F, 0xDA, 0x55, 0xDA, 0xBC, 0xC5, 
+0xC9, 0xF4, 0xC8, 0x5D, 0x4F, 0x32, 0x09, 0xE2, 
+0xD0, 0x16, 0xF6, 0xE7, 
+
+0x0F, 0x51, 0x4A, 0x0F, 0x79, 0xA0, 0x95, 0x0F, 
+0xE8, 0x02, 0xA0, 0x43, 0x5F, 0xE6, 0x2E, 0x9A, 
+0x6D, 0x0D, 0x29, 0xC6, 0x93, 0x1C, 0x07, 0x8C, 
+0x2B, 0x43, 0xFB, 0x0E, 0x12, 0xBD, 0x15, 0xF0, 
+0x58, 0x4A, 0x8D, 0x92, 0xE5, 0xF3, 0xDD, 0x61, 
+0x37, 0x7A, 0x17, 0x0B, 0xF3, 0x84, 0x9E, 0xA4, 
+0x39, 0x08, 0x1A, 0xC8, 0x7F, 0x95, 0x06, 0x53, 
+0x3F, 0xE4, 0xBE, 0xAC, 0x2E, 0xCF, 0xDA, 0x4B, 
+0x58, 0x40, 0x89, 0xA6, 0xF0, 0xF4, 0x6A, 0xEB, 
+0xE5, 0x00, 0x0A, 0x0A, 0x43, 0xEB, 0x4D, 0x59, 
+0x9B, 0x46, 0xE7, 0xF7, 0xB3, 0xA1, 0x39, 0x10, 
+0x26, 0x0E, 0xE1, 0x90, 0xD7, 
+
+0xE6, 0x13, 0xEE, 0x84, 0xF6, 0xB4, 0x9B, 0x39, 
+0x84, 0x24, 0x9E, 0x58, 0x17, 0xA6, 0xD3, 0x1E, 
+0x89, 0xA4, 0x0C, 0x13, 0x22, 0xEE, 0xC2, 0xEF, 
+0x4B, 0x8A, 0x67, 0x4D, 0x3C, 0xA3, 0x5E, 0xE1, 
+0xAF, 0x60, 0x75, 0x96, 0x19, 0x61, 0xB3, 0xE9, 
+0x06, 0x81, 0xF7, 0xE8, 0xAE, 0x65, 0xAD, 0xB8, 
+0xFB, 0x72, 0xA9, 0xDD, 0xE8, 0xC1, 0xFA, 0x5C, 
+0x25, 0x63, 0x2F, 0x20, 0xD2, 0x02, 0xCA, 0x86, 
+0x1D, 0x85, 0xA4, 0xB1, 0x65, 0xB9, 0xAF, 0xA9, 
+0xFC, 0x77, 0xB1, 0x8F, 0x55, 0xE7, 0x97, 0x40, 
+0x51, 0x03, 0x71, 0x17, 0x6E, 0xD9, 0xD7, 0x32, 
+0x50, 0x62, 0x68, 0x69, 0x3A, 0x57, 
+
+0xC4, 0x38, 0x66, 0xD1, 0xDA, 0x42, 0x3F, 0x89, 
+0xCD, 0x37, 0x92, 0x3B, 0xDA, 0xAF, 0xB4, 0x5A, 
+0xC2, 0x23, 0x14, 0xF4, 0x3D, 0x2C, 0x27, 0x19, 
+0xA1, 0x3F, 0xA4, 0x89, 0xD3, 0x2C, 0x07, 0x1E, 
+0x74, 0x38, 0x81, 0x31, 0x75, 0x32, 0x8A, 0x7F, 
+0x1E, 0x56, 0x71, 0xF2, 0x5D, 0x87, 0x99, 0x4D, 
+0x98, 0x6F, 0xCE, 0x8B, 0x97, 0x43, 0xC4, 0x82, 
+0x06, 0x3D, 0x4E, 0x8A, 0xC1, 0xBF, 0x84, 0x53, 
+0xAA, 0xDC, 0x8B, 0xDF, 0x0B, 0xEB, 0x40, 0x42, 
+0xDD, 0xAE, 0xA2, 0x03, 0x55, 0x3D, 0x98, 0x2A, 
+0x10, 0xFE, 0x14, 0xFE, 0x97, 0xFF, 0x0B, 0x53, 
+0x52, 0xCD, 0x97, 0xC1, 0xA7, 0x65, 0x25, 
+
+0x38, 0xF3, 0x98, 0xD9, 0xBA, 0xE4, 0xDA, 0x4D, 
+0x82, 0xE8, 0xA2, 0x4E, 0xB5, 0x65, 0x42, 0x3A, 
+0x8B, 0x37, 0x22, 0xCE, 0x91, 0xE0, 0x0F, 0x82, 
+0x5E, 0x9C, 0xC5, 0x09, 0xD2, 0x44, 0xCA, 0x46, 
+0xCD, 0xEB, 0xDD, 0xF7, 0x8E, 0xDC, 0xAE, 0xDD, 
+0x97, 0x0E, 0x12, 0x81, 0x6B, 0x87, 0xC3, 0x6B, 
+0xED, 0x49, 0x69, 0xC3, 0x9C, 0xFA, 0xA0, 0x45, 
+0x1E, 0xD1, 0x1D, 0x2F, 0x2E, 0xEE, 0x6C, 0x70, 
+0x1B, 0xA2, 0xDC, 0x1D, 0x0F, 0xD1, 0x21, 0x0B, 
+0x8A, 0xC6, 0xE3, 0xDC, 0xA3, 0xA1, 0x4A, 0xFC, 
+0xE7, 0xB1, 0x2B, 0xFD, 0xCF, 0x2B, 0x8B, 0xE1, 
+0xEC, 0xBB, 0xCB, 0x8A, 0x7E, 0xFA, 0x6D, 0xB4, 
+
+0x1D, 0x18, 0xAE, 0xCC, 0xE2, 0x16, 0x87, 0xE0, 
+0xE5, 0xF3, 0x52, 0x2B, 0x5B, 0xA4, 0xA3, 0x72, 
+0xD8, 0x15, 0x8C, 0xAF, 0x66, 0x52, 0x5D, 0x82, 
+0x6F, 0xFB, 0x3E, 0x37, 0x6C, 0x0C, 0xD8, 0x2F, 
+0x3C, 0x3E, 0x32, 0x58, 0xFA, 0xED, 0x9B, 0xD3, 
+0xC5, 0xC4, 0x29, 0x4D, 0xF9, 0x8D, 0xD6, 0xB8, 
+0x98, 0x33, 0xD2, 0x12, 0x2B, 0xD0, 0x82, 0x57, 
+0x22, 0x05, 0x76, 0x3E, 0xF8, 0x19, 0xFB, 0x06, 
+0x0E, 0x85, 0x2A, 0xF1, 0x21, 0x62, 0x96, 0x97, 
+0x7E, 0x85, 0xDA, 0x54, 0x82, 0x80, 0xCE, 0x32, 
+0xFF, 0x37, 0xBB, 0xA2, 0xC9, 0x8C, 0x3B, 0x4D, 
+0xE2, 0x7B, 0x17, 0xF1, 0xA4, 0x73, 0xCC, 0x5E, 
+0x4A, 
+
+0xB7, 0xDC, 0xBC, 0xC1, 0xF8, 0x2E, 0x9D, 0xD1, 
+0x35, 0xC8, 0xFD, 0xD7, 0xCD, 0x63, 0xD3, 0xA0, 
+0x4D, 0xC6, 0xC6, 0xDA, 0x10, 0x8E, 0x16, 0x7C, 
+0x17, 0xCB, 0x24, 0xBB, 0x71, 0xA1, 0xC9, 0x11, 
+0x32, 0x63, 0xA4, 0x39, 0x62, 0xEF, 0xB7, 0x25, 
+0x4E, 0xCE, 0xCA, 0x62, 0xD1, 0x6B, 0x2A, 0x12, 
+0x37, 0xD8, 0xE2, 0xF6, 0xCE, 0x28, 0x70, 0x71, 
+0x19, 0x00, 0x12, 0x9A, 0xFD, 0x8E, 0xC0, 0x5A, 
+0xB2, 0x31, 0xA3, 0xD1, 0x28, 0x2F, 0x03, 0x0A, 
+0x56, 0x86, 0xE1, 0xB1, 0xDE, 0x7C, 0xBF, 0xCB, 
+0x7D, 0x72, 0xD3, 0x00, 0xC8, 0x5D, 0xD3, 0x7E, 
+0xC0, 0xDA, 0xC1, 0x15, 0xB0, 0xBB, 0xF9, 0xD6, 
+0x6E, 0xE3, 
+
+0xD3, 0xB7, 0x22, 0x84, 0xC0, 0x01, 0x61, 0x79, 
+0x54, 0x22, 0x20, 0x4B, 0xD6, 0x67, 0x6B, 0xEA, 
+0xA6, 0xAB, 0x6A, 0xC6, 0x22, 0x26, 0x83, 0x7B, 
+0xD4, 0xB8, 0x0B, 0xA0, 0xD6, 0xC0, 0xE0, 0x7E, 
+0x07, 0xE9, 0xFE, 0xDB, 0x13, 0xCE, 0x88, 0x80, 
+0x55, 0x79, 0x4B, 0x1D, 0xFA, 0xF0, 0x30, 0x28, 
+0xA4, 0xE4, 0xEB, 0x46, 0x57, 0x7C, 0xDA, 0x54, 
+0xF6, 0xC5, 0x5A, 0x04, 0x13, 0x15, 0x37, 0x89, 
+0xB4, 0x09, 0xF5, 0x0C, 0x34, 0x3A, 0x7A, 0xDB, 
+0xF9, 0x8B, 0x25, 0x6D, 0x5D, 0x85, 0x6A, 0xEC, 
+0x58, 0xA4, 0xFF, 0x39, 0x81, 0x92, 0xEF, 0x54, 
+0x22, 0xE1, 0x49, 0x2C, 0x02, 0x0F, 0x91, 0x47, 
+0x5D, 0x02, 0xCE, 
+
+0xA6, 0xBD, 0xD4, 0x5E, 0xB9, 0x28, 0xA0, 0x23, 
+0xF5, 0x6E, 0x63, 0xA7, 0x9B, 0x74, 0xDF, 0xFD, 
+0x39, 0x2A, 0xA7, 0x9C, 0x7A, 0x8E, 0x1D, 0x3D, 
+0xB4, 0x0F, 0xAF, 0xF3, 0xBD, 0xDF, 0x61, 0xFA, 
+0xBE, 0xF1, 0x2C, 0xC4, 0x33, 0x69, 0x6E, 0x75, 
+0xF4, 0x54, 0x3F, 0x72, 0x97, 0xE9, 0xCD, 0xF7, 
+0x6A, 0xAE, 0x41, 0x26, 0x08, 0x7F, 0xCC, 0x80, 
+0x6E, 0xB1, 0x39, 0xA7, 0xF9, 0x15, 0x10, 0x3A, 
+0xBE, 0x14, 0xC3, 0x3D, 0x5C, 0xC8, 0x0D, 0xA2, 
+0x88, 0x7D, 0xAE, 0x5E, 0x83, 0x7E, 0xBC, 0x28, 
+0x6F, 0xF3, 0xBE, 0x79, 0x99, 0xAB, 0x9C, 0x26, 
+0x2F, 0xBC, 0x35, 0xB9, 0x33, 0x8E, 0x50, 0xF7, 
+0xA7, 0xDB, 0xB2, 0x2E, 
+
+0x5F, 0xB3, 0x3A, 0x3E, 0x30, 0x12, 0x3F, 0x2A, 
+0xB1, 0x4D, 0x09, 0x2D, 0x27, 0x0D, 0xC7, 0xBC, 
+0x28, 0x29, 0x16, 0x73, 0xB6, 0x9E, 0xE1, 0xAC, 
+0x40, 0xA8, 0x19, 0xE5, 0x9D, 0x6A, 0x95, 0xC2, 
+0xAD, 0x02, 0x5D, 0x8A, 0xC4, 0x51, 0x9F, 0x68, 
+0x73, 0xC6, 0x49, 0x29, 0xBA, 0x6C, 0x47, 0xD6, 
+0xE9, 0x9C, 0xAE, 0x15, 0x1A, 0xDB, 0x10, 0x74, 
+0xBA, 0x49, 0x71, 0x83, 0x17, 0xD5, 0xD2, 0xE6, 
+0x44, 0xBE, 0x73, 0x00, 0x8F, 0xC1, 0x07, 0x9F, 
+0xE3, 0xE6, 0xA9, 0x6A, 0x5B, 0x28, 0xE9, 0x9F, 
+0xA7, 0x57, 0x7D, 0xBE, 0x5A, 0xCF, 0xE7, 0x9A, 
+0x1B, 0xAA, 0x2B, 0x5A, 0xE7, 0x66, 0xCC, 0x53, 
+0x58, 0x20, 0x7F, 0x84, 0x29, 
+
+0xD4, 0x8A, 0x00, 0xD5, 0x1D, 0xD6, 0x4A, 0xC0, 
+0x9A, 0x87, 0x8A, 0x05, 0xEC, 0x91, 0x5F, 0x1E, 
+0x7D, 0xD5, 0x5F, 0x09, 0xEE, 0x5B, 0x8E, 0x39, 
+0xA7, 0x97, 0x50, 0x0B, 0xC9, 0x97, 0x1B, 0x10, 
+0x14, 0x7A, 0xBB, 0x50, 0xFE, 0xB3, 0x6E, 0x56, 
+0x79, 0x38, 0xF7, 0xC0, 0x07, 0x4E, 0x65, 0x64, 
+0x91, 0x24, 0x83, 0xB6, 0xF9, 0x61, 0x62, 0xDD, 
+0x18, 0x43, 0x29, 0xEC, 0x7A, 0x78, 0xB8, 0x7B, 
+0xF0, 0xFB, 0x07, 0x20, 0x82, 0x05, 0x43, 0x3A, 
+0x94, 0xD5, 0x92, 0x95, 0x9C, 0x65, 0x31, 0x4F, 
+0x19, 0x93, 0x9B, 0xE2, 0x6D, 0xDA, 0x09, 0x69, 
+0xC8, 0x5A, 0x34, 0xBC, 0xE3, 0x50, 0xE2, 0xAE, 
+0xDF, 0x27, 0x91, 0x68, 0x09, 0x75, 
+
+0x0F, 0x7E, 0x37, 0xD7, 0xD1, 0xFA, 0x4A, 0x0F, 
+0xF4, 0x1B, 0x46, 0xCC, 0x78, 0xBC, 0x3C, 0x7D, 
+0xFF, 0x77, 0x62, 0x18, 0x21, 0xB8, 0xEA, 0x82, 
+0x8F, 0xF0, 0x4C, 0xFB, 0x52, 0x83, 0x37, 0x80, 
+0xE9, 0x0F, 0x12, 0x82, 0x7C, 0x1B, 0x9B, 0x9B, 
+0xBE, 0x49, 0x07, 0x59, 0x1E, 0x15, 0xD2, 0xA4, 
+0x8C, 0x3F, 0x81, 0x76, 0x96, 0x38, 0x05, 0x56, 
+0x9B, 0x8A, 0xC1, 0x60, 0x62, 0xB0, 0x46, 0xAE, 
+0xE4, 0x08, 0x42, 0x60, 0x74, 0xE9, 0x64, 0x8E, 
+0xD7, 0x8B, 0x62, 0xB8, 0x3F, 0x82, 0xE2, 0xF1, 
+0x85, 0x76, 0xD9, 0x84, 0x57, 0x33, 0x66, 0x7D, 
+0x54, 0x43, 0x69, 0x8B, 0x6A, 0x99, 0x32, 0xBB, 
+0x67, 0x54, 0x55, 0x1F, 0xC5, 0xDD, 0xD1, 
+
+0x28, 0x60, 0xB2, 0xA6, 0x6E, 0x4F, 0xF8, 0x13, 
+0xC3, 0xFF, 0xE5, 0x0E, 0xF0, 0x2C, 0x4A, 0x6A, 
+0xC3, 0xE4, 0x9D, 0x62, 0xE4, 0x4B, 0x76, 0x4A, 
+0x0D, 0x8F, 0x58, 0x19, 0xDF, 0x90, 0xE2, 0x68, 
+0x34, 0xE3, 0x01, 0x17, 0xA9, 0x2B, 0xC9, 0x31, 
+0xEB, 0x0A, 0x89, 0xB9, 0xF9, 0x20, 0x7A, 0xDD, 
+0xFC, 0x5B, 0x73, 0x57, 0xFF, 0xEA, 0xA0, 0x57, 
+0x4F, 0x28, 0x79, 0x0B, 0xFF, 0xCA, 0x45, 0xB9, 
+0xAA, 0xEB, 0x01, 0x3E, 0x9E, 0xF9, 0x2C, 0xC9, 
+0xE4, 0x28, 0xE2, 0xC4, 0x78, 0x87, 0x51, 0x04, 
+0xA2, 0x67, 0xCF, 0xD2, 0x80, 0x24, 0xF5, 0x82, 
+0xD4, 0x84, 0x65, 0x80, 0x14, 0x03, 0x5F, 0x01, 
+0xB6, 0x76, 0x82, 0x0B, 0xB4, 0x8E, 0x4E, 0x8D, 
+
+0xE0, 0x2D, 0x52, 0x9B, 0xAC, 0xA3, 0x78, 0xF4, 
+0x8D, 0x8D, 0xCA, 0xC1, 0xCF, 0xA1, 0x0F, 0xE0, 
+0x44, 0x22, 0x8C, 0x8E, 0xB5, 0xBB, 0xBB, 0xBF, 
+0x20, 0xA0, 0xCE, 0xE1, 0x00, 0xD7, 0xD3, 0x25, 
+0x21, 0xFE, 0x6F, 0x09, 0x1D, 0x1A, 0x8A, 0xA1, 
+0xEE, 0x85, 0xD1, 0x1F, 0xF5, 0xFF, 0xB5, 0x37, 
+0x46, 0xA4, 0x17, 0xDE, 0xA0, 0xAA, 0xA6, 0x34, 
+0x4D, 0x42, 0x07, 0xD9, 0x76, 0x38, 0xB5, 0x7C, 
+0xFA, 0xA4, 0x1B, 0x6A, 0xBC, 0xAA, 0x9F, 0x8B, 
+0x64, 0xFB, 0x8D, 0xA6, 0xB8, 0xC5, 0x97, 0xBC, 
+0x6C, 0x52, 0x44, 0x6C, 0x94, 0x29, 0x1B, 0x33, 
+0x79, 0x43, 0xDC, 0x51, 0xA4, 0x33, 0x2C, 0x59, 
+0xD9, 0x23, 0xD9, 0xEF, 0x6A, 0x5B, 0xA5, 0x92, 
+0xC8, 
+
+0x9D, 0x5F, 0x01, 0xE7, 0x41, 0x19, 0x9E, 0x35, 
+0x76, 0xC8, 0x09, 0xD9, 0xC1, 0xD3, 0x3D, 0x92, 
+0xFA, 0x4A, 0xBB, 0xDA, 0x2E, 0x33, 0x72, 0xF3, 
+0xD4, 0x65, 0xB6, 0x02, 0x64, 0xC4, 0x20, 0xF8, 
+0xEA, 0x77, 0x41, 0x7C, 0x8A, 0xEA, 0x51, 0xED, 
+0xCD, 0x10, 0xA1, 0xC8, 0x50, 0xA5, 0x49, 0x94, 
+0x67, 0x3F, 0x01, 0x8F, 0x01, 0x65, 0x47, 0xF0, 
+0xE0, 0x4A, 0x39, 0xD8, 0x8F, 0x12, 0x3F, 0x70, 
+0x25, 0xDE, 0x4D, 0xEE, 0xF7, 0x26, 0xCD, 0x34, 
+0x2C, 0xB4, 0x16, 0x0D, 0x93, 0x8B, 0x27, 0xF6, 
+0x58, 0x5C, 0x46, 0xCE, 0xCA, 0x35, 0xAF, 0x30, 
+0x0C, 0x2B, 0x7D, 0x33, 0x55, 0x9D, 0x0F, 0x10, 
+0x9C, 0x30, 0x60, 0x90, 0x0E, 0x83, 0xE9, 0x1B, 
+0x56, 0x8F, 
+
+0x83, 0x93, 0x7C, 0xD4, 0x20, 0x10, 0x01, 0x6B, 
+0x16, 0x27, 0xCD, 0x26, 0x1F, 0x02, 0x78, 0xA0, 
+0x51, 0x71, 0x94, 0x84, 0x65, 0x69, 0x4A, 0x59, 
+0x97, 0x29, 0xA4, 0x33, 0xC5, 0x5E, 0x24, 0xDB, 
+0x2F, 0xF1, 0x48, 0x52, 0xC7, 0x84, 0xB6, 0x5E, 
+0x93, 0x44, 0x6B, 0x83, 0x89, 0xB7, 0xED, 0x06, 
+0x05, 0x01, 0xF3, 0x72, 0xD9, 0xAF, 0x8A, 0xBE, 
+0xE1, 0x3B, 0xE4, 0x4F, 0x1D, 0x93, 0xC3, 0x90, 
+0x10, 0x8C, 0x27, 0xDC, 0xB8, 0xFE, 0x1C, 0x79, 
+0x07, 0xEE, 0x4A, 0x0F, 0xF1, 0x9F, 0x1C, 0xB5, 
+0xFC, 0xAB, 0xCF, 0x0D, 0x55, 0xAF, 0xAE, 0x5B, 
+0x65, 0xE3, 0xC2, 0xF8, 0xF9, 0x81, 0x08, 0x22, 
+0x3B, 0x2B, 0x4B, 0x69, 0x58, 0xC9, 0xD8, 0xCF, 
+0xF0, 0x3D, 0x88, 
+
+0x22, 0x6B, 0x41, 0x72, 0xB3, 0xAA, 0x0C, 0xED, 
+0x04, 0xC5, 0x1C, 0x18, 0x60, 0x6E, 0x81, 0x5D, 
+0xD5, 0xA4, 0x66, 0x67, 0xF6, 0xA5, 0x6B, 0xA5, 
+0xFF, 0x01, 0x93, 0x22, 0x75, 0x62, 0x80, 0x6E, 
+0x0D, 0x78, 0xA8, 0xD8, 0x6E, 0xDB, 0xAA, 0x2D, 
+0x55, 0x96, 0xD1, 0x62, 0x17, 0x0C, 0x9D, 0xDE, 
+0x8B, 0x1B, 0x02, 0xFA, 0xF0, 0xFB, 0xCC, 0x80, 
+0x79, 0x6E, 0x68, 0x35, 0xFF, 0x7F, 0xCF, 0x84, 
+0x12, 0xC9, 0x67, 0x5E, 0xC1, 0xEE, 0x36, 0x23, 
+0xD5, 0xCD, 0x14, 0x86, 0x0B, 0x97, 0x5D, 0x2B, 
+0x7D, 0xA2, 0x7D, 0xBA, 0x19, 0xA5, 0xF8, 0x75, 
+0x3E, 0xA8, 0xE8, 0x80, 0x51, 0x45, 0xB9, 0xD6, 
+0x37, 0x8D, 0x72, 0x23, 0x11, 0x9A, 0x36, 0x46, 
+0x10, 0xA1, 0x72, 0xD6, 
+
+0x47, 0x65, 0xEC, 0x24, 0x57, 0xC5, 0x74, 0xE1, 
+0x26, 0x5E, 0xC1, 0x56, 0x9A, 0xA5, 0xC3, 0x40, 
+0xA8, 0x0F, 0x33, 0x73, 0xBC, 0x98, 0x3B, 0x94, 
+0x75, 0x9A, 0xF4, 0xBC, 0xF0, 0xC0, 0xB7, 0x93, 
+0x39, 0xD2, 0xEE, 0x58, 0xC6, 0xCA, 0x0B, 0x0B, 
+0x65, 0x2B, 0x35, 0xF3, 0x7E, 0x9C, 0x9A, 0xD8, 
+0x09, 0x87, 0x64, 0x74, 0x1C, 0xE0, 0xE6, 0x02, 
+0xE1, 0x63, 0x8B, 0x15, 0xC0, 0x08, 0x74, 0xC5, 
+0xDC, 0x19, 0x9C, 0x67, 0xF1, 0xC6, 0xEA, 0x48, 
+0x9A, 0xC4, 0x81, 0xB3, 0xDE, 0x96, 0x8C, 0x5E, 
+0x33, 0xF6, 0x93, 0xD4, 0x9D, 0x80, 0x57, 0x84, 
+0x65, 0x2F, 0xD3, 0x35, 0x0A, 0x12, 0x3B, 0x9C, 
+0x91, 0x21, 0x73, 0x80, 0x61, 0xB3, 0x6A, 0x59, 
+0x7A, 0x21, 0x36, 0xA1, 0x14, 
+
+0x17, 0xD5, 0x14, 0xE3, 0x71, 0x6B, 0x95, 0x63, 
+0x45, 0x47, 0x0E, 0xCA, 0xA0, 0x65, 0x06, 0xCC, 
+0x28, 0xA2, 0x09, 0x68, 0x5C, 0x91, 0x60, 0x16, 
+0xBC, 0xB0, 0x82, 0x4E, 0x6B, 0xAD, 0x0E, 0x07, 
+0xE7, 0xF4, 0x6F, 0x0E, 0x4D, 0xBE, 0x63, 0xAC, 
+0xB8, 0x0E, 0x45, 0x6F, 0xEC, 0x89, 0x81, 0xD0, 
+0x55, 0x8D, 0x7A, 0x1C, 0xBA, 0x2D, 0x4E, 0x48, 
+0xFA, 0x78, 0x5E, 0x94, 0xF0, 0x61, 0xB2, 0xDD, 
+0x43, 0xF9, 0xCA, 0xBF, 0x07, 0x25, 0x80, 0x17, 
+0x0B, 0xF1, 0x70, 0x1B, 0xAF, 0x0D, 0xAF, 0x9E, 
+0x7B, 0x77, 0x3B, 0x13, 0xEB, 0xE2, 0x3D, 0xC2, 
+0xA5, 0xE5, 0x0B, 0x86, 0xEF, 0x18, 0x30, 0xF4, 
+0x36, 0x30, 0x25, 0x5C, 0x4A, 0xEA, 0x17, 0x42, 
+0xCE, 0xA3, 0x49, 0x0F, 0xCD, 0xCA, 
+
+0x0E, 0x2F, 0xFC, 0x3F, 0xFE, 0xD8, 0x1D, 0x3A, 
+0x51, 0x68, 0x16, 0x51, 0x15, 0xC1, 0x21, 0xC2, 
+0xAB, 0xEB, 0x2B, 0xBE, 0x73, 0x10, 0x72, 0x95, 
+0x0F, 0xFC, 0x5D, 0x95, 0x88, 0x2A, 0xA9, 0x7E, 
+0x1D, 0xCA, 0xFD, 0xEF, 0x2A, 0xEB, 0xE9, 0x37, 
+0xA2, 0x9D, 0x28, 0x67, 0xA4, 0x9F, 0xB5, 0xB4, 
+0x89, 0x0A, 0xDB, 0x9C, 0xD9, 0x24, 0xBA, 0xF6, 
+0x16, 0xF5, 0x50, 0x5B, 0x1A, 0xDA, 0x9C, 0xF2, 
+0x04, 0xF9, 0xF0, 0xE9, 0x0E, 0x13, 0xC8, 0x75, 
+0x94, 0xEB, 0x93, 0x1D, 0xA1, 0x0F, 0xBC, 0x14, 
+0x01, 0xA7, 0xA8, 0xDB, 0xB9, 0x0E, 0x11, 0xAE, 
+0x50, 0x2A, 0x49, 0x61, 0x99, 0xE9, 0x16, 0x85, 
+0x04, 0x20, 0x82, 0xEF, 0x56, 0xFA, 0xA1, 0x59, 
+0xF4, 0xCD, 0x28, 0xCE, 0xF0, 0xAA, 0xFE, 
+
+0xA3, 0xAA, 0x3F, 0xE5, 0x29, 0xE9, 0x75, 0x7E, 
+0x93, 0x8F, 0xD5, 0x38, 0x51, 0xA3, 0x0B, 0xA2, 
+0xBA, 0xB5, 0xCD, 0x8E, 0x9B, 0x22, 0x1D, 0xA6, 
+0xBA, 0x41, 0xF2, 0x43, 0x00, 0xA6, 0xD0, 0xDD, 
+0x4A, 0x6C, 0xBB, 0x7D, 0xEE, 0x84, 0x9D, 0x29, 
+0xF5, 0x47, 0x02, 0x3B, 0xEC, 0xD6, 0xEF, 0x9A, 
+0xA6, 0x46, 0x0B, 0xFA, 0xDB, 0x82, 0x5E, 0xCF, 
+0x32, 0x8B, 0x70, 0x9E, 0x2C, 0x40, 0x13, 0x0D, 
+0xF3, 0x2E, 0xA4, 0x80, 0x15, 0xD9, 0x19, 0xDC, 
+0x45, 0x5D, 0x82, 0x8C, 0xB7, 0x6A, 0x36, 0xD7, 
+0x41, 0x75, 0xA9, 0x38, 0x28, 0x65, 0x6E, 0xC6, 
+0xDB, 0x3E, 0xA9, 0x61, 0xCB, 0xE1, 0x66, 0x86, 
+0x3D, 0xBF, 0xC0, 0x5F, 0xE7, 0xD9, 0xB8, 0x83, 
+0x4D, 0x43, 0x4F, 0x07, 0xFB, 0x38, 0x8E, 0xD7, 
+
+0x9B, 0x9E, 0xFF, 0x1F, 0xED, 0x2F, 0xFE, 0x30, 
+0xFE, 0x0B, 0x1F, 0x2A, 0x60, 0xCC, 0x10, 0xB5, 
+0x8C, 0xAB, 0x06, 0xE8, 0x32, 0x25, 0xB3, 0xF8, 
+0x9E, 0xEC, 0xBE, 0xE4, 0xCA, 0x24, 0xDB, 0x7A, 
+0xA6, 0x43, 0x1D, 0x49, 0x72, 0x32, 0x07, 0x46, 
+0xEA, 0xE5, 0xCB, 0x89, 0xCD, 0xB4, 0xFA, 0x9D, 
+0x4B, 0xE0, 0xAF, 0x9E, 0xB2, 0xEA, 0x4E, 0x12, 
+0xF0, 0xB2, 0x10, 0x17, 0xED, 0xD1, 0x9D, 0xB3, 
+0xCD, 0xD7, 0xEF, 0x41, 0xBC, 0x97, 0x89, 0x26, 
+0xFD, 0xD9, 0x5E, 0x89, 0x12, 0x1B, 0xDC, 0x7B, 
+0x34, 0x98, 0xC1, 0x88, 0x2B, 0xD0, 0xB1, 0x5D, 
+0x6F, 0x83, 0xEB, 0xC9, 0x08, 0x36, 0x4F, 0x07, 
+0x8D, 0x5D, 0xBB, 0xD0, 0xE1, 0xF4, 0x90, 0xB9, 
+0x55, 0x08, 0x01, 0xFD, 0x42, 0xEA, 0x28, 0xEB, 
+0x82, 
+
+0x8F, 0xC5, 0xE1, 0xC0, 0xF6, 0x61, 0x26, 0x90, 
+0x6B, 0x53, 0xEA, 0xB3, 0xCA, 0xDA, 0xD9, 0x61, 
+0xCC, 0xC1, 0x6F, 0x34, 0xC8, 0x74, 0xAD, 0x57, 
+0x80, 0x7E, 0x95, 0x4E, 0xF4, 0xEC, 0x42, 0x68, 
+0x9E, 0x76, 0x08, 0x85, 0x2D, 0xBA, 0xE3, 0xF4, 
+0xB9, 0x26, 0x3A, 0x76, 0x30, 0xF5, 0x72, 0xB7, 
+0x89, 0x63, 0xC7, 0x7C, 0x96, 0x92, 0x95, 0xF0, 
+0x4D, 0x40, 0x54, 0xFF, 0x1B, 0xAC, 0x86, 0x99, 
+0x88, 0x73, 0x29, 0xD3, 0x1E, 0x1F, 0x08, 0x9A, 
+0x08, 0x02, 0xF4, 0x68, 0x36, 0xD7, 0x1F, 0x70, 
+0x1A, 0xE3, 0x86, 0x0D, 0x45, 0x3E, 0xD7, 0x9D, 
+0xBE, 0xFC, 0x35, 0x4E, 0xBA, 0xA3, 0xBF, 0x1E, 
+0x5C, 0xFE, 0x41, 0x69, 0xB9, 0x4B, 0x2F, 0x50, 
+0x3E, 0x99, 0x9E, 0x75, 0x22, 0x1B, 0x0D, 0xDD, 
+0x83, 0xD2, 
+
+0x06, 0x70, 0xE0, 0xC9, 0x58, 0xAE, 0x4A, 0xD1, 
+0x42, 0x62, 0x75, 0xE2, 0x31, 0x2E, 0x7E, 0x54, 
+0x36, 0x6F, 0xC2, 0x97, 0xF2, 0xFB, 0x41, 0x3E, 
+0x25, 0x35, 0x7C, 0xD4, 0xC4, 0x14, 0xC8, 0xA1, 
+0x43, 0x3B, 0x40, 0x39, 0xF0, 0x5D, 0x2C, 0x4A, 
+0x43, 0x25, 0x4D, 0x0F, 0xD7, 0x59, 0x84, 0x5E, 
+0x7F, 0xF1, 0x47, 0x14, 0xE8, 0x4E, 0x1E, 0x69, 
+0x06, 0x1C, 0x67, 0xA3, 0x20, 0x0A, 0x81, 0x96, 
+0x35, 0xF6, 0x39, 0xE9, 0x91, 0xE9, 0xA8, 0x08, 
+0xEF, 0x12, 0xFC, 0xDD, 0x15, 0xD3, 0xB4, 0x32, 
+0xCD, 0x76, 0x1B, 0xD1, 0x1E, 0xFB, 0xA4, 0xE2, 
+0x58, 0x39, 0x03, 0xB4, 0xC9, 0xC6, 0xAA, 0x3D, 
+0x97, 0xB9, 0xA8, 0x7A, 0x74, 0xD7, 0x40, 0x48, 
+0x17, 0x26, 0x0A, 0x3F, 0x88, 0x9F, 0x47, 0x85, 
+0x72, 0xF0, 0x1A, 
+
+0x71, 0xC2, 0xB4, 0xCB, 0x81, 0x0E, 0x2C, 0x06, 
+0x81, 0xFB, 0x06, 0xBF, 0x47, 0xD3, 0x7C, 0x5C, 
+0x57, 0xFA, 0x94, 0x26, 0x26, 0x4B, 0xB5, 0x4C, 
+0xC1, 0x4B, 0xA4, 0x33, 0xD9, 0x91, 0xF6, 0x95, 
+0x50, 0xBE, 0x46, 0x59, 0xE3, 0x0C, 0xEC, 0x47, 
+0xB0, 0xD7, 0x56, 0x4D, 0xF6, 0xBE, 0xE4, 0xF1, 
+0x81, 0xFF, 0xCB, 0x90, 0x26, 0xCF, 0x12, 0x42, 
+0x3B, 0x81, 0x04, 0x22, 0x0F, 0x42, 0x06, 0xBA, 
+0x6B, 0x9C, 0xC0, 0xE0, 0x29, 0xE2, 0x26, 0xEF, 
+0xC5, 0xCB, 0xFF, 0xB5, 0x55, 0x97, 0xEB, 0x81, 
+0x97, 0x88, 0xDA, 0x02, 0xF2, 0xE0, 0xFC, 0x28, 
+0x3C, 0xBA, 0x9A, 0xCB, 0x2F, 0xEF, 0x58, 0x41, 
+0xEB, 0x2C, 0x1B, 0x00, 0xCE, 0x51, 0x8A, 0x14, 
+0x9A, 0x7C, 0xD1, 0x47, 0x4E, 0x56, 0xDD, 0x0D, 
+0x05, 0x35, 0x83, 0xCB, 
+
+0xCC, 0xC3, 0xA1, 0x94, 0x2B, 0x64, 0xA6, 0x1C, 
+0x8B, 0x89, 0x43, 0x1F, 0x1A, 0x64, 0xC7, 0x2B, 
+0x7B, 0xEE, 0xD9, 0x07, 0xB8, 0xF9, 0x71, 0x58, 
+0x22, 0xF4, 0xC8, 0x98, 0x59, 0x4E, 0x55, 0xB0, 
+0xAB, 0x84, 0xBF, 0x47, 0x94, 0x60, 0x58, 0xC2, 
+0xF5, 0xB0, 0xC0, 0x69, 0xDA, 0x4B, 0xFA, 0x71, 
+0x23, 0x85, 0xBD, 0x22, 0x8B, 0xAB, 0xF0, 0x3E, 
+0x9D, 0xE2, 0x15, 0x44, 0xCF, 0xFE, 0x32, 0x67, 
+0x3B, 0x06, 0xBD, 0xBC, 0xF9, 0x5C, 0x42, 0x2A, 
+0x8E, 0x97, 0xD6, 0x80, 0xC7, 0x36, 0x5A, 0xC8, 
+0xF1, 0x4C, 0x3E, 0xE9, 0x6C, 0x46, 0x01, 0x21, 
+0x6F, 0xA3, 0x2A, 0x99, 0xF5, 0x70, 0x87, 0xC6, 
+0x1C, 0xEC, 0x20, 0x2E, 0x2D, 0xFF, 0x57, 0x44, 
+0xA3, 0xA5, 0x8E, 0xA3, 0x22, 0x37, 0x9D, 0x5E, 
+0x4B, 0x62, 0xC9, 0xC7, 0xF7, 
+
+0xF6, 0x5A, 0xD5, 0x9E, 0xE7, 0x2D, 0xC4, 0xD6, 
+0x2A, 0x03, 0x1D, 0xCF, 0x34, 0xD7, 0xDB, 0x1B, 
+0xFB, 0x11, 0x7E, 0x7C, 0x03, 0x4B, 0x32, 0x94, 
+0x95, 0x3D, 0xAA, 0xB8, 0xE3, 0xE4, 0xD3, 0x1B, 
+0xBD, 0x8C, 0x47, 0x3D, 0x59, 0xBB, 0x62, 0xCC, 
+0xF5, 0xEC, 0x65, 0xB9, 0xE0, 0xA5, 0xF8, 0x16, 
+0x49, 0xB5, 0xA8, 0xEB, 0x7C, 0x7E, 0x3F, 0x29, 
+0x4E, 0xC6, 0xDE, 0x56, 0xE6, 0x61, 0x7D, 0xD3, 
+0x72, 0xC6, 0xC4, 0xED, 0xA3, 0xBB, 0x3A, 0xA7, 
+0xE6, 0x78, 0xCE, 0xE9, 0x83, 0x13, 0x07, 0x1D, 
+0x37, 0xF3, 0xA0, 0x57, 0xB8, 0xC3, 0x40, 0x0D, 
+0xB9, 0xB4, 0x22, 0xFD, 0x7D, 0x68, 0x35, 0x4D, 
+0x88, 0x3B, 0xD5, 0x4A, 0xCB, 0xB5, 0x6A, 0xCA, 
+0x4F, 0xEF, 0x9E, 0xDD, 0xDC, 0x26, 0xDC, 0x57, 
+0x29, 0x63, 0xB2, 0x62, 0xA5, 0x09, 
+
+0xA7, 0xE1, 0xC0, 0x98, 0xBA, 0x82, 0xC8, 0x19, 
+0x9B, 0x0A, 0x4B, 0xCA, 0xCF, 0x45, 0x96, 0x28, 
+0x47, 0x75, 0xA2, 0x63, 0xB6, 0xF3, 0x84, 0x60, 
+0x2D, 0x19, 0xBD, 0x65, 0x06, 0x47, 0xDD, 0x60, 
+0x33, 0xB3, 0xCA, 0x92, 0x56, 0xB8, 0xAE, 0x3C, 
+0x86, 0xC6, 0xF5, 0x1D, 0xBB, 0xF8, 0x4E, 0xEF, 
+0xBA, 0x4A, 0x01, 0x97, 0xCD, 0xAE, 0xBF, 0x64, 
+0x31, 0x51, 0x45, 0xEF, 0x60, 0x55, 0x65, 0x6F, 
+0x1E, 0x6B, 0xC8, 0xC5, 0x9D, 0x69, 0x59, 0xE1, 
+0xF9, 0x3E, 0x37, 0xDC, 0x1B, 0x49, 0xA0, 0x23, 
+0xD1, 0x5A, 0xBD, 0xD0, 0xA4, 0x44, 0x65, 0x44, 
+0xB5, 0x92, 0x8D, 0x8E, 0x43, 0xC2, 0x48, 0xEF, 
+0x75, 0xFD, 0x80, 0x2A, 0x77, 0x15, 0x34, 0x7E, 
+0xB9, 0xFD, 0x7C, 0xBF, 0xD2, 0x2B, 0x78, 0x1D, 
+0x7E, 0x8C, 0x3A, 0x75, 0xE0, 0xF0, 0x3F, 
+
+0x5E, 0x1C, 0x27, 0xA0, 0x41, 0xC4, 0xA4, 0xA1, 
+0x7C, 0x7B, 0xAE, 0xDC, 0x5E, 0x61, 0xC2, 0xC1, 
+0xC4, 0xED, 0xE7, 0xA6, 0x25, 0xD3, 0x69, 0x78, 
+0x3C, 0xA9, 0xCB, 0xE1, 0x84, 0x04, 0xB7, 0x75, 
+0x4B, 0x7E, 0xB8, 0x4C, 0x11, 0xCE, 0xBE, 0x8D, 
+0xAE, 0x4F, 0x58, 0x93, 0xF3, 0x0E, 0x56, 0x08, 
+0xF2, 0x96, 0x30, 0xF8, 0x0B, 0xA1, 0x07, 0x87, 
+0xED, 0x44, 0x47, 0x2B, 0x6F, 0x9E, 0xA2, 0xDC, 
+0x88, 0xFC, 0xE7, 0x02, 0x59, 0xE8, 0x52, 0xD6, 
+0x79, 0x7A, 0x15, 0xD5, 0xC5, 0xE7, 0xC9, 0x5B, 
+0x2B, 0x5A, 0x2F, 0x0E, 0x0E, 0x3C, 0xB7, 0x90, 
+0xE4, 0xFF, 0x39, 0x90, 0x97, 0xDF, 0x9A, 0x85, 
+0x68, 0xC4, 0xD0, 0x0E, 0x89, 0xAD, 0xF2, 0xA8, 
+0xFD, 0x1D, 0xF1, 0x01, 0x16, 0x5D, 0xFE, 0xD9, 
+0xEF, 0x5E, 0x24, 0x6E, 0xEC, 0xF9, 0xF8, 0x1F, 
+
+0xD1, 0x59, 0xCF, 0x72, 0x33, 0xC0, 0x53, 0x99, 
+0x8C, 0x1A, 0xD4, 0x81, 0x2B, 0x45, 0x8C, 0xEF, 
+0x1E, 0x1B, 0x3B, 0xA7, 0x46, 0x7A, 0x32, 0xBF, 
+0xC8, 0xFE, 0xF0, 0xB1, 0x1B, 0x12, 0x7E, 0xDC, 
+0x7D, 0xFC, 0x0C, 0x54, 0x76, 0x99, 0xDA, 0xB2, 
+0xEF, 0x2D, 0xF2, 0xCD, 0x91, 0x2C, 0xBE, 0xFC, 
+0x11, 0x47, 0x55, 0x18, 0x2C, 0x43, 0x06, 0xF5, 
+0x8A, 0x00, 0x0F, 0xA9, 0xA3, 0xC5, 0x8F, 0xEE, 
+0x78, 0x90, 0xE0, 0x74, 0xE4, 0x88, 0xC4, 0x09, 
+0xB5, 0x45, 0x85, 0xC0, 0xC0, 0x9F, 0xFD, 0x01, 
+0x18, 0x34, 0x14, 0x7B, 0x73, 0x97, 0xE1, 0x43, 
+0x75, 0x3D, 0x68, 0x12, 0x0D, 0xD5, 0x45, 0x8A, 
+0x3B, 0x6C, 0x81, 0xD4, 0xF0, 0x99, 0x1C, 0xAC, 
+0x61, 0xCA, 0xF6, 0x31, 0xC8, 0xAD, 0x0B, 0x3A, 
+0x17, 0x8F, 0x16, 0xD7, 0x3C, 0x0C, 0xD5, 0xB2, 
+0xB3, 
+
+0xE0, 0x12, 0x54, 0x30, 0xD3, 0x35, 0xD8, 0xA1, 
+0x99, 0xCE, 0xA5, 0x1D, 0x11, 0x58, 0x3F, 0xCA, 
+0xE3, 0x06, 0xD0, 0x5B, 0x8F, 0x78, 0xC1, 0xC9, 
+0x52, 0xA6, 0x08, 0xF0, 0x72, 0x08, 0x1F, 0x4E, 
+0x0D, 0x76, 0x87, 0x2B, 0xE6, 0xED, 0x07, 0xB8, 
+0x06, 0x44, 0xC1, 0x1D, 0x2E, 0x2A, 0x41, 0xDC, 
+0x10, 0xDA, 0xFD, 0xCC, 0x1C, 0x9C, 0xF9, 0xA9, 
+0xF7, 0xC6, 0x0F, 0xAE, 0x25, 0xFC, 0x61, 0x47, 
+0x5E, 0x54, 0xDD, 0x43, 0x4B, 0x8D, 0x85, 0x8A, 
+0x49, 0x51, 0x28, 0x62, 0xE6, 0x21, 0x04, 0x8B, 
+0x97, 0xCC, 0xBE, 0x9B, 0x56, 0x07, 0x26, 0xDE, 
+0xB4, 0xE6, 0x19, 0x20, 0xC1, 0x8F, 0x61, 0xCE, 
+0x62, 0xC4, 0x2E, 0xA3, 0xA6, 0xCB, 0x55, 0xB5, 
+0xB5, 0x52, 0x8C, 0x93, 0x09, 0x13, 0x82, 0x21, 
+0xD0, 0xC1, 0x57, 0x81, 0x75, 0xC9, 0x2F, 0x06, 
+0x9B, 0xF6, 
+
+0x1A, 0xBB, 0x32, 0x62, 0xA1, 0xCD, 0x95, 0x04, 
+0x93, 0xA9, 0x28, 0xAC, 0x78, 0x48, 0xDD, 0xA4, 
+0x6D, 0xD0, 0x2A, 0x78, 0xCE, 0x70, 0xD5, 0x50, 
+0xAF, 0x5D, 0x3C, 0x98, 0x4A, 0x60, 0x38, 0xB5, 
+0xC5, 0x88, 0x1B, 0xBE, 0x3F, 0x9D, 0x8E, 0x27, 
+0x29, 0x3C, 0x63, 0xF8, 0xA6, 0x00, 0x77, 0xBE, 
+0x8B, 0x63, 0xD8, 0x93, 0xB7, 0x30, 0xD3, 0x63, 
+0x94, 0x34, 0x3A, 0x80, 0xFF, 0xE0, 0xB7, 0xCA, 
+0x57, 0x82, 0x99, 0x2A, 0xF1, 0xCE, 0xFC, 0xF6, 
+0x80, 0xE3, 0x7B, 0xBC, 0x6C, 0x0A, 0x63, 0xE4, 
+0x08, 0x5E, 0x25, 0x53, 0x60, 0xF2, 0xDD, 0x48, 
+0xBE, 0x34, 0x00, 0x34, 0xE8, 0x8D, 0x3F, 0x22, 
+0x0E, 0x20, 0x3C, 0xA7, 0x5E, 0xE0, 0x81, 0x7B, 
+0x7D, 0x71, 0x2C, 0xF0, 0x0B, 0x17, 0x79, 0xC8, 
+0x3A, 0xD0, 0xCD, 0x09, 0xC8, 0x20, 0xD6, 0x6B, 
+0x2E, 0x0B, 0xAC, 
+
+0xFB, 0x7D, 0xB3, 0x6A, 0xEE, 0x23, 0x79, 0xE9, 
+0x35, 0x72, 0xD4, 0xCB, 0xD2, 0x29, 0xDE, 0x2D, 
+0xD6, 0x85, 0xCC, 0x4A, 0x19, 0xDB, 0xC6, 0x9D, 
+0x34, 0x88, 0x00, 0x9D, 0xE5, 0x2F, 0x35, 0x85, 
+0xD1, 0x63, 0xD4, 0xC3, 0x1D, 0x74, 0x89, 0x54, 
+0xCA, 0xB4, 0x96, 0x5A, 0x47, 0x6A, 0xC5, 0x54, 
+0x02, 0xE0, 0xAF, 0x3A, 0xE6, 0x99, 0x40, 0x28, 
+0x3F, 0x93, 0x2D, 0xD5, 0x02, 0xD5, 0xA3, 0x7F, 
+0x8A, 0xBA, 0xA4, 0x39, 0x2D, 0xFA, 0x8F, 0x45, 
+0xCB, 0x2B, 0xEB, 0xE6, 0x83, 0xF0, 0xEE, 0xAE, 
+0x9B, 0x8A, 0x60, 0x42, 0x40, 0x79, 0x5C, 0x2D, 
+0x21, 0x6B, 0x46, 0x5D, 0x7B, 0xEB, 0xCF, 0x5E, 
+0x87, 0x03, 0xF9, 0xC3, 0x65, 0x26, 0xC0, 0x62, 
+0x16, 0x3D, 0x7A, 0x84, 0x7B, 0xA9, 0x8C, 0x1E, 
+0x9A, 0x87, 0x82, 0x01, 0x30, 0xF1, 0x0B, 0x1F, 
+0x75, 0xF9, 0x14, 0x6A, 
+
+0xBB, 0x05, 0x6C, 0x20, 0xF6, 0x54, 0xFE, 0xE4, 
+0x34, 0xE6, 0x1A, 0xDC, 0x91, 0xFF, 0x6C, 0x18, 
+0x5D, 0x35, 0x74, 0xC1, 0x7F, 0xA2, 0xAC, 0xFE, 
+0x80, 0x06, 0x0E, 0xC1, 0xEF, 0x9E, 0x80, 0xA9, 
+0xDC, 0x93, 0x00, 0x0B, 0x88, 0x56, 0x99, 0x53, 
+0x23, 0x54, 0x6D, 0xC3, 0x24, 0xB0, 0x32, 0xDC, 
+0xF0, 0x38, 0x90, 0x24, 0xE0, 0x50, 0x15, 0x54, 
+0xB2, 0x61, 0x52, 0x34, 0x19, 0x46, 0xD8, 0x7B, 
+0x98, 0xE5, 0x51, 0xDB, 0x4C, 0x68, 0x3F, 0x61, 
+0x02, 0x72, 0x51, 0x25, 0xAC, 0x5C, 0x77, 0xDF, 
+0xA5, 0x2D, 0x4D, 0x7B, 0x24, 0x63, 0x96, 0x11, 
+0x91, 0x24, 0x67, 0xF3, 0x17, 0xC5, 0xB6, 0x4C, 
+0x44, 0xB6, 0x73, 0x56, 0x98, 0x6F, 0x5D, 0x09, 
+0xA3, 0xE2, 0xB2, 0xDD, 0xB8, 0x58, 0x97, 0x4B, 
+0xC9, 0x6E, 0x86, 0x0C, 0x5F, 0xFF, 0xE5, 0xA5, 
+0x87, 0x1F, 0x44, 0x7E, 0x55, 
+
+0xF9, 0xED, 0xFB, 0x79, 0x03, 0xDF, 0x51, 0x56, 
+0xDA, 0xD4, 0x2A, 0x58, 0x58, 0xC8, 0x51, 0xCF, 
+0x19, 0xAC, 0x58, 0x1F, 0xA9, 0x80, 0xDD, 0xCC, 
+0x3B, 0xD2, 0x30, 0x50, 0xB6, 0xC6, 0xEB, 0x21, 
+0xAA, 0x4A, 0x91, 0xD0, 0xCC, 0x9B, 0x1B, 0x22, 
+0xBB, 0x8F, 0xAF, 0x71, 0x94, 0xB5, 0x72, 0xBA, 
+0x74, 0x44, 0x22, 0x98, 0x67, 0xF5, 0x24, 0xA7, 
+0x91, 0x9C, 0xB8, 0x12, 0xF8, 0x39, 0x9D, 0xE5, 
+0x11, 0xE1, 0x70, 0x3E, 0x1F, 0x06, 0x70, 0xBB, 
+0xD1, 0xAA, 0xD9, 0x81, 0xF1, 0x22, 0x0E, 0x73, 
+0x2E, 0xA5, 0x06, 0xED, 0x56, 0xD0, 0xE9, 0xD0, 
+0x69, 0x35, 0x8D, 0x39, 0x9F, 0x68, 0xD9, 0x40, 
+0x55, 0xF8, 0xE5, 0x17, 0xC8, 0xA8, 0x41, 0x74, 
+0x83, 0xBF, 0xFB, 0x72, 0xE4, 0x22, 0x9B, 0xD6, 
+0x59, 0xA7, 0xB8, 0xBD, 0x5C, 0x8B, 0xBE, 0x57, 
+0xFA, 0x51, 0x4D, 0xFF, 0x85, 0xB2, 
+
+0x87, 0x68, 0x84, 0x01, 0x0F, 0x0F, 0xFB, 0xAD, 
+0xB7, 0xAC, 0x48, 0xF6, 0xA0, 0x46, 0xF9, 0x74, 
+0x94, 0xF5, 0x7D, 0x60, 0xBD, 0x7F, 0xAC, 0x21, 
+0xFC, 0x45, 0x15, 0xDA, 0xFF, 0xD6, 0x94, 0x94, 
+0x1D, 0xB2, 0xC3, 0x2F, 0x86, 0x00, 0x29, 0x4B, 
+0xC2, 0x45, 0x14, 0x33, 0xBF, 0xA9, 0x02, 0x0F, 
+0x0C, 0x0C, 0x3D, 0x51, 0xE1, 0xF0, 0x55, 0xE5, 
+0xC8, 0x1D, 0x94, 0x33, 0x79, 0x6E, 0x34, 0x77, 
+0x23, 0xE0, 0xF3, 0x8D, 0x03, 0x6A, 0xE2, 0x42, 
+0x42, 0xD6, 0x10, 0x6B, 0xC5, 0x22, 0xC7, 0x51, 
+0xBF, 0x55, 0xDD, 0x7C, 0x89, 0xEE, 0x16, 0x5C, 
+0xFE, 0x72, 0x21, 0x0A, 0x8D, 0x19, 0xC5, 0x20, 
+0x9E, 0xDB, 0x8E, 0xAC, 0x55, 0x3B, 0x58, 0x19, 
+0x28, 0x33, 0xAD, 0x3A, 0xAF, 0xA5, 0x94, 0x18, 
+0xEA, 0xDC, 0x00, 0xFB, 0xE5, 0x10, 0xC7, 0xCD, 
+0x81, 0x47, 0x19, 0x15, 0x51, 0xFA, 0x09, 
+
+0x82, 0x8C, 0xCC, 0xF5, 0xE7, 0x5F, 0x9F, 0xC1, 
+0x64, 0x6B, 0xA1, 0x2C, 0x7E, 0x5D, 0x55, 0xAF, 
+0x9A, 0xD9, 0x6B, 0xAE, 0x24, 0x5B, 0x7A, 0x0D, 
+0x9E, 0x5A, 0xDF, 0x32, 0xA4, 0x45, 0xE9, 0xB4, 
+0xF1, 0x0E, 0x30, 0xD3, 0x33, 0x42, 0xE1, 0xA4, 
+0x25, 0xCE, 0x48, 0xCB, 0x75, 0x52, 0xF5, 0x1F, 
+0x02, 0x2E, 0xE9, 0x8C, 0xF0, 0xC2, 0x6A, 0xF4, 
+0xBB, 0xD6, 0x93, 0x82, 0xD6, 0xFE, 0xAF, 0x54, 
+0x14, 0x98, 0x2F, 0xBF, 0xAB, 0xD1, 0x99, 0xB2, 
+0xE5, 0xFE, 0x27, 0x39, 0x2F, 0xEE, 0x7F, 0x6A, 
+0xE0, 0x03, 0x5C, 0xF3, 0x48, 0x2C, 0xF4, 0xDE, 
+0x30, 0x4E, 0xE5, 0x75, 0x19, 0x12, 0xE9, 0xC6, 
+0x70, 0xEC, 0x03, 0x5B, 0xE2, 0x27, 0x2C, 0xA6, 
+0x59, 0x4C, 0x17, 0xEA, 0xB4, 0x0F, 0xD4, 0x53, 
+0xE5, 0x49, 0xBA, 0x16, 0x52, 0x3F, 0x92, 0xAE, 
+0xCC, 0x35, 0x93, 0x24, 0x0F, 0x84, 0xF6, 0xF5, 
+
+0xB2, 0x1A, 0xDA, 0xA4, 0x04, 0x10, 0xA7, 0x68, 
+0xE3, 0x47, 0x53, 0x2C, 0x0B, 0xA0, 0x6E, 0xE5, 
+0x11, 0xF3, 0x95, 0x65, 0x43, 0xC4, 0x23, 0x1C, 
+0xD7, 0x90, 0x8F, 0xD2, 0x09, 0xC0, 0x46, 0x4C, 
+0x07, 0x96, 0x3D, 0x7B, 0xB9, 0x72, 0x86, 0x98, 
+0x4C, 0x93, 0x17, 0xBF, 0x55, 0x05, 0x1D, 0x93, 
+0x6A, 0xB6, 0x96, 0x55, 0x0C, 0x2D, 0x47, 0x78, 
+0xBA, 0xC6, 0xE9, 0xE5, 0xB0, 0x92, 0x90, 0xE1, 
+0x58, 0x26, 0xA2, 0x76, 0x0D, 0xB7, 0x8D, 0xAF, 
+0x4B, 0xED, 0x8D, 0xF6, 0xEC, 0x07, 0x6D, 0x51, 
+0xB7, 0x82, 0x47, 0x72, 0x2C, 0xE5, 0x81, 0xF4, 
+0xD4, 0x1D, 0xF6, 0x09, 0x5A, 0x30, 0x56, 0x83, 
+0x84, 0x64, 0xFA, 0x76, 0x88, 0x13, 0x47, 0x15, 
+0x4C, 0x0F, 0x4C, 0x5B, 0x3D, 0x85, 0x4A, 0x52, 
+0x83, 0x38, 0x44, 0xA2, 0x6B, 0xCA, 0x9B, 0xD7, 
+0xB9, 0x76, 0x07, 0xE6, 0x8C, 0xF6, 0x78, 0x02, 
+0xA5, 
+
+0x1D, 0x42, 0xF0, 0x24, 0x6A, 0x89, 0x70, 0xB4, 
+0x77, 0xAA, 0x84, 0x37, 0x96, 0xCA, 0xA5, 0x83, 
+0xF1, 0x37, 0x0C, 0xE2, 0x08, 0x83, 0xCD, 0x1F, 
+0x02, 0xC6, 0x05, 0xA5, 0x96, 0xB7, 0x02, 0xCF, 
+0x1C, 0x2A, 0x43, 0xD2, 0x3F, 0x5F, 0xE4, 0x3E, 
+0x11, 0x99, 0xA2, 0xB3, 0xBF, 0x16, 0x53, 0x52, 
+0x6A, 0xB0, 0x33, 0xD7, 0xC5, 0xB5, 0x21, 0xB4, 
+0x58, 0x9C, 0x74, 0xBE, 0xAC, 0xA4, 0xAA, 0xEA, 
+0xE1, 0xDA, 0xD5, 0x52, 0xCC, 0xBB, 0x32, 0x05, 
+0xAB, 0xF9, 0x7F, 0xFF, 0xC2, 0xD5, 0x8B, 0x2D, 
+0x42, 0xA6, 0x69, 0x8D, 0x62, 0x21, 0x3A, 0xEF, 
+0xE8, 0x79, 0xC5, 0xC0, 0x68, 0x4E, 0xD7, 0xF6, 
+0x61, 0xDF, 0x25, 0xBE, 0xD3, 0x1F, 0x4F, 0xC4, 
+0x7D, 0x9E, 0x2C, 0x51, 0xC7, 0xF3, 0x56, 0x13, 
+0x2D, 0xFA, 0x10, 0x4B, 0xFD, 0x69, 0x8E, 0x66, 
+0x44, 0x6B, 0xC0, 0x91, 0xD9, 0x06, 0x41, 0x57, 
+0xBD, 0x02, 
+
+0x2D, 0x90, 0xAD, 0x64, 0x6B, 0xBA, 0xFC, 0xC4, 
+0x25, 0xD1, 0x32, 0x39, 0x84, 0x2D, 0xBD, 0x13, 
+0xBB, 0xC5, 0xE9, 0x60, 0x8A, 0x22, 0xFC, 0xCD, 
+0x28, 0x24, 0xE5, 0xFE, 0xFE, 0x4E, 0xAD, 0xB3, 
+0x12, 0x5C, 0xE6, 0x1F, 0xAA, 0xF2, 0x71, 0x70, 
+0x8D, 0x03, 0x09, 0x50, 0x92, 0x71, 0x85, 0x8A, 
+0x2F, 0x25, 0x93, 0x0B, 0x2C, 0xA8, 0x95, 0xC7, 
+0xBD, 0x17, 0x92, 0xE7, 0x32, 0x21, 0xED, 0x04, 
+0x77, 0x68, 0xFA, 0x80, 0xCF, 0xEC, 0x9D, 0x9D, 
+0xF9, 0x8C, 0x29, 0xBA, 0x35, 0x22, 0xB8, 0x87, 
+0x29, 0xF8, 0x9D, 0x45, 0xE2, 0x48, 0x46, 0x55, 
+0x66, 0x22, 0x97, 0x46, 0x96, 0x5A, 0xBB, 0x05, 
+0x1A, 0xAC, 0x8D, 0x6F, 0xD8, 0x6D, 0x74, 0xA5, 
+0x96, 0x16, 0x79, 0xC8, 0xA5, 0xF7, 0x46, 0xB0, 
+0xBF, 0x99, 0xA8, 0xA6, 0xAE, 0x22, 0x7A, 0x90, 
+0x23, 0x74, 0xA7, 0x50, 0x1B, 0x91, 0x65, 0x5B, 
+0x5D, 0x1F, 0x42, 
+
+0xB9, 0xD1, 0xCD, 0x6E, 0x3F, 0xF5, 0xB6, 0x53, 
+0x9B, 0x01, 0xFA, 0x50, 0x6D, 0x2E, 0xB5, 0x2D, 
+0x8E, 0xEF, 0x41, 0xE3, 0x57, 0xB7, 0xDD, 0x0E, 
+0x1A, 0x4B, 0xB2, 0xDD, 0xF3, 0x46, 0xD9, 0x3E, 
+0xDB, 0x59, 0x37, 0x2A, 0x73, 0x2F, 0x14, 0xC7, 
+0x85, 0xE1, 0x11, 0xDC, 0x0D, 0x1A, 0x66, 0x25, 
+0x62, 0x86, 0xCB, 0x8D, 0x6B, 0x25, 0x30, 0xFA, 
+0x2C, 0x8A, 0xE6, 0xE1, 0xC7, 0x75, 0x60, 0xB7, 
+0x7B, 0xCB, 0x14, 0x69, 0x0A, 0xC3, 0xBC, 0x79, 
+0xBE, 0x5E, 0x26, 0xD7, 0x87, 0x34, 0x90, 0x00, 
+0xB0, 0xB2, 0x0E, 0xBD, 0x79, 0x15, 0xDB, 0xB6, 
+0xCE, 0xDD, 0x9B, 0x91, 0x1D, 0x4F, 0x1D, 0xB5, 
+0xA5, 0xB0, 0x2E, 0x86, 0xE9, 0x85, 0x42, 0x61, 
+0x34, 0xFB, 0x74, 0xF5, 0x1E, 0x73, 0xC4, 0x30, 
+0xA5, 0xCD, 0x53, 0x49, 0xE0, 0x2E, 0x24, 0x92, 
+0xD6, 0xFC, 0xB0, 0x3C, 0x75, 0x9E, 0xAA, 0xF8, 
+0xE2, 0xE1, 0x7C, 0x53, 
+
+0x0C, 0x5A, 0xFE, 0x32, 0xC7, 0x47, 0xA7, 0x5C, 
+0xBB, 0x09, 0x00, 0xE5, 0x00, 0x2C, 0x5D, 0x06, 
+0x39, 0x1C, 0xE5, 0x5B, 0x24, 0xC4, 0xC3, 0xE5, 
+0x97, 0x8E, 0xF0, 0xE0, 0xB0, 0xF2, 0xE7, 0x67, 
+
+0x91, 0x8D, 0xE8, 0x6F, 0x54, 0x5B, 0xDC, 0x6D, 
+0xE1, 0x1D, 0x84, 0x78, 0x8D, 0x9A, 0x2B, 0x6D, 
+0x1D, 0xFB, 0xA4, 0x5E, 0xA6, 0x2D, 0x62, 0x78, 
+0xC3, 0xB8, 0x6E, 0xBA, 0x4C, 0x66, 0xA2, 0x11, 
+0xEC, 
+
+0x68, 0xA4, 0xFC, 0x39, 0x4D, 0x10, 0x23, 0x53, 
+0x98, 0x4F, 0x6B, 0x31, 0xBD, 0x4A, 0x9A, 0x11, 
+0xFF, 0x85, 0xBE, 0x62, 0xEC, 0xC9, 0x04, 0xCF, 
+0x8C, 0x2E, 0x52, 0x6C, 0xAC, 0xCF, 0xFF, 0x71, 
+0xDD, 0x1D, 
+
+0x45, 0x07, 0xFA, 0xD0, 0xBF, 0xA7, 0x35, 0x08, 
+0x7B, 0xE2, 0xEE, 0x32, 0xE9, 0xF2, 0xF2, 0x38, 
+0xD0, 0x31, 0xE2, 0xB5, 0x48, 0x93, 0x90, 0xB5, 
+0x6E, 0xF8, 0x5C, 0x05, 0x6A, 0xF0, 0xCD, 0x90, 
+0xB7, 0xDD, 0xE8, 
+
+0x4B, 0x79, 0x92, 0x24, 0x49, 0x13, 0x48, 0x22, 
+0x24, 0x3B, 0x8E, 0x67, 0x90, 0x1F, 0x13, 0x7F, 
+0x46, 0xB3, 0x14, 0x39, 0x14, 0x00, 0xCD, 0xBD, 
+0x34, 0x36, 0xBC, 0x06, 0xC6, 0xAD, 0x6B, 0x11, 
+0xBE, 0x42, 0x28, 0xFC, 
+
+0xD1, 0x0A, 0x91, 0xF7, 0xA7, 0x28, 0xBB, 0x46, 
+0xB9, 0x37, 0x8D, 0x62, 0x72, 0x56, 0x1E, 0xF8, 
+0xF0, 0x9C, 0xFE, 0xFC, 0x89, 0x36, 0xB4, 0x1A, 
+0x8F, 0x9E, 0x82, 0x64, 0x82, 0x30, 0x01, 0x37, 
+0xF2, 0x33, 0xD0, 0x05, 0x44, 
+
+0x5B, 0x04, 0x51, 0xAF, 0x7D, 0x75, 0xA2, 0x5C, 
+0x67, 0xB9, 0x34, 0x3A, 0x16, 0xC8, 0x39, 0xE8, 
+0x90, 0xC5, 0x5D, 0xDD, 0xD1, 0xE6, 0x13, 0x05, 
+0x9A, 0x83, 0xEE, 0xE5, 0xF4, 0x4E, 0x9B, 0xE8, 
+0x66, 0x0E, 0xCF, 0x65, 0xBD, 0x60, 
+
+0x92, 0xB1, 0x68, 0x15, 0x30, 0xEF, 0xA0, 0x9F, 
+0xF1, 0x46, 0x03, 0x84, 0x03, 0x81, 0x42, 0xF4, 
+0x7E, 0x83, 0xAE, 0x78, 0xD4, 0xAA, 0xA5, 0x71, 
+0xD6, 0x86, 0x40, 0xAA, 0x34, 0xB3, 0xEB, 0xD5, 
+0x7B, 0x9E, 0x89, 0x68, 0xFE, 0x9F, 0x79, 
+
+0x71, 0xA3, 0xB8, 0xCA, 0xA0, 0x3C, 0xCA, 0xB6, 
+0xD8, 0xB4, 0xE9, 0x36, 0x8A, 0x79, 0x43, 0x43, 
+0x00, 0x99, 0x61, 0xAA, 0x1B, 0x75, 0x2E, 0x82, 
+0xA9, 0xB3, 0x77, 0x5B, 0x3D, 0xFE, 0xE4, 0x3C, 
+0xE7, 0x96, 0x66, 0x4B, 0xB6, 0x5D, 0xD3, 0x28, 
+
+0x0B, 0xC3, 0xE8, 0x89, 0x1E, 0x3F, 0xF9, 0xB6, 
+0x8C, 0x32, 0x47, 0x5F, 0x69, 0x8F, 0xD6, 0xC9, 
+0x9C, 0x61, 0x1A, 0x11, 0xD2, 0xBA, 0xB1, 0xCC, 
+0x7B, 0x26, 0x9D, 0x93, 0x65, 0x4A, 0x03, 0xD8, 
+0x05, 0x1D, 0xC0, 0x4F, 0xB6, 0x6F, 0xD9, 0x90, 
+0x19, 
+
+0xB3, 0x09, 0xC9, 0xB0, 0x61, 0xFB, 0x53, 0x45, 
+0x67, 0x7C, 0xE6, 0x95, 0xCA, 0xDF, 0x45, 0x09, 
+0x2D, 0x8D, 0x74, 0x8B, 0x98, 0x53, 0xDF, 0x3D, 
+0x29, 0x32, 0xCB, 0x81, 0xE3, 0xE4, 0xD0, 0x4A, 
+0x99, 0xE4, 0x46, 0x57, 0x3F, 0x25, 0x10, 0xCC, 
+0xB1, 0x9C, 
+
+0x8C, 0xC1, 0xBD, 0x91, 0x05, 0x2C, 0x39, 0x09, 
+0x99, 0x97, 0xCC, 0x08, 0xC6, 0x4E, 0x17, 0x60, 
+0xE1, 0xAF, 0xA6, 0x48, 0xD4, 0xD8, 0xED, 0xDA, 
+0x2F, 0xF8, 0x07, 0xF7, 0x41, 0xA4, 0x7C, 0x7A, 
+0xCB, 0x06, 0xCC, 0x0D, 0x51, 0x46, 0xC3, 0x20, 
+0x21, 0x68, 0xC7, 
+
+0x8A, 0x41, 0x58, 0xEF, 0x13, 0xEC, 0x7A, 0x9A, 
+0x28, 0xAD, 0x35, 0x2E, 0xDB, 0xBE, 0xDD, 0x15, 
+0xB6, 0x3E, 0x79, 0x70, 0x6F, 0x7B, 0xB7, 0xAD, 
+0xDF, 0x76, 0x41, 0x96, 0xEF, 0x13, 0x9F, 0x8A, 
+0xC5, 0x7F, 0x5F, 0xEC, 0x72, 0xEC, 0xAE, 0xD7, 
+0x8B, 0x26, 0xE4, 0xD4, 
+
+0x7F, 0x06, 0x7C, 0x1B, 0x85, 0x0C, 0x95, 0x98, 
+0x35, 0xDC, 0xA5, 0xED, 0xF8, 0x3D, 0x6F, 0xBA, 
+0x33, 0xDA, 0x5F, 0x66, 0x51, 0x82, 0x33, 0x15, 
+0x9E, 0xE9, 0xA3, 0xA6, 0xF1, 0x17, 0x3F, 0xBB, 
+0xD6, 0x94, 0x78, 0x7E, 0xD2, 0xC1, 0x9A, 0x5F, 
+0xAB, 0x39, 0x2C, 0xB6, 0xA0, 
+
+0x73, 0x0B, 0x75, 0x9B, 0x6F, 0xD8, 0xF1, 0xA2, 
+0x62, 0xAC, 0x7C, 0x70, 0x83, 0x74, 0x8A, 0xB9, 
+0xAF, 0x17, 0xDC, 0x3C, 0xC7, 0x7E, 0xB2, 0x66, 
+0xF3, 0x1D, 0x69, 0x48, 0x71, 0xEF, 0xD0, 0x09, 
+0x4B, 0xB8, 0x17, 0xA7, 0x48, 0x4A, 0x80, 0xD0, 
+0x57, 0xAB, 0xAE, 0xB0, 0x4F, 0x00, 
+
+0xF7, 0x37, 0x87, 0x9D, 0x3B, 0x2D, 0xAA, 0x0B, 
+0x28, 0x0B, 0x8A, 0xC3, 0x00, 0xB0, 0xF1, 0xF3, 
+0x01, 0x89, 0xB3, 0x55, 0x9F, 0x02, 0x11, 0x91, 
+0xE8, 0x49, 0x48, 0x2E, 0x78, 0x2D, 0x16, 0x80, 
+0x92, 0xB8, 0x7E, 0x21, 0x54, 0xD2, 0x93, 0xD9, 
+0xBF, 0xDD, 0xFE, 0xC6, 0x01, 0xB1, 0xA4, 
+
+0xA0, 0x14, 0x08, 0x32, 0xE1, 0x12, 0xCF, 0xCE, 
+0x05, 0xCF, 0x02, 0x71, 0xDB, 0x92, 0x2A, 0x7D, 
+0x6A, 0x79, 0xAC, 0x5F, 0x8A, 0x58, 0x65, 0x28, 
+0xB3, 0x4C, 0x44, 0xAF, 0x3E, 0x39, 0xD7, 0x1C, 
+0x5A, 0xE1, 0x74, 0xE2, 0x22, 0xC5, 0x19, 0x47, 
+0x1B, 0x94, 0x9B, 0xBA, 0x14, 0x31, 0xFD, 0xA9, 
+
+0xDA, 0x50, 0x96, 0x40, 0x5A, 0xB4, 0x58, 0x9A, 
+0x70, 0x85, 0xBF, 0x62, 0xA8, 0x59, 0xF3, 0x89, 
+0x72, 0xED, 0xCC, 0x20, 0x4E, 0x4F, 0xA7, 0xB9, 
+0x71, 0xF7, 0xC4, 0xED, 0x5C, 0x56, 0xD1, 0xD8, 
+0xC6, 0xDA, 0x4B, 0x6F, 0x7C, 0x66, 0xDA, 0x3B, 
+0x35, 0x4A, 0x6A, 0x0C, 0x8D, 0x00, 0xDA, 0x5F, 
+0xED, 
+
+0x93, 0x38, 0xD0, 0xFA, 0x42, 0x1B, 0x69, 0x05, 
+0x5C, 0x3D, 0xA4, 0x34, 0xE1, 0x13, 0x5D, 0xCA, 
+0xED, 0x41, 0xEE, 0xDB, 0xC7, 0x03, 0x4F, 0xF2, 
+0x38, 0x93, 0xEE, 0xE8, 0x12, 0xAA, 0x5A, 0x87, 
+0x39, 0xBB, 0xD6, 0xF9, 0x11, 0x2C, 0x79, 0xD3, 
+0x6A, 0xE3, 0x12, 0x89, 0x10, 0x39, 0x40, 0x1C, 
+0xF6, 0x33, 
+
+0x3F, 0x52, 0xB4, 0xAB, 0x28, 0x26, 0x13, 0x5E, 
+0x35, 0xC9, 0x64, 0x52, 0xE0, 0x62, 0x27, 0x2D, 
+0x9E, 0xA6, 0x4C, 0xF8, 0x19, 0xBC, 0x21, 0xC4, 
+0x82, 0xE8, 0x5B, 0x7D, 0x78, 0x3F, 0x50, 0x08, 
+0xCE, 0x25, 0x90, 0x62, 0x08, 0x6C, 0x34, 0x50, 
+0x31, 0x7F, 0x29, 0x69, 0x33, 0xDC, 0x65, 0xE3, 
+0xEB, 0xD0, 0x5B, 
+
+0x73, 0xBC, 0xCA, 0x86, 0x25, 0x42, 0xB5, 0x63, 
+0x43, 0xEB, 0x07, 0xCB, 0x39, 0x50, 0x92, 0x1B, 
+0xE2, 0x7A, 0x8F, 0x7A, 0xCD, 0x7C, 0x4D, 0xD4, 
+0x6C, 0xEB, 0x6A, 0xF7, 0x18, 0x12, 0x59, 0xEF, 
+0xFF, 0xE2, 0x71, 0x37, 0x5E, 0x4E, 0x27, 0x9F, 
+0x74, 0x27, 0x7F, 0x7B, 0xD8, 0xB9, 0xFD, 0x0C, 
+0x82, 0xC8, 0x10, 0x6C, 
+
+0x25, 0x8D, 0x85, 0x7F, 0x63, 0xE3, 0xAE, 0xA6, 
+0x58, 0x13, 0x9E, 0x11, 0x8F, 0x9B, 0x67, 0x26, 
+0xDE, 0xAB, 0x31, 0xBA, 0x79, 0xC8, 0x6C, 0xEB, 
+0x2C, 0xA4, 0xAB, 0xAD, 0x1A, 0x6B, 0x81, 0x22, 
+0x01, 0x08, 0xD2, 0xA2, 0xB4, 0x86, 0xBD, 0xC4, 
+0xC5, 0xA6, 0xBC, 0x07, 0xAB, 0x97, 0x97, 0xA7, 
+0x7C, 0x7D, 0xD4, 0x2A, 0xB8, 
+
+0x41, 0xF7, 0xA4, 0x16, 0x81, 0x0F, 0xCE, 0x0F, 
+0xB5, 0x7F, 0x50, 0xD3, 0xE8, 0x79, 0x32, 0x45, 
+0xE0, 0xF7, 0xD5, 0x9B, 0x56, 0x5A, 0x0B, 0x03, 
+0x3B, 0x70, 0xB1, 0x23, 0x58, 0x31, 0xC1, 0xC9, 
+0x80, 0x94, 0x73, 0x5C, 0x4E, 0x7E, 0x4A, 0xF0, 
+0xFC, 0x5E, 0xBE, 0x4A, 0xCF, 0xBE, 0x4E, 0x18, 
+0x1C, 0x7B, 0x4B, 0x65, 0x81, 0x18, 
+
+0x5B, 0xE7, 0x4F, 0x8E, 0x42, 0x99, 0x49, 0xF4, 
+0x65, 0x2A, 0x56, 0xCF, 0xD5, 0x98, 0xB1, 0x75, 
+0xF7, 0x5B, 0x77, 0x8E, 0xD3, 0x40, 0x1E, 0x0D, 
+0x08, 0xA2, 0x16, 0xD0, 0xF0, 0x96, 0xE8, 0x7F, 
+0xEC, 0xD6, 0xC1, 0xC9, 0xFD, 0x02, 0x98, 0x2B, 
+0x78, 0x05, 0xB8, 0x98, 0x40, 0xBC, 0x76, 0x3E, 
+0xE3, 0x95, 0xB8, 0x1A, 0xF3, 0xFC, 0x00, 
+
+0x27, 0xD3, 0x68, 0xD2, 0xE1, 0x25, 0xB8, 0x73, 
+0x42, 0xA2, 0x43, 0xBE, 0x87, 0x93, 0x07, 0x3F, 
+0x2A, 0x8D, 0x0E, 0x72, 0xD9, 0x53, 0xC1, 0xC8, 
+0x05, 0x5E, 0x19, 0xC2, 0xFD, 0xD9, 0x7A, 0x78, 
+0x70, 0xD1, 0xF8, 0xED, 0x67, 0x1A, 0xD3, 0x92, 
+0xE4, 0x31, 0xAE, 0xCD, 0x9F, 0x21, 0x99, 0x74, 
+0xA8, 0x8F, 0x54, 0xC4, 0xD7, 0xC5, 0x7F, 0xF0, 
+
+0xB4, 0x37, 0x46, 0x55, 0x83, 0x74, 0x98, 0xA3, 
+0x69, 0xCA, 0x09, 0xAE, 0xDC, 0xB8, 0x58, 0xA3, 
+0xEF, 0x7B, 0x89, 0x46, 0xBA, 0x7C, 0xB4, 0x91, 
+0xDC, 0x1E, 0x4C, 0x20, 0xAE, 0x7C, 0x1C, 0xDC, 
+0x50, 0x09, 0x64, 0x10, 0x0F, 0xC1, 0xC8, 0x8E, 
+0xC4, 0xCF, 0x2B, 0x6E, 0x2B, 0x85, 0xDE, 0x6D, 
+0x34, 0x95, 0xBA, 0x24, 0x23, 0x67, 0xEC, 0x54, 
+0x13, 
+
+0x73, 0x98, 0x75, 0x73, 0xF6, 0x7A, 0xD3, 0x0B, 
+0x97, 0x62, 0x41, 0x4D, 0x95, 0x3A, 0xA8, 0xCF, 
+0x79, 0x57, 0x22, 0xEA, 0xB1, 0x23, 0xD7, 0x5D, 
+0xE2, 0x2C, 0x24, 0x75, 0x10, 0xA1, 0x6C, 0xA4, 
+0x89, 0x33, 0x25, 0x69, 0x6E, 0x35, 0xDC, 0xED, 
+0x07, 0x53, 0xCF, 0xDF, 0x6A, 0xE0, 0xFA, 0xFE, 
+0x7A, 0x69, 0xEC, 0x48, 0x0E, 0x20, 0xC2, 0x7C, 
+0x4D, 0x3C, 
+
+0x8F, 0x68, 0x2B, 0x2C, 0xB5, 0x3A, 0x43, 0x3E, 
+0x7D, 0x7F, 0xDC, 0xF9, 0xD6, 0x0C, 0xBB, 0x50, 
+0xD3, 0x8E, 0xE8, 0x91, 0x71, 0x0E, 0xA2, 0x59, 
+0x58, 0x72, 0x05, 0x2C, 0x7E, 0x2C, 0x94, 0xD3, 
+0x89, 0x83, 0xF6, 0x25, 0xC3, 0x2A, 0x96, 0xF9, 
+0xFC, 0xF5, 0xB9, 0xE7, 0xA7, 0xF9, 0xFA, 0x26, 
+0x8A, 0xC1, 0x84, 0x23, 0x90, 0xA5, 0x27, 0xD0, 
+0xAC, 0x3B, 0xEB, 
+
+0x56, 0x3A, 0x79, 0x7E, 0x3D, 0x9E, 0x1A, 0xE3, 
+0x5F, 0x6F, 0x35, 0x6C, 0x24, 0x27, 0x09, 0xDD, 
+0x33, 0x4E, 0x8C, 0xF9, 0x22, 0xDC, 0xAD, 0x50, 
+0xAD, 0xF7, 0x5A, 0xFB, 0x8F, 0x5F, 0x5B, 0x4F, 
+0x53, 0xC3, 0xDB, 0xC6, 0xA5, 0x4C, 0x9F, 0x12, 
+0xC5, 0x14, 0xCE, 0x78, 0xAF, 0x93, 0xC2, 0x35, 
+0x67, 0xB3, 0xFC, 0xDD, 0xE6, 0xEA, 0x8F, 0x8F, 
+0xB4, 0x81, 0x82, 0xBB, 
+
+0xE0, 0x35, 0x90, 0x36, 0xA4, 0xAA, 0x04, 0x9B, 
+0xB4, 0xFC, 0x54, 0x50, 0xBD, 0x46, 0xEB, 0x58, 
+0xC5, 0x24, 0x31, 0xA5, 0xDB, 0x6C, 0x56, 0xC9, 
+0xD0, 0x22, 0x68, 0xEC, 0x1B, 0x7F, 0xEF, 0x60, 
+0xD6, 0x0D, 0x2A, 0x12, 0x58, 0xDE, 0xCF, 0x88, 
+0x31, 0xF5, 0x16, 0xBD, 0x4C, 0x79, 0x0A, 0x45, 
+0xAA, 0xAC, 0x58, 0xFE, 0xBB, 0xA2, 0xFF, 0xFB, 
+0x29, 0x16, 0x92, 0x81, 0x65, 
+
+0x58, 0xAC, 0x9E, 0x82, 0x63, 0x3D, 0x13, 0xD8, 
+0x0F, 0x9D, 0x61, 0x1F, 0xB6, 0x63, 0xAB, 0x16, 
+0x9D, 0x87, 0x7B, 0xC9, 0x01, 0x6B, 0x7B, 0x68, 
+0xA1, 0x69, 0xCF, 0x76, 0xE9, 0x2A, 0x8A, 0x0B, 
+0x33, 0xDC, 0xF4, 0x34, 0xC4, 0xA0, 0xF7, 0x4B, 
+0x44, 0x68, 0x16, 0x8C, 0x80, 0x8D, 0x65, 0x50, 
+0x93, 0x28, 0xA6, 0xC1, 0x7E, 0xC2, 0xED, 0x94, 
+0x10, 0x8B, 0x9C, 0x4B, 0x07, 0xA7, 
+
+0x6C, 0x10, 0x7C, 0xD0, 0x2E, 0x13, 0x98, 0xBC, 
+0xBC, 0x59, 0x2E, 0xAE, 0xEF, 0xCF, 0x1F, 0xCD, 
+0x07, 0x8D, 0x18, 0xB7, 0x3C, 0x5D, 0x7B, 0x18, 
+0x57, 0x1C, 0xE5, 0x89, 0x3D, 0xEA, 0x41, 0x46, 
+0xBF, 0xF4, 0x90, 0xD1, 0xD8, 0x8A, 0xF7, 0x55, 
+0x7E, 0xD1, 0xA5, 0xFF, 0x13, 0xFC, 0x45, 0xF8, 
+0xA1, 0x26, 0xE6, 0x74, 0x12, 0x4D, 0xBE, 0x1B, 
+0xF4, 0x68, 0x15, 0x99, 0xC9, 0xFD, 0xF7, 
+
+0xF3, 0xAC, 0x52, 0xAB, 0xCA, 0x24, 0xA6, 0x91, 
+0x93, 0xA4, 0x45, 0x07, 0x2B, 0x4B, 0x5F, 0x32, 
+0x50, 0xA5, 0x21, 0x2F, 0xA1, 0x05, 0xE3, 0xED, 
+0x4B, 0x8C, 0xD4, 0x47, 0x5B, 0xED, 0x4E, 0xC5, 
+0xA2, 0x0B, 0x90, 0x3B, 0x19, 0x65, 0x42, 0x9E, 
+0x3E, 0x51, 0x2F, 0xAD, 0xAA, 0x4D, 0x3B, 0x14, 
+0xE5, 0x40, 0x26, 0xA6, 0x12, 0xE2, 0xD2, 0x14, 
+0x37, 0xA8, 0x20, 0xED, 0x1E, 0x8E, 0xD8, 0xA3, 
+
+0x6C, 0xEE, 0x5F, 0x5D, 0x1A, 0xAD, 0xF4, 0xAE, 
+0x4E, 0x04, 0xF0, 0x9F, 0xA6, 0x4C, 0xC7, 0x0F, 
+0x75, 0xAF, 0xB3, 0x4A, 0x23, 0xD0, 0xF2, 0x37, 
+0xBE, 0x4D, 0x83, 0x40, 0x91, 0xCE, 0xF4, 0xC9, 
+0xB2, 0xAE, 0x3F, 0x5E, 0xFF, 0x91, 0x0E, 0x4E, 
+0x92, 0x9D, 0x36, 0xBF, 0x39, 0x3F, 0x25, 0xA7, 
+0x6D, 0x70, 0x80, 0x4C, 0xF7, 0x59, 0x38, 0xDE, 
+0xD2, 0x4B, 0xBC, 0x94, 0x3D, 0x99, 0x62, 0x59, 
+0x1A, 
+
+0x85, 0x66, 0x92, 0x1A, 0xCE, 0x0E, 0xA1, 0x5E, 
+0xFE, 0x8F, 0x4F, 0xC3, 0xD2, 0x12, 0xAF, 0x40, 
+0xE9, 0x35, 0x30, 0xB6, 0x85, 0x0A, 0x7B, 0xDC, 
+0x57, 0x86, 0x49, 0x9B, 0x2A, 0x6A, 0x7D, 0xBC, 
+0x34, 0x23, 0x78, 0xD5, 0x25, 0xC7, 0xBA, 0xBD, 
+0x3D, 0x7B, 0x52, 0xA2, 0x73, 0xEB, 0x20, 0xA5, 
+0xC8, 0x4C, 0x35, 0x2A, 0xDD, 0xAE, 0xCC, 0x95, 
+0x0A, 0x3B, 0x7D, 0x54, 0xBA, 0x0A, 0x3C, 0x12, 
+0x0F, 0x09, 
+
+0xA2, 0x51, 0xCC, 0x40, 0x99, 0x5B, 0xC2, 0x0B, 
+0x6B, 0x11, 0x42, 0xBD, 0x6A, 0x56, 0x28, 0x4A, 
+0xFA, 0xB5, 0xD2, 0xC6, 0x49, 0xAC, 0xF1, 0x7B, 
+0xEF, 0x4C, 0x0B, 0x37, 0xFB, 0x98, 0x8F, 0xFF, 
+0x02, 0xF2, 0x54, 0x08, 0x39, 0xEE, 0xE5, 0x65, 
+0xC3, 0x41, 0x91, 0x11, 0x4A, 0x4D, 0xA7, 0x37, 
+0x50, 0x26, 0xEA, 0x02, 0x26, 0x5F, 0x1D, 0xCC, 
+0xDE, 0x18, 0xB3, 0xDC, 0xAC, 0x54, 0x71, 0xC2, 
+0xA6, 0x7D, 0xA8, 
+
+0x42, 0x77, 0x83, 0xAD, 0xC3, 0x2A, 0x55, 0x49, 
+0xA3, 0xC3, 0xA5, 0xE5, 0x8A, 0x18, 0xD2, 0x3E, 
+0x26, 0x30, 0xB9, 0xEA, 0x6F, 0x66, 0x33, 0x94, 
+0x61, 0xAB, 0x01, 0x4C, 0x63, 0x9A, 0x9B, 0x79, 
+0x98, 0xD8, 0x47, 0xF0, 0x43, 0x38, 0xDB, 0x9C, 
+0x6F, 0x4F, 0x7E, 0x85, 0xAE, 0x84, 0x92, 0xE2, 
+0xA8, 0x21, 0x0B, 0x92, 0xB1, 0x95, 0xE1, 0xE7, 
+0x1A, 0x60, 0x3D, 0xA6, 0x84, 0x7E, 0xE0, 0x0E, 
+0xDF, 0x14, 0xA9, 0xEB, 
+
+0x6D, 0xC2, 0xAB, 0x28, 0xC6, 0x5F, 0xF4, 0x7B, 
+0x00, 0x1B, 0x81, 0x46, 0xB4, 0x70, 0x15, 0x00, 
+0x7E, 0xFE, 0x3A, 0xEA, 0x36, 0xA6, 0xC6, 0xFD, 
+0xC1, 0xE1, 0xBD, 0x50, 0x65, 0xDD, 0xFC, 0x69, 
+0x25, 0xB6, 0xDE, 0x37, 0x03, 0x77, 0x21, 0x0A, 
+0x45, 0x0B, 0x8D, 0x09, 0xEE, 0xCD, 0xD1, 0x7D, 
+0x51, 0x6E, 0x91, 0x35, 0xAF, 0x25, 0x14, 0x36, 
+0x9E, 0xD0, 0x9A, 0xA3, 0x4A, 0x31, 0x05, 0xEE, 
+0x78, 0x11, 0x4E, 0xC7, 0xF0, 
+
+0x83, 0xCA, 0xDF, 0x1A, 0x7C, 0xF8, 0xDD, 0xB8, 
+0xBF, 0x59, 0xE7, 0xC7, 0x48, 0xFF, 0xFA, 0x02, 
+0x98, 0x4B, 0x1C, 0x1C, 0xD3, 0x6A, 0xEB, 0xEC, 
+0xBC, 0x2E, 0x0A, 0x6F, 0x3C, 0x0C, 0xF1, 0xC7, 
+0x9A, 0x74, 0xF7, 0xC9, 0x4B, 0xA4, 0xD6, 0x46, 
+0xC2, 0x15, 0x26, 0x02, 0xEB, 0xEB, 0xC7, 0x02, 
+0xC2, 0x22, 0x13, 0xC0, 0xAE, 0x7E, 0x69, 0xB6, 
+0xD0, 0xC9, 0xE4, 0x93, 0xEF, 0x8A, 0x76, 0x2E, 
+0xDC, 0x89, 0xEF, 0xE8, 0xEC, 0x3D, 
+
+0xF8, 0x64, 0xBA, 0xAD, 0x07, 0x60, 0x69, 0x43, 
+0x7C, 0x11, 0xC7, 0x46, 0x03, 0xD1, 0x6D, 0xFD, 
+0x64, 0x0B, 0xED, 0xD0, 0x71, 0x94, 0x63, 0x95, 
+0x6D, 0xB6, 0x5B, 0xF5, 0x11, 0x0C, 0xD5, 0xEC, 
+0x84, 0xF6, 0xA6, 0x41, 0xEE, 0x9F, 0x58, 0x5E, 
+0xC1, 0x4A, 0xDB, 0x13, 0xC6, 0x89, 0x32, 0xC1, 
+0xD4, 0x8E, 0x73, 0x2F, 0x75, 0x3D, 0x14, 0x95, 
+0x4B, 0x71, 0xB6, 0xB1, 0x44, 0x6C, 0xA4, 0xCC, 
+0xB7, 0x7F, 0xB7, 0x2B, 0x27, 0x36, 0x78, 
+
+0xB0, 0xFA, 0x3E, 0x3A, 0x20, 0x09, 0x84, 0x9F, 
+0xEB, 0x09, 0x1A, 0x41, 0x82, 0x08, 0x05, 0x76, 
+0x69, 0x84, 0xE1, 0x2C, 0xA2, 0xAF, 0xDA, 0xA6, 
+0xC3, 0xB9, 0x51, 0x9E, 0x8A, 0xCF, 0xE4, 0x9C, 
+0x83, 0xF8, 0x72, 0x00, 0x59, 0x87, 0x26, 0x99, 
+0x40, 0x8B, 0xD7, 0xC9, 0xAB, 0x1B, 0xC1, 0xAB, 
+0x48, 0xEF, 0x91, 0x9B, 0x11, 0xBA, 0x77, 0xBC, 
+0x2A, 0x77, 0x61, 0x80, 0x13, 0xC8, 0xEE, 0xB7, 
+0xBF, 0x26, 0xF6, 0x0F, 0xA5, 0x21, 0xC4, 0xA6, 
+
+0xE8, 0x46, 0xD0, 0x44, 0x1A, 0xA5, 0x84, 0x12, 
+0x6D, 0x15, 0x1A, 0xCF, 0xAE, 0x5A, 0x5B, 0xAE, 
+0x12, 0x44, 0x67, 0xE6, 0xCE, 0xD1, 0xCB, 0xC1, 
+0xEA, 0xF4, 0xFD, 0xB6, 0x20, 0x90, 0x4B, 0x30, 
+0x90, 0xDD, 0x83, 0xFA, 0xDE, 0x43, 0xC7, 0xB6, 
+0x9C, 0xF2, 0xF8, 0xB6, 0x55, 0xE6, 0x9B, 0x9D, 
+0x91, 0x42, 0x30, 0x8C, 0xF4, 0xC5, 0xA7, 0x08, 
+0xD8, 0x32, 0x54, 0xF9, 0x5B, 0xF0, 0xA3, 0x79, 
+0xA1, 0xB0, 0x04, 0x82, 0xAB, 0xEA, 0x3D, 0x86, 
+0x74, 
+
+0x20, 0xF4, 0x8D, 0x4E, 0x76, 0x2A, 0x21, 0xBA, 
+0x58, 0x55, 0xF8, 0x8B, 0xEB, 0x42, 0xAB, 0x3F, 
+0xB3, 0x7A, 0x1E, 0x34, 0xCD, 0xA5, 0xB6, 0x3D, 
+0x59, 0xF8, 0x0E, 0x1D, 0x39, 0x34, 0xDE, 0x9B, 
+0x43, 0xAE, 0xC4, 0xEC, 0x4F, 0x09, 0x0B, 0xEF, 
+0x11, 0xDD, 0x80, 0x99, 0x3E, 0x07, 0xD6, 0x83, 
+0xE7, 0xDB, 0x81, 0x3C, 0x48, 0x97, 0x0F, 0x69, 
+0x8F, 0xE4, 0x5B, 0xCB, 0x9A, 0xF9, 0xB1, 0xE7, 
+0x3E, 0x9F, 0x88, 0xD2, 0x1F, 0x55, 0xF3, 0x18, 
+0x48, 0x86, 
+
+0x15, 0xB1, 0x3D, 0xF3, 0x0D, 0x73, 0xD0, 0xCD, 
+0x9B, 0x5B, 0x93, 0x74, 0xD8, 0x13, 0xF5, 0xD6, 
+0xB8, 0xCE, 0x63, 0x02, 0xEB, 0xED, 0xD8, 0x07, 
+0x44, 0x8F, 0x74, 0x61, 0xD7, 0xF6, 0x61, 0x48, 
+0xF7, 0xCE, 0x04, 0x99, 0xD9, 0x14, 0xE4, 0xE1, 
+0xB9, 0xCA, 0x91, 0x93, 0xB5, 0x31, 0xF0, 0xBD, 
+0x08, 0x10, 0x2C, 0x5B, 0xF4, 0xA8, 0x68, 0x96, 
+0x48, 0xBA, 0x51, 0x27, 0xF6, 0x1E, 0x63, 0x51, 
+0xD2, 0xFB, 0xBC, 0x7D, 0x16, 0x3D, 0xB6, 0x98, 
+0xBC, 0x11, 0x7A, 
+
+0xD0, 0x6A, 0xCE, 0xBA, 0x60, 0x4C, 0x1F, 0x41, 
+0xD9, 0x1C, 0x24, 0x43, 0x24, 0xC1, 0xFD, 0x49, 
+0x22, 0x9A, 0xF9, 0xFF, 0xC6, 0x05, 0xDA, 0xF2, 
+0x21, 0xED, 0xC1, 0x17, 0xE2, 0xDD, 0x8D, 0xB8, 
+0x4E, 0x1F, 0x79, 0xA0, 0xA6, 0xD1, 0x5C, 0xC7, 
+0xBD, 0x09, 0x13, 0xC2, 0x8A, 0xA5, 0x1F, 0x1C, 
+0x6D, 0x10, 0xD9, 0xC2, 0xCF, 0xCA, 0x95, 0x86, 
+0x92, 0xE6, 0x4D, 0x8E, 0x5F, 0x8D, 0x32, 0xE6, 
+0x46, 0x27, 0x84, 0x5F, 0xF2, 0xE3, 0x7B, 0x66, 
+0x9A, 0xA5, 0x00, 0xF3, 
+
+0xBA, 0x65, 0xB9, 0x44, 0x15, 0x31, 0xCB, 0xB3, 
+0xED, 0x68, 0x2E, 0x06, 0x4E, 0x34, 0x64, 0x05, 
+0xD0, 0x7A, 0xCE, 0xF7, 0x4B, 0x62, 0x63, 0xB5, 
+0x09, 0x46, 0xBE, 0xAE, 0xA0, 0x83, 0x67, 0x24, 
+0xA9, 0xE1, 0x6A, 0x7E, 0xD0, 0x4D, 0xD3, 0x1D, 
+0x61, 0xAE, 0x4D, 0x69, 0xB9, 0xC1, 0xA2, 0x22, 
+0xB4, 0xB8, 0xCF, 0x29, 0x4B, 0x07, 0xB6, 0xC8, 
+0xFA, 0x33, 0xAB, 0x6B, 0x40, 0xCB, 0x75, 0x9B, 
+0xC8, 0xEC, 0x6E, 0xC0, 0xF7, 0x96, 0xC3, 0xEA, 
+0xA3, 0xFE, 0x15, 0xFB, 0xA3, 
+
+0xDE, 0x79, 0xDE, 0x4E, 0x26, 0x41, 0x65, 0x80, 
+0x7C, 0x9E, 0xBA, 0xAD, 0x0E, 0x37, 0xFD, 0xFB, 
+0xCC, 0x18, 0x5F, 0x89, 0xFD, 0x60, 0x26, 0x0C, 
+0xF6, 0x77, 0xA8, 0x25, 0x07, 0x25, 0x46, 0x43, 
+0xD5, 0xDC, 0x73, 0x21, 0x8B, 0xA1, 0x96, 0x01, 
+0xB0, 0xF5, 0xD1, 0x4D, 0x66, 0x64, 0xEC, 0x25, 
+0x41, 0xD5, 0x90, 0x48, 0x40, 0x3A, 0xE4, 0x30, 
+0x45, 0xC8, 0xE3, 0x24, 0x3F, 0x69, 0x99, 0x9D, 
+0x51, 0x34, 0xE0, 0x0C, 0xE7, 0x40, 0xD6, 0x17, 
+0xC2, 0x23, 0x19, 0xE4, 0x7F, 0x40, 
+
+0x27, 0xDA, 0x23, 0x0A, 0xCD, 0x5A, 0x51, 0xDE, 
+0x85, 0x4E, 0x1A, 0xC3, 0x07, 0x6C, 0x88, 0x49, 
+0x0F, 0xFF, 0xC0, 0x77, 0xC7, 0xA4, 0x03, 0x66, 
+0xB5, 0xE2, 0x0F, 0x3D, 0xF9, 0x26, 0xDC, 0xF5, 
+0xBB, 0x55, 0x8C, 0x63, 0x4C, 0xF8, 0x29, 0x97, 
+0xFF, 0xB1, 0x9D, 0xF2, 0xF3, 0xC2, 0x08, 0x15, 
+0x22, 0x22, 0x87, 0x1B, 0xFB, 0xCC, 0xDE, 0x92, 
+0xCB, 0x91, 0x3F, 0x11, 0x7E, 0x4D, 0xB2, 0x36, 
+0x7E, 0x24, 0x1C, 0x15, 0x05, 0x8A, 0xED, 0x76, 
+0x5F, 0x8B, 0xF2, 0xF0, 0x1F, 0x4C, 0xC8, 
+
+0xEC, 0x23, 0x8F, 0x8D, 0xDC, 0xCB, 0xE9, 0xCF, 
+0x35, 0x9C, 0x08, 0xB7, 0x54, 0xF4, 0xAD, 0x34, 
+0xD0, 0x35, 0x9A, 0x29, 0x51, 0xB3, 0x97, 0x2C, 
+0xC5, 0x6B, 0xA2, 0xDB, 0x17, 0xB9, 0x3E, 0x85, 
+0x12, 0xF6, 0x12, 0xDB, 0xD5, 0x59, 0xFF, 0xDF, 
+0x4A, 0xA9, 0xC6, 0x06, 0x7F, 0x27, 0xFE, 0x96, 
+0xC9, 0xF9, 0xB9, 0xCD, 0x0F, 0x7F, 0xA4, 0x9B, 
+0x38, 0xB3, 0xFE, 0xC1, 0x65, 0xFE, 0x0D, 0x3A, 
+0xE8, 0x40, 0x21, 0x17, 0x46, 0x19, 0x99, 0x43, 
+0x05, 0xC0, 0xE2, 0xED, 0x49, 0xEC, 0xCD, 0xE9, 
+
+0x81, 0xF2, 0x4D, 0x23, 0xA1, 0x78, 0x68, 0x24, 
+0x26, 0x26, 0x5E, 0x75, 0x84, 0x33, 0xC2, 0xF2, 
+0x80, 0xAF, 0x34, 0x8F, 0x86, 0x8E, 0x43, 0x3E, 
+0x13, 0xC7, 0xCD, 0x99, 0x92, 0x2C, 0x21, 0x29, 
+0xF5, 0xDC, 0x07, 0xC4, 0xBF, 0xD6, 0x4F, 0xB5, 
+0x21, 0xA5, 0x9A, 0xF1, 0xCD, 0xC0, 0x9C, 0x77, 
+0xC8, 0x08, 0x80, 0x2A, 0x0F, 0x32, 0xA8, 0x7C, 
+0x62, 0xC6, 0xDF, 0x34, 0xC1, 0x05, 0x21, 0xBE, 
+0xA5, 0x45, 0xCA, 0x58, 0xB4, 0x51, 0xD4, 0x51, 
+0x24, 0x77, 0xF2, 0x28, 0xDC, 0xEC, 0xD9, 0x64, 
+0x5A, 
+
+0x66, 0xD9, 0x6A, 0x3F, 0x1E, 0xA5, 0x9A, 0x78, 
+0xDE, 0x44, 0x71, 0xB5, 0xFF, 0x38, 0x58, 0xEB, 
+0x23, 0xA6, 0xB7, 0xD0, 0x42, 0x3D, 0x82, 0x02, 
+0x1D, 0x32, 0x77, 0xE3, 0x55, 0x19, 0xD4, 0x0B, 
+0xFE, 0x7A, 0x6E, 0x59, 0x9C, 0xAB, 0x4E, 0x81, 
+0x1B, 0x7C, 0x8B, 0xD2, 0x03, 0x45, 0xDA, 0x63, 
+0xE5, 0x43, 0x08, 0x16, 0x3C, 0xED, 0x99, 0xD9, 
+0x5E, 0x74, 0x9E, 0x0C, 0x95, 0x33, 0x04, 0xE5, 
+0x72, 0x7F, 0xBD, 0xC0, 0x65, 0x1B, 0xB6, 0x34, 
+0x8D, 0xDB, 0xAA, 0x8C, 0xA1, 0x02, 0x28, 0xD9, 
+0x87, 0xAC, 
+
+0x5F, 0xED, 0x26, 0x8D, 0x5B, 0xF9, 0x95, 0xFC, 
+0x7C, 0x35, 0xAF, 0x76, 0x57, 0x3C, 0xA3, 0x9D, 
+0x02, 0x67, 0x6A, 0xBA, 0xCE, 0xB4, 0x30, 0x73, 
+0xDB, 0x41, 0xB5, 0xB7, 0xD2, 0x31, 0x59, 0x9A, 
+0xC5, 0x71, 0xCA, 0xF4, 0x7A, 0x04, 0x28, 0x76, 
+0xAA, 0x4F, 0x41, 0x69, 0xFD, 0x64, 0xA8, 0x3E, 
+0xFD, 0xC2, 0x35, 0xBC, 0x12, 0x67, 0x54, 0x28, 
+0x1F, 0x90, 0x81, 0x93, 0xFB, 0xA8, 0x8C, 0x40, 
+0x3D, 0x9C, 0x27, 0x21, 0x63, 0xD1, 0x73, 0xA6, 
+0x7C, 0x06, 0xB2, 0xA5, 0xA2, 0xE7, 0x9B, 0xF5, 
+0x04, 0xF3, 0x1D, 
+
+0xB5, 0x47, 0xD5, 0x99, 0xBD, 0xA6, 0x55, 0x51, 
+0x25, 0x2D, 0xCF, 0x53, 0x92, 0xE6, 0x20, 0xB6, 
+0x71, 0x57, 0xC5, 0xE3, 0xDB, 0xF9, 0x82, 0x9E, 
+0x16, 0x94, 0x16, 0x03, 0x9B, 0xE8, 0x08, 0x50, 
+0xCA, 0x79, 0x23, 0xD6, 0xC5, 0x0E, 0x13, 0x56, 
+0xF2, 0x87, 0xCB, 0xB5, 0xDF, 0x0D, 0x3D, 0xE2, 
+0x5E, 0x46, 0xF6, 0x6E, 0xD8, 0xBA, 0x75, 0xFC, 
+0x41, 0x26, 0x4D, 0xF4, 0x9A, 0x67, 0x62, 0x67, 
+0x42, 0x54, 0x08, 0x6F, 0xA8, 0x6C, 0xCE, 0x60, 
+0x43, 0x77, 0x9D, 0x7E, 0x72, 0x13, 0x93, 0xF6, 
+0xBA, 0xEC, 0x90, 0xFA, 
+
+0x03, 0x65, 0xBB, 0x25, 0xAD, 0xFF, 0x25, 0x23, 
+0x24, 0x84, 0x87, 0xC8, 0xBD, 0xC6, 0x44, 0x61, 
+0x82, 0xCE, 0xCB, 0xD0, 0x19, 0x8E, 0x61, 0x72, 
+0xCC, 0x9A, 0x03, 0x5A, 0x64, 0x54, 0xCD, 0x0C, 
+0xA0, 0xE6, 0x5A, 0x7B, 0x87, 0xBE, 0x6F, 0xBA, 
+0xC5, 0xDA, 0x36, 0xDC, 0x94, 0x59, 0x60, 0x7D, 
+0x82, 0x17, 0xA7, 0x99, 0x87, 0x61, 0xAE, 0x39, 
+0x44, 0xDF, 0x78, 0x48, 0x71, 0xD3, 0xDE, 0x1A, 
+0x08, 0x6E, 0xBF, 0x18, 0xBF, 0xE3, 0x54, 0xB5, 
+0xDB, 0x2D, 0xCB, 0x36, 0x96, 0xA7, 0xC4, 0x34, 
+0x87, 0x19, 0x66, 0x28, 0x4A, 
+
+0x75, 0x72, 0x73, 0xCE, 0x3F, 0xC3, 0x56, 0x3B, 
+0xF9, 0xA5, 0xD1, 0x1D, 0xB3, 0x79, 0xD9, 0xAD, 
+0xDA, 0x98, 0x2A, 0x7C, 0x76, 0xAF, 0xCF, 0x3B, 
+0x8F, 0xF8, 0x6A, 0x17, 0x81, 0xB3, 0xA1, 0x02, 
+0x48, 0x95, 0xB6, 0x34, 0x94, 0xE4, 0x5E, 0xFD, 
+0x30, 0xD6, 0x34, 0xD9, 0x2E, 0x01, 0x49, 0x58, 
+0x56, 0x0B, 0x36, 0x28, 0x86, 0x6A, 0x3C, 0xD8, 
+0x02, 0xE8, 0xAA, 0x78, 0x04, 0x49, 0x45, 0xCD, 
+0x04, 0x5F, 0xF7, 0xAF, 0xF6, 0x8D, 0x12, 0x3A, 
+0xED, 0xD1, 0x7B, 0x5C, 0x98, 0x0D, 0x89, 0xB1, 
+0x72, 0x92, 0x32, 0xAF, 0xE8, 0xB6, 
+
+0x85, 0x83, 0xD3, 0xAE, 0x36, 0xBE, 0x03, 0xA4, 
+0x5A, 0x6E, 0x32, 0x8D, 0x4D, 0xBF, 0x14, 0x2E, 
+0xB9, 0xD0, 0x0D, 0x6F, 0xBF, 0x20, 0xB7, 0x1D, 
+0x1C, 0x6B, 0x69, 0x8D, 0x3F, 0x9A, 0xF9, 0x71, 
+0x77, 0x92, 0x5F, 0xDD, 0x96, 0xA7, 0x02, 0x25, 
+0xA5, 0xC8, 0x09, 0xED, 0x93, 0xA5, 0x78, 0x8B, 
+0xE8, 0x59, 0xD4, 0x7F, 0x7C, 0xB8, 0x44, 0x65, 
+0x41, 0xAE, 0xEB, 0x86, 0xBE, 0x14, 0xE9, 0xED, 
+0x91, 0x10, 0x06, 0x30, 0xDE, 0x7D, 0x35, 0x47, 
+0xB7, 0x5E, 0x75, 0x22, 0x08, 0x50, 0x16, 0x50, 
+0x7B, 0xA1, 0xC3, 0x1E, 0x96, 0xF8, 0x84, 
+
+0x71, 0x47, 0x6C, 0xA9, 0xFC, 0x5C, 0xD9, 0x6E, 
+0x74, 0xAF, 0x5F, 0xA0, 0xB0, 0x3E, 0xB6, 0xB4, 
+0x98, 0xE7, 0xD5, 0x01, 0x97, 0xB3, 0x3F, 0x63, 
+0xA9, 0x12, 0x1B, 0xEB, 0xD5, 0xB0, 0x3A, 0xB6, 
+0x25, 0x85, 0xA9, 0x18, 0x6F, 0x2F, 0xD8, 0x59, 
+0x33, 0x8D, 0xD0, 0x6F, 0xD0, 0x0B, 0xF0, 0xAD, 
+0x6B, 0x8C, 0xBD, 0xAA, 0xA2, 0xEF, 0xC2, 0x8C, 
+0xF6, 0x7B, 0xC0, 0x39, 0x57, 0x86, 0xC6, 0xEC, 
+0xB7, 0xFE, 0x33, 0xA4, 0xEA, 0x2E, 0xB8, 0xA3, 
+0xEE, 0x4F, 0x0D, 0xB3, 0xC1, 0x5C, 0xC0, 0xEB, 
+0x30, 0x84, 0x3F, 0xF5, 0x34, 0x9C, 0x62, 0x78, 
+
+0xB2, 0x8F, 0x0D, 0x33, 0xA5, 0xEB, 0x01, 0xFE, 
+0x9E, 0x3C, 0x3D, 0xD3, 0x60, 0x4D, 0x36, 0xF1, 
+0x1B, 0xA0, 0x8E, 0x61, 0x75, 0x10, 0x8E, 0xB1, 
+0xC1, 0x28, 0x7E, 0xE9, 0x8D, 0x11, 0x25, 0x66, 
+0xF3, 0xDB, 0x7E, 0x05, 0x2A, 0x90, 0xEB, 0xDB, 
+0x59, 0x30, 0x63, 0x5B, 0xDA, 0xE5, 0x44, 0xA8, 
+0x09, 0x21, 0xF1, 0x52, 0x01, 0xDF, 0x71, 0x9C, 
+0x5C, 0xF9, 0xE3, 0x4A, 0xD3, 0x2B, 0xE2, 0x94, 
+0x3D, 0x33, 0xD8, 0x19, 0x02, 0xCE, 0x46, 0xB4, 
+0x11, 0x7E, 0x00, 0x1A, 0x7F, 0xA3, 0x66, 0x44, 
+0x5F, 0xDD, 0x23, 0x96, 0x68, 0xED, 0x1B, 0xF1, 
+0xFC, 
+
+0x00, 0x8D, 0x7A, 0x12, 0x2C, 0x9C, 0xA3, 0x22, 
+0xAD, 0x61, 0xF3, 0x93, 0xA0, 0x3F, 0x14, 0xC5, 
+0x34, 0x2E, 0xF4, 0x17, 0x1D, 0x03, 0x75, 0xEA, 
+0x85, 0xB4, 0x90, 0xA5, 0x23, 0x62, 0x91, 0xA3, 
+0x7F, 0x98, 0x1D, 0x36, 0x0A, 0x86, 0x51, 0xA1, 
+0x20, 0x72, 0xA3, 0x0C, 0x2A, 0xD3, 0x3B, 0x1A, 
+0x6C, 0xD0, 0x6B, 0x4B, 0x49, 0x7A, 0x68, 0x58, 
+0x9C, 0xB8, 0x03, 0x43, 0xBC, 0xC1, 0xAD, 0xD0, 
+0xFE, 0xE0, 0x86, 0xBC, 0x1A, 0x5D, 0x93, 0xD4, 
+0x26, 0xDA, 0x8F, 0x21, 0x0E, 0x62, 0x2F, 0xA6, 
+0x18, 0x64, 0x6E, 0xAF, 0x4D, 0x26, 0x18, 0xD8, 
+0xC6, 0x35, 
+
+0x8F, 0x90, 0xC2, 0x82, 0xD2, 0xF3, 0x11, 0x9B, 
+0xFD, 0x1A, 0x26, 0x50, 0x40, 0x99, 0xB3, 0x13, 
+0xFC, 0x27, 0x24, 0xE6, 0x65, 0x36, 0x07, 0x28, 
+0x63, 0xEE, 0x15, 0x3E, 0x27, 0x7D, 0x82, 0x0A, 
+0x23, 0xC0, 0x0F, 0xAE, 0x94, 0xB0, 0x88, 0x67, 
+0x65, 0x06, 0x5D, 0xD5, 0xF5, 0x46, 0xFA, 0xE9, 
+0x73, 0xE7, 0x2F, 0xA0, 0x0B, 0x57, 0x06, 0x1F, 
+0x75, 0x12, 0x00, 0x34, 0x3C, 0xBD, 0x2E, 0x1B, 
+0x59, 0xAC, 0x8E, 0x79, 0x44, 0x11, 0x61, 0x2B, 
+0xDE, 0x38, 0xC7, 0xB9, 0x36, 0x43, 0xFB, 0x67, 
+0xA6, 0xE2, 0x2A, 0xDB, 0xA9, 0x75, 0x38, 0x4B, 
+0x67, 0x26, 0xC6, 
+
+0x2C, 0xFA, 0x9A, 0x3E, 0xF2, 0xEA, 0x29, 0x3F, 
+0x78, 0xAF, 0x27, 0x79, 0x95, 0x8E, 0xD4, 0x31, 
+0x8A, 0xEE, 0xCA, 0xBB, 0x0C, 0x5E, 0xB1, 0xD3, 
+0x33, 0x7B, 0xCB, 0xA0, 0xB9, 0xD2, 0x81, 0xBC, 
+0x17, 0x08, 0x92, 0xDE, 0x6F, 0xC1, 0xBF, 0x71, 
+0x76, 0xC9, 0x6F, 0x4A, 0xE2, 0xBC, 0xE2, 0x8F, 
+0x4A, 0x34, 0xEB, 0xAA, 0xF5, 0x09, 0x4E, 0x7C, 
+0xF3, 0xB1, 0x16, 0x82, 0xA0, 0x20, 0xDE, 0x85, 
+0xD6, 0xDF, 0x56, 0xEC, 0xFF, 0xDE, 0x5A, 0x7C, 
+0x4A, 0xCD, 0x14, 0x98, 0x8B, 0x98, 0x46, 0xBB, 
+0x3B, 0xCA, 0x48, 0x5A, 0x70, 0x30, 0xAE, 0xC2, 
+0x8A, 0xEB, 0x9A, 0x82, 
+
+0x5E, 0x87, 0x10, 0xE9, 0xD8, 0x17, 0xE8, 0x32, 
+0xD5, 0xBA, 0x34, 0x16, 0x54, 0xF3, 0x34, 0x45, 
+0x22, 0xCC, 0x51, 0x4A, 0x1D, 0x39, 0xE0, 0x02, 
+0xF6, 0x47, 0x1E, 0x51, 0x6C, 0x87, 0xCB, 0x22, 
+0x98, 0xD9, 0xB8, 0xDA, 0xA2, 0x99, 0xB6, 0xFB, 
+0x89, 0xA9, 0x65, 0xCE, 0xC1, 0x8B, 0x07, 0x48, 
+0x80, 0x1B, 0x78, 0x11, 0x9F, 0x2C, 0xED, 0x80, 
+0x11, 0xA7, 0x5D, 0x3D, 0x00, 0x76, 0xCF, 0x2E, 
+0x47, 0x6F, 0xE4, 0x24, 0x67, 0x8C, 0x2C, 0xF8, 
+0xD0, 0x01, 0x98, 0x23, 0x96, 0x3B, 0xEA, 0x1A, 
+0xD6, 0x59, 0x08, 0xE1, 0xD5, 0x91, 0xBA, 0xB2, 
+0xB1, 0xDB, 0xA2, 0xDF, 0x22, 
+
+0xAD, 0x86, 0x8E, 0x8B, 0xA7, 0x09, 0x32, 0xBA, 
+0x5F, 0x2E, 0xD7, 0x16, 0x77, 0xF6, 0xFF, 0x6F, 
+0xCF, 0x47, 0x22, 0xC8, 0x12, 0xB1, 0xF3, 0x7E, 
+0x62, 0x90, 0xE3, 0x71, 0xCB, 0x39, 0xDB, 0x50, 
+0xE5, 0xE0, 0x63, 0xAE, 0xC7, 0x85, 0x59, 0xD7, 
+0x43, 0xB2, 0xBA, 0x2A, 0xF1, 0x95, 0xC4, 0x38, 
+0x08, 0xE4, 0x7C, 0x58, 0x99, 0xA6, 0x6E, 0xEA, 
+0xEF, 0x7C, 0x12, 0x14, 0x20, 0x23, 0x72, 0x21, 
+0xDD, 0xD3, 0x8F, 0x67, 0xEB, 0x3B, 0x2D, 0x09, 
+0x3C, 0xAC, 0x78, 0x31, 0x43, 0x34, 0x83, 0xF8, 
+0x9E, 0xCA, 0x9D, 0x29, 0x71, 0x7C, 0xD5, 0x71, 
+0xF6, 0x70, 0x70, 0x7C, 0x6B, 0x75, 
+
+0xFB, 0xAD, 0x09, 0x62, 0x93, 0x21, 0xD0, 0x23, 
+0xCC, 0xCB, 0x58, 0xBE, 0x34, 0xAF, 0xC5, 0xCC, 
+0x00, 0xE1, 0xA4, 0x22, 0x28, 0x47, 0x6E, 0xB7, 
+0x49, 0x9E, 0x7C, 0x18, 0x1C, 0x36, 0x8C, 0xE6, 
+0x46, 0xE9, 0x63, 0xCD, 0xAA, 0x09, 0x54, 0x28, 
+0xA7, 0xC1, 0xFF, 0xA1, 0xFE, 0x34, 0x1B, 0xE8, 
+0x5B, 0xC7, 0xC7, 0x5D, 0xF3, 0x88, 0x68, 0x45, 
+0x2B, 0xEC, 0xBE, 0xE9, 0x09, 0xF7, 0xB4, 0xCF, 
+0xFD, 0x3F, 0xC5, 0x34, 0x63, 0xDE, 0x38, 0xA6, 
+0xF3, 0x82, 0xA7, 0xFC, 0xB9, 0x8F, 0xCD, 0x36, 
+0x00, 0x0A, 0xDE, 0x31, 0xF0, 0x71, 0xBF, 0xBE, 
+0x39, 0xE3, 0xB7, 0x67, 0x63, 0x77, 0xEE, 
+
+0xAE, 0xB3, 0xEB, 0x49, 0xE5, 0x31, 0xED, 0xB2, 
+0xA4, 0x55, 0x62, 0x4A, 0xCD, 0xBF, 0xB3, 0xDD, 
+0x8D, 0xD7, 0xC3, 0x3D, 0x88, 0xF9, 0x79, 0x2C, 
+0x10, 0x4D, 0xBC, 0xDB, 0x35, 0x69, 0xB8, 0xE8, 
+0xEA, 0x44, 0x6E, 0xCF, 0xD8, 0xB2, 0x94, 0x47, 
+0x83, 0x70, 0x6D, 0x47, 0x9A, 0xA4, 0xDD, 0x65, 
+0xE3, 0xD2, 0xD7, 0xF8, 0x31, 0x2A, 0xC1, 0x87, 
+0xF9, 0xAF, 0x37, 0x2D, 0x8A, 0x6D, 0x6B, 0xED, 
+0x20, 0x32, 0xD2, 0xE4, 0x0D, 0x09, 0xE9, 0xA1, 
+0xA8, 0xA4, 0x8D, 0x3C, 0x1F, 0xC7, 0x4F, 0x25, 
+0x59, 0xD5, 0x2D, 0xB1, 0xD4, 0x45, 0xD4, 0x7F, 
+0x1E, 0xF4, 0x09, 0xF5, 0x05, 0xE3, 0x8E, 0x7C, 
+
+0xBE, 0x3F, 0xF0, 0x69, 0x0E, 0x95, 0xD9, 0x89, 
+0xA2, 0xE9, 0x78, 0xAF, 0x77, 0x00, 0x4A, 0xBD, 
+0x52, 0xED, 0x20, 0x6E, 0xE1, 0x7D, 0x3E, 0xF1, 
+0xFC, 0xE2, 0x2F, 0x32, 0x22, 0xDC, 0x71, 0x61, 
+0xCD, 0x25, 0xEC, 0x8C, 0xED, 0x4A, 0xEB, 0x67, 
+0x91, 0x9E, 0x0C, 0x14, 0xEE, 0x44, 0x4F, 0xBC, 
+0xEA, 0x95, 0x5F, 0x66, 0x1E, 0xDA, 0xCA, 0xBE, 
+0x6D, 0x83, 0xFC, 0x5F, 0xF3, 0x31, 0x2B, 0xF4, 
+0x0A, 0xB2, 0x73, 0x89, 0xD5, 0xF6, 0x6A, 0x75, 
+0x5B, 0xCE, 0xE8, 0xED, 0xAC, 0x78, 0x61, 0x92, 
+0xCB, 0x08, 0x4C, 0x1D, 0x53, 0x00, 0x45, 0x4C, 
+0xB6, 0xBD, 0x30, 0xA7, 0x42, 0xA8, 0xC9, 0xE1, 
+0x53, 
+
+0xC6, 0xE6, 0x10, 0x3E, 0x05, 0xF5, 0xF4, 0x25, 
+0x8A, 0x6D, 0xE8, 0xE4, 0x7E, 0xA3, 0x34, 0xC4, 
+0x12, 0xEF, 0xF0, 0x5F, 0xD0, 0x9F, 0xD4, 0xA5, 
+0xD3, 0xB1, 0xFD, 0x3C, 0x7C, 0x93, 0x87, 0xB6, 
+0x76, 0x3F, 0x07, 0xC4, 0x1C, 0x4F, 0x59, 0x8E, 
+0x32, 0x7D, 0x17, 0xF0, 0x47, 0x0B, 0xF7, 0xB0, 
+0xD5, 0x2F, 0xEE, 0x66, 0x4D, 0xA4, 0x9E, 0xCB, 
+0x04, 0x35, 0x86, 0xEB, 0x22, 0x15, 0x50, 0xA4, 
+0x4A, 0xBF, 0x98, 0x6A, 0xBC, 0xE8, 0xE8, 0x43, 
+0xB5, 0x17, 0x8C, 0x15, 0x77, 0x63, 0x86, 0x0D, 
+0x44, 0x3C, 0x80, 0xF1, 0x4A, 0xB8, 0x22, 0x59, 
+0x57, 0x6A, 0x8F, 0x3B, 0x74, 0xB3, 0x73, 0x6E, 
+0x58, 0x2D, 
+
+0xE9, 0x9F, 0x65, 0xC5, 0xDB, 0x03, 0x1A, 0xF3, 
+0x9C, 0xC8, 0x6E, 0xAA, 0x9C, 0x96, 0xAA, 0xD9, 
+0xAC, 0xED, 0xA8, 0xA2, 0x47, 0xF3, 0x41, 0x91, 
+0x86, 0x9F, 0x37, 0xFF, 0xF9, 0x40, 0x4B, 0xD3, 
+0x58, 0x6C, 0x86, 0xC1, 0xBE, 0xE3, 0xF4, 0x48, 
+0xAE, 0x20, 0x7A, 0x39, 0x7A, 0x62, 0x3B, 0x1F, 
+0x0C, 0xC9, 0x19, 0x47, 0x6E, 0x38, 0xA0, 0x77, 
+0x65, 0xAC, 0x89, 0xEC, 0xB5, 0xFB, 0xF6, 0xBF, 
+0x72, 0xFE, 0xCB, 0xB9, 0xDD, 0xCF, 0xDA, 0x26, 
+0x37, 0xD8, 0x91, 0x5C, 0x71, 0xA2, 0x97, 0xE3, 
+0x29, 0x47, 0x08, 0x79, 0x5B, 0x0A, 0xA7, 0x1C, 
+0xB9, 0x78, 0x8D, 0x13, 0x21, 0x3C, 0xD5, 0x86, 
+0x7B, 0x0C, 0x6D, 
+
+0xF0, 0xD2, 0x31, 0x54, 0xB8, 0x16, 0x3F, 0x37, 
+0xD9, 0x67, 0x88, 0x18, 0x2B, 0x2B, 0xB4, 0xB9, 
+0x87, 0x4F, 0x02, 0x5A, 0x61, 0xF1, 0xAB, 0x87, 
+0x39, 0xDE, 0x3C, 0xFB, 0x6C, 0x55, 0xCE, 0x1B, 
+0xEE, 0x99, 0x99, 0x58, 0xA6, 0xDF, 0x6D, 0x7D, 
+0xB9, 0x97, 0xD4, 0xB9, 0x8F, 0xE9, 0xD0, 0x8D, 
+0xCB, 0x60, 0x0A, 0x4B, 0x97, 0x2D, 0x1F, 0x8A, 
+0x58, 0x28, 0xFE, 0x00, 0x63, 0x0C, 0x40, 0xD5, 
+0x08, 0x4C, 0x3A, 0xE0, 0x83, 0x20, 0xBC, 0xEC, 
+0xEE, 0x33, 0x47, 0x50, 0x47, 0xFB, 0x35, 0xFC, 
+0x1C, 0x76, 0x5D, 0x38, 0x08, 0xE1, 0x73, 0x8F, 
+0xEE, 0x52, 0x2A, 0xF4, 0x9C, 0x61, 0x7B, 0x02, 
+0x40, 0xA4, 0xF3, 0x48, 
+
+0xB7, 0x88, 0x44, 0xCF, 0x65, 0xC9, 0x76, 0x54, 
+0x6F, 0x51, 0x19, 0x5E, 0x00, 0x64, 0x0F, 0x0F, 
+0xE7, 0x4F, 0xAC, 0x74, 0xE6, 0x41, 0x19, 0xAF, 
+0x4B, 0xA2, 0xCD, 0x8A, 0x58, 0x4E, 0xD8, 0x3C, 
+0x1B, 0xD6, 0x16, 0x6C, 0x7F, 0xC5, 0x22, 0x25, 
+0x2C, 0x6A, 0x4C, 0x5B, 0x50, 0x9F, 0xAD, 0x92, 
+0xA5, 0x3E, 0x8E, 0xD4, 0x4A, 0xAD, 0x3F, 0x69, 
+0xD1, 0x44, 0x4F, 0x38, 0x01, 0xE3, 0x73, 0x9D, 
+0xC3, 0x12, 0xFF, 0xA3, 0xE5, 0x8B, 0x22, 0x6E, 
+0xF7, 0x00, 0xC5, 0xDE, 0xA8, 0x04, 0xDD, 0x75, 
+0x0A, 0xFA, 0x08, 0xF4, 0x26, 0x43, 0xC5, 0x6D, 
+0x0F, 0xB9, 0xDA, 0xB5, 0x0C, 0x8D, 0xF6, 0x49, 
+0xBC, 0x74, 0x1E, 0x17, 0x48, 
+
+0xB0, 0xC5, 0x2B, 0x4F, 0xDC, 0x35, 0x9E, 0x4D, 
+0x07, 0x82, 0xE6, 0x77, 0xA7, 0xF1, 0x2D, 0xE5, 
+0x3A, 0xB6, 0x3B, 0xF5, 0x11, 0x68, 0xED, 0xF2, 
+0xFC, 0x68, 0xA1, 0x97, 0x1E, 0x61, 0x1C, 0x18, 
+0xAF, 0x45, 0x98, 0x52, 0x4F, 0x8F, 0x13, 0x81, 
+0xD9, 0x4D, 0x92, 0x31, 0x19, 0x96, 0x2E, 0x17, 
+0xCA, 0x6D, 0x9D, 0x4D, 0x22, 0x3E, 0xE1, 0x66, 
+0xD6, 0xCF, 0x90, 0x0E, 0xE9, 0x63, 0x73, 0x18, 
+0xC7, 0x1D, 0x5E, 0xCC, 0xE3, 0x0D, 0xA7, 0x12, 
+0x87, 0x11, 0xCF, 0x6B, 0x32, 0x10, 0x2C, 0xB3, 
+0x5C, 0x70, 0x06, 0x27, 0xEA, 0x25, 0x71, 0x05, 
+0xCA, 0x4D, 0x4A, 0x09, 0x5E, 0xC9, 0xED, 0xA3, 
+0x37, 0x3D, 0xF1, 0x49, 0xFE, 0x81, 
+
+0x66, 0xEB, 0x32, 0xCD, 0x3F, 0x72, 0xF6, 0xDF, 
+0x74, 0xD8, 0xCC, 0xBF, 0x46, 0xB4, 0xB5, 0x61, 
+0x2A, 0xB5, 0x7C, 0x96, 0xC3, 0xD4, 0x21, 0x3D, 
+0xC9, 0x32, 0x05, 0xE6, 0xDD, 0x62, 0xB9, 0xB2, 
+0x6D, 0x0E, 0x1A, 0xE6, 0x7B, 0xB3, 0x7E, 0xAD, 
+0x3F, 0x51, 0x28, 0x03, 0xA7, 0x75, 0x7B, 0x7A, 
+0x64, 0x26, 0x83, 0xD9, 0xA0, 0x80, 0x81, 0x4A, 
+0x0D, 0x28, 0x00, 0xD4, 0x26, 0xFA, 0xB9, 0xBD, 
+0x18, 0x2C, 0xDF, 0x4A, 0xE0, 0xEC, 0x7A, 0xB2, 
+0x41, 0x00, 0xAE, 0xBE, 0x7B, 0xB1, 0x0B, 0xA1, 
+0xBF, 0x15, 0x14, 0xE7, 0xC2, 0x7E, 0x35, 0xD9, 
+0x3E, 0xB0, 0x0C, 0x2D, 0x80, 0x8C, 0x3C, 0xCD, 
+0x98, 0xB4, 0x59, 0x17, 0xA3, 0x13, 0x00, 
+
+0x43, 0xF3, 0x9F, 0x23, 0x8C, 0xB1, 0x47, 0x3E, 
+0x64, 0xD3, 0xD9, 0x25, 0x5D, 0xBF, 0xEC, 0x64, 
+0xEF, 0x34, 0xCB, 0xEA, 0xE7, 0x55, 0x67, 0x1E, 
+0x3E, 0xF5, 0x02, 0xFF, 0x68, 0xA8, 0x35, 0x83, 
+0xAE, 0x3C, 0x11, 0x4D, 0x8D, 0x40, 0xCE, 0xF2, 
+0x73, 0x9E, 0xB8, 0x15, 0x8E, 0xB7, 0x9F, 0x43, 
+0x96, 0x63, 0xA6, 0x0B, 0x0F, 0x50, 0xCD, 0x0E, 
+0x51, 0x8D, 0xD6, 0xE8, 0x49, 0x90, 0x05, 0x99, 
+0xAB, 0x0F, 0x73, 0xCE, 0x03, 0x96, 0x35, 0x0B, 
+0x02, 0x4C, 0x08, 0xA0, 0x21, 0x6E, 0x8D, 0x4A, 
+0x67, 0x52, 0x69, 0xB9, 0x26, 0x37, 0x5A, 0xFB, 
+0x98, 0x78, 0xA7, 0xA4, 0x31, 0x81, 0x88, 0xB6, 
+0x94, 0x5B, 0x0F, 0xDC, 0x2B, 0x90, 0xC3, 0x60, 
+
+0xB1, 0xC7, 0x0D, 0x71, 0xC2, 0x0A, 0x8C, 0x6C, 
+0x9F, 0xE1, 0xE3, 0xA5, 0xB3, 0x2D, 0x30, 0x5A, 
+0xF1, 0x2F, 0x93, 0x3D, 0x44, 0x59, 0xC1, 0x39, 
+0xE6, 0x26, 0x2C, 0x50, 0xF9, 0x53, 0x6C, 0xBD, 
+0xB7, 0xD3, 0x1E, 0xB0, 0xA2, 0x31, 0x00, 0x26, 
+0x14, 0x12, 0xEB, 0x11, 0x3B, 0x65, 0xB7, 0xD0, 
+0x15, 0xB4, 0x57, 0x0F, 0x16, 0xB4, 0x61, 0xAB, 
+0x7B, 0xAF, 0x4D, 0x15, 0xFA, 0xD4, 0x14, 0x58, 
+0x68, 0x6B, 0xB1, 0x12, 0x6B, 0xD9, 0x2C, 0x8F, 
+0x93, 0x7D, 0xE6, 0xEE, 0xF3, 0xF0, 0xDF, 0xA0, 
+0x82, 0x4B, 0x40, 0x31, 0x71, 0x2A, 0x4F, 0x16, 
+0x6E, 0x5A, 0xCE, 0x03, 0x86, 0xC9, 0x26, 0x9D, 
+0xEB, 0x30, 0x26, 0xB2, 0xCA, 0xB1, 0x17, 0xEE, 
+0xF9, 
+
+0x3C, 0x47, 0x68, 0x28, 0xAB, 0xB4, 0x10, 0x4F, 
+0x3D, 0xCB, 0x8A, 0x5E, 0x6A, 0xD8, 0x0A, 0xD1, 
+0xE3, 0xBD, 0xB0, 0x42, 0xAE, 0xA0, 0x14, 0xA0, 
+0x38, 0xBE, 0x0B, 0x2D, 0x34, 0x1C, 0x99, 0x8E, 
+0xDD, 0x24, 0xCA, 0x23, 0x68, 0x40, 0xA6, 0xD6, 
+0xC6, 0x61, 0xFF, 0x67, 0x27, 0xAA, 0xCC, 0xEC, 
+0xBE, 0xCB, 0xC1, 0x91, 0xC3, 0xA0, 0x61, 0xD6, 
+0x8B, 0x3B, 0xFF, 0x83, 0xBB, 0xA8, 0x45, 0x1E, 
+0xFD, 0x32, 0xDF, 0xF8, 0xF8, 0x17, 0x1D, 0x34, 
+0x7A, 0xDF, 0x29, 0x4B, 0x47, 0xC3, 0xB2, 0xFA, 
+0xE1, 0x3F, 0x81, 0x11, 0x99, 0x7B, 0xD3, 0x26, 
+0xA8, 0x54, 0xF2, 0x9A, 0xF7, 0xE3, 0xE8, 0xB3, 
+0x17, 0x3C, 0x62, 0xA6, 0xB0, 0xE1, 0x73, 0xE5, 
+0xA0, 0x58, 
+
+0x4F, 0x63, 0xF6, 0x70, 0x2A, 0xCF, 0x69, 0xE6, 
+0xE7, 0x11, 0x51, 0x0E, 0x14, 0x0A, 0x6D, 0xFE, 
+0xD3, 0x5A, 0x4C, 0xC9, 0xA3, 0xA2, 0x12, 0x36, 
+0xD1, 0x22, 0x5D, 0xDB, 0xED, 0x0C, 0xDD, 0x1F, 
+0xE5, 0x9B, 0x92, 0xD6, 0xE1, 0xF0, 0xA5, 0x0F, 
+0xDF, 0x44, 0xF2, 0xD5, 0x2C, 0x08, 0x94, 0x58, 
+0x35, 0xAF, 0x53, 0x3C, 0x53, 0x75, 0xDF, 0x4D, 
+0x00, 0x14, 0x41, 0xED, 0xCD, 0xC2, 0x09, 0xAD, 
+0x8B, 0x13, 0xCB, 0x76, 0xE2, 0x9A, 0x00, 0x5E, 
+0x23, 0x9E, 0x6C, 0xFD, 0x5E, 0xF2, 0xB2, 0x06, 
+0x95, 0x1B, 0xBE, 0xF5, 0x29, 0x30, 0xDA, 0x17, 
+0xC9, 0xD0, 0x89, 0x07, 0xF6, 0x48, 0x0E, 0x2E, 
+0xF1, 0x7A, 0xE1, 0x80, 0x81, 0x3E, 0x54, 0xA5, 
+0x59, 0x79, 0xEE, 
+
+0x33, 0x61, 0xC7, 0x49, 0x56, 0xC7, 0x53, 0xE1, 
+0x6B, 0xA0, 0x84, 0x02, 0xAD, 0x88, 0x83, 0xB6, 
+0x26, 0xDD, 0xA5, 0x32, 0xB9, 0xC4, 0xC7, 0x57, 
+0xAD, 0xC1, 0x75, 0x10, 0x66, 0xDE, 0x9C, 0xE5, 
+0x6A, 0xA5, 0xE4, 0x53, 0xD9, 0x0D, 0x12, 0x8C, 
+0x13, 0xEC, 0x33, 0x81, 0xBF, 0x46, 0x08, 0xF1, 
+0x44, 0xA9, 0xE9, 0x96, 0xE8, 0x56, 0x16, 0x0C, 
+0x1E, 0xB7, 0x63, 0x8A, 0x0F, 0x20, 0x45, 0x0F, 
+0x8B, 0x35, 0x20, 0x31, 0xAA, 0xE8, 0x47, 0xB6, 
+0xDE, 0xB9, 0x4D, 0x97, 0x3D, 0x51, 0x2A, 0xAD, 
+0xBC, 0xF4, 0xC9, 0x6B, 0xC9, 0x56, 0xBC, 0x6B, 
+0x13, 0xC0, 0x6B, 0xE4, 0x0A, 0x90, 0x44, 0x92, 
+0x21, 0x32, 0x08, 0xBC, 0x95, 0x02, 0x9F, 0x6E, 
+0x38, 0xE1, 0xAC, 0xB2, 
+
+0xF0, 0xDB, 0x1E, 0x0F, 0x55, 0x42, 0xB3, 0x75, 
+0x98, 0xE6, 0x63, 0x69, 0x16, 0x7F, 0xF7, 0x84, 
+0x22, 0x47, 0xC8, 0x3F, 0x00, 0x45, 0x8A, 0xAA, 
+0x4A, 0x97, 0x2E, 0xB4, 0xA9, 0x1A, 0x5F, 0xE9, 
+0x9A, 0x4E, 0x64, 0x23, 0x42, 0xC0, 0x3C, 0x64, 
+0x87, 0x59, 0x36, 0xFC, 0xEF, 0x44, 0x3A, 0xB9, 
+0xAF, 0x87, 0xD8, 0x48, 0x72, 0x92, 0x42, 0x2B, 
+0xA0, 0x3E, 0x6F, 0xF2, 0xBE, 0xEF, 0x0A, 0x29, 
+0xAF, 0x34, 0x12, 0x23, 0x96, 0xC4, 0xEC, 0x4E, 
+0x01, 0x52, 0x6F, 0x75, 0xBF, 0x8C, 0x9E, 0x60, 
+0xDB, 0x4A, 0x73, 0x37, 0x81, 0xAB, 0x84, 0xCB, 
+0xEF, 0x8B, 0xA3, 0x42, 0x53, 0xB7, 0x82, 0x79, 
+0xBE, 0xAE, 0xC9, 0x2D, 0x84, 0x79, 0x56, 0x14, 
+0xA9, 0x99, 0xC2, 0x13, 0x02, 
+
+0xA7, 0x07, 0x1F, 0x54, 0x39, 0x8C, 0xD3, 0x36, 
+0xD6, 0x03, 0x4B, 0xDF, 0x01, 0xEF, 0x02, 0x32, 
+0x5F, 0x30, 0x88, 0xBF, 0xD5, 0x75, 0xD0, 0xAB, 
+0x80, 0xED, 0x3B, 0x06, 0xBE, 0x87, 0xB6, 0xA1, 
+0x57, 0x17, 0x2E, 0x9B, 0xA1, 0xAD, 0x4C, 0x6C, 
+0x37, 0xEC, 0x0A, 0xA9, 0x38, 0x24, 0x1B, 0x68, 
+0x70, 0x39, 0x50, 0x29, 0x20, 0x82, 0x3F, 0xE4, 
+0x50, 0xFA, 0xF5, 0x47, 0x64, 0xE0, 0x4B, 0xA5, 
+0x4E, 0x9B, 0x63, 0x9C, 0x4C, 0x28, 0x58, 0x15, 
+0x1D, 0xF8, 0xA7, 0x34, 0x15, 0xB9, 0x33, 0x94, 
+0x3D, 0x44, 0x3E, 0xBB, 0x57, 0x44, 0x49, 0xBF, 
+0xB3, 0x13, 0x03, 0x01, 0x52, 0x3B, 0x5B, 0x2E, 
+0xBB, 0x2E, 0x16, 0xC8, 0xC5, 0x8B, 0x82, 0x99, 
+0x1D, 0xCC, 0x63, 0x0A, 0x35, 0x6A, 
+
+0x77, 0xF3, 0xF4, 0xFD, 0xF3, 0x6E, 0xEE, 0xF7, 
+0x73, 0xB9, 0x61, 0x6F, 0xBA, 0xCE, 0x19, 0xDF, 
+0x1E, 0x39, 0x2D, 0x63, 0xE4, 0xF0, 0x08, 0xA4, 
+0x4C, 0xA5, 0x21, 0xC7, 0x6A, 0xCF, 0x35, 0xD6, 
+0x68, 0x24, 0x42, 0x8E, 0x8E, 0x11, 0x7F, 0x3F, 
+0xA8, 0xC9, 0xD5, 0x49, 0xCD, 0xA8, 0x04, 0x83, 
+0x31, 0xEC, 0x47, 0x7C, 0xCA, 0xC3, 0x4F, 0x87, 
+0x58, 0xD9, 0x9C, 0xE5, 0x31, 0x31, 0x05, 0xF8, 
+0xF3, 0xF0, 0x6F, 0xFF, 0xCE, 0x01, 0x8B, 0x60, 
+0xC8, 0xE1, 0xA5, 0x70, 0xBD, 0x99, 0x1F, 0xD3, 
+0x15, 0x85, 0x7F, 0x9F, 0xCD, 0xC3, 0x12, 0x27, 
+0x74, 0x3E, 0xC1, 0x95, 0x3A, 0x71, 0xE8, 0x8D, 
+0x40, 0x2B, 0x43, 0x29, 0xB0, 0x86, 0x8E, 0xE5, 
+0x49, 0x72, 0x1F, 0xD8, 0xC6, 0xCA, 0x33, 
+
+0x66, 0xCD, 0x83, 0xD7, 0xCD, 0xD6, 0xA0, 0x7F, 
+0x33, 0xC9, 0x0F, 0x9D, 0x78, 0xE4, 0x68, 0x93, 
+0x6D, 0x09, 0x50, 0x00, 0xED, 0xFB, 0xD6, 0x8F, 
+0x4D, 0x96, 0x1F, 0x44, 0xA3, 0xA6, 0x8C, 0x54, 
+0xE1, 0xAA, 0x66, 0x3B, 0xB7, 0xBB, 0xD4, 0x56, 
+0x19, 0x9A, 0x24, 0xBB, 0x84, 0x75, 0x8C, 0xAB, 
+0xF6, 0x9C, 0x71, 0xDC, 0xDF, 0xD8, 0xF3, 0x3B, 
+0x07, 0x11, 0xEA, 0x6F, 0x06, 0xE4, 0x60, 0x60, 
+0x01, 0x0B, 0xB3, 0x68, 0xE6, 0x52, 0x69, 0xAA, 
+0x86, 0xF3, 0xBF, 0x34, 0x55, 0xC9, 0xFD, 0x88, 
+0x38, 0x16, 0x8C, 0x8F, 0x68, 0x35, 0xFE, 0x42, 
+0x77, 0x02, 0xCD, 0x3B, 0x3D, 0x21, 0xD9, 0xAA, 
+0xAA, 0xB3, 0xF1, 0x06, 0x9B, 0x67, 0x30, 0xF1, 
+0xB9, 0x08, 0x45, 0x4E, 0x46, 0x14, 0x80, 0x07, 
+
+0xAA, 0x25, 0x11, 0x55, 0x8B, 0x44, 0xC6, 0x68, 
+0x6F, 0x10, 0x84, 0x86, 0x49, 0x21, 0xD3, 0xA1, 
+0x98, 0x65, 0x6D, 0x51, 0x4C, 0x6C, 0x02, 0x53, 
+0xF6, 0xF0, 0xDC, 0x79, 0xA8, 0xA1, 0x22, 0x49, 
+0x7F, 0x21, 0x84, 0x21, 0x12, 0x26, 0x78, 0x33, 
+0xCC, 0x61, 0x7F, 0xB0, 0x37, 0x6F, 0xDB, 0x5F, 
+0x8B, 0xB2, 0x32, 0x2D, 0xC6, 0x17, 0x49, 0xED, 
+0x45, 0x41, 0x9A, 0x1C, 0xF7, 0x5B, 0x8F, 0xFE, 
+0x15, 0xE5, 0x56, 0xF0, 0x52, 0xB2, 0x81, 0x87, 
+0x65, 0xD6, 0x2B, 0xD1, 0xB6, 0x4C, 0xAE, 0x76, 
+0x02, 0x47, 0xBA, 0x39, 0x98, 0x08, 0xCB, 0x32, 
+0x32, 0xF5, 0x4F, 0xD4, 0x23, 0x8B, 0x85, 0x9C, 
+0x70, 0x38, 0xB3, 0x50, 0xBC, 0x39, 0x9F, 0xDB, 
+0x1F, 0x58, 0x52, 0x66, 0x5F, 0x2D, 0x0D, 0x02, 
+0xF4, 
+
+0x60, 0xB9, 0x61, 0x5D, 0xD7, 0x3F, 0xA7, 0x8B, 
+0x17, 0xA0, 0x1D, 0xFD, 0xEC, 0xBF, 0xEB, 0x6D, 
+0x39, 0x86, 0xF5, 0x18, 0x06, 0x17, 0x3E, 0xCC, 
+0xE5, 0x3C, 0x61, 0xB9, 0x67, 0xD7, 0xF0, 0x42, 
+0xA6, 0xFF, 0xCE, 0x75, 0x5B, 0x13, 0x4D, 0xB5, 
+0xA2, 0xCC, 0xE9, 0x67, 0xBC, 0x16, 0x18, 0x0A, 
+0x40, 0xA2, 0xAE, 0xF9, 0x1A, 0x02, 0x7A, 0xE4, 
+0x5D, 0xB9, 0x8C, 0x3F, 0x3F, 0xD5, 0x90, 0xD2, 
+0x66, 0x38, 0x37, 0x44, 0x68, 0xBF, 0xC6, 0x02, 
+0xFE, 0xF9, 0x50, 0x5D, 0x01, 0x4E, 0x8F, 0x07, 
+0x82, 0x66, 0x05, 0x36, 0x27, 0xC4, 0x12, 0xE1, 
+0xBB, 0x86, 0x17, 0xB0, 0xDB, 0x53, 0xCF, 0x15, 
+0xE6, 0x64, 0x6A, 0x7B, 0x07, 0xF4, 0xB8, 0x1D, 
+0x96, 0x1F, 0xA2, 0x12, 0xF6, 0x77, 0xF1, 0x68, 
+0xD6, 0x26, 
+
+0xC3, 0x7D, 0xE3, 0xF5, 0x92, 0xDC, 0x47, 0x30, 
+0x53, 0xDF, 0x9C, 0x67, 0x2A, 0x69, 0xE7, 0xF6, 
+0xB1, 0xA1, 0xF6, 0x0D, 0x67, 0x38, 0xBB, 0xE8, 
+0xE4, 0x67, 0xD2, 0xFA, 0xCD, 0x9D, 0xFA, 0x4B, 
+0xAB, 0x9D, 0xDA, 0x82, 0x21, 0x2E, 0x14, 0xDE, 
+0x2A, 0x77, 0xC2, 0x22, 0x88, 0x9B, 0x0D, 0x64, 
+0xE3, 0xEC, 0xAC, 0xA2, 0x3B, 0x0E, 0xA7, 0xCA, 
+0xB2, 0x0F, 0xB8, 0x1D, 0x80, 0x25, 0x76, 0x06, 
+0x62, 0xFC, 0x4F, 0xCB, 0x75, 0xB3, 0xCB, 0x99, 
+0xBA, 0x1B, 0xB8, 0x0D, 0xAD, 0x26, 0x30, 0xB3, 
+0x9C, 0xBF, 0x46, 0x32, 0x9F, 0x7B, 0xFC, 0xA0, 
+0xA7, 0x4D, 0xDE, 0xA0, 0x7D, 0x9E, 0x75, 0xCD, 
+0x8B, 0x4E, 0x86, 0xA4, 0x96, 0x1A, 0x61, 0x0F, 
+0x72, 0x91, 0x3C, 0x59, 0x62, 0x66, 0x1C, 0x45, 
+0x66, 0xB2, 0xA0, 
+
+0x82, 0xA5, 0x1C, 0x3A, 0xE9, 0xB2, 0xE7, 0x0F, 
+0x17, 0xA9, 0xA9, 0xFE, 0x55, 0xC9, 0x2C, 0xD9, 
+0x56, 0x62, 0xC9, 0x98, 0x76, 0x1A, 0x35, 0x18, 
+0x3C, 0xC4, 0xC9, 0xA1, 0x2E, 0x36, 0x76, 0x55, 
+0xCF, 0xA5, 0xC6, 0x72, 0x88, 0xA1, 0x65, 0x2C, 
+0x0C, 0x4C, 0x0F, 0xC2, 0xF1, 0xFE, 0x1E, 0xEC, 
+0x04, 0x75, 0x18, 0xDA, 0xEC, 0x42, 0x32, 0x7A, 
+0x55, 0x70, 0xE6, 0x3D, 0x58, 0x62, 0x6B, 0x7D, 
+0xE5, 0x74, 0x13, 0x5D, 0xE9, 0xFA, 0x60, 0xA4, 
+0xB0, 0x1D, 0xB0, 0x78, 0x86, 0x53, 0xB6, 0xE5, 
+0x8E, 0xA8, 0x1E, 0xB9, 0xCE, 0x31, 0x77, 0x16, 
+0xF3, 0x81, 0xD9, 0x8F, 0x4A, 0x9F, 0xC4, 0x98, 
+0x1F, 0x6E, 0xE2, 0x8B, 0x9C, 0x81, 0xA1, 0x74, 
+0x1D, 0x49, 0xE7, 0x15, 0x41, 0x49, 0xDA, 0x7F, 
+0xB3, 0xF2, 0x0B, 0x23, 
+
+0xF4, 0xA5, 0x13, 0x47, 0x6F, 0xD2, 0x3A, 0xA4, 
+0xAE, 0x91, 0x69, 0xDC, 0x50, 0xF9, 0xD3, 0x11, 
+0x89, 0x0D, 0x2C, 0x99, 0xA5, 0x5F, 0xC3, 0x02, 
+0x79, 0xFA, 0xDF, 0x82, 0x4F, 0x83, 0x7A, 0x04, 
+0xE4, 0x81, 0x12, 0x78, 0x69, 0x08, 0xF9, 0xD2, 
+0xD7, 0x86, 0xDF, 0x15, 0x96, 0xF2, 0x76, 0xFD, 
+0x6C, 0x89, 0x0D, 0xFC, 0x74, 0x12, 0x24, 0x63, 
+0xF1, 0x54, 0x75, 0x95, 0x2E, 0xDF, 0x85, 0xE6, 
+0xCE, 0xB7, 0xFE, 0xA8, 0x6D, 0x29, 0xF6, 0xDA, 
+0xEB, 0xA8, 0x17, 0xA0, 0x01, 0x91, 0x98, 0xEF, 
+0x21, 0xAF, 0xDA, 0x68, 0x8E, 0xB0, 0x0A, 0xF0, 
+0xD3, 0xD3, 0x4F, 0x03, 0x21, 0x63, 0x8A, 0x4C, 
+0x04, 0x0D, 0x21, 0x55, 0xD8, 0x4B, 0xE8, 0x28, 
+0x43, 0x59, 0x74, 0xC2, 0x88, 0x68, 0x1C, 0xB6, 
+0x91, 0x51, 0x69, 0x33, 0x64, 
+
+0xEA, 0xA8, 0xEE, 0xBC, 0xA2, 0x36, 0xFF, 0xE0, 
+0x4F, 0xCA, 0xFA, 0x89, 0x24, 0x17, 0x02, 0x16, 
+0xDB, 0x80, 0xC4, 0xB9, 0x21, 0xDA, 0x0F, 0x73, 
+0xF8, 0x28, 0x93, 0xEF, 0x0C, 0x00, 0x95, 0x3A, 
+0x3F, 0x6E, 0x1A, 0x6D, 0x6F, 0xDD, 0xBC, 0x70, 
+0xEF, 0x7F, 0x34, 0x04, 0x76, 0x7B, 0x42, 0x0B, 
+0x4E, 0x66, 0xCC, 0x41, 0xE2, 0xC5, 0xF9, 0xE0, 
+0xF4, 0x1D, 0xB5, 0x59, 0x5F, 0x5B, 0x15, 0xC1, 
+0x22, 0x43, 0xCD, 0x01, 0xF1, 0x44, 0x8E, 0x8C, 
+0xC2, 0x9D, 0xEB, 0x79, 0x38, 0xE3, 0x9F, 0x0F, 
+0x75, 0xA3, 0x9E, 0xD0, 0x11, 0x08, 0x5F, 0x94, 
+0x52, 0xA3, 0x29, 0xDA, 0x11, 0x25, 0xE5, 0x15, 
+0x2B, 0x4E, 0xE0, 0xF8, 0xDB, 0x3A, 0xC7, 0x92, 
+0xA2, 0x36, 0x4E, 0xC8, 0x01, 0x3B, 0x15, 0xB1, 
+0x03, 0x6B, 0x3D, 0x2E, 0xB0, 0xF9, 
+
+0x7F, 0x1B, 0x2F, 0xE2, 0x66, 0x7E, 0xBC, 0x45, 
+0x2D, 0x6A, 0xF2, 0xF7, 0xC3, 0x06, 0xC7, 0x6F, 
+0xA5, 0x35, 0xDE, 0x73, 0x26, 0xC3, 0x54, 0x6E, 
+0x16, 0x9D, 0x0E, 0x4C, 0x30, 0x9F, 0xFC, 0x68, 
+0x10, 0x82, 0x80, 0xCD, 0x69, 0x80, 0x24, 0x96, 
+0xC6, 0x84, 0x0F, 0x34, 0x7A, 0x54, 0x51, 0x22, 
+0x11, 0x8F, 0x75, 0x65, 0x5F, 0x87, 0x1C, 0xC8, 
+0x3F, 0x1E, 0x2D, 0xB8, 0x30, 0xA3, 0xE1, 0x7D, 
+0x35, 0x12, 0xAF, 0x04, 0x1E, 0xB2, 0x78, 0x40, 
+0x0C, 0x45, 0x35, 0x88, 0xF8, 0x89, 0xE1, 0x8E, 
+0x37, 0x94, 0xBE, 0xC6, 0x30, 0x84, 0xDC, 0x9A, 
+0x65, 0xAA, 0x02, 0xFA, 0x8D, 0xF6, 0x05, 0x10, 
+0xC4, 0x71, 0xA2, 0x28, 0xC2, 0x9A, 0x24, 0x8F, 
+0x29, 0xA5, 0x06, 0x5D, 0xA6, 0xEC, 0xB5, 0xAD, 
+0x48, 0x4E, 0x5E, 0xEE, 0x0A, 0x52, 0x89, 
+
+0x78, 0x06, 0x36, 0x15, 0x0D, 0x21, 0xEC, 0xA9, 
+0x41, 0x7B, 0xD3, 0xE6, 0x4C, 0xC8, 0x8E, 0x0E, 
+0x5A, 0xE1, 0x42, 0xE3, 0x41, 0x5C, 0x0D, 0x31, 
+0x78, 0x8D, 0x13, 0x5A, 0x24, 0x79, 0x5D, 0x68, 
+0x1A, 0x0A, 0x62, 0x02, 0x70, 0x3A, 0xEB, 0xDB, 
+0xC3, 0xF2, 0xD6, 0x0B, 0x6F, 0x36, 0x5B, 0xFD, 
+0x02, 0xF5, 0x7B, 0x42, 0x9F, 0xE5, 0x8A, 0x08, 
+0xA6, 0x4B, 0x64, 0x1E, 0xE2, 0x02, 0xDD, 0x30, 
+0x16, 0x8D, 0xAF, 0x3E, 0x24, 0x1C, 0x98, 0x49, 
+0x58, 0x10, 0xB4, 0x1D, 0x76, 0x8E, 0xDB, 0x7A, 
+0x63, 0xC4, 0x12, 0x93, 0x83, 0x28, 0xE1, 0x27, 
+0xAC, 0x31, 0xC2, 0x91, 0x01, 0x70, 0xEA, 0xCB, 
+0xA1, 0x7E, 0xD8, 0xCD, 0x09, 0x4D, 0x66, 0xCF, 
+0x97, 0x05, 0x0A, 0xFB, 0x96, 0x0B, 0x3E, 0x21, 
+0x0C, 0x3D, 0xD0, 0xCD, 0xBA, 0xD5, 0x7A, 0xC3, 
+
+0xB5, 0x21, 0xF2, 0xF6, 0x9A, 0xCE, 0xDE, 0xC0, 
+0x5B, 0xB0, 0x7A, 0xDA, 0x3A, 0x44, 0x35, 0x8E, 
+0xBF, 0xF8, 0x97, 0x72, 0x3C, 0x28, 0xBD, 0x99, 
+0x5A, 0x5E, 0xDE, 0x1C, 0x66, 0x82, 0x5D, 0x2A, 
+0xBE, 0xB1, 0xD7, 0xA5, 0xE2, 0x58, 0xF8, 0x39, 
+0xD8, 0xC1, 0x3D, 0x06, 0x82, 0xE4, 0x74, 0xBD, 
+0xAB, 0xB6, 0x4F, 0xEA, 0x2F, 0xFA, 0x47, 0x43, 
+0x36, 0x37, 0x31, 0x75, 0x16, 0xFF, 0x35, 0x99, 
+0x73, 0x75, 0x97, 0x33, 0x9C, 0xDE, 0xCD, 0xB8, 
+0x7D, 0xD2, 0xAA, 0x91, 0x67, 0xF9, 0x1C, 0x72, 
+0x6C, 0xDE, 0xA6, 0x82, 0xEB, 0xA5, 0x62, 0x3E, 
+0x18, 0x77, 0x45, 0xFF, 0x47, 0x5B, 0xDE, 0x3B, 
+0x30, 0x64, 0x6C, 0xDB, 0x5C, 0x74, 0x0E, 0xE8, 
+0x87, 0xA4, 0xED, 0xF5, 0x88, 0xD0, 0x91, 0xC4, 
+0xA5, 0xE0, 0x4E, 0x1C, 0x7B, 0x6A, 0xF0, 0xEC, 
+0x49, 
+
+0x9F, 0xD1, 0x3A, 0x1E, 0x83, 0x9A, 0xB9, 0xEE, 
+0x07, 0xE7, 0xBE, 0x7F, 0x21, 0xE4, 0xB8, 0xF2, 
+0x6A, 0x36, 0x01, 0x3A, 0x7A, 0x88, 0x45, 0xA6, 
+0x0B, 0x1E, 0x61, 0x70, 0xBE, 0x1B, 0x0F, 0x07, 
+0xCA, 0x87, 0x0B, 0xD5, 0xE5, 0x16, 0x8E, 0x7B, 
+0xDE, 0xB9, 0xCD, 0x81, 0xBD, 0x3D, 0x8E, 0x44, 
+0x7F, 0xC7, 0x95, 0x40, 0x1D, 0x44, 0xE5, 0x66, 
+0x45, 0x0E, 0xC3, 0xF2, 0x88, 0x71, 0x95, 0x0F, 
+0x2F, 0xF4, 0x6D, 0xDA, 0x88, 0x31, 0x64, 0x26, 
+0xC8, 0x2A, 0xDD, 0xF6, 0x88, 0x1C, 0x6B, 0xD6, 
+0xD0, 0xA9, 0x81, 0xA4, 0x0D, 0xC4, 0x5F, 0x48, 
+0x18, 0xED, 0x47, 0xA2, 0x0C, 0xB5, 0xDA, 0x18, 
+0x42, 0x60, 0xCE, 0xEB, 0xD9, 0x19, 0x8C, 0xE5, 
+0x54, 0x8C, 0x7B, 0x31, 0x0C, 0xAE, 0x97, 0x18, 
+0xAB, 0x68, 0x8C, 0x3F, 0x77, 0xF8, 0xF4, 0x7A, 
+0xC8, 0x82, 
+
+0x68, 0x0E, 0xC9, 0x16, 0x9A, 0xC2, 0x3E, 0xEF, 
+0x7A, 0xCA, 0x3C, 0x1E, 0xF5, 0x39, 0xDA, 0xCF, 
+0xB6, 0x96, 0x76, 0xB7, 0xE1, 0x70, 0x7F, 0xB3, 
+0x64, 0x1F, 0x98, 0x9E, 0x30, 0x4B, 0x37, 0xB4, 
+0x24, 0xA0, 0xD7, 0x23, 0x0A, 0x80, 0x82, 0xA8, 
+0xC8, 0x44, 0xB5, 0x64, 0x2E, 0x0A, 0xAA, 0x66, 
+0x61, 0x35, 0x78, 0x85, 0x4A, 0xC8, 0x0F, 0xC8, 
+0xEA, 0xA8, 0x80, 0xD9, 0xCA, 0x7D, 0xDC, 0x5F, 
+0xA7, 0xFC, 0xE6, 0xA3, 0xCE, 0xBB, 0x18, 0x92, 
+0xF2, 0xB0, 0x3A, 0x68, 0x1A, 0x14, 0xF9, 0x73, 
+0x1F, 0x23, 0x7C, 0x5A, 0x15, 0x06, 0x06, 0x8E, 
+0x8E, 0x8F, 0xE3, 0xCF, 0x24, 0x5E, 0xE6, 0xD1, 
+0xE8, 0x26, 0x3B, 0xE3, 0x81, 0x9E, 0x68, 0x8D, 
+0x5D, 0x89, 0xC9, 0x05, 0x04, 0xF3, 0x3A, 0x7F, 
+0x93, 0x4B, 0xCE, 0x40, 0x08, 0x3D, 0x54, 0x3A, 
+0x93, 0xDA, 0xA1, 
+
+0xDE, 0x05, 0x6A, 0xEE, 0xB3, 0xCB, 0xED, 0x42, 
+0x22, 0xEB, 0x2E, 0x64, 0x5C, 0xB4, 0xB7, 0x91, 
+0x3D, 0x36, 0x0D, 0xD1, 0xCF, 0xD1, 0x21, 0x65, 
+0x67, 0xE2, 0xD8, 0xDB, 0x94, 0x1B, 0x77, 0x71, 
+0xC0, 0x4D, 0x16, 0x91, 0x2F, 0xF5, 0x19, 0x04, 
+0xDE, 0xC5, 0x95, 0xAC, 0x70, 0xAD, 0xD4, 0xB1, 
+0xB3, 0x88, 0xF9, 0xF4, 0xB3, 0x3C, 0xDA, 0x26, 
+0x14, 0x11, 0x67, 0x6A, 0x65, 0x2E, 0xDA, 0x0B, 
+0xBF, 0xE5, 0x0D, 0xB2, 0x57, 0xC1, 0x22, 0x02, 
+0x5A, 0xF7, 0x72, 0x47, 0xD5, 0x4F, 0x08, 0x63, 
+0xE8, 0xDB, 0x66, 0xF8, 0xD3, 0xDB, 0xF3, 0x5D, 
+0xAB, 0xDD, 0xD9, 0xFD, 0x75, 0xA3, 0x26, 0x37, 
+0xBE, 0x3D, 0x7F, 0x9C, 0x66, 0xB0, 0xFA, 0x35, 
+0x19, 0x4B, 0x5E, 0xE6, 0xEB, 0x30, 0xE7, 0x81, 
+0xDF, 0x4C, 0x44, 0x1D, 0xBB, 0x89, 0xD7, 0x4B, 
+0x39, 0xAF, 0x59, 0x97, 
+
+0x2F, 0xB8, 0xA3, 0x2C, 0xBC, 0xBA, 0x17, 0x3D, 
+0xFD, 0x34, 0xD2, 0xCE, 0xBA, 0x6E, 0x9C, 0x89, 
+0x17, 0x04, 0x22, 0x53, 0x1E, 0x64, 0xC7, 0xCB, 
+0x95, 0xD3, 0x1C, 0xA2, 0xD3, 0x3A, 0xBD, 0x5A, 
+0x13, 0xA9, 0x0B, 0xEC, 0xDF, 0x15, 0xB3, 0x07, 
+0x6A, 0x7D, 0x76, 0x90, 0xEE, 0x41, 0x14, 0x02, 
+0x30, 0xA9, 0xEB, 0xCB, 0x24, 0x40, 0xE7, 0xC2, 
+0xFB, 0x1A, 0xC0, 0x57, 0x9E, 0xDA, 0xD2, 0x5E, 
+0x37, 0x21, 0xB6, 0xAB, 0x7A, 0x5B, 0x17, 0x3A, 
+0x44, 0x6A, 0x53, 0xF4, 0x75, 0x26, 0x1A, 0xFE, 
+0xC7, 0x87, 0x72, 0x87, 0x98, 0x63, 0x27, 0xA3, 
+0xD8, 0xD3, 0x92, 0x59, 0xDD, 0xFD, 0xCF, 0xF1, 
+0xEC, 0x63, 0xAB, 0x7D, 0xD2, 0xBB, 0xB0, 0x0E, 
+0x5F, 0xDA, 0x8B, 0x96, 0x01, 0xBF, 0x72, 0x16, 
+0xF3, 0xA4, 0x91, 0x88, 0x8A, 0x79, 0x87, 0x7F, 
+0x8E, 0x0C, 0x29, 0x26, 0x43, 
+
+0xC1, 0x0C, 0xDE, 0x47, 0x12, 0x1D, 0x85, 0x2E, 
+0x06, 0x1D, 0x82, 0xD8, 0xAE, 0x71, 0x4D, 0x11, 
+0x76, 0x35, 0xA4, 0x43, 0x62, 0x77, 0x3F, 0x1C, 
+0xD5, 0xDE, 0x22, 0x4E, 0x93, 0x06, 0x5A, 0xAE, 
+0x3C, 0x4A, 0x3D, 0x63, 0x45, 0xD8, 0x72, 0xB4, 
+0xA0, 0x55, 0x5A, 0x69, 0x78, 0x27, 0x10, 0xDE, 
+0xE7, 0x0E, 0x93, 0x1B, 0xB0, 0xB0, 0x30, 0xB0, 
+0x47, 0x14, 0x57, 0xD6, 0x90, 0x92, 0x3B, 0x31, 
+0xEB, 0x4A, 0xBF, 0xD2, 0xD0, 0x36, 0x11, 0x0A, 
+0x26, 0x58, 0x55, 0xEA, 0xFE, 0xCB, 0x2D, 0x0E, 
+0x18, 0xA7, 0x79, 0x86, 0x4F, 0x9C, 0x0C, 0xA9, 
+0x9A, 0xD3, 0xEF, 0x4E, 0x5E, 0xEB, 0x3A, 0x99, 
+0xDE, 0xD5, 0x42, 0xE8, 0xAA, 0x6B, 0xED, 0xB3, 
+0xAD, 0x14, 0x2C, 0x8A, 0x9D, 0xE7, 0x1F, 0x8D, 
+0x07, 0x57, 0x5A, 0x4D, 0x59, 0x2B, 0x24, 0x69, 
+0x50, 0xCF, 0x1A, 0x91, 0xF3, 0xA6, 
+
+0xAC, 0xF6, 0xEB, 0x09, 0x8F, 0x8F, 0x41, 0x7D, 
+0x5B, 0xC6, 0x76, 0xB3, 0xBE, 0xCD, 0x18, 0x85, 
+0x23, 0x4E, 0xF0, 0xA7, 0x5F, 0x60, 0xED, 0x10, 
+0x0D, 0x36, 0x26, 0x2E, 0xF4, 0x11, 0xDA, 0x95, 
+0x76, 0x3B, 0x04, 0xF3, 0x73, 0xA1, 0xD9, 0xCE, 
+0x97, 0xBD, 0x10, 0xD9, 0x5D, 0x91, 0x4D, 0x13, 
+0x2A, 0x4A, 0x74, 0xF5, 0x6D, 0xE3, 0xF9, 0xBD, 
+0xBA, 0x33, 0x48, 0xBF, 0x78, 0x57, 0xD0, 0x81, 
+0x58, 0x02, 0xDD, 0x4E, 0x39, 0xD9, 0x4F, 0x87, 
+0xFC, 0x4B, 0xAA, 0x57, 0x82, 0x87, 0xA1, 0x5A, 
+0x16, 0xB9, 0xEF, 0x98, 0xBD, 0x9F, 0x30, 0xB8, 
+0x5D, 0x4E, 0xCD, 0x61, 0x73, 0xB3, 0x28, 0xC9, 
+0xF3, 0xE6, 0x49, 0x2A, 0x12, 0x1F, 0x88, 0x35, 
+0xD5, 0x78, 0x38, 0xB7, 0xCE, 0x30, 0x49, 0x12, 
+0xE7, 0xD1, 0x10, 0x2A, 0x91, 0xB9, 0x7D, 0xF9, 
+0xE8, 0x55, 0xD5, 0xB3, 0x2E, 0x53, 0xDA, 
+
+0x91, 0x05, 0x33, 0x85, 0x75, 0xA4, 0x8E, 0xA9, 
+0x27, 0x21, 0xCE, 0x1B, 0x5A, 0xAD, 0x6B, 0x7E, 
+0xC4, 0xA9, 0x65, 0x1B, 0x07, 0x21, 0xA6, 0x15, 
+0x17, 0x40, 0x2E, 0x17, 0xB5, 0xAC, 0xE9, 0xDC, 
+0x71, 0x5E, 0x83, 0xFC, 0x2B, 0xEC, 0xAF, 0xBD, 
+0xA8, 0x4D, 0x5C, 0x7A, 0xB9, 0xF2, 0x1E, 0x90, 
+0x10, 0xB6, 0x76, 0x77, 0x02, 0xB7, 0x1F, 0x15, 
+0xB4, 0xA9, 0x9E, 0xDE, 0x3D, 0x4A, 0xD6, 0x08, 
+0x6E, 0xFE, 0x9F, 0xA4, 0xC4, 0x44, 0x7D, 0xFF, 
+0xF4, 0x04, 0x7D, 0xEA, 0x40, 0x06, 0xC9, 0x87, 
+0xEA, 0x9C, 0xF3, 0x49, 0x9B, 0x70, 0x04, 0x1F, 
+0x5F, 0xCE, 0x61, 0xF6, 0x19, 0x0B, 0x56, 0x63, 
+0x16, 0x98, 0x3D, 0xE9, 0xF1, 0xD7, 0xEB, 0x07, 
+0xD1, 0x3C, 0xF2, 0x53, 0x61, 0x63, 0x66, 0x4E, 
+0x75, 0x4A, 0x60, 0xA4, 0xDE, 0xBA, 0xE5, 0xF6, 
+0x85, 0xE3, 0x0D, 0x98, 0xF3, 0x3A, 0xC8, 0xC6, 
+
+0xB3, 0x4F, 0xE6, 0x14, 0x87, 0x0C, 0xD3, 0xCD, 
+0x24, 0x42, 0x1B, 0x44, 0x41, 0x48, 0xC1, 0xF8, 
+0x1C, 0xA5, 0xD4, 0x15, 0x6A, 0x66, 0x5B, 0x13, 
+0xBB, 0x2F, 0x06, 0x36, 0x92, 0xBE, 0x8C, 0x4B, 
+0xE7, 0x03, 0xDC, 0xF4, 0x4D, 0xBB, 0xCA, 0x54, 
+0xA4, 0xCC, 0x57, 0xB6, 0x22, 0xC9, 0x75, 0x48, 
+0xFA, 0x9B, 0xBE, 0x8D, 0x28, 0xA1, 0x3A, 0x55, 
+0x9F, 0xF8, 0x7F, 0x15, 0x17, 0x63, 0xE9, 0x05, 
+0x84, 0xAA, 0x62, 0x06, 0xC5, 0x38, 0xD9, 0x62, 
+0x00, 0x35, 0xBF, 0xAA, 0xC3, 0x00, 0x4F, 0xD5, 
+0xBA, 0x60, 0x57, 0x03, 0x33, 0x5B, 0xDB, 0x92, 
+0xA4, 0x5F, 0x73, 0x11, 0xB3, 0x22, 0xC7, 0xEC, 
+0x20, 0x79, 0xC6, 0x61, 0x16, 0x36, 0x45, 0x05, 
+0x48, 0x2D, 0x38, 0xC0, 0x05, 0x6B, 0x80, 0xB9, 
+0x1E, 0x16, 0xB5, 0x6A, 0x11, 0x54, 0xA2, 0x62, 
+0xFB, 0xE9, 0x89, 0x0E, 0x43, 0x2E, 0x12, 0x1E, 
+0x6B, 
+
+0x93, 0xFB, 0xEC, 0x8E, 0x67, 0x64, 0x56, 0x7A, 
+0x5E, 0x97, 0x15, 0xCB, 0x6A, 0x86, 0xD8, 0xDA, 
+0xC3, 0x6E, 0xF9, 0xD3, 0xD7, 0xF7, 0x9A, 0xC8, 
+0x4F, 0xAF, 0xB6, 0xEC, 0x12, 0x2B, 0x7F, 0x1D, 
+0x0D, 0x11, 0xE5, 0x09, 0x6C, 0xE9, 0x12, 0x19, 
+0xD0, 0x7D, 0x08, 0x96, 0x8E, 0xEC, 0x94, 0x29, 
+0x44, 0xE0, 0x2E, 0x36, 0x95, 0x45, 0xED, 0x05, 
+0x28, 0xF6, 0x7A, 0xEE, 0x00, 0xEB, 0x77, 0x1D, 
+0x6A, 0xA4, 0x14, 0x6D, 0x4A, 0xB8, 0x54, 0xA6, 
+0x59, 0x43, 0xF8, 0x34, 0x28, 0x0A, 0xE5, 0xDD, 
+0xDD, 0xCB, 0xB9, 0x1F, 0x24, 0xDE, 0xF7, 0xB0, 
+0xC0, 0xDB, 0x4E, 0x0C, 0xE5, 0x91, 0x20, 0x4D, 
+0x9D, 0xBD, 0xDD, 0x41, 0xAD, 0x58, 0x90, 0xC4, 
+0x84, 0xFA, 0x10, 0x27, 0x24, 0x97, 0xEA, 0x4D, 
+0xC8, 0x56, 0xB4, 0x18, 0x90, 0xFA, 0x16, 0x0F, 
+0xDF, 0xF5, 0xC0, 0x07, 0x83, 0x1E, 0xAF, 0xBD, 
+0x6C, 0x11, 
+
+0x7C, 0x12, 0xAB, 0x24, 0x43, 0x33, 0x68, 0xBE, 
+0x05, 0x31, 0xA1, 0x39, 0x87, 0x5D, 0xBE, 0x12, 
+0x25, 0x76, 0x42, 0x1A, 0x94, 0xF7, 0xED, 0x0A, 
+0xE8, 0x84, 0xC2, 0x0F, 0xCC, 0x90, 0xB8, 0x93, 
+0xD7, 0xF1, 0xD6, 0x3C, 0xBC, 0xE7, 0xDC, 0x27, 
+0xD6, 0x5B, 0x56, 0x6A, 0xFF, 0xA7, 0xA0, 0x7C, 
+0xCD, 0x74, 0xB6, 0xA5, 0x9F, 0xC0, 0xD2, 0x07, 
+0x96, 0x30, 0x4B, 0x5A, 0xE3, 0xC9, 0x80, 0x92, 
+0x5D, 0x32, 0xE2, 0x31, 0x94, 0xF4, 0x38, 0xB7, 
+0xE8, 0xAA, 0x70, 0x7A, 0x9D, 0xCB, 0x1F, 0x02, 
+0x20, 0x7B, 0x0A, 0xA7, 0x4E, 0xD8, 0xA7, 0xA1, 
+0xB3, 0x64, 0xCA, 0xC6, 0x99, 0x46, 0xFC, 0x3C, 
+0x1D, 0xB1, 0xE5, 0xEC, 0x9C, 0x9B, 0xFC, 0xCC, 
+0x14, 0x78, 0xEF, 0xB6, 0x85, 0x65, 0xAF, 0x8B, 
+0xCF, 0x07, 0xFD, 0x7D, 0x21, 0x8A, 0xD4, 0x3A, 
+0x62, 0x0D, 0x80, 0x39, 0x08, 0x87, 0x02, 0x7E, 
+0x3E, 0x22, 0x76, 
+
+0xF8, 0xF7, 0x3B, 0xF6, 0xE2, 0x7F, 0x36, 0x4F, 
+0xFE, 0x84, 0xFA, 0xD6, 0xFD, 0x1C, 0x2C, 0x1D, 
+0x99, 0x1B, 0xF9, 0x65, 0xCA, 0x85, 0x10, 0x2F, 
+0xE5, 0xEA, 0xE9, 0xC0, 0xFE, 0x7E, 0xA9, 0x30, 
+0xD0, 0xE3, 0x54, 0x60, 0xEF, 0x65, 0x3A, 0x0E, 
+0x4F, 0xA6, 0xF9, 0x29, 0xF0, 0x12, 0x3A, 0x39, 
+0xDA, 0x90, 0x23, 0x6D, 0x66, 0x95, 0xB2, 0xEB, 
+0x7F, 0xD6, 0x34, 0x34, 0x1F, 0x9C, 0xB9, 0x09, 
+0x17, 0x1A, 0x44, 0x89, 0xBC, 0x8E, 0x52, 0x9B, 
+0x56, 0x06, 0xB2, 0x66, 0xD4, 0x9F, 0x91, 0x22, 
+0xE8, 0x4F, 0x25, 0x5E, 0x8E, 0x94, 0x72, 0xA5, 
+0x5D, 0xEE, 0xEF, 0xB7, 0x10, 0xC3, 0xF0, 0xA5, 
+0x6A, 0x18, 0xF0, 0x66, 0x6E, 0x53, 0x3C, 0xD1, 
+0x62, 0x37, 0x2B, 0xC8, 0x10, 0x7D, 0x1A, 0x1B, 
+0x43, 0x5E, 0x17, 0xB1, 0x00, 0x57, 0x3D, 0x96, 
+0x51, 0x50, 0xB2, 0xD5, 0x43, 0x6B, 0x2F, 0x43, 
+0xC0, 0xDE, 0x0A, 0x9E, 
+
+0xE9, 0xC3, 0x45, 0xCA, 0x61, 0xDD, 0xDF, 0x48, 
+0x4B, 0x7C, 0x29, 0x91, 0xA4, 0xD7, 0x2B, 0x25, 
+0xED, 0x65, 0xD9, 0x8A, 0xB9, 0x85, 0x0A, 0x6E, 
+0x14, 0xFE, 0x06, 0x44, 0x60, 0xC6, 0xFF, 0x17, 
+
+0xC0, 0x2B, 0x63, 0x30, 0xDE, 0xF0, 0xD2, 0x8A, 
+0x58, 0x58, 0x01, 0x6D, 0x85, 0xCD, 0x45, 0xB8, 
+0xC7, 0x7D, 0xC0, 0xFB, 0x57, 0x67, 0x5F, 0x2D, 
+0x9D, 0x40, 0x10, 0x3B, 0x61, 0x1C, 0x07, 0xCF, 
+0x37, 
+
+0xFE, 0x51, 0x9F, 0x86, 0x80, 0xF9, 0x0F, 0x5B, 
+0xD9, 0x62, 0x93, 0x48, 0xD5, 0xC4, 0x15, 0x6D, 
+0x7D, 0x3D, 0x5C, 0x6C, 0x97, 0xB1, 0xD6, 0x41, 
+0x04, 0x1F, 0xC4, 0xA5, 0x3F, 0x21, 0xAF, 0x15, 
+0x96, 0xD9, 
+
+0xFB, 0x6B, 0x87, 0x44, 0x8E, 0xAD, 0x91, 0x65, 
+0x48, 0x5A, 0xC7, 0x07, 0x7C, 0xB1, 0x8A, 0xC1, 
+0xCB, 0xC0, 0x0C, 0x50, 0x97, 0xB5, 0x0A, 0x72, 
+0xBD, 0xCF, 0x05, 0xDF, 0x9C, 0x04, 0xFE, 0x90, 
+0x2B, 0x79, 0x4A, 
+
+0xBC, 0x24, 0x1C, 0x0A, 0x85, 0x14, 0xD3, 0x4A, 
+0x42, 0x3A, 0xF8, 0x3B, 0xE3, 0x4E, 0x77, 0xB7, 
+0x3F, 0x4B, 0x6D, 0x33, 0xD9, 0x89, 0x10, 0x85, 
+0x35, 0xA3, 0x04, 0x93, 0x1A, 0x5A, 0x9C, 0x05, 
+0x78, 0xD5, 0xD4, 0x28, 
+
+0x66, 0x4A, 0xB6, 0x65, 0xFC, 0x90, 0x0E, 0xDC, 
+0x71, 0x5B, 0xE8, 0x82, 0x9D, 0x98, 0xC0, 0x51, 
+0x22, 0xA7, 0x11, 0xCF, 0x1C, 0x74, 0x9A, 0x5E, 
+0xEC, 0x52, 0x38, 0xB0, 0x26, 0x2B, 0x34, 0x73, 
+0x7A, 0xAA, 0xED, 0x55, 0xC2, 
+
+0xBD, 0xE3, 0xC7, 0x0C, 0x2C, 0x49, 0x02, 0x64, 
+0x46, 0x07, 0x9A, 0xFA, 0xD0, 0xD6, 0xA9, 0xE8, 
+0x48, 0x75, 0x46, 0xDF, 0xF1, 0x07, 0x3E, 0x7A, 
+0xE7, 0xEF, 0x1C, 0x62, 0xC4, 0x3B, 0x72, 0x61, 
+0x39, 0xB6, 0xBA, 0xA3, 0x69, 0xC9, 
+
+0xB1, 0xF0, 0xCB, 0x4A, 0x0C, 0xE7, 0x57, 0xC5, 
+0x39, 0xFA, 0x6B, 0x41, 0x23, 0xA8, 0xE0, 0x48, 
+0xD7, 0x01, 0x73, 0x45, 0xC6, 0x71, 0x90, 0x0E, 
+0xC2, 0x16, 0x14, 0x9D, 0xE3, 0x7F, 0xCD, 0x5C, 
+0x0C, 0xF4, 0x7E, 0x5B, 0xF9, 0x3B, 0xD0, 
+
+0xD0, 0x0A, 0xFF, 0x48, 0xF8, 0x5B, 0x3F, 0x93, 
+0xD1, 0xFE, 0x48, 0x24, 0xC9, 0xE7, 0xFE, 0x42, 
+0xD2, 0xD8, 0x2C, 0x2F, 0x8F, 0xCE, 0xFD, 0x32, 
+0xD4, 0xFF, 0x2C, 0x9D, 0x75, 0xFB, 0x36, 0x18, 
+0xB4, 0x53, 0x61, 0xDF, 0xE1, 0x0A, 0xD1, 0xC2, 
+
+0x6D, 0x19, 0xCF, 0x90, 0xF9, 0x47, 0x25, 0x1F, 
+0xC3, 0xC3, 0xC1, 0xD3, 0x8C, 0xDC, 0x47, 0xC9, 
+0xB0, 0xEF, 0x3B, 0x66, 0x6A, 0x7A, 0x3E, 0xEB, 
+0x0E, 0xB3, 0x0D, 0x1A, 0x21, 0x76, 0x0B, 0x24, 
+0x9F, 0xE7, 0xB4, 0x53, 0x61, 0xA3, 0x6C, 0x51, 
+0x7C, 
+
+0xFC, 0x39, 0x53, 0x99, 0x21, 0xD9, 0xFF, 0xD3, 
+0xB0, 0xF6, 0x6E, 0x92, 0x4A, 0x7B, 0x1D, 0xC9, 
+0xA3, 0x3E, 0xE9, 0x21, 0x8A, 0xB0, 0x50, 0xA1, 
+0x01, 0x22, 0x97, 0xCC, 0x7F, 0xFE, 0x32, 0x38, 
+0xA6, 0xA7, 0xF8, 0x68, 0xA1, 0x09, 0xD4, 0x72, 
+0x69, 0xDA, 
+
+0x1E, 0x47, 0x67, 0x1A, 0x76, 0xCB, 0x0C, 0x16, 
+0xE5, 0x40, 0xB3, 0x50, 0x19, 0x82, 0x84, 0x18, 
+0xE8, 0x75, 0x04, 0xDB, 0x8D, 0x39, 0xA0, 0x6A, 
+0xDB, 0x02, 0x82, 0x51, 0xBB, 0x64, 0x77, 0xB6, 
+0x8C, 0x04, 0xDB, 0x64, 0x4B, 0xD0, 0x37, 0x9A, 
+0x9A, 0xD5, 0xE6, 
+
+0x8C, 0x02, 0x42, 0x0C, 0x87, 0x69, 0xBB, 0xD9, 
+0xCC, 0x61, 0x29, 0x0B, 0xE0, 0xA7, 0x9B, 0x29, 
+0x5B, 0x42, 0xE9, 0x10, 0x5C, 0x7F, 0xF7, 0xA0, 
+0xED, 0x73, 0xF2, 0x69, 0x50, 0x18, 0x11, 0x95, 
+0xEE, 0x57, 0xFD, 0x1E, 0xC9, 0x1E, 0x46, 0xDF, 
+0xA9, 0x06, 0xE6, 0x4A, 
+
+0xAD, 0x4A, 0xA4, 0x3B, 0xD8, 0x4A, 0xF2, 0x21, 
+0x12, 0x5C, 0x26, 0xD1, 0xA5, 0xBA, 0x01, 0xF6, 
+0x00, 0xF5, 0x2E, 0xE9, 0xCF, 0xCD, 0xAE, 0xF7, 
+0x85, 0xC4, 0x4A, 0x88, 0x24, 0x2B, 0xAC, 0xCE, 
+0xDB, 0xCB, 0x1D, 0x92, 0x35, 0xE7, 0x9B, 0x25, 
+0x97, 0x28, 0xDC, 0xC2, 0x19, 
+
+0x57, 0xAE, 0x1D, 0x1B, 0x2E, 0x8E, 0xE4, 0x31, 
+0xE8, 0x0F, 0x0D, 0x7F, 0x9F, 0x9B, 0x82, 0x04, 
+0x28, 0xEB, 0xB0, 0x56, 0xBB, 0x4E, 0xD6, 0x87, 
+0xE8, 0x5C, 0x2D, 0x14, 0x0A, 0x2E, 0xF8, 0xB0, 
+0x51, 0x2C, 0x06, 0x08, 0xF6, 0x04, 0xFA, 0xC3, 
+0x1A, 0x18, 0x5E, 0x57, 0xA6, 0x78, 
+
+0x19, 0xE4, 0x7B, 0x97, 0x56, 0x17, 0x87, 0x67, 
+0xE6, 0xAF, 0xA6, 0x93, 0x8E, 0xC3, 0xD2, 0x26, 
+0xCA, 0x2E, 0x8F, 0x36, 0xAF, 0x19, 0x2E, 0x85, 
+0xE5, 0xAB, 0xE5, 0xD9, 0x77, 0xF5, 0x65, 0xA3, 
+0xFC, 0x67, 0x96, 0x92, 0xCA, 0x3A, 0x46, 0xAB, 
+0xB2, 0xD9, 0xD7, 0xD9, 0xAA, 0x10, 0xCF, 
+
+0x9B, 0x4C, 0xFB, 0x27, 0x22, 0x05, 0x24, 0xF9, 
+0x81, 0xAF, 0xE0, 0x8D, 0x8B, 0x87, 0x55, 0x19, 
+0x22, 0xB6, 0x80, 0xF9, 0x64, 0x26, 0x77, 0xA0, 
+0xFE, 0x1B, 0x2F, 0xCB, 0x91, 0x91, 0xFA, 0x3F, 
+0xB0, 0xD8, 0xDA, 0x2F, 0x1E, 0xA7, 0x91, 0xFB, 
+0xEC, 0x9A, 0xF7, 0xEE, 0xC1, 0x6E, 0xF7, 0x21, 
+
+0xC2, 0xE6, 0x0F, 0x98, 0x38, 0xFF, 0x53, 0x54, 
+0x01, 0xFD, 0x05, 0x18, 0x70, 0x67, 0x93, 0xA4, 
+0x47, 0x31, 0xC3, 0xB6, 0x9D, 0x91, 0xCD, 0x05, 
+0xE2, 0x26, 0xC4, 0x21, 0x02, 0xF7, 0x90, 0x67, 
+0xF5, 0xF5, 0x91, 0xD4, 0x78, 0x01, 0x08, 0x4D, 
+0xEE, 0x2D, 0x36, 0x62, 0x5D, 0x20, 0x8F, 0xF5, 
+0x5B, 
+
+0xF9, 0xBD, 0x86, 0x04, 0xBE, 0xC8, 0xA3, 0x30, 
+0x18, 0x45, 0x4E, 0xD1, 0x0A, 0xF0, 0xB6, 0x03, 
+0x03, 0x2F, 0xC5, 0x02, 0x69, 0x19, 0x9F, 0x3A, 
+0xED, 0x8C, 0xA6, 0x36, 0xC8, 0x05, 0xFC, 0xFD, 
+0x2C, 0x16, 0xF2, 0x88, 0x56, 0x5F, 0x56, 0xC7, 
+0x26, 0x6E, 0x34, 0x4B, 0x78, 0xD0, 0x98, 0x44, 
+0x00, 0x28, 
+
+0x41, 0x05, 0x2F, 0x4F, 0x2B, 0x1C, 0x07, 0x42, 
+0x68, 0x47, 0x34, 0xF1, 0xF1, 0xAE, 0xBF, 0xDD, 
+0xB8, 0xF3, 0x9C, 0xBC, 0x26, 0x5B, 0xE5, 0x62, 
+0x77, 0xB7, 0x5F, 0xCB, 0x44, 0x63, 0x9F, 0xDE, 
+0x92, 0xF3, 0x46, 0xB6, 0x9D, 0x36, 0x1B, 0x0E, 
+0xFF, 0xF4, 0xE4, 0x34, 0xE9, 0xB8, 0xFD, 0xF0, 
+0x07, 0xD1, 0x27, 
+
+0x25, 0xDC, 0xF7, 0x63, 0x08, 0x9D, 0x08, 0x1F, 
+0x11, 0xC0, 0xDE, 0x19, 0x77, 0x94, 0x24, 0xE3, 
+0x8A, 0xF9, 0x8D, 0x45, 0x6D, 0xC3, 0xD0, 0x55, 
+0xB8, 0x23, 0xB5, 0x17, 0xCB, 0x08, 0x52, 0x41, 
+0x7F, 0xA2, 0x06, 0x14, 0x7B, 0x89, 0xC5, 0xC1, 
+0x06, 0xC3, 0x58, 0x1A, 0x37, 0x0F, 0xBA, 0x16, 
+0xD2, 0x1A, 0x7F, 0x96, 
+
+0x15, 0x6C, 0xE5, 0x07, 0xA8, 0xFE, 0x01, 0x14, 
+0x62, 0x5E, 0x76, 0x3D, 0x44, 0xC2, 0xB8, 0x51, 
+0x34, 0x5B, 0x0C, 0xFC, 0x23, 0x86, 0x1C, 0x5B, 
+0x03, 0x9B, 0xAC, 0x39, 0x65, 0xC1, 0xBA, 0x1B, 
+0x55, 0x90, 0x03, 0xC8, 0xD0, 0xEA, 0x63, 0x27, 
+0x2B, 0x4F, 0x1A, 0xD5, 0x49, 0x2C, 0xE6, 0x13, 
+0xEB, 0x20, 0x82, 0x45, 0xBF, 
+
+0xF8, 0xAB, 0x9D, 0x76, 0x7C, 0x9A, 0xDD, 0xE5, 
+0xF1, 0x9A, 0xFA, 0x4D, 0x3F, 0xF2, 0x21, 0xE6, 
+0x5E, 0xE9, 0x73, 0x85, 0x5D, 0x99, 0xC9, 0x85, 
+0x62, 0x09, 0xAE, 0xDB, 0x02, 0x14, 0xDA, 0xC5, 
+0xC1, 0x64, 0xC0, 0x56, 0xC7, 0x76, 0x41, 0xE1, 
+0x9F, 0x15, 0x65, 0xE6, 0x7F, 0x87, 0xC4, 0xDF, 
+0xE5, 0xE8, 0x7D, 0x1D, 0x0F, 0xB1, 
+
+0x06, 0x02, 0x27, 0xD6, 0x04, 0xB3, 0x50, 0x95, 
+0x4B, 0x1C, 0x70, 0x0F, 0x9C, 0x8D, 0x11, 0xEE, 
+0x8B, 0x64, 0x40, 0xD5, 0x7D, 0x23, 0x3F, 0x30, 
+0x10, 0x17, 0xD3, 0xD7, 0x44, 0xA3, 0x3D, 0x00, 
+0xF8, 0xFB, 0xDE, 0x2C, 0x15, 0x43, 0xA0, 0x49, 
+0x1A, 0x57, 0x2E, 0xCF, 0x54, 0x0B, 0x71, 0x18, 
+0xCD, 0xC3, 0x2D, 0xE9, 0x44, 0xBB, 0x8C, 
+
+0x9B, 0xDC, 0xD8, 0xA9, 0xC4, 0xCC, 0x56, 0xEC, 
+0xAE, 0x1C, 0xB4, 0x56, 0x1B, 0xCE, 0xF4, 0x73, 
+0xD4, 0x48, 0x1D, 0x3B, 0x69, 0xF4, 0x56, 0x04, 
+0x06, 0xCA, 0x45, 0x3F, 0x29, 0x66, 0xFD, 0xCE, 
+0x7B, 0xFA, 0x82, 0x90, 0x10, 0xD7, 0x21, 0xFB, 
+0x06, 0xAC, 0x3D, 0xF7, 0x3B, 0x5C, 0xC7, 0x4C, 
+0xC2, 0x15, 0xCD, 0x24, 0x47, 0x9A, 0x21, 0xD5, 
+
+0x1E, 0x15, 0x53, 0xE6, 0x16, 0xC0, 0x76, 0x18, 
+0xA7, 0x54, 0x65, 0x4A, 0xCD, 0xAA, 0x4E, 0xDC, 
+0x50, 0x78, 0x24, 0xD7, 0xAB, 0x88, 0xED, 0x96, 
+0xB1, 0x61, 0x22, 0x40, 0x4B, 0x58, 0xD6, 0x87, 
+0xC2, 0x18, 0x09, 0x5A, 0xEC, 0xB0, 0xB1, 0x36, 
+0x47, 0xBD, 0x3E, 0x27, 0xEB, 0x3A, 0xAF, 0x73, 
+0x87, 0x82, 0xE7, 0x25, 0xE1, 0x89, 0xA4, 0x18, 
+0x71, 
+
+0x83, 0xDA, 0xC0, 0xBB, 0x07, 0xC5, 0x8E, 0xA2, 
+0x3A, 0x0A, 0xDF, 0x04, 0x9B, 0x0D, 0x92, 0xC7, 
+0x92, 0xC9, 0x27, 0xE0, 0x81, 0x84, 0x60, 0x7C, 
+0x39, 0x11, 0x21, 0xFF, 0xA1, 0x35, 0x5B, 0xE2, 
+0xE4, 0x8A, 0x57, 0x1F, 0xBA, 0x0E, 0x85, 0xD0, 
+0xAF, 0x1C, 0x5D, 0xF8, 0xC2, 0xC6, 0xED, 0x14, 
+0xDA, 0x14, 0x95, 0x52, 0x4E, 0x54, 0x8E, 0xCC, 
+0xEF, 0xD6, 
+
+0xD4, 0xD3, 0xD2, 0xD4, 0x35, 0xD1, 0x2A, 0xAE, 
+0x51, 0xE9, 0x3B, 0x1F, 0x0F, 0xD3, 0xA5, 0xD8, 
+0xF2, 0x27, 0x87, 0x9D, 0xEC, 0x23, 0x58, 0x51, 
+0x4B, 0xAE, 0x33, 0x29, 0xE0, 0x4A, 0xDD, 0xC1, 
+0x05, 0x70, 0x3E, 0x8F, 0x5B, 0x52, 0xD8, 0xF5, 
+0x61, 0xE1, 0xE4, 0x44, 0xF9, 0x98, 0xED, 0xFB, 
+0xA5, 0x9E, 0xDF, 0xB0, 0xE0, 0x92, 0x91, 0x99, 
+0xEB, 0xDD, 0x8D, 
+
+0x6C, 0x8F, 0xD8, 0xAF, 0x13, 0x42, 0x03, 0x30, 
+0xA2, 0x01, 0x7D, 0xC7, 0x13, 0xB0, 0x62, 0xD6, 
+0x85, 0x6F, 0x6E, 0x96, 0xFF, 0x19, 0xDA, 0x3F, 
+0xD8, 0xDD, 0xAC, 0x8A, 0x53, 0x5A, 0xE4, 0x6F, 
+0x9A, 0xF2, 0xA7, 0xB3, 0x0A, 0xEC, 0x7E, 0x62, 
+0x78, 0xD5, 0x32, 0x0E, 0x57, 0xDB, 0xD4, 0xEB, 
+0x6F, 0x1D, 0x55, 0xFE, 0xCA, 0xD0, 0x5D, 0x31, 
+0x00, 0x2E, 0x17, 0x9C, 
+
+0x35, 0x1C, 0x01, 0x21, 0xBD, 0x11, 0xF9, 0xD7, 
+0x14, 0xE2, 0x4E, 0xC1, 0x27, 0xA1, 0x0F, 0xE3, 
+0x76, 0xFC, 0x7A, 0xF9, 0x95, 0x43, 0x47, 0x53, 
+0x68, 0x2E, 0xBF, 0x79, 0x60, 0x33, 0x24, 0x4A, 
+0x5B, 0x86, 0x73, 0x84, 0xAE, 0xFE, 0x45, 0x39, 
+0x7C, 0x3A, 0x04, 0x66, 0xDF, 0x83, 0xBF, 0x06, 
+0x12, 0x40, 0xE4, 0x12, 0x77, 0x9F, 0xA9, 0xED, 
+0xBC, 0x00, 0xC3, 0x66, 0x62, 
+
+0x34, 0xC2, 0xEC, 0x46, 0xB4, 0x27, 0x96, 0x6F, 
+0xD8, 0xFE, 0x42, 0xBB, 0x8F, 0x31, 0x96, 0xB2, 
+0xED, 0xE5, 0x99, 0x92, 0x9D, 0x30, 0xA0, 0x77, 
+0x1C, 0x3D, 0x61, 0x7A, 0x5D, 0x06, 0xC0, 0x08, 
+0x63, 0x45, 0x86, 0xAD, 0x62, 0x02, 0xF5, 0xA8, 
+0x8E, 0xCC, 0x1D, 0xC6, 0xAA, 0xFA, 0x9F, 0x18, 
+0xD9, 0x3D, 0xAB, 0x7A, 0xD3, 0x6B, 0xBA, 0x3E, 
+0x4D, 0xE2, 0x02, 0x6E, 0x2F, 0x63, 
+
+0xF2, 0xE0, 0x3B, 0x38, 0xAB, 0x6D, 0xEC, 0x2B, 
+0x19, 0x31, 0x8A, 0x66, 0xCF, 0x4E, 0x21, 0x6C, 
+0x88, 0x54, 0x1C, 0xE0, 0x8F, 0x39, 0xF2, 0x69, 
+0x44, 0xB7, 0x74, 0x64, 0x17, 0xA0, 0xC9, 0xAC, 
+0x5B, 0xCE, 0x62, 0x68, 0xB1, 0x11, 0x68, 0xB2, 
+0x08, 0x55, 0x44, 0x0F, 0x28, 0x10, 0xFF, 0xB4, 
+0xBB, 0x70, 0x8E, 0x45, 0xD5, 0x2D, 0xAA, 0x06, 
+0x13, 0xF8, 0xA5, 0x28, 0x9C, 0x71, 0x0C, 
+
+0xB8, 0x00, 0x7F, 0xFA, 0xA9, 0x24, 0xC5, 0x94, 
+0xAC, 0x12, 0x1B, 0xF6, 0xA2, 0x5B, 0xDF, 0xBA, 
+0x67, 0xF6, 0xC5, 0x17, 0x06, 0xE0, 0x59, 0x86, 
+0xBD, 0xF7, 0x1D, 0x88, 0x64, 0x89, 0xF0, 0x91, 
+0x7E, 0x31, 0x44, 0xDF, 0x9B, 0x35, 0xDD, 0x20, 
+0x75, 0x59, 0xE5, 0x09, 0x33, 0x68, 0x5A, 0x03, 
+0x15, 0x2E, 0x2E, 0x04, 0xD1, 0xD9, 0x29, 0xF1, 
+0xAE, 0xFA, 0x00, 0xD0, 0xB2, 0x66, 0xA8, 0x96, 
+
+0xE9, 0xB1, 0x48, 0x63, 0x4B, 0xA2, 0x73, 0x1D, 
+0x76, 0x97, 0xD0, 0xA3, 0x05, 0x48, 0xA3, 0x75, 
+0x2A, 0x50, 0x68, 0x77, 0x14, 0x5F, 0x4B, 0x42, 
+0x6A, 0xA4, 0x09, 0x8C, 0x09, 0x60, 0x47, 0xF9, 
+0x6E, 0xC4, 0x44, 0xF2, 0x50, 0xE0, 0xDD, 0xC6, 
+0xA5, 0xC6, 0x8F, 0xFA, 0xD3, 0xCD, 0xCA, 0x51, 
+0x7D, 0x9D, 0x54, 0x81, 0xFC, 0xFB, 0x92, 0xF8, 
+0xD7, 0x17, 0x77, 0xBA, 0x78, 0x7C, 0xEC, 0x76, 
+0x96, 
+
+0xEB, 0x88, 0xB9, 0x6F, 0x23, 0x17, 0x02, 0x88, 
+0xA0, 0x70, 0x75, 0xBD, 0xD1, 0x91, 0xF5, 0x98, 
+0x5B, 0x49, 0x0F, 0xE2, 0xEB, 0x06, 0x0D, 0x02, 
+0xDC, 0xDD, 0xA1, 0xF4, 0x91, 0x98, 0xCE, 0xFC, 
+0x90, 0x83, 0xE9, 0x18, 0x50, 0x79, 0xB2, 0xEA, 
+0xCF, 0x07, 0x4E, 0xE1, 0x96, 0x21, 0xF6, 0x7B, 
+0x4E, 0x58, 0xE4, 0xCF, 0x16, 0xE2, 0x56, 0x4F, 
+0x32, 0x9A, 0x3D, 0x9D, 0x8D, 0x31, 0x97, 0xE2, 
+0x16, 0xE3, 
+
+0x61, 0x60, 0x2E, 0x26, 0xBE, 0x81, 0xA6, 0x8D, 
+0x52, 0x35, 0xCB, 0x07, 0x6E, 0xD3, 0x5E, 0xC2, 
+0x25, 0xDE, 0x3B, 0xA9, 0xCD, 0x1C, 0x64, 0x82, 
+0x08, 0x8E, 0xFB, 0xF5, 0xE2, 0x09, 0xAE, 0x2F, 
+0xF6, 0x43, 0x16, 0x66, 0x99, 0xAA, 0xAA, 0x73, 
+0x0D, 0xE6, 0xFA, 0xB3, 0x92, 0xA9, 0x62, 0xC7, 
+0x1E, 0x03, 0x8C, 0x75, 0x59, 0x89, 0xD1, 0xDD, 
+0x5B, 0x46, 0x75, 0x8B, 0x2D, 0x15, 0xD6, 0xDC, 
+0xE1, 0x0A, 0xB3, 
+
+0xFC, 0x79, 0x7B, 0x40, 0xF4, 0xE5, 0x20, 0xF0, 
+0x9D, 0xB5, 0x69, 0x12, 0x6B, 0x5E, 0x2C, 0x42, 
+0xDF, 0x00, 0x1C, 0xDD, 0xCE, 0xD9, 0xCF, 0x13, 
+0x1C, 0x7D, 0x68, 0xC1, 0x20, 0x09, 0x2D, 0xE7, 
+0xE8, 0x51, 0xAF, 0x4C, 0x88, 0xC1, 0x84, 0x5C, 
+0x4C, 0x09, 0x6E, 0x82, 0xE9, 0xF2, 0xC6, 0x28, 
+0x97, 0xB2, 0x57, 0x5E, 0x6C, 0x52, 0x9E, 0x11, 
+0x87, 0xD9, 0xE4, 0xC3, 0x91, 0x3B, 0x49, 0x57, 
+0xE9, 0xAC, 0x85, 0x3D, 
+
+0x70, 0x97, 0x29, 0x75, 0x03, 0x50, 0x5D, 0x4F, 
+0x9E, 0x4E, 0xC7, 0x87, 0x4F, 0xA9, 0x56, 0xD2, 
+0xC8, 0x74, 0xB7, 0xE1, 0x38, 0x98, 0x20, 0xDB, 
+0xE8, 0xBA, 0xA5, 0x7A, 0x30, 0x26, 0x68, 0x54, 
+0x5A, 0x5D, 0x7B, 0x87, 0x27, 0x9A, 0xE3, 0x39, 
+0x30, 0x17, 0xE9, 0xC0, 0x0E, 0xA7, 0x6E, 0xC1, 
+0x89, 0x12, 0x6C, 0xBF, 0xB5, 0x14, 0xAB, 0xC9, 
+0xB4, 0x78, 0x7E, 0x55, 0x82, 0x48, 0xEF, 0x50, 
+0xC6, 0xEA, 0x11, 0xB8, 0x8F, 
+
+0x35, 0xA1, 0xED, 0x51, 0x53, 0x77, 0x3F, 0xA1, 
+0xA6, 0x50, 0xCA, 0xB6, 0x85, 0x06, 0xB2, 0x54, 
+0xB3, 0x5D, 0xC8, 0xCE, 0xE2, 0x40, 0xCA, 0xFF, 
+0xD4, 0x0B, 0xE6, 0xB6, 0x8F, 0x2D, 0x52, 0xFC, 
+0x24, 0xB3, 0x45, 0xA2, 0x99, 0xD0, 0xD0, 0xF6, 
+0xE3, 0x5C, 0x42, 0xF4, 0x0B, 0xF4, 0xE8, 0x32, 
+0xA6, 0x58, 0x1D, 0xA5, 0x9B, 0xB5, 0x7F, 0x01, 
+0x67, 0xC6, 0xCC, 0xDB, 0xC0, 0x05, 0x8D, 0xD6, 
+0x08, 0x71, 0x1F, 0x10, 0x8A, 0xD0, 
+
+0xF8, 0x6D, 0x28, 0xE8, 0x5B, 0x99, 0x56, 0xEE, 
+0x97, 0x5A, 0x6F, 0x8B, 0x00, 0x8C, 0x47, 0x95, 
+0x08, 0x30, 0xCA, 0xAB, 0xC0, 0x4C, 0x12, 0xA6, 
+0x2A, 0x4F, 0x2D, 0x6E, 0x8C, 0xD5, 0xDA, 0xB1, 
+0x13, 0xAB, 0xE7, 0x24, 0xA8, 0x10, 0x51, 0xD6, 
+0x81, 0x5A, 0xAF, 0xE1, 0x83, 0x71, 0xF1, 0x64, 
+0xA5, 0x99, 0x0D, 0x86, 0x3C, 0xCB, 0x40, 0xB2, 
+0x8F, 0x8F, 0x23, 0x01, 0xE4, 0x9C, 0xAE, 0x5F, 
+0xEF, 0xA2, 0x8C, 0xC3, 0x6C, 0x38, 0xC4, 
+
+0xFE, 0xC7, 0x48, 0x84, 0xE0, 0x16, 0x61, 0x98, 
+0xE4, 0x88, 0x6B, 0xED, 0x4C, 0x83, 0x28, 0x9D, 
+0x87, 0xE2, 0x4B, 0xF2, 0x17, 0x64, 0x42, 0xAB, 
+0x17, 0x2F, 0xCE, 0x91, 0xB4, 0xA7, 0xBD, 0xF8, 
+0xC5, 0xA0, 0xE8, 0xAB, 0x66, 0x53, 0xEE, 0x0C, 
+0x75, 0xDA, 0x6A, 0xEC, 0x0C, 0xA1, 0x54, 0xD4, 
+0x70, 0x81, 0xBB, 0xE4, 0x62, 0x95, 0x79, 0xCE, 
+0x76, 0x88, 0x93, 0xB9, 0x4D, 0xDD, 0x74, 0x11, 
+0x2E, 0x9E, 0x71, 0x1E, 0x63, 0x9F, 0xB7, 0x5B, 
+
+0xD8, 0xF6, 0x5E, 0x9A, 0x6E, 0x7F, 0xC3, 0x19, 
+0x5D, 0x4A, 0xC7, 0xBD, 0x9D, 0x16, 0xBA, 0x8C, 
+0x41, 0x7E, 0x00, 0xF3, 0x5B, 0x36, 0xB2, 0x3C, 
+0xBD, 0x50, 0xAC, 0x15, 0x1F, 0xF3, 0x9D, 0x1A, 
+0x25, 0xDB, 0x15, 0xE3, 0x40, 0xC9, 0x63, 0xBE, 
+0x1B, 0xE2, 0xA1, 0xE3, 0xC2, 0xD6, 0xB0, 0xB3, 
+0x13, 0x4C, 0x03, 0x27, 0xB7, 0x9D, 0xAF, 0x6B, 
+0x50, 0xAE, 0x26, 0x5A, 0x5A, 0x15, 0x52, 0x73, 
+0x95, 0xFE, 0xF3, 0x84, 0xAB, 0x4A, 0x51, 0x21, 
+0x5D, 
+
+0x95, 0x6F, 0x8F, 0xEE, 0xB2, 0x95, 0xC4, 0x26, 
+0xD7, 0xEA, 0x3E, 0xA1, 0x66, 0xB4, 0x00, 0xE0, 
+0xBB, 0xB9, 0xB7, 0xF9, 0xC0, 0x91, 0xBD, 0xD4, 
+0x20, 0x9D, 0x99, 0x49, 0x9D, 0xE4, 0x99, 0xE8, 
+0x93, 0x59, 0xBD, 0x31, 0x6B, 0xA9, 0x58, 0x17, 
+0x16, 0x9D, 0xA5, 0xD8, 0xB5, 0x12, 0xD0, 0x11, 
+0x8C, 0x52, 0x4A, 0xCA, 0x72, 0x33, 0x66, 0x08, 
+0x21, 0x38, 0x2F, 0x13, 0x79, 0x64, 0x33, 0xA2, 
+0xD3, 0xB0, 0x76, 0x21, 0x3B, 0xEF, 0x9A, 0x74, 
+0xB0, 0x38, 
+
+0xD6, 0xCB, 0x36, 0x65, 0x7E, 0xF6, 0x2D, 0xD4, 
+0xBA, 0xD1, 0x84, 0x2F, 0x11, 0x9F, 0x0F, 0x31, 
+0x94, 0x02, 0xE8, 0xD1, 0x5D, 0x53, 0x05, 0x7D, 
+0xDA, 0xA7, 0x17, 0x67, 0xEA, 0xA5, 0xDA, 0xF2, 
+0xD4, 0x05, 0x5B, 0x3A, 0xF3, 0xD5, 0x39, 0xAA, 
+0xF9, 0xDF, 0x87, 0x9B, 0x60, 0xC9, 0x14, 0xAF, 
+0xA7, 0x20, 0x8C, 0x13, 0x70, 0x27, 0x24, 0xD4, 
+0x02, 0x37, 0x8C, 0x50, 0x49, 0x2D, 0x4A, 0xBC, 
+0xB6, 0x49, 0x4F, 0x7E, 0x01, 0x47, 0x9B, 0x51, 
+0x3E, 0x1F, 0xE0, 
+
+0x64, 0xEA, 0x87, 0x2A, 0x73, 0xA5, 0xB6, 0x71, 
+0x34, 0xEA, 0x25, 0xD0, 0x39, 0x1C, 0x5F, 0x31, 
+0x0C, 0xC4, 0x11, 0x8B, 0xF1, 0x0E, 0x8A, 0x2F, 
+0x41, 0x40, 0xB2, 0x6F, 0x1C, 0x2C, 0x65, 0x9D, 
+0xD0, 0xCB, 0x4A, 0x83, 0x7F, 0x13, 0x52, 0x11, 
+0xED, 0xFA, 0x24, 0x68, 0x1A, 0x5F, 0xB4, 0xDD, 
+0x51, 0x2C, 0x4E, 0x91, 0xEF, 0x8A, 0x4A, 0xEE, 
+0x7A, 0x85, 0xE7, 0xE5, 0x4E, 0xF5, 0x85, 0x44, 
+0x6B, 0x3C, 0x4F, 0x32, 0x90, 0x3F, 0x6F, 0x95, 
+0x36, 0xDD, 0xFD, 0x9B, 
+
+0x6C, 0x9F, 0xF8, 0xA6, 0x35, 0xE4, 0x2B, 0xC0, 
+0x24, 0x17, 0xC2, 0x71, 0xF6, 0x20, 0xD6, 0x50, 
+0x7A, 0xEC, 0x32, 0x50, 0x79, 0x55, 0xFE, 0x23, 
+0x0E, 0xE2, 0x8F, 0xEF, 0xC2, 0xB1, 0x7D, 0xD6, 
+0x6A, 0x6A, 0x02, 0xBE, 0xAD, 0x57, 0x94, 0x43, 
+0x7F, 0xA0, 0x90, 0x2E, 0x1A, 0xC9, 0x84, 0x97, 
+0xD0, 0xC7, 0x7C, 0xB2, 0xD7, 0x44, 0xF7, 0xA9, 
+0xC6, 0x6D, 0x58, 0x42, 0x9B, 0x18, 0x5A, 0xF0, 
+0xD0, 0x69, 0x30, 0x4D, 0xFC, 0x9D, 0x4A, 0x3C, 
+0x59, 0x63, 0xFC, 0xD2, 0xB8, 
+
+0xE1, 0x4A, 0xB9, 0x63, 0x64, 0x8B, 0xD0, 0xEF, 
+0x92, 0x7E, 0xC3, 0xEE, 0xC2, 0x8C, 0x10, 0xB0, 
+0xA2, 0xF0, 0x3B, 0x81, 0xA8, 0xD0, 0x69, 0x66, 
+0x02, 0xB1, 0xE2, 0xC5, 0x44, 0x1E, 0x4D, 0x93, 
+0x64, 0x5B, 0x40, 0xDA, 0xF7, 0x77, 0xA9, 0x3F, 
+0x3C, 0x34, 0xAE, 0xF2, 0x9D, 0xFE, 0x77, 0x34, 
+0x7C, 0x68, 0xA7, 0xCC, 0xF0, 0x37, 0xB8, 0x92, 
+0x69, 0x05, 0x81, 0xE2, 0x18, 0x07, 0x80, 0x27, 
+0x58, 0x97, 0xF0, 0xC3, 0x9A, 0x88, 0x5D, 0x7E, 
+0x14, 0x79, 0x84, 0xB5, 0xEF, 0xE2, 
+
+0x48, 0xE4, 0xA5, 0x8D, 0x80, 0x54, 0x50, 0xAE, 
+0x49, 0x6F, 0xAD, 0x1E, 0x53, 0xAD, 0x16, 0xC3, 
+0xEB, 0x62, 0x94, 0xDE, 0xED, 0xDF, 0xFD, 0xA0, 
+0x93, 0xC5, 0x84, 0x8C, 0x89, 0x58, 0x41, 0xA0, 
+0xCF, 0xDF, 0xE2, 0x19, 0xDA, 0x56, 0x11, 0x4A, 
+0xB0, 0x0C, 0x59, 0x12, 0xCC, 0x71, 0x3F, 0x8B, 
+0xDE, 0x9F, 0xE3, 0x36, 0x70, 0x2D, 0x0C, 0x9A, 
+0x13, 0x4C, 0xBB, 0x78, 0x2D, 0x55, 0x59, 0xF5, 
+0x09, 0x80, 0xDC, 0x20, 0x17, 0x16, 0x76, 0xCA, 
+0x0C, 0x26, 0x06, 0xB5, 0xC2, 0x3D, 0x0C, 
+
+0x6E, 0xC7, 0x3E, 0xB3, 0xE9, 0x0D, 0xCC, 0x98, 
+0x8C, 0x6B, 0x5D, 0x68, 0xD9, 0x5E, 0xEE, 0x00, 
+0x69, 0x83, 0x17, 0x00, 0xC9, 0xD1, 0x45, 0xB3, 
+0x01, 0xEE, 0xB3, 0x94, 0xFD, 0xFD, 0xA0, 0xAD, 
+0xAA, 0x1F, 0xC9, 0x20, 0x41, 0xFC, 0xCD, 0xEB, 
+0x03, 0x91, 0xEE, 0x42, 0xA8, 0xAC, 0xE5, 0x08, 
+0x54, 0xCB, 0xC4, 0xBC, 0xAD, 0x00, 0xF5, 0x57, 
+0xAB, 0x54, 0x37, 0x8D, 0x87, 0x42, 0xCF, 0x47, 
+0x06, 0x4F, 0x53, 0xB1, 0x3F, 0xA1, 0x7C, 0x48, 
+0x57, 0xF0, 0x02, 0x04, 0x90, 0x93, 0xA6, 0x83, 
+
+0xA0, 0x71, 0xCF, 0xFA, 0x39, 0x26, 0xF8, 0x52, 
+0xB1, 0x5F, 0x3F, 0xD0, 0x41, 0xB9, 0x2E, 0x39, 
+0xA0, 0xF8, 0x5F, 0x27, 0xD6, 0x91, 0xFA, 0xF7, 
+0xB5, 0xDF, 0xF7, 0x15, 0x1C, 0x8A, 0x42, 0xD4, 
+0x6B, 0xEF, 0x47, 0x44, 0x5C, 0x0C, 0xB5, 0xF0, 
+0xD6, 0x20, 0x7C, 0x95, 0x5E, 0x5F, 0xB8, 0x19, 
+0x25, 0xA6, 0x99, 0x0B, 0x75, 0xD7, 0xA3, 0xF9, 
+0x0C, 0x5F, 0x89, 0xFF, 0x08, 0xF3, 0xF4, 0xBA, 
+0x8A, 0x0F, 0x86, 0xFE, 0xE5, 0xCB, 0x14, 0xA3, 
+0xD7, 0x17, 0x2B, 0xAC, 0xF5, 0xB9, 0xAE, 0xB5, 
+0xBE, 
+
+0x5C, 0x49, 0x49, 0xE6, 0x3E, 0x23, 0x67, 0x0D, 
+0xFE, 0x48, 0xB6, 0xE1, 0x0B, 0x0E, 0xCA, 0xE5, 
+0xD8, 0x91, 0x69, 0x6F, 0xE8, 0xD7, 0xFA, 0x51, 
+0x3D, 0x47, 0x75, 0x63, 0x8F, 0xFA, 0x69, 0x96, 
+0x72, 0xA1, 0x97, 0x26, 0x2F, 0x5B, 0x56, 0x00, 
+0x5B, 0xB5, 0xCD, 0xFD, 0x8A, 0x81, 0x71, 0xF4, 
+0x7D, 0xD3, 0x9A, 0xAF, 0x4E, 0xFE, 0x82, 0x61, 
+0x47, 0x60, 0xE4, 0x87, 0x3C, 0x03, 0xE5, 0xE0, 
+0x64, 0x96, 0x74, 0xEC, 0x15, 0x86, 0xED, 0x1F, 
+0x13, 0xE0, 0x1B, 0x2E, 0x3D, 0x47, 0x30, 0xBF, 
+0xFB, 0xF4, 
+
+0x7A, 0xF0, 0x38, 0x0B, 0xBA, 0xC6, 0xF8, 0x0C, 
+0xAF, 0x96, 0x43, 0xCE, 0xDF, 0x66, 0xF4, 0xB4, 
+0xF5, 0xD4, 0x1E, 0x63, 0x82, 0xE5, 0x25, 0xA6, 
+0xFA, 0x17, 0xC8, 0xD8, 0xDE, 0x38, 0x2B, 0x87, 
+0x43, 0xE6, 0x9B, 0xD5, 0xD6, 0x69, 0x5E, 0x53, 
+0xB1, 0x08, 0x4D, 0xF1, 0x95, 0x6B, 0xC8, 0x62, 
+0xC6, 0xCD, 0x82, 0xB2, 0x53, 0xAB, 0xE0, 0xEB, 
+0x27, 0xEB, 0x3C, 0x8C, 0xA5, 0x5B, 0xC0, 0xF2, 
+0xEC, 0xE2, 0x63, 0x96, 0x7C, 0xA8, 0x68, 0x78, 
+0xC9, 0xA2, 0x23, 0x3F, 0xB1, 0x0C, 0x76, 0x51, 
+0x55, 0x46, 0x71, 
+
+0x85, 0xB4, 0x6A, 0xC5, 0x06, 0x79, 0xE7, 0x4D, 
+0x30, 0x44, 0x17, 0xCB, 0x63, 0xC5, 0x4F, 0xF1, 
+0xFC, 0xE8, 0xC0, 0x08, 0x46, 0x96, 0x20, 0x4C, 
+0xFB, 0x0D, 0xA9, 0x5E, 0xE0, 0xCB, 0x99, 0x2F, 
+0x96, 0xF6, 0x3D, 0xBD, 0x7D, 0xEB, 0x63, 0x1B, 
+0x6A, 0x0B, 0xD0, 0xB0, 0xDF, 0xB1, 0xC1, 0xD8, 
+0xA5, 0xCA, 0xFB, 0xF3, 0x04, 0x1E, 0x9E, 0x5B, 
+0x56, 0xC0, 0x9A, 0x78, 0x61, 0x58, 0xAD, 0xF5, 
+0x9C, 0x9F, 0xBF, 0xFA, 0x8A, 0x0C, 0x8F, 0x84, 
+0x8E, 0xE5, 0x4E, 0x3A, 0xA1, 0x58, 0xE7, 0x66, 
+0x87, 0x56, 0xF2, 0xA7, 
+
+0x80, 0x32, 0x1E, 0x45, 0xAE, 0xAB, 0x36, 0x80, 
+0x31, 0x70, 0xB2, 0x23, 0x91, 0x14, 0xD6, 0x47, 
+0xCB, 0xF1, 0xB0, 0x7A, 0x79, 0x25, 0x52, 0xE3, 
+0x4F, 0x43, 0x4E, 0xEF, 0x76, 0x67, 0xB4, 0x30, 
+0x5B, 0xFD, 0x9B, 0x88, 0x26, 0xCF, 0xEE, 0xA9, 
+0x84, 0xCA, 0x15, 0xBA, 0xD1, 0x54, 0x26, 0x63, 
+0x1A, 0x13, 0x67, 0x69, 0xE1, 0xEE, 0xDC, 0x0C, 
+0x2F, 0x48, 0x1A, 0xCF, 0xE5, 0x18, 0x15, 0xFC, 
+0x1E, 0x0F, 0x77, 0x41, 0x05, 0xB0, 0xF8, 0x23, 
+0x82, 0x4A, 0x1B, 0x08, 0x45, 0xC1, 0x4D, 0x27, 
+0xD2, 0x9F, 0x1F, 0xD3, 0x4F, 
+
+}
+return kat[i:j]
+}
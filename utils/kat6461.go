@@ -0,0 +1,5478 @@
+package utils
+func getkat6461(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0xFE, 0xDC, 0x6C, 0x0A, 0x64, 0x9F, 0x86, 0xDC, 
+0x89, 0x23, 0x19, 0x36, 0x0F, 0xF3, 0xC5, 0x17, 
+0xB7, 0x35, 0x8E, 0x7E, 0xA7, 0xEF, 0x5A, 0xE2, 
+0xFB, 0x99, 0xD3, 0xCE, 0x98, 0x89, 0xC4, 0x68, 
+
+0x54, 0x8A, 0x50, 0x64, 0x18, 0x8A, 0xFA, 0x55, 
+0x1E, 0x94, 0xE8, 0x95, 0x80, 0x99, 0x2A, 0x0D, 
+0x5C, 0xF5, 0xA0, 0x1F, 0x82, 0x60, 0x72, 0x44, 
+0xB9, 0xEF, 0xD8, 0x24, 0x91, 0xAA, 0xFD, 0x1A, 
+0x86, 
+
+0x12, 0x07, 0x95, 0x76, 0xD6, 0x47, 0x8F, 0xE8, 
+0x7F, 0x9A, 0xCB, 0xE0, 0x1A, 0x96, 0x71, 0xBC, 
+0x93, 0x36, 0x7E, 0x81, 0x76, 0xED, 0x26, 0x3F, 
+0x9D, 0xA0, 0xBB, 0x0B, 0x09, 0x9A, 0x94, 0x7C, 
+0xA4, 0xCA, 
+
+0x63, 0x0C, 0xE4, 0x7D, 0xAB, 0x9C, 0x06, 0x7B, 
+0xA4, 0xBA, 0xC5, 0xAC, 0x2C, 0xCD, 0xA7, 0x6C, 
+0xC7, 0x4B, 0x26, 0x6B, 0x63, 0x44, 0xC1, 0xEB, 
+0xCC, 0x0E, 0xC4, 0xA6, 0xEC, 0x45, 0x6E, 0xFA, 
+0x2A, 0xF6, 0x3A, 
+
+0x86, 0x7D, 0x12, 0x08, 0xDD, 0xE6, 0x57, 0xB9, 
+0x02, 0xA1, 0x09, 0x6B, 0xD8, 0x1D, 0x42, 0x19, 
+0xBD, 0x65, 0x02, 0xE7, 0xF9, 0x56, 0x9C, 0xF2, 
+0x5F, 0xC3, 0xF9, 0xF1, 0x87, 0x28, 0x62, 0xE4, 
+0xEE, 0x0B, 0x31, 0xE4, 
+
+0xF8, 0xD0, 0xB7, 0xD4, 0x54, 0xB7, 0xDA, 0xAC, 
+0xDC, 0xA9, 0xE4, 0x6E, 0x55, 0x25, 0x85, 0xA7, 
+0x2D, 0xDD, 0xB7, 0x16, 0x0E, 0xDC, 0xF7, 0x29, 
+0x38, 0x45, 0xA3, 0x25, 0x10, 0xDF, 0xC4, 0xDC, 
+0x42, 0x0A, 0x29, 0x23, 0xFB, 
+
+0x82, 0x35, 0xFE, 0x23, 0x07, 0x3E, 0xB8, 0xE7, 
+0xEE, 0x40, 0x46, 0x1F, 0x99, 0xD4, 0x06, 0xC0, 
+0x73, 0x3E, 0xA9, 0x5F, 0x0D, 0x4F, 0x3D, 0xA8, 
+0x88, 0x3B, 0x1D, 0x91, 0x55, 0x6F, 0x39, 0xA2, 
+0x6B, 0x22, 0xBA, 0x8D, 0x69, 0x86, 
+
+0x53, 0x4A, 0xB0, 0x11, 0xA3, 0x61, 0x7E, 0x48, 
+0x1F, 0x6C, 0x49, 0x37, 0x7C, 0xA7, 0x1A, 0xCF, 
+0xA7, 0x1B, 0x5A, 0xFC, 0x8D, 0x80, 0x2A, 0x4C, 
+0x09, 0x8C, 0x30, 0x62, 0xE2, 0x77, 0xCA, 0x63, 
+0xFB, 0x3B, 0x6E, 0x8B, 0x92, 0xFA, 0x2D, 
+
+0xC0, 0x07, 0x1D, 0x07, 0xF1, 0xED, 0xBA, 0x85, 
+0x5E, 0x58, 0x9C, 0xF0, 0x77, 0x97, 0xCF, 0x03, 
+0x6A, 0x82, 0x8C, 0xAC, 0xB2, 0xB5, 0x10, 0x93, 
+0xA9, 0x57, 0x36, 0xB9, 0x45, 0x92, 0x58, 0x52, 
+0xF3, 0x94, 0xB6, 0xA0, 0x4C, 0x74, 0xE5, 0x07, 
+
+0x67, 0x36, 0x83, 0x43, 0x36, 0xE4, 0xFD, 0x28, 
+0xB6, 0x67, 0x51, 0x68, 0xB3, 0x16, 0xE7, 0x67, 
+0x20, 0x34, 0xA8, 0xEA, 0xDC, 0x9A, 0xD8, 0xD6, 
+0x0F, 0xD5, 0x26, 0x99, 0x3D, 0x5B, 0x15, 0xAE, 
+0x62, 0xC3, 0x39, 0xB2, 0x4A, 0x86, 0x3E, 0x7A, 
+0x2B, 
+
+0x31, 0x4E, 0x1D, 0xCC, 0xED, 0x60, 0x9E, 0x6B, 
+0x08, 0x24, 0x44, 0x9A, 0xDA, 0x86, 0xAA, 0xA0, 
+0xAC, 0x0A, 0x27, 0x8E, 0x89, 0x1E, 0x34, 0x49, 
+0x35, 0xD0, 0xD7, 0xEC, 0x40, 0xD3, 0x84, 0x71, 
+0x36, 0xF9, 0x05, 0x57, 0xC3, 0xE9, 0xA7, 0xB7, 
+0x6C, 0x6C, 
+
+0xC6, 0x71, 0x40, 0x0B, 0xB0, 0x8E, 0xDD, 0xE4, 
+0xE6, 0xE1, 0x3B, 0x92, 0xB7, 0xC4, 0xED, 0xE8, 
+0x6E, 0xB8, 0xE2, 0x92, 0xFD, 0x36, 0x2F, 0x16, 
+0x7C, 0x93, 0xCD, 0xE3, 0x7D, 0x7B, 0x4F, 0x64, 
+0x25, 0xE4, 0xF7, 0x0F, 0x76, 0x93, 0x62, 0x92, 
+0xEB, 0x7E, 0xF8, 
+
+0xFE, 0x4B, 0x22, 0x90, 0xC8, 0x37, 0xFF, 0x16, 
+0xCE, 0x98, 0x67, 0x64, 0xC5, 0x28, 0xDB, 0xF3, 
+0x8D, 0xBE, 0x7F, 0x28, 0x81, 0x17, 0xEF, 0x89, 
+0xCB, 0x80, 0x60, 0x2F, 0x22, 0x92, 0x7D, 0xD8, 
+0xBF, 0xF9, 0xFD, 0x98, 0x9B, 0x01, 0xB2, 0x6B, 
+0x89, 0x32, 0x12, 0xB5, 
+
+0xBE, 0xA1, 0x4A, 0xA3, 0x4A, 0x7E, 0xDB, 0xFF, 
+0x77, 0xE6, 0x82, 0x07, 0x13, 0xD8, 0xAB, 0x28, 
+0x0F, 0x1A, 0x27, 0xC7, 0xF6, 0xFD, 0x31, 0x02, 
+0x0A, 0xD9, 0x9A, 0xDF, 0x11, 0x64, 0x09, 0xB6, 
+0x2F, 0xDD, 0x27, 0x8A, 0xE2, 0x2B, 0x86, 0xB6, 
+0xBF, 0x6A, 0x6A, 0xED, 0xFF, 
+
+0x1A, 0x0A, 0x14, 0x9D, 0xFC, 0x25, 0x61, 0x66, 
+0x5D, 0xE3, 0x95, 0x59, 0xB0, 0xC3, 0xEE, 0xFE, 
+0xE4, 0xE3, 0x5C, 0x66, 0x83, 0xAC, 0x6A, 0x67, 
+0x75, 0x5B, 0xDE, 0x2A, 0x3C, 0x1F, 0xC8, 0x70, 
+0x8D, 0x16, 0x78, 0x90, 0xEC, 0x5D, 0xB1, 0xEF, 
+0x87, 0xF3, 0x6D, 0x27, 0xC9, 0x7B, 
+
+0x6A, 0x9E, 0x3D, 0x41, 0xBA, 0x78, 0x85, 0xB0, 
+0xEC, 0x2A, 0x42, 0xFE, 0xE1, 0x2C, 0x13, 0xB5, 
+0xCD, 0x08, 0xD3, 0xC4, 0x03, 0xE1, 0xB7, 0x79, 
+0x48, 0x99, 0x6B, 0x04, 0x92, 0x5D, 0x20, 0x9E, 
+0xEE, 0xF1, 0x40, 0x34, 0xFE, 0x2B, 0xFB, 0x41, 
+0x06, 0xC2, 0x60, 0x54, 0x6B, 0x52, 0xD4, 
+
+0x87, 0xE5, 0x1D, 0x76, 0x9D, 0xC9, 0x55, 0x8A, 
+0x9B, 0x3B, 0x88, 0x61, 0x3E, 0x03, 0x4C, 0x62, 
+0x1B, 0x2E, 0x3E, 0xCA, 0x48, 0xA1, 0x84, 0xF6, 
+0xBC, 0xD7, 0xC0, 0xDE, 0xB3, 0x9D, 0x72, 0x96, 
+0x39, 0x27, 0x14, 0x27, 0x60, 0x0B, 0x00, 0x29, 
+0x2D, 0xD6, 0x49, 0xAD, 0xB3, 0xB9, 0x17, 0x08, 
+
+0xA3, 0x72, 0x76, 0x13, 0x50, 0x42, 0x41, 0xEB, 
+0x0F, 0x42, 0x0D, 0xEE, 0xB7, 0x26, 0x1A, 0x3B, 
+0x09, 0x2B, 0xE1, 0x74, 0xF1, 0x71, 0x11, 0x57, 
+0x5D, 0xC0, 0x23, 0x48, 0x9D, 0xA7, 0xA1, 0xDE, 
+0x3D, 0x5C, 0xD7, 0x0D, 0xB5, 0x80, 0x9B, 0xBE, 
+0xBE, 0x0D, 0x61, 0x3D, 0x79, 0x1C, 0x35, 0x35, 
+0x71, 
+
+0x48, 0x7C, 0x20, 0x26, 0xA7, 0xC1, 0xDD, 0xBF, 
+0xA2, 0x55, 0xD5, 0xCE, 0x4D, 0x0F, 0x7B, 0xB9, 
+0xB3, 0x36, 0x68, 0xF6, 0xF1, 0x37, 0x2A, 0x25, 
+0x6E, 0x45, 0xB5, 0xD8, 0xF8, 0xEC, 0x85, 0x8A, 
+0xA3, 0x73, 0xD1, 0xA6, 0xE2, 0x8E, 0xCB, 0x82, 
+0xC9, 0x49, 0x14, 0x39, 0x84, 0x3B, 0x17, 0xD7, 
+0xE4, 0xC5, 
+
+0xC0, 0xD7, 0x99, 0xB0, 0x68, 0x91, 0x14, 0x61, 
+0xC6, 0x80, 0x2B, 0x5E, 0x98, 0x1F, 0x13, 0xBC, 
+0x5F, 0x04, 0x07, 0x77, 0x70, 0xA8, 0x9C, 0x12, 
+0x9C, 0x07, 0x5E, 0xE7, 0xC9, 0x67, 0xE7, 0xCB, 
+0xC6, 0x57, 0x8D, 0x9B, 0x41, 0x41, 0x3D, 0x1E, 
+0xD3, 0xD0, 0x2E, 0xD4, 0x3F, 0xE7, 0x5D, 0xCA, 
+0x2F, 0x96, 0xFE, 
+
+0x9A, 0x79, 0xE6, 0x35, 0xDB, 0x49, 0x1C, 0xF8, 
+0x19, 0xB6, 0x3D, 0x10, 0x50, 0x51, 0xA3, 0x05, 
+0xED, 0x62, 0xDA, 0x09, 0xDD, 0x80, 0x1F, 0xD6, 
+0xAC, 0xFB, 0x2B, 0xE5, 0xDE, 0x21, 0x34, 0x9E, 
+0x33, 0x6D, 0x78, 0xB4, 0x35, 0xB7, 0xB8, 0x27, 
+0x19, 0x99, 0x87, 0x26, 0xDF, 0x33, 0x7B, 0x9C, 
+0xE3, 0xB9, 0xD7, 0x6F, 
+
+0xEB, 0x18, 0xA4, 0xCD, 0x3E, 0x59, 0xEE, 0xA3, 
+0x00, 0x1F, 0x0E, 0xAA, 0x8F, 0xBF, 0x6C, 0x58, 
+0xAB, 0x80, 0x12, 0xD0, 0x32, 0x84, 0x5C, 0x75, 
+0xF3, 0x22, 0x8C, 0x06, 0x82, 0x77, 0xC8, 0xA7, 
+0x21, 0x19, 0xB9, 0x21, 0x27, 0x55, 0xBC, 0xDE, 
+0xBF, 0xAB, 0xEA, 0x83, 0xFC, 0xBF, 0x40, 0xAA, 
+0xFB, 0xB8, 0xEC, 0xB6, 0x47, 
+
+0xCB, 0x47, 0xCC, 0xC5, 0x9E, 0x2D, 0x2A, 0x0D, 
+0xA1, 0x29, 0xE6, 0x10, 0x76, 0x18, 0xB9, 0xC2, 
+0xF7, 0xEE, 0xD9, 0xE7, 0xF9, 0x7D, 0x19, 0xAE, 
+0xED, 0x29, 0xB2, 0xD3, 0xED, 0xB8, 0xCD, 0x6C, 
+0xCA, 0xB8, 0x4F, 0x83, 0x63, 0xFA, 0x55, 0x36, 
+0x32, 0x00, 0x2C, 0xA8, 0xED, 0x25, 0xD8, 0xE2, 
+0x60, 0x45, 0xC1, 0xBF, 0x29, 0xF2, 
+
+0x6F, 0x7A, 0x97, 0x11, 0x47, 0x14, 0x3B, 0x72, 
+0xE3, 0x05, 0xA0, 0x67, 0x80, 0x20, 0x7D, 0x3D, 
+0x96, 0x48, 0x39, 0xF5, 0x06, 0x33, 0xC3, 0x6E, 
+0x99, 0xA9, 0x41, 0x47, 0x40, 0xC7, 0x07, 0x93, 
+0x08, 0x41, 0x61, 0xDD, 0xBC, 0x95, 0x3F, 0x52, 
+0x7E, 0x3A, 0xFE, 0x53, 0xD6, 0x30, 0xE4, 0x95, 
+0x0A, 0x3D, 0x7D, 0xA2, 0x15, 0xC1, 0xED, 
+
+0x96, 0x31, 0x74, 0x73, 0x7E, 0x30, 0x8D, 0xF2, 
+0x9E, 0x68, 0x8D, 0x0D, 0x20, 0x49, 0x68, 0xAF, 
+0x0E, 0x7C, 0xE8, 0x36, 0xBC, 0x97, 0x24, 0xC6, 
+0xD1, 0x0F, 0xFA, 0xA3, 0xAC, 0x8E, 0x19, 0x2A, 
+0x25, 0x40, 0xE6, 0x40, 0x7B, 0x42, 0xD5, 0xA6, 
+0xF4, 0x79, 0x99, 0x16, 0x96, 0x78, 0x6B, 0xA1, 
+0x49, 0x3A, 0xCD, 0x1F, 0xFB, 0x26, 0xD0, 0xA7, 
+
+0x00, 0x0F, 0x9C, 0xDA, 0xAE, 0xE0, 0x47, 0xBB, 
+0xED, 0x82, 0x5E, 0x83, 0x69, 0x40, 0x0B, 0x55, 
+0x2D, 0x0B, 0x12, 0x45, 0xA3, 0xF0, 0x1E, 0x3C, 
+0xBC, 0x79, 0x2A, 0xA4, 0xA0, 0x18, 0x51, 0xAC, 
+0x51, 0x24, 0xD6, 0xA8, 0x0F, 0x7E, 0x50, 0xE1, 
+0x0F, 0x45, 0xB8, 0xFF, 0xC2, 0x65, 0x06, 0xB3, 
+0x22, 0x23, 0x76, 0xED, 0xBE, 0x53, 0x62, 0x13, 
+0xED, 
+
+0xEE, 0x21, 0x2C, 0xAB, 0x9C, 0x80, 0x0D, 0xD7, 
+0xE5, 0xF0, 0xBE, 0xC4, 0x6D, 0xFC, 0x51, 0x86, 
+0x26, 0x3D, 0xF0, 0x99, 0x5B, 0x76, 0x97, 0xEB, 
+0x4C, 0x4C, 0xA2, 0x58, 0xE7, 0x5A, 0x38, 0x38, 
+0x8C, 0x7F, 0xD0, 0x3D, 0xD8, 0x2B, 0x56, 0xE9, 
+0xE0, 0xB6, 0xC2, 0x66, 0x9B, 0x15, 0x25, 0x3F, 
+0x44, 0x5A, 0xD0, 0xDB, 0x8E, 0x73, 0xE9, 0xC1, 
+0xB7, 0x2C, 
+
+0x87, 0xBF, 0x58, 0x94, 0x7F, 0x87, 0x2A, 0x5C, 
+0xBE, 0x94, 0x92, 0xAD, 0x4A, 0x00, 0x2D, 0x45, 
+0x38, 0x07, 0x22, 0xD4, 0xD4, 0x32, 0xF4, 0x5E, 
+0x0C, 0x45, 0x6B, 0xEE, 0x99, 0x52, 0x41, 0xBF, 
+0x0B, 0x23, 0x55, 0xC1, 0x6D, 0x20, 0x15, 0x9D, 
+0x28, 0xD8, 0xDE, 0x33, 0x43, 0x5F, 0x81, 0x5A, 
+0x33, 0x7D, 0xAE, 0xDE, 0xB5, 0x4D, 0x7A, 0xC1, 
+0xCE, 0xBD, 0x2B, 
+
+0x19, 0x17, 0x8D, 0x2D, 0xBB, 0x70, 0x49, 0x2D, 
+0x89, 0x42, 0x31, 0xD8, 0xA2, 0xED, 0x48, 0xFE, 
+0x42, 0xF3, 0xDD, 0x90, 0xA8, 0x03, 0x78, 0x39, 
+0x5E, 0x1F, 0xFE, 0x5F, 0x53, 0xA3, 0x1C, 0xF2, 
+0x6A, 0x03, 0x2C, 0x6A, 0x3B, 0xF3, 0xC2, 0x78, 
+0xDC, 0x5E, 0x61, 0xD0, 0x7C, 0xE5, 0x3C, 0x4F, 
+0x81, 0x52, 0x94, 0x6E, 0x66, 0x91, 0xA5, 0x5D, 
+0xB7, 0x04, 0x2B, 0xBA, 
+
+0xC2, 0x2E, 0xBA, 0x5E, 0x5F, 0x9F, 0xA8, 0x1B, 
+0xFC, 0xD7, 0xE6, 0xB0, 0x14, 0xED, 0xE3, 0x06, 
+0x74, 0xCB, 0x4B, 0x18, 0xC8, 0x5F, 0x67, 0xAE, 
+0xCD, 0x66, 0x3D, 0xC5, 0xB9, 0x57, 0xA7, 0x27, 
+0xF6, 0xDF, 0x34, 0xED, 0xD3, 0xDF, 0x85, 0x81, 
+0x01, 0xE8, 0xA6, 0x35, 0x3B, 0x8C, 0x91, 0x0D, 
+0x30, 0x15, 0x66, 0xA5, 0x8E, 0x9F, 0xED, 0xFC, 
+0xA6, 0x47, 0xBF, 0x73, 0x7E, 
+
+0x00, 0x9C, 0xFB, 0xC5, 0xDC, 0x8F, 0xCB, 0x41, 
+0x06, 0xE0, 0xAF, 0x3E, 0x03, 0x88, 0x20, 0x24, 
+0x36, 0x97, 0x09, 0xC7, 0x42, 0x8C, 0x22, 0x63, 
+0x1D, 0x3E, 0x88, 0x18, 0xD6, 0xAF, 0xE7, 0x6A, 
+0x39, 0x8A, 0xE6, 0x34, 0xF7, 0xE1, 0x00, 0xE8, 
+0x8E, 0x33, 0xC0, 0x62, 0xBB, 0xFB, 0x6C, 0x94, 
+0xC9, 0x4E, 0x92, 0x86, 0xEF, 0x25, 0x6D, 0x1A, 
+0x97, 0x88, 0xEB, 0x39, 0x14, 0xB7, 
+
+0x07, 0xD3, 0x77, 0x4F, 0xFE, 0xC4, 0x2F, 0xEE, 
+0x9D, 0xB5, 0x02, 0xE9, 0x63, 0xBD, 0xDC, 0x23, 
+0x79, 0xB4, 0x9C, 0x3F, 0xC9, 0x57, 0x41, 0xE5, 
+0xBA, 0x30, 0xB9, 0xFA, 0x79, 0xB8, 0x2F, 0xFB, 
+0x09, 0xDD, 0xF0, 0xFB, 0x79, 0x89, 0xDC, 0x87, 
+0x55, 0xD4, 0xFA, 0xC5, 0x5F, 0x27, 0xBF, 0x07, 
+0x1E, 0x36, 0xA2, 0x39, 0x7C, 0x73, 0x22, 0x65, 
+0x39, 0x4C, 0x5B, 0x85, 0x66, 0xAA, 0x1F, 
+
+0x4C, 0xF1, 0x47, 0x1B, 0xCA, 0xDF, 0xFA, 0x4E, 
+0xA4, 0xE8, 0x16, 0x0D, 0x87, 0xFC, 0x0D, 0xE1, 
+0x5D, 0xF8, 0x2F, 0x56, 0x73, 0x79, 0x42, 0xE7, 
+0xAC, 0x13, 0x20, 0x22, 0xD0, 0xA6, 0xE2, 0x63, 
+0x7D, 0x65, 0x6E, 0x11, 0xE5, 0x84, 0x4B, 0x44, 
+0x6A, 0x34, 0xA4, 0x76, 0x30, 0xDF, 0xC8, 0x20, 
+0x9F, 0x90, 0xAE, 0x23, 0x36, 0xAA, 0x14, 0xB6, 
+0x81, 0x5B, 0x20, 0x5E, 0x65, 0xC9, 0x33, 0xCD, 
+
+0x92, 0xB2, 0x4F, 0x8C, 0x03, 0x5C, 0x5F, 0x13, 
+0x1D, 0xB6, 0xF1, 0x36, 0xBA, 0x1F, 0x60, 0x21, 
+0x8D, 0xEF, 0x8F, 0x4B, 0x24, 0x75, 0x9F, 0x18, 
+0xC4, 0xCF, 0xE8, 0x5F, 0xD5, 0x37, 0x74, 0xC3, 
+0x29, 0x21, 0xAB, 0x57, 0xBE, 0xD6, 0x55, 0x5F, 
+0x18, 0xFE, 0x51, 0x02, 0x41, 0xF5, 0xAB, 0xA3, 
+0x44, 0x53, 0x97, 0xE6, 0xE7, 0x91, 0xBB, 0x4D, 
+0xA1, 0xE0, 0xA8, 0xAF, 0xB5, 0xE1, 0x68, 0x24, 
+0xA4, 
+
+0xCE, 0xBC, 0x37, 0xE1, 0xD9, 0x05, 0xE8, 0x50, 
+0x31, 0x46, 0x32, 0x33, 0xE8, 0xA2, 0x10, 0xF0, 
+0xA2, 0xB7, 0xC1, 0xA0, 0x68, 0x7A, 0x3C, 0x64, 
+0x5B, 0xD9, 0x2C, 0xB5, 0x75, 0x84, 0x1E, 0x87, 
+0x66, 0xBB, 0x05, 0xA9, 0xBF, 0x0A, 0x0D, 0xD9, 
+0xBF, 0xAC, 0xA6, 0xCC, 0x92, 0xD1, 0x30, 0xA0, 
+0x4E, 0xD2, 0x49, 0x88, 0x77, 0xC7, 0x85, 0xBB, 
+0x5E, 0x7D, 0x55, 0x86, 0x9D, 0x83, 0x5B, 0xAC, 
+0x37, 0x9E, 
+
+0xAC, 0x64, 0xC1, 0x2B, 0xD3, 0x9B, 0x55, 0x6E, 
+0x03, 0x4D, 0xE7, 0x80, 0x10, 0x5A, 0x1C, 0x7E, 
+0x4C, 0xF2, 0xD3, 0x68, 0x68, 0xC4, 0x78, 0xC6, 
+0x19, 0x7D, 0x7F, 0x51, 0xCD, 0xB4, 0x47, 0x41, 
+0x8C, 0x49, 0xB2, 0xE3, 0xB5, 0xDC, 0x6D, 0xE2, 
+0x98, 0x4C, 0xCA, 0x79, 0x50, 0x71, 0x47, 0x25, 
+0xDF, 0x5C, 0xDE, 0xEE, 0x98, 0xBE, 0xC6, 0x45, 
+0xF5, 0x3C, 0xBD, 0x0E, 0x02, 0x44, 0x1B, 0x5C, 
+0x03, 0xE4, 0x34, 
+
+0x7E, 0xE6, 0xE3, 0xCA, 0x4A, 0xCB, 0xE9, 0x33, 
+0xD1, 0xBD, 0x27, 0x2A, 0x70, 0x42, 0x45, 0x95, 
+0x55, 0xE8, 0xB0, 0x2A, 0x20, 0xD2, 0xEC, 0xE0, 
+0x4B, 0x0B, 0x8C, 0x45, 0xAE, 0x5B, 0x54, 0xA8, 
+0x64, 0x95, 0x75, 0x70, 0xA1, 0x25, 0x2F, 0xED, 
+0xD7, 0xDB, 0x59, 0x20, 0xAC, 0xC7, 0xA3, 0x6A, 
+0xC0, 0x3C, 0x8B, 0xBA, 0x86, 0xD4, 0xB0, 0x3C, 
+0xBB, 0xCA, 0x87, 0x68, 0x20, 0xFC, 0x26, 0x3B, 
+0x18, 0x76, 0x23, 0x8E, 
+
+0xB5, 0xBA, 0xA4, 0x9F, 0x28, 0x9C, 0xBB, 0x5C, 
+0x62, 0xD1, 0xE6, 0x8D, 0x49, 0xA9, 0x24, 0x3F, 
+0xF0, 0x8F, 0xEB, 0x56, 0xD4, 0xA9, 0xD4, 0xE8, 
+0xBE, 0x65, 0x18, 0xC1, 0x62, 0xED, 0x35, 0x59, 
+0x2D, 0x38, 0x34, 0x3D, 0x29, 0xF9, 0x25, 0x76, 
+0xB3, 0x16, 0x22, 0x85, 0xA5, 0x49, 0x83, 0x7F, 
+0x66, 0x21, 0x9C, 0x02, 0x35, 0x09, 0x63, 0x99, 
+0x1C, 0xC9, 0x2C, 0xA8, 0x10, 0xF6, 0xF8, 0xE6, 
+0x1F, 0x36, 0xB0, 0x97, 0x57, 
+
+0x77, 0x31, 0x90, 0xF0, 0x2F, 0x7B, 0xC7, 0x49, 
+0x96, 0xE3, 0xE7, 0x4A, 0x90, 0x16, 0xB1, 0x1F, 
+0x2A, 0xE2, 0x86, 0x36, 0xD9, 0x84, 0xE5, 0x56, 
+0x34, 0x7E, 0x5C, 0xE0, 0xEE, 0x12, 0xDC, 0xAE, 
+0x48, 0x35, 0xF0, 0x90, 0x19, 0xD0, 0x90, 0x44, 
+0x84, 0x97, 0x1A, 0xC1, 0x82, 0xCC, 0x5F, 0x17, 
+0x40, 0x83, 0x08, 0x94, 0x74, 0xE1, 0xC9, 0x6C, 
+0x50, 0x0D, 0x1C, 0xE8, 0x37, 0xD1, 0x6B, 0x1C, 
+0xD3, 0x32, 0x41, 0x81, 0xD6, 0xF5, 
+
+0x37, 0xB5, 0x4D, 0x5D, 0x4B, 0x74, 0x48, 0x1D, 
+0x2D, 0xBE, 0x16, 0x84, 0x63, 0xD1, 0xCF, 0xDB, 
+0x26, 0xD4, 0xF2, 0x38, 0x09, 0x66, 0x6B, 0xA2, 
+0x27, 0x23, 0x87, 0x7E, 0x2D, 0xAC, 0xC8, 0x4F, 
+0x08, 0x58, 0xFC, 0xE9, 0xAA, 0x12, 0x7E, 0x96, 
+0xC9, 0xF9, 0x36, 0x39, 0x9C, 0x78, 0x89, 0x0B, 
+0xBA, 0x17, 0x4C, 0x9F, 0xD0, 0x70, 0x84, 0xDE, 
+0x34, 0x58, 0x9E, 0x06, 0x10, 0xCA, 0x8F, 0x5E, 
+0x43, 0x96, 0x39, 0xD6, 0xD6, 0x6A, 0xEF, 
+
+0xC9, 0xA8, 0x3A, 0x0E, 0xDD, 0xEA, 0xDD, 0x00, 
+0xD1, 0xD1, 0x22, 0x65, 0x1A, 0x1F, 0x3F, 0xE0, 
+0x17, 0xD3, 0x57, 0xC0, 0xF3, 0xE0, 0x13, 0xAB, 
+0x44, 0x00, 0x49, 0x18, 0x97, 0x34, 0x08, 0x74, 
+0xB4, 0x0F, 0xDE, 0x72, 0xA2, 0x2F, 0x2C, 0x7D, 
+0x1A, 0xF6, 0x0B, 0x93, 0x11, 0xFB, 0x9E, 0x4B, 
+0x10, 0xED, 0xB0, 0x96, 0xFF, 0x5E, 0xA0, 0x59, 
+0x58, 0xFC, 0x7C, 0x90, 0xF1, 0x52, 0x0D, 0x5A, 
+0x3B, 0x7B, 0x3F, 0x03, 0x90, 0xE5, 0x91, 0xA0, 
+
+0x53, 0xD1, 0x3D, 0xD1, 0xF0, 0xF3, 0x5B, 0x86, 
+0xF3, 0xE5, 0xCB, 0xC3, 0x22, 0x55, 0x6F, 0x57, 
+0xB7, 0x42, 0x84, 0xC4, 0xE0, 0x5F, 0x99, 0x5D, 
+0xD6, 0x7B, 0x65, 0xA3, 0x1C, 0x1C, 0x68, 0x18, 
+0xE4, 0x50, 0x5B, 0x85, 0x06, 0xDC, 0x02, 0x9F, 
+0x2D, 0x01, 0x59, 0x18, 0xB6, 0xAC, 0xE8, 0xA7, 
+0xA0, 0xEB, 0x8A, 0x5F, 0x83, 0xB2, 0x13, 0x8A, 
+0x53, 0x10, 0x99, 0xF9, 0x1E, 0x9C, 0xF1, 0x56, 
+0xA2, 0x0F, 0xBD, 0x72, 0x93, 0x8E, 0xF3, 0x9E, 
+0xFD, 
+
+0xD2, 0x07, 0x46, 0xAF, 0xC6, 0x07, 0x83, 0x18, 
+0x66, 0x1E, 0x99, 0xA7, 0x89, 0xD3, 0x9C, 0x3C, 
+0x1F, 0xC8, 0xB5, 0x5E, 0xFD, 0x09, 0xEC, 0xB1, 
+0x01, 0x53, 0x5F, 0xC9, 0x3B, 0x1B, 0xE4, 0x43, 
+0xA4, 0xB3, 0x49, 0x93, 0xC4, 0x47, 0xD9, 0x27, 
+0xCB, 0x2E, 0xDC, 0xCE, 0xD1, 0xE6, 0xCA, 0xA8, 
+0xA2, 0xD2, 0xB7, 0x23, 0xBC, 0xBE, 0x80, 0x4D, 
+0xF4, 0x82, 0xF6, 0xDB, 0xAA, 0xE1, 0x8E, 0xB2, 
+0x0C, 0xEF, 0x05, 0xC3, 0x97, 0x64, 0xD7, 0xFA, 
+0x43, 0xED, 
+
+0xBA, 0x89, 0x94, 0x5E, 0x59, 0x87, 0x50, 0xAE, 
+0x60, 0xD3, 0x90, 0xBC, 0x84, 0x3A, 0x89, 0xFC, 
+0x3D, 0x9F, 0x42, 0x03, 0x44, 0x98, 0xFF, 0x9A, 
+0x14, 0x00, 0x31, 0xD0, 0x95, 0x21, 0x2B, 0x0D, 
+0xD7, 0x39, 0x5D, 0x61, 0x74, 0x6B, 0xE8, 0xD3, 
+0xB4, 0xC9, 0x22, 0x2D, 0x16, 0x64, 0x8D, 0x9F, 
+0x1F, 0xAD, 0x8F, 0x00, 0x6A, 0x76, 0x61, 0x25, 
+0x20, 0x6C, 0x09, 0x08, 0xE2, 0x55, 0x2E, 0x85, 
+0x8D, 0x8B, 0xA4, 0x10, 0x40, 0xC2, 0x90, 0x61, 
+0xDC, 0x6A, 0x40, 
+
+0x21, 0x01, 0xC4, 0x5D, 0x5A, 0xA5, 0xD5, 0x63, 
+0x86, 0xFF, 0x31, 0xB5, 0x94, 0xF8, 0xF1, 0x7B, 
+0xA4, 0xA1, 0x9B, 0xCB, 0x83, 0x8E, 0x98, 0xB4, 
+0x7B, 0xA1, 0x6E, 0x8C, 0x34, 0x6B, 0x6F, 0x36, 
+0x69, 0xB1, 0x8F, 0x4C, 0x30, 0xEF, 0x84, 0x68, 
+0xC8, 0x5E, 0x5B, 0x14, 0x29, 0x96, 0x24, 0x33, 
+0xA0, 0x1B, 0x67, 0x08, 0xC2, 0x89, 0xF0, 0x20, 
+0x21, 0x8F, 0x5F, 0x9F, 0x46, 0xEC, 0xC1, 0x67, 
+0xEC, 0x89, 0x0B, 0x2A, 0xE9, 0x90, 0x1E, 0x9C, 
+0x2F, 0x1D, 0xAA, 0x8C, 
+
+0xF3, 0x11, 0xB0, 0x69, 0x1B, 0x56, 0x0E, 0xFE, 
+0xF9, 0xB1, 0xC6, 0xE9, 0x1B, 0x76, 0xA4, 0xC6, 
+0x3E, 0x36, 0x20, 0x4D, 0xAB, 0x24, 0xE5, 0x98, 
+0xC5, 0x07, 0x21, 0xC8, 0xD8, 0x34, 0x7E, 0xF2, 
+0xE7, 0xDC, 0x6B, 0xFF, 0x22, 0x57, 0xB3, 0x23, 
+0x6E, 0x9A, 0xD4, 0x57, 0xEC, 0x71, 0xFD, 0xF0, 
+0x16, 0xE5, 0x20, 0x7E, 0x09, 0x6F, 0xAB, 0x1E, 
+0x52, 0xC5, 0x42, 0x66, 0x1A, 0x14, 0xA4, 0x1D, 
+0xE8, 0xC3, 0xBB, 0x76, 0x31, 0x65, 0x91, 0xB4, 
+0xAC, 0x86, 0xFF, 0xE2, 0x6B, 
+
+0xD0, 0x8F, 0x31, 0x12, 0x2F, 0x6C, 0xC2, 0x0F, 
+0x12, 0x6F, 0x52, 0x45, 0x9C, 0xF8, 0x16, 0x44, 
+0x2B, 0xEC, 0x8A, 0xD5, 0x05, 0x55, 0x17, 0xC3, 
+0x4F, 0x9F, 0x0F, 0x03, 0x8D, 0x51, 0x8B, 0x28, 
+0x1C, 0xBA, 0x6C, 0x68, 0x15, 0xFD, 0x2F, 0xDB, 
+0x75, 0xCE, 0x4A, 0x1F, 0xB6, 0xD8, 0x9E, 0x85, 
+0x84, 0x15, 0xCA, 0x26, 0x35, 0x0F, 0xF7, 0x26, 
+0x63, 0x80, 0x83, 0x4B, 0x6E, 0x4B, 0xA0, 0xF8, 
+0x64, 0x71, 0xB6, 0xE7, 0x53, 0xBE, 0x17, 0x7E, 
+0xDB, 0x37, 0x95, 0xEF, 0xA4, 0x26, 
+
+0x30, 0x26, 0xDF, 0x4F, 0x5D, 0xFE, 0x05, 0xF3, 
+0xA9, 0x6F, 0xC4, 0x25, 0x01, 0x00, 0xEB, 0xA1, 
+0xDF, 0x05, 0x10, 0x32, 0x55, 0xF7, 0xB2, 0x72, 
+0xE5, 0xFB, 0x02, 0xE0, 0xE3, 0x86, 0x30, 0x7E, 
+0x9B, 0xD0, 0x16, 0x74, 0x3F, 0x63, 0xA9, 0x0B, 
+0x3D, 0x3E, 0x72, 0x41, 0x08, 0xEE, 0x15, 0xEB, 
+0xA4, 0x1E, 0xF7, 0x42, 0x18, 0x6C, 0xCF, 0xF9, 
+0x19, 0xB1, 0xE6, 0xB8, 0x55, 0xBA, 0xD9, 0x0E, 
+0x08, 0xEF, 0xAA, 0x8B, 0x54, 0xE8, 0xE4, 0xDA, 
+0xBD, 0x2F, 0xF7, 0xDB, 0x9B, 0x34, 0xD7, 
+
+0x2B, 0xFF, 0x74, 0xEC, 0x1A, 0xD0, 0xC2, 0xAA, 
+0x95, 0x2D, 0xE1, 0xFF, 0x47, 0xFB, 0xE5, 0x59, 
+0x9C, 0xA6, 0x29, 0x57, 0xF7, 0x83, 0xB9, 0x02, 
+0xEA, 0xB2, 0xF0, 0xE7, 0x96, 0xEF, 0x9E, 0x79, 
+0x20, 0xE7, 0x2A, 0xF4, 0x40, 0x4A, 0x40, 0xDC, 
+0x83, 0x30, 0x95, 0xE5, 0x53, 0xBC, 0x88, 0xD2, 
+0x24, 0xD5, 0x46, 0x94, 0x39, 0xC1, 0xD2, 0xFE, 
+0x4A, 0xFC, 0xDE, 0x84, 0xDD, 0xE0, 0x2A, 0xA2, 
+0xF5, 0x51, 0x64, 0xC9, 0xDA, 0xC8, 0x78, 0x86, 
+0xD3, 0xBF, 0x63, 0xAD, 0x5F, 0x3D, 0xD5, 0x74, 
+
+0x42, 0xFD, 0x94, 0x1A, 0x94, 0x29, 0x34, 0xAF, 
+0x9F, 0xAA, 0x56, 0x2A, 0x64, 0xBF, 0x34, 0xD9, 
+0x80, 0x53, 0x79, 0x29, 0xB0, 0x9F, 0x2E, 0x21, 
+0x1A, 0xA3, 0x76, 0xF7, 0xD1, 0xB2, 0xF0, 0x11, 
+0x27, 0x35, 0x12, 0x24, 0xEF, 0x99, 0xC6, 0x17, 
+0xFD, 0x7D, 0x75, 0xFB, 0x90, 0x90, 0xD3, 0x5A, 
+0x01, 0x95, 0x2B, 0x44, 0xEE, 0x51, 0xA5, 0x4B, 
+0x1C, 0xFE, 0xF3, 0xD4, 0x56, 0xB2, 0xA7, 0xF6, 
+0x71, 0xE8, 0xCD, 0x02, 0xF2, 0x17, 0x71, 0xBD, 
+0xE6, 0x77, 0xC3, 0xDB, 0xB4, 0xF7, 0xFA, 0xA3, 
+0xFC, 
+
+0xA2, 0x8A, 0x59, 0x68, 0xB5, 0x36, 0xF3, 0x6E, 
+0x01, 0xBD, 0x0A, 0x57, 0xB9, 0x3B, 0x2B, 0x9B, 
+0xA9, 0xF8, 0x84, 0x82, 0x5A, 0xBD, 0x80, 0x58, 
+0xE7, 0x29, 0xF8, 0x7B, 0x7E, 0xD1, 0xCB, 0xCF, 
+0x39, 0x83, 0x19, 0xDB, 0x11, 0x5E, 0x34, 0x86, 
+0xC8, 0x38, 0x63, 0x5A, 0xDE, 0x87, 0x75, 0x65, 
+0x3C, 0xA6, 0x51, 0xB5, 0xBD, 0x2D, 0x41, 0x78, 
+0xA2, 0x13, 0x8C, 0x71, 0x2F, 0xD7, 0xE7, 0x79, 
+0xA6, 0x43, 0xCD, 0x3F, 0xB8, 0xEA, 0x6A, 0x6B, 
+0x37, 0x99, 0x50, 0x48, 0x1A, 0x83, 0xB5, 0x5A, 
+0x95, 0x87, 
+
+0xA6, 0x9B, 0xB8, 0x5C, 0x93, 0xA1, 0xA2, 0xB5, 
+0xEE, 0x84, 0x0D, 0xEA, 0x11, 0xB9, 0x68, 0x7E, 
+0x68, 0x95, 0xB1, 0x42, 0x7D, 0x8E, 0x39, 0xE6, 
+0xA4, 0x2B, 0x54, 0x2F, 0xD2, 0x7E, 0x55, 0xB5, 
+0x02, 0xD9, 0x47, 0x3A, 0x25, 0x53, 0x7F, 0x5E, 
+0x0B, 0x91, 0xEE, 0x03, 0x5A, 0xA9, 0x1F, 0x1D, 
+0x72, 0xD8, 0x20, 0x4B, 0xF2, 0xBB, 0xF4, 0x93, 
+0x29, 0x0C, 0x56, 0x85, 0x6F, 0x5C, 0xFE, 0x31, 
+0xBB, 0x47, 0x21, 0x15, 0x7D, 0x14, 0x4B, 0xA1, 
+0x51, 0x79, 0x6E, 0x0B, 0xD5, 0x92, 0x6C, 0x21, 
+0x8E, 0x16, 0x7E, 
+
+0x75, 0x5B, 0x7E, 0x41, 0x56, 0xF8, 0xDD, 0x65, 
+0xD6, 0x0E, 0x0B, 0x81, 0x93, 0x3F, 0x9D, 0xA8, 
+0xF3, 0x54, 0xDC, 0x51, 0x1F, 0xB7, 0x5D, 0xF4, 
+0x41, 0xCE, 0xA9, 0x68, 0xEE, 0x2C, 0x23, 0x14, 
+0x90, 0xCF, 0xE8, 0x27, 0x5B, 0x4D, 0xD4, 0xEA, 
+0x89, 0x14, 0xC2, 0x50, 0x07, 0x17, 0x96, 0xE2, 
+0xB2, 0x18, 0x42, 0xBA, 0x7B, 0x4B, 0x7F, 0xB9, 
+0xA9, 0xAE, 0x93, 0x7D, 0xAE, 0xA2, 0x72, 0xCA, 
+0x97, 0xAB, 0x95, 0x03, 0x6E, 0x99, 0x45, 0xAA, 
+0x78, 0x43, 0x22, 0xF5, 0xC4, 0x63, 0x42, 0xA1, 
+0x59, 0xF6, 0x69, 0x94, 
+
+0x6C, 0xF6, 0x21, 0x75, 0xEE, 0x08, 0x5F, 0xE7, 
+0x88, 0x3B, 0x33, 0xA3, 0x49, 0xDF, 0x95, 0x14, 
+0xA0, 0x76, 0x54, 0x4A, 0x81, 0xFB, 0x3A, 0x3C, 
+0x5C, 0xF1, 0x03, 0x2C, 0x6A, 0xBE, 0x72, 0x89, 
+0xAE, 0xB8, 0xC8, 0xE3, 0x37, 0xC8, 0xF2, 0xE2, 
+0xC2, 0xFF, 0x67, 0xF6, 0x4C, 0x7A, 0x08, 0x6E, 
+0x84, 0x0F, 0x5C, 0x9B, 0xEC, 0x1A, 0x89, 0x60, 
+0xAB, 0x3A, 0xB7, 0xD5, 0x73, 0x3A, 0xCB, 0x91, 
+0x19, 0x41, 0x61, 0x50, 0x53, 0x0C, 0xAD, 0xAF, 
+0x16, 0x5F, 0x77, 0xAE, 0xA4, 0x54, 0x0D, 0x40, 
+0xEC, 0x7F, 0x27, 0xBB, 0xF8, 
+
+0xD3, 0x21, 0xAC, 0x3A, 0x53, 0xAC, 0x6C, 0x81, 
+0x46, 0xA1, 0x0B, 0x6E, 0x92, 0xE0, 0xA2, 0xEE, 
+0x2C, 0x94, 0x3D, 0x2A, 0x7B, 0x23, 0x15, 0x8E, 
+0x4A, 0xDF, 0xC6, 0x3C, 0x3C, 0xEC, 0xCD, 0xFE, 
+0xE5, 0xF9, 0x75, 0x52, 0x29, 0x31, 0xA4, 0x94, 
+0x79, 0x58, 0x61, 0x63, 0xEC, 0x58, 0xB8, 0x7D, 
+0x9B, 0x01, 0x89, 0xF5, 0x32, 0xCD, 0xC6, 0xC3, 
+0x43, 0xEC, 0x07, 0xCF, 0x88, 0x59, 0x00, 0x06, 
+0x72, 0x47, 0x4E, 0x44, 0x7F, 0x42, 0x62, 0x1A, 
+0xF7, 0x59, 0xF4, 0xF5, 0x7B, 0xED, 0xBC, 0x83, 
+0x26, 0xC9, 0xB4, 0xE6, 0xBF, 0xC3, 
+
+0xC5, 0x62, 0x27, 0xF8, 0x0F, 0xF2, 0x95, 0x8E, 
+0x2A, 0x9C, 0x62, 0x57, 0x68, 0x9E, 0xCC, 0x66, 
+0x84, 0x94, 0x0B, 0xF2, 0xBD, 0x8B, 0xC1, 0x02, 
+0x77, 0x7F, 0x3C, 0x60, 0xF1, 0xBC, 0xF8, 0x2A, 
+0xBF, 0xC6, 0x4D, 0xA1, 0xC2, 0x8A, 0x94, 0xB2, 
+0xEE, 0x3C, 0x6A, 0xB1, 0xC2, 0x6D, 0xE4, 0xF0, 
+0x58, 0xF4, 0x66, 0xB7, 0x56, 0x1E, 0x45, 0x3F, 
+0x69, 0x6E, 0xF9, 0x30, 0xD1, 0x76, 0x85, 0x05, 
+0xE9, 0xB1, 0x39, 0xDE, 0x33, 0x1D, 0x5E, 0x04, 
+0x3B, 0x2F, 0x15, 0xD2, 0x98, 0x9E, 0xD6, 0xE7, 
+0x27, 0x5A, 0xD4, 0xD9, 0xE8, 0xC3, 0x76, 
+
+0xA8, 0x56, 0x54, 0x7E, 0x0A, 0xB2, 0xFF, 0xAF, 
+0xE8, 0x43, 0xA4, 0x40, 0xA9, 0xA9, 0x7B, 0x7F, 
+0x0A, 0x01, 0x57, 0x2A, 0x3D, 0x38, 0x11, 0xE6, 
+0xF8, 0x3C, 0x80, 0x9B, 0x7A, 0x11, 0xAC, 0xD6, 
+0xF9, 0xB8, 0x61, 0x57, 0xB4, 0x3A, 0x45, 0xE3, 
+0x1D, 0xA9, 0x83, 0x8E, 0xE8, 0x03, 0xAB, 0xA7, 
+0x85, 0xAA, 0xE3, 0x21, 0x95, 0x30, 0xDE, 0x8E, 
+0x06, 0x4A, 0xF8, 0x95, 0x16, 0x7B, 0x0B, 0xFF, 
+0x59, 0xFF, 0xE7, 0x05, 0x77, 0x92, 0x03, 0x22, 
+0xC0, 0x36, 0x48, 0x92, 0x8F, 0x27, 0xB8, 0xBE, 
+0xA9, 0x76, 0x09, 0x80, 0x7C, 0x48, 0xC5, 0xFE, 
+
+0x46, 0x91, 0xFE, 0xFB, 0xE6, 0x8F, 0x3B, 0x89, 
+0xF5, 0xE6, 0xF7, 0xB3, 0xCF, 0x8B, 0x4B, 0x48, 
+0x6D, 0xB6, 0x5E, 0xCF, 0x05, 0x2D, 0x73, 0xE8, 
+0x19, 0x86, 0x36, 0x00, 0x15, 0xF7, 0xEC, 0xDA, 
+0x66, 0x24, 0x3E, 0xBC, 0x78, 0x7B, 0x00, 0x57, 
+0x6A, 0x7C, 0xDD, 0x19, 0x79, 0x18, 0xF9, 0xE2, 
+0xDA, 0x8C, 0x96, 0x78, 0x8B, 0x90, 0xAB, 0x56, 
+0xAD, 0x15, 0x27, 0xBA, 0x54, 0xB3, 0xE5, 0x4A, 
+0x12, 0x31, 0x7A, 0xD6, 0x18, 0xBA, 0xD3, 0x1F, 
+0x78, 0x28, 0x84, 0xC6, 0x31, 0xCA, 0xBC, 0xBF, 
+0xC7, 0x7E, 0x0E, 0xFF, 0x88, 0x44, 0x7C, 0x59, 
+0xA9, 
+
+0xD6, 0xED, 0x9C, 0x0F, 0xE8, 0xEF, 0x62, 0xD1, 
+0x15, 0xD1, 0x25, 0x21, 0xF5, 0xF7, 0xB6, 0x4A, 
+0xEB, 0x49, 0x9E, 0xCE, 0x46, 0x55, 0x8E, 0x97, 
+0xFE, 0xE1, 0xC4, 0xED, 0xD6, 0xD9, 0x0E, 0x25, 
+0x99, 0xD4, 0x53, 0xE3, 0x6C, 0xC0, 0xE1, 0x6B, 
+0xF9, 0x56, 0x2D, 0xF7, 0xB5, 0x54, 0xF2, 0xE5, 
+0x92, 0xB1, 0x72, 0x2D, 0x87, 0x61, 0x4E, 0x10, 
+0x6C, 0x3D, 0xD1, 0x06, 0xFF, 0xAA, 0x42, 0x44, 
+0xBE, 0x92, 0x1C, 0xDA, 0x04, 0x81, 0x32, 0xD0, 
+0xD6, 0xF1, 0x0D, 0xDF, 0x9B, 0x9F, 0x1F, 0x55, 
+0x90, 0x12, 0xD1, 0xC4, 0x69, 0x5E, 0xE4, 0x18, 
+0x58, 0x4B, 
+
+0x4B, 0x82, 0x82, 0x10, 0x97, 0x18, 0xA3, 0x93, 
+0xC1, 0x8B, 0x12, 0x39, 0x5B, 0x3A, 0x01, 0x76, 
+0x9A, 0xFE, 0x31, 0x28, 0x9E, 0x37, 0x7F, 0xF1, 
+0xD7, 0x3C, 0xD6, 0x65, 0x91, 0x83, 0x79, 0x29, 
+0x56, 0xBE, 0xFE, 0x49, 0x26, 0x11, 0xE8, 0xBE, 
+0x30, 0x0F, 0xB3, 0x49, 0xCF, 0x12, 0xD8, 0x2C, 
+0xFF, 0xF3, 0x5F, 0x51, 0xF2, 0x0E, 0x9E, 0x13, 
+0x76, 0xC1, 0x79, 0xC9, 0xA5, 0x98, 0x9C, 0x03, 
+0x5C, 0x95, 0x7C, 0x4A, 0x95, 0x9E, 0x4C, 0x7E, 
+0x03, 0xCB, 0xCF, 0x2C, 0xAC, 0xDB, 0x57, 0x5D, 
+0xA2, 0x65, 0x15, 0xC6, 0x8F, 0xCB, 0x8A, 0xF3, 
+0xD9, 0x97, 0xFB, 
+
+0x3C, 0xCE, 0x11, 0x32, 0x92, 0x41, 0x59, 0x26, 
+0x30, 0x2C, 0xA2, 0x68, 0xC4, 0xBA, 0x7F, 0x89, 
+0x8A, 0x41, 0x58, 0x2A, 0xAE, 0x01, 0xD7, 0x94, 
+0xF3, 0xA1, 0x32, 0xB1, 0x7B, 0xA0, 0xF1, 0xE3, 
+0xC0, 0x37, 0xAE, 0x54, 0xD9, 0x4B, 0x19, 0xCF, 
+0xD3, 0x74, 0xD1, 0x04, 0xD1, 0x31, 0x5F, 0x21, 
+0x79, 0x88, 0x58, 0x8A, 0x39, 0xAF, 0x1A, 0x99, 
+0x9A, 0xB6, 0x01, 0xCD, 0x46, 0xC5, 0x7C, 0x16, 
+0xF4, 0xA6, 0x4B, 0x6F, 0x20, 0x37, 0xB7, 0x17, 
+0x42, 0xE4, 0xF5, 0xC0, 0xFA, 0xFE, 0x3B, 0x82, 
+0x7B, 0x02, 0xD2, 0xE8, 0xA9, 0x81, 0xF0, 0xB1, 
+0x43, 0x25, 0xDA, 0x3C, 
+
+0x40, 0x48, 0x58, 0x4B, 0x8B, 0x35, 0x11, 0x26, 
+0xE8, 0x8B, 0x42, 0x9F, 0x69, 0x84, 0x57, 0x98, 
+0x87, 0x2D, 0xD0, 0x3D, 0x94, 0x94, 0xDD, 0x6D, 
+0x5D, 0x8D, 0xFF, 0x0B, 0xA1, 0x5D, 0xD9, 0xAD, 
+0x2A, 0x64, 0x8A, 0x3B, 0xB1, 0xAD, 0x17, 0x91, 
+0x2A, 0xA2, 0xC9, 0x3C, 0x79, 0xD9, 0x0F, 0x4C, 
+0x6E, 0x67, 0xDD, 0x19, 0xF0, 0xFE, 0xBF, 0x04, 
+0xBA, 0xF7, 0x28, 0x1C, 0x30, 0xBD, 0xFE, 0x39, 
+0x4B, 0x57, 0xC0, 0x13, 0x77, 0x6A, 0xA9, 0x22, 
+0x47, 0x50, 0x0A, 0xEC, 0xFC, 0x55, 0x22, 0xA5, 
+0x74, 0x81, 0xFD, 0x4F, 0x04, 0x63, 0xCB, 0x54, 
+0x6D, 0x44, 0x3B, 0xE7, 0x6C, 
+
+0xC7, 0x10, 0x0D, 0x0F, 0x62, 0x44, 0x72, 0xED, 
+0xA1, 0xA5, 0x5F, 0xFE, 0x21, 0x9E, 0xB8, 0x3B, 
+0x19, 0x82, 0x81, 0xC7, 0xB7, 0x12, 0x6C, 0xA8, 
+0x3F, 0x99, 0x8E, 0x30, 0x31, 0xCD, 0xC0, 0xF1, 
+0x87, 0x75, 0xFF, 0xA6, 0xC9, 0x8B, 0xF6, 0x87, 
+0x6A, 0xC8, 0xD4, 0xB9, 0xB9, 0x70, 0x63, 0xC3, 
+0xBE, 0x88, 0x6C, 0xBC, 0xEC, 0xA2, 0xD9, 0x38, 
+0x01, 0xB0, 0xA9, 0xA4, 0x90, 0x37, 0xAB, 0x33, 
+0x10, 0x53, 0x82, 0x88, 0xE0, 0x33, 0x43, 0x7F, 
+0x7B, 0x82, 0x6A, 0x29, 0xF3, 0x61, 0x69, 0xF3, 
+0xC4, 0x53, 0xD8, 0x87, 0x92, 0x28, 0x0F, 0x5C, 
+0xD7, 0x98, 0x84, 0xF6, 0x0A, 0x77, 
+
+0xAF, 0x8E, 0x6A, 0x95, 0x27, 0x21, 0x87, 0xF0, 
+0x9C, 0x55, 0x91, 0x3E, 0x4B, 0x71, 0x0F, 0x1E, 
+0x6B, 0x20, 0x90, 0xB0, 0x37, 0xCF, 0xB7, 0xDF, 
+0x06, 0x14, 0x27, 0x5B, 0x4F, 0xC0, 0x5A, 0xBC, 
+0xF2, 0x5C, 0x74, 0x77, 0x21, 0xD3, 0x84, 0x5E, 
+0xDE, 0x99, 0x87, 0xFE, 0x55, 0x77, 0xBA, 0x13, 
+0x03, 0x0D, 0xC7, 0xF9, 0x3A, 0xD3, 0xD9, 0xAC, 
+0xE8, 0x32, 0x54, 0x30, 0xFB, 0x82, 0x15, 0x4C, 
+0xE6, 0x8E, 0xAE, 0xE2, 0x3C, 0x18, 0x47, 0x7E, 
+0xEA, 0xE7, 0x1E, 0xFE, 0x22, 0x00, 0x5C, 0x06, 
+0xD5, 0x60, 0x83, 0xC7, 0x72, 0xD3, 0x2F, 0xD8, 
+0x22, 0x7F, 0x4E, 0xE5, 0xA3, 0xDA, 0x0A, 
+
+0x4D, 0x15, 0x76, 0x5C, 0xDA, 0xC1, 0x86, 0x1E, 
+0x70, 0xD6, 0x79, 0xDF, 0x3E, 0x47, 0x40, 0xDD, 
+0xCA, 0x8D, 0x57, 0x3A, 0x5E, 0x5A, 0xC6, 0xCD, 
+0x02, 0xB2, 0xEE, 0x19, 0xF1, 0x58, 0x9F, 0x15, 
+0xD5, 0x40, 0xC2, 0x9C, 0xBE, 0x5A, 0xC2, 0xF5, 
+0xED, 0x27, 0x54, 0x32, 0xC6, 0xA9, 0x69, 0x24, 
+0x83, 0x76, 0x36, 0xED, 0x60, 0x12, 0x29, 0x07, 
+0x57, 0x32, 0x42, 0x08, 0xE0, 0x99, 0xE4, 0x19, 
+0xD0, 0x83, 0x80, 0xF4, 0x15, 0x72, 0xAC, 0x71, 
+0xDA, 0x1F, 0x90, 0x01, 0xF8, 0xFE, 0xDA, 0x5D, 
+0x25, 0xFE, 0x3B, 0x6A, 0x22, 0xA5, 0xE4, 0x77, 
+0xFD, 0xE3, 0x7B, 0xE2, 0x56, 0xA0, 0x06, 0x44, 
+
+0x55, 0x92, 0x89, 0x26, 0xDE, 0x42, 0x95, 0x3C, 
+0x4A, 0x2F, 0xFC, 0x8E, 0xA7, 0x0B, 0x6B, 0xF4, 
+0x20, 0x91, 0x23, 0x69, 0x3C, 0x7B, 0xDA, 0xCB, 
+0x42, 0x84, 0xE1, 0x57, 0x0A, 0xD8, 0x0F, 0x51, 
+0xA2, 0x66, 0xF5, 0x26, 0x7E, 0x0B, 0x53, 0x45, 
+0xCA, 0x22, 0xA7, 0x35, 0x94, 0x0A, 0x4A, 0x65, 
+0x32, 0x0B, 0x87, 0x45, 0x80, 0x33, 0xEB, 0xA8, 
+0xBC, 0xFF, 0xCA, 0xB2, 0x61, 0xE0, 0x08, 0x10, 
+0xB6, 0x31, 0x65, 0x24, 0xF3, 0x30, 0x8D, 0xC4, 
+0x00, 0x35, 0x7B, 0xEB, 0x9F, 0x31, 0x75, 0x97, 
+0x9E, 0xB5, 0x5C, 0xBC, 0x92, 0x54, 0xB5, 0x8C, 
+0x41, 0x44, 0xFA, 0x2C, 0xA7, 0xE4, 0x77, 0x8F, 
+0x17, 
+
+0x86, 0xAE, 0xDA, 0xF6, 0x79, 0xA9, 0x3A, 0x8A, 
+0xFC, 0x22, 0xAF, 0x0E, 0x71, 0x11, 0xBF, 0xA3, 
+0x34, 0x0C, 0x61, 0x7F, 0x89, 0x5E, 0x2E, 0xEB, 
+0xA4, 0x08, 0xB2, 0x53, 0xB0, 0x5B, 0x34, 0xD5, 
+0x0D, 0xD0, 0x9E, 0x83, 0x00, 0xD0, 0x85, 0x8F, 
+0x50, 0xD1, 0x34, 0x54, 0x53, 0x70, 0xD3, 0x95, 
+0x7D, 0x08, 0x5E, 0x42, 0xA3, 0x5F, 0x2C, 0x57, 
+0x5B, 0x8A, 0x4A, 0x21, 0x1B, 0xBA, 0x75, 0xD8, 
+0x28, 0x3A, 0xB9, 0x1B, 0x03, 0x51, 0x6E, 0xF6, 
+0x9F, 0xE3, 0xFD, 0x6A, 0x49, 0xCB, 0x94, 0xBD, 
+0xCD, 0x57, 0x02, 0x2D, 0x97, 0xFD, 0xA2, 0x4D, 
+0x50, 0xE6, 0xEC, 0xEC, 0xFD, 0xD4, 0x44, 0xD7, 
+0x3D, 0x92, 
+
+0xC7, 0x1F, 0x0F, 0x9D, 0x2C, 0x4E, 0x69, 0xEB, 
+0x6A, 0xD1, 0x2C, 0xE7, 0x84, 0x11, 0x74, 0xC9, 
+0x7B, 0xD4, 0x08, 0xDD, 0xBF, 0x27, 0x12, 0xAE, 
+0xDD, 0x91, 0x78, 0x22, 0x33, 0xEE, 0x38, 0x62, 
+0xC6, 0xA0, 0x69, 0xBF, 0xB6, 0x85, 0x07, 0x06, 
+0x2C, 0xA3, 0xD4, 0x14, 0x42, 0x85, 0x66, 0x63, 
+0xEF, 0x3F, 0x27, 0xCE, 0x41, 0xE6, 0xD1, 0x5C, 
+0x4D, 0x33, 0xC7, 0xF5, 0x7A, 0xC2, 0xF2, 0x7A, 
+0x21, 0x78, 0xEB, 0x75, 0x9E, 0xB7, 0x91, 0x56, 
+0x69, 0x23, 0x70, 0xDC, 0xA5, 0x72, 0x51, 0x23, 
+0x19, 0xCE, 0x41, 0x95, 0x67, 0x4C, 0xD2, 0x42, 
+0x6B, 0x44, 0x58, 0xC4, 0x11, 0x76, 0xF1, 0xC0, 
+0xAC, 0x11, 0x09, 
+
+0x65, 0x59, 0x9A, 0xC4, 0x7E, 0xDF, 0x33, 0xD2, 
+0x38, 0x5B, 0x87, 0x94, 0x80, 0x91, 0xE2, 0x5A, 
+0x00, 0x4F, 0xB6, 0x6D, 0x6E, 0x94, 0xF1, 0xDA, 
+0xEE, 0xA5, 0xB8, 0xD6, 0x6A, 0x9A, 0xEE, 0x24, 
+0xE4, 0x91, 0x19, 0x3F, 0x02, 0xF0, 0x8C, 0xEF, 
+0x7F, 0x36, 0x2D, 0xB3, 0x2B, 0x85, 0xC2, 0xC9, 
+0xC8, 0x3F, 0xFB, 0xB7, 0x93, 0xB0, 0xE3, 0x37, 
+0x60, 0x46, 0x25, 0x67, 0x79, 0x13, 0x93, 0x4A, 
+0xEC, 0x8D, 0x62, 0x55, 0xEF, 0xD0, 0x15, 0x5C, 
+0x97, 0x76, 0xAA, 0x20, 0x47, 0xA0, 0x39, 0x3E, 
+0xE2, 0x8B, 0x43, 0xDE, 0xB9, 0x37, 0xDB, 0xE1, 
+0x6F, 0x95, 0xBE, 0x9F, 0x1F, 0x81, 0x5F, 0xDC, 
+0x13, 0xA5, 0xB0, 0x52, 
+
+0x92, 0x85, 0x6B, 0x29, 0x58, 0xD2, 0x51, 0x21, 
+0xD4, 0x6A, 0x32, 0x86, 0x0E, 0x0F, 0x95, 0x92, 
+0x17, 0xBC, 0x96, 0xBE, 0x83, 0x16, 0x1E, 0x6A, 
+0xE3, 0xE9, 0x4D, 0xD7, 0x63, 0x04, 0xA5, 0x0A, 
+0xEF, 0xFC, 0xD0, 0x41, 0x3B, 0x79, 0xEE, 0x57, 
+0x68, 0x5B, 0x86, 0x3B, 0xD2, 0xDC, 0xDC, 0xBB, 
+0x76, 0x3E, 0xEC, 0x34, 0x80, 0x33, 0x21, 0xE1, 
+0x9E, 0x7C, 0x86, 0xF8, 0xD0, 0x59, 0x07, 0x40, 
+0xD7, 0x34, 0xD8, 0xE9, 0x3B, 0xC0, 0x96, 0x10, 
+0x36, 0xA8, 0xA6, 0x14, 0xCF, 0xC7, 0x36, 0x20, 
+0xC3, 0x29, 0xD1, 0xA3, 0xEA, 0x6F, 0xF1, 0x99, 
+0xC0, 0xBE, 0x45, 0x0A, 0x8F, 0xC4, 0xE8, 0x0E, 
+0xCE, 0x1D, 0x5B, 0xB5, 0xDC, 
+
+0xDE, 0xB3, 0x8A, 0x6A, 0x9A, 0x01, 0x5B, 0xEA, 
+0xB9, 0x95, 0xEE, 0x8F, 0xBC, 0x10, 0x80, 0x65, 
+0x5B, 0x2B, 0xDE, 0x06, 0x3D, 0xBB, 0xDE, 0x8A, 
+0xCA, 0x45, 0x86, 0xF6, 0x11, 0x6E, 0xB6, 0x56, 
+0x03, 0x5D, 0x0E, 0x8D, 0x3D, 0x9A, 0xE1, 0xFC, 
+0x27, 0x14, 0xF6, 0x15, 0x9E, 0xB7, 0x72, 0x92, 
+0xC5, 0xAC, 0x60, 0x1D, 0x9C, 0xF6, 0x34, 0x68, 
+0x96, 0x55, 0x82, 0x84, 0xAD, 0x10, 0x09, 0xE5, 
+0xD9, 0x02, 0xA2, 0xB6, 0x54, 0xB2, 0x1F, 0x5C, 
+0x40, 0x83, 0x6A, 0x92, 0xAA, 0x78, 0x1B, 0xFE, 
+0xB7, 0x0E, 0x8F, 0x97, 0xAF, 0x23, 0xD5, 0x99, 
+0xA3, 0x01, 0x7C, 0x3A, 0x9D, 0x6E, 0x80, 0x09, 
+0x23, 0xA8, 0x2D, 0x82, 0xAC, 0xAC, 
+
+0x7F, 0x3C, 0x80, 0x5A, 0xA0, 0x3B, 0xBB, 0xEF, 
+0x5D, 0x8C, 0x34, 0xD4, 0x17, 0x98, 0x73, 0xEF, 
+0xF8, 0xBF, 0xB9, 0x28, 0x69, 0x6E, 0x90, 0x1F, 
+0xE9, 0x86, 0x16, 0x04, 0x0F, 0xB9, 0xB1, 0x91, 
+0xB0, 0x47, 0x00, 0x2A, 0xD1, 0x1B, 0x4F, 0x6A, 
+0x56, 0x98, 0x62, 0x00, 0xCF, 0x96, 0x6A, 0xBC, 
+0x88, 0xE6, 0x5B, 0xE4, 0x0A, 0x75, 0x11, 0xED, 
+0x6E, 0x27, 0x46, 0xB0, 0x09, 0xA5, 0x27, 0xB2, 
+0x35, 0x26, 0xC9, 0xAE, 0xC7, 0x64, 0x22, 0xA8, 
+0x5A, 0x4C, 0x9E, 0x3B, 0x52, 0xEF, 0x90, 0xEE, 
+0x85, 0xC9, 0x85, 0xB1, 0x08, 0xAA, 0xFF, 0x4E, 
+0xD5, 0xDD, 0x69, 0xC2, 0x14, 0xAA, 0xE6, 0xAF, 
+0x88, 0xF2, 0xF3, 0x08, 0x9C, 0xC3, 0x6B, 
+
+0xFE, 0x05, 0x4E, 0x2F, 0x68, 0x69, 0x59, 0x57, 
+0x59, 0x93, 0xA4, 0xD9, 0x33, 0x6E, 0x6E, 0xC5, 
+0x35, 0x92, 0xA0, 0x50, 0xAE, 0xBD, 0xD1, 0x4A, 
+0x30, 0xCB, 0x11, 0x8D, 0x6C, 0xDB, 0x41, 0x24, 
+0x37, 0x36, 0xD8, 0xF7, 0x26, 0x04, 0x55, 0x46, 
+0x63, 0xB6, 0x5C, 0x3B, 0x40, 0x09, 0x06, 0xEA, 
+0x67, 0xD7, 0xE2, 0x2E, 0x1E, 0x44, 0x01, 0x9B, 
+0xA8, 0x1E, 0xCA, 0xBA, 0x02, 0xCD, 0x92, 0xF1, 
+0x92, 0x39, 0xE9, 0xD7, 0xC6, 0xC4, 0xCA, 0xFC, 
+0x34, 0xB0, 0x27, 0xD6, 0x12, 0x61, 0x28, 0xDE, 
+0x3B, 0x97, 0x7A, 0x84, 0xD1, 0xA4, 0x66, 0x62, 
+0xEB, 0xA6, 0x49, 0x70, 0x6D, 0x93, 0x7E, 0x36, 
+0xD5, 0x2A, 0x68, 0x8E, 0x04, 0xE5, 0xE0, 0x64, 
+
+0x1D, 0x7B, 0xD6, 0x02, 0x3D, 0x2A, 0xD4, 0xD3, 
+0x18, 0xBE, 0x06, 0xCD, 0xF2, 0xCB, 0x4B, 0x72, 
+0x72, 0xD4, 0xA1, 0xD2, 0x00, 0xB9, 0x29, 0x43, 
+0x86, 0x36, 0xBC, 0x18, 0xAF, 0x4B, 0xE6, 0x33, 
+0xF4, 0x1E, 0x86, 0x9D, 0x70, 0xA4, 0x4C, 0x8B, 
+0x5D, 0xC4, 0x6B, 0xCD, 0x6C, 0x7E, 0x2F, 0xD5, 
+0xAC, 0x4C, 0xBB, 0x92, 0xDE, 0x88, 0xED, 0x9E, 
+0x43, 0x71, 0xBC, 0x45, 0x9B, 0xC5, 0xEF, 0xDC, 
+0x1E, 0xA7, 0x4B, 0x63, 0x4D, 0x63, 0xBE, 0x7E, 
+0x29, 0xAD, 0x33, 0x46, 0x5B, 0xF5, 0xBD, 0x63, 
+0x5D, 0x05, 0x0D, 0x04, 0x71, 0xA1, 0xF3, 0x1D, 
+0xF8, 0xAB, 0xFC, 0x5D, 0x23, 0x84, 0x94, 0xFC, 
+0xF3, 0x50, 0x17, 0xC4, 0x6A, 0x84, 0x0F, 0xAA, 
+0x62, 
+
+0x47, 0x60, 0xDA, 0x9B, 0x31, 0xA4, 0x83, 0x29, 
+0x66, 0xBC, 0x62, 0xC6, 0x77, 0x2C, 0x50, 0x47, 
+0xAB, 0xFC, 0x2F, 0x81, 0x07, 0x42, 0xAC, 0x99, 
+0x39, 0x38, 0x96, 0x4E, 0xC8, 0x95, 0x9F, 0x35, 
+0x64, 0xB0, 0xBF, 0x78, 0xDD, 0x1C, 0xD6, 0x4B, 
+0xF1, 0x9B, 0xDB, 0x85, 0x7D, 0x49, 0xC4, 0xB6, 
+0xE2, 0xEE, 0x28, 0x52, 0xA1, 0xE8, 0xF6, 0xB5, 
+0xA4, 0xFB, 0x96, 0xD8, 0x6B, 0xF3, 0x70, 0x27, 
+0x92, 0xA7, 0xF8, 0x6F, 0x28, 0xD4, 0x5F, 0xF7, 
+0xAD, 0xB8, 0x25, 0x07, 0xC1, 0x1C, 0xE3, 0x4E, 
+0xAB, 0x41, 0xCF, 0xCE, 0x43, 0x12, 0xD5, 0xD9, 
+0xC6, 0x24, 0xAF, 0x6F, 0x70, 0xEF, 0x8B, 0xB9, 
+0xFF, 0x5F, 0x45, 0xE6, 0x8F, 0xFF, 0x3D, 0x5E, 
+0x6C, 0xEA, 
+
+0xC7, 0x85, 0x4D, 0x8C, 0xC2, 0xDC, 0x55, 0xC8, 
+0x5A, 0xD1, 0x8A, 0x28, 0x84, 0xA8, 0xBF, 0x78, 
+0xE0, 0x13, 0x0F, 0xA3, 0x9C, 0xC9, 0x93, 0x0E, 
+0xE2, 0xAD, 0x78, 0x4A, 0x5A, 0x0E, 0xCA, 0x39, 
+0x00, 0x67, 0xA4, 0xAA, 0xA1, 0x85, 0xCE, 0x56, 
+0xA8, 0xBF, 0x01, 0x95, 0x43, 0x37, 0xF1, 0xF7, 
+0x9B, 0xDE, 0x15, 0xD5, 0x5B, 0x66, 0xB8, 0x6F, 
+0x27, 0xA6, 0xCF, 0x73, 0xE1, 0xEC, 0x31, 0xAE, 
+0xD9, 0x6D, 0x48, 0x28, 0x45, 0x18, 0x6D, 0x8D, 
+0x7D, 0xAE, 0x41, 0x2D, 0xC4, 0xEB, 0x22, 0xF8, 
+0x62, 0x78, 0x92, 0xEA, 0x70, 0x56, 0x30, 0xB5, 
+0x93, 0x9F, 0x42, 0xF6, 0x02, 0xFC, 0x2C, 0x12, 
+0x50, 0xA8, 0x72, 0xFC, 0x79, 0x6D, 0xBE, 0x9D, 
+0xA5, 0x0D, 0x69, 
+
+0xF3, 0xD0, 0x7A, 0x6D, 0x0F, 0x26, 0xFE, 0xAC, 
+0x35, 0x30, 0xA0, 0xAF, 0xC0, 0x19, 0xB8, 0xE0, 
+0xA9, 0x43, 0xCD, 0xC9, 0xF3, 0xE8, 0x70, 0x69, 
+0x30, 0xB2, 0xC3, 0xC4, 0xC2, 0x3D, 0xDB, 0xAF, 
+0xBB, 0x20, 0x00, 0xDA, 0x71, 0x45, 0xCB, 0xDA, 
+0x2A, 0xC8, 0xC3, 0xAB, 0xE8, 0x9F, 0xEB, 0x3E, 
+0x4A, 0xF0, 0x2A, 0xD4, 0x13, 0xDB, 0xFD, 0xE3, 
+0x3D, 0x76, 0x81, 0x90, 0xCF, 0x89, 0x53, 0xF2, 
+0x57, 0x38, 0xC7, 0x6C, 0x17, 0xDF, 0xBE, 0xF8, 
+0x61, 0x9A, 0x38, 0x38, 0x26, 0xB8, 0x59, 0x85, 
+0x67, 0x3F, 0x25, 0xF0, 0xB4, 0x81, 0x70, 0xDC, 
+0xE5, 0x3A, 0xA4, 0x0A, 0x93, 0x3F, 0xD8, 0xDE, 
+0x3E, 0x52, 0x0C, 0x7E, 0x7D, 0x44, 0x29, 0x0E, 
+0xF7, 0x38, 0x44, 0x66, 
+
+0x3B, 0x81, 0xB0, 0xF0, 0xEC, 0xE8, 0xA9, 0xD9, 
+0x10, 0xEB, 0x21, 0x22, 0xD0, 0xE8, 0x98, 0x32, 
+0x87, 0xE5, 0x20, 0x0E, 0x25, 0x63, 0x69, 0xD7, 
+0x3B, 0x6E, 0x67, 0x52, 0xD2, 0x60, 0x61, 0x30, 
+0x52, 0x2C, 0x17, 0x75, 0x0A, 0xE1, 0x55, 0x12, 
+0xE3, 0x92, 0xE1, 0xAC, 0xD9, 0xBB, 0xCE, 0x4B, 
+0x9D, 0x37, 0xE4, 0xB9, 0x45, 0xAD, 0xEC, 0xC5, 
+0x4E, 0x5E, 0xD2, 0xC3, 0x68, 0x60, 0x69, 0xC0, 
+0x34, 0x52, 0xFC, 0xAD, 0x08, 0x58, 0x24, 0x79, 
+0xFA, 0x2E, 0x56, 0x9B, 0x2F, 0x00, 0x33, 0x95, 
+0x88, 0x64, 0x15, 0x9C, 0x0F, 0xC6, 0x73, 0x95, 
+0xB6, 0xBE, 0xF8, 0x5E, 0x41, 0x7B, 0x9E, 0x8F, 
+0xC0, 0xFA, 0x9A, 0x97, 0x99, 0x3F, 0xD0, 0x98, 
+0xF4, 0xCC, 0xE3, 0x80, 0xDB, 
+
+0x97, 0xCD, 0x01, 0x27, 0x34, 0xFD, 0x9F, 0xF0, 
+0x42, 0xFA, 0xD9, 0xBC, 0xAB, 0x06, 0x75, 0x69, 
+0x83, 0x38, 0xD4, 0x39, 0x76, 0x36, 0x14, 0x38, 
+0xCE, 0x35, 0xFD, 0x85, 0xC7, 0x49, 0x95, 0x2E, 
+0x3A, 0x75, 0xE9, 0xDC, 0x01, 0xBB, 0xAF, 0xA2, 
+0xD0, 0x69, 0xC0, 0xEC, 0xDD, 0xBE, 0x25, 0x6D, 
+0x9B, 0xC3, 0xF9, 0x9C, 0x79, 0x8D, 0x12, 0x36, 
+0x96, 0x50, 0xC7, 0x62, 0x31, 0x06, 0x45, 0x4F, 
+0x94, 0xF5, 0x9E, 0x1D, 0xF2, 0xD6, 0x46, 0xE8, 
+0xE1, 0xD3, 0x69, 0xD3, 0x29, 0xEF, 0x0A, 0x8A, 
+0xDF, 0x85, 0x0D, 0x2B, 0xB0, 0x5C, 0xCC, 0x88, 
+0x0C, 0x0F, 0x92, 0x0F, 0x45, 0xCE, 0x2C, 0xCC, 
+0x01, 0x9F, 0x6C, 0xEA, 0xDD, 0x04, 0x75, 0x7E, 
+0x2D, 0xF7, 0x1C, 0x5D, 0xD2, 0x9C, 
+
+0x79, 0x62, 0x87, 0xD3, 0x32, 0xA5, 0xB0, 0xF1, 
+0x6A, 0x07, 0xA2, 0xAD, 0xF9, 0x7B, 0xD5, 0x15, 
+0x52, 0xAA, 0x7B, 0xB5, 0xCF, 0x66, 0x5A, 0x2C, 
+0x57, 0xD6, 0x69, 0xDF, 0xD5, 0xA6, 0x36, 0x69, 
+0x69, 0x06, 0xA2, 0xF4, 0xAF, 0x2C, 0x27, 0xF2, 
+0x37, 0xF0, 0xD7, 0x95, 0x09, 0x9F, 0x78, 0x22, 
+0x29, 0x43, 0x75, 0xA6, 0xAA, 0x6C, 0xC0, 0x0B, 
+0xAC, 0x44, 0xCE, 0x66, 0x4D, 0xAE, 0x99, 0x4A, 
+0xE4, 0xE9, 0xB3, 0xD7, 0xD1, 0x61, 0x2A, 0x8A, 
+0x01, 0x92, 0x47, 0x44, 0xA1, 0xF9, 0x77, 0x30, 
+0xD8, 0x77, 0x72, 0x9C, 0xEA, 0x08, 0xF3, 0xCF, 
+0x1A, 0x83, 0x92, 0xFD, 0xEA, 0xA3, 0x4E, 0xFB, 
+0xFD, 0x8B, 0xCB, 0x3E, 0xE7, 0x15, 0xFE, 0x18, 
+0x4A, 0x36, 0x1B, 0xA4, 0xEC, 0xE6, 0x67, 
+
+0x0E, 0x27, 0x0A, 0xDF, 0xB3, 0x41, 0x6C, 0x80, 
+0xDF, 0x7F, 0xBC, 0x0B, 0x60, 0x19, 0x0B, 0x22, 
+0xCF, 0x7E, 0x42, 0x21, 0xB7, 0xA2, 0x8D, 0xBB, 
+0xA5, 0x2E, 0xB5, 0x36, 0x3F, 0xFC, 0x3F, 0x4A, 
+0x60, 0x02, 0xA6, 0x27, 0x68, 0x04, 0x55, 0x43, 
+0xFC, 0x8E, 0xBC, 0x48, 0xE4, 0xA6, 0xDC, 0x6C, 
+0x6E, 0x79, 0x76, 0xB6, 0x30, 0xB5, 0x55, 0x6E, 
+0x24, 0x76, 0x8F, 0x91, 0x8A, 0x43, 0xF9, 0x35, 
+0x45, 0xF7, 0x82, 0xD9, 0xFE, 0x0C, 0x67, 0xFE, 
+0x30, 0xAC, 0x89, 0x55, 0x47, 0x57, 0x88, 0x6A, 
+0x3B, 0x8D, 0xF4, 0xCD, 0xD6, 0xC1, 0xD9, 0xEB, 
+0x21, 0x2B, 0xA6, 0x0C, 0x35, 0x50, 0x6E, 0x66, 
+0xB7, 0x22, 0x8F, 0x05, 0xFA, 0x81, 0x31, 0x24, 
+0x23, 0xDE, 0x4A, 0x30, 0x26, 0xFD, 0x88, 0xC5, 
+
+0xBF, 0xEC, 0xF5, 0xB0, 0x7A, 0x8D, 0x3D, 0x79, 
+0xFB, 0x55, 0x23, 0x8E, 0x20, 0x3D, 0x70, 0xF9, 
+0x67, 0xB7, 0xDE, 0x42, 0x6D, 0xED, 0x04, 0xB3, 
+0x14, 0x61, 0x79, 0xEB, 0xB3, 0x1F, 0x00, 0xCA, 
+0xA8, 0xA0, 0xC2, 0xD0, 0x18, 0xD4, 0x16, 0xC3, 
+0xA6, 0x43, 0x7E, 0xAD, 0x25, 0xDA, 0xAC, 0xE2, 
+0xAB, 0x8C, 0xE6, 0xDB, 0xD1, 0x21, 0xD1, 0x5F, 
+0x78, 0x21, 0xB0, 0xE2, 0x21, 0x56, 0x24, 0x3C, 
+0xCD, 0x4D, 0x8E, 0x5D, 0xEB, 0xFD, 0x9E, 0xF2, 
+0x3C, 0xFD, 0x95, 0xB2, 0xA1, 0xB5, 0xC9, 0x27, 
+0x66, 0xC9, 0xE2, 0xEE, 0xF6, 0x23, 0x2F, 0x55, 
+0xD3, 0xCD, 0xAF, 0xBC, 0xD6, 0x45, 0xE1, 0xBA, 
+0xCF, 0x65, 0xD5, 0x38, 0xEB, 0x4B, 0x5E, 0xE5, 
+0x66, 0x27, 0xA0, 0x79, 0x28, 0xB1, 0xF0, 0x73, 
+0xA8, 
+
+0x90, 0xB0, 0xE2, 0x82, 0xFE, 0x58, 0xD3, 0x2E, 
+0x66, 0x97, 0xA5, 0xF1, 0xF6, 0xFB, 0xA3, 0xD0, 
+0x67, 0x9E, 0x7C, 0x31, 0x3C, 0x72, 0xB3, 0xCC, 
+0xE9, 0x86, 0x89, 0xA5, 0xF1, 0x9E, 0x9A, 0xF7, 
+0x77, 0x8D, 0x5E, 0x64, 0x69, 0x58, 0x27, 0xE2, 
+0x02, 0xA9, 0x94, 0xDF, 0xF6, 0x69, 0x5A, 0xF0, 
+0xFD, 0xB1, 0x52, 0x50, 0x46, 0x87, 0x65, 0xE6, 
+0x02, 0x9E, 0x5B, 0xF8, 0x3D, 0x4A, 0xBE, 0xEA, 
+0xF1, 0x4C, 0xA7, 0xD5, 0xEF, 0xFC, 0xC9, 0x97, 
+0x08, 0xEA, 0x78, 0x55, 0xA0, 0x14, 0x0F, 0xA7, 
+0x0D, 0xD8, 0x12, 0xDA, 0xF0, 0xB2, 0x8F, 0xB4, 
+0x44, 0xC1, 0x04, 0x4B, 0x55, 0x44, 0xDD, 0x68, 
+0x0C, 0x08, 0x54, 0x25, 0x49, 0xCB, 0x2A, 0xF7, 
+0x49, 0x31, 0xC3, 0x47, 0x73, 0x49, 0x0A, 0x97, 
+0xC1, 0xB7, 
+
+0xE8, 0x65, 0x2E, 0xFC, 0xDF, 0x18, 0xA5, 0x27, 
+0xBE, 0x95, 0x59, 0xAE, 0x83, 0x9E, 0xC3, 0x64, 
+0xBB, 0x50, 0x12, 0x58, 0xAE, 0xC2, 0x6C, 0x7D, 
+0x66, 0x71, 0x7F, 0xE1, 0xBC, 0x10, 0x87, 0x03, 
+0x07, 0x9D, 0x63, 0xF1, 0xA1, 0x43, 0xDC, 0x7D, 
+0x87, 0xD0, 0x15, 0x0F, 0xFF, 0xAA, 0x43, 0xC6, 
+0xD6, 0x21, 0x16, 0x90, 0xF2, 0x03, 0x6F, 0xF8, 
+0xD6, 0xE8, 0x10, 0x08, 0x94, 0x7F, 0x1B, 0x90, 
+0x72, 0xD9, 0x68, 0x63, 0x6A, 0x1E, 0xAB, 0x46, 
+0x8B, 0x67, 0x9B, 0x10, 0x93, 0xD3, 0xCA, 0x86, 
+0x9F, 0x5D, 0x90, 0x6F, 0x0B, 0x5B, 0x2E, 0x50, 
+0x9C, 0x02, 0xE8, 0x63, 0xFF, 0x12, 0x5A, 0x03, 
+0x9E, 0xEC, 0xDF, 0x33, 0x7D, 0x12, 0x95, 0x2B, 
+0x9C, 0x18, 0xC5, 0xC7, 0xD9, 0xB8, 0xA0, 0xAA, 
+0x73, 0x89, 0x51, 
+
+0x4E, 0xF8, 0xB3, 0xB4, 0x4A, 0x31, 0x09, 0x3C, 
+0x4B, 0xF1, 0x9C, 0x01, 0x04, 0xB0, 0x78, 0x66, 
+0x29, 0xDD, 0x56, 0x1C, 0x3C, 0xE6, 0xA7, 0x5E, 
+0x74, 0x3D, 0x10, 0x1C, 0xB3, 0xDA, 0xCD, 0xB6, 
+0x70, 0x69, 0x52, 0xAA, 0x0F, 0xF7, 0xA6, 0x14, 
+0xA1, 0xDF, 0x0E, 0x76, 0xE9, 0x3C, 0x22, 0xB9, 
+0x70, 0x0A, 0x2C, 0xCA, 0x00, 0xD0, 0xA5, 0xE0, 
+0xF1, 0xD6, 0xDB, 0xB5, 0xC5, 0x66, 0x3F, 0x6F, 
+0x97, 0xE2, 0x01, 0xB1, 0x4C, 0x5A, 0xBF, 0xC9, 
+0x95, 0x85, 0x04, 0xDA, 0x57, 0xF4, 0x21, 0xDF, 
+0x6E, 0x3C, 0xDE, 0x09, 0xBD, 0xAA, 0xDF, 0xBE, 
+0x43, 0x97, 0xEF, 0x9B, 0x2D, 0xFB, 0x4E, 0xA8, 
+0x4A, 0x24, 0xA2, 0x64, 0xB0, 0xD7, 0xB2, 0x0D, 
+0x71, 0xAD, 0xE9, 0x57, 0x26, 0x34, 0xED, 0x1F, 
+0x8C, 0x9F, 0xED, 0xFD, 
+
+0xE0, 0xBF, 0x7B, 0x6C, 0x20, 0xBE, 0x05, 0x72, 
+0x54, 0x6C, 0x4E, 0x9B, 0xF7, 0xAB, 0xC0, 0x11, 
+0x5A, 0xDE, 0xEE, 0xE5, 0xB9, 0x4F, 0xAC, 0xAC, 
+0xC4, 0xA0, 0xE5, 0x5C, 0x8D, 0x79, 0xDB, 0x74, 
+0xFC, 0x49, 0xE7, 0x7D, 0x90, 0x73, 0xCA, 0xB0, 
+0xC4, 0x45, 0x59, 0x49, 0xA4, 0x85, 0x2B, 0xEE, 
+0xB9, 0x53, 0xA8, 0x44, 0x83, 0xE4, 0x65, 0x71, 
+0x0D, 0xCD, 0xCB, 0xAA, 0xF7, 0x1A, 0x59, 0xB7, 
+0x14, 0xC7, 0xAC, 0xA8, 0x71, 0x27, 0x05, 0x60, 
+0x42, 0xE6, 0xA7, 0xF7, 0x88, 0x8A, 0xCF, 0x3F, 
+0xF0, 0x67, 0x94, 0x8F, 0x2B, 0xE2, 0x9A, 0x9D, 
+0x78, 0x30, 0x9A, 0x13, 0xCF, 0xF2, 0x14, 0x96, 
+0x1C, 0x50, 0x71, 0xD7, 0xB9, 0x64, 0x0D, 0x31, 
+0xC5, 0x40, 0xA8, 0xA0, 0xF2, 0xFF, 0x76, 0xDC, 
+0x22, 0x18, 0x1C, 0x53, 0xA3, 
+
+0x50, 0x68, 0x2D, 0x25, 0x90, 0x95, 0x86, 0xFE, 
+0x0A, 0x4F, 0x89, 0xA5, 0x2C, 0x81, 0x98, 0xCE, 
+0x21, 0x2B, 0xF6, 0x3C, 0x4D, 0x88, 0x73, 0x25, 
+0x8B, 0x39, 0x8A, 0x02, 0x78, 0x4D, 0x18, 0x9C, 
+0x23, 0xC9, 0xD3, 0xCB, 0x10, 0xCF, 0x9A, 0x86, 
+0x1A, 0x83, 0x85, 0xF9, 0x73, 0x06, 0x0D, 0x73, 
+0xD5, 0x18, 0x7E, 0x94, 0x27, 0xA5, 0x75, 0x76, 
+0xE1, 0xE0, 0xAA, 0xE0, 0x4D, 0xE2, 0x3A, 0x73, 
+0x50, 0x56, 0x1F, 0x2B, 0x7B, 0x74, 0xFE, 0x34, 
+0xD1, 0x0D, 0xA9, 0xE3, 0x14, 0xCD, 0x3C, 0xDF, 
+0x62, 0xA3, 0x54, 0x24, 0x0B, 0x86, 0xE8, 0xA1, 
+0x2F, 0x22, 0x77, 0x4C, 0x3E, 0x3E, 0x40, 0x9B, 
+0xAC, 0x8A, 0x89, 0x3B, 0x77, 0x75, 0xCD, 0x65, 
+0x86, 0x95, 0x01, 0x30, 0x6C, 0xCA, 0xAF, 0x7A, 
+0xE4, 0x76, 0x28, 0xE3, 0xDB, 0xAE, 
+
+0x5B, 0x5D, 0x14, 0xB1, 0xDD, 0xB8, 0xDA, 0xEA, 
+0x76, 0xC5, 0x21, 0x1E, 0xF4, 0x81, 0x5C, 0xFC, 
+0x83, 0xAC, 0x9E, 0x99, 0x45, 0x28, 0xE1, 0x13, 
+0x22, 0xA2, 0xD4, 0xC0, 0x0C, 0xEF, 0xAA, 0x37, 
+0xCC, 0x69, 0x93, 0x86, 0x22, 0x8B, 0x34, 0x9B, 
+0xA2, 0x1B, 0xD6, 0x85, 0xB1, 0xFA, 0xBD, 0xC9, 
+0x8B, 0x80, 0x30, 0xB4, 0x99, 0xE4, 0x57, 0x07, 
+0x8F, 0x92, 0xDA, 0xDB, 0x3A, 0x4E, 0x80, 0xC0, 
+0xEA, 0xD6, 0x8F, 0xB2, 0x8F, 0x5F, 0x7C, 0x98, 
+0x83, 0x0F, 0x42, 0x8B, 0x87, 0x93, 0xE9, 0x35, 
+0x38, 0xCE, 0x35, 0x77, 0xE0, 0x28, 0x43, 0x1B, 
+0xBA, 0xBC, 0x4E, 0x83, 0x79, 0x71, 0xEB, 0x4F, 
+0x85, 0x82, 0xB4, 0xF3, 0xA2, 0x16, 0x57, 0xE1, 
+0x55, 0x3D, 0x80, 0x72, 0xCB, 0x85, 0x6B, 0x66, 
+0x1C, 0x6C, 0xA0, 0x6E, 0x0E, 0xF1, 0x66, 
+
+0x63, 0x8A, 0xC2, 0xB7, 0xE7, 0xFA, 0x2E, 0xC3, 
+0x59, 0xA3, 0x52, 0xF8, 0xCD, 0x78, 0xC9, 0x3A, 
+0xBA, 0x61, 0xAA, 0xC4, 0xC8, 0x05, 0xEC, 0x87, 
+0xB0, 0x7B, 0x0F, 0x2A, 0x7D, 0xEE, 0x4A, 0xC2, 
+0xA2, 0x98, 0x5E, 0xB5, 0xF0, 0x51, 0x4D, 0x1E, 
+0x8F, 0x82, 0x7A, 0x0A, 0xC1, 0x65, 0xF3, 0xE8, 
+0x5E, 0x13, 0xC1, 0xB5, 0x9E, 0x8B, 0xAC, 0x7B, 
+0xB7, 0x95, 0xFF, 0x3A, 0x23, 0x5A, 0xA1, 0x31, 
+0xF6, 0xE6, 0x26, 0x49, 0xD3, 0xF1, 0xE7, 0xE2, 
+0xAE, 0xDE, 0x5D, 0x57, 0x18, 0x37, 0x6F, 0xD8, 
+0xA6, 0x41, 0x65, 0x96, 0x39, 0x4B, 0x8E, 0xBD, 
+0xD3, 0x6E, 0x38, 0x6E, 0xE5, 0x95, 0xDB, 0x97, 
+0x2B, 0xB5, 0x9D, 0x9A, 0x78, 0x7E, 0x93, 0x3C, 
+0x75, 0x94, 0x42, 0x42, 0xF1, 0xF2, 0x75, 0xDF, 
+0x19, 0x0A, 0x35, 0x27, 0xE5, 0x26, 0x61, 0xB8, 
+
+0x82, 0xE6, 0xD7, 0x37, 0xF2, 0x3D, 0x46, 0xC4, 
+0xE1, 0x9E, 0x56, 0x8C, 0x8A, 0x89, 0x48, 0x82, 
+0x90, 0x4E, 0x6A, 0x32, 0x3E, 0x9E, 0x5D, 0x48, 
+0x87, 0xE8, 0xF2, 0x87, 0x31, 0xEE, 0x1F, 0x8D, 
+0x92, 0x0E, 0x21, 0xE9, 0x0F, 0x1C, 0x05, 0x0F, 
+0xD4, 0x6E, 0x54, 0x82, 0x2E, 0x86, 0x8B, 0xDA, 
+0x6C, 0xF4, 0x74, 0x08, 0x2B, 0xC4, 0xFB, 0xC7, 
+0x60, 0x90, 0x14, 0x54, 0x99, 0x71, 0x69, 0x25, 
+0xB3, 0x4E, 0xCD, 0xEB, 0x5D, 0xA4, 0x78, 0x03, 
+0x80, 0x7E, 0xFB, 0xBC, 0xC0, 0x3B, 0x06, 0x41, 
+0xC1, 0x03, 0x3A, 0xAD, 0x11, 0x32, 0x93, 0xE0, 
+0x9C, 0xF6, 0x18, 0xB4, 0x31, 0xBA, 0x03, 0x53, 
+0xFC, 0xB1, 0x87, 0x3B, 0x7C, 0xAA, 0xD0, 0x6F, 
+0xFE, 0x7B, 0x86, 0xBC, 0xEB, 0x52, 0xF2, 0xF1, 
+0x3F, 0x01, 0x27, 0xA2, 0xF5, 0xC1, 0x79, 0x88, 
+0x7B, 
+
+0xAC, 0x98, 0xF5, 0x83, 0xA3, 0xC2, 0x7B, 0x12, 
+0x81, 0x0C, 0x3F, 0x38, 0x73, 0x25, 0xC8, 0x8B, 
+0x92, 0xB2, 0xF5, 0xDE, 0x70, 0x71, 0x7A, 0x3E, 
+0x4D, 0xF7, 0xDF, 0xA6, 0xE0, 0x3C, 0xEE, 0x4B, 
+0x76, 0x25, 0x98, 0xF7, 0xAC, 0x19, 0x4A, 0xD5, 
+0xED, 0x7A, 0x55, 0x81, 0xAE, 0x7E, 0xCD, 0x52, 
+0x15, 0x60, 0x67, 0xE2, 0x91, 0x6D, 0x92, 0x11, 
+0x0A, 0xA0, 0xB9, 0x5E, 0xF3, 0xF4, 0x9D, 0xDE, 
+0x76, 0xF5, 0x91, 0x99, 0x66, 0xC7, 0x74, 0xF9, 
+0x3F, 0x13, 0x27, 0xF6, 0x84, 0x17, 0x0B, 0xE7, 
+0x16, 0x58, 0x60, 0x67, 0xE8, 0x2B, 0x83, 0x96, 
+0x63, 0x33, 0xD5, 0x7C, 0x65, 0xCF, 0x4F, 0xFA, 
+0x94, 0xB1, 0x09, 0x17, 0xBC, 0xA2, 0x15, 0xDE, 
+0x7F, 0xE2, 0x94, 0xD0, 0x82, 0xEE, 0x20, 0x7E, 
+0xA9, 0x5E, 0x7B, 0x61, 0x71, 0xE3, 0x1C, 0xDF, 
+0x9C, 0x29, 
+
+0xC8, 0xFD, 0x84, 0xC7, 0xCE, 0xAE, 0x0F, 0xB5, 
+0x31, 0xE3, 0x25, 0x68, 0x72, 0x8D, 0xEB, 0x03, 
+0x31, 0x60, 0xD7, 0xA1, 0xA8, 0xAB, 0x01, 0xBF, 
+0x4F, 0xBB, 0x0E, 0xFF, 0x6A, 0xEE, 0xD6, 0xAE, 
+0xE7, 0x7D, 0xF2, 0xA7, 0x97, 0xF9, 0x7F, 0x02, 
+0x90, 0x3F, 0xE6, 0x07, 0x49, 0x9C, 0x22, 0x72, 
+0x4F, 0x9B, 0x5C, 0x6F, 0x11, 0x7F, 0xE4, 0xD5, 
+0x30, 0x0A, 0xFE, 0x62, 0x30, 0x76, 0x85, 0xA5, 
+0x68, 0xE7, 0x74, 0xC2, 0x75, 0x48, 0x2F, 0xED, 
+0xDF, 0x2B, 0xB2, 0x74, 0x13, 0x5E, 0x9A, 0x22, 
+0x7E, 0xE6, 0x33, 0xF9, 0xF5, 0xFA, 0xC1, 0x6C, 
+0x55, 0x69, 0x0C, 0x23, 0xA5, 0x02, 0x57, 0x57, 
+0x89, 0xC5, 0x3A, 0x19, 0x0E, 0xE6, 0x09, 0xEA, 
+0x4A, 0x88, 0x51, 0x7D, 0x62, 0x6C, 0xFC, 0xF5, 
+0x43, 0x63, 0x05, 0x4A, 0x4E, 0x25, 0xC7, 0x9C, 
+0xF3, 0x49, 0x25, 
+
+0x54, 0x36, 0x58, 0x27, 0x0C, 0xA6, 0xD8, 0xB3, 
+0x9B, 0x64, 0xFD, 0x06, 0x56, 0x0E, 0x96, 0x2E, 
+0x86, 0x37, 0x69, 0x85, 0xB7, 0x67, 0xB6, 0x84, 
+0xA4, 0x87, 0x64, 0x5D, 0xFD, 0xB3, 0x85, 0x0E, 
+0x07, 0x9B, 0xC3, 0x60, 0xC3, 0xD1, 0x76, 0x91, 
+0xC6, 0xB7, 0x91, 0x2C, 0xE1, 0x8D, 0x21, 0x6A, 
+0x65, 0x16, 0x94, 0xC7, 0x3D, 0x8F, 0x75, 0xC8, 
+0x4E, 0x41, 0x3E, 0xB8, 0x88, 0xA9, 0x70, 0x11, 
+0xE7, 0xBD, 0x82, 0xC3, 0xBE, 0xE1, 0x75, 0x1C, 
+0x3A, 0x41, 0x5F, 0x8F, 0xBC, 0xA8, 0x7E, 0xB5, 
+0x83, 0x59, 0x31, 0x99, 0x71, 0x3E, 0x89, 0xF3, 
+0x98, 0x62, 0xDD, 0x59, 0x96, 0x8B, 0xEE, 0x56, 
+0xF8, 0x9E, 0x0D, 0xD1, 0xC6, 0xDD, 0x0B, 0x9E, 
+0xFC, 0x19, 0x6A, 0x78, 0xDD, 0x9B, 0x03, 0x71, 
+0x45, 0x2A, 0x6C, 0xCB, 0xBE, 0x79, 0x3F, 0xC3, 
+0xDA, 0x2B, 0xC8, 0x77, 
+
+0x5A, 0x7C, 0xA5, 0xDB, 0xFE, 0x2E, 0x6D, 0x53, 
+0x17, 0x10, 0x00, 0x5C, 0x9E, 0xA8, 0x55, 0x75, 
+0x4C, 0xC4, 0xC8, 0x31, 0x4C, 0x86, 0xA2, 0x47, 
+0x2F, 0x81, 0x9D, 0x25, 0xAA, 0xE4, 0xB2, 0x5A, 
+0xBB, 0x20, 0xA5, 0xED, 0x57, 0xF9, 0xAC, 0xFC, 
+0x3A, 0xB3, 0x02, 0x65, 0xE8, 0x41, 0x1C, 0x9F, 
+0x1C, 0xA1, 0xFF, 0x3E, 0x31, 0x28, 0x13, 0xCF, 
+0x68, 0x9B, 0xF9, 0x86, 0xE2, 0xB9, 0x99, 0x46, 
+0x7F, 0xEF, 0x3B, 0x7A, 0xE5, 0x2A, 0xB5, 0x0F, 
+0x13, 0x3D, 0x44, 0x06, 0x3C, 0x29, 0xBD, 0x95, 
+0x72, 0xA1, 0xFD, 0x84, 0x0A, 0x51, 0x56, 0x61, 
+0xE5, 0x07, 0x44, 0x3B, 0xBD, 0x70, 0x03, 0x95, 
+0x83, 0x7D, 0xB8, 0xE5, 0x8B, 0x7B, 0x93, 0x1C, 
+0xCE, 0x73, 0x9D, 0x67, 0x45, 0x3A, 0x95, 0xF2, 
+0xD7, 0x2C, 0x88, 0x41, 0x1C, 0x5B, 0xE2, 0x2C, 
+0x09, 0x3D, 0xFA, 0xC4, 0xB2, 
+
+0xA4, 0xF6, 0x21, 0xBA, 0xB4, 0x2A, 0x7B, 0x96, 
+0x83, 0x24, 0x76, 0xD5, 0x07, 0xD3, 0x49, 0x77, 
+0x38, 0x80, 0x74, 0xF9, 0x8F, 0xBB, 0x42, 0xCC, 
+0x48, 0x06, 0xC8, 0x15, 0x04, 0xDC, 0x3A, 0x5C, 
+0x41, 0xBF, 0xA1, 0x94, 0x98, 0x10, 0x67, 0xAA, 
+0x8D, 0x0A, 0x11, 0x40, 0x58, 0x17, 0x5D, 0x8D, 
+0x43, 0x13, 0x0F, 0xA4, 0x9F, 0x2F, 0x7F, 0x3F, 
+0x79, 0x13, 0xBC, 0x58, 0x5B, 0x2B, 0x6E, 0x06, 
+0xEF, 0xEC, 0xDE, 0x7C, 0xDB, 0x21, 0x7D, 0xBF, 
+0x48, 0x26, 0x36, 0x9E, 0x51, 0x33, 0x61, 0xD4, 
+0xF2, 0xD3, 0x85, 0xE9, 0x34, 0xE6, 0x1B, 0x14, 
+0xAB, 0x59, 0x76, 0x72, 0xBB, 0x5E, 0xA0, 0x61, 
+0xE4, 0xA1, 0xD8, 0x6F, 0x6B, 0x2C, 0x31, 0xE4, 
+0xB0, 0x8F, 0xC9, 0x32, 0xF1, 0xE2, 0x9C, 0x39, 
+0x82, 0xB5, 0x91, 0xA3, 0xAE, 0x12, 0x40, 0x59, 
+0x97, 0x6C, 0x7D, 0x71, 0xED, 0xE5, 
+
+0x77, 0x7E, 0xCA, 0x05, 0x61, 0xB0, 0x29, 0xD0, 
+0xC0, 0xA8, 0x53, 0x06, 0x02, 0x99, 0xFC, 0x9B, 
+0xCA, 0xDF, 0x4F, 0x49, 0xBA, 0x73, 0xF1, 0x66, 
+0x9F, 0x9C, 0x56, 0x98, 0x2F, 0xF8, 0x9F, 0xDE, 
+0xD0, 0x97, 0x4B, 0xC7, 0x80, 0xAD, 0xAA, 0x58, 
+0xC8, 0xCD, 0x30, 0x5C, 0x64, 0x77, 0xDE, 0x15, 
+0x0B, 0x5A, 0xA3, 0x4A, 0x43, 0x5D, 0x6D, 0xAE, 
+0x86, 0xE7, 0x44, 0x3A, 0x5A, 0x29, 0x57, 0xD3, 
+0xC7, 0xEA, 0xF3, 0x01, 0xBC, 0xB7, 0xC5, 0x72, 
+0x1D, 0x32, 0x3B, 0xB9, 0xAA, 0x9A, 0xC5, 0x50, 
+0x15, 0x56, 0x7F, 0x9C, 0x91, 0x97, 0xD0, 0x2D, 
+0xB2, 0x76, 0x57, 0x01, 0x55, 0x4C, 0x61, 0xEF, 
+0xB4, 0x0C, 0xFB, 0x41, 0x57, 0xD5, 0xF3, 0x2C, 
+0xA3, 0xC1, 0xC1, 0x9E, 0x8F, 0x14, 0xF8, 0x99, 
+0x73, 0x30, 0x4D, 0xC9, 0x8B, 0x98, 0x76, 0xCA, 
+0x84, 0xC6, 0xB7, 0xC9, 0xFE, 0x89, 0x9B, 
+
+0x52, 0xF1, 0xFC, 0xAD, 0x67, 0x80, 0xF8, 0x9F, 
+0xC9, 0x81, 0xDA, 0xA1, 0xB5, 0x9E, 0x42, 0x35, 
+0xA0, 0x78, 0xFA, 0x40, 0x6D, 0x71, 0xE4, 0x79, 
+0x95, 0x46, 0xDB, 0x88, 0xAC, 0x31, 0xA6, 0x1D, 
+0xFB, 0x63, 0xE0, 0x7A, 0xBC, 0x8A, 0x00, 0xEF, 
+0xF9, 0xE7, 0xAC, 0x65, 0x6F, 0x71, 0xDA, 0x44, 
+0x3B, 0x07, 0x42, 0xF2, 0x78, 0x0B, 0x49, 0x3C, 
+0x7C, 0xFF, 0x61, 0x07, 0xBE, 0x00, 0x5A, 0x29, 
+0xB0, 0x5E, 0x96, 0x39, 0xE3, 0x3F, 0xE4, 0x80, 
+0x20, 0xBE, 0xBC, 0x9C, 0x31, 0x7E, 0x1E, 0xB9, 
+0xB3, 0x60, 0xF5, 0x5C, 0x22, 0x09, 0xF0, 0xB1, 
+0x03, 0x07, 0xC0, 0x28, 0x44, 0x7D, 0xBD, 0xC9, 
+0x48, 0x63, 0x6B, 0xE7, 0x32, 0x23, 0x33, 0x30, 
+0x23, 0x4F, 0x7C, 0x6D, 0x94, 0x27, 0xA2, 0xE4, 
+0x7A, 0x9B, 0x1C, 0xB8, 0xC7, 0xB7, 0x1C, 0xE7, 
+0x47, 0xC9, 0xCC, 0xFD, 0x28, 0x5D, 0x27, 0xAF, 
+
+0xCC, 0xF6, 0x1E, 0xAF, 0x60, 0x39, 0xDB, 0x11, 
+0x69, 0xCF, 0x50, 0x4A, 0xE4, 0x21, 0x4B, 0xA7, 
+0xA8, 0xFC, 0x13, 0x80, 0x75, 0x99, 0x15, 0xF0, 
+0x0A, 0xB6, 0x31, 0x3C, 0xC7, 0x5B, 0x3C, 0x19, 
+0x08, 0x88, 0xB6, 0x03, 0x6A, 0xB1, 0xA1, 0xDE, 
+0x92, 0x08, 0x1E, 0xF7, 0x51, 0x5D, 0xCD, 0x18, 
+0x2A, 0x2C, 0xF5, 0x0B, 0x63, 0x87, 0x48, 0xF0, 
+0xC4, 0xED, 0x8E, 0xB7, 0x6B, 0xE2, 0x8F, 0x63, 
+0x9E, 0xDC, 0x47, 0xF4, 0x6D, 0xB1, 0x77, 0xF8, 
+0xD2, 0x98, 0xB3, 0x8D, 0x6D, 0x55, 0xF3, 0x84, 
+0x74, 0x85, 0xE7, 0x1E, 0xCE, 0xB0, 0x28, 0xBA, 
+0x7E, 0x60, 0x81, 0xF6, 0xEA, 0x3C, 0xC7, 0xFE, 
+0x09, 0x50, 0x84, 0x0B, 0x92, 0x72, 0x22, 0x0D, 
+0x53, 0x02, 0x0E, 0x60, 0x49, 0x2C, 0x8F, 0x3B, 
+0xD0, 0x13, 0x02, 0x26, 0xFA, 0x0B, 0x6E, 0xC2, 
+0x17, 0xEA, 0x17, 0x5D, 0x16, 0xE0, 0xA7, 0x20, 
+0xFF, 
+
+0x30, 0x8E, 0xD7, 0xE7, 0x09, 0xB9, 0x25, 0x9B, 
+0x67, 0x9A, 0x99, 0x0F, 0xA1, 0xEA, 0xF9, 0x59, 
+0x6F, 0xDE, 0x06, 0x4B, 0x3E, 0x1A, 0x35, 0xC8, 
+0x6C, 0x1E, 0xB2, 0xB2, 0x50, 0xF3, 0xAE, 0x78, 
+0x02, 0x2B, 0x3F, 0x36, 0x5E, 0x30, 0x0F, 0x4E, 
+0xB3, 0x85, 0x47, 0x59, 0x19, 0x8D, 0xF8, 0xA8, 
+0x79, 0xB9, 0x6D, 0x8F, 0x39, 0x1E, 0xCE, 0xE8, 
+0x61, 0x6E, 0x5B, 0xEA, 0x78, 0x78, 0xC9, 0xAB, 
+0x15, 0xD6, 0xFC, 0x13, 0x21, 0x0F, 0x14, 0xF4, 
+0x6A, 0xF9, 0xBC, 0xBB, 0x8D, 0x8C, 0x66, 0xFC, 
+0xED, 0xF4, 0x5C, 0xAD, 0x1F, 0x1C, 0x77, 0x91, 
+0x21, 0x95, 0x04, 0xCB, 0xA9, 0x73, 0xF6, 0xC6, 
+0xD1, 0x2F, 0x46, 0xE3, 0x88, 0x43, 0x91, 0x59, 
+0x37, 0x9B, 0xB9, 0xEB, 0x7C, 0xBC, 0xE7, 0x50, 
+0x3D, 0x4C, 0xCC, 0xB8, 0xDD, 0xD1, 0x48, 0xCA, 
+0xCB, 0x6D, 0xED, 0x33, 0x93, 0x76, 0xA2, 0xDC, 
+0xF6, 0xB7, 
+
+0xD6, 0x15, 0x45, 0x56, 0x7E, 0xDC, 0xC4, 0xA1, 
+0x15, 0x2D, 0x5F, 0xDC, 0xC2, 0xD2, 0x8A, 0x2E, 
+0x17, 0xE0, 0xFF, 0xCA, 0x7A, 0x64, 0x6B, 0x65, 
+0x5B, 0x3F, 0x93, 0xE0, 0xD1, 0x1D, 0x6C, 0xE7, 
+0x20, 0x11, 0x34, 0xE2, 0x84, 0xF7, 0xA2, 0xF5, 
+0x41, 0xAD, 0x79, 0x40, 0x24, 0x30, 0xE7, 0x58, 
+0x4A, 0xB1, 0xAF, 0xD3, 0x5F, 0x5C, 0xAA, 0x0C, 
+0x42, 0x7D, 0xD7, 0x35, 0xAD, 0xA9, 0x81, 0xEE, 
+0x39, 0x41, 0x17, 0x77, 0x4E, 0xAC, 0xCE, 0xD6, 
+0x0E, 0x75, 0x34, 0x4C, 0x1E, 0xB8, 0x68, 0x35, 
+0x40, 0x08, 0xD1, 0x6A, 0xAF, 0x0E, 0x42, 0x93, 
+0xB8, 0x6D, 0x97, 0x29, 0x03, 0x43, 0xE3, 0x25, 
+0x55, 0x6F, 0x83, 0xAA, 0xD8, 0x17, 0x6A, 0x87, 
+0xF4, 0xAF, 0x02, 0x0A, 0x9E, 0xA9, 0xFF, 0x15, 
+0x15, 0xCD, 0xA9, 0xF5, 0x66, 0x87, 0xAB, 0xBF, 
+0x83, 0x45, 0x75, 0x73, 0x7D, 0x39, 0x10, 0x8F, 
+0xEE, 0x81, 0x37, 
+
+0x56, 0x06, 0xD1, 0x42, 0xE4, 0x12, 0x2A, 0x26, 
+0x90, 0x5D, 0xF5, 0x45, 0x3A, 0x06, 0xA2, 0x74, 
+0x5B, 0xF4, 0xD4, 0x26, 0x47, 0xBD, 0x03, 0xE5, 
+0x28, 0x63, 0x85, 0x86, 0x0F, 0xA7, 0x54, 0x53, 
+0xD2, 0xDC, 0x13, 0x62, 0xCC, 0xB6, 0xCA, 0xB8, 
+0xE7, 0x00, 0x0D, 0x77, 0x01, 0x7D, 0x4D, 0x90, 
+0xE1, 0xDA, 0x18, 0x00, 0xBB, 0x73, 0xA1, 0xEA, 
+0xD3, 0x12, 0x4E, 0x1E, 0x53, 0x64, 0x95, 0x92, 
+0x2C, 0x86, 0x18, 0x7D, 0xEB, 0x59, 0xE4, 0x99, 
+0x88, 0x4D, 0x64, 0x24, 0x9D, 0xCF, 0xC3, 0x7A, 
+0x10, 0x73, 0x84, 0xBB, 0xF4, 0x7C, 0x6E, 0xE0, 
+0x12, 0xB6, 0xF5, 0xA4, 0x9B, 0x69, 0x88, 0x66, 
+0x09, 0xD2, 0xFF, 0x3C, 0xC5, 0x87, 0x7F, 0xF0, 
+0x9F, 0x4C, 0x43, 0x5A, 0x2A, 0xD8, 0x6A, 0x2D, 
+0xD9, 0xF0, 0x04, 0x34, 0xFB, 0xB8, 0xBA, 0xD3, 
+0xDD, 0x92, 0x3F, 0xDB, 0x7F, 0xF5, 0x32, 0x8F, 
+0x1B, 0x84, 0xEF, 0x0D, 
+
+0xFF, 0x76, 0x85, 0xAE, 0xA3, 0xEF, 0x61, 0xCA, 
+0xED, 0x38, 0x65, 0x02, 0xD3, 0xFE, 0xAF, 0xD9, 
+0x3C, 0x08, 0x05, 0x58, 0x06, 0x4E, 0xFE, 0x84, 
+0xD1, 0x96, 0x9C, 0xCD, 0xC2, 0x43, 0xE7, 0x4C, 
+0x13, 0xFB, 0x07, 0xC8, 0x0C, 0x77, 0xE1, 0x87, 
+0x57, 0x26, 0xE2, 0x89, 0xEE, 0x1E, 0xFE, 0x9B, 
+0x8D, 0x4B, 0xC5, 0x11, 0x95, 0x82, 0xFF, 0x15, 
+0xE6, 0xE6, 0x56, 0x19, 0x9C, 0x62, 0x94, 0xAC, 
+0xFD, 0xDB, 0x1B, 0x4E, 0x09, 0x16, 0x97, 0xFA, 
+0x4A, 0x3D, 0x32, 0x3F, 0x58, 0xD7, 0x5F, 0x92, 
+0x5E, 0xF9, 0x7A, 0xAF, 0xE0, 0x9E, 0x5B, 0xF7, 
+0x07, 0x56, 0xAC, 0x54, 0xDD, 0x08, 0xF2, 0x27, 
+0xA7, 0x89, 0x71, 0xE1, 0x52, 0xD5, 0x99, 0x77, 
+0x74, 0x6C, 0xFF, 0x7C, 0x60, 0xC8, 0xAF, 0x8B, 
+0xDA, 0x66, 0x21, 0xBC, 0xF6, 0x02, 0x60, 0x80, 
+0x49, 0xBC, 0xF1, 0x1E, 0xE6, 0xA0, 0x55, 0x18, 
+0x8A, 0xB6, 0x6B, 0xD0, 0xDC, 
+
+0xDD, 0xA2, 0xDF, 0xFA, 0xC0, 0xEA, 0xC9, 0x65, 
+0xDC, 0xE3, 0xD9, 0x58, 0x0D, 0x51, 0xC4, 0x09, 
+0x37, 0xB7, 0x31, 0x51, 0xCF, 0x07, 0x36, 0x5D, 
+0x8F, 0x5B, 0x7D, 0xC1, 0x9B, 0xF4, 0x22, 0x31, 
+0xE7, 0xE4, 0x7B, 0x03, 0xE6, 0xE7, 0xB0, 0x44, 
+0xCB, 0x92, 0xD4, 0x84, 0xA7, 0xFD, 0x14, 0x16, 
+0xAF, 0xC6, 0xBC, 0x98, 0xAC, 0x83, 0xAF, 0xD9, 
+0x35, 0xBB, 0x31, 0xFC, 0x7D, 0xA7, 0xD4, 0x10, 
+0x78, 0xFD, 0x44, 0x27, 0xF8, 0x3F, 0xAE, 0xAB, 
+0x9A, 0x58, 0x4B, 0xDD, 0x67, 0xFB, 0x3D, 0x6D, 
+0xF9, 0x90, 0x1D, 0xE9, 0xB8, 0x86, 0xA3, 0xBD, 
+0x36, 0x8B, 0x23, 0x10, 0x3D, 0x39, 0xAD, 0x46, 
+0x3E, 0x57, 0xCD, 0x72, 0x92, 0x9C, 0x11, 0x2A, 
+0xF8, 0x13, 0xBB, 0xD1, 0x61, 0xA0, 0xCB, 0xAF, 
+0xEF, 0x73, 0x56, 0x68, 0xBC, 0x27, 0x19, 0x38, 
+0x73, 0xE7, 0x60, 0x7D, 0x33, 0x41, 0x75, 0x55, 
+0xEA, 0x8C, 0xCF, 0x25, 0x3A, 0x2F, 
+
+0x99, 0xAC, 0x61, 0x6A, 0x19, 0x72, 0x92, 0x9C, 
+0x36, 0x5F, 0x79, 0xC8, 0xEF, 0x6D, 0x9C, 0x87, 
+0x0E, 0x64, 0xC9, 0xB8, 0x76, 0x21, 0x8B, 0xAB, 
+0xA7, 0x6D, 0xC7, 0x97, 0xD2, 0x80, 0x2C, 0x04, 
+0x5B, 0x67, 0x81, 0xBC, 0xF1, 0x8B, 0x38, 0x45, 
+0x8D, 0x8F, 0xE3, 0x48, 0x34, 0x09, 0x54, 0x22, 
+0x25, 0x4F, 0xDB, 0x5A, 0x68, 0xB7, 0xBD, 0x82, 
+0x15, 0xDF, 0x3B, 0x33, 0xE1, 0xD6, 0x21, 0x53, 
+0x44, 0xF2, 0x9B, 0xD5, 0xAF, 0x34, 0x0F, 0x3E, 
+0xCB, 0x9F, 0x64, 0x2A, 0x97, 0x20, 0xE6, 0xC0, 
+0xB1, 0xFA, 0xFC, 0xCE, 0x80, 0xCD, 0x47, 0x07, 
+0xD2, 0xC4, 0x8A, 0xE5, 0x63, 0x6F, 0x02, 0x23, 
+0xEC, 0xBD, 0x60, 0x61, 0x15, 0x36, 0x10, 0xA3, 
+0x92, 0x7B, 0xF3, 0x06, 0x53, 0xAF, 0x26, 0xC9, 
+0xDE, 0xA5, 0xCF, 0x66, 0x94, 0x5A, 0x94, 0x64, 
+0x05, 0xD2, 0x4E, 0x3E, 0x15, 0x4F, 0xF0, 0x28, 
+0xE7, 0x40, 0x0F, 0x40, 0xA6, 0xF2, 0x24, 
+
+0x32, 0x5B, 0xEB, 0x16, 0xFA, 0x93, 0x20, 0xC2, 
+0x00, 0xE6, 0x8B, 0xED, 0x30, 0x6B, 0x19, 0xED, 
+0xFA, 0x57, 0xE2, 0xEC, 0xC6, 0x77, 0x11, 0x99, 
+0x54, 0x7E, 0xB4, 0x28, 0xE2, 0x09, 0x72, 0x90, 
+0x41, 0xB6, 0x80, 0xB8, 0x30, 0x49, 0xF7, 0xD7, 
+0x92, 0x3D, 0x0A, 0x3E, 0xFF, 0xF5, 0xC7, 0x46, 
+0xF2, 0xA6, 0x0F, 0x78, 0x74, 0xE4, 0x12, 0x66, 
+0x77, 0x52, 0xFD, 0xCF, 0xDF, 0xD6, 0xED, 0x74, 
+0x26, 0xE0, 0x2F, 0xF2, 0x37, 0x82, 0x90, 0x44, 
+0xF6, 0x57, 0x70, 0xF0, 0x88, 0x30, 0xF3, 0xEB, 
+0x05, 0x81, 0x90, 0x91, 0xDF, 0xD9, 0xAF, 0x17, 
+0x88, 0x1A, 0xA6, 0xF4, 0xDF, 0x4A, 0x47, 0x59, 
+0xC4, 0xC1, 0x63, 0x01, 0xBB, 0xFA, 0x27, 0xDD, 
+0x9C, 0x44, 0xA7, 0xFD, 0xCE, 0xEF, 0xDF, 0xB2, 
+0x1E, 0xDB, 0x2F, 0x5E, 0xF1, 0x7F, 0xF8, 0xE8, 
+0xD5, 0xC7, 0x12, 0x0E, 0x00, 0xA1, 0x0A, 0x10, 
+0x62, 0xD8, 0xFF, 0x4E, 0x2D, 0xA8, 0x48, 0x34, 
+
+0x98, 0x2F, 0x9F, 0x1E, 0x0F, 0xD7, 0x4C, 0xFD, 
+0x1E, 0x98, 0x51, 0x8B, 0xCF, 0xAA, 0x51, 0x30, 
+0x42, 0x75, 0x69, 0xB7, 0x45, 0xCA, 0x9F, 0x55, 
+0x75, 0xE1, 0x43, 0xDE, 0xFF, 0xF8, 0x33, 0xE3, 
+0x90, 0xE9, 0x7A, 0xEE, 0x0B, 0xB8, 0x91, 0xD9, 
+0xC2, 0xCF, 0x4B, 0xA0, 0x46, 0x88, 0xC7, 0x5F, 
+0x33, 0x7B, 0x6F, 0x79, 0x69, 0x3C, 0xCC, 0x28, 
+0xE3, 0x5A, 0x4B, 0x5A, 0x3A, 0xB8, 0xB8, 0xD4, 
+0x93, 0x85, 0xAB, 0x2D, 0x8B, 0x52, 0xB9, 0x45, 
+0xB7, 0xF8, 0xDA, 0x24, 0xF0, 0xA7, 0xB9, 0x96, 
+0xDE, 0x55, 0xBC, 0x2F, 0xF2, 0x72, 0xC9, 0xCE, 
+0xFE, 0x4C, 0x4A, 0x90, 0x88, 0x6A, 0x42, 0x07, 
+0x77, 0xD6, 0xF1, 0x00, 0x42, 0x85, 0x05, 0xE1, 
+0x23, 0x2D, 0x64, 0x07, 0x92, 0xAD, 0xE8, 0x0D, 
+0x03, 0xED, 0x61, 0xC8, 0x08, 0x79, 0x09, 0xD8, 
+0xAD, 0x96, 0x06, 0xE1, 0x3E, 0x0F, 0xF9, 0x6C, 
+0xBE, 0x95, 0x51, 0xD4, 0x77, 0xD3, 0x7B, 0xFD, 
+0xD9, 
+
+0x96, 0x56, 0xDF, 0x26, 0x35, 0x58, 0x8B, 0xC7, 
+0x86, 0x6E, 0x0F, 0x9B, 0x7D, 0x46, 0x86, 0x26, 
+0x51, 0x0B, 0x3C, 0x85, 0x87, 0x4D, 0x41, 0x69, 
+0x85, 0x19, 0xD3, 0x93, 0x22, 0xE3, 0x2B, 0xE2, 
+0x04, 0xF6, 0xED, 0xD6, 0xD9, 0xFF, 0xE0, 0x69, 
+0xFD, 0xDB, 0xEC, 0x0F, 0x13, 0x2B, 0x3A, 0x79, 
+0xD3, 0x32, 0x38, 0xD1, 0x8C, 0xCC, 0x1E, 0x33, 
+0x01, 0x4A, 0x23, 0x59, 0x37, 0xCC, 0x99, 0x0B, 
+0xDB, 0xE6, 0xB0, 0x96, 0x85, 0xB9, 0xDC, 0x58, 
+0xDF, 0x02, 0x00, 0xA2, 0xDA, 0xCF, 0xCD, 0xFA, 
+0xB5, 0xA4, 0xB2, 0xCE, 0x94, 0x51, 0xDF, 0x3D, 
+0xF2, 0xB2, 0x44, 0x50, 0x62, 0x19, 0x3B, 0x58, 
+0x0F, 0xEE, 0xCB, 0x52, 0x4E, 0x88, 0xD3, 0xF0, 
+0x09, 0xED, 0x11, 0x14, 0x0E, 0xAF, 0x82, 0x3D, 
+0x65, 0x62, 0xFB, 0x05, 0xF9, 0x3A, 0x70, 0x24, 
+0x67, 0x0C, 0x66, 0xC1, 0x3A, 0x22, 0xA0, 0x6C, 
+0xD5, 0x4F, 0x9B, 0x26, 0xFE, 0x12, 0x96, 0x03, 
+0x7E, 0x96, 
+
+0x55, 0x78, 0xD3, 0xFA, 0x3D, 0x3A, 0x5B, 0xEF, 
+0x2C, 0x51, 0x05, 0x1A, 0x96, 0x78, 0x18, 0x5F, 
+0x90, 0xD1, 0x33, 0xA1, 0xA3, 0x16, 0x1F, 0x37, 
+0x27, 0xF6, 0x3E, 0x9D, 0x6A, 0x2B, 0x60, 0x5D, 
+0x1D, 0x0C, 0x07, 0x92, 0x67, 0x74, 0x57, 0x8A, 
+0x53, 0x4A, 0xDB, 0x91, 0xC7, 0xDA, 0xB5, 0x59, 
+0xEC, 0xB7, 0x5C, 0xB2, 0xB2, 0x0D, 0x18, 0x88, 
+0xFF, 0x25, 0x53, 0x56, 0x0E, 0x59, 0xB9, 0x9C, 
+0x7E, 0xA2, 0x86, 0x68, 0x18, 0x4C, 0xDA, 0x11, 
+0xEE, 0xAD, 0xBB, 0xC4, 0x8A, 0x24, 0x67, 0x08, 
+0xCC, 0x18, 0x22, 0x5A, 0x21, 0xDB, 0x67, 0x1E, 
+0x48, 0x30, 0xC1, 0xCC, 0x95, 0xCD, 0x1E, 0x20, 
+0x8A, 0xDD, 0x3C, 0x84, 0x3A, 0x87, 0xBB, 0x99, 
+0x6B, 0x9C, 0xC5, 0xAE, 0xCC, 0xE0, 0xB8, 0x6B, 
+0x03, 0xB8, 0x53, 0xD2, 0x5B, 0xB1, 0xFA, 0x09, 
+0xDA, 0x49, 0xC1, 0x67, 0xC5, 0x1D, 0xD7, 0x5E, 
+0x1F, 0x11, 0x66, 0xF5, 0x48, 0xBC, 0x31, 0xF3, 
+0x69, 0x8B, 0xC3, 
+
+0x11, 0x84, 0xD7, 0x80, 0x57, 0x73, 0x7E, 0x15, 
+0x73, 0x7C, 0xB0, 0x16, 0x60, 0x2B, 0x28, 0x19, 
+0xCB, 0x4D, 0x78, 0x2C, 0x05, 0x56, 0xDA, 0x73, 
+0xEE, 0xDD, 0xB0, 0x02, 0x05, 0x91, 0x2C, 0x5E, 
+0x2E, 0xF6, 0x52, 0xF7, 0x27, 0x1D, 0x03, 0xB7, 
+0x42, 0xCF, 0x37, 0x61, 0xA5, 0xE1, 0x2A, 0xFA, 
+0x16, 0x61, 0x47, 0xC3, 0x55, 0xCC, 0xD1, 0xE5, 
+0xF4, 0xE0, 0x99, 0x82, 0x55, 0x14, 0x58, 0xAD, 
+0x06, 0x0A, 0x87, 0x3D, 0x23, 0x1E, 0x20, 0xF5, 
+0x56, 0x04, 0xA1, 0xF9, 0x26, 0x02, 0xFB, 0x6B, 
+0x45, 0x41, 0xDA, 0x7C, 0xB4, 0xB8, 0x39, 0x73, 
+0xBA, 0x11, 0xD8, 0xFB, 0x27, 0x2C, 0xB9, 0xD4, 
+0x14, 0xE1, 0x17, 0x39, 0x08, 0x86, 0xD0, 0x94, 
+0x27, 0x86, 0x5C, 0xDB, 0x84, 0xF9, 0x97, 0x4D, 
+0x26, 0x09, 0x16, 0x43, 0x0A, 0x5A, 0x25, 0x83, 
+0xC6, 0x5F, 0x06, 0x3B, 0xAD, 0x88, 0x80, 0xE0, 
+0x95, 0x05, 0xF1, 0xFF, 0xD2, 0x90, 0xD4, 0x35, 
+0x59, 0x30, 0x80, 0xB1, 
+
+0x6D, 0x8D, 0xE2, 0x5E, 0x5F, 0xB0, 0x5C, 0x80, 
+0x93, 0xDA, 0x07, 0x2D, 0xD9, 0x2F, 0x31, 0x59, 
+0xD1, 0x31, 0x17, 0x3E, 0x0E, 0x91, 0xE6, 0x91, 
+0x90, 0xDA, 0x25, 0xD6, 0x2A, 0xDD, 0xAE, 0xA5, 
+0x8D, 0x29, 0x08, 0xC5, 0xE8, 0x0D, 0xE4, 0xA2, 
+0xBE, 0x92, 0x77, 0x39, 0x1C, 0xE4, 0x34, 0xE5, 
+0x79, 0x74, 0x32, 0x2F, 0x3F, 0xE4, 0x76, 0x7B, 
+0xC3, 0x93, 0x36, 0xCA, 0xD0, 0x9F, 0x39, 0x0E, 
+0x87, 0x30, 0x35, 0xBB, 0x85, 0x84, 0x0E, 0x43, 
+0x6B, 0x9C, 0xE1, 0xAF, 0x70, 0xC6, 0xB3, 0xFE, 
+0xAA, 0x99, 0xA5, 0x21, 0x86, 0x36, 0xFA, 0xE7, 
+0x15, 0xD6, 0x90, 0x86, 0xD1, 0xB2, 0x9D, 0x68, 
+0xAA, 0x7D, 0x6F, 0x13, 0xFC, 0x41, 0xA0, 0xBE, 
+0x18, 0xFE, 0xEF, 0xDE, 0x6C, 0x80, 0x77, 0xEE, 
+0xF1, 0xA9, 0x61, 0x39, 0x13, 0xDB, 0x27, 0xA5, 
+0x39, 0x74, 0xB1, 0x47, 0xD9, 0x65, 0xA2, 0x67, 
+0x19, 0x64, 0xF0, 0x33, 0x11, 0xEB, 0xD7, 0xA8, 
+0xE1, 0xE3, 0x6B, 0xC7, 0xC6, 
+
+0x1B, 0x9F, 0x7A, 0xCB, 0x17, 0x81, 0x39, 0x26, 
+0x86, 0xE9, 0x23, 0xB0, 0xC9, 0xBF, 0xF6, 0xB4, 
+0x77, 0xAB, 0x4E, 0x0F, 0xCF, 0xAA, 0x6D, 0x62, 
+0xF8, 0x07, 0xDC, 0x02, 0xE0, 0x33, 0x63, 0xD8, 
+0x64, 0x55, 0x42, 0xDA, 0xC6, 0x58, 0x10, 0x7F, 
+0xEF, 0xB1, 0x22, 0xA6, 0x19, 0x70, 0xFD, 0x6A, 
+0xB4, 0xB8, 0x79, 0xB3, 0x7F, 0x15, 0x96, 0xC3, 
+0x53, 0x26, 0xF2, 0xD1, 0xDF, 0x1C, 0x63, 0xD7, 
+0x7E, 0x77, 0xD9, 0x49, 0xDD, 0xE3, 0xE5, 0x38, 
+0xC4, 0x63, 0x41, 0x19, 0xF9, 0xE5, 0x72, 0xE7, 
+0x2F, 0x0B, 0xD0, 0x57, 0x8F, 0x18, 0x98, 0xF6, 
+0x7E, 0x26, 0x4C, 0x9B, 0xAD, 0xCF, 0xBC, 0xC0, 
+0x15, 0x46, 0xD4, 0x3A, 0xDC, 0x2C, 0x0E, 0x1A, 
+0x70, 0xD7, 0x22, 0x75, 0xA7, 0x7B, 0xF9, 0x63, 
+0xD0, 0xE8, 0xB7, 0xB1, 0x57, 0xA8, 0xD9, 0x0F, 
+0xB0, 0x98, 0x2C, 0x35, 0xE1, 0xD9, 0xB5, 0x11, 
+0x25, 0xA9, 0x13, 0x0E, 0x80, 0x34, 0x3D, 0x91, 
+0xFC, 0xC0, 0x5C, 0xB4, 0x6C, 0x91, 
+
+0x52, 0xA2, 0xD1, 0xF3, 0x3F, 0x69, 0xA0, 0x74, 
+0x6B, 0x83, 0x25, 0xA7, 0x05, 0xFF, 0x80, 0x40, 
+0x14, 0x5B, 0x09, 0xB1, 0xA3, 0x8C, 0x9D, 0xA3, 
+0x7F, 0x64, 0xEC, 0x05, 0xE3, 0xA9, 0x35, 0xCB, 
+0x21, 0x62, 0x07, 0xEA, 0xBF, 0x4C, 0x25, 0x1E, 
+0xCD, 0xD4, 0x2E, 0x1E, 0x51, 0x6B, 0x29, 0xEC, 
+0xB1, 0x14, 0x21, 0xF7, 0x91, 0xAF, 0x79, 0x73, 
+0x66, 0xEE, 0x4C, 0xAA, 0xF8, 0x5E, 0x01, 0x51, 
+0x23, 0x2A, 0xDC, 0xD2, 0x52, 0x6D, 0x49, 0x21, 
+0x18, 0x13, 0xEA, 0x47, 0x0C, 0xA1, 0x9D, 0x14, 
+0x9A, 0x5E, 0xF8, 0xEB, 0xFC, 0xBE, 0xBC, 0xAE, 
+0x9E, 0x03, 0xB2, 0x69, 0xAB, 0xCF, 0xB3, 0x12, 
+0xC9, 0x52, 0xA2, 0xD7, 0xC6, 0xFB, 0x7E, 0x84, 
+0x4C, 0x3C, 0x1B, 0x56, 0x67, 0x2A, 0xC8, 0xC7, 
+0xFE, 0xB1, 0x27, 0xCD, 0x9A, 0x6C, 0x72, 0x44, 
+0x9B, 0xDE, 0x8D, 0x11, 0x10, 0x27, 0x42, 0x96, 
+0xD2, 0x54, 0xA0, 0xBA, 0xA7, 0x73, 0xFF, 0x17, 
+0x1C, 0xB6, 0xF8, 0x39, 0x4C, 0x0A, 0xE2, 
+
+0x0B, 0xF7, 0x61, 0xBC, 0x7E, 0xA7, 0xF9, 0x86, 
+0x86, 0x73, 0x67, 0xAF, 0xA0, 0x6B, 0xA7, 0x54, 
+0xE0, 0x91, 0xF8, 0x6A, 0x43, 0xDB, 0xE3, 0x12, 
+0x34, 0x79, 0x9F, 0x0D, 0x43, 0x55, 0x94, 0xC2, 
+0xDD, 0x93, 0x10, 0xD4, 0x20, 0xED, 0x66, 0x0C, 
+0x94, 0x71, 0x22, 0xEF, 0xCB, 0x61, 0xA9, 0x5F, 
+0x4B, 0x8D, 0x45, 0x02, 0xE8, 0x17, 0x7A, 0x23, 
+0x2B, 0x2F, 0xFF, 0xA3, 0xCC, 0xBC, 0xDE, 0x39, 
+0x4D, 0x51, 0x65, 0xD2, 0xC9, 0xCD, 0xEE, 0x35, 
+0xAD, 0x9C, 0x27, 0x33, 0xDA, 0xFC, 0x4E, 0x3F, 
+0xC3, 0xB6, 0x05, 0x70, 0xD0, 0x72, 0x1A, 0x99, 
+0xA5, 0x02, 0x25, 0x97, 0x3B, 0x3C, 0xF9, 0x10, 
+0xBF, 0x40, 0xE9, 0x59, 0x6C, 0x55, 0x89, 0x92, 
+0x2A, 0x5A, 0x3E, 0x37, 0xF2, 0xAF, 0x60, 0x9C, 
+0x51, 0xB2, 0x4C, 0xFF, 0xB4, 0x86, 0x2F, 0x2B, 
+0x15, 0x04, 0x28, 0xA9, 0xB7, 0x76, 0xD7, 0x65, 
+0x89, 0xB2, 0x29, 0x6D, 0xFA, 0x12, 0xAC, 0xA8, 
+0x96, 0x33, 0xEB, 0xF8, 0x4D, 0x9E, 0x0B, 0xA9, 
+
+0x3B, 0xC4, 0x8A, 0x01, 0x8D, 0x39, 0x4D, 0x78, 
+0x5D, 0x42, 0x39, 0x80, 0xFC, 0xB3, 0x9D, 0x79, 
+0x21, 0x8B, 0x36, 0xF9, 0xCA, 0x70, 0x0B, 0x41, 
+0x6B, 0x6F, 0x04, 0xD9, 0x65, 0x97, 0x17, 0x1D, 
+0xDD, 0x2B, 0x82, 0x97, 0x57, 0x1B, 0x79, 0xB3, 
+0xEC, 0x04, 0x79, 0x09, 0x44, 0x41, 0x9E, 0xFD, 
+0x56, 0xBB, 0x89, 0x3D, 0x2E, 0x79, 0x76, 0x9A, 
+0x13, 0xA5, 0xB9, 0xF3, 0x84, 0x28, 0x68, 0x73, 
+0xAB, 0xCE, 0x15, 0xBF, 0x66, 0x48, 0xC9, 0xBD, 
+0xFA, 0x64, 0x38, 0x43, 0x9F, 0x39, 0x7E, 0x7C, 
+0x0A, 0xC2, 0x8F, 0xDE, 0x76, 0x89, 0xAD, 0xA2, 
+0x71, 0x71, 0x53, 0x96, 0x7C, 0xE4, 0x0B, 0x35, 
+0x28, 0x69, 0x8C, 0xFD, 0x22, 0x54, 0xB8, 0x7D, 
+0x74, 0x15, 0xCE, 0xC6, 0xD2, 0x0D, 0xFB, 0x2A, 
+0xAA, 0x2A, 0x27, 0x13, 0xE4, 0x59, 0x8F, 0x25, 
+0x8D, 0x65, 0x4B, 0xBD, 0x5D, 0x89, 0x61, 0x96, 
+0xAB, 0x8A, 0x1B, 0x36, 0x61, 0xA2, 0x7B, 0x9D, 
+0x46, 0xEE, 0xFF, 0x17, 0xC5, 0x2A, 0x8A, 0x21, 
+0xCB, 
+
+0x10, 0xB9, 0xE5, 0xC4, 0x60, 0x1F, 0xED, 0x99, 
+0x57, 0x1D, 0x40, 0x94, 0xE0, 0x58, 0xEE, 0x0C, 
+0x0F, 0x9F, 0x60, 0xFE, 0x2C, 0x0C, 0xEE, 0xDA, 
+0x0C, 0x04, 0x32, 0xDB, 0x17, 0xBC, 0x34, 0x93, 
+0xBD, 0x0F, 0xAE, 0xCD, 0x0D, 0x2B, 0x63, 0x4A, 
+0x64, 0x95, 0xF9, 0x44, 0x9A, 0xDB, 0xA0, 0x42, 
+0x04, 0x49, 0xCE, 0x90, 0x6D, 0x74, 0x6A, 0xA1, 
+0x74, 0xA8, 0x0D, 0x45, 0x19, 0x11, 0x98, 0xEA, 
+0xEE, 0xBA, 0xDB, 0x81, 0x67, 0x46, 0xBA, 0x55, 
+0xAD, 0xAA, 0x0B, 0x40, 0xED, 0x9B, 0x02, 0x22, 
+0x04, 0xD1, 0xDB, 0x18, 0xDA, 0x0B, 0x8F, 0x38, 
+0xF4, 0x84, 0x16, 0xC1, 0x04, 0x3B, 0x30, 0x24, 
+0x96, 0xE5, 0x55, 0x9B, 0xC6, 0x4B, 0x76, 0x59, 
+0xF4, 0xB2, 0x00, 0x3A, 0xCC, 0x7F, 0xED, 0xD6, 
+0xFF, 0x43, 0x26, 0xB8, 0x13, 0xF0, 0xD5, 0x38, 
+0x3B, 0x7C, 0x28, 0x79, 0xB6, 0xF9, 0x3D, 0x9A, 
+0x80, 0xCF, 0x55, 0xD0, 0xF8, 0xFE, 0x27, 0x7A, 
+0xBA, 0xFA, 0x64, 0x70, 0xC7, 0x5E, 0x42, 0x9B, 
+0xB0, 0x64, 
+
+0xC6, 0x6A, 0x93, 0xF3, 0x76, 0x19, 0x85, 0xCE, 
+0x01, 0xA1, 0x3B, 0xEB, 0x75, 0xB3, 0x80, 0x6A, 
+0x1B, 0x67, 0x90, 0xB5, 0xD7, 0x70, 0x56, 0x44, 
+0xAF, 0xF8, 0x4D, 0xC0, 0x9A, 0xC3, 0xE1, 0xAA, 
+0x47, 0xAA, 0xB8, 0x78, 0x28, 0x3A, 0x94, 0x2B, 
+0xBB, 0x65, 0x8A, 0xC4, 0x9A, 0x5E, 0xF4, 0x98, 
+0x4B, 0xF6, 0x2A, 0x5A, 0xC1, 0xFF, 0xAA, 0xFD, 
+0xB0, 0x9C, 0xB2, 0xCA, 0x25, 0xD1, 0x61, 0x0F, 
+0x09, 0x69, 0xF8, 0x30, 0x6A, 0xE9, 0x2F, 0x5B, 
+0xA4, 0x04, 0x44, 0xE5, 0x03, 0x5C, 0xD1, 0xF1, 
+0x5F, 0xC1, 0xF3, 0x0B, 0xED, 0x41, 0x8F, 0xF2, 
+0xC5, 0xBD, 0x55, 0x39, 0x28, 0x64, 0x32, 0x9A, 
+0x41, 0xD1, 0x88, 0xFB, 0x08, 0x04, 0x3A, 0x7F, 
+0x47, 0x51, 0x89, 0x2A, 0x4D, 0x96, 0xBC, 0x1B, 
+0x22, 0xA9, 0xF2, 0x94, 0x22, 0xBD, 0x50, 0x42, 
+0x57, 0xD5, 0xED, 0x6F, 0x83, 0xDC, 0xE9, 0x13, 
+0x6E, 0x05, 0x2A, 0xBD, 0x51, 0x60, 0xBF, 0x79, 
+0x0F, 0xA0, 0x3F, 0xD1, 0x36, 0x05, 0x19, 0xCD, 
+0x59, 0xA6, 0x1E, 
+
+0x0D, 0x2C, 0x33, 0xD3, 0x90, 0x58, 0x17, 0xBE, 
+0xAB, 0x92, 0xD5, 0x70, 0xD6, 0xA6, 0x8E, 0x1E, 
+0x6E, 0x3F, 0x94, 0xE0, 0xCA, 0xCF, 0x72, 0x57, 
+0x3B, 0xFE, 0x08, 0xF4, 0x8B, 0xF3, 0x59, 0x4B, 
+0x9F, 0x1F, 0xE7, 0x8F, 0x75, 0x09, 0xA8, 0x05, 
+0x85, 0x82, 0x58, 0xD9, 0x43, 0x9B, 0x23, 0xB5, 
+0xA9, 0x00, 0xC7, 0x0E, 0xF2, 0xF2, 0x9F, 0x20, 
+0xC3, 0xF2, 0x21, 0x90, 0xA1, 0x22, 0xD9, 0x12, 
+0xAF, 0xDF, 0xCB, 0x27, 0x9C, 0x4F, 0xB5, 0x3C, 
+0x91, 0x9F, 0x5B, 0x33, 0xC6, 0x3D, 0xF2, 0x23, 
+0x55, 0xB4, 0x92, 0x6B, 0x95, 0xCE, 0xB9, 0x07, 
+0x4C, 0x44, 0xFE, 0x6A, 0x9A, 0x30, 0xB1, 0x5E, 
+0x68, 0x3C, 0xF4, 0x36, 0x1C, 0xC5, 0x3D, 0x68, 
+0x1E, 0x40, 0x45, 0xC6, 0xFB, 0x34, 0x01, 0x34, 
+0xD6, 0x4A, 0xC3, 0x51, 0xF7, 0x0D, 0x7A, 0x1B, 
+0xF7, 0x8F, 0x0D, 0xCB, 0xE2, 0x41, 0xDF, 0x3A, 
+0xBC, 0xE6, 0x27, 0xEB, 0x48, 0xDA, 0x1A, 0xFF, 
+0xB2, 0x9A, 0xAD, 0xFA, 0xC6, 0x51, 0x33, 0xB1, 
+0x34, 0x64, 0x02, 0x91, 
+
+0x68, 0x13, 0x49, 0x8C, 0x89, 0x44, 0xE0, 0x9E, 
+0x0F, 0x07, 0x72, 0x6C, 0x2A, 0xE6, 0x38, 0xB8, 
+0x20, 0xC4, 0x0F, 0x34, 0xDA, 0x2C, 0xA6, 0x87, 
+0xEA, 0xE2, 0x20, 0x7F, 0xCC, 0x9F, 0xC9, 0xCC, 
+0x54, 0x25, 0x6A, 0xB2, 0x38, 0x6E, 0x49, 0xD8, 
+0xF8, 0xD3, 0xC6, 0x63, 0x52, 0xE1, 0x79, 0xBA, 
+0x22, 0x48, 0xFE, 0x5C, 0x21, 0x1D, 0x2B, 0x37, 
+0x0B, 0xFC, 0x54, 0xEF, 0x2E, 0xAD, 0x8F, 0xBD, 
+0xD9, 0x28, 0x72, 0xFA, 0x63, 0xF7, 0x8D, 0x19, 
+0xDD, 0xA2, 0x3C, 0xFC, 0x36, 0x6E, 0xF6, 0x20, 
+0x83, 0x78, 0xF7, 0x87, 0x7F, 0x8B, 0x79, 0x6F, 
+0x07, 0x63, 0xF5, 0xAE, 0xB7, 0x89, 0x37, 0xC3, 
+0x4C, 0xE0, 0x7B, 0x59, 0xEB, 0x5B, 0x2A, 0x0E, 
+0x1A, 0x88, 0xAF, 0x71, 0xE0, 0xAE, 0x37, 0x2E, 
+0xB4, 0x38, 0x36, 0xE1, 0xDA, 0x4F, 0xEB, 0x10, 
+0xE3, 0x77, 0x68, 0x2A, 0xC4, 0x1B, 0x39, 0xF2, 
+0xCA, 0xB8, 0xC2, 0x22, 0xB9, 0xCF, 0x4C, 0xC9, 
+0x88, 0xD7, 0x09, 0xD2, 0x88, 0xE2, 0x96, 0x36, 
+0xB3, 0xD0, 0xCF, 0x89, 0x71, 
+
+0x07, 0x34, 0x14, 0xD6, 0xD8, 0x60, 0x4D, 0x13, 
+0x97, 0x6C, 0xE3, 0x5A, 0x71, 0x23, 0xB1, 0x81, 
+0xB5, 0x4D, 0xC3, 0x24, 0x02, 0xFB, 0x42, 0x11, 
+0xCD, 0x95, 0x1D, 0xFC, 0xEB, 0x81, 0xA4, 0x1A, 
+0x54, 0x5C, 0x0E, 0xE7, 0xE8, 0x1F, 0x0B, 0x7B, 
+0x3A, 0x11, 0x74, 0x47, 0xFC, 0x09, 0x4F, 0x69, 
+0x6C, 0xC5, 0x98, 0x35, 0xC1, 0xE3, 0xDF, 0x46, 
+0xA6, 0x23, 0xDA, 0xB3, 0xD5, 0xA3, 0xE6, 0x03, 
+0x6C, 0x91, 0xCA, 0x74, 0xC8, 0x33, 0xDC, 0xFA, 
+0x96, 0x7B, 0x37, 0xB1, 0x35, 0x15, 0x88, 0x64, 
+0xB1, 0xCA, 0x77, 0x21, 0x7A, 0xA6, 0x88, 0x91, 
+0x8E, 0x70, 0x28, 0xA6, 0x60, 0x22, 0x61, 0x5D, 
+0x72, 0x47, 0x60, 0x15, 0xD7, 0xB8, 0x7D, 0xED, 
+0x0A, 0x1B, 0x02, 0x63, 0xA5, 0x44, 0x57, 0x3B, 
+0x27, 0x2E, 0x7C, 0x43, 0x4B, 0x37, 0xF1, 0xA6, 
+0x20, 0x40, 0x51, 0xD8, 0x1C, 0x8E, 0x82, 0x16, 
+0x86, 0x79, 0xFD, 0x71, 0x65, 0x3A, 0xDD, 0xAD, 
+0xB8, 0x5B, 0x28, 0x1F, 0x4E, 0x84, 0x26, 0x04, 
+0x7A, 0x41, 0xB2, 0xF1, 0x96, 0xA4, 
+
+0x67, 0xA5, 0x9B, 0xD0, 0x6A, 0x52, 0xBE, 0x40, 
+0xE5, 0xBE, 0xB2, 0x00, 0xEE, 0x7D, 0x87, 0xEB, 
+0x8E, 0x1D, 0xBE, 0x27, 0xB1, 0xEE, 0x2F, 0x1D, 
+0x85, 0x00, 0x39, 0x88, 0xF9, 0xA4, 0x80, 0xB5, 
+0x90, 0x21, 0x07, 0xF2, 0x48, 0x9F, 0xF3, 0x79, 
+0x72, 0x6C, 0x50, 0x89, 0x00, 0xB4, 0x19, 0x91, 
+0x3E, 0xD0, 0x20, 0x32, 0xEF, 0x72, 0x09, 0x94, 
+0x93, 0x6E, 0x6F, 0xA1, 0x49, 0xC2, 0x72, 0x44, 
+0x24, 0x3E, 0x01, 0x1B, 0xD1, 0x29, 0x7F, 0x64, 
+0xBB, 0x22, 0x9C, 0xAC, 0xFD, 0x21, 0xE8, 0x88, 
+0x42, 0x97, 0x82, 0x8B, 0x18, 0x49, 0xA1, 0xFE, 
+0x6C, 0x85, 0x81, 0xC8, 0xE9, 0xD1, 0x17, 0x24, 
+0xBF, 0xC0, 0xF4, 0xBC, 0xFE, 0xC7, 0x3A, 0x5D, 
+0x85, 0x10, 0x4F, 0x88, 0x98, 0x19, 0xA6, 0x59, 
+0x93, 0x5E, 0xA6, 0x75, 0x46, 0xC1, 0x5C, 0x5E, 
+0xB9, 0xEA, 0x46, 0xE5, 0x9B, 0xB5, 0xE2, 0xCA, 
+0x1F, 0x52, 0x9B, 0xC6, 0x08, 0x8D, 0x8F, 0x2E, 
+0x19, 0x70, 0xCC, 0xD9, 0x62, 0x2A, 0x36, 0x4E, 
+0xE4, 0xAD, 0xC1, 0x0C, 0x63, 0x25, 0x3C, 
+
+0x0D, 0x70, 0x8C, 0x5F, 0xF0, 0xF2, 0x7F, 0x8E, 
+0x1E, 0xA8, 0xEB, 0xDE, 0x31, 0xEF, 0x58, 0x20, 
+0x4B, 0x08, 0x79, 0x4E, 0x62, 0xC0, 0xA6, 0x81, 
+0xF1, 0xF5, 0x71, 0xC7, 0xD6, 0xFA, 0x57, 0x23, 
+0x14, 0xCD, 0x18, 0xD6, 0x9E, 0x3B, 0x25, 0x7B, 
+0xEF, 0x27, 0x69, 0x00, 0x08, 0x88, 0xA1, 0xBD, 
+0xAA, 0x40, 0xDA, 0xE8, 0x09, 0xD7, 0x79, 0x3D, 
+0x1F, 0x59, 0x51, 0xA9, 0x27, 0x1F, 0x23, 0x9D, 
+0x4B, 0x06, 0xDD, 0xDB, 0xA0, 0x49, 0x37, 0xDA, 
+0x1F, 0x8A, 0x27, 0x00, 0x92, 0x38, 0xCC, 0xCB, 
+0xFD, 0x13, 0xF1, 0x8C, 0xAA, 0xB4, 0x9A, 0x90, 
+0x47, 0x09, 0x44, 0x1B, 0x6D, 0x7C, 0x00, 0xC5, 
+0x8D, 0xC5, 0x06, 0xBB, 0xC5, 0x48, 0x6D, 0x80, 
+0x8F, 0x0B, 0x4F, 0x71, 0x70, 0x48, 0xC5, 0xB7, 
+0x78, 0x99, 0xD8, 0x36, 0xBE, 0xCB, 0x39, 0x9E, 
+0x2A, 0x43, 0xD9, 0x80, 0xF3, 0x49, 0xD7, 0xFF, 
+0xFB, 0x59, 0x7C, 0xB3, 0xFD, 0xAB, 0x0E, 0xCC, 
+0x18, 0x8C, 0x82, 0xF0, 0xA2, 0x76, 0x1C, 0x47, 
+0x24, 0xDC, 0x8C, 0x22, 0x29, 0xD0, 0x7B, 0xC9, 
+
+0xF4, 0x21, 0x23, 0x47, 0x23, 0xAC, 0x48, 0xA0, 
+0x85, 0x72, 0x61, 0x88, 0x52, 0xE4, 0xEB, 0x57, 
+0x99, 0xB6, 0x70, 0x22, 0xA0, 0x91, 0xA1, 0x16, 
+0xAB, 0x46, 0x19, 0x5D, 0x98, 0x54, 0xE4, 0x57, 
+0x74, 0xF5, 0x2D, 0x92, 0x2F, 0x00, 0x7C, 0xCA, 
+0x78, 0x91, 0x92, 0x3E, 0x6D, 0x16, 0x0B, 0x57, 
+0x0F, 0x65, 0xB5, 0x69, 0x2F, 0x2B, 0xB4, 0xA2, 
+0x82, 0x16, 0xD5, 0xDB, 0x5C, 0x90, 0x1D, 0xD5, 
+0x58, 0x34, 0x46, 0xF2, 0x4E, 0x65, 0x5C, 0xDF, 
+0x89, 0x53, 0xAA, 0xA3, 0x19, 0x1F, 0xC3, 0x3E, 
+0x50, 0x1D, 0xF6, 0x68, 0x8E, 0x45, 0xC8, 0x46, 
+0x89, 0x76, 0x86, 0xED, 0x47, 0x62, 0x82, 0x10, 
+0x47, 0xAB, 0x52, 0x04, 0xA8, 0x26, 0xB1, 0x35, 
+0x40, 0x76, 0x74, 0xFA, 0xA1, 0x17, 0x9C, 0xC8, 
+0x88, 0x07, 0xF0, 0x94, 0xF3, 0x81, 0x6D, 0x66, 
+0xA9, 0xD4, 0xF3, 0x52, 0x48, 0x42, 0x04, 0x38, 
+0x5E, 0x82, 0x21, 0x53, 0x0F, 0xDB, 0x74, 0x72, 
+0x86, 0x52, 0x7E, 0xD7, 0xB8, 0x38, 0x0C, 0x96, 
+0xC6, 0x25, 0xAF, 0xAA, 0x0F, 0x8F, 0x75, 0x34, 
+0xA2, 
+
+0x85, 0xDF, 0xC6, 0xFE, 0xAC, 0xED, 0x79, 0xE8, 
+0xAB, 0x23, 0xC2, 0xFB, 0xF1, 0x45, 0x2A, 0xC3, 
+0xB6, 0xB2, 0x14, 0x94, 0x17, 0x4D, 0x0C, 0x49, 
+0x8D, 0x30, 0xDC, 0x97, 0x76, 0x33, 0xC7, 0xDA, 
+0x2A, 0x49, 0x05, 0x6B, 0x75, 0x51, 0x03, 0x45, 
+0x5E, 0x4B, 0x1A, 0x3D, 0x46, 0x70, 0xE4, 0xB6, 
+0x74, 0x94, 0xF7, 0x96, 0x8D, 0x14, 0xE2, 0x70, 
+0xA2, 0x39, 0xFE, 0xD8, 0x93, 0x95, 0x64, 0xA0, 
+0x82, 0x39, 0xE0, 0x6D, 0x82, 0x6A, 0xBD, 0xE8, 
+0xE7, 0x35, 0xD0, 0x5B, 0x22, 0xD0, 0x09, 0x79, 
+0xEF, 0xC0, 0x46, 0xC8, 0x0F, 0x46, 0x79, 0xBF, 
+0x22, 0xA4, 0x51, 0xA3, 0x4E, 0xE4, 0x81, 0xCC, 
+0x4B, 0x73, 0xA7, 0x02, 0x78, 0x65, 0xCC, 0x93, 
+0xB2, 0xA4, 0x77, 0x96, 0xA2, 0x1A, 0xE7, 0xFA, 
+0x40, 0x4A, 0x2D, 0x0E, 0x79, 0x62, 0x90, 0x46, 
+0x2A, 0x16, 0xF6, 0x92, 0x5B, 0x3C, 0x69, 0x31, 
+0x99, 0xB3, 0xFB, 0xDD, 0xCE, 0x99, 0x9A, 0x71, 
+0x0F, 0x9C, 0xDC, 0x88, 0xFD, 0xC8, 0x29, 0x46, 
+0x7A, 0xE9, 0x66, 0xA5, 0xEF, 0x52, 0x64, 0x83, 
+0x2B, 0xF3, 
+
+0xEE, 0x3E, 0x37, 0x60, 0xDB, 0x5E, 0x38, 0x4F, 
+0xA7, 0x92, 0x11, 0x57, 0x91, 0x3D, 0xCC, 0xB6, 
+0x5A, 0x7E, 0xDF, 0x72, 0xB2, 0xF4, 0xE9, 0x2D, 
+0x53, 0x54, 0x31, 0x23, 0xAB, 0xF0, 0xDB, 0x59, 
+0x62, 0xC6, 0xE1, 0x72, 0x89, 0x62, 0x50, 0x26, 
+0xAB, 0x0A, 0x3A, 0x41, 0xB1, 0x00, 0xE1, 0x1E, 
+0x81, 0xFD, 0xA9, 0xD1, 0xC8, 0xE9, 0x70, 0x20, 
+0x05, 0x0B, 0xE7, 0xB5, 0x6B, 0xC8, 0xB1, 0x55, 
+0xD0, 0x97, 0x51, 0xB6, 0x1F, 0x9F, 0x69, 0xC6, 
+0xD8, 0x81, 0x81, 0x5C, 0xA0, 0x90, 0x4D, 0x8E, 
+0xBD, 0x24, 0xA6, 0x88, 0xE1, 0xCF, 0xBC, 0xC6, 
+0xAC, 0x10, 0x19, 0xA5, 0x10, 0x41, 0x92, 0x86, 
+0x3D, 0x73, 0xE2, 0xC7, 0x6C, 0xCD, 0xA7, 0x8B, 
+0x73, 0xFC, 0x04, 0xC4, 0x39, 0xB4, 0x07, 0xBC, 
+0xF2, 0x62, 0x6A, 0x7D, 0xA3, 0x49, 0xBC, 0x64, 
+0xF4, 0x76, 0x2C, 0x59, 0x81, 0x3E, 0xF7, 0x8A, 
+0xD2, 0xB4, 0x38, 0x5E, 0x3A, 0xFA, 0x3A, 0xD4, 
+0x82, 0xB2, 0x34, 0xBC, 0xE0, 0x5D, 0xD1, 0x20, 
+0x65, 0x23, 0x41, 0x9B, 0x09, 0xF8, 0x45, 0x3B, 
+0x8C, 0xBF, 0x04, 
+
+0x0B, 0xB7, 0xA8, 0x64, 0xB5, 0xF9, 0x1A, 0xA7, 
+0x3F, 0xF8, 0x13, 0x1A, 0x4E, 0x63, 0x7C, 0xB3, 
+0x54, 0x7A, 0x57, 0x1C, 0x55, 0x2F, 0x79, 0x13, 
+0xA8, 0xF1, 0xFC, 0x8A, 0x1F, 0x2E, 0x22, 0x7F, 
+0xC5, 0xDA, 0x25, 0x0B, 0x99, 0x47, 0x10, 0x49, 
+0x03, 0x43, 0x13, 0x45, 0xE5, 0x79, 0xD9, 0xB7, 
+0x88, 0x49, 0x10, 0x4E, 0x7F, 0x84, 0x51, 0x89, 
+0xA1, 0x66, 0x2A, 0x5C, 0xE2, 0xD5, 0xFF, 0x4C, 
+0x42, 0x50, 0x41, 0x5B, 0x16, 0x9E, 0xF5, 0x31, 
+0x70, 0x8F, 0xC7, 0xAD, 0xC8, 0xFA, 0x5A, 0x20, 
+0xE8, 0x4E, 0xEF, 0xD3, 0x53, 0x1C, 0xFD, 0x63, 
+0x1D, 0x71, 0x73, 0x35, 0x83, 0xF6, 0x2F, 0xA8, 
+0x6F, 0xC5, 0x86, 0x0D, 0x6F, 0xBF, 0x4A, 0xA8, 
+0x54, 0xFC, 0x20, 0xA8, 0x1F, 0xD5, 0x65, 0x96, 
+0x75, 0xDC, 0x2C, 0x54, 0xCA, 0xAD, 0x4B, 0x4A, 
+0x1D, 0x6C, 0x18, 0x7F, 0xD1, 0xF0, 0x2E, 0x03, 
+0x17, 0xBC, 0xA5, 0x4C, 0x47, 0xC1, 0xBC, 0xFB, 
+0x5B, 0x46, 0x83, 0x9F, 0xC0, 0xD4, 0x8A, 0xF2, 
+0x4C, 0x61, 0x20, 0xC4, 0x83, 0xAA, 0x20, 0xE3, 
+0x8B, 0x03, 0xF1, 0xD6, 
+
+0xEA, 0x2D, 0x0B, 0x89, 0x79, 0x7E, 0xD3, 0x25, 
+0x2B, 0x87, 0x8B, 0x1D, 0xEF, 0x21, 0x8B, 0x61, 
+0xC9, 0x3D, 0x8F, 0xB9, 0xA6, 0x8D, 0x10, 0x2C, 
+0xC2, 0x8C, 0xC2, 0xDE, 0xED, 0xB5, 0x70, 0x68, 
+0xA0, 0x59, 0x94, 0xB0, 0x93, 0xDC, 0x57, 0x46, 
+0x85, 0x41, 0x9E, 0xCB, 0x51, 0xFE, 0x20, 0xB0, 
+0x6F, 0xEF, 0x0B, 0x97, 0x33, 0x3B, 0xED, 0x74, 
+0xA4, 0xF0, 0xD6, 0x0D, 0x86, 0xFF, 0xDD, 0x25, 
+0x50, 0x52, 0xC3, 0x6C, 0xF3, 0x82, 0xDF, 0x72, 
+0xEF, 0xDB, 0x89, 0xC0, 0xAB, 0x95, 0x55, 0xD3, 
+0x8C, 0x20, 0x7D, 0xEF, 0x1A, 0xE4, 0x7E, 0x7A, 
+0x84, 0x9A, 0x10, 0xE5, 0x89, 0x5D, 0xE2, 0x74, 
+0x44, 0xAE, 0x55, 0x77, 0x7F, 0x03, 0xB3, 0x6D, 
+0x7E, 0x3A, 0xD1, 0x7B, 0xCE, 0x8C, 0x60, 0x39, 
+0xE4, 0xB6, 0xB3, 0x9C, 0x2B, 0xAE, 0x0F, 0x5D, 
+0xC7, 0xA0, 0xB2, 0xB1, 0x4F, 0x1D, 0x7C, 0x12, 
+0xC0, 0x36, 0xA4, 0xB9, 0x6B, 0xA4, 0xE0, 0x10, 
+0xC9, 0xB7, 0x90, 0x5F, 0x0F, 0xF8, 0xB4, 0x15, 
+0x93, 0x4D, 0x2C, 0xEC, 0x6D, 0x67, 0xE5, 0xF4, 
+0xFF, 0x36, 0xEB, 0xF7, 0x91, 
+
+0xEF, 0xC6, 0x77, 0x8F, 0x84, 0x7F, 0x9D, 0x2A, 
+0x4B, 0xD6, 0x77, 0x54, 0x57, 0xAB, 0x9B, 0xA1, 
+0xD7, 0x67, 0xC2, 0xDD, 0x9C, 0x7C, 0x41, 0x17, 
+0x43, 0x4A, 0x23, 0x63, 0xF5, 0x8A, 0x38, 0x94, 
+0x3B, 0xE3, 0xBD, 0x66, 0x5C, 0x47, 0x8C, 0x18, 
+0x8C, 0xEA, 0x16, 0x7B, 0x0D, 0xA1, 0x8B, 0x3F, 
+0x89, 0x4E, 0x18, 0x9F, 0x3F, 0x74, 0x58, 0xC9, 
+0xFF, 0x81, 0x9B, 0x96, 0x5A, 0xF8, 0xD8, 0x4B, 
+0xAD, 0x56, 0x8C, 0xB2, 0x52, 0x8A, 0x25, 0xDE, 
+0x19, 0xE2, 0x9F, 0x7F, 0xB1, 0x8E, 0xC8, 0x07, 
+0x34, 0x17, 0x61, 0x20, 0x0E, 0x4F, 0x1B, 0xD7, 
+0x43, 0xB9, 0x11, 0xD5, 0xBF, 0x2B, 0x68, 0xF6, 
+0xCD, 0xDE, 0xB5, 0x3E, 0x40, 0x36, 0x4E, 0xFD, 
+0xF9, 0x3A, 0x8E, 0x2E, 0x3B, 0x55, 0x9D, 0x73, 
+0xE7, 0x19, 0xE6, 0x74, 0xF7, 0xAB, 0x2C, 0x79, 
+0x81, 0xA5, 0xBE, 0x4A, 0x24, 0xE7, 0xB2, 0x68, 
+0x82, 0x9C, 0x32, 0x0B, 0xBC, 0x60, 0xDD, 0x80, 
+0x0D, 0x84, 0xBD, 0xEC, 0xA1, 0x73, 0x9D, 0x0D, 
+0x1F, 0xEC, 0x5E, 0x3E, 0x1C, 0x74, 0x2B, 0x90, 
+0xF6, 0x3A, 0x39, 0x3B, 0x04, 0xC6, 
+
+0x2B, 0x3C, 0x23, 0x8D, 0x82, 0xD3, 0xAA, 0x8D, 
+0x05, 0x0A, 0x73, 0x47, 0x4C, 0x86, 0x68, 0x5E, 
+0x3C, 0x8C, 0x12, 0xD2, 0x49, 0xAD, 0x1B, 0xC4, 
+0x93, 0xF0, 0x2A, 0x39, 0x29, 0x85, 0x8D, 0x48, 
+0x84, 0xA9, 0x66, 0xA4, 0x02, 0x7B, 0xBD, 0xA9, 
+0x93, 0x83, 0x83, 0x1E, 0x3A, 0xA6, 0xEF, 0x49, 
+0x90, 0x4E, 0x94, 0x1C, 0x03, 0x4A, 0xE4, 0x8B, 
+0xF7, 0xC6, 0xEA, 0x5B, 0x0D, 0xAB, 0x56, 0x88, 
+0x8F, 0x99, 0x15, 0xA6, 0x72, 0x0E, 0xB2, 0xFE, 
+0xD9, 0x5E, 0x56, 0xE4, 0x4F, 0x86, 0x70, 0xD7, 
+0x20, 0x28, 0x54, 0xAB, 0x02, 0x2F, 0x74, 0x81, 
+0x29, 0xA9, 0x4F, 0xF8, 0xBD, 0x36, 0x0D, 0x99, 
+0xDF, 0x8E, 0x84, 0x4E, 0x7D, 0xB1, 0x48, 0xFA, 
+0x55, 0xFB, 0x3B, 0xB5, 0xDF, 0x59, 0xE6, 0x49, 
+0xA5, 0xE6, 0xAB, 0x27, 0xFA, 0xA7, 0x88, 0xB8, 
+0x8F, 0xCE, 0xE1, 0x19, 0xB0, 0x4A, 0x06, 0x49, 
+0x3C, 0x5C, 0x5C, 0x8B, 0xD2, 0x85, 0x41, 0x42, 
+0x59, 0x85, 0xAC, 0x87, 0x4B, 0xC7, 0xB8, 0x65, 
+0xC2, 0xA6, 0xCD, 0x91, 0xEF, 0x5D, 0xFD, 0x55, 
+0x69, 0xF0, 0x9E, 0xA8, 0xF6, 0xE8, 0xB0, 
+
+0xB6, 0x7A, 0x09, 0xC9, 0x57, 0x18, 0xFE, 0x90, 
+0xB8, 0x44, 0xD2, 0x9E, 0xFC, 0xCF, 0x7D, 0x21, 
+0x0C, 0xA7, 0x51, 0xCE, 0x4C, 0x72, 0x3C, 0xB3, 
+0xCE, 0x1C, 0xD8, 0x2E, 0x87, 0x1C, 0x15, 0x1D, 
+0x2B, 0xEE, 0x70, 0xFE, 0x2E, 0x75, 0xA3, 0xA3, 
+0xE2, 0xEA, 0xB9, 0x42, 0xFB, 0x32, 0x6E, 0x86, 
+0x8C, 0x67, 0x63, 0xD0, 0xE8, 0xF9, 0xD5, 0xC5, 
+0x8C, 0x05, 0x8F, 0xB5, 0x95, 0xC5, 0x41, 0xB8, 
+0x09, 0xDB, 0x15, 0xFF, 0xC0, 0xCA, 0xDE, 0x68, 
+0xDC, 0x4C, 0xAB, 0x20, 0x06, 0x74, 0x54, 0x6A, 
+0x4B, 0xC4, 0x46, 0x13, 0x1D, 0xD2, 0x1E, 0xA7, 
+0x08, 0xD5, 0xA4, 0x94, 0x2B, 0xDD, 0xE2, 0x20, 
+0x21, 0xEE, 0x46, 0x7D, 0xF9, 0xE6, 0x22, 0x84, 
+0xD2, 0x59, 0x9F, 0xFC, 0x4D, 0x01, 0xBC, 0xDC, 
+0x4D, 0xE8, 0x8E, 0x35, 0x90, 0xA8, 0xC6, 0x9C, 
+0x1E, 0x3F, 0xDC, 0x5E, 0x64, 0x91, 0xA2, 0x62, 
+0x12, 0x4B, 0x4C, 0xA8, 0xB2, 0x8A, 0xBE, 0xDB, 
+0xB8, 0xA2, 0x02, 0x51, 0x93, 0x5D, 0x92, 0xEC, 
+0x60, 0x16, 0x45, 0x03, 0x1A, 0x04, 0x84, 0x23, 
+0xAE, 0x29, 0x96, 0x53, 0x5B, 0x6D, 0xE8, 0xE7, 
+
+0x1F, 0xCB, 0x23, 0x61, 0x9E, 0xC2, 0x4A, 0xC0, 
+0x85, 0x07, 0x9F, 0xF7, 0x40, 0x14, 0x59, 0xE4, 
+0x3A, 0xBB, 0x9E, 0x2E, 0x4D, 0x56, 0x61, 0x5B, 
+0x9A, 0xD2, 0xDD, 0x6C, 0x24, 0xD8, 0x78, 0x15, 
+0xBE, 0xD4, 0x44, 0x75, 0xA9, 0x26, 0x9A, 0x1B, 
+0x95, 0xBD, 0xB4, 0x23, 0x5B, 0xC5, 0x35, 0xF8, 
+0xF9, 0x89, 0x2D, 0xFD, 0xC8, 0xC6, 0x52, 0x8D, 
+0x4F, 0xCB, 0x07, 0xA9, 0x61, 0x3C, 0x28, 0x5B, 
+0x94, 0x42, 0x99, 0xCD, 0x51, 0x47, 0x4A, 0xAB, 
+0xB1, 0xE8, 0x8D, 0x17, 0x55, 0xCB, 0x90, 0x96, 
+0xC9, 0x50, 0xFD, 0x65, 0xFF, 0x46, 0x95, 0xB1, 
+0x54, 0xDA, 0xB5, 0x2C, 0x2B, 0x32, 0xAD, 0x94, 
+0x29, 0x30, 0x62, 0xF1, 0xF6, 0x0F, 0x45, 0x2E, 
+0x33, 0xD1, 0x35, 0x31, 0x1B, 0xB1, 0x02, 0x00, 
+0x11, 0x18, 0x25, 0x35, 0x80, 0xBF, 0xE2, 0xA4, 
+0xA6, 0xB1, 0x1B, 0xB3, 0x4D, 0xFD, 0xA3, 0xA4, 
+0xD2, 0xA3, 0x3D, 0x52, 0xB5, 0x59, 0x44, 0x03, 
+0xED, 0x41, 0x42, 0x72, 0x45, 0x17, 0x62, 0x36, 
+0x13, 0x32, 0x67, 0x0D, 0x69, 0x98, 0x16, 0x56, 
+0xEE, 0x5A, 0x8C, 0x6B, 0x24, 0xB1, 0xAC, 0xD3, 
+0x88, 
+
+0xBB, 0xB7, 0xEC, 0x72, 0x6A, 0xC0, 0xB9, 0x4D, 
+0xC4, 0xE3, 0xEB, 0xBA, 0xBE, 0xAE, 0xDB, 0x7F, 
+0xC4, 0xB6, 0x76, 0x5F, 0x9F, 0xEC, 0x60, 0x30, 
+0x94, 0x5E, 0xCA, 0x25, 0x89, 0x33, 0xE1, 0x41, 
+0x30, 0x6B, 0x1C, 0x9A, 0x9A, 0xC2, 0x4D, 0xA4, 
+0xC7, 0xC9, 0x0D, 0x6F, 0x92, 0xE2, 0x89, 0xEA, 
+0x38, 0x26, 0x3F, 0xE0, 0x77, 0xFB, 0x71, 0x35, 
+0xA6, 0x2B, 0x89, 0x46, 0x4C, 0x97, 0xE3, 0x93, 
+0x7E, 0x31, 0x1C, 0x37, 0xF6, 0x1B, 0x74, 0x24, 
+0x3D, 0xFF, 0x81, 0x9F, 0xA6, 0x81, 0xC4, 0xFC, 
+0x8A, 0x5D, 0xD8, 0x3A, 0xF5, 0xB2, 0xC4, 0xB7, 
+0x29, 0xB1, 0xF8, 0x54, 0x80, 0xA4, 0x44, 0x18, 
+0x50, 0x32, 0xD7, 0x80, 0x1D, 0xC9, 0x91, 0xE6, 
+0x68, 0x2C, 0xE2, 0xF1, 0x85, 0x4A, 0xF1, 0x9D, 
+0x25, 0xAB, 0x58, 0x5F, 0x42, 0x8E, 0x36, 0xB6, 
+0x5A, 0x7D, 0x4C, 0xCF, 0x50, 0x76, 0x80, 0x3E, 
+0x90, 0xBA, 0xA8, 0x4B, 0xBD, 0xC9, 0x9F, 0xF2, 
+0xC9, 0x86, 0x2A, 0xAC, 0x71, 0x2C, 0x93, 0x34, 
+0x9F, 0x5A, 0xFD, 0x72, 0x78, 0xB7, 0x50, 0xE2, 
+0x62, 0xBD, 0xF4, 0xF1, 0x68, 0x36, 0x3C, 0xCA, 
+0x16, 0xC7, 
+
+0x2C, 0x06, 0xE9, 0x49, 0x59, 0xBC, 0x3F, 0xCF, 
+0x55, 0x87, 0xAB, 0x12, 0x69, 0xE1, 0x0C, 0x69, 
+0x4D, 0xDB, 0xC9, 0x99, 0xB1, 0xF4, 0xFA, 0xE1, 
+0xD8, 0x18, 0xC8, 0xE1, 0x30, 0xE1, 0x3B, 0xF8, 
+0x28, 0x4E, 0xC3, 0xEA, 0x14, 0xE8, 0xDB, 0x8D, 
+0xC1, 0x94, 0xA5, 0x9E, 0x3F, 0x69, 0x1D, 0xBF, 
+0x49, 0x38, 0x50, 0xFA, 0x1E, 0xC7, 0xFD, 0xBD, 
+0xEB, 0x76, 0x46, 0x6E, 0x2D, 0x81, 0x4E, 0x1A, 
+0x48, 0xF0, 0xC1, 0x7E, 0xD2, 0x65, 0x36, 0x70, 
+0xD0, 0xC9, 0x38, 0x93, 0x67, 0x48, 0xD2, 0xA9, 
+0x13, 0x17, 0x4E, 0x23, 0x77, 0xE7, 0x74, 0xA5, 
+0xF2, 0xE6, 0x14, 0xAB, 0x0E, 0x71, 0xC5, 0xB2, 
+0x3A, 0x1F, 0x96, 0xC7, 0x92, 0x57, 0x0E, 0x33, 
+0x66, 0xF4, 0x17, 0xB8, 0x47, 0x50, 0xF7, 0x41, 
+0x5C, 0x43, 0x94, 0xF7, 0x52, 0x1B, 0xB2, 0xEB, 
+0xD9, 0x8D, 0x47, 0x8B, 0x76, 0x5F, 0x21, 0x01, 
+0xE7, 0x36, 0x20, 0xC5, 0x39, 0xA1, 0x6A, 0xDE, 
+0x76, 0xFA, 0x21, 0xA0, 0xBC, 0x04, 0xE3, 0x4B, 
+0x4B, 0xA0, 0x1B, 0x31, 0xD1, 0x67, 0xE0, 0x38, 
+0xB8, 0x87, 0xB0, 0xF8, 0xBD, 0x7B, 0xAC, 0x90, 
+0x34, 0x2E, 0x9C, 
+
+0xC2, 0x1C, 0xCC, 0x4C, 0x4A, 0x44, 0x28, 0x15, 
+0x01, 0x2D, 0xF8, 0x46, 0xD7, 0xE9, 0x48, 0x96, 
+0xED, 0xBD, 0x0F, 0x52, 0x19, 0xFC, 0xC5, 0x67, 
+0xB5, 0x96, 0x9B, 0xA4, 0xA7, 0xD8, 0x50, 0x41, 
+0x7E, 0x23, 0x7F, 0x56, 0x2C, 0x97, 0x9B, 0xB1, 
+0x7A, 0xE5, 0x77, 0xA9, 0xCD, 0xE3, 0x24, 0xCF, 
+0x18, 0xD9, 0x08, 0xC6, 0xBB, 0xF0, 0x49, 0x33, 
+0x3C, 0x8B, 0xFF, 0x59, 0x79, 0xDD, 0xCB, 0x96, 
+0x04, 0xD0, 0x6B, 0x23, 0x47, 0x40, 0xEB, 0x00, 
+0x21, 0xFA, 0xF9, 0x84, 0x24, 0x2A, 0x46, 0x8A, 
+0xEE, 0xE9, 0xBC, 0x0A, 0xD9, 0x0D, 0x84, 0x89, 
+0x1E, 0x80, 0x8E, 0x1A, 0x1A, 0x47, 0x18, 0x7D, 
+0xB1, 0x12, 0x14, 0xF9, 0x50, 0xC0, 0x66, 0x2E, 
+0xED, 0x46, 0x58, 0x75, 0x70, 0x4C, 0x4F, 0x0E, 
+0xEB, 0xA9, 0xA8, 0xDC, 0xE0, 0x3E, 0xA0, 0x67, 
+0xCD, 0xC0, 0x0D, 0x35, 0x7E, 0x6C, 0x34, 0x04, 
+0x0E, 0x3E, 0x22, 0x42, 0x86, 0x59, 0x3E, 0x5E, 
+0xC8, 0x05, 0xC5, 0x46, 0xD7, 0xBE, 0xE1, 0x02, 
+0x32, 0xE1, 0x25, 0xFD, 0xD0, 0xFC, 0x5E, 0xE3, 
+0xE4, 0xE8, 0x26, 0x06, 0x60, 0xF5, 0x5A, 0xBC, 
+0xA4, 0xDB, 0xAA, 0x7A, 
+
+0xDD, 0xCF, 0x8C, 0x55, 0xD9, 0x2B, 0xB5, 0xD6, 
+0x80, 0xE3, 0x98, 0x4D, 0xBD, 0xFA, 0xD5, 0xFB, 
+0x49, 0x82, 0xBF, 0xDF, 0x10, 0x88, 0xC4, 0x40, 
+0x41, 0x4A, 0x0E, 0x43, 0x09, 0x9E, 0xE5, 0x80, 
+0x68, 0xC3, 0x9E, 0xDE, 0x02, 0x98, 0xF6, 0x99, 
+0x3C, 0x01, 0x6F, 0x49, 0xCB, 0x8B, 0xAE, 0x8E, 
+0xA3, 0xB2, 0xC8, 0xBC, 0x2E, 0x91, 0x97, 0x45, 
+0x07, 0x3A, 0x1F, 0x6B, 0xCA, 0x4E, 0xE8, 0x64, 
+0xE2, 0x92, 0x83, 0x83, 0xB0, 0xCE, 0x2E, 0xCB, 
+0x27, 0xF1, 0x9A, 0x4A, 0x1D, 0x84, 0x37, 0x29, 
+0xE5, 0x8C, 0xCC, 0x9F, 0xD5, 0x6E, 0x4E, 0xE1, 
+0xDE, 0xC1, 0xFA, 0x73, 0xDA, 0xB8, 0xB4, 0xEC, 
+0xE3, 0x7A, 0xB6, 0x66, 0x75, 0x04, 0xB3, 0xC1, 
+0x95, 0x63, 0x56, 0xD0, 0x07, 0x69, 0xFA, 0x12, 
+0x9C, 0x3D, 0x9F, 0xE8, 0x96, 0x20, 0x90, 0x2F, 
+0x66, 0x0B, 0x16, 0xE4, 0xEB, 0x23, 0xA9, 0x5A, 
+0x14, 0x3A, 0xA9, 0xEE, 0x2A, 0x02, 0x22, 0x49, 
+0xE8, 0x60, 0xF8, 0xB0, 0xEF, 0x4D, 0xEE, 0x00, 
+0xF2, 0xED, 0xF9, 0x43, 0x23, 0x7F, 0x18, 0x1E, 
+0x40, 0xA0, 0x3D, 0xA4, 0xCF, 0xD4, 0x28, 0x3B, 
+0x66, 0x59, 0xF8, 0xDB, 0xB6, 
+
+0x67, 0x2C, 0x2E, 0xFD, 0x7E, 0x62, 0xCE, 0x88, 
+0x8F, 0xFE, 0x0B, 0xAD, 0xAD, 0x1E, 0xF8, 0x51, 
+0x52, 0x0B, 0x21, 0xC0, 0x2E, 0x7A, 0x4F, 0x00, 
+0x01, 0xF8, 0xCC, 0xE2, 0xEF, 0x15, 0x30, 0x89, 
+0xD9, 0xBB, 0xDD, 0x85, 0x2B, 0xE3, 0xE9, 0x03, 
+0x82, 0x6D, 0x28, 0x66, 0xB8, 0xFA, 0xA3, 0xCF, 
+0xA1, 0x26, 0x7D, 0xBA, 0x75, 0x0E, 0x80, 0x0A, 
+0xB8, 0x62, 0xF4, 0x76, 0x00, 0xB0, 0xF6, 0x37, 
+0x7D, 0x03, 0x76, 0xCF, 0x19, 0x59, 0x57, 0x42, 
+0x6B, 0x42, 0x81, 0x28, 0x89, 0x2A, 0x22, 0x5A, 
+0x51, 0xFA, 0x42, 0x7B, 0xCF, 0x2A, 0x6C, 0xFD, 
+0xE8, 0x24, 0x69, 0x86, 0x96, 0xB1, 0x63, 0x22, 
+0x0C, 0xA4, 0xE6, 0xE7, 0x40, 0xCD, 0x0A, 0x7C, 
+0xF8, 0xF2, 0x1E, 0xE1, 0x1C, 0x57, 0x63, 0x0D, 
+0xF1, 0x91, 0x70, 0xFC, 0xCC, 0xAA, 0xC2, 0x99, 
+0x46, 0xFB, 0x92, 0xB7, 0xB3, 0xCB, 0x99, 0x61, 
+0x77, 0x92, 0x96, 0x0D, 0x61, 0x72, 0x27, 0x9C, 
+0x56, 0x6A, 0xB1, 0x0E, 0xD7, 0x91, 0xEE, 0xC3, 
+0xBD, 0x36, 0x7F, 0x44, 0x14, 0xA8, 0xF1, 0x0C, 
+0x3A, 0xA9, 0xCE, 0x4B, 0x49, 0x96, 0x25, 0x51, 
+0xEC, 0xE0, 0x28, 0x73, 0xEE, 0xFD, 
+
+0xB3, 0x0A, 0x69, 0x0A, 0xF3, 0x36, 0x86, 0x50, 
+0x2D, 0x9D, 0x7E, 0x6C, 0x86, 0xCE, 0x5C, 0xB5, 
+0x0D, 0x7E, 0x64, 0x73, 0xA7, 0xAD, 0x97, 0x88, 
+0x5F, 0x0A, 0x0E, 0x36, 0x61, 0xC9, 0x52, 0x4E, 
+0xBF, 0xE1, 0x3B, 0xB6, 0xB1, 0x3F, 0x86, 0x81, 
+0x79, 0xBF, 0xA7, 0x34, 0x5D, 0xA6, 0xCD, 0x85, 
+0x54, 0x6D, 0xF2, 0xF7, 0xC9, 0xCD, 0x8B, 0xFB, 
+0x1D, 0x59, 0x77, 0x2B, 0xD4, 0x67, 0xC2, 0x7B, 
+0xB0, 0x2F, 0xFF, 0x8A, 0x07, 0xE9, 0x69, 0x41, 
+0xE4, 0x40, 0x8D, 0xB8, 0x55, 0xF1, 0x62, 0xF3, 
+0xCC, 0xE7, 0xDB, 0x86, 0x09, 0x28, 0xEB, 0x30, 
+0xE7, 0x8A, 0xC3, 0x53, 0x3F, 0x74, 0xF0, 0xFE, 
+0x15, 0xD0, 0xC6, 0x34, 0x70, 0xEB, 0x9F, 0x40, 
+0x44, 0x2D, 0x49, 0x3F, 0x3E, 0xC6, 0x33, 0x9D, 
+0x70, 0xF2, 0x18, 0x9D, 0x1E, 0x19, 0xDC, 0x84, 
+0xD9, 0x5B, 0xAB, 0xDA, 0xAA, 0xA6, 0xEB, 0x7E, 
+0xAD, 0x82, 0xCB, 0xA2, 0xCF, 0xAA, 0x08, 0x21, 
+0x9B, 0x8D, 0x0C, 0x05, 0x25, 0x2D, 0x50, 0xCC, 
+0xC1, 0xD8, 0x35, 0x4F, 0x25, 0x6E, 0x51, 0x12, 
+0x9F, 0xFF, 0x8D, 0x48, 0xA0, 0x3D, 0x2C, 0xDA, 
+0xBB, 0x91, 0x2A, 0x9E, 0x35, 0xB2, 0x0B, 
+
+0x06, 0x78, 0xDE, 0x62, 0x1F, 0x10, 0x83, 0x59, 
+0x72, 0xC4, 0xBD, 0x41, 0x95, 0x26, 0x04, 0x70, 
+0x7E, 0xB7, 0x8E, 0x23, 0xAB, 0xC6, 0xF4, 0x9D, 
+0xAE, 0xCB, 0xE8, 0x14, 0x06, 0x4C, 0x08, 0x5E, 
+0xC8, 0x59, 0x09, 0x62, 0x82, 0x2C, 0x50, 0x45, 
+0x95, 0x6E, 0xD3, 0xC1, 0x48, 0xE3, 0xBB, 0x6E, 
+0xE7, 0xC5, 0x83, 0x9B, 0xFD, 0x28, 0xC1, 0xC5, 
+0xAF, 0x4D, 0x1B, 0x7A, 0xAF, 0x70, 0x44, 0xE9, 
+0xF6, 0x84, 0xE8, 0x43, 0x54, 0x0C, 0x26, 0x18, 
+0xB7, 0x0A, 0x92, 0x06, 0x84, 0x5F, 0x53, 0x07, 
+0xBA, 0x3D, 0x02, 0x94, 0x11, 0x47, 0xB0, 0xC4, 
+0xDB, 0x70, 0x6A, 0xB6, 0x37, 0x02, 0x6C, 0xB3, 
+0x96, 0x6C, 0xA8, 0x04, 0xCF, 0xEA, 0xA7, 0x59, 
+0x81, 0x7C, 0x5F, 0x7B, 0xC0, 0xFE, 0x29, 0x3D, 
+0x32, 0xE9, 0x2A, 0x5B, 0x00, 0x4D, 0x40, 0x7C, 
+0x79, 0xE8, 0xA3, 0xE6, 0x3D, 0xC8, 0x0D, 0x3C, 
+0xB8, 0x5B, 0xAC, 0x4E, 0x85, 0x3C, 0x51, 0xAF, 
+0x20, 0xD2, 0xA4, 0x15, 0x8B, 0x90, 0xE1, 0xF6, 
+0x18, 0x53, 0x52, 0xA2, 0x8C, 0x95, 0xEF, 0x28, 
+0xE6, 0x39, 0xE9, 0x75, 0xE2, 0x70, 0x31, 0x14, 
+0x60, 0x92, 0x51, 0x50, 0x1D, 0xC6, 0xB9, 0xF9, 
+
+0x97, 0x3B, 0x01, 0x8E, 0x5E, 0xBB, 0xBE, 0x87, 
+0xEB, 0x24, 0xF8, 0x45, 0xB5, 0xA3, 0xD9, 0x0A, 
+0x4F, 0x2B, 0x48, 0xCB, 0x5D, 0xDC, 0x44, 0x0C, 
+0x73, 0x2A, 0x94, 0x1C, 0x6F, 0x48, 0x87, 0x5D, 
+0xE8, 0x9D, 0xB6, 0xCD, 0xB9, 0xD4, 0x48, 0x88, 
+0xCC, 0xA4, 0x11, 0xA9, 0x5D, 0xED, 0x9D, 0xEB, 
+0xDC, 0xB4, 0x34, 0x09, 0x48, 0xAB, 0xC8, 0x82, 
+0xEF, 0x14, 0x77, 0xEE, 0xFE, 0x09, 0x83, 0xDE, 
+0x43, 0xA0, 0x2B, 0xF1, 0x25, 0x35, 0x62, 0x94, 
+0xCE, 0x16, 0x1A, 0x2D, 0xEF, 0x79, 0xA6, 0x61, 
+0x19, 0x33, 0x81, 0x5A, 0x17, 0x4B, 0x6E, 0xC7, 
+0x55, 0xD5, 0xC2, 0xFC, 0x2D, 0xA8, 0xE9, 0xA0, 
+0xF9, 0xB5, 0x4E, 0x13, 0x8F, 0xDF, 0x65, 0x1A, 
+0x2F, 0x26, 0x35, 0xE1, 0x23, 0x2F, 0xA6, 0xF7, 
+0x15, 0x00, 0x65, 0x9B, 0x39, 0x60, 0x92, 0xB9, 
+0xF7, 0xF5, 0x49, 0x6C, 0xD6, 0x68, 0xC1, 0xD2, 
+0x24, 0x2B, 0x07, 0xF0, 0x60, 0x43, 0x38, 0xB6, 
+0xCA, 0xDC, 0xE6, 0x05, 0xAE, 0x5B, 0x00, 0x2C, 
+0x45, 0xC2, 0x68, 0xFD, 0xFD, 0x88, 0x94, 0x1B, 
+0x20, 0x1A, 0x77, 0xA4, 0x20, 0x4D, 0x70, 0xC6, 
+0x57, 0x75, 0xA8, 0xBB, 0x1A, 0xE5, 0x08, 0x6E, 
+0x40, 
+
+0x27, 0x84, 0xD9, 0x17, 0x17, 0x6C, 0xAB, 0x6E, 
+0x01, 0xD8, 0x18, 0x6A, 0x6F, 0x73, 0x22, 0x9C, 
+0xEC, 0x89, 0xA9, 0x30, 0xD4, 0xCB, 0xCE, 0x7E, 
+0x4A, 0x7B, 0x44, 0xE8, 0x70, 0xA4, 0x88, 0x0D, 
+0xE5, 0x0B, 0x8F, 0x53, 0xE6, 0x62, 0xAA, 0x7E, 
+0x3E, 0x48, 0xB0, 0x13, 0x2B, 0x71, 0x2D, 0xDC, 
+0x6D, 0xD4, 0x7A, 0x02, 0x32, 0x9E, 0xF8, 0x09, 
+0xD3, 0x6F, 0x40, 0xF4, 0xF7, 0x53, 0x0A, 0xA9, 
+0x56, 0x1F, 0x42, 0xD1, 0xCA, 0xB4, 0x21, 0xBD, 
+0xA9, 0x99, 0x8F, 0x1F, 0xF2, 0x4D, 0xD3, 0x39, 
+0x9D, 0x91, 0x45, 0x87, 0xBF, 0x11, 0xB1, 0x28, 
+0xC0, 0xE4, 0x5F, 0xB8, 0x9E, 0x99, 0x48, 0x01, 
+0xC2, 0x7A, 0x5B, 0x39, 0xCF, 0x55, 0xFC, 0x08, 
+0x25, 0x8D, 0x12, 0xB4, 0xA1, 0x71, 0xDB, 0x74, 
+0x76, 0x73, 0xAE, 0x0A, 0xB8, 0xF5, 0x44, 0x79, 
+0x84, 0x49, 0x5E, 0x32, 0x85, 0xB4, 0xD1, 0x82, 
+0xB2, 0x96, 0x54, 0x61, 0x91, 0x0A, 0xAD, 0x0E, 
+0x56, 0xFD, 0x0D, 0xF2, 0xDF, 0x59, 0x80, 0xC7, 
+0x03, 0xB9, 0x0A, 0x7B, 0x9E, 0x92, 0x97, 0x91, 
+0x48, 0x88, 0xAD, 0x34, 0xB8, 0x01, 0x69, 0x5C, 
+0x93, 0x53, 0xC9, 0x3B, 0xF3, 0xA8, 0xCA, 0x30, 
+0xF1, 0x0F, 
+
+0x43, 0x2A, 0x9A, 0x21, 0x6F, 0xE2, 0x48, 0xD0, 
+0x11, 0x18, 0xCE, 0x4E, 0x42, 0x90, 0xAC, 0x9E, 
+0xA1, 0x1B, 0x3E, 0xDB, 0x3B, 0x13, 0x67, 0xB5, 
+0x56, 0x8D, 0x1C, 0x61, 0x25, 0x83, 0x43, 0xB9, 
+0x9A, 0x15, 0xB4, 0xD6, 0x0B, 0x09, 0xE6, 0x3D, 
+0x62, 0xEC, 0x46, 0x92, 0x13, 0x19, 0x8C, 0x02, 
+0xAE, 0x78, 0x22, 0xF7, 0x9F, 0xAA, 0xAD, 0xA5, 
+0x2A, 0xE6, 0x17, 0x0E, 0xFA, 0xCF, 0x67, 0x7F, 
+0x91, 0xD1, 0x78, 0x60, 0xF5, 0x59, 0x08, 0x5A, 
+0xE7, 0xA2, 0xD6, 0x37, 0xA2, 0xDD, 0x99, 0xB0, 
+0xCC, 0x76, 0x7F, 0x31, 0x78, 0xB4, 0x40, 0xD6, 
+0xF0, 0x07, 0x0A, 0xEE, 0x91, 0x4C, 0x12, 0x08, 
+0x31, 0x95, 0x09, 0x21, 0x74, 0x7C, 0xFB, 0x7F, 
+0x49, 0xA1, 0x54, 0x3A, 0x6A, 0xC5, 0xFB, 0xE9, 
+0xFB, 0xC5, 0xCE, 0xCE, 0xDE, 0xB7, 0xA6, 0xE8, 
+0x34, 0xEB, 0x58, 0x7E, 0x33, 0x2A, 0x50, 0x5E, 
+0x36, 0x27, 0xBD, 0xDA, 0xDD, 0x75, 0x27, 0xA2, 
+0xB2, 0xB1, 0x83, 0x9B, 0xB3, 0xE9, 0x2D, 0x39, 
+0x4A, 0xED, 0xED, 0x80, 0xCC, 0x0A, 0x5C, 0x70, 
+0x10, 0x0F, 0x99, 0x59, 0x78, 0x5A, 0x6F, 0x55, 
+0x64, 0x38, 0x77, 0x5D, 0xA3, 0x90, 0x0C, 0xF4, 
+0xD3, 0x0B, 0xCD, 
+
+0x39, 0x13, 0x86, 0xA1, 0x44, 0x24, 0xE6, 0x60, 
+0x1E, 0xA1, 0xDB, 0xC7, 0xD9, 0xD4, 0x15, 0x65, 
+0x45, 0x23, 0x26, 0x0D, 0x80, 0x62, 0xD4, 0xA9, 
+0x2E, 0x8C, 0x76, 0xDC, 0xDA, 0xA5, 0xFF, 0x4E, 
+0x6E, 0xE2, 0xA9, 0xEC, 0x3F, 0xAB, 0x3F, 0xDA, 
+0x8D, 0x1F, 0x2D, 0x5A, 0x67, 0xBD, 0xC5, 0xDA, 
+0xD0, 0x06, 0x01, 0x1D, 0x84, 0xDF, 0x41, 0x6B, 
+0xEC, 0x93, 0xB4, 0xD4, 0xFB, 0x73, 0x7D, 0x1A, 
+0xA8, 0x64, 0xE1, 0x33, 0xA7, 0x71, 0x7E, 0x3F, 
+0x8F, 0x95, 0x50, 0x43, 0xFE, 0xB5, 0xF7, 0xD3, 
+0x2E, 0x54, 0x6E, 0xEE, 0x70, 0x80, 0xA3, 0xD9, 
+0xD0, 0x88, 0xB6, 0x66, 0x70, 0xC2, 0x34, 0x97, 
+0xAF, 0x5F, 0x6B, 0x38, 0xF6, 0x11, 0x97, 0x69, 
+0x52, 0xF4, 0x16, 0x5E, 0x50, 0x5E, 0xEF, 0x6E, 
+0xF1, 0xF1, 0x64, 0x6A, 0xE0, 0xCA, 0x9A, 0x68, 
+0xAE, 0xA3, 0xE0, 0x7E, 0xB7, 0x9B, 0xFC, 0xDC, 
+0x3E, 0xA5, 0xB9, 0x4C, 0xE2, 0xFA, 0xF7, 0xB1, 
+0x21, 0x69, 0x0B, 0x17, 0x80, 0xE8, 0x6A, 0x57, 
+0x48, 0xC8, 0x4B, 0x43, 0x36, 0x7C, 0x88, 0x28, 
+0x11, 0x96, 0x9E, 0xE1, 0x9D, 0x87, 0xB6, 0x05, 
+0xE3, 0x24, 0x57, 0x97, 0x3B, 0x57, 0x41, 0x5F, 
+0x72, 0x9E, 0x13, 0x73, 
+
+0x5C, 0xC3, 0x07, 0xE9, 0xAC, 0x9F, 0xC2, 0xDA, 
+0xDE, 0x5D, 0x9D, 0x17, 0x6A, 0x4D, 0xD1, 0x63, 
+0x87, 0xFB, 0x67, 0xE6, 0x64, 0xDF, 0x86, 0x75, 
+0xCD, 0x6A, 0xCB, 0x1B, 0x0A, 0x32, 0xA9, 0x14, 
+0x72, 0x84, 0xDA, 0x0F, 0xFF, 0xFC, 0x4B, 0x99, 
+0xDE, 0x27, 0x94, 0xFA, 0xB1, 0xE8, 0xF9, 0x39, 
+0x7C, 0x8F, 0xF7, 0x6A, 0x1E, 0x5C, 0xD6, 0x6D, 
+0xD4, 0xA9, 0xB5, 0xA8, 0x55, 0xA1, 0x41, 0xBA, 
+0x9A, 0x93, 0x60, 0x00, 0xEE, 0x5E, 0x14, 0xFC, 
+0x60, 0xCE, 0xEA, 0xD3, 0x35, 0xE4, 0x1F, 0x3B, 
+0xB1, 0x9F, 0xA7, 0x30, 0x0C, 0xBC, 0xB5, 0x3B, 
+0x10, 0x36, 0xCB, 0x52, 0xB1, 0xA7, 0x44, 0xF6, 
+0x92, 0xA7, 0xB7, 0xD1, 0x06, 0xFB, 0x91, 0x4D, 
+0x97, 0xA9, 0x51, 0xB5, 0x61, 0xE3, 0x31, 0x7E, 
+0xCE, 0x07, 0x15, 0x63, 0xE7, 0xBF, 0xF1, 0x27, 
+0x42, 0xA3, 0x40, 0xA5, 0xA0, 0xA1, 0x1C, 0x2F, 
+0x5C, 0xA2, 0x76, 0xFE, 0x7A, 0x2E, 0xED, 0x85, 
+0x71, 0xAC, 0x5A, 0x44, 0x71, 0x76, 0x79, 0x2E, 
+0xD7, 0x8D, 0x29, 0x75, 0xC9, 0x27, 0x75, 0x46, 
+0x8E, 0x28, 0x7E, 0x21, 0x2A, 0xC6, 0xED, 0xCA, 
+0x5A, 0x13, 0x73, 0x5B, 0x00, 0x6E, 0xA6, 0x07, 
+0x32, 0x4B, 0xE9, 0xE4, 0x30, 
+
+0x8C, 0xE4, 0x22, 0xF1, 0xF8, 0x13, 0xC1, 0x15, 
+0xF9, 0x81, 0xF9, 0x01, 0x78, 0xF2, 0x84, 0x36, 
+0x71, 0xDF, 0x32, 0xC4, 0xFD, 0x8A, 0x98, 0x81, 
+0x83, 0xA4, 0x5D, 0xBA, 0x3F, 0x08, 0x33, 0x34, 
+0x99, 0x0F, 0xE3, 0xEE, 0x8B, 0x9C, 0xA9, 0xE9, 
+0x89, 0x5C, 0x37, 0xBC, 0xB0, 0x8C, 0x24, 0x72, 
+0xA6, 0xF9, 0x29, 0xAA, 0xCE, 0x5B, 0x17, 0xA7, 
+0x29, 0x99, 0x29, 0x18, 0x82, 0xAD, 0xB3, 0x70, 
+0x13, 0x81, 0x37, 0xA1, 0x5A, 0x86, 0x5F, 0x33, 
+0xE4, 0x55, 0xEB, 0xBA, 0x1A, 0xB5, 0x04, 0x46, 
+0xFF, 0x4F, 0xE2, 0x42, 0x6A, 0xB1, 0xC6, 0xD0, 
+0xFE, 0x23, 0x60, 0x33, 0xFC, 0xC6, 0xEA, 0xDD, 
+0x98, 0xAD, 0xD7, 0xB8, 0xCC, 0x70, 0x08, 0xBA, 
+0x32, 0x9F, 0xF8, 0x35, 0xD0, 0x66, 0x2B, 0x1A, 
+0xE6, 0x6F, 0x2F, 0xEE, 0x99, 0xA7, 0x0A, 0x71, 
+0x82, 0xA1, 0xDE, 0x32, 0xDF, 0x4E, 0x7C, 0xE4, 
+0x5F, 0xD6, 0x5A, 0xE4, 0xB3, 0x93, 0x5C, 0x7A, 
+0xCE, 0x06, 0xFE, 0x0C, 0x85, 0x4E, 0x21, 0x24, 
+0x08, 0x07, 0xD2, 0xEE, 0x65, 0x84, 0x87, 0x91, 
+0xD8, 0xAE, 0xC3, 0xCF, 0x44, 0x13, 0x20, 0xB5, 
+0x76, 0xCC, 0xD6, 0xBE, 0x86, 0xBE, 0xA9, 0x75, 
+0x7E, 0xA3, 0xD4, 0x05, 0x86, 0x99, 
+
+0x3C, 0x2C, 0x78, 0x9E, 0xE3, 0x5F, 0x00, 0xCA, 
+0x79, 0xE5, 0xE8, 0x9E, 0x95, 0xB1, 0x37, 0x33, 
+0x12, 0x00, 0x06, 0xA7, 0x41, 0x78, 0xAA, 0x31, 
+0x34, 0xFE, 0x1C, 0xFC, 0x33, 0x95, 0x87, 0x33, 
+0xB8, 0x44, 0x60, 0x11, 0xF4, 0xD8, 0x40, 0x96, 
+0x4A, 0x1C, 0x83, 0x28, 0x4F, 0x0B, 0x91, 0xBB, 
+0x5A, 0x1C, 0x6C, 0xCF, 0xBB, 0x0B, 0xF9, 0x39, 
+0x1A, 0x16, 0xEC, 0x35, 0x05, 0xFC, 0xFD, 0x34, 
+0xD5, 0x4F, 0x5B, 0x13, 0xF1, 0x4E, 0x11, 0xF7, 
+0x0E, 0xE5, 0xD0, 0x22, 0xB2, 0x7A, 0xC4, 0xCE, 
+0x3D, 0x2F, 0xBB, 0x15, 0x3B, 0x64, 0xA2, 0xA5, 
+0xD7, 0x1B, 0xFF, 0xB6, 0x36, 0xB2, 0x25, 0x1C, 
+0x61, 0x69, 0xD3, 0x01, 0x75, 0x49, 0x00, 0x9A, 
+0xE3, 0x36, 0x17, 0x16, 0xBF, 0x69, 0x42, 0x3A, 
+0x41, 0x18, 0x16, 0x70, 0xD9, 0xD0, 0xAC, 0x8D, 
+0x49, 0x18, 0x5F, 0xE4, 0x20, 0x7F, 0x61, 0x13, 
+0x06, 0x3F, 0x9C, 0x48, 0xD1, 0xCE, 0x6C, 0x97, 
+0x7D, 0x83, 0xE3, 0xF1, 0x3B, 0xF6, 0x83, 0x32, 
+0x14, 0xCF, 0x80, 0xBE, 0x3B, 0xDD, 0x6C, 0x97, 
+0xBC, 0xF8, 0x0D, 0x08, 0xCC, 0x4C, 0x74, 0x1E, 
+0x89, 0x64, 0x80, 0xA7, 0xFF, 0x85, 0x9F, 0x0B, 
+0xA2, 0xC2, 0xBF, 0x60, 0x45, 0xEB, 0x74, 
+
+0xD8, 0x01, 0x35, 0x09, 0x94, 0x53, 0x30, 0x28, 
+0x0C, 0x8B, 0x9E, 0xC7, 0x24, 0xDF, 0x67, 0xB2, 
+0xE8, 0x80, 0x23, 0xA3, 0x17, 0x56, 0x31, 0x80, 
+0x2E, 0x54, 0xB8, 0xB3, 0xA5, 0x5D, 0xB4, 0x71, 
+0x20, 0xAC, 0x07, 0x32, 0x33, 0x1F, 0xC3, 0x97, 
+0xBD, 0x65, 0x6B, 0x33, 0x33, 0x4E, 0x8C, 0xFD, 
+0xFA, 0xB9, 0x0F, 0x8A, 0x3F, 0x51, 0x1E, 0x17, 
+0x6C, 0x7F, 0xE7, 0xB8, 0xDF, 0xF3, 0x5B, 0xB6, 
+0x86, 0x6C, 0x81, 0x8E, 0x4A, 0x67, 0x7E, 0xC1, 
+0x75, 0x35, 0x48, 0xCD, 0x8B, 0x00, 0xDE, 0x67, 
+0x29, 0x5F, 0x05, 0xFB, 0x90, 0xF1, 0x98, 0xEC, 
+0xF7, 0x37, 0x5A, 0xF5, 0x16, 0x49, 0x4B, 0xED, 
+0xA9, 0xF8, 0x17, 0x4A, 0x4A, 0x43, 0x33, 0xA4, 
+0x92, 0xBF, 0x27, 0x70, 0xD4, 0x47, 0x14, 0x34, 
+0x6E, 0xD3, 0x3D, 0x67, 0x78, 0x76, 0x4A, 0x5B, 
+0x55, 0xBD, 0x19, 0x92, 0xFB, 0xEB, 0x4D, 0xD5, 
+0xBC, 0x98, 0xD3, 0xD2, 0x96, 0xC0, 0x02, 0xCE, 
+0xC2, 0x9B, 0x18, 0x9A, 0x1D, 0xC6, 0xCC, 0x6C, 
+0x19, 0x17, 0xB5, 0x90, 0x2A, 0xB1, 0x17, 0x8C, 
+0x50, 0xA9, 0x12, 0x03, 0xC3, 0xF9, 0x9D, 0xB6, 
+0x3A, 0x5C, 0x56, 0xA9, 0x77, 0x70, 0xAC, 0x4D, 
+0x6A, 0x52, 0xB2, 0xED, 0xF3, 0x6C, 0x0C, 0x2B, 
+
+0xEF, 0x03, 0x29, 0xD0, 0x88, 0xAD, 0x9B, 0x23, 
+0xCE, 0xB7, 0x23, 0x66, 0x6E, 0x6E, 0xF2, 0x59, 
+0xA4, 0x77, 0xC1, 0xE5, 0xC8, 0xB3, 0xA4, 0xB7, 
+0x8D, 0x1D, 0x7A, 0x81, 0xF2, 0x06, 0x4E, 0xBF, 
+0x1E, 0x50, 0x2D, 0xCB, 0x2A, 0xF8, 0xB3, 0xDF, 
+0xE5, 0x43, 0xB7, 0x06, 0x3E, 0xB9, 0xCB, 0xBB, 
+0x63, 0xA2, 0xEF, 0x9F, 0xEC, 0x24, 0x20, 0xE6, 
+0x1D, 0x26, 0xE0, 0xB6, 0x0C, 0xAB, 0xF5, 0xE4, 
+0xD2, 0x40, 0x87, 0xD4, 0x35, 0x1A, 0xEA, 0xF2, 
+0xFA, 0x1B, 0x1D, 0xA0, 0x30, 0x5B, 0xBE, 0x4C, 
+0xEF, 0xEC, 0x52, 0x6B, 0xB3, 0xB4, 0xB7, 0x69, 
+0xD7, 0x68, 0x49, 0x1A, 0x52, 0x59, 0xA3, 0x8D, 
+0xA5, 0x4A, 0x3F, 0xC1, 0x68, 0x7E, 0x30, 0x31, 
+0x22, 0x9B, 0xBE, 0x32, 0x55, 0xF9, 0x1F, 0xF6, 
+0xED, 0x41, 0x87, 0x26, 0xDF, 0x69, 0x5D, 0xA0, 
+0xF8, 0xDB, 0x2B, 0xE1, 0x40, 0x35, 0x4B, 0x3F, 
+0x6C, 0x7E, 0x3E, 0xC3, 0xEA, 0x38, 0xFE, 0x03, 
+0xA9, 0x06, 0xBA, 0x88, 0x4E, 0x4D, 0xAB, 0x42, 
+0x6A, 0xFE, 0xA1, 0x33, 0x9C, 0xFA, 0x5A, 0x2D, 
+0x67, 0x5C, 0x6F, 0xB3, 0xB3, 0xCB, 0xDB, 0xD7, 
+0x2C, 0x78, 0x8B, 0x30, 0xBA, 0xB8, 0x64, 0x04, 
+0xF7, 0x73, 0xFC, 0xCA, 0x51, 0x01, 0x31, 0x8E, 
+0x74, 
+
+0xEA, 0xE4, 0xC7, 0xC3, 0x0D, 0x9F, 0xC0, 0x15, 
+0x20, 0xED, 0x43, 0x6A, 0xF8, 0x02, 0x41, 0xA6, 
+0xAB, 0x33, 0xC3, 0x91, 0xAC, 0x3F, 0xE1, 0x17, 
+0x66, 0xD8, 0x56, 0x50, 0x77, 0x62, 0xD9, 0x4B, 
+0x1E, 0x2E, 0xB4, 0x36, 0xE3, 0x48, 0x85, 0x43, 
+0x84, 0x71, 0xE7, 0x41, 0x41, 0x39, 0xCC, 0x0E, 
+0xE0, 0x9D, 0x4C, 0xD4, 0xF6, 0xF1, 0xEA, 0x30, 
+0x16, 0x67, 0x42, 0x55, 0xC5, 0x20, 0x23, 0x1F, 
+0x23, 0xE7, 0x50, 0x10, 0x60, 0x68, 0x55, 0x2E, 
+0x26, 0xE6, 0x84, 0x97, 0x17, 0x1E, 0xA0, 0x2C, 
+0xBC, 0xCD, 0xE4, 0xBD, 0xF8, 0x07, 0x91, 0x31, 
+0x24, 0x16, 0xFB, 0x66, 0x4B, 0x1B, 0x98, 0xBA, 
+0x88, 0xD9, 0x41, 0xDF, 0x36, 0xC6, 0xD4, 0xB4, 
+0x0C, 0x54, 0x36, 0xD5, 0xC9, 0x00, 0x19, 0x77, 
+0x79, 0x46, 0xE9, 0x37, 0xD2, 0x2F, 0x68, 0x93, 
+0xF4, 0x2A, 0x43, 0xC3, 0x4C, 0x28, 0xE3, 0xC9, 
+0xF2, 0xE0, 0x21, 0xDD, 0x93, 0x8F, 0x96, 0x3A, 
+0x35, 0xC5, 0x04, 0x0C, 0x8D, 0xEA, 0xC8, 0xDC, 
+0x1A, 0xC7, 0x91, 0x3C, 0x1C, 0xB3, 0xB3, 0xBA, 
+0x2E, 0xF9, 0xDF, 0xF8, 0x58, 0xDC, 0xC2, 0xF8, 
+0xCC, 0x7D, 0x68, 0x2F, 0xF7, 0xD1, 0x0E, 0x17, 
+0x22, 0x21, 0x3C, 0xDD, 0xCC, 0xFE, 0xBC, 0x8C, 
+0xD2, 0xD6, 
+
+0xB8, 0x6A, 0xBD, 0x0D, 0x93, 0xAB, 0xC0, 0x43, 
+0xCD, 0x98, 0xF0, 0xC1, 0x61, 0xC6, 0x2A, 0xA4, 
+0x64, 0xA6, 0xD7, 0xE9, 0x4E, 0x59, 0xC7, 0x33, 
+0x9A, 0x1C, 0x4C, 0xDD, 0x88, 0x17, 0x65, 0xB7, 
+0xE0, 0x5D, 0xC8, 0xE9, 0x4F, 0x1E, 0x7C, 0x3C, 
+0x16, 0xDE, 0x55, 0xDA, 0x75, 0xA6, 0x9A, 0x6C, 
+0x59, 0x30, 0x1D, 0xC6, 0xB9, 0x33, 0x11, 0xD9, 
+0x86, 0x1F, 0xDF, 0x5B, 0x55, 0x54, 0xA2, 0xB1, 
+0xFF, 0x95, 0xD0, 0x55, 0xFC, 0x6C, 0x46, 0xCA, 
+0xD5, 0xB9, 0x8A, 0xF5, 0xA6, 0x03, 0x01, 0xDB, 
+0x86, 0xAD, 0xB4, 0xD0, 0xA7, 0xCA, 0xD2, 0x4C, 
+0x22, 0x08, 0xAB, 0xF4, 0x12, 0xDA, 0x58, 0x2F, 
+0xCD, 0x48, 0xFD, 0x74, 0xB6, 0xF6, 0xEA, 0x17, 
+0xC7, 0x70, 0xDA, 0xC1, 0x41, 0xCB, 0xA3, 0x29, 
+0x16, 0x78, 0xA7, 0x3D, 0x94, 0x9F, 0x3E, 0x01, 
+0x74, 0x65, 0x62, 0xC4, 0x44, 0x3A, 0x2A, 0xE1, 
+0x99, 0x32, 0x65, 0x01, 0x6E, 0x84, 0xDE, 0x0F, 
+0x84, 0x0F, 0xD7, 0x4A, 0x32, 0xD9, 0x98, 0x64, 
+0x21, 0x9B, 0xDF, 0x17, 0x28, 0x96, 0xA7, 0x4F, 
+0x00, 0x2D, 0x26, 0x72, 0x42, 0x95, 0xCA, 0x36, 
+0x9E, 0x04, 0xCD, 0x3E, 0x7A, 0x48, 0xF7, 0xCC, 
+0xE3, 0x27, 0xFF, 0xBE, 0x3B, 0xC9, 0x95, 0x14, 
+0xE2, 0x3A, 0xD6, 
+
+0x1D, 0xEC, 0xEB, 0x4C, 0x4C, 0x5B, 0x2C, 0xE2, 
+0xEE, 0x51, 0x3C, 0xF4, 0x4B, 0xE9, 0x1B, 0xCB, 
+0x77, 0x0C, 0x67, 0x20, 0xA2, 0xED, 0xD4, 0x1F, 
+0xC8, 0xCC, 0x1A, 0x45, 0x22, 0x39, 0xCC, 0xD2, 
+0x56, 0xBF, 0xE1, 0x43, 0x78, 0x86, 0x55, 0xDD, 
+0x11, 0x25, 0xA2, 0x19, 0x6A, 0x37, 0x92, 0xD7, 
+0x9C, 0x10, 0x75, 0xF9, 0xF3, 0xD6, 0xAD, 0xF4, 
+0xCC, 0x8A, 0xCA, 0xF0, 0x4C, 0xD9, 0xA5, 0xDF, 
+0x32, 0xB5, 0x6B, 0x88, 0xFC, 0xDC, 0x18, 0x95, 
+0x04, 0x7D, 0xD3, 0x3D, 0x9A, 0xD2, 0xEA, 0xF5, 
+0xC7, 0x85, 0x17, 0x47, 0x89, 0x53, 0x7B, 0x11, 
+0x7F, 0x8C, 0x11, 0x92, 0x1B, 0x86, 0x4F, 0x6A, 
+0x18, 0x67, 0x0C, 0x85, 0x20, 0xE6, 0x30, 0x73, 
+0x19, 0x8C, 0x9A, 0x24, 0xA3, 0xB5, 0xA9, 0x1E, 
+0x4B, 0xEB, 0x9B, 0x3F, 0x49, 0x16, 0xB5, 0xA7, 
+0xE1, 0x0D, 0x09, 0x46, 0x5D, 0x66, 0xA3, 0x9A, 
+0x23, 0xD9, 0xD0, 0x90, 0x81, 0x90, 0xC2, 0x55, 
+0x07, 0xCF, 0x14, 0x6A, 0x14, 0xAC, 0x56, 0x6D, 
+0xED, 0x64, 0xD3, 0x01, 0x0E, 0xD5, 0xB6, 0x5B, 
+0x1E, 0xD4, 0x46, 0x02, 0xB6, 0x84, 0x2D, 0xC4, 
+0xF4, 0x50, 0xF4, 0xFB, 0x48, 0xF2, 0x36, 0x9C, 
+0xE9, 0x1A, 0xCF, 0x67, 0xA4, 0xAD, 0x75, 0xFB, 
+0xCA, 0xB3, 0x17, 0xC5, 
+
+0x2D, 0xB6, 0x22, 0xE9, 0xA6, 0x55, 0x5A, 0x76, 
+0xD7, 0x52, 0x76, 0x94, 0x47, 0xDE, 0x5B, 0xC6, 
+0xDE, 0x16, 0xB8, 0x13, 0x98, 0xB9, 0x0F, 0x66, 
+0x9E, 0x7E, 0x8B, 0xD8, 0xC5, 0xE1, 0x98, 0xEB, 
+0x8C, 0x9F, 0xD6, 0xD5, 0xDC, 0xAC, 0x66, 0x11, 
+0x51, 0x88, 0x10, 0x1F, 0x41, 0x4D, 0x68, 0x36, 
+0xA7, 0x10, 0x8A, 0xCA, 0x59, 0x5F, 0xFF, 0x4C, 
+0xF6, 0x4A, 0xC4, 0x9A, 0x67, 0xEF, 0x55, 0xF6, 
+0x2C, 0x42, 0xA5, 0xAC, 0xA3, 0xD9, 0xF0, 0xD4, 
+0xD0, 0x7C, 0x82, 0xFD, 0x56, 0x38, 0xED, 0x21, 
+0x2D, 0x63, 0xC2, 0x9F, 0x6E, 0xB5, 0x46, 0xCE, 
+0x5C, 0x74, 0x69, 0x30, 0x24, 0x3F, 0xD1, 0xF8, 
+0x5F, 0x66, 0x01, 0x2D, 0xEA, 0x8C, 0xD3, 0xF7, 
+0x1D, 0x25, 0x03, 0xAE, 0xD6, 0x8A, 0xF0, 0x07, 
+0x55, 0x4E, 0x45, 0x0B, 0x74, 0xB8, 0x2A, 0xA3, 
+0x7F, 0xE0, 0x54, 0x4A, 0x07, 0xA2, 0x30, 0x6F, 
+0xAE, 0x58, 0xC7, 0x77, 0xAB, 0x09, 0x3A, 0x1B, 
+0x5F, 0xD6, 0xAC, 0xE5, 0xC6, 0x0F, 0x5E, 0xC3, 
+0xBA, 0xFB, 0xCC, 0x57, 0x16, 0x1F, 0x64, 0x82, 
+0xD5, 0x64, 0xAD, 0x39, 0x2E, 0x29, 0x16, 0xA8, 
+0x00, 0xD5, 0x35, 0xB2, 0x45, 0xEE, 0x1A, 0x44, 
+0xBA, 0x7C, 0x1A, 0x07, 0x54, 0x6A, 0x07, 0xF0, 
+0x0E, 0x99, 0xFE, 0xB4, 0x07, 
+
+0x1E, 0xB7, 0xC1, 0xE2, 0x96, 0x64, 0xE4, 0x10, 
+0xB1, 0xD9, 0x0A, 0x29, 0x36, 0x52, 0xDC, 0xB7, 
+0x31, 0x25, 0xF8, 0x11, 0x9D, 0x01, 0x6B, 0xB7, 
+0x2E, 0x1C, 0x86, 0x1D, 0xA5, 0x7D, 0xBC, 0xE2, 
+0x01, 0xA1, 0xF3, 0x0D, 0xB5, 0xE5, 0x86, 0x16, 
+0xAB, 0x6F, 0x86, 0x27, 0x97, 0x38, 0xED, 0xAB, 
+0x7D, 0x77, 0xAE, 0xCB, 0x5C, 0xEE, 0xA0, 0xFC, 
+0x47, 0xB3, 0x55, 0x84, 0x5F, 0x56, 0x48, 0x65, 
+0xB8, 0xEA, 0x03, 0x58, 0x52, 0x5A, 0xC4, 0x11, 
+0x05, 0x5D, 0x6C, 0xA6, 0x46, 0xEF, 0xEE, 0x8F, 
+0x40, 0xBD, 0x17, 0xA6, 0x1F, 0x8F, 0x7F, 0xC5, 
+0xB6, 0xD1, 0x83, 0x37, 0x6E, 0x58, 0xBE, 0xC2, 
+0xC8, 0x3A, 0x28, 0xFA, 0x8F, 0x09, 0xE0, 0x75, 
+0x70, 0x51, 0x51, 0x6B, 0x2C, 0x75, 0xF1, 0xC1, 
+0xEE, 0xDF, 0x90, 0xB8, 0x69, 0xB4, 0x1A, 0x22, 
+0xC0, 0xB5, 0xAD, 0xAE, 0x93, 0x6D, 0x69, 0xC2, 
+0xD4, 0xA4, 0x78, 0xBF, 0x89, 0xE8, 0xC9, 0x38, 
+0x21, 0xFE, 0xD3, 0x82, 0x8C, 0xFF, 0x16, 0xCD, 
+0x08, 0xF0, 0x8D, 0xCC, 0xD2, 0x73, 0xC1, 0xA4, 
+0x3D, 0xE7, 0x82, 0x0D, 0x60, 0xFD, 0x63, 0xC4, 
+0x9F, 0x02, 0x68, 0x37, 0xFA, 0xDE, 0x4D, 0xFF, 
+0x11, 0x5F, 0xBB, 0x9D, 0xAA, 0xFC, 0x7C, 0xA8, 
+0x84, 0x45, 0x81, 0x57, 0xDD, 0xDC, 
+
+0x81, 0xEC, 0x99, 0xFE, 0x5F, 0xFA, 0x0E, 0x0F, 
+0x81, 0x3D, 0xD3, 0x7A, 0x13, 0x68, 0x2B, 0xAC, 
+0x77, 0xB3, 0xB6, 0xB4, 0x40, 0x4A, 0xEA, 0xAC, 
+0x37, 0x01, 0xBA, 0xAE, 0x80, 0xDF, 0x99, 0x1E, 
+0xEE, 0x70, 0x67, 0x43, 0xF2, 0x9D, 0x1E, 0x4A, 
+0xA9, 0x22, 0x82, 0x52, 0x45, 0x8C, 0xBB, 0xCD, 
+0x77, 0x5C, 0xF7, 0x51, 0x93, 0x70, 0xBF, 0x23, 
+0xBA, 0xA8, 0x89, 0x8F, 0x73, 0x9B, 0xD8, 0x0A, 
+0xAB, 0x5C, 0xC7, 0x56, 0x79, 0xC2, 0x0A, 0xAC, 
+0xDE, 0x7B, 0xF5, 0xFC, 0xAB, 0x2E, 0x83, 0x61, 
+0xC1, 0x65, 0xD6, 0x68, 0x27, 0x8B, 0xD7, 0x4D, 
+0x91, 0x65, 0x70, 0xCE, 0x09, 0x1A, 0x33, 0xEC, 
+0x26, 0x63, 0x21, 0x8D, 0x55, 0x7D, 0x70, 0xF0, 
+0xDF, 0x28, 0x1A, 0x68, 0x01, 0x52, 0xC8, 0xDB, 
+0x43, 0x89, 0xBF, 0xE3, 0x5D, 0x77, 0x18, 0x88, 
+0xA3, 0x8F, 0x33, 0x63, 0x2C, 0xC4, 0xDE, 0x32, 
+0x74, 0x16, 0xBD, 0xE9, 0xAC, 0xC2, 0x6F, 0x0B, 
+0x03, 0x82, 0x87, 0xCA, 0x4A, 0x04, 0xDC, 0x9B, 
+0x2B, 0x51, 0x26, 0x18, 0xBC, 0x1C, 0xC4, 0xD0, 
+0x8F, 0x0C, 0xA9, 0x01, 0x4A, 0x99, 0x4A, 0x3C, 
+0x2C, 0x22, 0xC6, 0x6B, 0xB6, 0x45, 0x6C, 0x14, 
+0x30, 0x1C, 0x45, 0x4E, 0x40, 0x1D, 0xEB, 0xE5, 
+0xB3, 0x6D, 0x53, 0x0B, 0x70, 0x9F, 0x73, 
+
+0x3A, 0x8B, 0x10, 0x8A, 0x7E, 0xDF, 0x80, 0xB9, 
+0x25, 0x33, 0x62, 0x6C, 0xB3, 0xE4, 0xB1, 0x08, 
+0x9C, 0x47, 0x81, 0xE7, 0xC3, 0xC1, 0xBE, 0x50, 
+0x33, 0xF0, 0x5D, 0x98, 0xE5, 0xD1, 0x1F, 0xFB, 
+0x34, 0xFC, 0xA2, 0x33, 0x5E, 0x6E, 0xF0, 0x05, 
+0xD9, 0x64, 0x3E, 0x74, 0x73, 0xF6, 0x62, 0xFB, 
+0x2F, 0xB5, 0x45, 0xCC, 0x04, 0x12, 0x04, 0x25, 
+0x16, 0xCB, 0x65, 0x7E, 0x5C, 0x21, 0x8F, 0x79, 
+0xE6, 0x0A, 0x2F, 0x49, 0x57, 0x90, 0x35, 0x82, 
+0x44, 0x6E, 0x7B, 0xF3, 0x39, 0x89, 0x1D, 0x66, 
+0x30, 0x00, 0x11, 0x30, 0x4F, 0x6D, 0x68, 0x2E, 
+0xC8, 0xF1, 0x05, 0x38, 0x59, 0x15, 0x6E, 0xD3, 
+0xA3, 0xFE, 0x81, 0x2F, 0xE4, 0xF6, 0xC3, 0x03, 
+0x10, 0x12, 0x12, 0x6F, 0x6F, 0x21, 0x0B, 0xDD, 
+0x94, 0xDB, 0x30, 0xFC, 0x45, 0x86, 0xCF, 0xD8, 
+0x98, 0xFA, 0xE8, 0xAD, 0x47, 0xEF, 0xB4, 0xBA, 
+0xA7, 0xA2, 0x30, 0x92, 0x67, 0xE9, 0x6F, 0x86, 
+0x23, 0xC6, 0x17, 0xC3, 0x2D, 0x13, 0x23, 0x42, 
+0xA5, 0xF4, 0xFB, 0x28, 0xCD, 0x80, 0x86, 0x2A, 
+0x70, 0x24, 0x74, 0x09, 0xE1, 0x9E, 0x9B, 0xA1, 
+0xE2, 0xF5, 0x4E, 0xF2, 0xC5, 0x0D, 0x5A, 0x48, 
+0xB5, 0xCA, 0xB4, 0xA6, 0x3D, 0xBD, 0x97, 0xC6, 
+0x18, 0xA0, 0x0D, 0xED, 0x2A, 0x51, 0x16, 0xF1, 
+
+0xF3, 0x94, 0x6D, 0xA5, 0x2F, 0xB0, 0x9E, 0xDD, 
+0x48, 0x2B, 0xF7, 0x0E, 0xD5, 0xB8, 0x81, 0x80, 
+0x76, 0x08, 0x24, 0x84, 0x0E, 0x42, 0xE8, 0x92, 
+0xAB, 0xBC, 0x79, 0x83, 0x29, 0x49, 0x92, 0x8A, 
+0x80, 0x20, 0x0C, 0x6F, 0xC6, 0x04, 0x4B, 0xAA, 
+0xCC, 0x9C, 0xCA, 0x0A, 0x9B, 0xE5, 0x97, 0xDB, 
+0x4C, 0x0F, 0x58, 0x91, 0xFA, 0x58, 0x93, 0x21, 
+0xE4, 0x1F, 0x02, 0x0A, 0x88, 0x47, 0xF0, 0xFE, 
+0x98, 0xF7, 0xB5, 0x88, 0xB7, 0x12, 0xDB, 0x1D, 
+0x6B, 0x2F, 0x3E, 0xB1, 0x28, 0xC3, 0xED, 0x84, 
+0x79, 0x51, 0xA1, 0x50, 0x56, 0x39, 0x54, 0x97, 
+0x6E, 0x37, 0x1E, 0xD3, 0x44, 0x1A, 0x71, 0xBA, 
+0x46, 0x81, 0xAC, 0xD9, 0x45, 0x08, 0x0E, 0xCE, 
+0x38, 0x4F, 0xC2, 0xCB, 0xE9, 0x94, 0x2A, 0x23, 
+0x16, 0x0D, 0x0B, 0x17, 0xFE, 0x5E, 0x97, 0x7E, 
+0x3C, 0x06, 0x0A, 0x2F, 0x80, 0xE6, 0x04, 0x4C, 
+0x2A, 0x16, 0xA7, 0xF0, 0xC5, 0x12, 0x02, 0x3B, 
+0xD1, 0x1C, 0x13, 0x78, 0x55, 0x7E, 0x88, 0x40, 
+0x28, 0x1B, 0x79, 0xEF, 0xBA, 0x1B, 0x8F, 0xC4, 
+0x63, 0x21, 0x66, 0x05, 0xE8, 0xA8, 0x5B, 0x84, 
+0x0E, 0x2A, 0x18, 0x5C, 0x79, 0xB1, 0xA0, 0xE5, 
+0x2E, 0xE4, 0xF7, 0x93, 0x27, 0x11, 0x8F, 0x7F, 
+0x7E, 0x4F, 0x21, 0xAE, 0xBD, 0xB7, 0x69, 0x9D, 
+0x33, 
+
+0xFB, 0x92, 0x25, 0x86, 0xC3, 0xCF, 0x82, 0xDB, 
+0x68, 0xF4, 0xB9, 0xF8, 0x2A, 0x9E, 0xB6, 0x03, 
+0x56, 0xD4, 0x5B, 0xF3, 0x3D, 0xAB, 0xB5, 0x95, 
+0x27, 0xE3, 0x9B, 0x87, 0xF3, 0xBB, 0x97, 0x29, 
+0x9D, 0x39, 0xD4, 0x02, 0x49, 0x3D, 0xA1, 0x69, 
+0xF5, 0x7A, 0xCB, 0xBB, 0x87, 0xD6, 0xF7, 0xC6, 
+0x4F, 0x75, 0x66, 0x85, 0x48, 0xDA, 0xDD, 0x6A, 
+0xB0, 0xB5, 0x43, 0x1B, 0xEF, 0xC9, 0x42, 0x62, 
+0x40, 0x24, 0xED, 0x02, 0xD2, 0xF0, 0x87, 0x04, 
+0xFF, 0xF8, 0x7F, 0xD2, 0xE8, 0x5C, 0xAB, 0x03, 
+0x68, 0xBD, 0xBE, 0x12, 0x4A, 0xBA, 0x51, 0x45, 
+0xB8, 0x01, 0x2A, 0x7A, 0xB1, 0x3B, 0xF4, 0x25, 
+0x29, 0x09, 0x8D, 0xDB, 0x48, 0x72, 0x27, 0x09, 
+0x81, 0x9B, 0x90, 0xAC, 0x14, 0x7B, 0xAD, 0xBB, 
+0x0F, 0x21, 0x6B, 0x12, 0x0C, 0x05, 0xE5, 0x6F, 
+0xEE, 0xF4, 0x08, 0xBC, 0x9D, 0xB7, 0x29, 0xCD, 
+0x48, 0x51, 0x74, 0x0B, 0x8B, 0x92, 0xA2, 0x52, 
+0x5D, 0x0E, 0x4E, 0x80, 0x02, 0x2E, 0xFC, 0x4B, 
+0x95, 0xC4, 0xA9, 0x04, 0xED, 0xC2, 0xB8, 0xA7, 
+0x04, 0x15, 0x9D, 0x84, 0x73, 0x07, 0x5E, 0xA0, 
+0x65, 0x94, 0xC5, 0x01, 0x9E, 0x33, 0x12, 0x90, 
+0x2E, 0x1D, 0x01, 0x5F, 0x24, 0x90, 0x71, 0x7A, 
+0xF6, 0x57, 0xA9, 0xDD, 0xFF, 0x4D, 0x29, 0x81, 
+0xAD, 0xA7, 
+
+0x51, 0x70, 0xAA, 0x0A, 0xD3, 0x38, 0x3F, 0x99, 
+0xC0, 0x7F, 0x15, 0x44, 0x0C, 0xD3, 0x8C, 0xEF, 
+0x2D, 0x64, 0x67, 0x53, 0xAB, 0x42, 0x63, 0x25, 
+0xE9, 0xE4, 0x6E, 0x00, 0xE8, 0x0D, 0x94, 0x3C, 
+0x01, 0x0E, 0x77, 0x9E, 0x7A, 0x53, 0xB8, 0x4A, 
+0xEF, 0xF2, 0xB4, 0x36, 0xAD, 0x8E, 0xFB, 0x73, 
+0x32, 0x91, 0x7F, 0xBA, 0x07, 0x62, 0x28, 0x81, 
+0x76, 0xF9, 0x2E, 0xE1, 0x91, 0xC0, 0xD1, 0x09, 
+0xF4, 0x70, 0x84, 0xB9, 0x85, 0x2D, 0xE5, 0x24, 
+0xAD, 0xAE, 0x28, 0xA9, 0x6F, 0xD5, 0x60, 0xE6, 
+0x6F, 0x99, 0x4C, 0xE7, 0x9C, 0xE0, 0x2C, 0x46, 
+0x8E, 0x6E, 0x0F, 0x96, 0x76, 0x92, 0xF2, 0xBE, 
+0x32, 0x31, 0x03, 0x56, 0x82, 0x65, 0x7D, 0x58, 
+0x9D, 0x9E, 0xD8, 0xE0, 0x16, 0x41, 0x7F, 0x8E, 
+0x18, 0x19, 0x54, 0x40, 0x33, 0x3F, 0x80, 0xDD, 
+0x19, 0x59, 0xA3, 0x85, 0xB6, 0x8A, 0x1C, 0xB8, 
+0x4A, 0x7D, 0xB0, 0x7F, 0x77, 0x61, 0x41, 0xA3, 
+0x60, 0x9C, 0xA1, 0x3D, 0x92, 0x01, 0x3A, 0x92, 
+0x32, 0x58, 0x0F, 0xC2, 0x7C, 0x1E, 0xCC, 0xDD, 
+0xF0, 0xCB, 0x1D, 0xC5, 0x56, 0x7C, 0x38, 0x5D, 
+0xDF, 0x7C, 0x0D, 0x4E, 0x34, 0xB7, 0x4D, 0x26, 
+0xF5, 0x06, 0x36, 0xE9, 0x88, 0x69, 0xDB, 0x98, 
+0xEF, 0x40, 0x16, 0x78, 0xF7, 0x31, 0xEB, 0x7B, 
+0x00, 0x2D, 0x1E, 
+
+0x64, 0x6D, 0xA2, 0x8D, 0xD3, 0xF2, 0x63, 0x99, 
+0x5A, 0x98, 0x9F, 0x46, 0x49, 0x55, 0x44, 0xE8, 
+0x0A, 0x2A, 0x10, 0xE5, 0x02, 0xBF, 0x42, 0x64, 
+0x1E, 0xD1, 0x68, 0x3D, 0x91, 0xE6, 0x79, 0x58, 
+0x53, 0x9E, 0xDF, 0x45, 0xC5, 0xF5, 0x6C, 0xAD, 
+0x74, 0x22, 0x79, 0xAF, 0xAF, 0x1F, 0x69, 0x01, 
+0xF7, 0x97, 0x70, 0x49, 0x02, 0xDA, 0x7C, 0xF1, 
+0xF2, 0x67, 0x09, 0x3E, 0x9A, 0x0B, 0x8F, 0x2C, 
+0xB2, 0xE7, 0xF1, 0x78, 0x9D, 0x89, 0x21, 0x8D, 
+0xB5, 0xC9, 0xB6, 0x7C, 0xA2, 0x72, 0x0D, 0xC7, 
+0x81, 0xE6, 0x88, 0x5E, 0xCC, 0x8F, 0x73, 0xA7, 
+0x32, 0xC5, 0x55, 0xB0, 0xCF, 0xBE, 0x15, 0x46, 
+0xE5, 0x41, 0xF1, 0x9A, 0x4F, 0x9F, 0x3D, 0xC1, 
+0xFD, 0xC1, 0x64, 0x10, 0xDA, 0x71, 0x7F, 0x21, 
+0x82, 0x25, 0x29, 0x23, 0x1D, 0xC8, 0x5A, 0xF1, 
+0xAB, 0xB4, 0x6B, 0x28, 0x09, 0x64, 0xD0, 0x48, 
+0x07, 0x17, 0xBF, 0xC3, 0x91, 0xD9, 0x6A, 0xA3, 
+0x5D, 0x0E, 0xA9, 0x25, 0xA7, 0x64, 0x2E, 0xEC, 
+0x1E, 0x99, 0x7D, 0xF7, 0xCB, 0xD4, 0x0C, 0x8B, 
+0x5F, 0x0B, 0x3A, 0x4B, 0xA9, 0x4E, 0x5C, 0xBC, 
+0xE1, 0xD5, 0xA4, 0x5F, 0xF3, 0x81, 0x99, 0xAB, 
+0x7A, 0x16, 0x23, 0x67, 0x68, 0x55, 0x97, 0x65, 
+0x30, 0xBE, 0x9C, 0x52, 0x0E, 0xA2, 0xF2, 0xB3, 
+0x47, 0x3F, 0x55, 0xE8, 
+
+0xA0, 0x95, 0xD9, 0x07, 0xE1, 0x69, 0x19, 0x7C, 
+0x9A, 0xA2, 0x81, 0x0A, 0x8F, 0x24, 0x58, 0xF0, 
+0xC6, 0x45, 0x99, 0xCA, 0xFF, 0x55, 0x65, 0x36, 
+0xFA, 0xCD, 0x8E, 0x57, 0xED, 0x36, 0xAA, 0xCE, 
+0xCF, 0xCD, 0x4D, 0xF6, 0xA8, 0x60, 0xC8, 0xD0, 
+0x87, 0xAC, 0x0D, 0x5C, 0x67, 0x84, 0xD6, 0x5F, 
+0x2F, 0xB3, 0xD2, 0x0B, 0x5C, 0x2A, 0x3C, 0xFE, 
+0x1E, 0x3E, 0xE6, 0x9D, 0xE1, 0xD1, 0xBC, 0xE2, 
+0xA0, 0x4C, 0x02, 0x8F, 0x51, 0x66, 0xBC, 0xF4, 
+0x5B, 0x60, 0xB8, 0x12, 0xF9, 0x73, 0x27, 0x3B, 
+0x37, 0xCC, 0x5D, 0x5B, 0xCA, 0xD3, 0x57, 0xF9, 
+0x42, 0x5D, 0x87, 0xD3, 0xA2, 0x36, 0x3A, 0xA8, 
+0x62, 0x46, 0x69, 0xC0, 0x3E, 0xE5, 0xF8, 0xB3, 
+0x3B, 0x93, 0x4F, 0x47, 0xBE, 0x0E, 0x0B, 0x6A, 
+0xF5, 0x39, 0x72, 0xB1, 0xD3, 0xBC, 0x64, 0x75, 
+0x63, 0x2E, 0xBA, 0xA0, 0xA0, 0x61, 0xE8, 0x05, 
+0x90, 0x66, 0x22, 0xA4, 0x0D, 0xA8, 0x0D, 0xB6, 
+0x85, 0x93, 0x91, 0xD7, 0x59, 0xFF, 0x48, 0x13, 
+0xC8, 0xEF, 0xBA, 0xB9, 0xFC, 0x88, 0xBF, 0xD9, 
+0xAB, 0x7F, 0x33, 0x55, 0x39, 0x81, 0x2E, 0x67, 
+0x52, 0x3E, 0xE8, 0xF7, 0xA7, 0xA0, 0x3E, 0x2C, 
+0xC6, 0x8B, 0x2F, 0x24, 0xA5, 0xE8, 0x0A, 0x3C, 
+0x79, 0xC2, 0x88, 0xF6, 0x26, 0x45, 0x89, 0x1C, 
+0x1C, 0x02, 0x11, 0xD9, 0xB0, 
+
+0xC1, 0xF5, 0x52, 0xFC, 0xC2, 0x56, 0x21, 0x51, 
+0x01, 0xB7, 0xEE, 0x01, 0x53, 0x18, 0xD0, 0xA0, 
+0x8F, 0x79, 0xA9, 0x9B, 0x95, 0x3B, 0xD4, 0xB7, 
+0xA7, 0x5B, 0x27, 0x61, 0x9E, 0xC9, 0x81, 0xEB, 
+0x69, 0x3F, 0xCE, 0xA8, 0x3E, 0xD8, 0x1C, 0x4E, 
+0xF6, 0xC4, 0xB4, 0x88, 0x0A, 0x93, 0x27, 0x59, 
+0x05, 0xD6, 0x3A, 0x6E, 0x79, 0xA5, 0x22, 0x03, 
+0x09, 0xB5, 0x77, 0xCF, 0xA8, 0x2F, 0x61, 0x77, 
+0x7A, 0x7F, 0xE8, 0xB8, 0x90, 0x48, 0x79, 0xB6, 
+0x37, 0x84, 0x18, 0x64, 0x81, 0x91, 0x7F, 0x3D, 
+0x7A, 0x2C, 0x56, 0xD9, 0x16, 0x13, 0x65, 0xBA, 
+0x0E, 0x87, 0xA1, 0x1F, 0x14, 0x4F, 0x2A, 0x47, 
+0xA7, 0x0C, 0xB4, 0xDC, 0x0D, 0xDD, 0x24, 0xD0, 
+0xBF, 0x27, 0x7A, 0xA5, 0x76, 0xD5, 0x69, 0xAB, 
+0xCC, 0xCA, 0x5C, 0x36, 0xCE, 0xD0, 0xCB, 0x89, 
+0x89, 0x8F, 0xAF, 0x49, 0xA3, 0x3C, 0x78, 0x5F, 
+0x76, 0x8A, 0xF4, 0x7E, 0xFE, 0xC1, 0x38, 0x14, 
+0x2F, 0xA7, 0xF3, 0xBF, 0x03, 0xCB, 0xD7, 0x7B, 
+0x30, 0xEB, 0xF1, 0x42, 0x2D, 0x2D, 0xFE, 0xEE, 
+0x2F, 0xCE, 0x9C, 0xAB, 0x5A, 0xE5, 0xF0, 0x1A, 
+0x37, 0x2F, 0x22, 0xDE, 0x20, 0x06, 0xB0, 0xB1, 
+0x26, 0x88, 0x0F, 0xB3, 0xA1, 0x2F, 0x1B, 0x0C, 
+0xCB, 0xA7, 0x8A, 0xD2, 0x29, 0x1A, 0xED, 0x44, 
+0x33, 0x09, 0xDD, 0x68, 0xDE, 0x2A, 
+
+0xC9, 0x33, 0xF0, 0x06, 0x16, 0x27, 0xFA, 0x37, 
+0xC3, 0x55, 0x45, 0xC4, 0xB7, 0x71, 0x92, 0x0B, 
+0x20, 0x1D, 0x27, 0x3C, 0x82, 0xA3, 0x63, 0xE9, 
+0x33, 0x70, 0x4C, 0x04, 0xF5, 0xD0, 0xFC, 0x43, 
+0xB2, 0xC6, 0xA5, 0xEF, 0xE0, 0x52, 0x8C, 0x0F, 
+0x5F, 0x0D, 0xDD, 0x5A, 0xF4, 0xBF, 0x2D, 0xB3, 
+0x00, 0x91, 0x94, 0x88, 0xB2, 0xDC, 0x48, 0x1E, 
+0xB4, 0x4C, 0xF4, 0x9B, 0x64, 0x98, 0x73, 0xDE, 
+0xBF, 0x94, 0x8E, 0x5D, 0xA2, 0x87, 0xB6, 0x34, 
+0x04, 0x0C, 0x3C, 0x11, 0xA8, 0x85, 0x43, 0x11, 
+0x18, 0x9A, 0x00, 0xD8, 0xE4, 0xD9, 0x89, 0x59, 
+0xB3, 0x35, 0xDA, 0x52, 0xD1, 0x85, 0x23, 0x9B, 
+0xD3, 0x57, 0x5A, 0x8C, 0xF3, 0x89, 0xDB, 0xAC, 
+0x85, 0xDE, 0xB3, 0xA5, 0xB1, 0xAE, 0xD8, 0xA5, 
+0x73, 0x00, 0x8A, 0x2F, 0xF2, 0xF7, 0xEE, 0x85, 
+0x6D, 0xFD, 0xFF, 0x15, 0x24, 0x49, 0x32, 0xF3, 
+0xD1, 0xF8, 0x8C, 0x00, 0x1D, 0xC1, 0x21, 0xBB, 
+0x4D, 0x63, 0x34, 0x8F, 0x0B, 0x46, 0x69, 0xEB, 
+0xBB, 0x77, 0xED, 0x3F, 0x30, 0xC2, 0x0A, 0x06, 
+0xA3, 0x2B, 0x75, 0xA8, 0xAE, 0xE9, 0x54, 0xA8, 
+0x11, 0x17, 0xA4, 0x40, 0xA4, 0xF1, 0x1C, 0x13, 
+0xD6, 0xB7, 0x8F, 0x26, 0xF4, 0xCB, 0x21, 0x7B, 
+0x43, 0x54, 0xBD, 0xC2, 0x8D, 0x3E, 0x8A, 0x8E, 
+0x74, 0x88, 0x3B, 0xF1, 0xAB, 0x0B, 0xCB, 
+
+0x47, 0xA2, 0x45, 0xBD, 0xAB, 0x40, 0x0E, 0xFA, 
+0xEE, 0xED, 0xD7, 0xE1, 0xF9, 0xE4, 0x6A, 0x6F, 
+0xA5, 0x11, 0xB4, 0x1D, 0x91, 0x49, 0x3A, 0x6F, 
+0xFA, 0x2B, 0x62, 0xC7, 0x20, 0x6F, 0xA9, 0x2E, 
+0xDD, 0xE3, 0x44, 0xA3, 0x4A, 0x80, 0x6A, 0xA3, 
+0x7F, 0xB1, 0xCF, 0x29, 0x7B, 0x7D, 0xEB, 0x3C, 
+0x66, 0xA7, 0xF9, 0x23, 0xCF, 0xCD, 0x0D, 0x81, 
+0x8B, 0x99, 0x61, 0xE1, 0xD7, 0x44, 0x23, 0xA5, 
+0x67, 0x5B, 0x18, 0x8D, 0x1E, 0x54, 0x17, 0x4C, 
+0x6D, 0xCA, 0x89, 0x1B, 0x4B, 0x2D, 0xEE, 0xF9, 
+0x3F, 0x88, 0x92, 0xA1, 0x52, 0xD2, 0xC6, 0xDC, 
+0x7D, 0x6E, 0x35, 0x81, 0xD5, 0xB8, 0xA7, 0xFF, 
+0xD1, 0xC9, 0x17, 0xFA, 0xD5, 0xCE, 0x67, 0x0A, 
+0x74, 0xED, 0x72, 0xAD, 0x9A, 0xE6, 0x94, 0x11, 
+0x63, 0xF3, 0xBE, 0xAA, 0x21, 0x7A, 0x18, 0x07, 
+0xB6, 0x50, 0x1E, 0x28, 0x91, 0x4E, 0x4D, 0x5F, 
+0xD4, 0xC6, 0x8E, 0xE5, 0x3F, 0xC6, 0x2C, 0x57, 
+0xC6, 0xC9, 0xC1, 0x20, 0x6E, 0x02, 0x0C, 0xB5, 
+0x55, 0x59, 0x3E, 0x72, 0x23, 0x70, 0xC8, 0x15, 
+0x6D, 0x09, 0xA0, 0xF6, 0x03, 0xC3, 0x15, 0x96, 
+0x0A, 0x2E, 0xA0, 0xC0, 0x31, 0x1F, 0x33, 0x68, 
+0x0C, 0x5B, 0xC6, 0x6E, 0xBF, 0xFD, 0x02, 0x78, 
+0xA6, 0x7B, 0x3A, 0x7C, 0x51, 0xD0, 0xA6, 0x82, 
+0xB6, 0xE5, 0x07, 0xB9, 0xC2, 0x92, 0xD1, 0xBB, 
+
+0x84, 0xE8, 0x55, 0x16, 0xDF, 0x40, 0x94, 0xFF, 
+0x22, 0x8C, 0xAA, 0xFD, 0x09, 0xF6, 0x71, 0x5A, 
+0x73, 0x20, 0xFA, 0xDF, 0x89, 0x63, 0xB8, 0xC7, 
+0xDB, 0xB4, 0xF5, 0x1D, 0x93, 0xC7, 0xA1, 0x3B, 
+0xCB, 0x10, 0x45, 0x52, 0x4C, 0xAF, 0xC4, 0x6B, 
+0x4C, 0x00, 0x09, 0x24, 0xDD, 0x13, 0xC9, 0x65, 
+0x89, 0x91, 0x2F, 0x2D, 0xBB, 0xC3, 0x4B, 0x04, 
+0x9D, 0x5C, 0x22, 0x4F, 0xEB, 0x5A, 0x82, 0x81, 
+0x3E, 0x59, 0x21, 0x77, 0x99, 0xD6, 0xE8, 0xA4, 
+0x37, 0x2B, 0x72, 0x71, 0x51, 0xD5, 0x1D, 0xEE, 
+0x58, 0xED, 0xF0, 0xCB, 0x01, 0x23, 0x89, 0x77, 
+0x48, 0x04, 0x10, 0x8F, 0xBE, 0x3B, 0x7E, 0xFA, 
+0xA0, 0xAB, 0x25, 0x85, 0x6F, 0xE5, 0xCF, 0x6E, 
+0xC0, 0xD7, 0x02, 0xA0, 0xCF, 0xAA, 0x6B, 0xEF, 
+0xD3, 0x6F, 0x40, 0xF9, 0x84, 0xEB, 0xED, 0x1F, 
+0x06, 0x1F, 0x6F, 0xEB, 0x0C, 0xD7, 0x3C, 0xB6, 
+0x62, 0x96, 0xD1, 0xCA, 0x1E, 0xF9, 0x40, 0xEB, 
+0x3C, 0x1D, 0x55, 0xFA, 0x89, 0x9F, 0xC0, 0x5E, 
+0x2F, 0x6A, 0xBC, 0xDE, 0x28, 0xDA, 0xCB, 0xB3, 
+0xA5, 0x1D, 0xA8, 0xB9, 0xCC, 0x34, 0x18, 0x19, 
+0xA5, 0x02, 0x91, 0xA9, 0x36, 0xAA, 0x17, 0xED, 
+0x4F, 0x3A, 0xC1, 0xC7, 0x9A, 0x60, 0x8B, 0xF9, 
+0xFB, 0x74, 0x27, 0x67, 0x1A, 0xF3, 0xA4, 0x5D, 
+0xBB, 0x6C, 0x20, 0xE8, 0xF7, 0xDC, 0xCC, 0x1E, 
+0xCB, 
+
+0x40, 0x37, 0x6D, 0xC2, 0x67, 0x84, 0x9C, 0xE5, 
+0xAA, 0xBA, 0x70, 0x46, 0xF4, 0xE2, 0xD3, 0xC0, 
+0x93, 0x2D, 0xFD, 0x35, 0x4B, 0xA4, 0x84, 0xBF, 
+0x2A, 0x30, 0xAF, 0x28, 0x39, 0x48, 0xBE, 0xF4, 
+0xAF, 0x1A, 0x31, 0x25, 0x97, 0x4B, 0x3A, 0xAE, 
+0x35, 0x21, 0xE5, 0xB9, 0x09, 0x1F, 0xD8, 0xB1, 
+0x13, 0x33, 0xA2, 0xFA, 0x67, 0xE0, 0xB6, 0x38, 
+0x8C, 0xB0, 0x7D, 0x35, 0xAC, 0x0C, 0xB9, 0x00, 
+0xFA, 0xD3, 0xD0, 0xD3, 0x9D, 0xBF, 0x5B, 0xE2, 
+0xDA, 0x59, 0x51, 0xF9, 0x36, 0x2D, 0x66, 0x1D, 
+0xE5, 0x43, 0x8C, 0x48, 0xF0, 0x94, 0x75, 0x73, 
+0x0A, 0x01, 0xD9, 0x70, 0xA0, 0x8F, 0xD1, 0x09, 
+0x73, 0x3B, 0x2C, 0x1C, 0x2C, 0xA9, 0xF9, 0x79, 
+0x41, 0x4D, 0x6B, 0x9F, 0x6A, 0xB4, 0xCA, 0xE2, 
+0xAB, 0x7D, 0x4D, 0x30, 0x7A, 0x24, 0x1C, 0x1A, 
+0x3E, 0xBA, 0xC8, 0x8F, 0xCC, 0xD4, 0xCC, 0x74, 
+0xF7, 0xE7, 0x0B, 0xBF, 0x10, 0xC1, 0xB4, 0x58, 
+0xFC, 0xDB, 0x18, 0x61, 0x80, 0xE9, 0x55, 0x14, 
+0x0D, 0xA5, 0xA4, 0x44, 0xC7, 0x38, 0x84, 0xAC, 
+0x41, 0xC3, 0xEC, 0xC9, 0x5A, 0xCD, 0x45, 0x91, 
+0x97, 0xEB, 0xE2, 0x99, 0xC6, 0x92, 0x3C, 0xE8, 
+0xFF, 0x00, 0x88, 0x28, 0xE2, 0xFF, 0x0B, 0x59, 
+0x09, 0x7E, 0x02, 0x5E, 0x5E, 0x62, 0xD5, 0x9C, 
+0x8D, 0x7A, 0xFF, 0x7A, 0x9F, 0x9D, 0x0F, 0x60, 
+0x44, 0x30, 
+
+0x97, 0x6F, 0xFA, 0x07, 0xF6, 0x74, 0x9A, 0x88, 
+0xF8, 0xC8, 0xEE, 0xA1, 0xBD, 0x5F, 0x02, 0x94, 
+0x2D, 0x5D, 0xAF, 0x03, 0x05, 0xE5, 0x20, 0x62, 
+0x3F, 0x96, 0x7A, 0x90, 0x7E, 0x94, 0x08, 0xED, 
+0x07, 0x36, 0xF9, 0xA2, 0xB0, 0x34, 0x57, 0xD3, 
+0xB2, 0x47, 0x8F, 0x8E, 0x12, 0x3C, 0x34, 0x90, 
+0xB2, 0x82, 0x3B, 0x0C, 0x33, 0x4D, 0xA2, 0xBB, 
+0x0F, 0x65, 0x39, 0xCD, 0xBB, 0x75, 0xCE, 0xA4, 
+0x2B, 0x4B, 0xFA, 0xA6, 0x0A, 0x1D, 0x88, 0x76, 
+0xC2, 0xE9, 0xE5, 0x4F, 0xB1, 0x39, 0x77, 0xAA, 
+0xC3, 0x88, 0x1A, 0x34, 0xEE, 0xAD, 0xDF, 0x96, 
+0xF0, 0x61, 0xC5, 0x05, 0x58, 0xA6, 0xCD, 0x1F, 
+0x20, 0x87, 0xC6, 0x40, 0xD4, 0x9A, 0x3D, 0xBF, 
+0xDA, 0x1A, 0x06, 0xCC, 0x3B, 0x91, 0xC7, 0x2D, 
+0x00, 0xCC, 0xCB, 0xFC, 0x50, 0xC5, 0x30, 0x9F, 
+0xBE, 0x89, 0x03, 0x72, 0x85, 0xC1, 0xE4, 0xCB, 
+0x54, 0xFF, 0x46, 0x99, 0x83, 0x66, 0x84, 0xF3, 
+0xF1, 0x5E, 0xDA, 0x7D, 0x5D, 0x95, 0x93, 0xF8, 
+0xD8, 0x29, 0x9B, 0xCC, 0x03, 0xAB, 0x12, 0x9B, 
+0xF6, 0x11, 0x5F, 0x60, 0x8A, 0xE6, 0x2C, 0xE7, 
+0xAD, 0x25, 0x84, 0x92, 0xB8, 0x93, 0x3C, 0x1C, 
+0xCF, 0x18, 0xA9, 0x1D, 0x0C, 0xD6, 0x5C, 0x98, 
+0xC5, 0xB4, 0xDF, 0x6E, 0xCF, 0xA6, 0x04, 0xEC, 
+0x1F, 0x5F, 0x04, 0xEC, 0xFA, 0xFF, 0x9A, 0x05, 
+0x6F, 0xF7, 0x9E, 
+
+0x75, 0x38, 0xC0, 0x09, 0x88, 0x15, 0x9C, 0x7E, 
+0x24, 0xB3, 0xCF, 0x78, 0x96, 0xA6, 0x90, 0x49, 
+0x55, 0xD7, 0x4A, 0x78, 0xED, 0xDF, 0x12, 0x1B, 
+0xC3, 0x8A, 0xC4, 0x6C, 0x3F, 0xCD, 0x3C, 0x32, 
+0x00, 0x89, 0x92, 0x11, 0xA8, 0x7C, 0xFA, 0x4B, 
+0xA4, 0xEC, 0xEC, 0x80, 0x3C, 0xFA, 0x69, 0x1E, 
+0x03, 0x2D, 0xD4, 0xCF, 0xA8, 0xB5, 0x3E, 0x00, 
+0xFE, 0xF3, 0x0A, 0xF3, 0x39, 0x00, 0x43, 0xAE, 
+0xCF, 0x62, 0xEF, 0x6A, 0xD4, 0x63, 0x09, 0x68, 
+0x00, 0x8A, 0xB9, 0x44, 0x4F, 0xA0, 0x94, 0xED, 
+0x39, 0x09, 0x48, 0xEB, 0x52, 0x11, 0xBF, 0x90, 
+0x10, 0x17, 0xA8, 0x08, 0x34, 0xB0, 0x6B, 0x86, 
+0x6F, 0x88, 0x51, 0x38, 0xA2, 0x83, 0xB2, 0xEE, 
+0x61, 0x2B, 0x49, 0x6A, 0x64, 0xD7, 0x1A, 0xAA, 
+0xDD, 0xBF, 0xE9, 0xEC, 0xE0, 0xAE, 0xB3, 0xB8, 
+0x5A, 0x25, 0x0E, 0xFB, 0xB1, 0xB1, 0x5F, 0x55, 
+0xB5, 0x5B, 0x00, 0x8C, 0x74, 0x18, 0x75, 0xA3, 
+0xF6, 0xA7, 0x60, 0xD8, 0xEB, 0x38, 0x2C, 0x7C, 
+0xCB, 0x3B, 0xA2, 0x94, 0xA1, 0xFC, 0xF7, 0xB3, 
+0x81, 0x6E, 0x46, 0xEC, 0xE9, 0x53, 0xBF, 0xD0, 
+0x0B, 0x86, 0x26, 0x73, 0x1E, 0xC3, 0x69, 0x24, 
+0xF2, 0x76, 0x49, 0xF8, 0x62, 0xD4, 0x65, 0x59, 
+0xF4, 0x8C, 0x9D, 0x48, 0x28, 0xED, 0x88, 0x99, 
+0xAF, 0x2B, 0x00, 0xB3, 0xB1, 0x95, 0x67, 0x71, 
+0x55, 0x6E, 0x2C, 0xFC, 
+
+0x12, 0xBA, 0x87, 0x43, 0x09, 0x36, 0xBC, 0xD8, 
+0x6B, 0x4E, 0xC2, 0x95, 0xFC, 0xD7, 0xD9, 0x5D, 
+0x84, 0x10, 0x3D, 0x8B, 0x2B, 0x1C, 0x0E, 0xCE, 
+0xA3, 0x6C, 0x2F, 0x1D, 0xA0, 0x00, 0xE6, 0xA0, 
+0xCE, 0x0E, 0x59, 0x8C, 0xEF, 0x69, 0x7D, 0x99, 
+0xD4, 0x86, 0x3D, 0x3E, 0xC4, 0x16, 0xCD, 0x70, 
+0x58, 0xB6, 0xE6, 0x46, 0x30, 0x14, 0xDB, 0x73, 
+0xAC, 0x1A, 0x36, 0x1A, 0xF3, 0xE6, 0x0A, 0x2C, 
+0xC5, 0xCC, 0xCE, 0xA1, 0xB8, 0xEC, 0xE8, 0x7F, 
+0x41, 0x18, 0xEB, 0xBD, 0x98, 0xCB, 0x41, 0xA5, 
+0x09, 0x2B, 0xB7, 0xB0, 0x3A, 0xF5, 0x58, 0x76, 
+0x45, 0x08, 0x56, 0x3E, 0xE0, 0xAA, 0x4E, 0xB9, 
+0x0F, 0x2E, 0x91, 0x02, 0xA8, 0x9C, 0x5A, 0xD3, 
+0x2D, 0x87, 0x74, 0x0B, 0x6B, 0x0E, 0xAD, 0xE3, 
+0xDD, 0x5D, 0x2D, 0xF9, 0x4D, 0x2C, 0x82, 0x76, 
+0x22, 0xFD, 0xD8, 0x92, 0xBD, 0x19, 0xB2, 0x9A, 
+0x52, 0x22, 0x30, 0x84, 0x7B, 0x6D, 0x52, 0x6B, 
+0x2D, 0x42, 0xFC, 0xC9, 0xE3, 0x1B, 0x4A, 0xE6, 
+0x3F, 0xE3, 0x84, 0xCF, 0x2C, 0x75, 0xEA, 0x00, 
+0x12, 0xAB, 0xC9, 0x15, 0x1C, 0x00, 0x1A, 0xBB, 
+0x29, 0x0D, 0x59, 0xE1, 0x81, 0x08, 0x6C, 0x85, 
+0x9A, 0xAE, 0xC0, 0xB0, 0x76, 0x95, 0x39, 0xC5, 
+0x7C, 0xC0, 0x89, 0x84, 0x55, 0x46, 0xAA, 0xBA, 
+0x63, 0x2F, 0x5B, 0x6F, 0x5B, 0xC0, 0x85, 0x64, 
+0x82, 0xCC, 0x2F, 0x1A, 0xA8, 
+
+0xCC, 0xCC, 0x1C, 0x44, 0xE2, 0xC7, 0x17, 0xE8, 
+0x01, 0x63, 0x65, 0x1D, 0x85, 0x54, 0x66, 0x4E, 
+0xD1, 0xB0, 0xE7, 0x41, 0x4F, 0x9F, 0x57, 0xC0, 
+0x09, 0x4A, 0xAF, 0x41, 0xA4, 0xC5, 0xFE, 0xFF, 
+0xB9, 0x9D, 0x1C, 0x07, 0x13, 0x19, 0xB6, 0xE9, 
+0x9D, 0xC9, 0xED, 0xA5, 0xE0, 0x4C, 0x88, 0x23, 
+0x95, 0x8C, 0x09, 0x37, 0xF4, 0x66, 0x95, 0x2D, 
+0x62, 0x73, 0x68, 0x1B, 0x1D, 0x51, 0x8B, 0x55, 
+0x61, 0xF0, 0x43, 0xC2, 0x4A, 0x41, 0x86, 0x6E, 
+0x6C, 0xB2, 0xF5, 0x66, 0xCC, 0x52, 0xBC, 0x2E, 
+0x0D, 0x9E, 0x00, 0x17, 0x75, 0x2A, 0x36, 0x2F, 
+0x61, 0xFD, 0x30, 0x60, 0x92, 0x68, 0x47, 0xC7, 
+0xF9, 0x6B, 0x9A, 0xD4, 0x96, 0xBE, 0x95, 0x8E, 
+0x8D, 0x27, 0x98, 0x7B, 0xFB, 0x4F, 0xA0, 0xBC, 
+0x76, 0x29, 0xEF, 0x69, 0x80, 0x03, 0x4A, 0x4A, 
+0x8E, 0xC0, 0xAD, 0x6B, 0xAE, 0x08, 0x02, 0x04, 
+0xC7, 0xE5, 0x74, 0x6B, 0xE2, 0x50, 0x43, 0x00, 
+0xEE, 0x4B, 0x64, 0x24, 0x88, 0xAD, 0x4E, 0xD1, 
+0xC3, 0x7B, 0x19, 0x46, 0x6A, 0x9E, 0x3E, 0xD1, 
+0x7E, 0x2C, 0xA4, 0xE6, 0xA9, 0x3A, 0x9D, 0xCC, 
+0xF1, 0xB5, 0xE3, 0x75, 0x78, 0x31, 0xA8, 0xC9, 
+0x9D, 0x12, 0x54, 0x99, 0x4C, 0xD0, 0x21, 0x8E, 
+0x94, 0x81, 0x9C, 0xDB, 0x91, 0x8F, 0xE5, 0x94, 
+0xD5, 0x7F, 0x41, 0xCD, 0xFA, 0x11, 0xBD, 0x7A, 
+0xDF, 0x44, 0xCA, 0xA0, 0xD8, 0x41, 
+
+0x27, 0xAB, 0x10, 0x9C, 0x7E, 0xF8, 0xD1, 0x44, 
+0xF4, 0xEB, 0x54, 0x32, 0x21, 0x3E, 0xF5, 0xB7, 
+0x5E, 0x44, 0x1A, 0x74, 0x0D, 0x9D, 0x57, 0x28, 
+0x77, 0x56, 0xF4, 0xF0, 0x63, 0x6E, 0x24, 0xA6, 
+0x6E, 0x4F, 0xB4, 0x2C, 0xFA, 0xB0, 0xD8, 0x7D, 
+0x55, 0xF1, 0xD4, 0x90, 0xE9, 0x4A, 0xF4, 0xED, 
+0xBB, 0x5F, 0x4F, 0x06, 0x1C, 0x27, 0x09, 0xF2, 
+0x44, 0xFC, 0x12, 0xF0, 0x8E, 0x33, 0x46, 0xC3, 
+0x9C, 0x2D, 0x81, 0x8D, 0x9C, 0x10, 0x8B, 0x69, 
+0x33, 0x03, 0xD3, 0xAE, 0x38, 0x07, 0x6F, 0xC3, 
+0x0E, 0x46, 0x3D, 0x24, 0x30, 0xF6, 0xD7, 0x70, 
+0xCA, 0xF4, 0x48, 0x56, 0x95, 0x67, 0x35, 0x3A, 
+0x27, 0x62, 0xEA, 0xED, 0x70, 0xA0, 0xAD, 0xEF, 
+0x9A, 0xB5, 0x6F, 0x16, 0x13, 0xDB, 0x4D, 0xB9, 
+0x8D, 0x5A, 0x91, 0x8C, 0x8C, 0xF5, 0x3D, 0x83, 
+0x99, 0x31, 0x90, 0x1A, 0x50, 0xBD, 0x08, 0x8B, 
+0x24, 0x05, 0x6C, 0x76, 0xE8, 0x16, 0xB2, 0x54, 
+0x39, 0x4C, 0x17, 0xFF, 0x9B, 0xD4, 0xFC, 0xF5, 
+0x15, 0x6F, 0x00, 0x71, 0x8E, 0xDA, 0x5B, 0x48, 
+0x4E, 0x2F, 0xD7, 0x58, 0x97, 0x86, 0xBD, 0xBF, 
+0xE1, 0x7B, 0x6F, 0xD4, 0x63, 0xDD, 0xF1, 0xFA, 
+0xD3, 0x57, 0x3F, 0x70, 0x16, 0xBB, 0x7A, 0x5B, 
+0x76, 0x0A, 0x79, 0xC9, 0xB3, 0x92, 0x15, 0x3A, 
+0x3B, 0xDF, 0x80, 0x5D, 0xA6, 0x51, 0x6B, 0x6C, 
+0xE1, 0xAF, 0x4A, 0xCA, 0x1C, 0x59, 0x71, 
+
+0x71, 0xE3, 0xA5, 0xD5, 0xB7, 0x47, 0x78, 0xF3, 
+0x05, 0x83, 0x9A, 0xF0, 0xE9, 0x76, 0xC1, 0x1A, 
+0x14, 0xC3, 0x21, 0xF4, 0x19, 0xA9, 0xCA, 0xF2, 
+0xF1, 0x65, 0x38, 0x87, 0x8E, 0x5B, 0xF8, 0x42, 
+0xFE, 0xA0, 0x59, 0xBD, 0x31, 0xA7, 0x63, 0x44, 
+0x23, 0x00, 0x70, 0x09, 0xF5, 0xB0, 0xE4, 0x3D, 
+0xE3, 0xF7, 0x90, 0xFF, 0x13, 0x31, 0x7C, 0x5F, 
+0x28, 0xED, 0xDC, 0xC5, 0x7E, 0xAA, 0x68, 0xF6, 
+0x0E, 0x0D, 0xDF, 0x6A, 0xCD, 0x57, 0x9A, 0x48, 
+0xC0, 0xF8, 0xC0, 0x69, 0xA0, 0xA4, 0xC2, 0x5A, 
+0x0A, 0xE7, 0x00, 0xC7, 0x7C, 0xA3, 0x2E, 0x1A, 
+0xEB, 0x17, 0x5F, 0x9B, 0xEA, 0x6F, 0x29, 0xAC, 
+0x5E, 0x44, 0x08, 0x9F, 0x3F, 0x18, 0x70, 0x9C, 
+0xD2, 0x82, 0x00, 0x27, 0xF4, 0xA7, 0x69, 0x86, 
+0x87, 0xA2, 0x5D, 0xA6, 0x0B, 0xAA, 0x7C, 0x83, 
+0x8B, 0xB5, 0x4B, 0x76, 0x7D, 0x43, 0xC0, 0x2F, 
+0x09, 0xE2, 0x00, 0x79, 0x1F, 0xA3, 0xEF, 0xEF, 
+0x02, 0x7B, 0xF9, 0x0F, 0xC2, 0x1B, 0x91, 0x37, 
+0xB1, 0xAE, 0x77, 0x06, 0x20, 0xC0, 0x37, 0xE7, 
+0xB2, 0x5A, 0x16, 0xA8, 0x1E, 0x9A, 0x30, 0xB6, 
+0xAF, 0xC9, 0xE9, 0x9F, 0x9D, 0x15, 0xEF, 0x6B, 
+0xFD, 0xFB, 0x17, 0xA8, 0xD0, 0x78, 0xAC, 0xD4, 
+0xF6, 0x0C, 0xF2, 0xFE, 0xFD, 0xD0, 0x25, 0x35, 
+0xE9, 0xDE, 0xD3, 0x4A, 0xF4, 0xA6, 0x2F, 0x36, 
+0xB3, 0x73, 0x03, 0x93, 0x48, 0x39, 0x0B, 0xFD, 
+
+0x34, 0xEC, 0x72, 0x64, 0x75, 0xE7, 0x23, 0xE9, 
+0x82, 0x0C, 0xDC, 0xBD, 0xFB, 0x63, 0x21, 0x21, 
+0xC3, 0xD3, 0x14, 0xE8, 0x37, 0x01, 0x61, 0x26, 
+0xA4, 0x3D, 0xB6, 0xEA, 0x05, 0x34, 0x0E, 0xE2, 
+0x71, 0x86, 0xB9, 0x26, 0xE8, 0x0B, 0xDC, 0x63, 
+0xF1, 0xA0, 0x8D, 0x92, 0x41, 0x51, 0x07, 0x63, 
+0xDA, 0x24, 0x0D, 0x44, 0x05, 0x70, 0x59, 0xCC, 
+0x4C, 0xBA, 0x78, 0xFD, 0x64, 0x6C, 0xB3, 0xF7, 
+0x31, 0x3B, 0xF0, 0x45, 0x68, 0x9A, 0x2B, 0x2C, 
+0xA7, 0x04, 0xFA, 0x00, 0xC6, 0xD8, 0x00, 0xA6, 
+0x76, 0x6D, 0xDA, 0x5C, 0xF8, 0x36, 0x44, 0x0A, 
+0xB6, 0x19, 0xA7, 0xA9, 0x92, 0x47, 0x4C, 0xF6, 
+0x77, 0x09, 0x7E, 0x62, 0xE4, 0xAF, 0x2A, 0x81, 
+0xCB, 0x5A, 0x00, 0xC6, 0x1F, 0x3B, 0x87, 0xCD, 
+0xC7, 0x45, 0xFB, 0xFC, 0x9A, 0xA2, 0x46, 0xA4, 
+0xE1, 0x6E, 0xF0, 0x93, 0x9B, 0x06, 0x12, 0x62, 
+0xE7, 0x8D, 0xC3, 0xB1, 0xB2, 0x4B, 0x6B, 0x94, 
+0x7C, 0x3A, 0x6C, 0x14, 0xCE, 0x45, 0xEC, 0xB8, 
+0x56, 0x7A, 0x27, 0x4B, 0x41, 0x63, 0x49, 0x0E, 
+0xF4, 0xCA, 0xD2, 0x96, 0x28, 0x6D, 0x55, 0xF2, 
+0x2E, 0xE5, 0x27, 0xA2, 0xA0, 0x0E, 0xE8, 0x6A, 
+0x97, 0x63, 0x78, 0xE5, 0x5B, 0x51, 0xEC, 0x56, 
+0xD2, 0xD8, 0x30, 0xA6, 0x35, 0xBA, 0xB0, 0x0A, 
+0x9D, 0x26, 0xFB, 0xA3, 0xF1, 0x85, 0xB6, 0x3E, 
+0x9D, 0xE7, 0xE0, 0x53, 0x4C, 0xE8, 0xCC, 0x55, 
+0x2A, 
+
+0x14, 0x4A, 0xBB, 0x6A, 0x71, 0x1D, 0x21, 0xCD, 
+0xFD, 0x03, 0x99, 0x4D, 0x74, 0x77, 0xF4, 0x10, 
+0xEF, 0x8E, 0x63, 0xDA, 0x68, 0x87, 0xEB, 0xAD, 
+0xA5, 0x96, 0x93, 0x93, 0xCE, 0xF9, 0x29, 0xFB, 
+0x8A, 0xD3, 0x52, 0xCA, 0x04, 0x59, 0x04, 0x03, 
+0x5D, 0xF2, 0x73, 0x4F, 0x50, 0x9E, 0xC6, 0x78, 
+0xFB, 0x5B, 0x03, 0xB3, 0xCB, 0x95, 0xA5, 0xBA, 
+0x63, 0x39, 0x5E, 0xED, 0xB0, 0x9B, 0x5C, 0x1F, 
+0x29, 0x67, 0xDA, 0x76, 0x75, 0x46, 0xA0, 0xFB, 
+0x92, 0xCD, 0x18, 0x14, 0x65, 0x63, 0xFD, 0x1C, 
+0x1D, 0xAE, 0x65, 0x76, 0x57, 0x5E, 0xD9, 0xC4, 
+0x0A, 0x93, 0x4A, 0xED, 0xF5, 0x8F, 0x87, 0x39, 
+0x04, 0xBB, 0x7B, 0x99, 0x6F, 0x74, 0xD3, 0x19, 
+0xE2, 0x41, 0x58, 0x26, 0x7D, 0xEB, 0x53, 0x93, 
+0x9C, 0x84, 0xA8, 0x74, 0xB2, 0x54, 0x15, 0xE8, 
+0x93, 0x60, 0x2D, 0xA9, 0xE3, 0x7B, 0x47, 0x35, 
+0x0F, 0xA9, 0x34, 0xAE, 0x25, 0x9B, 0x1D, 0x71, 
+0x1D, 0xD4, 0x89, 0xEF, 0x58, 0x6F, 0x09, 0x2B, 
+0x3B, 0xAF, 0x5F, 0x80, 0xE6, 0xA9, 0x9C, 0xDE, 
+0xA7, 0x88, 0x96, 0x41, 0xD6, 0x39, 0x46, 0x8C, 
+0xD3, 0x82, 0x3E, 0xB9, 0x1A, 0xF9, 0xD7, 0x0E, 
+0xCC, 0x48, 0x4D, 0x18, 0x82, 0x5D, 0x44, 0xDD, 
+0xBF, 0x8B, 0x3B, 0xEF, 0x55, 0x34, 0xAA, 0x13, 
+0x95, 0x65, 0x58, 0x13, 0x48, 0x1C, 0x5A, 0x78, 
+0x1D, 0x32, 0x18, 0xE2, 0x7F, 0xEF, 0xAF, 0x01, 
+0x68, 0xCF, 
+
+0x13, 0xA0, 0xBA, 0xBB, 0xD0, 0x6B, 0xAF, 0x94, 
+0xE2, 0xA9, 0x5D, 0x04, 0xBA, 0x8A, 0x43, 0xB0, 
+0x4E, 0xF1, 0x9B, 0x04, 0xEF, 0x63, 0x1B, 0x81, 
+0x11, 0x39, 0x0C, 0x06, 0xE8, 0xE8, 0x97, 0xAB, 
+0x9C, 0xE3, 0x25, 0x8B, 0x47, 0xA1, 0xEB, 0xF1, 
+0xCA, 0x3E, 0xEB, 0x46, 0x65, 0xC2, 0x67, 0x24, 
+0xC1, 0x1D, 0x68, 0xAF, 0xB1, 0x0A, 0x1E, 0xC2, 
+0x8B, 0x3A, 0x06, 0xAD, 0xEB, 0x7D, 0x99, 0x59, 
+0x31, 0x06, 0x74, 0xF8, 0xC7, 0x3A, 0xCE, 0x00, 
+0xDC, 0x72, 0xDF, 0x74, 0xFF, 0xB3, 0xFF, 0xB3, 
+0x04, 0xC7, 0x78, 0x45, 0xE3, 0x92, 0xA8, 0x64, 
+0xF8, 0x09, 0xC0, 0x2F, 0x6D, 0x83, 0xEB, 0xA6, 
+0x54, 0x91, 0x93, 0xF3, 0x5D, 0x19, 0x3C, 0x5E, 
+0xBF, 0x8C, 0xE5, 0x6C, 0x3E, 0x40, 0x82, 0x81, 
+0x89, 0x88, 0x51, 0x7C, 0x5A, 0xDB, 0xCE, 0xFE, 
+0xF8, 0x09, 0x6F, 0x82, 0x78, 0xEA, 0x1A, 0xAA, 
+0xFB, 0x44, 0xF0, 0xFE, 0xC2, 0x5C, 0x6C, 0xA4, 
+0x0C, 0x97, 0x8C, 0xB6, 0xD0, 0xFD, 0x5A, 0xAB, 
+0xCA, 0xBD, 0x0B, 0x94, 0x75, 0xE8, 0xEF, 0x5E, 
+0x15, 0x75, 0x40, 0x3D, 0x60, 0x6A, 0xC8, 0xAF, 
+0x85, 0xB3, 0x13, 0x97, 0x92, 0x54, 0x8C, 0xF4, 
+0x97, 0xA8, 0xF0, 0x2D, 0x22, 0x5E, 0xA1, 0xDF, 
+0x04, 0x43, 0xCE, 0x67, 0xC4, 0xEB, 0x13, 0x3E, 
+0xBD, 0x51, 0xEE, 0x36, 0xA9, 0x10, 0x59, 0xCE, 
+0x39, 0x4D, 0x3F, 0x8D, 0xDD, 0xAE, 0xC0, 0xD5, 
+0xA9, 0xFD, 0x9B, 
+
+0x62, 0xE9, 0xE2, 0x74, 0xE4, 0x19, 0xD4, 0x5A, 
+0x6D, 0xEC, 0xB4, 0x10, 0x8F, 0xA3, 0xCC, 0x4A, 
+0x11, 0x5E, 0x39, 0xCF, 0xEC, 0x40, 0xFB, 0xDB, 
+0x6C, 0x7F, 0xA9, 0x0E, 0x10, 0x92, 0x3C, 0x5A, 
+0xE8, 0x47, 0x0D, 0xE9, 0xF7, 0xAA, 0x7D, 0x3B, 
+0xD9, 0xE8, 0xE5, 0xFF, 0x4C, 0x39, 0xF4, 0x54, 
+0xD5, 0x9B, 0xA3, 0x8B, 0xB4, 0xC1, 0xA3, 0x47, 
+0x99, 0xAD, 0x49, 0x77, 0x8A, 0x7E, 0xF1, 0xBC, 
+0xC7, 0x7A, 0xE2, 0x18, 0xD8, 0x90, 0x10, 0x70, 
+0xD4, 0x0D, 0x4E, 0x99, 0x9E, 0x15, 0x2A, 0xB5, 
+0x1A, 0xEB, 0x56, 0x06, 0xAC, 0xAD, 0x6B, 0xE8, 
+0xDF, 0xEB, 0x37, 0x9E, 0xFE, 0x57, 0xAE, 0xF9, 
+0x12, 0x4D, 0xEE, 0xBC, 0x47, 0x38, 0x17, 0x6F, 
+0x58, 0x0F, 0xCE, 0x01, 0x80, 0x9F, 0x41, 0x78, 
+0x20, 0x86, 0xF0, 0xED, 0x2A, 0xCD, 0xD3, 0x17, 
+0x7B, 0x5D, 0x1A, 0x8B, 0xE5, 0x64, 0x29, 0x4A, 
+0x84, 0x41, 0x19, 0x7C, 0x4C, 0xB2, 0xB5, 0x5E, 
+0x19, 0xC2, 0xC8, 0x5E, 0x97, 0x6D, 0x87, 0xC7, 
+0x21, 0x49, 0xBA, 0x1B, 0x12, 0xFE, 0xB9, 0x58, 
+0xA0, 0x55, 0xAF, 0x07, 0x21, 0xF5, 0x41, 0x74, 
+0x16, 0xC2, 0xFA, 0x35, 0x41, 0x88, 0x25, 0xE6, 
+0x55, 0xA1, 0x7D, 0xA8, 0x43, 0xAF, 0xA4, 0xD7, 
+0x14, 0xDA, 0x41, 0x0C, 0x44, 0xA9, 0x88, 0xBB, 
+0x50, 0x9D, 0x21, 0x57, 0x61, 0x2D, 0x23, 0xDC, 
+0x30, 0xB9, 0x6E, 0x9A, 0x81, 0x9B, 0xA1, 0x28, 
+0x83, 0xD9, 0x5C, 0x57, 
+
+0x9F, 0x64, 0xAA, 0x06, 0x01, 0xF1, 0x0F, 0x0F, 
+0xE5, 0xFE, 0x57, 0x03, 0x18, 0x45, 0x62, 0x81, 
+0x99, 0xDD, 0x5D, 0x5B, 0x0D, 0x57, 0x36, 0xCE, 
+0x15, 0x42, 0x8B, 0xA5, 0x97, 0xAA, 0x17, 0xE0, 
+0xD3, 0x0D, 0x5E, 0x21, 0xF0, 0x14, 0x2C, 0x34, 
+0x74, 0x69, 0x1E, 0xBA, 0x87, 0x79, 0x1C, 0x52, 
+0x73, 0x40, 0x69, 0x35, 0x53, 0x62, 0x04, 0xF2, 
+0x81, 0xD1, 0xE9, 0xE2, 0xD4, 0x5E, 0x46, 0xB6, 
+0x56, 0xEB, 0x63, 0x0A, 0x9A, 0x3D, 0x40, 0xEA, 
+0x69, 0xFF, 0x27, 0x16, 0x61, 0xCF, 0x56, 0x6B, 
+0x80, 0x10, 0x4D, 0x30, 0x5B, 0x7C, 0xEE, 0x6D, 
+0x9B, 0x44, 0x12, 0xC8, 0xFE, 0xD1, 0x39, 0x64, 
+0xAF, 0xD9, 0xA2, 0xF5, 0xBE, 0x94, 0x4D, 0x67, 
+0x70, 0x9F, 0x38, 0x8A, 0x51, 0x48, 0xB1, 0xFA, 
+0x06, 0x5B, 0x3C, 0x40, 0x6A, 0x79, 0x76, 0xDC, 
+0x84, 0xEB, 0xEB, 0x92, 0x27, 0x4C, 0xE3, 0xCA, 
+0xB7, 0xC1, 0xCC, 0x52, 0x95, 0xA0, 0x9C, 0x59, 
+0x3B, 0x55, 0xAD, 0x10, 0x63, 0x71, 0x2C, 0xB9, 
+0xF3, 0x6C, 0x37, 0x4B, 0xF6, 0xCC, 0xFC, 0x51, 
+0x12, 0x40, 0x2F, 0x41, 0xFD, 0x5B, 0xF1, 0x18, 
+0x53, 0x50, 0xAC, 0x43, 0xEA, 0x55, 0x6A, 0xC4, 
+0x2D, 0x8B, 0x05, 0x57, 0xA0, 0x24, 0xB2, 0x37, 
+0x03, 0x6E, 0x26, 0xC8, 0x3B, 0xBF, 0x3C, 0xA0, 
+0x61, 0xBD, 0x42, 0x8F, 0x05, 0x2B, 0x1A, 0xC6, 
+0x1B, 0xD4, 0x31, 0x5B, 0xC4, 0x46, 0x4B, 0xB9, 
+0xCB, 0xE7, 0xCB, 0xAE, 0x73, 
+
+0x1E, 0x49, 0xC6, 0x26, 0xD7, 0xE0, 0x00, 0xEA, 
+0x8C, 0x31, 0x07, 0xF8, 0x5E, 0x0F, 0xF0, 0x6C, 
+0x41, 0x52, 0x3D, 0x25, 0x61, 0xC9, 0xFE, 0xFC, 
+0x58, 0xDB, 0xED, 0x11, 0x87, 0x5C, 0x8A, 0xFE, 
+0xCB, 0x93, 0xED, 0x1C, 0x1B, 0xA9, 0x2D, 0x81, 
+0xD0, 0xC2, 0x57, 0x47, 0x32, 0x8A, 0x1E, 0x0C, 
+0xAA, 0x6E, 0x53, 0x98, 0x18, 0xA8, 0x52, 0x28, 
+0x2D, 0x20, 0x16, 0xF6, 0x5F, 0x9F, 0x60, 0xC5, 
+0xC8, 0x79, 0xFC, 0x59, 0xA5, 0x0C, 0xE3, 0x0B, 
+0x8B, 0xE3, 0x44, 0x2D, 0x2E, 0xF7, 0xFA, 0x7E, 
+0xE6, 0xBA, 0x81, 0xFF, 0x60, 0x01, 0x88, 0xB5, 
+0x55, 0x63, 0x4F, 0xD3, 0x7B, 0xDE, 0xF9, 0x95, 
+0x60, 0xF9, 0x34, 0xAF, 0x92, 0x93, 0x96, 0x3D, 
+0xE8, 0x2C, 0x04, 0xD1, 0xC0, 0x6B, 0xFC, 0x9B, 
+0x20, 0xC5, 0xA7, 0xDB, 0xB8, 0x43, 0xC9, 0xC7, 
+0x60, 0xA5, 0x96, 0x06, 0xC0, 0x61, 0x1A, 0xE1, 
+0xE3, 0xE8, 0xE7, 0x5E, 0x48, 0xBF, 0x23, 0x51, 
+0xE8, 0x06, 0xA2, 0xCE, 0x75, 0x29, 0xA7, 0x92, 
+0xAB, 0xE7, 0x0B, 0xA5, 0xB5, 0x2D, 0x0F, 0xAD, 
+0xE0, 0x1B, 0x99, 0xB7, 0x76, 0xAD, 0x06, 0xBE, 
+0x2C, 0xB2, 0x71, 0xEC, 0x0F, 0xFD, 0x83, 0x77, 
+0xC8, 0xFA, 0xF3, 0x41, 0x88, 0x99, 0x20, 0x58, 
+0xBB, 0x62, 0x1F, 0x56, 0xCF, 0x4D, 0x42, 0xEF, 
+0x6D, 0x97, 0xF8, 0x84, 0xC6, 0x9F, 0x2B, 0x0A, 
+0x71, 0xDD, 0xB6, 0x14, 0x25, 0x71, 0x9C, 0x9D, 
+0xA9, 0xAE, 0x87, 0xC3, 0x25, 0x0D, 
+
+0x85, 0xC4, 0x79, 0xCC, 0x88, 0xF3, 0xD2, 0xE3, 
+0x7D, 0xF0, 0x2C, 0x0A, 0x0F, 0xB5, 0xBD, 0xA6, 
+0x1B, 0xC6, 0x98, 0x46, 0xFE, 0xFD, 0xA6, 0x74, 
+0x3D, 0x2E, 0x63, 0xFE, 0xAC, 0x3C, 0x38, 0xA9, 
+0x00, 0xA1, 0x1D, 0x67, 0x76, 0xD2, 0x90, 0x8B, 
+0x8F, 0x66, 0x01, 0xD7, 0x7B, 0x55, 0x11, 0xC0, 
+0x9B, 0xF1, 0x3B, 0x3A, 0x2C, 0x11, 0x31, 0xD1, 
+0x12, 0x6C, 0x42, 0x40, 0xEC, 0x43, 0xF5, 0xAD, 
+0x00, 0x35, 0x1C, 0x94, 0xB8, 0xC8, 0x5A, 0x10, 
+0x07, 0x85, 0x67, 0x36, 0x6B, 0x8C, 0xF6, 0xD5, 
+0xA5, 0x47, 0xAC, 0x69, 0x67, 0xA3, 0xC6, 0xDC, 
+0xC1, 0xFC, 0x21, 0xDD, 0x37, 0x67, 0x3A, 0xA1, 
+0x08, 0x6A, 0x08, 0x70, 0x56, 0xA9, 0x4E, 0xD3, 
+0xD7, 0x93, 0xBD, 0x8E, 0x73, 0x20, 0xBB, 0x09, 
+0x08, 0x0E, 0x56, 0x89, 0x45, 0x2B, 0x7C, 0x76, 
+0x47, 0xD0, 0xCA, 0x98, 0x24, 0xEA, 0x75, 0xD0, 
+0xCA, 0x5D, 0xFB, 0x39, 0x92, 0x6C, 0x48, 0xF3, 
+0xF9, 0xB0, 0x2E, 0xC5, 0x23, 0xEF, 0xF6, 0xD8, 
+0x47, 0xFE, 0xCF, 0x47, 0x00, 0xEE, 0xDB, 0xD3, 
+0xA6, 0x20, 0x1D, 0x72, 0xCC, 0x19, 0x9B, 0xAB, 
+0x5B, 0x02, 0x72, 0x66, 0xF2, 0xB5, 0x65, 0x0D, 
+0x57, 0x0C, 0xBF, 0x40, 0x8E, 0x06, 0x10, 0x81, 
+0x01, 0x10, 0x38, 0x02, 0xFD, 0x2A, 0x78, 0x2E, 
+0x78, 0x09, 0xB4, 0xFF, 0x58, 0x6B, 0x67, 0x9E, 
+0x43, 0xD1, 0xCB, 0x50, 0x7D, 0x7D, 0x17, 0xB8, 
+0x77, 0xD0, 0x75, 0x96, 0xFF, 0xB3, 0xFF, 
+
+0x8F, 0x01, 0x10, 0xCF, 0x40, 0x86, 0x5F, 0xC7, 
+0xF9, 0x05, 0x4F, 0xD1, 0xD8, 0x1A, 0xDF, 0x30, 
+0xE2, 0x4A, 0xEB, 0x1E, 0x5F, 0x0D, 0x4A, 0x1B, 
+0x0A, 0x30, 0x85, 0x29, 0x05, 0x91, 0x1D, 0xEB, 
+0xB8, 0x7E, 0x45, 0xE7, 0x70, 0x9F, 0x32, 0x19, 
+0x21, 0x0A, 0xAC, 0x60, 0xF2, 0x34, 0x0B, 0xEF, 
+0xD6, 0x69, 0x93, 0x50, 0xC3, 0xBE, 0xD9, 0xAE, 
+0xB5, 0x16, 0x31, 0xF1, 0xBF, 0x52, 0x9F, 0x73, 
+0x2B, 0x71, 0x4E, 0x46, 0xC8, 0x18, 0x0A, 0x77, 
+0xBA, 0x07, 0xCA, 0xC7, 0xFD, 0xFF, 0xFC, 0x91, 
+0xFB, 0x80, 0x1F, 0x3C, 0xA7, 0x1E, 0x44, 0x85, 
+0xD8, 0x51, 0x47, 0x12, 0x76, 0x8E, 0xD7, 0x96, 
+0x88, 0x96, 0x9B, 0x86, 0x65, 0x26, 0xC6, 0x19, 
+0x8A, 0xAC, 0x7D, 0x70, 0x6E, 0xEE, 0x41, 0xBA, 
+0x84, 0xAD, 0xFC, 0x60, 0x31, 0x02, 0x28, 0x36, 
+0x35, 0x09, 0xC5, 0xE7, 0x74, 0xDA, 0xAC, 0xAC, 
+0x47, 0xFA, 0xCE, 0x15, 0xBC, 0xE7, 0x3B, 0x7D, 
+0xDF, 0x27, 0x3B, 0xA1, 0xA3, 0xEB, 0xCF, 0x22, 
+0x4C, 0xAF, 0xBE, 0x34, 0xC0, 0xAF, 0x57, 0x49, 
+0xA5, 0x6B, 0xB2, 0xA4, 0x48, 0x95, 0xF2, 0x04, 
+0x9F, 0xD5, 0xF3, 0x92, 0xEE, 0x2F, 0xC1, 0xBC, 
+0x42, 0x38, 0x51, 0x45, 0xEC, 0x94, 0xC3, 0xDE, 
+0xAF, 0xCE, 0x3C, 0xA5, 0x29, 0x5E, 0xD2, 0x40, 
+0x01, 0xF6, 0x84, 0xFC, 0xDA, 0xBF, 0x99, 0x9C, 
+0x32, 0x35, 0x5C, 0xA2, 0x39, 0x10, 0x36, 0x2D, 
+0x41, 0x07, 0xD1, 0xA1, 0x78, 0x28, 0x63, 0xE5, 
+
+0x88, 0xE0, 0xF3, 0x1B, 0x65, 0xD9, 0x5E, 0x3E, 
+0x69, 0xA0, 0x08, 0xAA, 0x05, 0x6E, 0xEE, 0xB4, 
+0xE1, 0x3B, 0x5A, 0xE1, 0xCC, 0xE5, 0x62, 0xB7, 
+0x88, 0xFA, 0x22, 0x25, 0x30, 0x28, 0x37, 0x5E, 
+0x70, 0xFE, 0xF7, 0xC0, 0x76, 0x94, 0xB3, 0x8C, 
+0x97, 0x6B, 0x31, 0x09, 0x21, 0x6D, 0xD5, 0xD5, 
+0x9C, 0x1E, 0xE8, 0x68, 0xCA, 0xE5, 0xFB, 0x03, 
+0xAF, 0x7F, 0x72, 0x25, 0x9E, 0xEB, 0x06, 0x69, 
+0x09, 0xDD, 0x55, 0x09, 0x68, 0xC7, 0x16, 0x38, 
+0x11, 0x77, 0x52, 0x91, 0xB1, 0xCA, 0x59, 0xA9, 
+0xB7, 0xF5, 0xB7, 0xE5, 0x1B, 0x27, 0x92, 0x9D, 
+0xAE, 0x7E, 0x6B, 0x03, 0xA9, 0x56, 0x5D, 0x50, 
+0xF1, 0xEA, 0x2C, 0x10, 0xD6, 0xC6, 0xF5, 0x03, 
+0x50, 0x8C, 0x01, 0x64, 0xAC, 0x37, 0xCB, 0x23, 
+0x63, 0x57, 0x1E, 0xEE, 0x2B, 0xA8, 0x00, 0x87, 
+0x93, 0x5A, 0x68, 0x04, 0x68, 0x68, 0x43, 0x08, 
+0x09, 0x60, 0xAD, 0xB8, 0xEA, 0x82, 0x43, 0x9F, 
+0xC9, 0x5F, 0xCF, 0x22, 0xD4, 0x9C, 0x6B, 0x5F, 
+0x46, 0xB3, 0x9A, 0x12, 0x72, 0x25, 0x36, 0x77, 
+0x55, 0xF3, 0x47, 0x6E, 0xC3, 0x0D, 0x80, 0xE2, 
+0xBD, 0xF2, 0x64, 0xC9, 0x9F, 0x50, 0x64, 0xE4, 
+0x01, 0xBF, 0xF0, 0x74, 0x09, 0x2B, 0xFE, 0x9C, 
+0x03, 0xE1, 0xB4, 0x43, 0x69, 0x04, 0x7D, 0x89, 
+0x20, 0xF5, 0x8A, 0x71, 0x51, 0x4C, 0x22, 0x82, 
+0x7B, 0x66, 0xD5, 0xD6, 0x88, 0x85, 0x96, 0xBC, 
+0x92, 0x97, 0xBB, 0x54, 0xAC, 0x8F, 0xA3, 0x70, 
+0x56, 
+
+0x4B, 0x43, 0xFC, 0xF9, 0x27, 0x36, 0x17, 0xFB, 
+0x94, 0x58, 0xA9, 0x88, 0xB0, 0xDA, 0x15, 0x71, 
+0xEE, 0x99, 0xBE, 0x60, 0x42, 0x00, 0x13, 0x1D, 
+0x93, 0x96, 0x5B, 0x5B, 0xAD, 0x43, 0x5A, 0xB0, 
+0x2C, 0xB7, 0x1C, 0x3A, 0xE9, 0x79, 0xE7, 0x55, 
+0x78, 0xC3, 0x4B, 0x9D, 0xDC, 0x39, 0x97, 0x3A, 
+0x92, 0x5E, 0xE8, 0x48, 0x06, 0xFC, 0x90, 0x2A, 
+0x57, 0x30, 0xD8, 0x6A, 0x35, 0x9C, 0x35, 0xBD, 
+0x96, 0x77, 0xFD, 0x39, 0x1F, 0x96, 0x76, 0xAE, 
+0xCA, 0xA4, 0x08, 0xFC, 0x7D, 0xFA, 0xAA, 0x0A, 
+0x7F, 0xE1, 0x5A, 0x26, 0x92, 0x98, 0x14, 0x24, 
+0xD8, 0xC5, 0xC8, 0x4E, 0xC3, 0xF7, 0x54, 0x62, 
+0xB1, 0x11, 0x53, 0xB6, 0x5A, 0x7D, 0xBB, 0xA6, 
+0x79, 0x31, 0x46, 0x77, 0x75, 0xCC, 0xA3, 0x0D, 
+0x85, 0xCD, 0xD6, 0xCC, 0x16, 0x49, 0xF0, 0x6A, 
+0xBB, 0x61, 0x9F, 0x54, 0x01, 0x92, 0xC2, 0x69, 
+0x0E, 0x70, 0x2D, 0xE1, 0x3E, 0x0C, 0x98, 0xC8, 
+0x45, 0xD3, 0xD7, 0x37, 0xFD, 0x65, 0xDF, 0x51, 
+0x97, 0x25, 0x04, 0xF3, 0x03, 0xC7, 0xCC, 0x28, 
+0x12, 0xF2, 0xB7, 0x6C, 0x42, 0x4C, 0xF4, 0xBC, 
+0x9C, 0x6F, 0xC8, 0x00, 0xE5, 0xE9, 0x7B, 0x4C, 
+0x3F, 0x33, 0xFA, 0xA5, 0x68, 0xA4, 0x0F, 0x1A, 
+0xF1, 0x26, 0xEC, 0x09, 0x0B, 0x14, 0x89, 0xFD, 
+0xA1, 0xEC, 0xE0, 0x84, 0x20, 0x06, 0x4F, 0xC5, 
+0x0F, 0xC8, 0x0D, 0xD8, 0x75, 0xE9, 0xCE, 0x9C, 
+0x77, 0xF1, 0x04, 0xD6, 0xFE, 0x1C, 0x8A, 0xE9, 
+0x92, 0xAB, 
+
+0x84, 0x1E, 0x80, 0x10, 0x1D, 0xE8, 0x8F, 0x8F, 
+0xED, 0x0F, 0x2B, 0xBB, 0xC6, 0x4C, 0x95, 0x31, 
+0x3F, 0x5D, 0x2B, 0x22, 0xB6, 0xE9, 0xD4, 0x40, 
+0xB3, 0xB7, 0xAC, 0xF8, 0x2C, 0xC7, 0x30, 0x76, 
+0x5B, 0xA4, 0xA6, 0x87, 0x18, 0x20, 0x1B, 0x59, 
+0xB8, 0xFF, 0x7B, 0xD3, 0x00, 0x33, 0xAF, 0x08, 
+0x84, 0x0C, 0x2F, 0xE7, 0x38, 0x44, 0xD1, 0xAC, 
+0x00, 0xE8, 0xFB, 0xB7, 0xCC, 0x3E, 0x4B, 0x1E, 
+0xE2, 0xFA, 0x66, 0xEE, 0xC0, 0x4F, 0x11, 0xF8, 
+0xD2, 0x97, 0x75, 0x28, 0xE7, 0x1B, 0x1A, 0xE1, 
+0xF6, 0x09, 0x14, 0xC4, 0x5F, 0x89, 0x5D, 0x31, 
+0x4B, 0x75, 0xFB, 0xD6, 0xDE, 0x8A, 0xE7, 0x0C, 
+0x8E, 0x41, 0xCB, 0x42, 0x3B, 0x93, 0x9B, 0xCE, 
+0x02, 0x5E, 0x2D, 0x6D, 0x38, 0xCD, 0x44, 0x35, 
+0x9D, 0xF6, 0xD2, 0x6C, 0x97, 0xDC, 0xE6, 0x11, 
+0x24, 0x3C, 0x3C, 0x6F, 0xBF, 0x39, 0xD6, 0x23, 
+0xB9, 0x04, 0x6D, 0x86, 0xB0, 0xE5, 0x32, 0xC5, 
+0x75, 0x35, 0x8B, 0x5C, 0x0D, 0x52, 0x83, 0x1E, 
+0x95, 0xEE, 0xDA, 0x3B, 0xA6, 0x0A, 0xA3, 0xFF, 
+0x45, 0x01, 0x11, 0xC6, 0x8D, 0xDE, 0x08, 0x7F, 
+0x16, 0x99, 0xD3, 0x4D, 0xBF, 0xFD, 0x75, 0x46, 
+0x3A, 0x32, 0xE0, 0xA3, 0x0E, 0x21, 0x9B, 0xFD, 
+0x11, 0xC8, 0x1A, 0xDD, 0xFD, 0x3C, 0x32, 0xE9, 
+0x19, 0xFC, 0x97, 0x71, 0xBD, 0x97, 0x84, 0x23, 
+0xCD, 0x01, 0x30, 0x14, 0x08, 0x2A, 0xEB, 0x7E, 
+0xCE, 0x4C, 0x9C, 0x48, 0xAB, 0xD5, 0x59, 0x35, 
+0xE2, 0x8C, 0xFB, 
+
+0x6C, 0x40, 0x84, 0x7A, 0xA5, 0xDF, 0x48, 0x87, 
+0x60, 0x88, 0xCF, 0x49, 0x28, 0xF4, 0x2D, 0x90, 
+0x4A, 0x9E, 0x36, 0x75, 0x1F, 0xEE, 0x2B, 0xC1, 
+0xE1, 0x22, 0xAB, 0x38, 0x89, 0x44, 0xC4, 0x1F, 
+0xB8, 0xE7, 0x50, 0x8E, 0xCF, 0x54, 0xA8, 0xA3, 
+0x3F, 0x96, 0xCF, 0x18, 0x3B, 0xB6, 0x6B, 0x61, 
+0x68, 0x46, 0x09, 0x08, 0x01, 0x9F, 0xA5, 0x73, 
+0x59, 0x39, 0x13, 0x32, 0x6B, 0xBB, 0xB2, 0x8F, 
+0x11, 0xD4, 0x63, 0x7C, 0x0D, 0xB5, 0xEC, 0xC3, 
+0x59, 0x1E, 0x85, 0x59, 0x61, 0x5E, 0x54, 0x6B, 
+0x80, 0x04, 0xD7, 0xB8, 0x27, 0x6A, 0x74, 0x48, 
+0xF1, 0x45, 0x1D, 0xBB, 0x1C, 0x43, 0x2F, 0x46, 
+0x73, 0xF5, 0x31, 0x78, 0xF1, 0xCA, 0x7F, 0xED, 
+0xEC, 0x5F, 0x23, 0xB5, 0xF1, 0x72, 0x7E, 0xF5, 
+0x48, 0x9E, 0x8E, 0x75, 0x7A, 0x22, 0x75, 0xA2, 
+0x4F, 0x3A, 0xDA, 0xD1, 0x11, 0x14, 0x9F, 0x2A, 
+0xFF, 0xA9, 0x74, 0x89, 0xD3, 0x24, 0x7A, 0x4F, 
+0x3B, 0x24, 0xBD, 0x78, 0xE9, 0x9C, 0xF6, 0x04, 
+0x06, 0x23, 0x42, 0xB6, 0xA3, 0x5F, 0x3C, 0xB6, 
+0xD1, 0xEE, 0x3A, 0xA9, 0x47, 0x70, 0xE4, 0xC4, 
+0x1F, 0x82, 0x2E, 0xB1, 0xF0, 0x5D, 0x0B, 0x93, 
+0x0F, 0xCE, 0xB3, 0x58, 0xE9, 0xD3, 0xC4, 0xD0, 
+0x7A, 0x9C, 0x27, 0x73, 0x95, 0xE5, 0x55, 0x5E, 
+0x78, 0xB9, 0xC5, 0x91, 0x2A, 0x8C, 0x01, 0x65, 
+0xEE, 0x20, 0x2C, 0x49, 0xFE, 0x53, 0x64, 0x6E, 
+0xD0, 0x27, 0xB8, 0x79, 0xB9, 0x4D, 0xE6, 0x72, 
+0x32, 0xD2, 0xFD, 0xB5, 
+
+0x6B, 0xBB, 0xFE, 0xD6, 0x63, 0xE8, 0xE0, 0xF9, 
+0x34, 0x60, 0x98, 0xD7, 0x83, 0x9F, 0xB3, 0x7E, 
+0x50, 0x4D, 0xA1, 0xBA, 0xC8, 0x96, 0x94, 0x8C, 
+0x65, 0x2D, 0xA4, 0xA3, 0xA8, 0x81, 0xC8, 0xAB, 
+0xD6, 0x95, 0x74, 0x84, 0xE5, 0x8F, 0xAF, 0x50, 
+0x1A, 0x37, 0x2B, 0x30, 0x9F, 0x03, 0x71, 0x16, 
+0xE2, 0xE6, 0x73, 0x71, 0xE2, 0x81, 0x87, 0xCB, 
+0xEA, 0x48, 0xF7, 0x8B, 0x7A, 0x9B, 0xA1, 0x2B, 
+0x02, 0xF6, 0x70, 0x5D, 0x10, 0x34, 0x32, 0x1E, 
+0x14, 0xA9, 0x25, 0xE4, 0xFE, 0xA5, 0x9F, 0xC1, 
+0x6C, 0xE6, 0x9A, 0x9A, 0x74, 0xA8, 0x92, 0xAE, 
+0xFD, 0x0D, 0xA5, 0x15, 0x24, 0xD8, 0xFC, 0xD8, 
+0x2D, 0x3E, 0x7A, 0xD0, 0xF8, 0x95, 0xEC, 0x61, 
+0x99, 0xF5, 0x3F, 0x92, 0x3C, 0xAC, 0xD5, 0x7F, 
+0x49, 0xA9, 0xEE, 0x99, 0xE0, 0x2E, 0x96, 0x3A, 
+0xF2, 0x61, 0xA8, 0x57, 0xA6, 0xB7, 0x93, 0x36, 
+0xC6, 0xE2, 0x4F, 0x1F, 0xA8, 0x2F, 0x0F, 0x94, 
+0x60, 0x2C, 0xA1, 0xB4, 0x01, 0x64, 0xCE, 0x3A, 
+0x03, 0xB9, 0x96, 0xC0, 0x81, 0x57, 0xD4, 0xF5, 
+0xDF, 0xF8, 0x4E, 0x4A, 0x26, 0x5A, 0x42, 0x06, 
+0xAD, 0x11, 0x12, 0x6A, 0xDF, 0xAB, 0x63, 0xF5, 
+0xE6, 0x5E, 0x85, 0x2E, 0xBF, 0x8B, 0x25, 0xE3, 
+0xCB, 0xE2, 0x89, 0xA0, 0x65, 0x44, 0x7C, 0x33, 
+0x98, 0xAD, 0xA8, 0xBA, 0x69, 0x66, 0x2B, 0xF4, 
+0xB4, 0xE3, 0x21, 0xEE, 0x7C, 0x7C, 0x42, 0x32, 
+0xF5, 0x93, 0x4E, 0x36, 0x04, 0x02, 0xA0, 0x08, 
+0x85, 0x1A, 0xB9, 0x01, 0x6B, 
+
+0x3D, 0x5B, 0xF9, 0x73, 0xA8, 0x25, 0x58, 0xD9, 
+0x62, 0xB6, 0x07, 0x41, 0x9C, 0xC7, 0x05, 0xEF, 
+0xD1, 0x06, 0x91, 0x04, 0x54, 0xB0, 0xBD, 0x61, 
+0xD7, 0x7A, 0xA6, 0xC7, 0xC3, 0xDE, 0x75, 0x27, 
+0x69, 0xE6, 0x90, 0xEC, 0xB1, 0x0E, 0x7D, 0x5F, 
+0x24, 0x52, 0x2F, 0x21, 0xB1, 0xD0, 0x20, 0xE5, 
+0x34, 0x27, 0x93, 0x7C, 0xBB, 0x32, 0x93, 0xC3, 
+0x04, 0x6E, 0x98, 0x9C, 0xED, 0x8A, 0x1C, 0xEE, 
+0x34, 0x6B, 0xB0, 0xFB, 0xAF, 0xEF, 0x97, 0xFA, 
+0x7F, 0x29, 0x5A, 0xCD, 0x19, 0x67, 0x12, 0x7B, 
+0xB1, 0xE2, 0x0E, 0x79, 0x10, 0xF6, 0xE3, 0x73, 
+0xE6, 0x73, 0xAD, 0x13, 0x17, 0x00, 0x99, 0x12, 
+0x7E, 0x83, 0x44, 0x61, 0xDA, 0xFC, 0x3B, 0x1B, 
+0x97, 0x6E, 0xCB, 0xB2, 0xFE, 0x34, 0xCF, 0x34, 
+0x3D, 0xCD, 0x27, 0x11, 0x3C, 0xF0, 0x9E, 0xDD, 
+0x43, 0x18, 0x95, 0x05, 0xE9, 0x77, 0xB2, 0xF3, 
+0xAE, 0xD9, 0xD3, 0x5E, 0x76, 0x9E, 0xCF, 0x6F, 
+0x9A, 0xF3, 0x38, 0x34, 0x5D, 0xD0, 0xE2, 0x9E, 
+0x54, 0x27, 0x79, 0x8E, 0x99, 0xEE, 0x3A, 0x25, 
+0xD5, 0x87, 0x0C, 0x48, 0x2B, 0x21, 0x5F, 0x39, 
+0x03, 0xC2, 0xBD, 0x24, 0x57, 0x40, 0x6E, 0x6F, 
+0x5D, 0x40, 0x6D, 0xB2, 0x57, 0x1C, 0x4B, 0x1F, 
+0x13, 0xB8, 0x2A, 0x42, 0x71, 0xCF, 0x79, 0x62, 
+0xB2, 0xB4, 0x5D, 0x62, 0x70, 0xCC, 0xB7, 0xA3, 
+0x35, 0xB4, 0xBE, 0x68, 0x33, 0x91, 0x40, 0x50, 
+0xEB, 0x02, 0xCB, 0x3E, 0xC8, 0x8C, 0x77, 0xF8, 
+0xAF, 0xFD, 0x7A, 0x3D, 0x69, 0x35, 
+
+0xD7, 0x0D, 0x80, 0xE9, 0x77, 0x4E, 0x81, 0x0E, 
+0x38, 0xEE, 0x4A, 0x63, 0x84, 0x5A, 0xDF, 0xB6, 
+0x6A, 0xD1, 0xFC, 0x3D, 0x43, 0x32, 0xA1, 0x74, 
+0x44, 0xE1, 0x5A, 0x80, 0x4F, 0x7C, 0x70, 0x8D, 
+0x14, 0xF5, 0x28, 0xFA, 0xFE, 0xDC, 0x1B, 0xA5, 
+0x08, 0x4B, 0x64, 0x77, 0x56, 0x47, 0x8F, 0x93, 
+0x0C, 0xCA, 0x24, 0xBD, 0x13, 0x9D, 0x9B, 0x42, 
+0x83, 0x8E, 0x22, 0x59, 0xE6, 0x0F, 0x4E, 0xD2, 
+0x9A, 0xB9, 0x30, 0xE2, 0xF0, 0xED, 0x8F, 0x39, 
+0xD3, 0x1B, 0xAA, 0x68, 0xD7, 0x14, 0xC8, 0xE8, 
+0xA4, 0x40, 0x93, 0xD2, 0xCC, 0xCB, 0xFC, 0x1D, 
+0x06, 0x11, 0x84, 0x9B, 0xE4, 0x2B, 0x50, 0x65, 
+0x0C, 0x60, 0xD7, 0xF3, 0xC9, 0xF5, 0xBC, 0x17, 
+0x51, 0x4D, 0xDE, 0x89, 0x58, 0xBE, 0xDB, 0x13, 
+0x6D, 0x8E, 0x7D, 0x33, 0x3E, 0x40, 0x70, 0x5A, 
+0x10, 0x5E, 0x84, 0xC7, 0x97, 0x53, 0x22, 0xE9, 
+0xFB, 0x4C, 0xCF, 0x23, 0x30, 0x22, 0xC0, 0xD0, 
+0xBD, 0x2F, 0x07, 0x65, 0x7A, 0xFD, 0x64, 0xBD, 
+0x00, 0x25, 0x7B, 0xE3, 0x1B, 0x8A, 0x97, 0x1D, 
+0x07, 0xC8, 0x17, 0x8E, 0x12, 0x78, 0x50, 0xBC, 
+0xA6, 0x46, 0xAB, 0x0B, 0x04, 0xEE, 0x39, 0x5F, 
+0x95, 0x11, 0x91, 0x04, 0xAB, 0xB7, 0x06, 0xAF, 
+0xF0, 0x2A, 0xA1, 0x51, 0x6A, 0x87, 0x60, 0x5C, 
+0xB2, 0x9A, 0xBC, 0x33, 0x81, 0xB9, 0x2F, 0x26, 
+0x0A, 0x44, 0x79, 0xCE, 0x21, 0x60, 0xF0, 0xD2, 
+0xF9, 0x06, 0x06, 0x5F, 0x9F, 0xE4, 0x1D, 0x89, 
+0xAA, 0x97, 0x7B, 0x34, 0x2D, 0xF6, 0xC3, 
+
+0xBD, 0x74, 0xC9, 0x4D, 0x92, 0xCE, 0x73, 0x93, 
+0x8B, 0xF8, 0x9B, 0x26, 0x45, 0x60, 0xDD, 0xD7, 
+0xCA, 0x4F, 0xF5, 0xB6, 0x7F, 0x00, 0x4B, 0xB5, 
+0xBA, 0x9B, 0x76, 0x57, 0x1F, 0x4B, 0xC4, 0xF5, 
+0x78, 0x8B, 0x44, 0xB7, 0xAF, 0xCE, 0xBA, 0x7C, 
+0x02, 0x70, 0xCB, 0x41, 0xEC, 0xF9, 0xB4, 0x9D, 
+0xD9, 0xED, 0xE0, 0xE3, 0x5A, 0x7C, 0x2E, 0xE2, 
+0x79, 0x59, 0xE8, 0x30, 0x97, 0x34, 0x2C, 0xB7, 
+0xF2, 0x9F, 0x46, 0x28, 0x16, 0xBB, 0xF3, 0x35, 
+0x3A, 0x7B, 0xD8, 0xF8, 0xAF, 0xAD, 0x8C, 0xC9, 
+0x20, 0x21, 0xF0, 0xFC, 0xE3, 0x47, 0xD3, 0xE8, 
+0x37, 0x6E, 0xC8, 0x66, 0x6B, 0xF7, 0x80, 0x2A, 
+0xFC, 0xE9, 0xEA, 0xF7, 0xEB, 0x4C, 0xA6, 0xB4, 
+0x01, 0xB1, 0x86, 0x8C, 0x21, 0x39, 0x33, 0x63, 
+0x3C, 0x58, 0x59, 0x73, 0x4B, 0xB5, 0x6A, 0x2A, 
+0xE3, 0xB9, 0x70, 0x3A, 0x0A, 0x39, 0xE0, 0xF4, 
+0xA2, 0xAA, 0x29, 0x87, 0xA9, 0xE8, 0x80, 0xC1, 
+0xA2, 0x36, 0xD4, 0xFB, 0xF7, 0xDA, 0xD7, 0x5E, 
+0x93, 0x24, 0xE9, 0x32, 0x83, 0x34, 0x13, 0x5E, 
+0x01, 0x15, 0xA7, 0x2C, 0xB7, 0x7B, 0x0C, 0xFC, 
+0x28, 0x43, 0x2F, 0xA1, 0x46, 0xB7, 0xDE, 0x33, 
+0xA9, 0x47, 0x48, 0x3F, 0xF7, 0xB4, 0xC4, 0x6B, 
+0x3F, 0xE5, 0x27, 0x9B, 0x73, 0x23, 0x86, 0x12, 
+0x46, 0xD6, 0x9A, 0xF5, 0x01, 0x55, 0xD9, 0xC8, 
+0x82, 0x78, 0x27, 0x64, 0x9B, 0x66, 0x3C, 0x28, 
+0x59, 0xE1, 0x3E, 0xCC, 0x5B, 0xDE, 0x1F, 0xEF, 
+0xB1, 0xAA, 0xDA, 0x4E, 0x96, 0x11, 0xCB, 0x5E, 
+
+0xDF, 0x57, 0xAB, 0x50, 0xC1, 0x79, 0x48, 0xF5, 
+0x44, 0x81, 0x06, 0x3B, 0x17, 0xAF, 0x34, 0xBD, 
+0xEA, 0xFF, 0x5A, 0xD2, 0xFC, 0x6D, 0x02, 0x4A, 
+0x2A, 0x02, 0x4F, 0x1A, 0x85, 0xBF, 0x0A, 0x57, 
+0x79, 0xF4, 0xCF, 0x97, 0x93, 0x99, 0xDC, 0x8C, 
+0x2E, 0x8C, 0x2C, 0x61, 0x2E, 0x52, 0xF2, 0xB6, 
+0x6B, 0xCD, 0xD2, 0x30, 0xFC, 0x71, 0xCA, 0xB4, 
+0x98, 0xA2, 0x3E, 0x39, 0x57, 0x66, 0x13, 0xFA, 
+0x19, 0x6D, 0x29, 0x6B, 0x3A, 0xF0, 0x55, 0x71, 
+0x85, 0x5A, 0xC9, 0x97, 0x3C, 0x49, 0xB3, 0x4E, 
+0xAE, 0x72, 0x00, 0xCE, 0x7B, 0xB2, 0x36, 0x3A, 
+0x05, 0xB0, 0x54, 0x51, 0x8C, 0x11, 0x10, 0x9A, 
+0x26, 0xA7, 0x57, 0xAF, 0xE4, 0x08, 0xF0, 0x7A, 
+0xC2, 0xC1, 0x8B, 0x94, 0x80, 0xE4, 0x48, 0x69, 
+0x35, 0xE9, 0xC4, 0x85, 0x21, 0x6B, 0xC2, 0x86, 
+0x23, 0xF1, 0x21, 0xC8, 0xAB, 0x23, 0x97, 0xCB, 
+0x59, 0x5C, 0x75, 0xA6, 0xC0, 0xDF, 0xAA, 0x63, 
+0x10, 0x5C, 0xB4, 0xA9, 0x8E, 0x9E, 0xA7, 0xA4, 
+0xF8, 0x84, 0xEE, 0x3F, 0xFF, 0x39, 0xDD, 0x54, 
+0x34, 0xA9, 0x56, 0xC5, 0xBA, 0xF1, 0xB1, 0xC2, 
+0xA1, 0xAF, 0x13, 0x60, 0x29, 0x80, 0x0D, 0x5D, 
+0xBD, 0x56, 0x51, 0xF4, 0xDA, 0x01, 0xB0, 0x46, 
+0xE8, 0xED, 0xE6, 0xE4, 0x0F, 0x34, 0x93, 0x0A, 
+0xD1, 0xD1, 0x40, 0x86, 0xD8, 0x81, 0x7B, 0xF5, 
+0xA3, 0xEF, 0xBA, 0x0E, 0x8E, 0x2A, 0xE6, 0x66, 
+0x92, 0x41, 0x54, 0x41, 0x7B, 0xF2, 0xCC, 0x9A, 
+0x75, 0x8B, 0xE6, 0x07, 0x0A, 0xCE, 0x71, 0x4B, 
+0xD7, 
+
+0x67, 0xBC, 0x2D, 0xD5, 0x36, 0xA0, 0xD7, 0x7E, 
+0x34, 0x21, 0x83, 0x1C, 0x35, 0x21, 0x70, 0x72, 
+0xA6, 0x55, 0x38, 0x81, 0x44, 0x44, 0x8F, 0x10, 
+0xE2, 0x71, 0x5C, 0x3D, 0x88, 0xE0, 0x4C, 0xA4, 
+0x58, 0x19, 0x3F, 0x69, 0x58, 0x7C, 0x71, 0xBD, 
+0x81, 0xCE, 0xA2, 0x49, 0xCD, 0xBD, 0xE9, 0x86, 
+0x5C, 0xED, 0xD1, 0xAE, 0x4D, 0xF7, 0x16, 0x65, 
+0x1E, 0xF1, 0xD7, 0x7E, 0xF9, 0x71, 0x2E, 0x07, 
+0x84, 0x43, 0x93, 0x49, 0x47, 0xC9, 0x27, 0xBC, 
+0xE8, 0xFB, 0xA9, 0x72, 0x94, 0x2D, 0xBC, 0x73, 
+0x29, 0x52, 0xD3, 0xF4, 0xCA, 0x6E, 0x5C, 0x38, 
+0x39, 0x24, 0x85, 0x00, 0xE5, 0x12, 0xA1, 0x8B, 
+0xCD, 0x0A, 0x89, 0x9D, 0xD3, 0x23, 0x0D, 0x24, 
+0x74, 0xEF, 0x16, 0x7F, 0x20, 0xDD, 0x0B, 0x69, 
+0x5E, 0x3A, 0xAA, 0xC3, 0x07, 0x5F, 0x6A, 0x4F, 
+0xC6, 0xCD, 0x1D, 0x2A, 0x1E, 0xD0, 0x85, 0xDF, 
+0xB5, 0xF7, 0x20, 0x88, 0x98, 0xD9, 0x28, 0x14, 
+0x7D, 0x5F, 0x29, 0x32, 0x2E, 0xC2, 0x91, 0x0A, 
+0x15, 0xD9, 0x2E, 0x3D, 0x55, 0x3D, 0xED, 0xF1, 
+0xCA, 0x7A, 0x35, 0x87, 0x65, 0x96, 0x2A, 0x29, 
+0x76, 0xBF, 0x02, 0x57, 0x70, 0x09, 0x7C, 0x10, 
+0x7B, 0x5C, 0x3F, 0x5A, 0x3E, 0x50, 0x42, 0x80, 
+0x4D, 0x6D, 0x78, 0x6C, 0xAD, 0xE7, 0xAF, 0x13, 
+0x65, 0x8F, 0xED, 0x66, 0x2F, 0x84, 0x54, 0xD9, 
+0x6A, 0x03, 0x32, 0xB2, 0x90, 0x50, 0x7E, 0xF8, 
+0xB7, 0x4A, 0x96, 0x62, 0x84, 0x81, 0x02, 0xBE, 
+0xC7, 0x88, 0xDE, 0xD4, 0x3C, 0x87, 0x81, 0xB4, 
+0x3E, 0x75, 
+
+0x72, 0x9E, 0xC8, 0x3F, 0x11, 0x69, 0xC3, 0x81, 
+0x20, 0x19, 0xF9, 0x45, 0x81, 0xC2, 0x3A, 0xF5, 
+0x59, 0xF8, 0xB0, 0x4C, 0x3D, 0xF9, 0x51, 0x8A, 
+0x7D, 0x3F, 0xAF, 0x4B, 0x3F, 0xF1, 0xB4, 0x8D, 
+0xCF, 0x96, 0x10, 0x43, 0x52, 0xF3, 0xE2, 0xC1, 
+0x27, 0x89, 0x7E, 0x99, 0xFA, 0xF6, 0x40, 0x32, 
+0xAC, 0xDE, 0x27, 0xD2, 0x24, 0xEC, 0xFA, 0x44, 
+0x09, 0x24, 0x3A, 0x00, 0xEF, 0xF0, 0x00, 0xEF, 
+0x8F, 0x02, 0x97, 0xDC, 0xE6, 0xD2, 0xF7, 0x0F, 
+0x86, 0xC3, 0x94, 0x52, 0x34, 0x42, 0xE8, 0x39, 
+0xC7, 0xE7, 0x4A, 0xCE, 0x59, 0xFF, 0x2C, 0x53, 
+0xB0, 0x7D, 0xB2, 0x54, 0x36, 0xEC, 0x3A, 0x20, 
+0x19, 0x95, 0x08, 0x20, 0x88, 0xA9, 0x47, 0xC6, 
+0x5F, 0xC7, 0xFA, 0xB1, 0x81, 0xC0, 0xC2, 0xCB, 
+0xE4, 0x1E, 0x44, 0xDD, 0x3D, 0x18, 0x5B, 0x79, 
+0x7C, 0x80, 0x3A, 0x14, 0x3F, 0xE6, 0x09, 0xE6, 
+0xD7, 0xF9, 0x0C, 0x23, 0x0C, 0xEF, 0xB1, 0x63, 
+0x47, 0x9A, 0x9B, 0x91, 0x69, 0xC1, 0xC7, 0xA8, 
+0x69, 0x12, 0xEC, 0xBF, 0xFB, 0xDE, 0x58, 0x69, 
+0x75, 0xDC, 0xA4, 0xAB, 0x88, 0xAA, 0x36, 0xB9, 
+0x8F, 0xD6, 0x6E, 0xDC, 0xD8, 0x71, 0x49, 0xDE, 
+0xB0, 0xF0, 0x18, 0x80, 0xDC, 0xB5, 0x66, 0xB4, 
+0xDD, 0xDA, 0xC9, 0x2D, 0xEE, 0x34, 0x2A, 0x87, 
+0x99, 0x1B, 0x45, 0x4B, 0x2C, 0x15, 0x03, 0x20, 
+0x59, 0x15, 0x58, 0x6F, 0x5F, 0xEF, 0x6E, 0x4B, 
+0x26, 0xC3, 0xDF, 0xB8, 0x29, 0x38, 0x51, 0x8A, 
+0x25, 0x69, 0x20, 0xAD, 0xAD, 0xFC, 0xB9, 0x1E, 
+0x9E, 0x1A, 0x52, 
+
+0x08, 0x8C, 0xCE, 0x8A, 0x1C, 0x5D, 0x77, 0x08, 
+0xF9, 0x86, 0x14, 0x78, 0x46, 0x61, 0x6B, 0x3F, 
+0x7B, 0xD7, 0x32, 0xBD, 0xBA, 0x43, 0x0B, 0xC6, 
+0x52, 0xEF, 0x5E, 0xB6, 0x5C, 0x05, 0xDA, 0x14, 
+0x14, 0x8B, 0xAC, 0xAA, 0xA6, 0x62, 0x7E, 0x81, 
+0x26, 0xB1, 0xFD, 0x9E, 0xCC, 0x65, 0xA3, 0x75, 
+0x8C, 0xAC, 0x95, 0xF8, 0x17, 0xAE, 0xB2, 0x24, 
+0xE3, 0x93, 0x8D, 0xFE, 0x3B, 0xC5, 0x3A, 0x2D, 
+0x25, 0x69, 0x38, 0x90, 0x75, 0xD4, 0x80, 0xA5, 
+0xDB, 0x20, 0xB8, 0xD9, 0x0B, 0x4F, 0x6A, 0x85, 
+0xFD, 0xD9, 0x3C, 0x57, 0x44, 0xA8, 0x34, 0x1F, 
+0x43, 0x22, 0xD5, 0x91, 0xC5, 0xCC, 0x38, 0xFF, 
+0x18, 0x05, 0x6E, 0xF7, 0xA2, 0x75, 0x79, 0x87, 
+0x3C, 0xC4, 0x59, 0xDC, 0x22, 0x94, 0xE5, 0x9C, 
+0x7B, 0x0D, 0x2B, 0x12, 0x9C, 0xE9, 0x2E, 0x11, 
+0xA8, 0xFF, 0xE3, 0xCD, 0x65, 0x38, 0xC3, 0xBC, 
+0xA4, 0x28, 0x49, 0x89, 0x73, 0xE7, 0x01, 0xF9, 
+0x54, 0x21, 0x19, 0xFA, 0x7A, 0xC8, 0x99, 0x5B, 
+0xAA, 0x22, 0x79, 0x66, 0x34, 0x2A, 0x14, 0x43, 
+0x85, 0x16, 0x79, 0xC1, 0x88, 0xA2, 0xA6, 0x91, 
+0x6D, 0xC0, 0x10, 0xFF, 0x83, 0xA7, 0x52, 0xEC, 
+0x59, 0x35, 0xE6, 0x89, 0x33, 0x74, 0x1E, 0x07, 
+0x38, 0xA1, 0x03, 0xB2, 0x12, 0x8B, 0xA9, 0xCF, 
+0x84, 0x7D, 0x5C, 0xDA, 0x61, 0xBE, 0x85, 0x18, 
+0x6D, 0x3B, 0xF9, 0x44, 0xE6, 0xC7, 0x30, 0x05, 
+0x9C, 0xFC, 0xF9, 0xA0, 0xEF, 0x85, 0xF6, 0x9A, 
+0xE4, 0x68, 0xEC, 0x0F, 0xD9, 0xC0, 0x5E, 0x21, 
+0x89, 0xB3, 0x81, 0xE0, 
+
+0x0B, 0xC1, 0x97, 0x1D, 0xD7, 0x10, 0x27, 0x9D, 
+0xE6, 0x18, 0xF9, 0x54, 0xF6, 0xD1, 0x27, 0xCE, 
+0x8E, 0x22, 0x06, 0xBB, 0xFE, 0x0D, 0x7E, 0x3C, 
+0x12, 0x41, 0x1F, 0x35, 0xB8, 0x08, 0x33, 0x0D, 
+0x39, 0x90, 0x39, 0xE7, 0x39, 0x3E, 0x85, 0x31, 
+0xBA, 0xC1, 0x16, 0x87, 0x86, 0x8F, 0x59, 0xD8, 
+0xB3, 0x33, 0x5C, 0x33, 0xCE, 0x21, 0x10, 0x5B, 
+0x75, 0xD4, 0x58, 0x14, 0x9B, 0x1A, 0xBC, 0xD4, 
+0xA3, 0x9D, 0x0E, 0xFC, 0x1E, 0xAB, 0x01, 0x93, 
+0x8E, 0x38, 0xE4, 0x60, 0xFA, 0x68, 0xA9, 0x06, 
+0x91, 0x7B, 0xC6, 0xBA, 0x5D, 0xB5, 0x65, 0x12, 
+0x52, 0x4F, 0xED, 0x17, 0x12, 0xBE, 0x35, 0xEC, 
+0x76, 0x3B, 0xCA, 0x52, 0x33, 0x9E, 0x65, 0x03, 
+0x27, 0x66, 0x90, 0xEB, 0x7A, 0x1C, 0x78, 0xF3, 
+0xC9, 0x21, 0xC6, 0x26, 0xA9, 0x08, 0x9F, 0x41, 
+0x32, 0xCC, 0xE7, 0xC1, 0x70, 0x49, 0xA5, 0xFC, 
+0x89, 0xE5, 0xD0, 0x23, 0x24, 0x0C, 0x84, 0xC3, 
+0xB0, 0x9A, 0x16, 0x19, 0xAF, 0x18, 0xAF, 0xA0, 
+0x3F, 0x7A, 0xF1, 0xDD, 0xDC, 0x49, 0x43, 0x25, 
+0x1A, 0x68, 0x8E, 0xA7, 0x56, 0x90, 0xB4, 0x0D, 
+0x74, 0xBC, 0x50, 0x89, 0xDA, 0x45, 0xFA, 0xC7, 
+0xB7, 0x3D, 0x01, 0x9A, 0x99, 0x00, 0xC2, 0x25, 
+0x38, 0x30, 0x17, 0x80, 0x2A, 0x8B, 0x5E, 0xE0, 
+0x58, 0xA6, 0x80, 0x91, 0x02, 0x25, 0xA0, 0x23, 
+0x64, 0xEF, 0x6E, 0x92, 0x65, 0x78, 0x0D, 0x58, 
+0x3C, 0xDF, 0x50, 0xC9, 0x83, 0x23, 0x77, 0x2A, 
+0xC2, 0x42, 0x2F, 0x94, 0xF4, 0xD7, 0x10, 0x4E, 
+0x03, 0x5F, 0xAB, 0x50, 0x95, 
+
+0xD8, 0x5C, 0xAD, 0x34, 0xE4, 0x86, 0xC0, 0x91, 
+0xBB, 0xF6, 0x1C, 0x70, 0x2B, 0x75, 0x4D, 0x3A, 
+0xCE, 0x86, 0x38, 0x1E, 0xCF, 0x53, 0x2D, 0xF4, 
+0x8C, 0x76, 0x91, 0x90, 0x87, 0xA9, 0xF8, 0x4B, 
+0x4D, 0x73, 0xA8, 0x8F, 0x1E, 0xBD, 0x80, 0x4C, 
+0x82, 0xFC, 0xED, 0x32, 0x3E, 0x79, 0x3A, 0x21, 
+0x27, 0x8A, 0xA6, 0xCE, 0x85, 0xE1, 0xF1, 0xE6, 
+0xF5, 0x1B, 0x8A, 0x5B, 0x10, 0x4E, 0xFE, 0x5E, 
+0x1D, 0x04, 0x23, 0x3E, 0x2E, 0x39, 0x74, 0xA0, 
+0xA4, 0x58, 0xA2, 0x36, 0x78, 0x16, 0x34, 0xF1, 
+0x7A, 0x9E, 0xE7, 0x7C, 0x50, 0x10, 0x06, 0x81, 
+0x8A, 0x25, 0xF0, 0xB7, 0x63, 0x5F, 0xB9, 0xA8, 
+0xF0, 0x78, 0x56, 0x99, 0xFF, 0x43, 0xFC, 0x7B, 
+0x24, 0xF2, 0x14, 0x80, 0x25, 0x69, 0x91, 0xFB, 
+0xFA, 0x90, 0x29, 0x26, 0x94, 0x5B, 0x0F, 0x72, 
+0x68, 0x90, 0xB4, 0xF3, 0xD2, 0x3E, 0x5B, 0xAD, 
+0xF8, 0x08, 0x9B, 0xF6, 0x31, 0xAF, 0xD8, 0x75, 
+0xC4, 0x66, 0x81, 0xF9, 0xC2, 0x57, 0x92, 0x0A, 
+0x63, 0x2E, 0x47, 0xB3, 0x24, 0x47, 0x8C, 0x9F, 
+0xC9, 0x94, 0x1C, 0x4E, 0xBD, 0x6F, 0x53, 0x01, 
+0xA4, 0xC9, 0x07, 0x0E, 0xBB, 0x6D, 0x42, 0x15, 
+0x9F, 0xB0, 0xE1, 0x95, 0x06, 0x08, 0x08, 0xB3, 
+0xD3, 0xDF, 0x98, 0xCA, 0xE2, 0xBA, 0xA1, 0x3A, 
+0x4D, 0x8D, 0x34, 0xE5, 0x7A, 0xBE, 0x23, 0xEC, 
+0xA2, 0xF8, 0xF3, 0x57, 0x44, 0x11, 0x2A, 0x81, 
+0x89, 0x47, 0x1A, 0xD2, 0x11, 0x62, 0x43, 0xCC, 
+0x72, 0xAA, 0xE7, 0x4D, 0x32, 0x74, 0x4A, 0xF5, 
+0x5A, 0x96, 0x54, 0x77, 0x72, 0xFE, 
+
+0x0A, 0x3E, 0x0D, 0x8A, 0x8D, 0xDE, 0x66, 0xAB, 
+0x18, 0x43, 0xE0, 0x77, 0x43, 0x93, 0xB6, 0x4A, 
+0x9E, 0x2B, 0x61, 0x0F, 0x35, 0xBE, 0x0A, 0x79, 
+0x79, 0x10, 0x2E, 0x61, 0xA8, 0x87, 0x09, 0xEC, 
+0x19, 0x11, 0x76, 0xF7, 0x72, 0x97, 0x07, 0x21, 
+0x6C, 0x09, 0x88, 0x22, 0xBE, 0x48, 0x25, 0x08, 
+0x97, 0x55, 0x82, 0x3D, 0x46, 0xBA, 0x04, 0xE3, 
+0x61, 0x61, 0x4B, 0x8A, 0x18, 0x4C, 0xC1, 0x4D, 
+0x80, 0x65, 0x4A, 0x8A, 0xBA, 0x80, 0x1D, 0x3D, 
+0xE6, 0x30, 0x33, 0x0F, 0x5F, 0x7E, 0xB7, 0x74, 
+0xD5, 0x67, 0x42, 0x36, 0x9D, 0x16, 0xDB, 0x55, 
+0xBA, 0x0D, 0x0A, 0x4C, 0x79, 0x2F, 0x75, 0x80, 
+0xF5, 0x08, 0xD3, 0xC4, 0x6B, 0x8F, 0x52, 0x0D, 
+0x9C, 0x17, 0x9B, 0x41, 0xF7, 0x69, 0x90, 0x3A, 
+0x1A, 0x36, 0x91, 0xB8, 0xEA, 0x28, 0xCA, 0xAB, 
+0x94, 0x31, 0x6C, 0x83, 0x07, 0x45, 0x3C, 0x36, 
+0x4C, 0x1B, 0xC2, 0x7B, 0x85, 0x93, 0xE5, 0xC5, 
+0xFA, 0x68, 0xCA, 0xC9, 0xB0, 0x93, 0x45, 0x30, 
+0xA7, 0xE8, 0xE9, 0x13, 0x96, 0xE1, 0x55, 0x6C, 
+0x97, 0x32, 0xEB, 0x36, 0x68, 0x47, 0xAF, 0xF2, 
+0x21, 0x09, 0x8B, 0x13, 0x94, 0x93, 0xCD, 0x77, 
+0x17, 0x86, 0x46, 0x25, 0xB0, 0x33, 0xD6, 0x0E, 
+0xA9, 0xE6, 0xD6, 0x85, 0xE6, 0xCC, 0xE7, 0xA6, 
+0x34, 0xF9, 0x9B, 0x2D, 0x28, 0xA4, 0xAA, 0x77, 
+0x15, 0xA2, 0x57, 0xF2, 0x83, 0x8C, 0xFA, 0x58, 
+0x3E, 0xEC, 0xFF, 0x6F, 0xD8, 0x08, 0x76, 0x91, 
+0xCD, 0x72, 0x08, 0x1C, 0x86, 0x85, 0x3D, 0xAD, 
+0xB6, 0xA8, 0x0C, 0x93, 0x4A, 0x66, 0x9E, 
+
+0xAC, 0x39, 0xAB, 0x56, 0x2B, 0xC9, 0x63, 0x58, 
+0xE0, 0xB7, 0x50, 0xE4, 0xDA, 0xCD, 0xEE, 0x9E, 
+0x3E, 0x1B, 0x6A, 0xD9, 0x44, 0x9F, 0xB6, 0x0D, 
+0xAE, 0xC4, 0x1D, 0xBA, 0xA1, 0x97, 0x4B, 0x7C, 
+0x40, 0x31, 0x8F, 0xD5, 0x84, 0x17, 0x9D, 0xF8, 
+0xC5, 0x2F, 0xED, 0x3B, 0x05, 0xC5, 0xFE, 0x7D, 
+0xCC, 0xB5, 0x4D, 0x37, 0x79, 0x8F, 0x3D, 0x9A, 
+0xE5, 0x65, 0xFB, 0x51, 0x0D, 0x53, 0xD0, 0xF4, 
+0x50, 0xD0, 0x95, 0x95, 0x69, 0x4B, 0x09, 0x15, 
+0xFB, 0x54, 0x80, 0xF7, 0x04, 0xCA, 0x59, 0x91, 
+0x36, 0xB5, 0x66, 0xB1, 0x1C, 0xC7, 0x87, 0x71, 
+0x24, 0x87, 0x58, 0xAD, 0x25, 0x57, 0x14, 0x15, 
+0x47, 0x6B, 0x6F, 0xBF, 0xE9, 0xCF, 0x6F, 0xB8, 
+0x61, 0xFD, 0x65, 0x94, 0x26, 0x4B, 0xF3, 0x5C, 
+0x0F, 0x86, 0x79, 0xAD, 0xBC, 0x72, 0xFE, 0x0C, 
+0x8F, 0xB4, 0x50, 0x70, 0xDD, 0xC4, 0x7E, 0xCA, 
+0xB0, 0x68, 0xCC, 0xB1, 0x5A, 0xAB, 0x2A, 0xDB, 
+0xC9, 0xD6, 0x87, 0x44, 0xAD, 0x83, 0x77, 0x4B, 
+0x96, 0xC5, 0xB3, 0x18, 0x64, 0xA3, 0x2D, 0x1A, 
+0x23, 0x78, 0xF0, 0xD4, 0x11, 0xF5, 0xAC, 0xA2, 
+0x23, 0x12, 0x1E, 0x1D, 0x38, 0xCD, 0x8E, 0xF0, 
+0x34, 0xED, 0xDA, 0xB2, 0x8A, 0x11, 0xB6, 0xAA, 
+0x5B, 0x15, 0xBD, 0xD2, 0xA5, 0xF6, 0xE7, 0xD7, 
+0xFE, 0xC8, 0x6F, 0xFF, 0x49, 0x82, 0x4D, 0x52, 
+0x2A, 0x3A, 0xF5, 0x29, 0x1E, 0x0E, 0xFC, 0x4F, 
+0x7D, 0xFF, 0xE8, 0xC4, 0x4D, 0x8C, 0x49, 0x6C, 
+0xF2, 0x5C, 0xCA, 0xD9, 0xB0, 0xD0, 0xE2, 0xE0, 
+0x9F, 0x64, 0xA1, 0x41, 0x90, 0x00, 0x42, 0xE4, 
+
+0x82, 0x5A, 0xEA, 0x00, 0x20, 0xCE, 0x2A, 0xEE, 
+0xD2, 0x57, 0x11, 0x93, 0x71, 0xF7, 0xA0, 0xFB, 
+0xE2, 0xA3, 0x41, 0x1B, 0xD6, 0x5C, 0x78, 0x39, 
+0xAA, 0xD1, 0xD6, 0x8D, 0x48, 0x42, 0xAF, 0x06, 
+0x1F, 0x65, 0x39, 0x9C, 0x6F, 0x27, 0xE4, 0xD7, 
+0x90, 0xA7, 0xC6, 0x9B, 0x68, 0x0B, 0x66, 0x02, 
+0xFB, 0xF7, 0x2C, 0x10, 0xFB, 0xC1, 0x24, 0xE7, 
+0x74, 0xCF, 0x9B, 0xD7, 0x00, 0x2B, 0x5E, 0xC0, 
+0x1E, 0x3D, 0x73, 0xF1, 0x6F, 0x70, 0xD9, 0x9F, 
+0x30, 0xA0, 0x8E, 0x57, 0x99, 0x4C, 0x99, 0x3A, 
+0x88, 0x1A, 0x88, 0xDA, 0x83, 0xD0, 0xAE, 0xBA, 
+0x04, 0x47, 0x6C, 0xAA, 0xA9, 0xA8, 0xCA, 0x12, 
+0x8F, 0x2A, 0x24, 0x47, 0x45, 0x0D, 0xF1, 0x42, 
+0x9C, 0x24, 0x82, 0x63, 0x45, 0xD9, 0xE5, 0xBF, 
+0x66, 0x60, 0x5E, 0x1D, 0x2A, 0x00, 0x68, 0xED, 
+0x1E, 0x39, 0xD7, 0x84, 0xCF, 0x3C, 0x34, 0x3D, 
+0x82, 0xC2, 0xB6, 0x33, 0xD5, 0xB2, 0xCF, 0x9A, 
+0xB6, 0x43, 0x20, 0x88, 0xD2, 0x51, 0x66, 0x7A, 
+0xB4, 0x56, 0x43, 0x62, 0x50, 0x32, 0xFB, 0x12, 
+0xC4, 0xF0, 0x23, 0x4A, 0xBF, 0x6D, 0xCA, 0xDF, 
+0xAA, 0x88, 0xF1, 0x27, 0x08, 0xB2, 0x36, 0x54, 
+0x8F, 0xD0, 0x1C, 0x2A, 0x66, 0xCB, 0x70, 0xD4, 
+0xFA, 0x08, 0xA7, 0x80, 0xD3, 0x74, 0x71, 0x12, 
+0x4F, 0x33, 0x94, 0xD0, 0xFE, 0x6A, 0x67, 0x95, 
+0xED, 0xD0, 0x62, 0xF4, 0x6A, 0xD4, 0x0D, 0xA4, 
+0x64, 0xA0, 0xB4, 0x74, 0x74, 0x3F, 0xD0, 0xE1, 
+0x98, 0x35, 0xCA, 0x4E, 0xBC, 0xF1, 0xA9, 0xAF, 
+0xED, 0xF2, 0x62, 0x7F, 0x14, 0xDB, 0xA0, 0x50, 
+0xBB, 
+
+0x46, 0xB0, 0xD6, 0x67, 0xDD, 0x44, 0xA8, 0x73, 
+0x10, 0x33, 0xC0, 0x92, 0xA7, 0xA0, 0x21, 0xC3, 
+0x92, 0xA0, 0x31, 0xCB, 0x7B, 0x99, 0xE7, 0x65, 
+0x36, 0x31, 0xA7, 0xED, 0x9C, 0x25, 0xB1, 0x7A, 
+0xCF, 0x65, 0x0A, 0xBE, 0xBF, 0x1B, 0x23, 0x9A, 
+0x8F, 0x75, 0x97, 0xFC, 0x88, 0xC6, 0xC1, 0x8A, 
+0x3C, 0x31, 0x1B, 0xF8, 0xDC, 0xB3, 0x03, 0x94, 
+0x47, 0xD6, 0xE9, 0xF0, 0xD6, 0x0E, 0x6C, 0x5C, 
+0xE6, 0xD5, 0xCA, 0x4A, 0xB0, 0x96, 0xD5, 0xDD, 
+0x73, 0x26, 0x07, 0x05, 0x11, 0x2E, 0x0F, 0x38, 
+0xFF, 0x0C, 0x8C, 0x7C, 0xC4, 0x69, 0xBE, 0x80, 
+0x79, 0xA3, 0x15, 0xF4, 0x5A, 0x4A, 0xCF, 0x38, 
+0x5A, 0x74, 0x80, 0xB2, 0xB5, 0x32, 0xD8, 0x6F, 
+0x25, 0x70, 0xE8, 0xC0, 0x04, 0xD2, 0x36, 0x5A, 
+0x7D, 0x59, 0xD9, 0x77, 0x75, 0x5C, 0x6F, 0x3A, 
+0x0E, 0x56, 0x48, 0x87, 0x5E, 0xB3, 0xA0, 0x6C, 
+0x2C, 0x61, 0x18, 0xA2, 0x36, 0xB5, 0x29, 0xAA, 
+0xAC, 0x95, 0x40, 0x2E, 0xB8, 0x6E, 0x16, 0x3E, 
+0x78, 0x7F, 0x24, 0x72, 0x42, 0x9A, 0x8B, 0xA5, 
+0x84, 0x9F, 0xC3, 0xE7, 0xA0, 0xC4, 0xA0, 0xE5, 
+0x7C, 0x61, 0x5F, 0xE6, 0xC3, 0x2D, 0xFF, 0x9D, 
+0xF8, 0x51, 0x97, 0xAA, 0x58, 0x62, 0x46, 0x26, 
+0x93, 0xE0, 0x59, 0x85, 0x4A, 0x56, 0xB5, 0x12, 
+0xE0, 0xAC, 0x5A, 0xD1, 0xED, 0x9A, 0x17, 0x20, 
+0x62, 0xDE, 0x2E, 0xAD, 0xCB, 0x0A, 0x8F, 0x33, 
+0x47, 0x19, 0x69, 0xBC, 0x6A, 0x9C, 0x4B, 0x5B, 
+0x3D, 0x02, 0x47, 0x12, 0x9C, 0x1B, 0xBA, 0xA2, 
+0x89, 0xB2, 0x81, 0x88, 0x11, 0x40, 0xC7, 0x90, 
+0xE7, 0x2E, 
+
+0x8C, 0xAE, 0xDA, 0x16, 0x79, 0xC2, 0x0F, 0x36, 
+0xAD, 0xC8, 0xD0, 0x0E, 0xBC, 0x2D, 0x78, 0xA2, 
+0x55, 0x90, 0x32, 0xA9, 0xC2, 0x37, 0xF7, 0x08, 
+0x00, 0xD3, 0xC3, 0xBF, 0x9F, 0x57, 0x60, 0xA5, 
+0xFD, 0xC1, 0xB2, 0xF2, 0xF4, 0x72, 0x5C, 0xD8, 
+0x37, 0xA7, 0xC4, 0x5C, 0x55, 0x57, 0xCC, 0xD9, 
+0x50, 0xEB, 0xB4, 0x70, 0x2C, 0x78, 0x3D, 0x43, 
+0xD8, 0x62, 0x06, 0x21, 0xF1, 0xC1, 0x48, 0xB0, 
+0x41, 0x65, 0xA3, 0x12, 0x9F, 0xA4, 0xEE, 0x95, 
+0x63, 0xC0, 0x8C, 0x1F, 0xCC, 0x40, 0x43, 0x81, 
+0x90, 0xBF, 0xC4, 0xE9, 0x3A, 0xE6, 0xC1, 0x09, 
+0x3B, 0xE8, 0xFF, 0xCB, 0xD4, 0x0C, 0x40, 0x4D, 
+0x4B, 0xCA, 0xC4, 0xDA, 0x47, 0xCA, 0x91, 0x23, 
+0x2B, 0x3A, 0x72, 0x1A, 0x7C, 0xAF, 0x22, 0x16, 
+0xA6, 0xFA, 0x70, 0x01, 0x1F, 0x5D, 0xC6, 0xA4, 
+0x9A, 0xAC, 0xBA, 0x28, 0xDF, 0xF3, 0x8A, 0x2E, 
+0x63, 0x97, 0x9A, 0x64, 0x86, 0x40, 0xF2, 0xAB, 
+0xF8, 0x8F, 0x6F, 0x79, 0x62, 0x27, 0xB6, 0xE0, 
+0xF5, 0xB1, 0x74, 0x66, 0xB0, 0xBA, 0xFA, 0x58, 
+0x27, 0xD6, 0xFB, 0x8A, 0x8C, 0x60, 0xEF, 0x7F, 
+0xD8, 0x5F, 0xA0, 0x2F, 0x32, 0x65, 0x2A, 0xE2, 
+0x85, 0xDA, 0xAB, 0x5B, 0xA2, 0xCC, 0xB3, 0x0D, 
+0x63, 0xCB, 0x35, 0xDD, 0x10, 0x08, 0x69, 0x45, 
+0x86, 0x08, 0x0C, 0xA2, 0x26, 0xE9, 0x79, 0x8E, 
+0xBB, 0x25, 0x53, 0xB5, 0x9C, 0xF9, 0x81, 0x09, 
+0x96, 0x9A, 0x48, 0x41, 0x66, 0x5F, 0xEF, 0x96, 
+0x8E, 0xE9, 0xEA, 0x6E, 0xC3, 0xD7, 0x39, 0x99, 
+0xF5, 0xDD, 0x50, 0xC9, 0x2C, 0xC9, 0x63, 0xC2, 
+0xB7, 0xA1, 0x87, 
+
+0x0D, 0x8F, 0xCA, 0x5D, 0x57, 0x9E, 0x4C, 0x56, 
+0xB7, 0x3F, 0x31, 0x6B, 0xF7, 0xAF, 0xA7, 0x0B, 
+0x84, 0xCD, 0x8D, 0x4A, 0xAA, 0x4E, 0x1A, 0x0B, 
+0x74, 0x90, 0xD1, 0x4E, 0x02, 0x2E, 0x38, 0x83, 
+0x53, 0x2D, 0x9F, 0x60, 0x38, 0x2B, 0xB4, 0x2B, 
+0x51, 0x3B, 0x04, 0x5B, 0x28, 0x59, 0xE0, 0xDF, 
+0x60, 0x05, 0x9B, 0x1A, 0x6F, 0x6C, 0x71, 0xB7, 
+0x69, 0xD3, 0xFE, 0x82, 0xEA, 0x08, 0xF4, 0x7D, 
+0xBA, 0x4F, 0xBD, 0x76, 0x11, 0xDE, 0x54, 0xAD, 
+0x4B, 0x68, 0x19, 0xE8, 0x63, 0x6E, 0xC5, 0x9B, 
+0x63, 0x84, 0x2D, 0x42, 0xF3, 0x8A, 0xC5, 0x80, 
+0xAD, 0x0B, 0xBC, 0xC9, 0xE1, 0xD9, 0x5F, 0x3F, 
+0x79, 0x27, 0xD3, 0xE4, 0x22, 0x67, 0xA0, 0xC9, 
+0xF4, 0x98, 0xCB, 0xFF, 0x27, 0xA5, 0x53, 0x94, 
+0x64, 0x74, 0xC4, 0xA1, 0x32, 0x77, 0xD6, 0xC7, 
+0x06, 0xE2, 0x42, 0x59, 0x75, 0x27, 0x48, 0xEA, 
+0x23, 0x61, 0xB9, 0xD0, 0x6C, 0xE3, 0xD4, 0x51, 
+0x99, 0x98, 0x86, 0xC7, 0xFB, 0x07, 0x35, 0x2C, 
+0xC4, 0x32, 0x86, 0x49, 0xD8, 0x84, 0x0B, 0x7C, 
+0x5B, 0x23, 0x3E, 0x00, 0x91, 0xF0, 0xE7, 0x41, 
+0xD2, 0x1D, 0x3D, 0x8F, 0x1C, 0x4B, 0x9B, 0x99, 
+0x36, 0x6D, 0x2B, 0xBF, 0xBA, 0x91, 0x8E, 0x12, 
+0x34, 0x1D, 0xEB, 0x8B, 0x4D, 0xDD, 0xA8, 0xC1, 
+0x58, 0x26, 0xBA, 0x2D, 0x6A, 0x8D, 0x00, 0xBC, 
+0xEE, 0x34, 0xF7, 0xA6, 0x13, 0x0B, 0x43, 0x68, 
+0x21, 0x41, 0xBF, 0x0C, 0xFC, 0xED, 0x8D, 0x57, 
+0xE5, 0xF5, 0x7F, 0x3D, 0x4C, 0x34, 0x2F, 0x0E, 
+0x4C, 0x24, 0x0B, 0xBF, 0xA1, 0xEB, 0x4D, 0xD9, 
+0xE4, 0xB4, 0xE0, 0x23, 
+
+0x73, 0xDA, 0xD9, 0xBF, 0x25, 0xC5, 0x1E, 0x98, 
+0xC8, 0xEB, 0xCA, 0xCE, 0x0A, 0x3A, 0x81, 0xC9, 
+0x2F, 0x7E, 0x7A, 0x41, 0x99, 0x14, 0x64, 0xEB, 
+0x97, 0xB5, 0x64, 0x1C, 0x41, 0x52, 0xED, 0x7B, 
+0xE4, 0x16, 0x25, 0x54, 0x04, 0x61, 0xA4, 0x67, 
+0xC1, 0x67, 0x43, 0xDF, 0x06, 0xF2, 0x02, 0x5C, 
+0x79, 0x0C, 0xB1, 0xBE, 0x2A, 0x2C, 0x6D, 0x97, 
+0xC5, 0x8D, 0x5C, 0x88, 0xCD, 0x9C, 0x6C, 0x0D, 
+0x50, 0x7E, 0xAC, 0x28, 0x04, 0x5C, 0xA5, 0x20, 
+0x90, 0xC7, 0x6E, 0xFA, 0xDE, 0xB1, 0x4B, 0x93, 
+0x04, 0xAD, 0x8F, 0xBE, 0x28, 0x44, 0xFB, 0xAB, 
+0xC5, 0x25, 0xFE, 0xB0, 0x1C, 0x3F, 0x36, 0x90, 
+0x86, 0x9E, 0x4E, 0xB8, 0x5F, 0x64, 0x27, 0xAB, 
+0x8B, 0xDF, 0xD0, 0x2F, 0xCC, 0x17, 0xBA, 0xA6, 
+0x56, 0xA7, 0x3F, 0x2E, 0xF6, 0xBC, 0xDF, 0xDF, 
+0x3B, 0xBD, 0x7B, 0xF2, 0xAA, 0x44, 0x59, 0x25, 
+0x08, 0x06, 0xD2, 0xED, 0x06, 0xD8, 0x60, 0x84, 
+0x92, 0x3E, 0x44, 0x7D, 0xC6, 0xDD, 0x79, 0x39, 
+0x45, 0xA4, 0x48, 0xA6, 0x96, 0xEB, 0xB8, 0xCE, 
+0x65, 0x0E, 0xBD, 0x07, 0x9D, 0x5D, 0xB2, 0x3B, 
+0xA3, 0x31, 0x2B, 0x3F, 0xEE, 0x0F, 0x65, 0x65, 
+0x38, 0xB9, 0x3A, 0x45, 0xBD, 0x4C, 0x2A, 0xD7, 
+0x2A, 0x62, 0x60, 0x58, 0x5A, 0x9D, 0x20, 0x6B, 
+0x0B, 0x89, 0x1C, 0xAA, 0xB1, 0xD6, 0x13, 0x94, 
+0x03, 0x00, 0x32, 0x1A, 0x53, 0xB3, 0x72, 0x3E, 
+0x22, 0xE7, 0x26, 0x40, 0x4C, 0x19, 0x1F, 0x43, 
+0xDF, 0xC1, 0x5F, 0xA9, 0x54, 0x00, 0x06, 0xDD, 
+0x64, 0xD3, 0xE6, 0xA7, 0xA8, 0xA7, 0x83, 0xA5, 
+0xD9, 0xC4, 0x3E, 0xF7, 0x2E, 
+
+0x82, 0x96, 0x3E, 0xE7, 0x3B, 0x9D, 0xFA, 0x86, 
+0x5E, 0x1D, 0x2A, 0xF3, 0x4D, 0x6C, 0x51, 0xD8, 
+0x16, 0x32, 0x2B, 0xDC, 0x2E, 0xC5, 0x80, 0xA6, 
+0x35, 0x2E, 0x94, 0x81, 0x4A, 0x79, 0xAB, 0xBE, 
+0x1C, 0x98, 0x73, 0xA3, 0x26, 0xE8, 0xB4, 0x6F, 
+0xC0, 0xAA, 0xCB, 0x61, 0x1C, 0x9B, 0xB7, 0x44, 
+0xE5, 0x85, 0x8A, 0xA3, 0x31, 0x82, 0xAD, 0xBC, 
+0xEC, 0x51, 0xC6, 0x90, 0x9D, 0x11, 0xF0, 0x5B, 
+0x10, 0x9D, 0x46, 0x77, 0xFC, 0x49, 0xE5, 0x7B, 
+0xB6, 0xD6, 0x20, 0xF0, 0x57, 0xA9, 0x7B, 0xF9, 
+0x40, 0x3C, 0xCE, 0xF6, 0x70, 0x61, 0x30, 0x02, 
+0xA2, 0x89, 0xF8, 0xE6, 0x1F, 0x82, 0x3E, 0x34, 
+0xE0, 0xBB, 0xBD, 0x24, 0x70, 0xBC, 0xA0, 0xAF, 
+0xC1, 0x26, 0x83, 0x25, 0x14, 0xBA, 0x92, 0x37, 
+0x92, 0x64, 0x4A, 0xC4, 0xBB, 0xB5, 0x04, 0x46, 
+0xC8, 0x8D, 0x2D, 0x25, 0x23, 0x12, 0x07, 0xE3, 
+0xA6, 0xAD, 0xB2, 0x80, 0xB3, 0xFB, 0xD3, 0x4C, 
+0xDD, 0xA6, 0xD1, 0x28, 0x7F, 0x08, 0xEC, 0xDC, 
+0xB4, 0xDA, 0xB9, 0x60, 0xEA, 0x22, 0xBA, 0x60, 
+0x0A, 0x35, 0x59, 0x03, 0xE8, 0x3B, 0x61, 0xC6, 
+0xFA, 0xED, 0x79, 0x33, 0x11, 0x02, 0x6A, 0x4F, 
+0x4B, 0xCD, 0xF9, 0x7F, 0xBD, 0xAC, 0x4D, 0xC1, 
+0x22, 0xD1, 0xA0, 0x64, 0xC7, 0x4B, 0x14, 0x09, 
+0xF2, 0xA5, 0x72, 0xB1, 0x4B, 0xE0, 0x13, 0x85, 
+0xD7, 0xA3, 0x43, 0xE0, 0xA6, 0x11, 0x1B, 0xD7, 
+0x12, 0xD8, 0xE9, 0x26, 0xB0, 0x5D, 0xA7, 0x2A, 
+0x30, 0x48, 0xC0, 0x7E, 0x86, 0x9F, 0x5F, 0x0E, 
+0x3A, 0xC5, 0x61, 0x7F, 0xF5, 0xD4, 0x17, 0xC2, 
+0x26, 0x21, 0xF5, 0x1B, 0x30, 0xF5, 
+
+0xFD, 0xD4, 0x72, 0xFE, 0xDD, 0xD6, 0xDC, 0xF2, 
+0x20, 0x0D, 0x86, 0x3A, 0x2F, 0xD5, 0x7F, 0xEB, 
+0x5E, 0x8A, 0xC3, 0x5A, 0x73, 0x18, 0x8C, 0xCF, 
+0xA8, 0xCC, 0x63, 0xB1, 0xCD, 0x5E, 0x53, 0xD1, 
+0x57, 0xD2, 0x9E, 0xF4, 0xED, 0xB2, 0x1C, 0x13, 
+0x5D, 0xBB, 0xAB, 0x95, 0x63, 0x2C, 0x66, 0xDA, 
+0x70, 0x0E, 0xE0, 0x55, 0x6D, 0xF4, 0xF1, 0x6D, 
+0x4C, 0x83, 0xF9, 0x14, 0x80, 0xB5, 0xCA, 0xED, 
+0xDB, 0x4A, 0xBC, 0x3C, 0xB6, 0x69, 0x03, 0x27, 
+0x58, 0xB5, 0xDB, 0x1C, 0xBC, 0x45, 0x3B, 0xB3, 
+0xD5, 0x41, 0x7A, 0x78, 0x25, 0x18, 0x5F, 0xF3, 
+0x69, 0x29, 0xEC, 0xBC, 0xCD, 0x52, 0xAC, 0x94, 
+0x07, 0xC2, 0x17, 0x57, 0xD1, 0x1D, 0x14, 0x13, 
+0x40, 0x41, 0xC1, 0x45, 0x29, 0x5D, 0x06, 0xF3, 
+0x2D, 0x0B, 0x63, 0x6F, 0x78, 0xBC, 0xCF, 0xD9, 
+0xAC, 0x32, 0x69, 0x67, 0x9F, 0x2D, 0x56, 0x88, 
+0x2A, 0x48, 0xAE, 0x5E, 0xE6, 0x99, 0xF7, 0xE5, 
+0x84, 0x23, 0xCD, 0xD8, 0xEA, 0x70, 0x47, 0x8B, 
+0xB8, 0xDD, 0xB6, 0x94, 0x94, 0x64, 0xB7, 0xDC, 
+0x79, 0xF8, 0x5E, 0x14, 0x17, 0x6F, 0x5A, 0x24, 
+0xA2, 0x35, 0x67, 0x9D, 0x51, 0x9C, 0xA8, 0x27, 
+0x04, 0x9F, 0x98, 0xE1, 0x21, 0xFD, 0x2B, 0x98, 
+0x8F, 0x6B, 0x04, 0xCA, 0x26, 0xF5, 0xC0, 0xC2, 
+0x9F, 0x0A, 0xC9, 0xFF, 0x8E, 0x71, 0x6F, 0x8C, 
+0x85, 0x88, 0x39, 0x8B, 0xB7, 0x5E, 0xC5, 0x0B, 
+0x49, 0x67, 0xFE, 0x05, 0x81, 0xF5, 0x7B, 0x0E, 
+0x13, 0x1B, 0xEA, 0x5A, 0x50, 0x06, 0x84, 0x75, 
+0xC3, 0x8A, 0xF9, 0xD4, 0xD2, 0x3F, 0xE7, 0x68, 
+0x1D, 0x7A, 0x11, 0x87, 0xED, 0xA0, 0xBB, 
+
+0xE7, 0xA7, 0x63, 0xCF, 0x5C, 0xDC, 0x36, 0x61, 
+0x6F, 0x07, 0xAF, 0x91, 0x52, 0x30, 0x60, 0xD7, 
+0xCE, 0x03, 0x83, 0xA5, 0x82, 0x09, 0x81, 0x6B, 
+0x68, 0x17, 0x2E, 0x3D, 0xA1, 0x31, 0x59, 0xFE, 
+0xB1, 0xB2, 0x5D, 0x7B, 0xC1, 0x5F, 0x18, 0xBD, 
+0xCC, 0x4E, 0x37, 0x4C, 0xA8, 0x98, 0xFF, 0x49, 
+0x08, 0x1B, 0xF5, 0xFF, 0x29, 0x43, 0xC8, 0x5C, 
+0x13, 0x99, 0x6E, 0x62, 0xD6, 0xCB, 0x3D, 0x04, 
+0x6D, 0x98, 0xCA, 0x8B, 0x40, 0x05, 0x61, 0x06, 
+0x3C, 0xD0, 0x9A, 0x20, 0x60, 0x36, 0x22, 0x59, 
+0x9D, 0xD1, 0xBC, 0xF0, 0x45, 0x5C, 0x98, 0x22, 
+0xD8, 0xD7, 0x29, 0xE6, 0x0A, 0x52, 0xC4, 0x06, 
+0xE1, 0xBF, 0xA0, 0x77, 0xA4, 0x36, 0x68, 0x0F, 
+0x3F, 0x13, 0x8A, 0x30, 0x9B, 0x7E, 0x38, 0xFB, 
+0xE8, 0xF0, 0x75, 0x5D, 0x49, 0x93, 0xA1, 0x94, 
+0x54, 0xAD, 0x12, 0x10, 0xE2, 0x87, 0x56, 0x91, 
+0xC4, 0x6D, 0x92, 0x8F, 0x34, 0xB4, 0x29, 0x1D, 
+0xBF, 0x02, 0xC1, 0xFA, 0x1B, 0x8A, 0x3E, 0x9E, 
+0x5C, 0x17, 0xC4, 0xCB, 0xA4, 0x63, 0x82, 0xD7, 
+0x6F, 0xE8, 0x79, 0x5D, 0xC7, 0x8A, 0x59, 0xA0, 
+0x34, 0x14, 0x31, 0xFD, 0xD5, 0x4D, 0xA3, 0x3B, 
+0xCC, 0x0A, 0xCB, 0xDC, 0xB2, 0x7B, 0xD6, 0xD3, 
+0xB2, 0xAA, 0x33, 0x07, 0x4B, 0x6D, 0xD8, 0xDA, 
+0x70, 0x11, 0x50, 0xAE, 0x92, 0x04, 0x5D, 0x55, 
+0x47, 0xE1, 0x92, 0x10, 0x9B, 0xE5, 0x86, 0xCE, 
+0xE4, 0xCD, 0x6F, 0xF6, 0x86, 0xC6, 0xB4, 0x3C, 
+0x69, 0xB2, 0xF3, 0x7F, 0xCC, 0xB9, 0x62, 0x9C, 
+0x75, 0x64, 0x25, 0x2D, 0x14, 0x7D, 0xBE, 0x21, 
+0x7D, 0x53, 0x51, 0x61, 0x85, 0xB5, 0x7F, 0x8C, 
+
+0xD2, 0xDA, 0x01, 0xA2, 0xBF, 0x9A, 0x15, 0x54, 
+0xBC, 0x65, 0x35, 0x52, 0xF5, 0x31, 0x9E, 0x13, 
+0xE4, 0x15, 0xB0, 0x13, 0xE1, 0xC6, 0x42, 0xA0, 
+0x2F, 0xE9, 0xA8, 0x4E, 0xB1, 0xA3, 0xAB, 0x38, 
+0x1D, 0xC2, 0x1C, 0x5D, 0x59, 0xEC, 0x97, 0x6B, 
+0x19, 0x9F, 0x90, 0x16, 0x0F, 0x79, 0x57, 0x12, 
+0x1E, 0x0C, 0x24, 0x61, 0xF9, 0xA7, 0x30, 0x5E, 
+0x44, 0xB8, 0x45, 0x3F, 0x81, 0xEA, 0x1D, 0xB4, 
+0xD6, 0x43, 0x24, 0xAE, 0xC6, 0x94, 0x48, 0x4B, 
+0x77, 0x92, 0x8D, 0x28, 0xFF, 0x19, 0x40, 0xB7, 
+0x54, 0x0F, 0x7E, 0x8F, 0xBB, 0xAA, 0x40, 0xC6, 
+0x16, 0xAA, 0x42, 0x59, 0x39, 0x9D, 0xF1, 0x39, 
+0x6D, 0xE7, 0xCA, 0x65, 0xDB, 0x51, 0x04, 0xB3, 
+0xA5, 0xD3, 0xB9, 0xDC, 0x42, 0x74, 0x9C, 0x7E, 
+0xEC, 0xA8, 0xBA, 0x34, 0x7E, 0xB4, 0x8B, 0xBD, 
+0x69, 0x86, 0xF3, 0xC4, 0x86, 0xFE, 0x06, 0x3A, 
+0x72, 0xB9, 0xBA, 0x32, 0xFF, 0x7F, 0x16, 0x8E, 
+0x27, 0x51, 0xBF, 0xD7, 0x57, 0x64, 0x2D, 0xE2, 
+0xF4, 0xDD, 0x85, 0x94, 0x8B, 0x1C, 0xF9, 0x4A, 
+0x30, 0x22, 0x9D, 0xEF, 0x18, 0x48, 0xF5, 0xB5, 
+0x95, 0x3C, 0x90, 0xF8, 0x70, 0xD2, 0xB5, 0x37, 
+0x84, 0xEC, 0x6E, 0x29, 0xB7, 0x2F, 0x5F, 0x37, 
+0xB1, 0x1D, 0xC3, 0x9D, 0x3F, 0x3B, 0x55, 0xC4, 
+0xC5, 0xAE, 0xA3, 0x01, 0x56, 0xB3, 0x87, 0x87, 
+0xB3, 0x14, 0xDB, 0x4E, 0x0D, 0xC2, 0x50, 0xAC, 
+0x7B, 0x19, 0x08, 0xEF, 0xDB, 0x55, 0xEC, 0xE7, 
+0x2E, 0x9E, 0xC1, 0x22, 0xA3, 0x2F, 0x9A, 0x12, 
+0x87, 0x91, 0x8F, 0x0C, 0xBC, 0xC6, 0xE5, 0x32, 
+0x0C, 0x06, 0x37, 0x67, 0x82, 0x0F, 0x81, 0x0B, 
+0x27, 
+
+0xB7, 0x41, 0xBE, 0xD3, 0x08, 0x8A, 0x4D, 0x6B, 
+0xA7, 0x6A, 0x40, 0x35, 0xB8, 0x74, 0xA8, 0x87, 
+0xBB, 0x2A, 0x8C, 0xE3, 0x9F, 0xC4, 0x61, 0xCB, 
+0x88, 0xF2, 0x67, 0x4E, 0x76, 0x39, 0xD4, 0x44, 
+0x7F, 0x78, 0x5A, 0x29, 0x97, 0x51, 0x58, 0x48, 
+0xAB, 0x71, 0x36, 0xF3, 0x81, 0x2C, 0x97, 0xDC, 
+0xF2, 0x57, 0xE4, 0x84, 0xFA, 0x6E, 0x11, 0x9B, 
+0xC8, 0xCD, 0xC0, 0x84, 0xBD, 0xB3, 0xED, 0x03, 
+0x22, 0xCE, 0xFD, 0xC3, 0x7B, 0xAE, 0x05, 0x70, 
+0x0E, 0xEA, 0xE8, 0xFF, 0x17, 0x23, 0x17, 0x0C, 
+0xF1, 0x41, 0x19, 0xD7, 0x2D, 0xA9, 0xFA, 0x69, 
+0xFC, 0xC9, 0x7A, 0x26, 0x8C, 0xC2, 0x3E, 0x4A, 
+0x2E, 0xB8, 0xE9, 0x7B, 0xFD, 0x2A, 0x02, 0x86, 
+0x21, 0x6B, 0xCB, 0xF0, 0x02, 0xEB, 0xA4, 0x56, 
+0x68, 0xB1, 0xDF, 0xB0, 0xF1, 0x75, 0xCB, 0x0E, 
+0x9F, 0xF4, 0x06, 0x8D, 0x89, 0xEF, 0x7F, 0xF3, 
+0xF6, 0x4A, 0xE7, 0xA5, 0x7A, 0xDB, 0xDA, 0x76, 
+0x03, 0x03, 0xD0, 0x41, 0xB9, 0x9D, 0x48, 0x7B, 
+0xA3, 0xEB, 0x95, 0x38, 0x9A, 0x5C, 0x8A, 0xE9, 
+0x75, 0x9A, 0x63, 0xD1, 0xE5, 0x5F, 0x25, 0x87, 
+0xDF, 0x27, 0x43, 0x8A, 0x99, 0xE9, 0x84, 0x86, 
+0x77, 0xC7, 0x93, 0xC2, 0x58, 0xBA, 0xFA, 0x92, 
+0xFC, 0x6D, 0x0F, 0xE2, 0x5A, 0x0C, 0x51, 0x74, 
+0x4A, 0x2D, 0xD2, 0xA8, 0x83, 0xA5, 0xE0, 0x70, 
+0x6D, 0x84, 0xEB, 0x0B, 0x0C, 0xD7, 0x63, 0x3E, 
+0x36, 0x1F, 0x92, 0xFA, 0x48, 0xF2, 0x20, 0x1B, 
+0x76, 0xE2, 0x49, 0x3A, 0xFC, 0xBD, 0x2F, 0x2F, 
+0x2C, 0xFF, 0x6E, 0x39, 0x78, 0x34, 0xDA, 0x35, 
+0xDF, 0x65, 0x72, 0xF1, 0x44, 0x25, 0xCB, 0x12, 
+0x6E, 0x21, 
+
+0x27, 0xA6, 0xD7, 0xCA, 0x3B, 0x2C, 0x64, 0xD3, 
+0xEE, 0x7A, 0x9E, 0xFA, 0x67, 0xF2, 0xA6, 0xAA, 
+0x3F, 0xD8, 0x30, 0x01, 0xBA, 0x68, 0x29, 0x7C, 
+0x45, 0x38, 0x53, 0x0E, 0xD7, 0x9A, 0x94, 0xAA, 
+0x51, 0x07, 0x49, 0x53, 0xFD, 0x39, 0x73, 0x2C, 
+0x76, 0x5D, 0xC6, 0x6F, 0xCD, 0x6F, 0xBD, 0x2B, 
+0x30, 0x4D, 0x96, 0xD8, 0x54, 0x11, 0x80, 0x5B, 
+0x20, 0x2B, 0xC9, 0x1C, 0xDF, 0xAF, 0x44, 0x84, 
+0x41, 0xB5, 0x76, 0x5F, 0x1D, 0x87, 0x2C, 0xD4, 
+0x4D, 0x53, 0x89, 0x5A, 0xFD, 0x6B, 0x04, 0xDF, 
+0x5C, 0x16, 0xA2, 0x20, 0xAB, 0x33, 0xFD, 0x46, 
+0xA4, 0xB8, 0xBB, 0xBC, 0x84, 0x37, 0xF7, 0x6E, 
+0xB5, 0xCC, 0x0F, 0xCE, 0xDD, 0x30, 0xB4, 0xE6, 
+0x21, 0x75, 0x37, 0x66, 0x66, 0xF3, 0xA7, 0x84, 
+0x71, 0x01, 0x07, 0x27, 0x32, 0xDC, 0x07, 0xFD, 
+0x09, 0x88, 0x0E, 0x9F, 0xAD, 0xCB, 0xCD, 0xBF, 
+0x59, 0xB1, 0x1B, 0x67, 0xF1, 0xAD, 0xC0, 0x1E, 
+0xB6, 0xD7, 0x82, 0xB7, 0x47, 0x57, 0x56, 0xD6, 
+0x4D, 0x17, 0x48, 0x23, 0x31, 0x92, 0xAF, 0x8E, 
+0x73, 0x7D, 0x77, 0x12, 0xE9, 0x72, 0x42, 0x07, 
+0xC5, 0x84, 0xC1, 0xF3, 0x8C, 0x2A, 0x23, 0x13, 
+0x87, 0xD1, 0xD9, 0x41, 0x06, 0x0F, 0x99, 0x4A, 
+0x1F, 0x2D, 0xDF, 0x8E, 0x70, 0x5D, 0x9F, 0x0C, 
+0x27, 0xAB, 0x84, 0x62, 0x2E, 0xC3, 0xA8, 0x48, 
+0xBA, 0x36, 0x37, 0x99, 0x0C, 0x82, 0xB0, 0x06, 
+0xF8, 0x57, 0x2F, 0x8F, 0xEB, 0x8A, 0xEB, 0x37, 
+0xED, 0x03, 0xB5, 0x85, 0x10, 0x78, 0x63, 0xED, 
+0xE6, 0x5A, 0x43, 0x95, 0xCB, 0x26, 0x24, 0x36, 
+0x32, 0x57, 0x3B, 0xB5, 0x30, 0x2A, 0x13, 0x61, 
+0xBB, 0x43, 0x20, 
+
+0x06, 0xDA, 0x6A, 0xD6, 0x9C, 0x73, 0xF1, 0xFE, 
+0x3F, 0x56, 0xE6, 0xB6, 0x73, 0x3D, 0x2C, 0xA2, 
+0xF6, 0xA6, 0x17, 0x7F, 0x02, 0x36, 0x11, 0x81, 
+0xEC, 0xA6, 0x2B, 0xE0, 0x00, 0x76, 0xE8, 0x8C, 
+0x6E, 0xCC, 0x24, 0xA3, 0xDE, 0x46, 0xF6, 0x71, 
+0x3A, 0xD2, 0x74, 0xE8, 0x31, 0x32, 0xEE, 0xA9, 
+0x64, 0xF6, 0x92, 0x81, 0x41, 0xEF, 0x9B, 0xA8, 
+0x41, 0xF5, 0xE0, 0x71, 0x69, 0x08, 0x10, 0xE1, 
+0x3C, 0xED, 0xE2, 0x94, 0x1C, 0xE4, 0x66, 0x87, 
+0xBD, 0x25, 0xC1, 0x79, 0xE2, 0x67, 0x60, 0xB6, 
+0x00, 0x54, 0x13, 0x01, 0xB9, 0x16, 0x1C, 0x47, 
+0x14, 0xEF, 0x7D, 0x04, 0x4B, 0x3B, 0x44, 0xAB, 
+0x84, 0x02, 0x0B, 0xFA, 0xA5, 0x5A, 0x4E, 0x4D, 
+0x2C, 0xB9, 0x25, 0x4B, 0x7A, 0xEC, 0x78, 0x3B, 
+0xF6, 0x37, 0x4E, 0x31, 0xEB, 0x8C, 0xBB, 0xAD, 
+0x34, 0x70, 0xEA, 0x63, 0x11, 0xB0, 0x60, 0x25, 
+0xF9, 0xAB, 0x08, 0x6F, 0xD0, 0x67, 0x0B, 0xF0, 
+0x84, 0xF1, 0xBB, 0xA1, 0xB3, 0xFB, 0x63, 0x5B, 
+0x3A, 0x94, 0x1C, 0xB7, 0x97, 0xD0, 0x27, 0xA2, 
+0xD2, 0x3B, 0xE7, 0x39, 0xAA, 0x87, 0xCF, 0xC8, 
+0x6C, 0xC7, 0x3A, 0xA7, 0x9D, 0xFC, 0xEA, 0x56, 
+0x6F, 0x65, 0xC3, 0x3E, 0x27, 0xDF, 0xD6, 0xB4, 
+0xC4, 0x6B, 0xBB, 0x2D, 0x25, 0x6F, 0x87, 0x07, 
+0x9D, 0xFC, 0xE7, 0xC5, 0xC7, 0x43, 0x60, 0x40, 
+0xF4, 0x33, 0x97, 0xBF, 0x79, 0x7B, 0xDB, 0x8A, 
+0xFC, 0x29, 0x45, 0xBC, 0xB5, 0x39, 0xFA, 0x98, 
+0x54, 0x65, 0x0A, 0x5A, 0xC8, 0x44, 0x16, 0x12, 
+0x2F, 0x3E, 0x6A, 0xD2, 0xC2, 0xBC, 0x43, 0x2C, 
+0x30, 0x16, 0x93, 0x59, 0x69, 0xD6, 0xE9, 0xA5, 
+0xAD, 0x97, 0x2B, 0x5A, 
+
+0x42, 0x9D, 0x58, 0x2A, 0xA9, 0x3A, 0x8C, 0x90, 
+0xB0, 0x0C, 0x7A, 0x4A, 0x5A, 0x83, 0x02, 0xF1, 
+0x6D, 0x18, 0xC9, 0xD3, 0x7A, 0x91, 0xBB, 0x2D, 
+0xC3, 0x25, 0xD6, 0xE9, 0x77, 0x70, 0xF1, 0x48, 
+0x4F, 0x5F, 0xAE, 0x77, 0x8E, 0x58, 0x45, 0x38, 
+0xAB, 0x54, 0xC4, 0x75, 0x1C, 0x55, 0xAF, 0x11, 
+0x95, 0x35, 0x9C, 0x21, 0x54, 0xFD, 0x0A, 0x96, 
+0x2C, 0xD6, 0x27, 0x0A, 0x9C, 0xCA, 0x3A, 0xCE, 
+0x9C, 0xE4, 0xB5, 0x8E, 0x34, 0xC9, 0x54, 0xA9, 
+0xDC, 0xC7, 0x2E, 0x28, 0xAE, 0x6B, 0x08, 0xE9, 
+0x40, 0x5D, 0xCB, 0x09, 0x38, 0xEB, 0x62, 0xC7, 
+0x4B, 0xE6, 0x15, 0x8D, 0x7A, 0x52, 0xE5, 0x68, 
+0x58, 0x9D, 0xDE, 0xA9, 0x78, 0x47, 0xD4, 0x83, 
+0x94, 0xF8, 0x9C, 0x02, 0xD8, 0xF0, 0x2E, 0xFD, 
+0xAC, 0x9A, 0xB6, 0xD3, 0x1A, 0x02, 0x1D, 0xC6, 
+0x72, 0x83, 0x27, 0x01, 0x1A, 0x87, 0x46, 0x74, 
+0x91, 0xAC, 0xB1, 0x18, 0xC7, 0x22, 0x91, 0x9C, 
+0x0F, 0xE4, 0x67, 0x2C, 0xE9, 0xC9, 0x37, 0xE9, 
+0x37, 0xE3, 0x8A, 0x98, 0x02, 0x00, 0xFE, 0xC6, 
+0xB8, 0xF7, 0x03, 0xA4, 0xCF, 0x53, 0x4B, 0xDD, 
+0x9C, 0x1B, 0x81, 0xD2, 0x50, 0xF5, 0x37, 0xFF, 
+0x87, 0x8A, 0xCB, 0x8C, 0x54, 0x2A, 0x27, 0x33, 
+0x6B, 0x1E, 0x7C, 0xD9, 0xAD, 0xC2, 0x01, 0x7A, 
+0xEC, 0x36, 0xEE, 0xDC, 0x86, 0x92, 0x0D, 0x19, 
+0x57, 0xBF, 0xDA, 0xF3, 0xC7, 0x40, 0x84, 0x49, 
+0xA3, 0xBB, 0xB8, 0x70, 0x4F, 0x89, 0x24, 0x56, 
+0xE0, 0x2F, 0x12, 0x50, 0x3E, 0x54, 0xF4, 0x82, 
+0xE4, 0xFA, 0xA6, 0xDA, 0x66, 0xF1, 0x63, 0xA5, 
+0x0F, 0x11, 0x27, 0x3D, 0xAB, 0x17, 0x65, 0x5D, 
+0xD3, 0x1C, 0x1B, 0xC8, 0x76, 
+
+0xD4, 0xA6, 0x54, 0x3C, 0x62, 0xA1, 0x36, 0x99, 
+0x7A, 0xCD, 0x8F, 0x4D, 0x31, 0x63, 0xAD, 0x20, 
+0x3F, 0x8B, 0x21, 0x39, 0xFF, 0xCA, 0xBB, 0xD2, 
+0x78, 0x54, 0xB1, 0x4B, 0xAB, 0x0C, 0x89, 0xDF, 
+0xF9, 0x51, 0x4E, 0x15, 0xAC, 0x32, 0x92, 0x20, 
+0xB7, 0xF2, 0x2A, 0x10, 0x2B, 0x4A, 0x41, 0x97, 
+0xBC, 0x37, 0xC3, 0x50, 0x55, 0xF2, 0x35, 0x96, 
+0xC5, 0x63, 0xFB, 0xD4, 0x27, 0xD3, 0x04, 0x91, 
+0xC9, 0x95, 0x47, 0x0C, 0xA4, 0x44, 0x5A, 0xDC, 
+0x80, 0x65, 0x3C, 0x94, 0xB8, 0xD4, 0xFF, 0xA2, 
+0xB4, 0x83, 0xE4, 0x3F, 0x55, 0xD9, 0xD9, 0x25, 
+0xF7, 0x66, 0x3D, 0xED, 0xA1, 0x8D, 0x08, 0xF9, 
+0xBE, 0x46, 0x1F, 0x00, 0x26, 0x3C, 0x52, 0xDC, 
+0x8D, 0x24, 0x92, 0x13, 0x6D, 0x7A, 0xEC, 0x11, 
+0x10, 0xB0, 0x13, 0x30, 0x2C, 0xE5, 0x80, 0x5F, 
+0x7E, 0xEF, 0x0D, 0x20, 0x96, 0xC2, 0x1C, 0x6A, 
+0xBF, 0xBE, 0x58, 0x02, 0x0C, 0xEE, 0x37, 0x1B, 
+0x07, 0x31, 0x03, 0xE2, 0x28, 0x7D, 0xE5, 0xA3, 
+0x0E, 0x9E, 0xA6, 0x75, 0xBA, 0x50, 0xC8, 0x51, 
+0x4A, 0xF6, 0xC1, 0x74, 0xD1, 0x0F, 0x8C, 0x1A, 
+0x51, 0x4B, 0xAE, 0xA8, 0x08, 0x87, 0xA6, 0xEA, 
+0xEF, 0xE1, 0xF8, 0x24, 0xF7, 0x4D, 0x05, 0x9A, 
+0x29, 0xF5, 0xA0, 0x2A, 0xC3, 0x49, 0x5C, 0x47, 
+0xCD, 0xAE, 0x61, 0xE8, 0xD8, 0x9D, 0xBF, 0x48, 
+0x6D, 0xD9, 0x75, 0xDB, 0x7B, 0xD9, 0xC8, 0xF7, 
+0xCF, 0x68, 0x86, 0xA0, 0x8D, 0xDF, 0xFF, 0x24, 
+0xFE, 0x58, 0x77, 0x78, 0x27, 0x37, 0x0C, 0xC8, 
+0x08, 0xBB, 0x2F, 0x92, 0x2A, 0xC7, 0x5C, 0x64, 
+0x50, 0xBF, 0xEF, 0x0D, 0x26, 0x54, 0x2F, 0x85, 
+0x54, 0xE1, 0xA5, 0x55, 0x89, 0x44, 
+
+0x4C, 0x96, 0x0F, 0x0B, 0x44, 0x94, 0xFA, 0x7A, 
+0xD8, 0x88, 0x35, 0xE7, 0x00, 0x35, 0xFC, 0x51, 
+0x24, 0x9B, 0x79, 0x3D, 0xF3, 0x29, 0x21, 0x0A, 
+0xF9, 0x4E, 0xDD, 0xC9, 0xDB, 0x13, 0x08, 0xB2, 
+0x98, 0x22, 0xC5, 0x2F, 0x16, 0xD4, 0x29, 0x27, 
+0xF1, 0x21, 0x99, 0x45, 0xB6, 0xE6, 0x37, 0x57, 
+0x46, 0x69, 0x52, 0xCD, 0x40, 0x66, 0x49, 0xE6, 
+0x81, 0x6B, 0xC8, 0xBB, 0xD4, 0x38, 0x72, 0xDF, 
+0x93, 0xE6, 0x52, 0x34, 0x4B, 0xE8, 0xA5, 0x56, 
+0xA1, 0x14, 0x8F, 0xDF, 0xB7, 0xD0, 0x56, 0x4F, 
+0x4F, 0x1F, 0x93, 0xD2, 0x8B, 0x83, 0x5C, 0x71, 
+0x78, 0x48, 0x9D, 0x6D, 0xB0, 0x62, 0x2C, 0xBB, 
+0x09, 0xBA, 0xC9, 0xC3, 0xBC, 0x61, 0xC8, 0x3D, 
+0x67, 0x3B, 0xC9, 0xD3, 0xC6, 0x40, 0xC2, 0xB0, 
+0xDB, 0x5E, 0x17, 0xBB, 0x36, 0xFF, 0xBB, 0x75, 
+0x39, 0x93, 0x8D, 0x3C, 0x49, 0x75, 0xDA, 0xBA, 
+0xF0, 0x4E, 0x1C, 0x9D, 0x73, 0x8D, 0xB5, 0xBC, 
+0x56, 0x5D, 0x58, 0xCD, 0x63, 0xC4, 0x18, 0xF9, 
+0xA4, 0x14, 0x89, 0xFF, 0x9C, 0xAF, 0xB8, 0x5F, 
+0x1B, 0x65, 0x8F, 0xA8, 0x23, 0xC9, 0x5E, 0x33, 
+0x03, 0x6F, 0x75, 0xA8, 0xFE, 0xAE, 0x32, 0x26, 
+0xA8, 0x22, 0x8C, 0x06, 0x49, 0x78, 0x14, 0x2F, 
+0xE5, 0xE4, 0x18, 0x9F, 0x5D, 0xE9, 0xBE, 0x1E, 
+0x2A, 0x68, 0x64, 0x42, 0x78, 0x7F, 0x40, 0x65, 
+0xC7, 0xDA, 0x0F, 0xA0, 0xBE, 0xC0, 0x47, 0x0C, 
+0xA6, 0x9D, 0xF6, 0x95, 0x59, 0xAC, 0x71, 0xC9, 
+0x4A, 0x1C, 0xE3, 0xF1, 0x2B, 0x6E, 0x3D, 0xBB, 
+0xDA, 0x23, 0xD7, 0xB4, 0xD8, 0x98, 0x9E, 0x80, 
+0x46, 0xFA, 0x40, 0x0E, 0x95, 0x06, 0x19, 0x3B, 
+0xDE, 0x2B, 0x36, 0x65, 0x3D, 0x3F, 0xCA, 
+
+0x81, 0x72, 0x56, 0x30, 0xFD, 0x2D, 0xFC, 0xD7, 
+0x1F, 0xE4, 0x35, 0x55, 0xAC, 0x8F, 0x1C, 0xBD, 
+0xB4, 0x31, 0xE3, 0xF0, 0x44, 0xB5, 0x65, 0x1F, 
+0x22, 0xA8, 0xF4, 0x8D, 0xD0, 0xF1, 0x54, 0xD1, 
+0x40, 0x52, 0x3C, 0xA5, 0x5A, 0x0F, 0xD3, 0xD1, 
+0xCD, 0x33, 0x7F, 0xAF, 0x16, 0x7A, 0x89, 0xFB, 
+0xAC, 0x66, 0x89, 0x66, 0x71, 0xD3, 0x94, 0xBA, 
+0x12, 0x4D, 0x78, 0xB9, 0x00, 0x7A, 0x18, 0x8E, 
+0xEF, 0xFA, 0x55, 0xD7, 0xD8, 0x98, 0xC7, 0x25, 
+0xC2, 0x07, 0x58, 0xAA, 0x9E, 0x0A, 0xFD, 0xF8, 
+0x7B, 0x31, 0xDB, 0xDC, 0xCB, 0xB3, 0x86, 0x43, 
+0x28, 0x47, 0x7B, 0x3C, 0xB1, 0x10, 0x14, 0x74, 
+0x71, 0x35, 0x09, 0xF1, 0xFD, 0x46, 0xA3, 0x50, 
+0x13, 0xBA, 0x6C, 0x41, 0xF4, 0x12, 0xA0, 0x5C, 
+0xF1, 0x4C, 0x4B, 0x77, 0xDC, 0x9C, 0x46, 0xC0, 
+0x3D, 0x74, 0x06, 0xE7, 0x08, 0xC9, 0xDC, 0xE1, 
+0x16, 0x0E, 0x3F, 0x94, 0x9B, 0x5F, 0x50, 0x84, 
+0xF8, 0xFF, 0xAF, 0x34, 0xE5, 0x92, 0x8A, 0xAD, 
+0x9F, 0xA0, 0xD4, 0x05, 0x53, 0xA4, 0x7E, 0xA1, 
+0x3A, 0x50, 0xA6, 0x03, 0x98, 0x77, 0xDF, 0x01, 
+0xF1, 0x25, 0xD4, 0xE1, 0x69, 0xD8, 0x27, 0xCA, 
+0xA2, 0x59, 0x6C, 0xC2, 0x27, 0xB1, 0x02, 0x73, 
+0x67, 0x6A, 0x00, 0x62, 0x13, 0x4E, 0xA5, 0x1A, 
+0x69, 0xEC, 0x9C, 0xC2, 0x74, 0xB4, 0x1A, 0x65, 
+0x53, 0x41, 0xC6, 0xD1, 0x5D, 0xF8, 0xA1, 0xDC, 
+0x2B, 0x8E, 0xB6, 0x85, 0xB1, 0xDA, 0x17, 0xE9, 
+0x60, 0x33, 0x8D, 0xD3, 0x86, 0x55, 0x66, 0x7F, 
+0x8F, 0x71, 0x30, 0x99, 0x78, 0xEC, 0xD7, 0xAC, 
+0xE9, 0x27, 0x03, 0x6A, 0x9C, 0xCF, 0xB5, 0xBC, 
+0x23, 0x0A, 0x29, 0xD6, 0xDE, 0x93, 0xC5, 0x2E, 
+
+0x95, 0x56, 0xDF, 0xBB, 0xA4, 0x97, 0x1A, 0x02, 
+0x58, 0xDA, 0xB0, 0x60, 0x32, 0x5A, 0xA1, 0x11, 
+0x07, 0xB9, 0x5B, 0xBB, 0x34, 0x21, 0xE7, 0x77, 
+0xB9, 0x92, 0xB5, 0x2B, 0x32, 0xDD, 0x7F, 0x96, 
+0x8A, 0x65, 0x08, 0x28, 0xA4, 0x53, 0xEB, 0xC8, 
+0x90, 0x6D, 0xAC, 0xDC, 0x5A, 0x6D, 0x48, 0xBE, 
+0x06, 0xBB, 0xC6, 0xA3, 0xD7, 0xE2, 0xC1, 0xBF, 
+0xFC, 0x62, 0x56, 0x69, 0x91, 0x5F, 0xAB, 0xCF, 
+0x3D, 0x6B, 0xC4, 0x34, 0xEA, 0x50, 0x6E, 0xCF, 
+0x45, 0x82, 0x50, 0xB0, 0x20, 0x63, 0xDD, 0x56, 
+0x14, 0x6E, 0xE3, 0x9C, 0x31, 0x9B, 0x37, 0x32, 
+0xC0, 0xFB, 0x50, 0x73, 0x42, 0x76, 0x35, 0xDD, 
+0x3E, 0xBF, 0xB7, 0xB8, 0x23, 0x9C, 0x97, 0xCA, 
+0x2A, 0xA9, 0x75, 0x8D, 0xC8, 0xF2, 0x25, 0xB8, 
+0xD9, 0x6B, 0x7B, 0x55, 0x5F, 0xB2, 0xB9, 0xE7, 
+0xF7, 0xFD, 0x9F, 0x40, 0xD9, 0xD6, 0x26, 0x84, 
+0xA9, 0x05, 0x8B, 0xD6, 0x91, 0xB5, 0x04, 0x8B, 
+0xDF, 0xAD, 0x01, 0xAF, 0x6E, 0xA6, 0x6D, 0xE6, 
+0x29, 0xE5, 0x65, 0x86, 0x17, 0x4D, 0x61, 0xA4, 
+0x28, 0x7B, 0xFF, 0xFE, 0x48, 0x44, 0x63, 0x6F, 
+0x17, 0x57, 0xD2, 0xBD, 0xE7, 0x5E, 0x53, 0x80, 
+0x53, 0xD4, 0xDB, 0x5F, 0xB3, 0xE0, 0xFF, 0x20, 
+0xD8, 0x9E, 0xCF, 0xA1, 0x71, 0xB6, 0xA2, 0x2B, 
+0xC6, 0x55, 0x53, 0x62, 0xF2, 0xF1, 0xB6, 0xA4, 
+0x76, 0x73, 0x5D, 0x3A, 0xB6, 0x2B, 0xB5, 0xF3, 
+0x03, 0xFA, 0x73, 0x04, 0x24, 0x23, 0x27, 0x1F, 
+0x64, 0x67, 0xA6, 0x10, 0x48, 0x7C, 0x8F, 0x12, 
+0x2D, 0x0D, 0x73, 0xD9, 0x21, 0xF1, 0x35, 0x9C, 
+0xC7, 0x43, 0x5D, 0xE2, 0x1C, 0x7F, 0xC4, 0x13, 
+0x90, 0x92, 0xE1, 0xD1, 0x49, 0xDA, 0x71, 0x14, 
+0xBA, 
+
+0x74, 0x3D, 0x1D, 0x72, 0x06, 0x14, 0x7D, 0x47, 
+0xF1, 0xD9, 0xBD, 0x7D, 0x93, 0x0E, 0x03, 0x1C, 
+0x96, 0x0E, 0x62, 0x4F, 0x65, 0xBE, 0x51, 0x87, 
+0x3B, 0x5F, 0x82, 0x6D, 0xEB, 0x36, 0x83, 0x1F, 
+0x33, 0x70, 0x51, 0x46, 0x6E, 0x27, 0x6C, 0x0F, 
+0x3C, 0x1A, 0x94, 0x45, 0xDF, 0xC0, 0x96, 0x98, 
+0x8B, 0x22, 0x02, 0xD7, 0xD8, 0xC3, 0xA5, 0xAF, 
+0xE8, 0xAF, 0x41, 0x72, 0x20, 0xF6, 0x7B, 0xC3, 
+0x81, 0xA5, 0xDC, 0x49, 0x3B, 0x09, 0xD0, 0x11, 
+0xA4, 0xFC, 0xE6, 0x57, 0x12, 0x4B, 0x2E, 0x99, 
+0xEA, 0xC4, 0x0A, 0x5A, 0x1E, 0x52, 0x9D, 0x82, 
+0x4C, 0x43, 0x44, 0x1D, 0x43, 0x16, 0xBB, 0x2B, 
+0x78, 0x1A, 0xAA, 0x73, 0xFD, 0x05, 0xA5, 0xFD, 
+0x37, 0xEE, 0x1E, 0xA9, 0x99, 0x23, 0x1C, 0x71, 
+0x35, 0x25, 0xC7, 0x50, 0xA5, 0x6A, 0xC9, 0xB2, 
+0xF7, 0x3A, 0x06, 0x4F, 0x15, 0x04, 0xBC, 0x1A, 
+0xE0, 0xC1, 0x1A, 0x12, 0xF2, 0xAD, 0xFE, 0x8E, 
+0xE0, 0x99, 0x0E, 0x4B, 0x69, 0x97, 0x34, 0x7C, 
+0x7D, 0x32, 0x05, 0xC5, 0x4C, 0xB7, 0xD6, 0x3F, 
+0x0B, 0xFC, 0xAD, 0x20, 0x71, 0x2F, 0x37, 0x90, 
+0x8A, 0x85, 0x78, 0xD7, 0x2A, 0x98, 0xCE, 0x96, 
+0x77, 0x27, 0xB8, 0x71, 0xEE, 0x3C, 0x8A, 0xED, 
+0xE3, 0x15, 0x9B, 0x2D, 0x84, 0x11, 0xCE, 0x43, 
+0x3F, 0xB6, 0x22, 0x63, 0x2A, 0x03, 0x7A, 0xA9, 
+0xFE, 0x8B, 0x23, 0x72, 0x2A, 0x78, 0xE3, 0x86, 
+0x9D, 0xC6, 0xF0, 0x7C, 0x47, 0x60, 0x50, 0xC5, 
+0xC3, 0x24, 0x59, 0x31, 0xE8, 0x3E, 0x37, 0x07, 
+0xFD, 0xEC, 0xC5, 0x0B, 0xDA, 0xFC, 0x12, 0x89, 
+0xB5, 0x1B, 0x50, 0x5F, 0x71, 0x70, 0x46, 0xBE, 
+0x42, 0xD7, 0x9A, 0x31, 0x48, 0x2F, 0xB8, 0xFB, 
+0xEC, 0x5E, 
+
+0xD8, 0xF3, 0xF2, 0x14, 0x9C, 0xF1, 0xFD, 0xEA, 
+0x4B, 0x78, 0xBB, 0xFA, 0x3A, 0xF7, 0x1F, 0x14, 
+0x89, 0xD1, 0x40, 0x4E, 0x83, 0xCE, 0x65, 0xF0, 
+0x85, 0xA0, 0x53, 0x98, 0xA0, 0xEF, 0xE3, 0x33, 
+0x85, 0xDB, 0x3B, 0xAD, 0xBE, 0xC5, 0x1E, 0x9B, 
+0xDF, 0x8D, 0x71, 0xBC, 0x01, 0x58, 0xB7, 0x3E, 
+0xCF, 0x41, 0x78, 0x36, 0x30, 0x91, 0xEB, 0xAB, 
+0xF9, 0x2E, 0x1C, 0xD0, 0xD4, 0x97, 0xA2, 0xA5, 
+0x24, 0x4B, 0x14, 0x1F, 0x38, 0x25, 0xA1, 0xCF, 
+0x00, 0x36, 0x9A, 0xEC, 0x44, 0xA6, 0x43, 0xF0, 
+0x98, 0x06, 0x52, 0x84, 0x8E, 0xF3, 0xEF, 0xC9, 
+0x94, 0x48, 0xAB, 0xED, 0xCF, 0x7F, 0xD0, 0x21, 
+0xFC, 0xE6, 0x76, 0x08, 0x87, 0xDA, 0x3D, 0x6F, 
+0x63, 0x25, 0x2A, 0x33, 0x79, 0x43, 0xFD, 0xAC, 
+0x09, 0x75, 0x45, 0x27, 0xFE, 0x62, 0xC6, 0x17, 
+0xED, 0x38, 0xE2, 0x52, 0x13, 0xEF, 0x44, 0x4A, 
+0x8F, 0xE7, 0x20, 0x57, 0x86, 0xAE, 0xA2, 0x30, 
+0x5C, 0x1B, 0x75, 0x3E, 0x90, 0xC0, 0x23, 0x08, 
+0xBF, 0x22, 0x4E, 0xDE, 0x95, 0xB8, 0xD5, 0xEF, 
+0x97, 0x20, 0x6D, 0xAD, 0x5C, 0xC2, 0x4B, 0x36, 
+0x74, 0x13, 0xC8, 0x6D, 0x6F, 0x4F, 0x41, 0x85, 
+0xED, 0xD4, 0x36, 0x67, 0x1D, 0x79, 0x50, 0x27, 
+0x21, 0xAB, 0x83, 0x83, 0x29, 0x8B, 0x73, 0x5C, 
+0xA2, 0x12, 0x28, 0xDA, 0x64, 0x94, 0x41, 0x0F, 
+0x9F, 0xE9, 0x35, 0x01, 0x28, 0x59, 0x9F, 0x0E, 
+0x36, 0xDA, 0x4B, 0xCF, 0x9B, 0xB2, 0x75, 0x0F, 
+0x8D, 0xAF, 0x3F, 0x8C, 0xE9, 0x5A, 0x65, 0x73, 
+0x38, 0xBD, 0x86, 0x16, 0xAF, 0x2D, 0xBF, 0xBF, 
+0xAB, 0x1C, 0x82, 0x21, 0x7A, 0x25, 0x00, 0x28, 
+0x30, 0x22, 0x1C, 0xCE, 0xAF, 0x4D, 0xD1, 0x48, 
+0x83, 0xA6, 0x56, 
+
+0xEA, 0xB2, 0x4B, 0x38, 0x85, 0x37, 0x67, 0x1B, 
+0x25, 0x42, 0x98, 0x22, 0x3F, 0x2A, 0xE5, 0xC1, 
+0x5C, 0x7C, 0xB5, 0x8D, 0x97, 0x2C, 0x2E, 0xE3, 
+0x0E, 0xA9, 0x3B, 0x95, 0x8E, 0x91, 0x7A, 0x89, 
+0x27, 0x2A, 0xF6, 0x32, 0xC6, 0x66, 0x75, 0x3A, 
+0x38, 0xA5, 0x15, 0x50, 0xDE, 0xD9, 0xE7, 0x10, 
+0x94, 0xC2, 0x68, 0x79, 0xA6, 0x68, 0x8C, 0x4A, 
+0xF0, 0x3E, 0xBF, 0x1C, 0xD8, 0x87, 0xE3, 0x10, 
+0x6F, 0x11, 0x83, 0x67, 0x26, 0xAA, 0x9F, 0xDA, 
+0x1D, 0x0F, 0xC4, 0xF3, 0x30, 0x5D, 0x51, 0xAE, 
+0x3F, 0x25, 0x04, 0x39, 0x77, 0x46, 0x09, 0x3E, 
+0xB2, 0x34, 0x61, 0xE3, 0xBE, 0x15, 0x82, 0xCA, 
+0x2D, 0x3B, 0x65, 0xDD, 0x47, 0xA4, 0xCF, 0x7F, 
+0x2E, 0x0C, 0xDE, 0xD2, 0x96, 0x9B, 0xDE, 0x19, 
+0x9A, 0xA0, 0x7E, 0x78, 0xCD, 0xFB, 0xDF, 0x88, 
+0x6A, 0xC0, 0x6E, 0xBD, 0xC5, 0x79, 0xF8, 0x5C, 
+0x1E, 0xED, 0xB4, 0xF4, 0x04, 0xE0, 0xD9, 0xD9, 
+0x82, 0x1E, 0x16, 0x41, 0xA2, 0x53, 0x0B, 0x3C, 
+0xA1, 0xFF, 0xAF, 0xC2, 0x7B, 0x0C, 0x67, 0x51, 
+0x0F, 0xD9, 0xB3, 0xB5, 0xC5, 0xD4, 0xD2, 0xFC, 
+0x40, 0x96, 0xF5, 0x81, 0xD1, 0xBA, 0xF0, 0x31, 
+0x8E, 0xEB, 0x75, 0xC1, 0x6D, 0x70, 0x6E, 0x93, 
+0x19, 0x33, 0x82, 0x53, 0x9A, 0x45, 0x6D, 0x16, 
+0x09, 0xCD, 0x8E, 0x79, 0xD3, 0xA2, 0x5E, 0x4F, 
+0xCA, 0xE6, 0xDA, 0xDD, 0xC9, 0x71, 0x68, 0xD2, 
+0x88, 0xB5, 0x75, 0x5E, 0x96, 0xC8, 0xBB, 0xB0, 
+0xEB, 0x51, 0x33, 0xEE, 0x20, 0xEA, 0x5A, 0xA7, 
+0x9C, 0xD5, 0xB6, 0xE6, 0x1C, 0x69, 0x23, 0x80, 
+0xA9, 0x59, 0x04, 0xD0, 0xC6, 0x7C, 0xC9, 0x71, 
+0x4C, 0x94, 0xA4, 0x73, 0x9B, 0x70, 0x40, 0xC0, 
+0x91, 0xA3, 0x3E, 0x7A, 
+
+0xA2, 0x47, 0x89, 0x27, 0x42, 0xFF, 0xD3, 0xBF, 
+0xCB, 0xD3, 0x3B, 0x84, 0x40, 0x8F, 0xA2, 0x82, 
+0x4A, 0xEB, 0xE8, 0x3C, 0x45, 0xD3, 0xFF, 0xF2, 
+0x0E, 0x93, 0x47, 0x00, 0x3D, 0x11, 0xA4, 0xED, 
+0x49, 0xA2, 0xF5, 0xC3, 0x10, 0x8B, 0x48, 0x8F, 
+0x5A, 0xD2, 0x38, 0xAA, 0xE3, 0x6E, 0xA9, 0x39, 
+0x7A, 0x86, 0x27, 0xB8, 0x36, 0x53, 0x51, 0xEC, 
+0x11, 0x16, 0xCB, 0xE2, 0xD5, 0xD8, 0x61, 0xF2, 
+0xDD, 0xA5, 0x3E, 0xD3, 0x7C, 0x3C, 0x86, 0x12, 
+0xEA, 0x27, 0xA5, 0xD9, 0xCD, 0x41, 0x73, 0x02, 
+0x1A, 0x43, 0xF9, 0x5D, 0xD5, 0xE3, 0xED, 0x0B, 
+0x3D, 0xF1, 0xDC, 0x10, 0xBC, 0x89, 0x4B, 0x9F, 
+0xD3, 0x2B, 0x26, 0x58, 0x2B, 0x5B, 0x1C, 0x73, 
+0x49, 0xA9, 0x38, 0x4D, 0x5B, 0xD4, 0x00, 0x3C, 
+0xF4, 0x7D, 0xD0, 0x3B, 0x9C, 0xC5, 0xED, 0x89, 
+0x56, 0xCB, 0xBD, 0xB1, 0xF3, 0xE2, 0x49, 0x61, 
+0x30, 0xF5, 0xE0, 0xA0, 0xF7, 0x28, 0x9D, 0x2A, 
+0xA6, 0x6A, 0xA8, 0xF2, 0x7B, 0x32, 0x5D, 0xF0, 
+0xEA, 0x7F, 0x48, 0x0B, 0x9F, 0x26, 0x22, 0x63, 
+0x24, 0xE2, 0x52, 0xED, 0x85, 0x11, 0xF4, 0x60, 
+0x55, 0xB4, 0x73, 0x1E, 0xA6, 0x67, 0x98, 0x73, 
+0xF3, 0x2F, 0xB4, 0xC5, 0x93, 0xB7, 0x77, 0x7E, 
+0x4F, 0xF4, 0x31, 0x93, 0xA0, 0x00, 0x11, 0x24, 
+0x74, 0xB9, 0xF5, 0xD1, 0x5A, 0x92, 0x54, 0x98, 
+0x7A, 0x4D, 0xE8, 0x2F, 0xC0, 0x42, 0xB1, 0xDA, 
+0x49, 0xAA, 0x33, 0x42, 0x88, 0xC2, 0xB2, 0x07, 
+0x47, 0xDA, 0x16, 0x1D, 0xAD, 0x25, 0x50, 0x2D, 
+0xAD, 0x1A, 0x5C, 0xE3, 0x96, 0x13, 0x86, 0x1B, 
+0xFD, 0x93, 0x1F, 0xED, 0x1E, 0xD2, 0xB9, 0xEC, 
+0x40, 0x9A, 0x7F, 0xD1, 0x1F, 0x42, 0xA9, 0xFE, 
+0x71, 0xDF, 0xB9, 0xF0, 0x42, 
+
+0xEF, 0xE1, 0x57, 0x9A, 0xE1, 0xCB, 0xFA, 0x45, 
+0x9A, 0x0B, 0x30, 0x9E, 0x98, 0xE5, 0xD1, 0x5B, 
+0xAB, 0x54, 0x76, 0x02, 0xCB, 0xDA, 0xC4, 0x22, 
+0xEF, 0xB3, 0x29, 0xA4, 0xF7, 0xAA, 0xA8, 0x28, 
+0x82, 0x53, 0x7B, 0xE7, 0x67, 0xE3, 0xFA, 0xF9, 
+0x92, 0xAE, 0xE0, 0x84, 0xE8, 0xD6, 0xED, 0xB5, 
+0xF2, 0xE1, 0x76, 0x02, 0x89, 0xBD, 0xBC, 0x15, 
+0x5A, 0xD4, 0x29, 0x58, 0x6F, 0x68, 0x68, 0xF5, 
+0x00, 0x80, 0x1A, 0x32, 0xF7, 0xD7, 0xC2, 0xA9, 
+0x59, 0x20, 0x61, 0x7E, 0x2A, 0xE7, 0xDC, 0xD8, 
+0x51, 0x75, 0x55, 0x19, 0x0D, 0x5C, 0x9E, 0x96, 
+0xB4, 0x3B, 0xA3, 0x7F, 0x20, 0x99, 0xF3, 0x8C, 
+0xDD, 0x40, 0x1D, 0xD5, 0xBB, 0x57, 0xCB, 0x55, 
+0x07, 0x33, 0x3C, 0xF6, 0x13, 0x06, 0xBA, 0x64, 
+0x6F, 0x1F, 0xB9, 0xA7, 0x7B, 0x59, 0xF9, 0x9E, 
+0x2D, 0xEF, 0xC3, 0x6E, 0x62, 0x7E, 0x28, 0x96, 
+0xA8, 0x11, 0x9B, 0xB3, 0x83, 0xE8, 0xF0, 0xA6, 
+0x2F, 0xC0, 0xD0, 0x40, 0x86, 0x4E, 0xEF, 0x2C, 
+0xDC, 0x39, 0xEF, 0x13, 0xF1, 0xBA, 0x67, 0x95, 
+0xC3, 0xF5, 0x5A, 0xAE, 0x72, 0x2B, 0xD5, 0xE5, 
+0x71, 0xB1, 0x82, 0x2B, 0x0F, 0xD9, 0x54, 0xAD, 
+0x07, 0xF7, 0xA6, 0xA5, 0xCD, 0xEF, 0x5C, 0x6B, 
+0x50, 0xEB, 0x01, 0xCF, 0x44, 0x18, 0xE9, 0x5A, 
+0x87, 0xE8, 0x60, 0x25, 0x06, 0xF9, 0x92, 0x1B, 
+0xC2, 0x45, 0x86, 0xC3, 0x51, 0xE7, 0xFC, 0xAB, 
+0x0B, 0x91, 0xAD, 0xDB, 0xA4, 0x3E, 0xE4, 0x9E, 
+0x79, 0x1F, 0x3D, 0x01, 0x14, 0x0E, 0x68, 0x5E, 
+0xCF, 0xAB, 0xD3, 0x95, 0x22, 0x20, 0xF4, 0xEB, 
+0x9B, 0x35, 0x24, 0x94, 0x86, 0x89, 0x03, 0x2E, 
+0x16, 0x64, 0x13, 0xDD, 0x98, 0xF3, 0x25, 0xFC, 
+0x5B, 0x15, 0x14, 0xF7, 0x38, 0xA9, 
+
+0x64, 0x41, 0x09, 0xB2, 0x07, 0xD0, 0x9B, 0xDE, 
+0x90, 0x1B, 0x9A, 0xE1, 0x76, 0x88, 0x7B, 0x2A, 
+0xEF, 0x27, 0x8C, 0x0C, 0xA5, 0xE2, 0xEE, 0xEB, 
+0x74, 0xE7, 0x71, 0xFD, 0x8F, 0x95, 0x44, 0x71, 
+0x6A, 0xF2, 0x23, 0xE2, 0xA0, 0x9F, 0x6D, 0x59, 
+0x98, 0xCB, 0x22, 0x02, 0xA4, 0x5B, 0x4C, 0xFD, 
+0x2D, 0xE2, 0x14, 0x95, 0x8D, 0x9F, 0xE6, 0x7F, 
+0xD0, 0x10, 0xF7, 0x1C, 0xEC, 0x0C, 0xBD, 0x2F, 
+0xE1, 0xC3, 0x04, 0xAA, 0x4E, 0xD7, 0x9B, 0xBC, 
+0x96, 0x23, 0xA2, 0x5A, 0xCD, 0x3A, 0x8B, 0x00, 
+0x22, 0xD6, 0x8C, 0xA3, 0x25, 0x22, 0xEE, 0x98, 
+0x6B, 0x29, 0x55, 0x10, 0x0B, 0xB6, 0xC5, 0x58, 
+0x23, 0xF5, 0xED, 0x81, 0x87, 0x98, 0xB9, 0x60, 
+0xCD, 0x4B, 0x4C, 0xFA, 0x18, 0x15, 0x70, 0x26, 
+0x7E, 0xB2, 0x37, 0x8E, 0x9D, 0x14, 0x2E, 0x92, 
+0x7A, 0x57, 0x04, 0xE9, 0xE5, 0x8D, 0xC7, 0x80, 
+0x60, 0x02, 0xED, 0x2B, 0x94, 0x5B, 0x03, 0xE1, 
+0x81, 0x77, 0x15, 0xC3, 0xAF, 0xF2, 0x0B, 0x49, 
+0x5B, 0x0F, 0x5E, 0x5E, 0xC3, 0x9F, 0x55, 0x7A, 
+0x96, 0xDE, 0x9C, 0xA9, 0xC6, 0x17, 0xFF, 0x33, 
+0xC5, 0xF9, 0x64, 0x8C, 0xBF, 0xCC, 0x39, 0x50, 
+0x6E, 0xAF, 0xDA, 0x39, 0x22, 0x12, 0x90, 0x80, 
+0x4F, 0x0F, 0x91, 0xB9, 0xEB, 0xD8, 0x27, 0x42, 
+0xFF, 0x93, 0x18, 0xB1, 0x59, 0x41, 0xDB, 0x52, 
+0x8E, 0x76, 0x49, 0x66, 0x10, 0xAC, 0x4B, 0x0B, 
+0xDD, 0x22, 0x4F, 0xD4, 0xFE, 0xD5, 0x72, 0xB2, 
+0x9E, 0x74, 0xD2, 0x2A, 0x01, 0x09, 0xC8, 0xD0, 
+0x18, 0x13, 0x96, 0xCB, 0xB2, 0xE4, 0x0F, 0x1E, 
+0x30, 0x8F, 0xC9, 0x5A, 0x2B, 0x8B, 0x8C, 0x40, 
+0x35, 0x33, 0x49, 0xB7, 0x4A, 0x11, 0xB1, 0x10, 
+0x14, 0x90, 0xD9, 0x61, 0x5D, 0x2D, 0xE8, 
+
+0x3C, 0xD9, 0x47, 0xCD, 0x73, 0x95, 0x8F, 0x59, 
+0xCD, 0xB3, 0x87, 0x31, 0x40, 0xDD, 0x40, 0x91, 
+0xB3, 0x25, 0x06, 0xC7, 0x6C, 0xFC, 0xC3, 0x5E, 
+0xFE, 0x23, 0x0E, 0x3F, 0x92, 0x14, 0xB0, 0x37, 
+0xB4, 0xC4, 0xE9, 0xE0, 0xE8, 0xBE, 0xF5, 0x07, 
+0x34, 0x41, 0x04, 0x64, 0xE8, 0x9C, 0x5E, 0xF0, 
+0x0B, 0x79, 0x62, 0xBD, 0x0D, 0xD7, 0xA6, 0x38, 
+0x10, 0x4A, 0xAD, 0x76, 0x76, 0xB4, 0xD8, 0x3F, 
+0xAC, 0xFF, 0xDB, 0xDD, 0x2D, 0xB5, 0x4B, 0x59, 
+0xD0, 0x5A, 0x8F, 0xB0, 0x26, 0x3E, 0x80, 0xAF, 
+0x52, 0x55, 0xC3, 0x0E, 0x36, 0xE4, 0xF9, 0x35, 
+0x40, 0xAA, 0xEF, 0x01, 0x86, 0xBF, 0x0C, 0x48, 
+0x9A, 0xF3, 0xE9, 0xB3, 0xEF, 0x5C, 0x81, 0x00, 
+0x6A, 0x9A, 0x35, 0xFB, 0xCC, 0xDE, 0xFD, 0x67, 
+0xF8, 0xDA, 0x44, 0x31, 0x17, 0xF6, 0xEC, 0x1B, 
+0xBC, 0x69, 0xF6, 0x1F, 0x02, 0x84, 0x66, 0x3B, 
+0xD4, 0xCC, 0xE3, 0x1A, 0x37, 0x15, 0xD8, 0x97, 
+0x37, 0xFB, 0xD1, 0x26, 0x62, 0xBC, 0xDB, 0xD9, 
+0x20, 0xB2, 0x96, 0x76, 0xF4, 0xB2, 0x44, 0x29, 
+0x8C, 0x28, 0xD7, 0x44, 0xD7, 0x4B, 0xDE, 0x78, 
+0x8B, 0x6B, 0x70, 0x4C, 0x0F, 0xD0, 0x60, 0xC9, 
+0x63, 0x83, 0x37, 0x86, 0xCA, 0x67, 0xA6, 0xA6, 
+0x72, 0x6B, 0xF4, 0x1E, 0xB8, 0x0C, 0xC9, 0xCB, 
+0x41, 0x0E, 0x6E, 0xA5, 0x07, 0xBA, 0xF3, 0xE3, 
+0xC8, 0x7B, 0xB6, 0x83, 0x0D, 0xB6, 0x1C, 0x20, 
+0x6F, 0xC8, 0x71, 0xCB, 0x2D, 0xA9, 0x2E, 0x10, 
+0x51, 0xC3, 0x2E, 0x89, 0x40, 0xE7, 0xC7, 0x0B, 
+0x13, 0x48, 0xE7, 0x54, 0x02, 0xC9, 0xBC, 0x74, 
+0x31, 0x2D, 0xBE, 0xB8, 0xC8, 0xBF, 0xFD, 0x99, 
+0xAA, 0x75, 0xF3, 0x0C, 0xFF, 0xB5, 0x38, 0x6B, 
+0x8E, 0xB1, 0xC2, 0x03, 0xB1, 0x55, 0x4D, 0x83, 
+
+0x4C, 0x86, 0x96, 0xD3, 0xBB, 0x46, 0x81, 0x7B, 
+0x10, 0xAE, 0xAB, 0xAE, 0xE9, 0x48, 0x6F, 0x80, 
+0x18, 0x55, 0xFA, 0xC6, 0x7A, 0x35, 0x8C, 0xA2, 
+0x2C, 0xD2, 0x8C, 0x21, 0xE0, 0xB8, 0x21, 0xAD, 
+0x1B, 0x21, 0x59, 0x40, 0x57, 0xF6, 0x52, 0xD3, 
+0x69, 0x3E, 0x0C, 0x61, 0x0C, 0x8B, 0xBA, 0x5F, 
+0x67, 0x71, 0x40, 0x79, 0x14, 0xC7, 0x19, 0xBF, 
+0xA0, 0xE9, 0x3D, 0x3B, 0x3C, 0x86, 0x3B, 0x63, 
+0xB4, 0x06, 0xFF, 0x9D, 0xF8, 0x59, 0xED, 0x85, 
+0xD5, 0x82, 0x90, 0x4E, 0xD4, 0x42, 0x91, 0x8A, 
+0xC2, 0x26, 0xCA, 0x6A, 0x07, 0x6B, 0xCD, 0x2D, 
+0x6A, 0x60, 0xF2, 0x8F, 0x1C, 0x82, 0x9B, 0x58, 
+0x4E, 0x36, 0x78, 0x52, 0x58, 0x86, 0x33, 0x8C, 
+0x2A, 0x58, 0xD8, 0xA7, 0x41, 0xC4, 0xAA, 0x86, 
+0x7C, 0x06, 0x2C, 0x00, 0xBB, 0x79, 0x8E, 0xB4, 
+0x4D, 0x2F, 0x09, 0x1D, 0x79, 0xB4, 0xB2, 0x9E, 
+0xB1, 0x35, 0x81, 0x93, 0x27, 0x90, 0x7F, 0x54, 
+0x95, 0x5E, 0x58, 0x5F, 0x97, 0xC4, 0x70, 0x82, 
+0x29, 0x23, 0x46, 0x4F, 0x50, 0x2B, 0xC0, 0xB4, 
+0x75, 0xC9, 0x02, 0x88, 0xD8, 0xB5, 0x77, 0x64, 
+0xB7, 0xCB, 0x3E, 0x9F, 0x5B, 0x7C, 0x60, 0x33, 
+0xEF, 0x05, 0x8A, 0xA4, 0x4C, 0x74, 0xBD, 0xA9, 
+0x65, 0x82, 0x18, 0x62, 0x6E, 0x84, 0xFC, 0x41, 
+0x41, 0xBE, 0xCD, 0x3E, 0xDE, 0x46, 0xC1, 0x2A, 
+0x2B, 0xBA, 0xDB, 0xB6, 0x90, 0xF2, 0x30, 0xED, 
+0x4B, 0x7D, 0xE2, 0xD6, 0x6B, 0xEE, 0x51, 0x1B, 
+0x5D, 0xD0, 0xE8, 0x99, 0x13, 0xFF, 0x92, 0x02, 
+0xD9, 0x6D, 0x6F, 0x92, 0xD7, 0x1C, 0x63, 0x6C, 
+0x07, 0xEA, 0x8D, 0x75, 0xEB, 0x3A, 0x03, 0x38, 
+0xEA, 0x0F, 0x80, 0x89, 0x95, 0x96, 0x10, 0x7B, 
+0x52, 0x27, 0x00, 0x04, 0xEC, 0x74, 0x21, 0xFA, 
+0x88, 
+
+0x4F, 0x4C, 0x14, 0xD1, 0x9E, 0x67, 0x9F, 0x1C, 
+0x23, 0x2C, 0x1A, 0x17, 0x00, 0xE7, 0x1A, 0x90, 
+0x50, 0xBB, 0xC5, 0x24, 0xD2, 0x20, 0x3A, 0x92, 
+0xDE, 0x77, 0x48, 0x4D, 0xC3, 0xC0, 0x68, 0x7A, 
+0x02, 0xDE, 0xDD, 0x20, 0xC3, 0x40, 0x0B, 0x08, 
+0xD8, 0x55, 0xD9, 0x1A, 0x7C, 0xE5, 0xE7, 0x1C, 
+0x1C, 0xDC, 0x7B, 0x13, 0x04, 0xE2, 0x08, 0x84, 
+0x4A, 0x07, 0x63, 0xB7, 0xF7, 0x05, 0x21, 0x44, 
+0x41, 0x52, 0x32, 0x03, 0xCB, 0x5A, 0xA7, 0x12, 
+0x73, 0x12, 0xAE, 0x38, 0xF9, 0x80, 0xFB, 0x67, 
+0x27, 0x17, 0x3D, 0x19, 0x42, 0x4F, 0x70, 0xA2, 
+0xDA, 0x39, 0xAC, 0x69, 0x49, 0x82, 0x78, 0x74, 
+0xDF, 0xD7, 0xE3, 0x6B, 0x6D, 0x64, 0x02, 0x76, 
+0x56, 0xD2, 0x0C, 0x55, 0x97, 0x17, 0x29, 0x42, 
+0x96, 0xB1, 0x12, 0x9B, 0x02, 0xE8, 0x14, 0x54, 
+0x53, 0x3C, 0xBD, 0xC2, 0xF8, 0x41, 0x55, 0x2F, 
+0x22, 0xDE, 0xBC, 0x53, 0x14, 0x7E, 0xD5, 0x00, 
+0xCC, 0xB2, 0x37, 0x09, 0x31, 0x5B, 0xDD, 0xA8, 
+0x53, 0xDC, 0xFF, 0xE8, 0xFE, 0xD9, 0x4C, 0x0D, 
+0xAF, 0xB5, 0xAC, 0xC4, 0x36, 0xBB, 0xBA, 0x51, 
+0x02, 0x3C, 0x9E, 0x76, 0x71, 0xC8, 0x33, 0x09, 
+0x2D, 0x40, 0x1D, 0xC4, 0xE9, 0xAC, 0x0A, 0xA6, 
+0xA0, 0x7C, 0x68, 0x09, 0xEB, 0xC7, 0x3E, 0xBD, 
+0xF2, 0x50, 0xFD, 0x6C, 0x88, 0x91, 0x99, 0x95, 
+0xAE, 0x6A, 0x3A, 0x68, 0xEE, 0x57, 0x5E, 0x30, 
+0xA4, 0x0B, 0x31, 0xFA, 0x0A, 0x5F, 0x8C, 0x6D, 
+0x62, 0xF0, 0xDB, 0x8D, 0x26, 0x55, 0x9B, 0xED, 
+0x89, 0xFB, 0xB1, 0x02, 0x2F, 0x3C, 0x39, 0x64, 
+0xA2, 0x66, 0x0D, 0x36, 0x22, 0xC0, 0xAD, 0xD2, 
+0x7F, 0x3E, 0x33, 0xA2, 0xC7, 0x62, 0xFC, 0xC9, 
+0x2F, 0x75, 0x6F, 0xD9, 0xC5, 0xB4, 0x0E, 0xE2, 
+0x1C, 0x99, 
+
+0x96, 0x7D, 0x1E, 0xC5, 0x11, 0xA0, 0x7F, 0xA4, 
+0xCB, 0xC6, 0xB9, 0x00, 0xEC, 0x73, 0xF5, 0x61, 
+0x9A, 0x7C, 0x1D, 0x74, 0x90, 0xB7, 0xEC, 0x0A, 
+0x9F, 0xE1, 0x22, 0xCF, 0xAC, 0x9B, 0x19, 0x1A, 
+0xA6, 0x1C, 0x89, 0xFD, 0x51, 0xA0, 0x23, 0xC7, 
+0x56, 0xBF, 0x0A, 0xE7, 0x90, 0xF0, 0xBB, 0xA5, 
+0x23, 0x7A, 0x66, 0x90, 0x65, 0xA4, 0xA3, 0x7C, 
+0xB2, 0x46, 0xED, 0x6B, 0x40, 0x8F, 0x08, 0xE1, 
+0x57, 0xAD, 0x95, 0x9A, 0x55, 0x12, 0x82, 0x45, 
+0xB8, 0x66, 0xDB, 0x1F, 0x9A, 0xF1, 0x84, 0xF1, 
+0xDE, 0xCB, 0x62, 0xAF, 0x59, 0x10, 0xD9, 0xC8, 
+0x7B, 0x62, 0xCD, 0xAE, 0x38, 0xB9, 0xBA, 0x79, 
+0x21, 0xBD, 0x8D, 0x8D, 0x68, 0xCF, 0x26, 0xC5, 
+0x59, 0x89, 0x3C, 0x2E, 0xCD, 0xA8, 0x5C, 0x27, 
+0x20, 0x0A, 0x7F, 0x6C, 0x65, 0xA0, 0x2D, 0x7A, 
+0x37, 0x66, 0xEA, 0x9C, 0xE6, 0xD2, 0xB5, 0x2F, 
+0xD7, 0x96, 0x94, 0x48, 0x57, 0x74, 0xC9, 0x0A, 
+0x1D, 0xEE, 0x3A, 0x32, 0xFF, 0x6A, 0x48, 0xE0, 
+0xF7, 0x1E, 0x40, 0xB4, 0x93, 0xD6, 0x8A, 0x51, 
+0x5E, 0xC8, 0x39, 0xBE, 0x0F, 0x85, 0x1D, 0x04, 
+0x66, 0xCD, 0x96, 0x48, 0xC9, 0xE2, 0x37, 0x4B, 
+0xE0, 0xF8, 0x99, 0xD5, 0x4D, 0x93, 0xBF, 0x7E, 
+0xFE, 0x3E, 0x7F, 0x69, 0x75, 0x8C, 0xA3, 0xAE, 
+0xC0, 0xEC, 0x70, 0x23, 0x6E, 0xF6, 0x9A, 0xDC, 
+0xC1, 0xD6, 0x37, 0x02, 0x69, 0xA6, 0xA4, 0xD7, 
+0xDA, 0x8C, 0xFA, 0x8D, 0x38, 0xF4, 0xD5, 0x89, 
+0x1D, 0xD4, 0x5E, 0x0A, 0x4F, 0xC7, 0x06, 0x9C, 
+0x3B, 0x8E, 0x24, 0xE9, 0x33, 0x25, 0x87, 0x63, 
+0x1F, 0x7E, 0x93, 0xC3, 0x50, 0xA3, 0x8C, 0xF3, 
+0x67, 0xF1, 0xD7, 0xCB, 0x39, 0xD5, 0x04, 0x10, 
+0x97, 0xAB, 0xBC, 0x60, 0x5B, 0x87, 0x74, 0x5B, 
+0xED, 0xFF, 0x71, 
+
+0x66, 0x01, 0xC0, 0x0A, 0x7E, 0x64, 0x13, 0xE3, 
+0xDB, 0x68, 0xDE, 0xF6, 0x1B, 0x42, 0x43, 0xA7, 
+0x09, 0xB7, 0x49, 0xF0, 0x29, 0xD0, 0xB3, 0x31, 
+0x9E, 0xE0, 0x7A, 0x95, 0x34, 0xBF, 0x05, 0x69, 
+0xD5, 0x50, 0x72, 0x49, 0x66, 0xC3, 0xCA, 0x0B, 
+0x44, 0x6F, 0x68, 0x2D, 0x35, 0x4B, 0xF2, 0x51, 
+0x3A, 0xCE, 0x32, 0xE3, 0xB9, 0xA7, 0x99, 0x22, 
+0x52, 0x57, 0x1B, 0x19, 0x55, 0x18, 0xAE, 0x81, 
+0x8D, 0x03, 0xE3, 0xE7, 0x58, 0x1C, 0x11, 0x5B, 
+0x07, 0x90, 0x28, 0x6E, 0xD4, 0x72, 0x1C, 0x14, 
+0x4C, 0x7B, 0xC6, 0x34, 0xDA, 0x87, 0x02, 0x24, 
+0x20, 0xAF, 0x85, 0x3B, 0xEE, 0x96, 0x55, 0x97, 
+0x16, 0x6E, 0xFB, 0x58, 0xAF, 0x7A, 0x73, 0x7F, 
+0x81, 0x86, 0x94, 0x49, 0x47, 0x9E, 0xF9, 0xF4, 
+0x37, 0xEE, 0x41, 0xB5, 0xCA, 0xD1, 0xBB, 0x28, 
+0x06, 0xE3, 0xDA, 0x28, 0x93, 0x6E, 0xB4, 0x22, 
+0xC7, 0xEB, 0x10, 0xDA, 0x03, 0x51, 0x19, 0x44, 
+0xFE, 0xD9, 0xAF, 0xF7, 0x75, 0xD4, 0x3D, 0xE4, 
+0x8C, 0x14, 0x1B, 0xB1, 0x5A, 0xCB, 0xF3, 0x34, 
+0x60, 0xA8, 0x15, 0x39, 0x12, 0x7B, 0xFD, 0x2B, 
+0x60, 0xF6, 0x3A, 0x19, 0x9A, 0x45, 0x06, 0x02, 
+0x53, 0xCB, 0x10, 0xB8, 0xCB, 0x5A, 0xDE, 0x0E, 
+0x82, 0x0D, 0x51, 0x7F, 0x2D, 0x3D, 0x50, 0x97, 
+0x01, 0x82, 0xE6, 0xC7, 0x9C, 0x3F, 0xD0, 0x00, 
+0x44, 0xFF, 0xD6, 0x73, 0xAF, 0x8D, 0xB4, 0xCC, 
+0x6D, 0x58, 0xC7, 0x2E, 0x32, 0x80, 0xCB, 0x90, 
+0xD9, 0x90, 0x11, 0x71, 0xBE, 0x26, 0xAF, 0xDD, 
+0x96, 0xC4, 0xD4, 0xAA, 0x5D, 0x7B, 0x7A, 0x9A, 
+0xB2, 0xB8, 0xCA, 0xC2, 0xC8, 0x56, 0x6C, 0x7E, 
+0xE2, 0x3A, 0x74, 0xB9, 0xCA, 0xB1, 0xEC, 0x42, 
+0xAA, 0x5C, 0x36, 0x9B, 0xD1, 0x27, 0x90, 0x57, 
+0xF5, 0xCB, 0x42, 0xDA, 
+
+0xBC, 0x4A, 0x59, 0xF6, 0x99, 0x57, 0x88, 0x2C, 
+0x67, 0x1C, 0x5E, 0x0F, 0x95, 0xA0, 0x2C, 0x56, 
+0x44, 0xA2, 0xF2, 0x86, 0x6F, 0x52, 0x91, 0x0B, 
+0x4B, 0xD3, 0xCF, 0xFE, 0xA9, 0xE2, 0xB0, 0xCD, 
+0x15, 0xE4, 0x47, 0xC0, 0x50, 0x3A, 0xCA, 0x58, 
+0xD2, 0x89, 0x42, 0xB4, 0x1E, 0xA3, 0xAA, 0x7A, 
+0xC2, 0x37, 0xF3, 0x98, 0x95, 0x12, 0x71, 0xF5, 
+0xEE, 0xA5, 0xEB, 0x4C, 0x00, 0x86, 0x49, 0x41, 
+0x50, 0xF5, 0x5A, 0x14, 0x93, 0x4D, 0x23, 0x9F, 
+0xBD, 0x77, 0x07, 0x46, 0xA9, 0x57, 0x54, 0xD1, 
+0xC7, 0xFD, 0x91, 0xCC, 0x63, 0xE7, 0x6D, 0x7D, 
+0x4D, 0x05, 0x34, 0x05, 0x39, 0xC4, 0x33, 0x03, 
+0x61, 0xA6, 0x13, 0x79, 0x01, 0xA4, 0xC9, 0xD3, 
+0x39, 0xE4, 0xEF, 0x02, 0xE1, 0xD6, 0x89, 0x51, 
+0x88, 0x11, 0x0F, 0x7C, 0xF8, 0x04, 0x35, 0xA4, 
+0x4E, 0xF6, 0xF6, 0x76, 0xB3, 0xE7, 0x10, 0xFF, 
+0xBD, 0x30, 0x65, 0x9D, 0x9B, 0xE9, 0x77, 0x3F, 
+0x1E, 0x2D, 0x28, 0x25, 0xD4, 0x2B, 0xB3, 0xD1, 
+0x4E, 0x88, 0xE1, 0xFB, 0x3B, 0xC1, 0x0A, 0xC7, 
+0x69, 0x7F, 0xDF, 0x3C, 0x2F, 0x65, 0x0C, 0x14, 
+0x2F, 0xCE, 0x9E, 0x63, 0x58, 0xA8, 0x00, 0xD8, 
+0x81, 0xE1, 0x87, 0xAE, 0x62, 0xFA, 0x8D, 0x53, 
+0xA1, 0x0C, 0x12, 0xA5, 0xB8, 0xE3, 0xD1, 0x1B, 
+0x48, 0x14, 0xCE, 0x57, 0xAC, 0xBB, 0x4F, 0xA0, 
+0x9D, 0xBF, 0xB5, 0x95, 0xEF, 0x3D, 0xB6, 0xAB, 
+0x69, 0x73, 0x47, 0xD3, 0xD6, 0x51, 0x1F, 0x04, 
+0xDF, 0x7C, 0xF6, 0xFB, 0x14, 0xF6, 0xBE, 0xF6, 
+0x9C, 0x0F, 0xE9, 0x31, 0x65, 0x4A, 0x10, 0x5D, 
+0xBA, 0xDC, 0x58, 0x2F, 0xEF, 0x34, 0x1E, 0x4E, 
+0xAC, 0x42, 0xA3, 0x3D, 0xDC, 0x87, 0xDF, 0x0B, 
+0x70, 0x57, 0xE2, 0x93, 0xBF, 0xEA, 0x99, 0xBB, 
+0xF6, 0xC3, 0x9D, 0x10, 0xBA, 
+
+0xF9, 0x60, 0xAE, 0x92, 0x92, 0x29, 0x83, 0x71, 
+0x13, 0x50, 0x53, 0x43, 0x4F, 0x0C, 0x03, 0xFF, 
+0xC9, 0x53, 0x0C, 0xB5, 0xD5, 0x12, 0x4E, 0x89, 
+0x34, 0x6C, 0x82, 0x9F, 0xC4, 0x3B, 0x96, 0x4D, 
+0x43, 0xCC, 0x7C, 0x30, 0x7F, 0x87, 0x85, 0x92, 
+0xD6, 0xA4, 0xB7, 0x2B, 0x61, 0x98, 0xDE, 0xE1, 
+0x80, 0xBF, 0x22, 0x74, 0x8B, 0x97, 0x5D, 0x65, 
+0x04, 0xA2, 0xFE, 0x1F, 0x70, 0x00, 0x37, 0xA9, 
+0x99, 0xE5, 0x35, 0x7A, 0xE5, 0x69, 0x31, 0x58, 
+0xA6, 0xA2, 0x18, 0x60, 0xDE, 0xC5, 0x5E, 0xDF, 
+0x8E, 0x58, 0x40, 0x2B, 0x34, 0x47, 0xA5, 0xB6, 
+0x0B, 0x54, 0xD9, 0xBC, 0x1E, 0x14, 0x3C, 0x28, 
+0xDB, 0x61, 0xFA, 0x4A, 0xD2, 0x45, 0xA7, 0x84, 
+0xB2, 0x5B, 0x5D, 0xE9, 0x5A, 0xA2, 0x19, 0x91, 
+0x9B, 0x77, 0xA7, 0x6A, 0xAB, 0x94, 0x31, 0x25, 
+0x66, 0xF2, 0xC6, 0x9B, 0xBE, 0xAF, 0xBC, 0xAB, 
+0xD7, 0x74, 0x27, 0x96, 0xE8, 0x06, 0xC0, 0x32, 
+0xBF, 0xBD, 0x62, 0x7B, 0xBD, 0x53, 0xE7, 0x5A, 
+0x4D, 0xED, 0x9D, 0xD2, 0x84, 0xB7, 0xD3, 0x5E, 
+0x94, 0x3D, 0x6D, 0x88, 0xD4, 0xCA, 0xFF, 0x5B, 
+0x1A, 0xB8, 0x14, 0x14, 0xCB, 0x97, 0x44, 0xA1, 
+0x6E, 0x6C, 0x00, 0x28, 0x5F, 0x87, 0x79, 0xDA, 
+0xBE, 0x51, 0xCD, 0x35, 0x85, 0x6F, 0x8B, 0x1E, 
+0x2E, 0xD1, 0xBC, 0xAB, 0x3D, 0xC4, 0x98, 0xB8, 
+0xBD, 0x79, 0xE5, 0xCC, 0xA2, 0xAE, 0xA0, 0x6E, 
+0xBB, 0x7C, 0x23, 0x33, 0xC7, 0x09, 0x81, 0xB0, 
+0x75, 0x49, 0x54, 0x78, 0xD3, 0x65, 0xDD, 0x6A, 
+0x0C, 0x55, 0xF5, 0xE0, 0x5E, 0x85, 0x4C, 0x64, 
+0x18, 0xD3, 0x02, 0xDC, 0x6C, 0x69, 0x8A, 0x4E, 
+0xC8, 0x2B, 0x98, 0x8C, 0x05, 0x89, 0xAF, 0x3E, 
+0x2C, 0x2A, 0xC8, 0xEF, 0x4A, 0xFB, 0xD9, 0x33, 
+0x78, 0x7D, 0xDC, 0x0F, 0xD7, 0x26, 
+
+0x4E, 0xF4, 0x38, 0x32, 0x36, 0x0B, 0x9C, 0x04, 
+0x53, 0x2C, 0xEF, 0x4D, 0x54, 0x23, 0x12, 0xF8, 
+0x9A, 0xFF, 0x68, 0x2E, 0x1E, 0x51, 0x29, 0x47, 
+0x9A, 0x6D, 0xB7, 0x1C, 0xD1, 0x57, 0x73, 0x9D, 
+0x20, 0x12, 0x77, 0xE0, 0x00, 0xC6, 0xBE, 0x24, 
+0xE6, 0xF4, 0xDA, 0xC1, 0xD8, 0xD0, 0x31, 0x0A, 
+0x61, 0xA3, 0xC3, 0x60, 0xEF, 0x47, 0x47, 0x4C, 
+0xD1, 0x85, 0x7E, 0xE2, 0x21, 0xC5, 0x2D, 0xAC, 
+0x98, 0x78, 0x8F, 0x63, 0x93, 0x91, 0x6A, 0x5D, 
+0x4B, 0xD1, 0xA5, 0x95, 0x1D, 0x4E, 0x49, 0x30, 
+0x97, 0x7F, 0x48, 0x6A, 0x49, 0xFD, 0x0C, 0x8A, 
+0x74, 0x9B, 0x0F, 0x0B, 0x2A, 0x5C, 0x2F, 0x4C, 
+0x9C, 0xCA, 0xF1, 0x6C, 0x3A, 0x0C, 0x14, 0xE2, 
+0xD9, 0x59, 0xDD, 0xA8, 0x48, 0xF6, 0xBF, 0xEA, 
+0x75, 0x0B, 0x42, 0xE6, 0xE1, 0xBE, 0x51, 0x14, 
+0xEF, 0xD9, 0x37, 0x8D, 0xA2, 0xEA, 0x0A, 0x97, 
+0xDF, 0xB8, 0xE3, 0xBC, 0x30, 0x9F, 0xCB, 0xB9, 
+0x33, 0x6C, 0x89, 0x7B, 0x97, 0xF1, 0xFC, 0xF6, 
+0xAF, 0x69, 0x60, 0x25, 0xE2, 0xA9, 0xF7, 0xD9, 
+0xC9, 0x53, 0xA4, 0x1D, 0xC7, 0xC2, 0x45, 0xC7, 
+0x85, 0xA8, 0x09, 0x92, 0x08, 0x32, 0x14, 0x90, 
+0xAD, 0x12, 0xE5, 0xE4, 0x1B, 0x06, 0xEE, 0xFF, 
+0x33, 0x86, 0x70, 0x89, 0xC4, 0xD6, 0x43, 0x1F, 
+0xAD, 0x36, 0x42, 0xE9, 0x93, 0xE2, 0x3E, 0x44, 
+0xF8, 0x48, 0xEF, 0x54, 0xD0, 0x7E, 0xBA, 0x66, 
+0x10, 0x7D, 0x87, 0xFD, 0xE7, 0x39, 0xFD, 0xCC, 
+0x20, 0xB6, 0x68, 0xCA, 0x66, 0x1C, 0xCC, 0x60, 
+0xFF, 0x37, 0x82, 0x1C, 0x65, 0x15, 0xCA, 0x23, 
+0x14, 0x83, 0x58, 0x75, 0x88, 0xDF, 0xEA, 0x20, 
+0x8A, 0x1C, 0x65, 0xE2, 0xD2, 0xE5, 0x27, 0x2F, 
+0x86, 0xB5, 0xB3, 0xC3, 0x48, 0x7C, 0x35, 0x25, 
+0x82, 0xDB, 0x7E, 0xD0, 0x1B, 0xC6, 0x8C, 
+
+0x8E, 0x24, 0x6B, 0x37, 0xB9, 0x53, 0x8F, 0x22, 
+0x36, 0x7A, 0x7B, 0x47, 0x46, 0x6E, 0x8B, 0x3B, 
+0x07, 0xD2, 0x24, 0x32, 0xC5, 0x8E, 0x11, 0xB2, 
+0x69, 0x2D, 0x02, 0x4F, 0x3C, 0xC2, 0x43, 0xA2, 
+0x21, 0xE0, 0x69, 0x4F, 0x63, 0xAF, 0x21, 0x3C, 
+0x59, 0xA9, 0x5F, 0x92, 0x3E, 0xE9, 0x23, 0x7E, 
+0xE7, 0xAB, 0xFD, 0xE8, 0x52, 0x3F, 0x35, 0xEB, 
+0x4D, 0xB4, 0x0D, 0xDD, 0x15, 0x1F, 0x02, 0x95, 
+0x53, 0x94, 0xF0, 0xDD, 0xB3, 0x46, 0xD1, 0xAE, 
+0xA9, 0x9F, 0xFF, 0x7A, 0xA4, 0x8B, 0x25, 0xF8, 
+0xE0, 0x4F, 0xBB, 0xFB, 0x35, 0x7D, 0xF6, 0x26, 
+0xEE, 0x01, 0x7C, 0x9D, 0x5A, 0xA5, 0x56, 0xB7, 
+0x6A, 0x0E, 0xBE, 0x7C, 0xA7, 0xB7, 0xE9, 0xE9, 
+0xEF, 0x0E, 0x2D, 0xA8, 0xB8, 0x30, 0x8B, 0xE3, 
+0xCE, 0x00, 0x26, 0x9C, 0x5C, 0xD1, 0xB0, 0x59, 
+0x50, 0x0A, 0x51, 0xCD, 0xA8, 0x1E, 0xDA, 0x66, 
+0x9C, 0x56, 0x4F, 0x07, 0x6C, 0xAF, 0x90, 0xF9, 
+0x93, 0xDB, 0x75, 0x64, 0x30, 0xA5, 0x54, 0x51, 
+0x30, 0x0B, 0x02, 0x89, 0xB5, 0x3F, 0xFC, 0x5E, 
+0xEB, 0xD8, 0x85, 0x4E, 0xA0, 0x94, 0x03, 0x32, 
+0x69, 0x22, 0x34, 0x18, 0x9C, 0xDC, 0x21, 0xE7, 
+0x42, 0x03, 0x21, 0x71, 0x71, 0x9A, 0xE3, 0x06, 
+0xA3, 0xA5, 0x7C, 0x25, 0xDD, 0xB6, 0x2E, 0x1D, 
+0x6F, 0x81, 0x83, 0x0E, 0xA6, 0x5D, 0x61, 0xDF, 
+0x45, 0xE8, 0x4F, 0x9F, 0xF2, 0x02, 0x5D, 0x5B, 
+0x92, 0xBA, 0xF0, 0x53, 0x03, 0x1F, 0x57, 0xA9, 
+0x30, 0x3B, 0x2D, 0x39, 0x35, 0xF0, 0x9E, 0x9B, 
+0x08, 0x2D, 0x73, 0x1F, 0x64, 0x6B, 0x81, 0x1D, 
+0xF7, 0xAE, 0xB1, 0x9E, 0xC3, 0x89, 0x91, 0x39, 
+0xA5, 0x96, 0x60, 0x6E, 0xAF, 0x0B, 0x84, 0x5D, 
+0xFF, 0x91, 0x3A, 0xA2, 0x26, 0xD0, 0x0F, 0xFB, 
+0xD9, 0xA2, 0x11, 0xC8, 0xCE, 0x1E, 0xBB, 0xEF, 
+
+0x55, 0x04, 0x2D, 0xA7, 0x1D, 0x36, 0xDE, 0xAB, 
+0xFC, 0x54, 0x82, 0x61, 0xAA, 0x94, 0xAD, 0x92, 
+0x30, 0x46, 0xC0, 0x02, 0x8B, 0x8E, 0x5F, 0x14, 
+0xA8, 0xF9, 0x90, 0x35, 0xDA, 0x92, 0x5B, 0x02, 
+0xFD, 0x01, 0x32, 0x80, 0xFE, 0x0E, 0x3E, 0xA2, 
+0x2D, 0xBF, 0x8A, 0x8F, 0xBD, 0x47, 0x93, 0xE7, 
+0xD5, 0x1A, 0x7B, 0xAC, 0x68, 0x2F, 0x56, 0xC6, 
+0xD8, 0x08, 0x8F, 0xA5, 0xA3, 0xFB, 0x39, 0xAE, 
+0x09, 0xD8, 0x41, 0xC6, 0x6E, 0x95, 0x5F, 0x37, 
+0xC9, 0xE7, 0x56, 0x57, 0x03, 0x5D, 0xBC, 0x19, 
+0x47, 0x13, 0xE8, 0x1A, 0x68, 0xA1, 0x12, 0x8E, 
+0x9D, 0xA9, 0x36, 0x73, 0x0F, 0x41, 0x90, 0xAB, 
+0x4E, 0x6B, 0x1F, 0x5A, 0x5E, 0x3F, 0x95, 0xC0, 
+0x84, 0x4F, 0x9D, 0x7E, 0x84, 0xF4, 0xFD, 0x59, 
+0x4D, 0x18, 0x50, 0xBF, 0x24, 0xDB, 0x17, 0xFA, 
+0x57, 0xAF, 0x61, 0xE8, 0xE3, 0x20, 0x8F, 0x08, 
+0xA7, 0x1A, 0x55, 0x12, 0x6A, 0x0D, 0x9D, 0xC1, 
+0xC7, 0x55, 0xAD, 0xF1, 0xDC, 0xF3, 0x63, 0x6B, 
+0x3A, 0x8C, 0xD9, 0x9B, 0x9D, 0x83, 0x81, 0x57, 
+0x9A, 0xB5, 0x5D, 0x4C, 0x02, 0x53, 0x5A, 0x00, 
+0x7F, 0x63, 0x6A, 0x08, 0x80, 0xFF, 0x52, 0x6A, 
+0x74, 0xD6, 0x2F, 0x50, 0x00, 0x63, 0x9C, 0x56, 
+0x3D, 0x3E, 0x96, 0x2F, 0x18, 0x89, 0x2A, 0xF7, 
+0x52, 0x91, 0x52, 0x90, 0x0E, 0x7A, 0xD0, 0x77, 
+0xA7, 0x40, 0x04, 0x3B, 0x6C, 0x7F, 0x12, 0xDA, 
+0xEC, 0x7F, 0x7B, 0xDA, 0xF8, 0xA0, 0xFD, 0xEF, 
+0xF0, 0xE0, 0xC5, 0x92, 0x4F, 0xB7, 0x30, 0x7A, 
+0xE7, 0x56, 0x75, 0x2F, 0x83, 0xEF, 0xA9, 0x89, 
+0xB2, 0xE6, 0x0D, 0x77, 0x83, 0x22, 0x09, 0xB9, 
+0x7F, 0x55, 0x74, 0x32, 0x26, 0xB0, 0x3C, 0x55, 
+0xEE, 0xA4, 0x59, 0x46, 0xFB, 0x0B, 0x9B, 0x49, 
+0x62, 0x1B, 0x14, 0x2C, 0x66, 0xB2, 0xC3, 0x53, 
+0x52, 
+
+0x4F, 0x70, 0xD4, 0xD0, 0x2C, 0xB7, 0xA7, 0xEF, 
+0x2C, 0x3F, 0xA4, 0x6C, 0x64, 0xA6, 0xEB, 0xA1, 
+0xD1, 0xC8, 0xBF, 0x25, 0x78, 0xBE, 0xFF, 0x58, 
+0x74, 0x33, 0x7D, 0x1D, 0xC3, 0x96, 0xA3, 0xE3, 
+0xA6, 0x84, 0x6E, 0x06, 0x20, 0x17, 0x2B, 0x37, 
+0x6C, 0x3C, 0xCD, 0xD9, 0x87, 0xF0, 0x99, 0x03, 
+0x62, 0x02, 0xAE, 0xF9, 0xA2, 0x98, 0xA9, 0x62, 
+0x35, 0x32, 0xB8, 0xF3, 0xE2, 0x54, 0xA7, 0xB0, 
+0xDA, 0x5E, 0xD6, 0xD5, 0xAB, 0x7F, 0x81, 0x57, 
+0xD8, 0x13, 0x36, 0xE1, 0x15, 0x31, 0x4F, 0xC4, 
+0xDB, 0x11, 0xD7, 0x86, 0x00, 0x7D, 0x6A, 0x98, 
+0xB5, 0xA5, 0xD7, 0x9F, 0x9D, 0x62, 0xE5, 0x89, 
+0x2E, 0xB0, 0x89, 0xE3, 0x00, 0xDC, 0x39, 0xCE, 
+0x32, 0xFF, 0x86, 0xB6, 0xFA, 0x7F, 0x8D, 0x9E, 
+0x44, 0xCD, 0x74, 0x14, 0x8A, 0xB2, 0xD4, 0x4A, 
+0xC4, 0x95, 0xA6, 0x46, 0x25, 0x27, 0xC8, 0x41, 
+0x1F, 0x36, 0x53, 0x63, 0x83, 0xF5, 0xA2, 0xE6, 
+0x56, 0x3F, 0x0B, 0xFE, 0x2A, 0x55, 0x2E, 0x35, 
+0x72, 0x9E, 0x0A, 0x59, 0x48, 0x64, 0xDD, 0xCB, 
+0x7C, 0xE0, 0x63, 0xE3, 0x3E, 0xCC, 0xA7, 0x50, 
+0xEB, 0x07, 0x1E, 0x66, 0x75, 0xCF, 0x1E, 0xCD, 
+0xAF, 0xC1, 0x0F, 0xF2, 0xF3, 0x61, 0xDC, 0xC0, 
+0x94, 0x0A, 0x0E, 0xDC, 0xB7, 0x87, 0xBD, 0xB2, 
+0x6D, 0xAA, 0x69, 0x68, 0x39, 0xF5, 0xA2, 0x70, 
+0x5A, 0xCB, 0x55, 0x5A, 0x94, 0x7A, 0x75, 0x88, 
+0x9A, 0x6A, 0x9B, 0xAE, 0x2B, 0xDE, 0xEC, 0x46, 
+0x38, 0x41, 0xE5, 0x96, 0x99, 0xED, 0xA5, 0x7A, 
+0xD0, 0x20, 0xAA, 0x64, 0xC9, 0x57, 0x8B, 0xA0, 
+0x4B, 0x5E, 0x07, 0x67, 0xAA, 0xFA, 0x2B, 0xDE, 
+0xF1, 0xDA, 0x50, 0x90, 0xE0, 0xE4, 0x49, 0x40, 
+0x9B, 0x02, 0xE0, 0x33, 0xDD, 0xE0, 0x99, 0x5C, 
+0x3D, 0xF1, 0x73, 0x8F, 0x17, 0x64, 0x06, 0x5F, 
+0x81, 0x8F, 
+
+0x19, 0x0D, 0x12, 0xEC, 0x7E, 0xCB, 0xE1, 0xE1, 
+0x0C, 0xED, 0x15, 0x11, 0x76, 0x63, 0x01, 0x21, 
+0x95, 0x7D, 0xD5, 0x27, 0x4E, 0xF2, 0xE7, 0xBB, 
+0xC7, 0x58, 0x0A, 0x5A, 0x49, 0x74, 0x4A, 0xA0, 
+0xA9, 0x9E, 0x60, 0x4E, 0x40, 0xAB, 0xB5, 0x62, 
+0xA2, 0x5C, 0x46, 0x84, 0x48, 0x0C, 0x58, 0x9A, 
+0xA7, 0x0E, 0x28, 0x56, 0x41, 0x7A, 0x47, 0x4E, 
+0xE3, 0x64, 0xE8, 0x58, 0x62, 0x59, 0x1A, 0xB8, 
+0x10, 0x65, 0x92, 0x9D, 0x27, 0x3B, 0xBE, 0x32, 
+0x51, 0x47, 0x63, 0xA7, 0x43, 0xC3, 0x21, 0xA8, 
+0x74, 0x58, 0x74, 0x77, 0xD4, 0xAD, 0x04, 0x29, 
+0x6E, 0xCE, 0xFD, 0xE4, 0xEF, 0x6B, 0xD6, 0xA7, 
+0x79, 0xB6, 0x7C, 0x77, 0x6C, 0xA9, 0x19, 0xB0, 
+0x29, 0xF3, 0x0B, 0x9A, 0x80, 0x0D, 0x5E, 0x2E, 
+0xDC, 0x1C, 0x78, 0x38, 0x75, 0x07, 0xF1, 0x4E, 
+0x4D, 0xBF, 0x5A, 0x52, 0x60, 0x4A, 0x6E, 0x0C, 
+0xB2, 0x49, 0x8C, 0x7E, 0x9A, 0x58, 0x75, 0xE8, 
+0x08, 0xC5, 0x63, 0x06, 0xB2, 0x9F, 0x2B, 0x94, 
+0xD3, 0x17, 0x1F, 0xDA, 0x4D, 0x1A, 0xBF, 0x63, 
+0xBF, 0x54, 0x81, 0x21, 0x54, 0x02, 0x2E, 0xE2, 
+0x29, 0x99, 0x3E, 0x5B, 0xAF, 0x48, 0x76, 0xE7, 
+0xA0, 0xB5, 0x71, 0x7A, 0x09, 0x9C, 0x08, 0xD3, 
+0x3A, 0x7A, 0xCA, 0x25, 0x24, 0x0E, 0x39, 0x22, 
+0x9D, 0x54, 0xA5, 0xF6, 0x77, 0x96, 0xB1, 0x5C, 
+0xA5, 0x92, 0x0D, 0x73, 0xEB, 0x8D, 0xCD, 0xD3, 
+0x0E, 0xC4, 0xDE, 0x5F, 0xDA, 0x07, 0xB3, 0xEC, 
+0xB3, 0x35, 0x04, 0xEB, 0xCD, 0x4A, 0x57, 0xA1, 
+0xF3, 0x78, 0xFB, 0xE3, 0xFB, 0x51, 0x09, 0xAB, 
+0xD2, 0xAF, 0xAB, 0x71, 0x68, 0x78, 0x7F, 0x39, 
+0x41, 0xB7, 0x92, 0x63, 0xC5, 0x48, 0xE6, 0x7B, 
+0x50, 0xB9, 0x98, 0xCD, 0xA9, 0x39, 0xFD, 0xAB, 
+0xE0, 0x7D, 0x81, 0xF0, 0x96, 0x5C, 0x56, 0x2F, 
+0xCC, 0xA2, 0x30, 
+
+0x39, 0x38, 0x82, 0x93, 0xB9, 0xC6, 0x25, 0x49, 
+0x4C, 0xC9, 0x39, 0x5D, 0x02, 0x83, 0xCC, 0xC1, 
+0x91, 0x2C, 0x2B, 0xDD, 0x12, 0x0A, 0x36, 0x55, 
+0xF4, 0x47, 0xE4, 0xDF, 0xFB, 0x3A, 0xB1, 0x8B, 
+0x3B, 0x6B, 0x62, 0x37, 0xA3, 0xCC, 0x84, 0x6B, 
+0x6F, 0x62, 0x28, 0xAE, 0x97, 0xDE, 0x20, 0x6B, 
+0x4E, 0x0F, 0xE8, 0x6C, 0xF5, 0xB3, 0xB2, 0x11, 
+0xE1, 0x41, 0x9F, 0x76, 0xF1, 0xA5, 0xB7, 0x0E, 
+0xCE, 0xD4, 0xBC, 0xBD, 0x7A, 0x51, 0x83, 0x24, 
+0x17, 0xC3, 0xFD, 0x6F, 0xDE, 0x6A, 0xCB, 0x4E, 
+0x67, 0xE6, 0x11, 0x38, 0x84, 0xF1, 0xFB, 0x17, 
+0x18, 0xAB, 0xBB, 0x63, 0x32, 0xDD, 0x9C, 0xBC, 
+0x73, 0x0D, 0xFE, 0x0C, 0xB0, 0xC8, 0x4B, 0x23, 
+0x28, 0x65, 0x43, 0x7D, 0xD9, 0xE6, 0x2A, 0x1C, 
+0x08, 0x1A, 0x57, 0x1A, 0x14, 0x9A, 0xE0, 0xD1, 
+0x7F, 0x49, 0x01, 0xB9, 0x6C, 0x8A, 0x0F, 0x14, 
+0xB9, 0x4E, 0xCE, 0x29, 0x82, 0x54, 0x07, 0xF0, 
+0x41, 0x3F, 0x9B, 0xAC, 0xDB, 0x97, 0xD1, 0x13, 
+0xB1, 0x59, 0x22, 0x27, 0x6B, 0x0B, 0x8D, 0x0E, 
+0x9E, 0x46, 0xB1, 0xE3, 0x1B, 0xE2, 0x09, 0x8D, 
+0xDB, 0x8C, 0x39, 0x86, 0xBE, 0x75, 0x04, 0xA9, 
+0x3C, 0x37, 0xF1, 0x24, 0x6A, 0x90, 0x4C, 0xC5, 
+0x9A, 0xDF, 0x6F, 0xEA, 0x99, 0x41, 0xC7, 0xD0, 
+0xF3, 0x09, 0x20, 0xEB, 0xE4, 0x03, 0xE8, 0x18, 
+0x83, 0x58, 0x76, 0xB4, 0x9B, 0x1D, 0xBB, 0xF8, 
+0x2F, 0x92, 0x8E, 0x80, 0x58, 0xC2, 0x85, 0x15, 
+0x82, 0x12, 0xC2, 0xF9, 0xD2, 0x5E, 0xF3, 0x97, 
+0xB3, 0x4D, 0x6E, 0xD6, 0x33, 0xD1, 0xDA, 0x74, 
+0xEA, 0xD9, 0x26, 0x9C, 0xD6, 0xE0, 0x25, 0xC3, 
+0x84, 0xD1, 0xAC, 0x09, 0xB7, 0x14, 0x9F, 0xA0, 
+0x35, 0xA1, 0xCE, 0x02, 0xAE, 0x7D, 0xB7, 0x87, 
+0xA7, 0xF8, 0xFF, 0xD5, 0x9B, 0x9C, 0x89, 0x97, 
+0x34, 0xE2, 0x0F, 0x9C, 
+
+0x75, 0x51, 0xB9, 0x75, 0x1E, 0x09, 0x3B, 0xF9, 
+0x2C, 0x79, 0x5C, 0x3C, 0x86, 0xC8, 0x26, 0xA8, 
+0x63, 0x80, 0x4A, 0x13, 0xEF, 0x0A, 0xD9, 0x87, 
+0xF3, 0x96, 0x3D, 0xE3, 0x3A, 0x6E, 0x94, 0x61, 
+0x4D, 0xCD, 0x0E, 0x66, 0x11, 0xD3, 0x19, 0x31, 
+0x48, 0x79, 0x2C, 0xAA, 0x68, 0xF7, 0x71, 0x71, 
+0xA8, 0x33, 0xF3, 0xDF, 0x59, 0xF1, 0x51, 0xB2, 
+0xDD, 0xBB, 0xE3, 0x8C, 0xD6, 0xE7, 0xDE, 0x34, 
+0x12, 0xFF, 0xF8, 0xE5, 0x14, 0x8A, 0x43, 0xCE, 
+0xC3, 0xCC, 0x14, 0x89, 0x73, 0x7E, 0x5D, 0xE6, 
+0x67, 0xA2, 0xB5, 0x71, 0xEA, 0x1B, 0xBC, 0xDE, 
+0xBF, 0x25, 0x6C, 0x10, 0x7A, 0xB8, 0xB9, 0x97, 
+0xCD, 0xF1, 0x67, 0x6B, 0xCC, 0x1F, 0xAD, 0x6C, 
+0x26, 0xF1, 0x7A, 0x12, 0xE7, 0x55, 0xA0, 0x2A, 
+0x4E, 0x30, 0x07, 0x2E, 0x44, 0x0F, 0x3D, 0x9D, 
+0xEB, 0x86, 0xCD, 0x4F, 0x66, 0x63, 0xB4, 0xAF, 
+0x40, 0x99, 0xA7, 0x6E, 0x8E, 0xBF, 0x73, 0x49, 
+0x57, 0x1F, 0x57, 0x22, 0x52, 0x96, 0xEF, 0xD9, 
+0x8A, 0xD6, 0x80, 0x74, 0xC2, 0xA7, 0x9E, 0x7E, 
+0x7B, 0x2A, 0x6F, 0x04, 0xB8, 0xDB, 0x0D, 0x81, 
+0x71, 0xE4, 0x1B, 0xB1, 0x20, 0xA4, 0xB7, 0x03, 
+0x44, 0x83, 0x94, 0x09, 0xB8, 0x43, 0x15, 0xE8, 
+0xFB, 0xE3, 0x41, 0x3B, 0x09, 0x96, 0x16, 0x81, 
+0x6B, 0xB0, 0x20, 0xD5, 0x4A, 0xD5, 0xF1, 0x4F, 
+0x49, 0xA5, 0x47, 0xC7, 0x2A, 0x7E, 0xCF, 0xB6, 
+0xC6, 0xEF, 0x52, 0x54, 0xF5, 0xE9, 0xC1, 0x5D, 
+0x0D, 0xF1, 0x4C, 0x88, 0x46, 0x88, 0xA3, 0x42, 
+0x86, 0x59, 0x56, 0x6E, 0x20, 0x77, 0x0A, 0x62, 
+0xA5, 0x94, 0x9F, 0xE5, 0x90, 0x82, 0xDD, 0x48, 
+0x9D, 0xCE, 0xED, 0x49, 0xE5, 0x06, 0x67, 0x6D, 
+0x01, 0xDB, 0x7B, 0xDA, 0xEA, 0xBD, 0x0B, 0x4E, 
+0x4A, 0x29, 0x02, 0xAC, 0x19, 0x4C, 0x17, 0x81, 
+0xE4, 0xA5, 0xA8, 0x83, 0x8E, 
+
+0xF5, 0xF3, 0xA2, 0xCE, 0x02, 0xEB, 0x5A, 0x70, 
+0x72, 0xC7, 0xDA, 0xD1, 0x8D, 0xDC, 0x6D, 0xED, 
+0x00, 0xE4, 0x4D, 0xCB, 0x71, 0x04, 0xE2, 0x0F, 
+0x58, 0xBE, 0x8E, 0x1F, 0x3D, 0xF6, 0x1E, 0x7F, 
+0xF7, 0x76, 0x50, 0xFD, 0x8E, 0x98, 0x43, 0xB7, 
+0xC4, 0xA3, 0xAF, 0xF4, 0x82, 0xC8, 0xCF, 0xC0, 
+0x1C, 0x2C, 0x61, 0x61, 0x6D, 0x3F, 0x4A, 0x7E, 
+0x1F, 0xA8, 0xA4, 0x74, 0xD2, 0x44, 0x3D, 0xF3, 
+0x84, 0xF5, 0xC6, 0x51, 0xA8, 0x50, 0xE3, 0x90, 
+0x2E, 0xD9, 0x58, 0x01, 0x5B, 0x87, 0x41, 0xE9, 
+0x0E, 0x83, 0xE6, 0x22, 0xE4, 0x67, 0x61, 0x8B, 
+0xB8, 0xF1, 0xEF, 0x0E, 0x6C, 0xF8, 0x5D, 0x85, 
+0xAB, 0x24, 0x80, 0x49, 0xA0, 0x27, 0xB8, 0x4B, 
+0x13, 0x29, 0x9B, 0x77, 0xF4, 0x67, 0xA0, 0xA7, 
+0x9E, 0xC5, 0x3D, 0x60, 0xDB, 0x15, 0xA0, 0xCD, 
+0xBF, 0xE2, 0x41, 0xFC, 0x5F, 0xB4, 0xC8, 0xE9, 
+0x29, 0xD7, 0x26, 0x4A, 0x92, 0xFE, 0x57, 0xF5, 
+0x14, 0x43, 0x28, 0xE1, 0xD4, 0xED, 0x70, 0x26, 
+0x73, 0x72, 0x16, 0xA2, 0x42, 0x6B, 0x3C, 0xDD, 
+0xA1, 0xFA, 0x80, 0xDD, 0x3B, 0x0E, 0x37, 0x7C, 
+0xF2, 0x9C, 0x59, 0x71, 0xC3, 0xB1, 0xAA, 0x91, 
+0xE3, 0x66, 0x4B, 0xE2, 0x10, 0xCC, 0xDA, 0xD9, 
+0x4C, 0x9B, 0xF0, 0xAE, 0x49, 0x76, 0xE6, 0x99, 
+0xE5, 0xF9, 0xC7, 0x93, 0x5B, 0xD3, 0x54, 0x04, 
+0xB5, 0x14, 0x96, 0xFC, 0xE5, 0x83, 0x61, 0xF8, 
+0xAA, 0x33, 0x3C, 0xD0, 0x6E, 0x57, 0xB0, 0x10, 
+0xB1, 0x35, 0xF4, 0x3A, 0x6C, 0x7C, 0xD4, 0x6B, 
+0x4E, 0xF6, 0xA4, 0x9A, 0x42, 0xBF, 0x82, 0xDA, 
+0xC3, 0xA0, 0x4B, 0x48, 0x8B, 0xB1, 0xCE, 0x7E, 
+0xE2, 0x0D, 0x34, 0x02, 0x65, 0x5A, 0xFD, 0x0E, 
+0x59, 0x73, 0xBA, 0xD2, 0xB0, 0xF0, 0x19, 0x4E, 
+0x7F, 0xD6, 0x64, 0x90, 0x55, 0xB7, 0xB3, 0x2B, 
+0xEF, 0x4D, 0x7C, 0xC5, 0x6E, 0xF7, 
+
+0x3D, 0xCB, 0xCC, 0x37, 0x94, 0x16, 0xBC, 0xAA, 
+0x77, 0x8C, 0x1D, 0x23, 0xE8, 0x0D, 0x93, 0x0C, 
+0x0D, 0xDB, 0xDC, 0x7B, 0xE4, 0x95, 0x57, 0xFC, 
+0xE0, 0xC6, 0x5D, 0xBB, 0x5F, 0x62, 0xE1, 0x39, 
+0x53, 0x02, 0x43, 0x24, 0x81, 0xDA, 0xB8, 0x98, 
+0x61, 0x2E, 0x02, 0xF1, 0x89, 0x56, 0x51, 0x0C, 
+0xF4, 0xEB, 0x3A, 0x1B, 0x2A, 0x4B, 0xCE, 0xD8, 
+0xAE, 0xB6, 0xFB, 0x60, 0x69, 0xC8, 0xE9, 0xBF, 
+0xC3, 0x3C, 0x59, 0x56, 0x4B, 0x1E, 0x8F, 0x2B, 
+0x05, 0x2B, 0x8C, 0x7C, 0x27, 0x20, 0x6F, 0xE3, 
+0xFB, 0x50, 0x4E, 0x1A, 0xB0, 0x7C, 0x67, 0xF9, 
+0x34, 0x36, 0x4C, 0x98, 0x38, 0xAA, 0xED, 0x70, 
+0xB8, 0x96, 0xCC, 0x72, 0xD6, 0xC2, 0x0F, 0x90, 
+0x22, 0x76, 0x2F, 0xC6, 0x42, 0x95, 0xCA, 0x0B, 
+0x67, 0xBC, 0x82, 0x62, 0xBB, 0x81, 0x24, 0x8D, 
+0x08, 0x2A, 0x41, 0x13, 0xBB, 0x35, 0xB0, 0x9B, 
+0x48, 0x28, 0x0D, 0x2E, 0xBA, 0x51, 0x60, 0x16, 
+0x8B, 0x84, 0x2D, 0x2D, 0x55, 0x99, 0xA8, 0x15, 
+0x35, 0x89, 0x99, 0xED, 0x11, 0xF1, 0x81, 0x05, 
+0x62, 0x03, 0xBA, 0xE5, 0x39, 0x4B, 0xA8, 0x74, 
+0xBC, 0x48, 0xCC, 0x26, 0x50, 0xC2, 0xC1, 0x42, 
+0x5A, 0x0D, 0x14, 0x01, 0x92, 0x5A, 0x96, 0x76, 
+0xFC, 0x4D, 0x1A, 0x00, 0xD2, 0xB6, 0x48, 0x89, 
+0x23, 0xD0, 0xB9, 0x53, 0x00, 0xC9, 0xC4, 0xE7, 
+0xF1, 0x39, 0xA7, 0x2E, 0x89, 0x36, 0xFA, 0x53, 
+0xE1, 0x4E, 0x05, 0x0F, 0x49, 0xE9, 0xA5, 0x6C, 
+0x3E, 0xB7, 0x90, 0x19, 0x9D, 0xB6, 0x1F, 0xB0, 
+0x9E, 0xA8, 0x8B, 0xA0, 0xB6, 0xF2, 0xAF, 0x7D, 
+0x23, 0x63, 0x04, 0xF7, 0x5B, 0x08, 0x20, 0x45, 
+0x7C, 0x15, 0x29, 0x91, 0x8A, 0x9B, 0xE7, 0xBE, 
+0xD6, 0xAB, 0xB3, 0xCF, 0xF8, 0xAB, 0x54, 0x82, 
+0x92, 0x44, 0x48, 0xD2, 0xCD, 0xF2, 0x39, 0x9C, 
+0x2C, 0x09, 0x1C, 0x2A, 0x60, 0x5D, 0x69, 
+
+0x98, 0x2A, 0x09, 0x12, 0x00, 0xF1, 0x9F, 0x46, 
+0x8E, 0x39, 0x38, 0x48, 0x8F, 0x0F, 0xA1, 0xF4, 
+0x8C, 0x78, 0x51, 0x28, 0xB3, 0xE9, 0xB9, 0x54, 
+0xA5, 0x23, 0xB2, 0x23, 0x08, 0xE1, 0x7C, 0xBF, 
+0x17, 0xC2, 0xD9, 0x5A, 0x0B, 0xD9, 0x1A, 0xC5, 
+0x07, 0xFB, 0x6E, 0xE9, 0x87, 0x54, 0x22, 0x3C, 
+0x13, 0x42, 0x06, 0x88, 0x86, 0x7D, 0xA3, 0xC0, 
+0xFC, 0xE8, 0xEE, 0x93, 0x60, 0xE3, 0x50, 0xC8, 
+0xE2, 0xF8, 0xEC, 0xBA, 0x90, 0x96, 0x9D, 0xD9, 
+0x33, 0x07, 0x05, 0x17, 0xC2, 0x21, 0xBA, 0x0E, 
+0x1F, 0x4D, 0xFE, 0x1C, 0x99, 0x8C, 0x58, 0x39, 
+0x56, 0x39, 0x4D, 0xB1, 0x31, 0x2A, 0xB4, 0x40, 
+0x57, 0xAB, 0x6A, 0x04, 0xF7, 0x38, 0x64, 0x9D, 
+0x6C, 0x16, 0xCA, 0xC1, 0xCB, 0xAA, 0xA4, 0xE5, 
+0xAC, 0x56, 0x12, 0xEF, 0x38, 0x44, 0x02, 0x4F, 
+0x88, 0xC7, 0xCB, 0x00, 0xBD, 0xF1, 0x78, 0x6F, 
+0x80, 0xA9, 0xA9, 0x0F, 0x3C, 0x19, 0x84, 0x3F, 
+0xA3, 0x3F, 0xCC, 0x91, 0x55, 0x4F, 0xFD, 0x22, 
+0xA8, 0xFD, 0xDB, 0x59, 0x65, 0x64, 0xDD, 0x2D, 
+0x67, 0x34, 0x03, 0xD5, 0x4A, 0x1D, 0x3C, 0x23, 
+0xA3, 0xE5, 0x7E, 0xA4, 0x53, 0xD5, 0x06, 0xE2, 
+0xA5, 0x06, 0xD0, 0x0D, 0x13, 0x64, 0xDC, 0xA8, 
+0x8B, 0xDF, 0xD0, 0x71, 0x4E, 0x00, 0x26, 0x80, 
+0x03, 0x44, 0x1B, 0xA7, 0x72, 0xC2, 0xFC, 0xD0, 
+0x8A, 0x19, 0x34, 0x8F, 0xC8, 0x66, 0x15, 0x6D, 
+0x50, 0xE0, 0xF9, 0x64, 0xD2, 0x0F, 0x24, 0x8A, 
+0x7B, 0x88, 0x0C, 0xCC, 0xE4, 0xF8, 0x77, 0xA6, 
+0xF4, 0x20, 0x64, 0x5A, 0x7B, 0xC7, 0x45, 0x40, 
+0x9C, 0xAB, 0x5F, 0xE4, 0x53, 0xE5, 0xDD, 0x28, 
+0x2B, 0x09, 0xDE, 0xD2, 0xA9, 0x17, 0x47, 0xF5, 
+0xE2, 0x8F, 0xFA, 0x81, 0x59, 0x78, 0x88, 0x72, 
+0x74, 0xE3, 0x5F, 0x19, 0x76, 0x87, 0x79, 0x7B, 
+0xDD, 0xBA, 0xF6, 0x6C, 0x5D, 0x7E, 0xD4, 0x89, 
+
+0xCC, 0xF3, 0x74, 0x83, 0xB1, 0x62, 0xB5, 0xF3, 
+0x3B, 0xED, 0x78, 0x2E, 0x72, 0x59, 0x3B, 0x81, 
+0x61, 0xBC, 0x01, 0xB7, 0xAF, 0x61, 0x35, 0x90, 
+0x50, 0x41, 0x91, 0x8E, 0x83, 0x96, 0x0D, 0xCD, 
+0x84, 0x22, 0x0A, 0x2E, 0x99, 0x70, 0x9B, 0x92, 
+0x48, 0x3A, 0x49, 0xCB, 0x7A, 0x80, 0x0E, 0xC3, 
+0x06, 0x40, 0xFB, 0x0A, 0x6A, 0xC1, 0xFC, 0xD6, 
+0x66, 0xD2, 0x1C, 0xB3, 0x56, 0x43, 0x50, 0x3D, 
+0x92, 0x89, 0x66, 0xF5, 0x99, 0xEA, 0x72, 0x9F, 
+0x48, 0x68, 0x72, 0xE8, 0x0B, 0x8D, 0xBE, 0xC2, 
+0xE6, 0x74, 0xC7, 0xE3, 0x1A, 0xBA, 0x6C, 0x35, 
+0x2C, 0x1C, 0xEA, 0x9D, 0x7B, 0x62, 0x93, 0x2C, 
+0xE3, 0x16, 0x07, 0xC2, 0x22, 0xB6, 0x84, 0x34, 
+0x0C, 0x68, 0x31, 0x28, 0x3A, 0x66, 0xDB, 0x03, 
+0x38, 0xD0, 0x21, 0x5A, 0x9B, 0x50, 0x01, 0xF7, 
+0xB9, 0xC4, 0x12, 0xE0, 0x33, 0x70, 0x21, 0xF3, 
+0x90, 0x03, 0xB6, 0x47, 0xC1, 0x0C, 0x9D, 0x72, 
+0x8A, 0xC5, 0xB8, 0xCE, 0x4C, 0xF1, 0x71, 0x42, 
+0x38, 0x6F, 0x01, 0x5B, 0xE9, 0xF9, 0x3B, 0x16, 
+0x0E, 0x9B, 0xA3, 0x29, 0xCD, 0x9B, 0xF4, 0xEB, 
+0x56, 0x23, 0xBF, 0x9F, 0xFD, 0xC5, 0x27, 0x7B, 
+0xE2, 0x36, 0xA3, 0x78, 0x35, 0x7C, 0x14, 0xEC, 
+0x31, 0x36, 0xC0, 0x71, 0x43, 0xE7, 0x5B, 0x19, 
+0x72, 0xDC, 0xB1, 0x84, 0x27, 0x40, 0x8C, 0xE6, 
+0x24, 0x00, 0x31, 0x70, 0x31, 0x3B, 0xF9, 0xAF, 
+0xD8, 0x38, 0xD4, 0x32, 0xF3, 0x07, 0xA0, 0x83, 
+0xB1, 0x50, 0x39, 0x6F, 0x8C, 0xA6, 0xD2, 0x99, 
+0xD7, 0x30, 0x7B, 0x54, 0xBC, 0x00, 0x2D, 0xA4, 
+0xC6, 0x4D, 0x69, 0xCC, 0xAF, 0x7C, 0x1B, 0x67, 
+0x57, 0x11, 0xAA, 0xEA, 0x95, 0x4A, 0x22, 0x5D, 
+0x74, 0x96, 0x45, 0x9D, 0x90, 0x6A, 0x33, 0x4D, 
+0xF1, 0xFF, 0x40, 0x5C, 0x3A, 0xF4, 0x4D, 0x79, 
+0x9D, 0xC9, 0x48, 0x3F, 0x6D, 0x97, 0x05, 0x1D, 
+0x37, 
+
+0x98, 0x4D, 0x13, 0xC4, 0x2E, 0xEC, 0x79, 0xD9, 
+0xFE, 0x94, 0x14, 0x4C, 0x58, 0x56, 0xAA, 0x62, 
+0x65, 0x6C, 0x60, 0x71, 0xE8, 0x3E, 0xAB, 0xE5, 
+0x0B, 0x1E, 0x4C, 0xD3, 0x83, 0x15, 0x56, 0x30, 
+0x22, 0x1B, 0x3E, 0x94, 0xFF, 0xE7, 0xAA, 0x27, 
+0xCE, 0xC8, 0x1D, 0x3B, 0x4F, 0xFA, 0xB0, 0xA7, 
+0x0E, 0xA4, 0xF3, 0x9F, 0x7E, 0x9D, 0x2C, 0xEC, 
+0x43, 0x76, 0x7C, 0x48, 0x64, 0x80, 0xDA, 0x1B, 
+0xE2, 0xBC, 0x5E, 0x7B, 0x91, 0x57, 0x82, 0x8D, 
+0x86, 0x0E, 0x94, 0x55, 0x2A, 0x58, 0xAD, 0x4C, 
+0x38, 0x47, 0x79, 0x05, 0x7C, 0xC7, 0xD2, 0x46, 
+0x9E, 0xE0, 0x6F, 0x14, 0x74, 0x04, 0x2B, 0x9C, 
+0xED, 0xDC, 0x83, 0x3C, 0x31, 0x5D, 0x29, 0x87, 
+0xE8, 0x2E, 0x2C, 0x20, 0xC7, 0x4C, 0xD7, 0x29, 
+0xD7, 0xC9, 0xA7, 0x6A, 0x03, 0x4F, 0xA4, 0xE2, 
+0x39, 0x5C, 0xE4, 0x5A, 0xAE, 0xA4, 0x6D, 0x7E, 
+0x06, 0x53, 0xC2, 0xBA, 0x89, 0x47, 0x21, 0xBE, 
+0x30, 0x90, 0xFB, 0x3F, 0x67, 0x3D, 0xA3, 0xB3, 
+0x73, 0x07, 0xA5, 0x73, 0xAC, 0x89, 0x73, 0xEA, 
+0xB2, 0x8C, 0xAC, 0xA1, 0x97, 0xD2, 0x87, 0x1E, 
+0xC2, 0xB0, 0x79, 0x57, 0xA6, 0x7E, 0x6E, 0x3C, 
+0x88, 0x46, 0xBB, 0x00, 0x9D, 0xD7, 0x99, 0xB9, 
+0xA7, 0x69, 0xCD, 0xCE, 0x15, 0x3A, 0x34, 0x63, 
+0xFB, 0xF5, 0x32, 0x04, 0xA6, 0x40, 0x78, 0x69, 
+0xAC, 0xB9, 0x52, 0xAD, 0xDD, 0xE6, 0x48, 0x53, 
+0xBF, 0x3A, 0xE0, 0xBB, 0x96, 0x1A, 0x5A, 0x90, 
+0x06, 0x8F, 0x85, 0xB7, 0x15, 0xC3, 0xA8, 0x3E, 
+0x66, 0x3B, 0xC5, 0x19, 0xFB, 0xBA, 0xD6, 0x5B, 
+0xDC, 0xE5, 0x95, 0xD3, 0xDA, 0x8A, 0x31, 0x65, 
+0x78, 0xAC, 0xA4, 0x75, 0x04, 0xDD, 0x75, 0x89, 
+0xCA, 0x47, 0xF9, 0x41, 0x7C, 0x5E, 0xC3, 0x92, 
+0xC4, 0x8A, 0xAB, 0x2F, 0x9F, 0x1C, 0x99, 0x0A, 
+0xCA, 0xD9, 0xB5, 0xD2, 0x2A, 0x96, 0xD6, 0x79, 
+0x4A, 0x3D, 
+
+0x5D, 0xEF, 0x4A, 0xEA, 0xFD, 0xEC, 0x04, 0x9C, 
+0x51, 0x54, 0x0D, 0x34, 0x02, 0x78, 0x37, 0xF2, 
+0xEB, 0x21, 0xD2, 0x32, 0xF2, 0xA8, 0xA1, 0x08, 
+0xDE, 0x39, 0xFF, 0x35, 0xBF, 0xBC, 0xD9, 0x2C, 
+0x93, 0x2C, 0xFA, 0x76, 0xC6, 0x10, 0x30, 0xFB, 
+0xE2, 0x46, 0x92, 0xD8, 0xF4, 0xA0, 0xCC, 0x1C, 
+0xE9, 0x89, 0x7D, 0xB0, 0xF9, 0x4D, 0xEE, 0xFD, 
+0x98, 0x1F, 0x5E, 0xCA, 0x1E, 0x95, 0x93, 0x6D, 
+0xC4, 0x1C, 0xBC, 0xE8, 0xC6, 0x37, 0xAE, 0x5E, 
+0xD8, 0xC9, 0x3A, 0x1D, 0xA6, 0x89, 0xF8, 0x70, 
+0x29, 0xBF, 0x66, 0xCD, 0x4D, 0xE0, 0x0A, 0xAA, 
+0x3F, 0xC0, 0x89, 0x82, 0x9E, 0x01, 0x15, 0xD4, 
+0xCD, 0x65, 0x2B, 0xC7, 0x70, 0xCF, 0x8B, 0x11, 
+0xA5, 0xE2, 0xBE, 0xF7, 0xEC, 0x00, 0xD6, 0xE0, 
+0x44, 0x2E, 0xD2, 0xA2, 0x56, 0xAE, 0x2D, 0xA2, 
+0x4E, 0xEF, 0xD0, 0x78, 0xBC, 0x78, 0x19, 0x86, 
+0x56, 0x24, 0x7D, 0x04, 0x15, 0xC1, 0x87, 0x85, 
+0xD2, 0x09, 0x75, 0xCE, 0x36, 0xC1, 0xF1, 0xC5, 
+0x05, 0xE7, 0x95, 0x02, 0xBC, 0xDA, 0x5D, 0x71, 
+0x48, 0x1A, 0xC5, 0xC4, 0x3E, 0xDB, 0x5C, 0x6C, 
+0x30, 0xEC, 0xF5, 0x9A, 0x1E, 0xC3, 0x36, 0x9F, 
+0xE3, 0x5A, 0x41, 0xE1, 0xD4, 0x58, 0x94, 0xE0, 
+0xC9, 0xA3, 0x75, 0xAA, 0x74, 0xFB, 0xAC, 0x2D, 
+0x10, 0x1C, 0x4C, 0x4D, 0xF8, 0x85, 0x09, 0x15, 
+0x0B, 0xF0, 0x2C, 0x19, 0x74, 0xA4, 0x8C, 0xAD, 
+0x15, 0xB6, 0x41, 0x5E, 0xC1, 0x19, 0xF6, 0x74, 
+0xB3, 0xB0, 0x45, 0xFE, 0x92, 0xD1, 0xEA, 0xD9, 
+0x22, 0xBC, 0x43, 0x1D, 0xF7, 0x4A, 0xAB, 0x35, 
+0x69, 0x86, 0xAF, 0x19, 0x94, 0x5D, 0x09, 0x80, 
+0x27, 0xBD, 0x31, 0xB0, 0xE1, 0xA9, 0xF7, 0xC1, 
+0x61, 0xA5, 0xC9, 0x04, 0x3D, 0x64, 0x2C, 0xD6, 
+0x93, 0x0C, 0x6E, 0x88, 0x62, 0xCB, 0x4D, 0x9E, 
+0x55, 0x80, 0x54, 0x38, 0x5B, 0x48, 0xFB, 0x2D, 
+0xF8, 0xE0, 0x36, 
+
+0x2B, 0x79, 0x3A, 0x1A, 0xBA, 0x13, 0x8D, 0x97, 
+0x13, 0x80, 0x14, 0x9D, 0x56, 0xB0, 0xBD, 0x2A, 
+0x31, 0xE6, 0x28, 0x20, 0x3D, 0x85, 0x56, 0xE7, 
+0x38, 0x79, 0x1A, 0xAF, 0x96, 0x72, 0x37, 0x6D, 
+0xB0, 0xA2, 0xA1, 0xC1, 0x2A, 0x6F, 0x29, 0x3D, 
+0xF4, 0xC2, 0x0A, 0x9C, 0x0D, 0xF6, 0x21, 0x61, 
+0x00, 0x40, 0xA5, 0x25, 0x49, 0xF2, 0x72, 0x1E, 
+0x7B, 0x0B, 0x71, 0xD0, 0x55, 0xB9, 0xFD, 0xA8, 
+0xC5, 0xAA, 0x83, 0x95, 0xEC, 0x58, 0x2F, 0x84, 
+0xDC, 0x35, 0x1B, 0x07, 0x67, 0x7C, 0x6E, 0xF2, 
+0xBC, 0x5F, 0xFB, 0x5B, 0x23, 0x58, 0x8F, 0x0E, 
+0x3C, 0x69, 0x7B, 0xC0, 0x32, 0x60, 0x52, 0xB1, 
+0x86, 0xAD, 0x60, 0xEC, 0xC4, 0x15, 0x59, 0x7D, 
+0x66, 0xF6, 0x11, 0x18, 0x6F, 0xE5, 0xD1, 0x8E, 
+0xDC, 0x1E, 0x51, 0x11, 0x4C, 0xA6, 0x75, 0xFB, 
+0xAA, 0xE9, 0x54, 0xA0, 0xF7, 0xF9, 0xA2, 0x8F, 
+0xDE, 0x67, 0xDB, 0x31, 0x54, 0x0E, 0x2D, 0x52, 
+0xBB, 0x38, 0xAA, 0x1D, 0xA9, 0x5F, 0x52, 0x54, 
+0x7F, 0x7E, 0x5B, 0x46, 0xDD, 0xD5, 0xE8, 0xBA, 
+0xB7, 0xD8, 0x3F, 0xC0, 0x41, 0xF5, 0x22, 0xCD, 
+0x37, 0x23, 0xC7, 0xB0, 0xEA, 0xBF, 0x68, 0xD4, 
+0x79, 0xFB, 0x6E, 0x24, 0x0D, 0xCA, 0x24, 0xAD, 
+0x18, 0x35, 0x75, 0xDA, 0x41, 0x87, 0xFF, 0xB1, 
+0x3E, 0x8F, 0x7C, 0x22, 0xF1, 0xD7, 0x00, 0xE2, 
+0x88, 0x35, 0x8C, 0x7E, 0x98, 0xA4, 0xD0, 0xEC, 
+0x42, 0x8C, 0xFE, 0x50, 0x03, 0x31, 0x55, 0x0B, 
+0x59, 0x48, 0xD5, 0xEB, 0x91, 0x8C, 0x05, 0x47, 
+0x78, 0x9B, 0x72, 0xA1, 0x66, 0x44, 0x7C, 0xE9, 
+0x98, 0x6C, 0x0B, 0x98, 0x9A, 0xEA, 0xFD, 0x91, 
+0xB3, 0x19, 0x34, 0x07, 0x31, 0x32, 0x25, 0x3C, 
+0x42, 0x57, 0xF9, 0xF8, 0x8D, 0xDF, 0x31, 0x83, 
+0x2C, 0xDF, 0xF2, 0x17, 0xC5, 0xEF, 0x16, 0xEE, 
+0x71, 0xC6, 0x21, 0x21, 0x28, 0x79, 0xE3, 0x6F, 
+0x99, 0xC4, 0xBB, 0xDE, 
+
+0xD7, 0xB9, 0x91, 0xB6, 0x3F, 0x7D, 0x09, 0xCD, 
+0xE2, 0x80, 0x20, 0xA7, 0xF6, 0xC3, 0xAC, 0x03, 
+0x2A, 0x31, 0x52, 0x57, 0xD6, 0x86, 0x73, 0x91, 
+0x4A, 0xA7, 0xB1, 0x8A, 0x4D, 0x0B, 0x53, 0x52, 
+0x3A, 0x91, 0x38, 0x83, 0x47, 0xA7, 0x05, 0x7D, 
+0x69, 0x0B, 0xDD, 0xD9, 0x1B, 0x93, 0x60, 0x8F, 
+0xDB, 0xA2, 0x68, 0xF3, 0xB6, 0x01, 0x4C, 0x4D, 
+0x3B, 0x24, 0xB2, 0x64, 0xF6, 0x01, 0xEB, 0xE1, 
+0xE2, 0x71, 0xE4, 0xE7, 0x6B, 0x71, 0x2E, 0xC8, 
+0xAE, 0x9F, 0xF2, 0x37, 0xCB, 0xD9, 0xA3, 0x69, 
+0xE6, 0x37, 0xB1, 0xC1, 0x1F, 0xFA, 0xAA, 0x77, 
+0xE4, 0xF6, 0x4F, 0xD5, 0x55, 0x9A, 0x4A, 0xAE, 
+0x5E, 0x1B, 0xB8, 0x95, 0xBE, 0x6E, 0x95, 0x5A, 
+0x6A, 0xB4, 0x3E, 0xAF, 0x23, 0xED, 0x35, 0x17, 
+0xB8, 0x4B, 0x9E, 0x82, 0x8D, 0x21, 0x1D, 0x01, 
+0x4B, 0xFF, 0xB7, 0xA2, 0x86, 0x36, 0xE2, 0x61, 
+0xFE, 0x39, 0xE1, 0x6C, 0xAF, 0xAB, 0x1E, 0x88, 
+0xC5, 0xE1, 0x2A, 0x18, 0xBC, 0xCB, 0xBA, 0x08, 
+0xCD, 0x46, 0xF9, 0xD6, 0x5C, 0x80, 0xD9, 0x0C, 
+0xB7, 0x84, 0x5C, 0x8B, 0x6B, 0x92, 0xCE, 0xDE, 
+0x6E, 0xDE, 0x02, 0xEA, 0xDA, 0x27, 0xD0, 0xBD, 
+0x13, 0x0D, 0x9A, 0x7F, 0xC0, 0xE0, 0x83, 0xFE, 
+0xE4, 0x40, 0x2F, 0xD8, 0x6B, 0xEB, 0x37, 0xE7, 
+0xC4, 0x29, 0x8F, 0x51, 0xAD, 0xA2, 0xB0, 0x75, 
+0xC1, 0x42, 0x9C, 0x46, 0xA8, 0x31, 0x7C, 0x50, 
+0xA7, 0x0A, 0xA0, 0xD3, 0xE2, 0x7F, 0xCB, 0x16, 
+0x24, 0xF9, 0x3A, 0x98, 0x4C, 0x97, 0x4B, 0x29, 
+0xE8, 0xC4, 0xA0, 0x19, 0x37, 0xD7, 0x14, 0xEC, 
+0x68, 0x21, 0x44, 0x0A, 0x98, 0x6E, 0x77, 0xE0, 
+0xFD, 0x89, 0x95, 0x59, 0x69, 0xFD, 0x43, 0xD3, 
+0x1F, 0x29, 0xB6, 0x29, 0xC7, 0x2B, 0x61, 0x2F, 
+0xE1, 0x9B, 0xD0, 0x5B, 0xA9, 0xFF, 0x71, 0xA8, 
+0x3B, 0xC0, 0xAA, 0x3D, 0xAC, 0x13, 0xA3, 0x83, 
+0x7C, 0x35, 0x0B, 0xF5, 0xD1, 
+
+0xF0, 0x7C, 0xDA, 0x48, 0x7B, 0x67, 0x4A, 0xED, 
+0xFA, 0xE6, 0xBE, 0x7E, 0x14, 0xC7, 0x2E, 0x53, 
+0xFC, 0x60, 0x16, 0x21, 0x7C, 0xAE, 0xA0, 0xA6, 
+0xCA, 0xAB, 0x6C, 0xD4, 0xB9, 0xE1, 0xF9, 0x25, 
+0xB5, 0x78, 0x08, 0x48, 0xFA, 0x32, 0xB6, 0xAA, 
+0x57, 0x35, 0xD8, 0xBD, 0x72, 0xDC, 0xF8, 0x49, 
+0x09, 0xF8, 0x38, 0xAC, 0x39, 0x73, 0xF5, 0x96, 
+0xCE, 0x04, 0xB4, 0x37, 0xC0, 0x3D, 0x54, 0x2A, 
+0xC0, 0x6A, 0x99, 0x84, 0x2D, 0xC0, 0x75, 0xC9, 
+0x24, 0x39, 0x43, 0xDD, 0x73, 0x2C, 0x58, 0xA9, 
+0x27, 0x02, 0x65, 0x55, 0xC2, 0xA2, 0xD8, 0x87, 
+0x47, 0x31, 0x4D, 0xAB, 0xBF, 0x04, 0xA2, 0xA0, 
+0x11, 0xB8, 0x6F, 0x11, 0xAC, 0x3A, 0x09, 0xE5, 
+0xFD, 0xB8, 0xD1, 0x8E, 0x72, 0x77, 0x51, 0xBF, 
+0xAE, 0xBB, 0xB5, 0x50, 0x1B, 0x2B, 0x5D, 0xC5, 
+0xD5, 0x10, 0xD9, 0x5A, 0x7F, 0x0E, 0xE4, 0x8E, 
+0x65, 0x68, 0x01, 0xC3, 0x08, 0x2C, 0xB7, 0xFB, 
+0xD8, 0x1A, 0x3E, 0x09, 0x6B, 0x81, 0x9C, 0x50, 
+0x09, 0xC7, 0x46, 0xD5, 0xD4, 0x3F, 0x4A, 0xDF, 
+0x56, 0x08, 0xB9, 0x9A, 0x16, 0x3C, 0x3B, 0x81, 
+0xD9, 0xA5, 0xE0, 0x87, 0x3A, 0xC0, 0xC8, 0x0D, 
+0x02, 0x89, 0x2B, 0xB2, 0xCD, 0xAA, 0xAA, 0x50, 
+0x1E, 0x5E, 0xFC, 0x14, 0xAE, 0x2B, 0xBF, 0x78, 
+0xF7, 0x14, 0xB9, 0x6C, 0x53, 0xC8, 0xCE, 0x82, 
+0x92, 0x64, 0x72, 0x4B, 0x26, 0x44, 0xF9, 0x2C, 
+0xBC, 0x47, 0x4A, 0x73, 0x96, 0x64, 0xBD, 0x1A, 
+0xDC, 0xA0, 0x3E, 0x7C, 0xD6, 0xD3, 0x06, 0x83, 
+0xAB, 0xAA, 0xCE, 0xF3, 0x11, 0xEB, 0x46, 0xE5, 
+0x50, 0x4C, 0xC3, 0xFD, 0xFE, 0x3B, 0xD1, 0xBE, 
+0x23, 0x8A, 0x12, 0x63, 0x0E, 0x79, 0x3C, 0xF4, 
+0xE1, 0x93, 0x43, 0x58, 0x66, 0x86, 0xDE, 0xC6, 
+0x9E, 0xC4, 0xF0, 0xD9, 0x0A, 0x0C, 0x41, 0xF4, 
+0xBD, 0x71, 0x6D, 0x8E, 0xEC, 0xDD, 0x40, 0x7B, 
+0xBD, 0x6B, 0xAE, 0x03, 0x78, 0x4E, 
+
+0x47, 0x96, 0x88, 0x87, 0x00, 0x72, 0x9B, 0xF0, 
+0xEC, 0x7E, 0xFC, 0x04, 0x81, 0xBD, 0xAA, 0xFC, 
+0x14, 0xB2, 0xD7, 0x13, 0x7D, 0xA6, 0x2E, 0xA7, 
+0x76, 0x87, 0x2C, 0xFD, 0x1E, 0x71, 0xC0, 0x95, 
+0x87, 0x46, 0xD8, 0x86, 0x2B, 0x5E, 0x01, 0x48, 
+0x39, 0xBE, 0xD4, 0x31, 0x3C, 0xD4, 0xC2, 0x13, 
+0x59, 0x2A, 0x4B, 0xAE, 0xFC, 0x3C, 0x05, 0xA5, 
+0xBF, 0x76, 0x24, 0xD5, 0x58, 0x6B, 0x8F, 0xCD, 
+0xFD, 0xD3, 0x49, 0xD4, 0x0A, 0x8E, 0xD3, 0x96, 
+0x59, 0xD6, 0x31, 0x2F, 0x7C, 0xF3, 0x48, 0x2B, 
+0x84, 0x9E, 0x60, 0xA3, 0x89, 0xE4, 0x1A, 0x7A, 
+0x4D, 0xA4, 0x13, 0x2B, 0x9A, 0xBD, 0x27, 0x0C, 
+0xCA, 0xA7, 0xFF, 0x64, 0xC5, 0x79, 0x7E, 0xF7, 
+0xFD, 0x36, 0xFD, 0xE0, 0x26, 0x12, 0x1A, 0xF3, 
+0x32, 0x21, 0x86, 0xD4, 0xAB, 0x78, 0x5E, 0x7C, 
+0x14, 0x98, 0x61, 0x30, 0x7B, 0xF1, 0x9D, 0xD9, 
+0x06, 0x60, 0x76, 0x3A, 0x45, 0x41, 0xD9, 0xEB, 
+0x73, 0x73, 0xC1, 0x03, 0xDD, 0x50, 0x64, 0x07, 
+0x07, 0xE1, 0xF9, 0xFE, 0xC0, 0x96, 0x4C, 0x88, 
+0xE2, 0xB6, 0xE4, 0x37, 0xC9, 0x3F, 0x61, 0x69, 
+0x89, 0x88, 0x5A, 0xC6, 0x2F, 0x28, 0xD3, 0xC9, 
+0xF2, 0x50, 0xA3, 0x98, 0x24, 0x8F, 0x98, 0xCF, 
+0xDE, 0x69, 0x75, 0x73, 0x44, 0x32, 0xA2, 0xAB, 
+0x4C, 0x73, 0x71, 0xBB, 0xDF, 0x6B, 0xB0, 0xFB, 
+0x1D, 0xB0, 0x5C, 0x5F, 0x06, 0xB5, 0xE6, 0x6C, 
+0x61, 0xAC, 0x06, 0x44, 0xFF, 0x51, 0x97, 0x51, 
+0xB1, 0x69, 0x46, 0xE9, 0x4A, 0xCC, 0x13, 0x55, 
+0x72, 0xDB, 0x22, 0xFD, 0x1D, 0xAC, 0xB6, 0x59, 
+0xEB, 0xBB, 0x6D, 0x40, 0x12, 0xEA, 0xFC, 0xDD, 
+0x47, 0xC1, 0x16, 0x68, 0x99, 0x29, 0x6D, 0x6F, 
+0xC7, 0xFA, 0x01, 0x60, 0x3B, 0x33, 0x9C, 0x02, 
+0x06, 0x5C, 0xAA, 0xF0, 0x57, 0x0E, 0x04, 0x67, 
+0x66, 0xF4, 0xF3, 0xF2, 0x58, 0xA3, 0xAE, 0x14, 
+0x77, 0xF6, 0x88, 0x52, 0xD8, 0xDC, 0xF4, 
+
+0x4E, 0xDB, 0x48, 0xAF, 0x97, 0x6C, 0xAE, 0x35, 
+0xCC, 0xBA, 0xE3, 0xA9, 0x7A, 0x47, 0xC8, 0xAA, 
+0x1B, 0xD6, 0x28, 0xAE, 0xB1, 0x76, 0x39, 0xF9, 
+0x2C, 0x4E, 0xA9, 0x96, 0x88, 0xDD, 0x77, 0x56, 
+0x14, 0xAF, 0xE6, 0x39, 0xCF, 0x81, 0xC1, 0x3C, 
+0xB0, 0xA8, 0x6A, 0x88, 0xDC, 0x69, 0xB7, 0xED, 
+0x4A, 0x88, 0x3C, 0x66, 0x7A, 0xEC, 0xA0, 0xCF, 
+0x71, 0xBA, 0xB5, 0x07, 0x48, 0x1D, 0x12, 0x16, 
+0x0C, 0x06, 0x72, 0xEC, 0xBF, 0x2D, 0x1A, 0xCC, 
+0x2A, 0x0A, 0x9B, 0x09, 0x1E, 0xD3, 0x98, 0x6E, 
+0x59, 0x36, 0x33, 0xB0, 0x9D, 0x2B, 0x3A, 0xB2, 
+0x2C, 0x34, 0x79, 0x17, 0x06, 0xF7, 0x25, 0x2F, 
+0xB5, 0x28, 0x2B, 0xC5, 0x92, 0x73, 0xD9, 0x82, 
+0x71, 0xBC, 0xB3, 0x04, 0xA7, 0xA9, 0x79, 0xC7, 
+0xC2, 0xB9, 0x9E, 0x4B, 0xD9, 0x5F, 0xE5, 0xC7, 
+0x49, 0x92, 0x82, 0xD1, 0xEC, 0x65, 0xFE, 0x5B, 
+0x84, 0x64, 0x5F, 0xEC, 0x96, 0x4E, 0x18, 0x79, 
+0x75, 0x3A, 0xB2, 0x92, 0x89, 0x98, 0x6B, 0xBE, 
+0x80, 0x77, 0xAA, 0xE2, 0x18, 0x90, 0x60, 0x7A, 
+0x61, 0x56, 0xDB, 0x05, 0xEE, 0x1B, 0xD8, 0x66, 
+0x2B, 0xA0, 0x38, 0x32, 0xF7, 0xBF, 0xB1, 0x52, 
+0x82, 0x5C, 0xA8, 0x06, 0x03, 0x4F, 0x5D, 0x3A, 
+0x02, 0xA0, 0xE6, 0x5E, 0x8A, 0x2E, 0x06, 0xC8, 
+0xE0, 0x93, 0x46, 0x39, 0x9A, 0x15, 0x93, 0x36, 
+0x09, 0x9B, 0xE3, 0x26, 0x83, 0x69, 0xEF, 0xD7, 
+0x2A, 0xD4, 0x8A, 0xB3, 0x34, 0xEB, 0x07, 0xE6, 
+0xF5, 0x8E, 0x12, 0x07, 0x6E, 0xFA, 0x87, 0x1A, 
+0x01, 0x01, 0xDD, 0xC1, 0x4F, 0x19, 0x87, 0xB7, 
+0x58, 0x1D, 0xC5, 0x78, 0x1F, 0x98, 0x70, 0x31, 
+0x43, 0x74, 0xC4, 0x10, 0x71, 0x8E, 0x30, 0x0B, 
+0x77, 0x47, 0x1D, 0x7A, 0x7B, 0x85, 0xA9, 0x00, 
+0x0B, 0x93, 0xE2, 0xBB, 0x62, 0x03, 0x7C, 0x09, 
+0xA3, 0xC1, 0x30, 0xE7, 0x52, 0xA0, 0x63, 0xF8, 
+0x36, 0x12, 0x51, 0x5F, 0x91, 0x6E, 0x29, 0xF6, 
+
+0x73, 0x9C, 0x35, 0xE6, 0x1E, 0x2B, 0xFD, 0x47, 
+0x5B, 0x99, 0xAE, 0x5E, 0xFC, 0x0A, 0x41, 0xCA, 
+0x95, 0xC2, 0xE5, 0xE8, 0x5B, 0x9B, 0x18, 0xE9, 
+0xA7, 0xAE, 0xE8, 0xCB, 0xC9, 0x2E, 0x77, 0x0D, 
+0x08, 0x31, 0x57, 0x8A, 0xAA, 0x45, 0xA3, 0x69, 
+0xBC, 0xA3, 0xE3, 0x3E, 0x0C, 0xF6, 0x5E, 0xFF, 
+0x0D, 0x89, 0x41, 0xB3, 0x3A, 0x19, 0x27, 0x24, 
+0x46, 0x09, 0x14, 0xC0, 0xB7, 0x28, 0xB5, 0x8C, 
+0xFE, 0x38, 0x7C, 0xF4, 0xE7, 0x70, 0xA4, 0xE5, 
+0x89, 0x3D, 0x0B, 0x2F, 0xF5, 0x9D, 0x47, 0xCF, 
+0xDA, 0x4A, 0xF5, 0x5B, 0xEC, 0xE2, 0x86, 0x0C, 
+0x5B, 0xD0, 0x47, 0x8D, 0x26, 0x9F, 0x4A, 0xA1, 
+0xFC, 0x1B, 0x0C, 0x7D, 0x7D, 0xCE, 0x3D, 0x25, 
+0x5A, 0x3D, 0x91, 0x5C, 0xA9, 0x0B, 0x84, 0x57, 
+0x21, 0xAC, 0xFD, 0x31, 0x7D, 0xEA, 0x00, 0xEE, 
+0xA0, 0xB2, 0xE3, 0x83, 0x07, 0xCF, 0x86, 0xDE, 
+0x36, 0xB8, 0x71, 0x39, 0xF5, 0xEE, 0x70, 0xE4, 
+0x47, 0x07, 0xEF, 0xDA, 0xDB, 0x25, 0x0B, 0x4E, 
+0x9F, 0x7D, 0x84, 0x83, 0x90, 0x27, 0x08, 0xF0, 
+0xB0, 0xDC, 0x0C, 0xF3, 0x42, 0x3A, 0xAD, 0xFB, 
+0xD7, 0x4B, 0x8C, 0x57, 0x56, 0x9E, 0xCA, 0x27, 
+0x13, 0xDA, 0x5A, 0x78, 0xD1, 0x04, 0xF5, 0x9A, 
+0x13, 0xC9, 0xB5, 0xBE, 0x49, 0xB4, 0xFF, 0xE8, 
+0x0E, 0x1C, 0x35, 0xED, 0xF2, 0x21, 0x03, 0x08, 
+0x73, 0xA8, 0x99, 0xD4, 0xF6, 0x35, 0x35, 0x2F, 
+0xA5, 0xD0, 0x96, 0x86, 0x85, 0xA8, 0xF8, 0xB9, 
+0x3C, 0xF9, 0x80, 0xEC, 0x08, 0xA5, 0x5E, 0x81, 
+0xEA, 0xA2, 0xEC, 0x40, 0x56, 0x92, 0x7F, 0xE6, 
+0xA7, 0xE4, 0xEF, 0x53, 0xFE, 0xAA, 0x90, 0xFE, 
+0x4B, 0x3F, 0x04, 0xC3, 0xDB, 0x93, 0xA1, 0xFE, 
+0xCD, 0x76, 0x6A, 0x54, 0x33, 0xC6, 0xAA, 0xD5, 
+0x67, 0x87, 0x9E, 0x22, 0x93, 0x18, 0x81, 0x7D, 
+0x34, 0xC4, 0xF0, 0x60, 0x73, 0x64, 0x85, 0x83, 
+0x6E, 0xFD, 0xAC, 0x0A, 0xB1, 0x1A, 0xA8, 0xFD, 
+0x9B, 
+
+0xCC, 0x21, 0xEC, 0x34, 0x5C, 0x31, 0x6A, 0xAB, 
+0x1B, 0x76, 0x61, 0x2A, 0xEB, 0xFE, 0xDF, 0xE5, 
+0x59, 0x3E, 0x21, 0xC5, 0xCE, 0x54, 0x7E, 0x3F, 
+0xB4, 0xBA, 0x7E, 0x6A, 0x44, 0x52, 0x34, 0xE7, 
+0xA5, 0xE1, 0xE1, 0xED, 0xE9, 0x50, 0xD5, 0x10, 
+0x4D, 0xD4, 0xA3, 0x11, 0x56, 0x8E, 0x35, 0x71, 
+0x3B, 0x8C, 0x11, 0xB7, 0x42, 0x56, 0x4F, 0xE7, 
+0xE0, 0xB4, 0x64, 0xCC, 0x6E, 0xB5, 0xEA, 0xCD, 
+0x0B, 0xB1, 0x49, 0x82, 0xB3, 0x62, 0x5D, 0xE9, 
+0xAB, 0x1C, 0xC0, 0x02, 0xE9, 0xF3, 0x8A, 0x20, 
+0x95, 0x6D, 0xEE, 0xF9, 0x9C, 0xA4, 0x30, 0x6A, 
+0xDB, 0xCE, 0x57, 0x2B, 0x5A, 0xD1, 0x74, 0x5E, 
+0x2C, 0x3D, 0xD2, 0x33, 0xAB, 0x8A, 0xCD, 0xDE, 
+0x1D, 0x32, 0x14, 0x13, 0x67, 0x2B, 0xF6, 0xDB, 
+0x6C, 0x1D, 0xF7, 0xCC, 0xE4, 0x8F, 0xF6, 0x69, 
+0x1B, 0x4D, 0x79, 0x4E, 0x54, 0xE6, 0x47, 0xC0, 
+0xC2, 0x04, 0xD5, 0x67, 0x29, 0x7E, 0xEE, 0x09, 
+0xE5, 0x7E, 0xCF, 0xC6, 0x72, 0x30, 0x69, 0x0E, 
+0x13, 0x37, 0xA8, 0x7D, 0x64, 0xB0, 0xA1, 0xF0, 
+0xEA, 0x0E, 0xCF, 0x51, 0x9B, 0xEA, 0x3E, 0xAF, 
+0xBC, 0x90, 0xF4, 0x9E, 0x3B, 0x17, 0x16, 0xE1, 
+0x80, 0x00, 0x97, 0x1F, 0x2F, 0x61, 0x26, 0x98, 
+0xD4, 0x59, 0xA9, 0xD5, 0xFB, 0xCF, 0x5E, 0x9C, 
+0x68, 0x02, 0xBC, 0xA8, 0xF2, 0x8F, 0x9C, 0xBC, 
+0x4C, 0x06, 0x09, 0x99, 0xA4, 0x41, 0xCB, 0x12, 
+0x68, 0x4E, 0xF0, 0xCE, 0x77, 0xBA, 0x06, 0x41, 
+0xD5, 0x13, 0xEF, 0xCF, 0xB8, 0x0C, 0x0E, 0xE8, 
+0x2F, 0x3E, 0x26, 0x33, 0xA7, 0x71, 0x89, 0x6D, 
+0x16, 0xBF, 0xD9, 0xF4, 0x5C, 0x01, 0x9B, 0x49, 
+0xB1, 0x3D, 0xC1, 0x3B, 0x77, 0x90, 0x86, 0xA1, 
+0x70, 0x5D, 0xA7, 0xF5, 0x79, 0x61, 0x25, 0x6B, 
+0x5A, 0xCA, 0x7F, 0xDB, 0x1E, 0x79, 0x4E, 0xF6, 
+0xCA, 0x0A, 0x42, 0x9D, 0xFD, 0x12, 0xBF, 0x9C, 
+0x7C, 0x8E, 0x36, 0xD9, 0xBE, 0x7F, 0x8F, 0xD6, 
+0x4D, 0xEB, 
+
+0x98, 0xE5, 0x39, 0xB1, 0x7A, 0xCD, 0x26, 0x2D, 
+0x0D, 0x4E, 0x2A, 0x1E, 0xFB, 0x4F, 0x2E, 0x4F, 
+0x84, 0x54, 0x91, 0xAD, 0x71, 0xF8, 0x15, 0x87, 
+0x92, 0x94, 0xBA, 0x5B, 0x56, 0xE7, 0x17, 0xCC, 
+0x3D, 0x82, 0x47, 0xA7, 0x29, 0x86, 0x2B, 0x94, 
+0x0F, 0x50, 0xF0, 0x83, 0x3D, 0x6E, 0x30, 0xB0, 
+0x09, 0xF2, 0xB2, 0x77, 0xEB, 0x9F, 0x71, 0x02, 
+0x36, 0x45, 0xCC, 0xAB, 0x11, 0xCA, 0x99, 0x4B, 
+0x8F, 0x90, 0x74, 0x00, 0x5C, 0xC4, 0xC7, 0x06, 
+0xBB, 0x47, 0xA3, 0xCE, 0x6B, 0xE6, 0xB4, 0x91, 
+0x9D, 0x2D, 0x4E, 0xB2, 0xBB, 0x98, 0xAD, 0xBF, 
+0xA0, 0xE0, 0xEA, 0xA4, 0x77, 0x38, 0xC1, 0x1F, 
+0x9B, 0xC8, 0x97, 0x26, 0x82, 0x24, 0xDB, 0x70, 
+0x3B, 0x92, 0xF9, 0x88, 0x12, 0x23, 0xAB, 0x05, 
+0x34, 0xA2, 0x88, 0x45, 0x5A, 0xF2, 0x12, 0x6A, 
+0x03, 0x48, 0xEE, 0x9E, 0xB3, 0x9B, 0x71, 0x05, 
+0xE8, 0xE6, 0x89, 0x7D, 0x55, 0xF2, 0xAD, 0xC4, 
+0x5A, 0x1E, 0xCF, 0x70, 0x5A, 0x59, 0xBA, 0x6A, 
+0x1E, 0x2F, 0xC6, 0x66, 0xAC, 0xBD, 0xE3, 0x87, 
+0x96, 0x80, 0xE9, 0xD8, 0x03, 0x6D, 0x4E, 0xF6, 
+0x30, 0x5B, 0x1F, 0xE0, 0xAB, 0xE8, 0x88, 0x17, 
+0x20, 0x49, 0x99, 0xDD, 0x15, 0xE0, 0xE6, 0xDA, 
+0xDB, 0x5B, 0x56, 0x1C, 0x2B, 0x56, 0x6C, 0x29, 
+0x2F, 0xD7, 0xF3, 0x26, 0x93, 0xBF, 0x6F, 0x9C, 
+0x50, 0xA0, 0x12, 0x46, 0xE3, 0xDE, 0xC9, 0x0E, 
+0x0C, 0xC5, 0x5C, 0xD3, 0xD4, 0xE2, 0xDE, 0x9B, 
+0xF3, 0x02, 0x96, 0x4C, 0x34, 0xBF, 0xAA, 0x3A, 
+0x7A, 0xCE, 0x70, 0x99, 0x48, 0x22, 0x23, 0xF2, 
+0x9E, 0x7D, 0xAB, 0x11, 0x89, 0x47, 0xFA, 0x46, 
+0x47, 0xD9, 0x17, 0x07, 0xDF, 0xD2, 0xCF, 0x29, 
+0x7C, 0xDA, 0x38, 0xA8, 0xA1, 0x7B, 0xCD, 0xB2, 
+0x97, 0x4D, 0x0E, 0x1A, 0x26, 0x72, 0x1C, 0x66, 
+0xF9, 0x9E, 0x7B, 0xAD, 0xA7, 0xDE, 0x9A, 0x39, 
+0x14, 0x7E, 0x98, 0xAE, 0xDE, 0x93, 0xBB, 0x88, 
+0xE9, 0x16, 0x38, 
+
+0x59, 0x64, 0x7E, 0x5E, 0xCA, 0x70, 0xC4, 0xBB, 
+0x4D, 0x18, 0xF7, 0xCA, 0x2C, 0x2C, 0x5E, 0x85, 
+0xBF, 0x3D, 0xD7, 0x8A, 0x0D, 0x74, 0x19, 0x8C, 
+0xFD, 0xCF, 0x9E, 0xC8, 0xCB, 0xB3, 0x20, 0xF2, 
+0x71, 0x82, 0xFC, 0xF2, 0x34, 0xE1, 0x1C, 0xD6, 
+0x15, 0x1E, 0xDE, 0x32, 0x00, 0x6F, 0x3E, 0x61, 
+0x0E, 0xD4, 0x42, 0xF9, 0xDF, 0x59, 0x93, 0x6D, 
+0x04, 0xFC, 0x7B, 0x20, 0x84, 0x8B, 0x31, 0x89, 
+0xE0, 0x3B, 0x7E, 0x35, 0x01, 0x62, 0x4F, 0x13, 
+0x26, 0xF1, 0xDC, 0x9F, 0x7F, 0x29, 0x02, 0xB4, 
+0xD5, 0x87, 0x29, 0x7C, 0x3B, 0x76, 0xA9, 0xC8, 
+0x17, 0xA3, 0xE8, 0xF8, 0x78, 0x03, 0x8A, 0x5D, 
+0x67, 0x2C, 0x95, 0xEF, 0xA5, 0x0A, 0x61, 0x27, 
+0x58, 0x46, 0xC7, 0xD6, 0xA4, 0x9B, 0xA4, 0x09, 
+0x08, 0xF3, 0x40, 0x71, 0x33, 0x5D, 0xEC, 0x13, 
+0xEA, 0x81, 0x17, 0x90, 0x6D, 0x61, 0x45, 0xB9, 
+0x8D, 0x3B, 0xF4, 0x1E, 0x9D, 0x4E, 0x10, 0x00, 
+0xF6, 0x40, 0xD8, 0xF4, 0x83, 0xAE, 0xF7, 0x44, 
+0x35, 0xBA, 0xF8, 0xA3, 0xD7, 0xF8, 0xA9, 0xAE, 
+0x36, 0xA7, 0x93, 0x43, 0x59, 0x01, 0xA4, 0xC2, 
+0xD8, 0x55, 0xB5, 0xC3, 0x78, 0xF6, 0x4B, 0x49, 
+0xDB, 0x0F, 0x95, 0xD0, 0xCC, 0xFB, 0x8D, 0xEC, 
+0x4B, 0x4A, 0xD1, 0x3C, 0x0D, 0xEA, 0x3E, 0xA8, 
+0x5E, 0xAE, 0xE3, 0x0A, 0x7E, 0xDB, 0x3E, 0x50, 
+0xB6, 0x6D, 0xCE, 0xC2, 0x1F, 0xD0, 0xBE, 0x45, 
+0xA8, 0x17, 0x87, 0xA1, 0x15, 0x80, 0x61, 0x20, 
+0x9E, 0x69, 0x74, 0x9E, 0xDE, 0x65, 0x0B, 0x3B, 
+0x7D, 0x42, 0xFB, 0xC2, 0xC7, 0x9C, 0x2C, 0x85, 
+0x73, 0x31, 0x4A, 0x78, 0x2E, 0x31, 0x87, 0x96, 
+0xD0, 0x73, 0x02, 0xCC, 0xF7, 0xCA, 0x0C, 0x0D, 
+0x7D, 0x68, 0x91, 0x6A, 0x3A, 0xB1, 0xE7, 0x64, 
+0xFD, 0x47, 0xCE, 0x4A, 0x08, 0xB3, 0x0A, 0x84, 
+0xC2, 0x80, 0xCC, 0x4E, 0x95, 0x4E, 0x0A, 0x3E, 
+0x75, 0x0A, 0xAB, 0xDF, 0x0F, 0x52, 0x55, 0x13, 
+0x13, 0xDB, 0xA6, 0x7A, 
+
+0x61, 0xB1, 0x82, 0x8B, 0x01, 0x79, 0x85, 0xED, 
+0xE9, 0x1C, 0xE9, 0xB5, 0x7A, 0x69, 0xFD, 0xF8, 
+0xD6, 0xB2, 0xCB, 0xC3, 0x83, 0x7D, 0xB4, 0xFA, 
+0x05, 0xF3, 0x1C, 0xAF, 0xA2, 0x3B, 0x53, 0xDC, 
+0xF6, 0x67, 0xEF, 0x58, 0x51, 0x69, 0xC8, 0xC9, 
+0xB8, 0xA8, 0xBF, 0xD6, 0x59, 0xEE, 0xDD, 0x17, 
+0x8F, 0x3A, 0x91, 0x3A, 0x5D, 0x8A, 0xB8, 0x11, 
+0x4B, 0x26, 0x14, 0x0C, 0x90, 0x9A, 0xC2, 0xDB, 
+0xE6, 0x7E, 0xB1, 0x60, 0x3E, 0x26, 0xEF, 0x3C, 
+0xCF, 0xDB, 0xE0, 0xFC, 0xE3, 0x8F, 0x0B, 0x11, 
+0x49, 0xAF, 0xB0, 0x08, 0x44, 0x6A, 0x01, 0x4B, 
+0xCC, 0x96, 0x90, 0xD4, 0x06, 0xA0, 0xEB, 0x08, 
+0x08, 0xF2, 0x85, 0x1D, 0xD7, 0x12, 0xC4, 0x1D, 
+0x15, 0x91, 0x44, 0x64, 0x32, 0xEC, 0x74, 0x43, 
+0x93, 0x52, 0x1E, 0xCA, 0x3C, 0x30, 0x49, 0x1E, 
+0x2E, 0xF4, 0x6E, 0xCC, 0x1F, 0x12, 0x74, 0xEF, 
+0x47, 0x12, 0xD8, 0x69, 0x03, 0x68, 0x37, 0xFD, 
+0x3D, 0x8B, 0x2E, 0x46, 0xD2, 0x57, 0xBF, 0x96, 
+0x43, 0xAD, 0x73, 0x93, 0x6B, 0x09, 0x37, 0xA4, 
+0x06, 0xCF, 0xD6, 0xB2, 0x9C, 0x76, 0x01, 0xF1, 
+0xFD, 0x66, 0xC5, 0x71, 0x8B, 0xB1, 0xD8, 0xE8, 
+0xD0, 0x7E, 0x85, 0x06, 0x17, 0x66, 0x39, 0x94, 
+0xC0, 0xEA, 0x67, 0x17, 0x67, 0xBA, 0x23, 0xCB, 
+0xD1, 0x83, 0xF7, 0x99, 0x3A, 0x36, 0xEA, 0x3F, 
+0x33, 0x07, 0xA3, 0x6F, 0x5C, 0x84, 0x7E, 0x53, 
+0xD7, 0x54, 0xFF, 0xE4, 0xB8, 0x83, 0xCC, 0xE9, 
+0x14, 0x9C, 0xA7, 0x9F, 0x3F, 0x55, 0x9A, 0xA8, 
+0x73, 0xE6, 0xE3, 0xD0, 0x38, 0x64, 0xAD, 0xC4, 
+0x0F, 0xED, 0x24, 0x46, 0xA0, 0xB6, 0x58, 0x73, 
+0x86, 0x84, 0xA3, 0x02, 0xD1, 0xE0, 0x26, 0x44, 
+0xC3, 0x74, 0xCC, 0x0B, 0x84, 0x4A, 0xCE, 0x7F, 
+0xE2, 0xA3, 0xBC, 0xE2, 0xC8, 0x6F, 0x22, 0x9B, 
+0x3D, 0x6C, 0x40, 0xD1, 0x8C, 0xEE, 0x0A, 0x23, 
+0xCE, 0x06, 0xDE, 0x10, 0x17, 0xD9, 0x06, 0x6E, 
+0x2C, 0xCE, 0xEE, 0x94, 0xB0, 
+
+0xDB, 0xCC, 0x59, 0x2A, 0x28, 0xA6, 0xDA, 0x6D, 
+0xA5, 0x44, 0xEA, 0xD1, 0x92, 0x5A, 0x6A, 0x56, 
+0x8F, 0x7F, 0x10, 0xEC, 0x1E, 0x8E, 0xD8, 0xEA, 
+0x66, 0xB5, 0x48, 0x84, 0x58, 0x2E, 0x31, 0x39, 
+0x9C, 0xB5, 0x27, 0x1F, 0xB0, 0x28, 0x7E, 0xF3, 
+0xE5, 0xA3, 0x07, 0x7E, 0xB7, 0xE7, 0xB3, 0x06, 
+0x13, 0xA2, 0x7D, 0x9F, 0xC7, 0xE0, 0x5F, 0x9F, 
+0x44, 0x62, 0x6E, 0x16, 0x00, 0x0E, 0xE7, 0x6F, 
+0xAD, 0xDC, 0xC1, 0x3E, 0x34, 0x1C, 0xDD, 0x62, 
+0x48, 0x04, 0xED, 0xEE, 0x3D, 0x66, 0x4D, 0xFE, 
+0x53, 0x56, 0x3A, 0x15, 0x1A, 0xE7, 0x0B, 0xA9, 
+0x48, 0x5E, 0x5A, 0x4B, 0x9B, 0xB2, 0x29, 0xD8, 
+0xB0, 0x54, 0xD6, 0x93, 0xF6, 0x3D, 0x42, 0x72, 
+0x48, 0xF1, 0xFF, 0x46, 0x67, 0x7A, 0xC3, 0xB3, 
+0x35, 0x62, 0xA4, 0x03, 0xD0, 0xD2, 0x20, 0x80, 
+0x79, 0x57, 0x14, 0x24, 0x09, 0x82, 0x90, 0x89, 
+0x52, 0x41, 0xCE, 0xBC, 0xC7, 0xAD, 0xF8, 0x1B, 
+0x88, 0x60, 0x69, 0x0B, 0xB0, 0x76, 0x4C, 0xF2, 
+0x1D, 0x42, 0x9A, 0xB3, 0x0E, 0x39, 0x83, 0x18, 
+0xBA, 0x96, 0x1C, 0x4E, 0x49, 0x6A, 0x79, 0x9B, 
+0x8E, 0x11, 0xA9, 0x93, 0x59, 0x5F, 0xC1, 0xB7, 
+0x4B, 0xC0, 0x94, 0xC5, 0x4E, 0x54, 0xB8, 0x46, 
+0xF7, 0x6B, 0xAA, 0x6E, 0x7E, 0xA4, 0x7E, 0xEC, 
+0xA1, 0x23, 0x7A, 0x5D, 0x84, 0xC1, 0xCD, 0x63, 
+0xF9, 0x18, 0x7B, 0x86, 0xB6, 0x8D, 0xD0, 0x83, 
+0x80, 0x3B, 0x5F, 0xF1, 0xA0, 0xBD, 0xAF, 0xA0, 
+0x6C, 0x0C, 0xCF, 0xBD, 0x90, 0x53, 0xDB, 0xC1, 
+0x8B, 0x9E, 0xA1, 0x90, 0x2B, 0x24, 0x6F, 0xD9, 
+0x55, 0xE5, 0xC2, 0x1B, 0xF2, 0x38, 0x50, 0x28, 
+0xC4, 0x1A, 0x37, 0xA9, 0xAE, 0x14, 0x33, 0xD0, 
+0x2A, 0x42, 0xBD, 0xF0, 0x5F, 0x68, 0xD6, 0xD2, 
+0x45, 0x03, 0x2F, 0x09, 0x0C, 0x48, 0xC2, 0x92, 
+0xF0, 0xA8, 0x3D, 0x68, 0x83, 0xBA, 0x7F, 0x61, 
+0x95, 0x58, 0xFA, 0x5F, 0xC3, 0x45, 0x57, 0x41, 
+0x8E, 0x85, 0xD2, 0x03, 0xD5, 0x3A, 
+
+0xFF, 0xCA, 0xA7, 0x1B, 0x16, 0xF1, 0x46, 0x59, 
+0x7B, 0x3E, 0x0F, 0xA3, 0xE9, 0xDF, 0xFE, 0x10, 
+0x58, 0x24, 0x28, 0x8D, 0x42, 0x3F, 0xCC, 0x88, 
+0x09, 0xCE, 0x4D, 0xA4, 0x86, 0x62, 0xB9, 0x8B, 
+0x1D, 0x4D, 0xA6, 0x4C, 0x0C, 0xC1, 0x56, 0xCF, 
+0xF0, 0x0B, 0xCD, 0x6F, 0x75, 0x64, 0xD4, 0x01, 
+0x57, 0x15, 0x38, 0x82, 0xF5, 0xF2, 0xC5, 0x19, 
+0xF6, 0xF3, 0x36, 0xF8, 0x62, 0x5E, 0x7E, 0xC1, 
+0xB4, 0x96, 0x51, 0x55, 0x2C, 0x80, 0x61, 0x18, 
+0xE0, 0x41, 0x29, 0x86, 0x66, 0xAF, 0x07, 0x00, 
+0x5B, 0x09, 0x82, 0xFA, 0x0A, 0xE5, 0x27, 0xED, 
+0xD6, 0x70, 0x91, 0xA4, 0x7B, 0x32, 0x8D, 0x65, 
+0xB5, 0xEB, 0x2D, 0x74, 0x05, 0x88, 0x42, 0x41, 
+0x6F, 0x53, 0x01, 0xE3, 0x29, 0x12, 0x90, 0xC2, 
+0x01, 0x63, 0x9F, 0x56, 0x62, 0x12, 0x01, 0x4A, 
+0x58, 0x55, 0xF4, 0xA6, 0xD0, 0xE5, 0xD4, 0xA8, 
+0x22, 0x3F, 0x79, 0x08, 0x26, 0x43, 0xA2, 0xDA, 
+0xB3, 0xB0, 0x82, 0x78, 0xE3, 0x80, 0x2F, 0x87, 
+0xE0, 0x4B, 0x81, 0xA9, 0xAA, 0x92, 0x01, 0x50, 
+0x90, 0x15, 0x25, 0x07, 0x27, 0xB9, 0x40, 0x34, 
+0xFA, 0xB2, 0x51, 0x53, 0x1F, 0xD8, 0xC6, 0x8D, 
+0x17, 0xE4, 0x2B, 0x73, 0xA0, 0x0A, 0x85, 0xFC, 
+0x80, 0xE6, 0x3E, 0xB0, 0xF0, 0xA4, 0x34, 0xB5, 
+0x8E, 0x5E, 0x18, 0xA8, 0xF5, 0x23, 0xEC, 0x62, 
+0xFF, 0x50, 0xD2, 0x9A, 0xD4, 0x21, 0x71, 0x20, 
+0xEA, 0x24, 0x75, 0xB6, 0xA5, 0x24, 0xDF, 0x4D, 
+0x0A, 0x0D, 0x89, 0x56, 0x7E, 0x08, 0x7A, 0x9D, 
+0x8F, 0x51, 0x73, 0xD2, 0x2C, 0x10, 0xD9, 0x7C, 
+0x35, 0x04, 0x5F, 0x3E, 0x3E, 0xA7, 0x62, 0x19, 
+0xE0, 0xF7, 0x3C, 0xAD, 0x16, 0x07, 0x1F, 0xB1, 
+0x1A, 0x73, 0x8F, 0x31, 0x60, 0x61, 0xF9, 0x6A, 
+0x85, 0xF2, 0xE5, 0x05, 0xF5, 0x02, 0x81, 0xB5, 
+0x72, 0xA2, 0x98, 0x74, 0xA5, 0x14, 0x27, 0x3E, 
+0x57, 0x35, 0xEC, 0x92, 0x3E, 0xAA, 0x95, 0x69, 
+0x23, 0xBA, 0xAF, 0x4A, 0x02, 0xED, 0xE2, 
+
+0xAD, 0x08, 0xB1, 0xDE, 0xF8, 0x88, 0x2D, 0xCB, 
+0xDC, 0x15, 0xF8, 0xFA, 0x07, 0xA8, 0x96, 0x42, 
+0xBC, 0xBF, 0x98, 0x10, 0x8C, 0xF6, 0xDD, 0xC1, 
+0x42, 0xA7, 0xE2, 0x8D, 0x36, 0x8A, 0xB9, 0xA1, 
+0xAE, 0x2F, 0xF8, 0x6D, 0xBB, 0x11, 0x5A, 0xF8, 
+0x98, 0xD9, 0x7D, 0xF3, 0x86, 0x14, 0x7E, 0x7F, 
+0x1D, 0x13, 0xFD, 0x31, 0x7A, 0x01, 0xEF, 0x1D, 
+0xEE, 0xD3, 0xFC, 0x6C, 0x21, 0x37, 0x91, 0x76, 
+0x48, 0x01, 0xF1, 0xB8, 0x90, 0xBF, 0xBE, 0xBF, 
+0xA8, 0x3D, 0xC8, 0xF1, 0x10, 0xE0, 0xFA, 0x29, 
+0x5A, 0x3D, 0x1D, 0x08, 0xB7, 0xBF, 0x1D, 0x8E, 
+0x80, 0xC6, 0xA3, 0x72, 0x23, 0xC1, 0x38, 0xAC, 
+0x74, 0x4D, 0x02, 0x44, 0x0D, 0xDE, 0x70, 0xA7, 
+0x04, 0xA4, 0x8B, 0xBF, 0xD2, 0xD3, 0xE3, 0x56, 
+0xFA, 0xE0, 0x43, 0x68, 0x9D, 0x6F, 0x7C, 0xBD, 
+0x4C, 0x5A, 0xE0, 0x0C, 0x62, 0x3F, 0x5B, 0x78, 
+0x95, 0xA5, 0x42, 0x4A, 0xE2, 0x7E, 0x6B, 0x1F, 
+0x80, 0x8C, 0xAB, 0x93, 0x44, 0xA6, 0xAA, 0x68, 
+0x0A, 0xF8, 0xC9, 0x59, 0x7E, 0x51, 0xED, 0x1E, 
+0x2B, 0x7D, 0x19, 0x3C, 0x8B, 0x8F, 0x02, 0x48, 
+0xEA, 0x33, 0xF1, 0x44, 0x56, 0x04, 0xFC, 0x28, 
+0xE5, 0x1B, 0xBF, 0x0A, 0x4A, 0x05, 0x97, 0xC5, 
+0x18, 0x5F, 0x6D, 0xD3, 0x47, 0xF9, 0xB4, 0x7C, 
+0xC3, 0x38, 0x67, 0x15, 0xF0, 0x15, 0xB5, 0x62, 
+0xA0, 0x7E, 0x2C, 0xED, 0x7F, 0xE9, 0xF3, 0x1D, 
+0xEB, 0x3C, 0x77, 0xDC, 0xD3, 0xAC, 0x67, 0x05, 
+0xC9, 0x76, 0xD4, 0x5A, 0x06, 0x48, 0xDB, 0x91, 
+0xE6, 0xEE, 0xB6, 0x82, 0xED, 0x28, 0xAD, 0x87, 
+0x8E, 0x2C, 0x16, 0x2C, 0xD8, 0x0D, 0x17, 0x95, 
+0x3E, 0x36, 0x73, 0x3C, 0x78, 0x54, 0x3C, 0x1F, 
+0x08, 0x0C, 0x9D, 0x7C, 0x69, 0xDE, 0xA8, 0x45, 
+0x6B, 0xF2, 0x6B, 0x79, 0xEE, 0xF9, 0xEE, 0x30, 
+0xBF, 0x1A, 0xF5, 0x74, 0xF7, 0x91, 0x0C, 0xB5, 
+0xC7, 0x28, 0x51, 0x7A, 0x4C, 0x30, 0x91, 0x53, 
+0x94, 0x3E, 0x0B, 0xC8, 0x11, 0x79, 0x6D, 0xA2, 
+
+0x08, 0x47, 0x1C, 0x0F, 0xA3, 0xB0, 0x3D, 0x08, 
+0xB2, 0xFE, 0x53, 0x46, 0xD7, 0x3F, 0xB1, 0x3C, 
+0xC1, 0x3E, 0x7D, 0xEF, 0x7E, 0xED, 0x9F, 0xCC, 
+0x86, 0xA0, 0xB4, 0xA1, 0xC2, 0xB1, 0xF2, 0xF5, 
+0xC6, 0x49, 0xDD, 0x3E, 0x0A, 0x68, 0x26, 0xF3, 
+0x99, 0x95, 0xA7, 0x47, 0x82, 0x4A, 0x6A, 0x26, 
+0x9C, 0xEB, 0x95, 0x29, 0xD3, 0x12, 0x8D, 0x5F, 
+0x8C, 0x4D, 0xCF, 0x8B, 0x1E, 0xEF, 0x22, 0x75, 
+0xB2, 0x89, 0x6E, 0xBB, 0x4B, 0x34, 0x9B, 0x5A, 
+0x41, 0xA0, 0x0B, 0x8B, 0x1D, 0x64, 0x18, 0xE1, 
+0x57, 0x67, 0x05, 0xB3, 0xCE, 0xB9, 0x11, 0xB8, 
+0x00, 0x60, 0x48, 0x60, 0x9F, 0x70, 0x46, 0xD6, 
+0xC1, 0x1B, 0x3F, 0x1E, 0x7F, 0x78, 0xC5, 0xF1, 
+0x84, 0x17, 0x50, 0xD9, 0x65, 0xB1, 0x17, 0xD9, 
+0x47, 0xED, 0x60, 0x70, 0x0D, 0x3B, 0xDF, 0xCC, 
+0x94, 0xF9, 0xB5, 0xF4, 0x1E, 0x6D, 0x1F, 0x50, 
+0x94, 0x9C, 0xBA, 0x7C, 0x65, 0x0E, 0x12, 0xD2, 
+0x2F, 0x41, 0x0E, 0xE2, 0x50, 0x43, 0xD8, 0xBC, 
+0x95, 0xDD, 0x8F, 0xF3, 0x89, 0xB1, 0xA5, 0xAC, 
+0xD0, 0x6B, 0xB9, 0x00, 0xED, 0x39, 0xB2, 0xA7, 
+0xF3, 0xE5, 0xA6, 0x00, 0x5B, 0x48, 0x05, 0xD0, 
+0xDE, 0x05, 0x46, 0x9F, 0xAF, 0x41, 0x20, 0xA4, 
+0x7A, 0xA8, 0xEA, 0x06, 0xE5, 0xD6, 0x4C, 0x3E, 
+0x74, 0xC8, 0x77, 0x74, 0x0F, 0x5A, 0x7A, 0xCE, 
+0xBD, 0x4C, 0x75, 0x26, 0x70, 0x37, 0xB5, 0xBE, 
+0x73, 0x1B, 0xD7, 0x22, 0x9A, 0x00, 0xE7, 0x70, 
+0x08, 0xA3, 0xBD, 0x66, 0xBC, 0x8A, 0x70, 0x3C, 
+0x45, 0xEA, 0x66, 0xF6, 0x93, 0x27, 0x3B, 0xAA, 
+0x95, 0x9B, 0xF2, 0x96, 0xF2, 0x30, 0xFD, 0x17, 
+0x51, 0x7E, 0xB6, 0x54, 0x8D, 0x52, 0xAA, 0x16, 
+0x9F, 0xDD, 0x57, 0x82, 0x2C, 0x1A, 0x96, 0x91, 
+0x16, 0xC4, 0xBC, 0xD9, 0x9C, 0xB8, 0x24, 0xD1, 
+0xE4, 0xF2, 0xB9, 0x1F, 0x5D, 0x03, 0xA8, 0xA4, 
+0x76, 0x3C, 0x18, 0x7E, 0xF9, 0x5A, 0x16, 0xF9, 
+0xC4, 0x23, 0xD8, 0x57, 0x1D, 0x6D, 0x08, 0x4F, 
+0x5B, 
+
+0xB7, 0x03, 0x6A, 0x3E, 0x9B, 0x29, 0xCE, 0x4A, 
+0x6A, 0xFB, 0x61, 0xD8, 0xCB, 0xCF, 0x6D, 0x8C, 
+0xE6, 0x93, 0x34, 0xAC, 0x5E, 0xD3, 0x3C, 0xD7, 
+0xF1, 0xEB, 0xE9, 0x0F, 0x6A, 0xFC, 0x8F, 0x01, 
+0xF7, 0x56, 0xC9, 0x18, 0x92, 0xBA, 0x58, 0x1D, 
+0x11, 0x20, 0xF9, 0xEF, 0x1E, 0x96, 0xA3, 0xD6, 
+0x20, 0x25, 0xAF, 0xFD, 0x95, 0x24, 0x22, 0xC4, 
+0x14, 0x96, 0xC1, 0xD1, 0x62, 0xDF, 0x9A, 0x98, 
+0xEF, 0x1B, 0x17, 0x48, 0xC7, 0x84, 0x70, 0x2A, 
+0x3D, 0xA3, 0xCB, 0xCC, 0xD9, 0x21, 0x2C, 0xD4, 
+0xBD, 0x98, 0x3A, 0xD2, 0x35, 0x0A, 0xC2, 0x34, 
+0x7A, 0x44, 0x9F, 0x56, 0x9F, 0x85, 0xD4, 0x2B, 
+0xC6, 0xDF, 0xF2, 0xE8, 0x01, 0x33, 0xE3, 0xE3, 
+0x25, 0x5B, 0xE4, 0x74, 0x15, 0x2C, 0xA4, 0x0C, 
+0x6B, 0xEA, 0x07, 0xA5, 0x23, 0x93, 0xAC, 0x98, 
+0x09, 0x74, 0xD8, 0xF6, 0xE0, 0xA7, 0xB2, 0x0C, 
+0x2E, 0x4D, 0xA2, 0xDE, 0x8E, 0x80, 0x85, 0x18, 
+0xDA, 0x88, 0x3D, 0xE7, 0x51, 0xBE, 0xB9, 0x38, 
+0xEC, 0x84, 0xF0, 0x7D, 0x13, 0x53, 0x6B, 0xCD, 
+0x88, 0x1F, 0x07, 0x3A, 0x89, 0x25, 0xC0, 0xFC, 
+0xC2, 0xB1, 0x5B, 0xD4, 0x99, 0x8A, 0x5D, 0x35, 
+0x7B, 0x2A, 0x22, 0x56, 0x67, 0x6C, 0xD7, 0xEC, 
+0x25, 0x0C, 0xCC, 0x37, 0xC4, 0x0B, 0x78, 0x88, 
+0x9C, 0x40, 0xA8, 0x55, 0x82, 0x6C, 0x73, 0x3D, 
+0x7C, 0xC4, 0xCA, 0x23, 0x15, 0x30, 0xB0, 0x0C, 
+0x6B, 0x16, 0x4E, 0xDE, 0xB9, 0x40, 0xC9, 0xC6, 
+0x52, 0xE2, 0x37, 0x7C, 0x48, 0xD6, 0x39, 0x37, 
+0x6D, 0x94, 0x09, 0xB0, 0x90, 0x83, 0xC4, 0xED, 
+0x24, 0x6A, 0xB7, 0x5A, 0x8E, 0x4A, 0x0B, 0x20, 
+0xF2, 0x76, 0xE3, 0xF5, 0xFD, 0x19, 0xAA, 0xFD, 
+0xB5, 0x97, 0x92, 0xE1, 0x5C, 0x4C, 0xD5, 0x98, 
+0x79, 0x5E, 0x2A, 0xD9, 0x66, 0xC5, 0x3E, 0x65, 
+0x71, 0x6F, 0x9D, 0x4B, 0xA7, 0xE6, 0x27, 0x85, 
+0x37, 0x47, 0xC8, 0xEF, 0x94, 0x8E, 0x74, 0x62, 
+0x90, 0x6F, 0x77, 0x98, 0x44, 0xC6, 0x41, 0xBF, 
+0x93, 0x91, 
+
+0x44, 0xC5, 0x80, 0x17, 0x49, 0x4B, 0x88, 0xE9, 
+0x5C, 0xF8, 0x29, 0xFD, 0x9B, 0xB5, 0x00, 0xDB, 
+0x7E, 0x1B, 0xE5, 0x92, 0x64, 0xA3, 0x0A, 0x65, 
+0x66, 0x02, 0x32, 0xAE, 0x81, 0x2C, 0xE0, 0xA6, 
+0x43, 0x1C, 0xA6, 0x62, 0x3F, 0xB5, 0xE0, 0x67, 
+0xF8, 0xF8, 0xEB, 0x96, 0x7A, 0x17, 0x6A, 0x10, 
+0x28, 0x97, 0x13, 0x86, 0x0F, 0x09, 0xC8, 0xB9, 
+0x64, 0x92, 0xFB, 0x79, 0x66, 0x5F, 0xBE, 0x11, 
+0xE4, 0x43, 0xA4, 0xB1, 0x74, 0x01, 0x80, 0x59, 
+0xDA, 0x47, 0xDA, 0xBF, 0x4E, 0xE4, 0xE6, 0xAA, 
+0x0A, 0x13, 0x43, 0x83, 0xC9, 0x0D, 0xB3, 0xD7, 
+0x76, 0x56, 0xA5, 0xD6, 0x2C, 0xAC, 0x7F, 0x3C, 
+0x04, 0xFF, 0x65, 0xAE, 0x23, 0xE6, 0x37, 0x47, 
+0xEC, 0x66, 0x05, 0x6A, 0xBB, 0x1F, 0x06, 0x06, 
+0xAE, 0xD4, 0xE9, 0x75, 0x4F, 0x0B, 0x06, 0x18, 
+0xEB, 0xB5, 0xDA, 0xD1, 0xB0, 0xE4, 0xD1, 0x00, 
+0xCC, 0x2C, 0x41, 0x45, 0x85, 0x04, 0x7A, 0x02, 
+0x75, 0x52, 0x24, 0x82, 0xDC, 0x6A, 0x0F, 0xE2, 
+0x8B, 0x26, 0xAF, 0x52, 0x19, 0x6F, 0x0E, 0xBC, 
+0x0E, 0xE5, 0x61, 0xFE, 0xE7, 0x4D, 0xC8, 0x08, 
+0x35, 0x5F, 0xC4, 0x61, 0xB8, 0xD6, 0x63, 0xD1, 
+0xB0, 0x24, 0x1C, 0x06, 0x77, 0x0D, 0x17, 0xEC, 
+0xDF, 0xA2, 0x6C, 0x7E, 0x59, 0x7D, 0xE3, 0x54, 
+0xC5, 0xB4, 0x99, 0x62, 0x21, 0x22, 0x0B, 0x66, 
+0xEF, 0x01, 0xC0, 0x15, 0x88, 0x24, 0x11, 0x3E, 
+0xEA, 0x0E, 0x29, 0x50, 0x14, 0x39, 0xA3, 0xEA, 
+0x25, 0x27, 0xF3, 0x2C, 0x3B, 0xEF, 0x48, 0x95, 
+0x27, 0x13, 0xC8, 0x04, 0xC7, 0x06, 0x52, 0x4D, 
+0x32, 0x13, 0x36, 0x21, 0x67, 0xB5, 0xF9, 0x54, 
+0x58, 0x63, 0x0C, 0x84, 0x2A, 0xD5, 0x06, 0x56, 
+0xD0, 0x9D, 0x2A, 0x8C, 0x03, 0x30, 0xB0, 0x54, 
+0xDD, 0xA0, 0x08, 0xCF, 0x10, 0xB3, 0x7B, 0x9D, 
+0x14, 0xC0, 0x1C, 0xF9, 0xB9, 0xDD, 0xF8, 0x66, 
+0xA2, 0x91, 0x64, 0xB3, 0x98, 0x45, 0x05, 0xE0, 
+0xD9, 0x23, 0xEA, 0x4F, 0x96, 0x71, 0x97, 0x54, 
+0xE3, 0x5F, 0x20, 
+
+0xE9, 0xCD, 0x92, 0x29, 0xF4, 0x05, 0xF1, 0x6E, 
+0xDE, 0x52, 0x21, 0x87, 0x05, 0xFB, 0xB1, 0xEA, 
+0xAC, 0xA7, 0x12, 0x35, 0x80, 0x66, 0x5D, 0xC2, 
+0x20, 0xE0, 0x95, 0x35, 0x6A, 0x99, 0xD1, 0x60, 
+0xED, 0x24, 0x98, 0x15, 0x79, 0x2A, 0xF6, 0x1C, 
+0x23, 0xF9, 0xE2, 0xBA, 0x20, 0x82, 0x54, 0x28, 
+0x6A, 0x25, 0xEA, 0xE2, 0xD9, 0xEA, 0x38, 0x59, 
+0xD1, 0x74, 0xA2, 0xB1, 0xFC, 0x6E, 0xBC, 0x33, 
+0xCB, 0xAB, 0x0D, 0x47, 0x8F, 0xA2, 0x03, 0xDE, 
+0xC6, 0xCD, 0x60, 0x36, 0xEC, 0x4B, 0xE1, 0xBB, 
+0x59, 0xEE, 0x29, 0x15, 0x19, 0x14, 0x35, 0x8C, 
+0x7C, 0x23, 0x78, 0xC6, 0xAC, 0xB9, 0x6C, 0x96, 
+0xC8, 0xA2, 0xA8, 0xBE, 0x68, 0xD3, 0x48, 0xC4, 
+0xB2, 0x87, 0xEF, 0x27, 0x47, 0x25, 0xA7, 0xA4, 
+0xD8, 0x73, 0x13, 0x34, 0xB0, 0x59, 0x35, 0x02, 
+0x4F, 0xA3, 0xDB, 0xCE, 0xA1, 0xD4, 0x85, 0xB8, 
+0x56, 0x4A, 0x44, 0x6B, 0x17, 0x8F, 0x26, 0x85, 
+0xD4, 0x52, 0x94, 0xCC, 0x39, 0x1B, 0x86, 0x50, 
+0xCB, 0xA9, 0x5E, 0x46, 0x2C, 0x15, 0x85, 0x07, 
+0x3E, 0xDD, 0x31, 0x20, 0xD7, 0x2F, 0x19, 0xFA, 
+0x24, 0xDE, 0x56, 0x10, 0x90, 0x16, 0x33, 0xE2, 
+0xD4, 0x7C, 0xEB, 0x32, 0x32, 0x27, 0xF2, 0x99, 
+0x37, 0xD1, 0xF9, 0x51, 0x47, 0xEA, 0x72, 0xF0, 
+0x1D, 0xE8, 0xAA, 0x1D, 0x87, 0xAB, 0x7A, 0x1F, 
+0x20, 0x2B, 0x48, 0xEE, 0x76, 0xA7, 0x38, 0xFC, 
+0xBA, 0xF9, 0x3A, 0xDD, 0xD2, 0xF6, 0x9E, 0xCC, 
+0xEA, 0xAC, 0xE5, 0x32, 0x57, 0xA1, 0x5E, 0x89, 
+0x1A, 0xF9, 0x2A, 0x8F, 0x66, 0x1B, 0xDD, 0xCE, 
+0x13, 0xEE, 0xAE, 0x21, 0x8F, 0x9B, 0x5E, 0x71, 
+0x7C, 0x52, 0x72, 0xFC, 0xE2, 0xB9, 0x10, 0xAF, 
+0x45, 0x75, 0x56, 0x0C, 0x42, 0xA7, 0x7B, 0x68, 
+0xE5, 0x26, 0x31, 0xA3, 0xF0, 0xBD, 0x3F, 0xFD, 
+0xA8, 0xC1, 0xD8, 0x3C, 0x77, 0xF9, 0x7B, 0x5A, 
+0x5D, 0x0F, 0x07, 0x51, 0xDF, 0xB4, 0xD3, 0x1D, 
+0xE3, 0x26, 0xC2, 0xF5, 0x39, 0x13, 0xE3, 0x8C, 
+0x9A, 0x33, 0xE7, 0x21, 
+
+0x47, 0x01, 0x53, 0xAD, 0x77, 0xBE, 0x56, 0x04, 
+0xF8, 0xD3, 0x3F, 0x53, 0xA0, 0x6D, 0x85, 0x6B, 
+0xCB, 0xF8, 0x59, 0xB7, 0x1A, 0x75, 0x06, 0xCB, 
+0x4C, 0xE3, 0x3E, 0xF7, 0xD4, 0x4E, 0x24, 0x59, 
+0x90, 0xDD, 0xE3, 0x8F, 0xC6, 0xCC, 0x5A, 0x3E, 
+0x8F, 0x10, 0xE9, 0xDA, 0x45, 0x9E, 0x51, 0x33, 
+0x4C, 0x0F, 0x32, 0x47, 0x0F, 0xCD, 0x37, 0xDA, 
+0x4B, 0xA6, 0x48, 0xAD, 0xC0, 0x21, 0xD8, 0xC0, 
+0xEA, 0xB7, 0x77, 0x20, 0x2F, 0x19, 0x03, 0x68, 
+0xD3, 0x59, 0x30, 0x5E, 0x6E, 0x16, 0x2C, 0x14, 
+0xD7, 0xE3, 0xE4, 0x6D, 0xFB, 0xEE, 0xE0, 0x28, 
+0x52, 0x8E, 0x49, 0x09, 0x5F, 0x58, 0xFE, 0xCC, 
+0xB5, 0x95, 0x56, 0xB9, 0xAA, 0x22, 0x6D, 0x98, 
+0x03, 0x31, 0x4B, 0x8D, 0x60, 0xBE, 0xA0, 0xFA, 
+0x07, 0xBA, 0xB1, 0xB5, 0x08, 0xC0, 0x73, 0x94, 
+0x92, 0x28, 0xC3, 0xC7, 0x4D, 0xB7, 0x16, 0x1E, 
+0x55, 0x3C, 0x89, 0x2D, 0x51, 0xE8, 0x12, 0x99, 
+0x23, 0xD2, 0x4A, 0xCE, 0xC6, 0x1F, 0x3E, 0x8F, 
+0xDE, 0x8E, 0x64, 0x78, 0x52, 0x71, 0x47, 0x00, 
+0x63, 0x82, 0x32, 0xB8, 0x76, 0x83, 0xAE, 0x12, 
+0xCA, 0x60, 0xD8, 0xD4, 0x6F, 0xFD, 0x6F, 0x39, 
+0x80, 0x11, 0xBA, 0x86, 0xB2, 0x12, 0x05, 0xC1, 
+0x6E, 0x76, 0x96, 0xB6, 0x9D, 0x5C, 0x4B, 0x15, 
+0x74, 0x97, 0xDA, 0xEF, 0x4F, 0x8C, 0x93, 0xC4, 
+0x62, 0xA5, 0xF3, 0xD0, 0xCE, 0xEE, 0x73, 0xCB, 
+0xAA, 0x78, 0x25, 0xBF, 0xBB, 0x65, 0x9A, 0xF1, 
+0xD5, 0xC3, 0x48, 0xA6, 0x3D, 0x68, 0x6E, 0xC0, 
+0x38, 0xDD, 0xEC, 0x51, 0x5B, 0xC2, 0x1B, 0xA4, 
+0x77, 0xAF, 0x42, 0x10, 0x94, 0xC2, 0x03, 0x8A, 
+0x59, 0x62, 0xFE, 0x0A, 0x52, 0x5B, 0x30, 0x18, 
+0xEB, 0xCF, 0xA6, 0x51, 0xCA, 0x68, 0x62, 0xFE, 
+0x51, 0x04, 0xC4, 0xDF, 0x36, 0x71, 0x41, 0xE8, 
+0x5C, 0xE8, 0xBE, 0x97, 0x53, 0x9B, 0xE2, 0x46, 
+0x9A, 0xE4, 0x6A, 0x24, 0x52, 0xD1, 0xA8, 0x2A, 
+0x95, 0x6D, 0xC2, 0x3C, 0xE1, 0xF0, 0xDE, 0xA8, 
+0xF8, 0x16, 0x7B, 0x16, 0x3D, 
+
+0x63, 0xAB, 0x83, 0x5D, 0x58, 0x0D, 0xE7, 0xFC, 
+0x3C, 0x1B, 0x15, 0xC2, 0x8E, 0x8B, 0x8C, 0xEA, 
+0x69, 0xBF, 0x23, 0x0D, 0xB4, 0x73, 0xBC, 0x60, 
+0x7C, 0x12, 0x6B, 0xE9, 0x34, 0xFE, 0xC9, 0x6F, 
+0x72, 0xE5, 0x47, 0xE7, 0xAD, 0x9F, 0x5F, 0x17, 
+0x9C, 0x7E, 0xF3, 0xA4, 0x9C, 0x79, 0x2C, 0x15, 
+0xF9, 0x4D, 0xE5, 0x66, 0xF9, 0xD6, 0xE3, 0x87, 
+0xBB, 0x2B, 0x28, 0xE6, 0xE8, 0x37, 0x3A, 0xD0, 
+0x88, 0x72, 0x9E, 0x69, 0x7B, 0x33, 0xF4, 0x5E, 
+0x10, 0x3A, 0x1A, 0x77, 0x73, 0xAB, 0xA9, 0x5C, 
+0xA1, 0x91, 0x45, 0xC0, 0x4B, 0x0F, 0x91, 0x47, 
+0x6B, 0x23, 0x84, 0x0C, 0x2F, 0x97, 0xB9, 0xA1, 
+0xE6, 0xE9, 0x1C, 0x01, 0x16, 0x00, 0xF2, 0x4C, 
+0xF1, 0x89, 0xA3, 0x72, 0xB8, 0x56, 0x2B, 0xBB, 
+0x02, 0x94, 0x57, 0x36, 0x37, 0xCE, 0x54, 0xE9, 
+0x07, 0x5E, 0xF5, 0x93, 0xB1, 0x38, 0x2C, 0x7D, 
+0x61, 0x57, 0x5B, 0x28, 0x82, 0x86, 0xD8, 0x39, 
+0xA7, 0x36, 0x76, 0x50, 0x09, 0xBB, 0xC3, 0x6F, 
+0xF8, 0x49, 0x7B, 0x19, 0x47, 0x72, 0xB4, 0x93, 
+0x7C, 0x80, 0xB0, 0xA7, 0xF8, 0x6C, 0xBD, 0x3A, 
+0xB5, 0x9B, 0xF0, 0x0C, 0x70, 0x43, 0x8F, 0x64, 
+0x8F, 0xA1, 0xC0, 0xCC, 0x5B, 0xA9, 0xC6, 0x44, 
+0xC4, 0xE3, 0xD6, 0x1B, 0xCD, 0x81, 0x6B, 0xA5, 
+0x6A, 0xB2, 0xB3, 0x9F, 0xAE, 0x58, 0x0A, 0xDE, 
+0x62, 0x53, 0x58, 0x09, 0x21, 0x2C, 0xB7, 0x4A, 
+0x1B, 0xEE, 0x53, 0x35, 0x4F, 0x7D, 0xA6, 0x9D, 
+0xD5, 0xCC, 0x4E, 0x76, 0x18, 0x26, 0x9C, 0xAD, 
+0x82, 0xD9, 0xED, 0x21, 0x00, 0xFE, 0x7C, 0x39, 
+0xCA, 0x6C, 0xAB, 0x0E, 0x1B, 0x36, 0x82, 0xB0, 
+0x1F, 0x06, 0xE2, 0x96, 0xDB, 0x13, 0x26, 0x50, 
+0x2B, 0x80, 0x3B, 0xAB, 0xB6, 0x23, 0x8C, 0x4E, 
+0x28, 0x80, 0x97, 0xE1, 0x4C, 0x68, 0x11, 0x4D, 
+0xC7, 0xA8, 0x33, 0xC0, 0xED, 0x4B, 0x53, 0xA7, 
+0xD1, 0x6B, 0xC1, 0x4B, 0xE3, 0x67, 0xE7, 0x06, 
+0x0A, 0x2B, 0x70, 0x4E, 0xA7, 0x55, 0x5F, 0x1C, 
+0x40, 0x8F, 0x1F, 0xB5, 0xB4, 0x1B, 
+
+0x01, 0x98, 0xC0, 0x7C, 0xFC, 0x85, 0x7A, 0x6A, 
+0x2A, 0xAB, 0xD1, 0x22, 0x4B, 0xFE, 0x18, 0x89, 
+0x28, 0x47, 0xAF, 0x78, 0xE7, 0x44, 0x4B, 0x4D, 
+0xD2, 0xEA, 0x61, 0x27, 0xF2, 0x03, 0xFF, 0x62, 
+0x1E, 0x72, 0x04, 0xBF, 0x55, 0x7C, 0x03, 0x98, 
+0x5E, 0xFD, 0x12, 0x97, 0xCD, 0x61, 0x57, 0x8F, 
+0xEB, 0xCD, 0x20, 0x3A, 0x9A, 0x27, 0x01, 0xDD, 
+0x26, 0x9A, 0x03, 0x44, 0x34, 0xB5, 0x3A, 0x8E, 
+0xB5, 0xDE, 0x46, 0x2E, 0xE2, 0xA3, 0x38, 0xAF, 
+0x21, 0xE0, 0x03, 0xCD, 0x83, 0x52, 0x18, 0x3B, 
+0x4A, 0x30, 0xF2, 0x55, 0xA8, 0x40, 0x4A, 0xC9, 
+0xFA, 0x94, 0x27, 0x55, 0x47, 0xC0, 0xD9, 0xA3, 
+0x9D, 0x82, 0xCC, 0xB6, 0xEE, 0x4A, 0x6C, 0x2C, 
+0x70, 0x0D, 0x21, 0x06, 0x0D, 0x31, 0xAD, 0x7A, 
+0xF4, 0x1A, 0x42, 0x86, 0xA2, 0xD8, 0x35, 0x10, 
+0xCF, 0x65, 0x5D, 0x70, 0x50, 0xE6, 0xDE, 0x60, 
+0xA4, 0xFF, 0xE7, 0x68, 0x9C, 0xA1, 0x62, 0x85, 
+0xCC, 0xD6, 0x95, 0xE6, 0x89, 0xC7, 0xAB, 0x35, 
+0xDC, 0x96, 0x58, 0xC2, 0xB1, 0x91, 0x48, 0x15, 
+0x16, 0xBE, 0xBE, 0x69, 0x94, 0x70, 0x66, 0x67, 
+0x9E, 0xFF, 0xCD, 0xC7, 0xDC, 0xF1, 0xE9, 0xEB, 
+0x7B, 0xCF, 0x2E, 0x65, 0xB6, 0x1D, 0xBC, 0xBE, 
+0x08, 0xFF, 0xE5, 0xC6, 0x71, 0x62, 0xB2, 0xE0, 
+0x8F, 0xC8, 0x9F, 0xD7, 0x51, 0x71, 0xE7, 0x0E, 
+0xA8, 0x02, 0x92, 0xEF, 0x84, 0x7F, 0xF7, 0x44, 
+0x37, 0x5D, 0xE1, 0x6D, 0x7D, 0xBB, 0x3A, 0x75, 
+0xD7, 0x1C, 0x47, 0x23, 0x37, 0xD5, 0x50, 0xD3, 
+0xC7, 0x35, 0x8D, 0x68, 0xC7, 0x6A, 0xAA, 0x3B, 
+0x0F, 0xDC, 0xBF, 0x89, 0x69, 0x1A, 0xB0, 0x23, 
+0x83, 0xAB, 0x03, 0x09, 0xC2, 0x41, 0xCC, 0xBD, 
+0x27, 0xCF, 0x94, 0x1E, 0x81, 0xD0, 0x5E, 0x9B, 
+0x1B, 0x06, 0x79, 0x28, 0xC9, 0xA3, 0xA7, 0x12, 
+0x36, 0x22, 0x36, 0xB3, 0xEE, 0x99, 0xFA, 0x61, 
+0x50, 0xB0, 0x35, 0x0C, 0x89, 0x69, 0xA7, 0xB2, 
+0x6E, 0x9F, 0x70, 0xD2, 0x50, 0x1A, 0x7E, 0x75, 
+0xA7, 0x5E, 0x87, 0x86, 0xC0, 0xE6, 0x8B, 
+
+}
+return kat[i:j]
+}
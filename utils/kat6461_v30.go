@@ -0,0 +1,5478 @@
+package utils
+func getkat6461_v30(i uint64, j uint64) []uint8 {
+kat := []uint8{
+0x31, 0x13, 0x20, 0x19, 0x0F, 0x63, 0xF8, 0x5D, 
+0x85, 0xAF, 0x1A, 0x03, 0xF1, 0x04, 0x82, 0x7A, 
+0xF3, 0x66, 0x3E, 0x11, 0x14, 0x66, 0x83, 0x6C, 
+0x19, 0xD5, 0x41, 0xC3, 0x13, 0xE0, 0x42, 0x46, 
+
+0xA2, 0x5F, 0x9C, 0x16, 0x8B, 0x5C, 0x00, 0xDB, 
+0x8C, 0x4A, 0x80, 0x4D, 0x10, 0x83, 0x90, 0x69, 
+0xD8, 0xD4, 0x78, 0xCB, 0x6C, 0x9E, 0x88, 0x08, 
+0xB1, 0x0B, 0xC4, 0xEF, 0x1F, 0x52, 0x71, 0x04, 
+0x20, 
+
+0xF3, 0x81, 0xEF, 0x67, 0x39, 0x15, 0xDD, 0xE8, 
+0xF6, 0xC2, 0xFF, 0x31, 0x6D, 0x23, 0x36, 0x79, 
+0xA9, 0x91, 0x92, 0xFB, 0x7F, 0x38, 0xFD, 0xBA, 
+0x31, 0x05, 0xC6, 0xC9, 0x80, 0xAA, 0xB1, 0xC7, 
+0x0E, 0x67, 
+
+0x92, 0x31, 0x24, 0x86, 0xA0, 0x58, 0x32, 0x6C, 
+0x16, 0xBE, 0x76, 0x6F, 0xC3, 0xCD, 0x04, 0xD3, 
+0xF4, 0x44, 0x97, 0x0B, 0x09, 0x7E, 0xFC, 0x48, 
+0x96, 0x3E, 0xC7, 0xC8, 0xA1, 0x5E, 0xE8, 0x03, 
+0xEC, 0x69, 0xA3, 
+
+0xC1, 0x4B, 0x1C, 0x0F, 0x0B, 0xAC, 0xE4, 0x8A, 
+0x45, 0xF0, 0x51, 0x5D, 0xD8, 0x14, 0xEF, 0x60, 
+0x1C, 0xD3, 0x05, 0x0A, 0x78, 0x56, 0x58, 0x23, 
+0xC7, 0xBD, 0xF8, 0x3C, 0xCF, 0x44, 0x6C, 0xC5, 
+0x0C, 0x6A, 0x3F, 0xD9, 
+
+0x34, 0x83, 0x79, 0x4E, 0x5C, 0x1C, 0x79, 0xCE, 
+0x73, 0xB4, 0x84, 0x8C, 0x49, 0xDA, 0x84, 0x94, 
+0xE7, 0x74, 0x85, 0x5F, 0xE6, 0x06, 0x20, 0xA9, 
+0x46, 0xA0, 0x69, 0x4B, 0xF8, 0x2E, 0xB9, 0x11, 
+0x9F, 0xA1, 0x9C, 0x4C, 0x52, 
+
+0x6F, 0x28, 0x45, 0xE3, 0x22, 0xC0, 0x43, 0x2F, 
+0x57, 0x8D, 0x41, 0xC4, 0xB6, 0x8F, 0x53, 0x3E, 
+0x70, 0xA8, 0xA6, 0x4A, 0xF9, 0xEE, 0x07, 0xDC, 
+0x17, 0x2B, 0x1F, 0x86, 0xDA, 0xD9, 0x06, 0x07, 
+0x9B, 0xA7, 0x22, 0x38, 0x7A, 0x1F, 
+
+0xFF, 0x86, 0xEE, 0xEE, 0xF9, 0x6B, 0x2B, 0x01, 
+0x09, 0x14, 0xD1, 0xCC, 0x6D, 0x83, 0x6D, 0xCC, 
+0xE9, 0x67, 0x69, 0x84, 0x35, 0xD3, 0x6F, 0x0F, 
+0x4E, 0xD7, 0xAF, 0x03, 0x30, 0x73, 0xB8, 0xC3, 
+0xAF, 0x57, 0xA8, 0x8A, 0xE5, 0xF3, 0x52, 
+
+0x8C, 0xCF, 0xA7, 0x08, 0x8F, 0xCB, 0xA1, 0x66, 
+0x0F, 0x9D, 0x6D, 0x6E, 0xD7, 0x5B, 0xAC, 0x19, 
+0x4C, 0x61, 0xA7, 0x36, 0xFF, 0x2D, 0x14, 0x90, 
+0xDC, 0x66, 0x2F, 0xEC, 0x8C, 0x6A, 0x1C, 0x30, 
+0x65, 0xA9, 0x78, 0x92, 0x08, 0x57, 0x1C, 0x51, 
+
+0x26, 0xF5, 0x6C, 0xD7, 0xC4, 0x81, 0xF9, 0x19, 
+0x69, 0xD8, 0xAB, 0x76, 0x61, 0x3B, 0x12, 0x01, 
+0xF0, 0x07, 0xDD, 0x21, 0xCE, 0x28, 0x1B, 0xC9, 
+0x15, 0x6B, 0xBF, 0x6A, 0x08, 0x15, 0x16, 0x5C, 
+0xA6, 0xD9, 0x4B, 0x2A, 0xF1, 0x96, 0x72, 0xDD, 
+0x85, 
+
+0xA2, 0x98, 0x2D, 0x14, 0xFD, 0x0B, 0x4B, 0x0C, 
+0x91, 0x3D, 0x4F, 0x68, 0xED, 0xF6, 0xCF, 0x95, 
+0xA4, 0x7A, 0xC0, 0xE2, 0x7F, 0x06, 0x63, 0x8E, 
+0x9D, 0xAA, 0x32, 0xC7, 0xD4, 0x4B, 0x99, 0xB3, 
+0xDB, 0xC0, 0x08, 0x09, 0x2E, 0xE3, 0xFB, 0x6C, 
+0xF3, 0x00, 
+
+0x6D, 0x33, 0x46, 0xFE, 0xEF, 0x22, 0x10, 0x63, 
+0xAF, 0xE9, 0xFB, 0x87, 0x1E, 0x28, 0xD4, 0xC7, 
+0xFE, 0x56, 0x78, 0xA0, 0x3F, 0x4A, 0x45, 0x3D, 
+0x1E, 0xCA, 0x54, 0xEF, 0x36, 0x49, 0xA6, 0x70, 
+0x95, 0xD5, 0x04, 0xE2, 0xFA, 0xB8, 0x84, 0x2B, 
+0xD9, 0xB3, 0x02, 
+
+0x20, 0x8B, 0x5C, 0xF3, 0x65, 0x1C, 0x60, 0x8A, 
+0x31, 0xB1, 0x5D, 0x13, 0x19, 0xBF, 0xEA, 0x55, 
+0xFE, 0x85, 0x30, 0x2D, 0x23, 0x4F, 0x28, 0xCD, 
+0x84, 0x89, 0xD1, 0x3C, 0x4F, 0xAD, 0x9F, 0xB7, 
+0xC3, 0x1C, 0x3F, 0x28, 0xB8, 0x22, 0x26, 0x90, 
+0x42, 0x83, 0x67, 0x5E, 
+
+0xA0, 0xA1, 0x64, 0x02, 0x1D, 0x57, 0x55, 0x71, 
+0xD1, 0xFD, 0xA9, 0x55, 0xF3, 0x4D, 0x22, 0x67, 
+0xE7, 0xDA, 0x59, 0xEC, 0xBA, 0xE8, 0x5F, 0x42, 
+0x93, 0x03, 0x9C, 0xCC, 0x28, 0xB1, 0x80, 0x1C, 
+0x08, 0x9F, 0x43, 0x9B, 0x5D, 0xA6, 0x94, 0xEC, 
+0x55, 0x91, 0x6C, 0x7D, 0xD0, 
+
+0x15, 0xD6, 0xDA, 0x57, 0x02, 0xA7, 0xB5, 0x64, 
+0x67, 0x6A, 0x5C, 0xE3, 0x7C, 0x48, 0x1A, 0xD6, 
+0xC7, 0xA4, 0x03, 0xF9, 0xF3, 0xD7, 0x0C, 0x53, 
+0x11, 0xD7, 0x8C, 0xA4, 0x60, 0xD1, 0x02, 0x95, 
+0x24, 0xF6, 0x75, 0x68, 0x4B, 0x94, 0x8F, 0xA5, 
+0x87, 0x34, 0x8A, 0x20, 0xA2, 0x7B, 
+
+0xAC, 0x5D, 0x91, 0x5B, 0x79, 0x54, 0x7B, 0xD8, 
+0x3A, 0x83, 0xA2, 0x6E, 0x10, 0x7C, 0x29, 0x6B, 
+0xC0, 0x23, 0xEE, 0x4E, 0x97, 0x2B, 0xA8, 0x65, 
+0xAC, 0xA2, 0x8D, 0xB8, 0xC0, 0x1F, 0x47, 0x3F, 
+0xB9, 0xBE, 0xA3, 0x50, 0xCB, 0x11, 0x2F, 0xB1, 
+0x0F, 0xFA, 0xF7, 0xC3, 0xEB, 0xA7, 0x4E, 
+
+0x09, 0xDF, 0xD4, 0xBB, 0x8C, 0x4B, 0xEC, 0x5F, 
+0x79, 0xED, 0x02, 0x77, 0xEF, 0xA8, 0xBE, 0xBC, 
+0x07, 0x55, 0xB2, 0x99, 0x85, 0xAE, 0xB6, 0x26, 
+0xF8, 0xD5, 0xC8, 0x03, 0x04, 0xA9, 0x3F, 0x3F, 
+0x06, 0xF7, 0x59, 0xD1, 0x49, 0x69, 0x2E, 0xA5, 
+0x16, 0x3C, 0x7D, 0x76, 0x1C, 0xB4, 0x50, 0xDD, 
+
+0x75, 0xC0, 0x68, 0x05, 0xAE, 0xB3, 0x01, 0xE5, 
+0x67, 0xB4, 0xD6, 0x74, 0x13, 0xBB, 0xCB, 0xF7, 
+0x99, 0x02, 0x0A, 0x1D, 0x62, 0x64, 0xB0, 0xBA, 
+0x81, 0x08, 0x43, 0xF9, 0xDD, 0x61, 0x9F, 0x0C, 
+0x15, 0xD6, 0xA7, 0x50, 0x97, 0x63, 0x05, 0x0D, 
+0xE8, 0xC4, 0x29, 0x77, 0x9D, 0xA0, 0x5F, 0x7F, 
+0x45, 
+
+0x4C, 0xD0, 0x75, 0x73, 0x93, 0x02, 0xD4, 0x99, 
+0x1D, 0xC6, 0x69, 0x31, 0x6E, 0x92, 0xA9, 0x2E, 
+0x5B, 0x4F, 0xA5, 0xED, 0x2C, 0x4A, 0x95, 0x2C, 
+0x00, 0x8F, 0x02, 0x1A, 0x0A, 0x4D, 0x02, 0x2F, 
+0x3B, 0x9B, 0x32, 0x48, 0x02, 0x6A, 0x9A, 0xAD, 
+0xA3, 0x8D, 0x51, 0x81, 0x6C, 0x00, 0xCA, 0xC4, 
+0xAE, 0xA6, 
+
+0x21, 0xFE, 0xBC, 0xFA, 0xDC, 0x46, 0x9D, 0x2E, 
+0x0A, 0x55, 0x24, 0xCB, 0x0A, 0x6D, 0xAA, 0x32, 
+0xBF, 0x7A, 0xAB, 0x5B, 0xA4, 0x28, 0xD3, 0xF1, 
+0x3C, 0x5E, 0xED, 0x8A, 0xC4, 0x38, 0x7A, 0xCE, 
+0x38, 0x9B, 0x6E, 0x20, 0x83, 0x1F, 0x5B, 0x16, 
+0x7A, 0xC0, 0x1D, 0x1E, 0x4A, 0xA9, 0x14, 0xAF, 
+0xC4, 0x60, 0x1C, 
+
+0x44, 0xA8, 0x6C, 0x1F, 0xAB, 0xAE, 0xB6, 0x77, 
+0x62, 0xA9, 0xA6, 0x1A, 0x86, 0x51, 0xFC, 0xDE, 
+0xDC, 0xA6, 0xD6, 0xC5, 0xDC, 0x1D, 0x68, 0x4A, 
+0x4C, 0x9C, 0x2B, 0x87, 0xC6, 0xD7, 0xDD, 0xBF, 
+0x05, 0xE4, 0xC5, 0x03, 0x3C, 0x87, 0xAF, 0xCD, 
+0x34, 0x8C, 0x80, 0x4A, 0x23, 0x10, 0x41, 0xE3, 
+0xE0, 0xFB, 0xC4, 0x8B, 
+
+0x78, 0xB9, 0x76, 0x3C, 0xC5, 0x03, 0xEB, 0xD4, 
+0x88, 0x2A, 0x7F, 0x00, 0x9E, 0x13, 0x7B, 0x69, 
+0xFC, 0x87, 0xA2, 0x54, 0x9D, 0x55, 0x28, 0xC0, 
+0x06, 0xD2, 0x0A, 0x58, 0x26, 0xF8, 0x76, 0xED, 
+0xBE, 0xB4, 0x8E, 0x22, 0x61, 0x37, 0x64, 0xEF, 
+0xB2, 0xC6, 0xE3, 0x51, 0xF7, 0x9E, 0x86, 0x49, 
+0x16, 0x4E, 0x0C, 0x07, 0xBE, 
+
+0xF6, 0x66, 0x90, 0x33, 0x77, 0x58, 0xA7, 0x5A, 
+0xB1, 0xE1, 0x4C, 0xA5, 0xF0, 0x45, 0x76, 0x55, 
+0x53, 0x8C, 0xC1, 0x25, 0x01, 0xE3, 0x3E, 0x19, 
+0x1A, 0xC0, 0x7E, 0x07, 0x0F, 0x62, 0xB1, 0x79, 
+0x0E, 0xE8, 0xFF, 0x69, 0x7B, 0x84, 0x42, 0x6F, 
+0x49, 0x86, 0xFF, 0x02, 0x80, 0x4B, 0x96, 0x64, 
+0xC3, 0x3F, 0xE8, 0x3A, 0x77, 0x7B, 
+
+0xA6, 0x48, 0x5C, 0xC5, 0x91, 0x60, 0x07, 0x3E, 
+0x8E, 0x07, 0x76, 0x88, 0xB4, 0x99, 0x73, 0x56, 
+0x3E, 0x0C, 0x5B, 0x22, 0x77, 0x3F, 0x89, 0xBE, 
+0xF1, 0xA7, 0x87, 0x3C, 0xE3, 0xBA, 0x1E, 0x39, 
+0xD6, 0xFE, 0x7A, 0x31, 0xDC, 0xFD, 0x1A, 0x69, 
+0xF5, 0x37, 0xA9, 0x5C, 0x51, 0xF6, 0x5E, 0x0E, 
+0x29, 0xE8, 0x86, 0x9E, 0xC9, 0xBA, 0x0A, 
+
+0xA0, 0x2F, 0xEA, 0xDF, 0x4A, 0x61, 0x55, 0x5D, 
+0xEE, 0x50, 0xB2, 0x41, 0xC6, 0x7A, 0x12, 0xB6, 
+0x5F, 0xCE, 0xA0, 0xAE, 0xB6, 0x08, 0xA0, 0x83, 
+0x6A, 0x57, 0x26, 0xFC, 0x78, 0xCF, 0x8E, 0x82, 
+0xAA, 0x3B, 0x2C, 0xD6, 0xBB, 0x28, 0x26, 0xEF, 
+0x30, 0x75, 0xB8, 0x5A, 0x61, 0x32, 0x39, 0x7F, 
+0x4D, 0xF6, 0xB9, 0x85, 0x22, 0xA6, 0x0B, 0xEC, 
+
+0x60, 0x5A, 0x7B, 0x4C, 0x52, 0x5B, 0x67, 0x9B, 
+0x78, 0x52, 0x62, 0x5E, 0xC3, 0x4C, 0x6B, 0xF1, 
+0x46, 0x67, 0x6E, 0x3D, 0x50, 0xB4, 0x08, 0x16, 
+0x83, 0xA1, 0xB9, 0xD3, 0x88, 0x07, 0xF9, 0xDF, 
+0x70, 0x87, 0xC3, 0x32, 0x14, 0xB2, 0x11, 0xD1, 
+0x8C, 0x01, 0x43, 0x5A, 0x3C, 0xE5, 0xCE, 0x63, 
+0x4D, 0xBE, 0x3D, 0x43, 0xE2, 0xDD, 0xFC, 0xF1, 
+0x64, 
+
+0xD5, 0xC5, 0xF4, 0x07, 0x73, 0x79, 0x44, 0x99, 
+0x03, 0x48, 0x0A, 0x39, 0x7D, 0xC6, 0x69, 0x89, 
+0x70, 0x97, 0xC1, 0x32, 0x6D, 0x3A, 0xF7, 0x04, 
+0x1F, 0x5C, 0x48, 0xAA, 0x7A, 0x8B, 0xEE, 0x1D, 
+0x68, 0x5B, 0x60, 0x40, 0x0B, 0x02, 0x80, 0x8D, 
+0xBD, 0x74, 0xA6, 0x75, 0x1F, 0x2F, 0x8D, 0x89, 
+0xF0, 0x7F, 0x29, 0x80, 0x33, 0x33, 0xF5, 0xF9, 
+0xD8, 0x02, 
+
+0x52, 0x68, 0x3D, 0xB3, 0x6C, 0xEC, 0x48, 0x82, 
+0xDC, 0xB6, 0x2E, 0xA5, 0x48, 0xA8, 0x8A, 0x78, 
+0x83, 0x84, 0xAD, 0x46, 0xD2, 0x9F, 0x5E, 0xD9, 
+0xAE, 0x50, 0xE5, 0x10, 0x25, 0x09, 0x20, 0x33, 
+0x4A, 0x23, 0x04, 0xD8, 0x07, 0x6D, 0x9C, 0xB4, 
+0x29, 0xF5, 0x19, 0x8C, 0xEF, 0x0E, 0xC6, 0x0F, 
+0xE2, 0xF6, 0xD7, 0x4D, 0x27, 0x87, 0x20, 0x35, 
+0xAD, 0x68, 0xCF, 
+
+0xDE, 0xA4, 0x7C, 0x20, 0x5F, 0x0D, 0x59, 0x25, 
+0x0D, 0xED, 0x1C, 0x58, 0xA9, 0xF1, 0x81, 0x6B, 
+0x9C, 0x59, 0xFB, 0xD4, 0x4F, 0xA5, 0xCD, 0x83, 
+0x45, 0x03, 0x4B, 0x69, 0xEA, 0xAB, 0xAA, 0xF2, 
+0xC8, 0x01, 0xF2, 0xA4, 0x1A, 0xD8, 0xEC, 0xCB, 
+0x74, 0x75, 0xEF, 0x3D, 0x40, 0xD2, 0x0B, 0xDB, 
+0x06, 0x08, 0xC1, 0xB5, 0x5A, 0x43, 0xA4, 0x97, 
+0xD9, 0xBD, 0x5E, 0x49, 
+
+0x10, 0x05, 0x78, 0x43, 0x3F, 0xF7, 0x96, 0x86, 
+0xE8, 0x42, 0xD5, 0x67, 0x01, 0x68, 0x73, 0x25, 
+0x1A, 0xF3, 0xB1, 0xAD, 0x37, 0x62, 0x66, 0xFE, 
+0x6D, 0xB9, 0x3F, 0x95, 0xFA, 0x2D, 0xF9, 0xA5, 
+0xF0, 0xC4, 0x43, 0xC4, 0x2A, 0xF5, 0x00, 0xB4, 
+0x3F, 0x04, 0x8C, 0x42, 0xF1, 0x71, 0x11, 0x38, 
+0x2B, 0x26, 0xF3, 0x49, 0x4A, 0x26, 0x61, 0x1F, 
+0x57, 0xAE, 0x20, 0xC1, 0xA0, 
+
+0xCA, 0x1D, 0xA9, 0x24, 0x0F, 0x11, 0x3D, 0x3D, 
+0x63, 0x0E, 0xC9, 0x87, 0xF1, 0xE3, 0x61, 0xF9, 
+0x80, 0xAC, 0x0B, 0xFC, 0x0B, 0xC6, 0xD6, 0xDF, 
+0x2E, 0x47, 0x36, 0x1D, 0x6F, 0x4A, 0x41, 0x30, 
+0xAD, 0x67, 0x9F, 0xFF, 0x42, 0xF3, 0x41, 0x8D, 
+0x67, 0xAF, 0x6E, 0x16, 0x2A, 0xB5, 0x68, 0x41, 
+0x94, 0x7F, 0x82, 0x7C, 0xC6, 0x92, 0x3F, 0xFE, 
+0xCC, 0x53, 0xE2, 0x08, 0x98, 0x37, 
+
+0xD8, 0x61, 0xB3, 0x5B, 0x3A, 0x5F, 0x0F, 0xC5, 
+0x81, 0xB0, 0x8A, 0xF6, 0x32, 0xC5, 0x5C, 0x56, 
+0xCD, 0xAD, 0x42, 0x9F, 0x2A, 0x1C, 0x50, 0x7C, 
+0xAF, 0x75, 0xE6, 0x4A, 0x50, 0x9E, 0xFF, 0x98, 
+0x36, 0x85, 0x17, 0x63, 0x13, 0xBB, 0xC9, 0x67, 
+0x17, 0x2D, 0xBE, 0xA8, 0x73, 0x13, 0x33, 0x67, 
+0xA6, 0xC1, 0x98, 0x65, 0x22, 0xFD, 0xB5, 0x51, 
+0x8B, 0x90, 0x8C, 0x21, 0xD3, 0xDA, 0x02, 
+
+0x8D, 0x5E, 0x43, 0x4A, 0x70, 0x4D, 0xBE, 0xE2, 
+0x43, 0x61, 0x3C, 0xDE, 0xFA, 0x48, 0x21, 0x07, 
+0x60, 0xF2, 0x3E, 0x5D, 0x6E, 0x1B, 0xDD, 0xCF, 
+0x42, 0x22, 0x56, 0x49, 0x6B, 0xC1, 0xDF, 0x91, 
+0x0A, 0xEB, 0xBD, 0x71, 0x43, 0x43, 0x83, 0x52, 
+0x1A, 0xCA, 0xE9, 0x0F, 0x12, 0x68, 0xC4, 0xFD, 
+0x1A, 0x31, 0xBC, 0x1B, 0xD3, 0xA8, 0xDE, 0x7E, 
+0x30, 0x47, 0x1B, 0x4B, 0x9A, 0x3F, 0x74, 0x2B, 
+
+0x08, 0x2F, 0xDA, 0x5D, 0xB3, 0xEB, 0x7C, 0xE9, 
+0xA7, 0x30, 0x53, 0x81, 0xCC, 0x82, 0xE4, 0x0E, 
+0xCB, 0x64, 0x2B, 0x03, 0x97, 0x1F, 0x40, 0x4A, 
+0xB7, 0xB2, 0xA8, 0x89, 0x00, 0x9A, 0x17, 0xA6, 
+0xB4, 0x37, 0xBF, 0xC9, 0x17, 0x7E, 0x50, 0xBE, 
+0x89, 0xF8, 0x70, 0xB9, 0x2E, 0x8C, 0x7C, 0x0B, 
+0x43, 0x74, 0xD0, 0x32, 0x04, 0x76, 0x69, 0x97, 
+0x8B, 0xC8, 0x03, 0xC5, 0x63, 0x26, 0xDB, 0x9C, 
+0x0C, 
+
+0xAC, 0x5A, 0x99, 0x22, 0xC2, 0xF8, 0x9C, 0x5E, 
+0xF1, 0x6E, 0x58, 0x98, 0xB2, 0xAE, 0x04, 0xE0, 
+0x5F, 0xBE, 0x84, 0x49, 0xB5, 0xF0, 0xB4, 0x53, 
+0xB5, 0xD3, 0x9D, 0x67, 0x55, 0x08, 0x10, 0xB1, 
+0xCE, 0x0A, 0x93, 0x76, 0xDB, 0xE4, 0xBB, 0xD8, 
+0x30, 0x8B, 0x92, 0xEC, 0xE0, 0xFC, 0x73, 0xF8, 
+0xDB, 0xB2, 0x29, 0xC7, 0x95, 0x9A, 0xB0, 0xAE, 
+0xF8, 0x7D, 0x5E, 0x00, 0x9B, 0x65, 0x5D, 0xC1, 
+0xB6, 0xB4, 
+
+0x32, 0xE4, 0xF7, 0xBC, 0xA9, 0xE9, 0xAF, 0x52, 
+0x4D, 0x8C, 0xB6, 0xE7, 0x31, 0x4C, 0xA4, 0xC9, 
+0x65, 0xC9, 0x79, 0xB3, 0x00, 0x80, 0x40, 0x91, 
+0xCC, 0x00, 0x71, 0x5F, 0x99, 0x21, 0x4A, 0xF5, 
+0x5A, 0x8C, 0xEF, 0xD3, 0xB3, 0xEE, 0xC8, 0x68, 
+0xC6, 0x16, 0x3D, 0x05, 0x23, 0x42, 0xD2, 0x7F, 
+0x3A, 0xC9, 0x00, 0x57, 0x52, 0xB9, 0x2E, 0xA2, 
+0xC8, 0x1D, 0x27, 0xC5, 0x7C, 0x65, 0xAC, 0x43, 
+0x3C, 0x6C, 0x47, 
+
+0x65, 0x9F, 0xEF, 0x0C, 0x1E, 0x41, 0x98, 0xBB, 
+0x45, 0xD0, 0x08, 0x8D, 0xA7, 0xA1, 0x57, 0xF6, 
+0x43, 0x64, 0xCB, 0xA2, 0x9D, 0xFE, 0xF5, 0x45, 
+0xDF, 0x0D, 0x1C, 0x2A, 0x4E, 0x29, 0x2E, 0xDF, 
+0x72, 0x9F, 0xD6, 0x24, 0xEC, 0x8C, 0x26, 0xA0, 
+0xA6, 0xD2, 0x88, 0xE4, 0x3E, 0x5B, 0xB5, 0x35, 
+0xF7, 0x70, 0x74, 0x6C, 0x36, 0x83, 0x50, 0x1E, 
+0x9B, 0x3D, 0x8D, 0x83, 0x7C, 0xA5, 0x3F, 0xE6, 
+0x12, 0xC0, 0xEB, 0xD7, 
+
+0x00, 0x5F, 0x8B, 0x14, 0x7D, 0x0F, 0x65, 0xF6, 
+0x5F, 0x02, 0x13, 0x01, 0x4C, 0x47, 0xF8, 0x23, 
+0xC8, 0xC6, 0xD1, 0xD2, 0xE3, 0x84, 0xFE, 0xCC, 
+0xFE, 0x20, 0xF1, 0x55, 0xA7, 0xDA, 0x81, 0x93, 
+0x97, 0xE3, 0x66, 0x89, 0xCF, 0xB7, 0x1C, 0xDC, 
+0xFE, 0x85, 0xD2, 0x2A, 0xF1, 0xF7, 0xCF, 0xB0, 
+0x19, 0xCE, 0x7B, 0x86, 0x03, 0x5E, 0x59, 0x4F, 
+0xCE, 0x72, 0xF9, 0xAB, 0x86, 0xC6, 0xCE, 0xE7, 
+0xC8, 0xED, 0x6E, 0x93, 0x9D, 
+
+0x33, 0x08, 0xFD, 0x46, 0xD7, 0x75, 0x0C, 0x0A, 
+0x29, 0xBF, 0x7C, 0xE2, 0x35, 0x2F, 0xBE, 0xFB, 
+0x62, 0x20, 0x5A, 0x72, 0x49, 0x90, 0xD8, 0xC1, 
+0xD3, 0x1E, 0xD8, 0xD3, 0xC2, 0x68, 0x03, 0xA7, 
+0xE1, 0xDB, 0x81, 0x9A, 0x07, 0x59, 0xD4, 0xE5, 
+0x95, 0x19, 0xDD, 0x94, 0x15, 0x69, 0xA2, 0xDA, 
+0x16, 0xF1, 0x11, 0xC4, 0xB4, 0x9C, 0x2F, 0x6A, 
+0xA4, 0xFA, 0xEE, 0x36, 0x6F, 0xC2, 0x3D, 0x3D, 
+0xD4, 0x52, 0x72, 0xED, 0xA6, 0x88, 
+
+0x51, 0x3C, 0x2D, 0x17, 0x51, 0xC9, 0x1E, 0xED, 
+0x5F, 0xCB, 0xE7, 0x1C, 0x07, 0xFC, 0x51, 0xCD, 
+0x12, 0x2C, 0x0C, 0xC9, 0x8B, 0xA7, 0xB7, 0x35, 
+0xE3, 0x19, 0xD2, 0x58, 0x48, 0x19, 0xC8, 0x6A, 
+0x1C, 0xB0, 0x49, 0x19, 0xAC, 0xC2, 0xD7, 0x8A, 
+0x8D, 0x22, 0x0A, 0x73, 0xCD, 0xD4, 0x0A, 0x0E, 
+0x07, 0x19, 0x49, 0xFE, 0x57, 0x15, 0x19, 0x27, 
+0xE7, 0xD1, 0x2B, 0x5F, 0xB3, 0x78, 0x4A, 0xD8, 
+0x61, 0xDF, 0x9E, 0x8F, 0x8E, 0xF3, 0x75, 
+
+0xAD, 0x27, 0xF2, 0x77, 0xD8, 0x34, 0xA4, 0x80, 
+0xBC, 0x43, 0x30, 0xC2, 0x35, 0x5F, 0x37, 0xE8, 
+0xDF, 0x33, 0xE3, 0xD5, 0xD1, 0x09, 0xF9, 0xEA, 
+0x41, 0x57, 0x36, 0x94, 0x3B, 0xFB, 0x4B, 0x9E, 
+0xC5, 0xFD, 0xAE, 0x41, 0x8D, 0x0B, 0xA0, 0x18, 
+0x07, 0xF3, 0x73, 0x2E, 0xED, 0x86, 0x5D, 0xBB, 
+0x04, 0x73, 0x95, 0xAA, 0xD5, 0xAE, 0x97, 0x4A, 
+0xC6, 0x11, 0xDE, 0x61, 0xAC, 0x25, 0xD8, 0x02, 
+0x13, 0xAC, 0xA0, 0x35, 0x28, 0x0D, 0xFA, 0x83, 
+
+0x8D, 0x58, 0x1B, 0x59, 0xED, 0x21, 0x71, 0x97, 
+0x8A, 0xD7, 0x56, 0xB4, 0x4E, 0xCB, 0x1F, 0x24, 
+0xE6, 0x29, 0x23, 0xF6, 0x05, 0xEA, 0xFA, 0x12, 
+0xBC, 0x71, 0xC6, 0x21, 0x9C, 0x7D, 0x87, 0xFA, 
+0xCB, 0xD9, 0x7C, 0xBB, 0x54, 0x0D, 0xC7, 0x6D, 
+0x76, 0xF8, 0x2F, 0xEC, 0xAA, 0xC9, 0xAC, 0xF5, 
+0x5F, 0xA5, 0x25, 0x94, 0x6D, 0xEB, 0x73, 0x17, 
+0xB3, 0xC4, 0x2B, 0x91, 0x2C, 0x2C, 0x14, 0x2B, 
+0x85, 0x0B, 0x6D, 0xBB, 0x70, 0xE7, 0x1F, 0xFB, 
+0x75, 
+
+0x14, 0xCE, 0xA6, 0x2E, 0x43, 0xDE, 0xE0, 0x73, 
+0xB4, 0x4D, 0x37, 0x9C, 0x42, 0x2D, 0x8D, 0x4A, 
+0xCB, 0x1A, 0x4A, 0xC6, 0x80, 0x89, 0xE8, 0x4C, 
+0x46, 0xF9, 0x6A, 0x05, 0x98, 0x97, 0xB7, 0x26, 
+0xAC, 0x17, 0x4C, 0xD7, 0x3B, 0x84, 0x87, 0xBC, 
+0xD7, 0xCA, 0xCE, 0x49, 0x18, 0x51, 0x9B, 0xA1, 
+0xF6, 0xDA, 0x14, 0x35, 0xA6, 0x3A, 0x37, 0x2E, 
+0x7C, 0x55, 0x00, 0x68, 0x9C, 0x6B, 0x4A, 0x37, 
+0x18, 0x5A, 0xA0, 0x3B, 0x9B, 0x2B, 0x11, 0x89, 
+0x46, 0x30, 
+
+0xFB, 0xAE, 0x1E, 0xDF, 0x3F, 0x41, 0xD3, 0xCB, 
+0xC7, 0x9C, 0xB8, 0x06, 0x49, 0x95, 0x7E, 0xF6, 
+0xD9, 0x44, 0x73, 0xEE, 0xCE, 0x37, 0x5B, 0x8E, 
+0x00, 0x46, 0x73, 0x47, 0xD0, 0xAD, 0xCF, 0x3A, 
+0xDC, 0x2D, 0xC8, 0x35, 0x9B, 0x46, 0x70, 0x71, 
+0xD1, 0x2A, 0x7D, 0xBB, 0x7D, 0x03, 0x43, 0x60, 
+0x99, 0x6E, 0x6A, 0x70, 0x87, 0xA9, 0xD4, 0x55, 
+0xF5, 0xA9, 0xB2, 0x55, 0xFD, 0x3B, 0xCF, 0x3B, 
+0xCB, 0xEA, 0x96, 0xC4, 0xF2, 0x53, 0x22, 0x5B, 
+0x25, 0xEC, 0x0D, 
+
+0xC4, 0xD6, 0x29, 0x45, 0xA1, 0x49, 0x75, 0xD8, 
+0xD0, 0xC1, 0x28, 0xBF, 0xF4, 0x01, 0xC2, 0xDE, 
+0xD8, 0xE8, 0x48, 0x28, 0x8B, 0x77, 0x44, 0x25, 
+0x17, 0xE9, 0xF1, 0x28, 0x97, 0x08, 0x26, 0xCC, 
+0x5F, 0xED, 0xF7, 0x17, 0x8D, 0xA5, 0xFD, 0x50, 
+0x5E, 0xAE, 0xE1, 0xE9, 0xD4, 0x26, 0x34, 0x62, 
+0x6B, 0xA3, 0x56, 0x19, 0x84, 0xCA, 0x84, 0x38, 
+0x6E, 0x65, 0xAE, 0x90, 0xBB, 0xD5, 0xAB, 0x6F, 
+0x64, 0x8F, 0xD1, 0x81, 0x44, 0xCA, 0x2F, 0x8D, 
+0x2A, 0xEE, 0x80, 0x83, 
+
+0x24, 0xF2, 0x1D, 0x38, 0x04, 0xFE, 0xA3, 0x3D, 
+0xF1, 0xAA, 0x23, 0x48, 0x37, 0x5E, 0x53, 0xCB, 
+0xE5, 0x5F, 0x4A, 0xAD, 0xC5, 0x5D, 0xFB, 0xBC, 
+0x84, 0xD3, 0x1F, 0xD9, 0x68, 0xC6, 0x8B, 0xE4, 
+0xCC, 0x4F, 0xFF, 0x80, 0x90, 0xAE, 0xF9, 0x0B, 
+0xC2, 0xBD, 0x3D, 0xC5, 0x60, 0x5E, 0x8E, 0x7B, 
+0x04, 0x33, 0x40, 0x6F, 0x19, 0xEC, 0x4B, 0xAE, 
+0x95, 0x6C, 0xC1, 0x3C, 0xDC, 0xA9, 0xEC, 0x0C, 
+0x2E, 0x28, 0x98, 0xAC, 0xCD, 0x73, 0xE1, 0x86, 
+0x40, 0x75, 0x1C, 0xCC, 0x91, 
+
+0xAD, 0x61, 0x2B, 0xCA, 0x83, 0x8A, 0x42, 0x47, 
+0x69, 0xBB, 0x06, 0x15, 0x66, 0xF6, 0x1D, 0x69, 
+0xC0, 0xDD, 0x1B, 0x4A, 0x23, 0xB9, 0x0F, 0xA2, 
+0xDB, 0xD1, 0x7A, 0xA1, 0x0F, 0x27, 0x15, 0x7E, 
+0xBA, 0x99, 0x13, 0x2A, 0xE7, 0xFF, 0xA8, 0x1F, 
+0xA3, 0x75, 0xAC, 0xE7, 0x90, 0x93, 0xD0, 0x4C, 
+0xC4, 0xCA, 0xC8, 0xE7, 0x3A, 0x5A, 0xCC, 0x55, 
+0x39, 0x66, 0x17, 0x2B, 0x85, 0x5A, 0xED, 0xAC, 
+0xAA, 0x29, 0x4E, 0x37, 0x24, 0xC4, 0xEC, 0xF6, 
+0x7D, 0xCB, 0xC6, 0x90, 0xD1, 0x97, 
+
+0x22, 0xEF, 0x2C, 0x28, 0x07, 0x60, 0xC2, 0xD5, 
+0x2A, 0x73, 0xAF, 0x40, 0xCD, 0x94, 0x57, 0x77, 
+0x05, 0xAE, 0x30, 0x27, 0xAB, 0xEF, 0xA8, 0x0C, 
+0x3A, 0xE7, 0x42, 0xD9, 0xBF, 0x9A, 0xE7, 0x2A, 
+0xFD, 0x8F, 0xB4, 0x3E, 0x43, 0x04, 0xDB, 0x41, 
+0xA5, 0x3F, 0x2A, 0x0B, 0xCD, 0x01, 0x98, 0x2C, 
+0xDD, 0x90, 0xDF, 0x09, 0xC0, 0x03, 0xB7, 0x7C, 
+0x0D, 0x0B, 0x41, 0x87, 0x67, 0xAE, 0x61, 0x9D, 
+0xD5, 0x77, 0x76, 0x9F, 0xA7, 0x1D, 0x8D, 0x35, 
+0xE8, 0x73, 0x9A, 0x63, 0x8D, 0xA7, 0x9E, 
+
+0x48, 0x98, 0xFE, 0x9B, 0x76, 0x42, 0xDB, 0x91, 
+0x96, 0xC0, 0x56, 0x21, 0x45, 0xE1, 0x34, 0xB9, 
+0xA5, 0xFC, 0x80, 0x32, 0x36, 0x7C, 0x26, 0x25, 
+0x44, 0x7C, 0xCB, 0x9D, 0x4E, 0xA5, 0xA4, 0x7F, 
+0xE1, 0x62, 0x4F, 0x49, 0xC1, 0x78, 0x34, 0xE6, 
+0x2B, 0x82, 0xC3, 0xD3, 0x3A, 0x7A, 0xED, 0x0E, 
+0x96, 0x77, 0x6C, 0xF6, 0x52, 0x54, 0x5F, 0x2F, 
+0xC7, 0x92, 0x15, 0x1D, 0x1F, 0x23, 0x25, 0x13, 
+0x68, 0xC4, 0xD3, 0xB9, 0x51, 0x21, 0xA8, 0xBB, 
+0x0D, 0xE2, 0xC1, 0xF8, 0xDB, 0xCD, 0x3F, 0x98, 
+
+0x1E, 0x01, 0x9C, 0x38, 0xDD, 0xDF, 0x8D, 0xB1, 
+0x70, 0x9B, 0x25, 0x21, 0x48, 0x63, 0x1F, 0x59, 
+0x31, 0x5C, 0xD1, 0x5A, 0x43, 0x2C, 0xAD, 0x45, 
+0xFB, 0xCB, 0xD1, 0xB4, 0xFA, 0x8C, 0xD8, 0x58, 
+0x45, 0x5E, 0xA7, 0xE7, 0x34, 0x6D, 0x1A, 0x7E, 
+0x22, 0x54, 0xE2, 0xDB, 0xAA, 0x08, 0x75, 0x85, 
+0xAA, 0x64, 0xFA, 0xBA, 0xE9, 0x6A, 0x6B, 0x7D, 
+0xAA, 0x44, 0x8F, 0xB2, 0xA3, 0x27, 0x93, 0x40, 
+0x05, 0x2A, 0x4B, 0x94, 0xF3, 0xC2, 0xDA, 0xC7, 
+0x8F, 0x14, 0x5C, 0x2E, 0x16, 0x5E, 0x9F, 0xC4, 
+0x25, 
+
+0x32, 0x6B, 0xAC, 0xA9, 0x16, 0x7B, 0xE2, 0x57, 
+0xE9, 0xC3, 0x86, 0x09, 0x48, 0xDF, 0xD0, 0x45, 
+0x5D, 0x65, 0x2A, 0x15, 0x29, 0x72, 0x75, 0x60, 
+0x5E, 0x31, 0x4A, 0xEF, 0x2B, 0x6C, 0x09, 0xD0, 
+0xAF, 0xEE, 0x34, 0xCD, 0x6C, 0x54, 0xB2, 0x12, 
+0x0C, 0xB1, 0x08, 0xB8, 0x24, 0x70, 0x09, 0x56, 
+0xB8, 0xC1, 0xA4, 0x03, 0x6A, 0x34, 0x2B, 0xF9, 
+0x56, 0x88, 0x01, 0x3C, 0xC0, 0x86, 0xB4, 0x70, 
+0xFA, 0x72, 0x20, 0x3D, 0x53, 0xD7, 0x22, 0x66, 
+0x07, 0x95, 0x3E, 0x8D, 0x9D, 0x5B, 0x8A, 0x52, 
+0x37, 0x5B, 
+
+0xED, 0x3F, 0xC0, 0x38, 0x9E, 0x19, 0x16, 0x0D, 
+0xA3, 0x37, 0x87, 0xB1, 0x22, 0x9D, 0x00, 0xD3, 
+0x6F, 0xE2, 0xC9, 0xE4, 0xF5, 0x9E, 0x6D, 0xA7, 
+0xE7, 0x56, 0xAF, 0xE8, 0x10, 0xDC, 0xCB, 0x90, 
+0x61, 0xCB, 0x3E, 0xAC, 0xA0, 0x9E, 0xC1, 0x84, 
+0xFB, 0xCE, 0x34, 0x00, 0x7E, 0xE5, 0x44, 0xBF, 
+0xE4, 0x67, 0xC3, 0x47, 0x74, 0xEA, 0xED, 0x3C, 
+0x76, 0xF3, 0xF7, 0x18, 0xFC, 0xB2, 0xB1, 0xDD, 
+0x3D, 0x25, 0x5C, 0x39, 0x0D, 0x8F, 0x25, 0xE9, 
+0x91, 0xFE, 0x00, 0x6F, 0xDC, 0x31, 0xB0, 0x39, 
+0x5D, 0x27, 0x8E, 
+
+0x07, 0xA6, 0x13, 0x9F, 0x77, 0xE5, 0x8C, 0x10, 
+0xA1, 0xEE, 0x44, 0x21, 0x10, 0xAA, 0x91, 0x86, 
+0x63, 0x94, 0xDD, 0x4A, 0x46, 0x67, 0xB7, 0xDA, 
+0xF8, 0x1E, 0x31, 0x61, 0x09, 0x2E, 0xB7, 0xF1, 
+0x84, 0xCF, 0x05, 0xDD, 0xE8, 0x19, 0x33, 0x5B, 
+0x53, 0xA0, 0xCA, 0x5C, 0x8A, 0x70, 0x70, 0x30, 
+0xC4, 0xFB, 0x21, 0x68, 0x5D, 0x65, 0x87, 0x45, 
+0xA3, 0x71, 0x3B, 0xD0, 0x2F, 0xF1, 0x62, 0x02, 
+0xE6, 0x05, 0xB0, 0x0C, 0x19, 0x52, 0x64, 0xFB, 
+0x3B, 0x04, 0x9C, 0x5E, 0x2E, 0x3B, 0x8D, 0x58, 
+0xDC, 0xFA, 0x93, 0xDF, 
+
+0x81, 0x4A, 0x35, 0x7F, 0x76, 0x73, 0xF0, 0x93, 
+0x95, 0x04, 0xA3, 0x8B, 0xD7, 0x25, 0xFF, 0xC3, 
+0xF4, 0x6F, 0xCC, 0x32, 0x1F, 0x32, 0x5A, 0x3C, 
+0x37, 0x6B, 0x86, 0xF4, 0x79, 0x62, 0x53, 0x8B, 
+0x5C, 0x22, 0x0B, 0xDA, 0xD2, 0x44, 0xEE, 0x52, 
+0xB4, 0x72, 0x6E, 0x76, 0x44, 0x82, 0x35, 0x5C, 
+0x13, 0xA9, 0xA5, 0xFC, 0x69, 0xEB, 0xC0, 0x1B, 
+0xC0, 0xEB, 0xD0, 0x9D, 0x13, 0x4D, 0x0C, 0xF2, 
+0xDC, 0xCB, 0x5C, 0x9D, 0x98, 0xAF, 0x56, 0xEC, 
+0x52, 0xB1, 0xBE, 0x95, 0x7D, 0xC5, 0xDE, 0xBB, 
+0x96, 0x98, 0xED, 0xE5, 0x4A, 
+
+0xF2, 0x8D, 0x09, 0xBC, 0x7C, 0xBD, 0x55, 0x45, 
+0x45, 0xF3, 0x63, 0x9B, 0x20, 0x16, 0x61, 0x4C, 
+0x75, 0x3C, 0xFA, 0xD6, 0x59, 0x3B, 0x10, 0xCA, 
+0x63, 0xE8, 0x8A, 0x73, 0x79, 0xB5, 0x4A, 0xEC, 
+0x47, 0x4E, 0x70, 0xDB, 0x2E, 0xD0, 0x01, 0x66, 
+0x4E, 0xE2, 0x20, 0xE6, 0x6A, 0x04, 0x18, 0xA1, 
+0xFF, 0x9B, 0x2D, 0x91, 0xD4, 0x38, 0xB3, 0x78, 
+0x4D, 0xFA, 0xE4, 0x44, 0x67, 0xC5, 0x80, 0x71, 
+0x00, 0x78, 0x67, 0x19, 0xD9, 0x55, 0x07, 0x2F, 
+0xAB, 0x97, 0xB1, 0xD6, 0xD9, 0x39, 0x08, 0xEF, 
+0x33, 0x55, 0xB0, 0x6C, 0x40, 0x2F, 
+
+0xB7, 0x50, 0x84, 0x45, 0xB5, 0x78, 0xF4, 0x40, 
+0x58, 0x20, 0x2B, 0x7E, 0x29, 0x5E, 0xCD, 0x11, 
+0x57, 0x14, 0xC5, 0x65, 0x6A, 0xA1, 0x0B, 0x45, 
+0xDB, 0x4D, 0x73, 0x20, 0x17, 0xB0, 0x38, 0x8A, 
+0x22, 0xCD, 0x6F, 0x66, 0x88, 0xC4, 0x02, 0xF3, 
+0x7E, 0xD2, 0x0A, 0xE8, 0x97, 0x19, 0xA7, 0x55, 
+0x84, 0xFD, 0xCB, 0xB0, 0xA0, 0x6F, 0x38, 0xD1, 
+0x34, 0x0F, 0x27, 0x00, 0xF5, 0xBA, 0x8E, 0x2C, 
+0x10, 0xBD, 0x45, 0x99, 0xD2, 0x4F, 0xDB, 0xD1, 
+0x94, 0x22, 0x49, 0xC5, 0xF4, 0x05, 0x57, 0x8A, 
+0x2E, 0xEB, 0xF2, 0x58, 0x36, 0xE5, 0x58, 
+
+0x33, 0xB1, 0x0C, 0xB5, 0x95, 0x21, 0xED, 0x5E, 
+0xF7, 0x4D, 0x76, 0x89, 0x2E, 0xD6, 0x75, 0xCD, 
+0x11, 0x12, 0x51, 0x8B, 0x9F, 0xDE, 0xB7, 0xFB, 
+0x04, 0x12, 0xFC, 0x0A, 0xC4, 0x3E, 0x75, 0x00, 
+0x9F, 0xF2, 0x25, 0xFE, 0x4A, 0xEC, 0x31, 0x0D, 
+0xC4, 0xA2, 0xA3, 0xC8, 0x0F, 0x03, 0x98, 0xCC, 
+0x35, 0xCA, 0xD4, 0x2E, 0x02, 0xD4, 0x44, 0x1E, 
+0x74, 0xEE, 0x5A, 0x04, 0x58, 0x4C, 0x2B, 0x33, 
+0xCF, 0x56, 0xE5, 0xDE, 0x0B, 0x4B, 0x75, 0x3F, 
+0xEC, 0x76, 0xA5, 0x2D, 0x83, 0xE9, 0xF6, 0xE0, 
+0xBC, 0x1B, 0xE5, 0x98, 0x2F, 0x84, 0xDB, 0x9B, 
+
+0x57, 0xA5, 0x1B, 0x01, 0x40, 0xD3, 0xA7, 0x64, 
+0x75, 0xE8, 0x74, 0xE5, 0x9C, 0xA6, 0x31, 0x77, 
+0xF8, 0x9D, 0x8E, 0x33, 0x57, 0x6B, 0x4C, 0x49, 
+0x34, 0x18, 0x79, 0x97, 0xBF, 0xB6, 0xE7, 0xFA, 
+0x12, 0x25, 0x37, 0xAB, 0x8A, 0xA0, 0x77, 0x41, 
+0x25, 0x73, 0x61, 0xE2, 0x1C, 0x00, 0x85, 0x90, 
+0xE0, 0x10, 0x52, 0xCD, 0x9A, 0xF0, 0x54, 0x8E, 
+0x45, 0x26, 0x95, 0xF3, 0x51, 0xFB, 0xDF, 0x37, 
+0xAB, 0xB8, 0xF9, 0xDB, 0x11, 0x75, 0x0D, 0xD5, 
+0x1C, 0xA3, 0xC0, 0x16, 0xD0, 0x29, 0xE2, 0xE8, 
+0x5B, 0x78, 0xAA, 0x98, 0x73, 0x52, 0xD1, 0xED, 
+0xC1, 
+
+0x3A, 0x8A, 0xA9, 0xEB, 0x96, 0xCB, 0xCF, 0x85, 
+0xEB, 0x7F, 0x3F, 0x8B, 0xDE, 0x28, 0x89, 0x7C, 
+0xEF, 0x88, 0xC5, 0x85, 0x1E, 0x8F, 0x39, 0x1F, 
+0x6E, 0x92, 0xA4, 0x36, 0xD8, 0x99, 0x22, 0xD9, 
+0xC6, 0xCD, 0x95, 0x50, 0x05, 0x5A, 0xC5, 0x66, 
+0xC1, 0x6F, 0x8E, 0x90, 0xD5, 0xD4, 0x8D, 0x09, 
+0x87, 0xEC, 0x5F, 0xB5, 0x8F, 0xB8, 0x3C, 0x16, 
+0x7B, 0x31, 0xB4, 0x5C, 0x2E, 0x66, 0xAC, 0xA3, 
+0x79, 0x3B, 0xF3, 0xDD, 0xD2, 0xD6, 0xA5, 0xE9, 
+0x63, 0x6B, 0xED, 0xC6, 0x64, 0x6F, 0x0F, 0xC9, 
+0x41, 0xF8, 0x89, 0x9F, 0xC9, 0xA1, 0xE9, 0xF0, 
+0xAD, 0x50, 
+
+0x31, 0xD0, 0x55, 0x23, 0x98, 0x00, 0x50, 0xB1, 
+0x2E, 0x05, 0x01, 0x27, 0x29, 0x33, 0xBA, 0x12, 
+0xC3, 0x76, 0xF7, 0xB7, 0xDE, 0xCD, 0x2E, 0x56, 
+0x6C, 0xD0, 0xC6, 0x24, 0x8D, 0x80, 0x18, 0x39, 
+0x21, 0x45, 0x5D, 0x9C, 0xC0, 0xC0, 0x77, 0xBC, 
+0x7D, 0xF2, 0xF1, 0x43, 0xBF, 0x3C, 0x77, 0x6C, 
+0x5D, 0xB1, 0x2E, 0x61, 0x39, 0x2B, 0x74, 0xCB, 
+0xBB, 0xFB, 0x47, 0x78, 0xCE, 0xE9, 0x78, 0x1C, 
+0xD8, 0xA5, 0x58, 0xB9, 0x40, 0x64, 0x2E, 0x45, 
+0x2E, 0xFE, 0xD8, 0xE2, 0x34, 0x33, 0x93, 0x48, 
+0x03, 0xD6, 0x62, 0x30, 0x30, 0xAA, 0x06, 0xFE, 
+0xC5, 0xAE, 0xD6, 
+
+0xDC, 0xC7, 0x96, 0xAC, 0x78, 0x2F, 0x54, 0x45, 
+0xD3, 0x0D, 0xA8, 0x52, 0x30, 0xAB, 0x0E, 0xF7, 
+0xE0, 0x0D, 0x70, 0x4F, 0x9A, 0xBF, 0x38, 0xAE, 
+0x17, 0xBB, 0x9F, 0x0A, 0x48, 0x42, 0x7E, 0x80, 
+0x93, 0x88, 0x0A, 0x54, 0x5C, 0xA2, 0x39, 0xBD, 
+0x26, 0x4D, 0xD4, 0x8B, 0x07, 0x6F, 0xD4, 0xA0, 
+0xF4, 0x91, 0x33, 0xB7, 0x35, 0xCE, 0x51, 0xAB, 
+0x06, 0x1A, 0xF3, 0x1D, 0xF5, 0xBD, 0x53, 0xC2, 
+0xCE, 0xEC, 0x47, 0x4C, 0x49, 0x33, 0x64, 0xD3, 
+0x2F, 0xE4, 0x55, 0x1A, 0x5E, 0x8F, 0xE3, 0xAF, 
+0xFA, 0x27, 0x4B, 0xC3, 0xFB, 0x7F, 0x1C, 0x49, 
+0x0F, 0xBF, 0x0F, 0x2C, 
+
+0x49, 0x3C, 0xD4, 0x80, 0x10, 0xB3, 0x17, 0xD1, 
+0x16, 0xA7, 0xF4, 0x47, 0x17, 0x4F, 0x9C, 0xDD, 
+0x7A, 0xE3, 0x77, 0xE4, 0xCE, 0x93, 0x2D, 0x38, 
+0x0A, 0x32, 0xC9, 0xE6, 0xCF, 0x0D, 0x4E, 0xD3, 
+0x34, 0x53, 0x33, 0xF7, 0xB5, 0x18, 0xA2, 0x17, 
+0x81, 0x12, 0x99, 0xE8, 0xDC, 0x3E, 0x72, 0x22, 
+0x8A, 0xB9, 0x16, 0x86, 0x7D, 0x69, 0x6D, 0x3B, 
+0xE8, 0xBF, 0x8B, 0x78, 0x06, 0xFE, 0xA4, 0x4A, 
+0x62, 0x35, 0x10, 0xCF, 0x0A, 0x06, 0x62, 0x6E, 
+0x73, 0xB4, 0x87, 0x82, 0xFF, 0xB7, 0x4C, 0x6D, 
+0x48, 0xF7, 0xA3, 0x8A, 0x7E, 0xA0, 0x10, 0x8A, 
+0x02, 0x9F, 0x5F, 0x9E, 0xB9, 
+
+0x88, 0x02, 0xE4, 0x46, 0x8A, 0xCC, 0x7A, 0x78, 
+0x15, 0xBC, 0xEC, 0x9D, 0x7B, 0xDD, 0xC3, 0x0F, 
+0x0B, 0xF0, 0xC3, 0x78, 0x40, 0x5A, 0x46, 0x4D, 
+0xDE, 0x0B, 0xCB, 0xB9, 0xDC, 0x46, 0xF4, 0xC8, 
+0x52, 0xB2, 0x61, 0x0B, 0x3F, 0x2B, 0x3E, 0xF9, 
+0x72, 0x48, 0xB2, 0xBA, 0x55, 0x39, 0x2C, 0xB7, 
+0x31, 0xF6, 0x27, 0xF5, 0xBE, 0x83, 0x83, 0xF1, 
+0x35, 0x13, 0x22, 0x3A, 0x80, 0xE1, 0xC6, 0xC0, 
+0xA9, 0x66, 0x40, 0x1D, 0x1D, 0x42, 0xE3, 0xE2, 
+0xB0, 0x26, 0x23, 0x0C, 0xF0, 0xAE, 0x77, 0x00, 
+0x2C, 0x1B, 0xF6, 0xB8, 0x9B, 0x65, 0xC7, 0xB4, 
+0xEE, 0x14, 0x86, 0x35, 0xAB, 0xF0, 
+
+0xB4, 0x84, 0x17, 0xDD, 0xCC, 0x00, 0x58, 0x61, 
+0x55, 0x80, 0x76, 0xF7, 0x71, 0x67, 0xBF, 0x6E, 
+0x46, 0x6F, 0x3F, 0xAC, 0x82, 0xA4, 0x45, 0xD5, 
+0xB0, 0xAF, 0x84, 0x81, 0xC4, 0x32, 0x48, 0xFE, 
+0xC9, 0x17, 0x76, 0xA4, 0x76, 0xEB, 0xCC, 0x3F, 
+0x95, 0xDF, 0x3A, 0x11, 0x6B, 0xEF, 0x5C, 0x39, 
+0x0F, 0xA6, 0x7D, 0xC0, 0x4D, 0x1E, 0x0A, 0xDA, 
+0x73, 0x55, 0xDF, 0x4A, 0xC5, 0xAA, 0x23, 0x25, 
+0xD3, 0x8C, 0xCB, 0x73, 0xD8, 0xAB, 0x54, 0xEA, 
+0x6A, 0xC5, 0x0A, 0x90, 0xC9, 0xB9, 0xA0, 0x28, 
+0x33, 0xA9, 0x52, 0x0F, 0x8C, 0x15, 0x98, 0xE6, 
+0x90, 0x1C, 0xEA, 0xB4, 0xDC, 0x75, 0xFA, 
+
+0xB2, 0xB0, 0x53, 0x72, 0xC2, 0x3F, 0xA2, 0xB7, 
+0x0F, 0x1C, 0x0D, 0xFF, 0x96, 0x02, 0x8A, 0x0A, 
+0x8B, 0x43, 0x44, 0x8A, 0x5C, 0x91, 0x89, 0xB9, 
+0xC9, 0x66, 0xD1, 0xE6, 0x76, 0xD9, 0xEE, 0x5F, 
+0x5A, 0xA3, 0xE4, 0x98, 0xFD, 0x1F, 0x73, 0xC9, 
+0xF3, 0xF3, 0xAD, 0x11, 0x62, 0x91, 0x56, 0xC2, 
+0xAA, 0xC6, 0x9D, 0xC9, 0x54, 0x4B, 0x94, 0x9F, 
+0xAF, 0xA4, 0xF1, 0x43, 0xAC, 0x05, 0x54, 0x88, 
+0x91, 0x29, 0x78, 0x06, 0xCE, 0x46, 0x3B, 0xDD, 
+0x8C, 0x9C, 0x16, 0xB7, 0x14, 0x79, 0x59, 0x7C, 
+0x23, 0xB3, 0x62, 0x8B, 0xCF, 0xEA, 0xBD, 0x9C, 
+0x31, 0xD3, 0xFC, 0x25, 0xAE, 0x08, 0xD9, 0xD6, 
+
+0xA8, 0x1A, 0x4F, 0x9B, 0x9D, 0x38, 0x3F, 0xB1, 
+0x0E, 0x9E, 0x12, 0xA9, 0x29, 0xE7, 0xB3, 0xD9, 
+0x55, 0x4E, 0x42, 0x39, 0x66, 0x3D, 0x82, 0x9C, 
+0xB2, 0x2A, 0x78, 0xE8, 0xC2, 0x61, 0xFD, 0x03, 
+0xA1, 0xEC, 0x5C, 0xC6, 0xA5, 0x00, 0x32, 0x4B, 
+0x27, 0xBD, 0x2C, 0xD4, 0x2F, 0xE5, 0x3A, 0x9C, 
+0xBA, 0xDF, 0xE3, 0x35, 0x86, 0xC7, 0x11, 0xEB, 
+0xCC, 0x28, 0xB2, 0x88, 0xD5, 0x4B, 0x3B, 0xE1, 
+0x6A, 0xCA, 0x8F, 0xE4, 0x53, 0xE0, 0x5E, 0x6B, 
+0x0A, 0x2C, 0x3D, 0x01, 0xC9, 0xB3, 0x3E, 0xF0, 
+0x43, 0x7D, 0x88, 0xD5, 0xB8, 0x84, 0x35, 0x84, 
+0xE9, 0xD7, 0xC0, 0x46, 0x73, 0x98, 0x1E, 0x8B, 
+0x58, 
+
+0x62, 0x6E, 0x54, 0x86, 0x3A, 0x02, 0xE2, 0xA5, 
+0x0E, 0xA5, 0xE3, 0xD7, 0xBB, 0xBB, 0x30, 0xE6, 
+0x37, 0xCF, 0xDA, 0x65, 0x32, 0xC6, 0x91, 0x9B, 
+0x8B, 0x66, 0x21, 0xA8, 0xA5, 0x3B, 0x65, 0x43, 
+0x54, 0x1E, 0xA4, 0xC6, 0xF8, 0x1C, 0xD5, 0x0B, 
+0xA5, 0xB1, 0x38, 0xB4, 0xBD, 0x5A, 0x4C, 0x4C, 
+0xCD, 0x9A, 0xB2, 0xFF, 0xDE, 0xD9, 0xE9, 0xC0, 
+0x9A, 0x48, 0xBD, 0x54, 0xA9, 0xB0, 0xCA, 0x26, 
+0x67, 0x57, 0xDC, 0x5E, 0xC0, 0xC7, 0x63, 0xF9, 
+0x42, 0x58, 0x9A, 0xF4, 0x0D, 0x4E, 0x49, 0x99, 
+0x1C, 0x51, 0xDB, 0xB9, 0xA8, 0x73, 0xDD, 0x03, 
+0x46, 0x07, 0xAB, 0x51, 0xB3, 0xE4, 0xF6, 0xDC, 
+0xEB, 0x7D, 
+
+0xAA, 0x9E, 0x7B, 0xA1, 0x8C, 0xF6, 0xEA, 0x2A, 
+0x15, 0xE3, 0x5A, 0x27, 0xAE, 0x80, 0x5D, 0x2C, 
+0xDE, 0x66, 0x12, 0xB6, 0x70, 0x73, 0xA1, 0xD6, 
+0x2A, 0x35, 0xC1, 0x96, 0xA2, 0xB1, 0x3D, 0x7C, 
+0xAB, 0x7C, 0xCB, 0x9C, 0x56, 0x33, 0x69, 0x2C, 
+0xA7, 0x0F, 0x9F, 0xE7, 0x04, 0xB3, 0xC7, 0x35, 
+0xB7, 0xC9, 0xB9, 0x3C, 0xE6, 0x95, 0xF4, 0x63, 
+0x80, 0x51, 0x2B, 0xEA, 0x5C, 0xC8, 0x80, 0x33, 
+0x52, 0xFD, 0xBC, 0xD7, 0xED, 0x2F, 0xE8, 0xFF, 
+0x60, 0xCC, 0x04, 0xFA, 0xE1, 0x9F, 0x21, 0xFE, 
+0xBF, 0xD4, 0x43, 0x48, 0xB2, 0xF7, 0xF5, 0x43, 
+0x8B, 0xC3, 0x39, 0xD2, 0x5E, 0xB7, 0x88, 0x45, 
+0x4E, 0x96, 0xA2, 
+
+0x46, 0xC4, 0xBE, 0x0D, 0x30, 0x9B, 0x9B, 0xB1, 
+0xE2, 0xC8, 0xA2, 0x80, 0xD0, 0xFF, 0x04, 0x4C, 
+0x4D, 0x67, 0x91, 0xC7, 0x83, 0xF1, 0x70, 0x94, 
+0xB6, 0x34, 0x25, 0x91, 0x8A, 0x01, 0x89, 0x56, 
+0x92, 0x32, 0x27, 0x08, 0xE6, 0x1F, 0x65, 0x1B, 
+0x1F, 0x6D, 0xD8, 0xA3, 0x25, 0x02, 0xA0, 0x3F, 
+0xA8, 0xBC, 0xD0, 0xFB, 0xCF, 0x5C, 0xE9, 0x1F, 
+0x30, 0x77, 0x69, 0x66, 0x1D, 0x8B, 0xE1, 0xB9, 
+0x65, 0x90, 0xAC, 0xA9, 0x86, 0xD1, 0x17, 0xC9, 
+0x84, 0x63, 0xE7, 0x5F, 0x78, 0x9C, 0xD9, 0x2A, 
+0x5D, 0xEF, 0x15, 0x3A, 0xA8, 0xA3, 0x4D, 0xA1, 
+0x76, 0xB4, 0x9D, 0xFA, 0x1B, 0xF8, 0xB6, 0x9D, 
+0x3C, 0x15, 0xF8, 0x76, 
+
+0x47, 0x7E, 0xCC, 0x3A, 0x84, 0xB9, 0x68, 0xBA, 
+0x03, 0x45, 0xCD, 0xDE, 0xF2, 0xD8, 0x56, 0x3C, 
+0x74, 0xBC, 0x0E, 0xD3, 0x09, 0x03, 0x6D, 0x5F, 
+0x70, 0x57, 0x07, 0x82, 0x7E, 0x8F, 0x4F, 0x40, 
+0x04, 0xFB, 0xF5, 0xEA, 0x90, 0xF4, 0xF6, 0x4C, 
+0x17, 0xD6, 0xF4, 0xF5, 0x55, 0x70, 0xF5, 0x9F, 
+0x70, 0x6C, 0x6D, 0x8D, 0xCA, 0x29, 0x67, 0x93, 
+0xFF, 0x8E, 0x9B, 0xBD, 0x93, 0xD9, 0x73, 0x32, 
+0x6B, 0x1F, 0x3E, 0xFA, 0x66, 0x9F, 0x03, 0x09, 
+0xC4, 0xF2, 0xDE, 0x6E, 0x51, 0xA1, 0x84, 0xDD, 
+0x10, 0x52, 0xF6, 0xF7, 0x9B, 0xC5, 0x11, 0xD9, 
+0xB5, 0x99, 0x38, 0xDE, 0x55, 0xEE, 0x40, 0xD9, 
+0x85, 0x26, 0x2E, 0x08, 0x2B, 
+
+0x6A, 0xAB, 0x4F, 0xF4, 0x0E, 0x8A, 0x2F, 0x74, 
+0xE1, 0xB3, 0xB5, 0x1C, 0x47, 0xAC, 0x9B, 0xF4, 
+0xD7, 0xC0, 0x0F, 0x7A, 0xCF, 0x0F, 0x98, 0x25, 
+0x1B, 0x6B, 0x00, 0x1E, 0xBE, 0x1D, 0xE9, 0x6B, 
+0x96, 0x2B, 0x78, 0x36, 0x36, 0x25, 0xB6, 0xCA, 
+0xEA, 0x44, 0x45, 0x3F, 0xE2, 0x52, 0x1E, 0x1E, 
+0x16, 0xCE, 0x6B, 0xEF, 0x2E, 0x67, 0xF6, 0xE5, 
+0x17, 0xB8, 0x5C, 0x1E, 0xAE, 0x8A, 0xE6, 0xB2, 
+0xA4, 0xA9, 0x56, 0x9D, 0xA5, 0xA4, 0xBF, 0x64, 
+0xB7, 0x21, 0x4C, 0xEB, 0xFA, 0x67, 0xB8, 0x9F, 
+0xF2, 0xA0, 0xEB, 0xCF, 0x10, 0x45, 0xB4, 0x94, 
+0xDE, 0xF4, 0xD7, 0xE9, 0xBB, 0x59, 0x0A, 0xD9, 
+0x96, 0xAD, 0x84, 0x94, 0x2E, 0x55, 
+
+0x7C, 0x12, 0x7D, 0x58, 0xB6, 0xD5, 0x9C, 0xAF, 
+0x2A, 0xB0, 0xE4, 0x7E, 0x87, 0xB5, 0x7C, 0x41, 
+0xFD, 0x3D, 0x3B, 0xC9, 0xBE, 0x79, 0xFD, 0x90, 
+0x0A, 0xF6, 0x8C, 0x1A, 0xB3, 0x22, 0x40, 0xE9, 
+0x55, 0x0C, 0x27, 0xF2, 0x4F, 0x6D, 0x6C, 0x17, 
+0x22, 0x11, 0x8F, 0x6E, 0xF1, 0x7F, 0x87, 0xF4, 
+0x31, 0x44, 0x1A, 0x88, 0x16, 0x11, 0x81, 0xB8, 
+0x15, 0xE0, 0x70, 0xC9, 0x04, 0x0B, 0xF5, 0xD1, 
+0x11, 0x5D, 0x16, 0x86, 0x34, 0x58, 0x81, 0x58, 
+0x4D, 0x32, 0x89, 0x90, 0xD9, 0xEA, 0x50, 0xE2, 
+0x3D, 0xE1, 0x5A, 0x45, 0xF4, 0xD0, 0x89, 0x10, 
+0xA2, 0xCF, 0x6F, 0x58, 0x03, 0xB4, 0xBB, 0x2F, 
+0x39, 0x1E, 0x9B, 0x12, 0xB3, 0xA8, 0x23, 
+
+0x02, 0x70, 0x07, 0xC4, 0xA4, 0xAB, 0x10, 0xFE, 
+0x3A, 0xBD, 0x16, 0xF0, 0xFC, 0xC8, 0x67, 0x28, 
+0xD5, 0x75, 0xF3, 0x7F, 0x90, 0xE7, 0xD5, 0xE5, 
+0x2A, 0xF5, 0xC8, 0x62, 0x2C, 0x5A, 0xBE, 0xB7, 
+0xE6, 0x42, 0x64, 0xC0, 0x10, 0xAC, 0x4A, 0x88, 
+0x3C, 0xD6, 0x81, 0x53, 0x76, 0xED, 0x19, 0xD9, 
+0xEB, 0xBD, 0x0D, 0xA8, 0x43, 0xC6, 0xB6, 0xEA, 
+0x25, 0x81, 0xF6, 0x25, 0x17, 0x1A, 0x5F, 0x38, 
+0xB0, 0x41, 0xD9, 0xD2, 0x16, 0x31, 0x6B, 0x97, 
+0xB9, 0x6B, 0xC3, 0x42, 0x9F, 0x37, 0x6A, 0xF3, 
+0x6A, 0x5E, 0xDA, 0xFE, 0x11, 0x34, 0x13, 0x2C, 
+0x47, 0x0B, 0xEE, 0x72, 0xF8, 0x93, 0x7A, 0x24, 
+0x24, 0xAD, 0x36, 0x1D, 0xF9, 0xE7, 0x4A, 0xF0, 
+
+0xC8, 0x02, 0x91, 0x49, 0x6F, 0x43, 0x20, 0x02, 
+0x8A, 0xDB, 0x1F, 0xAB, 0x24, 0x3E, 0xF7, 0x72, 
+0xD4, 0x30, 0x58, 0xF1, 0x4A, 0x88, 0x73, 0x1C, 
+0x8E, 0x34, 0x0C, 0x58, 0x00, 0xA0, 0x15, 0xFF, 
+0x47, 0x42, 0xDC, 0xD2, 0xA3, 0xC6, 0x42, 0xAF, 
+0x94, 0xA2, 0x22, 0x15, 0x51, 0x37, 0xA5, 0x3D, 
+0x56, 0xB5, 0x0D, 0x0F, 0xBE, 0xAA, 0x7E, 0xAD, 
+0x67, 0xA3, 0x61, 0x87, 0xB8, 0xD8, 0x76, 0x02, 
+0x23, 0xE5, 0xBD, 0x2E, 0xEE, 0xC8, 0x05, 0x6E, 
+0xA9, 0xFC, 0x9A, 0x40, 0xD5, 0x61, 0xF7, 0x3F, 
+0x6C, 0x17, 0x1A, 0x24, 0xFF, 0x90, 0xB8, 0x23, 
+0x56, 0x16, 0x55, 0x86, 0x4B, 0x60, 0x66, 0xDD, 
+0x01, 0x5F, 0x63, 0xF2, 0xEB, 0x04, 0x28, 0x71, 
+0x02, 
+
+0x42, 0x5D, 0xE4, 0x97, 0x6F, 0x25, 0x1F, 0x4A, 
+0x2D, 0x03, 0xB4, 0x3D, 0xB6, 0xF4, 0x9E, 0xEE, 
+0x01, 0x50, 0x4A, 0x61, 0xCC, 0x98, 0x7A, 0x6D, 
+0xCE, 0x29, 0x77, 0x5A, 0x05, 0xF7, 0xA0, 0x68, 
+0xF2, 0x93, 0x50, 0xFC, 0xB2, 0xEC, 0x81, 0x23, 
+0xD7, 0x44, 0x43, 0x5D, 0xFC, 0xA5, 0xF0, 0x7F, 
+0xAF, 0x00, 0xBF, 0x7D, 0x97, 0xD8, 0xC2, 0x15, 
+0x94, 0x03, 0x28, 0x98, 0x69, 0x3C, 0x52, 0x8F, 
+0xDD, 0x0C, 0x32, 0xCA, 0xC4, 0x37, 0xC4, 0x8B, 
+0x48, 0xBA, 0xD4, 0x81, 0x7D, 0xA9, 0x5C, 0x4C, 
+0x78, 0xB8, 0x67, 0x53, 0xD3, 0x4F, 0xBE, 0xB5, 
+0x27, 0x54, 0xB6, 0x69, 0xC2, 0x6B, 0x36, 0x65, 
+0x53, 0xEC, 0xE6, 0x51, 0xBA, 0x9B, 0x02, 0xED, 
+0x24, 0x99, 
+
+0xA9, 0xD2, 0x7A, 0x99, 0x44, 0x3E, 0x69, 0x2D, 
+0xF7, 0x3E, 0xE5, 0xE1, 0x78, 0xBF, 0xBA, 0x92, 
+0xE1, 0xA8, 0x8C, 0xB2, 0x2D, 0xC1, 0x79, 0x60, 
+0x2B, 0x10, 0xE7, 0x04, 0xEE, 0x24, 0x7E, 0x28, 
+0x9E, 0x75, 0xFE, 0x65, 0x41, 0xE3, 0x76, 0xE5, 
+0x0C, 0x4D, 0x00, 0x08, 0x24, 0xCC, 0x54, 0x55, 
+0xCA, 0x74, 0xF2, 0xDD, 0x8C, 0x3D, 0x75, 0x73, 
+0x23, 0xDA, 0xAF, 0xE9, 0xC2, 0x18, 0xDF, 0x10, 
+0x22, 0xF7, 0xC9, 0x9C, 0xFB, 0x39, 0x82, 0x85, 
+0xDA, 0xBF, 0x7B, 0xE4, 0x4B, 0x8C, 0xD7, 0x20, 
+0x5E, 0x3A, 0x99, 0x61, 0x13, 0x4A, 0xF5, 0xBC, 
+0xD0, 0x12, 0x38, 0x55, 0xD3, 0x4F, 0xA7, 0xFD, 
+0xE2, 0x07, 0xF2, 0x8B, 0x65, 0xAC, 0x36, 0xEE, 
+0x04, 0xB4, 0x82, 
+
+0x3C, 0xE4, 0xF1, 0xE7, 0xAD, 0x47, 0x3A, 0x01, 
+0x69, 0xDD, 0xED, 0xBD, 0xA4, 0x09, 0xCE, 0x43, 
+0x98, 0x79, 0x42, 0xF3, 0x59, 0x50, 0xAE, 0x8A, 
+0x3F, 0x53, 0x82, 0x7B, 0x1C, 0x8A, 0xFA, 0x5F, 
+0x51, 0x32, 0xF8, 0x57, 0xAB, 0xA3, 0x23, 0xE9, 
+0x88, 0xF5, 0x8C, 0x23, 0x5D, 0x66, 0x2F, 0x64, 
+0x3F, 0xDD, 0xBE, 0xD3, 0xEE, 0x64, 0x63, 0xC3, 
+0x68, 0xBD, 0x3D, 0xDB, 0x6D, 0x4B, 0xF4, 0x11, 
+0x6D, 0xF8, 0xAF, 0x35, 0x34, 0xAE, 0x77, 0xA6, 
+0x82, 0xE4, 0x40, 0x32, 0x0D, 0x81, 0xF9, 0x73, 
+0x86, 0xFC, 0xD5, 0x91, 0xDC, 0xDD, 0xD5, 0x86, 
+0x43, 0x0F, 0xE6, 0x86, 0xA9, 0x37, 0x99, 0x72, 
+0x88, 0xA2, 0xDA, 0x01, 0xB7, 0x6B, 0x38, 0xD2, 
+0xB7, 0x31, 0x1E, 0xFE, 
+
+0xDE, 0xEF, 0x3D, 0x82, 0xD2, 0x3E, 0x89, 0x4A, 
+0xCC, 0xCC, 0x6C, 0x30, 0x2B, 0xC0, 0xB9, 0x51, 
+0xFA, 0x54, 0xC6, 0xB1, 0x1F, 0x13, 0xF2, 0x98, 
+0x07, 0x7C, 0x3B, 0x48, 0x4F, 0xE8, 0x4B, 0x58, 
+0xF8, 0x81, 0x0B, 0x7C, 0x67, 0x1F, 0x57, 0x03, 
+0xA5, 0xF3, 0xE3, 0xA8, 0xC8, 0xD8, 0xE6, 0x33, 
+0x0A, 0x11, 0x1A, 0x75, 0xD9, 0x5E, 0x81, 0xB5, 
+0xB8, 0x6A, 0x90, 0x37, 0x40, 0x10, 0x37, 0xB5, 
+0xAB, 0x99, 0x88, 0x35, 0xF4, 0x8D, 0x48, 0x50, 
+0xD5, 0xCB, 0x47, 0x62, 0x18, 0x31, 0xBA, 0x8D, 
+0x15, 0x25, 0xD5, 0xD5, 0x60, 0x7E, 0x54, 0x2B, 
+0x81, 0xEE, 0x73, 0x03, 0xF0, 0x7D, 0x3A, 0x01, 
+0x2A, 0x2A, 0xD8, 0xD5, 0xA1, 0x52, 0x8A, 0x7F, 
+0x3D, 0x70, 0x83, 0x03, 0xFD, 
+
+0x70, 0x39, 0xC0, 0xA5, 0x08, 0x66, 0xA2, 0x86, 
+0x96, 0x27, 0x2C, 0x35, 0x9D, 0x2D, 0xCE, 0xD9, 
+0x49, 0xFB, 0x0C, 0x64, 0x29, 0xD7, 0xBD, 0x28, 
+0xA7, 0x78, 0xCD, 0xDC, 0x32, 0x2B, 0x8D, 0xF8, 
+0x32, 0xE5, 0xC7, 0xCF, 0x39, 0x4D, 0x18, 0xA1, 
+0x1E, 0x2F, 0x0B, 0xAE, 0xC4, 0x91, 0x61, 0x37, 
+0xE8, 0x7F, 0xA5, 0x29, 0x28, 0xFD, 0xF2, 0x54, 
+0x17, 0xF0, 0x3A, 0x24, 0xF4, 0x9D, 0x40, 0xCE, 
+0xC9, 0x16, 0x15, 0xEE, 0x1F, 0x4D, 0xDB, 0xCC, 
+0x05, 0xB6, 0xDD, 0x16, 0xB2, 0xED, 0x09, 0x0F, 
+0x82, 0x24, 0xF7, 0xDC, 0xA3, 0xAE, 0x60, 0x52, 
+0x8D, 0x83, 0x50, 0x07, 0xFC, 0x26, 0x23, 0xDE, 
+0x32, 0x25, 0xB6, 0x58, 0x42, 0x1F, 0x45, 0x53, 
+0x4F, 0x86, 0x53, 0xA5, 0x72, 0xFC, 
+
+0x59, 0x60, 0x6A, 0xBC, 0xE2, 0x94, 0xFF, 0x01, 
+0x85, 0xF1, 0xC9, 0x7A, 0x2D, 0xA7, 0x46, 0x2D, 
+0xDB, 0xB5, 0xDF, 0x60, 0x0C, 0xD7, 0x0B, 0xEF, 
+0x7C, 0x92, 0x21, 0x90, 0x11, 0xE4, 0x8A, 0xB9, 
+0xA0, 0x0F, 0x15, 0xFB, 0x97, 0x53, 0x20, 0x47, 
+0x89, 0x28, 0xFD, 0x2A, 0x77, 0x1B, 0x21, 0xAC, 
+0x2D, 0xD5, 0x38, 0xC2, 0xBF, 0x9F, 0xDC, 0x72, 
+0x76, 0x43, 0xF3, 0x14, 0x45, 0xE2, 0x50, 0xF1, 
+0xB3, 0xC7, 0x47, 0x8E, 0xC7, 0x40, 0x72, 0x45, 
+0x32, 0x2B, 0xBB, 0xF5, 0x58, 0xC3, 0x67, 0x8B, 
+0xBA, 0xC3, 0xD0, 0x3C, 0xC2, 0xF0, 0x41, 0x48, 
+0xAE, 0x8A, 0x5C, 0xD5, 0x66, 0x37, 0x9D, 0xF2, 
+0x7F, 0x9E, 0xB8, 0xFC, 0x0A, 0xB4, 0x47, 0x12, 
+0x6E, 0xFF, 0x01, 0x6C, 0x62, 0x3E, 0x36, 
+
+0x43, 0x40, 0xC8, 0x24, 0x7E, 0x5C, 0x56, 0xEE, 
+0x1A, 0xC2, 0x78, 0x7B, 0x8A, 0xE1, 0xE4, 0x44, 
+0x2F, 0x3D, 0xF7, 0x69, 0x23, 0x66, 0x58, 0x24, 
+0x17, 0xE9, 0x6A, 0x8F, 0x7E, 0xA3, 0x4E, 0x06, 
+0x7A, 0xA9, 0x5D, 0xBA, 0x11, 0x3F, 0x17, 0x33, 
+0x5C, 0x5C, 0x78, 0x53, 0x84, 0x5A, 0xEB, 0xAC, 
+0xA1, 0xDB, 0x57, 0x10, 0x56, 0x62, 0xDD, 0x9D, 
+0xB6, 0x7E, 0xF9, 0xE6, 0xB3, 0x6F, 0x67, 0xEF, 
+0xC7, 0x66, 0xF7, 0x96, 0x37, 0x79, 0x8C, 0x5F, 
+0x90, 0x6A, 0xBF, 0x04, 0x4A, 0x27, 0xA8, 0x7E, 
+0x77, 0x5B, 0xD7, 0x50, 0xE6, 0xC0, 0x03, 0x5C, 
+0x3D, 0x34, 0x48, 0x04, 0xBB, 0x34, 0x89, 0xC8, 
+0x4D, 0x37, 0x36, 0x84, 0x08, 0x1A, 0xE6, 0xDC, 
+0xBB, 0x46, 0xAD, 0x71, 0x4E, 0x39, 0x31, 0x12, 
+
+0x6F, 0x9D, 0x4F, 0x3F, 0x5C, 0xD8, 0x7F, 0xC5, 
+0x29, 0x2F, 0x0A, 0x4A, 0x72, 0x66, 0xDD, 0x81, 
+0xB2, 0xD2, 0x79, 0xB2, 0xF6, 0x65, 0x81, 0xB6, 
+0x6B, 0xD5, 0x44, 0xE8, 0xBD, 0x53, 0xCE, 0xB0, 
+0x7F, 0x3D, 0x16, 0xBE, 0xC1, 0xF1, 0x41, 0x08, 
+0x22, 0x64, 0xC1, 0xAB, 0xDA, 0xE6, 0xB6, 0x69, 
+0x9D, 0x2B, 0xB2, 0x4D, 0x0B, 0x56, 0x72, 0x68, 
+0x4A, 0xBE, 0x71, 0xDC, 0x31, 0xF2, 0xCE, 0x09, 
+0x7F, 0x04, 0x2A, 0x13, 0xBF, 0x01, 0x9A, 0xD1, 
+0xFD, 0x3D, 0xB4, 0x22, 0xD7, 0xF7, 0xFC, 0xA4, 
+0xE1, 0x68, 0x1B, 0xA6, 0xC1, 0x18, 0xAA, 0xBE, 
+0x0B, 0x7B, 0xBA, 0xDA, 0x30, 0x0E, 0xC3, 0x1D, 
+0xCD, 0x99, 0xCA, 0x2D, 0x82, 0x1E, 0xB7, 0xA2, 
+0x07, 0xA0, 0x9D, 0xAA, 0xE1, 0xA8, 0x83, 0xDC, 
+0x11, 
+
+0x72, 0x04, 0x85, 0xA4, 0xD2, 0xFB, 0x10, 0xD1, 
+0xC4, 0x57, 0x62, 0xEF, 0xA0, 0x78, 0xE6, 0xBC, 
+0x76, 0x66, 0x9B, 0xC2, 0xCD, 0x6F, 0xF3, 0x5F, 
+0x63, 0xF8, 0xE1, 0x26, 0x88, 0x23, 0xB2, 0x3B, 
+0xFB, 0xF5, 0xFC, 0x7E, 0x88, 0xF6, 0xDC, 0xDD, 
+0x1F, 0x52, 0xDF, 0x2F, 0xA5, 0x0F, 0x26, 0xD8, 
+0xF8, 0x20, 0xCC, 0x6C, 0xF3, 0xFF, 0x2B, 0x36, 
+0x89, 0xC7, 0xA6, 0xB4, 0xC0, 0x78, 0x36, 0xF6, 
+0x75, 0x88, 0xB9, 0x22, 0x11, 0x0C, 0x62, 0x5E, 
+0x8A, 0xA9, 0xB6, 0x89, 0x51, 0xA1, 0xFF, 0x64, 
+0x9D, 0xAC, 0x7F, 0x32, 0x66, 0x7E, 0x14, 0x33, 
+0x98, 0xF0, 0xF3, 0x1B, 0x92, 0x20, 0xBC, 0x32, 
+0x1A, 0xDF, 0xCF, 0xB6, 0xFA, 0xC3, 0x22, 0xBD, 
+0x36, 0x6A, 0xB3, 0xDB, 0x7E, 0x6C, 0x55, 0x5E, 
+0x0B, 0x2F, 
+
+0x9F, 0xB4, 0x23, 0x0B, 0x8A, 0xA5, 0x86, 0xBA, 
+0xC6, 0x7D, 0xCF, 0x5E, 0x21, 0xB9, 0x7C, 0x29, 
+0xB8, 0x33, 0xEB, 0x5F, 0xC6, 0xC8, 0xD4, 0x5D, 
+0x96, 0x0C, 0x2A, 0xCD, 0xAD, 0x48, 0x3F, 0xBB, 
+0x9A, 0xF3, 0x80, 0x04, 0x4C, 0xC8, 0x24, 0xFC, 
+0x53, 0xE3, 0xC0, 0xC7, 0x35, 0x37, 0xE2, 0x4F, 
+0x63, 0xB1, 0xF1, 0x5A, 0x83, 0xDF, 0x8E, 0x6A, 
+0xCB, 0x92, 0x40, 0x7F, 0x05, 0x6B, 0x59, 0x66, 
+0x0E, 0x14, 0x08, 0x47, 0x8C, 0xF5, 0x97, 0x3A, 
+0x0D, 0xFB, 0x25, 0xE5, 0x4C, 0xD5, 0xDC, 0x4C, 
+0x56, 0x10, 0xC0, 0x41, 0xAD, 0xC8, 0x2F, 0x32, 
+0x9D, 0x58, 0x77, 0xCD, 0x92, 0x2D, 0xC7, 0x34, 
+0x4B, 0x90, 0x93, 0xF3, 0x5C, 0x09, 0xBB, 0x00, 
+0xC9, 0x38, 0x0A, 0x21, 0x28, 0x9E, 0x18, 0x33, 
+0xCA, 0xEE, 0x4A, 
+
+0xE6, 0x34, 0x00, 0xD5, 0x0D, 0x24, 0x23, 0xE5, 
+0xE5, 0x46, 0xC1, 0xD5, 0xD4, 0xEA, 0xB0, 0xEF, 
+0xC3, 0xF5, 0xAF, 0x68, 0xAE, 0x3F, 0x3C, 0x10, 
+0x38, 0x76, 0x84, 0x2D, 0x3E, 0x64, 0x74, 0xD5, 
+0xE7, 0xF8, 0x00, 0x26, 0xDC, 0x1B, 0x23, 0x75, 
+0xF4, 0x8B, 0xB1, 0x55, 0xA7, 0x21, 0xAE, 0x40, 
+0x9D, 0x76, 0x41, 0x79, 0xE8, 0xF8, 0x22, 0xF7, 
+0x84, 0x92, 0x54, 0xD4, 0x8A, 0xFD, 0x8F, 0x2C, 
+0xB8, 0xC7, 0x8B, 0x08, 0xFC, 0x66, 0x16, 0xFB, 
+0xEE, 0xA9, 0xC4, 0x9B, 0xC6, 0x93, 0xAF, 0x1C, 
+0x9D, 0x3B, 0x36, 0x86, 0x0E, 0x12, 0xDF, 0x97, 
+0x15, 0x07, 0x9A, 0xBE, 0x95, 0xA8, 0x0E, 0xE7, 
+0xF2, 0x66, 0xC4, 0xF3, 0xBF, 0x59, 0x91, 0x70, 
+0xB5, 0x8C, 0x52, 0x31, 0x22, 0xB9, 0x2C, 0x93, 
+0x38, 0x38, 0xE7, 0xD6, 
+
+0x06, 0xF5, 0x02, 0x07, 0x5F, 0x89, 0x66, 0xD4, 
+0x7F, 0x52, 0xFE, 0x97, 0xC0, 0xB6, 0x88, 0xB4, 
+0x89, 0xB7, 0xB4, 0x0D, 0x01, 0x00, 0x80, 0x78, 
+0x34, 0xE6, 0xA0, 0xE1, 0x32, 0xC9, 0x0B, 0xAC, 
+0x55, 0xB7, 0xD1, 0x99, 0x5B, 0xA3, 0x63, 0x80, 
+0x6A, 0x39, 0xC5, 0x84, 0x02, 0xCD, 0x85, 0x21, 
+0xAD, 0xA2, 0x95, 0xB8, 0x2C, 0x6D, 0x7F, 0x5F, 
+0x0C, 0x27, 0xD1, 0x2F, 0xCC, 0x5D, 0xA6, 0xA7, 
+0x10, 0x46, 0xA3, 0x9B, 0x74, 0x09, 0x56, 0x1A, 
+0x39, 0xB2, 0x0C, 0x89, 0x46, 0x52, 0x76, 0xA8, 
+0x5F, 0xBD, 0xD9, 0xE0, 0x96, 0x60, 0x24, 0x0D, 
+0x8A, 0xEC, 0x6A, 0xE8, 0x2E, 0x19, 0x0D, 0xE3, 
+0x44, 0xFF, 0x0C, 0x55, 0xCC, 0x93, 0x77, 0x47, 
+0x2E, 0x77, 0xE7, 0xC2, 0xE5, 0x04, 0x84, 0xA7, 
+0xBC, 0x62, 0x6B, 0xCE, 0x78, 
+
+0xD4, 0x10, 0xF0, 0x0E, 0x33, 0x02, 0x41, 0xA3, 
+0xD5, 0xD8, 0xEA, 0xDD, 0xDC, 0x97, 0x25, 0x6B, 
+0xBF, 0x83, 0x1D, 0x52, 0x05, 0x7C, 0xAE, 0x40, 
+0xE1, 0x71, 0x6F, 0x10, 0xDA, 0xA6, 0xB5, 0x57, 
+0x8E, 0x78, 0xEC, 0x73, 0xFB, 0xD6, 0x75, 0xE9, 
+0x26, 0x2A, 0x8F, 0xF2, 0x84, 0x71, 0x6F, 0xE2, 
+0x98, 0x45, 0xA3, 0x87, 0x60, 0xB4, 0xD2, 0x30, 
+0x57, 0xE2, 0xE8, 0x90, 0x3D, 0xD2, 0xE6, 0xE5, 
+0x31, 0x8A, 0x12, 0xD4, 0xBB, 0xE5, 0xC9, 0x56, 
+0x97, 0xEE, 0xCA, 0x2D, 0x19, 0x41, 0xA3, 0x5D, 
+0xF3, 0xBB, 0x44, 0xCB, 0x3E, 0x33, 0x98, 0x5A, 
+0x9E, 0x9F, 0xEE, 0xA9, 0x5E, 0xA0, 0x0E, 0x86, 
+0xC3, 0xA6, 0xE0, 0x7C, 0xFF, 0x81, 0xBE, 0x7F, 
+0x07, 0xE8, 0x00, 0x01, 0x1A, 0x03, 0x98, 0x3C, 
+0x1D, 0xC7, 0x10, 0x33, 0x5A, 0x14, 
+
+0x20, 0x12, 0xE8, 0x22, 0xB4, 0xCF, 0xC6, 0xF9, 
+0xCE, 0x6E, 0x0E, 0x7B, 0x20, 0x09, 0x1D, 0xDC, 
+0x75, 0x13, 0x78, 0x0F, 0x41, 0xC8, 0xDE, 0xFF, 
+0x0B, 0xB5, 0x6F, 0x26, 0xEB, 0xA3, 0xAE, 0xBA, 
+0xA1, 0x7C, 0x7A, 0x63, 0x09, 0x89, 0x22, 0xBA, 
+0x96, 0x35, 0x9B, 0x64, 0x3C, 0x00, 0xF8, 0x6D, 
+0x69, 0x4C, 0x47, 0x1B, 0xEF, 0xDB, 0x9E, 0x53, 
+0xE4, 0x9C, 0xCF, 0x5A, 0x9F, 0xEA, 0xF6, 0x44, 
+0x94, 0xDA, 0x70, 0x4B, 0x57, 0x3B, 0x0B, 0x9E, 
+0x19, 0x8A, 0x61, 0xC3, 0xD4, 0xF0, 0x58, 0x5D, 
+0x6C, 0xE2, 0x9C, 0x4A, 0x61, 0xA7, 0xF4, 0xB9, 
+0xF6, 0x81, 0x1B, 0x97, 0x19, 0x5C, 0xE2, 0x7D, 
+0xAC, 0x15, 0xE8, 0x10, 0xD1, 0x5B, 0xAA, 0x2F, 
+0x3F, 0xF0, 0xFE, 0x01, 0xA5, 0xFA, 0xA2, 0xB5, 
+0x56, 0xDC, 0x08, 0xA0, 0xEE, 0x66, 0x76, 
+
+0xC7, 0x62, 0xD7, 0xB5, 0xE7, 0xF7, 0xF8, 0x27, 
+0x74, 0x68, 0x63, 0x83, 0xC1, 0xB9, 0x29, 0xCF, 
+0x68, 0x2D, 0x8E, 0xC4, 0x2F, 0xC2, 0x98, 0xA3, 
+0xE6, 0xD2, 0xB3, 0xB9, 0x9F, 0x30, 0x88, 0xAF, 
+0x2C, 0x94, 0xDE, 0x86, 0x4F, 0xF4, 0xF1, 0x85, 
+0x05, 0x2B, 0x3E, 0x43, 0x33, 0xF3, 0x4E, 0xF1, 
+0x9A, 0xC0, 0xCA, 0xD8, 0x5D, 0x54, 0x7A, 0xF3, 
+0x30, 0x94, 0xF9, 0xBD, 0x67, 0x42, 0x6F, 0xA0, 
+0x46, 0x6E, 0x47, 0x8F, 0x0C, 0x08, 0x79, 0x51, 
+0x7D, 0x6E, 0x23, 0xD5, 0x0C, 0x78, 0x6D, 0x97, 
+0x7F, 0xBD, 0xC8, 0x96, 0x74, 0x98, 0xD3, 0x20, 
+0x8E, 0x09, 0x4B, 0x00, 0x69, 0x3E, 0x2E, 0xE6, 
+0x56, 0xD2, 0xE7, 0x60, 0x5B, 0x38, 0x22, 0x24, 
+0xDB, 0x7B, 0xB7, 0xFD, 0x4A, 0x84, 0xED, 0x56, 
+0xB4, 0x63, 0x64, 0xF5, 0xD5, 0x0D, 0x30, 0x52, 
+
+0xA1, 0x3F, 0x89, 0xA1, 0x1D, 0x1E, 0x4B, 0x55, 
+0x44, 0x67, 0x9D, 0xB1, 0x64, 0xF1, 0x86, 0x97, 
+0xE6, 0x47, 0x8A, 0x1B, 0x52, 0x26, 0x86, 0xA8, 
+0x70, 0xB0, 0xCF, 0x6F, 0xD2, 0x8B, 0x9E, 0x1D, 
+0x9D, 0x43, 0x8B, 0x95, 0x7B, 0x14, 0x86, 0x1E, 
+0xD5, 0x33, 0xF2, 0xB3, 0xDB, 0x90, 0x8B, 0x5E, 
+0x33, 0x32, 0xB6, 0x1A, 0x10, 0xE9, 0xC4, 0x58, 
+0xE6, 0xF3, 0xB0, 0x21, 0x75, 0xAC, 0x86, 0x45, 
+0x46, 0x2B, 0x93, 0xA8, 0xCA, 0xA0, 0x11, 0x40, 
+0x08, 0xD2, 0xBC, 0x8B, 0x40, 0xC4, 0x10, 0x2C, 
+0xCB, 0x3A, 0xC7, 0x3E, 0xBC, 0x4E, 0xA2, 0x0F, 
+0xD7, 0x85, 0xBD, 0x7B, 0x7A, 0xEA, 0x17, 0xAD, 
+0x5F, 0x5F, 0x6D, 0xC0, 0x09, 0x85, 0xF8, 0x40, 
+0x28, 0xD8, 0xCB, 0x3A, 0x6D, 0x17, 0x4B, 0x97, 
+0xD2, 0x2F, 0x1B, 0x46, 0x0D, 0x38, 0xDB, 0xEB, 
+0xF5, 
+
+0x4D, 0x5B, 0xC4, 0xB0, 0x2C, 0x07, 0x38, 0x28, 
+0x4F, 0xEA, 0xE3, 0x82, 0xCD, 0xB9, 0x50, 0xAE, 
+0x52, 0x28, 0x8A, 0x95, 0x95, 0x73, 0x64, 0x08, 
+0xBC, 0xBE, 0xAE, 0x36, 0x9B, 0x7C, 0xEC, 0xE2, 
+0x2C, 0x02, 0xC8, 0x8A, 0xC8, 0xE1, 0x46, 0x93, 
+0xD5, 0x8A, 0xD9, 0x2C, 0x63, 0xDE, 0x3E, 0x18, 
+0x93, 0x82, 0x20, 0xDF, 0x89, 0x74, 0x5F, 0x3C, 
+0x1E, 0xB4, 0x1C, 0x93, 0xB0, 0x34, 0x5F, 0x62, 
+0x6F, 0xBA, 0x56, 0x2C, 0xA2, 0xFE, 0x03, 0x27, 
+0xC4, 0x3A, 0xE2, 0xAF, 0x40, 0x66, 0xD4, 0x77, 
+0xC2, 0x00, 0x83, 0x08, 0x43, 0xC4, 0xAC, 0x8D, 
+0x98, 0x6F, 0xBC, 0x75, 0xB6, 0xF7, 0x69, 0x23, 
+0x38, 0x03, 0xDE, 0x9A, 0x63, 0xD1, 0x6C, 0x32, 
+0xC3, 0xF8, 0x55, 0xA2, 0x2D, 0xEB, 0xE5, 0x1A, 
+0xD6, 0xB7, 0x81, 0x45, 0xFB, 0xDF, 0x1E, 0x6B, 
+0x76, 0xAA, 
+
+0xDE, 0xFB, 0x27, 0x22, 0x17, 0xED, 0x7C, 0x45, 
+0xCC, 0x89, 0x49, 0xDA, 0xE5, 0xED, 0x63, 0xB6, 
+0x2C, 0x37, 0x86, 0x9A, 0xA9, 0x07, 0xD9, 0x26, 
+0x4C, 0xB2, 0x33, 0x19, 0xD6, 0xD6, 0xC4, 0x7C, 
+0x2F, 0x5A, 0xA9, 0x69, 0x4C, 0xB9, 0x19, 0x2E, 
+0xAE, 0x3D, 0xAE, 0x94, 0x2F, 0x1B, 0x5A, 0xCE, 
+0x35, 0xB3, 0xD0, 0xD9, 0x45, 0x71, 0x4D, 0x3F, 
+0x77, 0x33, 0x21, 0x70, 0xEC, 0xB0, 0x41, 0xDE, 
+0xC7, 0x50, 0x15, 0x81, 0xF5, 0x4F, 0x9C, 0xCE, 
+0xD5, 0xC7, 0xA9, 0x86, 0xD8, 0xE1, 0x3E, 0x2E, 
+0x0D, 0x17, 0x76, 0x92, 0x50, 0x27, 0x05, 0xC3, 
+0xA8, 0xFB, 0x08, 0x88, 0x14, 0xEB, 0x75, 0xD9, 
+0x32, 0xF5, 0xDC, 0x0F, 0xF4, 0x7F, 0x66, 0x0A, 
+0xE7, 0xDA, 0x15, 0xD8, 0xA6, 0xD7, 0xDD, 0x42, 
+0x2C, 0xC4, 0xF1, 0x02, 0xE3, 0xAC, 0xD1, 0x73, 
+0x23, 0x78, 0xAB, 
+
+0x63, 0xAB, 0xD3, 0x05, 0x03, 0xD8, 0xAE, 0x58, 
+0xB4, 0xF3, 0xF7, 0xA8, 0x36, 0x5B, 0xD4, 0x84, 
+0x6B, 0x49, 0x26, 0x39, 0xC4, 0x5C, 0x02, 0xAE, 
+0x38, 0x9B, 0xD8, 0xCE, 0x5F, 0x4F, 0x2A, 0x29, 
+0xCD, 0xD8, 0x4D, 0x05, 0x39, 0xAB, 0x09, 0xB7, 
+0x2B, 0x3D, 0xA7, 0x17, 0x77, 0x35, 0xCF, 0x62, 
+0x4C, 0xEE, 0x38, 0x16, 0xEF, 0x7E, 0x81, 0xD4, 
+0xB3, 0x6A, 0x7B, 0x26, 0xC8, 0xC5, 0xBE, 0xA2, 
+0x5A, 0xE5, 0x6D, 0x8A, 0xA3, 0xB1, 0x66, 0xF0, 
+0xD5, 0x6B, 0xC2, 0x0C, 0xBF, 0x8C, 0x1B, 0x0C, 
+0xB0, 0xB3, 0xC2, 0xC9, 0xA8, 0x27, 0x0C, 0xBD, 
+0xAD, 0x09, 0x8E, 0xD0, 0x9C, 0x22, 0x4E, 0xFD, 
+0x4D, 0x8C, 0x7E, 0xF1, 0xA7, 0x4E, 0xBB, 0x51, 
+0x06, 0xE7, 0x3F, 0xD1, 0x0F, 0x76, 0x14, 0x09, 
+0xB7, 0xEB, 0x36, 0x9B, 0xEF, 0xEF, 0xDA, 0xA5, 
+0x57, 0xE2, 0x82, 0xD4, 
+
+0x95, 0x2D, 0x7F, 0xFB, 0xA7, 0x59, 0x4F, 0xEE, 
+0x58, 0x96, 0x66, 0x77, 0x9F, 0x9F, 0x94, 0xB5, 
+0x1A, 0x42, 0x26, 0xD7, 0x07, 0x20, 0x18, 0x7E, 
+0x29, 0x48, 0x65, 0x52, 0xA2, 0x05, 0xC8, 0x51, 
+0x98, 0x61, 0x10, 0xA3, 0xC2, 0xC5, 0xEF, 0xA6, 
+0x08, 0x15, 0xF1, 0x10, 0x83, 0x60, 0x9D, 0x87, 
+0xBD, 0xCC, 0x35, 0x1C, 0xEB, 0x4B, 0x79, 0x50, 
+0x5E, 0x5E, 0xAD, 0x89, 0xED, 0xE9, 0xF9, 0xE2, 
+0x7A, 0x3C, 0x4E, 0x7E, 0x74, 0x36, 0xBD, 0xC3, 
+0x52, 0x57, 0x69, 0xA3, 0xEF, 0xF7, 0xA3, 0xEC, 
+0x6E, 0x51, 0x82, 0x31, 0x98, 0x98, 0x3B, 0x87, 
+0xB9, 0x1A, 0x85, 0x2F, 0xCD, 0x10, 0xD5, 0x20, 
+0xE6, 0xC3, 0xED, 0x41, 0x13, 0x94, 0x3E, 0xAD, 
+0x70, 0xC7, 0xA6, 0x39, 0x67, 0x35, 0xA0, 0x88, 
+0x8A, 0x9D, 0xCB, 0xD9, 0xD3, 0x7A, 0x72, 0xA6, 
+0xCD, 0xE7, 0x78, 0xF1, 0xBC, 
+
+0xD1, 0xD9, 0xC7, 0xF5, 0x06, 0xF0, 0x92, 0xEF, 
+0x32, 0xE0, 0xDF, 0x9B, 0x45, 0xBD, 0x50, 0xF1, 
+0xF1, 0xD1, 0x9B, 0x39, 0x64, 0x5F, 0xA1, 0x84, 
+0x7C, 0xF3, 0xC8, 0x4A, 0xFC, 0x61, 0x56, 0x8B, 
+0xF1, 0xA3, 0x97, 0x16, 0x5B, 0xF4, 0x90, 0xC2, 
+0x05, 0x2B, 0x48, 0x57, 0xCE, 0x34, 0x5E, 0x5E, 
+0x8F, 0xC0, 0xBD, 0xA2, 0x22, 0xD6, 0x87, 0xF6, 
+0xC7, 0x7E, 0xAD, 0x39, 0x80, 0x77, 0xD3, 0x99, 
+0x94, 0xCC, 0xDF, 0xA5, 0xD4, 0xDA, 0x26, 0x28, 
+0x12, 0x7D, 0x5B, 0xCB, 0x6B, 0x55, 0x43, 0x63, 
+0x63, 0x44, 0x93, 0x06, 0xC2, 0x64, 0x0F, 0x5A, 
+0xC5, 0x5C, 0xEF, 0xB1, 0x21, 0xF1, 0xD8, 0x3C, 
+0x20, 0x59, 0x42, 0x1D, 0xEE, 0x11, 0x3A, 0xF7, 
+0xF4, 0x4C, 0xBA, 0x4D, 0x9D, 0x95, 0x70, 0x09, 
+0xB3, 0x8D, 0x18, 0x63, 0xA7, 0xDF, 0x52, 0x60, 
+0x4D, 0xAF, 0x10, 0x1C, 0x28, 0xBF, 
+
+0x95, 0xF5, 0x47, 0x6E, 0x0B, 0x34, 0x3B, 0x5F, 
+0xD3, 0xA4, 0xA9, 0x11, 0x9B, 0xC5, 0xF9, 0x26, 
+0x73, 0x5B, 0xD8, 0x68, 0x33, 0x8C, 0x3B, 0x84, 
+0x7F, 0xCE, 0xBB, 0x7A, 0x45, 0xAE, 0x41, 0xFE, 
+0x90, 0x82, 0x7F, 0x40, 0x18, 0xB2, 0x29, 0xE0, 
+0x2A, 0xFF, 0xFB, 0x77, 0x26, 0xAB, 0x36, 0x16, 
+0x44, 0x5A, 0x8A, 0x4A, 0x01, 0xFE, 0x43, 0xE3, 
+0x60, 0x5C, 0x0F, 0x21, 0x12, 0x70, 0x3F, 0x91, 
+0x79, 0x42, 0x68, 0x04, 0xA9, 0xBD, 0xA3, 0xEB, 
+0x37, 0xAA, 0xD7, 0x64, 0xC5, 0x93, 0xEF, 0x21, 
+0xCD, 0x4B, 0x3D, 0x44, 0xDB, 0x59, 0x06, 0x40, 
+0xDE, 0xAF, 0x1D, 0x5E, 0x6D, 0x89, 0xAD, 0xF2, 
+0xA3, 0x8A, 0x38, 0x60, 0x3D, 0x3C, 0x67, 0x2E, 
+0xD6, 0xF0, 0xA4, 0xD9, 0xEC, 0xF5, 0x44, 0xB8, 
+0x23, 0x5C, 0x87, 0x40, 0xEB, 0xE5, 0x7D, 0xD9, 
+0x4C, 0x03, 0x7A, 0xF9, 0x7F, 0x06, 0x77, 
+
+0xD8, 0x72, 0x35, 0x41, 0x01, 0x12, 0x1E, 0x47, 
+0xEF, 0x8B, 0x89, 0x25, 0x9C, 0x2F, 0xE4, 0x79, 
+0x06, 0x4E, 0xB7, 0xBE, 0x6F, 0xB4, 0x74, 0x83, 
+0x33, 0x34, 0x38, 0xD3, 0xAA, 0xD5, 0x2B, 0xB3, 
+0x37, 0x82, 0x34, 0x54, 0x08, 0x15, 0x37, 0xCB, 
+0xF6, 0xDA, 0x41, 0x29, 0xC4, 0xCE, 0x26, 0x20, 
+0x93, 0xF3, 0xC8, 0xBC, 0x1B, 0x12, 0x2A, 0x1D, 
+0x7F, 0xC9, 0x98, 0xD6, 0x1D, 0xD9, 0x82, 0x47, 
+0xB4, 0x47, 0xB1, 0xB2, 0xE1, 0xDA, 0x24, 0x74, 
+0xE2, 0x03, 0xE9, 0xBF, 0x5A, 0x70, 0xD3, 0x11, 
+0x01, 0x40, 0x54, 0xFA, 0x97, 0x62, 0xDA, 0x26, 
+0x17, 0xA4, 0x33, 0x75, 0xA8, 0xC2, 0xB7, 0x1D, 
+0x48, 0x4D, 0xE6, 0xBA, 0x60, 0x32, 0xB1, 0xAF, 
+0xAD, 0x77, 0x7C, 0x24, 0x0D, 0x92, 0x6C, 0x25, 
+0xCE, 0x20, 0x4D, 0x0A, 0x6C, 0xD9, 0xC5, 0x80, 
+0xD7, 0x0A, 0xDF, 0x82, 0xE7, 0xB5, 0x0F, 0x95, 
+
+0x89, 0x01, 0x19, 0xFA, 0x83, 0xE1, 0x52, 0xD5, 
+0xEE, 0x5B, 0x5D, 0x48, 0x5B, 0x6A, 0xA0, 0x61, 
+0x52, 0xCC, 0x9E, 0xF9, 0x25, 0xC2, 0x4D, 0xE5, 
+0xB3, 0x9F, 0x87, 0xFA, 0x39, 0x7A, 0x2A, 0x49, 
+0x5E, 0x10, 0xB7, 0xEA, 0x2E, 0x7D, 0xE0, 0xB9, 
+0x48, 0x04, 0x04, 0xBD, 0x78, 0x54, 0x45, 0x86, 
+0x09, 0x51, 0x82, 0x96, 0x84, 0x81, 0x20, 0xDD, 
+0x88, 0x0A, 0x10, 0x5A, 0xB2, 0xDD, 0x67, 0xF1, 
+0xDD, 0x4C, 0xA9, 0xBD, 0x2C, 0xAE, 0xA5, 0x04, 
+0xBA, 0x83, 0x8B, 0xC6, 0x26, 0x17, 0x87, 0xF6, 
+0xC5, 0x31, 0x0F, 0x78, 0xBD, 0x9B, 0xC7, 0x79, 
+0x9E, 0x90, 0x9C, 0x84, 0xAD, 0x1D, 0xF3, 0xF6, 
+0x53, 0x2D, 0x89, 0x35, 0x55, 0xFF, 0x5C, 0xEE, 
+0xA9, 0x46, 0x3F, 0x3B, 0xA3, 0xE9, 0xB9, 0xF1, 
+0x31, 0x0B, 0xB9, 0x66, 0x2A, 0xEC, 0xCB, 0xFE, 
+0x14, 0x2F, 0x06, 0xB5, 0x32, 0x53, 0x58, 0xD6, 
+0xA1, 
+
+0x6B, 0x04, 0xAC, 0x58, 0x2E, 0x49, 0xF4, 0x3B, 
+0x0C, 0x56, 0x85, 0xDC, 0x14, 0x51, 0x7A, 0x49, 
+0xC5, 0xA1, 0x3B, 0xFA, 0x37, 0x96, 0x03, 0x13, 
+0xDA, 0xF9, 0xBF, 0x37, 0x31, 0xD4, 0x5E, 0x7B, 
+0xAE, 0x96, 0x86, 0xE4, 0x74, 0xF4, 0x1D, 0x44, 
+0xA8, 0x3B, 0x49, 0xEE, 0xBF, 0xFE, 0xF3, 0xAA, 
+0x8E, 0xA0, 0x59, 0xEF, 0xFB, 0x69, 0x47, 0x52, 
+0x33, 0x28, 0x51, 0x95, 0xD8, 0x54, 0xE3, 0xD4, 
+0xFC, 0x58, 0x2D, 0x69, 0x67, 0x12, 0x24, 0x82, 
+0xD8, 0x0F, 0xC9, 0xEB, 0x0B, 0x57, 0xF8, 0x8A, 
+0x9D, 0x62, 0x46, 0x54, 0xBE, 0xCE, 0x21, 0xFC, 
+0x04, 0x18, 0xA5, 0xA0, 0x18, 0xD1, 0x4D, 0x14, 
+0xF1, 0x9E, 0xD2, 0x4A, 0x84, 0x89, 0xA3, 0xEE, 
+0x5F, 0x0C, 0xBF, 0x4C, 0xC9, 0x9A, 0x4A, 0xF5, 
+0xD9, 0xC3, 0x34, 0x6D, 0x40, 0x73, 0x35, 0x51, 
+0xD5, 0x14, 0x2E, 0x9A, 0xA1, 0xA8, 0x5E, 0x09, 
+0xF3, 0xE0, 
+
+0x42, 0x2D, 0x1B, 0x1E, 0xF5, 0x38, 0x42, 0x29, 
+0x08, 0xBB, 0xCA, 0xCA, 0x9A, 0xF6, 0x58, 0x19, 
+0x00, 0xD3, 0xC0, 0x0C, 0xF4, 0xDA, 0xEC, 0x1D, 
+0x53, 0x26, 0xA4, 0x26, 0x4A, 0xCB, 0xA0, 0xFF, 
+0x49, 0x96, 0x31, 0xB6, 0xDE, 0x07, 0x7C, 0x6B, 
+0xD1, 0x48, 0x52, 0xBC, 0xAE, 0xC2, 0x7D, 0x85, 
+0xA7, 0x9E, 0x24, 0xE7, 0x29, 0x37, 0x84, 0x63, 
+0xF7, 0xB3, 0x9E, 0xBE, 0xE7, 0xDF, 0x0A, 0x7C, 
+0xC0, 0xE1, 0x64, 0x3B, 0x4C, 0x47, 0x6E, 0x0D, 
+0x1C, 0xA8, 0x6E, 0x09, 0xD2, 0x0D, 0x6D, 0x76, 
+0x90, 0xA9, 0x13, 0xFE, 0x00, 0x4A, 0x47, 0x91, 
+0xC0, 0xE7, 0x8C, 0xBB, 0x92, 0xE7, 0x26, 0xD6, 
+0x75, 0xEF, 0xDD, 0x47, 0xE2, 0x4C, 0x4D, 0xB7, 
+0x48, 0xAF, 0xAF, 0xF9, 0xD5, 0x63, 0x9A, 0xE0, 
+0x53, 0xDA, 0x8A, 0xE9, 0xA6, 0xFD, 0x11, 0x7D, 
+0xEE, 0x84, 0x57, 0x4C, 0xE2, 0x03, 0xCC, 0xF6, 
+0xF6, 0x56, 0x29, 
+
+0x0E, 0x83, 0xF7, 0xE4, 0x4F, 0x27, 0xF6, 0x9D, 
+0x1E, 0xB7, 0xDB, 0xAC, 0x5F, 0xD1, 0xC9, 0x77, 
+0xD7, 0x27, 0x01, 0x6A, 0x2D, 0xDC, 0xF5, 0xAF, 
+0x7E, 0x4D, 0x60, 0x6A, 0x53, 0x8C, 0x66, 0x53, 
+0xC9, 0xB7, 0x63, 0x10, 0x31, 0xE0, 0x11, 0x6C, 
+0x7D, 0x76, 0xD6, 0x04, 0x62, 0x67, 0x6E, 0x1E, 
+0x9F, 0xFD, 0x4D, 0x51, 0xF9, 0x5F, 0x88, 0x1E, 
+0xF2, 0x3F, 0x24, 0xBF, 0x45, 0x85, 0x54, 0x75, 
+0x2C, 0xCC, 0x41, 0x31, 0x94, 0x29, 0xF6, 0xDC, 
+0xC8, 0x00, 0x64, 0x0A, 0xD1, 0x56, 0xEA, 0x5A, 
+0x17, 0x52, 0x95, 0x86, 0xFA, 0x47, 0xD9, 0xFC, 
+0xFC, 0x00, 0x55, 0xF8, 0x04, 0x4D, 0xAD, 0xB7, 
+0x00, 0x62, 0x58, 0xB2, 0xC7, 0xC8, 0x47, 0xEB, 
+0xDD, 0x5B, 0x06, 0x13, 0x2F, 0x41, 0x66, 0xA7, 
+0x2E, 0xD4, 0x49, 0xAA, 0x56, 0x06, 0x59, 0x83, 
+0xAC, 0x0D, 0x65, 0x33, 0x6B, 0xD5, 0xAB, 0x32, 
+0x0D, 0x11, 0xCC, 0xE5, 
+
+0x95, 0xB9, 0x0D, 0xC6, 0x7F, 0x3E, 0xD0, 0x00, 
+0xD0, 0x18, 0x51, 0x55, 0xEA, 0x14, 0xA1, 0x83, 
+0xCF, 0x01, 0xC3, 0x1B, 0xB9, 0xAB, 0xDF, 0xD8, 
+0xE0, 0xF1, 0x5B, 0x54, 0x1A, 0xD8, 0x1F, 0xBB, 
+0x8D, 0x72, 0xC0, 0x4A, 0x78, 0x80, 0x35, 0x0B, 
+0xB7, 0xF4, 0x6B, 0x9C, 0x96, 0x97, 0x93, 0xDC, 
+0x9B, 0x0E, 0xC4, 0x23, 0xF4, 0x85, 0xFC, 0xA5, 
+0x41, 0x73, 0xCA, 0x79, 0xBD, 0x2B, 0xE7, 0x03, 
+0xDF, 0x8B, 0x56, 0x14, 0x7C, 0x29, 0xB6, 0x3F, 
+0xD7, 0x17, 0x99, 0xF1, 0x5B, 0x67, 0x46, 0x45, 
+0x8D, 0x81, 0x6A, 0x1E, 0xF1, 0xA2, 0xBC, 0x96, 
+0x53, 0x09, 0x38, 0xF4, 0x74, 0xB1, 0xAE, 0xEE, 
+0xF2, 0x39, 0xB9, 0x12, 0x22, 0xCB, 0xD8, 0x0A, 
+0xC9, 0x16, 0xCF, 0xA2, 0x9A, 0x08, 0xD9, 0x42, 
+0xEF, 0xEF, 0x38, 0x9E, 0x7C, 0x1E, 0x60, 0x2D, 
+0xED, 0xE9, 0x73, 0x68, 0x4E, 0x4C, 0x21, 0xED, 
+0xAD, 0x82, 0x02, 0xAE, 0xF1, 
+
+0x05, 0x31, 0xEE, 0x5F, 0x70, 0xA9, 0x59, 0x85, 
+0xF6, 0xDB, 0xCB, 0xC7, 0xAF, 0xCE, 0x96, 0xD1, 
+0x59, 0x23, 0x3E, 0x7B, 0x83, 0x26, 0xC9, 0x3B, 
+0xB9, 0xF8, 0x7C, 0x50, 0x4A, 0x9A, 0xE5, 0xA7, 
+0x7E, 0x2B, 0xEA, 0xCF, 0xDE, 0xF3, 0xAE, 0x6F, 
+0x11, 0x4F, 0x31, 0x4E, 0x48, 0x0A, 0xE1, 0x4D, 
+0x54, 0xB0, 0x66, 0x78, 0xA5, 0xEF, 0x7B, 0x8B, 
+0x7A, 0x81, 0xC6, 0x91, 0x23, 0x1C, 0xA0, 0x78, 
+0xB0, 0x4E, 0xF7, 0xAD, 0x6A, 0x4A, 0x65, 0x82, 
+0x08, 0x18, 0xC2, 0x5F, 0x99, 0x1E, 0x98, 0x9D, 
+0x25, 0xCD, 0xCC, 0x36, 0x7A, 0xC3, 0x49, 0x8B, 
+0xC1, 0x9D, 0x9F, 0xE6, 0xD6, 0x01, 0xB1, 0xE8, 
+0x08, 0xCF, 0x60, 0x91, 0x96, 0x46, 0x00, 0x6F, 
+0x0E, 0x14, 0x78, 0xFB, 0x0C, 0x8B, 0x39, 0x1F, 
+0x4F, 0xE9, 0xFF, 0xBE, 0x02, 0x05, 0x48, 0x03, 
+0x77, 0x18, 0xAE, 0x95, 0x92, 0xF9, 0x62, 0x90, 
+0xDB, 0x74, 0xEC, 0x7E, 0x8E, 0xEF, 
+
+0x52, 0x56, 0xF8, 0xEA, 0x85, 0x35, 0x72, 0xAE, 
+0x2D, 0xF0, 0x10, 0xF1, 0x2E, 0x02, 0x22, 0x9E, 
+0x9F, 0xEC, 0x54, 0x44, 0xDD, 0xFB, 0xB3, 0x5C, 
+0x9C, 0xD8, 0xBB, 0x9E, 0xAF, 0x9C, 0xF2, 0xEF, 
+0x39, 0x54, 0xEE, 0x3D, 0xDF, 0x1C, 0xA8, 0x09, 
+0x0C, 0x0C, 0x67, 0x06, 0x23, 0x7D, 0x82, 0x27, 
+0x44, 0xBD, 0x64, 0xFC, 0xB4, 0x88, 0xD6, 0x67, 
+0xE2, 0xFD, 0x16, 0x63, 0x61, 0x4F, 0xCA, 0x39, 
+0xBA, 0xBB, 0xB2, 0x65, 0x55, 0x3B, 0x81, 0x49, 
+0xC5, 0x29, 0x0E, 0xFD, 0x6E, 0xAF, 0xA1, 0xDF, 
+0x07, 0xD7, 0xBE, 0xA6, 0xAA, 0x39, 0xD0, 0x6D, 
+0x50, 0x95, 0xDA, 0x99, 0x64, 0x5F, 0xD7, 0x81, 
+0x31, 0x05, 0x0F, 0x0F, 0xAC, 0x29, 0x10, 0x45, 
+0xE3, 0x23, 0xFA, 0xEB, 0x70, 0xD8, 0xA9, 0x51, 
+0xCF, 0x3C, 0x62, 0x57, 0x3A, 0x69, 0xF2, 0x30, 
+0xE4, 0x01, 0xD4, 0xAC, 0xB7, 0x10, 0x22, 0x72, 
+0x27, 0xCD, 0x96, 0x5C, 0x95, 0x83, 0x9F, 
+
+0x4F, 0xD5, 0xDE, 0x4B, 0xF8, 0x21, 0x90, 0xA1, 
+0x85, 0xC9, 0x4D, 0x66, 0x2F, 0x72, 0xAB, 0xBE, 
+0x3E, 0xFD, 0x43, 0x00, 0x58, 0xD3, 0x37, 0xDF, 
+0x68, 0xC3, 0x81, 0x71, 0xBA, 0x4E, 0xCD, 0xE6, 
+0xAB, 0x34, 0x44, 0x6F, 0x28, 0x14, 0x61, 0xF7, 
+0xA6, 0x4D, 0x1A, 0x08, 0x81, 0x65, 0x0E, 0x99, 
+0x39, 0xAC, 0x5E, 0x50, 0xDA, 0x8D, 0x7C, 0xD1, 
+0x3A, 0x14, 0x66, 0xE4, 0x75, 0x91, 0x7E, 0xFA, 
+0xE3, 0xB6, 0x24, 0x18, 0x61, 0x20, 0x3D, 0xEF, 
+0x2E, 0x4D, 0x47, 0x2A, 0xC3, 0xD7, 0xD3, 0x40, 
+0x9D, 0xCA, 0xAC, 0x56, 0xA9, 0xCE, 0x9A, 0xB3, 
+0x95, 0x23, 0xE0, 0x09, 0x83, 0x97, 0x88, 0xBE, 
+0xDE, 0x06, 0xF5, 0x3E, 0xCD, 0xD6, 0xF5, 0x91, 
+0x6D, 0xE8, 0xAD, 0xCF, 0xE5, 0xFC, 0x65, 0xDA, 
+0x1F, 0x1D, 0x08, 0x08, 0x0B, 0x90, 0x01, 0xAD, 
+0x14, 0xDB, 0x62, 0x10, 0xB4, 0xBC, 0xB9, 0x15, 
+0x0D, 0xB1, 0x4F, 0xE7, 0xFE, 0x6C, 0x0C, 0xC6, 
+
+0x9B, 0x9A, 0xC4, 0xAD, 0x98, 0xB1, 0x45, 0x6F, 
+0x7B, 0x1C, 0x8E, 0x66, 0x5A, 0xF0, 0xAC, 0xA0, 
+0xFE, 0x99, 0x5F, 0x59, 0x10, 0x82, 0xE1, 0x3C, 
+0xEF, 0x32, 0x5A, 0x02, 0x36, 0x20, 0x31, 0x71, 
+0xE9, 0xF3, 0xC6, 0x0A, 0x24, 0x94, 0xAD, 0xAA, 
+0xD9, 0xC8, 0x92, 0xCB, 0xF3, 0x1B, 0x23, 0x92, 
+0x71, 0x08, 0x36, 0xF7, 0xB4, 0x7B, 0x8F, 0xEE, 
+0x72, 0xDC, 0xC5, 0xDB, 0x2D, 0x60, 0xDE, 0xAE, 
+0xAD, 0xD4, 0x7B, 0x8B, 0x4D, 0xC6, 0x36, 0x0F, 
+0xA4, 0xB6, 0xFD, 0x8D, 0x0D, 0x8C, 0xD4, 0x73, 
+0x78, 0x33, 0x95, 0x77, 0x00, 0xB1, 0x4D, 0x01, 
+0x4D, 0x6F, 0x21, 0x17, 0x5C, 0x2A, 0x34, 0xED, 
+0x90, 0xCA, 0x09, 0x6A, 0x73, 0x6C, 0x08, 0x38, 
+0xB4, 0x86, 0xE7, 0xF1, 0xA6, 0xD9, 0x62, 0x37, 
+0x6C, 0x35, 0x51, 0x7E, 0x8F, 0xFC, 0xBB, 0xC6, 
+0xC0, 0xC9, 0x84, 0x62, 0x88, 0xB2, 0x5B, 0xF5, 
+0x54, 0x3A, 0x61, 0x26, 0x3D, 0xD1, 0xA0, 0xE4, 
+0x0E, 
+
+0x0F, 0x0C, 0x1C, 0x7E, 0x27, 0x70, 0xEA, 0x41, 
+0xBC, 0xF2, 0xEB, 0x00, 0x48, 0x63, 0xB0, 0xB9, 
+0x52, 0x45, 0x11, 0x57, 0xCC, 0x31, 0x36, 0x1A, 
+0xB1, 0xFE, 0x37, 0x39, 0xDB, 0x1F, 0xF8, 0x2B, 
+0x1D, 0x0D, 0x0B, 0x9A, 0x22, 0x32, 0xE9, 0xCD, 
+0x35, 0x84, 0xA5, 0xD0, 0xED, 0xB8, 0x0C, 0x30, 
+0x49, 0x5B, 0xA7, 0x00, 0x8E, 0x5A, 0xF2, 0xE6, 
+0x2E, 0x48, 0xAA, 0x79, 0x5B, 0x5B, 0x3C, 0xD3, 
+0x8E, 0x7F, 0x26, 0x9B, 0x46, 0x71, 0x0D, 0xD1, 
+0x10, 0x8A, 0xC8, 0x99, 0x7A, 0xB8, 0x1C, 0x08, 
+0x3B, 0x61, 0x9B, 0x3D, 0x29, 0x5B, 0x0C, 0x30, 
+0x1E, 0x5E, 0xAF, 0x1E, 0x17, 0xFF, 0xE0, 0xD6, 
+0x57, 0xB0, 0x5B, 0x3D, 0x70, 0x30, 0x3B, 0x59, 
+0x37, 0x37, 0xE3, 0x68, 0xDC, 0x34, 0xD6, 0x09, 
+0xA8, 0x04, 0x96, 0xEE, 0x6C, 0x13, 0x03, 0x34, 
+0xDB, 0x24, 0x00, 0x79, 0x96, 0x41, 0x85, 0xC4, 
+0x41, 0xE8, 0xC1, 0x1C, 0xBC, 0x50, 0xAF, 0x62, 
+0x1D, 0xD7, 
+
+0xA4, 0xAB, 0xEA, 0xEB, 0xF6, 0xEB, 0xB9, 0xEB, 
+0x85, 0x2C, 0x79, 0x19, 0x97, 0xA7, 0x8A, 0xAA, 
+0x8B, 0xEA, 0x85, 0xD2, 0x4C, 0x02, 0xC6, 0xFB, 
+0x16, 0x64, 0xA5, 0xAC, 0x03, 0x37, 0xC0, 0x88, 
+0x4A, 0xAC, 0xA6, 0x7F, 0x12, 0x5E, 0x21, 0xEE, 
+0x4F, 0x16, 0x38, 0x34, 0x3E, 0x4F, 0xAC, 0xBA, 
+0xA4, 0x7E, 0xF4, 0xBD, 0x97, 0x65, 0x8C, 0x44, 
+0x4C, 0x6E, 0xD5, 0x02, 0xD2, 0xD6, 0x70, 0x5B, 
+0x8D, 0xD1, 0x3E, 0x86, 0x2D, 0x7B, 0x09, 0xF9, 
+0xBA, 0x54, 0xED, 0x62, 0xFB, 0xA5, 0xF8, 0x26, 
+0x7F, 0xEC, 0xD1, 0xFF, 0x12, 0x56, 0xFE, 0x0D, 
+0x82, 0xB6, 0x03, 0x3C, 0x57, 0x5A, 0x68, 0x96, 
+0x6D, 0x71, 0xDF, 0xC6, 0xD2, 0xF7, 0xE8, 0x12, 
+0x15, 0x96, 0x48, 0xB4, 0x9A, 0x52, 0xCD, 0x3C, 
+0x93, 0x08, 0x28, 0x53, 0xF7, 0x0A, 0xE6, 0x61, 
+0x47, 0xEC, 0x90, 0x35, 0xD6, 0x99, 0x6B, 0xD9, 
+0x27, 0xA5, 0x0D, 0x72, 0x4E, 0x31, 0xEF, 0xF9, 
+0xA3, 0x10, 0xF8, 
+
+0xFF, 0x6F, 0xDE, 0x93, 0x71, 0x5E, 0x62, 0xB8, 
+0x30, 0x13, 0x5D, 0x65, 0x59, 0x18, 0x5A, 0x3E, 
+0x1F, 0x7A, 0xFB, 0xC7, 0x3A, 0x3E, 0x7C, 0x24, 
+0x0F, 0xBE, 0x94, 0x2A, 0x61, 0x62, 0xFF, 0x68, 
+0xAF, 0x12, 0x02, 0x74, 0x88, 0x5B, 0x30, 0x22, 
+0xA0, 0x27, 0xC7, 0xBE, 0x88, 0x4A, 0x1A, 0x2F, 
+0x10, 0x89, 0xF0, 0xD7, 0x04, 0x58, 0x23, 0x6E, 
+0x71, 0xE3, 0x31, 0x47, 0x1D, 0x92, 0x9F, 0xB9, 
+0x61, 0xB0, 0xDB, 0xD7, 0xF9, 0xF9, 0x46, 0x54, 
+0x37, 0xC3, 0xAF, 0xF1, 0xBF, 0x00, 0xF1, 0x73, 
+0xCE, 0x0C, 0xA4, 0x0B, 0x6C, 0x04, 0x03, 0x16, 
+0x87, 0x0B, 0x93, 0x48, 0x08, 0x38, 0x01, 0x39, 
+0x94, 0xB1, 0xA2, 0x71, 0xC2, 0x8B, 0xB1, 0x2A, 
+0x90, 0x58, 0xB7, 0xFB, 0x83, 0xA5, 0x55, 0x5C, 
+0x3F, 0xB8, 0x0B, 0xB4, 0xC3, 0xA4, 0xC0, 0xE3, 
+0x54, 0x07, 0xB8, 0xDB, 0xFE, 0x94, 0x8C, 0x80, 
+0x22, 0xA9, 0x41, 0x09, 0xF0, 0x2C, 0x20, 0xEB, 
+0xBA, 0xB0, 0x93, 0x6C, 
+
+0xB4, 0x2C, 0xBC, 0xA7, 0xE3, 0xC9, 0x01, 0xDB, 
+0x61, 0xE3, 0x56, 0x59, 0x12, 0xAB, 0x9B, 0x77, 
+0x67, 0x9C, 0x1F, 0x76, 0xFC, 0xED, 0xD7, 0x46, 
+0x42, 0xF5, 0x68, 0x79, 0x1A, 0xDA, 0xBC, 0x25, 
+0x9F, 0x8B, 0xF6, 0x4E, 0x8A, 0xBC, 0xA5, 0x80, 
+0xF9, 0xC8, 0x66, 0xDA, 0x95, 0x54, 0xA7, 0x98, 
+0x9C, 0x05, 0x91, 0xC4, 0xC5, 0xB6, 0x8D, 0x5F, 
+0x59, 0xB9, 0x57, 0x42, 0x3C, 0x61, 0x8B, 0x4A, 
+0x90, 0x38, 0x1C, 0x15, 0x82, 0xA7, 0x71, 0x87, 
+0xCB, 0xA9, 0xAB, 0x99, 0x34, 0xF7, 0x78, 0x0B, 
+0x53, 0x76, 0x7B, 0x81, 0xC0, 0x00, 0x5D, 0xAC, 
+0xDA, 0xAC, 0x2A, 0x77, 0xE7, 0x3B, 0x2E, 0x3E, 
+0x54, 0xD0, 0x1F, 0x05, 0x69, 0x87, 0x80, 0xA7, 
+0x61, 0x89, 0x99, 0x75, 0xB2, 0x00, 0x0C, 0xF8, 
+0xCD, 0x44, 0xC8, 0xC3, 0x99, 0x90, 0x27, 0xA1, 
+0xFD, 0x53, 0x6C, 0x15, 0x45, 0x20, 0xE6, 0x8E, 
+0x0D, 0x23, 0xA0, 0x5C, 0x2B, 0xA8, 0xEA, 0x9E, 
+0x8E, 0x24, 0x64, 0xF2, 0xCA, 
+
+0xB8, 0xE7, 0x4A, 0x14, 0xD8, 0x04, 0x62, 0xAF, 
+0x9B, 0xB6, 0x9F, 0x59, 0x25, 0xDD, 0xF5, 0x90, 
+0x3E, 0x78, 0xA9, 0xAE, 0xE6, 0x30, 0x35, 0x32, 
+0x1A, 0x91, 0xA5, 0x1C, 0x96, 0x44, 0x3F, 0xE6, 
+0xB3, 0x8C, 0x14, 0x06, 0xE4, 0xE9, 0x60, 0x32, 
+0x1E, 0x46, 0xDE, 0xDC, 0x47, 0x9A, 0x69, 0xD6, 
+0x38, 0xEC, 0x31, 0xCC, 0x50, 0xE6, 0x79, 0xA8, 
+0x58, 0xB1, 0x0A, 0x4F, 0x22, 0x47, 0x33, 0xB2, 
+0x75, 0x96, 0xD0, 0xF6, 0x3C, 0xA1, 0x85, 0xDE, 
+0x1E, 0xB5, 0xFD, 0x79, 0xA0, 0x56, 0x5B, 0x6A, 
+0xCF, 0x72, 0x85, 0xAE, 0xC3, 0xA4, 0x93, 0x0E, 
+0x17, 0x01, 0xAC, 0xD8, 0xB3, 0xC4, 0x73, 0xE6, 
+0x78, 0x60, 0x0F, 0x02, 0x45, 0xF5, 0x8A, 0xFC, 
+0x29, 0x44, 0x29, 0xD7, 0x11, 0xFB, 0x66, 0xBB, 
+0x67, 0x54, 0x63, 0x33, 0xC3, 0xAD, 0xBE, 0x85, 
+0xED, 0xA2, 0x46, 0xF2, 0x13, 0x97, 0xD4, 0xCA, 
+0xB0, 0xAA, 0x9A, 0xBD, 0x8B, 0xF2, 0x33, 0x78, 
+0x54, 0x89, 0x37, 0x85, 0x5E, 0xC2, 
+
+0xB0, 0xDA, 0xCD, 0x36, 0x81, 0x0E, 0x56, 0x6E, 
+0xB2, 0x70, 0x59, 0xB9, 0x6F, 0x48, 0x1A, 0x5B, 
+0x52, 0xD8, 0xC6, 0x68, 0xFB, 0x19, 0x49, 0x74, 
+0x1F, 0x25, 0x13, 0xD8, 0x91, 0x0B, 0x09, 0x40, 
+0x8E, 0x10, 0xBF, 0xB7, 0x3A, 0x15, 0x44, 0xDD, 
+0x28, 0x63, 0xC3, 0xC8, 0x5A, 0xC9, 0xBD, 0x06, 
+0x9D, 0x99, 0x3A, 0xFC, 0x9B, 0x4C, 0xFD, 0xFF, 
+0x32, 0x3B, 0xF5, 0xE5, 0x4A, 0x0D, 0x59, 0xEF, 
+0x3A, 0x4C, 0x03, 0x7D, 0xAA, 0x4D, 0x13, 0xDC, 
+0x38, 0x9E, 0x3F, 0x32, 0x41, 0x5A, 0x9A, 0x9C, 
+0xE6, 0xB4, 0x9A, 0x0D, 0xCE, 0xFC, 0x76, 0xBD, 
+0x10, 0x60, 0xA8, 0x16, 0xA4, 0xF1, 0x8D, 0x0B, 
+0xFA, 0xC5, 0xAA, 0xD8, 0xBA, 0x91, 0xBB, 0x3D, 
+0xAC, 0x1A, 0x27, 0x09, 0xC4, 0xF1, 0x27, 0x0D, 
+0x3A, 0xC6, 0x17, 0x95, 0xBB, 0x6B, 0x7A, 0x7F, 
+0xF5, 0x71, 0xB1, 0xE9, 0xE4, 0xF1, 0xDF, 0xCF, 
+0xBC, 0x9D, 0xEC, 0x83, 0x19, 0x78, 0x31, 0x26, 
+0xC8, 0xB2, 0x78, 0xAA, 0xB9, 0x33, 0xD8, 
+
+0x38, 0xBF, 0xA7, 0x15, 0x68, 0x44, 0x00, 0xD8, 
+0xD4, 0x4E, 0xE7, 0x52, 0x49, 0xAA, 0xE3, 0x58, 
+0x0B, 0xA7, 0x3C, 0x3C, 0x67, 0x5E, 0xDD, 0xFB, 
+0x65, 0x39, 0x91, 0x63, 0x3F, 0xDD, 0xF3, 0xDB, 
+0xCB, 0x90, 0x4F, 0x97, 0x1B, 0x78, 0x23, 0x56, 
+0xB5, 0xE8, 0xE4, 0x77, 0xD4, 0xBB, 0x05, 0x98, 
+0xA7, 0xCA, 0x27, 0xCF, 0xC6, 0xA2, 0xE5, 0xEE, 
+0x5B, 0x17, 0xBB, 0xBE, 0x76, 0x98, 0xB7, 0xED, 
+0x34, 0x0F, 0x22, 0xB2, 0x9B, 0x5D, 0xD0, 0xD0, 
+0x04, 0x93, 0x4E, 0x6B, 0x91, 0x11, 0x57, 0x4A, 
+0x35, 0x06, 0xC2, 0x56, 0x01, 0xAD, 0xF9, 0xCA, 
+0x61, 0x8F, 0xB2, 0x0D, 0x15, 0x98, 0xE2, 0x41, 
+0x03, 0x5A, 0x70, 0x37, 0x21, 0x48, 0xD4, 0xD0, 
+0xB2, 0x0A, 0xB3, 0x43, 0xCF, 0x9A, 0x06, 0xF7, 
+0x98, 0x90, 0x80, 0xE7, 0x76, 0xB5, 0x1D, 0xA0, 
+0x0D, 0xE7, 0x9A, 0xC1, 0x57, 0x28, 0xE0, 0xF2, 
+0x93, 0xCF, 0x1B, 0x0B, 0x5F, 0xAF, 0x46, 0xAE, 
+0x9E, 0x2B, 0xB3, 0x55, 0x94, 0xA0, 0x2D, 0xE8, 
+
+0xF4, 0xA5, 0x15, 0xF5, 0x15, 0x5A, 0x4C, 0x2A, 
+0xE5, 0x5C, 0x98, 0x7F, 0xBF, 0x3D, 0x4A, 0xA1, 
+0x3F, 0x62, 0x9B, 0x96, 0x1F, 0xF7, 0x1F, 0x87, 
+0x74, 0x63, 0x48, 0x99, 0x9A, 0xB0, 0xE8, 0xD8, 
+0x2F, 0x81, 0x6A, 0x62, 0x27, 0x95, 0x5A, 0x14, 
+0xB0, 0x5C, 0xCE, 0x33, 0xF1, 0x41, 0xAB, 0x72, 
+0xB1, 0x3E, 0x24, 0xF7, 0xFA, 0xCE, 0x02, 0xDE, 
+0x35, 0xD4, 0x7F, 0x5A, 0x69, 0x55, 0x8D, 0x01, 
+0xC5, 0x1D, 0x9F, 0x62, 0x3E, 0xF7, 0x4B, 0x63, 
+0x93, 0x3B, 0xDB, 0xB7, 0xA4, 0xA5, 0xF6, 0xCC, 
+0xEC, 0xC9, 0xD3, 0x9B, 0x12, 0x72, 0x7C, 0x07, 
+0xEC, 0xC8, 0xBB, 0x63, 0x89, 0x3A, 0xFD, 0x7F, 
+0x1E, 0xC9, 0x96, 0x53, 0x5A, 0x44, 0x37, 0x7A, 
+0xA2, 0x20, 0xDD, 0x75, 0x4F, 0xAF, 0x64, 0xF5, 
+0x57, 0x0C, 0xF4, 0xC8, 0x16, 0x51, 0xBA, 0x41, 
+0x27, 0x96, 0x4E, 0x15, 0xBE, 0xA4, 0x1D, 0x2D, 
+0xC9, 0xE5, 0x44, 0x0B, 0x25, 0x20, 0xEC, 0xDA, 
+0x0E, 0x36, 0x64, 0xB2, 0xBB, 0x6C, 0x3B, 0x82, 
+0x9E, 
+
+0x7C, 0x63, 0x8D, 0x21, 0x01, 0xFF, 0x27, 0x74, 
+0x3C, 0x37, 0x20, 0xFB, 0x9C, 0x4C, 0x47, 0x8B, 
+0x24, 0x9F, 0xAB, 0x4D, 0x4C, 0xF4, 0x43, 0x09, 
+0x3E, 0x20, 0x10, 0x28, 0x31, 0xAA, 0xFA, 0x74, 
+0x08, 0x2B, 0xCB, 0x5A, 0xDA, 0xAB, 0x89, 0x6A, 
+0x6C, 0xCC, 0xF5, 0xEE, 0xFB, 0xCF, 0x08, 0x63, 
+0x25, 0x85, 0xE6, 0xD9, 0x22, 0xBD, 0x3E, 0x50, 
+0x2D, 0x6B, 0x61, 0x2E, 0xAF, 0x1C, 0xA4, 0x7A, 
+0x16, 0x37, 0x05, 0x29, 0x18, 0x8B, 0x07, 0x7C, 
+0x3A, 0x6E, 0x87, 0xEA, 0x77, 0x29, 0x0F, 0xE3, 
+0x4D, 0x73, 0x62, 0xD6, 0xFE, 0xB6, 0x78, 0xE2, 
+0xB7, 0x83, 0xCE, 0xB9, 0x3E, 0xE4, 0x4F, 0xF0, 
+0x68, 0xC1, 0xC7, 0x51, 0x97, 0x61, 0xEE, 0xBC, 
+0xCE, 0x23, 0x24, 0x8A, 0x57, 0xC7, 0x39, 0xA4, 
+0xBD, 0x93, 0x41, 0x70, 0x05, 0xBF, 0x67, 0x89, 
+0x78, 0x06, 0xD7, 0x26, 0xEA, 0x84, 0xF2, 0xF4, 
+0xF1, 0x35, 0xC9, 0xB9, 0x67, 0xB0, 0xC7, 0xE9, 
+0x50, 0x5A, 0x35, 0xCB, 0xF0, 0x57, 0x2A, 0x44, 
+0x9B, 0xDA, 
+
+0x38, 0xD1, 0xDA, 0x21, 0x87, 0xC7, 0xE5, 0xD7, 
+0xDD, 0x5C, 0xB2, 0xBD, 0x61, 0x70, 0x18, 0x23, 
+0x1B, 0x2A, 0xC4, 0x1F, 0x8D, 0x17, 0x5B, 0x9D, 
+0x0C, 0x46, 0x5A, 0x44, 0x33, 0x0A, 0xA7, 0xEE, 
+0xD9, 0x57, 0x2B, 0x85, 0xEF, 0x6F, 0x9A, 0x78, 
+0x52, 0xFF, 0x9F, 0xE2, 0xCB, 0x0A, 0x33, 0x97, 
+0xD2, 0xFD, 0x69, 0x04, 0x2E, 0x58, 0x68, 0x7F, 
+0xBD, 0xE5, 0xE3, 0x3D, 0xD0, 0xCD, 0x4D, 0x10, 
+0xC6, 0x18, 0xFF, 0x03, 0x54, 0x0E, 0x46, 0x38, 
+0x69, 0x3D, 0x9C, 0xCF, 0x7B, 0xD7, 0xA3, 0xF3, 
+0x3C, 0x95, 0x96, 0xED, 0x22, 0xB2, 0x5E, 0x36, 
+0xF9, 0xE7, 0xBC, 0x1F, 0xA3, 0x19, 0x44, 0x3F, 
+0xA1, 0x26, 0x99, 0xC9, 0x49, 0x53, 0x6F, 0xF2, 
+0x4D, 0x18, 0xCE, 0x29, 0xFB, 0x8B, 0xFB, 0x94, 
+0xB0, 0x9D, 0xA8, 0xD6, 0x3D, 0x3C, 0xDA, 0x53, 
+0xF3, 0x57, 0x09, 0xA8, 0x74, 0xE9, 0x1D, 0x4F, 
+0xA3, 0xC3, 0x97, 0x1E, 0x21, 0x83, 0xC7, 0xB8, 
+0x8A, 0xD6, 0xCD, 0xA6, 0x65, 0x23, 0xF2, 0x9A, 
+0x3B, 0x49, 0xE8, 
+
+0xC8, 0x6B, 0x28, 0x44, 0x38, 0x72, 0xA0, 0x9A, 
+0x61, 0x3B, 0x5D, 0x3E, 0x7D, 0xD4, 0x88, 0x28, 
+0x87, 0x85, 0xEF, 0x69, 0x7C, 0x2A, 0x28, 0x89, 
+0x48, 0xA6, 0x23, 0x01, 0x18, 0x5A, 0x2A, 0x62, 
+0x79, 0x58, 0x1B, 0x11, 0x76, 0x1E, 0xB8, 0x3D, 
+0x6C, 0x9C, 0x42, 0xDC, 0x0C, 0x0A, 0x1C, 0xA5, 
+0x38, 0x10, 0x9C, 0x4F, 0xFE, 0x88, 0x92, 0x45, 
+0x25, 0x60, 0x68, 0x7B, 0x8F, 0xA1, 0x0C, 0x62, 
+0x2F, 0x05, 0x45, 0xBC, 0xF7, 0x44, 0x6F, 0x7A, 
+0xAF, 0xCC, 0xBE, 0x6E, 0x86, 0x60, 0x4C, 0x7C, 
+0xC7, 0x0A, 0x02, 0x47, 0xB9, 0x2A, 0x19, 0xFE, 
+0x70, 0xF2, 0x0D, 0x0C, 0xC0, 0xA6, 0x50, 0x44, 
+0x20, 0xE5, 0xA0, 0xE9, 0x9F, 0x31, 0x3C, 0x34, 
+0x3A, 0xAC, 0x62, 0x32, 0xC5, 0xCF, 0xF9, 0xBC, 
+0x90, 0x88, 0xA8, 0x4C, 0x37, 0xC9, 0x10, 0x24, 
+0xC3, 0xAC, 0x18, 0xC6, 0x69, 0xDB, 0xD8, 0x9B, 
+0x4D, 0x05, 0x1D, 0x3E, 0x1D, 0x6A, 0xD0, 0xDB, 
+0xD0, 0x41, 0x46, 0x2F, 0x69, 0x53, 0xB4, 0x02, 
+0xA2, 0x25, 0xFE, 0xE8, 
+
+0x5D, 0x6D, 0xC1, 0xC4, 0x1F, 0x56, 0xAF, 0xD4, 
+0x18, 0xDE, 0xB5, 0x00, 0x30, 0xFE, 0x85, 0xE9, 
+0xB0, 0xFE, 0x3C, 0x26, 0x52, 0xD5, 0x88, 0x09, 
+0x7D, 0xE5, 0xA1, 0x15, 0x92, 0x5C, 0xB9, 0xE7, 
+0x63, 0x95, 0xA8, 0xE0, 0xB9, 0x46, 0x90, 0xF2, 
+0xE1, 0xD3, 0x34, 0x2E, 0x46, 0x21, 0xFE, 0x70, 
+0xE8, 0xA1, 0x3C, 0x96, 0xA1, 0xC4, 0x10, 0x7E, 
+0x83, 0x8C, 0xB4, 0xF9, 0x74, 0x09, 0x9F, 0xE9, 
+0x6C, 0xBA, 0x46, 0x1B, 0x96, 0x43, 0x90, 0x8D, 
+0x02, 0xCD, 0xB1, 0x3F, 0x6D, 0x81, 0xAD, 0x4E, 
+0x66, 0x33, 0x35, 0x16, 0x79, 0xEF, 0x51, 0x1D, 
+0x3E, 0x90, 0xB9, 0xC9, 0x6D, 0xFB, 0x79, 0x06, 
+0xB2, 0x24, 0x00, 0xCA, 0x44, 0x40, 0x61, 0xEF, 
+0x90, 0xB7, 0x41, 0xCC, 0xA3, 0x99, 0xD5, 0xA7, 
+0x54, 0x2B, 0x27, 0x97, 0xC5, 0x60, 0xD2, 0xC0, 
+0x83, 0x9D, 0x9C, 0xF3, 0x2D, 0x2A, 0x89, 0x95, 
+0xE7, 0x8B, 0x3D, 0x5B, 0x7D, 0x08, 0xC5, 0x68, 
+0xD4, 0x29, 0x23, 0x33, 0x78, 0xC9, 0x6F, 0xC0, 
+0x08, 0xEC, 0x2F, 0xA8, 0x95, 
+
+0xD5, 0x19, 0x88, 0x88, 0x44, 0xD7, 0xBA, 0x61, 
+0x1B, 0x03, 0x06, 0xE5, 0x18, 0xA9, 0xC1, 0xBA, 
+0x02, 0x70, 0xF1, 0x33, 0x29, 0xF6, 0x07, 0x11, 
+0x09, 0xF9, 0xB0, 0x12, 0x13, 0x4C, 0x41, 0xE6, 
+0x92, 0xE1, 0x01, 0x07, 0x95, 0x8C, 0x1B, 0x45, 
+0x77, 0xFA, 0xCF, 0x1C, 0xC1, 0xDC, 0x02, 0x7A, 
+0x34, 0xD4, 0xFF, 0xC8, 0x70, 0x67, 0x4A, 0xC6, 
+0x50, 0xF0, 0xEB, 0x10, 0x60, 0x3B, 0x5F, 0x86, 
+0x7E, 0x0F, 0x0C, 0x04, 0xDC, 0xD0, 0xF7, 0x15, 
+0xFF, 0x6D, 0x8D, 0xE3, 0xD4, 0x20, 0x80, 0x23, 
+0xA1, 0x13, 0x97, 0x3A, 0x3E, 0x4C, 0xFD, 0xBE, 
+0x44, 0xAB, 0xDA, 0x58, 0x8D, 0x23, 0x38, 0x20, 
+0x1D, 0x79, 0xBE, 0x3A, 0xA6, 0x8E, 0x58, 0x10, 
+0xD5, 0x20, 0xBB, 0x29, 0xF2, 0x53, 0x8E, 0x80, 
+0xB3, 0x12, 0x8D, 0xA7, 0x1A, 0xD0, 0xC9, 0xB4, 
+0x22, 0xC3, 0x87, 0xB2, 0xC3, 0x79, 0xCC, 0x22, 
+0xEF, 0xDF, 0x6C, 0xA1, 0x9B, 0xFE, 0xCF, 0x35, 
+0x18, 0x5D, 0x4B, 0xA7, 0x29, 0xF7, 0x89, 0xA0, 
+0x50, 0xED, 0x6A, 0x51, 0xB9, 0x4F, 
+
+0x3A, 0xB0, 0xFF, 0x8A, 0xB6, 0xB3, 0x4E, 0x04, 
+0x86, 0x4B, 0x9C, 0xA9, 0x9A, 0x47, 0xEB, 0xD8, 
+0xA0, 0x15, 0x64, 0x8D, 0x96, 0xBA, 0xBA, 0x4B, 
+0xBA, 0x3A, 0xD1, 0xF8, 0x41, 0x97, 0xE8, 0xED, 
+0xE9, 0x04, 0x45, 0xAC, 0x0B, 0x19, 0x68, 0x73, 
+0xB3, 0x92, 0x7C, 0xF5, 0x71, 0x68, 0xD9, 0x95, 
+0x56, 0x95, 0x23, 0xF6, 0x61, 0xF8, 0x85, 0xA3, 
+0x3D, 0x73, 0x11, 0x9A, 0x8A, 0x9B, 0xFC, 0x52, 
+0xB4, 0x9B, 0xB6, 0x61, 0x23, 0xB4, 0xD0, 0x55, 
+0x9C, 0x0D, 0x6A, 0xE3, 0x63, 0x57, 0xA5, 0x44, 
+0xDE, 0xB8, 0x68, 0x94, 0x8F, 0xC6, 0x9D, 0xCB, 
+0x81, 0xFD, 0x8A, 0xF1, 0x13, 0x61, 0x28, 0x35, 
+0xA2, 0x41, 0x66, 0xCA, 0xD1, 0xF9, 0xB8, 0xA3, 
+0x82, 0xAC, 0x7A, 0x6D, 0xE9, 0x37, 0xEB, 0xF0, 
+0xDC, 0xDE, 0xE6, 0xD1, 0x52, 0x47, 0xC8, 0x5E, 
+0x65, 0xDD, 0x99, 0x97, 0x20, 0x5C, 0x1F, 0x56, 
+0x58, 0xD9, 0x35, 0xF2, 0xD7, 0x0E, 0x9D, 0xB4, 
+0x59, 0x22, 0x36, 0x5E, 0xCC, 0x9A, 0xAC, 0x38, 
+0x2E, 0x96, 0x67, 0xC9, 0x57, 0x64, 0xA4, 
+
+0xEF, 0xF4, 0xEC, 0x7D, 0x3E, 0xB2, 0xC4, 0xBA, 
+0xD6, 0xDC, 0x82, 0xC3, 0x32, 0xB5, 0x07, 0x2C, 
+0xF9, 0x96, 0x96, 0x25, 0xEE, 0xDB, 0x7A, 0x79, 
+0x25, 0xF4, 0x3D, 0x8C, 0x1D, 0xAC, 0x30, 0x10, 
+0x3A, 0x19, 0xFE, 0xE6, 0x2E, 0x56, 0xBE, 0xF8, 
+0x4C, 0xA3, 0xDD, 0x1E, 0x36, 0xCC, 0x4B, 0xB4, 
+0xDC, 0x15, 0xF0, 0x21, 0xA8, 0x91, 0xEE, 0xDC, 
+0x31, 0xFC, 0xC6, 0x62, 0x59, 0x58, 0x7A, 0xBD, 
+0xFC, 0x43, 0xCB, 0xCB, 0x6B, 0x05, 0xD8, 0x1C, 
+0x94, 0x2D, 0x01, 0xAF, 0x37, 0x73, 0x70, 0xA5, 
+0x63, 0x3E, 0x05, 0x55, 0xBA, 0xAA, 0x42, 0xF2, 
+0x67, 0x8E, 0x9D, 0x81, 0x06, 0x3C, 0x3E, 0xE7, 
+0xB3, 0x48, 0x1D, 0x49, 0x05, 0xE9, 0x4F, 0xB9, 
+0xC5, 0xBF, 0xD0, 0x44, 0xEF, 0xA0, 0x66, 0x0F, 
+0x8E, 0x3F, 0x7D, 0x6D, 0xA9, 0x4B, 0xD4, 0x85, 
+0xE4, 0x6A, 0x1A, 0xAA, 0x20, 0x26, 0x75, 0xE1, 
+0x78, 0x19, 0x98, 0x96, 0xAE, 0xC7, 0x0D, 0x6E, 
+0xE8, 0x6A, 0x56, 0x48, 0xBD, 0x68, 0xC8, 0xA1, 
+0x7B, 0x41, 0xC0, 0x3B, 0x67, 0xED, 0x3B, 0xC4, 
+
+0xAC, 0x1C, 0x28, 0x1D, 0x49, 0xF3, 0xD9, 0x9C, 
+0xF1, 0xC9, 0xA6, 0xFD, 0x68, 0x87, 0xB6, 0x0E, 
+0x19, 0xF4, 0x59, 0x1D, 0xE5, 0x71, 0xD9, 0xCC, 
+0xD4, 0x35, 0x5F, 0x9D, 0x51, 0x94, 0x97, 0x27, 
+0x01, 0x8A, 0x0E, 0x2C, 0x0A, 0xB5, 0x8D, 0xE3, 
+0xE6, 0x64, 0x3D, 0xBA, 0xC7, 0xA0, 0x1C, 0xF0, 
+0x5E, 0x13, 0xC4, 0xB8, 0xEF, 0xED, 0x37, 0x7B, 
+0xA2, 0xD4, 0x77, 0x46, 0x5C, 0xD7, 0xD2, 0xBD, 
+0x47, 0xA0, 0x8A, 0x84, 0xC3, 0x55, 0x8B, 0xD5, 
+0x74, 0x33, 0xE0, 0x04, 0x08, 0xDF, 0x2E, 0x15, 
+0x94, 0x5F, 0x1B, 0xAB, 0xF6, 0xB1, 0x8F, 0xF7, 
+0x90, 0x1E, 0x32, 0x97, 0xA3, 0x28, 0xEE, 0x25, 
+0x7D, 0x53, 0xCB, 0xD1, 0x3F, 0xA0, 0x5F, 0xA6, 
+0xF7, 0x92, 0x20, 0xBC, 0x5E, 0x4E, 0xE8, 0xF7, 
+0x29, 0x94, 0x0B, 0xEE, 0xCD, 0x90, 0x29, 0x79, 
+0x2A, 0xFB, 0x5B, 0x43, 0x74, 0x69, 0x1A, 0x2F, 
+0xC1, 0x6D, 0x7A, 0x14, 0xF7, 0x19, 0x93, 0xA3, 
+0xAE, 0x0E, 0x58, 0x70, 0x9E, 0x1E, 0x40, 0xFB, 
+0xC6, 0xBF, 0x01, 0x95, 0x36, 0x01, 0xE2, 0x95, 
+0x88, 
+
+0xFF, 0x52, 0xD2, 0xDD, 0x68, 0xBF, 0x58, 0x4C, 
+0xE1, 0xE0, 0xE3, 0x63, 0xD2, 0xF4, 0xAC, 0x3D, 
+0x0D, 0xF5, 0x4E, 0x50, 0xF8, 0x1F, 0xB8, 0x7B, 
+0xBE, 0x6B, 0x30, 0x28, 0xAA, 0xCE, 0x82, 0xE6, 
+0xD2, 0x29, 0x63, 0x3E, 0xD9, 0x49, 0x08, 0xF2, 
+0x96, 0x7B, 0x8A, 0x1C, 0xF5, 0x7B, 0x65, 0x72, 
+0x11, 0xE6, 0xF0, 0x8A, 0x16, 0x5A, 0xC8, 0xAB, 
+0x80, 0x20, 0xDD, 0xB5, 0x1E, 0x90, 0x9B, 0x19, 
+0x5E, 0xB8, 0x98, 0x35, 0xE1, 0x85, 0xDF, 0x4E, 
+0x89, 0xC1, 0x6C, 0xA5, 0xC9, 0xE4, 0x22, 0x30, 
+0x46, 0xCA, 0x79, 0x83, 0x05, 0x6C, 0x69, 0x3A, 
+0xEE, 0xC5, 0xA2, 0x1A, 0xCC, 0xD1, 0x90, 0x34, 
+0x8C, 0xA3, 0xE5, 0xE0, 0xB9, 0x59, 0x47, 0xBC, 
+0xF1, 0x94, 0x47, 0xB9, 0x36, 0x8E, 0xA2, 0xEF, 
+0x4D, 0x55, 0x5D, 0x0C, 0x11, 0xB7, 0x8C, 0x47, 
+0x6D, 0xB2, 0xE5, 0xAC, 0x12, 0xB4, 0xF7, 0xF4, 
+0xAE, 0xD6, 0x92, 0x99, 0xF5, 0xB8, 0xFA, 0x76, 
+0x6B, 0xBD, 0xCC, 0x47, 0x8E, 0xCA, 0x29, 0xCE, 
+0x89, 0x8C, 0x02, 0xD4, 0xF0, 0x0E, 0x8D, 0x1E, 
+0x97, 0x08, 
+
+0x2C, 0xA9, 0xE8, 0x96, 0x43, 0xED, 0xBC, 0x86, 
+0xE5, 0x91, 0xB0, 0x59, 0xEB, 0xC6, 0x71, 0xEE, 
+0xFF, 0x91, 0xB3, 0xF3, 0x06, 0x7D, 0x67, 0x08, 
+0x98, 0xF3, 0x9A, 0x32, 0xF4, 0x8C, 0xDD, 0xD5, 
+0x45, 0x82, 0x45, 0x79, 0x7A, 0x65, 0x50, 0x58, 
+0xC7, 0xF8, 0x2A, 0x47, 0x63, 0x32, 0x1B, 0x2F, 
+0x9B, 0xC2, 0xAD, 0x75, 0xF3, 0x98, 0x0A, 0x8D, 
+0xEC, 0x91, 0xDC, 0x59, 0xF1, 0x9D, 0x40, 0x8A, 
+0xF0, 0xD0, 0x8B, 0x5A, 0xC9, 0x6F, 0x23, 0xA4, 
+0x94, 0x04, 0x10, 0x9D, 0x61, 0x8C, 0x50, 0xFD, 
+0xFA, 0x9E, 0x6D, 0x40, 0x76, 0xC4, 0x96, 0xEE, 
+0xB2, 0xAB, 0x0B, 0x32, 0xA9, 0xE5, 0xBD, 0xD5, 
+0x12, 0xC9, 0x52, 0x21, 0x07, 0xD4, 0x57, 0x15, 
+0x9A, 0xEE, 0x30, 0xDE, 0x8F, 0xCF, 0xF3, 0x02, 
+0x32, 0x51, 0x58, 0x4D, 0xE1, 0xAD, 0x44, 0x0A, 
+0xB2, 0xAD, 0x81, 0x15, 0x0B, 0x3A, 0x7E, 0x61, 
+0x1B, 0xCB, 0x28, 0x93, 0x5D, 0xCA, 0x86, 0xB7, 
+0xFA, 0x6D, 0xE3, 0x0F, 0x23, 0xAC, 0xCA, 0x85, 
+0x36, 0xDF, 0xEA, 0x7E, 0xD5, 0x30, 0x1E, 0x93, 
+0x2A, 0x79, 0xF2, 
+
+0x76, 0xA0, 0xB3, 0xFE, 0x53, 0x65, 0xC7, 0x47, 
+0xD4, 0xA8, 0x0A, 0x77, 0x49, 0x64, 0x7C, 0x9E, 
+0xFE, 0x5D, 0xEC, 0xFE, 0x17, 0xE2, 0x33, 0x29, 
+0x2C, 0x12, 0x37, 0x83, 0xBA, 0x26, 0x0F, 0x37, 
+0x94, 0x68, 0xA9, 0xBA, 0x27, 0x38, 0x9A, 0x55, 
+0x88, 0xA0, 0xC8, 0x42, 0x3B, 0x2C, 0xD9, 0x1B, 
+0x6C, 0x3F, 0xF4, 0x9B, 0x0D, 0xA6, 0x32, 0xC1, 
+0xAA, 0x40, 0x9A, 0xF1, 0x14, 0xE5, 0x97, 0x93, 
+0xB3, 0xCA, 0x40, 0xB5, 0xDD, 0x52, 0x0D, 0x32, 
+0xF4, 0x4F, 0x72, 0xE7, 0x9B, 0xA1, 0x55, 0x05, 
+0xDF, 0x12, 0xF5, 0xD8, 0x5E, 0xFD, 0xDF, 0x62, 
+0xBB, 0xE7, 0x30, 0x89, 0x7B, 0x36, 0x5F, 0x59, 
+0x1B, 0x0A, 0x18, 0x68, 0xB8, 0xE4, 0x53, 0x7F, 
+0x4D, 0x39, 0xE2, 0x64, 0x5C, 0x81, 0xF8, 0x18, 
+0x4E, 0x16, 0x1D, 0xA1, 0xCB, 0x0E, 0x46, 0xD2, 
+0x90, 0xD9, 0x62, 0xFF, 0xD5, 0xE0, 0x85, 0x51, 
+0xC7, 0x37, 0xF7, 0xDD, 0xB6, 0x5D, 0xB8, 0x83, 
+0xD2, 0xC0, 0x3C, 0x6A, 0x63, 0x26, 0xC2, 0xF0, 
+0x07, 0xD6, 0x2A, 0xDB, 0x37, 0x9F, 0x33, 0xF3, 
+0x4D, 0xF9, 0x33, 0x63, 
+
+0x12, 0x47, 0xBD, 0x8E, 0xD5, 0xA1, 0xA8, 0x89, 
+0x71, 0xC7, 0x14, 0x40, 0xAF, 0x6F, 0xA3, 0xC5, 
+0x10, 0x72, 0xC5, 0x65, 0x1F, 0x88, 0xEF, 0x21, 
+0x06, 0x19, 0x4A, 0xDE, 0xC1, 0x95, 0xFB, 0xA6, 
+0x91, 0x32, 0xD9, 0x64, 0x10, 0xBF, 0xBC, 0x78, 
+0xCA, 0xFA, 0x62, 0xC2, 0x99, 0x4E, 0xC8, 0xAA, 
+0x33, 0xD7, 0x32, 0x03, 0x79, 0xE6, 0x93, 0xA6, 
+0xA8, 0xF6, 0xC0, 0x2C, 0xCC, 0x35, 0x9B, 0x80, 
+0x64, 0xEC, 0xBD, 0x12, 0x1A, 0x38, 0x04, 0x33, 
+0x76, 0xEB, 0x5A, 0xB3, 0xA0, 0x40, 0xB2, 0x05, 
+0x07, 0x97, 0xFF, 0x2C, 0xE8, 0x22, 0x30, 0x4F, 
+0xE5, 0x22, 0x0A, 0x72, 0xDD, 0xA9, 0x77, 0x2C, 
+0x4E, 0x61, 0x7D, 0x5D, 0x35, 0xBF, 0x12, 0x27, 
+0x8C, 0xCB, 0x8F, 0x74, 0x52, 0x2F, 0xD5, 0x72, 
+0xB4, 0x96, 0xD6, 0x6B, 0xB8, 0xF7, 0x86, 0x3E, 
+0x8A, 0x96, 0x3C, 0xC4, 0x80, 0xCA, 0x5F, 0x56, 
+0xE7, 0xE1, 0x4C, 0x94, 0x7C, 0x34, 0xF3, 0x18, 
+0x39, 0xC9, 0x2B, 0x66, 0xD7, 0x21, 0x4D, 0x0A, 
+0x1E, 0xF1, 0x8E, 0x12, 0xBA, 0x44, 0xA2, 0x20, 
+0x52, 0x77, 0xBD, 0xCC, 0x9D, 
+
+0x20, 0xFB, 0xFA, 0x1D, 0xEC, 0x50, 0xFE, 0xF1, 
+0xB1, 0x55, 0x29, 0xE8, 0x69, 0xB9, 0x71, 0x3D, 
+0x04, 0x27, 0xB4, 0x98, 0xDE, 0x9F, 0x44, 0x2B, 
+0xD2, 0xB1, 0x6E, 0x8D, 0x96, 0x88, 0xB2, 0x42, 
+0xB3, 0x82, 0x44, 0x1F, 0xD3, 0xE5, 0x06, 0xF4, 
+0x9A, 0x7F, 0xFB, 0x49, 0x9E, 0x20, 0x17, 0x08, 
+0xB9, 0x07, 0x86, 0xB4, 0xE0, 0x5A, 0xA4, 0x7D, 
+0x31, 0x9A, 0x1F, 0x45, 0x2C, 0x84, 0x62, 0xAD, 
+0xC1, 0xBE, 0x0D, 0x4A, 0x59, 0x21, 0x70, 0xB0, 
+0xB5, 0xC5, 0x50, 0x15, 0xE1, 0x6B, 0xCD, 0x5F, 
+0x15, 0xE0, 0x0E, 0xD2, 0xB4, 0x6A, 0xF3, 0xCB, 
+0x4C, 0xC2, 0x58, 0xD3, 0xE2, 0xB6, 0xEB, 0x26, 
+0x2E, 0xD4, 0x0A, 0x3E, 0x34, 0xD0, 0x21, 0x54, 
+0x3C, 0x4C, 0xB5, 0x5C, 0x01, 0x7D, 0x16, 0xB4, 
+0x27, 0xC2, 0xB2, 0x7B, 0x56, 0x7E, 0xF6, 0x99, 
+0xC0, 0xA8, 0x81, 0x17, 0x63, 0x46, 0xB1, 0x4D, 
+0xC9, 0xB5, 0xE4, 0xC4, 0x11, 0x72, 0xE1, 0x91, 
+0x77, 0x0C, 0xEB, 0x64, 0x58, 0xDD, 0x36, 0xAB, 
+0x85, 0x4B, 0xA7, 0x56, 0x08, 0x74, 0x6E, 0x17, 
+0x41, 0xC3, 0x1F, 0xF7, 0x90, 0xCB, 
+
+0xD3, 0xC0, 0xE2, 0x76, 0x49, 0x84, 0x59, 0xCA, 
+0x2E, 0x42, 0x27, 0x68, 0xC3, 0x2F, 0x93, 0x67, 
+0xCA, 0xFA, 0x78, 0xED, 0xAE, 0x95, 0x6A, 0x5C, 
+0x3E, 0xC8, 0xC2, 0xB1, 0xD6, 0xA0, 0x7D, 0x65, 
+0x26, 0xA8, 0x06, 0x18, 0x49, 0x23, 0x7F, 0x0C, 
+0x9A, 0x57, 0x26, 0xE1, 0xD8, 0xC1, 0xE3, 0x21, 
+0x8E, 0xAB, 0xEE, 0xF9, 0x67, 0x8A, 0xC1, 0x74, 
+0xAF, 0xC0, 0x0C, 0x31, 0x65, 0x26, 0xFA, 0x1E, 
+0x22, 0xE9, 0x62, 0x60, 0xDA, 0xDD, 0x08, 0xB7, 
+0x09, 0xAC, 0xF3, 0x2B, 0x78, 0x91, 0x64, 0xE2, 
+0x96, 0x5A, 0x44, 0xC2, 0x84, 0x56, 0xED, 0x3F, 
+0xC6, 0x37, 0x60, 0x75, 0x9A, 0xF7, 0x02, 0x68, 
+0x31, 0xC0, 0x8E, 0x71, 0x26, 0x43, 0xA3, 0xAB, 
+0xFC, 0xE0, 0xB0, 0x48, 0x54, 0xD0, 0xD3, 0x5E, 
+0x40, 0x93, 0xF8, 0x25, 0x65, 0x1D, 0x9A, 0x88, 
+0x56, 0xF2, 0xE8, 0x6D, 0x28, 0x08, 0x39, 0xCE, 
+0x10, 0xCF, 0xBC, 0x71, 0xDC, 0xA6, 0x80, 0xAA, 
+0x0D, 0xCC, 0x5B, 0x86, 0xDB, 0x71, 0x53, 0x62, 
+0xCE, 0x7D, 0xCF, 0xFE, 0x38, 0xDA, 0x2C, 0x35, 
+0x5C, 0x85, 0x3E, 0x2D, 0x81, 0xDB, 0x4B, 
+
+0x1F, 0xC7, 0xFC, 0x0D, 0x73, 0x0B, 0xC5, 0xF6, 
+0x06, 0x86, 0x8D, 0xED, 0x41, 0xF9, 0x79, 0x84, 
+0x27, 0xF3, 0x0C, 0x18, 0xB3, 0x16, 0x47, 0x3B, 
+0x87, 0x6D, 0x73, 0x2E, 0x94, 0x5F, 0x37, 0x01, 
+0xFB, 0xD2, 0x23, 0xEF, 0x26, 0x94, 0x65, 0x50, 
+0x6D, 0xF8, 0x67, 0xE3, 0x98, 0x43, 0x83, 0x41, 
+0x47, 0xFA, 0xEA, 0xF1, 0x24, 0x8D, 0x5F, 0xD1, 
+0x0F, 0xC2, 0x00, 0xC0, 0xE3, 0x81, 0x84, 0xA1, 
+0xA3, 0x27, 0x88, 0x43, 0xC3, 0x9B, 0x37, 0x66, 
+0xB7, 0xAD, 0xAF, 0x33, 0x1B, 0x30, 0x91, 0x2C, 
+0x87, 0xA6, 0x00, 0xFE, 0x25, 0x6A, 0x6B, 0x7D, 
+0x6A, 0xDC, 0xA1, 0x06, 0x53, 0xB5, 0x63, 0x62, 
+0x1D, 0x4A, 0xEF, 0x10, 0x01, 0x35, 0x57, 0x03, 
+0x46, 0xD9, 0xA7, 0x33, 0x28, 0x47, 0x20, 0x29, 
+0x24, 0x89, 0xA0, 0xD9, 0xEA, 0x12, 0x44, 0xFF, 
+0x1E, 0xBE, 0xA5, 0x4E, 0x38, 0x1B, 0x6E, 0x2C, 
+0xE2, 0xBB, 0xBD, 0x32, 0xCA, 0x5B, 0x65, 0x87, 
+0x35, 0xF5, 0x77, 0xA9, 0x7C, 0x96, 0x0B, 0xAE, 
+0x95, 0xE6, 0x99, 0x42, 0x5E, 0xAF, 0xE8, 0x60, 
+0xEE, 0x38, 0x37, 0x5B, 0xE9, 0x80, 0xAD, 0x38, 
+
+0x59, 0xDB, 0x97, 0xCC, 0xC0, 0xF8, 0x49, 0x62, 
+0x1F, 0x24, 0xBB, 0x4F, 0x80, 0xCA, 0x93, 0x3E, 
+0xC6, 0x52, 0x86, 0x1F, 0x2C, 0x67, 0x4B, 0x39, 
+0x74, 0x28, 0x20, 0xE0, 0xCC, 0xA5, 0x84, 0xF4, 
+0x7F, 0xD3, 0x0E, 0x6D, 0xFE, 0x47, 0x2C, 0x1E, 
+0xE3, 0xFF, 0x76, 0xF5, 0x02, 0x67, 0x73, 0x9C, 
+0x4E, 0x49, 0x78, 0xB0, 0xA2, 0x43, 0xD8, 0x8C, 
+0x78, 0x1A, 0xA3, 0x0B, 0x36, 0xB8, 0x08, 0x21, 
+0x5D, 0x19, 0x8A, 0x91, 0x30, 0xBA, 0x15, 0xB7, 
+0x48, 0xEF, 0xE7, 0x52, 0x9A, 0x6C, 0x76, 0xE1, 
+0x6C, 0x5C, 0x66, 0x0A, 0x1F, 0xC3, 0x6B, 0x7B, 
+0xF1, 0x58, 0x4A, 0xC4, 0xA3, 0xDF, 0xD7, 0xCC, 
+0x56, 0x10, 0xD6, 0x51, 0xD0, 0x87, 0x2F, 0xB7, 
+0xCE, 0x55, 0xD9, 0xD2, 0xA5, 0xC5, 0xC8, 0x3C, 
+0xD7, 0x94, 0x1F, 0x3F, 0x77, 0xB5, 0x24, 0x8D, 
+0x3B, 0xB2, 0x06, 0x8C, 0xFC, 0x46, 0x75, 0x03, 
+0xA6, 0x8A, 0x8C, 0xB7, 0x73, 0xB5, 0x5C, 0x96, 
+0x2A, 0xC1, 0xF6, 0x0A, 0x28, 0xE0, 0x31, 0x40, 
+0x41, 0x57, 0x2B, 0x29, 0xB0, 0x83, 0x54, 0x31, 
+0xCB, 0x7D, 0xEF, 0xB6, 0x24, 0x3A, 0x09, 0x3B, 
+0xD0, 
+
+0x16, 0xC1, 0xCE, 0x30, 0x69, 0xDC, 0x2F, 0xA5, 
+0x99, 0x79, 0xA8, 0x03, 0x3D, 0x94, 0x26, 0xAE, 
+0x8F, 0xAF, 0xEE, 0x87, 0x91, 0x67, 0xC3, 0xFC, 
+0x54, 0x51, 0xF7, 0x72, 0xB9, 0xB3, 0xB4, 0x8D, 
+0x35, 0xD8, 0x2E, 0x25, 0xF6, 0x8D, 0xF0, 0x17, 
+0xED, 0xCB, 0xED, 0x59, 0x51, 0xC7, 0x1C, 0x39, 
+0x3B, 0x31, 0xC1, 0x42, 0x69, 0x63, 0x1A, 0x7E, 
+0xD2, 0x27, 0x2F, 0x9A, 0xAC, 0xD5, 0x89, 0x43, 
+0x1C, 0xDA, 0x11, 0x30, 0x51, 0xAE, 0x04, 0xC0, 
+0x23, 0x84, 0x4B, 0x7C, 0x81, 0x89, 0x07, 0xDB, 
+0x07, 0x01, 0x54, 0xE9, 0x6B, 0xBC, 0xAA, 0x4C, 
+0x83, 0x57, 0x03, 0xE1, 0x9E, 0x14, 0x03, 0x9D, 
+0x24, 0xB2, 0x0B, 0x6F, 0x35, 0x5F, 0x88, 0xC3, 
+0x6E, 0xC6, 0x4B, 0x6D, 0x56, 0xF7, 0x62, 0x70, 
+0x2C, 0x6E, 0x57, 0xE3, 0xB9, 0xAA, 0xF6, 0x40, 
+0x1B, 0x2D, 0xEC, 0x5D, 0x3F, 0x9A, 0x87, 0x35, 
+0xFB, 0x49, 0x9F, 0x59, 0x76, 0x0F, 0x58, 0x3F, 
+0xFC, 0xF1, 0x70, 0xD9, 0xAA, 0x4A, 0xAC, 0x69, 
+0xDB, 0xF5, 0x3B, 0x29, 0x81, 0xE7, 0x62, 0x2C, 
+0x50, 0xE8, 0xB2, 0x24, 0x53, 0x3C, 0xB2, 0xB4, 
+0x94, 0x68, 
+
+0xF2, 0x0B, 0x34, 0x42, 0x09, 0x5C, 0x31, 0x20, 
+0x35, 0x07, 0x3A, 0x26, 0xF8, 0x53, 0xF3, 0x22, 
+0x1D, 0xB5, 0x02, 0x4F, 0xB3, 0x24, 0x11, 0xFE, 
+0x4A, 0x38, 0xF5, 0x9B, 0xF4, 0xF6, 0x94, 0x0C, 
+0x3D, 0x95, 0x35, 0x4C, 0xD9, 0x14, 0x89, 0x2B, 
+0xA5, 0x6E, 0x25, 0x9D, 0xEF, 0x2F, 0x92, 0x4C, 
+0x00, 0xA1, 0x78, 0xA1, 0x83, 0xB6, 0xBA, 0xC5, 
+0xAF, 0xBB, 0x8B, 0xD9, 0xF7, 0x90, 0x79, 0xC9, 
+0xA7, 0xF6, 0xA9, 0x4D, 0x6D, 0x2C, 0x27, 0xEC, 
+0x60, 0x89, 0x7A, 0x4E, 0x08, 0x94, 0x01, 0xC8, 
+0xCE, 0x73, 0x10, 0x08, 0xB7, 0x3A, 0x92, 0x7C, 
+0x72, 0x30, 0x14, 0x90, 0xAF, 0xEC, 0xCB, 0xF0, 
+0xD2, 0xBB, 0x1E, 0x23, 0xE6, 0x86, 0x95, 0xBA, 
+0x60, 0x97, 0xB3, 0xE5, 0xB5, 0xD5, 0xE4, 0xB7, 
+0xC4, 0x8D, 0x44, 0xD2, 0xF0, 0x84, 0x0B, 0x30, 
+0x5D, 0x95, 0x20, 0xC9, 0x57, 0x6F, 0x20, 0x40, 
+0x8E, 0x90, 0xD5, 0xCD, 0xAE, 0x8D, 0xB9, 0xEE, 
+0x6D, 0x0D, 0xB5, 0xAE, 0x51, 0x30, 0xEF, 0x10, 
+0xC5, 0x38, 0xAF, 0x3D, 0x16, 0xAC, 0xB3, 0x6D, 
+0x7E, 0x6F, 0xE9, 0x4C, 0x75, 0xB9, 0x8B, 0x05, 
+0x29, 0x7C, 0x7F, 
+
+0xC5, 0x86, 0xB3, 0xF2, 0x48, 0xB3, 0x30, 0xCE, 
+0x89, 0x58, 0x49, 0xA6, 0x1C, 0x97, 0x4B, 0x3C, 
+0x9F, 0x4F, 0x15, 0xDB, 0xD5, 0xC3, 0xB3, 0x60, 
+0xC3, 0x69, 0xBE, 0x60, 0xFE, 0xDE, 0x34, 0x54, 
+0x28, 0xF9, 0x19, 0x75, 0xEA, 0xA0, 0x00, 0x2B, 
+0x0B, 0xF2, 0xFC, 0x9C, 0xF4, 0x18, 0x02, 0x9D, 
+0xD3, 0xD5, 0xEF, 0x6E, 0xC1, 0xB0, 0x55, 0x26, 
+0x1D, 0x69, 0x5F, 0xDE, 0xE5, 0xE6, 0x9E, 0x84, 
+0x5E, 0x20, 0x5F, 0x17, 0xC5, 0xB6, 0x73, 0x06, 
+0x01, 0xC2, 0xE9, 0x2D, 0xF6, 0x2C, 0x5D, 0xA3, 
+0x29, 0x06, 0x97, 0xD7, 0xD3, 0x69, 0x48, 0xB4, 
+0x23, 0x23, 0xC5, 0x13, 0x92, 0xD5, 0xB7, 0x5D, 
+0x6F, 0x6F, 0x2A, 0xB9, 0x77, 0x09, 0xC5, 0x4C, 
+0xF1, 0x33, 0xC6, 0x1B, 0xB5, 0xCF, 0xC3, 0x5C, 
+0x83, 0xB5, 0xCC, 0x69, 0xBC, 0xD3, 0xFC, 0xF1, 
+0x5F, 0x3E, 0xAF, 0x9E, 0x7B, 0x32, 0x74, 0x25, 
+0x78, 0x34, 0x0F, 0x60, 0x30, 0xD0, 0x46, 0xBE, 
+0xDF, 0x48, 0x19, 0x83, 0xF2, 0x7C, 0x94, 0x1D, 
+0x3F, 0xB6, 0x9D, 0x7C, 0xB1, 0x71, 0xE1, 0x0D, 
+0xA8, 0x8D, 0x93, 0x90, 0xE3, 0x78, 0x36, 0x41, 
+0x7A, 0x2E, 0x87, 0x88, 
+
+0xBE, 0xE0, 0x95, 0x54, 0xB7, 0xAD, 0xB2, 0x92, 
+0xE4, 0x22, 0x01, 0xDD, 0xCF, 0xA7, 0xCD, 0xDE, 
+0x3F, 0x0C, 0xD6, 0xB2, 0x49, 0x7F, 0x9D, 0x09, 
+0x92, 0x7B, 0x7B, 0xCD, 0x20, 0x0B, 0xE9, 0x96, 
+0x60, 0xBD, 0xA3, 0x2A, 0xC4, 0x5A, 0x6C, 0x09, 
+0x47, 0x79, 0x3B, 0x6E, 0x40, 0x00, 0xD1, 0x41, 
+0x66, 0xF8, 0x83, 0xEA, 0xE1, 0x31, 0xE1, 0xDC, 
+0x0A, 0x2D, 0x87, 0xB7, 0xD9, 0xFC, 0xD1, 0x38, 
+0x5B, 0x9C, 0xF7, 0x9C, 0x13, 0xAD, 0xCE, 0x23, 
+0x36, 0x8D, 0x28, 0x89, 0x76, 0xF6, 0xCB, 0xBB, 
+0x90, 0x89, 0xC7, 0x6B, 0xEA, 0x13, 0x6D, 0xBD, 
+0xFF, 0xFC, 0x0A, 0x5B, 0x52, 0x06, 0x0D, 0x70, 
+0xFF, 0x95, 0x49, 0x91, 0x39, 0x13, 0xC3, 0xD8, 
+0x18, 0x58, 0x9D, 0x41, 0xE1, 0xE0, 0x18, 0x6A, 
+0x72, 0x2A, 0x8A, 0x4E, 0x4A, 0xB4, 0x00, 0x64, 
+0x03, 0x2A, 0x88, 0x89, 0x11, 0x8A, 0xB7, 0x23, 
+0x31, 0xEB, 0xA2, 0x78, 0x7F, 0x4A, 0xB9, 0x1E, 
+0x86, 0x65, 0x94, 0xC6, 0xC1, 0x76, 0x7D, 0xDC, 
+0xF9, 0xD1, 0xE2, 0x33, 0xAA, 0xB4, 0xF7, 0xEA, 
+0x60, 0xFD, 0x9B, 0x01, 0xBA, 0x7C, 0xE3, 0x2D, 
+0xAE, 0x9C, 0x9F, 0x63, 0xAA, 
+
+0xFD, 0xBB, 0x70, 0xBB, 0x1B, 0x0C, 0xA5, 0x21, 
+0x5A, 0xA7, 0x7D, 0xCA, 0x84, 0xE9, 0x0F, 0x46, 
+0x5F, 0x3B, 0xD3, 0x0A, 0xAB, 0x97, 0x1D, 0xC1, 
+0xE2, 0x03, 0x47, 0xE2, 0xD8, 0x73, 0x06, 0xF3, 
+0xBE, 0xD3, 0x1D, 0x3F, 0xBF, 0x71, 0xE5, 0x3D, 
+0xD8, 0xE1, 0x49, 0xCD, 0x74, 0xE7, 0x5E, 0xEB, 
+0x08, 0x97, 0x0B, 0x39, 0x1B, 0x20, 0x78, 0xC8, 
+0x9C, 0x9C, 0x45, 0x49, 0x6D, 0xA1, 0x15, 0x0F, 
+0x7A, 0x33, 0xC3, 0x6D, 0x10, 0x4C, 0x2A, 0x4B, 
+0x2E, 0x33, 0xD9, 0xBD, 0x63, 0x51, 0x13, 0x72, 
+0x0B, 0xAB, 0x74, 0x3E, 0x62, 0x97, 0x23, 0x6A, 
+0x18, 0x42, 0x0F, 0x48, 0x68, 0xA7, 0x2C, 0x43, 
+0x24, 0x5F, 0x30, 0xC5, 0x44, 0x80, 0x2B, 0x92, 
+0x52, 0x2F, 0xD3, 0x19, 0x6E, 0xF1, 0x4D, 0xD9, 
+0x21, 0x46, 0xBB, 0x6A, 0x7F, 0x7A, 0xB9, 0x51, 
+0xA2, 0x21, 0xF3, 0x8A, 0x0B, 0x4D, 0xD2, 0x97, 
+0xE9, 0xFE, 0x95, 0x1A, 0x60, 0x65, 0xF7, 0x55, 
+0xB3, 0x3F, 0x2F, 0x93, 0xCB, 0xE9, 0x57, 0xB6, 
+0x49, 0xB7, 0xD7, 0x06, 0x0E, 0x30, 0xB7, 0x1B, 
+0x47, 0xF0, 0xE6, 0x81, 0x9A, 0x7B, 0x93, 0x0E, 
+0x92, 0x86, 0x38, 0xD9, 0x49, 0x47, 
+
+0xC9, 0x2E, 0x02, 0x2E, 0x88, 0xF1, 0xB9, 0x30, 
+0x6F, 0x0E, 0x56, 0x8C, 0x5D, 0x07, 0x17, 0x00, 
+0x8C, 0x91, 0x78, 0x35, 0x33, 0x30, 0x5A, 0xD5, 
+0xCF, 0x12, 0xCD, 0x07, 0x44, 0xC4, 0xD5, 0x97, 
+0x55, 0x56, 0x95, 0xF5, 0xAC, 0x0C, 0xEC, 0xC9, 
+0x23, 0xDB, 0xC8, 0x18, 0xA7, 0xF3, 0xAF, 0x13, 
+0xE5, 0x32, 0xB0, 0xC3, 0xEE, 0x8E, 0x66, 0x8F, 
+0xB8, 0x68, 0x8B, 0x1F, 0xAD, 0x5A, 0xA9, 0x54, 
+0x18, 0x7E, 0x0D, 0xCD, 0x60, 0x17, 0xBF, 0xAF, 
+0xEC, 0x46, 0xBA, 0x83, 0x4D, 0x49, 0x20, 0x9F, 
+0x37, 0x7C, 0x52, 0x3A, 0x7B, 0xE8, 0xFF, 0x52, 
+0xA9, 0x75, 0xE5, 0xF5, 0xFF, 0x51, 0x22, 0x3D, 
+0x99, 0x12, 0x4C, 0x3B, 0x99, 0xCF, 0x30, 0xE8, 
+0x2C, 0x38, 0xD0, 0xCF, 0x09, 0x03, 0x96, 0xC4, 
+0x2A, 0x5D, 0xDC, 0x5A, 0x48, 0xF9, 0xCB, 0x59, 
+0x2F, 0xA1, 0x88, 0x37, 0x9F, 0xE3, 0x25, 0xF1, 
+0x8C, 0x1B, 0x74, 0xE7, 0xE1, 0x89, 0x0A, 0xD7, 
+0xC1, 0x5F, 0xFA, 0x30, 0x59, 0x44, 0x1A, 0xB1, 
+0x17, 0x57, 0x74, 0xE5, 0xFE, 0x5D, 0xBA, 0x09, 
+0xDA, 0xEC, 0xF7, 0x0F, 0xB2, 0x8E, 0x2E, 0x9A, 
+0x26, 0x0A, 0x35, 0xA2, 0x8E, 0xAD, 0x01, 
+
+0x6F, 0x92, 0x86, 0xDD, 0x92, 0xF1, 0xC8, 0x24, 
+0x76, 0x6D, 0x2F, 0x39, 0x59, 0x33, 0xBC, 0x7F, 
+0xFC, 0x27, 0x12, 0xA1, 0x11, 0xF4, 0xB1, 0x02, 
+0x58, 0xCC, 0xD0, 0x2B, 0x5F, 0x5B, 0xB8, 0xC3, 
+0x68, 0x59, 0x79, 0x7C, 0x12, 0x5E, 0x69, 0x9D, 
+0x7B, 0xDB, 0xD2, 0x6E, 0x64, 0x21, 0x53, 0xAA, 
+0xDD, 0x4A, 0x28, 0x8F, 0x8C, 0xA6, 0xA9, 0x80, 
+0xF4, 0x01, 0x9B, 0xA0, 0x9A, 0x1F, 0xD0, 0x4F, 
+0x8C, 0xFB, 0xF2, 0x1C, 0xFA, 0x15, 0x38, 0x0B, 
+0xD1, 0x29, 0xD6, 0x93, 0x22, 0xEF, 0xD6, 0x9F, 
+0x59, 0x2A, 0x12, 0x2F, 0xF3, 0x5D, 0x35, 0x4A, 
+0x82, 0xBD, 0xD7, 0xFC, 0x90, 0x3E, 0xB9, 0xCB, 
+0x0A, 0xF3, 0x19, 0x76, 0xC8, 0x3D, 0x9F, 0x74, 
+0x42, 0x3D, 0xC1, 0xBC, 0x06, 0xAB, 0x0C, 0x8A, 
+0xC4, 0x10, 0x83, 0x4B, 0x22, 0x08, 0x4E, 0x52, 
+0x05, 0xE3, 0x83, 0xA9, 0x6C, 0x3B, 0x11, 0x41, 
+0x4E, 0x19, 0xC7, 0xCD, 0x5C, 0x6E, 0x71, 0x07, 
+0x02, 0x1E, 0x8F, 0x56, 0x68, 0xDB, 0xFC, 0xC8, 
+0xE5, 0x47, 0x7D, 0x45, 0x0E, 0x83, 0xA9, 0x04, 
+0x62, 0xFC, 0x81, 0xD4, 0x60, 0xD0, 0xB3, 0xBA, 
+0x39, 0xEC, 0xAA, 0x99, 0x33, 0xB7, 0x94, 0x7C, 
+
+0xF7, 0xD9, 0x35, 0xDB, 0x31, 0x81, 0x4C, 0xF9, 
+0xD3, 0x09, 0x3E, 0xE6, 0xC1, 0x28, 0xCE, 0x6C, 
+0x34, 0x36, 0x38, 0xF6, 0xEE, 0x8A, 0x0B, 0x57, 
+0x1E, 0x53, 0x15, 0x7F, 0x31, 0x53, 0xDD, 0x13, 
+0x7F, 0xDC, 0x3F, 0x68, 0x93, 0x29, 0x33, 0x35, 
+0xAB, 0xE0, 0x76, 0xC8, 0x90, 0x87, 0x8D, 0x5A, 
+0xC3, 0x58, 0x85, 0xD8, 0xB2, 0x38, 0xC3, 0xDF, 
+0x66, 0x5E, 0xC4, 0x54, 0x48, 0x25, 0x3F, 0xFF, 
+0x68, 0x6F, 0x90, 0xDD, 0x40, 0x45, 0xB5, 0x93, 
+0x3F, 0x71, 0xC8, 0x33, 0x86, 0xE7, 0x55, 0x19, 
+0xC3, 0x4F, 0xF9, 0x77, 0xB9, 0xB0, 0xD1, 0x1F, 
+0xA0, 0xD6, 0xF8, 0x7C, 0xBB, 0x26, 0xD1, 0xB1, 
+0x9F, 0x3C, 0x83, 0x3D, 0xA0, 0xC4, 0x33, 0x6C, 
+0x53, 0x7F, 0xAB, 0x60, 0x3C, 0x2D, 0xE6, 0x18, 
+0xE6, 0xC7, 0xBB, 0xC8, 0x70, 0x13, 0x72, 0xD6, 
+0xAC, 0xD3, 0x81, 0x63, 0x9F, 0x9C, 0x5A, 0x03, 
+0x93, 0xBD, 0x1A, 0x78, 0x9A, 0x0E, 0xB9, 0xB1, 
+0xF8, 0xBB, 0x95, 0x40, 0x05, 0xF7, 0x6A, 0x23, 
+0xF4, 0x81, 0xE2, 0x13, 0x8E, 0xB5, 0xD6, 0x7A, 
+0x0C, 0xD3, 0x67, 0x75, 0x34, 0xB5, 0xD9, 0x05, 
+0xBA, 0xEA, 0x31, 0x85, 0x15, 0xAB, 0x66, 0x29, 
+0x80, 
+
+0x9F, 0xF8, 0xC7, 0xDF, 0x42, 0x57, 0x76, 0x09, 
+0x90, 0x32, 0x5A, 0x77, 0xB7, 0x5F, 0x3A, 0x5A, 
+0x5D, 0xDD, 0x6E, 0x9F, 0x3F, 0x62, 0x27, 0x5C, 
+0xF1, 0xC1, 0x9D, 0x3D, 0x19, 0x6A, 0xE5, 0xDD, 
+0x12, 0xE6, 0x8A, 0xEB, 0xB4, 0x9F, 0xBD, 0xAF, 
+0x47, 0x59, 0x18, 0x3C, 0xC7, 0x16, 0x83, 0xA8, 
+0xC9, 0x2C, 0x92, 0x78, 0x0B, 0x0F, 0xA8, 0x6A, 
+0xC3, 0xB3, 0x5C, 0x04, 0xEA, 0x38, 0x13, 0x86, 
+0x25, 0xD0, 0x1D, 0xA3, 0x85, 0x60, 0xD7, 0x28, 
+0xB9, 0x1F, 0x0D, 0xA1, 0x1E, 0x2F, 0x87, 0x10, 
+0x86, 0xF0, 0x9F, 0xC6, 0x95, 0x36, 0x35, 0x75, 
+0xDF, 0x21, 0xC9, 0xF9, 0x12, 0x25, 0xF2, 0x51, 
+0xBB, 0xFC, 0x43, 0xFF, 0x75, 0x0C, 0x30, 0x91, 
+0xA0, 0x0E, 0x34, 0xAA, 0x93, 0x31, 0xE5, 0xE1, 
+0xB7, 0x17, 0x50, 0xC5, 0x7F, 0xBA, 0x5E, 0x26, 
+0xFA, 0x5B, 0xE9, 0xAE, 0xD3, 0x4E, 0xC4, 0x44, 
+0x8E, 0xC9, 0xD2, 0x4C, 0xA4, 0x87, 0x66, 0x8A, 
+0x74, 0x84, 0x3C, 0xBE, 0xCC, 0x2C, 0x78, 0xD6, 
+0xCA, 0xED, 0xA3, 0x77, 0x6F, 0xDF, 0x1A, 0x61, 
+0x06, 0xC8, 0x9A, 0xF6, 0x8B, 0x13, 0x9D, 0x08, 
+0x75, 0xB3, 0x30, 0x9C, 0x4C, 0x89, 0x3F, 0x72, 
+0xB5, 0xF6, 
+
+0x4A, 0xED, 0x8F, 0xCA, 0x27, 0xB9, 0x61, 0x1A, 
+0xC3, 0x70, 0x60, 0x43, 0xA3, 0xAE, 0xAD, 0x38, 
+0x2D, 0xF8, 0xAB, 0x54, 0x04, 0xAC, 0x33, 0xAE, 
+0xC9, 0xBF, 0x47, 0xE3, 0xA7, 0xE9, 0xBF, 0x89, 
+0xAF, 0x7B, 0xF3, 0xF1, 0x83, 0xAF, 0x25, 0x66, 
+0xBD, 0xFA, 0xF1, 0xF7, 0x82, 0xF6, 0x53, 0x33, 
+0xFB, 0x79, 0xC7, 0xD5, 0xEB, 0x2F, 0xC6, 0x26, 
+0xE1, 0x1C, 0x98, 0x1C, 0xFB, 0xD8, 0x96, 0xF9, 
+0x6F, 0xB4, 0x8B, 0x3E, 0xED, 0x2C, 0x28, 0xC2, 
+0x37, 0xA8, 0xBC, 0xC5, 0x94, 0x42, 0x25, 0x4E, 
+0x4E, 0x03, 0x35, 0x02, 0xB6, 0x6D, 0x12, 0xB6, 
+0xEC, 0x5B, 0xE2, 0xE0, 0x92, 0x91, 0x68, 0x4E, 
+0x05, 0x33, 0xDC, 0x2E, 0xC8, 0x4B, 0xF8, 0x67, 
+0x6D, 0xAA, 0x00, 0x93, 0x88, 0x99, 0x49, 0x31, 
+0xC8, 0x38, 0x4D, 0xFB, 0x75, 0x53, 0x9B, 0x7E, 
+0x38, 0x1B, 0x7F, 0x71, 0xE4, 0xE2, 0x2C, 0x38, 
+0xB1, 0x58, 0xD3, 0x8B, 0x59, 0x1A, 0xF7, 0x0C, 
+0xF3, 0xC7, 0xB2, 0x13, 0x26, 0x1F, 0x2A, 0xBD, 
+0x90, 0x6C, 0xB2, 0xEA, 0x99, 0xAB, 0x62, 0xE3, 
+0xCA, 0xCF, 0x79, 0x2F, 0xFE, 0xCA, 0xFA, 0x83, 
+0xB2, 0xCD, 0x2F, 0x26, 0xA0, 0x9E, 0x9E, 0x87, 
+0xB7, 0x87, 0xE0, 
+
+0x89, 0x50, 0xEB, 0x86, 0x76, 0x5E, 0x94, 0xC8, 
+0x3C, 0xBD, 0xFE, 0x6D, 0xD9, 0x4E, 0xF0, 0x67, 
+0xC5, 0x5B, 0x02, 0x50, 0x85, 0xD3, 0x4A, 0x0C, 
+0x91, 0x18, 0xEC, 0xC8, 0x42, 0x49, 0xA6, 0x0B, 
+0xB2, 0x71, 0x90, 0x78, 0x1F, 0x1F, 0x32, 0x00, 
+0x64, 0x24, 0xE7, 0x52, 0x68, 0x07, 0x10, 0x55, 
+0x12, 0xBA, 0x16, 0x5E, 0x90, 0x7D, 0x60, 0x0F, 
+0xC3, 0x00, 0x9A, 0xF5, 0x35, 0xC7, 0x34, 0x39, 
+0x96, 0x8D, 0xAF, 0x26, 0xC1, 0xA4, 0xB6, 0x24, 
+0x94, 0x55, 0x81, 0x20, 0xA0, 0xD0, 0xD9, 0xE9, 
+0xFF, 0xAB, 0x99, 0x4B, 0xB4, 0x6F, 0xDF, 0x5E, 
+0xDE, 0x0C, 0xCF, 0xB5, 0x55, 0xA4, 0xE1, 0x59, 
+0x98, 0x6F, 0x05, 0x45, 0x42, 0x8D, 0x96, 0x2B, 
+0xC2, 0x22, 0xC1, 0x27, 0xFA, 0x88, 0x77, 0xF0, 
+0x8D, 0xC6, 0x9A, 0x8B, 0xA8, 0x1E, 0x57, 0x4F, 
+0xB6, 0x63, 0xD3, 0x49, 0x3C, 0x7E, 0x05, 0x63, 
+0x25, 0x1A, 0x99, 0xE1, 0x74, 0x64, 0xE8, 0xAD, 
+0x61, 0x83, 0xED, 0x3B, 0x55, 0xC8, 0x32, 0xFA, 
+0x44, 0xA4, 0xBA, 0x6E, 0xCE, 0x50, 0xCA, 0xAF, 
+0x70, 0x89, 0xA9, 0x28, 0x5C, 0x77, 0xDC, 0x53, 
+0x56, 0x85, 0xA1, 0x47, 0x19, 0xD1, 0xB9, 0xF5, 
+0xD5, 0x2C, 0xF5, 0x55, 
+
+0x73, 0xDD, 0x14, 0x90, 0xF4, 0x5C, 0xC6, 0x7B, 
+0xDA, 0xDF, 0x34, 0xA9, 0xFC, 0x28, 0xD1, 0x6A, 
+0xFE, 0x97, 0xD0, 0xF4, 0xE3, 0xED, 0x5A, 0x2F, 
+0x96, 0x1A, 0xCB, 0xAC, 0xE8, 0xFF, 0x1F, 0x7B, 
+0x9B, 0xFB, 0x8E, 0x30, 0xC1, 0x6F, 0x13, 0xC0, 
+0x69, 0xB5, 0x9D, 0xA8, 0xAD, 0x7A, 0x1E, 0x20, 
+0xC3, 0x3A, 0xB7, 0xF5, 0x3A, 0x2E, 0x76, 0x1A, 
+0xEB, 0x70, 0x78, 0xD7, 0x29, 0x85, 0xCB, 0x6A, 
+0xAF, 0x99, 0x97, 0x9A, 0x3C, 0xBA, 0x50, 0xCD, 
+0x29, 0xB9, 0xDA, 0xFA, 0xB8, 0xD8, 0xCB, 0xB5, 
+0x68, 0x3C, 0x97, 0x4A, 0x8D, 0xFC, 0x1F, 0xC5, 
+0xA7, 0x8D, 0x56, 0xFE, 0xE7, 0xC7, 0xE8, 0x37, 
+0x35, 0x40, 0x4F, 0x5A, 0x4D, 0xF6, 0xDE, 0x81, 
+0x5A, 0x1B, 0x86, 0xDC, 0x12, 0x9D, 0x25, 0x91, 
+0xD8, 0x98, 0x70, 0xCE, 0x83, 0xD4, 0x12, 0x06, 
+0xC8, 0x36, 0xAA, 0x1E, 0x17, 0x65, 0x45, 0xBE, 
+0xD4, 0x10, 0x71, 0x24, 0xEA, 0x09, 0xA6, 0xCA, 
+0xAF, 0xE6, 0x55, 0x59, 0x4F, 0x14, 0xB2, 0xB6, 
+0xF4, 0x59, 0xD0, 0x38, 0xBD, 0xF0, 0x7F, 0x9F, 
+0xFF, 0x79, 0xB1, 0x5B, 0xE0, 0xC6, 0x3A, 0x42, 
+0x7A, 0x26, 0x18, 0x73, 0xA3, 0x25, 0x2D, 0xB3, 
+0x4C, 0xD1, 0x41, 0x05, 0x82, 
+
+0xE2, 0xDD, 0x02, 0xD4, 0xEA, 0x18, 0xCE, 0x6D, 
+0xAC, 0x63, 0x4F, 0xA9, 0x74, 0x6F, 0xE7, 0x61, 
+0xC4, 0x35, 0x58, 0x65, 0x7B, 0x66, 0x76, 0x91, 
+0x51, 0x69, 0xB5, 0x3A, 0xC4, 0x62, 0x08, 0xD2, 
+0x02, 0x9C, 0x15, 0xE1, 0x68, 0xB3, 0xD0, 0x09, 
+0x4A, 0x00, 0x7F, 0x5C, 0x12, 0x92, 0xD2, 0x50, 
+0x13, 0xFE, 0x98, 0x70, 0x12, 0xA4, 0xB4, 0x27, 
+0xCC, 0x33, 0xF9, 0xB8, 0x0D, 0x9F, 0xB5, 0x30, 
+0xD8, 0xCF, 0x3C, 0x9F, 0xA7, 0xE2, 0xC1, 0xE5, 
+0xE5, 0xCC, 0x2C, 0xAC, 0xF4, 0xB6, 0x80, 0x69, 
+0xD5, 0xB8, 0xAD, 0x2D, 0x89, 0x4E, 0x29, 0xB8, 
+0x95, 0x1C, 0x92, 0x8A, 0xA3, 0x45, 0x7E, 0x89, 
+0x5F, 0x6A, 0x36, 0x4C, 0x0C, 0x4B, 0x38, 0x24, 
+0x30, 0xB2, 0x30, 0xA0, 0x93, 0x94, 0xFE, 0xB6, 
+0x62, 0x05, 0xD2, 0xAE, 0x5C, 0xFB, 0xCD, 0x96, 
+0xDA, 0x77, 0x3A, 0x32, 0x2E, 0xED, 0x52, 0x0E, 
+0x5C, 0xEB, 0x7E, 0x7C, 0x90, 0xED, 0x57, 0x8B, 
+0xAE, 0x1D, 0x2B, 0x49, 0xB1, 0xC1, 0x38, 0x72, 
+0xE1, 0xFD, 0xAF, 0xD1, 0xAA, 0xF1, 0x45, 0xAF, 
+0x93, 0xAF, 0x8A, 0x26, 0xB4, 0x02, 0x3F, 0xD5, 
+0x21, 0x88, 0xE2, 0x82, 0xF7, 0x1B, 0x8E, 0x91, 
+0x1D, 0x14, 0x16, 0x2D, 0x31, 0xE6, 
+
+0xD2, 0x01, 0xEA, 0xCC, 0x12, 0xDA, 0xDB, 0x43, 
+0x2E, 0x5D, 0x48, 0x7E, 0xF9, 0xA6, 0x1A, 0xCB, 
+0x7E, 0x7F, 0x7E, 0x29, 0x32, 0xFA, 0xBB, 0xB0, 
+0x01, 0xA0, 0x07, 0x1C, 0x1B, 0x32, 0x22, 0x8A, 
+0x39, 0x2C, 0xF7, 0x9E, 0x3C, 0xEF, 0xFE, 0x86, 
+0xEA, 0x25, 0xFD, 0xDC, 0x37, 0x25, 0xC6, 0xF4, 
+0x90, 0xEB, 0xC6, 0x59, 0xA0, 0x7F, 0xCA, 0xE0, 
+0xB7, 0xA2, 0xF1, 0xA0, 0x89, 0xC1, 0x7C, 0xFE, 
+0x1B, 0xC7, 0xE4, 0x26, 0xC8, 0x25, 0xF0, 0xB2, 
+0x1B, 0x4C, 0x33, 0xCA, 0x15, 0xF5, 0x4E, 0xDF, 
+0x86, 0x4F, 0xD9, 0xE8, 0xF1, 0xAB, 0xB3, 0xF0, 
+0x69, 0x2D, 0xFD, 0x08, 0x71, 0x1E, 0x33, 0x6C, 
+0xFC, 0x54, 0xE1, 0xFE, 0x56, 0x0C, 0xF7, 0xD0, 
+0xCF, 0x17, 0xE7, 0xD7, 0xA8, 0xE8, 0xC9, 0xB2, 
+0xC5, 0xF0, 0xBE, 0xC5, 0x39, 0xDF, 0x8F, 0x23, 
+0xBE, 0xFD, 0x1C, 0xB7, 0x8F, 0xBF, 0x9C, 0x63, 
+0x0F, 0xFE, 0x63, 0xAC, 0xC3, 0x87, 0xFF, 0x56, 
+0xA9, 0xA3, 0x0F, 0x25, 0xD1, 0xD3, 0xE4, 0xA2, 
+0xCC, 0x6C, 0x0E, 0xDD, 0x8E, 0xD3, 0x6C, 0xDB, 
+0xDD, 0x0D, 0x44, 0x2B, 0x65, 0x83, 0x1B, 0xED, 
+0xCF, 0xD0, 0x04, 0x5C, 0x10, 0x5B, 0x66, 0xE5, 
+0xA8, 0x25, 0xB4, 0x38, 0x32, 0xD5, 0x8E, 
+
+0xB9, 0xEE, 0xFD, 0x0F, 0x80, 0xFA, 0xEF, 0x11, 
+0xB5, 0x47, 0x76, 0xB8, 0xCE, 0xF4, 0x34, 0xAD, 
+0xDE, 0xEF, 0xF3, 0x04, 0x17, 0x5B, 0xF5, 0x2E, 
+0xDD, 0xDE, 0x62, 0xDE, 0xA2, 0xC0, 0x0F, 0x58, 
+0xB0, 0x5E, 0x1F, 0x45, 0xE0, 0x7F, 0xCC, 0x96, 
+0x52, 0xA7, 0x7B, 0xF8, 0xF3, 0xE2, 0x86, 0xEE, 
+0x9C, 0xB6, 0x87, 0x9A, 0x80, 0x12, 0x4B, 0x66, 
+0xDA, 0x40, 0x1B, 0x90, 0xAB, 0xBA, 0x10, 0x85, 
+0x2D, 0x98, 0x5B, 0x15, 0x87, 0x96, 0xFE, 0xDB, 
+0x73, 0x69, 0xE7, 0x49, 0x62, 0xE7, 0xB7, 0x29, 
+0xDE, 0x87, 0xC2, 0xDB, 0x2A, 0x51, 0x29, 0x65, 
+0x26, 0xB3, 0xC5, 0x9E, 0x4B, 0x5B, 0x3F, 0x6D, 
+0x00, 0x37, 0x53, 0x35, 0x39, 0xF5, 0x20, 0x10, 
+0x06, 0x4F, 0xC0, 0x88, 0x5D, 0xDC, 0x14, 0x4D, 
+0xE6, 0xD6, 0x73, 0x11, 0x85, 0x77, 0x53, 0x5C, 
+0xDB, 0x72, 0xE9, 0x29, 0x91, 0x81, 0x72, 0xDC, 
+0x68, 0x77, 0xE9, 0x5A, 0x86, 0x30, 0x83, 0x08, 
+0xB4, 0x81, 0xB5, 0xE3, 0x62, 0x45, 0x52, 0x58, 
+0xE9, 0xB3, 0xC7, 0x09, 0x71, 0x98, 0xE9, 0xAD, 
+0x11, 0xA3, 0x2E, 0x8D, 0x71, 0x32, 0xC3, 0x2E, 
+0xB8, 0x1B, 0x93, 0x80, 0x9E, 0x84, 0x28, 0xF1, 
+0x4F, 0xD1, 0xF7, 0x72, 0xB3, 0x77, 0xD3, 0xAF, 
+
+0x08, 0xC6, 0x23, 0x88, 0xDE, 0xB4, 0x3E, 0xBA, 
+0xDA, 0xDF, 0x67, 0x2A, 0x07, 0xB5, 0x57, 0x15, 
+0x44, 0x74, 0x8C, 0x5D, 0xDD, 0xFE, 0x76, 0x89, 
+0xCC, 0x77, 0x85, 0xB3, 0x60, 0x96, 0xBB, 0x2A, 
+0xA9, 0x7B, 0x34, 0x4D, 0xF2, 0x73, 0x5D, 0x7B, 
+0xEF, 0x10, 0x76, 0xC4, 0x48, 0x7F, 0xB8, 0x53, 
+0x11, 0x14, 0x05, 0x03, 0x0D, 0x1F, 0x4E, 0x1E, 
+0xC0, 0x12, 0x52, 0xA1, 0x7D, 0x18, 0xC4, 0xF6, 
+0xBE, 0x56, 0xE2, 0x3B, 0xB0, 0xFA, 0x8F, 0xD7, 
+0x98, 0xEC, 0x1F, 0x1D, 0x5C, 0xE4, 0xB7, 0xFD, 
+0x0B, 0x82, 0x35, 0x0F, 0x66, 0xEF, 0x18, 0xC0, 
+0x53, 0x38, 0x3F, 0x00, 0x69, 0x6E, 0x40, 0x92, 
+0xF5, 0x0A, 0x97, 0x1C, 0x01, 0x1A, 0x55, 0x17, 
+0x3A, 0x85, 0x94, 0xD2, 0xC4, 0x16, 0x40, 0x90, 
+0x2A, 0xA7, 0xB8, 0x56, 0x20, 0x8A, 0x45, 0x25, 
+0xC5, 0x3D, 0x3F, 0x07, 0xBE, 0x59, 0xDB, 0x92, 
+0x79, 0x7F, 0x54, 0x97, 0x56, 0x6E, 0xBB, 0x1D, 
+0xF2, 0xBA, 0x5D, 0xC1, 0x44, 0xB4, 0x72, 0xB4, 
+0x14, 0xCF, 0x4E, 0x5A, 0xF9, 0xE5, 0xF3, 0xD7, 
+0xF4, 0xBA, 0x19, 0x3F, 0x04, 0xCB, 0x57, 0xB3, 
+0x1B, 0x78, 0xB5, 0x79, 0x38, 0x13, 0xB5, 0x3C, 
+0x56, 0xF1, 0x00, 0x6C, 0xFA, 0xA2, 0x0D, 0x3D, 
+0xFF, 
+
+0x40, 0xEB, 0x8F, 0xC4, 0x9A, 0x60, 0x73, 0xA4, 
+0xB2, 0xA2, 0xE3, 0x3D, 0x2B, 0x4E, 0x15, 0x15, 
+0xD0, 0x1D, 0x30, 0xED, 0xA5, 0xE3, 0x86, 0xB9, 
+0x47, 0xB8, 0x65, 0x82, 0x9E, 0x7E, 0xC6, 0xE3, 
+0xAF, 0xC2, 0x8A, 0x51, 0x2B, 0x63, 0x8B, 0x76, 
+0x17, 0x17, 0xD2, 0xA7, 0x73, 0x1D, 0x14, 0x15, 
+0x12, 0x03, 0xA9, 0xD4, 0x1B, 0xEB, 0x10, 0xFD, 
+0xD5, 0xDD, 0xE4, 0xBD, 0xB8, 0xBB, 0x51, 0x01, 
+0x99, 0x18, 0x36, 0x42, 0x34, 0xFE, 0xE1, 0x60, 
+0x73, 0x9E, 0x24, 0xB3, 0x0C, 0x82, 0x1D, 0xF7, 
+0x7A, 0x18, 0x9D, 0x02, 0xB4, 0x0A, 0x86, 0x53, 
+0xDF, 0x22, 0x11, 0x91, 0x56, 0xA8, 0x9B, 0xE6, 
+0x59, 0x3F, 0xB4, 0xBB, 0xD9, 0xC2, 0x2F, 0xAC, 
+0x42, 0x27, 0xE7, 0xC9, 0x9E, 0xE8, 0xF6, 0x95, 
+0xF3, 0xEE, 0x52, 0x01, 0x18, 0x70, 0xA3, 0x48, 
+0xFE, 0xE1, 0xA1, 0x08, 0x20, 0x8D, 0xC1, 0x49, 
+0xBA, 0x99, 0xEA, 0x09, 0x6B, 0x4A, 0x02, 0xB6, 
+0xE1, 0x3F, 0xD4, 0xD3, 0x87, 0x3F, 0x9B, 0x1B, 
+0x1B, 0x9E, 0xF3, 0x4A, 0x4B, 0x19, 0x57, 0x56, 
+0x66, 0x35, 0x83, 0x11, 0x6D, 0x22, 0x5C, 0xE1, 
+0x9E, 0x7C, 0x2E, 0x2C, 0x72, 0x39, 0x8D, 0x18, 
+0x3C, 0xC4, 0xBD, 0xF7, 0x3B, 0x52, 0x13, 0x00, 
+0xEA, 0xB4, 
+
+0xA6, 0x61, 0x05, 0x8D, 0x53, 0xB7, 0xEE, 0x20, 
+0xB6, 0x36, 0x00, 0x2F, 0x25, 0xFC, 0xA0, 0xCF, 
+0x65, 0xC0, 0x4A, 0xD3, 0x65, 0xF4, 0xB2, 0x73, 
+0x48, 0x07, 0x7D, 0x31, 0x4B, 0x28, 0xF4, 0x43, 
+0x58, 0xCE, 0x8D, 0x49, 0xAF, 0x89, 0x3B, 0x63, 
+0x60, 0xFC, 0xBF, 0x34, 0xB9, 0xB4, 0x00, 0x99, 
+0x38, 0x3E, 0x37, 0x33, 0xD6, 0x8E, 0xC6, 0x4D, 
+0xFA, 0x95, 0xCC, 0x96, 0x3F, 0xCB, 0x3F, 0x8A, 
+0x1C, 0xBB, 0x7E, 0x09, 0x73, 0x73, 0x8E, 0x29, 
+0xF9, 0x41, 0xD7, 0xF2, 0xB4, 0x7D, 0x4A, 0xF4, 
+0xAE, 0x0D, 0xCE, 0x63, 0x9B, 0xA1, 0x31, 0xDD, 
+0xD1, 0x53, 0x54, 0x18, 0xE9, 0x7B, 0x90, 0x79, 
+0x1C, 0x20, 0x3F, 0x2C, 0x39, 0xBD, 0x67, 0x0D, 
+0x70, 0xF7, 0x42, 0x43, 0xB4, 0x8E, 0xBF, 0xA6, 
+0x27, 0xD6, 0x63, 0x78, 0x78, 0x05, 0x77, 0x18, 
+0x65, 0xE7, 0x7E, 0x8E, 0x01, 0xF3, 0x57, 0x32, 
+0xB5, 0x20, 0x95, 0x10, 0xAF, 0xA5, 0xB7, 0x39, 
+0x3A, 0xE8, 0x08, 0x74, 0x3B, 0xC6, 0x5E, 0xFF, 
+0x2F, 0xAF, 0x72, 0xDF, 0x2E, 0xFF, 0x2B, 0xF5, 
+0x32, 0x89, 0x68, 0x10, 0x62, 0xF8, 0x20, 0x5A, 
+0x1E, 0xA6, 0x44, 0xE6, 0xE2, 0x9B, 0xC2, 0xD3, 
+0x6A, 0x0D, 0xC1, 0x15, 0xAE, 0x6F, 0xD6, 0x0E, 
+0xB4, 0x9C, 0x1D, 
+
+0x2D, 0xBE, 0xBC, 0x28, 0xE4, 0x0C, 0x49, 0xAF, 
+0xF5, 0xBB, 0x55, 0x52, 0xB6, 0xED, 0xEE, 0x4C, 
+0x54, 0xF5, 0xD9, 0x8C, 0x35, 0x04, 0xCF, 0x35, 
+0xEC, 0x93, 0x5D, 0x59, 0xBD, 0x6C, 0x23, 0x79, 
+0xFD, 0x3C, 0xF7, 0xA9, 0xF4, 0x1A, 0xE5, 0xD4, 
+0x96, 0xB7, 0xC3, 0x7C, 0xC2, 0x46, 0x66, 0xEB, 
+0x72, 0x0C, 0x39, 0x06, 0x08, 0x37, 0x5A, 0x40, 
+0x8D, 0x4C, 0xE7, 0xF7, 0x67, 0xEC, 0x54, 0x2F, 
+0x34, 0xF9, 0x0A, 0x6D, 0x95, 0x58, 0x48, 0x75, 
+0x00, 0xA0, 0xFE, 0xE0, 0x28, 0xEA, 0x08, 0x55, 
+0x48, 0x47, 0x56, 0x3D, 0x36, 0x43, 0x18, 0x51, 
+0xFE, 0x0B, 0x79, 0xF5, 0x05, 0xE8, 0xB8, 0x9E, 
+0xE3, 0xFA, 0x77, 0x78, 0x00, 0xA2, 0xF5, 0xBE, 
+0x31, 0x1C, 0xC7, 0x8D, 0x67, 0x20, 0x48, 0x91, 
+0x3A, 0x13, 0xA3, 0x47, 0x38, 0xFD, 0xDD, 0xFB, 
+0x3A, 0x79, 0x58, 0x5A, 0x69, 0x29, 0x11, 0xF6, 
+0x91, 0xA6, 0x4C, 0x23, 0x11, 0x00, 0x00, 0x88, 
+0x6A, 0x17, 0xE3, 0x2F, 0x2A, 0x2D, 0x55, 0x98, 
+0x49, 0x46, 0x12, 0x27, 0xA4, 0x7A, 0xE8, 0xD3, 
+0x55, 0xE8, 0xCF, 0xE7, 0x8B, 0x58, 0x2B, 0xF0, 
+0x3A, 0x04, 0x52, 0x27, 0x0E, 0xBE, 0x53, 0xA8, 
+0x73, 0x5A, 0x6E, 0xBB, 0x25, 0xEC, 0xF2, 0xEF, 
+0x4D, 0x3C, 0x63, 0xB8, 
+
+0x5C, 0x15, 0xA7, 0x3F, 0xA9, 0xDD, 0x80, 0xAC, 
+0xA2, 0x19, 0x35, 0x7F, 0x38, 0x2F, 0xB0, 0xBF, 
+0xDB, 0x96, 0x3A, 0xE0, 0x0D, 0xEE, 0xDA, 0xC8, 
+0x48, 0x92, 0x7C, 0x68, 0x89, 0xE9, 0xF9, 0xE2, 
+0x1C, 0x73, 0xCD, 0xCE, 0xFB, 0x4F, 0x8D, 0x92, 
+0x20, 0x99, 0x21, 0xA5, 0x73, 0x9E, 0x71, 0x87, 
+0x6D, 0x11, 0x3D, 0x4F, 0xA4, 0x89, 0xFA, 0x6F, 
+0x60, 0x7A, 0x7B, 0x37, 0x8E, 0x1E, 0x7B, 0x95, 
+0x0C, 0x4A, 0x2E, 0x4E, 0x7E, 0xD3, 0x5A, 0x5C, 
+0xEE, 0x5D, 0x16, 0x22, 0x29, 0x60, 0x63, 0xF2, 
+0x16, 0xDD, 0x98, 0x82, 0x05, 0x93, 0x04, 0xE6, 
+0x50, 0x5C, 0x36, 0xFE, 0x59, 0x1A, 0x72, 0x98, 
+0x57, 0x34, 0x75, 0x23, 0x3E, 0x26, 0x22, 0x83, 
+0xD0, 0x79, 0x16, 0x47, 0x8F, 0x52, 0x87, 0xB9, 
+0xBC, 0xF7, 0xC8, 0x64, 0xD2, 0x84, 0x9B, 0x6F, 
+0x35, 0x6C, 0xA8, 0xD5, 0xCF, 0x0D, 0x16, 0xB2, 
+0xA3, 0xDC, 0x3B, 0x3B, 0x55, 0x11, 0xD6, 0xB2, 
+0x8F, 0x24, 0x55, 0xA0, 0xF9, 0xCA, 0xFB, 0x53, 
+0x87, 0x22, 0x77, 0x47, 0xDF, 0x29, 0x1E, 0x3B, 
+0xDF, 0xF0, 0x94, 0x79, 0xDE, 0x65, 0x7E, 0xF6, 
+0x08, 0x81, 0xCC, 0x8F, 0x29, 0x1D, 0x96, 0xAE, 
+0x9B, 0x9E, 0xD0, 0x1D, 0x5D, 0x39, 0x44, 0x3E, 
+0xB5, 0x21, 0x1A, 0xF0, 0xE6, 
+
+0x9C, 0x4C, 0x4F, 0xD7, 0xA7, 0x92, 0xE3, 0x05, 
+0x6C, 0xDC, 0x1F, 0x74, 0xA9, 0x95, 0xA6, 0x35, 
+0x8E, 0x86, 0x07, 0xFE, 0x1B, 0xF5, 0xA6, 0xE4, 
+0x66, 0x81, 0x10, 0xFB, 0xE5, 0x06, 0xDB, 0x35, 
+0xAE, 0xAC, 0x8B, 0x63, 0xDF, 0xA1, 0x3C, 0x37, 
+0x93, 0x63, 0x98, 0xDA, 0x4C, 0xCA, 0x21, 0x68, 
+0x3D, 0x73, 0x03, 0x21, 0x19, 0x06, 0xD5, 0x0B, 
+0xC5, 0x41, 0x60, 0xE4, 0xAF, 0xE1, 0x83, 0xF4, 
+0xCF, 0x0E, 0xD2, 0x7A, 0x7F, 0x69, 0x94, 0x60, 
+0x4C, 0xE3, 0x81, 0xC9, 0x5A, 0xC3, 0x50, 0x5D, 
+0xD0, 0x37, 0x99, 0xE7, 0x0D, 0xB7, 0xC2, 0x1B, 
+0xCC, 0xAE, 0x8F, 0xD8, 0xD9, 0x78, 0xB5, 0xE8, 
+0x51, 0xDC, 0x95, 0xB1, 0xA8, 0x6C, 0x4C, 0x8E, 
+0x19, 0xDA, 0x20, 0x45, 0x61, 0xA7, 0x3F, 0x93, 
+0x0E, 0x50, 0x91, 0x6C, 0xB3, 0x8D, 0xC5, 0xB0, 
+0xAA, 0xA1, 0xCB, 0xA8, 0x1E, 0xC8, 0xDC, 0x1B, 
+0x20, 0xE1, 0xD7, 0x10, 0xD8, 0xF8, 0xFD, 0xE1, 
+0x63, 0xC3, 0x6B, 0xD7, 0xC2, 0xBE, 0x21, 0x66, 
+0x10, 0x33, 0x45, 0x4C, 0x97, 0x7F, 0x60, 0xEA, 
+0x1C, 0x21, 0x9E, 0xEE, 0x5E, 0x91, 0x4A, 0xA8, 
+0x29, 0x39, 0x62, 0xB4, 0x13, 0xA4, 0xC3, 0x4E, 
+0x00, 0xF4, 0x00, 0xC2, 0xC3, 0xB6, 0x47, 0xE1, 
+0xA3, 0x36, 0x97, 0x9B, 0x11, 0xFE, 
+
+0x1A, 0xFF, 0xAE, 0x54, 0x8C, 0xF7, 0x32, 0x33, 
+0xBC, 0x58, 0x90, 0x98, 0x4F, 0xE6, 0x8B, 0x65, 
+0x0E, 0x52, 0x49, 0xDE, 0x87, 0x55, 0x51, 0x17, 
+0x7F, 0x99, 0x82, 0x98, 0x2A, 0x25, 0x97, 0x22, 
+0xDF, 0x69, 0x96, 0xAB, 0x8D, 0x83, 0x2D, 0xAE, 
+0x4A, 0x2C, 0xD5, 0x7A, 0xA8, 0x22, 0x6F, 0x17, 
+0x18, 0x89, 0x33, 0xD0, 0x15, 0x01, 0xD7, 0x5A, 
+0x21, 0x85, 0x2F, 0xA7, 0xCE, 0xCF, 0x15, 0xE7, 
+0x13, 0x68, 0x87, 0x24, 0x2D, 0x90, 0x97, 0x0B, 
+0xD3, 0xC3, 0xB2, 0x25, 0xE5, 0x2D, 0xAC, 0xD7, 
+0xA3, 0xF5, 0xD7, 0x2D, 0x09, 0x17, 0x85, 0x43, 
+0xE7, 0x91, 0xBE, 0xB1, 0x97, 0x8E, 0xE8, 0x06, 
+0x31, 0x9C, 0xC8, 0xCE, 0x36, 0x53, 0x4D, 0x1D, 
+0x27, 0x52, 0x73, 0xDD, 0x10, 0xAD, 0x00, 0x24, 
+0xD6, 0x43, 0x28, 0xA9, 0x28, 0xC8, 0x5E, 0x07, 
+0xB9, 0x26, 0xFC, 0xD0, 0x01, 0x73, 0x57, 0x98, 
+0xC8, 0xD4, 0xCB, 0x4B, 0x02, 0xF2, 0x3F, 0x5A, 
+0x92, 0x1C, 0x68, 0x3B, 0xCA, 0x8D, 0xFD, 0x49, 
+0x8E, 0x04, 0x5E, 0x6C, 0xD9, 0x1A, 0x07, 0x4A, 
+0xA0, 0x7C, 0x31, 0x18, 0x42, 0xD8, 0x6A, 0x88, 
+0x8F, 0xDD, 0x9E, 0x26, 0x36, 0x7A, 0x20, 0x7D, 
+0x50, 0xCD, 0xD7, 0x4A, 0x1E, 0x9E, 0x30, 0x55, 
+0xD7, 0x6F, 0x70, 0x35, 0xCF, 0xDB, 0x32, 
+
+0x75, 0x9A, 0x7E, 0x63, 0x8E, 0xC1, 0x4C, 0x37, 
+0xF5, 0x93, 0xC8, 0xE7, 0x5F, 0xEC, 0xC2, 0x17, 
+0xF6, 0xA2, 0x5E, 0x7D, 0x8C, 0xEB, 0x50, 0xE0, 
+0xB5, 0x6E, 0x04, 0x5D, 0x6E, 0x79, 0xEA, 0xEC, 
+0x60, 0xA7, 0x2A, 0x0E, 0xD7, 0x83, 0xDF, 0x42, 
+0x8C, 0xDF, 0x19, 0xAA, 0x07, 0x0B, 0x3C, 0x28, 
+0xFE, 0x0A, 0x45, 0x26, 0xDE, 0x0C, 0x3C, 0xAB, 
+0x57, 0x11, 0xBC, 0x10, 0x1B, 0x71, 0x96, 0x63, 
+0xC9, 0xB8, 0x51, 0x9F, 0x87, 0x71, 0xDE, 0xDA, 
+0xCB, 0x9B, 0xD8, 0x65, 0x35, 0xF1, 0x70, 0x4B, 
+0x4C, 0xBF, 0x5F, 0x3E, 0xD8, 0xF2, 0x52, 0x0E, 
+0x24, 0x08, 0xD3, 0x57, 0x0F, 0x44, 0xE6, 0xE0, 
+0xAA, 0xC0, 0x11, 0x62, 0xC7, 0x6D, 0x35, 0xFF, 
+0x6A, 0x41, 0x92, 0x9E, 0x56, 0x07, 0x22, 0x1B, 
+0xFE, 0xE1, 0xA5, 0xED, 0x5C, 0x1B, 0x0B, 0x90, 
+0x42, 0xB4, 0x36, 0x5A, 0xF6, 0x3C, 0xDA, 0xE9, 
+0xBB, 0x8C, 0x0A, 0x12, 0x6A, 0x18, 0x22, 0xD4, 
+0xB5, 0x5F, 0x80, 0x46, 0xD0, 0x1C, 0x1A, 0x36, 
+0x93, 0x55, 0xAD, 0xF6, 0x92, 0x1C, 0x0D, 0xD4, 
+0xEB, 0x9A, 0x3B, 0x7D, 0x06, 0x5F, 0xBF, 0x22, 
+0x70, 0x1C, 0x3F, 0x60, 0xBD, 0x6D, 0x9A, 0x91, 
+0x45, 0xDC, 0x6F, 0x3C, 0xAB, 0x40, 0xCD, 0xBE, 
+0x91, 0x3E, 0x8D, 0x39, 0x0B, 0x70, 0x3A, 0x59, 
+
+0x1D, 0xC1, 0x46, 0x8F, 0x6F, 0x75, 0x70, 0x23, 
+0x4A, 0x02, 0x7F, 0x15, 0x38, 0x90, 0xB6, 0x8D, 
+0x52, 0xCA, 0xA9, 0x43, 0xE0, 0xF0, 0x47, 0xF9, 
+0x49, 0x72, 0x3B, 0xE5, 0x91, 0x82, 0x9B, 0x9C, 
+0xC9, 0x9D, 0x76, 0xA6, 0xAE, 0xAE, 0x8F, 0x19, 
+0xE9, 0x69, 0xA8, 0x42, 0xD4, 0xF3, 0x42, 0x80, 
+0xB3, 0x59, 0x0E, 0xAB, 0x89, 0x46, 0xC5, 0x7E, 
+0x91, 0xDE, 0x52, 0x3B, 0x20, 0x61, 0x3B, 0x44, 
+0xEB, 0x37, 0x3D, 0xEC, 0xF1, 0x58, 0xA5, 0xC3, 
+0xB7, 0x12, 0xC8, 0xAA, 0x0C, 0x18, 0x16, 0xE9, 
+0x3B, 0x44, 0xE8, 0xC8, 0x09, 0x99, 0x93, 0x88, 
+0x56, 0x81, 0xB8, 0x08, 0x5D, 0x71, 0x13, 0xC3, 
+0x72, 0x2D, 0x80, 0x66, 0x6C, 0x69, 0x06, 0xEE, 
+0x72, 0x02, 0x0F, 0x45, 0x30, 0x04, 0xC8, 0x88, 
+0xD7, 0xE5, 0x48, 0xE8, 0xC5, 0x59, 0x04, 0x3E, 
+0x47, 0xF1, 0xBD, 0x12, 0x82, 0x58, 0xCE, 0x2E, 
+0xDC, 0x04, 0x7D, 0xBF, 0xDD, 0x82, 0x90, 0x7E, 
+0x79, 0x01, 0xF3, 0x93, 0xDE, 0xC7, 0x6C, 0x07, 
+0xD2, 0xC2, 0xF0, 0xBC, 0x8D, 0x5D, 0x87, 0x36, 
+0xA5, 0x5D, 0x69, 0x2F, 0xD3, 0x92, 0x4E, 0xB2, 
+0xC1, 0x86, 0x9B, 0x83, 0x29, 0xE8, 0x2D, 0x53, 
+0x3B, 0xB2, 0xF4, 0x16, 0x3C, 0xD9, 0x4E, 0x94, 
+0xAA, 0xB7, 0x70, 0x1F, 0xBA, 0x7C, 0x76, 0x8C, 
+0xD3, 
+
+0x7E, 0x67, 0x83, 0x9B, 0x87, 0x1D, 0xB5, 0x45, 
+0x51, 0xC0, 0x69, 0x29, 0xB0, 0x7A, 0x2A, 0x6B, 
+0xB3, 0x64, 0x0C, 0xE0, 0x1B, 0x8A, 0x59, 0xB4, 
+0x43, 0x30, 0x9E, 0xD6, 0xFC, 0xEA, 0x36, 0x36, 
+0xB4, 0x38, 0x90, 0x45, 0xB0, 0x3D, 0xF6, 0xF3, 
+0xED, 0xB0, 0xC6, 0x20, 0x5E, 0x1C, 0xB5, 0xA8, 
+0x1D, 0xB9, 0xF4, 0xF1, 0x4C, 0x34, 0x1B, 0x91, 
+0x83, 0x36, 0xA5, 0xFE, 0x20, 0xE7, 0x59, 0x78, 
+0xC0, 0x69, 0xE0, 0xE9, 0x0E, 0xC2, 0x38, 0xC4, 
+0x09, 0x9A, 0xA3, 0xEF, 0x10, 0x32, 0x6C, 0x4A, 
+0xC9, 0x54, 0x2B, 0xD0, 0xEE, 0x5A, 0x22, 0x18, 
+0xC8, 0x3F, 0x16, 0xBC, 0x44, 0x4D, 0x97, 0x43, 
+0x25, 0xF3, 0x20, 0x64, 0x49, 0x61, 0x42, 0x48, 
+0x4A, 0xD1, 0xE9, 0xF9, 0xB1, 0x00, 0xB3, 0x33, 
+0x8B, 0x56, 0xF0, 0x52, 0x0A, 0xAB, 0x6F, 0xDC, 
+0x3C, 0xA9, 0x12, 0x8F, 0xCA, 0x33, 0xE0, 0x22, 
+0x91, 0x08, 0xD5, 0x21, 0xFA, 0x2D, 0x44, 0xE5, 
+0x39, 0x44, 0x4C, 0x46, 0x4F, 0xC9, 0x65, 0x4B, 
+0xE6, 0xC4, 0x1C, 0xEF, 0xEB, 0x54, 0x84, 0x97, 
+0x30, 0xBF, 0x5E, 0x2F, 0xAA, 0x43, 0x79, 0x25, 
+0x80, 0xB3, 0x92, 0x05, 0x22, 0x58, 0x58, 0x7D, 
+0x39, 0x62, 0x64, 0x98, 0x23, 0x37, 0x7F, 0xD3, 
+0x3B, 0x6C, 0x1F, 0x93, 0x94, 0xF9, 0xED, 0x84, 
+0xB1, 0x7A, 
+
+0x13, 0x56, 0x70, 0x1D, 0x41, 0x6A, 0x32, 0x13, 
+0xD4, 0xEA, 0xC1, 0x52, 0xB0, 0xEA, 0xEB, 0x63, 
+0xCF, 0xA7, 0xDE, 0x7F, 0xAD, 0x3E, 0x06, 0xC6, 
+0x0B, 0x4A, 0x89, 0x3B, 0xA7, 0xF5, 0xF5, 0x81, 
+0xCB, 0x66, 0xDE, 0x54, 0x33, 0x96, 0x0E, 0x9A, 
+0x0B, 0xC0, 0xD6, 0x8D, 0x35, 0x40, 0xA8, 0xC5, 
+0x25, 0xF6, 0xB5, 0x59, 0xFF, 0xFB, 0xE5, 0x60, 
+0x76, 0x18, 0xAD, 0xB9, 0xF7, 0x1D, 0xC2, 0x4C, 
+0x21, 0x3A, 0x32, 0xFC, 0x2A, 0xC0, 0xC0, 0x39, 
+0x59, 0xC2, 0xED, 0xBB, 0xC6, 0x83, 0xB1, 0x73, 
+0x56, 0x3B, 0xBA, 0x83, 0xDF, 0x35, 0x76, 0xDB, 
+0x9E, 0x8D, 0xAE, 0x40, 0x68, 0x25, 0xEC, 0xAF, 
+0x90, 0x0A, 0xC7, 0x73, 0x1E, 0xA3, 0x0C, 0x97, 
+0x9B, 0x30, 0x42, 0xA9, 0xEB, 0x12, 0x7B, 0xF2, 
+0xE2, 0x75, 0x12, 0xC4, 0x70, 0x4C, 0xBA, 0x1A, 
+0x67, 0x65, 0xA7, 0xA6, 0x7C, 0x8B, 0xF1, 0x9A, 
+0xE6, 0x23, 0x7F, 0x1C, 0x84, 0x9C, 0x13, 0x19, 
+0xA6, 0xD2, 0x0B, 0x27, 0x25, 0xEA, 0x46, 0xF9, 
+0xD7, 0xE9, 0x66, 0x81, 0xEA, 0xDB, 0xFF, 0x54, 
+0xB9, 0xE1, 0x9B, 0x0F, 0xAC, 0x03, 0xA8, 0x6B, 
+0x62, 0xEB, 0xD6, 0xC3, 0xE2, 0xB4, 0x50, 0xCF, 
+0x04, 0x2C, 0x1F, 0xCB, 0x0A, 0x7A, 0x27, 0x08, 
+0x15, 0xD4, 0x6B, 0x54, 0x9D, 0xD9, 0xE0, 0xF5, 
+0x42, 0x44, 0x73, 
+
+0x8C, 0xB3, 0x7D, 0x33, 0x01, 0x1F, 0x84, 0x37, 
+0x83, 0xAF, 0x97, 0x74, 0xF1, 0x8F, 0x74, 0x9D, 
+0x95, 0x5B, 0x2F, 0x82, 0xCD, 0x3B, 0x16, 0x45, 
+0x16, 0x55, 0x6E, 0xC2, 0xE3, 0x72, 0xB8, 0xB2, 
+0x5A, 0x84, 0x64, 0xB7, 0x11, 0x5A, 0xDB, 0x9A, 
+0xA0, 0x09, 0xD2, 0xAF, 0x5B, 0x4B, 0x25, 0x45, 
+0x2A, 0xE3, 0xAC, 0xBF, 0x56, 0x55, 0x56, 0x5A, 
+0xC2, 0xF2, 0xFD, 0xDA, 0x64, 0x7C, 0x21, 0x38, 
+0x21, 0x11, 0x97, 0x0E, 0x6E, 0xD7, 0x6B, 0x57, 
+0x03, 0xF8, 0xFF, 0x0E, 0x86, 0x9C, 0xBD, 0xA5, 
+0x12, 0x86, 0x6C, 0x95, 0xDA, 0x1F, 0x48, 0xF5, 
+0x33, 0xFC, 0xDE, 0xAC, 0xB0, 0x2E, 0xE0, 0x1B, 
+0x48, 0x09, 0x44, 0x03, 0xE3, 0x8B, 0x55, 0x40, 
+0x87, 0x4B, 0x4C, 0xB1, 0x8D, 0x0D, 0xC8, 0x10, 
+0x19, 0xD1, 0x61, 0x66, 0xB9, 0xE5, 0x69, 0x26, 
+0xDE, 0x04, 0x94, 0x63, 0xCD, 0x29, 0xDC, 0xA1, 
+0x3A, 0x1F, 0x24, 0x59, 0xEB, 0x01, 0x58, 0xDA, 
+0x93, 0xD1, 0xE4, 0xB9, 0xAA, 0x90, 0x41, 0xB3, 
+0x26, 0xB5, 0x2E, 0xF8, 0xFF, 0x72, 0x85, 0x5C, 
+0xAB, 0x60, 0xB5, 0xC4, 0x46, 0x03, 0x66, 0x95, 
+0x37, 0xD1, 0x8C, 0x81, 0x90, 0x10, 0xBF, 0x65, 
+0x38, 0x00, 0xC3, 0x02, 0xFF, 0x40, 0x77, 0xB6, 
+0x6E, 0xB7, 0xBD, 0xEC, 0x33, 0x8E, 0xB8, 0x21, 
+0x25, 0x8A, 0xE2, 0xBE, 
+
+0x36, 0x3F, 0xA5, 0x51, 0x93, 0xDE, 0x03, 0xDE, 
+0x39, 0x20, 0x98, 0xB6, 0x16, 0x01, 0x44, 0x82, 
+0xE2, 0xE8, 0x23, 0x3F, 0xE6, 0x94, 0x75, 0x6C, 
+0x04, 0xED, 0x56, 0xD2, 0x7D, 0x7A, 0x38, 0x39, 
+0x29, 0x5E, 0x7C, 0x8F, 0x66, 0x2F, 0x01, 0x25, 
+0x9E, 0xFB, 0xF7, 0x4D, 0xE5, 0xF6, 0xE9, 0x4E, 
+0x0F, 0x43, 0x3E, 0xC3, 0xF7, 0xD5, 0x51, 0xFC, 
+0xE8, 0x0E, 0xDC, 0xDC, 0xA8, 0xFC, 0x80, 0x27, 
+0x46, 0x32, 0x80, 0x1F, 0xD2, 0xBB, 0x44, 0x14, 
+0x59, 0x5C, 0x4D, 0x82, 0x0E, 0x5F, 0xF1, 0x7D, 
+0xDE, 0xD5, 0xA0, 0xF5, 0x7A, 0x58, 0x05, 0x28, 
+0xC7, 0xB4, 0x84, 0xDC, 0x86, 0x2C, 0xCF, 0xCA, 
+0x86, 0x04, 0x60, 0x40, 0x19, 0x66, 0x65, 0xD5, 
+0xE8, 0x67, 0x81, 0xAF, 0xB5, 0xA0, 0x2C, 0x19, 
+0x9B, 0x3E, 0x3C, 0x4A, 0x8F, 0x77, 0x6E, 0xC4, 
+0x16, 0x94, 0x2F, 0x20, 0x0C, 0x38, 0x5E, 0xB6, 
+0xE4, 0x1D, 0x30, 0xCF, 0x79, 0xE1, 0x1D, 0xC2, 
+0xF5, 0x2B, 0x6C, 0x40, 0xD3, 0x03, 0x18, 0x2B, 
+0x71, 0x27, 0x3F, 0xAA, 0x25, 0x4C, 0x91, 0x79, 
+0xBC, 0xF1, 0xCF, 0xAE, 0xF0, 0xDF, 0xF5, 0x9E, 
+0xC1, 0x6C, 0x5E, 0x72, 0x00, 0x60, 0x76, 0xCF, 
+0xD5, 0x8E, 0xA3, 0xD2, 0xD9, 0x66, 0x98, 0xB9, 
+0x27, 0x0D, 0x57, 0xC5, 0xC4, 0xF0, 0xF4, 0x8A, 
+0x87, 0xB5, 0xFA, 0x08, 0xAD, 
+
+0xEF, 0xAF, 0xC1, 0x2A, 0xBE, 0xF4, 0x47, 0x91, 
+0x8C, 0x1B, 0x30, 0xEA, 0x76, 0xEE, 0x65, 0x88, 
+0x86, 0x38, 0xF4, 0xCE, 0x2C, 0x12, 0x02, 0x13, 
+0x0D, 0x90, 0x67, 0x68, 0xA7, 0x5C, 0x7E, 0xCF, 
+0x25, 0x0E, 0x75, 0x5D, 0xBA, 0x69, 0x69, 0xB4, 
+0x32, 0xA4, 0x89, 0xAD, 0x44, 0x5E, 0x02, 0x43, 
+0x80, 0x38, 0x2B, 0xC7, 0x9B, 0x09, 0x44, 0x63, 
+0x37, 0xF9, 0x1E, 0xBB, 0x6A, 0xAC, 0x16, 0x25, 
+0x69, 0x4A, 0xD2, 0xBD, 0xCD, 0xE5, 0x29, 0x7B, 
+0x85, 0xB7, 0x0B, 0xAC, 0x64, 0xF0, 0x63, 0xB0, 
+0xFB, 0x56, 0x67, 0x51, 0x5B, 0x84, 0x31, 0x84, 
+0xCF, 0x38, 0x12, 0x79, 0x6C, 0x79, 0x4F, 0xA3, 
+0x14, 0x7B, 0x97, 0x41, 0x21, 0x65, 0x33, 0x79, 
+0x2E, 0xF1, 0x4E, 0xBE, 0xD6, 0x7F, 0xAE, 0xCD, 
+0xD2, 0x4D, 0xB3, 0x7E, 0x6A, 0x5B, 0x2B, 0xD8, 
+0xD6, 0x7C, 0x84, 0xBA, 0xB3, 0x62, 0x08, 0x1E, 
+0xA1, 0x5E, 0x56, 0x60, 0xC7, 0x63, 0x5B, 0x60, 
+0x93, 0xC7, 0x93, 0xFF, 0x0E, 0x4D, 0xA1, 0xFC, 
+0x86, 0x44, 0x4A, 0xDF, 0xE5, 0x43, 0x38, 0x07, 
+0x05, 0x68, 0x5C, 0xA8, 0xA0, 0x36, 0x69, 0x6D, 
+0x24, 0x46, 0xE5, 0x5D, 0x7B, 0x10, 0x25, 0xEA, 
+0xAE, 0x91, 0x53, 0x66, 0xC0, 0xBF, 0xF1, 0xD0, 
+0x5C, 0xE9, 0x3D, 0x11, 0x63, 0xB5, 0x1D, 0x16, 
+0x66, 0x44, 0x09, 0xFA, 0x34, 0x5B, 
+
+0xBF, 0x13, 0x51, 0x44, 0xF7, 0x3F, 0x3A, 0x5D, 
+0x5F, 0x8B, 0x5A, 0x25, 0x2C, 0x9F, 0x68, 0x9D, 
+0x88, 0x81, 0x39, 0x87, 0xDF, 0xD8, 0xDC, 0xFA, 
+0x10, 0xC0, 0xA9, 0x71, 0x5B, 0xC4, 0x04, 0xCD, 
+0xF4, 0x1A, 0xED, 0x80, 0x97, 0x74, 0xA5, 0xAF, 
+0xD5, 0xCE, 0x88, 0x46, 0x67, 0x97, 0x6A, 0x0E, 
+0xA9, 0x9D, 0xE8, 0x33, 0x00, 0xFA, 0xE3, 0x03, 
+0x3B, 0xA7, 0x53, 0x3E, 0xE7, 0xB0, 0x66, 0xF1, 
+0xC9, 0x7A, 0x01, 0x1B, 0x24, 0xA0, 0x0F, 0xDC, 
+0x15, 0x4A, 0xA5, 0x0C, 0x04, 0xEE, 0xC2, 0x25, 
+0xBF, 0xD9, 0x89, 0xA1, 0xDA, 0x2A, 0xA2, 0x8B, 
+0x8E, 0xF0, 0x6B, 0x46, 0x98, 0x8E, 0x5E, 0xB6, 
+0xC0, 0x1C, 0x30, 0xD0, 0xDD, 0x19, 0x23, 0x5B, 
+0x6C, 0x54, 0x08, 0x82, 0x5C, 0x43, 0x7F, 0xA1, 
+0xCA, 0xF7, 0x27, 0x7B, 0x32, 0x72, 0xC3, 0x78, 
+0x65, 0x1C, 0x0F, 0x54, 0xF1, 0xFC, 0x18, 0xF8, 
+0xA2, 0xF6, 0xDC, 0xA1, 0x72, 0xDA, 0xA3, 0x92, 
+0x0D, 0x5E, 0xFE, 0x2A, 0x68, 0x01, 0x14, 0x9B, 
+0x1B, 0x5D, 0xF1, 0x36, 0x52, 0x08, 0xBC, 0x26, 
+0xFC, 0x1C, 0xB8, 0x43, 0xBC, 0x40, 0xDE, 0x78, 
+0x14, 0xFB, 0x5E, 0x1A, 0x6E, 0x78, 0x96, 0x6D, 
+0x7F, 0xBA, 0x8F, 0xEE, 0xF8, 0x9F, 0xD8, 0xF6, 
+0xD7, 0xA3, 0x96, 0x35, 0xC5, 0x61, 0x78, 0xB8, 
+0xDC, 0x59, 0x4B, 0x3A, 0x09, 0x36, 0xE4, 
+
+0xB6, 0x91, 0x47, 0xD1, 0x52, 0x26, 0xFF, 0x2D, 
+0x72, 0xA4, 0x26, 0xFE, 0x24, 0xA5, 0x6B, 0x0F, 
+0x9E, 0xD3, 0x7C, 0x6D, 0x65, 0x33, 0x1C, 0x63, 
+0xBE, 0x5D, 0xBE, 0xCD, 0x1F, 0x28, 0xDA, 0x92, 
+0xDF, 0x7D, 0x2D, 0xED, 0xF9, 0x26, 0x0C, 0x86, 
+0x62, 0x5B, 0xBE, 0xED, 0xC0, 0x0C, 0xD8, 0x62, 
+0xE5, 0x64, 0x5C, 0x57, 0x97, 0x87, 0x73, 0x99, 
+0x2F, 0x25, 0xE1, 0x96, 0x3C, 0xAF, 0xBD, 0xD0, 
+0x31, 0xD8, 0x69, 0xC2, 0xE2, 0xF2, 0x88, 0x9E, 
+0x6C, 0x50, 0x99, 0x88, 0xD0, 0x2D, 0x20, 0x78, 
+0x61, 0xC3, 0xC3, 0x06, 0xAA, 0xDA, 0x04, 0xA3, 
+0x20, 0x51, 0x65, 0x02, 0x48, 0x62, 0xDF, 0x6B, 
+0x7C, 0x53, 0x07, 0xB0, 0x61, 0xA6, 0x6E, 0x7C, 
+0xCE, 0x8A, 0x28, 0x99, 0x1D, 0x0A, 0xDF, 0xDB, 
+0xE3, 0x48, 0x4F, 0x49, 0xCA, 0xBE, 0x32, 0x9B, 
+0x2B, 0xCF, 0xF5, 0x80, 0xEB, 0x5D, 0x6F, 0xA6, 
+0x22, 0xF2, 0x1A, 0x86, 0xE2, 0xBD, 0x75, 0xE3, 
+0x4A, 0x52, 0x86, 0xAE, 0xA3, 0x8C, 0xD5, 0x6C, 
+0xC4, 0xB0, 0xAA, 0xF8, 0x33, 0xAF, 0x4E, 0xA3, 
+0x84, 0x6A, 0x21, 0xF3, 0xEB, 0xAC, 0xD2, 0x9D, 
+0x63, 0xF0, 0xC5, 0x59, 0x1B, 0x27, 0x72, 0x13, 
+0x31, 0xA6, 0x6C, 0x46, 0x28, 0xFA, 0x19, 0x25, 
+0xDC, 0xE0, 0x55, 0xAA, 0xC7, 0x52, 0x77, 0x7F, 
+0xB3, 0x69, 0x3E, 0x6F, 0x0F, 0xBD, 0xF2, 0x66, 
+
+0x3E, 0x61, 0x36, 0x3C, 0x2C, 0x16, 0x21, 0x2D, 
+0x0F, 0xF8, 0xE3, 0x00, 0xD3, 0x96, 0x28, 0x48, 
+0x78, 0x52, 0x11, 0xB9, 0x35, 0x87, 0xC0, 0xF7, 
+0x1A, 0xD1, 0xE2, 0x2E, 0x5A, 0xD0, 0x76, 0x73, 
+0x93, 0xC4, 0x22, 0xBF, 0x66, 0x4E, 0x61, 0xCB, 
+0x59, 0x56, 0xC1, 0xBA, 0x01, 0xC2, 0x77, 0x26, 
+0x6F, 0x65, 0xB4, 0x6E, 0x62, 0xBC, 0x18, 0xCF, 
+0xB4, 0x4A, 0x1E, 0x7A, 0xA9, 0xD2, 0x10, 0xFE, 
+0x4A, 0xBF, 0x6B, 0x25, 0xAC, 0x46, 0xF4, 0x42, 
+0x0E, 0x9D, 0xFE, 0x24, 0x19, 0x4F, 0x94, 0xAB, 
+0xCB, 0xE5, 0x3E, 0xD2, 0x8C, 0x58, 0xE2, 0x7E, 
+0x4D, 0xFD, 0x71, 0x13, 0x31, 0x34, 0x65, 0x9A, 
+0x5B, 0x31, 0xDB, 0x50, 0x7A, 0x5F, 0xC5, 0x14, 
+0xB8, 0x8E, 0xB7, 0x22, 0x5C, 0xDA, 0x0E, 0x5A, 
+0x74, 0xEB, 0x9F, 0xF4, 0xAF, 0x22, 0x36, 0xE4, 
+0x7E, 0x53, 0x6E, 0x17, 0xF1, 0x17, 0xAE, 0x38, 
+0x09, 0x21, 0xF8, 0x50, 0x79, 0xD9, 0x53, 0x1D, 
+0x96, 0xA2, 0x1E, 0x52, 0x99, 0x8D, 0x4B, 0x66, 
+0x8C, 0x1F, 0x28, 0xE0, 0x8A, 0x4F, 0xFD, 0x01, 
+0xD0, 0x34, 0xBD, 0x26, 0x67, 0xEF, 0xDB, 0x18, 
+0x89, 0x92, 0x90, 0xC2, 0xD9, 0x6C, 0x25, 0x8A, 
+0x6F, 0xDD, 0xD2, 0xC5, 0xD3, 0xE2, 0x1A, 0xE4, 
+0x3F, 0xF1, 0x75, 0x58, 0x20, 0x8B, 0x92, 0xE0, 
+0xC9, 0x46, 0x19, 0x92, 0x9F, 0xFC, 0x0E, 0x5E, 
+0xAE, 
+
+0x81, 0x8A, 0x81, 0x20, 0x54, 0x7F, 0x10, 0x2B, 
+0x6B, 0xF1, 0xFD, 0x12, 0x9E, 0x81, 0xB0, 0x69, 
+0x83, 0x45, 0x0D, 0xAE, 0x02, 0xD0, 0x1B, 0x4B, 
+0xB6, 0xB9, 0xF5, 0xE2, 0x98, 0xAF, 0x9D, 0xB4, 
+0x74, 0x93, 0xE0, 0x8D, 0x90, 0x75, 0xBB, 0x83, 
+0x3A, 0x3A, 0x5F, 0x4A, 0x3C, 0x52, 0x32, 0x18, 
+0x0B, 0xB6, 0x60, 0x0A, 0x7C, 0xC4, 0xDC, 0x54, 
+0x3E, 0x0B, 0x4C, 0x8E, 0xEF, 0x75, 0x5B, 0x76, 
+0xEB, 0xE5, 0x16, 0xEE, 0x6C, 0x76, 0x28, 0x36, 
+0x50, 0x70, 0xAC, 0x09, 0xE4, 0xD4, 0xF4, 0x01, 
+0xC3, 0xC7, 0x56, 0x59, 0x83, 0x61, 0x96, 0x30, 
+0x81, 0x42, 0x6A, 0x91, 0x2B, 0xB2, 0x3C, 0x9E, 
+0x4A, 0xA1, 0x61, 0xCE, 0xF9, 0xA4, 0x0E, 0x76, 
+0x34, 0x70, 0x4C, 0x94, 0xEE, 0xA5, 0x4C, 0xD7, 
+0x1F, 0x1D, 0xDB, 0x66, 0xE9, 0x2D, 0x8C, 0x1C, 
+0xF4, 0xFC, 0xF6, 0xB5, 0xB1, 0xF8, 0x82, 0x9E, 
+0x58, 0x95, 0x5B, 0x5F, 0x27, 0x3D, 0x82, 0x4F, 
+0x99, 0xF0, 0x0C, 0xC8, 0xB6, 0x67, 0x23, 0x6A, 
+0x53, 0x12, 0x52, 0xD3, 0x93, 0x46, 0xF6, 0x4A, 
+0xA0, 0x6E, 0xBA, 0x84, 0x48, 0xBC, 0x79, 0x1E, 
+0x24, 0x4D, 0xCE, 0x7D, 0x01, 0xDF, 0x71, 0xF8, 
+0x40, 0x7F, 0x0D, 0x6A, 0xED, 0x87, 0x98, 0xE1, 
+0x25, 0x43, 0x3A, 0x49, 0x0E, 0xF2, 0x2A, 0x97, 
+0xE4, 0x14, 0x9E, 0x21, 0x94, 0x0C, 0x07, 0xF7, 
+0xCA, 0x61, 
+
+0x58, 0xDE, 0x03, 0x46, 0x5B, 0xCC, 0x5B, 0x65, 
+0x77, 0x62, 0xF5, 0x3D, 0x92, 0x23, 0x7E, 0x6E, 
+0x12, 0xE8, 0x3B, 0xFE, 0x65, 0xD0, 0x14, 0x40, 
+0x23, 0x63, 0x56, 0xE2, 0x4E, 0xD9, 0xDF, 0x76, 
+0xF0, 0x5D, 0x1F, 0x25, 0xA7, 0xAC, 0x29, 0x16, 
+0xF3, 0x16, 0xB5, 0xC8, 0x76, 0x5F, 0x2A, 0x00, 
+0xEB, 0x39, 0x38, 0x08, 0xA5, 0xA6, 0x07, 0xB1, 
+0xDC, 0x78, 0xC0, 0xC5, 0xC4, 0x5C, 0x12, 0x03, 
+0xAD, 0x85, 0x1D, 0x7E, 0xF1, 0x09, 0x6F, 0x9C, 
+0x14, 0xA2, 0x06, 0xAB, 0xCE, 0x5F, 0x30, 0x13, 
+0x81, 0x98, 0x4A, 0x8F, 0x87, 0x84, 0x72, 0xC0, 
+0x72, 0x96, 0x36, 0xD0, 0x5A, 0x50, 0x30, 0xFA, 
+0x0E, 0x90, 0x9E, 0x35, 0xDA, 0x2F, 0x7C, 0xE5, 
+0xF6, 0x63, 0x91, 0x07, 0x88, 0x3C, 0xEC, 0x15, 
+0x18, 0x45, 0xB6, 0xAE, 0x56, 0xE7, 0x83, 0xC1, 
+0x31, 0xAE, 0x5C, 0x4C, 0xAA, 0x31, 0xC8, 0x5B, 
+0x06, 0xF5, 0x24, 0xF3, 0x6A, 0x51, 0xC4, 0x5E, 
+0x58, 0xE9, 0x22, 0x7F, 0xEF, 0x74, 0xF7, 0x6D, 
+0xF9, 0xD7, 0x8B, 0xBA, 0xC4, 0x33, 0x72, 0xAC, 
+0x4F, 0x26, 0x53, 0x1C, 0x15, 0x0A, 0x2E, 0x03, 
+0x28, 0x4D, 0x55, 0x50, 0x1B, 0x65, 0x5A, 0x83, 
+0x9C, 0x24, 0xF7, 0xC0, 0xC2, 0x1D, 0xBC, 0x5C, 
+0xE2, 0x4E, 0x39, 0x5C, 0x44, 0xE5, 0x7D, 0x65, 
+0x72, 0x33, 0x8A, 0xAE, 0xF7, 0xCE, 0x6A, 0x99, 
+0x6A, 0xF9, 0x6F, 
+
+0x5D, 0x10, 0x58, 0x55, 0xE7, 0x00, 0x66, 0xF3, 
+0x86, 0x81, 0xA0, 0x59, 0x91, 0x3F, 0x21, 0x8F, 
+0xF2, 0xA7, 0xFB, 0xF7, 0x3E, 0xAE, 0x03, 0x60, 
+0x67, 0xF3, 0xA7, 0xCF, 0xE8, 0x42, 0x9B, 0x62, 
+0x03, 0xF1, 0x4F, 0x3B, 0xA8, 0x23, 0x4C, 0x70, 
+0x4E, 0x42, 0x34, 0x51, 0x71, 0x88, 0x21, 0xF1, 
+0xA0, 0x19, 0xFA, 0x41, 0x66, 0x20, 0x34, 0xC3, 
+0x32, 0xE8, 0xB0, 0xF7, 0xCA, 0x93, 0x3E, 0x0D, 
+0xCD, 0xC4, 0x9F, 0x0E, 0xDE, 0x6D, 0x6F, 0xF2, 
+0x8E, 0x1C, 0x7F, 0x9D, 0x0F, 0x37, 0x40, 0x33, 
+0x65, 0x49, 0xCA, 0xC4, 0xE1, 0xC7, 0x52, 0x82, 
+0x9C, 0xE2, 0x4B, 0xA1, 0x97, 0x17, 0x67, 0x95, 
+0x19, 0xF8, 0xF8, 0x55, 0xDC, 0x35, 0xEA, 0x50, 
+0xD0, 0x86, 0x78, 0xF4, 0x35, 0xD0, 0x4F, 0x3F, 
+0x23, 0xE9, 0xE1, 0x82, 0x09, 0xEB, 0x59, 0x42, 
+0x61, 0xA8, 0x26, 0x34, 0x75, 0x5A, 0x52, 0x00, 
+0xE8, 0x47, 0xEF, 0x8C, 0xD1, 0xCF, 0xD2, 0x91, 
+0x6D, 0x82, 0x6C, 0xB3, 0x47, 0x40, 0xF1, 0x8B, 
+0x59, 0x2D, 0x85, 0x3C, 0x91, 0xD2, 0x0A, 0xA9, 
+0x22, 0x4D, 0xAC, 0x18, 0xD9, 0xA8, 0x08, 0x15, 
+0xD9, 0x4F, 0xE4, 0x0E, 0x46, 0x37, 0x51, 0x9A, 
+0x32, 0x1D, 0x6D, 0x31, 0x15, 0x0A, 0x26, 0xB0, 
+0x66, 0x7F, 0x58, 0x94, 0x1D, 0x43, 0x7B, 0x69, 
+0x0B, 0x8E, 0x8D, 0x56, 0x98, 0xE0, 0xC5, 0x24, 
+0x99, 0x35, 0x82, 0xB8, 
+
+0x4C, 0x41, 0xD7, 0x79, 0xB2, 0x5A, 0x38, 0x8F, 
+0x71, 0xF0, 0xC8, 0x7F, 0x99, 0xFE, 0xAD, 0xAC, 
+0x93, 0x7B, 0x88, 0xCF, 0xC3, 0xD4, 0xDE, 0x27, 
+0x25, 0x38, 0xB7, 0x46, 0xAF, 0x8E, 0xD4, 0x15, 
+0x05, 0xC6, 0x4F, 0x99, 0x88, 0x94, 0x0D, 0xD5, 
+0x22, 0x3C, 0xBF, 0x51, 0x0C, 0x92, 0x81, 0x5C, 
+0xFD, 0x6F, 0xB9, 0x4B, 0x33, 0x99, 0x27, 0x21, 
+0x12, 0x5D, 0x1C, 0x37, 0x9F, 0x52, 0x10, 0xDC, 
+0x5B, 0xD2, 0x82, 0xE4, 0x06, 0x95, 0xBB, 0x7F, 
+0xE7, 0x03, 0x66, 0x64, 0x64, 0x37, 0xB6, 0xAB, 
+0x94, 0x82, 0xF0, 0x2A, 0x21, 0x22, 0x91, 0x60, 
+0xA1, 0xEE, 0x2D, 0x00, 0x99, 0xF1, 0x13, 0x74, 
+0x03, 0xB0, 0xBA, 0xE2, 0x77, 0xBE, 0x3C, 0x61, 
+0x42, 0xD0, 0x58, 0x85, 0xC3, 0x4D, 0x65, 0x04, 
+0xD0, 0x88, 0xE8, 0xC9, 0xB3, 0x1D, 0x01, 0x40, 
+0xE6, 0x7C, 0x7A, 0x44, 0xC4, 0x7E, 0x53, 0xE2, 
+0x54, 0xA3, 0xAC, 0xE9, 0xF7, 0x7D, 0x68, 0x8A, 
+0xA9, 0x93, 0x2E, 0x87, 0x14, 0x90, 0xC6, 0xEA, 
+0x20, 0x87, 0x32, 0xE6, 0xD7, 0x2B, 0xED, 0x8B, 
+0x14, 0x0F, 0xAD, 0xEB, 0xFE, 0x9D, 0x13, 0xAC, 
+0x04, 0xE8, 0x44, 0xF6, 0xB9, 0xA6, 0xD9, 0x99, 
+0xF2, 0x97, 0xAC, 0xA7, 0x1F, 0xFD, 0x7A, 0xE7, 
+0xDD, 0xB4, 0x36, 0xB4, 0x5F, 0x95, 0x35, 0xB6, 
+0xE8, 0x0E, 0xB9, 0xD4, 0xFD, 0xBE, 0x9E, 0x85, 
+0x32, 0x49, 0x94, 0x87, 0x0B, 
+
+0x8F, 0xBE, 0x71, 0xED, 0xAE, 0xD6, 0x82, 0x3B, 
+0x04, 0xF0, 0x29, 0xE2, 0x0A, 0x80, 0x81, 0x2B, 
+0x82, 0x43, 0x44, 0x96, 0x3A, 0xA2, 0xDE, 0x66, 
+0xEF, 0xBE, 0xFA, 0xD3, 0x85, 0xE0, 0xA7, 0xFC, 
+0x92, 0xD1, 0x5C, 0x9A, 0x1E, 0xA5, 0x14, 0xFA, 
+0xAC, 0x29, 0x2B, 0xF7, 0x24, 0xD5, 0xAC, 0x4F, 
+0x37, 0xFB, 0xD5, 0x9C, 0x33, 0xE9, 0x07, 0x41, 
+0x49, 0x17, 0xC1, 0xAA, 0xAF, 0x39, 0x68, 0x01, 
+0xA2, 0xED, 0x70, 0xFD, 0xA6, 0x65, 0x20, 0xF8, 
+0xBA, 0x80, 0xCC, 0x4F, 0x91, 0xF2, 0xF0, 0x57, 
+0x75, 0xA0, 0x0B, 0xBF, 0x8D, 0x99, 0x9C, 0xF5, 
+0xBF, 0xAA, 0x2B, 0x1B, 0x51, 0x1C, 0x8F, 0x93, 
+0x61, 0x64, 0x62, 0x51, 0xC7, 0x9C, 0x3C, 0x9D, 
+0x1C, 0x87, 0x21, 0x48, 0x67, 0x15, 0x53, 0xD7, 
+0x54, 0x12, 0xE5, 0x00, 0x85, 0x72, 0x70, 0x31, 
+0x82, 0x0B, 0xEA, 0xDE, 0x93, 0x44, 0xF6, 0x73, 
+0xE6, 0x6E, 0x65, 0xC2, 0x0A, 0xC5, 0xCE, 0x4F, 
+0xB9, 0x1D, 0x60, 0x72, 0x62, 0x4B, 0xF1, 0x82, 
+0x7C, 0xD7, 0xF1, 0xFD, 0xD0, 0xF0, 0xB3, 0x3C, 
+0x2B, 0x4E, 0x0A, 0xEE, 0x29, 0x9F, 0x15, 0x90, 
+0x31, 0x2E, 0x50, 0x1F, 0x96, 0x67, 0x43, 0x99, 
+0xF9, 0x95, 0xAA, 0x69, 0x8B, 0x51, 0xD7, 0xFB, 
+0xFD, 0x38, 0x72, 0xBF, 0x87, 0x35, 0x9D, 0x0A, 
+0x40, 0xAD, 0x47, 0x4C, 0xAD, 0x7E, 0x4F, 0xED, 
+0xF6, 0xDA, 0x20, 0x6A, 0x1B, 0xAE, 
+
+0xEA, 0xC9, 0xBD, 0x14, 0x22, 0x26, 0x76, 0x26, 
+0x2E, 0xAB, 0x86, 0x40, 0x4E, 0x57, 0xEC, 0x37, 
+0x19, 0x4B, 0x41, 0xFC, 0x72, 0x73, 0xDD, 0xC7, 
+0x95, 0xF8, 0x56, 0x4A, 0xBB, 0xDD, 0xF9, 0x16, 
+0x73, 0xDF, 0x8F, 0xCC, 0xC8, 0x80, 0xDD, 0xBC, 
+0x0F, 0x4F, 0xCE, 0x40, 0x73, 0x0A, 0x52, 0x11, 
+0x70, 0x8F, 0x0C, 0xF0, 0xAC, 0xB0, 0xEC, 0xE3, 
+0x8E, 0x4A, 0x97, 0xE8, 0x63, 0x09, 0x94, 0x90, 
+0x9C, 0x9E, 0x8B, 0x68, 0x5F, 0xA4, 0x5E, 0x29, 
+0xB7, 0xE3, 0xDD, 0x7D, 0x69, 0x46, 0x63, 0xB4, 
+0x11, 0x56, 0x81, 0x1D, 0xF9, 0x64, 0x08, 0xB8, 
+0xF7, 0xFC, 0xDE, 0xF9, 0x04, 0x60, 0x5A, 0x9D, 
+0xEB, 0x02, 0xA2, 0x39, 0x48, 0x20, 0xB8, 0xB2, 
+0x9C, 0xE9, 0x5D, 0xE5, 0x50, 0xC8, 0xF4, 0x0E, 
+0xE8, 0x70, 0xC0, 0xA0, 0xED, 0xE6, 0x7F, 0x76, 
+0x6D, 0x6F, 0x15, 0x55, 0x22, 0x1F, 0xE8, 0x82, 
+0x46, 0xDA, 0x5A, 0x8E, 0xC9, 0x0F, 0x5A, 0x82, 
+0x2F, 0x65, 0xFC, 0x80, 0x94, 0xD1, 0x52, 0x66, 
+0xF0, 0x83, 0xEE, 0x25, 0x75, 0x06, 0x7F, 0x9B, 
+0xAB, 0x9A, 0x59, 0xA9, 0x26, 0x6E, 0xBB, 0x92, 
+0x84, 0xF1, 0x8B, 0xC8, 0x0A, 0x78, 0x85, 0x99, 
+0x1F, 0x59, 0x40, 0xFE, 0x0F, 0x24, 0xC7, 0x6D, 
+0x9D, 0x2E, 0x38, 0x8D, 0x46, 0xDF, 0x48, 0xFB, 
+0xD7, 0x30, 0x7F, 0x66, 0x0E, 0x03, 0xDD, 0xE4, 
+0xCC, 0xE3, 0x64, 0x2C, 0xFA, 0x75, 0x88, 
+
+0x2D, 0x22, 0xC2, 0xD7, 0x38, 0x32, 0xBE, 0x63, 
+0xD8, 0x63, 0x41, 0x88, 0xFD, 0xEA, 0xE6, 0xD0, 
+0x47, 0xFA, 0x24, 0x69, 0x6E, 0xA5, 0x33, 0x17, 
+0x7E, 0x8C, 0xB1, 0xBB, 0xD6, 0xD5, 0xAF, 0xE5, 
+0x62, 0x13, 0x5B, 0xAC, 0xCC, 0x47, 0x99, 0xFD, 
+0x3F, 0x57, 0xCA, 0x18, 0xAA, 0x1D, 0xA2, 0x25, 
+0xA3, 0x21, 0x9D, 0x3D, 0x2A, 0x7B, 0x67, 0xBE, 
+0xD9, 0xBD, 0x45, 0x61, 0x4F, 0xE2, 0x55, 0x88, 
+0xEC, 0x2A, 0x0A, 0x99, 0xB8, 0x87, 0xD0, 0x0E, 
+0xF8, 0x0C, 0x8F, 0x73, 0xA6, 0x95, 0x90, 0xEA, 
+0x08, 0x4F, 0x2F, 0x63, 0x96, 0x30, 0xBD, 0x1C, 
+0x65, 0x44, 0xE9, 0x36, 0xF3, 0x86, 0x71, 0xD1, 
+0x75, 0x7E, 0x3A, 0xB0, 0x86, 0x0C, 0xAF, 0xFE, 
+0xEE, 0x52, 0xDB, 0xF2, 0xF5, 0x4D, 0x0B, 0xA8, 
+0x91, 0x6B, 0xE9, 0xC3, 0x7A, 0xFE, 0x56, 0xD2, 
+0x8B, 0x37, 0x9D, 0xE3, 0xC0, 0xC9, 0x30, 0xCA, 
+0xA6, 0x22, 0x9E, 0x21, 0x95, 0x64, 0xEB, 0xBD, 
+0x73, 0x33, 0x49, 0x73, 0x17, 0x0A, 0x95, 0x23, 
+0x5F, 0xAC, 0xD5, 0x42, 0x60, 0x19, 0x36, 0xA0, 
+0x7E, 0x2B, 0x23, 0x2A, 0x40, 0xDF, 0xCB, 0x73, 
+0x89, 0xB9, 0xC8, 0x42, 0x4A, 0xC8, 0x7A, 0x6F, 
+0x90, 0x40, 0x6D, 0xF5, 0xD2, 0x6A, 0x2E, 0x6A, 
+0x7C, 0x35, 0xBE, 0x08, 0x03, 0x8C, 0xF9, 0x39, 
+0x49, 0x22, 0x88, 0x1C, 0x40, 0xDE, 0x67, 0xE8, 
+0x95, 0xB4, 0x07, 0xB8, 0x1E, 0xE0, 0x69, 0x65, 
+
+0x87, 0xC3, 0xA1, 0x43, 0xB9, 0x38, 0x63, 0x0B, 
+0x4A, 0x62, 0x3B, 0xA0, 0x12, 0xB5, 0x8A, 0x9E, 
+0x84, 0x20, 0xC8, 0xF4, 0x75, 0x7A, 0xEC, 0x98, 
+0xE2, 0x0E, 0xCA, 0x64, 0x3C, 0xD3, 0x1E, 0x6E, 
+0x00, 0xD5, 0x24, 0x33, 0xE8, 0x05, 0x35, 0x4A, 
+0xE6, 0x9E, 0x79, 0xE1, 0xC9, 0x66, 0x3E, 0xA5, 
+0x04, 0x99, 0xD6, 0x7B, 0x2D, 0x14, 0x81, 0x88, 
+0x3A, 0x22, 0x81, 0xFE, 0x9A, 0x6C, 0xB4, 0x73, 
+0x9A, 0x2F, 0x00, 0xE6, 0x2E, 0xCA, 0x5A, 0x5D, 
+0x1F, 0xA9, 0x1A, 0x88, 0xED, 0xDB, 0xF8, 0x28, 
+0x31, 0xC2, 0x6B, 0x6B, 0xF9, 0xBA, 0xD5, 0x7B, 
+0xE5, 0x5E, 0xC0, 0xAE, 0xB3, 0x5A, 0x2F, 0x25, 
+0x7E, 0x0E, 0x54, 0x40, 0xC5, 0x13, 0xAE, 0xCC, 
+0xF5, 0x0D, 0x6A, 0x93, 0x8D, 0xFD, 0xCF, 0x82, 
+0xD6, 0x79, 0xDF, 0xDC, 0xD6, 0x81, 0xD2, 0x81, 
+0x54, 0xF9, 0x82, 0x66, 0x7F, 0x9F, 0xF5, 0x9A, 
+0xBA, 0x95, 0xFF, 0x2C, 0x18, 0xB8, 0x2E, 0xAC, 
+0x27, 0x51, 0x84, 0x70, 0x74, 0x48, 0x11, 0xE4, 
+0xA5, 0xA1, 0x8B, 0x58, 0xB9, 0x40, 0xB2, 0xDB, 
+0xED, 0xF9, 0xD3, 0x51, 0x85, 0x36, 0xA3, 0xE6, 
+0x34, 0xFB, 0xC1, 0xDB, 0x0F, 0xB8, 0xBF, 0x8C, 
+0x74, 0xC6, 0xF3, 0x52, 0x86, 0x55, 0xC0, 0xDC, 
+0x30, 0x87, 0x3B, 0x19, 0x94, 0xDA, 0x5C, 0xC1, 
+0xA5, 0xA4, 0xB5, 0xB4, 0x7F, 0x9E, 0xE7, 0x70, 
+0x8F, 0x80, 0xD4, 0x78, 0xEF, 0xA6, 0x2A, 0x4C, 
+0xD2, 
+
+0xE0, 0xFD, 0x1E, 0x63, 0xC2, 0x73, 0x07, 0x22, 
+0x98, 0xB1, 0x97, 0x72, 0xE1, 0x39, 0x83, 0x14, 
+0xFB, 0x41, 0x83, 0x5A, 0xE7, 0xA6, 0xE9, 0x00, 
+0xFF, 0x7B, 0x74, 0x63, 0x59, 0x93, 0x12, 0x5F, 
+0x76, 0x6E, 0x92, 0xCE, 0x3B, 0x15, 0xA2, 0x8D, 
+0x22, 0xC0, 0x0B, 0xC5, 0xE1, 0xEB, 0xDC, 0x76, 
+0xBB, 0xC8, 0x78, 0xFB, 0xDD, 0x93, 0xC2, 0xC0, 
+0xE3, 0xA2, 0x60, 0xAB, 0x60, 0xC6, 0xE0, 0x3E, 
+0x27, 0xF3, 0x21, 0xF3, 0xD7, 0x6F, 0x3F, 0x80, 
+0x81, 0x15, 0xF8, 0x13, 0x55, 0xA0, 0xC3, 0x49, 
+0x1F, 0xAE, 0x8C, 0x1B, 0xB8, 0x5A, 0xB1, 0x90, 
+0xA9, 0xEA, 0xB9, 0x1B, 0xBC, 0xC9, 0xF5, 0x4A, 
+0xF6, 0x28, 0x06, 0xC5, 0xF2, 0xFF, 0x6D, 0x63, 
+0x2C, 0x17, 0xBF, 0x34, 0xD3, 0xFE, 0x57, 0xD3, 
+0xF6, 0x4A, 0x71, 0x23, 0xB7, 0x01, 0xC7, 0xB9, 
+0x6A, 0x53, 0x56, 0xDB, 0x6B, 0xD7, 0x77, 0xD4, 
+0x76, 0x70, 0xF2, 0xF6, 0x49, 0xEE, 0x40, 0x41, 
+0x59, 0xFA, 0x64, 0xDC, 0xBF, 0x10, 0xD4, 0x11, 
+0x13, 0x02, 0xA7, 0x17, 0xCB, 0x2A, 0xC6, 0x62, 
+0x81, 0x75, 0xAC, 0x19, 0x5F, 0xA6, 0x92, 0x2E, 
+0x6F, 0x7C, 0xC6, 0x59, 0x6D, 0x21, 0x3B, 0xC5, 
+0x39, 0x00, 0x53, 0xC7, 0xC4, 0x29, 0xD3, 0x9E, 
+0x4F, 0x9D, 0xD8, 0x45, 0xE8, 0xA2, 0xD5, 0xF3, 
+0x0B, 0x14, 0x7D, 0x76, 0x2C, 0x7C, 0xEE, 0xA4, 
+0x47, 0xE1, 0x99, 0x6F, 0x99, 0x86, 0xA6, 0xA9, 
+0xDA, 0x59, 
+
+0x29, 0xF3, 0x94, 0x50, 0xAE, 0xE7, 0xF5, 0xB6, 
+0xDF, 0xD8, 0x63, 0x7A, 0x87, 0xF5, 0xF0, 0x4A, 
+0xB8, 0xB9, 0x72, 0xD8, 0xE1, 0xB3, 0xC0, 0x2D, 
+0x9B, 0x4A, 0x3D, 0x7D, 0x90, 0x3A, 0x72, 0xB8, 
+0x1D, 0x5E, 0x14, 0x52, 0xD4, 0x25, 0x3E, 0x13, 
+0x8C, 0x89, 0xD6, 0xB7, 0x36, 0x6D, 0x62, 0x55, 
+0xE6, 0x81, 0xE1, 0xF6, 0xFC, 0x87, 0xAF, 0x1C, 
+0x92, 0xD9, 0x1A, 0xFA, 0x71, 0x85, 0x83, 0xDC, 
+0x62, 0x0F, 0xF2, 0xA0, 0x1F, 0xE1, 0xAA, 0xDE, 
+0x03, 0x78, 0x1D, 0x1E, 0x46, 0x36, 0xA6, 0xBC, 
+0x36, 0xC4, 0x14, 0xCB, 0x3D, 0x5B, 0x6F, 0xDF, 
+0x1E, 0x3F, 0x43, 0x53, 0x69, 0x07, 0x9C, 0x60, 
+0x31, 0xB9, 0xED, 0xE2, 0x41, 0xA6, 0xAA, 0xB5, 
+0x5E, 0x70, 0x12, 0xC5, 0xE1, 0x9C, 0x19, 0x62, 
+0x77, 0x3B, 0xE4, 0x2E, 0xC2, 0x83, 0x3E, 0xB6, 
+0xD7, 0x28, 0x97, 0x4A, 0x85, 0x62, 0xAB, 0xE2, 
+0x66, 0x01, 0x9C, 0xA4, 0x27, 0x1D, 0xF3, 0x0D, 
+0x1E, 0x31, 0xD7, 0x6D, 0xF4, 0x85, 0xB0, 0x34, 
+0xDA, 0x3C, 0xAE, 0xD4, 0x63, 0x71, 0x92, 0x11, 
+0xCD, 0x8F, 0xB1, 0x42, 0x7A, 0x03, 0xC9, 0xE4, 
+0x43, 0xA4, 0xE9, 0xD8, 0x18, 0xB4, 0x2F, 0x0A, 
+0x22, 0xB2, 0xBC, 0xD3, 0xE3, 0xDB, 0xBE, 0xC7, 
+0xAD, 0x8E, 0xC9, 0xF3, 0x19, 0xFC, 0x9D, 0x28, 
+0x40, 0x46, 0x2F, 0x51, 0xB5, 0x4D, 0x40, 0x06, 
+0x81, 0xEA, 0xC4, 0x9E, 0xA1, 0xD3, 0xCF, 0x72, 
+0xB1, 0x49, 0x7B, 
+
+0x9D, 0x01, 0xC6, 0x80, 0xD2, 0x72, 0x37, 0x49, 
+0x24, 0x45, 0x36, 0x07, 0xC4, 0xF0, 0xA8, 0xA7, 
+0x1D, 0x9C, 0xC6, 0xC9, 0x4E, 0x74, 0xFB, 0xE9, 
+0x60, 0xDB, 0xD5, 0x80, 0x37, 0x29, 0x58, 0x66, 
+0x5A, 0xF5, 0xDC, 0xE4, 0xC4, 0x35, 0x11, 0x2E, 
+0x78, 0xD4, 0xFE, 0xFB, 0x74, 0x4F, 0x1F, 0x0F, 
+0xDC, 0xAC, 0x3F, 0x9D, 0x51, 0x86, 0x5C, 0xBA, 
+0xD4, 0x75, 0x48, 0x01, 0xCB, 0x77, 0xA0, 0xCB, 
+0x4A, 0x70, 0x4F, 0xA9, 0x1A, 0x18, 0x8B, 0xBF, 
+0xC8, 0xE4, 0x35, 0xEC, 0xB3, 0x5F, 0x03, 0x31, 
+0x10, 0xA9, 0x03, 0x78, 0x9E, 0xBC, 0xCB, 0x3B, 
+0x51, 0x66, 0x5B, 0xC9, 0xB8, 0x81, 0x54, 0x5F, 
+0x3E, 0x80, 0xDC, 0x9F, 0xED, 0x75, 0xAE, 0x55, 
+0xB9, 0x67, 0x2D, 0x59, 0xA0, 0x59, 0x18, 0xD4, 
+0x50, 0x46, 0xD2, 0xDF, 0x7D, 0xB4, 0x40, 0xFA, 
+0xC6, 0xBC, 0x33, 0x82, 0xE6, 0x94, 0x58, 0x41, 
+0xBB, 0x08, 0xAF, 0x88, 0x30, 0x73, 0x45, 0x61, 
+0x9D, 0x4B, 0x01, 0xCE, 0x7E, 0x09, 0xC8, 0x4B, 
+0x41, 0x44, 0xEC, 0xE3, 0xBA, 0x3C, 0xBB, 0x50, 
+0xDD, 0xB4, 0xAA, 0x94, 0xB4, 0x76, 0xE4, 0xCA, 
+0x5B, 0xC5, 0x52, 0xA5, 0x3D, 0x53, 0xAF, 0x8F, 
+0xFE, 0x61, 0x54, 0xEB, 0x22, 0x84, 0x13, 0xD0, 
+0x70, 0x48, 0x56, 0x3E, 0xCE, 0x66, 0xCB, 0xFE, 
+0xD6, 0xE4, 0xD6, 0x9E, 0x7E, 0x51, 0xCC, 0x04, 
+0x37, 0x61, 0xC9, 0xEC, 0x35, 0xE4, 0x3D, 0x50, 
+0xD7, 0xB1, 0xCE, 0x7F, 
+
+0xB9, 0x99, 0xAB, 0xF8, 0xA7, 0x51, 0xDF, 0x33, 
+0x50, 0x7F, 0xB6, 0xE0, 0x71, 0x78, 0xAA, 0x9B, 
+0xE4, 0x59, 0x61, 0x78, 0x4D, 0x5F, 0x39, 0x51, 
+0x4A, 0x53, 0xDA, 0x77, 0x96, 0xE9, 0x75, 0xB2, 
+0xD4, 0x1B, 0xF0, 0x1F, 0xCA, 0x07, 0x37, 0x78, 
+0x06, 0xDE, 0x2C, 0x55, 0x7A, 0xEB, 0x4C, 0xA2, 
+0xB2, 0x26, 0xEC, 0x11, 0xD5, 0x25, 0x4C, 0x48, 
+0x52, 0xFF, 0x21, 0xA4, 0x6D, 0xC4, 0xED, 0x83, 
+0x2F, 0xC9, 0x8B, 0x6C, 0x37, 0x93, 0xC1, 0x1D, 
+0xB9, 0x2B, 0xF4, 0xF9, 0x49, 0xA4, 0x6B, 0xA3, 
+0x06, 0xC0, 0x13, 0x74, 0x05, 0xB1, 0xA2, 0xA8, 
+0x51, 0x2C, 0x5C, 0x43, 0x53, 0x0D, 0xD6, 0x7C, 
+0xD3, 0x0E, 0x64, 0x4B, 0xCD, 0x40, 0xF5, 0xA4, 
+0x23, 0x03, 0x4E, 0x7F, 0x67, 0x6D, 0x82, 0xB0, 
+0x2D, 0x10, 0x4B, 0x2D, 0xA9, 0xA3, 0xD9, 0xBD, 
+0x66, 0x29, 0xD3, 0x74, 0x11, 0x7D, 0x98, 0x7E, 
+0x9D, 0xB9, 0x02, 0x97, 0x9E, 0x3C, 0xBB, 0x68, 
+0x78, 0xB0, 0x25, 0x9C, 0x1F, 0x39, 0xC7, 0xD4, 
+0xC8, 0xBD, 0xA8, 0xF3, 0xB0, 0x25, 0xF3, 0xAA, 
+0xBD, 0x0E, 0x6D, 0xD2, 0x34, 0x56, 0x4F, 0x1C, 
+0xDC, 0x81, 0x36, 0x06, 0xC7, 0x11, 0xA6, 0xE4, 
+0x07, 0xBF, 0x6A, 0x22, 0xD1, 0xD2, 0x94, 0x22, 
+0xD7, 0x0D, 0xE6, 0x1B, 0x44, 0xC6, 0xBF, 0xBF, 
+0x70, 0x6B, 0x9C, 0x47, 0xE9, 0xBD, 0x07, 0x0E, 
+0xCA, 0xA6, 0x9D, 0x78, 0x88, 0x47, 0x38, 0x6D, 
+0xC7, 0x83, 0xF3, 0xE3, 0x81, 
+
+0x5A, 0xAE, 0xE7, 0x26, 0x82, 0xA4, 0xF6, 0x65, 
+0xDD, 0x16, 0xE1, 0xE5, 0xA9, 0x27, 0x96, 0x12, 
+0xBA, 0x3D, 0xCF, 0xFB, 0xBD, 0xDE, 0x2D, 0x45, 
+0xD0, 0xE4, 0x45, 0x65, 0x9C, 0xB5, 0xC2, 0xDF, 
+0x8F, 0x41, 0xA5, 0xD9, 0x30, 0xC3, 0xC5, 0x81, 
+0xA2, 0xDA, 0x13, 0x09, 0x08, 0xAE, 0x43, 0x62, 
+0x90, 0xAF, 0x1B, 0xBA, 0x51, 0x06, 0xE1, 0xB2, 
+0xA7, 0x8A, 0x94, 0xBA, 0x79, 0x27, 0xE7, 0x68, 
+0x81, 0x7C, 0x05, 0xD9, 0x71, 0x58, 0xC3, 0x0D, 
+0x9B, 0x2C, 0x25, 0x41, 0xA2, 0x98, 0x2C, 0x94, 
+0x5C, 0x47, 0xC6, 0x81, 0x8A, 0x54, 0xB9, 0x25, 
+0x7E, 0x16, 0xE1, 0x45, 0xB3, 0x6D, 0x00, 0x5A, 
+0x28, 0xB8, 0xBC, 0xD5, 0x76, 0xBA, 0x30, 0x8C, 
+0xD8, 0xB4, 0xD7, 0xF6, 0x19, 0xC4, 0x65, 0x1D, 
+0xEA, 0x22, 0xCF, 0x9B, 0xBD, 0xC4, 0xDF, 0xB1, 
+0xD9, 0xA0, 0xA0, 0xCE, 0xD1, 0x9F, 0xCC, 0x07, 
+0xC5, 0xF4, 0x8C, 0xB2, 0xA3, 0xEB, 0x8F, 0x4A, 
+0x13, 0xC6, 0x48, 0x1A, 0x89, 0xB2, 0x39, 0xE9, 
+0x0E, 0x1A, 0x30, 0x02, 0x68, 0x19, 0x4B, 0x8E, 
+0x83, 0x3F, 0x4D, 0x19, 0x44, 0x8E, 0x3B, 0x57, 
+0xCC, 0x1B, 0xEB, 0xAA, 0xC3, 0x7D, 0xA4, 0x2D, 
+0xBA, 0xC8, 0x51, 0x08, 0x14, 0x90, 0xF9, 0x2D, 
+0xF9, 0x8F, 0xD9, 0x9B, 0xCF, 0xEF, 0x79, 0x95, 
+0xDA, 0x53, 0x8D, 0xA9, 0x41, 0x54, 0x37, 0xBB, 
+0xBF, 0x1B, 0x64, 0xC2, 0xC9, 0x09, 0x24, 0xD5, 
+0xCB, 0x1A, 0x1F, 0x6C, 0x43, 0x1C, 
+
+0x35, 0x0A, 0x6D, 0xE8, 0xAE, 0x43, 0xCE, 0x5E, 
+0x34, 0xF5, 0xDB, 0x18, 0xAA, 0x6C, 0xD3, 0x37, 
+0x54, 0x7E, 0x6B, 0x03, 0x1D, 0xF2, 0x14, 0xA7, 
+0x8A, 0x14, 0xA5, 0x96, 0x93, 0x57, 0x36, 0x0A, 
+0x3D, 0xB3, 0x80, 0x6A, 0x2E, 0x33, 0xDE, 0x63, 
+0xFF, 0x83, 0x2E, 0x0E, 0xEF, 0xE8, 0x1B, 0x13, 
+0xD2, 0x87, 0x0A, 0xF8, 0xE9, 0x4A, 0x4E, 0xBF, 
+0xF4, 0x73, 0x7C, 0x75, 0x70, 0x4A, 0x48, 0xA4, 
+0x66, 0xC3, 0x54, 0xDF, 0x15, 0x1F, 0x28, 0x04, 
+0xB6, 0xAE, 0xB5, 0x29, 0xAD, 0xC0, 0xA7, 0x5D, 
+0xE6, 0xCA, 0x4D, 0xDC, 0xF4, 0xAE, 0x9A, 0x93, 
+0x30, 0x2F, 0x11, 0x00, 0x1F, 0xB6, 0x5C, 0xEE, 
+0x97, 0xC2, 0x4E, 0x59, 0xB7, 0xCA, 0xE2, 0xEC, 
+0x31, 0x6B, 0x41, 0xFC, 0x9D, 0x3D, 0x8B, 0x29, 
+0xFB, 0x8E, 0x27, 0x75, 0x97, 0xC3, 0x9E, 0x06, 
+0xA6, 0xD1, 0x66, 0xF2, 0xD5, 0xB5, 0xEE, 0x52, 
+0x1A, 0x03, 0x66, 0xE3, 0xA9, 0x7B, 0x9F, 0x0A, 
+0x76, 0xF5, 0x6E, 0xD9, 0xA9, 0x35, 0xFB, 0x62, 
+0x00, 0x18, 0x91, 0xCD, 0xF9, 0x06, 0x0F, 0x9C, 
+0xDA, 0x81, 0x31, 0xFA, 0x0E, 0x9F, 0x9A, 0x2A, 
+0x12, 0x00, 0x2E, 0xB6, 0xD9, 0xB1, 0x31, 0x91, 
+0x11, 0x34, 0xB1, 0x46, 0x93, 0x95, 0xF8, 0xF2, 
+0x95, 0xD8, 0xA4, 0x6C, 0x13, 0xEF, 0x5A, 0xED, 
+0x6A, 0xB7, 0x09, 0x18, 0xC4, 0x98, 0x2D, 0xB5, 
+0x69, 0x2A, 0x66, 0xD9, 0x6D, 0xFF, 0x9E, 0x2E, 
+0x84, 0x89, 0x2E, 0x0F, 0x14, 0xD8, 0x38, 
+
+0x50, 0xEF, 0xFB, 0xC6, 0xDD, 0x10, 0xE5, 0x2C, 
+0x4C, 0x61, 0x40, 0x43, 0x7D, 0x70, 0x31, 0x5B, 
+0xCC, 0x10, 0x84, 0x4F, 0x6B, 0x25, 0x49, 0x61, 
+0xCC, 0xF8, 0xEC, 0x3B, 0x73, 0x61, 0xCD, 0xA3, 
+0x7F, 0xB5, 0x77, 0x70, 0x52, 0xB0, 0x30, 0x6C, 
+0x94, 0x2B, 0x07, 0x62, 0xFF, 0x6F, 0x0C, 0x55, 
+0x3D, 0x63, 0xB3, 0xAD, 0xAF, 0x62, 0x6B, 0x64, 
+0x35, 0x18, 0x02, 0x43, 0xB1, 0xEC, 0x4C, 0xD8, 
+0xBE, 0x05, 0xDC, 0x01, 0xF3, 0x8B, 0xFD, 0x57, 
+0xF7, 0x49, 0x02, 0xE5, 0xC9, 0x4D, 0xAA, 0xD1, 
+0xA1, 0x11, 0x37, 0x3A, 0x0C, 0x40, 0xA9, 0xB2, 
+0xED, 0xEF, 0xAE, 0x96, 0xDD, 0xE6, 0x9C, 0x7B, 
+0xC8, 0x9F, 0xC5, 0x21, 0xF8, 0x12, 0xC3, 0x8C, 
+0x97, 0x02, 0x1C, 0xB4, 0x3E, 0x72, 0x46, 0xB0, 
+0xA1, 0xFD, 0xE5, 0x36, 0x58, 0x03, 0x5B, 0x64, 
+0xF3, 0x7C, 0x23, 0x9E, 0xC6, 0x39, 0x0F, 0xEA, 
+0xCA, 0x6D, 0x5A, 0xFA, 0xA1, 0x69, 0x21, 0x62, 
+0xA7, 0x68, 0xCA, 0xEB, 0x0D, 0xBF, 0xC4, 0x21, 
+0x9C, 0xC0, 0x20, 0x7F, 0x1E, 0xDD, 0x3E, 0x6B, 
+0x0B, 0xCF, 0x67, 0x32, 0xB7, 0xC3, 0x15, 0x2D, 
+0xE9, 0x44, 0x22, 0x5A, 0x9C, 0xB2, 0xD6, 0x72, 
+0xB4, 0x41, 0xDA, 0xB7, 0x96, 0xD5, 0x78, 0xB2, 
+0xCA, 0xEC, 0x6B, 0x9E, 0x28, 0x3F, 0x86, 0xCA, 
+0xAB, 0xF2, 0x1E, 0xDB, 0x73, 0xEE, 0x54, 0x75, 
+0xAF, 0x1F, 0x9F, 0x6E, 0xFF, 0x79, 0xD8, 0x35, 
+0xAB, 0xB0, 0x81, 0xD1, 0x2B, 0x80, 0x75, 0x9B, 
+
+0x9D, 0x30, 0x0E, 0xA1, 0x6B, 0x96, 0x67, 0xDE, 
+0x13, 0xB5, 0xBE, 0x73, 0x7E, 0x3E, 0x9D, 0x4F, 
+0x86, 0xA2, 0xCB, 0x4F, 0xA1, 0x71, 0xAB, 0x53, 
+0x9D, 0x97, 0x4A, 0xE5, 0x33, 0xBF, 0x23, 0x60, 
+0x22, 0xCC, 0x48, 0x39, 0x38, 0x81, 0xDB, 0xDE, 
+0xD8, 0x23, 0x75, 0xBE, 0x34, 0xB3, 0xE1, 0xDD, 
+0xE5, 0xA8, 0x92, 0xDB, 0x5F, 0xE8, 0x5B, 0x67, 
+0xA4, 0x54, 0xA2, 0x36, 0x6A, 0x06, 0xFD, 0x55, 
+0x97, 0x80, 0x7A, 0x7E, 0xEF, 0xB6, 0x3C, 0x9D, 
+0xF5, 0x97, 0x05, 0x5D, 0xDE, 0x50, 0x28, 0xF2, 
+0x75, 0x9D, 0x0D, 0x8A, 0x9B, 0xDD, 0xA8, 0x8E, 
+0xB7, 0x95, 0x82, 0x5A, 0xC5, 0x86, 0xA2, 0xC5, 
+0x43, 0x57, 0xF6, 0x47, 0xCD, 0x0B, 0x0E, 0xE4, 
+0x23, 0x8B, 0x95, 0x8E, 0x24, 0x93, 0xC6, 0xC1, 
+0x5E, 0xA1, 0x37, 0x25, 0x9D, 0x86, 0x81, 0xD0, 
+0x8D, 0xF6, 0x13, 0xE2, 0x38, 0xB6, 0x72, 0xD9, 
+0xC7, 0x7D, 0x15, 0x31, 0x70, 0x8F, 0x3D, 0xE9, 
+0xFE, 0x65, 0xC8, 0xF0, 0x65, 0x2C, 0x90, 0x47, 
+0xF1, 0x7B, 0x8D, 0xE2, 0x9B, 0xAB, 0x89, 0x20, 
+0x2F, 0x1E, 0x16, 0x04, 0x7B, 0xFE, 0x0B, 0x89, 
+0xED, 0x0F, 0x20, 0x9F, 0x9E, 0x83, 0xB7, 0x3F, 
+0xFC, 0xCC, 0xBD, 0x8C, 0xE0, 0xD2, 0xDA, 0x4D, 
+0xE6, 0xA2, 0xDC, 0xA2, 0x97, 0x3C, 0xA2, 0xEB, 
+0xFC, 0x96, 0xDC, 0x9A, 0x9E, 0x13, 0x22, 0x1D, 
+0x6D, 0xBC, 0xBD, 0xB2, 0x1E, 0xAA, 0x0E, 0x97, 
+0xF6, 0x09, 0xCC, 0x54, 0x07, 0x30, 0x7D, 0x37, 
+0x62, 
+
+0xC1, 0x21, 0x1D, 0x32, 0xF8, 0x07, 0x82, 0xCC, 
+0xE9, 0x92, 0xC0, 0x74, 0xE5, 0xA4, 0x07, 0x9F, 
+0x0B, 0x66, 0x19, 0x11, 0x2B, 0xBC, 0x4D, 0x3A, 
+0xD5, 0x9A, 0x40, 0xB5, 0x65, 0x6C, 0x7D, 0x1D, 
+0x19, 0x37, 0xCB, 0xA6, 0x71, 0x57, 0x49, 0xDB, 
+0x75, 0x77, 0xFF, 0x04, 0x37, 0x0F, 0x14, 0xFF, 
+0xB2, 0x64, 0x45, 0xDE, 0xB4, 0x57, 0x5E, 0x0B, 
+0x0B, 0x3F, 0xE8, 0x37, 0xE6, 0xA3, 0xD4, 0xC5, 
+0x08, 0xE8, 0x14, 0xF0, 0x40, 0x1D, 0x89, 0x7C, 
+0xE5, 0x5B, 0xDA, 0xDB, 0xF2, 0xA3, 0x17, 0x97, 
+0xCA, 0xA6, 0x8D, 0x66, 0xF5, 0xF5, 0xAE, 0x28, 
+0xD8, 0x64, 0xD5, 0x66, 0xC3, 0xE0, 0x38, 0xD1, 
+0xED, 0x3B, 0x73, 0x80, 0x02, 0x4C, 0x83, 0x0A, 
+0x81, 0x1C, 0x1C, 0xC5, 0xCF, 0x7C, 0x6B, 0x54, 
+0x6E, 0xD7, 0xB1, 0x8F, 0x9E, 0xF7, 0x06, 0x11, 
+0x93, 0xF1, 0x52, 0x96, 0x0A, 0x48, 0x1C, 0x4E, 
+0x4E, 0xF8, 0xDB, 0x77, 0x61, 0x81, 0xE4, 0xC4, 
+0xFB, 0x7E, 0x6F, 0x15, 0x2B, 0xE1, 0x68, 0x58, 
+0x20, 0xDF, 0xC5, 0xC0, 0xAD, 0x5B, 0x8C, 0x4A, 
+0x98, 0x21, 0xF8, 0xF3, 0xEF, 0xD8, 0xAA, 0xFA, 
+0x1F, 0x9B, 0x52, 0x90, 0x11, 0x0A, 0x38, 0x06, 
+0x11, 0x51, 0x75, 0x14, 0xFD, 0x09, 0x4B, 0x59, 
+0xD9, 0x1E, 0x1F, 0xA2, 0x07, 0xBA, 0x54, 0x0D, 
+0xDA, 0xE1, 0x22, 0x5C, 0x8A, 0x84, 0x7C, 0x20, 
+0x43, 0xE4, 0x24, 0xA8, 0x5C, 0xB2, 0x31, 0x55, 
+0xAE, 0xAB, 0x74, 0x3C, 0x54, 0xFF, 0xA9, 0xCA, 
+0xE9, 0x20, 
+
+0x01, 0x22, 0x2C, 0x56, 0x8D, 0x34, 0xBF, 0xF3, 
+0x4D, 0x35, 0x3E, 0x67, 0xB8, 0xCC, 0x77, 0x14, 
+0x82, 0xA3, 0xB4, 0xC9, 0x31, 0x68, 0xEA, 0xAD, 
+0x4F, 0x53, 0xE5, 0x56, 0xB3, 0x74, 0xE1, 0xC1, 
+0x3D, 0xCD, 0x7E, 0x1B, 0x60, 0xCD, 0x8D, 0xDB, 
+0xA3, 0x23, 0x09, 0x40, 0x17, 0xA7, 0xB0, 0xA7, 
+0xA7, 0x78, 0x57, 0xA8, 0x22, 0x65, 0xBF, 0xCA, 
+0x71, 0x0C, 0xAC, 0x1D, 0x62, 0x5B, 0xCA, 0x4E, 
+0x5D, 0x26, 0xEA, 0x62, 0xB6, 0xAA, 0xBF, 0x3A, 
+0xBE, 0xAB, 0x13, 0xDB, 0x2C, 0x11, 0x7F, 0xD9, 
+0x45, 0xDE, 0x92, 0x92, 0xE9, 0xBA, 0xF4, 0x69, 
+0x13, 0x6E, 0x21, 0xB5, 0x8F, 0x38, 0x74, 0xD7, 
+0x28, 0x1A, 0x32, 0xAE, 0x52, 0x93, 0x7C, 0x44, 
+0xBD, 0x41, 0xA4, 0xDC, 0x35, 0xFD, 0xBF, 0xC2, 
+0x77, 0xA8, 0x2A, 0x91, 0x89, 0xD4, 0xBC, 0x0E, 
+0xAF, 0x76, 0x1B, 0x71, 0xDA, 0x1A, 0x06, 0x23, 
+0xE6, 0x6D, 0x6A, 0x91, 0xEB, 0x49, 0xD5, 0x81, 
+0xD5, 0x26, 0x90, 0xD7, 0xE1, 0x4A, 0xA4, 0x40, 
+0x87, 0x63, 0x68, 0x73, 0xFB, 0xF9, 0x68, 0x1F, 
+0xB0, 0x5A, 0xDB, 0x89, 0x36, 0x8F, 0x17, 0xF0, 
+0x40, 0xBF, 0x08, 0x98, 0x05, 0xE1, 0x07, 0x4C, 
+0x1D, 0xE1, 0x5F, 0x54, 0x34, 0xD1, 0x59, 0x83, 
+0xB9, 0x8A, 0x13, 0x1C, 0x90, 0xDE, 0x81, 0x42, 
+0x40, 0x9C, 0xFD, 0xFA, 0xA0, 0x4B, 0x07, 0xDC, 
+0xA4, 0x3E, 0x8F, 0x9A, 0xEB, 0x85, 0x1D, 0x83, 
+0x35, 0x39, 0x15, 0xF3, 0xA1, 0x1A, 0xDB, 0x06, 
+0x5F, 0xBE, 0x54, 
+
+0xFA, 0xF5, 0xB0, 0x13, 0x87, 0x82, 0x20, 0x81, 
+0x5F, 0x0E, 0xA6, 0xC5, 0x2E, 0xDD, 0x66, 0xE2, 
+0x42, 0x33, 0xCE, 0x07, 0x59, 0x56, 0x2C, 0xDE, 
+0x70, 0xF2, 0xF2, 0x0E, 0x0D, 0x50, 0x3C, 0x9A, 
+0xC0, 0xD6, 0x4C, 0x82, 0xF8, 0xC3, 0xD4, 0xA4, 
+0x4F, 0x0C, 0xF3, 0x72, 0xC1, 0x4F, 0x12, 0x27, 
+0xE0, 0x6D, 0x3B, 0xDB, 0x3B, 0x4A, 0x25, 0xDB, 
+0x78, 0x85, 0x98, 0x3A, 0x07, 0xA6, 0x24, 0x4C, 
+0x6E, 0x0F, 0x8A, 0xA9, 0x17, 0x28, 0x82, 0x5E, 
+0xE7, 0xE5, 0xAF, 0xCB, 0xB4, 0xA6, 0x16, 0xC5, 
+0x72, 0x71, 0xD0, 0x64, 0x74, 0xA8, 0x30, 0x4E, 
+0x98, 0x53, 0x13, 0x86, 0xA5, 0x1E, 0x3D, 0x67, 
+0x8E, 0x35, 0x58, 0xDB, 0x9D, 0x07, 0x97, 0x2D, 
+0xAA, 0x53, 0x97, 0x43, 0xA6, 0x7E, 0x10, 0x6C, 
+0x24, 0xE2, 0xE8, 0x73, 0x22, 0x01, 0x3A, 0xF0, 
+0xD0, 0xD1, 0x9A, 0xB3, 0x5E, 0x31, 0x9A, 0x9D, 
+0x8C, 0xB1, 0xB1, 0x3A, 0x02, 0xE9, 0x15, 0x8D, 
+0x0F, 0xAF, 0x88, 0x4D, 0xDB, 0xA8, 0xCD, 0xC9, 
+0x2C, 0x8E, 0x55, 0x66, 0x58, 0xF5, 0x52, 0x58, 
+0x68, 0x03, 0xA1, 0x1A, 0x9A, 0x4D, 0x59, 0x85, 
+0xED, 0x7E, 0x6F, 0xEB, 0xD3, 0x27, 0x14, 0x89, 
+0xC6, 0xC9, 0x05, 0x95, 0x25, 0xCA, 0x44, 0x80, 
+0x21, 0xFC, 0x96, 0x5A, 0x48, 0x81, 0x96, 0x0B, 
+0x3A, 0x6F, 0x83, 0xDB, 0x7B, 0xDA, 0x3B, 0x10, 
+0x37, 0x7B, 0x9F, 0x3E, 0x91, 0xF8, 0xEB, 0xE3, 
+0x4D, 0x97, 0xF0, 0x33, 0x59, 0xB7, 0xEA, 0x5E, 
+0xC8, 0x70, 0x17, 0x45, 
+
+0x0E, 0x2E, 0x01, 0x1F, 0xFF, 0x93, 0xFD, 0x9E, 
+0x90, 0x20, 0x47, 0x3D, 0x58, 0xBD, 0xDF, 0x88, 
+0xC8, 0xA3, 0x0C, 0x3B, 0xA0, 0x2C, 0xCD, 0xA8, 
+0x50, 0x21, 0xC7, 0xD0, 0x34, 0x21, 0x74, 0xE0, 
+0xC1, 0x52, 0xF1, 0x3A, 0xBB, 0x51, 0xA8, 0x52, 
+0x5A, 0xCA, 0x71, 0xE9, 0xA2, 0x78, 0xDC, 0x0F, 
+0xA0, 0xF5, 0xAB, 0xDF, 0x52, 0x07, 0xF1, 0x55, 
+0x5E, 0x45, 0x6E, 0x15, 0x35, 0x0D, 0x02, 0x47, 
+0x8E, 0x99, 0x9A, 0xB8, 0x78, 0xC6, 0xFC, 0xF7, 
+0xDF, 0x8D, 0x47, 0x2F, 0x41, 0x93, 0x96, 0x9A, 
+0xA1, 0x02, 0xC7, 0xCD, 0xBF, 0x00, 0xA0, 0xD9, 
+0xA8, 0x30, 0xC6, 0xE3, 0x0C, 0xFC, 0x59, 0x46, 
+0xE0, 0x5D, 0x33, 0x71, 0x14, 0x3B, 0x38, 0xD1, 
+0xBC, 0xCE, 0x3B, 0x50, 0xA2, 0xD3, 0x6F, 0x08, 
+0xCC, 0x9E, 0x3F, 0x31, 0x24, 0x87, 0x5C, 0x56, 
+0x52, 0x4F, 0xF1, 0x7B, 0x2E, 0xAD, 0x37, 0x5E, 
+0x7C, 0x3B, 0x97, 0xDB, 0xE8, 0x17, 0x4B, 0x28, 
+0xF3, 0xD1, 0xCA, 0xA1, 0x7E, 0x3B, 0xDA, 0x97, 
+0xDB, 0xC7, 0xFE, 0x6D, 0xEE, 0xF6, 0x5E, 0xFD, 
+0xDA, 0xDC, 0x4A, 0x29, 0x0F, 0x63, 0x1C, 0x60, 
+0xD8, 0xBC, 0x0A, 0x2D, 0xA0, 0xD9, 0xFB, 0x26, 
+0x08, 0x53, 0x2A, 0xFE, 0xD5, 0xC6, 0xE3, 0xAC, 
+0x9A, 0xAE, 0x85, 0xEE, 0x69, 0x54, 0xE8, 0x59, 
+0x15, 0x3D, 0xC3, 0x16, 0xCB, 0xE6, 0xB5, 0x30, 
+0x6A, 0xE5, 0xEF, 0xB1, 0x7F, 0x60, 0xD4, 0xB8, 
+0xA5, 0x27, 0x55, 0x74, 0x87, 0x20, 0x7A, 0x8C, 
+0x53, 0x07, 0x00, 0x47, 0xD8, 
+
+0x0A, 0xD5, 0xAE, 0x54, 0x33, 0x41, 0xF7, 0x98, 
+0xF4, 0xCC, 0xA2, 0x74, 0x0A, 0xB7, 0xD5, 0x9D, 
+0x3E, 0x3C, 0x23, 0x79, 0xDD, 0xAB, 0xCA, 0xF8, 
+0x10, 0x3D, 0x7E, 0x28, 0x71, 0xD3, 0x6E, 0x8C, 
+0x97, 0xAB, 0xF0, 0x62, 0x0E, 0x4B, 0xC2, 0x73, 
+0x49, 0x12, 0xD7, 0xA2, 0x1A, 0x5E, 0x63, 0xBB, 
+0x30, 0xB4, 0x3F, 0x9B, 0xF4, 0xAC, 0x30, 0x40, 
+0x74, 0xE7, 0x76, 0xFD, 0x12, 0x96, 0x8C, 0x2B, 
+0xB3, 0x4B, 0x57, 0x57, 0x34, 0x81, 0x69, 0xAE, 
+0xCC, 0xF4, 0x95, 0x81, 0x91, 0x95, 0xFE, 0xD0, 
+0x13, 0xB8, 0x1C, 0xCF, 0xB3, 0x55, 0xF1, 0xC5, 
+0x43, 0x0F, 0x8D, 0xFB, 0xE5, 0x30, 0x3A, 0x02, 
+0xFD, 0xBD, 0x36, 0x16, 0xF9, 0xAA, 0x02, 0x8E, 
+0x43, 0xEC, 0x69, 0x0B, 0xFB, 0x0E, 0x4D, 0x2D, 
+0x7E, 0xA7, 0x9A, 0xED, 0xDD, 0x20, 0xC4, 0x97, 
+0x4E, 0xFE, 0xFC, 0xCF, 0x03, 0x9E, 0x2E, 0xC9, 
+0xF5, 0xE0, 0x51, 0xCE, 0x23, 0xE3, 0x2B, 0x5F, 
+0x5B, 0xE8, 0xD4, 0x54, 0xC6, 0x37, 0x37, 0x52, 
+0x17, 0xE2, 0x26, 0x2B, 0x04, 0x5C, 0x79, 0x51, 
+0x4F, 0x75, 0xB3, 0xB7, 0xAF, 0x70, 0x43, 0xDC, 
+0x08, 0x73, 0x7D, 0xAC, 0x48, 0x3C, 0xA2, 0xE9, 
+0x91, 0xBC, 0xDE, 0xB6, 0xF3, 0x96, 0x7A, 0x93, 
+0xBC, 0xB9, 0x7D, 0x33, 0x2A, 0xEE, 0x7A, 0x8C, 
+0xD8, 0x51, 0xC3, 0x38, 0x4A, 0x80, 0x9C, 0xDB, 
+0x69, 0xF3, 0x90, 0xB2, 0x75, 0xBF, 0xBB, 0x48, 
+0xE5, 0x24, 0x74, 0x8A, 0x2F, 0xD6, 0x0B, 0x47, 
+0x6A, 0x20, 0x46, 0xD4, 0x9B, 0xE3, 
+
+0x95, 0xDC, 0x44, 0x16, 0x14, 0x10, 0x54, 0xD3, 
+0xD1, 0x45, 0x67, 0x9A, 0x19, 0x39, 0xC4, 0xE4, 
+0xC3, 0x89, 0x14, 0xD9, 0x5D, 0x38, 0xB8, 0xB8, 
+0x3C, 0x50, 0xBC, 0xF7, 0x8E, 0xA6, 0x2B, 0x5E, 
+0x61, 0xBC, 0xCF, 0x8B, 0xE0, 0xD0, 0xE3, 0x11, 
+0x73, 0x0D, 0xBB, 0x82, 0x53, 0x69, 0x2D, 0xBE, 
+0xB4, 0x03, 0x7E, 0x46, 0x0B, 0x64, 0x1E, 0xCF, 
+0xBD, 0xDA, 0x85, 0x4D, 0x78, 0x58, 0x64, 0x56, 
+0xAE, 0xEE, 0xF7, 0x03, 0x13, 0x6E, 0x2C, 0xEF, 
+0x4A, 0xD3, 0xED, 0x3E, 0x44, 0xA2, 0x7A, 0xFE, 
+0x9E, 0xB8, 0xEE, 0xCF, 0x1A, 0x12, 0x5A, 0xB2, 
+0x1C, 0x8B, 0xD2, 0x80, 0x74, 0x0A, 0x2E, 0x79, 
+0x63, 0x62, 0xC6, 0x57, 0xCA, 0xA5, 0x62, 0x15, 
+0xA1, 0x5D, 0xFE, 0x8D, 0xAA, 0x68, 0xA8, 0x72, 
+0x43, 0xB3, 0x5F, 0x3B, 0x67, 0xC9, 0x1B, 0x70, 
+0x94, 0x2B, 0xB4, 0x24, 0x22, 0x00, 0xCA, 0x2E, 
+0xFF, 0x37, 0x07, 0xF1, 0xC2, 0x28, 0xA2, 0x89, 
+0x06, 0xF5, 0xF6, 0xDA, 0x03, 0x19, 0x3A, 0x45, 
+0x9C, 0xB3, 0x4F, 0x44, 0x9E, 0x0E, 0x29, 0xF8, 
+0x7A, 0xF1, 0xF3, 0x81, 0xA1, 0x7E, 0xA7, 0xB5, 
+0x72, 0x13, 0xC5, 0x60, 0xF7, 0xC6, 0x00, 0x50, 
+0x22, 0xFA, 0x7D, 0x3C, 0xBB, 0x95, 0x96, 0xEE, 
+0xE7, 0xF2, 0x9C, 0x5F, 0xD1, 0x21, 0x1A, 0x01, 
+0xD2, 0x06, 0xAE, 0xF7, 0xC9, 0xB1, 0xE6, 0x42, 
+0xAA, 0xFE, 0xDE, 0xB5, 0x02, 0xDD, 0xDC, 0xEB, 
+0x66, 0x9F, 0xB1, 0xDC, 0x51, 0x25, 0xAD, 0xB5, 
+0xE3, 0x2B, 0xC6, 0xFC, 0x4E, 0xCB, 0xCF, 
+
+0xFA, 0x6F, 0x54, 0xB2, 0x06, 0x2E, 0xC5, 0x36, 
+0x1E, 0xB8, 0xF1, 0x39, 0x1E, 0x34, 0x40, 0x53, 
+0xDF, 0xD1, 0x85, 0xD8, 0x65, 0x84, 0x78, 0x15, 
+0x48, 0xA6, 0x6B, 0xDA, 0xA1, 0x04, 0x17, 0x8D, 
+0xAC, 0xAB, 0xCF, 0xD8, 0x18, 0xFE, 0xB3, 0xB8, 
+0xA4, 0x43, 0xEF, 0xF3, 0x6A, 0xDA, 0x70, 0x86, 
+0xFA, 0x1C, 0x7A, 0x65, 0xFC, 0x6B, 0x8A, 0x51, 
+0x70, 0xE6, 0x52, 0xC0, 0xD6, 0x61, 0x8A, 0xB3, 
+0x9C, 0x67, 0x2F, 0x30, 0xB9, 0x2C, 0x75, 0x12, 
+0x6C, 0xF1, 0x6D, 0xB6, 0x9C, 0xF6, 0x7D, 0x36, 
+0x9E, 0x85, 0xAC, 0xC9, 0xBA, 0x9E, 0x57, 0xA2, 
+0xCB, 0x74, 0xBE, 0x46, 0x67, 0x6B, 0xBF, 0xF1, 
+0x8D, 0x20, 0xAB, 0xE2, 0xB6, 0xED, 0xBF, 0x3C, 
+0x31, 0x51, 0x07, 0x5B, 0x79, 0xD4, 0xE8, 0x46, 
+0xF9, 0xC0, 0x3A, 0x5A, 0x69, 0x0D, 0xCD, 0xBE, 
+0x0B, 0x89, 0x09, 0x5D, 0x39, 0xD2, 0xA7, 0x18, 
+0x1A, 0xCA, 0x7F, 0x28, 0x8C, 0x6D, 0xA3, 0xD5, 
+0x10, 0xAF, 0x7B, 0x7F, 0xF2, 0x6D, 0x12, 0xA7, 
+0x1C, 0x11, 0xE9, 0xF5, 0x32, 0xB7, 0x9B, 0xEB, 
+0x35, 0xA7, 0x2D, 0x40, 0xAE, 0x45, 0x3E, 0x60, 
+0x5D, 0x6E, 0xA3, 0x07, 0x76, 0x25, 0xA7, 0x17, 
+0x61, 0x1C, 0xB5, 0x53, 0x64, 0xDB, 0xEE, 0xDB, 
+0xED, 0xB1, 0x2C, 0x9D, 0x1E, 0xD2, 0xDC, 0xF1, 
+0xCF, 0xD2, 0x57, 0x02, 0xAE, 0xB7, 0xBC, 0x69, 
+0x80, 0xCF, 0x6F, 0xA3, 0xC8, 0x03, 0xBB, 0xC1, 
+0x0E, 0x2A, 0x0E, 0xB3, 0x54, 0xED, 0x50, 0x8A, 
+0xD4, 0xE7, 0x2E, 0x14, 0xD0, 0x2C, 0xAB, 0x87, 
+
+0x24, 0xD1, 0x27, 0xC0, 0x18, 0xC3, 0xFD, 0xFD, 
+0x38, 0x6E, 0x28, 0xAB, 0xE9, 0x8D, 0xA8, 0x7D, 
+0x9C, 0x46, 0xB0, 0x76, 0x03, 0xA0, 0x1B, 0x10, 
+0xE6, 0x7F, 0xCB, 0x36, 0x25, 0x51, 0x27, 0xC4, 
+0x66, 0xB3, 0x63, 0xA2, 0x56, 0x00, 0x9B, 0x00, 
+0xE2, 0x05, 0xB9, 0x3B, 0x60, 0xF8, 0x21, 0x24, 
+0x25, 0xE3, 0x2C, 0x6C, 0x8E, 0xC1, 0xA3, 0xB4, 
+0x7A, 0x0A, 0xFE, 0x4D, 0x40, 0x98, 0x10, 0x79, 
+0x1E, 0x2D, 0x81, 0x91, 0x04, 0xAD, 0x12, 0x6C, 
+0x74, 0x37, 0xB5, 0x57, 0xBE, 0x83, 0x03, 0x65, 
+0x9B, 0x0F, 0x16, 0xC0, 0x60, 0xD0, 0x27, 0xB9, 
+0x2A, 0xCD, 0x08, 0xE6, 0x92, 0x29, 0x77, 0xF0, 
+0x5D, 0x30, 0x32, 0x8D, 0x08, 0xFE, 0x37, 0xCF, 
+0xCF, 0x0C, 0x91, 0x17, 0x8E, 0xC7, 0xF2, 0xA8, 
+0xE9, 0x3F, 0x05, 0xD5, 0x52, 0x27, 0x60, 0xB1, 
+0x95, 0x9B, 0x68, 0x16, 0x26, 0x0E, 0x5C, 0xB7, 
+0x9D, 0x2D, 0x7F, 0x0A, 0x18, 0xB3, 0x83, 0x0E, 
+0xB5, 0x4B, 0x82, 0x09, 0x42, 0xB1, 0xC4, 0x56, 
+0x06, 0xAA, 0x28, 0xDE, 0x99, 0x73, 0x63, 0x36, 
+0x7E, 0x4B, 0xD9, 0x75, 0x45, 0x7A, 0xB9, 0xCA, 
+0xAF, 0x8E, 0xF9, 0x3D, 0x81, 0x73, 0x5A, 0x50, 
+0x26, 0x9F, 0x6C, 0x25, 0xD3, 0xE9, 0x95, 0x82, 
+0x17, 0xA7, 0x4D, 0xB2, 0x35, 0xC2, 0x0A, 0x93, 
+0x75, 0x64, 0x07, 0xBB, 0xB3, 0xC4, 0x42, 0x76, 
+0xED, 0xE1, 0x0D, 0x9F, 0xEF, 0x41, 0x77, 0xD8, 
+0x27, 0x64, 0x89, 0x69, 0xBF, 0x66, 0xE6, 0x41, 
+0x77, 0x12, 0xDC, 0xE6, 0xC6, 0xFF, 0x76, 0x73, 
+0xDD, 
+
+0x9E, 0xC6, 0xD6, 0x27, 0x44, 0xA3, 0x2D, 0xFE, 
+0xE4, 0xEB, 0xB1, 0xEA, 0x19, 0x59, 0x56, 0xB9, 
+0xBA, 0xEB, 0xCC, 0xCB, 0xAE, 0x5F, 0x40, 0x9E, 
+0x4C, 0xB1, 0x79, 0x57, 0xBC, 0x2C, 0x22, 0x16, 
+0xB4, 0xF4, 0xE7, 0xA4, 0x01, 0xA4, 0xB5, 0xDB, 
+0x7C, 0x82, 0x45, 0x32, 0x6A, 0x56, 0x80, 0xE0, 
+0xB7, 0x96, 0x44, 0x00, 0x07, 0x34, 0xDE, 0x7E, 
+0x22, 0x92, 0xBE, 0xF5, 0x5C, 0x0B, 0x98, 0xA4, 
+0x5F, 0xC6, 0xCA, 0x27, 0x84, 0xA5, 0x88, 0x2E, 
+0xB0, 0x62, 0x91, 0xA3, 0x58, 0x8E, 0x1D, 0x56, 
+0x61, 0x57, 0x0F, 0x2E, 0x9F, 0xBC, 0xEC, 0xAC, 
+0x7A, 0xBA, 0x32, 0xD6, 0xC0, 0x88, 0x39, 0x29, 
+0x6F, 0xFC, 0xEE, 0xA8, 0x8F, 0x46, 0xD6, 0x36, 
+0xC2, 0xA5, 0x60, 0xF9, 0xA2, 0x72, 0x20, 0xBC, 
+0x1A, 0xD9, 0xE2, 0x3D, 0xF7, 0xBE, 0x72, 0x6B, 
+0xB3, 0x0B, 0xBD, 0x27, 0x78, 0x9D, 0x86, 0xDE, 
+0x2E, 0xA8, 0x24, 0xDB, 0x22, 0xED, 0x02, 0x8B, 
+0x16, 0x0C, 0x70, 0x1D, 0x3C, 0xA4, 0x97, 0x61, 
+0x8E, 0x37, 0xD3, 0x0B, 0xC7, 0xA8, 0x37, 0x4D, 
+0xE4, 0xD3, 0x71, 0x33, 0x02, 0xA3, 0x10, 0x91, 
+0xB5, 0x59, 0x68, 0xB6, 0xEF, 0x2A, 0x80, 0x42, 
+0x5D, 0x24, 0x34, 0x6F, 0x6B, 0x98, 0x9B, 0x87, 
+0xF9, 0x38, 0xE1, 0x99, 0xCC, 0xAF, 0x19, 0x02, 
+0xB3, 0x74, 0x10, 0x50, 0x5F, 0xF5, 0xD3, 0x06, 
+0xB6, 0x69, 0x87, 0x7D, 0xFF, 0x25, 0x1B, 0x9F, 
+0xD7, 0x97, 0xF2, 0xB3, 0x37, 0x9B, 0x9E, 0x20, 
+0x99, 0x7E, 0xA9, 0x18, 0xDD, 0x30, 0xE5, 0xFB, 
+0x7B, 0x42, 
+
+0x70, 0xE0, 0x8D, 0xAB, 0x0E, 0x4D, 0x24, 0x73, 
+0x83, 0x6C, 0x56, 0xB9, 0x72, 0x0A, 0x87, 0x97, 
+0xAA, 0xA7, 0x1E, 0xF8, 0x08, 0x80, 0xD6, 0x67, 
+0xE6, 0x87, 0x66, 0xEE, 0x22, 0xFC, 0xC2, 0x6F, 
+0xF2, 0x63, 0x40, 0x3A, 0xF9, 0x5D, 0xF8, 0xD3, 
+0x3D, 0xC7, 0xF9, 0xF5, 0xB2, 0xEE, 0x5D, 0x94, 
+0x49, 0xF4, 0xF7, 0x3A, 0x87, 0x69, 0xDE, 0x37, 
+0x0A, 0x87, 0x61, 0xB2, 0x0A, 0x1A, 0x27, 0x54, 
+0x75, 0xF4, 0x03, 0xF8, 0x26, 0xEC, 0x61, 0xD5, 
+0xBD, 0xEA, 0x68, 0x3B, 0x14, 0x5A, 0xB5, 0xFA, 
+0x74, 0x59, 0xF6, 0xF7, 0x41, 0x5C, 0x23, 0xD3, 
+0x6E, 0x37, 0x00, 0x65, 0x5C, 0x34, 0xE2, 0x74, 
+0xA0, 0xD1, 0xF3, 0xA4, 0x00, 0x76, 0x7A, 0x1A, 
+0x4F, 0x7F, 0x24, 0x6F, 0xBB, 0xE5, 0x76, 0x5E, 
+0x6D, 0xAB, 0x31, 0x5F, 0x14, 0xA8, 0x19, 0x95, 
+0xE8, 0x96, 0xB1, 0xCF, 0x7F, 0x36, 0xB8, 0x25, 
+0xAE, 0x75, 0x8D, 0x99, 0x09, 0x14, 0x5C, 0x51, 
+0x2C, 0x88, 0x94, 0xD7, 0x20, 0x64, 0x2C, 0xD1, 
+0x1E, 0xCC, 0x6F, 0xB4, 0x6A, 0xCC, 0xA8, 0xA3, 
+0x56, 0x6A, 0x14, 0x32, 0xDF, 0x52, 0x79, 0x09, 
+0x92, 0xAC, 0xD5, 0x56, 0x8C, 0xB6, 0x5B, 0xEA, 
+0x28, 0xCA, 0x2C, 0x32, 0x66, 0xC8, 0x3A, 0x42, 
+0xB8, 0x92, 0xD1, 0x54, 0xEB, 0xAC, 0x6B, 0x1B, 
+0x0B, 0x1B, 0x9B, 0x03, 0xA2, 0xE3, 0xF9, 0x58, 
+0x3A, 0xDE, 0xD0, 0xD9, 0xDE, 0x89, 0x56, 0xAB, 
+0x56, 0xEC, 0x8C, 0xA7, 0x60, 0x27, 0xBF, 0x62, 
+0x84, 0x6F, 0x86, 0x7C, 0x09, 0xBD, 0xD4, 0xC1, 
+0xB8, 0xB9, 0x38, 
+
+0x7B, 0x96, 0xDC, 0x19, 0xD5, 0x82, 0x5E, 0xB4, 
+0xE7, 0xAF, 0x1F, 0x69, 0x2E, 0x7C, 0xF9, 0x1F, 
+0x09, 0xEB, 0x70, 0x1B, 0x2A, 0xF2, 0x6F, 0xA8, 
+0x9C, 0x71, 0x22, 0xFF, 0xFE, 0x97, 0x54, 0x3F, 
+0xB5, 0xA8, 0x0C, 0xE2, 0x9A, 0xBC, 0x27, 0xA3, 
+0x83, 0x2C, 0x1B, 0x7E, 0x55, 0xBC, 0xAB, 0xAA, 
+0x9C, 0xC7, 0x34, 0xC2, 0xA9, 0x0D, 0xFE, 0x97, 
+0xE7, 0xFD, 0x87, 0x9C, 0x87, 0xA5, 0xF9, 0xA4, 
+0xE9, 0xD9, 0x7A, 0x88, 0x7E, 0xB6, 0x4B, 0x94, 
+0x0E, 0xF2, 0x64, 0x96, 0xAC, 0x68, 0x21, 0xCE, 
+0xBF, 0xEC, 0x9B, 0x14, 0x80, 0x77, 0xAE, 0x11, 
+0x8C, 0x62, 0x08, 0x35, 0xE1, 0x38, 0x5A, 0x4F, 
+0xD2, 0x92, 0x2C, 0x3F, 0xB0, 0x4A, 0x8D, 0x39, 
+0x53, 0x0E, 0x20, 0xFD, 0x23, 0x01, 0x15, 0xBD, 
+0x39, 0x48, 0x3A, 0xE7, 0xA3, 0xA9, 0xBA, 0x57, 
+0xA1, 0xA1, 0x3C, 0xEB, 0x60, 0x13, 0x1A, 0x41, 
+0x13, 0xCF, 0x85, 0x03, 0xC5, 0xC5, 0x23, 0x19, 
+0x85, 0xAE, 0xC5, 0x7E, 0xDF, 0x48, 0x69, 0xCF, 
+0xC0, 0xFB, 0x93, 0x50, 0xBF, 0x93, 0x50, 0xDF, 
+0x81, 0xE6, 0x93, 0x07, 0xEB, 0xD7, 0xC2, 0x8C, 
+0xAD, 0xAE, 0x71, 0x66, 0x89, 0x84, 0x58, 0xFB, 
+0xF7, 0x8D, 0xC7, 0x1B, 0xC4, 0x2B, 0xF2, 0x32, 
+0x8A, 0xC0, 0x14, 0x19, 0xA8, 0xBA, 0x24, 0xEF, 
+0xE2, 0xBA, 0x95, 0x78, 0xFC, 0xCD, 0x51, 0x85, 
+0xF4, 0x26, 0x94, 0xE1, 0x26, 0x6B, 0xBB, 0xF7, 
+0xB2, 0xDB, 0x6D, 0x35, 0x7A, 0xC4, 0x22, 0x9E, 
+0x03, 0x3C, 0x01, 0x75, 0x38, 0x1D, 0x9B, 0x47, 
+0x62, 0xCB, 0x66, 0xFD, 
+
+0xF2, 0x37, 0xCD, 0xA2, 0x5E, 0x96, 0x70, 0x72, 
+0xAA, 0x72, 0x1A, 0x07, 0x40, 0xFC, 0xF1, 0x7A, 
+0x93, 0x55, 0xE3, 0x5F, 0x4A, 0xC5, 0x8F, 0xA9, 
+0x64, 0x6D, 0x86, 0x73, 0x77, 0xB6, 0x6A, 0x72, 
+0xE0, 0xB4, 0x91, 0x26, 0x3F, 0xBE, 0x74, 0x91, 
+0xED, 0x3C, 0x28, 0x33, 0x14, 0x8C, 0x5B, 0xE2, 
+0x39, 0x1F, 0x28, 0xEB, 0x44, 0xBE, 0xC3, 0x01, 
+0xCA, 0x37, 0x6E, 0xAD, 0xCF, 0x76, 0x1A, 0xDC, 
+0x60, 0xAC, 0x41, 0xC0, 0x3C, 0x88, 0xCA, 0x11, 
+0x6A, 0x93, 0xE9, 0xD7, 0x2B, 0xCA, 0x38, 0xA4, 
+0xAD, 0xBD, 0x31, 0x13, 0xAA, 0x67, 0xA1, 0x21, 
+0xD1, 0x4F, 0x04, 0x0F, 0xFB, 0x93, 0x19, 0x5F, 
+0x13, 0xA2, 0x32, 0xB5, 0x1F, 0x29, 0x2C, 0x15, 
+0xB6, 0xFA, 0x8D, 0x78, 0x15, 0x79, 0xF4, 0x70, 
+0xD9, 0x75, 0x05, 0x24, 0xF3, 0xFE, 0x5B, 0x9E, 
+0x48, 0xD0, 0x51, 0xBD, 0x81, 0x8D, 0xFD, 0x7E, 
+0x0C, 0x46, 0x4A, 0xE7, 0x3D, 0x74, 0x99, 0x90, 
+0xD7, 0xFF, 0x35, 0x98, 0x9D, 0x02, 0xA5, 0x8D, 
+0xF1, 0x18, 0x36, 0xFB, 0xB1, 0x1E, 0x2B, 0xD2, 
+0x4D, 0x62, 0xA6, 0x6D, 0x07, 0xFD, 0xAD, 0xF4, 
+0x1C, 0x66, 0x64, 0x64, 0x58, 0x1B, 0x2B, 0x95, 
+0x6C, 0xB9, 0xC7, 0xFF, 0x6F, 0x2A, 0x9B, 0x35, 
+0xCB, 0x91, 0xA0, 0xE6, 0x08, 0x82, 0x4C, 0x7F, 
+0xCD, 0xC6, 0xE5, 0xE4, 0xCA, 0x24, 0x2C, 0x65, 
+0x4B, 0xBE, 0x2F, 0xEA, 0x7C, 0x79, 0x3D, 0xB4, 
+0x23, 0x42, 0x2A, 0xC1, 0xEF, 0xD1, 0x8E, 0xDF, 
+0x09, 0x9D, 0x9C, 0xFF, 0xE6, 0x32, 0x0E, 0xAE, 
+0x09, 0x55, 0xC7, 0x23, 0x01, 
+
+0xA8, 0x7B, 0x99, 0x36, 0xBB, 0x1D, 0x2B, 0x69, 
+0xCC, 0xFC, 0x79, 0x72, 0xB3, 0x36, 0xD9, 0x95, 
+0x87, 0x8D, 0x8D, 0x38, 0x65, 0xD4, 0x6D, 0x0D, 
+0xD9, 0x76, 0x6A, 0x2B, 0xF0, 0x70, 0x99, 0x35, 
+0x66, 0x83, 0xA0, 0x5F, 0x99, 0x7D, 0xC3, 0xF0, 
+0xE8, 0x44, 0xE7, 0x47, 0x5E, 0x03, 0x09, 0xF7, 
+0x31, 0x56, 0x30, 0x50, 0x6B, 0xAC, 0x21, 0x2E, 
+0xFB, 0x00, 0x34, 0x38, 0x89, 0xE3, 0xC0, 0x32, 
+0x3B, 0x88, 0x41, 0x28, 0x94, 0xCF, 0xA8, 0xEB, 
+0x01, 0x66, 0x10, 0x7B, 0x2E, 0xFE, 0xC4, 0x17, 
+0x4A, 0xB2, 0x67, 0x90, 0x6D, 0x32, 0x2E, 0x0C, 
+0xEA, 0xD2, 0x23, 0x56, 0xF9, 0x0C, 0xD9, 0xAE, 
+0x84, 0xBF, 0x4D, 0x80, 0x3D, 0x4F, 0xDA, 0x0D, 
+0xC0, 0x13, 0xD2, 0xCF, 0xF3, 0x6F, 0xC0, 0x2B, 
+0xD1, 0x83, 0x94, 0x74, 0x7B, 0xCE, 0x3C, 0xA7, 
+0x6A, 0x95, 0x3B, 0xF7, 0x11, 0x77, 0xC1, 0xEA, 
+0x34, 0x1C, 0x6B, 0x02, 0xC9, 0xB5, 0xA0, 0xC3, 
+0x2A, 0x9F, 0xFB, 0x10, 0x55, 0x41, 0xB6, 0x60, 
+0x5D, 0x3D, 0x32, 0xD6, 0x51, 0xE9, 0xFE, 0x07, 
+0xF9, 0xF1, 0xAD, 0x86, 0xE2, 0x29, 0x3B, 0x8F, 
+0x61, 0x46, 0x42, 0x26, 0xF5, 0x9A, 0x61, 0xEE, 
+0x7D, 0x19, 0x32, 0xF8, 0x82, 0x77, 0x75, 0x77, 
+0xD3, 0x66, 0xD6, 0x4C, 0x4B, 0x0F, 0x93, 0x66, 
+0x62, 0x0D, 0x65, 0x35, 0x2B, 0x6D, 0x15, 0xC0, 
+0x9B, 0x97, 0xA0, 0x4A, 0xB3, 0xB5, 0x4F, 0xA2, 
+0xC8, 0x47, 0xA1, 0xDD, 0x91, 0xC4, 0x8B, 0xAB, 
+0xEA, 0x00, 0xE4, 0x68, 0x3A, 0xE6, 0xCA, 0x75, 
+0x04, 0x7D, 0xB0, 0xA9, 0x23, 0x82, 
+
+0x66, 0x05, 0x2F, 0x0F, 0x33, 0x12, 0x81, 0xFD, 
+0x17, 0xDD, 0xD1, 0x57, 0xBC, 0x50, 0x4B, 0xA6, 
+0xB5, 0x7F, 0x02, 0xAC, 0xCF, 0x0C, 0xD1, 0xEE, 
+0xDB, 0x30, 0x4F, 0x21, 0xCC, 0xA9, 0x8D, 0x04, 
+0x78, 0x38, 0xED, 0x91, 0x73, 0xB1, 0x0D, 0x4C, 
+0xD1, 0xCD, 0xDF, 0x4F, 0x0D, 0x6F, 0x3A, 0xE9, 
+0x75, 0x28, 0x6E, 0xAE, 0x86, 0xBD, 0xC6, 0x49, 
+0xF7, 0xC4, 0xFA, 0x35, 0x80, 0x96, 0xB0, 0xD3, 
+0xA2, 0xA1, 0xE3, 0x39, 0x45, 0x17, 0xDA, 0xF2, 
+0x07, 0xE6, 0x46, 0x97, 0x08, 0x96, 0xC6, 0x92, 
+0x40, 0xDE, 0x16, 0x8A, 0xCC, 0x66, 0x4F, 0x6A, 
+0x47, 0x4B, 0x60, 0xEC, 0x44, 0x37, 0xB3, 0xC5, 
+0xA9, 0xD6, 0xA8, 0x29, 0x1F, 0x58, 0x56, 0xF3, 
+0x31, 0x73, 0x44, 0x63, 0x18, 0x99, 0x3F, 0xB2, 
+0x2C, 0xC1, 0x5D, 0x0E, 0xDC, 0x0D, 0xB5, 0x9A, 
+0xB7, 0xCD, 0xDF, 0xD4, 0x1F, 0x1E, 0xC2, 0x64, 
+0xA2, 0xB8, 0xCC, 0x61, 0xF4, 0xA1, 0x3D, 0x9D, 
+0x96, 0x06, 0xA4, 0x82, 0x7F, 0x66, 0x39, 0x23, 
+0xA1, 0xC5, 0x8C, 0x46, 0x19, 0xC7, 0x99, 0xF3, 
+0xA1, 0xA9, 0x9C, 0x0C, 0xF6, 0x56, 0xA9, 0xCB, 
+0xD1, 0x49, 0xA6, 0xD8, 0x88, 0x6F, 0x9B, 0x2A, 
+0x60, 0xD6, 0x56, 0x97, 0x1B, 0x4A, 0xF4, 0xED, 
+0x32, 0x74, 0x85, 0xAC, 0x86, 0x7F, 0x1C, 0x5D, 
+0xA7, 0xBE, 0x98, 0x2E, 0x74, 0x62, 0x1A, 0x91, 
+0xEC, 0xC8, 0xFF, 0xB5, 0xF8, 0x81, 0x51, 0x4E, 
+0xD3, 0xAB, 0x42, 0x47, 0x98, 0x81, 0xAA, 0x5B, 
+0x39, 0xD2, 0x30, 0x47, 0x93, 0x7A, 0xCC, 0x2E, 
+0xF5, 0x04, 0xF0, 0xCA, 0x66, 0xB0, 0x5D, 
+
+0x1C, 0x13, 0x92, 0x89, 0x87, 0xDA, 0xD2, 0x6B, 
+0x93, 0xEA, 0x87, 0xA9, 0x04, 0x88, 0xF5, 0x0C, 
+0x9C, 0x7E, 0x54, 0xFF, 0xCE, 0xD5, 0x8B, 0x02, 
+0x11, 0xB3, 0x22, 0xDF, 0x63, 0x8A, 0x23, 0xA4, 
+0xA6, 0x9D, 0xA1, 0x29, 0x0F, 0xE3, 0x21, 0xAE, 
+0xE6, 0x14, 0x6F, 0x1F, 0x57, 0x25, 0x17, 0x19, 
+0x38, 0x3F, 0xCF, 0xC2, 0xEB, 0x5D, 0x6A, 0x8B, 
+0x2B, 0x17, 0x45, 0x3D, 0x6B, 0x54, 0x9F, 0x66, 
+0x2B, 0x25, 0x50, 0xC1, 0x2A, 0x79, 0xB0, 0x13, 
+0x4A, 0xAA, 0x6D, 0x93, 0x84, 0xF5, 0x8B, 0xCE, 
+0x64, 0x1B, 0xDB, 0xD8, 0xC3, 0xA4, 0x2C, 0xDF, 
+0xFE, 0xD2, 0xEA, 0x87, 0x54, 0xAE, 0x5C, 0xCC, 
+0xD8, 0x2B, 0x22, 0x75, 0x94, 0xA9, 0x53, 0xA1, 
+0x56, 0x12, 0x2D, 0xDB, 0xAC, 0x65, 0xF8, 0x77, 
+0x15, 0x9B, 0x0E, 0xF6, 0x2A, 0x2B, 0x83, 0x52, 
+0x4C, 0xCB, 0xF1, 0x78, 0xAB, 0x27, 0x67, 0x04, 
+0xD1, 0xA5, 0x96, 0x1A, 0x62, 0xD6, 0x47, 0x2E, 
+0xB9, 0x86, 0x4F, 0x0A, 0x8C, 0x8A, 0x5F, 0x7A, 
+0x2B, 0x3D, 0xA4, 0x8F, 0x0B, 0x80, 0x39, 0x24, 
+0x89, 0x80, 0x37, 0x90, 0x74, 0x17, 0x58, 0xA1, 
+0x11, 0x98, 0xAC, 0x73, 0x58, 0x69, 0xEB, 0xA6, 
+0xA5, 0x68, 0xCE, 0x27, 0x22, 0x2A, 0x0A, 0x52, 
+0x8B, 0xBE, 0x67, 0x29, 0x3E, 0x77, 0x93, 0x7F, 
+0x34, 0x5A, 0x90, 0xDE, 0xC2, 0x60, 0x2A, 0x8A, 
+0x4D, 0x99, 0xED, 0x4F, 0x7C, 0x03, 0xF8, 0x77, 
+0x19, 0xAD, 0x4A, 0x72, 0xEF, 0xBD, 0xB3, 0xCB, 
+0x09, 0x8E, 0xBD, 0xF2, 0xEE, 0xED, 0x21, 0x56, 
+0xEB, 0xFB, 0x66, 0x0F, 0xCC, 0x54, 0xA5, 0xAB, 
+
+0x66, 0x88, 0xE2, 0xDE, 0xD0, 0xAE, 0x43, 0xA1, 
+0x28, 0xB4, 0x64, 0x0A, 0x75, 0x83, 0xE3, 0xDE, 
+0x08, 0xEA, 0x69, 0x74, 0xB3, 0xB8, 0xF3, 0xD9, 
+0xA9, 0x14, 0xEB, 0x2A, 0x1F, 0x54, 0xA3, 0xAC, 
+0x0C, 0xA3, 0x6C, 0x73, 0xB3, 0xD8, 0x00, 0x5B, 
+0x89, 0x5B, 0x12, 0xD5, 0xD2, 0x9D, 0x17, 0xB5, 
+0xBD, 0xA1, 0xF9, 0x91, 0x6B, 0x26, 0x82, 0xFF, 
+0x29, 0xDC, 0x65, 0x47, 0x71, 0x84, 0x62, 0x28, 
+0x94, 0x3C, 0x5B, 0x57, 0xF3, 0xAB, 0x69, 0x39, 
+0xC6, 0x7D, 0x4F, 0xD8, 0x58, 0x15, 0xC2, 0xC8, 
+0x82, 0xA0, 0x16, 0xD4, 0x80, 0x0D, 0x79, 0xAD, 
+0x06, 0x0F, 0xB9, 0xB6, 0xE7, 0xC8, 0xC5, 0x97, 
+0x5D, 0x58, 0x96, 0x19, 0xB7, 0x85, 0x3C, 0xE6, 
+0x0B, 0x3B, 0xAB, 0x61, 0x42, 0xAA, 0xE1, 0x48, 
+0x8F, 0x93, 0xC1, 0x50, 0x83, 0xA5, 0xC9, 0xF1, 
+0xB4, 0x5F, 0x02, 0x97, 0x43, 0x25, 0xF6, 0xC6, 
+0xD7, 0x1C, 0xF7, 0xDE, 0xD8, 0x00, 0x35, 0x19, 
+0xF9, 0x04, 0xBA, 0xAB, 0x87, 0x5B, 0x82, 0x2F, 
+0x2B, 0x88, 0x59, 0x2C, 0x27, 0x62, 0xF7, 0xC7, 
+0xA6, 0x77, 0x88, 0x3B, 0xCF, 0x1C, 0xC1, 0xA5, 
+0x9D, 0x2E, 0x8B, 0x95, 0x87, 0xA8, 0x97, 0xA4, 
+0xC5, 0xB4, 0x70, 0x71, 0x5E, 0xBB, 0xAE, 0xA1, 
+0xCB, 0x1F, 0x3C, 0x9C, 0x95, 0x25, 0xCE, 0x68, 
+0x4A, 0x3F, 0x0F, 0xD4, 0x0C, 0x8D, 0xE7, 0xF1, 
+0x30, 0x04, 0x34, 0x11, 0x03, 0x7B, 0x2F, 0xDC, 
+0x0E, 0x97, 0x60, 0x8B, 0xBE, 0x2E, 0xBE, 0xC7, 
+0xE9, 0x41, 0xF5, 0xF1, 0x12, 0x21, 0x9F, 0x30, 
+0x69, 0xF7, 0x6A, 0xB0, 0xF8, 0x3E, 0x0D, 0x78, 
+0xC8, 
+
+0xEB, 0x20, 0x9C, 0x15, 0xEA, 0xA9, 0x2F, 0x52, 
+0x81, 0x96, 0xC0, 0x33, 0x85, 0x5B, 0xB8, 0x76, 
+0x9B, 0x65, 0x50, 0xB1, 0x96, 0xA5, 0x01, 0x08, 
+0x36, 0xA6, 0x58, 0xE8, 0x38, 0x98, 0x8E, 0xE9, 
+0x49, 0xE0, 0xD9, 0x34, 0x63, 0x56, 0x5F, 0x76, 
+0xDE, 0xF0, 0x64, 0x7B, 0x2A, 0xF3, 0xEA, 0x78, 
+0x7C, 0x13, 0xAB, 0x13, 0xC2, 0x4B, 0x01, 0xCA, 
+0x4B, 0xC6, 0xCE, 0xDA, 0xA4, 0x28, 0x05, 0x6D, 
+0x82, 0x96, 0x7C, 0x60, 0x8F, 0x3A, 0x01, 0xD8, 
+0x08, 0xFF, 0xB8, 0xC6, 0x72, 0x66, 0xCF, 0x00, 
+0xFC, 0xEF, 0xC1, 0x0A, 0x43, 0x55, 0x97, 0xBC, 
+0x1D, 0x83, 0x36, 0xFB, 0x91, 0x2A, 0x74, 0x9A, 
+0x0D, 0x99, 0xC7, 0xCA, 0x9F, 0x15, 0xA9, 0xD8, 
+0xCE, 0x05, 0xDB, 0x78, 0x08, 0x61, 0x72, 0x87, 
+0x2B, 0xBF, 0xE7, 0x3C, 0xC9, 0xC9, 0x8F, 0x71, 
+0x82, 0xEF, 0x49, 0xED, 0xC6, 0xE5, 0xD6, 0xA8, 
+0x66, 0x17, 0xC1, 0x62, 0xE7, 0x2D, 0x3A, 0x16, 
+0x91, 0xF9, 0x08, 0x9F, 0x64, 0x85, 0x2B, 0xFD, 
+0xF2, 0x44, 0x78, 0xB9, 0xF4, 0x6E, 0xDA, 0xB2, 
+0x90, 0x08, 0xE9, 0xDE, 0xE6, 0xD9, 0x43, 0xD8, 
+0xA3, 0xB8, 0x58, 0xD0, 0xA0, 0xAC, 0x2E, 0x30, 
+0x70, 0x32, 0xD7, 0x06, 0x7E, 0x65, 0x8C, 0x69, 
+0x7F, 0xF6, 0x0C, 0xAE, 0x9B, 0x26, 0x7B, 0xA9, 
+0xB5, 0xCF, 0xF2, 0x66, 0x8C, 0xAE, 0xF7, 0xCE, 
+0x7F, 0xA3, 0xD8, 0xE9, 0x23, 0x8A, 0x21, 0xC6, 
+0x4F, 0x77, 0x0A, 0xBF, 0xE1, 0xBE, 0xC3, 0x50, 
+0xCA, 0x5C, 0xB2, 0x44, 0xA7, 0xD3, 0x15, 0x5A, 
+0x20, 0x85, 0x8C, 0x2D, 0x3A, 0x04, 0x29, 0x12, 
+0x37, 0x6A, 
+
+0xB3, 0xC2, 0xF5, 0xE6, 0x20, 0xAE, 0x54, 0x60, 
+0xE9, 0x80, 0x6F, 0xBF, 0xE3, 0x1B, 0x8D, 0x01, 
+0xC1, 0xA5, 0xDE, 0x12, 0x05, 0x96, 0x59, 0xB8, 
+0x20, 0x62, 0x2D, 0x0F, 0x53, 0x31, 0xCE, 0x1F, 
+0xC6, 0x78, 0xD4, 0x34, 0x46, 0xEA, 0xBC, 0xD6, 
+0x96, 0xFD, 0xC6, 0xE2, 0x2C, 0x04, 0x10, 0x1B, 
+0x99, 0x14, 0xDA, 0x45, 0x23, 0xBE, 0x6F, 0x18, 
+0x00, 0x40, 0x68, 0x09, 0x29, 0x71, 0x40, 0x82, 
+0x76, 0x1B, 0x16, 0xB7, 0x09, 0x91, 0x71, 0x7A, 
+0x80, 0xE2, 0x97, 0xF6, 0xAC, 0xCF, 0x90, 0x0E, 
+0x82, 0x25, 0x0F, 0xE9, 0x74, 0xF9, 0xB5, 0x89, 
+0xEF, 0x38, 0x30, 0xD7, 0x0C, 0x7B, 0x5E, 0x80, 
+0xD6, 0x67, 0x9A, 0x8D, 0x3D, 0xE4, 0x05, 0xB0, 
+0x10, 0x5A, 0x92, 0x6B, 0xA5, 0x98, 0xC7, 0xE5, 
+0x22, 0xCA, 0x7C, 0xF8, 0xE2, 0xE2, 0xF3, 0x3C, 
+0xBF, 0xFB, 0xD0, 0xE2, 0x8E, 0x02, 0x6A, 0x23, 
+0x90, 0xDD, 0x5B, 0x34, 0x89, 0xBF, 0xEA, 0x52, 
+0x64, 0x39, 0xDF, 0xF1, 0x2F, 0x71, 0x98, 0x74, 
+0xB9, 0x7A, 0xC7, 0x7A, 0x0D, 0xC8, 0x78, 0xD6, 
+0xE9, 0x93, 0x8B, 0xB9, 0xA7, 0xBD, 0x3D, 0x43, 
+0x14, 0x5C, 0x88, 0x07, 0x6F, 0x9D, 0x47, 0x54, 
+0x7E, 0xC2, 0xB0, 0xB0, 0xF1, 0x59, 0xC3, 0xDF, 
+0x1D, 0xD7, 0x5D, 0xFB, 0x05, 0x39, 0xFE, 0xBF, 
+0xF7, 0xD8, 0x8B, 0xBE, 0x1D, 0x4C, 0xDD, 0xEC, 
+0xFF, 0x59, 0x5C, 0x2A, 0x66, 0xD2, 0x73, 0xE6, 
+0x0C, 0x8A, 0x9E, 0xB4, 0x6E, 0x89, 0x1F, 0xED, 
+0xCB, 0xCE, 0x12, 0xC5, 0x55, 0x8E, 0x24, 0xE8, 
+0x3E, 0xC8, 0xCF, 0xEE, 0x0D, 0xAA, 0xE2, 0xCE, 
+0x46, 0xBC, 0x0F, 
+
+0x29, 0x5C, 0x96, 0x41, 0x93, 0x62, 0x2A, 0xE8, 
+0x66, 0x16, 0x73, 0x2E, 0x68, 0xAA, 0xBE, 0x81, 
+0x79, 0x57, 0x88, 0x45, 0x47, 0xFD, 0x95, 0x1C, 
+0x6E, 0x83, 0x38, 0x05, 0x25, 0x2A, 0x61, 0x16, 
+0x17, 0x5D, 0xDE, 0x51, 0x62, 0xDB, 0x8C, 0xBF, 
+0x5E, 0x05, 0xC5, 0xF7, 0x46, 0xFB, 0xC3, 0x22, 
+0xC3, 0x1F, 0x5D, 0xCF, 0x18, 0x91, 0x7D, 0xA4, 
+0xBA, 0x1A, 0x1F, 0x9C, 0x5E, 0xDF, 0x87, 0xCA, 
+0x55, 0x81, 0xBC, 0xF0, 0x36, 0x51, 0xA5, 0xAF, 
+0xBC, 0xBC, 0x30, 0x91, 0xF6, 0x9C, 0xA3, 0x6C, 
+0xC2, 0xA0, 0x2C, 0xF6, 0x78, 0x33, 0xD3, 0x1B, 
+0x61, 0x1B, 0xC0, 0x9F, 0x13, 0x74, 0x66, 0x73, 
+0xDD, 0xE4, 0xDB, 0x18, 0x19, 0xD9, 0xB9, 0xBF, 
+0xCE, 0x0D, 0x79, 0x5C, 0x6A, 0xF2, 0x37, 0xCD, 
+0x22, 0x77, 0x78, 0xEA, 0xAF, 0x3B, 0x9F, 0x6E, 
+0x1B, 0x88, 0x5D, 0x4C, 0x35, 0x1C, 0x1C, 0x36, 
+0x3E, 0x18, 0x20, 0xCA, 0x8A, 0x0E, 0x2D, 0xF0, 
+0x2A, 0xCD, 0x9F, 0x1D, 0xFD, 0x16, 0xC6, 0x61, 
+0x40, 0xEC, 0x5A, 0xBB, 0x7E, 0xE6, 0xBB, 0x7E, 
+0x21, 0xB7, 0x42, 0x16, 0x69, 0x52, 0x1A, 0xD3, 
+0x4C, 0xE8, 0x64, 0xFF, 0x98, 0xFD, 0xEF, 0xA2, 
+0xE4, 0x90, 0x0A, 0x2D, 0x5E, 0x22, 0x24, 0x64, 
+0x5C, 0xC4, 0x82, 0xC4, 0x6E, 0x34, 0x06, 0x32, 
+0x75, 0x75, 0x61, 0x54, 0xE5, 0x90, 0x82, 0x52, 
+0xF2, 0xE9, 0xBB, 0xA8, 0x7B, 0x93, 0xC3, 0xBC, 
+0x2C, 0x08, 0x99, 0xAF, 0xE2, 0x45, 0x3E, 0x58, 
+0x45, 0x30, 0x12, 0xE4, 0x96, 0x68, 0xAC, 0x1A, 
+0x33, 0xCD, 0x9B, 0x42, 0x41, 0xE2, 0x26, 0xFE, 
+0x42, 0x88, 0xD3, 0xBF, 
+
+0xD3, 0x35, 0x99, 0x55, 0x58, 0x7C, 0x19, 0x28, 
+0xB0, 0x14, 0x0C, 0xB3, 0x71, 0x82, 0x87, 0xC7, 
+0x74, 0xEF, 0x1D, 0x4F, 0xAF, 0x68, 0xA8, 0x10, 
+0x50, 0xCB, 0x34, 0x75, 0xFB, 0x4D, 0xB1, 0xF0, 
+0x14, 0x57, 0x0C, 0x02, 0xE5, 0xC8, 0xF8, 0x35, 
+0xF7, 0x5D, 0x4D, 0x33, 0xA6, 0x93, 0xAF, 0x4F, 
+0x8D, 0x51, 0x0F, 0xB7, 0x6A, 0x39, 0x5B, 0xA8, 
+0x73, 0xBD, 0xDE, 0x22, 0xB1, 0xD8, 0xE8, 0x34, 
+0x21, 0x3D, 0x41, 0x72, 0x64, 0x4B, 0xCF, 0xD2, 
+0xE2, 0x5E, 0x92, 0x3A, 0xF6, 0xFA, 0x55, 0x0B, 
+0x8B, 0xD3, 0x56, 0x42, 0xCF, 0xCB, 0x74, 0xF5, 
+0xD4, 0xEB, 0x01, 0x3D, 0x2E, 0xC2, 0x45, 0xE1, 
+0xF6, 0x17, 0xC3, 0x27, 0x8D, 0x10, 0x0E, 0x61, 
+0x00, 0xB2, 0x51, 0xE6, 0x5D, 0x2F, 0xB4, 0x21, 
+0x0A, 0x9E, 0xBF, 0xAB, 0x05, 0xCF, 0xDB, 0xCD, 
+0x68, 0xED, 0xD5, 0x56, 0xE1, 0x13, 0x19, 0x70, 
+0x9D, 0x37, 0x97, 0x3A, 0x6A, 0x7C, 0xC2, 0x12, 
+0x80, 0x8C, 0xE1, 0x56, 0x4E, 0xA7, 0xE4, 0x71, 
+0x14, 0x36, 0xF2, 0x8C, 0x57, 0x35, 0x8D, 0x6A, 
+0x18, 0x7E, 0x63, 0xB5, 0xA5, 0x54, 0x92, 0x4F, 
+0xFF, 0xFA, 0x4F, 0x60, 0x0F, 0x9C, 0x35, 0x2A, 
+0xE6, 0xC5, 0x8E, 0xAD, 0x8B, 0xE5, 0x77, 0x5A, 
+0x52, 0x8C, 0xC4, 0x09, 0x15, 0xA7, 0xA9, 0x71, 
+0x22, 0xF2, 0x11, 0xDC, 0xA8, 0xDD, 0xDA, 0x43, 
+0x02, 0xCF, 0xE8, 0x00, 0xE4, 0xAC, 0x85, 0xFC, 
+0x58, 0x02, 0x2A, 0xDF, 0xB7, 0x36, 0x3B, 0x25, 
+0x09, 0xC1, 0xE5, 0xCC, 0xDE, 0xF5, 0xCE, 0xE1, 
+0xF9, 0x0A, 0x93, 0x40, 0x21, 0x73, 0xFC, 0x52, 
+0x03, 0x90, 0xFD, 0x7A, 0x94, 
+
+0x22, 0x0A, 0x9E, 0x33, 0x14, 0x5D, 0x3F, 0x08, 
+0x88, 0xB5, 0xE3, 0x32, 0x2B, 0x01, 0x4A, 0xC7, 
+0xCF, 0x4B, 0xE0, 0x6C, 0x21, 0x5E, 0x2D, 0x11, 
+0xE4, 0xF9, 0x0B, 0x2D, 0xE9, 0x80, 0x24, 0x67, 
+0x0E, 0xF3, 0x41, 0x84, 0xCE, 0x9F, 0x0C, 0x76, 
+0x13, 0x8C, 0x40, 0xD1, 0x79, 0x18, 0x13, 0x0D, 
+0x0D, 0xA2, 0x48, 0x7A, 0x4A, 0x59, 0xCB, 0xE4, 
+0x60, 0x5D, 0x15, 0xDE, 0x11, 0xB7, 0x3C, 0x57, 
+0xC2, 0x97, 0xBF, 0xDD, 0x05, 0x16, 0xC7, 0xCB, 
+0xB1, 0xC0, 0xC2, 0x38, 0x1E, 0xAA, 0xB4, 0x6A, 
+0x8F, 0x68, 0x0B, 0x4D, 0x11, 0xE0, 0x71, 0x9F, 
+0x2C, 0xD5, 0xB5, 0x43, 0x67, 0x72, 0x85, 0x0B, 
+0x6E, 0x03, 0x28, 0xE9, 0xAD, 0x87, 0x96, 0x81, 
+0x30, 0xD9, 0xA9, 0x2D, 0xB4, 0x04, 0xB3, 0xE3, 
+0xA7, 0x13, 0x2F, 0xC1, 0x27, 0x2D, 0xC8, 0xCF, 
+0xCC, 0xB6, 0x12, 0xB6, 0xD8, 0x9F, 0xAF, 0x32, 
+0x10, 0xAB, 0x2E, 0x99, 0x6C, 0x3D, 0x0C, 0x09, 
+0xCB, 0x6E, 0x1F, 0xAB, 0x00, 0x2D, 0x75, 0xAB, 
+0x94, 0x6A, 0x84, 0x59, 0x8A, 0x1F, 0xA9, 0x03, 
+0xC6, 0x6C, 0x1A, 0xB5, 0xF7, 0x6E, 0x7C, 0xCE, 
+0x0C, 0x08, 0xAB, 0x1F, 0x0F, 0xCC, 0xF6, 0x86, 
+0xD9, 0xA3, 0xD4, 0xAA, 0x74, 0xC9, 0xDA, 0x0E, 
+0x17, 0x50, 0x98, 0x24, 0x63, 0xD5, 0xDF, 0x85, 
+0xB9, 0x5D, 0x2B, 0xEB, 0xEF, 0x3A, 0xE7, 0x6A, 
+0xAC, 0x8A, 0x9B, 0xCB, 0x07, 0xFD, 0xAC, 0xB6, 
+0x28, 0xA9, 0xCA, 0xCF, 0xB4, 0x71, 0x26, 0x3B, 
+0x9C, 0xE4, 0xE1, 0x50, 0x6D, 0x43, 0xCC, 0xEF, 
+0x2D, 0x96, 0x9C, 0x6D, 0x78, 0x3E, 0x45, 0xF7, 
+0x3E, 0x9F, 0x4E, 0x5F, 0xDE, 0xF8, 
+
+0x83, 0xD0, 0x60, 0xED, 0x60, 0x1D, 0xE9, 0x64, 
+0x27, 0xFB, 0x32, 0x9D, 0x04, 0x97, 0x75, 0xB2, 
+0xF0, 0xF5, 0x74, 0x50, 0xC8, 0xF6, 0x37, 0xA3, 
+0xC4, 0xC6, 0x7E, 0x5F, 0x8D, 0xE1, 0xCC, 0xBA, 
+0x1B, 0x67, 0xC4, 0x33, 0xE5, 0x88, 0xE5, 0xAB, 
+0x54, 0xB1, 0xB4, 0x33, 0x38, 0xD2, 0x26, 0xB9, 
+0xBB, 0xAB, 0xEC, 0x0C, 0x3D, 0xC2, 0xA2, 0xE7, 
+0x90, 0xBA, 0xD3, 0xDC, 0x37, 0x46, 0xC0, 0x2F, 
+0x03, 0x20, 0xD9, 0x2C, 0x58, 0x17, 0x2C, 0x6B, 
+0x7F, 0x06, 0x6A, 0x9A, 0x66, 0xA0, 0x1E, 0x6D, 
+0x87, 0xD2, 0x3C, 0x44, 0x06, 0xD7, 0x0E, 0xD0, 
+0x78, 0xB7, 0x2E, 0xF2, 0xE0, 0x72, 0x30, 0xFF, 
+0x1B, 0x58, 0x9A, 0xEB, 0xDB, 0x65, 0x70, 0xC1, 
+0x7F, 0x27, 0xCC, 0xA8, 0xC5, 0x1E, 0xD5, 0xE4, 
+0x4F, 0xA0, 0xCA, 0x1D, 0xD7, 0xE2, 0x7B, 0xD4, 
+0x91, 0xBA, 0xF4, 0x6F, 0xCF, 0x79, 0xF1, 0x85, 
+0xE0, 0x62, 0xFA, 0xC8, 0xF1, 0xD9, 0x54, 0x0C, 
+0xEB, 0x9F, 0xAF, 0xCB, 0x75, 0x05, 0xCA, 0x38, 
+0xE2, 0x5E, 0x1A, 0xC4, 0x33, 0xD2, 0xAB, 0xA5, 
+0x49, 0xBF, 0x0C, 0x8C, 0xAF, 0x03, 0xE4, 0x6F, 
+0xDE, 0x75, 0x0D, 0xA9, 0xC8, 0xE3, 0x3A, 0x07, 
+0xD5, 0x4E, 0x68, 0xD1, 0x02, 0x7B, 0x63, 0x38, 
+0x5D, 0x15, 0xB7, 0xA6, 0xB9, 0x2B, 0xAE, 0xAC, 
+0xAB, 0x3B, 0x7E, 0xD4, 0x3A, 0x33, 0x85, 0x58, 
+0xE7, 0x57, 0xBB, 0xE0, 0x21, 0x4D, 0x26, 0xE2, 
+0xAE, 0x81, 0xA7, 0xC4, 0xDE, 0xA7, 0x2C, 0x89, 
+0x93, 0x79, 0xA0, 0x9A, 0x21, 0x11, 0x16, 0xBD, 
+0x43, 0x9E, 0x9F, 0xCC, 0x19, 0x88, 0xFF, 0x4D, 
+0x60, 0xFC, 0xF4, 0x57, 0xC7, 0x0D, 0xE6, 
+
+0xE3, 0xC6, 0xF9, 0x93, 0xFE, 0xD2, 0x0F, 0xE9, 
+0x36, 0x5E, 0xD2, 0xFC, 0xFA, 0x94, 0xEF, 0x99, 
+0xD8, 0x10, 0x96, 0x91, 0x75, 0x61, 0x59, 0xFC, 
+0x9B, 0x8D, 0xCF, 0x04, 0x9F, 0x30, 0x65, 0x42, 
+0xC1, 0xDF, 0x51, 0x04, 0x69, 0x60, 0x58, 0x49, 
+0x13, 0xE2, 0xC9, 0xEA, 0x12, 0x08, 0x4A, 0x36, 
+0x41, 0x42, 0x14, 0x38, 0xCB, 0x75, 0x14, 0xCE, 
+0x8C, 0xAD, 0x48, 0x12, 0x96, 0xCB, 0x84, 0x2D, 
+0x5C, 0xD0, 0x69, 0x98, 0xB5, 0xC7, 0xEB, 0x6E, 
+0x3F, 0x4D, 0x4B, 0x90, 0x5D, 0x75, 0x3A, 0xAB, 
+0x0E, 0x3E, 0x50, 0xA3, 0x69, 0xA1, 0x8D, 0x8E, 
+0x48, 0x72, 0xBB, 0x3A, 0x94, 0x22, 0x99, 0x13, 
+0x69, 0xD9, 0xA7, 0x33, 0x1C, 0x82, 0x02, 0xE8, 
+0x3C, 0xFF, 0x49, 0x40, 0x84, 0x25, 0xDE, 0x67, 
+0x92, 0xF4, 0x59, 0x77, 0x41, 0xAB, 0x2A, 0x49, 
+0xAE, 0x40, 0xC7, 0x8D, 0xB9, 0x52, 0xDA, 0x42, 
+0xE1, 0x3D, 0xB4, 0xEF, 0x39, 0xEA, 0x0A, 0x32, 
+0xB6, 0x06, 0x21, 0xFD, 0xF5, 0x31, 0xF6, 0x56, 
+0x85, 0x7C, 0x37, 0xC5, 0xB4, 0x0E, 0x5D, 0xEA, 
+0x6D, 0x7D, 0xDC, 0x19, 0x8F, 0x71, 0xA8, 0x42, 
+0xCC, 0x2F, 0xA5, 0x26, 0xB9, 0xBC, 0x1A, 0x32, 
+0xEC, 0xC1, 0x4D, 0x66, 0x29, 0xA4, 0x10, 0xAA, 
+0x6C, 0xC5, 0xFC, 0x78, 0xCC, 0xC0, 0x42, 0x4B, 
+0x3B, 0x9C, 0x26, 0x5A, 0x37, 0x94, 0xFC, 0xE5, 
+0x68, 0x62, 0x73, 0x34, 0xEC, 0x75, 0x59, 0x0D, 
+0x1F, 0xEC, 0x11, 0x0C, 0x92, 0x55, 0xA7, 0xAD, 
+0xAD, 0x9F, 0x7A, 0xAB, 0x92, 0x71, 0xE8, 0xC3, 
+0x25, 0xC8, 0xD8, 0xB4, 0x63, 0x05, 0x39, 0xE8, 
+0x6A, 0x60, 0x56, 0xBF, 0x23, 0xA9, 0xAB, 0x40, 
+
+0xD2, 0x72, 0x92, 0xCF, 0xFE, 0x08, 0x39, 0x20, 
+0xDD, 0x2F, 0xC4, 0x08, 0xE3, 0x51, 0xB1, 0x15, 
+0xBF, 0xF4, 0x9C, 0x59, 0xC6, 0x3C, 0x81, 0xC8, 
+0xDC, 0x64, 0x29, 0x08, 0x9C, 0x76, 0x81, 0xF7, 
+0x6C, 0xB1, 0xA8, 0xDE, 0xB5, 0x7B, 0x60, 0xDE, 
+0x8E, 0xB6, 0x44, 0xA7, 0x86, 0xEF, 0x73, 0x33, 
+0x0D, 0xC4, 0xA0, 0x2B, 0xE7, 0xD1, 0x49, 0xFA, 
+0x21, 0xF5, 0x36, 0xC0, 0xA9, 0x52, 0x84, 0x4A, 
+0xEB, 0xAD, 0xCB, 0xF1, 0x9B, 0x22, 0x96, 0xB6, 
+0x30, 0x09, 0xAF, 0x19, 0x84, 0xD6, 0x64, 0x1E, 
+0x9A, 0xE5, 0xAC, 0x42, 0xCF, 0x04, 0x4D, 0x7D, 
+0xCC, 0x23, 0x94, 0xBC, 0x31, 0x69, 0x6A, 0xC3, 
+0x5D, 0xD7, 0xCD, 0x36, 0x5B, 0xF2, 0x13, 0x22, 
+0x77, 0x8C, 0xBA, 0x2C, 0x8E, 0xDC, 0x75, 0x18, 
+0xB5, 0x64, 0xCF, 0xED, 0x01, 0xF5, 0x1C, 0x58, 
+0x2A, 0x74, 0x9A, 0xC1, 0xC1, 0x04, 0xE3, 0xE9, 
+0xA1, 0xD1, 0xED, 0x44, 0x01, 0x48, 0x2E, 0xA8, 
+0x16, 0x4B, 0xF8, 0x77, 0xEB, 0x28, 0x6E, 0x79, 
+0x05, 0x38, 0xEB, 0xE7, 0xBE, 0x9C, 0xD6, 0xDE, 
+0x87, 0xEF, 0x60, 0xB9, 0xD7, 0x22, 0x7A, 0xC4, 
+0x12, 0x95, 0xA5, 0x8F, 0x45, 0x9C, 0x55, 0xB7, 
+0xC8, 0xB1, 0x89, 0xBD, 0x89, 0x8C, 0xDC, 0x9F, 
+0x2D, 0x57, 0x91, 0xED, 0x15, 0x80, 0xE8, 0x9F, 
+0xFA, 0xBE, 0xFF, 0xB6, 0xDE, 0x92, 0x1C, 0x99, 
+0x56, 0x7B, 0x7D, 0xB6, 0xC9, 0xA1, 0xD5, 0xC8, 
+0x2E, 0xBD, 0x4D, 0xF6, 0x46, 0x7A, 0x28, 0x22, 
+0x5C, 0x88, 0xA0, 0xFB, 0xA9, 0x5C, 0xD3, 0xA5, 
+0x80, 0xA5, 0xBF, 0x7E, 0xB7, 0xB6, 0xF5, 0x2A, 
+0x5E, 0xCF, 0xB6, 0xC6, 0x1A, 0xF9, 0x76, 0xF9, 
+0xD1, 
+
+0x90, 0x24, 0x19, 0xA4, 0xBE, 0xB1, 0xE5, 0xF9, 
+0x11, 0x55, 0x40, 0x95, 0x52, 0x01, 0x35, 0x57, 
+0xCE, 0x4D, 0x9D, 0x93, 0xAF, 0xDC, 0xA4, 0xA9, 
+0x1B, 0x9C, 0xE7, 0x0E, 0xC2, 0x3A, 0x43, 0xC3, 
+0x12, 0x64, 0x0D, 0x16, 0x40, 0xF7, 0x17, 0x8D, 
+0x34, 0xA3, 0x06, 0x93, 0x54, 0xE4, 0xA2, 0x33, 
+0xCD, 0xF3, 0xA2, 0x05, 0x36, 0x00, 0x14, 0xFB, 
+0x45, 0xC5, 0x32, 0xD6, 0x9E, 0xAC, 0x12, 0xEC, 
+0xCB, 0xA7, 0x3A, 0xEE, 0x93, 0xD5, 0x6F, 0x65, 
+0xAB, 0x1A, 0xB4, 0xA0, 0x74, 0xF7, 0x1E, 0xD5, 
+0xC9, 0xB4, 0x8A, 0xD4, 0xDE, 0xE6, 0x27, 0xDC, 
+0x1C, 0x30, 0x1C, 0x41, 0x23, 0x76, 0xF1, 0x77, 
+0x5C, 0xF1, 0x63, 0x71, 0x07, 0xC9, 0xF1, 0xC2, 
+0x01, 0x03, 0x7D, 0x39, 0x95, 0x29, 0x80, 0x2A, 
+0x31, 0xA5, 0x77, 0x0B, 0x26, 0x27, 0x32, 0x6A, 
+0x81, 0x0A, 0xDF, 0x18, 0x2E, 0x6D, 0x48, 0x7D, 
+0xFF, 0x5E, 0x84, 0xB7, 0xC8, 0x1D, 0x65, 0x84, 
+0x13, 0xEA, 0xB6, 0x13, 0xFC, 0x78, 0xD7, 0x27, 
+0xF8, 0xB0, 0xB2, 0xC3, 0xF9, 0x36, 0x58, 0xBF, 
+0xBE, 0x8F, 0xE1, 0xB7, 0x03, 0x95, 0xDA, 0x9D, 
+0xEE, 0x74, 0xEF, 0xB5, 0x91, 0xC7, 0x83, 0xB4, 
+0x12, 0x04, 0xB0, 0x70, 0x95, 0x90, 0x91, 0x49, 
+0x60, 0x42, 0xC0, 0xDC, 0xF2, 0xDA, 0xFD, 0x9E, 
+0xC9, 0x6F, 0x6F, 0x69, 0x38, 0xC0, 0x78, 0x8C, 
+0x82, 0x2B, 0x6D, 0x86, 0x3E, 0xF1, 0x90, 0x21, 
+0x73, 0x83, 0x56, 0x3A, 0x37, 0xEF, 0xAF, 0xB4, 
+0x70, 0xF5, 0xB7, 0xEB, 0x14, 0xD6, 0x83, 0x8D, 
+0xFA, 0x7A, 0xDA, 0x63, 0xFB, 0x99, 0x31, 0xEB, 
+0x99, 0x22, 0x29, 0x60, 0x84, 0xA7, 0xA8, 0xD5, 
+0xFF, 0xBC, 
+
+0x32, 0x9E, 0xFE, 0xE9, 0x9F, 0xF4, 0xDF, 0x52, 
+0x11, 0x99, 0xC9, 0xE0, 0xFF, 0xB0, 0xB0, 0x68, 
+0xE1, 0xDF, 0x6D, 0x8D, 0x3F, 0x07, 0x90, 0x48, 
+0x0B, 0x1D, 0xB3, 0x3B, 0x6B, 0xF1, 0x1D, 0xCA, 
+0x48, 0x52, 0xCA, 0xC9, 0xA5, 0xF4, 0x7B, 0x0B, 
+0xA9, 0x39, 0x1B, 0x69, 0xCB, 0x7F, 0x39, 0x19, 
+0xDC, 0x1E, 0x19, 0x7C, 0x92, 0xF4, 0x2A, 0x2A, 
+0x20, 0xC6, 0xB8, 0xD7, 0x60, 0xBD, 0x5C, 0x10, 
+0x8A, 0x47, 0x4B, 0x8B, 0x23, 0x0D, 0x26, 0xC4, 
+0x82, 0x44, 0x0D, 0x3C, 0x66, 0x8F, 0x7F, 0x22, 
+0x0A, 0xE2, 0x74, 0xD2, 0xE6, 0xC5, 0xE1, 0xEF, 
+0x1E, 0x95, 0x5A, 0x53, 0xB5, 0x93, 0x7F, 0x79, 
+0x60, 0x9A, 0x5E, 0x59, 0xBE, 0x12, 0xFE, 0x2E, 
+0xF1, 0x1B, 0xA7, 0x8F, 0x62, 0x33, 0x78, 0x3B, 
+0x2B, 0xD5, 0x8A, 0x06, 0xC5, 0x62, 0x61, 0x10, 
+0x09, 0xDF, 0xFF, 0x8F, 0x38, 0x72, 0x43, 0x93, 
+0xAD, 0xFD, 0x34, 0x3A, 0xB7, 0xF7, 0x74, 0xC0, 
+0x60, 0x43, 0x64, 0x0E, 0x2C, 0xDA, 0x62, 0x2B, 
+0x21, 0x8D, 0x5F, 0xE0, 0x33, 0x8E, 0xFA, 0x32, 
+0xCC, 0x12, 0x31, 0x09, 0x0A, 0x5B, 0x30, 0x7B, 
+0xD9, 0xA1, 0xFC, 0x99, 0x26, 0x13, 0x6E, 0x9F, 
+0x8C, 0x48, 0x87, 0x06, 0xF3, 0x36, 0x6C, 0x10, 
+0x61, 0xE3, 0xD4, 0x54, 0x13, 0x8A, 0x7D, 0x06, 
+0x52, 0x31, 0xC0, 0x6F, 0x62, 0xB0, 0xEE, 0xA9, 
+0x0F, 0xFB, 0xD6, 0x7C, 0x8B, 0xA4, 0x05, 0x91, 
+0xA3, 0xF2, 0xE6, 0x9B, 0x90, 0x0B, 0xB3, 0xE3, 
+0x77, 0x62, 0x04, 0x82, 0x77, 0xB3, 0x62, 0xC4, 
+0xA6, 0xBC, 0xC5, 0x66, 0xD1, 0xCE, 0x3E, 0x36, 
+0x3C, 0x0F, 0x13, 0x0F, 0xC9, 0xBF, 0xB6, 0x2F, 
+0x69, 0xAF, 0xF8, 
+
+0xCC, 0x62, 0x73, 0x39, 0x68, 0x74, 0x20, 0xB5, 
+0xAC, 0x53, 0xCD, 0x1C, 0xD0, 0x18, 0x48, 0x8E, 
+0x66, 0x8D, 0xFE, 0xFF, 0xF6, 0xB7, 0x03, 0x32, 
+0xAA, 0x34, 0x47, 0x13, 0x57, 0x30, 0xED, 0x7D, 
+0xFC, 0x36, 0xA8, 0x77, 0x09, 0x54, 0x93, 0xC3, 
+0x35, 0xE7, 0x5F, 0xC1, 0x58, 0xBD, 0xA6, 0x72, 
+0xC3, 0xA8, 0x6D, 0x22, 0x12, 0x11, 0xC2, 0x3B, 
+0x85, 0x0C, 0xB4, 0xF2, 0x26, 0xF8, 0x3B, 0x68, 
+0x07, 0xFF, 0x5E, 0x9D, 0x09, 0x76, 0xEC, 0x52, 
+0xA8, 0xEF, 0xA4, 0x58, 0x3D, 0x75, 0x73, 0x47, 
+0x84, 0x81, 0x94, 0xF6, 0xEF, 0xB1, 0x6B, 0xE2, 
+0xEE, 0x36, 0xC4, 0xBB, 0xC7, 0x3A, 0xF2, 0x98, 
+0x30, 0x21, 0x15, 0xFE, 0xEA, 0x3A, 0x73, 0x73, 
+0x2E, 0xAB, 0x77, 0x99, 0xA1, 0xBE, 0x81, 0x64, 
+0x22, 0x87, 0x31, 0xF6, 0x38, 0x54, 0x7A, 0x22, 
+0xE8, 0x6E, 0x10, 0xD3, 0x9C, 0xE0, 0x2A, 0xBD, 
+0x99, 0x33, 0x8C, 0x74, 0x46, 0x5E, 0x1A, 0x20, 
+0x67, 0x17, 0x0D, 0xC1, 0x63, 0x2E, 0x7B, 0x7C, 
+0x04, 0x66, 0x57, 0xE3, 0x48, 0x23, 0x3E, 0x78, 
+0xF5, 0x58, 0x32, 0x08, 0x73, 0x6B, 0x38, 0x3C, 
+0xFA, 0xB2, 0x33, 0x91, 0x0E, 0xC3, 0x09, 0x14, 
+0xC7, 0x62, 0xD2, 0xC2, 0xC1, 0x0B, 0x22, 0xA9, 
+0x48, 0x4E, 0x20, 0x26, 0xD1, 0x46, 0x5B, 0xC4, 
+0xFC, 0x99, 0x69, 0x77, 0xE7, 0x29, 0xB8, 0x55, 
+0x0D, 0x27, 0xCA, 0x14, 0x14, 0x59, 0x12, 0x3E, 
+0xF3, 0x1D, 0x5F, 0xA6, 0x51, 0x64, 0xF2, 0x2C, 
+0xDD, 0xC9, 0xCB, 0x0D, 0x92, 0x48, 0xDB, 0x5A, 
+0xCF, 0xB3, 0x9C, 0xE9, 0x9B, 0x48, 0xF1, 0x0B, 
+0x5F, 0xDA, 0xEF, 0x9A, 0xD3, 0x1B, 0xA7, 0x33, 
+0x12, 0xF3, 0xE9, 0xEF, 
+
+0x45, 0x86, 0xB6, 0x9B, 0x5F, 0xC2, 0x6C, 0x38, 
+0xCF, 0x23, 0xD6, 0xE9, 0x2F, 0xCF, 0x9C, 0x2D, 
+0x75, 0x9E, 0x4C, 0x43, 0xDB, 0xAE, 0x39, 0x7F, 
+0xFA, 0x42, 0x6B, 0x47, 0xE8, 0xF9, 0xE7, 0xCF, 
+0xBA, 0x53, 0xDE, 0x0E, 0x7C, 0x36, 0x46, 0x5D, 
+0xA5, 0xD0, 0xB5, 0xB1, 0x93, 0xFD, 0x07, 0xB4, 
+0x02, 0xAE, 0x90, 0x76, 0xF9, 0xE0, 0x94, 0xE5, 
+0xF5, 0x6D, 0xAA, 0x59, 0x9F, 0x34, 0x03, 0xB3, 
+0x41, 0x72, 0x12, 0x11, 0x40, 0xD7, 0x47, 0x42, 
+0x44, 0x3C, 0xFA, 0xCD, 0x51, 0xB6, 0x7A, 0x4D, 
+0x02, 0x1E, 0xF1, 0x11, 0x90, 0x96, 0x97, 0x24, 
+0x31, 0x4B, 0x49, 0xD7, 0xE3, 0x4B, 0x00, 0xEC, 
+0xD3, 0x79, 0x52, 0x36, 0x77, 0xD6, 0xCE, 0xA8, 
+0xDD, 0x1B, 0x43, 0x08, 0xFA, 0x59, 0x24, 0x04, 
+0x98, 0x2F, 0x85, 0x4C, 0x9E, 0x32, 0x24, 0x48, 
+0xD2, 0x37, 0xB4, 0x73, 0x7C, 0x5C, 0xD2, 0x7D, 
+0xBE, 0x5E, 0xC7, 0x5D, 0xB0, 0x6A, 0xCF, 0xD6, 
+0x5A, 0x5B, 0xC0, 0x2C, 0x70, 0xC2, 0x92, 0x72, 
+0x2E, 0x48, 0x9F, 0x91, 0x66, 0x03, 0x82, 0x49, 
+0xF6, 0x0E, 0x54, 0x61, 0x5F, 0x27, 0x96, 0xE8, 
+0x44, 0xAB, 0x5C, 0xBD, 0xB7, 0xEC, 0x12, 0xE0, 
+0x70, 0x35, 0xAB, 0x16, 0xD7, 0xC1, 0x0D, 0x6B, 
+0xC9, 0xB7, 0x74, 0xBB, 0xA8, 0xA4, 0x5F, 0xB8, 
+0xD7, 0x3D, 0xE6, 0x1C, 0xC1, 0xDE, 0xC2, 0x3F, 
+0x4B, 0xC6, 0x9A, 0xE3, 0x9C, 0x4A, 0xB1, 0x58, 
+0x75, 0x92, 0x3F, 0x85, 0xD7, 0x08, 0xCA, 0xF6, 
+0xCF, 0x9B, 0x68, 0x01, 0x5F, 0x2E, 0x53, 0x2C, 
+0x5B, 0xF5, 0x03, 0xCE, 0xFA, 0xE1, 0x0C, 0xD6, 
+0x89, 0xD5, 0x79, 0x88, 0x5F, 0x8F, 0x88, 0xB2, 
+0xD3, 0xAD, 0xF2, 0x6B, 0xB1, 
+
+0x84, 0x19, 0x11, 0x37, 0x40, 0x68, 0x01, 0x64, 
+0x57, 0x19, 0xD1, 0x32, 0xBE, 0xF3, 0xB4, 0xFC, 
+0x76, 0x1B, 0xD4, 0xD9, 0x6E, 0x73, 0x33, 0x1E, 
+0x1F, 0x5B, 0x5F, 0x19, 0x88, 0x26, 0xED, 0xA1, 
+0xB7, 0xA4, 0x62, 0x2A, 0x76, 0x71, 0xCA, 0xE9, 
+0x07, 0xE7, 0x89, 0x17, 0x52, 0x5E, 0x4B, 0xFD, 
+0x95, 0x02, 0x37, 0x24, 0x77, 0x39, 0x14, 0x33, 
+0xA5, 0x24, 0xDE, 0xED, 0x47, 0xA5, 0x85, 0x67, 
+0x63, 0x16, 0xC8, 0x0E, 0x6C, 0x0E, 0x71, 0x82, 
+0x76, 0x30, 0x2E, 0xDC, 0x4F, 0x8D, 0xFB, 0x79, 
+0x8E, 0xBC, 0x67, 0x00, 0x56, 0x94, 0x70, 0xA4, 
+0x20, 0x3F, 0xC5, 0xE4, 0xB9, 0xED, 0xFD, 0x62, 
+0x30, 0x87, 0x0E, 0x6A, 0x24, 0xD0, 0x28, 0x9A, 
+0x47, 0xA1, 0x6D, 0xED, 0x16, 0x65, 0xC5, 0xCC, 
+0x7D, 0x3C, 0x3B, 0x37, 0x8E, 0x3C, 0x3E, 0x66, 
+0x0A, 0x8A, 0x86, 0xF3, 0xAF, 0x92, 0x99, 0xC9, 
+0xD2, 0x22, 0xAF, 0x6E, 0x99, 0x9E, 0x1F, 0x06, 
+0xFF, 0x75, 0x83, 0x8A, 0x62, 0xB6, 0x7D, 0x4A, 
+0x7E, 0xDD, 0x55, 0x28, 0xB2, 0xAB, 0x43, 0x51, 
+0x90, 0xDF, 0x2F, 0x91, 0x21, 0x32, 0x02, 0xE7, 
+0x52, 0xA1, 0xA3, 0xBD, 0x2B, 0x12, 0x3B, 0x6F, 
+0xC0, 0x4D, 0xED, 0x81, 0xC1, 0x97, 0xDF, 0x54, 
+0x94, 0x3E, 0x28, 0x72, 0x46, 0xF8, 0xE1, 0xF9, 
+0x37, 0xA5, 0x8C, 0x63, 0xE2, 0x33, 0xE6, 0x6B, 
+0xC6, 0x31, 0xC6, 0x52, 0x29, 0xBD, 0x1D, 0xC8, 
+0x85, 0x9C, 0xC7, 0xE2, 0x2F, 0x60, 0x08, 0xF1, 
+0xF3, 0x00, 0x48, 0x18, 0xE0, 0x46, 0x13, 0xB1, 
+0x5D, 0xE3, 0x6A, 0xF8, 0xA7, 0xC6, 0x87, 0x81, 
+0xA0, 0xCE, 0x7C, 0xC6, 0x1F, 0x35, 0x8B, 0xEE, 
+0x48, 0x44, 0x31, 0x15, 0x56, 0xF7, 
+
+0xBC, 0x21, 0x05, 0x61, 0xE7, 0x75, 0xE4, 0xE6, 
+0x40, 0x9A, 0x25, 0x16, 0xC1, 0x06, 0xE9, 0xEF, 
+0x8A, 0xFD, 0xF8, 0xB6, 0x66, 0xD4, 0xBC, 0x10, 
+0xC4, 0x88, 0xEB, 0x97, 0xAA, 0x8B, 0x0E, 0x9B, 
+0x26, 0x56, 0xC5, 0x8C, 0x7C, 0x73, 0x32, 0x8C, 
+0xC1, 0x0C, 0x51, 0x7C, 0x05, 0xD4, 0x5D, 0xA1, 
+0xE1, 0xB7, 0xD1, 0x0A, 0x0E, 0xE5, 0x29, 0x29, 
+0xAD, 0x3B, 0xFA, 0x8E, 0xCE, 0x13, 0x1C, 0xB6, 
+0xA5, 0x0C, 0x1B, 0x59, 0xC8, 0x8E, 0x24, 0x9A, 
+0xF9, 0x93, 0xA6, 0xEA, 0x6D, 0xA8, 0x96, 0x35, 
+0x3B, 0x6C, 0x6F, 0xE8, 0xCD, 0x03, 0x51, 0x63, 
+0x83, 0xDE, 0x61, 0xBC, 0xDD, 0x05, 0xE0, 0x9B, 
+0x8B, 0x0E, 0x46, 0xDF, 0x71, 0x47, 0x2D, 0x3E, 
+0x17, 0x89, 0xBA, 0xB1, 0x63, 0x80, 0x9A, 0x8E, 
+0x3C, 0x9C, 0x55, 0x7E, 0xF4, 0xD3, 0xDD, 0x32, 
+0x6D, 0xD6, 0x5D, 0x05, 0x9D, 0xFB, 0x66, 0x3E, 
+0xC9, 0xDA, 0x25, 0x6F, 0xC8, 0x9B, 0x5A, 0xB5, 
+0xAE, 0xEB, 0x03, 0xB8, 0x11, 0xAF, 0xE9, 0x78, 
+0x74, 0x19, 0xAF, 0xF9, 0xDA, 0x3D, 0xF9, 0x9E, 
+0xEC, 0xF1, 0x22, 0x3A, 0xD1, 0x7C, 0xA0, 0xBD, 
+0x91, 0xCA, 0x90, 0x2E, 0xA3, 0x9B, 0xAB, 0x2C, 
+0xCF, 0x2B, 0xE8, 0x33, 0x1D, 0x25, 0x3A, 0x4F, 
+0x45, 0x7A, 0x1B, 0x5F, 0x43, 0xA1, 0xC4, 0x89, 
+0xFD, 0xC8, 0x7A, 0x8F, 0xD2, 0x23, 0x18, 0x06, 
+0x24, 0x0A, 0x39, 0xA9, 0xA2, 0xD9, 0xDD, 0xC7, 
+0xF2, 0xC5, 0x7C, 0xFA, 0x89, 0x48, 0xF8, 0x32, 
+0x30, 0x9D, 0x75, 0xCF, 0xE1, 0x70, 0x08, 0x31, 
+0xD5, 0xD7, 0xC5, 0x40, 0x12, 0xDF, 0x6F, 0x7C, 
+0x98, 0x7E, 0xD3, 0x9A, 0x10, 0x8C, 0xD8, 0x6D, 
+0x31, 0xD6, 0xBB, 0x52, 0x4B, 0xF0, 0xED, 
+
+0x18, 0x3D, 0x70, 0x2C, 0xC7, 0x91, 0x61, 0xE5, 
+0x10, 0x56, 0x8A, 0x91, 0x41, 0x53, 0x9D, 0xCE, 
+0x49, 0x41, 0xEF, 0x52, 0x6E, 0xDC, 0x11, 0xE7, 
+0xC9, 0x7B, 0x9A, 0x6C, 0x6B, 0xF8, 0x42, 0xC0, 
+0xF1, 0xEF, 0x12, 0xB8, 0x85, 0x45, 0x0B, 0x5E, 
+0x83, 0x45, 0x70, 0xAD, 0x15, 0x16, 0xF2, 0x03, 
+0x21, 0x1A, 0x48, 0xB4, 0x34, 0x14, 0x51, 0x51, 
+0x44, 0xDC, 0xEF, 0x1A, 0x7E, 0xBB, 0x1A, 0xE2, 
+0x6A, 0x0D, 0x8C, 0x89, 0x26, 0x2F, 0xEF, 0x3A, 
+0xC4, 0x21, 0x5C, 0xE7, 0xAD, 0x48, 0x37, 0xB7, 
+0xFC, 0x46, 0xEA, 0x8B, 0x50, 0x53, 0x35, 0xE1, 
+0x33, 0x21, 0x40, 0xE7, 0x1F, 0x9C, 0xD8, 0xC0, 
+0x64, 0xDD, 0x7E, 0x21, 0xC3, 0x51, 0x5D, 0x01, 
+0x43, 0xDF, 0xDA, 0x23, 0x19, 0xFC, 0xCF, 0x5D, 
+0x0A, 0xCB, 0xB2, 0x4A, 0x51, 0xF0, 0xAF, 0x69, 
+0x91, 0xFA, 0x20, 0x12, 0x5E, 0x70, 0xBE, 0x17, 
+0xC8, 0x92, 0x31, 0x56, 0x9C, 0x8F, 0x1B, 0x4A, 
+0xFE, 0x23, 0x4E, 0xC7, 0x02, 0x7F, 0x8B, 0x0B, 
+0xF9, 0x36, 0x31, 0xA7, 0x07, 0xD6, 0x26, 0xA0, 
+0xB2, 0xDD, 0x10, 0xC8, 0x17, 0xF9, 0x66, 0x8D, 
+0x17, 0xDA, 0x19, 0xB6, 0xBE, 0xED, 0x7E, 0x1B, 
+0x1A, 0x03, 0xAB, 0x32, 0x6A, 0xDB, 0x88, 0x5A, 
+0x55, 0x21, 0x40, 0x9C, 0xF4, 0xF5, 0x22, 0x0C, 
+0x5F, 0xB7, 0x06, 0x39, 0xAF, 0xD8, 0xE7, 0xCD, 
+0x7C, 0x7F, 0xD9, 0xC9, 0xF9, 0x01, 0xDF, 0xAA, 
+0xA9, 0xC7, 0xD4, 0x42, 0x64, 0x66, 0x95, 0x44, 
+0x06, 0xAE, 0x71, 0xCA, 0xDA, 0xB0, 0xF8, 0xF5, 
+0x8E, 0xED, 0xC3, 0xA0, 0x0E, 0x2B, 0x55, 0xCE, 
+0x85, 0x51, 0x98, 0x40, 0x17, 0x57, 0xEE, 0xE3, 
+0x27, 0xC6, 0xE9, 0x18, 0xB0, 0x65, 0xBC, 0xAC, 
+
+0x59, 0x66, 0xA0, 0xF1, 0x43, 0xEC, 0x6D, 0x51, 
+0x05, 0x33, 0x10, 0xBA, 0xDE, 0x52, 0xC9, 0x30, 
+0xA7, 0x91, 0x47, 0xF0, 0xF9, 0xB3, 0xA9, 0xD4, 
+0xAE, 0x41, 0x81, 0x21, 0x98, 0x6E, 0x69, 0x3C, 
+0x9A, 0xD9, 0xA5, 0x69, 0x66, 0x14, 0x75, 0x47, 
+0x7D, 0xE6, 0x62, 0xA9, 0xA4, 0x89, 0x01, 0x7F, 
+0x20, 0xF0, 0xF7, 0x69, 0x94, 0x40, 0x7B, 0xBF, 
+0x17, 0xB7, 0xAF, 0x3B, 0x51, 0xA6, 0xB9, 0xC3, 
+0x75, 0x5B, 0x3B, 0x48, 0x14, 0x6D, 0x57, 0x25, 
+0x43, 0x13, 0xC8, 0x1E, 0xBC, 0x0F, 0xB5, 0xC2, 
+0xB9, 0x20, 0x1B, 0xBC, 0xE0, 0xC5, 0xA0, 0xC1, 
+0x25, 0x28, 0xBA, 0xD6, 0x67, 0x28, 0x3E, 0x09, 
+0x71, 0xF9, 0x0E, 0x3E, 0x70, 0x03, 0x24, 0x9E, 
+0x9F, 0x26, 0xE2, 0x59, 0x35, 0xD8, 0x23, 0x46, 
+0x66, 0x3C, 0x4D, 0xDE, 0xC4, 0x80, 0x10, 0x0B, 
+0xF1, 0x7F, 0x71, 0xC9, 0x02, 0x1D, 0xB1, 0xBE, 
+0xB9, 0x13, 0xC5, 0x12, 0xE2, 0xA8, 0xEE, 0x1A, 
+0x20, 0x21, 0x01, 0xC8, 0xDD, 0xC2, 0x4D, 0x4D, 
+0x8C, 0xD0, 0x19, 0xB4, 0x06, 0xA0, 0x78, 0x02, 
+0xE0, 0x42, 0x32, 0x2C, 0x7A, 0x44, 0xEE, 0xB0, 
+0x17, 0x02, 0x6A, 0x37, 0x32, 0x98, 0xF1, 0x81, 
+0x12, 0xD1, 0xAA, 0x30, 0xF0, 0xCF, 0x6E, 0x33, 
+0x80, 0xE9, 0xD5, 0xEB, 0xDD, 0xE0, 0x78, 0x24, 
+0x6D, 0xDB, 0xEC, 0x4C, 0x14, 0x69, 0x9A, 0x3A, 
+0xF7, 0xC2, 0x99, 0x99, 0xE4, 0x1F, 0x88, 0x99, 
+0xA7, 0xD1, 0xAF, 0xAE, 0x4C, 0x48, 0x1A, 0xC5, 
+0xB5, 0x86, 0x8B, 0x5C, 0xCF, 0x8B, 0xE7, 0x86, 
+0xFB, 0x2E, 0xD3, 0x77, 0x24, 0x5A, 0xFF, 0xC2, 
+0x3D, 0x26, 0xEB, 0xD5, 0x68, 0xED, 0xE2, 0xD8, 
+0x76, 0xEF, 0x4D, 0xD0, 0x0F, 0x08, 0x86, 0x7F, 
+0x47, 
+
+0xF2, 0x49, 0xE0, 0xF4, 0x69, 0x40, 0xFE, 0x3B, 
+0x7A, 0xC0, 0xFC, 0xE6, 0x94, 0x60, 0xAD, 0x73, 
+0x37, 0x3E, 0x64, 0x42, 0x6E, 0x71, 0x38, 0x5F, 
+0x22, 0x92, 0x80, 0xDF, 0x0C, 0x2E, 0x80, 0x40, 
+0x42, 0xA5, 0xCB, 0xBE, 0x93, 0xEF, 0xAB, 0xA8, 
+0x16, 0x56, 0xA4, 0xE3, 0x9A, 0x30, 0x4C, 0xA1, 
+0x09, 0x84, 0x5C, 0x88, 0x85, 0x87, 0xF3, 0x34, 
+0xCB, 0x0D, 0xE3, 0xA9, 0x65, 0x3F, 0x8C, 0xD4, 
+0xDD, 0xC5, 0x34, 0x4A, 0xB8, 0xAC, 0xC0, 0x97, 
+0x7E, 0xD9, 0x1A, 0xF1, 0x44, 0x7D, 0x5C, 0xC4, 
+0x4B, 0x93, 0x4B, 0xF6, 0xED, 0x38, 0x29, 0x75, 
+0xB2, 0x15, 0x9A, 0xE8, 0x53, 0xD0, 0x5D, 0x3D, 
+0x48, 0x3E, 0x32, 0xE1, 0xAC, 0xDB, 0x8D, 0x1B, 
+0xD7, 0x83, 0x6B, 0xF5, 0x61, 0xD1, 0x22, 0x0E, 
+0x99, 0xFF, 0xEE, 0x75, 0x8F, 0xD2, 0xE8, 0x22, 
+0x3E, 0xF1, 0xBA, 0x4D, 0x9A, 0x9C, 0x65, 0xB3, 
+0xD8, 0xDF, 0x00, 0x54, 0x44, 0xA4, 0x73, 0xFF, 
+0xAF, 0xC6, 0xE7, 0xEA, 0x90, 0xEC, 0x38, 0x1D, 
+0xA1, 0x6F, 0x53, 0xB8, 0xEB, 0x4C, 0xF8, 0xD0, 
+0xBD, 0xB3, 0x24, 0x53, 0x8F, 0xC3, 0xBC, 0xD9, 
+0xDB, 0x65, 0x29, 0x14, 0x32, 0x44, 0x1A, 0xF5, 
+0xAD, 0xDB, 0x24, 0x68, 0x9D, 0x0C, 0xFF, 0x33, 
+0xA6, 0xDA, 0xD2, 0x82, 0x64, 0xFF, 0x03, 0x61, 
+0x1A, 0x2E, 0x6C, 0xEC, 0x78, 0xC2, 0x31, 0xB8, 
+0xBF, 0x69, 0x69, 0xC9, 0xD5, 0xAD, 0x0A, 0x8D, 
+0xB3, 0x9E, 0xE6, 0xE3, 0x46, 0xC0, 0xF9, 0x65, 
+0xC3, 0x9E, 0x1B, 0x29, 0xA0, 0x2E, 0xC2, 0x99, 
+0x14, 0xE1, 0x6B, 0x0F, 0x28, 0xA6, 0xC8, 0xF3, 
+0xFD, 0x60, 0xE9, 0x78, 0xEC, 0x38, 0x43, 0xD8, 
+0xA9, 0xC8, 0xF2, 0x85, 0x23, 0xA1, 0x4E, 0x57, 
+0xD6, 0xFD, 
+
+0x2F, 0xE7, 0x3B, 0xF9, 0xE2, 0x27, 0x54, 0x89, 
+0x86, 0xC2, 0xB0, 0x85, 0x40, 0xE0, 0x7E, 0xFD, 
+0xCD, 0x57, 0xDD, 0xB0, 0x4D, 0x44, 0x67, 0x8D, 
+0xF7, 0x09, 0x9E, 0x1D, 0x0F, 0x71, 0x39, 0x0A, 
+0x06, 0x8C, 0x70, 0xFE, 0x0F, 0x2E, 0x2F, 0xBA, 
+0x4C, 0x40, 0x2F, 0x93, 0xA5, 0x14, 0xFB, 0x05, 
+0xF3, 0xC1, 0xFF, 0x3A, 0x11, 0x24, 0x64, 0xD8, 
+0x09, 0x25, 0x12, 0xD0, 0xFD, 0x67, 0x06, 0x0F, 
+0x07, 0x83, 0x6C, 0x8B, 0x0C, 0x6F, 0xE3, 0x5B, 
+0x11, 0x10, 0xEF, 0x90, 0x8F, 0xB8, 0xEF, 0x43, 
+0xF5, 0xF2, 0x4B, 0xB4, 0x42, 0x93, 0x39, 0xF6, 
+0x03, 0xFF, 0x5E, 0x7F, 0x6C, 0xC5, 0x4E, 0xFC, 
+0x95, 0xEA, 0x56, 0x30, 0x0C, 0x6B, 0xFA, 0x2A, 
+0x7C, 0xB1, 0x79, 0x56, 0xDA, 0xC3, 0xE5, 0xE4, 
+0x2C, 0x09, 0xC4, 0x21, 0x23, 0x9F, 0xB7, 0x22, 
+0x35, 0xB2, 0x4B, 0xA2, 0xF3, 0x54, 0x74, 0xF1, 
+0x56, 0xA8, 0xC5, 0x65, 0xB8, 0x7D, 0xFF, 0xD2, 
+0x68, 0x81, 0xD5, 0x79, 0xD3, 0xDD, 0x6D, 0xF3, 
+0x21, 0xB2, 0xAC, 0x47, 0xAD, 0xD2, 0x92, 0xEE, 
+0x8E, 0x5D, 0xD3, 0x0F, 0x39, 0xDB, 0xD0, 0x7A, 
+0x46, 0x6D, 0xC9, 0xA1, 0x3B, 0x1E, 0xF8, 0x2F, 
+0x05, 0x9B, 0xEF, 0x56, 0xAD, 0xD8, 0xBF, 0xE0, 
+0xA8, 0xB3, 0xAF, 0xF0, 0x24, 0x86, 0x8A, 0x67, 
+0x7F, 0xE9, 0x6B, 0x6F, 0xCD, 0x17, 0x86, 0x00, 
+0x06, 0xF5, 0x75, 0xA4, 0x6D, 0xCD, 0x47, 0x24, 
+0x58, 0x21, 0x9F, 0xDC, 0x3D, 0x00, 0xE6, 0x5C, 
+0xDF, 0x97, 0xB5, 0x95, 0x3B, 0x40, 0x38, 0x26, 
+0xCF, 0xA1, 0x7E, 0xCA, 0x4F, 0xCD, 0xA7, 0x14, 
+0xC6, 0x12, 0x08, 0x1B, 0xFB, 0x18, 0xD8, 0xA2, 
+0x9C, 0x34, 0xFD, 0x6B, 0xB2, 0x9F, 0x77, 0x1F, 
+0x01, 0x8D, 0x6E, 
+
+0x4C, 0x03, 0x9B, 0x5A, 0x0F, 0xB4, 0x59, 0x98, 
+0xC9, 0x08, 0xF8, 0x19, 0x0A, 0xB8, 0xD0, 0x40, 
+0x63, 0xDF, 0xD7, 0xEA, 0x8F, 0xF1, 0xBA, 0x2F, 
+0xE3, 0x57, 0x51, 0x10, 0x6D, 0x35, 0xDC, 0x4F, 
+0x89, 0xBA, 0xBB, 0x40, 0x02, 0x35, 0xE9, 0x76, 
+0xEC, 0x0F, 0xED, 0xCD, 0xA8, 0xE4, 0xD6, 0x12, 
+0xE1, 0xFD, 0xE5, 0x25, 0x5E, 0x05, 0xD6, 0x08, 
+0x03, 0x58, 0xCE, 0xDD, 0x30, 0x8E, 0xE9, 0xCD, 
+0x1B, 0x88, 0x9D, 0x04, 0x08, 0xDC, 0xCC, 0xE8, 
+0xD6, 0x79, 0xB7, 0x06, 0xE9, 0xE0, 0x13, 0x31, 
+0x5A, 0x93, 0x81, 0x79, 0x10, 0x37, 0xD6, 0x2B, 
+0x9D, 0xDE, 0x94, 0x77, 0xEE, 0xB6, 0x58, 0x9D, 
+0xC9, 0x6E, 0xC4, 0xE5, 0x73, 0x9D, 0x6B, 0xF8, 
+0xE3, 0xF3, 0xFE, 0x08, 0x33, 0x44, 0x5B, 0x16, 
+0xA8, 0xB9, 0xD6, 0x59, 0xFE, 0x68, 0x17, 0xEE, 
+0x14, 0x92, 0x8E, 0x43, 0x19, 0x02, 0x06, 0x4A, 
+0x87, 0xE4, 0xF2, 0xA8, 0xC4, 0x07, 0x6C, 0x44, 
+0xA1, 0xB9, 0xEA, 0xD1, 0x42, 0x35, 0x98, 0xEA, 
+0x69, 0x31, 0xBC, 0x37, 0x42, 0xD0, 0x64, 0x76, 
+0x3E, 0x85, 0xE9, 0x06, 0x16, 0x6B, 0x49, 0xE3, 
+0xEA, 0xFB, 0x6D, 0x77, 0x71, 0x2A, 0xD1, 0x89, 
+0xC1, 0xC0, 0xC8, 0xDC, 0xBD, 0x2B, 0xEC, 0x9E, 
+0xEE, 0xDD, 0x86, 0xE9, 0xB2, 0x0D, 0x2B, 0x2B, 
+0xDE, 0xC2, 0x91, 0x72, 0x59, 0xE5, 0xEB, 0x4E, 
+0x7F, 0x54, 0x95, 0x03, 0x17, 0x6F, 0x72, 0x7C, 
+0x9B, 0x47, 0x1D, 0x51, 0x71, 0x66, 0x77, 0x3B, 
+0x7D, 0xEE, 0xE5, 0x9B, 0xA7, 0xE8, 0xDF, 0x17, 
+0xAC, 0x87, 0xC2, 0x44, 0x72, 0x67, 0x31, 0x6E, 
+0x46, 0x3B, 0xBE, 0x22, 0xBA, 0xBF, 0x61, 0x0D, 
+0xB4, 0xBF, 0x1B, 0xDB, 0x6F, 0xCF, 0xB5, 0x91, 
+0x19, 0x23, 0x2D, 0x0B, 
+
+0xCB, 0xA5, 0x4E, 0x7C, 0x89, 0xB8, 0x5B, 0x3B, 
+0x6F, 0x54, 0xBE, 0xFD, 0x94, 0x34, 0x51, 0xB7, 
+0xFE, 0x4E, 0x7C, 0x48, 0x70, 0x3C, 0xDC, 0xA5, 
+0xFD, 0x70, 0xD8, 0x6C, 0x9D, 0x44, 0x3A, 0x3E, 
+0xDC, 0xFC, 0x84, 0xFA, 0xA7, 0x4E, 0x03, 0x68, 
+0x31, 0x1A, 0xB5, 0x18, 0xCB, 0x7D, 0xC5, 0x52, 
+0xBE, 0xA7, 0xDC, 0xE7, 0x2F, 0x9B, 0x9E, 0xCE, 
+0x89, 0xB8, 0x47, 0x15, 0xAD, 0x4F, 0x71, 0x0F, 
+0xFA, 0x36, 0x90, 0x81, 0x7A, 0xD7, 0xED, 0x57, 
+0x4D, 0x9B, 0xCA, 0xD6, 0xED, 0xAA, 0x86, 0xEA, 
+0x01, 0xCE, 0x6C, 0x0B, 0xC6, 0x70, 0x61, 0x14, 
+0x93, 0x9C, 0xBE, 0x3D, 0x6C, 0xD6, 0x8F, 0x5F, 
+0x9B, 0xC1, 0x50, 0x2D, 0xE1, 0xFE, 0x73, 0xAF, 
+0x45, 0x33, 0x65, 0x79, 0x60, 0x2F, 0x95, 0x36, 
+0x41, 0xB9, 0xC1, 0x8D, 0xD7, 0xB5, 0xFB, 0x90, 
+0x68, 0x05, 0x2E, 0x6A, 0xBA, 0x00, 0x4A, 0x3F, 
+0x4A, 0x6A, 0x07, 0x55, 0x19, 0x23, 0x29, 0x4D, 
+0x51, 0x46, 0xD8, 0x80, 0x0A, 0xA6, 0x7D, 0x19, 
+0xA2, 0x46, 0x74, 0xA8, 0xDA, 0x0E, 0x7C, 0x9F, 
+0xA7, 0x4B, 0xD6, 0x5A, 0x00, 0x03, 0x1E, 0x8B, 
+0xEF, 0x45, 0x95, 0xBD, 0xB7, 0x9D, 0x49, 0x57, 
+0x0C, 0xE6, 0x38, 0x8A, 0xCA, 0x91, 0xF2, 0xB8, 
+0x72, 0x7C, 0x54, 0x74, 0x36, 0x16, 0x0D, 0x75, 
+0x98, 0x62, 0x86, 0xEE, 0xB2, 0x5C, 0xD2, 0x6D, 
+0x80, 0x2E, 0x2F, 0x86, 0x4F, 0x55, 0xB1, 0xCC, 
+0x96, 0xDF, 0x2A, 0x16, 0x0D, 0xEA, 0x35, 0x72, 
+0xC2, 0x21, 0x02, 0x4C, 0xFC, 0x6F, 0x79, 0x76, 
+0x65, 0xBE, 0x53, 0x2D, 0x34, 0xBC, 0x4C, 0x36, 
+0x07, 0x16, 0x01, 0x98, 0x0E, 0xE4, 0x3E, 0x8B, 
+0xB8, 0x6E, 0xDA, 0x60, 0xC9, 0xC2, 0x73, 0x70, 
+0xD0, 0x16, 0xA6, 0x37, 0x07, 
+
+0x6C, 0x86, 0xFE, 0xB2, 0xDB, 0x79, 0xDE, 0x04, 
+0x5B, 0xB9, 0xB3, 0x9B, 0x1A, 0xEC, 0x7D, 0xBD, 
+0x19, 0xC5, 0x06, 0x88, 0xCF, 0xBF, 0x78, 0x1A, 
+0x76, 0x58, 0x97, 0xB8, 0x2C, 0xC2, 0x75, 0x57, 
+0x68, 0x96, 0x28, 0xBD, 0x9F, 0x53, 0x7B, 0x26, 
+0x80, 0x84, 0x29, 0xF5, 0x13, 0xA6, 0x5A, 0x54, 
+0xCE, 0xC1, 0xC6, 0x36, 0x87, 0x0F, 0x15, 0x85, 
+0x80, 0x02, 0xB7, 0xF5, 0x67, 0xCA, 0xA1, 0xE1, 
+0xF3, 0x49, 0x1B, 0xE4, 0x40, 0xAA, 0x7A, 0xB3, 
+0x73, 0x3A, 0x19, 0x41, 0x86, 0xEF, 0x62, 0xD3, 
+0xD3, 0x01, 0x72, 0x0B, 0xD7, 0xE7, 0x64, 0xCC, 
+0x7C, 0xF5, 0x6F, 0x20, 0x1C, 0x3B, 0xDD, 0x75, 
+0x38, 0xBF, 0x30, 0xAF, 0xFA, 0x96, 0xA6, 0xF5, 
+0x8B, 0xB7, 0x76, 0xB5, 0xA8, 0xAB, 0x80, 0xC7, 
+0x3B, 0x47, 0x16, 0xA3, 0xC0, 0xB4, 0x25, 0x97, 
+0xE5, 0x88, 0xB9, 0x89, 0xC8, 0xF3, 0xD6, 0x25, 
+0x38, 0xC9, 0x92, 0xAD, 0x80, 0xC2, 0x94, 0x73, 
+0x4D, 0x72, 0x8B, 0x61, 0xCE, 0xC3, 0xD0, 0x13, 
+0x62, 0x31, 0xDD, 0x3C, 0x97, 0xB9, 0x44, 0x3A, 
+0x1F, 0x0B, 0x7A, 0x6D, 0x98, 0x5F, 0xA3, 0xF1, 
+0xAF, 0x49, 0xAA, 0xE5, 0x5D, 0xF1, 0x4B, 0xFA, 
+0x63, 0x31, 0xCF, 0xD2, 0xA8, 0xBB, 0x82, 0xF7, 
+0x58, 0x18, 0x74, 0x7A, 0xC6, 0xD9, 0x4D, 0xA4, 
+0xD4, 0x66, 0x24, 0x52, 0xDA, 0xB3, 0x43, 0x5F, 
+0xD7, 0x3A, 0x5C, 0xC0, 0x80, 0x1F, 0x64, 0x46, 
+0x8C, 0x1E, 0x07, 0x8B, 0x24, 0xDD, 0x5B, 0x47, 
+0xA2, 0x75, 0xB1, 0xD7, 0x79, 0xAD, 0x89, 0x97, 
+0x04, 0xD2, 0x56, 0x2C, 0x6F, 0xB7, 0xFC, 0xF5, 
+0xE8, 0xCC, 0x64, 0x92, 0x46, 0x1C, 0x92, 0x76, 
+0xBB, 0x6A, 0x6D, 0xE6, 0x26, 0xDC, 0x8A, 0xCD, 
+0x9E, 0x02, 0x9B, 0x8D, 0xB7, 0x7F, 
+
+0xF2, 0x60, 0x63, 0x53, 0x1C, 0xDE, 0x2D, 0x78, 
+0xB0, 0xFB, 0xA1, 0x9A, 0x3F, 0x9A, 0x6A, 0xDA, 
+0xE4, 0xF4, 0x3F, 0x60, 0x93, 0xE8, 0x07, 0xD9, 
+0xF9, 0x06, 0x4C, 0x4D, 0x00, 0xF3, 0xDE, 0x88, 
+0xD5, 0x93, 0xA4, 0x6F, 0x52, 0x18, 0x4B, 0xFA, 
+0xBA, 0x16, 0xC5, 0x90, 0x22, 0xCC, 0x85, 0xC7, 
+0x3B, 0x31, 0x76, 0xE4, 0xDA, 0x92, 0xC7, 0xA6, 
+0xB0, 0xA1, 0xCE, 0x63, 0xBE, 0x65, 0x42, 0x29, 
+0x48, 0xA1, 0x3D, 0x27, 0xD8, 0xD6, 0x79, 0xCF, 
+0x93, 0x42, 0x39, 0xC7, 0x5C, 0x19, 0x4E, 0x88, 
+0x9C, 0xB3, 0xD3, 0xDA, 0x50, 0x01, 0x12, 0xCE, 
+0x82, 0xA5, 0xAB, 0x05, 0x16, 0x03, 0x24, 0xA7, 
+0xAC, 0xD4, 0x5B, 0xD1, 0x50, 0xD3, 0xFF, 0x1F, 
+0x31, 0x8B, 0x5B, 0x93, 0xB3, 0x9C, 0x3A, 0xB4, 
+0xEA, 0x45, 0x5F, 0xAC, 0xDC, 0x73, 0xA4, 0xEF, 
+0x09, 0x9A, 0x29, 0x86, 0x75, 0x02, 0x9F, 0x6B, 
+0x68, 0xDD, 0x66, 0x11, 0xEF, 0x12, 0xDD, 0xD7, 
+0x6C, 0xC4, 0xA4, 0xA9, 0xF6, 0x3B, 0x03, 0x3A, 
+0x37, 0xC8, 0x21, 0x58, 0x91, 0xCF, 0xAE, 0x9A, 
+0x17, 0x3D, 0xCD, 0xF6, 0xE6, 0x93, 0x99, 0x10, 
+0x54, 0xCE, 0x20, 0x24, 0xEF, 0x3B, 0x4F, 0xD4, 
+0x1D, 0x38, 0xC9, 0x33, 0xFA, 0x52, 0x31, 0x39, 
+0xD7, 0x7C, 0xBF, 0xAB, 0x89, 0x55, 0x0B, 0xD7, 
+0x72, 0xD4, 0x52, 0x84, 0xF8, 0xC7, 0xD9, 0xE7, 
+0xF5, 0x6E, 0xA7, 0x5B, 0xC0, 0xCA, 0x2A, 0xBB, 
+0x9F, 0x42, 0xE5, 0x38, 0x53, 0x0E, 0x2D, 0x4A, 
+0xE5, 0x46, 0x61, 0x18, 0x69, 0x8D, 0x55, 0x7F, 
+0x65, 0xD4, 0x3F, 0xF6, 0xF5, 0xE4, 0x7E, 0x90, 
+0x72, 0xE0, 0x43, 0xE2, 0x06, 0xED, 0xA6, 0xA8, 
+0x28, 0xDB, 0x5B, 0xD3, 0xF8, 0xC3, 0x6F, 0x57, 
+0x5A, 0x18, 0xA8, 0x45, 0xB5, 0x98, 0x47, 
+
+0x05, 0x41, 0xA9, 0x3B, 0xCC, 0x7F, 0x8B, 0x1F, 
+0x80, 0xA6, 0x59, 0x87, 0x5B, 0xA2, 0x71, 0x6E, 
+0x19, 0x7E, 0x04, 0x0D, 0x16, 0x1E, 0x94, 0x52, 
+0x0D, 0x2B, 0x04, 0xE2, 0x5C, 0x09, 0x66, 0x18, 
+0x9F, 0xE3, 0x83, 0x90, 0xC9, 0x4E, 0x5D, 0xDA, 
+0xED, 0x73, 0x1E, 0x8E, 0x93, 0x25, 0xAE, 0x49, 
+0xD9, 0xEC, 0x24, 0xAB, 0xF9, 0x21, 0x3B, 0x34, 
+0x96, 0x79, 0x25, 0xCD, 0xB7, 0x81, 0x24, 0x66, 
+0x30, 0x46, 0xD6, 0xA2, 0xAD, 0x91, 0x86, 0x51, 
+0x01, 0x0E, 0x8C, 0x5C, 0xC4, 0x03, 0xEA, 0x54, 
+0x1F, 0xA6, 0xDA, 0x69, 0x3A, 0x41, 0x50, 0xB6, 
+0x09, 0x45, 0x43, 0xC0, 0xD1, 0xF6, 0xBE, 0x46, 
+0x2B, 0xD0, 0x48, 0xF8, 0x2C, 0x1E, 0x1C, 0xDE, 
+0xCE, 0x08, 0xD6, 0x66, 0xC8, 0xDA, 0x5F, 0x13, 
+0x77, 0xFE, 0xC1, 0x31, 0x66, 0xCE, 0xEB, 0x97, 
+0x2D, 0x33, 0xB5, 0x0B, 0x34, 0x4F, 0x80, 0x16, 
+0x54, 0x3E, 0xC2, 0x04, 0x54, 0x47, 0x76, 0x72, 
+0xDC, 0xB8, 0xC9, 0xAF, 0x2B, 0x13, 0x65, 0x28, 
+0x6C, 0xEA, 0x7B, 0xF8, 0x8A, 0x0C, 0x96, 0x69, 
+0x0F, 0xB9, 0x7E, 0x25, 0x69, 0xAA, 0x2C, 0xBE, 
+0x47, 0x15, 0x54, 0xDC, 0xC4, 0xD9, 0x71, 0x59, 
+0xED, 0x8D, 0xDE, 0x77, 0xC5, 0xD8, 0x75, 0x28, 
+0x39, 0x92, 0x31, 0x54, 0xB7, 0xF9, 0x18, 0x3B, 
+0xE0, 0x35, 0x15, 0x5A, 0x63, 0xEE, 0xB9, 0x73, 
+0x41, 0x4C, 0xB8, 0xFE, 0xAC, 0xBB, 0x4E, 0x5D, 
+0xC8, 0x4A, 0xC4, 0x70, 0x96, 0xCE, 0xEE, 0x42, 
+0x80, 0x20, 0xD3, 0xA4, 0x9B, 0x1D, 0x89, 0x89, 
+0x21, 0x89, 0x8E, 0x91, 0x82, 0x9A, 0x78, 0x70, 
+0x63, 0x0D, 0x7F, 0x37, 0x69, 0x27, 0x1A, 0xC9, 
+0xAE, 0xF4, 0x2F, 0x4E, 0x85, 0x63, 0xFE, 0x0D, 
+0xA3, 0x46, 0x49, 0xD9, 0x1C, 0x39, 0xB3, 0x7E, 
+
+0x57, 0x7E, 0x30, 0x67, 0x54, 0xA4, 0x36, 0x7A, 
+0xF1, 0x73, 0xFE, 0xAB, 0x1A, 0x7F, 0x92, 0xED, 
+0x27, 0x1C, 0xF8, 0x47, 0xB1, 0xD5, 0xAF, 0xD2, 
+0x05, 0xDD, 0x56, 0x30, 0x1C, 0x28, 0x34, 0xF1, 
+0x98, 0xB6, 0xC6, 0x46, 0x9D, 0x91, 0x6D, 0xB3, 
+0x38, 0x35, 0x27, 0x68, 0x24, 0xD8, 0xA0, 0x7E, 
+0x41, 0x5E, 0x48, 0xCF, 0x3E, 0x36, 0x15, 0x93, 
+0xFE, 0xA0, 0x0D, 0x9C, 0x05, 0x55, 0x63, 0x9B, 
+0x1E, 0xB8, 0xD6, 0x87, 0x59, 0x28, 0xE6, 0xA3, 
+0xA0, 0xB7, 0x8D, 0x6F, 0xDE, 0xE6, 0xCD, 0x10, 
+0x2F, 0xF1, 0x82, 0x42, 0x61, 0xCA, 0xD6, 0x40, 
+0xF8, 0x8C, 0xDB, 0x5B, 0x58, 0xB2, 0x30, 0x60, 
+0x53, 0xEA, 0x74, 0x81, 0x1F, 0x0B, 0x52, 0xB7, 
+0xB6, 0xCD, 0x18, 0x53, 0xAC, 0x10, 0xD2, 0xEA, 
+0xB3, 0xB9, 0x35, 0xC4, 0xDF, 0x06, 0x03, 0x01, 
+0x61, 0x43, 0x71, 0xAB, 0xAD, 0xB5, 0x3C, 0x0C, 
+0x5F, 0x82, 0xA9, 0x53, 0x79, 0x19, 0x6C, 0x77, 
+0x0E, 0x08, 0x7F, 0xAB, 0x07, 0xCB, 0xE6, 0x2C, 
+0x43, 0xB5, 0x5D, 0x1E, 0x53, 0x5F, 0x37, 0xC6, 
+0x7D, 0xF8, 0x19, 0xAA, 0xA5, 0x63, 0xE0, 0xC8, 
+0x86, 0xE5, 0xD7, 0xBB, 0x07, 0xE2, 0x95, 0xD0, 
+0xEC, 0x15, 0x09, 0xCC, 0x77, 0x05, 0x53, 0xF2, 
+0xB9, 0x86, 0x88, 0x7A, 0x28, 0x07, 0xEA, 0x79, 
+0x35, 0xDD, 0x44, 0xD4, 0xA9, 0x2C, 0x2F, 0x82, 
+0x93, 0x94, 0xE3, 0x5E, 0x40, 0x12, 0x91, 0xFE, 
+0x8D, 0x19, 0x09, 0xFE, 0x8B, 0xF6, 0xC4, 0x11, 
+0xAB, 0xE4, 0x8A, 0xFB, 0xD7, 0x09, 0xCF, 0xF8, 
+0x6A, 0x5D, 0xDF, 0x75, 0x8F, 0xA0, 0x84, 0xF5, 
+0x65, 0x61, 0xFB, 0x77, 0x00, 0x0C, 0x17, 0xD4, 
+0x83, 0x46, 0xD0, 0xD7, 0x88, 0xD4, 0x9D, 0x9A, 
+0xA3, 0x68, 0xCE, 0x91, 0x12, 0x63, 0xA6, 0x38, 
+0x6B, 
+
+0x8F, 0x2B, 0xDF, 0xD5, 0x87, 0x51, 0x54, 0x68, 
+0xFB, 0x68, 0x7B, 0x18, 0xE7, 0xA6, 0x02, 0xE9, 
+0x50, 0x51, 0x43, 0xFC, 0xC6, 0x68, 0x86, 0xF6, 
+0x11, 0xAE, 0x75, 0xF7, 0x35, 0x09, 0xE7, 0x92, 
+0x99, 0x58, 0x1B, 0x37, 0x96, 0x31, 0xEB, 0xCC, 
+0x3B, 0x4E, 0x29, 0xB0, 0x03, 0x78, 0x14, 0x5D, 
+0xA6, 0x6B, 0xBE, 0xC1, 0x5C, 0x45, 0xA2, 0xE8, 
+0xEE, 0x83, 0xBD, 0xB7, 0xE4, 0x4A, 0xEF, 0x89, 
+0xEF, 0x75, 0x92, 0xD9, 0x8C, 0x94, 0x1F, 0xEE, 
+0x7F, 0xE9, 0xBD, 0x21, 0x7F, 0x2A, 0x7D, 0xAA, 
+0xCE, 0x0D, 0x29, 0xC3, 0x49, 0xF4, 0x11, 0x4B, 
+0x26, 0x80, 0x32, 0x6E, 0x0F, 0x56, 0x70, 0x2B, 
+0xE6, 0x80, 0xBC, 0xBC, 0x24, 0xEA, 0xC4, 0x92, 
+0x60, 0x01, 0xC3, 0x43, 0xA0, 0x0A, 0x63, 0x42, 
+0xAE, 0x74, 0xF0, 0xC4, 0x06, 0xC1, 0x3D, 0x93, 
+0x0B, 0x52, 0x18, 0x62, 0x01, 0xA3, 0x6B, 0x34, 
+0x67, 0xFE, 0x77, 0xD6, 0x72, 0xD0, 0x94, 0xEF, 
+0x29, 0xEC, 0xD5, 0x95, 0x1C, 0x20, 0x16, 0x1F, 
+0x01, 0x35, 0x90, 0xAE, 0x92, 0xE0, 0x1F, 0xFC, 
+0x13, 0xF4, 0x49, 0x99, 0x52, 0x3A, 0xB7, 0xB0, 
+0xCF, 0x6C, 0x40, 0xED, 0x5F, 0x29, 0x6B, 0x50, 
+0x9E, 0x83, 0xF3, 0xF8, 0xD9, 0x45, 0x91, 0xAB, 
+0x31, 0xBD, 0x64, 0x1D, 0x48, 0xBE, 0xB0, 0x13, 
+0x5A, 0x10, 0x78, 0x80, 0x79, 0xF6, 0x50, 0x9B, 
+0xE7, 0xBD, 0x27, 0xA9, 0x14, 0x37, 0x5D, 0xEF, 
+0x6A, 0xBA, 0x95, 0xBD, 0xFA, 0xAC, 0x4B, 0x5D, 
+0x33, 0x0F, 0x93, 0xD3, 0x7D, 0x3E, 0xCC, 0xDC, 
+0x7E, 0xC4, 0x76, 0x68, 0xF8, 0x22, 0x05, 0xB3, 
+0xE4, 0x36, 0xAC, 0xF8, 0xE5, 0x3C, 0xD3, 0x44, 
+0xC8, 0x07, 0xBA, 0x6B, 0xE9, 0x5D, 0x6F, 0xBA, 
+0x6A, 0x80, 0x3F, 0xCC, 0xA7, 0x09, 0xAB, 0x7E, 
+0x81, 0xAD, 
+
+0x7B, 0x2A, 0x25, 0x35, 0x18, 0x0D, 0x50, 0x94, 
+0x8C, 0xE4, 0x00, 0x69, 0xC2, 0x82, 0xFB, 0x45, 
+0xAC, 0xBF, 0x76, 0x0D, 0x01, 0x82, 0xC1, 0x43, 
+0x4B, 0xE5, 0xA1, 0x8B, 0xB2, 0xAA, 0x82, 0x77, 
+0x57, 0xB2, 0xC7, 0x57, 0x29, 0x60, 0xD1, 0x45, 
+0x05, 0xB8, 0x95, 0x6F, 0xD1, 0xEF, 0xC4, 0x09, 
+0x07, 0xF8, 0xA6, 0x3A, 0xC0, 0x78, 0x3A, 0x16, 
+0x73, 0x0D, 0x57, 0xD6, 0x04, 0x43, 0x6E, 0x7E, 
+0x62, 0xD8, 0x5C, 0x12, 0x61, 0x33, 0x24, 0xF8, 
+0x87, 0x95, 0xF7, 0xC3, 0x13, 0x53, 0xD1, 0xE1, 
+0xA3, 0x7C, 0xC2, 0x5E, 0xBF, 0xBD, 0x0E, 0x72, 
+0x7A, 0x6C, 0xBD, 0x13, 0x19, 0x38, 0x9B, 0x54, 
+0xB4, 0xCC, 0x8E, 0x5E, 0x55, 0xB6, 0xD0, 0xA9, 
+0xD4, 0x1D, 0x8B, 0x24, 0xD2, 0x02, 0xCF, 0x1C, 
+0x8E, 0x2A, 0x54, 0x67, 0xB4, 0x06, 0xCB, 0x0C, 
+0x16, 0x0F, 0x80, 0xE8, 0xEA, 0x16, 0x76, 0x25, 
+0x8A, 0xC7, 0xF8, 0xF9, 0x05, 0xA0, 0x9D, 0x46, 
+0x59, 0xE7, 0xC9, 0xE1, 0x6B, 0x98, 0x95, 0x0C, 
+0xE8, 0x87, 0x12, 0xA5, 0x24, 0x63, 0x8C, 0xF7, 
+0xD1, 0xDD, 0x25, 0x59, 0xB3, 0xA4, 0x87, 0x74, 
+0x22, 0xD8, 0xB2, 0xD5, 0x9A, 0x58, 0x31, 0xAA, 
+0xFD, 0x0E, 0x60, 0x7B, 0x41, 0x1C, 0x20, 0xD8, 
+0x03, 0xEB, 0x03, 0xC2, 0xE6, 0xD4, 0xE3, 0xD6, 
+0x10, 0xF9, 0xCB, 0x01, 0x65, 0x4C, 0xA0, 0xEC, 
+0x7B, 0xF1, 0x26, 0x95, 0x8F, 0x2F, 0x59, 0x6B, 
+0xF7, 0xF3, 0xD8, 0x9D, 0x50, 0x56, 0xE7, 0xBE, 
+0x3C, 0x34, 0xCA, 0xCF, 0xA6, 0x09, 0x94, 0x09, 
+0x27, 0x30, 0xF8, 0x7B, 0x6D, 0x7C, 0x7F, 0xCF, 
+0x1D, 0x74, 0xB3, 0xA6, 0x71, 0x83, 0x1B, 0xDA, 
+0x4B, 0x84, 0x8C, 0x75, 0x76, 0xAF, 0x9F, 0x2A, 
+0x19, 0x86, 0xEE, 0xA3, 0x36, 0xDD, 0xC1, 0xB9, 
+0x69, 0xAF, 0xE9, 
+
+0x2F, 0x90, 0xBB, 0x8E, 0x30, 0x7A, 0xAD, 0x0C, 
+0xD6, 0x60, 0xD7, 0x07, 0x46, 0x58, 0xDE, 0xCA, 
+0x80, 0x9A, 0x3D, 0x5D, 0x85, 0x27, 0xD8, 0xE0, 
+0x0C, 0xA9, 0xBD, 0x48, 0x6C, 0x3E, 0x0B, 0x53, 
+0xB4, 0xBE, 0xE7, 0xCF, 0xAD, 0x12, 0xBB, 0x78, 
+0x55, 0xB6, 0x40, 0xE4, 0xB2, 0xE6, 0x64, 0xD1, 
+0x28, 0x55, 0xB7, 0xE3, 0x40, 0xB9, 0x4E, 0x6F, 
+0xC5, 0x11, 0x41, 0x04, 0xB9, 0x73, 0x7D, 0x02, 
+0x8E, 0x7D, 0x6A, 0xB9, 0x15, 0x1A, 0xA3, 0x12, 
+0x90, 0x94, 0x14, 0xF3, 0x5A, 0x83, 0x04, 0x34, 
+0x47, 0x16, 0x0D, 0xB1, 0x90, 0x1B, 0x6C, 0x54, 
+0x0A, 0xBD, 0xE7, 0xD5, 0x8B, 0xDC, 0xF7, 0x63, 
+0xD1, 0x65, 0xF5, 0x23, 0x99, 0x6C, 0xC2, 0x51, 
+0xE1, 0x8D, 0x8D, 0x74, 0x7D, 0x76, 0x76, 0xC6, 
+0xDA, 0x15, 0x5E, 0xB2, 0xAD, 0x00, 0x59, 0x54, 
+0x6B, 0xA8, 0x91, 0x4C, 0x15, 0x70, 0x3C, 0x11, 
+0x24, 0xC8, 0x28, 0x0B, 0xD2, 0xA6, 0xE3, 0xE6, 
+0xEF, 0x24, 0x5A, 0x56, 0xF4, 0xA5, 0x79, 0xF9, 
+0xB0, 0x2E, 0xAD, 0x66, 0x18, 0xBF, 0x2F, 0xC7, 
+0x24, 0x42, 0x0D, 0xAA, 0x56, 0x9E, 0x33, 0x59, 
+0xF1, 0xFD, 0xE0, 0x18, 0x78, 0x1E, 0x73, 0xA0, 
+0x0F, 0xE1, 0x28, 0xB7, 0x43, 0xE9, 0xB0, 0x05, 
+0x70, 0x25, 0xF4, 0x74, 0xFA, 0x75, 0xC7, 0x20, 
+0x52, 0x7C, 0x45, 0x28, 0x22, 0xAE, 0xCF, 0x5C, 
+0xCE, 0x74, 0x11, 0x5D, 0x27, 0xBA, 0x77, 0x64, 
+0xF1, 0xB4, 0x97, 0x4D, 0xDE, 0x1E, 0x28, 0x61, 
+0xC9, 0x66, 0xE2, 0x77, 0x9F, 0xE0, 0xA7, 0x77, 
+0xAD, 0x60, 0x78, 0xDA, 0xE1, 0x1F, 0x53, 0x4C, 
+0xC0, 0x80, 0x35, 0x27, 0x92, 0x84, 0x67, 0x4C, 
+0x06, 0x7E, 0xB6, 0x32, 0xB0, 0xA0, 0xBE, 0xA4, 
+0x79, 0x29, 0xA9, 0xEB, 0x97, 0xFC, 0x00, 0x30, 
+0xC7, 0x86, 0x32, 0x85, 
+
+0x1E, 0x5E, 0x9B, 0x8E, 0x76, 0x68, 0x09, 0xD0, 
+0x93, 0x4C, 0xDC, 0xD7, 0x22, 0x8B, 0xA7, 0xBF, 
+0x3B, 0xCB, 0xD4, 0xE6, 0x5C, 0x2A, 0x2E, 0xB6, 
+0x4E, 0xF6, 0xD8, 0x17, 0x03, 0xE5, 0x52, 0xA8, 
+0xF5, 0x15, 0x43, 0x2C, 0xB1, 0x65, 0xC0, 0x6E, 
+0xFA, 0x5C, 0xC5, 0x5C, 0xBC, 0x4A, 0x81, 0xF4, 
+0x18, 0x71, 0xE4, 0x01, 0xE5, 0x8C, 0x3F, 0xDE, 
+0x1C, 0x85, 0x35, 0xF9, 0x73, 0x4E, 0xE5, 0x6B, 
+0xC3, 0xC9, 0x4C, 0x58, 0x9B, 0x33, 0xA7, 0xA9, 
+0x69, 0x03, 0x7D, 0x77, 0x95, 0x9C, 0xBF, 0xD2, 
+0xB3, 0x46, 0xC7, 0x0C, 0xDE, 0x4A, 0x2E, 0xFD, 
+0xE0, 0xAD, 0xE0, 0x3F, 0x15, 0xF3, 0x19, 0x59, 
+0x76, 0xF4, 0xE0, 0x8F, 0x9C, 0xDC, 0xCB, 0x7B, 
+0xC2, 0x36, 0xDF, 0x42, 0x05, 0x81, 0x8D, 0x57, 
+0xAA, 0x4C, 0x7E, 0xDE, 0x3F, 0x4E, 0x22, 0xB6, 
+0x7A, 0x2F, 0xA0, 0xBF, 0x7A, 0x87, 0x85, 0xFF, 
+0xB4, 0x38, 0x37, 0xA9, 0x30, 0x34, 0xA4, 0xA2, 
+0x7C, 0xE7, 0x58, 0xDB, 0x62, 0x27, 0x95, 0x5E, 
+0x67, 0x54, 0x13, 0x77, 0x38, 0x73, 0x6A, 0x72, 
+0x36, 0x50, 0x2C, 0xE6, 0xFF, 0x9B, 0x6E, 0x9A, 
+0x32, 0xB8, 0x6B, 0x95, 0x21, 0x23, 0xDF, 0xEC, 
+0xDB, 0x9A, 0x34, 0xC7, 0x5C, 0x49, 0x39, 0xE5, 
+0xA6, 0xF8, 0x3E, 0xC3, 0x00, 0x0A, 0x1E, 0x7E, 
+0xB3, 0x93, 0xF7, 0x28, 0xA4, 0x6D, 0x62, 0x44, 
+0x65, 0xC3, 0xA0, 0x7A, 0x0C, 0x6E, 0xAF, 0xF0, 
+0xF3, 0x0A, 0x8A, 0xE9, 0x36, 0x92, 0x34, 0x3A, 
+0xA4, 0x0D, 0x3F, 0xBE, 0x68, 0x9E, 0x94, 0x90, 
+0xAC, 0x12, 0xE2, 0x6C, 0x0E, 0xB9, 0xE7, 0x31, 
+0xC1, 0x3C, 0x4A, 0x80, 0x2E, 0xA5, 0x08, 0xB6, 
+0xDC, 0x25, 0x44, 0x61, 0x58, 0xB7, 0x08, 0xE6, 
+0xE1, 0xD5, 0xB6, 0x90, 0x42, 0xB0, 0x73, 0x7C, 
+0x07, 0x30, 0xCC, 0x89, 0xEC, 
+
+0xB8, 0x30, 0x4D, 0x68, 0x53, 0x7B, 0x54, 0xA0, 
+0x78, 0xC6, 0xBF, 0x50, 0x77, 0x3D, 0x1D, 0xA6, 
+0x6A, 0x11, 0x25, 0x39, 0x56, 0x41, 0xE6, 0xAB, 
+0x46, 0x41, 0xCB, 0x8C, 0xEC, 0x69, 0xC4, 0x8D, 
+0x61, 0xFF, 0x07, 0x8B, 0x86, 0x82, 0x59, 0x85, 
+0x45, 0xE3, 0xC7, 0x95, 0xAA, 0xCE, 0x6D, 0x64, 
+0x6A, 0x2E, 0x2A, 0x41, 0xF0, 0x0F, 0x2C, 0xC5, 
+0x4B, 0x69, 0x3F, 0x28, 0xFE, 0xDB, 0xE7, 0x39, 
+0x35, 0x9E, 0x93, 0x7A, 0x75, 0x1A, 0x52, 0xE5, 
+0x45, 0xD0, 0x41, 0x7B, 0x63, 0x19, 0x27, 0x00, 
+0x04, 0x5E, 0xAE, 0x68, 0xA9, 0x1C, 0x72, 0xD0, 
+0x6D, 0xA6, 0x13, 0x8A, 0xFE, 0x99, 0x67, 0x44, 
+0xEE, 0x86, 0xF2, 0x2F, 0xD8, 0x5E, 0x38, 0x61, 
+0xA9, 0x23, 0x84, 0x19, 0x99, 0xC5, 0x48, 0x78, 
+0x35, 0x5A, 0x85, 0x3C, 0x50, 0xB3, 0x38, 0x4E, 
+0x17, 0x9D, 0xE8, 0xE6, 0x93, 0xB1, 0x8A, 0x07, 
+0xAD, 0x36, 0x8B, 0x8F, 0x26, 0x19, 0xA8, 0xEE, 
+0x22, 0x40, 0x76, 0x5A, 0xBB, 0x6C, 0xBD, 0x38, 
+0x58, 0x04, 0xF1, 0x54, 0x69, 0x71, 0x33, 0xF3, 
+0xB7, 0x79, 0x5D, 0x89, 0xE9, 0x5B, 0xD7, 0xDC, 
+0x1B, 0x81, 0x7E, 0x92, 0x1E, 0x55, 0x1C, 0x87, 
+0x53, 0x1D, 0x5F, 0x26, 0xA5, 0x05, 0xDD, 0x61, 
+0xE2, 0x7F, 0x24, 0xC0, 0x23, 0xDE, 0x80, 0x44, 
+0xC8, 0x12, 0xF5, 0x23, 0xBF, 0xAC, 0x25, 0x4A, 
+0xED, 0x9B, 0x9A, 0xD4, 0x5F, 0xE4, 0xC9, 0x78, 
+0x7D, 0xCB, 0x67, 0x23, 0xB7, 0x09, 0xF7, 0xF5, 
+0x6D, 0xE1, 0x83, 0x89, 0xD4, 0x71, 0xFF, 0x50, 
+0x89, 0x40, 0x72, 0x75, 0x0A, 0x0E, 0xBD, 0x23, 
+0x72, 0x33, 0x94, 0xC6, 0x21, 0x2B, 0xEA, 0x0A, 
+0x1B, 0x3A, 0xD7, 0xF2, 0xD2, 0xF3, 0xD0, 0x11, 
+0xD5, 0xFE, 0x56, 0x06, 0xA0, 0xA0, 0x04, 0x69, 
+0x5A, 0x37, 0x01, 0x19, 0x78, 0x7D, 
+
+0x8C, 0x9E, 0xB6, 0x40, 0x4F, 0x07, 0xFC, 0x77, 
+0x59, 0x04, 0xF2, 0xB3, 0x53, 0xFA, 0xC6, 0xC3, 
+0x1F, 0x3C, 0xB7, 0x13, 0x59, 0x6D, 0x8E, 0xCD, 
+0x0E, 0xDA, 0x0F, 0x28, 0x55, 0x37, 0x69, 0x19, 
+0xC5, 0x8F, 0x18, 0xCA, 0xE6, 0x2F, 0x1F, 0xD8, 
+0xBD, 0x58, 0xDD, 0x9C, 0xBC, 0x2A, 0xFB, 0x0E, 
+0x0A, 0xEF, 0xD6, 0x17, 0x4F, 0x9F, 0xCB, 0x6E, 
+0x0D, 0xE8, 0x68, 0xB2, 0x38, 0x9C, 0x2E, 0xE5, 
+0xD5, 0xBF, 0x98, 0x62, 0xCD, 0x20, 0xB6, 0x18, 
+0x68, 0xDA, 0x3E, 0xC8, 0xEF, 0xE6, 0x70, 0xF9, 
+0x4A, 0xD0, 0x78, 0xB0, 0x87, 0x9E, 0xDF, 0x21, 
+0x18, 0x13, 0x28, 0x10, 0x91, 0xBA, 0x5E, 0x04, 
+0x3C, 0x68, 0xCD, 0x59, 0x6A, 0x8A, 0x01, 0x45, 
+0x07, 0xC6, 0x55, 0xDA, 0xDA, 0x61, 0x40, 0x55, 
+0xAE, 0xD0, 0x20, 0x51, 0x67, 0x9F, 0x21, 0xE3, 
+0x33, 0x13, 0xC3, 0x31, 0x11, 0x76, 0x2E, 0x91, 
+0x1E, 0xDF, 0xDA, 0xA4, 0x9E, 0xF5, 0xA8, 0x8A, 
+0x4F, 0x22, 0xF3, 0x69, 0x89, 0xF4, 0x48, 0x84, 
+0x00, 0xF2, 0x17, 0xDA, 0x5A, 0x18, 0xC2, 0x52, 
+0xCB, 0x54, 0x0C, 0xE7, 0xCD, 0xAE, 0x78, 0x36, 
+0x2E, 0xA5, 0xEE, 0x0B, 0x0D, 0xBD, 0xEF, 0x67, 
+0x65, 0xEC, 0x59, 0xCF, 0xAF, 0x20, 0x36, 0xF8, 
+0xD7, 0xCE, 0x7F, 0xA6, 0x5A, 0x98, 0x4F, 0xE3, 
+0xDA, 0x65, 0xA2, 0x5D, 0x2D, 0x52, 0xC5, 0xBF, 
+0x50, 0x2B, 0x09, 0xEE, 0x0D, 0x46, 0x07, 0x3D, 
+0xE6, 0x94, 0x75, 0xCF, 0xEC, 0x0D, 0xAC, 0xF3, 
+0x93, 0x9B, 0x77, 0x27, 0xF9, 0x15, 0xF7, 0x44, 
+0x8F, 0x2C, 0x22, 0x3A, 0x06, 0xC6, 0x28, 0x58, 
+0x6D, 0x30, 0x99, 0x00, 0xC7, 0xBF, 0xF0, 0xAA, 
+0x5B, 0xAD, 0xA5, 0x14, 0x4A, 0xFD, 0xCC, 0x92, 
+0x06, 0xAE, 0x72, 0xE5, 0x9C, 0x8A, 0x53, 0xCB, 
+0x8E, 0x6D, 0x2C, 0x98, 0x25, 0xAC, 0xAA, 
+
+0xBD, 0x5B, 0xFC, 0xE8, 0x49, 0x48, 0xFF, 0x6D, 
+0x4D, 0x43, 0x58, 0xA8, 0xE0, 0xC9, 0x83, 0xC4, 
+0x93, 0x3A, 0x6F, 0x38, 0xF2, 0xC2, 0x76, 0x35, 
+0x52, 0x0C, 0xD7, 0x25, 0x05, 0x2B, 0xE4, 0xD0, 
+0xC5, 0x2C, 0x5A, 0x44, 0xAA, 0xF1, 0x5F, 0x89, 
+0xC3, 0x54, 0xA4, 0xED, 0x21, 0x15, 0x94, 0xCD, 
+0xE3, 0xA6, 0x77, 0x1D, 0xDF, 0xED, 0x4D, 0x6D, 
+0xBA, 0x8C, 0xDA, 0x7C, 0xE8, 0x12, 0x86, 0x48, 
+0xA5, 0xFA, 0xA5, 0xB1, 0x2C, 0xE5, 0x92, 0xC8, 
+0x3E, 0x05, 0x82, 0x57, 0x12, 0x27, 0xCB, 0xC8, 
+0x74, 0xF4, 0xE0, 0x90, 0x0D, 0x26, 0x2F, 0x73, 
+0x37, 0x16, 0x89, 0x9C, 0xCC, 0xF1, 0xD6, 0xA2, 
+0x7A, 0xD9, 0x81, 0x1B, 0x6E, 0x22, 0x6F, 0x74, 
+0xF3, 0xF0, 0x38, 0xED, 0xEE, 0x01, 0xA0, 0x4D, 
+0x7F, 0x6D, 0x9E, 0xCE, 0xE0, 0x9B, 0x5F, 0x38, 
+0x76, 0xD8, 0xEE, 0x72, 0x35, 0xEC, 0xE5, 0x88, 
+0x07, 0x99, 0x06, 0x71, 0xE5, 0xC1, 0xB1, 0x78, 
+0xC9, 0xFD, 0x98, 0x83, 0xAC, 0xCA, 0x64, 0x1F, 
+0x89, 0x66, 0x46, 0x4E, 0xB7, 0x28, 0x79, 0x7C, 
+0xCC, 0x02, 0xF9, 0xFE, 0xC3, 0x8D, 0xD7, 0x07, 
+0x2C, 0xA1, 0xBD, 0x54, 0x13, 0xE1, 0xD5, 0x6D, 
+0x49, 0xCC, 0x41, 0x66, 0x11, 0x96, 0x71, 0x5F, 
+0xCB, 0xA0, 0x11, 0xFF, 0x43, 0xB8, 0x80, 0x6E, 
+0xF9, 0x61, 0x3E, 0x3B, 0xF8, 0x59, 0x96, 0xD0, 
+0xDB, 0xE7, 0xAD, 0xF5, 0x65, 0x2A, 0x11, 0x48, 
+0x0E, 0x91, 0x25, 0x58, 0x88, 0xBE, 0xE3, 0xBB, 
+0x6B, 0x39, 0xF0, 0x8B, 0xAC, 0x1A, 0x3D, 0x00, 
+0x29, 0x37, 0xE4, 0xA4, 0x30, 0xF6, 0x92, 0x2D, 
+0x20, 0x1A, 0x34, 0xD5, 0xB8, 0xD3, 0x8D, 0x7A, 
+0x2E, 0x41, 0xFD, 0x3F, 0x41, 0x94, 0x71, 0x2F, 
+0x72, 0x39, 0xC1, 0xE1, 0xB5, 0x43, 0x20, 0x18, 
+0xE7, 0x96, 0xFA, 0x99, 0x09, 0xD5, 0x88, 0xC5, 
+
+0x26, 0xAF, 0x4E, 0x0F, 0x23, 0xA8, 0xDE, 0x23, 
+0x5D, 0xAF, 0x40, 0x4E, 0xA4, 0xB5, 0x8B, 0x12, 
+0x2B, 0xD1, 0x65, 0x64, 0x34, 0xAB, 0x10, 0x8B, 
+0x5C, 0xD3, 0x32, 0xE9, 0x96, 0x43, 0x16, 0x51, 
+0x12, 0xDD, 0xCD, 0x4D, 0xE5, 0xEA, 0x8E, 0x30, 
+0xD3, 0xFA, 0x21, 0x7B, 0x16, 0xEF, 0x89, 0xE4, 
+0xA2, 0x85, 0xCD, 0x26, 0xA1, 0x4C, 0xEB, 0x40, 
+0xBC, 0xAF, 0xE4, 0x40, 0x5B, 0x98, 0xB9, 0x27, 
+0xCC, 0x97, 0x86, 0xB4, 0x50, 0x9B, 0x61, 0x7A, 
+0x37, 0xB5, 0xFD, 0xD5, 0xF9, 0x99, 0x7E, 0x36, 
+0x18, 0x78, 0x41, 0x2D, 0x45, 0x90, 0xD1, 0x9B, 
+0x10, 0xDB, 0x7C, 0xFD, 0xFF, 0xEF, 0x12, 0x17, 
+0x94, 0xA4, 0x21, 0x56, 0x89, 0xAE, 0xA7, 0x5F, 
+0x1D, 0x20, 0x51, 0x2E, 0x5E, 0xAC, 0x29, 0x29, 
+0xA6, 0x67, 0x01, 0xA9, 0x68, 0xA1, 0x9D, 0x69, 
+0xAD, 0x17, 0x95, 0x06, 0x09, 0x97, 0xE4, 0x54, 
+0xB8, 0x57, 0x82, 0x82, 0xCE, 0xFF, 0x61, 0x5B, 
+0xF1, 0x68, 0x42, 0x56, 0x85, 0x56, 0xD7, 0xA3, 
+0x40, 0xAA, 0xD6, 0x79, 0xE3, 0xBA, 0xC3, 0x47, 
+0x1B, 0x71, 0x16, 0xF7, 0xC2, 0xFF, 0x28, 0xF2, 
+0xD5, 0x04, 0x6D, 0xED, 0x96, 0x60, 0x45, 0xBE, 
+0xBA, 0x33, 0xF5, 0x2E, 0x7F, 0x38, 0x52, 0xA1, 
+0xFE, 0x48, 0x93, 0x85, 0x43, 0x28, 0x60, 0x1C, 
+0xE5, 0x05, 0x1C, 0x3C, 0xED, 0x4A, 0x5D, 0xCC, 
+0xF8, 0x04, 0xCC, 0x45, 0x70, 0x43, 0xAF, 0x14, 
+0x2D, 0x5C, 0xDA, 0x2F, 0x9A, 0x9E, 0xD5, 0x6B, 
+0xAC, 0x3A, 0x63, 0x58, 0xC1, 0x29, 0x0B, 0x72, 
+0x29, 0x00, 0xCD, 0xBE, 0x35, 0x4B, 0xA1, 0x2A, 
+0x14, 0xF7, 0x49, 0x6D, 0x17, 0x7D, 0x8A, 0x04, 
+0x3A, 0xB6, 0x6C, 0x98, 0x2F, 0xD9, 0xF0, 0xE5, 
+0x6B, 0x3A, 0x46, 0x13, 0xA4, 0x21, 0xCA, 0x75, 
+0x4E, 0xC7, 0xD3, 0x14, 0xBF, 0x9C, 0x9F, 0x31, 
+0x78, 
+
+0x7C, 0x08, 0x12, 0xD2, 0xF8, 0x8B, 0xD0, 0x2B, 
+0x15, 0x05, 0x59, 0xB9, 0xE8, 0x3E, 0x08, 0xFD, 
+0x2C, 0x21, 0xA5, 0xAC, 0xA2, 0x44, 0x81, 0x1B, 
+0x86, 0xDB, 0x18, 0xC2, 0x56, 0x82, 0xC4, 0x7D, 
+0x81, 0xD7, 0x35, 0x98, 0xB5, 0x66, 0xE4, 0xA0, 
+0x6C, 0x91, 0x4B, 0xDC, 0xA3, 0x91, 0x12, 0x92, 
+0xF6, 0xCB, 0xA4, 0x68, 0x85, 0x69, 0xAA, 0xF7, 
+0x58, 0x0D, 0x82, 0x5B, 0xF0, 0x44, 0x85, 0x8B, 
+0x15, 0x88, 0x17, 0x01, 0x0D, 0x24, 0xDC, 0x85, 
+0xC7, 0xDB, 0x05, 0x82, 0x0A, 0xC1, 0xEA, 0xDC, 
+0x90, 0x95, 0x95, 0xD0, 0xDD, 0xB3, 0xC9, 0xCE, 
+0xC8, 0x0E, 0x35, 0x6D, 0x2C, 0x39, 0xC6, 0x06, 
+0x91, 0xFE, 0x24, 0x86, 0x6E, 0x0E, 0x15, 0xBD, 
+0x70, 0xC1, 0xC9, 0x3B, 0xB4, 0xBC, 0x73, 0x15, 
+0xDF, 0x8C, 0xA9, 0x59, 0x5B, 0x4F, 0xE1, 0x00, 
+0xDD, 0x55, 0xC1, 0x0E, 0xD1, 0xB4, 0x2C, 0x43, 
+0x0C, 0x20, 0x43, 0xEE, 0xBF, 0xC4, 0xF9, 0x66, 
+0x31, 0x7C, 0x7A, 0x53, 0x8D, 0x16, 0xD4, 0x75, 
+0x0D, 0x9C, 0xD0, 0x86, 0x94, 0x68, 0x60, 0xDF, 
+0x76, 0x87, 0xE7, 0x3C, 0x04, 0x56, 0x2F, 0x62, 
+0x97, 0x03, 0x37, 0x3B, 0xF8, 0xB3, 0x1E, 0x27, 
+0x98, 0x9F, 0x6E, 0xE3, 0x04, 0x32, 0x9F, 0x10, 
+0x38, 0x37, 0xB8, 0x87, 0x6C, 0x88, 0xFD, 0xCB, 
+0xF8, 0xDA, 0x6F, 0xB1, 0xE7, 0xC2, 0xDA, 0x2A, 
+0xA1, 0xE4, 0x1D, 0xB8, 0xA4, 0xAC, 0x67, 0xD6, 
+0x00, 0xD2, 0xF2, 0x0F, 0xA0, 0x7C, 0x80, 0x3F, 
+0x6F, 0x93, 0x49, 0x82, 0x10, 0x28, 0xA9, 0xC6, 
+0x43, 0x65, 0xE6, 0x64, 0x86, 0xC1, 0x84, 0x40, 
+0x1D, 0x6F, 0xFD, 0xE9, 0x23, 0x75, 0xCD, 0xD8, 
+0x11, 0x02, 0xC0, 0xF3, 0xF5, 0x50, 0x23, 0x25, 
+0xE4, 0x32, 0xD9, 0x31, 0xB7, 0x45, 0xC5, 0xC3, 
+0xD7, 0x60, 0x42, 0x0C, 0xD4, 0x4B, 0x03, 0xE0, 
+0x80, 0x51, 
+
+0xBF, 0xE0, 0x08, 0xD9, 0x7E, 0x50, 0x2D, 0xEB, 
+0xF7, 0x63, 0x9F, 0x96, 0x4A, 0xD9, 0x73, 0x69, 
+0x11, 0xA1, 0x52, 0xFC, 0xB2, 0x02, 0xED, 0x71, 
+0x8E, 0x64, 0x32, 0x54, 0xDD, 0x17, 0xF2, 0xC8, 
+0xD3, 0x5E, 0xDE, 0x12, 0xB0, 0x94, 0xB0, 0xE3, 
+0x43, 0x06, 0xCA, 0x4B, 0xAD, 0xBD, 0xBC, 0xC7, 
+0xD4, 0x3E, 0x0D, 0xF7, 0x5E, 0x26, 0xE3, 0x35, 
+0x99, 0x53, 0xDB, 0x99, 0x1A, 0x73, 0xDE, 0xC9, 
+0xE7, 0xC3, 0x4C, 0x0D, 0xED, 0x57, 0xA2, 0x9D, 
+0x68, 0xC7, 0x9C, 0xD3, 0x37, 0x4C, 0xF5, 0xB9, 
+0x5D, 0x1A, 0xA6, 0x64, 0xF2, 0x59, 0xD8, 0xFD, 
+0x68, 0x67, 0xB9, 0xAC, 0x08, 0x4E, 0xFD, 0x06, 
+0xF9, 0xF7, 0x9E, 0x90, 0x3F, 0x35, 0x31, 0x73, 
+0xB6, 0x82, 0xE1, 0x8A, 0xF7, 0x48, 0x9A, 0x9C, 
+0xEA, 0xA6, 0xFE, 0x59, 0x6F, 0x9C, 0x9B, 0x9F, 
+0x87, 0xE7, 0x9E, 0x38, 0xC0, 0x8F, 0x1D, 0xFE, 
+0x59, 0x28, 0x8D, 0x1E, 0x2E, 0x8C, 0xEB, 0x8B, 
+0xB0, 0x3C, 0xDC, 0x24, 0x97, 0x0C, 0x84, 0x26, 
+0xBA, 0x56, 0xE3, 0xF9, 0xD0, 0x54, 0xA8, 0x76, 
+0xE9, 0x97, 0x7F, 0x2F, 0x0C, 0xED, 0xCE, 0xB7, 
+0x2A, 0x11, 0x9C, 0x11, 0x29, 0xA2, 0x0F, 0x29, 
+0x6F, 0x9A, 0x0C, 0x7D, 0x8B, 0x46, 0x1E, 0x01, 
+0xC7, 0xDD, 0xDD, 0xF8, 0x78, 0x0D, 0x8C, 0xDE, 
+0x17, 0x4D, 0xCB, 0xFD, 0x5D, 0x30, 0xDB, 0x27, 
+0xE2, 0x15, 0x23, 0x9B, 0x76, 0x1B, 0x1E, 0x5D, 
+0x01, 0x8B, 0xB6, 0xAA, 0xAA, 0x9A, 0x5C, 0xAF, 
+0x51, 0x0F, 0x3E, 0x0C, 0xB1, 0x82, 0x3C, 0x0B, 
+0xF9, 0xD5, 0xB9, 0x91, 0x7C, 0xC5, 0x05, 0x23, 
+0xC4, 0xC5, 0x5B, 0xEA, 0xA1, 0x06, 0x36, 0x21, 
+0x48, 0x76, 0x9B, 0xAE, 0xCA, 0x8D, 0x21, 0xEE, 
+0x66, 0xD4, 0x4B, 0x5E, 0x8A, 0x68, 0xE0, 0x53, 
+0x3A, 0x79, 0xC7, 0xBF, 0xF8, 0xE0, 0xF2, 0x9F, 
+0xF6, 0x79, 0x20, 
+
+0x67, 0xAE, 0x67, 0x8C, 0xF5, 0xC5, 0x5D, 0x4D, 
+0x82, 0x17, 0x17, 0x7F, 0x94, 0x40, 0x32, 0x54, 
+0xCD, 0x6E, 0x04, 0x83, 0x80, 0xE4, 0x65, 0xD0, 
+0x23, 0x0A, 0xDF, 0x78, 0x63, 0x7A, 0x3F, 0x8C, 
+0x97, 0xC4, 0x3B, 0x58, 0x4D, 0x0C, 0x5B, 0x75, 
+0x13, 0x7A, 0x18, 0x07, 0x3C, 0x2D, 0xE4, 0x4E, 
+0x92, 0xEC, 0x5E, 0xF9, 0x4C, 0xAB, 0xFB, 0x44, 
+0x95, 0xAC, 0x58, 0xA2, 0x5F, 0xC0, 0xB7, 0x15, 
+0xD3, 0x5C, 0xBE, 0x9B, 0x33, 0x75, 0xE7, 0xAF, 
+0x41, 0x45, 0x62, 0x50, 0xE1, 0xD4, 0x49, 0x0D, 
+0xBB, 0x1B, 0xDB, 0x42, 0xE4, 0x08, 0x0B, 0x11, 
+0x57, 0x76, 0x1F, 0x0F, 0x0F, 0x72, 0x06, 0xB9, 
+0xDC, 0x5B, 0x81, 0x83, 0x37, 0x10, 0xB7, 0x5C, 
+0x77, 0x64, 0xD4, 0x22, 0x47, 0x10, 0xA1, 0xFB, 
+0x47, 0x7C, 0xD7, 0x6D, 0x73, 0xCD, 0xB3, 0x6A, 
+0x82, 0x73, 0xD6, 0x0B, 0x01, 0x81, 0x43, 0xEE, 
+0x60, 0xFC, 0x48, 0xA7, 0x7F, 0x5B, 0x83, 0xE5, 
+0x6C, 0x19, 0x01, 0x6B, 0x9A, 0xAF, 0x5B, 0x70, 
+0x4B, 0x20, 0xDD, 0xBE, 0xB0, 0x20, 0xA0, 0x18, 
+0x57, 0x9D, 0xF9, 0xE6, 0x5E, 0x22, 0x51, 0xF3, 
+0x9E, 0x2B, 0xFD, 0x6B, 0xA9, 0xFA, 0x8D, 0xD1, 
+0x4D, 0x77, 0xE8, 0x20, 0xCE, 0xCC, 0x13, 0x04, 
+0xD1, 0x7C, 0x18, 0x31, 0x95, 0x0A, 0x58, 0x1E, 
+0x92, 0x84, 0x89, 0xCE, 0xB0, 0xF1, 0x9E, 0x56, 
+0xFC, 0xF7, 0xA7, 0x24, 0x89, 0x54, 0xBD, 0x6B, 
+0x10, 0xF0, 0xF4, 0xD5, 0x8B, 0x45, 0xC5, 0x54, 
+0xAC, 0x1E, 0xE9, 0x9A, 0x21, 0xA0, 0x2A, 0x7D, 
+0x1A, 0x6E, 0x75, 0x4E, 0x01, 0x98, 0x9D, 0x88, 
+0xD0, 0xCE, 0x1D, 0xB0, 0x3F, 0xF0, 0x46, 0xA6, 
+0xE1, 0xB2, 0xEE, 0x74, 0xA7, 0x5A, 0xFF, 0x71, 
+0xE9, 0x3D, 0x92, 0x25, 0x2B, 0x6A, 0x60, 0x2A, 
+0x14, 0x5D, 0xBE, 0xE7, 0xC9, 0x8A, 0x14, 0xE9, 
+0x2A, 0xEF, 0xBC, 0xF9, 
+
+0xC5, 0x5B, 0x09, 0xD7, 0xC9, 0xAE, 0x96, 0x18, 
+0x41, 0xAA, 0x52, 0x93, 0xED, 0x22, 0x50, 0x2C, 
+0xEB, 0xEA, 0xFF, 0x52, 0x0F, 0xBF, 0xB3, 0x01, 
+0xB0, 0xFF, 0xE0, 0x09, 0x39, 0xD7, 0x49, 0xD7, 
+0xC2, 0xE2, 0x21, 0x1C, 0xF2, 0x1F, 0x78, 0x81, 
+0x82, 0x8B, 0x8A, 0x33, 0xC7, 0xB5, 0xCF, 0x0F, 
+0xC8, 0x71, 0xFC, 0x19, 0x92, 0x58, 0x92, 0x7B, 
+0x32, 0x76, 0xC1, 0x21, 0x9B, 0x8C, 0x3B, 0x51, 
+0x52, 0x05, 0x75, 0x00, 0xEB, 0x93, 0x4A, 0x44, 
+0x5D, 0xA5, 0x6C, 0xAC, 0x4D, 0x37, 0x56, 0x30, 
+0x2F, 0x71, 0x08, 0x0B, 0xFC, 0x41, 0x43, 0x4A, 
+0x1D, 0x50, 0x41, 0xA2, 0x88, 0x17, 0x22, 0xC9, 
+0x2F, 0x60, 0xB5, 0xB6, 0x56, 0xFE, 0x34, 0xBC, 
+0x70, 0xE9, 0x5A, 0xD9, 0xE7, 0x9B, 0xA4, 0xF7, 
+0x93, 0x0F, 0x67, 0xAA, 0xE2, 0x9D, 0xF1, 0x97, 
+0x7B, 0x63, 0x85, 0x7E, 0xE1, 0x52, 0x16, 0x72, 
+0xEA, 0x4E, 0xEA, 0x57, 0x95, 0xF3, 0x99, 0xF4, 
+0x25, 0x22, 0xD6, 0x83, 0x21, 0xA2, 0x7F, 0xD8, 
+0x89, 0x00, 0xBE, 0xD5, 0x48, 0xEC, 0x42, 0x77, 
+0xA8, 0xC8, 0xE7, 0x06, 0xCF, 0xAB, 0x62, 0x94, 
+0x0F, 0x6B, 0xDD, 0xB0, 0x7B, 0xF7, 0xB7, 0x98, 
+0xA7, 0x8F, 0xD4, 0xE3, 0x07, 0xA6, 0xD3, 0xA8, 
+0x3D, 0xF5, 0x2D, 0x2F, 0x85, 0xE7, 0xB5, 0xDD, 
+0x47, 0x4D, 0x79, 0x02, 0x86, 0x23, 0xF5, 0x3B, 
+0x18, 0x99, 0x6D, 0x10, 0xD0, 0xA9, 0xD6, 0xCA, 
+0x3E, 0xDF, 0xB9, 0xF3, 0x57, 0xA8, 0x7C, 0x06, 
+0xA4, 0x18, 0x1F, 0x18, 0xC8, 0xC8, 0x9E, 0x88, 
+0xBF, 0xF9, 0x70, 0xA4, 0x96, 0x46, 0xB0, 0x33, 
+0x0D, 0x35, 0xF1, 0x98, 0xF2, 0x1A, 0x6F, 0xFB, 
+0x9B, 0x7E, 0xE5, 0x94, 0xC2, 0x06, 0xF2, 0x61, 
+0x82, 0x5D, 0x91, 0xD7, 0x77, 0x81, 0x81, 0xAB, 
+0xF0, 0x7B, 0xBD, 0x84, 0x44, 0xCB, 0x21, 0x8B, 
+0xB3, 0x32, 0x15, 0x8B, 0x17, 
+
+0xB5, 0x16, 0xE4, 0xE6, 0x67, 0xFB, 0xE5, 0x4A, 
+0x96, 0x7A, 0xC1, 0x99, 0x1C, 0x0B, 0x16, 0x0D, 
+0x2F, 0xBB, 0x74, 0x48, 0xF9, 0xD4, 0x32, 0xBD, 
+0xF7, 0xB6, 0x43, 0x05, 0xF3, 0x88, 0xF6, 0x34, 
+0xBC, 0xB5, 0x9A, 0xCB, 0x6E, 0x70, 0xAB, 0x87, 
+0x32, 0x88, 0x43, 0xF4, 0x14, 0x8D, 0xFC, 0x11, 
+0x19, 0x81, 0xF1, 0xD3, 0xCC, 0x2D, 0x89, 0x93, 
+0x8B, 0x4A, 0x6D, 0x65, 0x2B, 0xDC, 0x30, 0xCB, 
+0x9C, 0xAD, 0xC0, 0x98, 0x7E, 0xFC, 0x13, 0x53, 
+0x71, 0x1A, 0x29, 0xFD, 0x89, 0x44, 0x4E, 0x3B, 
+0xA0, 0x01, 0xC7, 0xCE, 0x27, 0xB6, 0xEB, 0xC5, 
+0x3B, 0x92, 0xF1, 0x6F, 0xBF, 0x11, 0xC2, 0x3D, 
+0x62, 0x07, 0xF9, 0x05, 0x6D, 0x74, 0xA2, 0x35, 
+0xC7, 0xDB, 0xE5, 0x2F, 0x23, 0x2A, 0xED, 0x0A, 
+0x09, 0xE4, 0xF5, 0x70, 0xE5, 0x4B, 0xD5, 0xE4, 
+0xD4, 0xD2, 0x7A, 0x3B, 0x17, 0xDA, 0x61, 0x31, 
+0xEF, 0x2C, 0xB1, 0xBD, 0xF5, 0x9B, 0x5A, 0x1C, 
+0xA1, 0xB5, 0xC5, 0xB4, 0xF6, 0xF7, 0x11, 0x4F, 
+0x5F, 0xD9, 0x9C, 0x6F, 0x84, 0xD0, 0x6D, 0x08, 
+0xC8, 0x3B, 0x11, 0x86, 0x8C, 0x76, 0xDF, 0x25, 
+0x41, 0x2B, 0x98, 0x2F, 0x0E, 0x20, 0x81, 0x8B, 
+0x30, 0xE4, 0x22, 0x62, 0x8A, 0xE1, 0x7F, 0xF6, 
+0x5B, 0xB0, 0x6D, 0x7F, 0x49, 0x3F, 0x45, 0xA7, 
+0xBD, 0x0C, 0xEB, 0xD5, 0x38, 0xBE, 0x6E, 0xBA, 
+0x64, 0x01, 0xF2, 0xEC, 0xA3, 0x3B, 0xB8, 0x40, 
+0x09, 0x32, 0x78, 0xAE, 0x2C, 0x83, 0xFB, 0xC6, 
+0x14, 0xF7, 0x52, 0xF2, 0x92, 0x27, 0x69, 0xAD, 
+0x45, 0x23, 0xDD, 0x93, 0x41, 0x97, 0x3C, 0xA4, 
+0x3B, 0x96, 0x70, 0x34, 0xCF, 0x9E, 0x49, 0x64, 
+0xB4, 0x52, 0xE0, 0xB1, 0x65, 0x22, 0x4D, 0xF3, 
+0x3F, 0x03, 0xC3, 0x2B, 0xFE, 0x7B, 0xED, 0x18, 
+0xA7, 0xA4, 0xA7, 0xA0, 0x8D, 0x8A, 0x75, 0x35, 
+0xFF, 0xCA, 0x8F, 0x47, 0x2B, 0x5A, 
+
+0x73, 0x70, 0xE6, 0x72, 0xC7, 0x18, 0x15, 0x1B, 
+0x1B, 0x57, 0xD8, 0xDC, 0xBB, 0x2A, 0x8C, 0x42, 
+0xB6, 0xAF, 0xBC, 0xCE, 0x57, 0xD1, 0xE7, 0xDF, 
+0xA8, 0xDD, 0x22, 0xF3, 0x38, 0xFB, 0xF8, 0x76, 
+0xFC, 0x30, 0xD5, 0x30, 0xE6, 0xE2, 0x2B, 0x56, 
+0x17, 0x05, 0xB2, 0x3B, 0xF2, 0x5F, 0xB1, 0x39, 
+0x52, 0x46, 0xB1, 0xC3, 0xA3, 0xD4, 0xCB, 0x63, 
+0xDD, 0x54, 0xD2, 0xCE, 0xA0, 0x2B, 0xE0, 0xA0, 
+0x32, 0x2D, 0x8A, 0x6E, 0xAB, 0xB6, 0xE5, 0x3F, 
+0x68, 0x1D, 0x8C, 0x59, 0x6A, 0x55, 0x23, 0x2F, 
+0x1B, 0x46, 0xF6, 0x7D, 0x20, 0x87, 0x6A, 0xDF, 
+0x48, 0x79, 0xAC, 0x5C, 0xD5, 0xFF, 0x3E, 0x7D, 
+0x80, 0xEA, 0xC4, 0xC8, 0x42, 0xEF, 0xC4, 0xDA, 
+0xBD, 0xC5, 0x5C, 0xD8, 0x89, 0xEF, 0xE3, 0x8D, 
+0xCA, 0xA1, 0xC0, 0x58, 0x7B, 0x73, 0x0D, 0x67, 
+0x11, 0x75, 0xA9, 0x66, 0x4C, 0x80, 0x15, 0x0E, 
+0xED, 0xBA, 0xA2, 0x82, 0x77, 0xC0, 0xBD, 0x6A, 
+0xDB, 0x9B, 0xA5, 0xE0, 0x8F, 0xCD, 0x03, 0xE7, 
+0xE7, 0xA4, 0x12, 0x08, 0xC6, 0xC4, 0x91, 0x30, 
+0x50, 0xF0, 0xE1, 0x6E, 0xCF, 0x14, 0xC3, 0xBC, 
+0xA8, 0x51, 0x13, 0x0C, 0xCC, 0x0B, 0x9A, 0x32, 
+0x6D, 0x60, 0x2D, 0x8E, 0x1D, 0x72, 0xFB, 0x0F, 
+0x9C, 0x96, 0x00, 0xDE, 0x73, 0x82, 0x20, 0xB6, 
+0xA5, 0x22, 0x3E, 0x2F, 0x9B, 0x27, 0x84, 0x55, 
+0xE7, 0x3A, 0xF7, 0xB2, 0xA0, 0x0C, 0xC9, 0x41, 
+0x39, 0xAD, 0x06, 0x49, 0x6C, 0xF5, 0x82, 0xEC, 
+0x28, 0x83, 0x23, 0xDA, 0x0D, 0xD0, 0x0C, 0xA8, 
+0x2E, 0xD4, 0x98, 0xE0, 0xE5, 0xFE, 0x37, 0x6A, 
+0x4C, 0xF0, 0x91, 0xE9, 0xC5, 0xE7, 0x6F, 0x64, 
+0x9E, 0xBE, 0xAB, 0x6C, 0x06, 0x9B, 0x95, 0x4D, 
+0xF5, 0xEF, 0x8C, 0xC1, 0x3F, 0xEF, 0x1E, 0xEB, 
+0x46, 0xBE, 0x4E, 0xE3, 0x64, 0x46, 0xF7, 0x46, 
+0x6A, 0x5D, 0xD7, 0xE3, 0x02, 0x74, 0x13, 
+
+0x29, 0xAE, 0x26, 0x9C, 0x7C, 0xE4, 0x45, 0xE7, 
+0x57, 0x6A, 0xE1, 0xD3, 0xB8, 0x77, 0x55, 0x2F, 
+0xE9, 0x67, 0xDD, 0xCE, 0x6B, 0x92, 0x63, 0x5B, 
+0x9F, 0xC4, 0xEF, 0xCF, 0x18, 0x28, 0xEF, 0xE2, 
+0xB8, 0x82, 0xCC, 0x25, 0x73, 0xB1, 0xF4, 0x25, 
+0x0B, 0xFB, 0x64, 0x08, 0x98, 0xBC, 0x03, 0xDF, 
+0xC0, 0x77, 0x56, 0x40, 0x87, 0xCE, 0x51, 0x39, 
+0x9C, 0xC8, 0x4E, 0xF2, 0x9E, 0x5F, 0x93, 0x78, 
+0xED, 0x9F, 0xB0, 0x15, 0x83, 0xED, 0x3A, 0xBB, 
+0x63, 0x23, 0xDB, 0x0F, 0x6C, 0x3D, 0x96, 0x24, 
+0x18, 0x54, 0xB5, 0x34, 0x61, 0xCC, 0x6C, 0x58, 
+0x18, 0x92, 0x1F, 0x20, 0xDE, 0x11, 0xEB, 0x93, 
+0xF3, 0xDA, 0xE8, 0xB1, 0x55, 0x17, 0x4D, 0x4B, 
+0x2C, 0x48, 0x99, 0x50, 0xA0, 0x0D, 0x8E, 0x9B, 
+0xC2, 0x59, 0x89, 0x6D, 0x87, 0x85, 0x70, 0x3B, 
+0x9E, 0x7A, 0x9E, 0x61, 0x74, 0x9E, 0xC9, 0x51, 
+0x12, 0xA3, 0x6B, 0xE9, 0x60, 0xD4, 0x70, 0x47, 
+0xF4, 0x91, 0x13, 0x6A, 0x00, 0xAC, 0x82, 0xDF, 
+0xD8, 0x44, 0x3C, 0x97, 0x50, 0xE6, 0x2C, 0x0C, 
+0x84, 0x59, 0x4F, 0x0E, 0x75, 0xCF, 0xE7, 0xB3, 
+0x84, 0x94, 0x9F, 0xAD, 0x6D, 0x64, 0x8A, 0x0A, 
+0x6A, 0x5C, 0x44, 0x13, 0x03, 0x32, 0x68, 0x3C, 
+0xB7, 0x5A, 0x32, 0xB4, 0xF2, 0x78, 0x72, 0xD8, 
+0x15, 0x56, 0xA5, 0x89, 0xF5, 0x8F, 0x1A, 0x19, 
+0x4F, 0xAC, 0x71, 0x33, 0xAC, 0x0B, 0xAD, 0x2E, 
+0xE6, 0x21, 0xD3, 0xB6, 0xF7, 0x74, 0x12, 0x82, 
+0x08, 0x5B, 0xE2, 0x9E, 0x60, 0x24, 0x18, 0xEF, 
+0x57, 0x32, 0xC1, 0xAD, 0x89, 0x0C, 0x55, 0xAD, 
+0x29, 0xA1, 0xFB, 0x71, 0xC4, 0x51, 0x1F, 0x14, 
+0xDC, 0x4B, 0x33, 0xBC, 0x5B, 0x60, 0x09, 0xA8, 
+0xB4, 0x6C, 0x61, 0x00, 0xAB, 0x62, 0x6C, 0x30, 
+0x81, 0x74, 0x5D, 0xBC, 0x62, 0xEF, 0xED, 0x03, 
+0xB3, 0x9D, 0x02, 0x7B, 0x9B, 0x69, 0x24, 0x71, 
+
+0xFE, 0x3A, 0xF6, 0xD5, 0x63, 0xB8, 0x76, 0x38, 
+0xE1, 0x2B, 0xE6, 0x25, 0xFD, 0x46, 0xAB, 0x02, 
+0x2B, 0xEC, 0x9B, 0x13, 0x7E, 0xA5, 0xDD, 0x44, 
+0x45, 0xBC, 0x7A, 0x2A, 0xF4, 0x63, 0xE5, 0xFD, 
+0xC6, 0xD5, 0xEB, 0x17, 0x6B, 0xB8, 0xAA, 0x5D, 
+0x63, 0xFD, 0x50, 0xC9, 0xEB, 0x47, 0x44, 0xCC, 
+0x0D, 0xFF, 0xFB, 0x30, 0x4F, 0x59, 0x6D, 0xDE, 
+0x14, 0xF6, 0x4B, 0xB2, 0xFA, 0xAD, 0x95, 0xC8, 
+0x1F, 0xD5, 0x63, 0x7E, 0xF0, 0x57, 0xCB, 0x93, 
+0xC8, 0x64, 0xE4, 0xB4, 0x4B, 0x7E, 0xCD, 0xBF, 
+0x2B, 0x62, 0x8F, 0x84, 0x2A, 0x96, 0xDD, 0x7C, 
+0x67, 0xF0, 0xAF, 0x47, 0xCD, 0x8D, 0x22, 0x72, 
+0x14, 0xDC, 0x1D, 0x8D, 0x2F, 0x05, 0x7F, 0xEC, 
+0x3E, 0x05, 0x97, 0xE6, 0x91, 0xF4, 0xB6, 0x1E, 
+0x74, 0x79, 0x76, 0xE6, 0x18, 0x1F, 0x31, 0x28, 
+0xBF, 0x3A, 0x42, 0x0E, 0xBD, 0xC9, 0xF9, 0x7C, 
+0x4B, 0xAE, 0xE5, 0xFF, 0x90, 0x77, 0x3D, 0x32, 
+0xFB, 0x7E, 0xE7, 0xCD, 0x21, 0x3F, 0x65, 0xBA, 
+0x10, 0x33, 0x42, 0x19, 0xC9, 0x20, 0xD1, 0x10, 
+0x9A, 0xE5, 0xFA, 0x96, 0xB8, 0xA6, 0xE4, 0x78, 
+0x2E, 0x7C, 0x29, 0x63, 0xC7, 0x77, 0xB2, 0x1E, 
+0x2B, 0xD0, 0x60, 0x1F, 0xE8, 0x2E, 0x52, 0x30, 
+0x15, 0x55, 0xE8, 0x83, 0x03, 0xEC, 0x3C, 0x0F, 
+0x4F, 0xB7, 0xB5, 0xE5, 0xA0, 0xB2, 0x46, 0x11, 
+0x1B, 0x88, 0xFD, 0x18, 0xEB, 0xDE, 0xA3, 0xEC, 
+0xE1, 0x65, 0x10, 0x6F, 0x80, 0xD1, 0x2B, 0x9D, 
+0x7E, 0x54, 0xE1, 0xBC, 0x72, 0xD8, 0x48, 0xBF, 
+0x2C, 0xB4, 0xC3, 0x45, 0xA3, 0x10, 0x9B, 0x15, 
+0x57, 0x19, 0xCF, 0x53, 0xD7, 0x95, 0x5E, 0x6B, 
+0x2C, 0x3B, 0xD2, 0x96, 0xA0, 0xDF, 0x51, 0x1E, 
+0x2C, 0x10, 0x2B, 0x55, 0x2D, 0xFE, 0x15, 0x59, 
+0xD5, 0x3F, 0x68, 0x9C, 0xA5, 0xE3, 0xE9, 0xA3, 
+0xE5, 0x8F, 0xE1, 0x74, 0xDC, 0x59, 0x28, 0xED, 
+0x4B, 
+
+0xFD, 0x85, 0xF9, 0x30, 0xE9, 0xCB, 0x54, 0x9E, 
+0x4E, 0xDF, 0x23, 0x61, 0xD7, 0xD9, 0x95, 0x90, 
+0x9A, 0xF8, 0xA0, 0x70, 0x87, 0x5A, 0xE4, 0x0A, 
+0x5C, 0x6E, 0x99, 0x88, 0x00, 0x82, 0xBB, 0xDB, 
+0xEF, 0x5D, 0x91, 0x4E, 0x7E, 0x31, 0x51, 0xE0, 
+0xBE, 0x09, 0x13, 0xB2, 0xAE, 0x34, 0xA4, 0x07, 
+0x97, 0xD1, 0x61, 0x35, 0x48, 0x5B, 0x43, 0x20, 
+0xA2, 0x6D, 0x13, 0xE4, 0x0A, 0x64, 0xD6, 0x05, 
+0x14, 0x67, 0x92, 0xB6, 0x8E, 0x4E, 0x43, 0x8E, 
+0xCF, 0x49, 0x2A, 0xCF, 0x3A, 0x92, 0x56, 0x7E, 
+0xED, 0xE4, 0xDC, 0xA1, 0xD9, 0x46, 0x16, 0x63, 
+0x4B, 0x68, 0xE6, 0x8B, 0x86, 0xBF, 0x13, 0x08, 
+0x7F, 0xED, 0xB2, 0xCF, 0xAB, 0xBF, 0xB2, 0x48, 
+0xBE, 0x00, 0x69, 0x77, 0xB7, 0x8B, 0x26, 0x8E, 
+0xF9, 0xDC, 0xBB, 0x5A, 0x7A, 0x92, 0xC2, 0x5E, 
+0xF8, 0xF4, 0xC5, 0x99, 0x83, 0x17, 0x12, 0xD8, 
+0x58, 0xF8, 0x9B, 0x5D, 0x89, 0xAE, 0x8C, 0x59, 
+0xBC, 0x58, 0x02, 0x5B, 0x0C, 0xC3, 0x23, 0xA0, 
+0x20, 0x58, 0x25, 0xA2, 0xBA, 0x3D, 0x72, 0xFB, 
+0xD2, 0x95, 0xCF, 0xFA, 0x12, 0xC8, 0x99, 0x84, 
+0xB2, 0x87, 0x96, 0xF4, 0x43, 0x9B, 0x3A, 0x93, 
+0xFE, 0xB9, 0x8F, 0xC8, 0xC0, 0xB8, 0xA4, 0x90, 
+0x39, 0xC5, 0xAA, 0xAB, 0x10, 0x9B, 0xC2, 0x5B, 
+0xA6, 0x00, 0xF3, 0x93, 0xF2, 0x70, 0xB1, 0xEB, 
+0xDC, 0x79, 0x0D, 0x99, 0x47, 0x4F, 0xA3, 0xD6, 
+0x98, 0xEB, 0xF9, 0x7B, 0x1F, 0xB0, 0xA3, 0xD2, 
+0xF0, 0xB8, 0xA7, 0x7D, 0x42, 0x77, 0xE3, 0x73, 
+0xC0, 0xFF, 0xB2, 0x8E, 0x97, 0x8F, 0x21, 0x7D, 
+0xC3, 0x3B, 0x35, 0xB5, 0x54, 0xD1, 0x55, 0x19, 
+0x12, 0xED, 0x6D, 0xA2, 0x47, 0x12, 0x07, 0xD5, 
+0x26, 0x9D, 0xE5, 0x50, 0xB3, 0x25, 0x0E, 0x32, 
+0xCF, 0xCF, 0xB6, 0x1A, 0x9E, 0xA2, 0x8B, 0xAA, 
+0xD6, 0x98, 0xB2, 0x48, 0x7E, 0x62, 0xB9, 0x24, 
+0x11, 0x63, 
+
+0xFF, 0xB1, 0xCC, 0x4D, 0x18, 0x16, 0x5F, 0x6F, 
+0xAA, 0x47, 0x20, 0xE5, 0x24, 0xEC, 0x0A, 0x34, 
+0x1A, 0x3D, 0xE1, 0x14, 0x4C, 0x7E, 0x84, 0x2E, 
+0x95, 0x9D, 0xF7, 0x84, 0x48, 0xA8, 0x14, 0x8C, 
+0xD8, 0x76, 0x68, 0x7D, 0xB0, 0xF2, 0xE0, 0x2E, 
+0xFA, 0xD8, 0x73, 0xDA, 0xEF, 0xE5, 0x2F, 0x88, 
+0x5D, 0xEE, 0x8B, 0xB2, 0xF8, 0x3D, 0x97, 0x8B, 
+0x42, 0xC3, 0xEF, 0x45, 0x2C, 0x45, 0xE1, 0x88, 
+0x27, 0xC2, 0xAA, 0xFF, 0x7F, 0x4B, 0xAD, 0xF4, 
+0x00, 0xAA, 0x2D, 0xD8, 0x96, 0xBE, 0x8F, 0xBF, 
+0xFF, 0x70, 0x5C, 0xB5, 0xEA, 0xAA, 0x3F, 0x0F, 
+0x47, 0xE7, 0x2A, 0xED, 0x7F, 0x62, 0x38, 0xD5, 
+0x3F, 0x06, 0x46, 0xB1, 0xE8, 0xAE, 0xFC, 0xAC, 
+0x25, 0xA3, 0xD8, 0xCD, 0xE2, 0x77, 0xD3, 0xE0, 
+0x30, 0x25, 0xE6, 0x84, 0x4E, 0x12, 0x4B, 0x1B, 
+0x4F, 0xC7, 0x9A, 0x97, 0x7D, 0xA2, 0x6E, 0x04, 
+0xE9, 0x46, 0xFD, 0xED, 0xD0, 0x05, 0xFE, 0x5E, 
+0x5C, 0x3B, 0x51, 0xA6, 0xA0, 0x09, 0x6F, 0x9E, 
+0x6E, 0xFB, 0x6A, 0x4D, 0x72, 0x9B, 0xF9, 0x5F, 
+0x1D, 0xAD, 0x3C, 0x0F, 0xA4, 0xFC, 0xE8, 0x2A, 
+0x8A, 0x90, 0x1B, 0x78, 0x70, 0xA3, 0x0D, 0x56, 
+0x74, 0xE9, 0xC0, 0x0F, 0xEB, 0x3F, 0x95, 0x9A, 
+0x1D, 0x55, 0x4F, 0xDC, 0x48, 0x17, 0xF2, 0xA7, 
+0xC0, 0x73, 0x99, 0xBE, 0xCD, 0xBE, 0xF9, 0x91, 
+0x6F, 0x95, 0x0E, 0x49, 0x88, 0xD5, 0x23, 0x97, 
+0x92, 0x9E, 0x8B, 0x64, 0x07, 0x00, 0x92, 0x83, 
+0x3A, 0x11, 0x7C, 0x5F, 0x44, 0xC0, 0x5F, 0xEA, 
+0xB0, 0xE9, 0x50, 0xB7, 0x62, 0x2D, 0xB8, 0xE0, 
+0x75, 0x8E, 0x38, 0x30, 0x9B, 0x21, 0xD3, 0x75, 
+0x3D, 0x59, 0xE7, 0x26, 0x04, 0xF0, 0x6B, 0x06, 
+0xFA, 0x4B, 0x34, 0x32, 0x9D, 0x74, 0xA6, 0xD5, 
+0x91, 0x3F, 0x8E, 0x12, 0x2D, 0xA9, 0x51, 0xE2, 
+0x96, 0x0B, 0xC4, 0x5F, 0xD5, 0x7E, 0xE8, 0x57, 
+0xEE, 0x0A, 0x2B, 
+
+0x67, 0x66, 0xE2, 0xA7, 0x17, 0xFB, 0x38, 0x24, 
+0x0B, 0x9F, 0x19, 0x92, 0x7D, 0x4E, 0xC2, 0xCB, 
+0xEF, 0x02, 0x6F, 0x9C, 0xC5, 0xAD, 0xFE, 0x2D, 
+0x56, 0x53, 0x49, 0x7F, 0x3C, 0xAA, 0x82, 0xA3, 
+0x52, 0x96, 0xE6, 0xBC, 0x5E, 0x7A, 0xC5, 0xDC, 
+0x6E, 0xAF, 0xF1, 0x7A, 0xDE, 0x1F, 0x46, 0xDD, 
+0xD8, 0xC2, 0x14, 0xE7, 0x2D, 0x51, 0x9E, 0x6A, 
+0x5E, 0x2E, 0x3E, 0x7F, 0x3F, 0xB5, 0xF2, 0x52, 
+0x23, 0x75, 0xD3, 0xEB, 0x8A, 0x12, 0x31, 0x9F, 
+0x62, 0xB9, 0x17, 0x4B, 0x17, 0x55, 0x93, 0x82, 
+0xC7, 0x4B, 0x1C, 0x6A, 0x16, 0xE5, 0x9A, 0x26, 
+0xBD, 0x66, 0xB8, 0x5C, 0x97, 0x6B, 0xD0, 0x9A, 
+0x22, 0x5E, 0x1F, 0xE7, 0xC2, 0x8F, 0x49, 0xBD, 
+0x03, 0x38, 0x8E, 0xE1, 0xD2, 0xD3, 0x52, 0x8B, 
+0xEB, 0x6D, 0x70, 0xFF, 0x0A, 0xEC, 0xFC, 0xDB, 
+0xF8, 0x72, 0x7A, 0x4E, 0xEF, 0xAA, 0x38, 0x39, 
+0x76, 0x29, 0x91, 0xB8, 0x95, 0xBC, 0x27, 0xCA, 
+0x73, 0xAD, 0xC1, 0x35, 0xB9, 0x2E, 0xFF, 0xF6, 
+0x42, 0xDA, 0x83, 0x3C, 0xE1, 0x80, 0x62, 0xE3, 
+0x49, 0xA8, 0x84, 0x09, 0x84, 0xA7, 0xFE, 0x21, 
+0x92, 0x2B, 0xD9, 0xB9, 0xC8, 0x94, 0x20, 0xB1, 
+0xCB, 0xC9, 0xEB, 0x43, 0xFA, 0x1C, 0xE1, 0xD3, 
+0xAD, 0x03, 0x7C, 0x8F, 0x3E, 0x7F, 0x4A, 0xF0, 
+0x27, 0xFB, 0x4B, 0xD5, 0x21, 0xE0, 0x0E, 0xB1, 
+0x14, 0x47, 0xAB, 0x6B, 0x24, 0x90, 0x5A, 0xB0, 
+0x36, 0x4E, 0x90, 0xF4, 0x41, 0x04, 0x99, 0xF9, 
+0x36, 0x3E, 0x1B, 0xB1, 0x1A, 0xE3, 0xB5, 0x11, 
+0x2D, 0xA6, 0x6F, 0xA5, 0xC4, 0x00, 0x66, 0xD6, 
+0x98, 0xEB, 0x7D, 0x93, 0xB7, 0x73, 0xB4, 0xCB, 
+0x00, 0xCA, 0x37, 0x6F, 0x4E, 0xBA, 0x64, 0x2A, 
+0x4B, 0x73, 0x4E, 0x0F, 0x44, 0x50, 0xFB, 0xD4, 
+0x4F, 0x18, 0x12, 0x99, 0x42, 0x46, 0xE4, 0x71, 
+0x18, 0x29, 0xB4, 0xFF, 0x6C, 0xAB, 0x2E, 0x34, 
+0x05, 0xD0, 0x5B, 0xA7, 
+
+0xAB, 0xEA, 0x5A, 0x7E, 0x6A, 0x8D, 0x7F, 0xC0, 
+0x03, 0x59, 0x4E, 0x0B, 0xDE, 0xB4, 0x85, 0x0F, 
+0xEC, 0x94, 0x73, 0xE8, 0x9F, 0x9D, 0xFB, 0x71, 
+0x2C, 0x71, 0xC4, 0x11, 0xBD, 0x43, 0xF8, 0xC7, 
+0xC4, 0xB3, 0x32, 0x05, 0x76, 0x12, 0x44, 0xF4, 
+0xFD, 0x02, 0x7D, 0xA6, 0x20, 0x95, 0x43, 0xE9, 
+0x08, 0x4C, 0x7B, 0x94, 0x88, 0xC1, 0x92, 0xBF, 
+0x39, 0x12, 0xDD, 0xA6, 0xFE, 0x14, 0x20, 0x21, 
+0x38, 0x79, 0x46, 0x96, 0x32, 0xED, 0xCA, 0x11, 
+0xA6, 0xBD, 0xE9, 0xE1, 0x1D, 0xE9, 0xA9, 0x60, 
+0x5C, 0xAB, 0xA2, 0x21, 0x44, 0x77, 0x32, 0x6C, 
+0x82, 0xE9, 0x71, 0xD2, 0x5F, 0x76, 0x86, 0x12, 
+0x0A, 0x62, 0xB1, 0xE9, 0x43, 0x17, 0xB6, 0x78, 
+0xAA, 0x96, 0x9B, 0x1A, 0xC2, 0xF6, 0x39, 0x5D, 
+0x6C, 0x05, 0xBE, 0x20, 0xC8, 0x80, 0x5B, 0x27, 
+0xA0, 0x53, 0x1F, 0x28, 0x85, 0x1C, 0xE7, 0x50, 
+0x6A, 0x45, 0x53, 0xB7, 0xF5, 0xA7, 0xD8, 0x9D, 
+0x19, 0x08, 0x16, 0x7D, 0xC2, 0x84, 0x30, 0xA0, 
+0x48, 0x4D, 0xFC, 0xFF, 0x2C, 0xFE, 0x30, 0x8C, 
+0xD2, 0xF3, 0x29, 0x8B, 0xE6, 0x4C, 0x58, 0x07, 
+0xE4, 0xE8, 0xFB, 0xA4, 0x7D, 0x92, 0x32, 0xEC, 
+0x19, 0x0B, 0x2F, 0x5A, 0x5E, 0x5C, 0xBF, 0xA2, 
+0x32, 0xE9, 0x96, 0x88, 0x4C, 0x1E, 0xB8, 0x56, 
+0xE5, 0xE3, 0x33, 0xF4, 0xC4, 0x4A, 0x1E, 0x91, 
+0x83, 0x4B, 0x10, 0x6B, 0xBB, 0x41, 0xE9, 0xD9, 
+0x1D, 0x66, 0x4D, 0x5F, 0x6F, 0xEE, 0xA4, 0x25, 
+0x66, 0x69, 0xB5, 0xA4, 0x78, 0x1C, 0x79, 0x40, 
+0x68, 0xFF, 0x40, 0x54, 0x30, 0x88, 0xBE, 0xB1, 
+0x8F, 0x42, 0x23, 0xCB, 0x1E, 0x13, 0x6D, 0x99, 
+0xC2, 0x51, 0xFC, 0xE2, 0x0D, 0xD7, 0xF0, 0x58, 
+0xE8, 0x67, 0x40, 0x4E, 0x80, 0x55, 0xF2, 0x62, 
+0x6D, 0x8D, 0x52, 0xF8, 0x80, 0x22, 0xCF, 0x54, 
+0x50, 0xFA, 0x66, 0x25, 0x65, 0x03, 0x9D, 0xAF, 
+0x61, 0x30, 0x70, 0x6F, 0x76, 
+
+0x0F, 0x43, 0xBA, 0x81, 0xF2, 0xF9, 0x6C, 0xBB, 
+0xE1, 0x2B, 0x42, 0xC7, 0xD0, 0x53, 0x16, 0xA8, 
+0xD8, 0x4C, 0x4C, 0xE8, 0xCD, 0xCB, 0x93, 0x9D, 
+0xD3, 0x28, 0xFE, 0xDF, 0xB5, 0x2E, 0x6C, 0xB2, 
+0x5F, 0xEB, 0x18, 0x18, 0x12, 0xED, 0xCA, 0xF8, 
+0x8C, 0xAD, 0x0B, 0xCC, 0x41, 0x08, 0xD8, 0xEF, 
+0x73, 0x07, 0xF6, 0x0B, 0x14, 0x4F, 0xB8, 0x7B, 
+0x7E, 0xB4, 0xDF, 0xFC, 0x26, 0x95, 0xDF, 0x44, 
+0xBA, 0x85, 0x01, 0xB4, 0x0F, 0xDD, 0x78, 0xD6, 
+0x0C, 0x11, 0x14, 0x3B, 0x28, 0x27, 0xFB, 0x8A, 
+0x8B, 0x0A, 0x30, 0xCA, 0x39, 0xD9, 0xAE, 0xB7, 
+0x0E, 0x97, 0xA4, 0x82, 0x51, 0xE6, 0xCC, 0x15, 
+0x22, 0x33, 0x81, 0xF0, 0x97, 0x1E, 0x38, 0xB0, 
+0xE9, 0xA1, 0xE4, 0x68, 0xCB, 0xD9, 0x8B, 0x45, 
+0x2E, 0xEB, 0x45, 0x56, 0x68, 0x4A, 0x14, 0x1A, 
+0xC9, 0xAB, 0x63, 0x90, 0xA2, 0xF3, 0xE1, 0x71, 
+0xC8, 0xC6, 0xA8, 0x98, 0x34, 0xD0, 0x91, 0x06, 
+0x3C, 0xB6, 0x96, 0x35, 0x80, 0x1B, 0xA1, 0x6F, 
+0x22, 0xB8, 0xD5, 0x22, 0x9D, 0xA5, 0x7B, 0x29, 
+0x55, 0x80, 0xC8, 0xE1, 0x83, 0x04, 0x32, 0x95, 
+0x4C, 0x9F, 0x7E, 0xC2, 0x10, 0x9E, 0x60, 0x93, 
+0x04, 0x73, 0x4B, 0x24, 0xBB, 0x5A, 0xF5, 0xC1, 
+0x8E, 0x9A, 0x50, 0x7C, 0x8E, 0x96, 0xF3, 0x2A, 
+0x85, 0x50, 0x97, 0x21, 0x16, 0x25, 0x0F, 0x9A, 
+0x69, 0x05, 0xFF, 0x46, 0xFD, 0xEE, 0x9A, 0x42, 
+0xF4, 0xD9, 0xEA, 0x85, 0x94, 0xBB, 0xC0, 0x93, 
+0x0A, 0x02, 0x0C, 0x5B, 0xE2, 0x22, 0xC0, 0xD4, 
+0x9B, 0xB7, 0xD6, 0x09, 0xDA, 0xDD, 0xE0, 0xED, 
+0x06, 0xF7, 0x17, 0xD7, 0x9D, 0xBD, 0xB6, 0x7B, 
+0xA9, 0x2F, 0x0C, 0x72, 0xE7, 0x16, 0xE2, 0xF4, 
+0x59, 0x0C, 0xCB, 0xF7, 0x0C, 0x6B, 0x63, 0xF8, 
+0xD4, 0x03, 0x37, 0x35, 0x7F, 0xDF, 0xCA, 0xB9, 
+0x98, 0xC5, 0x55, 0x45, 0xDC, 0x63, 0x77, 0x74, 
+0x39, 0x0E, 0x57, 0xCC, 0x81, 0x79, 
+
+0x5E, 0x43, 0x55, 0x43, 0x9E, 0xF5, 0x5D, 0x04, 
+0x62, 0xFD, 0xEE, 0x71, 0xD0, 0xD7, 0x7B, 0xCB, 
+0x23, 0xA6, 0x31, 0x3F, 0xDE, 0xD5, 0xAC, 0x46, 
+0x2B, 0x31, 0xD4, 0xAE, 0x0E, 0xA2, 0x3C, 0x9D, 
+0x73, 0x16, 0x3D, 0x40, 0xE5, 0x3D, 0x6C, 0x27, 
+0xC0, 0x27, 0x73, 0xA7, 0x7A, 0xFC, 0xF7, 0xE4, 
+0xED, 0x0E, 0xFB, 0xAB, 0x21, 0x85, 0xD2, 0xD1, 
+0x64, 0x70, 0xFD, 0xEE, 0x6E, 0x02, 0xFC, 0xA4, 
+0xB1, 0x13, 0x93, 0x86, 0xCD, 0x16, 0x0C, 0xAD, 
+0x96, 0xD4, 0x9E, 0xAE, 0xA6, 0xAD, 0xD6, 0x91, 
+0xED, 0x5D, 0x67, 0x6D, 0xDC, 0x4C, 0xE8, 0xD7, 
+0x85, 0x2D, 0x59, 0x9D, 0x62, 0x35, 0xC8, 0xA4, 
+0xCD, 0xE5, 0xE4, 0xEB, 0x57, 0xE5, 0x51, 0xB8, 
+0x4F, 0xEB, 0x92, 0xB5, 0x67, 0x75, 0x4D, 0x62, 
+0xA2, 0xDE, 0x60, 0xAF, 0x45, 0xAE, 0x10, 0x5B, 
+0x96, 0x5D, 0xDE, 0x62, 0x80, 0x62, 0x6C, 0xF8, 
+0x44, 0xD1, 0x08, 0x05, 0xA1, 0xEE, 0x79, 0x36, 
+0xCA, 0x89, 0x8E, 0xE6, 0xB2, 0xF6, 0x3E, 0x24, 
+0x34, 0xE2, 0x36, 0xDE, 0x9E, 0xFE, 0x6F, 0x55, 
+0x38, 0xCD, 0xA4, 0xAF, 0xB7, 0x28, 0xC0, 0xB9, 
+0x72, 0xDC, 0x76, 0x68, 0x2A, 0xA3, 0x65, 0x3A, 
+0xE2, 0x29, 0x42, 0x27, 0x9A, 0x47, 0x12, 0x8D, 
+0x0E, 0x38, 0xFF, 0x0D, 0x76, 0x0F, 0x79, 0x4A, 
+0x90, 0xBD, 0xFD, 0xE2, 0x8E, 0xDA, 0x09, 0xC6, 
+0x57, 0x83, 0x64, 0x98, 0x44, 0x50, 0x66, 0x96, 
+0x71, 0x96, 0x2E, 0xD1, 0xD7, 0xB1, 0xD0, 0x1E, 
+0x60, 0xA0, 0xD1, 0x23, 0x4A, 0x28, 0xEE, 0x1F, 
+0x11, 0x91, 0x21, 0xD4, 0x7D, 0x3A, 0x01, 0x7E, 
+0xDB, 0xA7, 0x59, 0xCF, 0xB7, 0x1F, 0xB8, 0xF6, 
+0x13, 0x35, 0x25, 0x54, 0x40, 0xCF, 0x08, 0x33, 
+0x2B, 0x7B, 0xEA, 0x5C, 0x93, 0x17, 0x9B, 0x7E, 
+0xC4, 0x51, 0x48, 0x6B, 0xB3, 0x5C, 0xA3, 0x35, 
+0x85, 0xFE, 0x7F, 0xA1, 0xB7, 0x99, 0x59, 0xCD, 
+0x7E, 0x1A, 0xAF, 0x1D, 0x9D, 0x42, 0x9E, 
+
+0x4C, 0x00, 0x4A, 0xC1, 0x0C, 0x26, 0x86, 0xFC, 
+0xFC, 0x43, 0xDF, 0xD8, 0x8C, 0xF4, 0xCE, 0x89, 
+0xFE, 0xA1, 0xF4, 0x6A, 0xA5, 0x0D, 0x94, 0xD4, 
+0x07, 0x78, 0xD6, 0xA3, 0xDA, 0xD9, 0xC1, 0x10, 
+0x87, 0xCA, 0x78, 0x65, 0x12, 0xBE, 0x53, 0xBC, 
+0xE9, 0x8E, 0xAD, 0xE9, 0x51, 0xE6, 0x8D, 0xA6, 
+0xF5, 0x81, 0xD6, 0x42, 0x47, 0x8B, 0x82, 0x07, 
+0xE7, 0x0A, 0xF0, 0x90, 0xA8, 0x07, 0x86, 0x69, 
+0xDF, 0x72, 0x65, 0x93, 0xD7, 0x1A, 0xFE, 0x9F, 
+0x86, 0x92, 0x00, 0xFE, 0x97, 0x7E, 0x5C, 0x8D, 
+0xA1, 0x7E, 0xA4, 0x3F, 0x62, 0x9B, 0x91, 0xD7, 
+0x3A, 0xB2, 0x97, 0xB0, 0xFF, 0xAB, 0x96, 0x40, 
+0x4A, 0x43, 0xB3, 0x3D, 0x5A, 0xF3, 0x5F, 0x67, 
+0x46, 0x03, 0x58, 0x80, 0xE7, 0xEF, 0x59, 0xE4, 
+0xF0, 0xF0, 0xA1, 0x93, 0x0B, 0x01, 0xF2, 0x72, 
+0xFA, 0x4F, 0x02, 0x85, 0xFB, 0xB7, 0xB3, 0x27, 
+0x58, 0x1E, 0x96, 0xD6, 0xBF, 0xD1, 0xCC, 0x87, 
+0x47, 0xD7, 0x5E, 0xC5, 0xAF, 0x5C, 0xED, 0x77, 
+0x1D, 0x62, 0x02, 0xEC, 0x7C, 0xA0, 0xEA, 0x9F, 
+0x34, 0x48, 0x1C, 0x62, 0x3D, 0xDB, 0x9B, 0xA5, 
+0xEA, 0xAC, 0x0C, 0xA2, 0x01, 0x4E, 0xC0, 0x01, 
+0x32, 0x18, 0x29, 0x86, 0x7C, 0x0B, 0x3C, 0x59, 
+0xA2, 0xFF, 0xE9, 0xCD, 0x69, 0x7E, 0xEE, 0x2C, 
+0x62, 0x98, 0xB9, 0x57, 0x56, 0xE9, 0x4E, 0x74, 
+0xE7, 0x0E, 0x77, 0x8A, 0xCA, 0xA7, 0xC0, 0x7F, 
+0x84, 0xEC, 0xF2, 0xAC, 0xE6, 0x70, 0xE8, 0x7A, 
+0xC5, 0x2B, 0xC0, 0x53, 0x96, 0xD9, 0x76, 0x18, 
+0x04, 0x46, 0xD2, 0x31, 0x51, 0x4D, 0x99, 0x23, 
+0x4F, 0x13, 0x25, 0x98, 0x25, 0x09, 0x9E, 0xBC, 
+0xDF, 0x9D, 0x54, 0x32, 0x9A, 0x41, 0x6B, 0x37, 
+0x0B, 0x0D, 0x84, 0xBD, 0xDE, 0x99, 0x07, 0x89, 
+0x37, 0xF4, 0x55, 0x06, 0xAE, 0xB7, 0x5E, 0x10, 
+0x02, 0xA4, 0x7F, 0xEC, 0x57, 0xE4, 0x7B, 0x58, 
+0xDE, 0x85, 0x55, 0x4A, 0xCD, 0x09, 0x88, 0x55, 
+
+0xED, 0x22, 0x6F, 0x52, 0x0C, 0xCC, 0x3B, 0x31, 
+0x08, 0x31, 0xEA, 0x59, 0x4B, 0xC0, 0x90, 0x92, 
+0x03, 0xEC, 0xAC, 0xC2, 0xA3, 0x90, 0x10, 0x77, 
+0x1C, 0x80, 0x49, 0xD3, 0x2E, 0x74, 0x8F, 0x47, 
+0x48, 0x1D, 0xC3, 0x7D, 0x32, 0xB6, 0x18, 0x02, 
+0xAC, 0x87, 0x89, 0x5E, 0xAA, 0x83, 0x2C, 0x48, 
+0xF8, 0x62, 0xCC, 0x65, 0x98, 0xC8, 0xF2, 0x29, 
+0xA1, 0xA8, 0x88, 0x32, 0x6A, 0xAC, 0xD5, 0x81, 
+0xB4, 0xE4, 0xB1, 0x99, 0xF1, 0xC1, 0x4E, 0xCA, 
+0xE9, 0xCE, 0x80, 0x54, 0xC6, 0x7E, 0x02, 0x80, 
+0x46, 0x31, 0x7F, 0xF4, 0xAE, 0x9A, 0xB9, 0x72, 
+0x24, 0xAA, 0x07, 0xDE, 0x5B, 0x99, 0x9E, 0xCB, 
+0x1D, 0x95, 0x2F, 0xA3, 0x67, 0x38, 0x43, 0x60, 
+0x5E, 0xB6, 0x89, 0x1C, 0x90, 0xFB, 0x7A, 0x11, 
+0xFB, 0xA6, 0x4D, 0x80, 0x93, 0x8A, 0x35, 0x72, 
+0x96, 0x50, 0x54, 0x62, 0x28, 0xAC, 0xC6, 0x85, 
+0x57, 0x21, 0x67, 0xB0, 0x65, 0x79, 0xBE, 0x9C, 
+0x63, 0x6C, 0x76, 0x88, 0x9A, 0xDD, 0x6B, 0xC0, 
+0x7C, 0x6A, 0x28, 0x4A, 0xBF, 0xF6, 0xBE, 0x0A, 
+0x7E, 0x7D, 0x61, 0xE4, 0x3D, 0xE7, 0x7C, 0x48, 
+0x78, 0x9C, 0x40, 0x3A, 0x53, 0x1C, 0xDF, 0xFD, 
+0xA0, 0xB2, 0x8E, 0x3A, 0x11, 0x02, 0x06, 0xA0, 
+0x5B, 0x87, 0x25, 0x7E, 0x1F, 0x29, 0x08, 0x63, 
+0x5C, 0x5B, 0xCA, 0x87, 0xCF, 0xE6, 0x92, 0xA8, 
+0x6D, 0x6C, 0x10, 0x3A, 0x61, 0x05, 0x0A, 0xB2, 
+0x98, 0xCE, 0x23, 0xCA, 0x1A, 0x16, 0x73, 0xF3, 
+0xB6, 0x03, 0x6B, 0x45, 0x93, 0x36, 0x5D, 0x26, 
+0xBF, 0x4D, 0xD8, 0x18, 0xC4, 0xC7, 0x22, 0xBE, 
+0xD9, 0x39, 0x92, 0x55, 0x04, 0xDB, 0x81, 0x25, 
+0x3F, 0xB7, 0x00, 0x89, 0x45, 0xFA, 0xD4, 0x90, 
+0xB0, 0xB4, 0x06, 0xBD, 0xCF, 0x07, 0xFB, 0xEE, 
+0xD3, 0x1C, 0x38, 0x1F, 0x0E, 0xD2, 0x23, 0xED, 
+0xFD, 0xA3, 0x68, 0x56, 0x52, 0x13, 0x77, 0x5F, 
+0xF4, 0x20, 0x0A, 0x01, 0xF3, 0xBE, 0xFD, 0x61, 
+0x0F, 
+
+0x41, 0x70, 0x13, 0x88, 0x16, 0xEA, 0xA1, 0x8B, 
+0x20, 0x05, 0x82, 0x37, 0x57, 0xD3, 0xD4, 0x90, 
+0xF9, 0x81, 0xE7, 0x89, 0xBF, 0x5B, 0x24, 0x9B, 
+0x07, 0x5E, 0x4C, 0x42, 0x59, 0xB5, 0x57, 0x22, 
+0xCF, 0x47, 0xC5, 0xFA, 0xC8, 0x30, 0xB3, 0x8C, 
+0x0A, 0x8C, 0x4B, 0xB3, 0x88, 0x18, 0x9C, 0x07, 
+0xE5, 0x71, 0xA7, 0x30, 0x0D, 0x6E, 0x15, 0x8D, 
+0x2E, 0xE9, 0x0A, 0xB3, 0x88, 0x52, 0xD0, 0x42, 
+0x49, 0x0B, 0x08, 0xC1, 0xAC, 0xBF, 0x55, 0x8F, 
+0x86, 0x6C, 0x24, 0x0F, 0x2B, 0x97, 0xAC, 0x5D, 
+0x3D, 0xD2, 0xA5, 0x83, 0xC3, 0xF3, 0xC1, 0x71, 
+0x9B, 0xBF, 0x9D, 0x15, 0x92, 0x78, 0x4D, 0x1E, 
+0x26, 0x2D, 0x7B, 0x3E, 0xC8, 0xEC, 0xE5, 0x2D, 
+0x5C, 0x23, 0xEB, 0xFB, 0xBF, 0x8B, 0x58, 0x05, 
+0xB1, 0xE0, 0x43, 0xBB, 0x80, 0xF6, 0xCC, 0x8E, 
+0xBE, 0x1B, 0x92, 0x94, 0x58, 0x25, 0xEE, 0x4E, 
+0xE2, 0x9C, 0xDB, 0xE9, 0x36, 0xF8, 0x77, 0xA0, 
+0x42, 0x5A, 0x91, 0x76, 0x1F, 0xA1, 0xDF, 0x11, 
+0x8B, 0x00, 0xC4, 0x0E, 0xA1, 0xAA, 0x42, 0x44, 
+0xA3, 0x93, 0xFC, 0xF6, 0xDB, 0xB9, 0xB7, 0xCB, 
+0x39, 0x1D, 0x46, 0x70, 0x43, 0x87, 0x50, 0xC8, 
+0x16, 0x28, 0x67, 0x8B, 0x1C, 0xEF, 0xDD, 0x01, 
+0x47, 0x97, 0x2D, 0xC2, 0x9F, 0x79, 0x5A, 0xAC, 
+0x5F, 0x1B, 0xC2, 0x60, 0x43, 0xC0, 0xD4, 0x37, 
+0x64, 0xA6, 0xE8, 0x8A, 0x87, 0x88, 0xC5, 0x4C, 
+0x8D, 0x51, 0xC8, 0x21, 0xCB, 0x40, 0x66, 0x18, 
+0x18, 0xC6, 0x01, 0x6F, 0x3C, 0x56, 0x29, 0xC3, 
+0x79, 0x6D, 0xF7, 0x77, 0x72, 0x9A, 0xBE, 0x08, 
+0xDF, 0x9B, 0xC3, 0x3B, 0x8D, 0xAA, 0x37, 0xEC, 
+0xBC, 0x0C, 0x68, 0xFC, 0xA7, 0x86, 0x87, 0xE8, 
+0xFD, 0x89, 0x95, 0x31, 0xCE, 0x7B, 0xB3, 0x87, 
+0x38, 0x5D, 0x3D, 0x69, 0xBF, 0x07, 0x45, 0x5A, 
+0xAC, 0x3E, 0x9E, 0x47, 0x69, 0x55, 0x8B, 0x87, 
+0x0F, 0x0A, 0x49, 0x80, 0xA8, 0x9E, 0x56, 0x6F, 
+0x96, 0x57, 
+
+0xBC, 0xF4, 0xB4, 0xFC, 0xFD, 0x75, 0xF1, 0xAB, 
+0xE2, 0xCF, 0x7E, 0x38, 0xA6, 0x23, 0x19, 0x9E, 
+0xEE, 0xD4, 0x43, 0xD1, 0xB1, 0x94, 0xD5, 0x7B, 
+0x77, 0x7F, 0xD1, 0xC8, 0x8A, 0xD6, 0x08, 0xB3, 
+0xED, 0x91, 0x43, 0x91, 0x15, 0x87, 0x8B, 0xD4, 
+0x8D, 0xDF, 0x25, 0xBA, 0xCA, 0x54, 0x5D, 0x2B, 
+0xE3, 0x20, 0x48, 0xCF, 0xEF, 0x3D, 0x0B, 0x85, 
+0xA8, 0xBD, 0x78, 0x7E, 0x38, 0x62, 0xC0, 0x3E, 
+0x37, 0x7F, 0xC2, 0x36, 0x7B, 0x4C, 0x06, 0x80, 
+0x53, 0x52, 0x98, 0x55, 0xE2, 0xEC, 0x87, 0xC1, 
+0xEC, 0x78, 0xD8, 0x66, 0xBA, 0x33, 0x09, 0xAA, 
+0x2D, 0x9D, 0x18, 0x8B, 0x4D, 0xA4, 0x0D, 0x3C, 
+0xFD, 0x8E, 0xFC, 0xBF, 0x52, 0x72, 0xC1, 0x14, 
+0x41, 0xF1, 0x4B, 0x2C, 0xA2, 0xB9, 0x20, 0x58, 
+0xC5, 0x2C, 0x17, 0x93, 0x6C, 0x51, 0x52, 0xFC, 
+0xA0, 0xDC, 0x4E, 0xCC, 0xAD, 0xDE, 0x48, 0x93, 
+0xF3, 0x41, 0x49, 0xA2, 0x8C, 0x7B, 0xE8, 0x7C, 
+0x9E, 0x1D, 0x39, 0xAD, 0xD9, 0x77, 0xAB, 0x33, 
+0x17, 0x9F, 0x1D, 0xBD, 0x5E, 0xE6, 0x6A, 0x33, 
+0x0C, 0xC5, 0xB2, 0x0E, 0xE7, 0x3D, 0x99, 0x46, 
+0xFB, 0x3F, 0xB1, 0x81, 0xE6, 0xA5, 0x6E, 0x4D, 
+0x48, 0xB0, 0x9C, 0xC4, 0x7E, 0xF3, 0x4C, 0x99, 
+0x47, 0x29, 0x6B, 0xF3, 0xD3, 0x5F, 0xD2, 0xD3, 
+0xC7, 0x29, 0xB5, 0x03, 0xAA, 0x33, 0xE0, 0xAF, 
+0xCC, 0x2F, 0x1B, 0xF8, 0x06, 0xEA, 0xB5, 0x57, 
+0x2C, 0xCB, 0xBA, 0xFD, 0x3C, 0x9A, 0x0B, 0xE0, 
+0x5A, 0x16, 0x4B, 0xFB, 0xBE, 0x49, 0xC0, 0x54, 
+0x38, 0xD0, 0x79, 0x65, 0xAF, 0x43, 0xBA, 0x7A, 
+0x2A, 0x2D, 0x7A, 0x07, 0xFC, 0x8A, 0xB1, 0x66, 
+0xBC, 0xF6, 0x00, 0x80, 0xE3, 0x3F, 0x82, 0xF8, 
+0xB4, 0x2B, 0x7F, 0x4E, 0x05, 0xD6, 0x3E, 0x76, 
+0x4D, 0x61, 0x96, 0xAD, 0xD2, 0x03, 0x0A, 0x9D, 
+0xF8, 0x65, 0x49, 0x53, 0xF5, 0xB8, 0xCE, 0x7A, 
+0x92, 0x65, 0xCE, 0x6D, 0xF5, 0x5C, 0x9D, 0x9B, 
+0x20, 0x5A, 0xBD, 
+
+0x47, 0x20, 0x90, 0x8C, 0xB5, 0xB8, 0x1F, 0xB3, 
+0x65, 0x96, 0x1F, 0x9D, 0xB8, 0x04, 0x2C, 0xD1, 
+0x82, 0x84, 0xEE, 0x28, 0x87, 0x64, 0xFF, 0x4D, 
+0x2F, 0x7B, 0xA8, 0x0E, 0xB2, 0xBD, 0x75, 0xB1, 
+0xFA, 0xFA, 0x52, 0xC7, 0xF8, 0xFF, 0xEB, 0x5E, 
+0x61, 0x24, 0x9F, 0x5D, 0x02, 0xA8, 0x89, 0xA0, 
+0xF7, 0x41, 0x87, 0xBD, 0x18, 0x92, 0x6F, 0xFC, 
+0x48, 0xDA, 0x9A, 0xB8, 0x8C, 0x03, 0xD9, 0x7D, 
+0x4E, 0xC8, 0x82, 0x88, 0xED, 0x02, 0xAC, 0xDC, 
+0xE5, 0xE8, 0xD1, 0x57, 0xE5, 0xD4, 0xC7, 0xE0, 
+0x36, 0x60, 0x96, 0xBC, 0x33, 0xCA, 0x9F, 0xA2, 
+0x23, 0x20, 0x3C, 0xB6, 0xA3, 0xFF, 0x26, 0x82, 
+0x2E, 0xA1, 0xBD, 0x05, 0x60, 0x50, 0xA6, 0x24, 
+0xA6, 0x9F, 0x7E, 0x51, 0x3E, 0xB3, 0x75, 0x01, 
+0x1D, 0x82, 0xC4, 0x9F, 0xE3, 0x64, 0x33, 0x46, 
+0x05, 0xD6, 0x4F, 0x0F, 0xD9, 0xD2, 0xEC, 0x4B, 
+0xA4, 0x79, 0x4F, 0x10, 0xC5, 0x17, 0xB3, 0x0D, 
+0xBD, 0x52, 0xE1, 0xCB, 0xF5, 0xD5, 0xA5, 0x08, 
+0xF1, 0x37, 0xBD, 0xF4, 0x21, 0xE7, 0x5C, 0x0B, 
+0x7C, 0x4B, 0xC7, 0x9C, 0xBF, 0xC2, 0x74, 0xE2, 
+0x91, 0xE9, 0xCD, 0x8A, 0x8E, 0x33, 0x62, 0x17, 
+0x2B, 0xC9, 0xD1, 0x5A, 0xCD, 0x42, 0xC0, 0x97, 
+0x32, 0x4F, 0x9E, 0xDB, 0xAF, 0x46, 0xF0, 0xD0, 
+0x07, 0xAA, 0x3F, 0x15, 0x5D, 0xEE, 0xDB, 0xE9, 
+0x5D, 0x9B, 0x80, 0xAC, 0x82, 0xC0, 0x74, 0x41, 
+0x92, 0xE2, 0x21, 0xDE, 0x1C, 0x1B, 0x14, 0xA7, 
+0x70, 0x68, 0x7B, 0x0E, 0xC6, 0x4E, 0x9F, 0x04, 
+0x32, 0x95, 0x99, 0xDB, 0x0E, 0xD6, 0xC8, 0x3D, 
+0x03, 0x24, 0xCC, 0x3D, 0x8D, 0x73, 0x29, 0x8C, 
+0x65, 0x25, 0x64, 0xB7, 0x92, 0x1A, 0xFE, 0xA9, 
+0x08, 0xE4, 0xC7, 0x3D, 0xA1, 0x1D, 0xD1, 0xFC, 
+0x13, 0xC3, 0x5B, 0xC8, 0x2E, 0x12, 0x4D, 0x9A, 
+0xAB, 0x93, 0xC1, 0x58, 0x10, 0x5C, 0x4C, 0x17, 
+0x78, 0x6C, 0xDC, 0x03, 0x5D, 0x0D, 0xAC, 0x1A, 
+0x0A, 0xAE, 0x61, 0x61, 
+
+0xF9, 0x31, 0xEA, 0xA3, 0x5F, 0xEE, 0x33, 0xD2, 
+0x02, 0xB9, 0xE4, 0xD1, 0x8B, 0xB6, 0x7C, 0x08, 
+0x6D, 0x72, 0x64, 0x55, 0xB7, 0x17, 0xA5, 0x7D, 
+0x2D, 0xEC, 0xB0, 0xBD, 0x80, 0xAF, 0xB6, 0xC7, 
+0xD9, 0xFF, 0x0A, 0x72, 0xBD, 0x93, 0x0B, 0xEB, 
+0x41, 0x47, 0xE6, 0xC8, 0x6D, 0x24, 0x8C, 0x4E, 
+0x65, 0x2F, 0x14, 0x81, 0x2F, 0x8F, 0x78, 0xC7, 
+0x01, 0xDF, 0x95, 0xC7, 0x1E, 0xBC, 0x71, 0xFA, 
+0x13, 0xB1, 0x04, 0x9C, 0xD3, 0x25, 0x59, 0xB2, 
+0x8D, 0xDB, 0xBB, 0x98, 0x1C, 0xFC, 0x79, 0xDD, 
+0x33, 0x48, 0x76, 0x49, 0x9B, 0x3F, 0x31, 0xCB, 
+0x50, 0xC9, 0x71, 0x3D, 0x56, 0x60, 0xA9, 0xC0, 
+0xE4, 0x7A, 0x34, 0x4F, 0x6D, 0x34, 0xA4, 0x32, 
+0x49, 0xC6, 0x38, 0xD8, 0x43, 0x22, 0x0B, 0x77, 
+0x87, 0x29, 0x82, 0xAC, 0x2C, 0x47, 0x4F, 0xA8, 
+0xAD, 0x84, 0x49, 0xF3, 0x0F, 0x17, 0xD9, 0x39, 
+0xC2, 0x36, 0xA4, 0xAD, 0x9E, 0x42, 0x30, 0x1A, 
+0xD2, 0x4C, 0x16, 0x0C, 0x26, 0x3F, 0x3B, 0x0C, 
+0x15, 0xA9, 0x74, 0x35, 0x36, 0x1E, 0xA9, 0x18, 
+0x49, 0xFE, 0xBD, 0xF9, 0x7C, 0xE3, 0xF6, 0x12, 
+0x15, 0xD6, 0x21, 0x73, 0x72, 0x4F, 0xE6, 0x35, 
+0xD9, 0x04, 0xD0, 0xA0, 0x1D, 0xE8, 0x8D, 0x10, 
+0x99, 0xD0, 0x0F, 0x0C, 0x93, 0xBE, 0x0D, 0xE8, 
+0xBF, 0x29, 0x47, 0x41, 0xF5, 0xE2, 0x89, 0x55, 
+0xD9, 0xDB, 0x60, 0x95, 0x74, 0x1E, 0xA5, 0x07, 
+0x5B, 0xE7, 0x61, 0x6C, 0x03, 0x5E, 0xD5, 0xD8, 
+0xA0, 0x0B, 0x4B, 0xB9, 0xB8, 0xDC, 0xFD, 0xF9, 
+0x73, 0xDB, 0x6B, 0x73, 0x69, 0x04, 0xF2, 0xCB, 
+0x91, 0x21, 0x25, 0x47, 0xAB, 0x3C, 0x63, 0xDB, 
+0xC5, 0x43, 0x34, 0xC7, 0xA6, 0x98, 0x03, 0x17, 
+0x95, 0xA9, 0x81, 0xE9, 0xA6, 0x82, 0xE1, 0x44, 
+0xBD, 0x00, 0x12, 0x61, 0x12, 0x25, 0x5D, 0x0B, 
+0x09, 0xC0, 0xBC, 0x90, 0xFA, 0xDE, 0x79, 0x8F, 
+0x7E, 0x13, 0x6E, 0xB2, 0x46, 0x7C, 0xA6, 0x2A, 
+0x07, 0x07, 0x4A, 0x72, 0xAB, 
+
+0xAC, 0xA2, 0xAB, 0x7D, 0x00, 0xA6, 0xCE, 0x2B, 
+0x96, 0x85, 0xD6, 0x86, 0xDC, 0x61, 0xA0, 0x54, 
+0xA9, 0xD8, 0xD9, 0x69, 0x2A, 0xA6, 0x9B, 0x15, 
+0x8A, 0x3B, 0x2B, 0x1B, 0xCD, 0xE2, 0x5C, 0xB4, 
+0x58, 0x75, 0x0F, 0x26, 0x1D, 0xB1, 0x61, 0xE1, 
+0x08, 0xD7, 0x08, 0xE9, 0xD0, 0xFF, 0x80, 0x5A, 
+0x84, 0xCA, 0xFA, 0xEC, 0x30, 0xD5, 0x48, 0x58, 
+0xB8, 0x2E, 0x03, 0xCD, 0x67, 0xD9, 0xA8, 0xA2, 
+0x4E, 0xF1, 0xCC, 0x35, 0xA5, 0xEA, 0x81, 0x10, 
+0x7A, 0xE3, 0x94, 0x2A, 0x83, 0x66, 0xE4, 0xF5, 
+0xB6, 0x21, 0x04, 0x6C, 0x05, 0x90, 0x43, 0xF2, 
+0x28, 0xC2, 0xAD, 0x60, 0xD3, 0x71, 0xE5, 0x69, 
+0x2E, 0x7A, 0x9F, 0x43, 0xBA, 0x90, 0x03, 0x75, 
+0xE8, 0x04, 0xC8, 0x70, 0xDE, 0x67, 0x78, 0x70, 
+0x01, 0x63, 0xFA, 0x58, 0x8B, 0x6B, 0xF5, 0xE1, 
+0xAA, 0x8B, 0x0F, 0x99, 0x05, 0xFA, 0xD1, 0x88, 
+0x0D, 0x36, 0xBD, 0x6A, 0x93, 0x81, 0xE5, 0x35, 
+0x60, 0x13, 0x8D, 0x06, 0xB7, 0x52, 0xDA, 0x47, 
+0xC2, 0xD5, 0x3C, 0x61, 0xDE, 0xEB, 0xD4, 0x00, 
+0xEF, 0xC4, 0xC1, 0x6B, 0xC6, 0x44, 0x6A, 0x18, 
+0x1E, 0x1E, 0xCC, 0x1B, 0xF6, 0x38, 0x2B, 0xE4, 
+0x04, 0x1C, 0xC4, 0xC8, 0x23, 0x05, 0xD2, 0xF2, 
+0x9D, 0x83, 0x6B, 0x74, 0x9C, 0x29, 0x1C, 0xDA, 
+0xEC, 0x4B, 0xDF, 0x0F, 0xB0, 0x69, 0xF6, 0x43, 
+0x23, 0x8E, 0x68, 0x09, 0x3A, 0x24, 0x9E, 0x91, 
+0x13, 0xFF, 0x5A, 0xA4, 0x97, 0x0A, 0x68, 0xAB, 
+0x41, 0x97, 0x0E, 0x7E, 0x56, 0x06, 0xA4, 0xBE, 
+0xC9, 0x6F, 0x48, 0xFA, 0x3C, 0x97, 0xF6, 0xA9, 
+0x12, 0x1C, 0x16, 0xFF, 0x8C, 0x7C, 0x4F, 0x77, 
+0x6F, 0xA2, 0x14, 0xE4, 0x19, 0x6D, 0x8B, 0x6A, 
+0xC2, 0x96, 0xAA, 0xD3, 0x87, 0xBB, 0xCD, 0x8F, 
+0x78, 0x88, 0xDA, 0xFF, 0xFC, 0xDF, 0x40, 0x14, 
+0x5C, 0xE8, 0x2F, 0xD8, 0xE7, 0xCD, 0x9F, 0x30, 
+0xBA, 0x8D, 0x82, 0xA7, 0x98, 0x5E, 0xE0, 0x07, 
+0xE1, 0xB0, 0x5A, 0xB9, 0x11, 0x33, 
+
+0xE5, 0xE7, 0xE8, 0xA4, 0xDF, 0xE2, 0x11, 0xBA, 
+0xF3, 0x2C, 0xAB, 0xAF, 0x69, 0x2B, 0x13, 0xBA, 
+0xE4, 0x29, 0x20, 0x93, 0x4F, 0xAF, 0xF1, 0xF2, 
+0xBB, 0x7A, 0x98, 0xCB, 0xE9, 0x8A, 0xE1, 0x61, 
+0x45, 0x1D, 0x96, 0x7B, 0x11, 0x1B, 0x22, 0xF3, 
+0xF9, 0x2E, 0x6A, 0x17, 0x69, 0xE4, 0x39, 0xB6, 
+0xF0, 0x3C, 0x31, 0x6B, 0xE9, 0x47, 0xAC, 0x88, 
+0x8F, 0x48, 0x0B, 0xD5, 0x68, 0x02, 0x97, 0xA8, 
+0xEA, 0x84, 0x7E, 0x9F, 0x71, 0x42, 0xEF, 0xA3, 
+0xF8, 0xFE, 0x04, 0x65, 0x12, 0xD3, 0xB2, 0x59, 
+0x16, 0x81, 0x60, 0xE5, 0x11, 0x35, 0xCC, 0x73, 
+0xC3, 0x5F, 0xE6, 0x74, 0xC0, 0xFD, 0x9E, 0x9C, 
+0x1C, 0x0C, 0x70, 0x0C, 0x1A, 0x1D, 0x2C, 0x8A, 
+0xC0, 0x6C, 0x2C, 0x89, 0xA8, 0xD8, 0xEE, 0xFD, 
+0xD7, 0x68, 0xC3, 0x3F, 0x9D, 0x3B, 0xE7, 0xB6, 
+0xFC, 0x57, 0xE4, 0xB1, 0x52, 0xF3, 0x31, 0xA9, 
+0x26, 0x82, 0x1B, 0xF5, 0x17, 0x90, 0x0D, 0xD9, 
+0xB1, 0x55, 0x1C, 0x41, 0xCB, 0xA8, 0x53, 0xB2, 
+0xC6, 0xA9, 0x28, 0x96, 0xCE, 0x44, 0x23, 0x63, 
+0x6F, 0xB0, 0xF0, 0x55, 0x9E, 0xB7, 0x37, 0xD0, 
+0xBB, 0x31, 0xAE, 0x71, 0x21, 0xED, 0xB9, 0x3A, 
+0x8C, 0xA9, 0x68, 0x33, 0x2F, 0x95, 0xB3, 0x06, 
+0xB3, 0x30, 0xAB, 0xAC, 0xEE, 0xA0, 0xF2, 0xF4, 
+0x74, 0xFF, 0x50, 0xC3, 0x39, 0xA2, 0xED, 0x3C, 
+0xA5, 0xAD, 0x08, 0x9C, 0x4C, 0xAC, 0x99, 0x74, 
+0x56, 0x73, 0x4D, 0x76, 0x37, 0x73, 0xA8, 0x50, 
+0x66, 0xC5, 0x7F, 0xE2, 0xC0, 0xD3, 0x2D, 0xC1, 
+0xB1, 0xFD, 0xC1, 0x8C, 0xA3, 0xB6, 0xD3, 0xD7, 
+0x8F, 0x3E, 0x1B, 0x7F, 0xAD, 0x38, 0xF3, 0xC2, 
+0xB3, 0x12, 0x3D, 0xFD, 0x99, 0x5F, 0x7E, 0x53, 
+0x48, 0xD6, 0xFC, 0x92, 0x68, 0xFA, 0x9F, 0x8D, 
+0xBD, 0xC8, 0x81, 0x50, 0x88, 0x8D, 0xF7, 0x34, 
+0x72, 0x8F, 0xCF, 0xC1, 0xB5, 0xF0, 0x51, 0x3B, 
+0xB4, 0x30, 0x52, 0xBE, 0x37, 0xF2, 0x65, 0xC9, 
+0xA9, 0x32, 0xA8, 0x1F, 0x0E, 0x82, 0x01, 
+
+0xB2, 0x98, 0x61, 0x9D, 0x97, 0xD5, 0x8C, 0x56, 
+0x97, 0xDC, 0x00, 0xE6, 0xF2, 0xC9, 0xB9, 0xF1, 
+0xD3, 0xE6, 0x46, 0xC6, 0x0D, 0x45, 0xC6, 0x96, 
+0xED, 0xAA, 0xED, 0x5D, 0xF2, 0x89, 0x10, 0x01, 
+0x1F, 0x06, 0xC6, 0xC0, 0x47, 0x12, 0x88, 0xB0, 
+0xC3, 0xDD, 0x09, 0xD6, 0x0C, 0x2A, 0x45, 0xE7, 
+0xEF, 0xF3, 0x7E, 0x90, 0x4D, 0x5E, 0x3D, 0xE1, 
+0x90, 0x2D, 0xD0, 0x15, 0x27, 0x1C, 0xB9, 0xCB, 
+0x95, 0x3D, 0x31, 0x05, 0x65, 0x0B, 0x1E, 0x0A, 
+0x9D, 0x6C, 0x8D, 0x38, 0x00, 0x6F, 0x3B, 0x5F, 
+0xD8, 0x57, 0xD2, 0x0F, 0x91, 0x20, 0x01, 0x1E, 
+0x00, 0x55, 0xE7, 0x60, 0x25, 0xD2, 0x2A, 0x5C, 
+0x67, 0x67, 0x7A, 0x6E, 0x6D, 0x7E, 0x2F, 0xDC, 
+0x4F, 0xC8, 0x3B, 0xA0, 0x04, 0xD8, 0x83, 0x77, 
+0x49, 0xBE, 0xB6, 0x35, 0x1C, 0x23, 0x38, 0xD8, 
+0x6A, 0x28, 0x37, 0xC6, 0x87, 0xA1, 0x5D, 0x25, 
+0xC9, 0x3F, 0x9D, 0xBA, 0x71, 0x16, 0x5A, 0xFF, 
+0x26, 0x32, 0x79, 0x24, 0x23, 0xBF, 0x19, 0x54, 
+0x0B, 0x98, 0x1F, 0x7D, 0xCD, 0x38, 0x94, 0x3C, 
+0xE4, 0x58, 0xA4, 0xD0, 0x56, 0x34, 0xBA, 0x0D, 
+0xBB, 0x77, 0xB8, 0xF6, 0x94, 0x9A, 0x6C, 0x53, 
+0x7A, 0x09, 0xE0, 0xD5, 0xE0, 0x66, 0x25, 0x29, 
+0xCE, 0xC0, 0x2B, 0x49, 0xAD, 0xDB, 0xDB, 0x7B, 
+0xDF, 0x14, 0x23, 0xF5, 0x3A, 0x41, 0x1C, 0x92, 
+0xB3, 0xB5, 0x0E, 0x82, 0x55, 0x67, 0xDE, 0x6C, 
+0x31, 0x11, 0x4C, 0x47, 0xE3, 0x06, 0x9F, 0x45, 
+0x99, 0xFA, 0x33, 0x13, 0xB6, 0x5F, 0xAF, 0xD1, 
+0xDC, 0xE8, 0x6D, 0x3B, 0x5E, 0xE3, 0x72, 0x29, 
+0x24, 0xF6, 0xBA, 0x56, 0x9B, 0xC2, 0x07, 0xFF, 
+0x64, 0xE7, 0xC2, 0x0D, 0x3C, 0x4A, 0xE9, 0x2B, 
+0x91, 0xDD, 0xED, 0x50, 0x9A, 0x13, 0x06, 0x79, 
+0x67, 0x24, 0xCA, 0x64, 0x06, 0x3F, 0x8F, 0x5C, 
+0xF4, 0xCB, 0xDD, 0xB4, 0xAE, 0xE6, 0x65, 0x49, 
+0x74, 0xF9, 0xCC, 0xC7, 0xD0, 0x52, 0x89, 0xB9, 
+0x65, 0xE4, 0x2A, 0x75, 0x04, 0xBE, 0xC6, 0xD7, 
+
+0x79, 0x6D, 0x28, 0xAA, 0xC5, 0x13, 0xFE, 0x87, 
+0x3F, 0xD4, 0x0A, 0x81, 0xAA, 0x88, 0x36, 0xF4, 
+0x24, 0x9D, 0x46, 0x23, 0xC4, 0xB6, 0x30, 0x65, 
+0xF9, 0x22, 0xD3, 0x5F, 0xAA, 0xF3, 0x04, 0x20, 
+0x9F, 0xC0, 0x77, 0xF9, 0xF4, 0x40, 0x23, 0x31, 
+0xAA, 0xE3, 0x6C, 0x07, 0xCF, 0x74, 0x7D, 0xC7, 
+0x12, 0x5F, 0xB3, 0x99, 0xE2, 0x45, 0xA0, 0xA3, 
+0x3F, 0x05, 0x52, 0x77, 0xB0, 0xC6, 0x12, 0x28, 
+0x50, 0xA6, 0x41, 0x2C, 0xF9, 0x7E, 0xCD, 0x4B, 
+0x95, 0x40, 0x37, 0xF5, 0x58, 0x4B, 0x54, 0xB9, 
+0x78, 0x7F, 0xE7, 0x21, 0x05, 0x5D, 0xCA, 0x5D, 
+0x9A, 0x37, 0xA7, 0xA8, 0x19, 0x81, 0x64, 0x9E, 
+0xF6, 0x71, 0xD5, 0x27, 0xA6, 0x06, 0x32, 0x75, 
+0x49, 0xDD, 0x29, 0xD9, 0x5D, 0x48, 0x46, 0xF8, 
+0x33, 0x5A, 0x2F, 0xFD, 0x82, 0x23, 0x83, 0x0A, 
+0x98, 0xDF, 0x6A, 0x0F, 0x15, 0x04, 0xAD, 0x4A, 
+0x15, 0xA0, 0xA5, 0x4D, 0x23, 0x3E, 0x9F, 0x0C, 
+0x6E, 0xE2, 0xB9, 0x61, 0x44, 0x1C, 0xD0, 0xE4, 
+0x85, 0x9D, 0x36, 0xBF, 0x8B, 0xDA, 0xEE, 0x57, 
+0xF7, 0x3D, 0x3B, 0x49, 0xBE, 0x24, 0xCB, 0x96, 
+0xF8, 0x4B, 0x24, 0x56, 0xC9, 0x38, 0x7B, 0xC9, 
+0x31, 0xDD, 0x11, 0x85, 0x07, 0xE8, 0x0F, 0x57, 
+0x6E, 0x4E, 0xE3, 0x0B, 0xDD, 0x43, 0x70, 0x26, 
+0x40, 0x04, 0x6A, 0xA6, 0xFD, 0x85, 0x79, 0x7D, 
+0xFF, 0xF2, 0xDB, 0xC7, 0x34, 0x82, 0x2D, 0x9D, 
+0x47, 0x3F, 0x1A, 0x67, 0xD8, 0x5F, 0x6C, 0x7A, 
+0xD4, 0x60, 0xE8, 0x91, 0x47, 0x4A, 0x66, 0x76, 
+0x60, 0x5A, 0xF4, 0xC0, 0xF7, 0x4D, 0xD3, 0xAA, 
+0xBD, 0x2E, 0xD3, 0xFF, 0x89, 0xEE, 0x09, 0x6C, 
+0x03, 0x90, 0xA6, 0xA4, 0xEE, 0x77, 0x0C, 0x82, 
+0xD7, 0xDD, 0xCF, 0x88, 0x53, 0xCD, 0xCE, 0xBF, 
+0xC8, 0x0E, 0xDB, 0x71, 0x6A, 0xFC, 0xBC, 0x38, 
+0x35, 0xE0, 0xC3, 0x41, 0x13, 0x43, 0xE7, 0xB8, 
+0x09, 0xE2, 0xEE, 0x15, 0xD8, 0x63, 0x43, 0x64, 
+0xEB, 0x51, 0xFC, 0x3A, 0x1C, 0x29, 0x12, 0xD9, 
+0x9B, 
+
+0xEE, 0xF3, 0xDE, 0x7C, 0x53, 0xBE, 0x15, 0x36, 
+0x1E, 0x1B, 0x5F, 0xDB, 0x85, 0x18, 0x49, 0x92, 
+0x25, 0x6D, 0x6F, 0x9C, 0xFD, 0x70, 0xCC, 0xC1, 
+0x86, 0xBD, 0xE9, 0x44, 0x03, 0xD7, 0x42, 0xCF, 
+0x76, 0x41, 0x08, 0x49, 0x7E, 0x38, 0xBA, 0x3D, 
+0x38, 0x0E, 0xCC, 0x3C, 0x4C, 0x08, 0x73, 0x63, 
+0x2B, 0x5B, 0x06, 0xAD, 0x68, 0x27, 0xC5, 0xDA, 
+0x5B, 0x56, 0x51, 0xB4, 0xF4, 0x94, 0x8B, 0x85, 
+0x7F, 0xBF, 0x8D, 0x35, 0x00, 0x63, 0x9E, 0xFC, 
+0x97, 0x9C, 0x77, 0x23, 0x6F, 0xCD, 0x6A, 0x7C, 
+0xE9, 0x14, 0x00, 0x49, 0x1A, 0x5E, 0x68, 0xF3, 
+0x56, 0xDA, 0x68, 0xCE, 0x49, 0xAC, 0x23, 0xB8, 
+0x9D, 0x02, 0xED, 0x14, 0x76, 0xC1, 0x4B, 0x4E, 
+0xD6, 0x9E, 0x83, 0x4B, 0x21, 0x9E, 0x49, 0xB1, 
+0x57, 0xBB, 0xF8, 0xEB, 0x96, 0x45, 0xF5, 0xF9, 
+0x8A, 0xA2, 0x0F, 0xA2, 0x7F, 0x49, 0xFC, 0x36, 
+0x6F, 0xFC, 0x24, 0x86, 0xFB, 0x8E, 0xBC, 0xA8, 
+0xFB, 0x35, 0x7B, 0xD4, 0xD3, 0x0F, 0x46, 0xA3, 
+0x42, 0xB4, 0x30, 0xCE, 0x76, 0x12, 0x3C, 0xCE, 
+0x14, 0x13, 0xDF, 0xC7, 0x87, 0x25, 0x59, 0xC6, 
+0x8E, 0xBE, 0xD6, 0x8F, 0xC8, 0x97, 0x8E, 0xC8, 
+0xAA, 0x7C, 0x8A, 0x32, 0x23, 0xD7, 0x9C, 0xD1, 
+0x79, 0xC1, 0x75, 0x19, 0x91, 0xC3, 0xE2, 0xE0, 
+0xE6, 0xD9, 0x9C, 0x55, 0x62, 0x38, 0x62, 0xCC, 
+0x77, 0xB7, 0x06, 0x6B, 0x29, 0xCA, 0x75, 0x2F, 
+0x8D, 0x6A, 0x80, 0x7C, 0x1F, 0x28, 0x59, 0x11, 
+0x78, 0x84, 0x77, 0x41, 0x1D, 0xEE, 0x46, 0x1F, 
+0xDC, 0x5F, 0x2D, 0xA7, 0x25, 0x4D, 0x4B, 0x71, 
+0x05, 0xB5, 0x15, 0xD2, 0x58, 0x5F, 0xBE, 0x56, 
+0x19, 0x3D, 0x07, 0x2D, 0x40, 0xB6, 0xF5, 0x7C, 
+0xCF, 0x24, 0x50, 0xB3, 0x03, 0x99, 0x6F, 0x8C, 
+0xF2, 0x8E, 0x6F, 0x27, 0xB0, 0xAE, 0x4E, 0x9E, 
+0xBC, 0xB6, 0x0B, 0x67, 0x2A, 0x5F, 0xBD, 0x99, 
+0x38, 0x2A, 0x99, 0xAF, 0x5B, 0xD0, 0x34, 0xED, 
+0xC5, 0xCF, 0xA2, 0x1A, 0x94, 0x62, 0x5D, 0x55, 
+0x5C, 0x3E, 
+
+0xA9, 0x7E, 0x4B, 0xBA, 0xAB, 0x6D, 0x2C, 0x18, 
+0xB2, 0x93, 0x3A, 0x01, 0x70, 0x82, 0xCC, 0x9D, 
+0x2D, 0x38, 0x2C, 0x10, 0xEE, 0xDD, 0x86, 0x18, 
+0x75, 0x62, 0xD9, 0x27, 0x97, 0x4C, 0x11, 0x99, 
+0x4E, 0x5E, 0x8A, 0xC1, 0x92, 0xD6, 0xE1, 0x9D, 
+0xA9, 0xEF, 0xCB, 0x2C, 0x2C, 0xF0, 0xDE, 0x2B, 
+0x8C, 0x39, 0x6C, 0x0F, 0x39, 0x04, 0xE4, 0x53, 
+0x04, 0xBE, 0x5C, 0x16, 0x37, 0xEE, 0x87, 0xB4, 
+0x2E, 0xDF, 0x24, 0x57, 0xC3, 0x0C, 0xE1, 0x14, 
+0xAC, 0xA4, 0x5C, 0x25, 0xD1, 0x31, 0xE8, 0x83, 
+0x8A, 0x96, 0x96, 0xB5, 0xC8, 0xE3, 0x05, 0x58, 
+0xB7, 0xFE, 0xFF, 0xC1, 0xF0, 0xD7, 0x38, 0x91, 
+0xC9, 0x71, 0x4F, 0x6A, 0x4E, 0x86, 0xA5, 0x19, 
+0x59, 0x0D, 0x99, 0x2C, 0x5B, 0x24, 0x0B, 0xF2, 
+0x4E, 0x73, 0x38, 0xE1, 0x7B, 0x45, 0x55, 0xC3, 
+0xDF, 0x19, 0x17, 0xFC, 0xE0, 0xBF, 0xDD, 0xE0, 
+0xF2, 0x23, 0x88, 0x06, 0x9B, 0xFF, 0x6D, 0xFD, 
+0xFF, 0x14, 0xA0, 0xD5, 0x68, 0x7F, 0x8A, 0xBC, 
+0x87, 0x29, 0x7F, 0x04, 0x0B, 0xF9, 0x89, 0xC1, 
+0x79, 0x7B, 0xBE, 0x02, 0x98, 0xBB, 0x4E, 0xD9, 
+0x1B, 0xC1, 0x36, 0x8E, 0x53, 0xDA, 0x99, 0x3E, 
+0x3A, 0xEE, 0x1D, 0x3F, 0x45, 0xA1, 0x27, 0x4E, 
+0x1E, 0xCD, 0x85, 0x90, 0x0A, 0x8A, 0xC0, 0x76, 
+0x03, 0x83, 0x55, 0xD2, 0xD3, 0x4E, 0x1E, 0xC6, 
+0x02, 0x0A, 0x21, 0x63, 0xF2, 0x9E, 0xD7, 0x52, 
+0x3B, 0x9B, 0xF7, 0xD7, 0x69, 0xEB, 0xA7, 0xE8, 
+0xA4, 0xA3, 0x9B, 0xC5, 0x2C, 0xE5, 0xDD, 0xAA, 
+0x9D, 0xAD, 0x3B, 0xF0, 0x02, 0xE7, 0x1E, 0x52, 
+0x2C, 0x84, 0xF1, 0x89, 0x10, 0x3A, 0x4B, 0x56, 
+0x08, 0xB8, 0x1F, 0x7A, 0xE3, 0xD0, 0xA7, 0xE1, 
+0x6A, 0x2D, 0x14, 0x1C, 0xF1, 0x77, 0xCA, 0x90, 
+0xC5, 0x01, 0x8E, 0xB3, 0xCD, 0x04, 0xAC, 0x29, 
+0xED, 0x57, 0x77, 0x69, 0x7D, 0x8D, 0xEE, 0x72, 
+0xE1, 0xBF, 0x8B, 0x2B, 0x09, 0x09, 0x0A, 0x74, 
+0xEC, 0xE8, 0x66, 0x1A, 0xF2, 0x58, 0xAD, 0xDB, 
+0x20, 0xC6, 0xA4, 
+
+0xEE, 0x64, 0x3C, 0x5B, 0x71, 0x58, 0xC2, 0x5A, 
+0x2A, 0x1B, 0xA2, 0x7A, 0xA5, 0xBE, 0xB1, 0x56, 
+0x8F, 0xF5, 0x5D, 0xB5, 0x9C, 0x9B, 0x9F, 0x4A, 
+0x70, 0x3A, 0x62, 0x29, 0xC2, 0xFC, 0xF9, 0xC7, 
+0xC2, 0x60, 0x41, 0x08, 0x11, 0xC6, 0x54, 0x50, 
+0xC5, 0xFD, 0xBB, 0x16, 0xDA, 0xA9, 0xD0, 0xB9, 
+0x93, 0x4D, 0x61, 0xC6, 0x23, 0xFF, 0xBF, 0xD4, 
+0xAB, 0x08, 0x3C, 0xB4, 0x7A, 0x4C, 0x9F, 0xB0, 
+0x2F, 0xE7, 0xDC, 0xB4, 0x65, 0x58, 0x24, 0xAC, 
+0x57, 0xFE, 0xE8, 0x3C, 0x9F, 0xF9, 0x57, 0xB3, 
+0x65, 0x0F, 0x6A, 0x66, 0x61, 0xE7, 0xC5, 0x2B, 
+0x07, 0xEE, 0x6F, 0x5D, 0x97, 0x67, 0x86, 0xD4, 
+0xA9, 0x51, 0x58, 0x62, 0xE2, 0xAC, 0x87, 0x5D, 
+0x06, 0x89, 0x4F, 0x1A, 0x18, 0xC1, 0x60, 0x06, 
+0xBD, 0xAD, 0xBC, 0x94, 0xF3, 0x40, 0xE3, 0x0C, 
+0x41, 0x88, 0x3E, 0xC5, 0x5E, 0x1A, 0xD0, 0x7A, 
+0x8B, 0xB3, 0x4E, 0x78, 0x37, 0x27, 0x72, 0xA0, 
+0xB7, 0x06, 0xC7, 0xDE, 0x27, 0x59, 0xA1, 0xC3, 
+0x79, 0x6B, 0xED, 0x81, 0xAD, 0xA5, 0x8D, 0x56, 
+0x68, 0x18, 0xA2, 0x7E, 0xF7, 0x02, 0x71, 0x53, 
+0x51, 0x14, 0x7B, 0x40, 0x66, 0x05, 0xC9, 0x01, 
+0xDB, 0xDD, 0x49, 0x30, 0xD9, 0xD4, 0xC6, 0x74, 
+0x41, 0x0F, 0xE2, 0x00, 0xE5, 0x64, 0xA8, 0xF1, 
+0x7F, 0xDE, 0xA0, 0x95, 0x99, 0xEB, 0xD3, 0x9F, 
+0xB5, 0x7A, 0x87, 0xB8, 0x61, 0x09, 0x2B, 0x0B, 
+0xE8, 0xEE, 0xB4, 0xA9, 0x82, 0xBA, 0x1F, 0x44, 
+0x94, 0x47, 0xA5, 0xA5, 0x8E, 0x16, 0x29, 0x81, 
+0x79, 0xC6, 0x1F, 0xA9, 0x79, 0x7C, 0xF8, 0xE7, 
+0x69, 0x0F, 0x30, 0x4E, 0x9E, 0x29, 0x9C, 0xA7, 
+0x83, 0xC5, 0x85, 0x0F, 0x93, 0x83, 0xE6, 0xC4, 
+0x52, 0xBF, 0xF3, 0xE8, 0x33, 0x5C, 0x9D, 0xAE, 
+0x74, 0xB3, 0x19, 0x63, 0xA2, 0xBE, 0xC7, 0x66, 
+0x48, 0x2D, 0x74, 0xBB, 0xEC, 0x0B, 0xD6, 0x58, 
+0x6B, 0x68, 0xD1, 0x2B, 0x68, 0x59, 0x83, 0xB0, 
+0x30, 0xC6, 0xBD, 0xAD, 0xB0, 0xC8, 0x0F, 0xBE, 
+0xFD, 0xEF, 0x19, 0x93, 
+
+0x3D, 0xF3, 0x91, 0x21, 0xD5, 0xDD, 0x57, 0xD2, 
+0xA8, 0x14, 0xA9, 0x01, 0x83, 0x91, 0x65, 0xD4, 
+0xF4, 0x92, 0x37, 0xDE, 0x55, 0xD0, 0x9E, 0x62, 
+0xB5, 0xA3, 0xCA, 0x6E, 0x5D, 0xEC, 0xBA, 0x61, 
+0x9D, 0xA4, 0x58, 0x43, 0xBB, 0xEE, 0xD0, 0x93, 
+0x4E, 0xB3, 0xF7, 0xDB, 0x49, 0x50, 0x90, 0x12, 
+0xAF, 0xDB, 0x18, 0x82, 0xD5, 0xC4, 0x09, 0xFC, 
+0xE8, 0xC2, 0x54, 0x2E, 0xF9, 0xFF, 0x03, 0x8D, 
+0xE5, 0xF7, 0x2B, 0x3B, 0xB4, 0x10, 0x18, 0x51, 
+0x7B, 0xA8, 0x32, 0x5F, 0x4D, 0x09, 0x84, 0x33, 
+0x3B, 0x54, 0xC3, 0xED, 0xFE, 0xDD, 0xBB, 0xDA, 
+0x56, 0xA8, 0x85, 0xF6, 0xBB, 0xC8, 0x58, 0x7B, 
+0x80, 0x49, 0x86, 0x98, 0x73, 0xC2, 0xD8, 0x99, 
+0x4D, 0x09, 0x02, 0x9A, 0xE9, 0x92, 0xB0, 0xB1, 
+0x4B, 0x45, 0x6C, 0x8F, 0x32, 0xA5, 0x1D, 0x96, 
+0xE3, 0x40, 0x3C, 0x46, 0x91, 0x87, 0xD6, 0x50, 
+0x48, 0x53, 0xA5, 0x51, 0xBA, 0x7E, 0xC5, 0xED, 
+0x60, 0x09, 0x16, 0xD1, 0xB5, 0x20, 0xC6, 0x1A, 
+0xBF, 0x2F, 0x32, 0x97, 0xCE, 0xDF, 0x15, 0x2B, 
+0x96, 0xE1, 0x8B, 0xE8, 0xE8, 0x14, 0x74, 0xCE, 
+0x7C, 0xE0, 0x49, 0xC1, 0x21, 0x65, 0xBE, 0x6B, 
+0x8C, 0x6A, 0x16, 0x8D, 0x86, 0x22, 0x0B, 0x63, 
+0x10, 0x9E, 0x8B, 0xE6, 0x9E, 0x9B, 0xCC, 0x30, 
+0xF2, 0x73, 0x74, 0x78, 0xB4, 0xAE, 0x53, 0x4F, 
+0xE7, 0x72, 0xF1, 0x8C, 0xA3, 0x83, 0xA6, 0x11, 
+0xEE, 0x02, 0x93, 0x3D, 0xC2, 0x47, 0x20, 0x55, 
+0x1C, 0x1D, 0x4D, 0x6C, 0x3C, 0xB5, 0xF5, 0x48, 
+0x6D, 0xDC, 0x08, 0xDB, 0x54, 0x09, 0x3C, 0xBB, 
+0x17, 0xC8, 0x58, 0x0E, 0xCD, 0x3D, 0x15, 0x83, 
+0x99, 0xDB, 0xCE, 0x2D, 0x5E, 0x3A, 0x17, 0xBF, 
+0x33, 0xC4, 0xA4, 0x9C, 0x40, 0x81, 0x7F, 0xE3, 
+0x9E, 0x39, 0x1D, 0x81, 0xE7, 0xD3, 0x47, 0x5E, 
+0xCF, 0x4D, 0xFD, 0x1B, 0xDE, 0xFE, 0xC5, 0xE4, 
+0xF7, 0xC7, 0x6F, 0xDF, 0x4F, 0x75, 0xC7, 0x13, 
+0xCB, 0xC4, 0x6B, 0x0C, 0xE9, 0x2B, 0xF3, 0x0F, 
+0xEC, 0x2B, 0x51, 0xC3, 0xB5, 
+
+0xBC, 0xB1, 0x85, 0x11, 0x00, 0xE3, 0x25, 0x18, 
+0x51, 0x51, 0x03, 0xDA, 0x24, 0x0B, 0x98, 0x98, 
+0x58, 0x83, 0x7F, 0x9B, 0xC0, 0x0C, 0x51, 0x58, 
+0x21, 0xBC, 0x20, 0xE3, 0x6D, 0x72, 0xB4, 0xF6, 
+0xCB, 0xD3, 0xE3, 0xFA, 0xDC, 0x45, 0x77, 0x0C, 
+0xA2, 0xBD, 0x6E, 0x79, 0x67, 0x64, 0xB4, 0x21, 
+0x10, 0xAD, 0x84, 0x95, 0x00, 0x4C, 0xD9, 0x63, 
+0xAA, 0xB0, 0x8D, 0x06, 0xC3, 0x4D, 0x6B, 0x04, 
+0x1E, 0x91, 0x80, 0x22, 0x0B, 0x35, 0x2D, 0xC8, 
+0x2A, 0x22, 0x9E, 0x21, 0xA1, 0x62, 0x5B, 0x8C, 
+0x72, 0x90, 0xBE, 0x66, 0x3D, 0x35, 0xB3, 0x0E, 
+0x6A, 0x26, 0xE5, 0x7D, 0xBF, 0xC3, 0xF5, 0xC2, 
+0x08, 0x28, 0x16, 0xF4, 0x0D, 0xAE, 0xD8, 0x1D, 
+0x9D, 0xE2, 0x01, 0x66, 0xFD, 0x2B, 0x30, 0xF1, 
+0x56, 0x01, 0xD6, 0xE7, 0xE5, 0xF6, 0x91, 0x21, 
+0x9B, 0x6C, 0x7F, 0x7C, 0x8C, 0xA9, 0xCD, 0x35, 
+0xAC, 0xB9, 0xB6, 0x83, 0x8A, 0x45, 0xF2, 0xEA, 
+0xA6, 0x79, 0x34, 0x2A, 0x1D, 0x0F, 0x46, 0xF6, 
+0xAD, 0x09, 0xF7, 0xF0, 0xA4, 0xC1, 0x7D, 0x75, 
+0x0A, 0x87, 0x78, 0xCF, 0xD7, 0xC9, 0x06, 0x18, 
+0x50, 0xE2, 0x89, 0x95, 0x84, 0xFA, 0x3B, 0x08, 
+0xB7, 0xDD, 0xC9, 0x3F, 0x89, 0x1F, 0xC8, 0x42, 
+0xBC, 0xD3, 0x5A, 0xFC, 0x0B, 0xD5, 0xF9, 0x2D, 
+0xAC, 0xAC, 0x92, 0x46, 0xD7, 0xCA, 0x64, 0x15, 
+0x24, 0x0A, 0x9E, 0x0D, 0x94, 0x89, 0x59, 0xE6, 
+0xEE, 0xE9, 0x93, 0x7E, 0xB4, 0xD9, 0xEA, 0xCF, 
+0x43, 0xCA, 0xD2, 0x20, 0x71, 0x19, 0x7C, 0x2A, 
+0xE3, 0xEB, 0xAB, 0xD4, 0x70, 0x48, 0x90, 0x25, 
+0xB3, 0xBD, 0x44, 0x62, 0xBB, 0x54, 0x20, 0x67, 
+0x5C, 0x6F, 0xB1, 0x69, 0x68, 0x75, 0x94, 0x1F, 
+0x3B, 0x2D, 0xEF, 0xBD, 0x9B, 0xFD, 0xE1, 0x86, 
+0xAD, 0x3C, 0x27, 0xFC, 0xE2, 0xA2, 0x02, 0xDB, 
+0xC0, 0xED, 0xA2, 0x96, 0x4C, 0xD1, 0x46, 0x17, 
+0x75, 0x50, 0x6A, 0xAF, 0x48, 0xCF, 0x46, 0xAC, 
+0xD4, 0x82, 0x6B, 0xB2, 0x44, 0x68, 0xD6, 0xB2, 
+0x3D, 0x80, 0xED, 0xC5, 0x3B, 0xF8, 
+
+0xC3, 0xEB, 0xE8, 0xF8, 0xDB, 0xAB, 0x47, 0x2C, 
+0xFE, 0x5D, 0xFF, 0xB6, 0x13, 0x89, 0x46, 0x3F, 
+0xC7, 0x8E, 0x7C, 0x34, 0x01, 0x5E, 0xF2, 0x1C, 
+0xC1, 0x2B, 0x11, 0x25, 0xB6, 0xEE, 0x62, 0x03, 
+0x50, 0x34, 0xBC, 0xDD, 0xA7, 0x3F, 0x49, 0x9A, 
+0x6D, 0xE9, 0xEE, 0x76, 0x1A, 0xA4, 0x81, 0x30, 
+0xF4, 0x00, 0xA7, 0x9C, 0xEA, 0x89, 0x98, 0xD9, 
+0x49, 0x22, 0x69, 0x54, 0xB6, 0x46, 0x9B, 0x3B, 
+0x3E, 0xB3, 0xA6, 0xD0, 0xB2, 0x46, 0xCA, 0xED, 
+0x60, 0xD8, 0xD4, 0x24, 0xD7, 0xD0, 0x85, 0xC0, 
+0x35, 0x5F, 0xA4, 0x93, 0x0F, 0x02, 0x75, 0x27, 
+0x54, 0xB8, 0x4C, 0x8A, 0xC1, 0x24, 0x15, 0xBB, 
+0xC5, 0xBE, 0xD3, 0x59, 0x1F, 0x5F, 0x68, 0x7D, 
+0x7C, 0xE5, 0x18, 0x5B, 0x9D, 0xA2, 0x60, 0xA5, 
+0x72, 0xED, 0x31, 0x81, 0xB0, 0xBA, 0xD7, 0x89, 
+0xFA, 0xD1, 0x24, 0x32, 0x22, 0xEB, 0x92, 0x8A, 
+0x88, 0x49, 0x0A, 0x38, 0xC5, 0xA0, 0x59, 0xCC, 
+0x66, 0xDD, 0x37, 0xDC, 0x84, 0x9F, 0xFF, 0xFD, 
+0xF7, 0xD9, 0xF2, 0xB1, 0x32, 0x1B, 0xFE, 0x6C, 
+0x92, 0x99, 0xF2, 0xE8, 0x1D, 0x9C, 0xD5, 0x17, 
+0xE9, 0x0C, 0xC5, 0x7C, 0x66, 0x14, 0x71, 0x14, 
+0x3B, 0x34, 0xF8, 0xBE, 0x7A, 0xE0, 0x0E, 0xD8, 
+0x35, 0x96, 0x6F, 0xF1, 0x97, 0x0F, 0xE3, 0x01, 
+0x94, 0x26, 0x06, 0x50, 0x34, 0x45, 0x27, 0x44, 
+0x5F, 0x2E, 0xA7, 0xCA, 0x41, 0x80, 0xCE, 0x25, 
+0x4E, 0x90, 0x51, 0x88, 0x5C, 0x67, 0x97, 0x80, 
+0xE2, 0x12, 0xB3, 0xFB, 0x85, 0x63, 0xFF, 0xC9, 
+0x8C, 0xA1, 0x3F, 0x33, 0xB3, 0x87, 0xFD, 0x27, 
+0x5E, 0xCA, 0xEA, 0xEC, 0xB2, 0xF2, 0x62, 0x2D, 
+0xFA, 0xB3, 0x2C, 0xE6, 0xDD, 0xEB, 0x42, 0xD6, 
+0x43, 0xDD, 0x32, 0xBF, 0xAE, 0x75, 0xF9, 0x95, 
+0xB5, 0x83, 0x62, 0xF6, 0x4A, 0x1F, 0xFA, 0x05, 
+0x09, 0x84, 0xA0, 0xE8, 0x0A, 0xD3, 0x8D, 0x33, 
+0x77, 0x45, 0xB9, 0xFA, 0xFE, 0xCA, 0x5F, 0x5F, 
+0x96, 0xA7, 0x96, 0xE7, 0xBB, 0x81, 0xF6, 0x09, 
+0xC8, 0xF2, 0xC7, 0xB3, 0x24, 0xE6, 0x79, 
+
+}
+return kat[i:j]
+}
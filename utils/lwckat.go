@@ -0,0 +1,213 @@
+/*
+    lwckat.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+
+package utils
+
+import norx "github.com/daeinar/norx-go/aead"
+
+import "bufio"
+import "bytes"
+import "encoding/hex"
+import "encoding/json"
+import "errors"
+import "fmt"
+import "io"
+import "strconv"
+import "strings"
+
+// The LWC_AEAD_KAT files of the CAESAR and NIST lightweight competitions
+// list, for all message lengths from 0 to LWC_MAX_PT and, within each, all
+// header lengths from 0 to LWC_MAX_AD, the entries
+//
+//     Count = 1
+//     Key = 000102...
+//     Nonce = 000102...
+//     PT =
+//     AD =
+//     CT = <ciphertext || tag>
+//
+// separated by blank lines, where key, nonce, message and header are the
+// byte sequences 00 01 02 ... of the respective length. The trailer is empty.
+const (
+    LWC_MAX_PT = 32
+    LWC_MAX_AD = 32
+)
+
+var ErrKATFormat = errors.New("norx: malformed KAT file")
+
+// Vector is one entry of a KAT file.
+type Vector struct {
+    Count int      `json:"count"`
+    Key   hexbytes `json:"key"`
+    Nonce hexbytes `json:"nonce"`
+    PT    hexbytes `json:"pt"`
+    AD    hexbytes `json:"ad"`
+    CT    hexbytes `json:"ct"`
+}
+
+// hexbytes is a byte slice that is written to JSON as an upper-case hex
+// string, like in the text format.
+type hexbytes []uint8
+
+func (x hexbytes) MarshalJSON() ([]byte, error) {
+    return json.Marshal(strings.ToUpper(hex.EncodeToString(x)))
+}
+
+func (x *hexbytes) UnmarshalJSON(data []byte) error {
+
+    var s string
+    if err := json.Unmarshal(data, &s); err != nil {
+        return err
+    }
+    b, err := hex.DecodeString(s)
+    if err != nil {
+        return ErrKATFormat
+    }
+    *x = b
+    return nil
+}
+
+// GenerateLWC computes the LWC_AEAD_KAT vectors of p.
+func GenerateLWC(p *norx.Params) []Vector {
+
+    var vs []Vector
+    var count = 1
+
+    seq := func(n int) []uint8 {
+        b := make([]uint8, n)
+        for i := range b { b[i] = uint8(i) }
+        return b
+    }
+    k := seq(p.KeySize())
+    n := seq(p.NonceSize())
+
+    for mlen := 0; mlen <= LWC_MAX_PT; mlen++ {
+        for alen := 0; alen <= LWC_MAX_AD; alen++ {
+            var clen uint64 = 0
+            m, a := seq(mlen), seq(alen)
+            c := make([]uint8, mlen + p.TagSize())
+            p.AEAD_encrypt(c, &clen, a, uint64(alen), m, uint64(mlen), nil, 0, n, k)
+            vs = append(vs, Vector{Count: count, Key: k, Nonce: n, PT: m, AD: a, CT: c})
+            count++
+        }
+    }
+    return vs
+}
+
+// VerifyLWC checks that p encrypts every vector to its CT and decrypts it
+// back, and returns the first mismatch.
+func VerifyLWC(p *norx.Params, vs []Vector) error {
+
+    for _, v := range vs {
+        var clen, mlen uint64
+        c := make([]uint8, len(v.PT) + p.TagSize())
+        if err := p.AEAD_encrypt(c, &clen, v.AD, uint64(len(v.AD)), v.PT, uint64(len(v.PT)), nil, 0, v.Nonce, v.Key); err != nil {
+            return fmt.Errorf("%s %s: fail at KAT %d: %v", p, p.Version(), v.Count, err)
+        }
+        if !bytes.Equal(c, v.CT) {
+            return fmt.Errorf("%s %s: fail at KAT %d: ciphertext mismatch", p, p.Version(), v.Count)
+        }
+        m := make([]uint8, len(v.PT))
+        if err := p.AEAD_decrypt(m, &mlen, v.AD, uint64(len(v.AD)), v.CT, uint64(len(v.CT)), nil, 0, v.Nonce, v.Key); err != nil || !bytes.Equal(m, v.PT) {
+            return fmt.Errorf("%s %s: fail at KAT %d: decryption", p, p.Version(), v.Count)
+        }
+    }
+    return nil
+}
+
+// WriteLWC writes vs in the LWC_AEAD_KAT text format.
+func WriteLWC(w io.Writer, vs []Vector) error {
+
+    bw := bufio.NewWriter(w)
+    for _, v := range vs {
+        fmt.Fprintf(bw, "Count = %d\n", v.Count)
+        fmt.Fprintf(bw, "Key = %X\n", []uint8(v.Key))
+        fmt.Fprintf(bw, "Nonce = %X\n", []uint8(v.Nonce))
+        fmt.Fprintf(bw, "PT = %X\n", []uint8(v.PT))
+        fmt.Fprintf(bw, "AD = %X\n", []uint8(v.AD))
+        fmt.Fprintf(bw, "CT = %X\n\n", []uint8(v.CT))
+    }
+    return bw.Flush()
+}
+
+// ReadLWC parses a file in the LWC_AEAD_KAT text format.
+func ReadLWC(r io.Reader) ([]Vector, error) {
+
+    var vs []Vector
+    var v *Vector
+    var s = bufio.NewScanner(r)
+
+    for s.Scan() {
+        line := strings.TrimSpace(s.Text())
+        if line == "" || strings.HasPrefix(line, "#") {
+            continue
+        }
+        kv := strings.SplitN(line, "=", 2)
+        if len(kv) != 2 {
+            return nil, ErrKATFormat
+        }
+        key, val := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+
+        if key == "Count" {
+            n, err := strconv.Atoi(val)
+            if err != nil {
+                return nil, ErrKATFormat
+            }
+            vs = append(vs, Vector{Count: n})
+            v = &vs[len(vs) - 1]
+            continue
+        }
+        if v == nil {
+            return nil, ErrKATFormat
+        }
+        b, err := hex.DecodeString(val)
+        if err != nil {
+            return nil, ErrKATFormat
+        }
+        switch key {
+        case "Key":
+            v.Key = b
+        case "Nonce":
+            v.Nonce = b
+        case "PT":
+            v.PT = b
+        case "AD":
+            v.AD = b
+        case "CT":
+            v.CT = b
+        default:
+            return nil, ErrKATFormat
+        }
+    }
+    if err := s.Err(); err != nil {
+        return nil, err
+    }
+    return vs, nil
+}
+
+// WriteLWCJSON writes vs as a JSON array with the fields of the text format
+// in lower case.
+func WriteLWCJSON(w io.Writer, vs []Vector) error {
+
+    enc := json.NewEncoder(w)
+    enc.SetIndent("", "  ")
+    return enc.Encode(vs)
+}
+
+// ReadLWCJSON parses the output of WriteLWCJSON.
+func ReadLWCJSON(r io.Reader) ([]Vector, error) {
+
+    var vs []Vector
+    if err := json.NewDecoder(r).Decode(&vs); err != nil {
+        return nil, ErrKATFormat
+    }
+    return vs, nil
+}
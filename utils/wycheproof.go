@@ -0,0 +1,232 @@
+/*
+    wycheproof.go
+    ------
+
+    This file is part of the Go reference implementation of NORX.
+
+    :version: v2.0, v3.0
+    :copyright: (c) 2014, 2015 Philipp Jovanovic <philipp@jovanovic.io>
+    :license: CC0, see LICENSE
+*/
+
+package utils
+
+import norx "github.com/daeinar/norx-go/aead"
+
+import "bytes"
+import "encoding/json"
+import "fmt"
+import "io"
+import "strings"
+
+// Wycheproof is a test vector file in the format of Project Wycheproof's AEAD
+// tests. Every group names the NORX variant and specification version it
+// applies to in addition to the usual sizes in bits.
+type Wycheproof struct {
+    Algorithm     string            `json:"algorithm"`
+    NumberOfTests int               `json:"numberOfTests"`
+    Header        []string          `json:"header"`
+    Notes         map[string]string `json:"notes"`
+    Schema        string            `json:"schema"`
+    TestGroups    []WycheproofGroup `json:"testGroups"`
+}
+
+type WycheproofGroup struct {
+    Variant string           `json:"variant"`
+    Version string           `json:"version"`
+    IvSize  int              `json:"ivSize"`
+    KeySize int              `json:"keySize"`
+    TagSize int              `json:"tagSize"`
+    Type    string           `json:"type"`
+    Tests   []WycheproofTest `json:"tests"`
+}
+
+// WycheproofTest is one test case. Result is "valid" if ct || tag is the
+// encryption of msg, "invalid" if decryption has to fail and "acceptable" if
+// either outcome is fine.
+type WycheproofTest struct {
+    TcId    int      `json:"tcId"`
+    Comment string   `json:"comment"`
+    Flags   []string `json:"flags"`
+    Key     hexbytes `json:"key"`
+    Iv      hexbytes `json:"iv"`
+    Aad     hexbytes `json:"aad"`
+    Msg     hexbytes `json:"msg"`
+    Ct      hexbytes `json:"ct"`
+    Tag     hexbytes `json:"tag"`
+    Result  string   `json:"result"`
+}
+
+var wycheproof_notes = map[string]string{
+    "EdgeCaseLength": "The message or header ends at, just before or just after a block boundary of the rate.",
+    "ModifiedTag":    "The tag has been modified and must be rejected.",
+    "ModifiedCt":     "The ciphertext has been modified and must be rejected.",
+    "TruncatedTag":   "The tag is shorter than the tag size of the group and must be rejected.",
+}
+
+// GenerateWycheproof computes the vectors of p: empty inputs, messages and
+// headers around multiples of the rate, and modified and truncated tags and
+// ciphertexts.
+func GenerateWycheproof(p *norx.Params) *Wycheproof {
+
+    var r = p.RateSize()
+    var g = WycheproofGroup{
+        Variant: p.String(),
+        Version: p.Version(),
+        IvSize:  8 * p.NonceSize(),
+        KeySize: 8 * p.KeySize(),
+        TagSize: 8 * p.TagSize(),
+        Type:    "AeadTest",
+    }
+
+    h, w, _, k, n := Inputs(p, 2 * r + 1)
+
+    add := func(comment string, flags []string, a []uint8, m []uint8, modify func(ct, tag []uint8) ([]uint8, []uint8)) {
+        var clen uint64 = 0
+        c := make([]uint8, len(m) + p.TagSize())
+        p.AEAD_encrypt(c, &clen, a, uint64(len(a)), m, uint64(len(m)), nil, 0, n, k)
+        ct, tag := c[:len(m)], c[len(m):]
+        result := "valid"
+        if modify != nil {
+            ct, tag = modify(ct, tag)
+            result = "invalid"
+        }
+        if flags == nil {
+            flags = []string{}
+        }
+        g.Tests = append(g.Tests, WycheproofTest{
+            TcId: len(g.Tests) + 1, Comment: comment, Flags: flags,
+            Key: k, Iv: n, Aad: a, Msg: m, Ct: ct, Tag: tag, Result: result,
+        })
+    }
+    edge := []string{"EdgeCaseLength"}
+
+    add("empty message and header", nil, nil, nil, nil)
+    add("empty message", nil, h[:1], nil, nil)
+    add("short message", nil, h[:16], w[:16], nil)
+    for _, l := range []int{r - 1, r, r + 1, 2 * r, 2 * r + 1} {
+        add(fmt.Sprintf("message of %d bytes", l), edge, h[:16], w[:l], nil)
+    }
+    for _, l := range []int{r, r + 1} {
+        add(fmt.Sprintf("header of %d bytes", l), edge, h[:l], w[:17], nil)
+    }
+
+    flip := func(i int, bit uint8) func(ct, tag []uint8) ([]uint8, []uint8) {
+        return func(ct, tag []uint8) ([]uint8, []uint8) {
+            tag = append([]uint8{}, tag...)
+            tag[(i + len(tag)) % len(tag)] ^= bit
+            return ct, tag
+        }
+    }
+    add("flipped first bit of tag", []string{"ModifiedTag"}, h[:16], w[:32], flip(0, 0x01))
+    add("flipped last bit of tag", []string{"ModifiedTag"}, h[:16], w[:32], flip(-1, 0x80))
+    add("flipped bit of tag, empty message", []string{"ModifiedTag"}, nil, nil, flip(3, 0x10))
+    add("zero tag", []string{"ModifiedTag"}, h[:16], w[:32], func(ct, tag []uint8) ([]uint8, []uint8) {
+        return ct, make([]uint8, len(tag))
+    })
+    add("truncated tag", []string{"TruncatedTag"}, h[:16], w[:32], func(ct, tag []uint8) ([]uint8, []uint8) {
+        return ct, tag[:len(tag) - 1]
+    })
+    add("flipped bit of ciphertext", []string{"ModifiedCt"}, h[:16], w[:r + 1], func(ct, tag []uint8) ([]uint8, []uint8) {
+        ct = append([]uint8{}, ct...)
+        ct[r] ^= 0x01
+        return ct, tag
+    })
+
+    return &Wycheproof{
+        Algorithm:     "NORX",
+        NumberOfTests: len(g.Tests),
+        Header:        []string{"Test vectors of type AeadTest for NORX, generated by norx-go genkat --format wycheproof."},
+        Notes:         wycheproof_notes,
+        Schema:        "aead_test_schema.json",
+        TestGroups:    []WycheproofGroup{g},
+    }
+}
+
+// WriteWycheproof writes f as indented JSON.
+func WriteWycheproof(w io.Writer, f *Wycheproof) error {
+
+    enc := json.NewEncoder(w)
+    enc.SetIndent("", "  ")
+    return enc.Encode(f)
+}
+
+// ReadWycheproof parses a Wycheproof AEAD test vector file.
+func ReadWycheproof(r io.Reader) (*Wycheproof, error) {
+
+    var f = new(Wycheproof)
+    if err := json.NewDecoder(r).Decode(f); err != nil {
+        return nil, ErrKATFormat
+    }
+    return f, nil
+}
+
+// Params resolves the variant, version and tag size of the group, or returns
+// nil if the variant is unknown.
+func (g *WycheproofGroup) Params() *norx.Params {
+
+    name, _, _ := strings.Cut(g.Variant, "-")
+    p := Variant(name, g.Version)
+    if p == nil {
+        return nil
+    }
+    q, err := p.WithTagSize(g.TagSize / 8)
+    if err != nil || q.KeySize() * 8 != g.KeySize || q.NonceSize() * 8 != g.IvSize {
+        return nil
+    }
+    return &q
+}
+
+// Run checks the test case against p: a valid case has to encrypt to ct and
+// tag and decrypt to msg, an invalid one has to fail decryption, and an
+// acceptable one may do either as long as a successful decryption yields msg.
+func (tc *WycheproofTest) Run(p *norx.Params) error {
+
+    var mlen uint64 = 0
+    var c = append(append([]uint8{}, tc.Ct...), tc.Tag...)
+    var m = make([]uint8, len(c))
+
+    err := p.AEAD_decrypt(m, &mlen, tc.Aad, uint64(len(tc.Aad)), c, uint64(len(c)), nil, 0, tc.Iv, tc.Key)
+    opened := err == nil && bytes.Equal(m[:mlen], tc.Msg)
+
+    switch tc.Result {
+    case "valid":
+        var clen uint64 = 0
+        e := make([]uint8, len(tc.Msg) + p.TagSize())
+        if err := p.AEAD_encrypt(e, &clen, tc.Aad, uint64(len(tc.Aad)), tc.Msg, uint64(len(tc.Msg)), nil, 0, tc.Iv, tc.Key); err != nil || !bytes.Equal(e, c) {
+            return fmt.Errorf("tcId %d: encryption mismatch", tc.TcId)
+        }
+        if !opened {
+            return fmt.Errorf("tcId %d: valid ciphertext rejected: %v", tc.TcId, err)
+        }
+    case "invalid":
+        if err == nil {
+            return fmt.Errorf("tcId %d: invalid ciphertext accepted", tc.TcId)
+        }
+    case "acceptable":
+        if err == nil && !opened {
+            return fmt.Errorf("tcId %d: wrong plaintext", tc.TcId)
+        }
+    default:
+        return fmt.Errorf("tcId %d: unknown result %q", tc.TcId, tc.Result)
+    }
+    return nil
+}
+
+// VerifyWycheproof runs every test case of f and returns the first failure.
+func VerifyWycheproof(f *Wycheproof) error {
+
+    for i := range f.TestGroups {
+        var g = &f.TestGroups[i]
+        var p = g.Params()
+        if p == nil {
+            return fmt.Errorf("unsupported group %s %s", g.Variant, g.Version)
+        }
+        for j := range g.Tests {
+            if err := g.Tests[j].Run(p); err != nil {
+                return fmt.Errorf("%s %s: %v", p, p.Version(), err)
+            }
+        }
+    }
+    return nil
+}